@@ -0,0 +1,19 @@
+#!/bin/bash
+# Fetches the Godot class documentation into the "doc" folder so it can be
+# included as Go documentation as part of class generation.
+cd "$(dirname "$0")/.."
+
+if [ -d doc ]; then
+    echo "Godot documentation found. Pulling the latest changes..."
+    cd doc
+    git pull origin master
+else
+    echo "Godot documentation not found. Pulling the latest changes..."
+    mkdir doc
+    cd doc
+    git init
+    git remote add -f origin https://github.com/godotengine/godot.git
+    git config core.sparseCheckout true
+    echo "doc/classes/" >> .git/info/sparse-checkout
+    git pull origin master
+fi
@@ -0,0 +1,175 @@
+package classes
+
+import (
+	"sort"
+	"strings"
+)
+
+// GlobalEnum is a group of global constants that share a Go type.
+type GlobalEnum struct {
+	Name   string
+	GoType string
+
+	// Declare is true if the Go type needs to be declared. Some global enums
+	// already have a type in the gdnative package.
+	Declare bool
+	Values  []GlobalConstant
+}
+
+// GlobalConstant is a single constant value from the GlobalConstants class.
+type GlobalConstant struct {
+	Name   string
+	GoName string
+	Value  int64
+}
+
+// UniqueValues will return the enum values with duplicate values removed. The
+// first value in sorted order is kept.
+func (e GlobalEnum) UniqueValues() []GlobalConstant {
+	seen := map[int64]bool{}
+	unique := []GlobalConstant{}
+	for _, constant := range e.Values {
+		if seen[constant.Value] {
+			continue
+		}
+		seen[constant.Value] = true
+		unique = append(unique, constant)
+	}
+
+	return unique
+}
+
+// UniqueEnumValues will return the values of a class enum sorted by name, with
+// duplicate values removed.
+func (v View) UniqueEnumValues(values map[string]int64) []GlobalConstant {
+	enum := GlobalEnum{}
+	for _, name := range sortedConstantNames(values) {
+		enum.Values = append(enum.Values, GlobalConstant{Name: name, Value: values[name]})
+	}
+
+	return enum.UniqueValues()
+}
+
+// globalEnumSpec describes which global constants belong to a global enum. A
+// constant belongs to the first enum with a matching name or prefix.
+type globalEnumSpec struct {
+	name     string
+	goType   string
+	prefixes []string
+	names    []string
+}
+
+// globalEnumSpecs is a list of the global enums in the Godot API. These are
+// not part of godot_api.json, so we group the constants by their prefix.
+var globalEnumSpecs = []globalEnumSpec{
+	{name: "Margin", goType: "Margin", prefixes: []string{"MARGIN_"}},
+	{name: "Corner", goType: "Corner", prefixes: []string{"CORNER_"}},
+	{name: "Orientation", goType: "Orientation", names: []string{"VERTICAL", "HORIZONTAL"}},
+	{name: "HAlign", goType: "HAlign", prefixes: []string{"HALIGN_"}},
+	{name: "VAlign", goType: "VAlign", prefixes: []string{"VALIGN_"}},
+	{name: "KeyModifierMask", goType: "KeyModifierMask", prefixes: []string{"KEY_MASK_"}, names: []string{"KEY_CODE_MASK", "KEY_MODIFIER_MASK"}},
+	{name: "KeyList", goType: "KeyList", prefixes: []string{"KEY_"}},
+	{name: "ButtonList", goType: "ButtonList", prefixes: []string{"BUTTON_"}},
+	{name: "JoystickList", goType: "JoystickList", prefixes: []string{"JOY_"}},
+	{name: "MidiMessageList", goType: "MidiMessageList", prefixes: []string{"MIDI_MESSAGE_"}},
+	{name: "Error", goType: "gdnative.Error", prefixes: []string{"ERR_"}, names: []string{"OK", "FAILED"}},
+	{name: "PropertyHint", goType: "gdnative.PropertyHint", prefixes: []string{"PROPERTY_HINT_"}},
+	{name: "PropertyUsageFlags", goType: "gdnative.PropertyUsageFlags", prefixes: []string{"PROPERTY_USAGE_"}},
+	{name: "MethodFlags", goType: "MethodFlags", prefixes: []string{"METHOD_FLAG"}},
+	{name: "VariantType", goType: "gdnative.VariantType", prefixes: []string{"TYPE_"}},
+	{name: "VariantOperator", goType: "gdnative.VariantOperator", prefixes: []string{"OP_"}},
+}
+
+// matches will return true if the given constant name belongs to the enum.
+func (s globalEnumSpec) matches(name string) bool {
+	for _, n := range s.names {
+		if name == n {
+			return true
+		}
+	}
+	for _, prefix := range s.prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// GlobalEnums will return the constants of the GlobalConstants class grouped
+// into their enums. Constants that don't belong to an enum are returned under
+// an enum without a name.
+func (v View) GlobalEnums() []GlobalEnum {
+	api, _ := v.findAPI("GlobalConstants")
+
+	enums := make([]GlobalEnum, len(globalEnumSpecs)+1)
+	for i, spec := range globalEnumSpecs {
+		enums[i] = GlobalEnum{
+			Name:    spec.name,
+			GoType:  spec.goType,
+			Declare: !strings.Contains(spec.goType, "."),
+		}
+	}
+	for _, name := range sortedConstantNames(api.Constants) {
+		constant := GlobalConstant{Name: name, GoName: v.GoName(name), Value: api.Constants[name]}
+		index := len(globalEnumSpecs)
+		for i, spec := range globalEnumSpecs {
+			if spec.matches(name) {
+				index = i
+				break
+			}
+		}
+		// Constants can't have the same name as their type, such as the
+		// KEY_MODIFIER_MASK value of KeyModifierMask.
+		if constant.GoName == enums[index].GoType {
+			constant.GoName += "Value"
+		}
+		enums[index].Values = append(enums[index].Values, constant)
+	}
+
+	// Only return enums that have values.
+	found := []GlobalEnum{}
+	for _, enum := range enums {
+		if len(enum.Values) > 0 {
+			found = append(found, enum)
+		}
+	}
+
+	return found
+}
+
+// ClassConstants will return the constants of the given class that are not
+// part of one of its enums, sorted by name.
+func (v View) ClassConstants(api GDAPI) []GlobalConstant {
+	enumValues := map[string]bool{}
+	for _, enum := range api.Enums {
+		for name := range enum.Values {
+			enumValues[name] = true
+		}
+	}
+
+	constants := []GlobalConstant{}
+	for _, name := range sortedConstantNames(api.Constants) {
+		if enumValues[name] {
+			continue
+		}
+		constants = append(constants, GlobalConstant{
+			Name:   name,
+			GoName: v.GoClassName(api.Name) + v.GoName(name),
+			Value:  api.Constants[name],
+		})
+	}
+
+	return constants
+}
+
+// sortedConstantNames will return the names of the given constants in sorted
+// order, so the output of the generator is stable.
+func sortedConstantNames(constants map[string]int64) []string {
+	names := make([]string, 0, len(constants))
+	for name := range constants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
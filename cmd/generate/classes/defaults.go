@@ -0,0 +1,167 @@
+package classes
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// numberPattern matches the numbers in a default value, such as "(0, 0)".
+var numberPattern = regexp.MustCompile(`-?[0-9]+(\.[0-9]+)?`)
+
+// GoDefaultValue will convert the default value of the given argument from
+// godot_api.json into a Go expression. It returns false if the default value
+// can't be represented in Go.
+func (v View) GoDefaultValue(arg GDArgument) (string, bool) {
+	if !arg.HasDefaultValue {
+		return "", false
+	}
+	value := strings.TrimSpace(arg.DefaultValue)
+	numbers := numberPattern.FindAllString(value, -1)
+
+	// Optional objects are always null.
+	if v.IsGodotClass(arg.Type) {
+		if value == "Null" || value == "[Object:null]" {
+			return "nil", true
+		}
+		return "", false
+	}
+	if v.IsEnum(arg.Type) {
+		if len(numbers) == 1 && numbers[0] == value {
+			return value, true
+		}
+		return "", false
+	}
+
+	switch arg.Type {
+	case "bool":
+		switch value {
+		case "True":
+			return "true", true
+		case "False":
+			return "false", true
+		}
+	case "int", "float":
+		if len(numbers) == 1 && numbers[0] == value {
+			return value, true
+		}
+	case "String":
+		return strconv.Quote(value), true
+	case "Variant":
+		if value == "Null" {
+			return "gdnative.NewVariantNil()", true
+		}
+		if len(numbers) == 1 && numbers[0] == value {
+			return "gdnative.NewVariantInt(" + value + ")", true
+		}
+	case "Array":
+		if value == "[]" || value == "Null" {
+			return "gdnative.NewArray()", true
+		}
+	case "PoolByteArray", "PoolIntArray", "PoolRealArray", "PoolStringArray", "PoolVector2Array", "PoolVector3Array", "PoolColorArray":
+		if value == "[]" || value == "["+arg.Type+"]" {
+			return "gdnative.New" + arg.Type + "()", true
+		}
+	case "RID":
+		if value == "[RID]" {
+			return "gdnative.NewRid()", true
+		}
+	case "Vector2":
+		if len(numbers) == 2 {
+			return "gdnative.NewVector2(" + strings.Join(numbers, ", ") + ")", true
+		}
+	case "Vector3":
+		if len(numbers) == 3 {
+			return "gdnative.NewVector3(" + strings.Join(numbers, ", ") + ")", true
+		}
+	case "Rect2":
+		if len(numbers) == 4 {
+			return "gdnative.NewRect2(" + strings.Join(numbers, ", ") + ")", true
+		}
+	case "Color":
+		if len(numbers) == 4 {
+			return "gdnative.NewColorRgba(" + strings.Join(numbers, ", ") + ")", true
+		}
+	case "Transform2D":
+		if len(numbers) == 6 {
+			return "*gdnative.NewTransform2DAxisOrigin(" +
+				"gdnative.NewVector2(" + strings.Join(numbers[0:2], ", ") + "), " +
+				"gdnative.NewVector2(" + strings.Join(numbers[2:4], ", ") + "), " +
+				"gdnative.NewVector2(" + strings.Join(numbers[4:6], ", ") + "))", true
+		}
+	case "Transform":
+		// Transforms are given as the basis rows followed by the origin.
+		if len(numbers) == 12 {
+			return "gdnative.NewTransform(" +
+				"gdnative.NewBasisWithRows(" +
+				"gdnative.NewVector3(" + strings.Join(numbers[0:3], ", ") + "), " +
+				"gdnative.NewVector3(" + strings.Join(numbers[3:6], ", ") + "), " +
+				"gdnative.NewVector3(" + strings.Join(numbers[6:9], ", ") + ")), " +
+				"gdnative.NewVector3(" + strings.Join(numbers[9:12], ", ") + "))", true
+		}
+	}
+
+	return "", false
+}
+
+// DefaultsHelper is a class method with default argument values. We will
+// generate a short form of the method that only takes the required arguments
+// and passes the default values for the rest.
+type DefaultsHelper struct {
+	Method   GDMethod
+	GoName   string
+	Required []GDArgument
+	Defaults []DefaultValue
+}
+
+// DefaultValue is the Go expression of a default argument value.
+type DefaultValue struct {
+	Value string
+
+	// Destroy is true if the value allocates memory in Godot that has to be
+	// freed after the method call.
+	Destroy bool
+}
+
+// Omitted will return the names of the arguments that use their default value.
+func (d DefaultsHelper) Omitted() string {
+	names := []string{}
+	for _, arg := range d.Method.Arguments[len(d.Required):] {
+		names = append(names, arg.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// buildDefaultsHelper will return the short form of the given method, if it
+// has trailing arguments with default values that we can represent in Go.
+func (v View) buildDefaultsHelper(method GDMethod) (DefaultsHelper, bool) {
+	if method.HasVarargs {
+		return DefaultsHelper{}, false
+	}
+
+	// Find the trailing arguments that have default values.
+	required := len(method.Arguments)
+	defaults := []DefaultValue{}
+	for i := len(method.Arguments) - 1; i >= 0; i-- {
+		arg := method.Arguments[i]
+		value, ok := v.GoDefaultValue(arg)
+		if !ok {
+			break
+		}
+		required = i
+		destroy := arg.Type == "Variant" || arg.Type == "Array" || strings.HasPrefix(arg.Type, "Pool")
+		defaults = append([]DefaultValue{{Value: value, Destroy: destroy}}, defaults...)
+	}
+	if len(defaults) == 0 {
+		return DefaultsHelper{}, false
+	}
+
+	helper := DefaultsHelper{
+		Method:   method,
+		GoName:   v.GoMethodName(method.Name) + "WithDefaults",
+		Required: method.Arguments[:required],
+		Defaults: defaults,
+	}
+
+	return helper, true
+}
@@ -0,0 +1,48 @@
+package classes
+
+import "testing"
+
+func TestGoDefaultValue(t *testing.T) {
+	view := View{PackageMap: map[string]string{"Node": CorePackage}}
+	tests := []struct {
+		argType  string
+		value    string
+		expected string
+		ok       bool
+	}{
+		{"Node", "Null", "nil", true},
+		{"Node", "[Object:null]", "nil", true},
+		{"Node", "[Node:1234]", "", false},
+		{"enum.Node::PauseMode", "0", "0", true},
+		{"enum.Node::PauseMode", "PAUSE_MODE_INHERIT", "", false},
+		{"bool", "True", "true", true},
+		{"bool", "False", "false", true},
+		{"bool", "1", "", false},
+		{"int", "-1", "-1", true},
+		{"float", "0.5", "0.5", true},
+		{"String", "hello \"world\"", `"hello \"world\""`, true},
+		{"Variant", "Null", "gdnative.NewVariantNil()", true},
+		{"Variant", "3", "gdnative.NewVariantInt(3)", true},
+		{"Array", "[]", "gdnative.NewArray()", true},
+		{"PoolIntArray", "[PoolIntArray]", "gdnative.NewPoolIntArray()", true},
+		{"RID", "[RID]", "gdnative.NewRid()", true},
+		{"Vector2", "(0, -1.5)", "gdnative.NewVector2(0, -1.5)", true},
+		{"Vector3", "(1, 2)", "", false},
+		{"Rect2", "(0, 0, 1, 1)", "gdnative.NewRect2(0, 0, 1, 1)", true},
+		{"Color", "1,1,1,1", "gdnative.NewColorRgba(1, 1, 1, 1)", true},
+		{"Transform2D", "((1, 0), (0, 1), (0, 0))", "*gdnative.NewTransform2DAxisOrigin(gdnative.NewVector2(1, 0), gdnative.NewVector2(0, 1), gdnative.NewVector2(0, 0))", true},
+		{"Dictionary", "{}", "", false},
+	}
+
+	for _, test := range tests {
+		arg := GDArgument{Name: "arg", Type: test.argType, DefaultValue: test.value, HasDefaultValue: true}
+		value, ok := view.GoDefaultValue(arg)
+		if value != test.expected || ok != test.ok {
+			t.Errorf("GoDefaultValue(%s %q) = %q, %v; want %q, %v", test.argType, test.value, value, ok, test.expected, test.ok)
+		}
+	}
+
+	if _, ok := view.GoDefaultValue(GDArgument{Name: "arg", Type: "int"}); ok {
+		t.Error("GoDefaultValue returned a value for an argument without a default")
+	}
+}
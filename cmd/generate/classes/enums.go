@@ -0,0 +1,91 @@
+package classes
+
+import (
+	"strings"
+
+	"github.com/pinzolo/casee"
+)
+
+// inferEnumArguments will change the type of method arguments that take enum
+// values. The Godot API only lists enum types for return values, so we infer
+// the argument types from two sources:
+//
+//   - Setters with a matching getter that returns an enum, such as
+//     set_pause_mode and get_pause_mode.
+//   - Arguments that are named after an enum of the class, such as the
+//     edit_state argument of PackedScene.instance and GenEditState.
+func (v View) inferEnumArguments() {
+	for i, api := range v.APIs {
+		enums := v.classEnums(api.Name)
+		for j, method := range api.Methods {
+			for k, arg := range method.Arguments {
+				if arg.Type != "int" {
+					continue
+				}
+				enumType := v.setterEnumType(api.Name, method, k)
+				if enumType == "" {
+					enumType = v.namedEnumType(enums, arg.Name)
+				}
+				if enumType != "" {
+					v.APIs[i].Methods[j].Arguments[k].Type = enumType
+				}
+			}
+		}
+	}
+}
+
+// classEnums will return a map of the enum names of the given class and its
+// parents to their enum type strings.
+func (v View) classEnums(class string) map[string]string {
+	enums := map[string]string{}
+	for class != "" {
+		api, ok := v.findAPI(class)
+		if !ok {
+			break
+		}
+		for _, enum := range api.Enums {
+			if _, ok := enums[enum.Name]; !ok {
+				enums[enum.Name] = "enum." + api.Name + "::" + enum.Name
+			}
+		}
+		class = api.BaseClass
+	}
+
+	return enums
+}
+
+// setterEnumType will return the enum type of the given argument if the
+// method is a setter, the argument is its value, and the getter returns an enum.
+func (v View) setterEnumType(class string, method GDMethod, argIndex int) string {
+	if !strings.HasPrefix(method.Name, "set_") || argIndex != len(method.Arguments)-1 {
+		return ""
+	}
+	name := strings.TrimPrefix(method.Name, "set_")
+	for _, prefix := range []string{"get_", "is_"} {
+		getter, ok := v.findMethod(class, prefix+name)
+		if !ok || len(getter.Arguments) != len(method.Arguments)-1 {
+			continue
+		}
+		if strings.HasPrefix(getter.ReturnType, "enum.") && strings.Contains(getter.ReturnType, "::") {
+			return getter.ReturnType
+		}
+	}
+	return ""
+}
+
+// namedEnumType will return the enum type of an argument that is named after
+// one of the given enums. Arguments with a single word name must match the
+// enum name exactly, otherwise the enum name may have a prefix.
+func (v View) namedEnumType(enums map[string]string, argName string) string {
+	goName := casee.ToPascalCase(argName)
+	found := ""
+	for enumName, enumType := range enums {
+		if enumName == goName || (strings.Contains(argName, "_") && strings.HasSuffix(enumName, goName)) {
+			if found != "" {
+				return ""
+			}
+			found = enumType
+		}
+	}
+	return found
+}
@@ -6,21 +6,19 @@ objects.
 */
 
 import (
-	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"io/ioutil"
 	"log"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
-	"text/template"
 	"unicode"
 
-	"github.com/kr/pretty"
 	"github.com/pinzolo/casee"
+	"github.com/shadowapex/godot-go/cmd/generate/render"
 )
 
 // View is a structure that holds the api classes struct, but has additional methods
@@ -34,6 +32,9 @@ type View struct {
 	ClassDocs    map[string]string
 	MethodDocs   map[string]map[string]string
 	SingletonMap map[string]bool
+	Accessors    map[string][]PropertyAccessor
+	Signals      map[string][]SignalHelper
+	Defaults     map[string][]DefaultsHelper
 }
 
 // ClassDoc returns the class documentation for the given class.
@@ -68,11 +69,14 @@ func (v View) UltraTrim(input string) string {
 	return final
 }
 
+// GetImports will return the import paths of all generated packages that are
+// used by the current API.
 func (v View) GetImports() []string {
 	imports := []string{}
 	for key, _ := range v.Imports[v.API.Name] {
-		imports = append(imports, key)
+		imports = append(imports, v.ImportPath(key))
 	}
+	sort.Strings(imports)
 
 	return imports
 }
@@ -149,6 +153,18 @@ func (v View) GoMethodName(methodString string) string {
 	return methodString
 }
 
+// MethodBindName will return the name of the package variable that holds the
+// cached method bind of the given class method.
+func (v View) MethodBindName(class, method string) string {
+	return "methodBind" + v.GoClassName(class) + v.GoMethodName(method)
+}
+
+// ClassConstructorName will return the name of the package variable that holds
+// the cached class constructor of the given class.
+func (v View) ClassConstructorName(class string) string {
+	return "classConstructor" + v.GoClassName(class)
+}
+
 // GoArgName will check for Go reserved keywords like "type" when used as argument
 // names and convert them, so we don't get compile errors.
 func (v View) GoArgName(argString string) string {
@@ -169,7 +185,14 @@ func (v View) GoArgName(argString string) string {
 		return "intrfce"
 	}
 
-	return casee.ToCamelCase(argString)
+	// Arguments can't have the same name as a package that is used inside
+	// the method.
+	argString = casee.ToCamelCase(argString)
+	if v.Imports[v.API.Name][argString] {
+		return "a" + casee.ToPascalCase(argString)
+	}
+
+	return argString
 }
 
 // GoValue will convert the Godot value into a valid Go value.
@@ -188,7 +211,7 @@ func (v View) GoValue(returnString string) string {
 
 			// Check for certain this is a class enum
 			if v.IsGodotClass(className) {
-				return className + enumName
+				return v.Qualify(className, className+enumName)
 			}
 			return "gdnative." + className + enumName
 		}
@@ -200,7 +223,7 @@ func (v View) GoValue(returnString string) string {
 		return ""
 	}
 	if v.IsGodotClass(returnString) {
-		return returnString
+		return v.Qualify(returnString, returnString)
 	} else {
 		if returnString == "float" {
 			returnString = "real"
@@ -209,6 +232,26 @@ func (v View) GoValue(returnString string) string {
 	}
 }
 
+// GoVariantValue will return the Go expression that converts the given Go
+// argument of the given Godot type into a gdnative Variant.
+func (v View) GoVariantValue(typeString, argString string) string {
+	if v.IsGodotClass(typeString) {
+		return "gdnative.NewVariantObject(" + v.Core("GetBaseObject") + "(" + argString + "))"
+	}
+	if v.IsEnum(typeString) {
+		return "gdnative.NewVariantInt(gdnative.Int64T(" + argString + "))"
+	}
+	switch typeString {
+	case "Variant":
+		return "gdnative.NewVariantCopy(" + argString + ")"
+	case "int":
+		return "gdnative.NewVariantInt(gdnative.Int64T(" + argString + "))"
+	case "float":
+		return "gdnative.NewVariantReal(gdnative.Double(" + argString + "))"
+	}
+	return "gdnative.NewVariant" + strings.TrimPrefix(v.GoValue(typeString), "gdnative.") + "(" + argString + ")"
+}
+
 // IsValidClass will check the class to see if we should generate Go bindings for
 // it.
 func (v View) IsValidClass(classString, inheritsString string) bool {
@@ -252,7 +295,13 @@ func (v View) SetClassName(classString string, singleton bool) string {
 }
 
 func (v View) SetBaseClassName(baseClass string) string {
-	return v.SetClassName(baseClass, v.SingletonMap[baseClass])
+	return v.Qualify(baseClass, v.SetClassName(baseClass, v.SingletonMap[baseClass]))
+}
+
+// BaseImplementer will return the name of the Implementer interface of the
+// given base class.
+func (v View) BaseImplementer(baseClass string) string {
+	return v.Qualify(baseClass, v.GoClassName(baseClass)+"Implementer")
 }
 
 func (v View) PackageName(classString string) string {
@@ -281,12 +330,8 @@ func (v View) ResolvePackage(curPkg, api string) string {
 func (v View) IsGodotClass(str string) bool {
 	str = strings.Replace(str, "*", "", 1)
 	str = strings.TrimSpace(str)
-	for api, _ := range v.PackageMap {
-		if str == api {
-			return true
-		}
-	}
-	return false
+	_, ok := v.PackageMap[str]
+	return ok
 }
 
 // HasParentMethod checks to see if the given method exists in any of its parents.
@@ -318,50 +363,44 @@ func (v View) HasParentMethod(base, method string) bool {
 	return false
 }
 
-func Generate() {
-
-	// Get the GOPATH so we can locate our templates.
-	goPath := os.Getenv("GOPATH")
-	if goPath == "" {
-		panic("$GOPATH is not defined. Run 'export GOPATH=/path/to/go/path' before executing this.")
+// IsReferenceType will return true if the given class inherits from Reference.
+// Reference types are reference counted and freed by Godot automatically.
+func (v View) IsReferenceType(class string) bool {
+	for class != "" {
+		if class == "Reference" {
+			return true
+		}
+		base := ""
+		for _, api := range v.APIs {
+			if api.Name == class {
+				base = api.BaseClass
+				break
+			}
+		}
+		class = base
 	}
-	packagePath := goPath + "/src/github.com/shadowapex/godot-go"
-
-	// Get the docs path so we can parse the documentation.
-	docsPath := packagePath + "/doc/doc/classes"
-	templatePath := packagePath + "/cmd/generate/templates"
-	classPath := packagePath + "/godot"
+	return false
+}
 
-	// Get our documentation that was pulled down from generate.sh.
-	docFiles, err := ioutil.ReadDir(docsPath)
-	if err != nil {
-		panic(err)
+// GoType will return the Go type used for the given Godot type in method
+// arguments and return values. Godot classes are represented by their
+// Implementer interface.
+func (v View) GoType(typeString string) string {
+	if v.IsGodotClass(typeString) {
+		return v.GoValue(typeString) + "Implementer"
 	}
+	return v.GoValue(typeString)
+}
 
-	// Loop through all of the documentation files and parse them. We will use this for
-	// populating the comment strings in classes.go.
-	classDocs := map[string]string{}
-	methodDocs := map[string]map[string]string{}
-	for _, docFile := range docFiles {
-		body, err := ioutil.ReadFile(docsPath + "/" + docFile.Name())
-		if err != nil {
-			panic(err)
-		}
-		var obj GDAPIDoc
-		xml.Unmarshal(body, &obj)
-
-		// Populate our class docs
-		classDocs[obj.Name] = obj.Description
-		methodDocs[obj.Name] = map[string]string{}
-
-		// Populate our method docs
-		for _, method := range obj.Methods {
-			methodDocs[obj.Name][method.Name] = method.Description
-		}
-	}
+// Generate will generate Go wrappers for all of the Godot classes defined in
+// the Godot API JSON.
+func Generate(config render.Config, w *render.Writer) {
+	// Parse the documentation, if we have any. We will use this for
+	// populating the comment strings in the generated classes.
+	classDocs, methodDocs := ParseDocs(config.DocsDir)
 
 	// Open our godot_api.json file
-	body, err := ioutil.ReadFile(templatePath + "/godot_api.json")
+	body, err := ioutil.ReadFile(config.GodotAPI)
 	if err != nil {
 		panic(err)
 	}
@@ -380,6 +419,9 @@ func Generate() {
 		view.SingletonMap[api.Name] = api.Singleton
 	}
 
+	// Use enum types for the method arguments that take enum values.
+	view.inferEnumArguments()
+
 	// Sort the APIs so they will be generated in order.
 	sort.Sort(ByName(view.APIs))
 	for _, api := range view.APIs {
@@ -389,70 +431,15 @@ func Generate() {
 		sort.Sort(BySignalName(api.Signals))
 	}
 
-	// Prefixes contains a list of package prefixes that will be grouped
-	// together if the API starts with this prefix.
-	prefixes := []string{
-		"animation",
-		"audiostream",
-		"arvr",
-		"audioeffect",
-		"bitmap",
-		"bullet",
-		"dynamicfont",
-		"editor",
-		"inputevent",
-		"ip",
-		"physics",
-		"popup",
-		"reference",
-		"regex",
-		"resource",
-		"scenetree",
-		"shader",
-		"slider",
-		"spatial",
-		"streampeer",
-		"stylebox",
-		"texture",
-		"tree",
-		"vehicle",
-		"videostream",
-		"viewport",
-		"visibility",
-		"visualscript",
-		"websocket",
-	}
-
 	// Generate a package lookup table for all APIs.
-	view.PackageMap = map[string]string{}
-	for _, api := range view.APIs {
-		packageName := view.PackageName(api.Name)
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(packageName, prefix) {
-				packageName = prefix
-			}
-		}
-		view.PackageMap[api.Name] = packageName
-	}
+	view.buildPackageMap()
+
+	// Find all of the property accessors, signal helpers and short forms of
+	// methods with default arguments to generate.
+	view.Accessors, view.Signals, view.Defaults = view.buildMembers()
 
 	// Find all of the imports for each API
-	view.Imports = map[string]map[string]bool{}
-	for _, api := range view.APIs {
-		view.Imports[api.Name] = map[string]bool{}
-		for _, method := range api.Methods {
-			for _, arg := range method.Arguments {
-				if view.IsGodotClass(arg.Type) {
-					pkg := view.PackageMap[arg.Type]
-					view.Imports[api.Name][pkg] = true
-				}
-			}
-			if view.IsGodotClass(method.ReturnType) {
-				pkg := view.PackageMap[method.ReturnType]
-				view.Imports[api.Name][pkg] = true
-			}
-		}
-	}
-	pretty.Println(view.Imports)
+	view.buildImports()
 
 	// Loop through all of the APIs and generate packages for them.
 	for _, api := range view.APIs {
@@ -473,79 +460,106 @@ func Generate() {
 		view.Package = packageName
 
 		// Write the file using our template.
+		log.Println("  Generating Go code for:", filepath.Join(packageName, outFileName)+"...")
 		WriteTemplate(
-			packagePath+"/cmd/generate/templates/class.go.tmpl",
-			classPath+"/"+outFileName,
+			w,
+			config.Template("class.go.tmpl"),
+			filepath.Join(view.packageDir(config.GodotOut, packageName), outFileName),
 			view,
 		)
-
-		// Run gofmt on the generated Go file.
-		log.Println("  Running gofmt on output:", outFileName+"...")
-		GoFmt(classPath + "/" + outFileName)
-
-		log.Println("  Running goimports on output:", outFileName+"...")
-		GoImports(classPath + "/" + outFileName)
-
 	}
 
-	// Generate the conversion function to convert based on class name.
+	// Generate the conversion functions to convert based on class name for
+	// each package.
 	log.Println("Generating conversion functions.")
-	outFileName := "convert.gen.go"
+	view.API = GDAPI{}
+	for _, packageName := range append([]string{CorePackage}, view.Subpackages()...) {
+		view.Package = packageName
+		WriteTemplate(
+			w,
+			config.Template("convert.go.tmpl"),
+			filepath.Join(view.packageDir(config.GodotOut, packageName), "convert.gen.go"),
+			view,
+		)
+	}
+
+	// Generate the global constants.
+	log.Println("Generating global constants.")
+	view.Package = CorePackage
 	WriteTemplate(
-		packagePath+"/cmd/generate/templates/convert.go.tmpl",
-		classPath+"/"+outFileName,
+		w,
+		config.Template("globalconstants.go.tmpl"),
+		filepath.Join(config.GodotOut, "globalconstants.gen.go"),
 		view,
 	)
 
-	// Run gofmt and goimports on the conversions
-	log.Println("  Running gofmt on output:", outFileName+"...")
-	GoFmt(classPath + "/" + outFileName)
-
-	log.Println("  Running goimports on output:", outFileName+"...")
-	GoImports(classPath + "/" + outFileName)
+	// Remove any classes that were generated before, but no longer exist in
+	// the API or have moved to a different package.
+	for _, pattern := range []string{"*.gen.go", filepath.Join("*", "*.gen.go")} {
+		if err := w.Clean(config.GodotOut, pattern); err != nil {
+			panic(err)
+		}
+	}
 
 	log.Println(len(view.APIs))
 }
 
-func WriteTemplate(templatePath, outputPath string, view View) {
-	// Create a template from our template file.
-	t, err := template.ParseFiles(templatePath)
-	if err != nil {
-		log.Fatal("Error parsing template:", err)
+// ParseDocs will parse all of the Godot class documentation in the given
+// directory. It returns the class descriptions and the method descriptions by
+// class name. If the directory is empty or does not exist, no documentation
+// will be returned and all classes will be generated as undocumented.
+func ParseDocs(docsPath string) (map[string]string, map[string]map[string]string) {
+	classDocs := map[string]string{}
+	methodDocs := map[string]map[string]string{}
+	if docsPath == "" {
+		return classDocs, methodDocs
 	}
 
-	// Open the output file for writing
-	f, err := os.Create(outputPath)
-	defer f.Close()
+	docFiles, err := ioutil.ReadDir(docsPath)
+	if os.IsNotExist(err) {
+		log.Println("Godot documentation not found in", docsPath+". Generating without documentation...")
+		return classDocs, methodDocs
+	}
 	if err != nil {
 		panic(err)
 	}
 
-	// Write the template with the given view.
-	err = t.Execute(f, view)
-	if err != nil {
-		panic(err)
+	// Loop through all of the documentation files and parse them.
+	for _, docFile := range docFiles {
+		if docFile.IsDir() || filepath.Ext(docFile.Name()) != ".xml" {
+			continue
+		}
+		body, err := ioutil.ReadFile(filepath.Join(docsPath, docFile.Name()))
+		if err != nil {
+			panic(err)
+		}
+		var obj GDAPIDoc
+		xml.Unmarshal(body, &obj)
+
+		// Populate our class docs
+		classDocs[obj.Name] = obj.Description
+		methodDocs[obj.Name] = map[string]string{}
+
+		// Populate our method docs
+		for _, method := range obj.Methods {
+			methodDocs[obj.Name][method.Name] = method.Description
+		}
 	}
+
+	return classDocs, methodDocs
 }
 
-func GoFmt(filePath string) {
-	cmd := exec.Command("gofmt", "-w", filePath)
-	var stdErr bytes.Buffer
-	cmd.Stderr = &stdErr
-	err := cmd.Run()
-	if err != nil {
-		log.Println("Error running gofmt:", err)
-		panic(stdErr.String())
+// packageDir will return the directory of the given package inside the output
+// directory of the core package.
+func (v View) packageDir(godotOut, packageName string) string {
+	if packageName == CorePackage {
+		return godotOut
 	}
+	return filepath.Join(godotOut, packageName)
 }
 
-func GoImports(filePath string) {
-	cmd := exec.Command("goimports", "-w", filePath)
-	var stdErr bytes.Buffer
-	cmd.Stderr = &stdErr
-	err := cmd.Run()
-	if err != nil {
-		log.Println("Error running goimports:", err)
-		panic(stdErr.String())
+func WriteTemplate(w *render.Writer, templatePath, outputPath string, view View) {
+	if err := w.Template(templatePath, outputPath, view); err != nil {
+		panic(err)
 	}
 }
@@ -0,0 +1,32 @@
+package classes
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shadowapex/godot-go/cmd/generate/render"
+)
+
+// TestGenerateGolden renders the fixture API and checks the output against the
+// golden files in testdata. Run `make golden` to update them after changing the
+// generator or its templates.
+func TestGenerateGolden(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	config := render.Config{
+		GodotAPI:     filepath.Join("..", "testdata", "godot_api.json"),
+		DocsDir:      filepath.Join("..", "testdata", "doc", "classes"),
+		TemplatesDir: filepath.Join("..", "templates"),
+		GodotOut:     filepath.Join("..", "testdata", "godot"),
+	}
+	w := render.NewWriter(true)
+	Generate(config, w)
+
+	for _, path := range w.Changed {
+		t.Errorf("%s differs from the generated output; run `make golden` to update it", path)
+	}
+}
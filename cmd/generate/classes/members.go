@@ -0,0 +1,250 @@
+package classes
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pinzolo/casee"
+)
+
+// PropertyAccessor is a class property that we will generate accessor methods
+// for. The accessors call the declared getter and setter of the property.
+type PropertyAccessor struct {
+	Property GDProperty
+	GoName   string
+	Getter   GDMethod
+	Setter   GDMethod
+	Indexed  bool
+
+	// HasSetter is true if we need to generate a setter for the property. This
+	// is false if the property is read-only or the declared setter already has
+	// the accessor's name.
+	HasSetter bool
+}
+
+// SetterValue will return the argument of the setter that holds the property value.
+func (p PropertyAccessor) SetterValue() GDArgument {
+	return p.Setter.Arguments[len(p.Setter.Arguments)-1]
+}
+
+// SignalHelper is a class signal that we will generate a name constant and
+// typed Connect/Emit helper methods for.
+type SignalHelper struct {
+	Signal GDSignal
+	GoName string
+
+	// HasConnect and HasEmit are false if the helper method names would
+	// collide with another method.
+	HasConnect bool
+	HasEmit    bool
+}
+
+// MethodDefaults returns the short forms of the methods with default arguments
+// to generate for the given class.
+func (v View) MethodDefaults(class string) []DefaultsHelper {
+	return v.Defaults[class]
+}
+
+// PropertyAccessors returns the property accessors to generate for the given class.
+func (v View) PropertyAccessors(class string) []PropertyAccessor {
+	return v.Accessors[class]
+}
+
+// ClassSignals returns the signal helpers to generate for the given class.
+func (v View) ClassSignals(class string) []SignalHelper {
+	return v.Signals[class]
+}
+
+// findAPI will return the API with the given name.
+func (v View) findAPI(class string) (GDAPI, bool) {
+	for _, api := range v.APIs {
+		if api.Name == class {
+			return api, true
+		}
+	}
+	return GDAPI{}, false
+}
+
+// findMethod will look up the given method in the class or any of its parents.
+func (v View) findMethod(class, method string) (GDMethod, bool) {
+	for class != "" {
+		api, ok := v.findAPI(class)
+		if !ok {
+			break
+		}
+		for _, m := range api.Methods {
+			if m.Name == method {
+				return m, true
+			}
+		}
+		class = api.BaseClass
+	}
+	return GDMethod{}, false
+}
+
+// memberSet keeps track of the Go member names of every class, so generated
+// helper methods don't collide with methods in the class, its parents or its
+// children. A collision would break embedding and the Implementer interfaces.
+type memberSet struct {
+	view     View
+	members  map[string]map[string]bool
+	children map[string][]string
+}
+
+// newMemberSet will build a member set from all of the class methods.
+func newMemberSet(v View) *memberSet {
+	m := &memberSet{
+		view:     v,
+		members:  map[string]map[string]bool{},
+		children: map[string][]string{},
+	}
+	for _, api := range v.APIs {
+		// Embedded structs are also members of their children.
+		m.members[api.Name] = map[string]bool{v.SetClassName(api.Name, api.Singleton): true}
+		for _, method := range api.Methods {
+			m.members[api.Name][v.GoMethodName(method.Name)] = true
+		}
+		if api.BaseClass != "" {
+			m.children[api.BaseClass] = append(m.children[api.BaseClass], api.Name)
+		}
+	}
+	for _, name := range []string{"BaseClass", "SetBaseObject", "GetBaseObject", "Destroy"} {
+		m.members["Object"][name] = true
+	}
+
+	return m
+}
+
+// add will add the given member name to the class.
+func (m *memberSet) add(class, name string) {
+	m.members[class][name] = true
+}
+
+// collides will check if the given name is used by the class, its parents or
+// its children.
+func (m *memberSet) collides(class, name string) bool {
+	if m.hasChild(class, name) {
+		return true
+	}
+	for class != "" {
+		if m.members[class][name] {
+			return true
+		}
+		api, _ := m.view.findAPI(class)
+		class = api.BaseClass
+	}
+	return false
+}
+
+// hasChild will check if any of the children of the class use the given name.
+func (m *memberSet) hasChild(class, name string) bool {
+	for _, child := range m.children[class] {
+		if m.members[child][name] || m.hasChild(child, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedByDepth will return the APIs sorted by their inheritance depth, so
+// parent classes are always handled before their children.
+func (v View) sortedByDepth() []GDAPI {
+	apis := byDepth{apis: make([]GDAPI, len(v.APIs)), depth: map[string]int{}}
+	copy(apis.apis, v.APIs)
+	for _, api := range v.APIs {
+		class := api.Name
+		for class != "" {
+			base, _ := v.findAPI(class)
+			class = base.BaseClass
+			apis.depth[api.Name]++
+		}
+	}
+	sort.Stable(apis)
+
+	return apis.apis
+}
+
+// buildMembers will find all of the class properties, signals and methods with
+// default arguments that we can generate helpers for.
+func (v View) buildMembers() (map[string][]PropertyAccessor, map[string][]SignalHelper, map[string][]DefaultsHelper) {
+	members := newMemberSet(v)
+	accessors := map[string][]PropertyAccessor{}
+	signals := map[string][]SignalHelper{}
+	defaults := map[string][]DefaultsHelper{}
+
+	for _, api := range v.sortedByDepth() {
+		for _, method := range api.Methods {
+			helper, ok := v.buildDefaultsHelper(method)
+			if ok && !members.collides(api.Name, helper.GoName) {
+				members.add(api.Name, helper.GoName)
+				defaults[api.Name] = append(defaults[api.Name], helper)
+			}
+		}
+		for _, property := range api.Properties {
+			if accessor, ok := v.buildAccessor(members, api, property); ok {
+				accessors[api.Name] = append(accessors[api.Name], accessor)
+			}
+		}
+		for _, signal := range api.Signals {
+			helper := SignalHelper{
+				Signal: signal,
+				GoName: casee.ToPascalCase(signal.Name),
+			}
+			if !members.collides(api.Name, "Connect"+helper.GoName) {
+				helper.HasConnect = true
+				members.add(api.Name, "Connect"+helper.GoName)
+			}
+			if !members.collides(api.Name, "Emit"+helper.GoName) {
+				helper.HasEmit = true
+				members.add(api.Name, "Emit"+helper.GoName)
+			}
+			signals[api.Name] = append(signals[api.Name], helper)
+		}
+	}
+
+	return accessors, signals, defaults
+}
+
+// buildAccessor will return the accessor for the given class property, if we
+// can generate one.
+func (v View) buildAccessor(members *memberSet, api GDAPI, property GDProperty) (PropertyAccessor, bool) {
+	if strings.HasPrefix(property.Name, "_") {
+		return PropertyAccessor{}, false
+	}
+	accessor := PropertyAccessor{
+		Property: property,
+		GoName:   casee.ToPascalCase(strings.Replace(property.Name, "/", "_", -1)),
+		Indexed:  property.Index != -1,
+	}
+
+	// Look up the getter and make sure it takes the number of arguments
+	// that we expect.
+	getter, ok := v.findMethod(api.Name, property.Getter)
+	if !ok || getter.HasVarargs {
+		return PropertyAccessor{}, false
+	}
+	if (accessor.Indexed && len(getter.Arguments) != 1) || (!accessor.Indexed && len(getter.Arguments) != 0) {
+		return PropertyAccessor{}, false
+	}
+	accessor.Getter = getter
+	if members.collides(api.Name, accessor.GoName) {
+		return PropertyAccessor{}, false
+	}
+
+	// Look up the setter if there is one.
+	if setter, ok := v.findMethod(api.Name, property.Setter); ok && !setter.HasVarargs {
+		argCount := 1
+		if accessor.Indexed {
+			argCount = 2
+		}
+		setterName := "Set" + accessor.GoName
+		if len(setter.Arguments) == argCount && v.GoMethodName(setter.Name) != setterName && !members.collides(api.Name, setterName) {
+			accessor.Setter = setter
+			accessor.HasSetter = true
+			members.add(api.Name, setterName)
+		}
+	}
+	members.add(api.Name, accessor.GoName)
+
+	return accessor, true
+}
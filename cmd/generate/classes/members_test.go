@@ -0,0 +1,76 @@
+package classes
+
+import "testing"
+
+func TestBuildMembers(t *testing.T) {
+	view := View{
+		APIs: []GDAPI{
+			{Name: "Object"},
+			{
+				Name:      "Node",
+				BaseClass: "Object",
+				Methods: []GDMethod{
+					{Name: "emit_tree_exited", ReturnType: "void"},
+					{Name: "get_color", ReturnType: "Color", Arguments: []GDArgument{{Name: "name", Type: "String"}}},
+					{Name: "get_name", ReturnType: "String"},
+					{Name: "get_pause_mode", ReturnType: "int"},
+					{Name: "get_tree", ReturnType: "Object"},
+					{Name: "set_name", ReturnType: "void", Arguments: []GDArgument{{Name: "name", Type: "String"}}},
+					{Name: "set_pause", ReturnType: "void", Arguments: []GDArgument{{Name: "mode", Type: "int"}}},
+					{Name: "tree", ReturnType: "void"},
+				},
+				Properties: []GDProperty{
+					{Name: "_import_path", Getter: "get_name", Setter: "set_name", Index: -1},
+					{Name: "custom_colors/font", Getter: "get_color", Index: 0},
+					{Name: "editor_only", Getter: "is_editor_only", Index: -1},
+					{Name: "name", Getter: "get_name", Setter: "set_name", Index: -1},
+					{Name: "pause_mode", Getter: "get_pause_mode", Setter: "set_pause", Index: -1},
+					{Name: "tree", Getter: "get_tree", Index: -1},
+				},
+				Signals: []GDSignal{
+					{Name: "ready"},
+					{Name: "tree_exited"},
+				},
+			},
+		},
+	}
+
+	accessors, signals, _ := view.buildMembers()
+
+	expectedAccessors := []struct {
+		name      string
+		indexed   bool
+		hasSetter bool
+	}{
+		// The declared setter of "name" already has the accessor's name.
+		{"CustomColorsFont", true, false},
+		{"Name", false, false},
+		{"PauseMode", false, true},
+	}
+	if len(accessors["Node"]) != len(expectedAccessors) {
+		t.Fatalf("got %d accessors, want %d: %+v", len(accessors["Node"]), len(expectedAccessors), accessors["Node"])
+	}
+	for i, expected := range expectedAccessors {
+		accessor := accessors["Node"][i]
+		if accessor.GoName != expected.name || accessor.Indexed != expected.indexed || accessor.HasSetter != expected.hasSetter {
+			t.Errorf("accessor %d = %s (indexed %v, setter %v), want %s (indexed %v, setter %v)",
+				i, accessor.GoName, accessor.Indexed, accessor.HasSetter, expected.name, expected.indexed, expected.hasSetter)
+		}
+	}
+
+	expectedSignals := []SignalHelper{
+		{GoName: "Ready", HasConnect: true, HasEmit: true},
+		// EmitTreeExited is already a method of the class.
+		{GoName: "TreeExited", HasConnect: true, HasEmit: false},
+	}
+	if len(signals["Node"]) != len(expectedSignals) {
+		t.Fatalf("got %d signal helpers, want %d", len(signals["Node"]), len(expectedSignals))
+	}
+	for i, expected := range expectedSignals {
+		helper := signals["Node"][i]
+		if helper.GoName != expected.GoName || helper.HasConnect != expected.HasConnect || helper.HasEmit != expected.HasEmit {
+			t.Errorf("signal helper %d = %s (connect %v, emit %v), want %s (connect %v, emit %v)",
+				i, helper.GoName, helper.HasConnect, helper.HasEmit, expected.GoName, expected.HasConnect, expected.HasEmit)
+		}
+	}
+}
@@ -34,6 +34,16 @@ func (c ByName) Len() int           { return len(c) }
 func (c ByName) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
 func (c ByName) Less(i, j int) bool { return c[i].Name < c[j].Name }
 
+// byDepth is used for sorting GDAPI objects by their inheritance depth
+type byDepth struct {
+	apis  []GDAPI
+	depth map[string]int
+}
+
+func (c byDepth) Len() int           { return len(c.apis) }
+func (c byDepth) Swap(i, j int)      { c.apis[i], c.apis[j] = c.apis[j], c.apis[i] }
+func (c byDepth) Less(i, j int) bool { return c.depth[c.apis[i].Name] < c.depth[c.apis[j].Name] }
+
 type GDEnums struct {
 	Name   string           `json:"name"`
 	Values map[string]int64 `json:"values"`
@@ -75,6 +85,7 @@ type GDArgument struct {
 
 type GDProperty struct {
 	Getter string `json:"getter"`
+	Index  int    `json:"index"`
 	Name   string `json:"name"`
 	Setter string `json:"setter"`
 	Type   string `json:"type"`
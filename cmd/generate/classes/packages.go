@@ -0,0 +1,306 @@
+package classes
+
+import (
+	"sort"
+	"strings"
+)
+
+// CorePackage is the name of the package that holds the base classes of the
+// class hierarchy, along with every class that is used by them.
+const CorePackage = "godot"
+
+// CoreImportPath is the import path of the core package. Subpackages are
+// generated in directories below it.
+const CoreImportPath = "github.com/shadowapex/godot-go/godot"
+
+// packagePrefixes contains a list of package prefixes that will be grouped
+// together in their own subpackage if the API starts with this prefix.
+var packagePrefixes = []string{
+	"animation",
+	"audiostream",
+	"arvr",
+	"audioeffect",
+	"bitmap",
+	"bullet",
+	"dynamicfont",
+	"editor",
+	"inputevent",
+	"ip",
+	"physics",
+	"popup",
+	"reference",
+	"regex",
+	"resource",
+	"scenetree",
+	"shader",
+	"slider",
+	"spatial",
+	"streampeer",
+	"stylebox",
+	"texture",
+	"tree",
+	"vehicle",
+	"videostream",
+	"viewport",
+	"visibility",
+	"visualscript",
+	"websocket",
+}
+
+// familyPackages maps base classes to the subpackage that their descendants are
+// generated in, if their name does not start with one of the package prefixes.
+// The closest base class of a class is used, so the order does not matter.
+var familyPackages = []struct{ base, pkg string }{
+	{"Control", "control"},
+	{"CanvasItem", "canvasitem"},
+	{"Spatial", "spatial"},
+	{"Node", "node"},
+	{"Resource", "resource"},
+	{"Reference", "reference"},
+	{"Object", "object"},
+}
+
+// coreClasses are classes that are always generated in the core package, since
+// the handwritten code of the core package uses them.
+var coreClasses = map[string]bool{
+	"Object":          true,
+	"Reference":       true,
+	"NativeScript":    true,
+	"GDNativeLibrary": true,
+}
+
+// familyPackage will return the subpackage of the closest base class of the
+// given class that has one, or the core package if there is none.
+func (v View) familyPackage(class string) string {
+	api, ok := v.findAPI(class)
+	for ok {
+		for _, family := range familyPackages {
+			if api.BaseClass == family.base {
+				return family.pkg
+			}
+		}
+		api, ok = v.findAPI(api.BaseClass)
+	}
+	return CorePackage
+}
+
+// buildPackageMap will generate a package lookup table for all APIs. Classes
+// that start with one of the package prefixes are placed in a subpackage with
+// that name, and all other classes are placed in the subpackage of their family
+// (such as "control" for classes that inherit from Control).
+//
+// Go does not allow import cycles, so the core package can only use its own
+// classes, and subpackages can't depend on each other in a cycle. Classes that
+// would cause a cycle are moved into the core package until there are none left.
+// This leaves the classes that are used across families in the core package,
+// like Node, CanvasItem, Resource and Texture, along with the classes their
+// methods use. Splitting these further would need interfaces in place of the
+// concrete argument and return types of their methods.
+func (v *View) buildPackageMap() {
+	v.PackageMap = map[string]string{}
+	for _, api := range v.APIs {
+		packageName := CorePackage
+		if v.IsValidClass(api.Name, api.BaseClass) {
+			for _, prefix := range packagePrefixes {
+				if strings.HasPrefix(v.PackageName(api.Name), prefix) {
+					packageName = prefix
+				}
+			}
+		}
+		if packageName == CorePackage && v.IsValidClass(api.Name, api.BaseClass) && !coreClasses[api.Name] {
+			packageName = v.familyPackage(api.Name)
+		}
+		v.PackageMap[api.Name] = packageName
+	}
+
+	references := map[string][]string{}
+	for _, api := range v.APIs {
+		references[api.Name] = v.classReferences(api)
+	}
+
+	for v.movePackageCycle(references) {
+	}
+}
+
+// movePackageCycle will find the first class that is used in a way that would
+// cause an import cycle and move it into the package that resolves it. It
+// returns false if there are no import cycles left.
+func (v *View) movePackageCycle(references map[string][]string) bool {
+	dependencies := v.packageDependencies(references)
+
+	for _, api := range v.APIs {
+		pkg := v.PackageMap[api.Name]
+
+		// Singleton structs are not exported, so classes that inherit from a
+		// singleton have to be in the same package.
+		if v.SingletonMap[api.BaseClass] && v.PackageMap[api.BaseClass] != pkg {
+			v.PackageMap[api.Name] = v.PackageMap[api.BaseClass]
+			return true
+		}
+
+		for _, ref := range references[api.Name] {
+			refPkg := v.PackageMap[ref]
+			if refPkg == pkg || refPkg == CorePackage {
+				continue
+			}
+			if pkg == CorePackage || dependsOn(dependencies, refPkg, pkg) {
+				v.PackageMap[ref] = CorePackage
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// packageDependencies will return the subpackages that each subpackage uses.
+func (v *View) packageDependencies(references map[string][]string) map[string]map[string]bool {
+	dependencies := map[string]map[string]bool{}
+	for _, api := range v.APIs {
+		pkg := v.PackageMap[api.Name]
+		for _, ref := range references[api.Name] {
+			refPkg := v.PackageMap[ref]
+			if refPkg == pkg || refPkg == CorePackage {
+				continue
+			}
+			if dependencies[pkg] == nil {
+				dependencies[pkg] = map[string]bool{}
+			}
+			dependencies[pkg][refPkg] = true
+		}
+	}
+
+	return dependencies
+}
+
+// dependsOn will return true if the package from uses the package to, either
+// directly or through other packages.
+func dependsOn(dependencies map[string]map[string]bool, from, to string) bool {
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+		for dep := range dependencies[pkg] {
+			if dep == to {
+				return true
+			}
+			if !visited[dep] {
+				visited[dep] = true
+				queue = append(queue, dep)
+			}
+		}
+	}
+
+	return false
+}
+
+// classReferences will return the sorted names of all other classes that the
+// generated code of the given class uses.
+func (v View) classReferences(api GDAPI) []string {
+	found := map[string]bool{}
+	add := func(typeString string) {
+		if strings.HasPrefix(typeString, "enum.") && strings.Contains(typeString, "::") {
+			typeString = strings.Split(strings.TrimPrefix(typeString, "enum."), "::")[0]
+		}
+		if typeString != api.Name && v.IsGodotClass(typeString) {
+			found[typeString] = true
+		}
+	}
+
+	add(api.BaseClass)
+	for _, method := range api.Methods {
+		add(method.ReturnType)
+		for _, arg := range method.Arguments {
+			add(arg.Type)
+		}
+	}
+	for _, signal := range api.Signals {
+		for _, arg := range signal.Arguments {
+			add(arg.Type)
+		}
+	}
+
+	references := []string{}
+	for class := range found {
+		references = append(references, class)
+	}
+	sort.Strings(references)
+
+	return references
+}
+
+// buildImports will find all of the packages that need to be imported by the
+// generated code of each API.
+func (v *View) buildImports() {
+	v.Imports = map[string]map[string]bool{}
+	for _, api := range v.APIs {
+		pkg := v.PackageMap[api.Name]
+		v.Imports[api.Name] = map[string]bool{}
+		if pkg != CorePackage {
+			v.Imports[api.Name][CorePackage] = true
+		}
+		for _, ref := range v.classReferences(api) {
+			if refPkg := v.PackageMap[ref]; refPkg != pkg {
+				v.Imports[api.Name][refPkg] = true
+			}
+		}
+	}
+}
+
+// Subpackages will return the sorted names of all generated subpackages.
+func (v View) Subpackages() []string {
+	found := map[string]bool{}
+	for _, api := range v.APIs {
+		if pkg := v.PackageMap[api.Name]; pkg != CorePackage {
+			found[pkg] = true
+		}
+	}
+
+	packages := []string{}
+	for pkg := range found {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+
+	return packages
+}
+
+// ImportPath will return the import path of the given generated package.
+func (v View) ImportPath(pkg string) string {
+	if pkg == CorePackage {
+		return CoreImportPath
+	}
+	return CoreImportPath + "/" + pkg
+}
+
+// PackageClasses will return all classes that are generated in the current
+// package.
+func (v View) PackageClasses() []GDAPI {
+	classes := []GDAPI{}
+	for _, api := range v.APIs {
+		if v.PackageMap[api.Name] == v.Package && v.IsValidClass(api.Name, api.BaseClass) {
+			classes = append(classes, api)
+		}
+	}
+
+	return classes
+}
+
+// Qualify will return the given Go name of the given class, prefixed with the
+// package name if the class is generated in a different package.
+func (v View) Qualify(class, name string) string {
+	if pkg, ok := v.PackageMap[class]; ok && pkg != v.Package {
+		return pkg + "." + name
+	}
+	return name
+}
+
+// Core will return the given name of the core package, prefixed with the
+// package name if the current package is a subpackage.
+func (v View) Core(name string) string {
+	if v.Package != CorePackage {
+		return CorePackage + "." + name
+	}
+	return name
+}
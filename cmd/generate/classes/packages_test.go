@@ -0,0 +1,63 @@
+package classes
+
+import "testing"
+
+func TestBuildPackageMap(t *testing.T) {
+	view := View{
+		APIs: []GDAPI{
+			{Name: "@GDScript"},
+			{Name: "Engine", BaseClass: "Object", Singleton: true},
+			{Name: "Node", BaseClass: "Object", Methods: []GDMethod{
+				{Name: "set_resource", ReturnType: "void", Arguments: []GDArgument{{Name: "resource", Type: "Resource"}}},
+			}},
+			{Name: "Object"},
+			{Name: "RegEx", BaseClass: "Reference", Methods: []GDMethod{
+				{Name: "search", ReturnType: "RegExMatch", Arguments: []GDArgument{{Name: "subject", Type: "String"}}},
+			}},
+			{Name: "RegExMatch", BaseClass: "Reference"},
+			{Name: "Reference", BaseClass: "Object"},
+			{Name: "Resource", BaseClass: "Reference", Methods: []GDMethod{
+				{Name: "get_local_scene", ReturnType: "Node"},
+			}},
+			{Name: "Timer", BaseClass: "Node"},
+		},
+		SingletonMap: map[string]bool{"Engine": true},
+	}
+	view.buildPackageMap()
+
+	expected := map[string]string{
+		// Invalid classes and the classes used by handwritten code stay in core.
+		"@GDScript": CorePackage,
+		"Object":    CorePackage,
+		"Reference": CorePackage,
+
+		// Node and Resource use each other, so they are moved into core to
+		// break the import cycle between their family packages.
+		"Node":     CorePackage,
+		"Resource": CorePackage,
+
+		// Prefixed classes get their own package, and the others use the
+		// package of their closest base class.
+		"RegEx":      "regex",
+		"RegExMatch": "regex",
+		"Engine":     "object",
+		"Timer":      "node",
+	}
+	for class, pkg := range expected {
+		if view.PackageMap[class] != pkg {
+			t.Errorf("class %s is in package %q, want %q", class, view.PackageMap[class], pkg)
+		}
+	}
+
+	// The core package can't import any of the subpackages.
+	for _, api := range view.APIs {
+		if view.PackageMap[api.Name] != CorePackage {
+			continue
+		}
+		for _, ref := range view.classReferences(api) {
+			if view.PackageMap[ref] != CorePackage {
+				t.Errorf("core class %s uses %s from package %q", api.Name, ref, view.PackageMap[ref])
+			}
+		}
+	}
+}
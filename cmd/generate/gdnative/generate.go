@@ -6,8 +6,9 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"log"
-	"os"
-	"text/template"
+	"path/filepath"
+
+	"github.com/shadowapex/godot-go/cmd/generate/render"
 )
 
 // View is a structure that holds the api struct, so it can be used inside
@@ -47,20 +48,15 @@ func (v View) HasArgs(args [][]string) bool {
 	return false
 }
 
-func Generate() {
-	// Get the GOPATH so we can locate the godot api JSON.
-	goPath := os.Getenv("GOPATH")
-	if goPath == "" {
-		panic("$GOPATH is not defined. Run 'export GOPATH=/path/to/go/path' before executing this.")
-	}
-	packagePath := goPath + "/src/github.com/shadowapex/godot-go"
-
+// Generate will generate the C bindings for the GDNative core API and all of
+// its extensions.
+func Generate(config render.Config, w *render.Writer) {
 	// Create a structure for our template view. This will contain all of
 	// the data we need to construct our binding methods.
 	var view View
 
 	// Unmarshal the JSON into our struct.
-	apis := Parse(packagePath)
+	apis := Parse(config.GDNativeAPI)
 
 	// Add the core API to our view first
 	view.API = apis.Core
@@ -69,15 +65,17 @@ func Generate() {
 	// Generate the C bindings
 	log.Println("Generating", view.StructType, "C headers...")
 	WriteTemplate(
-		packagePath+"/cmd/generate/templates/gdnative.h.tmpl",
-		packagePath+"/gdnative/gdnative.gen.h",
+		w,
+		config.Template("gdnative.h.tmpl"),
+		filepath.Join(config.GDNativeOut, "gdnative.gen.h"),
 		view,
 	)
 
 	log.Println("Generating", view.StructType, "C bindings...")
 	WriteTemplate(
-		packagePath+"/cmd/generate/templates/gdnative.c.tmpl",
-		packagePath+"/gdnative/gdnative.gen.c",
+		w,
+		config.Template("gdnative.c.tmpl"),
+		filepath.Join(config.GDNativeOut, "gdnative.gen.c"),
 		view,
 	)
 
@@ -88,23 +86,25 @@ func Generate() {
 
 		log.Println("Generating", view.StructType, "C headers...")
 		WriteTemplate(
-			packagePath+"/cmd/generate/templates/gdnative.h.tmpl",
-			packagePath+"/gdnative/"+name+".gen.h",
+			w,
+			config.Template("gdnative.h.tmpl"),
+			filepath.Join(config.GDNativeOut, name+".gen.h"),
 			view,
 		)
 
 		log.Println("Generating", view.StructType, "C bindings...")
 		WriteTemplate(
-			packagePath+"/cmd/generate/templates/gdnative.c.tmpl",
-			packagePath+"/gdnative/"+name+".gen.c",
+			w,
+			config.Template("gdnative.c.tmpl"),
+			filepath.Join(config.GDNativeOut, name+".gen.c"),
 			view,
 		)
 	}
 }
 
-func Parse(packagePath string) APIs {
-	// Open the gdnative_api.json file that defines the GDNative API.
-	body, err := ioutil.ReadFile(packagePath + "/godot_headers/gdnative_api.json")
+// Parse will parse the given gdnative_api.json file that defines the GDNative API.
+func Parse(apiPath string) APIs {
+	body, err := ioutil.ReadFile(apiPath)
 	if err != nil {
 		panic(err)
 	}
@@ -116,23 +116,8 @@ func Parse(packagePath string) APIs {
 	return apis
 }
 
-func WriteTemplate(templatePath, outputPath string, view View) {
-	// Create a template from our template file.
-	t, err := template.ParseFiles(templatePath)
-	if err != nil {
-		log.Fatal("Error parsing template:", err)
-	}
-
-	// Open the output file for writing
-	f, err := os.Create(outputPath)
-	defer f.Close()
-	if err != nil {
-		panic(err)
-	}
-
-	// Write the template with the given view.
-	err = t.Execute(f, view)
-	if err != nil {
+func WriteTemplate(w *render.Writer, templatePath, outputPath string, view View) {
+	if err := w.Template(templatePath, outputPath, view); err != nil {
 		panic(err)
 	}
 }
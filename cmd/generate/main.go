@@ -1,27 +1,63 @@
+// Command generate generates the gdnative and godot packages from the Godot
+// headers, the Godot API JSON and the Godot class documentation.
+//
+// All paths default to their location in this repository, relative to the
+// current directory, so running the command from the repository root will
+// regenerate the bindings in place. With -check, nothing is written; instead
+// the command exits with a non-zero status if any generated file differs from
+// the one on disk.
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+
 	"github.com/shadowapex/godot-go/cmd/generate/classes"
 	"github.com/shadowapex/godot-go/cmd/generate/gdnative"
+	"github.com/shadowapex/godot-go/cmd/generate/render"
 	"github.com/shadowapex/godot-go/cmd/generate/types"
-	"os"
 )
 
 func main() {
-	// Environment variables will tell us if we only want to do one.
-	only := os.Getenv("ONLY")
+	var config render.Config
+	flag.StringVar(&config.HeadersDir, "headers", "godot_headers", "path to the godot_headers directory")
+	flag.StringVar(&config.GDNativeAPI, "gdnative-api", "godot_headers/gdnative_api.json", "path to the GDNative API JSON")
+	flag.StringVar(&config.GodotAPI, "api", "cmd/generate/templates/godot_api.json", "path to the Godot API JSON")
+	flag.StringVar(&config.DocsDir, "docs", "doc/doc/classes", "path to the Godot class documentation (optional)")
+	flag.StringVar(&config.TemplatesDir, "templates", "cmd/generate/templates", "path to the generator templates")
+	flag.StringVar(&config.GDNativeOut, "gdnative-out", "gdnative", "output directory of the gdnative package")
+	flag.StringVar(&config.GodotOut, "godot-out", "godot", "output directory of the godot package")
+
+	// The ONLY environment variable is still supported to pick the default.
+	only := flag.String("only", os.Getenv("ONLY"), "only generate one of: gdnative, types, classes")
+	check := flag.Bool("check", false, "check that the generated files are up to date instead of writing them")
+	flag.Parse()
+
+	w := render.NewWriter(*check)
 
 	// Generate the gdnative bindings
-	switch only {
+	switch *only {
 	case "gdnative":
-		gdnative.Generate()
+		gdnative.Generate(config, w)
 	case "types":
-		types.Generate()
+		types.Generate(config, w)
 	case "classes":
-		classes.Generate()
+		classes.Generate(config, w)
+	case "":
+		gdnative.Generate(config, w)
+		types.Generate(config, w)
+		classes.Generate(config, w)
 	default:
-		gdnative.Generate()
-		types.Generate()
-		classes.Generate()
+		fmt.Fprintf(os.Stderr, "unknown generator %q\n", *only)
+		os.Exit(2)
+	}
+
+	if len(w.Changed) > 0 {
+		fmt.Fprintln(os.Stderr, "The following generated files are out of date:")
+		for _, path := range w.Changed {
+			fmt.Fprintln(os.Stderr, "  "+path)
+		}
+		os.Exit(1)
 	}
 }
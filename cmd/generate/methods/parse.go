@@ -4,23 +4,12 @@ package methods
 
 import (
 	"github.com/shadowapex/godot-go/cmd/generate/gdnative"
-	"os"
 )
 
-// Parse will parse the GDNative headers. Takes a list of headers/structs to ignore.
-// Definitions in the given headers and definitions
-// with the given name will not be added to the returned list of type definitions.
-// We'll need to manually create these structures.
-func Parse() gdnative.APIs {
-
-	// Get the GOPATH so we can locate the godot headers.
-	goPath := os.Getenv("GOPATH")
-	if goPath == "" {
-		panic("$GOPATH is not defined. Run 'export GOPATH=/path/to/go/path' before executing this.")
-	}
-	packagePath := goPath + "/src/github.com/shadowapex/godot-go"
-
+// Parse will parse the given gdnative_api.json file for all of the GDNative
+// method definitions.
+func Parse(apiPath string) gdnative.APIs {
 	// Parse the GDNative JSON for method data.
-	apis := gdnative.Parse(packagePath)
+	apis := gdnative.Parse(apiPath)
 	return apis
 }
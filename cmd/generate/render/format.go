@@ -0,0 +1,114 @@
+package render
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Format will format the given Go source the same way gofmt and goimports
+// would. Unused imports are removed and grouped imports are sorted with the
+// standard library first. Missing imports are not added, so templates need to
+// import every package they might use.
+func Format(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find all of the package names that are referenced in the file.
+	used := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	// Collect the import declarations so they can be rewritten back to front
+	// without invalidating the offsets of earlier declarations.
+	decls := []*ast.GenDecl{}
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			decls = append(decls, gen)
+		}
+	}
+
+	out := src
+	for i := len(decls) - 1; i >= 0; i-- {
+		decl := decls[i]
+		start := fset.Position(decl.Pos()).Offset
+		end := fset.Position(decl.End()).Offset
+
+		var std, other []string
+		for _, spec := range decl.Specs {
+			imp := spec.(*ast.ImportSpec)
+			importPath, _ := strconv.Unquote(imp.Path.Value)
+			name := path.Base(importPath)
+			line := imp.Path.Value
+			if imp.Name != nil {
+				name = imp.Name.Name
+				line = name + " " + line
+			}
+			if name != "_" && name != "." && name != "C" && !used[name] {
+				continue
+			}
+			if strings.Contains(strings.SplitN(importPath, "/", 2)[0], ".") {
+				other = append(other, line)
+			} else {
+				std = append(std, line)
+			}
+		}
+
+		// Leave single imports alone unless they are unused, so the cgo
+		// preamble stays attached to `import "C"`.
+		if !decl.Lparen.IsValid() && len(std)+len(other) > 0 {
+			continue
+		}
+
+		sort.Strings(std)
+		sort.Strings(other)
+
+		var buf bytes.Buffer
+		buf.Write(out[:start])
+		if len(std)+len(other) > 0 {
+			buf.WriteString("import (\n")
+			for _, line := range std {
+				buf.WriteString("\t" + line + "\n")
+			}
+			if len(std) > 0 && len(other) > 0 {
+				buf.WriteString("\n")
+			}
+			for _, line := range other {
+				buf.WriteString("\t" + line + "\n")
+			}
+			buf.WriteString(")")
+		}
+		buf.Write(out[end:])
+		out = buf.Bytes()
+	}
+
+	// Indented block comments are shifted on every pass until they line up
+	// with the surrounding code, so keep formatting until the output settles.
+	for i := 0; i < 3; i++ {
+		formatted, err := format.Source(out)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(formatted, out) {
+			break
+		}
+		out = formatted
+	}
+
+	return out, nil
+}
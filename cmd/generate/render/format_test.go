@@ -0,0 +1,54 @@
+package render
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			name:     "gofmt",
+			src:      "package a\nfunc  f( ) {\nreturn\n}\n",
+			expected: "package a\n\nfunc f() {\n\treturn\n}\n",
+		},
+		{
+			name: "unused imports are removed and the rest sorted",
+			src: "package a\n\nimport (\n\t\"github.com/shadowapex/godot-go/gdnative\"\n\t\"strings\"\n\t\"fmt\"\n\t\"log\"\n)\n\n" +
+				"var _ = fmt.Sprint(strings.ToLower(\"\"), gdnative.Int(0))\n",
+			expected: "package a\n\nimport (\n\t\"fmt\"\n\t\"strings\"\n\n\t\"github.com/shadowapex/godot-go/gdnative\"\n)\n\n" +
+				"var _ = fmt.Sprint(strings.ToLower(\"\"), gdnative.Int(0))\n",
+		},
+		{
+			name:     "all imports unused",
+			src:      "package a\n\nimport (\n\t\"fmt\"\n)\n\nvar x = 1\n",
+			expected: "package a\n\nvar x = 1\n",
+		},
+		{
+			name:     "cgo import is kept",
+			src:      "package a\n\n// #include <stdlib.h>\nimport \"C\"\n\nvar x = 1\n",
+			expected: "package a\n\n// #include <stdlib.h>\nimport \"C\"\n\nvar x = 1\n",
+		},
+		{
+			name:     "named imports",
+			src:      "package a\n\nimport (\n\tgd \"github.com/shadowapex/godot-go/gdnative\"\n\tunused \"fmt\"\n)\n\nvar x gd.Int\n",
+			expected: "package a\n\nimport (\n\tgd \"github.com/shadowapex/godot-go/gdnative\"\n)\n\nvar x gd.Int\n",
+		},
+	}
+
+	for _, test := range tests {
+		out, err := Format([]byte(test.src))
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if string(out) != test.expected {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.name, out, test.expected)
+		}
+	}
+
+	if _, err := Format([]byte("package a\nfunc {")); err == nil {
+		t.Error("Format did not return an error for invalid source")
+	}
+}
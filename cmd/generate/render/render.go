@@ -0,0 +1,132 @@
+// Package render executes the generator templates and writes their output. Go
+// files are formatted in-process, so no external tools like gofmt or
+// goimports are required to regenerate the bindings.
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Config holds all of the input and output paths used by the generator.
+type Config struct {
+	// HeadersDir is the path to the godot_headers directory.
+	HeadersDir string
+
+	// GDNativeAPI is the path to the gdnative_api.json file.
+	GDNativeAPI string
+
+	// GodotAPI is the path to the godot_api.json file generated by Godot.
+	GodotAPI string
+
+	// DocsDir is the path to the Godot class documentation. If it is empty or
+	// does not exist, the classes will be generated without documentation.
+	DocsDir string
+
+	// TemplatesDir is the path to the generator templates.
+	TemplatesDir string
+
+	// GDNativeOut is the directory the gdnative package is generated into.
+	GDNativeOut string
+
+	// GodotOut is the directory the godot package is generated into.
+	GodotOut string
+}
+
+// Template returns the path of the template with the given file name.
+func (c Config) Template(name string) string {
+	return filepath.Join(c.TemplatesDir, name)
+}
+
+// Writer writes generated files to disk. In check mode, files are not
+// written; instead, any file that differs from the generated output is
+// recorded in Changed.
+type Writer struct {
+	Check   bool
+	Changed []string
+
+	written map[string]bool
+}
+
+// NewWriter will return a new Writer. If check is true, generated output will
+// be compared against the existing files instead of being written.
+func NewWriter(check bool) *Writer {
+	return &Writer{Check: check, written: map[string]bool{}}
+}
+
+// Template executes the given template with the given view and writes the
+// result to outputPath. Output with a ".go" extension will be formatted.
+func (w *Writer) Template(templatePath, outputPath string, view interface{}) error {
+	t, err := template.ParseFiles(templatePath)
+	if err != nil {
+		return fmt.Errorf("error parsing template %s: %s", templatePath, err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, view); err != nil {
+		return fmt.Errorf("error executing template %s: %s", templatePath, err)
+	}
+
+	src := buf.Bytes()
+	if strings.HasSuffix(outputPath, ".go") {
+		src, err = Format(src)
+		if err != nil {
+			return fmt.Errorf("error formatting %s: %s", outputPath, err)
+		}
+	}
+
+	return w.Write(outputPath, src)
+}
+
+// Write writes the given source to outputPath, or compares it against the
+// existing file in check mode.
+func (w *Writer) Write(outputPath string, src []byte) error {
+	w.written[filepath.Clean(outputPath)] = true
+
+	if w.Check {
+		existing, err := ioutil.ReadFile(outputPath)
+		if err != nil || !bytes.Equal(existing, src) {
+			w.Changed = append(w.Changed, outputPath)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputPath, src, 0644)
+}
+
+// Clean removes all files in dir that match the given pattern but were not
+// written by this Writer. In check mode, those files are recorded in Changed
+// instead.
+func (w *Writer) Clean(dir, pattern string) error {
+	matches, err := filepath.Glob(filepath.Join(dir, pattern))
+	if err != nil {
+		return err
+	}
+	sort.Strings(matches)
+
+	for _, match := range matches {
+		if w.written[filepath.Clean(match)] {
+			continue
+		}
+		if w.Check {
+			w.Changed = append(w.Changed, match)
+			continue
+		}
+		log.Println("  Removing stale file:", match+"...")
+		if err := os.Remove(match); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// baseClasses is a lookup table of the base class of every Godot class. It is
+// used to find the closest class that has a registered constructor.
+var baseClasses = map[string]string{
+	"InputEvent":       "Resource",
+	"InputEventAction": "InputEvent",
+	"Node":             "Object",
+	"Object":           "",
+	"PackedScene":      "Resource",
+	"Reference":        "Object",
+	"RegEx":            "Reference",
+	"RegExMatch":       "Reference",
+	"Resource":         "Reference",
+	"Timer":            "Node",
+	"_Engine":          "Object",
+}
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	RegisterObjectConstructor("InputEvent", func(obj gdnative.Object) ObjectImplementer {
+		class := &InputEvent{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	RegisterObjectConstructor("Node", func(obj gdnative.Object) ObjectImplementer {
+		class := &Node{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	RegisterObjectConstructor("Object", func(obj gdnative.Object) ObjectImplementer {
+		class := &Object{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	RegisterObjectConstructor("Reference", func(obj gdnative.Object) ObjectImplementer {
+		class := &Reference{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	RegisterObjectConstructor("Resource", func(obj gdnative.Object) ObjectImplementer {
+		class := &Resource{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
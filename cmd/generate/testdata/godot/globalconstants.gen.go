@@ -0,0 +1,279 @@
+package godot
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "globalconstants.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// Margin is an enum for the global Margin values.
+type Margin int
+
+// Margin values from the global constants.
+const (
+	MarginBottom Margin = 3
+	MarginLeft   Margin = 0
+	MarginRight  Margin = 2
+	MarginTop    Margin = 1
+)
+
+// String will return the Godot name of the Margin value.
+func (e Margin) String() string {
+	switch e {
+	case MarginBottom:
+		return "MARGIN_BOTTOM"
+	case MarginLeft:
+		return "MARGIN_LEFT"
+	case MarginRight:
+		return "MARGIN_RIGHT"
+	case MarginTop:
+		return "MARGIN_TOP"
+	}
+	return fmt.Sprintf("Margin(%d)", int(e))
+}
+
+// ParseMargin will return the Margin value with the given Godot name.
+func ParseMargin(name string) (Margin, error) {
+	switch name {
+	case "MARGIN_BOTTOM":
+		return MarginBottom, nil
+	case "MARGIN_LEFT":
+		return MarginLeft, nil
+	case "MARGIN_RIGHT":
+		return MarginRight, nil
+	case "MARGIN_TOP":
+		return MarginTop, nil
+	}
+	return 0, fmt.Errorf("invalid Margin value %q", name)
+}
+
+// Orientation is an enum for the global Orientation values.
+type Orientation int
+
+// Orientation values from the global constants.
+const (
+	Horizontal Orientation = 0
+	Vertical   Orientation = 1
+)
+
+// String will return the Godot name of the Orientation value.
+func (e Orientation) String() string {
+	switch e {
+	case Horizontal:
+		return "HORIZONTAL"
+	case Vertical:
+		return "VERTICAL"
+	}
+	return fmt.Sprintf("Orientation(%d)", int(e))
+}
+
+// ParseOrientation will return the Orientation value with the given Godot name.
+func ParseOrientation(name string) (Orientation, error) {
+	switch name {
+	case "HORIZONTAL":
+		return Horizontal, nil
+	case "VERTICAL":
+		return Vertical, nil
+	}
+	return 0, fmt.Errorf("invalid Orientation value %q", name)
+}
+
+// KeyModifierMask is an enum for the global KeyModifierMask values.
+type KeyModifierMask int
+
+// KeyModifierMask values from the global constants.
+const (
+	KeyCodeMask  KeyModifierMask = 33554431
+	KeyMaskCtrl  KeyModifierMask = 268435456
+	KeyMaskShift KeyModifierMask = 33554432
+)
+
+// String will return the Godot name of the KeyModifierMask value.
+func (e KeyModifierMask) String() string {
+	switch e {
+	case KeyCodeMask:
+		return "KEY_CODE_MASK"
+	case KeyMaskCtrl:
+		return "KEY_MASK_CTRL"
+	case KeyMaskShift:
+		return "KEY_MASK_SHIFT"
+	}
+	return fmt.Sprintf("KeyModifierMask(%d)", int(e))
+}
+
+// ParseKeyModifierMask will return the KeyModifierMask value with the given Godot name.
+func ParseKeyModifierMask(name string) (KeyModifierMask, error) {
+	switch name {
+	case "KEY_CODE_MASK":
+		return KeyCodeMask, nil
+	case "KEY_MASK_CTRL":
+		return KeyMaskCtrl, nil
+	case "KEY_MASK_SHIFT":
+		return KeyMaskShift, nil
+	}
+	return 0, fmt.Errorf("invalid KeyModifierMask value %q", name)
+}
+
+// KeyList is an enum for the global KeyList values.
+type KeyList int
+
+// KeyList values from the global constants.
+const (
+	KeyEnter  KeyList = 16777221
+	KeyEscape KeyList = 16777217
+)
+
+// String will return the Godot name of the KeyList value.
+func (e KeyList) String() string {
+	switch e {
+	case KeyEnter:
+		return "KEY_ENTER"
+	case KeyEscape:
+		return "KEY_ESCAPE"
+	}
+	return fmt.Sprintf("KeyList(%d)", int(e))
+}
+
+// ParseKeyList will return the KeyList value with the given Godot name.
+func ParseKeyList(name string) (KeyList, error) {
+	switch name {
+	case "KEY_ENTER":
+		return KeyEnter, nil
+	case "KEY_ESCAPE":
+		return KeyEscape, nil
+	}
+	return 0, fmt.Errorf("invalid KeyList value %q", name)
+}
+
+// ButtonList is an enum for the global ButtonList values.
+type ButtonList int
+
+// ButtonList values from the global constants.
+const (
+	ButtonLeft  ButtonList = 1
+	ButtonRight ButtonList = 2
+)
+
+// String will return the Godot name of the ButtonList value.
+func (e ButtonList) String() string {
+	switch e {
+	case ButtonLeft:
+		return "BUTTON_LEFT"
+	case ButtonRight:
+		return "BUTTON_RIGHT"
+	}
+	return fmt.Sprintf("ButtonList(%d)", int(e))
+}
+
+// ParseButtonList will return the ButtonList value with the given Godot name.
+func ParseButtonList(name string) (ButtonList, error) {
+	switch name {
+	case "BUTTON_LEFT":
+		return ButtonLeft, nil
+	case "BUTTON_RIGHT":
+		return ButtonRight, nil
+	}
+	return 0, fmt.Errorf("invalid ButtonList value %q", name)
+}
+
+// Error values from the global constants.
+const (
+	ErrUnavailable gdnative.Error = 2
+	Failed         gdnative.Error = 1
+	Ok             gdnative.Error = 0
+)
+
+// PropertyHint values from the global constants.
+const (
+	PropertyHintNone  gdnative.PropertyHint = 0
+	PropertyHintRange gdnative.PropertyHint = 1
+)
+
+// PropertyUsageFlags values from the global constants.
+const (
+	PropertyUsageEditor  gdnative.PropertyUsageFlags = 2
+	PropertyUsageStorage gdnative.PropertyUsageFlags = 1
+)
+
+// MethodFlags is an enum for the global MethodFlags values.
+type MethodFlags int
+
+// MethodFlags values from the global constants.
+const (
+	MethodFlagsDefault MethodFlags = 1
+	MethodFlagNormal   MethodFlags = 1
+)
+
+// String will return the Godot name of the MethodFlags value.
+func (e MethodFlags) String() string {
+	switch e {
+	case MethodFlagsDefault:
+		return "METHOD_FLAGS_DEFAULT"
+	}
+	return fmt.Sprintf("MethodFlags(%d)", int(e))
+}
+
+// ParseMethodFlags will return the MethodFlags value with the given Godot name.
+func ParseMethodFlags(name string) (MethodFlags, error) {
+	switch name {
+	case "METHOD_FLAGS_DEFAULT":
+		return MethodFlagsDefault, nil
+	case "METHOD_FLAG_NORMAL":
+		return MethodFlagNormal, nil
+	}
+	return 0, fmt.Errorf("invalid MethodFlags value %q", name)
+}
+
+// VariantType values from the global constants.
+const (
+	TypeBool gdnative.VariantType = 1
+	TypeInt  gdnative.VariantType = 2
+	TypeNil  gdnative.VariantType = 0
+)
+
+// VariantOperator values from the global constants.
+const (
+	OpAdd   gdnative.VariantOperator = 6
+	OpEqual gdnative.VariantOperator = 0
+)
+
+// GlobalConstantsLookupMap is a lookup table of all the global constant values,
+// using their Godot names as keys.
+var GlobalConstantsLookupMap = map[string]int64{
+	"MARGIN_BOTTOM":          3,
+	"MARGIN_LEFT":            0,
+	"MARGIN_RIGHT":           2,
+	"MARGIN_TOP":             1,
+	"HORIZONTAL":             0,
+	"VERTICAL":               1,
+	"KEY_CODE_MASK":          33554431,
+	"KEY_MASK_CTRL":          268435456,
+	"KEY_MASK_SHIFT":         33554432,
+	"KEY_ENTER":              16777221,
+	"KEY_ESCAPE":             16777217,
+	"BUTTON_LEFT":            1,
+	"BUTTON_RIGHT":           2,
+	"ERR_UNAVAILABLE":        2,
+	"FAILED":                 1,
+	"OK":                     0,
+	"PROPERTY_HINT_NONE":     0,
+	"PROPERTY_HINT_RANGE":    1,
+	"PROPERTY_USAGE_EDITOR":  2,
+	"PROPERTY_USAGE_STORAGE": 1,
+	"METHOD_FLAGS_DEFAULT":   1,
+	"METHOD_FLAG_NORMAL":     1,
+	"TYPE_BOOL":              1,
+	"TYPE_INT":               2,
+	"TYPE_NIL":               0,
+	"OP_ADD":                 6,
+	"OP_EQUAL":               0,
+}
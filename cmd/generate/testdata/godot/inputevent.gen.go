@@ -0,0 +1,366 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type InputEvent struct {
+	Resource
+	owner gdnative.Object
+}
+
+func (o *InputEvent) BaseClass() string {
+	return "InputEvent"
+}
+
+// Method binds of the InputEvent class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventActionMatch      = gdnative.NewLazyMethodBind("InputEvent", "action_match")
+	methodBindInputEventAsText           = gdnative.NewLazyMethodBind("InputEvent", "as_text")
+	methodBindInputEventGetDevice        = gdnative.NewLazyMethodBind("InputEvent", "get_device")
+	methodBindInputEventIsAction         = gdnative.NewLazyMethodBind("InputEvent", "is_action")
+	methodBindInputEventIsActionPressed  = gdnative.NewLazyMethodBind("InputEvent", "is_action_pressed")
+	methodBindInputEventIsActionReleased = gdnative.NewLazyMethodBind("InputEvent", "is_action_released")
+	methodBindInputEventIsActionType     = gdnative.NewLazyMethodBind("InputEvent", "is_action_type")
+	methodBindInputEventIsEcho           = gdnative.NewLazyMethodBind("InputEvent", "is_echo")
+	methodBindInputEventIsPressed        = gdnative.NewLazyMethodBind("InputEvent", "is_pressed")
+	methodBindInputEventSetDevice        = gdnative.NewLazyMethodBind("InputEvent", "set_device")
+	methodBindInputEventShortcutMatch    = gdnative.NewLazyMethodBind("InputEvent", "shortcut_match")
+	methodBindInputEventXformedBy        = gdnative.NewLazyMethodBind("InputEvent", "xformed_by")
+)
+
+/*
+	        Undocumented
+		Args: [{ false event InputEvent}], Returns: bool
+*/
+func (o *InputEvent) ActionMatch(event InputEventImplementer) gdnative.Bool {
+	//log.Println("Calling InputEvent.ActionMatch()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
+
+	// Get the method bind
+	methodBind := methodBindInputEventActionMatch.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *InputEvent) AsText() gdnative.String {
+	//log.Println("Calling InputEvent.AsText()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindInputEventAsText.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *InputEvent) GetDevice() gdnative.Int {
+	//log.Println("Calling InputEvent.GetDevice()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindInputEventGetDevice.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false action String}], Returns: bool
+*/
+func (o *InputEvent) IsAction(action gdnative.String) gdnative.Bool {
+	//log.Println("Calling InputEvent.IsAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(action)
+
+	// Get the method bind
+	methodBind := methodBindInputEventIsAction.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false action String}], Returns: bool
+*/
+func (o *InputEvent) IsActionPressed(action gdnative.String) gdnative.Bool {
+	//log.Println("Calling InputEvent.IsActionPressed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(action)
+
+	// Get the method bind
+	methodBind := methodBindInputEventIsActionPressed.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false action String}], Returns: bool
+*/
+func (o *InputEvent) IsActionReleased(action gdnative.String) gdnative.Bool {
+	//log.Println("Calling InputEvent.IsActionReleased()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(action)
+
+	// Get the method bind
+	methodBind := methodBindInputEventIsActionReleased.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *InputEvent) IsActionType() gdnative.Bool {
+	//log.Println("Calling InputEvent.IsActionType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindInputEventIsActionType.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *InputEvent) IsEcho() gdnative.Bool {
+	//log.Println("Calling InputEvent.IsEcho()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindInputEventIsEcho.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *InputEvent) IsPressed() gdnative.Bool {
+	//log.Println("Calling InputEvent.IsPressed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindInputEventIsPressed.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false device int}], Returns: void
+*/
+func (o *InputEvent) SetDevice(device gdnative.Int) {
+	//log.Println("Calling InputEvent.SetDevice()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(device)
+
+	// Get the method bind
+	methodBind := methodBindInputEventSetDevice.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false event InputEvent}], Returns: bool
+*/
+func (o *InputEvent) ShortcutMatch(event InputEventImplementer) gdnative.Bool {
+	//log.Println("Calling InputEvent.ShortcutMatch()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
+
+	// Get the method bind
+	methodBind := methodBindInputEventShortcutMatch.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false xform Transform2D} {(0, 0) true local_ofs Vector2}], Returns: InputEvent
+*/
+func (o *InputEvent) XformedBy(xform gdnative.Transform2D, localOfs gdnative.Vector2) InputEventImplementer {
+	//log.Println("Calling InputEvent.XformedBy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromTransform2D(xform)
+	ptrArguments[1] = gdnative.NewPointerFromVector2(localOfs)
+
+	// Get the method bind
+	methodBind := methodBindInputEventXformedBy.Get()
+
+	// Call the parent method.
+	// InputEvent
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := InputEvent{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(InputEventImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+// XformedByWithDefaults will call XformedBy using the default values for: local_ofs.
+func (o *InputEvent) XformedByWithDefaults(xform gdnative.Transform2D) InputEventImplementer {
+	return o.XformedBy(xform, gdnative.NewVector2(0, 0))
+}
+
+// Device will return the value of the "device" property.
+func (o *InputEvent) Device() gdnative.Int {
+	return o.GetDevice()
+}
+
+// InputEventImplementer is an interface that implements the methods
+// of the InputEvent class.
+type InputEventImplementer interface {
+	ResourceImplementer
+	ActionMatch(event InputEventImplementer) gdnative.Bool
+	AsText() gdnative.String
+	GetDevice() gdnative.Int
+	IsAction(action gdnative.String) gdnative.Bool
+	IsActionPressed(action gdnative.String) gdnative.Bool
+	IsActionReleased(action gdnative.String) gdnative.Bool
+	IsActionType() gdnative.Bool
+	IsEcho() gdnative.Bool
+	IsPressed() gdnative.Bool
+	SetDevice(device gdnative.Int)
+	ShortcutMatch(event InputEventImplementer) gdnative.Bool
+	XformedBy(xform gdnative.Transform2D, localOfs gdnative.Vector2) InputEventImplementer
+	XformedByWithDefaults(xform gdnative.Transform2D) InputEventImplementer
+	Device() gdnative.Int
+}
@@ -0,0 +1,132 @@
+package inputevent
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type InputEventAction struct {
+	godot.InputEvent
+	owner gdnative.Object
+}
+
+// classConstructorInputEventAction is the class constructor of the InputEventAction class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventAction = gdnative.NewLazyClassConstructor("InputEventAction")
+
+// NewInputEventAction will create a new instance of the InputEventAction class.
+// InputEventAction is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventAction() *InputEventAction {
+	obj := &InputEventAction{}
+	obj.SetBaseObject(classConstructorInputEventAction.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *InputEventAction) BaseClass() string {
+	return "InputEventAction"
+}
+
+// Method binds of the InputEventAction class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventActionGetAction  = gdnative.NewLazyMethodBind("InputEventAction", "get_action")
+	methodBindInputEventActionSetAction  = gdnative.NewLazyMethodBind("InputEventAction", "set_action")
+	methodBindInputEventActionSetPressed = gdnative.NewLazyMethodBind("InputEventAction", "set_pressed")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *InputEventAction) GetAction() gdnative.String {
+	//log.Println("Calling InputEventAction.GetAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindInputEventActionGetAction.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false action String}], Returns: void
+*/
+func (o *InputEventAction) SetAction(action gdnative.String) {
+	//log.Println("Calling InputEventAction.SetAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(action)
+
+	// Get the method bind
+	methodBind := methodBindInputEventActionSetAction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false pressed bool}], Returns: void
+*/
+func (o *InputEventAction) SetPressed(pressed gdnative.Bool) {
+	//log.Println("Calling InputEventAction.SetPressed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(pressed)
+
+	// Get the method bind
+	methodBind := methodBindInputEventActionSetPressed.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Action will return the value of the "action" property.
+func (o *InputEventAction) Action() gdnative.String {
+	return o.GetAction()
+}
+
+// InputEventActionImplementer is an interface that implements the methods
+// of the InputEventAction class.
+type InputEventActionImplementer interface {
+	godot.InputEventImplementer
+	GetAction() gdnative.String
+	SetAction(action gdnative.String)
+	SetPressed(pressed gdnative.Bool)
+	Action() gdnative.String
+}
@@ -0,0 +1,988 @@
+package godot
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// NodeDuplicateFlags is an enum for DuplicateFlags values.
+type NodeDuplicateFlags int
+
+const (
+	NodeDuplicateGroups        NodeDuplicateFlags = 2
+	NodeDuplicateScripts       NodeDuplicateFlags = 4
+	NodeDuplicateSignals       NodeDuplicateFlags = 1
+	NodeDuplicateUseInstancing NodeDuplicateFlags = 8
+)
+
+// String will return the Godot name of the NodeDuplicateFlags value.
+func (e NodeDuplicateFlags) String() string {
+	switch e {
+	case NodeDuplicateGroups:
+		return "DUPLICATE_GROUPS"
+	case NodeDuplicateScripts:
+		return "DUPLICATE_SCRIPTS"
+	case NodeDuplicateSignals:
+		return "DUPLICATE_SIGNALS"
+	case NodeDuplicateUseInstancing:
+		return "DUPLICATE_USE_INSTANCING"
+	}
+	return fmt.Sprintf("NodeDuplicateFlags(%d)", int(e))
+}
+
+// ParseNodeDuplicateFlags will return the NodeDuplicateFlags value with the given Godot name.
+func ParseNodeDuplicateFlags(name string) (NodeDuplicateFlags, error) {
+	switch name {
+	case "DUPLICATE_GROUPS":
+		return NodeDuplicateGroups, nil
+	case "DUPLICATE_SCRIPTS":
+		return NodeDuplicateScripts, nil
+	case "DUPLICATE_SIGNALS":
+		return NodeDuplicateSignals, nil
+	case "DUPLICATE_USE_INSTANCING":
+		return NodeDuplicateUseInstancing, nil
+	}
+	return 0, fmt.Errorf("invalid NodeDuplicateFlags value %q", name)
+}
+
+// NodePauseMode is an enum for PauseMode values.
+type NodePauseMode int
+
+const (
+	NodePauseModeInherit NodePauseMode = 0
+	NodePauseModeProcess NodePauseMode = 2
+	NodePauseModeStop    NodePauseMode = 1
+)
+
+// String will return the Godot name of the NodePauseMode value.
+func (e NodePauseMode) String() string {
+	switch e {
+	case NodePauseModeInherit:
+		return "PAUSE_MODE_INHERIT"
+	case NodePauseModeProcess:
+		return "PAUSE_MODE_PROCESS"
+	case NodePauseModeStop:
+		return "PAUSE_MODE_STOP"
+	}
+	return fmt.Sprintf("NodePauseMode(%d)", int(e))
+}
+
+// ParseNodePauseMode will return the NodePauseMode value with the given Godot name.
+func ParseNodePauseMode(name string) (NodePauseMode, error) {
+	switch name {
+	case "PAUSE_MODE_INHERIT":
+		return NodePauseModeInherit, nil
+	case "PAUSE_MODE_PROCESS":
+		return NodePauseModeProcess, nil
+	case "PAUSE_MODE_STOP":
+		return NodePauseModeStop, nil
+	}
+	return 0, fmt.Errorf("invalid NodePauseMode value %q", name)
+}
+
+// NodeRPCMode is an enum for RPCMode values.
+type NodeRPCMode int
+
+const (
+	NodeRpcModeDisabled NodeRPCMode = 0
+	NodeRpcModeMaster   NodeRPCMode = 3
+	NodeRpcModeRemote   NodeRPCMode = 1
+	NodeRpcModeSlave    NodeRPCMode = 4
+	NodeRpcModeSync     NodeRPCMode = 2
+)
+
+// String will return the Godot name of the NodeRPCMode value.
+func (e NodeRPCMode) String() string {
+	switch e {
+	case NodeRpcModeDisabled:
+		return "RPC_MODE_DISABLED"
+	case NodeRpcModeMaster:
+		return "RPC_MODE_MASTER"
+	case NodeRpcModeRemote:
+		return "RPC_MODE_REMOTE"
+	case NodeRpcModeSlave:
+		return "RPC_MODE_SLAVE"
+	case NodeRpcModeSync:
+		return "RPC_MODE_SYNC"
+	}
+	return fmt.Sprintf("NodeRPCMode(%d)", int(e))
+}
+
+// ParseNodeRPCMode will return the NodeRPCMode value with the given Godot name.
+func ParseNodeRPCMode(name string) (NodeRPCMode, error) {
+	switch name {
+	case "RPC_MODE_DISABLED":
+		return NodeRpcModeDisabled, nil
+	case "RPC_MODE_MASTER":
+		return NodeRpcModeMaster, nil
+	case "RPC_MODE_REMOTE":
+		return NodeRpcModeRemote, nil
+	case "RPC_MODE_SLAVE":
+		return NodeRpcModeSlave, nil
+	case "RPC_MODE_SYNC":
+		return NodeRpcModeSync, nil
+	}
+	return 0, fmt.Errorf("invalid NodeRPCMode value %q", name)
+}
+
+// Constants of the Node class.
+const (
+	NodeNotificationDragBegin              gdnative.Int = 21
+	NodeNotificationDragEnd                gdnative.Int = 22
+	NodeNotificationEnterTree              gdnative.Int = 10
+	NodeNotificationExitTree               gdnative.Int = 11
+	NodeNotificationInstanced              gdnative.Int = 20
+	NodeNotificationInternalPhysicsProcess gdnative.Int = 26
+	NodeNotificationInternalProcess        gdnative.Int = 25
+	NodeNotificationMovedInParent          gdnative.Int = 12
+	NodeNotificationParented               gdnative.Int = 18
+	NodeNotificationPathChanged            gdnative.Int = 23
+	NodeNotificationPaused                 gdnative.Int = 14
+	NodeNotificationPhysicsProcess         gdnative.Int = 16
+	NodeNotificationProcess                gdnative.Int = 17
+	NodeNotificationReady                  gdnative.Int = 13
+	NodeNotificationTranslationChanged     gdnative.Int = 24
+	NodeNotificationUnparented             gdnative.Int = 19
+	NodeNotificationUnpaused               gdnative.Int = 15
+)
+
+/*
+Nodes are Godot's building blocks. They can be assigned as the child of another node, resulting in a tree arrangement. A given node can contain any number of nodes as children with the requirement that all siblings (direct children of a node) should have unique names. A tree of nodes is called a [i]scene[/i]. Scenes can be saved to the disk and then instanced into other scenes. This allows for very high flexibility in the architecture and data model of Godot projects. Nodes can also optionally be added to groups. This makes it possible to access a number of nodes from code (an "enemies" group, for example) to perform grouped actions. [b]Scene tree:[/b] The [SceneTree] contains the active tree of nodes. When a node is added to the scene tree, it receives the NOTIFICATION_ENTER_TREE notification and its [method _enter_tree] callback is triggered. Child nodes are always added [i]after[/i] their parent node, i.e. the [method _enter_tree] callback of a parent node will be triggered before its child's. Once all nodes have been added in the scene tree, they receive the NOTIFICATION_READY notification and their respective [method _ready] callbacks are triggered. For groups of nodes, the [method _ready] callback is called in reverse order, starting with the children and moving up to the parent nodes. This means that when adding a node to the scene tree, the following order will be used for the callbacks: [method _enter_tree] of the parent, [method _enter_tree] of the children, [method _ready] of the children and finally [method _ready] of the parent (recursively for the entire scene tree). [b]Processing:[/b] Nodes can override the "process" state, so that they receive a callback on each frame requesting them to process (do something). Normal processing (callback [method _process], toggled with [method set_process]) happens as fast as possible and is dependent on the frame rate, so the processing time [i]delta[/i] is passed as an argument. Physics processing (callback [method _physics_process], toggled with [method set_physics_process]) happens a fixed number of times per second (60 by default) and is useful for code related to the physics engine. Nodes can also process input events. When present, the [method _input] function will be called for each input that the program receives. In many cases, this can be overkill (unless used for simple projects), and the [method _unhandled_input] function might be preferred; it is called when the input event was not handled by anyone else (typically, GUI [Control] nodes), ensuring that the node only receives the events that were meant for it. To keep track of the scene hierarchy (especially when instancing scenes into other scenes), an "owner" can be set for the node with [method set_owner]. This keeps track of who instanced what. This is mostly useful when writing editors and tools, though. Finally, when a node is freed with [method free] or [method queue_free], it will also free all its children. [b]Groups:[/b] Nodes can be added to as many groups as you want to be easy to manage, you could create groups like "enemies" or "collectables" for example, depending on your game. See [method add_to_group], [method is_in_group] and [method remove_from_group]. You can then retrieve all nodes in these groups, iterate them and even call methods on groups via the methods on [SceneTree]. [b]Networking with nodes:[/b] After connecting to a server (or making one, see [NetworkedMultiplayerENet]) it is possible to use the built-in RPC (remote procedure call) system to communicate over the network. By calling [method rpc] with a method name, it will be called locally and in all connected peers (peers = clients and the server that accepts connections). To identify which node receives the RPC call Godot will use its [NodePath] (make sure node names are the same on all peers). Also take a look at the high-level networking tutorial and corresponding demos.
+*/
+type Node struct {
+	Object
+	owner gdnative.Object
+}
+
+// classConstructorNode is the class constructor of the Node class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNode = gdnative.NewLazyClassConstructor("Node")
+
+// NewNode will create a new instance of the Node class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNode() *Node {
+	obj := &Node{}
+	obj.SetBaseObject(classConstructorNode.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Node) BaseClass() string {
+	return "Node"
+}
+
+// Method binds of the Node class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNodeX_EnterTree       = gdnative.NewLazyMethodBind("Node", "_enter_tree")
+	methodBindNodeX_Input           = gdnative.NewLazyMethodBind("Node", "_input")
+	methodBindNodeX_Process         = gdnative.NewLazyMethodBind("Node", "_process")
+	methodBindNodeX_Ready           = gdnative.NewLazyMethodBind("Node", "_ready")
+	methodBindNodeAddChild          = gdnative.NewLazyMethodBind("Node", "add_child")
+	methodBindNodeDuplicate         = gdnative.NewLazyMethodBind("Node", "duplicate")
+	methodBindNodeGetChild          = gdnative.NewLazyMethodBind("Node", "get_child")
+	methodBindNodeGetChildCount     = gdnative.NewLazyMethodBind("Node", "get_child_count")
+	methodBindNodeGetFilename       = gdnative.NewLazyMethodBind("Node", "get_filename")
+	methodBindNodeGetName           = gdnative.NewLazyMethodBind("Node", "get_name")
+	methodBindNodeGetNode           = gdnative.NewLazyMethodBind("Node", "get_node")
+	methodBindNodeGetOwner          = gdnative.NewLazyMethodBind("Node", "get_owner")
+	methodBindNodeGetParent         = gdnative.NewLazyMethodBind("Node", "get_parent")
+	methodBindNodeGetPauseMode      = gdnative.NewLazyMethodBind("Node", "get_pause_mode")
+	methodBindNodeIsDisplayedFolded = gdnative.NewLazyMethodBind("Node", "is_displayed_folded")
+	methodBindNodeIsInsideTree      = gdnative.NewLazyMethodBind("Node", "is_inside_tree")
+	methodBindNodeQueueFree         = gdnative.NewLazyMethodBind("Node", "queue_free")
+	methodBindNodeRemoveChild       = gdnative.NewLazyMethodBind("Node", "remove_child")
+	methodBindNodeRpc               = gdnative.NewLazyMethodBind("Node", "rpc")
+	methodBindNodeRpcConfig         = gdnative.NewLazyMethodBind("Node", "rpc_config")
+	methodBindNodeSetDisplayFolded  = gdnative.NewLazyMethodBind("Node", "set_display_folded")
+	methodBindNodeSetFilename       = gdnative.NewLazyMethodBind("Node", "set_filename")
+	methodBindNodeSetName           = gdnative.NewLazyMethodBind("Node", "set_name")
+	methodBindNodeSetOwner          = gdnative.NewLazyMethodBind("Node", "set_owner")
+	methodBindNodeSetPauseMode      = gdnative.NewLazyMethodBind("Node", "set_pause_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Node) X_EnterTree() {
+	//log.Println("Calling Node.X_EnterTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeX_EnterTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false event InputEvent}], Returns: void
+*/
+func (o *Node) X_Input(event InputEventImplementer) {
+	//log.Println("Calling Node.X_Input()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
+
+	// Get the method bind
+	methodBind := methodBindNodeX_Input.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false delta float}], Returns: void
+*/
+func (o *Node) X_Process(delta gdnative.Real) {
+	//log.Println("Calling Node.X_Process()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
+
+	// Get the method bind
+	methodBind := methodBindNodeX_Process.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Node) X_Ready() {
+	//log.Println("Calling Node.X_Ready()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeX_Ready.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Adds a child node. Nodes can have any number of children, but every child must have a unique name. Child nodes are automatically deleted when the parent node is deleted, so an entire scene can be removed by deleting its topmost node. Setting "legible_unique_name" [code]true[/code] creates child nodes with human-readable names, based on the name of the node being instanced instead of its type.
+		Args: [{ false node Object} {False true legible_unique_name bool}], Returns: void
+*/
+func (o *Node) AddChild(node ObjectImplementer, legibleUniqueName gdnative.Bool) {
+	//log.Println("Calling Node.AddChild()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
+	ptrArguments[1] = gdnative.NewPointerFromBool(legibleUniqueName)
+
+	// Get the method bind
+	methodBind := methodBindNodeAddChild.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{15 true flags int}], Returns: Node
+*/
+func (o *Node) Duplicate(flags gdnative.Int) NodeImplementer {
+	//log.Println("Calling Node.Duplicate()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
+
+	// Get the method bind
+	methodBind := methodBindNodeDuplicate.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns a child node by its index (see [method get_child_count]). This method is often used for iterating all children of a node.
+		Args: [{ false idx int}], Returns: Node
+*/
+func (o *Node) GetChild(idx gdnative.Int) NodeImplementer {
+	//log.Println("Calling Node.GetChild()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetChild.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Node) GetChildCount() gdnative.Int {
+	//log.Println("Calling Node.GetChildCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetChildCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Node) GetFilename() gdnative.String {
+	//log.Println("Calling Node.GetFilename()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetFilename.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Node) GetName() gdnative.String {
+	//log.Println("Calling Node.GetName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false path NodePath}], Returns: Node
+*/
+func (o *Node) GetNode(path gdnative.NodePath) NodeImplementer {
+	//log.Println("Calling Node.GetNode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetNode.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Node
+*/
+func (o *Node) GetOwner() NodeImplementer {
+	//log.Println("Calling Node.GetOwner()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetOwner.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Node
+*/
+func (o *Node) GetParent() NodeImplementer {
+	//log.Println("Calling Node.GetParent()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetParent.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Node::PauseMode
+*/
+func (o *Node) GetPauseMode() NodePauseMode {
+	//log.Println("Calling Node.GetPauseMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeGetPauseMode.Get()
+
+	// Call the parent method.
+	// enum.Node::PauseMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return NodePauseMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Node) IsDisplayedFolded() gdnative.Bool {
+	//log.Println("Calling Node.IsDisplayedFolded()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeIsDisplayedFolded.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Node) IsInsideTree() gdnative.Bool {
+	//log.Println("Calling Node.IsInsideTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeIsInsideTree.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Queues a node for deletion at the end of the current frame. When deleted, all of its child nodes will be deleted as well. This method ensures it's safe to delete the node, contrary to [method Object.free]. Use [method Object.is_queued_for_deletion] to check whether a node will be deleted at the end of the frame.
+		Args: [], Returns: void
+*/
+func (o *Node) QueueFree() {
+	//log.Println("Calling Node.QueueFree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNodeQueueFree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false node Object}], Returns: void
+*/
+func (o *Node) RemoveChild(node ObjectImplementer) {
+	//log.Println("Calling Node.RemoveChild()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
+
+	// Get the method bind
+	methodBind := methodBindNodeRemoveChild.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false method String}], Returns: Variant
+*/
+func (o *Node) Rpc(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling Node.Rpc()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindNodeRpc.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Undocumented
+		Args: [{ false method String} { false mode int}], Returns: void
+*/
+func (o *Node) RpcConfig(method gdnative.String, mode gdnative.Int) {
+	//log.Println("Calling Node.RpcConfig()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(method)
+	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
+
+	// Get the method bind
+	methodBind := methodBindNodeRpcConfig.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false fold bool}], Returns: void
+*/
+func (o *Node) SetDisplayFolded(fold gdnative.Bool) {
+	//log.Println("Calling Node.SetDisplayFolded()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(fold)
+
+	// Get the method bind
+	methodBind := methodBindNodeSetDisplayFolded.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false filename String}], Returns: void
+*/
+func (o *Node) SetFilename(filename gdnative.String) {
+	//log.Println("Calling Node.SetFilename()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(filename)
+
+	// Get the method bind
+	methodBind := methodBindNodeSetFilename.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: void
+*/
+func (o *Node) SetName(name gdnative.String) {
+	//log.Println("Calling Node.SetName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindNodeSetName.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false owner Object}], Returns: void
+*/
+func (o *Node) SetOwner(owner ObjectImplementer) {
+	//log.Println("Calling Node.SetOwner()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(owner))
+
+	// Get the method bind
+	methodBind := methodBindNodeSetOwner.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.Node::PauseMode}], Returns: void
+*/
+func (o *Node) SetPauseMode(mode NodePauseMode) {
+	//log.Println("Calling Node.SetPauseMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindNodeSetPauseMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AddChildWithDefaults will call AddChild using the default values for: legible_unique_name.
+func (o *Node) AddChildWithDefaults(node ObjectImplementer) {
+	o.AddChild(node, false)
+}
+
+// DuplicateWithDefaults will call Duplicate using the default values for: flags.
+func (o *Node) DuplicateWithDefaults() NodeImplementer {
+	return o.Duplicate(15)
+}
+
+// EditorDisplayFolded will return the value of the "editor/display_folded" property.
+func (o *Node) EditorDisplayFolded() gdnative.Bool {
+	return o.IsDisplayedFolded()
+}
+
+// SetEditorDisplayFolded will set the value of the "editor/display_folded" property.
+func (o *Node) SetEditorDisplayFolded(value gdnative.Bool) {
+	o.SetDisplayFolded(value)
+}
+
+// Filename will return the value of the "filename" property.
+func (o *Node) Filename() gdnative.String {
+	return o.GetFilename()
+}
+
+// Name will return the value of the "name" property.
+func (o *Node) Name() gdnative.String {
+	return o.GetName()
+}
+
+// Owner will return the value of the "owner" property.
+func (o *Node) Owner() NodeImplementer {
+	return o.GetOwner()
+}
+
+// PauseMode will return the value of the "pause_mode" property.
+func (o *Node) PauseMode() NodePauseMode {
+	return o.GetPauseMode()
+}
+
+// Signals of the Node class.
+const (
+	NodeSignalRenamed     gdnative.String = "renamed"
+	NodeSignalTreeEntered gdnative.String = "tree_entered"
+	NodeSignalTreeExited  gdnative.String = "tree_exited"
+	NodeSignalTreeExiting gdnative.String = "tree_exiting"
+)
+
+// ConnectRenamed will connect the "renamed" signal to the given method of the target object.
+func (o *Node) ConnectRenamed(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalRenamed, target, method, binds, 0)
+}
+
+// EmitRenamed will emit the "renamed" signal.
+func (o *Node) EmitRenamed() error {
+
+	ret, err := o.EmitSignal(NodeSignalRenamed)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTreeEntered will connect the "tree_entered" signal to the given method of the target object.
+func (o *Node) ConnectTreeEntered(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalTreeEntered, target, method, binds, 0)
+}
+
+// EmitTreeEntered will emit the "tree_entered" signal.
+func (o *Node) EmitTreeEntered() error {
+
+	ret, err := o.EmitSignal(NodeSignalTreeEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTreeExited will connect the "tree_exited" signal to the given method of the target object.
+func (o *Node) ConnectTreeExited(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalTreeExited, target, method, binds, 0)
+}
+
+// EmitTreeExited will emit the "tree_exited" signal.
+func (o *Node) EmitTreeExited() error {
+
+	ret, err := o.EmitSignal(NodeSignalTreeExited)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTreeExiting will connect the "tree_exiting" signal to the given method of the target object.
+func (o *Node) ConnectTreeExiting(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalTreeExiting, target, method, binds, 0)
+}
+
+// EmitTreeExiting will emit the "tree_exiting" signal.
+func (o *Node) EmitTreeExiting() error {
+
+	ret, err := o.EmitSignal(NodeSignalTreeExiting)
+	ret.Destroy()
+	return err
+}
+
+// NodeImplementer is an interface that implements the methods
+// of the Node class.
+type NodeImplementer interface {
+	ObjectImplementer
+	X_EnterTree()
+	X_Input(event InputEventImplementer)
+	X_Process(delta gdnative.Real)
+	X_Ready()
+	AddChild(node ObjectImplementer, legibleUniqueName gdnative.Bool)
+	Duplicate(flags gdnative.Int) NodeImplementer
+	GetChild(idx gdnative.Int) NodeImplementer
+	GetChildCount() gdnative.Int
+	GetFilename() gdnative.String
+	GetName() gdnative.String
+	GetNode(path gdnative.NodePath) NodeImplementer
+	GetOwner() NodeImplementer
+	GetParent() NodeImplementer
+	GetPauseMode() NodePauseMode
+	IsDisplayedFolded() gdnative.Bool
+	IsInsideTree() gdnative.Bool
+	QueueFree()
+	RemoveChild(node ObjectImplementer)
+	Rpc(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	RpcConfig(method gdnative.String, mode gdnative.Int)
+	SetDisplayFolded(fold gdnative.Bool)
+	SetFilename(filename gdnative.String)
+	SetName(name gdnative.String)
+	SetOwner(owner ObjectImplementer)
+	SetPauseMode(mode NodePauseMode)
+	AddChildWithDefaults(node ObjectImplementer)
+	DuplicateWithDefaults() NodeImplementer
+	EditorDisplayFolded() gdnative.Bool
+	SetEditorDisplayFolded(value gdnative.Bool)
+	Filename() gdnative.String
+	Name() gdnative.String
+	Owner() NodeImplementer
+	PauseMode() NodePauseMode
+	ConnectRenamed(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRenamed() error
+	ConnectTreeEntered(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTreeEntered() error
+	ConnectTreeExited(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTreeExited() error
+	ConnectTreeExiting(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTreeExiting() error
+}
@@ -1,7 +1,10 @@
-package godot
+package node
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,30 +24,76 @@ const (
 	TimerTimerProcessPhysics TimerTimerProcessMode = 0
 )
 
-//func NewTimerFromPointer(ptr gdnative.Pointer) Timer {
-func newTimerFromPointer(ptr gdnative.Pointer) Timer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Timer{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the TimerTimerProcessMode value.
+func (e TimerTimerProcessMode) String() string {
+	switch e {
+	case TimerTimerProcessIdle:
+		return "TIMER_PROCESS_IDLE"
+	case TimerTimerProcessPhysics:
+		return "TIMER_PROCESS_PHYSICS"
+	}
+	return fmt.Sprintf("TimerTimerProcessMode(%d)", int(e))
+}
 
-	return obj
+// ParseTimerTimerProcessMode will return the TimerTimerProcessMode value with the given Godot name.
+func ParseTimerTimerProcessMode(name string) (TimerTimerProcessMode, error) {
+	switch name {
+	case "TIMER_PROCESS_IDLE":
+		return TimerTimerProcessIdle, nil
+	case "TIMER_PROCESS_PHYSICS":
+		return TimerTimerProcessPhysics, nil
+	}
+	return 0, fmt.Errorf("invalid TimerTimerProcessMode value %q", name)
 }
 
 /*
 Counts down a specified interval and emits a signal on reaching 0. Can be set to repeat or "one shot" mode.
 */
 type Timer struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
+// classConstructorTimer is the class constructor of the Timer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTimer = gdnative.NewLazyClassConstructor("Timer")
+
+// NewTimer will create a new instance of the Timer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTimer() *Timer {
+	obj := &Timer{}
+	obj.SetBaseObject(classConstructorTimer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Timer) BaseClass() string {
 	return "Timer"
 }
 
+// Method binds of the Timer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTimerGetTimeLeft         = gdnative.NewLazyMethodBind("Timer", "get_time_left")
+	methodBindTimerGetTimerProcessMode = gdnative.NewLazyMethodBind("Timer", "get_timer_process_mode")
+	methodBindTimerGetWaitTime         = gdnative.NewLazyMethodBind("Timer", "get_wait_time")
+	methodBindTimerHasAutostart        = gdnative.NewLazyMethodBind("Timer", "has_autostart")
+	methodBindTimerIsOneShot           = gdnative.NewLazyMethodBind("Timer", "is_one_shot")
+	methodBindTimerIsPaused            = gdnative.NewLazyMethodBind("Timer", "is_paused")
+	methodBindTimerIsStopped           = gdnative.NewLazyMethodBind("Timer", "is_stopped")
+	methodBindTimerSetAutostart        = gdnative.NewLazyMethodBind("Timer", "set_autostart")
+	methodBindTimerSetOneShot          = gdnative.NewLazyMethodBind("Timer", "set_one_shot")
+	methodBindTimerSetPaused           = gdnative.NewLazyMethodBind("Timer", "set_paused")
+	methodBindTimerSetTimerProcessMode = gdnative.NewLazyMethodBind("Timer", "set_timer_process_mode")
+	methodBindTimerSetWaitTime         = gdnative.NewLazyMethodBind("Timer", "set_wait_time")
+	methodBindTimerStart               = gdnative.NewLazyMethodBind("Timer", "start")
+	methodBindTimerStop                = gdnative.NewLazyMethodBind("Timer", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Timer) GetTimeLeft() gdnative.Real {
 	//log.Println("Calling Timer.GetTimeLeft()")
@@ -53,7 +102,7 @@ func (o *Timer) GetTimeLeft() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "get_time_left")
+	methodBind := methodBindTimerGetTimeLeft.Get()
 
 	// Call the parent method.
 	// float
@@ -66,8 +115,8 @@ func (o *Timer) GetTimeLeft() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Timer::TimerProcessMode
+	        Undocumented
+		Args: [], Returns: enum.Timer::TimerProcessMode
 */
 func (o *Timer) GetTimerProcessMode() TimerTimerProcessMode {
 	//log.Println("Calling Timer.GetTimerProcessMode()")
@@ -76,7 +125,7 @@ func (o *Timer) GetTimerProcessMode() TimerTimerProcessMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "get_timer_process_mode")
+	methodBind := methodBindTimerGetTimerProcessMode.Get()
 
 	// Call the parent method.
 	// enum.Timer::TimerProcessMode
@@ -89,8 +138,8 @@ func (o *Timer) GetTimerProcessMode() TimerTimerProcessMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Timer) GetWaitTime() gdnative.Real {
 	//log.Println("Calling Timer.GetWaitTime()")
@@ -99,7 +148,7 @@ func (o *Timer) GetWaitTime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "get_wait_time")
+	methodBind := methodBindTimerGetWaitTime.Get()
 
 	// Call the parent method.
 	// float
@@ -112,8 +161,8 @@ func (o *Timer) GetWaitTime() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Timer) HasAutostart() gdnative.Bool {
 	//log.Println("Calling Timer.HasAutostart()")
@@ -122,7 +171,7 @@ func (o *Timer) HasAutostart() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "has_autostart")
+	methodBind := methodBindTimerHasAutostart.Get()
 
 	// Call the parent method.
 	// bool
@@ -135,8 +184,8 @@ func (o *Timer) HasAutostart() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Timer) IsOneShot() gdnative.Bool {
 	//log.Println("Calling Timer.IsOneShot()")
@@ -145,7 +194,7 @@ func (o *Timer) IsOneShot() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "is_one_shot")
+	methodBind := methodBindTimerIsOneShot.Get()
 
 	// Call the parent method.
 	// bool
@@ -158,8 +207,8 @@ func (o *Timer) IsOneShot() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Timer) IsPaused() gdnative.Bool {
 	//log.Println("Calling Timer.IsPaused()")
@@ -168,7 +217,7 @@ func (o *Timer) IsPaused() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "is_paused")
+	methodBind := methodBindTimerIsPaused.Get()
 
 	// Call the parent method.
 	// bool
@@ -181,8 +230,8 @@ func (o *Timer) IsPaused() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the timer is stopped.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the timer is stopped.
+		Args: [], Returns: bool
 */
 func (o *Timer) IsStopped() gdnative.Bool {
 	//log.Println("Calling Timer.IsStopped()")
@@ -191,7 +240,7 @@ func (o *Timer) IsStopped() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "is_stopped")
+	methodBind := methodBindTimerIsStopped.Get()
 
 	// Call the parent method.
 	// bool
@@ -204,8 +253,8 @@ func (o *Timer) IsStopped() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Timer) SetAutostart(enable gdnative.Bool) {
 	//log.Println("Calling Timer.SetAutostart()")
@@ -215,7 +264,7 @@ func (o *Timer) SetAutostart(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "set_autostart")
+	methodBind := methodBindTimerSetAutostart.Get()
 
 	// Call the parent method.
 	// void
@@ -225,8 +274,8 @@ func (o *Timer) SetAutostart(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Timer) SetOneShot(enable gdnative.Bool) {
 	//log.Println("Calling Timer.SetOneShot()")
@@ -236,7 +285,7 @@ func (o *Timer) SetOneShot(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "set_one_shot")
+	methodBind := methodBindTimerSetOneShot.Get()
 
 	// Call the parent method.
 	// void
@@ -246,8 +295,8 @@ func (o *Timer) SetOneShot(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false paused bool}], Returns: void
+	        Undocumented
+		Args: [{ false paused bool}], Returns: void
 */
 func (o *Timer) SetPaused(paused gdnative.Bool) {
 	//log.Println("Calling Timer.SetPaused()")
@@ -257,7 +306,7 @@ func (o *Timer) SetPaused(paused gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(paused)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "set_paused")
+	methodBind := methodBindTimerSetPaused.Get()
 
 	// Call the parent method.
 	// void
@@ -267,18 +316,18 @@ func (o *Timer) SetPaused(paused gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Timer::TimerProcessMode}], Returns: void
 */
-func (o *Timer) SetTimerProcessMode(mode gdnative.Int) {
+func (o *Timer) SetTimerProcessMode(mode TimerTimerProcessMode) {
 	//log.Println("Calling Timer.SetTimerProcessMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "set_timer_process_mode")
+	methodBind := methodBindTimerSetTimerProcessMode.Get()
 
 	// Call the parent method.
 	// void
@@ -288,8 +337,8 @@ func (o *Timer) SetTimerProcessMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false time_sec float}], Returns: void
+	        Undocumented
+		Args: [{ false time_sec float}], Returns: void
 */
 func (o *Timer) SetWaitTime(timeSec gdnative.Real) {
 	//log.Println("Calling Timer.SetWaitTime()")
@@ -299,7 +348,7 @@ func (o *Timer) SetWaitTime(timeSec gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(timeSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "set_wait_time")
+	methodBind := methodBindTimerSetWaitTime.Get()
 
 	// Call the parent method.
 	// void
@@ -309,8 +358,8 @@ func (o *Timer) SetWaitTime(timeSec gdnative.Real) {
 }
 
 /*
-        Starts the timer. This also resets the remaining time to [code]wait_time[/code]. Note: this method will not resume a paused timer. See [method set_paused].
-	Args: [], Returns: void
+	        Starts the timer. This also resets the remaining time to [code]wait_time[/code]. Note: this method will not resume a paused timer. See [method set_paused].
+		Args: [], Returns: void
 */
 func (o *Timer) Start() {
 	//log.Println("Calling Timer.Start()")
@@ -319,7 +368,7 @@ func (o *Timer) Start() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "start")
+	methodBind := methodBindTimerStart.Get()
 
 	// Call the parent method.
 	// void
@@ -329,8 +378,8 @@ func (o *Timer) Start() {
 }
 
 /*
-        Stop (cancel) the Timer.
-	Args: [], Returns: void
+	        Stop (cancel) the Timer.
+		Args: [], Returns: void
 */
 func (o *Timer) Stop() {
 	//log.Println("Calling Timer.Stop()")
@@ -339,7 +388,7 @@ func (o *Timer) Stop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Timer", "stop")
+	methodBind := methodBindTimerStop.Get()
 
 	// Call the parent method.
 	// void
@@ -348,11 +397,68 @@ func (o *Timer) Stop() {
 
 }
 
+// Autostart will return the value of the "autostart" property.
+func (o *Timer) Autostart() gdnative.Bool {
+	return o.HasAutostart()
+}
+
+// OneShot will return the value of the "one_shot" property.
+func (o *Timer) OneShot() gdnative.Bool {
+	return o.IsOneShot()
+}
+
+// Paused will return the value of the "paused" property.
+func (o *Timer) Paused() gdnative.Bool {
+	return o.IsPaused()
+}
+
+// ProcessMode will return the value of the "process_mode" property.
+func (o *Timer) ProcessMode() TimerTimerProcessMode {
+	return o.GetTimerProcessMode()
+}
+
+// SetProcessMode will set the value of the "process_mode" property.
+func (o *Timer) SetProcessMode(value TimerTimerProcessMode) {
+	o.SetTimerProcessMode(value)
+}
+
+// TimeLeft will return the value of the "time_left" property.
+func (o *Timer) TimeLeft() gdnative.Real {
+	return o.GetTimeLeft()
+}
+
+// WaitTime will return the value of the "wait_time" property.
+func (o *Timer) WaitTime() gdnative.Real {
+	return o.GetWaitTime()
+}
+
+// Signals of the Timer class.
+const (
+	TimerSignalTimeout gdnative.String = "timeout"
+)
+
+// ConnectTimeout will connect the "timeout" signal to the given method of the target object.
+func (o *Timer) ConnectTimeout(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TimerSignalTimeout, target, method, binds, 0)
+}
+
+// EmitTimeout will emit the "timeout" signal.
+func (o *Timer) EmitTimeout() error {
+
+	ret, err := o.EmitSignal(TimerSignalTimeout)
+	ret.Destroy()
+	return err
+}
+
 // TimerImplementer is an interface that implements the methods
 // of the Timer class.
 type TimerImplementer interface {
-	NodeImplementer
+	godot.NodeImplementer
 	GetTimeLeft() gdnative.Real
+	GetTimerProcessMode() TimerTimerProcessMode
 	GetWaitTime() gdnative.Real
 	HasAutostart() gdnative.Bool
 	IsOneShot() gdnative.Bool
@@ -361,8 +467,17 @@ type TimerImplementer interface {
 	SetAutostart(enable gdnative.Bool)
 	SetOneShot(enable gdnative.Bool)
 	SetPaused(paused gdnative.Bool)
-	SetTimerProcessMode(mode gdnative.Int)
+	SetTimerProcessMode(mode TimerTimerProcessMode)
 	SetWaitTime(timeSec gdnative.Real)
 	Start()
 	Stop()
+	Autostart() gdnative.Bool
+	OneShot() gdnative.Bool
+	Paused() gdnative.Bool
+	ProcessMode() TimerTimerProcessMode
+	SetProcessMode(value TimerTimerProcessMode)
+	TimeLeft() gdnative.Real
+	WaitTime() gdnative.Real
+	ConnectTimeout(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTimeout() error
 }
@@ -0,0 +1,1234 @@
+package godot
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// ObjectConnectFlags is an enum for ConnectFlags values.
+type ObjectConnectFlags int
+
+const (
+	ObjectConnectDeferred ObjectConnectFlags = 1
+	ObjectConnectOneshot  ObjectConnectFlags = 4
+	ObjectConnectPersist  ObjectConnectFlags = 2
+)
+
+// String will return the Godot name of the ObjectConnectFlags value.
+func (e ObjectConnectFlags) String() string {
+	switch e {
+	case ObjectConnectDeferred:
+		return "CONNECT_DEFERRED"
+	case ObjectConnectOneshot:
+		return "CONNECT_ONESHOT"
+	case ObjectConnectPersist:
+		return "CONNECT_PERSIST"
+	}
+	return fmt.Sprintf("ObjectConnectFlags(%d)", int(e))
+}
+
+// ParseObjectConnectFlags will return the ObjectConnectFlags value with the given Godot name.
+func ParseObjectConnectFlags(name string) (ObjectConnectFlags, error) {
+	switch name {
+	case "CONNECT_DEFERRED":
+		return ObjectConnectDeferred, nil
+	case "CONNECT_ONESHOT":
+		return ObjectConnectOneshot, nil
+	case "CONNECT_PERSIST":
+		return ObjectConnectPersist, nil
+	}
+	return 0, fmt.Errorf("invalid ObjectConnectFlags value %q", name)
+}
+
+// Constants of the Object class.
+const (
+	ObjectNotificationPostinitialize gdnative.Int = 0
+	ObjectNotificationPredelete      gdnative.Int = 1
+)
+
+/*
+Undocumented
+*/
+type Object struct {
+	owner gdnative.Object
+}
+
+// classConstructorObject is the class constructor of the Object class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorObject = gdnative.NewLazyClassConstructor("Object")
+
+// NewObject will create a new instance of the Object class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewObject() *Object {
+	obj := &Object{}
+	obj.SetBaseObject(classConstructorObject.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Object) BaseClass() string {
+	return "Object"
+}
+
+// SetBaseObject will internally set the Godot object inside the struct.
+// This is used to call parent methods.
+func (o *Object) SetBaseObject(object gdnative.Object) {
+	o.owner = object
+}
+
+func (o *Object) GetBaseObject() gdnative.Object {
+	return o.owner
+}
+
+// Destroy will free the underlying Godot object. This can only be used on
+// objects that do not inherit from Reference, since those are freed by Godot
+// once their last reference is dropped.
+func (o *Object) Destroy() {
+	if o.IsClass("Reference") {
+		Log.Error("Unable to destroy object of class ", o.GetClass(), ": Reference types are freed by Godot.")
+		return
+	}
+	o.owner.Destroy()
+	o.owner = gdnative.Object{}
+}
+
+// Method binds of the Object class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindObjectX_Get                     = gdnative.NewLazyMethodBind("Object", "_get")
+	methodBindObjectX_GetPropertyList         = gdnative.NewLazyMethodBind("Object", "_get_property_list")
+	methodBindObjectX_Init                    = gdnative.NewLazyMethodBind("Object", "_init")
+	methodBindObjectX_Notification            = gdnative.NewLazyMethodBind("Object", "_notification")
+	methodBindObjectX_Set                     = gdnative.NewLazyMethodBind("Object", "_set")
+	methodBindObjectAddUserSignal             = gdnative.NewLazyMethodBind("Object", "add_user_signal")
+	methodBindObjectCall                      = gdnative.NewLazyMethodBind("Object", "call")
+	methodBindObjectCallDeferred              = gdnative.NewLazyMethodBind("Object", "call_deferred")
+	methodBindObjectCallv                     = gdnative.NewLazyMethodBind("Object", "callv")
+	methodBindObjectCanTranslateMessages      = gdnative.NewLazyMethodBind("Object", "can_translate_messages")
+	methodBindObjectConnect                   = gdnative.NewLazyMethodBind("Object", "connect")
+	methodBindObjectDisconnect                = gdnative.NewLazyMethodBind("Object", "disconnect")
+	methodBindObjectEmitSignal                = gdnative.NewLazyMethodBind("Object", "emit_signal")
+	methodBindObjectFree                      = gdnative.NewLazyMethodBind("Object", "free")
+	methodBindObjectGet                       = gdnative.NewLazyMethodBind("Object", "get")
+	methodBindObjectGetClass                  = gdnative.NewLazyMethodBind("Object", "get_class")
+	methodBindObjectGetIncomingConnections    = gdnative.NewLazyMethodBind("Object", "get_incoming_connections")
+	methodBindObjectGetIndexed                = gdnative.NewLazyMethodBind("Object", "get_indexed")
+	methodBindObjectGetInstanceId             = gdnative.NewLazyMethodBind("Object", "get_instance_id")
+	methodBindObjectGetMeta                   = gdnative.NewLazyMethodBind("Object", "get_meta")
+	methodBindObjectGetMetaList               = gdnative.NewLazyMethodBind("Object", "get_meta_list")
+	methodBindObjectGetMethodList             = gdnative.NewLazyMethodBind("Object", "get_method_list")
+	methodBindObjectGetPropertyList           = gdnative.NewLazyMethodBind("Object", "get_property_list")
+	methodBindObjectGetScript                 = gdnative.NewLazyMethodBind("Object", "get_script")
+	methodBindObjectGetSignalConnectionList   = gdnative.NewLazyMethodBind("Object", "get_signal_connection_list")
+	methodBindObjectGetSignalList             = gdnative.NewLazyMethodBind("Object", "get_signal_list")
+	methodBindObjectHasMeta                   = gdnative.NewLazyMethodBind("Object", "has_meta")
+	methodBindObjectHasMethod                 = gdnative.NewLazyMethodBind("Object", "has_method")
+	methodBindObjectHasUserSignal             = gdnative.NewLazyMethodBind("Object", "has_user_signal")
+	methodBindObjectIsBlockingSignals         = gdnative.NewLazyMethodBind("Object", "is_blocking_signals")
+	methodBindObjectIsClass                   = gdnative.NewLazyMethodBind("Object", "is_class")
+	methodBindObjectIsConnected               = gdnative.NewLazyMethodBind("Object", "is_connected")
+	methodBindObjectIsQueuedForDeletion       = gdnative.NewLazyMethodBind("Object", "is_queued_for_deletion")
+	methodBindObjectNotification              = gdnative.NewLazyMethodBind("Object", "notification")
+	methodBindObjectPropertyListChangedNotify = gdnative.NewLazyMethodBind("Object", "property_list_changed_notify")
+	methodBindObjectSet                       = gdnative.NewLazyMethodBind("Object", "set")
+	methodBindObjectSetBlockSignals           = gdnative.NewLazyMethodBind("Object", "set_block_signals")
+	methodBindObjectSetIndexed                = gdnative.NewLazyMethodBind("Object", "set_indexed")
+	methodBindObjectSetMessageTranslation     = gdnative.NewLazyMethodBind("Object", "set_message_translation")
+	methodBindObjectSetMeta                   = gdnative.NewLazyMethodBind("Object", "set_meta")
+	methodBindObjectSetScript                 = gdnative.NewLazyMethodBind("Object", "set_script")
+	methodBindObjectTr                        = gdnative.NewLazyMethodBind("Object", "tr")
+)
+
+/*
+	        Undocumented
+		Args: [{ false property String}], Returns: void
+*/
+func (o *Object) X_Get(property gdnative.String) {
+	//log.Println("Calling Object.X_Get()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(property)
+
+	// Get the method bind
+	methodBind := methodBindObjectX_Get.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *Object) X_GetPropertyList() gdnative.Array {
+	//log.Println("Calling Object.X_GetPropertyList()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectX_GetPropertyList.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Object) X_Init() {
+	//log.Println("Calling Object.X_Init()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectX_Init.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false what int}], Returns: void
+*/
+func (o *Object) X_Notification(what gdnative.Int) {
+	//log.Println("Calling Object.X_Notification()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(what)
+
+	// Get the method bind
+	methodBind := methodBindObjectX_Notification.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false property String} { false value Variant}], Returns: bool
+*/
+func (o *Object) X_Set(property gdnative.String, value gdnative.Variant) gdnative.Bool {
+	//log.Println("Calling Object.X_Set()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(property)
+	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
+
+	// Get the method bind
+	methodBind := methodBindObjectX_Set.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false signal String} {[] true arguments Array}], Returns: void
+*/
+func (o *Object) AddUserSignal(signal gdnative.String, arguments gdnative.Array) {
+	//log.Println("Calling Object.AddUserSignal()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(signal)
+	ptrArguments[1] = gdnative.NewPointerFromArray(arguments)
+
+	// Get the method bind
+	methodBind := methodBindObjectAddUserSignal.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false method String}], Returns: Variant
+*/
+func (o *Object) Call(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling Object.Call()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindObjectCall.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Undocumented
+		Args: [{ false method String}], Returns: Variant
+*/
+func (o *Object) CallDeferred(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling Object.CallDeferred()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindObjectCallDeferred.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Undocumented
+		Args: [{ false method String} { false arg_array Array}], Returns: Variant
+*/
+func (o *Object) Callv(method gdnative.String, argArray gdnative.Array) gdnative.Variant {
+	//log.Println("Calling Object.Callv()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(method)
+	ptrArguments[1] = gdnative.NewPointerFromArray(argArray)
+
+	// Get the method bind
+	methodBind := methodBindObjectCallv.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Object) CanTranslateMessages() gdnative.Bool {
+	//log.Println("Calling Object.CanTranslateMessages()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectCanTranslateMessages.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false signal String} { false target Object} { false method String} {[] true binds Array} {0 true flags int}], Returns: enum.Error
+*/
+func (o *Object) Connect(signal gdnative.String, target ObjectImplementer, method gdnative.String, binds gdnative.Array, flags gdnative.Int) gdnative.Error {
+	//log.Println("Calling Object.Connect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromString(signal)
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(target))
+	ptrArguments[2] = gdnative.NewPointerFromString(method)
+	ptrArguments[3] = gdnative.NewPointerFromArray(binds)
+	ptrArguments[4] = gdnative.NewPointerFromInt(flags)
+
+	// Get the method bind
+	methodBind := methodBindObjectConnect.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false signal String} { false target Object} { false method String}], Returns: void
+*/
+func (o *Object) Disconnect(signal gdnative.String, target ObjectImplementer, method gdnative.String) {
+	//log.Println("Calling Object.Disconnect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(signal)
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(target))
+	ptrArguments[2] = gdnative.NewPointerFromString(method)
+
+	// Get the method bind
+	methodBind := methodBindObjectDisconnect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false signal String}], Returns: Variant
+*/
+func (o *Object) EmitSignal(signal gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling Object.EmitSignal()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(signal))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindObjectEmitSignal.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Object) Free() {
+	//log.Println("Calling Object.Free()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectFree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false property String}], Returns: Variant
+*/
+func (o *Object) Get(property gdnative.String) gdnative.Variant {
+	//log.Println("Calling Object.Get()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(property)
+
+	// Get the method bind
+	methodBind := methodBindObjectGet.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Object) GetClass() gdnative.String {
+	//log.Println("Calling Object.GetClass()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetClass.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *Object) GetIncomingConnections() gdnative.Array {
+	//log.Println("Calling Object.GetIncomingConnections()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetIncomingConnections.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false property NodePath}], Returns: Variant
+*/
+func (o *Object) GetIndexed(property gdnative.NodePath) gdnative.Variant {
+	//log.Println("Calling Object.GetIndexed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromNodePath(property)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetIndexed.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Object) GetInstanceId() gdnative.Int {
+	//log.Println("Calling Object.GetInstanceId()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetInstanceId.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: Variant
+*/
+func (o *Object) GetMeta(name gdnative.String) gdnative.Variant {
+	//log.Println("Calling Object.GetMeta()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetMeta.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: PoolStringArray
+*/
+func (o *Object) GetMetaList() gdnative.PoolStringArray {
+	//log.Println("Calling Object.GetMetaList()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetMetaList.Get()
+
+	// Call the parent method.
+	// PoolStringArray
+	retPtr := gdnative.NewEmptyPoolStringArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewPoolStringArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *Object) GetMethodList() gdnative.Array {
+	//log.Println("Calling Object.GetMethodList()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetMethodList.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *Object) GetPropertyList() gdnative.Array {
+	//log.Println("Calling Object.GetPropertyList()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetPropertyList.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Reference
+*/
+func (o *Object) GetScript() ReferenceImplementer {
+	//log.Println("Calling Object.GetScript()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetScript.Get()
+
+	// Call the parent method.
+	// Reference
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Reference{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ReferenceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false signal String}], Returns: Array
+*/
+func (o *Object) GetSignalConnectionList(signal gdnative.String) gdnative.Array {
+	//log.Println("Calling Object.GetSignalConnectionList()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(signal)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetSignalConnectionList.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *Object) GetSignalList() gdnative.Array {
+	//log.Println("Calling Object.GetSignalList()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectGetSignalList.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: bool
+*/
+func (o *Object) HasMeta(name gdnative.String) gdnative.Bool {
+	//log.Println("Calling Object.HasMeta()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindObjectHasMeta.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false method String}], Returns: bool
+*/
+func (o *Object) HasMethod(method gdnative.String) gdnative.Bool {
+	//log.Println("Calling Object.HasMethod()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(method)
+
+	// Get the method bind
+	methodBind := methodBindObjectHasMethod.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false signal String}], Returns: bool
+*/
+func (o *Object) HasUserSignal(signal gdnative.String) gdnative.Bool {
+	//log.Println("Calling Object.HasUserSignal()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(signal)
+
+	// Get the method bind
+	methodBind := methodBindObjectHasUserSignal.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Object) IsBlockingSignals() gdnative.Bool {
+	//log.Println("Calling Object.IsBlockingSignals()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectIsBlockingSignals.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false type String}], Returns: bool
+*/
+func (o *Object) IsClass(aType gdnative.String) gdnative.Bool {
+	//log.Println("Calling Object.IsClass()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(aType)
+
+	// Get the method bind
+	methodBind := methodBindObjectIsClass.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false signal String} { false target Object} { false method String}], Returns: bool
+*/
+func (o *Object) IsConnected(signal gdnative.String, target ObjectImplementer, method gdnative.String) gdnative.Bool {
+	//log.Println("Calling Object.IsConnected()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(signal)
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(target))
+	ptrArguments[2] = gdnative.NewPointerFromString(method)
+
+	// Get the method bind
+	methodBind := methodBindObjectIsConnected.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Object) IsQueuedForDeletion() gdnative.Bool {
+	//log.Println("Calling Object.IsQueuedForDeletion()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectIsQueuedForDeletion.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false what int} {False true reversed bool}], Returns: void
+*/
+func (o *Object) Notification(what gdnative.Int, reversed gdnative.Bool) {
+	//log.Println("Calling Object.Notification()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(what)
+	ptrArguments[1] = gdnative.NewPointerFromBool(reversed)
+
+	// Get the method bind
+	methodBind := methodBindObjectNotification.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Object) PropertyListChangedNotify() {
+	//log.Println("Calling Object.PropertyListChangedNotify()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindObjectPropertyListChangedNotify.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false property String} { false value Variant}], Returns: void
+*/
+func (o *Object) Set(property gdnative.String, value gdnative.Variant) {
+	//log.Println("Calling Object.Set()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(property)
+	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
+
+	// Get the method bind
+	methodBind := methodBindObjectSet.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Object) SetBlockSignals(enable gdnative.Bool) {
+	//log.Println("Calling Object.SetBlockSignals()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindObjectSetBlockSignals.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false property NodePath} { false value Variant}], Returns: void
+*/
+func (o *Object) SetIndexed(property gdnative.NodePath, value gdnative.Variant) {
+	//log.Println("Calling Object.SetIndexed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromNodePath(property)
+	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
+
+	// Get the method bind
+	methodBind := methodBindObjectSetIndexed.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Object) SetMessageTranslation(enable gdnative.Bool) {
+	//log.Println("Calling Object.SetMessageTranslation()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindObjectSetMessageTranslation.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String} { false value Variant}], Returns: void
+*/
+func (o *Object) SetMeta(name gdnative.String, value gdnative.Variant) {
+	//log.Println("Calling Object.SetMeta()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
+
+	// Get the method bind
+	methodBind := methodBindObjectSetMeta.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false script Reference}], Returns: void
+*/
+func (o *Object) SetScript(script ReferenceImplementer) {
+	//log.Println("Calling Object.SetScript()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(script))
+
+	// Get the method bind
+	methodBind := methodBindObjectSetScript.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false message String}], Returns: String
+*/
+func (o *Object) Tr(message gdnative.String) gdnative.String {
+	//log.Println("Calling Object.Tr()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(message)
+
+	// Get the method bind
+	methodBind := methodBindObjectTr.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+// AddUserSignalWithDefaults will call AddUserSignal using the default values for: arguments.
+func (o *Object) AddUserSignalWithDefaults(signal gdnative.String) {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	o.AddUserSignal(signal, default0)
+}
+
+// ConnectWithDefaults will call Connect using the default values for: binds, flags.
+func (o *Object) ConnectWithDefaults(signal gdnative.String, target ObjectImplementer, method gdnative.String) gdnative.Error {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	return o.Connect(signal, target, method, default0, 0)
+}
+
+// NotificationWithDefaults will call Notification using the default values for: reversed.
+func (o *Object) NotificationWithDefaults(what gdnative.Int) {
+	o.Notification(what, false)
+}
+
+// Signals of the Object class.
+const (
+	ObjectSignalScriptChanged gdnative.String = "script_changed"
+)
+
+// ConnectScriptChanged will connect the "script_changed" signal to the given method of the target object.
+func (o *Object) ConnectScriptChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ObjectSignalScriptChanged, target, method, binds, 0)
+}
+
+// EmitScriptChanged will emit the "script_changed" signal.
+func (o *Object) EmitScriptChanged() error {
+
+	ret, err := o.EmitSignal(ObjectSignalScriptChanged)
+	ret.Destroy()
+	return err
+}
+
+// ObjectImplementer is an interface that implements the methods
+// of the Object class.
+type ObjectImplementer interface {
+	Class
+	X_Get(property gdnative.String)
+	X_GetPropertyList() gdnative.Array
+	X_Init()
+	X_Notification(what gdnative.Int)
+	X_Set(property gdnative.String, value gdnative.Variant) gdnative.Bool
+	AddUserSignal(signal gdnative.String, arguments gdnative.Array)
+	Call(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	CallDeferred(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	Callv(method gdnative.String, argArray gdnative.Array) gdnative.Variant
+	CanTranslateMessages() gdnative.Bool
+	Connect(signal gdnative.String, target ObjectImplementer, method gdnative.String, binds gdnative.Array, flags gdnative.Int) gdnative.Error
+	Disconnect(signal gdnative.String, target ObjectImplementer, method gdnative.String)
+	EmitSignal(signal gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	Free()
+	Get(property gdnative.String) gdnative.Variant
+	GetClass() gdnative.String
+	GetIncomingConnections() gdnative.Array
+	GetIndexed(property gdnative.NodePath) gdnative.Variant
+	GetInstanceId() gdnative.Int
+	GetMeta(name gdnative.String) gdnative.Variant
+	GetMetaList() gdnative.PoolStringArray
+	GetMethodList() gdnative.Array
+	GetPropertyList() gdnative.Array
+	GetScript() ReferenceImplementer
+	GetSignalConnectionList(signal gdnative.String) gdnative.Array
+	GetSignalList() gdnative.Array
+	HasMeta(name gdnative.String) gdnative.Bool
+	HasMethod(method gdnative.String) gdnative.Bool
+	HasUserSignal(signal gdnative.String) gdnative.Bool
+	IsBlockingSignals() gdnative.Bool
+	IsClass(aType gdnative.String) gdnative.Bool
+	IsConnected(signal gdnative.String, target ObjectImplementer, method gdnative.String) gdnative.Bool
+	IsQueuedForDeletion() gdnative.Bool
+	Notification(what gdnative.Int, reversed gdnative.Bool)
+	PropertyListChangedNotify()
+	Set(property gdnative.String, value gdnative.Variant)
+	SetBlockSignals(enable gdnative.Bool)
+	SetIndexed(property gdnative.NodePath, value gdnative.Variant)
+	SetMessageTranslation(enable gdnative.Bool)
+	SetMeta(name gdnative.String, value gdnative.Variant)
+	SetScript(script ReferenceImplementer)
+	Tr(message gdnative.String) gdnative.String
+	AddUserSignalWithDefaults(signal gdnative.String)
+	ConnectWithDefaults(signal gdnative.String, target ObjectImplementer, method gdnative.String) gdnative.Error
+	NotificationWithDefaults(what gdnative.Int)
+	ConnectScriptChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScriptChanged() error
+}
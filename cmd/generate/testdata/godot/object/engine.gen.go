@@ -1,7 +1,8 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,29 +14,20 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewengineFromPointer(ptr gdnative.Pointer) engine {
-func new_EngineFromPointer(ptr gdnative.Pointer) engine {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := engine{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonEngine() *engine {
 	return &engine{}
 }
 
 /*
-   The [code]Engine[/code] class allows you to query and modify the game's run-time parameters, such as frames per second, time scale, and others.
+Undocumented
 */
 var Engine = newSingletonEngine()
 
 /*
-The [code]Engine[/code] class allows you to query and modify the game's run-time parameters, such as frames per second, time scale, and others.
+Undocumented
 */
 type engine struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,9 +48,28 @@ func (o *engine) BaseClass() string {
 	return "_Engine"
 }
 
+// Method binds of the Engine class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEngineGetFramesDrawn         = gdnative.NewLazyMethodBind("_Engine", "get_frames_drawn")
+	methodBindEngineGetFramesPerSecond     = gdnative.NewLazyMethodBind("_Engine", "get_frames_per_second")
+	methodBindEngineGetIterationsPerSecond = gdnative.NewLazyMethodBind("_Engine", "get_iterations_per_second")
+	methodBindEngineGetSingleton           = gdnative.NewLazyMethodBind("_Engine", "get_singleton")
+	methodBindEngineGetTargetFps           = gdnative.NewLazyMethodBind("_Engine", "get_target_fps")
+	methodBindEngineGetTimeScale           = gdnative.NewLazyMethodBind("_Engine", "get_time_scale")
+	methodBindEngineGetVersionInfo         = gdnative.NewLazyMethodBind("_Engine", "get_version_info")
+	methodBindEngineHasSingleton           = gdnative.NewLazyMethodBind("_Engine", "has_singleton")
+	methodBindEngineIsEditorHint           = gdnative.NewLazyMethodBind("_Engine", "is_editor_hint")
+	methodBindEngineIsInPhysicsFrame       = gdnative.NewLazyMethodBind("_Engine", "is_in_physics_frame")
+	methodBindEngineSetEditorHint          = gdnative.NewLazyMethodBind("_Engine", "set_editor_hint")
+	methodBindEngineSetIterationsPerSecond = gdnative.NewLazyMethodBind("_Engine", "set_iterations_per_second")
+	methodBindEngineSetTargetFps           = gdnative.NewLazyMethodBind("_Engine", "set_target_fps")
+	methodBindEngineSetTimeScale           = gdnative.NewLazyMethodBind("_Engine", "set_time_scale")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *engine) GetFramesDrawn() gdnative.Int {
 	o.ensureSingleton()
@@ -68,7 +79,7 @@ func (o *engine) GetFramesDrawn() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_frames_drawn")
+	methodBind := methodBindEngineGetFramesDrawn.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +92,8 @@ func (o *engine) GetFramesDrawn() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *engine) GetFramesPerSecond() gdnative.Real {
 	o.ensureSingleton()
@@ -92,7 +103,7 @@ func (o *engine) GetFramesPerSecond() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_frames_per_second")
+	methodBind := methodBindEngineGetFramesPerSecond.Get()
 
 	// Call the parent method.
 	// float
@@ -105,8 +116,8 @@ func (o *engine) GetFramesPerSecond() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *engine) GetIterationsPerSecond() gdnative.Int {
 	o.ensureSingleton()
@@ -116,7 +127,7 @@ func (o *engine) GetIterationsPerSecond() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_iterations_per_second")
+	methodBind := methodBindEngineGetIterationsPerSecond.Get()
 
 	// Call the parent method.
 	// int
@@ -129,48 +140,10 @@ func (o *engine) GetIterationsPerSecond() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: MainLoop
-*/
-func (o *engine) GetMainLoop() MainLoopImplementer {
-	o.ensureSingleton()
-	//log.Println("Calling _Engine.GetMainLoop()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_main_loop")
-
-	// Call the parent method.
-	// MainLoop
-	retPtr := gdnative.NewEmptyObject()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMainLoopFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MainLoopImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "MainLoop" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MainLoopImplementer)
-	}
-
-	return &ret
-}
-
-/*
-        Undocumented
-	Args: [{ false name String}], Returns: Object
+	        Undocumented
+		Args: [{ false name String}], Returns: Object
 */
-func (o *engine) GetSingleton(name gdnative.String) ObjectImplementer {
+func (o *engine) GetSingleton(name gdnative.String) godot.ObjectImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling _Engine.GetSingleton()")
 
@@ -179,7 +152,7 @@ func (o *engine) GetSingleton(name gdnative.String) ObjectImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_singleton")
+	methodBind := methodBindEngineGetSingleton.Get()
 
 	// Call the parent method.
 	// Object
@@ -187,27 +160,25 @@ func (o *engine) GetSingleton(name gdnative.String) ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *engine) GetTargetFps() gdnative.Int {
 	o.ensureSingleton()
@@ -217,7 +188,7 @@ func (o *engine) GetTargetFps() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_target_fps")
+	methodBind := methodBindEngineGetTargetFps.Get()
 
 	// Call the parent method.
 	// int
@@ -230,8 +201,8 @@ func (o *engine) GetTargetFps() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *engine) GetTimeScale() gdnative.Real {
 	o.ensureSingleton()
@@ -241,7 +212,7 @@ func (o *engine) GetTimeScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_time_scale")
+	methodBind := methodBindEngineGetTimeScale.Get()
 
 	// Call the parent method.
 	// float
@@ -254,8 +225,8 @@ func (o *engine) GetTimeScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *engine) GetVersionInfo() gdnative.Dictionary {
 	o.ensureSingleton()
@@ -265,7 +236,7 @@ func (o *engine) GetVersionInfo() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "get_version_info")
+	methodBind := methodBindEngineGetVersionInfo.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -278,8 +249,8 @@ func (o *engine) GetVersionInfo() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: bool
+	        Undocumented
+		Args: [{ false name String}], Returns: bool
 */
 func (o *engine) HasSingleton(name gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -290,7 +261,7 @@ func (o *engine) HasSingleton(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "has_singleton")
+	methodBind := methodBindEngineHasSingleton.Get()
 
 	// Call the parent method.
 	// bool
@@ -303,8 +274,8 @@ func (o *engine) HasSingleton(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *engine) IsEditorHint() gdnative.Bool {
 	o.ensureSingleton()
@@ -314,7 +285,7 @@ func (o *engine) IsEditorHint() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "is_editor_hint")
+	methodBind := methodBindEngineIsEditorHint.Get()
 
 	// Call the parent method.
 	// bool
@@ -327,8 +298,8 @@ func (o *engine) IsEditorHint() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *engine) IsInPhysicsFrame() gdnative.Bool {
 	o.ensureSingleton()
@@ -338,7 +309,7 @@ func (o *engine) IsInPhysicsFrame() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "is_in_physics_frame")
+	methodBind := methodBindEngineIsInPhysicsFrame.Get()
 
 	// Call the parent method.
 	// bool
@@ -351,8 +322,8 @@ func (o *engine) IsInPhysicsFrame() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *engine) SetEditorHint(enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -363,7 +334,7 @@ func (o *engine) SetEditorHint(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "set_editor_hint")
+	methodBind := methodBindEngineSetEditorHint.Get()
 
 	// Call the parent method.
 	// void
@@ -373,8 +344,8 @@ func (o *engine) SetEditorHint(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false iterations_per_second int}], Returns: void
+	        Undocumented
+		Args: [{ false iterations_per_second int}], Returns: void
 */
 func (o *engine) SetIterationsPerSecond(iterationsPerSecond gdnative.Int) {
 	o.ensureSingleton()
@@ -385,7 +356,7 @@ func (o *engine) SetIterationsPerSecond(iterationsPerSecond gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(iterationsPerSecond)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "set_iterations_per_second")
+	methodBind := methodBindEngineSetIterationsPerSecond.Get()
 
 	// Call the parent method.
 	// void
@@ -395,8 +366,8 @@ func (o *engine) SetIterationsPerSecond(iterationsPerSecond gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false target_fps int}], Returns: void
+	        Undocumented
+		Args: [{ false target_fps int}], Returns: void
 */
 func (o *engine) SetTargetFps(targetFps gdnative.Int) {
 	o.ensureSingleton()
@@ -407,7 +378,7 @@ func (o *engine) SetTargetFps(targetFps gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(targetFps)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "set_target_fps")
+	methodBind := methodBindEngineSetTargetFps.Get()
 
 	// Call the parent method.
 	// void
@@ -417,8 +388,8 @@ func (o *engine) SetTargetFps(targetFps gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false time_scale float}], Returns: void
+	        Undocumented
+		Args: [{ false time_scale float}], Returns: void
 */
 func (o *engine) SetTimeScale(timeScale gdnative.Real) {
 	o.ensureSingleton()
@@ -429,7 +400,7 @@ func (o *engine) SetTimeScale(timeScale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(timeScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Engine", "set_time_scale")
+	methodBind := methodBindEngineSetTimeScale.Get()
 
 	// Call the parent method.
 	// void
@@ -438,15 +409,34 @@ func (o *engine) SetTimeScale(timeScale gdnative.Real) {
 
 }
 
+// EditorHint will return the value of the "editor_hint" property.
+func (o *engine) EditorHint() gdnative.Bool {
+	return o.IsEditorHint()
+}
+
+// IterationsPerSecond will return the value of the "iterations_per_second" property.
+func (o *engine) IterationsPerSecond() gdnative.Int {
+	return o.GetIterationsPerSecond()
+}
+
+// TargetFps will return the value of the "target_fps" property.
+func (o *engine) TargetFps() gdnative.Int {
+	return o.GetTargetFps()
+}
+
+// TimeScale will return the value of the "time_scale" property.
+func (o *engine) TimeScale() gdnative.Real {
+	return o.GetTimeScale()
+}
+
 // EngineImplementer is an interface that implements the methods
 // of the Engine class.
 type EngineImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	GetFramesDrawn() gdnative.Int
 	GetFramesPerSecond() gdnative.Real
 	GetIterationsPerSecond() gdnative.Int
-	GetMainLoop() MainLoopImplementer
-	GetSingleton(name gdnative.String) ObjectImplementer
+	GetSingleton(name gdnative.String) godot.ObjectImplementer
 	GetTargetFps() gdnative.Int
 	GetTimeScale() gdnative.Real
 	GetVersionInfo() gdnative.Dictionary
@@ -457,4 +447,8 @@ type EngineImplementer interface {
 	SetIterationsPerSecond(iterationsPerSecond gdnative.Int)
 	SetTargetFps(targetFps gdnative.Int)
 	SetTimeScale(timeScale gdnative.Real)
+	EditorHint() gdnative.Bool
+	IterationsPerSecond() gdnative.Int
+	TargetFps() gdnative.Int
+	TimeScale() gdnative.Real
 }
@@ -0,0 +1,138 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type Reference struct {
+	Object
+	owner gdnative.Object
+}
+
+// classConstructorReference is the class constructor of the Reference class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorReference = gdnative.NewLazyClassConstructor("Reference")
+
+// NewReference will create a new instance of the Reference class.
+// Reference is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewReference() *Reference {
+	obj := &Reference{}
+	obj.SetBaseObject(classConstructorReference.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Reference) BaseClass() string {
+	return "Reference"
+}
+
+// Release will drop the reference held by the Go wrapper with Unreference(), and
+// free the object if it was the last reference. It must be called once for every
+// object created with one of the New functions of a reference type.
+func (o *Reference) Release() {
+	if o.Unreference() {
+		o.GetBaseObject().Destroy()
+	}
+}
+
+// Method binds of the Reference class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindReferenceInitRef         = gdnative.NewLazyMethodBind("Reference", "init_ref")
+	methodBindReferenceReferenceMethod = gdnative.NewLazyMethodBind("Reference", "reference")
+	methodBindReferenceUnreference     = gdnative.NewLazyMethodBind("Reference", "unreference")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Reference) InitRef() gdnative.Bool {
+	//log.Println("Calling Reference.InitRef()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindReferenceInitRef.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Reference) ReferenceMethod() gdnative.Bool {
+	//log.Println("Calling Reference.ReferenceMethod()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindReferenceReferenceMethod.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Reference) Unreference() gdnative.Bool {
+	//log.Println("Calling Reference.Unreference()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindReferenceUnreference.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+// ReferenceImplementer is an interface that implements the methods
+// of the Reference class.
+type ReferenceImplementer interface {
+	ObjectImplementer
+	InitRef() gdnative.Bool
+	ReferenceMethod() gdnative.Bool
+	Unreference() gdnative.Bool
+}
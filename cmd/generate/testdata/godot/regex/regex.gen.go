@@ -1,7 +1,8 @@
-package godot
+package regex
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,52 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRegExFromPointer(ptr gdnative.Pointer) RegEx {
-func newRegExFromPointer(ptr gdnative.Pointer) RegEx {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RegEx{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
 type RegEx struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorRegEx is the class constructor of the RegEx class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRegEx = gdnative.NewLazyClassConstructor("RegEx")
+
+// NewRegEx will create a new instance of the RegEx class.
+// RegEx is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewRegEx() *RegEx {
+	obj := &RegEx{}
+	obj.SetBaseObject(classConstructorRegEx.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RegEx) BaseClass() string {
 	return "RegEx"
 }
 
+// Method binds of the RegEx class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRegExClear         = gdnative.NewLazyMethodBind("RegEx", "clear")
+	methodBindRegExCompile       = gdnative.NewLazyMethodBind("RegEx", "compile")
+	methodBindRegExGetGroupCount = gdnative.NewLazyMethodBind("RegEx", "get_group_count")
+	methodBindRegExGetNames      = gdnative.NewLazyMethodBind("RegEx", "get_names")
+	methodBindRegExGetPattern    = gdnative.NewLazyMethodBind("RegEx", "get_pattern")
+	methodBindRegExIsValid       = gdnative.NewLazyMethodBind("RegEx", "is_valid")
+	methodBindRegExSearch        = gdnative.NewLazyMethodBind("RegEx", "search")
+	methodBindRegExSearchAll     = gdnative.NewLazyMethodBind("RegEx", "search_all")
+	methodBindRegExSub           = gdnative.NewLazyMethodBind("RegEx", "sub")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *RegEx) Clear() {
 	//log.Println("Calling RegEx.Clear()")
@@ -45,7 +68,7 @@ func (o *RegEx) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "clear")
+	methodBind := methodBindRegExClear.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +78,8 @@ func (o *RegEx) Clear() {
 }
 
 /*
-        Undocumented
-	Args: [{ false pattern String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false pattern String}], Returns: enum.Error
 */
 func (o *RegEx) Compile(pattern gdnative.String) gdnative.Error {
 	//log.Println("Calling RegEx.Compile()")
@@ -66,7 +89,7 @@ func (o *RegEx) Compile(pattern gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(pattern)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "compile")
+	methodBind := methodBindRegExCompile.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -79,8 +102,8 @@ func (o *RegEx) Compile(pattern gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *RegEx) GetGroupCount() gdnative.Int {
 	//log.Println("Calling RegEx.GetGroupCount()")
@@ -89,7 +112,7 @@ func (o *RegEx) GetGroupCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "get_group_count")
+	methodBind := methodBindRegExGetGroupCount.Get()
 
 	// Call the parent method.
 	// int
@@ -102,8 +125,8 @@ func (o *RegEx) GetGroupCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *RegEx) GetNames() gdnative.Array {
 	//log.Println("Calling RegEx.GetNames()")
@@ -112,7 +135,7 @@ func (o *RegEx) GetNames() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "get_names")
+	methodBind := methodBindRegExGetNames.Get()
 
 	// Call the parent method.
 	// Array
@@ -125,8 +148,8 @@ func (o *RegEx) GetNames() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *RegEx) GetPattern() gdnative.String {
 	//log.Println("Calling RegEx.GetPattern()")
@@ -135,7 +158,7 @@ func (o *RegEx) GetPattern() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "get_pattern")
+	methodBind := methodBindRegExGetPattern.Get()
 
 	// Call the parent method.
 	// String
@@ -148,8 +171,8 @@ func (o *RegEx) GetPattern() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RegEx) IsValid() gdnative.Bool {
 	//log.Println("Calling RegEx.IsValid()")
@@ -158,7 +181,7 @@ func (o *RegEx) IsValid() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "is_valid")
+	methodBind := methodBindRegExIsValid.Get()
 
 	// Call the parent method.
 	// bool
@@ -171,8 +194,8 @@ func (o *RegEx) IsValid() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false subject String} {0 true offset int} {-1 true end int}], Returns: RegExMatch
+	        Undocumented
+		Args: [{ false subject String} {0 true offset int} {-1 true end int}], Returns: RegExMatch
 */
 func (o *RegEx) Search(subject gdnative.String, offset gdnative.Int, end gdnative.Int) RegExMatchImplementer {
 	//log.Println("Calling RegEx.Search()")
@@ -184,7 +207,7 @@ func (o *RegEx) Search(subject gdnative.String, offset gdnative.Int, end gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromInt(end)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "search")
+	methodBind := methodBindRegExSearch.Get()
 
 	// Call the parent method.
 	// RegExMatch
@@ -192,27 +215,25 @@ func (o *RegEx) Search(subject gdnative.String, offset gdnative.Int, end gdnativ
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newRegExMatchFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(RegExMatchImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "RegExMatch" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(RegExMatchImplementer)
+	ret := RegExMatch{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(RegExMatchImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false subject String} {0 true offset int} {-1 true end int}], Returns: Array
+	        Undocumented
+		Args: [{ false subject String} {0 true offset int} {-1 true end int}], Returns: Array
 */
 func (o *RegEx) SearchAll(subject gdnative.String, offset gdnative.Int, end gdnative.Int) gdnative.Array {
 	//log.Println("Calling RegEx.SearchAll()")
@@ -224,7 +245,7 @@ func (o *RegEx) SearchAll(subject gdnative.String, offset gdnative.Int, end gdna
 	ptrArguments[2] = gdnative.NewPointerFromInt(end)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "search_all")
+	methodBind := methodBindRegExSearchAll.Get()
 
 	// Call the parent method.
 	// Array
@@ -237,8 +258,8 @@ func (o *RegEx) SearchAll(subject gdnative.String, offset gdnative.Int, end gdna
 }
 
 /*
-        Undocumented
-	Args: [{ false subject String} { false replacement String} {False true all bool} {0 true offset int} {-1 true end int}], Returns: String
+	        Undocumented
+		Args: [{ false subject String} { false replacement String} {False true all bool} {0 true offset int} {-1 true end int}], Returns: String
 */
 func (o *RegEx) Sub(subject gdnative.String, replacement gdnative.String, all gdnative.Bool, offset gdnative.Int, end gdnative.Int) gdnative.String {
 	//log.Println("Calling RegEx.Sub()")
@@ -252,7 +273,7 @@ func (o *RegEx) Sub(subject gdnative.String, replacement gdnative.String, all gd
 	ptrArguments[4] = gdnative.NewPointerFromInt(end)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegEx", "sub")
+	methodBind := methodBindRegExSub.Get()
 
 	// Call the parent method.
 	// String
@@ -264,11 +285,27 @@ func (o *RegEx) Sub(subject gdnative.String, replacement gdnative.String, all gd
 	return ret
 }
 
+// SearchWithDefaults will call Search using the default values for: offset, end.
+func (o *RegEx) SearchWithDefaults(subject gdnative.String) RegExMatchImplementer {
+	return o.Search(subject, 0, -1)
+}
+
+// SearchAllWithDefaults will call SearchAll using the default values for: offset, end.
+func (o *RegEx) SearchAllWithDefaults(subject gdnative.String) gdnative.Array {
+	return o.SearchAll(subject, 0, -1)
+}
+
+// SubWithDefaults will call Sub using the default values for: all, offset, end.
+func (o *RegEx) SubWithDefaults(subject gdnative.String, replacement gdnative.String) gdnative.String {
+	return o.Sub(subject, replacement, false, 0, -1)
+}
+
 // RegExImplementer is an interface that implements the methods
 // of the RegEx class.
 type RegExImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	Clear()
+	Compile(pattern gdnative.String) gdnative.Error
 	GetGroupCount() gdnative.Int
 	GetNames() gdnative.Array
 	GetPattern() gdnative.String
@@ -276,4 +313,7 @@ type RegExImplementer interface {
 	Search(subject gdnative.String, offset gdnative.Int, end gdnative.Int) RegExMatchImplementer
 	SearchAll(subject gdnative.String, offset gdnative.Int, end gdnative.Int) gdnative.Array
 	Sub(subject gdnative.String, replacement gdnative.String, all gdnative.Bool, offset gdnative.Int, end gdnative.Int) gdnative.String
+	SearchWithDefaults(subject gdnative.String) RegExMatchImplementer
+	SearchAllWithDefaults(subject gdnative.String) gdnative.Array
+	SubWithDefaults(subject gdnative.String, replacement gdnative.String) gdnative.String
 }
@@ -1,7 +1,8 @@
-package godot
+package regex
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,50 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRegExMatchFromPointer(ptr gdnative.Pointer) RegExMatch {
-func newRegExMatchFromPointer(ptr gdnative.Pointer) RegExMatch {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RegExMatch{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
 type RegExMatch struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorRegExMatch is the class constructor of the RegExMatch class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRegExMatch = gdnative.NewLazyClassConstructor("RegExMatch")
+
+// NewRegExMatch will create a new instance of the RegExMatch class.
+// RegExMatch is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewRegExMatch() *RegExMatch {
+	obj := &RegExMatch{}
+	obj.SetBaseObject(classConstructorRegExMatch.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RegExMatch) BaseClass() string {
 	return "RegExMatch"
 }
 
+// Method binds of the RegExMatch class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRegExMatchGetEnd        = gdnative.NewLazyMethodBind("RegExMatch", "get_end")
+	methodBindRegExMatchGetGroupCount = gdnative.NewLazyMethodBind("RegExMatch", "get_group_count")
+	methodBindRegExMatchGetNames      = gdnative.NewLazyMethodBind("RegExMatch", "get_names")
+	methodBindRegExMatchGetStart      = gdnative.NewLazyMethodBind("RegExMatch", "get_start")
+	methodBindRegExMatchGetString     = gdnative.NewLazyMethodBind("RegExMatch", "get_string")
+	methodBindRegExMatchGetStrings    = gdnative.NewLazyMethodBind("RegExMatch", "get_strings")
+	methodBindRegExMatchGetSubject    = gdnative.NewLazyMethodBind("RegExMatch", "get_subject")
+)
+
 /*
-        Undocumented
-	Args: [{0 true name Variant}], Returns: int
+	        Undocumented
+		Args: [{0 true name Variant}], Returns: int
 */
 func (o *RegExMatch) GetEnd(name gdnative.Variant) gdnative.Int {
 	//log.Println("Calling RegExMatch.GetEnd()")
@@ -46,7 +67,7 @@ func (o *RegExMatch) GetEnd(name gdnative.Variant) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegExMatch", "get_end")
+	methodBind := methodBindRegExMatchGetEnd.Get()
 
 	// Call the parent method.
 	// int
@@ -59,8 +80,8 @@ func (o *RegExMatch) GetEnd(name gdnative.Variant) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *RegExMatch) GetGroupCount() gdnative.Int {
 	//log.Println("Calling RegExMatch.GetGroupCount()")
@@ -69,7 +90,7 @@ func (o *RegExMatch) GetGroupCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegExMatch", "get_group_count")
+	methodBind := methodBindRegExMatchGetGroupCount.Get()
 
 	// Call the parent method.
 	// int
@@ -82,8 +103,8 @@ func (o *RegExMatch) GetGroupCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *RegExMatch) GetNames() gdnative.Dictionary {
 	//log.Println("Calling RegExMatch.GetNames()")
@@ -92,7 +113,7 @@ func (o *RegExMatch) GetNames() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegExMatch", "get_names")
+	methodBind := methodBindRegExMatchGetNames.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -105,8 +126,8 @@ func (o *RegExMatch) GetNames() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{0 true name Variant}], Returns: int
+	        Undocumented
+		Args: [{0 true name Variant}], Returns: int
 */
 func (o *RegExMatch) GetStart(name gdnative.Variant) gdnative.Int {
 	//log.Println("Calling RegExMatch.GetStart()")
@@ -116,7 +137,7 @@ func (o *RegExMatch) GetStart(name gdnative.Variant) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegExMatch", "get_start")
+	methodBind := methodBindRegExMatchGetStart.Get()
 
 	// Call the parent method.
 	// int
@@ -129,8 +150,8 @@ func (o *RegExMatch) GetStart(name gdnative.Variant) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{0 true name Variant}], Returns: String
+	        Undocumented
+		Args: [{0 true name Variant}], Returns: String
 */
 func (o *RegExMatch) GetString(name gdnative.Variant) gdnative.String {
 	//log.Println("Calling RegExMatch.GetString()")
@@ -140,7 +161,7 @@ func (o *RegExMatch) GetString(name gdnative.Variant) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegExMatch", "get_string")
+	methodBind := methodBindRegExMatchGetString.Get()
 
 	// Call the parent method.
 	// String
@@ -153,8 +174,8 @@ func (o *RegExMatch) GetString(name gdnative.Variant) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *RegExMatch) GetStrings() gdnative.Array {
 	//log.Println("Calling RegExMatch.GetStrings()")
@@ -163,7 +184,7 @@ func (o *RegExMatch) GetStrings() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegExMatch", "get_strings")
+	methodBind := methodBindRegExMatchGetStrings.Get()
 
 	// Call the parent method.
 	// Array
@@ -176,8 +197,8 @@ func (o *RegExMatch) GetStrings() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *RegExMatch) GetSubject() gdnative.String {
 	//log.Println("Calling RegExMatch.GetSubject()")
@@ -186,7 +207,7 @@ func (o *RegExMatch) GetSubject() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RegExMatch", "get_subject")
+	methodBind := methodBindRegExMatchGetSubject.Get()
 
 	// Call the parent method.
 	// String
@@ -198,10 +219,46 @@ func (o *RegExMatch) GetSubject() gdnative.String {
 	return ret
 }
 
+// GetEndWithDefaults will call GetEnd using the default values for: name.
+func (o *RegExMatch) GetEndWithDefaults() gdnative.Int {
+	default0 := gdnative.NewVariantInt(0)
+	defer default0.Destroy()
+	return o.GetEnd(default0)
+}
+
+// GetStartWithDefaults will call GetStart using the default values for: name.
+func (o *RegExMatch) GetStartWithDefaults() gdnative.Int {
+	default0 := gdnative.NewVariantInt(0)
+	defer default0.Destroy()
+	return o.GetStart(default0)
+}
+
+// GetStringWithDefaults will call GetString using the default values for: name.
+func (o *RegExMatch) GetStringWithDefaults() gdnative.String {
+	default0 := gdnative.NewVariantInt(0)
+	defer default0.Destroy()
+	return o.GetString(default0)
+}
+
+// Names will return the value of the "names" property.
+func (o *RegExMatch) Names() gdnative.Dictionary {
+	return o.GetNames()
+}
+
+// Strings will return the value of the "strings" property.
+func (o *RegExMatch) Strings() gdnative.Array {
+	return o.GetStrings()
+}
+
+// Subject will return the value of the "subject" property.
+func (o *RegExMatch) Subject() gdnative.String {
+	return o.GetSubject()
+}
+
 // RegExMatchImplementer is an interface that implements the methods
 // of the RegExMatch class.
 type RegExMatchImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetEnd(name gdnative.Variant) gdnative.Int
 	GetGroupCount() gdnative.Int
 	GetNames() gdnative.Dictionary
@@ -209,4 +266,10 @@ type RegExMatchImplementer interface {
 	GetString(name gdnative.Variant) gdnative.String
 	GetStrings() gdnative.Array
 	GetSubject() gdnative.String
+	GetEndWithDefaults() gdnative.Int
+	GetStartWithDefaults() gdnative.Int
+	GetStringWithDefaults() gdnative.String
+	Names() gdnative.Dictionary
+	Strings() gdnative.Array
+	Subject() gdnative.String
 }
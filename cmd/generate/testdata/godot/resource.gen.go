@@ -0,0 +1,430 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type Resource struct {
+	Reference
+	owner gdnative.Object
+}
+
+// classConstructorResource is the class constructor of the Resource class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorResource = gdnative.NewLazyClassConstructor("Resource")
+
+// NewResource will create a new instance of the Resource class.
+// Resource is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewResource() *Resource {
+	obj := &Resource{}
+	obj.SetBaseObject(classConstructorResource.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Resource) BaseClass() string {
+	return "Resource"
+}
+
+// Method binds of the Resource class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindResourceX_SetupLocalToScene = gdnative.NewLazyMethodBind("Resource", "_setup_local_to_scene")
+	methodBindResourceDuplicate           = gdnative.NewLazyMethodBind("Resource", "duplicate")
+	methodBindResourceGetLocalScene       = gdnative.NewLazyMethodBind("Resource", "get_local_scene")
+	methodBindResourceGetName             = gdnative.NewLazyMethodBind("Resource", "get_name")
+	methodBindResourceGetPath             = gdnative.NewLazyMethodBind("Resource", "get_path")
+	methodBindResourceGetRid              = gdnative.NewLazyMethodBind("Resource", "get_rid")
+	methodBindResourceIsLocalToScene      = gdnative.NewLazyMethodBind("Resource", "is_local_to_scene")
+	methodBindResourceSetLocalToScene     = gdnative.NewLazyMethodBind("Resource", "set_local_to_scene")
+	methodBindResourceSetName             = gdnative.NewLazyMethodBind("Resource", "set_name")
+	methodBindResourceSetPath             = gdnative.NewLazyMethodBind("Resource", "set_path")
+	methodBindResourceSetupLocalToScene   = gdnative.NewLazyMethodBind("Resource", "setup_local_to_scene")
+	methodBindResourceTakeOverPath        = gdnative.NewLazyMethodBind("Resource", "take_over_path")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Resource) X_SetupLocalToScene() {
+	//log.Println("Calling Resource.X_SetupLocalToScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindResourceX_SetupLocalToScene.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{False true subresources bool}], Returns: Resource
+*/
+func (o *Resource) Duplicate(subresources gdnative.Bool) ResourceImplementer {
+	//log.Println("Calling Resource.Duplicate()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(subresources)
+
+	// Get the method bind
+	methodBind := methodBindResourceDuplicate.Get()
+
+	// Call the parent method.
+	// Resource
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ResourceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Node
+*/
+func (o *Resource) GetLocalScene() NodeImplementer {
+	//log.Println("Calling Resource.GetLocalScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindResourceGetLocalScene.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Resource) GetName() gdnative.String {
+	//log.Println("Calling Resource.GetName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindResourceGetName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Resource) GetPath() gdnative.String {
+	//log.Println("Calling Resource.GetPath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindResourceGetPath.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: RID
+*/
+func (o *Resource) GetRid() gdnative.Rid {
+	//log.Println("Calling Resource.GetRid()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindResourceGetRid.Get()
+
+	// Call the parent method.
+	// RID
+	retPtr := gdnative.NewEmptyRid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRidFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Resource) IsLocalToScene() gdnative.Bool {
+	//log.Println("Calling Resource.IsLocalToScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindResourceIsLocalToScene.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Resource) SetLocalToScene(enable gdnative.Bool) {
+	//log.Println("Calling Resource.SetLocalToScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindResourceSetLocalToScene.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: void
+*/
+func (o *Resource) SetName(name gdnative.String) {
+	//log.Println("Calling Resource.SetName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindResourceSetName.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false path String}], Returns: void
+*/
+func (o *Resource) SetPath(path gdnative.String) {
+	//log.Println("Calling Resource.SetPath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindResourceSetPath.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Resource) SetupLocalToScene() {
+	//log.Println("Calling Resource.SetupLocalToScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindResourceSetupLocalToScene.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false path String}], Returns: void
+*/
+func (o *Resource) TakeOverPath(path gdnative.String) {
+	//log.Println("Calling Resource.TakeOverPath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindResourceTakeOverPath.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// DuplicateWithDefaults will call Duplicate using the default values for: subresources.
+func (o *Resource) DuplicateWithDefaults() ResourceImplementer {
+	return o.Duplicate(false)
+}
+
+// ResourceLocalToScene will return the value of the "resource_local_to_scene" property.
+func (o *Resource) ResourceLocalToScene() gdnative.Bool {
+	return o.IsLocalToScene()
+}
+
+// SetResourceLocalToScene will set the value of the "resource_local_to_scene" property.
+func (o *Resource) SetResourceLocalToScene(value gdnative.Bool) {
+	o.SetLocalToScene(value)
+}
+
+// ResourceName will return the value of the "resource_name" property.
+func (o *Resource) ResourceName() gdnative.String {
+	return o.GetName()
+}
+
+// SetResourceName will set the value of the "resource_name" property.
+func (o *Resource) SetResourceName(value gdnative.String) {
+	o.SetName(value)
+}
+
+// ResourcePath will return the value of the "resource_path" property.
+func (o *Resource) ResourcePath() gdnative.String {
+	return o.GetPath()
+}
+
+// SetResourcePath will set the value of the "resource_path" property.
+func (o *Resource) SetResourcePath(value gdnative.String) {
+	o.SetPath(value)
+}
+
+// Signals of the Resource class.
+const (
+	ResourceSignalChanged gdnative.String = "changed"
+)
+
+// ConnectChanged will connect the "changed" signal to the given method of the target object.
+func (o *Resource) ConnectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ResourceSignalChanged, target, method, binds, 0)
+}
+
+// EmitChanged will emit the "changed" signal.
+func (o *Resource) EmitChanged() error {
+
+	ret, err := o.EmitSignal(ResourceSignalChanged)
+	ret.Destroy()
+	return err
+}
+
+// ResourceImplementer is an interface that implements the methods
+// of the Resource class.
+type ResourceImplementer interface {
+	ReferenceImplementer
+	X_SetupLocalToScene()
+	Duplicate(subresources gdnative.Bool) ResourceImplementer
+	GetLocalScene() NodeImplementer
+	GetName() gdnative.String
+	GetPath() gdnative.String
+	GetRid() gdnative.Rid
+	IsLocalToScene() gdnative.Bool
+	SetLocalToScene(enable gdnative.Bool)
+	SetName(name gdnative.String)
+	SetPath(path gdnative.String)
+	SetupLocalToScene()
+	TakeOverPath(path gdnative.String)
+	DuplicateWithDefaults() ResourceImplementer
+	ResourceLocalToScene() gdnative.Bool
+	SetResourceLocalToScene(value gdnative.Bool)
+	ResourceName() gdnative.String
+	SetResourceName(value gdnative.String)
+	ResourcePath() gdnative.String
+	SetResourcePath(value gdnative.String)
+	ConnectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitChanged() error
+}
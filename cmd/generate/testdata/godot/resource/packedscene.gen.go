@@ -0,0 +1,235 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// PackedSceneGenEditState is an enum for GenEditState values.
+type PackedSceneGenEditState int
+
+const (
+	PackedSceneGenEditStateDisabled PackedSceneGenEditState = 0
+	PackedSceneGenEditStateInstance PackedSceneGenEditState = 1
+	PackedSceneGenEditStateMain     PackedSceneGenEditState = 2
+)
+
+// String will return the Godot name of the PackedSceneGenEditState value.
+func (e PackedSceneGenEditState) String() string {
+	switch e {
+	case PackedSceneGenEditStateDisabled:
+		return "GEN_EDIT_STATE_DISABLED"
+	case PackedSceneGenEditStateInstance:
+		return "GEN_EDIT_STATE_INSTANCE"
+	case PackedSceneGenEditStateMain:
+		return "GEN_EDIT_STATE_MAIN"
+	}
+	return fmt.Sprintf("PackedSceneGenEditState(%d)", int(e))
+}
+
+// ParsePackedSceneGenEditState will return the PackedSceneGenEditState value with the given Godot name.
+func ParsePackedSceneGenEditState(name string) (PackedSceneGenEditState, error) {
+	switch name {
+	case "GEN_EDIT_STATE_DISABLED":
+		return PackedSceneGenEditStateDisabled, nil
+	case "GEN_EDIT_STATE_INSTANCE":
+		return PackedSceneGenEditStateInstance, nil
+	case "GEN_EDIT_STATE_MAIN":
+		return PackedSceneGenEditStateMain, nil
+	}
+	return 0, fmt.Errorf("invalid PackedSceneGenEditState value %q", name)
+}
+
+/*
+Undocumented
+*/
+type PackedScene struct {
+	godot.Resource
+	owner gdnative.Object
+}
+
+// classConstructorPackedScene is the class constructor of the PackedScene class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPackedScene = gdnative.NewLazyClassConstructor("PackedScene")
+
+// NewPackedScene will create a new instance of the PackedScene class.
+// PackedScene is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPackedScene() *PackedScene {
+	obj := &PackedScene{}
+	obj.SetBaseObject(classConstructorPackedScene.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *PackedScene) BaseClass() string {
+	return "PackedScene"
+}
+
+// Method binds of the PackedScene class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPackedSceneX_GetBundledScene = gdnative.NewLazyMethodBind("PackedScene", "_get_bundled_scene")
+	methodBindPackedSceneX_SetBundledScene = gdnative.NewLazyMethodBind("PackedScene", "_set_bundled_scene")
+	methodBindPackedSceneCanInstance       = gdnative.NewLazyMethodBind("PackedScene", "can_instance")
+	methodBindPackedSceneInstance          = gdnative.NewLazyMethodBind("PackedScene", "instance")
+	methodBindPackedScenePack              = gdnative.NewLazyMethodBind("PackedScene", "pack")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Dictionary
+*/
+func (o *PackedScene) X_GetBundledScene() gdnative.Dictionary {
+	//log.Println("Calling PackedScene.X_GetBundledScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPackedSceneX_GetBundledScene.Get()
+
+	// Call the parent method.
+	// Dictionary
+	retPtr := gdnative.NewEmptyDictionary()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewDictionaryFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Dictionary}], Returns: void
+*/
+func (o *PackedScene) X_SetBundledScene(arg0 gdnative.Dictionary) {
+	//log.Println("Calling PackedScene.X_SetBundledScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromDictionary(arg0)
+
+	// Get the method bind
+	methodBind := methodBindPackedSceneX_SetBundledScene.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *PackedScene) CanInstance() gdnative.Bool {
+	//log.Println("Calling PackedScene.CanInstance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPackedSceneCanInstance.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{0 true edit_state enum.PackedScene::GenEditState}], Returns: Node
+*/
+func (o *PackedScene) Instance(editState PackedSceneGenEditState) godot.NodeImplementer {
+	//log.Println("Calling PackedScene.Instance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(editState))
+
+	// Get the method bind
+	methodBind := methodBindPackedSceneInstance.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false path Object}], Returns: enum.Error
+*/
+func (o *PackedScene) Pack(path godot.ObjectImplementer) gdnative.Error {
+	//log.Println("Calling PackedScene.Pack()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(path))
+
+	// Get the method bind
+	methodBind := methodBindPackedScenePack.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+// InstanceWithDefaults will call Instance using the default values for: edit_state.
+func (o *PackedScene) InstanceWithDefaults() godot.NodeImplementer {
+	return o.Instance(0)
+}
+
+// PackedSceneImplementer is an interface that implements the methods
+// of the PackedScene class.
+type PackedSceneImplementer interface {
+	godot.ResourceImplementer
+	X_GetBundledScene() gdnative.Dictionary
+	X_SetBundledScene(arg0 gdnative.Dictionary)
+	CanInstance() gdnative.Bool
+	Instance(editState PackedSceneGenEditState) godot.NodeImplementer
+	Pack(path godot.ObjectImplementer) gdnative.Error
+	InstanceWithDefaults() godot.NodeImplementer
+}
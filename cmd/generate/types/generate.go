@@ -3,16 +3,13 @@
 package types
 
 import (
-	"bytes"
-	"github.com/kr/pretty"
 	"github.com/pinzolo/casee"
 	"github.com/shadowapex/godot-go/cmd/generate/methods"
+	"github.com/shadowapex/godot-go/cmd/generate/render"
 	"log"
-	"os"
-	"os/exec"
+	"path/filepath"
 	"sort"
 	"strings"
-	"text/template"
 )
 
 // View is a structure that holds the api struct, so it can be used inside
@@ -275,15 +272,7 @@ type Method struct {
 }
 
 // Generate will generate Go wrappers for all Godot base types
-func Generate() {
-
-	// Get the GOPATH so we can locate our templates.
-	goPath := os.Getenv("GOPATH")
-	if goPath == "" {
-		panic("$GOPATH is not defined. Run 'export GOPATH=/path/to/go/path' before executing this.")
-	}
-	packagePath := goPath + "/src/github.com/shadowapex/godot-go"
-
+func Generate(config render.Config, w *render.Writer) {
 	// Set up headers/structs to ignore. Definitions in the given headers
 	// with the given name will not be added to the returned list of type definitions.
 	// We'll need to manually create these structures.
@@ -320,7 +309,7 @@ func Generate() {
 	}
 
 	// Parse all available methods
-	gdnativeAPI := methods.Parse()
+	gdnativeAPI := methods.Parse(config.GDNativeAPI)
 
 	// Convert the API definitions into a method struct
 	allMethodDefinitions := []Method{}
@@ -334,7 +323,7 @@ func Generate() {
 	}
 
 	// Parse the Godot header files for type definitions
-	allTypeDefinitions := Parse(ignoreHeaders, ignoreStructs)
+	allTypeDefinitions := Parse(config.HeadersDir, ignoreHeaders, ignoreStructs)
 
 	// Create a map of the type definitions by header name
 	defMap := map[string][]TypeDef{}
@@ -347,7 +336,6 @@ func Generate() {
 			defMap[typeDef.HeaderName] = []TypeDef{typeDef}
 		}
 	}
-	pretty.Println(defMap)
 
 	// Loop through each header name and generate the Go code in a file based
 	// on the header name.
@@ -382,61 +370,16 @@ func Generate() {
 
 		// Write the file using our template.
 		WriteTemplate(
-			packagePath+"/cmd/generate/templates/types.go.tmpl",
-			packagePath+"/gdnative/"+outFileName,
+			w,
+			config.Template("types.go.tmpl"),
+			filepath.Join(config.GDNativeOut, outFileName),
 			view,
 		)
-
-		// Run gofmt on the generated Go file.
-		log.Println("  Running gofmt on output:", outFileName+"...")
-		GoFmt(packagePath + "/gdnative/" + outFileName)
-
-		log.Println("  Running goimports on output:", outFileName+"...")
-		GoImports(packagePath + "/gdnative/" + outFileName)
 	}
-
-	pretty.Println(allMethodDefinitions)
 }
 
-func WriteTemplate(templatePath, outputPath string, view View) {
-	// Create a template from our template file.
-	t, err := template.ParseFiles(templatePath)
-	if err != nil {
-		log.Fatal("Error parsing template:", err)
-	}
-
-	// Open the output file for writing
-	f, err := os.Create(outputPath)
-	defer f.Close()
-	if err != nil {
+func WriteTemplate(w *render.Writer, templatePath, outputPath string, view View) {
+	if err := w.Template(templatePath, outputPath, view); err != nil {
 		panic(err)
 	}
-
-	// Write the template with the given view.
-	err = t.Execute(f, view)
-	if err != nil {
-		panic(err)
-	}
-}
-
-func GoFmt(filePath string) {
-	cmd := exec.Command("gofmt", "-w", filePath)
-	var stdErr bytes.Buffer
-	cmd.Stderr = &stdErr
-	err := cmd.Run()
-	if err != nil {
-		log.Println("Error running gofmt:", err)
-		panic(stdErr.String())
-	}
-}
-
-func GoImports(filePath string) {
-	cmd := exec.Command("goimports", "-w", filePath)
-	var stdErr bytes.Buffer
-	cmd.Stderr = &stdErr
-	err := cmd.Run()
-	if err != nil {
-		log.Println("Error running goimports:", err)
-		panic(stdErr.String())
-	}
 }
@@ -11,23 +11,19 @@ import (
 	"strings"
 )
 
-// Parse will parse the GDNative headers. Takes a list of headers/structs to ignore.
+// Parse will parse the GDNative headers in the given directory. Takes a list of headers/structs to ignore.
 // Definitions in the given headers and definitions
 // with the given name will not be added to the returned list of type definitions.
 // We'll need to manually create these structures.
-func Parse(excludeHeaders, excludeStructs []string) []TypeDef {
-
-	// Get the GOPATH so we can locate the godot headers.
-	goPath := os.Getenv("GOPATH")
-	if goPath == "" {
-		panic("$GOPATH is not defined. Run 'export GOPATH=/path/to/go/path' before executing this.")
-	}
-	packagePath := goPath + "/src/github.com/shadowapex/godot-go"
+func Parse(searchDir string, excludeHeaders, excludeStructs []string) []TypeDef {
 
 	// Walk through all of the godot header files
-	searchDir := packagePath + "/godot_headers"
+	searchDir = filepath.Clean(searchDir)
 	fileList := []string{}
 	err := filepath.Walk(searchDir, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
 		if !f.IsDir() && strings.Contains(path, ".h") {
 			fileList = append(fileList, path)
 		}
@@ -3,6 +3,8 @@ package main
 import (
 	gd "github.com/shadowapex/godot-go/gdnative"
 	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/canvasitem"
+	"github.com/shadowapex/godot-go/godot/node"
 	"log"
 	"math/rand"
 )
@@ -38,12 +40,12 @@ func NewMain() godot.Class {
 type Main struct {
 	godot.Node
 	Mob           godot.PackedSceneImplementer `hint:"ResourceType" usage:"Default"`
-	mobPath       godot.Path2DImplementer
-	mobTimer      godot.TimerImplementer
+	mobPath       canvasitem.Path2DImplementer
+	mobTimer      node.TimerImplementer
 	player        *Player
 	score         int
-	scoreTimer    godot.TimerImplementer
-	startTimer    godot.TimerImplementer
+	scoreTimer    node.TimerImplementer
+	startTimer    node.TimerImplementer
 	startPosition godot.Node2DImplementer
 }
 
@@ -55,15 +57,15 @@ func (m *Main) X_Ready() {
 	// Get our score and mob timers
 	scoreTimerPath := gd.NewNodePath("ScoreTimer")
 	scoreTimerNode := m.GetNode(scoreTimerPath)
-	m.scoreTimer = scoreTimerNode.(godot.TimerImplementer)
+	m.scoreTimer = scoreTimerNode.(node.TimerImplementer)
 
 	startTimerPath := gd.NewNodePath("StartTimer")
 	startTimerNode := m.GetNode(startTimerPath)
-	m.startTimer = startTimerNode.(godot.TimerImplementer)
+	m.startTimer = startTimerNode.(node.TimerImplementer)
 
 	mobTimerPath := gd.NewNodePath("MobTimer")
 	mobTimerNode := m.GetNode(mobTimerPath)
-	m.mobTimer = mobTimerNode.(godot.TimerImplementer)
+	m.mobTimer = mobTimerNode.(node.TimerImplementer)
 
 	// Get the player
 	playerPath := gd.NewNodePath("Player")
@@ -78,7 +80,7 @@ func (m *Main) X_Ready() {
 	// Get the mob path
 	mobPathPath := gd.NewNodePath("MobPath")
 	mobPathNode := m.GetNode(mobPathPath)
-	m.mobPath = mobPathNode.(godot.Path2DImplementer)
+	m.mobPath = mobPathNode.(canvasitem.Path2DImplementer)
 
 	// Start the game
 	m.NewGame()
@@ -111,13 +113,13 @@ func (m *Main) X_OnScoreTimerTimeout() {
 func (m *Main) X_OnMobTimerTimeout() {
 	log.Println("Mob timer timeout")
 	mobSpawnLocationPath := gd.NewNodePath("MobSpawnLocation")
-	mobSpawnLocation := (m.mobPath.GetNode(mobSpawnLocationPath)).(godot.PathFollow2DImplementer)
+	mobSpawnLocation := (m.mobPath.GetNode(mobSpawnLocationPath)).(canvasitem.PathFollow2DImplementer)
 	mobSpawnLocation.SetOffset(gd.Real(rand.Int()))
 
 	// Create a mob instance and add it to the scene
 	if m.Mob.CanInstance() {
-		mob := m.Mob.Instance(gd.Int(godot.PackedSceneGenEditStateDisabled))
-		m.AddChild(mob, false)
+		mob := m.Mob.Instance(godot.PackedSceneGenEditStateDisabled)
+		m.AddChildWithDefaults(mob)
 	}
 }
 
@@ -3,6 +3,7 @@ package main
 import (
 	gd "github.com/shadowapex/godot-go/gdnative"
 	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/canvasitem"
 	"log"
 	"math/rand"
 )
@@ -16,10 +17,10 @@ func NewMob() godot.Class {
 
 // Mob is a structure for enemy mobs.
 type Mob struct {
-	godot.RigidBody2D
+	canvasitem.RigidBody2D
 	MinSpeed       gd.Real
 	MaxSpeed       gd.Real
-	animatedSprite godot.AnimatedSpriteImplementer
+	animatedSprite canvasitem.AnimatedSpriteImplementer
 }
 
 // X_Ready will be called as soon as the mob enters the scene.
@@ -29,7 +30,7 @@ func (m *Mob) X_Ready() {
 	// Get the AnimatedSprite child node.
 	animatedSpritePath := gd.NewNodePath("AnimatedSprite")
 	animatedSpriteNode := m.GetNode(animatedSpritePath)
-	m.animatedSprite = animatedSpriteNode.(godot.AnimatedSpriteImplementer)
+	m.animatedSprite = animatedSpriteNode.(canvasitem.AnimatedSpriteImplementer)
 
 	// Set up different mob types
 	mobTypes := []gd.String{"walk", "swim", "fly"}
@@ -3,6 +3,8 @@ package main
 import (
 	gd "github.com/shadowapex/godot-go/gdnative"
 	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/canvasitem"
+	"github.com/shadowapex/godot-go/godot/object"
 	"log"
 )
 
@@ -21,12 +23,12 @@ func NewPlayer() godot.Class {
 
 // Player is a structure for the player.
 type Player struct {
-	godot.Area2D
+	canvasitem.Area2D
 	Speed          gd.Real `hint_string:"The speed of the player"`
 	Hit            godot.Signal
 	screenSize     gd.Vector2
-	animatedSprite godot.AnimatedSpriteImplementer
-	collisionShape godot.CollisionShape2DImplementer
+	animatedSprite canvasitem.AnimatedSpriteImplementer
+	collisionShape canvasitem.CollisionShape2DImplementer
 }
 
 // X_Ready will be called as soon as the player enters the scene.
@@ -38,12 +40,12 @@ func (p *Player) X_Ready() {
 	animatedSpritePath := gd.NewNodePath("AnimatedSprite")
 	animatedSpriteNode := p.GetNode(animatedSpritePath)
 	log.Println("Got animated sprite with ID:", animatedSpriteNode.GetBaseObject().ID())
-	p.animatedSprite = animatedSpriteNode.(godot.AnimatedSpriteImplementer)
+	p.animatedSprite = animatedSpriteNode.(canvasitem.AnimatedSpriteImplementer)
 
 	// Get the collision shape child node.
 	collisionShapePath := gd.NewNodePath("CollisionShape2D")
 	collisionShapeNode := p.GetNode(collisionShapePath)
-	p.collisionShape = collisionShapeNode.(godot.CollisionShape2DImplementer)
+	p.collisionShape = collisionShapeNode.(canvasitem.CollisionShape2DImplementer)
 
 	// Get the viewport size
 	viewportRect := p.GetViewportRect()
@@ -54,16 +56,16 @@ func (p *Player) X_Ready() {
 func (p *Player) X_Process(delta gd.Real) {
 	velocity := gd.NewVector2(0, 0)
 
-	if godot.Input.IsActionPressed("ui_right") {
+	if object.Input.IsActionPressed("ui_right") {
 		velocity.SetX(velocity.GetX() + 1)
 	}
-	if godot.Input.IsActionPressed("ui_left") {
+	if object.Input.IsActionPressed("ui_left") {
 		velocity.SetX(velocity.GetX() - 1)
 	}
-	if godot.Input.IsActionPressed("ui_down") {
+	if object.Input.IsActionPressed("ui_down") {
 		velocity.SetY(velocity.GetY() + 1)
 	}
-	if godot.Input.IsActionPressed("ui_up") {
+	if object.Input.IsActionPressed("ui_up") {
 		velocity.SetY(velocity.GetY() - 1)
 	}
 
@@ -10,12 +10,15 @@ package gdnative
 #include <gdnative_api_struct.gen.h>
 #include "gdnative.gen.h"
 #include "util.h"
+#include "variant.h"
 */
 import "C"
 
 import (
 	"fmt"
 	"log"
+	"strings"
+	"sync"
 	"unsafe"
 
 	"github.com/vitaminwater/cgo.wchar"
@@ -36,6 +39,7 @@ var GDNative = &gdNative{}
 // gdNative is a structure that wraps the GDNativeAPI.
 type gdNative struct {
 	api         *C.godot_gdnative_core_api_struct
+	library     Object
 	initialized bool
 }
 
@@ -44,6 +48,11 @@ func (g *gdNative) IsInitialized() bool {
 	return g.initialized
 }
 
+// GetLibrary will return the GDNativeLibrary resource that loaded this library.
+func (g *gdNative) GetLibrary() Object {
+	return g.library
+}
+
 // CheckInit will check to see if GDNative has initialized. If it is not, it will
 // throw a panic.
 func (g *gdNative) checkInit() {
@@ -60,7 +69,9 @@ func godot_gdnative_init(options *C.godot_gdnative_init_options) {
 	// library is loaded. This API struct will have all of the functions
 	// to call.
 	GDNative.api = (*options).api_struct
+	GDNative.library = Object{base: (*C.godot_object)((*options).gd_native_library)}
 	GDNative.initialized = true
+	C.go_set_main_thread()
 
 	// Configure logging.
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
@@ -78,6 +89,17 @@ func godot_gdnative_init(options *C.godot_gdnative_init_options) {
 				log.Println("Found nativescript extension!")
 			}
 			NativeScript.api = (*C.godot_gdnative_ext_nativescript_api_struct)(unsafe.Pointer(extension))
+
+			// Newer versions of the API are found by walking the "next" chain
+			// of the extension.
+			for next := extension.next; next != nil; next = next.next {
+				if next.version.major == 1 && next.version.minor == 1 {
+					if debug {
+						log.Println("Found nativescript 1.1 extension!")
+					}
+					NativeScript.api11 = (*C.godot_gdnative_ext_nativescript_1_1_api_struct)(unsafe.Pointer(next))
+				}
+			}
 		}
 	}
 }
@@ -91,7 +113,9 @@ func godot_gdnative_terminate(options *C.godot_gdnative_terminate_options) {
 		log.Println("De-initializing Go library.")
 	}
 	GDNative.api = nil
+	GDNative.library = Object{}
 	NativeScript.api = nil
+	NativeScript.api11 = nil
 }
 
 func NewEmptyVoid() Pointer {
@@ -108,12 +132,102 @@ func GetSingleton(name String) Object {
 
 	// Create a C string from the name argument.
 	cName := C.CString(string(name))
+	defer C.free(unsafe.Pointer(cName))
 
 	// Call the C method
 	obj := C.go_godot_global_get_singleton(GDNative.api, cName)
 	return Object{base: (*C.godot_object)(obj)}
 }
 
+// GetGlobalConstants will return a dictionary of all of Godot's global constants,
+// using the constant names as keys.
+func GetGlobalConstants() Dictionary {
+	GDNative.checkInit()
+	constants := C.go_godot_get_global_constants(GDNative.api)
+	return Dictionary{base: &constants}
+}
+
+// ClassConstructor is a wrapper around a Godot class constructor function, which
+// can be used to create new instances of engine classes.
+type ClassConstructor struct {
+	base C.godot_class_constructor
+}
+
+// GetClassConstructor will return the constructor for the given Godot class
+// name. If the class cannot be instanced, the returned constructor will not be
+// valid.
+func GetClassConstructor(class string) ClassConstructor {
+	if debug {
+		log.Println("Getting class constructor for:", class)
+	}
+	GDNative.checkInit()
+
+	// Create a C string from the class name and free it when we're done.
+	cClass := C.CString(class)
+	defer C.free(unsafe.Pointer(cClass))
+
+	// Call the C method
+	constructor := C.go_godot_get_class_constructor(GDNative.api, cClass)
+	return ClassConstructor{base: constructor}
+}
+
+// IsValid will return true if the constructor can be used to create objects.
+func (c ClassConstructor) IsValid() bool {
+	return c.base != nil
+}
+
+// Call will create a new instance of the constructor's class. The returned object
+// is owned by the caller and must be freed with Object.Destroy, unless it is
+// a Reference type. Reference types must have their first reference taken with
+// init_ref, and are freed when their last reference is dropped.
+func (c ClassConstructor) Call() Object {
+	GDNative.checkInit()
+	if !c.IsValid() {
+		panic("Class constructor was nil when calling ClassConstructor.Call")
+	}
+
+	obj := C.go_godot_class_constructor_call(c.base)
+	return Object{base: (*C.godot_object)(obj)}
+}
+
+// LazyClassConstructor is a class constructor that is looked up the first time it
+// is used and reused afterwards. This allows class constructors to be declared
+// before GDNative has been initialized.
+type LazyClassConstructor struct {
+	class       string
+	lock        sync.Mutex
+	constructor ClassConstructor
+}
+
+// NewLazyClassConstructor will return a lazily looked up class constructor for
+// the given class name.
+func NewLazyClassConstructor(class string) *LazyClassConstructor {
+	return &LazyClassConstructor{class: class}
+}
+
+// Get will return the class constructor, looking it up if it has not been found
+// yet.
+func (c *LazyClassConstructor) Get() ClassConstructor {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.constructor.IsValid() {
+		c.constructor = GetClassConstructor(c.class)
+	}
+
+	return c.constructor
+}
+
+// Destroy will free the given Godot object. This should only be used on objects
+// that do not inherit from Reference, since those are freed by Godot when
+// their reference count reaches zero.
+func (gdt Object) Destroy() {
+	GDNative.checkInit()
+	if gdt.getBase() == nil {
+		return
+	}
+	C.go_godot_object_destroy(GDNative.api, unsafe.Pointer(gdt.getBase()))
+}
+
 // NewMethodBind will return a method binding using the given class name and method
 // name.
 func NewMethodBind(class, method string) MethodBind {
@@ -121,25 +235,68 @@ func NewMethodBind(class, method string) MethodBind {
 		log.Println("Creating method bind for:", class+"."+method)
 	}
 	GDNative.checkInit()
+
+	// Create C strings from the class and method names.
+	cClass := C.CString(class)
+	defer C.free(unsafe.Pointer(cClass))
+	cMethod := C.CString(method)
+	defer C.free(unsafe.Pointer(cMethod))
+
 	methodBind := C.go_godot_method_bind_get_method(
 		GDNative.api,
-		C.CString(class),
-		C.CString(method),
+		cClass,
+		cMethod,
 	)
 
-	return MethodBind{base: methodBind}
+	bind := MethodBind{base: methodBind}
+	addThreadSafeBind(method, bind)
+
+	return bind
+}
+
+// LazyMethodBind is a method binding that is looked up the first time it is
+// used and reused afterwards. This allows method binds to be declared before
+// GDNative has been initialized.
+type LazyMethodBind struct {
+	class  string
+	method string
+	lock   sync.Mutex
+	bind   MethodBind
+}
+
+// NewLazyMethodBind will return a lazily looked up method binding using the
+// given class name and method name.
+func NewLazyMethodBind(class, method string) *LazyMethodBind {
+	return &LazyMethodBind{class: class, method: method}
+}
+
+// Get will return the method binding, looking it up if it has not been found
+// yet. A failed lookup is retried the next time Get is called.
+func (m *LazyMethodBind) Get() MethodBind {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.bind.base == nil {
+		m.bind = NewMethodBind(m.class, m.method)
+	}
+
+	return m.bind
 }
 
 // MethodBindPtrCall will call the given method on the given Godot Object. Its return
 // value is given as a pointer, which can be used to convert it to a variant.
 func MethodBindPtrCall(methodBind MethodBind, instance Object, args []Pointer, returns Pointer) Pointer {
 	GDNative.checkInit()
+	checkThread(methodBind)
 	if instance.getBase() == nil {
 		panic("Godot object pointer was nil when calling MethodBindPtrCall")
 	}
+	if methodBind.getBase() == nil {
+		panic("Method bind was not found when calling MethodBindPtrCall")
+	}
 
 	// Build out our C arguments array
 	cArgs := C.go_void_build_array(C.int(len(args)))
+	defer C.free(unsafe.Pointer(cArgs))
 	for i, arg := range args {
 		C.go_void_add_element(cArgs, arg.getBase(), C.int(i))
 	}
@@ -172,6 +329,103 @@ func MethodBindPtrCall(methodBind MethodBind, instance Object, args []Pointer, r
 	return returns
 }
 
+// MethodBindCall will call the given method on the given Godot Object using
+// variant arguments. Unlike MethodBindPtrCall, this can be used to call methods
+// that take a variable number of arguments. If Godot was unable to call the
+// method, a CallError will be returned.
+func MethodBindCall(methodBind MethodBind, instance Object, args []Variant) (Variant, error) {
+	GDNative.checkInit()
+	checkThread(methodBind)
+	if instance.getBase() == nil {
+		panic("Godot object pointer was nil when calling MethodBindCall")
+	}
+	if methodBind.getBase() == nil {
+		panic("Method bind was not found when calling MethodBindCall")
+	}
+
+	// Build out our C arguments array
+	cArgs := C.go_godot_variant_build_array(C.int(len(args)))
+	defer C.free(unsafe.Pointer(cArgs))
+	for i, arg := range args {
+		C.go_godot_variant_add_element(cArgs, arg.getBase(), C.int(i))
+	}
+
+	if debug {
+		log.Println("args: ", cArgs)
+		log.Println("object: ", unsafe.Pointer(instance.getBase()))
+		log.Println("methodbind: ", unsafe.Pointer(methodBind.getBase()))
+	}
+
+	// Call the C method
+	var callError C.godot_variant_call_error
+	ret := C.go_godot_method_bind_call(
+		GDNative.api,
+		methodBind.getBase(),
+		unsafe.Pointer(instance.getBase()),
+		cArgs,
+		C.int(len(args)),
+		&callError,
+	)
+	if debug {
+		log.Println("Finished calling method.")
+	}
+
+	// Check to see if the call was successful.
+	if VariantCallErrorError(callError.error) != CallErrorCallOk {
+		err := &CallError{
+			Type:     VariantCallErrorError(callError.error),
+			Argument: int(callError.argument),
+			Expected: VariantType(callError.expected),
+		}
+		return Variant{base: &ret}, err
+	}
+
+	return Variant{base: &ret}, nil
+}
+
+// CallError is an error returned by MethodBindCall when Godot was unable to call
+// the given method.
+type CallError struct {
+	// Type is the kind of error that occurred.
+	Type VariantCallErrorError
+
+	// Argument is the index of the invalid argument, if Type is
+	// CallErrorCallErrorInvalidArgument. Otherwise it is the number of
+	// expected arguments.
+	Argument int
+
+	// Expected is the variant type the invalid argument should have been.
+	Expected VariantType
+}
+
+// Error will return a description of the call error.
+func (e *CallError) Error() string {
+	switch e.Type {
+	case CallErrorCallErrorInvalidMethod:
+		return "invalid method"
+	case CallErrorCallErrorInvalidArgument:
+		return fmt.Sprintf("invalid type for argument %d, expected %s", e.Argument, VariantTypeName(e.Expected))
+	case CallErrorCallErrorTooManyArguments:
+		return fmt.Sprintf("too many arguments, expected %d", e.Argument)
+	case CallErrorCallErrorTooFewArguments:
+		return fmt.Sprintf("too few arguments, expected %d", e.Argument)
+	case CallErrorCallErrorInstanceIsNull:
+		return "instance is null"
+	}
+	return fmt.Sprintf("call error %d", e.Type)
+}
+
+// VariantTypeName will return a readable name of the given variant type, such
+// as "Vector2".
+func VariantTypeName(variantType VariantType) string {
+	for name, value := range VariantTypeLookupMap {
+		if value == variantType {
+			return strings.TrimPrefix(name, "VariantType")
+		}
+	}
+	return fmt.Sprintf("%d", variantType)
+}
+
 // Pointer is a pointer to arbitrary underlying data. This is primarily used
 // in conjunction with MethodBindPtrCall.
 type Pointer struct {
@@ -305,3 +559,8 @@ func (w WcharT) AsString() String {
 func (gdt Object) ID() string {
 	return fmt.Sprintf("%p", gdt.base)
 }
+
+// IsNil will return true if the object does not point to a Godot object.
+func (gdt Object) IsNil() bool {
+	return gdt.base == nil
+}
@@ -90,3 +90,91 @@ void go_godot_signal_argument_add_element(godot_signal_argument **array,
 					  int index) {
 	array[index] = element;
 }
+
+// The NativeScript 1.1 API is not part of gdnative_api.json, so the bindings
+// for the functions we use are defined here.
+void go_godot_nativescript_set_method_argument_information(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_function_name,
+    int p_num_args, const godot_method_arg *p_args) {
+	p_api->godot_nativescript_set_method_argument_information(
+	    p_gdnative_handle, p_name, p_function_name, p_num_args, p_args);
+}
+
+void go_godot_nativescript_set_class_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, godot_string p_documentation) {
+	p_api->godot_nativescript_set_class_documentation(
+	    p_gdnative_handle, p_name, p_documentation);
+}
+
+void go_godot_nativescript_set_method_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_function_name,
+    godot_string p_documentation) {
+	p_api->godot_nativescript_set_method_documentation(
+	    p_gdnative_handle, p_name, p_function_name, p_documentation);
+}
+
+void go_godot_nativescript_set_property_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_path,
+    godot_string p_documentation) {
+	p_api->godot_nativescript_set_property_documentation(
+	    p_gdnative_handle, p_name, p_path, p_documentation);
+}
+
+void go_godot_nativescript_set_signal_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_signal_name,
+    godot_string p_documentation) {
+	p_api->godot_nativescript_set_signal_documentation(
+	    p_gdnative_handle, p_name, p_signal_name, p_documentation);
+}
+
+void go_godot_nativescript_set_type_tag(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const void *p_type_tag) {
+	p_api->godot_nativescript_set_type_tag(p_gdnative_handle, p_name,
+					       p_type_tag);
+}
+
+const void *go_godot_nativescript_get_type_tag(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    const godot_object *p_object) {
+	return p_api->godot_nativescript_get_type_tag(p_object);
+}
+
+// This is a gateway function for allocating the instance binding data of an
+// object.
+void *cgo_gateway_alloc_instance_binding_data(void *data, godot_object *owner) {
+	void *go_alloc_instance_binding_data(void *, godot_object *);
+	return go_alloc_instance_binding_data(data, owner);
+}
+
+// This is a gateway function for freeing the instance binding data of an
+// object.
+void cgo_gateway_free_instance_binding_data(void *data, void *binding) {
+	void go_free_instance_binding_data(void *, void *);
+	go_free_instance_binding_data(data, binding);
+}
+
+int go_godot_nativescript_register_instance_binding_data_functions(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    godot_instance_binding_functions p_binding_functions) {
+	return p_api->godot_nativescript_register_instance_binding_data_functions(
+	    p_binding_functions);
+}
+
+void go_godot_nativescript_unregister_instance_binding_data_functions(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api, int p_idx) {
+	p_api->godot_nativescript_unregister_instance_binding_data_functions(
+	    p_idx);
+}
+
+void *go_godot_nativescript_get_instance_binding_data(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api, int p_idx,
+    godot_object *p_object) {
+	return p_api->godot_nativescript_get_instance_binding_data(p_idx,
+								   p_object);
+}
@@ -218,6 +218,10 @@ var NativeScript = &nativeScript{}
 type nativeScript struct {
 	api *C.godot_gdnative_ext_nativescript_api_struct
 
+	// api11 is the NativeScript 1.1 API. It is nil if the engine only supports
+	// NativeScript 1.0.
+	api11 *C.godot_gdnative_ext_nativescript_1_1_api_struct
+
 	// Handle is a pointer to the gdnative handler. It must be passed to any
 	// Godot nativescript functions. This will be populated when 'godot_nativescript_init'
 	// is called by Godot upon script initialization.
@@ -406,6 +410,19 @@ func (n *nativeScript) RegisterSignal(name string, signal *Signal) {
 	)
 }
 
+// GetUserData will return the user data of the NativeScript instance attached
+// to the given object, which is the string returned by its InstanceCreateFunc.
+// The object must have a script of a class registered by this library, which can
+// be checked with GetTypeTag.
+func (n *nativeScript) GetUserData(object Object) string {
+	userData := C.go_godot_nativescript_get_userdata(n.api, unsafe.Pointer(object.base))
+	if userData == nil {
+		return ""
+	}
+
+	return unsafeToGoString(userData)
+}
+
 // nativeScriptInit will be called when `godot_nativescript_init` is called by
 // Godot. You can use `SetNativeScriptInit` to set the function that will be called
 // when NativeScript initializes.
@@ -421,6 +438,20 @@ func SetNativeScriptInit(initFunc ...func()) {
 	}
 }
 
+// nativeScriptTerminate will be called when `godot_nativescript_terminate` is
+// called by Godot. You can use `SetNativeScriptTerminate` to set the functions
+// that will be called when NativeScript terminates.
+var nativeScriptTerminate = []func(){}
+
+// SetNativeScriptTerminate will configure the given function to be called when
+// `godot_nativescript_terminate` is called by Godot before the library is
+// unloaded. This is used to free any objects that were created by the library.
+func SetNativeScriptTerminate(terminateFunc ...func()) {
+	for _, terminate := range terminateFunc {
+		nativeScriptTerminate = append(nativeScriptTerminate, terminate)
+	}
+}
+
 /*------------------------------------------------------------------------------
 //	  			Exported C Functions
 //
@@ -436,6 +467,12 @@ func SetNativeScriptInit(initFunc ...func()) {
 // etc. The `unsafe.Pointer` type is used to represent a void C pointer.
 //export godot_nativescript_init
 func godot_nativescript_init(hdl unsafe.Pointer) {
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("godot_nativescript_init", "", r)
+		}
+	}()
+
 	if debug {
 		log.Println("Initializing NativeScript")
 	}
@@ -456,15 +493,57 @@ func godot_nativescript_init(hdl unsafe.Pointer) {
 	}
 }
 
+// godot_nativescript_terminate is called by Godot when the library is unloaded.
+// It will unregister any instance binding data functions.
+//export godot_nativescript_terminate
+func godot_nativescript_terminate(hdl unsafe.Pointer) {
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("godot_nativescript_terminate", "", r)
+		}
+	}()
+	if debug {
+		log.Println("Terminating NativeScript")
+	}
+
+	// Loop through any defined nativeScriptTerminate methods and execute them.
+	// A panic in one of them must not skip the others.
+	for _, terminate := range nativeScriptTerminate {
+		runTerminate(terminate)
+	}
+
+	NativeScript.unregisterInstanceBindingDataFunctions()
+}
+
+// runTerminate will run the given terminate function, and recover any panic so
+// the rest of the library can still be terminated.
+func runTerminate(terminate func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("godot_nativescript_terminate", "", r)
+		}
+	}()
+	terminate()
+}
+
 // This is a native Go function that is callable from C. It is called by the
 // gateway functions defined in nativescript.c. It will be ultimately called by
 // Godot, where it will pass us the Godot object and the MethodData defined in
 // CreateFunc. We will need to return UserData, which can be used to track the
 // actual instance that was created.
 //export go_create_func
-func go_create_func(godotObject *C.godot_object, methodData unsafe.Pointer) unsafe.Pointer {
+func go_create_func(godotObject *C.godot_object, methodData unsafe.Pointer) (userData unsafe.Pointer) {
 	// Convert the method data into a Go string.
 	methodDataString := unsafeToGoString(methodData)
+
+	// If the constructor panics, return empty user data. Methods called on the
+	// instance will then fail to find it in the instance registry.
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_create_func", methodDataString, r)
+			userData = unsafe.Pointer(C.CString(""))
+		}
+	}()
 	if debug {
 		log.Println("Create function called for:", methodDataString)
 	}
@@ -476,9 +555,9 @@ func go_create_func(godotObject *C.godot_object, methodData unsafe.Pointer) unsa
 	// Call the constructor and return the user data string. The user data
 	// returned by the create func will be passed to the method function as
 	// userData.
-	userData := constructor(Object{base: godotObject}, methodDataString)
+	userDataString := constructor(Object{base: godotObject}, methodDataString)
 
-	return unsafe.Pointer(C.CString(userData))
+	return unsafe.Pointer(C.CString(userDataString))
 }
 
 // This is a native Go function that is callable from C. It is called by the
@@ -494,6 +573,11 @@ func go_destroy_func(godotObject *C.godot_object, methodData unsafe.Pointer, use
 	if debug {
 		log.Println("Destroy function called for:", methodDataString)
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_destroy_func", methodDataString, r)
+		}
+	}()
 
 	// Look up the destroy function in our DestroyFuncRegistry for the function
 	// to call.
@@ -511,6 +595,11 @@ func go_free_func(methodData unsafe.Pointer) {
 	if debug {
 		log.Println("Free function called for:", methodDataString)
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_free_func", methodDataString, r)
+		}
+	}()
 
 	// Look up the free function in our FreeFuncRegistry for the function
 	// to call.
@@ -524,43 +613,31 @@ func go_free_func(methodData unsafe.Pointer) {
 // This is a native Go function that is callable from C. It is called by the
 // gateway functions defined in nativescript.c.
 //export go_method_func
-func go_method_func(godotObject *C.godot_object, methodData unsafe.Pointer, userData unsafe.Pointer, numArgs C.int, args **C.godot_variant) C.godot_variant {
+func go_method_func(godotObject *C.godot_object, methodData unsafe.Pointer, userData unsafe.Pointer, numArgs C.int, args **C.godot_variant) (ret C.godot_variant) {
 	// Convert the method data and user data into a Go string
 	methodDataString := unsafeToGoString(methodData)
 	userDataString := unsafeToGoString(userData)
 
+	// If the method panics, return Nil to Godot.
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_method_func", methodDataString, r)
+			ret = *NewVariantNil().getBase()
+		}
+	}()
+
 	// Create a slice of Variants for the arguments
 	variantArgs := []Variant{}
 
-	// Get the size of each godot_variant object pointer.
-	if debug {
-		log.Println("  Getting size of argument pointer")
-	}
-	size := unsafe.Sizeof(*args)
-
-	// Panic if something's wrong.
+	// Return Nil if something's wrong.
 	if int(numArgs) > 50 {
-		panic("Too many arguments. Invalid method.")
+		Log.Error("Too many arguments passed to ", methodDataString, ". Invalid method.")
+		return *NewVariantNil().getBase()
 	}
 
-	// If we have arguments, append the first argument.
-	if int(numArgs) > 0 {
-		arg := *args
-		// Loop through all our arguments.
-		for i := 0; i < int(numArgs); i++ {
-			// Convert the variant into a Go Variant
-			variant := Variant{base: arg}
-
-			// Append the variant to our list of variants
-			variantArgs = append(variantArgs, variant)
-
-			// Convert the pointer into a uintptr so we can perform artithmetic on it.
-			arrayPtr := uintptr(unsafe.Pointer(args))
-
-			// Add the size of the godot_variant pointer to our array pointer to get the position
-			// of the next argument.
-			arg = (*C.godot_variant)(unsafe.Pointer(arrayPtr + size))
-		}
+	// Convert each argument pointer into a Go Variant.
+	for _, arg := range pointerArray(unsafe.Pointer(args), int(numArgs)) {
+		variantArgs = append(variantArgs, Variant{base: (*C.godot_variant)(arg)})
 	}
 
 	// Look up the method function in our MethodFuncRegistry for the function
@@ -568,9 +645,9 @@ func go_method_func(godotObject *C.godot_object, methodData unsafe.Pointer, user
 	method := MethodFuncRegistry[methodDataString]
 
 	// Call the method
-	ret := method(Object{base: godotObject}, methodDataString, userDataString, int(numArgs), variantArgs)
+	variant := method(Object{base: godotObject}, methodDataString, userDataString, int(numArgs), variantArgs)
 
-	return *ret.getBase()
+	return *variant.getBase()
 }
 
 // This is a native Go function that is callable from C. It is called by the
@@ -580,6 +657,11 @@ func go_set_property_func(godotObject *C.godot_object, methodData unsafe.Pointer
 	// Convert the method data and user data into a Go string
 	methodDataString := unsafeToGoString(methodData)
 	userDataString := unsafeToGoString(userData)
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_set_property_func", methodDataString, r)
+		}
+	}()
 
 	// Convert the property into a Go variant
 	variant := Variant{base: property}
@@ -595,17 +677,67 @@ func go_set_property_func(godotObject *C.godot_object, methodData unsafe.Pointer
 // This is a native Go function that is callable from C. It is called by the
 // gateway functions defined in nativescript.c.
 //export go_get_property_func
-func go_get_property_func(godotObject *C.godot_object, methodData unsafe.Pointer, userData unsafe.Pointer) C.godot_variant {
+func go_get_property_func(godotObject *C.godot_object, methodData unsafe.Pointer, userData unsafe.Pointer) (ret C.godot_variant) {
 	// Convert the method data and user data into a Go string
 	methodDataString := unsafeToGoString(methodData)
 	userDataString := unsafeToGoString(userData)
 
+	// If the getter panics, return Nil to Godot.
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_get_property_func", methodDataString, r)
+			ret = *NewVariantNil().getBase()
+		}
+	}()
+
 	// Look up the get property function in our GetPropertyFuncRegistry for
 	// the function to call.
 	getFunc := GetPropertyFuncRegistry[methodDataString]
 
 	// Call the method
-	ret := getFunc(Object{base: godotObject}, methodDataString, userDataString)
+	variant := getFunc(Object{base: godotObject}, methodDataString, userDataString)
+
+	return *variant.getBase()
+}
+
+// This is a native Go function that is callable from C. It is called by the
+// gateway functions defined in nativescript.c when Godot needs the instance
+// binding data of an object. The returned C string is freed by
+// go_free_instance_binding_data.
+//export go_alloc_instance_binding_data
+func go_alloc_instance_binding_data(data unsafe.Pointer, godotObject *C.godot_object) (binding unsafe.Pointer) {
+	dataString := unsafeToGoString(data)
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_alloc_instance_binding_data", dataString, r)
+			binding = nil
+		}
+	}()
+
+	// Look up the alloc function in our registry and call it.
+	alloc := AllocInstanceBindingDataFuncRegistry[dataString]
+	bindingString := alloc(dataString, Object{base: godotObject})
+
+	return unsafe.Pointer(C.CString(bindingString))
+}
+
+// This is a native Go function that is callable from C. It is called by the
+// gateway functions defined in nativescript.c when an object with instance
+// binding data is freed.
+//export go_free_instance_binding_data
+func go_free_instance_binding_data(data unsafe.Pointer, binding unsafe.Pointer) {
+	dataString := unsafeToGoString(data)
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic("go_free_instance_binding_data", dataString, r)
+		}
+	}()
+	if binding == nil {
+		return
+	}
+	defer C.free(binding)
 
-	return *ret.getBase()
+	// Look up the free function in our registry and call it.
+	free := FreeInstanceBindingDataFuncRegistry[dataString]
+	free(dataString, unsafeToGoString(binding))
 }
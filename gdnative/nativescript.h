@@ -2,6 +2,7 @@
 #define CGDNATIVE_NATIVESCRIPT_GATEWAY_H
 
 #include <gdnative/gdnative.h>
+#include <gdnative_api_struct.gen.h>
 #include <nativescript/godot_nativescript.h>
 
 /* GDNative NATIVESCRIPT C Gateway */
@@ -27,8 +28,47 @@ typedef godot_variant (*method)(godot_object *, void *, void *, int,
 typedef void (*set_property_func)(godot_object *, void *, void *,
 				  godot_variant *);
 typedef godot_variant (*get_property_func)(godot_object *, void *, void *);
+typedef void *(*alloc_instance_binding_data_func)(void *, godot_object *);
+typedef void (*free_instance_binding_data_func)(void *, void *);
 godot_signal_argument **go_godot_signal_argument_build_array(int length);
 void go_godot_signal_argument_add_element(godot_signal_argument **array,
 					  godot_signal_argument *element,
 					  int index);
+
+/* GDNative NATIVESCRIPT 1.1 */
+void go_godot_nativescript_set_method_argument_information(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_function_name,
+    int p_num_args, const godot_method_arg *p_args);
+void go_godot_nativescript_set_class_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, godot_string p_documentation);
+void go_godot_nativescript_set_method_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_function_name,
+    godot_string p_documentation);
+void go_godot_nativescript_set_property_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_path,
+    godot_string p_documentation);
+void go_godot_nativescript_set_signal_documentation(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const char *p_signal_name,
+    godot_string p_documentation);
+void go_godot_nativescript_set_type_tag(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    void *p_gdnative_handle, const char *p_name, const void *p_type_tag);
+const void *go_godot_nativescript_get_type_tag(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    const godot_object *p_object);
+void *cgo_gateway_alloc_instance_binding_data(void *data, godot_object *owner);
+void cgo_gateway_free_instance_binding_data(void *data, void *binding);
+int go_godot_nativescript_register_instance_binding_data_functions(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api,
+    godot_instance_binding_functions p_binding_functions);
+void go_godot_nativescript_unregister_instance_binding_data_functions(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api, int p_idx);
+void *go_godot_nativescript_get_instance_binding_data(
+    godot_gdnative_ext_nativescript_1_1_api_struct *p_api, int p_idx,
+    godot_object *p_object);
 #endif
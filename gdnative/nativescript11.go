@@ -0,0 +1,265 @@
+package gdnative
+
+/*
+#include <stdlib.h>
+#include <nativescript/godot_nativescript.h>
+#include "gdnative.gen.h"
+#include "nativescript.h"
+*/
+import "C"
+
+import (
+	"log"
+	"unsafe"
+)
+
+// MethodArgument holds the name and type of an argument of a registered method.
+// It is used to show the arguments of a method in the editor.
+type MethodArgument struct {
+	Name       String
+	Type       VariantType
+	Hint       PropertyHint
+	HintString String
+}
+
+// HasVersion11 will return true if the engine supports NativeScript 1.1. If it
+// does not, the functions that require NativeScript 1.1 will do nothing.
+func (n *nativeScript) HasVersion11() bool {
+	return n.api11 != nil
+}
+
+// SetMethodArgumentInformation will set the names and types of the arguments of
+// the given method of the given class. This requires NativeScript 1.1.
+func (n *nativeScript) SetMethodArgumentInformation(name, funcName string, args []MethodArgument) {
+	if !n.HasVersion11() || len(args) == 0 {
+		return
+	}
+	if debug {
+		log.Println("Setting argument information for:", name+"."+funcName)
+	}
+
+	// Build the C array of arguments. The strings are copied by Godot, so they
+	// can be destroyed after the call.
+	cArgs := make([]C.godot_method_arg, len(args))
+	for i, arg := range args {
+		cArgs[i].name = *(arg.Name.getBase())
+		cArgs[i]._type = arg.Type.getBase()
+		cArgs[i].hint = arg.Hint.getBase()
+		cArgs[i].hint_string = *(arg.HintString.getBase())
+	}
+	defer func() {
+		for i := range cArgs {
+			C.go_godot_string_destroy(GDNative.api, &cArgs[i].name)
+			C.go_godot_string_destroy(GDNative.api, &cArgs[i].hint_string)
+		}
+	}()
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	cFuncName := C.CString(funcName)
+	defer C.free(unsafe.Pointer(cFuncName))
+
+	C.go_godot_nativescript_set_method_argument_information(
+		n.api11,
+		n.handle,
+		cName,
+		cFuncName,
+		C.int(len(cArgs)),
+		&cArgs[0],
+	)
+}
+
+// SetClassDocumentation will set the documentation of the given class. This
+// requires NativeScript 1.1.
+func (n *nativeScript) SetClassDocumentation(name string, documentation String) {
+	if !n.HasVersion11() || documentation == "" {
+		return
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	cDocumentation := documentation.getBase()
+	defer C.go_godot_string_destroy(GDNative.api, cDocumentation)
+
+	C.go_godot_nativescript_set_class_documentation(n.api11, n.handle, cName, *cDocumentation)
+}
+
+// SetMethodDocumentation will set the documentation of the given method of the
+// given class. This requires NativeScript 1.1.
+func (n *nativeScript) SetMethodDocumentation(name, funcName string, documentation String) {
+	if !n.HasVersion11() || documentation == "" {
+		return
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	cFuncName := C.CString(funcName)
+	defer C.free(unsafe.Pointer(cFuncName))
+	cDocumentation := documentation.getBase()
+	defer C.go_godot_string_destroy(GDNative.api, cDocumentation)
+
+	C.go_godot_nativescript_set_method_documentation(n.api11, n.handle, cName, cFuncName, *cDocumentation)
+}
+
+// SetPropertyDocumentation will set the documentation of the given property of
+// the given class. This requires NativeScript 1.1.
+func (n *nativeScript) SetPropertyDocumentation(name, path string, documentation String) {
+	if !n.HasVersion11() || documentation == "" {
+		return
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+	cDocumentation := documentation.getBase()
+	defer C.go_godot_string_destroy(GDNative.api, cDocumentation)
+
+	C.go_godot_nativescript_set_property_documentation(n.api11, n.handle, cName, cPath, *cDocumentation)
+}
+
+// SetSignalDocumentation will set the documentation of the given signal of the
+// given class. This requires NativeScript 1.1.
+func (n *nativeScript) SetSignalDocumentation(name, signalName string, documentation String) {
+	if !n.HasVersion11() || documentation == "" {
+		return
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	cSignalName := C.CString(signalName)
+	defer C.free(unsafe.Pointer(cSignalName))
+	cDocumentation := documentation.getBase()
+	defer C.go_godot_string_destroy(GDNative.api, cDocumentation)
+
+	C.go_godot_nativescript_set_signal_documentation(n.api11, n.handle, cName, cSignalName, *cDocumentation)
+}
+
+// typeTags is a mapping of the type tags registered with SetTypeTag to the names
+// of their classes.
+var typeTags = map[unsafe.Pointer]string{}
+
+// SetTypeTag will register a type tag for the given class. Objects with a script
+// of this class can then be identified with GetTypeTag. This requires
+// NativeScript 1.1.
+func (n *nativeScript) SetTypeTag(name string) {
+	if !n.HasVersion11() {
+		return
+	}
+	if debug {
+		log.Println("Setting type tag for:", name)
+	}
+
+	// The tag is a pointer that is unique to the class. It has to stay valid for
+	// as long as the library is loaded, so it is never freed.
+	tag := unsafe.Pointer(C.CString(name))
+	typeTags[tag] = name
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	C.go_godot_nativescript_set_type_tag(n.api11, n.handle, cName, tag)
+}
+
+// GetTypeTag will return the name of the class of the script attached to the
+// given object, if the class was registered with SetTypeTag by this library.
+// This requires NativeScript 1.1.
+func (n *nativeScript) GetTypeTag(object Object) (string, bool) {
+	if !n.HasVersion11() || object.base == nil {
+		return "", false
+	}
+
+	tag := C.go_godot_nativescript_get_type_tag(n.api11, unsafe.Pointer(object.base))
+	name, ok := typeTags[unsafe.Pointer(tag)]
+
+	return name, ok
+}
+
+// AllocInstanceBindingDataFunc will be called when Godot needs the instance
+// binding data of an object for the first time. It is passed the data string of
+// the InstanceBindingFunctions and the object, and returns a string that
+// identifies the binding data of the object.
+type AllocInstanceBindingDataFunc func(string, Object) string
+
+// FreeInstanceBindingDataFunc will be called when an object with instance binding
+// data is freed. It is passed the data string of the InstanceBindingFunctions and
+// the string returned by the AllocInstanceBindingDataFunc.
+type FreeInstanceBindingDataFunc func(string, string)
+
+// AllocInstanceBindingDataFuncRegistry is a mapping of instance binding data
+// allocation functions, keyed by the data string of their InstanceBindingFunctions.
+var AllocInstanceBindingDataFuncRegistry = map[string]AllocInstanceBindingDataFunc{}
+
+// FreeInstanceBindingDataFuncRegistry is a mapping of instance binding data free
+// functions, keyed by the data string of their InstanceBindingFunctions.
+var FreeInstanceBindingDataFuncRegistry = map[string]FreeInstanceBindingDataFunc{}
+
+// InstanceBindingFunctions is a structure that contains the functions Godot will
+// call to allocate and free the instance binding data of objects. Instance
+// binding data allows a language binding to keep a single wrapper for every
+// object.
+type InstanceBindingFunctions struct {
+	base      C.godot_instance_binding_functions
+	AllocFunc AllocInstanceBindingDataFunc
+	FreeFunc  FreeInstanceBindingDataFunc
+	Data      string
+	FreeData  FreeFunc
+}
+
+// instanceBindingIndices holds the indices of all registered instance binding
+// data functions, so they can be unregistered when NativeScript terminates.
+var instanceBindingIndices = []int{}
+
+// RegisterInstanceBindingDataFunctions will register the given instance binding
+// data functions with Godot, and return the index to use with
+// GetInstanceBindingData. This requires NativeScript 1.1, and will return -1 if
+// it is not supported.
+func (n *nativeScript) RegisterInstanceBindingDataFunctions(functions *InstanceBindingFunctions) int {
+	if !n.HasVersion11() {
+		return -1
+	}
+
+	// Construct the C struct based on the Go struct wrapper
+	functions.base.alloc_instance_binding_data = (C.alloc_instance_binding_data_func)(unsafe.Pointer(C.cgo_gateway_alloc_instance_binding_data))
+	functions.base.free_instance_binding_data = (C.free_instance_binding_data_func)(unsafe.Pointer(C.cgo_gateway_free_instance_binding_data))
+	functions.base.data = unsafe.Pointer(C.CString(functions.Data))
+	functions.base.free_func = (C.free_func)(unsafe.Pointer(C.cgo_gateway_free_func))
+
+	// Register our functions in a Go map, so the correct function can be called
+	// when the gateway functions are called.
+	AllocInstanceBindingDataFuncRegistry[functions.Data] = functions.AllocFunc
+	FreeInstanceBindingDataFuncRegistry[functions.Data] = functions.FreeFunc
+	FreeFuncRegistry[functions.Data] = functions.FreeData
+
+	index := int(C.go_godot_nativescript_register_instance_binding_data_functions(n.api11, functions.base))
+	instanceBindingIndices = append(instanceBindingIndices, index)
+
+	return index
+}
+
+// GetInstanceBindingData will return the instance binding data of the given
+// object for the instance binding data functions with the given index. If the
+// object does not have binding data yet, it will be allocated.
+func (n *nativeScript) GetInstanceBindingData(index int, object Object) string {
+	if !n.HasVersion11() || index < 0 || object.IsNil() {
+		return ""
+	}
+
+	binding := C.go_godot_nativescript_get_instance_binding_data(n.api11, C.int(index), unsafe.Pointer(object.base))
+	if binding == nil {
+		return ""
+	}
+
+	return unsafeToGoString(binding)
+}
+
+// unregisterInstanceBindingDataFunctions will unregister all instance binding
+// data functions that were registered.
+func (n *nativeScript) unregisterInstanceBindingDataFunctions() {
+	if n.HasVersion11() {
+		for _, index := range instanceBindingIndices {
+			C.go_godot_nativescript_unregister_instance_binding_data_functions(n.api11, C.int(index))
+		}
+	}
+	instanceBindingIndices = []int{}
+}
@@ -0,0 +1,120 @@
+package gdnative
+
+/*
+#include <stdlib.h>
+#include <gdnative/gdnative.h>
+#include "gdnative.gen.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	runtimedebug "runtime/debug"
+	"strings"
+	"unsafe"
+)
+
+// PanicInfo holds the details of a Go panic that was recovered inside one of
+// the exported functions that Godot calls, like go_method_func.
+type PanicInfo struct {
+	// Gateway is the name of the exported function the panic was recovered in.
+	Gateway string
+
+	// MethodData is the method data of the registered function that panicked,
+	// such as "Class::method".
+	MethodData string
+
+	// Value is the value that was passed to panic.
+	Value interface{}
+
+	// Function, File and Line are the location of the panic.
+	Function string
+	File     string
+	Line     int
+
+	// Stack is the Go stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+// Error will return a description of the panic, so PanicInfo can be used as
+// an error.
+func (p *PanicInfo) Error() string {
+	return fmt.Sprintf("Go panic in %s (%s): %v", p.Gateway, p.MethodData, p.Value)
+}
+
+// PanicHandler is a function that will be called with the details of a Go panic
+// that was recovered before it could unwind into Godot.
+type PanicHandler func(info *PanicInfo)
+
+// panicHandler is the handler that will be called when a panic is recovered.
+var panicHandler PanicHandler = PrintPanic
+
+// SetPanicHandler will set the function that is called when a Go panic is
+// recovered inside a function called by Godot. Setting the handler to nil will
+// restore the default handler, PrintPanic.
+func SetPanicHandler(handler PanicHandler) {
+	if handler == nil {
+		handler = PrintPanic
+	}
+	panicHandler = handler
+}
+
+// PrintPanic is the default panic handler. It will print the panic along with
+// its Go stack trace as an error in the Godot debugger and console.
+func PrintPanic(info *PanicInfo) {
+	if !GDNative.IsInitialized() || GDNative.api == nil {
+		log.Println(info.Error() + "\n" + string(info.Stack))
+		return
+	}
+
+	cDescription := C.CString(info.Error() + "\n" + string(info.Stack))
+	cFuncName := C.CString(info.Function)
+	cFile := C.CString(info.File)
+	defer C.free(unsafe.Pointer(cDescription))
+	defer C.free(unsafe.Pointer(cFuncName))
+	defer C.free(unsafe.Pointer(cFile))
+
+	C.go_godot_print_error(GDNative.api, cDescription, cFuncName, cFile, C.int(info.Line))
+}
+
+// handlePanic will build the panic details of the recovered value and pass them
+// to the panic handler. It must be called from the deferred function that
+// recovered the panic, so the location of the panic can be found on the stack.
+func handlePanic(gateway, methodData string, value interface{}) {
+	info := &PanicInfo{
+		Gateway:    gateway,
+		MethodData: methodData,
+		Value:      value,
+		Stack:      runtimedebug.Stack(),
+	}
+
+	// Walk up the stack to the first frame after the runtime started
+	// panicking, which is where the panic happened.
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+	panicking := false
+	for {
+		frame, more := frames.Next()
+		if frame.Function == "runtime.gopanic" {
+			panicking = true
+		} else if panicking && !strings.HasPrefix(frame.Function, "runtime.") {
+			info.Function = frame.Function
+			info.File = frame.File
+			info.Line = frame.Line
+			break
+		}
+		if !more {
+			break
+		}
+	}
+
+	// Make sure a panic in the handler itself does not unwind into Godot.
+	defer func() {
+		if r := recover(); r != nil {
+			log.Println("Panic in panic handler:", r)
+		}
+	}()
+	panicHandler(info)
+}
@@ -0,0 +1,80 @@
+package gdnative
+
+/*
+#include "util.h"
+*/
+import "C"
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// IsMainThread will return true if it is called on the main thread of Godot.
+// Most engine APIs may only be called on the main thread.
+func IsMainThread() bool {
+	return C.go_is_main_thread() != 0
+}
+
+// threadCheck is 1 if engine calls made off the main thread are reported. It is
+// read from any thread, so it is only accessed atomically.
+var threadCheck int32
+
+// SetThreadCheck will enable or disable reporting engine calls that are made off
+// the main thread, such as from a goroutine. This is meant for debugging, since
+// it adds a check to every call into the engine.
+func SetThreadCheck(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&threadCheck, 1)
+	} else {
+		atomic.StoreInt32(&threadCheck, 0)
+	}
+}
+
+// threadSafeMethods is a set of engine methods that may be called from any
+// thread, and are not reported by the thread check.
+var threadSafeMethods = map[string]bool{
+	"call_deferred": true,
+}
+
+// threadSafeBinds is the set of method binds of the threadSafeMethods that have
+// been looked up.
+var threadSafeBinds = struct {
+	sync.RWMutex
+	binds map[MethodBind]bool
+}{binds: map[MethodBind]bool{}}
+
+// addThreadSafeBind will add the given method bind to the set of thread-safe
+// binds, if the method it was looked up for is thread-safe.
+func addThreadSafeBind(method string, methodBind MethodBind) {
+	if !threadSafeMethods[method] {
+		return
+	}
+	threadSafeBinds.Lock()
+	defer threadSafeBinds.Unlock()
+	threadSafeBinds.binds[methodBind] = true
+}
+
+// checkThread will report the call of the given method bind if the thread check
+// is enabled and it is not called on the main thread.
+func checkThread(methodBind MethodBind) {
+	if atomic.LoadInt32(&threadCheck) == 0 || IsMainThread() {
+		return
+	}
+	threadSafeBinds.RLock()
+	threadSafe := threadSafeBinds.binds[methodBind]
+	threadSafeBinds.RUnlock()
+	if threadSafe {
+		return
+	}
+
+	// Report the function that called into the generated bindings.
+	caller := "unknown function"
+	if pc, _, _, ok := runtime.Caller(3); ok {
+		if function := runtime.FuncForPC(pc); function != nil {
+			caller = function.Name()
+		}
+	}
+	Log.Warning("Engine method called off the main thread from ", caller, ". Use godot.RunOnMain to call it on the main thread.")
+}
@@ -1,5 +1,6 @@
 #include "util.h"
 #include <gdnative/gdnative.h>
+#include <pthread.h>
 #include <stdlib.h>
 
 // Helper functions for accessing C arrays.
@@ -16,3 +17,22 @@ void **go_void_build_array(int length) {
 void go_void_add_element(void **array, void *element, int index) {
 	array[index] = element;
 }
+
+// Helper function for calling a class constructor function pointer.
+godot_object *go_godot_class_constructor_call(godot_class_constructor constructor) {
+	return constructor();
+}
+
+// Helper functions for checking which thread we are running on. Godot calls
+// godot_gdnative_init on the main thread, where go_set_main_thread is called.
+static pthread_t main_thread;
+static int main_thread_set = 0;
+
+void go_set_main_thread() {
+	main_thread = pthread_self();
+	main_thread_set = 1;
+}
+
+int go_is_main_thread() {
+	return !main_thread_set || pthread_equal(main_thread, pthread_self());
+}
@@ -18,6 +18,17 @@ func unsafeToGoString(p unsafe.Pointer) string {
 	return goString
 }
 
+// pointerArray will return the pointers of a C array of pointers with the given
+// length, such as the arguments that Godot passes to a method.
+func pointerArray(array unsafe.Pointer, length int) []unsafe.Pointer {
+	pointers := make([]unsafe.Pointer, length)
+	for i := range pointers {
+		pointers[i] = *(*unsafe.Pointer)(unsafe.Pointer(uintptr(array) + uintptr(i)*unsafe.Sizeof(array)))
+	}
+
+	return pointers
+}
+
 // camelToSnake will convert the given string from camelcase to snake case.
 // Attribution:
 //   Author: https://github.com/mantenie
@@ -6,4 +6,7 @@
 godot_gdnative_api_struct *cgo_get_ext(godot_gdnative_api_struct **ext, int i);
 void **go_void_build_array(int length);
 void go_void_add_element(void **array, void *element, int index);
+godot_object *go_godot_class_constructor_call(godot_class_constructor constructor);
+void go_set_main_thread();
+int go_is_main_thread();
 #endif
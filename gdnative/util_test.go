@@ -0,0 +1,30 @@
+package gdnative
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestPointerArray(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+	array := make([]unsafe.Pointer, len(values))
+	for i := range values {
+		array[i] = unsafe.Pointer(&values[i])
+	}
+
+	for length := 0; length <= len(array); length++ {
+		var arrayPtr unsafe.Pointer
+		if length > 0 {
+			arrayPtr = unsafe.Pointer(&array[0])
+		}
+		pointers := pointerArray(arrayPtr, length)
+		if len(pointers) != length {
+			t.Fatalf("pointerArray(%d) returned %d pointers", length, len(pointers))
+		}
+		for i, pointer := range pointers {
+			if pointer != array[i] {
+				t.Errorf("pointerArray(%d)[%d] = %p, want %p", length, i, pointer, array[i])
+			}
+		}
+	}
+}
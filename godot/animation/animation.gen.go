@@ -1,7 +1,10 @@
-package godot
+package animation
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +25,32 @@ const (
 	AnimationInterpolationNearest AnimationInterpolationType = 0
 )
 
+// String will return the Godot name of the AnimationInterpolationType value.
+func (e AnimationInterpolationType) String() string {
+	switch e {
+	case AnimationInterpolationCubic:
+		return "INTERPOLATION_CUBIC"
+	case AnimationInterpolationLinear:
+		return "INTERPOLATION_LINEAR"
+	case AnimationInterpolationNearest:
+		return "INTERPOLATION_NEAREST"
+	}
+	return fmt.Sprintf("AnimationInterpolationType(%d)", int(e))
+}
+
+// ParseAnimationInterpolationType will return the AnimationInterpolationType value with the given Godot name.
+func ParseAnimationInterpolationType(name string) (AnimationInterpolationType, error) {
+	switch name {
+	case "INTERPOLATION_CUBIC":
+		return AnimationInterpolationCubic, nil
+	case "INTERPOLATION_LINEAR":
+		return AnimationInterpolationLinear, nil
+	case "INTERPOLATION_NEAREST":
+		return AnimationInterpolationNearest, nil
+	}
+	return 0, fmt.Errorf("invalid AnimationInterpolationType value %q", name)
+}
+
 // AnimationTrackType is an enum for TrackType values.
 type AnimationTrackType int
 
@@ -31,6 +60,32 @@ const (
 	AnimationTypeValue     AnimationTrackType = 0
 )
 
+// String will return the Godot name of the AnimationTrackType value.
+func (e AnimationTrackType) String() string {
+	switch e {
+	case AnimationTypeMethod:
+		return "TYPE_METHOD"
+	case AnimationTypeTransform:
+		return "TYPE_TRANSFORM"
+	case AnimationTypeValue:
+		return "TYPE_VALUE"
+	}
+	return fmt.Sprintf("AnimationTrackType(%d)", int(e))
+}
+
+// ParseAnimationTrackType will return the AnimationTrackType value with the given Godot name.
+func ParseAnimationTrackType(name string) (AnimationTrackType, error) {
+	switch name {
+	case "TYPE_METHOD":
+		return AnimationTypeMethod, nil
+	case "TYPE_TRANSFORM":
+		return AnimationTypeTransform, nil
+	case "TYPE_VALUE":
+		return AnimationTypeValue, nil
+	}
+	return 0, fmt.Errorf("invalid AnimationTrackType value %q", name)
+}
+
 // AnimationUpdateMode is an enum for UpdateMode values.
 type AnimationUpdateMode int
 
@@ -40,30 +95,112 @@ const (
 	AnimationUpdateTrigger    AnimationUpdateMode = 2
 )
 
-//func NewAnimationFromPointer(ptr gdnative.Pointer) Animation {
-func newAnimationFromPointer(ptr gdnative.Pointer) Animation {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Animation{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the AnimationUpdateMode value.
+func (e AnimationUpdateMode) String() string {
+	switch e {
+	case AnimationUpdateContinuous:
+		return "UPDATE_CONTINUOUS"
+	case AnimationUpdateDiscrete:
+		return "UPDATE_DISCRETE"
+	case AnimationUpdateTrigger:
+		return "UPDATE_TRIGGER"
+	}
+	return fmt.Sprintf("AnimationUpdateMode(%d)", int(e))
+}
 
-	return obj
+// ParseAnimationUpdateMode will return the AnimationUpdateMode value with the given Godot name.
+func ParseAnimationUpdateMode(name string) (AnimationUpdateMode, error) {
+	switch name {
+	case "UPDATE_CONTINUOUS":
+		return AnimationUpdateContinuous, nil
+	case "UPDATE_DISCRETE":
+		return AnimationUpdateDiscrete, nil
+	case "UPDATE_TRIGGER":
+		return AnimationUpdateTrigger, nil
+	}
+	return 0, fmt.Errorf("invalid AnimationUpdateMode value %q", name)
 }
 
 /*
 An Animation resource contains data used to animate everything in the engine. Animations are divided into tracks, and each track must be linked to a node. The state of that node can be changed through time, by adding timed keys (events) to the track. Animations are just data containers, and must be added to odes such as an [AnimationPlayer] or [AnimationTreePlayer] to be played back.
 */
 type Animation struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorAnimation is the class constructor of the Animation class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAnimation = gdnative.NewLazyClassConstructor("Animation")
+
+// NewAnimation will create a new instance of the Animation class.
+// Animation is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAnimation() *Animation {
+	obj := &Animation{}
+	obj.SetBaseObject(classConstructorAnimation.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Animation) BaseClass() string {
 	return "Animation"
 }
 
+// Method binds of the Animation class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAnimationAddTrack                      = gdnative.NewLazyMethodBind("Animation", "add_track")
+	methodBindAnimationClear                         = gdnative.NewLazyMethodBind("Animation", "clear")
+	methodBindAnimationCopyTrack                     = gdnative.NewLazyMethodBind("Animation", "copy_track")
+	methodBindAnimationFindTrack                     = gdnative.NewLazyMethodBind("Animation", "find_track")
+	methodBindAnimationGetLength                     = gdnative.NewLazyMethodBind("Animation", "get_length")
+	methodBindAnimationGetStep                       = gdnative.NewLazyMethodBind("Animation", "get_step")
+	methodBindAnimationGetTrackCount                 = gdnative.NewLazyMethodBind("Animation", "get_track_count")
+	methodBindAnimationHasLoop                       = gdnative.NewLazyMethodBind("Animation", "has_loop")
+	methodBindAnimationMethodTrackGetKeyIndices      = gdnative.NewLazyMethodBind("Animation", "method_track_get_key_indices")
+	methodBindAnimationMethodTrackGetName            = gdnative.NewLazyMethodBind("Animation", "method_track_get_name")
+	methodBindAnimationMethodTrackGetParams          = gdnative.NewLazyMethodBind("Animation", "method_track_get_params")
+	methodBindAnimationRemoveTrack                   = gdnative.NewLazyMethodBind("Animation", "remove_track")
+	methodBindAnimationSetLength                     = gdnative.NewLazyMethodBind("Animation", "set_length")
+	methodBindAnimationSetLoop                       = gdnative.NewLazyMethodBind("Animation", "set_loop")
+	methodBindAnimationSetStep                       = gdnative.NewLazyMethodBind("Animation", "set_step")
+	methodBindAnimationTrackFindKey                  = gdnative.NewLazyMethodBind("Animation", "track_find_key")
+	methodBindAnimationTrackGetInterpolationLoopWrap = gdnative.NewLazyMethodBind("Animation", "track_get_interpolation_loop_wrap")
+	methodBindAnimationTrackGetInterpolationType     = gdnative.NewLazyMethodBind("Animation", "track_get_interpolation_type")
+	methodBindAnimationTrackGetKeyCount              = gdnative.NewLazyMethodBind("Animation", "track_get_key_count")
+	methodBindAnimationTrackGetKeyTime               = gdnative.NewLazyMethodBind("Animation", "track_get_key_time")
+	methodBindAnimationTrackGetKeyTransition         = gdnative.NewLazyMethodBind("Animation", "track_get_key_transition")
+	methodBindAnimationTrackGetKeyValue              = gdnative.NewLazyMethodBind("Animation", "track_get_key_value")
+	methodBindAnimationTrackGetPath                  = gdnative.NewLazyMethodBind("Animation", "track_get_path")
+	methodBindAnimationTrackGetType                  = gdnative.NewLazyMethodBind("Animation", "track_get_type")
+	methodBindAnimationTrackInsertKey                = gdnative.NewLazyMethodBind("Animation", "track_insert_key")
+	methodBindAnimationTrackIsEnabled                = gdnative.NewLazyMethodBind("Animation", "track_is_enabled")
+	methodBindAnimationTrackIsImported               = gdnative.NewLazyMethodBind("Animation", "track_is_imported")
+	methodBindAnimationTrackMoveDown                 = gdnative.NewLazyMethodBind("Animation", "track_move_down")
+	methodBindAnimationTrackMoveUp                   = gdnative.NewLazyMethodBind("Animation", "track_move_up")
+	methodBindAnimationTrackRemoveKey                = gdnative.NewLazyMethodBind("Animation", "track_remove_key")
+	methodBindAnimationTrackRemoveKeyAtPosition      = gdnative.NewLazyMethodBind("Animation", "track_remove_key_at_position")
+	methodBindAnimationTrackSetEnabled               = gdnative.NewLazyMethodBind("Animation", "track_set_enabled")
+	methodBindAnimationTrackSetImported              = gdnative.NewLazyMethodBind("Animation", "track_set_imported")
+	methodBindAnimationTrackSetInterpolationLoopWrap = gdnative.NewLazyMethodBind("Animation", "track_set_interpolation_loop_wrap")
+	methodBindAnimationTrackSetInterpolationType     = gdnative.NewLazyMethodBind("Animation", "track_set_interpolation_type")
+	methodBindAnimationTrackSetKeyTransition         = gdnative.NewLazyMethodBind("Animation", "track_set_key_transition")
+	methodBindAnimationTrackSetKeyValue              = gdnative.NewLazyMethodBind("Animation", "track_set_key_value")
+	methodBindAnimationTrackSetPath                  = gdnative.NewLazyMethodBind("Animation", "track_set_path")
+	methodBindAnimationTransformTrackInsertKey       = gdnative.NewLazyMethodBind("Animation", "transform_track_insert_key")
+	methodBindAnimationTransformTrackInterpolate     = gdnative.NewLazyMethodBind("Animation", "transform_track_interpolate")
+	methodBindAnimationValueTrackGetKeyIndices       = gdnative.NewLazyMethodBind("Animation", "value_track_get_key_indices")
+	methodBindAnimationValueTrackGetUpdateMode       = gdnative.NewLazyMethodBind("Animation", "value_track_get_update_mode")
+	methodBindAnimationValueTrackSetUpdateMode       = gdnative.NewLazyMethodBind("Animation", "value_track_set_update_mode")
+)
+
 /*
-        Add a track to the Animation. The track type must be specified as any of the values in the TYPE_* enumeration.
-	Args: [{ false type int} {-1 true at_position int}], Returns: int
+	        Add a track to the Animation. The track type must be specified as any of the values in the TYPE_* enumeration.
+		Args: [{ false type int} {-1 true at_position int}], Returns: int
 */
 func (o *Animation) AddTrack(aType gdnative.Int, atPosition gdnative.Int) gdnative.Int {
 	//log.Println("Calling Animation.AddTrack()")
@@ -74,7 +211,7 @@ func (o *Animation) AddTrack(aType gdnative.Int, atPosition gdnative.Int) gdnati
 	ptrArguments[1] = gdnative.NewPointerFromInt(atPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "add_track")
+	methodBind := methodBindAnimationAddTrack.Get()
 
 	// Call the parent method.
 	// int
@@ -87,8 +224,8 @@ func (o *Animation) AddTrack(aType gdnative.Int, atPosition gdnative.Int) gdnati
 }
 
 /*
-        Clear the animation (clear all tracks and reset all).
-	Args: [], Returns: void
+	        Clear the animation (clear all tracks and reset all).
+		Args: [], Returns: void
 */
 func (o *Animation) Clear() {
 	//log.Println("Calling Animation.Clear()")
@@ -97,7 +234,7 @@ func (o *Animation) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "clear")
+	methodBind := methodBindAnimationClear.Get()
 
 	// Call the parent method.
 	// void
@@ -107,8 +244,8 @@ func (o *Animation) Clear() {
 }
 
 /*
-        Adds a new track that is a copy of the given track from [code]to_animation[/code].
-	Args: [{ false track int} { false to_animation Animation}], Returns: void
+	        Adds a new track that is a copy of the given track from [code]to_animation[/code].
+		Args: [{ false track int} { false to_animation Animation}], Returns: void
 */
 func (o *Animation) CopyTrack(track gdnative.Int, toAnimation AnimationImplementer) {
 	//log.Println("Calling Animation.CopyTrack()")
@@ -116,10 +253,10 @@ func (o *Animation) CopyTrack(track gdnative.Int, toAnimation AnimationImplement
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(track)
-	ptrArguments[1] = gdnative.NewPointerFromObject(toAnimation.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(toAnimation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "copy_track")
+	methodBind := methodBindAnimationCopyTrack.Get()
 
 	// Call the parent method.
 	// void
@@ -129,8 +266,8 @@ func (o *Animation) CopyTrack(track gdnative.Int, toAnimation AnimationImplement
 }
 
 /*
-        Return the index of the specified track. If the track is not found, return -1.
-	Args: [{ false path NodePath}], Returns: int
+	        Return the index of the specified track. If the track is not found, return -1.
+		Args: [{ false path NodePath}], Returns: int
 */
 func (o *Animation) FindTrack(path gdnative.NodePath) gdnative.Int {
 	//log.Println("Calling Animation.FindTrack()")
@@ -140,7 +277,7 @@ func (o *Animation) FindTrack(path gdnative.NodePath) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "find_track")
+	methodBind := methodBindAnimationFindTrack.Get()
 
 	// Call the parent method.
 	// int
@@ -153,8 +290,8 @@ func (o *Animation) FindTrack(path gdnative.NodePath) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Animation) GetLength() gdnative.Real {
 	//log.Println("Calling Animation.GetLength()")
@@ -163,7 +300,7 @@ func (o *Animation) GetLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "get_length")
+	methodBind := methodBindAnimationGetLength.Get()
 
 	// Call the parent method.
 	// float
@@ -176,8 +313,8 @@ func (o *Animation) GetLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Animation) GetStep() gdnative.Real {
 	//log.Println("Calling Animation.GetStep()")
@@ -186,7 +323,7 @@ func (o *Animation) GetStep() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "get_step")
+	methodBind := methodBindAnimationGetStep.Get()
 
 	// Call the parent method.
 	// float
@@ -199,8 +336,8 @@ func (o *Animation) GetStep() gdnative.Real {
 }
 
 /*
-        Return the amount of tracks in the animation.
-	Args: [], Returns: int
+	        Return the amount of tracks in the animation.
+		Args: [], Returns: int
 */
 func (o *Animation) GetTrackCount() gdnative.Int {
 	//log.Println("Calling Animation.GetTrackCount()")
@@ -209,7 +346,7 @@ func (o *Animation) GetTrackCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "get_track_count")
+	methodBind := methodBindAnimationGetTrackCount.Get()
 
 	// Call the parent method.
 	// int
@@ -222,8 +359,8 @@ func (o *Animation) GetTrackCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Animation) HasLoop() gdnative.Bool {
 	//log.Println("Calling Animation.HasLoop()")
@@ -232,7 +369,7 @@ func (o *Animation) HasLoop() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "has_loop")
+	methodBind := methodBindAnimationHasLoop.Get()
 
 	// Call the parent method.
 	// bool
@@ -245,8 +382,8 @@ func (o *Animation) HasLoop() gdnative.Bool {
 }
 
 /*
-        Return all the key indices of a method track, given a position and delta time.
-	Args: [{ false idx int} { false time_sec float} { false delta float}], Returns: PoolIntArray
+	        Return all the key indices of a method track, given a position and delta time.
+		Args: [{ false idx int} { false time_sec float} { false delta float}], Returns: PoolIntArray
 */
 func (o *Animation) MethodTrackGetKeyIndices(idx gdnative.Int, timeSec gdnative.Real, delta gdnative.Real) gdnative.PoolIntArray {
 	//log.Println("Calling Animation.MethodTrackGetKeyIndices()")
@@ -258,7 +395,7 @@ func (o *Animation) MethodTrackGetKeyIndices(idx gdnative.Int, timeSec gdnative.
 	ptrArguments[2] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "method_track_get_key_indices")
+	methodBind := methodBindAnimationMethodTrackGetKeyIndices.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -271,8 +408,8 @@ func (o *Animation) MethodTrackGetKeyIndices(idx gdnative.Int, timeSec gdnative.
 }
 
 /*
-        Return the method name of a method track.
-	Args: [{ false idx int} { false key_idx int}], Returns: String
+	        Return the method name of a method track.
+		Args: [{ false idx int} { false key_idx int}], Returns: String
 */
 func (o *Animation) MethodTrackGetName(idx gdnative.Int, keyIdx gdnative.Int) gdnative.String {
 	//log.Println("Calling Animation.MethodTrackGetName()")
@@ -283,7 +420,7 @@ func (o *Animation) MethodTrackGetName(idx gdnative.Int, keyIdx gdnative.Int) gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(keyIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "method_track_get_name")
+	methodBind := methodBindAnimationMethodTrackGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -296,8 +433,8 @@ func (o *Animation) MethodTrackGetName(idx gdnative.Int, keyIdx gdnative.Int) gd
 }
 
 /*
-        Return the arguments values to be called on a method track for a given key in a given track.
-	Args: [{ false idx int} { false key_idx int}], Returns: Array
+	        Return the arguments values to be called on a method track for a given key in a given track.
+		Args: [{ false idx int} { false key_idx int}], Returns: Array
 */
 func (o *Animation) MethodTrackGetParams(idx gdnative.Int, keyIdx gdnative.Int) gdnative.Array {
 	//log.Println("Calling Animation.MethodTrackGetParams()")
@@ -308,7 +445,7 @@ func (o *Animation) MethodTrackGetParams(idx gdnative.Int, keyIdx gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(keyIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "method_track_get_params")
+	methodBind := methodBindAnimationMethodTrackGetParams.Get()
 
 	// Call the parent method.
 	// Array
@@ -321,8 +458,8 @@ func (o *Animation) MethodTrackGetParams(idx gdnative.Int, keyIdx gdnative.Int)
 }
 
 /*
-        Remove a track by specifying the track index.
-	Args: [{ false idx int}], Returns: void
+	        Remove a track by specifying the track index.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *Animation) RemoveTrack(idx gdnative.Int) {
 	//log.Println("Calling Animation.RemoveTrack()")
@@ -332,7 +469,7 @@ func (o *Animation) RemoveTrack(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "remove_track")
+	methodBind := methodBindAnimationRemoveTrack.Get()
 
 	// Call the parent method.
 	// void
@@ -342,8 +479,8 @@ func (o *Animation) RemoveTrack(idx gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false time_sec float}], Returns: void
+	        Undocumented
+		Args: [{ false time_sec float}], Returns: void
 */
 func (o *Animation) SetLength(timeSec gdnative.Real) {
 	//log.Println("Calling Animation.SetLength()")
@@ -353,7 +490,7 @@ func (o *Animation) SetLength(timeSec gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(timeSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "set_length")
+	methodBind := methodBindAnimationSetLength.Get()
 
 	// Call the parent method.
 	// void
@@ -363,8 +500,8 @@ func (o *Animation) SetLength(timeSec gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Animation) SetLoop(enabled gdnative.Bool) {
 	//log.Println("Calling Animation.SetLoop()")
@@ -374,7 +511,7 @@ func (o *Animation) SetLoop(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "set_loop")
+	methodBind := methodBindAnimationSetLoop.Get()
 
 	// Call the parent method.
 	// void
@@ -384,8 +521,8 @@ func (o *Animation) SetLoop(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size_sec float}], Returns: void
+	        Undocumented
+		Args: [{ false size_sec float}], Returns: void
 */
 func (o *Animation) SetStep(sizeSec gdnative.Real) {
 	//log.Println("Calling Animation.SetStep()")
@@ -395,7 +532,7 @@ func (o *Animation) SetStep(sizeSec gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(sizeSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "set_step")
+	methodBind := methodBindAnimationSetStep.Get()
 
 	// Call the parent method.
 	// void
@@ -405,8 +542,8 @@ func (o *Animation) SetStep(sizeSec gdnative.Real) {
 }
 
 /*
-        Find the key index by time in a given track. Optionally, only find it if the exact time is given.
-	Args: [{ false idx int} { false time float} {False true exact bool}], Returns: int
+	        Find the key index by time in a given track. Optionally, only find it if the exact time is given.
+		Args: [{ false idx int} { false time float} {False true exact bool}], Returns: int
 */
 func (o *Animation) TrackFindKey(idx gdnative.Int, time gdnative.Real, exact gdnative.Bool) gdnative.Int {
 	//log.Println("Calling Animation.TrackFindKey()")
@@ -418,7 +555,7 @@ func (o *Animation) TrackFindKey(idx gdnative.Int, time gdnative.Real, exact gdn
 	ptrArguments[2] = gdnative.NewPointerFromBool(exact)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_find_key")
+	methodBind := methodBindAnimationTrackFindKey.Get()
 
 	// Call the parent method.
 	// int
@@ -431,8 +568,8 @@ func (o *Animation) TrackFindKey(idx gdnative.Int, time gdnative.Real, exact gdn
 }
 
 /*
-        Returns [code]true[/code] if the track at [code]idx[/code] wraps the interpolation loop. Default value: [code]true[/code].
-	Args: [{ false idx int}], Returns: bool
+	        Returns [code]true[/code] if the track at [code]idx[/code] wraps the interpolation loop. Default value: [code]true[/code].
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *Animation) TrackGetInterpolationLoopWrap(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling Animation.TrackGetInterpolationLoopWrap()")
@@ -442,7 +579,7 @@ func (o *Animation) TrackGetInterpolationLoopWrap(idx gdnative.Int) gdnative.Boo
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_interpolation_loop_wrap")
+	methodBind := methodBindAnimationTrackGetInterpolationLoopWrap.Get()
 
 	// Call the parent method.
 	// bool
@@ -455,8 +592,8 @@ func (o *Animation) TrackGetInterpolationLoopWrap(idx gdnative.Int) gdnative.Boo
 }
 
 /*
-        Return the interpolation type of a given track, from the INTERPOLATION_* enum.
-	Args: [{ false idx int}], Returns: enum.Animation::InterpolationType
+	        Return the interpolation type of a given track, from the INTERPOLATION_* enum.
+		Args: [{ false idx int}], Returns: enum.Animation::InterpolationType
 */
 func (o *Animation) TrackGetInterpolationType(idx gdnative.Int) AnimationInterpolationType {
 	//log.Println("Calling Animation.TrackGetInterpolationType()")
@@ -466,7 +603,7 @@ func (o *Animation) TrackGetInterpolationType(idx gdnative.Int) AnimationInterpo
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_interpolation_type")
+	methodBind := methodBindAnimationTrackGetInterpolationType.Get()
 
 	// Call the parent method.
 	// enum.Animation::InterpolationType
@@ -479,8 +616,8 @@ func (o *Animation) TrackGetInterpolationType(idx gdnative.Int) AnimationInterpo
 }
 
 /*
-        Return the amount of keys in a given track.
-	Args: [{ false idx int}], Returns: int
+	        Return the amount of keys in a given track.
+		Args: [{ false idx int}], Returns: int
 */
 func (o *Animation) TrackGetKeyCount(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling Animation.TrackGetKeyCount()")
@@ -490,7 +627,7 @@ func (o *Animation) TrackGetKeyCount(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_key_count")
+	methodBind := methodBindAnimationTrackGetKeyCount.Get()
 
 	// Call the parent method.
 	// int
@@ -503,8 +640,8 @@ func (o *Animation) TrackGetKeyCount(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return the time at which the key is located.
-	Args: [{ false idx int} { false key_idx int}], Returns: float
+	        Return the time at which the key is located.
+		Args: [{ false idx int} { false key_idx int}], Returns: float
 */
 func (o *Animation) TrackGetKeyTime(idx gdnative.Int, keyIdx gdnative.Int) gdnative.Real {
 	//log.Println("Calling Animation.TrackGetKeyTime()")
@@ -515,7 +652,7 @@ func (o *Animation) TrackGetKeyTime(idx gdnative.Int, keyIdx gdnative.Int) gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(keyIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_key_time")
+	methodBind := methodBindAnimationTrackGetKeyTime.Get()
 
 	// Call the parent method.
 	// float
@@ -528,8 +665,8 @@ func (o *Animation) TrackGetKeyTime(idx gdnative.Int, keyIdx gdnative.Int) gdnat
 }
 
 /*
-        Return the transition curve (easing) for a specific key (see built-in math function "ease").
-	Args: [{ false idx int} { false key_idx int}], Returns: float
+	        Return the transition curve (easing) for a specific key (see built-in math function "ease").
+		Args: [{ false idx int} { false key_idx int}], Returns: float
 */
 func (o *Animation) TrackGetKeyTransition(idx gdnative.Int, keyIdx gdnative.Int) gdnative.Real {
 	//log.Println("Calling Animation.TrackGetKeyTransition()")
@@ -540,7 +677,7 @@ func (o *Animation) TrackGetKeyTransition(idx gdnative.Int, keyIdx gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(keyIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_key_transition")
+	methodBind := methodBindAnimationTrackGetKeyTransition.Get()
 
 	// Call the parent method.
 	// float
@@ -553,8 +690,8 @@ func (o *Animation) TrackGetKeyTransition(idx gdnative.Int, keyIdx gdnative.Int)
 }
 
 /*
-        Return the value of a given key in a given track.
-	Args: [{ false idx int} { false key_idx int}], Returns: Variant
+	        Return the value of a given key in a given track.
+		Args: [{ false idx int} { false key_idx int}], Returns: Variant
 */
 func (o *Animation) TrackGetKeyValue(idx gdnative.Int, keyIdx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling Animation.TrackGetKeyValue()")
@@ -565,7 +702,7 @@ func (o *Animation) TrackGetKeyValue(idx gdnative.Int, keyIdx gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(keyIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_key_value")
+	methodBind := methodBindAnimationTrackGetKeyValue.Get()
 
 	// Call the parent method.
 	// Variant
@@ -578,8 +715,8 @@ func (o *Animation) TrackGetKeyValue(idx gdnative.Int, keyIdx gdnative.Int) gdna
 }
 
 /*
-        Get the path of a track. for more information on the path format, see [method track_set_path]
-	Args: [{ false idx int}], Returns: NodePath
+	        Get the path of a track. for more information on the path format, see [method track_set_path]
+		Args: [{ false idx int}], Returns: NodePath
 */
 func (o *Animation) TrackGetPath(idx gdnative.Int) gdnative.NodePath {
 	//log.Println("Calling Animation.TrackGetPath()")
@@ -589,7 +726,7 @@ func (o *Animation) TrackGetPath(idx gdnative.Int) gdnative.NodePath {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_path")
+	methodBind := methodBindAnimationTrackGetPath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -602,8 +739,8 @@ func (o *Animation) TrackGetPath(idx gdnative.Int) gdnative.NodePath {
 }
 
 /*
-        Get the type of a track.
-	Args: [{ false idx int}], Returns: enum.Animation::TrackType
+	        Get the type of a track.
+		Args: [{ false idx int}], Returns: enum.Animation::TrackType
 */
 func (o *Animation) TrackGetType(idx gdnative.Int) AnimationTrackType {
 	//log.Println("Calling Animation.TrackGetType()")
@@ -613,7 +750,7 @@ func (o *Animation) TrackGetType(idx gdnative.Int) AnimationTrackType {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_get_type")
+	methodBind := methodBindAnimationTrackGetType.Get()
 
 	// Call the parent method.
 	// enum.Animation::TrackType
@@ -626,8 +763,8 @@ func (o *Animation) TrackGetType(idx gdnative.Int) AnimationTrackType {
 }
 
 /*
-        Insert a generic key in a given track.
-	Args: [{ false idx int} { false time float} { false key Variant} {1 true transition float}], Returns: void
+	        Insert a generic key in a given track.
+		Args: [{ false idx int} { false time float} { false key Variant} {1 true transition float}], Returns: void
 */
 func (o *Animation) TrackInsertKey(idx gdnative.Int, time gdnative.Real, key gdnative.Variant, transition gdnative.Real) {
 	//log.Println("Calling Animation.TrackInsertKey()")
@@ -640,7 +777,7 @@ func (o *Animation) TrackInsertKey(idx gdnative.Int, time gdnative.Real, key gdn
 	ptrArguments[3] = gdnative.NewPointerFromReal(transition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_insert_key")
+	methodBind := methodBindAnimationTrackInsertKey.Get()
 
 	// Call the parent method.
 	// void
@@ -650,8 +787,8 @@ func (o *Animation) TrackInsertKey(idx gdnative.Int, time gdnative.Real, key gdn
 }
 
 /*
-        Returns [code]true[/code] if the track at index [code]idx[/code] is enabled.
-	Args: [{ false idx int}], Returns: bool
+	        Returns [code]true[/code] if the track at index [code]idx[/code] is enabled.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *Animation) TrackIsEnabled(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling Animation.TrackIsEnabled()")
@@ -661,7 +798,7 @@ func (o *Animation) TrackIsEnabled(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_is_enabled")
+	methodBind := methodBindAnimationTrackIsEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -674,8 +811,8 @@ func (o *Animation) TrackIsEnabled(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Return true if the given track is imported. Else, return false.
-	Args: [{ false idx int}], Returns: bool
+	        Return true if the given track is imported. Else, return false.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *Animation) TrackIsImported(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling Animation.TrackIsImported()")
@@ -685,7 +822,7 @@ func (o *Animation) TrackIsImported(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_is_imported")
+	methodBind := methodBindAnimationTrackIsImported.Get()
 
 	// Call the parent method.
 	// bool
@@ -698,8 +835,8 @@ func (o *Animation) TrackIsImported(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Move a track down.
-	Args: [{ false idx int}], Returns: void
+	        Move a track down.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *Animation) TrackMoveDown(idx gdnative.Int) {
 	//log.Println("Calling Animation.TrackMoveDown()")
@@ -709,7 +846,7 @@ func (o *Animation) TrackMoveDown(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_move_down")
+	methodBind := methodBindAnimationTrackMoveDown.Get()
 
 	// Call the parent method.
 	// void
@@ -719,8 +856,8 @@ func (o *Animation) TrackMoveDown(idx gdnative.Int) {
 }
 
 /*
-        Move a track up.
-	Args: [{ false idx int}], Returns: void
+	        Move a track up.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *Animation) TrackMoveUp(idx gdnative.Int) {
 	//log.Println("Calling Animation.TrackMoveUp()")
@@ -730,7 +867,7 @@ func (o *Animation) TrackMoveUp(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_move_up")
+	methodBind := methodBindAnimationTrackMoveUp.Get()
 
 	// Call the parent method.
 	// void
@@ -740,8 +877,8 @@ func (o *Animation) TrackMoveUp(idx gdnative.Int) {
 }
 
 /*
-        Remove a key by index in a given track.
-	Args: [{ false idx int} { false key_idx int}], Returns: void
+	        Remove a key by index in a given track.
+		Args: [{ false idx int} { false key_idx int}], Returns: void
 */
 func (o *Animation) TrackRemoveKey(idx gdnative.Int, keyIdx gdnative.Int) {
 	//log.Println("Calling Animation.TrackRemoveKey()")
@@ -752,7 +889,7 @@ func (o *Animation) TrackRemoveKey(idx gdnative.Int, keyIdx gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(keyIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_remove_key")
+	methodBind := methodBindAnimationTrackRemoveKey.Get()
 
 	// Call the parent method.
 	// void
@@ -762,8 +899,8 @@ func (o *Animation) TrackRemoveKey(idx gdnative.Int, keyIdx gdnative.Int) {
 }
 
 /*
-        Remove a key by position (seconds) in a given track.
-	Args: [{ false idx int} { false position float}], Returns: void
+	        Remove a key by position (seconds) in a given track.
+		Args: [{ false idx int} { false position float}], Returns: void
 */
 func (o *Animation) TrackRemoveKeyAtPosition(idx gdnative.Int, position gdnative.Real) {
 	//log.Println("Calling Animation.TrackRemoveKeyAtPosition()")
@@ -774,7 +911,7 @@ func (o *Animation) TrackRemoveKeyAtPosition(idx gdnative.Int, position gdnative
 	ptrArguments[1] = gdnative.NewPointerFromReal(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_remove_key_at_position")
+	methodBind := methodBindAnimationTrackRemoveKeyAtPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -784,8 +921,8 @@ func (o *Animation) TrackRemoveKeyAtPosition(idx gdnative.Int, position gdnative
 }
 
 /*
-        Enables/disables the given track. Tracks are enabled by default.
-	Args: [{ false idx int} { false enabled bool}], Returns: void
+	        Enables/disables the given track. Tracks are enabled by default.
+		Args: [{ false idx int} { false enabled bool}], Returns: void
 */
 func (o *Animation) TrackSetEnabled(idx gdnative.Int, enabled gdnative.Bool) {
 	//log.Println("Calling Animation.TrackSetEnabled()")
@@ -796,7 +933,7 @@ func (o *Animation) TrackSetEnabled(idx gdnative.Int, enabled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_set_enabled")
+	methodBind := methodBindAnimationTrackSetEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -806,8 +943,8 @@ func (o *Animation) TrackSetEnabled(idx gdnative.Int, enabled gdnative.Bool) {
 }
 
 /*
-        Set the given track as imported or not.
-	Args: [{ false idx int} { false imported bool}], Returns: void
+	        Set the given track as imported or not.
+		Args: [{ false idx int} { false imported bool}], Returns: void
 */
 func (o *Animation) TrackSetImported(idx gdnative.Int, imported gdnative.Bool) {
 	//log.Println("Calling Animation.TrackSetImported()")
@@ -818,7 +955,7 @@ func (o *Animation) TrackSetImported(idx gdnative.Int, imported gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(imported)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_set_imported")
+	methodBind := methodBindAnimationTrackSetImported.Get()
 
 	// Call the parent method.
 	// void
@@ -828,8 +965,8 @@ func (o *Animation) TrackSetImported(idx gdnative.Int, imported gdnative.Bool) {
 }
 
 /*
-        If [code]true[/code] the track at [code]idx[/code] wraps the interpolation loop.
-	Args: [{ false idx int} { false interpolation bool}], Returns: void
+	        If [code]true[/code] the track at [code]idx[/code] wraps the interpolation loop.
+		Args: [{ false idx int} { false interpolation bool}], Returns: void
 */
 func (o *Animation) TrackSetInterpolationLoopWrap(idx gdnative.Int, interpolation gdnative.Bool) {
 	//log.Println("Calling Animation.TrackSetInterpolationLoopWrap()")
@@ -840,7 +977,7 @@ func (o *Animation) TrackSetInterpolationLoopWrap(idx gdnative.Int, interpolatio
 	ptrArguments[1] = gdnative.NewPointerFromBool(interpolation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_set_interpolation_loop_wrap")
+	methodBind := methodBindAnimationTrackSetInterpolationLoopWrap.Get()
 
 	// Call the parent method.
 	// void
@@ -850,8 +987,8 @@ func (o *Animation) TrackSetInterpolationLoopWrap(idx gdnative.Int, interpolatio
 }
 
 /*
-        Set the interpolation type of a given track, from the INTERPOLATION_* enum.
-	Args: [{ false idx int} { false interpolation int}], Returns: void
+	        Set the interpolation type of a given track, from the INTERPOLATION_* enum.
+		Args: [{ false idx int} { false interpolation int}], Returns: void
 */
 func (o *Animation) TrackSetInterpolationType(idx gdnative.Int, interpolation gdnative.Int) {
 	//log.Println("Calling Animation.TrackSetInterpolationType()")
@@ -862,7 +999,7 @@ func (o *Animation) TrackSetInterpolationType(idx gdnative.Int, interpolation gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(interpolation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_set_interpolation_type")
+	methodBind := methodBindAnimationTrackSetInterpolationType.Get()
 
 	// Call the parent method.
 	// void
@@ -872,8 +1009,8 @@ func (o *Animation) TrackSetInterpolationType(idx gdnative.Int, interpolation gd
 }
 
 /*
-        Set the transition curve (easing) for a specific key (see built-in math function "ease").
-	Args: [{ false idx int} { false key_idx int} { false transition float}], Returns: void
+	        Set the transition curve (easing) for a specific key (see built-in math function "ease").
+		Args: [{ false idx int} { false key_idx int} { false transition float}], Returns: void
 */
 func (o *Animation) TrackSetKeyTransition(idx gdnative.Int, keyIdx gdnative.Int, transition gdnative.Real) {
 	//log.Println("Calling Animation.TrackSetKeyTransition()")
@@ -885,7 +1022,7 @@ func (o *Animation) TrackSetKeyTransition(idx gdnative.Int, keyIdx gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromReal(transition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_set_key_transition")
+	methodBind := methodBindAnimationTrackSetKeyTransition.Get()
 
 	// Call the parent method.
 	// void
@@ -895,8 +1032,8 @@ func (o *Animation) TrackSetKeyTransition(idx gdnative.Int, keyIdx gdnative.Int,
 }
 
 /*
-        Set the value of an existing key.
-	Args: [{ false idx int} { false key int} { false value Variant}], Returns: void
+	        Set the value of an existing key.
+		Args: [{ false idx int} { false key int} { false value Variant}], Returns: void
 */
 func (o *Animation) TrackSetKeyValue(idx gdnative.Int, key gdnative.Int, value gdnative.Variant) {
 	//log.Println("Calling Animation.TrackSetKeyValue()")
@@ -908,7 +1045,7 @@ func (o *Animation) TrackSetKeyValue(idx gdnative.Int, key gdnative.Int, value g
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_set_key_value")
+	methodBind := methodBindAnimationTrackSetKeyValue.Get()
 
 	// Call the parent method.
 	// void
@@ -918,8 +1055,8 @@ func (o *Animation) TrackSetKeyValue(idx gdnative.Int, key gdnative.Int, value g
 }
 
 /*
-        Set the path of a track. Paths must be valid scene-tree paths to a node, and must be specified starting from the parent node of the node that will reproduce the animation. Tracks that control properties or bones must append their name after the path, separated by ":". Example: "character/skeleton:ankle" or "character/mesh:transform/local"
-	Args: [{ false idx int} { false path NodePath}], Returns: void
+	        Set the path of a track. Paths must be valid scene-tree paths to a node, and must be specified starting from the parent node of the node that will reproduce the animation. Tracks that control properties or bones must append their name after the path, separated by ":". Example: "character/skeleton:ankle" or "character/mesh:transform/local"
+		Args: [{ false idx int} { false path NodePath}], Returns: void
 */
 func (o *Animation) TrackSetPath(idx gdnative.Int, path gdnative.NodePath) {
 	//log.Println("Calling Animation.TrackSetPath()")
@@ -930,7 +1067,7 @@ func (o *Animation) TrackSetPath(idx gdnative.Int, path gdnative.NodePath) {
 	ptrArguments[1] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "track_set_path")
+	methodBind := methodBindAnimationTrackSetPath.Get()
 
 	// Call the parent method.
 	// void
@@ -940,8 +1077,8 @@ func (o *Animation) TrackSetPath(idx gdnative.Int, path gdnative.NodePath) {
 }
 
 /*
-        Insert a transform key for a transform track.
-	Args: [{ false idx int} { false time float} { false location Vector3} { false rotation Quat} { false scale Vector3}], Returns: int
+	        Insert a transform key for a transform track.
+		Args: [{ false idx int} { false time float} { false location Vector3} { false rotation Quat} { false scale Vector3}], Returns: int
 */
 func (o *Animation) TransformTrackInsertKey(idx gdnative.Int, time gdnative.Real, location gdnative.Vector3, rotation gdnative.Quat, scale gdnative.Vector3) gdnative.Int {
 	//log.Println("Calling Animation.TransformTrackInsertKey()")
@@ -955,7 +1092,7 @@ func (o *Animation) TransformTrackInsertKey(idx gdnative.Int, time gdnative.Real
 	ptrArguments[4] = gdnative.NewPointerFromVector3(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "transform_track_insert_key")
+	methodBind := methodBindAnimationTransformTrackInsertKey.Get()
 
 	// Call the parent method.
 	// int
@@ -968,8 +1105,8 @@ func (o *Animation) TransformTrackInsertKey(idx gdnative.Int, time gdnative.Real
 }
 
 /*
-        Return the interpolated value of a transform track at a given time (in seconds). An array consisting of 3 elements: position ([Vector3]), rotation ([Quat]) and scale ([Vector3]).
-	Args: [{ false idx int} { false time_sec float}], Returns: Array
+	        Return the interpolated value of a transform track at a given time (in seconds). An array consisting of 3 elements: position ([Vector3]), rotation ([Quat]) and scale ([Vector3]).
+		Args: [{ false idx int} { false time_sec float}], Returns: Array
 */
 func (o *Animation) TransformTrackInterpolate(idx gdnative.Int, timeSec gdnative.Real) gdnative.Array {
 	//log.Println("Calling Animation.TransformTrackInterpolate()")
@@ -980,7 +1117,7 @@ func (o *Animation) TransformTrackInterpolate(idx gdnative.Int, timeSec gdnative
 	ptrArguments[1] = gdnative.NewPointerFromReal(timeSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "transform_track_interpolate")
+	methodBind := methodBindAnimationTransformTrackInterpolate.Get()
 
 	// Call the parent method.
 	// Array
@@ -993,8 +1130,8 @@ func (o *Animation) TransformTrackInterpolate(idx gdnative.Int, timeSec gdnative
 }
 
 /*
-        Return all the key indices of a value track, given a position and delta time.
-	Args: [{ false idx int} { false time_sec float} { false delta float}], Returns: PoolIntArray
+	        Return all the key indices of a value track, given a position and delta time.
+		Args: [{ false idx int} { false time_sec float} { false delta float}], Returns: PoolIntArray
 */
 func (o *Animation) ValueTrackGetKeyIndices(idx gdnative.Int, timeSec gdnative.Real, delta gdnative.Real) gdnative.PoolIntArray {
 	//log.Println("Calling Animation.ValueTrackGetKeyIndices()")
@@ -1006,7 +1143,7 @@ func (o *Animation) ValueTrackGetKeyIndices(idx gdnative.Int, timeSec gdnative.R
 	ptrArguments[2] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "value_track_get_key_indices")
+	methodBind := methodBindAnimationValueTrackGetKeyIndices.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -1019,8 +1156,8 @@ func (o *Animation) ValueTrackGetKeyIndices(idx gdnative.Int, timeSec gdnative.R
 }
 
 /*
-        Return the update mode of a value track.
-	Args: [{ false idx int}], Returns: enum.Animation::UpdateMode
+	        Return the update mode of a value track.
+		Args: [{ false idx int}], Returns: enum.Animation::UpdateMode
 */
 func (o *Animation) ValueTrackGetUpdateMode(idx gdnative.Int) AnimationUpdateMode {
 	//log.Println("Calling Animation.ValueTrackGetUpdateMode()")
@@ -1030,7 +1167,7 @@ func (o *Animation) ValueTrackGetUpdateMode(idx gdnative.Int) AnimationUpdateMod
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "value_track_get_update_mode")
+	methodBind := methodBindAnimationValueTrackGetUpdateMode.Get()
 
 	// Call the parent method.
 	// enum.Animation::UpdateMode
@@ -1043,8 +1180,8 @@ func (o *Animation) ValueTrackGetUpdateMode(idx gdnative.Int) AnimationUpdateMod
 }
 
 /*
-        Set the update mode (UPDATE_*) of a value track.
-	Args: [{ false idx int} { false mode int}], Returns: void
+	        Set the update mode (UPDATE_*) of a value track.
+		Args: [{ false idx int} { false mode int}], Returns: void
 */
 func (o *Animation) ValueTrackSetUpdateMode(idx gdnative.Int, mode gdnative.Int) {
 	//log.Println("Calling Animation.ValueTrackSetUpdateMode()")
@@ -1055,7 +1192,7 @@ func (o *Animation) ValueTrackSetUpdateMode(idx gdnative.Int, mode gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Animation", "value_track_set_update_mode")
+	methodBind := methodBindAnimationValueTrackSetUpdateMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1064,10 +1201,40 @@ func (o *Animation) ValueTrackSetUpdateMode(idx gdnative.Int, mode gdnative.Int)
 
 }
 
+// AddTrackWithDefaults will call AddTrack using the default values for: at_position.
+func (o *Animation) AddTrackWithDefaults(aType gdnative.Int) gdnative.Int {
+	return o.AddTrack(aType, -1)
+}
+
+// TrackFindKeyWithDefaults will call TrackFindKey using the default values for: exact.
+func (o *Animation) TrackFindKeyWithDefaults(idx gdnative.Int, time gdnative.Real) gdnative.Int {
+	return o.TrackFindKey(idx, time, false)
+}
+
+// TrackInsertKeyWithDefaults will call TrackInsertKey using the default values for: transition.
+func (o *Animation) TrackInsertKeyWithDefaults(idx gdnative.Int, time gdnative.Real, key gdnative.Variant) {
+	o.TrackInsertKey(idx, time, key, 1)
+}
+
+// Length will return the value of the "length" property.
+func (o *Animation) Length() gdnative.Real {
+	return o.GetLength()
+}
+
+// Loop will return the value of the "loop" property.
+func (o *Animation) Loop() gdnative.Bool {
+	return o.HasLoop()
+}
+
+// Step will return the value of the "step" property.
+func (o *Animation) Step() gdnative.Real {
+	return o.GetStep()
+}
+
 // AnimationImplementer is an interface that implements the methods
 // of the Animation class.
 type AnimationImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	AddTrack(aType gdnative.Int, atPosition gdnative.Int) gdnative.Int
 	Clear()
 	CopyTrack(track gdnative.Int, toAnimation AnimationImplementer)
@@ -1085,11 +1252,13 @@ type AnimationImplementer interface {
 	SetStep(sizeSec gdnative.Real)
 	TrackFindKey(idx gdnative.Int, time gdnative.Real, exact gdnative.Bool) gdnative.Int
 	TrackGetInterpolationLoopWrap(idx gdnative.Int) gdnative.Bool
+	TrackGetInterpolationType(idx gdnative.Int) AnimationInterpolationType
 	TrackGetKeyCount(idx gdnative.Int) gdnative.Int
 	TrackGetKeyTime(idx gdnative.Int, keyIdx gdnative.Int) gdnative.Real
 	TrackGetKeyTransition(idx gdnative.Int, keyIdx gdnative.Int) gdnative.Real
 	TrackGetKeyValue(idx gdnative.Int, keyIdx gdnative.Int) gdnative.Variant
 	TrackGetPath(idx gdnative.Int) gdnative.NodePath
+	TrackGetType(idx gdnative.Int) AnimationTrackType
 	TrackInsertKey(idx gdnative.Int, time gdnative.Real, key gdnative.Variant, transition gdnative.Real)
 	TrackIsEnabled(idx gdnative.Int) gdnative.Bool
 	TrackIsImported(idx gdnative.Int) gdnative.Bool
@@ -1107,5 +1276,12 @@ type AnimationImplementer interface {
 	TransformTrackInsertKey(idx gdnative.Int, time gdnative.Real, location gdnative.Vector3, rotation gdnative.Quat, scale gdnative.Vector3) gdnative.Int
 	TransformTrackInterpolate(idx gdnative.Int, timeSec gdnative.Real) gdnative.Array
 	ValueTrackGetKeyIndices(idx gdnative.Int, timeSec gdnative.Real, delta gdnative.Real) gdnative.PoolIntArray
+	ValueTrackGetUpdateMode(idx gdnative.Int) AnimationUpdateMode
 	ValueTrackSetUpdateMode(idx gdnative.Int, mode gdnative.Int)
+	AddTrackWithDefaults(aType gdnative.Int) gdnative.Int
+	TrackFindKeyWithDefaults(idx gdnative.Int, time gdnative.Real) gdnative.Int
+	TrackInsertKeyWithDefaults(idx gdnative.Int, time gdnative.Real, key gdnative.Variant)
+	Length() gdnative.Real
+	Loop() gdnative.Bool
+	Step() gdnative.Real
 }
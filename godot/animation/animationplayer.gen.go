@@ -1,7 +1,10 @@
-package godot
+package animation
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,30 +24,102 @@ const (
 	AnimationPlayerAnimationProcessPhysics AnimationPlayerAnimationProcessMode = 0
 )
 
-//func NewAnimationPlayerFromPointer(ptr gdnative.Pointer) AnimationPlayer {
-func newAnimationPlayerFromPointer(ptr gdnative.Pointer) AnimationPlayer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AnimationPlayer{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the AnimationPlayerAnimationProcessMode value.
+func (e AnimationPlayerAnimationProcessMode) String() string {
+	switch e {
+	case AnimationPlayerAnimationProcessIdle:
+		return "ANIMATION_PROCESS_IDLE"
+	case AnimationPlayerAnimationProcessPhysics:
+		return "ANIMATION_PROCESS_PHYSICS"
+	}
+	return fmt.Sprintf("AnimationPlayerAnimationProcessMode(%d)", int(e))
+}
 
-	return obj
+// ParseAnimationPlayerAnimationProcessMode will return the AnimationPlayerAnimationProcessMode value with the given Godot name.
+func ParseAnimationPlayerAnimationProcessMode(name string) (AnimationPlayerAnimationProcessMode, error) {
+	switch name {
+	case "ANIMATION_PROCESS_IDLE":
+		return AnimationPlayerAnimationProcessIdle, nil
+	case "ANIMATION_PROCESS_PHYSICS":
+		return AnimationPlayerAnimationProcessPhysics, nil
+	}
+	return 0, fmt.Errorf("invalid AnimationPlayerAnimationProcessMode value %q", name)
 }
 
 /*
 An animation player is used for general purpose playback of [Animation] resources. It contains a dictionary of animations (referenced by name) and custom blend times between their transitions. Additionally, animations can be played and blended in different channels.
 */
 type AnimationPlayer struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
+// classConstructorAnimationPlayer is the class constructor of the AnimationPlayer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAnimationPlayer = gdnative.NewLazyClassConstructor("AnimationPlayer")
+
+// NewAnimationPlayer will create a new instance of the AnimationPlayer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAnimationPlayer() *AnimationPlayer {
+	obj := &AnimationPlayer{}
+	obj.SetBaseObject(classConstructorAnimationPlayer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AnimationPlayer) BaseClass() string {
 	return "AnimationPlayer"
 }
 
+// Method binds of the AnimationPlayer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAnimationPlayerX_AnimationChanged          = gdnative.NewLazyMethodBind("AnimationPlayer", "_animation_changed")
+	methodBindAnimationPlayerX_NodeRemoved               = gdnative.NewLazyMethodBind("AnimationPlayer", "_node_removed")
+	methodBindAnimationPlayerAddAnimation                = gdnative.NewLazyMethodBind("AnimationPlayer", "add_animation")
+	methodBindAnimationPlayerAdvance                     = gdnative.NewLazyMethodBind("AnimationPlayer", "advance")
+	methodBindAnimationPlayerAnimationGetNext            = gdnative.NewLazyMethodBind("AnimationPlayer", "animation_get_next")
+	methodBindAnimationPlayerAnimationSetNext            = gdnative.NewLazyMethodBind("AnimationPlayer", "animation_set_next")
+	methodBindAnimationPlayerClearCaches                 = gdnative.NewLazyMethodBind("AnimationPlayer", "clear_caches")
+	methodBindAnimationPlayerClearQueue                  = gdnative.NewLazyMethodBind("AnimationPlayer", "clear_queue")
+	methodBindAnimationPlayerFindAnimation               = gdnative.NewLazyMethodBind("AnimationPlayer", "find_animation")
+	methodBindAnimationPlayerGetAnimation                = gdnative.NewLazyMethodBind("AnimationPlayer", "get_animation")
+	methodBindAnimationPlayerGetAnimationList            = gdnative.NewLazyMethodBind("AnimationPlayer", "get_animation_list")
+	methodBindAnimationPlayerGetAnimationProcessMode     = gdnative.NewLazyMethodBind("AnimationPlayer", "get_animation_process_mode")
+	methodBindAnimationPlayerGetAssignedAnimation        = gdnative.NewLazyMethodBind("AnimationPlayer", "get_assigned_animation")
+	methodBindAnimationPlayerGetAutoplay                 = gdnative.NewLazyMethodBind("AnimationPlayer", "get_autoplay")
+	methodBindAnimationPlayerGetBlendTime                = gdnative.NewLazyMethodBind("AnimationPlayer", "get_blend_time")
+	methodBindAnimationPlayerGetCurrentAnimation         = gdnative.NewLazyMethodBind("AnimationPlayer", "get_current_animation")
+	methodBindAnimationPlayerGetCurrentAnimationLength   = gdnative.NewLazyMethodBind("AnimationPlayer", "get_current_animation_length")
+	methodBindAnimationPlayerGetCurrentAnimationPosition = gdnative.NewLazyMethodBind("AnimationPlayer", "get_current_animation_position")
+	methodBindAnimationPlayerGetDefaultBlendTime         = gdnative.NewLazyMethodBind("AnimationPlayer", "get_default_blend_time")
+	methodBindAnimationPlayerGetRoot                     = gdnative.NewLazyMethodBind("AnimationPlayer", "get_root")
+	methodBindAnimationPlayerGetSpeedScale               = gdnative.NewLazyMethodBind("AnimationPlayer", "get_speed_scale")
+	methodBindAnimationPlayerHasAnimation                = gdnative.NewLazyMethodBind("AnimationPlayer", "has_animation")
+	methodBindAnimationPlayerIsActive                    = gdnative.NewLazyMethodBind("AnimationPlayer", "is_active")
+	methodBindAnimationPlayerIsPlaying                   = gdnative.NewLazyMethodBind("AnimationPlayer", "is_playing")
+	methodBindAnimationPlayerPlay                        = gdnative.NewLazyMethodBind("AnimationPlayer", "play")
+	methodBindAnimationPlayerPlayBackwards               = gdnative.NewLazyMethodBind("AnimationPlayer", "play_backwards")
+	methodBindAnimationPlayerQueue                       = gdnative.NewLazyMethodBind("AnimationPlayer", "queue")
+	methodBindAnimationPlayerRemoveAnimation             = gdnative.NewLazyMethodBind("AnimationPlayer", "remove_animation")
+	methodBindAnimationPlayerRenameAnimation             = gdnative.NewLazyMethodBind("AnimationPlayer", "rename_animation")
+	methodBindAnimationPlayerSeek                        = gdnative.NewLazyMethodBind("AnimationPlayer", "seek")
+	methodBindAnimationPlayerSetActive                   = gdnative.NewLazyMethodBind("AnimationPlayer", "set_active")
+	methodBindAnimationPlayerSetAnimationProcessMode     = gdnative.NewLazyMethodBind("AnimationPlayer", "set_animation_process_mode")
+	methodBindAnimationPlayerSetAssignedAnimation        = gdnative.NewLazyMethodBind("AnimationPlayer", "set_assigned_animation")
+	methodBindAnimationPlayerSetAutoplay                 = gdnative.NewLazyMethodBind("AnimationPlayer", "set_autoplay")
+	methodBindAnimationPlayerSetBlendTime                = gdnative.NewLazyMethodBind("AnimationPlayer", "set_blend_time")
+	methodBindAnimationPlayerSetCurrentAnimation         = gdnative.NewLazyMethodBind("AnimationPlayer", "set_current_animation")
+	methodBindAnimationPlayerSetDefaultBlendTime         = gdnative.NewLazyMethodBind("AnimationPlayer", "set_default_blend_time")
+	methodBindAnimationPlayerSetRoot                     = gdnative.NewLazyMethodBind("AnimationPlayer", "set_root")
+	methodBindAnimationPlayerSetSpeedScale               = gdnative.NewLazyMethodBind("AnimationPlayer", "set_speed_scale")
+	methodBindAnimationPlayerStop                        = gdnative.NewLazyMethodBind("AnimationPlayer", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *AnimationPlayer) X_AnimationChanged() {
 	//log.Println("Calling AnimationPlayer.X_AnimationChanged()")
@@ -53,7 +128,7 @@ func (o *AnimationPlayer) X_AnimationChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "_animation_changed")
+	methodBind := methodBindAnimationPlayerX_AnimationChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -63,18 +138,18 @@ func (o *AnimationPlayer) X_AnimationChanged() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Object}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
 */
-func (o *AnimationPlayer) X_NodeRemoved(arg0 ObjectImplementer) {
+func (o *AnimationPlayer) X_NodeRemoved(arg0 godot.ObjectImplementer) {
 	//log.Println("Calling AnimationPlayer.X_NodeRemoved()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "_node_removed")
+	methodBind := methodBindAnimationPlayerX_NodeRemoved.Get()
 
 	// Call the parent method.
 	// void
@@ -84,8 +159,8 @@ func (o *AnimationPlayer) X_NodeRemoved(arg0 ObjectImplementer) {
 }
 
 /*
-        Adds [code]animation[/code] to the player accessible with the key [code]name[/code].
-	Args: [{ false name String} { false animation Animation}], Returns: enum.Error
+	        Adds [code]animation[/code] to the player accessible with the key [code]name[/code].
+		Args: [{ false name String} { false animation Animation}], Returns: enum.Error
 */
 func (o *AnimationPlayer) AddAnimation(name gdnative.String, animation AnimationImplementer) gdnative.Error {
 	//log.Println("Calling AnimationPlayer.AddAnimation()")
@@ -93,10 +168,10 @@ func (o *AnimationPlayer) AddAnimation(name gdnative.String, animation Animation
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
-	ptrArguments[1] = gdnative.NewPointerFromObject(animation.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(animation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "add_animation")
+	methodBind := methodBindAnimationPlayerAddAnimation.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -109,8 +184,8 @@ func (o *AnimationPlayer) AddAnimation(name gdnative.String, animation Animation
 }
 
 /*
-        Shifts position in the animation timeline. Delta is the time in seconds to shift.
-	Args: [{ false delta float}], Returns: void
+	        Shifts position in the animation timeline. Delta is the time in seconds to shift.
+		Args: [{ false delta float}], Returns: void
 */
 func (o *AnimationPlayer) Advance(delta gdnative.Real) {
 	//log.Println("Calling AnimationPlayer.Advance()")
@@ -120,7 +195,7 @@ func (o *AnimationPlayer) Advance(delta gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "advance")
+	methodBind := methodBindAnimationPlayerAdvance.Get()
 
 	// Call the parent method.
 	// void
@@ -130,8 +205,8 @@ func (o *AnimationPlayer) Advance(delta gdnative.Real) {
 }
 
 /*
-        Returns the name of the next animation in the queue.
-	Args: [{ false anim_from String}], Returns: String
+	        Returns the name of the next animation in the queue.
+		Args: [{ false anim_from String}], Returns: String
 */
 func (o *AnimationPlayer) AnimationGetNext(animFrom gdnative.String) gdnative.String {
 	//log.Println("Calling AnimationPlayer.AnimationGetNext()")
@@ -141,7 +216,7 @@ func (o *AnimationPlayer) AnimationGetNext(animFrom gdnative.String) gdnative.St
 	ptrArguments[0] = gdnative.NewPointerFromString(animFrom)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "animation_get_next")
+	methodBind := methodBindAnimationPlayerAnimationGetNext.Get()
 
 	// Call the parent method.
 	// String
@@ -154,8 +229,8 @@ func (o *AnimationPlayer) AnimationGetNext(animFrom gdnative.String) gdnative.St
 }
 
 /*
-        Triggers the [code]anim_to[/code] animation when the [code]anim_from[/code] animation completes.
-	Args: [{ false anim_from String} { false anim_to String}], Returns: void
+	        Triggers the [code]anim_to[/code] animation when the [code]anim_from[/code] animation completes.
+		Args: [{ false anim_from String} { false anim_to String}], Returns: void
 */
 func (o *AnimationPlayer) AnimationSetNext(animFrom gdnative.String, animTo gdnative.String) {
 	//log.Println("Calling AnimationPlayer.AnimationSetNext()")
@@ -166,7 +241,7 @@ func (o *AnimationPlayer) AnimationSetNext(animFrom gdnative.String, animTo gdna
 	ptrArguments[1] = gdnative.NewPointerFromString(animTo)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "animation_set_next")
+	methodBind := methodBindAnimationPlayerAnimationSetNext.Get()
 
 	// Call the parent method.
 	// void
@@ -176,8 +251,8 @@ func (o *AnimationPlayer) AnimationSetNext(animFrom gdnative.String, animTo gdna
 }
 
 /*
-        [code]AnimationPlayer[/code] caches animated nodes. It may not notice if a node disappears, so clear_caches forces it to update the cache again.
-	Args: [], Returns: void
+	        [code]AnimationPlayer[/code] caches animated nodes. It may not notice if a node disappears, so clear_caches forces it to update the cache again.
+		Args: [], Returns: void
 */
 func (o *AnimationPlayer) ClearCaches() {
 	//log.Println("Calling AnimationPlayer.ClearCaches()")
@@ -186,7 +261,7 @@ func (o *AnimationPlayer) ClearCaches() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "clear_caches")
+	methodBind := methodBindAnimationPlayerClearCaches.Get()
 
 	// Call the parent method.
 	// void
@@ -196,8 +271,8 @@ func (o *AnimationPlayer) ClearCaches() {
 }
 
 /*
-        Clears all queued, unplayed animations.
-	Args: [], Returns: void
+	        Clears all queued, unplayed animations.
+		Args: [], Returns: void
 */
 func (o *AnimationPlayer) ClearQueue() {
 	//log.Println("Calling AnimationPlayer.ClearQueue()")
@@ -206,7 +281,7 @@ func (o *AnimationPlayer) ClearQueue() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "clear_queue")
+	methodBind := methodBindAnimationPlayerClearQueue.Get()
 
 	// Call the parent method.
 	// void
@@ -216,18 +291,18 @@ func (o *AnimationPlayer) ClearQueue() {
 }
 
 /*
-        Returns the name of [code]animation[/code] or empty string if not found.
-	Args: [{ false animation Animation}], Returns: String
+	        Returns the name of [code]animation[/code] or empty string if not found.
+		Args: [{ false animation Animation}], Returns: String
 */
 func (o *AnimationPlayer) FindAnimation(animation AnimationImplementer) gdnative.String {
 	//log.Println("Calling AnimationPlayer.FindAnimation()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(animation.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(animation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "find_animation")
+	methodBind := methodBindAnimationPlayerFindAnimation.Get()
 
 	// Call the parent method.
 	// String
@@ -240,8 +315,8 @@ func (o *AnimationPlayer) FindAnimation(animation AnimationImplementer) gdnative
 }
 
 /*
-        Returns the [Animation] with key [code]name[/code] or [code]null[/code] if not found.
-	Args: [{ false name String}], Returns: Animation
+	        Returns the [Animation] with key [code]name[/code] or [code]null[/code] if not found.
+		Args: [{ false name String}], Returns: Animation
 */
 func (o *AnimationPlayer) GetAnimation(name gdnative.String) AnimationImplementer {
 	//log.Println("Calling AnimationPlayer.GetAnimation()")
@@ -251,7 +326,7 @@ func (o *AnimationPlayer) GetAnimation(name gdnative.String) AnimationImplemente
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_animation")
+	methodBind := methodBindAnimationPlayerGetAnimation.Get()
 
 	// Call the parent method.
 	// Animation
@@ -259,27 +334,25 @@ func (o *AnimationPlayer) GetAnimation(name gdnative.String) AnimationImplemente
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAnimationFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AnimationImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Animation" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AnimationImplementer)
+	ret := Animation{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(AnimationImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the list of stored animation names.
-	Args: [], Returns: PoolStringArray
+	        Returns the list of stored animation names.
+		Args: [], Returns: PoolStringArray
 */
 func (o *AnimationPlayer) GetAnimationList() gdnative.PoolStringArray {
 	//log.Println("Calling AnimationPlayer.GetAnimationList()")
@@ -288,7 +361,7 @@ func (o *AnimationPlayer) GetAnimationList() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_animation_list")
+	methodBind := methodBindAnimationPlayerGetAnimationList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -301,8 +374,8 @@ func (o *AnimationPlayer) GetAnimationList() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AnimationPlayer::AnimationProcessMode
+	        Undocumented
+		Args: [], Returns: enum.AnimationPlayer::AnimationProcessMode
 */
 func (o *AnimationPlayer) GetAnimationProcessMode() AnimationPlayerAnimationProcessMode {
 	//log.Println("Calling AnimationPlayer.GetAnimationProcessMode()")
@@ -311,7 +384,7 @@ func (o *AnimationPlayer) GetAnimationProcessMode() AnimationPlayerAnimationProc
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_animation_process_mode")
+	methodBind := methodBindAnimationPlayerGetAnimationProcessMode.Get()
 
 	// Call the parent method.
 	// enum.AnimationPlayer::AnimationProcessMode
@@ -324,8 +397,8 @@ func (o *AnimationPlayer) GetAnimationProcessMode() AnimationPlayerAnimationProc
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AnimationPlayer) GetAssignedAnimation() gdnative.String {
 	//log.Println("Calling AnimationPlayer.GetAssignedAnimation()")
@@ -334,7 +407,7 @@ func (o *AnimationPlayer) GetAssignedAnimation() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_assigned_animation")
+	methodBind := methodBindAnimationPlayerGetAssignedAnimation.Get()
 
 	// Call the parent method.
 	// String
@@ -347,8 +420,8 @@ func (o *AnimationPlayer) GetAssignedAnimation() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AnimationPlayer) GetAutoplay() gdnative.String {
 	//log.Println("Calling AnimationPlayer.GetAutoplay()")
@@ -357,7 +430,7 @@ func (o *AnimationPlayer) GetAutoplay() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_autoplay")
+	methodBind := methodBindAnimationPlayerGetAutoplay.Get()
 
 	// Call the parent method.
 	// String
@@ -370,8 +443,8 @@ func (o *AnimationPlayer) GetAutoplay() gdnative.String {
 }
 
 /*
-        Get the blend time (in seconds) between two animations, referenced by their names.
-	Args: [{ false anim_from String} { false anim_to String}], Returns: float
+	        Get the blend time (in seconds) between two animations, referenced by their names.
+		Args: [{ false anim_from String} { false anim_to String}], Returns: float
 */
 func (o *AnimationPlayer) GetBlendTime(animFrom gdnative.String, animTo gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationPlayer.GetBlendTime()")
@@ -382,7 +455,7 @@ func (o *AnimationPlayer) GetBlendTime(animFrom gdnative.String, animTo gdnative
 	ptrArguments[1] = gdnative.NewPointerFromString(animTo)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_blend_time")
+	methodBind := methodBindAnimationPlayerGetBlendTime.Get()
 
 	// Call the parent method.
 	// float
@@ -395,8 +468,8 @@ func (o *AnimationPlayer) GetBlendTime(animFrom gdnative.String, animTo gdnative
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AnimationPlayer) GetCurrentAnimation() gdnative.String {
 	//log.Println("Calling AnimationPlayer.GetCurrentAnimation()")
@@ -405,7 +478,7 @@ func (o *AnimationPlayer) GetCurrentAnimation() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_current_animation")
+	methodBind := methodBindAnimationPlayerGetCurrentAnimation.Get()
 
 	// Call the parent method.
 	// String
@@ -418,8 +491,8 @@ func (o *AnimationPlayer) GetCurrentAnimation() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AnimationPlayer) GetCurrentAnimationLength() gdnative.Real {
 	//log.Println("Calling AnimationPlayer.GetCurrentAnimationLength()")
@@ -428,7 +501,7 @@ func (o *AnimationPlayer) GetCurrentAnimationLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_current_animation_length")
+	methodBind := methodBindAnimationPlayerGetCurrentAnimationLength.Get()
 
 	// Call the parent method.
 	// float
@@ -441,8 +514,8 @@ func (o *AnimationPlayer) GetCurrentAnimationLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AnimationPlayer) GetCurrentAnimationPosition() gdnative.Real {
 	//log.Println("Calling AnimationPlayer.GetCurrentAnimationPosition()")
@@ -451,7 +524,7 @@ func (o *AnimationPlayer) GetCurrentAnimationPosition() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_current_animation_position")
+	methodBind := methodBindAnimationPlayerGetCurrentAnimationPosition.Get()
 
 	// Call the parent method.
 	// float
@@ -464,8 +537,8 @@ func (o *AnimationPlayer) GetCurrentAnimationPosition() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AnimationPlayer) GetDefaultBlendTime() gdnative.Real {
 	//log.Println("Calling AnimationPlayer.GetDefaultBlendTime()")
@@ -474,7 +547,7 @@ func (o *AnimationPlayer) GetDefaultBlendTime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_default_blend_time")
+	methodBind := methodBindAnimationPlayerGetDefaultBlendTime.Get()
 
 	// Call the parent method.
 	// float
@@ -487,8 +560,8 @@ func (o *AnimationPlayer) GetDefaultBlendTime() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *AnimationPlayer) GetRoot() gdnative.NodePath {
 	//log.Println("Calling AnimationPlayer.GetRoot()")
@@ -497,7 +570,7 @@ func (o *AnimationPlayer) GetRoot() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_root")
+	methodBind := methodBindAnimationPlayerGetRoot.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -510,8 +583,8 @@ func (o *AnimationPlayer) GetRoot() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AnimationPlayer) GetSpeedScale() gdnative.Real {
 	//log.Println("Calling AnimationPlayer.GetSpeedScale()")
@@ -520,7 +593,7 @@ func (o *AnimationPlayer) GetSpeedScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "get_speed_scale")
+	methodBind := methodBindAnimationPlayerGetSpeedScale.Get()
 
 	// Call the parent method.
 	// float
@@ -533,8 +606,8 @@ func (o *AnimationPlayer) GetSpeedScale() gdnative.Real {
 }
 
 /*
-        Returns [code]true[/code] if the [code]AnimationPlayer[/code] stores an [Animation] with key [code]name[/code].
-	Args: [{ false name String}], Returns: bool
+	        Returns [code]true[/code] if the [code]AnimationPlayer[/code] stores an [Animation] with key [code]name[/code].
+		Args: [{ false name String}], Returns: bool
 */
 func (o *AnimationPlayer) HasAnimation(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling AnimationPlayer.HasAnimation()")
@@ -544,7 +617,7 @@ func (o *AnimationPlayer) HasAnimation(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "has_animation")
+	methodBind := methodBindAnimationPlayerHasAnimation.Get()
 
 	// Call the parent method.
 	// bool
@@ -557,8 +630,8 @@ func (o *AnimationPlayer) HasAnimation(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AnimationPlayer) IsActive() gdnative.Bool {
 	//log.Println("Calling AnimationPlayer.IsActive()")
@@ -567,7 +640,7 @@ func (o *AnimationPlayer) IsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "is_active")
+	methodBind := methodBindAnimationPlayerIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -580,8 +653,8 @@ func (o *AnimationPlayer) IsActive() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if playing an animation.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if playing an animation.
+		Args: [], Returns: bool
 */
 func (o *AnimationPlayer) IsPlaying() gdnative.Bool {
 	//log.Println("Calling AnimationPlayer.IsPlaying()")
@@ -590,7 +663,7 @@ func (o *AnimationPlayer) IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "is_playing")
+	methodBind := methodBindAnimationPlayerIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -603,8 +676,8 @@ func (o *AnimationPlayer) IsPlaying() gdnative.Bool {
 }
 
 /*
-        Play the animation with key [code]name[/code]. Custom speed and blend times can be set. If custom speed is negative (-1), 'from_end' being true can play the animation backwards.
-	Args: [{ true name String} {-1 true custom_blend float} {1 true custom_speed float} {False true from_end bool}], Returns: void
+	        Play the animation with key [code]name[/code]. Custom speed and blend times can be set. If custom speed is negative (-1), 'from_end' being true can play the animation backwards.
+		Args: [{ true name String} {-1 true custom_blend float} {1 true custom_speed float} {False true from_end bool}], Returns: void
 */
 func (o *AnimationPlayer) Play(name gdnative.String, customBlend gdnative.Real, customSpeed gdnative.Real, fromEnd gdnative.Bool) {
 	//log.Println("Calling AnimationPlayer.Play()")
@@ -617,7 +690,7 @@ func (o *AnimationPlayer) Play(name gdnative.String, customBlend gdnative.Real,
 	ptrArguments[3] = gdnative.NewPointerFromBool(fromEnd)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "play")
+	methodBind := methodBindAnimationPlayerPlay.Get()
 
 	// Call the parent method.
 	// void
@@ -627,8 +700,8 @@ func (o *AnimationPlayer) Play(name gdnative.String, customBlend gdnative.Real,
 }
 
 /*
-        Play the animation with key [code]name[/code] in reverse.
-	Args: [{ true name String} {-1 true custom_blend float}], Returns: void
+	        Play the animation with key [code]name[/code] in reverse.
+		Args: [{ true name String} {-1 true custom_blend float}], Returns: void
 */
 func (o *AnimationPlayer) PlayBackwards(name gdnative.String, customBlend gdnative.Real) {
 	//log.Println("Calling AnimationPlayer.PlayBackwards()")
@@ -639,7 +712,7 @@ func (o *AnimationPlayer) PlayBackwards(name gdnative.String, customBlend gdnati
 	ptrArguments[1] = gdnative.NewPointerFromReal(customBlend)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "play_backwards")
+	methodBind := methodBindAnimationPlayerPlayBackwards.Get()
 
 	// Call the parent method.
 	// void
@@ -649,8 +722,8 @@ func (o *AnimationPlayer) PlayBackwards(name gdnative.String, customBlend gdnati
 }
 
 /*
-        Queue an animation for playback once the current one is done.
-	Args: [{ false name String}], Returns: void
+	        Queue an animation for playback once the current one is done.
+		Args: [{ false name String}], Returns: void
 */
 func (o *AnimationPlayer) Queue(name gdnative.String) {
 	//log.Println("Calling AnimationPlayer.Queue()")
@@ -660,7 +733,7 @@ func (o *AnimationPlayer) Queue(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "queue")
+	methodBind := methodBindAnimationPlayerQueue.Get()
 
 	// Call the parent method.
 	// void
@@ -670,8 +743,8 @@ func (o *AnimationPlayer) Queue(name gdnative.String) {
 }
 
 /*
-        Remove the animation with key [code]name[/code].
-	Args: [{ false name String}], Returns: void
+	        Remove the animation with key [code]name[/code].
+		Args: [{ false name String}], Returns: void
 */
 func (o *AnimationPlayer) RemoveAnimation(name gdnative.String) {
 	//log.Println("Calling AnimationPlayer.RemoveAnimation()")
@@ -681,7 +754,7 @@ func (o *AnimationPlayer) RemoveAnimation(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "remove_animation")
+	methodBind := methodBindAnimationPlayerRemoveAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -691,8 +764,8 @@ func (o *AnimationPlayer) RemoveAnimation(name gdnative.String) {
 }
 
 /*
-        Rename an existing animation with key [code]name[/code] to [code]newname[/code].
-	Args: [{ false name String} { false newname String}], Returns: void
+	        Rename an existing animation with key [code]name[/code] to [code]newname[/code].
+		Args: [{ false name String} { false newname String}], Returns: void
 */
 func (o *AnimationPlayer) RenameAnimation(name gdnative.String, newname gdnative.String) {
 	//log.Println("Calling AnimationPlayer.RenameAnimation()")
@@ -703,7 +776,7 @@ func (o *AnimationPlayer) RenameAnimation(name gdnative.String, newname gdnative
 	ptrArguments[1] = gdnative.NewPointerFromString(newname)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "rename_animation")
+	methodBind := methodBindAnimationPlayerRenameAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -713,8 +786,8 @@ func (o *AnimationPlayer) RenameAnimation(name gdnative.String, newname gdnative
 }
 
 /*
-        Seek the animation to the [code]seconds[/code] point in time (in seconds). If [code]update[/code] is [code]true[/code], the animation updates too, otherwise it updates at process time.
-	Args: [{ false seconds float} {False true update bool}], Returns: void
+	        Seek the animation to the [code]seconds[/code] point in time (in seconds). If [code]update[/code] is [code]true[/code], the animation updates too, otherwise it updates at process time.
+		Args: [{ false seconds float} {False true update bool}], Returns: void
 */
 func (o *AnimationPlayer) Seek(seconds gdnative.Real, update gdnative.Bool) {
 	//log.Println("Calling AnimationPlayer.Seek()")
@@ -725,7 +798,7 @@ func (o *AnimationPlayer) Seek(seconds gdnative.Real, update gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(update)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "seek")
+	methodBind := methodBindAnimationPlayerSeek.Get()
 
 	// Call the parent method.
 	// void
@@ -735,8 +808,8 @@ func (o *AnimationPlayer) Seek(seconds gdnative.Real, update gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false active bool}], Returns: void
+	        Undocumented
+		Args: [{ false active bool}], Returns: void
 */
 func (o *AnimationPlayer) SetActive(active gdnative.Bool) {
 	//log.Println("Calling AnimationPlayer.SetActive()")
@@ -746,7 +819,7 @@ func (o *AnimationPlayer) SetActive(active gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(active)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_active")
+	methodBind := methodBindAnimationPlayerSetActive.Get()
 
 	// Call the parent method.
 	// void
@@ -756,18 +829,18 @@ func (o *AnimationPlayer) SetActive(active gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.AnimationPlayer::AnimationProcessMode}], Returns: void
 */
-func (o *AnimationPlayer) SetAnimationProcessMode(mode gdnative.Int) {
+func (o *AnimationPlayer) SetAnimationProcessMode(mode AnimationPlayerAnimationProcessMode) {
 	//log.Println("Calling AnimationPlayer.SetAnimationProcessMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_animation_process_mode")
+	methodBind := methodBindAnimationPlayerSetAnimationProcessMode.Get()
 
 	// Call the parent method.
 	// void
@@ -777,8 +850,8 @@ func (o *AnimationPlayer) SetAnimationProcessMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false anim String}], Returns: void
+	        Undocumented
+		Args: [{ false anim String}], Returns: void
 */
 func (o *AnimationPlayer) SetAssignedAnimation(anim gdnative.String) {
 	//log.Println("Calling AnimationPlayer.SetAssignedAnimation()")
@@ -788,7 +861,7 @@ func (o *AnimationPlayer) SetAssignedAnimation(anim gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_assigned_animation")
+	methodBind := methodBindAnimationPlayerSetAssignedAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -798,8 +871,8 @@ func (o *AnimationPlayer) SetAssignedAnimation(anim gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *AnimationPlayer) SetAutoplay(name gdnative.String) {
 	//log.Println("Calling AnimationPlayer.SetAutoplay()")
@@ -809,7 +882,7 @@ func (o *AnimationPlayer) SetAutoplay(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_autoplay")
+	methodBind := methodBindAnimationPlayerSetAutoplay.Get()
 
 	// Call the parent method.
 	// void
@@ -819,8 +892,8 @@ func (o *AnimationPlayer) SetAutoplay(name gdnative.String) {
 }
 
 /*
-        Specify a blend time (in seconds) between two animations, referenced by their names.
-	Args: [{ false anim_from String} { false anim_to String} { false sec float}], Returns: void
+	        Specify a blend time (in seconds) between two animations, referenced by their names.
+		Args: [{ false anim_from String} { false anim_to String} { false sec float}], Returns: void
 */
 func (o *AnimationPlayer) SetBlendTime(animFrom gdnative.String, animTo gdnative.String, sec gdnative.Real) {
 	//log.Println("Calling AnimationPlayer.SetBlendTime()")
@@ -832,7 +905,7 @@ func (o *AnimationPlayer) SetBlendTime(animFrom gdnative.String, animTo gdnative
 	ptrArguments[2] = gdnative.NewPointerFromReal(sec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_blend_time")
+	methodBind := methodBindAnimationPlayerSetBlendTime.Get()
 
 	// Call the parent method.
 	// void
@@ -842,8 +915,8 @@ func (o *AnimationPlayer) SetBlendTime(animFrom gdnative.String, animTo gdnative
 }
 
 /*
-        Undocumented
-	Args: [{ false anim String}], Returns: void
+	        Undocumented
+		Args: [{ false anim String}], Returns: void
 */
 func (o *AnimationPlayer) SetCurrentAnimation(anim gdnative.String) {
 	//log.Println("Calling AnimationPlayer.SetCurrentAnimation()")
@@ -853,7 +926,7 @@ func (o *AnimationPlayer) SetCurrentAnimation(anim gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_current_animation")
+	methodBind := methodBindAnimationPlayerSetCurrentAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -863,8 +936,8 @@ func (o *AnimationPlayer) SetCurrentAnimation(anim gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false sec float}], Returns: void
+	        Undocumented
+		Args: [{ false sec float}], Returns: void
 */
 func (o *AnimationPlayer) SetDefaultBlendTime(sec gdnative.Real) {
 	//log.Println("Calling AnimationPlayer.SetDefaultBlendTime()")
@@ -874,7 +947,7 @@ func (o *AnimationPlayer) SetDefaultBlendTime(sec gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(sec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_default_blend_time")
+	methodBind := methodBindAnimationPlayerSetDefaultBlendTime.Get()
 
 	// Call the parent method.
 	// void
@@ -884,8 +957,8 @@ func (o *AnimationPlayer) SetDefaultBlendTime(sec gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false path NodePath}], Returns: void
 */
 func (o *AnimationPlayer) SetRoot(path gdnative.NodePath) {
 	//log.Println("Calling AnimationPlayer.SetRoot()")
@@ -895,7 +968,7 @@ func (o *AnimationPlayer) SetRoot(path gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_root")
+	methodBind := methodBindAnimationPlayerSetRoot.Get()
 
 	// Call the parent method.
 	// void
@@ -905,8 +978,8 @@ func (o *AnimationPlayer) SetRoot(path gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false speed float}], Returns: void
+	        Undocumented
+		Args: [{ false speed float}], Returns: void
 */
 func (o *AnimationPlayer) SetSpeedScale(speed gdnative.Real) {
 	//log.Println("Calling AnimationPlayer.SetSpeedScale()")
@@ -916,7 +989,7 @@ func (o *AnimationPlayer) SetSpeedScale(speed gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(speed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "set_speed_scale")
+	methodBind := methodBindAnimationPlayerSetSpeedScale.Get()
 
 	// Call the parent method.
 	// void
@@ -926,8 +999,8 @@ func (o *AnimationPlayer) SetSpeedScale(speed gdnative.Real) {
 }
 
 /*
-        Stop the currently playing animation. If [code]reset[/code] is [code]true[/code], the anim position is reset to [code]0[/code].
-	Args: [{True true reset bool}], Returns: void
+	        Stop the currently playing animation. If [code]reset[/code] is [code]true[/code], the anim position is reset to [code]0[/code].
+		Args: [{True true reset bool}], Returns: void
 */
 func (o *AnimationPlayer) Stop(reset gdnative.Bool) {
 	//log.Println("Calling AnimationPlayer.Stop()")
@@ -937,7 +1010,7 @@ func (o *AnimationPlayer) Stop(reset gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(reset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationPlayer", "stop")
+	methodBind := methodBindAnimationPlayerStop.Get()
 
 	// Call the parent method.
 	// void
@@ -946,12 +1019,187 @@ func (o *AnimationPlayer) Stop(reset gdnative.Bool) {
 
 }
 
+// PlayWithDefaults will call Play using the default values for: name, custom_blend, custom_speed, from_end.
+func (o *AnimationPlayer) PlayWithDefaults() {
+	o.Play("", -1, 1, false)
+}
+
+// PlayBackwardsWithDefaults will call PlayBackwards using the default values for: name, custom_blend.
+func (o *AnimationPlayer) PlayBackwardsWithDefaults() {
+	o.PlayBackwards("", -1)
+}
+
+// SeekWithDefaults will call Seek using the default values for: update.
+func (o *AnimationPlayer) SeekWithDefaults(seconds gdnative.Real) {
+	o.Seek(seconds, false)
+}
+
+// StopWithDefaults will call Stop using the default values for: reset.
+func (o *AnimationPlayer) StopWithDefaults() {
+	o.Stop(true)
+}
+
+// AssignedAnimation will return the value of the "assigned_animation" property.
+func (o *AnimationPlayer) AssignedAnimation() gdnative.String {
+	return o.GetAssignedAnimation()
+}
+
+// Autoplay will return the value of the "autoplay" property.
+func (o *AnimationPlayer) Autoplay() gdnative.String {
+	return o.GetAutoplay()
+}
+
+// CurrentAnimation will return the value of the "current_animation" property.
+func (o *AnimationPlayer) CurrentAnimation() gdnative.String {
+	return o.GetCurrentAnimation()
+}
+
+// CurrentAnimationLength will return the value of the "current_animation_length" property.
+func (o *AnimationPlayer) CurrentAnimationLength() gdnative.Real {
+	return o.GetCurrentAnimationLength()
+}
+
+// CurrentAnimationPosition will return the value of the "current_animation_position" property.
+func (o *AnimationPlayer) CurrentAnimationPosition() gdnative.Real {
+	return o.GetCurrentAnimationPosition()
+}
+
+// PlaybackActive will return the value of the "playback_active" property.
+func (o *AnimationPlayer) PlaybackActive() gdnative.Bool {
+	return o.IsActive()
+}
+
+// SetPlaybackActive will set the value of the "playback_active" property.
+func (o *AnimationPlayer) SetPlaybackActive(value gdnative.Bool) {
+	o.SetActive(value)
+}
+
+// PlaybackDefaultBlendTime will return the value of the "playback_default_blend_time" property.
+func (o *AnimationPlayer) PlaybackDefaultBlendTime() gdnative.Real {
+	return o.GetDefaultBlendTime()
+}
+
+// SetPlaybackDefaultBlendTime will set the value of the "playback_default_blend_time" property.
+func (o *AnimationPlayer) SetPlaybackDefaultBlendTime(value gdnative.Real) {
+	o.SetDefaultBlendTime(value)
+}
+
+// PlaybackProcessMode will return the value of the "playback_process_mode" property.
+func (o *AnimationPlayer) PlaybackProcessMode() AnimationPlayerAnimationProcessMode {
+	return o.GetAnimationProcessMode()
+}
+
+// SetPlaybackProcessMode will set the value of the "playback_process_mode" property.
+func (o *AnimationPlayer) SetPlaybackProcessMode(value AnimationPlayerAnimationProcessMode) {
+	o.SetAnimationProcessMode(value)
+}
+
+// PlaybackSpeed will return the value of the "playback_speed" property.
+func (o *AnimationPlayer) PlaybackSpeed() gdnative.Real {
+	return o.GetSpeedScale()
+}
+
+// SetPlaybackSpeed will set the value of the "playback_speed" property.
+func (o *AnimationPlayer) SetPlaybackSpeed(value gdnative.Real) {
+	o.SetSpeedScale(value)
+}
+
+// RootNode will return the value of the "root_node" property.
+func (o *AnimationPlayer) RootNode() gdnative.NodePath {
+	return o.GetRoot()
+}
+
+// SetRootNode will set the value of the "root_node" property.
+func (o *AnimationPlayer) SetRootNode(value gdnative.NodePath) {
+	o.SetRoot(value)
+}
+
+// Signals of the AnimationPlayer class.
+const (
+	AnimationPlayerSignalAnimationChanged  gdnative.String = "animation_changed"
+	AnimationPlayerSignalAnimationFinished gdnative.String = "animation_finished"
+	AnimationPlayerSignalAnimationStarted  gdnative.String = "animation_started"
+)
+
+// AnimationPlayerAnimationChangedArgs holds the arguments of the "animation_changed" signal.
+type AnimationPlayerAnimationChangedArgs struct {
+	OldName gdnative.String
+	NewName gdnative.String
+}
+
+// ConnectAnimationChanged will connect the "animation_changed" signal to the given method of the target object.
+func (o *AnimationPlayer) ConnectAnimationChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AnimationPlayerSignalAnimationChanged, target, method, binds, 0)
+}
+
+// EmitAnimationChanged will emit the "animation_changed" signal.
+func (o *AnimationPlayer) EmitAnimationChanged(args AnimationPlayerAnimationChangedArgs) error {
+	arg0 := gdnative.NewVariantString(args.OldName)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantString(args.NewName)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(AnimationPlayerSignalAnimationChanged, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// AnimationPlayerAnimationFinishedArgs holds the arguments of the "animation_finished" signal.
+type AnimationPlayerAnimationFinishedArgs struct {
+	AnimName gdnative.String
+}
+
+// ConnectAnimationFinished will connect the "animation_finished" signal to the given method of the target object.
+func (o *AnimationPlayer) ConnectAnimationFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AnimationPlayerSignalAnimationFinished, target, method, binds, 0)
+}
+
+// EmitAnimationFinished will emit the "animation_finished" signal.
+func (o *AnimationPlayer) EmitAnimationFinished(args AnimationPlayerAnimationFinishedArgs) error {
+	arg0 := gdnative.NewVariantString(args.AnimName)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(AnimationPlayerSignalAnimationFinished, arg0)
+	ret.Destroy()
+	return err
+}
+
+// AnimationPlayerAnimationStartedArgs holds the arguments of the "animation_started" signal.
+type AnimationPlayerAnimationStartedArgs struct {
+	AnimName gdnative.String
+}
+
+// ConnectAnimationStarted will connect the "animation_started" signal to the given method of the target object.
+func (o *AnimationPlayer) ConnectAnimationStarted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AnimationPlayerSignalAnimationStarted, target, method, binds, 0)
+}
+
+// EmitAnimationStarted will emit the "animation_started" signal.
+func (o *AnimationPlayer) EmitAnimationStarted(args AnimationPlayerAnimationStartedArgs) error {
+	arg0 := gdnative.NewVariantString(args.AnimName)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(AnimationPlayerSignalAnimationStarted, arg0)
+	ret.Destroy()
+	return err
+}
+
 // AnimationPlayerImplementer is an interface that implements the methods
 // of the AnimationPlayer class.
 type AnimationPlayerImplementer interface {
-	NodeImplementer
+	godot.NodeImplementer
 	X_AnimationChanged()
-	X_NodeRemoved(arg0 ObjectImplementer)
+	X_NodeRemoved(arg0 godot.ObjectImplementer)
+	AddAnimation(name gdnative.String, animation AnimationImplementer) gdnative.Error
 	Advance(delta gdnative.Real)
 	AnimationGetNext(animFrom gdnative.String) gdnative.String
 	AnimationSetNext(animFrom gdnative.String, animTo gdnative.String)
@@ -960,6 +1208,7 @@ type AnimationPlayerImplementer interface {
 	FindAnimation(animation AnimationImplementer) gdnative.String
 	GetAnimation(name gdnative.String) AnimationImplementer
 	GetAnimationList() gdnative.PoolStringArray
+	GetAnimationProcessMode() AnimationPlayerAnimationProcessMode
 	GetAssignedAnimation() gdnative.String
 	GetAutoplay() gdnative.String
 	GetBlendTime(animFrom gdnative.String, animTo gdnative.String) gdnative.Real
@@ -979,7 +1228,7 @@ type AnimationPlayerImplementer interface {
 	RenameAnimation(name gdnative.String, newname gdnative.String)
 	Seek(seconds gdnative.Real, update gdnative.Bool)
 	SetActive(active gdnative.Bool)
-	SetAnimationProcessMode(mode gdnative.Int)
+	SetAnimationProcessMode(mode AnimationPlayerAnimationProcessMode)
 	SetAssignedAnimation(anim gdnative.String)
 	SetAutoplay(name gdnative.String)
 	SetBlendTime(animFrom gdnative.String, animTo gdnative.String, sec gdnative.Real)
@@ -988,4 +1237,29 @@ type AnimationPlayerImplementer interface {
 	SetRoot(path gdnative.NodePath)
 	SetSpeedScale(speed gdnative.Real)
 	Stop(reset gdnative.Bool)
+	PlayWithDefaults()
+	PlayBackwardsWithDefaults()
+	SeekWithDefaults(seconds gdnative.Real)
+	StopWithDefaults()
+	AssignedAnimation() gdnative.String
+	Autoplay() gdnative.String
+	CurrentAnimation() gdnative.String
+	CurrentAnimationLength() gdnative.Real
+	CurrentAnimationPosition() gdnative.Real
+	PlaybackActive() gdnative.Bool
+	SetPlaybackActive(value gdnative.Bool)
+	PlaybackDefaultBlendTime() gdnative.Real
+	SetPlaybackDefaultBlendTime(value gdnative.Real)
+	PlaybackProcessMode() AnimationPlayerAnimationProcessMode
+	SetPlaybackProcessMode(value AnimationPlayerAnimationProcessMode)
+	PlaybackSpeed() gdnative.Real
+	SetPlaybackSpeed(value gdnative.Real)
+	RootNode() gdnative.NodePath
+	SetRootNode(value gdnative.NodePath)
+	ConnectAnimationChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAnimationChanged(args AnimationPlayerAnimationChangedArgs) error
+	ConnectAnimationFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAnimationFinished(args AnimationPlayerAnimationFinishedArgs) error
+	ConnectAnimationStarted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAnimationStarted(args AnimationPlayerAnimationStartedArgs) error
 }
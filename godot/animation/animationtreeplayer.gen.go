@@ -1,7 +1,10 @@
-package godot
+package animation
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,6 +24,28 @@ const (
 	AnimationTreePlayerAnimationProcessPhysics AnimationTreePlayerAnimationProcessMode = 0
 )
 
+// String will return the Godot name of the AnimationTreePlayerAnimationProcessMode value.
+func (e AnimationTreePlayerAnimationProcessMode) String() string {
+	switch e {
+	case AnimationTreePlayerAnimationProcessIdle:
+		return "ANIMATION_PROCESS_IDLE"
+	case AnimationTreePlayerAnimationProcessPhysics:
+		return "ANIMATION_PROCESS_PHYSICS"
+	}
+	return fmt.Sprintf("AnimationTreePlayerAnimationProcessMode(%d)", int(e))
+}
+
+// ParseAnimationTreePlayerAnimationProcessMode will return the AnimationTreePlayerAnimationProcessMode value with the given Godot name.
+func ParseAnimationTreePlayerAnimationProcessMode(name string) (AnimationTreePlayerAnimationProcessMode, error) {
+	switch name {
+	case "ANIMATION_PROCESS_IDLE":
+		return AnimationTreePlayerAnimationProcessIdle, nil
+	case "ANIMATION_PROCESS_PHYSICS":
+		return AnimationTreePlayerAnimationProcessPhysics, nil
+	}
+	return 0, fmt.Errorf("invalid AnimationTreePlayerAnimationProcessMode value %q", name)
+}
+
 // AnimationTreePlayerNodeType is an enum for NodeType values.
 type AnimationTreePlayerNodeType int
 
@@ -37,30 +62,158 @@ const (
 	AnimationTreePlayerNodeTransition AnimationTreePlayerNodeType = 9
 )
 
-//func NewAnimationTreePlayerFromPointer(ptr gdnative.Pointer) AnimationTreePlayer {
-func newAnimationTreePlayerFromPointer(ptr gdnative.Pointer) AnimationTreePlayer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AnimationTreePlayer{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the AnimationTreePlayerNodeType value.
+func (e AnimationTreePlayerNodeType) String() string {
+	switch e {
+	case AnimationTreePlayerNodeAnimation:
+		return "NODE_ANIMATION"
+	case AnimationTreePlayerNodeBlend2:
+		return "NODE_BLEND2"
+	case AnimationTreePlayerNodeBlend3:
+		return "NODE_BLEND3"
+	case AnimationTreePlayerNodeBlend4:
+		return "NODE_BLEND4"
+	case AnimationTreePlayerNodeMix:
+		return "NODE_MIX"
+	case AnimationTreePlayerNodeOneshot:
+		return "NODE_ONESHOT"
+	case AnimationTreePlayerNodeOutput:
+		return "NODE_OUTPUT"
+	case AnimationTreePlayerNodeTimescale:
+		return "NODE_TIMESCALE"
+	case AnimationTreePlayerNodeTimeseek:
+		return "NODE_TIMESEEK"
+	case AnimationTreePlayerNodeTransition:
+		return "NODE_TRANSITION"
+	}
+	return fmt.Sprintf("AnimationTreePlayerNodeType(%d)", int(e))
+}
+
+// ParseAnimationTreePlayerNodeType will return the AnimationTreePlayerNodeType value with the given Godot name.
+func ParseAnimationTreePlayerNodeType(name string) (AnimationTreePlayerNodeType, error) {
+	switch name {
+	case "NODE_ANIMATION":
+		return AnimationTreePlayerNodeAnimation, nil
+	case "NODE_BLEND2":
+		return AnimationTreePlayerNodeBlend2, nil
+	case "NODE_BLEND3":
+		return AnimationTreePlayerNodeBlend3, nil
+	case "NODE_BLEND4":
+		return AnimationTreePlayerNodeBlend4, nil
+	case "NODE_MIX":
+		return AnimationTreePlayerNodeMix, nil
+	case "NODE_ONESHOT":
+		return AnimationTreePlayerNodeOneshot, nil
+	case "NODE_OUTPUT":
+		return AnimationTreePlayerNodeOutput, nil
+	case "NODE_TIMESCALE":
+		return AnimationTreePlayerNodeTimescale, nil
+	case "NODE_TIMESEEK":
+		return AnimationTreePlayerNodeTimeseek, nil
+	case "NODE_TRANSITION":
+		return AnimationTreePlayerNodeTransition, nil
+	}
+	return 0, fmt.Errorf("invalid AnimationTreePlayerNodeType value %q", name)
 }
 
 /*
 A node graph tool for blending multiple animations bound to an [AnimationPlayer]. Especially useful for animating characters or other skeleton-based rigs. It can combine several animations to form a desired pose. It takes [Animation]s from an [AnimationPlayer] node and mixes them depending on the graph.
 */
 type AnimationTreePlayer struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
+// classConstructorAnimationTreePlayer is the class constructor of the AnimationTreePlayer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAnimationTreePlayer = gdnative.NewLazyClassConstructor("AnimationTreePlayer")
+
+// NewAnimationTreePlayer will create a new instance of the AnimationTreePlayer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAnimationTreePlayer() *AnimationTreePlayer {
+	obj := &AnimationTreePlayer{}
+	obj.SetBaseObject(classConstructorAnimationTreePlayer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AnimationTreePlayer) BaseClass() string {
 	return "AnimationTreePlayer"
 }
 
+// Method binds of the AnimationTreePlayer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAnimationTreePlayerAddNode                              = gdnative.NewLazyMethodBind("AnimationTreePlayer", "add_node")
+	methodBindAnimationTreePlayerAdvance                              = gdnative.NewLazyMethodBind("AnimationTreePlayer", "advance")
+	methodBindAnimationTreePlayerAnimationNodeGetAnimation            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "animation_node_get_animation")
+	methodBindAnimationTreePlayerAnimationNodeGetMasterAnimation      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "animation_node_get_master_animation")
+	methodBindAnimationTreePlayerAnimationNodeSetAnimation            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "animation_node_set_animation")
+	methodBindAnimationTreePlayerAnimationNodeSetFilterPath           = gdnative.NewLazyMethodBind("AnimationTreePlayer", "animation_node_set_filter_path")
+	methodBindAnimationTreePlayerAnimationNodeSetMasterAnimation      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "animation_node_set_master_animation")
+	methodBindAnimationTreePlayerAreNodesConnected                    = gdnative.NewLazyMethodBind("AnimationTreePlayer", "are_nodes_connected")
+	methodBindAnimationTreePlayerBlend2NodeGetAmount                  = gdnative.NewLazyMethodBind("AnimationTreePlayer", "blend2_node_get_amount")
+	methodBindAnimationTreePlayerBlend2NodeSetAmount                  = gdnative.NewLazyMethodBind("AnimationTreePlayer", "blend2_node_set_amount")
+	methodBindAnimationTreePlayerBlend2NodeSetFilterPath              = gdnative.NewLazyMethodBind("AnimationTreePlayer", "blend2_node_set_filter_path")
+	methodBindAnimationTreePlayerBlend3NodeGetAmount                  = gdnative.NewLazyMethodBind("AnimationTreePlayer", "blend3_node_get_amount")
+	methodBindAnimationTreePlayerBlend3NodeSetAmount                  = gdnative.NewLazyMethodBind("AnimationTreePlayer", "blend3_node_set_amount")
+	methodBindAnimationTreePlayerBlend4NodeGetAmount                  = gdnative.NewLazyMethodBind("AnimationTreePlayer", "blend4_node_get_amount")
+	methodBindAnimationTreePlayerBlend4NodeSetAmount                  = gdnative.NewLazyMethodBind("AnimationTreePlayer", "blend4_node_set_amount")
+	methodBindAnimationTreePlayerConnectNodes                         = gdnative.NewLazyMethodBind("AnimationTreePlayer", "connect_nodes")
+	methodBindAnimationTreePlayerDisconnectNodes                      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "disconnect_nodes")
+	methodBindAnimationTreePlayerGetAnimationProcessMode              = gdnative.NewLazyMethodBind("AnimationTreePlayer", "get_animation_process_mode")
+	methodBindAnimationTreePlayerGetBasePath                          = gdnative.NewLazyMethodBind("AnimationTreePlayer", "get_base_path")
+	methodBindAnimationTreePlayerGetMasterPlayer                      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "get_master_player")
+	methodBindAnimationTreePlayerGetNodeList                          = gdnative.NewLazyMethodBind("AnimationTreePlayer", "get_node_list")
+	methodBindAnimationTreePlayerIsActive                             = gdnative.NewLazyMethodBind("AnimationTreePlayer", "is_active")
+	methodBindAnimationTreePlayerMixNodeGetAmount                     = gdnative.NewLazyMethodBind("AnimationTreePlayer", "mix_node_get_amount")
+	methodBindAnimationTreePlayerMixNodeSetAmount                     = gdnative.NewLazyMethodBind("AnimationTreePlayer", "mix_node_set_amount")
+	methodBindAnimationTreePlayerNodeExists                           = gdnative.NewLazyMethodBind("AnimationTreePlayer", "node_exists")
+	methodBindAnimationTreePlayerNodeGetInputCount                    = gdnative.NewLazyMethodBind("AnimationTreePlayer", "node_get_input_count")
+	methodBindAnimationTreePlayerNodeGetInputSource                   = gdnative.NewLazyMethodBind("AnimationTreePlayer", "node_get_input_source")
+	methodBindAnimationTreePlayerNodeGetPosition                      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "node_get_position")
+	methodBindAnimationTreePlayerNodeGetType                          = gdnative.NewLazyMethodBind("AnimationTreePlayer", "node_get_type")
+	methodBindAnimationTreePlayerNodeRename                           = gdnative.NewLazyMethodBind("AnimationTreePlayer", "node_rename")
+	methodBindAnimationTreePlayerNodeSetPosition                      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "node_set_position")
+	methodBindAnimationTreePlayerOneshotNodeGetAutorestartDelay       = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_get_autorestart_delay")
+	methodBindAnimationTreePlayerOneshotNodeGetAutorestartRandomDelay = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_get_autorestart_random_delay")
+	methodBindAnimationTreePlayerOneshotNodeGetFadeinTime             = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_get_fadein_time")
+	methodBindAnimationTreePlayerOneshotNodeGetFadeoutTime            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_get_fadeout_time")
+	methodBindAnimationTreePlayerOneshotNodeHasAutorestart            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_has_autorestart")
+	methodBindAnimationTreePlayerOneshotNodeIsActive                  = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_is_active")
+	methodBindAnimationTreePlayerOneshotNodeSetAutorestart            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_set_autorestart")
+	methodBindAnimationTreePlayerOneshotNodeSetAutorestartDelay       = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_set_autorestart_delay")
+	methodBindAnimationTreePlayerOneshotNodeSetAutorestartRandomDelay = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_set_autorestart_random_delay")
+	methodBindAnimationTreePlayerOneshotNodeSetFadeinTime             = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_set_fadein_time")
+	methodBindAnimationTreePlayerOneshotNodeSetFadeoutTime            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_set_fadeout_time")
+	methodBindAnimationTreePlayerOneshotNodeSetFilterPath             = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_set_filter_path")
+	methodBindAnimationTreePlayerOneshotNodeStart                     = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_start")
+	methodBindAnimationTreePlayerOneshotNodeStop                      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "oneshot_node_stop")
+	methodBindAnimationTreePlayerRecomputeCaches                      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "recompute_caches")
+	methodBindAnimationTreePlayerRemoveNode                           = gdnative.NewLazyMethodBind("AnimationTreePlayer", "remove_node")
+	methodBindAnimationTreePlayerReset                                = gdnative.NewLazyMethodBind("AnimationTreePlayer", "reset")
+	methodBindAnimationTreePlayerSetActive                            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "set_active")
+	methodBindAnimationTreePlayerSetAnimationProcessMode              = gdnative.NewLazyMethodBind("AnimationTreePlayer", "set_animation_process_mode")
+	methodBindAnimationTreePlayerSetBasePath                          = gdnative.NewLazyMethodBind("AnimationTreePlayer", "set_base_path")
+	methodBindAnimationTreePlayerSetMasterPlayer                      = gdnative.NewLazyMethodBind("AnimationTreePlayer", "set_master_player")
+	methodBindAnimationTreePlayerTimescaleNodeGetScale                = gdnative.NewLazyMethodBind("AnimationTreePlayer", "timescale_node_get_scale")
+	methodBindAnimationTreePlayerTimescaleNodeSetScale                = gdnative.NewLazyMethodBind("AnimationTreePlayer", "timescale_node_set_scale")
+	methodBindAnimationTreePlayerTimeseekNodeSeek                     = gdnative.NewLazyMethodBind("AnimationTreePlayer", "timeseek_node_seek")
+	methodBindAnimationTreePlayerTransitionNodeDeleteInput            = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_delete_input")
+	methodBindAnimationTreePlayerTransitionNodeGetCurrent             = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_get_current")
+	methodBindAnimationTreePlayerTransitionNodeGetInputCount          = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_get_input_count")
+	methodBindAnimationTreePlayerTransitionNodeGetXfadeTime           = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_get_xfade_time")
+	methodBindAnimationTreePlayerTransitionNodeHasInputAutoAdvance    = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_has_input_auto_advance")
+	methodBindAnimationTreePlayerTransitionNodeSetCurrent             = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_set_current")
+	methodBindAnimationTreePlayerTransitionNodeSetInputAutoAdvance    = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_set_input_auto_advance")
+	methodBindAnimationTreePlayerTransitionNodeSetInputCount          = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_set_input_count")
+	methodBindAnimationTreePlayerTransitionNodeSetXfadeTime           = gdnative.NewLazyMethodBind("AnimationTreePlayer", "transition_node_set_xfade_time")
+)
+
 /*
-        Adds a [code]type[/code] node to the graph with name [code]id[/code].
-	Args: [{ false type int} { false id String}], Returns: void
+	        Adds a [code]type[/code] node to the graph with name [code]id[/code].
+		Args: [{ false type int} { false id String}], Returns: void
 */
 func (o *AnimationTreePlayer) AddNode(aType gdnative.Int, id gdnative.String) {
 	//log.Println("Calling AnimationTreePlayer.AddNode()")
@@ -71,7 +224,7 @@ func (o *AnimationTreePlayer) AddNode(aType gdnative.Int, id gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "add_node")
+	methodBind := methodBindAnimationTreePlayerAddNode.Get()
 
 	// Call the parent method.
 	// void
@@ -81,8 +234,8 @@ func (o *AnimationTreePlayer) AddNode(aType gdnative.Int, id gdnative.String) {
 }
 
 /*
-        Shifts position in the animation timeline. Delta is the time in seconds to shift.
-	Args: [{ false delta float}], Returns: void
+	        Shifts position in the animation timeline. Delta is the time in seconds to shift.
+		Args: [{ false delta float}], Returns: void
 */
 func (o *AnimationTreePlayer) Advance(delta gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.Advance()")
@@ -92,7 +245,7 @@ func (o *AnimationTreePlayer) Advance(delta gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "advance")
+	methodBind := methodBindAnimationTreePlayerAdvance.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +255,8 @@ func (o *AnimationTreePlayer) Advance(delta gdnative.Real) {
 }
 
 /*
-        Returns the [AnimationPlayer]'s [Animation] bound to the [code]AnimationTreePlayer[/code]'s animation node with name [code]id[/code].
-	Args: [{ false id String}], Returns: Animation
+	        Returns the [AnimationPlayer]'s [Animation] bound to the [code]AnimationTreePlayer[/code]'s animation node with name [code]id[/code].
+		Args: [{ false id String}], Returns: Animation
 */
 func (o *AnimationTreePlayer) AnimationNodeGetAnimation(id gdnative.String) AnimationImplementer {
 	//log.Println("Calling AnimationTreePlayer.AnimationNodeGetAnimation()")
@@ -113,7 +266,7 @@ func (o *AnimationTreePlayer) AnimationNodeGetAnimation(id gdnative.String) Anim
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "animation_node_get_animation")
+	methodBind := methodBindAnimationTreePlayerAnimationNodeGetAnimation.Get()
 
 	// Call the parent method.
 	// Animation
@@ -121,27 +274,25 @@ func (o *AnimationTreePlayer) AnimationNodeGetAnimation(id gdnative.String) Anim
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAnimationFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AnimationImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Animation" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AnimationImplementer)
+	ret := Animation{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(AnimationImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the name of the [member master_player]'s [Animation] bound to this animation node.
-	Args: [{ false id String}], Returns: String
+	        Returns the name of the [member master_player]'s [Animation] bound to this animation node.
+		Args: [{ false id String}], Returns: String
 */
 func (o *AnimationTreePlayer) AnimationNodeGetMasterAnimation(id gdnative.String) gdnative.String {
 	//log.Println("Calling AnimationTreePlayer.AnimationNodeGetMasterAnimation()")
@@ -151,7 +302,7 @@ func (o *AnimationTreePlayer) AnimationNodeGetMasterAnimation(id gdnative.String
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "animation_node_get_master_animation")
+	methodBind := methodBindAnimationTreePlayerAnimationNodeGetMasterAnimation.Get()
 
 	// Call the parent method.
 	// String
@@ -164,8 +315,8 @@ func (o *AnimationTreePlayer) AnimationNodeGetMasterAnimation(id gdnative.String
 }
 
 /*
-        Binds a new [Animation] from the [member master_player] to the [code]AnimationTreePlayer[/code]'s animation node with name [code]id[/code].
-	Args: [{ false id String} { false animation Animation}], Returns: void
+	        Binds a new [Animation] from the [member master_player] to the [code]AnimationTreePlayer[/code]'s animation node with name [code]id[/code].
+		Args: [{ false id String} { false animation Animation}], Returns: void
 */
 func (o *AnimationTreePlayer) AnimationNodeSetAnimation(id gdnative.String, animation AnimationImplementer) {
 	//log.Println("Calling AnimationTreePlayer.AnimationNodeSetAnimation()")
@@ -173,10 +324,10 @@ func (o *AnimationTreePlayer) AnimationNodeSetAnimation(id gdnative.String, anim
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(animation.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(animation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "animation_node_set_animation")
+	methodBind := methodBindAnimationTreePlayerAnimationNodeSetAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -186,8 +337,8 @@ func (o *AnimationTreePlayer) AnimationNodeSetAnimation(id gdnative.String, anim
 }
 
 /*
-        If [code]enable[/code] is [code]true[/code], the animation node with ID [code]id[/code] turns off the track modifying the property at [code]path[/code]. The modified node's children continue to animate.
-	Args: [{ false id String} { false path NodePath} { false enable bool}], Returns: void
+	        If [code]enable[/code] is [code]true[/code], the animation node with ID [code]id[/code] turns off the track modifying the property at [code]path[/code]. The modified node's children continue to animate.
+		Args: [{ false id String} { false path NodePath} { false enable bool}], Returns: void
 */
 func (o *AnimationTreePlayer) AnimationNodeSetFilterPath(id gdnative.String, path gdnative.NodePath, enable gdnative.Bool) {
 	//log.Println("Calling AnimationTreePlayer.AnimationNodeSetFilterPath()")
@@ -199,7 +350,7 @@ func (o *AnimationTreePlayer) AnimationNodeSetFilterPath(id gdnative.String, pat
 	ptrArguments[2] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "animation_node_set_filter_path")
+	methodBind := methodBindAnimationTreePlayerAnimationNodeSetFilterPath.Get()
 
 	// Call the parent method.
 	// void
@@ -209,8 +360,8 @@ func (o *AnimationTreePlayer) AnimationNodeSetFilterPath(id gdnative.String, pat
 }
 
 /*
-        Binds the [Animation] named [code]source[/code] from [member master_player] to the animation node [code]id[/code]. Recalculates caches.
-	Args: [{ false id String} { false source String}], Returns: void
+	        Binds the [Animation] named [code]source[/code] from [member master_player] to the animation node [code]id[/code]. Recalculates caches.
+		Args: [{ false id String} { false source String}], Returns: void
 */
 func (o *AnimationTreePlayer) AnimationNodeSetMasterAnimation(id gdnative.String, source gdnative.String) {
 	//log.Println("Calling AnimationTreePlayer.AnimationNodeSetMasterAnimation()")
@@ -221,7 +372,7 @@ func (o *AnimationTreePlayer) AnimationNodeSetMasterAnimation(id gdnative.String
 	ptrArguments[1] = gdnative.NewPointerFromString(source)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "animation_node_set_master_animation")
+	methodBind := methodBindAnimationTreePlayerAnimationNodeSetMasterAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -231,8 +382,8 @@ func (o *AnimationTreePlayer) AnimationNodeSetMasterAnimation(id gdnative.String
 }
 
 /*
-        Returns whether node [code]id[/code] and [code]dst_id[/code] are connected at the specified slot.
-	Args: [{ false id String} { false dst_id String} { false dst_input_idx int}], Returns: bool
+	        Returns whether node [code]id[/code] and [code]dst_id[/code] are connected at the specified slot.
+		Args: [{ false id String} { false dst_id String} { false dst_input_idx int}], Returns: bool
 */
 func (o *AnimationTreePlayer) AreNodesConnected(id gdnative.String, dstId gdnative.String, dstInputIdx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling AnimationTreePlayer.AreNodesConnected()")
@@ -244,7 +395,7 @@ func (o *AnimationTreePlayer) AreNodesConnected(id gdnative.String, dstId gdnati
 	ptrArguments[2] = gdnative.NewPointerFromInt(dstInputIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "are_nodes_connected")
+	methodBind := methodBindAnimationTreePlayerAreNodesConnected.Get()
 
 	// Call the parent method.
 	// bool
@@ -257,8 +408,8 @@ func (o *AnimationTreePlayer) AreNodesConnected(id gdnative.String, dstId gdnati
 }
 
 /*
-        Returns the blend amount of a Blend2 node given its name.
-	Args: [{ false id String}], Returns: float
+	        Returns the blend amount of a Blend2 node given its name.
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) Blend2NodeGetAmount(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.Blend2NodeGetAmount()")
@@ -268,7 +419,7 @@ func (o *AnimationTreePlayer) Blend2NodeGetAmount(id gdnative.String) gdnative.R
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "blend2_node_get_amount")
+	methodBind := methodBindAnimationTreePlayerBlend2NodeGetAmount.Get()
 
 	// Call the parent method.
 	// float
@@ -281,8 +432,8 @@ func (o *AnimationTreePlayer) Blend2NodeGetAmount(id gdnative.String) gdnative.R
 }
 
 /*
-        Sets the blend amount of a Blend2 node given its name and value. A Blend2 Node blends two animations with the amount between 0 and 1. At 0, Output is input a. Towards 1, the influence of a gets lessened, the influence of b gets raised. At 1, Output is input b.
-	Args: [{ false id String} { false blend float}], Returns: void
+	        Sets the blend amount of a Blend2 node given its name and value. A Blend2 Node blends two animations with the amount between 0 and 1. At 0, Output is input a. Towards 1, the influence of a gets lessened, the influence of b gets raised. At 1, Output is input b.
+		Args: [{ false id String} { false blend float}], Returns: void
 */
 func (o *AnimationTreePlayer) Blend2NodeSetAmount(id gdnative.String, blend gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.Blend2NodeSetAmount()")
@@ -293,7 +444,7 @@ func (o *AnimationTreePlayer) Blend2NodeSetAmount(id gdnative.String, blend gdna
 	ptrArguments[1] = gdnative.NewPointerFromReal(blend)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "blend2_node_set_amount")
+	methodBind := methodBindAnimationTreePlayerBlend2NodeSetAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -303,8 +454,8 @@ func (o *AnimationTreePlayer) Blend2NodeSetAmount(id gdnative.String, blend gdna
 }
 
 /*
-        If [code]enable[/code] is [code]true[/code], the blend2 node with ID [code]id[/code] turns off the track modifying the property at [code]path[/code]. The modified node's children continue to animate.
-	Args: [{ false id String} { false path NodePath} { false enable bool}], Returns: void
+	        If [code]enable[/code] is [code]true[/code], the blend2 node with ID [code]id[/code] turns off the track modifying the property at [code]path[/code]. The modified node's children continue to animate.
+		Args: [{ false id String} { false path NodePath} { false enable bool}], Returns: void
 */
 func (o *AnimationTreePlayer) Blend2NodeSetFilterPath(id gdnative.String, path gdnative.NodePath, enable gdnative.Bool) {
 	//log.Println("Calling AnimationTreePlayer.Blend2NodeSetFilterPath()")
@@ -316,7 +467,7 @@ func (o *AnimationTreePlayer) Blend2NodeSetFilterPath(id gdnative.String, path g
 	ptrArguments[2] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "blend2_node_set_filter_path")
+	methodBind := methodBindAnimationTreePlayerBlend2NodeSetFilterPath.Get()
 
 	// Call the parent method.
 	// void
@@ -326,8 +477,8 @@ func (o *AnimationTreePlayer) Blend2NodeSetFilterPath(id gdnative.String, path g
 }
 
 /*
-        Returns the blend amount of a Blend3 node given its name.
-	Args: [{ false id String}], Returns: float
+	        Returns the blend amount of a Blend3 node given its name.
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) Blend3NodeGetAmount(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.Blend3NodeGetAmount()")
@@ -337,7 +488,7 @@ func (o *AnimationTreePlayer) Blend3NodeGetAmount(id gdnative.String) gdnative.R
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "blend3_node_get_amount")
+	methodBind := methodBindAnimationTreePlayerBlend3NodeGetAmount.Get()
 
 	// Call the parent method.
 	// float
@@ -350,8 +501,8 @@ func (o *AnimationTreePlayer) Blend3NodeGetAmount(id gdnative.String) gdnative.R
 }
 
 /*
-        Sets the blend amount of a Blend3 node given its name and value. A Blend3 Node blends three animations with the amount between -1 and 1. At -1, Output is input b-. From -1 to 0, the influence of b- gets lessened, the influence of a gets raised and the influence of b+ is 0. At 0, Output is input a. From 0 to 1, the influence of a gets lessened, the influence of b+ gets raised and the influence of b+ is 0. At 1, Output is input b+.
-	Args: [{ false id String} { false blend float}], Returns: void
+	        Sets the blend amount of a Blend3 node given its name and value. A Blend3 Node blends three animations with the amount between -1 and 1. At -1, Output is input b-. From -1 to 0, the influence of b- gets lessened, the influence of a gets raised and the influence of b+ is 0. At 0, Output is input a. From 0 to 1, the influence of a gets lessened, the influence of b+ gets raised and the influence of b+ is 0. At 1, Output is input b+.
+		Args: [{ false id String} { false blend float}], Returns: void
 */
 func (o *AnimationTreePlayer) Blend3NodeSetAmount(id gdnative.String, blend gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.Blend3NodeSetAmount()")
@@ -362,7 +513,7 @@ func (o *AnimationTreePlayer) Blend3NodeSetAmount(id gdnative.String, blend gdna
 	ptrArguments[1] = gdnative.NewPointerFromReal(blend)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "blend3_node_set_amount")
+	methodBind := methodBindAnimationTreePlayerBlend3NodeSetAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -372,8 +523,8 @@ func (o *AnimationTreePlayer) Blend3NodeSetAmount(id gdnative.String, blend gdna
 }
 
 /*
-        Returns the blend amount of a Blend4 node given its name.
-	Args: [{ false id String}], Returns: Vector2
+	        Returns the blend amount of a Blend4 node given its name.
+		Args: [{ false id String}], Returns: Vector2
 */
 func (o *AnimationTreePlayer) Blend4NodeGetAmount(id gdnative.String) gdnative.Vector2 {
 	//log.Println("Calling AnimationTreePlayer.Blend4NodeGetAmount()")
@@ -383,7 +534,7 @@ func (o *AnimationTreePlayer) Blend4NodeGetAmount(id gdnative.String) gdnative.V
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "blend4_node_get_amount")
+	methodBind := methodBindAnimationTreePlayerBlend4NodeGetAmount.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -396,8 +547,8 @@ func (o *AnimationTreePlayer) Blend4NodeGetAmount(id gdnative.String) gdnative.V
 }
 
 /*
-        Sets the blend amount of a Blend4 node given its name and value. A Blend4 Node blends two pairs of animations. The two pairs are blended like blend2 and then added together.
-	Args: [{ false id String} { false blend Vector2}], Returns: void
+	        Sets the blend amount of a Blend4 node given its name and value. A Blend4 Node blends two pairs of animations. The two pairs are blended like blend2 and then added together.
+		Args: [{ false id String} { false blend Vector2}], Returns: void
 */
 func (o *AnimationTreePlayer) Blend4NodeSetAmount(id gdnative.String, blend gdnative.Vector2) {
 	//log.Println("Calling AnimationTreePlayer.Blend4NodeSetAmount()")
@@ -408,7 +559,7 @@ func (o *AnimationTreePlayer) Blend4NodeSetAmount(id gdnative.String, blend gdna
 	ptrArguments[1] = gdnative.NewPointerFromVector2(blend)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "blend4_node_set_amount")
+	methodBind := methodBindAnimationTreePlayerBlend4NodeSetAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -418,8 +569,8 @@ func (o *AnimationTreePlayer) Blend4NodeSetAmount(id gdnative.String, blend gdna
 }
 
 /*
-        Connects node [code]id[/code] to [code]dst_id[/code] at the specified input slot.
-	Args: [{ false id String} { false dst_id String} { false dst_input_idx int}], Returns: enum.Error
+	        Connects node [code]id[/code] to [code]dst_id[/code] at the specified input slot.
+		Args: [{ false id String} { false dst_id String} { false dst_input_idx int}], Returns: enum.Error
 */
 func (o *AnimationTreePlayer) ConnectNodes(id gdnative.String, dstId gdnative.String, dstInputIdx gdnative.Int) gdnative.Error {
 	//log.Println("Calling AnimationTreePlayer.ConnectNodes()")
@@ -431,7 +582,7 @@ func (o *AnimationTreePlayer) ConnectNodes(id gdnative.String, dstId gdnative.St
 	ptrArguments[2] = gdnative.NewPointerFromInt(dstInputIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "connect_nodes")
+	methodBind := methodBindAnimationTreePlayerConnectNodes.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -444,8 +595,8 @@ func (o *AnimationTreePlayer) ConnectNodes(id gdnative.String, dstId gdnative.St
 }
 
 /*
-        Disconnects nodes connected to [code]id[/code] at the specified input slot.
-	Args: [{ false id String} { false dst_input_idx int}], Returns: void
+	        Disconnects nodes connected to [code]id[/code] at the specified input slot.
+		Args: [{ false id String} { false dst_input_idx int}], Returns: void
 */
 func (o *AnimationTreePlayer) DisconnectNodes(id gdnative.String, dstInputIdx gdnative.Int) {
 	//log.Println("Calling AnimationTreePlayer.DisconnectNodes()")
@@ -456,7 +607,7 @@ func (o *AnimationTreePlayer) DisconnectNodes(id gdnative.String, dstInputIdx gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(dstInputIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "disconnect_nodes")
+	methodBind := methodBindAnimationTreePlayerDisconnectNodes.Get()
 
 	// Call the parent method.
 	// void
@@ -466,8 +617,8 @@ func (o *AnimationTreePlayer) DisconnectNodes(id gdnative.String, dstInputIdx gd
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AnimationTreePlayer::AnimationProcessMode
+	        Undocumented
+		Args: [], Returns: enum.AnimationTreePlayer::AnimationProcessMode
 */
 func (o *AnimationTreePlayer) GetAnimationProcessMode() AnimationTreePlayerAnimationProcessMode {
 	//log.Println("Calling AnimationTreePlayer.GetAnimationProcessMode()")
@@ -476,7 +627,7 @@ func (o *AnimationTreePlayer) GetAnimationProcessMode() AnimationTreePlayerAnima
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "get_animation_process_mode")
+	methodBind := methodBindAnimationTreePlayerGetAnimationProcessMode.Get()
 
 	// Call the parent method.
 	// enum.AnimationTreePlayer::AnimationProcessMode
@@ -489,8 +640,8 @@ func (o *AnimationTreePlayer) GetAnimationProcessMode() AnimationTreePlayerAnima
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *AnimationTreePlayer) GetBasePath() gdnative.NodePath {
 	//log.Println("Calling AnimationTreePlayer.GetBasePath()")
@@ -499,7 +650,7 @@ func (o *AnimationTreePlayer) GetBasePath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "get_base_path")
+	methodBind := methodBindAnimationTreePlayerGetBasePath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -512,8 +663,8 @@ func (o *AnimationTreePlayer) GetBasePath() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *AnimationTreePlayer) GetMasterPlayer() gdnative.NodePath {
 	//log.Println("Calling AnimationTreePlayer.GetMasterPlayer()")
@@ -522,7 +673,7 @@ func (o *AnimationTreePlayer) GetMasterPlayer() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "get_master_player")
+	methodBind := methodBindAnimationTreePlayerGetMasterPlayer.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -535,8 +686,8 @@ func (o *AnimationTreePlayer) GetMasterPlayer() gdnative.NodePath {
 }
 
 /*
-        Returns a [PoolStringArray] containing the name of all nodes.
-	Args: [], Returns: PoolStringArray
+	        Returns a [PoolStringArray] containing the name of all nodes.
+		Args: [], Returns: PoolStringArray
 */
 func (o *AnimationTreePlayer) GetNodeList() gdnative.PoolStringArray {
 	//log.Println("Calling AnimationTreePlayer.GetNodeList()")
@@ -545,7 +696,7 @@ func (o *AnimationTreePlayer) GetNodeList() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "get_node_list")
+	methodBind := methodBindAnimationTreePlayerGetNodeList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -558,8 +709,8 @@ func (o *AnimationTreePlayer) GetNodeList() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AnimationTreePlayer) IsActive() gdnative.Bool {
 	//log.Println("Calling AnimationTreePlayer.IsActive()")
@@ -568,7 +719,7 @@ func (o *AnimationTreePlayer) IsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "is_active")
+	methodBind := methodBindAnimationTreePlayerIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -581,8 +732,8 @@ func (o *AnimationTreePlayer) IsActive() gdnative.Bool {
 }
 
 /*
-        Returns mix amount of a Mix node given its name.
-	Args: [{ false id String}], Returns: float
+	        Returns mix amount of a Mix node given its name.
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) MixNodeGetAmount(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.MixNodeGetAmount()")
@@ -592,7 +743,7 @@ func (o *AnimationTreePlayer) MixNodeGetAmount(id gdnative.String) gdnative.Real
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "mix_node_get_amount")
+	methodBind := methodBindAnimationTreePlayerMixNodeGetAmount.Get()
 
 	// Call the parent method.
 	// float
@@ -605,8 +756,8 @@ func (o *AnimationTreePlayer) MixNodeGetAmount(id gdnative.String) gdnative.Real
 }
 
 /*
-        Sets mix amount of a Mix node given its name and value. A Mix node adds input b to input a by a the amount given by ratio.
-	Args: [{ false id String} { false ratio float}], Returns: void
+	        Sets mix amount of a Mix node given its name and value. A Mix node adds input b to input a by a the amount given by ratio.
+		Args: [{ false id String} { false ratio float}], Returns: void
 */
 func (o *AnimationTreePlayer) MixNodeSetAmount(id gdnative.String, ratio gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.MixNodeSetAmount()")
@@ -617,7 +768,7 @@ func (o *AnimationTreePlayer) MixNodeSetAmount(id gdnative.String, ratio gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "mix_node_set_amount")
+	methodBind := methodBindAnimationTreePlayerMixNodeSetAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -627,8 +778,8 @@ func (o *AnimationTreePlayer) MixNodeSetAmount(id gdnative.String, ratio gdnativ
 }
 
 /*
-        Check if a node exists (by name).
-	Args: [{ false node String}], Returns: bool
+	        Check if a node exists (by name).
+		Args: [{ false node String}], Returns: bool
 */
 func (o *AnimationTreePlayer) NodeExists(node gdnative.String) gdnative.Bool {
 	//log.Println("Calling AnimationTreePlayer.NodeExists()")
@@ -638,7 +789,7 @@ func (o *AnimationTreePlayer) NodeExists(node gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(node)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "node_exists")
+	methodBind := methodBindAnimationTreePlayerNodeExists.Get()
 
 	// Call the parent method.
 	// bool
@@ -651,8 +802,8 @@ func (o *AnimationTreePlayer) NodeExists(node gdnative.String) gdnative.Bool {
 }
 
 /*
-        Return the input count for a given node. Different types of nodes have different amount of inputs.
-	Args: [{ false id String}], Returns: int
+	        Return the input count for a given node. Different types of nodes have different amount of inputs.
+		Args: [{ false id String}], Returns: int
 */
 func (o *AnimationTreePlayer) NodeGetInputCount(id gdnative.String) gdnative.Int {
 	//log.Println("Calling AnimationTreePlayer.NodeGetInputCount()")
@@ -662,7 +813,7 @@ func (o *AnimationTreePlayer) NodeGetInputCount(id gdnative.String) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "node_get_input_count")
+	methodBind := methodBindAnimationTreePlayerNodeGetInputCount.Get()
 
 	// Call the parent method.
 	// int
@@ -675,8 +826,8 @@ func (o *AnimationTreePlayer) NodeGetInputCount(id gdnative.String) gdnative.Int
 }
 
 /*
-        Return the input source for a given node input.
-	Args: [{ false id String} { false idx int}], Returns: String
+	        Return the input source for a given node input.
+		Args: [{ false id String} { false idx int}], Returns: String
 */
 func (o *AnimationTreePlayer) NodeGetInputSource(id gdnative.String, idx gdnative.Int) gdnative.String {
 	//log.Println("Calling AnimationTreePlayer.NodeGetInputSource()")
@@ -687,7 +838,7 @@ func (o *AnimationTreePlayer) NodeGetInputSource(id gdnative.String, idx gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "node_get_input_source")
+	methodBind := methodBindAnimationTreePlayerNodeGetInputSource.Get()
 
 	// Call the parent method.
 	// String
@@ -700,8 +851,8 @@ func (o *AnimationTreePlayer) NodeGetInputSource(id gdnative.String, idx gdnativ
 }
 
 /*
-        Returns position of a node in the graph given its name.
-	Args: [{ false id String}], Returns: Vector2
+	        Returns position of a node in the graph given its name.
+		Args: [{ false id String}], Returns: Vector2
 */
 func (o *AnimationTreePlayer) NodeGetPosition(id gdnative.String) gdnative.Vector2 {
 	//log.Println("Calling AnimationTreePlayer.NodeGetPosition()")
@@ -711,7 +862,7 @@ func (o *AnimationTreePlayer) NodeGetPosition(id gdnative.String) gdnative.Vecto
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "node_get_position")
+	methodBind := methodBindAnimationTreePlayerNodeGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -724,8 +875,8 @@ func (o *AnimationTreePlayer) NodeGetPosition(id gdnative.String) gdnative.Vecto
 }
 
 /*
-        Get the node type, will return from NODE_* enum.
-	Args: [{ false id String}], Returns: enum.AnimationTreePlayer::NodeType
+	        Get the node type, will return from NODE_* enum.
+		Args: [{ false id String}], Returns: enum.AnimationTreePlayer::NodeType
 */
 func (o *AnimationTreePlayer) NodeGetType(id gdnative.String) AnimationTreePlayerNodeType {
 	//log.Println("Calling AnimationTreePlayer.NodeGetType()")
@@ -735,7 +886,7 @@ func (o *AnimationTreePlayer) NodeGetType(id gdnative.String) AnimationTreePlaye
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "node_get_type")
+	methodBind := methodBindAnimationTreePlayerNodeGetType.Get()
 
 	// Call the parent method.
 	// enum.AnimationTreePlayer::NodeType
@@ -748,8 +899,8 @@ func (o *AnimationTreePlayer) NodeGetType(id gdnative.String) AnimationTreePlaye
 }
 
 /*
-        Rename a node in the graph.
-	Args: [{ false node String} { false new_name String}], Returns: enum.Error
+	        Rename a node in the graph.
+		Args: [{ false node String} { false new_name String}], Returns: enum.Error
 */
 func (o *AnimationTreePlayer) NodeRename(node gdnative.String, newName gdnative.String) gdnative.Error {
 	//log.Println("Calling AnimationTreePlayer.NodeRename()")
@@ -760,7 +911,7 @@ func (o *AnimationTreePlayer) NodeRename(node gdnative.String, newName gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromString(newName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "node_rename")
+	methodBind := methodBindAnimationTreePlayerNodeRename.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -773,8 +924,8 @@ func (o *AnimationTreePlayer) NodeRename(node gdnative.String, newName gdnative.
 }
 
 /*
-        Sets position of a node in the graph given its name and position.
-	Args: [{ false id String} { false screen_position Vector2}], Returns: void
+	        Sets position of a node in the graph given its name and position.
+		Args: [{ false id String} { false screen_position Vector2}], Returns: void
 */
 func (o *AnimationTreePlayer) NodeSetPosition(id gdnative.String, screenPosition gdnative.Vector2) {
 	//log.Println("Calling AnimationTreePlayer.NodeSetPosition()")
@@ -785,7 +936,7 @@ func (o *AnimationTreePlayer) NodeSetPosition(id gdnative.String, screenPosition
 	ptrArguments[1] = gdnative.NewPointerFromVector2(screenPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "node_set_position")
+	methodBind := methodBindAnimationTreePlayerNodeSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -795,8 +946,8 @@ func (o *AnimationTreePlayer) NodeSetPosition(id gdnative.String, screenPosition
 }
 
 /*
-        Returns autostart delay of a OneShot node given its name.
-	Args: [{ false id String}], Returns: float
+	        Returns autostart delay of a OneShot node given its name.
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) OneshotNodeGetAutorestartDelay(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeGetAutorestartDelay()")
@@ -806,7 +957,7 @@ func (o *AnimationTreePlayer) OneshotNodeGetAutorestartDelay(id gdnative.String)
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_get_autorestart_delay")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeGetAutorestartDelay.Get()
 
 	// Call the parent method.
 	// float
@@ -819,8 +970,8 @@ func (o *AnimationTreePlayer) OneshotNodeGetAutorestartDelay(id gdnative.String)
 }
 
 /*
-        Returns autostart random delay of a OneShot node given its name.
-	Args: [{ false id String}], Returns: float
+	        Returns autostart random delay of a OneShot node given its name.
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) OneshotNodeGetAutorestartRandomDelay(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeGetAutorestartRandomDelay()")
@@ -830,7 +981,7 @@ func (o *AnimationTreePlayer) OneshotNodeGetAutorestartRandomDelay(id gdnative.S
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_get_autorestart_random_delay")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeGetAutorestartRandomDelay.Get()
 
 	// Call the parent method.
 	// float
@@ -843,8 +994,8 @@ func (o *AnimationTreePlayer) OneshotNodeGetAutorestartRandomDelay(id gdnative.S
 }
 
 /*
-        Returns fade in time of a OneShot node given its name.
-	Args: [{ false id String}], Returns: float
+	        Returns fade in time of a OneShot node given its name.
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) OneshotNodeGetFadeinTime(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeGetFadeinTime()")
@@ -854,7 +1005,7 @@ func (o *AnimationTreePlayer) OneshotNodeGetFadeinTime(id gdnative.String) gdnat
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_get_fadein_time")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeGetFadeinTime.Get()
 
 	// Call the parent method.
 	// float
@@ -867,8 +1018,8 @@ func (o *AnimationTreePlayer) OneshotNodeGetFadeinTime(id gdnative.String) gdnat
 }
 
 /*
-        Returns fade out time of a OneShot node given its name.
-	Args: [{ false id String}], Returns: float
+	        Returns fade out time of a OneShot node given its name.
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) OneshotNodeGetFadeoutTime(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeGetFadeoutTime()")
@@ -878,7 +1029,7 @@ func (o *AnimationTreePlayer) OneshotNodeGetFadeoutTime(id gdnative.String) gdna
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_get_fadeout_time")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeGetFadeoutTime.Get()
 
 	// Call the parent method.
 	// float
@@ -891,8 +1042,8 @@ func (o *AnimationTreePlayer) OneshotNodeGetFadeoutTime(id gdnative.String) gdna
 }
 
 /*
-        Returns whether a OneShot node will auto restart given its name.
-	Args: [{ false id String}], Returns: bool
+	        Returns whether a OneShot node will auto restart given its name.
+		Args: [{ false id String}], Returns: bool
 */
 func (o *AnimationTreePlayer) OneshotNodeHasAutorestart(id gdnative.String) gdnative.Bool {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeHasAutorestart()")
@@ -902,7 +1053,7 @@ func (o *AnimationTreePlayer) OneshotNodeHasAutorestart(id gdnative.String) gdna
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_has_autorestart")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeHasAutorestart.Get()
 
 	// Call the parent method.
 	// bool
@@ -915,8 +1066,8 @@ func (o *AnimationTreePlayer) OneshotNodeHasAutorestart(id gdnative.String) gdna
 }
 
 /*
-        Returns whether a OneShot node is active given its name.
-	Args: [{ false id String}], Returns: bool
+	        Returns whether a OneShot node is active given its name.
+		Args: [{ false id String}], Returns: bool
 */
 func (o *AnimationTreePlayer) OneshotNodeIsActive(id gdnative.String) gdnative.Bool {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeIsActive()")
@@ -926,7 +1077,7 @@ func (o *AnimationTreePlayer) OneshotNodeIsActive(id gdnative.String) gdnative.B
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_is_active")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -939,8 +1090,8 @@ func (o *AnimationTreePlayer) OneshotNodeIsActive(id gdnative.String) gdnative.B
 }
 
 /*
-        Sets autorestart property of a OneShot node given its name and value.
-	Args: [{ false id String} { false enable bool}], Returns: void
+	        Sets autorestart property of a OneShot node given its name and value.
+		Args: [{ false id String} { false enable bool}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeSetAutorestart(id gdnative.String, enable gdnative.Bool) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeSetAutorestart()")
@@ -951,7 +1102,7 @@ func (o *AnimationTreePlayer) OneshotNodeSetAutorestart(id gdnative.String, enab
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_set_autorestart")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeSetAutorestart.Get()
 
 	// Call the parent method.
 	// void
@@ -961,8 +1112,8 @@ func (o *AnimationTreePlayer) OneshotNodeSetAutorestart(id gdnative.String, enab
 }
 
 /*
-        Sets autorestart delay of a OneShot node given its name and value in seconds.
-	Args: [{ false id String} { false delay_sec float}], Returns: void
+	        Sets autorestart delay of a OneShot node given its name and value in seconds.
+		Args: [{ false id String} { false delay_sec float}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeSetAutorestartDelay(id gdnative.String, delaySec gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeSetAutorestartDelay()")
@@ -973,7 +1124,7 @@ func (o *AnimationTreePlayer) OneshotNodeSetAutorestartDelay(id gdnative.String,
 	ptrArguments[1] = gdnative.NewPointerFromReal(delaySec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_set_autorestart_delay")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeSetAutorestartDelay.Get()
 
 	// Call the parent method.
 	// void
@@ -983,8 +1134,8 @@ func (o *AnimationTreePlayer) OneshotNodeSetAutorestartDelay(id gdnative.String,
 }
 
 /*
-        Sets autorestart random delay of a OneShot node given its name and value in seconds.
-	Args: [{ false id String} { false rand_sec float}], Returns: void
+	        Sets autorestart random delay of a OneShot node given its name and value in seconds.
+		Args: [{ false id String} { false rand_sec float}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeSetAutorestartRandomDelay(id gdnative.String, randSec gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeSetAutorestartRandomDelay()")
@@ -995,7 +1146,7 @@ func (o *AnimationTreePlayer) OneshotNodeSetAutorestartRandomDelay(id gdnative.S
 	ptrArguments[1] = gdnative.NewPointerFromReal(randSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_set_autorestart_random_delay")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeSetAutorestartRandomDelay.Get()
 
 	// Call the parent method.
 	// void
@@ -1005,8 +1156,8 @@ func (o *AnimationTreePlayer) OneshotNodeSetAutorestartRandomDelay(id gdnative.S
 }
 
 /*
-        Sets fade in time of a OneShot node given its name and value in seconds.
-	Args: [{ false id String} { false time_sec float}], Returns: void
+	        Sets fade in time of a OneShot node given its name and value in seconds.
+		Args: [{ false id String} { false time_sec float}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeSetFadeinTime(id gdnative.String, timeSec gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeSetFadeinTime()")
@@ -1017,7 +1168,7 @@ func (o *AnimationTreePlayer) OneshotNodeSetFadeinTime(id gdnative.String, timeS
 	ptrArguments[1] = gdnative.NewPointerFromReal(timeSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_set_fadein_time")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeSetFadeinTime.Get()
 
 	// Call the parent method.
 	// void
@@ -1027,8 +1178,8 @@ func (o *AnimationTreePlayer) OneshotNodeSetFadeinTime(id gdnative.String, timeS
 }
 
 /*
-        Sets fade out time of a OneShot node given its name and value in seconds.
-	Args: [{ false id String} { false time_sec float}], Returns: void
+	        Sets fade out time of a OneShot node given its name and value in seconds.
+		Args: [{ false id String} { false time_sec float}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeSetFadeoutTime(id gdnative.String, timeSec gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeSetFadeoutTime()")
@@ -1039,7 +1190,7 @@ func (o *AnimationTreePlayer) OneshotNodeSetFadeoutTime(id gdnative.String, time
 	ptrArguments[1] = gdnative.NewPointerFromReal(timeSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_set_fadeout_time")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeSetFadeoutTime.Get()
 
 	// Call the parent method.
 	// void
@@ -1049,8 +1200,8 @@ func (o *AnimationTreePlayer) OneshotNodeSetFadeoutTime(id gdnative.String, time
 }
 
 /*
-        If [code]enable[/code] is [code]true[/code], the oneshot node with ID [code]id[/code] turns off the track modifying the property at [code]path[/code]. The modified node's children continue to animate.
-	Args: [{ false id String} { false path NodePath} { false enable bool}], Returns: void
+	        If [code]enable[/code] is [code]true[/code], the oneshot node with ID [code]id[/code] turns off the track modifying the property at [code]path[/code]. The modified node's children continue to animate.
+		Args: [{ false id String} { false path NodePath} { false enable bool}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeSetFilterPath(id gdnative.String, path gdnative.NodePath, enable gdnative.Bool) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeSetFilterPath()")
@@ -1062,7 +1213,7 @@ func (o *AnimationTreePlayer) OneshotNodeSetFilterPath(id gdnative.String, path
 	ptrArguments[2] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_set_filter_path")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeSetFilterPath.Get()
 
 	// Call the parent method.
 	// void
@@ -1072,8 +1223,8 @@ func (o *AnimationTreePlayer) OneshotNodeSetFilterPath(id gdnative.String, path
 }
 
 /*
-        Starts a OneShot node given its name.
-	Args: [{ false id String}], Returns: void
+	        Starts a OneShot node given its name.
+		Args: [{ false id String}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeStart(id gdnative.String) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeStart()")
@@ -1083,7 +1234,7 @@ func (o *AnimationTreePlayer) OneshotNodeStart(id gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_start")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeStart.Get()
 
 	// Call the parent method.
 	// void
@@ -1093,8 +1244,8 @@ func (o *AnimationTreePlayer) OneshotNodeStart(id gdnative.String) {
 }
 
 /*
-        Stops the OneShot node with name [code]id[/code].
-	Args: [{ false id String}], Returns: void
+	        Stops the OneShot node with name [code]id[/code].
+		Args: [{ false id String}], Returns: void
 */
 func (o *AnimationTreePlayer) OneshotNodeStop(id gdnative.String) {
 	//log.Println("Calling AnimationTreePlayer.OneshotNodeStop()")
@@ -1104,7 +1255,7 @@ func (o *AnimationTreePlayer) OneshotNodeStop(id gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "oneshot_node_stop")
+	methodBind := methodBindAnimationTreePlayerOneshotNodeStop.Get()
 
 	// Call the parent method.
 	// void
@@ -1114,8 +1265,8 @@ func (o *AnimationTreePlayer) OneshotNodeStop(id gdnative.String) {
 }
 
 /*
-        Manually recalculates the cache of track information generated from animation nodes. Needed when external sources modify the animation nodes' state.
-	Args: [], Returns: void
+	        Manually recalculates the cache of track information generated from animation nodes. Needed when external sources modify the animation nodes' state.
+		Args: [], Returns: void
 */
 func (o *AnimationTreePlayer) RecomputeCaches() {
 	//log.Println("Calling AnimationTreePlayer.RecomputeCaches()")
@@ -1124,7 +1275,7 @@ func (o *AnimationTreePlayer) RecomputeCaches() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "recompute_caches")
+	methodBind := methodBindAnimationTreePlayerRecomputeCaches.Get()
 
 	// Call the parent method.
 	// void
@@ -1134,8 +1285,8 @@ func (o *AnimationTreePlayer) RecomputeCaches() {
 }
 
 /*
-        Removes the animation node with name [code]id[/code].
-	Args: [{ false id String}], Returns: void
+	        Removes the animation node with name [code]id[/code].
+		Args: [{ false id String}], Returns: void
 */
 func (o *AnimationTreePlayer) RemoveNode(id gdnative.String) {
 	//log.Println("Calling AnimationTreePlayer.RemoveNode()")
@@ -1145,7 +1296,7 @@ func (o *AnimationTreePlayer) RemoveNode(id gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "remove_node")
+	methodBind := methodBindAnimationTreePlayerRemoveNode.Get()
 
 	// Call the parent method.
 	// void
@@ -1155,8 +1306,8 @@ func (o *AnimationTreePlayer) RemoveNode(id gdnative.String) {
 }
 
 /*
-        Resets this [code]AnimationTreePlayer[/code].
-	Args: [], Returns: void
+	        Resets this [code]AnimationTreePlayer[/code].
+		Args: [], Returns: void
 */
 func (o *AnimationTreePlayer) Reset() {
 	//log.Println("Calling AnimationTreePlayer.Reset()")
@@ -1165,7 +1316,7 @@ func (o *AnimationTreePlayer) Reset() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "reset")
+	methodBind := methodBindAnimationTreePlayerReset.Get()
 
 	// Call the parent method.
 	// void
@@ -1175,8 +1326,8 @@ func (o *AnimationTreePlayer) Reset() {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *AnimationTreePlayer) SetActive(enabled gdnative.Bool) {
 	//log.Println("Calling AnimationTreePlayer.SetActive()")
@@ -1186,7 +1337,7 @@ func (o *AnimationTreePlayer) SetActive(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "set_active")
+	methodBind := methodBindAnimationTreePlayerSetActive.Get()
 
 	// Call the parent method.
 	// void
@@ -1196,18 +1347,18 @@ func (o *AnimationTreePlayer) SetActive(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.AnimationTreePlayer::AnimationProcessMode}], Returns: void
 */
-func (o *AnimationTreePlayer) SetAnimationProcessMode(mode gdnative.Int) {
+func (o *AnimationTreePlayer) SetAnimationProcessMode(mode AnimationTreePlayerAnimationProcessMode) {
 	//log.Println("Calling AnimationTreePlayer.SetAnimationProcessMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "set_animation_process_mode")
+	methodBind := methodBindAnimationTreePlayerSetAnimationProcessMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1217,8 +1368,8 @@ func (o *AnimationTreePlayer) SetAnimationProcessMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false path NodePath}], Returns: void
 */
 func (o *AnimationTreePlayer) SetBasePath(path gdnative.NodePath) {
 	//log.Println("Calling AnimationTreePlayer.SetBasePath()")
@@ -1228,7 +1379,7 @@ func (o *AnimationTreePlayer) SetBasePath(path gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "set_base_path")
+	methodBind := methodBindAnimationTreePlayerSetBasePath.Get()
 
 	// Call the parent method.
 	// void
@@ -1238,8 +1389,8 @@ func (o *AnimationTreePlayer) SetBasePath(path gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false nodepath NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false nodepath NodePath}], Returns: void
 */
 func (o *AnimationTreePlayer) SetMasterPlayer(nodepath gdnative.NodePath) {
 	//log.Println("Calling AnimationTreePlayer.SetMasterPlayer()")
@@ -1249,7 +1400,7 @@ func (o *AnimationTreePlayer) SetMasterPlayer(nodepath gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(nodepath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "set_master_player")
+	methodBind := methodBindAnimationTreePlayerSetMasterPlayer.Get()
 
 	// Call the parent method.
 	// void
@@ -1259,8 +1410,8 @@ func (o *AnimationTreePlayer) SetMasterPlayer(nodepath gdnative.NodePath) {
 }
 
 /*
-        Returns time scale value of the TimeScale node with name [code]id[/code].
-	Args: [{ false id String}], Returns: float
+	        Returns time scale value of the TimeScale node with name [code]id[/code].
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) TimescaleNodeGetScale(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.TimescaleNodeGetScale()")
@@ -1270,7 +1421,7 @@ func (o *AnimationTreePlayer) TimescaleNodeGetScale(id gdnative.String) gdnative
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "timescale_node_get_scale")
+	methodBind := methodBindAnimationTreePlayerTimescaleNodeGetScale.Get()
 
 	// Call the parent method.
 	// float
@@ -1283,8 +1434,8 @@ func (o *AnimationTreePlayer) TimescaleNodeGetScale(id gdnative.String) gdnative
 }
 
 /*
-        Sets the time scale of the TimeScale node with name [code]id[/code] to [code]scale[/code]. The timescale node is used to speed [Animation]s up if the scale is above 1 or slow them down if it is below 1. If applied after a blend or mix, affects all input animations to that blend or mix.
-	Args: [{ false id String} { false scale float}], Returns: void
+	        Sets the time scale of the TimeScale node with name [code]id[/code] to [code]scale[/code]. The timescale node is used to speed [Animation]s up if the scale is above 1 or slow them down if it is below 1. If applied after a blend or mix, affects all input animations to that blend or mix.
+		Args: [{ false id String} { false scale float}], Returns: void
 */
 func (o *AnimationTreePlayer) TimescaleNodeSetScale(id gdnative.String, scale gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.TimescaleNodeSetScale()")
@@ -1295,7 +1446,7 @@ func (o *AnimationTreePlayer) TimescaleNodeSetScale(id gdnative.String, scale gd
 	ptrArguments[1] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "timescale_node_set_scale")
+	methodBind := methodBindAnimationTreePlayerTimescaleNodeSetScale.Get()
 
 	// Call the parent method.
 	// void
@@ -1305,8 +1456,8 @@ func (o *AnimationTreePlayer) TimescaleNodeSetScale(id gdnative.String, scale gd
 }
 
 /*
-        Sets the time seek value of the TimeSeek node with name [code]id[/code] to [code]seconds[/code] This functions as a seek in the [Animation] or the blend or mix of [Animation]s input in it.
-	Args: [{ false id String} { false seconds float}], Returns: void
+	        Sets the time seek value of the TimeSeek node with name [code]id[/code] to [code]seconds[/code] This functions as a seek in the [Animation] or the blend or mix of [Animation]s input in it.
+		Args: [{ false id String} { false seconds float}], Returns: void
 */
 func (o *AnimationTreePlayer) TimeseekNodeSeek(id gdnative.String, seconds gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.TimeseekNodeSeek()")
@@ -1317,7 +1468,7 @@ func (o *AnimationTreePlayer) TimeseekNodeSeek(id gdnative.String, seconds gdnat
 	ptrArguments[1] = gdnative.NewPointerFromReal(seconds)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "timeseek_node_seek")
+	methodBind := methodBindAnimationTreePlayerTimeseekNodeSeek.Get()
 
 	// Call the parent method.
 	// void
@@ -1327,8 +1478,8 @@ func (o *AnimationTreePlayer) TimeseekNodeSeek(id gdnative.String, seconds gdnat
 }
 
 /*
-        Deletes the input at [code]input_idx[/code] for the transition node with name [code]id[/code].
-	Args: [{ false id String} { false input_idx int}], Returns: void
+	        Deletes the input at [code]input_idx[/code] for the transition node with name [code]id[/code].
+		Args: [{ false id String} { false input_idx int}], Returns: void
 */
 func (o *AnimationTreePlayer) TransitionNodeDeleteInput(id gdnative.String, inputIdx gdnative.Int) {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeDeleteInput()")
@@ -1339,7 +1490,7 @@ func (o *AnimationTreePlayer) TransitionNodeDeleteInput(id gdnative.String, inpu
 	ptrArguments[1] = gdnative.NewPointerFromInt(inputIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_delete_input")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeDeleteInput.Get()
 
 	// Call the parent method.
 	// void
@@ -1349,8 +1500,8 @@ func (o *AnimationTreePlayer) TransitionNodeDeleteInput(id gdnative.String, inpu
 }
 
 /*
-        Returns the index of the currently evaluated input for the transition node with name [code]id[/code].
-	Args: [{ false id String}], Returns: int
+	        Returns the index of the currently evaluated input for the transition node with name [code]id[/code].
+		Args: [{ false id String}], Returns: int
 */
 func (o *AnimationTreePlayer) TransitionNodeGetCurrent(id gdnative.String) gdnative.Int {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeGetCurrent()")
@@ -1360,7 +1511,7 @@ func (o *AnimationTreePlayer) TransitionNodeGetCurrent(id gdnative.String) gdnat
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_get_current")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeGetCurrent.Get()
 
 	// Call the parent method.
 	// int
@@ -1373,8 +1524,8 @@ func (o *AnimationTreePlayer) TransitionNodeGetCurrent(id gdnative.String) gdnat
 }
 
 /*
-        Returns the number of inputs for the transition node with name [code]id[/code]. You can add inputs by rightclicking on the transition node.
-	Args: [{ false id String}], Returns: int
+	        Returns the number of inputs for the transition node with name [code]id[/code]. You can add inputs by rightclicking on the transition node.
+		Args: [{ false id String}], Returns: int
 */
 func (o *AnimationTreePlayer) TransitionNodeGetInputCount(id gdnative.String) gdnative.Int {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeGetInputCount()")
@@ -1384,7 +1535,7 @@ func (o *AnimationTreePlayer) TransitionNodeGetInputCount(id gdnative.String) gd
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_get_input_count")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeGetInputCount.Get()
 
 	// Call the parent method.
 	// int
@@ -1397,8 +1548,8 @@ func (o *AnimationTreePlayer) TransitionNodeGetInputCount(id gdnative.String) gd
 }
 
 /*
-        Returns the cross fade time for the transition node with name [code]id[/code].
-	Args: [{ false id String}], Returns: float
+	        Returns the cross fade time for the transition node with name [code]id[/code].
+		Args: [{ false id String}], Returns: float
 */
 func (o *AnimationTreePlayer) TransitionNodeGetXfadeTime(id gdnative.String) gdnative.Real {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeGetXfadeTime()")
@@ -1408,7 +1559,7 @@ func (o *AnimationTreePlayer) TransitionNodeGetXfadeTime(id gdnative.String) gdn
 	ptrArguments[0] = gdnative.NewPointerFromString(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_get_xfade_time")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeGetXfadeTime.Get()
 
 	// Call the parent method.
 	// float
@@ -1421,8 +1572,8 @@ func (o *AnimationTreePlayer) TransitionNodeGetXfadeTime(id gdnative.String) gdn
 }
 
 /*
-        Returns [code]true[/code] if the input at [code]input_idx[/code] on transition node with name [code]id[/code] is set to automatically advance to the next input upon completion.
-	Args: [{ false id String} { false input_idx int}], Returns: bool
+	        Returns [code]true[/code] if the input at [code]input_idx[/code] on transition node with name [code]id[/code] is set to automatically advance to the next input upon completion.
+		Args: [{ false id String} { false input_idx int}], Returns: bool
 */
 func (o *AnimationTreePlayer) TransitionNodeHasInputAutoAdvance(id gdnative.String, inputIdx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeHasInputAutoAdvance()")
@@ -1433,7 +1584,7 @@ func (o *AnimationTreePlayer) TransitionNodeHasInputAutoAdvance(id gdnative.Stri
 	ptrArguments[1] = gdnative.NewPointerFromInt(inputIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_has_input_auto_advance")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeHasInputAutoAdvance.Get()
 
 	// Call the parent method.
 	// bool
@@ -1446,8 +1597,8 @@ func (o *AnimationTreePlayer) TransitionNodeHasInputAutoAdvance(id gdnative.Stri
 }
 
 /*
-        The transition node with name [code]id[/code] sets its current input at [code]input_idx[/code].
-	Args: [{ false id String} { false input_idx int}], Returns: void
+	        The transition node with name [code]id[/code] sets its current input at [code]input_idx[/code].
+		Args: [{ false id String} { false input_idx int}], Returns: void
 */
 func (o *AnimationTreePlayer) TransitionNodeSetCurrent(id gdnative.String, inputIdx gdnative.Int) {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeSetCurrent()")
@@ -1458,7 +1609,7 @@ func (o *AnimationTreePlayer) TransitionNodeSetCurrent(id gdnative.String, input
 	ptrArguments[1] = gdnative.NewPointerFromInt(inputIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_set_current")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeSetCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -1468,8 +1619,8 @@ func (o *AnimationTreePlayer) TransitionNodeSetCurrent(id gdnative.String, input
 }
 
 /*
-        The transition node with name [code]id[/code] advances to its next input automatically when the input at [code]input_idx[/code] completes.
-	Args: [{ false id String} { false input_idx int} { false enable bool}], Returns: void
+	        The transition node with name [code]id[/code] advances to its next input automatically when the input at [code]input_idx[/code] completes.
+		Args: [{ false id String} { false input_idx int} { false enable bool}], Returns: void
 */
 func (o *AnimationTreePlayer) TransitionNodeSetInputAutoAdvance(id gdnative.String, inputIdx gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeSetInputAutoAdvance()")
@@ -1481,7 +1632,7 @@ func (o *AnimationTreePlayer) TransitionNodeSetInputAutoAdvance(id gdnative.Stri
 	ptrArguments[2] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_set_input_auto_advance")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeSetInputAutoAdvance.Get()
 
 	// Call the parent method.
 	// void
@@ -1491,8 +1642,8 @@ func (o *AnimationTreePlayer) TransitionNodeSetInputAutoAdvance(id gdnative.Stri
 }
 
 /*
-        Resizes the number of inputs available for the transition node with name [code]id[/code].
-	Args: [{ false id String} { false count int}], Returns: void
+	        Resizes the number of inputs available for the transition node with name [code]id[/code].
+		Args: [{ false id String} { false count int}], Returns: void
 */
 func (o *AnimationTreePlayer) TransitionNodeSetInputCount(id gdnative.String, count gdnative.Int) {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeSetInputCount()")
@@ -1503,7 +1654,7 @@ func (o *AnimationTreePlayer) TransitionNodeSetInputCount(id gdnative.String, co
 	ptrArguments[1] = gdnative.NewPointerFromInt(count)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_set_input_count")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeSetInputCount.Get()
 
 	// Call the parent method.
 	// void
@@ -1513,8 +1664,8 @@ func (o *AnimationTreePlayer) TransitionNodeSetInputCount(id gdnative.String, co
 }
 
 /*
-        The transition node with name [code]id[/code] sets its cross fade time to [code]time_sec[/code].
-	Args: [{ false id String} { false time_sec float}], Returns: void
+	        The transition node with name [code]id[/code] sets its cross fade time to [code]time_sec[/code].
+		Args: [{ false id String} { false time_sec float}], Returns: void
 */
 func (o *AnimationTreePlayer) TransitionNodeSetXfadeTime(id gdnative.String, timeSec gdnative.Real) {
 	//log.Println("Calling AnimationTreePlayer.TransitionNodeSetXfadeTime()")
@@ -1525,7 +1676,7 @@ func (o *AnimationTreePlayer) TransitionNodeSetXfadeTime(id gdnative.String, tim
 	ptrArguments[1] = gdnative.NewPointerFromReal(timeSec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimationTreePlayer", "transition_node_set_xfade_time")
+	methodBind := methodBindAnimationTreePlayerTransitionNodeSetXfadeTime.Get()
 
 	// Call the parent method.
 	// void
@@ -1534,10 +1685,35 @@ func (o *AnimationTreePlayer) TransitionNodeSetXfadeTime(id gdnative.String, tim
 
 }
 
+// Active will return the value of the "active" property.
+func (o *AnimationTreePlayer) Active() gdnative.Bool {
+	return o.IsActive()
+}
+
+// BasePath will return the value of the "base_path" property.
+func (o *AnimationTreePlayer) BasePath() gdnative.NodePath {
+	return o.GetBasePath()
+}
+
+// MasterPlayer will return the value of the "master_player" property.
+func (o *AnimationTreePlayer) MasterPlayer() gdnative.NodePath {
+	return o.GetMasterPlayer()
+}
+
+// PlaybackProcessMode will return the value of the "playback_process_mode" property.
+func (o *AnimationTreePlayer) PlaybackProcessMode() AnimationTreePlayerAnimationProcessMode {
+	return o.GetAnimationProcessMode()
+}
+
+// SetPlaybackProcessMode will set the value of the "playback_process_mode" property.
+func (o *AnimationTreePlayer) SetPlaybackProcessMode(value AnimationTreePlayerAnimationProcessMode) {
+	o.SetAnimationProcessMode(value)
+}
+
 // AnimationTreePlayerImplementer is an interface that implements the methods
 // of the AnimationTreePlayer class.
 type AnimationTreePlayerImplementer interface {
-	NodeImplementer
+	godot.NodeImplementer
 	AddNode(aType gdnative.Int, id gdnative.String)
 	Advance(delta gdnative.Real)
 	AnimationNodeGetAnimation(id gdnative.String) AnimationImplementer
@@ -1553,7 +1729,9 @@ type AnimationTreePlayerImplementer interface {
 	Blend3NodeSetAmount(id gdnative.String, blend gdnative.Real)
 	Blend4NodeGetAmount(id gdnative.String) gdnative.Vector2
 	Blend4NodeSetAmount(id gdnative.String, blend gdnative.Vector2)
+	ConnectNodes(id gdnative.String, dstId gdnative.String, dstInputIdx gdnative.Int) gdnative.Error
 	DisconnectNodes(id gdnative.String, dstInputIdx gdnative.Int)
+	GetAnimationProcessMode() AnimationTreePlayerAnimationProcessMode
 	GetBasePath() gdnative.NodePath
 	GetMasterPlayer() gdnative.NodePath
 	GetNodeList() gdnative.PoolStringArray
@@ -1564,6 +1742,8 @@ type AnimationTreePlayerImplementer interface {
 	NodeGetInputCount(id gdnative.String) gdnative.Int
 	NodeGetInputSource(id gdnative.String, idx gdnative.Int) gdnative.String
 	NodeGetPosition(id gdnative.String) gdnative.Vector2
+	NodeGetType(id gdnative.String) AnimationTreePlayerNodeType
+	NodeRename(node gdnative.String, newName gdnative.String) gdnative.Error
 	NodeSetPosition(id gdnative.String, screenPosition gdnative.Vector2)
 	OneshotNodeGetAutorestartDelay(id gdnative.String) gdnative.Real
 	OneshotNodeGetAutorestartRandomDelay(id gdnative.String) gdnative.Real
@@ -1583,7 +1763,7 @@ type AnimationTreePlayerImplementer interface {
 	RemoveNode(id gdnative.String)
 	Reset()
 	SetActive(enabled gdnative.Bool)
-	SetAnimationProcessMode(mode gdnative.Int)
+	SetAnimationProcessMode(mode AnimationTreePlayerAnimationProcessMode)
 	SetBasePath(path gdnative.NodePath)
 	SetMasterPlayer(nodepath gdnative.NodePath)
 	TimescaleNodeGetScale(id gdnative.String) gdnative.Real
@@ -1598,4 +1778,9 @@ type AnimationTreePlayerImplementer interface {
 	TransitionNodeSetInputAutoAdvance(id gdnative.String, inputIdx gdnative.Int, enable gdnative.Bool)
 	TransitionNodeSetInputCount(id gdnative.String, count gdnative.Int)
 	TransitionNodeSetXfadeTime(id gdnative.String, timeSec gdnative.Real)
+	Active() gdnative.Bool
+	BasePath() gdnative.NodePath
+	MasterPlayer() gdnative.NodePath
+	PlaybackProcessMode() AnimationTreePlayerAnimationProcessMode
+	SetPlaybackProcessMode(value AnimationTreePlayerAnimationProcessMode)
 }
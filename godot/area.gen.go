@@ -1,1213 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-// AreaSpaceOverride is an enum for SpaceOverride values.
-type AreaSpaceOverride int
-
-const (
-	AreaSpaceOverrideCombine        AreaSpaceOverride = 1
-	AreaSpaceOverrideCombineReplace AreaSpaceOverride = 2
-	AreaSpaceOverrideDisabled       AreaSpaceOverride = 0
-	AreaSpaceOverrideReplace        AreaSpaceOverride = 3
-	AreaSpaceOverrideReplaceCombine AreaSpaceOverride = 4
-)
-
-//func NewAreaFromPointer(ptr gdnative.Pointer) Area {
-func newAreaFromPointer(ptr gdnative.Pointer) Area {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Area{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-3D area that detects [CollisionObject] nodes overlapping, entering, or exiting. Can also alter or override local physics parameters (gravity, damping).
-*/
-type Area struct {
-	CollisionObject
-	owner gdnative.Object
-}
-
-func (o *Area) BaseClass() string {
-	return "Area"
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area) X_AreaEnterTree(id gdnative.Int) {
-	//log.Println("Calling Area.X_AreaEnterTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "_area_enter_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area) X_AreaExitTree(id gdnative.Int) {
-	//log.Println("Calling Area.X_AreaExitTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "_area_exit_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
-*/
-func (o *Area) X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
-	//log.Println("Calling Area.X_AreaInout()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 5, 5)
-	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
-	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
-	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
-	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "_area_inout")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area) X_BodyEnterTree(id gdnative.Int) {
-	//log.Println("Calling Area.X_BodyEnterTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "_body_enter_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area) X_BodyExitTree(id gdnative.Int) {
-	//log.Println("Calling Area.X_BodyExitTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "_body_exit_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
-*/
-func (o *Area) X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
-	//log.Println("Calling Area.X_BodyInout()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 5, 5)
-	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
-	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
-	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
-	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "_body_inout")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area) GetAngularDamp() gdnative.Real {
-	//log.Println("Calling Area.GetAngularDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_angular_damp")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: String
-*/
-func (o *Area) GetAudioBus() gdnative.String {
-	//log.Println("Calling Area.GetAudioBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_audio_bus")
-
-	// Call the parent method.
-	// String
-	retPtr := gdnative.NewEmptyString()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewStringFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: int
-*/
-func (o *Area) GetCollisionLayer() gdnative.Int {
-	//log.Println("Calling Area.GetCollisionLayer()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_collision_layer")
-
-	// Call the parent method.
-	// int
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Returns an individual bit on the layer mask.
-	Args: [{ false bit int}], Returns: bool
-*/
-func (o *Area) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
-	//log.Println("Calling Area.GetCollisionLayerBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_collision_layer_bit")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: int
-*/
-func (o *Area) GetCollisionMask() gdnative.Int {
-	//log.Println("Calling Area.GetCollisionMask()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_collision_mask")
-
-	// Call the parent method.
-	// int
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Returns an individual bit on the collision mask.
-	Args: [{ false bit int}], Returns: bool
-*/
-func (o *Area) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
-	//log.Println("Calling Area.GetCollisionMaskBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_collision_mask_bit")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area) GetGravity() gdnative.Real {
-	//log.Println("Calling Area.GetGravity()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_gravity")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area) GetGravityDistanceScale() gdnative.Real {
-	//log.Println("Calling Area.GetGravityDistanceScale()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_gravity_distance_scale")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Vector3
-*/
-func (o *Area) GetGravityVector() gdnative.Vector3 {
-	//log.Println("Calling Area.GetGravityVector()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_gravity_vector")
-
-	// Call the parent method.
-	// Vector3
-	retPtr := gdnative.NewEmptyVector3()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVector3FromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area) GetLinearDamp() gdnative.Real {
-	//log.Println("Calling Area.GetLinearDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_linear_damp")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Returns a list of intersecting [code]Area[/code]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
-	Args: [], Returns: Array
-*/
-func (o *Area) GetOverlappingAreas() gdnative.Array {
-	//log.Println("Calling Area.GetOverlappingAreas()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_overlapping_areas")
-
-	// Call the parent method.
-	// Array
-	retPtr := gdnative.NewEmptyArray()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewArrayFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Returns a list of intersecting [PhysicsBody]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
-	Args: [], Returns: Array
-*/
-func (o *Area) GetOverlappingBodies() gdnative.Array {
-	//log.Println("Calling Area.GetOverlappingBodies()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_overlapping_bodies")
-
-	// Call the parent method.
-	// Array
-	retPtr := gdnative.NewEmptyArray()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewArrayFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area) GetPriority() gdnative.Real {
-	//log.Println("Calling Area.GetPriority()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_priority")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area) GetReverbAmount() gdnative.Real {
-	//log.Println("Calling Area.GetReverbAmount()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_reverb_amount")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: String
-*/
-func (o *Area) GetReverbBus() gdnative.String {
-	//log.Println("Calling Area.GetReverbBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_reverb_bus")
-
-	// Call the parent method.
-	// String
-	retPtr := gdnative.NewEmptyString()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewStringFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area) GetReverbUniformity() gdnative.Real {
-	//log.Println("Calling Area.GetReverbUniformity()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_reverb_uniformity")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: enum.Area::SpaceOverride
-*/
-func (o *Area) GetSpaceOverrideMode() AreaSpaceOverride {
-	//log.Println("Calling Area.GetSpaceOverrideMode()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "get_space_override_mode")
-
-	// Call the parent method.
-	// enum.Area::SpaceOverride
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return AreaSpaceOverride(ret)
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area) IsGravityAPoint() gdnative.Bool {
-	//log.Println("Calling Area.IsGravityAPoint()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "is_gravity_a_point")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area) IsMonitorable() gdnative.Bool {
-	//log.Println("Calling Area.IsMonitorable()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "is_monitorable")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area) IsMonitoring() gdnative.Bool {
-	//log.Println("Calling Area.IsMonitoring()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "is_monitoring")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area) IsOverridingAudioBus() gdnative.Bool {
-	//log.Println("Calling Area.IsOverridingAudioBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "is_overriding_audio_bus")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area) IsUsingReverbBus() gdnative.Bool {
-	//log.Println("Calling Area.IsUsingReverbBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "is_using_reverb_bus")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        If [code]true[/code] the given area overlaps the Area. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
-	Args: [{ false area Object}], Returns: bool
-*/
-func (o *Area) OverlapsArea(area ObjectImplementer) gdnative.Bool {
-	//log.Println("Calling Area.OverlapsArea()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(area.GetBaseObject())
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "overlaps_area")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        If [code]true[/code] the given body overlaps the Area. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
-	Args: [{ false body Object}], Returns: bool
-*/
-func (o *Area) OverlapsBody(body ObjectImplementer) gdnative.Bool {
-	//log.Println("Calling Area.OverlapsBody()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(body.GetBaseObject())
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "overlaps_body")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [{ false angular_damp float}], Returns: void
-*/
-func (o *Area) SetAngularDamp(angularDamp gdnative.Real) {
-	//log.Println("Calling Area.SetAngularDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(angularDamp)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_angular_damp")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false name String}], Returns: void
-*/
-func (o *Area) SetAudioBus(name gdnative.String) {
-	//log.Println("Calling Area.SetAudioBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(name)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_audio_bus")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area) SetAudioBusOverride(enable gdnative.Bool) {
-	//log.Println("Calling Area.SetAudioBusOverride()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_audio_bus_override")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false collision_layer int}], Returns: void
-*/
-func (o *Area) SetCollisionLayer(collisionLayer gdnative.Int) {
-	//log.Println("Calling Area.SetCollisionLayer()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(collisionLayer)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_collision_layer")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Set/clear individual bits on the layer mask. This simplifies editing this [code]Area[code]'s layers.
-	Args: [{ false bit int} { false value bool}], Returns: void
-*/
-func (o *Area) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
-	//log.Println("Calling Area.SetCollisionLayerBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-	ptrArguments[1] = gdnative.NewPointerFromBool(value)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_collision_layer_bit")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false collision_mask int}], Returns: void
-*/
-func (o *Area) SetCollisionMask(collisionMask gdnative.Int) {
-	//log.Println("Calling Area.SetCollisionMask()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(collisionMask)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_collision_mask")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Set/clear individual bits on the collision mask. This simplifies editing which [code]Area[/code] layers this [code]Area[/code] scans.
-	Args: [{ false bit int} { false value bool}], Returns: void
-*/
-func (o *Area) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
-	//log.Println("Calling Area.SetCollisionMaskBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-	ptrArguments[1] = gdnative.NewPointerFromBool(value)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_collision_mask_bit")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false gravity float}], Returns: void
-*/
-func (o *Area) SetGravity(gravity gdnative.Real) {
-	//log.Println("Calling Area.SetGravity()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(gravity)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_gravity")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false distance_scale float}], Returns: void
-*/
-func (o *Area) SetGravityDistanceScale(distanceScale gdnative.Real) {
-	//log.Println("Calling Area.SetGravityDistanceScale()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(distanceScale)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_gravity_distance_scale")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area) SetGravityIsPoint(enable gdnative.Bool) {
-	//log.Println("Calling Area.SetGravityIsPoint()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_gravity_is_point")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false vector Vector3}], Returns: void
-*/
-func (o *Area) SetGravityVector(vector gdnative.Vector3) {
-	//log.Println("Calling Area.SetGravityVector()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromVector3(vector)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_gravity_vector")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false linear_damp float}], Returns: void
-*/
-func (o *Area) SetLinearDamp(linearDamp gdnative.Real) {
-	//log.Println("Calling Area.SetLinearDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(linearDamp)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_linear_damp")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area) SetMonitorable(enable gdnative.Bool) {
-	//log.Println("Calling Area.SetMonitorable()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_monitorable")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area) SetMonitoring(enable gdnative.Bool) {
-	//log.Println("Calling Area.SetMonitoring()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_monitoring")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false priority float}], Returns: void
-*/
-func (o *Area) SetPriority(priority gdnative.Real) {
-	//log.Println("Calling Area.SetPriority()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(priority)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_priority")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
-*/
-func (o *Area) SetReverbAmount(amount gdnative.Real) {
-	//log.Println("Calling Area.SetReverbAmount()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_reverb_amount")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false name String}], Returns: void
-*/
-func (o *Area) SetReverbBus(name gdnative.String) {
-	//log.Println("Calling Area.SetReverbBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(name)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_reverb_bus")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
-*/
-func (o *Area) SetReverbUniformity(amount gdnative.Real) {
-	//log.Println("Calling Area.SetReverbUniformity()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_reverb_uniformity")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable int}], Returns: void
-*/
-func (o *Area) SetSpaceOverrideMode(enable gdnative.Int) {
-	//log.Println("Calling Area.SetSpaceOverrideMode()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_space_override_mode")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area) SetUseReverbBus(enable gdnative.Bool) {
-	//log.Println("Calling Area.SetUseReverbBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area", "set_use_reverb_bus")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-// AreaImplementer is an interface that implements the methods
-// of the Area class.
-type AreaImplementer interface {
-	CollisionObjectImplementer
-	X_AreaEnterTree(id gdnative.Int)
-	X_AreaExitTree(id gdnative.Int)
-	X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
-	X_BodyEnterTree(id gdnative.Int)
-	X_BodyExitTree(id gdnative.Int)
-	X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
-	GetAngularDamp() gdnative.Real
-	GetAudioBus() gdnative.String
-	GetCollisionLayer() gdnative.Int
-	GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool
-	GetCollisionMask() gdnative.Int
-	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
-	GetGravity() gdnative.Real
-	GetGravityDistanceScale() gdnative.Real
-	GetGravityVector() gdnative.Vector3
-	GetLinearDamp() gdnative.Real
-	GetOverlappingAreas() gdnative.Array
-	GetOverlappingBodies() gdnative.Array
-	GetPriority() gdnative.Real
-	GetReverbAmount() gdnative.Real
-	GetReverbBus() gdnative.String
-	GetReverbUniformity() gdnative.Real
-	IsGravityAPoint() gdnative.Bool
-	IsMonitorable() gdnative.Bool
-	IsMonitoring() gdnative.Bool
-	IsOverridingAudioBus() gdnative.Bool
-	IsUsingReverbBus() gdnative.Bool
-	OverlapsArea(area ObjectImplementer) gdnative.Bool
-	OverlapsBody(body ObjectImplementer) gdnative.Bool
-	SetAngularDamp(angularDamp gdnative.Real)
-	SetAudioBus(name gdnative.String)
-	SetAudioBusOverride(enable gdnative.Bool)
-	SetCollisionLayer(collisionLayer gdnative.Int)
-	SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool)
-	SetCollisionMask(collisionMask gdnative.Int)
-	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
-	SetGravity(gravity gdnative.Real)
-	SetGravityDistanceScale(distanceScale gdnative.Real)
-	SetGravityIsPoint(enable gdnative.Bool)
-	SetGravityVector(vector gdnative.Vector3)
-	SetLinearDamp(linearDamp gdnative.Real)
-	SetMonitorable(enable gdnative.Bool)
-	SetMonitoring(enable gdnative.Bool)
-	SetPriority(priority gdnative.Real)
-	SetReverbAmount(amount gdnative.Real)
-	SetReverbBus(name gdnative.String)
-	SetReverbUniformity(amount gdnative.Real)
-	SetSpaceOverrideMode(enable gdnative.Int)
-	SetUseReverbBus(enable gdnative.Bool)
-}
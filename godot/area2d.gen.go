@@ -1,1029 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-// Area2DSpaceOverride is an enum for SpaceOverride values.
-type Area2DSpaceOverride int
-
-const (
-	Area2DSpaceOverrideCombine        Area2DSpaceOverride = 1
-	Area2DSpaceOverrideCombineReplace Area2DSpaceOverride = 2
-	Area2DSpaceOverrideDisabled       Area2DSpaceOverride = 0
-	Area2DSpaceOverrideReplace        Area2DSpaceOverride = 3
-	Area2DSpaceOverrideReplaceCombine Area2DSpaceOverride = 4
-)
-
-//func NewArea2DFromPointer(ptr gdnative.Pointer) Area2D {
-func newArea2DFromPointer(ptr gdnative.Pointer) Area2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Area2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-2D area that detects [CollisionObject2D] nodes overlapping, entering, or exiting. Can also alter or override local physics parameters (gravity, damping).
-*/
-type Area2D struct {
-	CollisionObject2D
-	owner gdnative.Object
-}
-
-func (o *Area2D) BaseClass() string {
-	return "Area2D"
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area2D) X_AreaEnterTree(id gdnative.Int) {
-	//log.Println("Calling Area2D.X_AreaEnterTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "_area_enter_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area2D) X_AreaExitTree(id gdnative.Int) {
-	//log.Println("Calling Area2D.X_AreaExitTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "_area_exit_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
-*/
-func (o *Area2D) X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
-	//log.Println("Calling Area2D.X_AreaInout()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 5, 5)
-	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
-	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
-	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
-	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "_area_inout")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area2D) X_BodyEnterTree(id gdnative.Int) {
-	//log.Println("Calling Area2D.X_BodyEnterTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "_body_enter_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false id int}], Returns: void
-*/
-func (o *Area2D) X_BodyExitTree(id gdnative.Int) {
-	//log.Println("Calling Area2D.X_BodyExitTree()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "_body_exit_tree")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
-*/
-func (o *Area2D) X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
-	//log.Println("Calling Area2D.X_BodyInout()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 5, 5)
-	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
-	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
-	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
-	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "_body_inout")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area2D) GetAngularDamp() gdnative.Real {
-	//log.Println("Calling Area2D.GetAngularDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_angular_damp")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: String
-*/
-func (o *Area2D) GetAudioBusName() gdnative.String {
-	//log.Println("Calling Area2D.GetAudioBusName()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_audio_bus_name")
-
-	// Call the parent method.
-	// String
-	retPtr := gdnative.NewEmptyString()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewStringFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: int
-*/
-func (o *Area2D) GetCollisionLayer() gdnative.Int {
-	//log.Println("Calling Area2D.GetCollisionLayer()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_collision_layer")
-
-	// Call the parent method.
-	// int
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Return an individual bit on the layer mask. Describes whether other areas will collide with this one on the given layer.
-	Args: [{ false bit int}], Returns: bool
-*/
-func (o *Area2D) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
-	//log.Println("Calling Area2D.GetCollisionLayerBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_collision_layer_bit")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: int
-*/
-func (o *Area2D) GetCollisionMask() gdnative.Int {
-	//log.Println("Calling Area2D.GetCollisionMask()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_collision_mask")
-
-	// Call the parent method.
-	// int
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Return an individual bit on the collision mask. Describes whether this area will collide with others on the given layer.
-	Args: [{ false bit int}], Returns: bool
-*/
-func (o *Area2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
-	//log.Println("Calling Area2D.GetCollisionMaskBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_collision_mask_bit")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area2D) GetGravity() gdnative.Real {
-	//log.Println("Calling Area2D.GetGravity()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_gravity")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area2D) GetGravityDistanceScale() gdnative.Real {
-	//log.Println("Calling Area2D.GetGravityDistanceScale()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_gravity_distance_scale")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Vector2
-*/
-func (o *Area2D) GetGravityVector() gdnative.Vector2 {
-	//log.Println("Calling Area2D.GetGravityVector()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_gravity_vector")
-
-	// Call the parent method.
-	// Vector2
-	retPtr := gdnative.NewEmptyVector2()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVector2FromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area2D) GetLinearDamp() gdnative.Real {
-	//log.Println("Calling Area2D.GetLinearDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_linear_damp")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Returns a list of intersecting [code]Area2D[/code]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
-	Args: [], Returns: Array
-*/
-func (o *Area2D) GetOverlappingAreas() gdnative.Array {
-	//log.Println("Calling Area2D.GetOverlappingAreas()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_overlapping_areas")
-
-	// Call the parent method.
-	// Array
-	retPtr := gdnative.NewEmptyArray()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewArrayFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Returns a list of intersecting [PhysicsBody2D]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
-	Args: [], Returns: Array
-*/
-func (o *Area2D) GetOverlappingBodies() gdnative.Array {
-	//log.Println("Calling Area2D.GetOverlappingBodies()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_overlapping_bodies")
-
-	// Call the parent method.
-	// Array
-	retPtr := gdnative.NewEmptyArray()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewArrayFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: float
-*/
-func (o *Area2D) GetPriority() gdnative.Real {
-	//log.Println("Calling Area2D.GetPriority()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_priority")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: enum.Area2D::SpaceOverride
-*/
-func (o *Area2D) GetSpaceOverrideMode() Area2DSpaceOverride {
-	//log.Println("Calling Area2D.GetSpaceOverrideMode()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "get_space_override_mode")
-
-	// Call the parent method.
-	// enum.Area2D::SpaceOverride
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return Area2DSpaceOverride(ret)
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area2D) IsGravityAPoint() gdnative.Bool {
-	//log.Println("Calling Area2D.IsGravityAPoint()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "is_gravity_a_point")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area2D) IsMonitorable() gdnative.Bool {
-	//log.Println("Calling Area2D.IsMonitorable()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "is_monitorable")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area2D) IsMonitoring() gdnative.Bool {
-	//log.Println("Calling Area2D.IsMonitoring()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "is_monitoring")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: bool
-*/
-func (o *Area2D) IsOverridingAudioBus() gdnative.Bool {
-	//log.Println("Calling Area2D.IsOverridingAudioBus()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "is_overriding_audio_bus")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        If [code]true[/code] the given area overlaps the Area2D. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
-	Args: [{ false area Object}], Returns: bool
-*/
-func (o *Area2D) OverlapsArea(area ObjectImplementer) gdnative.Bool {
-	//log.Println("Calling Area2D.OverlapsArea()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(area.GetBaseObject())
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "overlaps_area")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        If [code]true[/code] the given body overlaps the Area2D. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
-	Args: [{ false body Object}], Returns: bool
-*/
-func (o *Area2D) OverlapsBody(body ObjectImplementer) gdnative.Bool {
-	//log.Println("Calling Area2D.OverlapsBody()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(body.GetBaseObject())
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "overlaps_body")
-
-	// Call the parent method.
-	// bool
-	retPtr := gdnative.NewEmptyBool()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewBoolFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [{ false angular_damp float}], Returns: void
-*/
-func (o *Area2D) SetAngularDamp(angularDamp gdnative.Real) {
-	//log.Println("Calling Area2D.SetAngularDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(angularDamp)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_angular_damp")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false name String}], Returns: void
-*/
-func (o *Area2D) SetAudioBusName(name gdnative.String) {
-	//log.Println("Calling Area2D.SetAudioBusName()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(name)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_audio_bus_name")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area2D) SetAudioBusOverride(enable gdnative.Bool) {
-	//log.Println("Calling Area2D.SetAudioBusOverride()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_audio_bus_override")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false collision_layer int}], Returns: void
-*/
-func (o *Area2D) SetCollisionLayer(collisionLayer gdnative.Int) {
-	//log.Println("Calling Area2D.SetCollisionLayer()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(collisionLayer)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_collision_layer")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Set/clear individual bits on the layer mask. This makes getting an area in/out of only one layer easier.
-	Args: [{ false bit int} { false value bool}], Returns: void
-*/
-func (o *Area2D) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
-	//log.Println("Calling Area2D.SetCollisionLayerBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-	ptrArguments[1] = gdnative.NewPointerFromBool(value)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_collision_layer_bit")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false collision_mask int}], Returns: void
-*/
-func (o *Area2D) SetCollisionMask(collisionMask gdnative.Int) {
-	//log.Println("Calling Area2D.SetCollisionMask()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(collisionMask)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_collision_mask")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Set/clear individual bits on the collision mask. This makes selecting the areas scanned easier.
-	Args: [{ false bit int} { false value bool}], Returns: void
-*/
-func (o *Area2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
-	//log.Println("Calling Area2D.SetCollisionMaskBit()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
-	ptrArguments[1] = gdnative.NewPointerFromBool(value)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_collision_mask_bit")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false gravity float}], Returns: void
-*/
-func (o *Area2D) SetGravity(gravity gdnative.Real) {
-	//log.Println("Calling Area2D.SetGravity()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(gravity)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_gravity")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false distance_scale float}], Returns: void
-*/
-func (o *Area2D) SetGravityDistanceScale(distanceScale gdnative.Real) {
-	//log.Println("Calling Area2D.SetGravityDistanceScale()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(distanceScale)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_gravity_distance_scale")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area2D) SetGravityIsPoint(enable gdnative.Bool) {
-	//log.Println("Calling Area2D.SetGravityIsPoint()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_gravity_is_point")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false vector Vector2}], Returns: void
-*/
-func (o *Area2D) SetGravityVector(vector gdnative.Vector2) {
-	//log.Println("Calling Area2D.SetGravityVector()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromVector2(vector)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_gravity_vector")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false linear_damp float}], Returns: void
-*/
-func (o *Area2D) SetLinearDamp(linearDamp gdnative.Real) {
-	//log.Println("Calling Area2D.SetLinearDamp()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(linearDamp)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_linear_damp")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area2D) SetMonitorable(enable gdnative.Bool) {
-	//log.Println("Calling Area2D.SetMonitorable()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_monitorable")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
-*/
-func (o *Area2D) SetMonitoring(enable gdnative.Bool) {
-	//log.Println("Calling Area2D.SetMonitoring()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_monitoring")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false priority float}], Returns: void
-*/
-func (o *Area2D) SetPriority(priority gdnative.Real) {
-	//log.Println("Calling Area2D.SetPriority()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromReal(priority)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_priority")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false space_override_mode int}], Returns: void
-*/
-func (o *Area2D) SetSpaceOverrideMode(spaceOverrideMode gdnative.Int) {
-	//log.Println("Calling Area2D.SetSpaceOverrideMode()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(spaceOverrideMode)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Area2D", "set_space_override_mode")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-// Area2DImplementer is an interface that implements the methods
-// of the Area2D class.
-type Area2DImplementer interface {
-	CollisionObject2DImplementer
-	X_AreaEnterTree(id gdnative.Int)
-	X_AreaExitTree(id gdnative.Int)
-	X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
-	X_BodyEnterTree(id gdnative.Int)
-	X_BodyExitTree(id gdnative.Int)
-	X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
-	GetAngularDamp() gdnative.Real
-	GetAudioBusName() gdnative.String
-	GetCollisionLayer() gdnative.Int
-	GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool
-	GetCollisionMask() gdnative.Int
-	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
-	GetGravity() gdnative.Real
-	GetGravityDistanceScale() gdnative.Real
-	GetGravityVector() gdnative.Vector2
-	GetLinearDamp() gdnative.Real
-	GetOverlappingAreas() gdnative.Array
-	GetOverlappingBodies() gdnative.Array
-	GetPriority() gdnative.Real
-	IsGravityAPoint() gdnative.Bool
-	IsMonitorable() gdnative.Bool
-	IsMonitoring() gdnative.Bool
-	IsOverridingAudioBus() gdnative.Bool
-	OverlapsArea(area ObjectImplementer) gdnative.Bool
-	OverlapsBody(body ObjectImplementer) gdnative.Bool
-	SetAngularDamp(angularDamp gdnative.Real)
-	SetAudioBusName(name gdnative.String)
-	SetAudioBusOverride(enable gdnative.Bool)
-	SetCollisionLayer(collisionLayer gdnative.Int)
-	SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool)
-	SetCollisionMask(collisionMask gdnative.Int)
-	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
-	SetGravity(gravity gdnative.Real)
-	SetGravityDistanceScale(distanceScale gdnative.Real)
-	SetGravityIsPoint(enable gdnative.Bool)
-	SetGravityVector(vector gdnative.Vector2)
-	SetLinearDamp(linearDamp gdnative.Real)
-	SetMonitorable(enable gdnative.Bool)
-	SetMonitoring(enable gdnative.Bool)
-	SetPriority(priority gdnative.Real)
-	SetSpaceOverrideMode(spaceOverrideMode gdnative.Int)
-}
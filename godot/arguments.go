@@ -0,0 +1,123 @@
+package godot
+
+import (
+	"fmt"
+	"github.com/shadowapex/godot-go/gdnative"
+	"reflect"
+	"strings"
+)
+
+// ArgumentDefaulter is an interface for classes registered with AutoRegister that
+// have methods with optional trailing arguments. When Godot calls a method with
+// fewer arguments than it takes, the missing arguments are filled in with their
+// default values.
+type ArgumentDefaulter interface {
+	// ArgDefaults returns the default values of the last arguments of methods,
+	// keyed by Go method name. The values must be assignable or convertible to
+	// the types of the arguments.
+	ArgDefaults() map[string][]interface{}
+}
+
+// classArgDefaults will return the argument default values of the methods of the
+// given class, or an empty map if it does not implement ArgumentDefaulter.
+func classArgDefaults(class Class) map[string][]interface{} {
+	if defaulter, ok := class.(ArgumentDefaulter); ok {
+		if defaults := defaulter.ArgDefaults(); defaults != nil {
+			return defaults
+		}
+	}
+	return map[string][]interface{}{}
+}
+
+// setDefaults will convert the given default values into the types of the last
+// arguments of the method, and use them for arguments that Godot does not pass.
+func (m *registeredMethod) setDefaults(defaults []interface{}) error {
+	params := m.params()
+	if len(defaults) > len(params) {
+		return fmt.Errorf("got %d default values for %d arguments", len(defaults), len(params))
+	}
+
+	values := make([]reflect.Value, len(defaults))
+	for i, value := range defaults {
+		argType := params[len(params)-len(defaults)+i]
+		defaultValue := reflect.ValueOf(value)
+		switch {
+		case value == nil:
+			defaultValue = reflect.Zero(argType)
+		case defaultValue.Type().AssignableTo(argType):
+		case defaultValue.Type().ConvertibleTo(argType):
+			defaultValue = defaultValue.Convert(argType)
+		default:
+			return fmt.Errorf("default value %v of type %T can't be used for an argument of type %s", value, value, argType)
+		}
+		values[i] = defaultValue
+	}
+	m.defaults = values
+
+	return nil
+}
+
+// params will return the types of the arguments Godot passes to the method,
+// without the receiver and the variadic argument.
+func (m *registeredMethod) params() []reflect.Type {
+	params := m.arguments[1:]
+	if m.method.Type.IsVariadic() {
+		params = params[:len(params)-1]
+	}
+	return params
+}
+
+// callArgs will convert the arguments passed by Godot into the arguments of the
+// method. Missing trailing arguments are filled in with their default values,
+// and surplus arguments are passed to the variadic argument of the method.
+func (m *registeredMethod) callArgs(args []gdnative.Variant) ([]reflect.Value, error) {
+	params := m.params()
+	required := len(params) - len(m.defaults)
+	variadic := m.method.Type.IsVariadic()
+	if len(args) < required || (!variadic && len(args) > len(params)) {
+		return nil, fmt.Errorf("expected %s, got %d arguments", m.signature(), len(args))
+	}
+
+	goArgs := make([]reflect.Value, 0, len(args)+len(m.defaults))
+	for i, arg := range args {
+		var argType reflect.Type
+		if i < len(params) {
+			argType = params[i]
+		} else {
+			argType = m.arguments[len(m.arguments)-1].Elem()
+		}
+		goArg, err := decodeValue(arg, argType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d: %s", i, err)
+		}
+		goArgs = append(goArgs, goArg)
+	}
+
+	// Fill in the default values of the arguments that were not passed.
+	for i := len(args); i < len(params); i++ {
+		goArgs = append(goArgs, m.defaults[i-required])
+	}
+
+	return goArgs, nil
+}
+
+// signature will return a description of the arguments the method takes, such
+// as "Log(gdnative.String, gdnative.Int = 1, ...gdnative.Variant)".
+func (m *registeredMethod) signature() string {
+	params := m.params()
+	required := len(params) - len(m.defaults)
+
+	parts := []string{}
+	for i, argType := range params {
+		if i < required {
+			parts = append(parts, argType.String())
+		} else {
+			parts = append(parts, fmt.Sprintf("%s = %v", argType, m.defaults[i-required]))
+		}
+	}
+	if m.method.Type.IsVariadic() {
+		parts = append(parts, "..."+m.arguments[len(m.arguments)-1].Elem().String())
+	}
+
+	return m.method.Name + "(" + strings.Join(parts, ", ") + ")"
+}
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -28,6 +30,56 @@ const (
 	ArrayMeshArrayFormatWeights ArrayMeshArrayFormat = 128
 )
 
+// String will return the Godot name of the ArrayMeshArrayFormat value.
+func (e ArrayMeshArrayFormat) String() string {
+	switch e {
+	case ArrayMeshArrayFormatBones:
+		return "ARRAY_FORMAT_BONES"
+	case ArrayMeshArrayFormatColor:
+		return "ARRAY_FORMAT_COLOR"
+	case ArrayMeshArrayFormatIndex:
+		return "ARRAY_FORMAT_INDEX"
+	case ArrayMeshArrayFormatNormal:
+		return "ARRAY_FORMAT_NORMAL"
+	case ArrayMeshArrayFormatTangent:
+		return "ARRAY_FORMAT_TANGENT"
+	case ArrayMeshArrayFormatTexUv:
+		return "ARRAY_FORMAT_TEX_UV"
+	case ArrayMeshArrayFormatTexUv2:
+		return "ARRAY_FORMAT_TEX_UV2"
+	case ArrayMeshArrayFormatVertex:
+		return "ARRAY_FORMAT_VERTEX"
+	case ArrayMeshArrayFormatWeights:
+		return "ARRAY_FORMAT_WEIGHTS"
+	}
+	return fmt.Sprintf("ArrayMeshArrayFormat(%d)", int(e))
+}
+
+// ParseArrayMeshArrayFormat will return the ArrayMeshArrayFormat value with the given Godot name.
+func ParseArrayMeshArrayFormat(name string) (ArrayMeshArrayFormat, error) {
+	switch name {
+	case "ARRAY_FORMAT_BONES":
+		return ArrayMeshArrayFormatBones, nil
+	case "ARRAY_FORMAT_COLOR":
+		return ArrayMeshArrayFormatColor, nil
+	case "ARRAY_FORMAT_INDEX":
+		return ArrayMeshArrayFormatIndex, nil
+	case "ARRAY_FORMAT_NORMAL":
+		return ArrayMeshArrayFormatNormal, nil
+	case "ARRAY_FORMAT_TANGENT":
+		return ArrayMeshArrayFormatTangent, nil
+	case "ARRAY_FORMAT_TEX_UV":
+		return ArrayMeshArrayFormatTexUv, nil
+	case "ARRAY_FORMAT_TEX_UV2":
+		return ArrayMeshArrayFormatTexUv2, nil
+	case "ARRAY_FORMAT_VERTEX":
+		return ArrayMeshArrayFormatVertex, nil
+	case "ARRAY_FORMAT_WEIGHTS":
+		return ArrayMeshArrayFormatWeights, nil
+	}
+	return 0, fmt.Errorf("invalid ArrayMeshArrayFormat value %q", name)
+}
+
 // ArrayMeshArrayType is an enum for ArrayType values.
 type ArrayMeshArrayType int
 
@@ -44,30 +96,126 @@ const (
 	ArrayMeshArrayWeights ArrayMeshArrayType = 7
 )
 
-//func NewArrayMeshFromPointer(ptr gdnative.Pointer) ArrayMesh {
-func newArrayMeshFromPointer(ptr gdnative.Pointer) ArrayMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ArrayMesh{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ArrayMeshArrayType value.
+func (e ArrayMeshArrayType) String() string {
+	switch e {
+	case ArrayMeshArrayBones:
+		return "ARRAY_BONES"
+	case ArrayMeshArrayColor:
+		return "ARRAY_COLOR"
+	case ArrayMeshArrayIndex:
+		return "ARRAY_INDEX"
+	case ArrayMeshArrayMax:
+		return "ARRAY_MAX"
+	case ArrayMeshArrayNormal:
+		return "ARRAY_NORMAL"
+	case ArrayMeshArrayTangent:
+		return "ARRAY_TANGENT"
+	case ArrayMeshArrayTexUv:
+		return "ARRAY_TEX_UV"
+	case ArrayMeshArrayTexUv2:
+		return "ARRAY_TEX_UV2"
+	case ArrayMeshArrayVertex:
+		return "ARRAY_VERTEX"
+	case ArrayMeshArrayWeights:
+		return "ARRAY_WEIGHTS"
+	}
+	return fmt.Sprintf("ArrayMeshArrayType(%d)", int(e))
+}
 
-	return obj
+// ParseArrayMeshArrayType will return the ArrayMeshArrayType value with the given Godot name.
+func ParseArrayMeshArrayType(name string) (ArrayMeshArrayType, error) {
+	switch name {
+	case "ARRAY_BONES":
+		return ArrayMeshArrayBones, nil
+	case "ARRAY_COLOR":
+		return ArrayMeshArrayColor, nil
+	case "ARRAY_INDEX":
+		return ArrayMeshArrayIndex, nil
+	case "ARRAY_MAX":
+		return ArrayMeshArrayMax, nil
+	case "ARRAY_NORMAL":
+		return ArrayMeshArrayNormal, nil
+	case "ARRAY_TANGENT":
+		return ArrayMeshArrayTangent, nil
+	case "ARRAY_TEX_UV":
+		return ArrayMeshArrayTexUv, nil
+	case "ARRAY_TEX_UV2":
+		return ArrayMeshArrayTexUv2, nil
+	case "ARRAY_VERTEX":
+		return ArrayMeshArrayVertex, nil
+	case "ARRAY_WEIGHTS":
+		return ArrayMeshArrayWeights, nil
+	}
+	return 0, fmt.Errorf("invalid ArrayMeshArrayType value %q", name)
 }
 
-/*
+// Constants of the ArrayMesh class.
+const (
+	ArrayMeshArrayWeightsSize gdnative.Int = 4
+	ArrayMeshNoIndexArray     gdnative.Int = -1
+)
 
+/*
  */
 type ArrayMesh struct {
 	Mesh
 	owner gdnative.Object
 }
 
+// classConstructorArrayMesh is the class constructor of the ArrayMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorArrayMesh = gdnative.NewLazyClassConstructor("ArrayMesh")
+
+// NewArrayMesh will create a new instance of the ArrayMesh class.
+// ArrayMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewArrayMesh() *ArrayMesh {
+	obj := &ArrayMesh{}
+	obj.SetBaseObject(classConstructorArrayMesh.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ArrayMesh) BaseClass() string {
 	return "ArrayMesh"
 }
 
-/*
+// Method binds of the ArrayMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindArrayMeshAddBlendShape              = gdnative.NewLazyMethodBind("ArrayMesh", "add_blend_shape")
+	methodBindArrayMeshAddSurfaceFromArrays       = gdnative.NewLazyMethodBind("ArrayMesh", "add_surface_from_arrays")
+	methodBindArrayMeshCenterGeometry             = gdnative.NewLazyMethodBind("ArrayMesh", "center_geometry")
+	methodBindArrayMeshClearBlendShapes           = gdnative.NewLazyMethodBind("ArrayMesh", "clear_blend_shapes")
+	methodBindArrayMeshGetBlendShapeCount         = gdnative.NewLazyMethodBind("ArrayMesh", "get_blend_shape_count")
+	methodBindArrayMeshGetBlendShapeMode          = gdnative.NewLazyMethodBind("ArrayMesh", "get_blend_shape_mode")
+	methodBindArrayMeshGetBlendShapeName          = gdnative.NewLazyMethodBind("ArrayMesh", "get_blend_shape_name")
+	methodBindArrayMeshGetCustomAabb              = gdnative.NewLazyMethodBind("ArrayMesh", "get_custom_aabb")
+	methodBindArrayMeshGetSurfaceCount            = gdnative.NewLazyMethodBind("ArrayMesh", "get_surface_count")
+	methodBindArrayMeshLightmapUnwrap             = gdnative.NewLazyMethodBind("ArrayMesh", "lightmap_unwrap")
+	methodBindArrayMeshRegenNormalmaps            = gdnative.NewLazyMethodBind("ArrayMesh", "regen_normalmaps")
+	methodBindArrayMeshSetBlendShapeMode          = gdnative.NewLazyMethodBind("ArrayMesh", "set_blend_shape_mode")
+	methodBindArrayMeshSetCustomAabb              = gdnative.NewLazyMethodBind("ArrayMesh", "set_custom_aabb")
+	methodBindArrayMeshSurfaceGetArrayIndexLen    = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_array_index_len")
+	methodBindArrayMeshSurfaceGetArrayLen         = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_array_len")
+	methodBindArrayMeshSurfaceGetArrays           = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_arrays")
+	methodBindArrayMeshSurfaceGetBlendShapeArrays = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_blend_shape_arrays")
+	methodBindArrayMeshSurfaceGetFormat           = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_format")
+	methodBindArrayMeshSurfaceGetMaterial         = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_material")
+	methodBindArrayMeshSurfaceGetName             = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_name")
+	methodBindArrayMeshSurfaceGetPrimitiveType    = gdnative.NewLazyMethodBind("ArrayMesh", "surface_get_primitive_type")
+	methodBindArrayMeshSurfaceRemove              = gdnative.NewLazyMethodBind("ArrayMesh", "surface_remove")
+	methodBindArrayMeshSurfaceSetMaterial         = gdnative.NewLazyMethodBind("ArrayMesh", "surface_set_material")
+	methodBindArrayMeshSurfaceSetName             = gdnative.NewLazyMethodBind("ArrayMesh", "surface_set_name")
+	methodBindArrayMeshSurfaceUpdateRegion        = gdnative.NewLazyMethodBind("ArrayMesh", "surface_update_region")
+)
 
-	Args: [{ false name String}], Returns: void
+/*
+Args: [{ false name String}], Returns: void
 */
 func (o *ArrayMesh) AddBlendShape(name gdnative.String) {
 	//log.Println("Calling ArrayMesh.AddBlendShape()")
@@ -77,7 +225,7 @@ func (o *ArrayMesh) AddBlendShape(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "add_blend_shape")
+	methodBind := methodBindArrayMeshAddBlendShape.Get()
 
 	// Call the parent method.
 	// void
@@ -87,8 +235,8 @@ func (o *ArrayMesh) AddBlendShape(name gdnative.String) {
 }
 
 /*
-        Create a new surface ([method get_surface_count] that will become surf_idx for this. Surfaces are created to be rendered using a "primitive", which may be PRIMITIVE_POINTS, PRIMITIVE_LINES, PRIMITIVE_LINE_STRIP, PRIMITIVE_LINE_LOOP, PRIMITIVE_TRIANGLES, PRIMITIVE_TRIANGLE_STRIP, PRIMITIVE_TRIANGLE_FAN. (As a note, when using indices, it is recommended to only use just points, lines or triangles).
-	Args: [{ false primitive int} { false arrays Array} {[] true blend_shapes Array} {97792 true compress_flags int}], Returns: void
+	        Create a new surface ([method get_surface_count] that will become surf_idx for this. Surfaces are created to be rendered using a "primitive", which may be PRIMITIVE_POINTS, PRIMITIVE_LINES, PRIMITIVE_LINE_STRIP, PRIMITIVE_LINE_LOOP, PRIMITIVE_TRIANGLES, PRIMITIVE_TRIANGLE_STRIP, PRIMITIVE_TRIANGLE_FAN. (As a note, when using indices, it is recommended to only use just points, lines or triangles).
+		Args: [{ false primitive int} { false arrays Array} {[] true blend_shapes Array} {97792 true compress_flags int}], Returns: void
 */
 func (o *ArrayMesh) AddSurfaceFromArrays(primitive gdnative.Int, arrays gdnative.Array, blendShapes gdnative.Array, compressFlags gdnative.Int) {
 	//log.Println("Calling ArrayMesh.AddSurfaceFromArrays()")
@@ -101,7 +249,7 @@ func (o *ArrayMesh) AddSurfaceFromArrays(primitive gdnative.Int, arrays gdnative
 	ptrArguments[3] = gdnative.NewPointerFromInt(compressFlags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "add_surface_from_arrays")
+	methodBind := methodBindArrayMeshAddSurfaceFromArrays.Get()
 
 	// Call the parent method.
 	// void
@@ -111,8 +259,7 @@ func (o *ArrayMesh) AddSurfaceFromArrays(primitive gdnative.Int, arrays gdnative
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *ArrayMesh) CenterGeometry() {
 	//log.Println("Calling ArrayMesh.CenterGeometry()")
@@ -121,7 +268,7 @@ func (o *ArrayMesh) CenterGeometry() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "center_geometry")
+	methodBind := methodBindArrayMeshCenterGeometry.Get()
 
 	// Call the parent method.
 	// void
@@ -131,8 +278,7 @@ func (o *ArrayMesh) CenterGeometry() {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *ArrayMesh) ClearBlendShapes() {
 	//log.Println("Calling ArrayMesh.ClearBlendShapes()")
@@ -141,7 +287,7 @@ func (o *ArrayMesh) ClearBlendShapes() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "clear_blend_shapes")
+	methodBind := methodBindArrayMeshClearBlendShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -151,8 +297,7 @@ func (o *ArrayMesh) ClearBlendShapes() {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *ArrayMesh) GetBlendShapeCount() gdnative.Int {
 	//log.Println("Calling ArrayMesh.GetBlendShapeCount()")
@@ -161,7 +306,7 @@ func (o *ArrayMesh) GetBlendShapeCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "get_blend_shape_count")
+	methodBind := methodBindArrayMeshGetBlendShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -174,8 +319,8 @@ func (o *ArrayMesh) GetBlendShapeCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Mesh::BlendShapeMode
+	        Undocumented
+		Args: [], Returns: enum.Mesh::BlendShapeMode
 */
 func (o *ArrayMesh) GetBlendShapeMode() MeshBlendShapeMode {
 	//log.Println("Calling ArrayMesh.GetBlendShapeMode()")
@@ -184,7 +329,7 @@ func (o *ArrayMesh) GetBlendShapeMode() MeshBlendShapeMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "get_blend_shape_mode")
+	methodBind := methodBindArrayMeshGetBlendShapeMode.Get()
 
 	// Call the parent method.
 	// enum.Mesh::BlendShapeMode
@@ -197,8 +342,7 @@ func (o *ArrayMesh) GetBlendShapeMode() MeshBlendShapeMode {
 }
 
 /*
-
-	Args: [{ false index int}], Returns: String
+Args: [{ false index int}], Returns: String
 */
 func (o *ArrayMesh) GetBlendShapeName(index gdnative.Int) gdnative.String {
 	//log.Println("Calling ArrayMesh.GetBlendShapeName()")
@@ -208,7 +352,7 @@ func (o *ArrayMesh) GetBlendShapeName(index gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "get_blend_shape_name")
+	methodBind := methodBindArrayMeshGetBlendShapeName.Get()
 
 	// Call the parent method.
 	// String
@@ -221,8 +365,8 @@ func (o *ArrayMesh) GetBlendShapeName(index gdnative.Int) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: AABB
+	        Undocumented
+		Args: [], Returns: AABB
 */
 func (o *ArrayMesh) GetCustomAabb() gdnative.Aabb {
 	//log.Println("Calling ArrayMesh.GetCustomAabb()")
@@ -231,7 +375,7 @@ func (o *ArrayMesh) GetCustomAabb() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "get_custom_aabb")
+	methodBind := methodBindArrayMeshGetCustomAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -244,8 +388,8 @@ func (o *ArrayMesh) GetCustomAabb() gdnative.Aabb {
 }
 
 /*
-        Return the amount of surfaces that the [code]ArrayMesh[/code] holds.
-	Args: [], Returns: int
+	        Return the amount of surfaces that the [code]ArrayMesh[/code] holds.
+		Args: [], Returns: int
 */
 func (o *ArrayMesh) GetSurfaceCount() gdnative.Int {
 	//log.Println("Calling ArrayMesh.GetSurfaceCount()")
@@ -254,7 +398,7 @@ func (o *ArrayMesh) GetSurfaceCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "get_surface_count")
+	methodBind := methodBindArrayMeshGetSurfaceCount.Get()
 
 	// Call the parent method.
 	// int
@@ -267,8 +411,7 @@ func (o *ArrayMesh) GetSurfaceCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false arg0 Transform} { false arg1 float}], Returns: enum.Error
+Args: [{ false arg0 Transform} { false arg1 float}], Returns: enum.Error
 */
 func (o *ArrayMesh) LightmapUnwrap(arg0 gdnative.Transform, arg1 gdnative.Real) gdnative.Error {
 	//log.Println("Calling ArrayMesh.LightmapUnwrap()")
@@ -279,7 +422,7 @@ func (o *ArrayMesh) LightmapUnwrap(arg0 gdnative.Transform, arg1 gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "lightmap_unwrap")
+	methodBind := methodBindArrayMeshLightmapUnwrap.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -292,8 +435,7 @@ func (o *ArrayMesh) LightmapUnwrap(arg0 gdnative.Transform, arg1 gdnative.Real)
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *ArrayMesh) RegenNormalmaps() {
 	//log.Println("Calling ArrayMesh.RegenNormalmaps()")
@@ -302,7 +444,7 @@ func (o *ArrayMesh) RegenNormalmaps() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "regen_normalmaps")
+	methodBind := methodBindArrayMeshRegenNormalmaps.Get()
 
 	// Call the parent method.
 	// void
@@ -312,18 +454,18 @@ func (o *ArrayMesh) RegenNormalmaps() {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Mesh::BlendShapeMode}], Returns: void
 */
-func (o *ArrayMesh) SetBlendShapeMode(mode gdnative.Int) {
+func (o *ArrayMesh) SetBlendShapeMode(mode MeshBlendShapeMode) {
 	//log.Println("Calling ArrayMesh.SetBlendShapeMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "set_blend_shape_mode")
+	methodBind := methodBindArrayMeshSetBlendShapeMode.Get()
 
 	// Call the parent method.
 	// void
@@ -333,8 +475,8 @@ func (o *ArrayMesh) SetBlendShapeMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false aabb AABB}], Returns: void
+	        Undocumented
+		Args: [{ false aabb AABB}], Returns: void
 */
 func (o *ArrayMesh) SetCustomAabb(aabb gdnative.Aabb) {
 	//log.Println("Calling ArrayMesh.SetCustomAabb()")
@@ -344,7 +486,7 @@ func (o *ArrayMesh) SetCustomAabb(aabb gdnative.Aabb) {
 	ptrArguments[0] = gdnative.NewPointerFromAabb(aabb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "set_custom_aabb")
+	methodBind := methodBindArrayMeshSetCustomAabb.Get()
 
 	// Call the parent method.
 	// void
@@ -354,8 +496,8 @@ func (o *ArrayMesh) SetCustomAabb(aabb gdnative.Aabb) {
 }
 
 /*
-        Return the length in indices of the index array in the requested surface (see [method add_surface]).
-	Args: [{ false surf_idx int}], Returns: int
+	        Return the length in indices of the index array in the requested surface (see [method add_surface]).
+		Args: [{ false surf_idx int}], Returns: int
 */
 func (o *ArrayMesh) SurfaceGetArrayIndexLen(surfIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling ArrayMesh.SurfaceGetArrayIndexLen()")
@@ -365,7 +507,7 @@ func (o *ArrayMesh) SurfaceGetArrayIndexLen(surfIdx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_array_index_len")
+	methodBind := methodBindArrayMeshSurfaceGetArrayIndexLen.Get()
 
 	// Call the parent method.
 	// int
@@ -378,8 +520,8 @@ func (o *ArrayMesh) SurfaceGetArrayIndexLen(surfIdx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return the length in vertices of the vertex array in the requested surface (see [method add_surface]).
-	Args: [{ false surf_idx int}], Returns: int
+	        Return the length in vertices of the vertex array in the requested surface (see [method add_surface]).
+		Args: [{ false surf_idx int}], Returns: int
 */
 func (o *ArrayMesh) SurfaceGetArrayLen(surfIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling ArrayMesh.SurfaceGetArrayLen()")
@@ -389,7 +531,7 @@ func (o *ArrayMesh) SurfaceGetArrayLen(surfIdx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_array_len")
+	methodBind := methodBindArrayMeshSurfaceGetArrayLen.Get()
 
 	// Call the parent method.
 	// int
@@ -402,8 +544,7 @@ func (o *ArrayMesh) SurfaceGetArrayLen(surfIdx gdnative.Int) gdnative.Int {
 }
 
 /*
-
-	Args: [{ false surf_idx int}], Returns: Array
+Args: [{ false surf_idx int}], Returns: Array
 */
 func (o *ArrayMesh) SurfaceGetArrays(surfIdx gdnative.Int) gdnative.Array {
 	//log.Println("Calling ArrayMesh.SurfaceGetArrays()")
@@ -413,7 +554,7 @@ func (o *ArrayMesh) SurfaceGetArrays(surfIdx gdnative.Int) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_arrays")
+	methodBind := methodBindArrayMeshSurfaceGetArrays.Get()
 
 	// Call the parent method.
 	// Array
@@ -426,8 +567,7 @@ func (o *ArrayMesh) SurfaceGetArrays(surfIdx gdnative.Int) gdnative.Array {
 }
 
 /*
-
-	Args: [{ false surf_idx int}], Returns: Array
+Args: [{ false surf_idx int}], Returns: Array
 */
 func (o *ArrayMesh) SurfaceGetBlendShapeArrays(surfIdx gdnative.Int) gdnative.Array {
 	//log.Println("Calling ArrayMesh.SurfaceGetBlendShapeArrays()")
@@ -437,7 +577,7 @@ func (o *ArrayMesh) SurfaceGetBlendShapeArrays(surfIdx gdnative.Int) gdnative.Ar
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_blend_shape_arrays")
+	methodBind := methodBindArrayMeshSurfaceGetBlendShapeArrays.Get()
 
 	// Call the parent method.
 	// Array
@@ -450,8 +590,8 @@ func (o *ArrayMesh) SurfaceGetBlendShapeArrays(surfIdx gdnative.Int) gdnative.Ar
 }
 
 /*
-        Return the format mask of the requested surface (see [method add_surface]).
-	Args: [{ false surf_idx int}], Returns: int
+	        Return the format mask of the requested surface (see [method add_surface]).
+		Args: [{ false surf_idx int}], Returns: int
 */
 func (o *ArrayMesh) SurfaceGetFormat(surfIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling ArrayMesh.SurfaceGetFormat()")
@@ -461,7 +601,7 @@ func (o *ArrayMesh) SurfaceGetFormat(surfIdx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_format")
+	methodBind := methodBindArrayMeshSurfaceGetFormat.Get()
 
 	// Call the parent method.
 	// int
@@ -474,8 +614,8 @@ func (o *ArrayMesh) SurfaceGetFormat(surfIdx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return a [Material] in a given surface. Surface is rendered using this material.
-	Args: [{ false surf_idx int}], Returns: Material
+	        Return a [Material] in a given surface. Surface is rendered using this material.
+		Args: [{ false surf_idx int}], Returns: Material
 */
 func (o *ArrayMesh) SurfaceGetMaterial(surfIdx gdnative.Int) MaterialImplementer {
 	//log.Println("Calling ArrayMesh.SurfaceGetMaterial()")
@@ -485,7 +625,7 @@ func (o *ArrayMesh) SurfaceGetMaterial(surfIdx gdnative.Int) MaterialImplementer
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_material")
+	methodBind := methodBindArrayMeshSurfaceGetMaterial.Get()
 
 	// Call the parent method.
 	// Material
@@ -493,27 +633,24 @@ func (o *ArrayMesh) SurfaceGetMaterial(surfIdx gdnative.Int) MaterialImplementer
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false surf_idx int}], Returns: String
+Args: [{ false surf_idx int}], Returns: String
 */
 func (o *ArrayMesh) SurfaceGetName(surfIdx gdnative.Int) gdnative.String {
 	//log.Println("Calling ArrayMesh.SurfaceGetName()")
@@ -523,7 +660,7 @@ func (o *ArrayMesh) SurfaceGetName(surfIdx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_name")
+	methodBind := methodBindArrayMeshSurfaceGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -536,8 +673,8 @@ func (o *ArrayMesh) SurfaceGetName(surfIdx gdnative.Int) gdnative.String {
 }
 
 /*
-        Return the primitive type of the requested surface (see [method add_surface]).
-	Args: [{ false surf_idx int}], Returns: enum.Mesh::PrimitiveType
+	        Return the primitive type of the requested surface (see [method add_surface]).
+		Args: [{ false surf_idx int}], Returns: enum.Mesh::PrimitiveType
 */
 func (o *ArrayMesh) SurfaceGetPrimitiveType(surfIdx gdnative.Int) MeshPrimitiveType {
 	//log.Println("Calling ArrayMesh.SurfaceGetPrimitiveType()")
@@ -547,7 +684,7 @@ func (o *ArrayMesh) SurfaceGetPrimitiveType(surfIdx gdnative.Int) MeshPrimitiveT
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_get_primitive_type")
+	methodBind := methodBindArrayMeshSurfaceGetPrimitiveType.Get()
 
 	// Call the parent method.
 	// enum.Mesh::PrimitiveType
@@ -560,8 +697,8 @@ func (o *ArrayMesh) SurfaceGetPrimitiveType(surfIdx gdnative.Int) MeshPrimitiveT
 }
 
 /*
-        Remove a surface at position surf_idx, shifting greater surfaces one surf_idx slot down.
-	Args: [{ false surf_idx int}], Returns: void
+	        Remove a surface at position surf_idx, shifting greater surfaces one surf_idx slot down.
+		Args: [{ false surf_idx int}], Returns: void
 */
 func (o *ArrayMesh) SurfaceRemove(surfIdx gdnative.Int) {
 	//log.Println("Calling ArrayMesh.SurfaceRemove()")
@@ -571,7 +708,7 @@ func (o *ArrayMesh) SurfaceRemove(surfIdx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_remove")
+	methodBind := methodBindArrayMeshSurfaceRemove.Get()
 
 	// Call the parent method.
 	// void
@@ -581,8 +718,7 @@ func (o *ArrayMesh) SurfaceRemove(surfIdx gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false surf_idx int} { false material Material}], Returns: void
+Args: [{ false surf_idx int} { false material Material}], Returns: void
 */
 func (o *ArrayMesh) SurfaceSetMaterial(surfIdx gdnative.Int, material MaterialImplementer) {
 	//log.Println("Calling ArrayMesh.SurfaceSetMaterial()")
@@ -590,10 +726,10 @@ func (o *ArrayMesh) SurfaceSetMaterial(surfIdx gdnative.Int, material MaterialIm
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(surfIdx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_set_material")
+	methodBind := methodBindArrayMeshSurfaceSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -603,8 +739,8 @@ func (o *ArrayMesh) SurfaceSetMaterial(surfIdx gdnative.Int, material MaterialIm
 }
 
 /*
-        Set a [Material] for a given surface. Surface will be rendered using this material.
-	Args: [{ false surf_idx int} { false name String}], Returns: void
+	        Set a [Material] for a given surface. Surface will be rendered using this material.
+		Args: [{ false surf_idx int} { false name String}], Returns: void
 */
 func (o *ArrayMesh) SurfaceSetName(surfIdx gdnative.Int, name gdnative.String) {
 	//log.Println("Calling ArrayMesh.SurfaceSetName()")
@@ -615,7 +751,7 @@ func (o *ArrayMesh) SurfaceSetName(surfIdx gdnative.Int, name gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_set_name")
+	methodBind := methodBindArrayMeshSurfaceSetName.Get()
 
 	// Call the parent method.
 	// void
@@ -625,8 +761,7 @@ func (o *ArrayMesh) SurfaceSetName(surfIdx gdnative.Int, name gdnative.String) {
 }
 
 /*
-
-	Args: [{ false surf_idx int} { false offset int} { false data PoolByteArray}], Returns: void
+Args: [{ false surf_idx int} { false offset int} { false data PoolByteArray}], Returns: void
 */
 func (o *ArrayMesh) SurfaceUpdateRegion(surfIdx gdnative.Int, offset gdnative.Int, data gdnative.PoolByteArray) {
 	//log.Println("Calling ArrayMesh.SurfaceUpdateRegion()")
@@ -638,7 +773,7 @@ func (o *ArrayMesh) SurfaceUpdateRegion(surfIdx gdnative.Int, offset gdnative.In
 	ptrArguments[2] = gdnative.NewPointerFromPoolByteArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ArrayMesh", "surface_update_region")
+	methodBind := methodBindArrayMeshSurfaceUpdateRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -647,6 +782,23 @@ func (o *ArrayMesh) SurfaceUpdateRegion(surfIdx gdnative.Int, offset gdnative.In
 
 }
 
+// AddSurfaceFromArraysWithDefaults will call AddSurfaceFromArrays using the default values for: blend_shapes, compress_flags.
+func (o *ArrayMesh) AddSurfaceFromArraysWithDefaults(primitive gdnative.Int, arrays gdnative.Array) {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	o.AddSurfaceFromArrays(primitive, arrays, default0, 97792)
+}
+
+// BlendShapeMode will return the value of the "blend_shape_mode" property.
+func (o *ArrayMesh) BlendShapeMode() MeshBlendShapeMode {
+	return o.GetBlendShapeMode()
+}
+
+// CustomAabb will return the value of the "custom_aabb" property.
+func (o *ArrayMesh) CustomAabb() gdnative.Aabb {
+	return o.GetCustomAabb()
+}
+
 // ArrayMeshImplementer is an interface that implements the methods
 // of the ArrayMesh class.
 type ArrayMeshImplementer interface {
@@ -656,11 +808,13 @@ type ArrayMeshImplementer interface {
 	CenterGeometry()
 	ClearBlendShapes()
 	GetBlendShapeCount() gdnative.Int
+	GetBlendShapeMode() MeshBlendShapeMode
 	GetBlendShapeName(index gdnative.Int) gdnative.String
 	GetCustomAabb() gdnative.Aabb
 	GetSurfaceCount() gdnative.Int
+	LightmapUnwrap(arg0 gdnative.Transform, arg1 gdnative.Real) gdnative.Error
 	RegenNormalmaps()
-	SetBlendShapeMode(mode gdnative.Int)
+	SetBlendShapeMode(mode MeshBlendShapeMode)
 	SetCustomAabb(aabb gdnative.Aabb)
 	SurfaceGetArrayIndexLen(surfIdx gdnative.Int) gdnative.Int
 	SurfaceGetArrayLen(surfIdx gdnative.Int) gdnative.Int
@@ -669,8 +823,12 @@ type ArrayMeshImplementer interface {
 	SurfaceGetFormat(surfIdx gdnative.Int) gdnative.Int
 	SurfaceGetMaterial(surfIdx gdnative.Int) MaterialImplementer
 	SurfaceGetName(surfIdx gdnative.Int) gdnative.String
+	SurfaceGetPrimitiveType(surfIdx gdnative.Int) MeshPrimitiveType
 	SurfaceRemove(surfIdx gdnative.Int)
 	SurfaceSetMaterial(surfIdx gdnative.Int, material MaterialImplementer)
 	SurfaceSetName(surfIdx gdnative.Int, name gdnative.String)
 	SurfaceUpdateRegion(surfIdx gdnative.Int, offset gdnative.Int, data gdnative.PoolByteArray)
+	AddSurfaceFromArraysWithDefaults(primitive gdnative.Int, arrays gdnative.Array)
+	BlendShapeMode() MeshBlendShapeMode
+	CustomAabb() gdnative.Aabb
 }
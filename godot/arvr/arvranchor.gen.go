@@ -1,7 +1,8 @@
-package godot
+package arvr
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewARVRAnchorFromPointer(ptr gdnative.Pointer) ARVRAnchor {
-func newARVRAnchorFromPointer(ptr gdnative.Pointer) ARVRAnchor {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ARVRAnchor{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 The ARVR Anchor point is a spatial node that maps a real world location identified by the AR platform to a position within the game world. For example, as long as plane detection in ARKit is on, ARKit will identify and update the position of planes (tables, floors, etc) and create anchors for them. This node is mapped to one of the anchors through its unique id. When you receive a signal that a new anchor is available you should add this node to your scene for that anchor. You can predefine nodes and set the id and the nodes will simply remain on 0,0,0 until a plane is recognised. Keep in mind that as long as plane detection is enable the size, placing and orientation of an anchor will be updates as the detection logic learns more about the real world out there especially if only part of the surface is in view.
 */
 type ARVRAnchor struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorARVRAnchor is the class constructor of the ARVRAnchor class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorARVRAnchor = gdnative.NewLazyClassConstructor("ARVRAnchor")
+
+// NewARVRAnchor will create a new instance of the ARVRAnchor class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewARVRAnchor() *ARVRAnchor {
+	obj := &ARVRAnchor{}
+	obj.SetBaseObject(classConstructorARVRAnchor.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ARVRAnchor) BaseClass() string {
 	return "ARVRAnchor"
 }
 
+// Method binds of the ARVRAnchor class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindARVRAnchorGetAnchorId   = gdnative.NewLazyMethodBind("ARVRAnchor", "get_anchor_id")
+	methodBindARVRAnchorGetAnchorName = gdnative.NewLazyMethodBind("ARVRAnchor", "get_anchor_name")
+	methodBindARVRAnchorGetIsActive   = gdnative.NewLazyMethodBind("ARVRAnchor", "get_is_active")
+	methodBindARVRAnchorGetPlane      = gdnative.NewLazyMethodBind("ARVRAnchor", "get_plane")
+	methodBindARVRAnchorGetSize       = gdnative.NewLazyMethodBind("ARVRAnchor", "get_size")
+	methodBindARVRAnchorSetAnchorId   = gdnative.NewLazyMethodBind("ARVRAnchor", "set_anchor_id")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ARVRAnchor) GetAnchorId() gdnative.Int {
 	//log.Println("Calling ARVRAnchor.GetAnchorId()")
@@ -45,7 +62,7 @@ func (o *ARVRAnchor) GetAnchorId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRAnchor", "get_anchor_id")
+	methodBind := methodBindARVRAnchorGetAnchorId.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +75,8 @@ func (o *ARVRAnchor) GetAnchorId() gdnative.Int {
 }
 
 /*
-        Returns the name given to this anchor.
-	Args: [], Returns: String
+	        Returns the name given to this anchor.
+		Args: [], Returns: String
 */
 func (o *ARVRAnchor) GetAnchorName() gdnative.String {
 	//log.Println("Calling ARVRAnchor.GetAnchorName()")
@@ -68,7 +85,7 @@ func (o *ARVRAnchor) GetAnchorName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRAnchor", "get_anchor_name")
+	methodBind := methodBindARVRAnchorGetAnchorName.Get()
 
 	// Call the parent method.
 	// String
@@ -81,8 +98,8 @@ func (o *ARVRAnchor) GetAnchorName() gdnative.String {
 }
 
 /*
-        Returns true if the anchor is being tracked and false if no anchor with this id is currently known.
-	Args: [], Returns: bool
+	        Returns true if the anchor is being tracked and false if no anchor with this id is currently known.
+		Args: [], Returns: bool
 */
 func (o *ARVRAnchor) GetIsActive() gdnative.Bool {
 	//log.Println("Calling ARVRAnchor.GetIsActive()")
@@ -91,7 +108,7 @@ func (o *ARVRAnchor) GetIsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRAnchor", "get_is_active")
+	methodBind := methodBindARVRAnchorGetIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +121,8 @@ func (o *ARVRAnchor) GetIsActive() gdnative.Bool {
 }
 
 /*
-        Returns a plane aligned with our anchor, handy for intersection testing
-	Args: [], Returns: Plane
+	        Returns a plane aligned with our anchor, handy for intersection testing
+		Args: [], Returns: Plane
 */
 func (o *ARVRAnchor) GetPlane() gdnative.Plane {
 	//log.Println("Calling ARVRAnchor.GetPlane()")
@@ -114,7 +131,7 @@ func (o *ARVRAnchor) GetPlane() gdnative.Plane {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRAnchor", "get_plane")
+	methodBind := methodBindARVRAnchorGetPlane.Get()
 
 	// Call the parent method.
 	// Plane
@@ -127,8 +144,8 @@ func (o *ARVRAnchor) GetPlane() gdnative.Plane {
 }
 
 /*
-        Returns the estimated size of the plane that was detected. Say when the anchor relates to a table in the real world, this is the estimated size of the surface of that table.
-	Args: [], Returns: Vector3
+	        Returns the estimated size of the plane that was detected. Say when the anchor relates to a table in the real world, this is the estimated size of the surface of that table.
+		Args: [], Returns: Vector3
 */
 func (o *ARVRAnchor) GetSize() gdnative.Vector3 {
 	//log.Println("Calling ARVRAnchor.GetSize()")
@@ -137,7 +154,7 @@ func (o *ARVRAnchor) GetSize() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRAnchor", "get_size")
+	methodBind := methodBindARVRAnchorGetSize.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -150,8 +167,8 @@ func (o *ARVRAnchor) GetSize() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [{ false anchor_id int}], Returns: void
+	        Undocumented
+		Args: [{ false anchor_id int}], Returns: void
 */
 func (o *ARVRAnchor) SetAnchorId(anchorId gdnative.Int) {
 	//log.Println("Calling ARVRAnchor.SetAnchorId()")
@@ -161,7 +178,7 @@ func (o *ARVRAnchor) SetAnchorId(anchorId gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(anchorId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRAnchor", "set_anchor_id")
+	methodBind := methodBindARVRAnchorSetAnchorId.Get()
 
 	// Call the parent method.
 	// void
@@ -170,14 +187,20 @@ func (o *ARVRAnchor) SetAnchorId(anchorId gdnative.Int) {
 
 }
 
+// AnchorId will return the value of the "anchor_id" property.
+func (o *ARVRAnchor) AnchorId() gdnative.Int {
+	return o.GetAnchorId()
+}
+
 // ARVRAnchorImplementer is an interface that implements the methods
 // of the ARVRAnchor class.
 type ARVRAnchorImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetAnchorId() gdnative.Int
 	GetAnchorName() gdnative.String
 	GetIsActive() gdnative.Bool
 	GetPlane() gdnative.Plane
 	GetSize() gdnative.Vector3
 	SetAnchorId(anchorId gdnative.Int)
+	AnchorId() gdnative.Int
 }
@@ -1,7 +1,8 @@
-package godot
+package arvr
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,28 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewARVRCameraFromPointer(ptr gdnative.Pointer) ARVRCamera {
-func newARVRCameraFromPointer(ptr gdnative.Pointer) ARVRCamera {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ARVRCamera{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This is a helper spatial node for our camera, note that if stereoscopic rendering is applicable (VR-HMD) most of the camera properties are ignored as the HMD information overrides them. The only properties that can be trusted are the near and far planes. The position and orientation of this node is automatically updated by the ARVR Server to represent the location of the HMD if such tracking is available and can thus be used by game logic. Note that in contrast to the ARVR Controller the render thread has access to the most up to date tracking data of the HMD and the location of the ARVRCamera can lag a few milliseconds behind what is used for rendering as a result.
 */
 type ARVRCamera struct {
-	Camera
+	godot.Camera
 	owner gdnative.Object
 }
 
+// classConstructorARVRCamera is the class constructor of the ARVRCamera class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorARVRCamera = gdnative.NewLazyClassConstructor("ARVRCamera")
+
+// NewARVRCamera will create a new instance of the ARVRCamera class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewARVRCamera() *ARVRCamera {
+	obj := &ARVRCamera{}
+	obj.SetBaseObject(classConstructorARVRCamera.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ARVRCamera) BaseClass() string {
 	return "ARVRCamera"
 }
@@ -37,5 +43,5 @@ func (o *ARVRCamera) BaseClass() string {
 // ARVRCameraImplementer is an interface that implements the methods
 // of the ARVRCamera class.
 type ARVRCameraImplementer interface {
-	CameraImplementer
+	godot.CameraImplementer
 }
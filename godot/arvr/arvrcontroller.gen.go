@@ -1,7 +1,8 @@
-package godot
+package arvr
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,50 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewARVRControllerFromPointer(ptr gdnative.Pointer) ARVRController {
-func newARVRControllerFromPointer(ptr gdnative.Pointer) ARVRController {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ARVRController{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This is a helper spatial node that is linked to the tracking of controllers. It also offers several handy pass throughs to the state of buttons and such on the controllers. Controllers are linked by their id. You can create controller nodes before the controllers are available. Say your game always uses two controllers (one for each hand) you can predefine the controllers with id 1 and 2 and they will become active as soon as the controllers are identified. If you expect additional controllers to be used you should react to the signals and add ARVRController nodes to your scene. The position of the controller node is automatically updated by the ARVR Server. This makes this node ideal to add child nodes to visualise the controller.
 */
 type ARVRController struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorARVRController is the class constructor of the ARVRController class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorARVRController = gdnative.NewLazyClassConstructor("ARVRController")
+
+// NewARVRController will create a new instance of the ARVRController class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewARVRController() *ARVRController {
+	obj := &ARVRController{}
+	obj.SetBaseObject(classConstructorARVRController.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ARVRController) BaseClass() string {
 	return "ARVRController"
 }
 
+// Method binds of the ARVRController class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindARVRControllerGetControllerId   = gdnative.NewLazyMethodBind("ARVRController", "get_controller_id")
+	methodBindARVRControllerGetControllerName = gdnative.NewLazyMethodBind("ARVRController", "get_controller_name")
+	methodBindARVRControllerGetHand           = gdnative.NewLazyMethodBind("ARVRController", "get_hand")
+	methodBindARVRControllerGetIsActive       = gdnative.NewLazyMethodBind("ARVRController", "get_is_active")
+	methodBindARVRControllerGetJoystickAxis   = gdnative.NewLazyMethodBind("ARVRController", "get_joystick_axis")
+	methodBindARVRControllerGetJoystickId     = gdnative.NewLazyMethodBind("ARVRController", "get_joystick_id")
+	methodBindARVRControllerGetRumble         = gdnative.NewLazyMethodBind("ARVRController", "get_rumble")
+	methodBindARVRControllerIsButtonPressed   = gdnative.NewLazyMethodBind("ARVRController", "is_button_pressed")
+	methodBindARVRControllerSetControllerId   = gdnative.NewLazyMethodBind("ARVRController", "set_controller_id")
+	methodBindARVRControllerSetRumble         = gdnative.NewLazyMethodBind("ARVRController", "set_rumble")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ARVRController) GetControllerId() gdnative.Int {
 	//log.Println("Calling ARVRController.GetControllerId()")
@@ -45,7 +66,7 @@ func (o *ARVRController) GetControllerId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "get_controller_id")
+	methodBind := methodBindARVRControllerGetControllerId.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +79,8 @@ func (o *ARVRController) GetControllerId() gdnative.Int {
 }
 
 /*
-        If active, returns the name of the associated controller if provided by the AR/VR SDK used.
-	Args: [], Returns: String
+	        If active, returns the name of the associated controller if provided by the AR/VR SDK used.
+		Args: [], Returns: String
 */
 func (o *ARVRController) GetControllerName() gdnative.String {
 	//log.Println("Calling ARVRController.GetControllerName()")
@@ -68,7 +89,7 @@ func (o *ARVRController) GetControllerName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "get_controller_name")
+	methodBind := methodBindARVRControllerGetControllerName.Get()
 
 	// Call the parent method.
 	// String
@@ -81,8 +102,8 @@ func (o *ARVRController) GetControllerName() gdnative.String {
 }
 
 /*
-        Returns the hand holding this controller, if known. See TRACKER_* constants in [ARVRPositionalTracker].
-	Args: [], Returns: enum.ARVRPositionalTracker::TrackerHand
+	        Returns the hand holding this controller, if known. See TRACKER_* constants in [ARVRPositionalTracker].
+		Args: [], Returns: enum.ARVRPositionalTracker::TrackerHand
 */
 func (o *ARVRController) GetHand() ARVRPositionalTrackerTrackerHand {
 	//log.Println("Calling ARVRController.GetHand()")
@@ -91,7 +112,7 @@ func (o *ARVRController) GetHand() ARVRPositionalTrackerTrackerHand {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "get_hand")
+	methodBind := methodBindARVRControllerGetHand.Get()
 
 	// Call the parent method.
 	// enum.ARVRPositionalTracker::TrackerHand
@@ -104,8 +125,8 @@ func (o *ARVRController) GetHand() ARVRPositionalTrackerTrackerHand {
 }
 
 /*
-        Returns [code]true[/code] if the bound controller is active. ARVR systems attempt to track active controllers.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the bound controller is active. ARVR systems attempt to track active controllers.
+		Args: [], Returns: bool
 */
 func (o *ARVRController) GetIsActive() gdnative.Bool {
 	//log.Println("Calling ARVRController.GetIsActive()")
@@ -114,7 +135,7 @@ func (o *ARVRController) GetIsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "get_is_active")
+	methodBind := methodBindARVRControllerGetIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -127,8 +148,8 @@ func (o *ARVRController) GetIsActive() gdnative.Bool {
 }
 
 /*
-        Returns the value of the given axis for things like triggers, touchpads, etc. that are embedded into the controller.
-	Args: [{ false axis int}], Returns: float
+	        Returns the value of the given axis for things like triggers, touchpads, etc. that are embedded into the controller.
+		Args: [{ false axis int}], Returns: float
 */
 func (o *ARVRController) GetJoystickAxis(axis gdnative.Int) gdnative.Real {
 	//log.Println("Calling ARVRController.GetJoystickAxis()")
@@ -138,7 +159,7 @@ func (o *ARVRController) GetJoystickAxis(axis gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "get_joystick_axis")
+	methodBind := methodBindARVRControllerGetJoystickAxis.Get()
 
 	// Call the parent method.
 	// float
@@ -151,8 +172,8 @@ func (o *ARVRController) GetJoystickAxis(axis gdnative.Int) gdnative.Real {
 }
 
 /*
-        Returns the ID of the joystick object bound to this. Every controller tracked by the ARVR Server that has buttons and axis will also be registered as a joystick within Godot. This means that all the normal joystick tracking and input mapping will work for buttons and axis found on the AR/VR controllers. This ID is purely offered as information so you can link up the controller with its joystick entry.
-	Args: [], Returns: int
+	        Returns the ID of the joystick object bound to this. Every controller tracked by the ARVR Server that has buttons and axis will also be registered as a joystick within Godot. This means that all the normal joystick tracking and input mapping will work for buttons and axis found on the AR/VR controllers. This ID is purely offered as information so you can link up the controller with its joystick entry.
+		Args: [], Returns: int
 */
 func (o *ARVRController) GetJoystickId() gdnative.Int {
 	//log.Println("Calling ARVRController.GetJoystickId()")
@@ -161,7 +182,7 @@ func (o *ARVRController) GetJoystickId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "get_joystick_id")
+	methodBind := methodBindARVRControllerGetJoystickId.Get()
 
 	// Call the parent method.
 	// int
@@ -174,8 +195,8 @@ func (o *ARVRController) GetJoystickId() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ARVRController) GetRumble() gdnative.Real {
 	//log.Println("Calling ARVRController.GetRumble()")
@@ -184,7 +205,7 @@ func (o *ARVRController) GetRumble() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "get_rumble")
+	methodBind := methodBindARVRControllerGetRumble.Get()
 
 	// Call the parent method.
 	// float
@@ -197,8 +218,8 @@ func (o *ARVRController) GetRumble() gdnative.Real {
 }
 
 /*
-        Returns [code]true[/code] if the button at index [code]button[/code] is pressed.
-	Args: [{ false button int}], Returns: int
+	        Returns [code]true[/code] if the button at index [code]button[/code] is pressed.
+		Args: [{ false button int}], Returns: int
 */
 func (o *ARVRController) IsButtonPressed(button gdnative.Int) gdnative.Int {
 	//log.Println("Calling ARVRController.IsButtonPressed()")
@@ -208,7 +229,7 @@ func (o *ARVRController) IsButtonPressed(button gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(button)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "is_button_pressed")
+	methodBind := methodBindARVRControllerIsButtonPressed.Get()
 
 	// Call the parent method.
 	// int
@@ -221,8 +242,8 @@ func (o *ARVRController) IsButtonPressed(button gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false controller_id int}], Returns: void
+	        Undocumented
+		Args: [{ false controller_id int}], Returns: void
 */
 func (o *ARVRController) SetControllerId(controllerId gdnative.Int) {
 	//log.Println("Calling ARVRController.SetControllerId()")
@@ -232,7 +253,7 @@ func (o *ARVRController) SetControllerId(controllerId gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(controllerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "set_controller_id")
+	methodBind := methodBindARVRControllerSetControllerId.Get()
 
 	// Call the parent method.
 	// void
@@ -242,8 +263,8 @@ func (o *ARVRController) SetControllerId(controllerId gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rumble float}], Returns: void
+	        Undocumented
+		Args: [{ false rumble float}], Returns: void
 */
 func (o *ARVRController) SetRumble(rumble gdnative.Real) {
 	//log.Println("Calling ARVRController.SetRumble()")
@@ -253,7 +274,7 @@ func (o *ARVRController) SetRumble(rumble gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(rumble)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRController", "set_rumble")
+	methodBind := methodBindARVRControllerSetRumble.Get()
 
 	// Call the parent method.
 	// void
@@ -262,12 +283,75 @@ func (o *ARVRController) SetRumble(rumble gdnative.Real) {
 
 }
 
+// ControllerId will return the value of the "controller_id" property.
+func (o *ARVRController) ControllerId() gdnative.Int {
+	return o.GetControllerId()
+}
+
+// Rumble will return the value of the "rumble" property.
+func (o *ARVRController) Rumble() gdnative.Real {
+	return o.GetRumble()
+}
+
+// Signals of the ARVRController class.
+const (
+	ARVRControllerSignalButtonPressed gdnative.String = "button_pressed"
+	ARVRControllerSignalButtonRelease gdnative.String = "button_release"
+)
+
+// ARVRControllerButtonPressedArgs holds the arguments of the "button_pressed" signal.
+type ARVRControllerButtonPressedArgs struct {
+	Button gdnative.Int
+}
+
+// ConnectButtonPressed will connect the "button_pressed" signal to the given method of the target object.
+func (o *ARVRController) ConnectButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ARVRControllerSignalButtonPressed, target, method, binds, 0)
+}
+
+// EmitButtonPressed will emit the "button_pressed" signal.
+func (o *ARVRController) EmitButtonPressed(args ARVRControllerButtonPressedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Button))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ARVRControllerSignalButtonPressed, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ARVRControllerButtonReleaseArgs holds the arguments of the "button_release" signal.
+type ARVRControllerButtonReleaseArgs struct {
+	Button gdnative.Int
+}
+
+// ConnectButtonRelease will connect the "button_release" signal to the given method of the target object.
+func (o *ARVRController) ConnectButtonRelease(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ARVRControllerSignalButtonRelease, target, method, binds, 0)
+}
+
+// EmitButtonRelease will emit the "button_release" signal.
+func (o *ARVRController) EmitButtonRelease(args ARVRControllerButtonReleaseArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Button))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ARVRControllerSignalButtonRelease, arg0)
+	ret.Destroy()
+	return err
+}
+
 // ARVRControllerImplementer is an interface that implements the methods
 // of the ARVRController class.
 type ARVRControllerImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetControllerId() gdnative.Int
 	GetControllerName() gdnative.String
+	GetHand() ARVRPositionalTrackerTrackerHand
 	GetIsActive() gdnative.Bool
 	GetJoystickAxis(axis gdnative.Int) gdnative.Real
 	GetJoystickId() gdnative.Int
@@ -275,4 +359,10 @@ type ARVRControllerImplementer interface {
 	IsButtonPressed(button gdnative.Int) gdnative.Int
 	SetControllerId(controllerId gdnative.Int)
 	SetRumble(rumble gdnative.Real)
+	ControllerId() gdnative.Int
+	Rumble() gdnative.Real
+	ConnectButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitButtonPressed(args ARVRControllerButtonPressedArgs) error
+	ConnectButtonRelease(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitButtonRelease(args ARVRControllerButtonReleaseArgs) error
 }
@@ -0,0 +1,517 @@
+package arvr
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// ARVRInterfaceCapabilities is an enum for Capabilities values.
+type ARVRInterfaceCapabilities int
+
+const (
+	ARVRInterfaceArvrAr       ARVRInterfaceCapabilities = 4
+	ARVRInterfaceArvrExternal ARVRInterfaceCapabilities = 8
+	ARVRInterfaceArvrMono     ARVRInterfaceCapabilities = 1
+	ARVRInterfaceArvrNone     ARVRInterfaceCapabilities = 0
+	ARVRInterfaceArvrStereo   ARVRInterfaceCapabilities = 2
+)
+
+// String will return the Godot name of the ARVRInterfaceCapabilities value.
+func (e ARVRInterfaceCapabilities) String() string {
+	switch e {
+	case ARVRInterfaceArvrAr:
+		return "ARVR_AR"
+	case ARVRInterfaceArvrExternal:
+		return "ARVR_EXTERNAL"
+	case ARVRInterfaceArvrMono:
+		return "ARVR_MONO"
+	case ARVRInterfaceArvrNone:
+		return "ARVR_NONE"
+	case ARVRInterfaceArvrStereo:
+		return "ARVR_STEREO"
+	}
+	return fmt.Sprintf("ARVRInterfaceCapabilities(%d)", int(e))
+}
+
+// ParseARVRInterfaceCapabilities will return the ARVRInterfaceCapabilities value with the given Godot name.
+func ParseARVRInterfaceCapabilities(name string) (ARVRInterfaceCapabilities, error) {
+	switch name {
+	case "ARVR_AR":
+		return ARVRInterfaceArvrAr, nil
+	case "ARVR_EXTERNAL":
+		return ARVRInterfaceArvrExternal, nil
+	case "ARVR_MONO":
+		return ARVRInterfaceArvrMono, nil
+	case "ARVR_NONE":
+		return ARVRInterfaceArvrNone, nil
+	case "ARVR_STEREO":
+		return ARVRInterfaceArvrStereo, nil
+	}
+	return 0, fmt.Errorf("invalid ARVRInterfaceCapabilities value %q", name)
+}
+
+// ARVRInterfaceEyes is an enum for Eyes values.
+type ARVRInterfaceEyes int
+
+const (
+	ARVRInterfaceEyeLeft  ARVRInterfaceEyes = 1
+	ARVRInterfaceEyeMono  ARVRInterfaceEyes = 0
+	ARVRInterfaceEyeRight ARVRInterfaceEyes = 2
+)
+
+// String will return the Godot name of the ARVRInterfaceEyes value.
+func (e ARVRInterfaceEyes) String() string {
+	switch e {
+	case ARVRInterfaceEyeLeft:
+		return "EYE_LEFT"
+	case ARVRInterfaceEyeMono:
+		return "EYE_MONO"
+	case ARVRInterfaceEyeRight:
+		return "EYE_RIGHT"
+	}
+	return fmt.Sprintf("ARVRInterfaceEyes(%d)", int(e))
+}
+
+// ParseARVRInterfaceEyes will return the ARVRInterfaceEyes value with the given Godot name.
+func ParseARVRInterfaceEyes(name string) (ARVRInterfaceEyes, error) {
+	switch name {
+	case "EYE_LEFT":
+		return ARVRInterfaceEyeLeft, nil
+	case "EYE_MONO":
+		return ARVRInterfaceEyeMono, nil
+	case "EYE_RIGHT":
+		return ARVRInterfaceEyeRight, nil
+	}
+	return 0, fmt.Errorf("invalid ARVRInterfaceEyes value %q", name)
+}
+
+// ARVRInterfaceTracking_status is an enum for Tracking_status values.
+type ARVRInterfaceTracking_status int
+
+const (
+	ARVRInterfaceArvrExcessiveMotion      ARVRInterfaceTracking_status = 1
+	ARVRInterfaceArvrInsufficientFeatures ARVRInterfaceTracking_status = 2
+	ARVRInterfaceArvrNormalTracking       ARVRInterfaceTracking_status = 0
+	ARVRInterfaceArvrNotTracking          ARVRInterfaceTracking_status = 4
+	ARVRInterfaceArvrUnknownTracking      ARVRInterfaceTracking_status = 3
+)
+
+// String will return the Godot name of the ARVRInterfaceTracking_status value.
+func (e ARVRInterfaceTracking_status) String() string {
+	switch e {
+	case ARVRInterfaceArvrExcessiveMotion:
+		return "ARVR_EXCESSIVE_MOTION"
+	case ARVRInterfaceArvrInsufficientFeatures:
+		return "ARVR_INSUFFICIENT_FEATURES"
+	case ARVRInterfaceArvrNormalTracking:
+		return "ARVR_NORMAL_TRACKING"
+	case ARVRInterfaceArvrNotTracking:
+		return "ARVR_NOT_TRACKING"
+	case ARVRInterfaceArvrUnknownTracking:
+		return "ARVR_UNKNOWN_TRACKING"
+	}
+	return fmt.Sprintf("ARVRInterfaceTracking_status(%d)", int(e))
+}
+
+// ParseARVRInterfaceTracking_status will return the ARVRInterfaceTracking_status value with the given Godot name.
+func ParseARVRInterfaceTracking_status(name string) (ARVRInterfaceTracking_status, error) {
+	switch name {
+	case "ARVR_EXCESSIVE_MOTION":
+		return ARVRInterfaceArvrExcessiveMotion, nil
+	case "ARVR_INSUFFICIENT_FEATURES":
+		return ARVRInterfaceArvrInsufficientFeatures, nil
+	case "ARVR_NORMAL_TRACKING":
+		return ARVRInterfaceArvrNormalTracking, nil
+	case "ARVR_NOT_TRACKING":
+		return ARVRInterfaceArvrNotTracking, nil
+	case "ARVR_UNKNOWN_TRACKING":
+		return ARVRInterfaceArvrUnknownTracking, nil
+	}
+	return 0, fmt.Errorf("invalid ARVRInterfaceTracking_status value %q", name)
+}
+
+/*
+This class needs to be implemented to make an AR or VR platform available to Godot and these should be implemented as C++ modules or GDNative modules (note that for GDNative the subclass ARVRScriptInterface should be used). Part of the interface is exposed to GDScript so you can detect, enable and configure an AR or VR platform. Interfaces should be written in such a way that simply enabling them will give us a working setup. You can query the available interfaces through ARVRServer.
+*/
+type ARVRInterface struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+func (o *ARVRInterface) BaseClass() string {
+	return "ARVRInterface"
+}
+
+// Method binds of the ARVRInterface class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindARVRInterfaceGetAnchorDetectionIsEnabled = gdnative.NewLazyMethodBind("ARVRInterface", "get_anchor_detection_is_enabled")
+	methodBindARVRInterfaceGetCapabilities             = gdnative.NewLazyMethodBind("ARVRInterface", "get_capabilities")
+	methodBindARVRInterfaceGetName                     = gdnative.NewLazyMethodBind("ARVRInterface", "get_name")
+	methodBindARVRInterfaceGetRenderTargetsize         = gdnative.NewLazyMethodBind("ARVRInterface", "get_render_targetsize")
+	methodBindARVRInterfaceGetTrackingStatus           = gdnative.NewLazyMethodBind("ARVRInterface", "get_tracking_status")
+	methodBindARVRInterfaceInitialize                  = gdnative.NewLazyMethodBind("ARVRInterface", "initialize")
+	methodBindARVRInterfaceIsInitialized               = gdnative.NewLazyMethodBind("ARVRInterface", "is_initialized")
+	methodBindARVRInterfaceIsPrimary                   = gdnative.NewLazyMethodBind("ARVRInterface", "is_primary")
+	methodBindARVRInterfaceIsStereo                    = gdnative.NewLazyMethodBind("ARVRInterface", "is_stereo")
+	methodBindARVRInterfaceSetAnchorDetectionIsEnabled = gdnative.NewLazyMethodBind("ARVRInterface", "set_anchor_detection_is_enabled")
+	methodBindARVRInterfaceSetIsInitialized            = gdnative.NewLazyMethodBind("ARVRInterface", "set_is_initialized")
+	methodBindARVRInterfaceSetIsPrimary                = gdnative.NewLazyMethodBind("ARVRInterface", "set_is_primary")
+	methodBindARVRInterfaceUninitialize                = gdnative.NewLazyMethodBind("ARVRInterface", "uninitialize")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *ARVRInterface) GetAnchorDetectionIsEnabled() gdnative.Bool {
+	//log.Println("Calling ARVRInterface.GetAnchorDetectionIsEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceGetAnchorDetectionIsEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a combination of flags providing information about the capabilities of this interface.
+		Args: [], Returns: int
+*/
+func (o *ARVRInterface) GetCapabilities() gdnative.Int {
+	//log.Println("Calling ARVRInterface.GetCapabilities()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceGetCapabilities.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the name of this interface (OpenVR, OpenHMD, ARKit, etc).
+		Args: [], Returns: String
+*/
+func (o *ARVRInterface) GetName() gdnative.String {
+	//log.Println("Calling ARVRInterface.GetName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceGetName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the resolution at which we should render our intermediate results before things like lens distortion are applied by the VR platform.
+		Args: [], Returns: Vector2
+*/
+func (o *ARVRInterface) GetRenderTargetsize() gdnative.Vector2 {
+	//log.Println("Calling ARVRInterface.GetRenderTargetsize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceGetRenderTargetsize.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If supported, returns the status of our tracking. This will allow you to provide feedback to the user whether there are issues with positional tracking.
+		Args: [], Returns: enum.ARVRInterface::Tracking_status
+*/
+func (o *ARVRInterface) GetTrackingStatus() ARVRInterfaceTracking_status {
+	//log.Println("Calling ARVRInterface.GetTrackingStatus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceGetTrackingStatus.Get()
+
+	// Call the parent method.
+	// enum.ARVRInterface::Tracking_status
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ARVRInterfaceTracking_status(ret)
+}
+
+/*
+	        Call this to initialize this interface. The first interface that is initialized is identified as the primary interface and it will be used for rendering output. After initializing the interface you want to use you then need to enable the AR/VR mode of a viewport and rendering should commence. Note that you must enable the AR/VR mode on the main viewport for any device that uses the main output of Godot such as for mobile VR. If you do this for a platform that handles its own output (such as OpenVR) Godot will show just one eye without distortion on screen. Alternatively you can add a separate viewport node to your scene and enable AR/VR on that viewport and it will be used to output to the HMD leaving you free to do anything you like in the main window such as using a separate camera as a spectator camera or render out something completely different. While currently not used you can activate additional interfaces, you may wish to do this if you want to track controllers from other platforms. However at this point in time only one interface can render to an HMD.
+		Args: [], Returns: bool
+*/
+func (o *ARVRInterface) Initialize() gdnative.Bool {
+	//log.Println("Calling ARVRInterface.Initialize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceInitialize.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *ARVRInterface) IsInitialized() gdnative.Bool {
+	//log.Println("Calling ARVRInterface.IsInitialized()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceIsInitialized.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *ARVRInterface) IsPrimary() gdnative.Bool {
+	//log.Println("Calling ARVRInterface.IsPrimary()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceIsPrimary.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns true if the current output of this interface is in stereo.
+		Args: [], Returns: bool
+*/
+func (o *ARVRInterface) IsStereo() gdnative.Bool {
+	//log.Println("Calling ARVRInterface.IsStereo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceIsStereo.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *ARVRInterface) SetAnchorDetectionIsEnabled(enable gdnative.Bool) {
+	//log.Println("Calling ARVRInterface.SetAnchorDetectionIsEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceSetAnchorDetectionIsEnabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false initialized bool}], Returns: void
+*/
+func (o *ARVRInterface) SetIsInitialized(initialized gdnative.Bool) {
+	//log.Println("Calling ARVRInterface.SetIsInitialized()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(initialized)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceSetIsInitialized.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *ARVRInterface) SetIsPrimary(enable gdnative.Bool) {
+	//log.Println("Calling ARVRInterface.SetIsPrimary()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceSetIsPrimary.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Turns the interface off.
+		Args: [], Returns: void
+*/
+func (o *ARVRInterface) Uninitialize() {
+	//log.Println("Calling ARVRInterface.Uninitialize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRInterfaceUninitialize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// ArIsAnchorDetectionEnabled will return the value of the "ar_is_anchor_detection_enabled" property.
+func (o *ARVRInterface) ArIsAnchorDetectionEnabled() gdnative.Bool {
+	return o.GetAnchorDetectionIsEnabled()
+}
+
+// SetArIsAnchorDetectionEnabled will set the value of the "ar_is_anchor_detection_enabled" property.
+func (o *ARVRInterface) SetArIsAnchorDetectionEnabled(value gdnative.Bool) {
+	o.SetAnchorDetectionIsEnabled(value)
+}
+
+// InterfaceIsInitialized will return the value of the "interface_is_initialized" property.
+func (o *ARVRInterface) InterfaceIsInitialized() gdnative.Bool {
+	return o.IsInitialized()
+}
+
+// SetInterfaceIsInitialized will set the value of the "interface_is_initialized" property.
+func (o *ARVRInterface) SetInterfaceIsInitialized(value gdnative.Bool) {
+	o.SetIsInitialized(value)
+}
+
+// InterfaceIsPrimary will return the value of the "interface_is_primary" property.
+func (o *ARVRInterface) InterfaceIsPrimary() gdnative.Bool {
+	return o.IsPrimary()
+}
+
+// SetInterfaceIsPrimary will set the value of the "interface_is_primary" property.
+func (o *ARVRInterface) SetInterfaceIsPrimary(value gdnative.Bool) {
+	o.SetIsPrimary(value)
+}
+
+// ARVRInterfaceImplementer is an interface that implements the methods
+// of the ARVRInterface class.
+type ARVRInterfaceImplementer interface {
+	godot.ReferenceImplementer
+	GetAnchorDetectionIsEnabled() gdnative.Bool
+	GetCapabilities() gdnative.Int
+	GetName() gdnative.String
+	GetRenderTargetsize() gdnative.Vector2
+	GetTrackingStatus() ARVRInterfaceTracking_status
+	Initialize() gdnative.Bool
+	IsInitialized() gdnative.Bool
+	IsPrimary() gdnative.Bool
+	IsStereo() gdnative.Bool
+	SetAnchorDetectionIsEnabled(enable gdnative.Bool)
+	SetIsInitialized(initialized gdnative.Bool)
+	SetIsPrimary(enable gdnative.Bool)
+	Uninitialize()
+	ArIsAnchorDetectionEnabled() gdnative.Bool
+	SetArIsAnchorDetectionEnabled(value gdnative.Bool)
+	InterfaceIsInitialized() gdnative.Bool
+	SetInterfaceIsInitialized(value gdnative.Bool)
+	InterfaceIsPrimary() gdnative.Bool
+	SetInterfaceIsPrimary(value gdnative.Bool)
+}
@@ -1,7 +1,8 @@
-package godot
+package arvr
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewARVRInterfaceGDNativeFromPointer(ptr gdnative.Pointer) ARVRInterfaceGDNative {
-func newARVRInterfaceGDNativeFromPointer(ptr gdnative.Pointer) ARVRInterfaceGDNative {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ARVRInterfaceGDNative{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type ARVRInterfaceGDNative struct {
 	owner gdnative.Object
 }
 
+// classConstructorARVRInterfaceGDNative is the class constructor of the ARVRInterfaceGDNative class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorARVRInterfaceGDNative = gdnative.NewLazyClassConstructor("ARVRInterfaceGDNative")
+
+// NewARVRInterfaceGDNative will create a new instance of the ARVRInterfaceGDNative class.
+// ARVRInterfaceGDNative is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewARVRInterfaceGDNative() *ARVRInterfaceGDNative {
+	obj := &ARVRInterfaceGDNative{}
+	obj.SetBaseObject(classConstructorARVRInterfaceGDNative.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ARVRInterfaceGDNative) BaseClass() string {
 	return "ARVRInterfaceGDNative"
 }
@@ -1,7 +1,8 @@
-package godot
+package arvr
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,42 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewARVROriginFromPointer(ptr gdnative.Pointer) ARVROrigin {
-func newARVROriginFromPointer(ptr gdnative.Pointer) ARVROrigin {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ARVROrigin{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This is a special node within the AR/VR system that maps the physical location of the center of our tracking space to the virtual location within our game world. There should be only one of these nodes in your scene and you must have one. All the ARVRCamera, ARVRController and ARVRAnchor nodes should be direct children of this node for spatial tracking to work correctly. It is the position of this node that you update when you're character needs to move through your game world while we're not moving in the real world. Movement in the real world is always in relation to this origin point. So say that your character is driving a car, the ARVROrigin node should be a child node of this car. If you implement a teleport system to move your character, you change the position of this node. Etc.
 */
 type ARVROrigin struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorARVROrigin is the class constructor of the ARVROrigin class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorARVROrigin = gdnative.NewLazyClassConstructor("ARVROrigin")
+
+// NewARVROrigin will create a new instance of the ARVROrigin class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewARVROrigin() *ARVROrigin {
+	obj := &ARVROrigin{}
+	obj.SetBaseObject(classConstructorARVROrigin.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ARVROrigin) BaseClass() string {
 	return "ARVROrigin"
 }
 
+// Method binds of the ARVROrigin class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindARVROriginGetWorldScale = gdnative.NewLazyMethodBind("ARVROrigin", "get_world_scale")
+	methodBindARVROriginSetWorldScale = gdnative.NewLazyMethodBind("ARVROrigin", "set_world_scale")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ARVROrigin) GetWorldScale() gdnative.Real {
 	//log.Println("Calling ARVROrigin.GetWorldScale()")
@@ -45,7 +58,7 @@ func (o *ARVROrigin) GetWorldScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVROrigin", "get_world_scale")
+	methodBind := methodBindARVROriginGetWorldScale.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +71,8 @@ func (o *ARVROrigin) GetWorldScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false world_scale float}], Returns: void
+	        Undocumented
+		Args: [{ false world_scale float}], Returns: void
 */
 func (o *ARVROrigin) SetWorldScale(worldScale gdnative.Real) {
 	//log.Println("Calling ARVROrigin.SetWorldScale()")
@@ -69,7 +82,7 @@ func (o *ARVROrigin) SetWorldScale(worldScale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(worldScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVROrigin", "set_world_scale")
+	methodBind := methodBindARVROriginSetWorldScale.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *ARVROrigin) SetWorldScale(worldScale gdnative.Real) {
 
 }
 
+// WorldScale will return the value of the "world_scale" property.
+func (o *ARVROrigin) WorldScale() gdnative.Real {
+	return o.GetWorldScale()
+}
+
 // ARVROriginImplementer is an interface that implements the methods
 // of the ARVROrigin class.
 type ARVROriginImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetWorldScale() gdnative.Real
 	SetWorldScale(worldScale gdnative.Real)
+	WorldScale() gdnative.Real
 }
@@ -1,7 +1,10 @@
-package godot
+package arvr
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,30 +25,82 @@ const (
 	ARVRPositionalTrackerTrackerRightHand   ARVRPositionalTrackerTrackerHand = 2
 )
 
-//func NewARVRPositionalTrackerFromPointer(ptr gdnative.Pointer) ARVRPositionalTracker {
-func newARVRPositionalTrackerFromPointer(ptr gdnative.Pointer) ARVRPositionalTracker {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ARVRPositionalTracker{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ARVRPositionalTrackerTrackerHand value.
+func (e ARVRPositionalTrackerTrackerHand) String() string {
+	switch e {
+	case ARVRPositionalTrackerTrackerHandUnknown:
+		return "TRACKER_HAND_UNKNOWN"
+	case ARVRPositionalTrackerTrackerLeftHand:
+		return "TRACKER_LEFT_HAND"
+	case ARVRPositionalTrackerTrackerRightHand:
+		return "TRACKER_RIGHT_HAND"
+	}
+	return fmt.Sprintf("ARVRPositionalTrackerTrackerHand(%d)", int(e))
+}
 
-	return obj
+// ParseARVRPositionalTrackerTrackerHand will return the ARVRPositionalTrackerTrackerHand value with the given Godot name.
+func ParseARVRPositionalTrackerTrackerHand(name string) (ARVRPositionalTrackerTrackerHand, error) {
+	switch name {
+	case "TRACKER_HAND_UNKNOWN":
+		return ARVRPositionalTrackerTrackerHandUnknown, nil
+	case "TRACKER_LEFT_HAND":
+		return ARVRPositionalTrackerTrackerLeftHand, nil
+	case "TRACKER_RIGHT_HAND":
+		return ARVRPositionalTrackerTrackerRightHand, nil
+	}
+	return 0, fmt.Errorf("invalid ARVRPositionalTrackerTrackerHand value %q", name)
 }
 
 /*
 An instance of this object represents a device that is tracked such as a controller or anchor point. HMDs aren't represented here as they are fully handled internally. As controllers are turned on and the AR/VR interface detects them instances of this object are automatically added to this list of active tracking objects accessible through the ARVRServer The ARVRController and ARVRAnchor both consume objects of this type and should be the objects you use in game. The positional trackers are just the under the hood objects that make this all work and are mostly exposed so GDNative based interfaces can interact with them.
 */
 type ARVRPositionalTracker struct {
-	Object
+	godot.Object
 	owner gdnative.Object
 }
 
+// classConstructorARVRPositionalTracker is the class constructor of the ARVRPositionalTracker class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorARVRPositionalTracker = gdnative.NewLazyClassConstructor("ARVRPositionalTracker")
+
+// NewARVRPositionalTracker will create a new instance of the ARVRPositionalTracker class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewARVRPositionalTracker() *ARVRPositionalTracker {
+	obj := &ARVRPositionalTracker{}
+	obj.SetBaseObject(classConstructorARVRPositionalTracker.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ARVRPositionalTracker) BaseClass() string {
 	return "ARVRPositionalTracker"
 }
 
+// Method binds of the ARVRPositionalTracker class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindARVRPositionalTrackerX_SetJoyId           = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "_set_joy_id")
+	methodBindARVRPositionalTrackerX_SetName            = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "_set_name")
+	methodBindARVRPositionalTrackerX_SetOrientation     = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "_set_orientation")
+	methodBindARVRPositionalTrackerX_SetRwPosition      = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "_set_rw_position")
+	methodBindARVRPositionalTrackerX_SetType            = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "_set_type")
+	methodBindARVRPositionalTrackerGetHand              = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_hand")
+	methodBindARVRPositionalTrackerGetJoyId             = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_joy_id")
+	methodBindARVRPositionalTrackerGetName              = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_name")
+	methodBindARVRPositionalTrackerGetOrientation       = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_orientation")
+	methodBindARVRPositionalTrackerGetPosition          = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_position")
+	methodBindARVRPositionalTrackerGetRumble            = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_rumble")
+	methodBindARVRPositionalTrackerGetTracksOrientation = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_tracks_orientation")
+	methodBindARVRPositionalTrackerGetTracksPosition    = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_tracks_position")
+	methodBindARVRPositionalTrackerGetTransform         = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_transform")
+	methodBindARVRPositionalTrackerGetType              = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "get_type")
+	methodBindARVRPositionalTrackerSetRumble            = gdnative.NewLazyMethodBind("ARVRPositionalTracker", "set_rumble")
+)
+
 /*
-        Undocumented
-	Args: [{ false joy_id int}], Returns: void
+	        Undocumented
+		Args: [{ false joy_id int}], Returns: void
 */
 func (o *ARVRPositionalTracker) X_SetJoyId(joyId gdnative.Int) {
 	//log.Println("Calling ARVRPositionalTracker.X_SetJoyId()")
@@ -55,7 +110,7 @@ func (o *ARVRPositionalTracker) X_SetJoyId(joyId gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(joyId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "_set_joy_id")
+	methodBind := methodBindARVRPositionalTrackerX_SetJoyId.Get()
 
 	// Call the parent method.
 	// void
@@ -65,8 +120,8 @@ func (o *ARVRPositionalTracker) X_SetJoyId(joyId gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *ARVRPositionalTracker) X_SetName(name gdnative.String) {
 	//log.Println("Calling ARVRPositionalTracker.X_SetName()")
@@ -76,7 +131,7 @@ func (o *ARVRPositionalTracker) X_SetName(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "_set_name")
+	methodBind := methodBindARVRPositionalTrackerX_SetName.Get()
 
 	// Call the parent method.
 	// void
@@ -86,8 +141,8 @@ func (o *ARVRPositionalTracker) X_SetName(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false orientation Basis}], Returns: void
+	        Undocumented
+		Args: [{ false orientation Basis}], Returns: void
 */
 func (o *ARVRPositionalTracker) X_SetOrientation(orientation gdnative.Basis) {
 	//log.Println("Calling ARVRPositionalTracker.X_SetOrientation()")
@@ -97,7 +152,7 @@ func (o *ARVRPositionalTracker) X_SetOrientation(orientation gdnative.Basis) {
 	ptrArguments[0] = gdnative.NewPointerFromBasis(orientation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "_set_orientation")
+	methodBind := methodBindARVRPositionalTrackerX_SetOrientation.Get()
 
 	// Call the parent method.
 	// void
@@ -107,8 +162,8 @@ func (o *ARVRPositionalTracker) X_SetOrientation(orientation gdnative.Basis) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rw_position Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false rw_position Vector3}], Returns: void
 */
 func (o *ARVRPositionalTracker) X_SetRwPosition(rwPosition gdnative.Vector3) {
 	//log.Println("Calling ARVRPositionalTracker.X_SetRwPosition()")
@@ -118,7 +173,7 @@ func (o *ARVRPositionalTracker) X_SetRwPosition(rwPosition gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(rwPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "_set_rw_position")
+	methodBind := methodBindARVRPositionalTrackerX_SetRwPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -128,8 +183,8 @@ func (o *ARVRPositionalTracker) X_SetRwPosition(rwPosition gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false type int}], Returns: void
+	        Undocumented
+		Args: [{ false type int}], Returns: void
 */
 func (o *ARVRPositionalTracker) X_SetType(aType gdnative.Int) {
 	//log.Println("Calling ARVRPositionalTracker.X_SetType()")
@@ -139,7 +194,7 @@ func (o *ARVRPositionalTracker) X_SetType(aType gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "_set_type")
+	methodBind := methodBindARVRPositionalTrackerX_SetType.Get()
 
 	// Call the parent method.
 	// void
@@ -149,8 +204,8 @@ func (o *ARVRPositionalTracker) X_SetType(aType gdnative.Int) {
 }
 
 /*
-        Returns the hand holding this tracker, if known. See TRACKER_* constants.
-	Args: [], Returns: enum.ARVRPositionalTracker::TrackerHand
+	        Returns the hand holding this tracker, if known. See TRACKER_* constants.
+		Args: [], Returns: enum.ARVRPositionalTracker::TrackerHand
 */
 func (o *ARVRPositionalTracker) GetHand() ARVRPositionalTrackerTrackerHand {
 	//log.Println("Calling ARVRPositionalTracker.GetHand()")
@@ -159,7 +214,7 @@ func (o *ARVRPositionalTracker) GetHand() ARVRPositionalTrackerTrackerHand {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_hand")
+	methodBind := methodBindARVRPositionalTrackerGetHand.Get()
 
 	// Call the parent method.
 	// enum.ARVRPositionalTracker::TrackerHand
@@ -172,8 +227,8 @@ func (o *ARVRPositionalTracker) GetHand() ARVRPositionalTrackerTrackerHand {
 }
 
 /*
-        If this is a controller that is being tracked the controller will also be represented by a joystick entry with this id.
-	Args: [], Returns: int
+	        If this is a controller that is being tracked the controller will also be represented by a joystick entry with this id.
+		Args: [], Returns: int
 */
 func (o *ARVRPositionalTracker) GetJoyId() gdnative.Int {
 	//log.Println("Calling ARVRPositionalTracker.GetJoyId()")
@@ -182,7 +237,7 @@ func (o *ARVRPositionalTracker) GetJoyId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_joy_id")
+	methodBind := methodBindARVRPositionalTrackerGetJoyId.Get()
 
 	// Call the parent method.
 	// int
@@ -195,8 +250,8 @@ func (o *ARVRPositionalTracker) GetJoyId() gdnative.Int {
 }
 
 /*
-        Returns the controller or anchor point's name if available.
-	Args: [], Returns: String
+	        Returns the controller or anchor point's name if available.
+		Args: [], Returns: String
 */
 func (o *ARVRPositionalTracker) GetName() gdnative.String {
 	//log.Println("Calling ARVRPositionalTracker.GetName()")
@@ -205,7 +260,7 @@ func (o *ARVRPositionalTracker) GetName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_name")
+	methodBind := methodBindARVRPositionalTrackerGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -218,8 +273,8 @@ func (o *ARVRPositionalTracker) GetName() gdnative.String {
 }
 
 /*
-        Returns the controller's orientation matrix.
-	Args: [], Returns: Basis
+	        Returns the controller's orientation matrix.
+		Args: [], Returns: Basis
 */
 func (o *ARVRPositionalTracker) GetOrientation() gdnative.Basis {
 	//log.Println("Calling ARVRPositionalTracker.GetOrientation()")
@@ -228,7 +283,7 @@ func (o *ARVRPositionalTracker) GetOrientation() gdnative.Basis {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_orientation")
+	methodBind := methodBindARVRPositionalTrackerGetOrientation.Get()
 
 	// Call the parent method.
 	// Basis
@@ -241,8 +296,8 @@ func (o *ARVRPositionalTracker) GetOrientation() gdnative.Basis {
 }
 
 /*
-        Returns the world-space controller position.
-	Args: [], Returns: Vector3
+	        Returns the world-space controller position.
+		Args: [], Returns: Vector3
 */
 func (o *ARVRPositionalTracker) GetPosition() gdnative.Vector3 {
 	//log.Println("Calling ARVRPositionalTracker.GetPosition()")
@@ -251,7 +306,7 @@ func (o *ARVRPositionalTracker) GetPosition() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_position")
+	methodBind := methodBindARVRPositionalTrackerGetPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -264,8 +319,8 @@ func (o *ARVRPositionalTracker) GetPosition() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ARVRPositionalTracker) GetRumble() gdnative.Real {
 	//log.Println("Calling ARVRPositionalTracker.GetRumble()")
@@ -274,7 +329,7 @@ func (o *ARVRPositionalTracker) GetRumble() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_rumble")
+	methodBind := methodBindARVRPositionalTrackerGetRumble.Get()
 
 	// Call the parent method.
 	// float
@@ -287,8 +342,8 @@ func (o *ARVRPositionalTracker) GetRumble() gdnative.Real {
 }
 
 /*
-        Returns [code]true[/code] if this device tracks orientation.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if this device tracks orientation.
+		Args: [], Returns: bool
 */
 func (o *ARVRPositionalTracker) GetTracksOrientation() gdnative.Bool {
 	//log.Println("Calling ARVRPositionalTracker.GetTracksOrientation()")
@@ -297,7 +352,7 @@ func (o *ARVRPositionalTracker) GetTracksOrientation() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_tracks_orientation")
+	methodBind := methodBindARVRPositionalTrackerGetTracksOrientation.Get()
 
 	// Call the parent method.
 	// bool
@@ -310,8 +365,8 @@ func (o *ARVRPositionalTracker) GetTracksOrientation() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if this device tracks position.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if this device tracks position.
+		Args: [], Returns: bool
 */
 func (o *ARVRPositionalTracker) GetTracksPosition() gdnative.Bool {
 	//log.Println("Calling ARVRPositionalTracker.GetTracksPosition()")
@@ -320,7 +375,7 @@ func (o *ARVRPositionalTracker) GetTracksPosition() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_tracks_position")
+	methodBind := methodBindARVRPositionalTrackerGetTracksPosition.Get()
 
 	// Call the parent method.
 	// bool
@@ -333,8 +388,8 @@ func (o *ARVRPositionalTracker) GetTracksPosition() gdnative.Bool {
 }
 
 /*
-        Returns the transform combining this device's orientation and position.
-	Args: [{ false adjust_by_reference_frame bool}], Returns: Transform
+	        Returns the transform combining this device's orientation and position.
+		Args: [{ false adjust_by_reference_frame bool}], Returns: Transform
 */
 func (o *ARVRPositionalTracker) GetTransform(adjustByReferenceFrame gdnative.Bool) gdnative.Transform {
 	//log.Println("Calling ARVRPositionalTracker.GetTransform()")
@@ -344,7 +399,7 @@ func (o *ARVRPositionalTracker) GetTransform(adjustByReferenceFrame gdnative.Boo
 	ptrArguments[0] = gdnative.NewPointerFromBool(adjustByReferenceFrame)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_transform")
+	methodBind := methodBindARVRPositionalTrackerGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -357,8 +412,8 @@ func (o *ARVRPositionalTracker) GetTransform(adjustByReferenceFrame gdnative.Boo
 }
 
 /*
-        Returns the tracker's type.
-	Args: [], Returns: enum.ARVRServer::TrackerType
+	        Returns the tracker's type.
+		Args: [], Returns: enum.ARVRServer::TrackerType
 */
 func (o *ARVRPositionalTracker) GetType() ARVRServerTrackerType {
 	//log.Println("Calling ARVRPositionalTracker.GetType()")
@@ -367,7 +422,7 @@ func (o *ARVRPositionalTracker) GetType() ARVRServerTrackerType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "get_type")
+	methodBind := methodBindARVRPositionalTrackerGetType.Get()
 
 	// Call the parent method.
 	// enum.ARVRServer::TrackerType
@@ -380,8 +435,8 @@ func (o *ARVRPositionalTracker) GetType() ARVRServerTrackerType {
 }
 
 /*
-        Undocumented
-	Args: [{ false rumble float}], Returns: void
+	        Undocumented
+		Args: [{ false rumble float}], Returns: void
 */
 func (o *ARVRPositionalTracker) SetRumble(rumble gdnative.Real) {
 	//log.Println("Calling ARVRPositionalTracker.SetRumble()")
@@ -391,7 +446,7 @@ func (o *ARVRPositionalTracker) SetRumble(rumble gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(rumble)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ARVRPositionalTracker", "set_rumble")
+	methodBind := methodBindARVRPositionalTrackerSetRumble.Get()
 
 	// Call the parent method.
 	// void
@@ -400,15 +455,21 @@ func (o *ARVRPositionalTracker) SetRumble(rumble gdnative.Real) {
 
 }
 
+// Rumble will return the value of the "rumble" property.
+func (o *ARVRPositionalTracker) Rumble() gdnative.Real {
+	return o.GetRumble()
+}
+
 // ARVRPositionalTrackerImplementer is an interface that implements the methods
 // of the ARVRPositionalTracker class.
 type ARVRPositionalTrackerImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	X_SetJoyId(joyId gdnative.Int)
 	X_SetName(name gdnative.String)
 	X_SetOrientation(orientation gdnative.Basis)
 	X_SetRwPosition(rwPosition gdnative.Vector3)
 	X_SetType(aType gdnative.Int)
+	GetHand() ARVRPositionalTrackerTrackerHand
 	GetJoyId() gdnative.Int
 	GetName() gdnative.String
 	GetOrientation() gdnative.Basis
@@ -417,5 +478,7 @@ type ARVRPositionalTrackerImplementer interface {
 	GetTracksOrientation() gdnative.Bool
 	GetTracksPosition() gdnative.Bool
 	GetTransform(adjustByReferenceFrame gdnative.Bool) gdnative.Transform
+	GetType() ARVRServerTrackerType
 	SetRumble(rumble gdnative.Real)
+	Rumble() gdnative.Real
 }
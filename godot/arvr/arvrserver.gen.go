@@ -0,0 +1,601 @@
+package arvr
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// ARVRServerRotationMode is an enum for RotationMode values.
+type ARVRServerRotationMode int
+
+const (
+	ARVRServerDontResetRotation ARVRServerRotationMode = 2
+	ARVRServerResetButKeepTilt  ARVRServerRotationMode = 1
+	ARVRServerResetFullRotation ARVRServerRotationMode = 0
+)
+
+// String will return the Godot name of the ARVRServerRotationMode value.
+func (e ARVRServerRotationMode) String() string {
+	switch e {
+	case ARVRServerDontResetRotation:
+		return "DONT_RESET_ROTATION"
+	case ARVRServerResetButKeepTilt:
+		return "RESET_BUT_KEEP_TILT"
+	case ARVRServerResetFullRotation:
+		return "RESET_FULL_ROTATION"
+	}
+	return fmt.Sprintf("ARVRServerRotationMode(%d)", int(e))
+}
+
+// ParseARVRServerRotationMode will return the ARVRServerRotationMode value with the given Godot name.
+func ParseARVRServerRotationMode(name string) (ARVRServerRotationMode, error) {
+	switch name {
+	case "DONT_RESET_ROTATION":
+		return ARVRServerDontResetRotation, nil
+	case "RESET_BUT_KEEP_TILT":
+		return ARVRServerResetButKeepTilt, nil
+	case "RESET_FULL_ROTATION":
+		return ARVRServerResetFullRotation, nil
+	}
+	return 0, fmt.Errorf("invalid ARVRServerRotationMode value %q", name)
+}
+
+// ARVRServerTrackerType is an enum for TrackerType values.
+type ARVRServerTrackerType int
+
+const (
+	ARVRServerTrackerAnchor      ARVRServerTrackerType = 4
+	ARVRServerTrackerAny         ARVRServerTrackerType = 255
+	ARVRServerTrackerAnyKnown    ARVRServerTrackerType = 127
+	ARVRServerTrackerBasestation ARVRServerTrackerType = 2
+	ARVRServerTrackerController  ARVRServerTrackerType = 1
+	ARVRServerTrackerUnknown     ARVRServerTrackerType = 128
+)
+
+// String will return the Godot name of the ARVRServerTrackerType value.
+func (e ARVRServerTrackerType) String() string {
+	switch e {
+	case ARVRServerTrackerAnchor:
+		return "TRACKER_ANCHOR"
+	case ARVRServerTrackerAny:
+		return "TRACKER_ANY"
+	case ARVRServerTrackerAnyKnown:
+		return "TRACKER_ANY_KNOWN"
+	case ARVRServerTrackerBasestation:
+		return "TRACKER_BASESTATION"
+	case ARVRServerTrackerController:
+		return "TRACKER_CONTROLLER"
+	case ARVRServerTrackerUnknown:
+		return "TRACKER_UNKNOWN"
+	}
+	return fmt.Sprintf("ARVRServerTrackerType(%d)", int(e))
+}
+
+// ParseARVRServerTrackerType will return the ARVRServerTrackerType value with the given Godot name.
+func ParseARVRServerTrackerType(name string) (ARVRServerTrackerType, error) {
+	switch name {
+	case "TRACKER_ANCHOR":
+		return ARVRServerTrackerAnchor, nil
+	case "TRACKER_ANY":
+		return ARVRServerTrackerAny, nil
+	case "TRACKER_ANY_KNOWN":
+		return ARVRServerTrackerAnyKnown, nil
+	case "TRACKER_BASESTATION":
+		return ARVRServerTrackerBasestation, nil
+	case "TRACKER_CONTROLLER":
+		return ARVRServerTrackerController, nil
+	case "TRACKER_UNKNOWN":
+		return ARVRServerTrackerUnknown, nil
+	}
+	return 0, fmt.Errorf("invalid ARVRServerTrackerType value %q", name)
+}
+
+func newSingletonARVRServer() *arvrServer {
+	return &arvrServer{}
+}
+
+/*
+The AR/VR Server is the heart of our AR/VR solution and handles all the processing.
+*/
+var ARVRServer = newSingletonARVRServer()
+
+/*
+The AR/VR Server is the heart of our AR/VR solution and handles all the processing.
+*/
+type arvrServer struct {
+	godot.Object
+	owner       gdnative.Object
+	initialized bool
+}
+
+// EnsureSingleton will check to see if we have an object for it. If not, it will fetch its
+// GDNative object and set it.
+func (o *arvrServer) ensureSingleton() {
+	if o.initialized == true {
+		return
+	}
+	//log.Println("Singleton not found. Fetching from GDNative...")
+	base := gdnative.GetSingleton("ARVRServer")
+	o.SetBaseObject(base)
+	o.initialized = true
+}
+
+func (o *arvrServer) BaseClass() string {
+	return "ARVRServer"
+}
+
+// Method binds of the ARVRServer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindARVRServerCenterOnHmd         = gdnative.NewLazyMethodBind("ARVRServer", "center_on_hmd")
+	methodBindARVRServerFindInterface       = gdnative.NewLazyMethodBind("ARVRServer", "find_interface")
+	methodBindARVRServerGetInterface        = gdnative.NewLazyMethodBind("ARVRServer", "get_interface")
+	methodBindARVRServerGetInterfaceCount   = gdnative.NewLazyMethodBind("ARVRServer", "get_interface_count")
+	methodBindARVRServerGetInterfaces       = gdnative.NewLazyMethodBind("ARVRServer", "get_interfaces")
+	methodBindARVRServerGetReferenceFrame   = gdnative.NewLazyMethodBind("ARVRServer", "get_reference_frame")
+	methodBindARVRServerGetTracker          = gdnative.NewLazyMethodBind("ARVRServer", "get_tracker")
+	methodBindARVRServerGetTrackerCount     = gdnative.NewLazyMethodBind("ARVRServer", "get_tracker_count")
+	methodBindARVRServerGetWorldScale       = gdnative.NewLazyMethodBind("ARVRServer", "get_world_scale")
+	methodBindARVRServerSetPrimaryInterface = gdnative.NewLazyMethodBind("ARVRServer", "set_primary_interface")
+	methodBindARVRServerSetWorldScale       = gdnative.NewLazyMethodBind("ARVRServer", "set_world_scale")
+)
+
+/*
+	        This is a really important function to understand correctly. AR and VR platforms all handle positioning slightly differently. For platforms that do not offer spatial tracking our origin point (0,0,0) is the location of our HMD but you have little control over the direction the player is facing in the real world. For platforms that do offer spatial tracking our origin point depends very much on the system. For OpenVR our origin point is usually the center of the tracking space, on the ground. For other platforms its often the location of the tracking camera. This method allows you to center our tracker on the location of the HMD, it will take the current location of the HMD and use that to adjust all our tracking data in essence realigning the real world to your players current position in your game world. For this method to produce usable results tracking information should be available and this often takes a few frames after starting your game. You should call this method after a few seconds have passed, when the user requests a realignment of the display holding a designated button on a controller for a short period of time, and when implementing a teleport mechanism.
+		Args: [{ false rotation_mode enum.ARVRServer::RotationMode} { false keep_height bool}], Returns: void
+*/
+func (o *arvrServer) CenterOnHmd(rotationMode ARVRServerRotationMode, keepHeight gdnative.Bool) {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.CenterOnHmd()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(rotationMode))
+	ptrArguments[1] = gdnative.NewPointerFromBool(keepHeight)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerCenterOnHmd.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Find an interface by its name. Say that you're making a game that uses specific capabilities of an AR/VR platform you can find the interface for that platform by name and initialize it.
+		Args: [{ false name String}], Returns: ARVRInterface
+*/
+func (o *arvrServer) FindInterface(name gdnative.String) ARVRInterfaceImplementer {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.FindInterface()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerFindInterface.Get()
+
+	// Call the parent method.
+	// ARVRInterface
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := ARVRInterface{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ARVRInterfaceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Get the interface registered at a given index in our list of interfaces.
+		Args: [{ false idx int}], Returns: ARVRInterface
+*/
+func (o *arvrServer) GetInterface(idx gdnative.Int) ARVRInterfaceImplementer {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.GetInterface()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerGetInterface.Get()
+
+	// Call the parent method.
+	// ARVRInterface
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := ARVRInterface{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ARVRInterfaceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Get the number of interfaces currently registered with the AR/VR server. If you're game supports multiple AR/VR platforms you can look through the available interface and either present the user with a selection or simply try an initialize each interface and use the first one that returns true.
+		Args: [], Returns: int
+*/
+func (o *arvrServer) GetInterfaceCount() gdnative.Int {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.GetInterfaceCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerGetInterfaceCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a list of available interfaces with both id and name of the interface.
+		Args: [], Returns: Array
+*/
+func (o *arvrServer) GetInterfaces() gdnative.Array {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.GetInterfaces()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerGetInterfaces.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Gets our reference frame transform, mostly used internally and exposed for GDNative build interfaces.
+		Args: [], Returns: Transform
+*/
+func (o *arvrServer) GetReferenceFrame() gdnative.Transform {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.GetReferenceFrame()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerGetReferenceFrame.Get()
+
+	// Call the parent method.
+	// Transform
+	retPtr := gdnative.NewEmptyTransform()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewTransformFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Get the positional tracker at the given ID.
+		Args: [{ false idx int}], Returns: ARVRPositionalTracker
+*/
+func (o *arvrServer) GetTracker(idx gdnative.Int) ARVRPositionalTrackerImplementer {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.GetTracker()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerGetTracker.Get()
+
+	// Call the parent method.
+	// ARVRPositionalTracker
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := ARVRPositionalTracker{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ARVRPositionalTrackerImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Get the number of trackers currently registered.
+		Args: [], Returns: int
+*/
+func (o *arvrServer) GetTrackerCount() gdnative.Int {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.GetTrackerCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerGetTrackerCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *arvrServer) GetWorldScale() gdnative.Real {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.GetWorldScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerGetWorldScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Changes the primary interface to the specified interface. Again mostly exposed for GDNative interfaces.
+		Args: [{ false interface ARVRInterface}], Returns: void
+*/
+func (o *arvrServer) SetPrimaryInterface(intrfce ARVRInterfaceImplementer) {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.SetPrimaryInterface()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(intrfce))
+
+	// Get the method bind
+	methodBind := methodBindARVRServerSetPrimaryInterface.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
+*/
+func (o *arvrServer) SetWorldScale(arg0 gdnative.Real) {
+	o.ensureSingleton()
+	//log.Println("Calling ARVRServer.SetWorldScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
+
+	// Get the method bind
+	methodBind := methodBindARVRServerSetWorldScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// WorldScale will return the value of the "world_scale" property.
+func (o *arvrServer) WorldScale() gdnative.Real {
+	return o.GetWorldScale()
+}
+
+// Signals of the ARVRServer class.
+const (
+	ARVRServerSignalInterfaceAdded   gdnative.String = "interface_added"
+	ARVRServerSignalInterfaceRemoved gdnative.String = "interface_removed"
+	ARVRServerSignalTrackerAdded     gdnative.String = "tracker_added"
+	ARVRServerSignalTrackerRemoved   gdnative.String = "tracker_removed"
+)
+
+// ARVRServerInterfaceAddedArgs holds the arguments of the "interface_added" signal.
+type ARVRServerInterfaceAddedArgs struct {
+	InterfaceName gdnative.String
+}
+
+// ConnectInterfaceAdded will connect the "interface_added" signal to the given method of the target object.
+func (o *arvrServer) ConnectInterfaceAdded(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ARVRServerSignalInterfaceAdded, target, method, binds, 0)
+}
+
+// EmitInterfaceAdded will emit the "interface_added" signal.
+func (o *arvrServer) EmitInterfaceAdded(args ARVRServerInterfaceAddedArgs) error {
+	o.ensureSingleton()
+	arg0 := gdnative.NewVariantString(args.InterfaceName)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ARVRServerSignalInterfaceAdded, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ARVRServerInterfaceRemovedArgs holds the arguments of the "interface_removed" signal.
+type ARVRServerInterfaceRemovedArgs struct {
+	InterfaceName gdnative.String
+}
+
+// ConnectInterfaceRemoved will connect the "interface_removed" signal to the given method of the target object.
+func (o *arvrServer) ConnectInterfaceRemoved(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ARVRServerSignalInterfaceRemoved, target, method, binds, 0)
+}
+
+// EmitInterfaceRemoved will emit the "interface_removed" signal.
+func (o *arvrServer) EmitInterfaceRemoved(args ARVRServerInterfaceRemovedArgs) error {
+	o.ensureSingleton()
+	arg0 := gdnative.NewVariantString(args.InterfaceName)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ARVRServerSignalInterfaceRemoved, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ARVRServerTrackerAddedArgs holds the arguments of the "tracker_added" signal.
+type ARVRServerTrackerAddedArgs struct {
+	TrackerName gdnative.String
+	Type        gdnative.Int
+	Id          gdnative.Int
+}
+
+// ConnectTrackerAdded will connect the "tracker_added" signal to the given method of the target object.
+func (o *arvrServer) ConnectTrackerAdded(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ARVRServerSignalTrackerAdded, target, method, binds, 0)
+}
+
+// EmitTrackerAdded will emit the "tracker_added" signal.
+func (o *arvrServer) EmitTrackerAdded(args ARVRServerTrackerAddedArgs) error {
+	o.ensureSingleton()
+	arg0 := gdnative.NewVariantString(args.TrackerName)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.Type))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg2.Destroy()
+
+	ret, err := o.EmitSignal(ARVRServerSignalTrackerAdded, arg0, arg1, arg2)
+	ret.Destroy()
+	return err
+}
+
+// ARVRServerTrackerRemovedArgs holds the arguments of the "tracker_removed" signal.
+type ARVRServerTrackerRemovedArgs struct {
+	TrackerName gdnative.String
+	Type        gdnative.Int
+	Id          gdnative.Int
+}
+
+// ConnectTrackerRemoved will connect the "tracker_removed" signal to the given method of the target object.
+func (o *arvrServer) ConnectTrackerRemoved(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ARVRServerSignalTrackerRemoved, target, method, binds, 0)
+}
+
+// EmitTrackerRemoved will emit the "tracker_removed" signal.
+func (o *arvrServer) EmitTrackerRemoved(args ARVRServerTrackerRemovedArgs) error {
+	o.ensureSingleton()
+	arg0 := gdnative.NewVariantString(args.TrackerName)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.Type))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg2.Destroy()
+
+	ret, err := o.EmitSignal(ARVRServerSignalTrackerRemoved, arg0, arg1, arg2)
+	ret.Destroy()
+	return err
+}
+
+// ARVRServerImplementer is an interface that implements the methods
+// of the ARVRServer class.
+type ARVRServerImplementer interface {
+	godot.ObjectImplementer
+	CenterOnHmd(rotationMode ARVRServerRotationMode, keepHeight gdnative.Bool)
+	FindInterface(name gdnative.String) ARVRInterfaceImplementer
+	GetInterface(idx gdnative.Int) ARVRInterfaceImplementer
+	GetInterfaceCount() gdnative.Int
+	GetInterfaces() gdnative.Array
+	GetReferenceFrame() gdnative.Transform
+	GetTracker(idx gdnative.Int) ARVRPositionalTrackerImplementer
+	GetTrackerCount() gdnative.Int
+	GetWorldScale() gdnative.Real
+	SetPrimaryInterface(intrfce ARVRInterfaceImplementer)
+	SetWorldScale(arg0 gdnative.Real)
+	WorldScale() gdnative.Real
+	ConnectInterfaceAdded(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitInterfaceAdded(args ARVRServerInterfaceAddedArgs) error
+	ConnectInterfaceRemoved(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitInterfaceRemoved(args ARVRServerInterfaceRemovedArgs) error
+	ConnectTrackerAdded(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTrackerAdded(args ARVRServerTrackerAddedArgs) error
+	ConnectTrackerRemoved(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTrackerRemoved(args ARVRServerTrackerRemovedArgs) error
+}
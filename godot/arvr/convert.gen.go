@@ -0,0 +1,55 @@
+package arvr
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("ARVRAnchor", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ARVRAnchor{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ARVRCamera", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ARVRCamera{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ARVRController", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ARVRController{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ARVRInterface", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ARVRInterface{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ARVRInterfaceGDNative", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ARVRInterfaceGDNative{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ARVROrigin", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ARVROrigin{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ARVRPositionalTracker", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ARVRPositionalTracker{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
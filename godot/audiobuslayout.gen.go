@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioBusLayoutFromPointer(ptr gdnative.Pointer) AudioBusLayout {
-func newAudioBusLayoutFromPointer(ptr gdnative.Pointer) AudioBusLayout {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioBusLayout{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Stores position, muting, solo, bypass, effects, effect position, volume, and the connections between busses. See [AudioServer] for usage.
 */
@@ -30,6 +21,23 @@ type AudioBusLayout struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioBusLayout is the class constructor of the AudioBusLayout class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioBusLayout = gdnative.NewLazyClassConstructor("AudioBusLayout")
+
+// NewAudioBusLayout will create a new instance of the AudioBusLayout class.
+// AudioBusLayout is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioBusLayout() *AudioBusLayout {
+	obj := &AudioBusLayout{}
+	obj.SetBaseObject(classConstructorAudioBusLayout.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioBusLayout) BaseClass() string {
 	return "AudioBusLayout"
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectFromPointer(ptr gdnative.Pointer) AudioEffect {
-func newAudioEffectFromPointer(ptr gdnative.Pointer) AudioEffect {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffect{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base resource for audio bus. Applies an audio effect on the bus that the resource is applied on.
 */
 type AudioEffect struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
@@ -37,5 +29,5 @@ func (o *AudioEffect) BaseClass() string {
 // AudioEffectImplementer is an interface that implements the methods
 // of the AudioEffect class.
 type AudioEffectImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 }
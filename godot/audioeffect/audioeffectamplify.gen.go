@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectAmplifyFromPointer(ptr gdnative.Pointer) AudioEffectAmplify {
-func newAudioEffectAmplifyFromPointer(ptr gdnative.Pointer) AudioEffectAmplify {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectAmplify{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Increases or decreases the volume being routed through the audio bus.
 */
@@ -30,13 +22,37 @@ type AudioEffectAmplify struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectAmplify is the class constructor of the AudioEffectAmplify class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectAmplify = gdnative.NewLazyClassConstructor("AudioEffectAmplify")
+
+// NewAudioEffectAmplify will create a new instance of the AudioEffectAmplify class.
+// AudioEffectAmplify is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectAmplify() *AudioEffectAmplify {
+	obj := &AudioEffectAmplify{}
+	obj.SetBaseObject(classConstructorAudioEffectAmplify.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectAmplify) BaseClass() string {
 	return "AudioEffectAmplify"
 }
 
+// Method binds of the AudioEffectAmplify class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectAmplifyGetVolumeDb = gdnative.NewLazyMethodBind("AudioEffectAmplify", "get_volume_db")
+	methodBindAudioEffectAmplifySetVolumeDb = gdnative.NewLazyMethodBind("AudioEffectAmplify", "set_volume_db")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectAmplify) GetVolumeDb() gdnative.Real {
 	//log.Println("Calling AudioEffectAmplify.GetVolumeDb()")
@@ -45,7 +61,7 @@ func (o *AudioEffectAmplify) GetVolumeDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectAmplify", "get_volume_db")
+	methodBind := methodBindAudioEffectAmplifyGetVolumeDb.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +74,8 @@ func (o *AudioEffectAmplify) GetVolumeDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false volume float}], Returns: void
+	        Undocumented
+		Args: [{ false volume float}], Returns: void
 */
 func (o *AudioEffectAmplify) SetVolumeDb(volume gdnative.Real) {
 	//log.Println("Calling AudioEffectAmplify.SetVolumeDb()")
@@ -69,7 +85,7 @@ func (o *AudioEffectAmplify) SetVolumeDb(volume gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(volume)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectAmplify", "set_volume_db")
+	methodBind := methodBindAudioEffectAmplifySetVolumeDb.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *AudioEffectAmplify) SetVolumeDb(volume gdnative.Real) {
 
 }
 
+// VolumeDb will return the value of the "volume_db" property.
+func (o *AudioEffectAmplify) VolumeDb() gdnative.Real {
+	return o.GetVolumeDb()
+}
+
 // AudioEffectAmplifyImplementer is an interface that implements the methods
 // of the AudioEffectAmplify class.
 type AudioEffectAmplifyImplementer interface {
 	AudioEffectImplementer
 	GetVolumeDb() gdnative.Real
 	SetVolumeDb(volume gdnative.Real)
+	VolumeDb() gdnative.Real
 }
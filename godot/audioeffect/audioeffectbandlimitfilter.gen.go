@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectBandLimitFilterFromPointer(ptr gdnative.Pointer) AudioEffectBandLimitFilter {
-func newAudioEffectBandLimitFilterFromPointer(ptr gdnative.Pointer) AudioEffectBandLimitFilter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectBandLimitFilter{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Limits the frequencies in a range around the [member cutoff_hz] and allows frequencies outside of this range to pass.
 */
@@ -30,6 +22,23 @@ type AudioEffectBandLimitFilter struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectBandLimitFilter is the class constructor of the AudioEffectBandLimitFilter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectBandLimitFilter = gdnative.NewLazyClassConstructor("AudioEffectBandLimitFilter")
+
+// NewAudioEffectBandLimitFilter will create a new instance of the AudioEffectBandLimitFilter class.
+// AudioEffectBandLimitFilter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectBandLimitFilter() *AudioEffectBandLimitFilter {
+	obj := &AudioEffectBandLimitFilter{}
+	obj.SetBaseObject(classConstructorAudioEffectBandLimitFilter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectBandLimitFilter) BaseClass() string {
 	return "AudioEffectBandLimitFilter"
 }
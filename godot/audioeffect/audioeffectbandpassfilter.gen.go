@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectBandPassFilterFromPointer(ptr gdnative.Pointer) AudioEffectBandPassFilter {
-func newAudioEffectBandPassFilterFromPointer(ptr gdnative.Pointer) AudioEffectBandPassFilter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectBandPassFilter{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Attenuates the frequencies inside of a range around the [member cutoff_hz] and cuts frequencies outside of this band.
 */
@@ -30,6 +22,23 @@ type AudioEffectBandPassFilter struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectBandPassFilter is the class constructor of the AudioEffectBandPassFilter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectBandPassFilter = gdnative.NewLazyClassConstructor("AudioEffectBandPassFilter")
+
+// NewAudioEffectBandPassFilter will create a new instance of the AudioEffectBandPassFilter class.
+// AudioEffectBandPassFilter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectBandPassFilter() *AudioEffectBandPassFilter {
+	obj := &AudioEffectBandPassFilter{}
+	obj.SetBaseObject(classConstructorAudioEffectBandPassFilter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectBandPassFilter) BaseClass() string {
 	return "AudioEffectBandPassFilter"
 }
@@ -0,0 +1,805 @@
+package audioeffect
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Adds a chorus audio effect. The effect applies a filter with voices to duplicate the audio source and manipulate it through the filter.
+*/
+type AudioEffectChorus struct {
+	AudioEffect
+	owner gdnative.Object
+}
+
+// classConstructorAudioEffectChorus is the class constructor of the AudioEffectChorus class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectChorus = gdnative.NewLazyClassConstructor("AudioEffectChorus")
+
+// NewAudioEffectChorus will create a new instance of the AudioEffectChorus class.
+// AudioEffectChorus is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectChorus() *AudioEffectChorus {
+	obj := &AudioEffectChorus{}
+	obj.SetBaseObject(classConstructorAudioEffectChorus.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *AudioEffectChorus) BaseClass() string {
+	return "AudioEffectChorus"
+}
+
+// Method binds of the AudioEffectChorus class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectChorusGetDry           = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_dry")
+	methodBindAudioEffectChorusGetVoiceCount    = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_voice_count")
+	methodBindAudioEffectChorusGetVoiceCutoffHz = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_voice_cutoff_hz")
+	methodBindAudioEffectChorusGetVoiceDelayMs  = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_voice_delay_ms")
+	methodBindAudioEffectChorusGetVoiceDepthMs  = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_voice_depth_ms")
+	methodBindAudioEffectChorusGetVoiceLevelDb  = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_voice_level_db")
+	methodBindAudioEffectChorusGetVoicePan      = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_voice_pan")
+	methodBindAudioEffectChorusGetVoiceRateHz   = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_voice_rate_hz")
+	methodBindAudioEffectChorusGetWet           = gdnative.NewLazyMethodBind("AudioEffectChorus", "get_wet")
+	methodBindAudioEffectChorusSetDry           = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_dry")
+	methodBindAudioEffectChorusSetVoiceCount    = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_voice_count")
+	methodBindAudioEffectChorusSetVoiceCutoffHz = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_voice_cutoff_hz")
+	methodBindAudioEffectChorusSetVoiceDelayMs  = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_voice_delay_ms")
+	methodBindAudioEffectChorusSetVoiceDepthMs  = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_voice_depth_ms")
+	methodBindAudioEffectChorusSetVoiceLevelDb  = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_voice_level_db")
+	methodBindAudioEffectChorusSetVoicePan      = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_voice_pan")
+	methodBindAudioEffectChorusSetVoiceRateHz   = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_voice_rate_hz")
+	methodBindAudioEffectChorusSetWet           = gdnative.NewLazyMethodBind("AudioEffectChorus", "set_wet")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *AudioEffectChorus) GetDry() gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetDry()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetDry.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *AudioEffectChorus) GetVoiceCount() gdnative.Int {
+	//log.Println("Calling AudioEffectChorus.GetVoiceCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetVoiceCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int}], Returns: float
+*/
+func (o *AudioEffectChorus) GetVoiceCutoffHz(voiceIdx gdnative.Int) gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetVoiceCutoffHz()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetVoiceCutoffHz.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int}], Returns: float
+*/
+func (o *AudioEffectChorus) GetVoiceDelayMs(voiceIdx gdnative.Int) gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetVoiceDelayMs()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetVoiceDelayMs.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int}], Returns: float
+*/
+func (o *AudioEffectChorus) GetVoiceDepthMs(voiceIdx gdnative.Int) gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetVoiceDepthMs()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetVoiceDepthMs.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int}], Returns: float
+*/
+func (o *AudioEffectChorus) GetVoiceLevelDb(voiceIdx gdnative.Int) gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetVoiceLevelDb()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetVoiceLevelDb.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int}], Returns: float
+*/
+func (o *AudioEffectChorus) GetVoicePan(voiceIdx gdnative.Int) gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetVoicePan()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetVoicePan.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int}], Returns: float
+*/
+func (o *AudioEffectChorus) GetVoiceRateHz(voiceIdx gdnative.Int) gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetVoiceRateHz()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetVoiceRateHz.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *AudioEffectChorus) GetWet() gdnative.Real {
+	//log.Println("Calling AudioEffectChorus.GetWet()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusGetWet.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetDry(amount gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetDry()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetDry.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false voices int}], Returns: void
+*/
+func (o *AudioEffectChorus) SetVoiceCount(voices gdnative.Int) {
+	//log.Println("Calling AudioEffectChorus.SetVoiceCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voices)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetVoiceCount.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int} { false cutoff_hz float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetVoiceCutoffHz(voiceIdx gdnative.Int, cutoffHz gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetVoiceCutoffHz()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+	ptrArguments[1] = gdnative.NewPointerFromReal(cutoffHz)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetVoiceCutoffHz.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int} { false delay_ms float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetVoiceDelayMs(voiceIdx gdnative.Int, delayMs gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetVoiceDelayMs()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+	ptrArguments[1] = gdnative.NewPointerFromReal(delayMs)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetVoiceDelayMs.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int} { false depth_ms float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetVoiceDepthMs(voiceIdx gdnative.Int, depthMs gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetVoiceDepthMs()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+	ptrArguments[1] = gdnative.NewPointerFromReal(depthMs)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetVoiceDepthMs.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int} { false level_db float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetVoiceLevelDb(voiceIdx gdnative.Int, levelDb gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetVoiceLevelDb()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+	ptrArguments[1] = gdnative.NewPointerFromReal(levelDb)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetVoiceLevelDb.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int} { false pan float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetVoicePan(voiceIdx gdnative.Int, pan gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetVoicePan()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+	ptrArguments[1] = gdnative.NewPointerFromReal(pan)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetVoicePan.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false voice_idx int} { false rate_hz float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetVoiceRateHz(voiceIdx gdnative.Int, rateHz gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetVoiceRateHz()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(voiceIdx)
+	ptrArguments[1] = gdnative.NewPointerFromReal(rateHz)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetVoiceRateHz.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
+*/
+func (o *AudioEffectChorus) SetWet(amount gdnative.Real) {
+	//log.Println("Calling AudioEffectChorus.SetWet()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
+
+	// Get the method bind
+	methodBind := methodBindAudioEffectChorusSetWet.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Dry will return the value of the "dry" property.
+func (o *AudioEffectChorus) Dry() gdnative.Real {
+	return o.GetDry()
+}
+
+// Voice1CutoffHz will return the value of the "voice/1/cutoff_hz" property.
+func (o *AudioEffectChorus) Voice1CutoffHz() gdnative.Real {
+	return o.GetVoiceCutoffHz(0)
+}
+
+// SetVoice1CutoffHz will set the value of the "voice/1/cutoff_hz" property.
+func (o *AudioEffectChorus) SetVoice1CutoffHz(value gdnative.Real) {
+	o.SetVoiceCutoffHz(0, value)
+}
+
+// Voice1DelayMs will return the value of the "voice/1/delay_ms" property.
+func (o *AudioEffectChorus) Voice1DelayMs() gdnative.Real {
+	return o.GetVoiceDelayMs(0)
+}
+
+// SetVoice1DelayMs will set the value of the "voice/1/delay_ms" property.
+func (o *AudioEffectChorus) SetVoice1DelayMs(value gdnative.Real) {
+	o.SetVoiceDelayMs(0, value)
+}
+
+// Voice1DepthMs will return the value of the "voice/1/depth_ms" property.
+func (o *AudioEffectChorus) Voice1DepthMs() gdnative.Real {
+	return o.GetVoiceDepthMs(0)
+}
+
+// SetVoice1DepthMs will set the value of the "voice/1/depth_ms" property.
+func (o *AudioEffectChorus) SetVoice1DepthMs(value gdnative.Real) {
+	o.SetVoiceDepthMs(0, value)
+}
+
+// Voice1LevelDb will return the value of the "voice/1/level_db" property.
+func (o *AudioEffectChorus) Voice1LevelDb() gdnative.Real {
+	return o.GetVoiceLevelDb(0)
+}
+
+// SetVoice1LevelDb will set the value of the "voice/1/level_db" property.
+func (o *AudioEffectChorus) SetVoice1LevelDb(value gdnative.Real) {
+	o.SetVoiceLevelDb(0, value)
+}
+
+// Voice1Pan will return the value of the "voice/1/pan" property.
+func (o *AudioEffectChorus) Voice1Pan() gdnative.Real {
+	return o.GetVoicePan(0)
+}
+
+// SetVoice1Pan will set the value of the "voice/1/pan" property.
+func (o *AudioEffectChorus) SetVoice1Pan(value gdnative.Real) {
+	o.SetVoicePan(0, value)
+}
+
+// Voice1RateHz will return the value of the "voice/1/rate_hz" property.
+func (o *AudioEffectChorus) Voice1RateHz() gdnative.Real {
+	return o.GetVoiceRateHz(0)
+}
+
+// SetVoice1RateHz will set the value of the "voice/1/rate_hz" property.
+func (o *AudioEffectChorus) SetVoice1RateHz(value gdnative.Real) {
+	o.SetVoiceRateHz(0, value)
+}
+
+// Voice2CutoffHz will return the value of the "voice/2/cutoff_hz" property.
+func (o *AudioEffectChorus) Voice2CutoffHz() gdnative.Real {
+	return o.GetVoiceCutoffHz(1)
+}
+
+// SetVoice2CutoffHz will set the value of the "voice/2/cutoff_hz" property.
+func (o *AudioEffectChorus) SetVoice2CutoffHz(value gdnative.Real) {
+	o.SetVoiceCutoffHz(1, value)
+}
+
+// Voice2DelayMs will return the value of the "voice/2/delay_ms" property.
+func (o *AudioEffectChorus) Voice2DelayMs() gdnative.Real {
+	return o.GetVoiceDelayMs(1)
+}
+
+// SetVoice2DelayMs will set the value of the "voice/2/delay_ms" property.
+func (o *AudioEffectChorus) SetVoice2DelayMs(value gdnative.Real) {
+	o.SetVoiceDelayMs(1, value)
+}
+
+// Voice2DepthMs will return the value of the "voice/2/depth_ms" property.
+func (o *AudioEffectChorus) Voice2DepthMs() gdnative.Real {
+	return o.GetVoiceDepthMs(1)
+}
+
+// SetVoice2DepthMs will set the value of the "voice/2/depth_ms" property.
+func (o *AudioEffectChorus) SetVoice2DepthMs(value gdnative.Real) {
+	o.SetVoiceDepthMs(1, value)
+}
+
+// Voice2LevelDb will return the value of the "voice/2/level_db" property.
+func (o *AudioEffectChorus) Voice2LevelDb() gdnative.Real {
+	return o.GetVoiceLevelDb(1)
+}
+
+// SetVoice2LevelDb will set the value of the "voice/2/level_db" property.
+func (o *AudioEffectChorus) SetVoice2LevelDb(value gdnative.Real) {
+	o.SetVoiceLevelDb(1, value)
+}
+
+// Voice2Pan will return the value of the "voice/2/pan" property.
+func (o *AudioEffectChorus) Voice2Pan() gdnative.Real {
+	return o.GetVoicePan(1)
+}
+
+// SetVoice2Pan will set the value of the "voice/2/pan" property.
+func (o *AudioEffectChorus) SetVoice2Pan(value gdnative.Real) {
+	o.SetVoicePan(1, value)
+}
+
+// Voice2RateHz will return the value of the "voice/2/rate_hz" property.
+func (o *AudioEffectChorus) Voice2RateHz() gdnative.Real {
+	return o.GetVoiceRateHz(1)
+}
+
+// SetVoice2RateHz will set the value of the "voice/2/rate_hz" property.
+func (o *AudioEffectChorus) SetVoice2RateHz(value gdnative.Real) {
+	o.SetVoiceRateHz(1, value)
+}
+
+// Voice3CutoffHz will return the value of the "voice/3/cutoff_hz" property.
+func (o *AudioEffectChorus) Voice3CutoffHz() gdnative.Real {
+	return o.GetVoiceCutoffHz(2)
+}
+
+// SetVoice3CutoffHz will set the value of the "voice/3/cutoff_hz" property.
+func (o *AudioEffectChorus) SetVoice3CutoffHz(value gdnative.Real) {
+	o.SetVoiceCutoffHz(2, value)
+}
+
+// Voice3DelayMs will return the value of the "voice/3/delay_ms" property.
+func (o *AudioEffectChorus) Voice3DelayMs() gdnative.Real {
+	return o.GetVoiceDelayMs(2)
+}
+
+// SetVoice3DelayMs will set the value of the "voice/3/delay_ms" property.
+func (o *AudioEffectChorus) SetVoice3DelayMs(value gdnative.Real) {
+	o.SetVoiceDelayMs(2, value)
+}
+
+// Voice3DepthMs will return the value of the "voice/3/depth_ms" property.
+func (o *AudioEffectChorus) Voice3DepthMs() gdnative.Real {
+	return o.GetVoiceDepthMs(2)
+}
+
+// SetVoice3DepthMs will set the value of the "voice/3/depth_ms" property.
+func (o *AudioEffectChorus) SetVoice3DepthMs(value gdnative.Real) {
+	o.SetVoiceDepthMs(2, value)
+}
+
+// Voice3LevelDb will return the value of the "voice/3/level_db" property.
+func (o *AudioEffectChorus) Voice3LevelDb() gdnative.Real {
+	return o.GetVoiceLevelDb(2)
+}
+
+// SetVoice3LevelDb will set the value of the "voice/3/level_db" property.
+func (o *AudioEffectChorus) SetVoice3LevelDb(value gdnative.Real) {
+	o.SetVoiceLevelDb(2, value)
+}
+
+// Voice3Pan will return the value of the "voice/3/pan" property.
+func (o *AudioEffectChorus) Voice3Pan() gdnative.Real {
+	return o.GetVoicePan(2)
+}
+
+// SetVoice3Pan will set the value of the "voice/3/pan" property.
+func (o *AudioEffectChorus) SetVoice3Pan(value gdnative.Real) {
+	o.SetVoicePan(2, value)
+}
+
+// Voice3RateHz will return the value of the "voice/3/rate_hz" property.
+func (o *AudioEffectChorus) Voice3RateHz() gdnative.Real {
+	return o.GetVoiceRateHz(2)
+}
+
+// SetVoice3RateHz will set the value of the "voice/3/rate_hz" property.
+func (o *AudioEffectChorus) SetVoice3RateHz(value gdnative.Real) {
+	o.SetVoiceRateHz(2, value)
+}
+
+// Voice4CutoffHz will return the value of the "voice/4/cutoff_hz" property.
+func (o *AudioEffectChorus) Voice4CutoffHz() gdnative.Real {
+	return o.GetVoiceCutoffHz(3)
+}
+
+// SetVoice4CutoffHz will set the value of the "voice/4/cutoff_hz" property.
+func (o *AudioEffectChorus) SetVoice4CutoffHz(value gdnative.Real) {
+	o.SetVoiceCutoffHz(3, value)
+}
+
+// Voice4DelayMs will return the value of the "voice/4/delay_ms" property.
+func (o *AudioEffectChorus) Voice4DelayMs() gdnative.Real {
+	return o.GetVoiceDelayMs(3)
+}
+
+// SetVoice4DelayMs will set the value of the "voice/4/delay_ms" property.
+func (o *AudioEffectChorus) SetVoice4DelayMs(value gdnative.Real) {
+	o.SetVoiceDelayMs(3, value)
+}
+
+// Voice4DepthMs will return the value of the "voice/4/depth_ms" property.
+func (o *AudioEffectChorus) Voice4DepthMs() gdnative.Real {
+	return o.GetVoiceDepthMs(3)
+}
+
+// SetVoice4DepthMs will set the value of the "voice/4/depth_ms" property.
+func (o *AudioEffectChorus) SetVoice4DepthMs(value gdnative.Real) {
+	o.SetVoiceDepthMs(3, value)
+}
+
+// Voice4LevelDb will return the value of the "voice/4/level_db" property.
+func (o *AudioEffectChorus) Voice4LevelDb() gdnative.Real {
+	return o.GetVoiceLevelDb(3)
+}
+
+// SetVoice4LevelDb will set the value of the "voice/4/level_db" property.
+func (o *AudioEffectChorus) SetVoice4LevelDb(value gdnative.Real) {
+	o.SetVoiceLevelDb(3, value)
+}
+
+// Voice4Pan will return the value of the "voice/4/pan" property.
+func (o *AudioEffectChorus) Voice4Pan() gdnative.Real {
+	return o.GetVoicePan(3)
+}
+
+// SetVoice4Pan will set the value of the "voice/4/pan" property.
+func (o *AudioEffectChorus) SetVoice4Pan(value gdnative.Real) {
+	o.SetVoicePan(3, value)
+}
+
+// Voice4RateHz will return the value of the "voice/4/rate_hz" property.
+func (o *AudioEffectChorus) Voice4RateHz() gdnative.Real {
+	return o.GetVoiceRateHz(3)
+}
+
+// SetVoice4RateHz will set the value of the "voice/4/rate_hz" property.
+func (o *AudioEffectChorus) SetVoice4RateHz(value gdnative.Real) {
+	o.SetVoiceRateHz(3, value)
+}
+
+// VoiceCount will return the value of the "voice_count" property.
+func (o *AudioEffectChorus) VoiceCount() gdnative.Int {
+	return o.GetVoiceCount()
+}
+
+// Wet will return the value of the "wet" property.
+func (o *AudioEffectChorus) Wet() gdnative.Real {
+	return o.GetWet()
+}
+
+// AudioEffectChorusImplementer is an interface that implements the methods
+// of the AudioEffectChorus class.
+type AudioEffectChorusImplementer interface {
+	AudioEffectImplementer
+	GetDry() gdnative.Real
+	GetVoiceCount() gdnative.Int
+	GetVoiceCutoffHz(voiceIdx gdnative.Int) gdnative.Real
+	GetVoiceDelayMs(voiceIdx gdnative.Int) gdnative.Real
+	GetVoiceDepthMs(voiceIdx gdnative.Int) gdnative.Real
+	GetVoiceLevelDb(voiceIdx gdnative.Int) gdnative.Real
+	GetVoicePan(voiceIdx gdnative.Int) gdnative.Real
+	GetVoiceRateHz(voiceIdx gdnative.Int) gdnative.Real
+	GetWet() gdnative.Real
+	SetDry(amount gdnative.Real)
+	SetVoiceCount(voices gdnative.Int)
+	SetVoiceCutoffHz(voiceIdx gdnative.Int, cutoffHz gdnative.Real)
+	SetVoiceDelayMs(voiceIdx gdnative.Int, delayMs gdnative.Real)
+	SetVoiceDepthMs(voiceIdx gdnative.Int, depthMs gdnative.Real)
+	SetVoiceLevelDb(voiceIdx gdnative.Int, levelDb gdnative.Real)
+	SetVoicePan(voiceIdx gdnative.Int, pan gdnative.Real)
+	SetVoiceRateHz(voiceIdx gdnative.Int, rateHz gdnative.Real)
+	SetWet(amount gdnative.Real)
+	Dry() gdnative.Real
+	Voice1CutoffHz() gdnative.Real
+	SetVoice1CutoffHz(value gdnative.Real)
+	Voice1DelayMs() gdnative.Real
+	SetVoice1DelayMs(value gdnative.Real)
+	Voice1DepthMs() gdnative.Real
+	SetVoice1DepthMs(value gdnative.Real)
+	Voice1LevelDb() gdnative.Real
+	SetVoice1LevelDb(value gdnative.Real)
+	Voice1Pan() gdnative.Real
+	SetVoice1Pan(value gdnative.Real)
+	Voice1RateHz() gdnative.Real
+	SetVoice1RateHz(value gdnative.Real)
+	Voice2CutoffHz() gdnative.Real
+	SetVoice2CutoffHz(value gdnative.Real)
+	Voice2DelayMs() gdnative.Real
+	SetVoice2DelayMs(value gdnative.Real)
+	Voice2DepthMs() gdnative.Real
+	SetVoice2DepthMs(value gdnative.Real)
+	Voice2LevelDb() gdnative.Real
+	SetVoice2LevelDb(value gdnative.Real)
+	Voice2Pan() gdnative.Real
+	SetVoice2Pan(value gdnative.Real)
+	Voice2RateHz() gdnative.Real
+	SetVoice2RateHz(value gdnative.Real)
+	Voice3CutoffHz() gdnative.Real
+	SetVoice3CutoffHz(value gdnative.Real)
+	Voice3DelayMs() gdnative.Real
+	SetVoice3DelayMs(value gdnative.Real)
+	Voice3DepthMs() gdnative.Real
+	SetVoice3DepthMs(value gdnative.Real)
+	Voice3LevelDb() gdnative.Real
+	SetVoice3LevelDb(value gdnative.Real)
+	Voice3Pan() gdnative.Real
+	SetVoice3Pan(value gdnative.Real)
+	Voice3RateHz() gdnative.Real
+	SetVoice3RateHz(value gdnative.Real)
+	Voice4CutoffHz() gdnative.Real
+	SetVoice4CutoffHz(value gdnative.Real)
+	Voice4DelayMs() gdnative.Real
+	SetVoice4DelayMs(value gdnative.Real)
+	Voice4DepthMs() gdnative.Real
+	SetVoice4DepthMs(value gdnative.Real)
+	Voice4LevelDb() gdnative.Real
+	SetVoice4LevelDb(value gdnative.Real)
+	Voice4Pan() gdnative.Real
+	SetVoice4Pan(value gdnative.Real)
+	Voice4RateHz() gdnative.Real
+	SetVoice4RateHz(value gdnative.Real)
+	VoiceCount() gdnative.Int
+	Wet() gdnative.Real
+}
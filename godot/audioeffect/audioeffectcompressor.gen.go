@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectCompressorFromPointer(ptr gdnative.Pointer) AudioEffectCompressor {
-func newAudioEffectCompressorFromPointer(ptr gdnative.Pointer) AudioEffectCompressor {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectCompressor{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Dynamic range compressor reduces the level of the sound when the amplitude goes over a certain threshold in Decibels. One of the main uses of a compressor is to increase the dynamic range by clipping as little as possible (when sound goes over 0dB). Compressor has many uses in the mix: - In the Master bus to compress the whole output (Although a [AudioEffectLimiter] is probably better) - In voice channels to ensure they sound as balanced as possible. - Sidechained. Sidechained, which can reduce the sound level sidechained with another audio bus for threshold detection.. This technique is very common in video game mixing to download the level of Music/SFX while voices are being heard. - Accentuates transients by using a wider attack, making effects sound more punchy.
 */
@@ -30,13 +22,49 @@ type AudioEffectCompressor struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectCompressor is the class constructor of the AudioEffectCompressor class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectCompressor = gdnative.NewLazyClassConstructor("AudioEffectCompressor")
+
+// NewAudioEffectCompressor will create a new instance of the AudioEffectCompressor class.
+// AudioEffectCompressor is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectCompressor() *AudioEffectCompressor {
+	obj := &AudioEffectCompressor{}
+	obj.SetBaseObject(classConstructorAudioEffectCompressor.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectCompressor) BaseClass() string {
 	return "AudioEffectCompressor"
 }
 
+// Method binds of the AudioEffectCompressor class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectCompressorGetAttackUs  = gdnative.NewLazyMethodBind("AudioEffectCompressor", "get_attack_us")
+	methodBindAudioEffectCompressorGetGain      = gdnative.NewLazyMethodBind("AudioEffectCompressor", "get_gain")
+	methodBindAudioEffectCompressorGetMix       = gdnative.NewLazyMethodBind("AudioEffectCompressor", "get_mix")
+	methodBindAudioEffectCompressorGetRatio     = gdnative.NewLazyMethodBind("AudioEffectCompressor", "get_ratio")
+	methodBindAudioEffectCompressorGetReleaseMs = gdnative.NewLazyMethodBind("AudioEffectCompressor", "get_release_ms")
+	methodBindAudioEffectCompressorGetSidechain = gdnative.NewLazyMethodBind("AudioEffectCompressor", "get_sidechain")
+	methodBindAudioEffectCompressorGetThreshold = gdnative.NewLazyMethodBind("AudioEffectCompressor", "get_threshold")
+	methodBindAudioEffectCompressorSetAttackUs  = gdnative.NewLazyMethodBind("AudioEffectCompressor", "set_attack_us")
+	methodBindAudioEffectCompressorSetGain      = gdnative.NewLazyMethodBind("AudioEffectCompressor", "set_gain")
+	methodBindAudioEffectCompressorSetMix       = gdnative.NewLazyMethodBind("AudioEffectCompressor", "set_mix")
+	methodBindAudioEffectCompressorSetRatio     = gdnative.NewLazyMethodBind("AudioEffectCompressor", "set_ratio")
+	methodBindAudioEffectCompressorSetReleaseMs = gdnative.NewLazyMethodBind("AudioEffectCompressor", "set_release_ms")
+	methodBindAudioEffectCompressorSetSidechain = gdnative.NewLazyMethodBind("AudioEffectCompressor", "set_sidechain")
+	methodBindAudioEffectCompressorSetThreshold = gdnative.NewLazyMethodBind("AudioEffectCompressor", "set_threshold")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectCompressor) GetAttackUs() gdnative.Real {
 	//log.Println("Calling AudioEffectCompressor.GetAttackUs()")
@@ -45,7 +73,7 @@ func (o *AudioEffectCompressor) GetAttackUs() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "get_attack_us")
+	methodBind := methodBindAudioEffectCompressorGetAttackUs.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +86,8 @@ func (o *AudioEffectCompressor) GetAttackUs() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectCompressor) GetGain() gdnative.Real {
 	//log.Println("Calling AudioEffectCompressor.GetGain()")
@@ -68,7 +96,7 @@ func (o *AudioEffectCompressor) GetGain() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "get_gain")
+	methodBind := methodBindAudioEffectCompressorGetGain.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +109,8 @@ func (o *AudioEffectCompressor) GetGain() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectCompressor) GetMix() gdnative.Real {
 	//log.Println("Calling AudioEffectCompressor.GetMix()")
@@ -91,7 +119,7 @@ func (o *AudioEffectCompressor) GetMix() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "get_mix")
+	methodBind := methodBindAudioEffectCompressorGetMix.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +132,8 @@ func (o *AudioEffectCompressor) GetMix() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectCompressor) GetRatio() gdnative.Real {
 	//log.Println("Calling AudioEffectCompressor.GetRatio()")
@@ -114,7 +142,7 @@ func (o *AudioEffectCompressor) GetRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "get_ratio")
+	methodBind := methodBindAudioEffectCompressorGetRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +155,8 @@ func (o *AudioEffectCompressor) GetRatio() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectCompressor) GetReleaseMs() gdnative.Real {
 	//log.Println("Calling AudioEffectCompressor.GetReleaseMs()")
@@ -137,7 +165,7 @@ func (o *AudioEffectCompressor) GetReleaseMs() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "get_release_ms")
+	methodBind := methodBindAudioEffectCompressorGetReleaseMs.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +178,8 @@ func (o *AudioEffectCompressor) GetReleaseMs() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AudioEffectCompressor) GetSidechain() gdnative.String {
 	//log.Println("Calling AudioEffectCompressor.GetSidechain()")
@@ -160,7 +188,7 @@ func (o *AudioEffectCompressor) GetSidechain() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "get_sidechain")
+	methodBind := methodBindAudioEffectCompressorGetSidechain.Get()
 
 	// Call the parent method.
 	// String
@@ -173,8 +201,8 @@ func (o *AudioEffectCompressor) GetSidechain() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectCompressor) GetThreshold() gdnative.Real {
 	//log.Println("Calling AudioEffectCompressor.GetThreshold()")
@@ -183,7 +211,7 @@ func (o *AudioEffectCompressor) GetThreshold() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "get_threshold")
+	methodBind := methodBindAudioEffectCompressorGetThreshold.Get()
 
 	// Call the parent method.
 	// float
@@ -196,8 +224,8 @@ func (o *AudioEffectCompressor) GetThreshold() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false attack_us float}], Returns: void
+	        Undocumented
+		Args: [{ false attack_us float}], Returns: void
 */
 func (o *AudioEffectCompressor) SetAttackUs(attackUs gdnative.Real) {
 	//log.Println("Calling AudioEffectCompressor.SetAttackUs()")
@@ -207,7 +235,7 @@ func (o *AudioEffectCompressor) SetAttackUs(attackUs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(attackUs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "set_attack_us")
+	methodBind := methodBindAudioEffectCompressorSetAttackUs.Get()
 
 	// Call the parent method.
 	// void
@@ -217,8 +245,8 @@ func (o *AudioEffectCompressor) SetAttackUs(attackUs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false gain float}], Returns: void
+	        Undocumented
+		Args: [{ false gain float}], Returns: void
 */
 func (o *AudioEffectCompressor) SetGain(gain gdnative.Real) {
 	//log.Println("Calling AudioEffectCompressor.SetGain()")
@@ -228,7 +256,7 @@ func (o *AudioEffectCompressor) SetGain(gain gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(gain)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "set_gain")
+	methodBind := methodBindAudioEffectCompressorSetGain.Get()
 
 	// Call the parent method.
 	// void
@@ -238,8 +266,8 @@ func (o *AudioEffectCompressor) SetGain(gain gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mix float}], Returns: void
+	        Undocumented
+		Args: [{ false mix float}], Returns: void
 */
 func (o *AudioEffectCompressor) SetMix(mix gdnative.Real) {
 	//log.Println("Calling AudioEffectCompressor.SetMix()")
@@ -249,7 +277,7 @@ func (o *AudioEffectCompressor) SetMix(mix gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(mix)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "set_mix")
+	methodBind := methodBindAudioEffectCompressorSetMix.Get()
 
 	// Call the parent method.
 	// void
@@ -259,8 +287,8 @@ func (o *AudioEffectCompressor) SetMix(mix gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ratio float}], Returns: void
+	        Undocumented
+		Args: [{ false ratio float}], Returns: void
 */
 func (o *AudioEffectCompressor) SetRatio(ratio gdnative.Real) {
 	//log.Println("Calling AudioEffectCompressor.SetRatio()")
@@ -270,7 +298,7 @@ func (o *AudioEffectCompressor) SetRatio(ratio gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "set_ratio")
+	methodBind := methodBindAudioEffectCompressorSetRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -280,8 +308,8 @@ func (o *AudioEffectCompressor) SetRatio(ratio gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false release_ms float}], Returns: void
+	        Undocumented
+		Args: [{ false release_ms float}], Returns: void
 */
 func (o *AudioEffectCompressor) SetReleaseMs(releaseMs gdnative.Real) {
 	//log.Println("Calling AudioEffectCompressor.SetReleaseMs()")
@@ -291,7 +319,7 @@ func (o *AudioEffectCompressor) SetReleaseMs(releaseMs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(releaseMs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "set_release_ms")
+	methodBind := methodBindAudioEffectCompressorSetReleaseMs.Get()
 
 	// Call the parent method.
 	// void
@@ -301,8 +329,8 @@ func (o *AudioEffectCompressor) SetReleaseMs(releaseMs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false sidechain String}], Returns: void
+	        Undocumented
+		Args: [{ false sidechain String}], Returns: void
 */
 func (o *AudioEffectCompressor) SetSidechain(sidechain gdnative.String) {
 	//log.Println("Calling AudioEffectCompressor.SetSidechain()")
@@ -312,7 +340,7 @@ func (o *AudioEffectCompressor) SetSidechain(sidechain gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(sidechain)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "set_sidechain")
+	methodBind := methodBindAudioEffectCompressorSetSidechain.Get()
 
 	// Call the parent method.
 	// void
@@ -322,8 +350,8 @@ func (o *AudioEffectCompressor) SetSidechain(sidechain gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false threshold float}], Returns: void
+	        Undocumented
+		Args: [{ false threshold float}], Returns: void
 */
 func (o *AudioEffectCompressor) SetThreshold(threshold gdnative.Real) {
 	//log.Println("Calling AudioEffectCompressor.SetThreshold()")
@@ -333,7 +361,7 @@ func (o *AudioEffectCompressor) SetThreshold(threshold gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(threshold)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectCompressor", "set_threshold")
+	methodBind := methodBindAudioEffectCompressorSetThreshold.Get()
 
 	// Call the parent method.
 	// void
@@ -342,6 +370,41 @@ func (o *AudioEffectCompressor) SetThreshold(threshold gdnative.Real) {
 
 }
 
+// AttackUs will return the value of the "attack_us" property.
+func (o *AudioEffectCompressor) AttackUs() gdnative.Real {
+	return o.GetAttackUs()
+}
+
+// Gain will return the value of the "gain" property.
+func (o *AudioEffectCompressor) Gain() gdnative.Real {
+	return o.GetGain()
+}
+
+// Mix will return the value of the "mix" property.
+func (o *AudioEffectCompressor) Mix() gdnative.Real {
+	return o.GetMix()
+}
+
+// Ratio will return the value of the "ratio" property.
+func (o *AudioEffectCompressor) Ratio() gdnative.Real {
+	return o.GetRatio()
+}
+
+// ReleaseMs will return the value of the "release_ms" property.
+func (o *AudioEffectCompressor) ReleaseMs() gdnative.Real {
+	return o.GetReleaseMs()
+}
+
+// Sidechain will return the value of the "sidechain" property.
+func (o *AudioEffectCompressor) Sidechain() gdnative.String {
+	return o.GetSidechain()
+}
+
+// Threshold will return the value of the "threshold" property.
+func (o *AudioEffectCompressor) Threshold() gdnative.Real {
+	return o.GetThreshold()
+}
+
 // AudioEffectCompressorImplementer is an interface that implements the methods
 // of the AudioEffectCompressor class.
 type AudioEffectCompressorImplementer interface {
@@ -360,4 +423,11 @@ type AudioEffectCompressorImplementer interface {
 	SetReleaseMs(releaseMs gdnative.Real)
 	SetSidechain(sidechain gdnative.String)
 	SetThreshold(threshold gdnative.Real)
+	AttackUs() gdnative.Real
+	Gain() gdnative.Real
+	Mix() gdnative.Real
+	Ratio() gdnative.Real
+	ReleaseMs() gdnative.Real
+	Sidechain() gdnative.String
+	Threshold() gdnative.Real
 }
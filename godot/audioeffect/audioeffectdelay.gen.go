@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectDelayFromPointer(ptr gdnative.Pointer) AudioEffectDelay {
-func newAudioEffectDelayFromPointer(ptr gdnative.Pointer) AudioEffectDelay {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectDelay{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Plays input signal back after a period of time. The delayed signal may be played back multiple times to create the sound of a repeating, decaying echo. Delay effects range from a subtle echo effect to a pronounced blending of previous sounds with new sounds.
 */
@@ -30,13 +22,61 @@ type AudioEffectDelay struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectDelay is the class constructor of the AudioEffectDelay class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectDelay = gdnative.NewLazyClassConstructor("AudioEffectDelay")
+
+// NewAudioEffectDelay will create a new instance of the AudioEffectDelay class.
+// AudioEffectDelay is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectDelay() *AudioEffectDelay {
+	obj := &AudioEffectDelay{}
+	obj.SetBaseObject(classConstructorAudioEffectDelay.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectDelay) BaseClass() string {
 	return "AudioEffectDelay"
 }
 
+// Method binds of the AudioEffectDelay class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectDelayGetDry             = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_dry")
+	methodBindAudioEffectDelayGetFeedbackDelayMs = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_feedback_delay_ms")
+	methodBindAudioEffectDelayGetFeedbackLevelDb = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_feedback_level_db")
+	methodBindAudioEffectDelayGetFeedbackLowpass = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_feedback_lowpass")
+	methodBindAudioEffectDelayGetTap1DelayMs     = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_tap1_delay_ms")
+	methodBindAudioEffectDelayGetTap1LevelDb     = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_tap1_level_db")
+	methodBindAudioEffectDelayGetTap1Pan         = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_tap1_pan")
+	methodBindAudioEffectDelayGetTap2DelayMs     = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_tap2_delay_ms")
+	methodBindAudioEffectDelayGetTap2LevelDb     = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_tap2_level_db")
+	methodBindAudioEffectDelayGetTap2Pan         = gdnative.NewLazyMethodBind("AudioEffectDelay", "get_tap2_pan")
+	methodBindAudioEffectDelayIsFeedbackActive   = gdnative.NewLazyMethodBind("AudioEffectDelay", "is_feedback_active")
+	methodBindAudioEffectDelayIsTap1Active       = gdnative.NewLazyMethodBind("AudioEffectDelay", "is_tap1_active")
+	methodBindAudioEffectDelayIsTap2Active       = gdnative.NewLazyMethodBind("AudioEffectDelay", "is_tap2_active")
+	methodBindAudioEffectDelaySetDry             = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_dry")
+	methodBindAudioEffectDelaySetFeedbackActive  = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_feedback_active")
+	methodBindAudioEffectDelaySetFeedbackDelayMs = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_feedback_delay_ms")
+	methodBindAudioEffectDelaySetFeedbackLevelDb = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_feedback_level_db")
+	methodBindAudioEffectDelaySetFeedbackLowpass = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_feedback_lowpass")
+	methodBindAudioEffectDelaySetTap1Active      = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap1_active")
+	methodBindAudioEffectDelaySetTap1DelayMs     = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap1_delay_ms")
+	methodBindAudioEffectDelaySetTap1LevelDb     = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap1_level_db")
+	methodBindAudioEffectDelaySetTap1Pan         = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap1_pan")
+	methodBindAudioEffectDelaySetTap2Active      = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap2_active")
+	methodBindAudioEffectDelaySetTap2DelayMs     = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap2_delay_ms")
+	methodBindAudioEffectDelaySetTap2LevelDb     = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap2_level_db")
+	methodBindAudioEffectDelaySetTap2Pan         = gdnative.NewLazyMethodBind("AudioEffectDelay", "set_tap2_pan")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetDry() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetDry()")
@@ -45,7 +85,7 @@ func (o *AudioEffectDelay) GetDry() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_dry")
+	methodBind := methodBindAudioEffectDelayGetDry.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +98,8 @@ func (o *AudioEffectDelay) GetDry() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetFeedbackDelayMs() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetFeedbackDelayMs()")
@@ -68,7 +108,7 @@ func (o *AudioEffectDelay) GetFeedbackDelayMs() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_feedback_delay_ms")
+	methodBind := methodBindAudioEffectDelayGetFeedbackDelayMs.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +121,8 @@ func (o *AudioEffectDelay) GetFeedbackDelayMs() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetFeedbackLevelDb() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetFeedbackLevelDb()")
@@ -91,7 +131,7 @@ func (o *AudioEffectDelay) GetFeedbackLevelDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_feedback_level_db")
+	methodBind := methodBindAudioEffectDelayGetFeedbackLevelDb.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +144,8 @@ func (o *AudioEffectDelay) GetFeedbackLevelDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetFeedbackLowpass() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetFeedbackLowpass()")
@@ -114,7 +154,7 @@ func (o *AudioEffectDelay) GetFeedbackLowpass() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_feedback_lowpass")
+	methodBind := methodBindAudioEffectDelayGetFeedbackLowpass.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +167,8 @@ func (o *AudioEffectDelay) GetFeedbackLowpass() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetTap1DelayMs() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetTap1DelayMs()")
@@ -137,7 +177,7 @@ func (o *AudioEffectDelay) GetTap1DelayMs() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_tap1_delay_ms")
+	methodBind := methodBindAudioEffectDelayGetTap1DelayMs.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +190,8 @@ func (o *AudioEffectDelay) GetTap1DelayMs() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetTap1LevelDb() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetTap1LevelDb()")
@@ -160,7 +200,7 @@ func (o *AudioEffectDelay) GetTap1LevelDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_tap1_level_db")
+	methodBind := methodBindAudioEffectDelayGetTap1LevelDb.Get()
 
 	// Call the parent method.
 	// float
@@ -173,8 +213,8 @@ func (o *AudioEffectDelay) GetTap1LevelDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetTap1Pan() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetTap1Pan()")
@@ -183,7 +223,7 @@ func (o *AudioEffectDelay) GetTap1Pan() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_tap1_pan")
+	methodBind := methodBindAudioEffectDelayGetTap1Pan.Get()
 
 	// Call the parent method.
 	// float
@@ -196,8 +236,8 @@ func (o *AudioEffectDelay) GetTap1Pan() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetTap2DelayMs() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetTap2DelayMs()")
@@ -206,7 +246,7 @@ func (o *AudioEffectDelay) GetTap2DelayMs() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_tap2_delay_ms")
+	methodBind := methodBindAudioEffectDelayGetTap2DelayMs.Get()
 
 	// Call the parent method.
 	// float
@@ -219,8 +259,8 @@ func (o *AudioEffectDelay) GetTap2DelayMs() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetTap2LevelDb() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetTap2LevelDb()")
@@ -229,7 +269,7 @@ func (o *AudioEffectDelay) GetTap2LevelDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_tap2_level_db")
+	methodBind := methodBindAudioEffectDelayGetTap2LevelDb.Get()
 
 	// Call the parent method.
 	// float
@@ -242,8 +282,8 @@ func (o *AudioEffectDelay) GetTap2LevelDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDelay) GetTap2Pan() gdnative.Real {
 	//log.Println("Calling AudioEffectDelay.GetTap2Pan()")
@@ -252,7 +292,7 @@ func (o *AudioEffectDelay) GetTap2Pan() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "get_tap2_pan")
+	methodBind := methodBindAudioEffectDelayGetTap2Pan.Get()
 
 	// Call the parent method.
 	// float
@@ -265,8 +305,8 @@ func (o *AudioEffectDelay) GetTap2Pan() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioEffectDelay) IsFeedbackActive() gdnative.Bool {
 	//log.Println("Calling AudioEffectDelay.IsFeedbackActive()")
@@ -275,7 +315,7 @@ func (o *AudioEffectDelay) IsFeedbackActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "is_feedback_active")
+	methodBind := methodBindAudioEffectDelayIsFeedbackActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -288,8 +328,8 @@ func (o *AudioEffectDelay) IsFeedbackActive() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioEffectDelay) IsTap1Active() gdnative.Bool {
 	//log.Println("Calling AudioEffectDelay.IsTap1Active()")
@@ -298,7 +338,7 @@ func (o *AudioEffectDelay) IsTap1Active() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "is_tap1_active")
+	methodBind := methodBindAudioEffectDelayIsTap1Active.Get()
 
 	// Call the parent method.
 	// bool
@@ -311,8 +351,8 @@ func (o *AudioEffectDelay) IsTap1Active() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioEffectDelay) IsTap2Active() gdnative.Bool {
 	//log.Println("Calling AudioEffectDelay.IsTap2Active()")
@@ -321,7 +361,7 @@ func (o *AudioEffectDelay) IsTap2Active() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "is_tap2_active")
+	methodBind := methodBindAudioEffectDelayIsTap2Active.Get()
 
 	// Call the parent method.
 	// bool
@@ -334,8 +374,8 @@ func (o *AudioEffectDelay) IsTap2Active() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetDry(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetDry()")
@@ -345,7 +385,7 @@ func (o *AudioEffectDelay) SetDry(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_dry")
+	methodBind := methodBindAudioEffectDelaySetDry.Get()
 
 	// Call the parent method.
 	// void
@@ -355,8 +395,8 @@ func (o *AudioEffectDelay) SetDry(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount bool}], Returns: void
+	        Undocumented
+		Args: [{ false amount bool}], Returns: void
 */
 func (o *AudioEffectDelay) SetFeedbackActive(amount gdnative.Bool) {
 	//log.Println("Calling AudioEffectDelay.SetFeedbackActive()")
@@ -366,7 +406,7 @@ func (o *AudioEffectDelay) SetFeedbackActive(amount gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_feedback_active")
+	methodBind := methodBindAudioEffectDelaySetFeedbackActive.Get()
 
 	// Call the parent method.
 	// void
@@ -376,8 +416,8 @@ func (o *AudioEffectDelay) SetFeedbackActive(amount gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetFeedbackDelayMs(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetFeedbackDelayMs()")
@@ -387,7 +427,7 @@ func (o *AudioEffectDelay) SetFeedbackDelayMs(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_feedback_delay_ms")
+	methodBind := methodBindAudioEffectDelaySetFeedbackDelayMs.Get()
 
 	// Call the parent method.
 	// void
@@ -397,8 +437,8 @@ func (o *AudioEffectDelay) SetFeedbackDelayMs(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetFeedbackLevelDb(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetFeedbackLevelDb()")
@@ -408,7 +448,7 @@ func (o *AudioEffectDelay) SetFeedbackLevelDb(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_feedback_level_db")
+	methodBind := methodBindAudioEffectDelaySetFeedbackLevelDb.Get()
 
 	// Call the parent method.
 	// void
@@ -418,8 +458,8 @@ func (o *AudioEffectDelay) SetFeedbackLevelDb(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetFeedbackLowpass(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetFeedbackLowpass()")
@@ -429,7 +469,7 @@ func (o *AudioEffectDelay) SetFeedbackLowpass(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_feedback_lowpass")
+	methodBind := methodBindAudioEffectDelaySetFeedbackLowpass.Get()
 
 	// Call the parent method.
 	// void
@@ -439,8 +479,8 @@ func (o *AudioEffectDelay) SetFeedbackLowpass(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount bool}], Returns: void
+	        Undocumented
+		Args: [{ false amount bool}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap1Active(amount gdnative.Bool) {
 	//log.Println("Calling AudioEffectDelay.SetTap1Active()")
@@ -450,7 +490,7 @@ func (o *AudioEffectDelay) SetTap1Active(amount gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap1_active")
+	methodBind := methodBindAudioEffectDelaySetTap1Active.Get()
 
 	// Call the parent method.
 	// void
@@ -460,8 +500,8 @@ func (o *AudioEffectDelay) SetTap1Active(amount gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap1DelayMs(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetTap1DelayMs()")
@@ -471,7 +511,7 @@ func (o *AudioEffectDelay) SetTap1DelayMs(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap1_delay_ms")
+	methodBind := methodBindAudioEffectDelaySetTap1DelayMs.Get()
 
 	// Call the parent method.
 	// void
@@ -481,8 +521,8 @@ func (o *AudioEffectDelay) SetTap1DelayMs(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap1LevelDb(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetTap1LevelDb()")
@@ -492,7 +532,7 @@ func (o *AudioEffectDelay) SetTap1LevelDb(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap1_level_db")
+	methodBind := methodBindAudioEffectDelaySetTap1LevelDb.Get()
 
 	// Call the parent method.
 	// void
@@ -502,8 +542,8 @@ func (o *AudioEffectDelay) SetTap1LevelDb(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap1Pan(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetTap1Pan()")
@@ -513,7 +553,7 @@ func (o *AudioEffectDelay) SetTap1Pan(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap1_pan")
+	methodBind := methodBindAudioEffectDelaySetTap1Pan.Get()
 
 	// Call the parent method.
 	// void
@@ -523,8 +563,8 @@ func (o *AudioEffectDelay) SetTap1Pan(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount bool}], Returns: void
+	        Undocumented
+		Args: [{ false amount bool}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap2Active(amount gdnative.Bool) {
 	//log.Println("Calling AudioEffectDelay.SetTap2Active()")
@@ -534,7 +574,7 @@ func (o *AudioEffectDelay) SetTap2Active(amount gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap2_active")
+	methodBind := methodBindAudioEffectDelaySetTap2Active.Get()
 
 	// Call the parent method.
 	// void
@@ -544,8 +584,8 @@ func (o *AudioEffectDelay) SetTap2Active(amount gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap2DelayMs(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetTap2DelayMs()")
@@ -555,7 +595,7 @@ func (o *AudioEffectDelay) SetTap2DelayMs(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap2_delay_ms")
+	methodBind := methodBindAudioEffectDelaySetTap2DelayMs.Get()
 
 	// Call the parent method.
 	// void
@@ -565,8 +605,8 @@ func (o *AudioEffectDelay) SetTap2DelayMs(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap2LevelDb(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetTap2LevelDb()")
@@ -576,7 +616,7 @@ func (o *AudioEffectDelay) SetTap2LevelDb(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap2_level_db")
+	methodBind := methodBindAudioEffectDelaySetTap2LevelDb.Get()
 
 	// Call the parent method.
 	// void
@@ -586,8 +626,8 @@ func (o *AudioEffectDelay) SetTap2LevelDb(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectDelay) SetTap2Pan(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectDelay.SetTap2Pan()")
@@ -597,7 +637,7 @@ func (o *AudioEffectDelay) SetTap2Pan(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDelay", "set_tap2_pan")
+	methodBind := methodBindAudioEffectDelaySetTap2Pan.Get()
 
 	// Call the parent method.
 	// void
@@ -606,6 +646,71 @@ func (o *AudioEffectDelay) SetTap2Pan(amount gdnative.Real) {
 
 }
 
+// Dry will return the value of the "dry" property.
+func (o *AudioEffectDelay) Dry() gdnative.Real {
+	return o.GetDry()
+}
+
+// FeedbackActive will return the value of the "feedback/active" property.
+func (o *AudioEffectDelay) FeedbackActive() gdnative.Bool {
+	return o.IsFeedbackActive()
+}
+
+// FeedbackDelayMs will return the value of the "feedback/delay_ms" property.
+func (o *AudioEffectDelay) FeedbackDelayMs() gdnative.Real {
+	return o.GetFeedbackDelayMs()
+}
+
+// FeedbackLevelDb will return the value of the "feedback/level_db" property.
+func (o *AudioEffectDelay) FeedbackLevelDb() gdnative.Real {
+	return o.GetFeedbackLevelDb()
+}
+
+// FeedbackLowpass will return the value of the "feedback/lowpass" property.
+func (o *AudioEffectDelay) FeedbackLowpass() gdnative.Real {
+	return o.GetFeedbackLowpass()
+}
+
+// Tap1Active will return the value of the "tap1/active" property.
+func (o *AudioEffectDelay) Tap1Active() gdnative.Bool {
+	return o.IsTap1Active()
+}
+
+// Tap1DelayMs will return the value of the "tap1/delay_ms" property.
+func (o *AudioEffectDelay) Tap1DelayMs() gdnative.Real {
+	return o.GetTap1DelayMs()
+}
+
+// Tap1LevelDb will return the value of the "tap1/level_db" property.
+func (o *AudioEffectDelay) Tap1LevelDb() gdnative.Real {
+	return o.GetTap1LevelDb()
+}
+
+// Tap1Pan will return the value of the "tap1/pan" property.
+func (o *AudioEffectDelay) Tap1Pan() gdnative.Real {
+	return o.GetTap1Pan()
+}
+
+// Tap2Active will return the value of the "tap2/active" property.
+func (o *AudioEffectDelay) Tap2Active() gdnative.Bool {
+	return o.IsTap2Active()
+}
+
+// Tap2DelayMs will return the value of the "tap2/delay_ms" property.
+func (o *AudioEffectDelay) Tap2DelayMs() gdnative.Real {
+	return o.GetTap2DelayMs()
+}
+
+// Tap2LevelDb will return the value of the "tap2/level_db" property.
+func (o *AudioEffectDelay) Tap2LevelDb() gdnative.Real {
+	return o.GetTap2LevelDb()
+}
+
+// Tap2Pan will return the value of the "tap2/pan" property.
+func (o *AudioEffectDelay) Tap2Pan() gdnative.Real {
+	return o.GetTap2Pan()
+}
+
 // AudioEffectDelayImplementer is an interface that implements the methods
 // of the AudioEffectDelay class.
 type AudioEffectDelayImplementer interface {
@@ -636,4 +741,17 @@ type AudioEffectDelayImplementer interface {
 	SetTap2DelayMs(amount gdnative.Real)
 	SetTap2LevelDb(amount gdnative.Real)
 	SetTap2Pan(amount gdnative.Real)
+	Dry() gdnative.Real
+	FeedbackActive() gdnative.Bool
+	FeedbackDelayMs() gdnative.Real
+	FeedbackLevelDb() gdnative.Real
+	FeedbackLowpass() gdnative.Real
+	Tap1Active() gdnative.Bool
+	Tap1DelayMs() gdnative.Real
+	Tap1LevelDb() gdnative.Real
+	Tap1Pan() gdnative.Real
+	Tap2Active() gdnative.Bool
+	Tap2DelayMs() gdnative.Real
+	Tap2LevelDb() gdnative.Real
+	Tap2Pan() gdnative.Real
 }
@@ -1,7 +1,10 @@
-package godot
+package audioeffect
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -24,13 +27,38 @@ const (
 	AudioEffectDistortionModeWaveshape AudioEffectDistortionMode = 4
 )
 
-//func NewAudioEffectDistortionFromPointer(ptr gdnative.Pointer) AudioEffectDistortion {
-func newAudioEffectDistortionFromPointer(ptr gdnative.Pointer) AudioEffectDistortion {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectDistortion{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the AudioEffectDistortionMode value.
+func (e AudioEffectDistortionMode) String() string {
+	switch e {
+	case AudioEffectDistortionModeAtan:
+		return "MODE_ATAN"
+	case AudioEffectDistortionModeClip:
+		return "MODE_CLIP"
+	case AudioEffectDistortionModeLofi:
+		return "MODE_LOFI"
+	case AudioEffectDistortionModeOverdrive:
+		return "MODE_OVERDRIVE"
+	case AudioEffectDistortionModeWaveshape:
+		return "MODE_WAVESHAPE"
+	}
+	return fmt.Sprintf("AudioEffectDistortionMode(%d)", int(e))
+}
 
-	return obj
+// ParseAudioEffectDistortionMode will return the AudioEffectDistortionMode value with the given Godot name.
+func ParseAudioEffectDistortionMode(name string) (AudioEffectDistortionMode, error) {
+	switch name {
+	case "MODE_ATAN":
+		return AudioEffectDistortionModeAtan, nil
+	case "MODE_CLIP":
+		return AudioEffectDistortionModeClip, nil
+	case "MODE_LOFI":
+		return AudioEffectDistortionModeLofi, nil
+	case "MODE_OVERDRIVE":
+		return AudioEffectDistortionModeOverdrive, nil
+	case "MODE_WAVESHAPE":
+		return AudioEffectDistortionModeWaveshape, nil
+	}
+	return 0, fmt.Errorf("invalid AudioEffectDistortionMode value %q", name)
 }
 
 /*
@@ -41,13 +69,45 @@ type AudioEffectDistortion struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectDistortion is the class constructor of the AudioEffectDistortion class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectDistortion = gdnative.NewLazyClassConstructor("AudioEffectDistortion")
+
+// NewAudioEffectDistortion will create a new instance of the AudioEffectDistortion class.
+// AudioEffectDistortion is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectDistortion() *AudioEffectDistortion {
+	obj := &AudioEffectDistortion{}
+	obj.SetBaseObject(classConstructorAudioEffectDistortion.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectDistortion) BaseClass() string {
 	return "AudioEffectDistortion"
 }
 
+// Method binds of the AudioEffectDistortion class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectDistortionGetDrive    = gdnative.NewLazyMethodBind("AudioEffectDistortion", "get_drive")
+	methodBindAudioEffectDistortionGetKeepHfHz = gdnative.NewLazyMethodBind("AudioEffectDistortion", "get_keep_hf_hz")
+	methodBindAudioEffectDistortionGetMode     = gdnative.NewLazyMethodBind("AudioEffectDistortion", "get_mode")
+	methodBindAudioEffectDistortionGetPostGain = gdnative.NewLazyMethodBind("AudioEffectDistortion", "get_post_gain")
+	methodBindAudioEffectDistortionGetPreGain  = gdnative.NewLazyMethodBind("AudioEffectDistortion", "get_pre_gain")
+	methodBindAudioEffectDistortionSetDrive    = gdnative.NewLazyMethodBind("AudioEffectDistortion", "set_drive")
+	methodBindAudioEffectDistortionSetKeepHfHz = gdnative.NewLazyMethodBind("AudioEffectDistortion", "set_keep_hf_hz")
+	methodBindAudioEffectDistortionSetMode     = gdnative.NewLazyMethodBind("AudioEffectDistortion", "set_mode")
+	methodBindAudioEffectDistortionSetPostGain = gdnative.NewLazyMethodBind("AudioEffectDistortion", "set_post_gain")
+	methodBindAudioEffectDistortionSetPreGain  = gdnative.NewLazyMethodBind("AudioEffectDistortion", "set_pre_gain")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDistortion) GetDrive() gdnative.Real {
 	//log.Println("Calling AudioEffectDistortion.GetDrive()")
@@ -56,7 +116,7 @@ func (o *AudioEffectDistortion) GetDrive() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "get_drive")
+	methodBind := methodBindAudioEffectDistortionGetDrive.Get()
 
 	// Call the parent method.
 	// float
@@ -69,8 +129,8 @@ func (o *AudioEffectDistortion) GetDrive() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDistortion) GetKeepHfHz() gdnative.Real {
 	//log.Println("Calling AudioEffectDistortion.GetKeepHfHz()")
@@ -79,7 +139,7 @@ func (o *AudioEffectDistortion) GetKeepHfHz() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "get_keep_hf_hz")
+	methodBind := methodBindAudioEffectDistortionGetKeepHfHz.Get()
 
 	// Call the parent method.
 	// float
@@ -92,8 +152,8 @@ func (o *AudioEffectDistortion) GetKeepHfHz() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioEffectDistortion::Mode
+	        Undocumented
+		Args: [], Returns: enum.AudioEffectDistortion::Mode
 */
 func (o *AudioEffectDistortion) GetMode() AudioEffectDistortionMode {
 	//log.Println("Calling AudioEffectDistortion.GetMode()")
@@ -102,7 +162,7 @@ func (o *AudioEffectDistortion) GetMode() AudioEffectDistortionMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "get_mode")
+	methodBind := methodBindAudioEffectDistortionGetMode.Get()
 
 	// Call the parent method.
 	// enum.AudioEffectDistortion::Mode
@@ -115,8 +175,8 @@ func (o *AudioEffectDistortion) GetMode() AudioEffectDistortionMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDistortion) GetPostGain() gdnative.Real {
 	//log.Println("Calling AudioEffectDistortion.GetPostGain()")
@@ -125,7 +185,7 @@ func (o *AudioEffectDistortion) GetPostGain() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "get_post_gain")
+	methodBind := methodBindAudioEffectDistortionGetPostGain.Get()
 
 	// Call the parent method.
 	// float
@@ -138,8 +198,8 @@ func (o *AudioEffectDistortion) GetPostGain() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectDistortion) GetPreGain() gdnative.Real {
 	//log.Println("Calling AudioEffectDistortion.GetPreGain()")
@@ -148,7 +208,7 @@ func (o *AudioEffectDistortion) GetPreGain() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "get_pre_gain")
+	methodBind := methodBindAudioEffectDistortionGetPreGain.Get()
 
 	// Call the parent method.
 	// float
@@ -161,8 +221,8 @@ func (o *AudioEffectDistortion) GetPreGain() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false drive float}], Returns: void
+	        Undocumented
+		Args: [{ false drive float}], Returns: void
 */
 func (o *AudioEffectDistortion) SetDrive(drive gdnative.Real) {
 	//log.Println("Calling AudioEffectDistortion.SetDrive()")
@@ -172,7 +232,7 @@ func (o *AudioEffectDistortion) SetDrive(drive gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(drive)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "set_drive")
+	methodBind := methodBindAudioEffectDistortionSetDrive.Get()
 
 	// Call the parent method.
 	// void
@@ -182,8 +242,8 @@ func (o *AudioEffectDistortion) SetDrive(drive gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false keep_hf_hz float}], Returns: void
+	        Undocumented
+		Args: [{ false keep_hf_hz float}], Returns: void
 */
 func (o *AudioEffectDistortion) SetKeepHfHz(keepHfHz gdnative.Real) {
 	//log.Println("Calling AudioEffectDistortion.SetKeepHfHz()")
@@ -193,7 +253,7 @@ func (o *AudioEffectDistortion) SetKeepHfHz(keepHfHz gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(keepHfHz)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "set_keep_hf_hz")
+	methodBind := methodBindAudioEffectDistortionSetKeepHfHz.Get()
 
 	// Call the parent method.
 	// void
@@ -203,18 +263,18 @@ func (o *AudioEffectDistortion) SetKeepHfHz(keepHfHz gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.AudioEffectDistortion::Mode}], Returns: void
 */
-func (o *AudioEffectDistortion) SetMode(mode gdnative.Int) {
+func (o *AudioEffectDistortion) SetMode(mode AudioEffectDistortionMode) {
 	//log.Println("Calling AudioEffectDistortion.SetMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "set_mode")
+	methodBind := methodBindAudioEffectDistortionSetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -224,8 +284,8 @@ func (o *AudioEffectDistortion) SetMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false post_gain float}], Returns: void
+	        Undocumented
+		Args: [{ false post_gain float}], Returns: void
 */
 func (o *AudioEffectDistortion) SetPostGain(postGain gdnative.Real) {
 	//log.Println("Calling AudioEffectDistortion.SetPostGain()")
@@ -235,7 +295,7 @@ func (o *AudioEffectDistortion) SetPostGain(postGain gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(postGain)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "set_post_gain")
+	methodBind := methodBindAudioEffectDistortionSetPostGain.Get()
 
 	// Call the parent method.
 	// void
@@ -245,8 +305,8 @@ func (o *AudioEffectDistortion) SetPostGain(postGain gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pre_gain float}], Returns: void
+	        Undocumented
+		Args: [{ false pre_gain float}], Returns: void
 */
 func (o *AudioEffectDistortion) SetPreGain(preGain gdnative.Real) {
 	//log.Println("Calling AudioEffectDistortion.SetPreGain()")
@@ -256,7 +316,7 @@ func (o *AudioEffectDistortion) SetPreGain(preGain gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(preGain)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectDistortion", "set_pre_gain")
+	methodBind := methodBindAudioEffectDistortionSetPreGain.Get()
 
 	// Call the parent method.
 	// void
@@ -265,17 +325,48 @@ func (o *AudioEffectDistortion) SetPreGain(preGain gdnative.Real) {
 
 }
 
+// Drive will return the value of the "drive" property.
+func (o *AudioEffectDistortion) Drive() gdnative.Real {
+	return o.GetDrive()
+}
+
+// KeepHfHz will return the value of the "keep_hf_hz" property.
+func (o *AudioEffectDistortion) KeepHfHz() gdnative.Real {
+	return o.GetKeepHfHz()
+}
+
+// Mode will return the value of the "mode" property.
+func (o *AudioEffectDistortion) Mode() AudioEffectDistortionMode {
+	return o.GetMode()
+}
+
+// PostGain will return the value of the "post_gain" property.
+func (o *AudioEffectDistortion) PostGain() gdnative.Real {
+	return o.GetPostGain()
+}
+
+// PreGain will return the value of the "pre_gain" property.
+func (o *AudioEffectDistortion) PreGain() gdnative.Real {
+	return o.GetPreGain()
+}
+
 // AudioEffectDistortionImplementer is an interface that implements the methods
 // of the AudioEffectDistortion class.
 type AudioEffectDistortionImplementer interface {
 	AudioEffectImplementer
 	GetDrive() gdnative.Real
 	GetKeepHfHz() gdnative.Real
+	GetMode() AudioEffectDistortionMode
 	GetPostGain() gdnative.Real
 	GetPreGain() gdnative.Real
 	SetDrive(drive gdnative.Real)
 	SetKeepHfHz(keepHfHz gdnative.Real)
-	SetMode(mode gdnative.Int)
+	SetMode(mode AudioEffectDistortionMode)
 	SetPostGain(postGain gdnative.Real)
 	SetPreGain(preGain gdnative.Real)
+	Drive() gdnative.Real
+	KeepHfHz() gdnative.Real
+	Mode() AudioEffectDistortionMode
+	PostGain() gdnative.Real
+	PreGain() gdnative.Real
 }
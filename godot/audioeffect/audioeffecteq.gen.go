@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectEQFromPointer(ptr gdnative.Pointer) AudioEffectEQ {
-func newAudioEffectEQFromPointer(ptr gdnative.Pointer) AudioEffectEQ {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectEQ{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 AudioEffectEQ gives you control over frequencies. Use it to compensate for existing deficiencies in audio. AudioEffectEQ are very useful on the Master Bus to completely master a mix and give it character. They are also very useful when a game is run on a mobile device, to adjust the mix to that kind of speakers (it can be added but disabled when headphones are plugged).
 */
@@ -30,13 +22,38 @@ type AudioEffectEQ struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectEQ is the class constructor of the AudioEffectEQ class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectEQ = gdnative.NewLazyClassConstructor("AudioEffectEQ")
+
+// NewAudioEffectEQ will create a new instance of the AudioEffectEQ class.
+// AudioEffectEQ is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectEQ() *AudioEffectEQ {
+	obj := &AudioEffectEQ{}
+	obj.SetBaseObject(classConstructorAudioEffectEQ.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectEQ) BaseClass() string {
 	return "AudioEffectEQ"
 }
 
+// Method binds of the AudioEffectEQ class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectEQGetBandCount  = gdnative.NewLazyMethodBind("AudioEffectEQ", "get_band_count")
+	methodBindAudioEffectEQGetBandGainDb = gdnative.NewLazyMethodBind("AudioEffectEQ", "get_band_gain_db")
+	methodBindAudioEffectEQSetBandGainDb = gdnative.NewLazyMethodBind("AudioEffectEQ", "set_band_gain_db")
+)
+
 /*
-        Returns the number of bands of the equalizer.
-	Args: [], Returns: int
+	        Returns the number of bands of the equalizer.
+		Args: [], Returns: int
 */
 func (o *AudioEffectEQ) GetBandCount() gdnative.Int {
 	//log.Println("Calling AudioEffectEQ.GetBandCount()")
@@ -45,7 +62,7 @@ func (o *AudioEffectEQ) GetBandCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectEQ", "get_band_count")
+	methodBind := methodBindAudioEffectEQGetBandCount.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +75,8 @@ func (o *AudioEffectEQ) GetBandCount() gdnative.Int {
 }
 
 /*
-        Returns the band's gain at the specified index, in dB.
-	Args: [{ false band_idx int}], Returns: float
+	        Returns the band's gain at the specified index, in dB.
+		Args: [{ false band_idx int}], Returns: float
 */
 func (o *AudioEffectEQ) GetBandGainDb(bandIdx gdnative.Int) gdnative.Real {
 	//log.Println("Calling AudioEffectEQ.GetBandGainDb()")
@@ -69,7 +86,7 @@ func (o *AudioEffectEQ) GetBandGainDb(bandIdx gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bandIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectEQ", "get_band_gain_db")
+	methodBind := methodBindAudioEffectEQGetBandGainDb.Get()
 
 	// Call the parent method.
 	// float
@@ -82,8 +99,8 @@ func (o *AudioEffectEQ) GetBandGainDb(bandIdx gdnative.Int) gdnative.Real {
 }
 
 /*
-        Sets band's gain at the specified index, in dB.
-	Args: [{ false band_idx int} { false volume_db float}], Returns: void
+	        Sets band's gain at the specified index, in dB.
+		Args: [{ false band_idx int} { false volume_db float}], Returns: void
 */
 func (o *AudioEffectEQ) SetBandGainDb(bandIdx gdnative.Int, volumeDb gdnative.Real) {
 	//log.Println("Calling AudioEffectEQ.SetBandGainDb()")
@@ -94,7 +111,7 @@ func (o *AudioEffectEQ) SetBandGainDb(bandIdx gdnative.Int, volumeDb gdnative.Re
 	ptrArguments[1] = gdnative.NewPointerFromReal(volumeDb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectEQ", "set_band_gain_db")
+	methodBind := methodBindAudioEffectEQSetBandGainDb.Get()
 
 	// Call the parent method.
 	// void
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectEQ10FromPointer(ptr gdnative.Pointer) AudioEffectEQ10 {
-func newAudioEffectEQ10FromPointer(ptr gdnative.Pointer) AudioEffectEQ10 {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectEQ10{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Frequency bands : Band 1 : 31 Hz Band 2 : 62 Hz Band 3 : 125 Hz Band 4 : 250 Hz Band 5 : 500 Hz Band 6 : 1000 Hz Band 7 : 2000 Hz Band 8 : 4000 Hz Band 9 : 8000 Hz Band 10 : 16000 Hz See also [AudioEffectEQ], [AudioEffectEQ6], [AudioEffectEQ21].
 */
@@ -30,6 +22,23 @@ type AudioEffectEQ10 struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectEQ10 is the class constructor of the AudioEffectEQ10 class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectEQ10 = gdnative.NewLazyClassConstructor("AudioEffectEQ10")
+
+// NewAudioEffectEQ10 will create a new instance of the AudioEffectEQ10 class.
+// AudioEffectEQ10 is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectEQ10() *AudioEffectEQ10 {
+	obj := &AudioEffectEQ10{}
+	obj.SetBaseObject(classConstructorAudioEffectEQ10.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectEQ10) BaseClass() string {
 	return "AudioEffectEQ10"
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectEQ21FromPointer(ptr gdnative.Pointer) AudioEffectEQ21 {
-func newAudioEffectEQ21FromPointer(ptr gdnative.Pointer) AudioEffectEQ21 {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectEQ21{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Frequency bands : Band 1 : 22 Hz Band 2 : 32 Hz Band 3 : 44 Hz Band 4 : 63 Hz Band 5 : 90 Hz Band 6 : 125 Hz Band 7 : 175 Hz Band 8 : 250 Hz Band 9 : 350 Hz Band 10 : 500 Hz Band 11 : 700 Hz Band 12 : 1000 Hz Band 13 : 1400 Hz Band 14 : 2000 Hz Band 15 : 2800 Hz Band 16 : 4000 Hz Band 17 : 5600 Hz Band 18 : 8000 Hz Band 19 : 11000 Hz Band 20 : 16000 Hz Band 21 : 22000 Hz See also [AudioEffectEQ], [AudioEffectEQ6], [AudioEffectEQ10].
 */
@@ -30,6 +22,23 @@ type AudioEffectEQ21 struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectEQ21 is the class constructor of the AudioEffectEQ21 class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectEQ21 = gdnative.NewLazyClassConstructor("AudioEffectEQ21")
+
+// NewAudioEffectEQ21 will create a new instance of the AudioEffectEQ21 class.
+// AudioEffectEQ21 is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectEQ21() *AudioEffectEQ21 {
+	obj := &AudioEffectEQ21{}
+	obj.SetBaseObject(classConstructorAudioEffectEQ21.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectEQ21) BaseClass() string {
 	return "AudioEffectEQ21"
 }
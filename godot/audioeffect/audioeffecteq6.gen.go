@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectEQ6FromPointer(ptr gdnative.Pointer) AudioEffectEQ6 {
-func newAudioEffectEQ6FromPointer(ptr gdnative.Pointer) AudioEffectEQ6 {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectEQ6{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Frequency bands : Band 1 : 32 Hz Band 2 : 100 Hz Band 3 : 320 Hz Band 4 : 1000 Hz Band 5 : 3200 Hz Band 6 : 10000 Hz See also [AudioEffectEQ], [AudioEffectEQ10], [AudioEffectEQ21].
 */
@@ -30,6 +22,23 @@ type AudioEffectEQ6 struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectEQ6 is the class constructor of the AudioEffectEQ6 class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectEQ6 = gdnative.NewLazyClassConstructor("AudioEffectEQ6")
+
+// NewAudioEffectEQ6 will create a new instance of the AudioEffectEQ6 class.
+// AudioEffectEQ6 is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectEQ6() *AudioEffectEQ6 {
+	obj := &AudioEffectEQ6{}
+	obj.SetBaseObject(classConstructorAudioEffectEQ6.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectEQ6) BaseClass() string {
 	return "AudioEffectEQ6"
 }
@@ -1,7 +1,10 @@
-package godot
+package audioeffect
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,13 +26,34 @@ const (
 	AudioEffectFilterFilter6Db  AudioEffectFilterFilterDB = 0
 )
 
-//func NewAudioEffectFilterFromPointer(ptr gdnative.Pointer) AudioEffectFilter {
-func newAudioEffectFilterFromPointer(ptr gdnative.Pointer) AudioEffectFilter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectFilter{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the AudioEffectFilterFilterDB value.
+func (e AudioEffectFilterFilterDB) String() string {
+	switch e {
+	case AudioEffectFilterFilter12Db:
+		return "FILTER_12DB"
+	case AudioEffectFilterFilter18Db:
+		return "FILTER_18DB"
+	case AudioEffectFilterFilter24Db:
+		return "FILTER_24DB"
+	case AudioEffectFilterFilter6Db:
+		return "FILTER_6DB"
+	}
+	return fmt.Sprintf("AudioEffectFilterFilterDB(%d)", int(e))
+}
 
-	return obj
+// ParseAudioEffectFilterFilterDB will return the AudioEffectFilterFilterDB value with the given Godot name.
+func ParseAudioEffectFilterFilterDB(name string) (AudioEffectFilterFilterDB, error) {
+	switch name {
+	case "FILTER_12DB":
+		return AudioEffectFilterFilter12Db, nil
+	case "FILTER_18DB":
+		return AudioEffectFilterFilter18Db, nil
+	case "FILTER_24DB":
+		return AudioEffectFilterFilter24Db, nil
+	case "FILTER_6DB":
+		return AudioEffectFilterFilter6Db, nil
+	}
+	return 0, fmt.Errorf("invalid AudioEffectFilterFilterDB value %q", name)
 }
 
 /*
@@ -40,13 +64,43 @@ type AudioEffectFilter struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectFilter is the class constructor of the AudioEffectFilter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectFilter = gdnative.NewLazyClassConstructor("AudioEffectFilter")
+
+// NewAudioEffectFilter will create a new instance of the AudioEffectFilter class.
+// AudioEffectFilter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectFilter() *AudioEffectFilter {
+	obj := &AudioEffectFilter{}
+	obj.SetBaseObject(classConstructorAudioEffectFilter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectFilter) BaseClass() string {
 	return "AudioEffectFilter"
 }
 
+// Method binds of the AudioEffectFilter class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectFilterGetCutoff    = gdnative.NewLazyMethodBind("AudioEffectFilter", "get_cutoff")
+	methodBindAudioEffectFilterGetDb        = gdnative.NewLazyMethodBind("AudioEffectFilter", "get_db")
+	methodBindAudioEffectFilterGetGain      = gdnative.NewLazyMethodBind("AudioEffectFilter", "get_gain")
+	methodBindAudioEffectFilterGetResonance = gdnative.NewLazyMethodBind("AudioEffectFilter", "get_resonance")
+	methodBindAudioEffectFilterSetCutoff    = gdnative.NewLazyMethodBind("AudioEffectFilter", "set_cutoff")
+	methodBindAudioEffectFilterSetDb        = gdnative.NewLazyMethodBind("AudioEffectFilter", "set_db")
+	methodBindAudioEffectFilterSetGain      = gdnative.NewLazyMethodBind("AudioEffectFilter", "set_gain")
+	methodBindAudioEffectFilterSetResonance = gdnative.NewLazyMethodBind("AudioEffectFilter", "set_resonance")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectFilter) GetCutoff() gdnative.Real {
 	//log.Println("Calling AudioEffectFilter.GetCutoff()")
@@ -55,7 +109,7 @@ func (o *AudioEffectFilter) GetCutoff() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "get_cutoff")
+	methodBind := methodBindAudioEffectFilterGetCutoff.Get()
 
 	// Call the parent method.
 	// float
@@ -68,8 +122,8 @@ func (o *AudioEffectFilter) GetCutoff() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioEffectFilter::FilterDB
+	        Undocumented
+		Args: [], Returns: enum.AudioEffectFilter::FilterDB
 */
 func (o *AudioEffectFilter) GetDb() AudioEffectFilterFilterDB {
 	//log.Println("Calling AudioEffectFilter.GetDb()")
@@ -78,7 +132,7 @@ func (o *AudioEffectFilter) GetDb() AudioEffectFilterFilterDB {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "get_db")
+	methodBind := methodBindAudioEffectFilterGetDb.Get()
 
 	// Call the parent method.
 	// enum.AudioEffectFilter::FilterDB
@@ -91,8 +145,8 @@ func (o *AudioEffectFilter) GetDb() AudioEffectFilterFilterDB {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectFilter) GetGain() gdnative.Real {
 	//log.Println("Calling AudioEffectFilter.GetGain()")
@@ -101,7 +155,7 @@ func (o *AudioEffectFilter) GetGain() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "get_gain")
+	methodBind := methodBindAudioEffectFilterGetGain.Get()
 
 	// Call the parent method.
 	// float
@@ -114,8 +168,8 @@ func (o *AudioEffectFilter) GetGain() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectFilter) GetResonance() gdnative.Real {
 	//log.Println("Calling AudioEffectFilter.GetResonance()")
@@ -124,7 +178,7 @@ func (o *AudioEffectFilter) GetResonance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "get_resonance")
+	methodBind := methodBindAudioEffectFilterGetResonance.Get()
 
 	// Call the parent method.
 	// float
@@ -137,8 +191,8 @@ func (o *AudioEffectFilter) GetResonance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false freq float}], Returns: void
+	        Undocumented
+		Args: [{ false freq float}], Returns: void
 */
 func (o *AudioEffectFilter) SetCutoff(freq gdnative.Real) {
 	//log.Println("Calling AudioEffectFilter.SetCutoff()")
@@ -148,7 +202,7 @@ func (o *AudioEffectFilter) SetCutoff(freq gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(freq)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "set_cutoff")
+	methodBind := methodBindAudioEffectFilterSetCutoff.Get()
 
 	// Call the parent method.
 	// void
@@ -158,18 +212,18 @@ func (o *AudioEffectFilter) SetCutoff(freq gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount enum.AudioEffectFilter::FilterDB}], Returns: void
 */
-func (o *AudioEffectFilter) SetDb(amount gdnative.Int) {
+func (o *AudioEffectFilter) SetDb(amount AudioEffectFilterFilterDB) {
 	//log.Println("Calling AudioEffectFilter.SetDb()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(amount))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "set_db")
+	methodBind := methodBindAudioEffectFilterSetDb.Get()
 
 	// Call the parent method.
 	// void
@@ -179,8 +233,8 @@ func (o *AudioEffectFilter) SetDb(amount gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectFilter) SetGain(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectFilter.SetGain()")
@@ -190,7 +244,7 @@ func (o *AudioEffectFilter) SetGain(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "set_gain")
+	methodBind := methodBindAudioEffectFilterSetGain.Get()
 
 	// Call the parent method.
 	// void
@@ -200,8 +254,8 @@ func (o *AudioEffectFilter) SetGain(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectFilter) SetResonance(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectFilter.SetResonance()")
@@ -211,7 +265,7 @@ func (o *AudioEffectFilter) SetResonance(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectFilter", "set_resonance")
+	methodBind := methodBindAudioEffectFilterSetResonance.Get()
 
 	// Call the parent method.
 	// void
@@ -220,15 +274,46 @@ func (o *AudioEffectFilter) SetResonance(amount gdnative.Real) {
 
 }
 
+// CutoffHz will return the value of the "cutoff_hz" property.
+func (o *AudioEffectFilter) CutoffHz() gdnative.Real {
+	return o.GetCutoff()
+}
+
+// SetCutoffHz will set the value of the "cutoff_hz" property.
+func (o *AudioEffectFilter) SetCutoffHz(value gdnative.Real) {
+	o.SetCutoff(value)
+}
+
+// Db will return the value of the "db" property.
+func (o *AudioEffectFilter) Db() AudioEffectFilterFilterDB {
+	return o.GetDb()
+}
+
+// Gain will return the value of the "gain" property.
+func (o *AudioEffectFilter) Gain() gdnative.Real {
+	return o.GetGain()
+}
+
+// Resonance will return the value of the "resonance" property.
+func (o *AudioEffectFilter) Resonance() gdnative.Real {
+	return o.GetResonance()
+}
+
 // AudioEffectFilterImplementer is an interface that implements the methods
 // of the AudioEffectFilter class.
 type AudioEffectFilterImplementer interface {
 	AudioEffectImplementer
 	GetCutoff() gdnative.Real
+	GetDb() AudioEffectFilterFilterDB
 	GetGain() gdnative.Real
 	GetResonance() gdnative.Real
 	SetCutoff(freq gdnative.Real)
-	SetDb(amount gdnative.Int)
+	SetDb(amount AudioEffectFilterFilterDB)
 	SetGain(amount gdnative.Real)
 	SetResonance(amount gdnative.Real)
+	CutoffHz() gdnative.Real
+	SetCutoffHz(value gdnative.Real)
+	Db() AudioEffectFilterFilterDB
+	Gain() gdnative.Real
+	Resonance() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectHighPassFilterFromPointer(ptr gdnative.Pointer) AudioEffectHighPassFilter {
-func newAudioEffectHighPassFilterFromPointer(ptr gdnative.Pointer) AudioEffectHighPassFilter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectHighPassFilter{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Cuts frequencies lower than the [member cutoff_hz] and allows higher frequencies to pass.
 */
@@ -30,6 +22,23 @@ type AudioEffectHighPassFilter struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectHighPassFilter is the class constructor of the AudioEffectHighPassFilter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectHighPassFilter = gdnative.NewLazyClassConstructor("AudioEffectHighPassFilter")
+
+// NewAudioEffectHighPassFilter will create a new instance of the AudioEffectHighPassFilter class.
+// AudioEffectHighPassFilter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectHighPassFilter() *AudioEffectHighPassFilter {
+	obj := &AudioEffectHighPassFilter{}
+	obj.SetBaseObject(classConstructorAudioEffectHighPassFilter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectHighPassFilter) BaseClass() string {
 	return "AudioEffectHighPassFilter"
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectLimiterFromPointer(ptr gdnative.Pointer) AudioEffectLimiter {
-func newAudioEffectLimiterFromPointer(ptr gdnative.Pointer) AudioEffectLimiter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectLimiter{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A limiter is similar to a compressor, but it’s less flexible and designed to disallow sound going over a given dB threshold. Adding one in the Master Bus is always recommended to reduce the effects of clipping. Soft clipping starts to reduce the peaks a little below the threshold level and progressively increases its effect as the input level increases such that the threshold is never exceeded.
 */
@@ -30,13 +22,43 @@ type AudioEffectLimiter struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectLimiter is the class constructor of the AudioEffectLimiter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectLimiter = gdnative.NewLazyClassConstructor("AudioEffectLimiter")
+
+// NewAudioEffectLimiter will create a new instance of the AudioEffectLimiter class.
+// AudioEffectLimiter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectLimiter() *AudioEffectLimiter {
+	obj := &AudioEffectLimiter{}
+	obj.SetBaseObject(classConstructorAudioEffectLimiter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectLimiter) BaseClass() string {
 	return "AudioEffectLimiter"
 }
 
+// Method binds of the AudioEffectLimiter class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectLimiterGetCeilingDb     = gdnative.NewLazyMethodBind("AudioEffectLimiter", "get_ceiling_db")
+	methodBindAudioEffectLimiterGetSoftClipDb    = gdnative.NewLazyMethodBind("AudioEffectLimiter", "get_soft_clip_db")
+	methodBindAudioEffectLimiterGetSoftClipRatio = gdnative.NewLazyMethodBind("AudioEffectLimiter", "get_soft_clip_ratio")
+	methodBindAudioEffectLimiterGetThresholdDb   = gdnative.NewLazyMethodBind("AudioEffectLimiter", "get_threshold_db")
+	methodBindAudioEffectLimiterSetCeilingDb     = gdnative.NewLazyMethodBind("AudioEffectLimiter", "set_ceiling_db")
+	methodBindAudioEffectLimiterSetSoftClipDb    = gdnative.NewLazyMethodBind("AudioEffectLimiter", "set_soft_clip_db")
+	methodBindAudioEffectLimiterSetSoftClipRatio = gdnative.NewLazyMethodBind("AudioEffectLimiter", "set_soft_clip_ratio")
+	methodBindAudioEffectLimiterSetThresholdDb   = gdnative.NewLazyMethodBind("AudioEffectLimiter", "set_threshold_db")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectLimiter) GetCeilingDb() gdnative.Real {
 	//log.Println("Calling AudioEffectLimiter.GetCeilingDb()")
@@ -45,7 +67,7 @@ func (o *AudioEffectLimiter) GetCeilingDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "get_ceiling_db")
+	methodBind := methodBindAudioEffectLimiterGetCeilingDb.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +80,8 @@ func (o *AudioEffectLimiter) GetCeilingDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectLimiter) GetSoftClipDb() gdnative.Real {
 	//log.Println("Calling AudioEffectLimiter.GetSoftClipDb()")
@@ -68,7 +90,7 @@ func (o *AudioEffectLimiter) GetSoftClipDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "get_soft_clip_db")
+	methodBind := methodBindAudioEffectLimiterGetSoftClipDb.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +103,8 @@ func (o *AudioEffectLimiter) GetSoftClipDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectLimiter) GetSoftClipRatio() gdnative.Real {
 	//log.Println("Calling AudioEffectLimiter.GetSoftClipRatio()")
@@ -91,7 +113,7 @@ func (o *AudioEffectLimiter) GetSoftClipRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "get_soft_clip_ratio")
+	methodBind := methodBindAudioEffectLimiterGetSoftClipRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +126,8 @@ func (o *AudioEffectLimiter) GetSoftClipRatio() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectLimiter) GetThresholdDb() gdnative.Real {
 	//log.Println("Calling AudioEffectLimiter.GetThresholdDb()")
@@ -114,7 +136,7 @@ func (o *AudioEffectLimiter) GetThresholdDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "get_threshold_db")
+	methodBind := methodBindAudioEffectLimiterGetThresholdDb.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +149,8 @@ func (o *AudioEffectLimiter) GetThresholdDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false ceiling float}], Returns: void
+	        Undocumented
+		Args: [{ false ceiling float}], Returns: void
 */
 func (o *AudioEffectLimiter) SetCeilingDb(ceiling gdnative.Real) {
 	//log.Println("Calling AudioEffectLimiter.SetCeilingDb()")
@@ -138,7 +160,7 @@ func (o *AudioEffectLimiter) SetCeilingDb(ceiling gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ceiling)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "set_ceiling_db")
+	methodBind := methodBindAudioEffectLimiterSetCeilingDb.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +170,8 @@ func (o *AudioEffectLimiter) SetCeilingDb(ceiling gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false soft_clip float}], Returns: void
+	        Undocumented
+		Args: [{ false soft_clip float}], Returns: void
 */
 func (o *AudioEffectLimiter) SetSoftClipDb(softClip gdnative.Real) {
 	//log.Println("Calling AudioEffectLimiter.SetSoftClipDb()")
@@ -159,7 +181,7 @@ func (o *AudioEffectLimiter) SetSoftClipDb(softClip gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(softClip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "set_soft_clip_db")
+	methodBind := methodBindAudioEffectLimiterSetSoftClipDb.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +191,8 @@ func (o *AudioEffectLimiter) SetSoftClipDb(softClip gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false soft_clip float}], Returns: void
+	        Undocumented
+		Args: [{ false soft_clip float}], Returns: void
 */
 func (o *AudioEffectLimiter) SetSoftClipRatio(softClip gdnative.Real) {
 	//log.Println("Calling AudioEffectLimiter.SetSoftClipRatio()")
@@ -180,7 +202,7 @@ func (o *AudioEffectLimiter) SetSoftClipRatio(softClip gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(softClip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "set_soft_clip_ratio")
+	methodBind := methodBindAudioEffectLimiterSetSoftClipRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +212,8 @@ func (o *AudioEffectLimiter) SetSoftClipRatio(softClip gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false threshold float}], Returns: void
+	        Undocumented
+		Args: [{ false threshold float}], Returns: void
 */
 func (o *AudioEffectLimiter) SetThresholdDb(threshold gdnative.Real) {
 	//log.Println("Calling AudioEffectLimiter.SetThresholdDb()")
@@ -201,7 +223,7 @@ func (o *AudioEffectLimiter) SetThresholdDb(threshold gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(threshold)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectLimiter", "set_threshold_db")
+	methodBind := methodBindAudioEffectLimiterSetThresholdDb.Get()
 
 	// Call the parent method.
 	// void
@@ -210,6 +232,26 @@ func (o *AudioEffectLimiter) SetThresholdDb(threshold gdnative.Real) {
 
 }
 
+// CeilingDb will return the value of the "ceiling_db" property.
+func (o *AudioEffectLimiter) CeilingDb() gdnative.Real {
+	return o.GetCeilingDb()
+}
+
+// SoftClipDb will return the value of the "soft_clip_db" property.
+func (o *AudioEffectLimiter) SoftClipDb() gdnative.Real {
+	return o.GetSoftClipDb()
+}
+
+// SoftClipRatio will return the value of the "soft_clip_ratio" property.
+func (o *AudioEffectLimiter) SoftClipRatio() gdnative.Real {
+	return o.GetSoftClipRatio()
+}
+
+// ThresholdDb will return the value of the "threshold_db" property.
+func (o *AudioEffectLimiter) ThresholdDb() gdnative.Real {
+	return o.GetThresholdDb()
+}
+
 // AudioEffectLimiterImplementer is an interface that implements the methods
 // of the AudioEffectLimiter class.
 type AudioEffectLimiterImplementer interface {
@@ -222,4 +264,8 @@ type AudioEffectLimiterImplementer interface {
 	SetSoftClipDb(softClip gdnative.Real)
 	SetSoftClipRatio(softClip gdnative.Real)
 	SetThresholdDb(threshold gdnative.Real)
+	CeilingDb() gdnative.Real
+	SoftClipDb() gdnative.Real
+	SoftClipRatio() gdnative.Real
+	ThresholdDb() gdnative.Real
 }
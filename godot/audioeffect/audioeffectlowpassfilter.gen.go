@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectLowPassFilterFromPointer(ptr gdnative.Pointer) AudioEffectLowPassFilter {
-func newAudioEffectLowPassFilterFromPointer(ptr gdnative.Pointer) AudioEffectLowPassFilter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectLowPassFilter{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Cuts frequencies higher than the [member cutoff_hz] and allows lower frequencies to pass.
 */
@@ -30,6 +22,23 @@ type AudioEffectLowPassFilter struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectLowPassFilter is the class constructor of the AudioEffectLowPassFilter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectLowPassFilter = gdnative.NewLazyClassConstructor("AudioEffectLowPassFilter")
+
+// NewAudioEffectLowPassFilter will create a new instance of the AudioEffectLowPassFilter class.
+// AudioEffectLowPassFilter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectLowPassFilter() *AudioEffectLowPassFilter {
+	obj := &AudioEffectLowPassFilter{}
+	obj.SetBaseObject(classConstructorAudioEffectLowPassFilter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectLowPassFilter) BaseClass() string {
 	return "AudioEffectLowPassFilter"
 }
@@ -0,0 +1,49 @@
+package audioeffect
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+ */
+type AudioEffectLowShelfFilter struct {
+	AudioEffectFilter
+	owner gdnative.Object
+}
+
+// classConstructorAudioEffectLowShelfFilter is the class constructor of the AudioEffectLowShelfFilter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectLowShelfFilter = gdnative.NewLazyClassConstructor("AudioEffectLowShelfFilter")
+
+// NewAudioEffectLowShelfFilter will create a new instance of the AudioEffectLowShelfFilter class.
+// AudioEffectLowShelfFilter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectLowShelfFilter() *AudioEffectLowShelfFilter {
+	obj := &AudioEffectLowShelfFilter{}
+	obj.SetBaseObject(classConstructorAudioEffectLowShelfFilter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *AudioEffectLowShelfFilter) BaseClass() string {
+	return "AudioEffectLowShelfFilter"
+}
+
+// AudioEffectLowShelfFilterImplementer is an interface that implements the methods
+// of the AudioEffectLowShelfFilter class.
+type AudioEffectLowShelfFilterImplementer interface {
+	AudioEffectFilterImplementer
+}
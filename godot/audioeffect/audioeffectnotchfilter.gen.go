@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectNotchFilterFromPointer(ptr gdnative.Pointer) AudioEffectNotchFilter {
-func newAudioEffectNotchFilterFromPointer(ptr gdnative.Pointer) AudioEffectNotchFilter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectNotchFilter{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Attenuates frequencies in a narrow band around the [member cutoff_hz] and cuts frequencies outside of this range.
 */
@@ -30,6 +22,23 @@ type AudioEffectNotchFilter struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectNotchFilter is the class constructor of the AudioEffectNotchFilter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectNotchFilter = gdnative.NewLazyClassConstructor("AudioEffectNotchFilter")
+
+// NewAudioEffectNotchFilter will create a new instance of the AudioEffectNotchFilter class.
+// AudioEffectNotchFilter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectNotchFilter() *AudioEffectNotchFilter {
+	obj := &AudioEffectNotchFilter{}
+	obj.SetBaseObject(classConstructorAudioEffectNotchFilter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectNotchFilter) BaseClass() string {
 	return "AudioEffectNotchFilter"
 }
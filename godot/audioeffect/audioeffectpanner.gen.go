@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectPannerFromPointer(ptr gdnative.Pointer) AudioEffectPanner {
-func newAudioEffectPannerFromPointer(ptr gdnative.Pointer) AudioEffectPanner {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectPanner{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Determines how much of an audio signal is sent to the left and right buses.
 */
@@ -30,13 +22,37 @@ type AudioEffectPanner struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectPanner is the class constructor of the AudioEffectPanner class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectPanner = gdnative.NewLazyClassConstructor("AudioEffectPanner")
+
+// NewAudioEffectPanner will create a new instance of the AudioEffectPanner class.
+// AudioEffectPanner is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectPanner() *AudioEffectPanner {
+	obj := &AudioEffectPanner{}
+	obj.SetBaseObject(classConstructorAudioEffectPanner.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectPanner) BaseClass() string {
 	return "AudioEffectPanner"
 }
 
+// Method binds of the AudioEffectPanner class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectPannerGetPan = gdnative.NewLazyMethodBind("AudioEffectPanner", "get_pan")
+	methodBindAudioEffectPannerSetPan = gdnative.NewLazyMethodBind("AudioEffectPanner", "set_pan")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectPanner) GetPan() gdnative.Real {
 	//log.Println("Calling AudioEffectPanner.GetPan()")
@@ -45,7 +61,7 @@ func (o *AudioEffectPanner) GetPan() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPanner", "get_pan")
+	methodBind := methodBindAudioEffectPannerGetPan.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +74,8 @@ func (o *AudioEffectPanner) GetPan() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false cpanume float}], Returns: void
+	        Undocumented
+		Args: [{ false cpanume float}], Returns: void
 */
 func (o *AudioEffectPanner) SetPan(cpanume gdnative.Real) {
 	//log.Println("Calling AudioEffectPanner.SetPan()")
@@ -69,7 +85,7 @@ func (o *AudioEffectPanner) SetPan(cpanume gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(cpanume)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPanner", "set_pan")
+	methodBind := methodBindAudioEffectPannerSetPan.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *AudioEffectPanner) SetPan(cpanume gdnative.Real) {
 
 }
 
+// Pan will return the value of the "pan" property.
+func (o *AudioEffectPanner) Pan() gdnative.Real {
+	return o.GetPan()
+}
+
 // AudioEffectPannerImplementer is an interface that implements the methods
 // of the AudioEffectPanner class.
 type AudioEffectPannerImplementer interface {
 	AudioEffectImplementer
 	GetPan() gdnative.Real
 	SetPan(cpanume gdnative.Real)
+	Pan() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectPhaserFromPointer(ptr gdnative.Pointer) AudioEffectPhaser {
-func newAudioEffectPhaserFromPointer(ptr gdnative.Pointer) AudioEffectPhaser {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectPhaser{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Combines phase-shifted signals with the original signal. The movement of the phase-shifted signals is controlled using a Low Frequency Oscillator.
 */
@@ -30,13 +22,45 @@ type AudioEffectPhaser struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectPhaser is the class constructor of the AudioEffectPhaser class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectPhaser = gdnative.NewLazyClassConstructor("AudioEffectPhaser")
+
+// NewAudioEffectPhaser will create a new instance of the AudioEffectPhaser class.
+// AudioEffectPhaser is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectPhaser() *AudioEffectPhaser {
+	obj := &AudioEffectPhaser{}
+	obj.SetBaseObject(classConstructorAudioEffectPhaser.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectPhaser) BaseClass() string {
 	return "AudioEffectPhaser"
 }
 
+// Method binds of the AudioEffectPhaser class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectPhaserGetDepth      = gdnative.NewLazyMethodBind("AudioEffectPhaser", "get_depth")
+	methodBindAudioEffectPhaserGetFeedback   = gdnative.NewLazyMethodBind("AudioEffectPhaser", "get_feedback")
+	methodBindAudioEffectPhaserGetRangeMaxHz = gdnative.NewLazyMethodBind("AudioEffectPhaser", "get_range_max_hz")
+	methodBindAudioEffectPhaserGetRangeMinHz = gdnative.NewLazyMethodBind("AudioEffectPhaser", "get_range_min_hz")
+	methodBindAudioEffectPhaserGetRateHz     = gdnative.NewLazyMethodBind("AudioEffectPhaser", "get_rate_hz")
+	methodBindAudioEffectPhaserSetDepth      = gdnative.NewLazyMethodBind("AudioEffectPhaser", "set_depth")
+	methodBindAudioEffectPhaserSetFeedback   = gdnative.NewLazyMethodBind("AudioEffectPhaser", "set_feedback")
+	methodBindAudioEffectPhaserSetRangeMaxHz = gdnative.NewLazyMethodBind("AudioEffectPhaser", "set_range_max_hz")
+	methodBindAudioEffectPhaserSetRangeMinHz = gdnative.NewLazyMethodBind("AudioEffectPhaser", "set_range_min_hz")
+	methodBindAudioEffectPhaserSetRateHz     = gdnative.NewLazyMethodBind("AudioEffectPhaser", "set_rate_hz")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectPhaser) GetDepth() gdnative.Real {
 	//log.Println("Calling AudioEffectPhaser.GetDepth()")
@@ -45,7 +69,7 @@ func (o *AudioEffectPhaser) GetDepth() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "get_depth")
+	methodBind := methodBindAudioEffectPhaserGetDepth.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +82,8 @@ func (o *AudioEffectPhaser) GetDepth() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectPhaser) GetFeedback() gdnative.Real {
 	//log.Println("Calling AudioEffectPhaser.GetFeedback()")
@@ -68,7 +92,7 @@ func (o *AudioEffectPhaser) GetFeedback() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "get_feedback")
+	methodBind := methodBindAudioEffectPhaserGetFeedback.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +105,8 @@ func (o *AudioEffectPhaser) GetFeedback() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectPhaser) GetRangeMaxHz() gdnative.Real {
 	//log.Println("Calling AudioEffectPhaser.GetRangeMaxHz()")
@@ -91,7 +115,7 @@ func (o *AudioEffectPhaser) GetRangeMaxHz() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "get_range_max_hz")
+	methodBind := methodBindAudioEffectPhaserGetRangeMaxHz.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +128,8 @@ func (o *AudioEffectPhaser) GetRangeMaxHz() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectPhaser) GetRangeMinHz() gdnative.Real {
 	//log.Println("Calling AudioEffectPhaser.GetRangeMinHz()")
@@ -114,7 +138,7 @@ func (o *AudioEffectPhaser) GetRangeMinHz() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "get_range_min_hz")
+	methodBind := methodBindAudioEffectPhaserGetRangeMinHz.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +151,8 @@ func (o *AudioEffectPhaser) GetRangeMinHz() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectPhaser) GetRateHz() gdnative.Real {
 	//log.Println("Calling AudioEffectPhaser.GetRateHz()")
@@ -137,7 +161,7 @@ func (o *AudioEffectPhaser) GetRateHz() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "get_rate_hz")
+	methodBind := methodBindAudioEffectPhaserGetRateHz.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +174,8 @@ func (o *AudioEffectPhaser) GetRateHz() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false depth float}], Returns: void
+	        Undocumented
+		Args: [{ false depth float}], Returns: void
 */
 func (o *AudioEffectPhaser) SetDepth(depth gdnative.Real) {
 	//log.Println("Calling AudioEffectPhaser.SetDepth()")
@@ -161,7 +185,7 @@ func (o *AudioEffectPhaser) SetDepth(depth gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(depth)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "set_depth")
+	methodBind := methodBindAudioEffectPhaserSetDepth.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +195,8 @@ func (o *AudioEffectPhaser) SetDepth(depth gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false fbk float}], Returns: void
+	        Undocumented
+		Args: [{ false fbk float}], Returns: void
 */
 func (o *AudioEffectPhaser) SetFeedback(fbk gdnative.Real) {
 	//log.Println("Calling AudioEffectPhaser.SetFeedback()")
@@ -182,7 +206,7 @@ func (o *AudioEffectPhaser) SetFeedback(fbk gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fbk)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "set_feedback")
+	methodBind := methodBindAudioEffectPhaserSetFeedback.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +216,8 @@ func (o *AudioEffectPhaser) SetFeedback(fbk gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false hz float}], Returns: void
+	        Undocumented
+		Args: [{ false hz float}], Returns: void
 */
 func (o *AudioEffectPhaser) SetRangeMaxHz(hz gdnative.Real) {
 	//log.Println("Calling AudioEffectPhaser.SetRangeMaxHz()")
@@ -203,7 +227,7 @@ func (o *AudioEffectPhaser) SetRangeMaxHz(hz gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(hz)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "set_range_max_hz")
+	methodBind := methodBindAudioEffectPhaserSetRangeMaxHz.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +237,8 @@ func (o *AudioEffectPhaser) SetRangeMaxHz(hz gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false hz float}], Returns: void
+	        Undocumented
+		Args: [{ false hz float}], Returns: void
 */
 func (o *AudioEffectPhaser) SetRangeMinHz(hz gdnative.Real) {
 	//log.Println("Calling AudioEffectPhaser.SetRangeMinHz()")
@@ -224,7 +248,7 @@ func (o *AudioEffectPhaser) SetRangeMinHz(hz gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(hz)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "set_range_min_hz")
+	methodBind := methodBindAudioEffectPhaserSetRangeMinHz.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +258,8 @@ func (o *AudioEffectPhaser) SetRangeMinHz(hz gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false hz float}], Returns: void
+	        Undocumented
+		Args: [{ false hz float}], Returns: void
 */
 func (o *AudioEffectPhaser) SetRateHz(hz gdnative.Real) {
 	//log.Println("Calling AudioEffectPhaser.SetRateHz()")
@@ -245,7 +269,7 @@ func (o *AudioEffectPhaser) SetRateHz(hz gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(hz)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPhaser", "set_rate_hz")
+	methodBind := methodBindAudioEffectPhaserSetRateHz.Get()
 
 	// Call the parent method.
 	// void
@@ -254,6 +278,31 @@ func (o *AudioEffectPhaser) SetRateHz(hz gdnative.Real) {
 
 }
 
+// Depth will return the value of the "depth" property.
+func (o *AudioEffectPhaser) Depth() gdnative.Real {
+	return o.GetDepth()
+}
+
+// Feedback will return the value of the "feedback" property.
+func (o *AudioEffectPhaser) Feedback() gdnative.Real {
+	return o.GetFeedback()
+}
+
+// RangeMaxHz will return the value of the "range_max_hz" property.
+func (o *AudioEffectPhaser) RangeMaxHz() gdnative.Real {
+	return o.GetRangeMaxHz()
+}
+
+// RangeMinHz will return the value of the "range_min_hz" property.
+func (o *AudioEffectPhaser) RangeMinHz() gdnative.Real {
+	return o.GetRangeMinHz()
+}
+
+// RateHz will return the value of the "rate_hz" property.
+func (o *AudioEffectPhaser) RateHz() gdnative.Real {
+	return o.GetRateHz()
+}
+
 // AudioEffectPhaserImplementer is an interface that implements the methods
 // of the AudioEffectPhaser class.
 type AudioEffectPhaserImplementer interface {
@@ -268,4 +317,9 @@ type AudioEffectPhaserImplementer interface {
 	SetRangeMaxHz(hz gdnative.Real)
 	SetRangeMinHz(hz gdnative.Real)
 	SetRateHz(hz gdnative.Real)
+	Depth() gdnative.Real
+	Feedback() gdnative.Real
+	RangeMaxHz() gdnative.Real
+	RangeMinHz() gdnative.Real
+	RateHz() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectPitchShiftFromPointer(ptr gdnative.Pointer) AudioEffectPitchShift {
-func newAudioEffectPitchShiftFromPointer(ptr gdnative.Pointer) AudioEffectPitchShift {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectPitchShift{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Allows modulation of pitch independently of tempo. All frequencies can be increased/decreased with minimal effect on transients.
 */
@@ -30,13 +22,37 @@ type AudioEffectPitchShift struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectPitchShift is the class constructor of the AudioEffectPitchShift class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectPitchShift = gdnative.NewLazyClassConstructor("AudioEffectPitchShift")
+
+// NewAudioEffectPitchShift will create a new instance of the AudioEffectPitchShift class.
+// AudioEffectPitchShift is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectPitchShift() *AudioEffectPitchShift {
+	obj := &AudioEffectPitchShift{}
+	obj.SetBaseObject(classConstructorAudioEffectPitchShift.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectPitchShift) BaseClass() string {
 	return "AudioEffectPitchShift"
 }
 
+// Method binds of the AudioEffectPitchShift class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectPitchShiftGetPitchScale = gdnative.NewLazyMethodBind("AudioEffectPitchShift", "get_pitch_scale")
+	methodBindAudioEffectPitchShiftSetPitchScale = gdnative.NewLazyMethodBind("AudioEffectPitchShift", "set_pitch_scale")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectPitchShift) GetPitchScale() gdnative.Real {
 	//log.Println("Calling AudioEffectPitchShift.GetPitchScale()")
@@ -45,7 +61,7 @@ func (o *AudioEffectPitchShift) GetPitchScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPitchShift", "get_pitch_scale")
+	methodBind := methodBindAudioEffectPitchShiftGetPitchScale.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +74,8 @@ func (o *AudioEffectPitchShift) GetPitchScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false rate float}], Returns: void
+	        Undocumented
+		Args: [{ false rate float}], Returns: void
 */
 func (o *AudioEffectPitchShift) SetPitchScale(rate gdnative.Real) {
 	//log.Println("Calling AudioEffectPitchShift.SetPitchScale()")
@@ -69,7 +85,7 @@ func (o *AudioEffectPitchShift) SetPitchScale(rate gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(rate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectPitchShift", "set_pitch_scale")
+	methodBind := methodBindAudioEffectPitchShiftSetPitchScale.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *AudioEffectPitchShift) SetPitchScale(rate gdnative.Real) {
 
 }
 
+// PitchScale will return the value of the "pitch_scale" property.
+func (o *AudioEffectPitchShift) PitchScale() gdnative.Real {
+	return o.GetPitchScale()
+}
+
 // AudioEffectPitchShiftImplementer is an interface that implements the methods
 // of the AudioEffectPitchShift class.
 type AudioEffectPitchShiftImplementer interface {
 	AudioEffectImplementer
 	GetPitchScale() gdnative.Real
 	SetPitchScale(rate gdnative.Real)
+	PitchScale() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectReverbFromPointer(ptr gdnative.Pointer) AudioEffectReverb {
-func newAudioEffectReverbFromPointer(ptr gdnative.Pointer) AudioEffectReverb {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectReverb{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Simulates rooms of different sizes. Its parameters can be adjusted to simulate the sound of a specific room.
 */
@@ -30,13 +22,51 @@ type AudioEffectReverb struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectReverb is the class constructor of the AudioEffectReverb class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectReverb = gdnative.NewLazyClassConstructor("AudioEffectReverb")
+
+// NewAudioEffectReverb will create a new instance of the AudioEffectReverb class.
+// AudioEffectReverb is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectReverb() *AudioEffectReverb {
+	obj := &AudioEffectReverb{}
+	obj.SetBaseObject(classConstructorAudioEffectReverb.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectReverb) BaseClass() string {
 	return "AudioEffectReverb"
 }
 
+// Method binds of the AudioEffectReverb class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectReverbGetDamping          = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_damping")
+	methodBindAudioEffectReverbGetDry              = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_dry")
+	methodBindAudioEffectReverbGetHpf              = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_hpf")
+	methodBindAudioEffectReverbGetPredelayFeedback = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_predelay_feedback")
+	methodBindAudioEffectReverbGetPredelayMsec     = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_predelay_msec")
+	methodBindAudioEffectReverbGetRoomSize         = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_room_size")
+	methodBindAudioEffectReverbGetSpread           = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_spread")
+	methodBindAudioEffectReverbGetWet              = gdnative.NewLazyMethodBind("AudioEffectReverb", "get_wet")
+	methodBindAudioEffectReverbSetDamping          = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_damping")
+	methodBindAudioEffectReverbSetDry              = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_dry")
+	methodBindAudioEffectReverbSetHpf              = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_hpf")
+	methodBindAudioEffectReverbSetPredelayFeedback = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_predelay_feedback")
+	methodBindAudioEffectReverbSetPredelayMsec     = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_predelay_msec")
+	methodBindAudioEffectReverbSetRoomSize         = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_room_size")
+	methodBindAudioEffectReverbSetSpread           = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_spread")
+	methodBindAudioEffectReverbSetWet              = gdnative.NewLazyMethodBind("AudioEffectReverb", "set_wet")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetDamping() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetDamping()")
@@ -45,7 +75,7 @@ func (o *AudioEffectReverb) GetDamping() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_damping")
+	methodBind := methodBindAudioEffectReverbGetDamping.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +88,8 @@ func (o *AudioEffectReverb) GetDamping() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetDry() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetDry()")
@@ -68,7 +98,7 @@ func (o *AudioEffectReverb) GetDry() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_dry")
+	methodBind := methodBindAudioEffectReverbGetDry.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +111,8 @@ func (o *AudioEffectReverb) GetDry() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetHpf() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetHpf()")
@@ -91,7 +121,7 @@ func (o *AudioEffectReverb) GetHpf() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_hpf")
+	methodBind := methodBindAudioEffectReverbGetHpf.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +134,8 @@ func (o *AudioEffectReverb) GetHpf() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetPredelayFeedback() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetPredelayFeedback()")
@@ -114,7 +144,7 @@ func (o *AudioEffectReverb) GetPredelayFeedback() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_predelay_feedback")
+	methodBind := methodBindAudioEffectReverbGetPredelayFeedback.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +157,8 @@ func (o *AudioEffectReverb) GetPredelayFeedback() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetPredelayMsec() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetPredelayMsec()")
@@ -137,7 +167,7 @@ func (o *AudioEffectReverb) GetPredelayMsec() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_predelay_msec")
+	methodBind := methodBindAudioEffectReverbGetPredelayMsec.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +180,8 @@ func (o *AudioEffectReverb) GetPredelayMsec() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetRoomSize() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetRoomSize()")
@@ -160,7 +190,7 @@ func (o *AudioEffectReverb) GetRoomSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_room_size")
+	methodBind := methodBindAudioEffectReverbGetRoomSize.Get()
 
 	// Call the parent method.
 	// float
@@ -173,8 +203,8 @@ func (o *AudioEffectReverb) GetRoomSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetSpread() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetSpread()")
@@ -183,7 +213,7 @@ func (o *AudioEffectReverb) GetSpread() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_spread")
+	methodBind := methodBindAudioEffectReverbGetSpread.Get()
 
 	// Call the parent method.
 	// float
@@ -196,8 +226,8 @@ func (o *AudioEffectReverb) GetSpread() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectReverb) GetWet() gdnative.Real {
 	//log.Println("Calling AudioEffectReverb.GetWet()")
@@ -206,7 +236,7 @@ func (o *AudioEffectReverb) GetWet() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "get_wet")
+	methodBind := methodBindAudioEffectReverbGetWet.Get()
 
 	// Call the parent method.
 	// float
@@ -219,8 +249,8 @@ func (o *AudioEffectReverb) GetWet() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectReverb) SetDamping(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetDamping()")
@@ -230,7 +260,7 @@ func (o *AudioEffectReverb) SetDamping(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_damping")
+	methodBind := methodBindAudioEffectReverbSetDamping.Get()
 
 	// Call the parent method.
 	// void
@@ -240,8 +270,8 @@ func (o *AudioEffectReverb) SetDamping(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectReverb) SetDry(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetDry()")
@@ -251,7 +281,7 @@ func (o *AudioEffectReverb) SetDry(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_dry")
+	methodBind := methodBindAudioEffectReverbSetDry.Get()
 
 	// Call the parent method.
 	// void
@@ -261,8 +291,8 @@ func (o *AudioEffectReverb) SetDry(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectReverb) SetHpf(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetHpf()")
@@ -272,7 +302,7 @@ func (o *AudioEffectReverb) SetHpf(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_hpf")
+	methodBind := methodBindAudioEffectReverbSetHpf.Get()
 
 	// Call the parent method.
 	// void
@@ -282,8 +312,8 @@ func (o *AudioEffectReverb) SetHpf(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false feedback float}], Returns: void
+	        Undocumented
+		Args: [{ false feedback float}], Returns: void
 */
 func (o *AudioEffectReverb) SetPredelayFeedback(feedback gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetPredelayFeedback()")
@@ -293,7 +323,7 @@ func (o *AudioEffectReverb) SetPredelayFeedback(feedback gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(feedback)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_predelay_feedback")
+	methodBind := methodBindAudioEffectReverbSetPredelayFeedback.Get()
 
 	// Call the parent method.
 	// void
@@ -303,8 +333,8 @@ func (o *AudioEffectReverb) SetPredelayFeedback(feedback gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false msec float}], Returns: void
+	        Undocumented
+		Args: [{ false msec float}], Returns: void
 */
 func (o *AudioEffectReverb) SetPredelayMsec(msec gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetPredelayMsec()")
@@ -314,7 +344,7 @@ func (o *AudioEffectReverb) SetPredelayMsec(msec gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(msec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_predelay_msec")
+	methodBind := methodBindAudioEffectReverbSetPredelayMsec.Get()
 
 	// Call the parent method.
 	// void
@@ -324,8 +354,8 @@ func (o *AudioEffectReverb) SetPredelayMsec(msec gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size float}], Returns: void
+	        Undocumented
+		Args: [{ false size float}], Returns: void
 */
 func (o *AudioEffectReverb) SetRoomSize(size gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetRoomSize()")
@@ -335,7 +365,7 @@ func (o *AudioEffectReverb) SetRoomSize(size gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_room_size")
+	methodBind := methodBindAudioEffectReverbSetRoomSize.Get()
 
 	// Call the parent method.
 	// void
@@ -345,8 +375,8 @@ func (o *AudioEffectReverb) SetRoomSize(size gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectReverb) SetSpread(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetSpread()")
@@ -356,7 +386,7 @@ func (o *AudioEffectReverb) SetSpread(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_spread")
+	methodBind := methodBindAudioEffectReverbSetSpread.Get()
 
 	// Call the parent method.
 	// void
@@ -366,8 +396,8 @@ func (o *AudioEffectReverb) SetSpread(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectReverb) SetWet(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectReverb.SetWet()")
@@ -377,7 +407,7 @@ func (o *AudioEffectReverb) SetWet(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectReverb", "set_wet")
+	methodBind := methodBindAudioEffectReverbSetWet.Get()
 
 	// Call the parent method.
 	// void
@@ -386,6 +416,51 @@ func (o *AudioEffectReverb) SetWet(amount gdnative.Real) {
 
 }
 
+// Damping will return the value of the "damping" property.
+func (o *AudioEffectReverb) Damping() gdnative.Real {
+	return o.GetDamping()
+}
+
+// Dry will return the value of the "dry" property.
+func (o *AudioEffectReverb) Dry() gdnative.Real {
+	return o.GetDry()
+}
+
+// Hipass will return the value of the "hipass" property.
+func (o *AudioEffectReverb) Hipass() gdnative.Real {
+	return o.GetHpf()
+}
+
+// SetHipass will set the value of the "hipass" property.
+func (o *AudioEffectReverb) SetHipass(value gdnative.Real) {
+	o.SetHpf(value)
+}
+
+// PredelayFeedback will return the value of the "predelay_feedback" property.
+func (o *AudioEffectReverb) PredelayFeedback() gdnative.Real {
+	return o.GetPredelayFeedback()
+}
+
+// PredelayMsec will return the value of the "predelay_msec" property.
+func (o *AudioEffectReverb) PredelayMsec() gdnative.Real {
+	return o.GetPredelayMsec()
+}
+
+// RoomSize will return the value of the "room_size" property.
+func (o *AudioEffectReverb) RoomSize() gdnative.Real {
+	return o.GetRoomSize()
+}
+
+// Spread will return the value of the "spread" property.
+func (o *AudioEffectReverb) Spread() gdnative.Real {
+	return o.GetSpread()
+}
+
+// Wet will return the value of the "wet" property.
+func (o *AudioEffectReverb) Wet() gdnative.Real {
+	return o.GetWet()
+}
+
 // AudioEffectReverbImplementer is an interface that implements the methods
 // of the AudioEffectReverb class.
 type AudioEffectReverbImplementer interface {
@@ -406,4 +481,13 @@ type AudioEffectReverbImplementer interface {
 	SetRoomSize(size gdnative.Real)
 	SetSpread(amount gdnative.Real)
 	SetWet(amount gdnative.Real)
+	Damping() gdnative.Real
+	Dry() gdnative.Real
+	Hipass() gdnative.Real
+	SetHipass(value gdnative.Real)
+	PredelayFeedback() gdnative.Real
+	PredelayMsec() gdnative.Real
+	RoomSize() gdnative.Real
+	Spread() gdnative.Real
+	Wet() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package audioeffect
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,48 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioEffectStereoEnhanceFromPointer(ptr gdnative.Pointer) AudioEffectStereoEnhance {
-func newAudioEffectStereoEnhanceFromPointer(ptr gdnative.Pointer) AudioEffectStereoEnhance {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioEffectStereoEnhance{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type AudioEffectStereoEnhance struct {
 	AudioEffect
 	owner gdnative.Object
 }
 
+// classConstructorAudioEffectStereoEnhance is the class constructor of the AudioEffectStereoEnhance class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioEffectStereoEnhance = gdnative.NewLazyClassConstructor("AudioEffectStereoEnhance")
+
+// NewAudioEffectStereoEnhance will create a new instance of the AudioEffectStereoEnhance class.
+// AudioEffectStereoEnhance is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioEffectStereoEnhance() *AudioEffectStereoEnhance {
+	obj := &AudioEffectStereoEnhance{}
+	obj.SetBaseObject(classConstructorAudioEffectStereoEnhance.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioEffectStereoEnhance) BaseClass() string {
 	return "AudioEffectStereoEnhance"
 }
 
+// Method binds of the AudioEffectStereoEnhance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioEffectStereoEnhanceGetPanPullout  = gdnative.NewLazyMethodBind("AudioEffectStereoEnhance", "get_pan_pullout")
+	methodBindAudioEffectStereoEnhanceGetSurround    = gdnative.NewLazyMethodBind("AudioEffectStereoEnhance", "get_surround")
+	methodBindAudioEffectStereoEnhanceGetTimePullout = gdnative.NewLazyMethodBind("AudioEffectStereoEnhance", "get_time_pullout")
+	methodBindAudioEffectStereoEnhanceSetPanPullout  = gdnative.NewLazyMethodBind("AudioEffectStereoEnhance", "set_pan_pullout")
+	methodBindAudioEffectStereoEnhanceSetSurround    = gdnative.NewLazyMethodBind("AudioEffectStereoEnhance", "set_surround")
+	methodBindAudioEffectStereoEnhanceSetTimePullout = gdnative.NewLazyMethodBind("AudioEffectStereoEnhance", "set_time_pullout")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectStereoEnhance) GetPanPullout() gdnative.Real {
 	//log.Println("Calling AudioEffectStereoEnhance.GetPanPullout()")
@@ -45,7 +64,7 @@ func (o *AudioEffectStereoEnhance) GetPanPullout() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectStereoEnhance", "get_pan_pullout")
+	methodBind := methodBindAudioEffectStereoEnhanceGetPanPullout.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +77,8 @@ func (o *AudioEffectStereoEnhance) GetPanPullout() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectStereoEnhance) GetSurround() gdnative.Real {
 	//log.Println("Calling AudioEffectStereoEnhance.GetSurround()")
@@ -68,7 +87,7 @@ func (o *AudioEffectStereoEnhance) GetSurround() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectStereoEnhance", "get_surround")
+	methodBind := methodBindAudioEffectStereoEnhanceGetSurround.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +100,8 @@ func (o *AudioEffectStereoEnhance) GetSurround() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioEffectStereoEnhance) GetTimePullout() gdnative.Real {
 	//log.Println("Calling AudioEffectStereoEnhance.GetTimePullout()")
@@ -91,7 +110,7 @@ func (o *AudioEffectStereoEnhance) GetTimePullout() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectStereoEnhance", "get_time_pullout")
+	methodBind := methodBindAudioEffectStereoEnhanceGetTimePullout.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +123,8 @@ func (o *AudioEffectStereoEnhance) GetTimePullout() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectStereoEnhance) SetPanPullout(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectStereoEnhance.SetPanPullout()")
@@ -115,7 +134,7 @@ func (o *AudioEffectStereoEnhance) SetPanPullout(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectStereoEnhance", "set_pan_pullout")
+	methodBind := methodBindAudioEffectStereoEnhanceSetPanPullout.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +144,8 @@ func (o *AudioEffectStereoEnhance) SetPanPullout(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectStereoEnhance) SetSurround(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectStereoEnhance.SetSurround()")
@@ -136,7 +155,7 @@ func (o *AudioEffectStereoEnhance) SetSurround(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectStereoEnhance", "set_surround")
+	methodBind := methodBindAudioEffectStereoEnhanceSetSurround.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +165,8 @@ func (o *AudioEffectStereoEnhance) SetSurround(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *AudioEffectStereoEnhance) SetTimePullout(amount gdnative.Real) {
 	//log.Println("Calling AudioEffectStereoEnhance.SetTimePullout()")
@@ -157,7 +176,7 @@ func (o *AudioEffectStereoEnhance) SetTimePullout(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioEffectStereoEnhance", "set_time_pullout")
+	methodBind := methodBindAudioEffectStereoEnhanceSetTimePullout.Get()
 
 	// Call the parent method.
 	// void
@@ -166,6 +185,26 @@ func (o *AudioEffectStereoEnhance) SetTimePullout(amount gdnative.Real) {
 
 }
 
+// PanPullout will return the value of the "pan_pullout" property.
+func (o *AudioEffectStereoEnhance) PanPullout() gdnative.Real {
+	return o.GetPanPullout()
+}
+
+// Surround will return the value of the "surround" property.
+func (o *AudioEffectStereoEnhance) Surround() gdnative.Real {
+	return o.GetSurround()
+}
+
+// TimePulloutMs will return the value of the "time_pullout_ms" property.
+func (o *AudioEffectStereoEnhance) TimePulloutMs() gdnative.Real {
+	return o.GetTimePullout()
+}
+
+// SetTimePulloutMs will set the value of the "time_pullout_ms" property.
+func (o *AudioEffectStereoEnhance) SetTimePulloutMs(value gdnative.Real) {
+	o.SetTimePullout(value)
+}
+
 // AudioEffectStereoEnhanceImplementer is an interface that implements the methods
 // of the AudioEffectStereoEnhance class.
 type AudioEffectStereoEnhanceImplementer interface {
@@ -176,4 +215,8 @@ type AudioEffectStereoEnhanceImplementer interface {
 	SetPanPullout(amount gdnative.Real)
 	SetSurround(amount gdnative.Real)
 	SetTimePullout(amount gdnative.Real)
+	PanPullout() gdnative.Real
+	Surround() gdnative.Real
+	TimePulloutMs() gdnative.Real
+	SetTimePulloutMs(value gdnative.Real)
 }
@@ -0,0 +1,140 @@
+package audioeffect
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("AudioEffect", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffect{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectAmplify", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectAmplify{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectBandLimitFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectBandLimitFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectBandPassFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectBandPassFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectChorus", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectChorus{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectCompressor", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectCompressor{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectDelay", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectDelay{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectDistortion", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectDistortion{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectEQ", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectEQ{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectEQ10", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectEQ10{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectEQ21", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectEQ21{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectEQ6", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectEQ6{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectHighPassFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectHighPassFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectHighShelfFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectHighShelfFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectLimiter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectLimiter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectLowPassFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectLowPassFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectLowShelfFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectLowShelfFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectNotchFilter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectNotchFilter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectPanner", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectPanner{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectPhaser", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectPhaser{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectPitchShift", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectPitchShift{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectReverb", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectReverb{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioEffectStereoEnhance", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioEffectStereoEnhance{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,8 @@
-package godot
+package audiostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioStreamFromPointer(ptr gdnative.Pointer) AudioStream {
-func newAudioStreamFromPointer(ptr gdnative.Pointer) AudioStream {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStream{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base class for audio streams. Audio streams are used for music playback, or other types of streamed sounds that don't fit or require more flexibility than a [Sample].
 */
 type AudioStream struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
@@ -34,9 +26,14 @@ func (o *AudioStream) BaseClass() string {
 	return "AudioStream"
 }
 
-/*
+// Method binds of the AudioStream class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioStreamGetLength = gdnative.NewLazyMethodBind("AudioStream", "get_length")
+)
 
-	Args: [], Returns: float
+/*
+Args: [], Returns: float
 */
 func (o *AudioStream) GetLength() gdnative.Real {
 	//log.Println("Calling AudioStream.GetLength()")
@@ -45,7 +42,7 @@ func (o *AudioStream) GetLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStream", "get_length")
+	methodBind := methodBindAudioStreamGetLength.Get()
 
 	// Call the parent method.
 	// float
@@ -60,6 +57,6 @@ func (o *AudioStream) GetLength() gdnative.Real {
 // AudioStreamImplementer is an interface that implements the methods
 // of the AudioStream class.
 type AudioStreamImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	GetLength() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package audiostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioStreamOGGVorbisFromPointer(ptr gdnative.Pointer) AudioStreamOGGVorbis {
-func newAudioStreamOGGVorbisFromPointer(ptr gdnative.Pointer) AudioStreamOGGVorbis {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStreamOGGVorbis{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,41 @@ type AudioStreamOGGVorbis struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioStreamOGGVorbis is the class constructor of the AudioStreamOGGVorbis class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioStreamOGGVorbis = gdnative.NewLazyClassConstructor("AudioStreamOGGVorbis")
+
+// NewAudioStreamOGGVorbis will create a new instance of the AudioStreamOGGVorbis class.
+// AudioStreamOGGVorbis is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioStreamOGGVorbis() *AudioStreamOGGVorbis {
+	obj := &AudioStreamOGGVorbis{}
+	obj.SetBaseObject(classConstructorAudioStreamOGGVorbis.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioStreamOGGVorbis) BaseClass() string {
 	return "AudioStreamOGGVorbis"
 }
 
+// Method binds of the AudioStreamOGGVorbis class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioStreamOGGVorbisX_GetData     = gdnative.NewLazyMethodBind("AudioStreamOGGVorbis", "_get_data")
+	methodBindAudioStreamOGGVorbisX_SetData     = gdnative.NewLazyMethodBind("AudioStreamOGGVorbis", "_set_data")
+	methodBindAudioStreamOGGVorbisGetLoopOffset = gdnative.NewLazyMethodBind("AudioStreamOGGVorbis", "get_loop_offset")
+	methodBindAudioStreamOGGVorbisHasLoop       = gdnative.NewLazyMethodBind("AudioStreamOGGVorbis", "has_loop")
+	methodBindAudioStreamOGGVorbisSetLoop       = gdnative.NewLazyMethodBind("AudioStreamOGGVorbis", "set_loop")
+	methodBindAudioStreamOGGVorbisSetLoopOffset = gdnative.NewLazyMethodBind("AudioStreamOGGVorbis", "set_loop_offset")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolByteArray
+	        Undocumented
+		Args: [], Returns: PoolByteArray
 */
 func (o *AudioStreamOGGVorbis) X_GetData() gdnative.PoolByteArray {
 	//log.Println("Calling AudioStreamOGGVorbis.X_GetData()")
@@ -45,7 +65,7 @@ func (o *AudioStreamOGGVorbis) X_GetData() gdnative.PoolByteArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamOGGVorbis", "_get_data")
+	methodBind := methodBindAudioStreamOGGVorbisX_GetData.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -58,8 +78,8 @@ func (o *AudioStreamOGGVorbis) X_GetData() gdnative.PoolByteArray {
 }
 
 /*
-        Undocumented
-	Args: [{ false data PoolByteArray}], Returns: void
+	        Undocumented
+		Args: [{ false data PoolByteArray}], Returns: void
 */
 func (o *AudioStreamOGGVorbis) X_SetData(data gdnative.PoolByteArray) {
 	//log.Println("Calling AudioStreamOGGVorbis.X_SetData()")
@@ -69,7 +89,7 @@ func (o *AudioStreamOGGVorbis) X_SetData(data gdnative.PoolByteArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamOGGVorbis", "_set_data")
+	methodBind := methodBindAudioStreamOGGVorbisX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +99,8 @@ func (o *AudioStreamOGGVorbis) X_SetData(data gdnative.PoolByteArray) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamOGGVorbis) GetLoopOffset() gdnative.Real {
 	//log.Println("Calling AudioStreamOGGVorbis.GetLoopOffset()")
@@ -89,7 +109,7 @@ func (o *AudioStreamOGGVorbis) GetLoopOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamOGGVorbis", "get_loop_offset")
+	methodBind := methodBindAudioStreamOGGVorbisGetLoopOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -102,8 +122,8 @@ func (o *AudioStreamOGGVorbis) GetLoopOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamOGGVorbis) HasLoop() gdnative.Bool {
 	//log.Println("Calling AudioStreamOGGVorbis.HasLoop()")
@@ -112,7 +132,7 @@ func (o *AudioStreamOGGVorbis) HasLoop() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamOGGVorbis", "has_loop")
+	methodBind := methodBindAudioStreamOGGVorbisHasLoop.Get()
 
 	// Call the parent method.
 	// bool
@@ -125,8 +145,8 @@ func (o *AudioStreamOGGVorbis) HasLoop() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AudioStreamOGGVorbis) SetLoop(enable gdnative.Bool) {
 	//log.Println("Calling AudioStreamOGGVorbis.SetLoop()")
@@ -136,7 +156,7 @@ func (o *AudioStreamOGGVorbis) SetLoop(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamOGGVorbis", "set_loop")
+	methodBind := methodBindAudioStreamOGGVorbisSetLoop.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +166,8 @@ func (o *AudioStreamOGGVorbis) SetLoop(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false seconds float}], Returns: void
+	        Undocumented
+		Args: [{ false seconds float}], Returns: void
 */
 func (o *AudioStreamOGGVorbis) SetLoopOffset(seconds gdnative.Real) {
 	//log.Println("Calling AudioStreamOGGVorbis.SetLoopOffset()")
@@ -157,7 +177,7 @@ func (o *AudioStreamOGGVorbis) SetLoopOffset(seconds gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(seconds)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamOGGVorbis", "set_loop_offset")
+	methodBind := methodBindAudioStreamOGGVorbisSetLoopOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -166,6 +186,26 @@ func (o *AudioStreamOGGVorbis) SetLoopOffset(seconds gdnative.Real) {
 
 }
 
+// Data will return the value of the "data" property.
+func (o *AudioStreamOGGVorbis) Data() gdnative.PoolByteArray {
+	return o.X_GetData()
+}
+
+// SetData will set the value of the "data" property.
+func (o *AudioStreamOGGVorbis) SetData(value gdnative.PoolByteArray) {
+	o.X_SetData(value)
+}
+
+// Loop will return the value of the "loop" property.
+func (o *AudioStreamOGGVorbis) Loop() gdnative.Bool {
+	return o.HasLoop()
+}
+
+// LoopOffset will return the value of the "loop_offset" property.
+func (o *AudioStreamOGGVorbis) LoopOffset() gdnative.Real {
+	return o.GetLoopOffset()
+}
+
 // AudioStreamOGGVorbisImplementer is an interface that implements the methods
 // of the AudioStreamOGGVorbis class.
 type AudioStreamOGGVorbisImplementer interface {
@@ -176,4 +216,8 @@ type AudioStreamOGGVorbisImplementer interface {
 	HasLoop() gdnative.Bool
 	SetLoop(enable gdnative.Bool)
 	SetLoopOffset(seconds gdnative.Real)
+	Data() gdnative.PoolByteArray
+	SetData(value gdnative.PoolByteArray)
+	Loop() gdnative.Bool
+	LoopOffset() gdnative.Real
 }
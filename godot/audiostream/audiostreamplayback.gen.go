@@ -1,7 +1,8 @@
-package godot
+package audiostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioStreamPlaybackFromPointer(ptr gdnative.Pointer) AudioStreamPlayback {
-func newAudioStreamPlaybackFromPointer(ptr gdnative.Pointer) AudioStreamPlayback {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStreamPlayback{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Can play, loop, pause a scroll through Audio. See [AudioStream] and [AudioStreamOGGVorbis] for usage.
 */
 type AudioStreamPlayback struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
@@ -37,5 +29,5 @@ func (o *AudioStreamPlayback) BaseClass() string {
 // AudioStreamPlaybackImplementer is an interface that implements the methods
 // of the AudioStreamPlayback class.
 type AudioStreamPlaybackImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 }
@@ -1,7 +1,10 @@
-package godot
+package audiostream
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,30 +25,84 @@ const (
 	AudioStreamPlayerMixTargetSurround AudioStreamPlayerMixTarget = 1
 )
 
-//func NewAudioStreamPlayerFromPointer(ptr gdnative.Pointer) AudioStreamPlayer {
-func newAudioStreamPlayerFromPointer(ptr gdnative.Pointer) AudioStreamPlayer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStreamPlayer{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the AudioStreamPlayerMixTarget value.
+func (e AudioStreamPlayerMixTarget) String() string {
+	switch e {
+	case AudioStreamPlayerMixTargetCenter:
+		return "MIX_TARGET_CENTER"
+	case AudioStreamPlayerMixTargetStereo:
+		return "MIX_TARGET_STEREO"
+	case AudioStreamPlayerMixTargetSurround:
+		return "MIX_TARGET_SURROUND"
+	}
+	return fmt.Sprintf("AudioStreamPlayerMixTarget(%d)", int(e))
+}
 
-	return obj
+// ParseAudioStreamPlayerMixTarget will return the AudioStreamPlayerMixTarget value with the given Godot name.
+func ParseAudioStreamPlayerMixTarget(name string) (AudioStreamPlayerMixTarget, error) {
+	switch name {
+	case "MIX_TARGET_CENTER":
+		return AudioStreamPlayerMixTargetCenter, nil
+	case "MIX_TARGET_STEREO":
+		return AudioStreamPlayerMixTargetStereo, nil
+	case "MIX_TARGET_SURROUND":
+		return AudioStreamPlayerMixTargetSurround, nil
+	}
+	return 0, fmt.Errorf("invalid AudioStreamPlayerMixTarget value %q", name)
 }
 
 /*
 Plays background audio.
 */
 type AudioStreamPlayer struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
+// classConstructorAudioStreamPlayer is the class constructor of the AudioStreamPlayer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioStreamPlayer = gdnative.NewLazyClassConstructor("AudioStreamPlayer")
+
+// NewAudioStreamPlayer will create a new instance of the AudioStreamPlayer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAudioStreamPlayer() *AudioStreamPlayer {
+	obj := &AudioStreamPlayer{}
+	obj.SetBaseObject(classConstructorAudioStreamPlayer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioStreamPlayer) BaseClass() string {
 	return "AudioStreamPlayer"
 }
 
+// Method binds of the AudioStreamPlayer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioStreamPlayerX_BusLayoutChanged  = gdnative.NewLazyMethodBind("AudioStreamPlayer", "_bus_layout_changed")
+	methodBindAudioStreamPlayerX_IsActive          = gdnative.NewLazyMethodBind("AudioStreamPlayer", "_is_active")
+	methodBindAudioStreamPlayerX_SetPlaying        = gdnative.NewLazyMethodBind("AudioStreamPlayer", "_set_playing")
+	methodBindAudioStreamPlayerGetBus              = gdnative.NewLazyMethodBind("AudioStreamPlayer", "get_bus")
+	methodBindAudioStreamPlayerGetMixTarget        = gdnative.NewLazyMethodBind("AudioStreamPlayer", "get_mix_target")
+	methodBindAudioStreamPlayerGetPlaybackPosition = gdnative.NewLazyMethodBind("AudioStreamPlayer", "get_playback_position")
+	methodBindAudioStreamPlayerGetStream           = gdnative.NewLazyMethodBind("AudioStreamPlayer", "get_stream")
+	methodBindAudioStreamPlayerGetVolumeDb         = gdnative.NewLazyMethodBind("AudioStreamPlayer", "get_volume_db")
+	methodBindAudioStreamPlayerIsAutoplayEnabled   = gdnative.NewLazyMethodBind("AudioStreamPlayer", "is_autoplay_enabled")
+	methodBindAudioStreamPlayerIsPlaying           = gdnative.NewLazyMethodBind("AudioStreamPlayer", "is_playing")
+	methodBindAudioStreamPlayerPlay                = gdnative.NewLazyMethodBind("AudioStreamPlayer", "play")
+	methodBindAudioStreamPlayerSeek                = gdnative.NewLazyMethodBind("AudioStreamPlayer", "seek")
+	methodBindAudioStreamPlayerSetAutoplay         = gdnative.NewLazyMethodBind("AudioStreamPlayer", "set_autoplay")
+	methodBindAudioStreamPlayerSetBus              = gdnative.NewLazyMethodBind("AudioStreamPlayer", "set_bus")
+	methodBindAudioStreamPlayerSetMixTarget        = gdnative.NewLazyMethodBind("AudioStreamPlayer", "set_mix_target")
+	methodBindAudioStreamPlayerSetStream           = gdnative.NewLazyMethodBind("AudioStreamPlayer", "set_stream")
+	methodBindAudioStreamPlayerSetVolumeDb         = gdnative.NewLazyMethodBind("AudioStreamPlayer", "set_volume_db")
+	methodBindAudioStreamPlayerStop                = gdnative.NewLazyMethodBind("AudioStreamPlayer", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *AudioStreamPlayer) X_BusLayoutChanged() {
 	//log.Println("Calling AudioStreamPlayer.X_BusLayoutChanged()")
@@ -54,7 +111,7 @@ func (o *AudioStreamPlayer) X_BusLayoutChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "_bus_layout_changed")
+	methodBind := methodBindAudioStreamPlayerX_BusLayoutChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -64,8 +121,8 @@ func (o *AudioStreamPlayer) X_BusLayoutChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer) X_IsActive() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer.X_IsActive()")
@@ -74,7 +131,7 @@ func (o *AudioStreamPlayer) X_IsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "_is_active")
+	methodBind := methodBindAudioStreamPlayerX_IsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -87,8 +144,8 @@ func (o *AudioStreamPlayer) X_IsActive() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AudioStreamPlayer) X_SetPlaying(enable gdnative.Bool) {
 	//log.Println("Calling AudioStreamPlayer.X_SetPlaying()")
@@ -98,7 +155,7 @@ func (o *AudioStreamPlayer) X_SetPlaying(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "_set_playing")
+	methodBind := methodBindAudioStreamPlayerX_SetPlaying.Get()
 
 	// Call the parent method.
 	// void
@@ -108,8 +165,8 @@ func (o *AudioStreamPlayer) X_SetPlaying(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AudioStreamPlayer) GetBus() gdnative.String {
 	//log.Println("Calling AudioStreamPlayer.GetBus()")
@@ -118,7 +175,7 @@ func (o *AudioStreamPlayer) GetBus() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "get_bus")
+	methodBind := methodBindAudioStreamPlayerGetBus.Get()
 
 	// Call the parent method.
 	// String
@@ -131,8 +188,8 @@ func (o *AudioStreamPlayer) GetBus() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioStreamPlayer::MixTarget
+	        Undocumented
+		Args: [], Returns: enum.AudioStreamPlayer::MixTarget
 */
 func (o *AudioStreamPlayer) GetMixTarget() AudioStreamPlayerMixTarget {
 	//log.Println("Calling AudioStreamPlayer.GetMixTarget()")
@@ -141,7 +198,7 @@ func (o *AudioStreamPlayer) GetMixTarget() AudioStreamPlayerMixTarget {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "get_mix_target")
+	methodBind := methodBindAudioStreamPlayerGetMixTarget.Get()
 
 	// Call the parent method.
 	// enum.AudioStreamPlayer::MixTarget
@@ -154,8 +211,8 @@ func (o *AudioStreamPlayer) GetMixTarget() AudioStreamPlayerMixTarget {
 }
 
 /*
-        Returns the position in the [AudioStream].
-	Args: [], Returns: float
+	        Returns the position in the [AudioStream].
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer) GetPlaybackPosition() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer.GetPlaybackPosition()")
@@ -164,7 +221,7 @@ func (o *AudioStreamPlayer) GetPlaybackPosition() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "get_playback_position")
+	methodBind := methodBindAudioStreamPlayerGetPlaybackPosition.Get()
 
 	// Call the parent method.
 	// float
@@ -177,8 +234,8 @@ func (o *AudioStreamPlayer) GetPlaybackPosition() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: AudioStream
+	        Undocumented
+		Args: [], Returns: AudioStream
 */
 func (o *AudioStreamPlayer) GetStream() AudioStreamImplementer {
 	//log.Println("Calling AudioStreamPlayer.GetStream()")
@@ -187,7 +244,7 @@ func (o *AudioStreamPlayer) GetStream() AudioStreamImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "get_stream")
+	methodBind := methodBindAudioStreamPlayerGetStream.Get()
 
 	// Call the parent method.
 	// AudioStream
@@ -195,27 +252,25 @@ func (o *AudioStreamPlayer) GetStream() AudioStreamImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAudioStreamFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AudioStreamImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "AudioStream" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AudioStreamImplementer)
+	ret := AudioStream{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(AudioStreamImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer) GetVolumeDb() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer.GetVolumeDb()")
@@ -224,7 +279,7 @@ func (o *AudioStreamPlayer) GetVolumeDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "get_volume_db")
+	methodBind := methodBindAudioStreamPlayerGetVolumeDb.Get()
 
 	// Call the parent method.
 	// float
@@ -237,8 +292,8 @@ func (o *AudioStreamPlayer) GetVolumeDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer) IsAutoplayEnabled() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer.IsAutoplayEnabled()")
@@ -247,7 +302,7 @@ func (o *AudioStreamPlayer) IsAutoplayEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "is_autoplay_enabled")
+	methodBind := methodBindAudioStreamPlayerIsAutoplayEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -260,8 +315,8 @@ func (o *AudioStreamPlayer) IsAutoplayEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer) IsPlaying() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer.IsPlaying()")
@@ -270,7 +325,7 @@ func (o *AudioStreamPlayer) IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "is_playing")
+	methodBind := methodBindAudioStreamPlayerIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -283,8 +338,8 @@ func (o *AudioStreamPlayer) IsPlaying() gdnative.Bool {
 }
 
 /*
-        Plays the audio from the given position 'from_position', in seconds.
-	Args: [{0 true from_position float}], Returns: void
+	        Plays the audio from the given position 'from_position', in seconds.
+		Args: [{0 true from_position float}], Returns: void
 */
 func (o *AudioStreamPlayer) Play(fromPosition gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer.Play()")
@@ -294,7 +349,7 @@ func (o *AudioStreamPlayer) Play(fromPosition gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fromPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "play")
+	methodBind := methodBindAudioStreamPlayerPlay.Get()
 
 	// Call the parent method.
 	// void
@@ -304,8 +359,8 @@ func (o *AudioStreamPlayer) Play(fromPosition gdnative.Real) {
 }
 
 /*
-        Sets the position from which audio will be played, in seconds.
-	Args: [{ false to_position float}], Returns: void
+	        Sets the position from which audio will be played, in seconds.
+		Args: [{ false to_position float}], Returns: void
 */
 func (o *AudioStreamPlayer) Seek(toPosition gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer.Seek()")
@@ -315,7 +370,7 @@ func (o *AudioStreamPlayer) Seek(toPosition gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "seek")
+	methodBind := methodBindAudioStreamPlayerSeek.Get()
 
 	// Call the parent method.
 	// void
@@ -325,8 +380,8 @@ func (o *AudioStreamPlayer) Seek(toPosition gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AudioStreamPlayer) SetAutoplay(enable gdnative.Bool) {
 	//log.Println("Calling AudioStreamPlayer.SetAutoplay()")
@@ -336,7 +391,7 @@ func (o *AudioStreamPlayer) SetAutoplay(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "set_autoplay")
+	methodBind := methodBindAudioStreamPlayerSetAutoplay.Get()
 
 	// Call the parent method.
 	// void
@@ -346,8 +401,8 @@ func (o *AudioStreamPlayer) SetAutoplay(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bus String}], Returns: void
+	        Undocumented
+		Args: [{ false bus String}], Returns: void
 */
 func (o *AudioStreamPlayer) SetBus(bus gdnative.String) {
 	//log.Println("Calling AudioStreamPlayer.SetBus()")
@@ -357,7 +412,7 @@ func (o *AudioStreamPlayer) SetBus(bus gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(bus)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "set_bus")
+	methodBind := methodBindAudioStreamPlayerSetBus.Get()
 
 	// Call the parent method.
 	// void
@@ -367,18 +422,18 @@ func (o *AudioStreamPlayer) SetBus(bus gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mix_target int}], Returns: void
+	        Undocumented
+		Args: [{ false mix_target enum.AudioStreamPlayer::MixTarget}], Returns: void
 */
-func (o *AudioStreamPlayer) SetMixTarget(mixTarget gdnative.Int) {
+func (o *AudioStreamPlayer) SetMixTarget(mixTarget AudioStreamPlayerMixTarget) {
 	//log.Println("Calling AudioStreamPlayer.SetMixTarget()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mixTarget)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mixTarget))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "set_mix_target")
+	methodBind := methodBindAudioStreamPlayerSetMixTarget.Get()
 
 	// Call the parent method.
 	// void
@@ -388,18 +443,18 @@ func (o *AudioStreamPlayer) SetMixTarget(mixTarget gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false stream AudioStream}], Returns: void
+	        Undocumented
+		Args: [{ false stream AudioStream}], Returns: void
 */
 func (o *AudioStreamPlayer) SetStream(stream AudioStreamImplementer) {
 	//log.Println("Calling AudioStreamPlayer.SetStream()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(stream.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(stream))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "set_stream")
+	methodBind := methodBindAudioStreamPlayerSetStream.Get()
 
 	// Call the parent method.
 	// void
@@ -409,8 +464,8 @@ func (o *AudioStreamPlayer) SetStream(stream AudioStreamImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false volume_db float}], Returns: void
+	        Undocumented
+		Args: [{ false volume_db float}], Returns: void
 */
 func (o *AudioStreamPlayer) SetVolumeDb(volumeDb gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer.SetVolumeDb()")
@@ -420,7 +475,7 @@ func (o *AudioStreamPlayer) SetVolumeDb(volumeDb gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(volumeDb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "set_volume_db")
+	methodBind := methodBindAudioStreamPlayerSetVolumeDb.Get()
 
 	// Call the parent method.
 	// void
@@ -430,8 +485,8 @@ func (o *AudioStreamPlayer) SetVolumeDb(volumeDb gdnative.Real) {
 }
 
 /*
-        Stops the audio.
-	Args: [], Returns: void
+	        Stops the audio.
+		Args: [], Returns: void
 */
 func (o *AudioStreamPlayer) Stop() {
 	//log.Println("Calling AudioStreamPlayer.Stop()")
@@ -440,7 +495,7 @@ func (o *AudioStreamPlayer) Stop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer", "stop")
+	methodBind := methodBindAudioStreamPlayerStop.Get()
 
 	// Call the parent method.
 	// void
@@ -449,14 +504,76 @@ func (o *AudioStreamPlayer) Stop() {
 
 }
 
+// PlayWithDefaults will call Play using the default values for: from_position.
+func (o *AudioStreamPlayer) PlayWithDefaults() {
+	o.Play(0)
+}
+
+// Autoplay will return the value of the "autoplay" property.
+func (o *AudioStreamPlayer) Autoplay() gdnative.Bool {
+	return o.IsAutoplayEnabled()
+}
+
+// Bus will return the value of the "bus" property.
+func (o *AudioStreamPlayer) Bus() gdnative.String {
+	return o.GetBus()
+}
+
+// MixTarget will return the value of the "mix_target" property.
+func (o *AudioStreamPlayer) MixTarget() AudioStreamPlayerMixTarget {
+	return o.GetMixTarget()
+}
+
+// Playing will return the value of the "playing" property.
+func (o *AudioStreamPlayer) Playing() gdnative.Bool {
+	return o.IsPlaying()
+}
+
+// SetPlaying will set the value of the "playing" property.
+func (o *AudioStreamPlayer) SetPlaying(value gdnative.Bool) {
+	o.X_SetPlaying(value)
+}
+
+// Stream will return the value of the "stream" property.
+func (o *AudioStreamPlayer) Stream() AudioStreamImplementer {
+	return o.GetStream()
+}
+
+// VolumeDb will return the value of the "volume_db" property.
+func (o *AudioStreamPlayer) VolumeDb() gdnative.Real {
+	return o.GetVolumeDb()
+}
+
+// Signals of the AudioStreamPlayer class.
+const (
+	AudioStreamPlayerSignalFinished gdnative.String = "finished"
+)
+
+// ConnectFinished will connect the "finished" signal to the given method of the target object.
+func (o *AudioStreamPlayer) ConnectFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AudioStreamPlayerSignalFinished, target, method, binds, 0)
+}
+
+// EmitFinished will emit the "finished" signal.
+func (o *AudioStreamPlayer) EmitFinished() error {
+
+	ret, err := o.EmitSignal(AudioStreamPlayerSignalFinished)
+	ret.Destroy()
+	return err
+}
+
 // AudioStreamPlayerImplementer is an interface that implements the methods
 // of the AudioStreamPlayer class.
 type AudioStreamPlayerImplementer interface {
-	NodeImplementer
+	godot.NodeImplementer
 	X_BusLayoutChanged()
 	X_IsActive() gdnative.Bool
 	X_SetPlaying(enable gdnative.Bool)
 	GetBus() gdnative.String
+	GetMixTarget() AudioStreamPlayerMixTarget
 	GetPlaybackPosition() gdnative.Real
 	GetStream() AudioStreamImplementer
 	GetVolumeDb() gdnative.Real
@@ -466,8 +583,18 @@ type AudioStreamPlayerImplementer interface {
 	Seek(toPosition gdnative.Real)
 	SetAutoplay(enable gdnative.Bool)
 	SetBus(bus gdnative.String)
-	SetMixTarget(mixTarget gdnative.Int)
+	SetMixTarget(mixTarget AudioStreamPlayerMixTarget)
 	SetStream(stream AudioStreamImplementer)
 	SetVolumeDb(volumeDb gdnative.Real)
 	Stop()
+	PlayWithDefaults()
+	Autoplay() gdnative.Bool
+	Bus() gdnative.String
+	MixTarget() AudioStreamPlayerMixTarget
+	Playing() gdnative.Bool
+	SetPlaying(value gdnative.Bool)
+	Stream() AudioStreamImplementer
+	VolumeDb() gdnative.Real
+	ConnectFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFinished() error
 }
@@ -1,7 +1,8 @@
-package godot
+package audiostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,62 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioStreamPlayer2DFromPointer(ptr gdnative.Pointer) AudioStreamPlayer2D {
-func newAudioStreamPlayer2DFromPointer(ptr gdnative.Pointer) AudioStreamPlayer2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStreamPlayer2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Plays audio that dampens with distance from screen center.
 */
 type AudioStreamPlayer2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorAudioStreamPlayer2D is the class constructor of the AudioStreamPlayer2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioStreamPlayer2D = gdnative.NewLazyClassConstructor("AudioStreamPlayer2D")
+
+// NewAudioStreamPlayer2D will create a new instance of the AudioStreamPlayer2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAudioStreamPlayer2D() *AudioStreamPlayer2D {
+	obj := &AudioStreamPlayer2D{}
+	obj.SetBaseObject(classConstructorAudioStreamPlayer2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioStreamPlayer2D) BaseClass() string {
 	return "AudioStreamPlayer2D"
 }
 
+// Method binds of the AudioStreamPlayer2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioStreamPlayer2DX_BusLayoutChanged  = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "_bus_layout_changed")
+	methodBindAudioStreamPlayer2DX_IsActive          = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "_is_active")
+	methodBindAudioStreamPlayer2DX_SetPlaying        = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "_set_playing")
+	methodBindAudioStreamPlayer2DGetAreaMask         = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "get_area_mask")
+	methodBindAudioStreamPlayer2DGetAttenuation      = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "get_attenuation")
+	methodBindAudioStreamPlayer2DGetBus              = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "get_bus")
+	methodBindAudioStreamPlayer2DGetMaxDistance      = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "get_max_distance")
+	methodBindAudioStreamPlayer2DGetPlaybackPosition = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "get_playback_position")
+	methodBindAudioStreamPlayer2DGetStream           = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "get_stream")
+	methodBindAudioStreamPlayer2DGetVolumeDb         = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "get_volume_db")
+	methodBindAudioStreamPlayer2DIsAutoplayEnabled   = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "is_autoplay_enabled")
+	methodBindAudioStreamPlayer2DIsPlaying           = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "is_playing")
+	methodBindAudioStreamPlayer2DPlay                = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "play")
+	methodBindAudioStreamPlayer2DSeek                = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "seek")
+	methodBindAudioStreamPlayer2DSetAreaMask         = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "set_area_mask")
+	methodBindAudioStreamPlayer2DSetAttenuation      = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "set_attenuation")
+	methodBindAudioStreamPlayer2DSetAutoplay         = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "set_autoplay")
+	methodBindAudioStreamPlayer2DSetBus              = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "set_bus")
+	methodBindAudioStreamPlayer2DSetMaxDistance      = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "set_max_distance")
+	methodBindAudioStreamPlayer2DSetStream           = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "set_stream")
+	methodBindAudioStreamPlayer2DSetVolumeDb         = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "set_volume_db")
+	methodBindAudioStreamPlayer2DStop                = gdnative.NewLazyMethodBind("AudioStreamPlayer2D", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *AudioStreamPlayer2D) X_BusLayoutChanged() {
 	//log.Println("Calling AudioStreamPlayer2D.X_BusLayoutChanged()")
@@ -45,7 +78,7 @@ func (o *AudioStreamPlayer2D) X_BusLayoutChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "_bus_layout_changed")
+	methodBind := methodBindAudioStreamPlayer2DX_BusLayoutChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +88,8 @@ func (o *AudioStreamPlayer2D) X_BusLayoutChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer2D) X_IsActive() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer2D.X_IsActive()")
@@ -65,7 +98,7 @@ func (o *AudioStreamPlayer2D) X_IsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "_is_active")
+	methodBind := methodBindAudioStreamPlayer2DX_IsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -78,8 +111,8 @@ func (o *AudioStreamPlayer2D) X_IsActive() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AudioStreamPlayer2D) X_SetPlaying(enable gdnative.Bool) {
 	//log.Println("Calling AudioStreamPlayer2D.X_SetPlaying()")
@@ -89,7 +122,7 @@ func (o *AudioStreamPlayer2D) X_SetPlaying(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "_set_playing")
+	methodBind := methodBindAudioStreamPlayer2DX_SetPlaying.Get()
 
 	// Call the parent method.
 	// void
@@ -99,8 +132,8 @@ func (o *AudioStreamPlayer2D) X_SetPlaying(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *AudioStreamPlayer2D) GetAreaMask() gdnative.Int {
 	//log.Println("Calling AudioStreamPlayer2D.GetAreaMask()")
@@ -109,7 +142,7 @@ func (o *AudioStreamPlayer2D) GetAreaMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "get_area_mask")
+	methodBind := methodBindAudioStreamPlayer2DGetAreaMask.Get()
 
 	// Call the parent method.
 	// int
@@ -122,8 +155,8 @@ func (o *AudioStreamPlayer2D) GetAreaMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer2D) GetAttenuation() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer2D.GetAttenuation()")
@@ -132,7 +165,7 @@ func (o *AudioStreamPlayer2D) GetAttenuation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "get_attenuation")
+	methodBind := methodBindAudioStreamPlayer2DGetAttenuation.Get()
 
 	// Call the parent method.
 	// float
@@ -145,8 +178,8 @@ func (o *AudioStreamPlayer2D) GetAttenuation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AudioStreamPlayer2D) GetBus() gdnative.String {
 	//log.Println("Calling AudioStreamPlayer2D.GetBus()")
@@ -155,7 +188,7 @@ func (o *AudioStreamPlayer2D) GetBus() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "get_bus")
+	methodBind := methodBindAudioStreamPlayer2DGetBus.Get()
 
 	// Call the parent method.
 	// String
@@ -168,8 +201,8 @@ func (o *AudioStreamPlayer2D) GetBus() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer2D) GetMaxDistance() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer2D.GetMaxDistance()")
@@ -178,7 +211,7 @@ func (o *AudioStreamPlayer2D) GetMaxDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "get_max_distance")
+	methodBind := methodBindAudioStreamPlayer2DGetMaxDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -191,8 +224,8 @@ func (o *AudioStreamPlayer2D) GetMaxDistance() gdnative.Real {
 }
 
 /*
-        Returns the position in the [AudioStream].
-	Args: [], Returns: float
+	        Returns the position in the [AudioStream].
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer2D) GetPlaybackPosition() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer2D.GetPlaybackPosition()")
@@ -201,7 +234,7 @@ func (o *AudioStreamPlayer2D) GetPlaybackPosition() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "get_playback_position")
+	methodBind := methodBindAudioStreamPlayer2DGetPlaybackPosition.Get()
 
 	// Call the parent method.
 	// float
@@ -214,8 +247,8 @@ func (o *AudioStreamPlayer2D) GetPlaybackPosition() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: AudioStream
+	        Undocumented
+		Args: [], Returns: AudioStream
 */
 func (o *AudioStreamPlayer2D) GetStream() AudioStreamImplementer {
 	//log.Println("Calling AudioStreamPlayer2D.GetStream()")
@@ -224,7 +257,7 @@ func (o *AudioStreamPlayer2D) GetStream() AudioStreamImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "get_stream")
+	methodBind := methodBindAudioStreamPlayer2DGetStream.Get()
 
 	// Call the parent method.
 	// AudioStream
@@ -232,27 +265,25 @@ func (o *AudioStreamPlayer2D) GetStream() AudioStreamImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAudioStreamFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AudioStreamImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "AudioStream" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AudioStreamImplementer)
+	ret := AudioStream{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(AudioStreamImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer2D) GetVolumeDb() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer2D.GetVolumeDb()")
@@ -261,7 +292,7 @@ func (o *AudioStreamPlayer2D) GetVolumeDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "get_volume_db")
+	methodBind := methodBindAudioStreamPlayer2DGetVolumeDb.Get()
 
 	// Call the parent method.
 	// float
@@ -274,8 +305,8 @@ func (o *AudioStreamPlayer2D) GetVolumeDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer2D) IsAutoplayEnabled() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer2D.IsAutoplayEnabled()")
@@ -284,7 +315,7 @@ func (o *AudioStreamPlayer2D) IsAutoplayEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "is_autoplay_enabled")
+	methodBind := methodBindAudioStreamPlayer2DIsAutoplayEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -297,8 +328,8 @@ func (o *AudioStreamPlayer2D) IsAutoplayEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer2D) IsPlaying() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer2D.IsPlaying()")
@@ -307,7 +338,7 @@ func (o *AudioStreamPlayer2D) IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "is_playing")
+	methodBind := methodBindAudioStreamPlayer2DIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -320,8 +351,8 @@ func (o *AudioStreamPlayer2D) IsPlaying() gdnative.Bool {
 }
 
 /*
-        Plays the audio from the given position 'from_position', in seconds.
-	Args: [{0 true from_position float}], Returns: void
+	        Plays the audio from the given position 'from_position', in seconds.
+		Args: [{0 true from_position float}], Returns: void
 */
 func (o *AudioStreamPlayer2D) Play(fromPosition gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer2D.Play()")
@@ -331,7 +362,7 @@ func (o *AudioStreamPlayer2D) Play(fromPosition gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fromPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "play")
+	methodBind := methodBindAudioStreamPlayer2DPlay.Get()
 
 	// Call the parent method.
 	// void
@@ -341,8 +372,8 @@ func (o *AudioStreamPlayer2D) Play(fromPosition gdnative.Real) {
 }
 
 /*
-        Sets the position from which audio will be played, in seconds.
-	Args: [{ false to_position float}], Returns: void
+	        Sets the position from which audio will be played, in seconds.
+		Args: [{ false to_position float}], Returns: void
 */
 func (o *AudioStreamPlayer2D) Seek(toPosition gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer2D.Seek()")
@@ -352,7 +383,7 @@ func (o *AudioStreamPlayer2D) Seek(toPosition gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "seek")
+	methodBind := methodBindAudioStreamPlayer2DSeek.Get()
 
 	// Call the parent method.
 	// void
@@ -362,8 +393,8 @@ func (o *AudioStreamPlayer2D) Seek(toPosition gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *AudioStreamPlayer2D) SetAreaMask(mask gdnative.Int) {
 	//log.Println("Calling AudioStreamPlayer2D.SetAreaMask()")
@@ -373,7 +404,7 @@ func (o *AudioStreamPlayer2D) SetAreaMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "set_area_mask")
+	methodBind := methodBindAudioStreamPlayer2DSetAreaMask.Get()
 
 	// Call the parent method.
 	// void
@@ -383,8 +414,8 @@ func (o *AudioStreamPlayer2D) SetAreaMask(mask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false curve float}], Returns: void
+	        Undocumented
+		Args: [{ false curve float}], Returns: void
 */
 func (o *AudioStreamPlayer2D) SetAttenuation(curve gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer2D.SetAttenuation()")
@@ -394,7 +425,7 @@ func (o *AudioStreamPlayer2D) SetAttenuation(curve gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(curve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "set_attenuation")
+	methodBind := methodBindAudioStreamPlayer2DSetAttenuation.Get()
 
 	// Call the parent method.
 	// void
@@ -404,8 +435,8 @@ func (o *AudioStreamPlayer2D) SetAttenuation(curve gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AudioStreamPlayer2D) SetAutoplay(enable gdnative.Bool) {
 	//log.Println("Calling AudioStreamPlayer2D.SetAutoplay()")
@@ -415,7 +446,7 @@ func (o *AudioStreamPlayer2D) SetAutoplay(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "set_autoplay")
+	methodBind := methodBindAudioStreamPlayer2DSetAutoplay.Get()
 
 	// Call the parent method.
 	// void
@@ -425,8 +456,8 @@ func (o *AudioStreamPlayer2D) SetAutoplay(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bus String}], Returns: void
+	        Undocumented
+		Args: [{ false bus String}], Returns: void
 */
 func (o *AudioStreamPlayer2D) SetBus(bus gdnative.String) {
 	//log.Println("Calling AudioStreamPlayer2D.SetBus()")
@@ -436,7 +467,7 @@ func (o *AudioStreamPlayer2D) SetBus(bus gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(bus)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "set_bus")
+	methodBind := methodBindAudioStreamPlayer2DSetBus.Get()
 
 	// Call the parent method.
 	// void
@@ -446,8 +477,8 @@ func (o *AudioStreamPlayer2D) SetBus(bus gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pixels float}], Returns: void
+	        Undocumented
+		Args: [{ false pixels float}], Returns: void
 */
 func (o *AudioStreamPlayer2D) SetMaxDistance(pixels gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer2D.SetMaxDistance()")
@@ -457,7 +488,7 @@ func (o *AudioStreamPlayer2D) SetMaxDistance(pixels gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(pixels)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "set_max_distance")
+	methodBind := methodBindAudioStreamPlayer2DSetMaxDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -467,18 +498,18 @@ func (o *AudioStreamPlayer2D) SetMaxDistance(pixels gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false stream AudioStream}], Returns: void
+	        Undocumented
+		Args: [{ false stream AudioStream}], Returns: void
 */
 func (o *AudioStreamPlayer2D) SetStream(stream AudioStreamImplementer) {
 	//log.Println("Calling AudioStreamPlayer2D.SetStream()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(stream.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(stream))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "set_stream")
+	methodBind := methodBindAudioStreamPlayer2DSetStream.Get()
 
 	// Call the parent method.
 	// void
@@ -488,8 +519,8 @@ func (o *AudioStreamPlayer2D) SetStream(stream AudioStreamImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false volume_db float}], Returns: void
+	        Undocumented
+		Args: [{ false volume_db float}], Returns: void
 */
 func (o *AudioStreamPlayer2D) SetVolumeDb(volumeDb gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer2D.SetVolumeDb()")
@@ -499,7 +530,7 @@ func (o *AudioStreamPlayer2D) SetVolumeDb(volumeDb gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(volumeDb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "set_volume_db")
+	methodBind := methodBindAudioStreamPlayer2DSetVolumeDb.Get()
 
 	// Call the parent method.
 	// void
@@ -509,8 +540,8 @@ func (o *AudioStreamPlayer2D) SetVolumeDb(volumeDb gdnative.Real) {
 }
 
 /*
-        Stops the audio.
-	Args: [], Returns: void
+	        Stops the audio.
+		Args: [], Returns: void
 */
 func (o *AudioStreamPlayer2D) Stop() {
 	//log.Println("Calling AudioStreamPlayer2D.Stop()")
@@ -519,7 +550,7 @@ func (o *AudioStreamPlayer2D) Stop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer2D", "stop")
+	methodBind := methodBindAudioStreamPlayer2DStop.Get()
 
 	// Call the parent method.
 	// void
@@ -528,10 +559,81 @@ func (o *AudioStreamPlayer2D) Stop() {
 
 }
 
+// PlayWithDefaults will call Play using the default values for: from_position.
+func (o *AudioStreamPlayer2D) PlayWithDefaults() {
+	o.Play(0)
+}
+
+// AreaMask will return the value of the "area_mask" property.
+func (o *AudioStreamPlayer2D) AreaMask() gdnative.Int {
+	return o.GetAreaMask()
+}
+
+// Attenuation will return the value of the "attenuation" property.
+func (o *AudioStreamPlayer2D) Attenuation() gdnative.Real {
+	return o.GetAttenuation()
+}
+
+// Autoplay will return the value of the "autoplay" property.
+func (o *AudioStreamPlayer2D) Autoplay() gdnative.Bool {
+	return o.IsAutoplayEnabled()
+}
+
+// Bus will return the value of the "bus" property.
+func (o *AudioStreamPlayer2D) Bus() gdnative.String {
+	return o.GetBus()
+}
+
+// MaxDistance will return the value of the "max_distance" property.
+func (o *AudioStreamPlayer2D) MaxDistance() gdnative.Real {
+	return o.GetMaxDistance()
+}
+
+// Playing will return the value of the "playing" property.
+func (o *AudioStreamPlayer2D) Playing() gdnative.Bool {
+	return o.IsPlaying()
+}
+
+// SetPlaying will set the value of the "playing" property.
+func (o *AudioStreamPlayer2D) SetPlaying(value gdnative.Bool) {
+	o.X_SetPlaying(value)
+}
+
+// Stream will return the value of the "stream" property.
+func (o *AudioStreamPlayer2D) Stream() AudioStreamImplementer {
+	return o.GetStream()
+}
+
+// VolumeDb will return the value of the "volume_db" property.
+func (o *AudioStreamPlayer2D) VolumeDb() gdnative.Real {
+	return o.GetVolumeDb()
+}
+
+// Signals of the AudioStreamPlayer2D class.
+const (
+	AudioStreamPlayer2DSignalFinished gdnative.String = "finished"
+)
+
+// ConnectFinished will connect the "finished" signal to the given method of the target object.
+func (o *AudioStreamPlayer2D) ConnectFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AudioStreamPlayer2DSignalFinished, target, method, binds, 0)
+}
+
+// EmitFinished will emit the "finished" signal.
+func (o *AudioStreamPlayer2D) EmitFinished() error {
+
+	ret, err := o.EmitSignal(AudioStreamPlayer2DSignalFinished)
+	ret.Destroy()
+	return err
+}
+
 // AudioStreamPlayer2DImplementer is an interface that implements the methods
 // of the AudioStreamPlayer2D class.
 type AudioStreamPlayer2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	X_BusLayoutChanged()
 	X_IsActive() gdnative.Bool
 	X_SetPlaying(enable gdnative.Bool)
@@ -554,4 +656,16 @@ type AudioStreamPlayer2DImplementer interface {
 	SetStream(stream AudioStreamImplementer)
 	SetVolumeDb(volumeDb gdnative.Real)
 	Stop()
+	PlayWithDefaults()
+	AreaMask() gdnative.Int
+	Attenuation() gdnative.Real
+	Autoplay() gdnative.Bool
+	Bus() gdnative.String
+	MaxDistance() gdnative.Real
+	Playing() gdnative.Bool
+	SetPlaying(value gdnative.Bool)
+	Stream() AudioStreamImplementer
+	VolumeDb() gdnative.Real
+	ConnectFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFinished() error
 }
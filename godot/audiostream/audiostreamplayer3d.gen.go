@@ -1,7 +1,10 @@
-package godot
+package audiostream
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +25,32 @@ const (
 	AudioStreamPlayer3DAttenuationLogarithmic           AudioStreamPlayer3DAttenuationModel = 2
 )
 
+// String will return the Godot name of the AudioStreamPlayer3DAttenuationModel value.
+func (e AudioStreamPlayer3DAttenuationModel) String() string {
+	switch e {
+	case AudioStreamPlayer3DAttenuationInverseDistance:
+		return "ATTENUATION_INVERSE_DISTANCE"
+	case AudioStreamPlayer3DAttenuationInverseSquareDistance:
+		return "ATTENUATION_INVERSE_SQUARE_DISTANCE"
+	case AudioStreamPlayer3DAttenuationLogarithmic:
+		return "ATTENUATION_LOGARITHMIC"
+	}
+	return fmt.Sprintf("AudioStreamPlayer3DAttenuationModel(%d)", int(e))
+}
+
+// ParseAudioStreamPlayer3DAttenuationModel will return the AudioStreamPlayer3DAttenuationModel value with the given Godot name.
+func ParseAudioStreamPlayer3DAttenuationModel(name string) (AudioStreamPlayer3DAttenuationModel, error) {
+	switch name {
+	case "ATTENUATION_INVERSE_DISTANCE":
+		return AudioStreamPlayer3DAttenuationInverseDistance, nil
+	case "ATTENUATION_INVERSE_SQUARE_DISTANCE":
+		return AudioStreamPlayer3DAttenuationInverseSquareDistance, nil
+	case "ATTENUATION_LOGARITHMIC":
+		return AudioStreamPlayer3DAttenuationLogarithmic, nil
+	}
+	return 0, fmt.Errorf("invalid AudioStreamPlayer3DAttenuationModel value %q", name)
+}
+
 // AudioStreamPlayer3DDopplerTracking is an enum for DopplerTracking values.
 type AudioStreamPlayer3DDopplerTracking int
 
@@ -31,6 +60,32 @@ const (
 	AudioStreamPlayer3DDopplerTrackingPhysicsStep AudioStreamPlayer3DDopplerTracking = 2
 )
 
+// String will return the Godot name of the AudioStreamPlayer3DDopplerTracking value.
+func (e AudioStreamPlayer3DDopplerTracking) String() string {
+	switch e {
+	case AudioStreamPlayer3DDopplerTrackingDisabled:
+		return "DOPPLER_TRACKING_DISABLED"
+	case AudioStreamPlayer3DDopplerTrackingIdleStep:
+		return "DOPPLER_TRACKING_IDLE_STEP"
+	case AudioStreamPlayer3DDopplerTrackingPhysicsStep:
+		return "DOPPLER_TRACKING_PHYSICS_STEP"
+	}
+	return fmt.Sprintf("AudioStreamPlayer3DDopplerTracking(%d)", int(e))
+}
+
+// ParseAudioStreamPlayer3DDopplerTracking will return the AudioStreamPlayer3DDopplerTracking value with the given Godot name.
+func ParseAudioStreamPlayer3DDopplerTracking(name string) (AudioStreamPlayer3DDopplerTracking, error) {
+	switch name {
+	case "DOPPLER_TRACKING_DISABLED":
+		return AudioStreamPlayer3DDopplerTrackingDisabled, nil
+	case "DOPPLER_TRACKING_IDLE_STEP":
+		return AudioStreamPlayer3DDopplerTrackingIdleStep, nil
+	case "DOPPLER_TRACKING_PHYSICS_STEP":
+		return AudioStreamPlayer3DDopplerTrackingPhysicsStep, nil
+	}
+	return 0, fmt.Errorf("invalid AudioStreamPlayer3DDopplerTracking value %q", name)
+}
+
 // AudioStreamPlayer3DOutOfRangeMode is an enum for OutOfRangeMode values.
 type AudioStreamPlayer3DOutOfRangeMode int
 
@@ -39,30 +94,102 @@ const (
 	AudioStreamPlayer3DOutOfRangePause AudioStreamPlayer3DOutOfRangeMode = 1
 )
 
-//func NewAudioStreamPlayer3DFromPointer(ptr gdnative.Pointer) AudioStreamPlayer3D {
-func newAudioStreamPlayer3DFromPointer(ptr gdnative.Pointer) AudioStreamPlayer3D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStreamPlayer3D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the AudioStreamPlayer3DOutOfRangeMode value.
+func (e AudioStreamPlayer3DOutOfRangeMode) String() string {
+	switch e {
+	case AudioStreamPlayer3DOutOfRangeMix:
+		return "OUT_OF_RANGE_MIX"
+	case AudioStreamPlayer3DOutOfRangePause:
+		return "OUT_OF_RANGE_PAUSE"
+	}
+	return fmt.Sprintf("AudioStreamPlayer3DOutOfRangeMode(%d)", int(e))
+}
 
-	return obj
+// ParseAudioStreamPlayer3DOutOfRangeMode will return the AudioStreamPlayer3DOutOfRangeMode value with the given Godot name.
+func ParseAudioStreamPlayer3DOutOfRangeMode(name string) (AudioStreamPlayer3DOutOfRangeMode, error) {
+	switch name {
+	case "OUT_OF_RANGE_MIX":
+		return AudioStreamPlayer3DOutOfRangeMix, nil
+	case "OUT_OF_RANGE_PAUSE":
+		return AudioStreamPlayer3DOutOfRangePause, nil
+	}
+	return 0, fmt.Errorf("invalid AudioStreamPlayer3DOutOfRangeMode value %q", name)
 }
 
 /*
 Plays a sound effect with directed sound effects, dampens with distance if needed, generates effect of hearable position in space.
 */
 type AudioStreamPlayer3D struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorAudioStreamPlayer3D is the class constructor of the AudioStreamPlayer3D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioStreamPlayer3D = gdnative.NewLazyClassConstructor("AudioStreamPlayer3D")
+
+// NewAudioStreamPlayer3D will create a new instance of the AudioStreamPlayer3D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAudioStreamPlayer3D() *AudioStreamPlayer3D {
+	obj := &AudioStreamPlayer3D{}
+	obj.SetBaseObject(classConstructorAudioStreamPlayer3D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioStreamPlayer3D) BaseClass() string {
 	return "AudioStreamPlayer3D"
 }
 
+// Method binds of the AudioStreamPlayer3D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioStreamPlayer3DX_BusLayoutChanged                  = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "_bus_layout_changed")
+	methodBindAudioStreamPlayer3DX_IsActive                          = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "_is_active")
+	methodBindAudioStreamPlayer3DX_SetPlaying                        = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "_set_playing")
+	methodBindAudioStreamPlayer3DGetAreaMask                         = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_area_mask")
+	methodBindAudioStreamPlayer3DGetAttenuationFilterCutoffHz        = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_attenuation_filter_cutoff_hz")
+	methodBindAudioStreamPlayer3DGetAttenuationFilterDb              = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_attenuation_filter_db")
+	methodBindAudioStreamPlayer3DGetAttenuationModel                 = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_attenuation_model")
+	methodBindAudioStreamPlayer3DGetBus                              = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_bus")
+	methodBindAudioStreamPlayer3DGetDopplerTracking                  = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_doppler_tracking")
+	methodBindAudioStreamPlayer3DGetEmissionAngle                    = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_emission_angle")
+	methodBindAudioStreamPlayer3DGetEmissionAngleFilterAttenuationDb = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_emission_angle_filter_attenuation_db")
+	methodBindAudioStreamPlayer3DGetMaxDb                            = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_max_db")
+	methodBindAudioStreamPlayer3DGetMaxDistance                      = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_max_distance")
+	methodBindAudioStreamPlayer3DGetOutOfRangeMode                   = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_out_of_range_mode")
+	methodBindAudioStreamPlayer3DGetPlaybackPosition                 = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_playback_position")
+	methodBindAudioStreamPlayer3DGetStream                           = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_stream")
+	methodBindAudioStreamPlayer3DGetUnitDb                           = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_unit_db")
+	methodBindAudioStreamPlayer3DGetUnitSize                         = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "get_unit_size")
+	methodBindAudioStreamPlayer3DIsAutoplayEnabled                   = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "is_autoplay_enabled")
+	methodBindAudioStreamPlayer3DIsEmissionAngleEnabled              = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "is_emission_angle_enabled")
+	methodBindAudioStreamPlayer3DIsPlaying                           = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "is_playing")
+	methodBindAudioStreamPlayer3DPlay                                = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "play")
+	methodBindAudioStreamPlayer3DSeek                                = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "seek")
+	methodBindAudioStreamPlayer3DSetAreaMask                         = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_area_mask")
+	methodBindAudioStreamPlayer3DSetAttenuationFilterCutoffHz        = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_attenuation_filter_cutoff_hz")
+	methodBindAudioStreamPlayer3DSetAttenuationFilterDb              = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_attenuation_filter_db")
+	methodBindAudioStreamPlayer3DSetAttenuationModel                 = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_attenuation_model")
+	methodBindAudioStreamPlayer3DSetAutoplay                         = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_autoplay")
+	methodBindAudioStreamPlayer3DSetBus                              = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_bus")
+	methodBindAudioStreamPlayer3DSetDopplerTracking                  = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_doppler_tracking")
+	methodBindAudioStreamPlayer3DSetEmissionAngle                    = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_emission_angle")
+	methodBindAudioStreamPlayer3DSetEmissionAngleEnabled             = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_emission_angle_enabled")
+	methodBindAudioStreamPlayer3DSetEmissionAngleFilterAttenuationDb = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_emission_angle_filter_attenuation_db")
+	methodBindAudioStreamPlayer3DSetMaxDb                            = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_max_db")
+	methodBindAudioStreamPlayer3DSetMaxDistance                      = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_max_distance")
+	methodBindAudioStreamPlayer3DSetOutOfRangeMode                   = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_out_of_range_mode")
+	methodBindAudioStreamPlayer3DSetStream                           = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_stream")
+	methodBindAudioStreamPlayer3DSetUnitDb                           = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_unit_db")
+	methodBindAudioStreamPlayer3DSetUnitSize                         = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "set_unit_size")
+	methodBindAudioStreamPlayer3DStop                                = gdnative.NewLazyMethodBind("AudioStreamPlayer3D", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *AudioStreamPlayer3D) X_BusLayoutChanged() {
 	//log.Println("Calling AudioStreamPlayer3D.X_BusLayoutChanged()")
@@ -71,7 +198,7 @@ func (o *AudioStreamPlayer3D) X_BusLayoutChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "_bus_layout_changed")
+	methodBind := methodBindAudioStreamPlayer3DX_BusLayoutChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -81,8 +208,8 @@ func (o *AudioStreamPlayer3D) X_BusLayoutChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer3D) X_IsActive() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer3D.X_IsActive()")
@@ -91,7 +218,7 @@ func (o *AudioStreamPlayer3D) X_IsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "_is_active")
+	methodBind := methodBindAudioStreamPlayer3DX_IsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +231,8 @@ func (o *AudioStreamPlayer3D) X_IsActive() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AudioStreamPlayer3D) X_SetPlaying(enable gdnative.Bool) {
 	//log.Println("Calling AudioStreamPlayer3D.X_SetPlaying()")
@@ -115,7 +242,7 @@ func (o *AudioStreamPlayer3D) X_SetPlaying(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "_set_playing")
+	methodBind := methodBindAudioStreamPlayer3DX_SetPlaying.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +252,8 @@ func (o *AudioStreamPlayer3D) X_SetPlaying(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *AudioStreamPlayer3D) GetAreaMask() gdnative.Int {
 	//log.Println("Calling AudioStreamPlayer3D.GetAreaMask()")
@@ -135,7 +262,7 @@ func (o *AudioStreamPlayer3D) GetAreaMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_area_mask")
+	methodBind := methodBindAudioStreamPlayer3DGetAreaMask.Get()
 
 	// Call the parent method.
 	// int
@@ -148,8 +275,8 @@ func (o *AudioStreamPlayer3D) GetAreaMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetAttenuationFilterCutoffHz() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetAttenuationFilterCutoffHz()")
@@ -158,7 +285,7 @@ func (o *AudioStreamPlayer3D) GetAttenuationFilterCutoffHz() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_attenuation_filter_cutoff_hz")
+	methodBind := methodBindAudioStreamPlayer3DGetAttenuationFilterCutoffHz.Get()
 
 	// Call the parent method.
 	// float
@@ -171,8 +298,8 @@ func (o *AudioStreamPlayer3D) GetAttenuationFilterCutoffHz() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetAttenuationFilterDb() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetAttenuationFilterDb()")
@@ -181,7 +308,7 @@ func (o *AudioStreamPlayer3D) GetAttenuationFilterDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_attenuation_filter_db")
+	methodBind := methodBindAudioStreamPlayer3DGetAttenuationFilterDb.Get()
 
 	// Call the parent method.
 	// float
@@ -194,8 +321,8 @@ func (o *AudioStreamPlayer3D) GetAttenuationFilterDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioStreamPlayer3D::AttenuationModel
+	        Undocumented
+		Args: [], Returns: enum.AudioStreamPlayer3D::AttenuationModel
 */
 func (o *AudioStreamPlayer3D) GetAttenuationModel() AudioStreamPlayer3DAttenuationModel {
 	//log.Println("Calling AudioStreamPlayer3D.GetAttenuationModel()")
@@ -204,7 +331,7 @@ func (o *AudioStreamPlayer3D) GetAttenuationModel() AudioStreamPlayer3DAttenuati
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_attenuation_model")
+	methodBind := methodBindAudioStreamPlayer3DGetAttenuationModel.Get()
 
 	// Call the parent method.
 	// enum.AudioStreamPlayer3D::AttenuationModel
@@ -217,8 +344,8 @@ func (o *AudioStreamPlayer3D) GetAttenuationModel() AudioStreamPlayer3DAttenuati
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AudioStreamPlayer3D) GetBus() gdnative.String {
 	//log.Println("Calling AudioStreamPlayer3D.GetBus()")
@@ -227,7 +354,7 @@ func (o *AudioStreamPlayer3D) GetBus() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_bus")
+	methodBind := methodBindAudioStreamPlayer3DGetBus.Get()
 
 	// Call the parent method.
 	// String
@@ -240,8 +367,8 @@ func (o *AudioStreamPlayer3D) GetBus() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioStreamPlayer3D::DopplerTracking
+	        Undocumented
+		Args: [], Returns: enum.AudioStreamPlayer3D::DopplerTracking
 */
 func (o *AudioStreamPlayer3D) GetDopplerTracking() AudioStreamPlayer3DDopplerTracking {
 	//log.Println("Calling AudioStreamPlayer3D.GetDopplerTracking()")
@@ -250,7 +377,7 @@ func (o *AudioStreamPlayer3D) GetDopplerTracking() AudioStreamPlayer3DDopplerTra
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_doppler_tracking")
+	methodBind := methodBindAudioStreamPlayer3DGetDopplerTracking.Get()
 
 	// Call the parent method.
 	// enum.AudioStreamPlayer3D::DopplerTracking
@@ -263,8 +390,8 @@ func (o *AudioStreamPlayer3D) GetDopplerTracking() AudioStreamPlayer3DDopplerTra
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetEmissionAngle() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetEmissionAngle()")
@@ -273,7 +400,7 @@ func (o *AudioStreamPlayer3D) GetEmissionAngle() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_emission_angle")
+	methodBind := methodBindAudioStreamPlayer3DGetEmissionAngle.Get()
 
 	// Call the parent method.
 	// float
@@ -286,8 +413,8 @@ func (o *AudioStreamPlayer3D) GetEmissionAngle() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetEmissionAngleFilterAttenuationDb() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetEmissionAngleFilterAttenuationDb()")
@@ -296,7 +423,7 @@ func (o *AudioStreamPlayer3D) GetEmissionAngleFilterAttenuationDb() gdnative.Rea
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_emission_angle_filter_attenuation_db")
+	methodBind := methodBindAudioStreamPlayer3DGetEmissionAngleFilterAttenuationDb.Get()
 
 	// Call the parent method.
 	// float
@@ -309,8 +436,8 @@ func (o *AudioStreamPlayer3D) GetEmissionAngleFilterAttenuationDb() gdnative.Rea
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetMaxDb() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetMaxDb()")
@@ -319,7 +446,7 @@ func (o *AudioStreamPlayer3D) GetMaxDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_max_db")
+	methodBind := methodBindAudioStreamPlayer3DGetMaxDb.Get()
 
 	// Call the parent method.
 	// float
@@ -332,8 +459,8 @@ func (o *AudioStreamPlayer3D) GetMaxDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetMaxDistance() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetMaxDistance()")
@@ -342,7 +469,7 @@ func (o *AudioStreamPlayer3D) GetMaxDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_max_distance")
+	methodBind := methodBindAudioStreamPlayer3DGetMaxDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -355,8 +482,8 @@ func (o *AudioStreamPlayer3D) GetMaxDistance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioStreamPlayer3D::OutOfRangeMode
+	        Undocumented
+		Args: [], Returns: enum.AudioStreamPlayer3D::OutOfRangeMode
 */
 func (o *AudioStreamPlayer3D) GetOutOfRangeMode() AudioStreamPlayer3DOutOfRangeMode {
 	//log.Println("Calling AudioStreamPlayer3D.GetOutOfRangeMode()")
@@ -365,7 +492,7 @@ func (o *AudioStreamPlayer3D) GetOutOfRangeMode() AudioStreamPlayer3DOutOfRangeM
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_out_of_range_mode")
+	methodBind := methodBindAudioStreamPlayer3DGetOutOfRangeMode.Get()
 
 	// Call the parent method.
 	// enum.AudioStreamPlayer3D::OutOfRangeMode
@@ -378,8 +505,8 @@ func (o *AudioStreamPlayer3D) GetOutOfRangeMode() AudioStreamPlayer3DOutOfRangeM
 }
 
 /*
-        Returns the position in the [AudioStream].
-	Args: [], Returns: float
+	        Returns the position in the [AudioStream].
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetPlaybackPosition() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetPlaybackPosition()")
@@ -388,7 +515,7 @@ func (o *AudioStreamPlayer3D) GetPlaybackPosition() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_playback_position")
+	methodBind := methodBindAudioStreamPlayer3DGetPlaybackPosition.Get()
 
 	// Call the parent method.
 	// float
@@ -401,8 +528,8 @@ func (o *AudioStreamPlayer3D) GetPlaybackPosition() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: AudioStream
+	        Undocumented
+		Args: [], Returns: AudioStream
 */
 func (o *AudioStreamPlayer3D) GetStream() AudioStreamImplementer {
 	//log.Println("Calling AudioStreamPlayer3D.GetStream()")
@@ -411,7 +538,7 @@ func (o *AudioStreamPlayer3D) GetStream() AudioStreamImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_stream")
+	methodBind := methodBindAudioStreamPlayer3DGetStream.Get()
 
 	// Call the parent method.
 	// AudioStream
@@ -419,27 +546,25 @@ func (o *AudioStreamPlayer3D) GetStream() AudioStreamImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAudioStreamFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AudioStreamImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "AudioStream" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AudioStreamImplementer)
+	ret := AudioStream{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(AudioStreamImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetUnitDb() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetUnitDb()")
@@ -448,7 +573,7 @@ func (o *AudioStreamPlayer3D) GetUnitDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_unit_db")
+	methodBind := methodBindAudioStreamPlayer3DGetUnitDb.Get()
 
 	// Call the parent method.
 	// float
@@ -461,8 +586,8 @@ func (o *AudioStreamPlayer3D) GetUnitDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamPlayer3D) GetUnitSize() gdnative.Real {
 	//log.Println("Calling AudioStreamPlayer3D.GetUnitSize()")
@@ -471,7 +596,7 @@ func (o *AudioStreamPlayer3D) GetUnitSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "get_unit_size")
+	methodBind := methodBindAudioStreamPlayer3DGetUnitSize.Get()
 
 	// Call the parent method.
 	// float
@@ -484,8 +609,8 @@ func (o *AudioStreamPlayer3D) GetUnitSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer3D) IsAutoplayEnabled() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer3D.IsAutoplayEnabled()")
@@ -494,7 +619,7 @@ func (o *AudioStreamPlayer3D) IsAutoplayEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "is_autoplay_enabled")
+	methodBind := methodBindAudioStreamPlayer3DIsAutoplayEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -507,8 +632,8 @@ func (o *AudioStreamPlayer3D) IsAutoplayEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer3D) IsEmissionAngleEnabled() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer3D.IsEmissionAngleEnabled()")
@@ -517,7 +642,7 @@ func (o *AudioStreamPlayer3D) IsEmissionAngleEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "is_emission_angle_enabled")
+	methodBind := methodBindAudioStreamPlayer3DIsEmissionAngleEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -530,8 +655,8 @@ func (o *AudioStreamPlayer3D) IsEmissionAngleEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamPlayer3D) IsPlaying() gdnative.Bool {
 	//log.Println("Calling AudioStreamPlayer3D.IsPlaying()")
@@ -540,7 +665,7 @@ func (o *AudioStreamPlayer3D) IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "is_playing")
+	methodBind := methodBindAudioStreamPlayer3DIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -553,8 +678,8 @@ func (o *AudioStreamPlayer3D) IsPlaying() gdnative.Bool {
 }
 
 /*
-        Plays the audio from the given position 'from_position', in seconds.
-	Args: [{0 true from_position float}], Returns: void
+	        Plays the audio from the given position 'from_position', in seconds.
+		Args: [{0 true from_position float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) Play(fromPosition gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.Play()")
@@ -564,7 +689,7 @@ func (o *AudioStreamPlayer3D) Play(fromPosition gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fromPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "play")
+	methodBind := methodBindAudioStreamPlayer3DPlay.Get()
 
 	// Call the parent method.
 	// void
@@ -574,8 +699,8 @@ func (o *AudioStreamPlayer3D) Play(fromPosition gdnative.Real) {
 }
 
 /*
-        Sets the position from which audio will be played, in seconds.
-	Args: [{ false to_position float}], Returns: void
+	        Sets the position from which audio will be played, in seconds.
+		Args: [{ false to_position float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) Seek(toPosition gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.Seek()")
@@ -585,7 +710,7 @@ func (o *AudioStreamPlayer3D) Seek(toPosition gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "seek")
+	methodBind := methodBindAudioStreamPlayer3DSeek.Get()
 
 	// Call the parent method.
 	// void
@@ -595,8 +720,8 @@ func (o *AudioStreamPlayer3D) Seek(toPosition gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetAreaMask(mask gdnative.Int) {
 	//log.Println("Calling AudioStreamPlayer3D.SetAreaMask()")
@@ -606,7 +731,7 @@ func (o *AudioStreamPlayer3D) SetAreaMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_area_mask")
+	methodBind := methodBindAudioStreamPlayer3DSetAreaMask.Get()
 
 	// Call the parent method.
 	// void
@@ -616,8 +741,8 @@ func (o *AudioStreamPlayer3D) SetAreaMask(mask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetAttenuationFilterCutoffHz(degrees gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetAttenuationFilterCutoffHz()")
@@ -627,7 +752,7 @@ func (o *AudioStreamPlayer3D) SetAttenuationFilterCutoffHz(degrees gdnative.Real
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_attenuation_filter_cutoff_hz")
+	methodBind := methodBindAudioStreamPlayer3DSetAttenuationFilterCutoffHz.Get()
 
 	// Call the parent method.
 	// void
@@ -637,8 +762,8 @@ func (o *AudioStreamPlayer3D) SetAttenuationFilterCutoffHz(degrees gdnative.Real
 }
 
 /*
-        Undocumented
-	Args: [{ false db float}], Returns: void
+	        Undocumented
+		Args: [{ false db float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetAttenuationFilterDb(db gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetAttenuationFilterDb()")
@@ -648,7 +773,7 @@ func (o *AudioStreamPlayer3D) SetAttenuationFilterDb(db gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(db)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_attenuation_filter_db")
+	methodBind := methodBindAudioStreamPlayer3DSetAttenuationFilterDb.Get()
 
 	// Call the parent method.
 	// void
@@ -658,18 +783,18 @@ func (o *AudioStreamPlayer3D) SetAttenuationFilterDb(db gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false model int}], Returns: void
+	        Undocumented
+		Args: [{ false model enum.AudioStreamPlayer3D::AttenuationModel}], Returns: void
 */
-func (o *AudioStreamPlayer3D) SetAttenuationModel(model gdnative.Int) {
+func (o *AudioStreamPlayer3D) SetAttenuationModel(model AudioStreamPlayer3DAttenuationModel) {
 	//log.Println("Calling AudioStreamPlayer3D.SetAttenuationModel()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(model)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(model))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_attenuation_model")
+	methodBind := methodBindAudioStreamPlayer3DSetAttenuationModel.Get()
 
 	// Call the parent method.
 	// void
@@ -679,8 +804,8 @@ func (o *AudioStreamPlayer3D) SetAttenuationModel(model gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetAutoplay(enable gdnative.Bool) {
 	//log.Println("Calling AudioStreamPlayer3D.SetAutoplay()")
@@ -690,7 +815,7 @@ func (o *AudioStreamPlayer3D) SetAutoplay(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_autoplay")
+	methodBind := methodBindAudioStreamPlayer3DSetAutoplay.Get()
 
 	// Call the parent method.
 	// void
@@ -700,8 +825,8 @@ func (o *AudioStreamPlayer3D) SetAutoplay(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bus String}], Returns: void
+	        Undocumented
+		Args: [{ false bus String}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetBus(bus gdnative.String) {
 	//log.Println("Calling AudioStreamPlayer3D.SetBus()")
@@ -711,7 +836,7 @@ func (o *AudioStreamPlayer3D) SetBus(bus gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(bus)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_bus")
+	methodBind := methodBindAudioStreamPlayer3DSetBus.Get()
 
 	// Call the parent method.
 	// void
@@ -721,18 +846,18 @@ func (o *AudioStreamPlayer3D) SetBus(bus gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.AudioStreamPlayer3D::DopplerTracking}], Returns: void
 */
-func (o *AudioStreamPlayer3D) SetDopplerTracking(mode gdnative.Int) {
+func (o *AudioStreamPlayer3D) SetDopplerTracking(mode AudioStreamPlayer3DDopplerTracking) {
 	//log.Println("Calling AudioStreamPlayer3D.SetDopplerTracking()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_doppler_tracking")
+	methodBind := methodBindAudioStreamPlayer3DSetDopplerTracking.Get()
 
 	// Call the parent method.
 	// void
@@ -742,8 +867,8 @@ func (o *AudioStreamPlayer3D) SetDopplerTracking(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetEmissionAngle(degrees gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetEmissionAngle()")
@@ -753,7 +878,7 @@ func (o *AudioStreamPlayer3D) SetEmissionAngle(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_emission_angle")
+	methodBind := methodBindAudioStreamPlayer3DSetEmissionAngle.Get()
 
 	// Call the parent method.
 	// void
@@ -763,8 +888,8 @@ func (o *AudioStreamPlayer3D) SetEmissionAngle(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetEmissionAngleEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling AudioStreamPlayer3D.SetEmissionAngleEnabled()")
@@ -774,7 +899,7 @@ func (o *AudioStreamPlayer3D) SetEmissionAngleEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_emission_angle_enabled")
+	methodBind := methodBindAudioStreamPlayer3DSetEmissionAngleEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -784,8 +909,8 @@ func (o *AudioStreamPlayer3D) SetEmissionAngleEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false db float}], Returns: void
+	        Undocumented
+		Args: [{ false db float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetEmissionAngleFilterAttenuationDb(db gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetEmissionAngleFilterAttenuationDb()")
@@ -795,7 +920,7 @@ func (o *AudioStreamPlayer3D) SetEmissionAngleFilterAttenuationDb(db gdnative.Re
 	ptrArguments[0] = gdnative.NewPointerFromReal(db)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_emission_angle_filter_attenuation_db")
+	methodBind := methodBindAudioStreamPlayer3DSetEmissionAngleFilterAttenuationDb.Get()
 
 	// Call the parent method.
 	// void
@@ -805,8 +930,8 @@ func (o *AudioStreamPlayer3D) SetEmissionAngleFilterAttenuationDb(db gdnative.Re
 }
 
 /*
-        Undocumented
-	Args: [{ false max_db float}], Returns: void
+	        Undocumented
+		Args: [{ false max_db float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetMaxDb(maxDb gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetMaxDb()")
@@ -816,7 +941,7 @@ func (o *AudioStreamPlayer3D) SetMaxDb(maxDb gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(maxDb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_max_db")
+	methodBind := methodBindAudioStreamPlayer3DSetMaxDb.Get()
 
 	// Call the parent method.
 	// void
@@ -826,8 +951,8 @@ func (o *AudioStreamPlayer3D) SetMaxDb(maxDb gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false metres float}], Returns: void
+	        Undocumented
+		Args: [{ false metres float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetMaxDistance(metres gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetMaxDistance()")
@@ -837,7 +962,7 @@ func (o *AudioStreamPlayer3D) SetMaxDistance(metres gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(metres)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_max_distance")
+	methodBind := methodBindAudioStreamPlayer3DSetMaxDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -847,18 +972,18 @@ func (o *AudioStreamPlayer3D) SetMaxDistance(metres gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.AudioStreamPlayer3D::OutOfRangeMode}], Returns: void
 */
-func (o *AudioStreamPlayer3D) SetOutOfRangeMode(mode gdnative.Int) {
+func (o *AudioStreamPlayer3D) SetOutOfRangeMode(mode AudioStreamPlayer3DOutOfRangeMode) {
 	//log.Println("Calling AudioStreamPlayer3D.SetOutOfRangeMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_out_of_range_mode")
+	methodBind := methodBindAudioStreamPlayer3DSetOutOfRangeMode.Get()
 
 	// Call the parent method.
 	// void
@@ -868,18 +993,18 @@ func (o *AudioStreamPlayer3D) SetOutOfRangeMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false stream AudioStream}], Returns: void
+	        Undocumented
+		Args: [{ false stream AudioStream}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetStream(stream AudioStreamImplementer) {
 	//log.Println("Calling AudioStreamPlayer3D.SetStream()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(stream.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(stream))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_stream")
+	methodBind := methodBindAudioStreamPlayer3DSetStream.Get()
 
 	// Call the parent method.
 	// void
@@ -889,8 +1014,8 @@ func (o *AudioStreamPlayer3D) SetStream(stream AudioStreamImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false unit_db float}], Returns: void
+	        Undocumented
+		Args: [{ false unit_db float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetUnitDb(unitDb gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetUnitDb()")
@@ -900,7 +1025,7 @@ func (o *AudioStreamPlayer3D) SetUnitDb(unitDb gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(unitDb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_unit_db")
+	methodBind := methodBindAudioStreamPlayer3DSetUnitDb.Get()
 
 	// Call the parent method.
 	// void
@@ -910,8 +1035,8 @@ func (o *AudioStreamPlayer3D) SetUnitDb(unitDb gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false unit_size float}], Returns: void
+	        Undocumented
+		Args: [{ false unit_size float}], Returns: void
 */
 func (o *AudioStreamPlayer3D) SetUnitSize(unitSize gdnative.Real) {
 	//log.Println("Calling AudioStreamPlayer3D.SetUnitSize()")
@@ -921,7 +1046,7 @@ func (o *AudioStreamPlayer3D) SetUnitSize(unitSize gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(unitSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "set_unit_size")
+	methodBind := methodBindAudioStreamPlayer3DSetUnitSize.Get()
 
 	// Call the parent method.
 	// void
@@ -931,8 +1056,8 @@ func (o *AudioStreamPlayer3D) SetUnitSize(unitSize gdnative.Real) {
 }
 
 /*
-        Stops the audio.
-	Args: [], Returns: void
+	        Stops the audio.
+		Args: [], Returns: void
 */
 func (o *AudioStreamPlayer3D) Stop() {
 	//log.Println("Calling AudioStreamPlayer3D.Stop()")
@@ -941,7 +1066,7 @@ func (o *AudioStreamPlayer3D) Stop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamPlayer3D", "stop")
+	methodBind := methodBindAudioStreamPlayer3DStop.Get()
 
 	// Call the parent method.
 	// void
@@ -950,21 +1075,145 @@ func (o *AudioStreamPlayer3D) Stop() {
 
 }
 
+// PlayWithDefaults will call Play using the default values for: from_position.
+func (o *AudioStreamPlayer3D) PlayWithDefaults() {
+	o.Play(0)
+}
+
+// AreaMask will return the value of the "area_mask" property.
+func (o *AudioStreamPlayer3D) AreaMask() gdnative.Int {
+	return o.GetAreaMask()
+}
+
+// AttenuationFilterCutoffHz will return the value of the "attenuation_filter_cutoff_hz" property.
+func (o *AudioStreamPlayer3D) AttenuationFilterCutoffHz() gdnative.Real {
+	return o.GetAttenuationFilterCutoffHz()
+}
+
+// AttenuationFilterDb will return the value of the "attenuation_filter_db" property.
+func (o *AudioStreamPlayer3D) AttenuationFilterDb() gdnative.Real {
+	return o.GetAttenuationFilterDb()
+}
+
+// AttenuationModel will return the value of the "attenuation_model" property.
+func (o *AudioStreamPlayer3D) AttenuationModel() AudioStreamPlayer3DAttenuationModel {
+	return o.GetAttenuationModel()
+}
+
+// Autoplay will return the value of the "autoplay" property.
+func (o *AudioStreamPlayer3D) Autoplay() gdnative.Bool {
+	return o.IsAutoplayEnabled()
+}
+
+// Bus will return the value of the "bus" property.
+func (o *AudioStreamPlayer3D) Bus() gdnative.String {
+	return o.GetBus()
+}
+
+// DopplerTracking will return the value of the "doppler_tracking" property.
+func (o *AudioStreamPlayer3D) DopplerTracking() AudioStreamPlayer3DDopplerTracking {
+	return o.GetDopplerTracking()
+}
+
+// EmissionAngleDegrees will return the value of the "emission_angle_degrees" property.
+func (o *AudioStreamPlayer3D) EmissionAngleDegrees() gdnative.Real {
+	return o.GetEmissionAngle()
+}
+
+// SetEmissionAngleDegrees will set the value of the "emission_angle_degrees" property.
+func (o *AudioStreamPlayer3D) SetEmissionAngleDegrees(value gdnative.Real) {
+	o.SetEmissionAngle(value)
+}
+
+// EmissionAngleEnabled will return the value of the "emission_angle_enabled" property.
+func (o *AudioStreamPlayer3D) EmissionAngleEnabled() gdnative.Bool {
+	return o.IsEmissionAngleEnabled()
+}
+
+// EmissionAngleFilterAttenuationDb will return the value of the "emission_angle_filter_attenuation_db" property.
+func (o *AudioStreamPlayer3D) EmissionAngleFilterAttenuationDb() gdnative.Real {
+	return o.GetEmissionAngleFilterAttenuationDb()
+}
+
+// MaxDb will return the value of the "max_db" property.
+func (o *AudioStreamPlayer3D) MaxDb() gdnative.Real {
+	return o.GetMaxDb()
+}
+
+// MaxDistance will return the value of the "max_distance" property.
+func (o *AudioStreamPlayer3D) MaxDistance() gdnative.Real {
+	return o.GetMaxDistance()
+}
+
+// OutOfRangeMode will return the value of the "out_of_range_mode" property.
+func (o *AudioStreamPlayer3D) OutOfRangeMode() AudioStreamPlayer3DOutOfRangeMode {
+	return o.GetOutOfRangeMode()
+}
+
+// Playing will return the value of the "playing" property.
+func (o *AudioStreamPlayer3D) Playing() gdnative.Bool {
+	return o.IsPlaying()
+}
+
+// SetPlaying will set the value of the "playing" property.
+func (o *AudioStreamPlayer3D) SetPlaying(value gdnative.Bool) {
+	o.X_SetPlaying(value)
+}
+
+// Stream will return the value of the "stream" property.
+func (o *AudioStreamPlayer3D) Stream() AudioStreamImplementer {
+	return o.GetStream()
+}
+
+// UnitDb will return the value of the "unit_db" property.
+func (o *AudioStreamPlayer3D) UnitDb() gdnative.Real {
+	return o.GetUnitDb()
+}
+
+// UnitSize will return the value of the "unit_size" property.
+func (o *AudioStreamPlayer3D) UnitSize() gdnative.Real {
+	return o.GetUnitSize()
+}
+
+// Signals of the AudioStreamPlayer3D class.
+const (
+	AudioStreamPlayer3DSignalFinished gdnative.String = "finished"
+)
+
+// ConnectFinished will connect the "finished" signal to the given method of the target object.
+func (o *AudioStreamPlayer3D) ConnectFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AudioStreamPlayer3DSignalFinished, target, method, binds, 0)
+}
+
+// EmitFinished will emit the "finished" signal.
+func (o *AudioStreamPlayer3D) EmitFinished() error {
+
+	ret, err := o.EmitSignal(AudioStreamPlayer3DSignalFinished)
+	ret.Destroy()
+	return err
+}
+
 // AudioStreamPlayer3DImplementer is an interface that implements the methods
 // of the AudioStreamPlayer3D class.
 type AudioStreamPlayer3DImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	X_BusLayoutChanged()
 	X_IsActive() gdnative.Bool
 	X_SetPlaying(enable gdnative.Bool)
 	GetAreaMask() gdnative.Int
 	GetAttenuationFilterCutoffHz() gdnative.Real
 	GetAttenuationFilterDb() gdnative.Real
+	GetAttenuationModel() AudioStreamPlayer3DAttenuationModel
 	GetBus() gdnative.String
+	GetDopplerTracking() AudioStreamPlayer3DDopplerTracking
 	GetEmissionAngle() gdnative.Real
 	GetEmissionAngleFilterAttenuationDb() gdnative.Real
 	GetMaxDb() gdnative.Real
 	GetMaxDistance() gdnative.Real
+	GetOutOfRangeMode() AudioStreamPlayer3DOutOfRangeMode
 	GetPlaybackPosition() gdnative.Real
 	GetStream() AudioStreamImplementer
 	GetUnitDb() gdnative.Real
@@ -977,18 +1226,40 @@ type AudioStreamPlayer3DImplementer interface {
 	SetAreaMask(mask gdnative.Int)
 	SetAttenuationFilterCutoffHz(degrees gdnative.Real)
 	SetAttenuationFilterDb(db gdnative.Real)
-	SetAttenuationModel(model gdnative.Int)
+	SetAttenuationModel(model AudioStreamPlayer3DAttenuationModel)
 	SetAutoplay(enable gdnative.Bool)
 	SetBus(bus gdnative.String)
-	SetDopplerTracking(mode gdnative.Int)
+	SetDopplerTracking(mode AudioStreamPlayer3DDopplerTracking)
 	SetEmissionAngle(degrees gdnative.Real)
 	SetEmissionAngleEnabled(enabled gdnative.Bool)
 	SetEmissionAngleFilterAttenuationDb(db gdnative.Real)
 	SetMaxDb(maxDb gdnative.Real)
 	SetMaxDistance(metres gdnative.Real)
-	SetOutOfRangeMode(mode gdnative.Int)
+	SetOutOfRangeMode(mode AudioStreamPlayer3DOutOfRangeMode)
 	SetStream(stream AudioStreamImplementer)
 	SetUnitDb(unitDb gdnative.Real)
 	SetUnitSize(unitSize gdnative.Real)
 	Stop()
+	PlayWithDefaults()
+	AreaMask() gdnative.Int
+	AttenuationFilterCutoffHz() gdnative.Real
+	AttenuationFilterDb() gdnative.Real
+	AttenuationModel() AudioStreamPlayer3DAttenuationModel
+	Autoplay() gdnative.Bool
+	Bus() gdnative.String
+	DopplerTracking() AudioStreamPlayer3DDopplerTracking
+	EmissionAngleDegrees() gdnative.Real
+	SetEmissionAngleDegrees(value gdnative.Real)
+	EmissionAngleEnabled() gdnative.Bool
+	EmissionAngleFilterAttenuationDb() gdnative.Real
+	MaxDb() gdnative.Real
+	MaxDistance() gdnative.Real
+	OutOfRangeMode() AudioStreamPlayer3DOutOfRangeMode
+	Playing() gdnative.Bool
+	SetPlaying(value gdnative.Bool)
+	Stream() AudioStreamImplementer
+	UnitDb() gdnative.Real
+	UnitSize() gdnative.Real
+	ConnectFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFinished() error
 }
@@ -1,7 +1,8 @@
-package godot
+package audiostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAudioStreamRandomPitchFromPointer(ptr gdnative.Pointer) AudioStreamRandomPitch {
-func newAudioStreamRandomPitchFromPointer(ptr gdnative.Pointer) AudioStreamRandomPitch {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStreamRandomPitch{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Randomly varies pitch on each start.
 */
@@ -30,13 +22,39 @@ type AudioStreamRandomPitch struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioStreamRandomPitch is the class constructor of the AudioStreamRandomPitch class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioStreamRandomPitch = gdnative.NewLazyClassConstructor("AudioStreamRandomPitch")
+
+// NewAudioStreamRandomPitch will create a new instance of the AudioStreamRandomPitch class.
+// AudioStreamRandomPitch is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioStreamRandomPitch() *AudioStreamRandomPitch {
+	obj := &AudioStreamRandomPitch{}
+	obj.SetBaseObject(classConstructorAudioStreamRandomPitch.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioStreamRandomPitch) BaseClass() string {
 	return "AudioStreamRandomPitch"
 }
 
+// Method binds of the AudioStreamRandomPitch class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioStreamRandomPitchGetAudioStream = gdnative.NewLazyMethodBind("AudioStreamRandomPitch", "get_audio_stream")
+	methodBindAudioStreamRandomPitchGetRandomPitch = gdnative.NewLazyMethodBind("AudioStreamRandomPitch", "get_random_pitch")
+	methodBindAudioStreamRandomPitchSetAudioStream = gdnative.NewLazyMethodBind("AudioStreamRandomPitch", "set_audio_stream")
+	methodBindAudioStreamRandomPitchSetRandomPitch = gdnative.NewLazyMethodBind("AudioStreamRandomPitch", "set_random_pitch")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: AudioStream
+	        Undocumented
+		Args: [], Returns: AudioStream
 */
 func (o *AudioStreamRandomPitch) GetAudioStream() AudioStreamImplementer {
 	//log.Println("Calling AudioStreamRandomPitch.GetAudioStream()")
@@ -45,7 +63,7 @@ func (o *AudioStreamRandomPitch) GetAudioStream() AudioStreamImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamRandomPitch", "get_audio_stream")
+	methodBind := methodBindAudioStreamRandomPitchGetAudioStream.Get()
 
 	// Call the parent method.
 	// AudioStream
@@ -53,27 +71,25 @@ func (o *AudioStreamRandomPitch) GetAudioStream() AudioStreamImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAudioStreamFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AudioStreamImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "AudioStream" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AudioStreamImplementer)
+	ret := AudioStream{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(AudioStreamImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *AudioStreamRandomPitch) GetRandomPitch() gdnative.Real {
 	//log.Println("Calling AudioStreamRandomPitch.GetRandomPitch()")
@@ -82,7 +98,7 @@ func (o *AudioStreamRandomPitch) GetRandomPitch() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamRandomPitch", "get_random_pitch")
+	methodBind := methodBindAudioStreamRandomPitchGetRandomPitch.Get()
 
 	// Call the parent method.
 	// float
@@ -95,18 +111,18 @@ func (o *AudioStreamRandomPitch) GetRandomPitch() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false stream AudioStream}], Returns: void
+	        Undocumented
+		Args: [{ false stream AudioStream}], Returns: void
 */
 func (o *AudioStreamRandomPitch) SetAudioStream(stream AudioStreamImplementer) {
 	//log.Println("Calling AudioStreamRandomPitch.SetAudioStream()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(stream.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(stream))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamRandomPitch", "set_audio_stream")
+	methodBind := methodBindAudioStreamRandomPitchSetAudioStream.Get()
 
 	// Call the parent method.
 	// void
@@ -116,8 +132,8 @@ func (o *AudioStreamRandomPitch) SetAudioStream(stream AudioStreamImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale float}], Returns: void
+	        Undocumented
+		Args: [{ false scale float}], Returns: void
 */
 func (o *AudioStreamRandomPitch) SetRandomPitch(scale gdnative.Real) {
 	//log.Println("Calling AudioStreamRandomPitch.SetRandomPitch()")
@@ -127,7 +143,7 @@ func (o *AudioStreamRandomPitch) SetRandomPitch(scale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamRandomPitch", "set_random_pitch")
+	methodBind := methodBindAudioStreamRandomPitchSetRandomPitch.Get()
 
 	// Call the parent method.
 	// void
@@ -136,6 +152,11 @@ func (o *AudioStreamRandomPitch) SetRandomPitch(scale gdnative.Real) {
 
 }
 
+// RandomPitch will return the value of the "random_pitch" property.
+func (o *AudioStreamRandomPitch) RandomPitch() gdnative.Real {
+	return o.GetRandomPitch()
+}
+
 // AudioStreamRandomPitchImplementer is an interface that implements the methods
 // of the AudioStreamRandomPitch class.
 type AudioStreamRandomPitchImplementer interface {
@@ -144,4 +165,5 @@ type AudioStreamRandomPitchImplementer interface {
 	GetRandomPitch() gdnative.Real
 	SetAudioStream(stream AudioStreamImplementer)
 	SetRandomPitch(scale gdnative.Real)
+	RandomPitch() gdnative.Real
 }
@@ -1,7 +1,10 @@
-package godot
+package audiostream
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +25,32 @@ const (
 	AudioStreamSampleFormatImaAdpcm AudioStreamSampleFormat = 2
 )
 
+// String will return the Godot name of the AudioStreamSampleFormat value.
+func (e AudioStreamSampleFormat) String() string {
+	switch e {
+	case AudioStreamSampleFormat16Bits:
+		return "FORMAT_16_BITS"
+	case AudioStreamSampleFormat8Bits:
+		return "FORMAT_8_BITS"
+	case AudioStreamSampleFormatImaAdpcm:
+		return "FORMAT_IMA_ADPCM"
+	}
+	return fmt.Sprintf("AudioStreamSampleFormat(%d)", int(e))
+}
+
+// ParseAudioStreamSampleFormat will return the AudioStreamSampleFormat value with the given Godot name.
+func ParseAudioStreamSampleFormat(name string) (AudioStreamSampleFormat, error) {
+	switch name {
+	case "FORMAT_16_BITS":
+		return AudioStreamSampleFormat16Bits, nil
+	case "FORMAT_8_BITS":
+		return AudioStreamSampleFormat8Bits, nil
+	case "FORMAT_IMA_ADPCM":
+		return AudioStreamSampleFormatImaAdpcm, nil
+	}
+	return 0, fmt.Errorf("invalid AudioStreamSampleFormat value %q", name)
+}
+
 // AudioStreamSampleLoopMode is an enum for LoopMode values.
 type AudioStreamSampleLoopMode int
 
@@ -31,13 +60,30 @@ const (
 	AudioStreamSampleLoopPingPong AudioStreamSampleLoopMode = 2
 )
 
-//func NewAudioStreamSampleFromPointer(ptr gdnative.Pointer) AudioStreamSample {
-func newAudioStreamSampleFromPointer(ptr gdnative.Pointer) AudioStreamSample {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AudioStreamSample{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the AudioStreamSampleLoopMode value.
+func (e AudioStreamSampleLoopMode) String() string {
+	switch e {
+	case AudioStreamSampleLoopDisabled:
+		return "LOOP_DISABLED"
+	case AudioStreamSampleLoopForward:
+		return "LOOP_FORWARD"
+	case AudioStreamSampleLoopPingPong:
+		return "LOOP_PING_PONG"
+	}
+	return fmt.Sprintf("AudioStreamSampleLoopMode(%d)", int(e))
+}
 
-	return obj
+// ParseAudioStreamSampleLoopMode will return the AudioStreamSampleLoopMode value with the given Godot name.
+func ParseAudioStreamSampleLoopMode(name string) (AudioStreamSampleLoopMode, error) {
+	switch name {
+	case "LOOP_DISABLED":
+		return AudioStreamSampleLoopDisabled, nil
+	case "LOOP_FORWARD":
+		return AudioStreamSampleLoopForward, nil
+	case "LOOP_PING_PONG":
+		return AudioStreamSampleLoopPingPong, nil
+	}
+	return 0, fmt.Errorf("invalid AudioStreamSampleLoopMode value %q", name)
 }
 
 /*
@@ -48,13 +94,49 @@ type AudioStreamSample struct {
 	owner gdnative.Object
 }
 
+// classConstructorAudioStreamSample is the class constructor of the AudioStreamSample class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAudioStreamSample = gdnative.NewLazyClassConstructor("AudioStreamSample")
+
+// NewAudioStreamSample will create a new instance of the AudioStreamSample class.
+// AudioStreamSample is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAudioStreamSample() *AudioStreamSample {
+	obj := &AudioStreamSample{}
+	obj.SetBaseObject(classConstructorAudioStreamSample.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AudioStreamSample) BaseClass() string {
 	return "AudioStreamSample"
 }
 
+// Method binds of the AudioStreamSample class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioStreamSampleX_GetData    = gdnative.NewLazyMethodBind("AudioStreamSample", "_get_data")
+	methodBindAudioStreamSampleX_SetData    = gdnative.NewLazyMethodBind("AudioStreamSample", "_set_data")
+	methodBindAudioStreamSampleGetFormat    = gdnative.NewLazyMethodBind("AudioStreamSample", "get_format")
+	methodBindAudioStreamSampleGetLoopBegin = gdnative.NewLazyMethodBind("AudioStreamSample", "get_loop_begin")
+	methodBindAudioStreamSampleGetLoopEnd   = gdnative.NewLazyMethodBind("AudioStreamSample", "get_loop_end")
+	methodBindAudioStreamSampleGetLoopMode  = gdnative.NewLazyMethodBind("AudioStreamSample", "get_loop_mode")
+	methodBindAudioStreamSampleGetMixRate   = gdnative.NewLazyMethodBind("AudioStreamSample", "get_mix_rate")
+	methodBindAudioStreamSampleIsStereo     = gdnative.NewLazyMethodBind("AudioStreamSample", "is_stereo")
+	methodBindAudioStreamSampleSetFormat    = gdnative.NewLazyMethodBind("AudioStreamSample", "set_format")
+	methodBindAudioStreamSampleSetLoopBegin = gdnative.NewLazyMethodBind("AudioStreamSample", "set_loop_begin")
+	methodBindAudioStreamSampleSetLoopEnd   = gdnative.NewLazyMethodBind("AudioStreamSample", "set_loop_end")
+	methodBindAudioStreamSampleSetLoopMode  = gdnative.NewLazyMethodBind("AudioStreamSample", "set_loop_mode")
+	methodBindAudioStreamSampleSetMixRate   = gdnative.NewLazyMethodBind("AudioStreamSample", "set_mix_rate")
+	methodBindAudioStreamSampleSetStereo    = gdnative.NewLazyMethodBind("AudioStreamSample", "set_stereo")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolByteArray
+	        Undocumented
+		Args: [], Returns: PoolByteArray
 */
 func (o *AudioStreamSample) X_GetData() gdnative.PoolByteArray {
 	//log.Println("Calling AudioStreamSample.X_GetData()")
@@ -63,7 +145,7 @@ func (o *AudioStreamSample) X_GetData() gdnative.PoolByteArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "_get_data")
+	methodBind := methodBindAudioStreamSampleX_GetData.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -76,8 +158,8 @@ func (o *AudioStreamSample) X_GetData() gdnative.PoolByteArray {
 }
 
 /*
-        Undocumented
-	Args: [{ false data PoolByteArray}], Returns: void
+	        Undocumented
+		Args: [{ false data PoolByteArray}], Returns: void
 */
 func (o *AudioStreamSample) X_SetData(data gdnative.PoolByteArray) {
 	//log.Println("Calling AudioStreamSample.X_SetData()")
@@ -87,7 +169,7 @@ func (o *AudioStreamSample) X_SetData(data gdnative.PoolByteArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "_set_data")
+	methodBind := methodBindAudioStreamSampleX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -97,8 +179,8 @@ func (o *AudioStreamSample) X_SetData(data gdnative.PoolByteArray) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioStreamSample::Format
+	        Undocumented
+		Args: [], Returns: enum.AudioStreamSample::Format
 */
 func (o *AudioStreamSample) GetFormat() AudioStreamSampleFormat {
 	//log.Println("Calling AudioStreamSample.GetFormat()")
@@ -107,7 +189,7 @@ func (o *AudioStreamSample) GetFormat() AudioStreamSampleFormat {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "get_format")
+	methodBind := methodBindAudioStreamSampleGetFormat.Get()
 
 	// Call the parent method.
 	// enum.AudioStreamSample::Format
@@ -120,8 +202,8 @@ func (o *AudioStreamSample) GetFormat() AudioStreamSampleFormat {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *AudioStreamSample) GetLoopBegin() gdnative.Int {
 	//log.Println("Calling AudioStreamSample.GetLoopBegin()")
@@ -130,7 +212,7 @@ func (o *AudioStreamSample) GetLoopBegin() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "get_loop_begin")
+	methodBind := methodBindAudioStreamSampleGetLoopBegin.Get()
 
 	// Call the parent method.
 	// int
@@ -143,8 +225,8 @@ func (o *AudioStreamSample) GetLoopBegin() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *AudioStreamSample) GetLoopEnd() gdnative.Int {
 	//log.Println("Calling AudioStreamSample.GetLoopEnd()")
@@ -153,7 +235,7 @@ func (o *AudioStreamSample) GetLoopEnd() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "get_loop_end")
+	methodBind := methodBindAudioStreamSampleGetLoopEnd.Get()
 
 	// Call the parent method.
 	// int
@@ -166,8 +248,8 @@ func (o *AudioStreamSample) GetLoopEnd() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.AudioStreamSample::LoopMode
+	        Undocumented
+		Args: [], Returns: enum.AudioStreamSample::LoopMode
 */
 func (o *AudioStreamSample) GetLoopMode() AudioStreamSampleLoopMode {
 	//log.Println("Calling AudioStreamSample.GetLoopMode()")
@@ -176,7 +258,7 @@ func (o *AudioStreamSample) GetLoopMode() AudioStreamSampleLoopMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "get_loop_mode")
+	methodBind := methodBindAudioStreamSampleGetLoopMode.Get()
 
 	// Call the parent method.
 	// enum.AudioStreamSample::LoopMode
@@ -189,8 +271,8 @@ func (o *AudioStreamSample) GetLoopMode() AudioStreamSampleLoopMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *AudioStreamSample) GetMixRate() gdnative.Int {
 	//log.Println("Calling AudioStreamSample.GetMixRate()")
@@ -199,7 +281,7 @@ func (o *AudioStreamSample) GetMixRate() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "get_mix_rate")
+	methodBind := methodBindAudioStreamSampleGetMixRate.Get()
 
 	// Call the parent method.
 	// int
@@ -212,8 +294,8 @@ func (o *AudioStreamSample) GetMixRate() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AudioStreamSample) IsStereo() gdnative.Bool {
 	//log.Println("Calling AudioStreamSample.IsStereo()")
@@ -222,7 +304,7 @@ func (o *AudioStreamSample) IsStereo() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "is_stereo")
+	methodBind := methodBindAudioStreamSampleIsStereo.Get()
 
 	// Call the parent method.
 	// bool
@@ -235,18 +317,18 @@ func (o *AudioStreamSample) IsStereo() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false format int}], Returns: void
+	        Undocumented
+		Args: [{ false format enum.AudioStreamSample::Format}], Returns: void
 */
-func (o *AudioStreamSample) SetFormat(format gdnative.Int) {
+func (o *AudioStreamSample) SetFormat(format AudioStreamSampleFormat) {
 	//log.Println("Calling AudioStreamSample.SetFormat()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(format)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(format))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "set_format")
+	methodBind := methodBindAudioStreamSampleSetFormat.Get()
 
 	// Call the parent method.
 	// void
@@ -256,8 +338,8 @@ func (o *AudioStreamSample) SetFormat(format gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false loop_begin int}], Returns: void
+	        Undocumented
+		Args: [{ false loop_begin int}], Returns: void
 */
 func (o *AudioStreamSample) SetLoopBegin(loopBegin gdnative.Int) {
 	//log.Println("Calling AudioStreamSample.SetLoopBegin()")
@@ -267,7 +349,7 @@ func (o *AudioStreamSample) SetLoopBegin(loopBegin gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(loopBegin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "set_loop_begin")
+	methodBind := methodBindAudioStreamSampleSetLoopBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -277,8 +359,8 @@ func (o *AudioStreamSample) SetLoopBegin(loopBegin gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false loop_end int}], Returns: void
+	        Undocumented
+		Args: [{ false loop_end int}], Returns: void
 */
 func (o *AudioStreamSample) SetLoopEnd(loopEnd gdnative.Int) {
 	//log.Println("Calling AudioStreamSample.SetLoopEnd()")
@@ -288,7 +370,7 @@ func (o *AudioStreamSample) SetLoopEnd(loopEnd gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(loopEnd)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "set_loop_end")
+	methodBind := methodBindAudioStreamSampleSetLoopEnd.Get()
 
 	// Call the parent method.
 	// void
@@ -298,18 +380,18 @@ func (o *AudioStreamSample) SetLoopEnd(loopEnd gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false loop_mode int}], Returns: void
+	        Undocumented
+		Args: [{ false loop_mode enum.AudioStreamSample::LoopMode}], Returns: void
 */
-func (o *AudioStreamSample) SetLoopMode(loopMode gdnative.Int) {
+func (o *AudioStreamSample) SetLoopMode(loopMode AudioStreamSampleLoopMode) {
 	//log.Println("Calling AudioStreamSample.SetLoopMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(loopMode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(loopMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "set_loop_mode")
+	methodBind := methodBindAudioStreamSampleSetLoopMode.Get()
 
 	// Call the parent method.
 	// void
@@ -319,8 +401,8 @@ func (o *AudioStreamSample) SetLoopMode(loopMode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mix_rate int}], Returns: void
+	        Undocumented
+		Args: [{ false mix_rate int}], Returns: void
 */
 func (o *AudioStreamSample) SetMixRate(mixRate gdnative.Int) {
 	//log.Println("Calling AudioStreamSample.SetMixRate()")
@@ -330,7 +412,7 @@ func (o *AudioStreamSample) SetMixRate(mixRate gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mixRate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "set_mix_rate")
+	methodBind := methodBindAudioStreamSampleSetMixRate.Get()
 
 	// Call the parent method.
 	// void
@@ -340,8 +422,8 @@ func (o *AudioStreamSample) SetMixRate(mixRate gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false stereo bool}], Returns: void
+	        Undocumented
+		Args: [{ false stereo bool}], Returns: void
 */
 func (o *AudioStreamSample) SetStereo(stereo gdnative.Bool) {
 	//log.Println("Calling AudioStreamSample.SetStereo()")
@@ -351,7 +433,7 @@ func (o *AudioStreamSample) SetStereo(stereo gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(stereo)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioStreamSample", "set_stereo")
+	methodBind := methodBindAudioStreamSampleSetStereo.Get()
 
 	// Call the parent method.
 	// void
@@ -360,20 +442,70 @@ func (o *AudioStreamSample) SetStereo(stereo gdnative.Bool) {
 
 }
 
+// Data will return the value of the "data" property.
+func (o *AudioStreamSample) Data() gdnative.PoolByteArray {
+	return o.X_GetData()
+}
+
+// SetData will set the value of the "data" property.
+func (o *AudioStreamSample) SetData(value gdnative.PoolByteArray) {
+	o.X_SetData(value)
+}
+
+// Format will return the value of the "format" property.
+func (o *AudioStreamSample) Format() AudioStreamSampleFormat {
+	return o.GetFormat()
+}
+
+// LoopBegin will return the value of the "loop_begin" property.
+func (o *AudioStreamSample) LoopBegin() gdnative.Int {
+	return o.GetLoopBegin()
+}
+
+// LoopEnd will return the value of the "loop_end" property.
+func (o *AudioStreamSample) LoopEnd() gdnative.Int {
+	return o.GetLoopEnd()
+}
+
+// LoopMode will return the value of the "loop_mode" property.
+func (o *AudioStreamSample) LoopMode() AudioStreamSampleLoopMode {
+	return o.GetLoopMode()
+}
+
+// MixRate will return the value of the "mix_rate" property.
+func (o *AudioStreamSample) MixRate() gdnative.Int {
+	return o.GetMixRate()
+}
+
+// Stereo will return the value of the "stereo" property.
+func (o *AudioStreamSample) Stereo() gdnative.Bool {
+	return o.IsStereo()
+}
+
 // AudioStreamSampleImplementer is an interface that implements the methods
 // of the AudioStreamSample class.
 type AudioStreamSampleImplementer interface {
 	AudioStreamImplementer
 	X_GetData() gdnative.PoolByteArray
 	X_SetData(data gdnative.PoolByteArray)
+	GetFormat() AudioStreamSampleFormat
 	GetLoopBegin() gdnative.Int
 	GetLoopEnd() gdnative.Int
+	GetLoopMode() AudioStreamSampleLoopMode
 	GetMixRate() gdnative.Int
 	IsStereo() gdnative.Bool
-	SetFormat(format gdnative.Int)
+	SetFormat(format AudioStreamSampleFormat)
 	SetLoopBegin(loopBegin gdnative.Int)
 	SetLoopEnd(loopEnd gdnative.Int)
-	SetLoopMode(loopMode gdnative.Int)
+	SetLoopMode(loopMode AudioStreamSampleLoopMode)
 	SetMixRate(mixRate gdnative.Int)
 	SetStereo(stereo gdnative.Bool)
+	Data() gdnative.PoolByteArray
+	SetData(value gdnative.PoolByteArray)
+	Format() AudioStreamSampleFormat
+	LoopBegin() gdnative.Int
+	LoopEnd() gdnative.Int
+	LoopMode() AudioStreamSampleLoopMode
+	MixRate() gdnative.Int
+	Stereo() gdnative.Bool
 }
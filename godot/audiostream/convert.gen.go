@@ -0,0 +1,60 @@
+package audiostream
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("AudioStream", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStream{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioStreamOGGVorbis", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStreamOGGVorbis{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioStreamPlayback", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStreamPlayback{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioStreamPlayer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStreamPlayer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioStreamPlayer2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStreamPlayer2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioStreamPlayer3D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStreamPlayer3D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioStreamRandomPitch", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStreamRandomPitch{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("AudioStreamSample", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AudioStreamSample{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
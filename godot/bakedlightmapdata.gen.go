@@ -13,30 +13,59 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewBakedLightmapDataFromPointer(ptr gdnative.Pointer) BakedLightmapData {
-func newBakedLightmapDataFromPointer(ptr gdnative.Pointer) BakedLightmapData {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BakedLightmapData{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type BakedLightmapData struct {
 	Resource
 	owner gdnative.Object
 }
 
+// classConstructorBakedLightmapData is the class constructor of the BakedLightmapData class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorBakedLightmapData = gdnative.NewLazyClassConstructor("BakedLightmapData")
+
+// NewBakedLightmapData will create a new instance of the BakedLightmapData class.
+// BakedLightmapData is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewBakedLightmapData() *BakedLightmapData {
+	obj := &BakedLightmapData{}
+	obj.SetBaseObject(classConstructorBakedLightmapData.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *BakedLightmapData) BaseClass() string {
 	return "BakedLightmapData"
 }
 
+// Method binds of the BakedLightmapData class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBakedLightmapDataX_GetUserData         = gdnative.NewLazyMethodBind("BakedLightmapData", "_get_user_data")
+	methodBindBakedLightmapDataX_SetUserData         = gdnative.NewLazyMethodBind("BakedLightmapData", "_set_user_data")
+	methodBindBakedLightmapDataAddUser               = gdnative.NewLazyMethodBind("BakedLightmapData", "add_user")
+	methodBindBakedLightmapDataClearUsers            = gdnative.NewLazyMethodBind("BakedLightmapData", "clear_users")
+	methodBindBakedLightmapDataGetBounds             = gdnative.NewLazyMethodBind("BakedLightmapData", "get_bounds")
+	methodBindBakedLightmapDataGetCellSpaceTransform = gdnative.NewLazyMethodBind("BakedLightmapData", "get_cell_space_transform")
+	methodBindBakedLightmapDataGetCellSubdiv         = gdnative.NewLazyMethodBind("BakedLightmapData", "get_cell_subdiv")
+	methodBindBakedLightmapDataGetEnergy             = gdnative.NewLazyMethodBind("BakedLightmapData", "get_energy")
+	methodBindBakedLightmapDataGetOctree             = gdnative.NewLazyMethodBind("BakedLightmapData", "get_octree")
+	methodBindBakedLightmapDataGetUserCount          = gdnative.NewLazyMethodBind("BakedLightmapData", "get_user_count")
+	methodBindBakedLightmapDataGetUserLightmap       = gdnative.NewLazyMethodBind("BakedLightmapData", "get_user_lightmap")
+	methodBindBakedLightmapDataGetUserPath           = gdnative.NewLazyMethodBind("BakedLightmapData", "get_user_path")
+	methodBindBakedLightmapDataSetBounds             = gdnative.NewLazyMethodBind("BakedLightmapData", "set_bounds")
+	methodBindBakedLightmapDataSetCellSpaceTransform = gdnative.NewLazyMethodBind("BakedLightmapData", "set_cell_space_transform")
+	methodBindBakedLightmapDataSetCellSubdiv         = gdnative.NewLazyMethodBind("BakedLightmapData", "set_cell_subdiv")
+	methodBindBakedLightmapDataSetEnergy             = gdnative.NewLazyMethodBind("BakedLightmapData", "set_energy")
+	methodBindBakedLightmapDataSetOctree             = gdnative.NewLazyMethodBind("BakedLightmapData", "set_octree")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *BakedLightmapData) X_GetUserData() gdnative.Array {
 	//log.Println("Calling BakedLightmapData.X_GetUserData()")
@@ -45,7 +74,7 @@ func (o *BakedLightmapData) X_GetUserData() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "_get_user_data")
+	methodBind := methodBindBakedLightmapDataX_GetUserData.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +87,8 @@ func (o *BakedLightmapData) X_GetUserData() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false data Array}], Returns: void
+	        Undocumented
+		Args: [{ false data Array}], Returns: void
 */
 func (o *BakedLightmapData) X_SetUserData(data gdnative.Array) {
 	//log.Println("Calling BakedLightmapData.X_SetUserData()")
@@ -69,7 +98,7 @@ func (o *BakedLightmapData) X_SetUserData(data gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "_set_user_data")
+	methodBind := methodBindBakedLightmapDataX_SetUserData.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +108,7 @@ func (o *BakedLightmapData) X_SetUserData(data gdnative.Array) {
 }
 
 /*
-
-	Args: [{ false path NodePath} { false lightmap Texture} { false instance int}], Returns: void
+Args: [{ false path NodePath} { false lightmap Texture} { false instance int}], Returns: void
 */
 func (o *BakedLightmapData) AddUser(path gdnative.NodePath, lightmap TextureImplementer, instance gdnative.Int) {
 	//log.Println("Calling BakedLightmapData.AddUser()")
@@ -88,11 +116,11 @@ func (o *BakedLightmapData) AddUser(path gdnative.NodePath, lightmap TextureImpl
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
-	ptrArguments[1] = gdnative.NewPointerFromObject(lightmap.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(lightmap))
 	ptrArguments[2] = gdnative.NewPointerFromInt(instance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "add_user")
+	methodBind := methodBindBakedLightmapDataAddUser.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +130,7 @@ func (o *BakedLightmapData) AddUser(path gdnative.NodePath, lightmap TextureImpl
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *BakedLightmapData) ClearUsers() {
 	//log.Println("Calling BakedLightmapData.ClearUsers()")
@@ -112,7 +139,7 @@ func (o *BakedLightmapData) ClearUsers() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "clear_users")
+	methodBind := methodBindBakedLightmapDataClearUsers.Get()
 
 	// Call the parent method.
 	// void
@@ -122,8 +149,8 @@ func (o *BakedLightmapData) ClearUsers() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: AABB
+	        Undocumented
+		Args: [], Returns: AABB
 */
 func (o *BakedLightmapData) GetBounds() gdnative.Aabb {
 	//log.Println("Calling BakedLightmapData.GetBounds()")
@@ -132,7 +159,7 @@ func (o *BakedLightmapData) GetBounds() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_bounds")
+	methodBind := methodBindBakedLightmapDataGetBounds.Get()
 
 	// Call the parent method.
 	// AABB
@@ -145,8 +172,8 @@ func (o *BakedLightmapData) GetBounds() gdnative.Aabb {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform
+	        Undocumented
+		Args: [], Returns: Transform
 */
 func (o *BakedLightmapData) GetCellSpaceTransform() gdnative.Transform {
 	//log.Println("Calling BakedLightmapData.GetCellSpaceTransform()")
@@ -155,7 +182,7 @@ func (o *BakedLightmapData) GetCellSpaceTransform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_cell_space_transform")
+	methodBind := methodBindBakedLightmapDataGetCellSpaceTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -168,8 +195,8 @@ func (o *BakedLightmapData) GetCellSpaceTransform() gdnative.Transform {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *BakedLightmapData) GetCellSubdiv() gdnative.Int {
 	//log.Println("Calling BakedLightmapData.GetCellSubdiv()")
@@ -178,7 +205,7 @@ func (o *BakedLightmapData) GetCellSubdiv() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_cell_subdiv")
+	methodBind := methodBindBakedLightmapDataGetCellSubdiv.Get()
 
 	// Call the parent method.
 	// int
@@ -191,8 +218,8 @@ func (o *BakedLightmapData) GetCellSubdiv() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *BakedLightmapData) GetEnergy() gdnative.Real {
 	//log.Println("Calling BakedLightmapData.GetEnergy()")
@@ -201,7 +228,7 @@ func (o *BakedLightmapData) GetEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_energy")
+	methodBind := methodBindBakedLightmapDataGetEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -214,8 +241,8 @@ func (o *BakedLightmapData) GetEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolByteArray
+	        Undocumented
+		Args: [], Returns: PoolByteArray
 */
 func (o *BakedLightmapData) GetOctree() gdnative.PoolByteArray {
 	//log.Println("Calling BakedLightmapData.GetOctree()")
@@ -224,7 +251,7 @@ func (o *BakedLightmapData) GetOctree() gdnative.PoolByteArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_octree")
+	methodBind := methodBindBakedLightmapDataGetOctree.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -237,8 +264,7 @@ func (o *BakedLightmapData) GetOctree() gdnative.PoolByteArray {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *BakedLightmapData) GetUserCount() gdnative.Int {
 	//log.Println("Calling BakedLightmapData.GetUserCount()")
@@ -247,7 +273,7 @@ func (o *BakedLightmapData) GetUserCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_user_count")
+	methodBind := methodBindBakedLightmapDataGetUserCount.Get()
 
 	// Call the parent method.
 	// int
@@ -260,8 +286,7 @@ func (o *BakedLightmapData) GetUserCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false user_idx int}], Returns: Texture
+Args: [{ false user_idx int}], Returns: Texture
 */
 func (o *BakedLightmapData) GetUserLightmap(userIdx gdnative.Int) TextureImplementer {
 	//log.Println("Calling BakedLightmapData.GetUserLightmap()")
@@ -271,7 +296,7 @@ func (o *BakedLightmapData) GetUserLightmap(userIdx gdnative.Int) TextureImpleme
 	ptrArguments[0] = gdnative.NewPointerFromInt(userIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_user_lightmap")
+	methodBind := methodBindBakedLightmapDataGetUserLightmap.Get()
 
 	// Call the parent method.
 	// Texture
@@ -279,27 +304,24 @@ func (o *BakedLightmapData) GetUserLightmap(userIdx gdnative.Int) TextureImpleme
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false user_idx int}], Returns: NodePath
+Args: [{ false user_idx int}], Returns: NodePath
 */
 func (o *BakedLightmapData) GetUserPath(userIdx gdnative.Int) gdnative.NodePath {
 	//log.Println("Calling BakedLightmapData.GetUserPath()")
@@ -309,7 +331,7 @@ func (o *BakedLightmapData) GetUserPath(userIdx gdnative.Int) gdnative.NodePath
 	ptrArguments[0] = gdnative.NewPointerFromInt(userIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "get_user_path")
+	methodBind := methodBindBakedLightmapDataGetUserPath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -322,8 +344,8 @@ func (o *BakedLightmapData) GetUserPath(userIdx gdnative.Int) gdnative.NodePath
 }
 
 /*
-        Undocumented
-	Args: [{ false bounds AABB}], Returns: void
+	        Undocumented
+		Args: [{ false bounds AABB}], Returns: void
 */
 func (o *BakedLightmapData) SetBounds(bounds gdnative.Aabb) {
 	//log.Println("Calling BakedLightmapData.SetBounds()")
@@ -333,7 +355,7 @@ func (o *BakedLightmapData) SetBounds(bounds gdnative.Aabb) {
 	ptrArguments[0] = gdnative.NewPointerFromAabb(bounds)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "set_bounds")
+	methodBind := methodBindBakedLightmapDataSetBounds.Get()
 
 	// Call the parent method.
 	// void
@@ -343,8 +365,8 @@ func (o *BakedLightmapData) SetBounds(bounds gdnative.Aabb) {
 }
 
 /*
-        Undocumented
-	Args: [{ false xform Transform}], Returns: void
+	        Undocumented
+		Args: [{ false xform Transform}], Returns: void
 */
 func (o *BakedLightmapData) SetCellSpaceTransform(xform gdnative.Transform) {
 	//log.Println("Calling BakedLightmapData.SetCellSpaceTransform()")
@@ -354,7 +376,7 @@ func (o *BakedLightmapData) SetCellSpaceTransform(xform gdnative.Transform) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "set_cell_space_transform")
+	methodBind := methodBindBakedLightmapDataSetCellSpaceTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -364,8 +386,8 @@ func (o *BakedLightmapData) SetCellSpaceTransform(xform gdnative.Transform) {
 }
 
 /*
-        Undocumented
-	Args: [{ false cell_subdiv int}], Returns: void
+	        Undocumented
+		Args: [{ false cell_subdiv int}], Returns: void
 */
 func (o *BakedLightmapData) SetCellSubdiv(cellSubdiv gdnative.Int) {
 	//log.Println("Calling BakedLightmapData.SetCellSubdiv()")
@@ -375,7 +397,7 @@ func (o *BakedLightmapData) SetCellSubdiv(cellSubdiv gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(cellSubdiv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "set_cell_subdiv")
+	methodBind := methodBindBakedLightmapDataSetCellSubdiv.Get()
 
 	// Call the parent method.
 	// void
@@ -385,8 +407,8 @@ func (o *BakedLightmapData) SetCellSubdiv(cellSubdiv gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *BakedLightmapData) SetEnergy(energy gdnative.Real) {
 	//log.Println("Calling BakedLightmapData.SetEnergy()")
@@ -396,7 +418,7 @@ func (o *BakedLightmapData) SetEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "set_energy")
+	methodBind := methodBindBakedLightmapDataSetEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -406,8 +428,8 @@ func (o *BakedLightmapData) SetEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false octree PoolByteArray}], Returns: void
+	        Undocumented
+		Args: [{ false octree PoolByteArray}], Returns: void
 */
 func (o *BakedLightmapData) SetOctree(octree gdnative.PoolByteArray) {
 	//log.Println("Calling BakedLightmapData.SetOctree()")
@@ -417,7 +439,7 @@ func (o *BakedLightmapData) SetOctree(octree gdnative.PoolByteArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(octree)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BakedLightmapData", "set_octree")
+	methodBind := methodBindBakedLightmapDataSetOctree.Get()
 
 	// Call the parent method.
 	// void
@@ -426,6 +448,41 @@ func (o *BakedLightmapData) SetOctree(octree gdnative.PoolByteArray) {
 
 }
 
+// Bounds will return the value of the "bounds" property.
+func (o *BakedLightmapData) Bounds() gdnative.Aabb {
+	return o.GetBounds()
+}
+
+// CellSpaceTransform will return the value of the "cell_space_transform" property.
+func (o *BakedLightmapData) CellSpaceTransform() gdnative.Transform {
+	return o.GetCellSpaceTransform()
+}
+
+// CellSubdiv will return the value of the "cell_subdiv" property.
+func (o *BakedLightmapData) CellSubdiv() gdnative.Int {
+	return o.GetCellSubdiv()
+}
+
+// Energy will return the value of the "energy" property.
+func (o *BakedLightmapData) Energy() gdnative.Real {
+	return o.GetEnergy()
+}
+
+// Octree will return the value of the "octree" property.
+func (o *BakedLightmapData) Octree() gdnative.PoolByteArray {
+	return o.GetOctree()
+}
+
+// UserData will return the value of the "user_data" property.
+func (o *BakedLightmapData) UserData() gdnative.Array {
+	return o.X_GetUserData()
+}
+
+// SetUserData will set the value of the "user_data" property.
+func (o *BakedLightmapData) SetUserData(value gdnative.Array) {
+	o.X_SetUserData(value)
+}
+
 // BakedLightmapDataImplementer is an interface that implements the methods
 // of the BakedLightmapData class.
 type BakedLightmapDataImplementer interface {
@@ -447,4 +504,11 @@ type BakedLightmapDataImplementer interface {
 	SetCellSubdiv(cellSubdiv gdnative.Int)
 	SetEnergy(energy gdnative.Real)
 	SetOctree(octree gdnative.PoolByteArray)
+	Bounds() gdnative.Aabb
+	CellSpaceTransform() gdnative.Transform
+	CellSubdiv() gdnative.Int
+	Energy() gdnative.Real
+	Octree() gdnative.PoolByteArray
+	UserData() gdnative.Array
+	SetUserData(value gdnative.Array)
 }
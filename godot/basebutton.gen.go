@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -21,6 +23,28 @@ const (
 	BaseButtonActionModeButtonRelease BaseButtonActionMode = 1
 )
 
+// String will return the Godot name of the BaseButtonActionMode value.
+func (e BaseButtonActionMode) String() string {
+	switch e {
+	case BaseButtonActionModeButtonPress:
+		return "ACTION_MODE_BUTTON_PRESS"
+	case BaseButtonActionModeButtonRelease:
+		return "ACTION_MODE_BUTTON_RELEASE"
+	}
+	return fmt.Sprintf("BaseButtonActionMode(%d)", int(e))
+}
+
+// ParseBaseButtonActionMode will return the BaseButtonActionMode value with the given Godot name.
+func ParseBaseButtonActionMode(name string) (BaseButtonActionMode, error) {
+	switch name {
+	case "ACTION_MODE_BUTTON_PRESS":
+		return BaseButtonActionModeButtonPress, nil
+	case "ACTION_MODE_BUTTON_RELEASE":
+		return BaseButtonActionModeButtonRelease, nil
+	}
+	return 0, fmt.Errorf("invalid BaseButtonActionMode value %q", name)
+}
+
 // BaseButtonDrawMode is an enum for DrawMode values.
 type BaseButtonDrawMode int
 
@@ -31,13 +55,34 @@ const (
 	BaseButtonDrawPressed  BaseButtonDrawMode = 1
 )
 
-//func NewBaseButtonFromPointer(ptr gdnative.Pointer) BaseButton {
-func newBaseButtonFromPointer(ptr gdnative.Pointer) BaseButton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BaseButton{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the BaseButtonDrawMode value.
+func (e BaseButtonDrawMode) String() string {
+	switch e {
+	case BaseButtonDrawDisabled:
+		return "DRAW_DISABLED"
+	case BaseButtonDrawHover:
+		return "DRAW_HOVER"
+	case BaseButtonDrawNormal:
+		return "DRAW_NORMAL"
+	case BaseButtonDrawPressed:
+		return "DRAW_PRESSED"
+	}
+	return fmt.Sprintf("BaseButtonDrawMode(%d)", int(e))
+}
 
-	return obj
+// ParseBaseButtonDrawMode will return the BaseButtonDrawMode value with the given Godot name.
+func ParseBaseButtonDrawMode(name string) (BaseButtonDrawMode, error) {
+	switch name {
+	case "DRAW_DISABLED":
+		return BaseButtonDrawDisabled, nil
+	case "DRAW_HOVER":
+		return BaseButtonDrawHover, nil
+	case "DRAW_NORMAL":
+		return BaseButtonDrawNormal, nil
+	case "DRAW_PRESSED":
+		return BaseButtonDrawPressed, nil
+	}
+	return 0, fmt.Errorf("invalid BaseButtonDrawMode value %q", name)
 }
 
 /*
@@ -52,19 +97,44 @@ func (o *BaseButton) BaseClass() string {
 	return "BaseButton"
 }
 
+// Method binds of the BaseButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBaseButtonX_GuiInput          = gdnative.NewLazyMethodBind("BaseButton", "_gui_input")
+	methodBindBaseButtonX_Pressed           = gdnative.NewLazyMethodBind("BaseButton", "_pressed")
+	methodBindBaseButtonX_Toggled           = gdnative.NewLazyMethodBind("BaseButton", "_toggled")
+	methodBindBaseButtonX_UnhandledInput    = gdnative.NewLazyMethodBind("BaseButton", "_unhandled_input")
+	methodBindBaseButtonGetActionMode       = gdnative.NewLazyMethodBind("BaseButton", "get_action_mode")
+	methodBindBaseButtonGetButtonGroup      = gdnative.NewLazyMethodBind("BaseButton", "get_button_group")
+	methodBindBaseButtonGetDrawMode         = gdnative.NewLazyMethodBind("BaseButton", "get_draw_mode")
+	methodBindBaseButtonGetEnabledFocusMode = gdnative.NewLazyMethodBind("BaseButton", "get_enabled_focus_mode")
+	methodBindBaseButtonGetShortcut         = gdnative.NewLazyMethodBind("BaseButton", "get_shortcut")
+	methodBindBaseButtonIsDisabled          = gdnative.NewLazyMethodBind("BaseButton", "is_disabled")
+	methodBindBaseButtonIsHovered           = gdnative.NewLazyMethodBind("BaseButton", "is_hovered")
+	methodBindBaseButtonIsPressed           = gdnative.NewLazyMethodBind("BaseButton", "is_pressed")
+	methodBindBaseButtonIsToggleMode        = gdnative.NewLazyMethodBind("BaseButton", "is_toggle_mode")
+	methodBindBaseButtonSetActionMode       = gdnative.NewLazyMethodBind("BaseButton", "set_action_mode")
+	methodBindBaseButtonSetButtonGroup      = gdnative.NewLazyMethodBind("BaseButton", "set_button_group")
+	methodBindBaseButtonSetDisabled         = gdnative.NewLazyMethodBind("BaseButton", "set_disabled")
+	methodBindBaseButtonSetEnabledFocusMode = gdnative.NewLazyMethodBind("BaseButton", "set_enabled_focus_mode")
+	methodBindBaseButtonSetPressed          = gdnative.NewLazyMethodBind("BaseButton", "set_pressed")
+	methodBindBaseButtonSetShortcut         = gdnative.NewLazyMethodBind("BaseButton", "set_shortcut")
+	methodBindBaseButtonSetToggleMode       = gdnative.NewLazyMethodBind("BaseButton", "set_toggle_mode")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
 func (o *BaseButton) X_GuiInput(arg0 InputEventImplementer) {
 	//log.Println("Calling BaseButton.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "_gui_input")
+	methodBind := methodBindBaseButtonX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -74,8 +144,8 @@ func (o *BaseButton) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Called when button is pressed.
-	Args: [], Returns: void
+	        Called when button is pressed.
+		Args: [], Returns: void
 */
 func (o *BaseButton) X_Pressed() {
 	//log.Println("Calling BaseButton.X_Pressed()")
@@ -84,7 +154,7 @@ func (o *BaseButton) X_Pressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "_pressed")
+	methodBind := methodBindBaseButtonX_Pressed.Get()
 
 	// Call the parent method.
 	// void
@@ -94,8 +164,8 @@ func (o *BaseButton) X_Pressed() {
 }
 
 /*
-        Called when button is toggled (only if toggle_mode is active).
-	Args: [{ false button_pressed bool}], Returns: void
+	        Called when button is toggled (only if toggle_mode is active).
+		Args: [{ false button_pressed bool}], Returns: void
 */
 func (o *BaseButton) X_Toggled(buttonPressed gdnative.Bool) {
 	//log.Println("Calling BaseButton.X_Toggled()")
@@ -105,7 +175,7 @@ func (o *BaseButton) X_Toggled(buttonPressed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(buttonPressed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "_toggled")
+	methodBind := methodBindBaseButtonX_Toggled.Get()
 
 	// Call the parent method.
 	// void
@@ -115,18 +185,18 @@ func (o *BaseButton) X_Toggled(buttonPressed gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
 func (o *BaseButton) X_UnhandledInput(arg0 InputEventImplementer) {
 	//log.Println("Calling BaseButton.X_UnhandledInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "_unhandled_input")
+	methodBind := methodBindBaseButtonX_UnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -136,8 +206,8 @@ func (o *BaseButton) X_UnhandledInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.BaseButton::ActionMode
+	        Undocumented
+		Args: [], Returns: enum.BaseButton::ActionMode
 */
 func (o *BaseButton) GetActionMode() BaseButtonActionMode {
 	//log.Println("Calling BaseButton.GetActionMode()")
@@ -146,7 +216,7 @@ func (o *BaseButton) GetActionMode() BaseButtonActionMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "get_action_mode")
+	methodBind := methodBindBaseButtonGetActionMode.Get()
 
 	// Call the parent method.
 	// enum.BaseButton::ActionMode
@@ -159,8 +229,8 @@ func (o *BaseButton) GetActionMode() BaseButtonActionMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: ButtonGroup
+	        Undocumented
+		Args: [], Returns: ButtonGroup
 */
 func (o *BaseButton) GetButtonGroup() ButtonGroupImplementer {
 	//log.Println("Calling BaseButton.GetButtonGroup()")
@@ -169,7 +239,7 @@ func (o *BaseButton) GetButtonGroup() ButtonGroupImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "get_button_group")
+	methodBind := methodBindBaseButtonGetButtonGroup.Get()
 
 	// Call the parent method.
 	// ButtonGroup
@@ -177,27 +247,25 @@ func (o *BaseButton) GetButtonGroup() ButtonGroupImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newButtonGroupFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ButtonGroupImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ButtonGroup" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ButtonGroupImplementer)
+	ret := ButtonGroup{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ButtonGroupImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the visual state used to draw the button. This is useful mainly when implementing your own draw code by either overriding _draw() or connecting to "draw" signal. The visual state of the button is defined by the DRAW_* enum.
-	Args: [], Returns: enum.BaseButton::DrawMode
+	        Return the visual state used to draw the button. This is useful mainly when implementing your own draw code by either overriding _draw() or connecting to "draw" signal. The visual state of the button is defined by the DRAW_* enum.
+		Args: [], Returns: enum.BaseButton::DrawMode
 */
 func (o *BaseButton) GetDrawMode() BaseButtonDrawMode {
 	//log.Println("Calling BaseButton.GetDrawMode()")
@@ -206,7 +274,7 @@ func (o *BaseButton) GetDrawMode() BaseButtonDrawMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "get_draw_mode")
+	methodBind := methodBindBaseButtonGetDrawMode.Get()
 
 	// Call the parent method.
 	// enum.BaseButton::DrawMode
@@ -219,8 +287,8 @@ func (o *BaseButton) GetDrawMode() BaseButtonDrawMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Control::FocusMode
+	        Undocumented
+		Args: [], Returns: enum.Control::FocusMode
 */
 func (o *BaseButton) GetEnabledFocusMode() ControlFocusMode {
 	//log.Println("Calling BaseButton.GetEnabledFocusMode()")
@@ -229,7 +297,7 @@ func (o *BaseButton) GetEnabledFocusMode() ControlFocusMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "get_enabled_focus_mode")
+	methodBind := methodBindBaseButtonGetEnabledFocusMode.Get()
 
 	// Call the parent method.
 	// enum.Control::FocusMode
@@ -242,8 +310,8 @@ func (o *BaseButton) GetEnabledFocusMode() ControlFocusMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: ShortCut
+	        Undocumented
+		Args: [], Returns: ShortCut
 */
 func (o *BaseButton) GetShortcut() ShortCutImplementer {
 	//log.Println("Calling BaseButton.GetShortcut()")
@@ -252,7 +320,7 @@ func (o *BaseButton) GetShortcut() ShortCutImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "get_shortcut")
+	methodBind := methodBindBaseButtonGetShortcut.Get()
 
 	// Call the parent method.
 	// ShortCut
@@ -260,27 +328,25 @@ func (o *BaseButton) GetShortcut() ShortCutImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShortCutFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShortCutImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ShortCut" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShortCutImplementer)
+	ret := ShortCut{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ShortCutImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *BaseButton) IsDisabled() gdnative.Bool {
 	//log.Println("Calling BaseButton.IsDisabled()")
@@ -289,7 +355,7 @@ func (o *BaseButton) IsDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "is_disabled")
+	methodBind := methodBindBaseButtonIsDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -302,8 +368,8 @@ func (o *BaseButton) IsDisabled() gdnative.Bool {
 }
 
 /*
-        Return true if mouse entered the button before it exit.
-	Args: [], Returns: bool
+	        Return true if mouse entered the button before it exit.
+		Args: [], Returns: bool
 */
 func (o *BaseButton) IsHovered() gdnative.Bool {
 	//log.Println("Calling BaseButton.IsHovered()")
@@ -312,7 +378,7 @@ func (o *BaseButton) IsHovered() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "is_hovered")
+	methodBind := methodBindBaseButtonIsHovered.Get()
 
 	// Call the parent method.
 	// bool
@@ -325,8 +391,8 @@ func (o *BaseButton) IsHovered() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *BaseButton) IsPressed() gdnative.Bool {
 	//log.Println("Calling BaseButton.IsPressed()")
@@ -335,7 +401,7 @@ func (o *BaseButton) IsPressed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "is_pressed")
+	methodBind := methodBindBaseButtonIsPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -348,8 +414,8 @@ func (o *BaseButton) IsPressed() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *BaseButton) IsToggleMode() gdnative.Bool {
 	//log.Println("Calling BaseButton.IsToggleMode()")
@@ -358,7 +424,7 @@ func (o *BaseButton) IsToggleMode() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "is_toggle_mode")
+	methodBind := methodBindBaseButtonIsToggleMode.Get()
 
 	// Call the parent method.
 	// bool
@@ -371,18 +437,18 @@ func (o *BaseButton) IsToggleMode() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.BaseButton::ActionMode}], Returns: void
 */
-func (o *BaseButton) SetActionMode(mode gdnative.Int) {
+func (o *BaseButton) SetActionMode(mode BaseButtonActionMode) {
 	//log.Println("Calling BaseButton.SetActionMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "set_action_mode")
+	methodBind := methodBindBaseButtonSetActionMode.Get()
 
 	// Call the parent method.
 	// void
@@ -392,18 +458,18 @@ func (o *BaseButton) SetActionMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false button_group ButtonGroup}], Returns: void
+	        Undocumented
+		Args: [{ false button_group ButtonGroup}], Returns: void
 */
 func (o *BaseButton) SetButtonGroup(buttonGroup ButtonGroupImplementer) {
 	//log.Println("Calling BaseButton.SetButtonGroup()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(buttonGroup.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(buttonGroup))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "set_button_group")
+	methodBind := methodBindBaseButtonSetButtonGroup.Get()
 
 	// Call the parent method.
 	// void
@@ -413,8 +479,8 @@ func (o *BaseButton) SetButtonGroup(buttonGroup ButtonGroupImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false disabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false disabled bool}], Returns: void
 */
 func (o *BaseButton) SetDisabled(disabled gdnative.Bool) {
 	//log.Println("Calling BaseButton.SetDisabled()")
@@ -424,7 +490,7 @@ func (o *BaseButton) SetDisabled(disabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "set_disabled")
+	methodBind := methodBindBaseButtonSetDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -434,18 +500,18 @@ func (o *BaseButton) SetDisabled(disabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Control::FocusMode}], Returns: void
 */
-func (o *BaseButton) SetEnabledFocusMode(mode gdnative.Int) {
+func (o *BaseButton) SetEnabledFocusMode(mode ControlFocusMode) {
 	//log.Println("Calling BaseButton.SetEnabledFocusMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "set_enabled_focus_mode")
+	methodBind := methodBindBaseButtonSetEnabledFocusMode.Get()
 
 	// Call the parent method.
 	// void
@@ -455,8 +521,8 @@ func (o *BaseButton) SetEnabledFocusMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pressed bool}], Returns: void
+	        Undocumented
+		Args: [{ false pressed bool}], Returns: void
 */
 func (o *BaseButton) SetPressed(pressed gdnative.Bool) {
 	//log.Println("Calling BaseButton.SetPressed()")
@@ -466,7 +532,7 @@ func (o *BaseButton) SetPressed(pressed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(pressed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "set_pressed")
+	methodBind := methodBindBaseButtonSetPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -476,18 +542,18 @@ func (o *BaseButton) SetPressed(pressed gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false shortcut ShortCut}], Returns: void
+	        Undocumented
+		Args: [{ false shortcut ShortCut}], Returns: void
 */
 func (o *BaseButton) SetShortcut(shortcut ShortCutImplementer) {
 	//log.Println("Calling BaseButton.SetShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shortcut.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shortcut))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "set_shortcut")
+	methodBind := methodBindBaseButtonSetShortcut.Get()
 
 	// Call the parent method.
 	// void
@@ -497,8 +563,8 @@ func (o *BaseButton) SetShortcut(shortcut ShortCutImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *BaseButton) SetToggleMode(enabled gdnative.Bool) {
 	//log.Println("Calling BaseButton.SetToggleMode()")
@@ -508,7 +574,7 @@ func (o *BaseButton) SetToggleMode(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BaseButton", "set_toggle_mode")
+	methodBind := methodBindBaseButtonSetToggleMode.Get()
 
 	// Call the parent method.
 	// void
@@ -517,23 +583,161 @@ func (o *BaseButton) SetToggleMode(enabled gdnative.Bool) {
 
 }
 
+// ActionMode will return the value of the "action_mode" property.
+func (o *BaseButton) ActionMode() BaseButtonActionMode {
+	return o.GetActionMode()
+}
+
+// Disabled will return the value of the "disabled" property.
+func (o *BaseButton) Disabled() gdnative.Bool {
+	return o.IsDisabled()
+}
+
+// EnabledFocusMode will return the value of the "enabled_focus_mode" property.
+func (o *BaseButton) EnabledFocusMode() ControlFocusMode {
+	return o.GetEnabledFocusMode()
+}
+
+// Group will return the value of the "group" property.
+func (o *BaseButton) Group() ButtonGroupImplementer {
+	return o.GetButtonGroup()
+}
+
+// SetGroup will set the value of the "group" property.
+func (o *BaseButton) SetGroup(value ButtonGroupImplementer) {
+	o.SetButtonGroup(value)
+}
+
+// Pressed will return the value of the "pressed" property.
+func (o *BaseButton) Pressed() gdnative.Bool {
+	return o.IsPressed()
+}
+
+// Shortcut will return the value of the "shortcut" property.
+func (o *BaseButton) Shortcut() ShortCutImplementer {
+	return o.GetShortcut()
+}
+
+// ToggleMode will return the value of the "toggle_mode" property.
+func (o *BaseButton) ToggleMode() gdnative.Bool {
+	return o.IsToggleMode()
+}
+
+// Signals of the BaseButton class.
+const (
+	BaseButtonSignalButtonDown gdnative.String = "button_down"
+	BaseButtonSignalButtonUp   gdnative.String = "button_up"
+	BaseButtonSignalPressed    gdnative.String = "pressed"
+	BaseButtonSignalToggled    gdnative.String = "toggled"
+)
+
+// ConnectButtonDown will connect the "button_down" signal to the given method of the target object.
+func (o *BaseButton) ConnectButtonDown(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(BaseButtonSignalButtonDown, target, method, binds, 0)
+}
+
+// EmitButtonDown will emit the "button_down" signal.
+func (o *BaseButton) EmitButtonDown() error {
+
+	ret, err := o.EmitSignal(BaseButtonSignalButtonDown)
+	ret.Destroy()
+	return err
+}
+
+// ConnectButtonUp will connect the "button_up" signal to the given method of the target object.
+func (o *BaseButton) ConnectButtonUp(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(BaseButtonSignalButtonUp, target, method, binds, 0)
+}
+
+// EmitButtonUp will emit the "button_up" signal.
+func (o *BaseButton) EmitButtonUp() error {
+
+	ret, err := o.EmitSignal(BaseButtonSignalButtonUp)
+	ret.Destroy()
+	return err
+}
+
+// ConnectPressed will connect the "pressed" signal to the given method of the target object.
+func (o *BaseButton) ConnectPressed(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(BaseButtonSignalPressed, target, method, binds, 0)
+}
+
+// EmitPressed will emit the "pressed" signal.
+func (o *BaseButton) EmitPressed() error {
+
+	ret, err := o.EmitSignal(BaseButtonSignalPressed)
+	ret.Destroy()
+	return err
+}
+
+// BaseButtonToggledArgs holds the arguments of the "toggled" signal.
+type BaseButtonToggledArgs struct {
+	ButtonPressed gdnative.Bool
+}
+
+// ConnectToggled will connect the "toggled" signal to the given method of the target object.
+func (o *BaseButton) ConnectToggled(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(BaseButtonSignalToggled, target, method, binds, 0)
+}
+
+// EmitToggled will emit the "toggled" signal.
+func (o *BaseButton) EmitToggled(args BaseButtonToggledArgs) error {
+	arg0 := gdnative.NewVariantBool(args.ButtonPressed)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(BaseButtonSignalToggled, arg0)
+	ret.Destroy()
+	return err
+}
+
 // BaseButtonImplementer is an interface that implements the methods
 // of the BaseButton class.
 type BaseButtonImplementer interface {
 	ControlImplementer
 	X_Pressed()
 	X_Toggled(buttonPressed gdnative.Bool)
+	GetActionMode() BaseButtonActionMode
 	GetButtonGroup() ButtonGroupImplementer
+	GetDrawMode() BaseButtonDrawMode
+	GetEnabledFocusMode() ControlFocusMode
 	GetShortcut() ShortCutImplementer
 	IsDisabled() gdnative.Bool
 	IsHovered() gdnative.Bool
 	IsPressed() gdnative.Bool
 	IsToggleMode() gdnative.Bool
-	SetActionMode(mode gdnative.Int)
+	SetActionMode(mode BaseButtonActionMode)
 	SetButtonGroup(buttonGroup ButtonGroupImplementer)
 	SetDisabled(disabled gdnative.Bool)
-	SetEnabledFocusMode(mode gdnative.Int)
+	SetEnabledFocusMode(mode ControlFocusMode)
 	SetPressed(pressed gdnative.Bool)
 	SetShortcut(shortcut ShortCutImplementer)
 	SetToggleMode(enabled gdnative.Bool)
+	ActionMode() BaseButtonActionMode
+	Disabled() gdnative.Bool
+	EnabledFocusMode() ControlFocusMode
+	Group() ButtonGroupImplementer
+	SetGroup(value ButtonGroupImplementer)
+	Pressed() gdnative.Bool
+	Shortcut() ShortCutImplementer
+	ToggleMode() gdnative.Bool
+	ConnectButtonDown(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitButtonDown() error
+	ConnectButtonUp(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitButtonUp() error
+	ConnectPressed(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPressed() error
+	ConnectToggled(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitToggled(args BaseButtonToggledArgs) error
 }
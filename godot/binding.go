@@ -0,0 +1,81 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"log"
+	"sync"
+)
+
+// bindingIndex is the index of our instance binding data functions. It will be
+// -1 if the engine does not support instance binding data, in which case a new
+// Go wrapper will be created every time an object is returned from Godot.
+var bindingIndex = -1
+
+// bindingRegistry holds the Go wrapper of every engine object that has instance
+// binding data, keyed by the binding data string of the object. Wrappers are
+// removed when Godot frees the object.
+var bindingRegistry = struct {
+	sync.Mutex
+	wrappers map[string]ObjectImplementer
+}{wrappers: map[string]ObjectImplementer{}}
+
+// registerInstanceBindings will register our instance binding data functions
+// with Godot, so a single Go wrapper is kept for every engine object.
+func registerInstanceBindings() {
+	bindingIndex = gdnative.NativeScript.RegisterInstanceBindingDataFunctions(&gdnative.InstanceBindingFunctions{
+		AllocFunc: allocInstanceBinding,
+		FreeFunc:  freeInstanceBinding,
+		Data:      "godot-go",
+		FreeData:  func(data string) {},
+	})
+	if debug {
+		log.Println("Registered instance binding data functions with index:", bindingIndex)
+	}
+}
+
+// allocInstanceBinding will be called by Godot the first time the binding data
+// of an object is needed. The wrapper itself is created by GetActualClass, since
+// only it knows the class name of the object.
+func allocInstanceBinding(data string, obj gdnative.Object) string {
+	return obj.ID()
+}
+
+// freeInstanceBinding will be called by Godot when an object with binding data
+// is freed, so its Go wrapper and connected funcs can be garbage collected.
+func freeInstanceBinding(data, binding string) {
+	bindingRegistry.Lock()
+	delete(bindingRegistry.wrappers, binding)
+	bindingRegistry.Unlock()
+
+	// Free the Go funcs that were connected to signals of the object.
+	freeConnections(binding)
+}
+
+// boundWrapper will return the Go wrapper of the given object, creating it with
+// the given constructor if the object does not have one yet. If instance binding
+// data is not supported, a new wrapper is created every time.
+func boundWrapper(obj gdnative.Object, constructor func() ObjectImplementer) ObjectImplementer {
+	binding := gdnative.NativeScript.GetInstanceBindingData(bindingIndex, obj)
+	if binding == "" {
+		return constructor()
+	}
+
+	bindingRegistry.Lock()
+	defer bindingRegistry.Unlock()
+	if wrapper, ok := bindingRegistry.wrappers[binding]; ok {
+		return wrapper
+	}
+	wrapper := constructor()
+	bindingRegistry.wrappers[binding] = wrapper
+
+	return wrapper
+}
+
+// BindWrapper will register the given wrapper as the Go wrapper of its engine
+// object, so GetActualClass returns it instead of creating a new one. It is
+// called by the generated New functions for every object created from Go.
+func BindWrapper(wrapper ObjectImplementer) {
+	boundWrapper(wrapper.GetBaseObject(), func() ObjectImplementer {
+		return wrapper
+	})
+}
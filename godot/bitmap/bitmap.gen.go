@@ -1,7 +1,8 @@
-package godot
+package bitmap
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,52 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewBitMapFromPointer(ptr gdnative.Pointer) BitMap {
-func newBitMapFromPointer(ptr gdnative.Pointer) BitMap {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BitMap{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A two-dimensional array of boolean values, can be used to efficiently store a binary matrix (every matrix element takes only one bit) and query the values using natural cartesian coordinates.
 */
 type BitMap struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorBitMap is the class constructor of the BitMap class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorBitMap = gdnative.NewLazyClassConstructor("BitMap")
+
+// NewBitMap will create a new instance of the BitMap class.
+// BitMap is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewBitMap() *BitMap {
+	obj := &BitMap{}
+	obj.SetBaseObject(classConstructorBitMap.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *BitMap) BaseClass() string {
 	return "BitMap"
 }
 
+// Method binds of the BitMap class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBitMapX_GetData            = gdnative.NewLazyMethodBind("BitMap", "_get_data")
+	methodBindBitMapX_SetData            = gdnative.NewLazyMethodBind("BitMap", "_set_data")
+	methodBindBitMapCreate               = gdnative.NewLazyMethodBind("BitMap", "create")
+	methodBindBitMapCreateFromImageAlpha = gdnative.NewLazyMethodBind("BitMap", "create_from_image_alpha")
+	methodBindBitMapGetBit               = gdnative.NewLazyMethodBind("BitMap", "get_bit")
+	methodBindBitMapGetSize              = gdnative.NewLazyMethodBind("BitMap", "get_size")
+	methodBindBitMapGetTrueBitCount      = gdnative.NewLazyMethodBind("BitMap", "get_true_bit_count")
+	methodBindBitMapSetBit               = gdnative.NewLazyMethodBind("BitMap", "set_bit")
+	methodBindBitMapSetBitRect           = gdnative.NewLazyMethodBind("BitMap", "set_bit_rect")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *BitMap) X_GetData() gdnative.Dictionary {
 	//log.Println("Calling BitMap.X_GetData()")
@@ -45,7 +68,7 @@ func (o *BitMap) X_GetData() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "_get_data")
+	methodBind := methodBindBitMapX_GetData.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -58,8 +81,8 @@ func (o *BitMap) X_GetData() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Dictionary}], Returns: void
 */
 func (o *BitMap) X_SetData(arg0 gdnative.Dictionary) {
 	//log.Println("Calling BitMap.X_SetData()")
@@ -69,7 +92,7 @@ func (o *BitMap) X_SetData(arg0 gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "_set_data")
+	methodBind := methodBindBitMapX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +102,8 @@ func (o *BitMap) X_SetData(arg0 gdnative.Dictionary) {
 }
 
 /*
-        Creates a bitmap with the specified size, filled with false.
-	Args: [{ false size Vector2}], Returns: void
+	        Creates a bitmap with the specified size, filled with false.
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *BitMap) Create(size gdnative.Vector2) {
 	//log.Println("Calling BitMap.Create()")
@@ -90,7 +113,7 @@ func (o *BitMap) Create(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "create")
+	methodBind := methodBindBitMapCreate.Get()
 
 	// Call the parent method.
 	// void
@@ -100,18 +123,18 @@ func (o *BitMap) Create(size gdnative.Vector2) {
 }
 
 /*
-        Creates a bitmap that matches the given image dimensions, every element of the bitmap is set to false if the alpha value of the image at that position is equal to [code]threshold[/code] or less, and true in other case.
-	Args: [{ false image Image}], Returns: void
+	        Creates a bitmap that matches the given image dimensions, every element of the bitmap is set to false if the alpha value of the image at that position is equal to [code]threshold[/code] or less, and true in other case.
+		Args: [{ false image Image}], Returns: void
 */
-func (o *BitMap) CreateFromImageAlpha(image ImageImplementer) {
+func (o *BitMap) CreateFromImageAlpha(image godot.ImageImplementer) {
 	//log.Println("Calling BitMap.CreateFromImageAlpha()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(image.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "create_from_image_alpha")
+	methodBind := methodBindBitMapCreateFromImageAlpha.Get()
 
 	// Call the parent method.
 	// void
@@ -121,8 +144,8 @@ func (o *BitMap) CreateFromImageAlpha(image ImageImplementer) {
 }
 
 /*
-        Returns bitmap's value at the specified position.
-	Args: [{ false position Vector2}], Returns: bool
+	        Returns bitmap's value at the specified position.
+		Args: [{ false position Vector2}], Returns: bool
 */
 func (o *BitMap) GetBit(position gdnative.Vector2) gdnative.Bool {
 	//log.Println("Calling BitMap.GetBit()")
@@ -132,7 +155,7 @@ func (o *BitMap) GetBit(position gdnative.Vector2) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "get_bit")
+	methodBind := methodBindBitMapGetBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -145,8 +168,8 @@ func (o *BitMap) GetBit(position gdnative.Vector2) gdnative.Bool {
 }
 
 /*
-        Returns bitmap's dimensions.
-	Args: [], Returns: Vector2
+	        Returns bitmap's dimensions.
+		Args: [], Returns: Vector2
 */
 func (o *BitMap) GetSize() gdnative.Vector2 {
 	//log.Println("Calling BitMap.GetSize()")
@@ -155,7 +178,7 @@ func (o *BitMap) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "get_size")
+	methodBind := methodBindBitMapGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -168,8 +191,8 @@ func (o *BitMap) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Returns the amount of bitmap elements that are set to true.
-	Args: [], Returns: int
+	        Returns the amount of bitmap elements that are set to true.
+		Args: [], Returns: int
 */
 func (o *BitMap) GetTrueBitCount() gdnative.Int {
 	//log.Println("Calling BitMap.GetTrueBitCount()")
@@ -178,7 +201,7 @@ func (o *BitMap) GetTrueBitCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "get_true_bit_count")
+	methodBind := methodBindBitMapGetTrueBitCount.Get()
 
 	// Call the parent method.
 	// int
@@ -191,8 +214,8 @@ func (o *BitMap) GetTrueBitCount() gdnative.Int {
 }
 
 /*
-        Sets the bitmap's element at the specified position, to the specified value.
-	Args: [{ false position Vector2} { false bit bool}], Returns: void
+	        Sets the bitmap's element at the specified position, to the specified value.
+		Args: [{ false position Vector2} { false bit bool}], Returns: void
 */
 func (o *BitMap) SetBit(position gdnative.Vector2, bit gdnative.Bool) {
 	//log.Println("Calling BitMap.SetBit()")
@@ -203,7 +226,7 @@ func (o *BitMap) SetBit(position gdnative.Vector2, bit gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "set_bit")
+	methodBind := methodBindBitMapSetBit.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +236,8 @@ func (o *BitMap) SetBit(position gdnative.Vector2, bit gdnative.Bool) {
 }
 
 /*
-        Sets a rectangular portion of the bitmap to the specified value.
-	Args: [{ false p_rect Rect2} { false bit bool}], Returns: void
+	        Sets a rectangular portion of the bitmap to the specified value.
+		Args: [{ false p_rect Rect2} { false bit bool}], Returns: void
 */
 func (o *BitMap) SetBitRect(pRect gdnative.Rect2, bit gdnative.Bool) {
 	//log.Println("Calling BitMap.SetBitRect()")
@@ -225,7 +248,7 @@ func (o *BitMap) SetBitRect(pRect gdnative.Rect2, bit gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitMap", "set_bit_rect")
+	methodBind := methodBindBitMapSetBitRect.Get()
 
 	// Call the parent method.
 	// void
@@ -234,17 +257,29 @@ func (o *BitMap) SetBitRect(pRect gdnative.Rect2, bit gdnative.Bool) {
 
 }
 
+// Data will return the value of the "data" property.
+func (o *BitMap) Data() gdnative.Dictionary {
+	return o.X_GetData()
+}
+
+// SetData will set the value of the "data" property.
+func (o *BitMap) SetData(value gdnative.Dictionary) {
+	o.X_SetData(value)
+}
+
 // BitMapImplementer is an interface that implements the methods
 // of the BitMap class.
 type BitMapImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetData() gdnative.Dictionary
 	X_SetData(arg0 gdnative.Dictionary)
 	Create(size gdnative.Vector2)
-	CreateFromImageAlpha(image ImageImplementer)
+	CreateFromImageAlpha(image godot.ImageImplementer)
 	GetBit(position gdnative.Vector2) gdnative.Bool
 	GetSize() gdnative.Vector2
 	GetTrueBitCount() gdnative.Int
 	SetBit(position gdnative.Vector2, bit gdnative.Bool)
 	SetBitRect(pRect gdnative.Rect2, bit gdnative.Bool)
+	Data() gdnative.Dictionary
+	SetData(value gdnative.Dictionary)
 }
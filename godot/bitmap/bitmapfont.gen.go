@@ -1,7 +1,8 @@
-package godot
+package bitmap
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,63 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewBitmapFontFromPointer(ptr gdnative.Pointer) BitmapFont {
-func newBitmapFontFromPointer(ptr gdnative.Pointer) BitmapFont {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BitmapFont{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Renders text using [code]*.fnt[/code] fonts containing texture atlases. Supports distance fields. For using vector font files like TTF directly, see [DynamicFont].
 */
 type BitmapFont struct {
-	Font
+	godot.Font
 	owner gdnative.Object
 }
 
+// classConstructorBitmapFont is the class constructor of the BitmapFont class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorBitmapFont = gdnative.NewLazyClassConstructor("BitmapFont")
+
+// NewBitmapFont will create a new instance of the BitmapFont class.
+// BitmapFont is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewBitmapFont() *BitmapFont {
+	obj := &BitmapFont{}
+	obj.SetBaseObject(classConstructorBitmapFont.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *BitmapFont) BaseClass() string {
 	return "BitmapFont"
 }
 
+// Method binds of the BitmapFont class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBitmapFontX_GetChars           = gdnative.NewLazyMethodBind("BitmapFont", "_get_chars")
+	methodBindBitmapFontX_GetKernings        = gdnative.NewLazyMethodBind("BitmapFont", "_get_kernings")
+	methodBindBitmapFontX_GetTextures        = gdnative.NewLazyMethodBind("BitmapFont", "_get_textures")
+	methodBindBitmapFontX_SetChars           = gdnative.NewLazyMethodBind("BitmapFont", "_set_chars")
+	methodBindBitmapFontX_SetKernings        = gdnative.NewLazyMethodBind("BitmapFont", "_set_kernings")
+	methodBindBitmapFontX_SetTextures        = gdnative.NewLazyMethodBind("BitmapFont", "_set_textures")
+	methodBindBitmapFontAddChar              = gdnative.NewLazyMethodBind("BitmapFont", "add_char")
+	methodBindBitmapFontAddKerningPair       = gdnative.NewLazyMethodBind("BitmapFont", "add_kerning_pair")
+	methodBindBitmapFontAddTexture           = gdnative.NewLazyMethodBind("BitmapFont", "add_texture")
+	methodBindBitmapFontClear                = gdnative.NewLazyMethodBind("BitmapFont", "clear")
+	methodBindBitmapFontCreateFromFnt        = gdnative.NewLazyMethodBind("BitmapFont", "create_from_fnt")
+	methodBindBitmapFontGetCharSize          = gdnative.NewLazyMethodBind("BitmapFont", "get_char_size")
+	methodBindBitmapFontGetFallback          = gdnative.NewLazyMethodBind("BitmapFont", "get_fallback")
+	methodBindBitmapFontGetKerningPair       = gdnative.NewLazyMethodBind("BitmapFont", "get_kerning_pair")
+	methodBindBitmapFontGetTexture           = gdnative.NewLazyMethodBind("BitmapFont", "get_texture")
+	methodBindBitmapFontGetTextureCount      = gdnative.NewLazyMethodBind("BitmapFont", "get_texture_count")
+	methodBindBitmapFontSetAscent            = gdnative.NewLazyMethodBind("BitmapFont", "set_ascent")
+	methodBindBitmapFontSetDistanceFieldHint = gdnative.NewLazyMethodBind("BitmapFont", "set_distance_field_hint")
+	methodBindBitmapFontSetFallback          = gdnative.NewLazyMethodBind("BitmapFont", "set_fallback")
+	methodBindBitmapFontSetHeight            = gdnative.NewLazyMethodBind("BitmapFont", "set_height")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolIntArray
+	        Undocumented
+		Args: [], Returns: PoolIntArray
 */
 func (o *BitmapFont) X_GetChars() gdnative.PoolIntArray {
 	//log.Println("Calling BitmapFont.X_GetChars()")
@@ -45,7 +79,7 @@ func (o *BitmapFont) X_GetChars() gdnative.PoolIntArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "_get_chars")
+	methodBind := methodBindBitmapFontX_GetChars.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -58,8 +92,8 @@ func (o *BitmapFont) X_GetChars() gdnative.PoolIntArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolIntArray
+	        Undocumented
+		Args: [], Returns: PoolIntArray
 */
 func (o *BitmapFont) X_GetKernings() gdnative.PoolIntArray {
 	//log.Println("Calling BitmapFont.X_GetKernings()")
@@ -68,7 +102,7 @@ func (o *BitmapFont) X_GetKernings() gdnative.PoolIntArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "_get_kernings")
+	methodBind := methodBindBitmapFontX_GetKernings.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -81,8 +115,8 @@ func (o *BitmapFont) X_GetKernings() gdnative.PoolIntArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *BitmapFont) X_GetTextures() gdnative.Array {
 	//log.Println("Calling BitmapFont.X_GetTextures()")
@@ -91,7 +125,7 @@ func (o *BitmapFont) X_GetTextures() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "_get_textures")
+	methodBind := methodBindBitmapFontX_GetTextures.Get()
 
 	// Call the parent method.
 	// Array
@@ -104,8 +138,8 @@ func (o *BitmapFont) X_GetTextures() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 PoolIntArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 PoolIntArray}], Returns: void
 */
 func (o *BitmapFont) X_SetChars(arg0 gdnative.PoolIntArray) {
 	//log.Println("Calling BitmapFont.X_SetChars()")
@@ -115,7 +149,7 @@ func (o *BitmapFont) X_SetChars(arg0 gdnative.PoolIntArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolIntArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "_set_chars")
+	methodBind := methodBindBitmapFontX_SetChars.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +159,8 @@ func (o *BitmapFont) X_SetChars(arg0 gdnative.PoolIntArray) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 PoolIntArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 PoolIntArray}], Returns: void
 */
 func (o *BitmapFont) X_SetKernings(arg0 gdnative.PoolIntArray) {
 	//log.Println("Calling BitmapFont.X_SetKernings()")
@@ -136,7 +170,7 @@ func (o *BitmapFont) X_SetKernings(arg0 gdnative.PoolIntArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolIntArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "_set_kernings")
+	methodBind := methodBindBitmapFontX_SetKernings.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +180,8 @@ func (o *BitmapFont) X_SetKernings(arg0 gdnative.PoolIntArray) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
 */
 func (o *BitmapFont) X_SetTextures(arg0 gdnative.Array) {
 	//log.Println("Calling BitmapFont.X_SetTextures()")
@@ -157,7 +191,7 @@ func (o *BitmapFont) X_SetTextures(arg0 gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "_set_textures")
+	methodBind := methodBindBitmapFontX_SetTextures.Get()
 
 	// Call the parent method.
 	// void
@@ -167,8 +201,8 @@ func (o *BitmapFont) X_SetTextures(arg0 gdnative.Array) {
 }
 
 /*
-        Adds a character to the font, where [code]character[/code] is the unicode value, [code]texture[/code] is the texture index, [code]rect[/code] is the region in the texture (in pixels!), [code]align[/code] is the (optional) alignment for the character and [code]advance[/code] is the (optional) advance.
-	Args: [{ false character int} { false texture int} { false rect Rect2} {(0, 0) true align Vector2} {-1 true advance float}], Returns: void
+	        Adds a character to the font, where [code]character[/code] is the unicode value, [code]texture[/code] is the texture index, [code]rect[/code] is the region in the texture (in pixels!), [code]align[/code] is the (optional) alignment for the character and [code]advance[/code] is the (optional) advance.
+		Args: [{ false character int} { false texture int} { false rect Rect2} {(0, 0) true align Vector2} {-1 true advance float}], Returns: void
 */
 func (o *BitmapFont) AddChar(character gdnative.Int, texture gdnative.Int, rect gdnative.Rect2, align gdnative.Vector2, advance gdnative.Real) {
 	//log.Println("Calling BitmapFont.AddChar()")
@@ -182,7 +216,7 @@ func (o *BitmapFont) AddChar(character gdnative.Int, texture gdnative.Int, rect
 	ptrArguments[4] = gdnative.NewPointerFromReal(advance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "add_char")
+	methodBind := methodBindBitmapFontAddChar.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +226,8 @@ func (o *BitmapFont) AddChar(character gdnative.Int, texture gdnative.Int, rect
 }
 
 /*
-        Adds a kerning pair to the [code]BitmapFont[/code] as a difference. Kerning pairs are special cases where a typeface advance is determined by the next character.
-	Args: [{ false char_a int} { false char_b int} { false kerning int}], Returns: void
+	        Adds a kerning pair to the [code]BitmapFont[/code] as a difference. Kerning pairs are special cases where a typeface advance is determined by the next character.
+		Args: [{ false char_a int} { false char_b int} { false kerning int}], Returns: void
 */
 func (o *BitmapFont) AddKerningPair(charA gdnative.Int, charB gdnative.Int, kerning gdnative.Int) {
 	//log.Println("Calling BitmapFont.AddKerningPair()")
@@ -205,7 +239,7 @@ func (o *BitmapFont) AddKerningPair(charA gdnative.Int, charB gdnative.Int, kern
 	ptrArguments[2] = gdnative.NewPointerFromInt(kerning)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "add_kerning_pair")
+	methodBind := methodBindBitmapFontAddKerningPair.Get()
 
 	// Call the parent method.
 	// void
@@ -215,18 +249,18 @@ func (o *BitmapFont) AddKerningPair(charA gdnative.Int, charB gdnative.Int, kern
 }
 
 /*
-        Adds a texture to the [code]BitmapFont[/code].
-	Args: [{ false texture Texture}], Returns: void
+	        Adds a texture to the [code]BitmapFont[/code].
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *BitmapFont) AddTexture(texture TextureImplementer) {
+func (o *BitmapFont) AddTexture(texture godot.TextureImplementer) {
 	//log.Println("Calling BitmapFont.AddTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "add_texture")
+	methodBind := methodBindBitmapFontAddTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -236,8 +270,8 @@ func (o *BitmapFont) AddTexture(texture TextureImplementer) {
 }
 
 /*
-        Clears all the font data and settings.
-	Args: [], Returns: void
+	        Clears all the font data and settings.
+		Args: [], Returns: void
 */
 func (o *BitmapFont) Clear() {
 	//log.Println("Calling BitmapFont.Clear()")
@@ -246,7 +280,7 @@ func (o *BitmapFont) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "clear")
+	methodBind := methodBindBitmapFontClear.Get()
 
 	// Call the parent method.
 	// void
@@ -256,8 +290,8 @@ func (o *BitmapFont) Clear() {
 }
 
 /*
-        Creates a BitmapFont from the [code]*.fnt[/code] file at [code]path[/code].
-	Args: [{ false path String}], Returns: enum.Error
+	        Creates a BitmapFont from the [code]*.fnt[/code] file at [code]path[/code].
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *BitmapFont) CreateFromFnt(path gdnative.String) gdnative.Error {
 	//log.Println("Calling BitmapFont.CreateFromFnt()")
@@ -267,7 +301,7 @@ func (o *BitmapFont) CreateFromFnt(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "create_from_fnt")
+	methodBind := methodBindBitmapFontCreateFromFnt.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -280,8 +314,8 @@ func (o *BitmapFont) CreateFromFnt(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Returns the size of a character, optionally taking kerning into account if the next character is provided.
-	Args: [{ false char int} {0 true next int}], Returns: Vector2
+	        Returns the size of a character, optionally taking kerning into account if the next character is provided.
+		Args: [{ false char int} {0 true next int}], Returns: Vector2
 */
 func (o *BitmapFont) GetCharSize(char gdnative.Int, next gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling BitmapFont.GetCharSize()")
@@ -292,7 +326,7 @@ func (o *BitmapFont) GetCharSize(char gdnative.Int, next gdnative.Int) gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(next)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "get_char_size")
+	methodBind := methodBindBitmapFontGetCharSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -305,8 +339,8 @@ func (o *BitmapFont) GetCharSize(char gdnative.Int, next gdnative.Int) gdnative.
 }
 
 /*
-        Undocumented
-	Args: [], Returns: BitmapFont
+	        Undocumented
+		Args: [], Returns: BitmapFont
 */
 func (o *BitmapFont) GetFallback() BitmapFontImplementer {
 	//log.Println("Calling BitmapFont.GetFallback()")
@@ -315,7 +349,7 @@ func (o *BitmapFont) GetFallback() BitmapFontImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "get_fallback")
+	methodBind := methodBindBitmapFontGetFallback.Get()
 
 	// Call the parent method.
 	// BitmapFont
@@ -323,27 +357,25 @@ func (o *BitmapFont) GetFallback() BitmapFontImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newBitmapFontFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(BitmapFontImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "BitmapFont" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(BitmapFontImplementer)
+	ret := BitmapFont{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(BitmapFontImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns a kerning pair as a difference.
-	Args: [{ false char_a int} { false char_b int}], Returns: int
+	        Returns a kerning pair as a difference.
+		Args: [{ false char_a int} { false char_b int}], Returns: int
 */
 func (o *BitmapFont) GetKerningPair(charA gdnative.Int, charB gdnative.Int) gdnative.Int {
 	//log.Println("Calling BitmapFont.GetKerningPair()")
@@ -354,7 +386,7 @@ func (o *BitmapFont) GetKerningPair(charA gdnative.Int, charB gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(charB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "get_kerning_pair")
+	methodBind := methodBindBitmapFontGetKerningPair.Get()
 
 	// Call the parent method.
 	// int
@@ -367,10 +399,10 @@ func (o *BitmapFont) GetKerningPair(charA gdnative.Int, charB gdnative.Int) gdna
 }
 
 /*
-        Returns the font atlas texture at index [code]idx[/code].
-	Args: [{ false idx int}], Returns: Texture
+	        Returns the font atlas texture at index [code]idx[/code].
+		Args: [{ false idx int}], Returns: Texture
 */
-func (o *BitmapFont) GetTexture(idx gdnative.Int) TextureImplementer {
+func (o *BitmapFont) GetTexture(idx gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling BitmapFont.GetTexture()")
 
 	// Build out the method's arguments
@@ -378,7 +410,7 @@ func (o *BitmapFont) GetTexture(idx gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "get_texture")
+	methodBind := methodBindBitmapFontGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -386,27 +418,25 @@ func (o *BitmapFont) GetTexture(idx gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of textures in the BitmapFont atlas.
-	Args: [], Returns: int
+	        Returns the number of textures in the BitmapFont atlas.
+		Args: [], Returns: int
 */
 func (o *BitmapFont) GetTextureCount() gdnative.Int {
 	//log.Println("Calling BitmapFont.GetTextureCount()")
@@ -415,7 +445,7 @@ func (o *BitmapFont) GetTextureCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "get_texture_count")
+	methodBind := methodBindBitmapFontGetTextureCount.Get()
 
 	// Call the parent method.
 	// int
@@ -428,8 +458,8 @@ func (o *BitmapFont) GetTextureCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false px float}], Returns: void
+	        Undocumented
+		Args: [{ false px float}], Returns: void
 */
 func (o *BitmapFont) SetAscent(px gdnative.Real) {
 	//log.Println("Calling BitmapFont.SetAscent()")
@@ -439,7 +469,7 @@ func (o *BitmapFont) SetAscent(px gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(px)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "set_ascent")
+	methodBind := methodBindBitmapFontSetAscent.Get()
 
 	// Call the parent method.
 	// void
@@ -449,8 +479,8 @@ func (o *BitmapFont) SetAscent(px gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *BitmapFont) SetDistanceFieldHint(enable gdnative.Bool) {
 	//log.Println("Calling BitmapFont.SetDistanceFieldHint()")
@@ -460,7 +490,7 @@ func (o *BitmapFont) SetDistanceFieldHint(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "set_distance_field_hint")
+	methodBind := methodBindBitmapFontSetDistanceFieldHint.Get()
 
 	// Call the parent method.
 	// void
@@ -470,18 +500,18 @@ func (o *BitmapFont) SetDistanceFieldHint(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false fallback BitmapFont}], Returns: void
+	        Undocumented
+		Args: [{ false fallback BitmapFont}], Returns: void
 */
 func (o *BitmapFont) SetFallback(fallback BitmapFontImplementer) {
 	//log.Println("Calling BitmapFont.SetFallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(fallback.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(fallback))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "set_fallback")
+	methodBind := methodBindBitmapFontSetFallback.Get()
 
 	// Call the parent method.
 	// void
@@ -491,8 +521,8 @@ func (o *BitmapFont) SetFallback(fallback BitmapFontImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false px float}], Returns: void
+	        Undocumented
+		Args: [{ false px float}], Returns: void
 */
 func (o *BitmapFont) SetHeight(px gdnative.Real) {
 	//log.Println("Calling BitmapFont.SetHeight()")
@@ -502,7 +532,7 @@ func (o *BitmapFont) SetHeight(px gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(px)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BitmapFont", "set_height")
+	methodBind := methodBindBitmapFontSetHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -511,10 +541,75 @@ func (o *BitmapFont) SetHeight(px gdnative.Real) {
 
 }
 
+// AddCharWithDefaults will call AddChar using the default values for: align, advance.
+func (o *BitmapFont) AddCharWithDefaults(character gdnative.Int, texture gdnative.Int, rect gdnative.Rect2) {
+	o.AddChar(character, texture, rect, gdnative.NewVector2(0, 0), -1)
+}
+
+// GetCharSizeWithDefaults will call GetCharSize using the default values for: next.
+func (o *BitmapFont) GetCharSizeWithDefaults(char gdnative.Int) gdnative.Vector2 {
+	return o.GetCharSize(char, 0)
+}
+
+// Ascent will return the value of the "ascent" property.
+func (o *BitmapFont) Ascent() gdnative.Real {
+	return o.GetAscent()
+}
+
+// Chars will return the value of the "chars" property.
+func (o *BitmapFont) Chars() gdnative.PoolIntArray {
+	return o.X_GetChars()
+}
+
+// SetChars will set the value of the "chars" property.
+func (o *BitmapFont) SetChars(value gdnative.PoolIntArray) {
+	o.X_SetChars(value)
+}
+
+// DistanceField will return the value of the "distance_field" property.
+func (o *BitmapFont) DistanceField() gdnative.Bool {
+	return o.IsDistanceFieldHint()
+}
+
+// SetDistanceField will set the value of the "distance_field" property.
+func (o *BitmapFont) SetDistanceField(value gdnative.Bool) {
+	o.SetDistanceFieldHint(value)
+}
+
+// Fallback will return the value of the "fallback" property.
+func (o *BitmapFont) Fallback() BitmapFontImplementer {
+	return o.GetFallback()
+}
+
+// Height will return the value of the "height" property.
+func (o *BitmapFont) Height() gdnative.Real {
+	return o.GetHeight()
+}
+
+// Kernings will return the value of the "kernings" property.
+func (o *BitmapFont) Kernings() gdnative.PoolIntArray {
+	return o.X_GetKernings()
+}
+
+// SetKernings will set the value of the "kernings" property.
+func (o *BitmapFont) SetKernings(value gdnative.PoolIntArray) {
+	o.X_SetKernings(value)
+}
+
+// Textures will return the value of the "textures" property.
+func (o *BitmapFont) Textures() gdnative.Array {
+	return o.X_GetTextures()
+}
+
+// SetTextures will set the value of the "textures" property.
+func (o *BitmapFont) SetTextures(value gdnative.Array) {
+	o.X_SetTextures(value)
+}
+
 // BitmapFontImplementer is an interface that implements the methods
 // of the BitmapFont class.
 type BitmapFontImplementer interface {
-	FontImplementer
+	godot.FontImplementer
 	X_GetChars() gdnative.PoolIntArray
 	X_GetKernings() gdnative.PoolIntArray
 	X_GetTextures() gdnative.Array
@@ -523,15 +618,29 @@ type BitmapFontImplementer interface {
 	X_SetTextures(arg0 gdnative.Array)
 	AddChar(character gdnative.Int, texture gdnative.Int, rect gdnative.Rect2, align gdnative.Vector2, advance gdnative.Real)
 	AddKerningPair(charA gdnative.Int, charB gdnative.Int, kerning gdnative.Int)
-	AddTexture(texture TextureImplementer)
+	AddTexture(texture godot.TextureImplementer)
 	Clear()
+	CreateFromFnt(path gdnative.String) gdnative.Error
 	GetCharSize(char gdnative.Int, next gdnative.Int) gdnative.Vector2
 	GetFallback() BitmapFontImplementer
 	GetKerningPair(charA gdnative.Int, charB gdnative.Int) gdnative.Int
-	GetTexture(idx gdnative.Int) TextureImplementer
+	GetTexture(idx gdnative.Int) godot.TextureImplementer
 	GetTextureCount() gdnative.Int
 	SetAscent(px gdnative.Real)
 	SetDistanceFieldHint(enable gdnative.Bool)
 	SetFallback(fallback BitmapFontImplementer)
 	SetHeight(px gdnative.Real)
+	AddCharWithDefaults(character gdnative.Int, texture gdnative.Int, rect gdnative.Rect2)
+	GetCharSizeWithDefaults(char gdnative.Int) gdnative.Vector2
+	Ascent() gdnative.Real
+	Chars() gdnative.PoolIntArray
+	SetChars(value gdnative.PoolIntArray)
+	DistanceField() gdnative.Bool
+	SetDistanceField(value gdnative.Bool)
+	Fallback() BitmapFontImplementer
+	Height() gdnative.Real
+	Kernings() gdnative.PoolIntArray
+	SetKernings(value gdnative.PoolIntArray)
+	Textures() gdnative.Array
+	SetTextures(value gdnative.Array)
 }
@@ -0,0 +1,257 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"log"
+)
+
+// MethodFunc is a function that will be called when Godot calls a method that
+// was registered with a ClassBuilder. It is passed the class instance the
+// method was called on and the arguments of the call.
+type MethodFunc func(instance Class, args []gdnative.Variant) gdnative.Variant
+
+// PropertyGetter is a function that will be called when Godot gets a property
+// that was registered with a ClassBuilder.
+type PropertyGetter func(instance Class) gdnative.Variant
+
+// PropertySetter is a function that will be called when Godot sets a property
+// that was registered with a ClassBuilder.
+type PropertySetter func(instance Class, value gdnative.Variant)
+
+// ClassBuilder is used to register a Go struct as a Godot class with an explicit
+// list of methods, properties and signals. Unlike AutoRegister, no reflection is
+// used, and every method, property and signal is registered with the given Godot
+// name. Use RegisterClass to register the built class with Godot.
+type ClassBuilder struct {
+	name        string
+	constructor ClassConstructor
+	methods     []builderMethod
+	properties  []builderProperty
+	signals     []Signal
+	tool        bool
+	docs        map[string]string
+	arguments   map[string][]gdnative.MethodArgument
+	rpcModes    map[string]gdnative.MethodRpcMode
+}
+
+// builderMethod is a method that was added to a ClassBuilder.
+type builderMethod struct {
+	name   string
+	method MethodFunc
+}
+
+// builderProperty is a property that was added to a ClassBuilder.
+type builderProperty struct {
+	name       string
+	getter     PropertyGetter
+	setter     PropertySetter
+	attributes *gdnative.PropertyAttributes
+}
+
+// NewClassBuilder will return a new ClassBuilder for a Godot class with the given
+// name. The constructor will be called whenever Godot needs to create a new
+// instance of the class.
+func NewClassBuilder(name string, constructor ClassConstructor) *ClassBuilder {
+	return &ClassBuilder{
+		name:        name,
+		constructor: constructor,
+		docs:        map[string]string{},
+		arguments:   map[string][]gdnative.MethodArgument{},
+		rpcModes:    map[string]gdnative.MethodRpcMode{},
+	}
+}
+
+// Tool will register the class as a tool class, so it will also be instanced
+// inside the Godot editor. See ToolClass for more information.
+func (b *ClassBuilder) Tool() *ClassBuilder {
+	b.tool = true
+	return b
+}
+
+// Doc will set the documentation of the method, property or signal with the given
+// Godot name. An empty name documents the class itself. The documentation is
+// only shown in the editor if the engine supports NativeScript 1.1.
+func (b *ClassBuilder) Doc(name, doc string) *ClassBuilder {
+	b.docs[name] = doc
+	return b
+}
+
+// Arguments will set the names and types of the arguments of the method with the
+// given Godot name, so they can be shown in the editor. This is only supported if
+// the engine supports NativeScript 1.1.
+func (b *ClassBuilder) Arguments(method string, arguments ...gdnative.MethodArgument) *ClassBuilder {
+	b.arguments[method] = arguments
+	return b
+}
+
+// RPC will set the RPC mode of the method with the given Godot name, so it can be
+// called over the network with Godot's high-level multiplayer API. Methods are
+// registered with MethodRpcModeDisabled by default.
+func (b *ClassBuilder) RPC(method string, mode gdnative.MethodRpcMode) *ClassBuilder {
+	b.rpcModes[method] = mode
+	return b
+}
+
+// Method will add a method with the given Godot name to the class.
+func (b *ClassBuilder) Method(name string, method MethodFunc) *ClassBuilder {
+	b.methods = append(b.methods, builderMethod{name: name, method: method})
+	return b
+}
+
+// Property will add a property with the given Godot name to the class. If the
+// setter is nil, the property will be read-only. If the attributes are nil, the
+// property will be registered as a Nil variant with the default usage.
+func (b *ClassBuilder) Property(name string, getter PropertyGetter, setter PropertySetter, attributes *gdnative.PropertyAttributes) *ClassBuilder {
+	b.properties = append(b.properties, builderProperty{
+		name:       name,
+		getter:     getter,
+		setter:     setter,
+		attributes: attributes,
+	})
+	return b
+}
+
+// Signal will add the given signal to the class.
+func (b *ClassBuilder) Signal(signal Signal) *ClassBuilder {
+	b.signals = append(b.signals, signal)
+	return b
+}
+
+// register will register the class and all of its methods, properties and
+// signals with Godot.
+func (b *ClassBuilder) register() {
+	if debug {
+		log.Println("Registering class:", b.name)
+	}
+
+	// Call the constructor to get the BaseClass
+	class := b.constructor()
+
+	// Set up our constructor and destructor function structs.
+	createFunc := createConstructor(b.name, b.constructor)
+	destroyFunc := createDestructor(b.name)
+
+	// Register our class with Godot. Tool classes will also be instanced
+	// inside the editor.
+	if b.tool || isToolClass(class) {
+		gdnative.NativeScript.RegisterToolClass(b.name, class.BaseClass(), createFunc, destroyFunc)
+	} else {
+		gdnative.NativeScript.RegisterClass(b.name, class.BaseClass(), createFunc, destroyFunc)
+	}
+	gdnative.NativeScript.SetTypeTag(b.name)
+	gdnative.NativeScript.SetClassDocumentation(b.name, gdnative.String(b.docs[""]))
+
+	for _, method := range b.methods {
+		if debug {
+			log.Println("  Registering method:", method.name)
+		}
+		attributes := &gdnative.MethodAttributes{
+			RPCType: gdnative.MethodRpcModeDisabled,
+		}
+		if rpcMode, ok := b.rpcModes[method.name]; ok {
+			attributes.RPCType = rpcMode
+		}
+		gdnative.NativeScript.RegisterMethod(b.name, method.name, attributes, b.createMethod(method))
+		gdnative.NativeScript.SetMethodArgumentInformation(b.name, method.name, b.arguments[method.name])
+		gdnative.NativeScript.SetMethodDocumentation(b.name, method.name, gdnative.String(b.docs[method.name]))
+	}
+
+	for _, property := range b.properties {
+		if debug {
+			log.Println("  Registering property:", property.name)
+		}
+		attributes := property.attributes
+		if attributes == nil {
+			attributes = &gdnative.PropertyAttributes{
+				Type:         gdnative.Int(gdnative.VariantTypeNil),
+				Hint:         gdnative.PropertyHintNone,
+				Usage:        gdnative.PropertyUsageDefault,
+				RsetType:     gdnative.MethodRpcModeDisabled,
+				DefaultValue: gdnative.NewVariantNil(),
+			}
+		}
+		gdnative.NativeScript.RegisterProperty(
+			b.name,
+			property.name,
+			attributes,
+			b.createPropertySetter(property),
+			b.createPropertyGetter(property),
+		)
+		gdnative.NativeScript.SetPropertyDocumentation(b.name, property.name, gdnative.String(b.docs[property.name]))
+	}
+
+	for _, signal := range b.signals {
+		if debug {
+			log.Println("  Registering signal:", signal.Name)
+		}
+		gdnative.NativeScript.RegisterSignal(b.name, newGDNativeSignal(signal))
+		gdnative.NativeScript.SetSignalDocumentation(b.name, signal.Name, gdnative.String(b.docs[signal.Name]))
+	}
+}
+
+// createMethod will create the InstanceMethod structure for the given method.
+// This will be called whenever Godot calls the method.
+func (b *ClassBuilder) createMethod(method builderMethod) *gdnative.InstanceMethod {
+	var methodFunc gdnative.InstanceMethod
+	methodFunc.Method = func(object gdnative.Object, classMethod, instanceString string, numArgs int, args []gdnative.Variant) gdnative.Variant {
+		// Make sure funcs queued with RunOnMain can be run.
+		ensureDispatcher()
+
+		// Get the object instance based on the instance string given in userData.
+		class, ok := InstanceRegistry.getInstance("Method", classMethod, instanceString)
+		if !ok {
+			return gdnative.NewVariantNil()
+		}
+
+		return method.method(class, args)
+	}
+	methodFunc.MethodData = b.name + "::" + method.name
+	methodFunc.FreeFunc = func(methodData string) {}
+
+	return &methodFunc
+}
+
+// createPropertySetter will create the InstancePropertySet structure for the
+// given property. This will be called whenever Godot sets the property.
+func (b *ClassBuilder) createPropertySetter(property builderProperty) *gdnative.InstancePropertySet {
+	var propertySetFunc gdnative.InstancePropertySet
+	propertySetFunc.SetFunc = func(object gdnative.Object, classProperty, instanceString string, value gdnative.Variant) {
+		// Get the object instance based on the instance string given in userData.
+		class, ok := InstanceRegistry.getInstance("Set property", classProperty, instanceString)
+		if !ok {
+			return
+		}
+
+		if property.setter == nil {
+			Log.Error("Unable to set property ", classProperty, ": the property is read-only.")
+			return
+		}
+		property.setter(class, value)
+	}
+	propertySetFunc.MethodData = b.name + "::" + property.name
+	propertySetFunc.FreeFunc = func(methodData string) {}
+
+	return &propertySetFunc
+}
+
+// createPropertyGetter will create the InstancePropertyGet structure for the
+// given property. This will be called whenever Godot gets the property.
+func (b *ClassBuilder) createPropertyGetter(property builderProperty) *gdnative.InstancePropertyGet {
+	var propertyGetFunc gdnative.InstancePropertyGet
+	propertyGetFunc.GetFunc = func(object gdnative.Object, classProperty, instanceString string) gdnative.Variant {
+		// Get the object instance based on the instance string given in userData.
+		class, ok := InstanceRegistry.getInstance("Get property", classProperty, instanceString)
+		if !ok {
+			return gdnative.NewVariantNil()
+		}
+
+		if property.getter == nil {
+			return gdnative.NewVariantNil()
+		}
+		return property.getter(class)
+	}
+	propertyGetFunc.MethodData = b.name + "::" + property.name
+	propertyGetFunc.FreeFunc = func(methodData string) {}
+
+	return &propertyGetFunc
+}
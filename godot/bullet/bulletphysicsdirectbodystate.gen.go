@@ -1,7 +1,8 @@
-package godot
+package bullet
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot/physics"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewBulletPhysicsDirectBodyStateFromPointer(ptr gdnative.Pointer) BulletPhysicsDirectBodyState {
-func newBulletPhysicsDirectBodyStateFromPointer(ptr gdnative.Pointer) BulletPhysicsDirectBodyState {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BulletPhysicsDirectBodyState{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
 type BulletPhysicsDirectBodyState struct {
-	PhysicsDirectBodyState
+	physics.PhysicsDirectBodyState
 	owner gdnative.Object
 }
 
@@ -37,5 +29,5 @@ func (o *BulletPhysicsDirectBodyState) BaseClass() string {
 // BulletPhysicsDirectBodyStateImplementer is an interface that implements the methods
 // of the BulletPhysicsDirectBodyState class.
 type BulletPhysicsDirectBodyStateImplementer interface {
-	PhysicsDirectBodyStateImplementer
+	physics.PhysicsDirectBodyStateImplementer
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewButtonGroupFromPointer(ptr gdnative.Pointer) ButtonGroup {
-func newButtonGroupFromPointer(ptr gdnative.Pointer) ButtonGroup {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ButtonGroup{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Group of [Button]. All direct and indirect children buttons become radios. Only one allows being pressed. [member BaseButton.toggle_mode] should be [code]true[/code].
 */
@@ -30,13 +21,36 @@ type ButtonGroup struct {
 	owner gdnative.Object
 }
 
+// classConstructorButtonGroup is the class constructor of the ButtonGroup class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorButtonGroup = gdnative.NewLazyClassConstructor("ButtonGroup")
+
+// NewButtonGroup will create a new instance of the ButtonGroup class.
+// ButtonGroup is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewButtonGroup() *ButtonGroup {
+	obj := &ButtonGroup{}
+	obj.SetBaseObject(classConstructorButtonGroup.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ButtonGroup) BaseClass() string {
 	return "ButtonGroup"
 }
 
+// Method binds of the ButtonGroup class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindButtonGroupGetPressedButton = gdnative.NewLazyMethodBind("ButtonGroup", "get_pressed_button")
+)
+
 /*
-        Return the pressed button.
-	Args: [], Returns: BaseButton
+	        Return the pressed button.
+		Args: [], Returns: BaseButton
 */
 func (o *ButtonGroup) GetPressedButton() BaseButtonImplementer {
 	//log.Println("Calling ButtonGroup.GetPressedButton()")
@@ -45,7 +59,7 @@ func (o *ButtonGroup) GetPressedButton() BaseButtonImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ButtonGroup", "get_pressed_button")
+	methodBind := methodBindButtonGroupGetPressedButton.Get()
 
 	// Call the parent method.
 	// BaseButton
@@ -53,19 +67,17 @@ func (o *ButtonGroup) GetPressedButton() BaseButtonImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newBaseButtonFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(BaseButtonImplementer)
-	}
+	ret := BaseButton{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
 
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "BaseButton" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(BaseButtonImplementer)
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(BaseButtonImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,6 +24,32 @@ const (
 	CameraDopplerTrackingPhysicsStep CameraDopplerTracking = 2
 )
 
+// String will return the Godot name of the CameraDopplerTracking value.
+func (e CameraDopplerTracking) String() string {
+	switch e {
+	case CameraDopplerTrackingDisabled:
+		return "DOPPLER_TRACKING_DISABLED"
+	case CameraDopplerTrackingIdleStep:
+		return "DOPPLER_TRACKING_IDLE_STEP"
+	case CameraDopplerTrackingPhysicsStep:
+		return "DOPPLER_TRACKING_PHYSICS_STEP"
+	}
+	return fmt.Sprintf("CameraDopplerTracking(%d)", int(e))
+}
+
+// ParseCameraDopplerTracking will return the CameraDopplerTracking value with the given Godot name.
+func ParseCameraDopplerTracking(name string) (CameraDopplerTracking, error) {
+	switch name {
+	case "DOPPLER_TRACKING_DISABLED":
+		return CameraDopplerTrackingDisabled, nil
+	case "DOPPLER_TRACKING_IDLE_STEP":
+		return CameraDopplerTrackingIdleStep, nil
+	case "DOPPLER_TRACKING_PHYSICS_STEP":
+		return CameraDopplerTrackingPhysicsStep, nil
+	}
+	return 0, fmt.Errorf("invalid CameraDopplerTracking value %q", name)
+}
+
 // CameraKeepAspect is an enum for KeepAspect values.
 type CameraKeepAspect int
 
@@ -30,6 +58,28 @@ const (
 	CameraKeepWidth  CameraKeepAspect = 0
 )
 
+// String will return the Godot name of the CameraKeepAspect value.
+func (e CameraKeepAspect) String() string {
+	switch e {
+	case CameraKeepHeight:
+		return "KEEP_HEIGHT"
+	case CameraKeepWidth:
+		return "KEEP_WIDTH"
+	}
+	return fmt.Sprintf("CameraKeepAspect(%d)", int(e))
+}
+
+// ParseCameraKeepAspect will return the CameraKeepAspect value with the given Godot name.
+func ParseCameraKeepAspect(name string) (CameraKeepAspect, error) {
+	switch name {
+	case "KEEP_HEIGHT":
+		return CameraKeepHeight, nil
+	case "KEEP_WIDTH":
+		return CameraKeepWidth, nil
+	}
+	return 0, fmt.Errorf("invalid CameraKeepAspect value %q", name)
+}
+
 // CameraProjection is an enum for Projection values.
 type CameraProjection int
 
@@ -38,13 +88,26 @@ const (
 	CameraProjectionPerspective CameraProjection = 0
 )
 
-//func NewCameraFromPointer(ptr gdnative.Pointer) Camera {
-func newCameraFromPointer(ptr gdnative.Pointer) Camera {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Camera{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the CameraProjection value.
+func (e CameraProjection) String() string {
+	switch e {
+	case CameraProjectionOrthogonal:
+		return "PROJECTION_ORTHOGONAL"
+	case CameraProjectionPerspective:
+		return "PROJECTION_PERSPECTIVE"
+	}
+	return fmt.Sprintf("CameraProjection(%d)", int(e))
+}
 
-	return obj
+// ParseCameraProjection will return the CameraProjection value with the given Godot name.
+func ParseCameraProjection(name string) (CameraProjection, error) {
+	switch name {
+	case "PROJECTION_ORTHOGONAL":
+		return CameraProjectionOrthogonal, nil
+	case "PROJECTION_PERSPECTIVE":
+		return CameraProjectionPerspective, nil
+	}
+	return 0, fmt.Errorf("invalid CameraProjection value %q", name)
 }
 
 /*
@@ -55,13 +118,67 @@ type Camera struct {
 	owner gdnative.Object
 }
 
+// classConstructorCamera is the class constructor of the Camera class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCamera = gdnative.NewLazyClassConstructor("Camera")
+
+// NewCamera will create a new instance of the Camera class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCamera() *Camera {
+	obj := &Camera{}
+	obj.SetBaseObject(classConstructorCamera.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Camera) BaseClass() string {
 	return "Camera"
 }
 
+// Method binds of the Camera class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCameraClearCurrent          = gdnative.NewLazyMethodBind("Camera", "clear_current")
+	methodBindCameraGetCameraTransform    = gdnative.NewLazyMethodBind("Camera", "get_camera_transform")
+	methodBindCameraGetCullMask           = gdnative.NewLazyMethodBind("Camera", "get_cull_mask")
+	methodBindCameraGetDopplerTracking    = gdnative.NewLazyMethodBind("Camera", "get_doppler_tracking")
+	methodBindCameraGetEnvironment        = gdnative.NewLazyMethodBind("Camera", "get_environment")
+	methodBindCameraGetFov                = gdnative.NewLazyMethodBind("Camera", "get_fov")
+	methodBindCameraGetHOffset            = gdnative.NewLazyMethodBind("Camera", "get_h_offset")
+	methodBindCameraGetKeepAspectMode     = gdnative.NewLazyMethodBind("Camera", "get_keep_aspect_mode")
+	methodBindCameraGetProjection         = gdnative.NewLazyMethodBind("Camera", "get_projection")
+	methodBindCameraGetSize               = gdnative.NewLazyMethodBind("Camera", "get_size")
+	methodBindCameraGetVOffset            = gdnative.NewLazyMethodBind("Camera", "get_v_offset")
+	methodBindCameraGetZfar               = gdnative.NewLazyMethodBind("Camera", "get_zfar")
+	methodBindCameraGetZnear              = gdnative.NewLazyMethodBind("Camera", "get_znear")
+	methodBindCameraIsCurrent             = gdnative.NewLazyMethodBind("Camera", "is_current")
+	methodBindCameraIsPositionBehind      = gdnative.NewLazyMethodBind("Camera", "is_position_behind")
+	methodBindCameraMakeCurrent           = gdnative.NewLazyMethodBind("Camera", "make_current")
+	methodBindCameraProjectLocalRayNormal = gdnative.NewLazyMethodBind("Camera", "project_local_ray_normal")
+	methodBindCameraProjectPosition       = gdnative.NewLazyMethodBind("Camera", "project_position")
+	methodBindCameraProjectRayNormal      = gdnative.NewLazyMethodBind("Camera", "project_ray_normal")
+	methodBindCameraProjectRayOrigin      = gdnative.NewLazyMethodBind("Camera", "project_ray_origin")
+	methodBindCameraSetCullMask           = gdnative.NewLazyMethodBind("Camera", "set_cull_mask")
+	methodBindCameraSetCurrent            = gdnative.NewLazyMethodBind("Camera", "set_current")
+	methodBindCameraSetDopplerTracking    = gdnative.NewLazyMethodBind("Camera", "set_doppler_tracking")
+	methodBindCameraSetEnvironment        = gdnative.NewLazyMethodBind("Camera", "set_environment")
+	methodBindCameraSetFov                = gdnative.NewLazyMethodBind("Camera", "set_fov")
+	methodBindCameraSetHOffset            = gdnative.NewLazyMethodBind("Camera", "set_h_offset")
+	methodBindCameraSetKeepAspectMode     = gdnative.NewLazyMethodBind("Camera", "set_keep_aspect_mode")
+	methodBindCameraSetOrthogonal         = gdnative.NewLazyMethodBind("Camera", "set_orthogonal")
+	methodBindCameraSetPerspective        = gdnative.NewLazyMethodBind("Camera", "set_perspective")
+	methodBindCameraSetProjection         = gdnative.NewLazyMethodBind("Camera", "set_projection")
+	methodBindCameraSetSize               = gdnative.NewLazyMethodBind("Camera", "set_size")
+	methodBindCameraSetVOffset            = gdnative.NewLazyMethodBind("Camera", "set_v_offset")
+	methodBindCameraSetZfar               = gdnative.NewLazyMethodBind("Camera", "set_zfar")
+	methodBindCameraSetZnear              = gdnative.NewLazyMethodBind("Camera", "set_znear")
+	methodBindCameraUnprojectPosition     = gdnative.NewLazyMethodBind("Camera", "unproject_position")
+)
+
 /*
-        If this is the current Camera, remove it from being current. If it is inside the node tree, request to make the next Camera current, if any.
-	Args: [], Returns: void
+	        If this is the current Camera, remove it from being current. If it is inside the node tree, request to make the next Camera current, if any.
+		Args: [], Returns: void
 */
 func (o *Camera) ClearCurrent() {
 	//log.Println("Calling Camera.ClearCurrent()")
@@ -70,7 +187,7 @@ func (o *Camera) ClearCurrent() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "clear_current")
+	methodBind := methodBindCameraClearCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -80,8 +197,8 @@ func (o *Camera) ClearCurrent() {
 }
 
 /*
-        Get the camera transform. Subclassed cameras (such as CharacterCamera) may provide different transforms than the [Node] transform.
-	Args: [], Returns: Transform
+	        Get the camera transform. Subclassed cameras (such as CharacterCamera) may provide different transforms than the [Node] transform.
+		Args: [], Returns: Transform
 */
 func (o *Camera) GetCameraTransform() gdnative.Transform {
 	//log.Println("Calling Camera.GetCameraTransform()")
@@ -90,7 +207,7 @@ func (o *Camera) GetCameraTransform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_camera_transform")
+	methodBind := methodBindCameraGetCameraTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -103,8 +220,8 @@ func (o *Camera) GetCameraTransform() gdnative.Transform {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Camera) GetCullMask() gdnative.Int {
 	//log.Println("Calling Camera.GetCullMask()")
@@ -113,7 +230,7 @@ func (o *Camera) GetCullMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_cull_mask")
+	methodBind := methodBindCameraGetCullMask.Get()
 
 	// Call the parent method.
 	// int
@@ -126,8 +243,8 @@ func (o *Camera) GetCullMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Camera::DopplerTracking
+	        Undocumented
+		Args: [], Returns: enum.Camera::DopplerTracking
 */
 func (o *Camera) GetDopplerTracking() CameraDopplerTracking {
 	//log.Println("Calling Camera.GetDopplerTracking()")
@@ -136,7 +253,7 @@ func (o *Camera) GetDopplerTracking() CameraDopplerTracking {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_doppler_tracking")
+	methodBind := methodBindCameraGetDopplerTracking.Get()
 
 	// Call the parent method.
 	// enum.Camera::DopplerTracking
@@ -149,8 +266,8 @@ func (o *Camera) GetDopplerTracking() CameraDopplerTracking {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Environment
+	        Undocumented
+		Args: [], Returns: Environment
 */
 func (o *Camera) GetEnvironment() EnvironmentImplementer {
 	//log.Println("Calling Camera.GetEnvironment()")
@@ -159,7 +276,7 @@ func (o *Camera) GetEnvironment() EnvironmentImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_environment")
+	methodBind := methodBindCameraGetEnvironment.Get()
 
 	// Call the parent method.
 	// Environment
@@ -167,27 +284,25 @@ func (o *Camera) GetEnvironment() EnvironmentImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEnvironmentFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EnvironmentImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Environment" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EnvironmentImplementer)
+	ret := Environment{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EnvironmentImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera) GetFov() gdnative.Real {
 	//log.Println("Calling Camera.GetFov()")
@@ -196,7 +311,7 @@ func (o *Camera) GetFov() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_fov")
+	methodBind := methodBindCameraGetFov.Get()
 
 	// Call the parent method.
 	// float
@@ -209,8 +324,8 @@ func (o *Camera) GetFov() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera) GetHOffset() gdnative.Real {
 	//log.Println("Calling Camera.GetHOffset()")
@@ -219,7 +334,7 @@ func (o *Camera) GetHOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_h_offset")
+	methodBind := methodBindCameraGetHOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -232,8 +347,8 @@ func (o *Camera) GetHOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Camera::KeepAspect
+	        Undocumented
+		Args: [], Returns: enum.Camera::KeepAspect
 */
 func (o *Camera) GetKeepAspectMode() CameraKeepAspect {
 	//log.Println("Calling Camera.GetKeepAspectMode()")
@@ -242,7 +357,7 @@ func (o *Camera) GetKeepAspectMode() CameraKeepAspect {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_keep_aspect_mode")
+	methodBind := methodBindCameraGetKeepAspectMode.Get()
 
 	// Call the parent method.
 	// enum.Camera::KeepAspect
@@ -255,8 +370,8 @@ func (o *Camera) GetKeepAspectMode() CameraKeepAspect {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Camera::Projection
+	        Undocumented
+		Args: [], Returns: enum.Camera::Projection
 */
 func (o *Camera) GetProjection() CameraProjection {
 	//log.Println("Calling Camera.GetProjection()")
@@ -265,7 +380,7 @@ func (o *Camera) GetProjection() CameraProjection {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_projection")
+	methodBind := methodBindCameraGetProjection.Get()
 
 	// Call the parent method.
 	// enum.Camera::Projection
@@ -278,8 +393,8 @@ func (o *Camera) GetProjection() CameraProjection {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera) GetSize() gdnative.Real {
 	//log.Println("Calling Camera.GetSize()")
@@ -288,7 +403,7 @@ func (o *Camera) GetSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_size")
+	methodBind := methodBindCameraGetSize.Get()
 
 	// Call the parent method.
 	// float
@@ -301,8 +416,8 @@ func (o *Camera) GetSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera) GetVOffset() gdnative.Real {
 	//log.Println("Calling Camera.GetVOffset()")
@@ -311,7 +426,7 @@ func (o *Camera) GetVOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_v_offset")
+	methodBind := methodBindCameraGetVOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -324,8 +439,8 @@ func (o *Camera) GetVOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera) GetZfar() gdnative.Real {
 	//log.Println("Calling Camera.GetZfar()")
@@ -334,7 +449,7 @@ func (o *Camera) GetZfar() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_zfar")
+	methodBind := methodBindCameraGetZfar.Get()
 
 	// Call the parent method.
 	// float
@@ -347,8 +462,8 @@ func (o *Camera) GetZfar() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera) GetZnear() gdnative.Real {
 	//log.Println("Calling Camera.GetZnear()")
@@ -357,7 +472,7 @@ func (o *Camera) GetZnear() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "get_znear")
+	methodBind := methodBindCameraGetZnear.Get()
 
 	// Call the parent method.
 	// float
@@ -370,8 +485,8 @@ func (o *Camera) GetZnear() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera) IsCurrent() gdnative.Bool {
 	//log.Println("Calling Camera.IsCurrent()")
@@ -380,7 +495,7 @@ func (o *Camera) IsCurrent() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "is_current")
+	methodBind := methodBindCameraIsCurrent.Get()
 
 	// Call the parent method.
 	// bool
@@ -393,8 +508,8 @@ func (o *Camera) IsCurrent() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the given position is behind the Camera.
-	Args: [{ false world_point Vector3}], Returns: bool
+	        Returns [code]true[/code] if the given position is behind the Camera.
+		Args: [{ false world_point Vector3}], Returns: bool
 */
 func (o *Camera) IsPositionBehind(worldPoint gdnative.Vector3) gdnative.Bool {
 	//log.Println("Calling Camera.IsPositionBehind()")
@@ -404,7 +519,7 @@ func (o *Camera) IsPositionBehind(worldPoint gdnative.Vector3) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(worldPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "is_position_behind")
+	methodBind := methodBindCameraIsPositionBehind.Get()
 
 	// Call the parent method.
 	// bool
@@ -417,8 +532,8 @@ func (o *Camera) IsPositionBehind(worldPoint gdnative.Vector3) gdnative.Bool {
 }
 
 /*
-        Make this camera the current Camera for the [Viewport] (see class description). If the Camera Node is outside the scene tree, it will attempt to become current once it's added.
-	Args: [], Returns: void
+	        Make this camera the current Camera for the [Viewport] (see class description). If the Camera Node is outside the scene tree, it will attempt to become current once it's added.
+		Args: [], Returns: void
 */
 func (o *Camera) MakeCurrent() {
 	//log.Println("Calling Camera.MakeCurrent()")
@@ -427,7 +542,7 @@ func (o *Camera) MakeCurrent() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "make_current")
+	methodBind := methodBindCameraMakeCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -437,8 +552,8 @@ func (o *Camera) MakeCurrent() {
 }
 
 /*
-        Returns a normal vector from the screen point location directed along the camera. Orthogonal cameras are normalized. Perspective cameras account for perspective, screen width/height, etc.
-	Args: [{ false screen_point Vector2}], Returns: Vector3
+	        Returns a normal vector from the screen point location directed along the camera. Orthogonal cameras are normalized. Perspective cameras account for perspective, screen width/height, etc.
+		Args: [{ false screen_point Vector2}], Returns: Vector3
 */
 func (o *Camera) ProjectLocalRayNormal(screenPoint gdnative.Vector2) gdnative.Vector3 {
 	//log.Println("Calling Camera.ProjectLocalRayNormal()")
@@ -448,7 +563,7 @@ func (o *Camera) ProjectLocalRayNormal(screenPoint gdnative.Vector2) gdnative.Ve
 	ptrArguments[0] = gdnative.NewPointerFromVector2(screenPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "project_local_ray_normal")
+	methodBind := methodBindCameraProjectLocalRayNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -461,8 +576,8 @@ func (o *Camera) ProjectLocalRayNormal(screenPoint gdnative.Vector2) gdnative.Ve
 }
 
 /*
-        Returns how a 2D coordinate in the Viewport rectangle maps to a 3D point in worldspace.
-	Args: [{ false screen_point Vector2}], Returns: Vector3
+	        Returns how a 2D coordinate in the Viewport rectangle maps to a 3D point in worldspace.
+		Args: [{ false screen_point Vector2}], Returns: Vector3
 */
 func (o *Camera) ProjectPosition(screenPoint gdnative.Vector2) gdnative.Vector3 {
 	//log.Println("Calling Camera.ProjectPosition()")
@@ -472,7 +587,7 @@ func (o *Camera) ProjectPosition(screenPoint gdnative.Vector2) gdnative.Vector3
 	ptrArguments[0] = gdnative.NewPointerFromVector2(screenPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "project_position")
+	methodBind := methodBindCameraProjectPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -485,8 +600,8 @@ func (o *Camera) ProjectPosition(screenPoint gdnative.Vector2) gdnative.Vector3
 }
 
 /*
-        Returns a normal vector in worldspace, that is the result of projecting a point on the [Viewport] rectangle by the camera projection. This is useful for casting rays in the form of (origin, normal) for object intersection or picking.
-	Args: [{ false screen_point Vector2}], Returns: Vector3
+	        Returns a normal vector in worldspace, that is the result of projecting a point on the [Viewport] rectangle by the camera projection. This is useful for casting rays in the form of (origin, normal) for object intersection or picking.
+		Args: [{ false screen_point Vector2}], Returns: Vector3
 */
 func (o *Camera) ProjectRayNormal(screenPoint gdnative.Vector2) gdnative.Vector3 {
 	//log.Println("Calling Camera.ProjectRayNormal()")
@@ -496,7 +611,7 @@ func (o *Camera) ProjectRayNormal(screenPoint gdnative.Vector2) gdnative.Vector3
 	ptrArguments[0] = gdnative.NewPointerFromVector2(screenPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "project_ray_normal")
+	methodBind := methodBindCameraProjectRayNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -509,8 +624,8 @@ func (o *Camera) ProjectRayNormal(screenPoint gdnative.Vector2) gdnative.Vector3
 }
 
 /*
-        Returns a 3D position in worldspace, that is the result of projecting a point on the [Viewport] rectangle by the camera projection. This is useful for casting rays in the form of (origin, normal) for object intersection or picking.
-	Args: [{ false screen_point Vector2}], Returns: Vector3
+	        Returns a 3D position in worldspace, that is the result of projecting a point on the [Viewport] rectangle by the camera projection. This is useful for casting rays in the form of (origin, normal) for object intersection or picking.
+		Args: [{ false screen_point Vector2}], Returns: Vector3
 */
 func (o *Camera) ProjectRayOrigin(screenPoint gdnative.Vector2) gdnative.Vector3 {
 	//log.Println("Calling Camera.ProjectRayOrigin()")
@@ -520,7 +635,7 @@ func (o *Camera) ProjectRayOrigin(screenPoint gdnative.Vector2) gdnative.Vector3
 	ptrArguments[0] = gdnative.NewPointerFromVector2(screenPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "project_ray_origin")
+	methodBind := methodBindCameraProjectRayOrigin.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -533,8 +648,8 @@ func (o *Camera) ProjectRayOrigin(screenPoint gdnative.Vector2) gdnative.Vector3
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *Camera) SetCullMask(mask gdnative.Int) {
 	//log.Println("Calling Camera.SetCullMask()")
@@ -544,7 +659,7 @@ func (o *Camera) SetCullMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_cull_mask")
+	methodBind := methodBindCameraSetCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -554,8 +669,8 @@ func (o *Camera) SetCullMask(mask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 bool}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 bool}], Returns: void
 */
 func (o *Camera) SetCurrent(arg0 gdnative.Bool) {
 	//log.Println("Calling Camera.SetCurrent()")
@@ -565,7 +680,7 @@ func (o *Camera) SetCurrent(arg0 gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_current")
+	methodBind := methodBindCameraSetCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -575,18 +690,18 @@ func (o *Camera) SetCurrent(arg0 gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Camera::DopplerTracking}], Returns: void
 */
-func (o *Camera) SetDopplerTracking(mode gdnative.Int) {
+func (o *Camera) SetDopplerTracking(mode CameraDopplerTracking) {
 	//log.Println("Calling Camera.SetDopplerTracking()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_doppler_tracking")
+	methodBind := methodBindCameraSetDopplerTracking.Get()
 
 	// Call the parent method.
 	// void
@@ -596,18 +711,18 @@ func (o *Camera) SetDopplerTracking(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false env Environment}], Returns: void
+	        Undocumented
+		Args: [{ false env Environment}], Returns: void
 */
 func (o *Camera) SetEnvironment(env EnvironmentImplementer) {
 	//log.Println("Calling Camera.SetEnvironment()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(env.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(env))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_environment")
+	methodBind := methodBindCameraSetEnvironment.Get()
 
 	// Call the parent method.
 	// void
@@ -617,8 +732,8 @@ func (o *Camera) SetEnvironment(env EnvironmentImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *Camera) SetFov(arg0 gdnative.Real) {
 	//log.Println("Calling Camera.SetFov()")
@@ -628,7 +743,7 @@ func (o *Camera) SetFov(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_fov")
+	methodBind := methodBindCameraSetFov.Get()
 
 	// Call the parent method.
 	// void
@@ -638,8 +753,8 @@ func (o *Camera) SetFov(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs float}], Returns: void
+	        Undocumented
+		Args: [{ false ofs float}], Returns: void
 */
 func (o *Camera) SetHOffset(ofs gdnative.Real) {
 	//log.Println("Calling Camera.SetHOffset()")
@@ -649,7 +764,7 @@ func (o *Camera) SetHOffset(ofs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_h_offset")
+	methodBind := methodBindCameraSetHOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -659,18 +774,18 @@ func (o *Camera) SetHOffset(ofs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Camera::KeepAspect}], Returns: void
 */
-func (o *Camera) SetKeepAspectMode(mode gdnative.Int) {
+func (o *Camera) SetKeepAspectMode(mode CameraKeepAspect) {
 	//log.Println("Calling Camera.SetKeepAspectMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_keep_aspect_mode")
+	methodBind := methodBindCameraSetKeepAspectMode.Get()
 
 	// Call the parent method.
 	// void
@@ -680,8 +795,8 @@ func (o *Camera) SetKeepAspectMode(mode gdnative.Int) {
 }
 
 /*
-        Set the camera projection to orthogonal mode, by specifying a width and the [i]near[/i] and [i]far[/i] clip planes in worldspace units. (As a hint, 2D games often use this projection, with values specified in pixels)
-	Args: [{ false size float} { false z_near float} { false z_far float}], Returns: void
+	        Set the camera projection to orthogonal mode, by specifying a width and the [i]near[/i] and [i]far[/i] clip planes in worldspace units. (As a hint, 2D games often use this projection, with values specified in pixels)
+		Args: [{ false size float} { false z_near float} { false z_far float}], Returns: void
 */
 func (o *Camera) SetOrthogonal(size gdnative.Real, zNear gdnative.Real, zFar gdnative.Real) {
 	//log.Println("Calling Camera.SetOrthogonal()")
@@ -693,7 +808,7 @@ func (o *Camera) SetOrthogonal(size gdnative.Real, zNear gdnative.Real, zFar gdn
 	ptrArguments[2] = gdnative.NewPointerFromReal(zFar)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_orthogonal")
+	methodBind := methodBindCameraSetOrthogonal.Get()
 
 	// Call the parent method.
 	// void
@@ -703,8 +818,8 @@ func (o *Camera) SetOrthogonal(size gdnative.Real, zNear gdnative.Real, zFar gdn
 }
 
 /*
-        Set the camera projection to perspective mode, by specifying a [i]FOV[/i] Y angle in degrees (FOV means Field of View), and the [i]near[/i] and [i]far[/i] clip planes in worldspace units.
-	Args: [{ false fov float} { false z_near float} { false z_far float}], Returns: void
+	        Set the camera projection to perspective mode, by specifying a [i]FOV[/i] Y angle in degrees (FOV means Field of View), and the [i]near[/i] and [i]far[/i] clip planes in worldspace units.
+		Args: [{ false fov float} { false z_near float} { false z_far float}], Returns: void
 */
 func (o *Camera) SetPerspective(fov gdnative.Real, zNear gdnative.Real, zFar gdnative.Real) {
 	//log.Println("Calling Camera.SetPerspective()")
@@ -716,7 +831,7 @@ func (o *Camera) SetPerspective(fov gdnative.Real, zNear gdnative.Real, zFar gdn
 	ptrArguments[2] = gdnative.NewPointerFromReal(zFar)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_perspective")
+	methodBind := methodBindCameraSetPerspective.Get()
 
 	// Call the parent method.
 	// void
@@ -726,18 +841,18 @@ func (o *Camera) SetPerspective(fov gdnative.Real, zNear gdnative.Real, zFar gdn
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 enum.Camera::Projection}], Returns: void
 */
-func (o *Camera) SetProjection(arg0 gdnative.Int) {
+func (o *Camera) SetProjection(arg0 CameraProjection) {
 	//log.Println("Calling Camera.SetProjection()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_projection")
+	methodBind := methodBindCameraSetProjection.Get()
 
 	// Call the parent method.
 	// void
@@ -747,8 +862,8 @@ func (o *Camera) SetProjection(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *Camera) SetSize(arg0 gdnative.Real) {
 	//log.Println("Calling Camera.SetSize()")
@@ -758,7 +873,7 @@ func (o *Camera) SetSize(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_size")
+	methodBind := methodBindCameraSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -768,8 +883,8 @@ func (o *Camera) SetSize(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs float}], Returns: void
+	        Undocumented
+		Args: [{ false ofs float}], Returns: void
 */
 func (o *Camera) SetVOffset(ofs gdnative.Real) {
 	//log.Println("Calling Camera.SetVOffset()")
@@ -779,7 +894,7 @@ func (o *Camera) SetVOffset(ofs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_v_offset")
+	methodBind := methodBindCameraSetVOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -789,8 +904,8 @@ func (o *Camera) SetVOffset(ofs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *Camera) SetZfar(arg0 gdnative.Real) {
 	//log.Println("Calling Camera.SetZfar()")
@@ -800,7 +915,7 @@ func (o *Camera) SetZfar(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_zfar")
+	methodBind := methodBindCameraSetZfar.Get()
 
 	// Call the parent method.
 	// void
@@ -810,8 +925,8 @@ func (o *Camera) SetZfar(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *Camera) SetZnear(arg0 gdnative.Real) {
 	//log.Println("Calling Camera.SetZnear()")
@@ -821,7 +936,7 @@ func (o *Camera) SetZnear(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "set_znear")
+	methodBind := methodBindCameraSetZnear.Get()
 
 	// Call the parent method.
 	// void
@@ -831,8 +946,8 @@ func (o *Camera) SetZnear(arg0 gdnative.Real) {
 }
 
 /*
-        Returns how a 3D point in worldspace maps to a 2D coordinate in the [Viewport] rectangle.
-	Args: [{ false world_point Vector3}], Returns: Vector2
+	        Returns how a 3D point in worldspace maps to a 2D coordinate in the [Viewport] rectangle.
+		Args: [{ false world_point Vector3}], Returns: Vector2
 */
 func (o *Camera) UnprojectPosition(worldPoint gdnative.Vector3) gdnative.Vector2 {
 	//log.Println("Calling Camera.UnprojectPosition()")
@@ -842,7 +957,7 @@ func (o *Camera) UnprojectPosition(worldPoint gdnative.Vector3) gdnative.Vector2
 	ptrArguments[0] = gdnative.NewPointerFromVector3(worldPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera", "unproject_position")
+	methodBind := methodBindCameraUnprojectPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -854,6 +969,81 @@ func (o *Camera) UnprojectPosition(worldPoint gdnative.Vector3) gdnative.Vector2
 	return ret
 }
 
+// CullMask will return the value of the "cull_mask" property.
+func (o *Camera) CullMask() gdnative.Int {
+	return o.GetCullMask()
+}
+
+// Current will return the value of the "current" property.
+func (o *Camera) Current() gdnative.Bool {
+	return o.IsCurrent()
+}
+
+// DopplerTracking will return the value of the "doppler_tracking" property.
+func (o *Camera) DopplerTracking() CameraDopplerTracking {
+	return o.GetDopplerTracking()
+}
+
+// Environment will return the value of the "environment" property.
+func (o *Camera) Environment() EnvironmentImplementer {
+	return o.GetEnvironment()
+}
+
+// Far will return the value of the "far" property.
+func (o *Camera) Far() gdnative.Real {
+	return o.GetZfar()
+}
+
+// SetFar will set the value of the "far" property.
+func (o *Camera) SetFar(value gdnative.Real) {
+	o.SetZfar(value)
+}
+
+// Fov will return the value of the "fov" property.
+func (o *Camera) Fov() gdnative.Real {
+	return o.GetFov()
+}
+
+// HOffset will return the value of the "h_offset" property.
+func (o *Camera) HOffset() gdnative.Real {
+	return o.GetHOffset()
+}
+
+// KeepAspect will return the value of the "keep_aspect" property.
+func (o *Camera) KeepAspect() CameraKeepAspect {
+	return o.GetKeepAspectMode()
+}
+
+// SetKeepAspect will set the value of the "keep_aspect" property.
+func (o *Camera) SetKeepAspect(value CameraKeepAspect) {
+	o.SetKeepAspectMode(value)
+}
+
+// Near will return the value of the "near" property.
+func (o *Camera) Near() gdnative.Real {
+	return o.GetZnear()
+}
+
+// SetNear will set the value of the "near" property.
+func (o *Camera) SetNear(value gdnative.Real) {
+	o.SetZnear(value)
+}
+
+// Projection will return the value of the "projection" property.
+func (o *Camera) Projection() CameraProjection {
+	return o.GetProjection()
+}
+
+// Size will return the value of the "size" property.
+func (o *Camera) Size() gdnative.Real {
+	return o.GetSize()
+}
+
+// VOffset will return the value of the "v_offset" property.
+func (o *Camera) VOffset() gdnative.Real {
+	return o.GetVOffset()
+}
+
 // CameraImplementer is an interface that implements the methods
 // of the Camera class.
 type CameraImplementer interface {
@@ -861,9 +1051,12 @@ type CameraImplementer interface {
 	ClearCurrent()
 	GetCameraTransform() gdnative.Transform
 	GetCullMask() gdnative.Int
+	GetDopplerTracking() CameraDopplerTracking
 	GetEnvironment() EnvironmentImplementer
 	GetFov() gdnative.Real
 	GetHOffset() gdnative.Real
+	GetKeepAspectMode() CameraKeepAspect
+	GetProjection() CameraProjection
 	GetSize() gdnative.Real
 	GetVOffset() gdnative.Real
 	GetZfar() gdnative.Real
@@ -877,17 +1070,32 @@ type CameraImplementer interface {
 	ProjectRayOrigin(screenPoint gdnative.Vector2) gdnative.Vector3
 	SetCullMask(mask gdnative.Int)
 	SetCurrent(arg0 gdnative.Bool)
-	SetDopplerTracking(mode gdnative.Int)
+	SetDopplerTracking(mode CameraDopplerTracking)
 	SetEnvironment(env EnvironmentImplementer)
 	SetFov(arg0 gdnative.Real)
 	SetHOffset(ofs gdnative.Real)
-	SetKeepAspectMode(mode gdnative.Int)
+	SetKeepAspectMode(mode CameraKeepAspect)
 	SetOrthogonal(size gdnative.Real, zNear gdnative.Real, zFar gdnative.Real)
 	SetPerspective(fov gdnative.Real, zNear gdnative.Real, zFar gdnative.Real)
-	SetProjection(arg0 gdnative.Int)
+	SetProjection(arg0 CameraProjection)
 	SetSize(arg0 gdnative.Real)
 	SetVOffset(ofs gdnative.Real)
 	SetZfar(arg0 gdnative.Real)
 	SetZnear(arg0 gdnative.Real)
 	UnprojectPosition(worldPoint gdnative.Vector3) gdnative.Vector2
+	CullMask() gdnative.Int
+	Current() gdnative.Bool
+	DopplerTracking() CameraDopplerTracking
+	Environment() EnvironmentImplementer
+	Far() gdnative.Real
+	SetFar(value gdnative.Real)
+	Fov() gdnative.Real
+	HOffset() gdnative.Real
+	KeepAspect() CameraKeepAspect
+	SetKeepAspect(value CameraKeepAspect)
+	Near() gdnative.Real
+	SetNear(value gdnative.Real)
+	Projection() CameraProjection
+	Size() gdnative.Real
+	VOffset() gdnative.Real
 }
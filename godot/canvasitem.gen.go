@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -24,15 +26,49 @@ const (
 	CanvasItemBlendModeSub          CanvasItemBlendMode = 2
 )
 
-//func NewCanvasItemFromPointer(ptr gdnative.Pointer) CanvasItem {
-func newCanvasItemFromPointer(ptr gdnative.Pointer) CanvasItem {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CanvasItem{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the CanvasItemBlendMode value.
+func (e CanvasItemBlendMode) String() string {
+	switch e {
+	case CanvasItemBlendModeAdd:
+		return "BLEND_MODE_ADD"
+	case CanvasItemBlendModeMix:
+		return "BLEND_MODE_MIX"
+	case CanvasItemBlendModeMul:
+		return "BLEND_MODE_MUL"
+	case CanvasItemBlendModePremultAlpha:
+		return "BLEND_MODE_PREMULT_ALPHA"
+	case CanvasItemBlendModeSub:
+		return "BLEND_MODE_SUB"
+	}
+	return fmt.Sprintf("CanvasItemBlendMode(%d)", int(e))
+}
+
+// ParseCanvasItemBlendMode will return the CanvasItemBlendMode value with the given Godot name.
+func ParseCanvasItemBlendMode(name string) (CanvasItemBlendMode, error) {
+	switch name {
+	case "BLEND_MODE_ADD":
+		return CanvasItemBlendModeAdd, nil
+	case "BLEND_MODE_MIX":
+		return CanvasItemBlendModeMix, nil
+	case "BLEND_MODE_MUL":
+		return CanvasItemBlendModeMul, nil
+	case "BLEND_MODE_PREMULT_ALPHA":
+		return CanvasItemBlendModePremultAlpha, nil
+	case "BLEND_MODE_SUB":
+		return CanvasItemBlendModeSub, nil
+	}
+	return 0, fmt.Errorf("invalid CanvasItemBlendMode value %q", name)
 }
 
+// Constants of the CanvasItem class.
+const (
+	CanvasItemNotificationDraw              gdnative.Int = 30
+	CanvasItemNotificationEnterCanvas       gdnative.Int = 32
+	CanvasItemNotificationExitCanvas        gdnative.Int = 33
+	CanvasItemNotificationTransformChanged  gdnative.Int = 29
+	CanvasItemNotificationVisibilityChanged gdnative.Int = 31
+)
+
 /*
 Base class of anything 2D. Canvas items are laid out in a tree and children inherit and extend the transform of their parent. CanvasItem is extended by [Control], for anything GUI related, and by [Node2D] for anything 2D engine related. Any CanvasItem can draw. For this, the "update" function must be called, then NOTIFICATION_DRAW will be received on idle time to request redraw. Because of this, canvas items don't need to be redraw on every frame, improving the performance significantly. Several functions for drawing on the CanvasItem are provided (see draw_* functions). They can only be used inside the notification, signal or _draw() overrides function, though. Canvas items are draw in tree order. By default, children are on top of their parents so a root CanvasItem will be drawn behind everything (this can be changed per item though). Canvas items can also be hidden (hiding also their subtree). They provide many means for changing standard parameters such as opacity (for it and the subtree) and self opacity, blend mode. Ultimately, a transform notification can be requested, which will notify the node that its global position changed in case the parent tree changed.
 */
@@ -45,9 +81,89 @@ func (o *CanvasItem) BaseClass() string {
 	return "CanvasItem"
 }
 
+// Method binds of the CanvasItem class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCanvasItemX_Draw                              = gdnative.NewLazyMethodBind("CanvasItem", "_draw")
+	methodBindCanvasItemX_EditGetItemAndChildrenRect        = gdnative.NewLazyMethodBind("CanvasItem", "_edit_get_item_and_children_rect")
+	methodBindCanvasItemX_EditGetPivot                      = gdnative.NewLazyMethodBind("CanvasItem", "_edit_get_pivot")
+	methodBindCanvasItemX_EditGetPosition                   = gdnative.NewLazyMethodBind("CanvasItem", "_edit_get_position")
+	methodBindCanvasItemX_EditGetRect                       = gdnative.NewLazyMethodBind("CanvasItem", "_edit_get_rect")
+	methodBindCanvasItemX_EditGetRotation                   = gdnative.NewLazyMethodBind("CanvasItem", "_edit_get_rotation")
+	methodBindCanvasItemX_EditGetState                      = gdnative.NewLazyMethodBind("CanvasItem", "_edit_get_state")
+	methodBindCanvasItemX_EditSetPivot                      = gdnative.NewLazyMethodBind("CanvasItem", "_edit_set_pivot")
+	methodBindCanvasItemX_EditSetPosition                   = gdnative.NewLazyMethodBind("CanvasItem", "_edit_set_position")
+	methodBindCanvasItemX_EditSetRect                       = gdnative.NewLazyMethodBind("CanvasItem", "_edit_set_rect")
+	methodBindCanvasItemX_EditSetRotation                   = gdnative.NewLazyMethodBind("CanvasItem", "_edit_set_rotation")
+	methodBindCanvasItemX_EditSetState                      = gdnative.NewLazyMethodBind("CanvasItem", "_edit_set_state")
+	methodBindCanvasItemX_EditUsePivot                      = gdnative.NewLazyMethodBind("CanvasItem", "_edit_use_pivot")
+	methodBindCanvasItemX_EditUsePosition                   = gdnative.NewLazyMethodBind("CanvasItem", "_edit_use_position")
+	methodBindCanvasItemX_EditUseRect                       = gdnative.NewLazyMethodBind("CanvasItem", "_edit_use_rect")
+	methodBindCanvasItemX_EditUseRotation                   = gdnative.NewLazyMethodBind("CanvasItem", "_edit_use_rotation")
+	methodBindCanvasItemX_IsOnTop                           = gdnative.NewLazyMethodBind("CanvasItem", "_is_on_top")
+	methodBindCanvasItemX_SetOnTop                          = gdnative.NewLazyMethodBind("CanvasItem", "_set_on_top")
+	methodBindCanvasItemX_ToplevelRaiseSelf                 = gdnative.NewLazyMethodBind("CanvasItem", "_toplevel_raise_self")
+	methodBindCanvasItemX_UpdateCallback                    = gdnative.NewLazyMethodBind("CanvasItem", "_update_callback")
+	methodBindCanvasItemDrawChar                            = gdnative.NewLazyMethodBind("CanvasItem", "draw_char")
+	methodBindCanvasItemDrawCircle                          = gdnative.NewLazyMethodBind("CanvasItem", "draw_circle")
+	methodBindCanvasItemDrawColoredPolygon                  = gdnative.NewLazyMethodBind("CanvasItem", "draw_colored_polygon")
+	methodBindCanvasItemDrawLine                            = gdnative.NewLazyMethodBind("CanvasItem", "draw_line")
+	methodBindCanvasItemDrawMultiline                       = gdnative.NewLazyMethodBind("CanvasItem", "draw_multiline")
+	methodBindCanvasItemDrawMultilineColors                 = gdnative.NewLazyMethodBind("CanvasItem", "draw_multiline_colors")
+	methodBindCanvasItemDrawPolygon                         = gdnative.NewLazyMethodBind("CanvasItem", "draw_polygon")
+	methodBindCanvasItemDrawPolyline                        = gdnative.NewLazyMethodBind("CanvasItem", "draw_polyline")
+	methodBindCanvasItemDrawPolylineColors                  = gdnative.NewLazyMethodBind("CanvasItem", "draw_polyline_colors")
+	methodBindCanvasItemDrawPrimitive                       = gdnative.NewLazyMethodBind("CanvasItem", "draw_primitive")
+	methodBindCanvasItemDrawRect                            = gdnative.NewLazyMethodBind("CanvasItem", "draw_rect")
+	methodBindCanvasItemDrawSetTransform                    = gdnative.NewLazyMethodBind("CanvasItem", "draw_set_transform")
+	methodBindCanvasItemDrawSetTransformMatrix              = gdnative.NewLazyMethodBind("CanvasItem", "draw_set_transform_matrix")
+	methodBindCanvasItemDrawString                          = gdnative.NewLazyMethodBind("CanvasItem", "draw_string")
+	methodBindCanvasItemDrawStyleBox                        = gdnative.NewLazyMethodBind("CanvasItem", "draw_style_box")
+	methodBindCanvasItemDrawTexture                         = gdnative.NewLazyMethodBind("CanvasItem", "draw_texture")
+	methodBindCanvasItemDrawTextureRect                     = gdnative.NewLazyMethodBind("CanvasItem", "draw_texture_rect")
+	methodBindCanvasItemDrawTextureRectRegion               = gdnative.NewLazyMethodBind("CanvasItem", "draw_texture_rect_region")
+	methodBindCanvasItemGetCanvas                           = gdnative.NewLazyMethodBind("CanvasItem", "get_canvas")
+	methodBindCanvasItemGetCanvasItem                       = gdnative.NewLazyMethodBind("CanvasItem", "get_canvas_item")
+	methodBindCanvasItemGetCanvasTransform                  = gdnative.NewLazyMethodBind("CanvasItem", "get_canvas_transform")
+	methodBindCanvasItemGetGlobalMousePosition              = gdnative.NewLazyMethodBind("CanvasItem", "get_global_mouse_position")
+	methodBindCanvasItemGetGlobalTransform                  = gdnative.NewLazyMethodBind("CanvasItem", "get_global_transform")
+	methodBindCanvasItemGetGlobalTransformWithCanvas        = gdnative.NewLazyMethodBind("CanvasItem", "get_global_transform_with_canvas")
+	methodBindCanvasItemGetLightMask                        = gdnative.NewLazyMethodBind("CanvasItem", "get_light_mask")
+	methodBindCanvasItemGetLocalMousePosition               = gdnative.NewLazyMethodBind("CanvasItem", "get_local_mouse_position")
+	methodBindCanvasItemGetMaterial                         = gdnative.NewLazyMethodBind("CanvasItem", "get_material")
+	methodBindCanvasItemGetModulate                         = gdnative.NewLazyMethodBind("CanvasItem", "get_modulate")
+	methodBindCanvasItemGetSelfModulate                     = gdnative.NewLazyMethodBind("CanvasItem", "get_self_modulate")
+	methodBindCanvasItemGetTransform                        = gdnative.NewLazyMethodBind("CanvasItem", "get_transform")
+	methodBindCanvasItemGetUseParentMaterial                = gdnative.NewLazyMethodBind("CanvasItem", "get_use_parent_material")
+	methodBindCanvasItemGetViewportRect                     = gdnative.NewLazyMethodBind("CanvasItem", "get_viewport_rect")
+	methodBindCanvasItemGetViewportTransform                = gdnative.NewLazyMethodBind("CanvasItem", "get_viewport_transform")
+	methodBindCanvasItemGetWorld2D                          = gdnative.NewLazyMethodBind("CanvasItem", "get_world_2d")
+	methodBindCanvasItemHide                                = gdnative.NewLazyMethodBind("CanvasItem", "hide")
+	methodBindCanvasItemIsDrawBehindParentEnabled           = gdnative.NewLazyMethodBind("CanvasItem", "is_draw_behind_parent_enabled")
+	methodBindCanvasItemIsLocalTransformNotificationEnabled = gdnative.NewLazyMethodBind("CanvasItem", "is_local_transform_notification_enabled")
+	methodBindCanvasItemIsSetAsToplevel                     = gdnative.NewLazyMethodBind("CanvasItem", "is_set_as_toplevel")
+	methodBindCanvasItemIsTransformNotificationEnabled      = gdnative.NewLazyMethodBind("CanvasItem", "is_transform_notification_enabled")
+	methodBindCanvasItemIsVisible                           = gdnative.NewLazyMethodBind("CanvasItem", "is_visible")
+	methodBindCanvasItemIsVisibleInTree                     = gdnative.NewLazyMethodBind("CanvasItem", "is_visible_in_tree")
+	methodBindCanvasItemMakeCanvasPositionLocal             = gdnative.NewLazyMethodBind("CanvasItem", "make_canvas_position_local")
+	methodBindCanvasItemMakeInputLocal                      = gdnative.NewLazyMethodBind("CanvasItem", "make_input_local")
+	methodBindCanvasItemSetAsToplevel                       = gdnative.NewLazyMethodBind("CanvasItem", "set_as_toplevel")
+	methodBindCanvasItemSetDrawBehindParent                 = gdnative.NewLazyMethodBind("CanvasItem", "set_draw_behind_parent")
+	methodBindCanvasItemSetLightMask                        = gdnative.NewLazyMethodBind("CanvasItem", "set_light_mask")
+	methodBindCanvasItemSetMaterial                         = gdnative.NewLazyMethodBind("CanvasItem", "set_material")
+	methodBindCanvasItemSetModulate                         = gdnative.NewLazyMethodBind("CanvasItem", "set_modulate")
+	methodBindCanvasItemSetNotifyLocalTransform             = gdnative.NewLazyMethodBind("CanvasItem", "set_notify_local_transform")
+	methodBindCanvasItemSetNotifyTransform                  = gdnative.NewLazyMethodBind("CanvasItem", "set_notify_transform")
+	methodBindCanvasItemSetSelfModulate                     = gdnative.NewLazyMethodBind("CanvasItem", "set_self_modulate")
+	methodBindCanvasItemSetUseParentMaterial                = gdnative.NewLazyMethodBind("CanvasItem", "set_use_parent_material")
+	methodBindCanvasItemSetVisible                          = gdnative.NewLazyMethodBind("CanvasItem", "set_visible")
+	methodBindCanvasItemShow                                = gdnative.NewLazyMethodBind("CanvasItem", "show")
+	methodBindCanvasItemUpdate                              = gdnative.NewLazyMethodBind("CanvasItem", "update")
+)
+
 /*
-        Called (if exists) to draw the canvas item.
-	Args: [], Returns: void
+	        Called (if exists) to draw the canvas item.
+		Args: [], Returns: void
 */
 func (o *CanvasItem) X_Draw() {
 	//log.Println("Calling CanvasItem.X_Draw()")
@@ -56,7 +172,7 @@ func (o *CanvasItem) X_Draw() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_draw")
+	methodBind := methodBindCanvasItemX_Draw.Get()
 
 	// Call the parent method.
 	// void
@@ -66,8 +182,8 @@ func (o *CanvasItem) X_Draw() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *CanvasItem) X_EditGetItemAndChildrenRect() gdnative.Rect2 {
 	//log.Println("Calling CanvasItem.X_EditGetItemAndChildrenRect()")
@@ -76,7 +192,7 @@ func (o *CanvasItem) X_EditGetItemAndChildrenRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_get_item_and_children_rect")
+	methodBind := methodBindCanvasItemX_EditGetItemAndChildrenRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -89,8 +205,8 @@ func (o *CanvasItem) X_EditGetItemAndChildrenRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *CanvasItem) X_EditGetPivot() gdnative.Vector2 {
 	//log.Println("Calling CanvasItem.X_EditGetPivot()")
@@ -99,7 +215,7 @@ func (o *CanvasItem) X_EditGetPivot() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_get_pivot")
+	methodBind := methodBindCanvasItemX_EditGetPivot.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -112,8 +228,8 @@ func (o *CanvasItem) X_EditGetPivot() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *CanvasItem) X_EditGetPosition() gdnative.Vector2 {
 	//log.Println("Calling CanvasItem.X_EditGetPosition()")
@@ -122,7 +238,7 @@ func (o *CanvasItem) X_EditGetPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_get_position")
+	methodBind := methodBindCanvasItemX_EditGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -135,8 +251,8 @@ func (o *CanvasItem) X_EditGetPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *CanvasItem) X_EditGetRect() gdnative.Rect2 {
 	//log.Println("Calling CanvasItem.X_EditGetRect()")
@@ -145,7 +261,7 @@ func (o *CanvasItem) X_EditGetRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_get_rect")
+	methodBind := methodBindCanvasItemX_EditGetRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -158,8 +274,8 @@ func (o *CanvasItem) X_EditGetRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CanvasItem) X_EditGetRotation() gdnative.Real {
 	//log.Println("Calling CanvasItem.X_EditGetRotation()")
@@ -168,7 +284,7 @@ func (o *CanvasItem) X_EditGetRotation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_get_rotation")
+	methodBind := methodBindCanvasItemX_EditGetRotation.Get()
 
 	// Call the parent method.
 	// float
@@ -181,8 +297,8 @@ func (o *CanvasItem) X_EditGetRotation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *CanvasItem) X_EditGetState() gdnative.Dictionary {
 	//log.Println("Calling CanvasItem.X_EditGetState()")
@@ -191,7 +307,7 @@ func (o *CanvasItem) X_EditGetState() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_get_state")
+	methodBind := methodBindCanvasItemX_EditGetState.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -204,8 +320,8 @@ func (o *CanvasItem) X_EditGetState() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false pivot Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false pivot Vector2}], Returns: void
 */
 func (o *CanvasItem) X_EditSetPivot(pivot gdnative.Vector2) {
 	//log.Println("Calling CanvasItem.X_EditSetPivot()")
@@ -215,7 +331,7 @@ func (o *CanvasItem) X_EditSetPivot(pivot gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(pivot)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_set_pivot")
+	methodBind := methodBindCanvasItemX_EditSetPivot.Get()
 
 	// Call the parent method.
 	// void
@@ -225,8 +341,8 @@ func (o *CanvasItem) X_EditSetPivot(pivot gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *CanvasItem) X_EditSetPosition(position gdnative.Vector2) {
 	//log.Println("Calling CanvasItem.X_EditSetPosition()")
@@ -236,7 +352,7 @@ func (o *CanvasItem) X_EditSetPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_set_position")
+	methodBind := methodBindCanvasItemX_EditSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -246,8 +362,8 @@ func (o *CanvasItem) X_EditSetPosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rect Rect2}], Returns: void
+	        Undocumented
+		Args: [{ false rect Rect2}], Returns: void
 */
 func (o *CanvasItem) X_EditSetRect(rect gdnative.Rect2) {
 	//log.Println("Calling CanvasItem.X_EditSetRect()")
@@ -257,7 +373,7 @@ func (o *CanvasItem) X_EditSetRect(rect gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_set_rect")
+	methodBind := methodBindCanvasItemX_EditSetRect.Get()
 
 	// Call the parent method.
 	// void
@@ -267,8 +383,8 @@ func (o *CanvasItem) X_EditSetRect(rect gdnative.Rect2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *CanvasItem) X_EditSetRotation(degrees gdnative.Real) {
 	//log.Println("Calling CanvasItem.X_EditSetRotation()")
@@ -278,7 +394,7 @@ func (o *CanvasItem) X_EditSetRotation(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_set_rotation")
+	methodBind := methodBindCanvasItemX_EditSetRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -288,8 +404,8 @@ func (o *CanvasItem) X_EditSetRotation(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false state Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false state Dictionary}], Returns: void
 */
 func (o *CanvasItem) X_EditSetState(state gdnative.Dictionary) {
 	//log.Println("Calling CanvasItem.X_EditSetState()")
@@ -299,7 +415,7 @@ func (o *CanvasItem) X_EditSetState(state gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(state)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_set_state")
+	methodBind := methodBindCanvasItemX_EditSetState.Get()
 
 	// Call the parent method.
 	// void
@@ -309,8 +425,8 @@ func (o *CanvasItem) X_EditSetState(state gdnative.Dictionary) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) X_EditUsePivot() gdnative.Bool {
 	//log.Println("Calling CanvasItem.X_EditUsePivot()")
@@ -319,7 +435,7 @@ func (o *CanvasItem) X_EditUsePivot() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_use_pivot")
+	methodBind := methodBindCanvasItemX_EditUsePivot.Get()
 
 	// Call the parent method.
 	// bool
@@ -332,8 +448,8 @@ func (o *CanvasItem) X_EditUsePivot() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) X_EditUsePosition() gdnative.Bool {
 	//log.Println("Calling CanvasItem.X_EditUsePosition()")
@@ -342,7 +458,7 @@ func (o *CanvasItem) X_EditUsePosition() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_use_position")
+	methodBind := methodBindCanvasItemX_EditUsePosition.Get()
 
 	// Call the parent method.
 	// bool
@@ -355,8 +471,8 @@ func (o *CanvasItem) X_EditUsePosition() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) X_EditUseRect() gdnative.Bool {
 	//log.Println("Calling CanvasItem.X_EditUseRect()")
@@ -365,7 +481,7 @@ func (o *CanvasItem) X_EditUseRect() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_use_rect")
+	methodBind := methodBindCanvasItemX_EditUseRect.Get()
 
 	// Call the parent method.
 	// bool
@@ -378,8 +494,8 @@ func (o *CanvasItem) X_EditUseRect() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) X_EditUseRotation() gdnative.Bool {
 	//log.Println("Calling CanvasItem.X_EditUseRotation()")
@@ -388,7 +504,7 @@ func (o *CanvasItem) X_EditUseRotation() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_edit_use_rotation")
+	methodBind := methodBindCanvasItemX_EditUseRotation.Get()
 
 	// Call the parent method.
 	// bool
@@ -401,8 +517,8 @@ func (o *CanvasItem) X_EditUseRotation() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) X_IsOnTop() gdnative.Bool {
 	//log.Println("Calling CanvasItem.X_IsOnTop()")
@@ -411,7 +527,7 @@ func (o *CanvasItem) X_IsOnTop() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_is_on_top")
+	methodBind := methodBindCanvasItemX_IsOnTop.Get()
 
 	// Call the parent method.
 	// bool
@@ -424,8 +540,8 @@ func (o *CanvasItem) X_IsOnTop() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false on_top bool}], Returns: void
+	        Undocumented
+		Args: [{ false on_top bool}], Returns: void
 */
 func (o *CanvasItem) X_SetOnTop(onTop gdnative.Bool) {
 	//log.Println("Calling CanvasItem.X_SetOnTop()")
@@ -435,7 +551,7 @@ func (o *CanvasItem) X_SetOnTop(onTop gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(onTop)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_set_on_top")
+	methodBind := methodBindCanvasItemX_SetOnTop.Get()
 
 	// Call the parent method.
 	// void
@@ -445,8 +561,8 @@ func (o *CanvasItem) X_SetOnTop(onTop gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *CanvasItem) X_ToplevelRaiseSelf() {
 	//log.Println("Calling CanvasItem.X_ToplevelRaiseSelf()")
@@ -455,7 +571,7 @@ func (o *CanvasItem) X_ToplevelRaiseSelf() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_toplevel_raise_self")
+	methodBind := methodBindCanvasItemX_ToplevelRaiseSelf.Get()
 
 	// Call the parent method.
 	// void
@@ -465,8 +581,8 @@ func (o *CanvasItem) X_ToplevelRaiseSelf() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *CanvasItem) X_UpdateCallback() {
 	//log.Println("Calling CanvasItem.X_UpdateCallback()")
@@ -475,7 +591,7 @@ func (o *CanvasItem) X_UpdateCallback() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "_update_callback")
+	methodBind := methodBindCanvasItemX_UpdateCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -485,22 +601,22 @@ func (o *CanvasItem) X_UpdateCallback() {
 }
 
 /*
-        Draws a string character using a custom font. Returns the advance, depending on the char width and kerning with an optional next char.
-	Args: [{ false font Font} { false position Vector2} { false char String} { false next String} {1,1,1,1 true modulate Color}], Returns: float
+	        Draws a string character using a custom font. Returns the advance, depending on the char width and kerning with an optional next char.
+		Args: [{ false font Font} { false position Vector2} { false char String} { false next String} {1,1,1,1 true modulate Color}], Returns: float
 */
 func (o *CanvasItem) DrawChar(font FontImplementer, position gdnative.Vector2, char gdnative.String, next gdnative.String, modulate gdnative.Color) gdnative.Real {
 	//log.Println("Calling CanvasItem.DrawChar()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
-	ptrArguments[0] = gdnative.NewPointerFromObject(font.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(font))
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 	ptrArguments[2] = gdnative.NewPointerFromString(char)
 	ptrArguments[3] = gdnative.NewPointerFromString(next)
 	ptrArguments[4] = gdnative.NewPointerFromColor(modulate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_char")
+	methodBind := methodBindCanvasItemDrawChar.Get()
 
 	// Call the parent method.
 	// float
@@ -513,8 +629,8 @@ func (o *CanvasItem) DrawChar(font FontImplementer, position gdnative.Vector2, c
 }
 
 /*
-        Draws a colored circle.
-	Args: [{ false position Vector2} { false radius float} { false color Color}], Returns: void
+	        Draws a colored circle.
+		Args: [{ false position Vector2} { false radius float} { false color Color}], Returns: void
 */
 func (o *CanvasItem) DrawCircle(position gdnative.Vector2, radius gdnative.Real, color gdnative.Color) {
 	//log.Println("Calling CanvasItem.DrawCircle()")
@@ -526,7 +642,7 @@ func (o *CanvasItem) DrawCircle(position gdnative.Vector2, radius gdnative.Real,
 	ptrArguments[2] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_circle")
+	methodBind := methodBindCanvasItemDrawCircle.Get()
 
 	// Call the parent method.
 	// void
@@ -536,8 +652,8 @@ func (o *CanvasItem) DrawCircle(position gdnative.Vector2, radius gdnative.Real,
 }
 
 /*
-        Draws a colored polygon of any amount of points, convex or concave.
-	Args: [{ false points PoolVector2Array} { false color Color} {[] true uvs PoolVector2Array} {Null true texture Texture} {Null true normal_map Texture} {False true antialiased bool}], Returns: void
+	        Draws a colored polygon of any amount of points, convex or concave.
+		Args: [{ false points PoolVector2Array} { false color Color} {[] true uvs PoolVector2Array} {Null true texture Texture} {Null true normal_map Texture} {False true antialiased bool}], Returns: void
 */
 func (o *CanvasItem) DrawColoredPolygon(points gdnative.PoolVector2Array, color gdnative.Color, uvs gdnative.PoolVector2Array, texture TextureImplementer, normalMap TextureImplementer, antialiased gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawColoredPolygon()")
@@ -547,12 +663,12 @@ func (o *CanvasItem) DrawColoredPolygon(points gdnative.PoolVector2Array, color
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(points)
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 	ptrArguments[2] = gdnative.NewPointerFromPoolVector2Array(uvs)
-	ptrArguments[3] = gdnative.NewPointerFromObject(texture.GetBaseObject())
-	ptrArguments[4] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[3] = gdnative.NewPointerFromObject(GetBaseObject(texture))
+	ptrArguments[4] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 	ptrArguments[5] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_colored_polygon")
+	methodBind := methodBindCanvasItemDrawColoredPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -562,8 +678,8 @@ func (o *CanvasItem) DrawColoredPolygon(points gdnative.PoolVector2Array, color
 }
 
 /*
-        Draws a line from a 2D point to another, with a given color and width. It can be optionally antialiased.
-	Args: [{ false from Vector2} { false to Vector2} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
+	        Draws a line from a 2D point to another, with a given color and width. It can be optionally antialiased.
+		Args: [{ false from Vector2} { false to Vector2} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
 */
 func (o *CanvasItem) DrawLine(from gdnative.Vector2, to gdnative.Vector2, color gdnative.Color, width gdnative.Real, antialiased gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawLine()")
@@ -577,7 +693,7 @@ func (o *CanvasItem) DrawLine(from gdnative.Vector2, to gdnative.Vector2, color
 	ptrArguments[4] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_line")
+	methodBind := methodBindCanvasItemDrawLine.Get()
 
 	// Call the parent method.
 	// void
@@ -587,8 +703,8 @@ func (o *CanvasItem) DrawLine(from gdnative.Vector2, to gdnative.Vector2, color
 }
 
 /*
-        Draws multiple, parallel lines with a uniform [code]color[/code] and [code]width[/code] and optional antialiasing.
-	Args: [{ false points PoolVector2Array} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
+	        Draws multiple, parallel lines with a uniform [code]color[/code] and [code]width[/code] and optional antialiasing.
+		Args: [{ false points PoolVector2Array} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
 */
 func (o *CanvasItem) DrawMultiline(points gdnative.PoolVector2Array, color gdnative.Color, width gdnative.Real, antialiased gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawMultiline()")
@@ -601,7 +717,7 @@ func (o *CanvasItem) DrawMultiline(points gdnative.PoolVector2Array, color gdnat
 	ptrArguments[3] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_multiline")
+	methodBind := methodBindCanvasItemDrawMultiline.Get()
 
 	// Call the parent method.
 	// void
@@ -611,8 +727,8 @@ func (o *CanvasItem) DrawMultiline(points gdnative.PoolVector2Array, color gdnat
 }
 
 /*
-        Draws multiple, parallel lines with a uniform [code]width[/code], segment-by-segment coloring, and optional antialiasing. Colors assigned to line segments match by index between [code]points[/code] and [code]colors[/code].
-	Args: [{ false points PoolVector2Array} { false colors PoolColorArray} {1 true width float} {False true antialiased bool}], Returns: void
+	        Draws multiple, parallel lines with a uniform [code]width[/code], segment-by-segment coloring, and optional antialiasing. Colors assigned to line segments match by index between [code]points[/code] and [code]colors[/code].
+		Args: [{ false points PoolVector2Array} { false colors PoolColorArray} {1 true width float} {False true antialiased bool}], Returns: void
 */
 func (o *CanvasItem) DrawMultilineColors(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, width gdnative.Real, antialiased gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawMultilineColors()")
@@ -625,7 +741,7 @@ func (o *CanvasItem) DrawMultilineColors(points gdnative.PoolVector2Array, color
 	ptrArguments[3] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_multiline_colors")
+	methodBind := methodBindCanvasItemDrawMultilineColors.Get()
 
 	// Call the parent method.
 	// void
@@ -635,8 +751,8 @@ func (o *CanvasItem) DrawMultilineColors(points gdnative.PoolVector2Array, color
 }
 
 /*
-        Draws a polygon of any amount of points, convex or concave.
-	Args: [{ false points PoolVector2Array} { false colors PoolColorArray} {[] true uvs PoolVector2Array} {Null true texture Texture} {Null true normal_map Texture} {False true antialiased bool}], Returns: void
+	        Draws a polygon of any amount of points, convex or concave.
+		Args: [{ false points PoolVector2Array} { false colors PoolColorArray} {[] true uvs PoolVector2Array} {Null true texture Texture} {Null true normal_map Texture} {False true antialiased bool}], Returns: void
 */
 func (o *CanvasItem) DrawPolygon(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array, texture TextureImplementer, normalMap TextureImplementer, antialiased gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawPolygon()")
@@ -646,12 +762,12 @@ func (o *CanvasItem) DrawPolygon(points gdnative.PoolVector2Array, colors gdnati
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(points)
 	ptrArguments[1] = gdnative.NewPointerFromPoolColorArray(colors)
 	ptrArguments[2] = gdnative.NewPointerFromPoolVector2Array(uvs)
-	ptrArguments[3] = gdnative.NewPointerFromObject(texture.GetBaseObject())
-	ptrArguments[4] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[3] = gdnative.NewPointerFromObject(GetBaseObject(texture))
+	ptrArguments[4] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 	ptrArguments[5] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_polygon")
+	methodBind := methodBindCanvasItemDrawPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -661,8 +777,8 @@ func (o *CanvasItem) DrawPolygon(points gdnative.PoolVector2Array, colors gdnati
 }
 
 /*
-        Draws interconnected line segments with a uniform [code]color[/code] and [code]width[/code] and optional antialiasing.
-	Args: [{ false points PoolVector2Array} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
+	        Draws interconnected line segments with a uniform [code]color[/code] and [code]width[/code] and optional antialiasing.
+		Args: [{ false points PoolVector2Array} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
 */
 func (o *CanvasItem) DrawPolyline(points gdnative.PoolVector2Array, color gdnative.Color, width gdnative.Real, antialiased gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawPolyline()")
@@ -675,7 +791,7 @@ func (o *CanvasItem) DrawPolyline(points gdnative.PoolVector2Array, color gdnati
 	ptrArguments[3] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_polyline")
+	methodBind := methodBindCanvasItemDrawPolyline.Get()
 
 	// Call the parent method.
 	// void
@@ -685,8 +801,8 @@ func (o *CanvasItem) DrawPolyline(points gdnative.PoolVector2Array, color gdnati
 }
 
 /*
-        Draws interconnected line segments with a uniform [code]width[/code], segment-by-segment coloring, and optional antialiasing. Colors assigned to line segments match by index between [code]points[/code] and [code]colors[/code].
-	Args: [{ false points PoolVector2Array} { false colors PoolColorArray} {1 true width float} {False true antialiased bool}], Returns: void
+	        Draws interconnected line segments with a uniform [code]width[/code], segment-by-segment coloring, and optional antialiasing. Colors assigned to line segments match by index between [code]points[/code] and [code]colors[/code].
+		Args: [{ false points PoolVector2Array} { false colors PoolColorArray} {1 true width float} {False true antialiased bool}], Returns: void
 */
 func (o *CanvasItem) DrawPolylineColors(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, width gdnative.Real, antialiased gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawPolylineColors()")
@@ -699,7 +815,7 @@ func (o *CanvasItem) DrawPolylineColors(points gdnative.PoolVector2Array, colors
 	ptrArguments[3] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_polyline_colors")
+	methodBind := methodBindCanvasItemDrawPolylineColors.Get()
 
 	// Call the parent method.
 	// void
@@ -709,8 +825,8 @@ func (o *CanvasItem) DrawPolylineColors(points gdnative.PoolVector2Array, colors
 }
 
 /*
-        Draws a custom primitive, 1 point for a point, 2 points for a line, 3 points for a triangle and 4 points for a quad.
-	Args: [{ false points PoolVector2Array} { false colors PoolColorArray} { false uvs PoolVector2Array} {Null true texture Texture} {1 true width float} {Null true normal_map Texture}], Returns: void
+	        Draws a custom primitive, 1 point for a point, 2 points for a line, 3 points for a triangle and 4 points for a quad.
+		Args: [{ false points PoolVector2Array} { false colors PoolColorArray} { false uvs PoolVector2Array} {Null true texture Texture} {1 true width float} {Null true normal_map Texture}], Returns: void
 */
 func (o *CanvasItem) DrawPrimitive(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array, texture TextureImplementer, width gdnative.Real, normalMap TextureImplementer) {
 	//log.Println("Calling CanvasItem.DrawPrimitive()")
@@ -720,12 +836,12 @@ func (o *CanvasItem) DrawPrimitive(points gdnative.PoolVector2Array, colors gdna
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(points)
 	ptrArguments[1] = gdnative.NewPointerFromPoolColorArray(colors)
 	ptrArguments[2] = gdnative.NewPointerFromPoolVector2Array(uvs)
-	ptrArguments[3] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[3] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 	ptrArguments[4] = gdnative.NewPointerFromReal(width)
-	ptrArguments[5] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[5] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_primitive")
+	methodBind := methodBindCanvasItemDrawPrimitive.Get()
 
 	// Call the parent method.
 	// void
@@ -735,8 +851,8 @@ func (o *CanvasItem) DrawPrimitive(points gdnative.PoolVector2Array, colors gdna
 }
 
 /*
-        Draws a colored rectangle.
-	Args: [{ false rect Rect2} { false color Color} {True true filled bool}], Returns: void
+	        Draws a colored rectangle.
+		Args: [{ false rect Rect2} { false color Color} {True true filled bool}], Returns: void
 */
 func (o *CanvasItem) DrawRect(rect gdnative.Rect2, color gdnative.Color, filled gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawRect()")
@@ -748,7 +864,7 @@ func (o *CanvasItem) DrawRect(rect gdnative.Rect2, color gdnative.Color, filled
 	ptrArguments[2] = gdnative.NewPointerFromBool(filled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_rect")
+	methodBind := methodBindCanvasItemDrawRect.Get()
 
 	// Call the parent method.
 	// void
@@ -758,8 +874,8 @@ func (o *CanvasItem) DrawRect(rect gdnative.Rect2, color gdnative.Color, filled
 }
 
 /*
-        Sets a custom transform for drawing via components. Anything drawn afterwards will be transformed by this.
-	Args: [{ false position Vector2} { false rotation float} { false scale Vector2}], Returns: void
+	        Sets a custom transform for drawing via components. Anything drawn afterwards will be transformed by this.
+		Args: [{ false position Vector2} { false rotation float} { false scale Vector2}], Returns: void
 */
 func (o *CanvasItem) DrawSetTransform(position gdnative.Vector2, rotation gdnative.Real, scale gdnative.Vector2) {
 	//log.Println("Calling CanvasItem.DrawSetTransform()")
@@ -771,7 +887,7 @@ func (o *CanvasItem) DrawSetTransform(position gdnative.Vector2, rotation gdnati
 	ptrArguments[2] = gdnative.NewPointerFromVector2(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_set_transform")
+	methodBind := methodBindCanvasItemDrawSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -781,8 +897,8 @@ func (o *CanvasItem) DrawSetTransform(position gdnative.Vector2, rotation gdnati
 }
 
 /*
-        Sets a custom transform for drawing via matrix. Anything drawn afterwards will be transformed by this.
-	Args: [{ false xform Transform2D}], Returns: void
+	        Sets a custom transform for drawing via matrix. Anything drawn afterwards will be transformed by this.
+		Args: [{ false xform Transform2D}], Returns: void
 */
 func (o *CanvasItem) DrawSetTransformMatrix(xform gdnative.Transform2D) {
 	//log.Println("Calling CanvasItem.DrawSetTransformMatrix()")
@@ -792,7 +908,7 @@ func (o *CanvasItem) DrawSetTransformMatrix(xform gdnative.Transform2D) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_set_transform_matrix")
+	methodBind := methodBindCanvasItemDrawSetTransformMatrix.Get()
 
 	// Call the parent method.
 	// void
@@ -802,22 +918,22 @@ func (o *CanvasItem) DrawSetTransformMatrix(xform gdnative.Transform2D) {
 }
 
 /*
-        Draws a string using a custom font.
-	Args: [{ false font Font} { false position Vector2} { false text String} {1,1,1,1 true modulate Color} {-1 true clip_w int}], Returns: void
+	        Draws a string using a custom font.
+		Args: [{ false font Font} { false position Vector2} { false text String} {1,1,1,1 true modulate Color} {-1 true clip_w int}], Returns: void
 */
 func (o *CanvasItem) DrawString(font FontImplementer, position gdnative.Vector2, text gdnative.String, modulate gdnative.Color, clipW gdnative.Int) {
 	//log.Println("Calling CanvasItem.DrawString()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
-	ptrArguments[0] = gdnative.NewPointerFromObject(font.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(font))
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 	ptrArguments[2] = gdnative.NewPointerFromString(text)
 	ptrArguments[3] = gdnative.NewPointerFromColor(modulate)
 	ptrArguments[4] = gdnative.NewPointerFromInt(clipW)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_string")
+	methodBind := methodBindCanvasItemDrawString.Get()
 
 	// Call the parent method.
 	// void
@@ -827,19 +943,19 @@ func (o *CanvasItem) DrawString(font FontImplementer, position gdnative.Vector2,
 }
 
 /*
-        Draws a styled rectangle.
-	Args: [{ false style_box StyleBox} { false rect Rect2}], Returns: void
+	        Draws a styled rectangle.
+		Args: [{ false style_box StyleBox} { false rect Rect2}], Returns: void
 */
 func (o *CanvasItem) DrawStyleBox(styleBox StyleBoxImplementer, rect gdnative.Rect2) {
 	//log.Println("Calling CanvasItem.DrawStyleBox()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(styleBox.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(styleBox))
 	ptrArguments[1] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_style_box")
+	methodBind := methodBindCanvasItemDrawStyleBox.Get()
 
 	// Call the parent method.
 	// void
@@ -849,21 +965,21 @@ func (o *CanvasItem) DrawStyleBox(styleBox StyleBoxImplementer, rect gdnative.Re
 }
 
 /*
-        Draws a texture at a given position.
-	Args: [{ false texture Texture} { false position Vector2} {1,1,1,1 true modulate Color} {Null true normal_map Texture}], Returns: void
+	        Draws a texture at a given position.
+		Args: [{ false texture Texture} { false position Vector2} {1,1,1,1 true modulate Color} {Null true normal_map Texture}], Returns: void
 */
 func (o *CanvasItem) DrawTexture(texture TextureImplementer, position gdnative.Vector2, modulate gdnative.Color, normalMap TextureImplementer) {
 	//log.Println("Calling CanvasItem.DrawTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 	ptrArguments[2] = gdnative.NewPointerFromColor(modulate)
-	ptrArguments[3] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[3] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_texture")
+	methodBind := methodBindCanvasItemDrawTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -873,23 +989,23 @@ func (o *CanvasItem) DrawTexture(texture TextureImplementer, position gdnative.V
 }
 
 /*
-        Draws a textured rectangle at a given position, optionally modulated by a color. Transpose swaps the x and y coordinates when reading the texture.
-	Args: [{ false texture Texture} { false rect Rect2} { false tile bool} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture}], Returns: void
+	        Draws a textured rectangle at a given position, optionally modulated by a color. Transpose swaps the x and y coordinates when reading the texture.
+		Args: [{ false texture Texture} { false rect Rect2} { false tile bool} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture}], Returns: void
 */
 func (o *CanvasItem) DrawTextureRect(texture TextureImplementer, rect gdnative.Rect2, tile gdnative.Bool, modulate gdnative.Color, transpose gdnative.Bool, normalMap TextureImplementer) {
 	//log.Println("Calling CanvasItem.DrawTextureRect()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 6, 6)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 	ptrArguments[1] = gdnative.NewPointerFromRect2(rect)
 	ptrArguments[2] = gdnative.NewPointerFromBool(tile)
 	ptrArguments[3] = gdnative.NewPointerFromColor(modulate)
 	ptrArguments[4] = gdnative.NewPointerFromBool(transpose)
-	ptrArguments[5] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[5] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_texture_rect")
+	methodBind := methodBindCanvasItemDrawTextureRect.Get()
 
 	// Call the parent method.
 	// void
@@ -899,24 +1015,24 @@ func (o *CanvasItem) DrawTextureRect(texture TextureImplementer, rect gdnative.R
 }
 
 /*
-        Draws a textured rectangle region at a given position, optionally modulated by a color. Transpose swaps the x and y coordinates when reading the texture.
-	Args: [{ false texture Texture} { false rect Rect2} { false src_rect Rect2} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture} {True true clip_uv bool}], Returns: void
+	        Draws a textured rectangle region at a given position, optionally modulated by a color. Transpose swaps the x and y coordinates when reading the texture.
+		Args: [{ false texture Texture} { false rect Rect2} { false src_rect Rect2} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture} {True true clip_uv bool}], Returns: void
 */
 func (o *CanvasItem) DrawTextureRectRegion(texture TextureImplementer, rect gdnative.Rect2, srcRect gdnative.Rect2, modulate gdnative.Color, transpose gdnative.Bool, normalMap TextureImplementer, clipUv gdnative.Bool) {
 	//log.Println("Calling CanvasItem.DrawTextureRectRegion()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 7, 7)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 	ptrArguments[1] = gdnative.NewPointerFromRect2(rect)
 	ptrArguments[2] = gdnative.NewPointerFromRect2(srcRect)
 	ptrArguments[3] = gdnative.NewPointerFromColor(modulate)
 	ptrArguments[4] = gdnative.NewPointerFromBool(transpose)
-	ptrArguments[5] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[5] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 	ptrArguments[6] = gdnative.NewPointerFromBool(clipUv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "draw_texture_rect_region")
+	methodBind := methodBindCanvasItemDrawTextureRectRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -926,8 +1042,8 @@ func (o *CanvasItem) DrawTextureRectRegion(texture TextureImplementer, rect gdna
 }
 
 /*
-        Return the [RID] of the [World2D] canvas where this item is in.
-	Args: [], Returns: RID
+	        Return the [RID] of the [World2D] canvas where this item is in.
+		Args: [], Returns: RID
 */
 func (o *CanvasItem) GetCanvas() gdnative.Rid {
 	//log.Println("Calling CanvasItem.GetCanvas()")
@@ -936,7 +1052,7 @@ func (o *CanvasItem) GetCanvas() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_canvas")
+	methodBind := methodBindCanvasItemGetCanvas.Get()
 
 	// Call the parent method.
 	// RID
@@ -949,8 +1065,8 @@ func (o *CanvasItem) GetCanvas() gdnative.Rid {
 }
 
 /*
-        Return the canvas item RID used by [VisualServer] for this item.
-	Args: [], Returns: RID
+	        Return the canvas item RID used by [VisualServer] for this item.
+		Args: [], Returns: RID
 */
 func (o *CanvasItem) GetCanvasItem() gdnative.Rid {
 	//log.Println("Calling CanvasItem.GetCanvasItem()")
@@ -959,7 +1075,7 @@ func (o *CanvasItem) GetCanvasItem() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_canvas_item")
+	methodBind := methodBindCanvasItemGetCanvasItem.Get()
 
 	// Call the parent method.
 	// RID
@@ -972,8 +1088,8 @@ func (o *CanvasItem) GetCanvasItem() gdnative.Rid {
 }
 
 /*
-        Get the transform matrix of this item's canvas.
-	Args: [], Returns: Transform2D
+	        Get the transform matrix of this item's canvas.
+		Args: [], Returns: Transform2D
 */
 func (o *CanvasItem) GetCanvasTransform() gdnative.Transform2D {
 	//log.Println("Calling CanvasItem.GetCanvasTransform()")
@@ -982,7 +1098,7 @@ func (o *CanvasItem) GetCanvasTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_canvas_transform")
+	methodBind := methodBindCanvasItemGetCanvasTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -995,8 +1111,8 @@ func (o *CanvasItem) GetCanvasTransform() gdnative.Transform2D {
 }
 
 /*
-        Get the global position of the mouse.
-	Args: [], Returns: Vector2
+	        Get the global position of the mouse.
+		Args: [], Returns: Vector2
 */
 func (o *CanvasItem) GetGlobalMousePosition() gdnative.Vector2 {
 	//log.Println("Calling CanvasItem.GetGlobalMousePosition()")
@@ -1005,7 +1121,7 @@ func (o *CanvasItem) GetGlobalMousePosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_global_mouse_position")
+	methodBind := methodBindCanvasItemGetGlobalMousePosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1018,8 +1134,8 @@ func (o *CanvasItem) GetGlobalMousePosition() gdnative.Vector2 {
 }
 
 /*
-        Get the global transform matrix of this item.
-	Args: [], Returns: Transform2D
+	        Get the global transform matrix of this item.
+		Args: [], Returns: Transform2D
 */
 func (o *CanvasItem) GetGlobalTransform() gdnative.Transform2D {
 	//log.Println("Calling CanvasItem.GetGlobalTransform()")
@@ -1028,7 +1144,7 @@ func (o *CanvasItem) GetGlobalTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_global_transform")
+	methodBind := methodBindCanvasItemGetGlobalTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -1041,8 +1157,8 @@ func (o *CanvasItem) GetGlobalTransform() gdnative.Transform2D {
 }
 
 /*
-        Get the global transform matrix of this item in relation to the canvas.
-	Args: [], Returns: Transform2D
+	        Get the global transform matrix of this item in relation to the canvas.
+		Args: [], Returns: Transform2D
 */
 func (o *CanvasItem) GetGlobalTransformWithCanvas() gdnative.Transform2D {
 	//log.Println("Calling CanvasItem.GetGlobalTransformWithCanvas()")
@@ -1051,7 +1167,7 @@ func (o *CanvasItem) GetGlobalTransformWithCanvas() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_global_transform_with_canvas")
+	methodBind := methodBindCanvasItemGetGlobalTransformWithCanvas.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -1064,8 +1180,8 @@ func (o *CanvasItem) GetGlobalTransformWithCanvas() gdnative.Transform2D {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CanvasItem) GetLightMask() gdnative.Int {
 	//log.Println("Calling CanvasItem.GetLightMask()")
@@ -1074,7 +1190,7 @@ func (o *CanvasItem) GetLightMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_light_mask")
+	methodBind := methodBindCanvasItemGetLightMask.Get()
 
 	// Call the parent method.
 	// int
@@ -1087,8 +1203,8 @@ func (o *CanvasItem) GetLightMask() gdnative.Int {
 }
 
 /*
-        Get the mouse position relative to this item's position.
-	Args: [], Returns: Vector2
+	        Get the mouse position relative to this item's position.
+		Args: [], Returns: Vector2
 */
 func (o *CanvasItem) GetLocalMousePosition() gdnative.Vector2 {
 	//log.Println("Calling CanvasItem.GetLocalMousePosition()")
@@ -1097,7 +1213,7 @@ func (o *CanvasItem) GetLocalMousePosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_local_mouse_position")
+	methodBind := methodBindCanvasItemGetLocalMousePosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1110,8 +1226,8 @@ func (o *CanvasItem) GetLocalMousePosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Material
+	        Undocumented
+		Args: [], Returns: Material
 */
 func (o *CanvasItem) GetMaterial() MaterialImplementer {
 	//log.Println("Calling CanvasItem.GetMaterial()")
@@ -1120,7 +1236,7 @@ func (o *CanvasItem) GetMaterial() MaterialImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_material")
+	methodBind := methodBindCanvasItemGetMaterial.Get()
 
 	// Call the parent method.
 	// Material
@@ -1128,27 +1244,25 @@ func (o *CanvasItem) GetMaterial() MaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *CanvasItem) GetModulate() gdnative.Color {
 	//log.Println("Calling CanvasItem.GetModulate()")
@@ -1157,7 +1271,7 @@ func (o *CanvasItem) GetModulate() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_modulate")
+	methodBind := methodBindCanvasItemGetModulate.Get()
 
 	// Call the parent method.
 	// Color
@@ -1170,8 +1284,8 @@ func (o *CanvasItem) GetModulate() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *CanvasItem) GetSelfModulate() gdnative.Color {
 	//log.Println("Calling CanvasItem.GetSelfModulate()")
@@ -1180,7 +1294,7 @@ func (o *CanvasItem) GetSelfModulate() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_self_modulate")
+	methodBind := methodBindCanvasItemGetSelfModulate.Get()
 
 	// Call the parent method.
 	// Color
@@ -1193,8 +1307,8 @@ func (o *CanvasItem) GetSelfModulate() gdnative.Color {
 }
 
 /*
-        Get the transform matrix of this item.
-	Args: [], Returns: Transform2D
+	        Get the transform matrix of this item.
+		Args: [], Returns: Transform2D
 */
 func (o *CanvasItem) GetTransform() gdnative.Transform2D {
 	//log.Println("Calling CanvasItem.GetTransform()")
@@ -1203,7 +1317,7 @@ func (o *CanvasItem) GetTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_transform")
+	methodBind := methodBindCanvasItemGetTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -1216,8 +1330,8 @@ func (o *CanvasItem) GetTransform() gdnative.Transform2D {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) GetUseParentMaterial() gdnative.Bool {
 	//log.Println("Calling CanvasItem.GetUseParentMaterial()")
@@ -1226,7 +1340,7 @@ func (o *CanvasItem) GetUseParentMaterial() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_use_parent_material")
+	methodBind := methodBindCanvasItemGetUseParentMaterial.Get()
 
 	// Call the parent method.
 	// bool
@@ -1239,8 +1353,8 @@ func (o *CanvasItem) GetUseParentMaterial() gdnative.Bool {
 }
 
 /*
-        Get the viewport's boundaries as a [Rect2].
-	Args: [], Returns: Rect2
+	        Get the viewport's boundaries as a [Rect2].
+		Args: [], Returns: Rect2
 */
 func (o *CanvasItem) GetViewportRect() gdnative.Rect2 {
 	//log.Println("Calling CanvasItem.GetViewportRect()")
@@ -1249,7 +1363,7 @@ func (o *CanvasItem) GetViewportRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_viewport_rect")
+	methodBind := methodBindCanvasItemGetViewportRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -1262,8 +1376,8 @@ func (o *CanvasItem) GetViewportRect() gdnative.Rect2 {
 }
 
 /*
-        Get this item's transform in relation to the viewport.
-	Args: [], Returns: Transform2D
+	        Get this item's transform in relation to the viewport.
+		Args: [], Returns: Transform2D
 */
 func (o *CanvasItem) GetViewportTransform() gdnative.Transform2D {
 	//log.Println("Calling CanvasItem.GetViewportTransform()")
@@ -1272,7 +1386,7 @@ func (o *CanvasItem) GetViewportTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_viewport_transform")
+	methodBind := methodBindCanvasItemGetViewportTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -1285,8 +1399,8 @@ func (o *CanvasItem) GetViewportTransform() gdnative.Transform2D {
 }
 
 /*
-        Get the [World2D] where this item is in.
-	Args: [], Returns: World2D
+	        Get the [World2D] where this item is in.
+		Args: [], Returns: World2D
 */
 func (o *CanvasItem) GetWorld2D() World2DImplementer {
 	//log.Println("Calling CanvasItem.GetWorld2D()")
@@ -1295,7 +1409,7 @@ func (o *CanvasItem) GetWorld2D() World2DImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "get_world_2d")
+	methodBind := methodBindCanvasItemGetWorld2D.Get()
 
 	// Call the parent method.
 	// World2D
@@ -1303,27 +1417,25 @@ func (o *CanvasItem) GetWorld2D() World2DImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newWorld2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(World2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "World2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(World2DImplementer)
+	ret := World2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(World2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Hide the CanvasItem currently visible.
-	Args: [], Returns: void
+	        Hide the CanvasItem currently visible.
+		Args: [], Returns: void
 */
 func (o *CanvasItem) Hide() {
 	//log.Println("Calling CanvasItem.Hide()")
@@ -1332,7 +1444,7 @@ func (o *CanvasItem) Hide() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "hide")
+	methodBind := methodBindCanvasItemHide.Get()
 
 	// Call the parent method.
 	// void
@@ -1342,8 +1454,8 @@ func (o *CanvasItem) Hide() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) IsDrawBehindParentEnabled() gdnative.Bool {
 	//log.Println("Calling CanvasItem.IsDrawBehindParentEnabled()")
@@ -1352,7 +1464,7 @@ func (o *CanvasItem) IsDrawBehindParentEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "is_draw_behind_parent_enabled")
+	methodBind := methodBindCanvasItemIsDrawBehindParentEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1365,8 +1477,8 @@ func (o *CanvasItem) IsDrawBehindParentEnabled() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if local transform notifications are communicated to children.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if local transform notifications are communicated to children.
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) IsLocalTransformNotificationEnabled() gdnative.Bool {
 	//log.Println("Calling CanvasItem.IsLocalTransformNotificationEnabled()")
@@ -1375,7 +1487,7 @@ func (o *CanvasItem) IsLocalTransformNotificationEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "is_local_transform_notification_enabled")
+	methodBind := methodBindCanvasItemIsLocalTransformNotificationEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1388,8 +1500,8 @@ func (o *CanvasItem) IsLocalTransformNotificationEnabled() gdnative.Bool {
 }
 
 /*
-        Return if set as toplevel. See [method set_as_toplevel].
-	Args: [], Returns: bool
+	        Return if set as toplevel. See [method set_as_toplevel].
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) IsSetAsToplevel() gdnative.Bool {
 	//log.Println("Calling CanvasItem.IsSetAsToplevel()")
@@ -1398,7 +1510,7 @@ func (o *CanvasItem) IsSetAsToplevel() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "is_set_as_toplevel")
+	methodBind := methodBindCanvasItemIsSetAsToplevel.Get()
 
 	// Call the parent method.
 	// bool
@@ -1411,8 +1523,8 @@ func (o *CanvasItem) IsSetAsToplevel() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if global transform notifications are communicated to children.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if global transform notifications are communicated to children.
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) IsTransformNotificationEnabled() gdnative.Bool {
 	//log.Println("Calling CanvasItem.IsTransformNotificationEnabled()")
@@ -1421,7 +1533,7 @@ func (o *CanvasItem) IsTransformNotificationEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "is_transform_notification_enabled")
+	methodBind := methodBindCanvasItemIsTransformNotificationEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1434,8 +1546,8 @@ func (o *CanvasItem) IsTransformNotificationEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) IsVisible() gdnative.Bool {
 	//log.Println("Calling CanvasItem.IsVisible()")
@@ -1444,7 +1556,7 @@ func (o *CanvasItem) IsVisible() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "is_visible")
+	methodBind := methodBindCanvasItemIsVisible.Get()
 
 	// Call the parent method.
 	// bool
@@ -1457,8 +1569,8 @@ func (o *CanvasItem) IsVisible() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the node is present in the [SceneTree], its [member visible] property is [code]true[/code] and its inherited visibility is also [code]true[/code].
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the node is present in the [SceneTree], its [member visible] property is [code]true[/code] and its inherited visibility is also [code]true[/code].
+		Args: [], Returns: bool
 */
 func (o *CanvasItem) IsVisibleInTree() gdnative.Bool {
 	//log.Println("Calling CanvasItem.IsVisibleInTree()")
@@ -1467,7 +1579,7 @@ func (o *CanvasItem) IsVisibleInTree() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "is_visible_in_tree")
+	methodBind := methodBindCanvasItemIsVisibleInTree.Get()
 
 	// Call the parent method.
 	// bool
@@ -1480,8 +1592,8 @@ func (o *CanvasItem) IsVisibleInTree() gdnative.Bool {
 }
 
 /*
-        Assigns [code]screen_point[/code] as this node's new local transform.
-	Args: [{ false screen_point Vector2}], Returns: Vector2
+	        Assigns [code]screen_point[/code] as this node's new local transform.
+		Args: [{ false screen_point Vector2}], Returns: Vector2
 */
 func (o *CanvasItem) MakeCanvasPositionLocal(screenPoint gdnative.Vector2) gdnative.Vector2 {
 	//log.Println("Calling CanvasItem.MakeCanvasPositionLocal()")
@@ -1491,7 +1603,7 @@ func (o *CanvasItem) MakeCanvasPositionLocal(screenPoint gdnative.Vector2) gdnat
 	ptrArguments[0] = gdnative.NewPointerFromVector2(screenPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "make_canvas_position_local")
+	methodBind := methodBindCanvasItemMakeCanvasPositionLocal.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1504,18 +1616,18 @@ func (o *CanvasItem) MakeCanvasPositionLocal(screenPoint gdnative.Vector2) gdnat
 }
 
 /*
-        Transformations issued by [code]event[/code]'s inputs are applied in local space instead of global space.
-	Args: [{ false event InputEvent}], Returns: InputEvent
+	        Transformations issued by [code]event[/code]'s inputs are applied in local space instead of global space.
+		Args: [{ false event InputEvent}], Returns: InputEvent
 */
 func (o *CanvasItem) MakeInputLocal(event InputEventImplementer) InputEventImplementer {
 	//log.Println("Calling CanvasItem.MakeInputLocal()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "make_input_local")
+	methodBind := methodBindCanvasItemMakeInputLocal.Get()
 
 	// Call the parent method.
 	// InputEvent
@@ -1523,27 +1635,25 @@ func (o *CanvasItem) MakeInputLocal(event InputEventImplementer) InputEventImple
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newInputEventFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(InputEventImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "InputEvent" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(InputEventImplementer)
+	ret := InputEvent{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(InputEventImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Sets as top level. This means that it will not inherit transform from parent canvas items.
-	Args: [{ false enable bool}], Returns: void
+	        Sets as top level. This means that it will not inherit transform from parent canvas items.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CanvasItem) SetAsToplevel(enable gdnative.Bool) {
 	//log.Println("Calling CanvasItem.SetAsToplevel()")
@@ -1553,7 +1663,7 @@ func (o *CanvasItem) SetAsToplevel(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_as_toplevel")
+	methodBind := methodBindCanvasItemSetAsToplevel.Get()
 
 	// Call the parent method.
 	// void
@@ -1563,8 +1673,8 @@ func (o *CanvasItem) SetAsToplevel(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CanvasItem) SetDrawBehindParent(enable gdnative.Bool) {
 	//log.Println("Calling CanvasItem.SetDrawBehindParent()")
@@ -1574,7 +1684,7 @@ func (o *CanvasItem) SetDrawBehindParent(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_draw_behind_parent")
+	methodBind := methodBindCanvasItemSetDrawBehindParent.Get()
 
 	// Call the parent method.
 	// void
@@ -1584,8 +1694,8 @@ func (o *CanvasItem) SetDrawBehindParent(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false light_mask int}], Returns: void
+	        Undocumented
+		Args: [{ false light_mask int}], Returns: void
 */
 func (o *CanvasItem) SetLightMask(lightMask gdnative.Int) {
 	//log.Println("Calling CanvasItem.SetLightMask()")
@@ -1595,7 +1705,7 @@ func (o *CanvasItem) SetLightMask(lightMask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(lightMask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_light_mask")
+	methodBind := methodBindCanvasItemSetLightMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1605,18 +1715,18 @@ func (o *CanvasItem) SetLightMask(lightMask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false material Material}], Returns: void
+	        Undocumented
+		Args: [{ false material Material}], Returns: void
 */
 func (o *CanvasItem) SetMaterial(material MaterialImplementer) {
 	//log.Println("Calling CanvasItem.SetMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_material")
+	methodBind := methodBindCanvasItemSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -1626,8 +1736,8 @@ func (o *CanvasItem) SetMaterial(material MaterialImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false modulate Color}], Returns: void
+	        Undocumented
+		Args: [{ false modulate Color}], Returns: void
 */
 func (o *CanvasItem) SetModulate(modulate gdnative.Color) {
 	//log.Println("Calling CanvasItem.SetModulate()")
@@ -1637,7 +1747,7 @@ func (o *CanvasItem) SetModulate(modulate gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(modulate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_modulate")
+	methodBind := methodBindCanvasItemSetModulate.Get()
 
 	// Call the parent method.
 	// void
@@ -1647,8 +1757,8 @@ func (o *CanvasItem) SetModulate(modulate gdnative.Color) {
 }
 
 /*
-        If [code]enable[/code] is [code]true[/code], children will be updated with local transform data.
-	Args: [{ false enable bool}], Returns: void
+	        If [code]enable[/code] is [code]true[/code], children will be updated with local transform data.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CanvasItem) SetNotifyLocalTransform(enable gdnative.Bool) {
 	//log.Println("Calling CanvasItem.SetNotifyLocalTransform()")
@@ -1658,7 +1768,7 @@ func (o *CanvasItem) SetNotifyLocalTransform(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_notify_local_transform")
+	methodBind := methodBindCanvasItemSetNotifyLocalTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1668,8 +1778,8 @@ func (o *CanvasItem) SetNotifyLocalTransform(enable gdnative.Bool) {
 }
 
 /*
-        If [code]enable[/code] is [code]true[/code], children will be updated with global transform data.
-	Args: [{ false enable bool}], Returns: void
+	        If [code]enable[/code] is [code]true[/code], children will be updated with global transform data.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CanvasItem) SetNotifyTransform(enable gdnative.Bool) {
 	//log.Println("Calling CanvasItem.SetNotifyTransform()")
@@ -1679,7 +1789,7 @@ func (o *CanvasItem) SetNotifyTransform(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_notify_transform")
+	methodBind := methodBindCanvasItemSetNotifyTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1689,8 +1799,8 @@ func (o *CanvasItem) SetNotifyTransform(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false self_modulate Color}], Returns: void
+	        Undocumented
+		Args: [{ false self_modulate Color}], Returns: void
 */
 func (o *CanvasItem) SetSelfModulate(selfModulate gdnative.Color) {
 	//log.Println("Calling CanvasItem.SetSelfModulate()")
@@ -1700,7 +1810,7 @@ func (o *CanvasItem) SetSelfModulate(selfModulate gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(selfModulate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_self_modulate")
+	methodBind := methodBindCanvasItemSetSelfModulate.Get()
 
 	// Call the parent method.
 	// void
@@ -1710,8 +1820,8 @@ func (o *CanvasItem) SetSelfModulate(selfModulate gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CanvasItem) SetUseParentMaterial(enable gdnative.Bool) {
 	//log.Println("Calling CanvasItem.SetUseParentMaterial()")
@@ -1721,7 +1831,7 @@ func (o *CanvasItem) SetUseParentMaterial(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_use_parent_material")
+	methodBind := methodBindCanvasItemSetUseParentMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -1731,8 +1841,8 @@ func (o *CanvasItem) SetUseParentMaterial(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false visible bool}], Returns: void
+	        Undocumented
+		Args: [{ false visible bool}], Returns: void
 */
 func (o *CanvasItem) SetVisible(visible gdnative.Bool) {
 	//log.Println("Calling CanvasItem.SetVisible()")
@@ -1742,7 +1852,7 @@ func (o *CanvasItem) SetVisible(visible gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(visible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "set_visible")
+	methodBind := methodBindCanvasItemSetVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -1752,8 +1862,8 @@ func (o *CanvasItem) SetVisible(visible gdnative.Bool) {
 }
 
 /*
-        Show the CanvasItem currently hidden.
-	Args: [], Returns: void
+	        Show the CanvasItem currently hidden.
+		Args: [], Returns: void
 */
 func (o *CanvasItem) Show() {
 	//log.Println("Calling CanvasItem.Show()")
@@ -1762,7 +1872,7 @@ func (o *CanvasItem) Show() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "show")
+	methodBind := methodBindCanvasItemShow.Get()
 
 	// Call the parent method.
 	// void
@@ -1772,8 +1882,8 @@ func (o *CanvasItem) Show() {
 }
 
 /*
-        Queue the CanvasItem for update. [code]NOTIFICATION_DRAW[/code] will be called on idle time to request redraw.
-	Args: [], Returns: void
+	        Queue the CanvasItem for update. [code]NOTIFICATION_DRAW[/code] will be called on idle time to request redraw.
+		Args: [], Returns: void
 */
 func (o *CanvasItem) Update() {
 	//log.Println("Calling CanvasItem.Update()")
@@ -1782,7 +1892,7 @@ func (o *CanvasItem) Update() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasItem", "update")
+	methodBind := methodBindCanvasItemUpdate.Get()
 
 	// Call the parent method.
 	// void
@@ -1791,6 +1901,202 @@ func (o *CanvasItem) Update() {
 
 }
 
+// DrawCharWithDefaults will call DrawChar using the default values for: modulate.
+func (o *CanvasItem) DrawCharWithDefaults(font FontImplementer, position gdnative.Vector2, char gdnative.String, next gdnative.String) gdnative.Real {
+	return o.DrawChar(font, position, char, next, gdnative.NewColorRgba(1, 1, 1, 1))
+}
+
+// DrawColoredPolygonWithDefaults will call DrawColoredPolygon using the default values for: uvs, texture, normal_map, antialiased.
+func (o *CanvasItem) DrawColoredPolygonWithDefaults(points gdnative.PoolVector2Array, color gdnative.Color) {
+	default0 := gdnative.NewPoolVector2Array()
+	defer default0.Destroy()
+	o.DrawColoredPolygon(points, color, default0, nil, nil, false)
+}
+
+// DrawLineWithDefaults will call DrawLine using the default values for: width, antialiased.
+func (o *CanvasItem) DrawLineWithDefaults(from gdnative.Vector2, to gdnative.Vector2, color gdnative.Color) {
+	o.DrawLine(from, to, color, 1, false)
+}
+
+// DrawMultilineWithDefaults will call DrawMultiline using the default values for: width, antialiased.
+func (o *CanvasItem) DrawMultilineWithDefaults(points gdnative.PoolVector2Array, color gdnative.Color) {
+	o.DrawMultiline(points, color, 1, false)
+}
+
+// DrawMultilineColorsWithDefaults will call DrawMultilineColors using the default values for: width, antialiased.
+func (o *CanvasItem) DrawMultilineColorsWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray) {
+	o.DrawMultilineColors(points, colors, 1, false)
+}
+
+// DrawPolygonWithDefaults will call DrawPolygon using the default values for: uvs, texture, normal_map, antialiased.
+func (o *CanvasItem) DrawPolygonWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray) {
+	default0 := gdnative.NewPoolVector2Array()
+	defer default0.Destroy()
+	o.DrawPolygon(points, colors, default0, nil, nil, false)
+}
+
+// DrawPolylineWithDefaults will call DrawPolyline using the default values for: width, antialiased.
+func (o *CanvasItem) DrawPolylineWithDefaults(points gdnative.PoolVector2Array, color gdnative.Color) {
+	o.DrawPolyline(points, color, 1, false)
+}
+
+// DrawPolylineColorsWithDefaults will call DrawPolylineColors using the default values for: width, antialiased.
+func (o *CanvasItem) DrawPolylineColorsWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray) {
+	o.DrawPolylineColors(points, colors, 1, false)
+}
+
+// DrawPrimitiveWithDefaults will call DrawPrimitive using the default values for: texture, width, normal_map.
+func (o *CanvasItem) DrawPrimitiveWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array) {
+	o.DrawPrimitive(points, colors, uvs, nil, 1, nil)
+}
+
+// DrawRectWithDefaults will call DrawRect using the default values for: filled.
+func (o *CanvasItem) DrawRectWithDefaults(rect gdnative.Rect2, color gdnative.Color) {
+	o.DrawRect(rect, color, true)
+}
+
+// DrawStringWithDefaults will call DrawString using the default values for: modulate, clip_w.
+func (o *CanvasItem) DrawStringWithDefaults(font FontImplementer, position gdnative.Vector2, text gdnative.String) {
+	o.DrawString(font, position, text, gdnative.NewColorRgba(1, 1, 1, 1), -1)
+}
+
+// DrawTextureWithDefaults will call DrawTexture using the default values for: modulate, normal_map.
+func (o *CanvasItem) DrawTextureWithDefaults(texture TextureImplementer, position gdnative.Vector2) {
+	o.DrawTexture(texture, position, gdnative.NewColorRgba(1, 1, 1, 1), nil)
+}
+
+// DrawTextureRectWithDefaults will call DrawTextureRect using the default values for: modulate, transpose, normal_map.
+func (o *CanvasItem) DrawTextureRectWithDefaults(texture TextureImplementer, rect gdnative.Rect2, tile gdnative.Bool) {
+	o.DrawTextureRect(texture, rect, tile, gdnative.NewColorRgba(1, 1, 1, 1), false, nil)
+}
+
+// DrawTextureRectRegionWithDefaults will call DrawTextureRectRegion using the default values for: modulate, transpose, normal_map, clip_uv.
+func (o *CanvasItem) DrawTextureRectRegionWithDefaults(texture TextureImplementer, rect gdnative.Rect2, srcRect gdnative.Rect2) {
+	o.DrawTextureRectRegion(texture, rect, srcRect, gdnative.NewColorRgba(1, 1, 1, 1), false, nil, true)
+}
+
+// LightMask will return the value of the "light_mask" property.
+func (o *CanvasItem) LightMask() gdnative.Int {
+	return o.GetLightMask()
+}
+
+// Material will return the value of the "material" property.
+func (o *CanvasItem) Material() MaterialImplementer {
+	return o.GetMaterial()
+}
+
+// Modulate will return the value of the "modulate" property.
+func (o *CanvasItem) Modulate() gdnative.Color {
+	return o.GetModulate()
+}
+
+// SelfModulate will return the value of the "self_modulate" property.
+func (o *CanvasItem) SelfModulate() gdnative.Color {
+	return o.GetSelfModulate()
+}
+
+// ShowBehindParent will return the value of the "show_behind_parent" property.
+func (o *CanvasItem) ShowBehindParent() gdnative.Bool {
+	return o.IsDrawBehindParentEnabled()
+}
+
+// SetShowBehindParent will set the value of the "show_behind_parent" property.
+func (o *CanvasItem) SetShowBehindParent(value gdnative.Bool) {
+	o.SetDrawBehindParent(value)
+}
+
+// ShowOnTop will return the value of the "show_on_top" property.
+func (o *CanvasItem) ShowOnTop() gdnative.Bool {
+	return o.X_IsOnTop()
+}
+
+// SetShowOnTop will set the value of the "show_on_top" property.
+func (o *CanvasItem) SetShowOnTop(value gdnative.Bool) {
+	o.X_SetOnTop(value)
+}
+
+// UseParentMaterial will return the value of the "use_parent_material" property.
+func (o *CanvasItem) UseParentMaterial() gdnative.Bool {
+	return o.GetUseParentMaterial()
+}
+
+// Visible will return the value of the "visible" property.
+func (o *CanvasItem) Visible() gdnative.Bool {
+	return o.IsVisible()
+}
+
+// Signals of the CanvasItem class.
+const (
+	CanvasItemSignalDraw              gdnative.String = "draw"
+	CanvasItemSignalHide              gdnative.String = "hide"
+	CanvasItemSignalItemRectChanged   gdnative.String = "item_rect_changed"
+	CanvasItemSignalVisibilityChanged gdnative.String = "visibility_changed"
+)
+
+// ConnectDraw will connect the "draw" signal to the given method of the target object.
+func (o *CanvasItem) ConnectDraw(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CanvasItemSignalDraw, target, method, binds, 0)
+}
+
+// EmitDraw will emit the "draw" signal.
+func (o *CanvasItem) EmitDraw() error {
+
+	ret, err := o.EmitSignal(CanvasItemSignalDraw)
+	ret.Destroy()
+	return err
+}
+
+// ConnectHide will connect the "hide" signal to the given method of the target object.
+func (o *CanvasItem) ConnectHide(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CanvasItemSignalHide, target, method, binds, 0)
+}
+
+// EmitHide will emit the "hide" signal.
+func (o *CanvasItem) EmitHide() error {
+
+	ret, err := o.EmitSignal(CanvasItemSignalHide)
+	ret.Destroy()
+	return err
+}
+
+// ConnectItemRectChanged will connect the "item_rect_changed" signal to the given method of the target object.
+func (o *CanvasItem) ConnectItemRectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CanvasItemSignalItemRectChanged, target, method, binds, 0)
+}
+
+// EmitItemRectChanged will emit the "item_rect_changed" signal.
+func (o *CanvasItem) EmitItemRectChanged() error {
+
+	ret, err := o.EmitSignal(CanvasItemSignalItemRectChanged)
+	ret.Destroy()
+	return err
+}
+
+// ConnectVisibilityChanged will connect the "visibility_changed" signal to the given method of the target object.
+func (o *CanvasItem) ConnectVisibilityChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CanvasItemSignalVisibilityChanged, target, method, binds, 0)
+}
+
+// EmitVisibilityChanged will emit the "visibility_changed" signal.
+func (o *CanvasItem) EmitVisibilityChanged() error {
+
+	ret, err := o.EmitSignal(CanvasItemSignalVisibilityChanged)
+	ret.Destroy()
+	return err
+}
+
 // CanvasItemImplementer is an interface that implements the methods
 // of the CanvasItem class.
 type CanvasItemImplementer interface {
@@ -1870,4 +2176,36 @@ type CanvasItemImplementer interface {
 	SetVisible(visible gdnative.Bool)
 	Show()
 	Update()
+	DrawCharWithDefaults(font FontImplementer, position gdnative.Vector2, char gdnative.String, next gdnative.String) gdnative.Real
+	DrawColoredPolygonWithDefaults(points gdnative.PoolVector2Array, color gdnative.Color)
+	DrawLineWithDefaults(from gdnative.Vector2, to gdnative.Vector2, color gdnative.Color)
+	DrawMultilineWithDefaults(points gdnative.PoolVector2Array, color gdnative.Color)
+	DrawMultilineColorsWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray)
+	DrawPolygonWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray)
+	DrawPolylineWithDefaults(points gdnative.PoolVector2Array, color gdnative.Color)
+	DrawPolylineColorsWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray)
+	DrawPrimitiveWithDefaults(points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array)
+	DrawRectWithDefaults(rect gdnative.Rect2, color gdnative.Color)
+	DrawStringWithDefaults(font FontImplementer, position gdnative.Vector2, text gdnative.String)
+	DrawTextureWithDefaults(texture TextureImplementer, position gdnative.Vector2)
+	DrawTextureRectWithDefaults(texture TextureImplementer, rect gdnative.Rect2, tile gdnative.Bool)
+	DrawTextureRectRegionWithDefaults(texture TextureImplementer, rect gdnative.Rect2, srcRect gdnative.Rect2)
+	LightMask() gdnative.Int
+	Material() MaterialImplementer
+	Modulate() gdnative.Color
+	SelfModulate() gdnative.Color
+	ShowBehindParent() gdnative.Bool
+	SetShowBehindParent(value gdnative.Bool)
+	ShowOnTop() gdnative.Bool
+	SetShowOnTop(value gdnative.Bool)
+	UseParentMaterial() gdnative.Bool
+	Visible() gdnative.Bool
+	ConnectDraw(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDraw() error
+	ConnectHide(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitHide() error
+	ConnectItemRectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemRectChanged() error
+	ConnectVisibilityChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitVisibilityChanged() error
 }
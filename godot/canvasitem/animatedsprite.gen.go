@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,60 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAnimatedSpriteFromPointer(ptr gdnative.Pointer) AnimatedSprite {
-func newAnimatedSpriteFromPointer(ptr gdnative.Pointer) AnimatedSprite {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AnimatedSprite{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Animations are created using a [SpriteFrames] resource, which can be configured in the editor via the SpriteFrames panel.
 */
 type AnimatedSprite struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorAnimatedSprite is the class constructor of the AnimatedSprite class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAnimatedSprite = gdnative.NewLazyClassConstructor("AnimatedSprite")
+
+// NewAnimatedSprite will create a new instance of the AnimatedSprite class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAnimatedSprite() *AnimatedSprite {
+	obj := &AnimatedSprite{}
+	obj.SetBaseObject(classConstructorAnimatedSprite.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AnimatedSprite) BaseClass() string {
 	return "AnimatedSprite"
 }
 
+// Method binds of the AnimatedSprite class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAnimatedSpriteX_IsPlaying     = gdnative.NewLazyMethodBind("AnimatedSprite", "_is_playing")
+	methodBindAnimatedSpriteX_ResChanged    = gdnative.NewLazyMethodBind("AnimatedSprite", "_res_changed")
+	methodBindAnimatedSpriteX_SetPlaying    = gdnative.NewLazyMethodBind("AnimatedSprite", "_set_playing")
+	methodBindAnimatedSpriteGetAnimation    = gdnative.NewLazyMethodBind("AnimatedSprite", "get_animation")
+	methodBindAnimatedSpriteGetFrame        = gdnative.NewLazyMethodBind("AnimatedSprite", "get_frame")
+	methodBindAnimatedSpriteGetOffset       = gdnative.NewLazyMethodBind("AnimatedSprite", "get_offset")
+	methodBindAnimatedSpriteGetSpriteFrames = gdnative.NewLazyMethodBind("AnimatedSprite", "get_sprite_frames")
+	methodBindAnimatedSpriteIsCentered      = gdnative.NewLazyMethodBind("AnimatedSprite", "is_centered")
+	methodBindAnimatedSpriteIsFlippedH      = gdnative.NewLazyMethodBind("AnimatedSprite", "is_flipped_h")
+	methodBindAnimatedSpriteIsFlippedV      = gdnative.NewLazyMethodBind("AnimatedSprite", "is_flipped_v")
+	methodBindAnimatedSpriteIsPlaying       = gdnative.NewLazyMethodBind("AnimatedSprite", "is_playing")
+	methodBindAnimatedSpritePlay            = gdnative.NewLazyMethodBind("AnimatedSprite", "play")
+	methodBindAnimatedSpriteSetAnimation    = gdnative.NewLazyMethodBind("AnimatedSprite", "set_animation")
+	methodBindAnimatedSpriteSetCentered     = gdnative.NewLazyMethodBind("AnimatedSprite", "set_centered")
+	methodBindAnimatedSpriteSetFlipH        = gdnative.NewLazyMethodBind("AnimatedSprite", "set_flip_h")
+	methodBindAnimatedSpriteSetFlipV        = gdnative.NewLazyMethodBind("AnimatedSprite", "set_flip_v")
+	methodBindAnimatedSpriteSetFrame        = gdnative.NewLazyMethodBind("AnimatedSprite", "set_frame")
+	methodBindAnimatedSpriteSetOffset       = gdnative.NewLazyMethodBind("AnimatedSprite", "set_offset")
+	methodBindAnimatedSpriteSetSpriteFrames = gdnative.NewLazyMethodBind("AnimatedSprite", "set_sprite_frames")
+	methodBindAnimatedSpriteStop            = gdnative.NewLazyMethodBind("AnimatedSprite", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AnimatedSprite) X_IsPlaying() gdnative.Bool {
 	//log.Println("Calling AnimatedSprite.X_IsPlaying()")
@@ -45,7 +76,7 @@ func (o *AnimatedSprite) X_IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "_is_playing")
+	methodBind := methodBindAnimatedSpriteX_IsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +89,8 @@ func (o *AnimatedSprite) X_IsPlaying() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *AnimatedSprite) X_ResChanged() {
 	//log.Println("Calling AnimatedSprite.X_ResChanged()")
@@ -68,7 +99,7 @@ func (o *AnimatedSprite) X_ResChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "_res_changed")
+	methodBind := methodBindAnimatedSpriteX_ResChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -78,8 +109,8 @@ func (o *AnimatedSprite) X_ResChanged() {
 }
 
 /*
-        Undocumented
-	Args: [{ false playing bool}], Returns: void
+	        Undocumented
+		Args: [{ false playing bool}], Returns: void
 */
 func (o *AnimatedSprite) X_SetPlaying(playing gdnative.Bool) {
 	//log.Println("Calling AnimatedSprite.X_SetPlaying()")
@@ -89,7 +120,7 @@ func (o *AnimatedSprite) X_SetPlaying(playing gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(playing)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "_set_playing")
+	methodBind := methodBindAnimatedSpriteX_SetPlaying.Get()
 
 	// Call the parent method.
 	// void
@@ -99,8 +130,8 @@ func (o *AnimatedSprite) X_SetPlaying(playing gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AnimatedSprite) GetAnimation() gdnative.String {
 	//log.Println("Calling AnimatedSprite.GetAnimation()")
@@ -109,7 +140,7 @@ func (o *AnimatedSprite) GetAnimation() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "get_animation")
+	methodBind := methodBindAnimatedSpriteGetAnimation.Get()
 
 	// Call the parent method.
 	// String
@@ -122,8 +153,8 @@ func (o *AnimatedSprite) GetAnimation() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *AnimatedSprite) GetFrame() gdnative.Int {
 	//log.Println("Calling AnimatedSprite.GetFrame()")
@@ -132,7 +163,7 @@ func (o *AnimatedSprite) GetFrame() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "get_frame")
+	methodBind := methodBindAnimatedSpriteGetFrame.Get()
 
 	// Call the parent method.
 	// int
@@ -145,8 +176,8 @@ func (o *AnimatedSprite) GetFrame() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *AnimatedSprite) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling AnimatedSprite.GetOffset()")
@@ -155,7 +186,7 @@ func (o *AnimatedSprite) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "get_offset")
+	methodBind := methodBindAnimatedSpriteGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -168,17 +199,17 @@ func (o *AnimatedSprite) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: SpriteFrames
+	        Undocumented
+		Args: [], Returns: SpriteFrames
 */
-func (o *AnimatedSprite) GetSpriteFrames() SpriteFramesImplementer {
+func (o *AnimatedSprite) GetSpriteFrames() godot.SpriteFramesImplementer {
 	//log.Println("Calling AnimatedSprite.GetSpriteFrames()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "get_sprite_frames")
+	methodBind := methodBindAnimatedSpriteGetSpriteFrames.Get()
 
 	// Call the parent method.
 	// SpriteFrames
@@ -186,27 +217,25 @@ func (o *AnimatedSprite) GetSpriteFrames() SpriteFramesImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSpriteFramesFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SpriteFramesImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "SpriteFrames" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SpriteFramesImplementer)
+	ret := godot.SpriteFrames{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.SpriteFramesImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AnimatedSprite) IsCentered() gdnative.Bool {
 	//log.Println("Calling AnimatedSprite.IsCentered()")
@@ -215,7 +244,7 @@ func (o *AnimatedSprite) IsCentered() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "is_centered")
+	methodBind := methodBindAnimatedSpriteIsCentered.Get()
 
 	// Call the parent method.
 	// bool
@@ -228,8 +257,8 @@ func (o *AnimatedSprite) IsCentered() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AnimatedSprite) IsFlippedH() gdnative.Bool {
 	//log.Println("Calling AnimatedSprite.IsFlippedH()")
@@ -238,7 +267,7 @@ func (o *AnimatedSprite) IsFlippedH() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "is_flipped_h")
+	methodBind := methodBindAnimatedSpriteIsFlippedH.Get()
 
 	// Call the parent method.
 	// bool
@@ -251,8 +280,8 @@ func (o *AnimatedSprite) IsFlippedH() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AnimatedSprite) IsFlippedV() gdnative.Bool {
 	//log.Println("Calling AnimatedSprite.IsFlippedV()")
@@ -261,7 +290,7 @@ func (o *AnimatedSprite) IsFlippedV() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "is_flipped_v")
+	methodBind := methodBindAnimatedSpriteIsFlippedV.Get()
 
 	// Call the parent method.
 	// bool
@@ -274,8 +303,8 @@ func (o *AnimatedSprite) IsFlippedV() gdnative.Bool {
 }
 
 /*
-        Return true if an animation if currently being played.
-	Args: [], Returns: bool
+	        Return true if an animation if currently being played.
+		Args: [], Returns: bool
 */
 func (o *AnimatedSprite) IsPlaying() gdnative.Bool {
 	//log.Println("Calling AnimatedSprite.IsPlaying()")
@@ -284,7 +313,7 @@ func (o *AnimatedSprite) IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "is_playing")
+	methodBind := methodBindAnimatedSpriteIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -297,8 +326,8 @@ func (o *AnimatedSprite) IsPlaying() gdnative.Bool {
 }
 
 /*
-        Play the animation set in parameter. If no parameter is provided, the current animation is played.
-	Args: [{ true anim String}], Returns: void
+	        Play the animation set in parameter. If no parameter is provided, the current animation is played.
+		Args: [{ true anim String}], Returns: void
 */
 func (o *AnimatedSprite) Play(anim gdnative.String) {
 	//log.Println("Calling AnimatedSprite.Play()")
@@ -308,7 +337,7 @@ func (o *AnimatedSprite) Play(anim gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "play")
+	methodBind := methodBindAnimatedSpritePlay.Get()
 
 	// Call the parent method.
 	// void
@@ -318,8 +347,8 @@ func (o *AnimatedSprite) Play(anim gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false animation String}], Returns: void
+	        Undocumented
+		Args: [{ false animation String}], Returns: void
 */
 func (o *AnimatedSprite) SetAnimation(animation gdnative.String) {
 	//log.Println("Calling AnimatedSprite.SetAnimation()")
@@ -329,7 +358,7 @@ func (o *AnimatedSprite) SetAnimation(animation gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(animation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "set_animation")
+	methodBind := methodBindAnimatedSpriteSetAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -339,8 +368,8 @@ func (o *AnimatedSprite) SetAnimation(animation gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false centered bool}], Returns: void
+	        Undocumented
+		Args: [{ false centered bool}], Returns: void
 */
 func (o *AnimatedSprite) SetCentered(centered gdnative.Bool) {
 	//log.Println("Calling AnimatedSprite.SetCentered()")
@@ -350,7 +379,7 @@ func (o *AnimatedSprite) SetCentered(centered gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(centered)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "set_centered")
+	methodBind := methodBindAnimatedSpriteSetCentered.Get()
 
 	// Call the parent method.
 	// void
@@ -360,8 +389,8 @@ func (o *AnimatedSprite) SetCentered(centered gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flip_h bool}], Returns: void
+	        Undocumented
+		Args: [{ false flip_h bool}], Returns: void
 */
 func (o *AnimatedSprite) SetFlipH(flipH gdnative.Bool) {
 	//log.Println("Calling AnimatedSprite.SetFlipH()")
@@ -371,7 +400,7 @@ func (o *AnimatedSprite) SetFlipH(flipH gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(flipH)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "set_flip_h")
+	methodBind := methodBindAnimatedSpriteSetFlipH.Get()
 
 	// Call the parent method.
 	// void
@@ -381,8 +410,8 @@ func (o *AnimatedSprite) SetFlipH(flipH gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flip_v bool}], Returns: void
+	        Undocumented
+		Args: [{ false flip_v bool}], Returns: void
 */
 func (o *AnimatedSprite) SetFlipV(flipV gdnative.Bool) {
 	//log.Println("Calling AnimatedSprite.SetFlipV()")
@@ -392,7 +421,7 @@ func (o *AnimatedSprite) SetFlipV(flipV gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(flipV)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "set_flip_v")
+	methodBind := methodBindAnimatedSpriteSetFlipV.Get()
 
 	// Call the parent method.
 	// void
@@ -402,8 +431,8 @@ func (o *AnimatedSprite) SetFlipV(flipV gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false frame int}], Returns: void
+	        Undocumented
+		Args: [{ false frame int}], Returns: void
 */
 func (o *AnimatedSprite) SetFrame(frame gdnative.Int) {
 	//log.Println("Calling AnimatedSprite.SetFrame()")
@@ -413,7 +442,7 @@ func (o *AnimatedSprite) SetFrame(frame gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(frame)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "set_frame")
+	methodBind := methodBindAnimatedSpriteSetFrame.Get()
 
 	// Call the parent method.
 	// void
@@ -423,8 +452,8 @@ func (o *AnimatedSprite) SetFrame(frame gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *AnimatedSprite) SetOffset(offset gdnative.Vector2) {
 	//log.Println("Calling AnimatedSprite.SetOffset()")
@@ -434,7 +463,7 @@ func (o *AnimatedSprite) SetOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "set_offset")
+	methodBind := methodBindAnimatedSpriteSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -444,18 +473,18 @@ func (o *AnimatedSprite) SetOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false sprite_frames SpriteFrames}], Returns: void
+	        Undocumented
+		Args: [{ false sprite_frames SpriteFrames}], Returns: void
 */
-func (o *AnimatedSprite) SetSpriteFrames(spriteFrames SpriteFramesImplementer) {
+func (o *AnimatedSprite) SetSpriteFrames(spriteFrames godot.SpriteFramesImplementer) {
 	//log.Println("Calling AnimatedSprite.SetSpriteFrames()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(spriteFrames.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(spriteFrames))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "set_sprite_frames")
+	methodBind := methodBindAnimatedSpriteSetSpriteFrames.Get()
 
 	// Call the parent method.
 	// void
@@ -465,8 +494,8 @@ func (o *AnimatedSprite) SetSpriteFrames(spriteFrames SpriteFramesImplementer) {
 }
 
 /*
-        Stop the current animation (does not reset the frame counter).
-	Args: [], Returns: void
+	        Stop the current animation (does not reset the frame counter).
+		Args: [], Returns: void
 */
 func (o *AnimatedSprite) Stop() {
 	//log.Println("Calling AnimatedSprite.Stop()")
@@ -475,7 +504,7 @@ func (o *AnimatedSprite) Stop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite", "stop")
+	methodBind := methodBindAnimatedSpriteStop.Get()
 
 	// Call the parent method.
 	// void
@@ -484,17 +513,110 @@ func (o *AnimatedSprite) Stop() {
 
 }
 
+// PlayWithDefaults will call Play using the default values for: anim.
+func (o *AnimatedSprite) PlayWithDefaults() {
+	o.Play("")
+}
+
+// Animation will return the value of the "animation" property.
+func (o *AnimatedSprite) Animation() gdnative.String {
+	return o.GetAnimation()
+}
+
+// Centered will return the value of the "centered" property.
+func (o *AnimatedSprite) Centered() gdnative.Bool {
+	return o.IsCentered()
+}
+
+// FlipH will return the value of the "flip_h" property.
+func (o *AnimatedSprite) FlipH() gdnative.Bool {
+	return o.IsFlippedH()
+}
+
+// FlipV will return the value of the "flip_v" property.
+func (o *AnimatedSprite) FlipV() gdnative.Bool {
+	return o.IsFlippedV()
+}
+
+// Frame will return the value of the "frame" property.
+func (o *AnimatedSprite) Frame() gdnative.Int {
+	return o.GetFrame()
+}
+
+// Frames will return the value of the "frames" property.
+func (o *AnimatedSprite) Frames() godot.SpriteFramesImplementer {
+	return o.GetSpriteFrames()
+}
+
+// SetFrames will set the value of the "frames" property.
+func (o *AnimatedSprite) SetFrames(value godot.SpriteFramesImplementer) {
+	o.SetSpriteFrames(value)
+}
+
+// Offset will return the value of the "offset" property.
+func (o *AnimatedSprite) Offset() gdnative.Vector2 {
+	return o.GetOffset()
+}
+
+// Playing will return the value of the "playing" property.
+func (o *AnimatedSprite) Playing() gdnative.Bool {
+	return o.X_IsPlaying()
+}
+
+// SetPlaying will set the value of the "playing" property.
+func (o *AnimatedSprite) SetPlaying(value gdnative.Bool) {
+	o.X_SetPlaying(value)
+}
+
+// Signals of the AnimatedSprite class.
+const (
+	AnimatedSpriteSignalAnimationFinished gdnative.String = "animation_finished"
+	AnimatedSpriteSignalFrameChanged      gdnative.String = "frame_changed"
+)
+
+// ConnectAnimationFinished will connect the "animation_finished" signal to the given method of the target object.
+func (o *AnimatedSprite) ConnectAnimationFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AnimatedSpriteSignalAnimationFinished, target, method, binds, 0)
+}
+
+// EmitAnimationFinished will emit the "animation_finished" signal.
+func (o *AnimatedSprite) EmitAnimationFinished() error {
+
+	ret, err := o.EmitSignal(AnimatedSpriteSignalAnimationFinished)
+	ret.Destroy()
+	return err
+}
+
+// ConnectFrameChanged will connect the "frame_changed" signal to the given method of the target object.
+func (o *AnimatedSprite) ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AnimatedSpriteSignalFrameChanged, target, method, binds, 0)
+}
+
+// EmitFrameChanged will emit the "frame_changed" signal.
+func (o *AnimatedSprite) EmitFrameChanged() error {
+
+	ret, err := o.EmitSignal(AnimatedSpriteSignalFrameChanged)
+	ret.Destroy()
+	return err
+}
+
 // AnimatedSpriteImplementer is an interface that implements the methods
 // of the AnimatedSprite class.
 type AnimatedSpriteImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	X_IsPlaying() gdnative.Bool
 	X_ResChanged()
 	X_SetPlaying(playing gdnative.Bool)
 	GetAnimation() gdnative.String
 	GetFrame() gdnative.Int
 	GetOffset() gdnative.Vector2
-	GetSpriteFrames() SpriteFramesImplementer
+	GetSpriteFrames() godot.SpriteFramesImplementer
 	IsCentered() gdnative.Bool
 	IsFlippedH() gdnative.Bool
 	IsFlippedV() gdnative.Bool
@@ -506,6 +628,21 @@ type AnimatedSpriteImplementer interface {
 	SetFlipV(flipV gdnative.Bool)
 	SetFrame(frame gdnative.Int)
 	SetOffset(offset gdnative.Vector2)
-	SetSpriteFrames(spriteFrames SpriteFramesImplementer)
+	SetSpriteFrames(spriteFrames godot.SpriteFramesImplementer)
 	Stop()
+	PlayWithDefaults()
+	Animation() gdnative.String
+	Centered() gdnative.Bool
+	FlipH() gdnative.Bool
+	FlipV() gdnative.Bool
+	Frame() gdnative.Int
+	Frames() godot.SpriteFramesImplementer
+	SetFrames(value godot.SpriteFramesImplementer)
+	Offset() gdnative.Vector2
+	Playing() gdnative.Bool
+	SetPlaying(value gdnative.Bool)
+	ConnectAnimationFinished(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAnimationFinished() error
+	ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFrameChanged() error
 }
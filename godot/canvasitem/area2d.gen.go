@@ -0,0 +1,1469 @@
+package canvasitem
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// Area2DSpaceOverride is an enum for SpaceOverride values.
+type Area2DSpaceOverride int
+
+const (
+	Area2DSpaceOverrideCombine        Area2DSpaceOverride = 1
+	Area2DSpaceOverrideCombineReplace Area2DSpaceOverride = 2
+	Area2DSpaceOverrideDisabled       Area2DSpaceOverride = 0
+	Area2DSpaceOverrideReplace        Area2DSpaceOverride = 3
+	Area2DSpaceOverrideReplaceCombine Area2DSpaceOverride = 4
+)
+
+// String will return the Godot name of the Area2DSpaceOverride value.
+func (e Area2DSpaceOverride) String() string {
+	switch e {
+	case Area2DSpaceOverrideCombine:
+		return "SPACE_OVERRIDE_COMBINE"
+	case Area2DSpaceOverrideCombineReplace:
+		return "SPACE_OVERRIDE_COMBINE_REPLACE"
+	case Area2DSpaceOverrideDisabled:
+		return "SPACE_OVERRIDE_DISABLED"
+	case Area2DSpaceOverrideReplace:
+		return "SPACE_OVERRIDE_REPLACE"
+	case Area2DSpaceOverrideReplaceCombine:
+		return "SPACE_OVERRIDE_REPLACE_COMBINE"
+	}
+	return fmt.Sprintf("Area2DSpaceOverride(%d)", int(e))
+}
+
+// ParseArea2DSpaceOverride will return the Area2DSpaceOverride value with the given Godot name.
+func ParseArea2DSpaceOverride(name string) (Area2DSpaceOverride, error) {
+	switch name {
+	case "SPACE_OVERRIDE_COMBINE":
+		return Area2DSpaceOverrideCombine, nil
+	case "SPACE_OVERRIDE_COMBINE_REPLACE":
+		return Area2DSpaceOverrideCombineReplace, nil
+	case "SPACE_OVERRIDE_DISABLED":
+		return Area2DSpaceOverrideDisabled, nil
+	case "SPACE_OVERRIDE_REPLACE":
+		return Area2DSpaceOverrideReplace, nil
+	case "SPACE_OVERRIDE_REPLACE_COMBINE":
+		return Area2DSpaceOverrideReplaceCombine, nil
+	}
+	return 0, fmt.Errorf("invalid Area2DSpaceOverride value %q", name)
+}
+
+/*
+2D area that detects [CollisionObject2D] nodes overlapping, entering, or exiting. Can also alter or override local physics parameters (gravity, damping).
+*/
+type Area2D struct {
+	godot.CollisionObject2D
+	owner gdnative.Object
+}
+
+// classConstructorArea2D is the class constructor of the Area2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorArea2D = gdnative.NewLazyClassConstructor("Area2D")
+
+// NewArea2D will create a new instance of the Area2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewArea2D() *Area2D {
+	obj := &Area2D{}
+	obj.SetBaseObject(classConstructorArea2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Area2D) BaseClass() string {
+	return "Area2D"
+}
+
+// Method binds of the Area2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindArea2DX_AreaEnterTree         = gdnative.NewLazyMethodBind("Area2D", "_area_enter_tree")
+	methodBindArea2DX_AreaExitTree          = gdnative.NewLazyMethodBind("Area2D", "_area_exit_tree")
+	methodBindArea2DX_AreaInout             = gdnative.NewLazyMethodBind("Area2D", "_area_inout")
+	methodBindArea2DX_BodyEnterTree         = gdnative.NewLazyMethodBind("Area2D", "_body_enter_tree")
+	methodBindArea2DX_BodyExitTree          = gdnative.NewLazyMethodBind("Area2D", "_body_exit_tree")
+	methodBindArea2DX_BodyInout             = gdnative.NewLazyMethodBind("Area2D", "_body_inout")
+	methodBindArea2DGetAngularDamp          = gdnative.NewLazyMethodBind("Area2D", "get_angular_damp")
+	methodBindArea2DGetAudioBusName         = gdnative.NewLazyMethodBind("Area2D", "get_audio_bus_name")
+	methodBindArea2DGetCollisionLayer       = gdnative.NewLazyMethodBind("Area2D", "get_collision_layer")
+	methodBindArea2DGetCollisionLayerBit    = gdnative.NewLazyMethodBind("Area2D", "get_collision_layer_bit")
+	methodBindArea2DGetCollisionMask        = gdnative.NewLazyMethodBind("Area2D", "get_collision_mask")
+	methodBindArea2DGetCollisionMaskBit     = gdnative.NewLazyMethodBind("Area2D", "get_collision_mask_bit")
+	methodBindArea2DGetGravity              = gdnative.NewLazyMethodBind("Area2D", "get_gravity")
+	methodBindArea2DGetGravityDistanceScale = gdnative.NewLazyMethodBind("Area2D", "get_gravity_distance_scale")
+	methodBindArea2DGetGravityVector        = gdnative.NewLazyMethodBind("Area2D", "get_gravity_vector")
+	methodBindArea2DGetLinearDamp           = gdnative.NewLazyMethodBind("Area2D", "get_linear_damp")
+	methodBindArea2DGetOverlappingAreas     = gdnative.NewLazyMethodBind("Area2D", "get_overlapping_areas")
+	methodBindArea2DGetOverlappingBodies    = gdnative.NewLazyMethodBind("Area2D", "get_overlapping_bodies")
+	methodBindArea2DGetPriority             = gdnative.NewLazyMethodBind("Area2D", "get_priority")
+	methodBindArea2DGetSpaceOverrideMode    = gdnative.NewLazyMethodBind("Area2D", "get_space_override_mode")
+	methodBindArea2DIsGravityAPoint         = gdnative.NewLazyMethodBind("Area2D", "is_gravity_a_point")
+	methodBindArea2DIsMonitorable           = gdnative.NewLazyMethodBind("Area2D", "is_monitorable")
+	methodBindArea2DIsMonitoring            = gdnative.NewLazyMethodBind("Area2D", "is_monitoring")
+	methodBindArea2DIsOverridingAudioBus    = gdnative.NewLazyMethodBind("Area2D", "is_overriding_audio_bus")
+	methodBindArea2DOverlapsArea            = gdnative.NewLazyMethodBind("Area2D", "overlaps_area")
+	methodBindArea2DOverlapsBody            = gdnative.NewLazyMethodBind("Area2D", "overlaps_body")
+	methodBindArea2DSetAngularDamp          = gdnative.NewLazyMethodBind("Area2D", "set_angular_damp")
+	methodBindArea2DSetAudioBusName         = gdnative.NewLazyMethodBind("Area2D", "set_audio_bus_name")
+	methodBindArea2DSetAudioBusOverride     = gdnative.NewLazyMethodBind("Area2D", "set_audio_bus_override")
+	methodBindArea2DSetCollisionLayer       = gdnative.NewLazyMethodBind("Area2D", "set_collision_layer")
+	methodBindArea2DSetCollisionLayerBit    = gdnative.NewLazyMethodBind("Area2D", "set_collision_layer_bit")
+	methodBindArea2DSetCollisionMask        = gdnative.NewLazyMethodBind("Area2D", "set_collision_mask")
+	methodBindArea2DSetCollisionMaskBit     = gdnative.NewLazyMethodBind("Area2D", "set_collision_mask_bit")
+	methodBindArea2DSetGravity              = gdnative.NewLazyMethodBind("Area2D", "set_gravity")
+	methodBindArea2DSetGravityDistanceScale = gdnative.NewLazyMethodBind("Area2D", "set_gravity_distance_scale")
+	methodBindArea2DSetGravityIsPoint       = gdnative.NewLazyMethodBind("Area2D", "set_gravity_is_point")
+	methodBindArea2DSetGravityVector        = gdnative.NewLazyMethodBind("Area2D", "set_gravity_vector")
+	methodBindArea2DSetLinearDamp           = gdnative.NewLazyMethodBind("Area2D", "set_linear_damp")
+	methodBindArea2DSetMonitorable          = gdnative.NewLazyMethodBind("Area2D", "set_monitorable")
+	methodBindArea2DSetMonitoring           = gdnative.NewLazyMethodBind("Area2D", "set_monitoring")
+	methodBindArea2DSetPriority             = gdnative.NewLazyMethodBind("Area2D", "set_priority")
+	methodBindArea2DSetSpaceOverrideMode    = gdnative.NewLazyMethodBind("Area2D", "set_space_override_mode")
+)
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area2D) X_AreaEnterTree(id gdnative.Int) {
+	//log.Println("Calling Area2D.X_AreaEnterTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindArea2DX_AreaEnterTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area2D) X_AreaExitTree(id gdnative.Int) {
+	//log.Println("Calling Area2D.X_AreaExitTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindArea2DX_AreaExitTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
+*/
+func (o *Area2D) X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
+	//log.Println("Calling Area2D.X_AreaInout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
+	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
+	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
+	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
+
+	// Get the method bind
+	methodBind := methodBindArea2DX_AreaInout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area2D) X_BodyEnterTree(id gdnative.Int) {
+	//log.Println("Calling Area2D.X_BodyEnterTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindArea2DX_BodyEnterTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area2D) X_BodyExitTree(id gdnative.Int) {
+	//log.Println("Calling Area2D.X_BodyExitTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindArea2DX_BodyExitTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
+*/
+func (o *Area2D) X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
+	//log.Println("Calling Area2D.X_BodyInout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
+	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
+	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
+	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
+
+	// Get the method bind
+	methodBind := methodBindArea2DX_BodyInout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area2D) GetAngularDamp() gdnative.Real {
+	//log.Println("Calling Area2D.GetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetAngularDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Area2D) GetAudioBusName() gdnative.String {
+	//log.Println("Calling Area2D.GetAudioBusName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetAudioBusName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Area2D) GetCollisionLayer() gdnative.Int {
+	//log.Println("Calling Area2D.GetCollisionLayer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetCollisionLayer.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Return an individual bit on the layer mask. Describes whether other areas will collide with this one on the given layer.
+		Args: [{ false bit int}], Returns: bool
+*/
+func (o *Area2D) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
+	//log.Println("Calling Area2D.GetCollisionLayerBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetCollisionLayerBit.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Area2D) GetCollisionMask() gdnative.Int {
+	//log.Println("Calling Area2D.GetCollisionMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetCollisionMask.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Return an individual bit on the collision mask. Describes whether this area will collide with others on the given layer.
+		Args: [{ false bit int}], Returns: bool
+*/
+func (o *Area2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
+	//log.Println("Calling Area2D.GetCollisionMaskBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetCollisionMaskBit.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area2D) GetGravity() gdnative.Real {
+	//log.Println("Calling Area2D.GetGravity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetGravity.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area2D) GetGravityDistanceScale() gdnative.Real {
+	//log.Println("Calling Area2D.GetGravityDistanceScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetGravityDistanceScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *Area2D) GetGravityVector() gdnative.Vector2 {
+	//log.Println("Calling Area2D.GetGravityVector()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetGravityVector.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area2D) GetLinearDamp() gdnative.Real {
+	//log.Println("Calling Area2D.GetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetLinearDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a list of intersecting [code]Area2D[/code]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
+		Args: [], Returns: Array
+*/
+func (o *Area2D) GetOverlappingAreas() gdnative.Array {
+	//log.Println("Calling Area2D.GetOverlappingAreas()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetOverlappingAreas.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a list of intersecting [PhysicsBody2D]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
+		Args: [], Returns: Array
+*/
+func (o *Area2D) GetOverlappingBodies() gdnative.Array {
+	//log.Println("Calling Area2D.GetOverlappingBodies()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetOverlappingBodies.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area2D) GetPriority() gdnative.Real {
+	//log.Println("Calling Area2D.GetPriority()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetPriority.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Area2D::SpaceOverride
+*/
+func (o *Area2D) GetSpaceOverrideMode() Area2DSpaceOverride {
+	//log.Println("Calling Area2D.GetSpaceOverrideMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DGetSpaceOverrideMode.Get()
+
+	// Call the parent method.
+	// enum.Area2D::SpaceOverride
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return Area2DSpaceOverride(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area2D) IsGravityAPoint() gdnative.Bool {
+	//log.Println("Calling Area2D.IsGravityAPoint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DIsGravityAPoint.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area2D) IsMonitorable() gdnative.Bool {
+	//log.Println("Calling Area2D.IsMonitorable()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DIsMonitorable.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area2D) IsMonitoring() gdnative.Bool {
+	//log.Println("Calling Area2D.IsMonitoring()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DIsMonitoring.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area2D) IsOverridingAudioBus() gdnative.Bool {
+	//log.Println("Calling Area2D.IsOverridingAudioBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindArea2DIsOverridingAudioBus.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If [code]true[/code] the given area overlaps the Area2D. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
+		Args: [{ false area Object}], Returns: bool
+*/
+func (o *Area2D) OverlapsArea(area godot.ObjectImplementer) gdnative.Bool {
+	//log.Println("Calling Area2D.OverlapsArea()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(area))
+
+	// Get the method bind
+	methodBind := methodBindArea2DOverlapsArea.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If [code]true[/code] the given body overlaps the Area2D. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
+		Args: [{ false body Object}], Returns: bool
+*/
+func (o *Area2D) OverlapsBody(body godot.ObjectImplementer) gdnative.Bool {
+	//log.Println("Calling Area2D.OverlapsBody()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(body))
+
+	// Get the method bind
+	methodBind := methodBindArea2DOverlapsBody.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false angular_damp float}], Returns: void
+*/
+func (o *Area2D) SetAngularDamp(angularDamp gdnative.Real) {
+	//log.Println("Calling Area2D.SetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angularDamp)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetAngularDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: void
+*/
+func (o *Area2D) SetAudioBusName(name gdnative.String) {
+	//log.Println("Calling Area2D.SetAudioBusName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetAudioBusName.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area2D) SetAudioBusOverride(enable gdnative.Bool) {
+	//log.Println("Calling Area2D.SetAudioBusOverride()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetAudioBusOverride.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false collision_layer int}], Returns: void
+*/
+func (o *Area2D) SetCollisionLayer(collisionLayer gdnative.Int) {
+	//log.Println("Calling Area2D.SetCollisionLayer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(collisionLayer)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetCollisionLayer.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set/clear individual bits on the layer mask. This makes getting an area in/out of only one layer easier.
+		Args: [{ false bit int} { false value bool}], Returns: void
+*/
+func (o *Area2D) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
+	//log.Println("Calling Area2D.SetCollisionLayerBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+	ptrArguments[1] = gdnative.NewPointerFromBool(value)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetCollisionLayerBit.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false collision_mask int}], Returns: void
+*/
+func (o *Area2D) SetCollisionMask(collisionMask gdnative.Int) {
+	//log.Println("Calling Area2D.SetCollisionMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(collisionMask)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetCollisionMask.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set/clear individual bits on the collision mask. This makes selecting the areas scanned easier.
+		Args: [{ false bit int} { false value bool}], Returns: void
+*/
+func (o *Area2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
+	//log.Println("Calling Area2D.SetCollisionMaskBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+	ptrArguments[1] = gdnative.NewPointerFromBool(value)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetCollisionMaskBit.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false gravity float}], Returns: void
+*/
+func (o *Area2D) SetGravity(gravity gdnative.Real) {
+	//log.Println("Calling Area2D.SetGravity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(gravity)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetGravity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false distance_scale float}], Returns: void
+*/
+func (o *Area2D) SetGravityDistanceScale(distanceScale gdnative.Real) {
+	//log.Println("Calling Area2D.SetGravityDistanceScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(distanceScale)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetGravityDistanceScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area2D) SetGravityIsPoint(enable gdnative.Bool) {
+	//log.Println("Calling Area2D.SetGravityIsPoint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetGravityIsPoint.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false vector Vector2}], Returns: void
+*/
+func (o *Area2D) SetGravityVector(vector gdnative.Vector2) {
+	//log.Println("Calling Area2D.SetGravityVector()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(vector)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetGravityVector.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false linear_damp float}], Returns: void
+*/
+func (o *Area2D) SetLinearDamp(linearDamp gdnative.Real) {
+	//log.Println("Calling Area2D.SetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(linearDamp)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetLinearDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area2D) SetMonitorable(enable gdnative.Bool) {
+	//log.Println("Calling Area2D.SetMonitorable()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetMonitorable.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area2D) SetMonitoring(enable gdnative.Bool) {
+	//log.Println("Calling Area2D.SetMonitoring()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetMonitoring.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false priority float}], Returns: void
+*/
+func (o *Area2D) SetPriority(priority gdnative.Real) {
+	//log.Println("Calling Area2D.SetPriority()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(priority)
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetPriority.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false space_override_mode enum.Area2D::SpaceOverride}], Returns: void
+*/
+func (o *Area2D) SetSpaceOverrideMode(spaceOverrideMode Area2DSpaceOverride) {
+	//log.Println("Calling Area2D.SetSpaceOverrideMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(spaceOverrideMode))
+
+	// Get the method bind
+	methodBind := methodBindArea2DSetSpaceOverrideMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AngularDamp will return the value of the "angular_damp" property.
+func (o *Area2D) AngularDamp() gdnative.Real {
+	return o.GetAngularDamp()
+}
+
+// AudioBusName will return the value of the "audio_bus_name" property.
+func (o *Area2D) AudioBusName() gdnative.String {
+	return o.GetAudioBusName()
+}
+
+// AudioBusOverride will return the value of the "audio_bus_override" property.
+func (o *Area2D) AudioBusOverride() gdnative.Bool {
+	return o.IsOverridingAudioBus()
+}
+
+// CollisionLayer will return the value of the "collision_layer" property.
+func (o *Area2D) CollisionLayer() gdnative.Int {
+	return o.GetCollisionLayer()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *Area2D) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// Gravity will return the value of the "gravity" property.
+func (o *Area2D) Gravity() gdnative.Real {
+	return o.GetGravity()
+}
+
+// GravityDistanceScale will return the value of the "gravity_distance_scale" property.
+func (o *Area2D) GravityDistanceScale() gdnative.Real {
+	return o.GetGravityDistanceScale()
+}
+
+// GravityPoint will return the value of the "gravity_point" property.
+func (o *Area2D) GravityPoint() gdnative.Bool {
+	return o.IsGravityAPoint()
+}
+
+// SetGravityPoint will set the value of the "gravity_point" property.
+func (o *Area2D) SetGravityPoint(value gdnative.Bool) {
+	o.SetGravityIsPoint(value)
+}
+
+// GravityVec will return the value of the "gravity_vec" property.
+func (o *Area2D) GravityVec() gdnative.Vector2 {
+	return o.GetGravityVector()
+}
+
+// SetGravityVec will set the value of the "gravity_vec" property.
+func (o *Area2D) SetGravityVec(value gdnative.Vector2) {
+	o.SetGravityVector(value)
+}
+
+// LinearDamp will return the value of the "linear_damp" property.
+func (o *Area2D) LinearDamp() gdnative.Real {
+	return o.GetLinearDamp()
+}
+
+// Monitorable will return the value of the "monitorable" property.
+func (o *Area2D) Monitorable() gdnative.Bool {
+	return o.IsMonitorable()
+}
+
+// Monitoring will return the value of the "monitoring" property.
+func (o *Area2D) Monitoring() gdnative.Bool {
+	return o.IsMonitoring()
+}
+
+// Priority will return the value of the "priority" property.
+func (o *Area2D) Priority() gdnative.Real {
+	return o.GetPriority()
+}
+
+// SpaceOverride will return the value of the "space_override" property.
+func (o *Area2D) SpaceOverride() Area2DSpaceOverride {
+	return o.GetSpaceOverrideMode()
+}
+
+// SetSpaceOverride will set the value of the "space_override" property.
+func (o *Area2D) SetSpaceOverride(value Area2DSpaceOverride) {
+	o.SetSpaceOverrideMode(value)
+}
+
+// Signals of the Area2D class.
+const (
+	Area2DSignalAreaEntered      gdnative.String = "area_entered"
+	Area2DSignalAreaExited       gdnative.String = "area_exited"
+	Area2DSignalAreaShapeEntered gdnative.String = "area_shape_entered"
+	Area2DSignalAreaShapeExited  gdnative.String = "area_shape_exited"
+	Area2DSignalBodyEntered      gdnative.String = "body_entered"
+	Area2DSignalBodyExited       gdnative.String = "body_exited"
+	Area2DSignalBodyShapeEntered gdnative.String = "body_shape_entered"
+	Area2DSignalBodyShapeExited  gdnative.String = "body_shape_exited"
+)
+
+// Area2DAreaEnteredArgs holds the arguments of the "area_entered" signal.
+type Area2DAreaEnteredArgs struct {
+	Area Area2DImplementer
+}
+
+// ConnectAreaEntered will connect the "area_entered" signal to the given method of the target object.
+func (o *Area2D) ConnectAreaEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalAreaEntered, target, method, binds, 0)
+}
+
+// EmitAreaEntered will emit the "area_entered" signal.
+func (o *Area2D) EmitAreaEntered(args Area2DAreaEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalAreaEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// Area2DAreaExitedArgs holds the arguments of the "area_exited" signal.
+type Area2DAreaExitedArgs struct {
+	Area Area2DImplementer
+}
+
+// ConnectAreaExited will connect the "area_exited" signal to the given method of the target object.
+func (o *Area2D) ConnectAreaExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalAreaExited, target, method, binds, 0)
+}
+
+// EmitAreaExited will emit the "area_exited" signal.
+func (o *Area2D) EmitAreaExited(args Area2DAreaExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalAreaExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// Area2DAreaShapeEnteredArgs holds the arguments of the "area_shape_entered" signal.
+type Area2DAreaShapeEnteredArgs struct {
+	AreaId    gdnative.Int
+	Area      Area2DImplementer
+	AreaShape gdnative.Int
+	SelfShape gdnative.Int
+}
+
+// ConnectAreaShapeEntered will connect the "area_shape_entered" signal to the given method of the target object.
+func (o *Area2D) ConnectAreaShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalAreaShapeEntered, target, method, binds, 0)
+}
+
+// EmitAreaShapeEntered will emit the "area_shape_entered" signal.
+func (o *Area2D) EmitAreaShapeEntered(args Area2DAreaShapeEnteredArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.SelfShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalAreaShapeEntered, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// Area2DAreaShapeExitedArgs holds the arguments of the "area_shape_exited" signal.
+type Area2DAreaShapeExitedArgs struct {
+	AreaId    gdnative.Int
+	Area      Area2DImplementer
+	AreaShape gdnative.Int
+	SelfShape gdnative.Int
+}
+
+// ConnectAreaShapeExited will connect the "area_shape_exited" signal to the given method of the target object.
+func (o *Area2D) ConnectAreaShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalAreaShapeExited, target, method, binds, 0)
+}
+
+// EmitAreaShapeExited will emit the "area_shape_exited" signal.
+func (o *Area2D) EmitAreaShapeExited(args Area2DAreaShapeExitedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.SelfShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalAreaShapeExited, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// Area2DBodyEnteredArgs holds the arguments of the "body_entered" signal.
+type Area2DBodyEnteredArgs struct {
+	Body godot.PhysicsBody2DImplementer
+}
+
+// ConnectBodyEntered will connect the "body_entered" signal to the given method of the target object.
+func (o *Area2D) ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalBodyEntered, target, method, binds, 0)
+}
+
+// EmitBodyEntered will emit the "body_entered" signal.
+func (o *Area2D) EmitBodyEntered(args Area2DBodyEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalBodyEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// Area2DBodyExitedArgs holds the arguments of the "body_exited" signal.
+type Area2DBodyExitedArgs struct {
+	Body godot.PhysicsBody2DImplementer
+}
+
+// ConnectBodyExited will connect the "body_exited" signal to the given method of the target object.
+func (o *Area2D) ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalBodyExited, target, method, binds, 0)
+}
+
+// EmitBodyExited will emit the "body_exited" signal.
+func (o *Area2D) EmitBodyExited(args Area2DBodyExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalBodyExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// Area2DBodyShapeEnteredArgs holds the arguments of the "body_shape_entered" signal.
+type Area2DBodyShapeEnteredArgs struct {
+	BodyId    gdnative.Int
+	Body      godot.PhysicsBody2DImplementer
+	BodyShape gdnative.Int
+	AreaShape gdnative.Int
+}
+
+// ConnectBodyShapeEntered will connect the "body_shape_entered" signal to the given method of the target object.
+func (o *Area2D) ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalBodyShapeEntered, target, method, binds, 0)
+}
+
+// EmitBodyShapeEntered will emit the "body_shape_entered" signal.
+func (o *Area2D) EmitBodyShapeEntered(args Area2DBodyShapeEnteredArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalBodyShapeEntered, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// Area2DBodyShapeExitedArgs holds the arguments of the "body_shape_exited" signal.
+type Area2DBodyShapeExitedArgs struct {
+	BodyId    gdnative.Int
+	Body      godot.PhysicsBody2DImplementer
+	BodyShape gdnative.Int
+	AreaShape gdnative.Int
+}
+
+// ConnectBodyShapeExited will connect the "body_shape_exited" signal to the given method of the target object.
+func (o *Area2D) ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Area2DSignalBodyShapeExited, target, method, binds, 0)
+}
+
+// EmitBodyShapeExited will emit the "body_shape_exited" signal.
+func (o *Area2D) EmitBodyShapeExited(args Area2DBodyShapeExitedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(Area2DSignalBodyShapeExited, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// Area2DImplementer is an interface that implements the methods
+// of the Area2D class.
+type Area2DImplementer interface {
+	godot.CollisionObject2DImplementer
+	X_AreaEnterTree(id gdnative.Int)
+	X_AreaExitTree(id gdnative.Int)
+	X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
+	X_BodyEnterTree(id gdnative.Int)
+	X_BodyExitTree(id gdnative.Int)
+	X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
+	GetAngularDamp() gdnative.Real
+	GetAudioBusName() gdnative.String
+	GetCollisionLayer() gdnative.Int
+	GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool
+	GetCollisionMask() gdnative.Int
+	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
+	GetGravity() gdnative.Real
+	GetGravityDistanceScale() gdnative.Real
+	GetGravityVector() gdnative.Vector2
+	GetLinearDamp() gdnative.Real
+	GetOverlappingAreas() gdnative.Array
+	GetOverlappingBodies() gdnative.Array
+	GetPriority() gdnative.Real
+	GetSpaceOverrideMode() Area2DSpaceOverride
+	IsGravityAPoint() gdnative.Bool
+	IsMonitorable() gdnative.Bool
+	IsMonitoring() gdnative.Bool
+	IsOverridingAudioBus() gdnative.Bool
+	OverlapsArea(area godot.ObjectImplementer) gdnative.Bool
+	OverlapsBody(body godot.ObjectImplementer) gdnative.Bool
+	SetAngularDamp(angularDamp gdnative.Real)
+	SetAudioBusName(name gdnative.String)
+	SetAudioBusOverride(enable gdnative.Bool)
+	SetCollisionLayer(collisionLayer gdnative.Int)
+	SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool)
+	SetCollisionMask(collisionMask gdnative.Int)
+	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
+	SetGravity(gravity gdnative.Real)
+	SetGravityDistanceScale(distanceScale gdnative.Real)
+	SetGravityIsPoint(enable gdnative.Bool)
+	SetGravityVector(vector gdnative.Vector2)
+	SetLinearDamp(linearDamp gdnative.Real)
+	SetMonitorable(enable gdnative.Bool)
+	SetMonitoring(enable gdnative.Bool)
+	SetPriority(priority gdnative.Real)
+	SetSpaceOverrideMode(spaceOverrideMode Area2DSpaceOverride)
+	AngularDamp() gdnative.Real
+	AudioBusName() gdnative.String
+	AudioBusOverride() gdnative.Bool
+	CollisionLayer() gdnative.Int
+	CollisionMask() gdnative.Int
+	Gravity() gdnative.Real
+	GravityDistanceScale() gdnative.Real
+	GravityPoint() gdnative.Bool
+	SetGravityPoint(value gdnative.Bool)
+	GravityVec() gdnative.Vector2
+	SetGravityVec(value gdnative.Vector2)
+	LinearDamp() gdnative.Real
+	Monitorable() gdnative.Bool
+	Monitoring() gdnative.Bool
+	Priority() gdnative.Real
+	SpaceOverride() Area2DSpaceOverride
+	SetSpaceOverride(value Area2DSpaceOverride)
+	ConnectAreaEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaEntered(args Area2DAreaEnteredArgs) error
+	ConnectAreaExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaExited(args Area2DAreaExitedArgs) error
+	ConnectAreaShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaShapeEntered(args Area2DAreaShapeEnteredArgs) error
+	ConnectAreaShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaShapeExited(args Area2DAreaShapeExitedArgs) error
+	ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyEntered(args Area2DBodyEnteredArgs) error
+	ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyExited(args Area2DBodyExitedArgs) error
+	ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeEntered(args Area2DBodyShapeEnteredArgs) error
+	ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeExited(args Area2DBodyShapeExitedArgs) error
+}
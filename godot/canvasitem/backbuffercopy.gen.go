@@ -1,7 +1,10 @@
-package godot
+package canvasitem
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,30 +25,70 @@ const (
 	BackBufferCopyCopyModeViewport BackBufferCopyCopyMode = 2
 )
 
-//func NewBackBufferCopyFromPointer(ptr gdnative.Pointer) BackBufferCopy {
-func newBackBufferCopyFromPointer(ptr gdnative.Pointer) BackBufferCopy {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BackBufferCopy{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the BackBufferCopyCopyMode value.
+func (e BackBufferCopyCopyMode) String() string {
+	switch e {
+	case BackBufferCopyCopyModeDisabled:
+		return "COPY_MODE_DISABLED"
+	case BackBufferCopyCopyModeRect:
+		return "COPY_MODE_RECT"
+	case BackBufferCopyCopyModeViewport:
+		return "COPY_MODE_VIEWPORT"
+	}
+	return fmt.Sprintf("BackBufferCopyCopyMode(%d)", int(e))
+}
 
-	return obj
+// ParseBackBufferCopyCopyMode will return the BackBufferCopyCopyMode value with the given Godot name.
+func ParseBackBufferCopyCopyMode(name string) (BackBufferCopyCopyMode, error) {
+	switch name {
+	case "COPY_MODE_DISABLED":
+		return BackBufferCopyCopyModeDisabled, nil
+	case "COPY_MODE_RECT":
+		return BackBufferCopyCopyModeRect, nil
+	case "COPY_MODE_VIEWPORT":
+		return BackBufferCopyCopyModeViewport, nil
+	}
+	return 0, fmt.Errorf("invalid BackBufferCopyCopyMode value %q", name)
 }
 
 /*
 Node for back-buffering the currently displayed screen. The region defined in the BackBufferCopy node is bufferized with the content of the screen it covers, or the entire screen according to the copy mode set. Accessing this buffer is done with the texscreen() shader instruction.
 */
 type BackBufferCopy struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorBackBufferCopy is the class constructor of the BackBufferCopy class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorBackBufferCopy = gdnative.NewLazyClassConstructor("BackBufferCopy")
+
+// NewBackBufferCopy will create a new instance of the BackBufferCopy class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewBackBufferCopy() *BackBufferCopy {
+	obj := &BackBufferCopy{}
+	obj.SetBaseObject(classConstructorBackBufferCopy.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *BackBufferCopy) BaseClass() string {
 	return "BackBufferCopy"
 }
 
+// Method binds of the BackBufferCopy class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBackBufferCopyGetCopyMode = gdnative.NewLazyMethodBind("BackBufferCopy", "get_copy_mode")
+	methodBindBackBufferCopyGetRect     = gdnative.NewLazyMethodBind("BackBufferCopy", "get_rect")
+	methodBindBackBufferCopySetCopyMode = gdnative.NewLazyMethodBind("BackBufferCopy", "set_copy_mode")
+	methodBindBackBufferCopySetRect     = gdnative.NewLazyMethodBind("BackBufferCopy", "set_rect")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.BackBufferCopy::CopyMode
+	        Undocumented
+		Args: [], Returns: enum.BackBufferCopy::CopyMode
 */
 func (o *BackBufferCopy) GetCopyMode() BackBufferCopyCopyMode {
 	//log.Println("Calling BackBufferCopy.GetCopyMode()")
@@ -54,7 +97,7 @@ func (o *BackBufferCopy) GetCopyMode() BackBufferCopyCopyMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BackBufferCopy", "get_copy_mode")
+	methodBind := methodBindBackBufferCopyGetCopyMode.Get()
 
 	// Call the parent method.
 	// enum.BackBufferCopy::CopyMode
@@ -67,8 +110,8 @@ func (o *BackBufferCopy) GetCopyMode() BackBufferCopyCopyMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *BackBufferCopy) GetRect() gdnative.Rect2 {
 	//log.Println("Calling BackBufferCopy.GetRect()")
@@ -77,7 +120,7 @@ func (o *BackBufferCopy) GetRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BackBufferCopy", "get_rect")
+	methodBind := methodBindBackBufferCopyGetRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -90,18 +133,18 @@ func (o *BackBufferCopy) GetRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false copy_mode int}], Returns: void
+	        Undocumented
+		Args: [{ false copy_mode enum.BackBufferCopy::CopyMode}], Returns: void
 */
-func (o *BackBufferCopy) SetCopyMode(copyMode gdnative.Int) {
+func (o *BackBufferCopy) SetCopyMode(copyMode BackBufferCopyCopyMode) {
 	//log.Println("Calling BackBufferCopy.SetCopyMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(copyMode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(copyMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BackBufferCopy", "set_copy_mode")
+	methodBind := methodBindBackBufferCopySetCopyMode.Get()
 
 	// Call the parent method.
 	// void
@@ -111,8 +154,8 @@ func (o *BackBufferCopy) SetCopyMode(copyMode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rect Rect2}], Returns: void
+	        Undocumented
+		Args: [{ false rect Rect2}], Returns: void
 */
 func (o *BackBufferCopy) SetRect(rect gdnative.Rect2) {
 	//log.Println("Calling BackBufferCopy.SetRect()")
@@ -122,7 +165,7 @@ func (o *BackBufferCopy) SetRect(rect gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BackBufferCopy", "set_rect")
+	methodBind := methodBindBackBufferCopySetRect.Get()
 
 	// Call the parent method.
 	// void
@@ -131,11 +174,24 @@ func (o *BackBufferCopy) SetRect(rect gdnative.Rect2) {
 
 }
 
+// CopyMode will return the value of the "copy_mode" property.
+func (o *BackBufferCopy) CopyMode() BackBufferCopyCopyMode {
+	return o.GetCopyMode()
+}
+
+// Rect will return the value of the "rect" property.
+func (o *BackBufferCopy) Rect() gdnative.Rect2 {
+	return o.GetRect()
+}
+
 // BackBufferCopyImplementer is an interface that implements the methods
 // of the BackBufferCopy class.
 type BackBufferCopyImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
+	GetCopyMode() BackBufferCopyCopyMode
 	GetRect() gdnative.Rect2
-	SetCopyMode(copyMode gdnative.Int)
+	SetCopyMode(copyMode BackBufferCopyCopyMode)
 	SetRect(rect gdnative.Rect2)
+	CopyMode() BackBufferCopyCopyMode
+	Rect() gdnative.Rect2
 }
@@ -1,7 +1,10 @@
-package godot
+package canvasitem
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,40 +24,118 @@ const (
 	Camera2DAnchorModeFixedTopLeft Camera2DAnchorMode = 0
 )
 
-//func NewCamera2DFromPointer(ptr gdnative.Pointer) Camera2D {
-func newCamera2DFromPointer(ptr gdnative.Pointer) Camera2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Camera2D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the Camera2DAnchorMode value.
+func (e Camera2DAnchorMode) String() string {
+	switch e {
+	case Camera2DAnchorModeDragCenter:
+		return "ANCHOR_MODE_DRAG_CENTER"
+	case Camera2DAnchorModeFixedTopLeft:
+		return "ANCHOR_MODE_FIXED_TOP_LEFT"
+	}
+	return fmt.Sprintf("Camera2DAnchorMode(%d)", int(e))
+}
 
-	return obj
+// ParseCamera2DAnchorMode will return the Camera2DAnchorMode value with the given Godot name.
+func ParseCamera2DAnchorMode(name string) (Camera2DAnchorMode, error) {
+	switch name {
+	case "ANCHOR_MODE_DRAG_CENTER":
+		return Camera2DAnchorModeDragCenter, nil
+	case "ANCHOR_MODE_FIXED_TOP_LEFT":
+		return Camera2DAnchorModeFixedTopLeft, nil
+	}
+	return 0, fmt.Errorf("invalid Camera2DAnchorMode value %q", name)
 }
 
 /*
 Camera node for 2D scenes. It forces the screen (current layer) to scroll following this node. This makes it easier (and faster) to program scrollable scenes than manually changing the position of [CanvasItem] based nodes. This node is intended to be a simple helper to get things going quickly and it may happen often that more functionality is desired to change how the camera works. To make your own custom camera node, simply inherit from [Node2D] and change the transform of the canvas by calling get_viewport().set_canvas_transform(m) in [Viewport].
 */
 type Camera2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorCamera2D is the class constructor of the Camera2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCamera2D = gdnative.NewLazyClassConstructor("Camera2D")
+
+// NewCamera2D will create a new instance of the Camera2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCamera2D() *Camera2D {
+	obj := &Camera2D{}
+	obj.SetBaseObject(classConstructorCamera2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Camera2D) BaseClass() string {
 	return "Camera2D"
 }
 
+// Method binds of the Camera2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCamera2DX_MakeCurrent            = gdnative.NewLazyMethodBind("Camera2D", "_make_current")
+	methodBindCamera2DX_SetCurrent             = gdnative.NewLazyMethodBind("Camera2D", "_set_current")
+	methodBindCamera2DX_SetOldSmoothing        = gdnative.NewLazyMethodBind("Camera2D", "_set_old_smoothing")
+	methodBindCamera2DX_UpdateScroll           = gdnative.NewLazyMethodBind("Camera2D", "_update_scroll")
+	methodBindCamera2DAlign                    = gdnative.NewLazyMethodBind("Camera2D", "align")
+	methodBindCamera2DClearCurrent             = gdnative.NewLazyMethodBind("Camera2D", "clear_current")
+	methodBindCamera2DForceUpdateScroll        = gdnative.NewLazyMethodBind("Camera2D", "force_update_scroll")
+	methodBindCamera2DGetAnchorMode            = gdnative.NewLazyMethodBind("Camera2D", "get_anchor_mode")
+	methodBindCamera2DGetCameraPosition        = gdnative.NewLazyMethodBind("Camera2D", "get_camera_position")
+	methodBindCamera2DGetCameraScreenCenter    = gdnative.NewLazyMethodBind("Camera2D", "get_camera_screen_center")
+	methodBindCamera2DGetCustomViewport        = gdnative.NewLazyMethodBind("Camera2D", "get_custom_viewport")
+	methodBindCamera2DGetDragMargin            = gdnative.NewLazyMethodBind("Camera2D", "get_drag_margin")
+	methodBindCamera2DGetFollowSmoothing       = gdnative.NewLazyMethodBind("Camera2D", "get_follow_smoothing")
+	methodBindCamera2DGetHOffset               = gdnative.NewLazyMethodBind("Camera2D", "get_h_offset")
+	methodBindCamera2DGetLimit                 = gdnative.NewLazyMethodBind("Camera2D", "get_limit")
+	methodBindCamera2DGetOffset                = gdnative.NewLazyMethodBind("Camera2D", "get_offset")
+	methodBindCamera2DGetVOffset               = gdnative.NewLazyMethodBind("Camera2D", "get_v_offset")
+	methodBindCamera2DGetZoom                  = gdnative.NewLazyMethodBind("Camera2D", "get_zoom")
+	methodBindCamera2DIsCurrent                = gdnative.NewLazyMethodBind("Camera2D", "is_current")
+	methodBindCamera2DIsFollowSmoothingEnabled = gdnative.NewLazyMethodBind("Camera2D", "is_follow_smoothing_enabled")
+	methodBindCamera2DIsHDragEnabled           = gdnative.NewLazyMethodBind("Camera2D", "is_h_drag_enabled")
+	methodBindCamera2DIsLimitDrawingEnabled    = gdnative.NewLazyMethodBind("Camera2D", "is_limit_drawing_enabled")
+	methodBindCamera2DIsLimitSmoothingEnabled  = gdnative.NewLazyMethodBind("Camera2D", "is_limit_smoothing_enabled")
+	methodBindCamera2DIsMarginDrawingEnabled   = gdnative.NewLazyMethodBind("Camera2D", "is_margin_drawing_enabled")
+	methodBindCamera2DIsRotating               = gdnative.NewLazyMethodBind("Camera2D", "is_rotating")
+	methodBindCamera2DIsScreenDrawingEnabled   = gdnative.NewLazyMethodBind("Camera2D", "is_screen_drawing_enabled")
+	methodBindCamera2DIsVDragEnabled           = gdnative.NewLazyMethodBind("Camera2D", "is_v_drag_enabled")
+	methodBindCamera2DMakeCurrent              = gdnative.NewLazyMethodBind("Camera2D", "make_current")
+	methodBindCamera2DResetSmoothing           = gdnative.NewLazyMethodBind("Camera2D", "reset_smoothing")
+	methodBindCamera2DSetAnchorMode            = gdnative.NewLazyMethodBind("Camera2D", "set_anchor_mode")
+	methodBindCamera2DSetCustomViewport        = gdnative.NewLazyMethodBind("Camera2D", "set_custom_viewport")
+	methodBindCamera2DSetDragMargin            = gdnative.NewLazyMethodBind("Camera2D", "set_drag_margin")
+	methodBindCamera2DSetEnableFollowSmoothing = gdnative.NewLazyMethodBind("Camera2D", "set_enable_follow_smoothing")
+	methodBindCamera2DSetFollowSmoothing       = gdnative.NewLazyMethodBind("Camera2D", "set_follow_smoothing")
+	methodBindCamera2DSetHDragEnabled          = gdnative.NewLazyMethodBind("Camera2D", "set_h_drag_enabled")
+	methodBindCamera2DSetHOffset               = gdnative.NewLazyMethodBind("Camera2D", "set_h_offset")
+	methodBindCamera2DSetLimit                 = gdnative.NewLazyMethodBind("Camera2D", "set_limit")
+	methodBindCamera2DSetLimitDrawingEnabled   = gdnative.NewLazyMethodBind("Camera2D", "set_limit_drawing_enabled")
+	methodBindCamera2DSetLimitSmoothingEnabled = gdnative.NewLazyMethodBind("Camera2D", "set_limit_smoothing_enabled")
+	methodBindCamera2DSetMarginDrawingEnabled  = gdnative.NewLazyMethodBind("Camera2D", "set_margin_drawing_enabled")
+	methodBindCamera2DSetOffset                = gdnative.NewLazyMethodBind("Camera2D", "set_offset")
+	methodBindCamera2DSetRotating              = gdnative.NewLazyMethodBind("Camera2D", "set_rotating")
+	methodBindCamera2DSetScreenDrawingEnabled  = gdnative.NewLazyMethodBind("Camera2D", "set_screen_drawing_enabled")
+	methodBindCamera2DSetVDragEnabled          = gdnative.NewLazyMethodBind("Camera2D", "set_v_drag_enabled")
+	methodBindCamera2DSetVOffset               = gdnative.NewLazyMethodBind("Camera2D", "set_v_offset")
+	methodBindCamera2DSetZoom                  = gdnative.NewLazyMethodBind("Camera2D", "set_zoom")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 Object}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
 */
-func (o *Camera2D) X_MakeCurrent(arg0 ObjectImplementer) {
+func (o *Camera2D) X_MakeCurrent(arg0 godot.ObjectImplementer) {
 	//log.Println("Calling Camera2D.X_MakeCurrent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "_make_current")
+	methodBind := methodBindCamera2DX_MakeCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -64,8 +145,8 @@ func (o *Camera2D) X_MakeCurrent(arg0 ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false current bool}], Returns: void
+	        Undocumented
+		Args: [{ false current bool}], Returns: void
 */
 func (o *Camera2D) X_SetCurrent(current gdnative.Bool) {
 	//log.Println("Calling Camera2D.X_SetCurrent()")
@@ -75,7 +156,7 @@ func (o *Camera2D) X_SetCurrent(current gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(current)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "_set_current")
+	methodBind := methodBindCamera2DX_SetCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -85,8 +166,8 @@ func (o *Camera2D) X_SetCurrent(current gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false follow_smoothing float}], Returns: void
+	        Undocumented
+		Args: [{ false follow_smoothing float}], Returns: void
 */
 func (o *Camera2D) X_SetOldSmoothing(followSmoothing gdnative.Real) {
 	//log.Println("Calling Camera2D.X_SetOldSmoothing()")
@@ -96,7 +177,7 @@ func (o *Camera2D) X_SetOldSmoothing(followSmoothing gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(followSmoothing)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "_set_old_smoothing")
+	methodBind := methodBindCamera2DX_SetOldSmoothing.Get()
 
 	// Call the parent method.
 	// void
@@ -106,8 +187,8 @@ func (o *Camera2D) X_SetOldSmoothing(followSmoothing gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Camera2D) X_UpdateScroll() {
 	//log.Println("Calling Camera2D.X_UpdateScroll()")
@@ -116,7 +197,7 @@ func (o *Camera2D) X_UpdateScroll() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "_update_scroll")
+	methodBind := methodBindCamera2DX_UpdateScroll.Get()
 
 	// Call the parent method.
 	// void
@@ -126,8 +207,8 @@ func (o *Camera2D) X_UpdateScroll() {
 }
 
 /*
-        Align the camera to the tracked node
-	Args: [], Returns: void
+	        Align the camera to the tracked node
+		Args: [], Returns: void
 */
 func (o *Camera2D) Align() {
 	//log.Println("Calling Camera2D.Align()")
@@ -136,7 +217,7 @@ func (o *Camera2D) Align() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "align")
+	methodBind := methodBindCamera2DAlign.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +227,8 @@ func (o *Camera2D) Align() {
 }
 
 /*
-        Removes any [code]Camera2D[/code] from the ancestor [Viewport]'s internal currently-assigned camera.
-	Args: [], Returns: void
+	        Removes any [code]Camera2D[/code] from the ancestor [Viewport]'s internal currently-assigned camera.
+		Args: [], Returns: void
 */
 func (o *Camera2D) ClearCurrent() {
 	//log.Println("Calling Camera2D.ClearCurrent()")
@@ -156,7 +237,7 @@ func (o *Camera2D) ClearCurrent() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "clear_current")
+	methodBind := methodBindCamera2DClearCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -166,8 +247,8 @@ func (o *Camera2D) ClearCurrent() {
 }
 
 /*
-        Force the camera to update scroll immediately.
-	Args: [], Returns: void
+	        Force the camera to update scroll immediately.
+		Args: [], Returns: void
 */
 func (o *Camera2D) ForceUpdateScroll() {
 	//log.Println("Calling Camera2D.ForceUpdateScroll()")
@@ -176,7 +257,7 @@ func (o *Camera2D) ForceUpdateScroll() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "force_update_scroll")
+	methodBind := methodBindCamera2DForceUpdateScroll.Get()
 
 	// Call the parent method.
 	// void
@@ -186,8 +267,8 @@ func (o *Camera2D) ForceUpdateScroll() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Camera2D::AnchorMode
+	        Undocumented
+		Args: [], Returns: enum.Camera2D::AnchorMode
 */
 func (o *Camera2D) GetAnchorMode() Camera2DAnchorMode {
 	//log.Println("Calling Camera2D.GetAnchorMode()")
@@ -196,7 +277,7 @@ func (o *Camera2D) GetAnchorMode() Camera2DAnchorMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_anchor_mode")
+	methodBind := methodBindCamera2DGetAnchorMode.Get()
 
 	// Call the parent method.
 	// enum.Camera2D::AnchorMode
@@ -209,8 +290,8 @@ func (o *Camera2D) GetAnchorMode() Camera2DAnchorMode {
 }
 
 /*
-        Return the camera position.
-	Args: [], Returns: Vector2
+	        Return the camera position.
+		Args: [], Returns: Vector2
 */
 func (o *Camera2D) GetCameraPosition() gdnative.Vector2 {
 	//log.Println("Calling Camera2D.GetCameraPosition()")
@@ -219,7 +300,7 @@ func (o *Camera2D) GetCameraPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_camera_position")
+	methodBind := methodBindCamera2DGetCameraPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -232,8 +313,8 @@ func (o *Camera2D) GetCameraPosition() gdnative.Vector2 {
 }
 
 /*
-        Returns the location of the [code]Camera2D[/code]'s screen-center, relative to the origin.
-	Args: [], Returns: Vector2
+	        Returns the location of the [code]Camera2D[/code]'s screen-center, relative to the origin.
+		Args: [], Returns: Vector2
 */
 func (o *Camera2D) GetCameraScreenCenter() gdnative.Vector2 {
 	//log.Println("Calling Camera2D.GetCameraScreenCenter()")
@@ -242,7 +323,7 @@ func (o *Camera2D) GetCameraScreenCenter() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_camera_screen_center")
+	methodBind := methodBindCamera2DGetCameraScreenCenter.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -255,17 +336,17 @@ func (o *Camera2D) GetCameraScreenCenter() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Node
+	        Undocumented
+		Args: [], Returns: Node
 */
-func (o *Camera2D) GetCustomViewport() NodeImplementer {
+func (o *Camera2D) GetCustomViewport() godot.NodeImplementer {
 	//log.Println("Calling Camera2D.GetCustomViewport()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_custom_viewport")
+	methodBind := methodBindCamera2DGetCustomViewport.Get()
 
 	// Call the parent method.
 	// Node
@@ -273,27 +354,25 @@ func (o *Camera2D) GetCustomViewport() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := godot.Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: float
+	        Undocumented
+		Args: [{ false margin int}], Returns: float
 */
 func (o *Camera2D) GetDragMargin(margin gdnative.Int) gdnative.Real {
 	//log.Println("Calling Camera2D.GetDragMargin()")
@@ -303,7 +382,7 @@ func (o *Camera2D) GetDragMargin(margin gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_drag_margin")
+	methodBind := methodBindCamera2DGetDragMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -316,8 +395,8 @@ func (o *Camera2D) GetDragMargin(margin gdnative.Int) gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera2D) GetFollowSmoothing() gdnative.Real {
 	//log.Println("Calling Camera2D.GetFollowSmoothing()")
@@ -326,7 +405,7 @@ func (o *Camera2D) GetFollowSmoothing() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_follow_smoothing")
+	methodBind := methodBindCamera2DGetFollowSmoothing.Get()
 
 	// Call the parent method.
 	// float
@@ -339,8 +418,8 @@ func (o *Camera2D) GetFollowSmoothing() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera2D) GetHOffset() gdnative.Real {
 	//log.Println("Calling Camera2D.GetHOffset()")
@@ -349,7 +428,7 @@ func (o *Camera2D) GetHOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_h_offset")
+	methodBind := methodBindCamera2DGetHOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -362,8 +441,8 @@ func (o *Camera2D) GetHOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: int
+	        Undocumented
+		Args: [{ false margin int}], Returns: int
 */
 func (o *Camera2D) GetLimit(margin gdnative.Int) gdnative.Int {
 	//log.Println("Calling Camera2D.GetLimit()")
@@ -373,7 +452,7 @@ func (o *Camera2D) GetLimit(margin gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_limit")
+	methodBind := methodBindCamera2DGetLimit.Get()
 
 	// Call the parent method.
 	// int
@@ -386,8 +465,8 @@ func (o *Camera2D) GetLimit(margin gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Camera2D) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling Camera2D.GetOffset()")
@@ -396,7 +475,7 @@ func (o *Camera2D) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_offset")
+	methodBind := methodBindCamera2DGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -409,8 +488,8 @@ func (o *Camera2D) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Camera2D) GetVOffset() gdnative.Real {
 	//log.Println("Calling Camera2D.GetVOffset()")
@@ -419,7 +498,7 @@ func (o *Camera2D) GetVOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_v_offset")
+	methodBind := methodBindCamera2DGetVOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -432,8 +511,8 @@ func (o *Camera2D) GetVOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Camera2D) GetZoom() gdnative.Vector2 {
 	//log.Println("Calling Camera2D.GetZoom()")
@@ -442,7 +521,7 @@ func (o *Camera2D) GetZoom() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "get_zoom")
+	methodBind := methodBindCamera2DGetZoom.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -455,8 +534,8 @@ func (o *Camera2D) GetZoom() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsCurrent() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsCurrent()")
@@ -465,7 +544,7 @@ func (o *Camera2D) IsCurrent() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_current")
+	methodBind := methodBindCamera2DIsCurrent.Get()
 
 	// Call the parent method.
 	// bool
@@ -478,8 +557,8 @@ func (o *Camera2D) IsCurrent() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsFollowSmoothingEnabled() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsFollowSmoothingEnabled()")
@@ -488,7 +567,7 @@ func (o *Camera2D) IsFollowSmoothingEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_follow_smoothing_enabled")
+	methodBind := methodBindCamera2DIsFollowSmoothingEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -501,8 +580,8 @@ func (o *Camera2D) IsFollowSmoothingEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsHDragEnabled() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsHDragEnabled()")
@@ -511,7 +590,7 @@ func (o *Camera2D) IsHDragEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_h_drag_enabled")
+	methodBind := methodBindCamera2DIsHDragEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -524,8 +603,8 @@ func (o *Camera2D) IsHDragEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsLimitDrawingEnabled() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsLimitDrawingEnabled()")
@@ -534,7 +613,7 @@ func (o *Camera2D) IsLimitDrawingEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_limit_drawing_enabled")
+	methodBind := methodBindCamera2DIsLimitDrawingEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -547,8 +626,8 @@ func (o *Camera2D) IsLimitDrawingEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsLimitSmoothingEnabled() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsLimitSmoothingEnabled()")
@@ -557,7 +636,7 @@ func (o *Camera2D) IsLimitSmoothingEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_limit_smoothing_enabled")
+	methodBind := methodBindCamera2DIsLimitSmoothingEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -570,8 +649,8 @@ func (o *Camera2D) IsLimitSmoothingEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsMarginDrawingEnabled() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsMarginDrawingEnabled()")
@@ -580,7 +659,7 @@ func (o *Camera2D) IsMarginDrawingEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_margin_drawing_enabled")
+	methodBind := methodBindCamera2DIsMarginDrawingEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -593,8 +672,8 @@ func (o *Camera2D) IsMarginDrawingEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsRotating() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsRotating()")
@@ -603,7 +682,7 @@ func (o *Camera2D) IsRotating() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_rotating")
+	methodBind := methodBindCamera2DIsRotating.Get()
 
 	// Call the parent method.
 	// bool
@@ -616,8 +695,8 @@ func (o *Camera2D) IsRotating() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsScreenDrawingEnabled() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsScreenDrawingEnabled()")
@@ -626,7 +705,7 @@ func (o *Camera2D) IsScreenDrawingEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_screen_drawing_enabled")
+	methodBind := methodBindCamera2DIsScreenDrawingEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -639,8 +718,8 @@ func (o *Camera2D) IsScreenDrawingEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Camera2D) IsVDragEnabled() gdnative.Bool {
 	//log.Println("Calling Camera2D.IsVDragEnabled()")
@@ -649,7 +728,7 @@ func (o *Camera2D) IsVDragEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "is_v_drag_enabled")
+	methodBind := methodBindCamera2DIsVDragEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -662,8 +741,8 @@ func (o *Camera2D) IsVDragEnabled() gdnative.Bool {
 }
 
 /*
-        Make this the current 2D camera for the scene (viewport and layer), in case there's many cameras in the scene.
-	Args: [], Returns: void
+	        Make this the current 2D camera for the scene (viewport and layer), in case there's many cameras in the scene.
+		Args: [], Returns: void
 */
 func (o *Camera2D) MakeCurrent() {
 	//log.Println("Calling Camera2D.MakeCurrent()")
@@ -672,7 +751,7 @@ func (o *Camera2D) MakeCurrent() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "make_current")
+	methodBind := methodBindCamera2DMakeCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -682,8 +761,8 @@ func (o *Camera2D) MakeCurrent() {
 }
 
 /*
-        Set the camera's position immediately to its current smoothing destination. This has no effect if smoothing is disabled.
-	Args: [], Returns: void
+	        Set the camera's position immediately to its current smoothing destination. This has no effect if smoothing is disabled.
+		Args: [], Returns: void
 */
 func (o *Camera2D) ResetSmoothing() {
 	//log.Println("Calling Camera2D.ResetSmoothing()")
@@ -692,7 +771,7 @@ func (o *Camera2D) ResetSmoothing() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "reset_smoothing")
+	methodBind := methodBindCamera2DResetSmoothing.Get()
 
 	// Call the parent method.
 	// void
@@ -702,18 +781,18 @@ func (o *Camera2D) ResetSmoothing() {
 }
 
 /*
-        Undocumented
-	Args: [{ false anchor_mode int}], Returns: void
+	        Undocumented
+		Args: [{ false anchor_mode enum.Camera2D::AnchorMode}], Returns: void
 */
-func (o *Camera2D) SetAnchorMode(anchorMode gdnative.Int) {
+func (o *Camera2D) SetAnchorMode(anchorMode Camera2DAnchorMode) {
 	//log.Println("Calling Camera2D.SetAnchorMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(anchorMode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(anchorMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_anchor_mode")
+	methodBind := methodBindCamera2DSetAnchorMode.Get()
 
 	// Call the parent method.
 	// void
@@ -723,18 +802,18 @@ func (o *Camera2D) SetAnchorMode(anchorMode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false viewport Object}], Returns: void
+	        Undocumented
+		Args: [{ false viewport Object}], Returns: void
 */
-func (o *Camera2D) SetCustomViewport(viewport ObjectImplementer) {
+func (o *Camera2D) SetCustomViewport(viewport godot.ObjectImplementer) {
 	//log.Println("Calling Camera2D.SetCustomViewport()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(viewport.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(viewport))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_custom_viewport")
+	methodBind := methodBindCamera2DSetCustomViewport.Get()
 
 	// Call the parent method.
 	// void
@@ -744,8 +823,8 @@ func (o *Camera2D) SetCustomViewport(viewport ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false drag_margin float}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false drag_margin float}], Returns: void
 */
 func (o *Camera2D) SetDragMargin(margin gdnative.Int, dragMargin gdnative.Real) {
 	//log.Println("Calling Camera2D.SetDragMargin()")
@@ -756,7 +835,7 @@ func (o *Camera2D) SetDragMargin(margin gdnative.Int, dragMargin gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(dragMargin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_drag_margin")
+	methodBind := methodBindCamera2DSetDragMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -766,8 +845,8 @@ func (o *Camera2D) SetDragMargin(margin gdnative.Int, dragMargin gdnative.Real)
 }
 
 /*
-        Undocumented
-	Args: [{ false follow_smoothing bool}], Returns: void
+	        Undocumented
+		Args: [{ false follow_smoothing bool}], Returns: void
 */
 func (o *Camera2D) SetEnableFollowSmoothing(followSmoothing gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetEnableFollowSmoothing()")
@@ -777,7 +856,7 @@ func (o *Camera2D) SetEnableFollowSmoothing(followSmoothing gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(followSmoothing)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_enable_follow_smoothing")
+	methodBind := methodBindCamera2DSetEnableFollowSmoothing.Get()
 
 	// Call the parent method.
 	// void
@@ -787,8 +866,8 @@ func (o *Camera2D) SetEnableFollowSmoothing(followSmoothing gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false follow_smoothing float}], Returns: void
+	        Undocumented
+		Args: [{ false follow_smoothing float}], Returns: void
 */
 func (o *Camera2D) SetFollowSmoothing(followSmoothing gdnative.Real) {
 	//log.Println("Calling Camera2D.SetFollowSmoothing()")
@@ -798,7 +877,7 @@ func (o *Camera2D) SetFollowSmoothing(followSmoothing gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(followSmoothing)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_follow_smoothing")
+	methodBind := methodBindCamera2DSetFollowSmoothing.Get()
 
 	// Call the parent method.
 	// void
@@ -808,8 +887,8 @@ func (o *Camera2D) SetFollowSmoothing(followSmoothing gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Camera2D) SetHDragEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetHDragEnabled()")
@@ -819,7 +898,7 @@ func (o *Camera2D) SetHDragEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_h_drag_enabled")
+	methodBind := methodBindCamera2DSetHDragEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -829,8 +908,8 @@ func (o *Camera2D) SetHDragEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs float}], Returns: void
+	        Undocumented
+		Args: [{ false ofs float}], Returns: void
 */
 func (o *Camera2D) SetHOffset(ofs gdnative.Real) {
 	//log.Println("Calling Camera2D.SetHOffset()")
@@ -840,7 +919,7 @@ func (o *Camera2D) SetHOffset(ofs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_h_offset")
+	methodBind := methodBindCamera2DSetHOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -850,8 +929,8 @@ func (o *Camera2D) SetHOffset(ofs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false limit int}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false limit int}], Returns: void
 */
 func (o *Camera2D) SetLimit(margin gdnative.Int, limit gdnative.Int) {
 	//log.Println("Calling Camera2D.SetLimit()")
@@ -862,7 +941,7 @@ func (o *Camera2D) SetLimit(margin gdnative.Int, limit gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(limit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_limit")
+	methodBind := methodBindCamera2DSetLimit.Get()
 
 	// Call the parent method.
 	// void
@@ -872,8 +951,8 @@ func (o *Camera2D) SetLimit(margin gdnative.Int, limit gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false limit_drawing_enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false limit_drawing_enabled bool}], Returns: void
 */
 func (o *Camera2D) SetLimitDrawingEnabled(limitDrawingEnabled gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetLimitDrawingEnabled()")
@@ -883,7 +962,7 @@ func (o *Camera2D) SetLimitDrawingEnabled(limitDrawingEnabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(limitDrawingEnabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_limit_drawing_enabled")
+	methodBind := methodBindCamera2DSetLimitDrawingEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -893,8 +972,8 @@ func (o *Camera2D) SetLimitDrawingEnabled(limitDrawingEnabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false limit_smoothing_enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false limit_smoothing_enabled bool}], Returns: void
 */
 func (o *Camera2D) SetLimitSmoothingEnabled(limitSmoothingEnabled gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetLimitSmoothingEnabled()")
@@ -904,7 +983,7 @@ func (o *Camera2D) SetLimitSmoothingEnabled(limitSmoothingEnabled gdnative.Bool)
 	ptrArguments[0] = gdnative.NewPointerFromBool(limitSmoothingEnabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_limit_smoothing_enabled")
+	methodBind := methodBindCamera2DSetLimitSmoothingEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -914,8 +993,8 @@ func (o *Camera2D) SetLimitSmoothingEnabled(limitSmoothingEnabled gdnative.Bool)
 }
 
 /*
-        Undocumented
-	Args: [{ false margin_drawing_enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false margin_drawing_enabled bool}], Returns: void
 */
 func (o *Camera2D) SetMarginDrawingEnabled(marginDrawingEnabled gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetMarginDrawingEnabled()")
@@ -925,7 +1004,7 @@ func (o *Camera2D) SetMarginDrawingEnabled(marginDrawingEnabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(marginDrawingEnabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_margin_drawing_enabled")
+	methodBind := methodBindCamera2DSetMarginDrawingEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -935,8 +1014,8 @@ func (o *Camera2D) SetMarginDrawingEnabled(marginDrawingEnabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *Camera2D) SetOffset(offset gdnative.Vector2) {
 	//log.Println("Calling Camera2D.SetOffset()")
@@ -946,7 +1025,7 @@ func (o *Camera2D) SetOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_offset")
+	methodBind := methodBindCamera2DSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -956,8 +1035,8 @@ func (o *Camera2D) SetOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rotating bool}], Returns: void
+	        Undocumented
+		Args: [{ false rotating bool}], Returns: void
 */
 func (o *Camera2D) SetRotating(rotating gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetRotating()")
@@ -967,7 +1046,7 @@ func (o *Camera2D) SetRotating(rotating gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(rotating)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_rotating")
+	methodBind := methodBindCamera2DSetRotating.Get()
 
 	// Call the parent method.
 	// void
@@ -977,8 +1056,8 @@ func (o *Camera2D) SetRotating(rotating gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false screen_drawing_enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false screen_drawing_enabled bool}], Returns: void
 */
 func (o *Camera2D) SetScreenDrawingEnabled(screenDrawingEnabled gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetScreenDrawingEnabled()")
@@ -988,7 +1067,7 @@ func (o *Camera2D) SetScreenDrawingEnabled(screenDrawingEnabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(screenDrawingEnabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_screen_drawing_enabled")
+	methodBind := methodBindCamera2DSetScreenDrawingEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -998,8 +1077,8 @@ func (o *Camera2D) SetScreenDrawingEnabled(screenDrawingEnabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Camera2D) SetVDragEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Camera2D.SetVDragEnabled()")
@@ -1009,7 +1088,7 @@ func (o *Camera2D) SetVDragEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_v_drag_enabled")
+	methodBind := methodBindCamera2DSetVDragEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1019,8 +1098,8 @@ func (o *Camera2D) SetVDragEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs float}], Returns: void
+	        Undocumented
+		Args: [{ false ofs float}], Returns: void
 */
 func (o *Camera2D) SetVOffset(ofs gdnative.Real) {
 	//log.Println("Calling Camera2D.SetVOffset()")
@@ -1030,7 +1109,7 @@ func (o *Camera2D) SetVOffset(ofs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_v_offset")
+	methodBind := methodBindCamera2DSetVOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -1040,8 +1119,8 @@ func (o *Camera2D) SetVOffset(ofs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false zoom Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false zoom Vector2}], Returns: void
 */
 func (o *Camera2D) SetZoom(zoom gdnative.Vector2) {
 	//log.Println("Calling Camera2D.SetZoom()")
@@ -1051,7 +1130,7 @@ func (o *Camera2D) SetZoom(zoom gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(zoom)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Camera2D", "set_zoom")
+	methodBind := methodBindCamera2DSetZoom.Get()
 
 	// Call the parent method.
 	// void
@@ -1060,20 +1139,236 @@ func (o *Camera2D) SetZoom(zoom gdnative.Vector2) {
 
 }
 
+// AnchorMode will return the value of the "anchor_mode" property.
+func (o *Camera2D) AnchorMode() Camera2DAnchorMode {
+	return o.GetAnchorMode()
+}
+
+// Current will return the value of the "current" property.
+func (o *Camera2D) Current() gdnative.Bool {
+	return o.IsCurrent()
+}
+
+// SetCurrent will set the value of the "current" property.
+func (o *Camera2D) SetCurrent(value gdnative.Bool) {
+	o.X_SetCurrent(value)
+}
+
+// CustomViewport will return the value of the "custom_viewport" property.
+func (o *Camera2D) CustomViewport() godot.NodeImplementer {
+	return o.GetCustomViewport()
+}
+
+// DragMarginBottom will return the value of the "drag_margin_bottom" property.
+func (o *Camera2D) DragMarginBottom() gdnative.Real {
+	return o.GetDragMargin(3)
+}
+
+// SetDragMarginBottom will set the value of the "drag_margin_bottom" property.
+func (o *Camera2D) SetDragMarginBottom(value gdnative.Real) {
+	o.SetDragMargin(3, value)
+}
+
+// DragMarginHEnabled will return the value of the "drag_margin_h_enabled" property.
+func (o *Camera2D) DragMarginHEnabled() gdnative.Bool {
+	return o.IsHDragEnabled()
+}
+
+// SetDragMarginHEnabled will set the value of the "drag_margin_h_enabled" property.
+func (o *Camera2D) SetDragMarginHEnabled(value gdnative.Bool) {
+	o.SetHDragEnabled(value)
+}
+
+// DragMarginLeft will return the value of the "drag_margin_left" property.
+func (o *Camera2D) DragMarginLeft() gdnative.Real {
+	return o.GetDragMargin(0)
+}
+
+// SetDragMarginLeft will set the value of the "drag_margin_left" property.
+func (o *Camera2D) SetDragMarginLeft(value gdnative.Real) {
+	o.SetDragMargin(0, value)
+}
+
+// DragMarginRight will return the value of the "drag_margin_right" property.
+func (o *Camera2D) DragMarginRight() gdnative.Real {
+	return o.GetDragMargin(2)
+}
+
+// SetDragMarginRight will set the value of the "drag_margin_right" property.
+func (o *Camera2D) SetDragMarginRight(value gdnative.Real) {
+	o.SetDragMargin(2, value)
+}
+
+// DragMarginTop will return the value of the "drag_margin_top" property.
+func (o *Camera2D) DragMarginTop() gdnative.Real {
+	return o.GetDragMargin(1)
+}
+
+// SetDragMarginTop will set the value of the "drag_margin_top" property.
+func (o *Camera2D) SetDragMarginTop(value gdnative.Real) {
+	o.SetDragMargin(1, value)
+}
+
+// DragMarginVEnabled will return the value of the "drag_margin_v_enabled" property.
+func (o *Camera2D) DragMarginVEnabled() gdnative.Bool {
+	return o.IsVDragEnabled()
+}
+
+// SetDragMarginVEnabled will set the value of the "drag_margin_v_enabled" property.
+func (o *Camera2D) SetDragMarginVEnabled(value gdnative.Bool) {
+	o.SetVDragEnabled(value)
+}
+
+// EditorDrawDragMargin will return the value of the "editor_draw_drag_margin" property.
+func (o *Camera2D) EditorDrawDragMargin() gdnative.Bool {
+	return o.IsMarginDrawingEnabled()
+}
+
+// SetEditorDrawDragMargin will set the value of the "editor_draw_drag_margin" property.
+func (o *Camera2D) SetEditorDrawDragMargin(value gdnative.Bool) {
+	o.SetMarginDrawingEnabled(value)
+}
+
+// EditorDrawLimits will return the value of the "editor_draw_limits" property.
+func (o *Camera2D) EditorDrawLimits() gdnative.Bool {
+	return o.IsLimitDrawingEnabled()
+}
+
+// SetEditorDrawLimits will set the value of the "editor_draw_limits" property.
+func (o *Camera2D) SetEditorDrawLimits(value gdnative.Bool) {
+	o.SetLimitDrawingEnabled(value)
+}
+
+// EditorDrawScreen will return the value of the "editor_draw_screen" property.
+func (o *Camera2D) EditorDrawScreen() gdnative.Bool {
+	return o.IsScreenDrawingEnabled()
+}
+
+// SetEditorDrawScreen will set the value of the "editor_draw_screen" property.
+func (o *Camera2D) SetEditorDrawScreen(value gdnative.Bool) {
+	o.SetScreenDrawingEnabled(value)
+}
+
+// LimitBottom will return the value of the "limit_bottom" property.
+func (o *Camera2D) LimitBottom() gdnative.Int {
+	return o.GetLimit(3)
+}
+
+// SetLimitBottom will set the value of the "limit_bottom" property.
+func (o *Camera2D) SetLimitBottom(value gdnative.Int) {
+	o.SetLimit(3, value)
+}
+
+// LimitLeft will return the value of the "limit_left" property.
+func (o *Camera2D) LimitLeft() gdnative.Int {
+	return o.GetLimit(0)
+}
+
+// SetLimitLeft will set the value of the "limit_left" property.
+func (o *Camera2D) SetLimitLeft(value gdnative.Int) {
+	o.SetLimit(0, value)
+}
+
+// LimitRight will return the value of the "limit_right" property.
+func (o *Camera2D) LimitRight() gdnative.Int {
+	return o.GetLimit(2)
+}
+
+// SetLimitRight will set the value of the "limit_right" property.
+func (o *Camera2D) SetLimitRight(value gdnative.Int) {
+	o.SetLimit(2, value)
+}
+
+// LimitSmoothed will return the value of the "limit_smoothed" property.
+func (o *Camera2D) LimitSmoothed() gdnative.Bool {
+	return o.IsLimitSmoothingEnabled()
+}
+
+// SetLimitSmoothed will set the value of the "limit_smoothed" property.
+func (o *Camera2D) SetLimitSmoothed(value gdnative.Bool) {
+	o.SetLimitSmoothingEnabled(value)
+}
+
+// LimitTop will return the value of the "limit_top" property.
+func (o *Camera2D) LimitTop() gdnative.Int {
+	return o.GetLimit(1)
+}
+
+// SetLimitTop will set the value of the "limit_top" property.
+func (o *Camera2D) SetLimitTop(value gdnative.Int) {
+	o.SetLimit(1, value)
+}
+
+// Offset will return the value of the "offset" property.
+func (o *Camera2D) Offset() gdnative.Vector2 {
+	return o.GetOffset()
+}
+
+// OffsetH will return the value of the "offset_h" property.
+func (o *Camera2D) OffsetH() gdnative.Real {
+	return o.GetHOffset()
+}
+
+// SetOffsetH will set the value of the "offset_h" property.
+func (o *Camera2D) SetOffsetH(value gdnative.Real) {
+	o.SetHOffset(value)
+}
+
+// OffsetV will return the value of the "offset_v" property.
+func (o *Camera2D) OffsetV() gdnative.Real {
+	return o.GetVOffset()
+}
+
+// SetOffsetV will set the value of the "offset_v" property.
+func (o *Camera2D) SetOffsetV(value gdnative.Real) {
+	o.SetVOffset(value)
+}
+
+// Rotating will return the value of the "rotating" property.
+func (o *Camera2D) Rotating() gdnative.Bool {
+	return o.IsRotating()
+}
+
+// SmoothingEnabled will return the value of the "smoothing_enabled" property.
+func (o *Camera2D) SmoothingEnabled() gdnative.Bool {
+	return o.IsFollowSmoothingEnabled()
+}
+
+// SetSmoothingEnabled will set the value of the "smoothing_enabled" property.
+func (o *Camera2D) SetSmoothingEnabled(value gdnative.Bool) {
+	o.SetEnableFollowSmoothing(value)
+}
+
+// SmoothingSpeed will return the value of the "smoothing_speed" property.
+func (o *Camera2D) SmoothingSpeed() gdnative.Real {
+	return o.GetFollowSmoothing()
+}
+
+// SetSmoothingSpeed will set the value of the "smoothing_speed" property.
+func (o *Camera2D) SetSmoothingSpeed(value gdnative.Real) {
+	o.SetFollowSmoothing(value)
+}
+
+// Zoom will return the value of the "zoom" property.
+func (o *Camera2D) Zoom() gdnative.Vector2 {
+	return o.GetZoom()
+}
+
 // Camera2DImplementer is an interface that implements the methods
 // of the Camera2D class.
 type Camera2DImplementer interface {
-	Node2DImplementer
-	X_MakeCurrent(arg0 ObjectImplementer)
+	godot.Node2DImplementer
+	X_MakeCurrent(arg0 godot.ObjectImplementer)
 	X_SetCurrent(current gdnative.Bool)
 	X_SetOldSmoothing(followSmoothing gdnative.Real)
 	X_UpdateScroll()
 	Align()
 	ClearCurrent()
 	ForceUpdateScroll()
+	GetAnchorMode() Camera2DAnchorMode
 	GetCameraPosition() gdnative.Vector2
 	GetCameraScreenCenter() gdnative.Vector2
-	GetCustomViewport() NodeImplementer
+	GetCustomViewport() godot.NodeImplementer
 	GetDragMargin(margin gdnative.Int) gdnative.Real
 	GetFollowSmoothing() gdnative.Real
 	GetHOffset() gdnative.Real
@@ -1092,8 +1387,8 @@ type Camera2DImplementer interface {
 	IsVDragEnabled() gdnative.Bool
 	MakeCurrent()
 	ResetSmoothing()
-	SetAnchorMode(anchorMode gdnative.Int)
-	SetCustomViewport(viewport ObjectImplementer)
+	SetAnchorMode(anchorMode Camera2DAnchorMode)
+	SetCustomViewport(viewport godot.ObjectImplementer)
 	SetDragMargin(margin gdnative.Int, dragMargin gdnative.Real)
 	SetEnableFollowSmoothing(followSmoothing gdnative.Bool)
 	SetFollowSmoothing(followSmoothing gdnative.Real)
@@ -1109,4 +1404,47 @@ type Camera2DImplementer interface {
 	SetVDragEnabled(enabled gdnative.Bool)
 	SetVOffset(ofs gdnative.Real)
 	SetZoom(zoom gdnative.Vector2)
+	AnchorMode() Camera2DAnchorMode
+	Current() gdnative.Bool
+	SetCurrent(value gdnative.Bool)
+	CustomViewport() godot.NodeImplementer
+	DragMarginBottom() gdnative.Real
+	SetDragMarginBottom(value gdnative.Real)
+	DragMarginHEnabled() gdnative.Bool
+	SetDragMarginHEnabled(value gdnative.Bool)
+	DragMarginLeft() gdnative.Real
+	SetDragMarginLeft(value gdnative.Real)
+	DragMarginRight() gdnative.Real
+	SetDragMarginRight(value gdnative.Real)
+	DragMarginTop() gdnative.Real
+	SetDragMarginTop(value gdnative.Real)
+	DragMarginVEnabled() gdnative.Bool
+	SetDragMarginVEnabled(value gdnative.Bool)
+	EditorDrawDragMargin() gdnative.Bool
+	SetEditorDrawDragMargin(value gdnative.Bool)
+	EditorDrawLimits() gdnative.Bool
+	SetEditorDrawLimits(value gdnative.Bool)
+	EditorDrawScreen() gdnative.Bool
+	SetEditorDrawScreen(value gdnative.Bool)
+	LimitBottom() gdnative.Int
+	SetLimitBottom(value gdnative.Int)
+	LimitLeft() gdnative.Int
+	SetLimitLeft(value gdnative.Int)
+	LimitRight() gdnative.Int
+	SetLimitRight(value gdnative.Int)
+	LimitSmoothed() gdnative.Bool
+	SetLimitSmoothed(value gdnative.Bool)
+	LimitTop() gdnative.Int
+	SetLimitTop(value gdnative.Int)
+	Offset() gdnative.Vector2
+	OffsetH() gdnative.Real
+	SetOffsetH(value gdnative.Real)
+	OffsetV() gdnative.Real
+	SetOffsetV(value gdnative.Real)
+	Rotating() gdnative.Bool
+	SmoothingEnabled() gdnative.Bool
+	SetSmoothingEnabled(value gdnative.Bool)
+	SmoothingSpeed() gdnative.Real
+	SetSmoothingSpeed(value gdnative.Real)
+	Zoom() gdnative.Vector2
 }
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,42 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCanvasModulateFromPointer(ptr gdnative.Pointer) CanvasModulate {
-func newCanvasModulateFromPointer(ptr gdnative.Pointer) CanvasModulate {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CanvasModulate{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 [code]CanvasModulate[/code] tints the canvas elements using its assigned [code]color[/code].
 */
 type CanvasModulate struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorCanvasModulate is the class constructor of the CanvasModulate class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCanvasModulate = gdnative.NewLazyClassConstructor("CanvasModulate")
+
+// NewCanvasModulate will create a new instance of the CanvasModulate class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCanvasModulate() *CanvasModulate {
+	obj := &CanvasModulate{}
+	obj.SetBaseObject(classConstructorCanvasModulate.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CanvasModulate) BaseClass() string {
 	return "CanvasModulate"
 }
 
+// Method binds of the CanvasModulate class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCanvasModulateGetColor = gdnative.NewLazyMethodBind("CanvasModulate", "get_color")
+	methodBindCanvasModulateSetColor = gdnative.NewLazyMethodBind("CanvasModulate", "set_color")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *CanvasModulate) GetColor() gdnative.Color {
 	//log.Println("Calling CanvasModulate.GetColor()")
@@ -45,7 +58,7 @@ func (o *CanvasModulate) GetColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasModulate", "get_color")
+	methodBind := methodBindCanvasModulateGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -58,8 +71,8 @@ func (o *CanvasModulate) GetColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *CanvasModulate) SetColor(color gdnative.Color) {
 	//log.Println("Calling CanvasModulate.SetColor()")
@@ -69,7 +82,7 @@ func (o *CanvasModulate) SetColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasModulate", "set_color")
+	methodBind := methodBindCanvasModulateSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *CanvasModulate) SetColor(color gdnative.Color) {
 
 }
 
+// Color will return the value of the "color" property.
+func (o *CanvasModulate) Color() gdnative.Color {
+	return o.GetColor()
+}
+
 // CanvasModulateImplementer is an interface that implements the methods
 // of the CanvasModulate class.
 type CanvasModulateImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetColor() gdnative.Color
 	SetColor(color gdnative.Color)
+	Color() gdnative.Color
 }
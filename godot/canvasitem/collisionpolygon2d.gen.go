@@ -1,7 +1,10 @@
-package godot
+package canvasitem
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,30 +24,70 @@ const (
 	CollisionPolygon2DBuildSolids   CollisionPolygon2DBuildMode = 0
 )
 
-//func NewCollisionPolygon2DFromPointer(ptr gdnative.Pointer) CollisionPolygon2D {
-func newCollisionPolygon2DFromPointer(ptr gdnative.Pointer) CollisionPolygon2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CollisionPolygon2D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the CollisionPolygon2DBuildMode value.
+func (e CollisionPolygon2DBuildMode) String() string {
+	switch e {
+	case CollisionPolygon2DBuildSegments:
+		return "BUILD_SEGMENTS"
+	case CollisionPolygon2DBuildSolids:
+		return "BUILD_SOLIDS"
+	}
+	return fmt.Sprintf("CollisionPolygon2DBuildMode(%d)", int(e))
+}
 
-	return obj
+// ParseCollisionPolygon2DBuildMode will return the CollisionPolygon2DBuildMode value with the given Godot name.
+func ParseCollisionPolygon2DBuildMode(name string) (CollisionPolygon2DBuildMode, error) {
+	switch name {
+	case "BUILD_SEGMENTS":
+		return CollisionPolygon2DBuildSegments, nil
+	case "BUILD_SOLIDS":
+		return CollisionPolygon2DBuildSolids, nil
+	}
+	return 0, fmt.Errorf("invalid CollisionPolygon2DBuildMode value %q", name)
 }
 
 /*
 Provides a 2D collision polygon to a [CollisionObject2D] parent. Polygon can be drawn in the editor or specified by a list of vertices.
 */
 type CollisionPolygon2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorCollisionPolygon2D is the class constructor of the CollisionPolygon2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCollisionPolygon2D = gdnative.NewLazyClassConstructor("CollisionPolygon2D")
+
+// NewCollisionPolygon2D will create a new instance of the CollisionPolygon2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCollisionPolygon2D() *CollisionPolygon2D {
+	obj := &CollisionPolygon2D{}
+	obj.SetBaseObject(classConstructorCollisionPolygon2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CollisionPolygon2D) BaseClass() string {
 	return "CollisionPolygon2D"
 }
 
+// Method binds of the CollisionPolygon2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCollisionPolygon2DGetBuildMode             = gdnative.NewLazyMethodBind("CollisionPolygon2D", "get_build_mode")
+	methodBindCollisionPolygon2DGetPolygon               = gdnative.NewLazyMethodBind("CollisionPolygon2D", "get_polygon")
+	methodBindCollisionPolygon2DIsDisabled               = gdnative.NewLazyMethodBind("CollisionPolygon2D", "is_disabled")
+	methodBindCollisionPolygon2DIsOneWayCollisionEnabled = gdnative.NewLazyMethodBind("CollisionPolygon2D", "is_one_way_collision_enabled")
+	methodBindCollisionPolygon2DSetBuildMode             = gdnative.NewLazyMethodBind("CollisionPolygon2D", "set_build_mode")
+	methodBindCollisionPolygon2DSetDisabled              = gdnative.NewLazyMethodBind("CollisionPolygon2D", "set_disabled")
+	methodBindCollisionPolygon2DSetOneWayCollision       = gdnative.NewLazyMethodBind("CollisionPolygon2D", "set_one_way_collision")
+	methodBindCollisionPolygon2DSetPolygon               = gdnative.NewLazyMethodBind("CollisionPolygon2D", "set_polygon")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.CollisionPolygon2D::BuildMode
+	        Undocumented
+		Args: [], Returns: enum.CollisionPolygon2D::BuildMode
 */
 func (o *CollisionPolygon2D) GetBuildMode() CollisionPolygon2DBuildMode {
 	//log.Println("Calling CollisionPolygon2D.GetBuildMode()")
@@ -53,7 +96,7 @@ func (o *CollisionPolygon2D) GetBuildMode() CollisionPolygon2DBuildMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "get_build_mode")
+	methodBind := methodBindCollisionPolygon2DGetBuildMode.Get()
 
 	// Call the parent method.
 	// enum.CollisionPolygon2D::BuildMode
@@ -66,8 +109,8 @@ func (o *CollisionPolygon2D) GetBuildMode() CollisionPolygon2DBuildMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *CollisionPolygon2D) GetPolygon() gdnative.PoolVector2Array {
 	//log.Println("Calling CollisionPolygon2D.GetPolygon()")
@@ -76,7 +119,7 @@ func (o *CollisionPolygon2D) GetPolygon() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "get_polygon")
+	methodBind := methodBindCollisionPolygon2DGetPolygon.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -89,8 +132,8 @@ func (o *CollisionPolygon2D) GetPolygon() gdnative.PoolVector2Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionPolygon2D) IsDisabled() gdnative.Bool {
 	//log.Println("Calling CollisionPolygon2D.IsDisabled()")
@@ -99,7 +142,7 @@ func (o *CollisionPolygon2D) IsDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "is_disabled")
+	methodBind := methodBindCollisionPolygon2DIsDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -112,8 +155,8 @@ func (o *CollisionPolygon2D) IsDisabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionPolygon2D) IsOneWayCollisionEnabled() gdnative.Bool {
 	//log.Println("Calling CollisionPolygon2D.IsOneWayCollisionEnabled()")
@@ -122,7 +165,7 @@ func (o *CollisionPolygon2D) IsOneWayCollisionEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "is_one_way_collision_enabled")
+	methodBind := methodBindCollisionPolygon2DIsOneWayCollisionEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -135,18 +178,18 @@ func (o *CollisionPolygon2D) IsOneWayCollisionEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false build_mode int}], Returns: void
+	        Undocumented
+		Args: [{ false build_mode enum.CollisionPolygon2D::BuildMode}], Returns: void
 */
-func (o *CollisionPolygon2D) SetBuildMode(buildMode gdnative.Int) {
+func (o *CollisionPolygon2D) SetBuildMode(buildMode CollisionPolygon2DBuildMode) {
 	//log.Println("Calling CollisionPolygon2D.SetBuildMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(buildMode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(buildMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "set_build_mode")
+	methodBind := methodBindCollisionPolygon2DSetBuildMode.Get()
 
 	// Call the parent method.
 	// void
@@ -156,8 +199,8 @@ func (o *CollisionPolygon2D) SetBuildMode(buildMode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false disabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false disabled bool}], Returns: void
 */
 func (o *CollisionPolygon2D) SetDisabled(disabled gdnative.Bool) {
 	//log.Println("Calling CollisionPolygon2D.SetDisabled()")
@@ -167,7 +210,7 @@ func (o *CollisionPolygon2D) SetDisabled(disabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "set_disabled")
+	methodBind := methodBindCollisionPolygon2DSetDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -177,8 +220,8 @@ func (o *CollisionPolygon2D) SetDisabled(disabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *CollisionPolygon2D) SetOneWayCollision(enabled gdnative.Bool) {
 	//log.Println("Calling CollisionPolygon2D.SetOneWayCollision()")
@@ -188,7 +231,7 @@ func (o *CollisionPolygon2D) SetOneWayCollision(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "set_one_way_collision")
+	methodBind := methodBindCollisionPolygon2DSetOneWayCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -198,8 +241,8 @@ func (o *CollisionPolygon2D) SetOneWayCollision(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false polygon PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false polygon PoolVector2Array}], Returns: void
 */
 func (o *CollisionPolygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 	//log.Println("Calling CollisionPolygon2D.SetPolygon()")
@@ -209,7 +252,7 @@ func (o *CollisionPolygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon2D", "set_polygon")
+	methodBind := methodBindCollisionPolygon2DSetPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -218,15 +261,40 @@ func (o *CollisionPolygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 
 }
 
+// BuildMode will return the value of the "build_mode" property.
+func (o *CollisionPolygon2D) BuildMode() CollisionPolygon2DBuildMode {
+	return o.GetBuildMode()
+}
+
+// Disabled will return the value of the "disabled" property.
+func (o *CollisionPolygon2D) Disabled() gdnative.Bool {
+	return o.IsDisabled()
+}
+
+// OneWayCollision will return the value of the "one_way_collision" property.
+func (o *CollisionPolygon2D) OneWayCollision() gdnative.Bool {
+	return o.IsOneWayCollisionEnabled()
+}
+
+// Polygon will return the value of the "polygon" property.
+func (o *CollisionPolygon2D) Polygon() gdnative.PoolVector2Array {
+	return o.GetPolygon()
+}
+
 // CollisionPolygon2DImplementer is an interface that implements the methods
 // of the CollisionPolygon2D class.
 type CollisionPolygon2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
+	GetBuildMode() CollisionPolygon2DBuildMode
 	GetPolygon() gdnative.PoolVector2Array
 	IsDisabled() gdnative.Bool
 	IsOneWayCollisionEnabled() gdnative.Bool
-	SetBuildMode(buildMode gdnative.Int)
+	SetBuildMode(buildMode CollisionPolygon2DBuildMode)
 	SetDisabled(disabled gdnative.Bool)
 	SetOneWayCollision(enabled gdnative.Bool)
 	SetPolygon(polygon gdnative.PoolVector2Array)
+	BuildMode() CollisionPolygon2DBuildMode
+	Disabled() gdnative.Bool
+	OneWayCollision() gdnative.Bool
+	Polygon() gdnative.PoolVector2Array
 }
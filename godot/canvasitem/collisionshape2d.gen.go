@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCollisionShape2DFromPointer(ptr gdnative.Pointer) CollisionShape2D {
-func newCollisionShape2DFromPointer(ptr gdnative.Pointer) CollisionShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CollisionShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Editor facility for creating and editing collision shapes in 2D space. You can use this node to represent all sorts of collision shapes, for example, add this to an [Area2D] to give it a detection shape, or add it to a [PhysicsBody2D] to give create solid object. [b]IMPORTANT[/b]: this is an Editor-only helper to create shapes, use [method get_shape] to get the actual shape.
 */
 type CollisionShape2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorCollisionShape2D is the class constructor of the CollisionShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCollisionShape2D = gdnative.NewLazyClassConstructor("CollisionShape2D")
+
+// NewCollisionShape2D will create a new instance of the CollisionShape2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCollisionShape2D() *CollisionShape2D {
+	obj := &CollisionShape2D{}
+	obj.SetBaseObject(classConstructorCollisionShape2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CollisionShape2D) BaseClass() string {
 	return "CollisionShape2D"
 }
 
+// Method binds of the CollisionShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCollisionShape2DX_ShapeChanged           = gdnative.NewLazyMethodBind("CollisionShape2D", "_shape_changed")
+	methodBindCollisionShape2DGetShape                 = gdnative.NewLazyMethodBind("CollisionShape2D", "get_shape")
+	methodBindCollisionShape2DIsDisabled               = gdnative.NewLazyMethodBind("CollisionShape2D", "is_disabled")
+	methodBindCollisionShape2DIsOneWayCollisionEnabled = gdnative.NewLazyMethodBind("CollisionShape2D", "is_one_way_collision_enabled")
+	methodBindCollisionShape2DSetDisabled              = gdnative.NewLazyMethodBind("CollisionShape2D", "set_disabled")
+	methodBindCollisionShape2DSetOneWayCollision       = gdnative.NewLazyMethodBind("CollisionShape2D", "set_one_way_collision")
+	methodBindCollisionShape2DSetShape                 = gdnative.NewLazyMethodBind("CollisionShape2D", "set_shape")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *CollisionShape2D) X_ShapeChanged() {
 	//log.Println("Calling CollisionShape2D.X_ShapeChanged()")
@@ -45,7 +63,7 @@ func (o *CollisionShape2D) X_ShapeChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape2D", "_shape_changed")
+	methodBind := methodBindCollisionShape2DX_ShapeChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -55,17 +73,17 @@ func (o *CollisionShape2D) X_ShapeChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Shape2D
+	        Undocumented
+		Args: [], Returns: Shape2D
 */
-func (o *CollisionShape2D) GetShape() Shape2DImplementer {
+func (o *CollisionShape2D) GetShape() godot.Shape2DImplementer {
 	//log.Println("Calling CollisionShape2D.GetShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape2D", "get_shape")
+	methodBind := methodBindCollisionShape2DGetShape.Get()
 
 	// Call the parent method.
 	// Shape2D
@@ -73,27 +91,25 @@ func (o *CollisionShape2D) GetShape() Shape2DImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShape2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(Shape2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shape2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(Shape2DImplementer)
+	ret := godot.Shape2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.Shape2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionShape2D) IsDisabled() gdnative.Bool {
 	//log.Println("Calling CollisionShape2D.IsDisabled()")
@@ -102,7 +118,7 @@ func (o *CollisionShape2D) IsDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape2D", "is_disabled")
+	methodBind := methodBindCollisionShape2DIsDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -115,8 +131,8 @@ func (o *CollisionShape2D) IsDisabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionShape2D) IsOneWayCollisionEnabled() gdnative.Bool {
 	//log.Println("Calling CollisionShape2D.IsOneWayCollisionEnabled()")
@@ -125,7 +141,7 @@ func (o *CollisionShape2D) IsOneWayCollisionEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape2D", "is_one_way_collision_enabled")
+	methodBind := methodBindCollisionShape2DIsOneWayCollisionEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -138,8 +154,8 @@ func (o *CollisionShape2D) IsOneWayCollisionEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false disabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false disabled bool}], Returns: void
 */
 func (o *CollisionShape2D) SetDisabled(disabled gdnative.Bool) {
 	//log.Println("Calling CollisionShape2D.SetDisabled()")
@@ -149,7 +165,7 @@ func (o *CollisionShape2D) SetDisabled(disabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape2D", "set_disabled")
+	methodBind := methodBindCollisionShape2DSetDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -159,8 +175,8 @@ func (o *CollisionShape2D) SetDisabled(disabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *CollisionShape2D) SetOneWayCollision(enabled gdnative.Bool) {
 	//log.Println("Calling CollisionShape2D.SetOneWayCollision()")
@@ -170,7 +186,7 @@ func (o *CollisionShape2D) SetOneWayCollision(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape2D", "set_one_way_collision")
+	methodBind := methodBindCollisionShape2DSetOneWayCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -180,18 +196,18 @@ func (o *CollisionShape2D) SetOneWayCollision(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false shape Shape2D}], Returns: void
+	        Undocumented
+		Args: [{ false shape Shape2D}], Returns: void
 */
-func (o *CollisionShape2D) SetShape(shape Shape2DImplementer) {
+func (o *CollisionShape2D) SetShape(shape godot.Shape2DImplementer) {
 	//log.Println("Calling CollisionShape2D.SetShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape2D", "set_shape")
+	methodBind := methodBindCollisionShape2DSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -200,15 +216,33 @@ func (o *CollisionShape2D) SetShape(shape Shape2DImplementer) {
 
 }
 
+// Disabled will return the value of the "disabled" property.
+func (o *CollisionShape2D) Disabled() gdnative.Bool {
+	return o.IsDisabled()
+}
+
+// OneWayCollision will return the value of the "one_way_collision" property.
+func (o *CollisionShape2D) OneWayCollision() gdnative.Bool {
+	return o.IsOneWayCollisionEnabled()
+}
+
+// Shape will return the value of the "shape" property.
+func (o *CollisionShape2D) Shape() godot.Shape2DImplementer {
+	return o.GetShape()
+}
+
 // CollisionShape2DImplementer is an interface that implements the methods
 // of the CollisionShape2D class.
 type CollisionShape2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	X_ShapeChanged()
-	GetShape() Shape2DImplementer
+	GetShape() godot.Shape2DImplementer
 	IsDisabled() gdnative.Bool
 	IsOneWayCollisionEnabled() gdnative.Bool
 	SetDisabled(disabled gdnative.Bool)
 	SetOneWayCollision(enabled gdnative.Bool)
-	SetShape(shape Shape2DImplementer)
+	SetShape(shape godot.Shape2DImplementer)
+	Disabled() gdnative.Bool
+	OneWayCollision() gdnative.Bool
+	Shape() godot.Shape2DImplementer
 }
@@ -0,0 +1,175 @@
+package canvasitem
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("AnimatedSprite", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AnimatedSprite{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Area2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Area2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("BackBufferCopy", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &BackBufferCopy{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Camera2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Camera2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CanvasModulate", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CanvasModulate{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CollisionPolygon2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CollisionPolygon2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CollisionShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CollisionShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("DampedSpringJoint2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &DampedSpringJoint2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GrooveJoint2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GrooveJoint2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Joint2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Joint2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("KinematicBody2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &KinematicBody2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Light2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Light2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("LightOccluder2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &LightOccluder2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Line2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Line2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Navigation2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Navigation2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("NavigationPolygonInstance", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &NavigationPolygonInstance{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ParallaxLayer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ParallaxLayer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Particles2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Particles2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Path2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Path2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PathFollow2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PathFollow2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PinJoint2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PinJoint2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Polygon2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Polygon2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Position2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Position2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RayCast2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RayCast2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RemoteTransform2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RemoteTransform2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RigidBody2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RigidBody2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Sprite", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Sprite{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("StaticBody2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &StaticBody2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("TileMap", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &TileMap{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("TouchScreenButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &TouchScreenButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("YSort", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &YSort{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewDampedSpringJoint2DFromPointer(ptr gdnative.Pointer) DampedSpringJoint2D {
-func newDampedSpringJoint2DFromPointer(ptr gdnative.Pointer) DampedSpringJoint2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := DampedSpringJoint2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Damped spring constraint for 2D physics. This resembles a spring joint that always wants to go back to a given length.
 */
@@ -30,13 +22,40 @@ type DampedSpringJoint2D struct {
 	owner gdnative.Object
 }
 
+// classConstructorDampedSpringJoint2D is the class constructor of the DampedSpringJoint2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorDampedSpringJoint2D = gdnative.NewLazyClassConstructor("DampedSpringJoint2D")
+
+// NewDampedSpringJoint2D will create a new instance of the DampedSpringJoint2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewDampedSpringJoint2D() *DampedSpringJoint2D {
+	obj := &DampedSpringJoint2D{}
+	obj.SetBaseObject(classConstructorDampedSpringJoint2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *DampedSpringJoint2D) BaseClass() string {
 	return "DampedSpringJoint2D"
 }
 
+// Method binds of the DampedSpringJoint2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindDampedSpringJoint2DGetDamping    = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "get_damping")
+	methodBindDampedSpringJoint2DGetLength     = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "get_length")
+	methodBindDampedSpringJoint2DGetRestLength = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "get_rest_length")
+	methodBindDampedSpringJoint2DGetStiffness  = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "get_stiffness")
+	methodBindDampedSpringJoint2DSetDamping    = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "set_damping")
+	methodBindDampedSpringJoint2DSetLength     = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "set_length")
+	methodBindDampedSpringJoint2DSetRestLength = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "set_rest_length")
+	methodBindDampedSpringJoint2DSetStiffness  = gdnative.NewLazyMethodBind("DampedSpringJoint2D", "set_stiffness")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *DampedSpringJoint2D) GetDamping() gdnative.Real {
 	//log.Println("Calling DampedSpringJoint2D.GetDamping()")
@@ -45,7 +64,7 @@ func (o *DampedSpringJoint2D) GetDamping() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "get_damping")
+	methodBind := methodBindDampedSpringJoint2DGetDamping.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +77,8 @@ func (o *DampedSpringJoint2D) GetDamping() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *DampedSpringJoint2D) GetLength() gdnative.Real {
 	//log.Println("Calling DampedSpringJoint2D.GetLength()")
@@ -68,7 +87,7 @@ func (o *DampedSpringJoint2D) GetLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "get_length")
+	methodBind := methodBindDampedSpringJoint2DGetLength.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +100,8 @@ func (o *DampedSpringJoint2D) GetLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *DampedSpringJoint2D) GetRestLength() gdnative.Real {
 	//log.Println("Calling DampedSpringJoint2D.GetRestLength()")
@@ -91,7 +110,7 @@ func (o *DampedSpringJoint2D) GetRestLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "get_rest_length")
+	methodBind := methodBindDampedSpringJoint2DGetRestLength.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +123,8 @@ func (o *DampedSpringJoint2D) GetRestLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *DampedSpringJoint2D) GetStiffness() gdnative.Real {
 	//log.Println("Calling DampedSpringJoint2D.GetStiffness()")
@@ -114,7 +133,7 @@ func (o *DampedSpringJoint2D) GetStiffness() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "get_stiffness")
+	methodBind := methodBindDampedSpringJoint2DGetStiffness.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +146,8 @@ func (o *DampedSpringJoint2D) GetStiffness() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false damping float}], Returns: void
+	        Undocumented
+		Args: [{ false damping float}], Returns: void
 */
 func (o *DampedSpringJoint2D) SetDamping(damping gdnative.Real) {
 	//log.Println("Calling DampedSpringJoint2D.SetDamping()")
@@ -138,7 +157,7 @@ func (o *DampedSpringJoint2D) SetDamping(damping gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(damping)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "set_damping")
+	methodBind := methodBindDampedSpringJoint2DSetDamping.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +167,8 @@ func (o *DampedSpringJoint2D) SetDamping(damping gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *DampedSpringJoint2D) SetLength(length gdnative.Real) {
 	//log.Println("Calling DampedSpringJoint2D.SetLength()")
@@ -159,7 +178,7 @@ func (o *DampedSpringJoint2D) SetLength(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "set_length")
+	methodBind := methodBindDampedSpringJoint2DSetLength.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +188,8 @@ func (o *DampedSpringJoint2D) SetLength(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rest_length float}], Returns: void
+	        Undocumented
+		Args: [{ false rest_length float}], Returns: void
 */
 func (o *DampedSpringJoint2D) SetRestLength(restLength gdnative.Real) {
 	//log.Println("Calling DampedSpringJoint2D.SetRestLength()")
@@ -180,7 +199,7 @@ func (o *DampedSpringJoint2D) SetRestLength(restLength gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(restLength)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "set_rest_length")
+	methodBind := methodBindDampedSpringJoint2DSetRestLength.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +209,8 @@ func (o *DampedSpringJoint2D) SetRestLength(restLength gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false stiffness float}], Returns: void
+	        Undocumented
+		Args: [{ false stiffness float}], Returns: void
 */
 func (o *DampedSpringJoint2D) SetStiffness(stiffness gdnative.Real) {
 	//log.Println("Calling DampedSpringJoint2D.SetStiffness()")
@@ -201,7 +220,7 @@ func (o *DampedSpringJoint2D) SetStiffness(stiffness gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(stiffness)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DampedSpringJoint2D", "set_stiffness")
+	methodBind := methodBindDampedSpringJoint2DSetStiffness.Get()
 
 	// Call the parent method.
 	// void
@@ -210,6 +229,26 @@ func (o *DampedSpringJoint2D) SetStiffness(stiffness gdnative.Real) {
 
 }
 
+// Damping will return the value of the "damping" property.
+func (o *DampedSpringJoint2D) Damping() gdnative.Real {
+	return o.GetDamping()
+}
+
+// Length will return the value of the "length" property.
+func (o *DampedSpringJoint2D) Length() gdnative.Real {
+	return o.GetLength()
+}
+
+// RestLength will return the value of the "rest_length" property.
+func (o *DampedSpringJoint2D) RestLength() gdnative.Real {
+	return o.GetRestLength()
+}
+
+// Stiffness will return the value of the "stiffness" property.
+func (o *DampedSpringJoint2D) Stiffness() gdnative.Real {
+	return o.GetStiffness()
+}
+
 // DampedSpringJoint2DImplementer is an interface that implements the methods
 // of the DampedSpringJoint2D class.
 type DampedSpringJoint2DImplementer interface {
@@ -222,4 +261,8 @@ type DampedSpringJoint2DImplementer interface {
 	SetLength(length gdnative.Real)
 	SetRestLength(restLength gdnative.Real)
 	SetStiffness(stiffness gdnative.Real)
+	Damping() gdnative.Real
+	Length() gdnative.Real
+	RestLength() gdnative.Real
+	Stiffness() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGrooveJoint2DFromPointer(ptr gdnative.Pointer) GrooveJoint2D {
-func newGrooveJoint2DFromPointer(ptr gdnative.Pointer) GrooveJoint2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GrooveJoint2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Groove constraint for 2D physics. This is useful for making a body "slide" through a segment placed in another.
 */
@@ -30,13 +22,36 @@ type GrooveJoint2D struct {
 	owner gdnative.Object
 }
 
+// classConstructorGrooveJoint2D is the class constructor of the GrooveJoint2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGrooveJoint2D = gdnative.NewLazyClassConstructor("GrooveJoint2D")
+
+// NewGrooveJoint2D will create a new instance of the GrooveJoint2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewGrooveJoint2D() *GrooveJoint2D {
+	obj := &GrooveJoint2D{}
+	obj.SetBaseObject(classConstructorGrooveJoint2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GrooveJoint2D) BaseClass() string {
 	return "GrooveJoint2D"
 }
 
+// Method binds of the GrooveJoint2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGrooveJoint2DGetInitialOffset = gdnative.NewLazyMethodBind("GrooveJoint2D", "get_initial_offset")
+	methodBindGrooveJoint2DGetLength        = gdnative.NewLazyMethodBind("GrooveJoint2D", "get_length")
+	methodBindGrooveJoint2DSetInitialOffset = gdnative.NewLazyMethodBind("GrooveJoint2D", "set_initial_offset")
+	methodBindGrooveJoint2DSetLength        = gdnative.NewLazyMethodBind("GrooveJoint2D", "set_length")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GrooveJoint2D) GetInitialOffset() gdnative.Real {
 	//log.Println("Calling GrooveJoint2D.GetInitialOffset()")
@@ -45,7 +60,7 @@ func (o *GrooveJoint2D) GetInitialOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GrooveJoint2D", "get_initial_offset")
+	methodBind := methodBindGrooveJoint2DGetInitialOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +73,8 @@ func (o *GrooveJoint2D) GetInitialOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GrooveJoint2D) GetLength() gdnative.Real {
 	//log.Println("Calling GrooveJoint2D.GetLength()")
@@ -68,7 +83,7 @@ func (o *GrooveJoint2D) GetLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GrooveJoint2D", "get_length")
+	methodBind := methodBindGrooveJoint2DGetLength.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +96,8 @@ func (o *GrooveJoint2D) GetLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset float}], Returns: void
+	        Undocumented
+		Args: [{ false offset float}], Returns: void
 */
 func (o *GrooveJoint2D) SetInitialOffset(offset gdnative.Real) {
 	//log.Println("Calling GrooveJoint2D.SetInitialOffset()")
@@ -92,7 +107,7 @@ func (o *GrooveJoint2D) SetInitialOffset(offset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GrooveJoint2D", "set_initial_offset")
+	methodBind := methodBindGrooveJoint2DSetInitialOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +117,8 @@ func (o *GrooveJoint2D) SetInitialOffset(offset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *GrooveJoint2D) SetLength(length gdnative.Real) {
 	//log.Println("Calling GrooveJoint2D.SetLength()")
@@ -113,7 +128,7 @@ func (o *GrooveJoint2D) SetLength(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GrooveJoint2D", "set_length")
+	methodBind := methodBindGrooveJoint2DSetLength.Get()
 
 	// Call the parent method.
 	// void
@@ -122,6 +137,16 @@ func (o *GrooveJoint2D) SetLength(length gdnative.Real) {
 
 }
 
+// InitialOffset will return the value of the "initial_offset" property.
+func (o *GrooveJoint2D) InitialOffset() gdnative.Real {
+	return o.GetInitialOffset()
+}
+
+// Length will return the value of the "length" property.
+func (o *GrooveJoint2D) Length() gdnative.Real {
+	return o.GetLength()
+}
+
 // GrooveJoint2DImplementer is an interface that implements the methods
 // of the GrooveJoint2D class.
 type GrooveJoint2DImplementer interface {
@@ -130,4 +155,6 @@ type GrooveJoint2DImplementer interface {
 	GetLength() gdnative.Real
 	SetInitialOffset(offset gdnative.Real)
 	SetLength(length gdnative.Real)
+	InitialOffset() gdnative.Real
+	Length() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewJoint2DFromPointer(ptr gdnative.Pointer) Joint2D {
-func newJoint2DFromPointer(ptr gdnative.Pointer) Joint2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Joint2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base node for all joint constraints in 2D physics. Joints take 2 bodies and apply a custom constraint.
 */
 type Joint2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
@@ -34,9 +26,22 @@ func (o *Joint2D) BaseClass() string {
 	return "Joint2D"
 }
 
+// Method binds of the Joint2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindJoint2DGetBias                      = gdnative.NewLazyMethodBind("Joint2D", "get_bias")
+	methodBindJoint2DGetExcludeNodesFromCollision = gdnative.NewLazyMethodBind("Joint2D", "get_exclude_nodes_from_collision")
+	methodBindJoint2DGetNodeA                     = gdnative.NewLazyMethodBind("Joint2D", "get_node_a")
+	methodBindJoint2DGetNodeB                     = gdnative.NewLazyMethodBind("Joint2D", "get_node_b")
+	methodBindJoint2DSetBias                      = gdnative.NewLazyMethodBind("Joint2D", "set_bias")
+	methodBindJoint2DSetExcludeNodesFromCollision = gdnative.NewLazyMethodBind("Joint2D", "set_exclude_nodes_from_collision")
+	methodBindJoint2DSetNodeA                     = gdnative.NewLazyMethodBind("Joint2D", "set_node_a")
+	methodBindJoint2DSetNodeB                     = gdnative.NewLazyMethodBind("Joint2D", "set_node_b")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Joint2D) GetBias() gdnative.Real {
 	//log.Println("Calling Joint2D.GetBias()")
@@ -45,7 +50,7 @@ func (o *Joint2D) GetBias() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "get_bias")
+	methodBind := methodBindJoint2DGetBias.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +63,8 @@ func (o *Joint2D) GetBias() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Joint2D) GetExcludeNodesFromCollision() gdnative.Bool {
 	//log.Println("Calling Joint2D.GetExcludeNodesFromCollision()")
@@ -68,7 +73,7 @@ func (o *Joint2D) GetExcludeNodesFromCollision() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "get_exclude_nodes_from_collision")
+	methodBind := methodBindJoint2DGetExcludeNodesFromCollision.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +86,8 @@ func (o *Joint2D) GetExcludeNodesFromCollision() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *Joint2D) GetNodeA() gdnative.NodePath {
 	//log.Println("Calling Joint2D.GetNodeA()")
@@ -91,7 +96,7 @@ func (o *Joint2D) GetNodeA() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "get_node_a")
+	methodBind := methodBindJoint2DGetNodeA.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -104,8 +109,8 @@ func (o *Joint2D) GetNodeA() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *Joint2D) GetNodeB() gdnative.NodePath {
 	//log.Println("Calling Joint2D.GetNodeB()")
@@ -114,7 +119,7 @@ func (o *Joint2D) GetNodeB() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "get_node_b")
+	methodBind := methodBindJoint2DGetNodeB.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -127,8 +132,8 @@ func (o *Joint2D) GetNodeB() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [{ false bias float}], Returns: void
+	        Undocumented
+		Args: [{ false bias float}], Returns: void
 */
 func (o *Joint2D) SetBias(bias gdnative.Real) {
 	//log.Println("Calling Joint2D.SetBias()")
@@ -138,7 +143,7 @@ func (o *Joint2D) SetBias(bias gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(bias)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "set_bias")
+	methodBind := methodBindJoint2DSetBias.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +153,8 @@ func (o *Joint2D) SetBias(bias gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Joint2D) SetExcludeNodesFromCollision(enable gdnative.Bool) {
 	//log.Println("Calling Joint2D.SetExcludeNodesFromCollision()")
@@ -159,7 +164,7 @@ func (o *Joint2D) SetExcludeNodesFromCollision(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "set_exclude_nodes_from_collision")
+	methodBind := methodBindJoint2DSetExcludeNodesFromCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +174,8 @@ func (o *Joint2D) SetExcludeNodesFromCollision(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false node NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false node NodePath}], Returns: void
 */
 func (o *Joint2D) SetNodeA(node gdnative.NodePath) {
 	//log.Println("Calling Joint2D.SetNodeA()")
@@ -180,7 +185,7 @@ func (o *Joint2D) SetNodeA(node gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(node)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "set_node_a")
+	methodBind := methodBindJoint2DSetNodeA.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +195,8 @@ func (o *Joint2D) SetNodeA(node gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false node NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false node NodePath}], Returns: void
 */
 func (o *Joint2D) SetNodeB(node gdnative.NodePath) {
 	//log.Println("Calling Joint2D.SetNodeB()")
@@ -201,7 +206,7 @@ func (o *Joint2D) SetNodeB(node gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(node)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint2D", "set_node_b")
+	methodBind := methodBindJoint2DSetNodeB.Get()
 
 	// Call the parent method.
 	// void
@@ -210,10 +215,35 @@ func (o *Joint2D) SetNodeB(node gdnative.NodePath) {
 
 }
 
+// Bias will return the value of the "bias" property.
+func (o *Joint2D) Bias() gdnative.Real {
+	return o.GetBias()
+}
+
+// DisableCollision will return the value of the "disable_collision" property.
+func (o *Joint2D) DisableCollision() gdnative.Bool {
+	return o.GetExcludeNodesFromCollision()
+}
+
+// SetDisableCollision will set the value of the "disable_collision" property.
+func (o *Joint2D) SetDisableCollision(value gdnative.Bool) {
+	o.SetExcludeNodesFromCollision(value)
+}
+
+// NodeA will return the value of the "node_a" property.
+func (o *Joint2D) NodeA() gdnative.NodePath {
+	return o.GetNodeA()
+}
+
+// NodeB will return the value of the "node_b" property.
+func (o *Joint2D) NodeB() gdnative.NodePath {
+	return o.GetNodeB()
+}
+
 // Joint2DImplementer is an interface that implements the methods
 // of the Joint2D class.
 type Joint2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetBias() gdnative.Real
 	GetExcludeNodesFromCollision() gdnative.Bool
 	GetNodeA() gdnative.NodePath
@@ -222,4 +252,9 @@ type Joint2DImplementer interface {
 	SetExcludeNodesFromCollision(enable gdnative.Bool)
 	SetNodeA(node gdnative.NodePath)
 	SetNodeB(node gdnative.NodePath)
+	Bias() gdnative.Real
+	DisableCollision() gdnative.Bool
+	SetDisableCollision(value gdnative.Bool)
+	NodeA() gdnative.NodePath
+	NodeB() gdnative.NodePath
 }
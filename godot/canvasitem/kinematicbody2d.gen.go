@@ -1,7 +1,9 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/reference"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +15,51 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewKinematicBody2DFromPointer(ptr gdnative.Pointer) KinematicBody2D {
-func newKinematicBody2DFromPointer(ptr gdnative.Pointer) KinematicBody2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := KinematicBody2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Kinematic bodies are special types of bodies that are meant to be user-controlled. They are not affected by physics at all (to other types of bodies, such a character or a rigid body, these are the same as a static body). They have however, two main uses: Simulated Motion: When these bodies are moved manually, either from code or from an AnimationPlayer (with process mode set to fixed), the physics will automatically compute an estimate of their linear and angular velocity. This makes them very useful for moving platforms or other AnimationPlayer-controlled objects (like a door, a bridge that opens, etc). Kinematic Characters: KinematicBody2D also has an API for moving objects (the [method move_and_collide] and [method move_and_slide] methods) while performing collision tests. This makes them really useful to implement characters that collide against a world, but that don't require advanced physics.
 */
 type KinematicBody2D struct {
-	PhysicsBody2D
+	godot.PhysicsBody2D
 	owner gdnative.Object
 }
 
+// classConstructorKinematicBody2D is the class constructor of the KinematicBody2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorKinematicBody2D = gdnative.NewLazyClassConstructor("KinematicBody2D")
+
+// NewKinematicBody2D will create a new instance of the KinematicBody2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewKinematicBody2D() *KinematicBody2D {
+	obj := &KinematicBody2D{}
+	obj.SetBaseObject(classConstructorKinematicBody2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *KinematicBody2D) BaseClass() string {
 	return "KinematicBody2D"
 }
 
+// Method binds of the KinematicBody2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindKinematicBody2DGetFloorVelocity  = gdnative.NewLazyMethodBind("KinematicBody2D", "get_floor_velocity")
+	methodBindKinematicBody2DGetSafeMargin     = gdnative.NewLazyMethodBind("KinematicBody2D", "get_safe_margin")
+	methodBindKinematicBody2DGetSlideCollision = gdnative.NewLazyMethodBind("KinematicBody2D", "get_slide_collision")
+	methodBindKinematicBody2DGetSlideCount     = gdnative.NewLazyMethodBind("KinematicBody2D", "get_slide_count")
+	methodBindKinematicBody2DIsOnCeiling       = gdnative.NewLazyMethodBind("KinematicBody2D", "is_on_ceiling")
+	methodBindKinematicBody2DIsOnFloor         = gdnative.NewLazyMethodBind("KinematicBody2D", "is_on_floor")
+	methodBindKinematicBody2DIsOnWall          = gdnative.NewLazyMethodBind("KinematicBody2D", "is_on_wall")
+	methodBindKinematicBody2DMoveAndCollide    = gdnative.NewLazyMethodBind("KinematicBody2D", "move_and_collide")
+	methodBindKinematicBody2DMoveAndSlide      = gdnative.NewLazyMethodBind("KinematicBody2D", "move_and_slide")
+	methodBindKinematicBody2DSetSafeMargin     = gdnative.NewLazyMethodBind("KinematicBody2D", "set_safe_margin")
+	methodBindKinematicBody2DTestMove          = gdnative.NewLazyMethodBind("KinematicBody2D", "test_move")
+)
+
 /*
-        Returns the velocity of the floor. Only updates when calling [method move_and_slide].
-	Args: [], Returns: Vector2
+	        Returns the velocity of the floor. Only updates when calling [method move_and_slide].
+		Args: [], Returns: Vector2
 */
 func (o *KinematicBody2D) GetFloorVelocity() gdnative.Vector2 {
 	//log.Println("Calling KinematicBody2D.GetFloorVelocity()")
@@ -45,7 +68,7 @@ func (o *KinematicBody2D) GetFloorVelocity() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "get_floor_velocity")
+	methodBind := methodBindKinematicBody2DGetFloorVelocity.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +81,8 @@ func (o *KinematicBody2D) GetFloorVelocity() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *KinematicBody2D) GetSafeMargin() gdnative.Real {
 	//log.Println("Calling KinematicBody2D.GetSafeMargin()")
@@ -68,7 +91,7 @@ func (o *KinematicBody2D) GetSafeMargin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "get_safe_margin")
+	methodBind := methodBindKinematicBody2DGetSafeMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -81,10 +104,10 @@ func (o *KinematicBody2D) GetSafeMargin() gdnative.Real {
 }
 
 /*
-        Returns a [KinematicCollision2D], which contains information about a collision that occurred during the last [method move_and_slide] call. Since the body can collide several times in a single call to [method move_and_slide], you must specify the index of the collision in the range 0 to ([method get_slide_count] - 1).
-	Args: [{ false slide_idx int}], Returns: KinematicCollision2D
+	        Returns a [KinematicCollision2D], which contains information about a collision that occurred during the last [method move_and_slide] call. Since the body can collide several times in a single call to [method move_and_slide], you must specify the index of the collision in the range 0 to ([method get_slide_count] - 1).
+		Args: [{ false slide_idx int}], Returns: KinematicCollision2D
 */
-func (o *KinematicBody2D) GetSlideCollision(slideIdx gdnative.Int) KinematicCollision2DImplementer {
+func (o *KinematicBody2D) GetSlideCollision(slideIdx gdnative.Int) reference.KinematicCollision2DImplementer {
 	//log.Println("Calling KinematicBody2D.GetSlideCollision()")
 
 	// Build out the method's arguments
@@ -92,7 +115,7 @@ func (o *KinematicBody2D) GetSlideCollision(slideIdx gdnative.Int) KinematicColl
 	ptrArguments[0] = gdnative.NewPointerFromInt(slideIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "get_slide_collision")
+	methodBind := methodBindKinematicBody2DGetSlideCollision.Get()
 
 	// Call the parent method.
 	// KinematicCollision2D
@@ -100,27 +123,25 @@ func (o *KinematicBody2D) GetSlideCollision(slideIdx gdnative.Int) KinematicColl
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newKinematicCollision2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(KinematicCollision2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "KinematicCollision2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(KinematicCollision2DImplementer)
+	ret := reference.KinematicCollision2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(reference.KinematicCollision2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of times the body collided and changed direction during the last call to [method move_and_slide].
-	Args: [], Returns: int
+	        Returns the number of times the body collided and changed direction during the last call to [method move_and_slide].
+		Args: [], Returns: int
 */
 func (o *KinematicBody2D) GetSlideCount() gdnative.Int {
 	//log.Println("Calling KinematicBody2D.GetSlideCount()")
@@ -129,7 +150,7 @@ func (o *KinematicBody2D) GetSlideCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "get_slide_count")
+	methodBind := methodBindKinematicBody2DGetSlideCount.Get()
 
 	// Call the parent method.
 	// int
@@ -142,8 +163,8 @@ func (o *KinematicBody2D) GetSlideCount() gdnative.Int {
 }
 
 /*
-        Returns [code]true[/code] if the body is on the ceiling. Only updates when calling [method move_and_slide].
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the body is on the ceiling. Only updates when calling [method move_and_slide].
+		Args: [], Returns: bool
 */
 func (o *KinematicBody2D) IsOnCeiling() gdnative.Bool {
 	//log.Println("Calling KinematicBody2D.IsOnCeiling()")
@@ -152,7 +173,7 @@ func (o *KinematicBody2D) IsOnCeiling() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "is_on_ceiling")
+	methodBind := methodBindKinematicBody2DIsOnCeiling.Get()
 
 	// Call the parent method.
 	// bool
@@ -165,8 +186,8 @@ func (o *KinematicBody2D) IsOnCeiling() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the body is on the floor. Only updates when calling [method move_and_slide].
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the body is on the floor. Only updates when calling [method move_and_slide].
+		Args: [], Returns: bool
 */
 func (o *KinematicBody2D) IsOnFloor() gdnative.Bool {
 	//log.Println("Calling KinematicBody2D.IsOnFloor()")
@@ -175,7 +196,7 @@ func (o *KinematicBody2D) IsOnFloor() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "is_on_floor")
+	methodBind := methodBindKinematicBody2DIsOnFloor.Get()
 
 	// Call the parent method.
 	// bool
@@ -188,8 +209,8 @@ func (o *KinematicBody2D) IsOnFloor() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the body is on a wall. Only updates when calling [method move_and_slide].
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the body is on a wall. Only updates when calling [method move_and_slide].
+		Args: [], Returns: bool
 */
 func (o *KinematicBody2D) IsOnWall() gdnative.Bool {
 	//log.Println("Calling KinematicBody2D.IsOnWall()")
@@ -198,7 +219,7 @@ func (o *KinematicBody2D) IsOnWall() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "is_on_wall")
+	methodBind := methodBindKinematicBody2DIsOnWall.Get()
 
 	// Call the parent method.
 	// bool
@@ -211,10 +232,10 @@ func (o *KinematicBody2D) IsOnWall() gdnative.Bool {
 }
 
 /*
-        Moves the body along the vector [code]rel_vec[/code]. The body will stop if it collides. Returns a [KinematicCollision2D], which contains information about the collision.
-	Args: [{ false rel_vec Vector2}], Returns: KinematicCollision2D
+	        Moves the body along the vector [code]rel_vec[/code]. The body will stop if it collides. Returns a [KinematicCollision2D], which contains information about the collision.
+		Args: [{ false rel_vec Vector2}], Returns: KinematicCollision2D
 */
-func (o *KinematicBody2D) MoveAndCollide(relVec gdnative.Vector2) KinematicCollision2DImplementer {
+func (o *KinematicBody2D) MoveAndCollide(relVec gdnative.Vector2) reference.KinematicCollision2DImplementer {
 	//log.Println("Calling KinematicBody2D.MoveAndCollide()")
 
 	// Build out the method's arguments
@@ -222,7 +243,7 @@ func (o *KinematicBody2D) MoveAndCollide(relVec gdnative.Vector2) KinematicColli
 	ptrArguments[0] = gdnative.NewPointerFromVector2(relVec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "move_and_collide")
+	methodBind := methodBindKinematicBody2DMoveAndCollide.Get()
 
 	// Call the parent method.
 	// KinematicCollision2D
@@ -230,27 +251,25 @@ func (o *KinematicBody2D) MoveAndCollide(relVec gdnative.Vector2) KinematicColli
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newKinematicCollision2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(KinematicCollision2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "KinematicCollision2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(KinematicCollision2DImplementer)
+	ret := reference.KinematicCollision2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(reference.KinematicCollision2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Moves the body along a vector. If the body collides with another, it will slide along the other body rather than stop immediately. If the other body is a [code]KinematicBody2D[/code] or [RigidBody2D], it will also be affected by the motion of the other body. You can use this to make moving or rotating platforms, or to make nodes push other nodes. [code]linear_velocity[/code] is a value in pixels per second. Unlike in for example [method move_and_collide], you should [i]not[/i] multiply it with [code]delta[/code] — this is done by the method. [code]floor_normal[/code] is the up direction, used to determine what is a wall and what is a floor or a ceiling. If set to the default value of [code]Vector2(0, 0)[/code], everything is considered a wall. This is useful for topdown games. If the body is standing on a slope and the horizontal speed (relative to the floor's speed) goes below [code]slope_stop_min_velocity[/code], the body will stop completely. This prevents the body from sliding down slopes when you include gravity in [code]linear_velocity[/code]. When set to lower values, the body will not be able to stand still on steep slopes. If the body collides, it will change direction a maximum of [code]max_bounces[/code] times before it stops. [code]floor_max_angle[/code] is the maximum angle (in radians) where a slope is still considered a floor (or a ceiling), rather than a wall. The default value equals 45 degrees. Returns the movement that remained when the body stopped. To get more detailed information about collisions that occurred, use [method get_slide_collision].
-	Args: [{ false linear_velocity Vector2} {(0, 0) true floor_normal Vector2} {5 true slope_stop_min_velocity float} {4 true max_bounces int} {0.785398 true floor_max_angle float}], Returns: Vector2
+	        Moves the body along a vector. If the body collides with another, it will slide along the other body rather than stop immediately. If the other body is a [code]KinematicBody2D[/code] or [RigidBody2D], it will also be affected by the motion of the other body. You can use this to make moving or rotating platforms, or to make nodes push other nodes. [code]linear_velocity[/code] is a value in pixels per second. Unlike in for example [method move_and_collide], you should [i]not[/i] multiply it with [code]delta[/code] — this is done by the method. [code]floor_normal[/code] is the up direction, used to determine what is a wall and what is a floor or a ceiling. If set to the default value of [code]Vector2(0, 0)[/code], everything is considered a wall. This is useful for topdown games. If the body is standing on a slope and the horizontal speed (relative to the floor's speed) goes below [code]slope_stop_min_velocity[/code], the body will stop completely. This prevents the body from sliding down slopes when you include gravity in [code]linear_velocity[/code]. When set to lower values, the body will not be able to stand still on steep slopes. If the body collides, it will change direction a maximum of [code]max_bounces[/code] times before it stops. [code]floor_max_angle[/code] is the maximum angle (in radians) where a slope is still considered a floor (or a ceiling), rather than a wall. The default value equals 45 degrees. Returns the movement that remained when the body stopped. To get more detailed information about collisions that occurred, use [method get_slide_collision].
+		Args: [{ false linear_velocity Vector2} {(0, 0) true floor_normal Vector2} {5 true slope_stop_min_velocity float} {4 true max_bounces int} {0.785398 true floor_max_angle float}], Returns: Vector2
 */
 func (o *KinematicBody2D) MoveAndSlide(linearVelocity gdnative.Vector2, floorNormal gdnative.Vector2, slopeStopMinVelocity gdnative.Real, maxBounces gdnative.Int, floorMaxAngle gdnative.Real) gdnative.Vector2 {
 	//log.Println("Calling KinematicBody2D.MoveAndSlide()")
@@ -264,7 +283,7 @@ func (o *KinematicBody2D) MoveAndSlide(linearVelocity gdnative.Vector2, floorNor
 	ptrArguments[4] = gdnative.NewPointerFromReal(floorMaxAngle)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "move_and_slide")
+	methodBind := methodBindKinematicBody2DMoveAndSlide.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -277,8 +296,8 @@ func (o *KinematicBody2D) MoveAndSlide(linearVelocity gdnative.Vector2, floorNor
 }
 
 /*
-        Undocumented
-	Args: [{ false pixels float}], Returns: void
+	        Undocumented
+		Args: [{ false pixels float}], Returns: void
 */
 func (o *KinematicBody2D) SetSafeMargin(pixels gdnative.Real) {
 	//log.Println("Calling KinematicBody2D.SetSafeMargin()")
@@ -288,7 +307,7 @@ func (o *KinematicBody2D) SetSafeMargin(pixels gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(pixels)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "set_safe_margin")
+	methodBind := methodBindKinematicBody2DSetSafeMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -298,8 +317,8 @@ func (o *KinematicBody2D) SetSafeMargin(pixels gdnative.Real) {
 }
 
 /*
-        Checks for collisions without moving the body. Virtually sets the node's position, scale and rotation to that of the given [Transform2D], then tries to move the body along the vector [code]rel_vec[/code]. Returns [code]true[/code] if a collision would occur.
-	Args: [{ false from Transform2D} { false rel_vec Vector2}], Returns: bool
+	        Checks for collisions without moving the body. Virtually sets the node's position, scale and rotation to that of the given [Transform2D], then tries to move the body along the vector [code]rel_vec[/code]. Returns [code]true[/code] if a collision would occur.
+		Args: [{ false from Transform2D} { false rel_vec Vector2}], Returns: bool
 */
 func (o *KinematicBody2D) TestMove(from gdnative.Transform2D, relVec gdnative.Vector2) gdnative.Bool {
 	//log.Println("Calling KinematicBody2D.TestMove()")
@@ -310,7 +329,7 @@ func (o *KinematicBody2D) TestMove(from gdnative.Transform2D, relVec gdnative.Ve
 	ptrArguments[1] = gdnative.NewPointerFromVector2(relVec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicBody2D", "test_move")
+	methodBind := methodBindKinematicBody2DTestMove.Get()
 
 	// Call the parent method.
 	// bool
@@ -322,19 +341,37 @@ func (o *KinematicBody2D) TestMove(from gdnative.Transform2D, relVec gdnative.Ve
 	return ret
 }
 
+// MoveAndSlideWithDefaults will call MoveAndSlide using the default values for: floor_normal, slope_stop_min_velocity, max_bounces, floor_max_angle.
+func (o *KinematicBody2D) MoveAndSlideWithDefaults(linearVelocity gdnative.Vector2) gdnative.Vector2 {
+	return o.MoveAndSlide(linearVelocity, gdnative.NewVector2(0, 0), 5, 4, 0.785398)
+}
+
+// CollisionSafeMargin will return the value of the "collision/safe_margin" property.
+func (o *KinematicBody2D) CollisionSafeMargin() gdnative.Real {
+	return o.GetSafeMargin()
+}
+
+// SetCollisionSafeMargin will set the value of the "collision/safe_margin" property.
+func (o *KinematicBody2D) SetCollisionSafeMargin(value gdnative.Real) {
+	o.SetSafeMargin(value)
+}
+
 // KinematicBody2DImplementer is an interface that implements the methods
 // of the KinematicBody2D class.
 type KinematicBody2DImplementer interface {
-	PhysicsBody2DImplementer
+	godot.PhysicsBody2DImplementer
 	GetFloorVelocity() gdnative.Vector2
 	GetSafeMargin() gdnative.Real
-	GetSlideCollision(slideIdx gdnative.Int) KinematicCollision2DImplementer
+	GetSlideCollision(slideIdx gdnative.Int) reference.KinematicCollision2DImplementer
 	GetSlideCount() gdnative.Int
 	IsOnCeiling() gdnative.Bool
 	IsOnFloor() gdnative.Bool
 	IsOnWall() gdnative.Bool
-	MoveAndCollide(relVec gdnative.Vector2) KinematicCollision2DImplementer
+	MoveAndCollide(relVec gdnative.Vector2) reference.KinematicCollision2DImplementer
 	MoveAndSlide(linearVelocity gdnative.Vector2, floorNormal gdnative.Vector2, slopeStopMinVelocity gdnative.Real, maxBounces gdnative.Int, floorMaxAngle gdnative.Real) gdnative.Vector2
 	SetSafeMargin(pixels gdnative.Real)
 	TestMove(from gdnative.Transform2D, relVec gdnative.Vector2) gdnative.Bool
+	MoveAndSlideWithDefaults(linearVelocity gdnative.Vector2) gdnative.Vector2
+	CollisionSafeMargin() gdnative.Real
+	SetCollisionSafeMargin(value gdnative.Real)
 }
@@ -1,7 +1,10 @@
-package godot
+package canvasitem
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,6 +26,36 @@ const (
 	Light2DModeSub  Light2DMode = 1
 )
 
+// String will return the Godot name of the Light2DMode value.
+func (e Light2DMode) String() string {
+	switch e {
+	case Light2DModeAdd:
+		return "MODE_ADD"
+	case Light2DModeMask:
+		return "MODE_MASK"
+	case Light2DModeMix:
+		return "MODE_MIX"
+	case Light2DModeSub:
+		return "MODE_SUB"
+	}
+	return fmt.Sprintf("Light2DMode(%d)", int(e))
+}
+
+// ParseLight2DMode will return the Light2DMode value with the given Godot name.
+func ParseLight2DMode(name string) (Light2DMode, error) {
+	switch name {
+	case "MODE_ADD":
+		return Light2DModeAdd, nil
+	case "MODE_MASK":
+		return Light2DModeMask, nil
+	case "MODE_MIX":
+		return Light2DModeMix, nil
+	case "MODE_SUB":
+		return Light2DModeSub, nil
+	}
+	return 0, fmt.Errorf("invalid Light2DMode value %q", name)
+}
+
 // Light2DShadowFilter is an enum for ShadowFilter values.
 type Light2DShadowFilter int
 
@@ -35,30 +68,120 @@ const (
 	Light2DShadowFilterPcf9  Light2DShadowFilter = 4
 )
 
-//func NewLight2DFromPointer(ptr gdnative.Pointer) Light2D {
-func newLight2DFromPointer(ptr gdnative.Pointer) Light2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Light2D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the Light2DShadowFilter value.
+func (e Light2DShadowFilter) String() string {
+	switch e {
+	case Light2DShadowFilterNone:
+		return "SHADOW_FILTER_NONE"
+	case Light2DShadowFilterPcf13:
+		return "SHADOW_FILTER_PCF13"
+	case Light2DShadowFilterPcf3:
+		return "SHADOW_FILTER_PCF3"
+	case Light2DShadowFilterPcf5:
+		return "SHADOW_FILTER_PCF5"
+	case Light2DShadowFilterPcf7:
+		return "SHADOW_FILTER_PCF7"
+	case Light2DShadowFilterPcf9:
+		return "SHADOW_FILTER_PCF9"
+	}
+	return fmt.Sprintf("Light2DShadowFilter(%d)", int(e))
+}
 
-	return obj
+// ParseLight2DShadowFilter will return the Light2DShadowFilter value with the given Godot name.
+func ParseLight2DShadowFilter(name string) (Light2DShadowFilter, error) {
+	switch name {
+	case "SHADOW_FILTER_NONE":
+		return Light2DShadowFilterNone, nil
+	case "SHADOW_FILTER_PCF13":
+		return Light2DShadowFilterPcf13, nil
+	case "SHADOW_FILTER_PCF3":
+		return Light2DShadowFilterPcf3, nil
+	case "SHADOW_FILTER_PCF5":
+		return Light2DShadowFilterPcf5, nil
+	case "SHADOW_FILTER_PCF7":
+		return Light2DShadowFilterPcf7, nil
+	case "SHADOW_FILTER_PCF9":
+		return Light2DShadowFilterPcf9, nil
+	}
+	return 0, fmt.Errorf("invalid Light2DShadowFilter value %q", name)
 }
 
 /*
 Casts light in a 2D environment. Light is defined by a (usually grayscale) texture, a color, an energy value, a mode (see constants), and various other parameters (range and shadows-related). Note that Light2D can be used as a mask.
 */
 type Light2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorLight2D is the class constructor of the Light2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLight2D = gdnative.NewLazyClassConstructor("Light2D")
+
+// NewLight2D will create a new instance of the Light2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewLight2D() *Light2D {
+	obj := &Light2D{}
+	obj.SetBaseObject(classConstructorLight2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Light2D) BaseClass() string {
 	return "Light2D"
 }
 
+// Method binds of the Light2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLight2DGetColor                = gdnative.NewLazyMethodBind("Light2D", "get_color")
+	methodBindLight2DGetEnergy               = gdnative.NewLazyMethodBind("Light2D", "get_energy")
+	methodBindLight2DGetHeight               = gdnative.NewLazyMethodBind("Light2D", "get_height")
+	methodBindLight2DGetItemCullMask         = gdnative.NewLazyMethodBind("Light2D", "get_item_cull_mask")
+	methodBindLight2DGetItemShadowCullMask   = gdnative.NewLazyMethodBind("Light2D", "get_item_shadow_cull_mask")
+	methodBindLight2DGetLayerRangeMax        = gdnative.NewLazyMethodBind("Light2D", "get_layer_range_max")
+	methodBindLight2DGetLayerRangeMin        = gdnative.NewLazyMethodBind("Light2D", "get_layer_range_min")
+	methodBindLight2DGetMode                 = gdnative.NewLazyMethodBind("Light2D", "get_mode")
+	methodBindLight2DGetShadowBufferSize     = gdnative.NewLazyMethodBind("Light2D", "get_shadow_buffer_size")
+	methodBindLight2DGetShadowColor          = gdnative.NewLazyMethodBind("Light2D", "get_shadow_color")
+	methodBindLight2DGetShadowFilter         = gdnative.NewLazyMethodBind("Light2D", "get_shadow_filter")
+	methodBindLight2DGetShadowGradientLength = gdnative.NewLazyMethodBind("Light2D", "get_shadow_gradient_length")
+	methodBindLight2DGetShadowSmooth         = gdnative.NewLazyMethodBind("Light2D", "get_shadow_smooth")
+	methodBindLight2DGetTexture              = gdnative.NewLazyMethodBind("Light2D", "get_texture")
+	methodBindLight2DGetTextureOffset        = gdnative.NewLazyMethodBind("Light2D", "get_texture_offset")
+	methodBindLight2DGetTextureScale         = gdnative.NewLazyMethodBind("Light2D", "get_texture_scale")
+	methodBindLight2DGetZRangeMax            = gdnative.NewLazyMethodBind("Light2D", "get_z_range_max")
+	methodBindLight2DGetZRangeMin            = gdnative.NewLazyMethodBind("Light2D", "get_z_range_min")
+	methodBindLight2DIsEditorOnly            = gdnative.NewLazyMethodBind("Light2D", "is_editor_only")
+	methodBindLight2DIsEnabled               = gdnative.NewLazyMethodBind("Light2D", "is_enabled")
+	methodBindLight2DIsShadowEnabled         = gdnative.NewLazyMethodBind("Light2D", "is_shadow_enabled")
+	methodBindLight2DSetColor                = gdnative.NewLazyMethodBind("Light2D", "set_color")
+	methodBindLight2DSetEditorOnly           = gdnative.NewLazyMethodBind("Light2D", "set_editor_only")
+	methodBindLight2DSetEnabled              = gdnative.NewLazyMethodBind("Light2D", "set_enabled")
+	methodBindLight2DSetEnergy               = gdnative.NewLazyMethodBind("Light2D", "set_energy")
+	methodBindLight2DSetHeight               = gdnative.NewLazyMethodBind("Light2D", "set_height")
+	methodBindLight2DSetItemCullMask         = gdnative.NewLazyMethodBind("Light2D", "set_item_cull_mask")
+	methodBindLight2DSetItemShadowCullMask   = gdnative.NewLazyMethodBind("Light2D", "set_item_shadow_cull_mask")
+	methodBindLight2DSetLayerRangeMax        = gdnative.NewLazyMethodBind("Light2D", "set_layer_range_max")
+	methodBindLight2DSetLayerRangeMin        = gdnative.NewLazyMethodBind("Light2D", "set_layer_range_min")
+	methodBindLight2DSetMode                 = gdnative.NewLazyMethodBind("Light2D", "set_mode")
+	methodBindLight2DSetShadowBufferSize     = gdnative.NewLazyMethodBind("Light2D", "set_shadow_buffer_size")
+	methodBindLight2DSetShadowColor          = gdnative.NewLazyMethodBind("Light2D", "set_shadow_color")
+	methodBindLight2DSetShadowEnabled        = gdnative.NewLazyMethodBind("Light2D", "set_shadow_enabled")
+	methodBindLight2DSetShadowFilter         = gdnative.NewLazyMethodBind("Light2D", "set_shadow_filter")
+	methodBindLight2DSetShadowGradientLength = gdnative.NewLazyMethodBind("Light2D", "set_shadow_gradient_length")
+	methodBindLight2DSetShadowSmooth         = gdnative.NewLazyMethodBind("Light2D", "set_shadow_smooth")
+	methodBindLight2DSetTexture              = gdnative.NewLazyMethodBind("Light2D", "set_texture")
+	methodBindLight2DSetTextureOffset        = gdnative.NewLazyMethodBind("Light2D", "set_texture_offset")
+	methodBindLight2DSetTextureScale         = gdnative.NewLazyMethodBind("Light2D", "set_texture_scale")
+	methodBindLight2DSetZRangeMax            = gdnative.NewLazyMethodBind("Light2D", "set_z_range_max")
+	methodBindLight2DSetZRangeMin            = gdnative.NewLazyMethodBind("Light2D", "set_z_range_min")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Light2D) GetColor() gdnative.Color {
 	//log.Println("Calling Light2D.GetColor()")
@@ -67,7 +190,7 @@ func (o *Light2D) GetColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_color")
+	methodBind := methodBindLight2DGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -80,8 +203,8 @@ func (o *Light2D) GetColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Light2D) GetEnergy() gdnative.Real {
 	//log.Println("Calling Light2D.GetEnergy()")
@@ -90,7 +213,7 @@ func (o *Light2D) GetEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_energy")
+	methodBind := methodBindLight2DGetEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -103,8 +226,8 @@ func (o *Light2D) GetEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Light2D) GetHeight() gdnative.Real {
 	//log.Println("Calling Light2D.GetHeight()")
@@ -113,7 +236,7 @@ func (o *Light2D) GetHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_height")
+	methodBind := methodBindLight2DGetHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -126,8 +249,8 @@ func (o *Light2D) GetHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Light2D) GetItemCullMask() gdnative.Int {
 	//log.Println("Calling Light2D.GetItemCullMask()")
@@ -136,7 +259,7 @@ func (o *Light2D) GetItemCullMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_item_cull_mask")
+	methodBind := methodBindLight2DGetItemCullMask.Get()
 
 	// Call the parent method.
 	// int
@@ -149,8 +272,8 @@ func (o *Light2D) GetItemCullMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Light2D) GetItemShadowCullMask() gdnative.Int {
 	//log.Println("Calling Light2D.GetItemShadowCullMask()")
@@ -159,7 +282,7 @@ func (o *Light2D) GetItemShadowCullMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_item_shadow_cull_mask")
+	methodBind := methodBindLight2DGetItemShadowCullMask.Get()
 
 	// Call the parent method.
 	// int
@@ -172,8 +295,8 @@ func (o *Light2D) GetItemShadowCullMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Light2D) GetLayerRangeMax() gdnative.Int {
 	//log.Println("Calling Light2D.GetLayerRangeMax()")
@@ -182,7 +305,7 @@ func (o *Light2D) GetLayerRangeMax() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_layer_range_max")
+	methodBind := methodBindLight2DGetLayerRangeMax.Get()
 
 	// Call the parent method.
 	// int
@@ -195,8 +318,8 @@ func (o *Light2D) GetLayerRangeMax() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Light2D) GetLayerRangeMin() gdnative.Int {
 	//log.Println("Calling Light2D.GetLayerRangeMin()")
@@ -205,7 +328,7 @@ func (o *Light2D) GetLayerRangeMin() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_layer_range_min")
+	methodBind := methodBindLight2DGetLayerRangeMin.Get()
 
 	// Call the parent method.
 	// int
@@ -218,8 +341,8 @@ func (o *Light2D) GetLayerRangeMin() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Light2D::Mode
+	        Undocumented
+		Args: [], Returns: enum.Light2D::Mode
 */
 func (o *Light2D) GetMode() Light2DMode {
 	//log.Println("Calling Light2D.GetMode()")
@@ -228,7 +351,7 @@ func (o *Light2D) GetMode() Light2DMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_mode")
+	methodBind := methodBindLight2DGetMode.Get()
 
 	// Call the parent method.
 	// enum.Light2D::Mode
@@ -241,8 +364,8 @@ func (o *Light2D) GetMode() Light2DMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Light2D) GetShadowBufferSize() gdnative.Int {
 	//log.Println("Calling Light2D.GetShadowBufferSize()")
@@ -251,7 +374,7 @@ func (o *Light2D) GetShadowBufferSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_shadow_buffer_size")
+	methodBind := methodBindLight2DGetShadowBufferSize.Get()
 
 	// Call the parent method.
 	// int
@@ -264,8 +387,8 @@ func (o *Light2D) GetShadowBufferSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Light2D) GetShadowColor() gdnative.Color {
 	//log.Println("Calling Light2D.GetShadowColor()")
@@ -274,7 +397,7 @@ func (o *Light2D) GetShadowColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_shadow_color")
+	methodBind := methodBindLight2DGetShadowColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -287,8 +410,8 @@ func (o *Light2D) GetShadowColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Light2D::ShadowFilter
+	        Undocumented
+		Args: [], Returns: enum.Light2D::ShadowFilter
 */
 func (o *Light2D) GetShadowFilter() Light2DShadowFilter {
 	//log.Println("Calling Light2D.GetShadowFilter()")
@@ -297,7 +420,7 @@ func (o *Light2D) GetShadowFilter() Light2DShadowFilter {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_shadow_filter")
+	methodBind := methodBindLight2DGetShadowFilter.Get()
 
 	// Call the parent method.
 	// enum.Light2D::ShadowFilter
@@ -310,8 +433,8 @@ func (o *Light2D) GetShadowFilter() Light2DShadowFilter {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Light2D) GetShadowGradientLength() gdnative.Real {
 	//log.Println("Calling Light2D.GetShadowGradientLength()")
@@ -320,7 +443,7 @@ func (o *Light2D) GetShadowGradientLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_shadow_gradient_length")
+	methodBind := methodBindLight2DGetShadowGradientLength.Get()
 
 	// Call the parent method.
 	// float
@@ -333,8 +456,8 @@ func (o *Light2D) GetShadowGradientLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Light2D) GetShadowSmooth() gdnative.Real {
 	//log.Println("Calling Light2D.GetShadowSmooth()")
@@ -343,7 +466,7 @@ func (o *Light2D) GetShadowSmooth() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_shadow_smooth")
+	methodBind := methodBindLight2DGetShadowSmooth.Get()
 
 	// Call the parent method.
 	// float
@@ -356,17 +479,17 @@ func (o *Light2D) GetShadowSmooth() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Light2D) GetTexture() TextureImplementer {
+func (o *Light2D) GetTexture() godot.TextureImplementer {
 	//log.Println("Calling Light2D.GetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_texture")
+	methodBind := methodBindLight2DGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -374,27 +497,25 @@ func (o *Light2D) GetTexture() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Light2D) GetTextureOffset() gdnative.Vector2 {
 	//log.Println("Calling Light2D.GetTextureOffset()")
@@ -403,7 +524,7 @@ func (o *Light2D) GetTextureOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_texture_offset")
+	methodBind := methodBindLight2DGetTextureOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -416,8 +537,8 @@ func (o *Light2D) GetTextureOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Light2D) GetTextureScale() gdnative.Real {
 	//log.Println("Calling Light2D.GetTextureScale()")
@@ -426,7 +547,7 @@ func (o *Light2D) GetTextureScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_texture_scale")
+	methodBind := methodBindLight2DGetTextureScale.Get()
 
 	// Call the parent method.
 	// float
@@ -439,8 +560,8 @@ func (o *Light2D) GetTextureScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Light2D) GetZRangeMax() gdnative.Int {
 	//log.Println("Calling Light2D.GetZRangeMax()")
@@ -449,7 +570,7 @@ func (o *Light2D) GetZRangeMax() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_z_range_max")
+	methodBind := methodBindLight2DGetZRangeMax.Get()
 
 	// Call the parent method.
 	// int
@@ -462,8 +583,8 @@ func (o *Light2D) GetZRangeMax() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Light2D) GetZRangeMin() gdnative.Int {
 	//log.Println("Calling Light2D.GetZRangeMin()")
@@ -472,7 +593,7 @@ func (o *Light2D) GetZRangeMin() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "get_z_range_min")
+	methodBind := methodBindLight2DGetZRangeMin.Get()
 
 	// Call the parent method.
 	// int
@@ -485,8 +606,8 @@ func (o *Light2D) GetZRangeMin() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Light2D) IsEditorOnly() gdnative.Bool {
 	//log.Println("Calling Light2D.IsEditorOnly()")
@@ -495,7 +616,7 @@ func (o *Light2D) IsEditorOnly() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "is_editor_only")
+	methodBind := methodBindLight2DIsEditorOnly.Get()
 
 	// Call the parent method.
 	// bool
@@ -508,8 +629,8 @@ func (o *Light2D) IsEditorOnly() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Light2D) IsEnabled() gdnative.Bool {
 	//log.Println("Calling Light2D.IsEnabled()")
@@ -518,7 +639,7 @@ func (o *Light2D) IsEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "is_enabled")
+	methodBind := methodBindLight2DIsEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -531,8 +652,8 @@ func (o *Light2D) IsEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Light2D) IsShadowEnabled() gdnative.Bool {
 	//log.Println("Calling Light2D.IsShadowEnabled()")
@@ -541,7 +662,7 @@ func (o *Light2D) IsShadowEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "is_shadow_enabled")
+	methodBind := methodBindLight2DIsShadowEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -554,8 +675,8 @@ func (o *Light2D) IsShadowEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Light2D) SetColor(color gdnative.Color) {
 	//log.Println("Calling Light2D.SetColor()")
@@ -565,7 +686,7 @@ func (o *Light2D) SetColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_color")
+	methodBind := methodBindLight2DSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -575,8 +696,8 @@ func (o *Light2D) SetColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false editor_only bool}], Returns: void
+	        Undocumented
+		Args: [{ false editor_only bool}], Returns: void
 */
 func (o *Light2D) SetEditorOnly(editorOnly gdnative.Bool) {
 	//log.Println("Calling Light2D.SetEditorOnly()")
@@ -586,7 +707,7 @@ func (o *Light2D) SetEditorOnly(editorOnly gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(editorOnly)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_editor_only")
+	methodBind := methodBindLight2DSetEditorOnly.Get()
 
 	// Call the parent method.
 	// void
@@ -596,8 +717,8 @@ func (o *Light2D) SetEditorOnly(editorOnly gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Light2D) SetEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Light2D.SetEnabled()")
@@ -607,7 +728,7 @@ func (o *Light2D) SetEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_enabled")
+	methodBind := methodBindLight2DSetEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -617,8 +738,8 @@ func (o *Light2D) SetEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *Light2D) SetEnergy(energy gdnative.Real) {
 	//log.Println("Calling Light2D.SetEnergy()")
@@ -628,7 +749,7 @@ func (o *Light2D) SetEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_energy")
+	methodBind := methodBindLight2DSetEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -638,8 +759,8 @@ func (o *Light2D) SetEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false height float}], Returns: void
+	        Undocumented
+		Args: [{ false height float}], Returns: void
 */
 func (o *Light2D) SetHeight(height gdnative.Real) {
 	//log.Println("Calling Light2D.SetHeight()")
@@ -649,7 +770,7 @@ func (o *Light2D) SetHeight(height gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_height")
+	methodBind := methodBindLight2DSetHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -659,8 +780,8 @@ func (o *Light2D) SetHeight(height gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false item_cull_mask int}], Returns: void
+	        Undocumented
+		Args: [{ false item_cull_mask int}], Returns: void
 */
 func (o *Light2D) SetItemCullMask(itemCullMask gdnative.Int) {
 	//log.Println("Calling Light2D.SetItemCullMask()")
@@ -670,7 +791,7 @@ func (o *Light2D) SetItemCullMask(itemCullMask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(itemCullMask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_item_cull_mask")
+	methodBind := methodBindLight2DSetItemCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -680,8 +801,8 @@ func (o *Light2D) SetItemCullMask(itemCullMask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false item_shadow_cull_mask int}], Returns: void
+	        Undocumented
+		Args: [{ false item_shadow_cull_mask int}], Returns: void
 */
 func (o *Light2D) SetItemShadowCullMask(itemShadowCullMask gdnative.Int) {
 	//log.Println("Calling Light2D.SetItemShadowCullMask()")
@@ -691,7 +812,7 @@ func (o *Light2D) SetItemShadowCullMask(itemShadowCullMask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(itemShadowCullMask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_item_shadow_cull_mask")
+	methodBind := methodBindLight2DSetItemShadowCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -701,8 +822,8 @@ func (o *Light2D) SetItemShadowCullMask(itemShadowCullMask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *Light2D) SetLayerRangeMax(layer gdnative.Int) {
 	//log.Println("Calling Light2D.SetLayerRangeMax()")
@@ -712,7 +833,7 @@ func (o *Light2D) SetLayerRangeMax(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_layer_range_max")
+	methodBind := methodBindLight2DSetLayerRangeMax.Get()
 
 	// Call the parent method.
 	// void
@@ -722,8 +843,8 @@ func (o *Light2D) SetLayerRangeMax(layer gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *Light2D) SetLayerRangeMin(layer gdnative.Int) {
 	//log.Println("Calling Light2D.SetLayerRangeMin()")
@@ -733,7 +854,7 @@ func (o *Light2D) SetLayerRangeMin(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_layer_range_min")
+	methodBind := methodBindLight2DSetLayerRangeMin.Get()
 
 	// Call the parent method.
 	// void
@@ -743,18 +864,18 @@ func (o *Light2D) SetLayerRangeMin(layer gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Light2D::Mode}], Returns: void
 */
-func (o *Light2D) SetMode(mode gdnative.Int) {
+func (o *Light2D) SetMode(mode Light2DMode) {
 	//log.Println("Calling Light2D.SetMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_mode")
+	methodBind := methodBindLight2DSetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -764,8 +885,8 @@ func (o *Light2D) SetMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size int}], Returns: void
 */
 func (o *Light2D) SetShadowBufferSize(size gdnative.Int) {
 	//log.Println("Calling Light2D.SetShadowBufferSize()")
@@ -775,7 +896,7 @@ func (o *Light2D) SetShadowBufferSize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_shadow_buffer_size")
+	methodBind := methodBindLight2DSetShadowBufferSize.Get()
 
 	// Call the parent method.
 	// void
@@ -785,8 +906,8 @@ func (o *Light2D) SetShadowBufferSize(size gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false shadow_color Color}], Returns: void
+	        Undocumented
+		Args: [{ false shadow_color Color}], Returns: void
 */
 func (o *Light2D) SetShadowColor(shadowColor gdnative.Color) {
 	//log.Println("Calling Light2D.SetShadowColor()")
@@ -796,7 +917,7 @@ func (o *Light2D) SetShadowColor(shadowColor gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(shadowColor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_shadow_color")
+	methodBind := methodBindLight2DSetShadowColor.Get()
 
 	// Call the parent method.
 	// void
@@ -806,8 +927,8 @@ func (o *Light2D) SetShadowColor(shadowColor gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Light2D) SetShadowEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Light2D.SetShadowEnabled()")
@@ -817,7 +938,7 @@ func (o *Light2D) SetShadowEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_shadow_enabled")
+	methodBind := methodBindLight2DSetShadowEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -827,18 +948,18 @@ func (o *Light2D) SetShadowEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false filter int}], Returns: void
+	        Undocumented
+		Args: [{ false filter enum.Light2D::ShadowFilter}], Returns: void
 */
-func (o *Light2D) SetShadowFilter(filter gdnative.Int) {
+func (o *Light2D) SetShadowFilter(filter Light2DShadowFilter) {
 	//log.Println("Calling Light2D.SetShadowFilter()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(filter)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(filter))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_shadow_filter")
+	methodBind := methodBindLight2DSetShadowFilter.Get()
 
 	// Call the parent method.
 	// void
@@ -848,8 +969,8 @@ func (o *Light2D) SetShadowFilter(filter gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false multiplier float}], Returns: void
+	        Undocumented
+		Args: [{ false multiplier float}], Returns: void
 */
 func (o *Light2D) SetShadowGradientLength(multiplier gdnative.Real) {
 	//log.Println("Calling Light2D.SetShadowGradientLength()")
@@ -859,7 +980,7 @@ func (o *Light2D) SetShadowGradientLength(multiplier gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(multiplier)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_shadow_gradient_length")
+	methodBind := methodBindLight2DSetShadowGradientLength.Get()
 
 	// Call the parent method.
 	// void
@@ -869,8 +990,8 @@ func (o *Light2D) SetShadowGradientLength(multiplier gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false smooth float}], Returns: void
+	        Undocumented
+		Args: [{ false smooth float}], Returns: void
 */
 func (o *Light2D) SetShadowSmooth(smooth gdnative.Real) {
 	//log.Println("Calling Light2D.SetShadowSmooth()")
@@ -880,7 +1001,7 @@ func (o *Light2D) SetShadowSmooth(smooth gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(smooth)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_shadow_smooth")
+	methodBind := methodBindLight2DSetShadowSmooth.Get()
 
 	// Call the parent method.
 	// void
@@ -890,18 +1011,18 @@ func (o *Light2D) SetShadowSmooth(smooth gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Light2D) SetTexture(texture TextureImplementer) {
+func (o *Light2D) SetTexture(texture godot.TextureImplementer) {
 	//log.Println("Calling Light2D.SetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_texture")
+	methodBind := methodBindLight2DSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -911,8 +1032,8 @@ func (o *Light2D) SetTexture(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture_offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false texture_offset Vector2}], Returns: void
 */
 func (o *Light2D) SetTextureOffset(textureOffset gdnative.Vector2) {
 	//log.Println("Calling Light2D.SetTextureOffset()")
@@ -922,7 +1043,7 @@ func (o *Light2D) SetTextureOffset(textureOffset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(textureOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_texture_offset")
+	methodBind := methodBindLight2DSetTextureOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -932,8 +1053,8 @@ func (o *Light2D) SetTextureOffset(textureOffset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture_scale float}], Returns: void
+	        Undocumented
+		Args: [{ false texture_scale float}], Returns: void
 */
 func (o *Light2D) SetTextureScale(textureScale gdnative.Real) {
 	//log.Println("Calling Light2D.SetTextureScale()")
@@ -943,7 +1064,7 @@ func (o *Light2D) SetTextureScale(textureScale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(textureScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_texture_scale")
+	methodBind := methodBindLight2DSetTextureScale.Get()
 
 	// Call the parent method.
 	// void
@@ -953,8 +1074,8 @@ func (o *Light2D) SetTextureScale(textureScale gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false z int}], Returns: void
+	        Undocumented
+		Args: [{ false z int}], Returns: void
 */
 func (o *Light2D) SetZRangeMax(z gdnative.Int) {
 	//log.Println("Calling Light2D.SetZRangeMax()")
@@ -964,7 +1085,7 @@ func (o *Light2D) SetZRangeMax(z gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(z)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_z_range_max")
+	methodBind := methodBindLight2DSetZRangeMax.Get()
 
 	// Call the parent method.
 	// void
@@ -974,8 +1095,8 @@ func (o *Light2D) SetZRangeMax(z gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false z int}], Returns: void
+	        Undocumented
+		Args: [{ false z int}], Returns: void
 */
 func (o *Light2D) SetZRangeMin(z gdnative.Int) {
 	//log.Println("Calling Light2D.SetZRangeMin()")
@@ -985,7 +1106,7 @@ func (o *Light2D) SetZRangeMin(z gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(z)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Light2D", "set_z_range_min")
+	methodBind := methodBindLight2DSetZRangeMin.Get()
 
 	// Call the parent method.
 	// void
@@ -994,10 +1115,160 @@ func (o *Light2D) SetZRangeMin(z gdnative.Int) {
 
 }
 
+// Color will return the value of the "color" property.
+func (o *Light2D) Color() gdnative.Color {
+	return o.GetColor()
+}
+
+// EditorOnly will return the value of the "editor_only" property.
+func (o *Light2D) EditorOnly() gdnative.Bool {
+	return o.IsEditorOnly()
+}
+
+// Enabled will return the value of the "enabled" property.
+func (o *Light2D) Enabled() gdnative.Bool {
+	return o.IsEnabled()
+}
+
+// Energy will return the value of the "energy" property.
+func (o *Light2D) Energy() gdnative.Real {
+	return o.GetEnergy()
+}
+
+// Mode will return the value of the "mode" property.
+func (o *Light2D) Mode() Light2DMode {
+	return o.GetMode()
+}
+
+// Offset will return the value of the "offset" property.
+func (o *Light2D) Offset() gdnative.Vector2 {
+	return o.GetTextureOffset()
+}
+
+// SetOffset will set the value of the "offset" property.
+func (o *Light2D) SetOffset(value gdnative.Vector2) {
+	o.SetTextureOffset(value)
+}
+
+// RangeHeight will return the value of the "range_height" property.
+func (o *Light2D) RangeHeight() gdnative.Real {
+	return o.GetHeight()
+}
+
+// SetRangeHeight will set the value of the "range_height" property.
+func (o *Light2D) SetRangeHeight(value gdnative.Real) {
+	o.SetHeight(value)
+}
+
+// RangeItemCullMask will return the value of the "range_item_cull_mask" property.
+func (o *Light2D) RangeItemCullMask() gdnative.Int {
+	return o.GetItemCullMask()
+}
+
+// SetRangeItemCullMask will set the value of the "range_item_cull_mask" property.
+func (o *Light2D) SetRangeItemCullMask(value gdnative.Int) {
+	o.SetItemCullMask(value)
+}
+
+// RangeLayerMax will return the value of the "range_layer_max" property.
+func (o *Light2D) RangeLayerMax() gdnative.Int {
+	return o.GetLayerRangeMax()
+}
+
+// SetRangeLayerMax will set the value of the "range_layer_max" property.
+func (o *Light2D) SetRangeLayerMax(value gdnative.Int) {
+	o.SetLayerRangeMax(value)
+}
+
+// RangeLayerMin will return the value of the "range_layer_min" property.
+func (o *Light2D) RangeLayerMin() gdnative.Int {
+	return o.GetLayerRangeMin()
+}
+
+// SetRangeLayerMin will set the value of the "range_layer_min" property.
+func (o *Light2D) SetRangeLayerMin(value gdnative.Int) {
+	o.SetLayerRangeMin(value)
+}
+
+// RangeZMax will return the value of the "range_z_max" property.
+func (o *Light2D) RangeZMax() gdnative.Int {
+	return o.GetZRangeMax()
+}
+
+// SetRangeZMax will set the value of the "range_z_max" property.
+func (o *Light2D) SetRangeZMax(value gdnative.Int) {
+	o.SetZRangeMax(value)
+}
+
+// RangeZMin will return the value of the "range_z_min" property.
+func (o *Light2D) RangeZMin() gdnative.Int {
+	return o.GetZRangeMin()
+}
+
+// SetRangeZMin will set the value of the "range_z_min" property.
+func (o *Light2D) SetRangeZMin(value gdnative.Int) {
+	o.SetZRangeMin(value)
+}
+
+// ShadowBufferSize will return the value of the "shadow_buffer_size" property.
+func (o *Light2D) ShadowBufferSize() gdnative.Int {
+	return o.GetShadowBufferSize()
+}
+
+// ShadowColor will return the value of the "shadow_color" property.
+func (o *Light2D) ShadowColor() gdnative.Color {
+	return o.GetShadowColor()
+}
+
+// ShadowEnabled will return the value of the "shadow_enabled" property.
+func (o *Light2D) ShadowEnabled() gdnative.Bool {
+	return o.IsShadowEnabled()
+}
+
+// ShadowFilter will return the value of the "shadow_filter" property.
+func (o *Light2D) ShadowFilter() Light2DShadowFilter {
+	return o.GetShadowFilter()
+}
+
+// ShadowFilterSmooth will return the value of the "shadow_filter_smooth" property.
+func (o *Light2D) ShadowFilterSmooth() gdnative.Real {
+	return o.GetShadowSmooth()
+}
+
+// SetShadowFilterSmooth will set the value of the "shadow_filter_smooth" property.
+func (o *Light2D) SetShadowFilterSmooth(value gdnative.Real) {
+	o.SetShadowSmooth(value)
+}
+
+// ShadowGradientLength will return the value of the "shadow_gradient_length" property.
+func (o *Light2D) ShadowGradientLength() gdnative.Real {
+	return o.GetShadowGradientLength()
+}
+
+// ShadowItemCullMask will return the value of the "shadow_item_cull_mask" property.
+func (o *Light2D) ShadowItemCullMask() gdnative.Int {
+	return o.GetItemShadowCullMask()
+}
+
+// SetShadowItemCullMask will set the value of the "shadow_item_cull_mask" property.
+func (o *Light2D) SetShadowItemCullMask(value gdnative.Int) {
+	o.SetItemShadowCullMask(value)
+}
+
+// Texture will return the value of the "texture" property.
+func (o *Light2D) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// TextureScale will return the value of the "texture_scale" property.
+func (o *Light2D) TextureScale() gdnative.Real {
+	return o.GetTextureScale()
+}
+
 // Light2DImplementer is an interface that implements the methods
 // of the Light2D class.
 type Light2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetColor() gdnative.Color
 	GetEnergy() gdnative.Real
 	GetHeight() gdnative.Real
@@ -1005,11 +1276,13 @@ type Light2DImplementer interface {
 	GetItemShadowCullMask() gdnative.Int
 	GetLayerRangeMax() gdnative.Int
 	GetLayerRangeMin() gdnative.Int
+	GetMode() Light2DMode
 	GetShadowBufferSize() gdnative.Int
 	GetShadowColor() gdnative.Color
+	GetShadowFilter() Light2DShadowFilter
 	GetShadowGradientLength() gdnative.Real
 	GetShadowSmooth() gdnative.Real
-	GetTexture() TextureImplementer
+	GetTexture() godot.TextureImplementer
 	GetTextureOffset() gdnative.Vector2
 	GetTextureScale() gdnative.Real
 	GetZRangeMax() gdnative.Int
@@ -1026,16 +1299,46 @@ type Light2DImplementer interface {
 	SetItemShadowCullMask(itemShadowCullMask gdnative.Int)
 	SetLayerRangeMax(layer gdnative.Int)
 	SetLayerRangeMin(layer gdnative.Int)
-	SetMode(mode gdnative.Int)
+	SetMode(mode Light2DMode)
 	SetShadowBufferSize(size gdnative.Int)
 	SetShadowColor(shadowColor gdnative.Color)
 	SetShadowEnabled(enabled gdnative.Bool)
-	SetShadowFilter(filter gdnative.Int)
+	SetShadowFilter(filter Light2DShadowFilter)
 	SetShadowGradientLength(multiplier gdnative.Real)
 	SetShadowSmooth(smooth gdnative.Real)
-	SetTexture(texture TextureImplementer)
+	SetTexture(texture godot.TextureImplementer)
 	SetTextureOffset(textureOffset gdnative.Vector2)
 	SetTextureScale(textureScale gdnative.Real)
 	SetZRangeMax(z gdnative.Int)
 	SetZRangeMin(z gdnative.Int)
+	Color() gdnative.Color
+	EditorOnly() gdnative.Bool
+	Enabled() gdnative.Bool
+	Energy() gdnative.Real
+	Mode() Light2DMode
+	Offset() gdnative.Vector2
+	SetOffset(value gdnative.Vector2)
+	RangeHeight() gdnative.Real
+	SetRangeHeight(value gdnative.Real)
+	RangeItemCullMask() gdnative.Int
+	SetRangeItemCullMask(value gdnative.Int)
+	RangeLayerMax() gdnative.Int
+	SetRangeLayerMax(value gdnative.Int)
+	RangeLayerMin() gdnative.Int
+	SetRangeLayerMin(value gdnative.Int)
+	RangeZMax() gdnative.Int
+	SetRangeZMax(value gdnative.Int)
+	RangeZMin() gdnative.Int
+	SetRangeZMin(value gdnative.Int)
+	ShadowBufferSize() gdnative.Int
+	ShadowColor() gdnative.Color
+	ShadowEnabled() gdnative.Bool
+	ShadowFilter() Light2DShadowFilter
+	ShadowFilterSmooth() gdnative.Real
+	SetShadowFilterSmooth(value gdnative.Real)
+	ShadowGradientLength() gdnative.Real
+	ShadowItemCullMask() gdnative.Int
+	SetShadowItemCullMask(value gdnative.Int)
+	Texture() godot.TextureImplementer
+	TextureScale() gdnative.Real
 }
@@ -0,0 +1,195 @@
+package canvasitem
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Occludes light cast by a Light2D, casting shadows. The LightOccluder2D must be provided with an [OccluderPolygon2D] in order for the shadow to be computed.
+*/
+type LightOccluder2D struct {
+	godot.Node2D
+	owner gdnative.Object
+}
+
+// classConstructorLightOccluder2D is the class constructor of the LightOccluder2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLightOccluder2D = gdnative.NewLazyClassConstructor("LightOccluder2D")
+
+// NewLightOccluder2D will create a new instance of the LightOccluder2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewLightOccluder2D() *LightOccluder2D {
+	obj := &LightOccluder2D{}
+	obj.SetBaseObject(classConstructorLightOccluder2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *LightOccluder2D) BaseClass() string {
+	return "LightOccluder2D"
+}
+
+// Method binds of the LightOccluder2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLightOccluder2DX_PolyChanged        = gdnative.NewLazyMethodBind("LightOccluder2D", "_poly_changed")
+	methodBindLightOccluder2DGetOccluderLightMask = gdnative.NewLazyMethodBind("LightOccluder2D", "get_occluder_light_mask")
+	methodBindLightOccluder2DGetOccluderPolygon   = gdnative.NewLazyMethodBind("LightOccluder2D", "get_occluder_polygon")
+	methodBindLightOccluder2DSetOccluderLightMask = gdnative.NewLazyMethodBind("LightOccluder2D", "set_occluder_light_mask")
+	methodBindLightOccluder2DSetOccluderPolygon   = gdnative.NewLazyMethodBind("LightOccluder2D", "set_occluder_polygon")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *LightOccluder2D) X_PolyChanged() {
+	//log.Println("Calling LightOccluder2D.X_PolyChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightOccluder2DX_PolyChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *LightOccluder2D) GetOccluderLightMask() gdnative.Int {
+	//log.Println("Calling LightOccluder2D.GetOccluderLightMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightOccluder2DGetOccluderLightMask.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: OccluderPolygon2D
+*/
+func (o *LightOccluder2D) GetOccluderPolygon() resource.OccluderPolygon2DImplementer {
+	//log.Println("Calling LightOccluder2D.GetOccluderPolygon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightOccluder2DGetOccluderPolygon.Get()
+
+	// Call the parent method.
+	// OccluderPolygon2D
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := resource.OccluderPolygon2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.OccluderPolygon2DImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
+*/
+func (o *LightOccluder2D) SetOccluderLightMask(mask gdnative.Int) {
+	//log.Println("Calling LightOccluder2D.SetOccluderLightMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
+
+	// Get the method bind
+	methodBind := methodBindLightOccluder2DSetOccluderLightMask.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false polygon OccluderPolygon2D}], Returns: void
+*/
+func (o *LightOccluder2D) SetOccluderPolygon(polygon resource.OccluderPolygon2DImplementer) {
+	//log.Println("Calling LightOccluder2D.SetOccluderPolygon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(polygon))
+
+	// Get the method bind
+	methodBind := methodBindLightOccluder2DSetOccluderPolygon.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Occluder will return the value of the "occluder" property.
+func (o *LightOccluder2D) Occluder() resource.OccluderPolygon2DImplementer {
+	return o.GetOccluderPolygon()
+}
+
+// SetOccluder will set the value of the "occluder" property.
+func (o *LightOccluder2D) SetOccluder(value resource.OccluderPolygon2DImplementer) {
+	o.SetOccluderPolygon(value)
+}
+
+// LightOccluder2DImplementer is an interface that implements the methods
+// of the LightOccluder2D class.
+type LightOccluder2DImplementer interface {
+	godot.Node2DImplementer
+	X_PolyChanged()
+	GetOccluderLightMask() gdnative.Int
+	GetOccluderPolygon() resource.OccluderPolygon2DImplementer
+	SetOccluderLightMask(mask gdnative.Int)
+	SetOccluderPolygon(polygon resource.OccluderPolygon2DImplementer)
+	Occluder() resource.OccluderPolygon2DImplementer
+	SetOccluder(value resource.OccluderPolygon2DImplementer)
+}
@@ -1,7 +1,11 @@
-package godot
+package canvasitem
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +26,32 @@ const (
 	Line2DLineCapRound Line2DLineCapMode = 2
 )
 
+// String will return the Godot name of the Line2DLineCapMode value.
+func (e Line2DLineCapMode) String() string {
+	switch e {
+	case Line2DLineCapBox:
+		return "LINE_CAP_BOX"
+	case Line2DLineCapNone:
+		return "LINE_CAP_NONE"
+	case Line2DLineCapRound:
+		return "LINE_CAP_ROUND"
+	}
+	return fmt.Sprintf("Line2DLineCapMode(%d)", int(e))
+}
+
+// ParseLine2DLineCapMode will return the Line2DLineCapMode value with the given Godot name.
+func ParseLine2DLineCapMode(name string) (Line2DLineCapMode, error) {
+	switch name {
+	case "LINE_CAP_BOX":
+		return Line2DLineCapBox, nil
+	case "LINE_CAP_NONE":
+		return Line2DLineCapNone, nil
+	case "LINE_CAP_ROUND":
+		return Line2DLineCapRound, nil
+	}
+	return 0, fmt.Errorf("invalid Line2DLineCapMode value %q", name)
+}
+
 // Line2DLineJointMode is an enum for LineJointMode values.
 type Line2DLineJointMode int
 
@@ -31,6 +61,32 @@ const (
 	Line2DLineJointSharp Line2DLineJointMode = 0
 )
 
+// String will return the Godot name of the Line2DLineJointMode value.
+func (e Line2DLineJointMode) String() string {
+	switch e {
+	case Line2DLineJointBevel:
+		return "LINE_JOINT_BEVEL"
+	case Line2DLineJointRound:
+		return "LINE_JOINT_ROUND"
+	case Line2DLineJointSharp:
+		return "LINE_JOINT_SHARP"
+	}
+	return fmt.Sprintf("Line2DLineJointMode(%d)", int(e))
+}
+
+// ParseLine2DLineJointMode will return the Line2DLineJointMode value with the given Godot name.
+func ParseLine2DLineJointMode(name string) (Line2DLineJointMode, error) {
+	switch name {
+	case "LINE_JOINT_BEVEL":
+		return Line2DLineJointBevel, nil
+	case "LINE_JOINT_ROUND":
+		return Line2DLineJointRound, nil
+	case "LINE_JOINT_SHARP":
+		return Line2DLineJointSharp, nil
+	}
+	return 0, fmt.Errorf("invalid Line2DLineJointMode value %q", name)
+}
+
 // Line2DLineTextureMode is an enum for LineTextureMode values.
 type Line2DLineTextureMode int
 
@@ -39,30 +95,90 @@ const (
 	Line2DLineTextureTile Line2DLineTextureMode = 1
 )
 
-//func NewLine2DFromPointer(ptr gdnative.Pointer) Line2D {
-func newLine2DFromPointer(ptr gdnative.Pointer) Line2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Line2D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the Line2DLineTextureMode value.
+func (e Line2DLineTextureMode) String() string {
+	switch e {
+	case Line2DLineTextureNone:
+		return "LINE_TEXTURE_NONE"
+	case Line2DLineTextureTile:
+		return "LINE_TEXTURE_TILE"
+	}
+	return fmt.Sprintf("Line2DLineTextureMode(%d)", int(e))
+}
 
-	return obj
+// ParseLine2DLineTextureMode will return the Line2DLineTextureMode value with the given Godot name.
+func ParseLine2DLineTextureMode(name string) (Line2DLineTextureMode, error) {
+	switch name {
+	case "LINE_TEXTURE_NONE":
+		return Line2DLineTextureNone, nil
+	case "LINE_TEXTURE_TILE":
+		return Line2DLineTextureTile, nil
+	}
+	return 0, fmt.Errorf("invalid Line2DLineTextureMode value %q", name)
 }
 
 /*
 A line through several points in 2D space.
 */
 type Line2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorLine2D is the class constructor of the Line2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLine2D = gdnative.NewLazyClassConstructor("Line2D")
+
+// NewLine2D will create a new instance of the Line2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewLine2D() *Line2D {
+	obj := &Line2D{}
+	obj.SetBaseObject(classConstructorLine2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Line2D) BaseClass() string {
 	return "Line2D"
 }
 
+// Method binds of the Line2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLine2DX_GradientChanged = gdnative.NewLazyMethodBind("Line2D", "_gradient_changed")
+	methodBindLine2DAddPoint          = gdnative.NewLazyMethodBind("Line2D", "add_point")
+	methodBindLine2DGetBeginCapMode   = gdnative.NewLazyMethodBind("Line2D", "get_begin_cap_mode")
+	methodBindLine2DGetDefaultColor   = gdnative.NewLazyMethodBind("Line2D", "get_default_color")
+	methodBindLine2DGetEndCapMode     = gdnative.NewLazyMethodBind("Line2D", "get_end_cap_mode")
+	methodBindLine2DGetGradient       = gdnative.NewLazyMethodBind("Line2D", "get_gradient")
+	methodBindLine2DGetJointMode      = gdnative.NewLazyMethodBind("Line2D", "get_joint_mode")
+	methodBindLine2DGetPointCount     = gdnative.NewLazyMethodBind("Line2D", "get_point_count")
+	methodBindLine2DGetPointPosition  = gdnative.NewLazyMethodBind("Line2D", "get_point_position")
+	methodBindLine2DGetPoints         = gdnative.NewLazyMethodBind("Line2D", "get_points")
+	methodBindLine2DGetRoundPrecision = gdnative.NewLazyMethodBind("Line2D", "get_round_precision")
+	methodBindLine2DGetSharpLimit     = gdnative.NewLazyMethodBind("Line2D", "get_sharp_limit")
+	methodBindLine2DGetTexture        = gdnative.NewLazyMethodBind("Line2D", "get_texture")
+	methodBindLine2DGetTextureMode    = gdnative.NewLazyMethodBind("Line2D", "get_texture_mode")
+	methodBindLine2DGetWidth          = gdnative.NewLazyMethodBind("Line2D", "get_width")
+	methodBindLine2DRemovePoint       = gdnative.NewLazyMethodBind("Line2D", "remove_point")
+	methodBindLine2DSetBeginCapMode   = gdnative.NewLazyMethodBind("Line2D", "set_begin_cap_mode")
+	methodBindLine2DSetDefaultColor   = gdnative.NewLazyMethodBind("Line2D", "set_default_color")
+	methodBindLine2DSetEndCapMode     = gdnative.NewLazyMethodBind("Line2D", "set_end_cap_mode")
+	methodBindLine2DSetGradient       = gdnative.NewLazyMethodBind("Line2D", "set_gradient")
+	methodBindLine2DSetJointMode      = gdnative.NewLazyMethodBind("Line2D", "set_joint_mode")
+	methodBindLine2DSetPointPosition  = gdnative.NewLazyMethodBind("Line2D", "set_point_position")
+	methodBindLine2DSetPoints         = gdnative.NewLazyMethodBind("Line2D", "set_points")
+	methodBindLine2DSetRoundPrecision = gdnative.NewLazyMethodBind("Line2D", "set_round_precision")
+	methodBindLine2DSetSharpLimit     = gdnative.NewLazyMethodBind("Line2D", "set_sharp_limit")
+	methodBindLine2DSetTexture        = gdnative.NewLazyMethodBind("Line2D", "set_texture")
+	methodBindLine2DSetTextureMode    = gdnative.NewLazyMethodBind("Line2D", "set_texture_mode")
+	methodBindLine2DSetWidth          = gdnative.NewLazyMethodBind("Line2D", "set_width")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Line2D) X_GradientChanged() {
 	//log.Println("Calling Line2D.X_GradientChanged()")
@@ -71,7 +187,7 @@ func (o *Line2D) X_GradientChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "_gradient_changed")
+	methodBind := methodBindLine2DX_GradientChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -81,8 +197,8 @@ func (o *Line2D) X_GradientChanged() {
 }
 
 /*
-        Add a point at the [code]position[/code]. Appends the point at the end of the line.
-	Args: [{ false position Vector2}], Returns: void
+	        Add a point at the [code]position[/code]. Appends the point at the end of the line.
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *Line2D) AddPoint(position gdnative.Vector2) {
 	//log.Println("Calling Line2D.AddPoint()")
@@ -92,7 +208,7 @@ func (o *Line2D) AddPoint(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "add_point")
+	methodBind := methodBindLine2DAddPoint.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +218,8 @@ func (o *Line2D) AddPoint(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Line2D::LineCapMode
+	        Undocumented
+		Args: [], Returns: enum.Line2D::LineCapMode
 */
 func (o *Line2D) GetBeginCapMode() Line2DLineCapMode {
 	//log.Println("Calling Line2D.GetBeginCapMode()")
@@ -112,7 +228,7 @@ func (o *Line2D) GetBeginCapMode() Line2DLineCapMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_begin_cap_mode")
+	methodBind := methodBindLine2DGetBeginCapMode.Get()
 
 	// Call the parent method.
 	// enum.Line2D::LineCapMode
@@ -125,8 +241,8 @@ func (o *Line2D) GetBeginCapMode() Line2DLineCapMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Line2D) GetDefaultColor() gdnative.Color {
 	//log.Println("Calling Line2D.GetDefaultColor()")
@@ -135,7 +251,7 @@ func (o *Line2D) GetDefaultColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_default_color")
+	methodBind := methodBindLine2DGetDefaultColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -148,8 +264,8 @@ func (o *Line2D) GetDefaultColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Line2D::LineCapMode
+	        Undocumented
+		Args: [], Returns: enum.Line2D::LineCapMode
 */
 func (o *Line2D) GetEndCapMode() Line2DLineCapMode {
 	//log.Println("Calling Line2D.GetEndCapMode()")
@@ -158,7 +274,7 @@ func (o *Line2D) GetEndCapMode() Line2DLineCapMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_end_cap_mode")
+	methodBind := methodBindLine2DGetEndCapMode.Get()
 
 	// Call the parent method.
 	// enum.Line2D::LineCapMode
@@ -171,17 +287,17 @@ func (o *Line2D) GetEndCapMode() Line2DLineCapMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Gradient
+	        Undocumented
+		Args: [], Returns: Gradient
 */
-func (o *Line2D) GetGradient() GradientImplementer {
+func (o *Line2D) GetGradient() resource.GradientImplementer {
 	//log.Println("Calling Line2D.GetGradient()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_gradient")
+	methodBind := methodBindLine2DGetGradient.Get()
 
 	// Call the parent method.
 	// Gradient
@@ -189,27 +305,25 @@ func (o *Line2D) GetGradient() GradientImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newGradientFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(GradientImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Gradient" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(GradientImplementer)
+	ret := resource.Gradient{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.GradientImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Line2D::LineJointMode
+	        Undocumented
+		Args: [], Returns: enum.Line2D::LineJointMode
 */
 func (o *Line2D) GetJointMode() Line2DLineJointMode {
 	//log.Println("Calling Line2D.GetJointMode()")
@@ -218,7 +332,7 @@ func (o *Line2D) GetJointMode() Line2DLineJointMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_joint_mode")
+	methodBind := methodBindLine2DGetJointMode.Get()
 
 	// Call the parent method.
 	// enum.Line2D::LineJointMode
@@ -231,8 +345,8 @@ func (o *Line2D) GetJointMode() Line2DLineJointMode {
 }
 
 /*
-        Returns the Line2D's amount of points.
-	Args: [], Returns: int
+	        Returns the Line2D's amount of points.
+		Args: [], Returns: int
 */
 func (o *Line2D) GetPointCount() gdnative.Int {
 	//log.Println("Calling Line2D.GetPointCount()")
@@ -241,7 +355,7 @@ func (o *Line2D) GetPointCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_point_count")
+	methodBind := methodBindLine2DGetPointCount.Get()
 
 	// Call the parent method.
 	// int
@@ -254,8 +368,8 @@ func (o *Line2D) GetPointCount() gdnative.Int {
 }
 
 /*
-        Returns point [code]i[/code]'s position.
-	Args: [{ false i int}], Returns: Vector2
+	        Returns point [code]i[/code]'s position.
+		Args: [{ false i int}], Returns: Vector2
 */
 func (o *Line2D) GetPointPosition(i gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Line2D.GetPointPosition()")
@@ -265,7 +379,7 @@ func (o *Line2D) GetPointPosition(i gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(i)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_point_position")
+	methodBind := methodBindLine2DGetPointPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -278,8 +392,8 @@ func (o *Line2D) GetPointPosition(i gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *Line2D) GetPoints() gdnative.PoolVector2Array {
 	//log.Println("Calling Line2D.GetPoints()")
@@ -288,7 +402,7 @@ func (o *Line2D) GetPoints() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_points")
+	methodBind := methodBindLine2DGetPoints.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -301,8 +415,8 @@ func (o *Line2D) GetPoints() gdnative.PoolVector2Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Line2D) GetRoundPrecision() gdnative.Int {
 	//log.Println("Calling Line2D.GetRoundPrecision()")
@@ -311,7 +425,7 @@ func (o *Line2D) GetRoundPrecision() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_round_precision")
+	methodBind := methodBindLine2DGetRoundPrecision.Get()
 
 	// Call the parent method.
 	// int
@@ -324,8 +438,8 @@ func (o *Line2D) GetRoundPrecision() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Line2D) GetSharpLimit() gdnative.Real {
 	//log.Println("Calling Line2D.GetSharpLimit()")
@@ -334,7 +448,7 @@ func (o *Line2D) GetSharpLimit() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_sharp_limit")
+	methodBind := methodBindLine2DGetSharpLimit.Get()
 
 	// Call the parent method.
 	// float
@@ -347,17 +461,17 @@ func (o *Line2D) GetSharpLimit() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Line2D) GetTexture() TextureImplementer {
+func (o *Line2D) GetTexture() godot.TextureImplementer {
 	//log.Println("Calling Line2D.GetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_texture")
+	methodBind := methodBindLine2DGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -365,27 +479,25 @@ func (o *Line2D) GetTexture() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Line2D::LineTextureMode
+	        Undocumented
+		Args: [], Returns: enum.Line2D::LineTextureMode
 */
 func (o *Line2D) GetTextureMode() Line2DLineTextureMode {
 	//log.Println("Calling Line2D.GetTextureMode()")
@@ -394,7 +506,7 @@ func (o *Line2D) GetTextureMode() Line2DLineTextureMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_texture_mode")
+	methodBind := methodBindLine2DGetTextureMode.Get()
 
 	// Call the parent method.
 	// enum.Line2D::LineTextureMode
@@ -407,8 +519,8 @@ func (o *Line2D) GetTextureMode() Line2DLineTextureMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Line2D) GetWidth() gdnative.Real {
 	//log.Println("Calling Line2D.GetWidth()")
@@ -417,7 +529,7 @@ func (o *Line2D) GetWidth() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "get_width")
+	methodBind := methodBindLine2DGetWidth.Get()
 
 	// Call the parent method.
 	// float
@@ -430,8 +542,8 @@ func (o *Line2D) GetWidth() gdnative.Real {
 }
 
 /*
-        Remove the point at index [code]i[/code] from the line.
-	Args: [{ false i int}], Returns: void
+	        Remove the point at index [code]i[/code] from the line.
+		Args: [{ false i int}], Returns: void
 */
 func (o *Line2D) RemovePoint(i gdnative.Int) {
 	//log.Println("Calling Line2D.RemovePoint()")
@@ -441,7 +553,7 @@ func (o *Line2D) RemovePoint(i gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(i)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "remove_point")
+	methodBind := methodBindLine2DRemovePoint.Get()
 
 	// Call the parent method.
 	// void
@@ -451,18 +563,18 @@ func (o *Line2D) RemovePoint(i gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Line2D::LineCapMode}], Returns: void
 */
-func (o *Line2D) SetBeginCapMode(mode gdnative.Int) {
+func (o *Line2D) SetBeginCapMode(mode Line2DLineCapMode) {
 	//log.Println("Calling Line2D.SetBeginCapMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_begin_cap_mode")
+	methodBind := methodBindLine2DSetBeginCapMode.Get()
 
 	// Call the parent method.
 	// void
@@ -472,8 +584,8 @@ func (o *Line2D) SetBeginCapMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Line2D) SetDefaultColor(color gdnative.Color) {
 	//log.Println("Calling Line2D.SetDefaultColor()")
@@ -483,7 +595,7 @@ func (o *Line2D) SetDefaultColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_default_color")
+	methodBind := methodBindLine2DSetDefaultColor.Get()
 
 	// Call the parent method.
 	// void
@@ -493,18 +605,18 @@ func (o *Line2D) SetDefaultColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Line2D::LineCapMode}], Returns: void
 */
-func (o *Line2D) SetEndCapMode(mode gdnative.Int) {
+func (o *Line2D) SetEndCapMode(mode Line2DLineCapMode) {
 	//log.Println("Calling Line2D.SetEndCapMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_end_cap_mode")
+	methodBind := methodBindLine2DSetEndCapMode.Get()
 
 	// Call the parent method.
 	// void
@@ -514,18 +626,18 @@ func (o *Line2D) SetEndCapMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Gradient}], Returns: void
+	        Undocumented
+		Args: [{ false color Gradient}], Returns: void
 */
-func (o *Line2D) SetGradient(color GradientImplementer) {
+func (o *Line2D) SetGradient(color resource.GradientImplementer) {
 	//log.Println("Calling Line2D.SetGradient()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(color.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(color))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_gradient")
+	methodBind := methodBindLine2DSetGradient.Get()
 
 	// Call the parent method.
 	// void
@@ -535,18 +647,18 @@ func (o *Line2D) SetGradient(color GradientImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Line2D::LineJointMode}], Returns: void
 */
-func (o *Line2D) SetJointMode(mode gdnative.Int) {
+func (o *Line2D) SetJointMode(mode Line2DLineJointMode) {
 	//log.Println("Calling Line2D.SetJointMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_joint_mode")
+	methodBind := methodBindLine2DSetJointMode.Get()
 
 	// Call the parent method.
 	// void
@@ -556,8 +668,8 @@ func (o *Line2D) SetJointMode(mode gdnative.Int) {
 }
 
 /*
-        Overwrites the position in point [code]i[/code] with the supplied [code]position[/code].
-	Args: [{ false i int} { false position Vector2}], Returns: void
+	        Overwrites the position in point [code]i[/code] with the supplied [code]position[/code].
+		Args: [{ false i int} { false position Vector2}], Returns: void
 */
 func (o *Line2D) SetPointPosition(i gdnative.Int, position gdnative.Vector2) {
 	//log.Println("Calling Line2D.SetPointPosition()")
@@ -568,7 +680,7 @@ func (o *Line2D) SetPointPosition(i gdnative.Int, position gdnative.Vector2) {
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_point_position")
+	methodBind := methodBindLine2DSetPointPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -578,8 +690,8 @@ func (o *Line2D) SetPointPosition(i gdnative.Int, position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false points PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false points PoolVector2Array}], Returns: void
 */
 func (o *Line2D) SetPoints(points gdnative.PoolVector2Array) {
 	//log.Println("Calling Line2D.SetPoints()")
@@ -589,7 +701,7 @@ func (o *Line2D) SetPoints(points gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(points)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_points")
+	methodBind := methodBindLine2DSetPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -599,8 +711,8 @@ func (o *Line2D) SetPoints(points gdnative.PoolVector2Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false precision int}], Returns: void
+	        Undocumented
+		Args: [{ false precision int}], Returns: void
 */
 func (o *Line2D) SetRoundPrecision(precision gdnative.Int) {
 	//log.Println("Calling Line2D.SetRoundPrecision()")
@@ -610,7 +722,7 @@ func (o *Line2D) SetRoundPrecision(precision gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(precision)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_round_precision")
+	methodBind := methodBindLine2DSetRoundPrecision.Get()
 
 	// Call the parent method.
 	// void
@@ -620,8 +732,8 @@ func (o *Line2D) SetRoundPrecision(precision gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false limit float}], Returns: void
+	        Undocumented
+		Args: [{ false limit float}], Returns: void
 */
 func (o *Line2D) SetSharpLimit(limit gdnative.Real) {
 	//log.Println("Calling Line2D.SetSharpLimit()")
@@ -631,7 +743,7 @@ func (o *Line2D) SetSharpLimit(limit gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(limit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_sharp_limit")
+	methodBind := methodBindLine2DSetSharpLimit.Get()
 
 	// Call the parent method.
 	// void
@@ -641,18 +753,18 @@ func (o *Line2D) SetSharpLimit(limit gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Line2D) SetTexture(texture TextureImplementer) {
+func (o *Line2D) SetTexture(texture godot.TextureImplementer) {
 	//log.Println("Calling Line2D.SetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_texture")
+	methodBind := methodBindLine2DSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -662,18 +774,18 @@ func (o *Line2D) SetTexture(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Line2D::LineTextureMode}], Returns: void
 */
-func (o *Line2D) SetTextureMode(mode gdnative.Int) {
+func (o *Line2D) SetTextureMode(mode Line2DLineTextureMode) {
 	//log.Println("Calling Line2D.SetTextureMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_texture_mode")
+	methodBind := methodBindLine2DSetTextureMode.Get()
 
 	// Call the parent method.
 	// void
@@ -683,8 +795,8 @@ func (o *Line2D) SetTextureMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false width float}], Returns: void
+	        Undocumented
+		Args: [{ false width float}], Returns: void
 */
 func (o *Line2D) SetWidth(width gdnative.Real) {
 	//log.Println("Calling Line2D.SetWidth()")
@@ -694,7 +806,7 @@ func (o *Line2D) SetWidth(width gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Line2D", "set_width")
+	methodBind := methodBindLine2DSetWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -703,32 +815,102 @@ func (o *Line2D) SetWidth(width gdnative.Real) {
 
 }
 
+// BeginCapMode will return the value of the "begin_cap_mode" property.
+func (o *Line2D) BeginCapMode() Line2DLineCapMode {
+	return o.GetBeginCapMode()
+}
+
+// DefaultColor will return the value of the "default_color" property.
+func (o *Line2D) DefaultColor() gdnative.Color {
+	return o.GetDefaultColor()
+}
+
+// EndCapMode will return the value of the "end_cap_mode" property.
+func (o *Line2D) EndCapMode() Line2DLineCapMode {
+	return o.GetEndCapMode()
+}
+
+// Gradient will return the value of the "gradient" property.
+func (o *Line2D) Gradient() resource.GradientImplementer {
+	return o.GetGradient()
+}
+
+// JointMode will return the value of the "joint_mode" property.
+func (o *Line2D) JointMode() Line2DLineJointMode {
+	return o.GetJointMode()
+}
+
+// Points will return the value of the "points" property.
+func (o *Line2D) Points() gdnative.PoolVector2Array {
+	return o.GetPoints()
+}
+
+// RoundPrecision will return the value of the "round_precision" property.
+func (o *Line2D) RoundPrecision() gdnative.Int {
+	return o.GetRoundPrecision()
+}
+
+// SharpLimit will return the value of the "sharp_limit" property.
+func (o *Line2D) SharpLimit() gdnative.Real {
+	return o.GetSharpLimit()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *Line2D) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// TextureMode will return the value of the "texture_mode" property.
+func (o *Line2D) TextureMode() Line2DLineTextureMode {
+	return o.GetTextureMode()
+}
+
+// Width will return the value of the "width" property.
+func (o *Line2D) Width() gdnative.Real {
+	return o.GetWidth()
+}
+
 // Line2DImplementer is an interface that implements the methods
 // of the Line2D class.
 type Line2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	X_GradientChanged()
 	AddPoint(position gdnative.Vector2)
+	GetBeginCapMode() Line2DLineCapMode
 	GetDefaultColor() gdnative.Color
-	GetGradient() GradientImplementer
+	GetEndCapMode() Line2DLineCapMode
+	GetGradient() resource.GradientImplementer
+	GetJointMode() Line2DLineJointMode
 	GetPointCount() gdnative.Int
 	GetPointPosition(i gdnative.Int) gdnative.Vector2
 	GetPoints() gdnative.PoolVector2Array
 	GetRoundPrecision() gdnative.Int
 	GetSharpLimit() gdnative.Real
-	GetTexture() TextureImplementer
+	GetTexture() godot.TextureImplementer
+	GetTextureMode() Line2DLineTextureMode
 	GetWidth() gdnative.Real
 	RemovePoint(i gdnative.Int)
-	SetBeginCapMode(mode gdnative.Int)
+	SetBeginCapMode(mode Line2DLineCapMode)
 	SetDefaultColor(color gdnative.Color)
-	SetEndCapMode(mode gdnative.Int)
-	SetGradient(color GradientImplementer)
-	SetJointMode(mode gdnative.Int)
+	SetEndCapMode(mode Line2DLineCapMode)
+	SetGradient(color resource.GradientImplementer)
+	SetJointMode(mode Line2DLineJointMode)
 	SetPointPosition(i gdnative.Int, position gdnative.Vector2)
 	SetPoints(points gdnative.PoolVector2Array)
 	SetRoundPrecision(precision gdnative.Int)
 	SetSharpLimit(limit gdnative.Real)
-	SetTexture(texture TextureImplementer)
-	SetTextureMode(mode gdnative.Int)
+	SetTexture(texture godot.TextureImplementer)
+	SetTextureMode(mode Line2DLineTextureMode)
 	SetWidth(width gdnative.Real)
+	BeginCapMode() Line2DLineCapMode
+	DefaultColor() gdnative.Color
+	EndCapMode() Line2DLineCapMode
+	Gradient() resource.GradientImplementer
+	JointMode() Line2DLineJointMode
+	Points() gdnative.PoolVector2Array
+	RoundPrecision() gdnative.Int
+	SharpLimit() gdnative.Real
+	Texture() godot.TextureImplementer
+	TextureMode() Line2DLineTextureMode
+	Width() gdnative.Real
 }
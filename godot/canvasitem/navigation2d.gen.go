@@ -1,7 +1,9 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +15,44 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewNavigation2DFromPointer(ptr gdnative.Pointer) Navigation2D {
-func newNavigation2DFromPointer(ptr gdnative.Pointer) Navigation2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Navigation2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type Navigation2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorNavigation2D is the class constructor of the Navigation2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNavigation2D = gdnative.NewLazyClassConstructor("Navigation2D")
+
+// NewNavigation2D will create a new instance of the Navigation2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNavigation2D() *Navigation2D {
+	obj := &Navigation2D{}
+	obj.SetBaseObject(classConstructorNavigation2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Navigation2D) BaseClass() string {
 	return "Navigation2D"
 }
 
-/*
+// Method binds of the Navigation2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNavigation2DGetClosestPoint      = gdnative.NewLazyMethodBind("Navigation2D", "get_closest_point")
+	methodBindNavigation2DGetClosestPointOwner = gdnative.NewLazyMethodBind("Navigation2D", "get_closest_point_owner")
+	methodBindNavigation2DGetSimplePath        = gdnative.NewLazyMethodBind("Navigation2D", "get_simple_path")
+	methodBindNavigation2DNavpolyAdd           = gdnative.NewLazyMethodBind("Navigation2D", "navpoly_add")
+	methodBindNavigation2DNavpolyRemove        = gdnative.NewLazyMethodBind("Navigation2D", "navpoly_remove")
+	methodBindNavigation2DNavpolySetTransform  = gdnative.NewLazyMethodBind("Navigation2D", "navpoly_set_transform")
+)
 
-	Args: [{ false to_point Vector2}], Returns: Vector2
+/*
+Args: [{ false to_point Vector2}], Returns: Vector2
 */
 func (o *Navigation2D) GetClosestPoint(toPoint gdnative.Vector2) gdnative.Vector2 {
 	//log.Println("Calling Navigation2D.GetClosestPoint()")
@@ -46,7 +62,7 @@ func (o *Navigation2D) GetClosestPoint(toPoint gdnative.Vector2) gdnative.Vector
 	ptrArguments[0] = gdnative.NewPointerFromVector2(toPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation2D", "get_closest_point")
+	methodBind := methodBindNavigation2DGetClosestPoint.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -59,10 +75,9 @@ func (o *Navigation2D) GetClosestPoint(toPoint gdnative.Vector2) gdnative.Vector
 }
 
 /*
-
-	Args: [{ false to_point Vector2}], Returns: Object
+Args: [{ false to_point Vector2}], Returns: Object
 */
-func (o *Navigation2D) GetClosestPointOwner(toPoint gdnative.Vector2) ObjectImplementer {
+func (o *Navigation2D) GetClosestPointOwner(toPoint gdnative.Vector2) godot.ObjectImplementer {
 	//log.Println("Calling Navigation2D.GetClosestPointOwner()")
 
 	// Build out the method's arguments
@@ -70,7 +85,7 @@ func (o *Navigation2D) GetClosestPointOwner(toPoint gdnative.Vector2) ObjectImpl
 	ptrArguments[0] = gdnative.NewPointerFromVector2(toPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation2D", "get_closest_point_owner")
+	methodBind := methodBindNavigation2DGetClosestPointOwner.Get()
 
 	// Call the parent method.
 	// Object
@@ -78,27 +93,24 @@ func (o *Navigation2D) GetClosestPointOwner(toPoint gdnative.Vector2) ObjectImpl
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false start Vector2} { false end Vector2} {True true optimize bool}], Returns: PoolVector2Array
+Args: [{ false start Vector2} { false end Vector2} {True true optimize bool}], Returns: PoolVector2Array
 */
 func (o *Navigation2D) GetSimplePath(start gdnative.Vector2, end gdnative.Vector2, optimize gdnative.Bool) gdnative.PoolVector2Array {
 	//log.Println("Calling Navigation2D.GetSimplePath()")
@@ -110,7 +122,7 @@ func (o *Navigation2D) GetSimplePath(start gdnative.Vector2, end gdnative.Vector
 	ptrArguments[2] = gdnative.NewPointerFromBool(optimize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation2D", "get_simple_path")
+	methodBind := methodBindNavigation2DGetSimplePath.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -123,20 +135,19 @@ func (o *Navigation2D) GetSimplePath(start gdnative.Vector2, end gdnative.Vector
 }
 
 /*
-
-	Args: [{ false mesh NavigationPolygon} { false xform Transform2D} {Null true owner Object}], Returns: int
+Args: [{ false mesh NavigationPolygon} { false xform Transform2D} {Null true owner Object}], Returns: int
 */
-func (o *Navigation2D) NavpolyAdd(mesh NavigationPolygonImplementer, xform gdnative.Transform2D, owner ObjectImplementer) gdnative.Int {
+func (o *Navigation2D) NavpolyAdd(mesh resource.NavigationPolygonImplementer, xform gdnative.Transform2D, owner godot.ObjectImplementer) gdnative.Int {
 	//log.Println("Calling Navigation2D.NavpolyAdd()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(xform)
-	ptrArguments[2] = gdnative.NewPointerFromObject(owner.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(owner))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation2D", "navpoly_add")
+	methodBind := methodBindNavigation2DNavpolyAdd.Get()
 
 	// Call the parent method.
 	// int
@@ -149,8 +160,7 @@ func (o *Navigation2D) NavpolyAdd(mesh NavigationPolygonImplementer, xform gdnat
 }
 
 /*
-
-	Args: [{ false id int}], Returns: void
+Args: [{ false id int}], Returns: void
 */
 func (o *Navigation2D) NavpolyRemove(id gdnative.Int) {
 	//log.Println("Calling Navigation2D.NavpolyRemove()")
@@ -160,7 +170,7 @@ func (o *Navigation2D) NavpolyRemove(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation2D", "navpoly_remove")
+	methodBind := methodBindNavigation2DNavpolyRemove.Get()
 
 	// Call the parent method.
 	// void
@@ -170,8 +180,7 @@ func (o *Navigation2D) NavpolyRemove(id gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false id int} { false xform Transform2D}], Returns: void
+Args: [{ false id int} { false xform Transform2D}], Returns: void
 */
 func (o *Navigation2D) NavpolySetTransform(id gdnative.Int, xform gdnative.Transform2D) {
 	//log.Println("Calling Navigation2D.NavpolySetTransform()")
@@ -182,7 +191,7 @@ func (o *Navigation2D) NavpolySetTransform(id gdnative.Int, xform gdnative.Trans
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation2D", "navpoly_set_transform")
+	methodBind := methodBindNavigation2DNavpolySetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -191,14 +200,26 @@ func (o *Navigation2D) NavpolySetTransform(id gdnative.Int, xform gdnative.Trans
 
 }
 
+// GetSimplePathWithDefaults will call GetSimplePath using the default values for: optimize.
+func (o *Navigation2D) GetSimplePathWithDefaults(start gdnative.Vector2, end gdnative.Vector2) gdnative.PoolVector2Array {
+	return o.GetSimplePath(start, end, true)
+}
+
+// NavpolyAddWithDefaults will call NavpolyAdd using the default values for: owner.
+func (o *Navigation2D) NavpolyAddWithDefaults(mesh resource.NavigationPolygonImplementer, xform gdnative.Transform2D) gdnative.Int {
+	return o.NavpolyAdd(mesh, xform, nil)
+}
+
 // Navigation2DImplementer is an interface that implements the methods
 // of the Navigation2D class.
 type Navigation2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetClosestPoint(toPoint gdnative.Vector2) gdnative.Vector2
-	GetClosestPointOwner(toPoint gdnative.Vector2) ObjectImplementer
+	GetClosestPointOwner(toPoint gdnative.Vector2) godot.ObjectImplementer
 	GetSimplePath(start gdnative.Vector2, end gdnative.Vector2, optimize gdnative.Bool) gdnative.PoolVector2Array
-	NavpolyAdd(mesh NavigationPolygonImplementer, xform gdnative.Transform2D, owner ObjectImplementer) gdnative.Int
+	NavpolyAdd(mesh resource.NavigationPolygonImplementer, xform gdnative.Transform2D, owner godot.ObjectImplementer) gdnative.Int
 	NavpolyRemove(id gdnative.Int)
 	NavpolySetTransform(id gdnative.Int, xform gdnative.Transform2D)
+	GetSimplePathWithDefaults(start gdnative.Vector2, end gdnative.Vector2) gdnative.PoolVector2Array
+	NavpolyAddWithDefaults(mesh resource.NavigationPolygonImplementer, xform gdnative.Transform2D) gdnative.Int
 }
@@ -0,0 +1,200 @@
+package canvasitem
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+ */
+type NavigationPolygonInstance struct {
+	godot.Node2D
+	owner gdnative.Object
+}
+
+// classConstructorNavigationPolygonInstance is the class constructor of the NavigationPolygonInstance class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNavigationPolygonInstance = gdnative.NewLazyClassConstructor("NavigationPolygonInstance")
+
+// NewNavigationPolygonInstance will create a new instance of the NavigationPolygonInstance class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNavigationPolygonInstance() *NavigationPolygonInstance {
+	obj := &NavigationPolygonInstance{}
+	obj.SetBaseObject(classConstructorNavigationPolygonInstance.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *NavigationPolygonInstance) BaseClass() string {
+	return "NavigationPolygonInstance"
+}
+
+// Method binds of the NavigationPolygonInstance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNavigationPolygonInstanceX_NavpolyChanged     = gdnative.NewLazyMethodBind("NavigationPolygonInstance", "_navpoly_changed")
+	methodBindNavigationPolygonInstanceGetNavigationPolygon = gdnative.NewLazyMethodBind("NavigationPolygonInstance", "get_navigation_polygon")
+	methodBindNavigationPolygonInstanceIsEnabled            = gdnative.NewLazyMethodBind("NavigationPolygonInstance", "is_enabled")
+	methodBindNavigationPolygonInstanceSetEnabled           = gdnative.NewLazyMethodBind("NavigationPolygonInstance", "set_enabled")
+	methodBindNavigationPolygonInstanceSetNavigationPolygon = gdnative.NewLazyMethodBind("NavigationPolygonInstance", "set_navigation_polygon")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *NavigationPolygonInstance) X_NavpolyChanged() {
+	//log.Println("Calling NavigationPolygonInstance.X_NavpolyChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNavigationPolygonInstanceX_NavpolyChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: NavigationPolygon
+*/
+func (o *NavigationPolygonInstance) GetNavigationPolygon() resource.NavigationPolygonImplementer {
+	//log.Println("Calling NavigationPolygonInstance.GetNavigationPolygon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNavigationPolygonInstanceGetNavigationPolygon.Get()
+
+	// Call the parent method.
+	// NavigationPolygon
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := resource.NavigationPolygon{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.NavigationPolygonImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *NavigationPolygonInstance) IsEnabled() gdnative.Bool {
+	//log.Println("Calling NavigationPolygonInstance.IsEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNavigationPolygonInstanceIsEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *NavigationPolygonInstance) SetEnabled(enabled gdnative.Bool) {
+	//log.Println("Calling NavigationPolygonInstance.SetEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindNavigationPolygonInstanceSetEnabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false navpoly NavigationPolygon}], Returns: void
+*/
+func (o *NavigationPolygonInstance) SetNavigationPolygon(navpoly resource.NavigationPolygonImplementer) {
+	//log.Println("Calling NavigationPolygonInstance.SetNavigationPolygon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(navpoly))
+
+	// Get the method bind
+	methodBind := methodBindNavigationPolygonInstanceSetNavigationPolygon.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Enabled will return the value of the "enabled" property.
+func (o *NavigationPolygonInstance) Enabled() gdnative.Bool {
+	return o.IsEnabled()
+}
+
+// Navpoly will return the value of the "navpoly" property.
+func (o *NavigationPolygonInstance) Navpoly() resource.NavigationPolygonImplementer {
+	return o.GetNavigationPolygon()
+}
+
+// SetNavpoly will set the value of the "navpoly" property.
+func (o *NavigationPolygonInstance) SetNavpoly(value resource.NavigationPolygonImplementer) {
+	o.SetNavigationPolygon(value)
+}
+
+// NavigationPolygonInstanceImplementer is an interface that implements the methods
+// of the NavigationPolygonInstance class.
+type NavigationPolygonInstanceImplementer interface {
+	godot.Node2DImplementer
+	X_NavpolyChanged()
+	GetNavigationPolygon() resource.NavigationPolygonImplementer
+	IsEnabled() gdnative.Bool
+	SetEnabled(enabled gdnative.Bool)
+	SetNavigationPolygon(navpoly resource.NavigationPolygonImplementer)
+	Enabled() gdnative.Bool
+	Navpoly() resource.NavigationPolygonImplementer
+	SetNavpoly(value resource.NavigationPolygonImplementer)
+}
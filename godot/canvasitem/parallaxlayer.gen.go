@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewParallaxLayerFromPointer(ptr gdnative.Pointer) ParallaxLayer {
-func newParallaxLayerFromPointer(ptr gdnative.Pointer) ParallaxLayer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ParallaxLayer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A ParallaxLayer must be the child of a [ParallaxBackground] node. Each ParallaxLayer can be set to move at different speeds relative to the camera movement or the [member ParallaxBackground.scroll_offset] value. This node's children will be affected by its scroll offset.
 */
 type ParallaxLayer struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorParallaxLayer is the class constructor of the ParallaxLayer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorParallaxLayer = gdnative.NewLazyClassConstructor("ParallaxLayer")
+
+// NewParallaxLayer will create a new instance of the ParallaxLayer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewParallaxLayer() *ParallaxLayer {
+	obj := &ParallaxLayer{}
+	obj.SetBaseObject(classConstructorParallaxLayer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ParallaxLayer) BaseClass() string {
 	return "ParallaxLayer"
 }
 
+// Method binds of the ParallaxLayer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindParallaxLayerGetMirroring    = gdnative.NewLazyMethodBind("ParallaxLayer", "get_mirroring")
+	methodBindParallaxLayerGetMotionOffset = gdnative.NewLazyMethodBind("ParallaxLayer", "get_motion_offset")
+	methodBindParallaxLayerGetMotionScale  = gdnative.NewLazyMethodBind("ParallaxLayer", "get_motion_scale")
+	methodBindParallaxLayerSetMirroring    = gdnative.NewLazyMethodBind("ParallaxLayer", "set_mirroring")
+	methodBindParallaxLayerSetMotionOffset = gdnative.NewLazyMethodBind("ParallaxLayer", "set_motion_offset")
+	methodBindParallaxLayerSetMotionScale  = gdnative.NewLazyMethodBind("ParallaxLayer", "set_motion_scale")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxLayer) GetMirroring() gdnative.Vector2 {
 	//log.Println("Calling ParallaxLayer.GetMirroring()")
@@ -45,7 +62,7 @@ func (o *ParallaxLayer) GetMirroring() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxLayer", "get_mirroring")
+	methodBind := methodBindParallaxLayerGetMirroring.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +75,8 @@ func (o *ParallaxLayer) GetMirroring() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxLayer) GetMotionOffset() gdnative.Vector2 {
 	//log.Println("Calling ParallaxLayer.GetMotionOffset()")
@@ -68,7 +85,7 @@ func (o *ParallaxLayer) GetMotionOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxLayer", "get_motion_offset")
+	methodBind := methodBindParallaxLayerGetMotionOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +98,8 @@ func (o *ParallaxLayer) GetMotionOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxLayer) GetMotionScale() gdnative.Vector2 {
 	//log.Println("Calling ParallaxLayer.GetMotionScale()")
@@ -91,7 +108,7 @@ func (o *ParallaxLayer) GetMotionScale() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxLayer", "get_motion_scale")
+	methodBind := methodBindParallaxLayerGetMotionScale.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -104,8 +121,8 @@ func (o *ParallaxLayer) GetMotionScale() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false mirror Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false mirror Vector2}], Returns: void
 */
 func (o *ParallaxLayer) SetMirroring(mirror gdnative.Vector2) {
 	//log.Println("Calling ParallaxLayer.SetMirroring()")
@@ -115,7 +132,7 @@ func (o *ParallaxLayer) SetMirroring(mirror gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(mirror)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxLayer", "set_mirroring")
+	methodBind := methodBindParallaxLayerSetMirroring.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +142,8 @@ func (o *ParallaxLayer) SetMirroring(mirror gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *ParallaxLayer) SetMotionOffset(offset gdnative.Vector2) {
 	//log.Println("Calling ParallaxLayer.SetMotionOffset()")
@@ -136,7 +153,7 @@ func (o *ParallaxLayer) SetMotionOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxLayer", "set_motion_offset")
+	methodBind := methodBindParallaxLayerSetMotionOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +163,8 @@ func (o *ParallaxLayer) SetMotionOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false scale Vector2}], Returns: void
 */
 func (o *ParallaxLayer) SetMotionScale(scale gdnative.Vector2) {
 	//log.Println("Calling ParallaxLayer.SetMotionScale()")
@@ -157,7 +174,7 @@ func (o *ParallaxLayer) SetMotionScale(scale gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxLayer", "set_motion_scale")
+	methodBind := methodBindParallaxLayerSetMotionScale.Get()
 
 	// Call the parent method.
 	// void
@@ -166,14 +183,38 @@ func (o *ParallaxLayer) SetMotionScale(scale gdnative.Vector2) {
 
 }
 
+// MotionMirroring will return the value of the "motion_mirroring" property.
+func (o *ParallaxLayer) MotionMirroring() gdnative.Vector2 {
+	return o.GetMirroring()
+}
+
+// SetMotionMirroring will set the value of the "motion_mirroring" property.
+func (o *ParallaxLayer) SetMotionMirroring(value gdnative.Vector2) {
+	o.SetMirroring(value)
+}
+
+// MotionOffset will return the value of the "motion_offset" property.
+func (o *ParallaxLayer) MotionOffset() gdnative.Vector2 {
+	return o.GetMotionOffset()
+}
+
+// MotionScale will return the value of the "motion_scale" property.
+func (o *ParallaxLayer) MotionScale() gdnative.Vector2 {
+	return o.GetMotionScale()
+}
+
 // ParallaxLayerImplementer is an interface that implements the methods
 // of the ParallaxLayer class.
 type ParallaxLayerImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetMirroring() gdnative.Vector2
 	GetMotionOffset() gdnative.Vector2
 	GetMotionScale() gdnative.Vector2
 	SetMirroring(mirror gdnative.Vector2)
 	SetMotionOffset(offset gdnative.Vector2)
 	SetMotionScale(scale gdnative.Vector2)
+	MotionMirroring() gdnative.Vector2
+	SetMotionMirroring(value gdnative.Vector2)
+	MotionOffset() gdnative.Vector2
+	MotionScale() gdnative.Vector2
 }
@@ -1,7 +1,10 @@
-package godot
+package canvasitem
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,30 +24,99 @@ const (
 	Particles2DDrawOrderLifetime Particles2DDrawOrder = 1
 )
 
-//func NewParticles2DFromPointer(ptr gdnative.Pointer) Particles2D {
-func newParticles2DFromPointer(ptr gdnative.Pointer) Particles2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Particles2D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the Particles2DDrawOrder value.
+func (e Particles2DDrawOrder) String() string {
+	switch e {
+	case Particles2DDrawOrderIndex:
+		return "DRAW_ORDER_INDEX"
+	case Particles2DDrawOrderLifetime:
+		return "DRAW_ORDER_LIFETIME"
+	}
+	return fmt.Sprintf("Particles2DDrawOrder(%d)", int(e))
+}
 
-	return obj
+// ParseParticles2DDrawOrder will return the Particles2DDrawOrder value with the given Godot name.
+func ParseParticles2DDrawOrder(name string) (Particles2DDrawOrder, error) {
+	switch name {
+	case "DRAW_ORDER_INDEX":
+		return Particles2DDrawOrderIndex, nil
+	case "DRAW_ORDER_LIFETIME":
+		return Particles2DDrawOrderLifetime, nil
+	}
+	return 0, fmt.Errorf("invalid Particles2DDrawOrder value %q", name)
 }
 
 /*
 2D particle node used to create a variety of particle systems and effects. [code]Particles2D[/code] features an emitter that generates some number of particles at a given rate. Use the [code]process_material[/code] property to add a [ParticlesMaterial] to configure particle appearance and behavior. Alternatively, you can add a [ShaderMaterial] which will be applied to all particles.
 */
 type Particles2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorParticles2D is the class constructor of the Particles2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorParticles2D = gdnative.NewLazyClassConstructor("Particles2D")
+
+// NewParticles2D will create a new instance of the Particles2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewParticles2D() *Particles2D {
+	obj := &Particles2D{}
+	obj.SetBaseObject(classConstructorParticles2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Particles2D) BaseClass() string {
 	return "Particles2D"
 }
 
-/*
+// Method binds of the Particles2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindParticles2DCaptureRect            = gdnative.NewLazyMethodBind("Particles2D", "capture_rect")
+	methodBindParticles2DGetAmount              = gdnative.NewLazyMethodBind("Particles2D", "get_amount")
+	methodBindParticles2DGetDrawOrder           = gdnative.NewLazyMethodBind("Particles2D", "get_draw_order")
+	methodBindParticles2DGetExplosivenessRatio  = gdnative.NewLazyMethodBind("Particles2D", "get_explosiveness_ratio")
+	methodBindParticles2DGetFixedFps            = gdnative.NewLazyMethodBind("Particles2D", "get_fixed_fps")
+	methodBindParticles2DGetFractionalDelta     = gdnative.NewLazyMethodBind("Particles2D", "get_fractional_delta")
+	methodBindParticles2DGetHFrames             = gdnative.NewLazyMethodBind("Particles2D", "get_h_frames")
+	methodBindParticles2DGetLifetime            = gdnative.NewLazyMethodBind("Particles2D", "get_lifetime")
+	methodBindParticles2DGetNormalMap           = gdnative.NewLazyMethodBind("Particles2D", "get_normal_map")
+	methodBindParticles2DGetOneShot             = gdnative.NewLazyMethodBind("Particles2D", "get_one_shot")
+	methodBindParticles2DGetPreProcessTime      = gdnative.NewLazyMethodBind("Particles2D", "get_pre_process_time")
+	methodBindParticles2DGetProcessMaterial     = gdnative.NewLazyMethodBind("Particles2D", "get_process_material")
+	methodBindParticles2DGetRandomnessRatio     = gdnative.NewLazyMethodBind("Particles2D", "get_randomness_ratio")
+	methodBindParticles2DGetSpeedScale          = gdnative.NewLazyMethodBind("Particles2D", "get_speed_scale")
+	methodBindParticles2DGetTexture             = gdnative.NewLazyMethodBind("Particles2D", "get_texture")
+	methodBindParticles2DGetUseLocalCoordinates = gdnative.NewLazyMethodBind("Particles2D", "get_use_local_coordinates")
+	methodBindParticles2DGetVFrames             = gdnative.NewLazyMethodBind("Particles2D", "get_v_frames")
+	methodBindParticles2DGetVisibilityRect      = gdnative.NewLazyMethodBind("Particles2D", "get_visibility_rect")
+	methodBindParticles2DIsEmitting             = gdnative.NewLazyMethodBind("Particles2D", "is_emitting")
+	methodBindParticles2DRestart                = gdnative.NewLazyMethodBind("Particles2D", "restart")
+	methodBindParticles2DSetAmount              = gdnative.NewLazyMethodBind("Particles2D", "set_amount")
+	methodBindParticles2DSetDrawOrder           = gdnative.NewLazyMethodBind("Particles2D", "set_draw_order")
+	methodBindParticles2DSetEmitting            = gdnative.NewLazyMethodBind("Particles2D", "set_emitting")
+	methodBindParticles2DSetExplosivenessRatio  = gdnative.NewLazyMethodBind("Particles2D", "set_explosiveness_ratio")
+	methodBindParticles2DSetFixedFps            = gdnative.NewLazyMethodBind("Particles2D", "set_fixed_fps")
+	methodBindParticles2DSetFractionalDelta     = gdnative.NewLazyMethodBind("Particles2D", "set_fractional_delta")
+	methodBindParticles2DSetHFrames             = gdnative.NewLazyMethodBind("Particles2D", "set_h_frames")
+	methodBindParticles2DSetLifetime            = gdnative.NewLazyMethodBind("Particles2D", "set_lifetime")
+	methodBindParticles2DSetNormalMap           = gdnative.NewLazyMethodBind("Particles2D", "set_normal_map")
+	methodBindParticles2DSetOneShot             = gdnative.NewLazyMethodBind("Particles2D", "set_one_shot")
+	methodBindParticles2DSetPreProcessTime      = gdnative.NewLazyMethodBind("Particles2D", "set_pre_process_time")
+	methodBindParticles2DSetProcessMaterial     = gdnative.NewLazyMethodBind("Particles2D", "set_process_material")
+	methodBindParticles2DSetRandomnessRatio     = gdnative.NewLazyMethodBind("Particles2D", "set_randomness_ratio")
+	methodBindParticles2DSetSpeedScale          = gdnative.NewLazyMethodBind("Particles2D", "set_speed_scale")
+	methodBindParticles2DSetTexture             = gdnative.NewLazyMethodBind("Particles2D", "set_texture")
+	methodBindParticles2DSetUseLocalCoordinates = gdnative.NewLazyMethodBind("Particles2D", "set_use_local_coordinates")
+	methodBindParticles2DSetVFrames             = gdnative.NewLazyMethodBind("Particles2D", "set_v_frames")
+	methodBindParticles2DSetVisibilityRect      = gdnative.NewLazyMethodBind("Particles2D", "set_visibility_rect")
+)
 
-	Args: [], Returns: Rect2
+/*
+Args: [], Returns: Rect2
 */
 func (o *Particles2D) CaptureRect() gdnative.Rect2 {
 	//log.Println("Calling Particles2D.CaptureRect()")
@@ -53,7 +125,7 @@ func (o *Particles2D) CaptureRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "capture_rect")
+	methodBind := methodBindParticles2DCaptureRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -66,8 +138,8 @@ func (o *Particles2D) CaptureRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Particles2D) GetAmount() gdnative.Int {
 	//log.Println("Calling Particles2D.GetAmount()")
@@ -76,7 +148,7 @@ func (o *Particles2D) GetAmount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_amount")
+	methodBind := methodBindParticles2DGetAmount.Get()
 
 	// Call the parent method.
 	// int
@@ -89,8 +161,8 @@ func (o *Particles2D) GetAmount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Particles2D::DrawOrder
+	        Undocumented
+		Args: [], Returns: enum.Particles2D::DrawOrder
 */
 func (o *Particles2D) GetDrawOrder() Particles2DDrawOrder {
 	//log.Println("Calling Particles2D.GetDrawOrder()")
@@ -99,7 +171,7 @@ func (o *Particles2D) GetDrawOrder() Particles2DDrawOrder {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_draw_order")
+	methodBind := methodBindParticles2DGetDrawOrder.Get()
 
 	// Call the parent method.
 	// enum.Particles2D::DrawOrder
@@ -112,8 +184,8 @@ func (o *Particles2D) GetDrawOrder() Particles2DDrawOrder {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles2D) GetExplosivenessRatio() gdnative.Real {
 	//log.Println("Calling Particles2D.GetExplosivenessRatio()")
@@ -122,7 +194,7 @@ func (o *Particles2D) GetExplosivenessRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_explosiveness_ratio")
+	methodBind := methodBindParticles2DGetExplosivenessRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -135,8 +207,8 @@ func (o *Particles2D) GetExplosivenessRatio() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Particles2D) GetFixedFps() gdnative.Int {
 	//log.Println("Calling Particles2D.GetFixedFps()")
@@ -145,7 +217,7 @@ func (o *Particles2D) GetFixedFps() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_fixed_fps")
+	methodBind := methodBindParticles2DGetFixedFps.Get()
 
 	// Call the parent method.
 	// int
@@ -158,8 +230,8 @@ func (o *Particles2D) GetFixedFps() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles2D) GetFractionalDelta() gdnative.Bool {
 	//log.Println("Calling Particles2D.GetFractionalDelta()")
@@ -168,7 +240,7 @@ func (o *Particles2D) GetFractionalDelta() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_fractional_delta")
+	methodBind := methodBindParticles2DGetFractionalDelta.Get()
 
 	// Call the parent method.
 	// bool
@@ -181,8 +253,8 @@ func (o *Particles2D) GetFractionalDelta() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Particles2D) GetHFrames() gdnative.Int {
 	//log.Println("Calling Particles2D.GetHFrames()")
@@ -191,7 +263,7 @@ func (o *Particles2D) GetHFrames() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_h_frames")
+	methodBind := methodBindParticles2DGetHFrames.Get()
 
 	// Call the parent method.
 	// int
@@ -204,8 +276,8 @@ func (o *Particles2D) GetHFrames() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles2D) GetLifetime() gdnative.Real {
 	//log.Println("Calling Particles2D.GetLifetime()")
@@ -214,7 +286,7 @@ func (o *Particles2D) GetLifetime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_lifetime")
+	methodBind := methodBindParticles2DGetLifetime.Get()
 
 	// Call the parent method.
 	// float
@@ -227,17 +299,17 @@ func (o *Particles2D) GetLifetime() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Particles2D) GetNormalMap() TextureImplementer {
+func (o *Particles2D) GetNormalMap() godot.TextureImplementer {
 	//log.Println("Calling Particles2D.GetNormalMap()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_normal_map")
+	methodBind := methodBindParticles2DGetNormalMap.Get()
 
 	// Call the parent method.
 	// Texture
@@ -245,27 +317,25 @@ func (o *Particles2D) GetNormalMap() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles2D) GetOneShot() gdnative.Bool {
 	//log.Println("Calling Particles2D.GetOneShot()")
@@ -274,7 +344,7 @@ func (o *Particles2D) GetOneShot() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_one_shot")
+	methodBind := methodBindParticles2DGetOneShot.Get()
 
 	// Call the parent method.
 	// bool
@@ -287,8 +357,8 @@ func (o *Particles2D) GetOneShot() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles2D) GetPreProcessTime() gdnative.Real {
 	//log.Println("Calling Particles2D.GetPreProcessTime()")
@@ -297,7 +367,7 @@ func (o *Particles2D) GetPreProcessTime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_pre_process_time")
+	methodBind := methodBindParticles2DGetPreProcessTime.Get()
 
 	// Call the parent method.
 	// float
@@ -310,17 +380,17 @@ func (o *Particles2D) GetPreProcessTime() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Material
+	        Undocumented
+		Args: [], Returns: Material
 */
-func (o *Particles2D) GetProcessMaterial() MaterialImplementer {
+func (o *Particles2D) GetProcessMaterial() godot.MaterialImplementer {
 	//log.Println("Calling Particles2D.GetProcessMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_process_material")
+	methodBind := methodBindParticles2DGetProcessMaterial.Get()
 
 	// Call the parent method.
 	// Material
@@ -328,27 +398,25 @@ func (o *Particles2D) GetProcessMaterial() MaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := godot.Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles2D) GetRandomnessRatio() gdnative.Real {
 	//log.Println("Calling Particles2D.GetRandomnessRatio()")
@@ -357,7 +425,7 @@ func (o *Particles2D) GetRandomnessRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_randomness_ratio")
+	methodBind := methodBindParticles2DGetRandomnessRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -370,8 +438,8 @@ func (o *Particles2D) GetRandomnessRatio() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles2D) GetSpeedScale() gdnative.Real {
 	//log.Println("Calling Particles2D.GetSpeedScale()")
@@ -380,7 +448,7 @@ func (o *Particles2D) GetSpeedScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_speed_scale")
+	methodBind := methodBindParticles2DGetSpeedScale.Get()
 
 	// Call the parent method.
 	// float
@@ -393,17 +461,17 @@ func (o *Particles2D) GetSpeedScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Particles2D) GetTexture() TextureImplementer {
+func (o *Particles2D) GetTexture() godot.TextureImplementer {
 	//log.Println("Calling Particles2D.GetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_texture")
+	methodBind := methodBindParticles2DGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -411,27 +479,25 @@ func (o *Particles2D) GetTexture() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles2D) GetUseLocalCoordinates() gdnative.Bool {
 	//log.Println("Calling Particles2D.GetUseLocalCoordinates()")
@@ -440,7 +506,7 @@ func (o *Particles2D) GetUseLocalCoordinates() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_use_local_coordinates")
+	methodBind := methodBindParticles2DGetUseLocalCoordinates.Get()
 
 	// Call the parent method.
 	// bool
@@ -453,8 +519,8 @@ func (o *Particles2D) GetUseLocalCoordinates() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Particles2D) GetVFrames() gdnative.Int {
 	//log.Println("Calling Particles2D.GetVFrames()")
@@ -463,7 +529,7 @@ func (o *Particles2D) GetVFrames() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_v_frames")
+	methodBind := methodBindParticles2DGetVFrames.Get()
 
 	// Call the parent method.
 	// int
@@ -476,8 +542,8 @@ func (o *Particles2D) GetVFrames() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *Particles2D) GetVisibilityRect() gdnative.Rect2 {
 	//log.Println("Calling Particles2D.GetVisibilityRect()")
@@ -486,7 +552,7 @@ func (o *Particles2D) GetVisibilityRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "get_visibility_rect")
+	methodBind := methodBindParticles2DGetVisibilityRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -499,8 +565,8 @@ func (o *Particles2D) GetVisibilityRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles2D) IsEmitting() gdnative.Bool {
 	//log.Println("Calling Particles2D.IsEmitting()")
@@ -509,7 +575,7 @@ func (o *Particles2D) IsEmitting() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "is_emitting")
+	methodBind := methodBindParticles2DIsEmitting.Get()
 
 	// Call the parent method.
 	// bool
@@ -522,8 +588,7 @@ func (o *Particles2D) IsEmitting() gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *Particles2D) Restart() {
 	//log.Println("Calling Particles2D.Restart()")
@@ -532,7 +597,7 @@ func (o *Particles2D) Restart() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "restart")
+	methodBind := methodBindParticles2DRestart.Get()
 
 	// Call the parent method.
 	// void
@@ -542,8 +607,8 @@ func (o *Particles2D) Restart() {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *Particles2D) SetAmount(amount gdnative.Int) {
 	//log.Println("Calling Particles2D.SetAmount()")
@@ -553,7 +618,7 @@ func (o *Particles2D) SetAmount(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_amount")
+	methodBind := methodBindParticles2DSetAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -563,18 +628,18 @@ func (o *Particles2D) SetAmount(amount gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false order int}], Returns: void
+	        Undocumented
+		Args: [{ false order enum.Particles2D::DrawOrder}], Returns: void
 */
-func (o *Particles2D) SetDrawOrder(order gdnative.Int) {
+func (o *Particles2D) SetDrawOrder(order Particles2DDrawOrder) {
 	//log.Println("Calling Particles2D.SetDrawOrder()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(order)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(order))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_draw_order")
+	methodBind := methodBindParticles2DSetDrawOrder.Get()
 
 	// Call the parent method.
 	// void
@@ -584,8 +649,8 @@ func (o *Particles2D) SetDrawOrder(order gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false emitting bool}], Returns: void
+	        Undocumented
+		Args: [{ false emitting bool}], Returns: void
 */
 func (o *Particles2D) SetEmitting(emitting gdnative.Bool) {
 	//log.Println("Calling Particles2D.SetEmitting()")
@@ -595,7 +660,7 @@ func (o *Particles2D) SetEmitting(emitting gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(emitting)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_emitting")
+	methodBind := methodBindParticles2DSetEmitting.Get()
 
 	// Call the parent method.
 	// void
@@ -605,8 +670,8 @@ func (o *Particles2D) SetEmitting(emitting gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ratio float}], Returns: void
+	        Undocumented
+		Args: [{ false ratio float}], Returns: void
 */
 func (o *Particles2D) SetExplosivenessRatio(ratio gdnative.Real) {
 	//log.Println("Calling Particles2D.SetExplosivenessRatio()")
@@ -616,7 +681,7 @@ func (o *Particles2D) SetExplosivenessRatio(ratio gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_explosiveness_ratio")
+	methodBind := methodBindParticles2DSetExplosivenessRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -626,8 +691,8 @@ func (o *Particles2D) SetExplosivenessRatio(ratio gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false fps int}], Returns: void
+	        Undocumented
+		Args: [{ false fps int}], Returns: void
 */
 func (o *Particles2D) SetFixedFps(fps gdnative.Int) {
 	//log.Println("Calling Particles2D.SetFixedFps()")
@@ -637,7 +702,7 @@ func (o *Particles2D) SetFixedFps(fps gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(fps)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_fixed_fps")
+	methodBind := methodBindParticles2DSetFixedFps.Get()
 
 	// Call the parent method.
 	// void
@@ -647,8 +712,8 @@ func (o *Particles2D) SetFixedFps(fps gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Particles2D) SetFractionalDelta(enable gdnative.Bool) {
 	//log.Println("Calling Particles2D.SetFractionalDelta()")
@@ -658,7 +723,7 @@ func (o *Particles2D) SetFractionalDelta(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_fractional_delta")
+	methodBind := methodBindParticles2DSetFractionalDelta.Get()
 
 	// Call the parent method.
 	// void
@@ -668,8 +733,8 @@ func (o *Particles2D) SetFractionalDelta(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false frames int}], Returns: void
+	        Undocumented
+		Args: [{ false frames int}], Returns: void
 */
 func (o *Particles2D) SetHFrames(frames gdnative.Int) {
 	//log.Println("Calling Particles2D.SetHFrames()")
@@ -679,7 +744,7 @@ func (o *Particles2D) SetHFrames(frames gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(frames)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_h_frames")
+	methodBind := methodBindParticles2DSetHFrames.Get()
 
 	// Call the parent method.
 	// void
@@ -689,8 +754,8 @@ func (o *Particles2D) SetHFrames(frames gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false secs float}], Returns: void
+	        Undocumented
+		Args: [{ false secs float}], Returns: void
 */
 func (o *Particles2D) SetLifetime(secs gdnative.Real) {
 	//log.Println("Calling Particles2D.SetLifetime()")
@@ -700,7 +765,7 @@ func (o *Particles2D) SetLifetime(secs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(secs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_lifetime")
+	methodBind := methodBindParticles2DSetLifetime.Get()
 
 	// Call the parent method.
 	// void
@@ -710,18 +775,18 @@ func (o *Particles2D) SetLifetime(secs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Particles2D) SetNormalMap(texture TextureImplementer) {
+func (o *Particles2D) SetNormalMap(texture godot.TextureImplementer) {
 	//log.Println("Calling Particles2D.SetNormalMap()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_normal_map")
+	methodBind := methodBindParticles2DSetNormalMap.Get()
 
 	// Call the parent method.
 	// void
@@ -731,8 +796,8 @@ func (o *Particles2D) SetNormalMap(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false secs bool}], Returns: void
+	        Undocumented
+		Args: [{ false secs bool}], Returns: void
 */
 func (o *Particles2D) SetOneShot(secs gdnative.Bool) {
 	//log.Println("Calling Particles2D.SetOneShot()")
@@ -742,7 +807,7 @@ func (o *Particles2D) SetOneShot(secs gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(secs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_one_shot")
+	methodBind := methodBindParticles2DSetOneShot.Get()
 
 	// Call the parent method.
 	// void
@@ -752,8 +817,8 @@ func (o *Particles2D) SetOneShot(secs gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false secs float}], Returns: void
+	        Undocumented
+		Args: [{ false secs float}], Returns: void
 */
 func (o *Particles2D) SetPreProcessTime(secs gdnative.Real) {
 	//log.Println("Calling Particles2D.SetPreProcessTime()")
@@ -763,7 +828,7 @@ func (o *Particles2D) SetPreProcessTime(secs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(secs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_pre_process_time")
+	methodBind := methodBindParticles2DSetPreProcessTime.Get()
 
 	// Call the parent method.
 	// void
@@ -773,18 +838,18 @@ func (o *Particles2D) SetPreProcessTime(secs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false material Material}], Returns: void
+	        Undocumented
+		Args: [{ false material Material}], Returns: void
 */
-func (o *Particles2D) SetProcessMaterial(material MaterialImplementer) {
+func (o *Particles2D) SetProcessMaterial(material godot.MaterialImplementer) {
 	//log.Println("Calling Particles2D.SetProcessMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_process_material")
+	methodBind := methodBindParticles2DSetProcessMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -794,8 +859,8 @@ func (o *Particles2D) SetProcessMaterial(material MaterialImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ratio float}], Returns: void
+	        Undocumented
+		Args: [{ false ratio float}], Returns: void
 */
 func (o *Particles2D) SetRandomnessRatio(ratio gdnative.Real) {
 	//log.Println("Calling Particles2D.SetRandomnessRatio()")
@@ -805,7 +870,7 @@ func (o *Particles2D) SetRandomnessRatio(ratio gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_randomness_ratio")
+	methodBind := methodBindParticles2DSetRandomnessRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -815,8 +880,8 @@ func (o *Particles2D) SetRandomnessRatio(ratio gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale float}], Returns: void
+	        Undocumented
+		Args: [{ false scale float}], Returns: void
 */
 func (o *Particles2D) SetSpeedScale(scale gdnative.Real) {
 	//log.Println("Calling Particles2D.SetSpeedScale()")
@@ -826,7 +891,7 @@ func (o *Particles2D) SetSpeedScale(scale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_speed_scale")
+	methodBind := methodBindParticles2DSetSpeedScale.Get()
 
 	// Call the parent method.
 	// void
@@ -836,18 +901,18 @@ func (o *Particles2D) SetSpeedScale(scale gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Particles2D) SetTexture(texture TextureImplementer) {
+func (o *Particles2D) SetTexture(texture godot.TextureImplementer) {
 	//log.Println("Calling Particles2D.SetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_texture")
+	methodBind := methodBindParticles2DSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -857,8 +922,8 @@ func (o *Particles2D) SetTexture(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Particles2D) SetUseLocalCoordinates(enable gdnative.Bool) {
 	//log.Println("Calling Particles2D.SetUseLocalCoordinates()")
@@ -868,7 +933,7 @@ func (o *Particles2D) SetUseLocalCoordinates(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_use_local_coordinates")
+	methodBind := methodBindParticles2DSetUseLocalCoordinates.Get()
 
 	// Call the parent method.
 	// void
@@ -878,8 +943,8 @@ func (o *Particles2D) SetUseLocalCoordinates(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false frames int}], Returns: void
+	        Undocumented
+		Args: [{ false frames int}], Returns: void
 */
 func (o *Particles2D) SetVFrames(frames gdnative.Int) {
 	//log.Println("Calling Particles2D.SetVFrames()")
@@ -889,7 +954,7 @@ func (o *Particles2D) SetVFrames(frames gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(frames)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_v_frames")
+	methodBind := methodBindParticles2DSetVFrames.Get()
 
 	// Call the parent method.
 	// void
@@ -899,8 +964,8 @@ func (o *Particles2D) SetVFrames(frames gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false aabb Rect2}], Returns: void
+	        Undocumented
+		Args: [{ false aabb Rect2}], Returns: void
 */
 func (o *Particles2D) SetVisibilityRect(aabb gdnative.Rect2) {
 	//log.Println("Calling Particles2D.SetVisibilityRect()")
@@ -910,7 +975,7 @@ func (o *Particles2D) SetVisibilityRect(aabb gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(aabb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles2D", "set_visibility_rect")
+	methodBind := methodBindParticles2DSetVisibilityRect.Get()
 
 	// Call the parent method.
 	// void
@@ -919,45 +984,184 @@ func (o *Particles2D) SetVisibilityRect(aabb gdnative.Rect2) {
 
 }
 
+// Amount will return the value of the "amount" property.
+func (o *Particles2D) Amount() gdnative.Int {
+	return o.GetAmount()
+}
+
+// DrawOrder will return the value of the "draw_order" property.
+func (o *Particles2D) DrawOrder() Particles2DDrawOrder {
+	return o.GetDrawOrder()
+}
+
+// Emitting will return the value of the "emitting" property.
+func (o *Particles2D) Emitting() gdnative.Bool {
+	return o.IsEmitting()
+}
+
+// Explosiveness will return the value of the "explosiveness" property.
+func (o *Particles2D) Explosiveness() gdnative.Real {
+	return o.GetExplosivenessRatio()
+}
+
+// SetExplosiveness will set the value of the "explosiveness" property.
+func (o *Particles2D) SetExplosiveness(value gdnative.Real) {
+	o.SetExplosivenessRatio(value)
+}
+
+// FixedFps will return the value of the "fixed_fps" property.
+func (o *Particles2D) FixedFps() gdnative.Int {
+	return o.GetFixedFps()
+}
+
+// FractDelta will return the value of the "fract_delta" property.
+func (o *Particles2D) FractDelta() gdnative.Bool {
+	return o.GetFractionalDelta()
+}
+
+// SetFractDelta will set the value of the "fract_delta" property.
+func (o *Particles2D) SetFractDelta(value gdnative.Bool) {
+	o.SetFractionalDelta(value)
+}
+
+// HFrames will return the value of the "h_frames" property.
+func (o *Particles2D) HFrames() gdnative.Int {
+	return o.GetHFrames()
+}
+
+// Lifetime will return the value of the "lifetime" property.
+func (o *Particles2D) Lifetime() gdnative.Real {
+	return o.GetLifetime()
+}
+
+// LocalCoords will return the value of the "local_coords" property.
+func (o *Particles2D) LocalCoords() gdnative.Bool {
+	return o.GetUseLocalCoordinates()
+}
+
+// SetLocalCoords will set the value of the "local_coords" property.
+func (o *Particles2D) SetLocalCoords(value gdnative.Bool) {
+	o.SetUseLocalCoordinates(value)
+}
+
+// NormalMap will return the value of the "normal_map" property.
+func (o *Particles2D) NormalMap() godot.TextureImplementer {
+	return o.GetNormalMap()
+}
+
+// OneShot will return the value of the "one_shot" property.
+func (o *Particles2D) OneShot() gdnative.Bool {
+	return o.GetOneShot()
+}
+
+// Preprocess will return the value of the "preprocess" property.
+func (o *Particles2D) Preprocess() gdnative.Real {
+	return o.GetPreProcessTime()
+}
+
+// SetPreprocess will set the value of the "preprocess" property.
+func (o *Particles2D) SetPreprocess(value gdnative.Real) {
+	o.SetPreProcessTime(value)
+}
+
+// ProcessMaterial will return the value of the "process_material" property.
+func (o *Particles2D) ProcessMaterial() godot.MaterialImplementer {
+	return o.GetProcessMaterial()
+}
+
+// Randomness will return the value of the "randomness" property.
+func (o *Particles2D) Randomness() gdnative.Real {
+	return o.GetRandomnessRatio()
+}
+
+// SetRandomness will set the value of the "randomness" property.
+func (o *Particles2D) SetRandomness(value gdnative.Real) {
+	o.SetRandomnessRatio(value)
+}
+
+// SpeedScale will return the value of the "speed_scale" property.
+func (o *Particles2D) SpeedScale() gdnative.Real {
+	return o.GetSpeedScale()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *Particles2D) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// VFrames will return the value of the "v_frames" property.
+func (o *Particles2D) VFrames() gdnative.Int {
+	return o.GetVFrames()
+}
+
+// VisibilityRect will return the value of the "visibility_rect" property.
+func (o *Particles2D) VisibilityRect() gdnative.Rect2 {
+	return o.GetVisibilityRect()
+}
+
 // Particles2DImplementer is an interface that implements the methods
 // of the Particles2D class.
 type Particles2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	CaptureRect() gdnative.Rect2
 	GetAmount() gdnative.Int
+	GetDrawOrder() Particles2DDrawOrder
 	GetExplosivenessRatio() gdnative.Real
 	GetFixedFps() gdnative.Int
 	GetFractionalDelta() gdnative.Bool
 	GetHFrames() gdnative.Int
 	GetLifetime() gdnative.Real
-	GetNormalMap() TextureImplementer
+	GetNormalMap() godot.TextureImplementer
 	GetOneShot() gdnative.Bool
 	GetPreProcessTime() gdnative.Real
-	GetProcessMaterial() MaterialImplementer
+	GetProcessMaterial() godot.MaterialImplementer
 	GetRandomnessRatio() gdnative.Real
 	GetSpeedScale() gdnative.Real
-	GetTexture() TextureImplementer
+	GetTexture() godot.TextureImplementer
 	GetUseLocalCoordinates() gdnative.Bool
 	GetVFrames() gdnative.Int
 	GetVisibilityRect() gdnative.Rect2
 	IsEmitting() gdnative.Bool
 	Restart()
 	SetAmount(amount gdnative.Int)
-	SetDrawOrder(order gdnative.Int)
+	SetDrawOrder(order Particles2DDrawOrder)
 	SetEmitting(emitting gdnative.Bool)
 	SetExplosivenessRatio(ratio gdnative.Real)
 	SetFixedFps(fps gdnative.Int)
 	SetFractionalDelta(enable gdnative.Bool)
 	SetHFrames(frames gdnative.Int)
 	SetLifetime(secs gdnative.Real)
-	SetNormalMap(texture TextureImplementer)
+	SetNormalMap(texture godot.TextureImplementer)
 	SetOneShot(secs gdnative.Bool)
 	SetPreProcessTime(secs gdnative.Real)
-	SetProcessMaterial(material MaterialImplementer)
+	SetProcessMaterial(material godot.MaterialImplementer)
 	SetRandomnessRatio(ratio gdnative.Real)
 	SetSpeedScale(scale gdnative.Real)
-	SetTexture(texture TextureImplementer)
+	SetTexture(texture godot.TextureImplementer)
 	SetUseLocalCoordinates(enable gdnative.Bool)
 	SetVFrames(frames gdnative.Int)
 	SetVisibilityRect(aabb gdnative.Rect2)
+	Amount() gdnative.Int
+	DrawOrder() Particles2DDrawOrder
+	Emitting() gdnative.Bool
+	Explosiveness() gdnative.Real
+	SetExplosiveness(value gdnative.Real)
+	FixedFps() gdnative.Int
+	FractDelta() gdnative.Bool
+	SetFractDelta(value gdnative.Bool)
+	HFrames() gdnative.Int
+	Lifetime() gdnative.Real
+	LocalCoords() gdnative.Bool
+	SetLocalCoords(value gdnative.Bool)
+	NormalMap() godot.TextureImplementer
+	OneShot() gdnative.Bool
+	Preprocess() gdnative.Real
+	SetPreprocess(value gdnative.Real)
+	ProcessMaterial() godot.MaterialImplementer
+	Randomness() gdnative.Real
+	SetRandomness(value gdnative.Real)
+	SpeedScale() gdnative.Real
+	Texture() godot.TextureImplementer
+	VFrames() gdnative.Int
+	VisibilityRect() gdnative.Rect2
 }
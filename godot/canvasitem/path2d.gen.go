@@ -0,0 +1,141 @@
+package canvasitem
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Can have [PathFollow2D] child-nodes moving along the [Curve2D]. See [PathFollow2D] for more information on this usage.
+*/
+type Path2D struct {
+	godot.Node2D
+	owner gdnative.Object
+}
+
+// classConstructorPath2D is the class constructor of the Path2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPath2D = gdnative.NewLazyClassConstructor("Path2D")
+
+// NewPath2D will create a new instance of the Path2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPath2D() *Path2D {
+	obj := &Path2D{}
+	obj.SetBaseObject(classConstructorPath2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Path2D) BaseClass() string {
+	return "Path2D"
+}
+
+// Method binds of the Path2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPath2DX_CurveChanged = gdnative.NewLazyMethodBind("Path2D", "_curve_changed")
+	methodBindPath2DGetCurve       = gdnative.NewLazyMethodBind("Path2D", "get_curve")
+	methodBindPath2DSetCurve       = gdnative.NewLazyMethodBind("Path2D", "set_curve")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Path2D) X_CurveChanged() {
+	//log.Println("Calling Path2D.X_CurveChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPath2DX_CurveChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Curve2D
+*/
+func (o *Path2D) GetCurve() resource.Curve2DImplementer {
+	//log.Println("Calling Path2D.GetCurve()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPath2DGetCurve.Get()
+
+	// Call the parent method.
+	// Curve2D
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := resource.Curve2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.Curve2DImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false curve Curve2D}], Returns: void
+*/
+func (o *Path2D) SetCurve(curve resource.Curve2DImplementer) {
+	//log.Println("Calling Path2D.SetCurve()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(curve))
+
+	// Get the method bind
+	methodBind := methodBindPath2DSetCurve.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Curve will return the value of the "curve" property.
+func (o *Path2D) Curve() resource.Curve2DImplementer {
+	return o.GetCurve()
+}
+
+// Path2DImplementer is an interface that implements the methods
+// of the Path2D class.
+type Path2DImplementer interface {
+	godot.Node2DImplementer
+	X_CurveChanged()
+	GetCurve() resource.Curve2DImplementer
+	SetCurve(curve resource.Curve2DImplementer)
+	Curve() resource.Curve2DImplementer
+}
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,56 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPathFollow2DFromPointer(ptr gdnative.Pointer) PathFollow2D {
-func newPathFollow2DFromPointer(ptr gdnative.Pointer) PathFollow2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PathFollow2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This node takes its parent [Path2D], and returns the coordinates of a point within it, given a distance from the first vertex. It is useful for making other nodes follow a path, without coding the movement pattern. For that, the nodes must be descendants of this node. Then, when setting an offset in this node, the descendant nodes will move accordingly.
 */
 type PathFollow2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorPathFollow2D is the class constructor of the PathFollow2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPathFollow2D = gdnative.NewLazyClassConstructor("PathFollow2D")
+
+// NewPathFollow2D will create a new instance of the PathFollow2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPathFollow2D() *PathFollow2D {
+	obj := &PathFollow2D{}
+	obj.SetBaseObject(classConstructorPathFollow2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PathFollow2D) BaseClass() string {
 	return "PathFollow2D"
 }
 
+// Method binds of the PathFollow2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPathFollow2DGetCubicInterpolation = gdnative.NewLazyMethodBind("PathFollow2D", "get_cubic_interpolation")
+	methodBindPathFollow2DGetHOffset            = gdnative.NewLazyMethodBind("PathFollow2D", "get_h_offset")
+	methodBindPathFollow2DGetLookahead          = gdnative.NewLazyMethodBind("PathFollow2D", "get_lookahead")
+	methodBindPathFollow2DGetOffset             = gdnative.NewLazyMethodBind("PathFollow2D", "get_offset")
+	methodBindPathFollow2DGetUnitOffset         = gdnative.NewLazyMethodBind("PathFollow2D", "get_unit_offset")
+	methodBindPathFollow2DGetVOffset            = gdnative.NewLazyMethodBind("PathFollow2D", "get_v_offset")
+	methodBindPathFollow2DHasLoop               = gdnative.NewLazyMethodBind("PathFollow2D", "has_loop")
+	methodBindPathFollow2DIsRotating            = gdnative.NewLazyMethodBind("PathFollow2D", "is_rotating")
+	methodBindPathFollow2DSetCubicInterpolation = gdnative.NewLazyMethodBind("PathFollow2D", "set_cubic_interpolation")
+	methodBindPathFollow2DSetHOffset            = gdnative.NewLazyMethodBind("PathFollow2D", "set_h_offset")
+	methodBindPathFollow2DSetLookahead          = gdnative.NewLazyMethodBind("PathFollow2D", "set_lookahead")
+	methodBindPathFollow2DSetLoop               = gdnative.NewLazyMethodBind("PathFollow2D", "set_loop")
+	methodBindPathFollow2DSetOffset             = gdnative.NewLazyMethodBind("PathFollow2D", "set_offset")
+	methodBindPathFollow2DSetRotate             = gdnative.NewLazyMethodBind("PathFollow2D", "set_rotate")
+	methodBindPathFollow2DSetUnitOffset         = gdnative.NewLazyMethodBind("PathFollow2D", "set_unit_offset")
+	methodBindPathFollow2DSetVOffset            = gdnative.NewLazyMethodBind("PathFollow2D", "set_v_offset")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PathFollow2D) GetCubicInterpolation() gdnative.Bool {
 	//log.Println("Calling PathFollow2D.GetCubicInterpolation()")
@@ -45,7 +72,7 @@ func (o *PathFollow2D) GetCubicInterpolation() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "get_cubic_interpolation")
+	methodBind := methodBindPathFollow2DGetCubicInterpolation.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +85,8 @@ func (o *PathFollow2D) GetCubicInterpolation() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow2D) GetHOffset() gdnative.Real {
 	//log.Println("Calling PathFollow2D.GetHOffset()")
@@ -68,7 +95,7 @@ func (o *PathFollow2D) GetHOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "get_h_offset")
+	methodBind := methodBindPathFollow2DGetHOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +108,8 @@ func (o *PathFollow2D) GetHOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow2D) GetLookahead() gdnative.Real {
 	//log.Println("Calling PathFollow2D.GetLookahead()")
@@ -91,7 +118,7 @@ func (o *PathFollow2D) GetLookahead() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "get_lookahead")
+	methodBind := methodBindPathFollow2DGetLookahead.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +131,8 @@ func (o *PathFollow2D) GetLookahead() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow2D) GetOffset() gdnative.Real {
 	//log.Println("Calling PathFollow2D.GetOffset()")
@@ -114,7 +141,7 @@ func (o *PathFollow2D) GetOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "get_offset")
+	methodBind := methodBindPathFollow2DGetOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +154,8 @@ func (o *PathFollow2D) GetOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow2D) GetUnitOffset() gdnative.Real {
 	//log.Println("Calling PathFollow2D.GetUnitOffset()")
@@ -137,7 +164,7 @@ func (o *PathFollow2D) GetUnitOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "get_unit_offset")
+	methodBind := methodBindPathFollow2DGetUnitOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +177,8 @@ func (o *PathFollow2D) GetUnitOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow2D) GetVOffset() gdnative.Real {
 	//log.Println("Calling PathFollow2D.GetVOffset()")
@@ -160,7 +187,7 @@ func (o *PathFollow2D) GetVOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "get_v_offset")
+	methodBind := methodBindPathFollow2DGetVOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -173,8 +200,8 @@ func (o *PathFollow2D) GetVOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PathFollow2D) HasLoop() gdnative.Bool {
 	//log.Println("Calling PathFollow2D.HasLoop()")
@@ -183,7 +210,7 @@ func (o *PathFollow2D) HasLoop() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "has_loop")
+	methodBind := methodBindPathFollow2DHasLoop.Get()
 
 	// Call the parent method.
 	// bool
@@ -196,8 +223,8 @@ func (o *PathFollow2D) HasLoop() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PathFollow2D) IsRotating() gdnative.Bool {
 	//log.Println("Calling PathFollow2D.IsRotating()")
@@ -206,7 +233,7 @@ func (o *PathFollow2D) IsRotating() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "is_rotating")
+	methodBind := methodBindPathFollow2DIsRotating.Get()
 
 	// Call the parent method.
 	// bool
@@ -219,8 +246,8 @@ func (o *PathFollow2D) IsRotating() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *PathFollow2D) SetCubicInterpolation(enable gdnative.Bool) {
 	//log.Println("Calling PathFollow2D.SetCubicInterpolation()")
@@ -230,7 +257,7 @@ func (o *PathFollow2D) SetCubicInterpolation(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_cubic_interpolation")
+	methodBind := methodBindPathFollow2DSetCubicInterpolation.Get()
 
 	// Call the parent method.
 	// void
@@ -240,8 +267,8 @@ func (o *PathFollow2D) SetCubicInterpolation(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false h_offset float}], Returns: void
+	        Undocumented
+		Args: [{ false h_offset float}], Returns: void
 */
 func (o *PathFollow2D) SetHOffset(hOffset gdnative.Real) {
 	//log.Println("Calling PathFollow2D.SetHOffset()")
@@ -251,7 +278,7 @@ func (o *PathFollow2D) SetHOffset(hOffset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(hOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_h_offset")
+	methodBind := methodBindPathFollow2DSetHOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -261,8 +288,8 @@ func (o *PathFollow2D) SetHOffset(hOffset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false lookahead float}], Returns: void
+	        Undocumented
+		Args: [{ false lookahead float}], Returns: void
 */
 func (o *PathFollow2D) SetLookahead(lookahead gdnative.Real) {
 	//log.Println("Calling PathFollow2D.SetLookahead()")
@@ -272,7 +299,7 @@ func (o *PathFollow2D) SetLookahead(lookahead gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(lookahead)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_lookahead")
+	methodBind := methodBindPathFollow2DSetLookahead.Get()
 
 	// Call the parent method.
 	// void
@@ -282,8 +309,8 @@ func (o *PathFollow2D) SetLookahead(lookahead gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false loop bool}], Returns: void
+	        Undocumented
+		Args: [{ false loop bool}], Returns: void
 */
 func (o *PathFollow2D) SetLoop(loop gdnative.Bool) {
 	//log.Println("Calling PathFollow2D.SetLoop()")
@@ -293,7 +320,7 @@ func (o *PathFollow2D) SetLoop(loop gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(loop)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_loop")
+	methodBind := methodBindPathFollow2DSetLoop.Get()
 
 	// Call the parent method.
 	// void
@@ -303,8 +330,8 @@ func (o *PathFollow2D) SetLoop(loop gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset float}], Returns: void
+	        Undocumented
+		Args: [{ false offset float}], Returns: void
 */
 func (o *PathFollow2D) SetOffset(offset gdnative.Real) {
 	//log.Println("Calling PathFollow2D.SetOffset()")
@@ -314,7 +341,7 @@ func (o *PathFollow2D) SetOffset(offset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_offset")
+	methodBind := methodBindPathFollow2DSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -324,8 +351,8 @@ func (o *PathFollow2D) SetOffset(offset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *PathFollow2D) SetRotate(enable gdnative.Bool) {
 	//log.Println("Calling PathFollow2D.SetRotate()")
@@ -335,7 +362,7 @@ func (o *PathFollow2D) SetRotate(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_rotate")
+	methodBind := methodBindPathFollow2DSetRotate.Get()
 
 	// Call the parent method.
 	// void
@@ -345,8 +372,8 @@ func (o *PathFollow2D) SetRotate(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false unit_offset float}], Returns: void
+	        Undocumented
+		Args: [{ false unit_offset float}], Returns: void
 */
 func (o *PathFollow2D) SetUnitOffset(unitOffset gdnative.Real) {
 	//log.Println("Calling PathFollow2D.SetUnitOffset()")
@@ -356,7 +383,7 @@ func (o *PathFollow2D) SetUnitOffset(unitOffset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(unitOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_unit_offset")
+	methodBind := methodBindPathFollow2DSetUnitOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -366,8 +393,8 @@ func (o *PathFollow2D) SetUnitOffset(unitOffset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false v_offset float}], Returns: void
+	        Undocumented
+		Args: [{ false v_offset float}], Returns: void
 */
 func (o *PathFollow2D) SetVOffset(vOffset gdnative.Real) {
 	//log.Println("Calling PathFollow2D.SetVOffset()")
@@ -377,7 +404,7 @@ func (o *PathFollow2D) SetVOffset(vOffset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(vOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow2D", "set_v_offset")
+	methodBind := methodBindPathFollow2DSetVOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -386,10 +413,50 @@ func (o *PathFollow2D) SetVOffset(vOffset gdnative.Real) {
 
 }
 
+// CubicInterp will return the value of the "cubic_interp" property.
+func (o *PathFollow2D) CubicInterp() gdnative.Bool {
+	return o.GetCubicInterpolation()
+}
+
+// SetCubicInterp will set the value of the "cubic_interp" property.
+func (o *PathFollow2D) SetCubicInterp(value gdnative.Bool) {
+	o.SetCubicInterpolation(value)
+}
+
+// HOffset will return the value of the "h_offset" property.
+func (o *PathFollow2D) HOffset() gdnative.Real {
+	return o.GetHOffset()
+}
+
+// Lookahead will return the value of the "lookahead" property.
+func (o *PathFollow2D) Lookahead() gdnative.Real {
+	return o.GetLookahead()
+}
+
+// Loop will return the value of the "loop" property.
+func (o *PathFollow2D) Loop() gdnative.Bool {
+	return o.HasLoop()
+}
+
+// Offset will return the value of the "offset" property.
+func (o *PathFollow2D) Offset() gdnative.Real {
+	return o.GetOffset()
+}
+
+// UnitOffset will return the value of the "unit_offset" property.
+func (o *PathFollow2D) UnitOffset() gdnative.Real {
+	return o.GetUnitOffset()
+}
+
+// VOffset will return the value of the "v_offset" property.
+func (o *PathFollow2D) VOffset() gdnative.Real {
+	return o.GetVOffset()
+}
+
 // PathFollow2DImplementer is an interface that implements the methods
 // of the PathFollow2D class.
 type PathFollow2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetCubicInterpolation() gdnative.Bool
 	GetHOffset() gdnative.Real
 	GetLookahead() gdnative.Real
@@ -406,4 +473,12 @@ type PathFollow2DImplementer interface {
 	SetRotate(enable gdnative.Bool)
 	SetUnitOffset(unitOffset gdnative.Real)
 	SetVOffset(vOffset gdnative.Real)
+	CubicInterp() gdnative.Bool
+	SetCubicInterp(value gdnative.Bool)
+	HOffset() gdnative.Real
+	Lookahead() gdnative.Real
+	Loop() gdnative.Bool
+	Offset() gdnative.Real
+	UnitOffset() gdnative.Real
+	VOffset() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPinJoint2DFromPointer(ptr gdnative.Pointer) PinJoint2D {
-func newPinJoint2DFromPointer(ptr gdnative.Pointer) PinJoint2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PinJoint2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Pin Joint for 2D Rigid Bodies. It pins two bodies (rigid or static) together.
 */
@@ -30,13 +22,34 @@ type PinJoint2D struct {
 	owner gdnative.Object
 }
 
+// classConstructorPinJoint2D is the class constructor of the PinJoint2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPinJoint2D = gdnative.NewLazyClassConstructor("PinJoint2D")
+
+// NewPinJoint2D will create a new instance of the PinJoint2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPinJoint2D() *PinJoint2D {
+	obj := &PinJoint2D{}
+	obj.SetBaseObject(classConstructorPinJoint2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PinJoint2D) BaseClass() string {
 	return "PinJoint2D"
 }
 
+// Method binds of the PinJoint2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPinJoint2DGetSoftness = gdnative.NewLazyMethodBind("PinJoint2D", "get_softness")
+	methodBindPinJoint2DSetSoftness = gdnative.NewLazyMethodBind("PinJoint2D", "set_softness")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PinJoint2D) GetSoftness() gdnative.Real {
 	//log.Println("Calling PinJoint2D.GetSoftness()")
@@ -45,7 +58,7 @@ func (o *PinJoint2D) GetSoftness() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PinJoint2D", "get_softness")
+	methodBind := methodBindPinJoint2DGetSoftness.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +71,8 @@ func (o *PinJoint2D) GetSoftness() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false softness float}], Returns: void
+	        Undocumented
+		Args: [{ false softness float}], Returns: void
 */
 func (o *PinJoint2D) SetSoftness(softness gdnative.Real) {
 	//log.Println("Calling PinJoint2D.SetSoftness()")
@@ -69,7 +82,7 @@ func (o *PinJoint2D) SetSoftness(softness gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(softness)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PinJoint2D", "set_softness")
+	methodBind := methodBindPinJoint2DSetSoftness.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *PinJoint2D) SetSoftness(softness gdnative.Real) {
 
 }
 
+// Softness will return the value of the "softness" property.
+func (o *PinJoint2D) Softness() gdnative.Real {
+	return o.GetSoftness()
+}
+
 // PinJoint2DImplementer is an interface that implements the methods
 // of the PinJoint2D class.
 type PinJoint2DImplementer interface {
 	Joint2DImplementer
 	GetSoftness() gdnative.Real
 	SetSoftness(softness gdnative.Real)
+	Softness() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,66 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPolygon2DFromPointer(ptr gdnative.Pointer) Polygon2D {
-func newPolygon2DFromPointer(ptr gdnative.Pointer) Polygon2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Polygon2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A Polygon2D is defined by a set of points. Each point is connected to the next, with the final point being connected to the first, resulting in a closed polygon. Polygon2Ds can be filled with color (solid or gradient) or filled with a given texture.
 */
 type Polygon2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorPolygon2D is the class constructor of the Polygon2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPolygon2D = gdnative.NewLazyClassConstructor("Polygon2D")
+
+// NewPolygon2D will create a new instance of the Polygon2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPolygon2D() *Polygon2D {
+	obj := &Polygon2D{}
+	obj.SetBaseObject(classConstructorPolygon2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Polygon2D) BaseClass() string {
 	return "Polygon2D"
 }
 
+// Method binds of the Polygon2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPolygon2DGetAntialiased            = gdnative.NewLazyMethodBind("Polygon2D", "get_antialiased")
+	methodBindPolygon2DGetColor                  = gdnative.NewLazyMethodBind("Polygon2D", "get_color")
+	methodBindPolygon2DGetInvert                 = gdnative.NewLazyMethodBind("Polygon2D", "get_invert")
+	methodBindPolygon2DGetInvertBorder           = gdnative.NewLazyMethodBind("Polygon2D", "get_invert_border")
+	methodBindPolygon2DGetOffset                 = gdnative.NewLazyMethodBind("Polygon2D", "get_offset")
+	methodBindPolygon2DGetPolygon                = gdnative.NewLazyMethodBind("Polygon2D", "get_polygon")
+	methodBindPolygon2DGetTexture                = gdnative.NewLazyMethodBind("Polygon2D", "get_texture")
+	methodBindPolygon2DGetTextureOffset          = gdnative.NewLazyMethodBind("Polygon2D", "get_texture_offset")
+	methodBindPolygon2DGetTextureRotation        = gdnative.NewLazyMethodBind("Polygon2D", "get_texture_rotation")
+	methodBindPolygon2DGetTextureRotationDegrees = gdnative.NewLazyMethodBind("Polygon2D", "get_texture_rotation_degrees")
+	methodBindPolygon2DGetTextureScale           = gdnative.NewLazyMethodBind("Polygon2D", "get_texture_scale")
+	methodBindPolygon2DGetUv                     = gdnative.NewLazyMethodBind("Polygon2D", "get_uv")
+	methodBindPolygon2DGetVertexColors           = gdnative.NewLazyMethodBind("Polygon2D", "get_vertex_colors")
+	methodBindPolygon2DSetAntialiased            = gdnative.NewLazyMethodBind("Polygon2D", "set_antialiased")
+	methodBindPolygon2DSetColor                  = gdnative.NewLazyMethodBind("Polygon2D", "set_color")
+	methodBindPolygon2DSetInvert                 = gdnative.NewLazyMethodBind("Polygon2D", "set_invert")
+	methodBindPolygon2DSetInvertBorder           = gdnative.NewLazyMethodBind("Polygon2D", "set_invert_border")
+	methodBindPolygon2DSetOffset                 = gdnative.NewLazyMethodBind("Polygon2D", "set_offset")
+	methodBindPolygon2DSetPolygon                = gdnative.NewLazyMethodBind("Polygon2D", "set_polygon")
+	methodBindPolygon2DSetTexture                = gdnative.NewLazyMethodBind("Polygon2D", "set_texture")
+	methodBindPolygon2DSetTextureOffset          = gdnative.NewLazyMethodBind("Polygon2D", "set_texture_offset")
+	methodBindPolygon2DSetTextureRotation        = gdnative.NewLazyMethodBind("Polygon2D", "set_texture_rotation")
+	methodBindPolygon2DSetTextureRotationDegrees = gdnative.NewLazyMethodBind("Polygon2D", "set_texture_rotation_degrees")
+	methodBindPolygon2DSetTextureScale           = gdnative.NewLazyMethodBind("Polygon2D", "set_texture_scale")
+	methodBindPolygon2DSetUv                     = gdnative.NewLazyMethodBind("Polygon2D", "set_uv")
+	methodBindPolygon2DSetVertexColors           = gdnative.NewLazyMethodBind("Polygon2D", "set_vertex_colors")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Polygon2D) GetAntialiased() gdnative.Bool {
 	//log.Println("Calling Polygon2D.GetAntialiased()")
@@ -45,7 +82,7 @@ func (o *Polygon2D) GetAntialiased() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_antialiased")
+	methodBind := methodBindPolygon2DGetAntialiased.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +95,8 @@ func (o *Polygon2D) GetAntialiased() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Polygon2D) GetColor() gdnative.Color {
 	//log.Println("Calling Polygon2D.GetColor()")
@@ -68,7 +105,7 @@ func (o *Polygon2D) GetColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_color")
+	methodBind := methodBindPolygon2DGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -81,8 +118,8 @@ func (o *Polygon2D) GetColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Polygon2D) GetInvert() gdnative.Bool {
 	//log.Println("Calling Polygon2D.GetInvert()")
@@ -91,7 +128,7 @@ func (o *Polygon2D) GetInvert() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_invert")
+	methodBind := methodBindPolygon2DGetInvert.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +141,8 @@ func (o *Polygon2D) GetInvert() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Polygon2D) GetInvertBorder() gdnative.Real {
 	//log.Println("Calling Polygon2D.GetInvertBorder()")
@@ -114,7 +151,7 @@ func (o *Polygon2D) GetInvertBorder() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_invert_border")
+	methodBind := methodBindPolygon2DGetInvertBorder.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +164,8 @@ func (o *Polygon2D) GetInvertBorder() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Polygon2D) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling Polygon2D.GetOffset()")
@@ -137,7 +174,7 @@ func (o *Polygon2D) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_offset")
+	methodBind := methodBindPolygon2DGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -150,8 +187,8 @@ func (o *Polygon2D) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *Polygon2D) GetPolygon() gdnative.PoolVector2Array {
 	//log.Println("Calling Polygon2D.GetPolygon()")
@@ -160,7 +197,7 @@ func (o *Polygon2D) GetPolygon() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_polygon")
+	methodBind := methodBindPolygon2DGetPolygon.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -173,17 +210,17 @@ func (o *Polygon2D) GetPolygon() gdnative.PoolVector2Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Polygon2D) GetTexture() TextureImplementer {
+func (o *Polygon2D) GetTexture() godot.TextureImplementer {
 	//log.Println("Calling Polygon2D.GetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_texture")
+	methodBind := methodBindPolygon2DGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -191,27 +228,25 @@ func (o *Polygon2D) GetTexture() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Polygon2D) GetTextureOffset() gdnative.Vector2 {
 	//log.Println("Calling Polygon2D.GetTextureOffset()")
@@ -220,7 +255,7 @@ func (o *Polygon2D) GetTextureOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_texture_offset")
+	methodBind := methodBindPolygon2DGetTextureOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -233,8 +268,8 @@ func (o *Polygon2D) GetTextureOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Polygon2D) GetTextureRotation() gdnative.Real {
 	//log.Println("Calling Polygon2D.GetTextureRotation()")
@@ -243,7 +278,7 @@ func (o *Polygon2D) GetTextureRotation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_texture_rotation")
+	methodBind := methodBindPolygon2DGetTextureRotation.Get()
 
 	// Call the parent method.
 	// float
@@ -256,8 +291,8 @@ func (o *Polygon2D) GetTextureRotation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Polygon2D) GetTextureRotationDegrees() gdnative.Real {
 	//log.Println("Calling Polygon2D.GetTextureRotationDegrees()")
@@ -266,7 +301,7 @@ func (o *Polygon2D) GetTextureRotationDegrees() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_texture_rotation_degrees")
+	methodBind := methodBindPolygon2DGetTextureRotationDegrees.Get()
 
 	// Call the parent method.
 	// float
@@ -279,8 +314,8 @@ func (o *Polygon2D) GetTextureRotationDegrees() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Polygon2D) GetTextureScale() gdnative.Vector2 {
 	//log.Println("Calling Polygon2D.GetTextureScale()")
@@ -289,7 +324,7 @@ func (o *Polygon2D) GetTextureScale() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_texture_scale")
+	methodBind := methodBindPolygon2DGetTextureScale.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -302,8 +337,8 @@ func (o *Polygon2D) GetTextureScale() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *Polygon2D) GetUv() gdnative.PoolVector2Array {
 	//log.Println("Calling Polygon2D.GetUv()")
@@ -312,7 +347,7 @@ func (o *Polygon2D) GetUv() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_uv")
+	methodBind := methodBindPolygon2DGetUv.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -325,8 +360,8 @@ func (o *Polygon2D) GetUv() gdnative.PoolVector2Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolColorArray
+	        Undocumented
+		Args: [], Returns: PoolColorArray
 */
 func (o *Polygon2D) GetVertexColors() gdnative.PoolColorArray {
 	//log.Println("Calling Polygon2D.GetVertexColors()")
@@ -335,7 +370,7 @@ func (o *Polygon2D) GetVertexColors() gdnative.PoolColorArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "get_vertex_colors")
+	methodBind := methodBindPolygon2DGetVertexColors.Get()
 
 	// Call the parent method.
 	// PoolColorArray
@@ -348,8 +383,8 @@ func (o *Polygon2D) GetVertexColors() gdnative.PoolColorArray {
 }
 
 /*
-        Undocumented
-	Args: [{ false antialiased bool}], Returns: void
+	        Undocumented
+		Args: [{ false antialiased bool}], Returns: void
 */
 func (o *Polygon2D) SetAntialiased(antialiased gdnative.Bool) {
 	//log.Println("Calling Polygon2D.SetAntialiased()")
@@ -359,7 +394,7 @@ func (o *Polygon2D) SetAntialiased(antialiased gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_antialiased")
+	methodBind := methodBindPolygon2DSetAntialiased.Get()
 
 	// Call the parent method.
 	// void
@@ -369,8 +404,8 @@ func (o *Polygon2D) SetAntialiased(antialiased gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Polygon2D) SetColor(color gdnative.Color) {
 	//log.Println("Calling Polygon2D.SetColor()")
@@ -380,7 +415,7 @@ func (o *Polygon2D) SetColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_color")
+	methodBind := methodBindPolygon2DSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -390,8 +425,8 @@ func (o *Polygon2D) SetColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false invert bool}], Returns: void
+	        Undocumented
+		Args: [{ false invert bool}], Returns: void
 */
 func (o *Polygon2D) SetInvert(invert gdnative.Bool) {
 	//log.Println("Calling Polygon2D.SetInvert()")
@@ -401,7 +436,7 @@ func (o *Polygon2D) SetInvert(invert gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(invert)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_invert")
+	methodBind := methodBindPolygon2DSetInvert.Get()
 
 	// Call the parent method.
 	// void
@@ -411,8 +446,8 @@ func (o *Polygon2D) SetInvert(invert gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false invert_border float}], Returns: void
+	        Undocumented
+		Args: [{ false invert_border float}], Returns: void
 */
 func (o *Polygon2D) SetInvertBorder(invertBorder gdnative.Real) {
 	//log.Println("Calling Polygon2D.SetInvertBorder()")
@@ -422,7 +457,7 @@ func (o *Polygon2D) SetInvertBorder(invertBorder gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(invertBorder)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_invert_border")
+	methodBind := methodBindPolygon2DSetInvertBorder.Get()
 
 	// Call the parent method.
 	// void
@@ -432,8 +467,8 @@ func (o *Polygon2D) SetInvertBorder(invertBorder gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *Polygon2D) SetOffset(offset gdnative.Vector2) {
 	//log.Println("Calling Polygon2D.SetOffset()")
@@ -443,7 +478,7 @@ func (o *Polygon2D) SetOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_offset")
+	methodBind := methodBindPolygon2DSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -453,8 +488,8 @@ func (o *Polygon2D) SetOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false polygon PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false polygon PoolVector2Array}], Returns: void
 */
 func (o *Polygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 	//log.Println("Calling Polygon2D.SetPolygon()")
@@ -464,7 +499,7 @@ func (o *Polygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_polygon")
+	methodBind := methodBindPolygon2DSetPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -474,18 +509,18 @@ func (o *Polygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Polygon2D) SetTexture(texture TextureImplementer) {
+func (o *Polygon2D) SetTexture(texture godot.TextureImplementer) {
 	//log.Println("Calling Polygon2D.SetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_texture")
+	methodBind := methodBindPolygon2DSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -495,8 +530,8 @@ func (o *Polygon2D) SetTexture(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture_offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false texture_offset Vector2}], Returns: void
 */
 func (o *Polygon2D) SetTextureOffset(textureOffset gdnative.Vector2) {
 	//log.Println("Calling Polygon2D.SetTextureOffset()")
@@ -506,7 +541,7 @@ func (o *Polygon2D) SetTextureOffset(textureOffset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(textureOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_texture_offset")
+	methodBind := methodBindPolygon2DSetTextureOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -516,8 +551,8 @@ func (o *Polygon2D) SetTextureOffset(textureOffset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture_rotation float}], Returns: void
+	        Undocumented
+		Args: [{ false texture_rotation float}], Returns: void
 */
 func (o *Polygon2D) SetTextureRotation(textureRotation gdnative.Real) {
 	//log.Println("Calling Polygon2D.SetTextureRotation()")
@@ -527,7 +562,7 @@ func (o *Polygon2D) SetTextureRotation(textureRotation gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(textureRotation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_texture_rotation")
+	methodBind := methodBindPolygon2DSetTextureRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -537,8 +572,8 @@ func (o *Polygon2D) SetTextureRotation(textureRotation gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture_rotation float}], Returns: void
+	        Undocumented
+		Args: [{ false texture_rotation float}], Returns: void
 */
 func (o *Polygon2D) SetTextureRotationDegrees(textureRotation gdnative.Real) {
 	//log.Println("Calling Polygon2D.SetTextureRotationDegrees()")
@@ -548,7 +583,7 @@ func (o *Polygon2D) SetTextureRotationDegrees(textureRotation gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(textureRotation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_texture_rotation_degrees")
+	methodBind := methodBindPolygon2DSetTextureRotationDegrees.Get()
 
 	// Call the parent method.
 	// void
@@ -558,8 +593,8 @@ func (o *Polygon2D) SetTextureRotationDegrees(textureRotation gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture_scale Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false texture_scale Vector2}], Returns: void
 */
 func (o *Polygon2D) SetTextureScale(textureScale gdnative.Vector2) {
 	//log.Println("Calling Polygon2D.SetTextureScale()")
@@ -569,7 +604,7 @@ func (o *Polygon2D) SetTextureScale(textureScale gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(textureScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_texture_scale")
+	methodBind := methodBindPolygon2DSetTextureScale.Get()
 
 	// Call the parent method.
 	// void
@@ -579,8 +614,8 @@ func (o *Polygon2D) SetTextureScale(textureScale gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false uv PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false uv PoolVector2Array}], Returns: void
 */
 func (o *Polygon2D) SetUv(uv gdnative.PoolVector2Array) {
 	//log.Println("Calling Polygon2D.SetUv()")
@@ -590,7 +625,7 @@ func (o *Polygon2D) SetUv(uv gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(uv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_uv")
+	methodBind := methodBindPolygon2DSetUv.Get()
 
 	// Call the parent method.
 	// void
@@ -600,8 +635,8 @@ func (o *Polygon2D) SetUv(uv gdnative.PoolVector2Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vertex_colors PoolColorArray}], Returns: void
+	        Undocumented
+		Args: [{ false vertex_colors PoolColorArray}], Returns: void
 */
 func (o *Polygon2D) SetVertexColors(vertexColors gdnative.PoolColorArray) {
 	//log.Println("Calling Polygon2D.SetVertexColors()")
@@ -611,7 +646,7 @@ func (o *Polygon2D) SetVertexColors(vertexColors gdnative.PoolColorArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolColorArray(vertexColors)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Polygon2D", "set_vertex_colors")
+	methodBind := methodBindPolygon2DSetVertexColors.Get()
 
 	// Call the parent method.
 	// void
@@ -620,17 +655,87 @@ func (o *Polygon2D) SetVertexColors(vertexColors gdnative.PoolColorArray) {
 
 }
 
+// Antialiased will return the value of the "antialiased" property.
+func (o *Polygon2D) Antialiased() gdnative.Bool {
+	return o.GetAntialiased()
+}
+
+// Color will return the value of the "color" property.
+func (o *Polygon2D) Color() gdnative.Color {
+	return o.GetColor()
+}
+
+// InvertBorder will return the value of the "invert_border" property.
+func (o *Polygon2D) InvertBorder() gdnative.Real {
+	return o.GetInvertBorder()
+}
+
+// InvertEnable will return the value of the "invert_enable" property.
+func (o *Polygon2D) InvertEnable() gdnative.Bool {
+	return o.GetInvert()
+}
+
+// SetInvertEnable will set the value of the "invert_enable" property.
+func (o *Polygon2D) SetInvertEnable(value gdnative.Bool) {
+	o.SetInvert(value)
+}
+
+// Offset will return the value of the "offset" property.
+func (o *Polygon2D) Offset() gdnative.Vector2 {
+	return o.GetOffset()
+}
+
+// Polygon will return the value of the "polygon" property.
+func (o *Polygon2D) Polygon() gdnative.PoolVector2Array {
+	return o.GetPolygon()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *Polygon2D) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// TextureOffset will return the value of the "texture_offset" property.
+func (o *Polygon2D) TextureOffset() gdnative.Vector2 {
+	return o.GetTextureOffset()
+}
+
+// TextureRotation will return the value of the "texture_rotation" property.
+func (o *Polygon2D) TextureRotation() gdnative.Real {
+	return o.GetTextureRotation()
+}
+
+// TextureRotationDegrees will return the value of the "texture_rotation_degrees" property.
+func (o *Polygon2D) TextureRotationDegrees() gdnative.Real {
+	return o.GetTextureRotationDegrees()
+}
+
+// TextureScale will return the value of the "texture_scale" property.
+func (o *Polygon2D) TextureScale() gdnative.Vector2 {
+	return o.GetTextureScale()
+}
+
+// Uv will return the value of the "uv" property.
+func (o *Polygon2D) Uv() gdnative.PoolVector2Array {
+	return o.GetUv()
+}
+
+// VertexColors will return the value of the "vertex_colors" property.
+func (o *Polygon2D) VertexColors() gdnative.PoolColorArray {
+	return o.GetVertexColors()
+}
+
 // Polygon2DImplementer is an interface that implements the methods
 // of the Polygon2D class.
 type Polygon2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetAntialiased() gdnative.Bool
 	GetColor() gdnative.Color
 	GetInvert() gdnative.Bool
 	GetInvertBorder() gdnative.Real
 	GetOffset() gdnative.Vector2
 	GetPolygon() gdnative.PoolVector2Array
-	GetTexture() TextureImplementer
+	GetTexture() godot.TextureImplementer
 	GetTextureOffset() gdnative.Vector2
 	GetTextureRotation() gdnative.Real
 	GetTextureRotationDegrees() gdnative.Real
@@ -643,11 +748,25 @@ type Polygon2DImplementer interface {
 	SetInvertBorder(invertBorder gdnative.Real)
 	SetOffset(offset gdnative.Vector2)
 	SetPolygon(polygon gdnative.PoolVector2Array)
-	SetTexture(texture TextureImplementer)
+	SetTexture(texture godot.TextureImplementer)
 	SetTextureOffset(textureOffset gdnative.Vector2)
 	SetTextureRotation(textureRotation gdnative.Real)
 	SetTextureRotationDegrees(textureRotation gdnative.Real)
 	SetTextureScale(textureScale gdnative.Vector2)
 	SetUv(uv gdnative.PoolVector2Array)
 	SetVertexColors(vertexColors gdnative.PoolColorArray)
+	Antialiased() gdnative.Bool
+	Color() gdnative.Color
+	InvertBorder() gdnative.Real
+	InvertEnable() gdnative.Bool
+	SetInvertEnable(value gdnative.Bool)
+	Offset() gdnative.Vector2
+	Polygon() gdnative.PoolVector2Array
+	Texture() godot.TextureImplementer
+	TextureOffset() gdnative.Vector2
+	TextureRotation() gdnative.Real
+	TextureRotationDegrees() gdnative.Real
+	TextureScale() gdnative.Vector2
+	Uv() gdnative.PoolVector2Array
+	VertexColors() gdnative.PoolColorArray
 }
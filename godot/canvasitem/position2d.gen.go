@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,28 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPosition2DFromPointer(ptr gdnative.Pointer) Position2D {
-func newPosition2DFromPointer(ptr gdnative.Pointer) Position2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Position2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Generic 2D Position hint for editing. It's just like a plain [Node2D] but displays as a cross in the 2D-Editor at all times.
 */
 type Position2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorPosition2D is the class constructor of the Position2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPosition2D = gdnative.NewLazyClassConstructor("Position2D")
+
+// NewPosition2D will create a new instance of the Position2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPosition2D() *Position2D {
+	obj := &Position2D{}
+	obj.SetBaseObject(classConstructorPosition2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Position2D) BaseClass() string {
 	return "Position2D"
 }
@@ -37,5 +43,5 @@ func (o *Position2D) BaseClass() string {
 // Position2DImplementer is an interface that implements the methods
 // of the Position2D class.
 type Position2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 }
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,40 +14,71 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRayCast2DFromPointer(ptr gdnative.Pointer) RayCast2D {
-func newRayCast2DFromPointer(ptr gdnative.Pointer) RayCast2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RayCast2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A RayCast represents a line from its origin to its destination position, [code]cast_to[/code]. It is used to query the 2D space in order to find the closest object along the path of the ray. RayCast2D can ignore some objects by adding them to the exception list via [code]add_exception[/code], by setting proper filtering with collision layers, or by filtering object types with type masks. Only enabled raycasts will be able to query the space and report collisions. RayCast2D calculates intersection every physics frame (see [Node]), and the result is cached so it can be used later until the next frame. If multiple queries are required between physics frames (or during the same frame) use [method force_raycast_update] after adjusting the raycast.
 */
 type RayCast2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorRayCast2D is the class constructor of the RayCast2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRayCast2D = gdnative.NewLazyClassConstructor("RayCast2D")
+
+// NewRayCast2D will create a new instance of the RayCast2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewRayCast2D() *RayCast2D {
+	obj := &RayCast2D{}
+	obj.SetBaseObject(classConstructorRayCast2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RayCast2D) BaseClass() string {
 	return "RayCast2D"
 }
 
+// Method binds of the RayCast2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRayCast2DAddException         = gdnative.NewLazyMethodBind("RayCast2D", "add_exception")
+	methodBindRayCast2DAddExceptionRid      = gdnative.NewLazyMethodBind("RayCast2D", "add_exception_rid")
+	methodBindRayCast2DClearExceptions      = gdnative.NewLazyMethodBind("RayCast2D", "clear_exceptions")
+	methodBindRayCast2DForceRaycastUpdate   = gdnative.NewLazyMethodBind("RayCast2D", "force_raycast_update")
+	methodBindRayCast2DGetCastTo            = gdnative.NewLazyMethodBind("RayCast2D", "get_cast_to")
+	methodBindRayCast2DGetCollider          = gdnative.NewLazyMethodBind("RayCast2D", "get_collider")
+	methodBindRayCast2DGetColliderShape     = gdnative.NewLazyMethodBind("RayCast2D", "get_collider_shape")
+	methodBindRayCast2DGetCollisionMask     = gdnative.NewLazyMethodBind("RayCast2D", "get_collision_mask")
+	methodBindRayCast2DGetCollisionMaskBit  = gdnative.NewLazyMethodBind("RayCast2D", "get_collision_mask_bit")
+	methodBindRayCast2DGetCollisionNormal   = gdnative.NewLazyMethodBind("RayCast2D", "get_collision_normal")
+	methodBindRayCast2DGetCollisionPoint    = gdnative.NewLazyMethodBind("RayCast2D", "get_collision_point")
+	methodBindRayCast2DGetExcludeParentBody = gdnative.NewLazyMethodBind("RayCast2D", "get_exclude_parent_body")
+	methodBindRayCast2DIsColliding          = gdnative.NewLazyMethodBind("RayCast2D", "is_colliding")
+	methodBindRayCast2DIsEnabled            = gdnative.NewLazyMethodBind("RayCast2D", "is_enabled")
+	methodBindRayCast2DRemoveException      = gdnative.NewLazyMethodBind("RayCast2D", "remove_exception")
+	methodBindRayCast2DRemoveExceptionRid   = gdnative.NewLazyMethodBind("RayCast2D", "remove_exception_rid")
+	methodBindRayCast2DSetCastTo            = gdnative.NewLazyMethodBind("RayCast2D", "set_cast_to")
+	methodBindRayCast2DSetCollisionMask     = gdnative.NewLazyMethodBind("RayCast2D", "set_collision_mask")
+	methodBindRayCast2DSetCollisionMaskBit  = gdnative.NewLazyMethodBind("RayCast2D", "set_collision_mask_bit")
+	methodBindRayCast2DSetEnabled           = gdnative.NewLazyMethodBind("RayCast2D", "set_enabled")
+	methodBindRayCast2DSetExcludeParentBody = gdnative.NewLazyMethodBind("RayCast2D", "set_exclude_parent_body")
+)
+
 /*
-        Adds a collision exception so the ray does not report collisions with the specified node.
-	Args: [{ false node Object}], Returns: void
+	        Adds a collision exception so the ray does not report collisions with the specified node.
+		Args: [{ false node Object}], Returns: void
 */
-func (o *RayCast2D) AddException(node ObjectImplementer) {
+func (o *RayCast2D) AddException(node godot.ObjectImplementer) {
 	//log.Println("Calling RayCast2D.AddException()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "add_exception")
+	methodBind := methodBindRayCast2DAddException.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +88,8 @@ func (o *RayCast2D) AddException(node ObjectImplementer) {
 }
 
 /*
-        Adds a collision exception so the ray does not report collisions with the specified [RID].
-	Args: [{ false rid RID}], Returns: void
+	        Adds a collision exception so the ray does not report collisions with the specified [RID].
+		Args: [{ false rid RID}], Returns: void
 */
 func (o *RayCast2D) AddExceptionRid(rid gdnative.Rid) {
 	//log.Println("Calling RayCast2D.AddExceptionRid()")
@@ -67,7 +99,7 @@ func (o *RayCast2D) AddExceptionRid(rid gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "add_exception_rid")
+	methodBind := methodBindRayCast2DAddExceptionRid.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +109,8 @@ func (o *RayCast2D) AddExceptionRid(rid gdnative.Rid) {
 }
 
 /*
-        Removes all collision exceptions for this ray.
-	Args: [], Returns: void
+	        Removes all collision exceptions for this ray.
+		Args: [], Returns: void
 */
 func (o *RayCast2D) ClearExceptions() {
 	//log.Println("Calling RayCast2D.ClearExceptions()")
@@ -87,7 +119,7 @@ func (o *RayCast2D) ClearExceptions() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "clear_exceptions")
+	methodBind := methodBindRayCast2DClearExceptions.Get()
 
 	// Call the parent method.
 	// void
@@ -97,8 +129,8 @@ func (o *RayCast2D) ClearExceptions() {
 }
 
 /*
-        Updates the collision information for the ray. Use this method to update the collision information immediately instead of waiting for the next [code]_physics_process[/code] call, for example if the ray or its parent has changed state. Note: [code]enabled == true[/code] is not required for this to work.
-	Args: [], Returns: void
+	        Updates the collision information for the ray. Use this method to update the collision information immediately instead of waiting for the next [code]_physics_process[/code] call, for example if the ray or its parent has changed state. Note: [code]enabled == true[/code] is not required for this to work.
+		Args: [], Returns: void
 */
 func (o *RayCast2D) ForceRaycastUpdate() {
 	//log.Println("Calling RayCast2D.ForceRaycastUpdate()")
@@ -107,7 +139,7 @@ func (o *RayCast2D) ForceRaycastUpdate() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "force_raycast_update")
+	methodBind := methodBindRayCast2DForceRaycastUpdate.Get()
 
 	// Call the parent method.
 	// void
@@ -117,8 +149,8 @@ func (o *RayCast2D) ForceRaycastUpdate() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *RayCast2D) GetCastTo() gdnative.Vector2 {
 	//log.Println("Calling RayCast2D.GetCastTo()")
@@ -127,7 +159,7 @@ func (o *RayCast2D) GetCastTo() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_cast_to")
+	methodBind := methodBindRayCast2DGetCastTo.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -140,17 +172,17 @@ func (o *RayCast2D) GetCastTo() gdnative.Vector2 {
 }
 
 /*
-        Returns the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast2D.is_colliding(): var collider = RayCast2D.get_collider() [/codeblock]
-	Args: [], Returns: Object
+	        Returns the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast2D.is_colliding(): var collider = RayCast2D.get_collider() [/codeblock]
+		Args: [], Returns: Object
 */
-func (o *RayCast2D) GetCollider() ObjectImplementer {
+func (o *RayCast2D) GetCollider() godot.ObjectImplementer {
 	//log.Println("Calling RayCast2D.GetCollider()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_collider")
+	methodBind := methodBindRayCast2DGetCollider.Get()
 
 	// Call the parent method.
 	// Object
@@ -158,27 +190,25 @@ func (o *RayCast2D) GetCollider() ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the collision shape of the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast2D.is_colliding(): var shape = RayCast2D.get_collider_shape() [/codeblock]
-	Args: [], Returns: int
+	        Returns the collision shape of the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast2D.is_colliding(): var shape = RayCast2D.get_collider_shape() [/codeblock]
+		Args: [], Returns: int
 */
 func (o *RayCast2D) GetColliderShape() gdnative.Int {
 	//log.Println("Calling RayCast2D.GetColliderShape()")
@@ -187,7 +217,7 @@ func (o *RayCast2D) GetColliderShape() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_collider_shape")
+	methodBind := methodBindRayCast2DGetColliderShape.Get()
 
 	// Call the parent method.
 	// int
@@ -200,8 +230,8 @@ func (o *RayCast2D) GetColliderShape() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *RayCast2D) GetCollisionMask() gdnative.Int {
 	//log.Println("Calling RayCast2D.GetCollisionMask()")
@@ -210,7 +240,7 @@ func (o *RayCast2D) GetCollisionMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_collision_mask")
+	methodBind := methodBindRayCast2DGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -223,8 +253,8 @@ func (o *RayCast2D) GetCollisionMask() gdnative.Int {
 }
 
 /*
-        Return an individual bit on the collision mask.
-	Args: [{ false bit int}], Returns: bool
+	        Return an individual bit on the collision mask.
+		Args: [{ false bit int}], Returns: bool
 */
 func (o *RayCast2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling RayCast2D.GetCollisionMaskBit()")
@@ -234,7 +264,7 @@ func (o *RayCast2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_collision_mask_bit")
+	methodBind := methodBindRayCast2DGetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -247,8 +277,8 @@ func (o *RayCast2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns the normal of the intersecting object's shape at the collision point.
-	Args: [], Returns: Vector2
+	        Returns the normal of the intersecting object's shape at the collision point.
+		Args: [], Returns: Vector2
 */
 func (o *RayCast2D) GetCollisionNormal() gdnative.Vector2 {
 	//log.Println("Calling RayCast2D.GetCollisionNormal()")
@@ -257,7 +287,7 @@ func (o *RayCast2D) GetCollisionNormal() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_collision_normal")
+	methodBind := methodBindRayCast2DGetCollisionNormal.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -270,8 +300,8 @@ func (o *RayCast2D) GetCollisionNormal() gdnative.Vector2 {
 }
 
 /*
-        Returns the collision point at which the ray intersects the closest object. Note: this point is in the [b]global[/b] coordinate system.
-	Args: [], Returns: Vector2
+	        Returns the collision point at which the ray intersects the closest object. Note: this point is in the [b]global[/b] coordinate system.
+		Args: [], Returns: Vector2
 */
 func (o *RayCast2D) GetCollisionPoint() gdnative.Vector2 {
 	//log.Println("Calling RayCast2D.GetCollisionPoint()")
@@ -280,7 +310,7 @@ func (o *RayCast2D) GetCollisionPoint() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_collision_point")
+	methodBind := methodBindRayCast2DGetCollisionPoint.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -293,8 +323,8 @@ func (o *RayCast2D) GetCollisionPoint() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RayCast2D) GetExcludeParentBody() gdnative.Bool {
 	//log.Println("Calling RayCast2D.GetExcludeParentBody()")
@@ -303,7 +333,7 @@ func (o *RayCast2D) GetExcludeParentBody() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "get_exclude_parent_body")
+	methodBind := methodBindRayCast2DGetExcludeParentBody.Get()
 
 	// Call the parent method.
 	// bool
@@ -316,8 +346,8 @@ func (o *RayCast2D) GetExcludeParentBody() gdnative.Bool {
 }
 
 /*
-        Return whether the closest object the ray is pointing to is colliding with the vector (considering the vector length).
-	Args: [], Returns: bool
+	        Return whether the closest object the ray is pointing to is colliding with the vector (considering the vector length).
+		Args: [], Returns: bool
 */
 func (o *RayCast2D) IsColliding() gdnative.Bool {
 	//log.Println("Calling RayCast2D.IsColliding()")
@@ -326,7 +356,7 @@ func (o *RayCast2D) IsColliding() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "is_colliding")
+	methodBind := methodBindRayCast2DIsColliding.Get()
 
 	// Call the parent method.
 	// bool
@@ -339,8 +369,8 @@ func (o *RayCast2D) IsColliding() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RayCast2D) IsEnabled() gdnative.Bool {
 	//log.Println("Calling RayCast2D.IsEnabled()")
@@ -349,7 +379,7 @@ func (o *RayCast2D) IsEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "is_enabled")
+	methodBind := methodBindRayCast2DIsEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -362,18 +392,18 @@ func (o *RayCast2D) IsEnabled() gdnative.Bool {
 }
 
 /*
-        Removes a collision exception so the ray does report collisions with the specified node.
-	Args: [{ false node Object}], Returns: void
+	        Removes a collision exception so the ray does report collisions with the specified node.
+		Args: [{ false node Object}], Returns: void
 */
-func (o *RayCast2D) RemoveException(node ObjectImplementer) {
+func (o *RayCast2D) RemoveException(node godot.ObjectImplementer) {
 	//log.Println("Calling RayCast2D.RemoveException()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "remove_exception")
+	methodBind := methodBindRayCast2DRemoveException.Get()
 
 	// Call the parent method.
 	// void
@@ -383,8 +413,8 @@ func (o *RayCast2D) RemoveException(node ObjectImplementer) {
 }
 
 /*
-        Removes a collision exception so the ray does report collisions with the specified [RID].
-	Args: [{ false rid RID}], Returns: void
+	        Removes a collision exception so the ray does report collisions with the specified [RID].
+		Args: [{ false rid RID}], Returns: void
 */
 func (o *RayCast2D) RemoveExceptionRid(rid gdnative.Rid) {
 	//log.Println("Calling RayCast2D.RemoveExceptionRid()")
@@ -394,7 +424,7 @@ func (o *RayCast2D) RemoveExceptionRid(rid gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "remove_exception_rid")
+	methodBind := methodBindRayCast2DRemoveExceptionRid.Get()
 
 	// Call the parent method.
 	// void
@@ -404,8 +434,8 @@ func (o *RayCast2D) RemoveExceptionRid(rid gdnative.Rid) {
 }
 
 /*
-        Undocumented
-	Args: [{ false local_point Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false local_point Vector2}], Returns: void
 */
 func (o *RayCast2D) SetCastTo(localPoint gdnative.Vector2) {
 	//log.Println("Calling RayCast2D.SetCastTo()")
@@ -415,7 +445,7 @@ func (o *RayCast2D) SetCastTo(localPoint gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(localPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "set_cast_to")
+	methodBind := methodBindRayCast2DSetCastTo.Get()
 
 	// Call the parent method.
 	// void
@@ -425,8 +455,8 @@ func (o *RayCast2D) SetCastTo(localPoint gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *RayCast2D) SetCollisionMask(mask gdnative.Int) {
 	//log.Println("Calling RayCast2D.SetCollisionMask()")
@@ -436,7 +466,7 @@ func (o *RayCast2D) SetCollisionMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "set_collision_mask")
+	methodBind := methodBindRayCast2DSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -446,8 +476,8 @@ func (o *RayCast2D) SetCollisionMask(mask gdnative.Int) {
 }
 
 /*
-        Set/clear individual bits on the collision mask. This makes selecting the areas scanned easier.
-	Args: [{ false bit int} { false value bool}], Returns: void
+	        Set/clear individual bits on the collision mask. This makes selecting the areas scanned easier.
+		Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *RayCast2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling RayCast2D.SetCollisionMaskBit()")
@@ -458,7 +488,7 @@ func (o *RayCast2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "set_collision_mask_bit")
+	methodBind := methodBindRayCast2DSetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// void
@@ -468,8 +498,8 @@ func (o *RayCast2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *RayCast2D) SetEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling RayCast2D.SetEnabled()")
@@ -479,7 +509,7 @@ func (o *RayCast2D) SetEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "set_enabled")
+	methodBind := methodBindRayCast2DSetEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -489,8 +519,8 @@ func (o *RayCast2D) SetEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask bool}], Returns: void
+	        Undocumented
+		Args: [{ false mask bool}], Returns: void
 */
 func (o *RayCast2D) SetExcludeParentBody(mask gdnative.Bool) {
 	//log.Println("Calling RayCast2D.SetExcludeParentBody()")
@@ -500,7 +530,7 @@ func (o *RayCast2D) SetExcludeParentBody(mask gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast2D", "set_exclude_parent_body")
+	methodBind := methodBindRayCast2DSetExcludeParentBody.Get()
 
 	// Call the parent method.
 	// void
@@ -509,16 +539,41 @@ func (o *RayCast2D) SetExcludeParentBody(mask gdnative.Bool) {
 
 }
 
+// CastTo will return the value of the "cast_to" property.
+func (o *RayCast2D) CastTo() gdnative.Vector2 {
+	return o.GetCastTo()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *RayCast2D) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// Enabled will return the value of the "enabled" property.
+func (o *RayCast2D) Enabled() gdnative.Bool {
+	return o.IsEnabled()
+}
+
+// ExcludeParent will return the value of the "exclude_parent" property.
+func (o *RayCast2D) ExcludeParent() gdnative.Bool {
+	return o.GetExcludeParentBody()
+}
+
+// SetExcludeParent will set the value of the "exclude_parent" property.
+func (o *RayCast2D) SetExcludeParent(value gdnative.Bool) {
+	o.SetExcludeParentBody(value)
+}
+
 // RayCast2DImplementer is an interface that implements the methods
 // of the RayCast2D class.
 type RayCast2DImplementer interface {
-	Node2DImplementer
-	AddException(node ObjectImplementer)
+	godot.Node2DImplementer
+	AddException(node godot.ObjectImplementer)
 	AddExceptionRid(rid gdnative.Rid)
 	ClearExceptions()
 	ForceRaycastUpdate()
 	GetCastTo() gdnative.Vector2
-	GetCollider() ObjectImplementer
+	GetCollider() godot.ObjectImplementer
 	GetColliderShape() gdnative.Int
 	GetCollisionMask() gdnative.Int
 	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
@@ -527,11 +582,16 @@ type RayCast2DImplementer interface {
 	GetExcludeParentBody() gdnative.Bool
 	IsColliding() gdnative.Bool
 	IsEnabled() gdnative.Bool
-	RemoveException(node ObjectImplementer)
+	RemoveException(node godot.ObjectImplementer)
 	RemoveExceptionRid(rid gdnative.Rid)
 	SetCastTo(localPoint gdnative.Vector2)
 	SetCollisionMask(mask gdnative.Int)
 	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
 	SetEnabled(enabled gdnative.Bool)
 	SetExcludeParentBody(mask gdnative.Bool)
+	CastTo() gdnative.Vector2
+	CollisionMask() gdnative.Int
+	Enabled() gdnative.Bool
+	ExcludeParent() gdnative.Bool
+	SetExcludeParent(value gdnative.Bool)
 }
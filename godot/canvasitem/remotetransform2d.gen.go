@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,50 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRemoteTransform2DFromPointer(ptr gdnative.Pointer) RemoteTransform2D {
-func newRemoteTransform2DFromPointer(ptr gdnative.Pointer) RemoteTransform2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RemoteTransform2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 RemoteTransform2D leads the [Transform2D] of another [CanvasItem] derived Node (called the remote node) in the scene. It can be set to track another Node's position, rotation and/or scale. It can update using either global or local coordinates.
 */
 type RemoteTransform2D struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorRemoteTransform2D is the class constructor of the RemoteTransform2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRemoteTransform2D = gdnative.NewLazyClassConstructor("RemoteTransform2D")
+
+// NewRemoteTransform2D will create a new instance of the RemoteTransform2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewRemoteTransform2D() *RemoteTransform2D {
+	obj := &RemoteTransform2D{}
+	obj.SetBaseObject(classConstructorRemoteTransform2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RemoteTransform2D) BaseClass() string {
 	return "RemoteTransform2D"
 }
 
+// Method binds of the RemoteTransform2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRemoteTransform2DGetRemoteNode           = gdnative.NewLazyMethodBind("RemoteTransform2D", "get_remote_node")
+	methodBindRemoteTransform2DGetUpdatePosition       = gdnative.NewLazyMethodBind("RemoteTransform2D", "get_update_position")
+	methodBindRemoteTransform2DGetUpdateRotation       = gdnative.NewLazyMethodBind("RemoteTransform2D", "get_update_rotation")
+	methodBindRemoteTransform2DGetUpdateScale          = gdnative.NewLazyMethodBind("RemoteTransform2D", "get_update_scale")
+	methodBindRemoteTransform2DGetUseGlobalCoordinates = gdnative.NewLazyMethodBind("RemoteTransform2D", "get_use_global_coordinates")
+	methodBindRemoteTransform2DSetRemoteNode           = gdnative.NewLazyMethodBind("RemoteTransform2D", "set_remote_node")
+	methodBindRemoteTransform2DSetUpdatePosition       = gdnative.NewLazyMethodBind("RemoteTransform2D", "set_update_position")
+	methodBindRemoteTransform2DSetUpdateRotation       = gdnative.NewLazyMethodBind("RemoteTransform2D", "set_update_rotation")
+	methodBindRemoteTransform2DSetUpdateScale          = gdnative.NewLazyMethodBind("RemoteTransform2D", "set_update_scale")
+	methodBindRemoteTransform2DSetUseGlobalCoordinates = gdnative.NewLazyMethodBind("RemoteTransform2D", "set_use_global_coordinates")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *RemoteTransform2D) GetRemoteNode() gdnative.NodePath {
 	//log.Println("Calling RemoteTransform2D.GetRemoteNode()")
@@ -45,7 +66,7 @@ func (o *RemoteTransform2D) GetRemoteNode() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "get_remote_node")
+	methodBind := methodBindRemoteTransform2DGetRemoteNode.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -58,8 +79,8 @@ func (o *RemoteTransform2D) GetRemoteNode() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform2D) GetUpdatePosition() gdnative.Bool {
 	//log.Println("Calling RemoteTransform2D.GetUpdatePosition()")
@@ -68,7 +89,7 @@ func (o *RemoteTransform2D) GetUpdatePosition() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "get_update_position")
+	methodBind := methodBindRemoteTransform2DGetUpdatePosition.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +102,8 @@ func (o *RemoteTransform2D) GetUpdatePosition() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform2D) GetUpdateRotation() gdnative.Bool {
 	//log.Println("Calling RemoteTransform2D.GetUpdateRotation()")
@@ -91,7 +112,7 @@ func (o *RemoteTransform2D) GetUpdateRotation() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "get_update_rotation")
+	methodBind := methodBindRemoteTransform2DGetUpdateRotation.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +125,8 @@ func (o *RemoteTransform2D) GetUpdateRotation() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform2D) GetUpdateScale() gdnative.Bool {
 	//log.Println("Calling RemoteTransform2D.GetUpdateScale()")
@@ -114,7 +135,7 @@ func (o *RemoteTransform2D) GetUpdateScale() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "get_update_scale")
+	methodBind := methodBindRemoteTransform2DGetUpdateScale.Get()
 
 	// Call the parent method.
 	// bool
@@ -127,8 +148,8 @@ func (o *RemoteTransform2D) GetUpdateScale() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform2D) GetUseGlobalCoordinates() gdnative.Bool {
 	//log.Println("Calling RemoteTransform2D.GetUseGlobalCoordinates()")
@@ -137,7 +158,7 @@ func (o *RemoteTransform2D) GetUseGlobalCoordinates() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "get_use_global_coordinates")
+	methodBind := methodBindRemoteTransform2DGetUseGlobalCoordinates.Get()
 
 	// Call the parent method.
 	// bool
@@ -150,8 +171,8 @@ func (o *RemoteTransform2D) GetUseGlobalCoordinates() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false path NodePath}], Returns: void
 */
 func (o *RemoteTransform2D) SetRemoteNode(path gdnative.NodePath) {
 	//log.Println("Calling RemoteTransform2D.SetRemoteNode()")
@@ -161,7 +182,7 @@ func (o *RemoteTransform2D) SetRemoteNode(path gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "set_remote_node")
+	methodBind := methodBindRemoteTransform2DSetRemoteNode.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +192,8 @@ func (o *RemoteTransform2D) SetRemoteNode(path gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false update_remote_position bool}], Returns: void
+	        Undocumented
+		Args: [{ false update_remote_position bool}], Returns: void
 */
 func (o *RemoteTransform2D) SetUpdatePosition(updateRemotePosition gdnative.Bool) {
 	//log.Println("Calling RemoteTransform2D.SetUpdatePosition()")
@@ -182,7 +203,7 @@ func (o *RemoteTransform2D) SetUpdatePosition(updateRemotePosition gdnative.Bool
 	ptrArguments[0] = gdnative.NewPointerFromBool(updateRemotePosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "set_update_position")
+	methodBind := methodBindRemoteTransform2DSetUpdatePosition.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +213,8 @@ func (o *RemoteTransform2D) SetUpdatePosition(updateRemotePosition gdnative.Bool
 }
 
 /*
-        Undocumented
-	Args: [{ false update_remote_rotation bool}], Returns: void
+	        Undocumented
+		Args: [{ false update_remote_rotation bool}], Returns: void
 */
 func (o *RemoteTransform2D) SetUpdateRotation(updateRemoteRotation gdnative.Bool) {
 	//log.Println("Calling RemoteTransform2D.SetUpdateRotation()")
@@ -203,7 +224,7 @@ func (o *RemoteTransform2D) SetUpdateRotation(updateRemoteRotation gdnative.Bool
 	ptrArguments[0] = gdnative.NewPointerFromBool(updateRemoteRotation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "set_update_rotation")
+	methodBind := methodBindRemoteTransform2DSetUpdateRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +234,8 @@ func (o *RemoteTransform2D) SetUpdateRotation(updateRemoteRotation gdnative.Bool
 }
 
 /*
-        Undocumented
-	Args: [{ false update_remote_scale bool}], Returns: void
+	        Undocumented
+		Args: [{ false update_remote_scale bool}], Returns: void
 */
 func (o *RemoteTransform2D) SetUpdateScale(updateRemoteScale gdnative.Bool) {
 	//log.Println("Calling RemoteTransform2D.SetUpdateScale()")
@@ -224,7 +245,7 @@ func (o *RemoteTransform2D) SetUpdateScale(updateRemoteScale gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(updateRemoteScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "set_update_scale")
+	methodBind := methodBindRemoteTransform2DSetUpdateScale.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +255,8 @@ func (o *RemoteTransform2D) SetUpdateScale(updateRemoteScale gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false use_global_coordinates bool}], Returns: void
+	        Undocumented
+		Args: [{ false use_global_coordinates bool}], Returns: void
 */
 func (o *RemoteTransform2D) SetUseGlobalCoordinates(useGlobalCoordinates gdnative.Bool) {
 	//log.Println("Calling RemoteTransform2D.SetUseGlobalCoordinates()")
@@ -245,7 +266,7 @@ func (o *RemoteTransform2D) SetUseGlobalCoordinates(useGlobalCoordinates gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromBool(useGlobalCoordinates)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform2D", "set_use_global_coordinates")
+	methodBind := methodBindRemoteTransform2DSetUseGlobalCoordinates.Get()
 
 	// Call the parent method.
 	// void
@@ -254,10 +275,40 @@ func (o *RemoteTransform2D) SetUseGlobalCoordinates(useGlobalCoordinates gdnativ
 
 }
 
+// RemotePath will return the value of the "remote_path" property.
+func (o *RemoteTransform2D) RemotePath() gdnative.NodePath {
+	return o.GetRemoteNode()
+}
+
+// SetRemotePath will set the value of the "remote_path" property.
+func (o *RemoteTransform2D) SetRemotePath(value gdnative.NodePath) {
+	o.SetRemoteNode(value)
+}
+
+// UpdatePosition will return the value of the "update_position" property.
+func (o *RemoteTransform2D) UpdatePosition() gdnative.Bool {
+	return o.GetUpdatePosition()
+}
+
+// UpdateRotation will return the value of the "update_rotation" property.
+func (o *RemoteTransform2D) UpdateRotation() gdnative.Bool {
+	return o.GetUpdateRotation()
+}
+
+// UpdateScale will return the value of the "update_scale" property.
+func (o *RemoteTransform2D) UpdateScale() gdnative.Bool {
+	return o.GetUpdateScale()
+}
+
+// UseGlobalCoordinates will return the value of the "use_global_coordinates" property.
+func (o *RemoteTransform2D) UseGlobalCoordinates() gdnative.Bool {
+	return o.GetUseGlobalCoordinates()
+}
+
 // RemoteTransform2DImplementer is an interface that implements the methods
 // of the RemoteTransform2D class.
 type RemoteTransform2DImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetRemoteNode() gdnative.NodePath
 	GetUpdatePosition() gdnative.Bool
 	GetUpdateRotation() gdnative.Bool
@@ -268,4 +319,10 @@ type RemoteTransform2DImplementer interface {
 	SetUpdateRotation(updateRemoteRotation gdnative.Bool)
 	SetUpdateScale(updateRemoteScale gdnative.Bool)
 	SetUseGlobalCoordinates(useGlobalCoordinates gdnative.Bool)
+	RemotePath() gdnative.NodePath
+	SetRemotePath(value gdnative.NodePath)
+	UpdatePosition() gdnative.Bool
+	UpdateRotation() gdnative.Bool
+	UpdateScale() gdnative.Bool
+	UseGlobalCoordinates() gdnative.Bool
 }
@@ -0,0 +1,1541 @@
+package canvasitem
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/physics"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// RigidBody2DCCDMode is an enum for CCDMode values.
+type RigidBody2DCCDMode int
+
+const (
+	RigidBody2DCcdModeCastRay   RigidBody2DCCDMode = 1
+	RigidBody2DCcdModeCastShape RigidBody2DCCDMode = 2
+	RigidBody2DCcdModeDisabled  RigidBody2DCCDMode = 0
+)
+
+// String will return the Godot name of the RigidBody2DCCDMode value.
+func (e RigidBody2DCCDMode) String() string {
+	switch e {
+	case RigidBody2DCcdModeCastRay:
+		return "CCD_MODE_CAST_RAY"
+	case RigidBody2DCcdModeCastShape:
+		return "CCD_MODE_CAST_SHAPE"
+	case RigidBody2DCcdModeDisabled:
+		return "CCD_MODE_DISABLED"
+	}
+	return fmt.Sprintf("RigidBody2DCCDMode(%d)", int(e))
+}
+
+// ParseRigidBody2DCCDMode will return the RigidBody2DCCDMode value with the given Godot name.
+func ParseRigidBody2DCCDMode(name string) (RigidBody2DCCDMode, error) {
+	switch name {
+	case "CCD_MODE_CAST_RAY":
+		return RigidBody2DCcdModeCastRay, nil
+	case "CCD_MODE_CAST_SHAPE":
+		return RigidBody2DCcdModeCastShape, nil
+	case "CCD_MODE_DISABLED":
+		return RigidBody2DCcdModeDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid RigidBody2DCCDMode value %q", name)
+}
+
+// RigidBody2DMode is an enum for Mode values.
+type RigidBody2DMode int
+
+const (
+	RigidBody2DModeCharacter RigidBody2DMode = 2
+	RigidBody2DModeKinematic RigidBody2DMode = 3
+	RigidBody2DModeRigid     RigidBody2DMode = 0
+	RigidBody2DModeStatic    RigidBody2DMode = 1
+)
+
+// String will return the Godot name of the RigidBody2DMode value.
+func (e RigidBody2DMode) String() string {
+	switch e {
+	case RigidBody2DModeCharacter:
+		return "MODE_CHARACTER"
+	case RigidBody2DModeKinematic:
+		return "MODE_KINEMATIC"
+	case RigidBody2DModeRigid:
+		return "MODE_RIGID"
+	case RigidBody2DModeStatic:
+		return "MODE_STATIC"
+	}
+	return fmt.Sprintf("RigidBody2DMode(%d)", int(e))
+}
+
+// ParseRigidBody2DMode will return the RigidBody2DMode value with the given Godot name.
+func ParseRigidBody2DMode(name string) (RigidBody2DMode, error) {
+	switch name {
+	case "MODE_CHARACTER":
+		return RigidBody2DModeCharacter, nil
+	case "MODE_KINEMATIC":
+		return RigidBody2DModeKinematic, nil
+	case "MODE_RIGID":
+		return RigidBody2DModeRigid, nil
+	case "MODE_STATIC":
+		return RigidBody2DModeStatic, nil
+	}
+	return 0, fmt.Errorf("invalid RigidBody2DMode value %q", name)
+}
+
+/*
+This node implements simulated 2D physics. You do not control a RigidBody2D directly. Instead you apply forces to it (gravity, impulses, etc.) and the physics simulation calculates the resulting movement based on its mass, friction, and other physical properties. A RigidBody2D has 4 behavior [member mode]s: Rigid, Static, Character, and Kinematic. [b]Note:[/b] You should not change a RigidBody2D's [code]position[/code] or [code]linear_velocity[/code] every frame or even very often. If you need to directly affect the body's state, use [method _integrate_forces], which allows you to directly access the physics state. If you need to override the default physics behavior, you can write a custom force integration. See [member custom_integrator].
+*/
+type RigidBody2D struct {
+	godot.PhysicsBody2D
+	owner gdnative.Object
+}
+
+// classConstructorRigidBody2D is the class constructor of the RigidBody2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRigidBody2D = gdnative.NewLazyClassConstructor("RigidBody2D")
+
+// NewRigidBody2D will create a new instance of the RigidBody2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewRigidBody2D() *RigidBody2D {
+	obj := &RigidBody2D{}
+	obj.SetBaseObject(classConstructorRigidBody2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *RigidBody2D) BaseClass() string {
+	return "RigidBody2D"
+}
+
+// Method binds of the RigidBody2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRigidBody2DX_BodyEnterTree                     = gdnative.NewLazyMethodBind("RigidBody2D", "_body_enter_tree")
+	methodBindRigidBody2DX_BodyExitTree                      = gdnative.NewLazyMethodBind("RigidBody2D", "_body_exit_tree")
+	methodBindRigidBody2DX_DirectStateChanged                = gdnative.NewLazyMethodBind("RigidBody2D", "_direct_state_changed")
+	methodBindRigidBody2DX_IntegrateForces                   = gdnative.NewLazyMethodBind("RigidBody2D", "_integrate_forces")
+	methodBindRigidBody2DAddForce                            = gdnative.NewLazyMethodBind("RigidBody2D", "add_force")
+	methodBindRigidBody2DApplyImpulse                        = gdnative.NewLazyMethodBind("RigidBody2D", "apply_impulse")
+	methodBindRigidBody2DGetAngularDamp                      = gdnative.NewLazyMethodBind("RigidBody2D", "get_angular_damp")
+	methodBindRigidBody2DGetAngularVelocity                  = gdnative.NewLazyMethodBind("RigidBody2D", "get_angular_velocity")
+	methodBindRigidBody2DGetAppliedForce                     = gdnative.NewLazyMethodBind("RigidBody2D", "get_applied_force")
+	methodBindRigidBody2DGetAppliedTorque                    = gdnative.NewLazyMethodBind("RigidBody2D", "get_applied_torque")
+	methodBindRigidBody2DGetBounce                           = gdnative.NewLazyMethodBind("RigidBody2D", "get_bounce")
+	methodBindRigidBody2DGetCollidingBodies                  = gdnative.NewLazyMethodBind("RigidBody2D", "get_colliding_bodies")
+	methodBindRigidBody2DGetContinuousCollisionDetectionMode = gdnative.NewLazyMethodBind("RigidBody2D", "get_continuous_collision_detection_mode")
+	methodBindRigidBody2DGetFriction                         = gdnative.NewLazyMethodBind("RigidBody2D", "get_friction")
+	methodBindRigidBody2DGetGravityScale                     = gdnative.NewLazyMethodBind("RigidBody2D", "get_gravity_scale")
+	methodBindRigidBody2DGetInertia                          = gdnative.NewLazyMethodBind("RigidBody2D", "get_inertia")
+	methodBindRigidBody2DGetLinearDamp                       = gdnative.NewLazyMethodBind("RigidBody2D", "get_linear_damp")
+	methodBindRigidBody2DGetLinearVelocity                   = gdnative.NewLazyMethodBind("RigidBody2D", "get_linear_velocity")
+	methodBindRigidBody2DGetMass                             = gdnative.NewLazyMethodBind("RigidBody2D", "get_mass")
+	methodBindRigidBody2DGetMaxContactsReported              = gdnative.NewLazyMethodBind("RigidBody2D", "get_max_contacts_reported")
+	methodBindRigidBody2DGetMode                             = gdnative.NewLazyMethodBind("RigidBody2D", "get_mode")
+	methodBindRigidBody2DGetWeight                           = gdnative.NewLazyMethodBind("RigidBody2D", "get_weight")
+	methodBindRigidBody2DIsAbleToSleep                       = gdnative.NewLazyMethodBind("RigidBody2D", "is_able_to_sleep")
+	methodBindRigidBody2DIsContactMonitorEnabled             = gdnative.NewLazyMethodBind("RigidBody2D", "is_contact_monitor_enabled")
+	methodBindRigidBody2DIsSleeping                          = gdnative.NewLazyMethodBind("RigidBody2D", "is_sleeping")
+	methodBindRigidBody2DIsUsingCustomIntegrator             = gdnative.NewLazyMethodBind("RigidBody2D", "is_using_custom_integrator")
+	methodBindRigidBody2DSetAngularDamp                      = gdnative.NewLazyMethodBind("RigidBody2D", "set_angular_damp")
+	methodBindRigidBody2DSetAngularVelocity                  = gdnative.NewLazyMethodBind("RigidBody2D", "set_angular_velocity")
+	methodBindRigidBody2DSetAppliedForce                     = gdnative.NewLazyMethodBind("RigidBody2D", "set_applied_force")
+	methodBindRigidBody2DSetAppliedTorque                    = gdnative.NewLazyMethodBind("RigidBody2D", "set_applied_torque")
+	methodBindRigidBody2DSetAxisVelocity                     = gdnative.NewLazyMethodBind("RigidBody2D", "set_axis_velocity")
+	methodBindRigidBody2DSetBounce                           = gdnative.NewLazyMethodBind("RigidBody2D", "set_bounce")
+	methodBindRigidBody2DSetCanSleep                         = gdnative.NewLazyMethodBind("RigidBody2D", "set_can_sleep")
+	methodBindRigidBody2DSetContactMonitor                   = gdnative.NewLazyMethodBind("RigidBody2D", "set_contact_monitor")
+	methodBindRigidBody2DSetContinuousCollisionDetectionMode = gdnative.NewLazyMethodBind("RigidBody2D", "set_continuous_collision_detection_mode")
+	methodBindRigidBody2DSetFriction                         = gdnative.NewLazyMethodBind("RigidBody2D", "set_friction")
+	methodBindRigidBody2DSetGravityScale                     = gdnative.NewLazyMethodBind("RigidBody2D", "set_gravity_scale")
+	methodBindRigidBody2DSetInertia                          = gdnative.NewLazyMethodBind("RigidBody2D", "set_inertia")
+	methodBindRigidBody2DSetLinearDamp                       = gdnative.NewLazyMethodBind("RigidBody2D", "set_linear_damp")
+	methodBindRigidBody2DSetLinearVelocity                   = gdnative.NewLazyMethodBind("RigidBody2D", "set_linear_velocity")
+	methodBindRigidBody2DSetMass                             = gdnative.NewLazyMethodBind("RigidBody2D", "set_mass")
+	methodBindRigidBody2DSetMaxContactsReported              = gdnative.NewLazyMethodBind("RigidBody2D", "set_max_contacts_reported")
+	methodBindRigidBody2DSetMode                             = gdnative.NewLazyMethodBind("RigidBody2D", "set_mode")
+	methodBindRigidBody2DSetSleeping                         = gdnative.NewLazyMethodBind("RigidBody2D", "set_sleeping")
+	methodBindRigidBody2DSetUseCustomIntegrator              = gdnative.NewLazyMethodBind("RigidBody2D", "set_use_custom_integrator")
+	methodBindRigidBody2DSetWeight                           = gdnative.NewLazyMethodBind("RigidBody2D", "set_weight")
+	methodBindRigidBody2DTestMotion                          = gdnative.NewLazyMethodBind("RigidBody2D", "test_motion")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
+*/
+func (o *RigidBody2D) X_BodyEnterTree(arg0 gdnative.Int) {
+	//log.Println("Calling RigidBody2D.X_BodyEnterTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DX_BodyEnterTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
+*/
+func (o *RigidBody2D) X_BodyExitTree(arg0 gdnative.Int) {
+	//log.Println("Calling RigidBody2D.X_BodyExitTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DX_BodyExitTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
+*/
+func (o *RigidBody2D) X_DirectStateChanged(arg0 godot.ObjectImplementer) {
+	//log.Println("Calling RigidBody2D.X_DirectStateChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DX_DirectStateChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Allows you to read and safely modify the simulation state for the object. Use this instead of [Node._physics_process] if you need to directly change the body's [code]position[/code] or other physics properties. By default it works in addition to the usual physics behavior, but [member custom_integrator] allows you to disable the default behavior and write custom force integration for a body.
+		Args: [{ false state Physics2DDirectBodyState}], Returns: void
+*/
+func (o *RigidBody2D) X_IntegrateForces(state physics.Physics2DDirectBodyStateImplementer) {
+	//log.Println("Calling RigidBody2D.X_IntegrateForces()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(state))
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DX_IntegrateForces.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Adds a positioned force to the body. Both the force and the offset from the body origin are in global coordinates.
+		Args: [{ false offset Vector2} { false force Vector2}], Returns: void
+*/
+func (o *RigidBody2D) AddForce(offset gdnative.Vector2, force gdnative.Vector2) {
+	//log.Println("Calling RigidBody2D.AddForce()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
+	ptrArguments[1] = gdnative.NewPointerFromVector2(force)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DAddForce.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Applies a positioned impulse to the body (which will be affected by the body mass and shape). This is the equivalent of hitting a billiard ball with a cue: a force that is applied instantaneously. Both the impulse and the offset from the body origin are in global coordinates.
+		Args: [{ false offset Vector2} { false impulse Vector2}], Returns: void
+*/
+func (o *RigidBody2D) ApplyImpulse(offset gdnative.Vector2, impulse gdnative.Vector2) {
+	//log.Println("Calling RigidBody2D.ApplyImpulse()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
+	ptrArguments[1] = gdnative.NewPointerFromVector2(impulse)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DApplyImpulse.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetAngularDamp() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetAngularDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetAngularVelocity() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetAngularVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetAngularVelocity.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *RigidBody2D) GetAppliedForce() gdnative.Vector2 {
+	//log.Println("Calling RigidBody2D.GetAppliedForce()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetAppliedForce.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetAppliedTorque() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetAppliedTorque()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetAppliedTorque.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetBounce() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetBounce()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetBounce.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a list of the bodies colliding with this one. Use [member contacts_reported] to set the maximum number reported. You must also set [member contact_monitor] to [code]true[/code]. Note that the result of this test is not immediate after moving objects. For performance, list of collisions is updated once per frame and before the physics step. Consider using signals instead.
+		Args: [], Returns: Array
+*/
+func (o *RigidBody2D) GetCollidingBodies() gdnative.Array {
+	//log.Println("Calling RigidBody2D.GetCollidingBodies()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetCollidingBodies.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.RigidBody2D::CCDMode
+*/
+func (o *RigidBody2D) GetContinuousCollisionDetectionMode() RigidBody2DCCDMode {
+	//log.Println("Calling RigidBody2D.GetContinuousCollisionDetectionMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetContinuousCollisionDetectionMode.Get()
+
+	// Call the parent method.
+	// enum.RigidBody2D::CCDMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return RigidBody2DCCDMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetFriction() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetFriction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetFriction.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetGravityScale() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetGravityScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetGravityScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetInertia() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetInertia()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetInertia.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetLinearDamp() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetLinearDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *RigidBody2D) GetLinearVelocity() gdnative.Vector2 {
+	//log.Println("Calling RigidBody2D.GetLinearVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetLinearVelocity.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetMass() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetMass()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetMass.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *RigidBody2D) GetMaxContactsReported() gdnative.Int {
+	//log.Println("Calling RigidBody2D.GetMaxContactsReported()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetMaxContactsReported.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.RigidBody2D::Mode
+*/
+func (o *RigidBody2D) GetMode() RigidBody2DMode {
+	//log.Println("Calling RigidBody2D.GetMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetMode.Get()
+
+	// Call the parent method.
+	// enum.RigidBody2D::Mode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return RigidBody2DMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody2D) GetWeight() gdnative.Real {
+	//log.Println("Calling RigidBody2D.GetWeight()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DGetWeight.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody2D) IsAbleToSleep() gdnative.Bool {
+	//log.Println("Calling RigidBody2D.IsAbleToSleep()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DIsAbleToSleep.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody2D) IsContactMonitorEnabled() gdnative.Bool {
+	//log.Println("Calling RigidBody2D.IsContactMonitorEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DIsContactMonitorEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody2D) IsSleeping() gdnative.Bool {
+	//log.Println("Calling RigidBody2D.IsSleeping()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DIsSleeping.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody2D) IsUsingCustomIntegrator() gdnative.Bool {
+	//log.Println("Calling RigidBody2D.IsUsingCustomIntegrator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DIsUsingCustomIntegrator.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false angular_damp float}], Returns: void
+*/
+func (o *RigidBody2D) SetAngularDamp(angularDamp gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angularDamp)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetAngularDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false angular_velocity float}], Returns: void
+*/
+func (o *RigidBody2D) SetAngularVelocity(angularVelocity gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetAngularVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angularVelocity)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetAngularVelocity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false force Vector2}], Returns: void
+*/
+func (o *RigidBody2D) SetAppliedForce(force gdnative.Vector2) {
+	//log.Println("Calling RigidBody2D.SetAppliedForce()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(force)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetAppliedForce.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false torque float}], Returns: void
+*/
+func (o *RigidBody2D) SetAppliedTorque(torque gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetAppliedTorque()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(torque)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetAppliedTorque.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Sets the body's velocity on the given axis. The velocity in the given vector axis will be set as the given vector length. This is useful for jumping behavior.
+		Args: [{ false axis_velocity Vector2}], Returns: void
+*/
+func (o *RigidBody2D) SetAxisVelocity(axisVelocity gdnative.Vector2) {
+	//log.Println("Calling RigidBody2D.SetAxisVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(axisVelocity)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetAxisVelocity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false bounce float}], Returns: void
+*/
+func (o *RigidBody2D) SetBounce(bounce gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetBounce()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(bounce)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetBounce.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false able_to_sleep bool}], Returns: void
+*/
+func (o *RigidBody2D) SetCanSleep(ableToSleep gdnative.Bool) {
+	//log.Println("Calling RigidBody2D.SetCanSleep()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(ableToSleep)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetCanSleep.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *RigidBody2D) SetContactMonitor(enabled gdnative.Bool) {
+	//log.Println("Calling RigidBody2D.SetContactMonitor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetContactMonitor.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.RigidBody2D::CCDMode}], Returns: void
+*/
+func (o *RigidBody2D) SetContinuousCollisionDetectionMode(mode RigidBody2DCCDMode) {
+	//log.Println("Calling RigidBody2D.SetContinuousCollisionDetectionMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetContinuousCollisionDetectionMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false friction float}], Returns: void
+*/
+func (o *RigidBody2D) SetFriction(friction gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetFriction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(friction)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetFriction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false gravity_scale float}], Returns: void
+*/
+func (o *RigidBody2D) SetGravityScale(gravityScale gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetGravityScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(gravityScale)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetGravityScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false inertia float}], Returns: void
+*/
+func (o *RigidBody2D) SetInertia(inertia gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetInertia()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(inertia)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetInertia.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false linear_damp float}], Returns: void
+*/
+func (o *RigidBody2D) SetLinearDamp(linearDamp gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(linearDamp)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetLinearDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false linear_velocity Vector2}], Returns: void
+*/
+func (o *RigidBody2D) SetLinearVelocity(linearVelocity gdnative.Vector2) {
+	//log.Println("Calling RigidBody2D.SetLinearVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(linearVelocity)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetLinearVelocity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mass float}], Returns: void
+*/
+func (o *RigidBody2D) SetMass(mass gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetMass()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(mass)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetMass.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
+*/
+func (o *RigidBody2D) SetMaxContactsReported(amount gdnative.Int) {
+	//log.Println("Calling RigidBody2D.SetMaxContactsReported()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetMaxContactsReported.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.RigidBody2D::Mode}], Returns: void
+*/
+func (o *RigidBody2D) SetMode(mode RigidBody2DMode) {
+	//log.Println("Calling RigidBody2D.SetMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false sleeping bool}], Returns: void
+*/
+func (o *RigidBody2D) SetSleeping(sleeping gdnative.Bool) {
+	//log.Println("Calling RigidBody2D.SetSleeping()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(sleeping)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetSleeping.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *RigidBody2D) SetUseCustomIntegrator(enable gdnative.Bool) {
+	//log.Println("Calling RigidBody2D.SetUseCustomIntegrator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetUseCustomIntegrator.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false weight float}], Returns: void
+*/
+func (o *RigidBody2D) SetWeight(weight gdnative.Real) {
+	//log.Println("Calling RigidBody2D.SetWeight()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(weight)
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DSetWeight.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Returns [code]true[/code] if a collision would result from moving in the given vector. [code]margin[/code] increases the size of the shapes involved in the collision detection, and [code]result[/code] is an object of type [Physics2DTestMotionResult], which contains additional information about the collision (should there be one).
+		Args: [{ false motion Vector2} {0.08 true margin float} {Null true result Physics2DTestMotionResult}], Returns: bool
+*/
+func (o *RigidBody2D) TestMotion(motion gdnative.Vector2, margin gdnative.Real, result physics.Physics2DTestMotionResultImplementer) gdnative.Bool {
+	//log.Println("Calling RigidBody2D.TestMotion()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(motion)
+	ptrArguments[1] = gdnative.NewPointerFromReal(margin)
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(result))
+
+	// Get the method bind
+	methodBind := methodBindRigidBody2DTestMotion.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+// TestMotionWithDefaults will call TestMotion using the default values for: margin, result.
+func (o *RigidBody2D) TestMotionWithDefaults(motion gdnative.Vector2) gdnative.Bool {
+	return o.TestMotion(motion, 0.08, nil)
+}
+
+// AngularDamp will return the value of the "angular_damp" property.
+func (o *RigidBody2D) AngularDamp() gdnative.Real {
+	return o.GetAngularDamp()
+}
+
+// AngularVelocity will return the value of the "angular_velocity" property.
+func (o *RigidBody2D) AngularVelocity() gdnative.Real {
+	return o.GetAngularVelocity()
+}
+
+// AppliedForce will return the value of the "applied_force" property.
+func (o *RigidBody2D) AppliedForce() gdnative.Vector2 {
+	return o.GetAppliedForce()
+}
+
+// AppliedTorque will return the value of the "applied_torque" property.
+func (o *RigidBody2D) AppliedTorque() gdnative.Real {
+	return o.GetAppliedTorque()
+}
+
+// Bounce will return the value of the "bounce" property.
+func (o *RigidBody2D) Bounce() gdnative.Real {
+	return o.GetBounce()
+}
+
+// CanSleep will return the value of the "can_sleep" property.
+func (o *RigidBody2D) CanSleep() gdnative.Bool {
+	return o.IsAbleToSleep()
+}
+
+// ContactMonitor will return the value of the "contact_monitor" property.
+func (o *RigidBody2D) ContactMonitor() gdnative.Bool {
+	return o.IsContactMonitorEnabled()
+}
+
+// ContactsReported will return the value of the "contacts_reported" property.
+func (o *RigidBody2D) ContactsReported() gdnative.Int {
+	return o.GetMaxContactsReported()
+}
+
+// SetContactsReported will set the value of the "contacts_reported" property.
+func (o *RigidBody2D) SetContactsReported(value gdnative.Int) {
+	o.SetMaxContactsReported(value)
+}
+
+// ContinuousCd will return the value of the "continuous_cd" property.
+func (o *RigidBody2D) ContinuousCd() RigidBody2DCCDMode {
+	return o.GetContinuousCollisionDetectionMode()
+}
+
+// SetContinuousCd will set the value of the "continuous_cd" property.
+func (o *RigidBody2D) SetContinuousCd(value RigidBody2DCCDMode) {
+	o.SetContinuousCollisionDetectionMode(value)
+}
+
+// CustomIntegrator will return the value of the "custom_integrator" property.
+func (o *RigidBody2D) CustomIntegrator() gdnative.Bool {
+	return o.IsUsingCustomIntegrator()
+}
+
+// SetCustomIntegrator will set the value of the "custom_integrator" property.
+func (o *RigidBody2D) SetCustomIntegrator(value gdnative.Bool) {
+	o.SetUseCustomIntegrator(value)
+}
+
+// Friction will return the value of the "friction" property.
+func (o *RigidBody2D) Friction() gdnative.Real {
+	return o.GetFriction()
+}
+
+// GravityScale will return the value of the "gravity_scale" property.
+func (o *RigidBody2D) GravityScale() gdnative.Real {
+	return o.GetGravityScale()
+}
+
+// Inertia will return the value of the "inertia" property.
+func (o *RigidBody2D) Inertia() gdnative.Real {
+	return o.GetInertia()
+}
+
+// LinearDamp will return the value of the "linear_damp" property.
+func (o *RigidBody2D) LinearDamp() gdnative.Real {
+	return o.GetLinearDamp()
+}
+
+// LinearVelocity will return the value of the "linear_velocity" property.
+func (o *RigidBody2D) LinearVelocity() gdnative.Vector2 {
+	return o.GetLinearVelocity()
+}
+
+// Mass will return the value of the "mass" property.
+func (o *RigidBody2D) Mass() gdnative.Real {
+	return o.GetMass()
+}
+
+// Mode will return the value of the "mode" property.
+func (o *RigidBody2D) Mode() RigidBody2DMode {
+	return o.GetMode()
+}
+
+// Sleeping will return the value of the "sleeping" property.
+func (o *RigidBody2D) Sleeping() gdnative.Bool {
+	return o.IsSleeping()
+}
+
+// Weight will return the value of the "weight" property.
+func (o *RigidBody2D) Weight() gdnative.Real {
+	return o.GetWeight()
+}
+
+// Signals of the RigidBody2D class.
+const (
+	RigidBody2DSignalBodyEntered          gdnative.String = "body_entered"
+	RigidBody2DSignalBodyExited           gdnative.String = "body_exited"
+	RigidBody2DSignalBodyShapeEntered     gdnative.String = "body_shape_entered"
+	RigidBody2DSignalBodyShapeExited      gdnative.String = "body_shape_exited"
+	RigidBody2DSignalSleepingStateChanged gdnative.String = "sleeping_state_changed"
+)
+
+// RigidBody2DBodyEnteredArgs holds the arguments of the "body_entered" signal.
+type RigidBody2DBodyEnteredArgs struct {
+	Body godot.ObjectImplementer
+}
+
+// ConnectBodyEntered will connect the "body_entered" signal to the given method of the target object.
+func (o *RigidBody2D) ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBody2DSignalBodyEntered, target, method, binds, 0)
+}
+
+// EmitBodyEntered will emit the "body_entered" signal.
+func (o *RigidBody2D) EmitBodyEntered(args RigidBody2DBodyEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RigidBody2DSignalBodyEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// RigidBody2DBodyExitedArgs holds the arguments of the "body_exited" signal.
+type RigidBody2DBodyExitedArgs struct {
+	Body godot.ObjectImplementer
+}
+
+// ConnectBodyExited will connect the "body_exited" signal to the given method of the target object.
+func (o *RigidBody2D) ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBody2DSignalBodyExited, target, method, binds, 0)
+}
+
+// EmitBodyExited will emit the "body_exited" signal.
+func (o *RigidBody2D) EmitBodyExited(args RigidBody2DBodyExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RigidBody2DSignalBodyExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// RigidBody2DBodyShapeEnteredArgs holds the arguments of the "body_shape_entered" signal.
+type RigidBody2DBodyShapeEnteredArgs struct {
+	BodyId     gdnative.Int
+	Body       godot.ObjectImplementer
+	BodyShape  gdnative.Int
+	LocalShape gdnative.Int
+}
+
+// ConnectBodyShapeEntered will connect the "body_shape_entered" signal to the given method of the target object.
+func (o *RigidBody2D) ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBody2DSignalBodyShapeEntered, target, method, binds, 0)
+}
+
+// EmitBodyShapeEntered will emit the "body_shape_entered" signal.
+func (o *RigidBody2D) EmitBodyShapeEntered(args RigidBody2DBodyShapeEnteredArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.LocalShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(RigidBody2DSignalBodyShapeEntered, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// RigidBody2DBodyShapeExitedArgs holds the arguments of the "body_shape_exited" signal.
+type RigidBody2DBodyShapeExitedArgs struct {
+	BodyId     gdnative.Int
+	Body       godot.ObjectImplementer
+	BodyShape  gdnative.Int
+	LocalShape gdnative.Int
+}
+
+// ConnectBodyShapeExited will connect the "body_shape_exited" signal to the given method of the target object.
+func (o *RigidBody2D) ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBody2DSignalBodyShapeExited, target, method, binds, 0)
+}
+
+// EmitBodyShapeExited will emit the "body_shape_exited" signal.
+func (o *RigidBody2D) EmitBodyShapeExited(args RigidBody2DBodyShapeExitedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.LocalShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(RigidBody2DSignalBodyShapeExited, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// ConnectSleepingStateChanged will connect the "sleeping_state_changed" signal to the given method of the target object.
+func (o *RigidBody2D) ConnectSleepingStateChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBody2DSignalSleepingStateChanged, target, method, binds, 0)
+}
+
+// EmitSleepingStateChanged will emit the "sleeping_state_changed" signal.
+func (o *RigidBody2D) EmitSleepingStateChanged() error {
+
+	ret, err := o.EmitSignal(RigidBody2DSignalSleepingStateChanged)
+	ret.Destroy()
+	return err
+}
+
+// RigidBody2DImplementer is an interface that implements the methods
+// of the RigidBody2D class.
+type RigidBody2DImplementer interface {
+	godot.PhysicsBody2DImplementer
+	X_BodyEnterTree(arg0 gdnative.Int)
+	X_BodyExitTree(arg0 gdnative.Int)
+	X_DirectStateChanged(arg0 godot.ObjectImplementer)
+	X_IntegrateForces(state physics.Physics2DDirectBodyStateImplementer)
+	AddForce(offset gdnative.Vector2, force gdnative.Vector2)
+	ApplyImpulse(offset gdnative.Vector2, impulse gdnative.Vector2)
+	GetAngularDamp() gdnative.Real
+	GetAngularVelocity() gdnative.Real
+	GetAppliedForce() gdnative.Vector2
+	GetAppliedTorque() gdnative.Real
+	GetBounce() gdnative.Real
+	GetCollidingBodies() gdnative.Array
+	GetContinuousCollisionDetectionMode() RigidBody2DCCDMode
+	GetFriction() gdnative.Real
+	GetGravityScale() gdnative.Real
+	GetInertia() gdnative.Real
+	GetLinearDamp() gdnative.Real
+	GetLinearVelocity() gdnative.Vector2
+	GetMass() gdnative.Real
+	GetMaxContactsReported() gdnative.Int
+	GetMode() RigidBody2DMode
+	GetWeight() gdnative.Real
+	IsAbleToSleep() gdnative.Bool
+	IsContactMonitorEnabled() gdnative.Bool
+	IsSleeping() gdnative.Bool
+	IsUsingCustomIntegrator() gdnative.Bool
+	SetAngularDamp(angularDamp gdnative.Real)
+	SetAngularVelocity(angularVelocity gdnative.Real)
+	SetAppliedForce(force gdnative.Vector2)
+	SetAppliedTorque(torque gdnative.Real)
+	SetAxisVelocity(axisVelocity gdnative.Vector2)
+	SetBounce(bounce gdnative.Real)
+	SetCanSleep(ableToSleep gdnative.Bool)
+	SetContactMonitor(enabled gdnative.Bool)
+	SetContinuousCollisionDetectionMode(mode RigidBody2DCCDMode)
+	SetFriction(friction gdnative.Real)
+	SetGravityScale(gravityScale gdnative.Real)
+	SetInertia(inertia gdnative.Real)
+	SetLinearDamp(linearDamp gdnative.Real)
+	SetLinearVelocity(linearVelocity gdnative.Vector2)
+	SetMass(mass gdnative.Real)
+	SetMaxContactsReported(amount gdnative.Int)
+	SetMode(mode RigidBody2DMode)
+	SetSleeping(sleeping gdnative.Bool)
+	SetUseCustomIntegrator(enable gdnative.Bool)
+	SetWeight(weight gdnative.Real)
+	TestMotion(motion gdnative.Vector2, margin gdnative.Real, result physics.Physics2DTestMotionResultImplementer) gdnative.Bool
+	TestMotionWithDefaults(motion gdnative.Vector2) gdnative.Bool
+	AngularDamp() gdnative.Real
+	AngularVelocity() gdnative.Real
+	AppliedForce() gdnative.Vector2
+	AppliedTorque() gdnative.Real
+	Bounce() gdnative.Real
+	CanSleep() gdnative.Bool
+	ContactMonitor() gdnative.Bool
+	ContactsReported() gdnative.Int
+	SetContactsReported(value gdnative.Int)
+	ContinuousCd() RigidBody2DCCDMode
+	SetContinuousCd(value RigidBody2DCCDMode)
+	CustomIntegrator() gdnative.Bool
+	SetCustomIntegrator(value gdnative.Bool)
+	Friction() gdnative.Real
+	GravityScale() gdnative.Real
+	Inertia() gdnative.Real
+	LinearDamp() gdnative.Real
+	LinearVelocity() gdnative.Vector2
+	Mass() gdnative.Real
+	Mode() RigidBody2DMode
+	Sleeping() gdnative.Bool
+	Weight() gdnative.Real
+	ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyEntered(args RigidBody2DBodyEnteredArgs) error
+	ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyExited(args RigidBody2DBodyExitedArgs) error
+	ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeEntered(args RigidBody2DBodyShapeEnteredArgs) error
+	ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeExited(args RigidBody2DBodyShapeExitedArgs) error
+	ConnectSleepingStateChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSleepingStateChanged() error
+}
@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,64 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSpriteFromPointer(ptr gdnative.Pointer) Sprite {
-func newSpriteFromPointer(ptr gdnative.Pointer) Sprite {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Sprite{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A node that displays a 2D texture. The texture displayed can be a region from a larger atlas texture, or a frame from a sprite sheet animation.
 */
 type Sprite struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorSprite is the class constructor of the Sprite class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSprite = gdnative.NewLazyClassConstructor("Sprite")
+
+// NewSprite will create a new instance of the Sprite class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSprite() *Sprite {
+	obj := &Sprite{}
+	obj.SetBaseObject(classConstructorSprite.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Sprite) BaseClass() string {
 	return "Sprite"
 }
 
+// Method binds of the Sprite class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSpriteGetFrame                  = gdnative.NewLazyMethodBind("Sprite", "get_frame")
+	methodBindSpriteGetHframes                = gdnative.NewLazyMethodBind("Sprite", "get_hframes")
+	methodBindSpriteGetNormalMap              = gdnative.NewLazyMethodBind("Sprite", "get_normal_map")
+	methodBindSpriteGetOffset                 = gdnative.NewLazyMethodBind("Sprite", "get_offset")
+	methodBindSpriteGetRegionRect             = gdnative.NewLazyMethodBind("Sprite", "get_region_rect")
+	methodBindSpriteGetTexture                = gdnative.NewLazyMethodBind("Sprite", "get_texture")
+	methodBindSpriteGetVframes                = gdnative.NewLazyMethodBind("Sprite", "get_vframes")
+	methodBindSpriteIsCentered                = gdnative.NewLazyMethodBind("Sprite", "is_centered")
+	methodBindSpriteIsFlippedH                = gdnative.NewLazyMethodBind("Sprite", "is_flipped_h")
+	methodBindSpriteIsFlippedV                = gdnative.NewLazyMethodBind("Sprite", "is_flipped_v")
+	methodBindSpriteIsRegion                  = gdnative.NewLazyMethodBind("Sprite", "is_region")
+	methodBindSpriteIsRegionFilterClipEnabled = gdnative.NewLazyMethodBind("Sprite", "is_region_filter_clip_enabled")
+	methodBindSpriteSetCentered               = gdnative.NewLazyMethodBind("Sprite", "set_centered")
+	methodBindSpriteSetFlipH                  = gdnative.NewLazyMethodBind("Sprite", "set_flip_h")
+	methodBindSpriteSetFlipV                  = gdnative.NewLazyMethodBind("Sprite", "set_flip_v")
+	methodBindSpriteSetFrame                  = gdnative.NewLazyMethodBind("Sprite", "set_frame")
+	methodBindSpriteSetHframes                = gdnative.NewLazyMethodBind("Sprite", "set_hframes")
+	methodBindSpriteSetNormalMap              = gdnative.NewLazyMethodBind("Sprite", "set_normal_map")
+	methodBindSpriteSetOffset                 = gdnative.NewLazyMethodBind("Sprite", "set_offset")
+	methodBindSpriteSetRegion                 = gdnative.NewLazyMethodBind("Sprite", "set_region")
+	methodBindSpriteSetRegionFilterClip       = gdnative.NewLazyMethodBind("Sprite", "set_region_filter_clip")
+	methodBindSpriteSetRegionRect             = gdnative.NewLazyMethodBind("Sprite", "set_region_rect")
+	methodBindSpriteSetTexture                = gdnative.NewLazyMethodBind("Sprite", "set_texture")
+	methodBindSpriteSetVframes                = gdnative.NewLazyMethodBind("Sprite", "set_vframes")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Sprite) GetFrame() gdnative.Int {
 	//log.Println("Calling Sprite.GetFrame()")
@@ -45,7 +80,7 @@ func (o *Sprite) GetFrame() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "get_frame")
+	methodBind := methodBindSpriteGetFrame.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +93,8 @@ func (o *Sprite) GetFrame() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Sprite) GetHframes() gdnative.Int {
 	//log.Println("Calling Sprite.GetHframes()")
@@ -68,7 +103,7 @@ func (o *Sprite) GetHframes() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "get_hframes")
+	methodBind := methodBindSpriteGetHframes.Get()
 
 	// Call the parent method.
 	// int
@@ -81,17 +116,17 @@ func (o *Sprite) GetHframes() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Sprite) GetNormalMap() TextureImplementer {
+func (o *Sprite) GetNormalMap() godot.TextureImplementer {
 	//log.Println("Calling Sprite.GetNormalMap()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "get_normal_map")
+	methodBind := methodBindSpriteGetNormalMap.Get()
 
 	// Call the parent method.
 	// Texture
@@ -99,27 +134,25 @@ func (o *Sprite) GetNormalMap() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Sprite) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling Sprite.GetOffset()")
@@ -128,7 +161,7 @@ func (o *Sprite) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "get_offset")
+	methodBind := methodBindSpriteGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -141,8 +174,8 @@ func (o *Sprite) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *Sprite) GetRegionRect() gdnative.Rect2 {
 	//log.Println("Calling Sprite.GetRegionRect()")
@@ -151,7 +184,7 @@ func (o *Sprite) GetRegionRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "get_region_rect")
+	methodBind := methodBindSpriteGetRegionRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -164,17 +197,17 @@ func (o *Sprite) GetRegionRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Sprite) GetTexture() TextureImplementer {
+func (o *Sprite) GetTexture() godot.TextureImplementer {
 	//log.Println("Calling Sprite.GetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "get_texture")
+	methodBind := methodBindSpriteGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -182,27 +215,25 @@ func (o *Sprite) GetTexture() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Sprite) GetVframes() gdnative.Int {
 	//log.Println("Calling Sprite.GetVframes()")
@@ -211,7 +242,7 @@ func (o *Sprite) GetVframes() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "get_vframes")
+	methodBind := methodBindSpriteGetVframes.Get()
 
 	// Call the parent method.
 	// int
@@ -224,8 +255,8 @@ func (o *Sprite) GetVframes() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Sprite) IsCentered() gdnative.Bool {
 	//log.Println("Calling Sprite.IsCentered()")
@@ -234,7 +265,7 @@ func (o *Sprite) IsCentered() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "is_centered")
+	methodBind := methodBindSpriteIsCentered.Get()
 
 	// Call the parent method.
 	// bool
@@ -247,8 +278,8 @@ func (o *Sprite) IsCentered() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Sprite) IsFlippedH() gdnative.Bool {
 	//log.Println("Calling Sprite.IsFlippedH()")
@@ -257,7 +288,7 @@ func (o *Sprite) IsFlippedH() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "is_flipped_h")
+	methodBind := methodBindSpriteIsFlippedH.Get()
 
 	// Call the parent method.
 	// bool
@@ -270,8 +301,8 @@ func (o *Sprite) IsFlippedH() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Sprite) IsFlippedV() gdnative.Bool {
 	//log.Println("Calling Sprite.IsFlippedV()")
@@ -280,7 +311,7 @@ func (o *Sprite) IsFlippedV() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "is_flipped_v")
+	methodBind := methodBindSpriteIsFlippedV.Get()
 
 	// Call the parent method.
 	// bool
@@ -293,8 +324,8 @@ func (o *Sprite) IsFlippedV() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Sprite) IsRegion() gdnative.Bool {
 	//log.Println("Calling Sprite.IsRegion()")
@@ -303,7 +334,7 @@ func (o *Sprite) IsRegion() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "is_region")
+	methodBind := methodBindSpriteIsRegion.Get()
 
 	// Call the parent method.
 	// bool
@@ -316,8 +347,8 @@ func (o *Sprite) IsRegion() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Sprite) IsRegionFilterClipEnabled() gdnative.Bool {
 	//log.Println("Calling Sprite.IsRegionFilterClipEnabled()")
@@ -326,7 +357,7 @@ func (o *Sprite) IsRegionFilterClipEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "is_region_filter_clip_enabled")
+	methodBind := methodBindSpriteIsRegionFilterClipEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -339,8 +370,8 @@ func (o *Sprite) IsRegionFilterClipEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false centered bool}], Returns: void
+	        Undocumented
+		Args: [{ false centered bool}], Returns: void
 */
 func (o *Sprite) SetCentered(centered gdnative.Bool) {
 	//log.Println("Calling Sprite.SetCentered()")
@@ -350,7 +381,7 @@ func (o *Sprite) SetCentered(centered gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(centered)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_centered")
+	methodBind := methodBindSpriteSetCentered.Get()
 
 	// Call the parent method.
 	// void
@@ -360,8 +391,8 @@ func (o *Sprite) SetCentered(centered gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flip_h bool}], Returns: void
+	        Undocumented
+		Args: [{ false flip_h bool}], Returns: void
 */
 func (o *Sprite) SetFlipH(flipH gdnative.Bool) {
 	//log.Println("Calling Sprite.SetFlipH()")
@@ -371,7 +402,7 @@ func (o *Sprite) SetFlipH(flipH gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(flipH)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_flip_h")
+	methodBind := methodBindSpriteSetFlipH.Get()
 
 	// Call the parent method.
 	// void
@@ -381,8 +412,8 @@ func (o *Sprite) SetFlipH(flipH gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flip_v bool}], Returns: void
+	        Undocumented
+		Args: [{ false flip_v bool}], Returns: void
 */
 func (o *Sprite) SetFlipV(flipV gdnative.Bool) {
 	//log.Println("Calling Sprite.SetFlipV()")
@@ -392,7 +423,7 @@ func (o *Sprite) SetFlipV(flipV gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(flipV)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_flip_v")
+	methodBind := methodBindSpriteSetFlipV.Get()
 
 	// Call the parent method.
 	// void
@@ -402,8 +433,8 @@ func (o *Sprite) SetFlipV(flipV gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false frame int}], Returns: void
+	        Undocumented
+		Args: [{ false frame int}], Returns: void
 */
 func (o *Sprite) SetFrame(frame gdnative.Int) {
 	//log.Println("Calling Sprite.SetFrame()")
@@ -413,7 +444,7 @@ func (o *Sprite) SetFrame(frame gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(frame)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_frame")
+	methodBind := methodBindSpriteSetFrame.Get()
 
 	// Call the parent method.
 	// void
@@ -423,8 +454,8 @@ func (o *Sprite) SetFrame(frame gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false hframes int}], Returns: void
+	        Undocumented
+		Args: [{ false hframes int}], Returns: void
 */
 func (o *Sprite) SetHframes(hframes gdnative.Int) {
 	//log.Println("Calling Sprite.SetHframes()")
@@ -434,7 +465,7 @@ func (o *Sprite) SetHframes(hframes gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(hframes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_hframes")
+	methodBind := methodBindSpriteSetHframes.Get()
 
 	// Call the parent method.
 	// void
@@ -444,18 +475,18 @@ func (o *Sprite) SetHframes(hframes gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false normal_map Texture}], Returns: void
+	        Undocumented
+		Args: [{ false normal_map Texture}], Returns: void
 */
-func (o *Sprite) SetNormalMap(normalMap TextureImplementer) {
+func (o *Sprite) SetNormalMap(normalMap godot.TextureImplementer) {
 	//log.Println("Calling Sprite.SetNormalMap()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(normalMap))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_normal_map")
+	methodBind := methodBindSpriteSetNormalMap.Get()
 
 	// Call the parent method.
 	// void
@@ -465,8 +496,8 @@ func (o *Sprite) SetNormalMap(normalMap TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *Sprite) SetOffset(offset gdnative.Vector2) {
 	//log.Println("Calling Sprite.SetOffset()")
@@ -476,7 +507,7 @@ func (o *Sprite) SetOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_offset")
+	methodBind := methodBindSpriteSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -486,8 +517,8 @@ func (o *Sprite) SetOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Sprite) SetRegion(enabled gdnative.Bool) {
 	//log.Println("Calling Sprite.SetRegion()")
@@ -497,7 +528,7 @@ func (o *Sprite) SetRegion(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_region")
+	methodBind := methodBindSpriteSetRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -507,8 +538,8 @@ func (o *Sprite) SetRegion(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Sprite) SetRegionFilterClip(enabled gdnative.Bool) {
 	//log.Println("Calling Sprite.SetRegionFilterClip()")
@@ -518,7 +549,7 @@ func (o *Sprite) SetRegionFilterClip(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_region_filter_clip")
+	methodBind := methodBindSpriteSetRegionFilterClip.Get()
 
 	// Call the parent method.
 	// void
@@ -528,8 +559,8 @@ func (o *Sprite) SetRegionFilterClip(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rect Rect2}], Returns: void
+	        Undocumented
+		Args: [{ false rect Rect2}], Returns: void
 */
 func (o *Sprite) SetRegionRect(rect gdnative.Rect2) {
 	//log.Println("Calling Sprite.SetRegionRect()")
@@ -539,7 +570,7 @@ func (o *Sprite) SetRegionRect(rect gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_region_rect")
+	methodBind := methodBindSpriteSetRegionRect.Get()
 
 	// Call the parent method.
 	// void
@@ -549,18 +580,18 @@ func (o *Sprite) SetRegionRect(rect gdnative.Rect2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Sprite) SetTexture(texture TextureImplementer) {
+func (o *Sprite) SetTexture(texture godot.TextureImplementer) {
 	//log.Println("Calling Sprite.SetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_texture")
+	methodBind := methodBindSpriteSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -570,8 +601,8 @@ func (o *Sprite) SetTexture(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vframes int}], Returns: void
+	        Undocumented
+		Args: [{ false vframes int}], Returns: void
 */
 func (o *Sprite) SetVframes(vframes gdnative.Int) {
 	//log.Println("Calling Sprite.SetVframes()")
@@ -581,7 +612,7 @@ func (o *Sprite) SetVframes(vframes gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(vframes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite", "set_vframes")
+	methodBind := methodBindSpriteSetVframes.Get()
 
 	// Call the parent method.
 	// void
@@ -590,16 +621,119 @@ func (o *Sprite) SetVframes(vframes gdnative.Int) {
 
 }
 
+// Centered will return the value of the "centered" property.
+func (o *Sprite) Centered() gdnative.Bool {
+	return o.IsCentered()
+}
+
+// FlipH will return the value of the "flip_h" property.
+func (o *Sprite) FlipH() gdnative.Bool {
+	return o.IsFlippedH()
+}
+
+// FlipV will return the value of the "flip_v" property.
+func (o *Sprite) FlipV() gdnative.Bool {
+	return o.IsFlippedV()
+}
+
+// Frame will return the value of the "frame" property.
+func (o *Sprite) Frame() gdnative.Int {
+	return o.GetFrame()
+}
+
+// Hframes will return the value of the "hframes" property.
+func (o *Sprite) Hframes() gdnative.Int {
+	return o.GetHframes()
+}
+
+// NormalMap will return the value of the "normal_map" property.
+func (o *Sprite) NormalMap() godot.TextureImplementer {
+	return o.GetNormalMap()
+}
+
+// Offset will return the value of the "offset" property.
+func (o *Sprite) Offset() gdnative.Vector2 {
+	return o.GetOffset()
+}
+
+// RegionEnabled will return the value of the "region_enabled" property.
+func (o *Sprite) RegionEnabled() gdnative.Bool {
+	return o.IsRegion()
+}
+
+// SetRegionEnabled will set the value of the "region_enabled" property.
+func (o *Sprite) SetRegionEnabled(value gdnative.Bool) {
+	o.SetRegion(value)
+}
+
+// RegionFilterClip will return the value of the "region_filter_clip" property.
+func (o *Sprite) RegionFilterClip() gdnative.Bool {
+	return o.IsRegionFilterClipEnabled()
+}
+
+// RegionRect will return the value of the "region_rect" property.
+func (o *Sprite) RegionRect() gdnative.Rect2 {
+	return o.GetRegionRect()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *Sprite) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// Vframes will return the value of the "vframes" property.
+func (o *Sprite) Vframes() gdnative.Int {
+	return o.GetVframes()
+}
+
+// Signals of the Sprite class.
+const (
+	SpriteSignalFrameChanged   gdnative.String = "frame_changed"
+	SpriteSignalTextureChanged gdnative.String = "texture_changed"
+)
+
+// ConnectFrameChanged will connect the "frame_changed" signal to the given method of the target object.
+func (o *Sprite) ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SpriteSignalFrameChanged, target, method, binds, 0)
+}
+
+// EmitFrameChanged will emit the "frame_changed" signal.
+func (o *Sprite) EmitFrameChanged() error {
+
+	ret, err := o.EmitSignal(SpriteSignalFrameChanged)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTextureChanged will connect the "texture_changed" signal to the given method of the target object.
+func (o *Sprite) ConnectTextureChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SpriteSignalTextureChanged, target, method, binds, 0)
+}
+
+// EmitTextureChanged will emit the "texture_changed" signal.
+func (o *Sprite) EmitTextureChanged() error {
+
+	ret, err := o.EmitSignal(SpriteSignalTextureChanged)
+	ret.Destroy()
+	return err
+}
+
 // SpriteImplementer is an interface that implements the methods
 // of the Sprite class.
 type SpriteImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	GetFrame() gdnative.Int
 	GetHframes() gdnative.Int
-	GetNormalMap() TextureImplementer
+	GetNormalMap() godot.TextureImplementer
 	GetOffset() gdnative.Vector2
 	GetRegionRect() gdnative.Rect2
-	GetTexture() TextureImplementer
+	GetTexture() godot.TextureImplementer
 	GetVframes() gdnative.Int
 	IsCentered() gdnative.Bool
 	IsFlippedH() gdnative.Bool
@@ -611,11 +745,28 @@ type SpriteImplementer interface {
 	SetFlipV(flipV gdnative.Bool)
 	SetFrame(frame gdnative.Int)
 	SetHframes(hframes gdnative.Int)
-	SetNormalMap(normalMap TextureImplementer)
+	SetNormalMap(normalMap godot.TextureImplementer)
 	SetOffset(offset gdnative.Vector2)
 	SetRegion(enabled gdnative.Bool)
 	SetRegionFilterClip(enabled gdnative.Bool)
 	SetRegionRect(rect gdnative.Rect2)
-	SetTexture(texture TextureImplementer)
+	SetTexture(texture godot.TextureImplementer)
 	SetVframes(vframes gdnative.Int)
+	Centered() gdnative.Bool
+	FlipH() gdnative.Bool
+	FlipV() gdnative.Bool
+	Frame() gdnative.Int
+	Hframes() gdnative.Int
+	NormalMap() godot.TextureImplementer
+	Offset() gdnative.Vector2
+	RegionEnabled() gdnative.Bool
+	SetRegionEnabled(value gdnative.Bool)
+	RegionFilterClip() gdnative.Bool
+	RegionRect() gdnative.Rect2
+	Texture() godot.TextureImplementer
+	Vframes() gdnative.Int
+	ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFrameChanged() error
+	ConnectTextureChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTextureChanged() error
 }
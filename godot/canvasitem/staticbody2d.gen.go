@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,48 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStaticBody2DFromPointer(ptr gdnative.Pointer) StaticBody2D {
-func newStaticBody2DFromPointer(ptr gdnative.Pointer) StaticBody2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StaticBody2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Static body for 2D Physics. A StaticBody2D is a body that is not intended to move. It is ideal for implementing objects in the environment, such as walls or platforms. Additionally, a constant linear or angular velocity can be set for the static body, which will affect colliding bodies as if it were moving (for example, a conveyor belt).
 */
 type StaticBody2D struct {
-	PhysicsBody2D
+	godot.PhysicsBody2D
 	owner gdnative.Object
 }
 
+// classConstructorStaticBody2D is the class constructor of the StaticBody2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStaticBody2D = gdnative.NewLazyClassConstructor("StaticBody2D")
+
+// NewStaticBody2D will create a new instance of the StaticBody2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewStaticBody2D() *StaticBody2D {
+	obj := &StaticBody2D{}
+	obj.SetBaseObject(classConstructorStaticBody2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *StaticBody2D) BaseClass() string {
 	return "StaticBody2D"
 }
 
+// Method binds of the StaticBody2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStaticBody2DGetBounce                  = gdnative.NewLazyMethodBind("StaticBody2D", "get_bounce")
+	methodBindStaticBody2DGetConstantAngularVelocity = gdnative.NewLazyMethodBind("StaticBody2D", "get_constant_angular_velocity")
+	methodBindStaticBody2DGetConstantLinearVelocity  = gdnative.NewLazyMethodBind("StaticBody2D", "get_constant_linear_velocity")
+	methodBindStaticBody2DGetFriction                = gdnative.NewLazyMethodBind("StaticBody2D", "get_friction")
+	methodBindStaticBody2DSetBounce                  = gdnative.NewLazyMethodBind("StaticBody2D", "set_bounce")
+	methodBindStaticBody2DSetConstantAngularVelocity = gdnative.NewLazyMethodBind("StaticBody2D", "set_constant_angular_velocity")
+	methodBindStaticBody2DSetConstantLinearVelocity  = gdnative.NewLazyMethodBind("StaticBody2D", "set_constant_linear_velocity")
+	methodBindStaticBody2DSetFriction                = gdnative.NewLazyMethodBind("StaticBody2D", "set_friction")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *StaticBody2D) GetBounce() gdnative.Real {
 	//log.Println("Calling StaticBody2D.GetBounce()")
@@ -45,7 +64,7 @@ func (o *StaticBody2D) GetBounce() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "get_bounce")
+	methodBind := methodBindStaticBody2DGetBounce.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +77,8 @@ func (o *StaticBody2D) GetBounce() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *StaticBody2D) GetConstantAngularVelocity() gdnative.Real {
 	//log.Println("Calling StaticBody2D.GetConstantAngularVelocity()")
@@ -68,7 +87,7 @@ func (o *StaticBody2D) GetConstantAngularVelocity() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "get_constant_angular_velocity")
+	methodBind := methodBindStaticBody2DGetConstantAngularVelocity.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +100,8 @@ func (o *StaticBody2D) GetConstantAngularVelocity() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *StaticBody2D) GetConstantLinearVelocity() gdnative.Vector2 {
 	//log.Println("Calling StaticBody2D.GetConstantLinearVelocity()")
@@ -91,7 +110,7 @@ func (o *StaticBody2D) GetConstantLinearVelocity() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "get_constant_linear_velocity")
+	methodBind := methodBindStaticBody2DGetConstantLinearVelocity.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -104,8 +123,8 @@ func (o *StaticBody2D) GetConstantLinearVelocity() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *StaticBody2D) GetFriction() gdnative.Real {
 	//log.Println("Calling StaticBody2D.GetFriction()")
@@ -114,7 +133,7 @@ func (o *StaticBody2D) GetFriction() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "get_friction")
+	methodBind := methodBindStaticBody2DGetFriction.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +146,8 @@ func (o *StaticBody2D) GetFriction() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false bounce float}], Returns: void
+	        Undocumented
+		Args: [{ false bounce float}], Returns: void
 */
 func (o *StaticBody2D) SetBounce(bounce gdnative.Real) {
 	//log.Println("Calling StaticBody2D.SetBounce()")
@@ -138,7 +157,7 @@ func (o *StaticBody2D) SetBounce(bounce gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(bounce)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "set_bounce")
+	methodBind := methodBindStaticBody2DSetBounce.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +167,8 @@ func (o *StaticBody2D) SetBounce(bounce gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vel float}], Returns: void
+	        Undocumented
+		Args: [{ false vel float}], Returns: void
 */
 func (o *StaticBody2D) SetConstantAngularVelocity(vel gdnative.Real) {
 	//log.Println("Calling StaticBody2D.SetConstantAngularVelocity()")
@@ -159,7 +178,7 @@ func (o *StaticBody2D) SetConstantAngularVelocity(vel gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(vel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "set_constant_angular_velocity")
+	methodBind := methodBindStaticBody2DSetConstantAngularVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +188,8 @@ func (o *StaticBody2D) SetConstantAngularVelocity(vel gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vel Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false vel Vector2}], Returns: void
 */
 func (o *StaticBody2D) SetConstantLinearVelocity(vel gdnative.Vector2) {
 	//log.Println("Calling StaticBody2D.SetConstantLinearVelocity()")
@@ -180,7 +199,7 @@ func (o *StaticBody2D) SetConstantLinearVelocity(vel gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(vel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "set_constant_linear_velocity")
+	methodBind := methodBindStaticBody2DSetConstantLinearVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +209,8 @@ func (o *StaticBody2D) SetConstantLinearVelocity(vel gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false friction float}], Returns: void
+	        Undocumented
+		Args: [{ false friction float}], Returns: void
 */
 func (o *StaticBody2D) SetFriction(friction gdnative.Real) {
 	//log.Println("Calling StaticBody2D.SetFriction()")
@@ -201,7 +220,7 @@ func (o *StaticBody2D) SetFriction(friction gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(friction)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody2D", "set_friction")
+	methodBind := methodBindStaticBody2DSetFriction.Get()
 
 	// Call the parent method.
 	// void
@@ -210,10 +229,30 @@ func (o *StaticBody2D) SetFriction(friction gdnative.Real) {
 
 }
 
+// Bounce will return the value of the "bounce" property.
+func (o *StaticBody2D) Bounce() gdnative.Real {
+	return o.GetBounce()
+}
+
+// ConstantAngularVelocity will return the value of the "constant_angular_velocity" property.
+func (o *StaticBody2D) ConstantAngularVelocity() gdnative.Real {
+	return o.GetConstantAngularVelocity()
+}
+
+// ConstantLinearVelocity will return the value of the "constant_linear_velocity" property.
+func (o *StaticBody2D) ConstantLinearVelocity() gdnative.Vector2 {
+	return o.GetConstantLinearVelocity()
+}
+
+// Friction will return the value of the "friction" property.
+func (o *StaticBody2D) Friction() gdnative.Real {
+	return o.GetFriction()
+}
+
 // StaticBody2DImplementer is an interface that implements the methods
 // of the StaticBody2D class.
 type StaticBody2DImplementer interface {
-	PhysicsBody2DImplementer
+	godot.PhysicsBody2DImplementer
 	GetBounce() gdnative.Real
 	GetConstantAngularVelocity() gdnative.Real
 	GetConstantLinearVelocity() gdnative.Vector2
@@ -222,4 +261,8 @@ type StaticBody2DImplementer interface {
 	SetConstantAngularVelocity(vel gdnative.Real)
 	SetConstantLinearVelocity(vel gdnative.Vector2)
 	SetFriction(friction gdnative.Real)
+	Bounce() gdnative.Real
+	ConstantAngularVelocity() gdnative.Real
+	ConstantLinearVelocity() gdnative.Vector2
+	Friction() gdnative.Real
 }
@@ -1,7 +1,11 @@
-package godot
+package canvasitem
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +26,32 @@ const (
 	TileMapHalfOffsetY        TileMapHalfOffset = 1
 )
 
+// String will return the Godot name of the TileMapHalfOffset value.
+func (e TileMapHalfOffset) String() string {
+	switch e {
+	case TileMapHalfOffsetDisabled:
+		return "HALF_OFFSET_DISABLED"
+	case TileMapHalfOffsetX:
+		return "HALF_OFFSET_X"
+	case TileMapHalfOffsetY:
+		return "HALF_OFFSET_Y"
+	}
+	return fmt.Sprintf("TileMapHalfOffset(%d)", int(e))
+}
+
+// ParseTileMapHalfOffset will return the TileMapHalfOffset value with the given Godot name.
+func ParseTileMapHalfOffset(name string) (TileMapHalfOffset, error) {
+	switch name {
+	case "HALF_OFFSET_DISABLED":
+		return TileMapHalfOffsetDisabled, nil
+	case "HALF_OFFSET_X":
+		return TileMapHalfOffsetX, nil
+	case "HALF_OFFSET_Y":
+		return TileMapHalfOffsetY, nil
+	}
+	return 0, fmt.Errorf("invalid TileMapHalfOffset value %q", name)
+}
+
 // TileMapMode is an enum for Mode values.
 type TileMapMode int
 
@@ -31,6 +61,32 @@ const (
 	TileMapModeSquare    TileMapMode = 0
 )
 
+// String will return the Godot name of the TileMapMode value.
+func (e TileMapMode) String() string {
+	switch e {
+	case TileMapModeCustom:
+		return "MODE_CUSTOM"
+	case TileMapModeIsometric:
+		return "MODE_ISOMETRIC"
+	case TileMapModeSquare:
+		return "MODE_SQUARE"
+	}
+	return fmt.Sprintf("TileMapMode(%d)", int(e))
+}
+
+// ParseTileMapMode will return the TileMapMode value with the given Godot name.
+func ParseTileMapMode(name string) (TileMapMode, error) {
+	switch name {
+	case "MODE_CUSTOM":
+		return TileMapModeCustom, nil
+	case "MODE_ISOMETRIC":
+		return TileMapModeIsometric, nil
+	case "MODE_SQUARE":
+		return TileMapModeSquare, nil
+	}
+	return 0, fmt.Errorf("invalid TileMapMode value %q", name)
+}
+
 // TileMapTileOrigin is an enum for TileOrigin values.
 type TileMapTileOrigin int
 
@@ -40,30 +96,127 @@ const (
 	TileMapTileOriginTopLeft    TileMapTileOrigin = 0
 )
 
-//func NewTileMapFromPointer(ptr gdnative.Pointer) TileMap {
-func newTileMapFromPointer(ptr gdnative.Pointer) TileMap {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := TileMap{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the TileMapTileOrigin value.
+func (e TileMapTileOrigin) String() string {
+	switch e {
+	case TileMapTileOriginBottomLeft:
+		return "TILE_ORIGIN_BOTTOM_LEFT"
+	case TileMapTileOriginCenter:
+		return "TILE_ORIGIN_CENTER"
+	case TileMapTileOriginTopLeft:
+		return "TILE_ORIGIN_TOP_LEFT"
+	}
+	return fmt.Sprintf("TileMapTileOrigin(%d)", int(e))
+}
 
-	return obj
+// ParseTileMapTileOrigin will return the TileMapTileOrigin value with the given Godot name.
+func ParseTileMapTileOrigin(name string) (TileMapTileOrigin, error) {
+	switch name {
+	case "TILE_ORIGIN_BOTTOM_LEFT":
+		return TileMapTileOriginBottomLeft, nil
+	case "TILE_ORIGIN_CENTER":
+		return TileMapTileOriginCenter, nil
+	case "TILE_ORIGIN_TOP_LEFT":
+		return TileMapTileOriginTopLeft, nil
+	}
+	return 0, fmt.Errorf("invalid TileMapTileOrigin value %q", name)
 }
 
+// Constants of the TileMap class.
+const (
+	TileMapInvalidCell gdnative.Int = -1
+)
+
 /*
 Node for 2D tile-based maps. Tilemaps use a [TileSet] which contain a list of tiles (textures plus optional collision, navigation, and/or occluder shapes) which are used to create grid-based maps.
 */
 type TileMap struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorTileMap is the class constructor of the TileMap class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTileMap = gdnative.NewLazyClassConstructor("TileMap")
+
+// NewTileMap will create a new instance of the TileMap class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTileMap() *TileMap {
+	obj := &TileMap{}
+	obj.SetBaseObject(classConstructorTileMap.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *TileMap) BaseClass() string {
 	return "TileMap"
 }
 
+// Method binds of the TileMap class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTileMapX_ClearQuadrants         = gdnative.NewLazyMethodBind("TileMap", "_clear_quadrants")
+	methodBindTileMapX_GetOldCellSize         = gdnative.NewLazyMethodBind("TileMap", "_get_old_cell_size")
+	methodBindTileMapX_GetTileData            = gdnative.NewLazyMethodBind("TileMap", "_get_tile_data")
+	methodBindTileMapX_RecreateQuadrants      = gdnative.NewLazyMethodBind("TileMap", "_recreate_quadrants")
+	methodBindTileMapX_SetOldCellSize         = gdnative.NewLazyMethodBind("TileMap", "_set_old_cell_size")
+	methodBindTileMapX_SetTileData            = gdnative.NewLazyMethodBind("TileMap", "_set_tile_data")
+	methodBindTileMapX_UpdateDirtyQuadrants   = gdnative.NewLazyMethodBind("TileMap", "_update_dirty_quadrants")
+	methodBindTileMapClear                    = gdnative.NewLazyMethodBind("TileMap", "clear")
+	methodBindTileMapGetCell                  = gdnative.NewLazyMethodBind("TileMap", "get_cell")
+	methodBindTileMapGetCellSize              = gdnative.NewLazyMethodBind("TileMap", "get_cell_size")
+	methodBindTileMapGetCellv                 = gdnative.NewLazyMethodBind("TileMap", "get_cellv")
+	methodBindTileMapGetClipUv                = gdnative.NewLazyMethodBind("TileMap", "get_clip_uv")
+	methodBindTileMapGetCollisionBounce       = gdnative.NewLazyMethodBind("TileMap", "get_collision_bounce")
+	methodBindTileMapGetCollisionFriction     = gdnative.NewLazyMethodBind("TileMap", "get_collision_friction")
+	methodBindTileMapGetCollisionLayer        = gdnative.NewLazyMethodBind("TileMap", "get_collision_layer")
+	methodBindTileMapGetCollisionLayerBit     = gdnative.NewLazyMethodBind("TileMap", "get_collision_layer_bit")
+	methodBindTileMapGetCollisionMask         = gdnative.NewLazyMethodBind("TileMap", "get_collision_mask")
+	methodBindTileMapGetCollisionMaskBit      = gdnative.NewLazyMethodBind("TileMap", "get_collision_mask_bit")
+	methodBindTileMapGetCollisionUseKinematic = gdnative.NewLazyMethodBind("TileMap", "get_collision_use_kinematic")
+	methodBindTileMapGetCustomTransform       = gdnative.NewLazyMethodBind("TileMap", "get_custom_transform")
+	methodBindTileMapGetHalfOffset            = gdnative.NewLazyMethodBind("TileMap", "get_half_offset")
+	methodBindTileMapGetMode                  = gdnative.NewLazyMethodBind("TileMap", "get_mode")
+	methodBindTileMapGetOccluderLightMask     = gdnative.NewLazyMethodBind("TileMap", "get_occluder_light_mask")
+	methodBindTileMapGetQuadrantSize          = gdnative.NewLazyMethodBind("TileMap", "get_quadrant_size")
+	methodBindTileMapGetTileOrigin            = gdnative.NewLazyMethodBind("TileMap", "get_tile_origin")
+	methodBindTileMapGetTileset               = gdnative.NewLazyMethodBind("TileMap", "get_tileset")
+	methodBindTileMapGetUsedCells             = gdnative.NewLazyMethodBind("TileMap", "get_used_cells")
+	methodBindTileMapGetUsedCellsById         = gdnative.NewLazyMethodBind("TileMap", "get_used_cells_by_id")
+	methodBindTileMapGetUsedRect              = gdnative.NewLazyMethodBind("TileMap", "get_used_rect")
+	methodBindTileMapIsCellTransposed         = gdnative.NewLazyMethodBind("TileMap", "is_cell_transposed")
+	methodBindTileMapIsCellXFlipped           = gdnative.NewLazyMethodBind("TileMap", "is_cell_x_flipped")
+	methodBindTileMapIsCellYFlipped           = gdnative.NewLazyMethodBind("TileMap", "is_cell_y_flipped")
+	methodBindTileMapIsYSortModeEnabled       = gdnative.NewLazyMethodBind("TileMap", "is_y_sort_mode_enabled")
+	methodBindTileMapMapToWorld               = gdnative.NewLazyMethodBind("TileMap", "map_to_world")
+	methodBindTileMapSetCell                  = gdnative.NewLazyMethodBind("TileMap", "set_cell")
+	methodBindTileMapSetCellSize              = gdnative.NewLazyMethodBind("TileMap", "set_cell_size")
+	methodBindTileMapSetCellv                 = gdnative.NewLazyMethodBind("TileMap", "set_cellv")
+	methodBindTileMapSetClipUv                = gdnative.NewLazyMethodBind("TileMap", "set_clip_uv")
+	methodBindTileMapSetCollisionBounce       = gdnative.NewLazyMethodBind("TileMap", "set_collision_bounce")
+	methodBindTileMapSetCollisionFriction     = gdnative.NewLazyMethodBind("TileMap", "set_collision_friction")
+	methodBindTileMapSetCollisionLayer        = gdnative.NewLazyMethodBind("TileMap", "set_collision_layer")
+	methodBindTileMapSetCollisionLayerBit     = gdnative.NewLazyMethodBind("TileMap", "set_collision_layer_bit")
+	methodBindTileMapSetCollisionMask         = gdnative.NewLazyMethodBind("TileMap", "set_collision_mask")
+	methodBindTileMapSetCollisionMaskBit      = gdnative.NewLazyMethodBind("TileMap", "set_collision_mask_bit")
+	methodBindTileMapSetCollisionUseKinematic = gdnative.NewLazyMethodBind("TileMap", "set_collision_use_kinematic")
+	methodBindTileMapSetCustomTransform       = gdnative.NewLazyMethodBind("TileMap", "set_custom_transform")
+	methodBindTileMapSetHalfOffset            = gdnative.NewLazyMethodBind("TileMap", "set_half_offset")
+	methodBindTileMapSetMode                  = gdnative.NewLazyMethodBind("TileMap", "set_mode")
+	methodBindTileMapSetOccluderLightMask     = gdnative.NewLazyMethodBind("TileMap", "set_occluder_light_mask")
+	methodBindTileMapSetQuadrantSize          = gdnative.NewLazyMethodBind("TileMap", "set_quadrant_size")
+	methodBindTileMapSetTileOrigin            = gdnative.NewLazyMethodBind("TileMap", "set_tile_origin")
+	methodBindTileMapSetTileset               = gdnative.NewLazyMethodBind("TileMap", "set_tileset")
+	methodBindTileMapSetYSortMode             = gdnative.NewLazyMethodBind("TileMap", "set_y_sort_mode")
+	methodBindTileMapUpdateBitmaskArea        = gdnative.NewLazyMethodBind("TileMap", "update_bitmask_area")
+	methodBindTileMapUpdateBitmaskRegion      = gdnative.NewLazyMethodBind("TileMap", "update_bitmask_region")
+	methodBindTileMapWorldToMap               = gdnative.NewLazyMethodBind("TileMap", "world_to_map")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TileMap) X_ClearQuadrants() {
 	//log.Println("Calling TileMap.X_ClearQuadrants()")
@@ -72,7 +225,7 @@ func (o *TileMap) X_ClearQuadrants() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "_clear_quadrants")
+	methodBind := methodBindTileMapX_ClearQuadrants.Get()
 
 	// Call the parent method.
 	// void
@@ -82,8 +235,8 @@ func (o *TileMap) X_ClearQuadrants() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *TileMap) X_GetOldCellSize() gdnative.Int {
 	//log.Println("Calling TileMap.X_GetOldCellSize()")
@@ -92,7 +245,7 @@ func (o *TileMap) X_GetOldCellSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "_get_old_cell_size")
+	methodBind := methodBindTileMapX_GetOldCellSize.Get()
 
 	// Call the parent method.
 	// int
@@ -105,8 +258,8 @@ func (o *TileMap) X_GetOldCellSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolIntArray
+	        Undocumented
+		Args: [], Returns: PoolIntArray
 */
 func (o *TileMap) X_GetTileData() gdnative.PoolIntArray {
 	//log.Println("Calling TileMap.X_GetTileData()")
@@ -115,7 +268,7 @@ func (o *TileMap) X_GetTileData() gdnative.PoolIntArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "_get_tile_data")
+	methodBind := methodBindTileMapX_GetTileData.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -128,8 +281,8 @@ func (o *TileMap) X_GetTileData() gdnative.PoolIntArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TileMap) X_RecreateQuadrants() {
 	//log.Println("Calling TileMap.X_RecreateQuadrants()")
@@ -138,7 +291,7 @@ func (o *TileMap) X_RecreateQuadrants() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "_recreate_quadrants")
+	methodBind := methodBindTileMapX_RecreateQuadrants.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +301,8 @@ func (o *TileMap) X_RecreateQuadrants() {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size int}], Returns: void
 */
 func (o *TileMap) X_SetOldCellSize(size gdnative.Int) {
 	//log.Println("Calling TileMap.X_SetOldCellSize()")
@@ -159,7 +312,7 @@ func (o *TileMap) X_SetOldCellSize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "_set_old_cell_size")
+	methodBind := methodBindTileMapX_SetOldCellSize.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +322,8 @@ func (o *TileMap) X_SetOldCellSize(size gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 PoolIntArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 PoolIntArray}], Returns: void
 */
 func (o *TileMap) X_SetTileData(arg0 gdnative.PoolIntArray) {
 	//log.Println("Calling TileMap.X_SetTileData()")
@@ -180,7 +333,7 @@ func (o *TileMap) X_SetTileData(arg0 gdnative.PoolIntArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolIntArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "_set_tile_data")
+	methodBind := methodBindTileMapX_SetTileData.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +343,8 @@ func (o *TileMap) X_SetTileData(arg0 gdnative.PoolIntArray) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TileMap) X_UpdateDirtyQuadrants() {
 	//log.Println("Calling TileMap.X_UpdateDirtyQuadrants()")
@@ -200,7 +353,7 @@ func (o *TileMap) X_UpdateDirtyQuadrants() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "_update_dirty_quadrants")
+	methodBind := methodBindTileMapX_UpdateDirtyQuadrants.Get()
 
 	// Call the parent method.
 	// void
@@ -210,8 +363,8 @@ func (o *TileMap) X_UpdateDirtyQuadrants() {
 }
 
 /*
-        Clear all cells.
-	Args: [], Returns: void
+	        Clear all cells.
+		Args: [], Returns: void
 */
 func (o *TileMap) Clear() {
 	//log.Println("Calling TileMap.Clear()")
@@ -220,7 +373,7 @@ func (o *TileMap) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "clear")
+	methodBind := methodBindTileMapClear.Get()
 
 	// Call the parent method.
 	// void
@@ -230,8 +383,8 @@ func (o *TileMap) Clear() {
 }
 
 /*
-        Return the tile index of the referenced cell.
-	Args: [{ false x int} { false y int}], Returns: int
+	        Return the tile index of the referenced cell.
+		Args: [{ false x int} { false y int}], Returns: int
 */
 func (o *TileMap) GetCell(x gdnative.Int, y gdnative.Int) gdnative.Int {
 	//log.Println("Calling TileMap.GetCell()")
@@ -242,7 +395,7 @@ func (o *TileMap) GetCell(x gdnative.Int, y gdnative.Int) gdnative.Int {
 	ptrArguments[1] = gdnative.NewPointerFromInt(y)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_cell")
+	methodBind := methodBindTileMapGetCell.Get()
 
 	// Call the parent method.
 	// int
@@ -255,8 +408,8 @@ func (o *TileMap) GetCell(x gdnative.Int, y gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *TileMap) GetCellSize() gdnative.Vector2 {
 	//log.Println("Calling TileMap.GetCellSize()")
@@ -265,7 +418,7 @@ func (o *TileMap) GetCellSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_cell_size")
+	methodBind := methodBindTileMapGetCellSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -278,8 +431,8 @@ func (o *TileMap) GetCellSize() gdnative.Vector2 {
 }
 
 /*
-        Return the tile index of the cell referenced by a Vector2.
-	Args: [{ false position Vector2}], Returns: int
+	        Return the tile index of the cell referenced by a Vector2.
+		Args: [{ false position Vector2}], Returns: int
 */
 func (o *TileMap) GetCellv(position gdnative.Vector2) gdnative.Int {
 	//log.Println("Calling TileMap.GetCellv()")
@@ -289,7 +442,7 @@ func (o *TileMap) GetCellv(position gdnative.Vector2) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_cellv")
+	methodBind := methodBindTileMapGetCellv.Get()
 
 	// Call the parent method.
 	// int
@@ -302,8 +455,8 @@ func (o *TileMap) GetCellv(position gdnative.Vector2) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TileMap) GetClipUv() gdnative.Bool {
 	//log.Println("Calling TileMap.GetClipUv()")
@@ -312,7 +465,7 @@ func (o *TileMap) GetClipUv() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_clip_uv")
+	methodBind := methodBindTileMapGetClipUv.Get()
 
 	// Call the parent method.
 	// bool
@@ -325,8 +478,8 @@ func (o *TileMap) GetClipUv() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *TileMap) GetCollisionBounce() gdnative.Real {
 	//log.Println("Calling TileMap.GetCollisionBounce()")
@@ -335,7 +488,7 @@ func (o *TileMap) GetCollisionBounce() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_collision_bounce")
+	methodBind := methodBindTileMapGetCollisionBounce.Get()
 
 	// Call the parent method.
 	// float
@@ -348,8 +501,8 @@ func (o *TileMap) GetCollisionBounce() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *TileMap) GetCollisionFriction() gdnative.Real {
 	//log.Println("Calling TileMap.GetCollisionFriction()")
@@ -358,7 +511,7 @@ func (o *TileMap) GetCollisionFriction() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_collision_friction")
+	methodBind := methodBindTileMapGetCollisionFriction.Get()
 
 	// Call the parent method.
 	// float
@@ -371,8 +524,8 @@ func (o *TileMap) GetCollisionFriction() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *TileMap) GetCollisionLayer() gdnative.Int {
 	//log.Println("Calling TileMap.GetCollisionLayer()")
@@ -381,7 +534,7 @@ func (o *TileMap) GetCollisionLayer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_collision_layer")
+	methodBind := methodBindTileMapGetCollisionLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -394,8 +547,7 @@ func (o *TileMap) GetCollisionLayer() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false bit int}], Returns: bool
+Args: [{ false bit int}], Returns: bool
 */
 func (o *TileMap) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TileMap.GetCollisionLayerBit()")
@@ -405,7 +557,7 @@ func (o *TileMap) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_collision_layer_bit")
+	methodBind := methodBindTileMapGetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -418,8 +570,8 @@ func (o *TileMap) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *TileMap) GetCollisionMask() gdnative.Int {
 	//log.Println("Calling TileMap.GetCollisionMask()")
@@ -428,7 +580,7 @@ func (o *TileMap) GetCollisionMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_collision_mask")
+	methodBind := methodBindTileMapGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -441,8 +593,7 @@ func (o *TileMap) GetCollisionMask() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false bit int}], Returns: bool
+Args: [{ false bit int}], Returns: bool
 */
 func (o *TileMap) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TileMap.GetCollisionMaskBit()")
@@ -452,7 +603,7 @@ func (o *TileMap) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_collision_mask_bit")
+	methodBind := methodBindTileMapGetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -465,8 +616,8 @@ func (o *TileMap) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TileMap) GetCollisionUseKinematic() gdnative.Bool {
 	//log.Println("Calling TileMap.GetCollisionUseKinematic()")
@@ -475,7 +626,7 @@ func (o *TileMap) GetCollisionUseKinematic() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_collision_use_kinematic")
+	methodBind := methodBindTileMapGetCollisionUseKinematic.Get()
 
 	// Call the parent method.
 	// bool
@@ -488,8 +639,8 @@ func (o *TileMap) GetCollisionUseKinematic() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform2D
+	        Undocumented
+		Args: [], Returns: Transform2D
 */
 func (o *TileMap) GetCustomTransform() gdnative.Transform2D {
 	//log.Println("Calling TileMap.GetCustomTransform()")
@@ -498,7 +649,7 @@ func (o *TileMap) GetCustomTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_custom_transform")
+	methodBind := methodBindTileMapGetCustomTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -511,8 +662,8 @@ func (o *TileMap) GetCustomTransform() gdnative.Transform2D {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.TileMap::HalfOffset
+	        Undocumented
+		Args: [], Returns: enum.TileMap::HalfOffset
 */
 func (o *TileMap) GetHalfOffset() TileMapHalfOffset {
 	//log.Println("Calling TileMap.GetHalfOffset()")
@@ -521,7 +672,7 @@ func (o *TileMap) GetHalfOffset() TileMapHalfOffset {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_half_offset")
+	methodBind := methodBindTileMapGetHalfOffset.Get()
 
 	// Call the parent method.
 	// enum.TileMap::HalfOffset
@@ -534,8 +685,8 @@ func (o *TileMap) GetHalfOffset() TileMapHalfOffset {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.TileMap::Mode
+	        Undocumented
+		Args: [], Returns: enum.TileMap::Mode
 */
 func (o *TileMap) GetMode() TileMapMode {
 	//log.Println("Calling TileMap.GetMode()")
@@ -544,7 +695,7 @@ func (o *TileMap) GetMode() TileMapMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_mode")
+	methodBind := methodBindTileMapGetMode.Get()
 
 	// Call the parent method.
 	// enum.TileMap::Mode
@@ -557,8 +708,8 @@ func (o *TileMap) GetMode() TileMapMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *TileMap) GetOccluderLightMask() gdnative.Int {
 	//log.Println("Calling TileMap.GetOccluderLightMask()")
@@ -567,7 +718,7 @@ func (o *TileMap) GetOccluderLightMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_occluder_light_mask")
+	methodBind := methodBindTileMapGetOccluderLightMask.Get()
 
 	// Call the parent method.
 	// int
@@ -580,8 +731,8 @@ func (o *TileMap) GetOccluderLightMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *TileMap) GetQuadrantSize() gdnative.Int {
 	//log.Println("Calling TileMap.GetQuadrantSize()")
@@ -590,7 +741,7 @@ func (o *TileMap) GetQuadrantSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_quadrant_size")
+	methodBind := methodBindTileMapGetQuadrantSize.Get()
 
 	// Call the parent method.
 	// int
@@ -603,8 +754,8 @@ func (o *TileMap) GetQuadrantSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.TileMap::TileOrigin
+	        Undocumented
+		Args: [], Returns: enum.TileMap::TileOrigin
 */
 func (o *TileMap) GetTileOrigin() TileMapTileOrigin {
 	//log.Println("Calling TileMap.GetTileOrigin()")
@@ -613,7 +764,7 @@ func (o *TileMap) GetTileOrigin() TileMapTileOrigin {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_tile_origin")
+	methodBind := methodBindTileMapGetTileOrigin.Get()
 
 	// Call the parent method.
 	// enum.TileMap::TileOrigin
@@ -626,17 +777,17 @@ func (o *TileMap) GetTileOrigin() TileMapTileOrigin {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: TileSet
+	        Undocumented
+		Args: [], Returns: TileSet
 */
-func (o *TileMap) GetTileset() TileSetImplementer {
+func (o *TileMap) GetTileset() resource.TileSetImplementer {
 	//log.Println("Calling TileMap.GetTileset()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_tileset")
+	methodBind := methodBindTileMapGetTileset.Get()
 
 	// Call the parent method.
 	// TileSet
@@ -644,27 +795,25 @@ func (o *TileMap) GetTileset() TileSetImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTileSetFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TileSetImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TileSet" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TileSetImplementer)
+	ret := resource.TileSet{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.TileSetImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return an array of all cells containing a tile from the tileset (i.e. a tile index different from -1).
-	Args: [], Returns: Array
+	        Return an array of all cells containing a tile from the tileset (i.e. a tile index different from -1).
+		Args: [], Returns: Array
 */
 func (o *TileMap) GetUsedCells() gdnative.Array {
 	//log.Println("Calling TileMap.GetUsedCells()")
@@ -673,7 +822,7 @@ func (o *TileMap) GetUsedCells() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_used_cells")
+	methodBind := methodBindTileMapGetUsedCells.Get()
 
 	// Call the parent method.
 	// Array
@@ -686,8 +835,7 @@ func (o *TileMap) GetUsedCells() gdnative.Array {
 }
 
 /*
-
-	Args: [{ false id int}], Returns: Array
+Args: [{ false id int}], Returns: Array
 */
 func (o *TileMap) GetUsedCellsById(id gdnative.Int) gdnative.Array {
 	//log.Println("Calling TileMap.GetUsedCellsById()")
@@ -697,7 +845,7 @@ func (o *TileMap) GetUsedCellsById(id gdnative.Int) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_used_cells_by_id")
+	methodBind := methodBindTileMapGetUsedCellsById.Get()
 
 	// Call the parent method.
 	// Array
@@ -710,8 +858,7 @@ func (o *TileMap) GetUsedCellsById(id gdnative.Int) gdnative.Array {
 }
 
 /*
-
-	Args: [], Returns: Rect2
+Args: [], Returns: Rect2
 */
 func (o *TileMap) GetUsedRect() gdnative.Rect2 {
 	//log.Println("Calling TileMap.GetUsedRect()")
@@ -720,7 +867,7 @@ func (o *TileMap) GetUsedRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "get_used_rect")
+	methodBind := methodBindTileMapGetUsedRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -733,8 +880,8 @@ func (o *TileMap) GetUsedRect() gdnative.Rect2 {
 }
 
 /*
-        Return whether the referenced cell is transposed, i.e. the X and Y axes are swapped (mirroring with regard to the (1,1) vector).
-	Args: [{ false x int} { false y int}], Returns: bool
+	        Return whether the referenced cell is transposed, i.e. the X and Y axes are swapped (mirroring with regard to the (1,1) vector).
+		Args: [{ false x int} { false y int}], Returns: bool
 */
 func (o *TileMap) IsCellTransposed(x gdnative.Int, y gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TileMap.IsCellTransposed()")
@@ -745,7 +892,7 @@ func (o *TileMap) IsCellTransposed(x gdnative.Int, y gdnative.Int) gdnative.Bool
 	ptrArguments[1] = gdnative.NewPointerFromInt(y)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "is_cell_transposed")
+	methodBind := methodBindTileMapIsCellTransposed.Get()
 
 	// Call the parent method.
 	// bool
@@ -758,8 +905,8 @@ func (o *TileMap) IsCellTransposed(x gdnative.Int, y gdnative.Int) gdnative.Bool
 }
 
 /*
-        Return whether the referenced cell is flipped over the X axis.
-	Args: [{ false x int} { false y int}], Returns: bool
+	        Return whether the referenced cell is flipped over the X axis.
+		Args: [{ false x int} { false y int}], Returns: bool
 */
 func (o *TileMap) IsCellXFlipped(x gdnative.Int, y gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TileMap.IsCellXFlipped()")
@@ -770,7 +917,7 @@ func (o *TileMap) IsCellXFlipped(x gdnative.Int, y gdnative.Int) gdnative.Bool {
 	ptrArguments[1] = gdnative.NewPointerFromInt(y)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "is_cell_x_flipped")
+	methodBind := methodBindTileMapIsCellXFlipped.Get()
 
 	// Call the parent method.
 	// bool
@@ -783,8 +930,8 @@ func (o *TileMap) IsCellXFlipped(x gdnative.Int, y gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Return whether the referenced cell is flipped over the Y axis.
-	Args: [{ false x int} { false y int}], Returns: bool
+	        Return whether the referenced cell is flipped over the Y axis.
+		Args: [{ false x int} { false y int}], Returns: bool
 */
 func (o *TileMap) IsCellYFlipped(x gdnative.Int, y gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TileMap.IsCellYFlipped()")
@@ -795,7 +942,7 @@ func (o *TileMap) IsCellYFlipped(x gdnative.Int, y gdnative.Int) gdnative.Bool {
 	ptrArguments[1] = gdnative.NewPointerFromInt(y)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "is_cell_y_flipped")
+	methodBind := methodBindTileMapIsCellYFlipped.Get()
 
 	// Call the parent method.
 	// bool
@@ -808,8 +955,8 @@ func (o *TileMap) IsCellYFlipped(x gdnative.Int, y gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TileMap) IsYSortModeEnabled() gdnative.Bool {
 	//log.Println("Calling TileMap.IsYSortModeEnabled()")
@@ -818,7 +965,7 @@ func (o *TileMap) IsYSortModeEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "is_y_sort_mode_enabled")
+	methodBind := methodBindTileMapIsYSortModeEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -831,8 +978,8 @@ func (o *TileMap) IsYSortModeEnabled() gdnative.Bool {
 }
 
 /*
-        Return the absolute world position corresponding to the tilemap (grid-based) coordinates given as an argument. Optionally, the tilemap's potential half offset can be ignored.
-	Args: [{ false map_position Vector2} {False true ignore_half_ofs bool}], Returns: Vector2
+	        Return the absolute world position corresponding to the tilemap (grid-based) coordinates given as an argument. Optionally, the tilemap's potential half offset can be ignored.
+		Args: [{ false map_position Vector2} {False true ignore_half_ofs bool}], Returns: Vector2
 */
 func (o *TileMap) MapToWorld(mapPosition gdnative.Vector2, ignoreHalfOfs gdnative.Bool) gdnative.Vector2 {
 	//log.Println("Calling TileMap.MapToWorld()")
@@ -843,7 +990,7 @@ func (o *TileMap) MapToWorld(mapPosition gdnative.Vector2, ignoreHalfOfs gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromBool(ignoreHalfOfs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "map_to_world")
+	methodBind := methodBindTileMapMapToWorld.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -856,8 +1003,8 @@ func (o *TileMap) MapToWorld(mapPosition gdnative.Vector2, ignoreHalfOfs gdnativ
 }
 
 /*
-        Set the tile index for the cell referenced by its grid-based X and Y coordinates. A tile index of -1 clears the cell. Optionally, the tile can also be flipped over the X and Y coordinates, transposed, or be given autotile coordinates.
-	Args: [{ false x int} { false y int} { false tile int} {False true flip_x bool} {False true flip_y bool} {False true transpose bool} {(0, 0) true autotile_coord Vector2}], Returns: void
+	        Set the tile index for the cell referenced by its grid-based X and Y coordinates. A tile index of -1 clears the cell. Optionally, the tile can also be flipped over the X and Y coordinates, transposed, or be given autotile coordinates.
+		Args: [{ false x int} { false y int} { false tile int} {False true flip_x bool} {False true flip_y bool} {False true transpose bool} {(0, 0) true autotile_coord Vector2}], Returns: void
 */
 func (o *TileMap) SetCell(x gdnative.Int, y gdnative.Int, tile gdnative.Int, flipX gdnative.Bool, flipY gdnative.Bool, transpose gdnative.Bool, autotileCoord gdnative.Vector2) {
 	//log.Println("Calling TileMap.SetCell()")
@@ -873,7 +1020,7 @@ func (o *TileMap) SetCell(x gdnative.Int, y gdnative.Int, tile gdnative.Int, fli
 	ptrArguments[6] = gdnative.NewPointerFromVector2(autotileCoord)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_cell")
+	methodBind := methodBindTileMapSetCell.Get()
 
 	// Call the parent method.
 	// void
@@ -883,8 +1030,8 @@ func (o *TileMap) SetCell(x gdnative.Int, y gdnative.Int, tile gdnative.Int, fli
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *TileMap) SetCellSize(size gdnative.Vector2) {
 	//log.Println("Calling TileMap.SetCellSize()")
@@ -894,7 +1041,7 @@ func (o *TileMap) SetCellSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_cell_size")
+	methodBind := methodBindTileMapSetCellSize.Get()
 
 	// Call the parent method.
 	// void
@@ -904,8 +1051,8 @@ func (o *TileMap) SetCellSize(size gdnative.Vector2) {
 }
 
 /*
-        Set the tile index for the cell referenced by a Vector2 of grid-based coordinates. A tile index of -1 clears the cell. Optionally, the tile can also be flipped over the X and Y axes or transposed.
-	Args: [{ false position Vector2} { false tile int} {False true flip_x bool} {False true flip_y bool} {False true transpose bool}], Returns: void
+	        Set the tile index for the cell referenced by a Vector2 of grid-based coordinates. A tile index of -1 clears the cell. Optionally, the tile can also be flipped over the X and Y axes or transposed.
+		Args: [{ false position Vector2} { false tile int} {False true flip_x bool} {False true flip_y bool} {False true transpose bool}], Returns: void
 */
 func (o *TileMap) SetCellv(position gdnative.Vector2, tile gdnative.Int, flipX gdnative.Bool, flipY gdnative.Bool, transpose gdnative.Bool) {
 	//log.Println("Calling TileMap.SetCellv()")
@@ -919,7 +1066,7 @@ func (o *TileMap) SetCellv(position gdnative.Vector2, tile gdnative.Int, flipX g
 	ptrArguments[4] = gdnative.NewPointerFromBool(transpose)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_cellv")
+	methodBind := methodBindTileMapSetCellv.Get()
 
 	// Call the parent method.
 	// void
@@ -929,8 +1076,8 @@ func (o *TileMap) SetCellv(position gdnative.Vector2, tile gdnative.Int, flipX g
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TileMap) SetClipUv(enable gdnative.Bool) {
 	//log.Println("Calling TileMap.SetClipUv()")
@@ -940,7 +1087,7 @@ func (o *TileMap) SetClipUv(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_clip_uv")
+	methodBind := methodBindTileMapSetClipUv.Get()
 
 	// Call the parent method.
 	// void
@@ -950,8 +1097,8 @@ func (o *TileMap) SetClipUv(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value float}], Returns: void
+	        Undocumented
+		Args: [{ false value float}], Returns: void
 */
 func (o *TileMap) SetCollisionBounce(value gdnative.Real) {
 	//log.Println("Calling TileMap.SetCollisionBounce()")
@@ -961,7 +1108,7 @@ func (o *TileMap) SetCollisionBounce(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_collision_bounce")
+	methodBind := methodBindTileMapSetCollisionBounce.Get()
 
 	// Call the parent method.
 	// void
@@ -971,8 +1118,8 @@ func (o *TileMap) SetCollisionBounce(value gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value float}], Returns: void
+	        Undocumented
+		Args: [{ false value float}], Returns: void
 */
 func (o *TileMap) SetCollisionFriction(value gdnative.Real) {
 	//log.Println("Calling TileMap.SetCollisionFriction()")
@@ -982,7 +1129,7 @@ func (o *TileMap) SetCollisionFriction(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_collision_friction")
+	methodBind := methodBindTileMapSetCollisionFriction.Get()
 
 	// Call the parent method.
 	// void
@@ -992,8 +1139,8 @@ func (o *TileMap) SetCollisionFriction(value gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *TileMap) SetCollisionLayer(layer gdnative.Int) {
 	//log.Println("Calling TileMap.SetCollisionLayer()")
@@ -1003,7 +1150,7 @@ func (o *TileMap) SetCollisionLayer(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_collision_layer")
+	methodBind := methodBindTileMapSetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -1013,8 +1160,7 @@ func (o *TileMap) SetCollisionLayer(layer gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false bit int} { false value bool}], Returns: void
+Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *TileMap) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling TileMap.SetCollisionLayerBit()")
@@ -1025,7 +1171,7 @@ func (o *TileMap) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_collision_layer_bit")
+	methodBind := methodBindTileMapSetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// void
@@ -1035,8 +1181,8 @@ func (o *TileMap) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *TileMap) SetCollisionMask(mask gdnative.Int) {
 	//log.Println("Calling TileMap.SetCollisionMask()")
@@ -1046,7 +1192,7 @@ func (o *TileMap) SetCollisionMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_collision_mask")
+	methodBind := methodBindTileMapSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1056,8 +1202,7 @@ func (o *TileMap) SetCollisionMask(mask gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false bit int} { false value bool}], Returns: void
+Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *TileMap) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling TileMap.SetCollisionMaskBit()")
@@ -1068,7 +1213,7 @@ func (o *TileMap) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_collision_mask_bit")
+	methodBind := methodBindTileMapSetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// void
@@ -1078,8 +1223,8 @@ func (o *TileMap) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false use_kinematic bool}], Returns: void
+	        Undocumented
+		Args: [{ false use_kinematic bool}], Returns: void
 */
 func (o *TileMap) SetCollisionUseKinematic(useKinematic gdnative.Bool) {
 	//log.Println("Calling TileMap.SetCollisionUseKinematic()")
@@ -1089,7 +1234,7 @@ func (o *TileMap) SetCollisionUseKinematic(useKinematic gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(useKinematic)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_collision_use_kinematic")
+	methodBind := methodBindTileMapSetCollisionUseKinematic.Get()
 
 	// Call the parent method.
 	// void
@@ -1099,8 +1244,8 @@ func (o *TileMap) SetCollisionUseKinematic(useKinematic gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false custom_transform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false custom_transform Transform2D}], Returns: void
 */
 func (o *TileMap) SetCustomTransform(customTransform gdnative.Transform2D) {
 	//log.Println("Calling TileMap.SetCustomTransform()")
@@ -1110,7 +1255,7 @@ func (o *TileMap) SetCustomTransform(customTransform gdnative.Transform2D) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(customTransform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_custom_transform")
+	methodBind := methodBindTileMapSetCustomTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1120,18 +1265,18 @@ func (o *TileMap) SetCustomTransform(customTransform gdnative.Transform2D) {
 }
 
 /*
-        Undocumented
-	Args: [{ false half_offset int}], Returns: void
+	        Undocumented
+		Args: [{ false half_offset enum.TileMap::HalfOffset}], Returns: void
 */
-func (o *TileMap) SetHalfOffset(halfOffset gdnative.Int) {
+func (o *TileMap) SetHalfOffset(halfOffset TileMapHalfOffset) {
 	//log.Println("Calling TileMap.SetHalfOffset()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(halfOffset)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(halfOffset))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_half_offset")
+	methodBind := methodBindTileMapSetHalfOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -1141,18 +1286,18 @@ func (o *TileMap) SetHalfOffset(halfOffset gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.TileMap::Mode}], Returns: void
 */
-func (o *TileMap) SetMode(mode gdnative.Int) {
+func (o *TileMap) SetMode(mode TileMapMode) {
 	//log.Println("Calling TileMap.SetMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_mode")
+	methodBind := methodBindTileMapSetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1162,8 +1307,8 @@ func (o *TileMap) SetMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *TileMap) SetOccluderLightMask(mask gdnative.Int) {
 	//log.Println("Calling TileMap.SetOccluderLightMask()")
@@ -1173,7 +1318,7 @@ func (o *TileMap) SetOccluderLightMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_occluder_light_mask")
+	methodBind := methodBindTileMapSetOccluderLightMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1183,8 +1328,8 @@ func (o *TileMap) SetOccluderLightMask(mask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size int}], Returns: void
 */
 func (o *TileMap) SetQuadrantSize(size gdnative.Int) {
 	//log.Println("Calling TileMap.SetQuadrantSize()")
@@ -1194,7 +1339,7 @@ func (o *TileMap) SetQuadrantSize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_quadrant_size")
+	methodBind := methodBindTileMapSetQuadrantSize.Get()
 
 	// Call the parent method.
 	// void
@@ -1204,18 +1349,18 @@ func (o *TileMap) SetQuadrantSize(size gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false origin int}], Returns: void
+	        Undocumented
+		Args: [{ false origin enum.TileMap::TileOrigin}], Returns: void
 */
-func (o *TileMap) SetTileOrigin(origin gdnative.Int) {
+func (o *TileMap) SetTileOrigin(origin TileMapTileOrigin) {
 	//log.Println("Calling TileMap.SetTileOrigin()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(origin)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(origin))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_tile_origin")
+	methodBind := methodBindTileMapSetTileOrigin.Get()
 
 	// Call the parent method.
 	// void
@@ -1225,18 +1370,18 @@ func (o *TileMap) SetTileOrigin(origin gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false tileset TileSet}], Returns: void
+	        Undocumented
+		Args: [{ false tileset TileSet}], Returns: void
 */
-func (o *TileMap) SetTileset(tileset TileSetImplementer) {
+func (o *TileMap) SetTileset(tileset resource.TileSetImplementer) {
 	//log.Println("Calling TileMap.SetTileset()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(tileset.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(tileset))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_tileset")
+	methodBind := methodBindTileMapSetTileset.Get()
 
 	// Call the parent method.
 	// void
@@ -1246,8 +1391,8 @@ func (o *TileMap) SetTileset(tileset TileSetImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TileMap) SetYSortMode(enable gdnative.Bool) {
 	//log.Println("Calling TileMap.SetYSortMode()")
@@ -1257,7 +1402,7 @@ func (o *TileMap) SetYSortMode(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "set_y_sort_mode")
+	methodBind := methodBindTileMapSetYSortMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1267,8 +1412,8 @@ func (o *TileMap) SetYSortMode(enable gdnative.Bool) {
 }
 
 /*
-        Applies autotiling rules to the cell (and its adjacent cells) referenced by its grid-based X and Y coordinates.
-	Args: [{ false position Vector2}], Returns: void
+	        Applies autotiling rules to the cell (and its adjacent cells) referenced by its grid-based X and Y coordinates.
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *TileMap) UpdateBitmaskArea(position gdnative.Vector2) {
 	//log.Println("Calling TileMap.UpdateBitmaskArea()")
@@ -1278,7 +1423,7 @@ func (o *TileMap) UpdateBitmaskArea(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "update_bitmask_area")
+	methodBind := methodBindTileMapUpdateBitmaskArea.Get()
 
 	// Call the parent method.
 	// void
@@ -1288,8 +1433,8 @@ func (o *TileMap) UpdateBitmaskArea(position gdnative.Vector2) {
 }
 
 /*
-        Applies autotiling rules to the cells in the given region (specified by grid-based X and Y coordinates). Calling with invalid (or missing) parameters applies autotiling rules for the entire TileMap.
-	Args: [{(0, 0) true start Vector2} {(0, 0) true end Vector2}], Returns: void
+	        Applies autotiling rules to the cells in the given region (specified by grid-based X and Y coordinates). Calling with invalid (or missing) parameters applies autotiling rules for the entire TileMap.
+		Args: [{(0, 0) true start Vector2} {(0, 0) true end Vector2}], Returns: void
 */
 func (o *TileMap) UpdateBitmaskRegion(start gdnative.Vector2, end gdnative.Vector2) {
 	//log.Println("Calling TileMap.UpdateBitmaskRegion()")
@@ -1300,7 +1445,7 @@ func (o *TileMap) UpdateBitmaskRegion(start gdnative.Vector2, end gdnative.Vecto
 	ptrArguments[1] = gdnative.NewPointerFromVector2(end)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "update_bitmask_region")
+	methodBind := methodBindTileMapUpdateBitmaskRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -1310,8 +1455,8 @@ func (o *TileMap) UpdateBitmaskRegion(start gdnative.Vector2, end gdnative.Vecto
 }
 
 /*
-        Return the tilemap (grid-based) coordinates corresponding to the absolute world position given as an argument.
-	Args: [{ false world_position Vector2}], Returns: Vector2
+	        Return the tilemap (grid-based) coordinates corresponding to the absolute world position given as an argument.
+		Args: [{ false world_position Vector2}], Returns: Vector2
 */
 func (o *TileMap) WorldToMap(worldPosition gdnative.Vector2) gdnative.Vector2 {
 	//log.Println("Calling TileMap.WorldToMap()")
@@ -1321,7 +1466,7 @@ func (o *TileMap) WorldToMap(worldPosition gdnative.Vector2) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(worldPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileMap", "world_to_map")
+	methodBind := methodBindTileMapWorldToMap.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1333,10 +1478,161 @@ func (o *TileMap) WorldToMap(worldPosition gdnative.Vector2) gdnative.Vector2 {
 	return ret
 }
 
+// MapToWorldWithDefaults will call MapToWorld using the default values for: ignore_half_ofs.
+func (o *TileMap) MapToWorldWithDefaults(mapPosition gdnative.Vector2) gdnative.Vector2 {
+	return o.MapToWorld(mapPosition, false)
+}
+
+// SetCellWithDefaults will call SetCell using the default values for: flip_x, flip_y, transpose, autotile_coord.
+func (o *TileMap) SetCellWithDefaults(x gdnative.Int, y gdnative.Int, tile gdnative.Int) {
+	o.SetCell(x, y, tile, false, false, false, gdnative.NewVector2(0, 0))
+}
+
+// SetCellvWithDefaults will call SetCellv using the default values for: flip_x, flip_y, transpose.
+func (o *TileMap) SetCellvWithDefaults(position gdnative.Vector2, tile gdnative.Int) {
+	o.SetCellv(position, tile, false, false, false)
+}
+
+// UpdateBitmaskRegionWithDefaults will call UpdateBitmaskRegion using the default values for: start, end.
+func (o *TileMap) UpdateBitmaskRegionWithDefaults() {
+	o.UpdateBitmaskRegion(gdnative.NewVector2(0, 0), gdnative.NewVector2(0, 0))
+}
+
+// CellClipUv will return the value of the "cell_clip_uv" property.
+func (o *TileMap) CellClipUv() gdnative.Bool {
+	return o.GetClipUv()
+}
+
+// SetCellClipUv will set the value of the "cell_clip_uv" property.
+func (o *TileMap) SetCellClipUv(value gdnative.Bool) {
+	o.SetClipUv(value)
+}
+
+// CellCustomTransform will return the value of the "cell_custom_transform" property.
+func (o *TileMap) CellCustomTransform() gdnative.Transform2D {
+	return o.GetCustomTransform()
+}
+
+// SetCellCustomTransform will set the value of the "cell_custom_transform" property.
+func (o *TileMap) SetCellCustomTransform(value gdnative.Transform2D) {
+	o.SetCustomTransform(value)
+}
+
+// CellHalfOffset will return the value of the "cell_half_offset" property.
+func (o *TileMap) CellHalfOffset() TileMapHalfOffset {
+	return o.GetHalfOffset()
+}
+
+// SetCellHalfOffset will set the value of the "cell_half_offset" property.
+func (o *TileMap) SetCellHalfOffset(value TileMapHalfOffset) {
+	o.SetHalfOffset(value)
+}
+
+// CellQuadrantSize will return the value of the "cell_quadrant_size" property.
+func (o *TileMap) CellQuadrantSize() gdnative.Int {
+	return o.GetQuadrantSize()
+}
+
+// SetCellQuadrantSize will set the value of the "cell_quadrant_size" property.
+func (o *TileMap) SetCellQuadrantSize(value gdnative.Int) {
+	o.SetQuadrantSize(value)
+}
+
+// CellSize will return the value of the "cell_size" property.
+func (o *TileMap) CellSize() gdnative.Vector2 {
+	return o.GetCellSize()
+}
+
+// CellTileOrigin will return the value of the "cell_tile_origin" property.
+func (o *TileMap) CellTileOrigin() TileMapTileOrigin {
+	return o.GetTileOrigin()
+}
+
+// SetCellTileOrigin will set the value of the "cell_tile_origin" property.
+func (o *TileMap) SetCellTileOrigin(value TileMapTileOrigin) {
+	o.SetTileOrigin(value)
+}
+
+// CellYSort will return the value of the "cell_y_sort" property.
+func (o *TileMap) CellYSort() gdnative.Bool {
+	return o.IsYSortModeEnabled()
+}
+
+// SetCellYSort will set the value of the "cell_y_sort" property.
+func (o *TileMap) SetCellYSort(value gdnative.Bool) {
+	o.SetYSortMode(value)
+}
+
+// CollisionBounce will return the value of the "collision_bounce" property.
+func (o *TileMap) CollisionBounce() gdnative.Real {
+	return o.GetCollisionBounce()
+}
+
+// CollisionFriction will return the value of the "collision_friction" property.
+func (o *TileMap) CollisionFriction() gdnative.Real {
+	return o.GetCollisionFriction()
+}
+
+// CollisionLayer will return the value of the "collision_layer" property.
+func (o *TileMap) CollisionLayer() gdnative.Int {
+	return o.GetCollisionLayer()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *TileMap) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// CollisionUseKinematic will return the value of the "collision_use_kinematic" property.
+func (o *TileMap) CollisionUseKinematic() gdnative.Bool {
+	return o.GetCollisionUseKinematic()
+}
+
+// Mode will return the value of the "mode" property.
+func (o *TileMap) Mode() TileMapMode {
+	return o.GetMode()
+}
+
+// OccluderLightMask will return the value of the "occluder_light_mask" property.
+func (o *TileMap) OccluderLightMask() gdnative.Int {
+	return o.GetOccluderLightMask()
+}
+
+// TileSet will return the value of the "tile_set" property.
+func (o *TileMap) TileSet() resource.TileSetImplementer {
+	return o.GetTileset()
+}
+
+// SetTileSet will set the value of the "tile_set" property.
+func (o *TileMap) SetTileSet(value resource.TileSetImplementer) {
+	o.SetTileset(value)
+}
+
+// Signals of the TileMap class.
+const (
+	TileMapSignalSettingsChanged gdnative.String = "settings_changed"
+)
+
+// ConnectSettingsChanged will connect the "settings_changed" signal to the given method of the target object.
+func (o *TileMap) ConnectSettingsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TileMapSignalSettingsChanged, target, method, binds, 0)
+}
+
+// EmitSettingsChanged will emit the "settings_changed" signal.
+func (o *TileMap) EmitSettingsChanged() error {
+
+	ret, err := o.EmitSignal(TileMapSignalSettingsChanged)
+	ret.Destroy()
+	return err
+}
+
 // TileMapImplementer is an interface that implements the methods
 // of the TileMap class.
 type TileMapImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	X_ClearQuadrants()
 	X_GetOldCellSize() gdnative.Int
 	X_GetTileData() gdnative.PoolIntArray
@@ -1357,9 +1653,12 @@ type TileMapImplementer interface {
 	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
 	GetCollisionUseKinematic() gdnative.Bool
 	GetCustomTransform() gdnative.Transform2D
+	GetHalfOffset() TileMapHalfOffset
+	GetMode() TileMapMode
 	GetOccluderLightMask() gdnative.Int
 	GetQuadrantSize() gdnative.Int
-	GetTileset() TileSetImplementer
+	GetTileOrigin() TileMapTileOrigin
+	GetTileset() resource.TileSetImplementer
 	GetUsedCells() gdnative.Array
 	GetUsedCellsById(id gdnative.Int) gdnative.Array
 	GetUsedRect() gdnative.Rect2
@@ -1380,14 +1679,42 @@ type TileMapImplementer interface {
 	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
 	SetCollisionUseKinematic(useKinematic gdnative.Bool)
 	SetCustomTransform(customTransform gdnative.Transform2D)
-	SetHalfOffset(halfOffset gdnative.Int)
-	SetMode(mode gdnative.Int)
+	SetHalfOffset(halfOffset TileMapHalfOffset)
+	SetMode(mode TileMapMode)
 	SetOccluderLightMask(mask gdnative.Int)
 	SetQuadrantSize(size gdnative.Int)
-	SetTileOrigin(origin gdnative.Int)
-	SetTileset(tileset TileSetImplementer)
+	SetTileOrigin(origin TileMapTileOrigin)
+	SetTileset(tileset resource.TileSetImplementer)
 	SetYSortMode(enable gdnative.Bool)
 	UpdateBitmaskArea(position gdnative.Vector2)
 	UpdateBitmaskRegion(start gdnative.Vector2, end gdnative.Vector2)
 	WorldToMap(worldPosition gdnative.Vector2) gdnative.Vector2
+	MapToWorldWithDefaults(mapPosition gdnative.Vector2) gdnative.Vector2
+	SetCellWithDefaults(x gdnative.Int, y gdnative.Int, tile gdnative.Int)
+	SetCellvWithDefaults(position gdnative.Vector2, tile gdnative.Int)
+	UpdateBitmaskRegionWithDefaults()
+	CellClipUv() gdnative.Bool
+	SetCellClipUv(value gdnative.Bool)
+	CellCustomTransform() gdnative.Transform2D
+	SetCellCustomTransform(value gdnative.Transform2D)
+	CellHalfOffset() TileMapHalfOffset
+	SetCellHalfOffset(value TileMapHalfOffset)
+	CellQuadrantSize() gdnative.Int
+	SetCellQuadrantSize(value gdnative.Int)
+	CellSize() gdnative.Vector2
+	CellTileOrigin() TileMapTileOrigin
+	SetCellTileOrigin(value TileMapTileOrigin)
+	CellYSort() gdnative.Bool
+	SetCellYSort(value gdnative.Bool)
+	CollisionBounce() gdnative.Real
+	CollisionFriction() gdnative.Real
+	CollisionLayer() gdnative.Int
+	CollisionMask() gdnative.Int
+	CollisionUseKinematic() gdnative.Bool
+	Mode() TileMapMode
+	OccluderLightMask() gdnative.Int
+	TileSet() resource.TileSetImplementer
+	SetTileSet(value resource.TileSetImplementer)
+	ConnectSettingsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSettingsChanged() error
 }
@@ -0,0 +1,720 @@
+package canvasitem
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/bitmap"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TouchScreenButtonVisibilityMode is an enum for VisibilityMode values.
+type TouchScreenButtonVisibilityMode int
+
+const (
+	TouchScreenButtonVisibilityAlways          TouchScreenButtonVisibilityMode = 0
+	TouchScreenButtonVisibilityTouchscreenOnly TouchScreenButtonVisibilityMode = 1
+)
+
+// String will return the Godot name of the TouchScreenButtonVisibilityMode value.
+func (e TouchScreenButtonVisibilityMode) String() string {
+	switch e {
+	case TouchScreenButtonVisibilityAlways:
+		return "VISIBILITY_ALWAYS"
+	case TouchScreenButtonVisibilityTouchscreenOnly:
+		return "VISIBILITY_TOUCHSCREEN_ONLY"
+	}
+	return fmt.Sprintf("TouchScreenButtonVisibilityMode(%d)", int(e))
+}
+
+// ParseTouchScreenButtonVisibilityMode will return the TouchScreenButtonVisibilityMode value with the given Godot name.
+func ParseTouchScreenButtonVisibilityMode(name string) (TouchScreenButtonVisibilityMode, error) {
+	switch name {
+	case "VISIBILITY_ALWAYS":
+		return TouchScreenButtonVisibilityAlways, nil
+	case "VISIBILITY_TOUCHSCREEN_ONLY":
+		return TouchScreenButtonVisibilityTouchscreenOnly, nil
+	}
+	return 0, fmt.Errorf("invalid TouchScreenButtonVisibilityMode value %q", name)
+}
+
+/*
+Button for touch screen devices. You can set it to be visible on all screens, or only on touch devices.
+*/
+type TouchScreenButton struct {
+	godot.Node2D
+	owner gdnative.Object
+}
+
+// classConstructorTouchScreenButton is the class constructor of the TouchScreenButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTouchScreenButton = gdnative.NewLazyClassConstructor("TouchScreenButton")
+
+// NewTouchScreenButton will create a new instance of the TouchScreenButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTouchScreenButton() *TouchScreenButton {
+	obj := &TouchScreenButton{}
+	obj.SetBaseObject(classConstructorTouchScreenButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *TouchScreenButton) BaseClass() string {
+	return "TouchScreenButton"
+}
+
+// Method binds of the TouchScreenButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTouchScreenButtonX_Input              = gdnative.NewLazyMethodBind("TouchScreenButton", "_input")
+	methodBindTouchScreenButtonGetAction            = gdnative.NewLazyMethodBind("TouchScreenButton", "get_action")
+	methodBindTouchScreenButtonGetBitmask           = gdnative.NewLazyMethodBind("TouchScreenButton", "get_bitmask")
+	methodBindTouchScreenButtonGetShape             = gdnative.NewLazyMethodBind("TouchScreenButton", "get_shape")
+	methodBindTouchScreenButtonGetTexture           = gdnative.NewLazyMethodBind("TouchScreenButton", "get_texture")
+	methodBindTouchScreenButtonGetTexturePressed    = gdnative.NewLazyMethodBind("TouchScreenButton", "get_texture_pressed")
+	methodBindTouchScreenButtonGetVisibilityMode    = gdnative.NewLazyMethodBind("TouchScreenButton", "get_visibility_mode")
+	methodBindTouchScreenButtonIsPassbyPressEnabled = gdnative.NewLazyMethodBind("TouchScreenButton", "is_passby_press_enabled")
+	methodBindTouchScreenButtonIsPressed            = gdnative.NewLazyMethodBind("TouchScreenButton", "is_pressed")
+	methodBindTouchScreenButtonIsShapeCentered      = gdnative.NewLazyMethodBind("TouchScreenButton", "is_shape_centered")
+	methodBindTouchScreenButtonIsShapeVisible       = gdnative.NewLazyMethodBind("TouchScreenButton", "is_shape_visible")
+	methodBindTouchScreenButtonSetAction            = gdnative.NewLazyMethodBind("TouchScreenButton", "set_action")
+	methodBindTouchScreenButtonSetBitmask           = gdnative.NewLazyMethodBind("TouchScreenButton", "set_bitmask")
+	methodBindTouchScreenButtonSetPassbyPress       = gdnative.NewLazyMethodBind("TouchScreenButton", "set_passby_press")
+	methodBindTouchScreenButtonSetShape             = gdnative.NewLazyMethodBind("TouchScreenButton", "set_shape")
+	methodBindTouchScreenButtonSetShapeCentered     = gdnative.NewLazyMethodBind("TouchScreenButton", "set_shape_centered")
+	methodBindTouchScreenButtonSetShapeVisible      = gdnative.NewLazyMethodBind("TouchScreenButton", "set_shape_visible")
+	methodBindTouchScreenButtonSetTexture           = gdnative.NewLazyMethodBind("TouchScreenButton", "set_texture")
+	methodBindTouchScreenButtonSetTexturePressed    = gdnative.NewLazyMethodBind("TouchScreenButton", "set_texture_pressed")
+	methodBindTouchScreenButtonSetVisibilityMode    = gdnative.NewLazyMethodBind("TouchScreenButton", "set_visibility_mode")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *TouchScreenButton) X_Input(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling TouchScreenButton.X_Input()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonX_Input.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *TouchScreenButton) GetAction() gdnative.String {
+	//log.Println("Calling TouchScreenButton.GetAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonGetAction.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: BitMap
+*/
+func (o *TouchScreenButton) GetBitmask() bitmap.BitMapImplementer {
+	//log.Println("Calling TouchScreenButton.GetBitmask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonGetBitmask.Get()
+
+	// Call the parent method.
+	// BitMap
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := bitmap.BitMap{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(bitmap.BitMapImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Shape2D
+*/
+func (o *TouchScreenButton) GetShape() godot.Shape2DImplementer {
+	//log.Println("Calling TouchScreenButton.GetShape()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonGetShape.Get()
+
+	// Call the parent method.
+	// Shape2D
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Shape2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.Shape2DImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TouchScreenButton) GetTexture() godot.TextureImplementer {
+	//log.Println("Calling TouchScreenButton.GetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonGetTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TouchScreenButton) GetTexturePressed() godot.TextureImplementer {
+	//log.Println("Calling TouchScreenButton.GetTexturePressed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonGetTexturePressed.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.TouchScreenButton::VisibilityMode
+*/
+func (o *TouchScreenButton) GetVisibilityMode() TouchScreenButtonVisibilityMode {
+	//log.Println("Calling TouchScreenButton.GetVisibilityMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonGetVisibilityMode.Get()
+
+	// Call the parent method.
+	// enum.TouchScreenButton::VisibilityMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TouchScreenButtonVisibilityMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *TouchScreenButton) IsPassbyPressEnabled() gdnative.Bool {
+	//log.Println("Calling TouchScreenButton.IsPassbyPressEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonIsPassbyPressEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns [code]true[/code] if this button is currently pressed.
+		Args: [], Returns: bool
+*/
+func (o *TouchScreenButton) IsPressed() gdnative.Bool {
+	//log.Println("Calling TouchScreenButton.IsPressed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonIsPressed.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *TouchScreenButton) IsShapeCentered() gdnative.Bool {
+	//log.Println("Calling TouchScreenButton.IsShapeCentered()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonIsShapeCentered.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *TouchScreenButton) IsShapeVisible() gdnative.Bool {
+	//log.Println("Calling TouchScreenButton.IsShapeVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonIsShapeVisible.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false action String}], Returns: void
+*/
+func (o *TouchScreenButton) SetAction(action gdnative.String) {
+	//log.Println("Calling TouchScreenButton.SetAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(action)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetAction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false bitmask BitMap}], Returns: void
+*/
+func (o *TouchScreenButton) SetBitmask(bitmask bitmap.BitMapImplementer) {
+	//log.Println("Calling TouchScreenButton.SetBitmask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(bitmask))
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetBitmask.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *TouchScreenButton) SetPassbyPress(enabled gdnative.Bool) {
+	//log.Println("Calling TouchScreenButton.SetPassbyPress()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetPassbyPress.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false shape Shape2D}], Returns: void
+*/
+func (o *TouchScreenButton) SetShape(shape godot.Shape2DImplementer) {
+	//log.Println("Calling TouchScreenButton.SetShape()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(shape))
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetShape.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false bool bool}], Returns: void
+*/
+func (o *TouchScreenButton) SetShapeCentered(bool gdnative.Bool) {
+	//log.Println("Calling TouchScreenButton.SetShapeCentered()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(bool)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetShapeCentered.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false bool bool}], Returns: void
+*/
+func (o *TouchScreenButton) SetShapeVisible(bool gdnative.Bool) {
+	//log.Println("Calling TouchScreenButton.SetShapeVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(bool)
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetShapeVisible.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *TouchScreenButton) SetTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling TouchScreenButton.SetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture_pressed Texture}], Returns: void
+*/
+func (o *TouchScreenButton) SetTexturePressed(texturePressed godot.TextureImplementer) {
+	//log.Println("Calling TouchScreenButton.SetTexturePressed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texturePressed))
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetTexturePressed.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.TouchScreenButton::VisibilityMode}], Returns: void
+*/
+func (o *TouchScreenButton) SetVisibilityMode(mode TouchScreenButtonVisibilityMode) {
+	//log.Println("Calling TouchScreenButton.SetVisibilityMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindTouchScreenButtonSetVisibilityMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Action will return the value of the "action" property.
+func (o *TouchScreenButton) Action() gdnative.String {
+	return o.GetAction()
+}
+
+// Bitmask will return the value of the "bitmask" property.
+func (o *TouchScreenButton) Bitmask() bitmap.BitMapImplementer {
+	return o.GetBitmask()
+}
+
+// Normal will return the value of the "normal" property.
+func (o *TouchScreenButton) Normal() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// SetNormal will set the value of the "normal" property.
+func (o *TouchScreenButton) SetNormal(value godot.TextureImplementer) {
+	o.SetTexture(value)
+}
+
+// PassbyPress will return the value of the "passby_press" property.
+func (o *TouchScreenButton) PassbyPress() gdnative.Bool {
+	return o.IsPassbyPressEnabled()
+}
+
+// Pressed will return the value of the "pressed" property.
+func (o *TouchScreenButton) Pressed() godot.TextureImplementer {
+	return o.GetTexturePressed()
+}
+
+// SetPressed will set the value of the "pressed" property.
+func (o *TouchScreenButton) SetPressed(value godot.TextureImplementer) {
+	o.SetTexturePressed(value)
+}
+
+// Shape will return the value of the "shape" property.
+func (o *TouchScreenButton) Shape() godot.Shape2DImplementer {
+	return o.GetShape()
+}
+
+// ShapeCentered will return the value of the "shape_centered" property.
+func (o *TouchScreenButton) ShapeCentered() gdnative.Bool {
+	return o.IsShapeCentered()
+}
+
+// ShapeVisible will return the value of the "shape_visible" property.
+func (o *TouchScreenButton) ShapeVisible() gdnative.Bool {
+	return o.IsShapeVisible()
+}
+
+// VisibilityMode will return the value of the "visibility_mode" property.
+func (o *TouchScreenButton) VisibilityMode() TouchScreenButtonVisibilityMode {
+	return o.GetVisibilityMode()
+}
+
+// Signals of the TouchScreenButton class.
+const (
+	TouchScreenButtonSignalPressed  gdnative.String = "pressed"
+	TouchScreenButtonSignalReleased gdnative.String = "released"
+)
+
+// ConnectPressed will connect the "pressed" signal to the given method of the target object.
+func (o *TouchScreenButton) ConnectPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TouchScreenButtonSignalPressed, target, method, binds, 0)
+}
+
+// EmitPressed will emit the "pressed" signal.
+func (o *TouchScreenButton) EmitPressed() error {
+
+	ret, err := o.EmitSignal(TouchScreenButtonSignalPressed)
+	ret.Destroy()
+	return err
+}
+
+// ConnectReleased will connect the "released" signal to the given method of the target object.
+func (o *TouchScreenButton) ConnectReleased(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TouchScreenButtonSignalReleased, target, method, binds, 0)
+}
+
+// EmitReleased will emit the "released" signal.
+func (o *TouchScreenButton) EmitReleased() error {
+
+	ret, err := o.EmitSignal(TouchScreenButtonSignalReleased)
+	ret.Destroy()
+	return err
+}
+
+// TouchScreenButtonImplementer is an interface that implements the methods
+// of the TouchScreenButton class.
+type TouchScreenButtonImplementer interface {
+	godot.Node2DImplementer
+	GetAction() gdnative.String
+	GetBitmask() bitmap.BitMapImplementer
+	GetShape() godot.Shape2DImplementer
+	GetTexture() godot.TextureImplementer
+	GetTexturePressed() godot.TextureImplementer
+	GetVisibilityMode() TouchScreenButtonVisibilityMode
+	IsPassbyPressEnabled() gdnative.Bool
+	IsPressed() gdnative.Bool
+	IsShapeCentered() gdnative.Bool
+	IsShapeVisible() gdnative.Bool
+	SetAction(action gdnative.String)
+	SetBitmask(bitmask bitmap.BitMapImplementer)
+	SetPassbyPress(enabled gdnative.Bool)
+	SetShape(shape godot.Shape2DImplementer)
+	SetShapeCentered(bool gdnative.Bool)
+	SetShapeVisible(bool gdnative.Bool)
+	SetTexture(texture godot.TextureImplementer)
+	SetTexturePressed(texturePressed godot.TextureImplementer)
+	SetVisibilityMode(mode TouchScreenButtonVisibilityMode)
+	Action() gdnative.String
+	Bitmask() bitmap.BitMapImplementer
+	Normal() godot.TextureImplementer
+	SetNormal(value godot.TextureImplementer)
+	PassbyPress() gdnative.Bool
+	Pressed() godot.TextureImplementer
+	SetPressed(value godot.TextureImplementer)
+	Shape() godot.Shape2DImplementer
+	ShapeCentered() gdnative.Bool
+	ShapeVisible() gdnative.Bool
+	VisibilityMode() TouchScreenButtonVisibilityMode
+	ConnectPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPressed() error
+	ConnectReleased(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitReleased() error
+}
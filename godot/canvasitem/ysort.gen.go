@@ -1,7 +1,8 @@
-package godot
+package canvasitem
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,42 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewYSortFromPointer(ptr gdnative.Pointer) YSort {
-func newYSortFromPointer(ptr gdnative.Pointer) YSort {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := YSort{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Sort all child nodes based on their Y positions. The child node must inherit from [CanvasItem] for it to be sorted. Nodes that have a higher Y position will be drawn later, so they will appear on top of nodes that have a lower Y position.
 */
 type YSort struct {
-	Node2D
+	godot.Node2D
 	owner gdnative.Object
 }
 
+// classConstructorYSort is the class constructor of the YSort class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorYSort = gdnative.NewLazyClassConstructor("YSort")
+
+// NewYSort will create a new instance of the YSort class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewYSort() *YSort {
+	obj := &YSort{}
+	obj.SetBaseObject(classConstructorYSort.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *YSort) BaseClass() string {
 	return "YSort"
 }
 
+// Method binds of the YSort class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindYSortIsSortEnabled  = gdnative.NewLazyMethodBind("YSort", "is_sort_enabled")
+	methodBindYSortSetSortEnabled = gdnative.NewLazyMethodBind("YSort", "set_sort_enabled")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *YSort) IsSortEnabled() gdnative.Bool {
 	//log.Println("Calling YSort.IsSortEnabled()")
@@ -45,7 +58,7 @@ func (o *YSort) IsSortEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("YSort", "is_sort_enabled")
+	methodBind := methodBindYSortIsSortEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +71,8 @@ func (o *YSort) IsSortEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *YSort) SetSortEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling YSort.SetSortEnabled()")
@@ -69,7 +82,7 @@ func (o *YSort) SetSortEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("YSort", "set_sort_enabled")
+	methodBind := methodBindYSortSetSortEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *YSort) SetSortEnabled(enabled gdnative.Bool) {
 
 }
 
+// SortEnabled will return the value of the "sort_enabled" property.
+func (o *YSort) SortEnabled() gdnative.Bool {
+	return o.IsSortEnabled()
+}
+
 // YSortImplementer is an interface that implements the methods
 // of the YSort class.
 type YSortImplementer interface {
-	Node2DImplementer
+	godot.Node2DImplementer
 	IsSortEnabled() gdnative.Bool
 	SetSortEnabled(enabled gdnative.Bool)
+	SortEnabled() gdnative.Bool
 }
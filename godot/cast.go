@@ -0,0 +1,90 @@
+package godot
+
+import (
+	"errors"
+	"github.com/shadowapex/godot-go/gdnative"
+	"reflect"
+	"strings"
+)
+
+// ErrNilObject is returned by As when the given object is nil.
+var ErrNilObject = errors.New("godot: can not cast a nil object")
+
+// CastError is returned by As when a Godot object is not an instance of the
+// requested Go class.
+type CastError struct {
+	// ClassName is the name of the registered Go class of the object, or its
+	// Godot class name if it is not an instance of a registered Go class.
+	ClassName string
+
+	// Target is the type the object was cast to.
+	Target reflect.Type
+}
+
+// Error will return a description of the failed cast.
+func (e *CastError) Error() string {
+	return "godot: can not cast object of class " + e.ClassName + " to " + e.Target.String()
+}
+
+// As will find the registered Go class instance of the given Godot object and
+// store it in target, which must be a non-nil pointer to a Go class type or an
+// interface type. This can be used to get your own Go structs back from objects
+// passed in from GDScript:
+//
+//	var enemy *Enemy
+//	if err := godot.As(node, &enemy); err != nil {
+//		return err
+//	}
+//
+// When the engine supports NativeScript 1.1, the instance is identified by the
+// type tag of its class, so scripts of other classes or libraries are never
+// mistaken for a Go class. If the object is not an instance of a Go class that
+// can be stored in target, a *CastError is returned.
+func As(object Class, target interface{}) error {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		panic("godot: target must be a non-nil pointer")
+	}
+	targetType := targetValue.Type().Elem()
+
+	obj := GetBaseObject(object)
+	if obj.IsNil() {
+		return ErrNilObject
+	}
+
+	instance, className, ok := instanceOf(obj)
+	if !ok {
+		base := &Object{owner: obj}
+		return &CastError{ClassName: string(base.GetClass()), Target: targetType}
+	}
+	if !reflect.TypeOf(instance).AssignableTo(targetType) {
+		return &CastError{ClassName: className, Target: targetType}
+	}
+	targetValue.Elem().Set(reflect.ValueOf(instance))
+
+	return nil
+}
+
+// instanceOf will return the registered Go class instance of the given Godot
+// object, along with the name of its class. It returns false if the object does
+// not have a script of a class that was registered by this library.
+func instanceOf(obj gdnative.Object) (Class, string, bool) {
+	if className, ok := gdnative.NativeScript.GetTypeTag(obj); ok {
+		instance, ok := InstanceRegistry.Get(gdnative.NativeScript.GetUserData(obj))
+		return instance, className, ok
+	}
+
+	// Type tags are authoritative if they are supported, so an object without
+	// one of our tags can't be one of our instances.
+	if gdnative.NativeScript.HasVersion11() {
+		return nil, "", false
+	}
+
+	// Otherwise, fall back to looking the object up by its address.
+	instance, ok := InstanceRegistry.Get(obj.ID())
+	if !ok {
+		return nil, "", false
+	}
+
+	return instance, strings.TrimPrefix(reflect.TypeOf(instance).String(), "*"), true
+}
@@ -0,0 +1,623 @@
+package godot
+
+import (
+	"fmt"
+	"github.com/shadowapex/godot-go/gdnative"
+	"reflect"
+	"strings"
+)
+
+// The codec in this file converts between Variants and arbitrary Go values. It
+// is used for method arguments and return values, properties and signals of
+// registered classes. Values are converted as follows:
+//
+//   - gdnative.Variant values are passed through as-is.
+//   - Godot classes are converted to and from Objects.
+//   - Booleans, strings and all integer and floating point types are converted
+//     to and from Bool, String, Int and Real variants. Integer and Real variants
+//     can be converted into any numeric type, as long as the value fits.
+//   - []byte, []int32, []float32, []string, []gdnative.Vector2,
+//     []gdnative.Vector3 and []gdnative.Color are converted to their Pool array
+//     types. All other slices and arrays are converted to an Array.
+//   - Maps are converted to a Dictionary.
+//   - Structs are converted to a Dictionary with a key for each exported field.
+//     The key is the field name, unless a `godot:"name"` tag is given. Fields with
+//     a `godot:"-"` tag are skipped.
+//   - Pointers are converted using the value they point to, and nil pointers and
+//     interfaces are converted to Nil.
+//   - All other gdnative types are converted to their variant type.
+
+var (
+	variantType  = reflect.TypeOf(gdnative.Variant{})
+	classType    = reflect.TypeOf((*Class)(nil)).Elem()
+	gdnativePath = variantType.PkgPath()
+	godotPath    = reflect.TypeOf(Object{}).PkgPath()
+	vector2Type  = reflect.TypeOf(gdnative.Vector2{})
+	vector3Type  = reflect.TypeOf(gdnative.Vector3{})
+	colorType    = reflect.TypeOf(gdnative.Color{})
+	arrayType    = reflect.TypeOf(gdnative.Array{})
+)
+
+// ToVariant will convert the given Go value into a Variant.
+func ToVariant(value interface{}) (gdnative.Variant, error) {
+	return encodeValue(reflect.ValueOf(value))
+}
+
+// FromVariant will convert the given Variant into the Go value that target
+// points to.
+func FromVariant(variant gdnative.Variant, target interface{}) error {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		return fmt.Errorf("target must be a non-nil pointer, got %T", target)
+	}
+
+	value, err := decodeValue(variant, targetValue.Type().Elem())
+	if err != nil {
+		return err
+	}
+	targetValue.Elem().Set(value)
+
+	return nil
+}
+
+// encodeValue will convert the given Go value into a Variant.
+func encodeValue(value reflect.Value) (gdnative.Variant, error) {
+	if !value.IsValid() {
+		return gdnative.NewVariantNil(), nil
+	}
+
+	// Unwrap interfaces, and return Nil for nil pointers and interfaces.
+	if value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return gdnative.NewVariantNil(), nil
+		}
+		if value.Kind() == reflect.Interface {
+			return encodeValue(value.Elem())
+		}
+	}
+
+	// Return Variants as-is, and Godot classes as Objects.
+	if value.CanInterface() {
+		switch v := value.Interface().(type) {
+		case gdnative.Variant:
+			return v, nil
+		case Class:
+			return gdnative.NewVariantObject(v.GetBaseObject()), nil
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return gdnative.NewVariantBool(gdnative.Bool(value.Bool())), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return gdnative.NewVariantInt(gdnative.Int64T(value.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return gdnative.NewVariantUint(gdnative.Uint64T(value.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		return gdnative.NewVariantReal(gdnative.Double(value.Float())), nil
+	case reflect.String:
+		return gdnative.NewVariantString(gdnative.String(value.String())), nil
+	case reflect.Ptr:
+		return encodeValue(value.Elem())
+	case reflect.Slice, reflect.Array:
+		return encodeSlice(value)
+	case reflect.Map:
+		return encodeMap(value)
+	case reflect.Struct:
+		if value.Type().PkgPath() == gdnativePath {
+			if variant, ok := goTypeToVariant(value); ok {
+				return variant, nil
+			}
+			break
+		}
+		return encodeStruct(value)
+	}
+
+	return gdnative.Variant{}, fmt.Errorf("unable to convert value of type %s into a Variant", value.Type())
+}
+
+// encodeSlice will convert the given slice or array into a Pool array if there
+// is one for its element type, or an Array otherwise.
+func encodeSlice(value reflect.Value) (gdnative.Variant, error) {
+	switch sliceVariantType(value.Type()) {
+	case gdnative.VariantTypePoolByteArray:
+		pool := gdnative.NewPoolByteArray()
+		for i := 0; i < value.Len(); i++ {
+			pool.Append(gdnative.Uint8T(value.Index(i).Uint()))
+		}
+		variant := gdnative.NewVariantPoolByteArray(pool)
+		pool.Destroy()
+		return variant, nil
+	case gdnative.VariantTypePoolIntArray:
+		pool := gdnative.NewPoolIntArray()
+		for i := 0; i < value.Len(); i++ {
+			pool.Append(gdnative.Int(value.Index(i).Int()))
+		}
+		variant := gdnative.NewVariantPoolIntArray(pool)
+		pool.Destroy()
+		return variant, nil
+	case gdnative.VariantTypePoolRealArray:
+		pool := gdnative.NewPoolRealArray()
+		for i := 0; i < value.Len(); i++ {
+			pool.Append(gdnative.Real(value.Index(i).Float()))
+		}
+		variant := gdnative.NewVariantPoolRealArray(pool)
+		pool.Destroy()
+		return variant, nil
+	case gdnative.VariantTypePoolStringArray:
+		pool := gdnative.NewPoolStringArray()
+		for i := 0; i < value.Len(); i++ {
+			pool.Append(gdnative.String(value.Index(i).String()))
+		}
+		variant := gdnative.NewVariantPoolStringArray(pool)
+		pool.Destroy()
+		return variant, nil
+	case gdnative.VariantTypePoolVector2Array:
+		pool := gdnative.NewPoolVector2Array()
+		for i := 0; i < value.Len(); i++ {
+			pool.Append(value.Index(i).Interface().(gdnative.Vector2))
+		}
+		variant := gdnative.NewVariantPoolVector2Array(pool)
+		pool.Destroy()
+		return variant, nil
+	case gdnative.VariantTypePoolVector3Array:
+		pool := gdnative.NewPoolVector3Array()
+		for i := 0; i < value.Len(); i++ {
+			pool.Append(value.Index(i).Interface().(gdnative.Vector3))
+		}
+		variant := gdnative.NewVariantPoolVector3Array(pool)
+		pool.Destroy()
+		return variant, nil
+	case gdnative.VariantTypePoolColorArray:
+		pool := gdnative.NewPoolColorArray()
+		for i := 0; i < value.Len(); i++ {
+			pool.Append(value.Index(i).Interface().(gdnative.Color))
+		}
+		variant := gdnative.NewVariantPoolColorArray(pool)
+		pool.Destroy()
+		return variant, nil
+	}
+
+	array := gdnative.NewArray()
+	for i := 0; i < value.Len(); i++ {
+		elem, err := encodeValue(value.Index(i))
+		if err != nil {
+			array.Destroy()
+			return gdnative.Variant{}, fmt.Errorf("index %d: %s", i, err)
+		}
+		array.Append(elem)
+		destroyEncoded(value.Index(i), elem)
+	}
+
+	variant := gdnative.NewVariantArray(array)
+	array.Destroy()
+
+	return variant, nil
+}
+
+// encodeMap will convert the given map into a Dictionary.
+func encodeMap(value reflect.Value) (gdnative.Variant, error) {
+	dictionary := gdnative.NewDictionary()
+	for _, key := range value.MapKeys() {
+		keyVariant, err := encodeValue(key)
+		if err != nil {
+			dictionary.Destroy()
+			return gdnative.Variant{}, fmt.Errorf("key %v: %s", key, err)
+		}
+		valueVariant, err := encodeValue(value.MapIndex(key))
+		if err != nil {
+			destroyEncoded(key, keyVariant)
+			dictionary.Destroy()
+			return gdnative.Variant{}, fmt.Errorf("key %v: %s", key, err)
+		}
+		dictionary.Set(keyVariant, valueVariant)
+		destroyEncoded(key, keyVariant)
+		destroyEncoded(value.MapIndex(key), valueVariant)
+	}
+
+	variant := gdnative.NewVariantDictionary(dictionary)
+	dictionary.Destroy()
+
+	return variant, nil
+}
+
+// encodeStruct will convert the exported fields of the given struct into a
+// Dictionary.
+func encodeStruct(value reflect.Value) (gdnative.Variant, error) {
+	dictionary := gdnative.NewDictionary()
+	for i := 0; i < value.NumField(); i++ {
+		name, ok := structFieldName(value.Type().Field(i))
+		if !ok {
+			continue
+		}
+		fieldVariant, err := encodeValue(value.Field(i))
+		if err != nil {
+			dictionary.Destroy()
+			return gdnative.Variant{}, fmt.Errorf("field %s: %s", name, err)
+		}
+		key := gdnative.NewVariantString(gdnative.String(name))
+		dictionary.Set(key, fieldVariant)
+		key.Destroy()
+		destroyEncoded(value.Field(i), fieldVariant)
+	}
+
+	variant := gdnative.NewVariantDictionary(dictionary)
+	dictionary.Destroy()
+
+	return variant, nil
+}
+
+// destroyEncoded will destroy a Variant that encodeValue created for the given
+// value, once it has been copied into an Array or Dictionary. Variants that were
+// passed through as-is are owned by the caller, so they are not destroyed.
+func destroyEncoded(value reflect.Value, variant gdnative.Variant) {
+	if !isVariantValue(value) {
+		variant.Destroy()
+	}
+}
+
+// isVariantValue will return true if the given value is a Variant, or a pointer
+// or interface holding one, which encodeValue passes through as-is.
+func isVariantValue(value reflect.Value) bool {
+	for value.IsValid() && (value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr) && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value.IsValid() && value.Type() == variantType
+}
+
+// decodeValue will convert the given Variant into a Go value of the given type.
+func decodeValue(variant gdnative.Variant, t reflect.Type) (reflect.Value, error) {
+	if t == variantType {
+		return reflect.ValueOf(variant), nil
+	}
+
+	variantKind := variant.GetType()
+
+	// Godot classes are built from the Godot object. Nil is converted into a
+	// nil class.
+	if t.Implements(classType) {
+		if variantKind == gdnative.VariantTypeNil {
+			return reflect.Zero(t), nil
+		}
+		if variantKind != gdnative.VariantTypeObject {
+			return reflect.Value{}, decodeError(variant, t)
+		}
+		return decodeClass(variant.AsObject(), t)
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if t.NumMethod() != 0 {
+			return reflect.Value{}, decodeError(variant, t)
+		}
+		value := reflect.New(t).Elem()
+		switch variantKind {
+		case gdnative.VariantTypeNil:
+		case gdnative.VariantTypeObject:
+			object := variant.AsObject()
+			class := &Object{owner: object}
+			value.Set(reflect.ValueOf(GetActualClass(class.GetClass(), object)))
+		default:
+			value.Set(VariantToGoType(variant))
+		}
+		return value, nil
+
+	case reflect.Bool:
+		if variantKind != gdnative.VariantTypeBool {
+			return reflect.Value{}, decodeError(variant, t)
+		}
+		return reflect.ValueOf(bool(variant.AsBool())).Convert(t), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value := reflect.New(t).Elem()
+		switch variantKind {
+		case gdnative.VariantTypeInt:
+			i := int64(variant.AsInt())
+			if value.OverflowInt(i) {
+				return reflect.Value{}, fmt.Errorf("value %d overflows %s", i, t)
+			}
+			value.SetInt(i)
+		case gdnative.VariantTypeReal:
+			f := float64(variant.AsReal())
+			if f != float64(int64(f)) || value.OverflowInt(int64(f)) {
+				return reflect.Value{}, fmt.Errorf("value %v can not be converted to %s", f, t)
+			}
+			value.SetInt(int64(f))
+		default:
+			return reflect.Value{}, decodeError(variant, t)
+		}
+		return value, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value := reflect.New(t).Elem()
+		switch variantKind {
+		case gdnative.VariantTypeInt:
+			i := int64(variant.AsInt())
+			if i < 0 || value.OverflowUint(uint64(i)) {
+				return reflect.Value{}, fmt.Errorf("value %d overflows %s", i, t)
+			}
+			value.SetUint(uint64(i))
+		case gdnative.VariantTypeReal:
+			f := float64(variant.AsReal())
+			if f < 0 || f != float64(uint64(f)) || value.OverflowUint(uint64(f)) {
+				return reflect.Value{}, fmt.Errorf("value %v can not be converted to %s", f, t)
+			}
+			value.SetUint(uint64(f))
+		default:
+			return reflect.Value{}, decodeError(variant, t)
+		}
+		return value, nil
+
+	case reflect.Float32, reflect.Float64:
+		value := reflect.New(t).Elem()
+		switch variantKind {
+		case gdnative.VariantTypeReal:
+			value.SetFloat(float64(variant.AsReal()))
+		case gdnative.VariantTypeInt:
+			value.SetFloat(float64(variant.AsInt()))
+		default:
+			return reflect.Value{}, decodeError(variant, t)
+		}
+		return value, nil
+
+	case reflect.String:
+		if variantKind != gdnative.VariantTypeString {
+			return reflect.Value{}, decodeError(variant, t)
+		}
+		return reflect.ValueOf(string(variant.AsString())).Convert(t), nil
+
+	case reflect.Ptr:
+		value := reflect.New(t.Elem())
+		if variantKind == gdnative.VariantTypeNil {
+			return reflect.Zero(t), nil
+		}
+		elem, err := decodeValue(variant, t.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.Elem().Set(elem)
+		return value, nil
+
+	case reflect.Slice, reflect.Array:
+		return decodeSlice(variant, t)
+
+	case reflect.Map:
+		return decodeMap(variant, t)
+
+	case reflect.Struct:
+		if t.PkgPath() != gdnativePath {
+			return decodeStruct(variant, t)
+		}
+		if t == arrayType {
+			if array, ok := variantAsArray(variant); ok {
+				return reflect.ValueOf(array), nil
+			}
+		}
+		if value := VariantToGoType(variant); value.IsValid() && value.Type() == t {
+			return value, nil
+		}
+	}
+
+	return reflect.Value{}, decodeError(variant, t)
+}
+
+// decodeClass will return the given Godot object as a Go value of the given
+// class type. If the object is an instance of a registered class, that instance
+// will be returned.
+func decodeClass(object gdnative.Object, t reflect.Type) (reflect.Value, error) {
+	base := &Object{owner: object}
+	class := reflect.ValueOf(GetActualClass(base.GetClass(), object))
+	if class.Type().AssignableTo(t) {
+		value := reflect.New(t).Elem()
+		value.Set(class)
+		return value, nil
+	}
+
+	// Otherwise, build a new wrapper of the given type with the object, which
+	// allows a subclass to be used as one of its base classes. Classes that are
+	// registered from Go can't be built this way, since their Go instance is
+	// owned by Godot.
+	if className, ok := wrapperClassName(t); ok && bool(base.IsClass(gdnative.String(className))) {
+		value := reflect.New(t.Elem())
+		value.Interface().(Class).SetBaseObject(object)
+		return value, nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("can not convert object of class %s into %s", class.Type(), t)
+}
+
+// wrapperClassName will return the Godot class of the given generated class
+// type, and false if the type is not a generated class, such as the type of a
+// class registered from Go.
+func wrapperClassName(t reflect.Type) (string, bool) {
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct || !t.Implements(classType) {
+		return "", false
+	}
+	pkgPath := t.Elem().PkgPath()
+	if pkgPath != godotPath && !strings.HasPrefix(pkgPath, godotPath+"/") {
+		return "", false
+	}
+
+	// Generated classes declare their own BaseClass method, while classes that
+	// embed one inherit it from the embedded class.
+	className := reflect.New(t.Elem()).Interface().(Class).BaseClass()
+	for i := 0; i < t.Elem().NumField(); i++ {
+		field := t.Elem().Field(i)
+		if !field.Anonymous || !reflect.PtrTo(field.Type).Implements(classType) {
+			continue
+		}
+		embedded := reflect.New(field.Type).Interface().(Class)
+		if embedded.BaseClass() == className {
+			return "", false
+		}
+	}
+
+	return className, true
+}
+
+// decodeSlice will convert the given Array or Pool array Variant into a Go slice
+// or array of the given type.
+func decodeSlice(variant gdnative.Variant, t reflect.Type) (reflect.Value, error) {
+	if variant.GetType() == gdnative.VariantTypeNil {
+		return reflect.Zero(t), nil
+	}
+
+	array, ok := variantAsArray(variant)
+	if !ok {
+		return reflect.Value{}, decodeError(variant, t)
+	}
+	size := int(array.Size())
+
+	var value reflect.Value
+	if t.Kind() == reflect.Array {
+		if size > t.Len() {
+			return reflect.Value{}, fmt.Errorf("array of size %d does not fit in %s", size, t)
+		}
+		value = reflect.New(t).Elem()
+	} else {
+		value = reflect.MakeSlice(t, size, size)
+	}
+
+	for i := 0; i < size; i++ {
+		elem, err := decodeValue(array.Get(gdnative.Int(i)), t.Elem())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("index %d: %s", i, err)
+		}
+		value.Index(i).Set(elem)
+	}
+
+	return value, nil
+}
+
+// decodeMap will convert the given Dictionary Variant into a Go map of the given
+// type.
+func decodeMap(variant gdnative.Variant, t reflect.Type) (reflect.Value, error) {
+	if variant.GetType() == gdnative.VariantTypeNil {
+		return reflect.Zero(t), nil
+	}
+	if variant.GetType() != gdnative.VariantTypeDictionary {
+		return reflect.Value{}, decodeError(variant, t)
+	}
+
+	dictionary := variant.AsDictionary()
+	keys := dictionary.Keys()
+	value := reflect.MakeMap(t)
+	for i := 0; i < int(keys.Size()); i++ {
+		keyVariant := keys.Get(gdnative.Int(i))
+		key, err := decodeValue(keyVariant, t.Key())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("key %d: %s", i, err)
+		}
+		elem, err := decodeValue(dictionary.Get(keyVariant), t.Elem())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("key %v: %s", key, err)
+		}
+		value.SetMapIndex(key, elem)
+	}
+
+	return value, nil
+}
+
+// decodeStruct will convert the given Dictionary Variant into a Go struct of the
+// given type. Fields that are missing from the Dictionary are left empty.
+func decodeStruct(variant gdnative.Variant, t reflect.Type) (reflect.Value, error) {
+	if variant.GetType() != gdnative.VariantTypeDictionary {
+		return reflect.Value{}, decodeError(variant, t)
+	}
+
+	dictionary := variant.AsDictionary()
+	value := reflect.New(t).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name, ok := structFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		key := gdnative.NewVariantString(gdnative.String(name))
+		if !dictionary.Has(key) {
+			continue
+		}
+		field, err := decodeValue(dictionary.Get(key), t.Field(i).Type)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("field %s: %s", name, err)
+		}
+		value.Field(i).Set(field)
+	}
+
+	return value, nil
+}
+
+// variantAsArray will return the given Array or Pool array Variant as an Array.
+func variantAsArray(variant gdnative.Variant) (gdnative.Array, bool) {
+	switch variant.GetType() {
+	case gdnative.VariantTypeArray:
+		return variant.AsArray(), true
+	case gdnative.VariantTypePoolByteArray:
+		return gdnative.NewArrayPoolByteArray(variant.AsPoolByteArray()), true
+	case gdnative.VariantTypePoolIntArray:
+		return gdnative.NewArrayPoolIntArray(variant.AsPoolIntArray()), true
+	case gdnative.VariantTypePoolRealArray:
+		return gdnative.NewArrayPoolRealArray(variant.AsPoolRealArray()), true
+	case gdnative.VariantTypePoolStringArray:
+		return gdnative.NewArrayPoolStringArray(variant.AsPoolStringArray()), true
+	case gdnative.VariantTypePoolVector2Array:
+		return gdnative.NewArrayPoolVector2Array(variant.AsPoolVector2Array()), true
+	case gdnative.VariantTypePoolVector3Array:
+		return gdnative.NewArrayPoolVector3Array(variant.AsPoolVector3Array()), true
+	case gdnative.VariantTypePoolColorArray:
+		return gdnative.NewArrayPoolColorArray(variant.AsPoolColorArray()), true
+	}
+	return gdnative.Array{}, false
+}
+
+// sliceVariantType will return the variant type that the given slice or array
+// type is converted to.
+func sliceVariantType(t reflect.Type) gdnative.VariantType {
+	elem := t.Elem()
+	switch {
+	case elem == vector2Type:
+		return gdnative.VariantTypePoolVector2Array
+	case elem == vector3Type:
+		return gdnative.VariantTypePoolVector3Array
+	case elem == colorType:
+		return gdnative.VariantTypePoolColorArray
+	case elem.Implements(classType):
+		return gdnative.VariantTypeArray
+	}
+
+	switch elem.Kind() {
+	case reflect.Uint8:
+		return gdnative.VariantTypePoolByteArray
+	case reflect.Int32:
+		return gdnative.VariantTypePoolIntArray
+	case reflect.Float32:
+		return gdnative.VariantTypePoolRealArray
+	case reflect.String:
+		return gdnative.VariantTypePoolStringArray
+	}
+
+	return gdnative.VariantTypeArray
+}
+
+// structFieldName will return the Dictionary key of the given struct field, and
+// false if the field should be skipped.
+func structFieldName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		return "", false
+	}
+
+	name := strings.Split(field.Tag.Get("godot"), ",")[0]
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	}
+
+	return name, true
+}
+
+// decodeError will return an error for a Variant that can not be converted into
+// the given type.
+func decodeError(variant gdnative.Variant, t reflect.Type) error {
+	return fmt.Errorf("can not convert %s variant into %s", gdnative.VariantTypeName(variant.GetType()), t)
+}
@@ -0,0 +1,39 @@
+package godot
+
+import (
+	"reflect"
+	"testing"
+)
+
+// goClass is a class as it would be registered from Go.
+type goClass struct {
+	Node
+}
+
+func TestWrapperClassName(t *testing.T) {
+	tests := []struct {
+		classType reflect.Type
+		expected  string
+		ok        bool
+	}{
+		{reflect.TypeOf(&Object{}), "Object", true},
+		{reflect.TypeOf(&Node{}), "Node", true},
+		{reflect.TypeOf(&Node2D{}), "Node2D", true},
+
+		// Classes registered from Go can't be built around another object.
+		{reflect.TypeOf(&goClass{}), "", false},
+		{reflect.TypeOf(&signalTrampoline{}), "", false},
+
+		// Other types are not classes.
+		{reflect.TypeOf(Node{}), "", false},
+		{reflect.TypeOf(&struct{}{}), "", false},
+		{reflect.TypeOf((*NodeImplementer)(nil)).Elem(), "", false},
+	}
+
+	for _, test := range tests {
+		className, ok := wrapperClassName(test.classType)
+		if className != test.expected || ok != test.ok {
+			t.Errorf("wrapperClassName(%s) = %q, %v; want %q, %v", test.classType, className, ok, test.expected, test.ok)
+		}
+	}
+}
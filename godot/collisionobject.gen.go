@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCollisionObjectFromPointer(ptr gdnative.Pointer) CollisionObject {
-func newCollisionObjectFromPointer(ptr gdnative.Pointer) CollisionObject {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CollisionObject{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 CollisionObject is the base class for physics objects. It can hold any number of collision [Shape]s. Each shape must be assigned to a [i]shape owner[/i]. The CollisionObject can have any number of shape owners. Shape owners are not nodes and do not appear in the editor, but are accessible through code using the [code]shape_owner_*[/code] methods.
 */
@@ -34,23 +25,49 @@ func (o *CollisionObject) BaseClass() string {
 	return "CollisionObject"
 }
 
+// Method binds of the CollisionObject class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCollisionObjectX_InputEvent            = gdnative.NewLazyMethodBind("CollisionObject", "_input_event")
+	methodBindCollisionObjectCreateShapeOwner        = gdnative.NewLazyMethodBind("CollisionObject", "create_shape_owner")
+	methodBindCollisionObjectGetCaptureInputOnDrag   = gdnative.NewLazyMethodBind("CollisionObject", "get_capture_input_on_drag")
+	methodBindCollisionObjectGetRid                  = gdnative.NewLazyMethodBind("CollisionObject", "get_rid")
+	methodBindCollisionObjectGetShapeOwners          = gdnative.NewLazyMethodBind("CollisionObject", "get_shape_owners")
+	methodBindCollisionObjectIsRayPickable           = gdnative.NewLazyMethodBind("CollisionObject", "is_ray_pickable")
+	methodBindCollisionObjectIsShapeOwnerDisabled    = gdnative.NewLazyMethodBind("CollisionObject", "is_shape_owner_disabled")
+	methodBindCollisionObjectRemoveShapeOwner        = gdnative.NewLazyMethodBind("CollisionObject", "remove_shape_owner")
+	methodBindCollisionObjectSetCaptureInputOnDrag   = gdnative.NewLazyMethodBind("CollisionObject", "set_capture_input_on_drag")
+	methodBindCollisionObjectSetRayPickable          = gdnative.NewLazyMethodBind("CollisionObject", "set_ray_pickable")
+	methodBindCollisionObjectShapeFindOwner          = gdnative.NewLazyMethodBind("CollisionObject", "shape_find_owner")
+	methodBindCollisionObjectShapeOwnerAddShape      = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_add_shape")
+	methodBindCollisionObjectShapeOwnerClearShapes   = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_clear_shapes")
+	methodBindCollisionObjectShapeOwnerGetOwner      = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_get_owner")
+	methodBindCollisionObjectShapeOwnerGetShape      = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_get_shape")
+	methodBindCollisionObjectShapeOwnerGetShapeCount = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_get_shape_count")
+	methodBindCollisionObjectShapeOwnerGetShapeIndex = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_get_shape_index")
+	methodBindCollisionObjectShapeOwnerGetTransform  = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_get_transform")
+	methodBindCollisionObjectShapeOwnerRemoveShape   = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_remove_shape")
+	methodBindCollisionObjectShapeOwnerSetDisabled   = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_set_disabled")
+	methodBindCollisionObjectShapeOwnerSetTransform  = gdnative.NewLazyMethodBind("CollisionObject", "shape_owner_set_transform")
+)
+
 /*
-        Accepts unhandled [InputEvent]s. [code]click_position[/code] is the clicked location in world space and [code]click_normal[/code] is the normal vector extending from the clicked surface of the [Shape] at [code]shape_idx[/code]. Connect to the [code]input_event[/code] signal to easily pick up these events.
-	Args: [{ false camera Object} { false event InputEvent} { false click_position Vector3} { false click_normal Vector3} { false shape_idx int}], Returns: void
+	        Accepts unhandled [InputEvent]s. [code]click_position[/code] is the clicked location in world space and [code]click_normal[/code] is the normal vector extending from the clicked surface of the [Shape] at [code]shape_idx[/code]. Connect to the [code]input_event[/code] signal to easily pick up these events.
+		Args: [{ false camera Object} { false event InputEvent} { false click_position Vector3} { false click_normal Vector3} { false shape_idx int}], Returns: void
 */
 func (o *CollisionObject) X_InputEvent(camera ObjectImplementer, event InputEventImplementer, clickPosition gdnative.Vector3, clickNormal gdnative.Vector3, shapeIdx gdnative.Int) {
 	//log.Println("Calling CollisionObject.X_InputEvent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
-	ptrArguments[0] = gdnative.NewPointerFromObject(camera.GetBaseObject())
-	ptrArguments[1] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(camera))
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(event))
 	ptrArguments[2] = gdnative.NewPointerFromVector3(clickPosition)
 	ptrArguments[3] = gdnative.NewPointerFromVector3(clickNormal)
 	ptrArguments[4] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "_input_event")
+	methodBind := methodBindCollisionObjectX_InputEvent.Get()
 
 	// Call the parent method.
 	// void
@@ -60,18 +77,18 @@ func (o *CollisionObject) X_InputEvent(camera ObjectImplementer, event InputEven
 }
 
 /*
-        Creates a new shape owner for the given object. Returns [code]owner_id[/code] of the new owner for future reference.
-	Args: [{ false owner Object}], Returns: int
+	        Creates a new shape owner for the given object. Returns [code]owner_id[/code] of the new owner for future reference.
+		Args: [{ false owner Object}], Returns: int
 */
 func (o *CollisionObject) CreateShapeOwner(owner ObjectImplementer) gdnative.Int {
 	//log.Println("Calling CollisionObject.CreateShapeOwner()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(owner.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(owner))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "create_shape_owner")
+	methodBind := methodBindCollisionObjectCreateShapeOwner.Get()
 
 	// Call the parent method.
 	// int
@@ -84,8 +101,8 @@ func (o *CollisionObject) CreateShapeOwner(owner ObjectImplementer) gdnative.Int
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionObject) GetCaptureInputOnDrag() gdnative.Bool {
 	//log.Println("Calling CollisionObject.GetCaptureInputOnDrag()")
@@ -94,7 +111,7 @@ func (o *CollisionObject) GetCaptureInputOnDrag() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "get_capture_input_on_drag")
+	methodBind := methodBindCollisionObjectGetCaptureInputOnDrag.Get()
 
 	// Call the parent method.
 	// bool
@@ -107,8 +124,8 @@ func (o *CollisionObject) GetCaptureInputOnDrag() gdnative.Bool {
 }
 
 /*
-        Returns the object's [RID].
-	Args: [], Returns: RID
+	        Returns the object's [RID].
+		Args: [], Returns: RID
 */
 func (o *CollisionObject) GetRid() gdnative.Rid {
 	//log.Println("Calling CollisionObject.GetRid()")
@@ -117,7 +134,7 @@ func (o *CollisionObject) GetRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "get_rid")
+	methodBind := methodBindCollisionObjectGetRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -130,8 +147,8 @@ func (o *CollisionObject) GetRid() gdnative.Rid {
 }
 
 /*
-        Returns an [Array] of [code]owner_id[/code] identifiers. You can use these ids in other methods that take [code]owner_id[/code] as an argument.
-	Args: [], Returns: Array
+	        Returns an [Array] of [code]owner_id[/code] identifiers. You can use these ids in other methods that take [code]owner_id[/code] as an argument.
+		Args: [], Returns: Array
 */
 func (o *CollisionObject) GetShapeOwners() gdnative.Array {
 	//log.Println("Calling CollisionObject.GetShapeOwners()")
@@ -140,7 +157,7 @@ func (o *CollisionObject) GetShapeOwners() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "get_shape_owners")
+	methodBind := methodBindCollisionObjectGetShapeOwners.Get()
 
 	// Call the parent method.
 	// Array
@@ -153,8 +170,8 @@ func (o *CollisionObject) GetShapeOwners() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionObject) IsRayPickable() gdnative.Bool {
 	//log.Println("Calling CollisionObject.IsRayPickable()")
@@ -163,7 +180,7 @@ func (o *CollisionObject) IsRayPickable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "is_ray_pickable")
+	methodBind := methodBindCollisionObjectIsRayPickable.Get()
 
 	// Call the parent method.
 	// bool
@@ -176,8 +193,8 @@ func (o *CollisionObject) IsRayPickable() gdnative.Bool {
 }
 
 /*
-        If [code]true[/code] the shape owner and its shapes are disabled.
-	Args: [{ false owner_id int}], Returns: bool
+	        If [code]true[/code] the shape owner and its shapes are disabled.
+		Args: [{ false owner_id int}], Returns: bool
 */
 func (o *CollisionObject) IsShapeOwnerDisabled(ownerId gdnative.Int) gdnative.Bool {
 	//log.Println("Calling CollisionObject.IsShapeOwnerDisabled()")
@@ -187,7 +204,7 @@ func (o *CollisionObject) IsShapeOwnerDisabled(ownerId gdnative.Int) gdnative.Bo
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "is_shape_owner_disabled")
+	methodBind := methodBindCollisionObjectIsShapeOwnerDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -200,8 +217,8 @@ func (o *CollisionObject) IsShapeOwnerDisabled(ownerId gdnative.Int) gdnative.Bo
 }
 
 /*
-        Removes the given shape owner.
-	Args: [{ false owner_id int}], Returns: void
+	        Removes the given shape owner.
+		Args: [{ false owner_id int}], Returns: void
 */
 func (o *CollisionObject) RemoveShapeOwner(ownerId gdnative.Int) {
 	//log.Println("Calling CollisionObject.RemoveShapeOwner()")
@@ -211,7 +228,7 @@ func (o *CollisionObject) RemoveShapeOwner(ownerId gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "remove_shape_owner")
+	methodBind := methodBindCollisionObjectRemoveShapeOwner.Get()
 
 	// Call the parent method.
 	// void
@@ -221,8 +238,8 @@ func (o *CollisionObject) RemoveShapeOwner(ownerId gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CollisionObject) SetCaptureInputOnDrag(enable gdnative.Bool) {
 	//log.Println("Calling CollisionObject.SetCaptureInputOnDrag()")
@@ -232,7 +249,7 @@ func (o *CollisionObject) SetCaptureInputOnDrag(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "set_capture_input_on_drag")
+	methodBind := methodBindCollisionObjectSetCaptureInputOnDrag.Get()
 
 	// Call the parent method.
 	// void
@@ -242,8 +259,8 @@ func (o *CollisionObject) SetCaptureInputOnDrag(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ray_pickable bool}], Returns: void
+	        Undocumented
+		Args: [{ false ray_pickable bool}], Returns: void
 */
 func (o *CollisionObject) SetRayPickable(rayPickable gdnative.Bool) {
 	//log.Println("Calling CollisionObject.SetRayPickable()")
@@ -253,7 +270,7 @@ func (o *CollisionObject) SetRayPickable(rayPickable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(rayPickable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "set_ray_pickable")
+	methodBind := methodBindCollisionObjectSetRayPickable.Get()
 
 	// Call the parent method.
 	// void
@@ -263,8 +280,8 @@ func (o *CollisionObject) SetRayPickable(rayPickable gdnative.Bool) {
 }
 
 /*
-        Returns the [code]owner_id[/code] of the given shape.
-	Args: [{ false shape_index int}], Returns: int
+	        Returns the [code]owner_id[/code] of the given shape.
+		Args: [{ false shape_index int}], Returns: int
 */
 func (o *CollisionObject) ShapeFindOwner(shapeIndex gdnative.Int) gdnative.Int {
 	//log.Println("Calling CollisionObject.ShapeFindOwner()")
@@ -274,7 +291,7 @@ func (o *CollisionObject) ShapeFindOwner(shapeIndex gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(shapeIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_find_owner")
+	methodBind := methodBindCollisionObjectShapeFindOwner.Get()
 
 	// Call the parent method.
 	// int
@@ -287,8 +304,8 @@ func (o *CollisionObject) ShapeFindOwner(shapeIndex gdnative.Int) gdnative.Int {
 }
 
 /*
-        Adds a [Shape] to the shape owner.
-	Args: [{ false owner_id int} { false shape Shape}], Returns: void
+	        Adds a [Shape] to the shape owner.
+		Args: [{ false owner_id int} { false shape Shape}], Returns: void
 */
 func (o *CollisionObject) ShapeOwnerAddShape(ownerId gdnative.Int, shape ShapeImplementer) {
 	//log.Println("Calling CollisionObject.ShapeOwnerAddShape()")
@@ -296,10 +313,10 @@ func (o *CollisionObject) ShapeOwnerAddShape(ownerId gdnative.Int, shape ShapeIm
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
-	ptrArguments[1] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_add_shape")
+	methodBind := methodBindCollisionObjectShapeOwnerAddShape.Get()
 
 	// Call the parent method.
 	// void
@@ -309,8 +326,8 @@ func (o *CollisionObject) ShapeOwnerAddShape(ownerId gdnative.Int, shape ShapeIm
 }
 
 /*
-        Removes all shapes from the shape owner.
-	Args: [{ false owner_id int}], Returns: void
+	        Removes all shapes from the shape owner.
+		Args: [{ false owner_id int}], Returns: void
 */
 func (o *CollisionObject) ShapeOwnerClearShapes(ownerId gdnative.Int) {
 	//log.Println("Calling CollisionObject.ShapeOwnerClearShapes()")
@@ -320,7 +337,7 @@ func (o *CollisionObject) ShapeOwnerClearShapes(ownerId gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_clear_shapes")
+	methodBind := methodBindCollisionObjectShapeOwnerClearShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -330,8 +347,8 @@ func (o *CollisionObject) ShapeOwnerClearShapes(ownerId gdnative.Int) {
 }
 
 /*
-        Returns the parent object of the given shape owner.
-	Args: [{ false owner_id int}], Returns: Object
+	        Returns the parent object of the given shape owner.
+		Args: [{ false owner_id int}], Returns: Object
 */
 func (o *CollisionObject) ShapeOwnerGetOwner(ownerId gdnative.Int) ObjectImplementer {
 	//log.Println("Calling CollisionObject.ShapeOwnerGetOwner()")
@@ -341,7 +358,7 @@ func (o *CollisionObject) ShapeOwnerGetOwner(ownerId gdnative.Int) ObjectImpleme
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_get_owner")
+	methodBind := methodBindCollisionObjectShapeOwnerGetOwner.Get()
 
 	// Call the parent method.
 	// Object
@@ -349,27 +366,25 @@ func (o *CollisionObject) ShapeOwnerGetOwner(ownerId gdnative.Int) ObjectImpleme
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the [Shape] with the given id from the given shape owner.
-	Args: [{ false owner_id int} { false shape_id int}], Returns: Shape
+	        Returns the [Shape] with the given id from the given shape owner.
+		Args: [{ false owner_id int} { false shape_id int}], Returns: Shape
 */
 func (o *CollisionObject) ShapeOwnerGetShape(ownerId gdnative.Int, shapeId gdnative.Int) ShapeImplementer {
 	//log.Println("Calling CollisionObject.ShapeOwnerGetShape()")
@@ -380,7 +395,7 @@ func (o *CollisionObject) ShapeOwnerGetShape(ownerId gdnative.Int, shapeId gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_get_shape")
+	methodBind := methodBindCollisionObjectShapeOwnerGetShape.Get()
 
 	// Call the parent method.
 	// Shape
@@ -388,27 +403,25 @@ func (o *CollisionObject) ShapeOwnerGetShape(ownerId gdnative.Int, shapeId gdnat
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShapeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShapeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shape" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShapeImplementer)
+	ret := Shape{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ShapeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of shapes the given shape owner contains.
-	Args: [{ false owner_id int}], Returns: int
+	        Returns the number of shapes the given shape owner contains.
+		Args: [{ false owner_id int}], Returns: int
 */
 func (o *CollisionObject) ShapeOwnerGetShapeCount(ownerId gdnative.Int) gdnative.Int {
 	//log.Println("Calling CollisionObject.ShapeOwnerGetShapeCount()")
@@ -418,7 +431,7 @@ func (o *CollisionObject) ShapeOwnerGetShapeCount(ownerId gdnative.Int) gdnative
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_get_shape_count")
+	methodBind := methodBindCollisionObjectShapeOwnerGetShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -431,8 +444,8 @@ func (o *CollisionObject) ShapeOwnerGetShapeCount(ownerId gdnative.Int) gdnative
 }
 
 /*
-        Returns the child index of the [Shape] with the given id from the given shape owner.
-	Args: [{ false owner_id int} { false shape_id int}], Returns: int
+	        Returns the child index of the [Shape] with the given id from the given shape owner.
+		Args: [{ false owner_id int} { false shape_id int}], Returns: int
 */
 func (o *CollisionObject) ShapeOwnerGetShapeIndex(ownerId gdnative.Int, shapeId gdnative.Int) gdnative.Int {
 	//log.Println("Calling CollisionObject.ShapeOwnerGetShapeIndex()")
@@ -443,7 +456,7 @@ func (o *CollisionObject) ShapeOwnerGetShapeIndex(ownerId gdnative.Int, shapeId
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_get_shape_index")
+	methodBind := methodBindCollisionObjectShapeOwnerGetShapeIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -456,8 +469,8 @@ func (o *CollisionObject) ShapeOwnerGetShapeIndex(ownerId gdnative.Int, shapeId
 }
 
 /*
-        Returns the shape owner's [Transform].
-	Args: [{ false owner_id int}], Returns: Transform
+	        Returns the shape owner's [Transform].
+		Args: [{ false owner_id int}], Returns: Transform
 */
 func (o *CollisionObject) ShapeOwnerGetTransform(ownerId gdnative.Int) gdnative.Transform {
 	//log.Println("Calling CollisionObject.ShapeOwnerGetTransform()")
@@ -467,7 +480,7 @@ func (o *CollisionObject) ShapeOwnerGetTransform(ownerId gdnative.Int) gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_get_transform")
+	methodBind := methodBindCollisionObjectShapeOwnerGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -480,8 +493,8 @@ func (o *CollisionObject) ShapeOwnerGetTransform(ownerId gdnative.Int) gdnative.
 }
 
 /*
-        Removes a shape from the given shape owner.
-	Args: [{ false owner_id int} { false shape_id int}], Returns: void
+	        Removes a shape from the given shape owner.
+		Args: [{ false owner_id int} { false shape_id int}], Returns: void
 */
 func (o *CollisionObject) ShapeOwnerRemoveShape(ownerId gdnative.Int, shapeId gdnative.Int) {
 	//log.Println("Calling CollisionObject.ShapeOwnerRemoveShape()")
@@ -492,7 +505,7 @@ func (o *CollisionObject) ShapeOwnerRemoveShape(ownerId gdnative.Int, shapeId gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_remove_shape")
+	methodBind := methodBindCollisionObjectShapeOwnerRemoveShape.Get()
 
 	// Call the parent method.
 	// void
@@ -502,8 +515,8 @@ func (o *CollisionObject) ShapeOwnerRemoveShape(ownerId gdnative.Int, shapeId gd
 }
 
 /*
-        If [code]true[/code] disables the given shape owner.
-	Args: [{ false owner_id int} { false disabled bool}], Returns: void
+	        If [code]true[/code] disables the given shape owner.
+		Args: [{ false owner_id int} { false disabled bool}], Returns: void
 */
 func (o *CollisionObject) ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled gdnative.Bool) {
 	//log.Println("Calling CollisionObject.ShapeOwnerSetDisabled()")
@@ -514,7 +527,7 @@ func (o *CollisionObject) ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled g
 	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_set_disabled")
+	methodBind := methodBindCollisionObjectShapeOwnerSetDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -524,8 +537,8 @@ func (o *CollisionObject) ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled g
 }
 
 /*
-        Sets the [Transform] of the given shape owner.
-	Args: [{ false owner_id int} { false transform Transform}], Returns: void
+	        Sets the [Transform] of the given shape owner.
+		Args: [{ false owner_id int} { false transform Transform}], Returns: void
 */
 func (o *CollisionObject) ShapeOwnerSetTransform(ownerId gdnative.Int, transform gdnative.Transform) {
 	//log.Println("Calling CollisionObject.ShapeOwnerSetTransform()")
@@ -536,7 +549,7 @@ func (o *CollisionObject) ShapeOwnerSetTransform(ownerId gdnative.Int, transform
 	ptrArguments[1] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject", "shape_owner_set_transform")
+	methodBind := methodBindCollisionObjectShapeOwnerSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -545,6 +558,100 @@ func (o *CollisionObject) ShapeOwnerSetTransform(ownerId gdnative.Int, transform
 
 }
 
+// InputCaptureOnDrag will return the value of the "input_capture_on_drag" property.
+func (o *CollisionObject) InputCaptureOnDrag() gdnative.Bool {
+	return o.GetCaptureInputOnDrag()
+}
+
+// SetInputCaptureOnDrag will set the value of the "input_capture_on_drag" property.
+func (o *CollisionObject) SetInputCaptureOnDrag(value gdnative.Bool) {
+	o.SetCaptureInputOnDrag(value)
+}
+
+// InputRayPickable will return the value of the "input_ray_pickable" property.
+func (o *CollisionObject) InputRayPickable() gdnative.Bool {
+	return o.IsRayPickable()
+}
+
+// SetInputRayPickable will set the value of the "input_ray_pickable" property.
+func (o *CollisionObject) SetInputRayPickable(value gdnative.Bool) {
+	o.SetRayPickable(value)
+}
+
+// Signals of the CollisionObject class.
+const (
+	CollisionObjectSignalInputEvent   gdnative.String = "input_event"
+	CollisionObjectSignalMouseEntered gdnative.String = "mouse_entered"
+	CollisionObjectSignalMouseExited  gdnative.String = "mouse_exited"
+)
+
+// CollisionObjectInputEventArgs holds the arguments of the "input_event" signal.
+type CollisionObjectInputEventArgs struct {
+	Camera        ObjectImplementer
+	Event         InputEventImplementer
+	ClickPosition gdnative.Vector3
+	ClickNormal   gdnative.Vector3
+	ShapeIdx      gdnative.Int
+}
+
+// ConnectInputEvent will connect the "input_event" signal to the given method of the target object.
+func (o *CollisionObject) ConnectInputEvent(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CollisionObjectSignalInputEvent, target, method, binds, 0)
+}
+
+// EmitInputEvent will emit the "input_event" signal.
+func (o *CollisionObject) EmitInputEvent(args CollisionObjectInputEventArgs) error {
+	arg0 := gdnative.NewVariantObject(GetBaseObject(args.Camera))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(GetBaseObject(args.Event))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantVector3(args.ClickPosition)
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantVector3(args.ClickNormal)
+	defer arg3.Destroy()
+	arg4 := gdnative.NewVariantInt(gdnative.Int64T(args.ShapeIdx))
+	defer arg4.Destroy()
+
+	ret, err := o.EmitSignal(CollisionObjectSignalInputEvent, arg0, arg1, arg2, arg3, arg4)
+	ret.Destroy()
+	return err
+}
+
+// ConnectMouseEntered will connect the "mouse_entered" signal to the given method of the target object.
+func (o *CollisionObject) ConnectMouseEntered(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CollisionObjectSignalMouseEntered, target, method, binds, 0)
+}
+
+// EmitMouseEntered will emit the "mouse_entered" signal.
+func (o *CollisionObject) EmitMouseEntered() error {
+
+	ret, err := o.EmitSignal(CollisionObjectSignalMouseEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectMouseExited will connect the "mouse_exited" signal to the given method of the target object.
+func (o *CollisionObject) ConnectMouseExited(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CollisionObjectSignalMouseExited, target, method, binds, 0)
+}
+
+// EmitMouseExited will emit the "mouse_exited" signal.
+func (o *CollisionObject) EmitMouseExited() error {
+
+	ret, err := o.EmitSignal(CollisionObjectSignalMouseExited)
+	ret.Destroy()
+	return err
+}
+
 // CollisionObjectImplementer is an interface that implements the methods
 // of the CollisionObject class.
 type CollisionObjectImplementer interface {
@@ -570,4 +677,14 @@ type CollisionObjectImplementer interface {
 	ShapeOwnerRemoveShape(ownerId gdnative.Int, shapeId gdnative.Int)
 	ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled gdnative.Bool)
 	ShapeOwnerSetTransform(ownerId gdnative.Int, transform gdnative.Transform)
+	InputCaptureOnDrag() gdnative.Bool
+	SetInputCaptureOnDrag(value gdnative.Bool)
+	InputRayPickable() gdnative.Bool
+	SetInputRayPickable(value gdnative.Bool)
+	ConnectInputEvent(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitInputEvent(args CollisionObjectInputEventArgs) error
+	ConnectMouseEntered(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMouseEntered() error
+	ConnectMouseExited(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMouseExited() error
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCollisionObject2DFromPointer(ptr gdnative.Pointer) CollisionObject2D {
-func newCollisionObject2DFromPointer(ptr gdnative.Pointer) CollisionObject2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CollisionObject2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 CollisionObject2D is the base class for 2D physics objects. It can hold any number of 2D collision [Shape2D]s. Each shape must be assigned to a [i]shape owner[/i]. The CollisionObject2D can have any number of shape owners. Shape owners are not nodes and do not appear in the editor, but are accessible through code using the [code]shape_owner_*[/code] methods.
 */
@@ -34,21 +25,47 @@ func (o *CollisionObject2D) BaseClass() string {
 	return "CollisionObject2D"
 }
 
+// Method binds of the CollisionObject2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCollisionObject2DX_InputEvent                       = gdnative.NewLazyMethodBind("CollisionObject2D", "_input_event")
+	methodBindCollisionObject2DCreateShapeOwner                   = gdnative.NewLazyMethodBind("CollisionObject2D", "create_shape_owner")
+	methodBindCollisionObject2DGetRid                             = gdnative.NewLazyMethodBind("CollisionObject2D", "get_rid")
+	methodBindCollisionObject2DGetShapeOwners                     = gdnative.NewLazyMethodBind("CollisionObject2D", "get_shape_owners")
+	methodBindCollisionObject2DIsPickable                         = gdnative.NewLazyMethodBind("CollisionObject2D", "is_pickable")
+	methodBindCollisionObject2DIsShapeOwnerDisabled               = gdnative.NewLazyMethodBind("CollisionObject2D", "is_shape_owner_disabled")
+	methodBindCollisionObject2DIsShapeOwnerOneWayCollisionEnabled = gdnative.NewLazyMethodBind("CollisionObject2D", "is_shape_owner_one_way_collision_enabled")
+	methodBindCollisionObject2DRemoveShapeOwner                   = gdnative.NewLazyMethodBind("CollisionObject2D", "remove_shape_owner")
+	methodBindCollisionObject2DSetPickable                        = gdnative.NewLazyMethodBind("CollisionObject2D", "set_pickable")
+	methodBindCollisionObject2DShapeFindOwner                     = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_find_owner")
+	methodBindCollisionObject2DShapeOwnerAddShape                 = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_add_shape")
+	methodBindCollisionObject2DShapeOwnerClearShapes              = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_clear_shapes")
+	methodBindCollisionObject2DShapeOwnerGetOwner                 = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_get_owner")
+	methodBindCollisionObject2DShapeOwnerGetShape                 = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_get_shape")
+	methodBindCollisionObject2DShapeOwnerGetShapeCount            = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_get_shape_count")
+	methodBindCollisionObject2DShapeOwnerGetShapeIndex            = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_get_shape_index")
+	methodBindCollisionObject2DShapeOwnerGetTransform             = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_get_transform")
+	methodBindCollisionObject2DShapeOwnerRemoveShape              = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_remove_shape")
+	methodBindCollisionObject2DShapeOwnerSetDisabled              = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_set_disabled")
+	methodBindCollisionObject2DShapeOwnerSetOneWayCollision       = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_set_one_way_collision")
+	methodBindCollisionObject2DShapeOwnerSetTransform             = gdnative.NewLazyMethodBind("CollisionObject2D", "shape_owner_set_transform")
+)
+
 /*
-        Accepts unhandled [InputEvent]s. [code]shape_idx[/code] is the child index of the clicked [Shape2D]. Connect to the [code]input_event[/code] signal to easily pick up these events.
-	Args: [{ false viewport Object} { false event InputEvent} { false shape_idx int}], Returns: void
+	        Accepts unhandled [InputEvent]s. [code]shape_idx[/code] is the child index of the clicked [Shape2D]. Connect to the [code]input_event[/code] signal to easily pick up these events.
+		Args: [{ false viewport Object} { false event InputEvent} { false shape_idx int}], Returns: void
 */
 func (o *CollisionObject2D) X_InputEvent(viewport ObjectImplementer, event InputEventImplementer, shapeIdx gdnative.Int) {
 	//log.Println("Calling CollisionObject2D.X_InputEvent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(viewport.GetBaseObject())
-	ptrArguments[1] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(viewport))
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(event))
 	ptrArguments[2] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "_input_event")
+	methodBind := methodBindCollisionObject2DX_InputEvent.Get()
 
 	// Call the parent method.
 	// void
@@ -58,18 +75,18 @@ func (o *CollisionObject2D) X_InputEvent(viewport ObjectImplementer, event Input
 }
 
 /*
-        Creates a new shape owner for the given object. Returns [code]owner_id[/code] of the new owner for future reference.
-	Args: [{ false owner Object}], Returns: int
+	        Creates a new shape owner for the given object. Returns [code]owner_id[/code] of the new owner for future reference.
+		Args: [{ false owner Object}], Returns: int
 */
 func (o *CollisionObject2D) CreateShapeOwner(owner ObjectImplementer) gdnative.Int {
 	//log.Println("Calling CollisionObject2D.CreateShapeOwner()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(owner.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(owner))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "create_shape_owner")
+	methodBind := methodBindCollisionObject2DCreateShapeOwner.Get()
 
 	// Call the parent method.
 	// int
@@ -82,8 +99,8 @@ func (o *CollisionObject2D) CreateShapeOwner(owner ObjectImplementer) gdnative.I
 }
 
 /*
-        Returns the object's [RID].
-	Args: [], Returns: RID
+	        Returns the object's [RID].
+		Args: [], Returns: RID
 */
 func (o *CollisionObject2D) GetRid() gdnative.Rid {
 	//log.Println("Calling CollisionObject2D.GetRid()")
@@ -92,7 +109,7 @@ func (o *CollisionObject2D) GetRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "get_rid")
+	methodBind := methodBindCollisionObject2DGetRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -105,8 +122,8 @@ func (o *CollisionObject2D) GetRid() gdnative.Rid {
 }
 
 /*
-        Returns an [Array] of [code]owner_id[/code] identifiers. You can use these ids in other methods that take [code]owner_id[/code] as an argument.
-	Args: [], Returns: Array
+	        Returns an [Array] of [code]owner_id[/code] identifiers. You can use these ids in other methods that take [code]owner_id[/code] as an argument.
+		Args: [], Returns: Array
 */
 func (o *CollisionObject2D) GetShapeOwners() gdnative.Array {
 	//log.Println("Calling CollisionObject2D.GetShapeOwners()")
@@ -115,7 +132,7 @@ func (o *CollisionObject2D) GetShapeOwners() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "get_shape_owners")
+	methodBind := methodBindCollisionObject2DGetShapeOwners.Get()
 
 	// Call the parent method.
 	// Array
@@ -128,8 +145,8 @@ func (o *CollisionObject2D) GetShapeOwners() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionObject2D) IsPickable() gdnative.Bool {
 	//log.Println("Calling CollisionObject2D.IsPickable()")
@@ -138,7 +155,7 @@ func (o *CollisionObject2D) IsPickable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "is_pickable")
+	methodBind := methodBindCollisionObject2DIsPickable.Get()
 
 	// Call the parent method.
 	// bool
@@ -151,8 +168,8 @@ func (o *CollisionObject2D) IsPickable() gdnative.Bool {
 }
 
 /*
-        If [code]true[/code] the shape owner and its shapes are disabled.
-	Args: [{ false owner_id int}], Returns: bool
+	        If [code]true[/code] the shape owner and its shapes are disabled.
+		Args: [{ false owner_id int}], Returns: bool
 */
 func (o *CollisionObject2D) IsShapeOwnerDisabled(ownerId gdnative.Int) gdnative.Bool {
 	//log.Println("Calling CollisionObject2D.IsShapeOwnerDisabled()")
@@ -162,7 +179,7 @@ func (o *CollisionObject2D) IsShapeOwnerDisabled(ownerId gdnative.Int) gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "is_shape_owner_disabled")
+	methodBind := methodBindCollisionObject2DIsShapeOwnerDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -175,8 +192,8 @@ func (o *CollisionObject2D) IsShapeOwnerDisabled(ownerId gdnative.Int) gdnative.
 }
 
 /*
-        Returns [code]true[/code] if collisions for the shape owner originating from this [code]CollisionObject2D[/code] will not be reported to collided with [code]CollisionObject2D[/code]s.
-	Args: [{ false owner_id int}], Returns: bool
+	        Returns [code]true[/code] if collisions for the shape owner originating from this [code]CollisionObject2D[/code] will not be reported to collided with [code]CollisionObject2D[/code]s.
+		Args: [{ false owner_id int}], Returns: bool
 */
 func (o *CollisionObject2D) IsShapeOwnerOneWayCollisionEnabled(ownerId gdnative.Int) gdnative.Bool {
 	//log.Println("Calling CollisionObject2D.IsShapeOwnerOneWayCollisionEnabled()")
@@ -186,7 +203,7 @@ func (o *CollisionObject2D) IsShapeOwnerOneWayCollisionEnabled(ownerId gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "is_shape_owner_one_way_collision_enabled")
+	methodBind := methodBindCollisionObject2DIsShapeOwnerOneWayCollisionEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -199,8 +216,8 @@ func (o *CollisionObject2D) IsShapeOwnerOneWayCollisionEnabled(ownerId gdnative.
 }
 
 /*
-        Removes the given shape owner.
-	Args: [{ false owner_id int}], Returns: void
+	        Removes the given shape owner.
+		Args: [{ false owner_id int}], Returns: void
 */
 func (o *CollisionObject2D) RemoveShapeOwner(ownerId gdnative.Int) {
 	//log.Println("Calling CollisionObject2D.RemoveShapeOwner()")
@@ -210,7 +227,7 @@ func (o *CollisionObject2D) RemoveShapeOwner(ownerId gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "remove_shape_owner")
+	methodBind := methodBindCollisionObject2DRemoveShapeOwner.Get()
 
 	// Call the parent method.
 	// void
@@ -220,8 +237,8 @@ func (o *CollisionObject2D) RemoveShapeOwner(ownerId gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *CollisionObject2D) SetPickable(enabled gdnative.Bool) {
 	//log.Println("Calling CollisionObject2D.SetPickable()")
@@ -231,7 +248,7 @@ func (o *CollisionObject2D) SetPickable(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "set_pickable")
+	methodBind := methodBindCollisionObject2DSetPickable.Get()
 
 	// Call the parent method.
 	// void
@@ -241,8 +258,8 @@ func (o *CollisionObject2D) SetPickable(enabled gdnative.Bool) {
 }
 
 /*
-        Returns the [code]owner_id[/code] of the given shape.
-	Args: [{ false shape_index int}], Returns: int
+	        Returns the [code]owner_id[/code] of the given shape.
+		Args: [{ false shape_index int}], Returns: int
 */
 func (o *CollisionObject2D) ShapeFindOwner(shapeIndex gdnative.Int) gdnative.Int {
 	//log.Println("Calling CollisionObject2D.ShapeFindOwner()")
@@ -252,7 +269,7 @@ func (o *CollisionObject2D) ShapeFindOwner(shapeIndex gdnative.Int) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromInt(shapeIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_find_owner")
+	methodBind := methodBindCollisionObject2DShapeFindOwner.Get()
 
 	// Call the parent method.
 	// int
@@ -265,8 +282,8 @@ func (o *CollisionObject2D) ShapeFindOwner(shapeIndex gdnative.Int) gdnative.Int
 }
 
 /*
-        Adds a [Shape2D] to the shape owner.
-	Args: [{ false owner_id int} { false shape Shape2D}], Returns: void
+	        Adds a [Shape2D] to the shape owner.
+		Args: [{ false owner_id int} { false shape Shape2D}], Returns: void
 */
 func (o *CollisionObject2D) ShapeOwnerAddShape(ownerId gdnative.Int, shape Shape2DImplementer) {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerAddShape()")
@@ -274,10 +291,10 @@ func (o *CollisionObject2D) ShapeOwnerAddShape(ownerId gdnative.Int, shape Shape
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
-	ptrArguments[1] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_add_shape")
+	methodBind := methodBindCollisionObject2DShapeOwnerAddShape.Get()
 
 	// Call the parent method.
 	// void
@@ -287,8 +304,8 @@ func (o *CollisionObject2D) ShapeOwnerAddShape(ownerId gdnative.Int, shape Shape
 }
 
 /*
-        Removes all shapes from the shape owner.
-	Args: [{ false owner_id int}], Returns: void
+	        Removes all shapes from the shape owner.
+		Args: [{ false owner_id int}], Returns: void
 */
 func (o *CollisionObject2D) ShapeOwnerClearShapes(ownerId gdnative.Int) {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerClearShapes()")
@@ -298,7 +315,7 @@ func (o *CollisionObject2D) ShapeOwnerClearShapes(ownerId gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_clear_shapes")
+	methodBind := methodBindCollisionObject2DShapeOwnerClearShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -308,8 +325,8 @@ func (o *CollisionObject2D) ShapeOwnerClearShapes(ownerId gdnative.Int) {
 }
 
 /*
-        Returns the parent object of the given shape owner.
-	Args: [{ false owner_id int}], Returns: Object
+	        Returns the parent object of the given shape owner.
+		Args: [{ false owner_id int}], Returns: Object
 */
 func (o *CollisionObject2D) ShapeOwnerGetOwner(ownerId gdnative.Int) ObjectImplementer {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerGetOwner()")
@@ -319,7 +336,7 @@ func (o *CollisionObject2D) ShapeOwnerGetOwner(ownerId gdnative.Int) ObjectImple
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_get_owner")
+	methodBind := methodBindCollisionObject2DShapeOwnerGetOwner.Get()
 
 	// Call the parent method.
 	// Object
@@ -327,27 +344,25 @@ func (o *CollisionObject2D) ShapeOwnerGetOwner(ownerId gdnative.Int) ObjectImple
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the [Shape2D] with the given id from the given shape owner.
-	Args: [{ false owner_id int} { false shape_id int}], Returns: Shape2D
+	        Returns the [Shape2D] with the given id from the given shape owner.
+		Args: [{ false owner_id int} { false shape_id int}], Returns: Shape2D
 */
 func (o *CollisionObject2D) ShapeOwnerGetShape(ownerId gdnative.Int, shapeId gdnative.Int) Shape2DImplementer {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerGetShape()")
@@ -358,7 +373,7 @@ func (o *CollisionObject2D) ShapeOwnerGetShape(ownerId gdnative.Int, shapeId gdn
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_get_shape")
+	methodBind := methodBindCollisionObject2DShapeOwnerGetShape.Get()
 
 	// Call the parent method.
 	// Shape2D
@@ -366,27 +381,25 @@ func (o *CollisionObject2D) ShapeOwnerGetShape(ownerId gdnative.Int, shapeId gdn
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShape2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(Shape2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shape2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(Shape2DImplementer)
+	ret := Shape2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(Shape2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of shapes the given shape owner contains.
-	Args: [{ false owner_id int}], Returns: int
+	        Returns the number of shapes the given shape owner contains.
+		Args: [{ false owner_id int}], Returns: int
 */
 func (o *CollisionObject2D) ShapeOwnerGetShapeCount(ownerId gdnative.Int) gdnative.Int {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerGetShapeCount()")
@@ -396,7 +409,7 @@ func (o *CollisionObject2D) ShapeOwnerGetShapeCount(ownerId gdnative.Int) gdnati
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_get_shape_count")
+	methodBind := methodBindCollisionObject2DShapeOwnerGetShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -409,8 +422,8 @@ func (o *CollisionObject2D) ShapeOwnerGetShapeCount(ownerId gdnative.Int) gdnati
 }
 
 /*
-        Returns the child index of the [Shape2D] with the given id from the given shape owner.
-	Args: [{ false owner_id int} { false shape_id int}], Returns: int
+	        Returns the child index of the [Shape2D] with the given id from the given shape owner.
+		Args: [{ false owner_id int} { false shape_id int}], Returns: int
 */
 func (o *CollisionObject2D) ShapeOwnerGetShapeIndex(ownerId gdnative.Int, shapeId gdnative.Int) gdnative.Int {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerGetShapeIndex()")
@@ -421,7 +434,7 @@ func (o *CollisionObject2D) ShapeOwnerGetShapeIndex(ownerId gdnative.Int, shapeI
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_get_shape_index")
+	methodBind := methodBindCollisionObject2DShapeOwnerGetShapeIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -434,8 +447,8 @@ func (o *CollisionObject2D) ShapeOwnerGetShapeIndex(ownerId gdnative.Int, shapeI
 }
 
 /*
-        Returns the shape owner's [Transform2D].
-	Args: [{ false owner_id int}], Returns: Transform2D
+	        Returns the shape owner's [Transform2D].
+		Args: [{ false owner_id int}], Returns: Transform2D
 */
 func (o *CollisionObject2D) ShapeOwnerGetTransform(ownerId gdnative.Int) gdnative.Transform2D {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerGetTransform()")
@@ -445,7 +458,7 @@ func (o *CollisionObject2D) ShapeOwnerGetTransform(ownerId gdnative.Int) gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromInt(ownerId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_get_transform")
+	methodBind := methodBindCollisionObject2DShapeOwnerGetTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -458,8 +471,8 @@ func (o *CollisionObject2D) ShapeOwnerGetTransform(ownerId gdnative.Int) gdnativ
 }
 
 /*
-        Removes a shape from the given shape owner.
-	Args: [{ false owner_id int} { false shape_id int}], Returns: void
+	        Removes a shape from the given shape owner.
+		Args: [{ false owner_id int} { false shape_id int}], Returns: void
 */
 func (o *CollisionObject2D) ShapeOwnerRemoveShape(ownerId gdnative.Int, shapeId gdnative.Int) {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerRemoveShape()")
@@ -470,7 +483,7 @@ func (o *CollisionObject2D) ShapeOwnerRemoveShape(ownerId gdnative.Int, shapeId
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_remove_shape")
+	methodBind := methodBindCollisionObject2DShapeOwnerRemoveShape.Get()
 
 	// Call the parent method.
 	// void
@@ -480,8 +493,8 @@ func (o *CollisionObject2D) ShapeOwnerRemoveShape(ownerId gdnative.Int, shapeId
 }
 
 /*
-        If [code]true[/code] disables the given shape owner.
-	Args: [{ false owner_id int} { false disabled bool}], Returns: void
+	        If [code]true[/code] disables the given shape owner.
+		Args: [{ false owner_id int} { false disabled bool}], Returns: void
 */
 func (o *CollisionObject2D) ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled gdnative.Bool) {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerSetDisabled()")
@@ -492,7 +505,7 @@ func (o *CollisionObject2D) ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled
 	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_set_disabled")
+	methodBind := methodBindCollisionObject2DShapeOwnerSetDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -502,8 +515,8 @@ func (o *CollisionObject2D) ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled
 }
 
 /*
-        If [code]enable[/code] is [code]true[/code], collisions for the shape owner originating from this [code]CollisionObject2D[/code] will not be reported to collided with [code]CollisionObject2D[/code]s.
-	Args: [{ false owner_id int} { false enable bool}], Returns: void
+	        If [code]enable[/code] is [code]true[/code], collisions for the shape owner originating from this [code]CollisionObject2D[/code] will not be reported to collided with [code]CollisionObject2D[/code]s.
+		Args: [{ false owner_id int} { false enable bool}], Returns: void
 */
 func (o *CollisionObject2D) ShapeOwnerSetOneWayCollision(ownerId gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerSetOneWayCollision()")
@@ -514,7 +527,7 @@ func (o *CollisionObject2D) ShapeOwnerSetOneWayCollision(ownerId gdnative.Int, e
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_set_one_way_collision")
+	methodBind := methodBindCollisionObject2DShapeOwnerSetOneWayCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -524,8 +537,8 @@ func (o *CollisionObject2D) ShapeOwnerSetOneWayCollision(ownerId gdnative.Int, e
 }
 
 /*
-        Sets the [Transform2D] of the given shape owner.
-	Args: [{ false owner_id int} { false transform Transform2D}], Returns: void
+	        Sets the [Transform2D] of the given shape owner.
+		Args: [{ false owner_id int} { false transform Transform2D}], Returns: void
 */
 func (o *CollisionObject2D) ShapeOwnerSetTransform(ownerId gdnative.Int, transform gdnative.Transform2D) {
 	//log.Println("Calling CollisionObject2D.ShapeOwnerSetTransform()")
@@ -536,7 +549,7 @@ func (o *CollisionObject2D) ShapeOwnerSetTransform(ownerId gdnative.Int, transfo
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionObject2D", "shape_owner_set_transform")
+	methodBind := methodBindCollisionObject2DShapeOwnerSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -545,6 +558,84 @@ func (o *CollisionObject2D) ShapeOwnerSetTransform(ownerId gdnative.Int, transfo
 
 }
 
+// InputPickable will return the value of the "input_pickable" property.
+func (o *CollisionObject2D) InputPickable() gdnative.Bool {
+	return o.IsPickable()
+}
+
+// SetInputPickable will set the value of the "input_pickable" property.
+func (o *CollisionObject2D) SetInputPickable(value gdnative.Bool) {
+	o.SetPickable(value)
+}
+
+// Signals of the CollisionObject2D class.
+const (
+	CollisionObject2DSignalInputEvent   gdnative.String = "input_event"
+	CollisionObject2DSignalMouseEntered gdnative.String = "mouse_entered"
+	CollisionObject2DSignalMouseExited  gdnative.String = "mouse_exited"
+)
+
+// CollisionObject2DInputEventArgs holds the arguments of the "input_event" signal.
+type CollisionObject2DInputEventArgs struct {
+	Viewport ObjectImplementer
+	Event    InputEventImplementer
+	ShapeIdx gdnative.Int
+}
+
+// ConnectInputEvent will connect the "input_event" signal to the given method of the target object.
+func (o *CollisionObject2D) ConnectInputEvent(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CollisionObject2DSignalInputEvent, target, method, binds, 0)
+}
+
+// EmitInputEvent will emit the "input_event" signal.
+func (o *CollisionObject2D) EmitInputEvent(args CollisionObject2DInputEventArgs) error {
+	arg0 := gdnative.NewVariantObject(GetBaseObject(args.Viewport))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(GetBaseObject(args.Event))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.ShapeIdx))
+	defer arg2.Destroy()
+
+	ret, err := o.EmitSignal(CollisionObject2DSignalInputEvent, arg0, arg1, arg2)
+	ret.Destroy()
+	return err
+}
+
+// ConnectMouseEntered will connect the "mouse_entered" signal to the given method of the target object.
+func (o *CollisionObject2D) ConnectMouseEntered(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CollisionObject2DSignalMouseEntered, target, method, binds, 0)
+}
+
+// EmitMouseEntered will emit the "mouse_entered" signal.
+func (o *CollisionObject2D) EmitMouseEntered() error {
+
+	ret, err := o.EmitSignal(CollisionObject2DSignalMouseEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectMouseExited will connect the "mouse_exited" signal to the given method of the target object.
+func (o *CollisionObject2D) ConnectMouseExited(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CollisionObject2DSignalMouseExited, target, method, binds, 0)
+}
+
+// EmitMouseExited will emit the "mouse_exited" signal.
+func (o *CollisionObject2D) EmitMouseExited() error {
+
+	ret, err := o.EmitSignal(CollisionObject2DSignalMouseExited)
+	ret.Destroy()
+	return err
+}
+
 // CollisionObject2DImplementer is an interface that implements the methods
 // of the CollisionObject2D class.
 type CollisionObject2DImplementer interface {
@@ -570,4 +661,12 @@ type CollisionObject2DImplementer interface {
 	ShapeOwnerSetDisabled(ownerId gdnative.Int, disabled gdnative.Bool)
 	ShapeOwnerSetOneWayCollision(ownerId gdnative.Int, enable gdnative.Bool)
 	ShapeOwnerSetTransform(ownerId gdnative.Int, transform gdnative.Transform2D)
+	InputPickable() gdnative.Bool
+	SetInputPickable(value gdnative.Bool)
+	ConnectInputEvent(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitInputEvent(args CollisionObject2DInputEventArgs) error
+	ConnectMouseEntered(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMouseEntered() error
+	ConnectMouseExited(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMouseExited() error
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewConfigFileFromPointer(ptr gdnative.Pointer) ConfigFile {
-func newConfigFileFromPointer(ptr gdnative.Pointer) ConfigFile {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ConfigFile{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This helper class can be used to store [Variant] values on the filesystem using INI-style formatting. The stored values are identified by a section and a key: [codeblock] [section] some_key=42 string_example="Hello World!" a_vector=Vector3( 1, 0, 2 ) [/codeblock] The stored data can be saved to or parsed from a file, though ConfigFile objects can also be used directly without accessing the filesystem. The following example shows how to parse an INI-style file from the system, read its contents and store new values in it: [codeblock] var config = ConfigFile.new() var err = config.load("user://settings.cfg") if err == OK: # if not, something went wrong with the file loading # Look for the display/width pair, and default to 1024 if missing var screen_width = get_value("display", "width", 1024) # Store a variable if and only if it hasn't been defined yet if not config.has_section_key("audio", "mute"): config.set_value("audio", "mute", false) # Save the changes by overwriting the previous file config.save("user://settings.cfg") [/codeblock]
 */
@@ -30,13 +21,44 @@ type ConfigFile struct {
 	owner gdnative.Object
 }
 
+// classConstructorConfigFile is the class constructor of the ConfigFile class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorConfigFile = gdnative.NewLazyClassConstructor("ConfigFile")
+
+// NewConfigFile will create a new instance of the ConfigFile class.
+// ConfigFile is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewConfigFile() *ConfigFile {
+	obj := &ConfigFile{}
+	obj.SetBaseObject(classConstructorConfigFile.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ConfigFile) BaseClass() string {
 	return "ConfigFile"
 }
 
+// Method binds of the ConfigFile class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindConfigFileEraseSection   = gdnative.NewLazyMethodBind("ConfigFile", "erase_section")
+	methodBindConfigFileGetSectionKeys = gdnative.NewLazyMethodBind("ConfigFile", "get_section_keys")
+	methodBindConfigFileGetSections    = gdnative.NewLazyMethodBind("ConfigFile", "get_sections")
+	methodBindConfigFileGetValue       = gdnative.NewLazyMethodBind("ConfigFile", "get_value")
+	methodBindConfigFileHasSection     = gdnative.NewLazyMethodBind("ConfigFile", "has_section")
+	methodBindConfigFileHasSectionKey  = gdnative.NewLazyMethodBind("ConfigFile", "has_section_key")
+	methodBindConfigFileLoad           = gdnative.NewLazyMethodBind("ConfigFile", "load")
+	methodBindConfigFileSave           = gdnative.NewLazyMethodBind("ConfigFile", "save")
+	methodBindConfigFileSetValue       = gdnative.NewLazyMethodBind("ConfigFile", "set_value")
+)
+
 /*
-        Deletes the specified section along with all the key-value pairs inside.
-	Args: [{ false section String}], Returns: void
+	        Deletes the specified section along with all the key-value pairs inside.
+		Args: [{ false section String}], Returns: void
 */
 func (o *ConfigFile) EraseSection(section gdnative.String) {
 	//log.Println("Calling ConfigFile.EraseSection()")
@@ -46,7 +68,7 @@ func (o *ConfigFile) EraseSection(section gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(section)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "erase_section")
+	methodBind := methodBindConfigFileEraseSection.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +78,8 @@ func (o *ConfigFile) EraseSection(section gdnative.String) {
 }
 
 /*
-        Returns an array of all defined key identifiers in the specified section.
-	Args: [{ false section String}], Returns: PoolStringArray
+	        Returns an array of all defined key identifiers in the specified section.
+		Args: [{ false section String}], Returns: PoolStringArray
 */
 func (o *ConfigFile) GetSectionKeys(section gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling ConfigFile.GetSectionKeys()")
@@ -67,7 +89,7 @@ func (o *ConfigFile) GetSectionKeys(section gdnative.String) gdnative.PoolString
 	ptrArguments[0] = gdnative.NewPointerFromString(section)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "get_section_keys")
+	methodBind := methodBindConfigFileGetSectionKeys.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -80,8 +102,8 @@ func (o *ConfigFile) GetSectionKeys(section gdnative.String) gdnative.PoolString
 }
 
 /*
-        Returns an array of all defined section identifiers.
-	Args: [], Returns: PoolStringArray
+	        Returns an array of all defined section identifiers.
+		Args: [], Returns: PoolStringArray
 */
 func (o *ConfigFile) GetSections() gdnative.PoolStringArray {
 	//log.Println("Calling ConfigFile.GetSections()")
@@ -90,7 +112,7 @@ func (o *ConfigFile) GetSections() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "get_sections")
+	methodBind := methodBindConfigFileGetSections.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -103,8 +125,8 @@ func (o *ConfigFile) GetSections() gdnative.PoolStringArray {
 }
 
 /*
-        Returns the current value for the specified section and key. If the section and/or the key do not exist, the method returns the value of the optional [code]default[/code] argument, or [code]null[/code] if it is omitted.
-	Args: [{ false section String} { false key String} {Null true default Variant}], Returns: Variant
+	        Returns the current value for the specified section and key. If the section and/or the key do not exist, the method returns the value of the optional [code]default[/code] argument, or [code]null[/code] if it is omitted.
+		Args: [{ false section String} { false key String} {Null true default Variant}], Returns: Variant
 */
 func (o *ConfigFile) GetValue(section gdnative.String, key gdnative.String, aDefault gdnative.Variant) gdnative.Variant {
 	//log.Println("Calling ConfigFile.GetValue()")
@@ -116,7 +138,7 @@ func (o *ConfigFile) GetValue(section gdnative.String, key gdnative.String, aDef
 	ptrArguments[2] = gdnative.NewPointerFromVariant(aDefault)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "get_value")
+	methodBind := methodBindConfigFileGetValue.Get()
 
 	// Call the parent method.
 	// Variant
@@ -129,8 +151,8 @@ func (o *ConfigFile) GetValue(section gdnative.String, key gdnative.String, aDef
 }
 
 /*
-        Returns [code]true[/code] if the specified section exists.
-	Args: [{ false section String}], Returns: bool
+	        Returns [code]true[/code] if the specified section exists.
+		Args: [{ false section String}], Returns: bool
 */
 func (o *ConfigFile) HasSection(section gdnative.String) gdnative.Bool {
 	//log.Println("Calling ConfigFile.HasSection()")
@@ -140,7 +162,7 @@ func (o *ConfigFile) HasSection(section gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(section)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "has_section")
+	methodBind := methodBindConfigFileHasSection.Get()
 
 	// Call the parent method.
 	// bool
@@ -153,8 +175,8 @@ func (o *ConfigFile) HasSection(section gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the specified section-key pair exists.
-	Args: [{ false section String} { false key String}], Returns: bool
+	        Returns [code]true[/code] if the specified section-key pair exists.
+		Args: [{ false section String} { false key String}], Returns: bool
 */
 func (o *ConfigFile) HasSectionKey(section gdnative.String, key gdnative.String) gdnative.Bool {
 	//log.Println("Calling ConfigFile.HasSectionKey()")
@@ -165,7 +187,7 @@ func (o *ConfigFile) HasSectionKey(section gdnative.String, key gdnative.String)
 	ptrArguments[1] = gdnative.NewPointerFromString(key)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "has_section_key")
+	methodBind := methodBindConfigFileHasSectionKey.Get()
 
 	// Call the parent method.
 	// bool
@@ -178,8 +200,8 @@ func (o *ConfigFile) HasSectionKey(section gdnative.String, key gdnative.String)
 }
 
 /*
-        Loads the config file specified as a parameter. The file's contents are parsed and loaded in the ConfigFile object which the method was called on. Returns one of the [code]OK[/code], [code]FAILED[/code] or [code]ERR_*[/code] constants listed in [@GlobalScope]. If the load was successful, the return value is [code]OK[/code].
-	Args: [{ false path String}], Returns: enum.Error
+	        Loads the config file specified as a parameter. The file's contents are parsed and loaded in the ConfigFile object which the method was called on. Returns one of the [code]OK[/code], [code]FAILED[/code] or [code]ERR_*[/code] constants listed in [@GlobalScope]. If the load was successful, the return value is [code]OK[/code].
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *ConfigFile) Load(path gdnative.String) gdnative.Error {
 	//log.Println("Calling ConfigFile.Load()")
@@ -189,7 +211,7 @@ func (o *ConfigFile) Load(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "load")
+	methodBind := methodBindConfigFileLoad.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -202,8 +224,8 @@ func (o *ConfigFile) Load(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Saves the contents of the ConfigFile object to the file specified as a parameter. The output file uses an INI-style structure. Returns one of the [code]OK[/code], [code]FAILED[/code] or [code]ERR_*[/code] constants listed in [@GlobalScope]. If the load was successful, the return value is [code]OK[/code].
-	Args: [{ false path String}], Returns: enum.Error
+	        Saves the contents of the ConfigFile object to the file specified as a parameter. The output file uses an INI-style structure. Returns one of the [code]OK[/code], [code]FAILED[/code] or [code]ERR_*[/code] constants listed in [@GlobalScope]. If the load was successful, the return value is [code]OK[/code].
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *ConfigFile) Save(path gdnative.String) gdnative.Error {
 	//log.Println("Calling ConfigFile.Save()")
@@ -213,7 +235,7 @@ func (o *ConfigFile) Save(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "save")
+	methodBind := methodBindConfigFileSave.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -226,8 +248,8 @@ func (o *ConfigFile) Save(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Assigns a value to the specified key of the the specified section. If the section and/or the key do not exist, they are created. Passing a [code]null[/code] value deletes the specified key if it exists, and deletes the section if it ends up empty once the key has been removed.
-	Args: [{ false section String} { false key String} { false value Variant}], Returns: void
+	        Assigns a value to the specified key of the the specified section. If the section and/or the key do not exist, they are created. Passing a [code]null[/code] value deletes the specified key if it exists, and deletes the section if it ends up empty once the key has been removed.
+		Args: [{ false section String} { false key String} { false value Variant}], Returns: void
 */
 func (o *ConfigFile) SetValue(section gdnative.String, key gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling ConfigFile.SetValue()")
@@ -239,7 +261,7 @@ func (o *ConfigFile) SetValue(section gdnative.String, key gdnative.String, valu
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfigFile", "set_value")
+	methodBind := methodBindConfigFileSetValue.Get()
 
 	// Call the parent method.
 	// void
@@ -248,6 +270,13 @@ func (o *ConfigFile) SetValue(section gdnative.String, key gdnative.String, valu
 
 }
 
+// GetValueWithDefaults will call GetValue using the default values for: default.
+func (o *ConfigFile) GetValueWithDefaults(section gdnative.String, key gdnative.String) gdnative.Variant {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	return o.GetValue(section, key, default0)
+}
+
 // ConfigFileImplementer is an interface that implements the methods
 // of the ConfigFile class.
 type ConfigFileImplementer interface {
@@ -258,5 +287,8 @@ type ConfigFileImplementer interface {
 	GetValue(section gdnative.String, key gdnative.String, aDefault gdnative.Variant) gdnative.Variant
 	HasSection(section gdnative.String) gdnative.Bool
 	HasSectionKey(section gdnative.String, key gdnative.String) gdnative.Bool
+	Load(path gdnative.String) gdnative.Error
+	Save(path gdnative.String) gdnative.Error
 	SetValue(section gdnative.String, key gdnative.String, value gdnative.Variant)
+	GetValueWithDefaults(section gdnative.String, key gdnative.String) gdnative.Variant
 }
@@ -0,0 +1,202 @@
+package godot
+
+import (
+	"fmt"
+	"github.com/shadowapex/godot-go/gdnative"
+	"sync"
+)
+
+// signalTrampolineClass is the name of the internal class that Go funcs are
+// connected through. Godot can only connect signals to methods of objects, so
+// every connected func gets its own trampoline object that calls it.
+const signalTrampolineClass = "GoSignalTrampoline"
+
+// signalTrampolineMethod is the method of the trampoline class that signals are
+// connected to.
+const signalTrampolineMethod = "_call"
+
+func init() {
+	RegisterClass(
+		NewClassBuilder(signalTrampolineClass, newSignalTrampoline).
+			Tool().
+			Method(signalTrampolineMethod, callSignalTrampoline),
+	)
+}
+
+// signalTrampoline is an object that calls a Go func when a signal it is
+// connected to is emitted.
+type signalTrampoline struct {
+	Object
+	fn func(args ...gdnative.Variant)
+}
+
+// newSignalTrampoline is the constructor of the trampoline class.
+func newSignalTrampoline() Class {
+	return &signalTrampoline{}
+}
+
+// callSignalTrampoline will call the Go func of the trampoline with the
+// arguments of the signal.
+func callSignalTrampoline(instance Class, args []gdnative.Variant) gdnative.Variant {
+	trampoline := instance.(*signalTrampoline)
+	if trampoline.fn != nil {
+		trampoline.fn(args...)
+	}
+	return gdnative.NewVariantNil()
+}
+
+// signalTrampolineScript is the NativeScript used to create trampoline objects.
+// It is created the first time a func is connected.
+var signalTrampolineScript *NativeScript
+
+// newSignalTrampolineObject will create a new trampoline object that calls the
+// given func.
+func newSignalTrampolineObject(fn func(args ...gdnative.Variant)) (*signalTrampoline, error) {
+	if signalTrampolineScript == nil {
+		library := &GDNativeLibrary{}
+		library.SetBaseObject(gdnative.GDNative.GetLibrary())
+
+		script := NewNativeScript()
+		script.SetLibrary(library)
+		script.SetClassName(signalTrampolineClass)
+
+		// The script keeps the reference it was created with, so it is not
+		// freed when the last trampoline object is.
+		signalTrampolineScript = script
+	}
+
+	object, err := signalTrampolineScript.New()
+	if err != nil {
+		return nil, err
+	}
+	trampoline, ok := object.(*signalTrampoline)
+	if !ok {
+		return nil, fmt.Errorf("unable to create %s object", signalTrampolineClass)
+	}
+	trampoline.fn = fn
+
+	return trampoline, nil
+}
+
+// freeSignalTrampolineScript will release the script that trampoline objects are
+// created with. It is called when the library is unloaded.
+func freeSignalTrampolineScript() {
+	if signalTrampolineScript == nil {
+		return
+	}
+	signalTrampolineScript.Release()
+	signalTrampolineScript = nil
+}
+
+// Connection is a connection of a Godot signal to a Go func that was made with
+// ConnectFunc.
+type Connection struct {
+	source     gdnative.Object
+	signal     string
+	trampoline *signalTrampoline
+	freed      chan struct{}
+	onFree     func()
+}
+
+// connectionRegistry holds all connections made with ConnectFunc, keyed by the
+// ID of the source object, so they can be cleaned up when the object is freed.
+var connectionRegistry = struct {
+	sync.Mutex
+	connections map[string][]*Connection
+}{connections: map[string][]*Connection{}}
+
+// ConnectFunc will connect the given signal of the object to the given Go func.
+// The func is called with the arguments of the signal every time it is emitted.
+// Use the returned Connection to disconnect it again. If the engine supports
+// NativeScript 1.1, the connection is also cleaned up when the object is freed.
+func ConnectFunc(object Class, signal string, fn func(args ...gdnative.Variant)) (*Connection, error) {
+	if object == nil || object.GetBaseObject().IsNil() {
+		return nil, ErrNilObject
+	}
+	trampoline, err := newSignalTrampolineObject(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	source := Object{owner: object.GetBaseObject()}
+	binds := gdnative.NewArray()
+	ret := source.Connect(gdnative.String(signal), trampoline, signalTrampolineMethod, binds, 0)
+	binds.Destroy()
+	if ret != gdnative.Ok {
+		// Object.Free can't be used here, since "free" has no method bind.
+		destroyObject(trampoline.GetBaseObject())
+		return nil, fmt.Errorf("unable to connect signal %s: error %d", signal, ret)
+	}
+
+	// Make sure the source object has instance binding data, so we are told
+	// when it is freed.
+	gdnative.NativeScript.GetInstanceBindingData(bindingIndex, source.owner)
+
+	connection := &Connection{
+		source:     source.owner,
+		signal:     signal,
+		trampoline: trampoline,
+		freed:      make(chan struct{}),
+	}
+	connectionRegistry.Lock()
+	defer connectionRegistry.Unlock()
+	id := source.owner.ID()
+	connectionRegistry.connections[id] = append(connectionRegistry.connections[id], connection)
+
+	return connection, nil
+}
+
+// Disconnect will disconnect the signal from the Go func. It is safe to call
+// Disconnect from inside the connected func, and to call it more than once. If
+// the engine does not support NativeScript 1.1, Disconnect must not be called
+// after the object has been freed.
+func (c *Connection) Disconnect() {
+	connectionRegistry.Lock()
+	id := c.source.ID()
+	connections := connectionRegistry.connections[id]
+	found := false
+	for i, connection := range connections {
+		if connection == c {
+			connections = append(connections[:i], connections[i+1:]...)
+			found = true
+			break
+		}
+	}
+	if len(connections) == 0 {
+		delete(connectionRegistry.connections, id)
+	} else {
+		connectionRegistry.connections[id] = connections
+	}
+	connectionRegistry.Unlock()
+	if !found {
+		return
+	}
+
+	source := Object{owner: c.source}
+	source.Disconnect(gdnative.String(c.signal), c.trampoline, signalTrampolineMethod)
+	c.free()
+}
+
+// free will free the trampoline object of the connection. It is freed at the
+// end of the frame, since the signal may still be emitting.
+func (c *Connection) free() {
+	c.trampoline.fn = nil
+	callDeferred(c.trampoline, "free")
+	close(c.freed)
+	if c.onFree != nil {
+		c.onFree()
+	}
+}
+
+// freeConnections will free all connections of the object with the given ID. It
+// is called when the object is freed, which also removes its connections.
+func freeConnections(id string) {
+	connectionRegistry.Lock()
+	connections := connectionRegistry.connections[id]
+	delete(connectionRegistry.connections, id)
+	connectionRegistry.Unlock()
+
+	for _, connection := range connections {
+		connection.free()
+	}
+}
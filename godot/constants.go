@@ -0,0 +1,48 @@
+package godot
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+// CheckGlobalConstants will compare the generated global constants with the
+// global constants reported by Godot. It returns a description of every
+// constant that is missing or has a different value, which happens when the
+// bindings were generated from a different version of the Godot API.
+func CheckGlobalConstants() []string {
+	constants := gdnative.GetGlobalConstants()
+	defer constants.Destroy()
+
+	mismatches := []string{}
+	for name, value := range GlobalConstantsLookupMap {
+		key := gdnative.NewVariantString(gdnative.String(name))
+		if !constants.Has(key) {
+			mismatches = append(mismatches, fmt.Sprintf("%s is not defined by Godot", name))
+			key.Destroy()
+			continue
+		}
+		actual := constants.Get(key)
+		if int64(actual.AsInt()) != value {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %d, but Godot defines it as %d", name, value, actual.AsInt()))
+		}
+		actual.Destroy()
+		key.Destroy()
+	}
+	sort.Strings(mismatches)
+
+	return mismatches
+}
+
+// checkGlobalConstants will log any global constants that don't match Godot's
+// values when debug logging is enabled.
+func checkGlobalConstants() {
+	if !debug {
+		return
+	}
+	for _, mismatch := range CheckGlobalConstants() {
+		log.Println("Global constant mismatch:", mismatch)
+	}
+}
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -21,6 +23,28 @@ const (
 	ControlAnchorEnd   ControlAnchor = 1
 )
 
+// String will return the Godot name of the ControlAnchor value.
+func (e ControlAnchor) String() string {
+	switch e {
+	case ControlAnchorBegin:
+		return "ANCHOR_BEGIN"
+	case ControlAnchorEnd:
+		return "ANCHOR_END"
+	}
+	return fmt.Sprintf("ControlAnchor(%d)", int(e))
+}
+
+// ParseControlAnchor will return the ControlAnchor value with the given Godot name.
+func ParseControlAnchor(name string) (ControlAnchor, error) {
+	switch name {
+	case "ANCHOR_BEGIN":
+		return ControlAnchorBegin, nil
+	case "ANCHOR_END":
+		return ControlAnchorEnd, nil
+	}
+	return 0, fmt.Errorf("invalid ControlAnchor value %q", name)
+}
+
 // ControlCursorShape is an enum for CursorShape values.
 type ControlCursorShape int
 
@@ -44,6 +68,88 @@ const (
 	ControlCursorWait         ControlCursorShape = 4
 )
 
+// String will return the Godot name of the ControlCursorShape value.
+func (e ControlCursorShape) String() string {
+	switch e {
+	case ControlCursorArrow:
+		return "CURSOR_ARROW"
+	case ControlCursorBdiagsize:
+		return "CURSOR_BDIAGSIZE"
+	case ControlCursorBusy:
+		return "CURSOR_BUSY"
+	case ControlCursorCanDrop:
+		return "CURSOR_CAN_DROP"
+	case ControlCursorCross:
+		return "CURSOR_CROSS"
+	case ControlCursorDrag:
+		return "CURSOR_DRAG"
+	case ControlCursorFdiagsize:
+		return "CURSOR_FDIAGSIZE"
+	case ControlCursorForbidden:
+		return "CURSOR_FORBIDDEN"
+	case ControlCursorHelp:
+		return "CURSOR_HELP"
+	case ControlCursorHsize:
+		return "CURSOR_HSIZE"
+	case ControlCursorHsplit:
+		return "CURSOR_HSPLIT"
+	case ControlCursorIbeam:
+		return "CURSOR_IBEAM"
+	case ControlCursorMove:
+		return "CURSOR_MOVE"
+	case ControlCursorPointingHand:
+		return "CURSOR_POINTING_HAND"
+	case ControlCursorVsize:
+		return "CURSOR_VSIZE"
+	case ControlCursorVsplit:
+		return "CURSOR_VSPLIT"
+	case ControlCursorWait:
+		return "CURSOR_WAIT"
+	}
+	return fmt.Sprintf("ControlCursorShape(%d)", int(e))
+}
+
+// ParseControlCursorShape will return the ControlCursorShape value with the given Godot name.
+func ParseControlCursorShape(name string) (ControlCursorShape, error) {
+	switch name {
+	case "CURSOR_ARROW":
+		return ControlCursorArrow, nil
+	case "CURSOR_BDIAGSIZE":
+		return ControlCursorBdiagsize, nil
+	case "CURSOR_BUSY":
+		return ControlCursorBusy, nil
+	case "CURSOR_CAN_DROP":
+		return ControlCursorCanDrop, nil
+	case "CURSOR_CROSS":
+		return ControlCursorCross, nil
+	case "CURSOR_DRAG":
+		return ControlCursorDrag, nil
+	case "CURSOR_FDIAGSIZE":
+		return ControlCursorFdiagsize, nil
+	case "CURSOR_FORBIDDEN":
+		return ControlCursorForbidden, nil
+	case "CURSOR_HELP":
+		return ControlCursorHelp, nil
+	case "CURSOR_HSIZE":
+		return ControlCursorHsize, nil
+	case "CURSOR_HSPLIT":
+		return ControlCursorHsplit, nil
+	case "CURSOR_IBEAM":
+		return ControlCursorIbeam, nil
+	case "CURSOR_MOVE":
+		return ControlCursorMove, nil
+	case "CURSOR_POINTING_HAND":
+		return ControlCursorPointingHand, nil
+	case "CURSOR_VSIZE":
+		return ControlCursorVsize, nil
+	case "CURSOR_VSPLIT":
+		return ControlCursorVsplit, nil
+	case "CURSOR_WAIT":
+		return ControlCursorWait, nil
+	}
+	return 0, fmt.Errorf("invalid ControlCursorShape value %q", name)
+}
+
 // ControlFocusMode is an enum for FocusMode values.
 type ControlFocusMode int
 
@@ -53,6 +159,32 @@ const (
 	ControlFocusNone  ControlFocusMode = 0
 )
 
+// String will return the Godot name of the ControlFocusMode value.
+func (e ControlFocusMode) String() string {
+	switch e {
+	case ControlFocusAll:
+		return "FOCUS_ALL"
+	case ControlFocusClick:
+		return "FOCUS_CLICK"
+	case ControlFocusNone:
+		return "FOCUS_NONE"
+	}
+	return fmt.Sprintf("ControlFocusMode(%d)", int(e))
+}
+
+// ParseControlFocusMode will return the ControlFocusMode value with the given Godot name.
+func ParseControlFocusMode(name string) (ControlFocusMode, error) {
+	switch name {
+	case "FOCUS_ALL":
+		return ControlFocusAll, nil
+	case "FOCUS_CLICK":
+		return ControlFocusClick, nil
+	case "FOCUS_NONE":
+		return ControlFocusNone, nil
+	}
+	return 0, fmt.Errorf("invalid ControlFocusMode value %q", name)
+}
+
 // ControlGrowDirection is an enum for GrowDirection values.
 type ControlGrowDirection int
 
@@ -61,6 +193,28 @@ const (
 	ControlGrowDirectionEnd   ControlGrowDirection = 1
 )
 
+// String will return the Godot name of the ControlGrowDirection value.
+func (e ControlGrowDirection) String() string {
+	switch e {
+	case ControlGrowDirectionBegin:
+		return "GROW_DIRECTION_BEGIN"
+	case ControlGrowDirectionEnd:
+		return "GROW_DIRECTION_END"
+	}
+	return fmt.Sprintf("ControlGrowDirection(%d)", int(e))
+}
+
+// ParseControlGrowDirection will return the ControlGrowDirection value with the given Godot name.
+func ParseControlGrowDirection(name string) (ControlGrowDirection, error) {
+	switch name {
+	case "GROW_DIRECTION_BEGIN":
+		return ControlGrowDirectionBegin, nil
+	case "GROW_DIRECTION_END":
+		return ControlGrowDirectionEnd, nil
+	}
+	return 0, fmt.Errorf("invalid ControlGrowDirection value %q", name)
+}
+
 // ControlLayoutPreset is an enum for LayoutPreset values.
 type ControlLayoutPreset int
 
@@ -83,6 +237,84 @@ const (
 	ControlPresetWide         ControlLayoutPreset = 15
 )
 
+// String will return the Godot name of the ControlLayoutPreset value.
+func (e ControlLayoutPreset) String() string {
+	switch e {
+	case ControlPresetBottomLeft:
+		return "PRESET_BOTTOM_LEFT"
+	case ControlPresetBottomRight:
+		return "PRESET_BOTTOM_RIGHT"
+	case ControlPresetBottomWide:
+		return "PRESET_BOTTOM_WIDE"
+	case ControlPresetCenter:
+		return "PRESET_CENTER"
+	case ControlPresetCenterBottom:
+		return "PRESET_CENTER_BOTTOM"
+	case ControlPresetCenterLeft:
+		return "PRESET_CENTER_LEFT"
+	case ControlPresetCenterRight:
+		return "PRESET_CENTER_RIGHT"
+	case ControlPresetCenterTop:
+		return "PRESET_CENTER_TOP"
+	case ControlPresetHcenterWide:
+		return "PRESET_HCENTER_WIDE"
+	case ControlPresetLeftWide:
+		return "PRESET_LEFT_WIDE"
+	case ControlPresetRightWide:
+		return "PRESET_RIGHT_WIDE"
+	case ControlPresetTopLeft:
+		return "PRESET_TOP_LEFT"
+	case ControlPresetTopRight:
+		return "PRESET_TOP_RIGHT"
+	case ControlPresetTopWide:
+		return "PRESET_TOP_WIDE"
+	case ControlPresetVcenterWide:
+		return "PRESET_VCENTER_WIDE"
+	case ControlPresetWide:
+		return "PRESET_WIDE"
+	}
+	return fmt.Sprintf("ControlLayoutPreset(%d)", int(e))
+}
+
+// ParseControlLayoutPreset will return the ControlLayoutPreset value with the given Godot name.
+func ParseControlLayoutPreset(name string) (ControlLayoutPreset, error) {
+	switch name {
+	case "PRESET_BOTTOM_LEFT":
+		return ControlPresetBottomLeft, nil
+	case "PRESET_BOTTOM_RIGHT":
+		return ControlPresetBottomRight, nil
+	case "PRESET_BOTTOM_WIDE":
+		return ControlPresetBottomWide, nil
+	case "PRESET_CENTER":
+		return ControlPresetCenter, nil
+	case "PRESET_CENTER_BOTTOM":
+		return ControlPresetCenterBottom, nil
+	case "PRESET_CENTER_LEFT":
+		return ControlPresetCenterLeft, nil
+	case "PRESET_CENTER_RIGHT":
+		return ControlPresetCenterRight, nil
+	case "PRESET_CENTER_TOP":
+		return ControlPresetCenterTop, nil
+	case "PRESET_HCENTER_WIDE":
+		return ControlPresetHcenterWide, nil
+	case "PRESET_LEFT_WIDE":
+		return ControlPresetLeftWide, nil
+	case "PRESET_RIGHT_WIDE":
+		return ControlPresetRightWide, nil
+	case "PRESET_TOP_LEFT":
+		return ControlPresetTopLeft, nil
+	case "PRESET_TOP_RIGHT":
+		return ControlPresetTopRight, nil
+	case "PRESET_TOP_WIDE":
+		return ControlPresetTopWide, nil
+	case "PRESET_VCENTER_WIDE":
+		return ControlPresetVcenterWide, nil
+	case "PRESET_WIDE":
+		return ControlPresetWide, nil
+	}
+	return 0, fmt.Errorf("invalid ControlLayoutPreset value %q", name)
+}
+
 // ControlLayoutPresetMode is an enum for LayoutPresetMode values.
 type ControlLayoutPresetMode int
 
@@ -93,6 +325,36 @@ const (
 	ControlPresetModeMinsize    ControlLayoutPresetMode = 0
 )
 
+// String will return the Godot name of the ControlLayoutPresetMode value.
+func (e ControlLayoutPresetMode) String() string {
+	switch e {
+	case ControlPresetModeKeepHeight:
+		return "PRESET_MODE_KEEP_HEIGHT"
+	case ControlPresetModeKeepSize:
+		return "PRESET_MODE_KEEP_SIZE"
+	case ControlPresetModeKeepWidth:
+		return "PRESET_MODE_KEEP_WIDTH"
+	case ControlPresetModeMinsize:
+		return "PRESET_MODE_MINSIZE"
+	}
+	return fmt.Sprintf("ControlLayoutPresetMode(%d)", int(e))
+}
+
+// ParseControlLayoutPresetMode will return the ControlLayoutPresetMode value with the given Godot name.
+func ParseControlLayoutPresetMode(name string) (ControlLayoutPresetMode, error) {
+	switch name {
+	case "PRESET_MODE_KEEP_HEIGHT":
+		return ControlPresetModeKeepHeight, nil
+	case "PRESET_MODE_KEEP_SIZE":
+		return ControlPresetModeKeepSize, nil
+	case "PRESET_MODE_KEEP_WIDTH":
+		return ControlPresetModeKeepWidth, nil
+	case "PRESET_MODE_MINSIZE":
+		return ControlPresetModeMinsize, nil
+	}
+	return 0, fmt.Errorf("invalid ControlLayoutPresetMode value %q", name)
+}
+
 // ControlMouseFilter is an enum for MouseFilter values.
 type ControlMouseFilter int
 
@@ -102,6 +364,32 @@ const (
 	ControlMouseFilterStop   ControlMouseFilter = 0
 )
 
+// String will return the Godot name of the ControlMouseFilter value.
+func (e ControlMouseFilter) String() string {
+	switch e {
+	case ControlMouseFilterIgnore:
+		return "MOUSE_FILTER_IGNORE"
+	case ControlMouseFilterPass:
+		return "MOUSE_FILTER_PASS"
+	case ControlMouseFilterStop:
+		return "MOUSE_FILTER_STOP"
+	}
+	return fmt.Sprintf("ControlMouseFilter(%d)", int(e))
+}
+
+// ParseControlMouseFilter will return the ControlMouseFilter value with the given Godot name.
+func ParseControlMouseFilter(name string) (ControlMouseFilter, error) {
+	switch name {
+	case "MOUSE_FILTER_IGNORE":
+		return ControlMouseFilterIgnore, nil
+	case "MOUSE_FILTER_PASS":
+		return ControlMouseFilterPass, nil
+	case "MOUSE_FILTER_STOP":
+		return ControlMouseFilterStop, nil
+	}
+	return 0, fmt.Errorf("invalid ControlMouseFilter value %q", name)
+}
+
 // ControlSizeFlags is an enum for SizeFlags values.
 type ControlSizeFlags int
 
@@ -113,15 +401,51 @@ const (
 	ControlSizeShrinkEnd    ControlSizeFlags = 8
 )
 
-//func NewControlFromPointer(ptr gdnative.Pointer) Control {
-func newControlFromPointer(ptr gdnative.Pointer) Control {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Control{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the ControlSizeFlags value.
+func (e ControlSizeFlags) String() string {
+	switch e {
+	case ControlSizeExpand:
+		return "SIZE_EXPAND"
+	case ControlSizeExpandFill:
+		return "SIZE_EXPAND_FILL"
+	case ControlSizeFill:
+		return "SIZE_FILL"
+	case ControlSizeShrinkCenter:
+		return "SIZE_SHRINK_CENTER"
+	case ControlSizeShrinkEnd:
+		return "SIZE_SHRINK_END"
+	}
+	return fmt.Sprintf("ControlSizeFlags(%d)", int(e))
+}
+
+// ParseControlSizeFlags will return the ControlSizeFlags value with the given Godot name.
+func ParseControlSizeFlags(name string) (ControlSizeFlags, error) {
+	switch name {
+	case "SIZE_EXPAND":
+		return ControlSizeExpand, nil
+	case "SIZE_EXPAND_FILL":
+		return ControlSizeExpandFill, nil
+	case "SIZE_FILL":
+		return ControlSizeFill, nil
+	case "SIZE_SHRINK_CENTER":
+		return ControlSizeShrinkCenter, nil
+	case "SIZE_SHRINK_END":
+		return ControlSizeShrinkEnd, nil
+	}
+	return 0, fmt.Errorf("invalid ControlSizeFlags value %q", name)
 }
 
+// Constants of the Control class.
+const (
+	ControlNotificationFocusEnter   gdnative.Int = 43
+	ControlNotificationFocusExit    gdnative.Int = 44
+	ControlNotificationModalClose   gdnative.Int = 46
+	ControlNotificationMouseEnter   gdnative.Int = 41
+	ControlNotificationMouseExit    gdnative.Int = 42
+	ControlNotificationResized      gdnative.Int = 40
+	ControlNotificationThemeChanged gdnative.Int = 45
+)
+
 /*
 Base class for all User Interface or [i]UI[/i] related nodes. [code]Control[/code] features a bounding rectangle that defines its extents, an anchor position relative to its parent and margins that represent an offset to the anchor. The margins update automatically when the node, any of its parents, or the screen size change. For more information on Godot's UI system, anchors, margins, and containers, see the related tutorials in the manual. To build flexible UIs, you'll need a mix of UI elements that inherit from [code]Control[/code] and [Container] nodes. [b]User Interface nodes and input[/b] Godot sends input events to the scene's root node first, by calling [method Node._input]. [method Node._input] forwards the event down the node tree to the nodes under the mouse cursor, or on keyboard focus. To do so, it calls [method MainLoop._input_event]. Call [method accept_event] so no other node receives the event. Once you accepted an input, it becomes handled so [method Node._unhandled_input] will not process it. Only one [code]Control[/code] node can be in keyboard focus. Only the node in focus will receive keyboard events. To get the focus, call [method grab_focus]. [code]Control[/code] nodes lose focus when another node grabs it, or if you hide the node in focus. Set [member mouse_filter] to MOUSE_FILTER_IGNORE to tell a [code]Control[/code] node to ignore mouse or touch events. You'll need it if you place an icon on top of a button. [Theme] resources change the Control's appearance. If you change the [Theme] on a [code]Control[/code] node, it affects all of its children. To override some of the theme's parameters, call one of the [code]add_*_override[/code] methods, like [method add_font_override]. You can override the theme with the inspector.
 */
@@ -130,13 +454,141 @@ type Control struct {
 	owner gdnative.Object
 }
 
+// classConstructorControl is the class constructor of the Control class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorControl = gdnative.NewLazyClassConstructor("Control")
+
+// NewControl will create a new instance of the Control class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewControl() *Control {
+	obj := &Control{}
+	obj.SetBaseObject(classConstructorControl.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Control) BaseClass() string {
 	return "Control"
 }
 
+// Method binds of the Control class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindControlX_FontChanged              = gdnative.NewLazyMethodBind("Control", "_font_changed")
+	methodBindControlX_GetMinimumSize           = gdnative.NewLazyMethodBind("Control", "_get_minimum_size")
+	methodBindControlX_GetTooltip               = gdnative.NewLazyMethodBind("Control", "_get_tooltip")
+	methodBindControlX_GuiInput                 = gdnative.NewLazyMethodBind("Control", "_gui_input")
+	methodBindControlX_SetAnchor                = gdnative.NewLazyMethodBind("Control", "_set_anchor")
+	methodBindControlX_SizeChanged              = gdnative.NewLazyMethodBind("Control", "_size_changed")
+	methodBindControlX_ThemeChanged             = gdnative.NewLazyMethodBind("Control", "_theme_changed")
+	methodBindControlX_UpdateMinimumSize        = gdnative.NewLazyMethodBind("Control", "_update_minimum_size")
+	methodBindControlAcceptEvent                = gdnative.NewLazyMethodBind("Control", "accept_event")
+	methodBindControlAddColorOverride           = gdnative.NewLazyMethodBind("Control", "add_color_override")
+	methodBindControlAddConstantOverride        = gdnative.NewLazyMethodBind("Control", "add_constant_override")
+	methodBindControlAddFontOverride            = gdnative.NewLazyMethodBind("Control", "add_font_override")
+	methodBindControlAddIconOverride            = gdnative.NewLazyMethodBind("Control", "add_icon_override")
+	methodBindControlAddShaderOverride          = gdnative.NewLazyMethodBind("Control", "add_shader_override")
+	methodBindControlAddStyleboxOverride        = gdnative.NewLazyMethodBind("Control", "add_stylebox_override")
+	methodBindControlCanDropData                = gdnative.NewLazyMethodBind("Control", "can_drop_data")
+	methodBindControlDropData                   = gdnative.NewLazyMethodBind("Control", "drop_data")
+	methodBindControlForceDrag                  = gdnative.NewLazyMethodBind("Control", "force_drag")
+	methodBindControlGetAnchor                  = gdnative.NewLazyMethodBind("Control", "get_anchor")
+	methodBindControlGetBegin                   = gdnative.NewLazyMethodBind("Control", "get_begin")
+	methodBindControlGetColor                   = gdnative.NewLazyMethodBind("Control", "get_color")
+	methodBindControlGetCombinedMinimumSize     = gdnative.NewLazyMethodBind("Control", "get_combined_minimum_size")
+	methodBindControlGetConstant                = gdnative.NewLazyMethodBind("Control", "get_constant")
+	methodBindControlGetCursorShape             = gdnative.NewLazyMethodBind("Control", "get_cursor_shape")
+	methodBindControlGetCustomMinimumSize       = gdnative.NewLazyMethodBind("Control", "get_custom_minimum_size")
+	methodBindControlGetDefaultCursorShape      = gdnative.NewLazyMethodBind("Control", "get_default_cursor_shape")
+	methodBindControlGetDragData                = gdnative.NewLazyMethodBind("Control", "get_drag_data")
+	methodBindControlGetEnd                     = gdnative.NewLazyMethodBind("Control", "get_end")
+	methodBindControlGetFocusMode               = gdnative.NewLazyMethodBind("Control", "get_focus_mode")
+	methodBindControlGetFocusNeighbour          = gdnative.NewLazyMethodBind("Control", "get_focus_neighbour")
+	methodBindControlGetFocusNext               = gdnative.NewLazyMethodBind("Control", "get_focus_next")
+	methodBindControlGetFocusOwner              = gdnative.NewLazyMethodBind("Control", "get_focus_owner")
+	methodBindControlGetFocusPrevious           = gdnative.NewLazyMethodBind("Control", "get_focus_previous")
+	methodBindControlGetFont                    = gdnative.NewLazyMethodBind("Control", "get_font")
+	methodBindControlGetGlobalPosition          = gdnative.NewLazyMethodBind("Control", "get_global_position")
+	methodBindControlGetGlobalRect              = gdnative.NewLazyMethodBind("Control", "get_global_rect")
+	methodBindControlGetHGrowDirection          = gdnative.NewLazyMethodBind("Control", "get_h_grow_direction")
+	methodBindControlGetHSizeFlags              = gdnative.NewLazyMethodBind("Control", "get_h_size_flags")
+	methodBindControlGetIcon                    = gdnative.NewLazyMethodBind("Control", "get_icon")
+	methodBindControlGetMargin                  = gdnative.NewLazyMethodBind("Control", "get_margin")
+	methodBindControlGetMinimumSize             = gdnative.NewLazyMethodBind("Control", "get_minimum_size")
+	methodBindControlGetMouseFilter             = gdnative.NewLazyMethodBind("Control", "get_mouse_filter")
+	methodBindControlGetParentAreaSize          = gdnative.NewLazyMethodBind("Control", "get_parent_area_size")
+	methodBindControlGetParentControl           = gdnative.NewLazyMethodBind("Control", "get_parent_control")
+	methodBindControlGetPivotOffset             = gdnative.NewLazyMethodBind("Control", "get_pivot_offset")
+	methodBindControlGetPosition                = gdnative.NewLazyMethodBind("Control", "get_position")
+	methodBindControlGetRect                    = gdnative.NewLazyMethodBind("Control", "get_rect")
+	methodBindControlGetRotation                = gdnative.NewLazyMethodBind("Control", "get_rotation")
+	methodBindControlGetRotationDegrees         = gdnative.NewLazyMethodBind("Control", "get_rotation_degrees")
+	methodBindControlGetScale                   = gdnative.NewLazyMethodBind("Control", "get_scale")
+	methodBindControlGetSize                    = gdnative.NewLazyMethodBind("Control", "get_size")
+	methodBindControlGetStretchRatio            = gdnative.NewLazyMethodBind("Control", "get_stretch_ratio")
+	methodBindControlGetStylebox                = gdnative.NewLazyMethodBind("Control", "get_stylebox")
+	methodBindControlGetTheme                   = gdnative.NewLazyMethodBind("Control", "get_theme")
+	methodBindControlGetTooltip                 = gdnative.NewLazyMethodBind("Control", "get_tooltip")
+	methodBindControlGetVGrowDirection          = gdnative.NewLazyMethodBind("Control", "get_v_grow_direction")
+	methodBindControlGetVSizeFlags              = gdnative.NewLazyMethodBind("Control", "get_v_size_flags")
+	methodBindControlGrabClickFocus             = gdnative.NewLazyMethodBind("Control", "grab_click_focus")
+	methodBindControlGrabFocus                  = gdnative.NewLazyMethodBind("Control", "grab_focus")
+	methodBindControlHasColor                   = gdnative.NewLazyMethodBind("Control", "has_color")
+	methodBindControlHasColorOverride           = gdnative.NewLazyMethodBind("Control", "has_color_override")
+	methodBindControlHasConstant                = gdnative.NewLazyMethodBind("Control", "has_constant")
+	methodBindControlHasConstantOverride        = gdnative.NewLazyMethodBind("Control", "has_constant_override")
+	methodBindControlHasFocus                   = gdnative.NewLazyMethodBind("Control", "has_focus")
+	methodBindControlHasFont                    = gdnative.NewLazyMethodBind("Control", "has_font")
+	methodBindControlHasFontOverride            = gdnative.NewLazyMethodBind("Control", "has_font_override")
+	methodBindControlHasIcon                    = gdnative.NewLazyMethodBind("Control", "has_icon")
+	methodBindControlHasIconOverride            = gdnative.NewLazyMethodBind("Control", "has_icon_override")
+	methodBindControlHasPoint                   = gdnative.NewLazyMethodBind("Control", "has_point")
+	methodBindControlHasShaderOverride          = gdnative.NewLazyMethodBind("Control", "has_shader_override")
+	methodBindControlHasStylebox                = gdnative.NewLazyMethodBind("Control", "has_stylebox")
+	methodBindControlHasStyleboxOverride        = gdnative.NewLazyMethodBind("Control", "has_stylebox_override")
+	methodBindControlIsClippingContents         = gdnative.NewLazyMethodBind("Control", "is_clipping_contents")
+	methodBindControlMinimumSizeChanged         = gdnative.NewLazyMethodBind("Control", "minimum_size_changed")
+	methodBindControlReleaseFocus               = gdnative.NewLazyMethodBind("Control", "release_focus")
+	methodBindControlSetAnchor                  = gdnative.NewLazyMethodBind("Control", "set_anchor")
+	methodBindControlSetAnchorAndMargin         = gdnative.NewLazyMethodBind("Control", "set_anchor_and_margin")
+	methodBindControlSetAnchorsAndMarginsPreset = gdnative.NewLazyMethodBind("Control", "set_anchors_and_margins_preset")
+	methodBindControlSetAnchorsPreset           = gdnative.NewLazyMethodBind("Control", "set_anchors_preset")
+	methodBindControlSetBegin                   = gdnative.NewLazyMethodBind("Control", "set_begin")
+	methodBindControlSetClipContents            = gdnative.NewLazyMethodBind("Control", "set_clip_contents")
+	methodBindControlSetCustomMinimumSize       = gdnative.NewLazyMethodBind("Control", "set_custom_minimum_size")
+	methodBindControlSetDefaultCursorShape      = gdnative.NewLazyMethodBind("Control", "set_default_cursor_shape")
+	methodBindControlSetDragForwarding          = gdnative.NewLazyMethodBind("Control", "set_drag_forwarding")
+	methodBindControlSetDragPreview             = gdnative.NewLazyMethodBind("Control", "set_drag_preview")
+	methodBindControlSetEnd                     = gdnative.NewLazyMethodBind("Control", "set_end")
+	methodBindControlSetFocusMode               = gdnative.NewLazyMethodBind("Control", "set_focus_mode")
+	methodBindControlSetFocusNeighbour          = gdnative.NewLazyMethodBind("Control", "set_focus_neighbour")
+	methodBindControlSetFocusNext               = gdnative.NewLazyMethodBind("Control", "set_focus_next")
+	methodBindControlSetFocusPrevious           = gdnative.NewLazyMethodBind("Control", "set_focus_previous")
+	methodBindControlSetGlobalPosition          = gdnative.NewLazyMethodBind("Control", "set_global_position")
+	methodBindControlSetHGrowDirection          = gdnative.NewLazyMethodBind("Control", "set_h_grow_direction")
+	methodBindControlSetHSizeFlags              = gdnative.NewLazyMethodBind("Control", "set_h_size_flags")
+	methodBindControlSetMargin                  = gdnative.NewLazyMethodBind("Control", "set_margin")
+	methodBindControlSetMarginsPreset           = gdnative.NewLazyMethodBind("Control", "set_margins_preset")
+	methodBindControlSetMouseFilter             = gdnative.NewLazyMethodBind("Control", "set_mouse_filter")
+	methodBindControlSetPivotOffset             = gdnative.NewLazyMethodBind("Control", "set_pivot_offset")
+	methodBindControlSetPosition                = gdnative.NewLazyMethodBind("Control", "set_position")
+	methodBindControlSetRotation                = gdnative.NewLazyMethodBind("Control", "set_rotation")
+	methodBindControlSetRotationDegrees         = gdnative.NewLazyMethodBind("Control", "set_rotation_degrees")
+	methodBindControlSetScale                   = gdnative.NewLazyMethodBind("Control", "set_scale")
+	methodBindControlSetSize                    = gdnative.NewLazyMethodBind("Control", "set_size")
+	methodBindControlSetStretchRatio            = gdnative.NewLazyMethodBind("Control", "set_stretch_ratio")
+	methodBindControlSetTheme                   = gdnative.NewLazyMethodBind("Control", "set_theme")
+	methodBindControlSetTooltip                 = gdnative.NewLazyMethodBind("Control", "set_tooltip")
+	methodBindControlSetVGrowDirection          = gdnative.NewLazyMethodBind("Control", "set_v_grow_direction")
+	methodBindControlSetVSizeFlags              = gdnative.NewLazyMethodBind("Control", "set_v_size_flags")
+	methodBindControlShowModal                  = gdnative.NewLazyMethodBind("Control", "show_modal")
+	methodBindControlWarpMouse                  = gdnative.NewLazyMethodBind("Control", "warp_mouse")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Control) X_FontChanged() {
 	//log.Println("Calling Control.X_FontChanged()")
@@ -145,7 +597,7 @@ func (o *Control) X_FontChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_font_changed")
+	methodBind := methodBindControlX_FontChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -155,8 +607,8 @@ func (o *Control) X_FontChanged() {
 }
 
 /*
-        Returns the minimum size this Control can shrink to. The node can never be smaller than this minimum size.
-	Args: [], Returns: Vector2
+	        Returns the minimum size this Control can shrink to. The node can never be smaller than this minimum size.
+		Args: [], Returns: Vector2
 */
 func (o *Control) X_GetMinimumSize() gdnative.Vector2 {
 	//log.Println("Calling Control.X_GetMinimumSize()")
@@ -165,7 +617,7 @@ func (o *Control) X_GetMinimumSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_get_minimum_size")
+	methodBind := methodBindControlX_GetMinimumSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -178,8 +630,8 @@ func (o *Control) X_GetMinimumSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Control) X_GetTooltip() gdnative.String {
 	//log.Println("Calling Control.X_GetTooltip()")
@@ -188,7 +640,7 @@ func (o *Control) X_GetTooltip() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_get_tooltip")
+	methodBind := methodBindControlX_GetTooltip.Get()
 
 	// Call the parent method.
 	// String
@@ -201,18 +653,18 @@ func (o *Control) X_GetTooltip() gdnative.String {
 }
 
 /*
-        The node's parent forwards input events to this method. Use it to process and accept inputs on UI elements. See [method accept_event]. Replaces Godot 2's [code]_input_event[/code].
-	Args: [{ false event InputEvent}], Returns: void
+	        The node's parent forwards input events to this method. Use it to process and accept inputs on UI elements. See [method accept_event]. Replaces Godot 2's [code]_input_event[/code].
+		Args: [{ false event InputEvent}], Returns: void
 */
 func (o *Control) X_GuiInput(event InputEventImplementer) {
 	//log.Println("Calling Control.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_gui_input")
+	methodBind := methodBindControlX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -222,8 +674,8 @@ func (o *Control) X_GuiInput(event InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false anchor float}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false anchor float}], Returns: void
 */
 func (o *Control) X_SetAnchor(margin gdnative.Int, anchor gdnative.Real) {
 	//log.Println("Calling Control.X_SetAnchor()")
@@ -234,7 +686,7 @@ func (o *Control) X_SetAnchor(margin gdnative.Int, anchor gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(anchor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_set_anchor")
+	methodBind := methodBindControlX_SetAnchor.Get()
 
 	// Call the parent method.
 	// void
@@ -244,8 +696,8 @@ func (o *Control) X_SetAnchor(margin gdnative.Int, anchor gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Control) X_SizeChanged() {
 	//log.Println("Calling Control.X_SizeChanged()")
@@ -254,7 +706,7 @@ func (o *Control) X_SizeChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_size_changed")
+	methodBind := methodBindControlX_SizeChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -264,8 +716,8 @@ func (o *Control) X_SizeChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Control) X_ThemeChanged() {
 	//log.Println("Calling Control.X_ThemeChanged()")
@@ -274,7 +726,7 @@ func (o *Control) X_ThemeChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_theme_changed")
+	methodBind := methodBindControlX_ThemeChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -284,8 +736,8 @@ func (o *Control) X_ThemeChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Control) X_UpdateMinimumSize() {
 	//log.Println("Calling Control.X_UpdateMinimumSize()")
@@ -294,7 +746,7 @@ func (o *Control) X_UpdateMinimumSize() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "_update_minimum_size")
+	methodBind := methodBindControlX_UpdateMinimumSize.Get()
 
 	// Call the parent method.
 	// void
@@ -304,8 +756,8 @@ func (o *Control) X_UpdateMinimumSize() {
 }
 
 /*
-        Marks an input event as handled. Once you accept an input event, it stops propagating, even to nodes listening to [method Node._unhandled_input] or [method Node._unhandled_key_input].
-	Args: [], Returns: void
+	        Marks an input event as handled. Once you accept an input event, it stops propagating, even to nodes listening to [method Node._unhandled_input] or [method Node._unhandled_key_input].
+		Args: [], Returns: void
 */
 func (o *Control) AcceptEvent() {
 	//log.Println("Calling Control.AcceptEvent()")
@@ -314,7 +766,7 @@ func (o *Control) AcceptEvent() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "accept_event")
+	methodBind := methodBindControlAcceptEvent.Get()
 
 	// Call the parent method.
 	// void
@@ -324,8 +776,8 @@ func (o *Control) AcceptEvent() {
 }
 
 /*
-        Overrides the color in the [theme] resource the node uses.
-	Args: [{ false name String} { false color Color}], Returns: void
+	        Overrides the color in the [theme] resource the node uses.
+		Args: [{ false name String} { false color Color}], Returns: void
 */
 func (o *Control) AddColorOverride(name gdnative.String, color gdnative.Color) {
 	//log.Println("Calling Control.AddColorOverride()")
@@ -336,7 +788,7 @@ func (o *Control) AddColorOverride(name gdnative.String, color gdnative.Color) {
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "add_color_override")
+	methodBind := methodBindControlAddColorOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -346,8 +798,8 @@ func (o *Control) AddColorOverride(name gdnative.String, color gdnative.Color) {
 }
 
 /*
-        Overrides an integer constant in the [Theme] resource the node uses. If the [code]constant[/code] is invalid, Godot clears the override. See [member Theme.INVALID_CONSTANT] for more information.
-	Args: [{ false name String} { false constant int}], Returns: void
+	        Overrides an integer constant in the [Theme] resource the node uses. If the [code]constant[/code] is invalid, Godot clears the override. See [member Theme.INVALID_CONSTANT] for more information.
+		Args: [{ false name String} { false constant int}], Returns: void
 */
 func (o *Control) AddConstantOverride(name gdnative.String, constant gdnative.Int) {
 	//log.Println("Calling Control.AddConstantOverride()")
@@ -358,7 +810,7 @@ func (o *Control) AddConstantOverride(name gdnative.String, constant gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(constant)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "add_constant_override")
+	methodBind := methodBindControlAddConstantOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -368,8 +820,8 @@ func (o *Control) AddConstantOverride(name gdnative.String, constant gdnative.In
 }
 
 /*
-        Overrides the [code]name[/code] font in the [theme] resource the node uses. If [code]font[/code] is empty, Godot clears the override.
-	Args: [{ false name String} { false font Font}], Returns: void
+	        Overrides the [code]name[/code] font in the [theme] resource the node uses. If [code]font[/code] is empty, Godot clears the override.
+		Args: [{ false name String} { false font Font}], Returns: void
 */
 func (o *Control) AddFontOverride(name gdnative.String, font FontImplementer) {
 	//log.Println("Calling Control.AddFontOverride()")
@@ -377,10 +829,10 @@ func (o *Control) AddFontOverride(name gdnative.String, font FontImplementer) {
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
-	ptrArguments[1] = gdnative.NewPointerFromObject(font.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(font))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "add_font_override")
+	methodBind := methodBindControlAddFontOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -390,8 +842,8 @@ func (o *Control) AddFontOverride(name gdnative.String, font FontImplementer) {
 }
 
 /*
-        Overrides the [code]name[/code] icon in the [theme] resource the node uses. If [code]icon[/code] is empty, Godot clears the override.
-	Args: [{ false name String} { false texture Texture}], Returns: void
+	        Overrides the [code]name[/code] icon in the [theme] resource the node uses. If [code]icon[/code] is empty, Godot clears the override.
+		Args: [{ false name String} { false texture Texture}], Returns: void
 */
 func (o *Control) AddIconOverride(name gdnative.String, texture TextureImplementer) {
 	//log.Println("Calling Control.AddIconOverride()")
@@ -399,10 +851,10 @@ func (o *Control) AddIconOverride(name gdnative.String, texture TextureImplement
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "add_icon_override")
+	methodBind := methodBindControlAddIconOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -412,8 +864,8 @@ func (o *Control) AddIconOverride(name gdnative.String, texture TextureImplement
 }
 
 /*
-        Overrides the [code]name[/code] shader in the [theme] resource the node uses. If [code]shader[/code] is empty, Godot clears the override.
-	Args: [{ false name String} { false shader Shader}], Returns: void
+	        Overrides the [code]name[/code] shader in the [theme] resource the node uses. If [code]shader[/code] is empty, Godot clears the override.
+		Args: [{ false name String} { false shader Shader}], Returns: void
 */
 func (o *Control) AddShaderOverride(name gdnative.String, shader ShaderImplementer) {
 	//log.Println("Calling Control.AddShaderOverride()")
@@ -421,10 +873,10 @@ func (o *Control) AddShaderOverride(name gdnative.String, shader ShaderImplement
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
-	ptrArguments[1] = gdnative.NewPointerFromObject(shader.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(shader))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "add_shader_override")
+	methodBind := methodBindControlAddShaderOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -434,8 +886,8 @@ func (o *Control) AddShaderOverride(name gdnative.String, shader ShaderImplement
 }
 
 /*
-        Overrides the [code]name[/code] [Stylebox] in the [theme] resource the node uses. If [code]stylebox[/code] is empty, Godot clears the override.
-	Args: [{ false name String} { false stylebox StyleBox}], Returns: void
+	        Overrides the [code]name[/code] [Stylebox] in the [theme] resource the node uses. If [code]stylebox[/code] is empty, Godot clears the override.
+		Args: [{ false name String} { false stylebox StyleBox}], Returns: void
 */
 func (o *Control) AddStyleboxOverride(name gdnative.String, stylebox StyleBoxImplementer) {
 	//log.Println("Calling Control.AddStyleboxOverride()")
@@ -443,10 +895,10 @@ func (o *Control) AddStyleboxOverride(name gdnative.String, stylebox StyleBoxImp
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
-	ptrArguments[1] = gdnative.NewPointerFromObject(stylebox.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(stylebox))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "add_stylebox_override")
+	methodBind := methodBindControlAddStyleboxOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -456,8 +908,8 @@ func (o *Control) AddStyleboxOverride(name gdnative.String, stylebox StyleBoxImp
 }
 
 /*
-        Godot calls this method to test if [code]data[/code] from a control's [method get_drag_data] can be dropped at [code]position[/code]. [code]position[/code] is local to this control. This method should only be used to test the data. Process the data in [method drop_data]. [codeblock] extends Control func can_drop_data(position, data): # check position if it is relevant to you # otherwise just check data return typeof(data) == TYPE_DICTIONARY and data.has('expected') [/codeblock]
-	Args: [{ false position Vector2} { false data Variant}], Returns: bool
+	        Godot calls this method to test if [code]data[/code] from a control's [method get_drag_data] can be dropped at [code]position[/code]. [code]position[/code] is local to this control. This method should only be used to test the data. Process the data in [method drop_data]. [codeblock] extends Control func can_drop_data(position, data): # check position if it is relevant to you # otherwise just check data return typeof(data) == TYPE_DICTIONARY and data.has('expected') [/codeblock]
+		Args: [{ false position Vector2} { false data Variant}], Returns: bool
 */
 func (o *Control) CanDropData(position gdnative.Vector2, data gdnative.Variant) gdnative.Bool {
 	//log.Println("Calling Control.CanDropData()")
@@ -468,7 +920,7 @@ func (o *Control) CanDropData(position gdnative.Vector2, data gdnative.Variant)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "can_drop_data")
+	methodBind := methodBindControlCanDropData.Get()
 
 	// Call the parent method.
 	// bool
@@ -481,8 +933,8 @@ func (o *Control) CanDropData(position gdnative.Vector2, data gdnative.Variant)
 }
 
 /*
-        Godot calls this method to pass you the [code]data[/code] from a control's [method get_drag_data] result. Godot first calls [method can_drop_data] to test if [code]data[/code] is allowed to drop at [code]position[/code] where [code]position[/code] is local to this control. [codeblock] extends ColorRect func can_drop_data(position, data): return typeof(data) == TYPE_DICTIONARY and data.has('color') func drop_data(position, data): color = data['color'] [/codeblock]
-	Args: [{ false position Vector2} { false data Variant}], Returns: void
+	        Godot calls this method to pass you the [code]data[/code] from a control's [method get_drag_data] result. Godot first calls [method can_drop_data] to test if [code]data[/code] is allowed to drop at [code]position[/code] where [code]position[/code] is local to this control. [codeblock] extends ColorRect func can_drop_data(position, data): return typeof(data) == TYPE_DICTIONARY and data.has('color') func drop_data(position, data): color = data['color'] [/codeblock]
+		Args: [{ false position Vector2} { false data Variant}], Returns: void
 */
 func (o *Control) DropData(position gdnative.Vector2, data gdnative.Variant) {
 	//log.Println("Calling Control.DropData()")
@@ -493,7 +945,7 @@ func (o *Control) DropData(position gdnative.Vector2, data gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "drop_data")
+	methodBind := methodBindControlDropData.Get()
 
 	// Call the parent method.
 	// void
@@ -503,8 +955,8 @@ func (o *Control) DropData(position gdnative.Vector2, data gdnative.Variant) {
 }
 
 /*
-        Forces drag and bypasses [method get_drag_data] and [method set_drag_preview] by passing [code]data[/code] and [code]preview[/code]. Drag will start even if the mouse is neither over nor pressed on this control. The methods [method can_drop_data] and [method drop_data] must be implemented on controls that want to recieve drop data.
-	Args: [{ false data Variant} { false preview Object}], Returns: void
+	        Forces drag and bypasses [method get_drag_data] and [method set_drag_preview] by passing [code]data[/code] and [code]preview[/code]. Drag will start even if the mouse is neither over nor pressed on this control. The methods [method can_drop_data] and [method drop_data] must be implemented on controls that want to recieve drop data.
+		Args: [{ false data Variant} { false preview Object}], Returns: void
 */
 func (o *Control) ForceDrag(data gdnative.Variant, preview ObjectImplementer) {
 	//log.Println("Calling Control.ForceDrag()")
@@ -512,10 +964,10 @@ func (o *Control) ForceDrag(data gdnative.Variant, preview ObjectImplementer) {
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromVariant(data)
-	ptrArguments[1] = gdnative.NewPointerFromObject(preview.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(preview))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "force_drag")
+	methodBind := methodBindControlForceDrag.Get()
 
 	// Call the parent method.
 	// void
@@ -525,8 +977,8 @@ func (o *Control) ForceDrag(data gdnative.Variant, preview ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: float
+	        Undocumented
+		Args: [{ false margin int}], Returns: float
 */
 func (o *Control) GetAnchor(margin gdnative.Int) gdnative.Real {
 	//log.Println("Calling Control.GetAnchor()")
@@ -536,7 +988,7 @@ func (o *Control) GetAnchor(margin gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_anchor")
+	methodBind := methodBindControlGetAnchor.Get()
 
 	// Call the parent method.
 	// float
@@ -549,8 +1001,7 @@ func (o *Control) GetAnchor(margin gdnative.Int) gdnative.Real {
 }
 
 /*
-
-	Args: [], Returns: Vector2
+Args: [], Returns: Vector2
 */
 func (o *Control) GetBegin() gdnative.Vector2 {
 	//log.Println("Calling Control.GetBegin()")
@@ -559,7 +1010,7 @@ func (o *Control) GetBegin() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_begin")
+	methodBind := methodBindControlGetBegin.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -572,8 +1023,7 @@ func (o *Control) GetBegin() gdnative.Vector2 {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: Color
+Args: [{ false name String} { true type String}], Returns: Color
 */
 func (o *Control) GetColor(name gdnative.String, aType gdnative.String) gdnative.Color {
 	//log.Println("Calling Control.GetColor()")
@@ -584,7 +1034,7 @@ func (o *Control) GetColor(name gdnative.String, aType gdnative.String) gdnative
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_color")
+	methodBind := methodBindControlGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -597,8 +1047,7 @@ func (o *Control) GetColor(name gdnative.String, aType gdnative.String) gdnative
 }
 
 /*
-
-	Args: [], Returns: Vector2
+Args: [], Returns: Vector2
 */
 func (o *Control) GetCombinedMinimumSize() gdnative.Vector2 {
 	//log.Println("Calling Control.GetCombinedMinimumSize()")
@@ -607,7 +1056,7 @@ func (o *Control) GetCombinedMinimumSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_combined_minimum_size")
+	methodBind := methodBindControlGetCombinedMinimumSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -620,8 +1069,7 @@ func (o *Control) GetCombinedMinimumSize() gdnative.Vector2 {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: int
+Args: [{ false name String} { true type String}], Returns: int
 */
 func (o *Control) GetConstant(name gdnative.String, aType gdnative.String) gdnative.Int {
 	//log.Println("Calling Control.GetConstant()")
@@ -632,7 +1080,7 @@ func (o *Control) GetConstant(name gdnative.String, aType gdnative.String) gdnat
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_constant")
+	methodBind := methodBindControlGetConstant.Get()
 
 	// Call the parent method.
 	// int
@@ -645,8 +1093,8 @@ func (o *Control) GetConstant(name gdnative.String, aType gdnative.String) gdnat
 }
 
 /*
-        Returns the mouse cursor shape the control displays on mouse hover, one of the [code]CURSOR_*[/code] constants.
-	Args: [{(0, 0) true position Vector2}], Returns: enum.Control::CursorShape
+	        Returns the mouse cursor shape the control displays on mouse hover, one of the [code]CURSOR_*[/code] constants.
+		Args: [{(0, 0) true position Vector2}], Returns: enum.Control::CursorShape
 */
 func (o *Control) GetCursorShape(position gdnative.Vector2) ControlCursorShape {
 	//log.Println("Calling Control.GetCursorShape()")
@@ -656,7 +1104,7 @@ func (o *Control) GetCursorShape(position gdnative.Vector2) ControlCursorShape {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_cursor_shape")
+	methodBind := methodBindControlGetCursorShape.Get()
 
 	// Call the parent method.
 	// enum.Control::CursorShape
@@ -669,8 +1117,8 @@ func (o *Control) GetCursorShape(position gdnative.Vector2) ControlCursorShape {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetCustomMinimumSize() gdnative.Vector2 {
 	//log.Println("Calling Control.GetCustomMinimumSize()")
@@ -679,7 +1127,7 @@ func (o *Control) GetCustomMinimumSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_custom_minimum_size")
+	methodBind := methodBindControlGetCustomMinimumSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -692,8 +1140,8 @@ func (o *Control) GetCustomMinimumSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Control::CursorShape
+	        Undocumented
+		Args: [], Returns: enum.Control::CursorShape
 */
 func (o *Control) GetDefaultCursorShape() ControlCursorShape {
 	//log.Println("Calling Control.GetDefaultCursorShape()")
@@ -702,7 +1150,7 @@ func (o *Control) GetDefaultCursorShape() ControlCursorShape {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_default_cursor_shape")
+	methodBind := methodBindControlGetDefaultCursorShape.Get()
 
 	// Call the parent method.
 	// enum.Control::CursorShape
@@ -715,8 +1163,8 @@ func (o *Control) GetDefaultCursorShape() ControlCursorShape {
 }
 
 /*
-        Godot calls this method to get data that can be dragged and dropped onto controls that expect drop data. Return null if there is no data to drag. Controls that want to recieve drop data should implement [method can_drop_data] and [method drop_data]. [code]position[/code] is local to this control. Drag may be forced with [method force_drag]. A preview that will follow the mouse that should represent the data can be set with [method set_drag_preview]. A good time to set the preview is in this method. [codeblock] extends Control func get_drag_data(position): var mydata = make_data() set_drag_preview(make_preview(mydata)) return mydata [/codeblock]
-	Args: [{ false position Vector2}], Returns: Object
+	        Godot calls this method to get data that can be dragged and dropped onto controls that expect drop data. Return null if there is no data to drag. Controls that want to recieve drop data should implement [method can_drop_data] and [method drop_data]. [code]position[/code] is local to this control. Drag may be forced with [method force_drag]. A preview that will follow the mouse that should represent the data can be set with [method set_drag_preview]. A good time to set the preview is in this method. [codeblock] extends Control func get_drag_data(position): var mydata = make_data() set_drag_preview(make_preview(mydata)) return mydata [/codeblock]
+		Args: [{ false position Vector2}], Returns: Object
 */
 func (o *Control) GetDragData(position gdnative.Vector2) ObjectImplementer {
 	//log.Println("Calling Control.GetDragData()")
@@ -726,7 +1174,7 @@ func (o *Control) GetDragData(position gdnative.Vector2) ObjectImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_drag_data")
+	methodBind := methodBindControlGetDragData.Get()
 
 	// Call the parent method.
 	// Object
@@ -734,27 +1182,25 @@ func (o *Control) GetDragData(position gdnative.Vector2) ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns MARGIN_LEFT and MARGIN_TOP at the same time. This is a helper (see [method set_margin]).
-	Args: [], Returns: Vector2
+	        Returns MARGIN_LEFT and MARGIN_TOP at the same time. This is a helper (see [method set_margin]).
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetEnd() gdnative.Vector2 {
 	//log.Println("Calling Control.GetEnd()")
@@ -763,7 +1209,7 @@ func (o *Control) GetEnd() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_end")
+	methodBind := methodBindControlGetEnd.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -776,8 +1222,8 @@ func (o *Control) GetEnd() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Control::FocusMode
+	        Undocumented
+		Args: [], Returns: enum.Control::FocusMode
 */
 func (o *Control) GetFocusMode() ControlFocusMode {
 	//log.Println("Calling Control.GetFocusMode()")
@@ -786,7 +1232,7 @@ func (o *Control) GetFocusMode() ControlFocusMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_focus_mode")
+	methodBind := methodBindControlGetFocusMode.Get()
 
 	// Call the parent method.
 	// enum.Control::FocusMode
@@ -799,8 +1245,8 @@ func (o *Control) GetFocusMode() ControlFocusMode {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: NodePath
+	        Undocumented
+		Args: [{ false margin int}], Returns: NodePath
 */
 func (o *Control) GetFocusNeighbour(margin gdnative.Int) gdnative.NodePath {
 	//log.Println("Calling Control.GetFocusNeighbour()")
@@ -810,7 +1256,7 @@ func (o *Control) GetFocusNeighbour(margin gdnative.Int) gdnative.NodePath {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_focus_neighbour")
+	methodBind := methodBindControlGetFocusNeighbour.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -823,8 +1269,8 @@ func (o *Control) GetFocusNeighbour(margin gdnative.Int) gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *Control) GetFocusNext() gdnative.NodePath {
 	//log.Println("Calling Control.GetFocusNext()")
@@ -833,7 +1279,7 @@ func (o *Control) GetFocusNext() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_focus_next")
+	methodBind := methodBindControlGetFocusNext.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -846,8 +1292,8 @@ func (o *Control) GetFocusNext() gdnative.NodePath {
 }
 
 /*
-        Return which control is owning the keyboard focus, or null if no one.
-	Args: [], Returns: Control
+	        Return which control is owning the keyboard focus, or null if no one.
+		Args: [], Returns: Control
 */
 func (o *Control) GetFocusOwner() ControlImplementer {
 	//log.Println("Calling Control.GetFocusOwner()")
@@ -856,7 +1302,7 @@ func (o *Control) GetFocusOwner() ControlImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_focus_owner")
+	methodBind := methodBindControlGetFocusOwner.Get()
 
 	// Call the parent method.
 	// Control
@@ -864,27 +1310,25 @@ func (o *Control) GetFocusOwner() ControlImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newControlFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ControlImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Control" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ControlImplementer)
+	ret := Control{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ControlImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *Control) GetFocusPrevious() gdnative.NodePath {
 	//log.Println("Calling Control.GetFocusPrevious()")
@@ -893,7 +1337,7 @@ func (o *Control) GetFocusPrevious() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_focus_previous")
+	methodBind := methodBindControlGetFocusPrevious.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -906,8 +1350,7 @@ func (o *Control) GetFocusPrevious() gdnative.NodePath {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: Font
+Args: [{ false name String} { true type String}], Returns: Font
 */
 func (o *Control) GetFont(name gdnative.String, aType gdnative.String) FontImplementer {
 	//log.Println("Calling Control.GetFont()")
@@ -918,7 +1361,7 @@ func (o *Control) GetFont(name gdnative.String, aType gdnative.String) FontImple
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_font")
+	methodBind := methodBindControlGetFont.Get()
 
 	// Call the parent method.
 	// Font
@@ -926,27 +1369,25 @@ func (o *Control) GetFont(name gdnative.String, aType gdnative.String) FontImple
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newFontFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(FontImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Font" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(FontImplementer)
+	ret := Font{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(FontImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetGlobalPosition() gdnative.Vector2 {
 	//log.Println("Calling Control.GetGlobalPosition()")
@@ -955,7 +1396,7 @@ func (o *Control) GetGlobalPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_global_position")
+	methodBind := methodBindControlGetGlobalPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -968,8 +1409,8 @@ func (o *Control) GetGlobalPosition() gdnative.Vector2 {
 }
 
 /*
-        Return position and size of the Control, relative to the top-left corner of the [i]window[/i] Control. This is a helper (see [method get_global_position], [method get_size]).
-	Args: [], Returns: Rect2
+	        Return position and size of the Control, relative to the top-left corner of the [i]window[/i] Control. This is a helper (see [method get_global_position], [method get_size]).
+		Args: [], Returns: Rect2
 */
 func (o *Control) GetGlobalRect() gdnative.Rect2 {
 	//log.Println("Calling Control.GetGlobalRect()")
@@ -978,7 +1419,7 @@ func (o *Control) GetGlobalRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_global_rect")
+	methodBind := methodBindControlGetGlobalRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -991,8 +1432,8 @@ func (o *Control) GetGlobalRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Control::GrowDirection
+	        Undocumented
+		Args: [], Returns: enum.Control::GrowDirection
 */
 func (o *Control) GetHGrowDirection() ControlGrowDirection {
 	//log.Println("Calling Control.GetHGrowDirection()")
@@ -1001,7 +1442,7 @@ func (o *Control) GetHGrowDirection() ControlGrowDirection {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_h_grow_direction")
+	methodBind := methodBindControlGetHGrowDirection.Get()
 
 	// Call the parent method.
 	// enum.Control::GrowDirection
@@ -1014,8 +1455,8 @@ func (o *Control) GetHGrowDirection() ControlGrowDirection {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Control) GetHSizeFlags() gdnative.Int {
 	//log.Println("Calling Control.GetHSizeFlags()")
@@ -1024,7 +1465,7 @@ func (o *Control) GetHSizeFlags() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_h_size_flags")
+	methodBind := methodBindControlGetHSizeFlags.Get()
 
 	// Call the parent method.
 	// int
@@ -1037,8 +1478,7 @@ func (o *Control) GetHSizeFlags() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: Texture
+Args: [{ false name String} { true type String}], Returns: Texture
 */
 func (o *Control) GetIcon(name gdnative.String, aType gdnative.String) TextureImplementer {
 	//log.Println("Calling Control.GetIcon()")
@@ -1049,7 +1489,7 @@ func (o *Control) GetIcon(name gdnative.String, aType gdnative.String) TextureIm
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_icon")
+	methodBind := methodBindControlGetIcon.Get()
 
 	// Call the parent method.
 	// Texture
@@ -1057,27 +1497,25 @@ func (o *Control) GetIcon(name gdnative.String, aType gdnative.String) TextureIm
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: float
+	        Undocumented
+		Args: [{ false margin int}], Returns: float
 */
 func (o *Control) GetMargin(margin gdnative.Int) gdnative.Real {
 	//log.Println("Calling Control.GetMargin()")
@@ -1087,7 +1525,7 @@ func (o *Control) GetMargin(margin gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_margin")
+	methodBind := methodBindControlGetMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -1100,8 +1538,8 @@ func (o *Control) GetMargin(margin gdnative.Int) gdnative.Real {
 }
 
 /*
-        Return the minimum size this Control can shrink to. A control will never be displayed or resized smaller than its minimum size.
-	Args: [], Returns: Vector2
+	        Return the minimum size this Control can shrink to. A control will never be displayed or resized smaller than its minimum size.
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetMinimumSize() gdnative.Vector2 {
 	//log.Println("Calling Control.GetMinimumSize()")
@@ -1110,7 +1548,7 @@ func (o *Control) GetMinimumSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_minimum_size")
+	methodBind := methodBindControlGetMinimumSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1123,8 +1561,8 @@ func (o *Control) GetMinimumSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Control::MouseFilter
+	        Undocumented
+		Args: [], Returns: enum.Control::MouseFilter
 */
 func (o *Control) GetMouseFilter() ControlMouseFilter {
 	//log.Println("Calling Control.GetMouseFilter()")
@@ -1133,7 +1571,7 @@ func (o *Control) GetMouseFilter() ControlMouseFilter {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_mouse_filter")
+	methodBind := methodBindControlGetMouseFilter.Get()
 
 	// Call the parent method.
 	// enum.Control::MouseFilter
@@ -1146,8 +1584,7 @@ func (o *Control) GetMouseFilter() ControlMouseFilter {
 }
 
 /*
-
-	Args: [], Returns: Vector2
+Args: [], Returns: Vector2
 */
 func (o *Control) GetParentAreaSize() gdnative.Vector2 {
 	//log.Println("Calling Control.GetParentAreaSize()")
@@ -1156,7 +1593,7 @@ func (o *Control) GetParentAreaSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_parent_area_size")
+	methodBind := methodBindControlGetParentAreaSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1169,8 +1606,7 @@ func (o *Control) GetParentAreaSize() gdnative.Vector2 {
 }
 
 /*
-
-	Args: [], Returns: Control
+Args: [], Returns: Control
 */
 func (o *Control) GetParentControl() ControlImplementer {
 	//log.Println("Calling Control.GetParentControl()")
@@ -1179,7 +1615,7 @@ func (o *Control) GetParentControl() ControlImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_parent_control")
+	methodBind := methodBindControlGetParentControl.Get()
 
 	// Call the parent method.
 	// Control
@@ -1187,27 +1623,25 @@ func (o *Control) GetParentControl() ControlImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newControlFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ControlImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Control" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ControlImplementer)
+	ret := Control{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ControlImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetPivotOffset() gdnative.Vector2 {
 	//log.Println("Calling Control.GetPivotOffset()")
@@ -1216,7 +1650,7 @@ func (o *Control) GetPivotOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_pivot_offset")
+	methodBind := methodBindControlGetPivotOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1229,8 +1663,8 @@ func (o *Control) GetPivotOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetPosition() gdnative.Vector2 {
 	//log.Println("Calling Control.GetPosition()")
@@ -1239,7 +1673,7 @@ func (o *Control) GetPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_position")
+	methodBind := methodBindControlGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1252,8 +1686,8 @@ func (o *Control) GetPosition() gdnative.Vector2 {
 }
 
 /*
-        Return position and size of the Control, relative to the top-left corner of the parent Control. This is a helper (see [method get_position], [method get_size]).
-	Args: [], Returns: Rect2
+	        Return position and size of the Control, relative to the top-left corner of the parent Control. This is a helper (see [method get_position], [method get_size]).
+		Args: [], Returns: Rect2
 */
 func (o *Control) GetRect() gdnative.Rect2 {
 	//log.Println("Calling Control.GetRect()")
@@ -1262,7 +1696,7 @@ func (o *Control) GetRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_rect")
+	methodBind := methodBindControlGetRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -1275,8 +1709,8 @@ func (o *Control) GetRect() gdnative.Rect2 {
 }
 
 /*
-        Return the rotation (in radians)
-	Args: [], Returns: float
+	        Return the rotation (in radians)
+		Args: [], Returns: float
 */
 func (o *Control) GetRotation() gdnative.Real {
 	//log.Println("Calling Control.GetRotation()")
@@ -1285,7 +1719,7 @@ func (o *Control) GetRotation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_rotation")
+	methodBind := methodBindControlGetRotation.Get()
 
 	// Call the parent method.
 	// float
@@ -1298,8 +1732,8 @@ func (o *Control) GetRotation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Control) GetRotationDegrees() gdnative.Real {
 	//log.Println("Calling Control.GetRotationDegrees()")
@@ -1308,7 +1742,7 @@ func (o *Control) GetRotationDegrees() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_rotation_degrees")
+	methodBind := methodBindControlGetRotationDegrees.Get()
 
 	// Call the parent method.
 	// float
@@ -1321,8 +1755,8 @@ func (o *Control) GetRotationDegrees() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetScale() gdnative.Vector2 {
 	//log.Println("Calling Control.GetScale()")
@@ -1331,7 +1765,7 @@ func (o *Control) GetScale() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_scale")
+	methodBind := methodBindControlGetScale.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1344,8 +1778,8 @@ func (o *Control) GetScale() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Control) GetSize() gdnative.Vector2 {
 	//log.Println("Calling Control.GetSize()")
@@ -1354,7 +1788,7 @@ func (o *Control) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_size")
+	methodBind := methodBindControlGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1367,8 +1801,8 @@ func (o *Control) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Control) GetStretchRatio() gdnative.Real {
 	//log.Println("Calling Control.GetStretchRatio()")
@@ -1377,7 +1811,7 @@ func (o *Control) GetStretchRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_stretch_ratio")
+	methodBind := methodBindControlGetStretchRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -1390,8 +1824,7 @@ func (o *Control) GetStretchRatio() gdnative.Real {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: StyleBox
+Args: [{ false name String} { true type String}], Returns: StyleBox
 */
 func (o *Control) GetStylebox(name gdnative.String, aType gdnative.String) StyleBoxImplementer {
 	//log.Println("Calling Control.GetStylebox()")
@@ -1402,7 +1835,7 @@ func (o *Control) GetStylebox(name gdnative.String, aType gdnative.String) Style
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_stylebox")
+	methodBind := methodBindControlGetStylebox.Get()
 
 	// Call the parent method.
 	// StyleBox
@@ -1410,27 +1843,25 @@ func (o *Control) GetStylebox(name gdnative.String, aType gdnative.String) Style
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newStyleBoxFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(StyleBoxImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "StyleBox" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(StyleBoxImplementer)
+	ret := StyleBox{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(StyleBoxImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Theme
+	        Undocumented
+		Args: [], Returns: Theme
 */
 func (o *Control) GetTheme() ThemeImplementer {
 	//log.Println("Calling Control.GetTheme()")
@@ -1439,7 +1870,7 @@ func (o *Control) GetTheme() ThemeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_theme")
+	methodBind := methodBindControlGetTheme.Get()
 
 	// Call the parent method.
 	// Theme
@@ -1447,27 +1878,25 @@ func (o *Control) GetTheme() ThemeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newThemeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ThemeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Theme" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ThemeImplementer)
+	ret := Theme{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ThemeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the tooltip, which will appear when the cursor is resting over this control.
-	Args: [{(0, 0) true at_position Vector2}], Returns: String
+	        Return the tooltip, which will appear when the cursor is resting over this control.
+		Args: [{(0, 0) true at_position Vector2}], Returns: String
 */
 func (o *Control) GetTooltip(atPosition gdnative.Vector2) gdnative.String {
 	//log.Println("Calling Control.GetTooltip()")
@@ -1477,7 +1906,7 @@ func (o *Control) GetTooltip(atPosition gdnative.Vector2) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(atPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_tooltip")
+	methodBind := methodBindControlGetTooltip.Get()
 
 	// Call the parent method.
 	// String
@@ -1490,8 +1919,8 @@ func (o *Control) GetTooltip(atPosition gdnative.Vector2) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Control::GrowDirection
+	        Undocumented
+		Args: [], Returns: enum.Control::GrowDirection
 */
 func (o *Control) GetVGrowDirection() ControlGrowDirection {
 	//log.Println("Calling Control.GetVGrowDirection()")
@@ -1500,7 +1929,7 @@ func (o *Control) GetVGrowDirection() ControlGrowDirection {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_v_grow_direction")
+	methodBind := methodBindControlGetVGrowDirection.Get()
 
 	// Call the parent method.
 	// enum.Control::GrowDirection
@@ -1513,8 +1942,8 @@ func (o *Control) GetVGrowDirection() ControlGrowDirection {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Control) GetVSizeFlags() gdnative.Int {
 	//log.Println("Calling Control.GetVSizeFlags()")
@@ -1523,7 +1952,7 @@ func (o *Control) GetVSizeFlags() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "get_v_size_flags")
+	methodBind := methodBindControlGetVSizeFlags.Get()
 
 	// Call the parent method.
 	// int
@@ -1536,8 +1965,7 @@ func (o *Control) GetVSizeFlags() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *Control) GrabClickFocus() {
 	//log.Println("Calling Control.GrabClickFocus()")
@@ -1546,7 +1974,7 @@ func (o *Control) GrabClickFocus() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "grab_click_focus")
+	methodBind := methodBindControlGrabClickFocus.Get()
 
 	// Call the parent method.
 	// void
@@ -1556,8 +1984,8 @@ func (o *Control) GrabClickFocus() {
 }
 
 /*
-        Steal the focus from another control and become the focused control (see [method set_focus_mode]).
-	Args: [], Returns: void
+	        Steal the focus from another control and become the focused control (see [method set_focus_mode]).
+		Args: [], Returns: void
 */
 func (o *Control) GrabFocus() {
 	//log.Println("Calling Control.GrabFocus()")
@@ -1566,7 +1994,7 @@ func (o *Control) GrabFocus() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "grab_focus")
+	methodBind := methodBindControlGrabFocus.Get()
 
 	// Call the parent method.
 	// void
@@ -1576,8 +2004,7 @@ func (o *Control) GrabFocus() {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: bool
+Args: [{ false name String} { true type String}], Returns: bool
 */
 func (o *Control) HasColor(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasColor()")
@@ -1588,7 +2015,7 @@ func (o *Control) HasColor(name gdnative.String, aType gdnative.String) gdnative
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_color")
+	methodBind := methodBindControlHasColor.Get()
 
 	// Call the parent method.
 	// bool
@@ -1601,8 +2028,7 @@ func (o *Control) HasColor(name gdnative.String, aType gdnative.String) gdnative
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *Control) HasColorOverride(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasColorOverride()")
@@ -1612,7 +2038,7 @@ func (o *Control) HasColorOverride(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_color_override")
+	methodBind := methodBindControlHasColorOverride.Get()
 
 	// Call the parent method.
 	// bool
@@ -1625,8 +2051,7 @@ func (o *Control) HasColorOverride(name gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: bool
+Args: [{ false name String} { true type String}], Returns: bool
 */
 func (o *Control) HasConstant(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasConstant()")
@@ -1637,7 +2062,7 @@ func (o *Control) HasConstant(name gdnative.String, aType gdnative.String) gdnat
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_constant")
+	methodBind := methodBindControlHasConstant.Get()
 
 	// Call the parent method.
 	// bool
@@ -1650,8 +2075,7 @@ func (o *Control) HasConstant(name gdnative.String, aType gdnative.String) gdnat
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *Control) HasConstantOverride(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasConstantOverride()")
@@ -1661,7 +2085,7 @@ func (o *Control) HasConstantOverride(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_constant_override")
+	methodBind := methodBindControlHasConstantOverride.Get()
 
 	// Call the parent method.
 	// bool
@@ -1674,8 +2098,8 @@ func (o *Control) HasConstantOverride(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Return whether the Control is the current focused control (see [method set_focus_mode]).
-	Args: [], Returns: bool
+	        Return whether the Control is the current focused control (see [method set_focus_mode]).
+		Args: [], Returns: bool
 */
 func (o *Control) HasFocus() gdnative.Bool {
 	//log.Println("Calling Control.HasFocus()")
@@ -1684,7 +2108,7 @@ func (o *Control) HasFocus() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_focus")
+	methodBind := methodBindControlHasFocus.Get()
 
 	// Call the parent method.
 	// bool
@@ -1697,8 +2121,7 @@ func (o *Control) HasFocus() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: bool
+Args: [{ false name String} { true type String}], Returns: bool
 */
 func (o *Control) HasFont(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasFont()")
@@ -1709,7 +2132,7 @@ func (o *Control) HasFont(name gdnative.String, aType gdnative.String) gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_font")
+	methodBind := methodBindControlHasFont.Get()
 
 	// Call the parent method.
 	// bool
@@ -1722,8 +2145,7 @@ func (o *Control) HasFont(name gdnative.String, aType gdnative.String) gdnative.
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *Control) HasFontOverride(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasFontOverride()")
@@ -1733,7 +2155,7 @@ func (o *Control) HasFontOverride(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_font_override")
+	methodBind := methodBindControlHasFontOverride.Get()
 
 	// Call the parent method.
 	// bool
@@ -1746,8 +2168,7 @@ func (o *Control) HasFontOverride(name gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: bool
+Args: [{ false name String} { true type String}], Returns: bool
 */
 func (o *Control) HasIcon(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasIcon()")
@@ -1758,7 +2179,7 @@ func (o *Control) HasIcon(name gdnative.String, aType gdnative.String) gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_icon")
+	methodBind := methodBindControlHasIcon.Get()
 
 	// Call the parent method.
 	// bool
@@ -1771,8 +2192,7 @@ func (o *Control) HasIcon(name gdnative.String, aType gdnative.String) gdnative.
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *Control) HasIconOverride(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasIconOverride()")
@@ -1782,7 +2202,7 @@ func (o *Control) HasIconOverride(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_icon_override")
+	methodBind := methodBindControlHasIconOverride.Get()
 
 	// Call the parent method.
 	// bool
@@ -1795,8 +2215,7 @@ func (o *Control) HasIconOverride(name gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false point Vector2}], Returns: bool
+Args: [{ false point Vector2}], Returns: bool
 */
 func (o *Control) HasPoint(point gdnative.Vector2) gdnative.Bool {
 	//log.Println("Calling Control.HasPoint()")
@@ -1806,7 +2225,7 @@ func (o *Control) HasPoint(point gdnative.Vector2) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_point")
+	methodBind := methodBindControlHasPoint.Get()
 
 	// Call the parent method.
 	// bool
@@ -1819,8 +2238,7 @@ func (o *Control) HasPoint(point gdnative.Vector2) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *Control) HasShaderOverride(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasShaderOverride()")
@@ -1830,7 +2248,7 @@ func (o *Control) HasShaderOverride(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_shader_override")
+	methodBind := methodBindControlHasShaderOverride.Get()
 
 	// Call the parent method.
 	// bool
@@ -1843,8 +2261,7 @@ func (o *Control) HasShaderOverride(name gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false name String} { true type String}], Returns: bool
+Args: [{ false name String} { true type String}], Returns: bool
 */
 func (o *Control) HasStylebox(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasStylebox()")
@@ -1855,7 +2272,7 @@ func (o *Control) HasStylebox(name gdnative.String, aType gdnative.String) gdnat
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_stylebox")
+	methodBind := methodBindControlHasStylebox.Get()
 
 	// Call the parent method.
 	// bool
@@ -1868,8 +2285,7 @@ func (o *Control) HasStylebox(name gdnative.String, aType gdnative.String) gdnat
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *Control) HasStyleboxOverride(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Control.HasStyleboxOverride()")
@@ -1879,7 +2295,7 @@ func (o *Control) HasStyleboxOverride(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "has_stylebox_override")
+	methodBind := methodBindControlHasStyleboxOverride.Get()
 
 	// Call the parent method.
 	// bool
@@ -1892,8 +2308,8 @@ func (o *Control) HasStyleboxOverride(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Control) IsClippingContents() gdnative.Bool {
 	//log.Println("Calling Control.IsClippingContents()")
@@ -1902,7 +2318,7 @@ func (o *Control) IsClippingContents() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "is_clipping_contents")
+	methodBind := methodBindControlIsClippingContents.Get()
 
 	// Call the parent method.
 	// bool
@@ -1915,8 +2331,7 @@ func (o *Control) IsClippingContents() gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *Control) MinimumSizeChanged() {
 	//log.Println("Calling Control.MinimumSizeChanged()")
@@ -1925,7 +2340,7 @@ func (o *Control) MinimumSizeChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "minimum_size_changed")
+	methodBind := methodBindControlMinimumSizeChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -1935,8 +2350,8 @@ func (o *Control) MinimumSizeChanged() {
 }
 
 /*
-        Give up the focus, no other control will be able to receive keyboard input.
-	Args: [], Returns: void
+	        Give up the focus, no other control will be able to receive keyboard input.
+		Args: [], Returns: void
 */
 func (o *Control) ReleaseFocus() {
 	//log.Println("Calling Control.ReleaseFocus()")
@@ -1945,7 +2360,7 @@ func (o *Control) ReleaseFocus() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "release_focus")
+	methodBind := methodBindControlReleaseFocus.Get()
 
 	// Call the parent method.
 	// void
@@ -1955,8 +2370,7 @@ func (o *Control) ReleaseFocus() {
 }
 
 /*
-
-	Args: [{ false margin int} { false anchor float} {False true keep_margin bool} {True true push_opposite_anchor bool}], Returns: void
+Args: [{ false margin int} { false anchor float} {False true keep_margin bool} {True true push_opposite_anchor bool}], Returns: void
 */
 func (o *Control) SetAnchor(margin gdnative.Int, anchor gdnative.Real, keepMargin gdnative.Bool, pushOppositeAnchor gdnative.Bool) {
 	//log.Println("Calling Control.SetAnchor()")
@@ -1969,7 +2383,7 @@ func (o *Control) SetAnchor(margin gdnative.Int, anchor gdnative.Real, keepMargi
 	ptrArguments[3] = gdnative.NewPointerFromBool(pushOppositeAnchor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_anchor")
+	methodBind := methodBindControlSetAnchor.Get()
 
 	// Call the parent method.
 	// void
@@ -1979,8 +2393,7 @@ func (o *Control) SetAnchor(margin gdnative.Int, anchor gdnative.Real, keepMargi
 }
 
 /*
-
-	Args: [{ false margin int} { false anchor float} { false offset float} {False true push_opposite_anchor bool}], Returns: void
+Args: [{ false margin int} { false anchor float} { false offset float} {False true push_opposite_anchor bool}], Returns: void
 */
 func (o *Control) SetAnchorAndMargin(margin gdnative.Int, anchor gdnative.Real, offset gdnative.Real, pushOppositeAnchor gdnative.Bool) {
 	//log.Println("Calling Control.SetAnchorAndMargin()")
@@ -1993,7 +2406,7 @@ func (o *Control) SetAnchorAndMargin(margin gdnative.Int, anchor gdnative.Real,
 	ptrArguments[3] = gdnative.NewPointerFromBool(pushOppositeAnchor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_anchor_and_margin")
+	methodBind := methodBindControlSetAnchorAndMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -2003,8 +2416,7 @@ func (o *Control) SetAnchorAndMargin(margin gdnative.Int, anchor gdnative.Real,
 }
 
 /*
-
-	Args: [{ false preset int} {0 true resize_mode int} {0 true margin int}], Returns: void
+Args: [{ false preset int} {0 true resize_mode int} {0 true margin int}], Returns: void
 */
 func (o *Control) SetAnchorsAndMarginsPreset(preset gdnative.Int, resizeMode gdnative.Int, margin gdnative.Int) {
 	//log.Println("Calling Control.SetAnchorsAndMarginsPreset()")
@@ -2016,7 +2428,7 @@ func (o *Control) SetAnchorsAndMarginsPreset(preset gdnative.Int, resizeMode gdn
 	ptrArguments[2] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_anchors_and_margins_preset")
+	methodBind := methodBindControlSetAnchorsAndMarginsPreset.Get()
 
 	// Call the parent method.
 	// void
@@ -2026,8 +2438,7 @@ func (o *Control) SetAnchorsAndMarginsPreset(preset gdnative.Int, resizeMode gdn
 }
 
 /*
-
-	Args: [{ false preset int} {False true keep_margin bool}], Returns: void
+Args: [{ false preset int} {False true keep_margin bool}], Returns: void
 */
 func (o *Control) SetAnchorsPreset(preset gdnative.Int, keepMargin gdnative.Bool) {
 	//log.Println("Calling Control.SetAnchorsPreset()")
@@ -2038,7 +2449,7 @@ func (o *Control) SetAnchorsPreset(preset gdnative.Int, keepMargin gdnative.Bool
 	ptrArguments[1] = gdnative.NewPointerFromBool(keepMargin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_anchors_preset")
+	methodBind := methodBindControlSetAnchorsPreset.Get()
 
 	// Call the parent method.
 	// void
@@ -2048,8 +2459,8 @@ func (o *Control) SetAnchorsPreset(preset gdnative.Int, keepMargin gdnative.Bool
 }
 
 /*
-        Sets MARGIN_LEFT and MARGIN_TOP at the same time. This is a helper (see [method set_margin]).
-	Args: [{ false position Vector2}], Returns: void
+	        Sets MARGIN_LEFT and MARGIN_TOP at the same time. This is a helper (see [method set_margin]).
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *Control) SetBegin(position gdnative.Vector2) {
 	//log.Println("Calling Control.SetBegin()")
@@ -2059,7 +2470,7 @@ func (o *Control) SetBegin(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_begin")
+	methodBind := methodBindControlSetBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -2069,8 +2480,8 @@ func (o *Control) SetBegin(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Control) SetClipContents(enable gdnative.Bool) {
 	//log.Println("Calling Control.SetClipContents()")
@@ -2080,7 +2491,7 @@ func (o *Control) SetClipContents(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_clip_contents")
+	methodBind := methodBindControlSetClipContents.Get()
 
 	// Call the parent method.
 	// void
@@ -2090,8 +2501,8 @@ func (o *Control) SetClipContents(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *Control) SetCustomMinimumSize(size gdnative.Vector2) {
 	//log.Println("Calling Control.SetCustomMinimumSize()")
@@ -2101,7 +2512,7 @@ func (o *Control) SetCustomMinimumSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_custom_minimum_size")
+	methodBind := methodBindControlSetCustomMinimumSize.Get()
 
 	// Call the parent method.
 	// void
@@ -2111,18 +2522,18 @@ func (o *Control) SetCustomMinimumSize(size gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false shape int}], Returns: void
+	        Undocumented
+		Args: [{ false shape enum.Control::CursorShape}], Returns: void
 */
-func (o *Control) SetDefaultCursorShape(shape gdnative.Int) {
+func (o *Control) SetDefaultCursorShape(shape ControlCursorShape) {
 	//log.Println("Calling Control.SetDefaultCursorShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(shape)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_default_cursor_shape")
+	methodBind := methodBindControlSetDefaultCursorShape.Get()
 
 	// Call the parent method.
 	// void
@@ -2132,18 +2543,18 @@ func (o *Control) SetDefaultCursorShape(shape gdnative.Int) {
 }
 
 /*
-        Forwards the handling of this control's drag and drop to [code]target[/code] control. Forwarding can be implemented in the target control similar to the methods [method get_drag_data], [method can_drop_data], and [method drop_data] but with two differences: 1. The function name must be suffixed with [b]_fw[/b] 2. The function must take an extra argument that is the control doing the forwarding [codeblock] # ThisControl.gd extends Control func _ready(): set_drag_forwarding(target_control) # TargetControl.gd extends Control func can_drop_data_fw(position, data, from_control): return true func drop_data_fw(position, data, from_control): my_handle_data(data) func get_drag_data_fw(position, from_control): set_drag_preview(my_preview) return my_data() [/codeblock]
-	Args: [{ false target Object}], Returns: void
+	        Forwards the handling of this control's drag and drop to [code]target[/code] control. Forwarding can be implemented in the target control similar to the methods [method get_drag_data], [method can_drop_data], and [method drop_data] but with two differences: 1. The function name must be suffixed with [b]_fw[/b] 2. The function must take an extra argument that is the control doing the forwarding [codeblock] # ThisControl.gd extends Control func _ready(): set_drag_forwarding(target_control) # TargetControl.gd extends Control func can_drop_data_fw(position, data, from_control): return true func drop_data_fw(position, data, from_control): my_handle_data(data) func get_drag_data_fw(position, from_control): set_drag_preview(my_preview) return my_data() [/codeblock]
+		Args: [{ false target Object}], Returns: void
 */
 func (o *Control) SetDragForwarding(target ObjectImplementer) {
 	//log.Println("Calling Control.SetDragForwarding()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(target.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(target))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_drag_forwarding")
+	methodBind := methodBindControlSetDragForwarding.Get()
 
 	// Call the parent method.
 	// void
@@ -2153,18 +2564,18 @@ func (o *Control) SetDragForwarding(target ObjectImplementer) {
 }
 
 /*
-        Shows the given control at the mouse pointer. A good time to call this method is in [method get_drag_data].
-	Args: [{ false control Object}], Returns: void
+	        Shows the given control at the mouse pointer. A good time to call this method is in [method get_drag_data].
+		Args: [{ false control Object}], Returns: void
 */
 func (o *Control) SetDragPreview(control ObjectImplementer) {
 	//log.Println("Calling Control.SetDragPreview()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(control.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(control))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_drag_preview")
+	methodBind := methodBindControlSetDragPreview.Get()
 
 	// Call the parent method.
 	// void
@@ -2174,8 +2585,8 @@ func (o *Control) SetDragPreview(control ObjectImplementer) {
 }
 
 /*
-        Sets MARGIN_RIGHT and MARGIN_BOTTOM at the same time. This is a helper (see [method set_margin]).
-	Args: [{ false position Vector2}], Returns: void
+	        Sets MARGIN_RIGHT and MARGIN_BOTTOM at the same time. This is a helper (see [method set_margin]).
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *Control) SetEnd(position gdnative.Vector2) {
 	//log.Println("Calling Control.SetEnd()")
@@ -2185,7 +2596,7 @@ func (o *Control) SetEnd(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_end")
+	methodBind := methodBindControlSetEnd.Get()
 
 	// Call the parent method.
 	// void
@@ -2195,18 +2606,18 @@ func (o *Control) SetEnd(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Control::FocusMode}], Returns: void
 */
-func (o *Control) SetFocusMode(mode gdnative.Int) {
+func (o *Control) SetFocusMode(mode ControlFocusMode) {
 	//log.Println("Calling Control.SetFocusMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_focus_mode")
+	methodBind := methodBindControlSetFocusMode.Get()
 
 	// Call the parent method.
 	// void
@@ -2216,8 +2627,8 @@ func (o *Control) SetFocusMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false neighbour NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false neighbour NodePath}], Returns: void
 */
 func (o *Control) SetFocusNeighbour(margin gdnative.Int, neighbour gdnative.NodePath) {
 	//log.Println("Calling Control.SetFocusNeighbour()")
@@ -2228,7 +2639,7 @@ func (o *Control) SetFocusNeighbour(margin gdnative.Int, neighbour gdnative.Node
 	ptrArguments[1] = gdnative.NewPointerFromNodePath(neighbour)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_focus_neighbour")
+	methodBind := methodBindControlSetFocusNeighbour.Get()
 
 	// Call the parent method.
 	// void
@@ -2238,8 +2649,8 @@ func (o *Control) SetFocusNeighbour(margin gdnative.Int, neighbour gdnative.Node
 }
 
 /*
-        Undocumented
-	Args: [{ false next NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false next NodePath}], Returns: void
 */
 func (o *Control) SetFocusNext(next gdnative.NodePath) {
 	//log.Println("Calling Control.SetFocusNext()")
@@ -2249,7 +2660,7 @@ func (o *Control) SetFocusNext(next gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(next)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_focus_next")
+	methodBind := methodBindControlSetFocusNext.Get()
 
 	// Call the parent method.
 	// void
@@ -2259,8 +2670,8 @@ func (o *Control) SetFocusNext(next gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false previous NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false previous NodePath}], Returns: void
 */
 func (o *Control) SetFocusPrevious(previous gdnative.NodePath) {
 	//log.Println("Calling Control.SetFocusPrevious()")
@@ -2270,7 +2681,7 @@ func (o *Control) SetFocusPrevious(previous gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(previous)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_focus_previous")
+	methodBind := methodBindControlSetFocusPrevious.Get()
 
 	// Call the parent method.
 	// void
@@ -2280,8 +2691,8 @@ func (o *Control) SetFocusPrevious(previous gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *Control) SetGlobalPosition(position gdnative.Vector2) {
 	//log.Println("Calling Control.SetGlobalPosition()")
@@ -2291,7 +2702,7 @@ func (o *Control) SetGlobalPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_global_position")
+	methodBind := methodBindControlSetGlobalPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -2301,18 +2712,18 @@ func (o *Control) SetGlobalPosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false direction int}], Returns: void
+	        Undocumented
+		Args: [{ false direction enum.Control::GrowDirection}], Returns: void
 */
-func (o *Control) SetHGrowDirection(direction gdnative.Int) {
+func (o *Control) SetHGrowDirection(direction ControlGrowDirection) {
 	//log.Println("Calling Control.SetHGrowDirection()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(direction)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(direction))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_h_grow_direction")
+	methodBind := methodBindControlSetHGrowDirection.Get()
 
 	// Call the parent method.
 	// void
@@ -2322,8 +2733,8 @@ func (o *Control) SetHGrowDirection(direction gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flags int}], Returns: void
+	        Undocumented
+		Args: [{ false flags int}], Returns: void
 */
 func (o *Control) SetHSizeFlags(flags gdnative.Int) {
 	//log.Println("Calling Control.SetHSizeFlags()")
@@ -2333,7 +2744,7 @@ func (o *Control) SetHSizeFlags(flags gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_h_size_flags")
+	methodBind := methodBindControlSetHSizeFlags.Get()
 
 	// Call the parent method.
 	// void
@@ -2343,8 +2754,8 @@ func (o *Control) SetHSizeFlags(flags gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false offset float}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false offset float}], Returns: void
 */
 func (o *Control) SetMargin(margin gdnative.Int, offset gdnative.Real) {
 	//log.Println("Calling Control.SetMargin()")
@@ -2355,7 +2766,7 @@ func (o *Control) SetMargin(margin gdnative.Int, offset gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_margin")
+	methodBind := methodBindControlSetMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -2365,8 +2776,7 @@ func (o *Control) SetMargin(margin gdnative.Int, offset gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false preset int} {0 true resize_mode int} {0 true margin int}], Returns: void
+Args: [{ false preset int} {0 true resize_mode int} {0 true margin int}], Returns: void
 */
 func (o *Control) SetMarginsPreset(preset gdnative.Int, resizeMode gdnative.Int, margin gdnative.Int) {
 	//log.Println("Calling Control.SetMarginsPreset()")
@@ -2378,7 +2788,7 @@ func (o *Control) SetMarginsPreset(preset gdnative.Int, resizeMode gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_margins_preset")
+	methodBind := methodBindControlSetMarginsPreset.Get()
 
 	// Call the parent method.
 	// void
@@ -2388,18 +2798,18 @@ func (o *Control) SetMarginsPreset(preset gdnative.Int, resizeMode gdnative.Int,
 }
 
 /*
-        Undocumented
-	Args: [{ false filter int}], Returns: void
+	        Undocumented
+		Args: [{ false filter enum.Control::MouseFilter}], Returns: void
 */
-func (o *Control) SetMouseFilter(filter gdnative.Int) {
+func (o *Control) SetMouseFilter(filter ControlMouseFilter) {
 	//log.Println("Calling Control.SetMouseFilter()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(filter)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(filter))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_mouse_filter")
+	methodBind := methodBindControlSetMouseFilter.Get()
 
 	// Call the parent method.
 	// void
@@ -2409,8 +2819,8 @@ func (o *Control) SetMouseFilter(filter gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pivot_offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false pivot_offset Vector2}], Returns: void
 */
 func (o *Control) SetPivotOffset(pivotOffset gdnative.Vector2) {
 	//log.Println("Calling Control.SetPivotOffset()")
@@ -2420,7 +2830,7 @@ func (o *Control) SetPivotOffset(pivotOffset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(pivotOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_pivot_offset")
+	methodBind := methodBindControlSetPivotOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -2430,8 +2840,8 @@ func (o *Control) SetPivotOffset(pivotOffset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *Control) SetPosition(position gdnative.Vector2) {
 	//log.Println("Calling Control.SetPosition()")
@@ -2441,7 +2851,7 @@ func (o *Control) SetPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_position")
+	methodBind := methodBindControlSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -2451,8 +2861,8 @@ func (o *Control) SetPosition(position gdnative.Vector2) {
 }
 
 /*
-        Set the rotation (in radians).
-	Args: [{ false radians float}], Returns: void
+	        Set the rotation (in radians).
+		Args: [{ false radians float}], Returns: void
 */
 func (o *Control) SetRotation(radians gdnative.Real) {
 	//log.Println("Calling Control.SetRotation()")
@@ -2462,7 +2872,7 @@ func (o *Control) SetRotation(radians gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radians)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_rotation")
+	methodBind := methodBindControlSetRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -2472,8 +2882,8 @@ func (o *Control) SetRotation(radians gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *Control) SetRotationDegrees(degrees gdnative.Real) {
 	//log.Println("Calling Control.SetRotationDegrees()")
@@ -2483,7 +2893,7 @@ func (o *Control) SetRotationDegrees(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_rotation_degrees")
+	methodBind := methodBindControlSetRotationDegrees.Get()
 
 	// Call the parent method.
 	// void
@@ -2493,8 +2903,8 @@ func (o *Control) SetRotationDegrees(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false scale Vector2}], Returns: void
 */
 func (o *Control) SetScale(scale gdnative.Vector2) {
 	//log.Println("Calling Control.SetScale()")
@@ -2504,7 +2914,7 @@ func (o *Control) SetScale(scale gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_scale")
+	methodBind := methodBindControlSetScale.Get()
 
 	// Call the parent method.
 	// void
@@ -2514,8 +2924,8 @@ func (o *Control) SetScale(scale gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *Control) SetSize(size gdnative.Vector2) {
 	//log.Println("Calling Control.SetSize()")
@@ -2525,7 +2935,7 @@ func (o *Control) SetSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_size")
+	methodBind := methodBindControlSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -2535,8 +2945,8 @@ func (o *Control) SetSize(size gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ratio float}], Returns: void
+	        Undocumented
+		Args: [{ false ratio float}], Returns: void
 */
 func (o *Control) SetStretchRatio(ratio gdnative.Real) {
 	//log.Println("Calling Control.SetStretchRatio()")
@@ -2546,7 +2956,7 @@ func (o *Control) SetStretchRatio(ratio gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_stretch_ratio")
+	methodBind := methodBindControlSetStretchRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -2556,18 +2966,18 @@ func (o *Control) SetStretchRatio(ratio gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false theme Theme}], Returns: void
+	        Undocumented
+		Args: [{ false theme Theme}], Returns: void
 */
 func (o *Control) SetTheme(theme ThemeImplementer) {
 	//log.Println("Calling Control.SetTheme()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(theme.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(theme))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_theme")
+	methodBind := methodBindControlSetTheme.Get()
 
 	// Call the parent method.
 	// void
@@ -2577,8 +2987,8 @@ func (o *Control) SetTheme(theme ThemeImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false tooltip String}], Returns: void
+	        Undocumented
+		Args: [{ false tooltip String}], Returns: void
 */
 func (o *Control) SetTooltip(tooltip gdnative.String) {
 	//log.Println("Calling Control.SetTooltip()")
@@ -2588,7 +2998,7 @@ func (o *Control) SetTooltip(tooltip gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(tooltip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_tooltip")
+	methodBind := methodBindControlSetTooltip.Get()
 
 	// Call the parent method.
 	// void
@@ -2598,18 +3008,18 @@ func (o *Control) SetTooltip(tooltip gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false direction int}], Returns: void
+	        Undocumented
+		Args: [{ false direction enum.Control::GrowDirection}], Returns: void
 */
-func (o *Control) SetVGrowDirection(direction gdnative.Int) {
+func (o *Control) SetVGrowDirection(direction ControlGrowDirection) {
 	//log.Println("Calling Control.SetVGrowDirection()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(direction)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(direction))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_v_grow_direction")
+	methodBind := methodBindControlSetVGrowDirection.Get()
 
 	// Call the parent method.
 	// void
@@ -2619,8 +3029,8 @@ func (o *Control) SetVGrowDirection(direction gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flags int}], Returns: void
+	        Undocumented
+		Args: [{ false flags int}], Returns: void
 */
 func (o *Control) SetVSizeFlags(flags gdnative.Int) {
 	//log.Println("Calling Control.SetVSizeFlags()")
@@ -2630,7 +3040,7 @@ func (o *Control) SetVSizeFlags(flags gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "set_v_size_flags")
+	methodBind := methodBindControlSetVSizeFlags.Get()
 
 	// Call the parent method.
 	// void
@@ -2640,8 +3050,8 @@ func (o *Control) SetVSizeFlags(flags gdnative.Int) {
 }
 
 /*
-        Display a Control as modal. Control must be a subwindow. Modal controls capture the input signals until closed or the area outside them is accessed. When a modal control loses focus, or the ESC key is pressed, they automatically hide. Modal controls are used extensively for popup dialogs and menus.
-	Args: [{False true exclusive bool}], Returns: void
+	        Display a Control as modal. Control must be a subwindow. Modal controls capture the input signals until closed or the area outside them is accessed. When a modal control loses focus, or the ESC key is pressed, they automatically hide. Modal controls are used extensively for popup dialogs and menus.
+		Args: [{False true exclusive bool}], Returns: void
 */
 func (o *Control) ShowModal(exclusive gdnative.Bool) {
 	//log.Println("Calling Control.ShowModal()")
@@ -2651,7 +3061,7 @@ func (o *Control) ShowModal(exclusive gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(exclusive)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "show_modal")
+	methodBind := methodBindControlShowModal.Get()
 
 	// Call the parent method.
 	// void
@@ -2661,8 +3071,7 @@ func (o *Control) ShowModal(exclusive gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false to_position Vector2}], Returns: void
+Args: [{ false to_position Vector2}], Returns: void
 */
 func (o *Control) WarpMouse(toPosition gdnative.Vector2) {
 	//log.Println("Calling Control.WarpMouse()")
@@ -2672,7 +3081,7 @@ func (o *Control) WarpMouse(toPosition gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Control", "warp_mouse")
+	methodBind := methodBindControlWarpMouse.Get()
 
 	// Call the parent method.
 	// void
@@ -2681,6 +3090,555 @@ func (o *Control) WarpMouse(toPosition gdnative.Vector2) {
 
 }
 
+// GetColorWithDefaults will call GetColor using the default values for: type.
+func (o *Control) GetColorWithDefaults(name gdnative.String) gdnative.Color {
+	return o.GetColor(name, "")
+}
+
+// GetConstantWithDefaults will call GetConstant using the default values for: type.
+func (o *Control) GetConstantWithDefaults(name gdnative.String) gdnative.Int {
+	return o.GetConstant(name, "")
+}
+
+// GetCursorShapeWithDefaults will call GetCursorShape using the default values for: position.
+func (o *Control) GetCursorShapeWithDefaults() ControlCursorShape {
+	return o.GetCursorShape(gdnative.NewVector2(0, 0))
+}
+
+// GetFontWithDefaults will call GetFont using the default values for: type.
+func (o *Control) GetFontWithDefaults(name gdnative.String) FontImplementer {
+	return o.GetFont(name, "")
+}
+
+// GetIconWithDefaults will call GetIcon using the default values for: type.
+func (o *Control) GetIconWithDefaults(name gdnative.String) TextureImplementer {
+	return o.GetIcon(name, "")
+}
+
+// GetStyleboxWithDefaults will call GetStylebox using the default values for: type.
+func (o *Control) GetStyleboxWithDefaults(name gdnative.String) StyleBoxImplementer {
+	return o.GetStylebox(name, "")
+}
+
+// GetTooltipWithDefaults will call GetTooltip using the default values for: at_position.
+func (o *Control) GetTooltipWithDefaults() gdnative.String {
+	return o.GetTooltip(gdnative.NewVector2(0, 0))
+}
+
+// HasColorWithDefaults will call HasColor using the default values for: type.
+func (o *Control) HasColorWithDefaults(name gdnative.String) gdnative.Bool {
+	return o.HasColor(name, "")
+}
+
+// HasConstantWithDefaults will call HasConstant using the default values for: type.
+func (o *Control) HasConstantWithDefaults(name gdnative.String) gdnative.Bool {
+	return o.HasConstant(name, "")
+}
+
+// HasFontWithDefaults will call HasFont using the default values for: type.
+func (o *Control) HasFontWithDefaults(name gdnative.String) gdnative.Bool {
+	return o.HasFont(name, "")
+}
+
+// HasIconWithDefaults will call HasIcon using the default values for: type.
+func (o *Control) HasIconWithDefaults(name gdnative.String) gdnative.Bool {
+	return o.HasIcon(name, "")
+}
+
+// HasStyleboxWithDefaults will call HasStylebox using the default values for: type.
+func (o *Control) HasStyleboxWithDefaults(name gdnative.String) gdnative.Bool {
+	return o.HasStylebox(name, "")
+}
+
+// SetAnchorWithDefaults will call SetAnchor using the default values for: keep_margin, push_opposite_anchor.
+func (o *Control) SetAnchorWithDefaults(margin gdnative.Int, anchor gdnative.Real) {
+	o.SetAnchor(margin, anchor, false, true)
+}
+
+// SetAnchorAndMarginWithDefaults will call SetAnchorAndMargin using the default values for: push_opposite_anchor.
+func (o *Control) SetAnchorAndMarginWithDefaults(margin gdnative.Int, anchor gdnative.Real, offset gdnative.Real) {
+	o.SetAnchorAndMargin(margin, anchor, offset, false)
+}
+
+// SetAnchorsAndMarginsPresetWithDefaults will call SetAnchorsAndMarginsPreset using the default values for: resize_mode, margin.
+func (o *Control) SetAnchorsAndMarginsPresetWithDefaults(preset gdnative.Int) {
+	o.SetAnchorsAndMarginsPreset(preset, 0, 0)
+}
+
+// SetAnchorsPresetWithDefaults will call SetAnchorsPreset using the default values for: keep_margin.
+func (o *Control) SetAnchorsPresetWithDefaults(preset gdnative.Int) {
+	o.SetAnchorsPreset(preset, false)
+}
+
+// SetMarginsPresetWithDefaults will call SetMarginsPreset using the default values for: resize_mode, margin.
+func (o *Control) SetMarginsPresetWithDefaults(preset gdnative.Int) {
+	o.SetMarginsPreset(preset, 0, 0)
+}
+
+// ShowModalWithDefaults will call ShowModal using the default values for: exclusive.
+func (o *Control) ShowModalWithDefaults() {
+	o.ShowModal(false)
+}
+
+// AnchorBottom will return the value of the "anchor_bottom" property.
+func (o *Control) AnchorBottom() gdnative.Real {
+	return o.GetAnchor(3)
+}
+
+// SetAnchorBottom will set the value of the "anchor_bottom" property.
+func (o *Control) SetAnchorBottom(value gdnative.Real) {
+	o.X_SetAnchor(3, value)
+}
+
+// AnchorLeft will return the value of the "anchor_left" property.
+func (o *Control) AnchorLeft() gdnative.Real {
+	return o.GetAnchor(0)
+}
+
+// SetAnchorLeft will set the value of the "anchor_left" property.
+func (o *Control) SetAnchorLeft(value gdnative.Real) {
+	o.X_SetAnchor(0, value)
+}
+
+// AnchorRight will return the value of the "anchor_right" property.
+func (o *Control) AnchorRight() gdnative.Real {
+	return o.GetAnchor(2)
+}
+
+// SetAnchorRight will set the value of the "anchor_right" property.
+func (o *Control) SetAnchorRight(value gdnative.Real) {
+	o.X_SetAnchor(2, value)
+}
+
+// AnchorTop will return the value of the "anchor_top" property.
+func (o *Control) AnchorTop() gdnative.Real {
+	return o.GetAnchor(1)
+}
+
+// SetAnchorTop will set the value of the "anchor_top" property.
+func (o *Control) SetAnchorTop(value gdnative.Real) {
+	o.X_SetAnchor(1, value)
+}
+
+// FocusMode will return the value of the "focus_mode" property.
+func (o *Control) FocusMode() ControlFocusMode {
+	return o.GetFocusMode()
+}
+
+// FocusNeighbourBottom will return the value of the "focus_neighbour_bottom" property.
+func (o *Control) FocusNeighbourBottom() gdnative.NodePath {
+	return o.GetFocusNeighbour(3)
+}
+
+// SetFocusNeighbourBottom will set the value of the "focus_neighbour_bottom" property.
+func (o *Control) SetFocusNeighbourBottom(value gdnative.NodePath) {
+	o.SetFocusNeighbour(3, value)
+}
+
+// FocusNeighbourLeft will return the value of the "focus_neighbour_left" property.
+func (o *Control) FocusNeighbourLeft() gdnative.NodePath {
+	return o.GetFocusNeighbour(0)
+}
+
+// SetFocusNeighbourLeft will set the value of the "focus_neighbour_left" property.
+func (o *Control) SetFocusNeighbourLeft(value gdnative.NodePath) {
+	o.SetFocusNeighbour(0, value)
+}
+
+// FocusNeighbourRight will return the value of the "focus_neighbour_right" property.
+func (o *Control) FocusNeighbourRight() gdnative.NodePath {
+	return o.GetFocusNeighbour(2)
+}
+
+// SetFocusNeighbourRight will set the value of the "focus_neighbour_right" property.
+func (o *Control) SetFocusNeighbourRight(value gdnative.NodePath) {
+	o.SetFocusNeighbour(2, value)
+}
+
+// FocusNeighbourTop will return the value of the "focus_neighbour_top" property.
+func (o *Control) FocusNeighbourTop() gdnative.NodePath {
+	return o.GetFocusNeighbour(1)
+}
+
+// SetFocusNeighbourTop will set the value of the "focus_neighbour_top" property.
+func (o *Control) SetFocusNeighbourTop(value gdnative.NodePath) {
+	o.SetFocusNeighbour(1, value)
+}
+
+// FocusNext will return the value of the "focus_next" property.
+func (o *Control) FocusNext() gdnative.NodePath {
+	return o.GetFocusNext()
+}
+
+// FocusPrevious will return the value of the "focus_previous" property.
+func (o *Control) FocusPrevious() gdnative.NodePath {
+	return o.GetFocusPrevious()
+}
+
+// GrowHorizontal will return the value of the "grow_horizontal" property.
+func (o *Control) GrowHorizontal() ControlGrowDirection {
+	return o.GetHGrowDirection()
+}
+
+// SetGrowHorizontal will set the value of the "grow_horizontal" property.
+func (o *Control) SetGrowHorizontal(value ControlGrowDirection) {
+	o.SetHGrowDirection(value)
+}
+
+// GrowVertical will return the value of the "grow_vertical" property.
+func (o *Control) GrowVertical() ControlGrowDirection {
+	return o.GetVGrowDirection()
+}
+
+// SetGrowVertical will set the value of the "grow_vertical" property.
+func (o *Control) SetGrowVertical(value ControlGrowDirection) {
+	o.SetVGrowDirection(value)
+}
+
+// HintTooltip will return the value of the "hint_tooltip" property.
+func (o *Control) HintTooltip() gdnative.String {
+	return o.X_GetTooltip()
+}
+
+// SetHintTooltip will set the value of the "hint_tooltip" property.
+func (o *Control) SetHintTooltip(value gdnative.String) {
+	o.SetTooltip(value)
+}
+
+// MarginBottom will return the value of the "margin_bottom" property.
+func (o *Control) MarginBottom() gdnative.Real {
+	return o.GetMargin(3)
+}
+
+// SetMarginBottom will set the value of the "margin_bottom" property.
+func (o *Control) SetMarginBottom(value gdnative.Real) {
+	o.SetMargin(3, value)
+}
+
+// MarginLeft will return the value of the "margin_left" property.
+func (o *Control) MarginLeft() gdnative.Real {
+	return o.GetMargin(0)
+}
+
+// SetMarginLeft will set the value of the "margin_left" property.
+func (o *Control) SetMarginLeft(value gdnative.Real) {
+	o.SetMargin(0, value)
+}
+
+// MarginRight will return the value of the "margin_right" property.
+func (o *Control) MarginRight() gdnative.Real {
+	return o.GetMargin(2)
+}
+
+// SetMarginRight will set the value of the "margin_right" property.
+func (o *Control) SetMarginRight(value gdnative.Real) {
+	o.SetMargin(2, value)
+}
+
+// MarginTop will return the value of the "margin_top" property.
+func (o *Control) MarginTop() gdnative.Real {
+	return o.GetMargin(1)
+}
+
+// SetMarginTop will set the value of the "margin_top" property.
+func (o *Control) SetMarginTop(value gdnative.Real) {
+	o.SetMargin(1, value)
+}
+
+// MouseDefaultCursorShape will return the value of the "mouse_default_cursor_shape" property.
+func (o *Control) MouseDefaultCursorShape() ControlCursorShape {
+	return o.GetDefaultCursorShape()
+}
+
+// SetMouseDefaultCursorShape will set the value of the "mouse_default_cursor_shape" property.
+func (o *Control) SetMouseDefaultCursorShape(value ControlCursorShape) {
+	o.SetDefaultCursorShape(value)
+}
+
+// MouseFilter will return the value of the "mouse_filter" property.
+func (o *Control) MouseFilter() ControlMouseFilter {
+	return o.GetMouseFilter()
+}
+
+// RectClipContent will return the value of the "rect_clip_content" property.
+func (o *Control) RectClipContent() gdnative.Bool {
+	return o.IsClippingContents()
+}
+
+// SetRectClipContent will set the value of the "rect_clip_content" property.
+func (o *Control) SetRectClipContent(value gdnative.Bool) {
+	o.SetClipContents(value)
+}
+
+// RectGlobalPosition will return the value of the "rect_global_position" property.
+func (o *Control) RectGlobalPosition() gdnative.Vector2 {
+	return o.GetGlobalPosition()
+}
+
+// SetRectGlobalPosition will set the value of the "rect_global_position" property.
+func (o *Control) SetRectGlobalPosition(value gdnative.Vector2) {
+	o.SetGlobalPosition(value)
+}
+
+// RectMinSize will return the value of the "rect_min_size" property.
+func (o *Control) RectMinSize() gdnative.Vector2 {
+	return o.GetCustomMinimumSize()
+}
+
+// SetRectMinSize will set the value of the "rect_min_size" property.
+func (o *Control) SetRectMinSize(value gdnative.Vector2) {
+	o.SetCustomMinimumSize(value)
+}
+
+// RectPivotOffset will return the value of the "rect_pivot_offset" property.
+func (o *Control) RectPivotOffset() gdnative.Vector2 {
+	return o.GetPivotOffset()
+}
+
+// SetRectPivotOffset will set the value of the "rect_pivot_offset" property.
+func (o *Control) SetRectPivotOffset(value gdnative.Vector2) {
+	o.SetPivotOffset(value)
+}
+
+// RectPosition will return the value of the "rect_position" property.
+func (o *Control) RectPosition() gdnative.Vector2 {
+	return o.GetPosition()
+}
+
+// SetRectPosition will set the value of the "rect_position" property.
+func (o *Control) SetRectPosition(value gdnative.Vector2) {
+	o.SetPosition(value)
+}
+
+// RectRotation will return the value of the "rect_rotation" property.
+func (o *Control) RectRotation() gdnative.Real {
+	return o.GetRotationDegrees()
+}
+
+// SetRectRotation will set the value of the "rect_rotation" property.
+func (o *Control) SetRectRotation(value gdnative.Real) {
+	o.SetRotationDegrees(value)
+}
+
+// RectScale will return the value of the "rect_scale" property.
+func (o *Control) RectScale() gdnative.Vector2 {
+	return o.GetScale()
+}
+
+// SetRectScale will set the value of the "rect_scale" property.
+func (o *Control) SetRectScale(value gdnative.Vector2) {
+	o.SetScale(value)
+}
+
+// RectSize will return the value of the "rect_size" property.
+func (o *Control) RectSize() gdnative.Vector2 {
+	return o.GetSize()
+}
+
+// SetRectSize will set the value of the "rect_size" property.
+func (o *Control) SetRectSize(value gdnative.Vector2) {
+	o.SetSize(value)
+}
+
+// SizeFlagsHorizontal will return the value of the "size_flags_horizontal" property.
+func (o *Control) SizeFlagsHorizontal() gdnative.Int {
+	return o.GetHSizeFlags()
+}
+
+// SetSizeFlagsHorizontal will set the value of the "size_flags_horizontal" property.
+func (o *Control) SetSizeFlagsHorizontal(value gdnative.Int) {
+	o.SetHSizeFlags(value)
+}
+
+// SizeFlagsStretchRatio will return the value of the "size_flags_stretch_ratio" property.
+func (o *Control) SizeFlagsStretchRatio() gdnative.Real {
+	return o.GetStretchRatio()
+}
+
+// SetSizeFlagsStretchRatio will set the value of the "size_flags_stretch_ratio" property.
+func (o *Control) SetSizeFlagsStretchRatio(value gdnative.Real) {
+	o.SetStretchRatio(value)
+}
+
+// SizeFlagsVertical will return the value of the "size_flags_vertical" property.
+func (o *Control) SizeFlagsVertical() gdnative.Int {
+	return o.GetVSizeFlags()
+}
+
+// SetSizeFlagsVertical will set the value of the "size_flags_vertical" property.
+func (o *Control) SetSizeFlagsVertical(value gdnative.Int) {
+	o.SetVSizeFlags(value)
+}
+
+// Theme will return the value of the "theme" property.
+func (o *Control) Theme() ThemeImplementer {
+	return o.GetTheme()
+}
+
+// Signals of the Control class.
+const (
+	ControlSignalFocusEntered       gdnative.String = "focus_entered"
+	ControlSignalFocusExited        gdnative.String = "focus_exited"
+	ControlSignalGuiInput           gdnative.String = "gui_input"
+	ControlSignalMinimumSizeChanged gdnative.String = "minimum_size_changed"
+	ControlSignalModalClosed        gdnative.String = "modal_closed"
+	ControlSignalMouseEntered       gdnative.String = "mouse_entered"
+	ControlSignalMouseExited        gdnative.String = "mouse_exited"
+	ControlSignalResized            gdnative.String = "resized"
+	ControlSignalSizeFlagsChanged   gdnative.String = "size_flags_changed"
+)
+
+// ConnectFocusEntered will connect the "focus_entered" signal to the given method of the target object.
+func (o *Control) ConnectFocusEntered(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalFocusEntered, target, method, binds, 0)
+}
+
+// EmitFocusEntered will emit the "focus_entered" signal.
+func (o *Control) EmitFocusEntered() error {
+
+	ret, err := o.EmitSignal(ControlSignalFocusEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectFocusExited will connect the "focus_exited" signal to the given method of the target object.
+func (o *Control) ConnectFocusExited(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalFocusExited, target, method, binds, 0)
+}
+
+// EmitFocusExited will emit the "focus_exited" signal.
+func (o *Control) EmitFocusExited() error {
+
+	ret, err := o.EmitSignal(ControlSignalFocusExited)
+	ret.Destroy()
+	return err
+}
+
+// ControlGuiInputArgs holds the arguments of the "gui_input" signal.
+type ControlGuiInputArgs struct {
+	Ev InputEventImplementer
+}
+
+// ConnectGuiInput will connect the "gui_input" signal to the given method of the target object.
+func (o *Control) ConnectGuiInput(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalGuiInput, target, method, binds, 0)
+}
+
+// EmitGuiInput will emit the "gui_input" signal.
+func (o *Control) EmitGuiInput(args ControlGuiInputArgs) error {
+	arg0 := gdnative.NewVariantObject(GetBaseObject(args.Ev))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ControlSignalGuiInput, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectMinimumSizeChanged will connect the "minimum_size_changed" signal to the given method of the target object.
+func (o *Control) ConnectMinimumSizeChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalMinimumSizeChanged, target, method, binds, 0)
+}
+
+// EmitMinimumSizeChanged will emit the "minimum_size_changed" signal.
+func (o *Control) EmitMinimumSizeChanged() error {
+
+	ret, err := o.EmitSignal(ControlSignalMinimumSizeChanged)
+	ret.Destroy()
+	return err
+}
+
+// ConnectModalClosed will connect the "modal_closed" signal to the given method of the target object.
+func (o *Control) ConnectModalClosed(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalModalClosed, target, method, binds, 0)
+}
+
+// EmitModalClosed will emit the "modal_closed" signal.
+func (o *Control) EmitModalClosed() error {
+
+	ret, err := o.EmitSignal(ControlSignalModalClosed)
+	ret.Destroy()
+	return err
+}
+
+// ConnectMouseEntered will connect the "mouse_entered" signal to the given method of the target object.
+func (o *Control) ConnectMouseEntered(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalMouseEntered, target, method, binds, 0)
+}
+
+// EmitMouseEntered will emit the "mouse_entered" signal.
+func (o *Control) EmitMouseEntered() error {
+
+	ret, err := o.EmitSignal(ControlSignalMouseEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectMouseExited will connect the "mouse_exited" signal to the given method of the target object.
+func (o *Control) ConnectMouseExited(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalMouseExited, target, method, binds, 0)
+}
+
+// EmitMouseExited will emit the "mouse_exited" signal.
+func (o *Control) EmitMouseExited() error {
+
+	ret, err := o.EmitSignal(ControlSignalMouseExited)
+	ret.Destroy()
+	return err
+}
+
+// ConnectResized will connect the "resized" signal to the given method of the target object.
+func (o *Control) ConnectResized(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalResized, target, method, binds, 0)
+}
+
+// EmitResized will emit the "resized" signal.
+func (o *Control) EmitResized() error {
+
+	ret, err := o.EmitSignal(ControlSignalResized)
+	ret.Destroy()
+	return err
+}
+
+// ConnectSizeFlagsChanged will connect the "size_flags_changed" signal to the given method of the target object.
+func (o *Control) ConnectSizeFlagsChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ControlSignalSizeFlagsChanged, target, method, binds, 0)
+}
+
+// EmitSizeFlagsChanged will emit the "size_flags_changed" signal.
+func (o *Control) EmitSizeFlagsChanged() error {
+
+	ret, err := o.EmitSignal(ControlSignalSizeFlagsChanged)
+	ret.Destroy()
+	return err
+}
+
 // ControlImplementer is an interface that implements the methods
 // of the Control class.
 type ControlImplementer interface {
@@ -2708,9 +3666,12 @@ type ControlImplementer interface {
 	GetColor(name gdnative.String, aType gdnative.String) gdnative.Color
 	GetCombinedMinimumSize() gdnative.Vector2
 	GetConstant(name gdnative.String, aType gdnative.String) gdnative.Int
+	GetCursorShape(position gdnative.Vector2) ControlCursorShape
 	GetCustomMinimumSize() gdnative.Vector2
+	GetDefaultCursorShape() ControlCursorShape
 	GetDragData(position gdnative.Vector2) ObjectImplementer
 	GetEnd() gdnative.Vector2
+	GetFocusMode() ControlFocusMode
 	GetFocusNeighbour(margin gdnative.Int) gdnative.NodePath
 	GetFocusNext() gdnative.NodePath
 	GetFocusOwner() ControlImplementer
@@ -2718,10 +3679,12 @@ type ControlImplementer interface {
 	GetFont(name gdnative.String, aType gdnative.String) FontImplementer
 	GetGlobalPosition() gdnative.Vector2
 	GetGlobalRect() gdnative.Rect2
+	GetHGrowDirection() ControlGrowDirection
 	GetHSizeFlags() gdnative.Int
 	GetIcon(name gdnative.String, aType gdnative.String) TextureImplementer
 	GetMargin(margin gdnative.Int) gdnative.Real
 	GetMinimumSize() gdnative.Vector2
+	GetMouseFilter() ControlMouseFilter
 	GetParentAreaSize() gdnative.Vector2
 	GetParentControl() ControlImplementer
 	GetPivotOffset() gdnative.Vector2
@@ -2735,6 +3698,7 @@ type ControlImplementer interface {
 	GetStylebox(name gdnative.String, aType gdnative.String) StyleBoxImplementer
 	GetTheme() ThemeImplementer
 	GetTooltip(atPosition gdnative.Vector2) gdnative.String
+	GetVGrowDirection() ControlGrowDirection
 	GetVSizeFlags() gdnative.Int
 	GrabClickFocus()
 	GrabFocus()
@@ -2761,20 +3725,20 @@ type ControlImplementer interface {
 	SetBegin(position gdnative.Vector2)
 	SetClipContents(enable gdnative.Bool)
 	SetCustomMinimumSize(size gdnative.Vector2)
-	SetDefaultCursorShape(shape gdnative.Int)
+	SetDefaultCursorShape(shape ControlCursorShape)
 	SetDragForwarding(target ObjectImplementer)
 	SetDragPreview(control ObjectImplementer)
 	SetEnd(position gdnative.Vector2)
-	SetFocusMode(mode gdnative.Int)
+	SetFocusMode(mode ControlFocusMode)
 	SetFocusNeighbour(margin gdnative.Int, neighbour gdnative.NodePath)
 	SetFocusNext(next gdnative.NodePath)
 	SetFocusPrevious(previous gdnative.NodePath)
 	SetGlobalPosition(position gdnative.Vector2)
-	SetHGrowDirection(direction gdnative.Int)
+	SetHGrowDirection(direction ControlGrowDirection)
 	SetHSizeFlags(flags gdnative.Int)
 	SetMargin(margin gdnative.Int, offset gdnative.Real)
 	SetMarginsPreset(preset gdnative.Int, resizeMode gdnative.Int, margin gdnative.Int)
-	SetMouseFilter(filter gdnative.Int)
+	SetMouseFilter(filter ControlMouseFilter)
 	SetPivotOffset(pivotOffset gdnative.Vector2)
 	SetPosition(position gdnative.Vector2)
 	SetRotation(radians gdnative.Real)
@@ -2784,8 +3748,103 @@ type ControlImplementer interface {
 	SetStretchRatio(ratio gdnative.Real)
 	SetTheme(theme ThemeImplementer)
 	SetTooltip(tooltip gdnative.String)
-	SetVGrowDirection(direction gdnative.Int)
+	SetVGrowDirection(direction ControlGrowDirection)
 	SetVSizeFlags(flags gdnative.Int)
 	ShowModal(exclusive gdnative.Bool)
 	WarpMouse(toPosition gdnative.Vector2)
+	GetColorWithDefaults(name gdnative.String) gdnative.Color
+	GetConstantWithDefaults(name gdnative.String) gdnative.Int
+	GetCursorShapeWithDefaults() ControlCursorShape
+	GetFontWithDefaults(name gdnative.String) FontImplementer
+	GetIconWithDefaults(name gdnative.String) TextureImplementer
+	GetStyleboxWithDefaults(name gdnative.String) StyleBoxImplementer
+	GetTooltipWithDefaults() gdnative.String
+	HasColorWithDefaults(name gdnative.String) gdnative.Bool
+	HasConstantWithDefaults(name gdnative.String) gdnative.Bool
+	HasFontWithDefaults(name gdnative.String) gdnative.Bool
+	HasIconWithDefaults(name gdnative.String) gdnative.Bool
+	HasStyleboxWithDefaults(name gdnative.String) gdnative.Bool
+	SetAnchorWithDefaults(margin gdnative.Int, anchor gdnative.Real)
+	SetAnchorAndMarginWithDefaults(margin gdnative.Int, anchor gdnative.Real, offset gdnative.Real)
+	SetAnchorsAndMarginsPresetWithDefaults(preset gdnative.Int)
+	SetAnchorsPresetWithDefaults(preset gdnative.Int)
+	SetMarginsPresetWithDefaults(preset gdnative.Int)
+	ShowModalWithDefaults()
+	AnchorBottom() gdnative.Real
+	SetAnchorBottom(value gdnative.Real)
+	AnchorLeft() gdnative.Real
+	SetAnchorLeft(value gdnative.Real)
+	AnchorRight() gdnative.Real
+	SetAnchorRight(value gdnative.Real)
+	AnchorTop() gdnative.Real
+	SetAnchorTop(value gdnative.Real)
+	FocusMode() ControlFocusMode
+	FocusNeighbourBottom() gdnative.NodePath
+	SetFocusNeighbourBottom(value gdnative.NodePath)
+	FocusNeighbourLeft() gdnative.NodePath
+	SetFocusNeighbourLeft(value gdnative.NodePath)
+	FocusNeighbourRight() gdnative.NodePath
+	SetFocusNeighbourRight(value gdnative.NodePath)
+	FocusNeighbourTop() gdnative.NodePath
+	SetFocusNeighbourTop(value gdnative.NodePath)
+	FocusNext() gdnative.NodePath
+	FocusPrevious() gdnative.NodePath
+	GrowHorizontal() ControlGrowDirection
+	SetGrowHorizontal(value ControlGrowDirection)
+	GrowVertical() ControlGrowDirection
+	SetGrowVertical(value ControlGrowDirection)
+	HintTooltip() gdnative.String
+	SetHintTooltip(value gdnative.String)
+	MarginBottom() gdnative.Real
+	SetMarginBottom(value gdnative.Real)
+	MarginLeft() gdnative.Real
+	SetMarginLeft(value gdnative.Real)
+	MarginRight() gdnative.Real
+	SetMarginRight(value gdnative.Real)
+	MarginTop() gdnative.Real
+	SetMarginTop(value gdnative.Real)
+	MouseDefaultCursorShape() ControlCursorShape
+	SetMouseDefaultCursorShape(value ControlCursorShape)
+	MouseFilter() ControlMouseFilter
+	RectClipContent() gdnative.Bool
+	SetRectClipContent(value gdnative.Bool)
+	RectGlobalPosition() gdnative.Vector2
+	SetRectGlobalPosition(value gdnative.Vector2)
+	RectMinSize() gdnative.Vector2
+	SetRectMinSize(value gdnative.Vector2)
+	RectPivotOffset() gdnative.Vector2
+	SetRectPivotOffset(value gdnative.Vector2)
+	RectPosition() gdnative.Vector2
+	SetRectPosition(value gdnative.Vector2)
+	RectRotation() gdnative.Real
+	SetRectRotation(value gdnative.Real)
+	RectScale() gdnative.Vector2
+	SetRectScale(value gdnative.Vector2)
+	RectSize() gdnative.Vector2
+	SetRectSize(value gdnative.Vector2)
+	SizeFlagsHorizontal() gdnative.Int
+	SetSizeFlagsHorizontal(value gdnative.Int)
+	SizeFlagsStretchRatio() gdnative.Real
+	SetSizeFlagsStretchRatio(value gdnative.Real)
+	SizeFlagsVertical() gdnative.Int
+	SetSizeFlagsVertical(value gdnative.Int)
+	Theme() ThemeImplementer
+	ConnectFocusEntered(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFocusEntered() error
+	ConnectFocusExited(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFocusExited() error
+	ConnectGuiInput(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitGuiInput(args ControlGuiInputArgs) error
+	ConnectMinimumSizeChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMinimumSizeChanged() error
+	ConnectModalClosed(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitModalClosed() error
+	ConnectMouseEntered(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMouseEntered() error
+	ConnectMouseExited(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMouseExited() error
+	ConnectResized(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitResized() error
+	ConnectSizeFlagsChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSizeFlagsChanged() error
 }
@@ -0,0 +1,470 @@
+package control
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+This dialog is useful for small notifications to the user about an event. It can only be accepted or closed, with the same result.
+*/
+type AcceptDialog struct {
+	WindowDialog
+	owner gdnative.Object
+}
+
+// classConstructorAcceptDialog is the class constructor of the AcceptDialog class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAcceptDialog = gdnative.NewLazyClassConstructor("AcceptDialog")
+
+// NewAcceptDialog will create a new instance of the AcceptDialog class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAcceptDialog() *AcceptDialog {
+	obj := &AcceptDialog{}
+	obj.SetBaseObject(classConstructorAcceptDialog.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *AcceptDialog) BaseClass() string {
+	return "AcceptDialog"
+}
+
+// Method binds of the AcceptDialog class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAcceptDialogX_BuiltinTextEntered = gdnative.NewLazyMethodBind("AcceptDialog", "_builtin_text_entered")
+	methodBindAcceptDialogX_CustomAction       = gdnative.NewLazyMethodBind("AcceptDialog", "_custom_action")
+	methodBindAcceptDialogX_Ok                 = gdnative.NewLazyMethodBind("AcceptDialog", "_ok")
+	methodBindAcceptDialogAddButton            = gdnative.NewLazyMethodBind("AcceptDialog", "add_button")
+	methodBindAcceptDialogAddCancel            = gdnative.NewLazyMethodBind("AcceptDialog", "add_cancel")
+	methodBindAcceptDialogGetHideOnOk          = gdnative.NewLazyMethodBind("AcceptDialog", "get_hide_on_ok")
+	methodBindAcceptDialogGetLabel             = gdnative.NewLazyMethodBind("AcceptDialog", "get_label")
+	methodBindAcceptDialogGetOk                = gdnative.NewLazyMethodBind("AcceptDialog", "get_ok")
+	methodBindAcceptDialogGetText              = gdnative.NewLazyMethodBind("AcceptDialog", "get_text")
+	methodBindAcceptDialogRegisterTextEnter    = gdnative.NewLazyMethodBind("AcceptDialog", "register_text_enter")
+	methodBindAcceptDialogSetHideOnOk          = gdnative.NewLazyMethodBind("AcceptDialog", "set_hide_on_ok")
+	methodBindAcceptDialogSetText              = gdnative.NewLazyMethodBind("AcceptDialog", "set_text")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
+*/
+func (o *AcceptDialog) X_BuiltinTextEntered(arg0 gdnative.String) {
+	//log.Println("Calling AcceptDialog.X_BuiltinTextEntered()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogX_BuiltinTextEntered.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
+*/
+func (o *AcceptDialog) X_CustomAction(arg0 gdnative.String) {
+	//log.Println("Calling AcceptDialog.X_CustomAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogX_CustomAction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *AcceptDialog) X_Ok() {
+	//log.Println("Calling AcceptDialog.X_Ok()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogX_Ok.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Adds a button with label [i]text[/i] and a custom [i]action[/i] to the dialog and returns the created button. [i]action[/i] will be passed to the [custom_action] signal when pressed. If [code]true[/code], [i]right[/i] will place the button to the right of any sibling buttons. Default value: [code]false[/code].
+		Args: [{ false text String} {False true right bool} { true action String}], Returns: Button
+*/
+func (o *AcceptDialog) AddButton(text gdnative.String, right gdnative.Bool, action gdnative.String) ButtonImplementer {
+	//log.Println("Calling AcceptDialog.AddButton()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(text)
+	ptrArguments[1] = gdnative.NewPointerFromBool(right)
+	ptrArguments[2] = gdnative.NewPointerFromString(action)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogAddButton.Get()
+
+	// Call the parent method.
+	// Button
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Button{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ButtonImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Adds a button with label [i]name[/i] and a cancel action to the dialog and returns the created button.
+		Args: [{ false name String}], Returns: Button
+*/
+func (o *AcceptDialog) AddCancel(name gdnative.String) ButtonImplementer {
+	//log.Println("Calling AcceptDialog.AddCancel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogAddCancel.Get()
+
+	// Call the parent method.
+	// Button
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Button{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ButtonImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *AcceptDialog) GetHideOnOk() gdnative.Bool {
+	//log.Println("Calling AcceptDialog.GetHideOnOk()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogGetHideOnOk.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Return the label used for built-in text.
+		Args: [], Returns: Label
+*/
+func (o *AcceptDialog) GetLabel() LabelImplementer {
+	//log.Println("Calling AcceptDialog.GetLabel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogGetLabel.Get()
+
+	// Call the parent method.
+	// Label
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Label{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(LabelImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Return the OK Button.
+		Args: [], Returns: Button
+*/
+func (o *AcceptDialog) GetOk() ButtonImplementer {
+	//log.Println("Calling AcceptDialog.GetOk()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogGetOk.Get()
+
+	// Call the parent method.
+	// Button
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := Button{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ButtonImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *AcceptDialog) GetText() gdnative.String {
+	//log.Println("Calling AcceptDialog.GetText()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogGetText.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Registers a [LineEdit] in the dialog. When the enter key is pressed, the dialog will be accepted.
+		Args: [{ false line_edit Object}], Returns: void
+*/
+func (o *AcceptDialog) RegisterTextEnter(lineEdit godot.ObjectImplementer) {
+	//log.Println("Calling AcceptDialog.RegisterTextEnter()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(lineEdit))
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogRegisterTextEnter.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *AcceptDialog) SetHideOnOk(enabled gdnative.Bool) {
+	//log.Println("Calling AcceptDialog.SetHideOnOk()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogSetHideOnOk.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false text String}], Returns: void
+*/
+func (o *AcceptDialog) SetText(text gdnative.String) {
+	//log.Println("Calling AcceptDialog.SetText()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(text)
+
+	// Get the method bind
+	methodBind := methodBindAcceptDialogSetText.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AddButtonWithDefaults will call AddButton using the default values for: right, action.
+func (o *AcceptDialog) AddButtonWithDefaults(text gdnative.String) ButtonImplementer {
+	return o.AddButton(text, false, "")
+}
+
+// DialogHideOnOk will return the value of the "dialog_hide_on_ok" property.
+func (o *AcceptDialog) DialogHideOnOk() gdnative.Bool {
+	return o.GetHideOnOk()
+}
+
+// SetDialogHideOnOk will set the value of the "dialog_hide_on_ok" property.
+func (o *AcceptDialog) SetDialogHideOnOk(value gdnative.Bool) {
+	o.SetHideOnOk(value)
+}
+
+// DialogText will return the value of the "dialog_text" property.
+func (o *AcceptDialog) DialogText() gdnative.String {
+	return o.GetText()
+}
+
+// SetDialogText will set the value of the "dialog_text" property.
+func (o *AcceptDialog) SetDialogText(value gdnative.String) {
+	o.SetText(value)
+}
+
+// Signals of the AcceptDialog class.
+const (
+	AcceptDialogSignalConfirmed    gdnative.String = "confirmed"
+	AcceptDialogSignalCustomAction gdnative.String = "custom_action"
+)
+
+// ConnectConfirmed will connect the "confirmed" signal to the given method of the target object.
+func (o *AcceptDialog) ConnectConfirmed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AcceptDialogSignalConfirmed, target, method, binds, 0)
+}
+
+// EmitConfirmed will emit the "confirmed" signal.
+func (o *AcceptDialog) EmitConfirmed() error {
+
+	ret, err := o.EmitSignal(AcceptDialogSignalConfirmed)
+	ret.Destroy()
+	return err
+}
+
+// AcceptDialogCustomActionArgs holds the arguments of the "custom_action" signal.
+type AcceptDialogCustomActionArgs struct {
+	Action gdnative.String
+}
+
+// ConnectCustomAction will connect the "custom_action" signal to the given method of the target object.
+func (o *AcceptDialog) ConnectCustomAction(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AcceptDialogSignalCustomAction, target, method, binds, 0)
+}
+
+// EmitCustomAction will emit the "custom_action" signal.
+func (o *AcceptDialog) EmitCustomAction(args AcceptDialogCustomActionArgs) error {
+	arg0 := gdnative.NewVariantString(args.Action)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(AcceptDialogSignalCustomAction, arg0)
+	ret.Destroy()
+	return err
+}
+
+// AcceptDialogImplementer is an interface that implements the methods
+// of the AcceptDialog class.
+type AcceptDialogImplementer interface {
+	WindowDialogImplementer
+	X_BuiltinTextEntered(arg0 gdnative.String)
+	X_CustomAction(arg0 gdnative.String)
+	X_Ok()
+	AddButton(text gdnative.String, right gdnative.Bool, action gdnative.String) ButtonImplementer
+	AddCancel(name gdnative.String) ButtonImplementer
+	GetHideOnOk() gdnative.Bool
+	GetLabel() LabelImplementer
+	GetOk() ButtonImplementer
+	GetText() gdnative.String
+	RegisterTextEnter(lineEdit godot.ObjectImplementer)
+	SetHideOnOk(enabled gdnative.Bool)
+	SetText(text gdnative.String)
+	AddButtonWithDefaults(text gdnative.String) ButtonImplementer
+	DialogHideOnOk() gdnative.Bool
+	SetDialogHideOnOk(value gdnative.Bool)
+	DialogText() gdnative.String
+	SetDialogText(value gdnative.String)
+	ConnectConfirmed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitConfirmed() error
+	ConnectCustomAction(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCustomAction(args AcceptDialogCustomActionArgs) error
+}
@@ -1,6 +1,8 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,13 +24,30 @@ const (
 	BoxContainerAlignEnd    BoxContainerAlignMode = 2
 )
 
-//func NewBoxContainerFromPointer(ptr gdnative.Pointer) BoxContainer {
-func newBoxContainerFromPointer(ptr gdnative.Pointer) BoxContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BoxContainer{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the BoxContainerAlignMode value.
+func (e BoxContainerAlignMode) String() string {
+	switch e {
+	case BoxContainerAlignBegin:
+		return "ALIGN_BEGIN"
+	case BoxContainerAlignCenter:
+		return "ALIGN_CENTER"
+	case BoxContainerAlignEnd:
+		return "ALIGN_END"
+	}
+	return fmt.Sprintf("BoxContainerAlignMode(%d)", int(e))
+}
 
-	return obj
+// ParseBoxContainerAlignMode will return the BoxContainerAlignMode value with the given Godot name.
+func ParseBoxContainerAlignMode(name string) (BoxContainerAlignMode, error) {
+	switch name {
+	case "ALIGN_BEGIN":
+		return BoxContainerAlignBegin, nil
+	case "ALIGN_CENTER":
+		return BoxContainerAlignCenter, nil
+	case "ALIGN_END":
+		return BoxContainerAlignEnd, nil
+	}
+	return 0, fmt.Errorf("invalid BoxContainerAlignMode value %q", name)
 }
 
 /*
@@ -43,9 +62,17 @@ func (o *BoxContainer) BaseClass() string {
 	return "BoxContainer"
 }
 
+// Method binds of the BoxContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBoxContainerAddSpacer    = gdnative.NewLazyMethodBind("BoxContainer", "add_spacer")
+	methodBindBoxContainerGetAlignment = gdnative.NewLazyMethodBind("BoxContainer", "get_alignment")
+	methodBindBoxContainerSetAlignment = gdnative.NewLazyMethodBind("BoxContainer", "set_alignment")
+)
+
 /*
-        Adds a control to the box as a spacer. If [code]true[/code], [i]begin[/i] will insert the spacer control in front of other children.
-	Args: [{ false begin bool}], Returns: void
+	        Adds a control to the box as a spacer. If [code]true[/code], [i]begin[/i] will insert the spacer control in front of other children.
+		Args: [{ false begin bool}], Returns: void
 */
 func (o *BoxContainer) AddSpacer(begin gdnative.Bool) {
 	//log.Println("Calling BoxContainer.AddSpacer()")
@@ -55,7 +82,7 @@ func (o *BoxContainer) AddSpacer(begin gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(begin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BoxContainer", "add_spacer")
+	methodBind := methodBindBoxContainerAddSpacer.Get()
 
 	// Call the parent method.
 	// void
@@ -65,8 +92,8 @@ func (o *BoxContainer) AddSpacer(begin gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.BoxContainer::AlignMode
+	        Undocumented
+		Args: [], Returns: enum.BoxContainer::AlignMode
 */
 func (o *BoxContainer) GetAlignment() BoxContainerAlignMode {
 	//log.Println("Calling BoxContainer.GetAlignment()")
@@ -75,7 +102,7 @@ func (o *BoxContainer) GetAlignment() BoxContainerAlignMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BoxContainer", "get_alignment")
+	methodBind := methodBindBoxContainerGetAlignment.Get()
 
 	// Call the parent method.
 	// enum.BoxContainer::AlignMode
@@ -88,18 +115,18 @@ func (o *BoxContainer) GetAlignment() BoxContainerAlignMode {
 }
 
 /*
-        Undocumented
-	Args: [{ false alignment int}], Returns: void
+	        Undocumented
+		Args: [{ false alignment enum.BoxContainer::AlignMode}], Returns: void
 */
-func (o *BoxContainer) SetAlignment(alignment gdnative.Int) {
+func (o *BoxContainer) SetAlignment(alignment BoxContainerAlignMode) {
 	//log.Println("Calling BoxContainer.SetAlignment()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(alignment)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(alignment))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BoxContainer", "set_alignment")
+	methodBind := methodBindBoxContainerSetAlignment.Get()
 
 	// Call the parent method.
 	// void
@@ -108,10 +135,17 @@ func (o *BoxContainer) SetAlignment(alignment gdnative.Int) {
 
 }
 
+// Alignment will return the value of the "alignment" property.
+func (o *BoxContainer) Alignment() BoxContainerAlignMode {
+	return o.GetAlignment()
+}
+
 // BoxContainerImplementer is an interface that implements the methods
 // of the BoxContainer class.
 type BoxContainerImplementer interface {
 	ContainerImplementer
 	AddSpacer(begin gdnative.Bool)
-	SetAlignment(alignment gdnative.Int)
+	GetAlignment() BoxContainerAlignMode
+	SetAlignment(alignment BoxContainerAlignMode)
+	Alignment() BoxContainerAlignMode
 }
@@ -1,7 +1,10 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,39 +25,85 @@ const (
 	ButtonAlignRight  ButtonTextAlign = 2
 )
 
-//func NewButtonFromPointer(ptr gdnative.Pointer) Button {
-func newButtonFromPointer(ptr gdnative.Pointer) Button {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Button{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ButtonTextAlign value.
+func (e ButtonTextAlign) String() string {
+	switch e {
+	case ButtonAlignCenter:
+		return "ALIGN_CENTER"
+	case ButtonAlignLeft:
+		return "ALIGN_LEFT"
+	case ButtonAlignRight:
+		return "ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("ButtonTextAlign(%d)", int(e))
+}
 
-	return obj
+// ParseButtonTextAlign will return the ButtonTextAlign value with the given Godot name.
+func ParseButtonTextAlign(name string) (ButtonTextAlign, error) {
+	switch name {
+	case "ALIGN_CENTER":
+		return ButtonAlignCenter, nil
+	case "ALIGN_LEFT":
+		return ButtonAlignLeft, nil
+	case "ALIGN_RIGHT":
+		return ButtonAlignRight, nil
+	}
+	return 0, fmt.Errorf("invalid ButtonTextAlign value %q", name)
 }
 
 /*
 Button is the standard themed button. It can contain text and an icon, and will display them according to the current [Theme].
 */
 type Button struct {
-	BaseButton
+	godot.BaseButton
 	owner gdnative.Object
 }
 
+// classConstructorButton is the class constructor of the Button class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorButton = gdnative.NewLazyClassConstructor("Button")
+
+// NewButton will create a new instance of the Button class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewButton() *Button {
+	obj := &Button{}
+	obj.SetBaseObject(classConstructorButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Button) BaseClass() string {
 	return "Button"
 }
 
+// Method binds of the Button class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindButtonGetButtonIcon = gdnative.NewLazyMethodBind("Button", "get_button_icon")
+	methodBindButtonGetClipText   = gdnative.NewLazyMethodBind("Button", "get_clip_text")
+	methodBindButtonGetText       = gdnative.NewLazyMethodBind("Button", "get_text")
+	methodBindButtonGetTextAlign  = gdnative.NewLazyMethodBind("Button", "get_text_align")
+	methodBindButtonIsFlat        = gdnative.NewLazyMethodBind("Button", "is_flat")
+	methodBindButtonSetButtonIcon = gdnative.NewLazyMethodBind("Button", "set_button_icon")
+	methodBindButtonSetClipText   = gdnative.NewLazyMethodBind("Button", "set_clip_text")
+	methodBindButtonSetFlat       = gdnative.NewLazyMethodBind("Button", "set_flat")
+	methodBindButtonSetText       = gdnative.NewLazyMethodBind("Button", "set_text")
+	methodBindButtonSetTextAlign  = gdnative.NewLazyMethodBind("Button", "set_text_align")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Button) GetButtonIcon() TextureImplementer {
+func (o *Button) GetButtonIcon() godot.TextureImplementer {
 	//log.Println("Calling Button.GetButtonIcon()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "get_button_icon")
+	methodBind := methodBindButtonGetButtonIcon.Get()
 
 	// Call the parent method.
 	// Texture
@@ -62,27 +111,25 @@ func (o *Button) GetButtonIcon() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Button) GetClipText() gdnative.Bool {
 	//log.Println("Calling Button.GetClipText()")
@@ -91,7 +138,7 @@ func (o *Button) GetClipText() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "get_clip_text")
+	methodBind := methodBindButtonGetClipText.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +151,8 @@ func (o *Button) GetClipText() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Button) GetText() gdnative.String {
 	//log.Println("Calling Button.GetText()")
@@ -114,7 +161,7 @@ func (o *Button) GetText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "get_text")
+	methodBind := methodBindButtonGetText.Get()
 
 	// Call the parent method.
 	// String
@@ -127,8 +174,8 @@ func (o *Button) GetText() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Button::TextAlign
+	        Undocumented
+		Args: [], Returns: enum.Button::TextAlign
 */
 func (o *Button) GetTextAlign() ButtonTextAlign {
 	//log.Println("Calling Button.GetTextAlign()")
@@ -137,7 +184,7 @@ func (o *Button) GetTextAlign() ButtonTextAlign {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "get_text_align")
+	methodBind := methodBindButtonGetTextAlign.Get()
 
 	// Call the parent method.
 	// enum.Button::TextAlign
@@ -150,8 +197,8 @@ func (o *Button) GetTextAlign() ButtonTextAlign {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Button) IsFlat() gdnative.Bool {
 	//log.Println("Calling Button.IsFlat()")
@@ -160,7 +207,7 @@ func (o *Button) IsFlat() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "is_flat")
+	methodBind := methodBindButtonIsFlat.Get()
 
 	// Call the parent method.
 	// bool
@@ -173,18 +220,18 @@ func (o *Button) IsFlat() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Button) SetButtonIcon(texture TextureImplementer) {
+func (o *Button) SetButtonIcon(texture godot.TextureImplementer) {
 	//log.Println("Calling Button.SetButtonIcon()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "set_button_icon")
+	methodBind := methodBindButtonSetButtonIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -194,8 +241,8 @@ func (o *Button) SetButtonIcon(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Button) SetClipText(enabled gdnative.Bool) {
 	//log.Println("Calling Button.SetClipText()")
@@ -205,7 +252,7 @@ func (o *Button) SetClipText(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "set_clip_text")
+	methodBind := methodBindButtonSetClipText.Get()
 
 	// Call the parent method.
 	// void
@@ -215,8 +262,8 @@ func (o *Button) SetClipText(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Button) SetFlat(enabled gdnative.Bool) {
 	//log.Println("Calling Button.SetFlat()")
@@ -226,7 +273,7 @@ func (o *Button) SetFlat(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "set_flat")
+	methodBind := methodBindButtonSetFlat.Get()
 
 	// Call the parent method.
 	// void
@@ -236,8 +283,8 @@ func (o *Button) SetFlat(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *Button) SetText(text gdnative.String) {
 	//log.Println("Calling Button.SetText()")
@@ -247,7 +294,7 @@ func (o *Button) SetText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "set_text")
+	methodBind := methodBindButtonSetText.Get()
 
 	// Call the parent method.
 	// void
@@ -257,18 +304,18 @@ func (o *Button) SetText(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false align int}], Returns: void
+	        Undocumented
+		Args: [{ false align enum.Button::TextAlign}], Returns: void
 */
-func (o *Button) SetTextAlign(align gdnative.Int) {
+func (o *Button) SetTextAlign(align ButtonTextAlign) {
 	//log.Println("Calling Button.SetTextAlign()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(align)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(align))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Button", "set_text_align")
+	methodBind := methodBindButtonSetTextAlign.Get()
 
 	// Call the parent method.
 	// void
@@ -277,17 +324,60 @@ func (o *Button) SetTextAlign(align gdnative.Int) {
 
 }
 
+// Align will return the value of the "align" property.
+func (o *Button) Align() ButtonTextAlign {
+	return o.GetTextAlign()
+}
+
+// SetAlign will set the value of the "align" property.
+func (o *Button) SetAlign(value ButtonTextAlign) {
+	o.SetTextAlign(value)
+}
+
+// ClipText will return the value of the "clip_text" property.
+func (o *Button) ClipText() gdnative.Bool {
+	return o.GetClipText()
+}
+
+// Flat will return the value of the "flat" property.
+func (o *Button) Flat() gdnative.Bool {
+	return o.IsFlat()
+}
+
+// Icon will return the value of the "icon" property.
+func (o *Button) Icon() godot.TextureImplementer {
+	return o.GetButtonIcon()
+}
+
+// SetIcon will set the value of the "icon" property.
+func (o *Button) SetIcon(value godot.TextureImplementer) {
+	o.SetButtonIcon(value)
+}
+
+// Text will return the value of the "text" property.
+func (o *Button) Text() gdnative.String {
+	return o.GetText()
+}
+
 // ButtonImplementer is an interface that implements the methods
 // of the Button class.
 type ButtonImplementer interface {
-	BaseButtonImplementer
-	GetButtonIcon() TextureImplementer
+	godot.BaseButtonImplementer
+	GetButtonIcon() godot.TextureImplementer
 	GetClipText() gdnative.Bool
 	GetText() gdnative.String
+	GetTextAlign() ButtonTextAlign
 	IsFlat() gdnative.Bool
-	SetButtonIcon(texture TextureImplementer)
+	SetButtonIcon(texture godot.TextureImplementer)
 	SetClipText(enabled gdnative.Bool)
 	SetFlat(enabled gdnative.Bool)
 	SetText(text gdnative.String)
-	SetTextAlign(align gdnative.Int)
+	SetTextAlign(align ButtonTextAlign)
+	Align() ButtonTextAlign
+	SetAlign(value ButtonTextAlign)
+	ClipText() gdnative.Bool
+	Flat() gdnative.Bool
+	Icon() godot.TextureImplementer
+	SetIcon(value godot.TextureImplementer)
+	Text() gdnative.String
 }
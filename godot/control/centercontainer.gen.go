@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCenterContainerFromPointer(ptr gdnative.Pointer) CenterContainer {
-func newCenterContainerFromPointer(ptr gdnative.Pointer) CenterContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CenterContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 CenterContainer Keeps children controls centered. This container keeps all children to their minimum size, in the center.
 */
@@ -30,13 +22,34 @@ type CenterContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorCenterContainer is the class constructor of the CenterContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCenterContainer = gdnative.NewLazyClassConstructor("CenterContainer")
+
+// NewCenterContainer will create a new instance of the CenterContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCenterContainer() *CenterContainer {
+	obj := &CenterContainer{}
+	obj.SetBaseObject(classConstructorCenterContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CenterContainer) BaseClass() string {
 	return "CenterContainer"
 }
 
+// Method binds of the CenterContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCenterContainerIsUsingTopLeft = gdnative.NewLazyMethodBind("CenterContainer", "is_using_top_left")
+	methodBindCenterContainerSetUseTopLeft  = gdnative.NewLazyMethodBind("CenterContainer", "set_use_top_left")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CenterContainer) IsUsingTopLeft() gdnative.Bool {
 	//log.Println("Calling CenterContainer.IsUsingTopLeft()")
@@ -45,7 +58,7 @@ func (o *CenterContainer) IsUsingTopLeft() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CenterContainer", "is_using_top_left")
+	methodBind := methodBindCenterContainerIsUsingTopLeft.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +71,8 @@ func (o *CenterContainer) IsUsingTopLeft() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CenterContainer) SetUseTopLeft(enable gdnative.Bool) {
 	//log.Println("Calling CenterContainer.SetUseTopLeft()")
@@ -69,7 +82,7 @@ func (o *CenterContainer) SetUseTopLeft(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CenterContainer", "set_use_top_left")
+	methodBind := methodBindCenterContainerSetUseTopLeft.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *CenterContainer) SetUseTopLeft(enable gdnative.Bool) {
 
 }
 
+// UseTopLeft will return the value of the "use_top_left" property.
+func (o *CenterContainer) UseTopLeft() gdnative.Bool {
+	return o.IsUsingTopLeft()
+}
+
 // CenterContainerImplementer is an interface that implements the methods
 // of the CenterContainer class.
 type CenterContainerImplementer interface {
 	ContainerImplementer
 	IsUsingTopLeft() gdnative.Bool
 	SetUseTopLeft(enable gdnative.Bool)
+	UseTopLeft() gdnative.Bool
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCheckBoxFromPointer(ptr gdnative.Pointer) CheckBox {
-func newCheckBoxFromPointer(ptr gdnative.Pointer) CheckBox {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CheckBox{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A checkbox allows the user to make a binary choice (choosing only one of two possible options), for example Answer 'yes' or 'no'.
 */
@@ -30,6 +22,20 @@ type CheckBox struct {
 	owner gdnative.Object
 }
 
+// classConstructorCheckBox is the class constructor of the CheckBox class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCheckBox = gdnative.NewLazyClassConstructor("CheckBox")
+
+// NewCheckBox will create a new instance of the CheckBox class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCheckBox() *CheckBox {
+	obj := &CheckBox{}
+	obj.SetBaseObject(classConstructorCheckBox.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CheckBox) BaseClass() string {
 	return "CheckBox"
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCheckButtonFromPointer(ptr gdnative.Pointer) CheckButton {
-func newCheckButtonFromPointer(ptr gdnative.Pointer) CheckButton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CheckButton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 CheckButton is a toggle button displayed as a check field.
 */
@@ -30,6 +22,20 @@ type CheckButton struct {
 	owner gdnative.Object
 }
 
+// classConstructorCheckButton is the class constructor of the CheckButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCheckButton = gdnative.NewLazyClassConstructor("CheckButton")
+
+// NewCheckButton will create a new instance of the CheckButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCheckButton() *CheckButton {
+	obj := &CheckButton{}
+	obj.SetBaseObject(classConstructorCheckButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CheckButton) BaseClass() string {
 	return "CheckButton"
 }
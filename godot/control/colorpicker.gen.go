@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewColorPickerFromPointer(ptr gdnative.Pointer) ColorPicker {
-func newColorPickerFromPointer(ptr gdnative.Pointer) ColorPicker {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ColorPicker{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This is a simple color picker [Control]. It's useful for selecting a color from an RGB/RGBA colorspace.
 */
@@ -30,13 +22,51 @@ type ColorPicker struct {
 	owner gdnative.Object
 }
 
+// classConstructorColorPicker is the class constructor of the ColorPicker class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorColorPicker = gdnative.NewLazyClassConstructor("ColorPicker")
+
+// NewColorPicker will create a new instance of the ColorPicker class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewColorPicker() *ColorPicker {
+	obj := &ColorPicker{}
+	obj.SetBaseObject(classConstructorColorPicker.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ColorPicker) BaseClass() string {
 	return "ColorPicker"
 }
 
+// Method binds of the ColorPicker class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindColorPickerX_AddPresetPressed  = gdnative.NewLazyMethodBind("ColorPicker", "_add_preset_pressed")
+	methodBindColorPickerX_HsvDraw           = gdnative.NewLazyMethodBind("ColorPicker", "_hsv_draw")
+	methodBindColorPickerX_HtmlEntered       = gdnative.NewLazyMethodBind("ColorPicker", "_html_entered")
+	methodBindColorPickerX_PresetInput       = gdnative.NewLazyMethodBind("ColorPicker", "_preset_input")
+	methodBindColorPickerX_SampleDraw        = gdnative.NewLazyMethodBind("ColorPicker", "_sample_draw")
+	methodBindColorPickerX_ScreenInput       = gdnative.NewLazyMethodBind("ColorPicker", "_screen_input")
+	methodBindColorPickerX_ScreenPickPressed = gdnative.NewLazyMethodBind("ColorPicker", "_screen_pick_pressed")
+	methodBindColorPickerX_TextTypeToggled   = gdnative.NewLazyMethodBind("ColorPicker", "_text_type_toggled")
+	methodBindColorPickerX_UpdatePresets     = gdnative.NewLazyMethodBind("ColorPicker", "_update_presets")
+	methodBindColorPickerX_UvInput           = gdnative.NewLazyMethodBind("ColorPicker", "_uv_input")
+	methodBindColorPickerX_ValueChanged      = gdnative.NewLazyMethodBind("ColorPicker", "_value_changed")
+	methodBindColorPickerX_WInput            = gdnative.NewLazyMethodBind("ColorPicker", "_w_input")
+	methodBindColorPickerAddPreset           = gdnative.NewLazyMethodBind("ColorPicker", "add_preset")
+	methodBindColorPickerGetPickColor        = gdnative.NewLazyMethodBind("ColorPicker", "get_pick_color")
+	methodBindColorPickerIsEditingAlpha      = gdnative.NewLazyMethodBind("ColorPicker", "is_editing_alpha")
+	methodBindColorPickerIsRawMode           = gdnative.NewLazyMethodBind("ColorPicker", "is_raw_mode")
+	methodBindColorPickerSetEditAlpha        = gdnative.NewLazyMethodBind("ColorPicker", "set_edit_alpha")
+	methodBindColorPickerSetPickColor        = gdnative.NewLazyMethodBind("ColorPicker", "set_pick_color")
+	methodBindColorPickerSetRawMode          = gdnative.NewLazyMethodBind("ColorPicker", "set_raw_mode")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ColorPicker) X_AddPresetPressed() {
 	//log.Println("Calling ColorPicker.X_AddPresetPressed()")
@@ -45,7 +75,7 @@ func (o *ColorPicker) X_AddPresetPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_add_preset_pressed")
+	methodBind := methodBindColorPickerX_AddPresetPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -55,19 +85,19 @@ func (o *ColorPicker) X_AddPresetPressed() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 Object}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 Object}], Returns: void
 */
-func (o *ColorPicker) X_HsvDraw(arg0 gdnative.Int, arg1 ObjectImplementer) {
+func (o *ColorPicker) X_HsvDraw(arg0 gdnative.Int, arg1 godot.ObjectImplementer) {
 	//log.Println("Calling ColorPicker.X_HsvDraw()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromObject(arg1.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg1))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_hsv_draw")
+	methodBind := methodBindColorPickerX_HsvDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +107,8 @@ func (o *ColorPicker) X_HsvDraw(arg0 gdnative.Int, arg1 ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ColorPicker) X_HtmlEntered(arg0 gdnative.String) {
 	//log.Println("Calling ColorPicker.X_HtmlEntered()")
@@ -88,7 +118,7 @@ func (o *ColorPicker) X_HtmlEntered(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_html_entered")
+	methodBind := methodBindColorPickerX_HtmlEntered.Get()
 
 	// Call the parent method.
 	// void
@@ -98,18 +128,18 @@ func (o *ColorPicker) X_HtmlEntered(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ColorPicker) X_PresetInput(arg0 InputEventImplementer) {
+func (o *ColorPicker) X_PresetInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ColorPicker.X_PresetInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_preset_input")
+	methodBind := methodBindColorPickerX_PresetInput.Get()
 
 	// Call the parent method.
 	// void
@@ -119,8 +149,8 @@ func (o *ColorPicker) X_PresetInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ColorPicker) X_SampleDraw() {
 	//log.Println("Calling ColorPicker.X_SampleDraw()")
@@ -129,7 +159,7 @@ func (o *ColorPicker) X_SampleDraw() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_sample_draw")
+	methodBind := methodBindColorPickerX_SampleDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -139,18 +169,18 @@ func (o *ColorPicker) X_SampleDraw() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ColorPicker) X_ScreenInput(arg0 InputEventImplementer) {
+func (o *ColorPicker) X_ScreenInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ColorPicker.X_ScreenInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_screen_input")
+	methodBind := methodBindColorPickerX_ScreenInput.Get()
 
 	// Call the parent method.
 	// void
@@ -160,8 +190,8 @@ func (o *ColorPicker) X_ScreenInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ColorPicker) X_ScreenPickPressed() {
 	//log.Println("Calling ColorPicker.X_ScreenPickPressed()")
@@ -170,7 +200,7 @@ func (o *ColorPicker) X_ScreenPickPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_screen_pick_pressed")
+	methodBind := methodBindColorPickerX_ScreenPickPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -180,8 +210,8 @@ func (o *ColorPicker) X_ScreenPickPressed() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ColorPicker) X_TextTypeToggled() {
 	//log.Println("Calling ColorPicker.X_TextTypeToggled()")
@@ -190,7 +220,7 @@ func (o *ColorPicker) X_TextTypeToggled() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_text_type_toggled")
+	methodBind := methodBindColorPickerX_TextTypeToggled.Get()
 
 	// Call the parent method.
 	// void
@@ -200,8 +230,8 @@ func (o *ColorPicker) X_TextTypeToggled() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ColorPicker) X_UpdatePresets() {
 	//log.Println("Calling ColorPicker.X_UpdatePresets()")
@@ -210,7 +240,7 @@ func (o *ColorPicker) X_UpdatePresets() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_update_presets")
+	methodBind := methodBindColorPickerX_UpdatePresets.Get()
 
 	// Call the parent method.
 	// void
@@ -220,18 +250,18 @@ func (o *ColorPicker) X_UpdatePresets() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ColorPicker) X_UvInput(arg0 InputEventImplementer) {
+func (o *ColorPicker) X_UvInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ColorPicker.X_UvInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_uv_input")
+	methodBind := methodBindColorPickerX_UvInput.Get()
 
 	// Call the parent method.
 	// void
@@ -241,8 +271,8 @@ func (o *ColorPicker) X_UvInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *ColorPicker) X_ValueChanged(arg0 gdnative.Real) {
 	//log.Println("Calling ColorPicker.X_ValueChanged()")
@@ -252,7 +282,7 @@ func (o *ColorPicker) X_ValueChanged(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_value_changed")
+	methodBind := methodBindColorPickerX_ValueChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -262,18 +292,18 @@ func (o *ColorPicker) X_ValueChanged(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ColorPicker) X_WInput(arg0 InputEventImplementer) {
+func (o *ColorPicker) X_WInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ColorPicker.X_WInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "_w_input")
+	methodBind := methodBindColorPickerX_WInput.Get()
 
 	// Call the parent method.
 	// void
@@ -283,8 +313,8 @@ func (o *ColorPicker) X_WInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Adds the current selected to color to a list of colors (presets), the presets will be displayed in the color picker and the user will be able to select them, notice that the presets list is only for this color picker.
-	Args: [{ false color Color}], Returns: void
+	        Adds the current selected to color to a list of colors (presets), the presets will be displayed in the color picker and the user will be able to select them, notice that the presets list is only for this color picker.
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ColorPicker) AddPreset(color gdnative.Color) {
 	//log.Println("Calling ColorPicker.AddPreset()")
@@ -294,7 +324,7 @@ func (o *ColorPicker) AddPreset(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "add_preset")
+	methodBind := methodBindColorPickerAddPreset.Get()
 
 	// Call the parent method.
 	// void
@@ -304,8 +334,8 @@ func (o *ColorPicker) AddPreset(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ColorPicker) GetPickColor() gdnative.Color {
 	//log.Println("Calling ColorPicker.GetPickColor()")
@@ -314,7 +344,7 @@ func (o *ColorPicker) GetPickColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "get_pick_color")
+	methodBind := methodBindColorPickerGetPickColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -327,8 +357,8 @@ func (o *ColorPicker) GetPickColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ColorPicker) IsEditingAlpha() gdnative.Bool {
 	//log.Println("Calling ColorPicker.IsEditingAlpha()")
@@ -337,7 +367,7 @@ func (o *ColorPicker) IsEditingAlpha() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "is_editing_alpha")
+	methodBind := methodBindColorPickerIsEditingAlpha.Get()
 
 	// Call the parent method.
 	// bool
@@ -350,8 +380,8 @@ func (o *ColorPicker) IsEditingAlpha() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ColorPicker) IsRawMode() gdnative.Bool {
 	//log.Println("Calling ColorPicker.IsRawMode()")
@@ -360,7 +390,7 @@ func (o *ColorPicker) IsRawMode() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "is_raw_mode")
+	methodBind := methodBindColorPickerIsRawMode.Get()
 
 	// Call the parent method.
 	// bool
@@ -373,8 +403,8 @@ func (o *ColorPicker) IsRawMode() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false show bool}], Returns: void
+	        Undocumented
+		Args: [{ false show bool}], Returns: void
 */
 func (o *ColorPicker) SetEditAlpha(show gdnative.Bool) {
 	//log.Println("Calling ColorPicker.SetEditAlpha()")
@@ -384,7 +414,7 @@ func (o *ColorPicker) SetEditAlpha(show gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(show)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "set_edit_alpha")
+	methodBind := methodBindColorPickerSetEditAlpha.Get()
 
 	// Call the parent method.
 	// void
@@ -394,8 +424,8 @@ func (o *ColorPicker) SetEditAlpha(show gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ColorPicker) SetPickColor(color gdnative.Color) {
 	//log.Println("Calling ColorPicker.SetPickColor()")
@@ -405,7 +435,7 @@ func (o *ColorPicker) SetPickColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "set_pick_color")
+	methodBind := methodBindColorPickerSetPickColor.Get()
 
 	// Call the parent method.
 	// void
@@ -415,8 +445,8 @@ func (o *ColorPicker) SetPickColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode bool}], Returns: void
+	        Undocumented
+		Args: [{ false mode bool}], Returns: void
 */
 func (o *ColorPicker) SetRawMode(mode gdnative.Bool) {
 	//log.Println("Calling ColorPicker.SetRawMode()")
@@ -426,7 +456,7 @@ func (o *ColorPicker) SetRawMode(mode gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorPicker", "set_raw_mode")
+	methodBind := methodBindColorPickerSetRawMode.Get()
 
 	// Call the parent method.
 	// void
@@ -435,22 +465,70 @@ func (o *ColorPicker) SetRawMode(mode gdnative.Bool) {
 
 }
 
+// Color will return the value of the "color" property.
+func (o *ColorPicker) Color() gdnative.Color {
+	return o.GetPickColor()
+}
+
+// SetColor will set the value of the "color" property.
+func (o *ColorPicker) SetColor(value gdnative.Color) {
+	o.SetPickColor(value)
+}
+
+// EditAlpha will return the value of the "edit_alpha" property.
+func (o *ColorPicker) EditAlpha() gdnative.Bool {
+	return o.IsEditingAlpha()
+}
+
+// RawMode will return the value of the "raw_mode" property.
+func (o *ColorPicker) RawMode() gdnative.Bool {
+	return o.IsRawMode()
+}
+
+// Signals of the ColorPicker class.
+const (
+	ColorPickerSignalColorChanged gdnative.String = "color_changed"
+)
+
+// ColorPickerColorChangedArgs holds the arguments of the "color_changed" signal.
+type ColorPickerColorChangedArgs struct {
+	Color gdnative.Color
+}
+
+// ConnectColorChanged will connect the "color_changed" signal to the given method of the target object.
+func (o *ColorPicker) ConnectColorChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ColorPickerSignalColorChanged, target, method, binds, 0)
+}
+
+// EmitColorChanged will emit the "color_changed" signal.
+func (o *ColorPicker) EmitColorChanged(args ColorPickerColorChangedArgs) error {
+	arg0 := gdnative.NewVariantColor(args.Color)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ColorPickerSignalColorChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
 // ColorPickerImplementer is an interface that implements the methods
 // of the ColorPicker class.
 type ColorPickerImplementer interface {
 	BoxContainerImplementer
 	X_AddPresetPressed()
-	X_HsvDraw(arg0 gdnative.Int, arg1 ObjectImplementer)
+	X_HsvDraw(arg0 gdnative.Int, arg1 godot.ObjectImplementer)
 	X_HtmlEntered(arg0 gdnative.String)
-	X_PresetInput(arg0 InputEventImplementer)
+	X_PresetInput(arg0 godot.InputEventImplementer)
 	X_SampleDraw()
-	X_ScreenInput(arg0 InputEventImplementer)
+	X_ScreenInput(arg0 godot.InputEventImplementer)
 	X_ScreenPickPressed()
 	X_TextTypeToggled()
 	X_UpdatePresets()
-	X_UvInput(arg0 InputEventImplementer)
+	X_UvInput(arg0 godot.InputEventImplementer)
 	X_ValueChanged(arg0 gdnative.Real)
-	X_WInput(arg0 InputEventImplementer)
+	X_WInput(arg0 godot.InputEventImplementer)
 	AddPreset(color gdnative.Color)
 	GetPickColor() gdnative.Color
 	IsEditingAlpha() gdnative.Bool
@@ -458,4 +536,10 @@ type ColorPickerImplementer interface {
 	SetEditAlpha(show gdnative.Bool)
 	SetPickColor(color gdnative.Color)
 	SetRawMode(mode gdnative.Bool)
+	Color() gdnative.Color
+	SetColor(value gdnative.Color)
+	EditAlpha() gdnative.Bool
+	RawMode() gdnative.Bool
+	ConnectColorChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitColorChanged(args ColorPickerColorChangedArgs) error
 }
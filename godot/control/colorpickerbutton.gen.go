@@ -0,0 +1,294 @@
+package control
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/popup"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Encapsulates a [ColorPicker] making it accesible by pressing a button, pressing the button will toggle the [ColorPicker] visibility
+*/
+type ColorPickerButton struct {
+	Button
+	owner gdnative.Object
+}
+
+// classConstructorColorPickerButton is the class constructor of the ColorPickerButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorColorPickerButton = gdnative.NewLazyClassConstructor("ColorPickerButton")
+
+// NewColorPickerButton will create a new instance of the ColorPickerButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewColorPickerButton() *ColorPickerButton {
+	obj := &ColorPickerButton{}
+	obj.SetBaseObject(classConstructorColorPickerButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *ColorPickerButton) BaseClass() string {
+	return "ColorPickerButton"
+}
+
+// Method binds of the ColorPickerButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindColorPickerButtonX_ColorChanged = gdnative.NewLazyMethodBind("ColorPickerButton", "_color_changed")
+	methodBindColorPickerButtonGetPickColor   = gdnative.NewLazyMethodBind("ColorPickerButton", "get_pick_color")
+	methodBindColorPickerButtonGetPicker      = gdnative.NewLazyMethodBind("ColorPickerButton", "get_picker")
+	methodBindColorPickerButtonGetPopup       = gdnative.NewLazyMethodBind("ColorPickerButton", "get_popup")
+	methodBindColorPickerButtonIsEditingAlpha = gdnative.NewLazyMethodBind("ColorPickerButton", "is_editing_alpha")
+	methodBindColorPickerButtonSetEditAlpha   = gdnative.NewLazyMethodBind("ColorPickerButton", "set_edit_alpha")
+	methodBindColorPickerButtonSetPickColor   = gdnative.NewLazyMethodBind("ColorPickerButton", "set_pick_color")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Color}], Returns: void
+*/
+func (o *ColorPickerButton) X_ColorChanged(arg0 gdnative.Color) {
+	//log.Println("Calling ColorPickerButton.X_ColorChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(arg0)
+
+	// Get the method bind
+	methodBind := methodBindColorPickerButtonX_ColorChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *ColorPickerButton) GetPickColor() gdnative.Color {
+	//log.Println("Calling ColorPickerButton.GetPickColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindColorPickerButtonGetPickColor.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the [code]ColorPicker[/code] that this [code]ColorPickerButton[/code] toggles.
+		Args: [], Returns: ColorPicker
+*/
+func (o *ColorPickerButton) GetPicker() ColorPickerImplementer {
+	//log.Println("Calling ColorPickerButton.GetPicker()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindColorPickerButtonGetPicker.Get()
+
+	// Call the parent method.
+	// ColorPicker
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := ColorPicker{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ColorPickerImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the control's [PopupPanel] which allows you to connect to Popup Signals. This allows you to handle events when the ColorPicker is shown or hidden.
+		Args: [], Returns: PopupPanel
+*/
+func (o *ColorPickerButton) GetPopup() popup.PopupPanelImplementer {
+	//log.Println("Calling ColorPickerButton.GetPopup()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindColorPickerButtonGetPopup.Get()
+
+	// Call the parent method.
+	// PopupPanel
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := popup.PopupPanel{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(popup.PopupPanelImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *ColorPickerButton) IsEditingAlpha() gdnative.Bool {
+	//log.Println("Calling ColorPickerButton.IsEditingAlpha()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindColorPickerButtonIsEditingAlpha.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false show bool}], Returns: void
+*/
+func (o *ColorPickerButton) SetEditAlpha(show gdnative.Bool) {
+	//log.Println("Calling ColorPickerButton.SetEditAlpha()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(show)
+
+	// Get the method bind
+	methodBind := methodBindColorPickerButtonSetEditAlpha.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
+*/
+func (o *ColorPickerButton) SetPickColor(color gdnative.Color) {
+	//log.Println("Calling ColorPickerButton.SetPickColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(color)
+
+	// Get the method bind
+	methodBind := methodBindColorPickerButtonSetPickColor.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Color will return the value of the "color" property.
+func (o *ColorPickerButton) Color() gdnative.Color {
+	return o.GetPickColor()
+}
+
+// SetColor will set the value of the "color" property.
+func (o *ColorPickerButton) SetColor(value gdnative.Color) {
+	o.SetPickColor(value)
+}
+
+// EditAlpha will return the value of the "edit_alpha" property.
+func (o *ColorPickerButton) EditAlpha() gdnative.Bool {
+	return o.IsEditingAlpha()
+}
+
+// Signals of the ColorPickerButton class.
+const (
+	ColorPickerButtonSignalColorChanged gdnative.String = "color_changed"
+)
+
+// ColorPickerButtonColorChangedArgs holds the arguments of the "color_changed" signal.
+type ColorPickerButtonColorChangedArgs struct {
+	Color gdnative.Color
+}
+
+// ConnectColorChanged will connect the "color_changed" signal to the given method of the target object.
+func (o *ColorPickerButton) ConnectColorChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ColorPickerButtonSignalColorChanged, target, method, binds, 0)
+}
+
+// EmitColorChanged will emit the "color_changed" signal.
+func (o *ColorPickerButton) EmitColorChanged(args ColorPickerButtonColorChangedArgs) error {
+	arg0 := gdnative.NewVariantColor(args.Color)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ColorPickerButtonSignalColorChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ColorPickerButtonImplementer is an interface that implements the methods
+// of the ColorPickerButton class.
+type ColorPickerButtonImplementer interface {
+	ButtonImplementer
+	X_ColorChanged(arg0 gdnative.Color)
+	GetPickColor() gdnative.Color
+	GetPicker() ColorPickerImplementer
+	GetPopup() popup.PopupPanelImplementer
+	IsEditingAlpha() gdnative.Bool
+	SetEditAlpha(show gdnative.Bool)
+	SetPickColor(color gdnative.Color)
+	Color() gdnative.Color
+	SetColor(value gdnative.Color)
+	EditAlpha() gdnative.Bool
+	ConnectColorChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitColorChanged(args ColorPickerButtonColorChangedArgs) error
+}
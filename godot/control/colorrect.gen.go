@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,42 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewColorRectFromPointer(ptr gdnative.Pointer) ColorRect {
-func newColorRectFromPointer(ptr gdnative.Pointer) ColorRect {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ColorRect{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 An object that is represented on the canvas as a rect with color. [Color] is used to set or get color info for the rect.
 */
 type ColorRect struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorColorRect is the class constructor of the ColorRect class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorColorRect = gdnative.NewLazyClassConstructor("ColorRect")
+
+// NewColorRect will create a new instance of the ColorRect class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewColorRect() *ColorRect {
+	obj := &ColorRect{}
+	obj.SetBaseObject(classConstructorColorRect.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ColorRect) BaseClass() string {
 	return "ColorRect"
 }
 
+// Method binds of the ColorRect class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindColorRectGetFrameColor = gdnative.NewLazyMethodBind("ColorRect", "get_frame_color")
+	methodBindColorRectSetFrameColor = gdnative.NewLazyMethodBind("ColorRect", "set_frame_color")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ColorRect) GetFrameColor() gdnative.Color {
 	//log.Println("Calling ColorRect.GetFrameColor()")
@@ -45,7 +58,7 @@ func (o *ColorRect) GetFrameColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorRect", "get_frame_color")
+	methodBind := methodBindColorRectGetFrameColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -58,8 +71,8 @@ func (o *ColorRect) GetFrameColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ColorRect) SetFrameColor(color gdnative.Color) {
 	//log.Println("Calling ColorRect.SetFrameColor()")
@@ -69,7 +82,7 @@ func (o *ColorRect) SetFrameColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ColorRect", "set_frame_color")
+	methodBind := methodBindColorRectSetFrameColor.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,22 @@ func (o *ColorRect) SetFrameColor(color gdnative.Color) {
 
 }
 
+// Color will return the value of the "color" property.
+func (o *ColorRect) Color() gdnative.Color {
+	return o.GetFrameColor()
+}
+
+// SetColor will set the value of the "color" property.
+func (o *ColorRect) SetColor(value gdnative.Color) {
+	o.SetFrameColor(value)
+}
+
 // ColorRectImplementer is an interface that implements the methods
 // of the ColorRect class.
 type ColorRectImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 	GetFrameColor() gdnative.Color
 	SetFrameColor(color gdnative.Color)
+	Color() gdnative.Color
+	SetColor(value gdnative.Color)
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewConfirmationDialogFromPointer(ptr gdnative.Pointer) ConfirmationDialog {
-func newConfirmationDialogFromPointer(ptr gdnative.Pointer) ConfirmationDialog {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ConfirmationDialog{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Dialog for confirmation of actions. This dialog inherits from [AcceptDialog], but has by default an OK and Cancel button (in host OS order).
 */
@@ -30,13 +22,33 @@ type ConfirmationDialog struct {
 	owner gdnative.Object
 }
 
+// classConstructorConfirmationDialog is the class constructor of the ConfirmationDialog class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorConfirmationDialog = gdnative.NewLazyClassConstructor("ConfirmationDialog")
+
+// NewConfirmationDialog will create a new instance of the ConfirmationDialog class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewConfirmationDialog() *ConfirmationDialog {
+	obj := &ConfirmationDialog{}
+	obj.SetBaseObject(classConstructorConfirmationDialog.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ConfirmationDialog) BaseClass() string {
 	return "ConfirmationDialog"
 }
 
+// Method binds of the ConfirmationDialog class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindConfirmationDialogGetCancel = gdnative.NewLazyMethodBind("ConfirmationDialog", "get_cancel")
+)
+
 /*
-        Return the cancel button.
-	Args: [], Returns: Button
+	        Return the cancel button.
+		Args: [], Returns: Button
 */
 func (o *ConfirmationDialog) GetCancel() ButtonImplementer {
 	//log.Println("Calling ConfirmationDialog.GetCancel()")
@@ -45,7 +57,7 @@ func (o *ConfirmationDialog) GetCancel() ButtonImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConfirmationDialog", "get_cancel")
+	methodBind := methodBindConfirmationDialogGetCancel.Get()
 
 	// Call the parent method.
 	// Button
@@ -53,19 +65,17 @@ func (o *ConfirmationDialog) GetCancel() ButtonImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newButtonFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ButtonImplementer)
-	}
+	ret := Button{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
 
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Button" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ButtonImplementer)
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ButtonImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
@@ -0,0 +1,170 @@
+package control
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// Constants of the Container class.
+const (
+	ContainerNotificationSortChildren gdnative.Int = 50
+)
+
+/*
+Base node for containers. A [code]Container[/code] contains other controls and automatically arranges them in a certain way. A Control can inherit this to create custom container classes.
+*/
+type Container struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorContainer is the class constructor of the Container class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorContainer = gdnative.NewLazyClassConstructor("Container")
+
+// NewContainer will create a new instance of the Container class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewContainer() *Container {
+	obj := &Container{}
+	obj.SetBaseObject(classConstructorContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Container) BaseClass() string {
+	return "Container"
+}
+
+// Method binds of the Container class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindContainerX_ChildMinsizeChanged = gdnative.NewLazyMethodBind("Container", "_child_minsize_changed")
+	methodBindContainerX_SortChildren        = gdnative.NewLazyMethodBind("Container", "_sort_children")
+	methodBindContainerFitChildInRect        = gdnative.NewLazyMethodBind("Container", "fit_child_in_rect")
+	methodBindContainerQueueSort             = gdnative.NewLazyMethodBind("Container", "queue_sort")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Container) X_ChildMinsizeChanged() {
+	//log.Println("Calling Container.X_ChildMinsizeChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindContainerX_ChildMinsizeChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Container) X_SortChildren() {
+	//log.Println("Calling Container.X_SortChildren()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindContainerX_SortChildren.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Fit a child control in a given rect. This is mainly a helper for creating custom container classes.
+		Args: [{ false child Object} { false rect Rect2}], Returns: void
+*/
+func (o *Container) FitChildInRect(child godot.ObjectImplementer, rect gdnative.Rect2) {
+	//log.Println("Calling Container.FitChildInRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(child))
+	ptrArguments[1] = gdnative.NewPointerFromRect2(rect)
+
+	// Get the method bind
+	methodBind := methodBindContainerFitChildInRect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Queue resort of the contained children. This is called automatically anyway, but can be called upon request.
+		Args: [], Returns: void
+*/
+func (o *Container) QueueSort() {
+	//log.Println("Calling Container.QueueSort()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindContainerQueueSort.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Signals of the Container class.
+const (
+	ContainerSignalSortChildren gdnative.String = "sort_children"
+)
+
+// ConnectSortChildren will connect the "sort_children" signal to the given method of the target object.
+func (o *Container) ConnectSortChildren(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ContainerSignalSortChildren, target, method, binds, 0)
+}
+
+// EmitSortChildren will emit the "sort_children" signal.
+func (o *Container) EmitSortChildren() error {
+
+	ret, err := o.EmitSignal(ContainerSignalSortChildren)
+	ret.Destroy()
+	return err
+}
+
+// ContainerImplementer is an interface that implements the methods
+// of the Container class.
+type ContainerImplementer interface {
+	godot.ControlImplementer
+	X_ChildMinsizeChanged()
+	X_SortChildren()
+	FitChildInRect(child godot.ObjectImplementer, rect gdnative.Rect2)
+	QueueSort()
+	ConnectSortChildren(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSortChildren() error
+}
@@ -0,0 +1,265 @@
+package control
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("AcceptDialog", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AcceptDialog{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("BoxContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &BoxContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Button", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Button{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CenterContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CenterContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CheckBox", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CheckBox{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CheckButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CheckButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ColorPicker", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ColorPicker{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ColorPickerButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ColorPickerButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ColorRect", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ColorRect{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ConfirmationDialog", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ConfirmationDialog{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Container", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Container{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("FileDialog", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &FileDialog{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GraphEdit", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GraphEdit{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GraphNode", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GraphNode{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GridContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GridContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HBoxContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HBoxContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HScrollBar", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HScrollBar{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HSeparator", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HSeparator{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HSlider", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HSlider{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HSplitContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HSplitContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ItemList", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ItemList{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Label", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Label{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("LineEdit", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &LineEdit{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("LinkButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &LinkButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("MarginContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &MarginContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("MenuButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &MenuButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("NinePatchRect", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &NinePatchRect{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("OptionButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &OptionButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Panel", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Panel{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PanelContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PanelContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ProgressBar", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ProgressBar{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RichTextLabel", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RichTextLabel{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ScriptEditor", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ScriptEditor{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ScrollBar", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ScrollBar{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ScrollContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ScrollContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Separator", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Separator{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SpinBox", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SpinBox{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SplitContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SplitContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("TabContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &TabContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Tabs", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Tabs{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("TextEdit", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &TextEdit{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ToolButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ToolButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VBoxContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VBoxContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VScrollBar", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VScrollBar{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VSeparator", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VSeparator{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VSlider", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VSlider{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VSplitContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VSplitContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VideoPlayer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VideoPlayer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("WindowDialog", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &WindowDialog{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,10 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +25,32 @@ const (
 	FileDialogAccessUserdata   FileDialogAccess = 1
 )
 
+// String will return the Godot name of the FileDialogAccess value.
+func (e FileDialogAccess) String() string {
+	switch e {
+	case FileDialogAccessFilesystem:
+		return "ACCESS_FILESYSTEM"
+	case FileDialogAccessResources:
+		return "ACCESS_RESOURCES"
+	case FileDialogAccessUserdata:
+		return "ACCESS_USERDATA"
+	}
+	return fmt.Sprintf("FileDialogAccess(%d)", int(e))
+}
+
+// ParseFileDialogAccess will return the FileDialogAccess value with the given Godot name.
+func ParseFileDialogAccess(name string) (FileDialogAccess, error) {
+	switch name {
+	case "ACCESS_FILESYSTEM":
+		return FileDialogAccessFilesystem, nil
+	case "ACCESS_RESOURCES":
+		return FileDialogAccessResources, nil
+	case "ACCESS_USERDATA":
+		return FileDialogAccessUserdata, nil
+	}
+	return 0, fmt.Errorf("invalid FileDialogAccess value %q", name)
+}
+
 // FileDialogMode is an enum for Mode values.
 type FileDialogMode int
 
@@ -33,13 +62,38 @@ const (
 	FileDialogModeSaveFile  FileDialogMode = 4
 )
 
-//func NewFileDialogFromPointer(ptr gdnative.Pointer) FileDialog {
-func newFileDialogFromPointer(ptr gdnative.Pointer) FileDialog {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := FileDialog{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the FileDialogMode value.
+func (e FileDialogMode) String() string {
+	switch e {
+	case FileDialogModeOpenAny:
+		return "MODE_OPEN_ANY"
+	case FileDialogModeOpenDir:
+		return "MODE_OPEN_DIR"
+	case FileDialogModeOpenFile:
+		return "MODE_OPEN_FILE"
+	case FileDialogModeOpenFiles:
+		return "MODE_OPEN_FILES"
+	case FileDialogModeSaveFile:
+		return "MODE_SAVE_FILE"
+	}
+	return fmt.Sprintf("FileDialogMode(%d)", int(e))
+}
 
-	return obj
+// ParseFileDialogMode will return the FileDialogMode value with the given Godot name.
+func ParseFileDialogMode(name string) (FileDialogMode, error) {
+	switch name {
+	case "MODE_OPEN_ANY":
+		return FileDialogModeOpenAny, nil
+	case "MODE_OPEN_DIR":
+		return FileDialogModeOpenDir, nil
+	case "MODE_OPEN_FILE":
+		return FileDialogModeOpenFile, nil
+	case "MODE_OPEN_FILES":
+		return FileDialogModeOpenFiles, nil
+	case "MODE_SAVE_FILE":
+		return FileDialogModeSaveFile, nil
+	}
+	return 0, fmt.Errorf("invalid FileDialogMode value %q", name)
 }
 
 /*
@@ -50,13 +104,68 @@ type FileDialog struct {
 	owner gdnative.Object
 }
 
+// classConstructorFileDialog is the class constructor of the FileDialog class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorFileDialog = gdnative.NewLazyClassConstructor("FileDialog")
+
+// NewFileDialog will create a new instance of the FileDialog class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewFileDialog() *FileDialog {
+	obj := &FileDialog{}
+	obj.SetBaseObject(classConstructorFileDialog.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *FileDialog) BaseClass() string {
 	return "FileDialog"
 }
 
+// Method binds of the FileDialog class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindFileDialogX_ActionPressed       = gdnative.NewLazyMethodBind("FileDialog", "_action_pressed")
+	methodBindFileDialogX_CancelPressed       = gdnative.NewLazyMethodBind("FileDialog", "_cancel_pressed")
+	methodBindFileDialogX_DirEntered          = gdnative.NewLazyMethodBind("FileDialog", "_dir_entered")
+	methodBindFileDialogX_FileEntered         = gdnative.NewLazyMethodBind("FileDialog", "_file_entered")
+	methodBindFileDialogX_FilterSelected      = gdnative.NewLazyMethodBind("FileDialog", "_filter_selected")
+	methodBindFileDialogX_GoUp                = gdnative.NewLazyMethodBind("FileDialog", "_go_up")
+	methodBindFileDialogX_MakeDir             = gdnative.NewLazyMethodBind("FileDialog", "_make_dir")
+	methodBindFileDialogX_MakeDirConfirm      = gdnative.NewLazyMethodBind("FileDialog", "_make_dir_confirm")
+	methodBindFileDialogX_SaveConfirmPressed  = gdnative.NewLazyMethodBind("FileDialog", "_save_confirm_pressed")
+	methodBindFileDialogX_SelectDrive         = gdnative.NewLazyMethodBind("FileDialog", "_select_drive")
+	methodBindFileDialogX_TreeDbSelected      = gdnative.NewLazyMethodBind("FileDialog", "_tree_db_selected")
+	methodBindFileDialogX_TreeSelected        = gdnative.NewLazyMethodBind("FileDialog", "_tree_selected")
+	methodBindFileDialogX_UnhandledInput      = gdnative.NewLazyMethodBind("FileDialog", "_unhandled_input")
+	methodBindFileDialogX_UpdateDir           = gdnative.NewLazyMethodBind("FileDialog", "_update_dir")
+	methodBindFileDialogX_UpdateFileList      = gdnative.NewLazyMethodBind("FileDialog", "_update_file_list")
+	methodBindFileDialogAddFilter             = gdnative.NewLazyMethodBind("FileDialog", "add_filter")
+	methodBindFileDialogClearFilters          = gdnative.NewLazyMethodBind("FileDialog", "clear_filters")
+	methodBindFileDialogDeselectItems         = gdnative.NewLazyMethodBind("FileDialog", "deselect_items")
+	methodBindFileDialogGetAccess             = gdnative.NewLazyMethodBind("FileDialog", "get_access")
+	methodBindFileDialogGetCurrentDir         = gdnative.NewLazyMethodBind("FileDialog", "get_current_dir")
+	methodBindFileDialogGetCurrentFile        = gdnative.NewLazyMethodBind("FileDialog", "get_current_file")
+	methodBindFileDialogGetCurrentPath        = gdnative.NewLazyMethodBind("FileDialog", "get_current_path")
+	methodBindFileDialogGetFilters            = gdnative.NewLazyMethodBind("FileDialog", "get_filters")
+	methodBindFileDialogGetMode               = gdnative.NewLazyMethodBind("FileDialog", "get_mode")
+	methodBindFileDialogGetVbox               = gdnative.NewLazyMethodBind("FileDialog", "get_vbox")
+	methodBindFileDialogInvalidate            = gdnative.NewLazyMethodBind("FileDialog", "invalidate")
+	methodBindFileDialogIsModeOverridingTitle = gdnative.NewLazyMethodBind("FileDialog", "is_mode_overriding_title")
+	methodBindFileDialogIsShowingHiddenFiles  = gdnative.NewLazyMethodBind("FileDialog", "is_showing_hidden_files")
+	methodBindFileDialogSetAccess             = gdnative.NewLazyMethodBind("FileDialog", "set_access")
+	methodBindFileDialogSetCurrentDir         = gdnative.NewLazyMethodBind("FileDialog", "set_current_dir")
+	methodBindFileDialogSetCurrentFile        = gdnative.NewLazyMethodBind("FileDialog", "set_current_file")
+	methodBindFileDialogSetCurrentPath        = gdnative.NewLazyMethodBind("FileDialog", "set_current_path")
+	methodBindFileDialogSetFilters            = gdnative.NewLazyMethodBind("FileDialog", "set_filters")
+	methodBindFileDialogSetMode               = gdnative.NewLazyMethodBind("FileDialog", "set_mode")
+	methodBindFileDialogSetModeOverridesTitle = gdnative.NewLazyMethodBind("FileDialog", "set_mode_overrides_title")
+	methodBindFileDialogSetShowHiddenFiles    = gdnative.NewLazyMethodBind("FileDialog", "set_show_hidden_files")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_ActionPressed() {
 	//log.Println("Calling FileDialog.X_ActionPressed()")
@@ -65,7 +174,7 @@ func (o *FileDialog) X_ActionPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_action_pressed")
+	methodBind := methodBindFileDialogX_ActionPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -75,8 +184,8 @@ func (o *FileDialog) X_ActionPressed() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_CancelPressed() {
 	//log.Println("Calling FileDialog.X_CancelPressed()")
@@ -85,7 +194,7 @@ func (o *FileDialog) X_CancelPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_cancel_pressed")
+	methodBind := methodBindFileDialogX_CancelPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -95,8 +204,8 @@ func (o *FileDialog) X_CancelPressed() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *FileDialog) X_DirEntered(arg0 gdnative.String) {
 	//log.Println("Calling FileDialog.X_DirEntered()")
@@ -106,7 +215,7 @@ func (o *FileDialog) X_DirEntered(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_dir_entered")
+	methodBind := methodBindFileDialogX_DirEntered.Get()
 
 	// Call the parent method.
 	// void
@@ -116,8 +225,8 @@ func (o *FileDialog) X_DirEntered(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *FileDialog) X_FileEntered(arg0 gdnative.String) {
 	//log.Println("Calling FileDialog.X_FileEntered()")
@@ -127,7 +236,7 @@ func (o *FileDialog) X_FileEntered(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_file_entered")
+	methodBind := methodBindFileDialogX_FileEntered.Get()
 
 	// Call the parent method.
 	// void
@@ -137,8 +246,8 @@ func (o *FileDialog) X_FileEntered(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *FileDialog) X_FilterSelected(arg0 gdnative.Int) {
 	//log.Println("Calling FileDialog.X_FilterSelected()")
@@ -148,7 +257,7 @@ func (o *FileDialog) X_FilterSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_filter_selected")
+	methodBind := methodBindFileDialogX_FilterSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -158,8 +267,8 @@ func (o *FileDialog) X_FilterSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_GoUp() {
 	//log.Println("Calling FileDialog.X_GoUp()")
@@ -168,7 +277,7 @@ func (o *FileDialog) X_GoUp() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_go_up")
+	methodBind := methodBindFileDialogX_GoUp.Get()
 
 	// Call the parent method.
 	// void
@@ -178,8 +287,8 @@ func (o *FileDialog) X_GoUp() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_MakeDir() {
 	//log.Println("Calling FileDialog.X_MakeDir()")
@@ -188,7 +297,7 @@ func (o *FileDialog) X_MakeDir() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_make_dir")
+	methodBind := methodBindFileDialogX_MakeDir.Get()
 
 	// Call the parent method.
 	// void
@@ -198,8 +307,8 @@ func (o *FileDialog) X_MakeDir() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_MakeDirConfirm() {
 	//log.Println("Calling FileDialog.X_MakeDirConfirm()")
@@ -208,7 +317,7 @@ func (o *FileDialog) X_MakeDirConfirm() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_make_dir_confirm")
+	methodBind := methodBindFileDialogX_MakeDirConfirm.Get()
 
 	// Call the parent method.
 	// void
@@ -218,8 +327,8 @@ func (o *FileDialog) X_MakeDirConfirm() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_SaveConfirmPressed() {
 	//log.Println("Calling FileDialog.X_SaveConfirmPressed()")
@@ -228,7 +337,7 @@ func (o *FileDialog) X_SaveConfirmPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_save_confirm_pressed")
+	methodBind := methodBindFileDialogX_SaveConfirmPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -238,8 +347,8 @@ func (o *FileDialog) X_SaveConfirmPressed() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *FileDialog) X_SelectDrive(arg0 gdnative.Int) {
 	//log.Println("Calling FileDialog.X_SelectDrive()")
@@ -249,7 +358,7 @@ func (o *FileDialog) X_SelectDrive(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_select_drive")
+	methodBind := methodBindFileDialogX_SelectDrive.Get()
 
 	// Call the parent method.
 	// void
@@ -259,8 +368,8 @@ func (o *FileDialog) X_SelectDrive(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_TreeDbSelected() {
 	//log.Println("Calling FileDialog.X_TreeDbSelected()")
@@ -269,7 +378,7 @@ func (o *FileDialog) X_TreeDbSelected() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_tree_db_selected")
+	methodBind := methodBindFileDialogX_TreeDbSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -279,8 +388,8 @@ func (o *FileDialog) X_TreeDbSelected() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_TreeSelected() {
 	//log.Println("Calling FileDialog.X_TreeSelected()")
@@ -289,7 +398,7 @@ func (o *FileDialog) X_TreeSelected() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_tree_selected")
+	methodBind := methodBindFileDialogX_TreeSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -299,18 +408,18 @@ func (o *FileDialog) X_TreeSelected() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *FileDialog) X_UnhandledInput(arg0 InputEventImplementer) {
+func (o *FileDialog) X_UnhandledInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling FileDialog.X_UnhandledInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_unhandled_input")
+	methodBind := methodBindFileDialogX_UnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -320,8 +429,8 @@ func (o *FileDialog) X_UnhandledInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_UpdateDir() {
 	//log.Println("Calling FileDialog.X_UpdateDir()")
@@ -330,7 +439,7 @@ func (o *FileDialog) X_UpdateDir() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_update_dir")
+	methodBind := methodBindFileDialogX_UpdateDir.Get()
 
 	// Call the parent method.
 	// void
@@ -340,8 +449,8 @@ func (o *FileDialog) X_UpdateDir() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *FileDialog) X_UpdateFileList() {
 	//log.Println("Calling FileDialog.X_UpdateFileList()")
@@ -350,7 +459,7 @@ func (o *FileDialog) X_UpdateFileList() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "_update_file_list")
+	methodBind := methodBindFileDialogX_UpdateFileList.Get()
 
 	// Call the parent method.
 	// void
@@ -360,8 +469,8 @@ func (o *FileDialog) X_UpdateFileList() {
 }
 
 /*
-        Add a custom filter. Filter format is: "mask ; description", example (C++): dialog->add_filter("*.png ; PNG Images");
-	Args: [{ false filter String}], Returns: void
+	        Add a custom filter. Filter format is: "mask ; description", example (C++): dialog->add_filter("*.png ; PNG Images");
+		Args: [{ false filter String}], Returns: void
 */
 func (o *FileDialog) AddFilter(filter gdnative.String) {
 	//log.Println("Calling FileDialog.AddFilter()")
@@ -371,7 +480,7 @@ func (o *FileDialog) AddFilter(filter gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(filter)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "add_filter")
+	methodBind := methodBindFileDialogAddFilter.Get()
 
 	// Call the parent method.
 	// void
@@ -381,8 +490,8 @@ func (o *FileDialog) AddFilter(filter gdnative.String) {
 }
 
 /*
-        Clear all the added filters in the dialog.
-	Args: [], Returns: void
+	        Clear all the added filters in the dialog.
+		Args: [], Returns: void
 */
 func (o *FileDialog) ClearFilters() {
 	//log.Println("Calling FileDialog.ClearFilters()")
@@ -391,7 +500,7 @@ func (o *FileDialog) ClearFilters() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "clear_filters")
+	methodBind := methodBindFileDialogClearFilters.Get()
 
 	// Call the parent method.
 	// void
@@ -401,8 +510,7 @@ func (o *FileDialog) ClearFilters() {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *FileDialog) DeselectItems() {
 	//log.Println("Calling FileDialog.DeselectItems()")
@@ -411,7 +519,7 @@ func (o *FileDialog) DeselectItems() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "deselect_items")
+	methodBind := methodBindFileDialogDeselectItems.Get()
 
 	// Call the parent method.
 	// void
@@ -421,8 +529,8 @@ func (o *FileDialog) DeselectItems() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.FileDialog::Access
+	        Undocumented
+		Args: [], Returns: enum.FileDialog::Access
 */
 func (o *FileDialog) GetAccess() FileDialogAccess {
 	//log.Println("Calling FileDialog.GetAccess()")
@@ -431,7 +539,7 @@ func (o *FileDialog) GetAccess() FileDialogAccess {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "get_access")
+	methodBind := methodBindFileDialogGetAccess.Get()
 
 	// Call the parent method.
 	// enum.FileDialog::Access
@@ -444,8 +552,8 @@ func (o *FileDialog) GetAccess() FileDialogAccess {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *FileDialog) GetCurrentDir() gdnative.String {
 	//log.Println("Calling FileDialog.GetCurrentDir()")
@@ -454,7 +562,7 @@ func (o *FileDialog) GetCurrentDir() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "get_current_dir")
+	methodBind := methodBindFileDialogGetCurrentDir.Get()
 
 	// Call the parent method.
 	// String
@@ -467,8 +575,8 @@ func (o *FileDialog) GetCurrentDir() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *FileDialog) GetCurrentFile() gdnative.String {
 	//log.Println("Calling FileDialog.GetCurrentFile()")
@@ -477,7 +585,7 @@ func (o *FileDialog) GetCurrentFile() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "get_current_file")
+	methodBind := methodBindFileDialogGetCurrentFile.Get()
 
 	// Call the parent method.
 	// String
@@ -490,8 +598,8 @@ func (o *FileDialog) GetCurrentFile() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *FileDialog) GetCurrentPath() gdnative.String {
 	//log.Println("Calling FileDialog.GetCurrentPath()")
@@ -500,7 +608,7 @@ func (o *FileDialog) GetCurrentPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "get_current_path")
+	methodBind := methodBindFileDialogGetCurrentPath.Get()
 
 	// Call the parent method.
 	// String
@@ -513,8 +621,8 @@ func (o *FileDialog) GetCurrentPath() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolStringArray
+	        Undocumented
+		Args: [], Returns: PoolStringArray
 */
 func (o *FileDialog) GetFilters() gdnative.PoolStringArray {
 	//log.Println("Calling FileDialog.GetFilters()")
@@ -523,7 +631,7 @@ func (o *FileDialog) GetFilters() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "get_filters")
+	methodBind := methodBindFileDialogGetFilters.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -536,8 +644,8 @@ func (o *FileDialog) GetFilters() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.FileDialog::Mode
+	        Undocumented
+		Args: [], Returns: enum.FileDialog::Mode
 */
 func (o *FileDialog) GetMode() FileDialogMode {
 	//log.Println("Calling FileDialog.GetMode()")
@@ -546,7 +654,7 @@ func (o *FileDialog) GetMode() FileDialogMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "get_mode")
+	methodBind := methodBindFileDialogGetMode.Get()
 
 	// Call the parent method.
 	// enum.FileDialog::Mode
@@ -559,8 +667,8 @@ func (o *FileDialog) GetMode() FileDialogMode {
 }
 
 /*
-        Return the vertical box container of the dialog, custom controls can be added to it.
-	Args: [], Returns: VBoxContainer
+	        Return the vertical box container of the dialog, custom controls can be added to it.
+		Args: [], Returns: VBoxContainer
 */
 func (o *FileDialog) GetVbox() VBoxContainerImplementer {
 	//log.Println("Calling FileDialog.GetVbox()")
@@ -569,7 +677,7 @@ func (o *FileDialog) GetVbox() VBoxContainerImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "get_vbox")
+	methodBind := methodBindFileDialogGetVbox.Get()
 
 	// Call the parent method.
 	// VBoxContainer
@@ -577,27 +685,25 @@ func (o *FileDialog) GetVbox() VBoxContainerImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newVBoxContainerFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(VBoxContainerImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "VBoxContainer" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(VBoxContainerImplementer)
+	ret := VBoxContainer{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(VBoxContainerImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Invalidate and update the current dialog content list.
-	Args: [], Returns: void
+	        Invalidate and update the current dialog content list.
+		Args: [], Returns: void
 */
 func (o *FileDialog) Invalidate() {
 	//log.Println("Calling FileDialog.Invalidate()")
@@ -606,7 +712,7 @@ func (o *FileDialog) Invalidate() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "invalidate")
+	methodBind := methodBindFileDialogInvalidate.Get()
 
 	// Call the parent method.
 	// void
@@ -616,8 +722,8 @@ func (o *FileDialog) Invalidate() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *FileDialog) IsModeOverridingTitle() gdnative.Bool {
 	//log.Println("Calling FileDialog.IsModeOverridingTitle()")
@@ -626,7 +732,7 @@ func (o *FileDialog) IsModeOverridingTitle() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "is_mode_overriding_title")
+	methodBind := methodBindFileDialogIsModeOverridingTitle.Get()
 
 	// Call the parent method.
 	// bool
@@ -639,8 +745,8 @@ func (o *FileDialog) IsModeOverridingTitle() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *FileDialog) IsShowingHiddenFiles() gdnative.Bool {
 	//log.Println("Calling FileDialog.IsShowingHiddenFiles()")
@@ -649,7 +755,7 @@ func (o *FileDialog) IsShowingHiddenFiles() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "is_showing_hidden_files")
+	methodBind := methodBindFileDialogIsShowingHiddenFiles.Get()
 
 	// Call the parent method.
 	// bool
@@ -662,18 +768,18 @@ func (o *FileDialog) IsShowingHiddenFiles() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false access int}], Returns: void
+	        Undocumented
+		Args: [{ false access enum.FileDialog::Access}], Returns: void
 */
-func (o *FileDialog) SetAccess(access gdnative.Int) {
+func (o *FileDialog) SetAccess(access FileDialogAccess) {
 	//log.Println("Calling FileDialog.SetAccess()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(access)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(access))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_access")
+	methodBind := methodBindFileDialogSetAccess.Get()
 
 	// Call the parent method.
 	// void
@@ -683,8 +789,8 @@ func (o *FileDialog) SetAccess(access gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false dir String}], Returns: void
+	        Undocumented
+		Args: [{ false dir String}], Returns: void
 */
 func (o *FileDialog) SetCurrentDir(dir gdnative.String) {
 	//log.Println("Calling FileDialog.SetCurrentDir()")
@@ -694,7 +800,7 @@ func (o *FileDialog) SetCurrentDir(dir gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(dir)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_current_dir")
+	methodBind := methodBindFileDialogSetCurrentDir.Get()
 
 	// Call the parent method.
 	// void
@@ -704,8 +810,8 @@ func (o *FileDialog) SetCurrentDir(dir gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false file String}], Returns: void
+	        Undocumented
+		Args: [{ false file String}], Returns: void
 */
 func (o *FileDialog) SetCurrentFile(file gdnative.String) {
 	//log.Println("Calling FileDialog.SetCurrentFile()")
@@ -715,7 +821,7 @@ func (o *FileDialog) SetCurrentFile(file gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_current_file")
+	methodBind := methodBindFileDialogSetCurrentFile.Get()
 
 	// Call the parent method.
 	// void
@@ -725,8 +831,8 @@ func (o *FileDialog) SetCurrentFile(file gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: void
+	        Undocumented
+		Args: [{ false path String}], Returns: void
 */
 func (o *FileDialog) SetCurrentPath(path gdnative.String) {
 	//log.Println("Calling FileDialog.SetCurrentPath()")
@@ -736,7 +842,7 @@ func (o *FileDialog) SetCurrentPath(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_current_path")
+	methodBind := methodBindFileDialogSetCurrentPath.Get()
 
 	// Call the parent method.
 	// void
@@ -746,8 +852,8 @@ func (o *FileDialog) SetCurrentPath(path gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false filters PoolStringArray}], Returns: void
+	        Undocumented
+		Args: [{ false filters PoolStringArray}], Returns: void
 */
 func (o *FileDialog) SetFilters(filters gdnative.PoolStringArray) {
 	//log.Println("Calling FileDialog.SetFilters()")
@@ -757,7 +863,7 @@ func (o *FileDialog) SetFilters(filters gdnative.PoolStringArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolStringArray(filters)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_filters")
+	methodBind := methodBindFileDialogSetFilters.Get()
 
 	// Call the parent method.
 	// void
@@ -767,18 +873,18 @@ func (o *FileDialog) SetFilters(filters gdnative.PoolStringArray) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.FileDialog::Mode}], Returns: void
 */
-func (o *FileDialog) SetMode(mode gdnative.Int) {
+func (o *FileDialog) SetMode(mode FileDialogMode) {
 	//log.Println("Calling FileDialog.SetMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_mode")
+	methodBind := methodBindFileDialogSetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -788,8 +894,8 @@ func (o *FileDialog) SetMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false override bool}], Returns: void
+	        Undocumented
+		Args: [{ false override bool}], Returns: void
 */
 func (o *FileDialog) SetModeOverridesTitle(override gdnative.Bool) {
 	//log.Println("Calling FileDialog.SetModeOverridesTitle()")
@@ -799,7 +905,7 @@ func (o *FileDialog) SetModeOverridesTitle(override gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(override)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_mode_overrides_title")
+	methodBind := methodBindFileDialogSetModeOverridesTitle.Get()
 
 	// Call the parent method.
 	// void
@@ -809,8 +915,8 @@ func (o *FileDialog) SetModeOverridesTitle(override gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false show bool}], Returns: void
+	        Undocumented
+		Args: [{ false show bool}], Returns: void
 */
 func (o *FileDialog) SetShowHiddenFiles(show gdnative.Bool) {
 	//log.Println("Calling FileDialog.SetShowHiddenFiles()")
@@ -820,7 +926,7 @@ func (o *FileDialog) SetShowHiddenFiles(show gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(show)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("FileDialog", "set_show_hidden_files")
+	methodBind := methodBindFileDialogSetShowHiddenFiles.Get()
 
 	// Call the parent method.
 	// void
@@ -829,6 +935,122 @@ func (o *FileDialog) SetShowHiddenFiles(show gdnative.Bool) {
 
 }
 
+// Access will return the value of the "access" property.
+func (o *FileDialog) Access() FileDialogAccess {
+	return o.GetAccess()
+}
+
+// CurrentDir will return the value of the "current_dir" property.
+func (o *FileDialog) CurrentDir() gdnative.String {
+	return o.GetCurrentDir()
+}
+
+// CurrentFile will return the value of the "current_file" property.
+func (o *FileDialog) CurrentFile() gdnative.String {
+	return o.GetCurrentFile()
+}
+
+// CurrentPath will return the value of the "current_path" property.
+func (o *FileDialog) CurrentPath() gdnative.String {
+	return o.GetCurrentPath()
+}
+
+// Filters will return the value of the "filters" property.
+func (o *FileDialog) Filters() gdnative.PoolStringArray {
+	return o.GetFilters()
+}
+
+// Mode will return the value of the "mode" property.
+func (o *FileDialog) Mode() FileDialogMode {
+	return o.GetMode()
+}
+
+// ModeOverridesTitle will return the value of the "mode_overrides_title" property.
+func (o *FileDialog) ModeOverridesTitle() gdnative.Bool {
+	return o.IsModeOverridingTitle()
+}
+
+// ShowHiddenFiles will return the value of the "show_hidden_files" property.
+func (o *FileDialog) ShowHiddenFiles() gdnative.Bool {
+	return o.IsShowingHiddenFiles()
+}
+
+// Signals of the FileDialog class.
+const (
+	FileDialogSignalDirSelected   gdnative.String = "dir_selected"
+	FileDialogSignalFileSelected  gdnative.String = "file_selected"
+	FileDialogSignalFilesSelected gdnative.String = "files_selected"
+)
+
+// FileDialogDirSelectedArgs holds the arguments of the "dir_selected" signal.
+type FileDialogDirSelectedArgs struct {
+	Dir gdnative.String
+}
+
+// ConnectDirSelected will connect the "dir_selected" signal to the given method of the target object.
+func (o *FileDialog) ConnectDirSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(FileDialogSignalDirSelected, target, method, binds, 0)
+}
+
+// EmitDirSelected will emit the "dir_selected" signal.
+func (o *FileDialog) EmitDirSelected(args FileDialogDirSelectedArgs) error {
+	arg0 := gdnative.NewVariantString(args.Dir)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(FileDialogSignalDirSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// FileDialogFileSelectedArgs holds the arguments of the "file_selected" signal.
+type FileDialogFileSelectedArgs struct {
+	Path gdnative.String
+}
+
+// ConnectFileSelected will connect the "file_selected" signal to the given method of the target object.
+func (o *FileDialog) ConnectFileSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(FileDialogSignalFileSelected, target, method, binds, 0)
+}
+
+// EmitFileSelected will emit the "file_selected" signal.
+func (o *FileDialog) EmitFileSelected(args FileDialogFileSelectedArgs) error {
+	arg0 := gdnative.NewVariantString(args.Path)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(FileDialogSignalFileSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// FileDialogFilesSelectedArgs holds the arguments of the "files_selected" signal.
+type FileDialogFilesSelectedArgs struct {
+	Paths gdnative.PoolStringArray
+}
+
+// ConnectFilesSelected will connect the "files_selected" signal to the given method of the target object.
+func (o *FileDialog) ConnectFilesSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(FileDialogSignalFilesSelected, target, method, binds, 0)
+}
+
+// EmitFilesSelected will emit the "files_selected" signal.
+func (o *FileDialog) EmitFilesSelected(args FileDialogFilesSelectedArgs) error {
+	arg0 := gdnative.NewVariantPoolStringArray(args.Paths)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(FileDialogSignalFilesSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
 // FileDialogImplementer is an interface that implements the methods
 // of the FileDialog class.
 type FileDialogImplementer interface {
@@ -850,20 +1072,36 @@ type FileDialogImplementer interface {
 	AddFilter(filter gdnative.String)
 	ClearFilters()
 	DeselectItems()
+	GetAccess() FileDialogAccess
 	GetCurrentDir() gdnative.String
 	GetCurrentFile() gdnative.String
 	GetCurrentPath() gdnative.String
 	GetFilters() gdnative.PoolStringArray
+	GetMode() FileDialogMode
 	GetVbox() VBoxContainerImplementer
 	Invalidate()
 	IsModeOverridingTitle() gdnative.Bool
 	IsShowingHiddenFiles() gdnative.Bool
-	SetAccess(access gdnative.Int)
+	SetAccess(access FileDialogAccess)
 	SetCurrentDir(dir gdnative.String)
 	SetCurrentFile(file gdnative.String)
 	SetCurrentPath(path gdnative.String)
 	SetFilters(filters gdnative.PoolStringArray)
-	SetMode(mode gdnative.Int)
+	SetMode(mode FileDialogMode)
 	SetModeOverridesTitle(override gdnative.Bool)
 	SetShowHiddenFiles(show gdnative.Bool)
+	Access() FileDialogAccess
+	CurrentDir() gdnative.String
+	CurrentFile() gdnative.String
+	CurrentPath() gdnative.String
+	Filters() gdnative.PoolStringArray
+	Mode() FileDialogMode
+	ModeOverridesTitle() gdnative.Bool
+	ShowHiddenFiles() gdnative.Bool
+	ConnectDirSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDirSelected(args FileDialogDirSelectedArgs) error
+	ConnectFileSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFileSelected(args FileDialogFileSelectedArgs) error
+	ConnectFilesSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFilesSelected(args FileDialogFilesSelectedArgs) error
 }
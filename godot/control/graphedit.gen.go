@@ -0,0 +1,1206 @@
+package control
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+GraphEdit manages the showing of GraphNodes it contains, as well as connections and disconnections between them. Signals are sent for each of these two events. Disconnection between GraphNodes slots is disabled by default. It is greatly advised to enable low processor usage mode (see [method OS.set_low_processor_usage_mode]) when using GraphEdits.
+*/
+type GraphEdit struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorGraphEdit is the class constructor of the GraphEdit class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGraphEdit = gdnative.NewLazyClassConstructor("GraphEdit")
+
+// NewGraphEdit will create a new instance of the GraphEdit class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewGraphEdit() *GraphEdit {
+	obj := &GraphEdit{}
+	obj.SetBaseObject(classConstructorGraphEdit.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *GraphEdit) BaseClass() string {
+	return "GraphEdit"
+}
+
+// Method binds of the GraphEdit class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGraphEditX_ConnectionsLayerDraw         = gdnative.NewLazyMethodBind("GraphEdit", "_connections_layer_draw")
+	methodBindGraphEditX_GraphNodeMoved               = gdnative.NewLazyMethodBind("GraphEdit", "_graph_node_moved")
+	methodBindGraphEditX_GraphNodeRaised              = gdnative.NewLazyMethodBind("GraphEdit", "_graph_node_raised")
+	methodBindGraphEditX_GuiInput                     = gdnative.NewLazyMethodBind("GraphEdit", "_gui_input")
+	methodBindGraphEditX_ScrollMoved                  = gdnative.NewLazyMethodBind("GraphEdit", "_scroll_moved")
+	methodBindGraphEditX_SnapToggled                  = gdnative.NewLazyMethodBind("GraphEdit", "_snap_toggled")
+	methodBindGraphEditX_SnapValueChanged             = gdnative.NewLazyMethodBind("GraphEdit", "_snap_value_changed")
+	methodBindGraphEditX_TopLayerDraw                 = gdnative.NewLazyMethodBind("GraphEdit", "_top_layer_draw")
+	methodBindGraphEditX_TopLayerInput                = gdnative.NewLazyMethodBind("GraphEdit", "_top_layer_input")
+	methodBindGraphEditX_UpdateScrollOffset           = gdnative.NewLazyMethodBind("GraphEdit", "_update_scroll_offset")
+	methodBindGraphEditX_ZoomMinus                    = gdnative.NewLazyMethodBind("GraphEdit", "_zoom_minus")
+	methodBindGraphEditX_ZoomPlus                     = gdnative.NewLazyMethodBind("GraphEdit", "_zoom_plus")
+	methodBindGraphEditX_ZoomReset                    = gdnative.NewLazyMethodBind("GraphEdit", "_zoom_reset")
+	methodBindGraphEditAddValidConnectionType         = gdnative.NewLazyMethodBind("GraphEdit", "add_valid_connection_type")
+	methodBindGraphEditAddValidLeftDisconnectType     = gdnative.NewLazyMethodBind("GraphEdit", "add_valid_left_disconnect_type")
+	methodBindGraphEditAddValidRightDisconnectType    = gdnative.NewLazyMethodBind("GraphEdit", "add_valid_right_disconnect_type")
+	methodBindGraphEditClearConnections               = gdnative.NewLazyMethodBind("GraphEdit", "clear_connections")
+	methodBindGraphEditConnectNode                    = gdnative.NewLazyMethodBind("GraphEdit", "connect_node")
+	methodBindGraphEditDisconnectNode                 = gdnative.NewLazyMethodBind("GraphEdit", "disconnect_node")
+	methodBindGraphEditGetConnectionList              = gdnative.NewLazyMethodBind("GraphEdit", "get_connection_list")
+	methodBindGraphEditGetScrollOfs                   = gdnative.NewLazyMethodBind("GraphEdit", "get_scroll_ofs")
+	methodBindGraphEditGetSnap                        = gdnative.NewLazyMethodBind("GraphEdit", "get_snap")
+	methodBindGraphEditGetZoom                        = gdnative.NewLazyMethodBind("GraphEdit", "get_zoom")
+	methodBindGraphEditIsNodeConnected                = gdnative.NewLazyMethodBind("GraphEdit", "is_node_connected")
+	methodBindGraphEditIsRightDisconnectsEnabled      = gdnative.NewLazyMethodBind("GraphEdit", "is_right_disconnects_enabled")
+	methodBindGraphEditIsUsingSnap                    = gdnative.NewLazyMethodBind("GraphEdit", "is_using_snap")
+	methodBindGraphEditIsValidConnectionType          = gdnative.NewLazyMethodBind("GraphEdit", "is_valid_connection_type")
+	methodBindGraphEditRemoveValidConnectionType      = gdnative.NewLazyMethodBind("GraphEdit", "remove_valid_connection_type")
+	methodBindGraphEditRemoveValidLeftDisconnectType  = gdnative.NewLazyMethodBind("GraphEdit", "remove_valid_left_disconnect_type")
+	methodBindGraphEditRemoveValidRightDisconnectType = gdnative.NewLazyMethodBind("GraphEdit", "remove_valid_right_disconnect_type")
+	methodBindGraphEditSetRightDisconnects            = gdnative.NewLazyMethodBind("GraphEdit", "set_right_disconnects")
+	methodBindGraphEditSetScrollOfs                   = gdnative.NewLazyMethodBind("GraphEdit", "set_scroll_ofs")
+	methodBindGraphEditSetSelected                    = gdnative.NewLazyMethodBind("GraphEdit", "set_selected")
+	methodBindGraphEditSetSnap                        = gdnative.NewLazyMethodBind("GraphEdit", "set_snap")
+	methodBindGraphEditSetUseSnap                     = gdnative.NewLazyMethodBind("GraphEdit", "set_use_snap")
+	methodBindGraphEditSetZoom                        = gdnative.NewLazyMethodBind("GraphEdit", "set_zoom")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) X_ConnectionsLayerDraw() {
+	//log.Println("Calling GraphEdit.X_ConnectionsLayerDraw()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_ConnectionsLayerDraw.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
+*/
+func (o *GraphEdit) X_GraphNodeMoved(arg0 godot.ObjectImplementer) {
+	//log.Println("Calling GraphEdit.X_GraphNodeMoved()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_GraphNodeMoved.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
+*/
+func (o *GraphEdit) X_GraphNodeRaised(arg0 godot.ObjectImplementer) {
+	//log.Println("Calling GraphEdit.X_GraphNodeRaised()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_GraphNodeRaised.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *GraphEdit) X_GuiInput(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling GraphEdit.X_GuiInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_GuiInput.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
+*/
+func (o *GraphEdit) X_ScrollMoved(arg0 gdnative.Real) {
+	//log.Println("Calling GraphEdit.X_ScrollMoved()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_ScrollMoved.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) X_SnapToggled() {
+	//log.Println("Calling GraphEdit.X_SnapToggled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_SnapToggled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
+*/
+func (o *GraphEdit) X_SnapValueChanged(arg0 gdnative.Real) {
+	//log.Println("Calling GraphEdit.X_SnapValueChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_SnapValueChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) X_TopLayerDraw() {
+	//log.Println("Calling GraphEdit.X_TopLayerDraw()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_TopLayerDraw.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *GraphEdit) X_TopLayerInput(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling GraphEdit.X_TopLayerInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_TopLayerInput.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) X_UpdateScrollOffset() {
+	//log.Println("Calling GraphEdit.X_UpdateScrollOffset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_UpdateScrollOffset.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) X_ZoomMinus() {
+	//log.Println("Calling GraphEdit.X_ZoomMinus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_ZoomMinus.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) X_ZoomPlus() {
+	//log.Println("Calling GraphEdit.X_ZoomPlus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_ZoomPlus.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) X_ZoomReset() {
+	//log.Println("Calling GraphEdit.X_ZoomReset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditX_ZoomReset.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Makes possible the connection between two different slot types. The type is defined with the [method GraphNode.set_slot] method.
+		Args: [{ false from_type int} { false to_type int}], Returns: void
+*/
+func (o *GraphEdit) AddValidConnectionType(fromType gdnative.Int, toType gdnative.Int) {
+	//log.Println("Calling GraphEdit.AddValidConnectionType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(fromType)
+	ptrArguments[1] = gdnative.NewPointerFromInt(toType)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditAddValidConnectionType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Makes possible to disconnect nodes when dragging from the slot at the left if it has the specified type.
+		Args: [{ false type int}], Returns: void
+*/
+func (o *GraphEdit) AddValidLeftDisconnectType(aType gdnative.Int) {
+	//log.Println("Calling GraphEdit.AddValidLeftDisconnectType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditAddValidLeftDisconnectType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Makes possible to disconnect nodes when dragging from the slot at the right if it has the specified type.
+		Args: [{ false type int}], Returns: void
+*/
+func (o *GraphEdit) AddValidRightDisconnectType(aType gdnative.Int) {
+	//log.Println("Calling GraphEdit.AddValidRightDisconnectType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditAddValidRightDisconnectType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Remove all connections between nodes.
+		Args: [], Returns: void
+*/
+func (o *GraphEdit) ClearConnections() {
+	//log.Println("Calling GraphEdit.ClearConnections()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditClearConnections.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Create a connection between 'from_port' slot of 'from' GraphNode and 'to_port' slot of 'to' GraphNode. If the connection already exists, no connection is created.
+		Args: [{ false from String} { false from_port int} { false to String} { false to_port int}], Returns: enum.Error
+*/
+func (o *GraphEdit) ConnectNode(from gdnative.String, fromPort gdnative.Int, to gdnative.String, toPort gdnative.Int) gdnative.Error {
+	//log.Println("Calling GraphEdit.ConnectNode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromString(from)
+	ptrArguments[1] = gdnative.NewPointerFromInt(fromPort)
+	ptrArguments[2] = gdnative.NewPointerFromString(to)
+	ptrArguments[3] = gdnative.NewPointerFromInt(toPort)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditConnectNode.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Remove the connection between 'from_port' slot of 'from' GraphNode and 'to_port' slot of 'to' GraphNode, if connection exists.
+		Args: [{ false from String} { false from_port int} { false to String} { false to_port int}], Returns: void
+*/
+func (o *GraphEdit) DisconnectNode(from gdnative.String, fromPort gdnative.Int, to gdnative.String, toPort gdnative.Int) {
+	//log.Println("Calling GraphEdit.DisconnectNode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromString(from)
+	ptrArguments[1] = gdnative.NewPointerFromInt(fromPort)
+	ptrArguments[2] = gdnative.NewPointerFromString(to)
+	ptrArguments[3] = gdnative.NewPointerFromInt(toPort)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditDisconnectNode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Return an Array containing the list of connections. A connection consists in a structure of the form {from_slot: 0, from: "GraphNode name 0", to_slot: 1, to: "GraphNode name 1" }
+		Args: [], Returns: Array
+*/
+func (o *GraphEdit) GetConnectionList() gdnative.Array {
+	//log.Println("Calling GraphEdit.GetConnectionList()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditGetConnectionList.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *GraphEdit) GetScrollOfs() gdnative.Vector2 {
+	//log.Println("Calling GraphEdit.GetScrollOfs()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditGetScrollOfs.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *GraphEdit) GetSnap() gdnative.Int {
+	//log.Println("Calling GraphEdit.GetSnap()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditGetSnap.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *GraphEdit) GetZoom() gdnative.Real {
+	//log.Println("Calling GraphEdit.GetZoom()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditGetZoom.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Return true if the 'from_port' slot of 'from' GraphNode is connected to the 'to_port' slot of 'to' GraphNode.
+		Args: [{ false from String} { false from_port int} { false to String} { false to_port int}], Returns: bool
+*/
+func (o *GraphEdit) IsNodeConnected(from gdnative.String, fromPort gdnative.Int, to gdnative.String, toPort gdnative.Int) gdnative.Bool {
+	//log.Println("Calling GraphEdit.IsNodeConnected()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromString(from)
+	ptrArguments[1] = gdnative.NewPointerFromInt(fromPort)
+	ptrArguments[2] = gdnative.NewPointerFromString(to)
+	ptrArguments[3] = gdnative.NewPointerFromInt(toPort)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditIsNodeConnected.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *GraphEdit) IsRightDisconnectsEnabled() gdnative.Bool {
+	//log.Println("Calling GraphEdit.IsRightDisconnectsEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditIsRightDisconnectsEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *GraphEdit) IsUsingSnap() gdnative.Bool {
+	//log.Println("Calling GraphEdit.IsUsingSnap()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditIsUsingSnap.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns whether it's possible to connect slots of the specified types.
+		Args: [{ false from_type int} { false to_type int}], Returns: bool
+*/
+func (o *GraphEdit) IsValidConnectionType(fromType gdnative.Int, toType gdnative.Int) gdnative.Bool {
+	//log.Println("Calling GraphEdit.IsValidConnectionType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(fromType)
+	ptrArguments[1] = gdnative.NewPointerFromInt(toType)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditIsValidConnectionType.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Makes it not possible to connect between two different slot types. The type is defined with the [method GraphNode.set_slot] method.
+		Args: [{ false from_type int} { false to_type int}], Returns: void
+*/
+func (o *GraphEdit) RemoveValidConnectionType(fromType gdnative.Int, toType gdnative.Int) {
+	//log.Println("Calling GraphEdit.RemoveValidConnectionType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(fromType)
+	ptrArguments[1] = gdnative.NewPointerFromInt(toType)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditRemoveValidConnectionType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Removes the possibility to disconnect nodes when dragging from the slot at the left if it has the specified type.
+		Args: [{ false type int}], Returns: void
+*/
+func (o *GraphEdit) RemoveValidLeftDisconnectType(aType gdnative.Int) {
+	//log.Println("Calling GraphEdit.RemoveValidLeftDisconnectType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditRemoveValidLeftDisconnectType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Removes the possibility to disconnect nodes when dragging from the slot at the right if it has the specified type.
+		Args: [{ false type int}], Returns: void
+*/
+func (o *GraphEdit) RemoveValidRightDisconnectType(aType gdnative.Int) {
+	//log.Println("Calling GraphEdit.RemoveValidRightDisconnectType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditRemoveValidRightDisconnectType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *GraphEdit) SetRightDisconnects(enable gdnative.Bool) {
+	//log.Println("Calling GraphEdit.SetRightDisconnects()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditSetRightDisconnects.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false ofs Vector2}], Returns: void
+*/
+func (o *GraphEdit) SetScrollOfs(ofs gdnative.Vector2) {
+	//log.Println("Calling GraphEdit.SetScrollOfs()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(ofs)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditSetScrollOfs.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Sets the specified [code]node[/code] as the one selected.
+		Args: [{ false node Object}], Returns: void
+*/
+func (o *GraphEdit) SetSelected(node godot.ObjectImplementer) {
+	//log.Println("Calling GraphEdit.SetSelected()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
+
+	// Get the method bind
+	methodBind := methodBindGraphEditSetSelected.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false pixels int}], Returns: void
+*/
+func (o *GraphEdit) SetSnap(pixels gdnative.Int) {
+	//log.Println("Calling GraphEdit.SetSnap()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(pixels)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditSetSnap.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *GraphEdit) SetUseSnap(enable gdnative.Bool) {
+	//log.Println("Calling GraphEdit.SetUseSnap()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditSetUseSnap.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false p_zoom float}], Returns: void
+*/
+func (o *GraphEdit) SetZoom(pZoom gdnative.Real) {
+	//log.Println("Calling GraphEdit.SetZoom()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(pZoom)
+
+	// Get the method bind
+	methodBind := methodBindGraphEditSetZoom.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// RightDisconnects will return the value of the "right_disconnects" property.
+func (o *GraphEdit) RightDisconnects() gdnative.Bool {
+	return o.IsRightDisconnectsEnabled()
+}
+
+// ScrollOffset will return the value of the "scroll_offset" property.
+func (o *GraphEdit) ScrollOffset() gdnative.Vector2 {
+	return o.GetScrollOfs()
+}
+
+// SetScrollOffset will set the value of the "scroll_offset" property.
+func (o *GraphEdit) SetScrollOffset(value gdnative.Vector2) {
+	o.SetScrollOfs(value)
+}
+
+// SnapDistance will return the value of the "snap_distance" property.
+func (o *GraphEdit) SnapDistance() gdnative.Int {
+	return o.GetSnap()
+}
+
+// SetSnapDistance will set the value of the "snap_distance" property.
+func (o *GraphEdit) SetSnapDistance(value gdnative.Int) {
+	o.SetSnap(value)
+}
+
+// UseSnap will return the value of the "use_snap" property.
+func (o *GraphEdit) UseSnap() gdnative.Bool {
+	return o.IsUsingSnap()
+}
+
+// Zoom will return the value of the "zoom" property.
+func (o *GraphEdit) Zoom() gdnative.Real {
+	return o.GetZoom()
+}
+
+// Signals of the GraphEdit class.
+const (
+	GraphEditSignalBeginNodeMove         gdnative.String = "_begin_node_move"
+	GraphEditSignalEndNodeMove           gdnative.String = "_end_node_move"
+	GraphEditSignalConnectionRequest     gdnative.String = "connection_request"
+	GraphEditSignalConnectionToEmpty     gdnative.String = "connection_to_empty"
+	GraphEditSignalDeleteNodesRequest    gdnative.String = "delete_nodes_request"
+	GraphEditSignalDisconnectionRequest  gdnative.String = "disconnection_request"
+	GraphEditSignalDuplicateNodesRequest gdnative.String = "duplicate_nodes_request"
+	GraphEditSignalNodeSelected          gdnative.String = "node_selected"
+	GraphEditSignalPopupRequest          gdnative.String = "popup_request"
+	GraphEditSignalScrollOffsetChanged   gdnative.String = "scroll_offset_changed"
+)
+
+// ConnectBeginNodeMove will connect the "_begin_node_move" signal to the given method of the target object.
+func (o *GraphEdit) ConnectBeginNodeMove(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalBeginNodeMove, target, method, binds, 0)
+}
+
+// EmitBeginNodeMove will emit the "_begin_node_move" signal.
+func (o *GraphEdit) EmitBeginNodeMove() error {
+
+	ret, err := o.EmitSignal(GraphEditSignalBeginNodeMove)
+	ret.Destroy()
+	return err
+}
+
+// ConnectEndNodeMove will connect the "_end_node_move" signal to the given method of the target object.
+func (o *GraphEdit) ConnectEndNodeMove(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalEndNodeMove, target, method, binds, 0)
+}
+
+// EmitEndNodeMove will emit the "_end_node_move" signal.
+func (o *GraphEdit) EmitEndNodeMove() error {
+
+	ret, err := o.EmitSignal(GraphEditSignalEndNodeMove)
+	ret.Destroy()
+	return err
+}
+
+// GraphEditConnectionRequestArgs holds the arguments of the "connection_request" signal.
+type GraphEditConnectionRequestArgs struct {
+	From     gdnative.String
+	FromSlot gdnative.Int
+	To       gdnative.String
+	ToSlot   gdnative.Int
+}
+
+// ConnectConnectionRequest will connect the "connection_request" signal to the given method of the target object.
+func (o *GraphEdit) ConnectConnectionRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalConnectionRequest, target, method, binds, 0)
+}
+
+// EmitConnectionRequest will emit the "connection_request" signal.
+func (o *GraphEdit) EmitConnectionRequest(args GraphEditConnectionRequestArgs) error {
+	arg0 := gdnative.NewVariantString(args.From)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.FromSlot))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantString(args.To)
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.ToSlot))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(GraphEditSignalConnectionRequest, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// GraphEditConnectionToEmptyArgs holds the arguments of the "connection_to_empty" signal.
+type GraphEditConnectionToEmptyArgs struct {
+	From            gdnative.String
+	FromSlot        gdnative.Int
+	ReleasePosition gdnative.Vector2
+}
+
+// ConnectConnectionToEmpty will connect the "connection_to_empty" signal to the given method of the target object.
+func (o *GraphEdit) ConnectConnectionToEmpty(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalConnectionToEmpty, target, method, binds, 0)
+}
+
+// EmitConnectionToEmpty will emit the "connection_to_empty" signal.
+func (o *GraphEdit) EmitConnectionToEmpty(args GraphEditConnectionToEmptyArgs) error {
+	arg0 := gdnative.NewVariantString(args.From)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.FromSlot))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantVector2(args.ReleasePosition)
+	defer arg2.Destroy()
+
+	ret, err := o.EmitSignal(GraphEditSignalConnectionToEmpty, arg0, arg1, arg2)
+	ret.Destroy()
+	return err
+}
+
+// ConnectDeleteNodesRequest will connect the "delete_nodes_request" signal to the given method of the target object.
+func (o *GraphEdit) ConnectDeleteNodesRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalDeleteNodesRequest, target, method, binds, 0)
+}
+
+// EmitDeleteNodesRequest will emit the "delete_nodes_request" signal.
+func (o *GraphEdit) EmitDeleteNodesRequest() error {
+
+	ret, err := o.EmitSignal(GraphEditSignalDeleteNodesRequest)
+	ret.Destroy()
+	return err
+}
+
+// GraphEditDisconnectionRequestArgs holds the arguments of the "disconnection_request" signal.
+type GraphEditDisconnectionRequestArgs struct {
+	From     gdnative.String
+	FromSlot gdnative.Int
+	To       gdnative.String
+	ToSlot   gdnative.Int
+}
+
+// ConnectDisconnectionRequest will connect the "disconnection_request" signal to the given method of the target object.
+func (o *GraphEdit) ConnectDisconnectionRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalDisconnectionRequest, target, method, binds, 0)
+}
+
+// EmitDisconnectionRequest will emit the "disconnection_request" signal.
+func (o *GraphEdit) EmitDisconnectionRequest(args GraphEditDisconnectionRequestArgs) error {
+	arg0 := gdnative.NewVariantString(args.From)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.FromSlot))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantString(args.To)
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.ToSlot))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(GraphEditSignalDisconnectionRequest, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// ConnectDuplicateNodesRequest will connect the "duplicate_nodes_request" signal to the given method of the target object.
+func (o *GraphEdit) ConnectDuplicateNodesRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalDuplicateNodesRequest, target, method, binds, 0)
+}
+
+// EmitDuplicateNodesRequest will emit the "duplicate_nodes_request" signal.
+func (o *GraphEdit) EmitDuplicateNodesRequest() error {
+
+	ret, err := o.EmitSignal(GraphEditSignalDuplicateNodesRequest)
+	ret.Destroy()
+	return err
+}
+
+// GraphEditNodeSelectedArgs holds the arguments of the "node_selected" signal.
+type GraphEditNodeSelectedArgs struct {
+	Node godot.ObjectImplementer
+}
+
+// ConnectNodeSelected will connect the "node_selected" signal to the given method of the target object.
+func (o *GraphEdit) ConnectNodeSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalNodeSelected, target, method, binds, 0)
+}
+
+// EmitNodeSelected will emit the "node_selected" signal.
+func (o *GraphEdit) EmitNodeSelected(args GraphEditNodeSelectedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Node))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(GraphEditSignalNodeSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// GraphEditPopupRequestArgs holds the arguments of the "popup_request" signal.
+type GraphEditPopupRequestArgs struct {
+	PPosition gdnative.Vector2
+}
+
+// ConnectPopupRequest will connect the "popup_request" signal to the given method of the target object.
+func (o *GraphEdit) ConnectPopupRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalPopupRequest, target, method, binds, 0)
+}
+
+// EmitPopupRequest will emit the "popup_request" signal.
+func (o *GraphEdit) EmitPopupRequest(args GraphEditPopupRequestArgs) error {
+	arg0 := gdnative.NewVariantVector2(args.PPosition)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(GraphEditSignalPopupRequest, arg0)
+	ret.Destroy()
+	return err
+}
+
+// GraphEditScrollOffsetChangedArgs holds the arguments of the "scroll_offset_changed" signal.
+type GraphEditScrollOffsetChangedArgs struct {
+	Ofs gdnative.Vector2
+}
+
+// ConnectScrollOffsetChanged will connect the "scroll_offset_changed" signal to the given method of the target object.
+func (o *GraphEdit) ConnectScrollOffsetChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphEditSignalScrollOffsetChanged, target, method, binds, 0)
+}
+
+// EmitScrollOffsetChanged will emit the "scroll_offset_changed" signal.
+func (o *GraphEdit) EmitScrollOffsetChanged(args GraphEditScrollOffsetChangedArgs) error {
+	arg0 := gdnative.NewVariantVector2(args.Ofs)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(GraphEditSignalScrollOffsetChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// GraphEditImplementer is an interface that implements the methods
+// of the GraphEdit class.
+type GraphEditImplementer interface {
+	godot.ControlImplementer
+	X_ConnectionsLayerDraw()
+	X_GraphNodeMoved(arg0 godot.ObjectImplementer)
+	X_GraphNodeRaised(arg0 godot.ObjectImplementer)
+	X_ScrollMoved(arg0 gdnative.Real)
+	X_SnapToggled()
+	X_SnapValueChanged(arg0 gdnative.Real)
+	X_TopLayerDraw()
+	X_TopLayerInput(arg0 godot.InputEventImplementer)
+	X_UpdateScrollOffset()
+	X_ZoomMinus()
+	X_ZoomPlus()
+	X_ZoomReset()
+	AddValidConnectionType(fromType gdnative.Int, toType gdnative.Int)
+	AddValidLeftDisconnectType(aType gdnative.Int)
+	AddValidRightDisconnectType(aType gdnative.Int)
+	ClearConnections()
+	ConnectNode(from gdnative.String, fromPort gdnative.Int, to gdnative.String, toPort gdnative.Int) gdnative.Error
+	DisconnectNode(from gdnative.String, fromPort gdnative.Int, to gdnative.String, toPort gdnative.Int)
+	GetConnectionList() gdnative.Array
+	GetScrollOfs() gdnative.Vector2
+	GetSnap() gdnative.Int
+	GetZoom() gdnative.Real
+	IsNodeConnected(from gdnative.String, fromPort gdnative.Int, to gdnative.String, toPort gdnative.Int) gdnative.Bool
+	IsRightDisconnectsEnabled() gdnative.Bool
+	IsUsingSnap() gdnative.Bool
+	IsValidConnectionType(fromType gdnative.Int, toType gdnative.Int) gdnative.Bool
+	RemoveValidConnectionType(fromType gdnative.Int, toType gdnative.Int)
+	RemoveValidLeftDisconnectType(aType gdnative.Int)
+	RemoveValidRightDisconnectType(aType gdnative.Int)
+	SetRightDisconnects(enable gdnative.Bool)
+	SetScrollOfs(ofs gdnative.Vector2)
+	SetSelected(node godot.ObjectImplementer)
+	SetSnap(pixels gdnative.Int)
+	SetUseSnap(enable gdnative.Bool)
+	SetZoom(pZoom gdnative.Real)
+	RightDisconnects() gdnative.Bool
+	ScrollOffset() gdnative.Vector2
+	SetScrollOffset(value gdnative.Vector2)
+	SnapDistance() gdnative.Int
+	SetSnapDistance(value gdnative.Int)
+	UseSnap() gdnative.Bool
+	Zoom() gdnative.Real
+	ConnectBeginNodeMove(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBeginNodeMove() error
+	ConnectEndNodeMove(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitEndNodeMove() error
+	ConnectConnectionRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitConnectionRequest(args GraphEditConnectionRequestArgs) error
+	ConnectConnectionToEmpty(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitConnectionToEmpty(args GraphEditConnectionToEmptyArgs) error
+	ConnectDeleteNodesRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDeleteNodesRequest() error
+	ConnectDisconnectionRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDisconnectionRequest(args GraphEditDisconnectionRequestArgs) error
+	ConnectDuplicateNodesRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDuplicateNodesRequest() error
+	ConnectNodeSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNodeSelected(args GraphEditNodeSelectedArgs) error
+	ConnectPopupRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPopupRequest(args GraphEditPopupRequestArgs) error
+	ConnectScrollOffsetChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScrollOffsetChanged(args GraphEditScrollOffsetChangedArgs) error
+}
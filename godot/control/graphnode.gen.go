@@ -1,7 +1,10 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,13 +25,30 @@ const (
 	GraphNodeOverlayPosition   GraphNodeOverlay = 2
 )
 
-//func NewGraphNodeFromPointer(ptr gdnative.Pointer) GraphNode {
-func newGraphNodeFromPointer(ptr gdnative.Pointer) GraphNode {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GraphNode{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the GraphNodeOverlay value.
+func (e GraphNodeOverlay) String() string {
+	switch e {
+	case GraphNodeOverlayBreakpoint:
+		return "OVERLAY_BREAKPOINT"
+	case GraphNodeOverlayDisabled:
+		return "OVERLAY_DISABLED"
+	case GraphNodeOverlayPosition:
+		return "OVERLAY_POSITION"
+	}
+	return fmt.Sprintf("GraphNodeOverlay(%d)", int(e))
+}
 
-	return obj
+// ParseGraphNodeOverlay will return the GraphNodeOverlay value with the given Godot name.
+func ParseGraphNodeOverlay(name string) (GraphNodeOverlay, error) {
+	switch name {
+	case "OVERLAY_BREAKPOINT":
+		return GraphNodeOverlayBreakpoint, nil
+	case "OVERLAY_DISABLED":
+		return GraphNodeOverlayDisabled, nil
+	case "OVERLAY_POSITION":
+		return GraphNodeOverlayPosition, nil
+	}
+	return 0, fmt.Errorf("invalid GraphNodeOverlay value %q", name)
 }
 
 /*
@@ -39,23 +59,74 @@ type GraphNode struct {
 	owner gdnative.Object
 }
 
+// classConstructorGraphNode is the class constructor of the GraphNode class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGraphNode = gdnative.NewLazyClassConstructor("GraphNode")
+
+// NewGraphNode will create a new instance of the GraphNode class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewGraphNode() *GraphNode {
+	obj := &GraphNode{}
+	obj.SetBaseObject(classConstructorGraphNode.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GraphNode) BaseClass() string {
 	return "GraphNode"
 }
 
+// Method binds of the GraphNode class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGraphNodeX_GuiInput                  = gdnative.NewLazyMethodBind("GraphNode", "_gui_input")
+	methodBindGraphNodeClearAllSlots               = gdnative.NewLazyMethodBind("GraphNode", "clear_all_slots")
+	methodBindGraphNodeClearSlot                   = gdnative.NewLazyMethodBind("GraphNode", "clear_slot")
+	methodBindGraphNodeGetConnectionInputColor     = gdnative.NewLazyMethodBind("GraphNode", "get_connection_input_color")
+	methodBindGraphNodeGetConnectionInputCount     = gdnative.NewLazyMethodBind("GraphNode", "get_connection_input_count")
+	methodBindGraphNodeGetConnectionInputPosition  = gdnative.NewLazyMethodBind("GraphNode", "get_connection_input_position")
+	methodBindGraphNodeGetConnectionInputType      = gdnative.NewLazyMethodBind("GraphNode", "get_connection_input_type")
+	methodBindGraphNodeGetConnectionOutputColor    = gdnative.NewLazyMethodBind("GraphNode", "get_connection_output_color")
+	methodBindGraphNodeGetConnectionOutputCount    = gdnative.NewLazyMethodBind("GraphNode", "get_connection_output_count")
+	methodBindGraphNodeGetConnectionOutputPosition = gdnative.NewLazyMethodBind("GraphNode", "get_connection_output_position")
+	methodBindGraphNodeGetConnectionOutputType     = gdnative.NewLazyMethodBind("GraphNode", "get_connection_output_type")
+	methodBindGraphNodeGetOffset                   = gdnative.NewLazyMethodBind("GraphNode", "get_offset")
+	methodBindGraphNodeGetOverlay                  = gdnative.NewLazyMethodBind("GraphNode", "get_overlay")
+	methodBindGraphNodeGetSlotColorLeft            = gdnative.NewLazyMethodBind("GraphNode", "get_slot_color_left")
+	methodBindGraphNodeGetSlotColorRight           = gdnative.NewLazyMethodBind("GraphNode", "get_slot_color_right")
+	methodBindGraphNodeGetSlotTypeLeft             = gdnative.NewLazyMethodBind("GraphNode", "get_slot_type_left")
+	methodBindGraphNodeGetSlotTypeRight            = gdnative.NewLazyMethodBind("GraphNode", "get_slot_type_right")
+	methodBindGraphNodeGetTitle                    = gdnative.NewLazyMethodBind("GraphNode", "get_title")
+	methodBindGraphNodeIsCloseButtonVisible        = gdnative.NewLazyMethodBind("GraphNode", "is_close_button_visible")
+	methodBindGraphNodeIsComment                   = gdnative.NewLazyMethodBind("GraphNode", "is_comment")
+	methodBindGraphNodeIsResizable                 = gdnative.NewLazyMethodBind("GraphNode", "is_resizable")
+	methodBindGraphNodeIsSelected                  = gdnative.NewLazyMethodBind("GraphNode", "is_selected")
+	methodBindGraphNodeIsSlotEnabledLeft           = gdnative.NewLazyMethodBind("GraphNode", "is_slot_enabled_left")
+	methodBindGraphNodeIsSlotEnabledRight          = gdnative.NewLazyMethodBind("GraphNode", "is_slot_enabled_right")
+	methodBindGraphNodeSetComment                  = gdnative.NewLazyMethodBind("GraphNode", "set_comment")
+	methodBindGraphNodeSetOffset                   = gdnative.NewLazyMethodBind("GraphNode", "set_offset")
+	methodBindGraphNodeSetOverlay                  = gdnative.NewLazyMethodBind("GraphNode", "set_overlay")
+	methodBindGraphNodeSetResizable                = gdnative.NewLazyMethodBind("GraphNode", "set_resizable")
+	methodBindGraphNodeSetSelected                 = gdnative.NewLazyMethodBind("GraphNode", "set_selected")
+	methodBindGraphNodeSetShowCloseButton          = gdnative.NewLazyMethodBind("GraphNode", "set_show_close_button")
+	methodBindGraphNodeSetSlot                     = gdnative.NewLazyMethodBind("GraphNode", "set_slot")
+	methodBindGraphNodeSetTitle                    = gdnative.NewLazyMethodBind("GraphNode", "set_title")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *GraphNode) X_GuiInput(arg0 InputEventImplementer) {
+func (o *GraphNode) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling GraphNode.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "_gui_input")
+	methodBind := methodBindGraphNodeX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -65,8 +136,8 @@ func (o *GraphNode) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Disable all input and output slots of the GraphNode.
-	Args: [], Returns: void
+	        Disable all input and output slots of the GraphNode.
+		Args: [], Returns: void
 */
 func (o *GraphNode) ClearAllSlots() {
 	//log.Println("Calling GraphNode.ClearAllSlots()")
@@ -75,7 +146,7 @@ func (o *GraphNode) ClearAllSlots() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "clear_all_slots")
+	methodBind := methodBindGraphNodeClearAllSlots.Get()
 
 	// Call the parent method.
 	// void
@@ -85,8 +156,8 @@ func (o *GraphNode) ClearAllSlots() {
 }
 
 /*
-        Disable input and output slot whose index is 'idx'.
-	Args: [{ false idx int}], Returns: void
+	        Disable input and output slot whose index is 'idx'.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *GraphNode) ClearSlot(idx gdnative.Int) {
 	//log.Println("Calling GraphNode.ClearSlot()")
@@ -96,7 +167,7 @@ func (o *GraphNode) ClearSlot(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "clear_slot")
+	methodBind := methodBindGraphNodeClearSlot.Get()
 
 	// Call the parent method.
 	// void
@@ -106,8 +177,8 @@ func (o *GraphNode) ClearSlot(idx gdnative.Int) {
 }
 
 /*
-        Return the color of the input connection 'idx'.
-	Args: [{ false idx int}], Returns: Color
+	        Return the color of the input connection 'idx'.
+		Args: [{ false idx int}], Returns: Color
 */
 func (o *GraphNode) GetConnectionInputColor(idx gdnative.Int) gdnative.Color {
 	//log.Println("Calling GraphNode.GetConnectionInputColor()")
@@ -117,7 +188,7 @@ func (o *GraphNode) GetConnectionInputColor(idx gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_input_color")
+	methodBind := methodBindGraphNodeGetConnectionInputColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -130,8 +201,8 @@ func (o *GraphNode) GetConnectionInputColor(idx gdnative.Int) gdnative.Color {
 }
 
 /*
-        Return the number of enabled input slots (connections) to the GraphNode.
-	Args: [], Returns: int
+	        Return the number of enabled input slots (connections) to the GraphNode.
+		Args: [], Returns: int
 */
 func (o *GraphNode) GetConnectionInputCount() gdnative.Int {
 	//log.Println("Calling GraphNode.GetConnectionInputCount()")
@@ -140,7 +211,7 @@ func (o *GraphNode) GetConnectionInputCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_input_count")
+	methodBind := methodBindGraphNodeGetConnectionInputCount.Get()
 
 	// Call the parent method.
 	// int
@@ -153,8 +224,8 @@ func (o *GraphNode) GetConnectionInputCount() gdnative.Int {
 }
 
 /*
-        Return the position of the input connection 'idx'.
-	Args: [{ false idx int}], Returns: Vector2
+	        Return the position of the input connection 'idx'.
+		Args: [{ false idx int}], Returns: Vector2
 */
 func (o *GraphNode) GetConnectionInputPosition(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling GraphNode.GetConnectionInputPosition()")
@@ -164,7 +235,7 @@ func (o *GraphNode) GetConnectionInputPosition(idx gdnative.Int) gdnative.Vector
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_input_position")
+	methodBind := methodBindGraphNodeGetConnectionInputPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -177,8 +248,8 @@ func (o *GraphNode) GetConnectionInputPosition(idx gdnative.Int) gdnative.Vector
 }
 
 /*
-        Return the type of the input connection 'idx'.
-	Args: [{ false idx int}], Returns: int
+	        Return the type of the input connection 'idx'.
+		Args: [{ false idx int}], Returns: int
 */
 func (o *GraphNode) GetConnectionInputType(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling GraphNode.GetConnectionInputType()")
@@ -188,7 +259,7 @@ func (o *GraphNode) GetConnectionInputType(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_input_type")
+	methodBind := methodBindGraphNodeGetConnectionInputType.Get()
 
 	// Call the parent method.
 	// int
@@ -201,8 +272,8 @@ func (o *GraphNode) GetConnectionInputType(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return the color of the output connection 'idx'.
-	Args: [{ false idx int}], Returns: Color
+	        Return the color of the output connection 'idx'.
+		Args: [{ false idx int}], Returns: Color
 */
 func (o *GraphNode) GetConnectionOutputColor(idx gdnative.Int) gdnative.Color {
 	//log.Println("Calling GraphNode.GetConnectionOutputColor()")
@@ -212,7 +283,7 @@ func (o *GraphNode) GetConnectionOutputColor(idx gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_output_color")
+	methodBind := methodBindGraphNodeGetConnectionOutputColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -225,8 +296,8 @@ func (o *GraphNode) GetConnectionOutputColor(idx gdnative.Int) gdnative.Color {
 }
 
 /*
-        Return the number of enabled output slots (connections) of the GraphNode.
-	Args: [], Returns: int
+	        Return the number of enabled output slots (connections) of the GraphNode.
+		Args: [], Returns: int
 */
 func (o *GraphNode) GetConnectionOutputCount() gdnative.Int {
 	//log.Println("Calling GraphNode.GetConnectionOutputCount()")
@@ -235,7 +306,7 @@ func (o *GraphNode) GetConnectionOutputCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_output_count")
+	methodBind := methodBindGraphNodeGetConnectionOutputCount.Get()
 
 	// Call the parent method.
 	// int
@@ -248,8 +319,8 @@ func (o *GraphNode) GetConnectionOutputCount() gdnative.Int {
 }
 
 /*
-        Return the position of the output connection 'idx'.
-	Args: [{ false idx int}], Returns: Vector2
+	        Return the position of the output connection 'idx'.
+		Args: [{ false idx int}], Returns: Vector2
 */
 func (o *GraphNode) GetConnectionOutputPosition(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling GraphNode.GetConnectionOutputPosition()")
@@ -259,7 +330,7 @@ func (o *GraphNode) GetConnectionOutputPosition(idx gdnative.Int) gdnative.Vecto
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_output_position")
+	methodBind := methodBindGraphNodeGetConnectionOutputPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -272,8 +343,8 @@ func (o *GraphNode) GetConnectionOutputPosition(idx gdnative.Int) gdnative.Vecto
 }
 
 /*
-        Return the type of the output connection 'idx'.
-	Args: [{ false idx int}], Returns: int
+	        Return the type of the output connection 'idx'.
+		Args: [{ false idx int}], Returns: int
 */
 func (o *GraphNode) GetConnectionOutputType(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling GraphNode.GetConnectionOutputType()")
@@ -283,7 +354,7 @@ func (o *GraphNode) GetConnectionOutputType(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_connection_output_type")
+	methodBind := methodBindGraphNodeGetConnectionOutputType.Get()
 
 	// Call the parent method.
 	// int
@@ -296,8 +367,8 @@ func (o *GraphNode) GetConnectionOutputType(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *GraphNode) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling GraphNode.GetOffset()")
@@ -306,7 +377,7 @@ func (o *GraphNode) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_offset")
+	methodBind := methodBindGraphNodeGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -319,8 +390,8 @@ func (o *GraphNode) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.GraphNode::Overlay
+	        Undocumented
+		Args: [], Returns: enum.GraphNode::Overlay
 */
 func (o *GraphNode) GetOverlay() GraphNodeOverlay {
 	//log.Println("Calling GraphNode.GetOverlay()")
@@ -329,7 +400,7 @@ func (o *GraphNode) GetOverlay() GraphNodeOverlay {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_overlay")
+	methodBind := methodBindGraphNodeGetOverlay.Get()
 
 	// Call the parent method.
 	// enum.GraphNode::Overlay
@@ -342,8 +413,8 @@ func (o *GraphNode) GetOverlay() GraphNodeOverlay {
 }
 
 /*
-        Return the color set to 'idx' left (input) slot.
-	Args: [{ false idx int}], Returns: Color
+	        Return the color set to 'idx' left (input) slot.
+		Args: [{ false idx int}], Returns: Color
 */
 func (o *GraphNode) GetSlotColorLeft(idx gdnative.Int) gdnative.Color {
 	//log.Println("Calling GraphNode.GetSlotColorLeft()")
@@ -353,7 +424,7 @@ func (o *GraphNode) GetSlotColorLeft(idx gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_slot_color_left")
+	methodBind := methodBindGraphNodeGetSlotColorLeft.Get()
 
 	// Call the parent method.
 	// Color
@@ -366,8 +437,8 @@ func (o *GraphNode) GetSlotColorLeft(idx gdnative.Int) gdnative.Color {
 }
 
 /*
-        Return the color set to 'idx' right (output) slot.
-	Args: [{ false idx int}], Returns: Color
+	        Return the color set to 'idx' right (output) slot.
+		Args: [{ false idx int}], Returns: Color
 */
 func (o *GraphNode) GetSlotColorRight(idx gdnative.Int) gdnative.Color {
 	//log.Println("Calling GraphNode.GetSlotColorRight()")
@@ -377,7 +448,7 @@ func (o *GraphNode) GetSlotColorRight(idx gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_slot_color_right")
+	methodBind := methodBindGraphNodeGetSlotColorRight.Get()
 
 	// Call the parent method.
 	// Color
@@ -390,8 +461,8 @@ func (o *GraphNode) GetSlotColorRight(idx gdnative.Int) gdnative.Color {
 }
 
 /*
-        Return the (integer) type of left (input) 'idx' slot.
-	Args: [{ false idx int}], Returns: int
+	        Return the (integer) type of left (input) 'idx' slot.
+		Args: [{ false idx int}], Returns: int
 */
 func (o *GraphNode) GetSlotTypeLeft(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling GraphNode.GetSlotTypeLeft()")
@@ -401,7 +472,7 @@ func (o *GraphNode) GetSlotTypeLeft(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_slot_type_left")
+	methodBind := methodBindGraphNodeGetSlotTypeLeft.Get()
 
 	// Call the parent method.
 	// int
@@ -414,8 +485,8 @@ func (o *GraphNode) GetSlotTypeLeft(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return the (integer) type of right (output) 'idx' slot.
-	Args: [{ false idx int}], Returns: int
+	        Return the (integer) type of right (output) 'idx' slot.
+		Args: [{ false idx int}], Returns: int
 */
 func (o *GraphNode) GetSlotTypeRight(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling GraphNode.GetSlotTypeRight()")
@@ -425,7 +496,7 @@ func (o *GraphNode) GetSlotTypeRight(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_slot_type_right")
+	methodBind := methodBindGraphNodeGetSlotTypeRight.Get()
 
 	// Call the parent method.
 	// int
@@ -438,8 +509,8 @@ func (o *GraphNode) GetSlotTypeRight(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *GraphNode) GetTitle() gdnative.String {
 	//log.Println("Calling GraphNode.GetTitle()")
@@ -448,7 +519,7 @@ func (o *GraphNode) GetTitle() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "get_title")
+	methodBind := methodBindGraphNodeGetTitle.Get()
 
 	// Call the parent method.
 	// String
@@ -461,8 +532,8 @@ func (o *GraphNode) GetTitle() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GraphNode) IsCloseButtonVisible() gdnative.Bool {
 	//log.Println("Calling GraphNode.IsCloseButtonVisible()")
@@ -471,7 +542,7 @@ func (o *GraphNode) IsCloseButtonVisible() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "is_close_button_visible")
+	methodBind := methodBindGraphNodeIsCloseButtonVisible.Get()
 
 	// Call the parent method.
 	// bool
@@ -484,8 +555,8 @@ func (o *GraphNode) IsCloseButtonVisible() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GraphNode) IsComment() gdnative.Bool {
 	//log.Println("Calling GraphNode.IsComment()")
@@ -494,7 +565,7 @@ func (o *GraphNode) IsComment() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "is_comment")
+	methodBind := methodBindGraphNodeIsComment.Get()
 
 	// Call the parent method.
 	// bool
@@ -507,8 +578,8 @@ func (o *GraphNode) IsComment() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GraphNode) IsResizable() gdnative.Bool {
 	//log.Println("Calling GraphNode.IsResizable()")
@@ -517,7 +588,7 @@ func (o *GraphNode) IsResizable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "is_resizable")
+	methodBind := methodBindGraphNodeIsResizable.Get()
 
 	// Call the parent method.
 	// bool
@@ -530,8 +601,8 @@ func (o *GraphNode) IsResizable() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GraphNode) IsSelected() gdnative.Bool {
 	//log.Println("Calling GraphNode.IsSelected()")
@@ -540,7 +611,7 @@ func (o *GraphNode) IsSelected() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "is_selected")
+	methodBind := methodBindGraphNodeIsSelected.Get()
 
 	// Call the parent method.
 	// bool
@@ -553,8 +624,8 @@ func (o *GraphNode) IsSelected() gdnative.Bool {
 }
 
 /*
-        Return true if left (input) slot 'idx' is enabled. False otherwise.
-	Args: [{ false idx int}], Returns: bool
+	        Return true if left (input) slot 'idx' is enabled. False otherwise.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *GraphNode) IsSlotEnabledLeft(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling GraphNode.IsSlotEnabledLeft()")
@@ -564,7 +635,7 @@ func (o *GraphNode) IsSlotEnabledLeft(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "is_slot_enabled_left")
+	methodBind := methodBindGraphNodeIsSlotEnabledLeft.Get()
 
 	// Call the parent method.
 	// bool
@@ -577,8 +648,8 @@ func (o *GraphNode) IsSlotEnabledLeft(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Return true if right (output) slot 'idx' is enabled. False otherwise.
-	Args: [{ false idx int}], Returns: bool
+	        Return true if right (output) slot 'idx' is enabled. False otherwise.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *GraphNode) IsSlotEnabledRight(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling GraphNode.IsSlotEnabledRight()")
@@ -588,7 +659,7 @@ func (o *GraphNode) IsSlotEnabledRight(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "is_slot_enabled_right")
+	methodBind := methodBindGraphNodeIsSlotEnabledRight.Get()
 
 	// Call the parent method.
 	// bool
@@ -601,8 +672,8 @@ func (o *GraphNode) IsSlotEnabledRight(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false comment bool}], Returns: void
+	        Undocumented
+		Args: [{ false comment bool}], Returns: void
 */
 func (o *GraphNode) SetComment(comment gdnative.Bool) {
 	//log.Println("Calling GraphNode.SetComment()")
@@ -612,7 +683,7 @@ func (o *GraphNode) SetComment(comment gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(comment)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_comment")
+	methodBind := methodBindGraphNodeSetComment.Get()
 
 	// Call the parent method.
 	// void
@@ -622,8 +693,8 @@ func (o *GraphNode) SetComment(comment gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *GraphNode) SetOffset(offset gdnative.Vector2) {
 	//log.Println("Calling GraphNode.SetOffset()")
@@ -633,7 +704,7 @@ func (o *GraphNode) SetOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_offset")
+	methodBind := methodBindGraphNodeSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -643,18 +714,18 @@ func (o *GraphNode) SetOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false overlay int}], Returns: void
+	        Undocumented
+		Args: [{ false overlay enum.GraphNode::Overlay}], Returns: void
 */
-func (o *GraphNode) SetOverlay(overlay gdnative.Int) {
+func (o *GraphNode) SetOverlay(overlay GraphNodeOverlay) {
 	//log.Println("Calling GraphNode.SetOverlay()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(overlay)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(overlay))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_overlay")
+	methodBind := methodBindGraphNodeSetOverlay.Get()
 
 	// Call the parent method.
 	// void
@@ -664,8 +735,8 @@ func (o *GraphNode) SetOverlay(overlay gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false resizable bool}], Returns: void
+	        Undocumented
+		Args: [{ false resizable bool}], Returns: void
 */
 func (o *GraphNode) SetResizable(resizable gdnative.Bool) {
 	//log.Println("Calling GraphNode.SetResizable()")
@@ -675,7 +746,7 @@ func (o *GraphNode) SetResizable(resizable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(resizable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_resizable")
+	methodBind := methodBindGraphNodeSetResizable.Get()
 
 	// Call the parent method.
 	// void
@@ -685,8 +756,8 @@ func (o *GraphNode) SetResizable(resizable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false selected bool}], Returns: void
+	        Undocumented
+		Args: [{ false selected bool}], Returns: void
 */
 func (o *GraphNode) SetSelected(selected gdnative.Bool) {
 	//log.Println("Calling GraphNode.SetSelected()")
@@ -696,7 +767,7 @@ func (o *GraphNode) SetSelected(selected gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(selected)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_selected")
+	methodBind := methodBindGraphNodeSetSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -706,8 +777,8 @@ func (o *GraphNode) SetSelected(selected gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false show bool}], Returns: void
+	        Undocumented
+		Args: [{ false show bool}], Returns: void
 */
 func (o *GraphNode) SetShowCloseButton(show gdnative.Bool) {
 	//log.Println("Calling GraphNode.SetShowCloseButton()")
@@ -717,7 +788,7 @@ func (o *GraphNode) SetShowCloseButton(show gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(show)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_show_close_button")
+	methodBind := methodBindGraphNodeSetShowCloseButton.Get()
 
 	// Call the parent method.
 	// void
@@ -727,10 +798,9 @@ func (o *GraphNode) SetShowCloseButton(show gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false idx int} { false enable_left bool} { false type_left int} { false color_left Color} { false enable_right bool} { false type_right int} { false color_right Color} {[Object:null] true custom_left Texture} {[Object:null] true custom_right Texture}], Returns: void
+Args: [{ false idx int} { false enable_left bool} { false type_left int} { false color_left Color} { false enable_right bool} { false type_right int} { false color_right Color} {[Object:null] true custom_left Texture} {[Object:null] true custom_right Texture}], Returns: void
 */
-func (o *GraphNode) SetSlot(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft gdnative.Int, colorLeft gdnative.Color, enableRight gdnative.Bool, typeRight gdnative.Int, colorRight gdnative.Color, customLeft TextureImplementer, customRight TextureImplementer) {
+func (o *GraphNode) SetSlot(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft gdnative.Int, colorLeft gdnative.Color, enableRight gdnative.Bool, typeRight gdnative.Int, colorRight gdnative.Color, customLeft godot.TextureImplementer, customRight godot.TextureImplementer) {
 	//log.Println("Calling GraphNode.SetSlot()")
 
 	// Build out the method's arguments
@@ -742,11 +812,11 @@ func (o *GraphNode) SetSlot(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft
 	ptrArguments[4] = gdnative.NewPointerFromBool(enableRight)
 	ptrArguments[5] = gdnative.NewPointerFromInt(typeRight)
 	ptrArguments[6] = gdnative.NewPointerFromColor(colorRight)
-	ptrArguments[7] = gdnative.NewPointerFromObject(customLeft.GetBaseObject())
-	ptrArguments[8] = gdnative.NewPointerFromObject(customRight.GetBaseObject())
+	ptrArguments[7] = gdnative.NewPointerFromObject(godot.GetBaseObject(customLeft))
+	ptrArguments[8] = gdnative.NewPointerFromObject(godot.GetBaseObject(customRight))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_slot")
+	methodBind := methodBindGraphNodeSetSlot.Get()
 
 	// Call the parent method.
 	// void
@@ -756,8 +826,8 @@ func (o *GraphNode) SetSlot(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft
 }
 
 /*
-        Undocumented
-	Args: [{ false title String}], Returns: void
+	        Undocumented
+		Args: [{ false title String}], Returns: void
 */
 func (o *GraphNode) SetTitle(title gdnative.String) {
 	//log.Println("Calling GraphNode.SetTitle()")
@@ -767,7 +837,7 @@ func (o *GraphNode) SetTitle(title gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(title)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GraphNode", "set_title")
+	methodBind := methodBindGraphNodeSetTitle.Get()
 
 	// Call the parent method.
 	// void
@@ -776,6 +846,157 @@ func (o *GraphNode) SetTitle(title gdnative.String) {
 
 }
 
+// SetSlotWithDefaults will call SetSlot using the default values for: custom_left, custom_right.
+func (o *GraphNode) SetSlotWithDefaults(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft gdnative.Int, colorLeft gdnative.Color, enableRight gdnative.Bool, typeRight gdnative.Int, colorRight gdnative.Color) {
+	o.SetSlot(idx, enableLeft, typeLeft, colorLeft, enableRight, typeRight, colorRight, nil, nil)
+}
+
+// Comment will return the value of the "comment" property.
+func (o *GraphNode) Comment() gdnative.Bool {
+	return o.IsComment()
+}
+
+// Offset will return the value of the "offset" property.
+func (o *GraphNode) Offset() gdnative.Vector2 {
+	return o.GetOffset()
+}
+
+// Overlay will return the value of the "overlay" property.
+func (o *GraphNode) Overlay() GraphNodeOverlay {
+	return o.GetOverlay()
+}
+
+// Resizable will return the value of the "resizable" property.
+func (o *GraphNode) Resizable() gdnative.Bool {
+	return o.IsResizable()
+}
+
+// Selected will return the value of the "selected" property.
+func (o *GraphNode) Selected() gdnative.Bool {
+	return o.IsSelected()
+}
+
+// ShowClose will return the value of the "show_close" property.
+func (o *GraphNode) ShowClose() gdnative.Bool {
+	return o.IsCloseButtonVisible()
+}
+
+// SetShowClose will set the value of the "show_close" property.
+func (o *GraphNode) SetShowClose(value gdnative.Bool) {
+	o.SetShowCloseButton(value)
+}
+
+// Title will return the value of the "title" property.
+func (o *GraphNode) Title() gdnative.String {
+	return o.GetTitle()
+}
+
+// Signals of the GraphNode class.
+const (
+	GraphNodeSignalCloseRequest  gdnative.String = "close_request"
+	GraphNodeSignalDragged       gdnative.String = "dragged"
+	GraphNodeSignalOffsetChanged gdnative.String = "offset_changed"
+	GraphNodeSignalRaiseRequest  gdnative.String = "raise_request"
+	GraphNodeSignalResizeRequest gdnative.String = "resize_request"
+)
+
+// ConnectCloseRequest will connect the "close_request" signal to the given method of the target object.
+func (o *GraphNode) ConnectCloseRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphNodeSignalCloseRequest, target, method, binds, 0)
+}
+
+// EmitCloseRequest will emit the "close_request" signal.
+func (o *GraphNode) EmitCloseRequest() error {
+
+	ret, err := o.EmitSignal(GraphNodeSignalCloseRequest)
+	ret.Destroy()
+	return err
+}
+
+// GraphNodeDraggedArgs holds the arguments of the "dragged" signal.
+type GraphNodeDraggedArgs struct {
+	From gdnative.Vector2
+	To   gdnative.Vector2
+}
+
+// ConnectDragged will connect the "dragged" signal to the given method of the target object.
+func (o *GraphNode) ConnectDragged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphNodeSignalDragged, target, method, binds, 0)
+}
+
+// EmitDragged will emit the "dragged" signal.
+func (o *GraphNode) EmitDragged(args GraphNodeDraggedArgs) error {
+	arg0 := gdnative.NewVariantVector2(args.From)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantVector2(args.To)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(GraphNodeSignalDragged, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// ConnectOffsetChanged will connect the "offset_changed" signal to the given method of the target object.
+func (o *GraphNode) ConnectOffsetChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphNodeSignalOffsetChanged, target, method, binds, 0)
+}
+
+// EmitOffsetChanged will emit the "offset_changed" signal.
+func (o *GraphNode) EmitOffsetChanged() error {
+
+	ret, err := o.EmitSignal(GraphNodeSignalOffsetChanged)
+	ret.Destroy()
+	return err
+}
+
+// ConnectRaiseRequest will connect the "raise_request" signal to the given method of the target object.
+func (o *GraphNode) ConnectRaiseRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphNodeSignalRaiseRequest, target, method, binds, 0)
+}
+
+// EmitRaiseRequest will emit the "raise_request" signal.
+func (o *GraphNode) EmitRaiseRequest() error {
+
+	ret, err := o.EmitSignal(GraphNodeSignalRaiseRequest)
+	ret.Destroy()
+	return err
+}
+
+// GraphNodeResizeRequestArgs holds the arguments of the "resize_request" signal.
+type GraphNodeResizeRequestArgs struct {
+	NewMinsize gdnative.Vector2
+}
+
+// ConnectResizeRequest will connect the "resize_request" signal to the given method of the target object.
+func (o *GraphNode) ConnectResizeRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GraphNodeSignalResizeRequest, target, method, binds, 0)
+}
+
+// EmitResizeRequest will emit the "resize_request" signal.
+func (o *GraphNode) EmitResizeRequest(args GraphNodeResizeRequestArgs) error {
+	arg0 := gdnative.NewVariantVector2(args.NewMinsize)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(GraphNodeSignalResizeRequest, arg0)
+	ret.Destroy()
+	return err
+}
+
 // GraphNodeImplementer is an interface that implements the methods
 // of the GraphNode class.
 type GraphNodeImplementer interface {
@@ -791,6 +1012,7 @@ type GraphNodeImplementer interface {
 	GetConnectionOutputPosition(idx gdnative.Int) gdnative.Vector2
 	GetConnectionOutputType(idx gdnative.Int) gdnative.Int
 	GetOffset() gdnative.Vector2
+	GetOverlay() GraphNodeOverlay
 	GetSlotColorLeft(idx gdnative.Int) gdnative.Color
 	GetSlotColorRight(idx gdnative.Int) gdnative.Color
 	GetSlotTypeLeft(idx gdnative.Int) gdnative.Int
@@ -804,10 +1026,29 @@ type GraphNodeImplementer interface {
 	IsSlotEnabledRight(idx gdnative.Int) gdnative.Bool
 	SetComment(comment gdnative.Bool)
 	SetOffset(offset gdnative.Vector2)
-	SetOverlay(overlay gdnative.Int)
+	SetOverlay(overlay GraphNodeOverlay)
 	SetResizable(resizable gdnative.Bool)
 	SetSelected(selected gdnative.Bool)
 	SetShowCloseButton(show gdnative.Bool)
-	SetSlot(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft gdnative.Int, colorLeft gdnative.Color, enableRight gdnative.Bool, typeRight gdnative.Int, colorRight gdnative.Color, customLeft TextureImplementer, customRight TextureImplementer)
+	SetSlot(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft gdnative.Int, colorLeft gdnative.Color, enableRight gdnative.Bool, typeRight gdnative.Int, colorRight gdnative.Color, customLeft godot.TextureImplementer, customRight godot.TextureImplementer)
 	SetTitle(title gdnative.String)
+	SetSlotWithDefaults(idx gdnative.Int, enableLeft gdnative.Bool, typeLeft gdnative.Int, colorLeft gdnative.Color, enableRight gdnative.Bool, typeRight gdnative.Int, colorRight gdnative.Color)
+	Comment() gdnative.Bool
+	Offset() gdnative.Vector2
+	Overlay() GraphNodeOverlay
+	Resizable() gdnative.Bool
+	Selected() gdnative.Bool
+	ShowClose() gdnative.Bool
+	SetShowClose(value gdnative.Bool)
+	Title() gdnative.String
+	ConnectCloseRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCloseRequest() error
+	ConnectDragged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDragged(args GraphNodeDraggedArgs) error
+	ConnectOffsetChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitOffsetChanged() error
+	ConnectRaiseRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRaiseRequest() error
+	ConnectResizeRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitResizeRequest(args GraphNodeResizeRequestArgs) error
 }
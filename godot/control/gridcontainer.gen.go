@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGridContainerFromPointer(ptr gdnative.Pointer) GridContainer {
-func newGridContainerFromPointer(ptr gdnative.Pointer) GridContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GridContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Grid container will arrange its children in a grid like structure, the grid columns are specified using the [method set_columns] method and the number of rows will be equal to the number of children in the container divided by the number of columns, for example: if the container has 5 children, and 2 columns, there will be 3 rows in the container. Notice that grid layout will preserve the columns and rows for every size of the container.
 */
@@ -30,13 +22,34 @@ type GridContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorGridContainer is the class constructor of the GridContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGridContainer = gdnative.NewLazyClassConstructor("GridContainer")
+
+// NewGridContainer will create a new instance of the GridContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewGridContainer() *GridContainer {
+	obj := &GridContainer{}
+	obj.SetBaseObject(classConstructorGridContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GridContainer) BaseClass() string {
 	return "GridContainer"
 }
 
+// Method binds of the GridContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGridContainerGetColumns = gdnative.NewLazyMethodBind("GridContainer", "get_columns")
+	methodBindGridContainerSetColumns = gdnative.NewLazyMethodBind("GridContainer", "set_columns")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *GridContainer) GetColumns() gdnative.Int {
 	//log.Println("Calling GridContainer.GetColumns()")
@@ -45,7 +58,7 @@ func (o *GridContainer) GetColumns() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridContainer", "get_columns")
+	methodBind := methodBindGridContainerGetColumns.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +71,8 @@ func (o *GridContainer) GetColumns() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false columns int}], Returns: void
+	        Undocumented
+		Args: [{ false columns int}], Returns: void
 */
 func (o *GridContainer) SetColumns(columns gdnative.Int) {
 	//log.Println("Calling GridContainer.SetColumns()")
@@ -69,7 +82,7 @@ func (o *GridContainer) SetColumns(columns gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(columns)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridContainer", "set_columns")
+	methodBind := methodBindGridContainerSetColumns.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *GridContainer) SetColumns(columns gdnative.Int) {
 
 }
 
+// Columns will return the value of the "columns" property.
+func (o *GridContainer) Columns() gdnative.Int {
+	return o.GetColumns()
+}
+
 // GridContainerImplementer is an interface that implements the methods
 // of the GridContainer class.
 type GridContainerImplementer interface {
 	ContainerImplementer
 	GetColumns() gdnative.Int
 	SetColumns(columns gdnative.Int)
+	Columns() gdnative.Int
 }
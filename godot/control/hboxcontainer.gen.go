@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewHBoxContainerFromPointer(ptr gdnative.Pointer) HBoxContainer {
-func newHBoxContainerFromPointer(ptr gdnative.Pointer) HBoxContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := HBoxContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Horizontal box container. See [BoxContainer].
 */
@@ -30,6 +22,20 @@ type HBoxContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorHBoxContainer is the class constructor of the HBoxContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHBoxContainer = gdnative.NewLazyClassConstructor("HBoxContainer")
+
+// NewHBoxContainer will create a new instance of the HBoxContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewHBoxContainer() *HBoxContainer {
+	obj := &HBoxContainer{}
+	obj.SetBaseObject(classConstructorHBoxContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *HBoxContainer) BaseClass() string {
 	return "HBoxContainer"
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewHScrollBarFromPointer(ptr gdnative.Pointer) HScrollBar {
-func newHScrollBarFromPointer(ptr gdnative.Pointer) HScrollBar {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := HScrollBar{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Horizontal scroll bar. See [ScrollBar]. This one goes from left (min) to right (max).
 */
@@ -30,6 +22,20 @@ type HScrollBar struct {
 	owner gdnative.Object
 }
 
+// classConstructorHScrollBar is the class constructor of the HScrollBar class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHScrollBar = gdnative.NewLazyClassConstructor("HScrollBar")
+
+// NewHScrollBar will create a new instance of the HScrollBar class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewHScrollBar() *HScrollBar {
+	obj := &HScrollBar{}
+	obj.SetBaseObject(classConstructorHScrollBar.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *HScrollBar) BaseClass() string {
 	return "HScrollBar"
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewHSeparatorFromPointer(ptr gdnative.Pointer) HSeparator {
-func newHSeparatorFromPointer(ptr gdnative.Pointer) HSeparator {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := HSeparator{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Horizontal separator. See [Separator]. It is used to separate objects vertically, though (but it looks horizontal!).
 */
@@ -30,6 +22,20 @@ type HSeparator struct {
 	owner gdnative.Object
 }
 
+// classConstructorHSeparator is the class constructor of the HSeparator class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHSeparator = gdnative.NewLazyClassConstructor("HSeparator")
+
+// NewHSeparator will create a new instance of the HSeparator class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewHSeparator() *HSeparator {
+	obj := &HSeparator{}
+	obj.SetBaseObject(classConstructorHSeparator.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *HSeparator) BaseClass() string {
 	return "HSeparator"
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,28 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewHSliderFromPointer(ptr gdnative.Pointer) HSlider {
-func newHSliderFromPointer(ptr gdnative.Pointer) HSlider {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := HSlider{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Horizontal slider. See [Slider]. This one goes from left (min) to right (max).
 */
 type HSlider struct {
-	Slider
+	godot.Slider
 	owner gdnative.Object
 }
 
+// classConstructorHSlider is the class constructor of the HSlider class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHSlider = gdnative.NewLazyClassConstructor("HSlider")
+
+// NewHSlider will create a new instance of the HSlider class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewHSlider() *HSlider {
+	obj := &HSlider{}
+	obj.SetBaseObject(classConstructorHSlider.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *HSlider) BaseClass() string {
 	return "HSlider"
 }
@@ -37,5 +43,5 @@ func (o *HSlider) BaseClass() string {
 // HSliderImplementer is an interface that implements the methods
 // of the HSlider class.
 type HSliderImplementer interface {
-	SliderImplementer
+	godot.SliderImplementer
 }
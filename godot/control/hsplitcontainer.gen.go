@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewHSplitContainerFromPointer(ptr gdnative.Pointer) HSplitContainer {
-func newHSplitContainerFromPointer(ptr gdnative.Pointer) HSplitContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := HSplitContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Horizontal split container. See [SplitContainer]. This goes from left to right.
 */
@@ -30,6 +22,20 @@ type HSplitContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorHSplitContainer is the class constructor of the HSplitContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHSplitContainer = gdnative.NewLazyClassConstructor("HSplitContainer")
+
+// NewHSplitContainer will create a new instance of the HSplitContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewHSplitContainer() *HSplitContainer {
+	obj := &HSplitContainer{}
+	obj.SetBaseObject(classConstructorHSplitContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *HSplitContainer) BaseClass() string {
 	return "HSplitContainer"
 }
@@ -1,7 +1,10 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,6 +24,28 @@ const (
 	ItemListIconModeTop  ItemListIconMode = 0
 )
 
+// String will return the Godot name of the ItemListIconMode value.
+func (e ItemListIconMode) String() string {
+	switch e {
+	case ItemListIconModeLeft:
+		return "ICON_MODE_LEFT"
+	case ItemListIconModeTop:
+		return "ICON_MODE_TOP"
+	}
+	return fmt.Sprintf("ItemListIconMode(%d)", int(e))
+}
+
+// ParseItemListIconMode will return the ItemListIconMode value with the given Godot name.
+func ParseItemListIconMode(name string) (ItemListIconMode, error) {
+	switch name {
+	case "ICON_MODE_LEFT":
+		return ItemListIconModeLeft, nil
+	case "ICON_MODE_TOP":
+		return ItemListIconModeTop, nil
+	}
+	return 0, fmt.Errorf("invalid ItemListIconMode value %q", name)
+}
+
 // ItemListSelectMode is an enum for SelectMode values.
 type ItemListSelectMode int
 
@@ -29,30 +54,119 @@ const (
 	ItemListSelectSingle ItemListSelectMode = 0
 )
 
-//func NewItemListFromPointer(ptr gdnative.Pointer) ItemList {
-func newItemListFromPointer(ptr gdnative.Pointer) ItemList {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ItemList{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ItemListSelectMode value.
+func (e ItemListSelectMode) String() string {
+	switch e {
+	case ItemListSelectMulti:
+		return "SELECT_MULTI"
+	case ItemListSelectSingle:
+		return "SELECT_SINGLE"
+	}
+	return fmt.Sprintf("ItemListSelectMode(%d)", int(e))
+}
 
-	return obj
+// ParseItemListSelectMode will return the ItemListSelectMode value with the given Godot name.
+func ParseItemListSelectMode(name string) (ItemListSelectMode, error) {
+	switch name {
+	case "SELECT_MULTI":
+		return ItemListSelectMulti, nil
+	case "SELECT_SINGLE":
+		return ItemListSelectSingle, nil
+	}
+	return 0, fmt.Errorf("invalid ItemListSelectMode value %q", name)
 }
 
 /*
 This control provides a selectable list of items that may be in a single (or multiple columns) with option of text, icons, or both text and icon. Tooltips are supported and may be different for every item in the list. Selectable items in the list may be selected or deselected and multiple selection may be enabled. Selection with right mouse button may also be enabled to allow use of popup context menus. Items may also be 'activated' with a double click (or Enter key).
 */
 type ItemList struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorItemList is the class constructor of the ItemList class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorItemList = gdnative.NewLazyClassConstructor("ItemList")
+
+// NewItemList will create a new instance of the ItemList class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewItemList() *ItemList {
+	obj := &ItemList{}
+	obj.SetBaseObject(classConstructorItemList.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ItemList) BaseClass() string {
 	return "ItemList"
 }
 
+// Method binds of the ItemList class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindItemListX_GetItems             = gdnative.NewLazyMethodBind("ItemList", "_get_items")
+	methodBindItemListX_GuiInput             = gdnative.NewLazyMethodBind("ItemList", "_gui_input")
+	methodBindItemListX_ScrollChanged        = gdnative.NewLazyMethodBind("ItemList", "_scroll_changed")
+	methodBindItemListX_SetItems             = gdnative.NewLazyMethodBind("ItemList", "_set_items")
+	methodBindItemListAddIconItem            = gdnative.NewLazyMethodBind("ItemList", "add_icon_item")
+	methodBindItemListAddItem                = gdnative.NewLazyMethodBind("ItemList", "add_item")
+	methodBindItemListClear                  = gdnative.NewLazyMethodBind("ItemList", "clear")
+	methodBindItemListEnsureCurrentIsVisible = gdnative.NewLazyMethodBind("ItemList", "ensure_current_is_visible")
+	methodBindItemListGetAllowReselect       = gdnative.NewLazyMethodBind("ItemList", "get_allow_reselect")
+	methodBindItemListGetAllowRmbSelect      = gdnative.NewLazyMethodBind("ItemList", "get_allow_rmb_select")
+	methodBindItemListGetFixedColumnWidth    = gdnative.NewLazyMethodBind("ItemList", "get_fixed_column_width")
+	methodBindItemListGetFixedIconSize       = gdnative.NewLazyMethodBind("ItemList", "get_fixed_icon_size")
+	methodBindItemListGetIconMode            = gdnative.NewLazyMethodBind("ItemList", "get_icon_mode")
+	methodBindItemListGetIconScale           = gdnative.NewLazyMethodBind("ItemList", "get_icon_scale")
+	methodBindItemListGetItemAtPosition      = gdnative.NewLazyMethodBind("ItemList", "get_item_at_position")
+	methodBindItemListGetItemCount           = gdnative.NewLazyMethodBind("ItemList", "get_item_count")
+	methodBindItemListGetItemCustomBgColor   = gdnative.NewLazyMethodBind("ItemList", "get_item_custom_bg_color")
+	methodBindItemListGetItemIcon            = gdnative.NewLazyMethodBind("ItemList", "get_item_icon")
+	methodBindItemListGetItemIconRegion      = gdnative.NewLazyMethodBind("ItemList", "get_item_icon_region")
+	methodBindItemListGetItemMetadata        = gdnative.NewLazyMethodBind("ItemList", "get_item_metadata")
+	methodBindItemListGetItemText            = gdnative.NewLazyMethodBind("ItemList", "get_item_text")
+	methodBindItemListGetItemTooltip         = gdnative.NewLazyMethodBind("ItemList", "get_item_tooltip")
+	methodBindItemListGetMaxColumns          = gdnative.NewLazyMethodBind("ItemList", "get_max_columns")
+	methodBindItemListGetMaxTextLines        = gdnative.NewLazyMethodBind("ItemList", "get_max_text_lines")
+	methodBindItemListGetSelectMode          = gdnative.NewLazyMethodBind("ItemList", "get_select_mode")
+	methodBindItemListGetSelectedItems       = gdnative.NewLazyMethodBind("ItemList", "get_selected_items")
+	methodBindItemListGetVScroll             = gdnative.NewLazyMethodBind("ItemList", "get_v_scroll")
+	methodBindItemListHasAutoHeight          = gdnative.NewLazyMethodBind("ItemList", "has_auto_height")
+	methodBindItemListIsItemDisabled         = gdnative.NewLazyMethodBind("ItemList", "is_item_disabled")
+	methodBindItemListIsItemSelectable       = gdnative.NewLazyMethodBind("ItemList", "is_item_selectable")
+	methodBindItemListIsItemTooltipEnabled   = gdnative.NewLazyMethodBind("ItemList", "is_item_tooltip_enabled")
+	methodBindItemListIsSameColumnWidth      = gdnative.NewLazyMethodBind("ItemList", "is_same_column_width")
+	methodBindItemListIsSelected             = gdnative.NewLazyMethodBind("ItemList", "is_selected")
+	methodBindItemListRemoveItem             = gdnative.NewLazyMethodBind("ItemList", "remove_item")
+	methodBindItemListSelect                 = gdnative.NewLazyMethodBind("ItemList", "select")
+	methodBindItemListSetAllowReselect       = gdnative.NewLazyMethodBind("ItemList", "set_allow_reselect")
+	methodBindItemListSetAllowRmbSelect      = gdnative.NewLazyMethodBind("ItemList", "set_allow_rmb_select")
+	methodBindItemListSetAutoHeight          = gdnative.NewLazyMethodBind("ItemList", "set_auto_height")
+	methodBindItemListSetFixedColumnWidth    = gdnative.NewLazyMethodBind("ItemList", "set_fixed_column_width")
+	methodBindItemListSetFixedIconSize       = gdnative.NewLazyMethodBind("ItemList", "set_fixed_icon_size")
+	methodBindItemListSetIconMode            = gdnative.NewLazyMethodBind("ItemList", "set_icon_mode")
+	methodBindItemListSetIconScale           = gdnative.NewLazyMethodBind("ItemList", "set_icon_scale")
+	methodBindItemListSetItemCustomBgColor   = gdnative.NewLazyMethodBind("ItemList", "set_item_custom_bg_color")
+	methodBindItemListSetItemDisabled        = gdnative.NewLazyMethodBind("ItemList", "set_item_disabled")
+	methodBindItemListSetItemIcon            = gdnative.NewLazyMethodBind("ItemList", "set_item_icon")
+	methodBindItemListSetItemIconRegion      = gdnative.NewLazyMethodBind("ItemList", "set_item_icon_region")
+	methodBindItemListSetItemMetadata        = gdnative.NewLazyMethodBind("ItemList", "set_item_metadata")
+	methodBindItemListSetItemSelectable      = gdnative.NewLazyMethodBind("ItemList", "set_item_selectable")
+	methodBindItemListSetItemText            = gdnative.NewLazyMethodBind("ItemList", "set_item_text")
+	methodBindItemListSetItemTooltip         = gdnative.NewLazyMethodBind("ItemList", "set_item_tooltip")
+	methodBindItemListSetItemTooltipEnabled  = gdnative.NewLazyMethodBind("ItemList", "set_item_tooltip_enabled")
+	methodBindItemListSetMaxColumns          = gdnative.NewLazyMethodBind("ItemList", "set_max_columns")
+	methodBindItemListSetMaxTextLines        = gdnative.NewLazyMethodBind("ItemList", "set_max_text_lines")
+	methodBindItemListSetSameColumnWidth     = gdnative.NewLazyMethodBind("ItemList", "set_same_column_width")
+	methodBindItemListSetSelectMode          = gdnative.NewLazyMethodBind("ItemList", "set_select_mode")
+	methodBindItemListSortItemsByText        = gdnative.NewLazyMethodBind("ItemList", "sort_items_by_text")
+	methodBindItemListUnselect               = gdnative.NewLazyMethodBind("ItemList", "unselect")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *ItemList) X_GetItems() gdnative.Array {
 	//log.Println("Calling ItemList.X_GetItems()")
@@ -61,7 +175,7 @@ func (o *ItemList) X_GetItems() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "_get_items")
+	methodBind := methodBindItemListX_GetItems.Get()
 
 	// Call the parent method.
 	// Array
@@ -74,18 +188,18 @@ func (o *ItemList) X_GetItems() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ItemList) X_GuiInput(arg0 InputEventImplementer) {
+func (o *ItemList) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ItemList.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "_gui_input")
+	methodBind := methodBindItemListX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -95,8 +209,8 @@ func (o *ItemList) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *ItemList) X_ScrollChanged(arg0 gdnative.Real) {
 	//log.Println("Calling ItemList.X_ScrollChanged()")
@@ -106,7 +220,7 @@ func (o *ItemList) X_ScrollChanged(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "_scroll_changed")
+	methodBind := methodBindItemListX_ScrollChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -116,8 +230,8 @@ func (o *ItemList) X_ScrollChanged(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
 */
 func (o *ItemList) X_SetItems(arg0 gdnative.Array) {
 	//log.Println("Calling ItemList.X_SetItems()")
@@ -127,7 +241,7 @@ func (o *ItemList) X_SetItems(arg0 gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "_set_items")
+	methodBind := methodBindItemListX_SetItems.Get()
 
 	// Call the parent method.
 	// void
@@ -137,19 +251,19 @@ func (o *ItemList) X_SetItems(arg0 gdnative.Array) {
 }
 
 /*
-        Adds an item to the item list with no text, only an icon.
-	Args: [{ false icon Texture} {True true selectable bool}], Returns: void
+	        Adds an item to the item list with no text, only an icon.
+		Args: [{ false icon Texture} {True true selectable bool}], Returns: void
 */
-func (o *ItemList) AddIconItem(icon TextureImplementer, selectable gdnative.Bool) {
+func (o *ItemList) AddIconItem(icon godot.TextureImplementer, selectable gdnative.Bool) {
 	//log.Println("Calling ItemList.AddIconItem()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(icon.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
 	ptrArguments[1] = gdnative.NewPointerFromBool(selectable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "add_icon_item")
+	methodBind := methodBindItemListAddIconItem.Get()
 
 	// Call the parent method.
 	// void
@@ -159,20 +273,20 @@ func (o *ItemList) AddIconItem(icon TextureImplementer, selectable gdnative.Bool
 }
 
 /*
-        Adds an item to the item list with specified text. Specify an icon of null for a list item with no icon. If selectable is true the list item will be selectable.
-	Args: [{ false text String} {Null true icon Texture} {True true selectable bool}], Returns: void
+	        Adds an item to the item list with specified text. Specify an icon of null for a list item with no icon. If selectable is true the list item will be selectable.
+		Args: [{ false text String} {Null true icon Texture} {True true selectable bool}], Returns: void
 */
-func (o *ItemList) AddItem(text gdnative.String, icon TextureImplementer, selectable gdnative.Bool) {
+func (o *ItemList) AddItem(text gdnative.String, icon godot.TextureImplementer, selectable gdnative.Bool) {
 	//log.Println("Calling ItemList.AddItem()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
-	ptrArguments[1] = gdnative.NewPointerFromObject(icon.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
 	ptrArguments[2] = gdnative.NewPointerFromBool(selectable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "add_item")
+	methodBind := methodBindItemListAddItem.Get()
 
 	// Call the parent method.
 	// void
@@ -182,8 +296,8 @@ func (o *ItemList) AddItem(text gdnative.String, icon TextureImplementer, select
 }
 
 /*
-        Remove all items from the list.
-	Args: [], Returns: void
+	        Remove all items from the list.
+		Args: [], Returns: void
 */
 func (o *ItemList) Clear() {
 	//log.Println("Calling ItemList.Clear()")
@@ -192,7 +306,7 @@ func (o *ItemList) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "clear")
+	methodBind := methodBindItemListClear.Get()
 
 	// Call the parent method.
 	// void
@@ -202,8 +316,8 @@ func (o *ItemList) Clear() {
 }
 
 /*
-        Ensure selection is visible, adjusting the scroll position as necessary.
-	Args: [], Returns: void
+	        Ensure selection is visible, adjusting the scroll position as necessary.
+		Args: [], Returns: void
 */
 func (o *ItemList) EnsureCurrentIsVisible() {
 	//log.Println("Calling ItemList.EnsureCurrentIsVisible()")
@@ -212,7 +326,7 @@ func (o *ItemList) EnsureCurrentIsVisible() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "ensure_current_is_visible")
+	methodBind := methodBindItemListEnsureCurrentIsVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -222,8 +336,8 @@ func (o *ItemList) EnsureCurrentIsVisible() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ItemList) GetAllowReselect() gdnative.Bool {
 	//log.Println("Calling ItemList.GetAllowReselect()")
@@ -232,7 +346,7 @@ func (o *ItemList) GetAllowReselect() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_allow_reselect")
+	methodBind := methodBindItemListGetAllowReselect.Get()
 
 	// Call the parent method.
 	// bool
@@ -245,8 +359,8 @@ func (o *ItemList) GetAllowReselect() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ItemList) GetAllowRmbSelect() gdnative.Bool {
 	//log.Println("Calling ItemList.GetAllowRmbSelect()")
@@ -255,7 +369,7 @@ func (o *ItemList) GetAllowRmbSelect() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_allow_rmb_select")
+	methodBind := methodBindItemListGetAllowRmbSelect.Get()
 
 	// Call the parent method.
 	// bool
@@ -268,8 +382,8 @@ func (o *ItemList) GetAllowRmbSelect() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ItemList) GetFixedColumnWidth() gdnative.Int {
 	//log.Println("Calling ItemList.GetFixedColumnWidth()")
@@ -278,7 +392,7 @@ func (o *ItemList) GetFixedColumnWidth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_fixed_column_width")
+	methodBind := methodBindItemListGetFixedColumnWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -291,8 +405,8 @@ func (o *ItemList) GetFixedColumnWidth() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ItemList) GetFixedIconSize() gdnative.Vector2 {
 	//log.Println("Calling ItemList.GetFixedIconSize()")
@@ -301,7 +415,7 @@ func (o *ItemList) GetFixedIconSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_fixed_icon_size")
+	methodBind := methodBindItemListGetFixedIconSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -314,8 +428,8 @@ func (o *ItemList) GetFixedIconSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.ItemList::IconMode
+	        Undocumented
+		Args: [], Returns: enum.ItemList::IconMode
 */
 func (o *ItemList) GetIconMode() ItemListIconMode {
 	//log.Println("Calling ItemList.GetIconMode()")
@@ -324,7 +438,7 @@ func (o *ItemList) GetIconMode() ItemListIconMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_icon_mode")
+	methodBind := methodBindItemListGetIconMode.Get()
 
 	// Call the parent method.
 	// enum.ItemList::IconMode
@@ -337,8 +451,8 @@ func (o *ItemList) GetIconMode() ItemListIconMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ItemList) GetIconScale() gdnative.Real {
 	//log.Println("Calling ItemList.GetIconScale()")
@@ -347,7 +461,7 @@ func (o *ItemList) GetIconScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_icon_scale")
+	methodBind := methodBindItemListGetIconScale.Get()
 
 	// Call the parent method.
 	// float
@@ -360,8 +474,8 @@ func (o *ItemList) GetIconScale() gdnative.Real {
 }
 
 /*
-        Given a position within the control return the item (if any) at that point.
-	Args: [{ false position Vector2} {False true exact bool}], Returns: int
+	        Given a position within the control return the item (if any) at that point.
+		Args: [{ false position Vector2} {False true exact bool}], Returns: int
 */
 func (o *ItemList) GetItemAtPosition(position gdnative.Vector2, exact gdnative.Bool) gdnative.Int {
 	//log.Println("Calling ItemList.GetItemAtPosition()")
@@ -372,7 +486,7 @@ func (o *ItemList) GetItemAtPosition(position gdnative.Vector2, exact gdnative.B
 	ptrArguments[1] = gdnative.NewPointerFromBool(exact)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_at_position")
+	methodBind := methodBindItemListGetItemAtPosition.Get()
 
 	// Call the parent method.
 	// int
@@ -385,8 +499,8 @@ func (o *ItemList) GetItemAtPosition(position gdnative.Vector2, exact gdnative.B
 }
 
 /*
-        Return count of items currently in the item list.
-	Args: [], Returns: int
+	        Return count of items currently in the item list.
+		Args: [], Returns: int
 */
 func (o *ItemList) GetItemCount() gdnative.Int {
 	//log.Println("Calling ItemList.GetItemCount()")
@@ -395,7 +509,7 @@ func (o *ItemList) GetItemCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_count")
+	methodBind := methodBindItemListGetItemCount.Get()
 
 	// Call the parent method.
 	// int
@@ -408,8 +522,7 @@ func (o *ItemList) GetItemCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Color
+Args: [{ false idx int}], Returns: Color
 */
 func (o *ItemList) GetItemCustomBgColor(idx gdnative.Int) gdnative.Color {
 	//log.Println("Calling ItemList.GetItemCustomBgColor()")
@@ -419,7 +532,7 @@ func (o *ItemList) GetItemCustomBgColor(idx gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_custom_bg_color")
+	methodBind := methodBindItemListGetItemCustomBgColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -432,10 +545,9 @@ func (o *ItemList) GetItemCustomBgColor(idx gdnative.Int) gdnative.Color {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Texture
+Args: [{ false idx int}], Returns: Texture
 */
-func (o *ItemList) GetItemIcon(idx gdnative.Int) TextureImplementer {
+func (o *ItemList) GetItemIcon(idx gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling ItemList.GetItemIcon()")
 
 	// Build out the method's arguments
@@ -443,7 +555,7 @@ func (o *ItemList) GetItemIcon(idx gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_icon")
+	methodBind := methodBindItemListGetItemIcon.Get()
 
 	// Call the parent method.
 	// Texture
@@ -451,27 +563,24 @@ func (o *ItemList) GetItemIcon(idx gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Rect2
+Args: [{ false idx int}], Returns: Rect2
 */
 func (o *ItemList) GetItemIconRegion(idx gdnative.Int) gdnative.Rect2 {
 	//log.Println("Calling ItemList.GetItemIconRegion()")
@@ -481,7 +590,7 @@ func (o *ItemList) GetItemIconRegion(idx gdnative.Int) gdnative.Rect2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_icon_region")
+	methodBind := methodBindItemListGetItemIconRegion.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -494,8 +603,7 @@ func (o *ItemList) GetItemIconRegion(idx gdnative.Int) gdnative.Rect2 {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Variant
+Args: [{ false idx int}], Returns: Variant
 */
 func (o *ItemList) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling ItemList.GetItemMetadata()")
@@ -505,7 +613,7 @@ func (o *ItemList) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_metadata")
+	methodBind := methodBindItemListGetItemMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -518,8 +626,8 @@ func (o *ItemList) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 }
 
 /*
-        Return the text for specified item index.
-	Args: [{ false idx int}], Returns: String
+	        Return the text for specified item index.
+		Args: [{ false idx int}], Returns: String
 */
 func (o *ItemList) GetItemText(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling ItemList.GetItemText()")
@@ -529,7 +637,7 @@ func (o *ItemList) GetItemText(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_text")
+	methodBind := methodBindItemListGetItemText.Get()
 
 	// Call the parent method.
 	// String
@@ -542,8 +650,8 @@ func (o *ItemList) GetItemText(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Return tooltip hint for specified item index.
-	Args: [{ false idx int}], Returns: String
+	        Return tooltip hint for specified item index.
+		Args: [{ false idx int}], Returns: String
 */
 func (o *ItemList) GetItemTooltip(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling ItemList.GetItemTooltip()")
@@ -553,7 +661,7 @@ func (o *ItemList) GetItemTooltip(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_item_tooltip")
+	methodBind := methodBindItemListGetItemTooltip.Get()
 
 	// Call the parent method.
 	// String
@@ -566,8 +674,8 @@ func (o *ItemList) GetItemTooltip(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ItemList) GetMaxColumns() gdnative.Int {
 	//log.Println("Calling ItemList.GetMaxColumns()")
@@ -576,7 +684,7 @@ func (o *ItemList) GetMaxColumns() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_max_columns")
+	methodBind := methodBindItemListGetMaxColumns.Get()
 
 	// Call the parent method.
 	// int
@@ -589,8 +697,8 @@ func (o *ItemList) GetMaxColumns() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ItemList) GetMaxTextLines() gdnative.Int {
 	//log.Println("Calling ItemList.GetMaxTextLines()")
@@ -599,7 +707,7 @@ func (o *ItemList) GetMaxTextLines() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_max_text_lines")
+	methodBind := methodBindItemListGetMaxTextLines.Get()
 
 	// Call the parent method.
 	// int
@@ -612,8 +720,8 @@ func (o *ItemList) GetMaxTextLines() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.ItemList::SelectMode
+	        Undocumented
+		Args: [], Returns: enum.ItemList::SelectMode
 */
 func (o *ItemList) GetSelectMode() ItemListSelectMode {
 	//log.Println("Calling ItemList.GetSelectMode()")
@@ -622,7 +730,7 @@ func (o *ItemList) GetSelectMode() ItemListSelectMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_select_mode")
+	methodBind := methodBindItemListGetSelectMode.Get()
 
 	// Call the parent method.
 	// enum.ItemList::SelectMode
@@ -635,8 +743,8 @@ func (o *ItemList) GetSelectMode() ItemListSelectMode {
 }
 
 /*
-        Returns the list of selected indexes.
-	Args: [], Returns: PoolIntArray
+	        Returns the list of selected indexes.
+		Args: [], Returns: PoolIntArray
 */
 func (o *ItemList) GetSelectedItems() gdnative.PoolIntArray {
 	//log.Println("Calling ItemList.GetSelectedItems()")
@@ -645,7 +753,7 @@ func (o *ItemList) GetSelectedItems() gdnative.PoolIntArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_selected_items")
+	methodBind := methodBindItemListGetSelectedItems.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -658,8 +766,8 @@ func (o *ItemList) GetSelectedItems() gdnative.PoolIntArray {
 }
 
 /*
-        Returns the current vertical scroll bar for the List.
-	Args: [], Returns: VScrollBar
+	        Returns the current vertical scroll bar for the List.
+		Args: [], Returns: VScrollBar
 */
 func (o *ItemList) GetVScroll() VScrollBarImplementer {
 	//log.Println("Calling ItemList.GetVScroll()")
@@ -668,7 +776,7 @@ func (o *ItemList) GetVScroll() VScrollBarImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "get_v_scroll")
+	methodBind := methodBindItemListGetVScroll.Get()
 
 	// Call the parent method.
 	// VScrollBar
@@ -676,27 +784,25 @@ func (o *ItemList) GetVScroll() VScrollBarImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newVScrollBarFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(VScrollBarImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "VScrollBar" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(VScrollBarImplementer)
+	ret := VScrollBar{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(VScrollBarImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ItemList) HasAutoHeight() gdnative.Bool {
 	//log.Println("Calling ItemList.HasAutoHeight()")
@@ -705,7 +811,7 @@ func (o *ItemList) HasAutoHeight() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "has_auto_height")
+	methodBind := methodBindItemListHasAutoHeight.Get()
 
 	// Call the parent method.
 	// bool
@@ -718,8 +824,8 @@ func (o *ItemList) HasAutoHeight() gdnative.Bool {
 }
 
 /*
-        Returns whether or not the item at the specified index is disabled
-	Args: [{ false idx int}], Returns: bool
+	        Returns whether or not the item at the specified index is disabled
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *ItemList) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling ItemList.IsItemDisabled()")
@@ -729,7 +835,7 @@ func (o *ItemList) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "is_item_disabled")
+	methodBind := methodBindItemListIsItemDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -742,8 +848,8 @@ func (o *ItemList) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns whether or not the item at the specified index is selectable.
-	Args: [{ false idx int}], Returns: bool
+	        Returns whether or not the item at the specified index is selectable.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *ItemList) IsItemSelectable(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling ItemList.IsItemSelectable()")
@@ -753,7 +859,7 @@ func (o *ItemList) IsItemSelectable(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "is_item_selectable")
+	methodBind := methodBindItemListIsItemSelectable.Get()
 
 	// Call the parent method.
 	// bool
@@ -766,8 +872,8 @@ func (o *ItemList) IsItemSelectable(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns whether the tooltip is enabled for specified item index.
-	Args: [{ false idx int}], Returns: bool
+	        Returns whether the tooltip is enabled for specified item index.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *ItemList) IsItemTooltipEnabled(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling ItemList.IsItemTooltipEnabled()")
@@ -777,7 +883,7 @@ func (o *ItemList) IsItemTooltipEnabled(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "is_item_tooltip_enabled")
+	methodBind := methodBindItemListIsItemTooltipEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -790,8 +896,8 @@ func (o *ItemList) IsItemTooltipEnabled(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ItemList) IsSameColumnWidth() gdnative.Bool {
 	//log.Println("Calling ItemList.IsSameColumnWidth()")
@@ -800,7 +906,7 @@ func (o *ItemList) IsSameColumnWidth() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "is_same_column_width")
+	methodBind := methodBindItemListIsSameColumnWidth.Get()
 
 	// Call the parent method.
 	// bool
@@ -813,8 +919,8 @@ func (o *ItemList) IsSameColumnWidth() gdnative.Bool {
 }
 
 /*
-        Returns whether or not item at the specified index is currently selected.
-	Args: [{ false idx int}], Returns: bool
+	        Returns whether or not item at the specified index is currently selected.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *ItemList) IsSelected(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling ItemList.IsSelected()")
@@ -824,7 +930,7 @@ func (o *ItemList) IsSelected(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "is_selected")
+	methodBind := methodBindItemListIsSelected.Get()
 
 	// Call the parent method.
 	// bool
@@ -837,8 +943,8 @@ func (o *ItemList) IsSelected(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Remove item at specified index from the list.
-	Args: [{ false idx int}], Returns: void
+	        Remove item at specified index from the list.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *ItemList) RemoveItem(idx gdnative.Int) {
 	//log.Println("Calling ItemList.RemoveItem()")
@@ -848,7 +954,7 @@ func (o *ItemList) RemoveItem(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "remove_item")
+	methodBind := methodBindItemListRemoveItem.Get()
 
 	// Call the parent method.
 	// void
@@ -858,8 +964,8 @@ func (o *ItemList) RemoveItem(idx gdnative.Int) {
 }
 
 /*
-        Select the item at the specified index. Note: This method does not trigger the item selection signal.
-	Args: [{ false idx int} {True true single bool}], Returns: void
+	        Select the item at the specified index. Note: This method does not trigger the item selection signal.
+		Args: [{ false idx int} {True true single bool}], Returns: void
 */
 func (o *ItemList) Select(idx gdnative.Int, single gdnative.Bool) {
 	//log.Println("Calling ItemList.Select()")
@@ -870,7 +976,7 @@ func (o *ItemList) Select(idx gdnative.Int, single gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(single)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "select")
+	methodBind := methodBindItemListSelect.Get()
 
 	// Call the parent method.
 	// void
@@ -880,8 +986,8 @@ func (o *ItemList) Select(idx gdnative.Int, single gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false allow bool}], Returns: void
+	        Undocumented
+		Args: [{ false allow bool}], Returns: void
 */
 func (o *ItemList) SetAllowReselect(allow gdnative.Bool) {
 	//log.Println("Calling ItemList.SetAllowReselect()")
@@ -891,7 +997,7 @@ func (o *ItemList) SetAllowReselect(allow gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(allow)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_allow_reselect")
+	methodBind := methodBindItemListSetAllowReselect.Get()
 
 	// Call the parent method.
 	// void
@@ -901,8 +1007,8 @@ func (o *ItemList) SetAllowReselect(allow gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false allow bool}], Returns: void
+	        Undocumented
+		Args: [{ false allow bool}], Returns: void
 */
 func (o *ItemList) SetAllowRmbSelect(allow gdnative.Bool) {
 	//log.Println("Calling ItemList.SetAllowRmbSelect()")
@@ -912,7 +1018,7 @@ func (o *ItemList) SetAllowRmbSelect(allow gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(allow)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_allow_rmb_select")
+	methodBind := methodBindItemListSetAllowRmbSelect.Get()
 
 	// Call the parent method.
 	// void
@@ -922,8 +1028,8 @@ func (o *ItemList) SetAllowRmbSelect(allow gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ItemList) SetAutoHeight(enable gdnative.Bool) {
 	//log.Println("Calling ItemList.SetAutoHeight()")
@@ -933,7 +1039,7 @@ func (o *ItemList) SetAutoHeight(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_auto_height")
+	methodBind := methodBindItemListSetAutoHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -943,8 +1049,8 @@ func (o *ItemList) SetAutoHeight(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false width int}], Returns: void
+	        Undocumented
+		Args: [{ false width int}], Returns: void
 */
 func (o *ItemList) SetFixedColumnWidth(width gdnative.Int) {
 	//log.Println("Calling ItemList.SetFixedColumnWidth()")
@@ -954,7 +1060,7 @@ func (o *ItemList) SetFixedColumnWidth(width gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_fixed_column_width")
+	methodBind := methodBindItemListSetFixedColumnWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -964,8 +1070,8 @@ func (o *ItemList) SetFixedColumnWidth(width gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *ItemList) SetFixedIconSize(size gdnative.Vector2) {
 	//log.Println("Calling ItemList.SetFixedIconSize()")
@@ -975,7 +1081,7 @@ func (o *ItemList) SetFixedIconSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_fixed_icon_size")
+	methodBind := methodBindItemListSetFixedIconSize.Get()
 
 	// Call the parent method.
 	// void
@@ -985,18 +1091,18 @@ func (o *ItemList) SetFixedIconSize(size gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.ItemList::IconMode}], Returns: void
 */
-func (o *ItemList) SetIconMode(mode gdnative.Int) {
+func (o *ItemList) SetIconMode(mode ItemListIconMode) {
 	//log.Println("Calling ItemList.SetIconMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_icon_mode")
+	methodBind := methodBindItemListSetIconMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1006,8 +1112,8 @@ func (o *ItemList) SetIconMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale float}], Returns: void
+	        Undocumented
+		Args: [{ false scale float}], Returns: void
 */
 func (o *ItemList) SetIconScale(scale gdnative.Real) {
 	//log.Println("Calling ItemList.SetIconScale()")
@@ -1017,7 +1123,7 @@ func (o *ItemList) SetIconScale(scale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_icon_scale")
+	methodBind := methodBindItemListSetIconScale.Get()
 
 	// Call the parent method.
 	// void
@@ -1027,8 +1133,7 @@ func (o *ItemList) SetIconScale(scale gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false idx int} { false custom_bg_color Color}], Returns: void
+Args: [{ false idx int} { false custom_bg_color Color}], Returns: void
 */
 func (o *ItemList) SetItemCustomBgColor(idx gdnative.Int, customBgColor gdnative.Color) {
 	//log.Println("Calling ItemList.SetItemCustomBgColor()")
@@ -1039,7 +1144,7 @@ func (o *ItemList) SetItemCustomBgColor(idx gdnative.Int, customBgColor gdnative
 	ptrArguments[1] = gdnative.NewPointerFromColor(customBgColor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_custom_bg_color")
+	methodBind := methodBindItemListSetItemCustomBgColor.Get()
 
 	// Call the parent method.
 	// void
@@ -1049,8 +1154,8 @@ func (o *ItemList) SetItemCustomBgColor(idx gdnative.Int, customBgColor gdnative
 }
 
 /*
-        Disable (or enable) item at specified index. Disabled items are not be selectable and do not fire activation (Enter or double-click) signals.
-	Args: [{ false idx int} { false disabled bool}], Returns: void
+	        Disable (or enable) item at specified index. Disabled items are not be selectable and do not fire activation (Enter or double-click) signals.
+		Args: [{ false idx int} { false disabled bool}], Returns: void
 */
 func (o *ItemList) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool) {
 	//log.Println("Calling ItemList.SetItemDisabled()")
@@ -1061,7 +1166,7 @@ func (o *ItemList) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_disabled")
+	methodBind := methodBindItemListSetItemDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1071,19 +1176,19 @@ func (o *ItemList) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool) {
 }
 
 /*
-        Set (or replace) icon of the item at the specified index.
-	Args: [{ false idx int} { false icon Texture}], Returns: void
+	        Set (or replace) icon of the item at the specified index.
+		Args: [{ false idx int} { false icon Texture}], Returns: void
 */
-func (o *ItemList) SetItemIcon(idx gdnative.Int, icon TextureImplementer) {
+func (o *ItemList) SetItemIcon(idx gdnative.Int, icon godot.TextureImplementer) {
 	//log.Println("Calling ItemList.SetItemIcon()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(icon.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_icon")
+	methodBind := methodBindItemListSetItemIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -1093,8 +1198,7 @@ func (o *ItemList) SetItemIcon(idx gdnative.Int, icon TextureImplementer) {
 }
 
 /*
-
-	Args: [{ false idx int} { false rect Rect2}], Returns: void
+Args: [{ false idx int} { false rect Rect2}], Returns: void
 */
 func (o *ItemList) SetItemIconRegion(idx gdnative.Int, rect gdnative.Rect2) {
 	//log.Println("Calling ItemList.SetItemIconRegion()")
@@ -1105,7 +1209,7 @@ func (o *ItemList) SetItemIconRegion(idx gdnative.Int, rect gdnative.Rect2) {
 	ptrArguments[1] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_icon_region")
+	methodBind := methodBindItemListSetItemIconRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -1115,8 +1219,8 @@ func (o *ItemList) SetItemIconRegion(idx gdnative.Int, rect gdnative.Rect2) {
 }
 
 /*
-        Sets a value (of any type) to be stored with the item at the specified index.
-	Args: [{ false idx int} { false metadata Variant}], Returns: void
+	        Sets a value (of any type) to be stored with the item at the specified index.
+		Args: [{ false idx int} { false metadata Variant}], Returns: void
 */
 func (o *ItemList) SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant) {
 	//log.Println("Calling ItemList.SetItemMetadata()")
@@ -1127,7 +1231,7 @@ func (o *ItemList) SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(metadata)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_metadata")
+	methodBind := methodBindItemListSetItemMetadata.Get()
 
 	// Call the parent method.
 	// void
@@ -1137,8 +1241,8 @@ func (o *ItemList) SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant)
 }
 
 /*
-        Allow or disallow selection of the item at the specified index.
-	Args: [{ false idx int} { false selectable bool}], Returns: void
+	        Allow or disallow selection of the item at the specified index.
+		Args: [{ false idx int} { false selectable bool}], Returns: void
 */
 func (o *ItemList) SetItemSelectable(idx gdnative.Int, selectable gdnative.Bool) {
 	//log.Println("Calling ItemList.SetItemSelectable()")
@@ -1149,7 +1253,7 @@ func (o *ItemList) SetItemSelectable(idx gdnative.Int, selectable gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(selectable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_selectable")
+	methodBind := methodBindItemListSetItemSelectable.Get()
 
 	// Call the parent method.
 	// void
@@ -1159,8 +1263,8 @@ func (o *ItemList) SetItemSelectable(idx gdnative.Int, selectable gdnative.Bool)
 }
 
 /*
-        Sets text of item at specified index.
-	Args: [{ false idx int} { false text String}], Returns: void
+	        Sets text of item at specified index.
+		Args: [{ false idx int} { false text String}], Returns: void
 */
 func (o *ItemList) SetItemText(idx gdnative.Int, text gdnative.String) {
 	//log.Println("Calling ItemList.SetItemText()")
@@ -1171,7 +1275,7 @@ func (o *ItemList) SetItemText(idx gdnative.Int, text gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_text")
+	methodBind := methodBindItemListSetItemText.Get()
 
 	// Call the parent method.
 	// void
@@ -1181,8 +1285,8 @@ func (o *ItemList) SetItemText(idx gdnative.Int, text gdnative.String) {
 }
 
 /*
-        Sets tooltip hint for item at specified index.
-	Args: [{ false idx int} { false tooltip String}], Returns: void
+	        Sets tooltip hint for item at specified index.
+		Args: [{ false idx int} { false tooltip String}], Returns: void
 */
 func (o *ItemList) SetItemTooltip(idx gdnative.Int, tooltip gdnative.String) {
 	//log.Println("Calling ItemList.SetItemTooltip()")
@@ -1193,7 +1297,7 @@ func (o *ItemList) SetItemTooltip(idx gdnative.Int, tooltip gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(tooltip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_tooltip")
+	methodBind := methodBindItemListSetItemTooltip.Get()
 
 	// Call the parent method.
 	// void
@@ -1203,8 +1307,8 @@ func (o *ItemList) SetItemTooltip(idx gdnative.Int, tooltip gdnative.String) {
 }
 
 /*
-        Sets whether the tooltip is enabled for specified item index.
-	Args: [{ false idx int} { false enable bool}], Returns: void
+	        Sets whether the tooltip is enabled for specified item index.
+		Args: [{ false idx int} { false enable bool}], Returns: void
 */
 func (o *ItemList) SetItemTooltipEnabled(idx gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling ItemList.SetItemTooltipEnabled()")
@@ -1215,7 +1319,7 @@ func (o *ItemList) SetItemTooltipEnabled(idx gdnative.Int, enable gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_item_tooltip_enabled")
+	methodBind := methodBindItemListSetItemTooltipEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1225,8 +1329,8 @@ func (o *ItemList) SetItemTooltipEnabled(idx gdnative.Int, enable gdnative.Bool)
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *ItemList) SetMaxColumns(amount gdnative.Int) {
 	//log.Println("Calling ItemList.SetMaxColumns()")
@@ -1236,7 +1340,7 @@ func (o *ItemList) SetMaxColumns(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_max_columns")
+	methodBind := methodBindItemListSetMaxColumns.Get()
 
 	// Call the parent method.
 	// void
@@ -1246,8 +1350,8 @@ func (o *ItemList) SetMaxColumns(amount gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false lines int}], Returns: void
+	        Undocumented
+		Args: [{ false lines int}], Returns: void
 */
 func (o *ItemList) SetMaxTextLines(lines gdnative.Int) {
 	//log.Println("Calling ItemList.SetMaxTextLines()")
@@ -1257,7 +1361,7 @@ func (o *ItemList) SetMaxTextLines(lines gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(lines)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_max_text_lines")
+	methodBind := methodBindItemListSetMaxTextLines.Get()
 
 	// Call the parent method.
 	// void
@@ -1267,8 +1371,8 @@ func (o *ItemList) SetMaxTextLines(lines gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ItemList) SetSameColumnWidth(enable gdnative.Bool) {
 	//log.Println("Calling ItemList.SetSameColumnWidth()")
@@ -1278,7 +1382,7 @@ func (o *ItemList) SetSameColumnWidth(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_same_column_width")
+	methodBind := methodBindItemListSetSameColumnWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -1288,18 +1392,18 @@ func (o *ItemList) SetSameColumnWidth(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.ItemList::SelectMode}], Returns: void
 */
-func (o *ItemList) SetSelectMode(mode gdnative.Int) {
+func (o *ItemList) SetSelectMode(mode ItemListSelectMode) {
 	//log.Println("Calling ItemList.SetSelectMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "set_select_mode")
+	methodBind := methodBindItemListSetSelectMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1309,8 +1413,8 @@ func (o *ItemList) SetSelectMode(mode gdnative.Int) {
 }
 
 /*
-        Sorts items in the list by their text.
-	Args: [], Returns: void
+	        Sorts items in the list by their text.
+		Args: [], Returns: void
 */
 func (o *ItemList) SortItemsByText() {
 	//log.Println("Calling ItemList.SortItemsByText()")
@@ -1319,7 +1423,7 @@ func (o *ItemList) SortItemsByText() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "sort_items_by_text")
+	methodBind := methodBindItemListSortItemsByText.Get()
 
 	// Call the parent method.
 	// void
@@ -1329,8 +1433,8 @@ func (o *ItemList) SortItemsByText() {
 }
 
 /*
-        Ensure item at specified index is not selected.
-	Args: [{ false idx int}], Returns: void
+	        Ensure item at specified index is not selected.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *ItemList) Unselect(idx gdnative.Int) {
 	//log.Println("Calling ItemList.Unselect()")
@@ -1340,7 +1444,7 @@ func (o *ItemList) Unselect(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ItemList", "unselect")
+	methodBind := methodBindItemListUnselect.Get()
 
 	// Call the parent method.
 	// void
@@ -1349,32 +1453,266 @@ func (o *ItemList) Unselect(idx gdnative.Int) {
 
 }
 
+// AddIconItemWithDefaults will call AddIconItem using the default values for: selectable.
+func (o *ItemList) AddIconItemWithDefaults(icon godot.TextureImplementer) {
+	o.AddIconItem(icon, true)
+}
+
+// AddItemWithDefaults will call AddItem using the default values for: icon, selectable.
+func (o *ItemList) AddItemWithDefaults(text gdnative.String) {
+	o.AddItem(text, nil, true)
+}
+
+// GetItemAtPositionWithDefaults will call GetItemAtPosition using the default values for: exact.
+func (o *ItemList) GetItemAtPositionWithDefaults(position gdnative.Vector2) gdnative.Int {
+	return o.GetItemAtPosition(position, false)
+}
+
+// SelectWithDefaults will call Select using the default values for: single.
+func (o *ItemList) SelectWithDefaults(idx gdnative.Int) {
+	o.Select(idx, true)
+}
+
+// AllowReselect will return the value of the "allow_reselect" property.
+func (o *ItemList) AllowReselect() gdnative.Bool {
+	return o.GetAllowReselect()
+}
+
+// AllowRmbSelect will return the value of the "allow_rmb_select" property.
+func (o *ItemList) AllowRmbSelect() gdnative.Bool {
+	return o.GetAllowRmbSelect()
+}
+
+// AutoHeight will return the value of the "auto_height" property.
+func (o *ItemList) AutoHeight() gdnative.Bool {
+	return o.HasAutoHeight()
+}
+
+// FixedColumnWidth will return the value of the "fixed_column_width" property.
+func (o *ItemList) FixedColumnWidth() gdnative.Int {
+	return o.GetFixedColumnWidth()
+}
+
+// FixedIconSize will return the value of the "fixed_icon_size" property.
+func (o *ItemList) FixedIconSize() gdnative.Vector2 {
+	return o.GetFixedIconSize()
+}
+
+// IconMode will return the value of the "icon_mode" property.
+func (o *ItemList) IconMode() ItemListIconMode {
+	return o.GetIconMode()
+}
+
+// IconScale will return the value of the "icon_scale" property.
+func (o *ItemList) IconScale() gdnative.Real {
+	return o.GetIconScale()
+}
+
+// Items will return the value of the "items" property.
+func (o *ItemList) Items() gdnative.Array {
+	return o.X_GetItems()
+}
+
+// SetItems will set the value of the "items" property.
+func (o *ItemList) SetItems(value gdnative.Array) {
+	o.X_SetItems(value)
+}
+
+// MaxColumns will return the value of the "max_columns" property.
+func (o *ItemList) MaxColumns() gdnative.Int {
+	return o.GetMaxColumns()
+}
+
+// MaxTextLines will return the value of the "max_text_lines" property.
+func (o *ItemList) MaxTextLines() gdnative.Int {
+	return o.GetMaxTextLines()
+}
+
+// SameColumnWidth will return the value of the "same_column_width" property.
+func (o *ItemList) SameColumnWidth() gdnative.Bool {
+	return o.IsSameColumnWidth()
+}
+
+// SelectMode will return the value of the "select_mode" property.
+func (o *ItemList) SelectMode() ItemListSelectMode {
+	return o.GetSelectMode()
+}
+
+// Signals of the ItemList class.
+const (
+	ItemListSignalItemActivated   gdnative.String = "item_activated"
+	ItemListSignalItemRmbSelected gdnative.String = "item_rmb_selected"
+	ItemListSignalItemSelected    gdnative.String = "item_selected"
+	ItemListSignalMultiSelected   gdnative.String = "multi_selected"
+	ItemListSignalNothingSelected gdnative.String = "nothing_selected"
+	ItemListSignalRmbClicked      gdnative.String = "rmb_clicked"
+)
+
+// ItemListItemActivatedArgs holds the arguments of the "item_activated" signal.
+type ItemListItemActivatedArgs struct {
+	Index gdnative.Int
+}
+
+// ConnectItemActivated will connect the "item_activated" signal to the given method of the target object.
+func (o *ItemList) ConnectItemActivated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ItemListSignalItemActivated, target, method, binds, 0)
+}
+
+// EmitItemActivated will emit the "item_activated" signal.
+func (o *ItemList) EmitItemActivated(args ItemListItemActivatedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Index))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ItemListSignalItemActivated, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ItemListItemRmbSelectedArgs holds the arguments of the "item_rmb_selected" signal.
+type ItemListItemRmbSelectedArgs struct {
+	Index      gdnative.Int
+	AtPosition gdnative.Vector2
+}
+
+// ConnectItemRmbSelected will connect the "item_rmb_selected" signal to the given method of the target object.
+func (o *ItemList) ConnectItemRmbSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ItemListSignalItemRmbSelected, target, method, binds, 0)
+}
+
+// EmitItemRmbSelected will emit the "item_rmb_selected" signal.
+func (o *ItemList) EmitItemRmbSelected(args ItemListItemRmbSelectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Index))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantVector2(args.AtPosition)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(ItemListSignalItemRmbSelected, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// ItemListItemSelectedArgs holds the arguments of the "item_selected" signal.
+type ItemListItemSelectedArgs struct {
+	Index gdnative.Int
+}
+
+// ConnectItemSelected will connect the "item_selected" signal to the given method of the target object.
+func (o *ItemList) ConnectItemSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ItemListSignalItemSelected, target, method, binds, 0)
+}
+
+// EmitItemSelected will emit the "item_selected" signal.
+func (o *ItemList) EmitItemSelected(args ItemListItemSelectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Index))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ItemListSignalItemSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ItemListMultiSelectedArgs holds the arguments of the "multi_selected" signal.
+type ItemListMultiSelectedArgs struct {
+	Index    gdnative.Int
+	Selected gdnative.Bool
+}
+
+// ConnectMultiSelected will connect the "multi_selected" signal to the given method of the target object.
+func (o *ItemList) ConnectMultiSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ItemListSignalMultiSelected, target, method, binds, 0)
+}
+
+// EmitMultiSelected will emit the "multi_selected" signal.
+func (o *ItemList) EmitMultiSelected(args ItemListMultiSelectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Index))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantBool(args.Selected)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(ItemListSignalMultiSelected, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// ConnectNothingSelected will connect the "nothing_selected" signal to the given method of the target object.
+func (o *ItemList) ConnectNothingSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ItemListSignalNothingSelected, target, method, binds, 0)
+}
+
+// EmitNothingSelected will emit the "nothing_selected" signal.
+func (o *ItemList) EmitNothingSelected() error {
+
+	ret, err := o.EmitSignal(ItemListSignalNothingSelected)
+	ret.Destroy()
+	return err
+}
+
+// ItemListRmbClickedArgs holds the arguments of the "rmb_clicked" signal.
+type ItemListRmbClickedArgs struct {
+	AtPosition gdnative.Vector2
+}
+
+// ConnectRmbClicked will connect the "rmb_clicked" signal to the given method of the target object.
+func (o *ItemList) ConnectRmbClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ItemListSignalRmbClicked, target, method, binds, 0)
+}
+
+// EmitRmbClicked will emit the "rmb_clicked" signal.
+func (o *ItemList) EmitRmbClicked(args ItemListRmbClickedArgs) error {
+	arg0 := gdnative.NewVariantVector2(args.AtPosition)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ItemListSignalRmbClicked, arg0)
+	ret.Destroy()
+	return err
+}
+
 // ItemListImplementer is an interface that implements the methods
 // of the ItemList class.
 type ItemListImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 	X_GetItems() gdnative.Array
 	X_ScrollChanged(arg0 gdnative.Real)
 	X_SetItems(arg0 gdnative.Array)
-	AddIconItem(icon TextureImplementer, selectable gdnative.Bool)
-	AddItem(text gdnative.String, icon TextureImplementer, selectable gdnative.Bool)
+	AddIconItem(icon godot.TextureImplementer, selectable gdnative.Bool)
+	AddItem(text gdnative.String, icon godot.TextureImplementer, selectable gdnative.Bool)
 	Clear()
 	EnsureCurrentIsVisible()
 	GetAllowReselect() gdnative.Bool
 	GetAllowRmbSelect() gdnative.Bool
 	GetFixedColumnWidth() gdnative.Int
 	GetFixedIconSize() gdnative.Vector2
+	GetIconMode() ItemListIconMode
 	GetIconScale() gdnative.Real
 	GetItemAtPosition(position gdnative.Vector2, exact gdnative.Bool) gdnative.Int
 	GetItemCount() gdnative.Int
 	GetItemCustomBgColor(idx gdnative.Int) gdnative.Color
-	GetItemIcon(idx gdnative.Int) TextureImplementer
+	GetItemIcon(idx gdnative.Int) godot.TextureImplementer
 	GetItemIconRegion(idx gdnative.Int) gdnative.Rect2
 	GetItemMetadata(idx gdnative.Int) gdnative.Variant
 	GetItemText(idx gdnative.Int) gdnative.String
 	GetItemTooltip(idx gdnative.Int) gdnative.String
 	GetMaxColumns() gdnative.Int
 	GetMaxTextLines() gdnative.Int
+	GetSelectMode() ItemListSelectMode
 	GetSelectedItems() gdnative.PoolIntArray
 	GetVScroll() VScrollBarImplementer
 	HasAutoHeight() gdnative.Bool
@@ -1390,11 +1728,11 @@ type ItemListImplementer interface {
 	SetAutoHeight(enable gdnative.Bool)
 	SetFixedColumnWidth(width gdnative.Int)
 	SetFixedIconSize(size gdnative.Vector2)
-	SetIconMode(mode gdnative.Int)
+	SetIconMode(mode ItemListIconMode)
 	SetIconScale(scale gdnative.Real)
 	SetItemCustomBgColor(idx gdnative.Int, customBgColor gdnative.Color)
 	SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool)
-	SetItemIcon(idx gdnative.Int, icon TextureImplementer)
+	SetItemIcon(idx gdnative.Int, icon godot.TextureImplementer)
 	SetItemIconRegion(idx gdnative.Int, rect gdnative.Rect2)
 	SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant)
 	SetItemSelectable(idx gdnative.Int, selectable gdnative.Bool)
@@ -1404,7 +1742,36 @@ type ItemListImplementer interface {
 	SetMaxColumns(amount gdnative.Int)
 	SetMaxTextLines(lines gdnative.Int)
 	SetSameColumnWidth(enable gdnative.Bool)
-	SetSelectMode(mode gdnative.Int)
+	SetSelectMode(mode ItemListSelectMode)
 	SortItemsByText()
 	Unselect(idx gdnative.Int)
+	AddIconItemWithDefaults(icon godot.TextureImplementer)
+	AddItemWithDefaults(text gdnative.String)
+	GetItemAtPositionWithDefaults(position gdnative.Vector2) gdnative.Int
+	SelectWithDefaults(idx gdnative.Int)
+	AllowReselect() gdnative.Bool
+	AllowRmbSelect() gdnative.Bool
+	AutoHeight() gdnative.Bool
+	FixedColumnWidth() gdnative.Int
+	FixedIconSize() gdnative.Vector2
+	IconMode() ItemListIconMode
+	IconScale() gdnative.Real
+	Items() gdnative.Array
+	SetItems(value gdnative.Array)
+	MaxColumns() gdnative.Int
+	MaxTextLines() gdnative.Int
+	SameColumnWidth() gdnative.Bool
+	SelectMode() ItemListSelectMode
+	ConnectItemActivated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemActivated(args ItemListItemActivatedArgs) error
+	ConnectItemRmbSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemRmbSelected(args ItemListItemRmbSelectedArgs) error
+	ConnectItemSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemSelected(args ItemListItemSelectedArgs) error
+	ConnectMultiSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMultiSelected(args ItemListMultiSelectedArgs) error
+	ConnectNothingSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNothingSelected() error
+	ConnectRmbClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRmbClicked(args ItemListRmbClickedArgs) error
 }
@@ -1,7 +1,10 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,6 +26,36 @@ const (
 	LabelAlignRight  LabelAlign = 2
 )
 
+// String will return the Godot name of the LabelAlign value.
+func (e LabelAlign) String() string {
+	switch e {
+	case LabelAlignCenter:
+		return "ALIGN_CENTER"
+	case LabelAlignFill:
+		return "ALIGN_FILL"
+	case LabelAlignLeft:
+		return "ALIGN_LEFT"
+	case LabelAlignRight:
+		return "ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("LabelAlign(%d)", int(e))
+}
+
+// ParseLabelAlign will return the LabelAlign value with the given Godot name.
+func ParseLabelAlign(name string) (LabelAlign, error) {
+	switch name {
+	case "ALIGN_CENTER":
+		return LabelAlignCenter, nil
+	case "ALIGN_FILL":
+		return LabelAlignFill, nil
+	case "ALIGN_LEFT":
+		return LabelAlignLeft, nil
+	case "ALIGN_RIGHT":
+		return LabelAlignRight, nil
+	}
+	return 0, fmt.Errorf("invalid LabelAlign value %q", name)
+}
+
 // LabelVAlign is an enum for VAlign values.
 type LabelVAlign int
 
@@ -33,30 +66,94 @@ const (
 	LabelValignTop    LabelVAlign = 0
 )
 
-//func NewLabelFromPointer(ptr gdnative.Pointer) Label {
-func newLabelFromPointer(ptr gdnative.Pointer) Label {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Label{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the LabelVAlign value.
+func (e LabelVAlign) String() string {
+	switch e {
+	case LabelValignBottom:
+		return "VALIGN_BOTTOM"
+	case LabelValignCenter:
+		return "VALIGN_CENTER"
+	case LabelValignFill:
+		return "VALIGN_FILL"
+	case LabelValignTop:
+		return "VALIGN_TOP"
+	}
+	return fmt.Sprintf("LabelVAlign(%d)", int(e))
+}
 
-	return obj
+// ParseLabelVAlign will return the LabelVAlign value with the given Godot name.
+func ParseLabelVAlign(name string) (LabelVAlign, error) {
+	switch name {
+	case "VALIGN_BOTTOM":
+		return LabelValignBottom, nil
+	case "VALIGN_CENTER":
+		return LabelValignCenter, nil
+	case "VALIGN_FILL":
+		return LabelValignFill, nil
+	case "VALIGN_TOP":
+		return LabelValignTop, nil
+	}
+	return 0, fmt.Errorf("invalid LabelVAlign value %q", name)
 }
 
 /*
 Label displays plain text on the screen. It gives you control over the horizontal and vertical alignment, and can wrap the text inside the node's bounding rectangle. It doesn't support bold, italics or other formatting. For that, use [RichTextLabel] instead. Note that contrarily to most other [Control]s, Label's [member Control.mouse_filter] defaults to MOUSE_FILTER_IGNORE (i.e. it doesn't react to mouse input events).
 */
 type Label struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorLabel is the class constructor of the Label class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLabel = gdnative.NewLazyClassConstructor("Label")
+
+// NewLabel will create a new instance of the Label class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewLabel() *Label {
+	obj := &Label{}
+	obj.SetBaseObject(classConstructorLabel.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Label) BaseClass() string {
 	return "Label"
 }
 
+// Method binds of the Label class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLabelGetAlign               = gdnative.NewLazyMethodBind("Label", "get_align")
+	methodBindLabelGetLineCount           = gdnative.NewLazyMethodBind("Label", "get_line_count")
+	methodBindLabelGetLineHeight          = gdnative.NewLazyMethodBind("Label", "get_line_height")
+	methodBindLabelGetLinesSkipped        = gdnative.NewLazyMethodBind("Label", "get_lines_skipped")
+	methodBindLabelGetMaxLinesVisible     = gdnative.NewLazyMethodBind("Label", "get_max_lines_visible")
+	methodBindLabelGetPercentVisible      = gdnative.NewLazyMethodBind("Label", "get_percent_visible")
+	methodBindLabelGetText                = gdnative.NewLazyMethodBind("Label", "get_text")
+	methodBindLabelGetTotalCharacterCount = gdnative.NewLazyMethodBind("Label", "get_total_character_count")
+	methodBindLabelGetValign              = gdnative.NewLazyMethodBind("Label", "get_valign")
+	methodBindLabelGetVisibleCharacters   = gdnative.NewLazyMethodBind("Label", "get_visible_characters")
+	methodBindLabelGetVisibleLineCount    = gdnative.NewLazyMethodBind("Label", "get_visible_line_count")
+	methodBindLabelHasAutowrap            = gdnative.NewLazyMethodBind("Label", "has_autowrap")
+	methodBindLabelIsClippingText         = gdnative.NewLazyMethodBind("Label", "is_clipping_text")
+	methodBindLabelIsUppercase            = gdnative.NewLazyMethodBind("Label", "is_uppercase")
+	methodBindLabelSetAlign               = gdnative.NewLazyMethodBind("Label", "set_align")
+	methodBindLabelSetAutowrap            = gdnative.NewLazyMethodBind("Label", "set_autowrap")
+	methodBindLabelSetClipText            = gdnative.NewLazyMethodBind("Label", "set_clip_text")
+	methodBindLabelSetLinesSkipped        = gdnative.NewLazyMethodBind("Label", "set_lines_skipped")
+	methodBindLabelSetMaxLinesVisible     = gdnative.NewLazyMethodBind("Label", "set_max_lines_visible")
+	methodBindLabelSetPercentVisible      = gdnative.NewLazyMethodBind("Label", "set_percent_visible")
+	methodBindLabelSetText                = gdnative.NewLazyMethodBind("Label", "set_text")
+	methodBindLabelSetUppercase           = gdnative.NewLazyMethodBind("Label", "set_uppercase")
+	methodBindLabelSetValign              = gdnative.NewLazyMethodBind("Label", "set_valign")
+	methodBindLabelSetVisibleCharacters   = gdnative.NewLazyMethodBind("Label", "set_visible_characters")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.Label::Align
+	        Undocumented
+		Args: [], Returns: enum.Label::Align
 */
 func (o *Label) GetAlign() LabelAlign {
 	//log.Println("Calling Label.GetAlign()")
@@ -65,7 +162,7 @@ func (o *Label) GetAlign() LabelAlign {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_align")
+	methodBind := methodBindLabelGetAlign.Get()
 
 	// Call the parent method.
 	// enum.Label::Align
@@ -78,8 +175,8 @@ func (o *Label) GetAlign() LabelAlign {
 }
 
 /*
-        Returns the amount of lines of text the Label has.
-	Args: [], Returns: int
+	        Returns the amount of lines of text the Label has.
+		Args: [], Returns: int
 */
 func (o *Label) GetLineCount() gdnative.Int {
 	//log.Println("Calling Label.GetLineCount()")
@@ -88,7 +185,7 @@ func (o *Label) GetLineCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_line_count")
+	methodBind := methodBindLabelGetLineCount.Get()
 
 	// Call the parent method.
 	// int
@@ -101,8 +198,8 @@ func (o *Label) GetLineCount() gdnative.Int {
 }
 
 /*
-        Returns the font size in pixels.
-	Args: [], Returns: int
+	        Returns the font size in pixels.
+		Args: [], Returns: int
 */
 func (o *Label) GetLineHeight() gdnative.Int {
 	//log.Println("Calling Label.GetLineHeight()")
@@ -111,7 +208,7 @@ func (o *Label) GetLineHeight() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_line_height")
+	methodBind := methodBindLabelGetLineHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -124,8 +221,8 @@ func (o *Label) GetLineHeight() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Label) GetLinesSkipped() gdnative.Int {
 	//log.Println("Calling Label.GetLinesSkipped()")
@@ -134,7 +231,7 @@ func (o *Label) GetLinesSkipped() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_lines_skipped")
+	methodBind := methodBindLabelGetLinesSkipped.Get()
 
 	// Call the parent method.
 	// int
@@ -147,8 +244,8 @@ func (o *Label) GetLinesSkipped() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Label) GetMaxLinesVisible() gdnative.Int {
 	//log.Println("Calling Label.GetMaxLinesVisible()")
@@ -157,7 +254,7 @@ func (o *Label) GetMaxLinesVisible() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_max_lines_visible")
+	methodBind := methodBindLabelGetMaxLinesVisible.Get()
 
 	// Call the parent method.
 	// int
@@ -170,8 +267,8 @@ func (o *Label) GetMaxLinesVisible() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Label) GetPercentVisible() gdnative.Real {
 	//log.Println("Calling Label.GetPercentVisible()")
@@ -180,7 +277,7 @@ func (o *Label) GetPercentVisible() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_percent_visible")
+	methodBind := methodBindLabelGetPercentVisible.Get()
 
 	// Call the parent method.
 	// float
@@ -193,8 +290,8 @@ func (o *Label) GetPercentVisible() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Label) GetText() gdnative.String {
 	//log.Println("Calling Label.GetText()")
@@ -203,7 +300,7 @@ func (o *Label) GetText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_text")
+	methodBind := methodBindLabelGetText.Get()
 
 	// Call the parent method.
 	// String
@@ -216,8 +313,8 @@ func (o *Label) GetText() gdnative.String {
 }
 
 /*
-        Returns the total length of the text.
-	Args: [], Returns: int
+	        Returns the total length of the text.
+		Args: [], Returns: int
 */
 func (o *Label) GetTotalCharacterCount() gdnative.Int {
 	//log.Println("Calling Label.GetTotalCharacterCount()")
@@ -226,7 +323,7 @@ func (o *Label) GetTotalCharacterCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_total_character_count")
+	methodBind := methodBindLabelGetTotalCharacterCount.Get()
 
 	// Call the parent method.
 	// int
@@ -239,8 +336,8 @@ func (o *Label) GetTotalCharacterCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Label::VAlign
+	        Undocumented
+		Args: [], Returns: enum.Label::VAlign
 */
 func (o *Label) GetValign() LabelVAlign {
 	//log.Println("Calling Label.GetValign()")
@@ -249,7 +346,7 @@ func (o *Label) GetValign() LabelVAlign {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_valign")
+	methodBind := methodBindLabelGetValign.Get()
 
 	// Call the parent method.
 	// enum.Label::VAlign
@@ -262,8 +359,8 @@ func (o *Label) GetValign() LabelVAlign {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Label) GetVisibleCharacters() gdnative.Int {
 	//log.Println("Calling Label.GetVisibleCharacters()")
@@ -272,7 +369,7 @@ func (o *Label) GetVisibleCharacters() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_visible_characters")
+	methodBind := methodBindLabelGetVisibleCharacters.Get()
 
 	// Call the parent method.
 	// int
@@ -285,8 +382,8 @@ func (o *Label) GetVisibleCharacters() gdnative.Int {
 }
 
 /*
-        Returns the number of lines shown. Useful if the [code]Label[/code] 's height cannot currently display all lines.
-	Args: [], Returns: int
+	        Returns the number of lines shown. Useful if the [code]Label[/code] 's height cannot currently display all lines.
+		Args: [], Returns: int
 */
 func (o *Label) GetVisibleLineCount() gdnative.Int {
 	//log.Println("Calling Label.GetVisibleLineCount()")
@@ -295,7 +392,7 @@ func (o *Label) GetVisibleLineCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "get_visible_line_count")
+	methodBind := methodBindLabelGetVisibleLineCount.Get()
 
 	// Call the parent method.
 	// int
@@ -308,8 +405,8 @@ func (o *Label) GetVisibleLineCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Label) HasAutowrap() gdnative.Bool {
 	//log.Println("Calling Label.HasAutowrap()")
@@ -318,7 +415,7 @@ func (o *Label) HasAutowrap() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "has_autowrap")
+	methodBind := methodBindLabelHasAutowrap.Get()
 
 	// Call the parent method.
 	// bool
@@ -331,8 +428,8 @@ func (o *Label) HasAutowrap() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Label) IsClippingText() gdnative.Bool {
 	//log.Println("Calling Label.IsClippingText()")
@@ -341,7 +438,7 @@ func (o *Label) IsClippingText() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "is_clipping_text")
+	methodBind := methodBindLabelIsClippingText.Get()
 
 	// Call the parent method.
 	// bool
@@ -354,8 +451,8 @@ func (o *Label) IsClippingText() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Label) IsUppercase() gdnative.Bool {
 	//log.Println("Calling Label.IsUppercase()")
@@ -364,7 +461,7 @@ func (o *Label) IsUppercase() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "is_uppercase")
+	methodBind := methodBindLabelIsUppercase.Get()
 
 	// Call the parent method.
 	// bool
@@ -377,18 +474,18 @@ func (o *Label) IsUppercase() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false align int}], Returns: void
+	        Undocumented
+		Args: [{ false align enum.Label::Align}], Returns: void
 */
-func (o *Label) SetAlign(align gdnative.Int) {
+func (o *Label) SetAlign(align LabelAlign) {
 	//log.Println("Calling Label.SetAlign()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(align)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(align))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_align")
+	methodBind := methodBindLabelSetAlign.Get()
 
 	// Call the parent method.
 	// void
@@ -398,8 +495,8 @@ func (o *Label) SetAlign(align gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Label) SetAutowrap(enable gdnative.Bool) {
 	//log.Println("Calling Label.SetAutowrap()")
@@ -409,7 +506,7 @@ func (o *Label) SetAutowrap(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_autowrap")
+	methodBind := methodBindLabelSetAutowrap.Get()
 
 	// Call the parent method.
 	// void
@@ -419,8 +516,8 @@ func (o *Label) SetAutowrap(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Label) SetClipText(enable gdnative.Bool) {
 	//log.Println("Calling Label.SetClipText()")
@@ -430,7 +527,7 @@ func (o *Label) SetClipText(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_clip_text")
+	methodBind := methodBindLabelSetClipText.Get()
 
 	// Call the parent method.
 	// void
@@ -440,8 +537,8 @@ func (o *Label) SetClipText(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false lines_skipped int}], Returns: void
+	        Undocumented
+		Args: [{ false lines_skipped int}], Returns: void
 */
 func (o *Label) SetLinesSkipped(linesSkipped gdnative.Int) {
 	//log.Println("Calling Label.SetLinesSkipped()")
@@ -451,7 +548,7 @@ func (o *Label) SetLinesSkipped(linesSkipped gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(linesSkipped)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_lines_skipped")
+	methodBind := methodBindLabelSetLinesSkipped.Get()
 
 	// Call the parent method.
 	// void
@@ -461,8 +558,8 @@ func (o *Label) SetLinesSkipped(linesSkipped gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false lines_visible int}], Returns: void
+	        Undocumented
+		Args: [{ false lines_visible int}], Returns: void
 */
 func (o *Label) SetMaxLinesVisible(linesVisible gdnative.Int) {
 	//log.Println("Calling Label.SetMaxLinesVisible()")
@@ -472,7 +569,7 @@ func (o *Label) SetMaxLinesVisible(linesVisible gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(linesVisible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_max_lines_visible")
+	methodBind := methodBindLabelSetMaxLinesVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -482,8 +579,8 @@ func (o *Label) SetMaxLinesVisible(linesVisible gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false percent_visible float}], Returns: void
+	        Undocumented
+		Args: [{ false percent_visible float}], Returns: void
 */
 func (o *Label) SetPercentVisible(percentVisible gdnative.Real) {
 	//log.Println("Calling Label.SetPercentVisible()")
@@ -493,7 +590,7 @@ func (o *Label) SetPercentVisible(percentVisible gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(percentVisible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_percent_visible")
+	methodBind := methodBindLabelSetPercentVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -503,8 +600,8 @@ func (o *Label) SetPercentVisible(percentVisible gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *Label) SetText(text gdnative.String) {
 	//log.Println("Calling Label.SetText()")
@@ -514,7 +611,7 @@ func (o *Label) SetText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_text")
+	methodBind := methodBindLabelSetText.Get()
 
 	// Call the parent method.
 	// void
@@ -524,8 +621,8 @@ func (o *Label) SetText(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Label) SetUppercase(enable gdnative.Bool) {
 	//log.Println("Calling Label.SetUppercase()")
@@ -535,7 +632,7 @@ func (o *Label) SetUppercase(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_uppercase")
+	methodBind := methodBindLabelSetUppercase.Get()
 
 	// Call the parent method.
 	// void
@@ -545,18 +642,18 @@ func (o *Label) SetUppercase(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false valign int}], Returns: void
+	        Undocumented
+		Args: [{ false valign enum.Label::VAlign}], Returns: void
 */
-func (o *Label) SetValign(valign gdnative.Int) {
+func (o *Label) SetValign(valign LabelVAlign) {
 	//log.Println("Calling Label.SetValign()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(valign)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(valign))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_valign")
+	methodBind := methodBindLabelSetValign.Get()
 
 	// Call the parent method.
 	// void
@@ -566,8 +663,8 @@ func (o *Label) SetValign(valign gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *Label) SetVisibleCharacters(amount gdnative.Int) {
 	//log.Println("Calling Label.SetVisibleCharacters()")
@@ -577,7 +674,7 @@ func (o *Label) SetVisibleCharacters(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Label", "set_visible_characters")
+	methodBind := methodBindLabelSetVisibleCharacters.Get()
 
 	// Call the parent method.
 	// void
@@ -586,10 +683,61 @@ func (o *Label) SetVisibleCharacters(amount gdnative.Int) {
 
 }
 
+// Align will return the value of the "align" property.
+func (o *Label) Align() LabelAlign {
+	return o.GetAlign()
+}
+
+// Autowrap will return the value of the "autowrap" property.
+func (o *Label) Autowrap() gdnative.Bool {
+	return o.HasAutowrap()
+}
+
+// ClipText will return the value of the "clip_text" property.
+func (o *Label) ClipText() gdnative.Bool {
+	return o.IsClippingText()
+}
+
+// LinesSkipped will return the value of the "lines_skipped" property.
+func (o *Label) LinesSkipped() gdnative.Int {
+	return o.GetLinesSkipped()
+}
+
+// MaxLinesVisible will return the value of the "max_lines_visible" property.
+func (o *Label) MaxLinesVisible() gdnative.Int {
+	return o.GetMaxLinesVisible()
+}
+
+// PercentVisible will return the value of the "percent_visible" property.
+func (o *Label) PercentVisible() gdnative.Real {
+	return o.GetPercentVisible()
+}
+
+// Text will return the value of the "text" property.
+func (o *Label) Text() gdnative.String {
+	return o.GetText()
+}
+
+// Uppercase will return the value of the "uppercase" property.
+func (o *Label) Uppercase() gdnative.Bool {
+	return o.IsUppercase()
+}
+
+// Valign will return the value of the "valign" property.
+func (o *Label) Valign() LabelVAlign {
+	return o.GetValign()
+}
+
+// VisibleCharacters will return the value of the "visible_characters" property.
+func (o *Label) VisibleCharacters() gdnative.Int {
+	return o.GetVisibleCharacters()
+}
+
 // LabelImplementer is an interface that implements the methods
 // of the Label class.
 type LabelImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
+	GetAlign() LabelAlign
 	GetLineCount() gdnative.Int
 	GetLineHeight() gdnative.Int
 	GetLinesSkipped() gdnative.Int
@@ -597,12 +745,13 @@ type LabelImplementer interface {
 	GetPercentVisible() gdnative.Real
 	GetText() gdnative.String
 	GetTotalCharacterCount() gdnative.Int
+	GetValign() LabelVAlign
 	GetVisibleCharacters() gdnative.Int
 	GetVisibleLineCount() gdnative.Int
 	HasAutowrap() gdnative.Bool
 	IsClippingText() gdnative.Bool
 	IsUppercase() gdnative.Bool
-	SetAlign(align gdnative.Int)
+	SetAlign(align LabelAlign)
 	SetAutowrap(enable gdnative.Bool)
 	SetClipText(enable gdnative.Bool)
 	SetLinesSkipped(linesSkipped gdnative.Int)
@@ -610,6 +759,16 @@ type LabelImplementer interface {
 	SetPercentVisible(percentVisible gdnative.Real)
 	SetText(text gdnative.String)
 	SetUppercase(enable gdnative.Bool)
-	SetValign(valign gdnative.Int)
+	SetValign(valign LabelVAlign)
 	SetVisibleCharacters(amount gdnative.Int)
+	Align() LabelAlign
+	Autowrap() gdnative.Bool
+	ClipText() gdnative.Bool
+	LinesSkipped() gdnative.Int
+	MaxLinesVisible() gdnative.Int
+	PercentVisible() gdnative.Real
+	Text() gdnative.String
+	Uppercase() gdnative.Bool
+	Valign() LabelVAlign
+	VisibleCharacters() gdnative.Int
 }
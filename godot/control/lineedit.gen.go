@@ -1,7 +1,11 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/popup"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,6 +27,36 @@ const (
 	LineEditAlignRight  LineEditAlign = 2
 )
 
+// String will return the Godot name of the LineEditAlign value.
+func (e LineEditAlign) String() string {
+	switch e {
+	case LineEditAlignCenter:
+		return "ALIGN_CENTER"
+	case LineEditAlignFill:
+		return "ALIGN_FILL"
+	case LineEditAlignLeft:
+		return "ALIGN_LEFT"
+	case LineEditAlignRight:
+		return "ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("LineEditAlign(%d)", int(e))
+}
+
+// ParseLineEditAlign will return the LineEditAlign value with the given Godot name.
+func ParseLineEditAlign(name string) (LineEditAlign, error) {
+	switch name {
+	case "ALIGN_CENTER":
+		return LineEditAlignCenter, nil
+	case "ALIGN_FILL":
+		return LineEditAlignFill, nil
+	case "ALIGN_LEFT":
+		return LineEditAlignLeft, nil
+	case "ALIGN_RIGHT":
+		return LineEditAlignRight, nil
+	}
+	return 0, fmt.Errorf("invalid LineEditAlign value %q", name)
+}
+
 // LineEditMenuItems is an enum for MenuItems values.
 type LineEditMenuItems int
 
@@ -37,30 +71,121 @@ const (
 	LineEditMenuUndo      LineEditMenuItems = 5
 )
 
-//func NewLineEditFromPointer(ptr gdnative.Pointer) LineEdit {
-func newLineEditFromPointer(ptr gdnative.Pointer) LineEdit {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := LineEdit{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the LineEditMenuItems value.
+func (e LineEditMenuItems) String() string {
+	switch e {
+	case LineEditMenuClear:
+		return "MENU_CLEAR"
+	case LineEditMenuCopy:
+		return "MENU_COPY"
+	case LineEditMenuCut:
+		return "MENU_CUT"
+	case LineEditMenuMax:
+		return "MENU_MAX"
+	case LineEditMenuPaste:
+		return "MENU_PASTE"
+	case LineEditMenuRedo:
+		return "MENU_REDO"
+	case LineEditMenuSelectAll:
+		return "MENU_SELECT_ALL"
+	case LineEditMenuUndo:
+		return "MENU_UNDO"
+	}
+	return fmt.Sprintf("LineEditMenuItems(%d)", int(e))
+}
 
-	return obj
+// ParseLineEditMenuItems will return the LineEditMenuItems value with the given Godot name.
+func ParseLineEditMenuItems(name string) (LineEditMenuItems, error) {
+	switch name {
+	case "MENU_CLEAR":
+		return LineEditMenuClear, nil
+	case "MENU_COPY":
+		return LineEditMenuCopy, nil
+	case "MENU_CUT":
+		return LineEditMenuCut, nil
+	case "MENU_MAX":
+		return LineEditMenuMax, nil
+	case "MENU_PASTE":
+		return LineEditMenuPaste, nil
+	case "MENU_REDO":
+		return LineEditMenuRedo, nil
+	case "MENU_SELECT_ALL":
+		return LineEditMenuSelectAll, nil
+	case "MENU_UNDO":
+		return LineEditMenuUndo, nil
+	}
+	return 0, fmt.Errorf("invalid LineEditMenuItems value %q", name)
 }
 
 /*
 LineEdit provides a single line string editor, used for text fields.
 */
 type LineEdit struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorLineEdit is the class constructor of the LineEdit class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLineEdit = gdnative.NewLazyClassConstructor("LineEdit")
+
+// NewLineEdit will create a new instance of the LineEdit class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewLineEdit() *LineEdit {
+	obj := &LineEdit{}
+	obj.SetBaseObject(classConstructorLineEdit.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *LineEdit) BaseClass() string {
 	return "LineEdit"
 }
 
+// Method binds of the LineEdit class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLineEditX_EditorSettingsChanged = gdnative.NewLazyMethodBind("LineEdit", "_editor_settings_changed")
+	methodBindLineEditX_GuiInput              = gdnative.NewLazyMethodBind("LineEdit", "_gui_input")
+	methodBindLineEditX_TextChanged           = gdnative.NewLazyMethodBind("LineEdit", "_text_changed")
+	methodBindLineEditX_ToggleDrawCaret       = gdnative.NewLazyMethodBind("LineEdit", "_toggle_draw_caret")
+	methodBindLineEditAppendAtCursor          = gdnative.NewLazyMethodBind("LineEdit", "append_at_cursor")
+	methodBindLineEditClear                   = gdnative.NewLazyMethodBind("LineEdit", "clear")
+	methodBindLineEditCursorGetBlinkEnabled   = gdnative.NewLazyMethodBind("LineEdit", "cursor_get_blink_enabled")
+	methodBindLineEditCursorGetBlinkSpeed     = gdnative.NewLazyMethodBind("LineEdit", "cursor_get_blink_speed")
+	methodBindLineEditCursorSetBlinkEnabled   = gdnative.NewLazyMethodBind("LineEdit", "cursor_set_blink_enabled")
+	methodBindLineEditCursorSetBlinkSpeed     = gdnative.NewLazyMethodBind("LineEdit", "cursor_set_blink_speed")
+	methodBindLineEditDeselect                = gdnative.NewLazyMethodBind("LineEdit", "deselect")
+	methodBindLineEditGetAlign                = gdnative.NewLazyMethodBind("LineEdit", "get_align")
+	methodBindLineEditGetCursorPosition       = gdnative.NewLazyMethodBind("LineEdit", "get_cursor_position")
+	methodBindLineEditGetExpandToTextLength   = gdnative.NewLazyMethodBind("LineEdit", "get_expand_to_text_length")
+	methodBindLineEditGetMaxLength            = gdnative.NewLazyMethodBind("LineEdit", "get_max_length")
+	methodBindLineEditGetMenu                 = gdnative.NewLazyMethodBind("LineEdit", "get_menu")
+	methodBindLineEditGetPlaceholder          = gdnative.NewLazyMethodBind("LineEdit", "get_placeholder")
+	methodBindLineEditGetPlaceholderAlpha     = gdnative.NewLazyMethodBind("LineEdit", "get_placeholder_alpha")
+	methodBindLineEditGetText                 = gdnative.NewLazyMethodBind("LineEdit", "get_text")
+	methodBindLineEditIsContextMenuEnabled    = gdnative.NewLazyMethodBind("LineEdit", "is_context_menu_enabled")
+	methodBindLineEditIsEditable              = gdnative.NewLazyMethodBind("LineEdit", "is_editable")
+	methodBindLineEditIsSecret                = gdnative.NewLazyMethodBind("LineEdit", "is_secret")
+	methodBindLineEditMenuOption              = gdnative.NewLazyMethodBind("LineEdit", "menu_option")
+	methodBindLineEditSelect                  = gdnative.NewLazyMethodBind("LineEdit", "select")
+	methodBindLineEditSelectAll               = gdnative.NewLazyMethodBind("LineEdit", "select_all")
+	methodBindLineEditSetAlign                = gdnative.NewLazyMethodBind("LineEdit", "set_align")
+	methodBindLineEditSetContextMenuEnabled   = gdnative.NewLazyMethodBind("LineEdit", "set_context_menu_enabled")
+	methodBindLineEditSetCursorPosition       = gdnative.NewLazyMethodBind("LineEdit", "set_cursor_position")
+	methodBindLineEditSetEditable             = gdnative.NewLazyMethodBind("LineEdit", "set_editable")
+	methodBindLineEditSetExpandToTextLength   = gdnative.NewLazyMethodBind("LineEdit", "set_expand_to_text_length")
+	methodBindLineEditSetMaxLength            = gdnative.NewLazyMethodBind("LineEdit", "set_max_length")
+	methodBindLineEditSetPlaceholder          = gdnative.NewLazyMethodBind("LineEdit", "set_placeholder")
+	methodBindLineEditSetPlaceholderAlpha     = gdnative.NewLazyMethodBind("LineEdit", "set_placeholder_alpha")
+	methodBindLineEditSetSecret               = gdnative.NewLazyMethodBind("LineEdit", "set_secret")
+	methodBindLineEditSetText                 = gdnative.NewLazyMethodBind("LineEdit", "set_text")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *LineEdit) X_EditorSettingsChanged() {
 	//log.Println("Calling LineEdit.X_EditorSettingsChanged()")
@@ -69,7 +194,7 @@ func (o *LineEdit) X_EditorSettingsChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "_editor_settings_changed")
+	methodBind := methodBindLineEditX_EditorSettingsChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -79,18 +204,18 @@ func (o *LineEdit) X_EditorSettingsChanged() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *LineEdit) X_GuiInput(arg0 InputEventImplementer) {
+func (o *LineEdit) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling LineEdit.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "_gui_input")
+	methodBind := methodBindLineEditX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +225,8 @@ func (o *LineEdit) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *LineEdit) X_TextChanged() {
 	//log.Println("Calling LineEdit.X_TextChanged()")
@@ -110,7 +235,7 @@ func (o *LineEdit) X_TextChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "_text_changed")
+	methodBind := methodBindLineEditX_TextChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -120,8 +245,8 @@ func (o *LineEdit) X_TextChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *LineEdit) X_ToggleDrawCaret() {
 	//log.Println("Calling LineEdit.X_ToggleDrawCaret()")
@@ -130,7 +255,7 @@ func (o *LineEdit) X_ToggleDrawCaret() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "_toggle_draw_caret")
+	methodBind := methodBindLineEditX_ToggleDrawCaret.Get()
 
 	// Call the parent method.
 	// void
@@ -140,8 +265,8 @@ func (o *LineEdit) X_ToggleDrawCaret() {
 }
 
 /*
-        Adds [code]text[/code] after the cursor. If the resulting value is longer than [member max_length], nothing happens.
-	Args: [{ false text String}], Returns: void
+	        Adds [code]text[/code] after the cursor. If the resulting value is longer than [member max_length], nothing happens.
+		Args: [{ false text String}], Returns: void
 */
 func (o *LineEdit) AppendAtCursor(text gdnative.String) {
 	//log.Println("Calling LineEdit.AppendAtCursor()")
@@ -151,7 +276,7 @@ func (o *LineEdit) AppendAtCursor(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "append_at_cursor")
+	methodBind := methodBindLineEditAppendAtCursor.Get()
 
 	// Call the parent method.
 	// void
@@ -161,8 +286,8 @@ func (o *LineEdit) AppendAtCursor(text gdnative.String) {
 }
 
 /*
-        Erases the [LineEdit] text.
-	Args: [], Returns: void
+	        Erases the [LineEdit] text.
+		Args: [], Returns: void
 */
 func (o *LineEdit) Clear() {
 	//log.Println("Calling LineEdit.Clear()")
@@ -171,7 +296,7 @@ func (o *LineEdit) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "clear")
+	methodBind := methodBindLineEditClear.Get()
 
 	// Call the parent method.
 	// void
@@ -181,8 +306,8 @@ func (o *LineEdit) Clear() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *LineEdit) CursorGetBlinkEnabled() gdnative.Bool {
 	//log.Println("Calling LineEdit.CursorGetBlinkEnabled()")
@@ -191,7 +316,7 @@ func (o *LineEdit) CursorGetBlinkEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "cursor_get_blink_enabled")
+	methodBind := methodBindLineEditCursorGetBlinkEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -204,8 +329,8 @@ func (o *LineEdit) CursorGetBlinkEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *LineEdit) CursorGetBlinkSpeed() gdnative.Real {
 	//log.Println("Calling LineEdit.CursorGetBlinkSpeed()")
@@ -214,7 +339,7 @@ func (o *LineEdit) CursorGetBlinkSpeed() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "cursor_get_blink_speed")
+	methodBind := methodBindLineEditCursorGetBlinkSpeed.Get()
 
 	// Call the parent method.
 	// float
@@ -227,8 +352,8 @@ func (o *LineEdit) CursorGetBlinkSpeed() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *LineEdit) CursorSetBlinkEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling LineEdit.CursorSetBlinkEnabled()")
@@ -238,7 +363,7 @@ func (o *LineEdit) CursorSetBlinkEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "cursor_set_blink_enabled")
+	methodBind := methodBindLineEditCursorSetBlinkEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -248,8 +373,8 @@ func (o *LineEdit) CursorSetBlinkEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false blink_speed float}], Returns: void
+	        Undocumented
+		Args: [{ false blink_speed float}], Returns: void
 */
 func (o *LineEdit) CursorSetBlinkSpeed(blinkSpeed gdnative.Real) {
 	//log.Println("Calling LineEdit.CursorSetBlinkSpeed()")
@@ -259,7 +384,7 @@ func (o *LineEdit) CursorSetBlinkSpeed(blinkSpeed gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(blinkSpeed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "cursor_set_blink_speed")
+	methodBind := methodBindLineEditCursorSetBlinkSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -269,8 +394,8 @@ func (o *LineEdit) CursorSetBlinkSpeed(blinkSpeed gdnative.Real) {
 }
 
 /*
-        Clears the current selection.
-	Args: [], Returns: void
+	        Clears the current selection.
+		Args: [], Returns: void
 */
 func (o *LineEdit) Deselect() {
 	//log.Println("Calling LineEdit.Deselect()")
@@ -279,7 +404,7 @@ func (o *LineEdit) Deselect() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "deselect")
+	methodBind := methodBindLineEditDeselect.Get()
 
 	// Call the parent method.
 	// void
@@ -289,8 +414,8 @@ func (o *LineEdit) Deselect() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.LineEdit::Align
+	        Undocumented
+		Args: [], Returns: enum.LineEdit::Align
 */
 func (o *LineEdit) GetAlign() LineEditAlign {
 	//log.Println("Calling LineEdit.GetAlign()")
@@ -299,7 +424,7 @@ func (o *LineEdit) GetAlign() LineEditAlign {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_align")
+	methodBind := methodBindLineEditGetAlign.Get()
 
 	// Call the parent method.
 	// enum.LineEdit::Align
@@ -312,8 +437,8 @@ func (o *LineEdit) GetAlign() LineEditAlign {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *LineEdit) GetCursorPosition() gdnative.Int {
 	//log.Println("Calling LineEdit.GetCursorPosition()")
@@ -322,7 +447,7 @@ func (o *LineEdit) GetCursorPosition() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_cursor_position")
+	methodBind := methodBindLineEditGetCursorPosition.Get()
 
 	// Call the parent method.
 	// int
@@ -335,8 +460,8 @@ func (o *LineEdit) GetCursorPosition() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *LineEdit) GetExpandToTextLength() gdnative.Bool {
 	//log.Println("Calling LineEdit.GetExpandToTextLength()")
@@ -345,7 +470,7 @@ func (o *LineEdit) GetExpandToTextLength() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_expand_to_text_length")
+	methodBind := methodBindLineEditGetExpandToTextLength.Get()
 
 	// Call the parent method.
 	// bool
@@ -358,8 +483,8 @@ func (o *LineEdit) GetExpandToTextLength() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *LineEdit) GetMaxLength() gdnative.Int {
 	//log.Println("Calling LineEdit.GetMaxLength()")
@@ -368,7 +493,7 @@ func (o *LineEdit) GetMaxLength() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_max_length")
+	methodBind := methodBindLineEditGetMaxLength.Get()
 
 	// Call the parent method.
 	// int
@@ -381,17 +506,17 @@ func (o *LineEdit) GetMaxLength() gdnative.Int {
 }
 
 /*
-        Returns the [PopupMenu] of this [code]LineEdit[/code]. By default, this menu is displayed when right-clicking on the [LineEdit].
-	Args: [], Returns: PopupMenu
+	        Returns the [PopupMenu] of this [code]LineEdit[/code]. By default, this menu is displayed when right-clicking on the [LineEdit].
+		Args: [], Returns: PopupMenu
 */
-func (o *LineEdit) GetMenu() PopupMenuImplementer {
+func (o *LineEdit) GetMenu() popup.PopupMenuImplementer {
 	//log.Println("Calling LineEdit.GetMenu()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_menu")
+	methodBind := methodBindLineEditGetMenu.Get()
 
 	// Call the parent method.
 	// PopupMenu
@@ -399,27 +524,25 @@ func (o *LineEdit) GetMenu() PopupMenuImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPopupMenuFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PopupMenuImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PopupMenu" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PopupMenuImplementer)
+	ret := popup.PopupMenu{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(popup.PopupMenuImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *LineEdit) GetPlaceholder() gdnative.String {
 	//log.Println("Calling LineEdit.GetPlaceholder()")
@@ -428,7 +551,7 @@ func (o *LineEdit) GetPlaceholder() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_placeholder")
+	methodBind := methodBindLineEditGetPlaceholder.Get()
 
 	// Call the parent method.
 	// String
@@ -441,8 +564,8 @@ func (o *LineEdit) GetPlaceholder() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *LineEdit) GetPlaceholderAlpha() gdnative.Real {
 	//log.Println("Calling LineEdit.GetPlaceholderAlpha()")
@@ -451,7 +574,7 @@ func (o *LineEdit) GetPlaceholderAlpha() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_placeholder_alpha")
+	methodBind := methodBindLineEditGetPlaceholderAlpha.Get()
 
 	// Call the parent method.
 	// float
@@ -464,8 +587,8 @@ func (o *LineEdit) GetPlaceholderAlpha() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *LineEdit) GetText() gdnative.String {
 	//log.Println("Calling LineEdit.GetText()")
@@ -474,7 +597,7 @@ func (o *LineEdit) GetText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "get_text")
+	methodBind := methodBindLineEditGetText.Get()
 
 	// Call the parent method.
 	// String
@@ -487,8 +610,8 @@ func (o *LineEdit) GetText() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *LineEdit) IsContextMenuEnabled() gdnative.Bool {
 	//log.Println("Calling LineEdit.IsContextMenuEnabled()")
@@ -497,7 +620,7 @@ func (o *LineEdit) IsContextMenuEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "is_context_menu_enabled")
+	methodBind := methodBindLineEditIsContextMenuEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -510,8 +633,8 @@ func (o *LineEdit) IsContextMenuEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *LineEdit) IsEditable() gdnative.Bool {
 	//log.Println("Calling LineEdit.IsEditable()")
@@ -520,7 +643,7 @@ func (o *LineEdit) IsEditable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "is_editable")
+	methodBind := methodBindLineEditIsEditable.Get()
 
 	// Call the parent method.
 	// bool
@@ -533,8 +656,8 @@ func (o *LineEdit) IsEditable() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *LineEdit) IsSecret() gdnative.Bool {
 	//log.Println("Calling LineEdit.IsSecret()")
@@ -543,7 +666,7 @@ func (o *LineEdit) IsSecret() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "is_secret")
+	methodBind := methodBindLineEditIsSecret.Get()
 
 	// Call the parent method.
 	// bool
@@ -556,8 +679,8 @@ func (o *LineEdit) IsSecret() gdnative.Bool {
 }
 
 /*
-        Executes a given action as defined in the MENU_* enum.
-	Args: [{ false option int}], Returns: void
+	        Executes a given action as defined in the MENU_* enum.
+		Args: [{ false option int}], Returns: void
 */
 func (o *LineEdit) MenuOption(option gdnative.Int) {
 	//log.Println("Calling LineEdit.MenuOption()")
@@ -567,7 +690,7 @@ func (o *LineEdit) MenuOption(option gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(option)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "menu_option")
+	methodBind := methodBindLineEditMenuOption.Get()
 
 	// Call the parent method.
 	// void
@@ -577,8 +700,8 @@ func (o *LineEdit) MenuOption(option gdnative.Int) {
 }
 
 /*
-        Selects characters inside [LineEdit] between [code]from[/code] and [code]to[/code]. By default [code]from[/code] is at the beginning and [code]to[/code] at the end. [codeblock] text = "Welcome" select() # Welcome select(4) # ome select(2, 5) # lco [/codeblock]
-	Args: [{0 true from int} {-1 true to int}], Returns: void
+	        Selects characters inside [LineEdit] between [code]from[/code] and [code]to[/code]. By default [code]from[/code] is at the beginning and [code]to[/code] at the end. [codeblock] text = "Welcome" select() # Welcome select(4) # ome select(2, 5) # lco [/codeblock]
+		Args: [{0 true from int} {-1 true to int}], Returns: void
 */
 func (o *LineEdit) Select(from gdnative.Int, to gdnative.Int) {
 	//log.Println("Calling LineEdit.Select()")
@@ -589,7 +712,7 @@ func (o *LineEdit) Select(from gdnative.Int, to gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(to)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "select")
+	methodBind := methodBindLineEditSelect.Get()
 
 	// Call the parent method.
 	// void
@@ -599,8 +722,8 @@ func (o *LineEdit) Select(from gdnative.Int, to gdnative.Int) {
 }
 
 /*
-        Selects the whole [String].
-	Args: [], Returns: void
+	        Selects the whole [String].
+		Args: [], Returns: void
 */
 func (o *LineEdit) SelectAll() {
 	//log.Println("Calling LineEdit.SelectAll()")
@@ -609,7 +732,7 @@ func (o *LineEdit) SelectAll() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "select_all")
+	methodBind := methodBindLineEditSelectAll.Get()
 
 	// Call the parent method.
 	// void
@@ -619,18 +742,18 @@ func (o *LineEdit) SelectAll() {
 }
 
 /*
-        Undocumented
-	Args: [{ false align int}], Returns: void
+	        Undocumented
+		Args: [{ false align enum.LineEdit::Align}], Returns: void
 */
-func (o *LineEdit) SetAlign(align gdnative.Int) {
+func (o *LineEdit) SetAlign(align LineEditAlign) {
 	//log.Println("Calling LineEdit.SetAlign()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(align)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(align))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_align")
+	methodBind := methodBindLineEditSetAlign.Get()
 
 	// Call the parent method.
 	// void
@@ -640,8 +763,8 @@ func (o *LineEdit) SetAlign(align gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *LineEdit) SetContextMenuEnabled(enable gdnative.Bool) {
 	//log.Println("Calling LineEdit.SetContextMenuEnabled()")
@@ -651,7 +774,7 @@ func (o *LineEdit) SetContextMenuEnabled(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_context_menu_enabled")
+	methodBind := methodBindLineEditSetContextMenuEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -661,8 +784,8 @@ func (o *LineEdit) SetContextMenuEnabled(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position int}], Returns: void
+	        Undocumented
+		Args: [{ false position int}], Returns: void
 */
 func (o *LineEdit) SetCursorPosition(position gdnative.Int) {
 	//log.Println("Calling LineEdit.SetCursorPosition()")
@@ -672,7 +795,7 @@ func (o *LineEdit) SetCursorPosition(position gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_cursor_position")
+	methodBind := methodBindLineEditSetCursorPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -682,8 +805,8 @@ func (o *LineEdit) SetCursorPosition(position gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *LineEdit) SetEditable(enabled gdnative.Bool) {
 	//log.Println("Calling LineEdit.SetEditable()")
@@ -693,7 +816,7 @@ func (o *LineEdit) SetEditable(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_editable")
+	methodBind := methodBindLineEditSetEditable.Get()
 
 	// Call the parent method.
 	// void
@@ -703,8 +826,8 @@ func (o *LineEdit) SetEditable(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *LineEdit) SetExpandToTextLength(enabled gdnative.Bool) {
 	//log.Println("Calling LineEdit.SetExpandToTextLength()")
@@ -714,7 +837,7 @@ func (o *LineEdit) SetExpandToTextLength(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_expand_to_text_length")
+	methodBind := methodBindLineEditSetExpandToTextLength.Get()
 
 	// Call the parent method.
 	// void
@@ -724,8 +847,8 @@ func (o *LineEdit) SetExpandToTextLength(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false chars int}], Returns: void
+	        Undocumented
+		Args: [{ false chars int}], Returns: void
 */
 func (o *LineEdit) SetMaxLength(chars gdnative.Int) {
 	//log.Println("Calling LineEdit.SetMaxLength()")
@@ -735,7 +858,7 @@ func (o *LineEdit) SetMaxLength(chars gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(chars)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_max_length")
+	methodBind := methodBindLineEditSetMaxLength.Get()
 
 	// Call the parent method.
 	// void
@@ -745,8 +868,8 @@ func (o *LineEdit) SetMaxLength(chars gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *LineEdit) SetPlaceholder(text gdnative.String) {
 	//log.Println("Calling LineEdit.SetPlaceholder()")
@@ -756,7 +879,7 @@ func (o *LineEdit) SetPlaceholder(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_placeholder")
+	methodBind := methodBindLineEditSetPlaceholder.Get()
 
 	// Call the parent method.
 	// void
@@ -766,8 +889,8 @@ func (o *LineEdit) SetPlaceholder(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false alpha float}], Returns: void
+	        Undocumented
+		Args: [{ false alpha float}], Returns: void
 */
 func (o *LineEdit) SetPlaceholderAlpha(alpha gdnative.Real) {
 	//log.Println("Calling LineEdit.SetPlaceholderAlpha()")
@@ -777,7 +900,7 @@ func (o *LineEdit) SetPlaceholderAlpha(alpha gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(alpha)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_placeholder_alpha")
+	methodBind := methodBindLineEditSetPlaceholderAlpha.Get()
 
 	// Call the parent method.
 	// void
@@ -787,8 +910,8 @@ func (o *LineEdit) SetPlaceholderAlpha(alpha gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *LineEdit) SetSecret(enabled gdnative.Bool) {
 	//log.Println("Calling LineEdit.SetSecret()")
@@ -798,7 +921,7 @@ func (o *LineEdit) SetSecret(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_secret")
+	methodBind := methodBindLineEditSetSecret.Get()
 
 	// Call the parent method.
 	// void
@@ -808,8 +931,8 @@ func (o *LineEdit) SetSecret(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *LineEdit) SetText(text gdnative.String) {
 	//log.Println("Calling LineEdit.SetText()")
@@ -819,7 +942,7 @@ func (o *LineEdit) SetText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineEdit", "set_text")
+	methodBind := methodBindLineEditSetText.Get()
 
 	// Call the parent method.
 	// void
@@ -828,10 +951,147 @@ func (o *LineEdit) SetText(text gdnative.String) {
 
 }
 
+// SelectWithDefaults will call Select using the default values for: from, to.
+func (o *LineEdit) SelectWithDefaults() {
+	o.Select(0, -1)
+}
+
+// Align will return the value of the "align" property.
+func (o *LineEdit) Align() LineEditAlign {
+	return o.GetAlign()
+}
+
+// CaretBlink will return the value of the "caret_blink" property.
+func (o *LineEdit) CaretBlink() gdnative.Bool {
+	return o.CursorGetBlinkEnabled()
+}
+
+// SetCaretBlink will set the value of the "caret_blink" property.
+func (o *LineEdit) SetCaretBlink(value gdnative.Bool) {
+	o.CursorSetBlinkEnabled(value)
+}
+
+// CaretBlinkSpeed will return the value of the "caret_blink_speed" property.
+func (o *LineEdit) CaretBlinkSpeed() gdnative.Real {
+	return o.CursorGetBlinkSpeed()
+}
+
+// SetCaretBlinkSpeed will set the value of the "caret_blink_speed" property.
+func (o *LineEdit) SetCaretBlinkSpeed(value gdnative.Real) {
+	o.CursorSetBlinkSpeed(value)
+}
+
+// CaretPosition will return the value of the "caret_position" property.
+func (o *LineEdit) CaretPosition() gdnative.Int {
+	return o.GetCursorPosition()
+}
+
+// SetCaretPosition will set the value of the "caret_position" property.
+func (o *LineEdit) SetCaretPosition(value gdnative.Int) {
+	o.SetCursorPosition(value)
+}
+
+// ContextMenuEnabled will return the value of the "context_menu_enabled" property.
+func (o *LineEdit) ContextMenuEnabled() gdnative.Bool {
+	return o.IsContextMenuEnabled()
+}
+
+// Editable will return the value of the "editable" property.
+func (o *LineEdit) Editable() gdnative.Bool {
+	return o.IsEditable()
+}
+
+// ExpandToTextLength will return the value of the "expand_to_text_length" property.
+func (o *LineEdit) ExpandToTextLength() gdnative.Bool {
+	return o.GetExpandToTextLength()
+}
+
+// MaxLength will return the value of the "max_length" property.
+func (o *LineEdit) MaxLength() gdnative.Int {
+	return o.GetMaxLength()
+}
+
+// PlaceholderAlpha will return the value of the "placeholder_alpha" property.
+func (o *LineEdit) PlaceholderAlpha() gdnative.Real {
+	return o.GetPlaceholderAlpha()
+}
+
+// PlaceholderText will return the value of the "placeholder_text" property.
+func (o *LineEdit) PlaceholderText() gdnative.String {
+	return o.GetPlaceholder()
+}
+
+// SetPlaceholderText will set the value of the "placeholder_text" property.
+func (o *LineEdit) SetPlaceholderText(value gdnative.String) {
+	o.SetPlaceholder(value)
+}
+
+// Secret will return the value of the "secret" property.
+func (o *LineEdit) Secret() gdnative.Bool {
+	return o.IsSecret()
+}
+
+// Text will return the value of the "text" property.
+func (o *LineEdit) Text() gdnative.String {
+	return o.GetText()
+}
+
+// Signals of the LineEdit class.
+const (
+	LineEditSignalTextChanged gdnative.String = "text_changed"
+	LineEditSignalTextEntered gdnative.String = "text_entered"
+)
+
+// LineEditTextChangedArgs holds the arguments of the "text_changed" signal.
+type LineEditTextChangedArgs struct {
+	NewText gdnative.String
+}
+
+// ConnectTextChanged will connect the "text_changed" signal to the given method of the target object.
+func (o *LineEdit) ConnectTextChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(LineEditSignalTextChanged, target, method, binds, 0)
+}
+
+// EmitTextChanged will emit the "text_changed" signal.
+func (o *LineEdit) EmitTextChanged(args LineEditTextChangedArgs) error {
+	arg0 := gdnative.NewVariantString(args.NewText)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(LineEditSignalTextChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// LineEditTextEnteredArgs holds the arguments of the "text_entered" signal.
+type LineEditTextEnteredArgs struct {
+	NewText gdnative.String
+}
+
+// ConnectTextEntered will connect the "text_entered" signal to the given method of the target object.
+func (o *LineEdit) ConnectTextEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(LineEditSignalTextEntered, target, method, binds, 0)
+}
+
+// EmitTextEntered will emit the "text_entered" signal.
+func (o *LineEdit) EmitTextEntered(args LineEditTextEnteredArgs) error {
+	arg0 := gdnative.NewVariantString(args.NewText)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(LineEditSignalTextEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
 // LineEditImplementer is an interface that implements the methods
 // of the LineEdit class.
 type LineEditImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 	X_EditorSettingsChanged()
 	X_TextChanged()
 	X_ToggleDrawCaret()
@@ -842,10 +1102,11 @@ type LineEditImplementer interface {
 	CursorSetBlinkEnabled(enabled gdnative.Bool)
 	CursorSetBlinkSpeed(blinkSpeed gdnative.Real)
 	Deselect()
+	GetAlign() LineEditAlign
 	GetCursorPosition() gdnative.Int
 	GetExpandToTextLength() gdnative.Bool
 	GetMaxLength() gdnative.Int
-	GetMenu() PopupMenuImplementer
+	GetMenu() popup.PopupMenuImplementer
 	GetPlaceholder() gdnative.String
 	GetPlaceholderAlpha() gdnative.Real
 	GetText() gdnative.String
@@ -855,7 +1116,7 @@ type LineEditImplementer interface {
 	MenuOption(option gdnative.Int)
 	Select(from gdnative.Int, to gdnative.Int)
 	SelectAll()
-	SetAlign(align gdnative.Int)
+	SetAlign(align LineEditAlign)
 	SetContextMenuEnabled(enable gdnative.Bool)
 	SetCursorPosition(position gdnative.Int)
 	SetEditable(enabled gdnative.Bool)
@@ -865,4 +1126,25 @@ type LineEditImplementer interface {
 	SetPlaceholderAlpha(alpha gdnative.Real)
 	SetSecret(enabled gdnative.Bool)
 	SetText(text gdnative.String)
+	SelectWithDefaults()
+	Align() LineEditAlign
+	CaretBlink() gdnative.Bool
+	SetCaretBlink(value gdnative.Bool)
+	CaretBlinkSpeed() gdnative.Real
+	SetCaretBlinkSpeed(value gdnative.Real)
+	CaretPosition() gdnative.Int
+	SetCaretPosition(value gdnative.Int)
+	ContextMenuEnabled() gdnative.Bool
+	Editable() gdnative.Bool
+	ExpandToTextLength() gdnative.Bool
+	MaxLength() gdnative.Int
+	PlaceholderAlpha() gdnative.Real
+	PlaceholderText() gdnative.String
+	SetPlaceholderText(value gdnative.String)
+	Secret() gdnative.Bool
+	Text() gdnative.String
+	ConnectTextChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTextChanged(args LineEditTextChangedArgs) error
+	ConnectTextEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTextEntered(args LineEditTextEnteredArgs) error
 }
@@ -0,0 +1,203 @@
+package control
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// LinkButtonUnderlineMode is an enum for UnderlineMode values.
+type LinkButtonUnderlineMode int
+
+const (
+	LinkButtonUnderlineModeAlways  LinkButtonUnderlineMode = 0
+	LinkButtonUnderlineModeNever   LinkButtonUnderlineMode = 2
+	LinkButtonUnderlineModeOnHover LinkButtonUnderlineMode = 1
+)
+
+// String will return the Godot name of the LinkButtonUnderlineMode value.
+func (e LinkButtonUnderlineMode) String() string {
+	switch e {
+	case LinkButtonUnderlineModeAlways:
+		return "UNDERLINE_MODE_ALWAYS"
+	case LinkButtonUnderlineModeNever:
+		return "UNDERLINE_MODE_NEVER"
+	case LinkButtonUnderlineModeOnHover:
+		return "UNDERLINE_MODE_ON_HOVER"
+	}
+	return fmt.Sprintf("LinkButtonUnderlineMode(%d)", int(e))
+}
+
+// ParseLinkButtonUnderlineMode will return the LinkButtonUnderlineMode value with the given Godot name.
+func ParseLinkButtonUnderlineMode(name string) (LinkButtonUnderlineMode, error) {
+	switch name {
+	case "UNDERLINE_MODE_ALWAYS":
+		return LinkButtonUnderlineModeAlways, nil
+	case "UNDERLINE_MODE_NEVER":
+		return LinkButtonUnderlineModeNever, nil
+	case "UNDERLINE_MODE_ON_HOVER":
+		return LinkButtonUnderlineModeOnHover, nil
+	}
+	return 0, fmt.Errorf("invalid LinkButtonUnderlineMode value %q", name)
+}
+
+/*
+This kind of buttons are primarily used when the interaction with the button causes a context change (like linking to a web page).
+*/
+type LinkButton struct {
+	godot.BaseButton
+	owner gdnative.Object
+}
+
+// classConstructorLinkButton is the class constructor of the LinkButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLinkButton = gdnative.NewLazyClassConstructor("LinkButton")
+
+// NewLinkButton will create a new instance of the LinkButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewLinkButton() *LinkButton {
+	obj := &LinkButton{}
+	obj.SetBaseObject(classConstructorLinkButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *LinkButton) BaseClass() string {
+	return "LinkButton"
+}
+
+// Method binds of the LinkButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLinkButtonGetText          = gdnative.NewLazyMethodBind("LinkButton", "get_text")
+	methodBindLinkButtonGetUnderlineMode = gdnative.NewLazyMethodBind("LinkButton", "get_underline_mode")
+	methodBindLinkButtonSetText          = gdnative.NewLazyMethodBind("LinkButton", "set_text")
+	methodBindLinkButtonSetUnderlineMode = gdnative.NewLazyMethodBind("LinkButton", "set_underline_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *LinkButton) GetText() gdnative.String {
+	//log.Println("Calling LinkButton.GetText()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLinkButtonGetText.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.LinkButton::UnderlineMode
+*/
+func (o *LinkButton) GetUnderlineMode() LinkButtonUnderlineMode {
+	//log.Println("Calling LinkButton.GetUnderlineMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLinkButtonGetUnderlineMode.Get()
+
+	// Call the parent method.
+	// enum.LinkButton::UnderlineMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return LinkButtonUnderlineMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false text String}], Returns: void
+*/
+func (o *LinkButton) SetText(text gdnative.String) {
+	//log.Println("Calling LinkButton.SetText()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(text)
+
+	// Get the method bind
+	methodBind := methodBindLinkButtonSetText.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false underline_mode enum.LinkButton::UnderlineMode}], Returns: void
+*/
+func (o *LinkButton) SetUnderlineMode(underlineMode LinkButtonUnderlineMode) {
+	//log.Println("Calling LinkButton.SetUnderlineMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(underlineMode))
+
+	// Get the method bind
+	methodBind := methodBindLinkButtonSetUnderlineMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Text will return the value of the "text" property.
+func (o *LinkButton) Text() gdnative.String {
+	return o.GetText()
+}
+
+// Underline will return the value of the "underline" property.
+func (o *LinkButton) Underline() LinkButtonUnderlineMode {
+	return o.GetUnderlineMode()
+}
+
+// SetUnderline will set the value of the "underline" property.
+func (o *LinkButton) SetUnderline(value LinkButtonUnderlineMode) {
+	o.SetUnderlineMode(value)
+}
+
+// LinkButtonImplementer is an interface that implements the methods
+// of the LinkButton class.
+type LinkButtonImplementer interface {
+	godot.BaseButtonImplementer
+	GetText() gdnative.String
+	GetUnderlineMode() LinkButtonUnderlineMode
+	SetText(text gdnative.String)
+	SetUnderlineMode(underlineMode LinkButtonUnderlineMode)
+	Text() gdnative.String
+	Underline() LinkButtonUnderlineMode
+	SetUnderline(value LinkButtonUnderlineMode)
+}
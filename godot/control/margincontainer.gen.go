@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMarginContainerFromPointer(ptr gdnative.Pointer) MarginContainer {
-func newMarginContainerFromPointer(ptr gdnative.Pointer) MarginContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := MarginContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Simple margin container. Adds a left margin to anything contained.
 */
@@ -30,6 +22,20 @@ type MarginContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorMarginContainer is the class constructor of the MarginContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMarginContainer = gdnative.NewLazyClassConstructor("MarginContainer")
+
+// NewMarginContainer will create a new instance of the MarginContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewMarginContainer() *MarginContainer {
+	obj := &MarginContainer{}
+	obj.SetBaseObject(classConstructorMarginContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *MarginContainer) BaseClass() string {
 	return "MarginContainer"
 }
@@ -0,0 +1,217 @@
+package control
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/popup"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Special button that brings up a [PopupMenu] when clicked. That's pretty much all it does, as it's just a helper class when building GUIs.
+*/
+type MenuButton struct {
+	Button
+	owner gdnative.Object
+}
+
+// classConstructorMenuButton is the class constructor of the MenuButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMenuButton = gdnative.NewLazyClassConstructor("MenuButton")
+
+// NewMenuButton will create a new instance of the MenuButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewMenuButton() *MenuButton {
+	obj := &MenuButton{}
+	obj.SetBaseObject(classConstructorMenuButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *MenuButton) BaseClass() string {
+	return "MenuButton"
+}
+
+// Method binds of the MenuButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMenuButtonX_GetItems          = gdnative.NewLazyMethodBind("MenuButton", "_get_items")
+	methodBindMenuButtonX_SetItems          = gdnative.NewLazyMethodBind("MenuButton", "_set_items")
+	methodBindMenuButtonX_UnhandledKeyInput = gdnative.NewLazyMethodBind("MenuButton", "_unhandled_key_input")
+	methodBindMenuButtonGetPopup            = gdnative.NewLazyMethodBind("MenuButton", "get_popup")
+	methodBindMenuButtonSetDisableShortcuts = gdnative.NewLazyMethodBind("MenuButton", "set_disable_shortcuts")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *MenuButton) X_GetItems() gdnative.Array {
+	//log.Println("Calling MenuButton.X_GetItems()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindMenuButtonX_GetItems.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
+*/
+func (o *MenuButton) X_SetItems(arg0 gdnative.Array) {
+	//log.Println("Calling MenuButton.X_SetItems()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
+
+	// Get the method bind
+	methodBind := methodBindMenuButtonX_SetItems.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *MenuButton) X_UnhandledKeyInput(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling MenuButton.X_UnhandledKeyInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindMenuButtonX_UnhandledKeyInput.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Return the [PopupMenu] contained in this button.
+		Args: [], Returns: PopupMenu
+*/
+func (o *MenuButton) GetPopup() popup.PopupMenuImplementer {
+	//log.Println("Calling MenuButton.GetPopup()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindMenuButtonGetPopup.Get()
+
+	// Call the parent method.
+	// PopupMenu
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := popup.PopupMenu{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(popup.PopupMenuImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [{ false disabled bool}], Returns: void
+*/
+func (o *MenuButton) SetDisableShortcuts(disabled gdnative.Bool) {
+	//log.Println("Calling MenuButton.SetDisableShortcuts()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(disabled)
+
+	// Get the method bind
+	methodBind := methodBindMenuButtonSetDisableShortcuts.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Items will return the value of the "items" property.
+func (o *MenuButton) Items() gdnative.Array {
+	return o.X_GetItems()
+}
+
+// SetItems will set the value of the "items" property.
+func (o *MenuButton) SetItems(value gdnative.Array) {
+	o.X_SetItems(value)
+}
+
+// Signals of the MenuButton class.
+const (
+	MenuButtonSignalAboutToShow gdnative.String = "about_to_show"
+)
+
+// ConnectAboutToShow will connect the "about_to_show" signal to the given method of the target object.
+func (o *MenuButton) ConnectAboutToShow(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(MenuButtonSignalAboutToShow, target, method, binds, 0)
+}
+
+// EmitAboutToShow will emit the "about_to_show" signal.
+func (o *MenuButton) EmitAboutToShow() error {
+
+	ret, err := o.EmitSignal(MenuButtonSignalAboutToShow)
+	ret.Destroy()
+	return err
+}
+
+// MenuButtonImplementer is an interface that implements the methods
+// of the MenuButton class.
+type MenuButtonImplementer interface {
+	ButtonImplementer
+	X_GetItems() gdnative.Array
+	X_SetItems(arg0 gdnative.Array)
+	GetPopup() popup.PopupMenuImplementer
+	SetDisableShortcuts(disabled gdnative.Bool)
+	Items() gdnative.Array
+	SetItems(value gdnative.Array)
+	ConnectAboutToShow(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAboutToShow() error
+}
@@ -0,0 +1,504 @@
+package control
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// NinePatchRectAxisStretchMode is an enum for AxisStretchMode values.
+type NinePatchRectAxisStretchMode int
+
+const (
+	NinePatchRectAxisStretchModeStretch NinePatchRectAxisStretchMode = 0
+	NinePatchRectAxisStretchModeTile    NinePatchRectAxisStretchMode = 1
+	NinePatchRectAxisStretchModeTileFit NinePatchRectAxisStretchMode = 2
+)
+
+// String will return the Godot name of the NinePatchRectAxisStretchMode value.
+func (e NinePatchRectAxisStretchMode) String() string {
+	switch e {
+	case NinePatchRectAxisStretchModeStretch:
+		return "AXIS_STRETCH_MODE_STRETCH"
+	case NinePatchRectAxisStretchModeTile:
+		return "AXIS_STRETCH_MODE_TILE"
+	case NinePatchRectAxisStretchModeTileFit:
+		return "AXIS_STRETCH_MODE_TILE_FIT"
+	}
+	return fmt.Sprintf("NinePatchRectAxisStretchMode(%d)", int(e))
+}
+
+// ParseNinePatchRectAxisStretchMode will return the NinePatchRectAxisStretchMode value with the given Godot name.
+func ParseNinePatchRectAxisStretchMode(name string) (NinePatchRectAxisStretchMode, error) {
+	switch name {
+	case "AXIS_STRETCH_MODE_STRETCH":
+		return NinePatchRectAxisStretchModeStretch, nil
+	case "AXIS_STRETCH_MODE_TILE":
+		return NinePatchRectAxisStretchModeTile, nil
+	case "AXIS_STRETCH_MODE_TILE_FIT":
+		return NinePatchRectAxisStretchModeTileFit, nil
+	}
+	return 0, fmt.Errorf("invalid NinePatchRectAxisStretchMode value %q", name)
+}
+
+/*
+Better known as 9-slice panels, NinePatchRect produces clean panels of any size, based on a small texture. To do so, it splits the texture in a 3 by 3 grid. When you scale the node, it tiles the texture's sides horizontally or vertically, the center on both axes but it doesn't scale or tile the corners.
+*/
+type NinePatchRect struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorNinePatchRect is the class constructor of the NinePatchRect class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNinePatchRect = gdnative.NewLazyClassConstructor("NinePatchRect")
+
+// NewNinePatchRect will create a new instance of the NinePatchRect class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNinePatchRect() *NinePatchRect {
+	obj := &NinePatchRect{}
+	obj.SetBaseObject(classConstructorNinePatchRect.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *NinePatchRect) BaseClass() string {
+	return "NinePatchRect"
+}
+
+// Method binds of the NinePatchRect class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNinePatchRectGetHAxisStretchMode = gdnative.NewLazyMethodBind("NinePatchRect", "get_h_axis_stretch_mode")
+	methodBindNinePatchRectGetPatchMargin      = gdnative.NewLazyMethodBind("NinePatchRect", "get_patch_margin")
+	methodBindNinePatchRectGetRegionRect       = gdnative.NewLazyMethodBind("NinePatchRect", "get_region_rect")
+	methodBindNinePatchRectGetTexture          = gdnative.NewLazyMethodBind("NinePatchRect", "get_texture")
+	methodBindNinePatchRectGetVAxisStretchMode = gdnative.NewLazyMethodBind("NinePatchRect", "get_v_axis_stretch_mode")
+	methodBindNinePatchRectIsDrawCenterEnabled = gdnative.NewLazyMethodBind("NinePatchRect", "is_draw_center_enabled")
+	methodBindNinePatchRectSetDrawCenter       = gdnative.NewLazyMethodBind("NinePatchRect", "set_draw_center")
+	methodBindNinePatchRectSetHAxisStretchMode = gdnative.NewLazyMethodBind("NinePatchRect", "set_h_axis_stretch_mode")
+	methodBindNinePatchRectSetPatchMargin      = gdnative.NewLazyMethodBind("NinePatchRect", "set_patch_margin")
+	methodBindNinePatchRectSetRegionRect       = gdnative.NewLazyMethodBind("NinePatchRect", "set_region_rect")
+	methodBindNinePatchRectSetTexture          = gdnative.NewLazyMethodBind("NinePatchRect", "set_texture")
+	methodBindNinePatchRectSetVAxisStretchMode = gdnative.NewLazyMethodBind("NinePatchRect", "set_v_axis_stretch_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.NinePatchRect::AxisStretchMode
+*/
+func (o *NinePatchRect) GetHAxisStretchMode() NinePatchRectAxisStretchMode {
+	//log.Println("Calling NinePatchRect.GetHAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectGetHAxisStretchMode.Get()
+
+	// Call the parent method.
+	// enum.NinePatchRect::AxisStretchMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return NinePatchRectAxisStretchMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false margin int}], Returns: int
+*/
+func (o *NinePatchRect) GetPatchMargin(margin gdnative.Int) gdnative.Int {
+	//log.Println("Calling NinePatchRect.GetPatchMargin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectGetPatchMargin.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Rect2
+*/
+func (o *NinePatchRect) GetRegionRect() gdnative.Rect2 {
+	//log.Println("Calling NinePatchRect.GetRegionRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectGetRegionRect.Get()
+
+	// Call the parent method.
+	// Rect2
+	retPtr := gdnative.NewEmptyRect2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRect2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *NinePatchRect) GetTexture() godot.TextureImplementer {
+	//log.Println("Calling NinePatchRect.GetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectGetTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.NinePatchRect::AxisStretchMode
+*/
+func (o *NinePatchRect) GetVAxisStretchMode() NinePatchRectAxisStretchMode {
+	//log.Println("Calling NinePatchRect.GetVAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectGetVAxisStretchMode.Get()
+
+	// Call the parent method.
+	// enum.NinePatchRect::AxisStretchMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return NinePatchRectAxisStretchMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *NinePatchRect) IsDrawCenterEnabled() gdnative.Bool {
+	//log.Println("Calling NinePatchRect.IsDrawCenterEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectIsDrawCenterEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false draw_center bool}], Returns: void
+*/
+func (o *NinePatchRect) SetDrawCenter(drawCenter gdnative.Bool) {
+	//log.Println("Calling NinePatchRect.SetDrawCenter()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(drawCenter)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectSetDrawCenter.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.NinePatchRect::AxisStretchMode}], Returns: void
+*/
+func (o *NinePatchRect) SetHAxisStretchMode(mode NinePatchRectAxisStretchMode) {
+	//log.Println("Calling NinePatchRect.SetHAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectSetHAxisStretchMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false margin int} { false value int}], Returns: void
+*/
+func (o *NinePatchRect) SetPatchMargin(margin gdnative.Int, value gdnative.Int) {
+	//log.Println("Calling NinePatchRect.SetPatchMargin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+	ptrArguments[1] = gdnative.NewPointerFromInt(value)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectSetPatchMargin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false rect Rect2}], Returns: void
+*/
+func (o *NinePatchRect) SetRegionRect(rect gdnative.Rect2) {
+	//log.Println("Calling NinePatchRect.SetRegionRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectSetRegionRect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *NinePatchRect) SetTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling NinePatchRect.SetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectSetTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.NinePatchRect::AxisStretchMode}], Returns: void
+*/
+func (o *NinePatchRect) SetVAxisStretchMode(mode NinePatchRectAxisStretchMode) {
+	//log.Println("Calling NinePatchRect.SetVAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindNinePatchRectSetVAxisStretchMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AxisStretchHorizontal will return the value of the "axis_stretch_horizontal" property.
+func (o *NinePatchRect) AxisStretchHorizontal() NinePatchRectAxisStretchMode {
+	return o.GetHAxisStretchMode()
+}
+
+// SetAxisStretchHorizontal will set the value of the "axis_stretch_horizontal" property.
+func (o *NinePatchRect) SetAxisStretchHorizontal(value NinePatchRectAxisStretchMode) {
+	o.SetHAxisStretchMode(value)
+}
+
+// AxisStretchVertical will return the value of the "axis_stretch_vertical" property.
+func (o *NinePatchRect) AxisStretchVertical() NinePatchRectAxisStretchMode {
+	return o.GetVAxisStretchMode()
+}
+
+// SetAxisStretchVertical will set the value of the "axis_stretch_vertical" property.
+func (o *NinePatchRect) SetAxisStretchVertical(value NinePatchRectAxisStretchMode) {
+	o.SetVAxisStretchMode(value)
+}
+
+// DrawCenter will return the value of the "draw_center" property.
+func (o *NinePatchRect) DrawCenter() gdnative.Bool {
+	return o.IsDrawCenterEnabled()
+}
+
+// PatchMarginBottom will return the value of the "patch_margin_bottom" property.
+func (o *NinePatchRect) PatchMarginBottom() gdnative.Int {
+	return o.GetPatchMargin(3)
+}
+
+// SetPatchMarginBottom will set the value of the "patch_margin_bottom" property.
+func (o *NinePatchRect) SetPatchMarginBottom(value gdnative.Int) {
+	o.SetPatchMargin(3, value)
+}
+
+// PatchMarginLeft will return the value of the "patch_margin_left" property.
+func (o *NinePatchRect) PatchMarginLeft() gdnative.Int {
+	return o.GetPatchMargin(0)
+}
+
+// SetPatchMarginLeft will set the value of the "patch_margin_left" property.
+func (o *NinePatchRect) SetPatchMarginLeft(value gdnative.Int) {
+	o.SetPatchMargin(0, value)
+}
+
+// PatchMarginRight will return the value of the "patch_margin_right" property.
+func (o *NinePatchRect) PatchMarginRight() gdnative.Int {
+	return o.GetPatchMargin(2)
+}
+
+// SetPatchMarginRight will set the value of the "patch_margin_right" property.
+func (o *NinePatchRect) SetPatchMarginRight(value gdnative.Int) {
+	o.SetPatchMargin(2, value)
+}
+
+// PatchMarginTop will return the value of the "patch_margin_top" property.
+func (o *NinePatchRect) PatchMarginTop() gdnative.Int {
+	return o.GetPatchMargin(1)
+}
+
+// SetPatchMarginTop will set the value of the "patch_margin_top" property.
+func (o *NinePatchRect) SetPatchMarginTop(value gdnative.Int) {
+	o.SetPatchMargin(1, value)
+}
+
+// RegionRect will return the value of the "region_rect" property.
+func (o *NinePatchRect) RegionRect() gdnative.Rect2 {
+	return o.GetRegionRect()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *NinePatchRect) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// Signals of the NinePatchRect class.
+const (
+	NinePatchRectSignalTextureChanged gdnative.String = "texture_changed"
+)
+
+// ConnectTextureChanged will connect the "texture_changed" signal to the given method of the target object.
+func (o *NinePatchRect) ConnectTextureChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NinePatchRectSignalTextureChanged, target, method, binds, 0)
+}
+
+// EmitTextureChanged will emit the "texture_changed" signal.
+func (o *NinePatchRect) EmitTextureChanged() error {
+
+	ret, err := o.EmitSignal(NinePatchRectSignalTextureChanged)
+	ret.Destroy()
+	return err
+}
+
+// NinePatchRectImplementer is an interface that implements the methods
+// of the NinePatchRect class.
+type NinePatchRectImplementer interface {
+	godot.ControlImplementer
+	GetHAxisStretchMode() NinePatchRectAxisStretchMode
+	GetPatchMargin(margin gdnative.Int) gdnative.Int
+	GetRegionRect() gdnative.Rect2
+	GetTexture() godot.TextureImplementer
+	GetVAxisStretchMode() NinePatchRectAxisStretchMode
+	IsDrawCenterEnabled() gdnative.Bool
+	SetDrawCenter(drawCenter gdnative.Bool)
+	SetHAxisStretchMode(mode NinePatchRectAxisStretchMode)
+	SetPatchMargin(margin gdnative.Int, value gdnative.Int)
+	SetRegionRect(rect gdnative.Rect2)
+	SetTexture(texture godot.TextureImplementer)
+	SetVAxisStretchMode(mode NinePatchRectAxisStretchMode)
+	AxisStretchHorizontal() NinePatchRectAxisStretchMode
+	SetAxisStretchHorizontal(value NinePatchRectAxisStretchMode)
+	AxisStretchVertical() NinePatchRectAxisStretchMode
+	SetAxisStretchVertical(value NinePatchRectAxisStretchMode)
+	DrawCenter() gdnative.Bool
+	PatchMarginBottom() gdnative.Int
+	SetPatchMarginBottom(value gdnative.Int)
+	PatchMarginLeft() gdnative.Int
+	SetPatchMarginLeft(value gdnative.Int)
+	PatchMarginRight() gdnative.Int
+	SetPatchMarginRight(value gdnative.Int)
+	PatchMarginTop() gdnative.Int
+	SetPatchMarginTop(value gdnative.Int)
+	RegionRect() gdnative.Rect2
+	Texture() godot.TextureImplementer
+	ConnectTextureChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTextureChanged() error
+}
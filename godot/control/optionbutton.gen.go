@@ -1,7 +1,9 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/popup"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +15,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewOptionButtonFromPointer(ptr gdnative.Pointer) OptionButton {
-func newOptionButtonFromPointer(ptr gdnative.Pointer) OptionButton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := OptionButton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 OptionButton is a type button that provides a selectable list of items when pressed. The item selected becomes the "current" item and is displayed as the button text.
 */
@@ -30,13 +23,57 @@ type OptionButton struct {
 	owner gdnative.Object
 }
 
+// classConstructorOptionButton is the class constructor of the OptionButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorOptionButton = gdnative.NewLazyClassConstructor("OptionButton")
+
+// NewOptionButton will create a new instance of the OptionButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewOptionButton() *OptionButton {
+	obj := &OptionButton{}
+	obj.SetBaseObject(classConstructorOptionButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *OptionButton) BaseClass() string {
 	return "OptionButton"
 }
 
+// Method binds of the OptionButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindOptionButtonX_GetItems          = gdnative.NewLazyMethodBind("OptionButton", "_get_items")
+	methodBindOptionButtonX_SelectInt         = gdnative.NewLazyMethodBind("OptionButton", "_select_int")
+	methodBindOptionButtonX_Selected          = gdnative.NewLazyMethodBind("OptionButton", "_selected")
+	methodBindOptionButtonX_SetItems          = gdnative.NewLazyMethodBind("OptionButton", "_set_items")
+	methodBindOptionButtonAddIconItem         = gdnative.NewLazyMethodBind("OptionButton", "add_icon_item")
+	methodBindOptionButtonAddItem             = gdnative.NewLazyMethodBind("OptionButton", "add_item")
+	methodBindOptionButtonAddSeparator        = gdnative.NewLazyMethodBind("OptionButton", "add_separator")
+	methodBindOptionButtonClear               = gdnative.NewLazyMethodBind("OptionButton", "clear")
+	methodBindOptionButtonGetItemCount        = gdnative.NewLazyMethodBind("OptionButton", "get_item_count")
+	methodBindOptionButtonGetItemIcon         = gdnative.NewLazyMethodBind("OptionButton", "get_item_icon")
+	methodBindOptionButtonGetItemId           = gdnative.NewLazyMethodBind("OptionButton", "get_item_id")
+	methodBindOptionButtonGetItemMetadata     = gdnative.NewLazyMethodBind("OptionButton", "get_item_metadata")
+	methodBindOptionButtonGetItemText         = gdnative.NewLazyMethodBind("OptionButton", "get_item_text")
+	methodBindOptionButtonGetPopup            = gdnative.NewLazyMethodBind("OptionButton", "get_popup")
+	methodBindOptionButtonGetSelected         = gdnative.NewLazyMethodBind("OptionButton", "get_selected")
+	methodBindOptionButtonGetSelectedId       = gdnative.NewLazyMethodBind("OptionButton", "get_selected_id")
+	methodBindOptionButtonGetSelectedMetadata = gdnative.NewLazyMethodBind("OptionButton", "get_selected_metadata")
+	methodBindOptionButtonIsItemDisabled      = gdnative.NewLazyMethodBind("OptionButton", "is_item_disabled")
+	methodBindOptionButtonRemoveItem          = gdnative.NewLazyMethodBind("OptionButton", "remove_item")
+	methodBindOptionButtonSelect              = gdnative.NewLazyMethodBind("OptionButton", "select")
+	methodBindOptionButtonSetItemDisabled     = gdnative.NewLazyMethodBind("OptionButton", "set_item_disabled")
+	methodBindOptionButtonSetItemIcon         = gdnative.NewLazyMethodBind("OptionButton", "set_item_icon")
+	methodBindOptionButtonSetItemId           = gdnative.NewLazyMethodBind("OptionButton", "set_item_id")
+	methodBindOptionButtonSetItemMetadata     = gdnative.NewLazyMethodBind("OptionButton", "set_item_metadata")
+	methodBindOptionButtonSetItemText         = gdnative.NewLazyMethodBind("OptionButton", "set_item_text")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *OptionButton) X_GetItems() gdnative.Array {
 	//log.Println("Calling OptionButton.X_GetItems()")
@@ -45,7 +82,7 @@ func (o *OptionButton) X_GetItems() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "_get_items")
+	methodBind := methodBindOptionButtonX_GetItems.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +95,8 @@ func (o *OptionButton) X_GetItems() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *OptionButton) X_SelectInt(arg0 gdnative.Int) {
 	//log.Println("Calling OptionButton.X_SelectInt()")
@@ -69,7 +106,7 @@ func (o *OptionButton) X_SelectInt(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "_select_int")
+	methodBind := methodBindOptionButtonX_SelectInt.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +116,8 @@ func (o *OptionButton) X_SelectInt(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *OptionButton) X_Selected(arg0 gdnative.Int) {
 	//log.Println("Calling OptionButton.X_Selected()")
@@ -90,7 +127,7 @@ func (o *OptionButton) X_Selected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "_selected")
+	methodBind := methodBindOptionButtonX_Selected.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +137,8 @@ func (o *OptionButton) X_Selected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
 */
 func (o *OptionButton) X_SetItems(arg0 gdnative.Array) {
 	//log.Println("Calling OptionButton.X_SetItems()")
@@ -111,7 +148,7 @@ func (o *OptionButton) X_SetItems(arg0 gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "_set_items")
+	methodBind := methodBindOptionButtonX_SetItems.Get()
 
 	// Call the parent method.
 	// void
@@ -121,20 +158,20 @@ func (o *OptionButton) X_SetItems(arg0 gdnative.Array) {
 }
 
 /*
-        Add an item, with a "texture" icon, text "label" and (optionally) id. If no "id" is passed, "id" becomes the item index. New items are appended at the end.
-	Args: [{ false texture Texture} { false label String} { false id int}], Returns: void
+	        Add an item, with a "texture" icon, text "label" and (optionally) id. If no "id" is passed, "id" becomes the item index. New items are appended at the end.
+		Args: [{ false texture Texture} { false label String} { false id int}], Returns: void
 */
-func (o *OptionButton) AddIconItem(texture TextureImplementer, label gdnative.String, id gdnative.Int) {
+func (o *OptionButton) AddIconItem(texture godot.TextureImplementer, label gdnative.String, id gdnative.Int) {
 	//log.Println("Calling OptionButton.AddIconItem()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 	ptrArguments[1] = gdnative.NewPointerFromString(label)
 	ptrArguments[2] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "add_icon_item")
+	methodBind := methodBindOptionButtonAddIconItem.Get()
 
 	// Call the parent method.
 	// void
@@ -144,8 +181,8 @@ func (o *OptionButton) AddIconItem(texture TextureImplementer, label gdnative.St
 }
 
 /*
-        Add an item, with text "label" and (optionally) id. If no "id" is passed, "id" becomes the item index. New items are appended at the end.
-	Args: [{ false label String} {-1 true id int}], Returns: void
+	        Add an item, with text "label" and (optionally) id. If no "id" is passed, "id" becomes the item index. New items are appended at the end.
+		Args: [{ false label String} {-1 true id int}], Returns: void
 */
 func (o *OptionButton) AddItem(label gdnative.String, id gdnative.Int) {
 	//log.Println("Calling OptionButton.AddItem()")
@@ -156,7 +193,7 @@ func (o *OptionButton) AddItem(label gdnative.String, id gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "add_item")
+	methodBind := methodBindOptionButtonAddItem.Get()
 
 	// Call the parent method.
 	// void
@@ -166,8 +203,8 @@ func (o *OptionButton) AddItem(label gdnative.String, id gdnative.Int) {
 }
 
 /*
-        Add a separator to the list of items. Separators help to group items. Separator also takes up an index and is appended at the end.
-	Args: [], Returns: void
+	        Add a separator to the list of items. Separators help to group items. Separator also takes up an index and is appended at the end.
+		Args: [], Returns: void
 */
 func (o *OptionButton) AddSeparator() {
 	//log.Println("Calling OptionButton.AddSeparator()")
@@ -176,7 +213,7 @@ func (o *OptionButton) AddSeparator() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "add_separator")
+	methodBind := methodBindOptionButtonAddSeparator.Get()
 
 	// Call the parent method.
 	// void
@@ -186,8 +223,8 @@ func (o *OptionButton) AddSeparator() {
 }
 
 /*
-        Clear all the items in the [code]OptionButton[/code].
-	Args: [], Returns: void
+	        Clear all the items in the [code]OptionButton[/code].
+		Args: [], Returns: void
 */
 func (o *OptionButton) Clear() {
 	//log.Println("Calling OptionButton.Clear()")
@@ -196,7 +233,7 @@ func (o *OptionButton) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "clear")
+	methodBind := methodBindOptionButtonClear.Get()
 
 	// Call the parent method.
 	// void
@@ -206,8 +243,8 @@ func (o *OptionButton) Clear() {
 }
 
 /*
-        Return the amount of items in the OptionButton.
-	Args: [], Returns: int
+	        Return the amount of items in the OptionButton.
+		Args: [], Returns: int
 */
 func (o *OptionButton) GetItemCount() gdnative.Int {
 	//log.Println("Calling OptionButton.GetItemCount()")
@@ -216,7 +253,7 @@ func (o *OptionButton) GetItemCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_item_count")
+	methodBind := methodBindOptionButtonGetItemCount.Get()
 
 	// Call the parent method.
 	// int
@@ -229,10 +266,10 @@ func (o *OptionButton) GetItemCount() gdnative.Int {
 }
 
 /*
-        Return the icon of the item at index "idx".
-	Args: [{ false idx int}], Returns: Texture
+	        Return the icon of the item at index "idx".
+		Args: [{ false idx int}], Returns: Texture
 */
-func (o *OptionButton) GetItemIcon(idx gdnative.Int) TextureImplementer {
+func (o *OptionButton) GetItemIcon(idx gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling OptionButton.GetItemIcon()")
 
 	// Build out the method's arguments
@@ -240,7 +277,7 @@ func (o *OptionButton) GetItemIcon(idx gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_item_icon")
+	methodBind := methodBindOptionButtonGetItemIcon.Get()
 
 	// Call the parent method.
 	// Texture
@@ -248,27 +285,25 @@ func (o *OptionButton) GetItemIcon(idx gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the ID of the item at index "idx".
-	Args: [{ false idx int}], Returns: int
+	        Return the ID of the item at index "idx".
+		Args: [{ false idx int}], Returns: int
 */
 func (o *OptionButton) GetItemId(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling OptionButton.GetItemId()")
@@ -278,7 +313,7 @@ func (o *OptionButton) GetItemId(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_item_id")
+	methodBind := methodBindOptionButtonGetItemId.Get()
 
 	// Call the parent method.
 	// int
@@ -291,8 +326,7 @@ func (o *OptionButton) GetItemId(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Variant
+Args: [{ false idx int}], Returns: Variant
 */
 func (o *OptionButton) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling OptionButton.GetItemMetadata()")
@@ -302,7 +336,7 @@ func (o *OptionButton) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_item_metadata")
+	methodBind := methodBindOptionButtonGetItemMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -315,8 +349,8 @@ func (o *OptionButton) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 }
 
 /*
-        Return the text of the item at index "idx".
-	Args: [{ false idx int}], Returns: String
+	        Return the text of the item at index "idx".
+		Args: [{ false idx int}], Returns: String
 */
 func (o *OptionButton) GetItemText(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling OptionButton.GetItemText()")
@@ -326,7 +360,7 @@ func (o *OptionButton) GetItemText(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_item_text")
+	methodBind := methodBindOptionButtonGetItemText.Get()
 
 	// Call the parent method.
 	// String
@@ -339,17 +373,17 @@ func (o *OptionButton) GetItemText(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Return the [PopupMenu] contained in this button.
-	Args: [], Returns: PopupMenu
+	        Return the [PopupMenu] contained in this button.
+		Args: [], Returns: PopupMenu
 */
-func (o *OptionButton) GetPopup() PopupMenuImplementer {
+func (o *OptionButton) GetPopup() popup.PopupMenuImplementer {
 	//log.Println("Calling OptionButton.GetPopup()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_popup")
+	methodBind := methodBindOptionButtonGetPopup.Get()
 
 	// Call the parent method.
 	// PopupMenu
@@ -357,27 +391,25 @@ func (o *OptionButton) GetPopup() PopupMenuImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPopupMenuFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PopupMenuImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PopupMenu" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PopupMenuImplementer)
+	ret := popup.PopupMenu{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(popup.PopupMenuImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *OptionButton) GetSelected() gdnative.Int {
 	//log.Println("Calling OptionButton.GetSelected()")
@@ -386,7 +418,7 @@ func (o *OptionButton) GetSelected() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_selected")
+	methodBind := methodBindOptionButtonGetSelected.Get()
 
 	// Call the parent method.
 	// int
@@ -399,8 +431,7 @@ func (o *OptionButton) GetSelected() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *OptionButton) GetSelectedId() gdnative.Int {
 	//log.Println("Calling OptionButton.GetSelectedId()")
@@ -409,7 +440,7 @@ func (o *OptionButton) GetSelectedId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_selected_id")
+	methodBind := methodBindOptionButtonGetSelectedId.Get()
 
 	// Call the parent method.
 	// int
@@ -422,8 +453,7 @@ func (o *OptionButton) GetSelectedId() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: Variant
+Args: [], Returns: Variant
 */
 func (o *OptionButton) GetSelectedMetadata() gdnative.Variant {
 	//log.Println("Calling OptionButton.GetSelectedMetadata()")
@@ -432,7 +462,7 @@ func (o *OptionButton) GetSelectedMetadata() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "get_selected_metadata")
+	methodBind := methodBindOptionButtonGetSelectedMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -445,8 +475,7 @@ func (o *OptionButton) GetSelectedMetadata() gdnative.Variant {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: bool
+Args: [{ false idx int}], Returns: bool
 */
 func (o *OptionButton) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling OptionButton.IsItemDisabled()")
@@ -456,7 +485,7 @@ func (o *OptionButton) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "is_item_disabled")
+	methodBind := methodBindOptionButtonIsItemDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -469,8 +498,7 @@ func (o *OptionButton) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: void
+Args: [{ false idx int}], Returns: void
 */
 func (o *OptionButton) RemoveItem(idx gdnative.Int) {
 	//log.Println("Calling OptionButton.RemoveItem()")
@@ -480,7 +508,7 @@ func (o *OptionButton) RemoveItem(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "remove_item")
+	methodBind := methodBindOptionButtonRemoveItem.Get()
 
 	// Call the parent method.
 	// void
@@ -490,8 +518,8 @@ func (o *OptionButton) RemoveItem(idx gdnative.Int) {
 }
 
 /*
-        Select an item by index and make it the current item.
-	Args: [{ false idx int}], Returns: void
+	        Select an item by index and make it the current item.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *OptionButton) Select(idx gdnative.Int) {
 	//log.Println("Calling OptionButton.Select()")
@@ -501,7 +529,7 @@ func (o *OptionButton) Select(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "select")
+	methodBind := methodBindOptionButtonSelect.Get()
 
 	// Call the parent method.
 	// void
@@ -511,8 +539,7 @@ func (o *OptionButton) Select(idx gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false idx int} { false disabled bool}], Returns: void
+Args: [{ false idx int} { false disabled bool}], Returns: void
 */
 func (o *OptionButton) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool) {
 	//log.Println("Calling OptionButton.SetItemDisabled()")
@@ -523,7 +550,7 @@ func (o *OptionButton) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "set_item_disabled")
+	methodBind := methodBindOptionButtonSetItemDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -533,19 +560,19 @@ func (o *OptionButton) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool)
 }
 
 /*
-        Set the icon of an item at index "idx".
-	Args: [{ false idx int} { false texture Texture}], Returns: void
+	        Set the icon of an item at index "idx".
+		Args: [{ false idx int} { false texture Texture}], Returns: void
 */
-func (o *OptionButton) SetItemIcon(idx gdnative.Int, texture TextureImplementer) {
+func (o *OptionButton) SetItemIcon(idx gdnative.Int, texture godot.TextureImplementer) {
 	//log.Println("Calling OptionButton.SetItemIcon()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "set_item_icon")
+	methodBind := methodBindOptionButtonSetItemIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -555,8 +582,8 @@ func (o *OptionButton) SetItemIcon(idx gdnative.Int, texture TextureImplementer)
 }
 
 /*
-        Set the ID of an item at index "idx".
-	Args: [{ false idx int} { false id int}], Returns: void
+	        Set the ID of an item at index "idx".
+		Args: [{ false idx int} { false id int}], Returns: void
 */
 func (o *OptionButton) SetItemId(idx gdnative.Int, id gdnative.Int) {
 	//log.Println("Calling OptionButton.SetItemId()")
@@ -567,7 +594,7 @@ func (o *OptionButton) SetItemId(idx gdnative.Int, id gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "set_item_id")
+	methodBind := methodBindOptionButtonSetItemId.Get()
 
 	// Call the parent method.
 	// void
@@ -577,8 +604,7 @@ func (o *OptionButton) SetItemId(idx gdnative.Int, id gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false idx int} { false metadata Variant}], Returns: void
+Args: [{ false idx int} { false metadata Variant}], Returns: void
 */
 func (o *OptionButton) SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant) {
 	//log.Println("Calling OptionButton.SetItemMetadata()")
@@ -589,7 +615,7 @@ func (o *OptionButton) SetItemMetadata(idx gdnative.Int, metadata gdnative.Varia
 	ptrArguments[1] = gdnative.NewPointerFromVariant(metadata)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "set_item_metadata")
+	methodBind := methodBindOptionButtonSetItemMetadata.Get()
 
 	// Call the parent method.
 	// void
@@ -599,8 +625,8 @@ func (o *OptionButton) SetItemMetadata(idx gdnative.Int, metadata gdnative.Varia
 }
 
 /*
-        Set the text of an item at index "idx".
-	Args: [{ false idx int} { false text String}], Returns: void
+	        Set the text of an item at index "idx".
+		Args: [{ false idx int} { false text String}], Returns: void
 */
 func (o *OptionButton) SetItemText(idx gdnative.Int, text gdnative.String) {
 	//log.Println("Calling OptionButton.SetItemText()")
@@ -611,7 +637,7 @@ func (o *OptionButton) SetItemText(idx gdnative.Int, text gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OptionButton", "set_item_text")
+	methodBind := methodBindOptionButtonSetItemText.Get()
 
 	// Call the parent method.
 	// void
@@ -620,6 +646,59 @@ func (o *OptionButton) SetItemText(idx gdnative.Int, text gdnative.String) {
 
 }
 
+// AddItemWithDefaults will call AddItem using the default values for: id.
+func (o *OptionButton) AddItemWithDefaults(label gdnative.String) {
+	o.AddItem(label, -1)
+}
+
+// Items will return the value of the "items" property.
+func (o *OptionButton) Items() gdnative.Array {
+	return o.X_GetItems()
+}
+
+// SetItems will set the value of the "items" property.
+func (o *OptionButton) SetItems(value gdnative.Array) {
+	o.X_SetItems(value)
+}
+
+// Selected will return the value of the "selected" property.
+func (o *OptionButton) Selected() gdnative.Int {
+	return o.GetSelected()
+}
+
+// SetSelected will set the value of the "selected" property.
+func (o *OptionButton) SetSelected(value gdnative.Int) {
+	o.X_SelectInt(value)
+}
+
+// Signals of the OptionButton class.
+const (
+	OptionButtonSignalItemSelected gdnative.String = "item_selected"
+)
+
+// OptionButtonItemSelectedArgs holds the arguments of the "item_selected" signal.
+type OptionButtonItemSelectedArgs struct {
+	Id gdnative.Int
+}
+
+// ConnectItemSelected will connect the "item_selected" signal to the given method of the target object.
+func (o *OptionButton) ConnectItemSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(OptionButtonSignalItemSelected, target, method, binds, 0)
+}
+
+// EmitItemSelected will emit the "item_selected" signal.
+func (o *OptionButton) EmitItemSelected(args OptionButtonItemSelectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(OptionButtonSignalItemSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
 // OptionButtonImplementer is an interface that implements the methods
 // of the OptionButton class.
 type OptionButtonImplementer interface {
@@ -628,16 +707,16 @@ type OptionButtonImplementer interface {
 	X_SelectInt(arg0 gdnative.Int)
 	X_Selected(arg0 gdnative.Int)
 	X_SetItems(arg0 gdnative.Array)
-	AddIconItem(texture TextureImplementer, label gdnative.String, id gdnative.Int)
+	AddIconItem(texture godot.TextureImplementer, label gdnative.String, id gdnative.Int)
 	AddItem(label gdnative.String, id gdnative.Int)
 	AddSeparator()
 	Clear()
 	GetItemCount() gdnative.Int
-	GetItemIcon(idx gdnative.Int) TextureImplementer
+	GetItemIcon(idx gdnative.Int) godot.TextureImplementer
 	GetItemId(idx gdnative.Int) gdnative.Int
 	GetItemMetadata(idx gdnative.Int) gdnative.Variant
 	GetItemText(idx gdnative.Int) gdnative.String
-	GetPopup() PopupMenuImplementer
+	GetPopup() popup.PopupMenuImplementer
 	GetSelected() gdnative.Int
 	GetSelectedId() gdnative.Int
 	GetSelectedMetadata() gdnative.Variant
@@ -645,8 +724,15 @@ type OptionButtonImplementer interface {
 	RemoveItem(idx gdnative.Int)
 	Select(idx gdnative.Int)
 	SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool)
-	SetItemIcon(idx gdnative.Int, texture TextureImplementer)
+	SetItemIcon(idx gdnative.Int, texture godot.TextureImplementer)
 	SetItemId(idx gdnative.Int, id gdnative.Int)
 	SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant)
 	SetItemText(idx gdnative.Int, text gdnative.String)
+	AddItemWithDefaults(label gdnative.String)
+	Items() gdnative.Array
+	SetItems(value gdnative.Array)
+	Selected() gdnative.Int
+	SetSelected(value gdnative.Int)
+	ConnectItemSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemSelected(args OptionButtonItemSelectedArgs) error
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,28 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPanelFromPointer(ptr gdnative.Pointer) Panel {
-func newPanelFromPointer(ptr gdnative.Pointer) Panel {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Panel{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Panel is a [Control] that displays an opaque background. It's commonly used as a parent and container for other types of [Control] nodes.
 */
 type Panel struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorPanel is the class constructor of the Panel class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPanel = gdnative.NewLazyClassConstructor("Panel")
+
+// NewPanel will create a new instance of the Panel class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPanel() *Panel {
+	obj := &Panel{}
+	obj.SetBaseObject(classConstructorPanel.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Panel) BaseClass() string {
 	return "Panel"
 }
@@ -37,5 +43,5 @@ func (o *Panel) BaseClass() string {
 // PanelImplementer is an interface that implements the methods
 // of the Panel class.
 type PanelImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPanelContainerFromPointer(ptr gdnative.Pointer) PanelContainer {
-func newPanelContainerFromPointer(ptr gdnative.Pointer) PanelContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PanelContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Panel container type. This container fits controls inside of the delimited area of a stylebox. It's useful for giving controls an outline.
 */
@@ -30,6 +22,20 @@ type PanelContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorPanelContainer is the class constructor of the PanelContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPanelContainer = gdnative.NewLazyClassConstructor("PanelContainer")
+
+// NewPanelContainer will create a new instance of the PanelContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPanelContainer() *PanelContainer {
+	obj := &PanelContainer{}
+	obj.SetBaseObject(classConstructorPanelContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PanelContainer) BaseClass() string {
 	return "PanelContainer"
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,42 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewProgressBarFromPointer(ptr gdnative.Pointer) ProgressBar {
-func newProgressBarFromPointer(ptr gdnative.Pointer) ProgressBar {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ProgressBar{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 General purpose progress bar. Shows fill percentage from right to left.
 */
 type ProgressBar struct {
-	Range
+	godot.Range
 	owner gdnative.Object
 }
 
+// classConstructorProgressBar is the class constructor of the ProgressBar class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorProgressBar = gdnative.NewLazyClassConstructor("ProgressBar")
+
+// NewProgressBar will create a new instance of the ProgressBar class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewProgressBar() *ProgressBar {
+	obj := &ProgressBar{}
+	obj.SetBaseObject(classConstructorProgressBar.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ProgressBar) BaseClass() string {
 	return "ProgressBar"
 }
 
+// Method binds of the ProgressBar class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindProgressBarIsPercentVisible  = gdnative.NewLazyMethodBind("ProgressBar", "is_percent_visible")
+	methodBindProgressBarSetPercentVisible = gdnative.NewLazyMethodBind("ProgressBar", "set_percent_visible")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ProgressBar) IsPercentVisible() gdnative.Bool {
 	//log.Println("Calling ProgressBar.IsPercentVisible()")
@@ -45,7 +58,7 @@ func (o *ProgressBar) IsPercentVisible() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProgressBar", "is_percent_visible")
+	methodBind := methodBindProgressBarIsPercentVisible.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +71,8 @@ func (o *ProgressBar) IsPercentVisible() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false visible bool}], Returns: void
+	        Undocumented
+		Args: [{ false visible bool}], Returns: void
 */
 func (o *ProgressBar) SetPercentVisible(visible gdnative.Bool) {
 	//log.Println("Calling ProgressBar.SetPercentVisible()")
@@ -69,7 +82,7 @@ func (o *ProgressBar) SetPercentVisible(visible gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(visible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProgressBar", "set_percent_visible")
+	methodBind := methodBindProgressBarSetPercentVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *ProgressBar) SetPercentVisible(visible gdnative.Bool) {
 
 }
 
+// PercentVisible will return the value of the "percent_visible" property.
+func (o *ProgressBar) PercentVisible() gdnative.Bool {
+	return o.IsPercentVisible()
+}
+
 // ProgressBarImplementer is an interface that implements the methods
 // of the ProgressBar class.
 type ProgressBarImplementer interface {
-	RangeImplementer
+	godot.RangeImplementer
 	IsPercentVisible() gdnative.Bool
 	SetPercentVisible(visible gdnative.Bool)
+	PercentVisible() gdnative.Bool
 }
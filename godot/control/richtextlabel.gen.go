@@ -1,7 +1,10 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,6 +26,36 @@ const (
 	RichTextLabelAlignRight  RichTextLabelAlign = 2
 )
 
+// String will return the Godot name of the RichTextLabelAlign value.
+func (e RichTextLabelAlign) String() string {
+	switch e {
+	case RichTextLabelAlignCenter:
+		return "ALIGN_CENTER"
+	case RichTextLabelAlignFill:
+		return "ALIGN_FILL"
+	case RichTextLabelAlignLeft:
+		return "ALIGN_LEFT"
+	case RichTextLabelAlignRight:
+		return "ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("RichTextLabelAlign(%d)", int(e))
+}
+
+// ParseRichTextLabelAlign will return the RichTextLabelAlign value with the given Godot name.
+func ParseRichTextLabelAlign(name string) (RichTextLabelAlign, error) {
+	switch name {
+	case "ALIGN_CENTER":
+		return RichTextLabelAlignCenter, nil
+	case "ALIGN_FILL":
+		return RichTextLabelAlignFill, nil
+	case "ALIGN_LEFT":
+		return RichTextLabelAlignLeft, nil
+	case "ALIGN_RIGHT":
+		return RichTextLabelAlignRight, nil
+	}
+	return 0, fmt.Errorf("invalid RichTextLabelAlign value %q", name)
+}
+
 // RichTextLabelItemType is an enum for ItemType values.
 type RichTextLabelItemType int
 
@@ -41,6 +74,68 @@ const (
 	RichTextLabelItemUnderline RichTextLabelItemType = 6
 )
 
+// String will return the Godot name of the RichTextLabelItemType value.
+func (e RichTextLabelItemType) String() string {
+	switch e {
+	case RichTextLabelItemAlign:
+		return "ITEM_ALIGN"
+	case RichTextLabelItemColor:
+		return "ITEM_COLOR"
+	case RichTextLabelItemFont:
+		return "ITEM_FONT"
+	case RichTextLabelItemFrame:
+		return "ITEM_FRAME"
+	case RichTextLabelItemImage:
+		return "ITEM_IMAGE"
+	case RichTextLabelItemIndent:
+		return "ITEM_INDENT"
+	case RichTextLabelItemList:
+		return "ITEM_LIST"
+	case RichTextLabelItemMeta:
+		return "ITEM_META"
+	case RichTextLabelItemNewline:
+		return "ITEM_NEWLINE"
+	case RichTextLabelItemTable:
+		return "ITEM_TABLE"
+	case RichTextLabelItemText:
+		return "ITEM_TEXT"
+	case RichTextLabelItemUnderline:
+		return "ITEM_UNDERLINE"
+	}
+	return fmt.Sprintf("RichTextLabelItemType(%d)", int(e))
+}
+
+// ParseRichTextLabelItemType will return the RichTextLabelItemType value with the given Godot name.
+func ParseRichTextLabelItemType(name string) (RichTextLabelItemType, error) {
+	switch name {
+	case "ITEM_ALIGN":
+		return RichTextLabelItemAlign, nil
+	case "ITEM_COLOR":
+		return RichTextLabelItemColor, nil
+	case "ITEM_FONT":
+		return RichTextLabelItemFont, nil
+	case "ITEM_FRAME":
+		return RichTextLabelItemFrame, nil
+	case "ITEM_IMAGE":
+		return RichTextLabelItemImage, nil
+	case "ITEM_INDENT":
+		return RichTextLabelItemIndent, nil
+	case "ITEM_LIST":
+		return RichTextLabelItemList, nil
+	case "ITEM_META":
+		return RichTextLabelItemMeta, nil
+	case "ITEM_NEWLINE":
+		return RichTextLabelItemNewline, nil
+	case "ITEM_TABLE":
+		return RichTextLabelItemTable, nil
+	case "ITEM_TEXT":
+		return RichTextLabelItemText, nil
+	case "ITEM_UNDERLINE":
+		return RichTextLabelItemUnderline, nil
+	}
+	return 0, fmt.Errorf("invalid RichTextLabelItemType value %q", name)
+}
+
 // RichTextLabelListType is an enum for ListType values.
 type RichTextLabelListType int
 
@@ -50,40 +145,123 @@ const (
 	RichTextLabelListNumbers RichTextLabelListType = 0
 )
 
-//func NewRichTextLabelFromPointer(ptr gdnative.Pointer) RichTextLabel {
-func newRichTextLabelFromPointer(ptr gdnative.Pointer) RichTextLabel {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RichTextLabel{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the RichTextLabelListType value.
+func (e RichTextLabelListType) String() string {
+	switch e {
+	case RichTextLabelListDots:
+		return "LIST_DOTS"
+	case RichTextLabelListLetters:
+		return "LIST_LETTERS"
+	case RichTextLabelListNumbers:
+		return "LIST_NUMBERS"
+	}
+	return fmt.Sprintf("RichTextLabelListType(%d)", int(e))
+}
 
-	return obj
+// ParseRichTextLabelListType will return the RichTextLabelListType value with the given Godot name.
+func ParseRichTextLabelListType(name string) (RichTextLabelListType, error) {
+	switch name {
+	case "LIST_DOTS":
+		return RichTextLabelListDots, nil
+	case "LIST_LETTERS":
+		return RichTextLabelListLetters, nil
+	case "LIST_NUMBERS":
+		return RichTextLabelListNumbers, nil
+	}
+	return 0, fmt.Errorf("invalid RichTextLabelListType value %q", name)
 }
 
 /*
 Rich text can contain custom text, fonts, images and some basic formatting. The label manages these as an internal tag stack. It also adapts itself to given width/heights. Note that assignments to [member bbcode_text] clear the tag stack and reconstruct it from the property's contents. Any edits made to [member bbcode_text] will erase previous edits made from other manual sources such as [method append_bbcode] and the [code]push_*[/code] / [method pop] methods.
 */
 type RichTextLabel struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorRichTextLabel is the class constructor of the RichTextLabel class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRichTextLabel = gdnative.NewLazyClassConstructor("RichTextLabel")
+
+// NewRichTextLabel will create a new instance of the RichTextLabel class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewRichTextLabel() *RichTextLabel {
+	obj := &RichTextLabel{}
+	obj.SetBaseObject(classConstructorRichTextLabel.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RichTextLabel) BaseClass() string {
 	return "RichTextLabel"
 }
 
+// Method binds of the RichTextLabel class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRichTextLabelX_GuiInput                    = gdnative.NewLazyMethodBind("RichTextLabel", "_gui_input")
+	methodBindRichTextLabelX_ScrollChanged               = gdnative.NewLazyMethodBind("RichTextLabel", "_scroll_changed")
+	methodBindRichTextLabelAddImage                      = gdnative.NewLazyMethodBind("RichTextLabel", "add_image")
+	methodBindRichTextLabelAddText                       = gdnative.NewLazyMethodBind("RichTextLabel", "add_text")
+	methodBindRichTextLabelAppendBbcode                  = gdnative.NewLazyMethodBind("RichTextLabel", "append_bbcode")
+	methodBindRichTextLabelClear                         = gdnative.NewLazyMethodBind("RichTextLabel", "clear")
+	methodBindRichTextLabelGetBbcode                     = gdnative.NewLazyMethodBind("RichTextLabel", "get_bbcode")
+	methodBindRichTextLabelGetLineCount                  = gdnative.NewLazyMethodBind("RichTextLabel", "get_line_count")
+	methodBindRichTextLabelGetPercentVisible             = gdnative.NewLazyMethodBind("RichTextLabel", "get_percent_visible")
+	methodBindRichTextLabelGetTabSize                    = gdnative.NewLazyMethodBind("RichTextLabel", "get_tab_size")
+	methodBindRichTextLabelGetText                       = gdnative.NewLazyMethodBind("RichTextLabel", "get_text")
+	methodBindRichTextLabelGetTotalCharacterCount        = gdnative.NewLazyMethodBind("RichTextLabel", "get_total_character_count")
+	methodBindRichTextLabelGetVScroll                    = gdnative.NewLazyMethodBind("RichTextLabel", "get_v_scroll")
+	methodBindRichTextLabelGetVisibleCharacters          = gdnative.NewLazyMethodBind("RichTextLabel", "get_visible_characters")
+	methodBindRichTextLabelGetVisibleLineCount           = gdnative.NewLazyMethodBind("RichTextLabel", "get_visible_line_count")
+	methodBindRichTextLabelIsMetaUnderlined              = gdnative.NewLazyMethodBind("RichTextLabel", "is_meta_underlined")
+	methodBindRichTextLabelIsOverridingSelectedFontColor = gdnative.NewLazyMethodBind("RichTextLabel", "is_overriding_selected_font_color")
+	methodBindRichTextLabelIsScrollActive                = gdnative.NewLazyMethodBind("RichTextLabel", "is_scroll_active")
+	methodBindRichTextLabelIsScrollFollowing             = gdnative.NewLazyMethodBind("RichTextLabel", "is_scroll_following")
+	methodBindRichTextLabelIsSelectionEnabled            = gdnative.NewLazyMethodBind("RichTextLabel", "is_selection_enabled")
+	methodBindRichTextLabelIsUsingBbcode                 = gdnative.NewLazyMethodBind("RichTextLabel", "is_using_bbcode")
+	methodBindRichTextLabelNewline                       = gdnative.NewLazyMethodBind("RichTextLabel", "newline")
+	methodBindRichTextLabelParseBbcode                   = gdnative.NewLazyMethodBind("RichTextLabel", "parse_bbcode")
+	methodBindRichTextLabelPop                           = gdnative.NewLazyMethodBind("RichTextLabel", "pop")
+	methodBindRichTextLabelPushAlign                     = gdnative.NewLazyMethodBind("RichTextLabel", "push_align")
+	methodBindRichTextLabelPushCell                      = gdnative.NewLazyMethodBind("RichTextLabel", "push_cell")
+	methodBindRichTextLabelPushColor                     = gdnative.NewLazyMethodBind("RichTextLabel", "push_color")
+	methodBindRichTextLabelPushFont                      = gdnative.NewLazyMethodBind("RichTextLabel", "push_font")
+	methodBindRichTextLabelPushIndent                    = gdnative.NewLazyMethodBind("RichTextLabel", "push_indent")
+	methodBindRichTextLabelPushList                      = gdnative.NewLazyMethodBind("RichTextLabel", "push_list")
+	methodBindRichTextLabelPushMeta                      = gdnative.NewLazyMethodBind("RichTextLabel", "push_meta")
+	methodBindRichTextLabelPushTable                     = gdnative.NewLazyMethodBind("RichTextLabel", "push_table")
+	methodBindRichTextLabelPushUnderline                 = gdnative.NewLazyMethodBind("RichTextLabel", "push_underline")
+	methodBindRichTextLabelRemoveLine                    = gdnative.NewLazyMethodBind("RichTextLabel", "remove_line")
+	methodBindRichTextLabelScrollToLine                  = gdnative.NewLazyMethodBind("RichTextLabel", "scroll_to_line")
+	methodBindRichTextLabelSetBbcode                     = gdnative.NewLazyMethodBind("RichTextLabel", "set_bbcode")
+	methodBindRichTextLabelSetMetaUnderline              = gdnative.NewLazyMethodBind("RichTextLabel", "set_meta_underline")
+	methodBindRichTextLabelSetOverrideSelectedFontColor  = gdnative.NewLazyMethodBind("RichTextLabel", "set_override_selected_font_color")
+	methodBindRichTextLabelSetPercentVisible             = gdnative.NewLazyMethodBind("RichTextLabel", "set_percent_visible")
+	methodBindRichTextLabelSetScrollActive               = gdnative.NewLazyMethodBind("RichTextLabel", "set_scroll_active")
+	methodBindRichTextLabelSetScrollFollow               = gdnative.NewLazyMethodBind("RichTextLabel", "set_scroll_follow")
+	methodBindRichTextLabelSetSelectionEnabled           = gdnative.NewLazyMethodBind("RichTextLabel", "set_selection_enabled")
+	methodBindRichTextLabelSetTabSize                    = gdnative.NewLazyMethodBind("RichTextLabel", "set_tab_size")
+	methodBindRichTextLabelSetTableColumnExpand          = gdnative.NewLazyMethodBind("RichTextLabel", "set_table_column_expand")
+	methodBindRichTextLabelSetText                       = gdnative.NewLazyMethodBind("RichTextLabel", "set_text")
+	methodBindRichTextLabelSetUseBbcode                  = gdnative.NewLazyMethodBind("RichTextLabel", "set_use_bbcode")
+	methodBindRichTextLabelSetVisibleCharacters          = gdnative.NewLazyMethodBind("RichTextLabel", "set_visible_characters")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *RichTextLabel) X_GuiInput(arg0 InputEventImplementer) {
+func (o *RichTextLabel) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling RichTextLabel.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "_gui_input")
+	methodBind := methodBindRichTextLabelX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -93,8 +271,8 @@ func (o *RichTextLabel) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *RichTextLabel) X_ScrollChanged(arg0 gdnative.Real) {
 	//log.Println("Calling RichTextLabel.X_ScrollChanged()")
@@ -104,7 +282,7 @@ func (o *RichTextLabel) X_ScrollChanged(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "_scroll_changed")
+	methodBind := methodBindRichTextLabelX_ScrollChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -114,18 +292,18 @@ func (o *RichTextLabel) X_ScrollChanged(arg0 gdnative.Real) {
 }
 
 /*
-        Adds an image's opening and closing tags to the tag stack.
-	Args: [{ false image Texture}], Returns: void
+	        Adds an image's opening and closing tags to the tag stack.
+		Args: [{ false image Texture}], Returns: void
 */
-func (o *RichTextLabel) AddImage(image TextureImplementer) {
+func (o *RichTextLabel) AddImage(image godot.TextureImplementer) {
 	//log.Println("Calling RichTextLabel.AddImage()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(image.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "add_image")
+	methodBind := methodBindRichTextLabelAddImage.Get()
 
 	// Call the parent method.
 	// void
@@ -135,8 +313,8 @@ func (o *RichTextLabel) AddImage(image TextureImplementer) {
 }
 
 /*
-        Adds raw non-bbcode-parsed text to the tag stack.
-	Args: [{ false text String}], Returns: void
+	        Adds raw non-bbcode-parsed text to the tag stack.
+		Args: [{ false text String}], Returns: void
 */
 func (o *RichTextLabel) AddText(text gdnative.String) {
 	//log.Println("Calling RichTextLabel.AddText()")
@@ -146,7 +324,7 @@ func (o *RichTextLabel) AddText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "add_text")
+	methodBind := methodBindRichTextLabelAddText.Get()
 
 	// Call the parent method.
 	// void
@@ -156,8 +334,8 @@ func (o *RichTextLabel) AddText(text gdnative.String) {
 }
 
 /*
-        Parses [code]bbcode[/code] and adds tags to the tag stack as needed. Returns the result of the parsing, [code]OK[/code] if successful.
-	Args: [{ false bbcode String}], Returns: enum.Error
+	        Parses [code]bbcode[/code] and adds tags to the tag stack as needed. Returns the result of the parsing, [code]OK[/code] if successful.
+		Args: [{ false bbcode String}], Returns: enum.Error
 */
 func (o *RichTextLabel) AppendBbcode(bbcode gdnative.String) gdnative.Error {
 	//log.Println("Calling RichTextLabel.AppendBbcode()")
@@ -167,7 +345,7 @@ func (o *RichTextLabel) AppendBbcode(bbcode gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(bbcode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "append_bbcode")
+	methodBind := methodBindRichTextLabelAppendBbcode.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -180,8 +358,8 @@ func (o *RichTextLabel) AppendBbcode(bbcode gdnative.String) gdnative.Error {
 }
 
 /*
-        Clears the tag stack and sets [member bbcode_text] to an empty string.
-	Args: [], Returns: void
+	        Clears the tag stack and sets [member bbcode_text] to an empty string.
+		Args: [], Returns: void
 */
 func (o *RichTextLabel) Clear() {
 	//log.Println("Calling RichTextLabel.Clear()")
@@ -190,7 +368,7 @@ func (o *RichTextLabel) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "clear")
+	methodBind := methodBindRichTextLabelClear.Get()
 
 	// Call the parent method.
 	// void
@@ -200,8 +378,8 @@ func (o *RichTextLabel) Clear() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *RichTextLabel) GetBbcode() gdnative.String {
 	//log.Println("Calling RichTextLabel.GetBbcode()")
@@ -210,7 +388,7 @@ func (o *RichTextLabel) GetBbcode() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_bbcode")
+	methodBind := methodBindRichTextLabelGetBbcode.Get()
 
 	// Call the parent method.
 	// String
@@ -223,8 +401,8 @@ func (o *RichTextLabel) GetBbcode() gdnative.String {
 }
 
 /*
-        Returns the total number of newlines in the tag stack's text tags. Considers wrapped text as one line.
-	Args: [], Returns: int
+	        Returns the total number of newlines in the tag stack's text tags. Considers wrapped text as one line.
+		Args: [], Returns: int
 */
 func (o *RichTextLabel) GetLineCount() gdnative.Int {
 	//log.Println("Calling RichTextLabel.GetLineCount()")
@@ -233,7 +411,7 @@ func (o *RichTextLabel) GetLineCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_line_count")
+	methodBind := methodBindRichTextLabelGetLineCount.Get()
 
 	// Call the parent method.
 	// int
@@ -246,8 +424,8 @@ func (o *RichTextLabel) GetLineCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *RichTextLabel) GetPercentVisible() gdnative.Real {
 	//log.Println("Calling RichTextLabel.GetPercentVisible()")
@@ -256,7 +434,7 @@ func (o *RichTextLabel) GetPercentVisible() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_percent_visible")
+	methodBind := methodBindRichTextLabelGetPercentVisible.Get()
 
 	// Call the parent method.
 	// float
@@ -269,8 +447,8 @@ func (o *RichTextLabel) GetPercentVisible() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *RichTextLabel) GetTabSize() gdnative.Int {
 	//log.Println("Calling RichTextLabel.GetTabSize()")
@@ -279,7 +457,7 @@ func (o *RichTextLabel) GetTabSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_tab_size")
+	methodBind := methodBindRichTextLabelGetTabSize.Get()
 
 	// Call the parent method.
 	// int
@@ -292,8 +470,8 @@ func (o *RichTextLabel) GetTabSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *RichTextLabel) GetText() gdnative.String {
 	//log.Println("Calling RichTextLabel.GetText()")
@@ -302,7 +480,7 @@ func (o *RichTextLabel) GetText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_text")
+	methodBind := methodBindRichTextLabelGetText.Get()
 
 	// Call the parent method.
 	// String
@@ -315,8 +493,8 @@ func (o *RichTextLabel) GetText() gdnative.String {
 }
 
 /*
-        Returns the total number of characters from text tags. Does not include bbcodes.
-	Args: [], Returns: int
+	        Returns the total number of characters from text tags. Does not include bbcodes.
+		Args: [], Returns: int
 */
 func (o *RichTextLabel) GetTotalCharacterCount() gdnative.Int {
 	//log.Println("Calling RichTextLabel.GetTotalCharacterCount()")
@@ -325,7 +503,7 @@ func (o *RichTextLabel) GetTotalCharacterCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_total_character_count")
+	methodBind := methodBindRichTextLabelGetTotalCharacterCount.Get()
 
 	// Call the parent method.
 	// int
@@ -338,8 +516,8 @@ func (o *RichTextLabel) GetTotalCharacterCount() gdnative.Int {
 }
 
 /*
-        Returns the vertical scrollbar.
-	Args: [], Returns: VScrollBar
+	        Returns the vertical scrollbar.
+		Args: [], Returns: VScrollBar
 */
 func (o *RichTextLabel) GetVScroll() VScrollBarImplementer {
 	//log.Println("Calling RichTextLabel.GetVScroll()")
@@ -348,7 +526,7 @@ func (o *RichTextLabel) GetVScroll() VScrollBarImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_v_scroll")
+	methodBind := methodBindRichTextLabelGetVScroll.Get()
 
 	// Call the parent method.
 	// VScrollBar
@@ -356,27 +534,25 @@ func (o *RichTextLabel) GetVScroll() VScrollBarImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newVScrollBarFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(VScrollBarImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "VScrollBar" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(VScrollBarImplementer)
+	ret := VScrollBar{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(VScrollBarImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *RichTextLabel) GetVisibleCharacters() gdnative.Int {
 	//log.Println("Calling RichTextLabel.GetVisibleCharacters()")
@@ -385,7 +561,7 @@ func (o *RichTextLabel) GetVisibleCharacters() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_visible_characters")
+	methodBind := methodBindRichTextLabelGetVisibleCharacters.Get()
 
 	// Call the parent method.
 	// int
@@ -398,8 +574,8 @@ func (o *RichTextLabel) GetVisibleCharacters() gdnative.Int {
 }
 
 /*
-        Returns the number of visible lines.
-	Args: [], Returns: int
+	        Returns the number of visible lines.
+		Args: [], Returns: int
 */
 func (o *RichTextLabel) GetVisibleLineCount() gdnative.Int {
 	//log.Println("Calling RichTextLabel.GetVisibleLineCount()")
@@ -408,7 +584,7 @@ func (o *RichTextLabel) GetVisibleLineCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "get_visible_line_count")
+	methodBind := methodBindRichTextLabelGetVisibleLineCount.Get()
 
 	// Call the parent method.
 	// int
@@ -421,8 +597,8 @@ func (o *RichTextLabel) GetVisibleLineCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RichTextLabel) IsMetaUnderlined() gdnative.Bool {
 	//log.Println("Calling RichTextLabel.IsMetaUnderlined()")
@@ -431,7 +607,7 @@ func (o *RichTextLabel) IsMetaUnderlined() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "is_meta_underlined")
+	methodBind := methodBindRichTextLabelIsMetaUnderlined.Get()
 
 	// Call the parent method.
 	// bool
@@ -444,8 +620,8 @@ func (o *RichTextLabel) IsMetaUnderlined() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RichTextLabel) IsOverridingSelectedFontColor() gdnative.Bool {
 	//log.Println("Calling RichTextLabel.IsOverridingSelectedFontColor()")
@@ -454,7 +630,7 @@ func (o *RichTextLabel) IsOverridingSelectedFontColor() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "is_overriding_selected_font_color")
+	methodBind := methodBindRichTextLabelIsOverridingSelectedFontColor.Get()
 
 	// Call the parent method.
 	// bool
@@ -467,8 +643,8 @@ func (o *RichTextLabel) IsOverridingSelectedFontColor() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RichTextLabel) IsScrollActive() gdnative.Bool {
 	//log.Println("Calling RichTextLabel.IsScrollActive()")
@@ -477,7 +653,7 @@ func (o *RichTextLabel) IsScrollActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "is_scroll_active")
+	methodBind := methodBindRichTextLabelIsScrollActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -490,8 +666,8 @@ func (o *RichTextLabel) IsScrollActive() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RichTextLabel) IsScrollFollowing() gdnative.Bool {
 	//log.Println("Calling RichTextLabel.IsScrollFollowing()")
@@ -500,7 +676,7 @@ func (o *RichTextLabel) IsScrollFollowing() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "is_scroll_following")
+	methodBind := methodBindRichTextLabelIsScrollFollowing.Get()
 
 	// Call the parent method.
 	// bool
@@ -513,8 +689,8 @@ func (o *RichTextLabel) IsScrollFollowing() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RichTextLabel) IsSelectionEnabled() gdnative.Bool {
 	//log.Println("Calling RichTextLabel.IsSelectionEnabled()")
@@ -523,7 +699,7 @@ func (o *RichTextLabel) IsSelectionEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "is_selection_enabled")
+	methodBind := methodBindRichTextLabelIsSelectionEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -536,8 +712,8 @@ func (o *RichTextLabel) IsSelectionEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RichTextLabel) IsUsingBbcode() gdnative.Bool {
 	//log.Println("Calling RichTextLabel.IsUsingBbcode()")
@@ -546,7 +722,7 @@ func (o *RichTextLabel) IsUsingBbcode() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "is_using_bbcode")
+	methodBind := methodBindRichTextLabelIsUsingBbcode.Get()
 
 	// Call the parent method.
 	// bool
@@ -559,8 +735,8 @@ func (o *RichTextLabel) IsUsingBbcode() gdnative.Bool {
 }
 
 /*
-        Adds a newline tag to the tag stack.
-	Args: [], Returns: void
+	        Adds a newline tag to the tag stack.
+		Args: [], Returns: void
 */
 func (o *RichTextLabel) Newline() {
 	//log.Println("Calling RichTextLabel.Newline()")
@@ -569,7 +745,7 @@ func (o *RichTextLabel) Newline() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "newline")
+	methodBind := methodBindRichTextLabelNewline.Get()
 
 	// Call the parent method.
 	// void
@@ -579,8 +755,8 @@ func (o *RichTextLabel) Newline() {
 }
 
 /*
-        The assignment version of [method append_bbcode]. Clears the tag stack and inserts the new content. Returns [code]OK[/code] if parses [code]bbcode[/code] successfully.
-	Args: [{ false bbcode String}], Returns: enum.Error
+	        The assignment version of [method append_bbcode]. Clears the tag stack and inserts the new content. Returns [code]OK[/code] if parses [code]bbcode[/code] successfully.
+		Args: [{ false bbcode String}], Returns: enum.Error
 */
 func (o *RichTextLabel) ParseBbcode(bbcode gdnative.String) gdnative.Error {
 	//log.Println("Calling RichTextLabel.ParseBbcode()")
@@ -590,7 +766,7 @@ func (o *RichTextLabel) ParseBbcode(bbcode gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(bbcode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "parse_bbcode")
+	methodBind := methodBindRichTextLabelParseBbcode.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -603,8 +779,8 @@ func (o *RichTextLabel) ParseBbcode(bbcode gdnative.String) gdnative.Error {
 }
 
 /*
-        Terminates the current tag. Use after [code]push_*[/code] methods to close bbcodes manually. Does not need to follow [code]add_*[/code] methods.
-	Args: [], Returns: void
+	        Terminates the current tag. Use after [code]push_*[/code] methods to close bbcodes manually. Does not need to follow [code]add_*[/code] methods.
+		Args: [], Returns: void
 */
 func (o *RichTextLabel) Pop() {
 	//log.Println("Calling RichTextLabel.Pop()")
@@ -613,7 +789,7 @@ func (o *RichTextLabel) Pop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "pop")
+	methodBind := methodBindRichTextLabelPop.Get()
 
 	// Call the parent method.
 	// void
@@ -623,18 +799,18 @@ func (o *RichTextLabel) Pop() {
 }
 
 /*
-        Adds a [code][right][/code] tag to the tag stack.
-	Args: [{ false align int}], Returns: void
+	        Adds a [code][right][/code] tag to the tag stack.
+		Args: [{ false align enum.RichTextLabel::Align}], Returns: void
 */
-func (o *RichTextLabel) PushAlign(align gdnative.Int) {
+func (o *RichTextLabel) PushAlign(align RichTextLabelAlign) {
 	//log.Println("Calling RichTextLabel.PushAlign()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(align)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(align))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_align")
+	methodBind := methodBindRichTextLabelPushAlign.Get()
 
 	// Call the parent method.
 	// void
@@ -644,8 +820,8 @@ func (o *RichTextLabel) PushAlign(align gdnative.Int) {
 }
 
 /*
-        Adds a [code][cell][/code] tag to the tag stack. Must be inside a [table] tag. See [method push_table] for details.
-	Args: [], Returns: void
+	        Adds a [code][cell][/code] tag to the tag stack. Must be inside a [table] tag. See [method push_table] for details.
+		Args: [], Returns: void
 */
 func (o *RichTextLabel) PushCell() {
 	//log.Println("Calling RichTextLabel.PushCell()")
@@ -654,7 +830,7 @@ func (o *RichTextLabel) PushCell() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_cell")
+	methodBind := methodBindRichTextLabelPushCell.Get()
 
 	// Call the parent method.
 	// void
@@ -664,8 +840,8 @@ func (o *RichTextLabel) PushCell() {
 }
 
 /*
-        Adds a [code][color][/code] tag to the tag stack.
-	Args: [{ false color Color}], Returns: void
+	        Adds a [code][color][/code] tag to the tag stack.
+		Args: [{ false color Color}], Returns: void
 */
 func (o *RichTextLabel) PushColor(color gdnative.Color) {
 	//log.Println("Calling RichTextLabel.PushColor()")
@@ -675,7 +851,7 @@ func (o *RichTextLabel) PushColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_color")
+	methodBind := methodBindRichTextLabelPushColor.Get()
 
 	// Call the parent method.
 	// void
@@ -685,18 +861,18 @@ func (o *RichTextLabel) PushColor(color gdnative.Color) {
 }
 
 /*
-        Adds a [code][font][/code] tag to the tag stack. Overrides default fonts for its duration.
-	Args: [{ false font Font}], Returns: void
+	        Adds a [code][font][/code] tag to the tag stack. Overrides default fonts for its duration.
+		Args: [{ false font Font}], Returns: void
 */
-func (o *RichTextLabel) PushFont(font FontImplementer) {
+func (o *RichTextLabel) PushFont(font godot.FontImplementer) {
 	//log.Println("Calling RichTextLabel.PushFont()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(font.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(font))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_font")
+	methodBind := methodBindRichTextLabelPushFont.Get()
 
 	// Call the parent method.
 	// void
@@ -706,8 +882,8 @@ func (o *RichTextLabel) PushFont(font FontImplementer) {
 }
 
 /*
-        Adds an [code][indent][/code] tag to the tag stack. Multiplies "level" by current tab_size to determine new margin length.
-	Args: [{ false level int}], Returns: void
+	        Adds an [code][indent][/code] tag to the tag stack. Multiplies "level" by current tab_size to determine new margin length.
+		Args: [{ false level int}], Returns: void
 */
 func (o *RichTextLabel) PushIndent(level gdnative.Int) {
 	//log.Println("Calling RichTextLabel.PushIndent()")
@@ -717,7 +893,7 @@ func (o *RichTextLabel) PushIndent(level gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(level)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_indent")
+	methodBind := methodBindRichTextLabelPushIndent.Get()
 
 	// Call the parent method.
 	// void
@@ -727,8 +903,8 @@ func (o *RichTextLabel) PushIndent(level gdnative.Int) {
 }
 
 /*
-        Adds a list tag to the tag stack. Similar to the bbcodes [code][ol][/code] or [code][ul][/code], but supports more list types. Not fully implemented!
-	Args: [{ false type int}], Returns: void
+	        Adds a list tag to the tag stack. Similar to the bbcodes [code][ol][/code] or [code][ul][/code], but supports more list types. Not fully implemented!
+		Args: [{ false type int}], Returns: void
 */
 func (o *RichTextLabel) PushList(aType gdnative.Int) {
 	//log.Println("Calling RichTextLabel.PushList()")
@@ -738,7 +914,7 @@ func (o *RichTextLabel) PushList(aType gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_list")
+	methodBind := methodBindRichTextLabelPushList.Get()
 
 	// Call the parent method.
 	// void
@@ -748,8 +924,8 @@ func (o *RichTextLabel) PushList(aType gdnative.Int) {
 }
 
 /*
-        Adds a meta tag to the tag stack. Similar to the bbcode [code][url=something]{text}[/url][/code], but supports non-[String] metadata types.
-	Args: [{ false data Variant}], Returns: void
+	        Adds a meta tag to the tag stack. Similar to the bbcode [code][url=something]{text}[/url][/code], but supports non-[String] metadata types.
+		Args: [{ false data Variant}], Returns: void
 */
 func (o *RichTextLabel) PushMeta(data gdnative.Variant) {
 	//log.Println("Calling RichTextLabel.PushMeta()")
@@ -759,7 +935,7 @@ func (o *RichTextLabel) PushMeta(data gdnative.Variant) {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_meta")
+	methodBind := methodBindRichTextLabelPushMeta.Get()
 
 	// Call the parent method.
 	// void
@@ -769,8 +945,8 @@ func (o *RichTextLabel) PushMeta(data gdnative.Variant) {
 }
 
 /*
-        Adds a [code][table=columns][/code] tag to the tag stack.
-	Args: [{ false columns int}], Returns: void
+	        Adds a [code][table=columns][/code] tag to the tag stack.
+		Args: [{ false columns int}], Returns: void
 */
 func (o *RichTextLabel) PushTable(columns gdnative.Int) {
 	//log.Println("Calling RichTextLabel.PushTable()")
@@ -780,7 +956,7 @@ func (o *RichTextLabel) PushTable(columns gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(columns)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_table")
+	methodBind := methodBindRichTextLabelPushTable.Get()
 
 	// Call the parent method.
 	// void
@@ -790,8 +966,8 @@ func (o *RichTextLabel) PushTable(columns gdnative.Int) {
 }
 
 /*
-        Adds a [code][u][/code] tag to the tag stack.
-	Args: [], Returns: void
+	        Adds a [code][u][/code] tag to the tag stack.
+		Args: [], Returns: void
 */
 func (o *RichTextLabel) PushUnderline() {
 	//log.Println("Calling RichTextLabel.PushUnderline()")
@@ -800,7 +976,7 @@ func (o *RichTextLabel) PushUnderline() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "push_underline")
+	methodBind := methodBindRichTextLabelPushUnderline.Get()
 
 	// Call the parent method.
 	// void
@@ -810,8 +986,8 @@ func (o *RichTextLabel) PushUnderline() {
 }
 
 /*
-        Removes a line of content from the label. Returns [code]true[/code] if the line exists.
-	Args: [{ false line int}], Returns: bool
+	        Removes a line of content from the label. Returns [code]true[/code] if the line exists.
+		Args: [{ false line int}], Returns: bool
 */
 func (o *RichTextLabel) RemoveLine(line gdnative.Int) gdnative.Bool {
 	//log.Println("Calling RichTextLabel.RemoveLine()")
@@ -821,7 +997,7 @@ func (o *RichTextLabel) RemoveLine(line gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "remove_line")
+	methodBind := methodBindRichTextLabelRemoveLine.Get()
 
 	// Call the parent method.
 	// bool
@@ -834,8 +1010,8 @@ func (o *RichTextLabel) RemoveLine(line gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Scrolls the window's top line to match [code]line[/code].
-	Args: [{ false line int}], Returns: void
+	        Scrolls the window's top line to match [code]line[/code].
+		Args: [{ false line int}], Returns: void
 */
 func (o *RichTextLabel) ScrollToLine(line gdnative.Int) {
 	//log.Println("Calling RichTextLabel.ScrollToLine()")
@@ -845,7 +1021,7 @@ func (o *RichTextLabel) ScrollToLine(line gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "scroll_to_line")
+	methodBind := methodBindRichTextLabelScrollToLine.Get()
 
 	// Call the parent method.
 	// void
@@ -855,8 +1031,8 @@ func (o *RichTextLabel) ScrollToLine(line gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *RichTextLabel) SetBbcode(text gdnative.String) {
 	//log.Println("Calling RichTextLabel.SetBbcode()")
@@ -866,7 +1042,7 @@ func (o *RichTextLabel) SetBbcode(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_bbcode")
+	methodBind := methodBindRichTextLabelSetBbcode.Get()
 
 	// Call the parent method.
 	// void
@@ -876,8 +1052,8 @@ func (o *RichTextLabel) SetBbcode(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *RichTextLabel) SetMetaUnderline(enable gdnative.Bool) {
 	//log.Println("Calling RichTextLabel.SetMetaUnderline()")
@@ -887,7 +1063,7 @@ func (o *RichTextLabel) SetMetaUnderline(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_meta_underline")
+	methodBind := methodBindRichTextLabelSetMetaUnderline.Get()
 
 	// Call the parent method.
 	// void
@@ -897,8 +1073,8 @@ func (o *RichTextLabel) SetMetaUnderline(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false override bool}], Returns: void
+	        Undocumented
+		Args: [{ false override bool}], Returns: void
 */
 func (o *RichTextLabel) SetOverrideSelectedFontColor(override gdnative.Bool) {
 	//log.Println("Calling RichTextLabel.SetOverrideSelectedFontColor()")
@@ -908,7 +1084,7 @@ func (o *RichTextLabel) SetOverrideSelectedFontColor(override gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(override)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_override_selected_font_color")
+	methodBind := methodBindRichTextLabelSetOverrideSelectedFontColor.Get()
 
 	// Call the parent method.
 	// void
@@ -918,8 +1094,8 @@ func (o *RichTextLabel) SetOverrideSelectedFontColor(override gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false percent_visible float}], Returns: void
+	        Undocumented
+		Args: [{ false percent_visible float}], Returns: void
 */
 func (o *RichTextLabel) SetPercentVisible(percentVisible gdnative.Real) {
 	//log.Println("Calling RichTextLabel.SetPercentVisible()")
@@ -929,7 +1105,7 @@ func (o *RichTextLabel) SetPercentVisible(percentVisible gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(percentVisible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_percent_visible")
+	methodBind := methodBindRichTextLabelSetPercentVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -939,8 +1115,8 @@ func (o *RichTextLabel) SetPercentVisible(percentVisible gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false active bool}], Returns: void
+	        Undocumented
+		Args: [{ false active bool}], Returns: void
 */
 func (o *RichTextLabel) SetScrollActive(active gdnative.Bool) {
 	//log.Println("Calling RichTextLabel.SetScrollActive()")
@@ -950,7 +1126,7 @@ func (o *RichTextLabel) SetScrollActive(active gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(active)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_scroll_active")
+	methodBind := methodBindRichTextLabelSetScrollActive.Get()
 
 	// Call the parent method.
 	// void
@@ -960,8 +1136,8 @@ func (o *RichTextLabel) SetScrollActive(active gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false follow bool}], Returns: void
+	        Undocumented
+		Args: [{ false follow bool}], Returns: void
 */
 func (o *RichTextLabel) SetScrollFollow(follow gdnative.Bool) {
 	//log.Println("Calling RichTextLabel.SetScrollFollow()")
@@ -971,7 +1147,7 @@ func (o *RichTextLabel) SetScrollFollow(follow gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(follow)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_scroll_follow")
+	methodBind := methodBindRichTextLabelSetScrollFollow.Get()
 
 	// Call the parent method.
 	// void
@@ -981,8 +1157,8 @@ func (o *RichTextLabel) SetScrollFollow(follow gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *RichTextLabel) SetSelectionEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling RichTextLabel.SetSelectionEnabled()")
@@ -992,7 +1168,7 @@ func (o *RichTextLabel) SetSelectionEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_selection_enabled")
+	methodBind := methodBindRichTextLabelSetSelectionEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1002,8 +1178,8 @@ func (o *RichTextLabel) SetSelectionEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false spaces int}], Returns: void
+	        Undocumented
+		Args: [{ false spaces int}], Returns: void
 */
 func (o *RichTextLabel) SetTabSize(spaces gdnative.Int) {
 	//log.Println("Calling RichTextLabel.SetTabSize()")
@@ -1013,7 +1189,7 @@ func (o *RichTextLabel) SetTabSize(spaces gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(spaces)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_tab_size")
+	methodBind := methodBindRichTextLabelSetTabSize.Get()
 
 	// Call the parent method.
 	// void
@@ -1023,8 +1199,8 @@ func (o *RichTextLabel) SetTabSize(spaces gdnative.Int) {
 }
 
 /*
-        Edits the selected columns expansion options. If [code]expand[/code] is [code]true[/code], the column expands in proportion to its expansion ratio versus the other columns' ratios. For example, 2 columns with ratios 3 and 4 plus 70 pixels in available width would expand 30 and 40 pixels, respectively. Columns with a [code]false[/code] expand will not contribute to the total ratio.
-	Args: [{ false column int} { false expand bool} { false ratio int}], Returns: void
+	        Edits the selected columns expansion options. If [code]expand[/code] is [code]true[/code], the column expands in proportion to its expansion ratio versus the other columns' ratios. For example, 2 columns with ratios 3 and 4 plus 70 pixels in available width would expand 30 and 40 pixels, respectively. Columns with a [code]false[/code] expand will not contribute to the total ratio.
+		Args: [{ false column int} { false expand bool} { false ratio int}], Returns: void
 */
 func (o *RichTextLabel) SetTableColumnExpand(column gdnative.Int, expand gdnative.Bool, ratio gdnative.Int) {
 	//log.Println("Calling RichTextLabel.SetTableColumnExpand()")
@@ -1036,7 +1212,7 @@ func (o *RichTextLabel) SetTableColumnExpand(column gdnative.Int, expand gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromInt(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_table_column_expand")
+	methodBind := methodBindRichTextLabelSetTableColumnExpand.Get()
 
 	// Call the parent method.
 	// void
@@ -1046,8 +1222,8 @@ func (o *RichTextLabel) SetTableColumnExpand(column gdnative.Int, expand gdnativ
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *RichTextLabel) SetText(text gdnative.String) {
 	//log.Println("Calling RichTextLabel.SetText()")
@@ -1057,7 +1233,7 @@ func (o *RichTextLabel) SetText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_text")
+	methodBind := methodBindRichTextLabelSetText.Get()
 
 	// Call the parent method.
 	// void
@@ -1067,8 +1243,8 @@ func (o *RichTextLabel) SetText(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *RichTextLabel) SetUseBbcode(enable gdnative.Bool) {
 	//log.Println("Calling RichTextLabel.SetUseBbcode()")
@@ -1078,7 +1254,7 @@ func (o *RichTextLabel) SetUseBbcode(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_use_bbcode")
+	methodBind := methodBindRichTextLabelSetUseBbcode.Get()
 
 	// Call the parent method.
 	// void
@@ -1088,8 +1264,8 @@ func (o *RichTextLabel) SetUseBbcode(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *RichTextLabel) SetVisibleCharacters(amount gdnative.Int) {
 	//log.Println("Calling RichTextLabel.SetVisibleCharacters()")
@@ -1099,7 +1275,7 @@ func (o *RichTextLabel) SetVisibleCharacters(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RichTextLabel", "set_visible_characters")
+	methodBind := methodBindRichTextLabelSetVisibleCharacters.Get()
 
 	// Call the parent method.
 	// void
@@ -1108,13 +1284,165 @@ func (o *RichTextLabel) SetVisibleCharacters(amount gdnative.Int) {
 
 }
 
+// BbcodeEnabled will return the value of the "bbcode_enabled" property.
+func (o *RichTextLabel) BbcodeEnabled() gdnative.Bool {
+	return o.IsUsingBbcode()
+}
+
+// SetBbcodeEnabled will set the value of the "bbcode_enabled" property.
+func (o *RichTextLabel) SetBbcodeEnabled(value gdnative.Bool) {
+	o.SetUseBbcode(value)
+}
+
+// BbcodeText will return the value of the "bbcode_text" property.
+func (o *RichTextLabel) BbcodeText() gdnative.String {
+	return o.GetBbcode()
+}
+
+// SetBbcodeText will set the value of the "bbcode_text" property.
+func (o *RichTextLabel) SetBbcodeText(value gdnative.String) {
+	o.SetBbcode(value)
+}
+
+// MetaUnderlined will return the value of the "meta_underlined" property.
+func (o *RichTextLabel) MetaUnderlined() gdnative.Bool {
+	return o.IsMetaUnderlined()
+}
+
+// SetMetaUnderlined will set the value of the "meta_underlined" property.
+func (o *RichTextLabel) SetMetaUnderlined(value gdnative.Bool) {
+	o.SetMetaUnderline(value)
+}
+
+// OverrideSelectedFontColor will return the value of the "override_selected_font_color" property.
+func (o *RichTextLabel) OverrideSelectedFontColor() gdnative.Bool {
+	return o.IsOverridingSelectedFontColor()
+}
+
+// PercentVisible will return the value of the "percent_visible" property.
+func (o *RichTextLabel) PercentVisible() gdnative.Real {
+	return o.GetPercentVisible()
+}
+
+// ScrollActive will return the value of the "scroll_active" property.
+func (o *RichTextLabel) ScrollActive() gdnative.Bool {
+	return o.IsScrollActive()
+}
+
+// ScrollFollowing will return the value of the "scroll_following" property.
+func (o *RichTextLabel) ScrollFollowing() gdnative.Bool {
+	return o.IsScrollFollowing()
+}
+
+// SetScrollFollowing will set the value of the "scroll_following" property.
+func (o *RichTextLabel) SetScrollFollowing(value gdnative.Bool) {
+	o.SetScrollFollow(value)
+}
+
+// SelectionEnabled will return the value of the "selection_enabled" property.
+func (o *RichTextLabel) SelectionEnabled() gdnative.Bool {
+	return o.IsSelectionEnabled()
+}
+
+// TabSize will return the value of the "tab_size" property.
+func (o *RichTextLabel) TabSize() gdnative.Int {
+	return o.GetTabSize()
+}
+
+// Text will return the value of the "text" property.
+func (o *RichTextLabel) Text() gdnative.String {
+	return o.GetText()
+}
+
+// VisibleCharacters will return the value of the "visible_characters" property.
+func (o *RichTextLabel) VisibleCharacters() gdnative.Int {
+	return o.GetVisibleCharacters()
+}
+
+// Signals of the RichTextLabel class.
+const (
+	RichTextLabelSignalMetaClicked      gdnative.String = "meta_clicked"
+	RichTextLabelSignalMetaHoverEnded   gdnative.String = "meta_hover_ended"
+	RichTextLabelSignalMetaHoverStarted gdnative.String = "meta_hover_started"
+)
+
+// RichTextLabelMetaClickedArgs holds the arguments of the "meta_clicked" signal.
+type RichTextLabelMetaClickedArgs struct {
+	Meta gdnative.Variant
+}
+
+// ConnectMetaClicked will connect the "meta_clicked" signal to the given method of the target object.
+func (o *RichTextLabel) ConnectMetaClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RichTextLabelSignalMetaClicked, target, method, binds, 0)
+}
+
+// EmitMetaClicked will emit the "meta_clicked" signal.
+func (o *RichTextLabel) EmitMetaClicked(args RichTextLabelMetaClickedArgs) error {
+	arg0 := gdnative.NewVariantCopy(args.Meta)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RichTextLabelSignalMetaClicked, arg0)
+	ret.Destroy()
+	return err
+}
+
+// RichTextLabelMetaHoverEndedArgs holds the arguments of the "meta_hover_ended" signal.
+type RichTextLabelMetaHoverEndedArgs struct {
+	Meta gdnative.Variant
+}
+
+// ConnectMetaHoverEnded will connect the "meta_hover_ended" signal to the given method of the target object.
+func (o *RichTextLabel) ConnectMetaHoverEnded(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RichTextLabelSignalMetaHoverEnded, target, method, binds, 0)
+}
+
+// EmitMetaHoverEnded will emit the "meta_hover_ended" signal.
+func (o *RichTextLabel) EmitMetaHoverEnded(args RichTextLabelMetaHoverEndedArgs) error {
+	arg0 := gdnative.NewVariantCopy(args.Meta)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RichTextLabelSignalMetaHoverEnded, arg0)
+	ret.Destroy()
+	return err
+}
+
+// RichTextLabelMetaHoverStartedArgs holds the arguments of the "meta_hover_started" signal.
+type RichTextLabelMetaHoverStartedArgs struct {
+	Meta gdnative.Variant
+}
+
+// ConnectMetaHoverStarted will connect the "meta_hover_started" signal to the given method of the target object.
+func (o *RichTextLabel) ConnectMetaHoverStarted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RichTextLabelSignalMetaHoverStarted, target, method, binds, 0)
+}
+
+// EmitMetaHoverStarted will emit the "meta_hover_started" signal.
+func (o *RichTextLabel) EmitMetaHoverStarted(args RichTextLabelMetaHoverStartedArgs) error {
+	arg0 := gdnative.NewVariantCopy(args.Meta)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RichTextLabelSignalMetaHoverStarted, arg0)
+	ret.Destroy()
+	return err
+}
+
 // RichTextLabelImplementer is an interface that implements the methods
 // of the RichTextLabel class.
 type RichTextLabelImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 	X_ScrollChanged(arg0 gdnative.Real)
-	AddImage(image TextureImplementer)
+	AddImage(image godot.TextureImplementer)
 	AddText(text gdnative.String)
+	AppendBbcode(bbcode gdnative.String) gdnative.Error
 	Clear()
 	GetBbcode() gdnative.String
 	GetLineCount() gdnative.Int
@@ -1132,11 +1460,12 @@ type RichTextLabelImplementer interface {
 	IsSelectionEnabled() gdnative.Bool
 	IsUsingBbcode() gdnative.Bool
 	Newline()
+	ParseBbcode(bbcode gdnative.String) gdnative.Error
 	Pop()
-	PushAlign(align gdnative.Int)
+	PushAlign(align RichTextLabelAlign)
 	PushCell()
 	PushColor(color gdnative.Color)
-	PushFont(font FontImplementer)
+	PushFont(font godot.FontImplementer)
 	PushIndent(level gdnative.Int)
 	PushList(aType gdnative.Int)
 	PushMeta(data gdnative.Variant)
@@ -1156,4 +1485,25 @@ type RichTextLabelImplementer interface {
 	SetText(text gdnative.String)
 	SetUseBbcode(enable gdnative.Bool)
 	SetVisibleCharacters(amount gdnative.Int)
+	BbcodeEnabled() gdnative.Bool
+	SetBbcodeEnabled(value gdnative.Bool)
+	BbcodeText() gdnative.String
+	SetBbcodeText(value gdnative.String)
+	MetaUnderlined() gdnative.Bool
+	SetMetaUnderlined(value gdnative.Bool)
+	OverrideSelectedFontColor() gdnative.Bool
+	PercentVisible() gdnative.Real
+	ScrollActive() gdnative.Bool
+	ScrollFollowing() gdnative.Bool
+	SetScrollFollowing(value gdnative.Bool)
+	SelectionEnabled() gdnative.Bool
+	TabSize() gdnative.Int
+	Text() gdnative.String
+	VisibleCharacters() gdnative.Int
+	ConnectMetaClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMetaClicked(args RichTextLabelMetaClickedArgs) error
+	ConnectMetaHoverEnded(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMetaHoverEnded(args RichTextLabelMetaHoverEndedArgs) error
+	ConnectMetaHoverStarted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMetaHoverStarted(args RichTextLabelMetaHoverStartedArgs) error
 }
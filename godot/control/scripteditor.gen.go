@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,17 +14,7 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewScriptEditorFromPointer(ptr gdnative.Pointer) ScriptEditor {
-func newScriptEditorFromPointer(ptr gdnative.Pointer) ScriptEditor {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ScriptEditor{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type ScriptEditor struct {
 	PanelContainer
@@ -34,21 +25,76 @@ func (o *ScriptEditor) BaseClass() string {
 	return "ScriptEditor"
 }
 
+// Method binds of the ScriptEditor class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindScriptEditorX_AddCallback                  = gdnative.NewLazyMethodBind("ScriptEditor", "_add_callback")
+	methodBindScriptEditorX_AutosaveScripts              = gdnative.NewLazyMethodBind("ScriptEditor", "_autosave_scripts")
+	methodBindScriptEditorX_Breaked                      = gdnative.NewLazyMethodBind("ScriptEditor", "_breaked")
+	methodBindScriptEditorX_CloseAllTabs                 = gdnative.NewLazyMethodBind("ScriptEditor", "_close_all_tabs")
+	methodBindScriptEditorX_CloseCurrentTab              = gdnative.NewLazyMethodBind("ScriptEditor", "_close_current_tab")
+	methodBindScriptEditorX_CloseDiscardCurrentTab       = gdnative.NewLazyMethodBind("ScriptEditor", "_close_discard_current_tab")
+	methodBindScriptEditorX_CloseDocsTab                 = gdnative.NewLazyMethodBind("ScriptEditor", "_close_docs_tab")
+	methodBindScriptEditorX_CloseOtherTabs               = gdnative.NewLazyMethodBind("ScriptEditor", "_close_other_tabs")
+	methodBindScriptEditorX_CopyScriptPath               = gdnative.NewLazyMethodBind("ScriptEditor", "_copy_script_path")
+	methodBindScriptEditorX_EditorPause                  = gdnative.NewLazyMethodBind("ScriptEditor", "_editor_pause")
+	methodBindScriptEditorX_EditorPlay                   = gdnative.NewLazyMethodBind("ScriptEditor", "_editor_play")
+	methodBindScriptEditorX_EditorSettingsChanged        = gdnative.NewLazyMethodBind("ScriptEditor", "_editor_settings_changed")
+	methodBindScriptEditorX_EditorStop                   = gdnative.NewLazyMethodBind("ScriptEditor", "_editor_stop")
+	methodBindScriptEditorX_FileDialogAction             = gdnative.NewLazyMethodBind("ScriptEditor", "_file_dialog_action")
+	methodBindScriptEditorX_GetDebugTooltip              = gdnative.NewLazyMethodBind("ScriptEditor", "_get_debug_tooltip")
+	methodBindScriptEditorX_GotoScriptLine               = gdnative.NewLazyMethodBind("ScriptEditor", "_goto_script_line")
+	methodBindScriptEditorX_GotoScriptLine2              = gdnative.NewLazyMethodBind("ScriptEditor", "_goto_script_line2")
+	methodBindScriptEditorX_HelpClassGoto                = gdnative.NewLazyMethodBind("ScriptEditor", "_help_class_goto")
+	methodBindScriptEditorX_HelpClassOpen                = gdnative.NewLazyMethodBind("ScriptEditor", "_help_class_open")
+	methodBindScriptEditorX_HelpIndex                    = gdnative.NewLazyMethodBind("ScriptEditor", "_help_index")
+	methodBindScriptEditorX_HelpOverviewSelected         = gdnative.NewLazyMethodBind("ScriptEditor", "_help_overview_selected")
+	methodBindScriptEditorX_HelpSearch                   = gdnative.NewLazyMethodBind("ScriptEditor", "_help_search")
+	methodBindScriptEditorX_HistoryBack                  = gdnative.NewLazyMethodBind("ScriptEditor", "_history_back")
+	methodBindScriptEditorX_HistoryForward               = gdnative.NewLazyMethodBind("ScriptEditor", "_history_forward")
+	methodBindScriptEditorX_LiveAutoReloadRunningScripts = gdnative.NewLazyMethodBind("ScriptEditor", "_live_auto_reload_running_scripts")
+	methodBindScriptEditorX_MembersOverviewSelected      = gdnative.NewLazyMethodBind("ScriptEditor", "_members_overview_selected")
+	methodBindScriptEditorX_MenuOption                   = gdnative.NewLazyMethodBind("ScriptEditor", "_menu_option")
+	methodBindScriptEditorX_OpenRecentScript             = gdnative.NewLazyMethodBind("ScriptEditor", "_open_recent_script")
+	methodBindScriptEditorX_ReloadScripts                = gdnative.NewLazyMethodBind("ScriptEditor", "_reload_scripts")
+	methodBindScriptEditorX_RequestHelp                  = gdnative.NewLazyMethodBind("ScriptEditor", "_request_help")
+	methodBindScriptEditorX_ResSavedCallback             = gdnative.NewLazyMethodBind("ScriptEditor", "_res_saved_callback")
+	methodBindScriptEditorX_ResaveScripts                = gdnative.NewLazyMethodBind("ScriptEditor", "_resave_scripts")
+	methodBindScriptEditorX_SaveHistory                  = gdnative.NewLazyMethodBind("ScriptEditor", "_save_history")
+	methodBindScriptEditorX_ScriptChanged                = gdnative.NewLazyMethodBind("ScriptEditor", "_script_changed")
+	methodBindScriptEditorX_ScriptCreated                = gdnative.NewLazyMethodBind("ScriptEditor", "_script_created")
+	methodBindScriptEditorX_ScriptListGuiInput           = gdnative.NewLazyMethodBind("ScriptEditor", "_script_list_gui_input")
+	methodBindScriptEditorX_ScriptSelected               = gdnative.NewLazyMethodBind("ScriptEditor", "_script_selected")
+	methodBindScriptEditorX_ScriptSplitDragged           = gdnative.NewLazyMethodBind("ScriptEditor", "_script_split_dragged")
+	methodBindScriptEditorX_ShowDebugger                 = gdnative.NewLazyMethodBind("ScriptEditor", "_show_debugger")
+	methodBindScriptEditorX_TabChanged                   = gdnative.NewLazyMethodBind("ScriptEditor", "_tab_changed")
+	methodBindScriptEditorX_TreeChanged                  = gdnative.NewLazyMethodBind("ScriptEditor", "_tree_changed")
+	methodBindScriptEditorX_UnhandledInput               = gdnative.NewLazyMethodBind("ScriptEditor", "_unhandled_input")
+	methodBindScriptEditorX_UpdateRecentScripts          = gdnative.NewLazyMethodBind("ScriptEditor", "_update_recent_scripts")
+	methodBindScriptEditorX_UpdateScriptNames            = gdnative.NewLazyMethodBind("ScriptEditor", "_update_script_names")
+	methodBindScriptEditorCanDropDataFw                  = gdnative.NewLazyMethodBind("ScriptEditor", "can_drop_data_fw")
+	methodBindScriptEditorDropDataFw                     = gdnative.NewLazyMethodBind("ScriptEditor", "drop_data_fw")
+	methodBindScriptEditorGetCurrentScript               = gdnative.NewLazyMethodBind("ScriptEditor", "get_current_script")
+	methodBindScriptEditorGetDragDataFw                  = gdnative.NewLazyMethodBind("ScriptEditor", "get_drag_data_fw")
+	methodBindScriptEditorGetOpenScripts                 = gdnative.NewLazyMethodBind("ScriptEditor", "get_open_scripts")
+	methodBindScriptEditorOpenScriptCreateDialog         = gdnative.NewLazyMethodBind("ScriptEditor", "open_script_create_dialog")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 Object} { false arg1 String} { false arg2 PoolStringArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Object} { false arg1 String} { false arg2 PoolStringArray}], Returns: void
 */
-func (o *ScriptEditor) X_AddCallback(arg0 ObjectImplementer, arg1 gdnative.String, arg2 gdnative.PoolStringArray) {
+func (o *ScriptEditor) X_AddCallback(arg0 godot.ObjectImplementer, arg1 gdnative.String, arg2 gdnative.PoolStringArray) {
 	//log.Println("Calling ScriptEditor.X_AddCallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 	ptrArguments[1] = gdnative.NewPointerFromString(arg1)
 	ptrArguments[2] = gdnative.NewPointerFromPoolStringArray(arg2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_add_callback")
+	methodBind := methodBindScriptEditorX_AddCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -58,8 +104,8 @@ func (o *ScriptEditor) X_AddCallback(arg0 ObjectImplementer, arg1 gdnative.Strin
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_AutosaveScripts() {
 	//log.Println("Calling ScriptEditor.X_AutosaveScripts()")
@@ -68,7 +114,7 @@ func (o *ScriptEditor) X_AutosaveScripts() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_autosave_scripts")
+	methodBind := methodBindScriptEditorX_AutosaveScripts.Get()
 
 	// Call the parent method.
 	// void
@@ -78,8 +124,8 @@ func (o *ScriptEditor) X_AutosaveScripts() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 bool} { false arg1 bool}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 bool} { false arg1 bool}], Returns: void
 */
 func (o *ScriptEditor) X_Breaked(arg0 gdnative.Bool, arg1 gdnative.Bool) {
 	//log.Println("Calling ScriptEditor.X_Breaked()")
@@ -90,7 +136,7 @@ func (o *ScriptEditor) X_Breaked(arg0 gdnative.Bool, arg1 gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_breaked")
+	methodBind := methodBindScriptEditorX_Breaked.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +146,8 @@ func (o *ScriptEditor) X_Breaked(arg0 gdnative.Bool, arg1 gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_CloseAllTabs() {
 	//log.Println("Calling ScriptEditor.X_CloseAllTabs()")
@@ -110,7 +156,7 @@ func (o *ScriptEditor) X_CloseAllTabs() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_close_all_tabs")
+	methodBind := methodBindScriptEditorX_CloseAllTabs.Get()
 
 	// Call the parent method.
 	// void
@@ -120,8 +166,8 @@ func (o *ScriptEditor) X_CloseAllTabs() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_CloseCurrentTab() {
 	//log.Println("Calling ScriptEditor.X_CloseCurrentTab()")
@@ -130,7 +176,7 @@ func (o *ScriptEditor) X_CloseCurrentTab() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_close_current_tab")
+	methodBind := methodBindScriptEditorX_CloseCurrentTab.Get()
 
 	// Call the parent method.
 	// void
@@ -140,8 +186,8 @@ func (o *ScriptEditor) X_CloseCurrentTab() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_CloseDiscardCurrentTab(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_CloseDiscardCurrentTab()")
@@ -151,7 +197,7 @@ func (o *ScriptEditor) X_CloseDiscardCurrentTab(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_close_discard_current_tab")
+	methodBind := methodBindScriptEditorX_CloseDiscardCurrentTab.Get()
 
 	// Call the parent method.
 	// void
@@ -161,8 +207,8 @@ func (o *ScriptEditor) X_CloseDiscardCurrentTab(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_CloseDocsTab() {
 	//log.Println("Calling ScriptEditor.X_CloseDocsTab()")
@@ -171,7 +217,7 @@ func (o *ScriptEditor) X_CloseDocsTab() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_close_docs_tab")
+	methodBind := methodBindScriptEditorX_CloseDocsTab.Get()
 
 	// Call the parent method.
 	// void
@@ -181,8 +227,8 @@ func (o *ScriptEditor) X_CloseDocsTab() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_CloseOtherTabs() {
 	//log.Println("Calling ScriptEditor.X_CloseOtherTabs()")
@@ -191,7 +237,7 @@ func (o *ScriptEditor) X_CloseOtherTabs() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_close_other_tabs")
+	methodBind := methodBindScriptEditorX_CloseOtherTabs.Get()
 
 	// Call the parent method.
 	// void
@@ -201,8 +247,8 @@ func (o *ScriptEditor) X_CloseOtherTabs() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_CopyScriptPath() {
 	//log.Println("Calling ScriptEditor.X_CopyScriptPath()")
@@ -211,7 +257,7 @@ func (o *ScriptEditor) X_CopyScriptPath() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_copy_script_path")
+	methodBind := methodBindScriptEditorX_CopyScriptPath.Get()
 
 	// Call the parent method.
 	// void
@@ -221,8 +267,8 @@ func (o *ScriptEditor) X_CopyScriptPath() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_EditorPause() {
 	//log.Println("Calling ScriptEditor.X_EditorPause()")
@@ -231,7 +277,7 @@ func (o *ScriptEditor) X_EditorPause() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_editor_pause")
+	methodBind := methodBindScriptEditorX_EditorPause.Get()
 
 	// Call the parent method.
 	// void
@@ -241,8 +287,8 @@ func (o *ScriptEditor) X_EditorPause() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_EditorPlay() {
 	//log.Println("Calling ScriptEditor.X_EditorPlay()")
@@ -251,7 +297,7 @@ func (o *ScriptEditor) X_EditorPlay() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_editor_play")
+	methodBind := methodBindScriptEditorX_EditorPlay.Get()
 
 	// Call the parent method.
 	// void
@@ -261,8 +307,8 @@ func (o *ScriptEditor) X_EditorPlay() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_EditorSettingsChanged() {
 	//log.Println("Calling ScriptEditor.X_EditorSettingsChanged()")
@@ -271,7 +317,7 @@ func (o *ScriptEditor) X_EditorSettingsChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_editor_settings_changed")
+	methodBind := methodBindScriptEditorX_EditorSettingsChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -281,8 +327,8 @@ func (o *ScriptEditor) X_EditorSettingsChanged() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_EditorStop() {
 	//log.Println("Calling ScriptEditor.X_EditorStop()")
@@ -291,7 +337,7 @@ func (o *ScriptEditor) X_EditorStop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_editor_stop")
+	methodBind := methodBindScriptEditorX_EditorStop.Get()
 
 	// Call the parent method.
 	// void
@@ -301,8 +347,8 @@ func (o *ScriptEditor) X_EditorStop() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_FileDialogAction(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_FileDialogAction()")
@@ -312,7 +358,7 @@ func (o *ScriptEditor) X_FileDialogAction(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_file_dialog_action")
+	methodBind := methodBindScriptEditorX_FileDialogAction.Get()
 
 	// Call the parent method.
 	// void
@@ -322,19 +368,19 @@ func (o *ScriptEditor) X_FileDialogAction(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String} { false arg1 Object}], Returns: String
+	        Undocumented
+		Args: [{ false arg0 String} { false arg1 Object}], Returns: String
 */
-func (o *ScriptEditor) X_GetDebugTooltip(arg0 gdnative.String, arg1 ObjectImplementer) gdnative.String {
+func (o *ScriptEditor) X_GetDebugTooltip(arg0 gdnative.String, arg1 godot.ObjectImplementer) gdnative.String {
 	//log.Println("Calling ScriptEditor.X_GetDebugTooltip()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromObject(arg1.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg1))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_get_debug_tooltip")
+	methodBind := methodBindScriptEditorX_GetDebugTooltip.Get()
 
 	// Call the parent method.
 	// String
@@ -347,19 +393,19 @@ func (o *ScriptEditor) X_GetDebugTooltip(arg0 gdnative.String, arg1 ObjectImplem
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Reference} { false arg1 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Reference} { false arg1 int}], Returns: void
 */
-func (o *ScriptEditor) X_GotoScriptLine(arg0 ReferenceImplementer, arg1 gdnative.Int) {
+func (o *ScriptEditor) X_GotoScriptLine(arg0 godot.ReferenceImplementer, arg1 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_GotoScriptLine()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 	ptrArguments[1] = gdnative.NewPointerFromInt(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_goto_script_line")
+	methodBind := methodBindScriptEditorX_GotoScriptLine.Get()
 
 	// Call the parent method.
 	// void
@@ -369,8 +415,8 @@ func (o *ScriptEditor) X_GotoScriptLine(arg0 ReferenceImplementer, arg1 gdnative
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *ScriptEditor) X_GotoScriptLine2(arg0 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_GotoScriptLine2()")
@@ -380,7 +426,7 @@ func (o *ScriptEditor) X_GotoScriptLine2(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_goto_script_line2")
+	methodBind := methodBindScriptEditorX_GotoScriptLine2.Get()
 
 	// Call the parent method.
 	// void
@@ -390,8 +436,8 @@ func (o *ScriptEditor) X_GotoScriptLine2(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_HelpClassGoto(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_HelpClassGoto()")
@@ -401,7 +447,7 @@ func (o *ScriptEditor) X_HelpClassGoto(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_help_class_goto")
+	methodBind := methodBindScriptEditorX_HelpClassGoto.Get()
 
 	// Call the parent method.
 	// void
@@ -411,8 +457,8 @@ func (o *ScriptEditor) X_HelpClassGoto(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_HelpClassOpen(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_HelpClassOpen()")
@@ -422,7 +468,7 @@ func (o *ScriptEditor) X_HelpClassOpen(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_help_class_open")
+	methodBind := methodBindScriptEditorX_HelpClassOpen.Get()
 
 	// Call the parent method.
 	// void
@@ -432,8 +478,8 @@ func (o *ScriptEditor) X_HelpClassOpen(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_HelpIndex(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_HelpIndex()")
@@ -443,7 +489,7 @@ func (o *ScriptEditor) X_HelpIndex(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_help_index")
+	methodBind := methodBindScriptEditorX_HelpIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -453,8 +499,8 @@ func (o *ScriptEditor) X_HelpIndex(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *ScriptEditor) X_HelpOverviewSelected(arg0 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_HelpOverviewSelected()")
@@ -464,7 +510,7 @@ func (o *ScriptEditor) X_HelpOverviewSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_help_overview_selected")
+	methodBind := methodBindScriptEditorX_HelpOverviewSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -474,8 +520,8 @@ func (o *ScriptEditor) X_HelpOverviewSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_HelpSearch(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_HelpSearch()")
@@ -485,7 +531,7 @@ func (o *ScriptEditor) X_HelpSearch(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_help_search")
+	methodBind := methodBindScriptEditorX_HelpSearch.Get()
 
 	// Call the parent method.
 	// void
@@ -495,8 +541,8 @@ func (o *ScriptEditor) X_HelpSearch(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_HistoryBack() {
 	//log.Println("Calling ScriptEditor.X_HistoryBack()")
@@ -505,7 +551,7 @@ func (o *ScriptEditor) X_HistoryBack() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_history_back")
+	methodBind := methodBindScriptEditorX_HistoryBack.Get()
 
 	// Call the parent method.
 	// void
@@ -515,8 +561,8 @@ func (o *ScriptEditor) X_HistoryBack() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_HistoryForward() {
 	//log.Println("Calling ScriptEditor.X_HistoryForward()")
@@ -525,7 +571,7 @@ func (o *ScriptEditor) X_HistoryForward() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_history_forward")
+	methodBind := methodBindScriptEditorX_HistoryForward.Get()
 
 	// Call the parent method.
 	// void
@@ -535,8 +581,8 @@ func (o *ScriptEditor) X_HistoryForward() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_LiveAutoReloadRunningScripts() {
 	//log.Println("Calling ScriptEditor.X_LiveAutoReloadRunningScripts()")
@@ -545,7 +591,7 @@ func (o *ScriptEditor) X_LiveAutoReloadRunningScripts() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_live_auto_reload_running_scripts")
+	methodBind := methodBindScriptEditorX_LiveAutoReloadRunningScripts.Get()
 
 	// Call the parent method.
 	// void
@@ -555,8 +601,8 @@ func (o *ScriptEditor) X_LiveAutoReloadRunningScripts() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *ScriptEditor) X_MembersOverviewSelected(arg0 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_MembersOverviewSelected()")
@@ -566,7 +612,7 @@ func (o *ScriptEditor) X_MembersOverviewSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_members_overview_selected")
+	methodBind := methodBindScriptEditorX_MembersOverviewSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -576,8 +622,8 @@ func (o *ScriptEditor) X_MembersOverviewSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *ScriptEditor) X_MenuOption(arg0 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_MenuOption()")
@@ -587,7 +633,7 @@ func (o *ScriptEditor) X_MenuOption(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_menu_option")
+	methodBind := methodBindScriptEditorX_MenuOption.Get()
 
 	// Call the parent method.
 	// void
@@ -597,8 +643,8 @@ func (o *ScriptEditor) X_MenuOption(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *ScriptEditor) X_OpenRecentScript(arg0 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_OpenRecentScript()")
@@ -608,7 +654,7 @@ func (o *ScriptEditor) X_OpenRecentScript(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_open_recent_script")
+	methodBind := methodBindScriptEditorX_OpenRecentScript.Get()
 
 	// Call the parent method.
 	// void
@@ -618,8 +664,8 @@ func (o *ScriptEditor) X_OpenRecentScript(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_ReloadScripts() {
 	//log.Println("Calling ScriptEditor.X_ReloadScripts()")
@@ -628,7 +674,7 @@ func (o *ScriptEditor) X_ReloadScripts() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_reload_scripts")
+	methodBind := methodBindScriptEditorX_ReloadScripts.Get()
 
 	// Call the parent method.
 	// void
@@ -638,8 +684,8 @@ func (o *ScriptEditor) X_ReloadScripts() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_RequestHelp(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_RequestHelp()")
@@ -649,7 +695,7 @@ func (o *ScriptEditor) X_RequestHelp(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_request_help")
+	methodBind := methodBindScriptEditorX_RequestHelp.Get()
 
 	// Call the parent method.
 	// void
@@ -659,18 +705,18 @@ func (o *ScriptEditor) X_RequestHelp(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Resource}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Resource}], Returns: void
 */
-func (o *ScriptEditor) X_ResSavedCallback(arg0 ResourceImplementer) {
+func (o *ScriptEditor) X_ResSavedCallback(arg0 godot.ResourceImplementer) {
 	//log.Println("Calling ScriptEditor.X_ResSavedCallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_res_saved_callback")
+	methodBind := methodBindScriptEditorX_ResSavedCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -680,8 +726,8 @@ func (o *ScriptEditor) X_ResSavedCallback(arg0 ResourceImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *ScriptEditor) X_ResaveScripts(arg0 gdnative.String) {
 	//log.Println("Calling ScriptEditor.X_ResaveScripts()")
@@ -691,7 +737,7 @@ func (o *ScriptEditor) X_ResaveScripts(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_resave_scripts")
+	methodBind := methodBindScriptEditorX_ResaveScripts.Get()
 
 	// Call the parent method.
 	// void
@@ -701,8 +747,8 @@ func (o *ScriptEditor) X_ResaveScripts(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_SaveHistory() {
 	//log.Println("Calling ScriptEditor.X_SaveHistory()")
@@ -711,7 +757,7 @@ func (o *ScriptEditor) X_SaveHistory() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_save_history")
+	methodBind := methodBindScriptEditorX_SaveHistory.Get()
 
 	// Call the parent method.
 	// void
@@ -721,8 +767,8 @@ func (o *ScriptEditor) X_SaveHistory() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_ScriptChanged() {
 	//log.Println("Calling ScriptEditor.X_ScriptChanged()")
@@ -731,7 +777,7 @@ func (o *ScriptEditor) X_ScriptChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_script_changed")
+	methodBind := methodBindScriptEditorX_ScriptChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -741,18 +787,18 @@ func (o *ScriptEditor) X_ScriptChanged() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Script}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Script}], Returns: void
 */
-func (o *ScriptEditor) X_ScriptCreated(arg0 ScriptImplementer) {
+func (o *ScriptEditor) X_ScriptCreated(arg0 godot.ScriptImplementer) {
 	//log.Println("Calling ScriptEditor.X_ScriptCreated()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_script_created")
+	methodBind := methodBindScriptEditorX_ScriptCreated.Get()
 
 	// Call the parent method.
 	// void
@@ -762,18 +808,18 @@ func (o *ScriptEditor) X_ScriptCreated(arg0 ScriptImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ScriptEditor) X_ScriptListGuiInput(arg0 InputEventImplementer) {
+func (o *ScriptEditor) X_ScriptListGuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ScriptEditor.X_ScriptListGuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_script_list_gui_input")
+	methodBind := methodBindScriptEditorX_ScriptListGuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -783,8 +829,8 @@ func (o *ScriptEditor) X_ScriptListGuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *ScriptEditor) X_ScriptSelected(arg0 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_ScriptSelected()")
@@ -794,7 +840,7 @@ func (o *ScriptEditor) X_ScriptSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_script_selected")
+	methodBind := methodBindScriptEditorX_ScriptSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -804,8 +850,8 @@ func (o *ScriptEditor) X_ScriptSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *ScriptEditor) X_ScriptSplitDragged(arg0 gdnative.Real) {
 	//log.Println("Calling ScriptEditor.X_ScriptSplitDragged()")
@@ -815,7 +861,7 @@ func (o *ScriptEditor) X_ScriptSplitDragged(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_script_split_dragged")
+	methodBind := methodBindScriptEditorX_ScriptSplitDragged.Get()
 
 	// Call the parent method.
 	// void
@@ -825,8 +871,8 @@ func (o *ScriptEditor) X_ScriptSplitDragged(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 bool}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 bool}], Returns: void
 */
 func (o *ScriptEditor) X_ShowDebugger(arg0 gdnative.Bool) {
 	//log.Println("Calling ScriptEditor.X_ShowDebugger()")
@@ -836,7 +882,7 @@ func (o *ScriptEditor) X_ShowDebugger(arg0 gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_show_debugger")
+	methodBind := methodBindScriptEditorX_ShowDebugger.Get()
 
 	// Call the parent method.
 	// void
@@ -846,8 +892,8 @@ func (o *ScriptEditor) X_ShowDebugger(arg0 gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *ScriptEditor) X_TabChanged(arg0 gdnative.Int) {
 	//log.Println("Calling ScriptEditor.X_TabChanged()")
@@ -857,7 +903,7 @@ func (o *ScriptEditor) X_TabChanged(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_tab_changed")
+	methodBind := methodBindScriptEditorX_TabChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -867,8 +913,8 @@ func (o *ScriptEditor) X_TabChanged(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_TreeChanged() {
 	//log.Println("Calling ScriptEditor.X_TreeChanged()")
@@ -877,7 +923,7 @@ func (o *ScriptEditor) X_TreeChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_tree_changed")
+	methodBind := methodBindScriptEditorX_TreeChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -887,18 +933,18 @@ func (o *ScriptEditor) X_TreeChanged() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ScriptEditor) X_UnhandledInput(arg0 InputEventImplementer) {
+func (o *ScriptEditor) X_UnhandledInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ScriptEditor.X_UnhandledInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_unhandled_input")
+	methodBind := methodBindScriptEditorX_UnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -908,8 +954,8 @@ func (o *ScriptEditor) X_UnhandledInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_UpdateRecentScripts() {
 	//log.Println("Calling ScriptEditor.X_UpdateRecentScripts()")
@@ -918,7 +964,7 @@ func (o *ScriptEditor) X_UpdateRecentScripts() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_update_recent_scripts")
+	methodBind := methodBindScriptEditorX_UpdateRecentScripts.Get()
 
 	// Call the parent method.
 	// void
@@ -928,8 +974,8 @@ func (o *ScriptEditor) X_UpdateRecentScripts() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScriptEditor) X_UpdateScriptNames() {
 	//log.Println("Calling ScriptEditor.X_UpdateScriptNames()")
@@ -938,7 +984,7 @@ func (o *ScriptEditor) X_UpdateScriptNames() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "_update_script_names")
+	methodBind := methodBindScriptEditorX_UpdateScriptNames.Get()
 
 	// Call the parent method.
 	// void
@@ -948,20 +994,19 @@ func (o *ScriptEditor) X_UpdateScriptNames() {
 }
 
 /*
-
-	Args: [{ false point Vector2} { false data Variant} { false from Object}], Returns: bool
+Args: [{ false point Vector2} { false data Variant} { false from Object}], Returns: bool
 */
-func (o *ScriptEditor) CanDropDataFw(point gdnative.Vector2, data gdnative.Variant, from ObjectImplementer) gdnative.Bool {
+func (o *ScriptEditor) CanDropDataFw(point gdnative.Vector2, data gdnative.Variant, from godot.ObjectImplementer) gdnative.Bool {
 	//log.Println("Calling ScriptEditor.CanDropDataFw()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(data)
-	ptrArguments[2] = gdnative.NewPointerFromObject(from.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(from))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "can_drop_data_fw")
+	methodBind := methodBindScriptEditorCanDropDataFw.Get()
 
 	// Call the parent method.
 	// bool
@@ -974,20 +1019,19 @@ func (o *ScriptEditor) CanDropDataFw(point gdnative.Vector2, data gdnative.Varia
 }
 
 /*
-
-	Args: [{ false point Vector2} { false data Variant} { false from Object}], Returns: void
+Args: [{ false point Vector2} { false data Variant} { false from Object}], Returns: void
 */
-func (o *ScriptEditor) DropDataFw(point gdnative.Vector2, data gdnative.Variant, from ObjectImplementer) {
+func (o *ScriptEditor) DropDataFw(point gdnative.Vector2, data gdnative.Variant, from godot.ObjectImplementer) {
 	//log.Println("Calling ScriptEditor.DropDataFw()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(data)
-	ptrArguments[2] = gdnative.NewPointerFromObject(from.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(from))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "drop_data_fw")
+	methodBind := methodBindScriptEditorDropDataFw.Get()
 
 	// Call the parent method.
 	// void
@@ -997,17 +1041,17 @@ func (o *ScriptEditor) DropDataFw(point gdnative.Vector2, data gdnative.Variant,
 }
 
 /*
-        Returns a [Script] that is currently active in editor.
-	Args: [], Returns: Script
+	        Returns a [Script] that is currently active in editor.
+		Args: [], Returns: Script
 */
-func (o *ScriptEditor) GetCurrentScript() ScriptImplementer {
+func (o *ScriptEditor) GetCurrentScript() godot.ScriptImplementer {
 	//log.Println("Calling ScriptEditor.GetCurrentScript()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "get_current_script")
+	methodBind := methodBindScriptEditorGetCurrentScript.Get()
 
 	// Call the parent method.
 	// Script
@@ -1015,38 +1059,35 @@ func (o *ScriptEditor) GetCurrentScript() ScriptImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newScriptFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ScriptImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Script" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ScriptImplementer)
+	ret := godot.Script{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ScriptImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false point Vector2} { false from Object}], Returns: Variant
+Args: [{ false point Vector2} { false from Object}], Returns: Variant
 */
-func (o *ScriptEditor) GetDragDataFw(point gdnative.Vector2, from ObjectImplementer) gdnative.Variant {
+func (o *ScriptEditor) GetDragDataFw(point gdnative.Vector2, from godot.ObjectImplementer) gdnative.Variant {
 	//log.Println("Calling ScriptEditor.GetDragDataFw()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
-	ptrArguments[1] = gdnative.NewPointerFromObject(from.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(from))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "get_drag_data_fw")
+	methodBind := methodBindScriptEditorGetDragDataFw.Get()
 
 	// Call the parent method.
 	// Variant
@@ -1059,8 +1100,8 @@ func (o *ScriptEditor) GetDragDataFw(point gdnative.Vector2, from ObjectImplemen
 }
 
 /*
-        Returns an array with all [Script] objects which are currently open in editor.
-	Args: [], Returns: Array
+	        Returns an array with all [Script] objects which are currently open in editor.
+		Args: [], Returns: Array
 */
 func (o *ScriptEditor) GetOpenScripts() gdnative.Array {
 	//log.Println("Calling ScriptEditor.GetOpenScripts()")
@@ -1069,7 +1110,7 @@ func (o *ScriptEditor) GetOpenScripts() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "get_open_scripts")
+	methodBind := methodBindScriptEditorGetOpenScripts.Get()
 
 	// Call the parent method.
 	// Array
@@ -1082,8 +1123,7 @@ func (o *ScriptEditor) GetOpenScripts() gdnative.Array {
 }
 
 /*
-
-	Args: [{ false base_name String} { false base_path String}], Returns: void
+Args: [{ false base_name String} { false base_path String}], Returns: void
 */
 func (o *ScriptEditor) OpenScriptCreateDialog(baseName gdnative.String, basePath gdnative.String) {
 	//log.Println("Calling ScriptEditor.OpenScriptCreateDialog()")
@@ -1094,7 +1134,7 @@ func (o *ScriptEditor) OpenScriptCreateDialog(baseName gdnative.String, basePath
 	ptrArguments[1] = gdnative.NewPointerFromString(basePath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScriptEditor", "open_script_create_dialog")
+	methodBind := methodBindScriptEditorOpenScriptCreateDialog.Get()
 
 	// Call the parent method.
 	// void
@@ -1103,11 +1143,63 @@ func (o *ScriptEditor) OpenScriptCreateDialog(baseName gdnative.String, basePath
 
 }
 
+// Signals of the ScriptEditor class.
+const (
+	ScriptEditorSignalEditorScriptChanged gdnative.String = "editor_script_changed"
+	ScriptEditorSignalScriptClose         gdnative.String = "script_close"
+)
+
+// ScriptEditorEditorScriptChangedArgs holds the arguments of the "editor_script_changed" signal.
+type ScriptEditorEditorScriptChangedArgs struct {
+	Script godot.ScriptImplementer
+}
+
+// ConnectEditorScriptChanged will connect the "editor_script_changed" signal to the given method of the target object.
+func (o *ScriptEditor) ConnectEditorScriptChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ScriptEditorSignalEditorScriptChanged, target, method, binds, 0)
+}
+
+// EmitEditorScriptChanged will emit the "editor_script_changed" signal.
+func (o *ScriptEditor) EmitEditorScriptChanged(args ScriptEditorEditorScriptChangedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Script))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ScriptEditorSignalEditorScriptChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ScriptEditorScriptCloseArgs holds the arguments of the "script_close" signal.
+type ScriptEditorScriptCloseArgs struct {
+	Script godot.ScriptImplementer
+}
+
+// ConnectScriptClose will connect the "script_close" signal to the given method of the target object.
+func (o *ScriptEditor) ConnectScriptClose(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ScriptEditorSignalScriptClose, target, method, binds, 0)
+}
+
+// EmitScriptClose will emit the "script_close" signal.
+func (o *ScriptEditor) EmitScriptClose(args ScriptEditorScriptCloseArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Script))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(ScriptEditorSignalScriptClose, arg0)
+	ret.Destroy()
+	return err
+}
+
 // ScriptEditorImplementer is an interface that implements the methods
 // of the ScriptEditor class.
 type ScriptEditorImplementer interface {
 	PanelContainerImplementer
-	X_AddCallback(arg0 ObjectImplementer, arg1 gdnative.String, arg2 gdnative.PoolStringArray)
+	X_AddCallback(arg0 godot.ObjectImplementer, arg1 gdnative.String, arg2 gdnative.PoolStringArray)
 	X_AutosaveScripts()
 	X_Breaked(arg0 gdnative.Bool, arg1 gdnative.Bool)
 	X_CloseAllTabs()
@@ -1121,8 +1213,8 @@ type ScriptEditorImplementer interface {
 	X_EditorSettingsChanged()
 	X_EditorStop()
 	X_FileDialogAction(arg0 gdnative.String)
-	X_GetDebugTooltip(arg0 gdnative.String, arg1 ObjectImplementer) gdnative.String
-	X_GotoScriptLine(arg0 ReferenceImplementer, arg1 gdnative.Int)
+	X_GetDebugTooltip(arg0 gdnative.String, arg1 godot.ObjectImplementer) gdnative.String
+	X_GotoScriptLine(arg0 godot.ReferenceImplementer, arg1 gdnative.Int)
 	X_GotoScriptLine2(arg0 gdnative.Int)
 	X_HelpClassGoto(arg0 gdnative.String)
 	X_HelpClassOpen(arg0 gdnative.String)
@@ -1137,12 +1229,12 @@ type ScriptEditorImplementer interface {
 	X_OpenRecentScript(arg0 gdnative.Int)
 	X_ReloadScripts()
 	X_RequestHelp(arg0 gdnative.String)
-	X_ResSavedCallback(arg0 ResourceImplementer)
+	X_ResSavedCallback(arg0 godot.ResourceImplementer)
 	X_ResaveScripts(arg0 gdnative.String)
 	X_SaveHistory()
 	X_ScriptChanged()
-	X_ScriptCreated(arg0 ScriptImplementer)
-	X_ScriptListGuiInput(arg0 InputEventImplementer)
+	X_ScriptCreated(arg0 godot.ScriptImplementer)
+	X_ScriptListGuiInput(arg0 godot.InputEventImplementer)
 	X_ScriptSelected(arg0 gdnative.Int)
 	X_ScriptSplitDragged(arg0 gdnative.Real)
 	X_ShowDebugger(arg0 gdnative.Bool)
@@ -1150,10 +1242,14 @@ type ScriptEditorImplementer interface {
 	X_TreeChanged()
 	X_UpdateRecentScripts()
 	X_UpdateScriptNames()
-	CanDropDataFw(point gdnative.Vector2, data gdnative.Variant, from ObjectImplementer) gdnative.Bool
-	DropDataFw(point gdnative.Vector2, data gdnative.Variant, from ObjectImplementer)
-	GetCurrentScript() ScriptImplementer
-	GetDragDataFw(point gdnative.Vector2, from ObjectImplementer) gdnative.Variant
+	CanDropDataFw(point gdnative.Vector2, data gdnative.Variant, from godot.ObjectImplementer) gdnative.Bool
+	DropDataFw(point gdnative.Vector2, data gdnative.Variant, from godot.ObjectImplementer)
+	GetCurrentScript() godot.ScriptImplementer
+	GetDragDataFw(point gdnative.Vector2, from godot.ObjectImplementer) gdnative.Variant
 	GetOpenScripts() gdnative.Array
 	OpenScriptCreateDialog(baseName gdnative.String, basePath gdnative.String)
+	ConnectEditorScriptChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitEditorScriptChanged(args ScriptEditorEditorScriptChangedArgs) error
+	ConnectScriptClose(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScriptClose(args ScriptEditorScriptCloseArgs) error
 }
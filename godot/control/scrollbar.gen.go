@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewScrollBarFromPointer(ptr gdnative.Pointer) ScrollBar {
-func newScrollBarFromPointer(ptr gdnative.Pointer) ScrollBar {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ScrollBar{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Scrollbars are a [Range] based [Control], that display a draggable area (the size of the page). Horizontal ([HScrollBar]) and Vertical ([VScrollBar]) versions are available.
 */
 type ScrollBar struct {
-	Range
+	godot.Range
 	owner gdnative.Object
 }
 
@@ -34,9 +26,19 @@ func (o *ScrollBar) BaseClass() string {
 	return "ScrollBar"
 }
 
+// Method binds of the ScrollBar class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindScrollBarX_DragSlaveExit  = gdnative.NewLazyMethodBind("ScrollBar", "_drag_slave_exit")
+	methodBindScrollBarX_DragSlaveInput = gdnative.NewLazyMethodBind("ScrollBar", "_drag_slave_input")
+	methodBindScrollBarX_GuiInput       = gdnative.NewLazyMethodBind("ScrollBar", "_gui_input")
+	methodBindScrollBarGetCustomStep    = gdnative.NewLazyMethodBind("ScrollBar", "get_custom_step")
+	methodBindScrollBarSetCustomStep    = gdnative.NewLazyMethodBind("ScrollBar", "set_custom_step")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScrollBar) X_DragSlaveExit() {
 	//log.Println("Calling ScrollBar.X_DragSlaveExit()")
@@ -45,7 +47,7 @@ func (o *ScrollBar) X_DragSlaveExit() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollBar", "_drag_slave_exit")
+	methodBind := methodBindScrollBarX_DragSlaveExit.Get()
 
 	// Call the parent method.
 	// void
@@ -55,18 +57,18 @@ func (o *ScrollBar) X_DragSlaveExit() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ScrollBar) X_DragSlaveInput(arg0 InputEventImplementer) {
+func (o *ScrollBar) X_DragSlaveInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ScrollBar.X_DragSlaveInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollBar", "_drag_slave_input")
+	methodBind := methodBindScrollBarX_DragSlaveInput.Get()
 
 	// Call the parent method.
 	// void
@@ -76,18 +78,18 @@ func (o *ScrollBar) X_DragSlaveInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ScrollBar) X_GuiInput(arg0 InputEventImplementer) {
+func (o *ScrollBar) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ScrollBar.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollBar", "_gui_input")
+	methodBind := methodBindScrollBarX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -97,8 +99,8 @@ func (o *ScrollBar) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ScrollBar) GetCustomStep() gdnative.Real {
 	//log.Println("Calling ScrollBar.GetCustomStep()")
@@ -107,7 +109,7 @@ func (o *ScrollBar) GetCustomStep() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollBar", "get_custom_step")
+	methodBind := methodBindScrollBarGetCustomStep.Get()
 
 	// Call the parent method.
 	// float
@@ -120,8 +122,8 @@ func (o *ScrollBar) GetCustomStep() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false step float}], Returns: void
+	        Undocumented
+		Args: [{ false step float}], Returns: void
 */
 func (o *ScrollBar) SetCustomStep(step gdnative.Real) {
 	//log.Println("Calling ScrollBar.SetCustomStep()")
@@ -131,7 +133,7 @@ func (o *ScrollBar) SetCustomStep(step gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(step)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollBar", "set_custom_step")
+	methodBind := methodBindScrollBarSetCustomStep.Get()
 
 	// Call the parent method.
 	// void
@@ -140,12 +142,41 @@ func (o *ScrollBar) SetCustomStep(step gdnative.Real) {
 
 }
 
+// CustomStep will return the value of the "custom_step" property.
+func (o *ScrollBar) CustomStep() gdnative.Real {
+	return o.GetCustomStep()
+}
+
+// Signals of the ScrollBar class.
+const (
+	ScrollBarSignalScrolling gdnative.String = "scrolling"
+)
+
+// ConnectScrolling will connect the "scrolling" signal to the given method of the target object.
+func (o *ScrollBar) ConnectScrolling(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ScrollBarSignalScrolling, target, method, binds, 0)
+}
+
+// EmitScrolling will emit the "scrolling" signal.
+func (o *ScrollBar) EmitScrolling() error {
+
+	ret, err := o.EmitSignal(ScrollBarSignalScrolling)
+	ret.Destroy()
+	return err
+}
+
 // ScrollBarImplementer is an interface that implements the methods
 // of the ScrollBar class.
 type ScrollBarImplementer interface {
-	RangeImplementer
+	godot.RangeImplementer
 	X_DragSlaveExit()
-	X_DragSlaveInput(arg0 InputEventImplementer)
+	X_DragSlaveInput(arg0 godot.InputEventImplementer)
 	GetCustomStep() gdnative.Real
 	SetCustomStep(step gdnative.Real)
+	CustomStep() gdnative.Real
+	ConnectScrolling(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScrolling() error
 }
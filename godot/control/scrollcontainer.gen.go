@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewScrollContainerFromPointer(ptr gdnative.Pointer) ScrollContainer {
-func newScrollContainerFromPointer(ptr gdnative.Pointer) ScrollContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ScrollContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A ScrollContainer node with a [Control] child and scrollbar child ([HScrollbar], [VScrollBar], or both) will only draw the Control within the ScrollContainer area. Scrollbars will automatically be drawn at the right (for vertical) or bottom (for horizontal) and will enable dragging to move the viewable Control (and its children) within the ScrollContainer. Scrollbars will also automatically resize the grabber based on the minimum_size of the Control relative to the ScrollContainer. Works great with a [Panel] control. You can set EXPAND on children size flags, so they will upscale to ScrollContainer size if ScrollContainer size is bigger (scroll is invisible for chosen dimension).
 */
@@ -30,23 +22,53 @@ type ScrollContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorScrollContainer is the class constructor of the ScrollContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorScrollContainer = gdnative.NewLazyClassConstructor("ScrollContainer")
+
+// NewScrollContainer will create a new instance of the ScrollContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewScrollContainer() *ScrollContainer {
+	obj := &ScrollContainer{}
+	obj.SetBaseObject(classConstructorScrollContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ScrollContainer) BaseClass() string {
 	return "ScrollContainer"
 }
 
+// Method binds of the ScrollContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindScrollContainerX_GuiInput                = gdnative.NewLazyMethodBind("ScrollContainer", "_gui_input")
+	methodBindScrollContainerX_ScrollMoved             = gdnative.NewLazyMethodBind("ScrollContainer", "_scroll_moved")
+	methodBindScrollContainerX_UpdateScrollbarPosition = gdnative.NewLazyMethodBind("ScrollContainer", "_update_scrollbar_position")
+	methodBindScrollContainerGetHScroll                = gdnative.NewLazyMethodBind("ScrollContainer", "get_h_scroll")
+	methodBindScrollContainerGetVScroll                = gdnative.NewLazyMethodBind("ScrollContainer", "get_v_scroll")
+	methodBindScrollContainerIsHScrollEnabled          = gdnative.NewLazyMethodBind("ScrollContainer", "is_h_scroll_enabled")
+	methodBindScrollContainerIsVScrollEnabled          = gdnative.NewLazyMethodBind("ScrollContainer", "is_v_scroll_enabled")
+	methodBindScrollContainerSetEnableHScroll          = gdnative.NewLazyMethodBind("ScrollContainer", "set_enable_h_scroll")
+	methodBindScrollContainerSetEnableVScroll          = gdnative.NewLazyMethodBind("ScrollContainer", "set_enable_v_scroll")
+	methodBindScrollContainerSetHScroll                = gdnative.NewLazyMethodBind("ScrollContainer", "set_h_scroll")
+	methodBindScrollContainerSetVScroll                = gdnative.NewLazyMethodBind("ScrollContainer", "set_v_scroll")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *ScrollContainer) X_GuiInput(arg0 InputEventImplementer) {
+func (o *ScrollContainer) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling ScrollContainer.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "_gui_input")
+	methodBind := methodBindScrollContainerX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +78,8 @@ func (o *ScrollContainer) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *ScrollContainer) X_ScrollMoved(arg0 gdnative.Real) {
 	//log.Println("Calling ScrollContainer.X_ScrollMoved()")
@@ -67,7 +89,7 @@ func (o *ScrollContainer) X_ScrollMoved(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "_scroll_moved")
+	methodBind := methodBindScrollContainerX_ScrollMoved.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +99,8 @@ func (o *ScrollContainer) X_ScrollMoved(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ScrollContainer) X_UpdateScrollbarPosition() {
 	//log.Println("Calling ScrollContainer.X_UpdateScrollbarPosition()")
@@ -87,7 +109,7 @@ func (o *ScrollContainer) X_UpdateScrollbarPosition() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "_update_scrollbar_position")
+	methodBind := methodBindScrollContainerX_UpdateScrollbarPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -97,8 +119,8 @@ func (o *ScrollContainer) X_UpdateScrollbarPosition() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ScrollContainer) GetHScroll() gdnative.Int {
 	//log.Println("Calling ScrollContainer.GetHScroll()")
@@ -107,7 +129,7 @@ func (o *ScrollContainer) GetHScroll() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "get_h_scroll")
+	methodBind := methodBindScrollContainerGetHScroll.Get()
 
 	// Call the parent method.
 	// int
@@ -120,8 +142,8 @@ func (o *ScrollContainer) GetHScroll() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ScrollContainer) GetVScroll() gdnative.Int {
 	//log.Println("Calling ScrollContainer.GetVScroll()")
@@ -130,7 +152,7 @@ func (o *ScrollContainer) GetVScroll() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "get_v_scroll")
+	methodBind := methodBindScrollContainerGetVScroll.Get()
 
 	// Call the parent method.
 	// int
@@ -143,8 +165,8 @@ func (o *ScrollContainer) GetVScroll() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ScrollContainer) IsHScrollEnabled() gdnative.Bool {
 	//log.Println("Calling ScrollContainer.IsHScrollEnabled()")
@@ -153,7 +175,7 @@ func (o *ScrollContainer) IsHScrollEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "is_h_scroll_enabled")
+	methodBind := methodBindScrollContainerIsHScrollEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -166,8 +188,8 @@ func (o *ScrollContainer) IsHScrollEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ScrollContainer) IsVScrollEnabled() gdnative.Bool {
 	//log.Println("Calling ScrollContainer.IsVScrollEnabled()")
@@ -176,7 +198,7 @@ func (o *ScrollContainer) IsVScrollEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "is_v_scroll_enabled")
+	methodBind := methodBindScrollContainerIsVScrollEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -189,8 +211,8 @@ func (o *ScrollContainer) IsVScrollEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ScrollContainer) SetEnableHScroll(enable gdnative.Bool) {
 	//log.Println("Calling ScrollContainer.SetEnableHScroll()")
@@ -200,7 +222,7 @@ func (o *ScrollContainer) SetEnableHScroll(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "set_enable_h_scroll")
+	methodBind := methodBindScrollContainerSetEnableHScroll.Get()
 
 	// Call the parent method.
 	// void
@@ -210,8 +232,8 @@ func (o *ScrollContainer) SetEnableHScroll(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ScrollContainer) SetEnableVScroll(enable gdnative.Bool) {
 	//log.Println("Calling ScrollContainer.SetEnableVScroll()")
@@ -221,7 +243,7 @@ func (o *ScrollContainer) SetEnableVScroll(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "set_enable_v_scroll")
+	methodBind := methodBindScrollContainerSetEnableVScroll.Get()
 
 	// Call the parent method.
 	// void
@@ -231,8 +253,8 @@ func (o *ScrollContainer) SetEnableVScroll(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value int}], Returns: void
+	        Undocumented
+		Args: [{ false value int}], Returns: void
 */
 func (o *ScrollContainer) SetHScroll(value gdnative.Int) {
 	//log.Println("Calling ScrollContainer.SetHScroll()")
@@ -242,7 +264,7 @@ func (o *ScrollContainer) SetHScroll(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "set_h_scroll")
+	methodBind := methodBindScrollContainerSetHScroll.Get()
 
 	// Call the parent method.
 	// void
@@ -252,8 +274,8 @@ func (o *ScrollContainer) SetHScroll(value gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value int}], Returns: void
+	        Undocumented
+		Args: [{ false value int}], Returns: void
 */
 func (o *ScrollContainer) SetVScroll(value gdnative.Int) {
 	//log.Println("Calling ScrollContainer.SetVScroll()")
@@ -263,7 +285,7 @@ func (o *ScrollContainer) SetVScroll(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ScrollContainer", "set_v_scroll")
+	methodBind := methodBindScrollContainerSetVScroll.Get()
 
 	// Call the parent method.
 	// void
@@ -272,6 +294,46 @@ func (o *ScrollContainer) SetVScroll(value gdnative.Int) {
 
 }
 
+// ScrollHorizontal will return the value of the "scroll_horizontal" property.
+func (o *ScrollContainer) ScrollHorizontal() gdnative.Int {
+	return o.GetHScroll()
+}
+
+// SetScrollHorizontal will set the value of the "scroll_horizontal" property.
+func (o *ScrollContainer) SetScrollHorizontal(value gdnative.Int) {
+	o.SetHScroll(value)
+}
+
+// ScrollHorizontalEnabled will return the value of the "scroll_horizontal_enabled" property.
+func (o *ScrollContainer) ScrollHorizontalEnabled() gdnative.Bool {
+	return o.IsHScrollEnabled()
+}
+
+// SetScrollHorizontalEnabled will set the value of the "scroll_horizontal_enabled" property.
+func (o *ScrollContainer) SetScrollHorizontalEnabled(value gdnative.Bool) {
+	o.SetEnableHScroll(value)
+}
+
+// ScrollVertical will return the value of the "scroll_vertical" property.
+func (o *ScrollContainer) ScrollVertical() gdnative.Int {
+	return o.GetVScroll()
+}
+
+// SetScrollVertical will set the value of the "scroll_vertical" property.
+func (o *ScrollContainer) SetScrollVertical(value gdnative.Int) {
+	o.SetVScroll(value)
+}
+
+// ScrollVerticalEnabled will return the value of the "scroll_vertical_enabled" property.
+func (o *ScrollContainer) ScrollVerticalEnabled() gdnative.Bool {
+	return o.IsVScrollEnabled()
+}
+
+// SetScrollVerticalEnabled will set the value of the "scroll_vertical_enabled" property.
+func (o *ScrollContainer) SetScrollVerticalEnabled(value gdnative.Bool) {
+	o.SetEnableVScroll(value)
+}
+
 // ScrollContainerImplementer is an interface that implements the methods
 // of the ScrollContainer class.
 type ScrollContainerImplementer interface {
@@ -286,4 +348,12 @@ type ScrollContainerImplementer interface {
 	SetEnableVScroll(enable gdnative.Bool)
 	SetHScroll(value gdnative.Int)
 	SetVScroll(value gdnative.Int)
+	ScrollHorizontal() gdnative.Int
+	SetScrollHorizontal(value gdnative.Int)
+	ScrollHorizontalEnabled() gdnative.Bool
+	SetScrollHorizontalEnabled(value gdnative.Bool)
+	ScrollVertical() gdnative.Int
+	SetScrollVertical(value gdnative.Int)
+	ScrollVerticalEnabled() gdnative.Bool
+	SetScrollVerticalEnabled(value gdnative.Bool)
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSeparatorFromPointer(ptr gdnative.Pointer) Separator {
-func newSeparatorFromPointer(ptr gdnative.Pointer) Separator {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Separator{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Separator is a [Control] used for separating other controls. It's purely a visual decoration. Horizontal ([HSeparator]) and Vertical ([VSeparator]) versions are available.
 */
 type Separator struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
@@ -37,5 +29,5 @@ func (o *Separator) BaseClass() string {
 // SeparatorImplementer is an interface that implements the methods
 // of the Separator class.
 type SeparatorImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 }
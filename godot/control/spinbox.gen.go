@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,40 +14,62 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSpinBoxFromPointer(ptr gdnative.Pointer) SpinBox {
-func newSpinBoxFromPointer(ptr gdnative.Pointer) SpinBox {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SpinBox{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 SpinBox is a numerical input text field. It allows entering integers and floats.
 */
 type SpinBox struct {
-	Range
+	godot.Range
 	owner gdnative.Object
 }
 
+// classConstructorSpinBox is the class constructor of the SpinBox class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSpinBox = gdnative.NewLazyClassConstructor("SpinBox")
+
+// NewSpinBox will create a new instance of the SpinBox class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSpinBox() *SpinBox {
+	obj := &SpinBox{}
+	obj.SetBaseObject(classConstructorSpinBox.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SpinBox) BaseClass() string {
 	return "SpinBox"
 }
 
+// Method binds of the SpinBox class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSpinBoxX_GuiInput          = gdnative.NewLazyMethodBind("SpinBox", "_gui_input")
+	methodBindSpinBoxX_LineEditFocusExit = gdnative.NewLazyMethodBind("SpinBox", "_line_edit_focus_exit")
+	methodBindSpinBoxX_LineEditInput     = gdnative.NewLazyMethodBind("SpinBox", "_line_edit_input")
+	methodBindSpinBoxX_RangeClickTimeout = gdnative.NewLazyMethodBind("SpinBox", "_range_click_timeout")
+	methodBindSpinBoxX_TextEntered       = gdnative.NewLazyMethodBind("SpinBox", "_text_entered")
+	methodBindSpinBoxGetLineEdit         = gdnative.NewLazyMethodBind("SpinBox", "get_line_edit")
+	methodBindSpinBoxGetPrefix           = gdnative.NewLazyMethodBind("SpinBox", "get_prefix")
+	methodBindSpinBoxGetSuffix           = gdnative.NewLazyMethodBind("SpinBox", "get_suffix")
+	methodBindSpinBoxIsEditable          = gdnative.NewLazyMethodBind("SpinBox", "is_editable")
+	methodBindSpinBoxSetEditable         = gdnative.NewLazyMethodBind("SpinBox", "set_editable")
+	methodBindSpinBoxSetPrefix           = gdnative.NewLazyMethodBind("SpinBox", "set_prefix")
+	methodBindSpinBoxSetSuffix           = gdnative.NewLazyMethodBind("SpinBox", "set_suffix")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *SpinBox) X_GuiInput(arg0 InputEventImplementer) {
+func (o *SpinBox) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling SpinBox.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "_gui_input")
+	methodBind := methodBindSpinBoxX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +79,8 @@ func (o *SpinBox) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *SpinBox) X_LineEditFocusExit() {
 	//log.Println("Calling SpinBox.X_LineEditFocusExit()")
@@ -66,7 +89,7 @@ func (o *SpinBox) X_LineEditFocusExit() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "_line_edit_focus_exit")
+	methodBind := methodBindSpinBoxX_LineEditFocusExit.Get()
 
 	// Call the parent method.
 	// void
@@ -76,18 +99,18 @@ func (o *SpinBox) X_LineEditFocusExit() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *SpinBox) X_LineEditInput(arg0 InputEventImplementer) {
+func (o *SpinBox) X_LineEditInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling SpinBox.X_LineEditInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "_line_edit_input")
+	methodBind := methodBindSpinBoxX_LineEditInput.Get()
 
 	// Call the parent method.
 	// void
@@ -97,8 +120,8 @@ func (o *SpinBox) X_LineEditInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *SpinBox) X_RangeClickTimeout() {
 	//log.Println("Calling SpinBox.X_RangeClickTimeout()")
@@ -107,7 +130,7 @@ func (o *SpinBox) X_RangeClickTimeout() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "_range_click_timeout")
+	methodBind := methodBindSpinBoxX_RangeClickTimeout.Get()
 
 	// Call the parent method.
 	// void
@@ -117,8 +140,8 @@ func (o *SpinBox) X_RangeClickTimeout() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *SpinBox) X_TextEntered(arg0 gdnative.String) {
 	//log.Println("Calling SpinBox.X_TextEntered()")
@@ -128,7 +151,7 @@ func (o *SpinBox) X_TextEntered(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "_text_entered")
+	methodBind := methodBindSpinBoxX_TextEntered.Get()
 
 	// Call the parent method.
 	// void
@@ -138,8 +161,7 @@ func (o *SpinBox) X_TextEntered(arg0 gdnative.String) {
 }
 
 /*
-
-	Args: [], Returns: LineEdit
+Args: [], Returns: LineEdit
 */
 func (o *SpinBox) GetLineEdit() LineEditImplementer {
 	//log.Println("Calling SpinBox.GetLineEdit()")
@@ -148,7 +170,7 @@ func (o *SpinBox) GetLineEdit() LineEditImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "get_line_edit")
+	methodBind := methodBindSpinBoxGetLineEdit.Get()
 
 	// Call the parent method.
 	// LineEdit
@@ -156,27 +178,25 @@ func (o *SpinBox) GetLineEdit() LineEditImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newLineEditFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(LineEditImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "LineEdit" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(LineEditImplementer)
+	ret := LineEdit{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(LineEditImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *SpinBox) GetPrefix() gdnative.String {
 	//log.Println("Calling SpinBox.GetPrefix()")
@@ -185,7 +205,7 @@ func (o *SpinBox) GetPrefix() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "get_prefix")
+	methodBind := methodBindSpinBoxGetPrefix.Get()
 
 	// Call the parent method.
 	// String
@@ -198,8 +218,8 @@ func (o *SpinBox) GetPrefix() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *SpinBox) GetSuffix() gdnative.String {
 	//log.Println("Calling SpinBox.GetSuffix()")
@@ -208,7 +228,7 @@ func (o *SpinBox) GetSuffix() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "get_suffix")
+	methodBind := methodBindSpinBoxGetSuffix.Get()
 
 	// Call the parent method.
 	// String
@@ -221,8 +241,8 @@ func (o *SpinBox) GetSuffix() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SpinBox) IsEditable() gdnative.Bool {
 	//log.Println("Calling SpinBox.IsEditable()")
@@ -231,7 +251,7 @@ func (o *SpinBox) IsEditable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "is_editable")
+	methodBind := methodBindSpinBoxIsEditable.Get()
 
 	// Call the parent method.
 	// bool
@@ -244,8 +264,8 @@ func (o *SpinBox) IsEditable() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false editable bool}], Returns: void
+	        Undocumented
+		Args: [{ false editable bool}], Returns: void
 */
 func (o *SpinBox) SetEditable(editable gdnative.Bool) {
 	//log.Println("Calling SpinBox.SetEditable()")
@@ -255,7 +275,7 @@ func (o *SpinBox) SetEditable(editable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(editable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "set_editable")
+	methodBind := methodBindSpinBoxSetEditable.Get()
 
 	// Call the parent method.
 	// void
@@ -265,8 +285,8 @@ func (o *SpinBox) SetEditable(editable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false prefix String}], Returns: void
+	        Undocumented
+		Args: [{ false prefix String}], Returns: void
 */
 func (o *SpinBox) SetPrefix(prefix gdnative.String) {
 	//log.Println("Calling SpinBox.SetPrefix()")
@@ -276,7 +296,7 @@ func (o *SpinBox) SetPrefix(prefix gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(prefix)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "set_prefix")
+	methodBind := methodBindSpinBoxSetPrefix.Get()
 
 	// Call the parent method.
 	// void
@@ -286,8 +306,8 @@ func (o *SpinBox) SetPrefix(prefix gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false suffix String}], Returns: void
+	        Undocumented
+		Args: [{ false suffix String}], Returns: void
 */
 func (o *SpinBox) SetSuffix(suffix gdnative.String) {
 	//log.Println("Calling SpinBox.SetSuffix()")
@@ -297,7 +317,7 @@ func (o *SpinBox) SetSuffix(suffix gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(suffix)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpinBox", "set_suffix")
+	methodBind := methodBindSpinBoxSetSuffix.Get()
 
 	// Call the parent method.
 	// void
@@ -306,12 +326,27 @@ func (o *SpinBox) SetSuffix(suffix gdnative.String) {
 
 }
 
+// Editable will return the value of the "editable" property.
+func (o *SpinBox) Editable() gdnative.Bool {
+	return o.IsEditable()
+}
+
+// Prefix will return the value of the "prefix" property.
+func (o *SpinBox) Prefix() gdnative.String {
+	return o.GetPrefix()
+}
+
+// Suffix will return the value of the "suffix" property.
+func (o *SpinBox) Suffix() gdnative.String {
+	return o.GetSuffix()
+}
+
 // SpinBoxImplementer is an interface that implements the methods
 // of the SpinBox class.
 type SpinBoxImplementer interface {
-	RangeImplementer
+	godot.RangeImplementer
 	X_LineEditFocusExit()
-	X_LineEditInput(arg0 InputEventImplementer)
+	X_LineEditInput(arg0 godot.InputEventImplementer)
 	X_RangeClickTimeout()
 	X_TextEntered(arg0 gdnative.String)
 	GetLineEdit() LineEditImplementer
@@ -321,4 +356,7 @@ type SpinBoxImplementer interface {
 	SetEditable(editable gdnative.Bool)
 	SetPrefix(prefix gdnative.String)
 	SetSuffix(suffix gdnative.String)
+	Editable() gdnative.Bool
+	Prefix() gdnative.String
+	Suffix() gdnative.String
 }
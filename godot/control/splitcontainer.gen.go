@@ -0,0 +1,289 @@
+package control
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// SplitContainerDraggerVisibility is an enum for DraggerVisibility values.
+type SplitContainerDraggerVisibility int
+
+const (
+	SplitContainerDraggerHidden          SplitContainerDraggerVisibility = 1
+	SplitContainerDraggerHiddenCollapsed SplitContainerDraggerVisibility = 2
+	SplitContainerDraggerVisible         SplitContainerDraggerVisibility = 0
+)
+
+// String will return the Godot name of the SplitContainerDraggerVisibility value.
+func (e SplitContainerDraggerVisibility) String() string {
+	switch e {
+	case SplitContainerDraggerHidden:
+		return "DRAGGER_HIDDEN"
+	case SplitContainerDraggerHiddenCollapsed:
+		return "DRAGGER_HIDDEN_COLLAPSED"
+	case SplitContainerDraggerVisible:
+		return "DRAGGER_VISIBLE"
+	}
+	return fmt.Sprintf("SplitContainerDraggerVisibility(%d)", int(e))
+}
+
+// ParseSplitContainerDraggerVisibility will return the SplitContainerDraggerVisibility value with the given Godot name.
+func ParseSplitContainerDraggerVisibility(name string) (SplitContainerDraggerVisibility, error) {
+	switch name {
+	case "DRAGGER_HIDDEN":
+		return SplitContainerDraggerHidden, nil
+	case "DRAGGER_HIDDEN_COLLAPSED":
+		return SplitContainerDraggerHiddenCollapsed, nil
+	case "DRAGGER_VISIBLE":
+		return SplitContainerDraggerVisible, nil
+	}
+	return 0, fmt.Errorf("invalid SplitContainerDraggerVisibility value %q", name)
+}
+
+/*
+Container for splitting two controls vertically or horizontally, with a grabber that allows adjusting the split offset or ratio.
+*/
+type SplitContainer struct {
+	Container
+	owner gdnative.Object
+}
+
+func (o *SplitContainer) BaseClass() string {
+	return "SplitContainer"
+}
+
+// Method binds of the SplitContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSplitContainerX_GuiInput           = gdnative.NewLazyMethodBind("SplitContainer", "_gui_input")
+	methodBindSplitContainerGetDraggerVisibility = gdnative.NewLazyMethodBind("SplitContainer", "get_dragger_visibility")
+	methodBindSplitContainerGetSplitOffset       = gdnative.NewLazyMethodBind("SplitContainer", "get_split_offset")
+	methodBindSplitContainerIsCollapsed          = gdnative.NewLazyMethodBind("SplitContainer", "is_collapsed")
+	methodBindSplitContainerSetCollapsed         = gdnative.NewLazyMethodBind("SplitContainer", "set_collapsed")
+	methodBindSplitContainerSetDraggerVisibility = gdnative.NewLazyMethodBind("SplitContainer", "set_dragger_visibility")
+	methodBindSplitContainerSetSplitOffset       = gdnative.NewLazyMethodBind("SplitContainer", "set_split_offset")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *SplitContainer) X_GuiInput(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling SplitContainer.X_GuiInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindSplitContainerX_GuiInput.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SplitContainer::DraggerVisibility
+*/
+func (o *SplitContainer) GetDraggerVisibility() SplitContainerDraggerVisibility {
+	//log.Println("Calling SplitContainer.GetDraggerVisibility()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSplitContainerGetDraggerVisibility.Get()
+
+	// Call the parent method.
+	// enum.SplitContainer::DraggerVisibility
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SplitContainerDraggerVisibility(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *SplitContainer) GetSplitOffset() gdnative.Int {
+	//log.Println("Calling SplitContainer.GetSplitOffset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSplitContainerGetSplitOffset.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *SplitContainer) IsCollapsed() gdnative.Bool {
+	//log.Println("Calling SplitContainer.IsCollapsed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSplitContainerIsCollapsed.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false collapsed bool}], Returns: void
+*/
+func (o *SplitContainer) SetCollapsed(collapsed gdnative.Bool) {
+	//log.Println("Calling SplitContainer.SetCollapsed()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(collapsed)
+
+	// Get the method bind
+	methodBind := methodBindSplitContainerSetCollapsed.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.SplitContainer::DraggerVisibility}], Returns: void
+*/
+func (o *SplitContainer) SetDraggerVisibility(mode SplitContainerDraggerVisibility) {
+	//log.Println("Calling SplitContainer.SetDraggerVisibility()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindSplitContainerSetDraggerVisibility.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false offset int}], Returns: void
+*/
+func (o *SplitContainer) SetSplitOffset(offset gdnative.Int) {
+	//log.Println("Calling SplitContainer.SetSplitOffset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(offset)
+
+	// Get the method bind
+	methodBind := methodBindSplitContainerSetSplitOffset.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Collapsed will return the value of the "collapsed" property.
+func (o *SplitContainer) Collapsed() gdnative.Bool {
+	return o.IsCollapsed()
+}
+
+// DraggerVisibility will return the value of the "dragger_visibility" property.
+func (o *SplitContainer) DraggerVisibility() SplitContainerDraggerVisibility {
+	return o.GetDraggerVisibility()
+}
+
+// SplitOffset will return the value of the "split_offset" property.
+func (o *SplitContainer) SplitOffset() gdnative.Int {
+	return o.GetSplitOffset()
+}
+
+// Signals of the SplitContainer class.
+const (
+	SplitContainerSignalDragged gdnative.String = "dragged"
+)
+
+// SplitContainerDraggedArgs holds the arguments of the "dragged" signal.
+type SplitContainerDraggedArgs struct {
+	Offset gdnative.Int
+}
+
+// ConnectDragged will connect the "dragged" signal to the given method of the target object.
+func (o *SplitContainer) ConnectDragged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SplitContainerSignalDragged, target, method, binds, 0)
+}
+
+// EmitDragged will emit the "dragged" signal.
+func (o *SplitContainer) EmitDragged(args SplitContainerDraggedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Offset))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(SplitContainerSignalDragged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// SplitContainerImplementer is an interface that implements the methods
+// of the SplitContainer class.
+type SplitContainerImplementer interface {
+	ContainerImplementer
+	GetDraggerVisibility() SplitContainerDraggerVisibility
+	GetSplitOffset() gdnative.Int
+	IsCollapsed() gdnative.Bool
+	SetCollapsed(collapsed gdnative.Bool)
+	SetDraggerVisibility(mode SplitContainerDraggerVisibility)
+	SetSplitOffset(offset gdnative.Int)
+	Collapsed() gdnative.Bool
+	DraggerVisibility() SplitContainerDraggerVisibility
+	SplitOffset() gdnative.Int
+	ConnectDragged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDragged(args SplitContainerDraggedArgs) error
+}
@@ -0,0 +1,762 @@
+package control
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/popup"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TabContainerTabAlign is an enum for TabAlign values.
+type TabContainerTabAlign int
+
+const (
+	TabContainerAlignCenter TabContainerTabAlign = 1
+	TabContainerAlignLeft   TabContainerTabAlign = 0
+	TabContainerAlignRight  TabContainerTabAlign = 2
+)
+
+// String will return the Godot name of the TabContainerTabAlign value.
+func (e TabContainerTabAlign) String() string {
+	switch e {
+	case TabContainerAlignCenter:
+		return "ALIGN_CENTER"
+	case TabContainerAlignLeft:
+		return "ALIGN_LEFT"
+	case TabContainerAlignRight:
+		return "ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("TabContainerTabAlign(%d)", int(e))
+}
+
+// ParseTabContainerTabAlign will return the TabContainerTabAlign value with the given Godot name.
+func ParseTabContainerTabAlign(name string) (TabContainerTabAlign, error) {
+	switch name {
+	case "ALIGN_CENTER":
+		return TabContainerAlignCenter, nil
+	case "ALIGN_LEFT":
+		return TabContainerAlignLeft, nil
+	case "ALIGN_RIGHT":
+		return TabContainerAlignRight, nil
+	}
+	return 0, fmt.Errorf("invalid TabContainerTabAlign value %q", name)
+}
+
+/*
+Sets the active tab's [code]visible[/code] property to the value [code]true[/code]. Sets all other children's to [code]false[/code]. Ignores non-[Control] children. Individual tabs are always visible unless you use [method set_tab_disabled] and [method set_tab_title] to hide it. To hide only a tab's content, nest the content inside a child [Control], so it receives the [code]TabContainer[/code]'s visibility setting instead.
+*/
+type TabContainer struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorTabContainer is the class constructor of the TabContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTabContainer = gdnative.NewLazyClassConstructor("TabContainer")
+
+// NewTabContainer will create a new instance of the TabContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTabContainer() *TabContainer {
+	obj := &TabContainer{}
+	obj.SetBaseObject(classConstructorTabContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *TabContainer) BaseClass() string {
+	return "TabContainer"
+}
+
+// Method binds of the TabContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTabContainerX_ChildRenamedCallback = gdnative.NewLazyMethodBind("TabContainer", "_child_renamed_callback")
+	methodBindTabContainerX_GuiInput             = gdnative.NewLazyMethodBind("TabContainer", "_gui_input")
+	methodBindTabContainerX_OnThemeChanged       = gdnative.NewLazyMethodBind("TabContainer", "_on_theme_changed")
+	methodBindTabContainerX_UpdateCurrentTab     = gdnative.NewLazyMethodBind("TabContainer", "_update_current_tab")
+	methodBindTabContainerAreTabsVisible         = gdnative.NewLazyMethodBind("TabContainer", "are_tabs_visible")
+	methodBindTabContainerGetCurrentTab          = gdnative.NewLazyMethodBind("TabContainer", "get_current_tab")
+	methodBindTabContainerGetCurrentTabControl   = gdnative.NewLazyMethodBind("TabContainer", "get_current_tab_control")
+	methodBindTabContainerGetPopup               = gdnative.NewLazyMethodBind("TabContainer", "get_popup")
+	methodBindTabContainerGetPreviousTab         = gdnative.NewLazyMethodBind("TabContainer", "get_previous_tab")
+	methodBindTabContainerGetTabAlign            = gdnative.NewLazyMethodBind("TabContainer", "get_tab_align")
+	methodBindTabContainerGetTabControl          = gdnative.NewLazyMethodBind("TabContainer", "get_tab_control")
+	methodBindTabContainerGetTabCount            = gdnative.NewLazyMethodBind("TabContainer", "get_tab_count")
+	methodBindTabContainerGetTabDisabled         = gdnative.NewLazyMethodBind("TabContainer", "get_tab_disabled")
+	methodBindTabContainerGetTabIcon             = gdnative.NewLazyMethodBind("TabContainer", "get_tab_icon")
+	methodBindTabContainerGetTabTitle            = gdnative.NewLazyMethodBind("TabContainer", "get_tab_title")
+	methodBindTabContainerSetCurrentTab          = gdnative.NewLazyMethodBind("TabContainer", "set_current_tab")
+	methodBindTabContainerSetPopup               = gdnative.NewLazyMethodBind("TabContainer", "set_popup")
+	methodBindTabContainerSetTabAlign            = gdnative.NewLazyMethodBind("TabContainer", "set_tab_align")
+	methodBindTabContainerSetTabDisabled         = gdnative.NewLazyMethodBind("TabContainer", "set_tab_disabled")
+	methodBindTabContainerSetTabIcon             = gdnative.NewLazyMethodBind("TabContainer", "set_tab_icon")
+	methodBindTabContainerSetTabTitle            = gdnative.NewLazyMethodBind("TabContainer", "set_tab_title")
+	methodBindTabContainerSetTabsVisible         = gdnative.NewLazyMethodBind("TabContainer", "set_tabs_visible")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *TabContainer) X_ChildRenamedCallback() {
+	//log.Println("Calling TabContainer.X_ChildRenamedCallback()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerX_ChildRenamedCallback.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *TabContainer) X_GuiInput(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling TabContainer.X_GuiInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindTabContainerX_GuiInput.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *TabContainer) X_OnThemeChanged() {
+	//log.Println("Calling TabContainer.X_OnThemeChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerX_OnThemeChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *TabContainer) X_UpdateCurrentTab() {
+	//log.Println("Calling TabContainer.X_UpdateCurrentTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerX_UpdateCurrentTab.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *TabContainer) AreTabsVisible() gdnative.Bool {
+	//log.Println("Calling TabContainer.AreTabsVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerAreTabsVisible.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *TabContainer) GetCurrentTab() gdnative.Int {
+	//log.Println("Calling TabContainer.GetCurrentTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetCurrentTab.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the child [Control] node located at the active tab index.
+		Args: [], Returns: Control
+*/
+func (o *TabContainer) GetCurrentTabControl() godot.ControlImplementer {
+	//log.Println("Calling TabContainer.GetCurrentTabControl()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetCurrentTabControl.Get()
+
+	// Call the parent method.
+	// Control
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Control{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ControlImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the [Popup] node instance if one has been set already with [method set_popup].
+		Args: [], Returns: Popup
+*/
+func (o *TabContainer) GetPopup() popup.PopupImplementer {
+	//log.Println("Calling TabContainer.GetPopup()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetPopup.Get()
+
+	// Call the parent method.
+	// Popup
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := popup.Popup{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(popup.PopupImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the previously active tab index.
+		Args: [], Returns: int
+*/
+func (o *TabContainer) GetPreviousTab() gdnative.Int {
+	//log.Println("Calling TabContainer.GetPreviousTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetPreviousTab.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.TabContainer::TabAlign
+*/
+func (o *TabContainer) GetTabAlign() TabContainerTabAlign {
+	//log.Println("Calling TabContainer.GetTabAlign()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetTabAlign.Get()
+
+	// Call the parent method.
+	// enum.TabContainer::TabAlign
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TabContainerTabAlign(ret)
+}
+
+/*
+	        Returns the currently visible tab's [Control] node.
+		Args: [{ false idx int}], Returns: Control
+*/
+func (o *TabContainer) GetTabControl(idx gdnative.Int) godot.ControlImplementer {
+	//log.Println("Calling TabContainer.GetTabControl()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetTabControl.Get()
+
+	// Call the parent method.
+	// Control
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Control{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ControlImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the number of tabs.
+		Args: [], Returns: int
+*/
+func (o *TabContainer) GetTabCount() gdnative.Int {
+	//log.Println("Calling TabContainer.GetTabCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetTabCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns [code]true[/code] if the tab at index [code]tab_idx[/code] is disabled.
+		Args: [{ false tab_idx int}], Returns: bool
+*/
+func (o *TabContainer) GetTabDisabled(tabIdx gdnative.Int) gdnative.Bool {
+	//log.Println("Calling TabContainer.GetTabDisabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetTabDisabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the [Texture] for the tab at index [code]tab_idx[/code] or null if the tab has no [Texture].
+		Args: [{ false tab_idx int}], Returns: Texture
+*/
+func (o *TabContainer) GetTabIcon(tabIdx gdnative.Int) godot.TextureImplementer {
+	//log.Println("Calling TabContainer.GetTabIcon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetTabIcon.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the title of the tab at index [code]tab_idx[/code]. Tab titles default to the name of the indexed child node, but this can be overridden with [method set_tab_title].
+		Args: [{ false tab_idx int}], Returns: String
+*/
+func (o *TabContainer) GetTabTitle(tabIdx gdnative.Int) gdnative.String {
+	//log.Println("Calling TabContainer.GetTabTitle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerGetTabTitle.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false tab_idx int}], Returns: void
+*/
+func (o *TabContainer) SetCurrentTab(tabIdx gdnative.Int) {
+	//log.Println("Calling TabContainer.SetCurrentTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerSetCurrentTab.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        If set on a [Popup] node instance, a popup menu icon appears in the top-right corner of the [code]TabContainer[/code]. Clicking it will expand the [Popup] node.
+		Args: [{ false popup Object}], Returns: void
+*/
+func (o *TabContainer) SetPopup(aPopup godot.ObjectImplementer) {
+	//log.Println("Calling TabContainer.SetPopup()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(aPopup))
+
+	// Get the method bind
+	methodBind := methodBindTabContainerSetPopup.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false align enum.TabContainer::TabAlign}], Returns: void
+*/
+func (o *TabContainer) SetTabAlign(align TabContainerTabAlign) {
+	//log.Println("Calling TabContainer.SetTabAlign()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(align))
+
+	// Get the method bind
+	methodBind := methodBindTabContainerSetTabAlign.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        If [code]disabled[/code] is false, hides the tab at index [code]tab_idx[/code]. Note that its title text will remain, unless also removed with [method set_tab_title].
+		Args: [{ false tab_idx int} { false disabled bool}], Returns: void
+*/
+func (o *TabContainer) SetTabDisabled(tabIdx gdnative.Int, disabled gdnative.Bool) {
+	//log.Println("Calling TabContainer.SetTabDisabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerSetTabDisabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Sets an icon for the tab at index [code]tab_idx[/code].
+		Args: [{ false tab_idx int} { false icon Texture}], Returns: void
+*/
+func (o *TabContainer) SetTabIcon(tabIdx gdnative.Int, icon godot.TextureImplementer) {
+	//log.Println("Calling TabContainer.SetTabIcon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
+
+	// Get the method bind
+	methodBind := methodBindTabContainerSetTabIcon.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Sets a title for the tab at index [code]tab_idx[/code]. Tab titles default to the name of the indexed child node, but this can be overridden with [method set_tab_title].
+		Args: [{ false tab_idx int} { false title String}], Returns: void
+*/
+func (o *TabContainer) SetTabTitle(tabIdx gdnative.Int, title gdnative.String) {
+	//log.Println("Calling TabContainer.SetTabTitle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+	ptrArguments[1] = gdnative.NewPointerFromString(title)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerSetTabTitle.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false visible bool}], Returns: void
+*/
+func (o *TabContainer) SetTabsVisible(visible gdnative.Bool) {
+	//log.Println("Calling TabContainer.SetTabsVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(visible)
+
+	// Get the method bind
+	methodBind := methodBindTabContainerSetTabsVisible.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// CurrentTab will return the value of the "current_tab" property.
+func (o *TabContainer) CurrentTab() gdnative.Int {
+	return o.GetCurrentTab()
+}
+
+// TabAlign will return the value of the "tab_align" property.
+func (o *TabContainer) TabAlign() TabContainerTabAlign {
+	return o.GetTabAlign()
+}
+
+// TabsVisible will return the value of the "tabs_visible" property.
+func (o *TabContainer) TabsVisible() gdnative.Bool {
+	return o.AreTabsVisible()
+}
+
+// Signals of the TabContainer class.
+const (
+	TabContainerSignalPrePopupPressed gdnative.String = "pre_popup_pressed"
+	TabContainerSignalTabChanged      gdnative.String = "tab_changed"
+	TabContainerSignalTabSelected     gdnative.String = "tab_selected"
+)
+
+// ConnectPrePopupPressed will connect the "pre_popup_pressed" signal to the given method of the target object.
+func (o *TabContainer) ConnectPrePopupPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabContainerSignalPrePopupPressed, target, method, binds, 0)
+}
+
+// EmitPrePopupPressed will emit the "pre_popup_pressed" signal.
+func (o *TabContainer) EmitPrePopupPressed() error {
+
+	ret, err := o.EmitSignal(TabContainerSignalPrePopupPressed)
+	ret.Destroy()
+	return err
+}
+
+// TabContainerTabChangedArgs holds the arguments of the "tab_changed" signal.
+type TabContainerTabChangedArgs struct {
+	Tab gdnative.Int
+}
+
+// ConnectTabChanged will connect the "tab_changed" signal to the given method of the target object.
+func (o *TabContainer) ConnectTabChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabContainerSignalTabChanged, target, method, binds, 0)
+}
+
+// EmitTabChanged will emit the "tab_changed" signal.
+func (o *TabContainer) EmitTabChanged(args TabContainerTabChangedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Tab))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabContainerSignalTabChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabContainerTabSelectedArgs holds the arguments of the "tab_selected" signal.
+type TabContainerTabSelectedArgs struct {
+	Tab gdnative.Int
+}
+
+// ConnectTabSelected will connect the "tab_selected" signal to the given method of the target object.
+func (o *TabContainer) ConnectTabSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabContainerSignalTabSelected, target, method, binds, 0)
+}
+
+// EmitTabSelected will emit the "tab_selected" signal.
+func (o *TabContainer) EmitTabSelected(args TabContainerTabSelectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Tab))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabContainerSignalTabSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabContainerImplementer is an interface that implements the methods
+// of the TabContainer class.
+type TabContainerImplementer interface {
+	godot.ControlImplementer
+	X_ChildRenamedCallback()
+	X_OnThemeChanged()
+	X_UpdateCurrentTab()
+	AreTabsVisible() gdnative.Bool
+	GetCurrentTab() gdnative.Int
+	GetCurrentTabControl() godot.ControlImplementer
+	GetPopup() popup.PopupImplementer
+	GetPreviousTab() gdnative.Int
+	GetTabAlign() TabContainerTabAlign
+	GetTabControl(idx gdnative.Int) godot.ControlImplementer
+	GetTabCount() gdnative.Int
+	GetTabDisabled(tabIdx gdnative.Int) gdnative.Bool
+	GetTabIcon(tabIdx gdnative.Int) godot.TextureImplementer
+	GetTabTitle(tabIdx gdnative.Int) gdnative.String
+	SetCurrentTab(tabIdx gdnative.Int)
+	SetPopup(aPopup godot.ObjectImplementer)
+	SetTabAlign(align TabContainerTabAlign)
+	SetTabDisabled(tabIdx gdnative.Int, disabled gdnative.Bool)
+	SetTabIcon(tabIdx gdnative.Int, icon godot.TextureImplementer)
+	SetTabTitle(tabIdx gdnative.Int, title gdnative.String)
+	SetTabsVisible(visible gdnative.Bool)
+	CurrentTab() gdnative.Int
+	TabAlign() TabContainerTabAlign
+	TabsVisible() gdnative.Bool
+	ConnectPrePopupPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPrePopupPressed() error
+	ConnectTabChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTabChanged(args TabContainerTabChangedArgs) error
+	ConnectTabSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTabSelected(args TabContainerTabSelectedArgs) error
+}
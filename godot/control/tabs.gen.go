@@ -0,0 +1,883 @@
+package control
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TabsCloseButtonDisplayPolicy is an enum for CloseButtonDisplayPolicy values.
+type TabsCloseButtonDisplayPolicy int
+
+const (
+	TabsCloseButtonMax            TabsCloseButtonDisplayPolicy = 3
+	TabsCloseButtonShowActiveOnly TabsCloseButtonDisplayPolicy = 1
+	TabsCloseButtonShowAlways     TabsCloseButtonDisplayPolicy = 2
+	TabsCloseButtonShowNever      TabsCloseButtonDisplayPolicy = 0
+)
+
+// String will return the Godot name of the TabsCloseButtonDisplayPolicy value.
+func (e TabsCloseButtonDisplayPolicy) String() string {
+	switch e {
+	case TabsCloseButtonMax:
+		return "CLOSE_BUTTON_MAX"
+	case TabsCloseButtonShowActiveOnly:
+		return "CLOSE_BUTTON_SHOW_ACTIVE_ONLY"
+	case TabsCloseButtonShowAlways:
+		return "CLOSE_BUTTON_SHOW_ALWAYS"
+	case TabsCloseButtonShowNever:
+		return "CLOSE_BUTTON_SHOW_NEVER"
+	}
+	return fmt.Sprintf("TabsCloseButtonDisplayPolicy(%d)", int(e))
+}
+
+// ParseTabsCloseButtonDisplayPolicy will return the TabsCloseButtonDisplayPolicy value with the given Godot name.
+func ParseTabsCloseButtonDisplayPolicy(name string) (TabsCloseButtonDisplayPolicy, error) {
+	switch name {
+	case "CLOSE_BUTTON_MAX":
+		return TabsCloseButtonMax, nil
+	case "CLOSE_BUTTON_SHOW_ACTIVE_ONLY":
+		return TabsCloseButtonShowActiveOnly, nil
+	case "CLOSE_BUTTON_SHOW_ALWAYS":
+		return TabsCloseButtonShowAlways, nil
+	case "CLOSE_BUTTON_SHOW_NEVER":
+		return TabsCloseButtonShowNever, nil
+	}
+	return 0, fmt.Errorf("invalid TabsCloseButtonDisplayPolicy value %q", name)
+}
+
+// TabsTabAlign is an enum for TabAlign values.
+type TabsTabAlign int
+
+const (
+	TabsAlignCenter TabsTabAlign = 1
+	TabsAlignLeft   TabsTabAlign = 0
+	TabsAlignMax    TabsTabAlign = 3
+	TabsAlignRight  TabsTabAlign = 2
+)
+
+// String will return the Godot name of the TabsTabAlign value.
+func (e TabsTabAlign) String() string {
+	switch e {
+	case TabsAlignCenter:
+		return "ALIGN_CENTER"
+	case TabsAlignLeft:
+		return "ALIGN_LEFT"
+	case TabsAlignMax:
+		return "ALIGN_MAX"
+	case TabsAlignRight:
+		return "ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("TabsTabAlign(%d)", int(e))
+}
+
+// ParseTabsTabAlign will return the TabsTabAlign value with the given Godot name.
+func ParseTabsTabAlign(name string) (TabsTabAlign, error) {
+	switch name {
+	case "ALIGN_CENTER":
+		return TabsAlignCenter, nil
+	case "ALIGN_LEFT":
+		return TabsAlignLeft, nil
+	case "ALIGN_MAX":
+		return TabsAlignMax, nil
+	case "ALIGN_RIGHT":
+		return TabsAlignRight, nil
+	}
+	return 0, fmt.Errorf("invalid TabsTabAlign value %q", name)
+}
+
+/*
+Simple tabs control, similar to [TabContainer] but is only in charge of drawing tabs, not interact with children.
+*/
+type Tabs struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorTabs is the class constructor of the Tabs class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTabs = gdnative.NewLazyClassConstructor("Tabs")
+
+// NewTabs will create a new instance of the Tabs class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTabs() *Tabs {
+	obj := &Tabs{}
+	obj.SetBaseObject(classConstructorTabs.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Tabs) BaseClass() string {
+	return "Tabs"
+}
+
+// Method binds of the Tabs class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTabsX_GuiInput               = gdnative.NewLazyMethodBind("Tabs", "_gui_input")
+	methodBindTabsAddTab                   = gdnative.NewLazyMethodBind("Tabs", "add_tab")
+	methodBindTabsEnsureTabVisible         = gdnative.NewLazyMethodBind("Tabs", "ensure_tab_visible")
+	methodBindTabsGetCurrentTab            = gdnative.NewLazyMethodBind("Tabs", "get_current_tab")
+	methodBindTabsGetOffsetButtonsVisible  = gdnative.NewLazyMethodBind("Tabs", "get_offset_buttons_visible")
+	methodBindTabsGetScrollingEnabled      = gdnative.NewLazyMethodBind("Tabs", "get_scrolling_enabled")
+	methodBindTabsGetTabAlign              = gdnative.NewLazyMethodBind("Tabs", "get_tab_align")
+	methodBindTabsGetTabCloseDisplayPolicy = gdnative.NewLazyMethodBind("Tabs", "get_tab_close_display_policy")
+	methodBindTabsGetTabCount              = gdnative.NewLazyMethodBind("Tabs", "get_tab_count")
+	methodBindTabsGetTabDisabled           = gdnative.NewLazyMethodBind("Tabs", "get_tab_disabled")
+	methodBindTabsGetTabIcon               = gdnative.NewLazyMethodBind("Tabs", "get_tab_icon")
+	methodBindTabsGetTabOffset             = gdnative.NewLazyMethodBind("Tabs", "get_tab_offset")
+	methodBindTabsGetTabRect               = gdnative.NewLazyMethodBind("Tabs", "get_tab_rect")
+	methodBindTabsGetTabTitle              = gdnative.NewLazyMethodBind("Tabs", "get_tab_title")
+	methodBindTabsMoveTab                  = gdnative.NewLazyMethodBind("Tabs", "move_tab")
+	methodBindTabsRemoveTab                = gdnative.NewLazyMethodBind("Tabs", "remove_tab")
+	methodBindTabsSetCurrentTab            = gdnative.NewLazyMethodBind("Tabs", "set_current_tab")
+	methodBindTabsSetScrollingEnabled      = gdnative.NewLazyMethodBind("Tabs", "set_scrolling_enabled")
+	methodBindTabsSetTabAlign              = gdnative.NewLazyMethodBind("Tabs", "set_tab_align")
+	methodBindTabsSetTabCloseDisplayPolicy = gdnative.NewLazyMethodBind("Tabs", "set_tab_close_display_policy")
+	methodBindTabsSetTabDisabled           = gdnative.NewLazyMethodBind("Tabs", "set_tab_disabled")
+	methodBindTabsSetTabIcon               = gdnative.NewLazyMethodBind("Tabs", "set_tab_icon")
+	methodBindTabsSetTabTitle              = gdnative.NewLazyMethodBind("Tabs", "set_tab_title")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *Tabs) X_GuiInput(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling Tabs.X_GuiInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindTabsX_GuiInput.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ true title String} {[Object:null] true icon Texture}], Returns: void
+*/
+func (o *Tabs) AddTab(title gdnative.String, icon godot.TextureImplementer) {
+	//log.Println("Calling Tabs.AddTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(title)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
+
+	// Get the method bind
+	methodBind := methodBindTabsAddTab.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false idx int}], Returns: void
+*/
+func (o *Tabs) EnsureTabVisible(idx gdnative.Int) {
+	//log.Println("Calling Tabs.EnsureTabVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
+
+	// Get the method bind
+	methodBind := methodBindTabsEnsureTabVisible.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Tabs) GetCurrentTab() gdnative.Int {
+	//log.Println("Calling Tabs.GetCurrentTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetCurrentTab.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [], Returns: bool
+*/
+func (o *Tabs) GetOffsetButtonsVisible() gdnative.Bool {
+	//log.Println("Calling Tabs.GetOffsetButtonsVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetOffsetButtonsVisible.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Tabs) GetScrollingEnabled() gdnative.Bool {
+	//log.Println("Calling Tabs.GetScrollingEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetScrollingEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Tabs::TabAlign
+*/
+func (o *Tabs) GetTabAlign() TabsTabAlign {
+	//log.Println("Calling Tabs.GetTabAlign()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabAlign.Get()
+
+	// Call the parent method.
+	// enum.Tabs::TabAlign
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TabsTabAlign(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Tabs::CloseButtonDisplayPolicy
+*/
+func (o *Tabs) GetTabCloseDisplayPolicy() TabsCloseButtonDisplayPolicy {
+	//log.Println("Calling Tabs.GetTabCloseDisplayPolicy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabCloseDisplayPolicy.Get()
+
+	// Call the parent method.
+	// enum.Tabs::CloseButtonDisplayPolicy
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TabsCloseButtonDisplayPolicy(ret)
+}
+
+/*
+Args: [], Returns: int
+*/
+func (o *Tabs) GetTabCount() gdnative.Int {
+	//log.Println("Calling Tabs.GetTabCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [{ false tab_idx int}], Returns: bool
+*/
+func (o *Tabs) GetTabDisabled(tabIdx gdnative.Int) gdnative.Bool {
+	//log.Println("Calling Tabs.GetTabDisabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabDisabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [{ false tab_idx int}], Returns: Texture
+*/
+func (o *Tabs) GetTabIcon(tabIdx gdnative.Int) godot.TextureImplementer {
+	//log.Println("Calling Tabs.GetTabIcon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabIcon.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [], Returns: int
+*/
+func (o *Tabs) GetTabOffset() gdnative.Int {
+	//log.Println("Calling Tabs.GetTabOffset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabOffset.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns tab [Rect2] with local position and size.
+		Args: [{ false tab_idx int}], Returns: Rect2
+*/
+func (o *Tabs) GetTabRect(tabIdx gdnative.Int) gdnative.Rect2 {
+	//log.Println("Calling Tabs.GetTabRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabRect.Get()
+
+	// Call the parent method.
+	// Rect2
+	retPtr := gdnative.NewEmptyRect2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRect2FromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [{ false tab_idx int}], Returns: String
+*/
+func (o *Tabs) GetTabTitle(tabIdx gdnative.Int) gdnative.String {
+	//log.Println("Calling Tabs.GetTabTitle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabsGetTabTitle.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Rearrange tab.
+		Args: [{ false from int} { false to int}], Returns: void
+*/
+func (o *Tabs) MoveTab(from gdnative.Int, to gdnative.Int) {
+	//log.Println("Calling Tabs.MoveTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(from)
+	ptrArguments[1] = gdnative.NewPointerFromInt(to)
+
+	// Get the method bind
+	methodBind := methodBindTabsMoveTab.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false tab_idx int}], Returns: void
+*/
+func (o *Tabs) RemoveTab(tabIdx gdnative.Int) {
+	//log.Println("Calling Tabs.RemoveTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabsRemoveTab.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false tab_idx int}], Returns: void
+*/
+func (o *Tabs) SetCurrentTab(tabIdx gdnative.Int) {
+	//log.Println("Calling Tabs.SetCurrentTab()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+
+	// Get the method bind
+	methodBind := methodBindTabsSetCurrentTab.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *Tabs) SetScrollingEnabled(enabled gdnative.Bool) {
+	//log.Println("Calling Tabs.SetScrollingEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindTabsSetScrollingEnabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false align enum.Tabs::TabAlign}], Returns: void
+*/
+func (o *Tabs) SetTabAlign(align TabsTabAlign) {
+	//log.Println("Calling Tabs.SetTabAlign()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(align))
+
+	// Get the method bind
+	methodBind := methodBindTabsSetTabAlign.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false policy enum.Tabs::CloseButtonDisplayPolicy}], Returns: void
+*/
+func (o *Tabs) SetTabCloseDisplayPolicy(policy TabsCloseButtonDisplayPolicy) {
+	//log.Println("Calling Tabs.SetTabCloseDisplayPolicy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(policy))
+
+	// Get the method bind
+	methodBind := methodBindTabsSetTabCloseDisplayPolicy.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false tab_idx int} { false disabled bool}], Returns: void
+*/
+func (o *Tabs) SetTabDisabled(tabIdx gdnative.Int, disabled gdnative.Bool) {
+	//log.Println("Calling Tabs.SetTabDisabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
+
+	// Get the method bind
+	methodBind := methodBindTabsSetTabDisabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false tab_idx int} { false icon Texture}], Returns: void
+*/
+func (o *Tabs) SetTabIcon(tabIdx gdnative.Int, icon godot.TextureImplementer) {
+	//log.Println("Calling Tabs.SetTabIcon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
+
+	// Get the method bind
+	methodBind := methodBindTabsSetTabIcon.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false tab_idx int} { false title String}], Returns: void
+*/
+func (o *Tabs) SetTabTitle(tabIdx gdnative.Int, title gdnative.String) {
+	//log.Println("Calling Tabs.SetTabTitle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(tabIdx)
+	ptrArguments[1] = gdnative.NewPointerFromString(title)
+
+	// Get the method bind
+	methodBind := methodBindTabsSetTabTitle.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AddTabWithDefaults will call AddTab using the default values for: title, icon.
+func (o *Tabs) AddTabWithDefaults() {
+	o.AddTab("", nil)
+}
+
+// CurrentTab will return the value of the "current_tab" property.
+func (o *Tabs) CurrentTab() gdnative.Int {
+	return o.GetCurrentTab()
+}
+
+// ScrollingEnabled will return the value of the "scrolling_enabled" property.
+func (o *Tabs) ScrollingEnabled() gdnative.Bool {
+	return o.GetScrollingEnabled()
+}
+
+// TabAlign will return the value of the "tab_align" property.
+func (o *Tabs) TabAlign() TabsTabAlign {
+	return o.GetTabAlign()
+}
+
+// TabCloseDisplayPolicy will return the value of the "tab_close_display_policy" property.
+func (o *Tabs) TabCloseDisplayPolicy() TabsCloseButtonDisplayPolicy {
+	return o.GetTabCloseDisplayPolicy()
+}
+
+// Signals of the Tabs class.
+const (
+	TabsSignalRepositionActiveTabRequest gdnative.String = "reposition_active_tab_request"
+	TabsSignalRightButtonPressed         gdnative.String = "right_button_pressed"
+	TabsSignalTabChanged                 gdnative.String = "tab_changed"
+	TabsSignalTabClicked                 gdnative.String = "tab_clicked"
+	TabsSignalTabClose                   gdnative.String = "tab_close"
+	TabsSignalTabHover                   gdnative.String = "tab_hover"
+)
+
+// TabsRepositionActiveTabRequestArgs holds the arguments of the "reposition_active_tab_request" signal.
+type TabsRepositionActiveTabRequestArgs struct {
+	IdxTo gdnative.Int
+}
+
+// ConnectRepositionActiveTabRequest will connect the "reposition_active_tab_request" signal to the given method of the target object.
+func (o *Tabs) ConnectRepositionActiveTabRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabsSignalRepositionActiveTabRequest, target, method, binds, 0)
+}
+
+// EmitRepositionActiveTabRequest will emit the "reposition_active_tab_request" signal.
+func (o *Tabs) EmitRepositionActiveTabRequest(args TabsRepositionActiveTabRequestArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.IdxTo))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabsSignalRepositionActiveTabRequest, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabsRightButtonPressedArgs holds the arguments of the "right_button_pressed" signal.
+type TabsRightButtonPressedArgs struct {
+	Tab gdnative.Int
+}
+
+// ConnectRightButtonPressed will connect the "right_button_pressed" signal to the given method of the target object.
+func (o *Tabs) ConnectRightButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabsSignalRightButtonPressed, target, method, binds, 0)
+}
+
+// EmitRightButtonPressed will emit the "right_button_pressed" signal.
+func (o *Tabs) EmitRightButtonPressed(args TabsRightButtonPressedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Tab))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabsSignalRightButtonPressed, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabsTabChangedArgs holds the arguments of the "tab_changed" signal.
+type TabsTabChangedArgs struct {
+	Tab gdnative.Int
+}
+
+// ConnectTabChanged will connect the "tab_changed" signal to the given method of the target object.
+func (o *Tabs) ConnectTabChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabsSignalTabChanged, target, method, binds, 0)
+}
+
+// EmitTabChanged will emit the "tab_changed" signal.
+func (o *Tabs) EmitTabChanged(args TabsTabChangedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Tab))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabsSignalTabChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabsTabClickedArgs holds the arguments of the "tab_clicked" signal.
+type TabsTabClickedArgs struct {
+	Tab gdnative.Int
+}
+
+// ConnectTabClicked will connect the "tab_clicked" signal to the given method of the target object.
+func (o *Tabs) ConnectTabClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabsSignalTabClicked, target, method, binds, 0)
+}
+
+// EmitTabClicked will emit the "tab_clicked" signal.
+func (o *Tabs) EmitTabClicked(args TabsTabClickedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Tab))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabsSignalTabClicked, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabsTabCloseArgs holds the arguments of the "tab_close" signal.
+type TabsTabCloseArgs struct {
+	Tab gdnative.Int
+}
+
+// ConnectTabClose will connect the "tab_close" signal to the given method of the target object.
+func (o *Tabs) ConnectTabClose(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabsSignalTabClose, target, method, binds, 0)
+}
+
+// EmitTabClose will emit the "tab_close" signal.
+func (o *Tabs) EmitTabClose(args TabsTabCloseArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Tab))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabsSignalTabClose, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabsTabHoverArgs holds the arguments of the "tab_hover" signal.
+type TabsTabHoverArgs struct {
+	Tab gdnative.Int
+}
+
+// ConnectTabHover will connect the "tab_hover" signal to the given method of the target object.
+func (o *Tabs) ConnectTabHover(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TabsSignalTabHover, target, method, binds, 0)
+}
+
+// EmitTabHover will emit the "tab_hover" signal.
+func (o *Tabs) EmitTabHover(args TabsTabHoverArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Tab))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TabsSignalTabHover, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TabsImplementer is an interface that implements the methods
+// of the Tabs class.
+type TabsImplementer interface {
+	godot.ControlImplementer
+	AddTab(title gdnative.String, icon godot.TextureImplementer)
+	EnsureTabVisible(idx gdnative.Int)
+	GetCurrentTab() gdnative.Int
+	GetOffsetButtonsVisible() gdnative.Bool
+	GetScrollingEnabled() gdnative.Bool
+	GetTabAlign() TabsTabAlign
+	GetTabCloseDisplayPolicy() TabsCloseButtonDisplayPolicy
+	GetTabCount() gdnative.Int
+	GetTabDisabled(tabIdx gdnative.Int) gdnative.Bool
+	GetTabIcon(tabIdx gdnative.Int) godot.TextureImplementer
+	GetTabOffset() gdnative.Int
+	GetTabRect(tabIdx gdnative.Int) gdnative.Rect2
+	GetTabTitle(tabIdx gdnative.Int) gdnative.String
+	MoveTab(from gdnative.Int, to gdnative.Int)
+	RemoveTab(tabIdx gdnative.Int)
+	SetCurrentTab(tabIdx gdnative.Int)
+	SetScrollingEnabled(enabled gdnative.Bool)
+	SetTabAlign(align TabsTabAlign)
+	SetTabCloseDisplayPolicy(policy TabsCloseButtonDisplayPolicy)
+	SetTabDisabled(tabIdx gdnative.Int, disabled gdnative.Bool)
+	SetTabIcon(tabIdx gdnative.Int, icon godot.TextureImplementer)
+	SetTabTitle(tabIdx gdnative.Int, title gdnative.String)
+	AddTabWithDefaults()
+	CurrentTab() gdnative.Int
+	ScrollingEnabled() gdnative.Bool
+	TabAlign() TabsTabAlign
+	TabCloseDisplayPolicy() TabsCloseButtonDisplayPolicy
+	ConnectRepositionActiveTabRequest(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRepositionActiveTabRequest(args TabsRepositionActiveTabRequestArgs) error
+	ConnectRightButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRightButtonPressed(args TabsRightButtonPressedArgs) error
+	ConnectTabChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTabChanged(args TabsTabChangedArgs) error
+	ConnectTabClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTabClicked(args TabsTabClickedArgs) error
+	ConnectTabClose(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTabClose(args TabsTabCloseArgs) error
+	ConnectTabHover(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTabHover(args TabsTabHoverArgs) error
+}
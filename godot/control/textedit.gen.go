@@ -1,7 +1,11 @@
-package godot
+package control
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/popup"
 )
 
 /*------------------------------------------------------------------------------
@@ -26,6 +30,48 @@ const (
 	TextEditMenuUndo      TextEditMenuItems = 5
 )
 
+// String will return the Godot name of the TextEditMenuItems value.
+func (e TextEditMenuItems) String() string {
+	switch e {
+	case TextEditMenuClear:
+		return "MENU_CLEAR"
+	case TextEditMenuCopy:
+		return "MENU_COPY"
+	case TextEditMenuCut:
+		return "MENU_CUT"
+	case TextEditMenuMax:
+		return "MENU_MAX"
+	case TextEditMenuPaste:
+		return "MENU_PASTE"
+	case TextEditMenuSelectAll:
+		return "MENU_SELECT_ALL"
+	case TextEditMenuUndo:
+		return "MENU_UNDO"
+	}
+	return fmt.Sprintf("TextEditMenuItems(%d)", int(e))
+}
+
+// ParseTextEditMenuItems will return the TextEditMenuItems value with the given Godot name.
+func ParseTextEditMenuItems(name string) (TextEditMenuItems, error) {
+	switch name {
+	case "MENU_CLEAR":
+		return TextEditMenuClear, nil
+	case "MENU_COPY":
+		return TextEditMenuCopy, nil
+	case "MENU_CUT":
+		return TextEditMenuCut, nil
+	case "MENU_MAX":
+		return TextEditMenuMax, nil
+	case "MENU_PASTE":
+		return TextEditMenuPaste, nil
+	case "MENU_SELECT_ALL":
+		return TextEditMenuSelectAll, nil
+	case "MENU_UNDO":
+		return TextEditMenuUndo, nil
+	}
+	return 0, fmt.Errorf("invalid TextEditMenuItems value %q", name)
+}
+
 // TextEditSearchFlags is an enum for SearchFlags values.
 type TextEditSearchFlags int
 
@@ -35,30 +81,144 @@ const (
 	TextEditSearchWholeWords TextEditSearchFlags = 2
 )
 
-//func NewTextEditFromPointer(ptr gdnative.Pointer) TextEdit {
-func newTextEditFromPointer(ptr gdnative.Pointer) TextEdit {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := TextEdit{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the TextEditSearchFlags value.
+func (e TextEditSearchFlags) String() string {
+	switch e {
+	case TextEditSearchBackwards:
+		return "SEARCH_BACKWARDS"
+	case TextEditSearchMatchCase:
+		return "SEARCH_MATCH_CASE"
+	case TextEditSearchWholeWords:
+		return "SEARCH_WHOLE_WORDS"
+	}
+	return fmt.Sprintf("TextEditSearchFlags(%d)", int(e))
+}
+
+// ParseTextEditSearchFlags will return the TextEditSearchFlags value with the given Godot name.
+func ParseTextEditSearchFlags(name string) (TextEditSearchFlags, error) {
+	switch name {
+	case "SEARCH_BACKWARDS":
+		return TextEditSearchBackwards, nil
+	case "SEARCH_MATCH_CASE":
+		return TextEditSearchMatchCase, nil
+	case "SEARCH_WHOLE_WORDS":
+		return TextEditSearchWholeWords, nil
+	}
+	return 0, fmt.Errorf("invalid TextEditSearchFlags value %q", name)
 }
 
 /*
 TextEdit is meant for editing large, multiline text. It also has facilities for editing code, such as syntax highlighting support and multiple levels of undo/redo.
 */
 type TextEdit struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorTextEdit is the class constructor of the TextEdit class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTextEdit = gdnative.NewLazyClassConstructor("TextEdit")
+
+// NewTextEdit will create a new instance of the TextEdit class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTextEdit() *TextEdit {
+	obj := &TextEdit{}
+	obj.SetBaseObject(classConstructorTextEdit.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *TextEdit) BaseClass() string {
 	return "TextEdit"
 }
 
+// Method binds of the TextEdit class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTextEditX_ClickSelectionHeld             = gdnative.NewLazyMethodBind("TextEdit", "_click_selection_held")
+	methodBindTextEditX_CursorChangedEmit              = gdnative.NewLazyMethodBind("TextEdit", "_cursor_changed_emit")
+	methodBindTextEditX_GuiInput                       = gdnative.NewLazyMethodBind("TextEdit", "_gui_input")
+	methodBindTextEditX_PushCurrentOp                  = gdnative.NewLazyMethodBind("TextEdit", "_push_current_op")
+	methodBindTextEditX_ScrollMoved                    = gdnative.NewLazyMethodBind("TextEdit", "_scroll_moved")
+	methodBindTextEditX_TextChangedEmit                = gdnative.NewLazyMethodBind("TextEdit", "_text_changed_emit")
+	methodBindTextEditX_ToggleDrawCaret                = gdnative.NewLazyMethodBind("TextEdit", "_toggle_draw_caret")
+	methodBindTextEditX_VScrollInput                   = gdnative.NewLazyMethodBind("TextEdit", "_v_scroll_input")
+	methodBindTextEditAddColorRegion                   = gdnative.NewLazyMethodBind("TextEdit", "add_color_region")
+	methodBindTextEditAddKeywordColor                  = gdnative.NewLazyMethodBind("TextEdit", "add_keyword_color")
+	methodBindTextEditCanFold                          = gdnative.NewLazyMethodBind("TextEdit", "can_fold")
+	methodBindTextEditClearColors                      = gdnative.NewLazyMethodBind("TextEdit", "clear_colors")
+	methodBindTextEditClearUndoHistory                 = gdnative.NewLazyMethodBind("TextEdit", "clear_undo_history")
+	methodBindTextEditCopy                             = gdnative.NewLazyMethodBind("TextEdit", "copy")
+	methodBindTextEditCursorGetBlinkEnabled            = gdnative.NewLazyMethodBind("TextEdit", "cursor_get_blink_enabled")
+	methodBindTextEditCursorGetBlinkSpeed              = gdnative.NewLazyMethodBind("TextEdit", "cursor_get_blink_speed")
+	methodBindTextEditCursorGetColumn                  = gdnative.NewLazyMethodBind("TextEdit", "cursor_get_column")
+	methodBindTextEditCursorGetLine                    = gdnative.NewLazyMethodBind("TextEdit", "cursor_get_line")
+	methodBindTextEditCursorIsBlockMode                = gdnative.NewLazyMethodBind("TextEdit", "cursor_is_block_mode")
+	methodBindTextEditCursorSetBlinkEnabled            = gdnative.NewLazyMethodBind("TextEdit", "cursor_set_blink_enabled")
+	methodBindTextEditCursorSetBlinkSpeed              = gdnative.NewLazyMethodBind("TextEdit", "cursor_set_blink_speed")
+	methodBindTextEditCursorSetBlockMode               = gdnative.NewLazyMethodBind("TextEdit", "cursor_set_block_mode")
+	methodBindTextEditCursorSetColumn                  = gdnative.NewLazyMethodBind("TextEdit", "cursor_set_column")
+	methodBindTextEditCursorSetLine                    = gdnative.NewLazyMethodBind("TextEdit", "cursor_set_line")
+	methodBindTextEditCut                              = gdnative.NewLazyMethodBind("TextEdit", "cut")
+	methodBindTextEditDeselect                         = gdnative.NewLazyMethodBind("TextEdit", "deselect")
+	methodBindTextEditFoldAllLines                     = gdnative.NewLazyMethodBind("TextEdit", "fold_all_lines")
+	methodBindTextEditFoldLine                         = gdnative.NewLazyMethodBind("TextEdit", "fold_line")
+	methodBindTextEditGetLine                          = gdnative.NewLazyMethodBind("TextEdit", "get_line")
+	methodBindTextEditGetLineCount                     = gdnative.NewLazyMethodBind("TextEdit", "get_line_count")
+	methodBindTextEditGetMenu                          = gdnative.NewLazyMethodBind("TextEdit", "get_menu")
+	methodBindTextEditGetSelectionFromColumn           = gdnative.NewLazyMethodBind("TextEdit", "get_selection_from_column")
+	methodBindTextEditGetSelectionFromLine             = gdnative.NewLazyMethodBind("TextEdit", "get_selection_from_line")
+	methodBindTextEditGetSelectionText                 = gdnative.NewLazyMethodBind("TextEdit", "get_selection_text")
+	methodBindTextEditGetSelectionToColumn             = gdnative.NewLazyMethodBind("TextEdit", "get_selection_to_column")
+	methodBindTextEditGetSelectionToLine               = gdnative.NewLazyMethodBind("TextEdit", "get_selection_to_line")
+	methodBindTextEditGetText                          = gdnative.NewLazyMethodBind("TextEdit", "get_text")
+	methodBindTextEditGetVScrollSpeed                  = gdnative.NewLazyMethodBind("TextEdit", "get_v_scroll_speed")
+	methodBindTextEditGetWordUnderCursor               = gdnative.NewLazyMethodBind("TextEdit", "get_word_under_cursor")
+	methodBindTextEditInsertTextAtCursor               = gdnative.NewLazyMethodBind("TextEdit", "insert_text_at_cursor")
+	methodBindTextEditIsContextMenuEnabled             = gdnative.NewLazyMethodBind("TextEdit", "is_context_menu_enabled")
+	methodBindTextEditIsFolded                         = gdnative.NewLazyMethodBind("TextEdit", "is_folded")
+	methodBindTextEditIsHidingEnabled                  = gdnative.NewLazyMethodBind("TextEdit", "is_hiding_enabled")
+	methodBindTextEditIsHighlightAllOccurrencesEnabled = gdnative.NewLazyMethodBind("TextEdit", "is_highlight_all_occurrences_enabled")
+	methodBindTextEditIsHighlightCurrentLineEnabled    = gdnative.NewLazyMethodBind("TextEdit", "is_highlight_current_line_enabled")
+	methodBindTextEditIsLineHidden                     = gdnative.NewLazyMethodBind("TextEdit", "is_line_hidden")
+	methodBindTextEditIsOverridingSelectedFontColor    = gdnative.NewLazyMethodBind("TextEdit", "is_overriding_selected_font_color")
+	methodBindTextEditIsReadonly                       = gdnative.NewLazyMethodBind("TextEdit", "is_readonly")
+	methodBindTextEditIsRightClickMovingCaret          = gdnative.NewLazyMethodBind("TextEdit", "is_right_click_moving_caret")
+	methodBindTextEditIsSelectionActive                = gdnative.NewLazyMethodBind("TextEdit", "is_selection_active")
+	methodBindTextEditIsShowLineNumbersEnabled         = gdnative.NewLazyMethodBind("TextEdit", "is_show_line_numbers_enabled")
+	methodBindTextEditIsSmoothScrollEnabled            = gdnative.NewLazyMethodBind("TextEdit", "is_smooth_scroll_enabled")
+	methodBindTextEditIsSyntaxColoringEnabled          = gdnative.NewLazyMethodBind("TextEdit", "is_syntax_coloring_enabled")
+	methodBindTextEditIsWrapping                       = gdnative.NewLazyMethodBind("TextEdit", "is_wrapping")
+	methodBindTextEditMenuOption                       = gdnative.NewLazyMethodBind("TextEdit", "menu_option")
+	methodBindTextEditPaste                            = gdnative.NewLazyMethodBind("TextEdit", "paste")
+	methodBindTextEditRedo                             = gdnative.NewLazyMethodBind("TextEdit", "redo")
+	methodBindTextEditSearch                           = gdnative.NewLazyMethodBind("TextEdit", "search")
+	methodBindTextEditSelect                           = gdnative.NewLazyMethodBind("TextEdit", "select")
+	methodBindTextEditSelectAll                        = gdnative.NewLazyMethodBind("TextEdit", "select_all")
+	methodBindTextEditSetContextMenuEnabled            = gdnative.NewLazyMethodBind("TextEdit", "set_context_menu_enabled")
+	methodBindTextEditSetHidingEnabled                 = gdnative.NewLazyMethodBind("TextEdit", "set_hiding_enabled")
+	methodBindTextEditSetHighlightAllOccurrences       = gdnative.NewLazyMethodBind("TextEdit", "set_highlight_all_occurrences")
+	methodBindTextEditSetHighlightCurrentLine          = gdnative.NewLazyMethodBind("TextEdit", "set_highlight_current_line")
+	methodBindTextEditSetLineAsHidden                  = gdnative.NewLazyMethodBind("TextEdit", "set_line_as_hidden")
+	methodBindTextEditSetOverrideSelectedFontColor     = gdnative.NewLazyMethodBind("TextEdit", "set_override_selected_font_color")
+	methodBindTextEditSetReadonly                      = gdnative.NewLazyMethodBind("TextEdit", "set_readonly")
+	methodBindTextEditSetRightClickMovesCaret          = gdnative.NewLazyMethodBind("TextEdit", "set_right_click_moves_caret")
+	methodBindTextEditSetShowLineNumbers               = gdnative.NewLazyMethodBind("TextEdit", "set_show_line_numbers")
+	methodBindTextEditSetSmoothScrollEnable            = gdnative.NewLazyMethodBind("TextEdit", "set_smooth_scroll_enable")
+	methodBindTextEditSetSyntaxColoring                = gdnative.NewLazyMethodBind("TextEdit", "set_syntax_coloring")
+	methodBindTextEditSetText                          = gdnative.NewLazyMethodBind("TextEdit", "set_text")
+	methodBindTextEditSetVScrollSpeed                  = gdnative.NewLazyMethodBind("TextEdit", "set_v_scroll_speed")
+	methodBindTextEditSetWrap                          = gdnative.NewLazyMethodBind("TextEdit", "set_wrap")
+	methodBindTextEditToggleFoldLine                   = gdnative.NewLazyMethodBind("TextEdit", "toggle_fold_line")
+	methodBindTextEditUndo                             = gdnative.NewLazyMethodBind("TextEdit", "undo")
+	methodBindTextEditUnfoldLine                       = gdnative.NewLazyMethodBind("TextEdit", "unfold_line")
+	methodBindTextEditUnhideAllLines                   = gdnative.NewLazyMethodBind("TextEdit", "unhide_all_lines")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TextEdit) X_ClickSelectionHeld() {
 	//log.Println("Calling TextEdit.X_ClickSelectionHeld()")
@@ -67,7 +227,7 @@ func (o *TextEdit) X_ClickSelectionHeld() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_click_selection_held")
+	methodBind := methodBindTextEditX_ClickSelectionHeld.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +237,8 @@ func (o *TextEdit) X_ClickSelectionHeld() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TextEdit) X_CursorChangedEmit() {
 	//log.Println("Calling TextEdit.X_CursorChangedEmit()")
@@ -87,7 +247,7 @@ func (o *TextEdit) X_CursorChangedEmit() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_cursor_changed_emit")
+	methodBind := methodBindTextEditX_CursorChangedEmit.Get()
 
 	// Call the parent method.
 	// void
@@ -97,18 +257,18 @@ func (o *TextEdit) X_CursorChangedEmit() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *TextEdit) X_GuiInput(arg0 InputEventImplementer) {
+func (o *TextEdit) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling TextEdit.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_gui_input")
+	methodBind := methodBindTextEditX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -118,8 +278,8 @@ func (o *TextEdit) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TextEdit) X_PushCurrentOp() {
 	//log.Println("Calling TextEdit.X_PushCurrentOp()")
@@ -128,7 +288,7 @@ func (o *TextEdit) X_PushCurrentOp() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_push_current_op")
+	methodBind := methodBindTextEditX_PushCurrentOp.Get()
 
 	// Call the parent method.
 	// void
@@ -138,8 +298,8 @@ func (o *TextEdit) X_PushCurrentOp() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 float}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
 */
 func (o *TextEdit) X_ScrollMoved(arg0 gdnative.Real) {
 	//log.Println("Calling TextEdit.X_ScrollMoved()")
@@ -149,7 +309,7 @@ func (o *TextEdit) X_ScrollMoved(arg0 gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_scroll_moved")
+	methodBind := methodBindTextEditX_ScrollMoved.Get()
 
 	// Call the parent method.
 	// void
@@ -159,8 +319,8 @@ func (o *TextEdit) X_ScrollMoved(arg0 gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TextEdit) X_TextChangedEmit() {
 	//log.Println("Calling TextEdit.X_TextChangedEmit()")
@@ -169,7 +329,7 @@ func (o *TextEdit) X_TextChangedEmit() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_text_changed_emit")
+	methodBind := methodBindTextEditX_TextChangedEmit.Get()
 
 	// Call the parent method.
 	// void
@@ -179,8 +339,8 @@ func (o *TextEdit) X_TextChangedEmit() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TextEdit) X_ToggleDrawCaret() {
 	//log.Println("Calling TextEdit.X_ToggleDrawCaret()")
@@ -189,7 +349,7 @@ func (o *TextEdit) X_ToggleDrawCaret() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_toggle_draw_caret")
+	methodBind := methodBindTextEditX_ToggleDrawCaret.Get()
 
 	// Call the parent method.
 	// void
@@ -199,8 +359,8 @@ func (o *TextEdit) X_ToggleDrawCaret() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *TextEdit) X_VScrollInput() {
 	//log.Println("Calling TextEdit.X_VScrollInput()")
@@ -209,7 +369,7 @@ func (o *TextEdit) X_VScrollInput() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "_v_scroll_input")
+	methodBind := methodBindTextEditX_VScrollInput.Get()
 
 	// Call the parent method.
 	// void
@@ -219,8 +379,8 @@ func (o *TextEdit) X_VScrollInput() {
 }
 
 /*
-        Add color region (given the delimiters) and its colors.
-	Args: [{ false begin_key String} { false end_key String} { false color Color} {False true line_only bool}], Returns: void
+	        Add color region (given the delimiters) and its colors.
+		Args: [{ false begin_key String} { false end_key String} { false color Color} {False true line_only bool}], Returns: void
 */
 func (o *TextEdit) AddColorRegion(beginKey gdnative.String, endKey gdnative.String, color gdnative.Color, lineOnly gdnative.Bool) {
 	//log.Println("Calling TextEdit.AddColorRegion()")
@@ -233,7 +393,7 @@ func (o *TextEdit) AddColorRegion(beginKey gdnative.String, endKey gdnative.Stri
 	ptrArguments[3] = gdnative.NewPointerFromBool(lineOnly)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "add_color_region")
+	methodBind := methodBindTextEditAddColorRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -243,8 +403,8 @@ func (o *TextEdit) AddColorRegion(beginKey gdnative.String, endKey gdnative.Stri
 }
 
 /*
-        Add a keyword and its color.
-	Args: [{ false keyword String} { false color Color}], Returns: void
+	        Add a keyword and its color.
+		Args: [{ false keyword String} { false color Color}], Returns: void
 */
 func (o *TextEdit) AddKeywordColor(keyword gdnative.String, color gdnative.Color) {
 	//log.Println("Calling TextEdit.AddKeywordColor()")
@@ -255,7 +415,7 @@ func (o *TextEdit) AddKeywordColor(keyword gdnative.String, color gdnative.Color
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "add_keyword_color")
+	methodBind := methodBindTextEditAddKeywordColor.Get()
 
 	// Call the parent method.
 	// void
@@ -265,8 +425,7 @@ func (o *TextEdit) AddKeywordColor(keyword gdnative.String, color gdnative.Color
 }
 
 /*
-
-	Args: [{ false line int}], Returns: bool
+Args: [{ false line int}], Returns: bool
 */
 func (o *TextEdit) CanFold(line gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TextEdit.CanFold()")
@@ -276,7 +435,7 @@ func (o *TextEdit) CanFold(line gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "can_fold")
+	methodBind := methodBindTextEditCanFold.Get()
 
 	// Call the parent method.
 	// bool
@@ -289,8 +448,8 @@ func (o *TextEdit) CanFold(line gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Clear all the syntax coloring information.
-	Args: [], Returns: void
+	        Clear all the syntax coloring information.
+		Args: [], Returns: void
 */
 func (o *TextEdit) ClearColors() {
 	//log.Println("Calling TextEdit.ClearColors()")
@@ -299,7 +458,7 @@ func (o *TextEdit) ClearColors() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "clear_colors")
+	methodBind := methodBindTextEditClearColors.Get()
 
 	// Call the parent method.
 	// void
@@ -309,8 +468,8 @@ func (o *TextEdit) ClearColors() {
 }
 
 /*
-        Clear the undo history.
-	Args: [], Returns: void
+	        Clear the undo history.
+		Args: [], Returns: void
 */
 func (o *TextEdit) ClearUndoHistory() {
 	//log.Println("Calling TextEdit.ClearUndoHistory()")
@@ -319,7 +478,7 @@ func (o *TextEdit) ClearUndoHistory() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "clear_undo_history")
+	methodBind := methodBindTextEditClearUndoHistory.Get()
 
 	// Call the parent method.
 	// void
@@ -329,8 +488,8 @@ func (o *TextEdit) ClearUndoHistory() {
 }
 
 /*
-        Copy the current selection.
-	Args: [], Returns: void
+	        Copy the current selection.
+		Args: [], Returns: void
 */
 func (o *TextEdit) Copy() {
 	//log.Println("Calling TextEdit.Copy()")
@@ -339,7 +498,7 @@ func (o *TextEdit) Copy() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "copy")
+	methodBind := methodBindTextEditCopy.Get()
 
 	// Call the parent method.
 	// void
@@ -349,8 +508,8 @@ func (o *TextEdit) Copy() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) CursorGetBlinkEnabled() gdnative.Bool {
 	//log.Println("Calling TextEdit.CursorGetBlinkEnabled()")
@@ -359,7 +518,7 @@ func (o *TextEdit) CursorGetBlinkEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_get_blink_enabled")
+	methodBind := methodBindTextEditCursorGetBlinkEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -372,8 +531,8 @@ func (o *TextEdit) CursorGetBlinkEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *TextEdit) CursorGetBlinkSpeed() gdnative.Real {
 	//log.Println("Calling TextEdit.CursorGetBlinkSpeed()")
@@ -382,7 +541,7 @@ func (o *TextEdit) CursorGetBlinkSpeed() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_get_blink_speed")
+	methodBind := methodBindTextEditCursorGetBlinkSpeed.Get()
 
 	// Call the parent method.
 	// float
@@ -395,8 +554,8 @@ func (o *TextEdit) CursorGetBlinkSpeed() gdnative.Real {
 }
 
 /*
-        Return the column the editing cursor is at.
-	Args: [], Returns: int
+	        Return the column the editing cursor is at.
+		Args: [], Returns: int
 */
 func (o *TextEdit) CursorGetColumn() gdnative.Int {
 	//log.Println("Calling TextEdit.CursorGetColumn()")
@@ -405,7 +564,7 @@ func (o *TextEdit) CursorGetColumn() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_get_column")
+	methodBind := methodBindTextEditCursorGetColumn.Get()
 
 	// Call the parent method.
 	// int
@@ -418,8 +577,8 @@ func (o *TextEdit) CursorGetColumn() gdnative.Int {
 }
 
 /*
-        Return the line the editing cursor is at.
-	Args: [], Returns: int
+	        Return the line the editing cursor is at.
+		Args: [], Returns: int
 */
 func (o *TextEdit) CursorGetLine() gdnative.Int {
 	//log.Println("Calling TextEdit.CursorGetLine()")
@@ -428,7 +587,7 @@ func (o *TextEdit) CursorGetLine() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_get_line")
+	methodBind := methodBindTextEditCursorGetLine.Get()
 
 	// Call the parent method.
 	// int
@@ -441,8 +600,8 @@ func (o *TextEdit) CursorGetLine() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) CursorIsBlockMode() gdnative.Bool {
 	//log.Println("Calling TextEdit.CursorIsBlockMode()")
@@ -451,7 +610,7 @@ func (o *TextEdit) CursorIsBlockMode() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_is_block_mode")
+	methodBind := methodBindTextEditCursorIsBlockMode.Get()
 
 	// Call the parent method.
 	// bool
@@ -464,8 +623,8 @@ func (o *TextEdit) CursorIsBlockMode() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) CursorSetBlinkEnabled(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.CursorSetBlinkEnabled()")
@@ -475,7 +634,7 @@ func (o *TextEdit) CursorSetBlinkEnabled(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_set_blink_enabled")
+	methodBind := methodBindTextEditCursorSetBlinkEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -485,8 +644,8 @@ func (o *TextEdit) CursorSetBlinkEnabled(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false blink_speed float}], Returns: void
+	        Undocumented
+		Args: [{ false blink_speed float}], Returns: void
 */
 func (o *TextEdit) CursorSetBlinkSpeed(blinkSpeed gdnative.Real) {
 	//log.Println("Calling TextEdit.CursorSetBlinkSpeed()")
@@ -496,7 +655,7 @@ func (o *TextEdit) CursorSetBlinkSpeed(blinkSpeed gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(blinkSpeed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_set_blink_speed")
+	methodBind := methodBindTextEditCursorSetBlinkSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -506,8 +665,8 @@ func (o *TextEdit) CursorSetBlinkSpeed(blinkSpeed gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) CursorSetBlockMode(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.CursorSetBlockMode()")
@@ -517,7 +676,7 @@ func (o *TextEdit) CursorSetBlockMode(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_set_block_mode")
+	methodBind := methodBindTextEditCursorSetBlockMode.Get()
 
 	// Call the parent method.
 	// void
@@ -527,8 +686,7 @@ func (o *TextEdit) CursorSetBlockMode(enable gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false column int} {True true adjust_viewport bool}], Returns: void
+Args: [{ false column int} {True true adjust_viewport bool}], Returns: void
 */
 func (o *TextEdit) CursorSetColumn(column gdnative.Int, adjustViewport gdnative.Bool) {
 	//log.Println("Calling TextEdit.CursorSetColumn()")
@@ -539,7 +697,7 @@ func (o *TextEdit) CursorSetColumn(column gdnative.Int, adjustViewport gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromBool(adjustViewport)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_set_column")
+	methodBind := methodBindTextEditCursorSetColumn.Get()
 
 	// Call the parent method.
 	// void
@@ -549,8 +707,7 @@ func (o *TextEdit) CursorSetColumn(column gdnative.Int, adjustViewport gdnative.
 }
 
 /*
-
-	Args: [{ false line int} {True true adjust_viewport bool} {True true can_be_hidden bool}], Returns: void
+Args: [{ false line int} {True true adjust_viewport bool} {True true can_be_hidden bool}], Returns: void
 */
 func (o *TextEdit) CursorSetLine(line gdnative.Int, adjustViewport gdnative.Bool, canBeHidden gdnative.Bool) {
 	//log.Println("Calling TextEdit.CursorSetLine()")
@@ -562,7 +719,7 @@ func (o *TextEdit) CursorSetLine(line gdnative.Int, adjustViewport gdnative.Bool
 	ptrArguments[2] = gdnative.NewPointerFromBool(canBeHidden)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cursor_set_line")
+	methodBind := methodBindTextEditCursorSetLine.Get()
 
 	// Call the parent method.
 	// void
@@ -572,8 +729,8 @@ func (o *TextEdit) CursorSetLine(line gdnative.Int, adjustViewport gdnative.Bool
 }
 
 /*
-        Cut the current selection.
-	Args: [], Returns: void
+	        Cut the current selection.
+		Args: [], Returns: void
 */
 func (o *TextEdit) Cut() {
 	//log.Println("Calling TextEdit.Cut()")
@@ -582,7 +739,7 @@ func (o *TextEdit) Cut() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "cut")
+	methodBind := methodBindTextEditCut.Get()
 
 	// Call the parent method.
 	// void
@@ -592,8 +749,8 @@ func (o *TextEdit) Cut() {
 }
 
 /*
-        Clears the current selection.
-	Args: [], Returns: void
+	        Clears the current selection.
+		Args: [], Returns: void
 */
 func (o *TextEdit) Deselect() {
 	//log.Println("Calling TextEdit.Deselect()")
@@ -602,7 +759,7 @@ func (o *TextEdit) Deselect() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "deselect")
+	methodBind := methodBindTextEditDeselect.Get()
 
 	// Call the parent method.
 	// void
@@ -612,8 +769,7 @@ func (o *TextEdit) Deselect() {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *TextEdit) FoldAllLines() {
 	//log.Println("Calling TextEdit.FoldAllLines()")
@@ -622,7 +778,7 @@ func (o *TextEdit) FoldAllLines() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "fold_all_lines")
+	methodBind := methodBindTextEditFoldAllLines.Get()
 
 	// Call the parent method.
 	// void
@@ -632,8 +788,7 @@ func (o *TextEdit) FoldAllLines() {
 }
 
 /*
-
-	Args: [{ false line int}], Returns: void
+Args: [{ false line int}], Returns: void
 */
 func (o *TextEdit) FoldLine(line gdnative.Int) {
 	//log.Println("Calling TextEdit.FoldLine()")
@@ -643,7 +798,7 @@ func (o *TextEdit) FoldLine(line gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "fold_line")
+	methodBind := methodBindTextEditFoldLine.Get()
 
 	// Call the parent method.
 	// void
@@ -653,8 +808,8 @@ func (o *TextEdit) FoldLine(line gdnative.Int) {
 }
 
 /*
-        Return the text of a specific line.
-	Args: [{ false line int}], Returns: String
+	        Return the text of a specific line.
+		Args: [{ false line int}], Returns: String
 */
 func (o *TextEdit) GetLine(line gdnative.Int) gdnative.String {
 	//log.Println("Calling TextEdit.GetLine()")
@@ -664,7 +819,7 @@ func (o *TextEdit) GetLine(line gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_line")
+	methodBind := methodBindTextEditGetLine.Get()
 
 	// Call the parent method.
 	// String
@@ -677,8 +832,8 @@ func (o *TextEdit) GetLine(line gdnative.Int) gdnative.String {
 }
 
 /*
-        Return the amount of total lines in the text.
-	Args: [], Returns: int
+	        Return the amount of total lines in the text.
+		Args: [], Returns: int
 */
 func (o *TextEdit) GetLineCount() gdnative.Int {
 	//log.Println("Calling TextEdit.GetLineCount()")
@@ -687,7 +842,7 @@ func (o *TextEdit) GetLineCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_line_count")
+	methodBind := methodBindTextEditGetLineCount.Get()
 
 	// Call the parent method.
 	// int
@@ -700,17 +855,16 @@ func (o *TextEdit) GetLineCount() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: PopupMenu
+Args: [], Returns: PopupMenu
 */
-func (o *TextEdit) GetMenu() PopupMenuImplementer {
+func (o *TextEdit) GetMenu() popup.PopupMenuImplementer {
 	//log.Println("Calling TextEdit.GetMenu()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_menu")
+	methodBind := methodBindTextEditGetMenu.Get()
 
 	// Call the parent method.
 	// PopupMenu
@@ -718,27 +872,25 @@ func (o *TextEdit) GetMenu() PopupMenuImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPopupMenuFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PopupMenuImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PopupMenu" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PopupMenuImplementer)
+	ret := popup.PopupMenu{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(popup.PopupMenuImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the selection begin column.
-	Args: [], Returns: int
+	        Return the selection begin column.
+		Args: [], Returns: int
 */
 func (o *TextEdit) GetSelectionFromColumn() gdnative.Int {
 	//log.Println("Calling TextEdit.GetSelectionFromColumn()")
@@ -747,7 +899,7 @@ func (o *TextEdit) GetSelectionFromColumn() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_selection_from_column")
+	methodBind := methodBindTextEditGetSelectionFromColumn.Get()
 
 	// Call the parent method.
 	// int
@@ -760,8 +912,8 @@ func (o *TextEdit) GetSelectionFromColumn() gdnative.Int {
 }
 
 /*
-        Return the selection begin line.
-	Args: [], Returns: int
+	        Return the selection begin line.
+		Args: [], Returns: int
 */
 func (o *TextEdit) GetSelectionFromLine() gdnative.Int {
 	//log.Println("Calling TextEdit.GetSelectionFromLine()")
@@ -770,7 +922,7 @@ func (o *TextEdit) GetSelectionFromLine() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_selection_from_line")
+	methodBind := methodBindTextEditGetSelectionFromLine.Get()
 
 	// Call the parent method.
 	// int
@@ -783,8 +935,8 @@ func (o *TextEdit) GetSelectionFromLine() gdnative.Int {
 }
 
 /*
-        Return the text inside the selection.
-	Args: [], Returns: String
+	        Return the text inside the selection.
+		Args: [], Returns: String
 */
 func (o *TextEdit) GetSelectionText() gdnative.String {
 	//log.Println("Calling TextEdit.GetSelectionText()")
@@ -793,7 +945,7 @@ func (o *TextEdit) GetSelectionText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_selection_text")
+	methodBind := methodBindTextEditGetSelectionText.Get()
 
 	// Call the parent method.
 	// String
@@ -806,8 +958,8 @@ func (o *TextEdit) GetSelectionText() gdnative.String {
 }
 
 /*
-        Return the selection end column.
-	Args: [], Returns: int
+	        Return the selection end column.
+		Args: [], Returns: int
 */
 func (o *TextEdit) GetSelectionToColumn() gdnative.Int {
 	//log.Println("Calling TextEdit.GetSelectionToColumn()")
@@ -816,7 +968,7 @@ func (o *TextEdit) GetSelectionToColumn() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_selection_to_column")
+	methodBind := methodBindTextEditGetSelectionToColumn.Get()
 
 	// Call the parent method.
 	// int
@@ -829,8 +981,8 @@ func (o *TextEdit) GetSelectionToColumn() gdnative.Int {
 }
 
 /*
-        Return the selection end line.
-	Args: [], Returns: int
+	        Return the selection end line.
+		Args: [], Returns: int
 */
 func (o *TextEdit) GetSelectionToLine() gdnative.Int {
 	//log.Println("Calling TextEdit.GetSelectionToLine()")
@@ -839,7 +991,7 @@ func (o *TextEdit) GetSelectionToLine() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_selection_to_line")
+	methodBind := methodBindTextEditGetSelectionToLine.Get()
 
 	// Call the parent method.
 	// int
@@ -852,8 +1004,8 @@ func (o *TextEdit) GetSelectionToLine() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *TextEdit) GetText() gdnative.String {
 	//log.Println("Calling TextEdit.GetText()")
@@ -862,7 +1014,7 @@ func (o *TextEdit) GetText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_text")
+	methodBind := methodBindTextEditGetText.Get()
 
 	// Call the parent method.
 	// String
@@ -875,8 +1027,8 @@ func (o *TextEdit) GetText() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *TextEdit) GetVScrollSpeed() gdnative.Real {
 	//log.Println("Calling TextEdit.GetVScrollSpeed()")
@@ -885,7 +1037,7 @@ func (o *TextEdit) GetVScrollSpeed() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_v_scroll_speed")
+	methodBind := methodBindTextEditGetVScrollSpeed.Get()
 
 	// Call the parent method.
 	// float
@@ -898,8 +1050,7 @@ func (o *TextEdit) GetVScrollSpeed() gdnative.Real {
 }
 
 /*
-
-	Args: [], Returns: String
+Args: [], Returns: String
 */
 func (o *TextEdit) GetWordUnderCursor() gdnative.String {
 	//log.Println("Calling TextEdit.GetWordUnderCursor()")
@@ -908,7 +1059,7 @@ func (o *TextEdit) GetWordUnderCursor() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "get_word_under_cursor")
+	methodBind := methodBindTextEditGetWordUnderCursor.Get()
 
 	// Call the parent method.
 	// String
@@ -921,8 +1072,8 @@ func (o *TextEdit) GetWordUnderCursor() gdnative.String {
 }
 
 /*
-        Insert a given text at the cursor position.
-	Args: [{ false text String}], Returns: void
+	        Insert a given text at the cursor position.
+		Args: [{ false text String}], Returns: void
 */
 func (o *TextEdit) InsertTextAtCursor(text gdnative.String) {
 	//log.Println("Calling TextEdit.InsertTextAtCursor()")
@@ -932,7 +1083,7 @@ func (o *TextEdit) InsertTextAtCursor(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "insert_text_at_cursor")
+	methodBind := methodBindTextEditInsertTextAtCursor.Get()
 
 	// Call the parent method.
 	// void
@@ -942,8 +1093,8 @@ func (o *TextEdit) InsertTextAtCursor(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsContextMenuEnabled() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsContextMenuEnabled()")
@@ -952,7 +1103,7 @@ func (o *TextEdit) IsContextMenuEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_context_menu_enabled")
+	methodBind := methodBindTextEditIsContextMenuEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -965,8 +1116,7 @@ func (o *TextEdit) IsContextMenuEnabled() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false line int}], Returns: bool
+Args: [{ false line int}], Returns: bool
 */
 func (o *TextEdit) IsFolded(line gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TextEdit.IsFolded()")
@@ -976,7 +1126,7 @@ func (o *TextEdit) IsFolded(line gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_folded")
+	methodBind := methodBindTextEditIsFolded.Get()
 
 	// Call the parent method.
 	// bool
@@ -989,8 +1139,8 @@ func (o *TextEdit) IsFolded(line gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *TextEdit) IsHidingEnabled() gdnative.Int {
 	//log.Println("Calling TextEdit.IsHidingEnabled()")
@@ -999,7 +1149,7 @@ func (o *TextEdit) IsHidingEnabled() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_hiding_enabled")
+	methodBind := methodBindTextEditIsHidingEnabled.Get()
 
 	// Call the parent method.
 	// int
@@ -1012,8 +1162,8 @@ func (o *TextEdit) IsHidingEnabled() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsHighlightAllOccurrencesEnabled() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsHighlightAllOccurrencesEnabled()")
@@ -1022,7 +1172,7 @@ func (o *TextEdit) IsHighlightAllOccurrencesEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_highlight_all_occurrences_enabled")
+	methodBind := methodBindTextEditIsHighlightAllOccurrencesEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1035,8 +1185,8 @@ func (o *TextEdit) IsHighlightAllOccurrencesEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsHighlightCurrentLineEnabled() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsHighlightCurrentLineEnabled()")
@@ -1045,7 +1195,7 @@ func (o *TextEdit) IsHighlightCurrentLineEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_highlight_current_line_enabled")
+	methodBind := methodBindTextEditIsHighlightCurrentLineEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1058,8 +1208,7 @@ func (o *TextEdit) IsHighlightCurrentLineEnabled() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false line int}], Returns: bool
+Args: [{ false line int}], Returns: bool
 */
 func (o *TextEdit) IsLineHidden(line gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TextEdit.IsLineHidden()")
@@ -1069,7 +1218,7 @@ func (o *TextEdit) IsLineHidden(line gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_line_hidden")
+	methodBind := methodBindTextEditIsLineHidden.Get()
 
 	// Call the parent method.
 	// bool
@@ -1082,8 +1231,8 @@ func (o *TextEdit) IsLineHidden(line gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsOverridingSelectedFontColor() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsOverridingSelectedFontColor()")
@@ -1092,7 +1241,7 @@ func (o *TextEdit) IsOverridingSelectedFontColor() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_overriding_selected_font_color")
+	methodBind := methodBindTextEditIsOverridingSelectedFontColor.Get()
 
 	// Call the parent method.
 	// bool
@@ -1105,8 +1254,8 @@ func (o *TextEdit) IsOverridingSelectedFontColor() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsReadonly() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsReadonly()")
@@ -1115,7 +1264,7 @@ func (o *TextEdit) IsReadonly() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_readonly")
+	methodBind := methodBindTextEditIsReadonly.Get()
 
 	// Call the parent method.
 	// bool
@@ -1128,8 +1277,8 @@ func (o *TextEdit) IsReadonly() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsRightClickMovingCaret() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsRightClickMovingCaret()")
@@ -1138,7 +1287,7 @@ func (o *TextEdit) IsRightClickMovingCaret() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_right_click_moving_caret")
+	methodBind := methodBindTextEditIsRightClickMovingCaret.Get()
 
 	// Call the parent method.
 	// bool
@@ -1151,8 +1300,8 @@ func (o *TextEdit) IsRightClickMovingCaret() gdnative.Bool {
 }
 
 /*
-        Return true if the selection is active.
-	Args: [], Returns: bool
+	        Return true if the selection is active.
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsSelectionActive() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsSelectionActive()")
@@ -1161,7 +1310,7 @@ func (o *TextEdit) IsSelectionActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_selection_active")
+	methodBind := methodBindTextEditIsSelectionActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -1174,8 +1323,8 @@ func (o *TextEdit) IsSelectionActive() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsShowLineNumbersEnabled() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsShowLineNumbersEnabled()")
@@ -1184,7 +1333,7 @@ func (o *TextEdit) IsShowLineNumbersEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_show_line_numbers_enabled")
+	methodBind := methodBindTextEditIsShowLineNumbersEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1197,8 +1346,8 @@ func (o *TextEdit) IsShowLineNumbersEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsSmoothScrollEnabled() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsSmoothScrollEnabled()")
@@ -1207,7 +1356,7 @@ func (o *TextEdit) IsSmoothScrollEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_smooth_scroll_enabled")
+	methodBind := methodBindTextEditIsSmoothScrollEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1220,8 +1369,8 @@ func (o *TextEdit) IsSmoothScrollEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsSyntaxColoringEnabled() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsSyntaxColoringEnabled()")
@@ -1230,7 +1379,7 @@ func (o *TextEdit) IsSyntaxColoringEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_syntax_coloring_enabled")
+	methodBind := methodBindTextEditIsSyntaxColoringEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1243,8 +1392,8 @@ func (o *TextEdit) IsSyntaxColoringEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TextEdit) IsWrapping() gdnative.Bool {
 	//log.Println("Calling TextEdit.IsWrapping()")
@@ -1253,7 +1402,7 @@ func (o *TextEdit) IsWrapping() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "is_wrapping")
+	methodBind := methodBindTextEditIsWrapping.Get()
 
 	// Call the parent method.
 	// bool
@@ -1266,8 +1415,7 @@ func (o *TextEdit) IsWrapping() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false option int}], Returns: void
+Args: [{ false option int}], Returns: void
 */
 func (o *TextEdit) MenuOption(option gdnative.Int) {
 	//log.Println("Calling TextEdit.MenuOption()")
@@ -1277,7 +1425,7 @@ func (o *TextEdit) MenuOption(option gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(option)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "menu_option")
+	methodBind := methodBindTextEditMenuOption.Get()
 
 	// Call the parent method.
 	// void
@@ -1287,8 +1435,8 @@ func (o *TextEdit) MenuOption(option gdnative.Int) {
 }
 
 /*
-        Paste the current selection.
-	Args: [], Returns: void
+	        Paste the current selection.
+		Args: [], Returns: void
 */
 func (o *TextEdit) Paste() {
 	//log.Println("Calling TextEdit.Paste()")
@@ -1297,7 +1445,7 @@ func (o *TextEdit) Paste() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "paste")
+	methodBind := methodBindTextEditPaste.Get()
 
 	// Call the parent method.
 	// void
@@ -1307,8 +1455,8 @@ func (o *TextEdit) Paste() {
 }
 
 /*
-        Perform redo operation.
-	Args: [], Returns: void
+	        Perform redo operation.
+		Args: [], Returns: void
 */
 func (o *TextEdit) Redo() {
 	//log.Println("Calling TextEdit.Redo()")
@@ -1317,7 +1465,7 @@ func (o *TextEdit) Redo() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "redo")
+	methodBind := methodBindTextEditRedo.Get()
 
 	// Call the parent method.
 	// void
@@ -1327,8 +1475,8 @@ func (o *TextEdit) Redo() {
 }
 
 /*
-        Perform a search inside the text. Search flags can be specified in the SEARCH_* enum.
-	Args: [{ false key String} { false flags int} { false from_line int} { false from_column int}], Returns: PoolIntArray
+	        Perform a search inside the text. Search flags can be specified in the SEARCH_* enum.
+		Args: [{ false key String} { false flags int} { false from_line int} { false from_column int}], Returns: PoolIntArray
 */
 func (o *TextEdit) Search(key gdnative.String, flags gdnative.Int, fromLine gdnative.Int, fromColumn gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling TextEdit.Search()")
@@ -1341,7 +1489,7 @@ func (o *TextEdit) Search(key gdnative.String, flags gdnative.Int, fromLine gdna
 	ptrArguments[3] = gdnative.NewPointerFromInt(fromColumn)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "search")
+	methodBind := methodBindTextEditSearch.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -1354,8 +1502,8 @@ func (o *TextEdit) Search(key gdnative.String, flags gdnative.Int, fromLine gdna
 }
 
 /*
-        Perform selection, from line/column to line/column.
-	Args: [{ false from_line int} { false from_column int} { false to_line int} { false to_column int}], Returns: void
+	        Perform selection, from line/column to line/column.
+		Args: [{ false from_line int} { false from_column int} { false to_line int} { false to_column int}], Returns: void
 */
 func (o *TextEdit) Select(fromLine gdnative.Int, fromColumn gdnative.Int, toLine gdnative.Int, toColumn gdnative.Int) {
 	//log.Println("Calling TextEdit.Select()")
@@ -1368,7 +1516,7 @@ func (o *TextEdit) Select(fromLine gdnative.Int, fromColumn gdnative.Int, toLine
 	ptrArguments[3] = gdnative.NewPointerFromInt(toColumn)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "select")
+	methodBind := methodBindTextEditSelect.Get()
 
 	// Call the parent method.
 	// void
@@ -1378,8 +1526,8 @@ func (o *TextEdit) Select(fromLine gdnative.Int, fromColumn gdnative.Int, toLine
 }
 
 /*
-        Select all the text.
-	Args: [], Returns: void
+	        Select all the text.
+		Args: [], Returns: void
 */
 func (o *TextEdit) SelectAll() {
 	//log.Println("Calling TextEdit.SelectAll()")
@@ -1388,7 +1536,7 @@ func (o *TextEdit) SelectAll() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "select_all")
+	methodBind := methodBindTextEditSelectAll.Get()
 
 	// Call the parent method.
 	// void
@@ -1398,8 +1546,8 @@ func (o *TextEdit) SelectAll() {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetContextMenuEnabled(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetContextMenuEnabled()")
@@ -1409,7 +1557,7 @@ func (o *TextEdit) SetContextMenuEnabled(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_context_menu_enabled")
+	methodBind := methodBindTextEditSetContextMenuEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1419,8 +1567,8 @@ func (o *TextEdit) SetContextMenuEnabled(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable int}], Returns: void
+	        Undocumented
+		Args: [{ false enable int}], Returns: void
 */
 func (o *TextEdit) SetHidingEnabled(enable gdnative.Int) {
 	//log.Println("Calling TextEdit.SetHidingEnabled()")
@@ -1430,7 +1578,7 @@ func (o *TextEdit) SetHidingEnabled(enable gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_hiding_enabled")
+	methodBind := methodBindTextEditSetHidingEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1440,8 +1588,8 @@ func (o *TextEdit) SetHidingEnabled(enable gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetHighlightAllOccurrences(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetHighlightAllOccurrences()")
@@ -1451,7 +1599,7 @@ func (o *TextEdit) SetHighlightAllOccurrences(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_highlight_all_occurrences")
+	methodBind := methodBindTextEditSetHighlightAllOccurrences.Get()
 
 	// Call the parent method.
 	// void
@@ -1461,8 +1609,8 @@ func (o *TextEdit) SetHighlightAllOccurrences(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *TextEdit) SetHighlightCurrentLine(enabled gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetHighlightCurrentLine()")
@@ -1472,7 +1620,7 @@ func (o *TextEdit) SetHighlightCurrentLine(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_highlight_current_line")
+	methodBind := methodBindTextEditSetHighlightCurrentLine.Get()
 
 	// Call the parent method.
 	// void
@@ -1482,8 +1630,7 @@ func (o *TextEdit) SetHighlightCurrentLine(enabled gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false line int} { false enable bool}], Returns: void
+Args: [{ false line int} { false enable bool}], Returns: void
 */
 func (o *TextEdit) SetLineAsHidden(line gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetLineAsHidden()")
@@ -1494,7 +1641,7 @@ func (o *TextEdit) SetLineAsHidden(line gdnative.Int, enable gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_line_as_hidden")
+	methodBind := methodBindTextEditSetLineAsHidden.Get()
 
 	// Call the parent method.
 	// void
@@ -1504,8 +1651,8 @@ func (o *TextEdit) SetLineAsHidden(line gdnative.Int, enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false override bool}], Returns: void
+	        Undocumented
+		Args: [{ false override bool}], Returns: void
 */
 func (o *TextEdit) SetOverrideSelectedFontColor(override gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetOverrideSelectedFontColor()")
@@ -1515,7 +1662,7 @@ func (o *TextEdit) SetOverrideSelectedFontColor(override gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(override)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_override_selected_font_color")
+	methodBind := methodBindTextEditSetOverrideSelectedFontColor.Get()
 
 	// Call the parent method.
 	// void
@@ -1525,8 +1672,8 @@ func (o *TextEdit) SetOverrideSelectedFontColor(override gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetReadonly(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetReadonly()")
@@ -1536,7 +1683,7 @@ func (o *TextEdit) SetReadonly(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_readonly")
+	methodBind := methodBindTextEditSetReadonly.Get()
 
 	// Call the parent method.
 	// void
@@ -1546,8 +1693,8 @@ func (o *TextEdit) SetReadonly(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetRightClickMovesCaret(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetRightClickMovesCaret()")
@@ -1557,7 +1704,7 @@ func (o *TextEdit) SetRightClickMovesCaret(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_right_click_moves_caret")
+	methodBind := methodBindTextEditSetRightClickMovesCaret.Get()
 
 	// Call the parent method.
 	// void
@@ -1567,8 +1714,8 @@ func (o *TextEdit) SetRightClickMovesCaret(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetShowLineNumbers(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetShowLineNumbers()")
@@ -1578,7 +1725,7 @@ func (o *TextEdit) SetShowLineNumbers(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_show_line_numbers")
+	methodBind := methodBindTextEditSetShowLineNumbers.Get()
 
 	// Call the parent method.
 	// void
@@ -1588,8 +1735,8 @@ func (o *TextEdit) SetShowLineNumbers(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetSmoothScrollEnable(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetSmoothScrollEnable()")
@@ -1599,7 +1746,7 @@ func (o *TextEdit) SetSmoothScrollEnable(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_smooth_scroll_enable")
+	methodBind := methodBindTextEditSetSmoothScrollEnable.Get()
 
 	// Call the parent method.
 	// void
@@ -1609,8 +1756,8 @@ func (o *TextEdit) SetSmoothScrollEnable(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetSyntaxColoring(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetSyntaxColoring()")
@@ -1620,7 +1767,7 @@ func (o *TextEdit) SetSyntaxColoring(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_syntax_coloring")
+	methodBind := methodBindTextEditSetSyntaxColoring.Get()
 
 	// Call the parent method.
 	// void
@@ -1630,8 +1777,8 @@ func (o *TextEdit) SetSyntaxColoring(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *TextEdit) SetText(text gdnative.String) {
 	//log.Println("Calling TextEdit.SetText()")
@@ -1641,7 +1788,7 @@ func (o *TextEdit) SetText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_text")
+	methodBind := methodBindTextEditSetText.Get()
 
 	// Call the parent method.
 	// void
@@ -1651,8 +1798,8 @@ func (o *TextEdit) SetText(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false speed float}], Returns: void
+	        Undocumented
+		Args: [{ false speed float}], Returns: void
 */
 func (o *TextEdit) SetVScrollSpeed(speed gdnative.Real) {
 	//log.Println("Calling TextEdit.SetVScrollSpeed()")
@@ -1662,7 +1809,7 @@ func (o *TextEdit) SetVScrollSpeed(speed gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(speed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_v_scroll_speed")
+	methodBind := methodBindTextEditSetVScrollSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -1672,8 +1819,8 @@ func (o *TextEdit) SetVScrollSpeed(speed gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TextEdit) SetWrap(enable gdnative.Bool) {
 	//log.Println("Calling TextEdit.SetWrap()")
@@ -1683,7 +1830,7 @@ func (o *TextEdit) SetWrap(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "set_wrap")
+	methodBind := methodBindTextEditSetWrap.Get()
 
 	// Call the parent method.
 	// void
@@ -1693,8 +1840,8 @@ func (o *TextEdit) SetWrap(enable gdnative.Bool) {
 }
 
 /*
-        Toggle the folding of the code block at the given line.
-	Args: [{ false line int}], Returns: void
+	        Toggle the folding of the code block at the given line.
+		Args: [{ false line int}], Returns: void
 */
 func (o *TextEdit) ToggleFoldLine(line gdnative.Int) {
 	//log.Println("Calling TextEdit.ToggleFoldLine()")
@@ -1704,7 +1851,7 @@ func (o *TextEdit) ToggleFoldLine(line gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "toggle_fold_line")
+	methodBind := methodBindTextEditToggleFoldLine.Get()
 
 	// Call the parent method.
 	// void
@@ -1714,8 +1861,8 @@ func (o *TextEdit) ToggleFoldLine(line gdnative.Int) {
 }
 
 /*
-        Perform undo operation.
-	Args: [], Returns: void
+	        Perform undo operation.
+		Args: [], Returns: void
 */
 func (o *TextEdit) Undo() {
 	//log.Println("Calling TextEdit.Undo()")
@@ -1724,7 +1871,7 @@ func (o *TextEdit) Undo() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "undo")
+	methodBind := methodBindTextEditUndo.Get()
 
 	// Call the parent method.
 	// void
@@ -1734,8 +1881,7 @@ func (o *TextEdit) Undo() {
 }
 
 /*
-
-	Args: [{ false line int}], Returns: void
+Args: [{ false line int}], Returns: void
 */
 func (o *TextEdit) UnfoldLine(line gdnative.Int) {
 	//log.Println("Calling TextEdit.UnfoldLine()")
@@ -1745,7 +1891,7 @@ func (o *TextEdit) UnfoldLine(line gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "unfold_line")
+	methodBind := methodBindTextEditUnfoldLine.Get()
 
 	// Call the parent method.
 	// void
@@ -1755,8 +1901,7 @@ func (o *TextEdit) UnfoldLine(line gdnative.Int) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *TextEdit) UnhideAllLines() {
 	//log.Println("Calling TextEdit.UnhideAllLines()")
@@ -1765,7 +1910,7 @@ func (o *TextEdit) UnhideAllLines() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TextEdit", "unhide_all_lines")
+	methodBind := methodBindTextEditUnhideAllLines.Get()
 
 	// Call the parent method.
 	// void
@@ -1774,10 +1919,249 @@ func (o *TextEdit) UnhideAllLines() {
 
 }
 
+// AddColorRegionWithDefaults will call AddColorRegion using the default values for: line_only.
+func (o *TextEdit) AddColorRegionWithDefaults(beginKey gdnative.String, endKey gdnative.String, color gdnative.Color) {
+	o.AddColorRegion(beginKey, endKey, color, false)
+}
+
+// CursorSetColumnWithDefaults will call CursorSetColumn using the default values for: adjust_viewport.
+func (o *TextEdit) CursorSetColumnWithDefaults(column gdnative.Int) {
+	o.CursorSetColumn(column, true)
+}
+
+// CursorSetLineWithDefaults will call CursorSetLine using the default values for: adjust_viewport, can_be_hidden.
+func (o *TextEdit) CursorSetLineWithDefaults(line gdnative.Int) {
+	o.CursorSetLine(line, true, true)
+}
+
+// CaretBlink will return the value of the "caret_blink" property.
+func (o *TextEdit) CaretBlink() gdnative.Bool {
+	return o.CursorGetBlinkEnabled()
+}
+
+// SetCaretBlink will set the value of the "caret_blink" property.
+func (o *TextEdit) SetCaretBlink(value gdnative.Bool) {
+	o.CursorSetBlinkEnabled(value)
+}
+
+// CaretBlinkSpeed will return the value of the "caret_blink_speed" property.
+func (o *TextEdit) CaretBlinkSpeed() gdnative.Real {
+	return o.CursorGetBlinkSpeed()
+}
+
+// SetCaretBlinkSpeed will set the value of the "caret_blink_speed" property.
+func (o *TextEdit) SetCaretBlinkSpeed(value gdnative.Real) {
+	o.CursorSetBlinkSpeed(value)
+}
+
+// CaretBlockMode will return the value of the "caret_block_mode" property.
+func (o *TextEdit) CaretBlockMode() gdnative.Bool {
+	return o.CursorIsBlockMode()
+}
+
+// SetCaretBlockMode will set the value of the "caret_block_mode" property.
+func (o *TextEdit) SetCaretBlockMode(value gdnative.Bool) {
+	o.CursorSetBlockMode(value)
+}
+
+// CaretMovingByRightClick will return the value of the "caret_moving_by_right_click" property.
+func (o *TextEdit) CaretMovingByRightClick() gdnative.Bool {
+	return o.IsRightClickMovingCaret()
+}
+
+// SetCaretMovingByRightClick will set the value of the "caret_moving_by_right_click" property.
+func (o *TextEdit) SetCaretMovingByRightClick(value gdnative.Bool) {
+	o.SetRightClickMovesCaret(value)
+}
+
+// ContextMenuEnabled will return the value of the "context_menu_enabled" property.
+func (o *TextEdit) ContextMenuEnabled() gdnative.Bool {
+	return o.IsContextMenuEnabled()
+}
+
+// HidingEnabled will return the value of the "hiding_enabled" property.
+func (o *TextEdit) HidingEnabled() gdnative.Int {
+	return o.IsHidingEnabled()
+}
+
+// HighlightAllOccurrences will return the value of the "highlight_all_occurrences" property.
+func (o *TextEdit) HighlightAllOccurrences() gdnative.Bool {
+	return o.IsHighlightAllOccurrencesEnabled()
+}
+
+// HighlightCurrentLine will return the value of the "highlight_current_line" property.
+func (o *TextEdit) HighlightCurrentLine() gdnative.Bool {
+	return o.IsHighlightCurrentLineEnabled()
+}
+
+// OverrideSelectedFontColor will return the value of the "override_selected_font_color" property.
+func (o *TextEdit) OverrideSelectedFontColor() gdnative.Bool {
+	return o.IsOverridingSelectedFontColor()
+}
+
+// Readonly will return the value of the "readonly" property.
+func (o *TextEdit) Readonly() gdnative.Bool {
+	return o.IsReadonly()
+}
+
+// ShowLineNumbers will return the value of the "show_line_numbers" property.
+func (o *TextEdit) ShowLineNumbers() gdnative.Bool {
+	return o.IsShowLineNumbersEnabled()
+}
+
+// SmoothScrolling will return the value of the "smooth_scrolling" property.
+func (o *TextEdit) SmoothScrolling() gdnative.Bool {
+	return o.IsSmoothScrollEnabled()
+}
+
+// SetSmoothScrolling will set the value of the "smooth_scrolling" property.
+func (o *TextEdit) SetSmoothScrolling(value gdnative.Bool) {
+	o.SetSmoothScrollEnable(value)
+}
+
+// SyntaxHighlighting will return the value of the "syntax_highlighting" property.
+func (o *TextEdit) SyntaxHighlighting() gdnative.Bool {
+	return o.IsSyntaxColoringEnabled()
+}
+
+// SetSyntaxHighlighting will set the value of the "syntax_highlighting" property.
+func (o *TextEdit) SetSyntaxHighlighting(value gdnative.Bool) {
+	o.SetSyntaxColoring(value)
+}
+
+// Text will return the value of the "text" property.
+func (o *TextEdit) Text() gdnative.String {
+	return o.GetText()
+}
+
+// VScrollSpeed will return the value of the "v_scroll_speed" property.
+func (o *TextEdit) VScrollSpeed() gdnative.Real {
+	return o.GetVScrollSpeed()
+}
+
+// WrapLines will return the value of the "wrap_lines" property.
+func (o *TextEdit) WrapLines() gdnative.Bool {
+	return o.IsWrapping()
+}
+
+// SetWrapLines will set the value of the "wrap_lines" property.
+func (o *TextEdit) SetWrapLines(value gdnative.Bool) {
+	o.SetWrap(value)
+}
+
+// Signals of the TextEdit class.
+const (
+	TextEditSignalBreakpointToggled gdnative.String = "breakpoint_toggled"
+	TextEditSignalCursorChanged     gdnative.String = "cursor_changed"
+	TextEditSignalRequestCompletion gdnative.String = "request_completion"
+	TextEditSignalSymbolLookup      gdnative.String = "symbol_lookup"
+	TextEditSignalTextChanged       gdnative.String = "text_changed"
+)
+
+// TextEditBreakpointToggledArgs holds the arguments of the "breakpoint_toggled" signal.
+type TextEditBreakpointToggledArgs struct {
+	Row gdnative.Int
+}
+
+// ConnectBreakpointToggled will connect the "breakpoint_toggled" signal to the given method of the target object.
+func (o *TextEdit) ConnectBreakpointToggled(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TextEditSignalBreakpointToggled, target, method, binds, 0)
+}
+
+// EmitBreakpointToggled will emit the "breakpoint_toggled" signal.
+func (o *TextEdit) EmitBreakpointToggled(args TextEditBreakpointToggledArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Row))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TextEditSignalBreakpointToggled, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectCursorChanged will connect the "cursor_changed" signal to the given method of the target object.
+func (o *TextEdit) ConnectCursorChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TextEditSignalCursorChanged, target, method, binds, 0)
+}
+
+// EmitCursorChanged will emit the "cursor_changed" signal.
+func (o *TextEdit) EmitCursorChanged() error {
+
+	ret, err := o.EmitSignal(TextEditSignalCursorChanged)
+	ret.Destroy()
+	return err
+}
+
+// ConnectRequestCompletion will connect the "request_completion" signal to the given method of the target object.
+func (o *TextEdit) ConnectRequestCompletion(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TextEditSignalRequestCompletion, target, method, binds, 0)
+}
+
+// EmitRequestCompletion will emit the "request_completion" signal.
+func (o *TextEdit) EmitRequestCompletion() error {
+
+	ret, err := o.EmitSignal(TextEditSignalRequestCompletion)
+	ret.Destroy()
+	return err
+}
+
+// TextEditSymbolLookupArgs holds the arguments of the "symbol_lookup" signal.
+type TextEditSymbolLookupArgs struct {
+	Symbol gdnative.String
+	Row    gdnative.Int
+	Column gdnative.Int
+}
+
+// ConnectSymbolLookup will connect the "symbol_lookup" signal to the given method of the target object.
+func (o *TextEdit) ConnectSymbolLookup(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TextEditSignalSymbolLookup, target, method, binds, 0)
+}
+
+// EmitSymbolLookup will emit the "symbol_lookup" signal.
+func (o *TextEdit) EmitSymbolLookup(args TextEditSymbolLookupArgs) error {
+	arg0 := gdnative.NewVariantString(args.Symbol)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.Row))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.Column))
+	defer arg2.Destroy()
+
+	ret, err := o.EmitSignal(TextEditSignalSymbolLookup, arg0, arg1, arg2)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTextChanged will connect the "text_changed" signal to the given method of the target object.
+func (o *TextEdit) ConnectTextChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TextEditSignalTextChanged, target, method, binds, 0)
+}
+
+// EmitTextChanged will emit the "text_changed" signal.
+func (o *TextEdit) EmitTextChanged() error {
+
+	ret, err := o.EmitSignal(TextEditSignalTextChanged)
+	ret.Destroy()
+	return err
+}
+
 // TextEditImplementer is an interface that implements the methods
 // of the TextEdit class.
 type TextEditImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 	X_ClickSelectionHeld()
 	X_CursorChangedEmit()
 	X_PushCurrentOp()
@@ -1807,7 +2191,7 @@ type TextEditImplementer interface {
 	FoldLine(line gdnative.Int)
 	GetLine(line gdnative.Int) gdnative.String
 	GetLineCount() gdnative.Int
-	GetMenu() PopupMenuImplementer
+	GetMenu() popup.PopupMenuImplementer
 	GetSelectionFromColumn() gdnative.Int
 	GetSelectionFromLine() gdnative.Int
 	GetSelectionText() gdnative.String
@@ -1855,4 +2239,40 @@ type TextEditImplementer interface {
 	Undo()
 	UnfoldLine(line gdnative.Int)
 	UnhideAllLines()
+	AddColorRegionWithDefaults(beginKey gdnative.String, endKey gdnative.String, color gdnative.Color)
+	CursorSetColumnWithDefaults(column gdnative.Int)
+	CursorSetLineWithDefaults(line gdnative.Int)
+	CaretBlink() gdnative.Bool
+	SetCaretBlink(value gdnative.Bool)
+	CaretBlinkSpeed() gdnative.Real
+	SetCaretBlinkSpeed(value gdnative.Real)
+	CaretBlockMode() gdnative.Bool
+	SetCaretBlockMode(value gdnative.Bool)
+	CaretMovingByRightClick() gdnative.Bool
+	SetCaretMovingByRightClick(value gdnative.Bool)
+	ContextMenuEnabled() gdnative.Bool
+	HidingEnabled() gdnative.Int
+	HighlightAllOccurrences() gdnative.Bool
+	HighlightCurrentLine() gdnative.Bool
+	OverrideSelectedFontColor() gdnative.Bool
+	Readonly() gdnative.Bool
+	ShowLineNumbers() gdnative.Bool
+	SmoothScrolling() gdnative.Bool
+	SetSmoothScrolling(value gdnative.Bool)
+	SyntaxHighlighting() gdnative.Bool
+	SetSyntaxHighlighting(value gdnative.Bool)
+	Text() gdnative.String
+	VScrollSpeed() gdnative.Real
+	WrapLines() gdnative.Bool
+	SetWrapLines(value gdnative.Bool)
+	ConnectBreakpointToggled(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBreakpointToggled(args TextEditBreakpointToggledArgs) error
+	ConnectCursorChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCursorChanged() error
+	ConnectRequestCompletion(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRequestCompletion() error
+	ConnectSymbolLookup(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSymbolLookup(args TextEditSymbolLookupArgs) error
+	ConnectTextChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTextChanged() error
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewToolButtonFromPointer(ptr gdnative.Pointer) ToolButton {
-func newToolButtonFromPointer(ptr gdnative.Pointer) ToolButton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ToolButton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This is a helper class to generate a flat [Button] (see [method Button.set_flat]), creating a ToolButton is equivalent to: [codeblock] var btn = Button.new() btn.set_flat(true) [/codeblock]
 */
@@ -30,6 +22,20 @@ type ToolButton struct {
 	owner gdnative.Object
 }
 
+// classConstructorToolButton is the class constructor of the ToolButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorToolButton = gdnative.NewLazyClassConstructor("ToolButton")
+
+// NewToolButton will create a new instance of the ToolButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewToolButton() *ToolButton {
+	obj := &ToolButton{}
+	obj.SetBaseObject(classConstructorToolButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ToolButton) BaseClass() string {
 	return "ToolButton"
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVBoxContainerFromPointer(ptr gdnative.Pointer) VBoxContainer {
-func newVBoxContainerFromPointer(ptr gdnative.Pointer) VBoxContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VBoxContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Vertical box container. See [BoxContainer].
 */
@@ -30,6 +22,20 @@ type VBoxContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorVBoxContainer is the class constructor of the VBoxContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVBoxContainer = gdnative.NewLazyClassConstructor("VBoxContainer")
+
+// NewVBoxContainer will create a new instance of the VBoxContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVBoxContainer() *VBoxContainer {
+	obj := &VBoxContainer{}
+	obj.SetBaseObject(classConstructorVBoxContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VBoxContainer) BaseClass() string {
 	return "VBoxContainer"
 }
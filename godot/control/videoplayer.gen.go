@@ -1,7 +1,9 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/videostream"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +15,65 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVideoPlayerFromPointer(ptr gdnative.Pointer) VideoPlayer {
-func newVideoPlayerFromPointer(ptr gdnative.Pointer) VideoPlayer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VideoPlayer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This control has the ability to play video streams. The only format accepted is the OGV Theora, so any other format must be converted before using in a project.
 */
 type VideoPlayer struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorVideoPlayer is the class constructor of the VideoPlayer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVideoPlayer = gdnative.NewLazyClassConstructor("VideoPlayer")
+
+// NewVideoPlayer will create a new instance of the VideoPlayer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVideoPlayer() *VideoPlayer {
+	obj := &VideoPlayer{}
+	obj.SetBaseObject(classConstructorVideoPlayer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VideoPlayer) BaseClass() string {
 	return "VideoPlayer"
 }
 
+// Method binds of the VideoPlayer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVideoPlayerGetAudioTrack     = gdnative.NewLazyMethodBind("VideoPlayer", "get_audio_track")
+	methodBindVideoPlayerGetBufferingMsec  = gdnative.NewLazyMethodBind("VideoPlayer", "get_buffering_msec")
+	methodBindVideoPlayerGetBus            = gdnative.NewLazyMethodBind("VideoPlayer", "get_bus")
+	methodBindVideoPlayerGetStream         = gdnative.NewLazyMethodBind("VideoPlayer", "get_stream")
+	methodBindVideoPlayerGetStreamName     = gdnative.NewLazyMethodBind("VideoPlayer", "get_stream_name")
+	methodBindVideoPlayerGetStreamPosition = gdnative.NewLazyMethodBind("VideoPlayer", "get_stream_position")
+	methodBindVideoPlayerGetVideoTexture   = gdnative.NewLazyMethodBind("VideoPlayer", "get_video_texture")
+	methodBindVideoPlayerGetVolume         = gdnative.NewLazyMethodBind("VideoPlayer", "get_volume")
+	methodBindVideoPlayerGetVolumeDb       = gdnative.NewLazyMethodBind("VideoPlayer", "get_volume_db")
+	methodBindVideoPlayerHasAutoplay       = gdnative.NewLazyMethodBind("VideoPlayer", "has_autoplay")
+	methodBindVideoPlayerHasExpand         = gdnative.NewLazyMethodBind("VideoPlayer", "has_expand")
+	methodBindVideoPlayerIsPaused          = gdnative.NewLazyMethodBind("VideoPlayer", "is_paused")
+	methodBindVideoPlayerIsPlaying         = gdnative.NewLazyMethodBind("VideoPlayer", "is_playing")
+	methodBindVideoPlayerPlay              = gdnative.NewLazyMethodBind("VideoPlayer", "play")
+	methodBindVideoPlayerSetAudioTrack     = gdnative.NewLazyMethodBind("VideoPlayer", "set_audio_track")
+	methodBindVideoPlayerSetAutoplay       = gdnative.NewLazyMethodBind("VideoPlayer", "set_autoplay")
+	methodBindVideoPlayerSetBufferingMsec  = gdnative.NewLazyMethodBind("VideoPlayer", "set_buffering_msec")
+	methodBindVideoPlayerSetBus            = gdnative.NewLazyMethodBind("VideoPlayer", "set_bus")
+	methodBindVideoPlayerSetExpand         = gdnative.NewLazyMethodBind("VideoPlayer", "set_expand")
+	methodBindVideoPlayerSetPaused         = gdnative.NewLazyMethodBind("VideoPlayer", "set_paused")
+	methodBindVideoPlayerSetStream         = gdnative.NewLazyMethodBind("VideoPlayer", "set_stream")
+	methodBindVideoPlayerSetStreamPosition = gdnative.NewLazyMethodBind("VideoPlayer", "set_stream_position")
+	methodBindVideoPlayerSetVolume         = gdnative.NewLazyMethodBind("VideoPlayer", "set_volume")
+	methodBindVideoPlayerSetVolumeDb       = gdnative.NewLazyMethodBind("VideoPlayer", "set_volume_db")
+	methodBindVideoPlayerStop              = gdnative.NewLazyMethodBind("VideoPlayer", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VideoPlayer) GetAudioTrack() gdnative.Int {
 	//log.Println("Calling VideoPlayer.GetAudioTrack()")
@@ -45,7 +82,7 @@ func (o *VideoPlayer) GetAudioTrack() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_audio_track")
+	methodBind := methodBindVideoPlayerGetAudioTrack.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +95,8 @@ func (o *VideoPlayer) GetAudioTrack() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VideoPlayer) GetBufferingMsec() gdnative.Int {
 	//log.Println("Calling VideoPlayer.GetBufferingMsec()")
@@ -68,7 +105,7 @@ func (o *VideoPlayer) GetBufferingMsec() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_buffering_msec")
+	methodBind := methodBindVideoPlayerGetBufferingMsec.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +118,8 @@ func (o *VideoPlayer) GetBufferingMsec() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VideoPlayer) GetBus() gdnative.String {
 	//log.Println("Calling VideoPlayer.GetBus()")
@@ -91,7 +128,7 @@ func (o *VideoPlayer) GetBus() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_bus")
+	methodBind := methodBindVideoPlayerGetBus.Get()
 
 	// Call the parent method.
 	// String
@@ -104,17 +141,17 @@ func (o *VideoPlayer) GetBus() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: VideoStream
+	        Undocumented
+		Args: [], Returns: VideoStream
 */
-func (o *VideoPlayer) GetStream() VideoStreamImplementer {
+func (o *VideoPlayer) GetStream() videostream.VideoStreamImplementer {
 	//log.Println("Calling VideoPlayer.GetStream()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_stream")
+	methodBind := methodBindVideoPlayerGetStream.Get()
 
 	// Call the parent method.
 	// VideoStream
@@ -122,27 +159,25 @@ func (o *VideoPlayer) GetStream() VideoStreamImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newVideoStreamFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(VideoStreamImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "VideoStream" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(VideoStreamImplementer)
+	ret := videostream.VideoStream{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(videostream.VideoStreamImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Get the name of the video stream.
-	Args: [], Returns: String
+	        Get the name of the video stream.
+		Args: [], Returns: String
 */
 func (o *VideoPlayer) GetStreamName() gdnative.String {
 	//log.Println("Calling VideoPlayer.GetStreamName()")
@@ -151,7 +186,7 @@ func (o *VideoPlayer) GetStreamName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_stream_name")
+	methodBind := methodBindVideoPlayerGetStreamName.Get()
 
 	// Call the parent method.
 	// String
@@ -164,8 +199,8 @@ func (o *VideoPlayer) GetStreamName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VideoPlayer) GetStreamPosition() gdnative.Real {
 	//log.Println("Calling VideoPlayer.GetStreamPosition()")
@@ -174,7 +209,7 @@ func (o *VideoPlayer) GetStreamPosition() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_stream_position")
+	methodBind := methodBindVideoPlayerGetStreamPosition.Get()
 
 	// Call the parent method.
 	// float
@@ -187,17 +222,17 @@ func (o *VideoPlayer) GetStreamPosition() gdnative.Real {
 }
 
 /*
-        Get the current frame of the video as a [Texture].
-	Args: [], Returns: Texture
+	        Get the current frame of the video as a [Texture].
+		Args: [], Returns: Texture
 */
-func (o *VideoPlayer) GetVideoTexture() TextureImplementer {
+func (o *VideoPlayer) GetVideoTexture() godot.TextureImplementer {
 	//log.Println("Calling VideoPlayer.GetVideoTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_video_texture")
+	methodBind := methodBindVideoPlayerGetVideoTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -205,27 +240,25 @@ func (o *VideoPlayer) GetVideoTexture() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VideoPlayer) GetVolume() gdnative.Real {
 	//log.Println("Calling VideoPlayer.GetVolume()")
@@ -234,7 +267,7 @@ func (o *VideoPlayer) GetVolume() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_volume")
+	methodBind := methodBindVideoPlayerGetVolume.Get()
 
 	// Call the parent method.
 	// float
@@ -247,8 +280,8 @@ func (o *VideoPlayer) GetVolume() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VideoPlayer) GetVolumeDb() gdnative.Real {
 	//log.Println("Calling VideoPlayer.GetVolumeDb()")
@@ -257,7 +290,7 @@ func (o *VideoPlayer) GetVolumeDb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "get_volume_db")
+	methodBind := methodBindVideoPlayerGetVolumeDb.Get()
 
 	// Call the parent method.
 	// float
@@ -270,8 +303,8 @@ func (o *VideoPlayer) GetVolumeDb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VideoPlayer) HasAutoplay() gdnative.Bool {
 	//log.Println("Calling VideoPlayer.HasAutoplay()")
@@ -280,7 +313,7 @@ func (o *VideoPlayer) HasAutoplay() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "has_autoplay")
+	methodBind := methodBindVideoPlayerHasAutoplay.Get()
 
 	// Call the parent method.
 	// bool
@@ -293,8 +326,8 @@ func (o *VideoPlayer) HasAutoplay() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VideoPlayer) HasExpand() gdnative.Bool {
 	//log.Println("Calling VideoPlayer.HasExpand()")
@@ -303,7 +336,7 @@ func (o *VideoPlayer) HasExpand() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "has_expand")
+	methodBind := methodBindVideoPlayerHasExpand.Get()
 
 	// Call the parent method.
 	// bool
@@ -316,8 +349,8 @@ func (o *VideoPlayer) HasExpand() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VideoPlayer) IsPaused() gdnative.Bool {
 	//log.Println("Calling VideoPlayer.IsPaused()")
@@ -326,7 +359,7 @@ func (o *VideoPlayer) IsPaused() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "is_paused")
+	methodBind := methodBindVideoPlayerIsPaused.Get()
 
 	// Call the parent method.
 	// bool
@@ -339,8 +372,8 @@ func (o *VideoPlayer) IsPaused() gdnative.Bool {
 }
 
 /*
-        Get whether or not the video is playing.
-	Args: [], Returns: bool
+	        Get whether or not the video is playing.
+		Args: [], Returns: bool
 */
 func (o *VideoPlayer) IsPlaying() gdnative.Bool {
 	//log.Println("Calling VideoPlayer.IsPlaying()")
@@ -349,7 +382,7 @@ func (o *VideoPlayer) IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "is_playing")
+	methodBind := methodBindVideoPlayerIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -362,8 +395,8 @@ func (o *VideoPlayer) IsPlaying() gdnative.Bool {
 }
 
 /*
-        Start the video playback.
-	Args: [], Returns: void
+	        Start the video playback.
+		Args: [], Returns: void
 */
 func (o *VideoPlayer) Play() {
 	//log.Println("Calling VideoPlayer.Play()")
@@ -372,7 +405,7 @@ func (o *VideoPlayer) Play() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "play")
+	methodBind := methodBindVideoPlayerPlay.Get()
 
 	// Call the parent method.
 	// void
@@ -382,8 +415,8 @@ func (o *VideoPlayer) Play() {
 }
 
 /*
-        Undocumented
-	Args: [{ false track int}], Returns: void
+	        Undocumented
+		Args: [{ false track int}], Returns: void
 */
 func (o *VideoPlayer) SetAudioTrack(track gdnative.Int) {
 	//log.Println("Calling VideoPlayer.SetAudioTrack()")
@@ -393,7 +426,7 @@ func (o *VideoPlayer) SetAudioTrack(track gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(track)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_audio_track")
+	methodBind := methodBindVideoPlayerSetAudioTrack.Get()
 
 	// Call the parent method.
 	// void
@@ -403,8 +436,8 @@ func (o *VideoPlayer) SetAudioTrack(track gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *VideoPlayer) SetAutoplay(enabled gdnative.Bool) {
 	//log.Println("Calling VideoPlayer.SetAutoplay()")
@@ -414,7 +447,7 @@ func (o *VideoPlayer) SetAutoplay(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_autoplay")
+	methodBind := methodBindVideoPlayerSetAutoplay.Get()
 
 	// Call the parent method.
 	// void
@@ -424,8 +457,8 @@ func (o *VideoPlayer) SetAutoplay(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false msec int}], Returns: void
+	        Undocumented
+		Args: [{ false msec int}], Returns: void
 */
 func (o *VideoPlayer) SetBufferingMsec(msec gdnative.Int) {
 	//log.Println("Calling VideoPlayer.SetBufferingMsec()")
@@ -435,7 +468,7 @@ func (o *VideoPlayer) SetBufferingMsec(msec gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(msec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_buffering_msec")
+	methodBind := methodBindVideoPlayerSetBufferingMsec.Get()
 
 	// Call the parent method.
 	// void
@@ -445,8 +478,8 @@ func (o *VideoPlayer) SetBufferingMsec(msec gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bus String}], Returns: void
+	        Undocumented
+		Args: [{ false bus String}], Returns: void
 */
 func (o *VideoPlayer) SetBus(bus gdnative.String) {
 	//log.Println("Calling VideoPlayer.SetBus()")
@@ -456,7 +489,7 @@ func (o *VideoPlayer) SetBus(bus gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(bus)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_bus")
+	methodBind := methodBindVideoPlayerSetBus.Get()
 
 	// Call the parent method.
 	// void
@@ -466,8 +499,8 @@ func (o *VideoPlayer) SetBus(bus gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *VideoPlayer) SetExpand(enable gdnative.Bool) {
 	//log.Println("Calling VideoPlayer.SetExpand()")
@@ -477,7 +510,7 @@ func (o *VideoPlayer) SetExpand(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_expand")
+	methodBind := methodBindVideoPlayerSetExpand.Get()
 
 	// Call the parent method.
 	// void
@@ -487,8 +520,8 @@ func (o *VideoPlayer) SetExpand(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false paused bool}], Returns: void
+	        Undocumented
+		Args: [{ false paused bool}], Returns: void
 */
 func (o *VideoPlayer) SetPaused(paused gdnative.Bool) {
 	//log.Println("Calling VideoPlayer.SetPaused()")
@@ -498,7 +531,7 @@ func (o *VideoPlayer) SetPaused(paused gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(paused)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_paused")
+	methodBind := methodBindVideoPlayerSetPaused.Get()
 
 	// Call the parent method.
 	// void
@@ -508,18 +541,18 @@ func (o *VideoPlayer) SetPaused(paused gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false stream VideoStream}], Returns: void
+	        Undocumented
+		Args: [{ false stream VideoStream}], Returns: void
 */
-func (o *VideoPlayer) SetStream(stream VideoStreamImplementer) {
+func (o *VideoPlayer) SetStream(stream videostream.VideoStreamImplementer) {
 	//log.Println("Calling VideoPlayer.SetStream()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(stream.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(stream))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_stream")
+	methodBind := methodBindVideoPlayerSetStream.Get()
 
 	// Call the parent method.
 	// void
@@ -529,8 +562,8 @@ func (o *VideoPlayer) SetStream(stream VideoStreamImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position float}], Returns: void
+	        Undocumented
+		Args: [{ false position float}], Returns: void
 */
 func (o *VideoPlayer) SetStreamPosition(position gdnative.Real) {
 	//log.Println("Calling VideoPlayer.SetStreamPosition()")
@@ -540,7 +573,7 @@ func (o *VideoPlayer) SetStreamPosition(position gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_stream_position")
+	methodBind := methodBindVideoPlayerSetStreamPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -550,8 +583,8 @@ func (o *VideoPlayer) SetStreamPosition(position gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false volume float}], Returns: void
+	        Undocumented
+		Args: [{ false volume float}], Returns: void
 */
 func (o *VideoPlayer) SetVolume(volume gdnative.Real) {
 	//log.Println("Calling VideoPlayer.SetVolume()")
@@ -561,7 +594,7 @@ func (o *VideoPlayer) SetVolume(volume gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(volume)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_volume")
+	methodBind := methodBindVideoPlayerSetVolume.Get()
 
 	// Call the parent method.
 	// void
@@ -571,8 +604,8 @@ func (o *VideoPlayer) SetVolume(volume gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false db float}], Returns: void
+	        Undocumented
+		Args: [{ false db float}], Returns: void
 */
 func (o *VideoPlayer) SetVolumeDb(db gdnative.Real) {
 	//log.Println("Calling VideoPlayer.SetVolumeDb()")
@@ -582,7 +615,7 @@ func (o *VideoPlayer) SetVolumeDb(db gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(db)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "set_volume_db")
+	methodBind := methodBindVideoPlayerSetVolumeDb.Get()
 
 	// Call the parent method.
 	// void
@@ -592,8 +625,8 @@ func (o *VideoPlayer) SetVolumeDb(db gdnative.Real) {
 }
 
 /*
-        Stop the video playback.
-	Args: [], Returns: void
+	        Stop the video playback.
+		Args: [], Returns: void
 */
 func (o *VideoPlayer) Stop() {
 	//log.Println("Calling VideoPlayer.Stop()")
@@ -602,7 +635,7 @@ func (o *VideoPlayer) Stop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoPlayer", "stop")
+	methodBind := methodBindVideoPlayerStop.Get()
 
 	// Call the parent method.
 	// void
@@ -611,17 +644,67 @@ func (o *VideoPlayer) Stop() {
 
 }
 
+// AudioTrack will return the value of the "audio_track" property.
+func (o *VideoPlayer) AudioTrack() gdnative.Int {
+	return o.GetAudioTrack()
+}
+
+// Autoplay will return the value of the "autoplay" property.
+func (o *VideoPlayer) Autoplay() gdnative.Bool {
+	return o.HasAutoplay()
+}
+
+// BufferingMsec will return the value of the "buffering_msec" property.
+func (o *VideoPlayer) BufferingMsec() gdnative.Int {
+	return o.GetBufferingMsec()
+}
+
+// Bus will return the value of the "bus" property.
+func (o *VideoPlayer) Bus() gdnative.String {
+	return o.GetBus()
+}
+
+// Expand will return the value of the "expand" property.
+func (o *VideoPlayer) Expand() gdnative.Bool {
+	return o.HasExpand()
+}
+
+// Paused will return the value of the "paused" property.
+func (o *VideoPlayer) Paused() gdnative.Bool {
+	return o.IsPaused()
+}
+
+// Stream will return the value of the "stream" property.
+func (o *VideoPlayer) Stream() videostream.VideoStreamImplementer {
+	return o.GetStream()
+}
+
+// StreamPosition will return the value of the "stream_position" property.
+func (o *VideoPlayer) StreamPosition() gdnative.Real {
+	return o.GetStreamPosition()
+}
+
+// Volume will return the value of the "volume" property.
+func (o *VideoPlayer) Volume() gdnative.Real {
+	return o.GetVolume()
+}
+
+// VolumeDb will return the value of the "volume_db" property.
+func (o *VideoPlayer) VolumeDb() gdnative.Real {
+	return o.GetVolumeDb()
+}
+
 // VideoPlayerImplementer is an interface that implements the methods
 // of the VideoPlayer class.
 type VideoPlayerImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 	GetAudioTrack() gdnative.Int
 	GetBufferingMsec() gdnative.Int
 	GetBus() gdnative.String
-	GetStream() VideoStreamImplementer
+	GetStream() videostream.VideoStreamImplementer
 	GetStreamName() gdnative.String
 	GetStreamPosition() gdnative.Real
-	GetVideoTexture() TextureImplementer
+	GetVideoTexture() godot.TextureImplementer
 	GetVolume() gdnative.Real
 	GetVolumeDb() gdnative.Real
 	HasAutoplay() gdnative.Bool
@@ -635,9 +718,19 @@ type VideoPlayerImplementer interface {
 	SetBus(bus gdnative.String)
 	SetExpand(enable gdnative.Bool)
 	SetPaused(paused gdnative.Bool)
-	SetStream(stream VideoStreamImplementer)
+	SetStream(stream videostream.VideoStreamImplementer)
 	SetStreamPosition(position gdnative.Real)
 	SetVolume(volume gdnative.Real)
 	SetVolumeDb(db gdnative.Real)
 	Stop()
+	AudioTrack() gdnative.Int
+	Autoplay() gdnative.Bool
+	BufferingMsec() gdnative.Int
+	Bus() gdnative.String
+	Expand() gdnative.Bool
+	Paused() gdnative.Bool
+	Stream() videostream.VideoStreamImplementer
+	StreamPosition() gdnative.Real
+	Volume() gdnative.Real
+	VolumeDb() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,27 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVScrollBarFromPointer(ptr gdnative.Pointer) VScrollBar {
-func newVScrollBarFromPointer(ptr gdnative.Pointer) VScrollBar {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VScrollBar{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type VScrollBar struct {
 	ScrollBar
 	owner gdnative.Object
 }
 
+// classConstructorVScrollBar is the class constructor of the VScrollBar class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVScrollBar = gdnative.NewLazyClassConstructor("VScrollBar")
+
+// NewVScrollBar will create a new instance of the VScrollBar class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVScrollBar() *VScrollBar {
+	obj := &VScrollBar{}
+	obj.SetBaseObject(classConstructorVScrollBar.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VScrollBar) BaseClass() string {
 	return "VScrollBar"
 }
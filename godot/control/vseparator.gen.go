@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVSeparatorFromPointer(ptr gdnative.Pointer) VSeparator {
-func newVSeparatorFromPointer(ptr gdnative.Pointer) VSeparator {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VSeparator{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Vertical version of [Separator]. It is used to separate objects horizontally, though (but it looks vertical!).
 */
@@ -30,6 +22,20 @@ type VSeparator struct {
 	owner gdnative.Object
 }
 
+// classConstructorVSeparator is the class constructor of the VSeparator class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVSeparator = gdnative.NewLazyClassConstructor("VSeparator")
+
+// NewVSeparator will create a new instance of the VSeparator class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVSeparator() *VSeparator {
+	obj := &VSeparator{}
+	obj.SetBaseObject(classConstructorVSeparator.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VSeparator) BaseClass() string {
 	return "VSeparator"
 }
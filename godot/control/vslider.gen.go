@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,28 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVSliderFromPointer(ptr gdnative.Pointer) VSlider {
-func newVSliderFromPointer(ptr gdnative.Pointer) VSlider {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VSlider{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Vertical slider. See [Slider]. This one goes from left (min) to right (max).
 */
 type VSlider struct {
-	Slider
+	godot.Slider
 	owner gdnative.Object
 }
 
+// classConstructorVSlider is the class constructor of the VSlider class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVSlider = gdnative.NewLazyClassConstructor("VSlider")
+
+// NewVSlider will create a new instance of the VSlider class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVSlider() *VSlider {
+	obj := &VSlider{}
+	obj.SetBaseObject(classConstructorVSlider.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VSlider) BaseClass() string {
 	return "VSlider"
 }
@@ -37,5 +43,5 @@ func (o *VSlider) BaseClass() string {
 // VSliderImplementer is an interface that implements the methods
 // of the VSlider class.
 type VSliderImplementer interface {
-	SliderImplementer
+	godot.SliderImplementer
 }
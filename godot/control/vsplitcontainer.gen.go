@@ -1,7 +1,8 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVSplitContainerFromPointer(ptr gdnative.Pointer) VSplitContainer {
-func newVSplitContainerFromPointer(ptr gdnative.Pointer) VSplitContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VSplitContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Vertical split container. See [SplitContainer]. This goes from left to right.
 */
@@ -30,6 +22,20 @@ type VSplitContainer struct {
 	owner gdnative.Object
 }
 
+// classConstructorVSplitContainer is the class constructor of the VSplitContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVSplitContainer = gdnative.NewLazyClassConstructor("VSplitContainer")
+
+// NewVSplitContainer will create a new instance of the VSplitContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVSplitContainer() *VSplitContainer {
+	obj := &VSplitContainer{}
+	obj.SetBaseObject(classConstructorVSplitContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VSplitContainer) BaseClass() string {
 	return "VSplitContainer"
 }
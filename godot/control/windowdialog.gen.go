@@ -1,7 +1,10 @@
-package godot
+package control
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/popup"
+	"github.com/shadowapex/godot-go/godot/texture"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +16,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewWindowDialogFromPointer(ptr gdnative.Pointer) WindowDialog {
-func newWindowDialogFromPointer(ptr gdnative.Pointer) WindowDialog {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := WindowDialog{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Windowdialog is the base class for all window-based dialogs. It's a by-default toplevel [Control] that draws a window decoration and allows motion and resizing.
 */
 type WindowDialog struct {
-	Popup
+	popup.Popup
 	owner gdnative.Object
 }
 
+// classConstructorWindowDialog is the class constructor of the WindowDialog class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorWindowDialog = gdnative.NewLazyClassConstructor("WindowDialog")
+
+// NewWindowDialog will create a new instance of the WindowDialog class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewWindowDialog() *WindowDialog {
+	obj := &WindowDialog{}
+	obj.SetBaseObject(classConstructorWindowDialog.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *WindowDialog) BaseClass() string {
 	return "WindowDialog"
 }
 
+// Method binds of the WindowDialog class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindWindowDialogX_Closed       = gdnative.NewLazyMethodBind("WindowDialog", "_closed")
+	methodBindWindowDialogX_GuiInput     = gdnative.NewLazyMethodBind("WindowDialog", "_gui_input")
+	methodBindWindowDialogGetCloseButton = gdnative.NewLazyMethodBind("WindowDialog", "get_close_button")
+	methodBindWindowDialogGetResizable   = gdnative.NewLazyMethodBind("WindowDialog", "get_resizable")
+	methodBindWindowDialogGetTitle       = gdnative.NewLazyMethodBind("WindowDialog", "get_title")
+	methodBindWindowDialogSetResizable   = gdnative.NewLazyMethodBind("WindowDialog", "set_resizable")
+	methodBindWindowDialogSetTitle       = gdnative.NewLazyMethodBind("WindowDialog", "set_title")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *WindowDialog) X_Closed() {
 	//log.Println("Calling WindowDialog.X_Closed()")
@@ -45,7 +65,7 @@ func (o *WindowDialog) X_Closed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WindowDialog", "_closed")
+	methodBind := methodBindWindowDialogX_Closed.Get()
 
 	// Call the parent method.
 	// void
@@ -55,18 +75,18 @@ func (o *WindowDialog) X_Closed() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *WindowDialog) X_GuiInput(arg0 InputEventImplementer) {
+func (o *WindowDialog) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling WindowDialog.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WindowDialog", "_gui_input")
+	methodBind := methodBindWindowDialogX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -76,17 +96,17 @@ func (o *WindowDialog) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Return the close [TextureButton].
-	Args: [], Returns: TextureButton
+	        Return the close [TextureButton].
+		Args: [], Returns: TextureButton
 */
-func (o *WindowDialog) GetCloseButton() TextureButtonImplementer {
+func (o *WindowDialog) GetCloseButton() texture.TextureButtonImplementer {
 	//log.Println("Calling WindowDialog.GetCloseButton()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WindowDialog", "get_close_button")
+	methodBind := methodBindWindowDialogGetCloseButton.Get()
 
 	// Call the parent method.
 	// TextureButton
@@ -94,27 +114,25 @@ func (o *WindowDialog) GetCloseButton() TextureButtonImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureButtonFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureButtonImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TextureButton" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureButtonImplementer)
+	ret := texture.TextureButton{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(texture.TextureButtonImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *WindowDialog) GetResizable() gdnative.Bool {
 	//log.Println("Calling WindowDialog.GetResizable()")
@@ -123,7 +141,7 @@ func (o *WindowDialog) GetResizable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WindowDialog", "get_resizable")
+	methodBind := methodBindWindowDialogGetResizable.Get()
 
 	// Call the parent method.
 	// bool
@@ -136,8 +154,8 @@ func (o *WindowDialog) GetResizable() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *WindowDialog) GetTitle() gdnative.String {
 	//log.Println("Calling WindowDialog.GetTitle()")
@@ -146,7 +164,7 @@ func (o *WindowDialog) GetTitle() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WindowDialog", "get_title")
+	methodBind := methodBindWindowDialogGetTitle.Get()
 
 	// Call the parent method.
 	// String
@@ -159,8 +177,8 @@ func (o *WindowDialog) GetTitle() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false resizable bool}], Returns: void
+	        Undocumented
+		Args: [{ false resizable bool}], Returns: void
 */
 func (o *WindowDialog) SetResizable(resizable gdnative.Bool) {
 	//log.Println("Calling WindowDialog.SetResizable()")
@@ -170,7 +188,7 @@ func (o *WindowDialog) SetResizable(resizable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(resizable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WindowDialog", "set_resizable")
+	methodBind := methodBindWindowDialogSetResizable.Get()
 
 	// Call the parent method.
 	// void
@@ -180,8 +198,8 @@ func (o *WindowDialog) SetResizable(resizable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false title String}], Returns: void
+	        Undocumented
+		Args: [{ false title String}], Returns: void
 */
 func (o *WindowDialog) SetTitle(title gdnative.String) {
 	//log.Println("Calling WindowDialog.SetTitle()")
@@ -191,7 +209,7 @@ func (o *WindowDialog) SetTitle(title gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(title)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WindowDialog", "set_title")
+	methodBind := methodBindWindowDialogSetTitle.Get()
 
 	// Call the parent method.
 	// void
@@ -200,14 +218,32 @@ func (o *WindowDialog) SetTitle(title gdnative.String) {
 
 }
 
+// Resizable will return the value of the "resizable" property.
+func (o *WindowDialog) Resizable() gdnative.Bool {
+	return o.GetResizable()
+}
+
+// WindowTitle will return the value of the "window_title" property.
+func (o *WindowDialog) WindowTitle() gdnative.String {
+	return o.GetTitle()
+}
+
+// SetWindowTitle will set the value of the "window_title" property.
+func (o *WindowDialog) SetWindowTitle(value gdnative.String) {
+	o.SetTitle(value)
+}
+
 // WindowDialogImplementer is an interface that implements the methods
 // of the WindowDialog class.
 type WindowDialogImplementer interface {
-	PopupImplementer
+	popup.PopupImplementer
 	X_Closed()
-	GetCloseButton() TextureButtonImplementer
+	GetCloseButton() texture.TextureButtonImplementer
 	GetResizable() gdnative.Bool
 	GetTitle() gdnative.String
 	SetResizable(resizable gdnative.Bool)
 	SetTitle(title gdnative.String)
+	Resizable() gdnative.Bool
+	WindowTitle() gdnative.String
+	SetWindowTitle(value gdnative.String)
 }
@@ -1,8 +1,6 @@
 package godot
 
 import (
-	"log"
-
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -15,1746 +13,774 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-// getActualClass will return the concrete class type of the godot object based on
-// the given class name.
-func getActualClass(className gdnative.String, obj gdnative.Object) ObjectImplementer {
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if debug {
-		log.Println("Checking to see if", obj.ID(), "is in registry:", InstanceRegistry)
-	}
-	if instance, ok := InstanceRegistry.Get(obj.ID()); ok {
-		if debug {
-			log.Println("Class instance already found in registry!")
-		}
-		return instance.(ObjectImplementer)
-	}
+// baseClasses is a lookup table of the base class of every Godot class. It is
+// used to find the closest class that has a registered constructor.
+var baseClasses = map[string]string{
+	"ARVRAnchor":                     "Spatial",
+	"ARVRCamera":                     "Camera",
+	"ARVRController":                 "Spatial",
+	"ARVRInterface":                  "Reference",
+	"ARVRInterfaceGDNative":          "ARVRInterface",
+	"ARVROrigin":                     "Spatial",
+	"ARVRPositionalTracker":          "Object",
+	"ARVRServer":                     "Object",
+	"AStar":                          "Reference",
+	"AcceptDialog":                   "WindowDialog",
+	"AnimatedSprite":                 "Node2D",
+	"AnimatedSprite3D":               "SpriteBase3D",
+	"Animation":                      "Resource",
+	"AnimationPlayer":                "Node",
+	"AnimationTreePlayer":            "Node",
+	"Area":                           "CollisionObject",
+	"Area2D":                         "CollisionObject2D",
+	"ArrayMesh":                      "Mesh",
+	"AtlasTexture":                   "Texture",
+	"AudioBusLayout":                 "Resource",
+	"AudioEffect":                    "Resource",
+	"AudioEffectAmplify":             "AudioEffect",
+	"AudioEffectBandLimitFilter":     "AudioEffectFilter",
+	"AudioEffectBandPassFilter":      "AudioEffectFilter",
+	"AudioEffectChorus":              "AudioEffect",
+	"AudioEffectCompressor":          "AudioEffect",
+	"AudioEffectDelay":               "AudioEffect",
+	"AudioEffectDistortion":          "AudioEffect",
+	"AudioEffectEQ":                  "AudioEffect",
+	"AudioEffectEQ10":                "AudioEffectEQ",
+	"AudioEffectEQ21":                "AudioEffectEQ",
+	"AudioEffectEQ6":                 "AudioEffectEQ",
+	"AudioEffectFilter":              "AudioEffect",
+	"AudioEffectHighPassFilter":      "AudioEffectFilter",
+	"AudioEffectHighShelfFilter":     "AudioEffectFilter",
+	"AudioEffectLimiter":             "AudioEffect",
+	"AudioEffectLowPassFilter":       "AudioEffectFilter",
+	"AudioEffectLowShelfFilter":      "AudioEffectFilter",
+	"AudioEffectNotchFilter":         "AudioEffectFilter",
+	"AudioEffectPanner":              "AudioEffect",
+	"AudioEffectPhaser":              "AudioEffect",
+	"AudioEffectPitchShift":          "AudioEffect",
+	"AudioEffectReverb":              "AudioEffect",
+	"AudioEffectStereoEnhance":       "AudioEffect",
+	"AudioServer":                    "Object",
+	"AudioStream":                    "Resource",
+	"AudioStreamOGGVorbis":           "AudioStream",
+	"AudioStreamPlayback":            "Reference",
+	"AudioStreamPlayer":              "Node",
+	"AudioStreamPlayer2D":            "Node2D",
+	"AudioStreamPlayer3D":            "Spatial",
+	"AudioStreamRandomPitch":         "AudioStream",
+	"AudioStreamSample":              "AudioStream",
+	"BackBufferCopy":                 "Node2D",
+	"BakedLightmap":                  "VisualInstance",
+	"BakedLightmapData":              "Resource",
+	"BaseButton":                     "Control",
+	"BitMap":                         "Resource",
+	"BitmapFont":                     "Font",
+	"BoneAttachment":                 "Spatial",
+	"BoxContainer":                   "Container",
+	"BoxShape":                       "Shape",
+	"BulletPhysicsDirectBodyState":   "PhysicsDirectBodyState",
+	"BulletPhysicsServer":            "PhysicsServer",
+	"Button":                         "BaseButton",
+	"ButtonGroup":                    "Resource",
+	"Camera":                         "Spatial",
+	"Camera2D":                       "Node2D",
+	"CanvasItem":                     "Node",
+	"CanvasItemMaterial":             "Material",
+	"CanvasLayer":                    "Node",
+	"CanvasModulate":                 "Node2D",
+	"CapsuleMesh":                    "PrimitiveMesh",
+	"CapsuleShape":                   "Shape",
+	"CapsuleShape2D":                 "Shape2D",
+	"CenterContainer":                "Container",
+	"CheckBox":                       "Button",
+	"CheckButton":                    "Button",
+	"CircleShape2D":                  "Shape2D",
+	"CollisionObject":                "Spatial",
+	"CollisionObject2D":              "Node2D",
+	"CollisionPolygon":               "Spatial",
+	"CollisionPolygon2D":             "Node2D",
+	"CollisionShape":                 "Spatial",
+	"CollisionShape2D":               "Node2D",
+	"ColorPicker":                    "BoxContainer",
+	"ColorPickerButton":              "Button",
+	"ColorRect":                      "Control",
+	"ConcavePolygonShape":            "Shape",
+	"ConcavePolygonShape2D":          "Shape2D",
+	"ConeTwistJoint":                 "Joint",
+	"ConfigFile":                     "Reference",
+	"ConfirmationDialog":             "AcceptDialog",
+	"Container":                      "Control",
+	"Control":                        "CanvasItem",
+	"ConvexPolygonShape":             "Shape",
+	"ConvexPolygonShape2D":           "Shape2D",
+	"CubeMap":                        "Resource",
+	"CubeMesh":                       "PrimitiveMesh",
+	"Curve":                          "Resource",
+	"Curve2D":                        "Resource",
+	"Curve3D":                        "Resource",
+	"CurveTexture":                   "Texture",
+	"CylinderMesh":                   "PrimitiveMesh",
+	"DampedSpringJoint2D":            "Joint2D",
+	"DirectionalLight":               "Light",
+	"DynamicFont":                    "Font",
+	"DynamicFontData":                "Resource",
+	"EditorExportPlugin":             "Reference",
+	"EditorFileDialog":               "ConfirmationDialog",
+	"EditorFileSystem":               "Node",
+	"EditorFileSystemDirectory":      "Object",
+	"EditorImportPlugin":             "Reference",
+	"EditorInterface":                "Node",
+	"EditorPlugin":                   "Node",
+	"EditorResourceConversionPlugin": "Reference",
+	"EditorResourcePreview":          "Node",
+	"EditorResourcePreviewGenerator": "Reference",
+	"EditorSceneImporter":            "Reference",
+	"EditorScenePostImport":          "Reference",
+	"EditorScript":                   "Reference",
+	"EditorSelection":                "Object",
+	"EditorSettings":                 "Resource",
+	"EditorSpatialGizmo":             "SpatialGizmo",
+	"EncodedObjectAsID":              "Reference",
+	"Environment":                    "Resource",
+	"FileDialog":                     "ConfirmationDialog",
+	"Font":                           "Resource",
+	"FuncRef":                        "Reference",
+	"GDNative":                       "Reference",
+	"GDNativeLibrary":                "Resource",
+	"GDScript":                       "Script",
+	"GDScriptFunctionState":          "Reference",
+	"GIProbe":                        "VisualInstance",
+	"GIProbeData":                    "Resource",
+	"Generic6DOFJoint":               "Joint",
+	"GeometryInstance":               "VisualInstance",
+	"Gradient":                       "Resource",
+	"GradientTexture":                "Texture",
+	"GraphEdit":                      "Control",
+	"GraphNode":                      "Container",
+	"GridContainer":                  "Container",
+	"GridMap":                        "Spatial",
+	"GrooveJoint2D":                  "Joint2D",
+	"HBoxContainer":                  "BoxContainer",
+	"HScrollBar":                     "ScrollBar",
+	"HSeparator":                     "Separator",
+	"HSlider":                        "Slider",
+	"HSplitContainer":                "SplitContainer",
+	"HTTPClient":                     "Reference",
+	"HTTPRequest":                    "Node",
+	"HingeJoint":                     "Joint",
+	"IP":                             "Object",
+	"IP_Unix":                        "IP",
+	"Image":                          "Resource",
+	"ImageTexture":                   "Texture",
+	"ImmediateGeometry":              "GeometryInstance",
+	"Input":                          "Object",
+	"InputDefault":                   "Input",
+	"InputEvent":                     "Resource",
+	"InputEventAction":               "InputEvent",
+	"InputEventGesture":              "InputEventWithModifiers",
+	"InputEventJoypadButton":         "InputEvent",
+	"InputEventJoypadMotion":         "InputEvent",
+	"InputEventKey":                  "InputEventWithModifiers",
+	"InputEventMagnifyGesture":       "InputEventGesture",
+	"InputEventMouse":                "InputEventWithModifiers",
+	"InputEventMouseButton":          "InputEventMouse",
+	"InputEventMouseMotion":          "InputEventMouse",
+	"InputEventPanGesture":           "InputEventGesture",
+	"InputEventScreenDrag":           "InputEvent",
+	"InputEventScreenTouch":          "InputEvent",
+	"InputEventWithModifiers":        "InputEvent",
+	"InputMap":                       "Object",
+	"InstancePlaceholder":            "Node",
+	"InterpolatedCamera":             "Camera",
+	"ItemList":                       "Control",
+	"JSONParseResult":                "Reference",
+	"JavaScript":                     "Object",
+	"Joint":                          "Spatial",
+	"Joint2D":                        "Node2D",
+	"KinematicBody":                  "PhysicsBody",
+	"KinematicBody2D":                "PhysicsBody2D",
+	"KinematicCollision":             "Reference",
+	"KinematicCollision2D":           "Reference",
+	"Label":                          "Control",
+	"LargeTexture":                   "Texture",
+	"Light":                          "VisualInstance",
+	"Light2D":                        "Node2D",
+	"LightOccluder2D":                "Node2D",
+	"Line2D":                         "Node2D",
+	"LineEdit":                       "Control",
+	"LineShape2D":                    "Shape2D",
+	"LinkButton":                     "BaseButton",
+	"Listener":                       "Spatial",
+	"MainLoop":                       "Object",
+	"MarginContainer":                "Container",
+	"Material":                       "Resource",
+	"MenuButton":                     "Button",
+	"Mesh":                           "Resource",
+	"MeshDataTool":                   "Reference",
+	"MeshInstance":                   "GeometryInstance",
+	"MeshLibrary":                    "Resource",
+	"MobileVRInterface":              "ARVRInterface",
+	"MultiMesh":                      "Resource",
+	"MultiMeshInstance":              "GeometryInstance",
+	"NativeScript":                   "Script",
+	"Navigation":                     "Spatial",
+	"Navigation2D":                   "Node2D",
+	"NavigationMesh":                 "Resource",
+	"NavigationMeshInstance":         "Spatial",
+	"NavigationPolygon":              "Resource",
+	"NavigationPolygonInstance":      "Node2D",
+	"NetworkedMultiplayerENet":       "NetworkedMultiplayerPeer",
+	"NetworkedMultiplayerPeer":       "PacketPeer",
+	"NinePatchRect":                  "Control",
+	"Node":                           "Object",
+	"Node2D":                         "CanvasItem",
+	"Object":                         "",
+	"OccluderPolygon2D":              "Resource",
+	"OmniLight":                      "Light",
+	"OptionButton":                   "Button",
+	"PCKPacker":                      "Reference",
+	"PHashTranslation":               "Translation",
+	"PackedDataContainer":            "Resource",
+	"PackedDataContainerRef":         "Reference",
+	"PackedScene":                    "Resource",
+	"PacketPeer":                     "Reference",
+	"PacketPeerStream":               "PacketPeer",
+	"PacketPeerUDP":                  "PacketPeer",
+	"Panel":                          "Control",
+	"PanelContainer":                 "Container",
+	"PanoramaSky":                    "Sky",
+	"ParallaxBackground":             "CanvasLayer",
+	"ParallaxLayer":                  "Node2D",
+	"Particles":                      "GeometryInstance",
+	"Particles2D":                    "Node2D",
+	"ParticlesMaterial":              "Material",
+	"Path":                           "Spatial",
+	"Path2D":                         "Node2D",
+	"PathFollow":                     "Spatial",
+	"PathFollow2D":                   "Node2D",
+	"Performance":                    "Object",
+	"Physics2DDirectBodyState":       "Object",
+	"Physics2DDirectBodyStateSW":     "Physics2DDirectBodyState",
+	"Physics2DDirectSpaceState":      "Object",
+	"Physics2DServer":                "Object",
+	"Physics2DServerSW":              "Physics2DServer",
+	"Physics2DShapeQueryParameters":  "Reference",
+	"Physics2DShapeQueryResult":      "Reference",
+	"Physics2DTestMotionResult":      "Reference",
+	"PhysicsBody":                    "CollisionObject",
+	"PhysicsBody2D":                  "CollisionObject2D",
+	"PhysicsDirectBodyState":         "Object",
+	"PhysicsDirectSpaceState":        "Object",
+	"PhysicsServer":                  "Object",
+	"PhysicsShapeQueryParameters":    "Reference",
+	"PhysicsShapeQueryResult":        "Reference",
+	"PinJoint":                       "Joint",
+	"PinJoint2D":                     "Joint2D",
+	"PlaneMesh":                      "PrimitiveMesh",
+	"PlaneShape":                     "Shape",
+	"PluginScript":                   "Script",
+	"Polygon2D":                      "Node2D",
+	"PolygonPathFinder":              "Resource",
+	"Popup":                          "Control",
+	"PopupDialog":                    "Popup",
+	"PopupMenu":                      "Popup",
+	"PopupPanel":                     "Popup",
+	"Position2D":                     "Node2D",
+	"Position3D":                     "Spatial",
+	"PrimitiveMesh":                  "Mesh",
+	"PrismMesh":                      "PrimitiveMesh",
+	"ProceduralSky":                  "Sky",
+	"ProgressBar":                    "Range",
+	"ProjectSettings":                "Object",
+	"ProximityGroup":                 "Spatial",
+	"ProxyTexture":                   "Texture",
+	"QuadMesh":                       "PrimitiveMesh",
+	"Range":                          "Control",
+	"RayCast":                        "Spatial",
+	"RayCast2D":                      "Node2D",
+	"RayShape":                       "Shape",
+	"RayShape2D":                     "Shape2D",
+	"RectangleShape2D":               "Shape2D",
+	"Reference":                      "Object",
+	"ReferenceRect":                  "Control",
+	"ReflectionProbe":                "VisualInstance",
+	"RegEx":                          "Reference",
+	"RegExMatch":                     "Reference",
+	"RemoteTransform":                "Spatial",
+	"RemoteTransform2D":              "Node2D",
+	"Resource":                       "Reference",
+	"ResourceImporter":               "Reference",
+	"ResourceImporterOGGVorbis":      "ResourceImporter",
+	"ResourceImporterTheora":         "ResourceImporter",
+	"ResourceImporterWebm":           "ResourceImporter",
+	"ResourceInteractiveLoader":      "Reference",
+	"ResourcePreloader":              "Node",
+	"RichTextLabel":                  "Control",
+	"RigidBody":                      "PhysicsBody",
+	"RigidBody2D":                    "PhysicsBody2D",
+	"SceneState":                     "Reference",
+	"SceneTree":                      "MainLoop",
+	"SceneTreeTimer":                 "Reference",
+	"Script":                         "Resource",
+	"ScriptEditor":                   "PanelContainer",
+	"ScrollBar":                      "Range",
+	"ScrollContainer":                "Container",
+	"SegmentShape2D":                 "Shape2D",
+	"Separator":                      "Control",
+	"Shader":                         "Resource",
+	"ShaderMaterial":                 "Material",
+	"Shape":                          "Resource",
+	"Shape2D":                        "Resource",
+	"ShortCut":                       "Resource",
+	"Skeleton":                       "Spatial",
+	"Sky":                            "Resource",
+	"Slider":                         "Range",
+	"SliderJoint":                    "Joint",
+	"Spatial":                        "Node",
+	"SpatialGizmo":                   "Reference",
+	"SpatialMaterial":                "Material",
+	"SpatialVelocityTracker":         "Reference",
+	"SphereMesh":                     "PrimitiveMesh",
+	"SphereShape":                    "Shape",
+	"SpinBox":                        "Range",
+	"SplitContainer":                 "Container",
+	"SpotLight":                      "Light",
+	"Sprite":                         "Node2D",
+	"Sprite3D":                       "SpriteBase3D",
+	"SpriteBase3D":                   "GeometryInstance",
+	"SpriteFrames":                   "Resource",
+	"StaticBody":                     "PhysicsBody",
+	"StaticBody2D":                   "PhysicsBody2D",
+	"StreamPeer":                     "Reference",
+	"StreamPeerBuffer":               "StreamPeer",
+	"StreamPeerSSL":                  "StreamPeer",
+	"StreamPeerTCP":                  "StreamPeer",
+	"StreamTexture":                  "Texture",
+	"StyleBox":                       "Resource",
+	"StyleBoxEmpty":                  "StyleBox",
+	"StyleBoxFlat":                   "StyleBox",
+	"StyleBoxLine":                   "StyleBox",
+	"StyleBoxTexture":                "StyleBox",
+	"SurfaceTool":                    "Reference",
+	"TCP_Server":                     "Reference",
+	"TabContainer":                   "Control",
+	"Tabs":                           "Control",
+	"TextEdit":                       "Control",
+	"Texture":                        "Resource",
+	"TextureButton":                  "BaseButton",
+	"TextureProgress":                "Range",
+	"TextureRect":                    "Control",
+	"Theme":                          "Resource",
+	"TileMap":                        "Node2D",
+	"TileSet":                        "Resource",
+	"Timer":                          "Node",
+	"ToolButton":                     "Button",
+	"TouchScreenButton":              "Node2D",
+	"Translation":                    "Resource",
+	"TranslationServer":              "Object",
+	"Tree":                           "Control",
+	"TreeItem":                       "Object",
+	"TriangleMesh":                   "Reference",
+	"Tween":                          "Node",
+	"UndoRedo":                       "Object",
+	"VBoxContainer":                  "BoxContainer",
+	"VScrollBar":                     "ScrollBar",
+	"VSeparator":                     "Separator",
+	"VSlider":                        "Slider",
+	"VSplitContainer":                "SplitContainer",
+	"VehicleBody":                    "RigidBody",
+	"VehicleWheel":                   "Spatial",
+	"VideoPlayer":                    "Control",
+	"VideoStream":                    "Resource",
+	"VideoStreamTheora":              "VideoStream",
+	"VideoStreamWebm":                "VideoStream",
+	"Viewport":                       "Node",
+	"ViewportContainer":              "Container",
+	"ViewportTexture":                "Texture",
+	"VisibilityEnabler":              "VisibilityNotifier",
+	"VisibilityEnabler2D":            "VisibilityNotifier2D",
+	"VisibilityNotifier":             "Spatial",
+	"VisibilityNotifier2D":           "Node2D",
+	"VisualInstance":                 "Spatial",
+	"VisualScript":                   "Script",
+	"VisualScriptBasicTypeConstant":  "VisualScriptNode",
+	"VisualScriptBuiltinFunc":        "VisualScriptNode",
+	"VisualScriptClassConstant":      "VisualScriptNode",
+	"VisualScriptComment":            "VisualScriptNode",
+	"VisualScriptCondition":          "VisualScriptNode",
+	"VisualScriptConstant":           "VisualScriptNode",
+	"VisualScriptConstructor":        "VisualScriptNode",
+	"VisualScriptCustomNode":         "VisualScriptNode",
+	"VisualScriptDeconstruct":        "VisualScriptNode",
+	"VisualScriptEmitSignal":         "VisualScriptNode",
+	"VisualScriptEngineSingleton":    "VisualScriptNode",
+	"VisualScriptExpression":         "VisualScriptNode",
+	"VisualScriptFunction":           "VisualScriptNode",
+	"VisualScriptFunctionCall":       "VisualScriptNode",
+	"VisualScriptFunctionState":      "Reference",
+	"VisualScriptGlobalConstant":     "VisualScriptNode",
+	"VisualScriptIndexGet":           "VisualScriptNode",
+	"VisualScriptIndexSet":           "VisualScriptNode",
+	"VisualScriptInputAction":        "VisualScriptNode",
+	"VisualScriptIterator":           "VisualScriptNode",
+	"VisualScriptLocalVar":           "VisualScriptNode",
+	"VisualScriptLocalVarSet":        "VisualScriptNode",
+	"VisualScriptMathConstant":       "VisualScriptNode",
+	"VisualScriptNode":               "Resource",
+	"VisualScriptOperator":           "VisualScriptNode",
+	"VisualScriptPreload":            "VisualScriptNode",
+	"VisualScriptPropertyGet":        "VisualScriptNode",
+	"VisualScriptPropertySet":        "VisualScriptNode",
+	"VisualScriptResourcePath":       "VisualScriptNode",
+	"VisualScriptReturn":             "VisualScriptNode",
+	"VisualScriptSceneNode":          "VisualScriptNode",
+	"VisualScriptSceneTree":          "VisualScriptNode",
+	"VisualScriptSelect":             "VisualScriptNode",
+	"VisualScriptSelf":               "VisualScriptNode",
+	"VisualScriptSequence":           "VisualScriptNode",
+	"VisualScriptSubCall":            "VisualScriptNode",
+	"VisualScriptSwitch":             "VisualScriptNode",
+	"VisualScriptTypeCast":           "VisualScriptNode",
+	"VisualScriptVariableGet":        "VisualScriptNode",
+	"VisualScriptVariableSet":        "VisualScriptNode",
+	"VisualScriptWhile":              "VisualScriptNode",
+	"VisualScriptYield":              "VisualScriptNode",
+	"VisualScriptYieldSignal":        "VisualScriptNode",
+	"VisualServer":                   "Object",
+	"WeakRef":                        "Reference",
+	"WindowDialog":                   "Popup",
+	"World":                          "Resource",
+	"World2D":                        "Resource",
+	"WorldEnvironment":               "Node",
+	"XMLParser":                      "Reference",
+	"YSort":                          "Node2D",
+	"_ClassDB":                       "Object",
+	"_Directory":                     "Reference",
+	"_Engine":                        "Object",
+	"_File":                          "Reference",
+	"_Geometry":                      "Object",
+	"_JSON":                          "Object",
+	"_Marshalls":                     "Reference",
+	"_Mutex":                         "Reference",
+	"_OS":                            "Object",
+	"_ResourceLoader":                "Object",
+	"_ResourceSaver":                 "Object",
+	"_Semaphore":                     "Reference",
+	"_Thread":                        "Reference",
+	"_VisualScriptEditor":            "Object",
+}
 
-	switch className {
-	case "ARVRAnchor":
-		class := &ARVRAnchor{}
-		class.SetBaseObject(obj)
-		return class
-	case "ARVRCamera":
-		class := &ARVRCamera{}
-		class.SetBaseObject(obj)
-		return class
-	case "ARVRController":
-		class := &ARVRController{}
-		class.SetBaseObject(obj)
-		return class
-	case "ARVRInterface":
-		class := &ARVRInterface{}
-		class.SetBaseObject(obj)
-		return class
-	case "ARVRInterfaceGDNative":
-		class := &ARVRInterfaceGDNative{}
-		class.SetBaseObject(obj)
-		return class
-	case "ARVROrigin":
-		class := &ARVROrigin{}
-		class.SetBaseObject(obj)
-		return class
-	case "ARVRPositionalTracker":
-		class := &ARVRPositionalTracker{}
-		class.SetBaseObject(obj)
-		return class
-	case "AStar":
-		class := &AStar{}
-		class.SetBaseObject(obj)
-		return class
-	case "AcceptDialog":
-		class := &AcceptDialog{}
-		class.SetBaseObject(obj)
-		return class
-	case "AnimatedSprite":
-		class := &AnimatedSprite{}
-		class.SetBaseObject(obj)
-		return class
-	case "AnimatedSprite3D":
-		class := &AnimatedSprite3D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Animation":
-		class := &Animation{}
-		class.SetBaseObject(obj)
-		return class
-	case "AnimationPlayer":
-		class := &AnimationPlayer{}
-		class.SetBaseObject(obj)
-		return class
-	case "AnimationTreePlayer":
-		class := &AnimationTreePlayer{}
-		class.SetBaseObject(obj)
-		return class
-	case "Area":
-		class := &Area{}
-		class.SetBaseObject(obj)
-		return class
-	case "Area2D":
-		class := &Area2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "ArrayMesh":
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	RegisterObjectConstructor("ArrayMesh", func(obj gdnative.Object) ObjectImplementer {
 		class := &ArrayMesh{}
 		class.SetBaseObject(obj)
 		return class
-	case "AtlasTexture":
-		class := &AtlasTexture{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioBusLayout":
+	})
+	RegisterObjectConstructor("AudioBusLayout", func(obj gdnative.Object) ObjectImplementer {
 		class := &AudioBusLayout{}
 		class.SetBaseObject(obj)
 		return class
-	case "AudioEffect":
-		class := &AudioEffect{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectAmplify":
-		class := &AudioEffectAmplify{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectBandLimitFilter":
-		class := &AudioEffectBandLimitFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectBandPassFilter":
-		class := &AudioEffectBandPassFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectChorus":
-		class := &AudioEffectChorus{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectCompressor":
-		class := &AudioEffectCompressor{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectDelay":
-		class := &AudioEffectDelay{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectDistortion":
-		class := &AudioEffectDistortion{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectEQ":
-		class := &AudioEffectEQ{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectEQ10":
-		class := &AudioEffectEQ10{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectEQ21":
-		class := &AudioEffectEQ21{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectEQ6":
-		class := &AudioEffectEQ6{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectFilter":
-		class := &AudioEffectFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectHighPassFilter":
-		class := &AudioEffectHighPassFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectHighShelfFilter":
-		class := &AudioEffectHighShelfFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectLimiter":
-		class := &AudioEffectLimiter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectLowPassFilter":
-		class := &AudioEffectLowPassFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectLowShelfFilter":
-		class := &AudioEffectLowShelfFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectNotchFilter":
-		class := &AudioEffectNotchFilter{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectPanner":
-		class := &AudioEffectPanner{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectPhaser":
-		class := &AudioEffectPhaser{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectPitchShift":
-		class := &AudioEffectPitchShift{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectReverb":
-		class := &AudioEffectReverb{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioEffectStereoEnhance":
-		class := &AudioEffectStereoEnhance{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStream":
-		class := &AudioStream{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStreamOGGVorbis":
-		class := &AudioStreamOGGVorbis{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStreamPlayback":
-		class := &AudioStreamPlayback{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStreamPlayer":
-		class := &AudioStreamPlayer{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStreamPlayer2D":
-		class := &AudioStreamPlayer2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStreamPlayer3D":
-		class := &AudioStreamPlayer3D{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStreamRandomPitch":
-		class := &AudioStreamRandomPitch{}
-		class.SetBaseObject(obj)
-		return class
-	case "AudioStreamSample":
-		class := &AudioStreamSample{}
-		class.SetBaseObject(obj)
-		return class
-	case "BackBufferCopy":
-		class := &BackBufferCopy{}
-		class.SetBaseObject(obj)
-		return class
-	case "BakedLightmap":
-		class := &BakedLightmap{}
-		class.SetBaseObject(obj)
-		return class
-	case "BakedLightmapData":
+	})
+	RegisterObjectConstructor("BakedLightmapData", func(obj gdnative.Object) ObjectImplementer {
 		class := &BakedLightmapData{}
 		class.SetBaseObject(obj)
 		return class
-	case "BaseButton":
+	})
+	RegisterObjectConstructor("BaseButton", func(obj gdnative.Object) ObjectImplementer {
 		class := &BaseButton{}
 		class.SetBaseObject(obj)
 		return class
-	case "BitMap":
-		class := &BitMap{}
-		class.SetBaseObject(obj)
-		return class
-	case "BitmapFont":
-		class := &BitmapFont{}
-		class.SetBaseObject(obj)
-		return class
-	case "BoneAttachment":
-		class := &BoneAttachment{}
-		class.SetBaseObject(obj)
-		return class
-	case "BoxContainer":
-		class := &BoxContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "BoxShape":
-		class := &BoxShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "BulletPhysicsDirectBodyState":
-		class := &BulletPhysicsDirectBodyState{}
-		class.SetBaseObject(obj)
-		return class
-	case "BulletPhysicsServer":
-		class := &BulletPhysicsServer{}
-		class.SetBaseObject(obj)
-		return class
-	case "Button":
-		class := &Button{}
-		class.SetBaseObject(obj)
-		return class
-	case "ButtonGroup":
+	})
+	RegisterObjectConstructor("ButtonGroup", func(obj gdnative.Object) ObjectImplementer {
 		class := &ButtonGroup{}
 		class.SetBaseObject(obj)
 		return class
-	case "Camera":
+	})
+	RegisterObjectConstructor("Camera", func(obj gdnative.Object) ObjectImplementer {
 		class := &Camera{}
 		class.SetBaseObject(obj)
 		return class
-	case "Camera2D":
-		class := &Camera2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "CanvasItem":
+	})
+	RegisterObjectConstructor("CanvasItem", func(obj gdnative.Object) ObjectImplementer {
 		class := &CanvasItem{}
 		class.SetBaseObject(obj)
 		return class
-	case "CanvasItemMaterial":
-		class := &CanvasItemMaterial{}
-		class.SetBaseObject(obj)
-		return class
-	case "CanvasLayer":
-		class := &CanvasLayer{}
-		class.SetBaseObject(obj)
-		return class
-	case "CanvasModulate":
-		class := &CanvasModulate{}
-		class.SetBaseObject(obj)
-		return class
-	case "CapsuleMesh":
-		class := &CapsuleMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "CapsuleShape":
-		class := &CapsuleShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "CapsuleShape2D":
-		class := &CapsuleShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "CenterContainer":
-		class := &CenterContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "CheckBox":
-		class := &CheckBox{}
-		class.SetBaseObject(obj)
-		return class
-	case "CheckButton":
-		class := &CheckButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "CircleShape2D":
-		class := &CircleShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "CollisionObject":
+	})
+	RegisterObjectConstructor("CollisionObject", func(obj gdnative.Object) ObjectImplementer {
 		class := &CollisionObject{}
 		class.SetBaseObject(obj)
 		return class
-	case "CollisionObject2D":
+	})
+	RegisterObjectConstructor("CollisionObject2D", func(obj gdnative.Object) ObjectImplementer {
 		class := &CollisionObject2D{}
 		class.SetBaseObject(obj)
 		return class
-	case "CollisionPolygon":
-		class := &CollisionPolygon{}
-		class.SetBaseObject(obj)
-		return class
-	case "CollisionPolygon2D":
-		class := &CollisionPolygon2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "CollisionShape":
-		class := &CollisionShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "CollisionShape2D":
-		class := &CollisionShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "ColorPicker":
-		class := &ColorPicker{}
-		class.SetBaseObject(obj)
-		return class
-	case "ColorPickerButton":
-		class := &ColorPickerButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "ColorRect":
-		class := &ColorRect{}
-		class.SetBaseObject(obj)
-		return class
-	case "ConcavePolygonShape":
-		class := &ConcavePolygonShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "ConcavePolygonShape2D":
-		class := &ConcavePolygonShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "ConeTwistJoint":
-		class := &ConeTwistJoint{}
-		class.SetBaseObject(obj)
-		return class
-	case "ConfigFile":
+	})
+	RegisterObjectConstructor("ConfigFile", func(obj gdnative.Object) ObjectImplementer {
 		class := &ConfigFile{}
 		class.SetBaseObject(obj)
 		return class
-	case "ConfirmationDialog":
-		class := &ConfirmationDialog{}
-		class.SetBaseObject(obj)
-		return class
-	case "Container":
-		class := &Container{}
-		class.SetBaseObject(obj)
-		return class
-	case "Control":
+	})
+	RegisterObjectConstructor("Control", func(obj gdnative.Object) ObjectImplementer {
 		class := &Control{}
 		class.SetBaseObject(obj)
 		return class
-	case "ConvexPolygonShape":
-		class := &ConvexPolygonShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "ConvexPolygonShape2D":
-		class := &ConvexPolygonShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "CubeMap":
-		class := &CubeMap{}
-		class.SetBaseObject(obj)
-		return class
-	case "CubeMesh":
-		class := &CubeMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "Curve":
-		class := &Curve{}
-		class.SetBaseObject(obj)
-		return class
-	case "Curve2D":
-		class := &Curve2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Curve3D":
-		class := &Curve3D{}
-		class.SetBaseObject(obj)
-		return class
-	case "CurveTexture":
-		class := &CurveTexture{}
-		class.SetBaseObject(obj)
-		return class
-	case "CylinderMesh":
-		class := &CylinderMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "DampedSpringJoint2D":
-		class := &DampedSpringJoint2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "DirectionalLight":
-		class := &DirectionalLight{}
-		class.SetBaseObject(obj)
-		return class
-	case "DynamicFont":
-		class := &DynamicFont{}
-		class.SetBaseObject(obj)
-		return class
-	case "DynamicFontData":
-		class := &DynamicFontData{}
-		class.SetBaseObject(obj)
-		return class
-	case "EditorExportPlugin":
-		class := &EditorExportPlugin{}
-		class.SetBaseObject(obj)
-		return class
-	case "EditorFileDialog":
-		class := &EditorFileDialog{}
-		class.SetBaseObject(obj)
-		return class
-	case "EditorFileSystem":
-		class := &EditorFileSystem{}
-		class.SetBaseObject(obj)
-		return class
-	case "EditorFileSystemDirectory":
-		class := &EditorFileSystemDirectory{}
-		class.SetBaseObject(obj)
-		return class
-	case "EditorImportPlugin":
-		class := &EditorImportPlugin{}
+	})
+	RegisterObjectConstructor("Environment", func(obj gdnative.Object) ObjectImplementer {
+		class := &Environment{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorInterface":
-		class := &EditorInterface{}
+	})
+	RegisterObjectConstructor("Font", func(obj gdnative.Object) ObjectImplementer {
+		class := &Font{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorPlugin":
-		class := &EditorPlugin{}
+	})
+	RegisterObjectConstructor("GDNativeLibrary", func(obj gdnative.Object) ObjectImplementer {
+		class := &GDNativeLibrary{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorResourceConversionPlugin":
-		class := &EditorResourceConversionPlugin{}
+	})
+	RegisterObjectConstructor("GIProbeData", func(obj gdnative.Object) ObjectImplementer {
+		class := &GIProbeData{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorResourcePreview":
-		class := &EditorResourcePreview{}
+	})
+	RegisterObjectConstructor("Image", func(obj gdnative.Object) ObjectImplementer {
+		class := &Image{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorResourcePreviewGenerator":
-		class := &EditorResourcePreviewGenerator{}
+	})
+	RegisterObjectConstructor("InputEvent", func(obj gdnative.Object) ObjectImplementer {
+		class := &InputEvent{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorSceneImporter":
-		class := &EditorSceneImporter{}
+	})
+	RegisterObjectConstructor("InputEventKey", func(obj gdnative.Object) ObjectImplementer {
+		class := &InputEventKey{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorScenePostImport":
-		class := &EditorScenePostImport{}
+	})
+	RegisterObjectConstructor("InputEventWithModifiers", func(obj gdnative.Object) ObjectImplementer {
+		class := &InputEventWithModifiers{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorScript":
-		class := &EditorScript{}
+	})
+	RegisterObjectConstructor("KinematicCollision", func(obj gdnative.Object) ObjectImplementer {
+		class := &KinematicCollision{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorSelection":
-		class := &EditorSelection{}
+	})
+	RegisterObjectConstructor("MainLoop", func(obj gdnative.Object) ObjectImplementer {
+		class := &MainLoop{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorSettings":
-		class := &EditorSettings{}
+	})
+	RegisterObjectConstructor("Material", func(obj gdnative.Object) ObjectImplementer {
+		class := &Material{}
 		class.SetBaseObject(obj)
 		return class
-	case "EditorSpatialGizmo":
-		class := &EditorSpatialGizmo{}
+	})
+	RegisterObjectConstructor("Mesh", func(obj gdnative.Object) ObjectImplementer {
+		class := &Mesh{}
 		class.SetBaseObject(obj)
 		return class
-	case "EncodedObjectAsID":
-		class := &EncodedObjectAsID{}
+	})
+	RegisterObjectConstructor("MeshLibrary", func(obj gdnative.Object) ObjectImplementer {
+		class := &MeshLibrary{}
 		class.SetBaseObject(obj)
 		return class
-	case "Environment":
-		class := &Environment{}
+	})
+	RegisterObjectConstructor("NativeScript", func(obj gdnative.Object) ObjectImplementer {
+		class := &NativeScript{}
 		class.SetBaseObject(obj)
 		return class
-	case "FileDialog":
-		class := &FileDialog{}
+	})
+	RegisterObjectConstructor("NavigationMesh", func(obj gdnative.Object) ObjectImplementer {
+		class := &NavigationMesh{}
 		class.SetBaseObject(obj)
 		return class
-	case "Font":
-		class := &Font{}
+	})
+	RegisterObjectConstructor("NetworkedMultiplayerPeer", func(obj gdnative.Object) ObjectImplementer {
+		class := &NetworkedMultiplayerPeer{}
 		class.SetBaseObject(obj)
 		return class
-	case "FuncRef":
-		class := &FuncRef{}
+	})
+	RegisterObjectConstructor("Node", func(obj gdnative.Object) ObjectImplementer {
+		class := &Node{}
 		class.SetBaseObject(obj)
 		return class
-	case "GDNative":
-		class := &GDNative{}
+	})
+	RegisterObjectConstructor("Node2D", func(obj gdnative.Object) ObjectImplementer {
+		class := &Node2D{}
 		class.SetBaseObject(obj)
 		return class
-	case "GDNativeLibrary":
-		class := &GDNativeLibrary{}
+	})
+	RegisterObjectConstructor("Object", func(obj gdnative.Object) ObjectImplementer {
+		class := &Object{}
 		class.SetBaseObject(obj)
 		return class
-	case "GDScript":
-		class := &GDScript{}
+	})
+	RegisterObjectConstructor("PackedScene", func(obj gdnative.Object) ObjectImplementer {
+		class := &PackedScene{}
 		class.SetBaseObject(obj)
 		return class
-	case "GDScriptFunctionState":
-		class := &GDScriptFunctionState{}
+	})
+	RegisterObjectConstructor("PacketPeer", func(obj gdnative.Object) ObjectImplementer {
+		class := &PacketPeer{}
 		class.SetBaseObject(obj)
 		return class
-	case "GIProbe":
-		class := &GIProbe{}
+	})
+	RegisterObjectConstructor("Physics2DDirectSpaceState", func(obj gdnative.Object) ObjectImplementer {
+		class := &Physics2DDirectSpaceState{}
 		class.SetBaseObject(obj)
 		return class
-	case "GIProbeData":
-		class := &GIProbeData{}
+	})
+	RegisterObjectConstructor("Physics2DShapeQueryParameters", func(obj gdnative.Object) ObjectImplementer {
+		class := &Physics2DShapeQueryParameters{}
 		class.SetBaseObject(obj)
 		return class
-	case "Generic6DOFJoint":
-		class := &Generic6DOFJoint{}
+	})
+	RegisterObjectConstructor("PhysicsBody", func(obj gdnative.Object) ObjectImplementer {
+		class := &PhysicsBody{}
 		class.SetBaseObject(obj)
 		return class
-	case "GeometryInstance":
-		class := &GeometryInstance{}
+	})
+	RegisterObjectConstructor("PhysicsBody2D", func(obj gdnative.Object) ObjectImplementer {
+		class := &PhysicsBody2D{}
 		class.SetBaseObject(obj)
 		return class
-	case "Gradient":
-		class := &Gradient{}
+	})
+	RegisterObjectConstructor("PhysicsDirectSpaceState", func(obj gdnative.Object) ObjectImplementer {
+		class := &PhysicsDirectSpaceState{}
 		class.SetBaseObject(obj)
 		return class
-	case "GradientTexture":
-		class := &GradientTexture{}
+	})
+	RegisterObjectConstructor("PhysicsShapeQueryParameters", func(obj gdnative.Object) ObjectImplementer {
+		class := &PhysicsShapeQueryParameters{}
 		class.SetBaseObject(obj)
 		return class
-	case "GraphEdit":
-		class := &GraphEdit{}
+	})
+	RegisterObjectConstructor("Range", func(obj gdnative.Object) ObjectImplementer {
+		class := &Range{}
 		class.SetBaseObject(obj)
 		return class
-	case "GraphNode":
-		class := &GraphNode{}
+	})
+	RegisterObjectConstructor("Reference", func(obj gdnative.Object) ObjectImplementer {
+		class := &Reference{}
 		class.SetBaseObject(obj)
 		return class
-	case "GridContainer":
-		class := &GridContainer{}
+	})
+	RegisterObjectConstructor("Resource", func(obj gdnative.Object) ObjectImplementer {
+		class := &Resource{}
 		class.SetBaseObject(obj)
 		return class
-	case "GridMap":
-		class := &GridMap{}
+	})
+	RegisterObjectConstructor("SceneState", func(obj gdnative.Object) ObjectImplementer {
+		class := &SceneState{}
 		class.SetBaseObject(obj)
 		return class
-	case "GrooveJoint2D":
-		class := &GrooveJoint2D{}
+	})
+	RegisterObjectConstructor("SceneTree", func(obj gdnative.Object) ObjectImplementer {
+		class := &SceneTree{}
 		class.SetBaseObject(obj)
 		return class
-	case "HBoxContainer":
-		class := &HBoxContainer{}
+	})
+	RegisterObjectConstructor("SceneTreeTimer", func(obj gdnative.Object) ObjectImplementer {
+		class := &SceneTreeTimer{}
 		class.SetBaseObject(obj)
 		return class
-	case "HScrollBar":
-		class := &HScrollBar{}
+	})
+	RegisterObjectConstructor("Script", func(obj gdnative.Object) ObjectImplementer {
+		class := &Script{}
 		class.SetBaseObject(obj)
 		return class
-	case "HSeparator":
-		class := &HSeparator{}
+	})
+	RegisterObjectConstructor("Shader", func(obj gdnative.Object) ObjectImplementer {
+		class := &Shader{}
 		class.SetBaseObject(obj)
 		return class
-	case "HSlider":
-		class := &HSlider{}
+	})
+	RegisterObjectConstructor("Shape", func(obj gdnative.Object) ObjectImplementer {
+		class := &Shape{}
 		class.SetBaseObject(obj)
 		return class
-	case "HSplitContainer":
-		class := &HSplitContainer{}
+	})
+	RegisterObjectConstructor("Shape2D", func(obj gdnative.Object) ObjectImplementer {
+		class := &Shape2D{}
 		class.SetBaseObject(obj)
 		return class
-	case "HTTPClient":
-		class := &HTTPClient{}
+	})
+	RegisterObjectConstructor("ShortCut", func(obj gdnative.Object) ObjectImplementer {
+		class := &ShortCut{}
 		class.SetBaseObject(obj)
 		return class
-	case "HTTPRequest":
-		class := &HTTPRequest{}
+	})
+	RegisterObjectConstructor("Sky", func(obj gdnative.Object) ObjectImplementer {
+		class := &Sky{}
 		class.SetBaseObject(obj)
 		return class
-	case "HingeJoint":
-		class := &HingeJoint{}
+	})
+	RegisterObjectConstructor("Slider", func(obj gdnative.Object) ObjectImplementer {
+		class := &Slider{}
 		class.SetBaseObject(obj)
 		return class
-	case "IP_Unix":
-		class := &IP_Unix{}
+	})
+	RegisterObjectConstructor("Spatial", func(obj gdnative.Object) ObjectImplementer {
+		class := &Spatial{}
 		class.SetBaseObject(obj)
 		return class
-	case "Image":
-		class := &Image{}
+	})
+	RegisterObjectConstructor("SpatialGizmo", func(obj gdnative.Object) ObjectImplementer {
+		class := &SpatialGizmo{}
 		class.SetBaseObject(obj)
 		return class
-	case "ImageTexture":
-		class := &ImageTexture{}
+	})
+	RegisterObjectConstructor("SpriteFrames", func(obj gdnative.Object) ObjectImplementer {
+		class := &SpriteFrames{}
 		class.SetBaseObject(obj)
 		return class
-	case "ImmediateGeometry":
-		class := &ImmediateGeometry{}
+	})
+	RegisterObjectConstructor("StyleBox", func(obj gdnative.Object) ObjectImplementer {
+		class := &StyleBox{}
 		class.SetBaseObject(obj)
 		return class
-	case "InputDefault":
-		class := &InputDefault{}
+	})
+	RegisterObjectConstructor("Texture", func(obj gdnative.Object) ObjectImplementer {
+		class := &Texture{}
 		class.SetBaseObject(obj)
 		return class
-	case "InputEvent":
-		class := &InputEvent{}
+	})
+	RegisterObjectConstructor("Theme", func(obj gdnative.Object) ObjectImplementer {
+		class := &Theme{}
 		class.SetBaseObject(obj)
 		return class
-	case "InputEventAction":
-		class := &InputEventAction{}
+	})
+	RegisterObjectConstructor("TriangleMesh", func(obj gdnative.Object) ObjectImplementer {
+		class := &TriangleMesh{}
 		class.SetBaseObject(obj)
 		return class
-	case "InputEventGesture":
-		class := &InputEventGesture{}
+	})
+	RegisterObjectConstructor("Viewport", func(obj gdnative.Object) ObjectImplementer {
+		class := &Viewport{}
 		class.SetBaseObject(obj)
 		return class
-	case "InputEventJoypadButton":
-		class := &InputEventJoypadButton{}
+	})
+	RegisterObjectConstructor("ViewportTexture", func(obj gdnative.Object) ObjectImplementer {
+		class := &ViewportTexture{}
 		class.SetBaseObject(obj)
 		return class
-	case "InputEventJoypadMotion":
-		class := &InputEventJoypadMotion{}
+	})
+	RegisterObjectConstructor("World", func(obj gdnative.Object) ObjectImplementer {
+		class := &World{}
 		class.SetBaseObject(obj)
 		return class
-	case "InputEventKey":
-		class := &InputEventKey{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventMagnifyGesture":
-		class := &InputEventMagnifyGesture{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventMouse":
-		class := &InputEventMouse{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventMouseButton":
-		class := &InputEventMouseButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventMouseMotion":
-		class := &InputEventMouseMotion{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventPanGesture":
-		class := &InputEventPanGesture{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventScreenDrag":
-		class := &InputEventScreenDrag{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventScreenTouch":
-		class := &InputEventScreenTouch{}
-		class.SetBaseObject(obj)
-		return class
-	case "InputEventWithModifiers":
-		class := &InputEventWithModifiers{}
-		class.SetBaseObject(obj)
-		return class
-	case "InstancePlaceholder":
-		class := &InstancePlaceholder{}
-		class.SetBaseObject(obj)
-		return class
-	case "InterpolatedCamera":
-		class := &InterpolatedCamera{}
-		class.SetBaseObject(obj)
-		return class
-	case "ItemList":
-		class := &ItemList{}
-		class.SetBaseObject(obj)
-		return class
-	case "JSONParseResult":
-		class := &JSONParseResult{}
-		class.SetBaseObject(obj)
-		return class
-	case "Joint":
-		class := &Joint{}
-		class.SetBaseObject(obj)
-		return class
-	case "Joint2D":
-		class := &Joint2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "KinematicBody":
-		class := &KinematicBody{}
-		class.SetBaseObject(obj)
-		return class
-	case "KinematicBody2D":
-		class := &KinematicBody2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "KinematicCollision":
-		class := &KinematicCollision{}
-		class.SetBaseObject(obj)
-		return class
-	case "KinematicCollision2D":
-		class := &KinematicCollision2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Label":
-		class := &Label{}
-		class.SetBaseObject(obj)
-		return class
-	case "LargeTexture":
-		class := &LargeTexture{}
-		class.SetBaseObject(obj)
-		return class
-	case "Light":
-		class := &Light{}
-		class.SetBaseObject(obj)
-		return class
-	case "Light2D":
-		class := &Light2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "LightOccluder2D":
-		class := &LightOccluder2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Line2D":
-		class := &Line2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "LineEdit":
-		class := &LineEdit{}
-		class.SetBaseObject(obj)
-		return class
-	case "LineShape2D":
-		class := &LineShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "LinkButton":
-		class := &LinkButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "Listener":
-		class := &Listener{}
-		class.SetBaseObject(obj)
-		return class
-	case "MainLoop":
-		class := &MainLoop{}
-		class.SetBaseObject(obj)
-		return class
-	case "MarginContainer":
-		class := &MarginContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "Material":
-		class := &Material{}
-		class.SetBaseObject(obj)
-		return class
-	case "MenuButton":
-		class := &MenuButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "Mesh":
-		class := &Mesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "MeshDataTool":
-		class := &MeshDataTool{}
-		class.SetBaseObject(obj)
-		return class
-	case "MeshInstance":
-		class := &MeshInstance{}
-		class.SetBaseObject(obj)
-		return class
-	case "MeshLibrary":
-		class := &MeshLibrary{}
-		class.SetBaseObject(obj)
-		return class
-	case "MobileVRInterface":
-		class := &MobileVRInterface{}
-		class.SetBaseObject(obj)
-		return class
-	case "MultiMesh":
-		class := &MultiMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "MultiMeshInstance":
-		class := &MultiMeshInstance{}
-		class.SetBaseObject(obj)
-		return class
-	case "NativeScript":
-		class := &NativeScript{}
-		class.SetBaseObject(obj)
-		return class
-	case "Navigation":
-		class := &Navigation{}
-		class.SetBaseObject(obj)
-		return class
-	case "Navigation2D":
-		class := &Navigation2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "NavigationMesh":
-		class := &NavigationMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "NavigationMeshInstance":
-		class := &NavigationMeshInstance{}
-		class.SetBaseObject(obj)
-		return class
-	case "NavigationPolygon":
-		class := &NavigationPolygon{}
-		class.SetBaseObject(obj)
-		return class
-	case "NavigationPolygonInstance":
-		class := &NavigationPolygonInstance{}
-		class.SetBaseObject(obj)
-		return class
-	case "NetworkedMultiplayerENet":
-		class := &NetworkedMultiplayerENet{}
-		class.SetBaseObject(obj)
-		return class
-	case "NetworkedMultiplayerPeer":
-		class := &NetworkedMultiplayerPeer{}
-		class.SetBaseObject(obj)
-		return class
-	case "NinePatchRect":
-		class := &NinePatchRect{}
-		class.SetBaseObject(obj)
-		return class
-	case "Node":
-		class := &Node{}
-		class.SetBaseObject(obj)
-		return class
-	case "Node2D":
-		class := &Node2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Object":
-		class := &Object{}
-		class.SetBaseObject(obj)
-		return class
-	case "OccluderPolygon2D":
-		class := &OccluderPolygon2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "OmniLight":
-		class := &OmniLight{}
-		class.SetBaseObject(obj)
-		return class
-	case "OptionButton":
-		class := &OptionButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "PCKPacker":
-		class := &PCKPacker{}
-		class.SetBaseObject(obj)
-		return class
-	case "PHashTranslation":
-		class := &PHashTranslation{}
-		class.SetBaseObject(obj)
-		return class
-	case "PackedDataContainer":
-		class := &PackedDataContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "PackedDataContainerRef":
-		class := &PackedDataContainerRef{}
-		class.SetBaseObject(obj)
-		return class
-	case "PackedScene":
-		class := &PackedScene{}
-		class.SetBaseObject(obj)
-		return class
-	case "PacketPeer":
-		class := &PacketPeer{}
-		class.SetBaseObject(obj)
-		return class
-	case "PacketPeerStream":
-		class := &PacketPeerStream{}
-		class.SetBaseObject(obj)
-		return class
-	case "PacketPeerUDP":
-		class := &PacketPeerUDP{}
-		class.SetBaseObject(obj)
-		return class
-	case "Panel":
-		class := &Panel{}
-		class.SetBaseObject(obj)
-		return class
-	case "PanelContainer":
-		class := &PanelContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "PanoramaSky":
-		class := &PanoramaSky{}
-		class.SetBaseObject(obj)
-		return class
-	case "ParallaxBackground":
-		class := &ParallaxBackground{}
-		class.SetBaseObject(obj)
-		return class
-	case "ParallaxLayer":
-		class := &ParallaxLayer{}
-		class.SetBaseObject(obj)
-		return class
-	case "Particles":
-		class := &Particles{}
-		class.SetBaseObject(obj)
-		return class
-	case "Particles2D":
-		class := &Particles2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "ParticlesMaterial":
-		class := &ParticlesMaterial{}
-		class.SetBaseObject(obj)
-		return class
-	case "Path":
-		class := &Path{}
-		class.SetBaseObject(obj)
-		return class
-	case "Path2D":
-		class := &Path2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "PathFollow":
-		class := &PathFollow{}
-		class.SetBaseObject(obj)
-		return class
-	case "PathFollow2D":
-		class := &PathFollow2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Physics2DDirectBodyState":
-		class := &Physics2DDirectBodyState{}
-		class.SetBaseObject(obj)
-		return class
-	case "Physics2DDirectBodyStateSW":
-		class := &Physics2DDirectBodyStateSW{}
-		class.SetBaseObject(obj)
-		return class
-	case "Physics2DDirectSpaceState":
-		class := &Physics2DDirectSpaceState{}
-		class.SetBaseObject(obj)
-		return class
-	case "Physics2DServerSW":
-		class := &Physics2DServerSW{}
-		class.SetBaseObject(obj)
-		return class
-	case "Physics2DShapeQueryParameters":
-		class := &Physics2DShapeQueryParameters{}
-		class.SetBaseObject(obj)
-		return class
-	case "Physics2DShapeQueryResult":
-		class := &Physics2DShapeQueryResult{}
-		class.SetBaseObject(obj)
-		return class
-	case "Physics2DTestMotionResult":
-		class := &Physics2DTestMotionResult{}
-		class.SetBaseObject(obj)
-		return class
-	case "PhysicsBody":
-		class := &PhysicsBody{}
-		class.SetBaseObject(obj)
-		return class
-	case "PhysicsBody2D":
-		class := &PhysicsBody2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "PhysicsDirectBodyState":
-		class := &PhysicsDirectBodyState{}
-		class.SetBaseObject(obj)
-		return class
-	case "PhysicsDirectSpaceState":
-		class := &PhysicsDirectSpaceState{}
-		class.SetBaseObject(obj)
-		return class
-	case "PhysicsShapeQueryParameters":
-		class := &PhysicsShapeQueryParameters{}
-		class.SetBaseObject(obj)
-		return class
-	case "PhysicsShapeQueryResult":
-		class := &PhysicsShapeQueryResult{}
-		class.SetBaseObject(obj)
-		return class
-	case "PinJoint":
-		class := &PinJoint{}
-		class.SetBaseObject(obj)
-		return class
-	case "PinJoint2D":
-		class := &PinJoint2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "PlaneMesh":
-		class := &PlaneMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "PlaneShape":
-		class := &PlaneShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "PluginScript":
-		class := &PluginScript{}
-		class.SetBaseObject(obj)
-		return class
-	case "Polygon2D":
-		class := &Polygon2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "PolygonPathFinder":
-		class := &PolygonPathFinder{}
-		class.SetBaseObject(obj)
-		return class
-	case "Popup":
-		class := &Popup{}
-		class.SetBaseObject(obj)
-		return class
-	case "PopupDialog":
-		class := &PopupDialog{}
-		class.SetBaseObject(obj)
-		return class
-	case "PopupMenu":
-		class := &PopupMenu{}
-		class.SetBaseObject(obj)
-		return class
-	case "PopupPanel":
-		class := &PopupPanel{}
-		class.SetBaseObject(obj)
-		return class
-	case "Position2D":
-		class := &Position2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Position3D":
-		class := &Position3D{}
-		class.SetBaseObject(obj)
-		return class
-	case "PrimitiveMesh":
-		class := &PrimitiveMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "PrismMesh":
-		class := &PrismMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "ProceduralSky":
-		class := &ProceduralSky{}
-		class.SetBaseObject(obj)
-		return class
-	case "ProgressBar":
-		class := &ProgressBar{}
-		class.SetBaseObject(obj)
-		return class
-	case "ProximityGroup":
-		class := &ProximityGroup{}
-		class.SetBaseObject(obj)
-		return class
-	case "ProxyTexture":
-		class := &ProxyTexture{}
-		class.SetBaseObject(obj)
-		return class
-	case "QuadMesh":
-		class := &QuadMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "Range":
-		class := &Range{}
-		class.SetBaseObject(obj)
-		return class
-	case "RayCast":
-		class := &RayCast{}
-		class.SetBaseObject(obj)
-		return class
-	case "RayCast2D":
-		class := &RayCast2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "RayShape":
-		class := &RayShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "RayShape2D":
-		class := &RayShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "RectangleShape2D":
-		class := &RectangleShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Reference":
-		class := &Reference{}
-		class.SetBaseObject(obj)
-		return class
-	case "ReferenceRect":
-		class := &ReferenceRect{}
-		class.SetBaseObject(obj)
-		return class
-	case "ReflectionProbe":
-		class := &ReflectionProbe{}
-		class.SetBaseObject(obj)
-		return class
-	case "RegEx":
-		class := &RegEx{}
-		class.SetBaseObject(obj)
-		return class
-	case "RegExMatch":
-		class := &RegExMatch{}
-		class.SetBaseObject(obj)
-		return class
-	case "RemoteTransform":
-		class := &RemoteTransform{}
-		class.SetBaseObject(obj)
-		return class
-	case "RemoteTransform2D":
-		class := &RemoteTransform2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Resource":
-		class := &Resource{}
-		class.SetBaseObject(obj)
-		return class
-	case "ResourceImporter":
-		class := &ResourceImporter{}
-		class.SetBaseObject(obj)
-		return class
-	case "ResourceImporterOGGVorbis":
-		class := &ResourceImporterOGGVorbis{}
-		class.SetBaseObject(obj)
-		return class
-	case "ResourceImporterTheora":
-		class := &ResourceImporterTheora{}
-		class.SetBaseObject(obj)
-		return class
-	case "ResourceImporterWebm":
-		class := &ResourceImporterWebm{}
-		class.SetBaseObject(obj)
-		return class
-	case "ResourceInteractiveLoader":
-		class := &ResourceInteractiveLoader{}
-		class.SetBaseObject(obj)
-		return class
-	case "ResourcePreloader":
-		class := &ResourcePreloader{}
-		class.SetBaseObject(obj)
-		return class
-	case "RichTextLabel":
-		class := &RichTextLabel{}
-		class.SetBaseObject(obj)
-		return class
-	case "RigidBody":
-		class := &RigidBody{}
-		class.SetBaseObject(obj)
-		return class
-	case "RigidBody2D":
-		class := &RigidBody2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "SceneState":
-		class := &SceneState{}
-		class.SetBaseObject(obj)
-		return class
-	case "SceneTree":
-		class := &SceneTree{}
-		class.SetBaseObject(obj)
-		return class
-	case "SceneTreeTimer":
-		class := &SceneTreeTimer{}
-		class.SetBaseObject(obj)
-		return class
-	case "Script":
-		class := &Script{}
-		class.SetBaseObject(obj)
-		return class
-	case "ScriptEditor":
-		class := &ScriptEditor{}
-		class.SetBaseObject(obj)
-		return class
-	case "ScrollBar":
-		class := &ScrollBar{}
-		class.SetBaseObject(obj)
-		return class
-	case "ScrollContainer":
-		class := &ScrollContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "SegmentShape2D":
-		class := &SegmentShape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "Separator":
-		class := &Separator{}
-		class.SetBaseObject(obj)
-		return class
-	case "Shader":
-		class := &Shader{}
-		class.SetBaseObject(obj)
-		return class
-	case "ShaderMaterial":
-		class := &ShaderMaterial{}
-		class.SetBaseObject(obj)
-		return class
-	case "Shape":
-		class := &Shape{}
-		class.SetBaseObject(obj)
-		return class
-	case "Shape2D":
-		class := &Shape2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "ShortCut":
-		class := &ShortCut{}
-		class.SetBaseObject(obj)
-		return class
-	case "Skeleton":
-		class := &Skeleton{}
-		class.SetBaseObject(obj)
-		return class
-	case "Sky":
-		class := &Sky{}
-		class.SetBaseObject(obj)
-		return class
-	case "Slider":
-		class := &Slider{}
-		class.SetBaseObject(obj)
-		return class
-	case "SliderJoint":
-		class := &SliderJoint{}
-		class.SetBaseObject(obj)
-		return class
-	case "Spatial":
-		class := &Spatial{}
-		class.SetBaseObject(obj)
-		return class
-	case "SpatialGizmo":
-		class := &SpatialGizmo{}
-		class.SetBaseObject(obj)
-		return class
-	case "SpatialMaterial":
-		class := &SpatialMaterial{}
-		class.SetBaseObject(obj)
-		return class
-	case "SpatialVelocityTracker":
-		class := &SpatialVelocityTracker{}
-		class.SetBaseObject(obj)
-		return class
-	case "SphereMesh":
-		class := &SphereMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "SphereShape":
-		class := &SphereShape{}
-		class.SetBaseObject(obj)
-		return class
-	case "SpinBox":
-		class := &SpinBox{}
-		class.SetBaseObject(obj)
-		return class
-	case "SplitContainer":
-		class := &SplitContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "SpotLight":
-		class := &SpotLight{}
-		class.SetBaseObject(obj)
-		return class
-	case "Sprite":
-		class := &Sprite{}
-		class.SetBaseObject(obj)
-		return class
-	case "Sprite3D":
-		class := &Sprite3D{}
-		class.SetBaseObject(obj)
-		return class
-	case "SpriteBase3D":
-		class := &SpriteBase3D{}
-		class.SetBaseObject(obj)
-		return class
-	case "SpriteFrames":
-		class := &SpriteFrames{}
-		class.SetBaseObject(obj)
-		return class
-	case "StaticBody":
-		class := &StaticBody{}
-		class.SetBaseObject(obj)
-		return class
-	case "StaticBody2D":
-		class := &StaticBody2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "StreamPeer":
-		class := &StreamPeer{}
-		class.SetBaseObject(obj)
-		return class
-	case "StreamPeerBuffer":
-		class := &StreamPeerBuffer{}
-		class.SetBaseObject(obj)
-		return class
-	case "StreamPeerSSL":
-		class := &StreamPeerSSL{}
-		class.SetBaseObject(obj)
-		return class
-	case "StreamPeerTCP":
-		class := &StreamPeerTCP{}
-		class.SetBaseObject(obj)
-		return class
-	case "StreamTexture":
-		class := &StreamTexture{}
-		class.SetBaseObject(obj)
-		return class
-	case "StyleBox":
-		class := &StyleBox{}
-		class.SetBaseObject(obj)
-		return class
-	case "StyleBoxEmpty":
-		class := &StyleBoxEmpty{}
-		class.SetBaseObject(obj)
-		return class
-	case "StyleBoxFlat":
-		class := &StyleBoxFlat{}
-		class.SetBaseObject(obj)
-		return class
-	case "StyleBoxLine":
-		class := &StyleBoxLine{}
-		class.SetBaseObject(obj)
-		return class
-	case "StyleBoxTexture":
-		class := &StyleBoxTexture{}
-		class.SetBaseObject(obj)
-		return class
-	case "SurfaceTool":
-		class := &SurfaceTool{}
-		class.SetBaseObject(obj)
-		return class
-	case "TCP_Server":
-		class := &TCP_Server{}
-		class.SetBaseObject(obj)
-		return class
-	case "TabContainer":
-		class := &TabContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "Tabs":
-		class := &Tabs{}
-		class.SetBaseObject(obj)
-		return class
-	case "TextEdit":
-		class := &TextEdit{}
-		class.SetBaseObject(obj)
-		return class
-	case "Texture":
-		class := &Texture{}
-		class.SetBaseObject(obj)
-		return class
-	case "TextureButton":
-		class := &TextureButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "TextureProgress":
-		class := &TextureProgress{}
-		class.SetBaseObject(obj)
-		return class
-	case "TextureRect":
-		class := &TextureRect{}
-		class.SetBaseObject(obj)
-		return class
-	case "Theme":
-		class := &Theme{}
-		class.SetBaseObject(obj)
-		return class
-	case "TileMap":
-		class := &TileMap{}
-		class.SetBaseObject(obj)
-		return class
-	case "TileSet":
-		class := &TileSet{}
-		class.SetBaseObject(obj)
-		return class
-	case "Timer":
-		class := &Timer{}
-		class.SetBaseObject(obj)
-		return class
-	case "ToolButton":
-		class := &ToolButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "TouchScreenButton":
-		class := &TouchScreenButton{}
-		class.SetBaseObject(obj)
-		return class
-	case "Translation":
-		class := &Translation{}
-		class.SetBaseObject(obj)
-		return class
-	case "Tree":
-		class := &Tree{}
-		class.SetBaseObject(obj)
-		return class
-	case "TreeItem":
-		class := &TreeItem{}
-		class.SetBaseObject(obj)
-		return class
-	case "TriangleMesh":
-		class := &TriangleMesh{}
-		class.SetBaseObject(obj)
-		return class
-	case "Tween":
-		class := &Tween{}
-		class.SetBaseObject(obj)
-		return class
-	case "UndoRedo":
-		class := &UndoRedo{}
-		class.SetBaseObject(obj)
-		return class
-	case "VBoxContainer":
-		class := &VBoxContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "VScrollBar":
-		class := &VScrollBar{}
-		class.SetBaseObject(obj)
-		return class
-	case "VSeparator":
-		class := &VSeparator{}
-		class.SetBaseObject(obj)
-		return class
-	case "VSlider":
-		class := &VSlider{}
-		class.SetBaseObject(obj)
-		return class
-	case "VSplitContainer":
-		class := &VSplitContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "VehicleBody":
-		class := &VehicleBody{}
-		class.SetBaseObject(obj)
-		return class
-	case "VehicleWheel":
-		class := &VehicleWheel{}
-		class.SetBaseObject(obj)
-		return class
-	case "VideoPlayer":
-		class := &VideoPlayer{}
-		class.SetBaseObject(obj)
-		return class
-	case "VideoStream":
-		class := &VideoStream{}
-		class.SetBaseObject(obj)
-		return class
-	case "VideoStreamTheora":
-		class := &VideoStreamTheora{}
-		class.SetBaseObject(obj)
-		return class
-	case "VideoStreamWebm":
-		class := &VideoStreamWebm{}
-		class.SetBaseObject(obj)
-		return class
-	case "Viewport":
-		class := &Viewport{}
-		class.SetBaseObject(obj)
-		return class
-	case "ViewportContainer":
-		class := &ViewportContainer{}
-		class.SetBaseObject(obj)
-		return class
-	case "ViewportTexture":
-		class := &ViewportTexture{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisibilityEnabler":
-		class := &VisibilityEnabler{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisibilityEnabler2D":
-		class := &VisibilityEnabler2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisibilityNotifier":
-		class := &VisibilityNotifier{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisibilityNotifier2D":
-		class := &VisibilityNotifier2D{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualInstance":
-		class := &VisualInstance{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScript":
-		class := &VisualScript{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptBasicTypeConstant":
-		class := &VisualScriptBasicTypeConstant{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptBuiltinFunc":
-		class := &VisualScriptBuiltinFunc{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptClassConstant":
-		class := &VisualScriptClassConstant{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptComment":
-		class := &VisualScriptComment{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptCondition":
-		class := &VisualScriptCondition{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptConstant":
-		class := &VisualScriptConstant{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptConstructor":
-		class := &VisualScriptConstructor{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptCustomNode":
-		class := &VisualScriptCustomNode{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptDeconstruct":
-		class := &VisualScriptDeconstruct{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptEmitSignal":
-		class := &VisualScriptEmitSignal{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptEngineSingleton":
-		class := &VisualScriptEngineSingleton{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptExpression":
-		class := &VisualScriptExpression{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptFunction":
-		class := &VisualScriptFunction{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptFunctionCall":
-		class := &VisualScriptFunctionCall{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptFunctionState":
-		class := &VisualScriptFunctionState{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptGlobalConstant":
-		class := &VisualScriptGlobalConstant{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptIndexGet":
-		class := &VisualScriptIndexGet{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptIndexSet":
-		class := &VisualScriptIndexSet{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptInputAction":
-		class := &VisualScriptInputAction{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptIterator":
-		class := &VisualScriptIterator{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptLocalVar":
-		class := &VisualScriptLocalVar{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptLocalVarSet":
-		class := &VisualScriptLocalVarSet{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptMathConstant":
-		class := &VisualScriptMathConstant{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptNode":
-		class := &VisualScriptNode{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptOperator":
-		class := &VisualScriptOperator{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptPreload":
-		class := &VisualScriptPreload{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptPropertyGet":
-		class := &VisualScriptPropertyGet{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptPropertySet":
-		class := &VisualScriptPropertySet{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptResourcePath":
-		class := &VisualScriptResourcePath{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptReturn":
-		class := &VisualScriptReturn{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptSceneNode":
-		class := &VisualScriptSceneNode{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptSceneTree":
-		class := &VisualScriptSceneTree{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptSelect":
-		class := &VisualScriptSelect{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptSelf":
-		class := &VisualScriptSelf{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptSequence":
-		class := &VisualScriptSequence{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptSubCall":
-		class := &VisualScriptSubCall{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptSwitch":
-		class := &VisualScriptSwitch{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptTypeCast":
-		class := &VisualScriptTypeCast{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptVariableGet":
-		class := &VisualScriptVariableGet{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptVariableSet":
-		class := &VisualScriptVariableSet{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptWhile":
-		class := &VisualScriptWhile{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptYield":
-		class := &VisualScriptYield{}
-		class.SetBaseObject(obj)
-		return class
-	case "VisualScriptYieldSignal":
-		class := &VisualScriptYieldSignal{}
-		class.SetBaseObject(obj)
-		return class
-	case "WeakRef":
-		class := &WeakRef{}
-		class.SetBaseObject(obj)
-		return class
-	case "WindowDialog":
-		class := &WindowDialog{}
-		class.SetBaseObject(obj)
-		return class
-	case "World":
-		class := &World{}
-		class.SetBaseObject(obj)
-		return class
-	case "World2D":
+	})
+	RegisterObjectConstructor("World2D", func(obj gdnative.Object) ObjectImplementer {
 		class := &World2D{}
 		class.SetBaseObject(obj)
 		return class
-	case "WorldEnvironment":
-		class := &WorldEnvironment{}
-		class.SetBaseObject(obj)
-		return class
-	case "XMLParser":
-		class := &XMLParser{}
-		class.SetBaseObject(obj)
-		return class
-	case "YSort":
-		class := &YSort{}
-		class.SetBaseObject(obj)
-		return class
-	case "_Directory":
-		class := &Directory{}
-		class.SetBaseObject(obj)
-		return class
-	case "_File":
-		class := &File{}
-		class.SetBaseObject(obj)
-		return class
-	case "_Mutex":
-		class := &Mutex{}
-		class.SetBaseObject(obj)
-		return class
-	case "_Semaphore":
-		class := &Semaphore{}
-		class.SetBaseObject(obj)
-		return class
-	case "_Thread":
-		class := &Thread{}
-		class.SetBaseObject(obj)
-		return class
-	}
-	log.Println("Could not find conversion for '" + className + "'. Defaulting to Object...")
-	return &Object{owner: obj}
+	})
 }
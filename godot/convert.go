@@ -0,0 +1,63 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"log"
+)
+
+// ObjectConstructor is a function that will build the Go class of a Godot
+// object with the given base object.
+type ObjectConstructor func(obj gdnative.Object) ObjectImplementer
+
+// objectConstructors is a mapping of Godot class names to the constructors of
+// their Go classes.
+var objectConstructors = map[string]ObjectConstructor{}
+
+// RegisterObjectConstructor will register the constructor of the Go class for
+// the given Godot class name. The generated packages register all of their
+// classes when they are imported, so objects can be converted into their
+// concrete class type by GetActualClass.
+func RegisterObjectConstructor(className string, constructor ObjectConstructor) {
+	objectConstructors[className] = constructor
+}
+
+// GetActualClass will return the concrete class type of the godot object based on
+// the given class name. If the package of that class has not been imported, the
+// closest base class that has been registered will be used instead. If the engine
+// supports instance binding data, the same wrapper will be returned for the object
+// until Godot frees it.
+func GetActualClass(className gdnative.String, obj gdnative.Object) ObjectImplementer {
+	// Check to see if we already have an instance of this object in our Go instance registry.
+	if debug {
+		log.Println("Checking to see if", obj.ID(), "is in registry:", InstanceRegistry)
+	}
+	if instance, _, ok := instanceOf(obj); ok {
+		if implementer, ok := instance.(ObjectImplementer); ok {
+			if debug {
+				log.Println("Class instance already found in registry!")
+			}
+			return implementer
+		}
+	}
+
+	// Return the same Go wrapper every time the object is returned from Godot.
+	return boundWrapper(obj, func() ObjectImplementer {
+		for class := string(className); class != ""; class = baseClasses[class] {
+			if constructor, ok := objectConstructors[class]; ok {
+				return constructor(obj)
+			}
+		}
+		log.Println("Could not find conversion for '" + className + "'. Defaulting to Object...")
+		return &Object{owner: obj}
+	})
+}
+
+// GetBaseObject will return the Godot object of the given class, or a null
+// object if the class is nil. This allows nil to be passed to methods with
+// optional object arguments.
+func GetBaseObject(class Class) gdnative.Object {
+	if class == nil {
+		return gdnative.Object{}
+	}
+	return class.GetBaseObject()
+}
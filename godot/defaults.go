@@ -0,0 +1,136 @@
+package godot
+
+import (
+	"fmt"
+	"github.com/shadowapex/godot-go/gdnative"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	rect2Type    = reflect.TypeOf(gdnative.Rect2{})
+	nodePathType = reflect.TypeOf(gdnative.NodePath{})
+)
+
+// propertyDefault will return the default value of the given struct field. The
+// value of the `default` tag is used if the field has one, otherwise the value
+// the class constructor put in the field is used.
+func propertyDefault(field reflect.StructField, value reflect.Value) gdnative.Variant {
+	if defaultStr, ok := field.Tag.Lookup("default"); ok {
+		variant, err := parseDefault(defaultStr, field.Type)
+		if err == nil {
+			return variant
+		}
+		Log.Error("Unable to parse default value of property ", field.Name, ": ", err.Error())
+	}
+
+	variant, err := encodeValue(value)
+	if err != nil {
+		return gdnative.NewVariantNil()
+	}
+	return variant
+}
+
+// parseDefault will parse the value of a `default` struct tag into a Variant of
+// the given type. Vectors, rectangles and colors are given as comma separated
+// numbers, such as "1,2,3". Godot classes, like resources, can't be given as a
+// tag, and return an error.
+func parseDefault(value string, t reflect.Type) (gdnative.Variant, error) {
+	switch t {
+	case vector2Type:
+		n, err := parseReals(value, 2, 2)
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		return gdnative.NewVariantVector2(gdnative.NewVector2(n[0], n[1])), nil
+	case vector3Type:
+		n, err := parseReals(value, 3, 3)
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		return gdnative.NewVariantVector3(gdnative.NewVector3(n[0], n[1], n[2])), nil
+	case rect2Type:
+		n, err := parseReals(value, 4, 4)
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		return gdnative.NewVariantRect2(gdnative.NewRect2(n[0], n[1], n[2], n[3])), nil
+	case colorType:
+		n, err := parseReals(value, 3, 4)
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		if len(n) == 3 {
+			return gdnative.NewVariantColor(gdnative.NewColorRgb(n[0], n[1], n[2])), nil
+		}
+		return gdnative.NewVariantColor(gdnative.NewColorRgba(n[0], n[1], n[2], n[3])), nil
+	case nodePathType:
+		return gdnative.NewVariantNodePath(gdnative.NewNodePath(gdnative.String(value))), nil
+	}
+
+	// Resources can't be loaded while classes are being registered, and their
+	// path is not a valid default of an Object property.
+	if isGodotClass(t) {
+		return gdnative.Variant{}, fmt.Errorf("default values of class type %s can't be given as a tag", t)
+	}
+
+	// Parse all other values into the type of the field, and convert them with
+	// the Variant codec.
+	parsed := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		parsed.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 0, t.Bits())
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		parsed.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 0, t.Bits())
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		parsed.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, t.Bits())
+		if err != nil {
+			return gdnative.NewVariantNil(), err
+		}
+		parsed.SetFloat(f)
+	case reflect.String:
+		parsed.SetString(value)
+	default:
+		return gdnative.NewVariantNil(), fmt.Errorf("default values of type %s can't be given as a tag", t)
+	}
+
+	return encodeValue(parsed)
+}
+
+// parseReals will parse a comma separated list of numbers, which must have
+// between min and max numbers.
+func parseReals(value string, min, max int) ([]gdnative.Real, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) < min || len(parts) > max {
+		if min == max {
+			return nil, fmt.Errorf("expected %d comma separated numbers, got %q", min, value)
+		}
+		return nil, fmt.Errorf("expected %d to %d comma separated numbers, got %q", min, max, value)
+	}
+
+	reals := make([]gdnative.Real, len(parts))
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, err
+		}
+		reals[i] = gdnative.Real(f)
+	}
+
+	return reals, nil
+}
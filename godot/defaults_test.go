@@ -0,0 +1,44 @@
+package godot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+// TestParseDefaultClass checks that a resource path is not accepted as the
+// default of a property of a Godot class type.
+func TestParseDefaultClass(t *testing.T) {
+	for _, classType := range []reflect.Type{
+		reflect.TypeOf(&Resource{}),
+		reflect.TypeOf((*ResourceImplementer)(nil)).Elem(),
+	} {
+		if _, err := parseDefault("res://icon.png", classType); err == nil {
+			t.Errorf("parseDefault returned no error for a %s property", classType)
+		}
+	}
+}
+
+func TestParseReals(t *testing.T) {
+	tests := []struct {
+		value    string
+		min, max int
+		expected []gdnative.Real
+		ok       bool
+	}{
+		{"1,2", 2, 2, []gdnative.Real{1, 2}, true},
+		{" 1.5, -2 ,3", 3, 4, []gdnative.Real{1.5, -2, 3}, true},
+		{"1,2,3,4", 3, 4, []gdnative.Real{1, 2, 3, 4}, true},
+		{"1,2", 3, 3, nil, false},
+		{"1,2,3,4,5", 3, 4, nil, false},
+		{"1,x", 2, 2, nil, false},
+	}
+
+	for _, test := range tests {
+		reals, err := parseReals(test.value, test.min, test.max)
+		if (err == nil) != test.ok || !reflect.DeepEqual(reals, test.expected) {
+			t.Errorf("parseReals(%q, %d, %d) = %v, %v; want %v", test.value, test.min, test.max, reals, err, test.expected)
+		}
+	}
+}
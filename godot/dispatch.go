@@ -0,0 +1,159 @@
+package godot
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"sync"
+)
+
+// mainQueue holds the funcs that are waiting to be run on the main thread. The
+// queue is drained by calling a trampoline object with call_deferred, which
+// Godot runs on the main thread at the end of the current frame.
+var mainQueue = struct {
+	sync.Mutex
+	funcs      []func()
+	dispatcher *signalTrampoline
+	creating   bool
+	scheduled  bool
+}{}
+
+// RunOnMain will queue the given func to be run on the main thread of Godot at
+// the end of the current frame. Most engine APIs may only be called on the main
+// thread, so goroutines should use RunOnMain to call them. Funcs are run in the
+// order they were queued.
+//
+// The queue is drained by an object that is created the first time Godot calls
+// into Go on the main thread, such as when it creates an instance of a
+// registered class or calls one of its methods. Funcs queued before then are
+// run once that happens.
+func RunOnMain(fn func()) {
+	mainQueue.Lock()
+	mainQueue.funcs = append(mainQueue.funcs, fn)
+	dispatcher := scheduleDispatcher()
+	mainQueue.Unlock()
+
+	if dispatcher != nil {
+		callDeferred(dispatcher, signalTrampolineMethod)
+	}
+}
+
+// Await will run the given func on the main thread of Godot, and wait until it
+// has returned. If it is called on the main thread, the func is run right away.
+// Like RunOnMain, a func awaited from a goroutine before Godot has called into
+// Go on the main thread is not run until it has, so Await blocks until then.
+func Await(fn func()) {
+	if gdnative.IsMainThread() {
+		fn()
+		return
+	}
+
+	done := make(chan struct{})
+	RunOnMain(func() {
+		defer close(done)
+		fn()
+	})
+	<-done
+}
+
+// scheduleDispatcher will return the dispatcher if it has to be called to drain
+// the queue, or nil if it is already scheduled or does not exist yet. The queue
+// must be locked.
+func scheduleDispatcher() *signalTrampoline {
+	if mainQueue.dispatcher == nil || mainQueue.scheduled || len(mainQueue.funcs) == 0 {
+		return nil
+	}
+	mainQueue.scheduled = true
+	return mainQueue.dispatcher
+}
+
+// ensureDispatcher will create the object that drains the queue, if it does not
+// exist yet. Objects may only be created on the main thread, so this is called
+// whenever Godot calls into Go on the main thread.
+func ensureDispatcher() {
+	if !gdnative.IsMainThread() {
+		return
+	}
+	mainQueue.Lock()
+	if mainQueue.dispatcher != nil || mainQueue.creating {
+		mainQueue.Unlock()
+		return
+	}
+	mainQueue.creating = true
+	mainQueue.Unlock()
+
+	// Creating the trampoline calls back into Go, so the queue can't be locked.
+	trampoline, err := newSignalTrampolineObject(drainMainQueue)
+
+	mainQueue.Lock()
+	mainQueue.creating = false
+	if err != nil {
+		mainQueue.Unlock()
+		Log.Error("Unable to create the main thread dispatcher: ", err.Error())
+		return
+	}
+	mainQueue.dispatcher = trampoline
+
+	// Drain any funcs that were queued before the dispatcher existed.
+	dispatcher := scheduleDispatcher()
+	mainQueue.Unlock()
+
+	if dispatcher != nil {
+		callDeferred(dispatcher, signalTrampolineMethod)
+	}
+}
+
+// freeDispatcher will free the object that drains the queue. It is called when
+// the library is unloaded, so any funcs that are still queued are dropped.
+func freeDispatcher() {
+	mainQueue.Lock()
+	dispatcher := mainQueue.dispatcher
+	mainQueue.dispatcher = nil
+	mainQueue.funcs = nil
+	mainQueue.scheduled = false
+	mainQueue.Unlock()
+
+	// Object.Free can't be used here, since "free" has no method bind.
+	if dispatcher != nil {
+		destroyObject(dispatcher.GetBaseObject())
+	}
+}
+
+// destroyObject will destroy the given Godot object. It is a variable so tests
+// can check which objects are destroyed without a running engine.
+var destroyObject = func(object gdnative.Object) {
+	object.Destroy()
+}
+
+// callDeferred will call the given method of the trampoline at the end of the
+// current frame, and destroy the Variant returned by call_deferred.
+func callDeferred(trampoline *signalTrampoline, method string) {
+	ret, err := trampoline.CallDeferred(gdnative.String(method))
+	ret.Destroy()
+	if err != nil {
+		Log.Error("Unable to call ", method, " deferred: ", err.Error())
+	}
+}
+
+// drainMainQueue will run all of the queued funcs. It is called by the
+// dispatcher on the main thread.
+func drainMainQueue(args ...gdnative.Variant) {
+	mainQueue.Lock()
+	funcs := mainQueue.funcs
+	mainQueue.funcs = nil
+	mainQueue.scheduled = false
+	mainQueue.Unlock()
+
+	for _, fn := range funcs {
+		runQueued(fn)
+	}
+}
+
+// runQueued will run the given queued func, and log any panic so the rest of the
+// queue is still run.
+func runQueued(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			Log.Error("Panic in func run on the main thread: ", r)
+		}
+	}()
+	fn()
+}
@@ -0,0 +1,34 @@
+package godot
+
+import (
+	"testing"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+// TestFreeDispatcher checks that the dispatcher is destroyed without calling
+// any engine method, like "free", which has no method bind. GDNative is not
+// initialized in tests, so any method call would panic.
+func TestFreeDispatcher(t *testing.T) {
+	destroyed := 0
+	defer func(destroy func(gdnative.Object)) { destroyObject = destroy }(destroyObject)
+	destroyObject = func(object gdnative.Object) { destroyed++ }
+
+	mainQueue.dispatcher = &signalTrampoline{}
+	mainQueue.funcs = []func(){func() {}}
+	mainQueue.scheduled = true
+	freeDispatcher()
+
+	if destroyed != 1 {
+		t.Errorf("dispatcher was destroyed %d times, want 1", destroyed)
+	}
+	if mainQueue.dispatcher != nil || mainQueue.funcs != nil || mainQueue.scheduled {
+		t.Error("the queue was not reset when the dispatcher was freed")
+	}
+
+	// Freeing it again does nothing.
+	freeDispatcher()
+	if destroyed != 1 {
+		t.Errorf("dispatcher was destroyed %d times after freeing it twice, want 1", destroyed)
+	}
+}
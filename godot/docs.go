@@ -0,0 +1,80 @@
+package godot
+
+import (
+	"fmt"
+	"github.com/shadowapex/godot-go/gdnative"
+	"reflect"
+)
+
+// ClassDocumenter is an interface for classes registered with AutoRegister that
+// document themselves in the Godot editor. The documentation is only shown if
+// the engine supports NativeScript 1.1.
+type ClassDocumenter interface {
+	// Doc returns the documentation of the class, keyed by Go name. The empty
+	// key documents the class itself, and all other keys are the names of
+	// methods, properties and signal fields. Properties can also be documented
+	// with a `doc:"..."` struct tag.
+	Doc() map[string]string
+}
+
+// ArgumentNamer is an interface for classes registered with AutoRegister that
+// name the arguments of their methods in the Godot editor. Go does not keep the
+// names of method arguments at runtime, so arguments that are not named are
+// shown as "arg0", "arg1", etc. The names are only shown if the engine supports
+// NativeScript 1.1.
+type ArgumentNamer interface {
+	// ArgNames returns the argument names of methods, keyed by Go method name.
+	ArgNames() map[string][]string
+}
+
+// classDocs will return the documentation of the given class, or an empty map if
+// it does not implement ClassDocumenter.
+func classDocs(class Class) map[string]string {
+	if documenter, ok := class.(ClassDocumenter); ok {
+		if docs := documenter.Doc(); docs != nil {
+			return docs
+		}
+	}
+	return map[string]string{}
+}
+
+// classArgNames will return the argument names of the methods of the given
+// class, or an empty map if it does not implement ArgumentNamer.
+func classArgNames(class Class) map[string][]string {
+	if namer, ok := class.(ArgumentNamer); ok {
+		if names := namer.ArgNames(); names != nil {
+			return names
+		}
+	}
+	return map[string][]string{}
+}
+
+// methodArguments will return the argument information of the given method,
+// using the given argument names where they are available. Arguments with types
+// that can't be converted into a Variant are registered as any type.
+func methodArguments(method *registeredMethod, names []string) []gdnative.MethodArgument {
+	// Variadic arguments are not shown, since Godot can't describe them.
+	arguments := []gdnative.MethodArgument{}
+	for i, argType := range method.params() {
+		var arg gdnative.MethodArgument
+		arg.Name = gdnative.String(fmt.Sprintf("arg%d", i))
+		if i < len(names) {
+			arg.Name = gdnative.String(names[i])
+		}
+		arg.Type, _ = variantTypeOf(argType)
+		arg.Hint = gdnative.PropertyHintNone
+
+		arguments = append(arguments, arg)
+	}
+
+	return arguments
+}
+
+// propertyDoc will return the documentation of the given struct field, from
+// either its `doc` tag or the class documentation.
+func propertyDoc(field reflect.StructField, docs map[string]string) string {
+	if doc, ok := field.Tag.Lookup("doc"); ok {
+		return doc
+	}
+	return docs[field.Name]
+}
@@ -1,7 +1,10 @@
-package godot
+package dynamicfont
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,40 +26,98 @@ const (
 	DynamicFontSpacingTop    DynamicFontSpacingType = 0
 )
 
-//func NewDynamicFontFromPointer(ptr gdnative.Pointer) DynamicFont {
-func newDynamicFontFromPointer(ptr gdnative.Pointer) DynamicFont {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := DynamicFont{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the DynamicFontSpacingType value.
+func (e DynamicFontSpacingType) String() string {
+	switch e {
+	case DynamicFontSpacingBottom:
+		return "SPACING_BOTTOM"
+	case DynamicFontSpacingChar:
+		return "SPACING_CHAR"
+	case DynamicFontSpacingSpace:
+		return "SPACING_SPACE"
+	case DynamicFontSpacingTop:
+		return "SPACING_TOP"
+	}
+	return fmt.Sprintf("DynamicFontSpacingType(%d)", int(e))
+}
 
-	return obj
+// ParseDynamicFontSpacingType will return the DynamicFontSpacingType value with the given Godot name.
+func ParseDynamicFontSpacingType(name string) (DynamicFontSpacingType, error) {
+	switch name {
+	case "SPACING_BOTTOM":
+		return DynamicFontSpacingBottom, nil
+	case "SPACING_CHAR":
+		return DynamicFontSpacingChar, nil
+	case "SPACING_SPACE":
+		return DynamicFontSpacingSpace, nil
+	case "SPACING_TOP":
+		return DynamicFontSpacingTop, nil
+	}
+	return 0, fmt.Errorf("invalid DynamicFontSpacingType value %q", name)
 }
 
 /*
 DynamicFont renders vector font files (such as TTF or OTF) dynamically at runtime instead of using a prerendered texture atlas like [BitmapFont]. This trades the faster loading time of [BitmapFont]s for the ability to change font parameters like size and spacing during runtime. [DynamicFontData] is used for referencing the font file paths.
 */
 type DynamicFont struct {
-	Font
+	godot.Font
 	owner gdnative.Object
 }
 
+// classConstructorDynamicFont is the class constructor of the DynamicFont class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorDynamicFont = gdnative.NewLazyClassConstructor("DynamicFont")
+
+// NewDynamicFont will create a new instance of the DynamicFont class.
+// DynamicFont is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewDynamicFont() *DynamicFont {
+	obj := &DynamicFont{}
+	obj.SetBaseObject(classConstructorDynamicFont.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *DynamicFont) BaseClass() string {
 	return "DynamicFont"
 }
 
+// Method binds of the DynamicFont class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindDynamicFontAddFallback      = gdnative.NewLazyMethodBind("DynamicFont", "add_fallback")
+	methodBindDynamicFontGetFallback      = gdnative.NewLazyMethodBind("DynamicFont", "get_fallback")
+	methodBindDynamicFontGetFallbackCount = gdnative.NewLazyMethodBind("DynamicFont", "get_fallback_count")
+	methodBindDynamicFontGetFontData      = gdnative.NewLazyMethodBind("DynamicFont", "get_font_data")
+	methodBindDynamicFontGetSize          = gdnative.NewLazyMethodBind("DynamicFont", "get_size")
+	methodBindDynamicFontGetSpacing       = gdnative.NewLazyMethodBind("DynamicFont", "get_spacing")
+	methodBindDynamicFontGetUseFilter     = gdnative.NewLazyMethodBind("DynamicFont", "get_use_filter")
+	methodBindDynamicFontGetUseMipmaps    = gdnative.NewLazyMethodBind("DynamicFont", "get_use_mipmaps")
+	methodBindDynamicFontRemoveFallback   = gdnative.NewLazyMethodBind("DynamicFont", "remove_fallback")
+	methodBindDynamicFontSetFallback      = gdnative.NewLazyMethodBind("DynamicFont", "set_fallback")
+	methodBindDynamicFontSetFontData      = gdnative.NewLazyMethodBind("DynamicFont", "set_font_data")
+	methodBindDynamicFontSetSize          = gdnative.NewLazyMethodBind("DynamicFont", "set_size")
+	methodBindDynamicFontSetSpacing       = gdnative.NewLazyMethodBind("DynamicFont", "set_spacing")
+	methodBindDynamicFontSetUseFilter     = gdnative.NewLazyMethodBind("DynamicFont", "set_use_filter")
+	methodBindDynamicFontSetUseMipmaps    = gdnative.NewLazyMethodBind("DynamicFont", "set_use_mipmaps")
+)
+
 /*
-        Adds a fallback font.
-	Args: [{ false data DynamicFontData}], Returns: void
+	        Adds a fallback font.
+		Args: [{ false data DynamicFontData}], Returns: void
 */
 func (o *DynamicFont) AddFallback(data DynamicFontDataImplementer) {
 	//log.Println("Calling DynamicFont.AddFallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(data.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(data))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "add_fallback")
+	methodBind := methodBindDynamicFontAddFallback.Get()
 
 	// Call the parent method.
 	// void
@@ -66,8 +127,8 @@ func (o *DynamicFont) AddFallback(data DynamicFontDataImplementer) {
 }
 
 /*
-        Returns the fallback font at index [code]idx[/code].
-	Args: [{ false idx int}], Returns: DynamicFontData
+	        Returns the fallback font at index [code]idx[/code].
+		Args: [{ false idx int}], Returns: DynamicFontData
 */
 func (o *DynamicFont) GetFallback(idx gdnative.Int) DynamicFontDataImplementer {
 	//log.Println("Calling DynamicFont.GetFallback()")
@@ -77,7 +138,7 @@ func (o *DynamicFont) GetFallback(idx gdnative.Int) DynamicFontDataImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "get_fallback")
+	methodBind := methodBindDynamicFontGetFallback.Get()
 
 	// Call the parent method.
 	// DynamicFontData
@@ -85,27 +146,25 @@ func (o *DynamicFont) GetFallback(idx gdnative.Int) DynamicFontDataImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newDynamicFontDataFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(DynamicFontDataImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "DynamicFontData" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(DynamicFontDataImplementer)
+	ret := DynamicFontData{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(DynamicFontDataImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of fallback fonts.
-	Args: [], Returns: int
+	        Returns the number of fallback fonts.
+		Args: [], Returns: int
 */
 func (o *DynamicFont) GetFallbackCount() gdnative.Int {
 	//log.Println("Calling DynamicFont.GetFallbackCount()")
@@ -114,7 +173,7 @@ func (o *DynamicFont) GetFallbackCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "get_fallback_count")
+	methodBind := methodBindDynamicFontGetFallbackCount.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +186,8 @@ func (o *DynamicFont) GetFallbackCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: DynamicFontData
+	        Undocumented
+		Args: [], Returns: DynamicFontData
 */
 func (o *DynamicFont) GetFontData() DynamicFontDataImplementer {
 	//log.Println("Calling DynamicFont.GetFontData()")
@@ -137,7 +196,7 @@ func (o *DynamicFont) GetFontData() DynamicFontDataImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "get_font_data")
+	methodBind := methodBindDynamicFontGetFontData.Get()
 
 	// Call the parent method.
 	// DynamicFontData
@@ -145,27 +204,25 @@ func (o *DynamicFont) GetFontData() DynamicFontDataImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newDynamicFontDataFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(DynamicFontDataImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "DynamicFontData" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(DynamicFontDataImplementer)
+	ret := DynamicFontData{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(DynamicFontDataImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *DynamicFont) GetSize() gdnative.Int {
 	//log.Println("Calling DynamicFont.GetSize()")
@@ -174,7 +231,7 @@ func (o *DynamicFont) GetSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "get_size")
+	methodBind := methodBindDynamicFontGetSize.Get()
 
 	// Call the parent method.
 	// int
@@ -187,8 +244,8 @@ func (o *DynamicFont) GetSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false type int}], Returns: int
+	        Undocumented
+		Args: [{ false type int}], Returns: int
 */
 func (o *DynamicFont) GetSpacing(aType gdnative.Int) gdnative.Int {
 	//log.Println("Calling DynamicFont.GetSpacing()")
@@ -198,7 +255,7 @@ func (o *DynamicFont) GetSpacing(aType gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "get_spacing")
+	methodBind := methodBindDynamicFontGetSpacing.Get()
 
 	// Call the parent method.
 	// int
@@ -211,8 +268,8 @@ func (o *DynamicFont) GetSpacing(aType gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *DynamicFont) GetUseFilter() gdnative.Bool {
 	//log.Println("Calling DynamicFont.GetUseFilter()")
@@ -221,7 +278,7 @@ func (o *DynamicFont) GetUseFilter() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "get_use_filter")
+	methodBind := methodBindDynamicFontGetUseFilter.Get()
 
 	// Call the parent method.
 	// bool
@@ -234,8 +291,8 @@ func (o *DynamicFont) GetUseFilter() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *DynamicFont) GetUseMipmaps() gdnative.Bool {
 	//log.Println("Calling DynamicFont.GetUseMipmaps()")
@@ -244,7 +301,7 @@ func (o *DynamicFont) GetUseMipmaps() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "get_use_mipmaps")
+	methodBind := methodBindDynamicFontGetUseMipmaps.Get()
 
 	// Call the parent method.
 	// bool
@@ -257,8 +314,8 @@ func (o *DynamicFont) GetUseMipmaps() gdnative.Bool {
 }
 
 /*
-        Removes the fallback font at index [code]idx[/code].
-	Args: [{ false idx int}], Returns: void
+	        Removes the fallback font at index [code]idx[/code].
+		Args: [{ false idx int}], Returns: void
 */
 func (o *DynamicFont) RemoveFallback(idx gdnative.Int) {
 	//log.Println("Calling DynamicFont.RemoveFallback()")
@@ -268,7 +325,7 @@ func (o *DynamicFont) RemoveFallback(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "remove_fallback")
+	methodBind := methodBindDynamicFontRemoveFallback.Get()
 
 	// Call the parent method.
 	// void
@@ -278,8 +335,8 @@ func (o *DynamicFont) RemoveFallback(idx gdnative.Int) {
 }
 
 /*
-        Sets the fallback font at index [code]idx[/code].
-	Args: [{ false idx int} { false data DynamicFontData}], Returns: void
+	        Sets the fallback font at index [code]idx[/code].
+		Args: [{ false idx int} { false data DynamicFontData}], Returns: void
 */
 func (o *DynamicFont) SetFallback(idx gdnative.Int, data DynamicFontDataImplementer) {
 	//log.Println("Calling DynamicFont.SetFallback()")
@@ -287,10 +344,10 @@ func (o *DynamicFont) SetFallback(idx gdnative.Int, data DynamicFontDataImplemen
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(data.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(data))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "set_fallback")
+	methodBind := methodBindDynamicFontSetFallback.Get()
 
 	// Call the parent method.
 	// void
@@ -300,18 +357,18 @@ func (o *DynamicFont) SetFallback(idx gdnative.Int, data DynamicFontDataImplemen
 }
 
 /*
-        Undocumented
-	Args: [{ false data DynamicFontData}], Returns: void
+	        Undocumented
+		Args: [{ false data DynamicFontData}], Returns: void
 */
 func (o *DynamicFont) SetFontData(data DynamicFontDataImplementer) {
 	//log.Println("Calling DynamicFont.SetFontData()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(data.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(data))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "set_font_data")
+	methodBind := methodBindDynamicFontSetFontData.Get()
 
 	// Call the parent method.
 	// void
@@ -321,8 +378,8 @@ func (o *DynamicFont) SetFontData(data DynamicFontDataImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false data int}], Returns: void
+	        Undocumented
+		Args: [{ false data int}], Returns: void
 */
 func (o *DynamicFont) SetSize(data gdnative.Int) {
 	//log.Println("Calling DynamicFont.SetSize()")
@@ -332,7 +389,7 @@ func (o *DynamicFont) SetSize(data gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "set_size")
+	methodBind := methodBindDynamicFontSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -342,8 +399,8 @@ func (o *DynamicFont) SetSize(data gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false type int} { false value int}], Returns: void
+	        Undocumented
+		Args: [{ false type int} { false value int}], Returns: void
 */
 func (o *DynamicFont) SetSpacing(aType gdnative.Int, value gdnative.Int) {
 	//log.Println("Calling DynamicFont.SetSpacing()")
@@ -354,7 +411,7 @@ func (o *DynamicFont) SetSpacing(aType gdnative.Int, value gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "set_spacing")
+	methodBind := methodBindDynamicFontSetSpacing.Get()
 
 	// Call the parent method.
 	// void
@@ -364,8 +421,8 @@ func (o *DynamicFont) SetSpacing(aType gdnative.Int, value gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *DynamicFont) SetUseFilter(enable gdnative.Bool) {
 	//log.Println("Calling DynamicFont.SetUseFilter()")
@@ -375,7 +432,7 @@ func (o *DynamicFont) SetUseFilter(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "set_use_filter")
+	methodBind := methodBindDynamicFontSetUseFilter.Get()
 
 	// Call the parent method.
 	// void
@@ -385,8 +442,8 @@ func (o *DynamicFont) SetUseFilter(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *DynamicFont) SetUseMipmaps(enable gdnative.Bool) {
 	//log.Println("Calling DynamicFont.SetUseMipmaps()")
@@ -396,7 +453,7 @@ func (o *DynamicFont) SetUseMipmaps(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFont", "set_use_mipmaps")
+	methodBind := methodBindDynamicFontSetUseMipmaps.Get()
 
 	// Call the parent method.
 	// void
@@ -405,10 +462,70 @@ func (o *DynamicFont) SetUseMipmaps(enable gdnative.Bool) {
 
 }
 
+// ExtraSpacingBottom will return the value of the "extra_spacing_bottom" property.
+func (o *DynamicFont) ExtraSpacingBottom() gdnative.Int {
+	return o.GetSpacing(1)
+}
+
+// SetExtraSpacingBottom will set the value of the "extra_spacing_bottom" property.
+func (o *DynamicFont) SetExtraSpacingBottom(value gdnative.Int) {
+	o.SetSpacing(1, value)
+}
+
+// ExtraSpacingChar will return the value of the "extra_spacing_char" property.
+func (o *DynamicFont) ExtraSpacingChar() gdnative.Int {
+	return o.GetSpacing(2)
+}
+
+// SetExtraSpacingChar will set the value of the "extra_spacing_char" property.
+func (o *DynamicFont) SetExtraSpacingChar(value gdnative.Int) {
+	o.SetSpacing(2, value)
+}
+
+// ExtraSpacingSpace will return the value of the "extra_spacing_space" property.
+func (o *DynamicFont) ExtraSpacingSpace() gdnative.Int {
+	return o.GetSpacing(3)
+}
+
+// SetExtraSpacingSpace will set the value of the "extra_spacing_space" property.
+func (o *DynamicFont) SetExtraSpacingSpace(value gdnative.Int) {
+	o.SetSpacing(3, value)
+}
+
+// ExtraSpacingTop will return the value of the "extra_spacing_top" property.
+func (o *DynamicFont) ExtraSpacingTop() gdnative.Int {
+	return o.GetSpacing(0)
+}
+
+// SetExtraSpacingTop will set the value of the "extra_spacing_top" property.
+func (o *DynamicFont) SetExtraSpacingTop(value gdnative.Int) {
+	o.SetSpacing(0, value)
+}
+
+// FontData will return the value of the "font_data" property.
+func (o *DynamicFont) FontData() DynamicFontDataImplementer {
+	return o.GetFontData()
+}
+
+// Size will return the value of the "size" property.
+func (o *DynamicFont) Size() gdnative.Int {
+	return o.GetSize()
+}
+
+// UseFilter will return the value of the "use_filter" property.
+func (o *DynamicFont) UseFilter() gdnative.Bool {
+	return o.GetUseFilter()
+}
+
+// UseMipmaps will return the value of the "use_mipmaps" property.
+func (o *DynamicFont) UseMipmaps() gdnative.Bool {
+	return o.GetUseMipmaps()
+}
+
 // DynamicFontImplementer is an interface that implements the methods
 // of the DynamicFont class.
 type DynamicFontImplementer interface {
-	FontImplementer
+	godot.FontImplementer
 	AddFallback(data DynamicFontDataImplementer)
 	GetFallback(idx gdnative.Int) DynamicFontDataImplementer
 	GetFallbackCount() gdnative.Int
@@ -424,4 +541,16 @@ type DynamicFontImplementer interface {
 	SetSpacing(aType gdnative.Int, value gdnative.Int)
 	SetUseFilter(enable gdnative.Bool)
 	SetUseMipmaps(enable gdnative.Bool)
+	ExtraSpacingBottom() gdnative.Int
+	SetExtraSpacingBottom(value gdnative.Int)
+	ExtraSpacingChar() gdnative.Int
+	SetExtraSpacingChar(value gdnative.Int)
+	ExtraSpacingSpace() gdnative.Int
+	SetExtraSpacingSpace(value gdnative.Int)
+	ExtraSpacingTop() gdnative.Int
+	SetExtraSpacingTop(value gdnative.Int)
+	FontData() DynamicFontDataImplementer
+	Size() gdnative.Int
+	UseFilter() gdnative.Bool
+	UseMipmaps() gdnative.Bool
 }
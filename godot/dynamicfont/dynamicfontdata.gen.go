@@ -1,7 +1,8 @@
-package godot
+package dynamicfont
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewDynamicFontDataFromPointer(ptr gdnative.Pointer) DynamicFontData {
-func newDynamicFontDataFromPointer(ptr gdnative.Pointer) DynamicFontData {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := DynamicFontData{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Used with [DynamicFont] to describe the location of a vector font file for dynamic rendering at runtime.
 */
 type DynamicFontData struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorDynamicFontData is the class constructor of the DynamicFontData class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorDynamicFontData = gdnative.NewLazyClassConstructor("DynamicFontData")
+
+// NewDynamicFontData will create a new instance of the DynamicFontData class.
+// DynamicFontData is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewDynamicFontData() *DynamicFontData {
+	obj := &DynamicFontData{}
+	obj.SetBaseObject(classConstructorDynamicFontData.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *DynamicFontData) BaseClass() string {
 	return "DynamicFontData"
 }
 
+// Method binds of the DynamicFontData class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindDynamicFontDataGetFontPath = gdnative.NewLazyMethodBind("DynamicFontData", "get_font_path")
+	methodBindDynamicFontDataSetFontPath = gdnative.NewLazyMethodBind("DynamicFontData", "set_font_path")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *DynamicFontData) GetFontPath() gdnative.String {
 	//log.Println("Calling DynamicFontData.GetFontPath()")
@@ -45,7 +61,7 @@ func (o *DynamicFontData) GetFontPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFontData", "get_font_path")
+	methodBind := methodBindDynamicFontDataGetFontPath.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *DynamicFontData) GetFontPath() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: void
+	        Undocumented
+		Args: [{ false path String}], Returns: void
 */
 func (o *DynamicFontData) SetFontPath(path gdnative.String) {
 	//log.Println("Calling DynamicFontData.SetFontPath()")
@@ -69,7 +85,7 @@ func (o *DynamicFontData) SetFontPath(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("DynamicFontData", "set_font_path")
+	methodBind := methodBindDynamicFontDataSetFontPath.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *DynamicFontData) SetFontPath(path gdnative.String) {
 
 }
 
+// FontPath will return the value of the "font_path" property.
+func (o *DynamicFontData) FontPath() gdnative.String {
+	return o.GetFontPath()
+}
+
 // DynamicFontDataImplementer is an interface that implements the methods
 // of the DynamicFontData class.
 type DynamicFontDataImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	GetFontPath() gdnative.String
 	SetFontPath(path gdnative.String)
+	FontPath() gdnative.String
 }
@@ -0,0 +1,100 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("EditorExportPlugin", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorExportPlugin{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorFileDialog", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorFileDialog{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorFileSystem", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorFileSystem{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorFileSystemDirectory", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorFileSystemDirectory{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorImportPlugin", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorImportPlugin{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorInterface", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorInterface{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorPlugin", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorPlugin{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorResourceConversionPlugin", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorResourceConversionPlugin{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorResourcePreview", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorResourcePreview{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorResourcePreviewGenerator", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorResourcePreviewGenerator{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorSceneImporter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorSceneImporter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorScenePostImport", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorScenePostImport{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorScript", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorScript{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorSelection", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorSelection{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorSettings", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorSettings{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EditorSpatialGizmo", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EditorSpatialGizmo{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
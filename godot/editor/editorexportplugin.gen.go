@@ -1,7 +1,8 @@
-package godot
+package editor
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,51 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewEditorExportPluginFromPointer(ptr gdnative.Pointer) EditorExportPlugin {
-func newEditorExportPluginFromPointer(ptr gdnative.Pointer) EditorExportPlugin {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EditorExportPlugin{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type EditorExportPlugin struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorEditorExportPlugin is the class constructor of the EditorExportPlugin class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorExportPlugin = gdnative.NewLazyClassConstructor("EditorExportPlugin")
+
+// NewEditorExportPlugin will create a new instance of the EditorExportPlugin class.
+// EditorExportPlugin is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorExportPlugin() *EditorExportPlugin {
+	obj := &EditorExportPlugin{}
+	obj.SetBaseObject(classConstructorEditorExportPlugin.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *EditorExportPlugin) BaseClass() string {
 	return "EditorExportPlugin"
 }
 
-/*
+// Method binds of the EditorExportPlugin class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorExportPluginX_ExportBegin      = gdnative.NewLazyMethodBind("EditorExportPlugin", "_export_begin")
+	methodBindEditorExportPluginX_ExportFile       = gdnative.NewLazyMethodBind("EditorExportPlugin", "_export_file")
+	methodBindEditorExportPluginAddFile            = gdnative.NewLazyMethodBind("EditorExportPlugin", "add_file")
+	methodBindEditorExportPluginAddIosBundleFile   = gdnative.NewLazyMethodBind("EditorExportPlugin", "add_ios_bundle_file")
+	methodBindEditorExportPluginAddIosCppCode      = gdnative.NewLazyMethodBind("EditorExportPlugin", "add_ios_cpp_code")
+	methodBindEditorExportPluginAddIosFramework    = gdnative.NewLazyMethodBind("EditorExportPlugin", "add_ios_framework")
+	methodBindEditorExportPluginAddIosLinkerFlags  = gdnative.NewLazyMethodBind("EditorExportPlugin", "add_ios_linker_flags")
+	methodBindEditorExportPluginAddIosPlistContent = gdnative.NewLazyMethodBind("EditorExportPlugin", "add_ios_plist_content")
+	methodBindEditorExportPluginAddSharedObject    = gdnative.NewLazyMethodBind("EditorExportPlugin", "add_shared_object")
+	methodBindEditorExportPluginSkip               = gdnative.NewLazyMethodBind("EditorExportPlugin", "skip")
+)
 
-	Args: [{ false features PoolStringArray} { false is_debug bool} { false path String} { false flags int}], Returns: void
+/*
+Args: [{ false features PoolStringArray} { false is_debug bool} { false path String} { false flags int}], Returns: void
 */
 func (o *EditorExportPlugin) X_ExportBegin(features gdnative.PoolStringArray, isDebug gdnative.Bool, path gdnative.String, flags gdnative.Int) {
 	//log.Println("Calling EditorExportPlugin.X_ExportBegin()")
@@ -49,7 +71,7 @@ func (o *EditorExportPlugin) X_ExportBegin(features gdnative.PoolStringArray, is
 	ptrArguments[3] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "_export_begin")
+	methodBind := methodBindEditorExportPluginX_ExportBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -59,8 +81,7 @@ func (o *EditorExportPlugin) X_ExportBegin(features gdnative.PoolStringArray, is
 }
 
 /*
-
-	Args: [{ false path String} { false type String} { false features PoolStringArray}], Returns: void
+Args: [{ false path String} { false type String} { false features PoolStringArray}], Returns: void
 */
 func (o *EditorExportPlugin) X_ExportFile(path gdnative.String, aType gdnative.String, features gdnative.PoolStringArray) {
 	//log.Println("Calling EditorExportPlugin.X_ExportFile()")
@@ -72,7 +93,7 @@ func (o *EditorExportPlugin) X_ExportFile(path gdnative.String, aType gdnative.S
 	ptrArguments[2] = gdnative.NewPointerFromPoolStringArray(features)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "_export_file")
+	methodBind := methodBindEditorExportPluginX_ExportFile.Get()
 
 	// Call the parent method.
 	// void
@@ -82,8 +103,7 @@ func (o *EditorExportPlugin) X_ExportFile(path gdnative.String, aType gdnative.S
 }
 
 /*
-
-	Args: [{ false path String} { false file PoolByteArray} { false remap bool}], Returns: void
+Args: [{ false path String} { false file PoolByteArray} { false remap bool}], Returns: void
 */
 func (o *EditorExportPlugin) AddFile(path gdnative.String, file gdnative.PoolByteArray, remap gdnative.Bool) {
 	//log.Println("Calling EditorExportPlugin.AddFile()")
@@ -95,7 +115,7 @@ func (o *EditorExportPlugin) AddFile(path gdnative.String, file gdnative.PoolByt
 	ptrArguments[2] = gdnative.NewPointerFromBool(remap)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "add_file")
+	methodBind := methodBindEditorExportPluginAddFile.Get()
 
 	// Call the parent method.
 	// void
@@ -105,8 +125,7 @@ func (o *EditorExportPlugin) AddFile(path gdnative.String, file gdnative.PoolByt
 }
 
 /*
-
-	Args: [{ false path String}], Returns: void
+Args: [{ false path String}], Returns: void
 */
 func (o *EditorExportPlugin) AddIosBundleFile(path gdnative.String) {
 	//log.Println("Calling EditorExportPlugin.AddIosBundleFile()")
@@ -116,7 +135,7 @@ func (o *EditorExportPlugin) AddIosBundleFile(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "add_ios_bundle_file")
+	methodBind := methodBindEditorExportPluginAddIosBundleFile.Get()
 
 	// Call the parent method.
 	// void
@@ -126,8 +145,7 @@ func (o *EditorExportPlugin) AddIosBundleFile(path gdnative.String) {
 }
 
 /*
-
-	Args: [{ false code String}], Returns: void
+Args: [{ false code String}], Returns: void
 */
 func (o *EditorExportPlugin) AddIosCppCode(code gdnative.String) {
 	//log.Println("Calling EditorExportPlugin.AddIosCppCode()")
@@ -137,7 +155,7 @@ func (o *EditorExportPlugin) AddIosCppCode(code gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(code)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "add_ios_cpp_code")
+	methodBind := methodBindEditorExportPluginAddIosCppCode.Get()
 
 	// Call the parent method.
 	// void
@@ -147,8 +165,7 @@ func (o *EditorExportPlugin) AddIosCppCode(code gdnative.String) {
 }
 
 /*
-
-	Args: [{ false path String}], Returns: void
+Args: [{ false path String}], Returns: void
 */
 func (o *EditorExportPlugin) AddIosFramework(path gdnative.String) {
 	//log.Println("Calling EditorExportPlugin.AddIosFramework()")
@@ -158,7 +175,7 @@ func (o *EditorExportPlugin) AddIosFramework(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "add_ios_framework")
+	methodBind := methodBindEditorExportPluginAddIosFramework.Get()
 
 	// Call the parent method.
 	// void
@@ -168,8 +185,7 @@ func (o *EditorExportPlugin) AddIosFramework(path gdnative.String) {
 }
 
 /*
-
-	Args: [{ false flags String}], Returns: void
+Args: [{ false flags String}], Returns: void
 */
 func (o *EditorExportPlugin) AddIosLinkerFlags(flags gdnative.String) {
 	//log.Println("Calling EditorExportPlugin.AddIosLinkerFlags()")
@@ -179,7 +195,7 @@ func (o *EditorExportPlugin) AddIosLinkerFlags(flags gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "add_ios_linker_flags")
+	methodBind := methodBindEditorExportPluginAddIosLinkerFlags.Get()
 
 	// Call the parent method.
 	// void
@@ -189,8 +205,7 @@ func (o *EditorExportPlugin) AddIosLinkerFlags(flags gdnative.String) {
 }
 
 /*
-
-	Args: [{ false plist_content String}], Returns: void
+Args: [{ false plist_content String}], Returns: void
 */
 func (o *EditorExportPlugin) AddIosPlistContent(plistContent gdnative.String) {
 	//log.Println("Calling EditorExportPlugin.AddIosPlistContent()")
@@ -200,7 +215,7 @@ func (o *EditorExportPlugin) AddIosPlistContent(plistContent gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(plistContent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "add_ios_plist_content")
+	methodBind := methodBindEditorExportPluginAddIosPlistContent.Get()
 
 	// Call the parent method.
 	// void
@@ -210,8 +225,7 @@ func (o *EditorExportPlugin) AddIosPlistContent(plistContent gdnative.String) {
 }
 
 /*
-
-	Args: [{ false path String} { false tags PoolStringArray}], Returns: void
+Args: [{ false path String} { false tags PoolStringArray}], Returns: void
 */
 func (o *EditorExportPlugin) AddSharedObject(path gdnative.String, tags gdnative.PoolStringArray) {
 	//log.Println("Calling EditorExportPlugin.AddSharedObject()")
@@ -222,7 +236,7 @@ func (o *EditorExportPlugin) AddSharedObject(path gdnative.String, tags gdnative
 	ptrArguments[1] = gdnative.NewPointerFromPoolStringArray(tags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "add_shared_object")
+	methodBind := methodBindEditorExportPluginAddSharedObject.Get()
 
 	// Call the parent method.
 	// void
@@ -232,8 +246,7 @@ func (o *EditorExportPlugin) AddSharedObject(path gdnative.String, tags gdnative
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *EditorExportPlugin) Skip() {
 	//log.Println("Calling EditorExportPlugin.Skip()")
@@ -242,7 +255,7 @@ func (o *EditorExportPlugin) Skip() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorExportPlugin", "skip")
+	methodBind := methodBindEditorExportPluginSkip.Get()
 
 	// Call the parent method.
 	// void
@@ -254,7 +267,7 @@ func (o *EditorExportPlugin) Skip() {
 // EditorExportPluginImplementer is an interface that implements the methods
 // of the EditorExportPlugin class.
 type EditorExportPluginImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	X_ExportBegin(features gdnative.PoolStringArray, isDebug gdnative.Bool, path gdnative.String, flags gdnative.Int)
 	X_ExportFile(path gdnative.String, aType gdnative.String, features gdnative.PoolStringArray)
 	AddFile(path gdnative.String, file gdnative.PoolByteArray, remap gdnative.Bool)
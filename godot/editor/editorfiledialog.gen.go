@@ -1,7 +1,11 @@
-package godot
+package editor
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/control"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +26,32 @@ const (
 	EditorFileDialogAccessUserdata   EditorFileDialogAccess = 1
 )
 
+// String will return the Godot name of the EditorFileDialogAccess value.
+func (e EditorFileDialogAccess) String() string {
+	switch e {
+	case EditorFileDialogAccessFilesystem:
+		return "ACCESS_FILESYSTEM"
+	case EditorFileDialogAccessResources:
+		return "ACCESS_RESOURCES"
+	case EditorFileDialogAccessUserdata:
+		return "ACCESS_USERDATA"
+	}
+	return fmt.Sprintf("EditorFileDialogAccess(%d)", int(e))
+}
+
+// ParseEditorFileDialogAccess will return the EditorFileDialogAccess value with the given Godot name.
+func ParseEditorFileDialogAccess(name string) (EditorFileDialogAccess, error) {
+	switch name {
+	case "ACCESS_FILESYSTEM":
+		return EditorFileDialogAccessFilesystem, nil
+	case "ACCESS_RESOURCES":
+		return EditorFileDialogAccessResources, nil
+	case "ACCESS_USERDATA":
+		return EditorFileDialogAccessUserdata, nil
+	}
+	return 0, fmt.Errorf("invalid EditorFileDialogAccess value %q", name)
+}
+
 // EditorFileDialogDisplayMode is an enum for DisplayMode values.
 type EditorFileDialogDisplayMode int
 
@@ -30,6 +60,28 @@ const (
 	EditorFileDialogDisplayThumbnails EditorFileDialogDisplayMode = 0
 )
 
+// String will return the Godot name of the EditorFileDialogDisplayMode value.
+func (e EditorFileDialogDisplayMode) String() string {
+	switch e {
+	case EditorFileDialogDisplayList:
+		return "DISPLAY_LIST"
+	case EditorFileDialogDisplayThumbnails:
+		return "DISPLAY_THUMBNAILS"
+	}
+	return fmt.Sprintf("EditorFileDialogDisplayMode(%d)", int(e))
+}
+
+// ParseEditorFileDialogDisplayMode will return the EditorFileDialogDisplayMode value with the given Godot name.
+func ParseEditorFileDialogDisplayMode(name string) (EditorFileDialogDisplayMode, error) {
+	switch name {
+	case "DISPLAY_LIST":
+		return EditorFileDialogDisplayList, nil
+	case "DISPLAY_THUMBNAILS":
+		return EditorFileDialogDisplayThumbnails, nil
+	}
+	return 0, fmt.Errorf("invalid EditorFileDialogDisplayMode value %q", name)
+}
+
 // EditorFileDialogMode is an enum for Mode values.
 type EditorFileDialogMode int
 
@@ -41,30 +93,122 @@ const (
 	EditorFileDialogModeSaveFile  EditorFileDialogMode = 4
 )
 
-//func NewEditorFileDialogFromPointer(ptr gdnative.Pointer) EditorFileDialog {
-func newEditorFileDialogFromPointer(ptr gdnative.Pointer) EditorFileDialog {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EditorFileDialog{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the EditorFileDialogMode value.
+func (e EditorFileDialogMode) String() string {
+	switch e {
+	case EditorFileDialogModeOpenAny:
+		return "MODE_OPEN_ANY"
+	case EditorFileDialogModeOpenDir:
+		return "MODE_OPEN_DIR"
+	case EditorFileDialogModeOpenFile:
+		return "MODE_OPEN_FILE"
+	case EditorFileDialogModeOpenFiles:
+		return "MODE_OPEN_FILES"
+	case EditorFileDialogModeSaveFile:
+		return "MODE_SAVE_FILE"
+	}
+	return fmt.Sprintf("EditorFileDialogMode(%d)", int(e))
+}
 
-	return obj
+// ParseEditorFileDialogMode will return the EditorFileDialogMode value with the given Godot name.
+func ParseEditorFileDialogMode(name string) (EditorFileDialogMode, error) {
+	switch name {
+	case "MODE_OPEN_ANY":
+		return EditorFileDialogModeOpenAny, nil
+	case "MODE_OPEN_DIR":
+		return EditorFileDialogModeOpenDir, nil
+	case "MODE_OPEN_FILE":
+		return EditorFileDialogModeOpenFile, nil
+	case "MODE_OPEN_FILES":
+		return EditorFileDialogModeOpenFiles, nil
+	case "MODE_SAVE_FILE":
+		return EditorFileDialogModeSaveFile, nil
+	}
+	return 0, fmt.Errorf("invalid EditorFileDialogMode value %q", name)
 }
 
 /*
-
  */
 type EditorFileDialog struct {
-	ConfirmationDialog
+	control.ConfirmationDialog
 	owner gdnative.Object
 }
 
+// classConstructorEditorFileDialog is the class constructor of the EditorFileDialog class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorFileDialog = gdnative.NewLazyClassConstructor("EditorFileDialog")
+
+// NewEditorFileDialog will create a new instance of the EditorFileDialog class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewEditorFileDialog() *EditorFileDialog {
+	obj := &EditorFileDialog{}
+	obj.SetBaseObject(classConstructorEditorFileDialog.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *EditorFileDialog) BaseClass() string {
 	return "EditorFileDialog"
 }
 
+// Method binds of the EditorFileDialog class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorFileDialogX_ActionPressed            = gdnative.NewLazyMethodBind("EditorFileDialog", "_action_pressed")
+	methodBindEditorFileDialogX_CancelPressed            = gdnative.NewLazyMethodBind("EditorFileDialog", "_cancel_pressed")
+	methodBindEditorFileDialogX_DirEntered               = gdnative.NewLazyMethodBind("EditorFileDialog", "_dir_entered")
+	methodBindEditorFileDialogX_FavoriteMoveDown         = gdnative.NewLazyMethodBind("EditorFileDialog", "_favorite_move_down")
+	methodBindEditorFileDialogX_FavoriteMoveUp           = gdnative.NewLazyMethodBind("EditorFileDialog", "_favorite_move_up")
+	methodBindEditorFileDialogX_FavoriteSelected         = gdnative.NewLazyMethodBind("EditorFileDialog", "_favorite_selected")
+	methodBindEditorFileDialogX_FavoriteToggled          = gdnative.NewLazyMethodBind("EditorFileDialog", "_favorite_toggled")
+	methodBindEditorFileDialogX_FileEntered              = gdnative.NewLazyMethodBind("EditorFileDialog", "_file_entered")
+	methodBindEditorFileDialogX_FilterSelected           = gdnative.NewLazyMethodBind("EditorFileDialog", "_filter_selected")
+	methodBindEditorFileDialogX_GoBack                   = gdnative.NewLazyMethodBind("EditorFileDialog", "_go_back")
+	methodBindEditorFileDialogX_GoForward                = gdnative.NewLazyMethodBind("EditorFileDialog", "_go_forward")
+	methodBindEditorFileDialogX_GoUp                     = gdnative.NewLazyMethodBind("EditorFileDialog", "_go_up")
+	methodBindEditorFileDialogX_ItemDbSelected           = gdnative.NewLazyMethodBind("EditorFileDialog", "_item_db_selected")
+	methodBindEditorFileDialogX_ItemListItemRmbSelected  = gdnative.NewLazyMethodBind("EditorFileDialog", "_item_list_item_rmb_selected")
+	methodBindEditorFileDialogX_ItemListRmbClicked       = gdnative.NewLazyMethodBind("EditorFileDialog", "_item_list_rmb_clicked")
+	methodBindEditorFileDialogX_ItemMenuIdPressed        = gdnative.NewLazyMethodBind("EditorFileDialog", "_item_menu_id_pressed")
+	methodBindEditorFileDialogX_ItemSelected             = gdnative.NewLazyMethodBind("EditorFileDialog", "_item_selected")
+	methodBindEditorFileDialogX_ItemsClearSelection      = gdnative.NewLazyMethodBind("EditorFileDialog", "_items_clear_selection")
+	methodBindEditorFileDialogX_MakeDir                  = gdnative.NewLazyMethodBind("EditorFileDialog", "_make_dir")
+	methodBindEditorFileDialogX_MakeDirConfirm           = gdnative.NewLazyMethodBind("EditorFileDialog", "_make_dir_confirm")
+	methodBindEditorFileDialogX_MultiSelected            = gdnative.NewLazyMethodBind("EditorFileDialog", "_multi_selected")
+	methodBindEditorFileDialogX_RecentSelected           = gdnative.NewLazyMethodBind("EditorFileDialog", "_recent_selected")
+	methodBindEditorFileDialogX_SaveConfirmPressed       = gdnative.NewLazyMethodBind("EditorFileDialog", "_save_confirm_pressed")
+	methodBindEditorFileDialogX_SelectDrive              = gdnative.NewLazyMethodBind("EditorFileDialog", "_select_drive")
+	methodBindEditorFileDialogX_ThumbnailDone            = gdnative.NewLazyMethodBind("EditorFileDialog", "_thumbnail_done")
+	methodBindEditorFileDialogX_ThumbnailResult          = gdnative.NewLazyMethodBind("EditorFileDialog", "_thumbnail_result")
+	methodBindEditorFileDialogX_UnhandledInput           = gdnative.NewLazyMethodBind("EditorFileDialog", "_unhandled_input")
+	methodBindEditorFileDialogX_UpdateDir                = gdnative.NewLazyMethodBind("EditorFileDialog", "_update_dir")
+	methodBindEditorFileDialogX_UpdateFileList           = gdnative.NewLazyMethodBind("EditorFileDialog", "_update_file_list")
+	methodBindEditorFileDialogAddFilter                  = gdnative.NewLazyMethodBind("EditorFileDialog", "add_filter")
+	methodBindEditorFileDialogClearFilters               = gdnative.NewLazyMethodBind("EditorFileDialog", "clear_filters")
+	methodBindEditorFileDialogGetAccess                  = gdnative.NewLazyMethodBind("EditorFileDialog", "get_access")
+	methodBindEditorFileDialogGetCurrentDir              = gdnative.NewLazyMethodBind("EditorFileDialog", "get_current_dir")
+	methodBindEditorFileDialogGetCurrentFile             = gdnative.NewLazyMethodBind("EditorFileDialog", "get_current_file")
+	methodBindEditorFileDialogGetCurrentPath             = gdnative.NewLazyMethodBind("EditorFileDialog", "get_current_path")
+	methodBindEditorFileDialogGetDisplayMode             = gdnative.NewLazyMethodBind("EditorFileDialog", "get_display_mode")
+	methodBindEditorFileDialogGetMode                    = gdnative.NewLazyMethodBind("EditorFileDialog", "get_mode")
+	methodBindEditorFileDialogGetVbox                    = gdnative.NewLazyMethodBind("EditorFileDialog", "get_vbox")
+	methodBindEditorFileDialogInvalidate                 = gdnative.NewLazyMethodBind("EditorFileDialog", "invalidate")
+	methodBindEditorFileDialogIsOverwriteWarningDisabled = gdnative.NewLazyMethodBind("EditorFileDialog", "is_overwrite_warning_disabled")
+	methodBindEditorFileDialogIsShowingHiddenFiles       = gdnative.NewLazyMethodBind("EditorFileDialog", "is_showing_hidden_files")
+	methodBindEditorFileDialogSetAccess                  = gdnative.NewLazyMethodBind("EditorFileDialog", "set_access")
+	methodBindEditorFileDialogSetCurrentDir              = gdnative.NewLazyMethodBind("EditorFileDialog", "set_current_dir")
+	methodBindEditorFileDialogSetCurrentFile             = gdnative.NewLazyMethodBind("EditorFileDialog", "set_current_file")
+	methodBindEditorFileDialogSetCurrentPath             = gdnative.NewLazyMethodBind("EditorFileDialog", "set_current_path")
+	methodBindEditorFileDialogSetDisableOverwriteWarning = gdnative.NewLazyMethodBind("EditorFileDialog", "set_disable_overwrite_warning")
+	methodBindEditorFileDialogSetDisplayMode             = gdnative.NewLazyMethodBind("EditorFileDialog", "set_display_mode")
+	methodBindEditorFileDialogSetMode                    = gdnative.NewLazyMethodBind("EditorFileDialog", "set_mode")
+	methodBindEditorFileDialogSetShowHiddenFiles         = gdnative.NewLazyMethodBind("EditorFileDialog", "set_show_hidden_files")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_ActionPressed() {
 	//log.Println("Calling EditorFileDialog.X_ActionPressed()")
@@ -73,7 +217,7 @@ func (o *EditorFileDialog) X_ActionPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_action_pressed")
+	methodBind := methodBindEditorFileDialogX_ActionPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -83,8 +227,8 @@ func (o *EditorFileDialog) X_ActionPressed() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_CancelPressed() {
 	//log.Println("Calling EditorFileDialog.X_CancelPressed()")
@@ -93,7 +237,7 @@ func (o *EditorFileDialog) X_CancelPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_cancel_pressed")
+	methodBind := methodBindEditorFileDialogX_CancelPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -103,8 +247,8 @@ func (o *EditorFileDialog) X_CancelPressed() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *EditorFileDialog) X_DirEntered(arg0 gdnative.String) {
 	//log.Println("Calling EditorFileDialog.X_DirEntered()")
@@ -114,7 +258,7 @@ func (o *EditorFileDialog) X_DirEntered(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_dir_entered")
+	methodBind := methodBindEditorFileDialogX_DirEntered.Get()
 
 	// Call the parent method.
 	// void
@@ -124,8 +268,8 @@ func (o *EditorFileDialog) X_DirEntered(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_FavoriteMoveDown() {
 	//log.Println("Calling EditorFileDialog.X_FavoriteMoveDown()")
@@ -134,7 +278,7 @@ func (o *EditorFileDialog) X_FavoriteMoveDown() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_favorite_move_down")
+	methodBind := methodBindEditorFileDialogX_FavoriteMoveDown.Get()
 
 	// Call the parent method.
 	// void
@@ -144,8 +288,8 @@ func (o *EditorFileDialog) X_FavoriteMoveDown() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_FavoriteMoveUp() {
 	//log.Println("Calling EditorFileDialog.X_FavoriteMoveUp()")
@@ -154,7 +298,7 @@ func (o *EditorFileDialog) X_FavoriteMoveUp() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_favorite_move_up")
+	methodBind := methodBindEditorFileDialogX_FavoriteMoveUp.Get()
 
 	// Call the parent method.
 	// void
@@ -164,8 +308,8 @@ func (o *EditorFileDialog) X_FavoriteMoveUp() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *EditorFileDialog) X_FavoriteSelected(arg0 gdnative.Int) {
 	//log.Println("Calling EditorFileDialog.X_FavoriteSelected()")
@@ -175,7 +319,7 @@ func (o *EditorFileDialog) X_FavoriteSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_favorite_selected")
+	methodBind := methodBindEditorFileDialogX_FavoriteSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -185,8 +329,8 @@ func (o *EditorFileDialog) X_FavoriteSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 bool}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 bool}], Returns: void
 */
 func (o *EditorFileDialog) X_FavoriteToggled(arg0 gdnative.Bool) {
 	//log.Println("Calling EditorFileDialog.X_FavoriteToggled()")
@@ -196,7 +340,7 @@ func (o *EditorFileDialog) X_FavoriteToggled(arg0 gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_favorite_toggled")
+	methodBind := methodBindEditorFileDialogX_FavoriteToggled.Get()
 
 	// Call the parent method.
 	// void
@@ -206,8 +350,8 @@ func (o *EditorFileDialog) X_FavoriteToggled(arg0 gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *EditorFileDialog) X_FileEntered(arg0 gdnative.String) {
 	//log.Println("Calling EditorFileDialog.X_FileEntered()")
@@ -217,7 +361,7 @@ func (o *EditorFileDialog) X_FileEntered(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_file_entered")
+	methodBind := methodBindEditorFileDialogX_FileEntered.Get()
 
 	// Call the parent method.
 	// void
@@ -227,8 +371,8 @@ func (o *EditorFileDialog) X_FileEntered(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *EditorFileDialog) X_FilterSelected(arg0 gdnative.Int) {
 	//log.Println("Calling EditorFileDialog.X_FilterSelected()")
@@ -238,7 +382,7 @@ func (o *EditorFileDialog) X_FilterSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_filter_selected")
+	methodBind := methodBindEditorFileDialogX_FilterSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -248,8 +392,8 @@ func (o *EditorFileDialog) X_FilterSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_GoBack() {
 	//log.Println("Calling EditorFileDialog.X_GoBack()")
@@ -258,7 +402,7 @@ func (o *EditorFileDialog) X_GoBack() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_go_back")
+	methodBind := methodBindEditorFileDialogX_GoBack.Get()
 
 	// Call the parent method.
 	// void
@@ -268,8 +412,8 @@ func (o *EditorFileDialog) X_GoBack() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_GoForward() {
 	//log.Println("Calling EditorFileDialog.X_GoForward()")
@@ -278,7 +422,7 @@ func (o *EditorFileDialog) X_GoForward() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_go_forward")
+	methodBind := methodBindEditorFileDialogX_GoForward.Get()
 
 	// Call the parent method.
 	// void
@@ -288,8 +432,8 @@ func (o *EditorFileDialog) X_GoForward() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_GoUp() {
 	//log.Println("Calling EditorFileDialog.X_GoUp()")
@@ -298,7 +442,7 @@ func (o *EditorFileDialog) X_GoUp() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_go_up")
+	methodBind := methodBindEditorFileDialogX_GoUp.Get()
 
 	// Call the parent method.
 	// void
@@ -308,8 +452,8 @@ func (o *EditorFileDialog) X_GoUp() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *EditorFileDialog) X_ItemDbSelected(arg0 gdnative.Int) {
 	//log.Println("Calling EditorFileDialog.X_ItemDbSelected()")
@@ -319,7 +463,7 @@ func (o *EditorFileDialog) X_ItemDbSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_item_db_selected")
+	methodBind := methodBindEditorFileDialogX_ItemDbSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -329,8 +473,8 @@ func (o *EditorFileDialog) X_ItemDbSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 Vector2}], Returns: void
 */
 func (o *EditorFileDialog) X_ItemListItemRmbSelected(arg0 gdnative.Int, arg1 gdnative.Vector2) {
 	//log.Println("Calling EditorFileDialog.X_ItemListItemRmbSelected()")
@@ -341,7 +485,7 @@ func (o *EditorFileDialog) X_ItemListItemRmbSelected(arg0 gdnative.Int, arg1 gdn
 	ptrArguments[1] = gdnative.NewPointerFromVector2(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_item_list_item_rmb_selected")
+	methodBind := methodBindEditorFileDialogX_ItemListItemRmbSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -351,8 +495,8 @@ func (o *EditorFileDialog) X_ItemListItemRmbSelected(arg0 gdnative.Int, arg1 gdn
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Vector2}], Returns: void
 */
 func (o *EditorFileDialog) X_ItemListRmbClicked(arg0 gdnative.Vector2) {
 	//log.Println("Calling EditorFileDialog.X_ItemListRmbClicked()")
@@ -362,7 +506,7 @@ func (o *EditorFileDialog) X_ItemListRmbClicked(arg0 gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_item_list_rmb_clicked")
+	methodBind := methodBindEditorFileDialogX_ItemListRmbClicked.Get()
 
 	// Call the parent method.
 	// void
@@ -372,8 +516,8 @@ func (o *EditorFileDialog) X_ItemListRmbClicked(arg0 gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *EditorFileDialog) X_ItemMenuIdPressed(arg0 gdnative.Int) {
 	//log.Println("Calling EditorFileDialog.X_ItemMenuIdPressed()")
@@ -383,7 +527,7 @@ func (o *EditorFileDialog) X_ItemMenuIdPressed(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_item_menu_id_pressed")
+	methodBind := methodBindEditorFileDialogX_ItemMenuIdPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -393,8 +537,8 @@ func (o *EditorFileDialog) X_ItemMenuIdPressed(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *EditorFileDialog) X_ItemSelected(arg0 gdnative.Int) {
 	//log.Println("Calling EditorFileDialog.X_ItemSelected()")
@@ -404,7 +548,7 @@ func (o *EditorFileDialog) X_ItemSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_item_selected")
+	methodBind := methodBindEditorFileDialogX_ItemSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -414,8 +558,8 @@ func (o *EditorFileDialog) X_ItemSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_ItemsClearSelection() {
 	//log.Println("Calling EditorFileDialog.X_ItemsClearSelection()")
@@ -424,7 +568,7 @@ func (o *EditorFileDialog) X_ItemsClearSelection() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_items_clear_selection")
+	methodBind := methodBindEditorFileDialogX_ItemsClearSelection.Get()
 
 	// Call the parent method.
 	// void
@@ -434,8 +578,8 @@ func (o *EditorFileDialog) X_ItemsClearSelection() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_MakeDir() {
 	//log.Println("Calling EditorFileDialog.X_MakeDir()")
@@ -444,7 +588,7 @@ func (o *EditorFileDialog) X_MakeDir() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_make_dir")
+	methodBind := methodBindEditorFileDialogX_MakeDir.Get()
 
 	// Call the parent method.
 	// void
@@ -454,8 +598,8 @@ func (o *EditorFileDialog) X_MakeDir() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_MakeDirConfirm() {
 	//log.Println("Calling EditorFileDialog.X_MakeDirConfirm()")
@@ -464,7 +608,7 @@ func (o *EditorFileDialog) X_MakeDirConfirm() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_make_dir_confirm")
+	methodBind := methodBindEditorFileDialogX_MakeDirConfirm.Get()
 
 	// Call the parent method.
 	// void
@@ -474,8 +618,8 @@ func (o *EditorFileDialog) X_MakeDirConfirm() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 bool}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 bool}], Returns: void
 */
 func (o *EditorFileDialog) X_MultiSelected(arg0 gdnative.Int, arg1 gdnative.Bool) {
 	//log.Println("Calling EditorFileDialog.X_MultiSelected()")
@@ -486,7 +630,7 @@ func (o *EditorFileDialog) X_MultiSelected(arg0 gdnative.Int, arg1 gdnative.Bool
 	ptrArguments[1] = gdnative.NewPointerFromBool(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_multi_selected")
+	methodBind := methodBindEditorFileDialogX_MultiSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -496,8 +640,8 @@ func (o *EditorFileDialog) X_MultiSelected(arg0 gdnative.Int, arg1 gdnative.Bool
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *EditorFileDialog) X_RecentSelected(arg0 gdnative.Int) {
 	//log.Println("Calling EditorFileDialog.X_RecentSelected()")
@@ -507,7 +651,7 @@ func (o *EditorFileDialog) X_RecentSelected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_recent_selected")
+	methodBind := methodBindEditorFileDialogX_RecentSelected.Get()
 
 	// Call the parent method.
 	// void
@@ -517,8 +661,8 @@ func (o *EditorFileDialog) X_RecentSelected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_SaveConfirmPressed() {
 	//log.Println("Calling EditorFileDialog.X_SaveConfirmPressed()")
@@ -527,7 +671,7 @@ func (o *EditorFileDialog) X_SaveConfirmPressed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_save_confirm_pressed")
+	methodBind := methodBindEditorFileDialogX_SaveConfirmPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -537,8 +681,8 @@ func (o *EditorFileDialog) X_SaveConfirmPressed() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *EditorFileDialog) X_SelectDrive(arg0 gdnative.Int) {
 	//log.Println("Calling EditorFileDialog.X_SelectDrive()")
@@ -548,7 +692,7 @@ func (o *EditorFileDialog) X_SelectDrive(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_select_drive")
+	methodBind := methodBindEditorFileDialogX_SelectDrive.Get()
 
 	// Call the parent method.
 	// void
@@ -558,20 +702,20 @@ func (o *EditorFileDialog) X_SelectDrive(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String} { false arg1 Texture} { false arg2 Variant}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String} { false arg1 Texture} { false arg2 Variant}], Returns: void
 */
-func (o *EditorFileDialog) X_ThumbnailDone(arg0 gdnative.String, arg1 TextureImplementer, arg2 gdnative.Variant) {
+func (o *EditorFileDialog) X_ThumbnailDone(arg0 gdnative.String, arg1 godot.TextureImplementer, arg2 gdnative.Variant) {
 	//log.Println("Calling EditorFileDialog.X_ThumbnailDone()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromObject(arg1.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg1))
 	ptrArguments[2] = gdnative.NewPointerFromVariant(arg2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_thumbnail_done")
+	methodBind := methodBindEditorFileDialogX_ThumbnailDone.Get()
 
 	// Call the parent method.
 	// void
@@ -581,20 +725,20 @@ func (o *EditorFileDialog) X_ThumbnailDone(arg0 gdnative.String, arg1 TextureImp
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 String} { false arg1 Texture} { false arg2 Variant}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String} { false arg1 Texture} { false arg2 Variant}], Returns: void
 */
-func (o *EditorFileDialog) X_ThumbnailResult(arg0 gdnative.String, arg1 TextureImplementer, arg2 gdnative.Variant) {
+func (o *EditorFileDialog) X_ThumbnailResult(arg0 gdnative.String, arg1 godot.TextureImplementer, arg2 gdnative.Variant) {
 	//log.Println("Calling EditorFileDialog.X_ThumbnailResult()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
-	ptrArguments[1] = gdnative.NewPointerFromObject(arg1.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg1))
 	ptrArguments[2] = gdnative.NewPointerFromVariant(arg2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_thumbnail_result")
+	methodBind := methodBindEditorFileDialogX_ThumbnailResult.Get()
 
 	// Call the parent method.
 	// void
@@ -604,18 +748,18 @@ func (o *EditorFileDialog) X_ThumbnailResult(arg0 gdnative.String, arg1 TextureI
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *EditorFileDialog) X_UnhandledInput(arg0 InputEventImplementer) {
+func (o *EditorFileDialog) X_UnhandledInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling EditorFileDialog.X_UnhandledInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_unhandled_input")
+	methodBind := methodBindEditorFileDialogX_UnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -625,8 +769,8 @@ func (o *EditorFileDialog) X_UnhandledInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_UpdateDir() {
 	//log.Println("Calling EditorFileDialog.X_UpdateDir()")
@@ -635,7 +779,7 @@ func (o *EditorFileDialog) X_UpdateDir() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_update_dir")
+	methodBind := methodBindEditorFileDialogX_UpdateDir.Get()
 
 	// Call the parent method.
 	// void
@@ -645,8 +789,8 @@ func (o *EditorFileDialog) X_UpdateDir() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) X_UpdateFileList() {
 	//log.Println("Calling EditorFileDialog.X_UpdateFileList()")
@@ -655,7 +799,7 @@ func (o *EditorFileDialog) X_UpdateFileList() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "_update_file_list")
+	methodBind := methodBindEditorFileDialogX_UpdateFileList.Get()
 
 	// Call the parent method.
 	// void
@@ -665,8 +809,8 @@ func (o *EditorFileDialog) X_UpdateFileList() {
 }
 
 /*
-        Adds a comma-delimited file extension filter option to the [code]EditorFileDialog[/code] with an optional semi-colon-delimited label. Example: "*.tscn, *.scn; Scenes", results in filter text "Scenes (*.tscn, *.scn)".
-	Args: [{ false filter String}], Returns: void
+	        Adds a comma-delimited file extension filter option to the [code]EditorFileDialog[/code] with an optional semi-colon-delimited label. Example: "*.tscn, *.scn; Scenes", results in filter text "Scenes (*.tscn, *.scn)".
+		Args: [{ false filter String}], Returns: void
 */
 func (o *EditorFileDialog) AddFilter(filter gdnative.String) {
 	//log.Println("Calling EditorFileDialog.AddFilter()")
@@ -676,7 +820,7 @@ func (o *EditorFileDialog) AddFilter(filter gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(filter)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "add_filter")
+	methodBind := methodBindEditorFileDialogAddFilter.Get()
 
 	// Call the parent method.
 	// void
@@ -686,8 +830,8 @@ func (o *EditorFileDialog) AddFilter(filter gdnative.String) {
 }
 
 /*
-        Removes all filters except for "All Files (*)".
-	Args: [], Returns: void
+	        Removes all filters except for "All Files (*)".
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) ClearFilters() {
 	//log.Println("Calling EditorFileDialog.ClearFilters()")
@@ -696,7 +840,7 @@ func (o *EditorFileDialog) ClearFilters() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "clear_filters")
+	methodBind := methodBindEditorFileDialogClearFilters.Get()
 
 	// Call the parent method.
 	// void
@@ -706,8 +850,8 @@ func (o *EditorFileDialog) ClearFilters() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.EditorFileDialog::Access
+	        Undocumented
+		Args: [], Returns: enum.EditorFileDialog::Access
 */
 func (o *EditorFileDialog) GetAccess() EditorFileDialogAccess {
 	//log.Println("Calling EditorFileDialog.GetAccess()")
@@ -716,7 +860,7 @@ func (o *EditorFileDialog) GetAccess() EditorFileDialogAccess {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "get_access")
+	methodBind := methodBindEditorFileDialogGetAccess.Get()
 
 	// Call the parent method.
 	// enum.EditorFileDialog::Access
@@ -729,8 +873,8 @@ func (o *EditorFileDialog) GetAccess() EditorFileDialogAccess {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *EditorFileDialog) GetCurrentDir() gdnative.String {
 	//log.Println("Calling EditorFileDialog.GetCurrentDir()")
@@ -739,7 +883,7 @@ func (o *EditorFileDialog) GetCurrentDir() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "get_current_dir")
+	methodBind := methodBindEditorFileDialogGetCurrentDir.Get()
 
 	// Call the parent method.
 	// String
@@ -752,8 +896,8 @@ func (o *EditorFileDialog) GetCurrentDir() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *EditorFileDialog) GetCurrentFile() gdnative.String {
 	//log.Println("Calling EditorFileDialog.GetCurrentFile()")
@@ -762,7 +906,7 @@ func (o *EditorFileDialog) GetCurrentFile() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "get_current_file")
+	methodBind := methodBindEditorFileDialogGetCurrentFile.Get()
 
 	// Call the parent method.
 	// String
@@ -775,8 +919,8 @@ func (o *EditorFileDialog) GetCurrentFile() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *EditorFileDialog) GetCurrentPath() gdnative.String {
 	//log.Println("Calling EditorFileDialog.GetCurrentPath()")
@@ -785,7 +929,7 @@ func (o *EditorFileDialog) GetCurrentPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "get_current_path")
+	methodBind := methodBindEditorFileDialogGetCurrentPath.Get()
 
 	// Call the parent method.
 	// String
@@ -798,8 +942,8 @@ func (o *EditorFileDialog) GetCurrentPath() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.EditorFileDialog::DisplayMode
+	        Undocumented
+		Args: [], Returns: enum.EditorFileDialog::DisplayMode
 */
 func (o *EditorFileDialog) GetDisplayMode() EditorFileDialogDisplayMode {
 	//log.Println("Calling EditorFileDialog.GetDisplayMode()")
@@ -808,7 +952,7 @@ func (o *EditorFileDialog) GetDisplayMode() EditorFileDialogDisplayMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "get_display_mode")
+	methodBind := methodBindEditorFileDialogGetDisplayMode.Get()
 
 	// Call the parent method.
 	// enum.EditorFileDialog::DisplayMode
@@ -821,8 +965,8 @@ func (o *EditorFileDialog) GetDisplayMode() EditorFileDialogDisplayMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.EditorFileDialog::Mode
+	        Undocumented
+		Args: [], Returns: enum.EditorFileDialog::Mode
 */
 func (o *EditorFileDialog) GetMode() EditorFileDialogMode {
 	//log.Println("Calling EditorFileDialog.GetMode()")
@@ -831,7 +975,7 @@ func (o *EditorFileDialog) GetMode() EditorFileDialogMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "get_mode")
+	methodBind := methodBindEditorFileDialogGetMode.Get()
 
 	// Call the parent method.
 	// enum.EditorFileDialog::Mode
@@ -844,17 +988,17 @@ func (o *EditorFileDialog) GetMode() EditorFileDialogMode {
 }
 
 /*
-        Returns the [code]VBoxContainer[/code] used to display the file system.
-	Args: [], Returns: VBoxContainer
+	        Returns the [code]VBoxContainer[/code] used to display the file system.
+		Args: [], Returns: VBoxContainer
 */
-func (o *EditorFileDialog) GetVbox() VBoxContainerImplementer {
+func (o *EditorFileDialog) GetVbox() control.VBoxContainerImplementer {
 	//log.Println("Calling EditorFileDialog.GetVbox()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "get_vbox")
+	methodBind := methodBindEditorFileDialogGetVbox.Get()
 
 	// Call the parent method.
 	// VBoxContainer
@@ -862,27 +1006,25 @@ func (o *EditorFileDialog) GetVbox() VBoxContainerImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newVBoxContainerFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(VBoxContainerImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "VBoxContainer" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(VBoxContainerImplementer)
+	ret := control.VBoxContainer{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(control.VBoxContainerImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Notify the [code]EditorFileDialog[/code] that its view of the data is no longer accurate. Updates the view contents on next view update.
-	Args: [], Returns: void
+	        Notify the [code]EditorFileDialog[/code] that its view of the data is no longer accurate. Updates the view contents on next view update.
+		Args: [], Returns: void
 */
 func (o *EditorFileDialog) Invalidate() {
 	//log.Println("Calling EditorFileDialog.Invalidate()")
@@ -891,7 +1033,7 @@ func (o *EditorFileDialog) Invalidate() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "invalidate")
+	methodBind := methodBindEditorFileDialogInvalidate.Get()
 
 	// Call the parent method.
 	// void
@@ -901,8 +1043,8 @@ func (o *EditorFileDialog) Invalidate() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *EditorFileDialog) IsOverwriteWarningDisabled() gdnative.Bool {
 	//log.Println("Calling EditorFileDialog.IsOverwriteWarningDisabled()")
@@ -911,7 +1053,7 @@ func (o *EditorFileDialog) IsOverwriteWarningDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "is_overwrite_warning_disabled")
+	methodBind := methodBindEditorFileDialogIsOverwriteWarningDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -924,8 +1066,8 @@ func (o *EditorFileDialog) IsOverwriteWarningDisabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *EditorFileDialog) IsShowingHiddenFiles() gdnative.Bool {
 	//log.Println("Calling EditorFileDialog.IsShowingHiddenFiles()")
@@ -934,7 +1076,7 @@ func (o *EditorFileDialog) IsShowingHiddenFiles() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "is_showing_hidden_files")
+	methodBind := methodBindEditorFileDialogIsShowingHiddenFiles.Get()
 
 	// Call the parent method.
 	// bool
@@ -947,18 +1089,18 @@ func (o *EditorFileDialog) IsShowingHiddenFiles() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false access int}], Returns: void
+	        Undocumented
+		Args: [{ false access enum.EditorFileDialog::Access}], Returns: void
 */
-func (o *EditorFileDialog) SetAccess(access gdnative.Int) {
+func (o *EditorFileDialog) SetAccess(access EditorFileDialogAccess) {
 	//log.Println("Calling EditorFileDialog.SetAccess()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(access)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(access))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_access")
+	methodBind := methodBindEditorFileDialogSetAccess.Get()
 
 	// Call the parent method.
 	// void
@@ -968,8 +1110,8 @@ func (o *EditorFileDialog) SetAccess(access gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false dir String}], Returns: void
+	        Undocumented
+		Args: [{ false dir String}], Returns: void
 */
 func (o *EditorFileDialog) SetCurrentDir(dir gdnative.String) {
 	//log.Println("Calling EditorFileDialog.SetCurrentDir()")
@@ -979,7 +1121,7 @@ func (o *EditorFileDialog) SetCurrentDir(dir gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(dir)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_current_dir")
+	methodBind := methodBindEditorFileDialogSetCurrentDir.Get()
 
 	// Call the parent method.
 	// void
@@ -989,8 +1131,8 @@ func (o *EditorFileDialog) SetCurrentDir(dir gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false file String}], Returns: void
+	        Undocumented
+		Args: [{ false file String}], Returns: void
 */
 func (o *EditorFileDialog) SetCurrentFile(file gdnative.String) {
 	//log.Println("Calling EditorFileDialog.SetCurrentFile()")
@@ -1000,7 +1142,7 @@ func (o *EditorFileDialog) SetCurrentFile(file gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_current_file")
+	methodBind := methodBindEditorFileDialogSetCurrentFile.Get()
 
 	// Call the parent method.
 	// void
@@ -1010,8 +1152,8 @@ func (o *EditorFileDialog) SetCurrentFile(file gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: void
+	        Undocumented
+		Args: [{ false path String}], Returns: void
 */
 func (o *EditorFileDialog) SetCurrentPath(path gdnative.String) {
 	//log.Println("Calling EditorFileDialog.SetCurrentPath()")
@@ -1021,7 +1163,7 @@ func (o *EditorFileDialog) SetCurrentPath(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_current_path")
+	methodBind := methodBindEditorFileDialogSetCurrentPath.Get()
 
 	// Call the parent method.
 	// void
@@ -1031,8 +1173,8 @@ func (o *EditorFileDialog) SetCurrentPath(path gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false disable bool}], Returns: void
+	        Undocumented
+		Args: [{ false disable bool}], Returns: void
 */
 func (o *EditorFileDialog) SetDisableOverwriteWarning(disable gdnative.Bool) {
 	//log.Println("Calling EditorFileDialog.SetDisableOverwriteWarning()")
@@ -1042,7 +1184,7 @@ func (o *EditorFileDialog) SetDisableOverwriteWarning(disable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_disable_overwrite_warning")
+	methodBind := methodBindEditorFileDialogSetDisableOverwriteWarning.Get()
 
 	// Call the parent method.
 	// void
@@ -1052,18 +1194,18 @@ func (o *EditorFileDialog) SetDisableOverwriteWarning(disable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.EditorFileDialog::DisplayMode}], Returns: void
 */
-func (o *EditorFileDialog) SetDisplayMode(mode gdnative.Int) {
+func (o *EditorFileDialog) SetDisplayMode(mode EditorFileDialogDisplayMode) {
 	//log.Println("Calling EditorFileDialog.SetDisplayMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_display_mode")
+	methodBind := methodBindEditorFileDialogSetDisplayMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1073,18 +1215,18 @@ func (o *EditorFileDialog) SetDisplayMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.EditorFileDialog::Mode}], Returns: void
 */
-func (o *EditorFileDialog) SetMode(mode gdnative.Int) {
+func (o *EditorFileDialog) SetMode(mode EditorFileDialogMode) {
 	//log.Println("Calling EditorFileDialog.SetMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_mode")
+	methodBind := methodBindEditorFileDialogSetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1094,8 +1236,8 @@ func (o *EditorFileDialog) SetMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false show bool}], Returns: void
+	        Undocumented
+		Args: [{ false show bool}], Returns: void
 */
 func (o *EditorFileDialog) SetShowHiddenFiles(show gdnative.Bool) {
 	//log.Println("Calling EditorFileDialog.SetShowHiddenFiles()")
@@ -1105,7 +1247,7 @@ func (o *EditorFileDialog) SetShowHiddenFiles(show gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(show)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileDialog", "set_show_hidden_files")
+	methodBind := methodBindEditorFileDialogSetShowHiddenFiles.Get()
 
 	// Call the parent method.
 	// void
@@ -1114,10 +1256,126 @@ func (o *EditorFileDialog) SetShowHiddenFiles(show gdnative.Bool) {
 
 }
 
+// Access will return the value of the "access" property.
+func (o *EditorFileDialog) Access() EditorFileDialogAccess {
+	return o.GetAccess()
+}
+
+// CurrentDir will return the value of the "current_dir" property.
+func (o *EditorFileDialog) CurrentDir() gdnative.String {
+	return o.GetCurrentDir()
+}
+
+// CurrentFile will return the value of the "current_file" property.
+func (o *EditorFileDialog) CurrentFile() gdnative.String {
+	return o.GetCurrentFile()
+}
+
+// CurrentPath will return the value of the "current_path" property.
+func (o *EditorFileDialog) CurrentPath() gdnative.String {
+	return o.GetCurrentPath()
+}
+
+// DisableOverwriteWarning will return the value of the "disable_overwrite_warning" property.
+func (o *EditorFileDialog) DisableOverwriteWarning() gdnative.Bool {
+	return o.IsOverwriteWarningDisabled()
+}
+
+// DisplayMode will return the value of the "display_mode" property.
+func (o *EditorFileDialog) DisplayMode() EditorFileDialogDisplayMode {
+	return o.GetDisplayMode()
+}
+
+// Mode will return the value of the "mode" property.
+func (o *EditorFileDialog) Mode() EditorFileDialogMode {
+	return o.GetMode()
+}
+
+// ShowHiddenFiles will return the value of the "show_hidden_files" property.
+func (o *EditorFileDialog) ShowHiddenFiles() gdnative.Bool {
+	return o.IsShowingHiddenFiles()
+}
+
+// Signals of the EditorFileDialog class.
+const (
+	EditorFileDialogSignalDirSelected   gdnative.String = "dir_selected"
+	EditorFileDialogSignalFileSelected  gdnative.String = "file_selected"
+	EditorFileDialogSignalFilesSelected gdnative.String = "files_selected"
+)
+
+// EditorFileDialogDirSelectedArgs holds the arguments of the "dir_selected" signal.
+type EditorFileDialogDirSelectedArgs struct {
+	Dir gdnative.String
+}
+
+// ConnectDirSelected will connect the "dir_selected" signal to the given method of the target object.
+func (o *EditorFileDialog) ConnectDirSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorFileDialogSignalDirSelected, target, method, binds, 0)
+}
+
+// EmitDirSelected will emit the "dir_selected" signal.
+func (o *EditorFileDialog) EmitDirSelected(args EditorFileDialogDirSelectedArgs) error {
+	arg0 := gdnative.NewVariantString(args.Dir)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorFileDialogSignalDirSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorFileDialogFileSelectedArgs holds the arguments of the "file_selected" signal.
+type EditorFileDialogFileSelectedArgs struct {
+	Path gdnative.String
+}
+
+// ConnectFileSelected will connect the "file_selected" signal to the given method of the target object.
+func (o *EditorFileDialog) ConnectFileSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorFileDialogSignalFileSelected, target, method, binds, 0)
+}
+
+// EmitFileSelected will emit the "file_selected" signal.
+func (o *EditorFileDialog) EmitFileSelected(args EditorFileDialogFileSelectedArgs) error {
+	arg0 := gdnative.NewVariantString(args.Path)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorFileDialogSignalFileSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorFileDialogFilesSelectedArgs holds the arguments of the "files_selected" signal.
+type EditorFileDialogFilesSelectedArgs struct {
+	Paths gdnative.PoolStringArray
+}
+
+// ConnectFilesSelected will connect the "files_selected" signal to the given method of the target object.
+func (o *EditorFileDialog) ConnectFilesSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorFileDialogSignalFilesSelected, target, method, binds, 0)
+}
+
+// EmitFilesSelected will emit the "files_selected" signal.
+func (o *EditorFileDialog) EmitFilesSelected(args EditorFileDialogFilesSelectedArgs) error {
+	arg0 := gdnative.NewVariantPoolStringArray(args.Paths)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorFileDialogSignalFilesSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
 // EditorFileDialogImplementer is an interface that implements the methods
 // of the EditorFileDialog class.
 type EditorFileDialogImplementer interface {
-	ConfirmationDialogImplementer
+	control.ConfirmationDialogImplementer
 	X_ActionPressed()
 	X_CancelPressed()
 	X_DirEntered(arg0 gdnative.String)
@@ -1142,25 +1400,42 @@ type EditorFileDialogImplementer interface {
 	X_RecentSelected(arg0 gdnative.Int)
 	X_SaveConfirmPressed()
 	X_SelectDrive(arg0 gdnative.Int)
-	X_ThumbnailDone(arg0 gdnative.String, arg1 TextureImplementer, arg2 gdnative.Variant)
-	X_ThumbnailResult(arg0 gdnative.String, arg1 TextureImplementer, arg2 gdnative.Variant)
+	X_ThumbnailDone(arg0 gdnative.String, arg1 godot.TextureImplementer, arg2 gdnative.Variant)
+	X_ThumbnailResult(arg0 gdnative.String, arg1 godot.TextureImplementer, arg2 gdnative.Variant)
 	X_UpdateDir()
 	X_UpdateFileList()
 	AddFilter(filter gdnative.String)
 	ClearFilters()
+	GetAccess() EditorFileDialogAccess
 	GetCurrentDir() gdnative.String
 	GetCurrentFile() gdnative.String
 	GetCurrentPath() gdnative.String
-	GetVbox() VBoxContainerImplementer
+	GetDisplayMode() EditorFileDialogDisplayMode
+	GetMode() EditorFileDialogMode
+	GetVbox() control.VBoxContainerImplementer
 	Invalidate()
 	IsOverwriteWarningDisabled() gdnative.Bool
 	IsShowingHiddenFiles() gdnative.Bool
-	SetAccess(access gdnative.Int)
+	SetAccess(access EditorFileDialogAccess)
 	SetCurrentDir(dir gdnative.String)
 	SetCurrentFile(file gdnative.String)
 	SetCurrentPath(path gdnative.String)
 	SetDisableOverwriteWarning(disable gdnative.Bool)
-	SetDisplayMode(mode gdnative.Int)
-	SetMode(mode gdnative.Int)
+	SetDisplayMode(mode EditorFileDialogDisplayMode)
+	SetMode(mode EditorFileDialogMode)
 	SetShowHiddenFiles(show gdnative.Bool)
+	Access() EditorFileDialogAccess
+	CurrentDir() gdnative.String
+	CurrentFile() gdnative.String
+	CurrentPath() gdnative.String
+	DisableOverwriteWarning() gdnative.Bool
+	DisplayMode() EditorFileDialogDisplayMode
+	Mode() EditorFileDialogMode
+	ShowHiddenFiles() gdnative.Bool
+	ConnectDirSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitDirSelected(args EditorFileDialogDirSelectedArgs) error
+	ConnectFileSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFileSelected(args EditorFileDialogFileSelectedArgs) error
+	ConnectFilesSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFilesSelected(args EditorFileDialogFilesSelectedArgs) error
 }
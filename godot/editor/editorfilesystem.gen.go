@@ -0,0 +1,331 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+This object holds information of all resources in the filesystem, their types, etc.
+*/
+type EditorFileSystem struct {
+	godot.Node
+	owner gdnative.Object
+}
+
+func (o *EditorFileSystem) BaseClass() string {
+	return "EditorFileSystem"
+}
+
+// Method binds of the EditorFileSystem class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorFileSystemGetFileType         = gdnative.NewLazyMethodBind("EditorFileSystem", "get_file_type")
+	methodBindEditorFileSystemGetFilesystem       = gdnative.NewLazyMethodBind("EditorFileSystem", "get_filesystem")
+	methodBindEditorFileSystemGetFilesystemPath   = gdnative.NewLazyMethodBind("EditorFileSystem", "get_filesystem_path")
+	methodBindEditorFileSystemGetScanningProgress = gdnative.NewLazyMethodBind("EditorFileSystem", "get_scanning_progress")
+	methodBindEditorFileSystemIsScanning          = gdnative.NewLazyMethodBind("EditorFileSystem", "is_scanning")
+	methodBindEditorFileSystemScan                = gdnative.NewLazyMethodBind("EditorFileSystem", "scan")
+	methodBindEditorFileSystemScanSources         = gdnative.NewLazyMethodBind("EditorFileSystem", "scan_sources")
+	methodBindEditorFileSystemUpdateFile          = gdnative.NewLazyMethodBind("EditorFileSystem", "update_file")
+)
+
+/*
+	        Get the type of the file, given the full path.
+		Args: [{ false path String}], Returns: String
+*/
+func (o *EditorFileSystem) GetFileType(path gdnative.String) gdnative.String {
+	//log.Println("Calling EditorFileSystem.GetFileType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemGetFileType.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Get the root directory object.
+		Args: [], Returns: EditorFileSystemDirectory
+*/
+func (o *EditorFileSystem) GetFilesystem() EditorFileSystemDirectoryImplementer {
+	//log.Println("Calling EditorFileSystem.GetFilesystem()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemGetFilesystem.Get()
+
+	// Call the parent method.
+	// EditorFileSystemDirectory
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := EditorFileSystemDirectory{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorFileSystemDirectoryImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns a view into the filesystem at [code]path[/code].
+		Args: [{ false path String}], Returns: EditorFileSystemDirectory
+*/
+func (o *EditorFileSystem) GetFilesystemPath(path gdnative.String) EditorFileSystemDirectoryImplementer {
+	//log.Println("Calling EditorFileSystem.GetFilesystemPath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemGetFilesystemPath.Get()
+
+	// Call the parent method.
+	// EditorFileSystemDirectory
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := EditorFileSystemDirectory{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorFileSystemDirectoryImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Return the scan progress for 0 to 1 if the FS is being scanned.
+		Args: [], Returns: float
+*/
+func (o *EditorFileSystem) GetScanningProgress() gdnative.Real {
+	//log.Println("Calling EditorFileSystem.GetScanningProgress()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemGetScanningProgress.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Return true of the filesystem is being scanned.
+		Args: [], Returns: bool
+*/
+func (o *EditorFileSystem) IsScanning() gdnative.Bool {
+	//log.Println("Calling EditorFileSystem.IsScanning()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemIsScanning.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Scan the filesystem for changes.
+		Args: [], Returns: void
+*/
+func (o *EditorFileSystem) Scan() {
+	//log.Println("Calling EditorFileSystem.Scan()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemScan.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Check if the source of any imported resource changed.
+		Args: [], Returns: void
+*/
+func (o *EditorFileSystem) ScanSources() {
+	//log.Println("Calling EditorFileSystem.ScanSources()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemScanSources.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Update a file information. Call this if an external program (not Godot) modified the file.
+		Args: [{ false path String}], Returns: void
+*/
+func (o *EditorFileSystem) UpdateFile(path gdnative.String) {
+	//log.Println("Calling EditorFileSystem.UpdateFile()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindEditorFileSystemUpdateFile.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Signals of the EditorFileSystem class.
+const (
+	EditorFileSystemSignalFilesystemChanged   gdnative.String = "filesystem_changed"
+	EditorFileSystemSignalResourcesReimported gdnative.String = "resources_reimported"
+	EditorFileSystemSignalSourcesChanged      gdnative.String = "sources_changed"
+)
+
+// ConnectFilesystemChanged will connect the "filesystem_changed" signal to the given method of the target object.
+func (o *EditorFileSystem) ConnectFilesystemChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorFileSystemSignalFilesystemChanged, target, method, binds, 0)
+}
+
+// EmitFilesystemChanged will emit the "filesystem_changed" signal.
+func (o *EditorFileSystem) EmitFilesystemChanged() error {
+
+	ret, err := o.EmitSignal(EditorFileSystemSignalFilesystemChanged)
+	ret.Destroy()
+	return err
+}
+
+// EditorFileSystemResourcesReimportedArgs holds the arguments of the "resources_reimported" signal.
+type EditorFileSystemResourcesReimportedArgs struct {
+	Resources gdnative.PoolStringArray
+}
+
+// ConnectResourcesReimported will connect the "resources_reimported" signal to the given method of the target object.
+func (o *EditorFileSystem) ConnectResourcesReimported(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorFileSystemSignalResourcesReimported, target, method, binds, 0)
+}
+
+// EmitResourcesReimported will emit the "resources_reimported" signal.
+func (o *EditorFileSystem) EmitResourcesReimported(args EditorFileSystemResourcesReimportedArgs) error {
+	arg0 := gdnative.NewVariantPoolStringArray(args.Resources)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorFileSystemSignalResourcesReimported, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorFileSystemSourcesChangedArgs holds the arguments of the "sources_changed" signal.
+type EditorFileSystemSourcesChangedArgs struct {
+	Exist gdnative.Bool
+}
+
+// ConnectSourcesChanged will connect the "sources_changed" signal to the given method of the target object.
+func (o *EditorFileSystem) ConnectSourcesChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorFileSystemSignalSourcesChanged, target, method, binds, 0)
+}
+
+// EmitSourcesChanged will emit the "sources_changed" signal.
+func (o *EditorFileSystem) EmitSourcesChanged(args EditorFileSystemSourcesChangedArgs) error {
+	arg0 := gdnative.NewVariantBool(args.Exist)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorFileSystemSignalSourcesChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorFileSystemImplementer is an interface that implements the methods
+// of the EditorFileSystem class.
+type EditorFileSystemImplementer interface {
+	godot.NodeImplementer
+	GetFileType(path gdnative.String) gdnative.String
+	GetFilesystem() EditorFileSystemDirectoryImplementer
+	GetFilesystemPath(path gdnative.String) EditorFileSystemDirectoryImplementer
+	GetScanningProgress() gdnative.Real
+	IsScanning() gdnative.Bool
+	Scan()
+	ScanSources()
+	UpdateFile(path gdnative.String)
+	ConnectFilesystemChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFilesystemChanged() error
+	ConnectResourcesReimported(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitResourcesReimported(args EditorFileSystemResourcesReimportedArgs) error
+	ConnectSourcesChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSourcesChanged(args EditorFileSystemSourcesChangedArgs) error
+}
@@ -1,7 +1,8 @@
-package godot
+package editor
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,52 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewEditorFileSystemDirectoryFromPointer(ptr gdnative.Pointer) EditorFileSystemDirectory {
-func newEditorFileSystemDirectoryFromPointer(ptr gdnative.Pointer) EditorFileSystemDirectory {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EditorFileSystemDirectory{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A more generalized, low-level variation of the directory concept.
 */
 type EditorFileSystemDirectory struct {
-	Object
+	godot.Object
 	owner gdnative.Object
 }
 
+// classConstructorEditorFileSystemDirectory is the class constructor of the EditorFileSystemDirectory class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorFileSystemDirectory = gdnative.NewLazyClassConstructor("EditorFileSystemDirectory")
+
+// NewEditorFileSystemDirectory will create a new instance of the EditorFileSystemDirectory class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewEditorFileSystemDirectory() *EditorFileSystemDirectory {
+	obj := &EditorFileSystemDirectory{}
+	obj.SetBaseObject(classConstructorEditorFileSystemDirectory.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *EditorFileSystemDirectory) BaseClass() string {
 	return "EditorFileSystemDirectory"
 }
 
+// Method binds of the EditorFileSystemDirectory class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorFileSystemDirectoryFindDirIndex         = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "find_dir_index")
+	methodBindEditorFileSystemDirectoryFindFileIndex        = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "find_file_index")
+	methodBindEditorFileSystemDirectoryGetFile              = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_file")
+	methodBindEditorFileSystemDirectoryGetFileCount         = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_file_count")
+	methodBindEditorFileSystemDirectoryGetFileImportIsValid = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_file_import_is_valid")
+	methodBindEditorFileSystemDirectoryGetFilePath          = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_file_path")
+	methodBindEditorFileSystemDirectoryGetFileType          = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_file_type")
+	methodBindEditorFileSystemDirectoryGetName              = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_name")
+	methodBindEditorFileSystemDirectoryGetParent            = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_parent")
+	methodBindEditorFileSystemDirectoryGetPath              = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_path")
+	methodBindEditorFileSystemDirectoryGetSubdir            = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_subdir")
+	methodBindEditorFileSystemDirectoryGetSubdirCount       = gdnative.NewLazyMethodBind("EditorFileSystemDirectory", "get_subdir_count")
+)
+
 /*
-        Returns the index of the directory with name [code]name[/code] or [code]-1[/code] if not found.
-	Args: [{ false name String}], Returns: int
+	        Returns the index of the directory with name [code]name[/code] or [code]-1[/code] if not found.
+		Args: [{ false name String}], Returns: int
 */
 func (o *EditorFileSystemDirectory) FindDirIndex(name gdnative.String) gdnative.Int {
 	//log.Println("Calling EditorFileSystemDirectory.FindDirIndex()")
@@ -46,7 +69,7 @@ func (o *EditorFileSystemDirectory) FindDirIndex(name gdnative.String) gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "find_dir_index")
+	methodBind := methodBindEditorFileSystemDirectoryFindDirIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -59,8 +82,8 @@ func (o *EditorFileSystemDirectory) FindDirIndex(name gdnative.String) gdnative.
 }
 
 /*
-        Returns the index of the file with name [code]name[/code] or [code]-1[/code] if not found.
-	Args: [{ false name String}], Returns: int
+	        Returns the index of the file with name [code]name[/code] or [code]-1[/code] if not found.
+		Args: [{ false name String}], Returns: int
 */
 func (o *EditorFileSystemDirectory) FindFileIndex(name gdnative.String) gdnative.Int {
 	//log.Println("Calling EditorFileSystemDirectory.FindFileIndex()")
@@ -70,7 +93,7 @@ func (o *EditorFileSystemDirectory) FindFileIndex(name gdnative.String) gdnative
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "find_file_index")
+	methodBind := methodBindEditorFileSystemDirectoryFindFileIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -83,8 +106,8 @@ func (o *EditorFileSystemDirectory) FindFileIndex(name gdnative.String) gdnative
 }
 
 /*
-        Returns the name of the file at index [code]idx[/code].
-	Args: [{ false idx int}], Returns: String
+	        Returns the name of the file at index [code]idx[/code].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *EditorFileSystemDirectory) GetFile(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling EditorFileSystemDirectory.GetFile()")
@@ -94,7 +117,7 @@ func (o *EditorFileSystemDirectory) GetFile(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_file")
+	methodBind := methodBindEditorFileSystemDirectoryGetFile.Get()
 
 	// Call the parent method.
 	// String
@@ -107,8 +130,8 @@ func (o *EditorFileSystemDirectory) GetFile(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the number of files in this directory.
-	Args: [], Returns: int
+	        Returns the number of files in this directory.
+		Args: [], Returns: int
 */
 func (o *EditorFileSystemDirectory) GetFileCount() gdnative.Int {
 	//log.Println("Calling EditorFileSystemDirectory.GetFileCount()")
@@ -117,7 +140,7 @@ func (o *EditorFileSystemDirectory) GetFileCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_file_count")
+	methodBind := methodBindEditorFileSystemDirectoryGetFileCount.Get()
 
 	// Call the parent method.
 	// int
@@ -130,8 +153,8 @@ func (o *EditorFileSystemDirectory) GetFileCount() gdnative.Int {
 }
 
 /*
-        Returns [code]true[/code] if the file at index [code]idx[/code] imported properly.
-	Args: [{ false idx int}], Returns: bool
+	        Returns [code]true[/code] if the file at index [code]idx[/code] imported properly.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *EditorFileSystemDirectory) GetFileImportIsValid(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling EditorFileSystemDirectory.GetFileImportIsValid()")
@@ -141,7 +164,7 @@ func (o *EditorFileSystemDirectory) GetFileImportIsValid(idx gdnative.Int) gdnat
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_file_import_is_valid")
+	methodBind := methodBindEditorFileSystemDirectoryGetFileImportIsValid.Get()
 
 	// Call the parent method.
 	// bool
@@ -154,8 +177,8 @@ func (o *EditorFileSystemDirectory) GetFileImportIsValid(idx gdnative.Int) gdnat
 }
 
 /*
-        Returns the path to the file at index [code]idx[/code].
-	Args: [{ false idx int}], Returns: String
+	        Returns the path to the file at index [code]idx[/code].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *EditorFileSystemDirectory) GetFilePath(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling EditorFileSystemDirectory.GetFilePath()")
@@ -165,7 +188,7 @@ func (o *EditorFileSystemDirectory) GetFilePath(idx gdnative.Int) gdnative.Strin
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_file_path")
+	methodBind := methodBindEditorFileSystemDirectoryGetFilePath.Get()
 
 	// Call the parent method.
 	// String
@@ -178,8 +201,8 @@ func (o *EditorFileSystemDirectory) GetFilePath(idx gdnative.Int) gdnative.Strin
 }
 
 /*
-        Returns the file extension of the file at index [code]idx[/code].
-	Args: [{ false idx int}], Returns: String
+	        Returns the file extension of the file at index [code]idx[/code].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *EditorFileSystemDirectory) GetFileType(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling EditorFileSystemDirectory.GetFileType()")
@@ -189,7 +212,7 @@ func (o *EditorFileSystemDirectory) GetFileType(idx gdnative.Int) gdnative.Strin
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_file_type")
+	methodBind := methodBindEditorFileSystemDirectoryGetFileType.Get()
 
 	// Call the parent method.
 	// String
@@ -202,8 +225,8 @@ func (o *EditorFileSystemDirectory) GetFileType(idx gdnative.Int) gdnative.Strin
 }
 
 /*
-        Returns the name of this directory.
-	Args: [], Returns: String
+	        Returns the name of this directory.
+		Args: [], Returns: String
 */
 func (o *EditorFileSystemDirectory) GetName() gdnative.String {
 	//log.Println("Calling EditorFileSystemDirectory.GetName()")
@@ -212,7 +235,7 @@ func (o *EditorFileSystemDirectory) GetName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_name")
+	methodBind := methodBindEditorFileSystemDirectoryGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -225,8 +248,8 @@ func (o *EditorFileSystemDirectory) GetName() gdnative.String {
 }
 
 /*
-        Returns the parent directory for this directory or null if called on a directory at [code]res://[/code] or [code]user://[/code].
-	Args: [], Returns: EditorFileSystemDirectory
+	        Returns the parent directory for this directory or null if called on a directory at [code]res://[/code] or [code]user://[/code].
+		Args: [], Returns: EditorFileSystemDirectory
 */
 func (o *EditorFileSystemDirectory) GetParent() EditorFileSystemDirectoryImplementer {
 	//log.Println("Calling EditorFileSystemDirectory.GetParent()")
@@ -235,7 +258,7 @@ func (o *EditorFileSystemDirectory) GetParent() EditorFileSystemDirectoryImpleme
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_parent")
+	methodBind := methodBindEditorFileSystemDirectoryGetParent.Get()
 
 	// Call the parent method.
 	// EditorFileSystemDirectory
@@ -243,27 +266,25 @@ func (o *EditorFileSystemDirectory) GetParent() EditorFileSystemDirectoryImpleme
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEditorFileSystemDirectoryFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EditorFileSystemDirectoryImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "EditorFileSystemDirectory" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EditorFileSystemDirectoryImplementer)
+	ret := EditorFileSystemDirectory{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorFileSystemDirectoryImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the path to this directory.
-	Args: [], Returns: String
+	        Returns the path to this directory.
+		Args: [], Returns: String
 */
 func (o *EditorFileSystemDirectory) GetPath() gdnative.String {
 	//log.Println("Calling EditorFileSystemDirectory.GetPath()")
@@ -272,7 +293,7 @@ func (o *EditorFileSystemDirectory) GetPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_path")
+	methodBind := methodBindEditorFileSystemDirectoryGetPath.Get()
 
 	// Call the parent method.
 	// String
@@ -285,8 +306,8 @@ func (o *EditorFileSystemDirectory) GetPath() gdnative.String {
 }
 
 /*
-        Returns the subdirectory at index [code]idx[/code].
-	Args: [{ false idx int}], Returns: EditorFileSystemDirectory
+	        Returns the subdirectory at index [code]idx[/code].
+		Args: [{ false idx int}], Returns: EditorFileSystemDirectory
 */
 func (o *EditorFileSystemDirectory) GetSubdir(idx gdnative.Int) EditorFileSystemDirectoryImplementer {
 	//log.Println("Calling EditorFileSystemDirectory.GetSubdir()")
@@ -296,7 +317,7 @@ func (o *EditorFileSystemDirectory) GetSubdir(idx gdnative.Int) EditorFileSystem
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_subdir")
+	methodBind := methodBindEditorFileSystemDirectoryGetSubdir.Get()
 
 	// Call the parent method.
 	// EditorFileSystemDirectory
@@ -304,27 +325,25 @@ func (o *EditorFileSystemDirectory) GetSubdir(idx gdnative.Int) EditorFileSystem
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEditorFileSystemDirectoryFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EditorFileSystemDirectoryImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "EditorFileSystemDirectory" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EditorFileSystemDirectoryImplementer)
+	ret := EditorFileSystemDirectory{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorFileSystemDirectoryImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of subdirectories in this directory.
-	Args: [], Returns: int
+	        Returns the number of subdirectories in this directory.
+		Args: [], Returns: int
 */
 func (o *EditorFileSystemDirectory) GetSubdirCount() gdnative.Int {
 	//log.Println("Calling EditorFileSystemDirectory.GetSubdirCount()")
@@ -333,7 +352,7 @@ func (o *EditorFileSystemDirectory) GetSubdirCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorFileSystemDirectory", "get_subdir_count")
+	methodBind := methodBindEditorFileSystemDirectoryGetSubdirCount.Get()
 
 	// Call the parent method.
 	// int
@@ -348,7 +367,7 @@ func (o *EditorFileSystemDirectory) GetSubdirCount() gdnative.Int {
 // EditorFileSystemDirectoryImplementer is an interface that implements the methods
 // of the EditorFileSystemDirectory class.
 type EditorFileSystemDirectoryImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	FindDirIndex(name gdnative.String) gdnative.Int
 	FindFileIndex(name gdnative.String) gdnative.Int
 	GetFile(idx gdnative.Int) gdnative.String
@@ -1,7 +1,8 @@
-package godot
+package editor
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,55 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewEditorImportPluginFromPointer(ptr gdnative.Pointer) EditorImportPlugin {
-func newEditorImportPluginFromPointer(ptr gdnative.Pointer) EditorImportPlugin {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EditorImportPlugin{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 EditorImportPlugins provide a way to extend the editor's resource import functionality. Use them to import resources from custom files or to provide alternatives to the editor's existing importers. Register your [EditorPlugin] with [method EditorPlugin.add_import_plugin]. EditorImportPlugins work by associating with specific file extensions and a resource type. See [method get_recognized_extension] and [method get_resource_type]). They may optionally specify some import presets that affect the import process. EditorImportPlugins are responsible for creating the resources and saving them in the [code].import[/code] directory. Below is an example EditorImportPlugin that imports a [Mesh] from a file with the extension ".special" or ".spec": [codeblock] tool extends EditorImportPlugin func get_importer_name(): return "my.special.plugin" func get_visible_name(): return "Special Mesh Importer" func get_recognized_extensions(): return ["special", "spec"] func get_save_extension(): return "mesh" func get_resource_type(): return "Mesh" func get_preset_count(): return 1 func get_preset_name(i): return "Default" func get_import_options(i): return [{"name": "my_option", "default_value": false}] func load(src, dst, opts, r_platform_variants, r_gen_files): var file = File.new() if file.open(src, File.READ) != OK: return FAILED var mesh = Mesh.new() var save = dst + "." + get_save_extension() ResourceSaver.save(file, mesh) return OK [/codeblock]
 */
 type EditorImportPlugin struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorEditorImportPlugin is the class constructor of the EditorImportPlugin class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorImportPlugin = gdnative.NewLazyClassConstructor("EditorImportPlugin")
+
+// NewEditorImportPlugin will create a new instance of the EditorImportPlugin class.
+// EditorImportPlugin is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorImportPlugin() *EditorImportPlugin {
+	obj := &EditorImportPlugin{}
+	obj.SetBaseObject(classConstructorEditorImportPlugin.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *EditorImportPlugin) BaseClass() string {
 	return "EditorImportPlugin"
 }
 
+// Method binds of the EditorImportPlugin class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorImportPluginGetImportOptions        = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_import_options")
+	methodBindEditorImportPluginGetImportOrder          = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_import_order")
+	methodBindEditorImportPluginGetImporterName         = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_importer_name")
+	methodBindEditorImportPluginGetOptionVisibility     = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_option_visibility")
+	methodBindEditorImportPluginGetPresetCount          = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_preset_count")
+	methodBindEditorImportPluginGetPresetName           = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_preset_name")
+	methodBindEditorImportPluginGetPriority             = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_priority")
+	methodBindEditorImportPluginGetRecognizedExtensions = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_recognized_extensions")
+	methodBindEditorImportPluginGetResourceType         = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_resource_type")
+	methodBindEditorImportPluginGetSaveExtension        = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_save_extension")
+	methodBindEditorImportPluginGetVisibleName          = gdnative.NewLazyMethodBind("EditorImportPlugin", "get_visible_name")
+	methodBindEditorImportPluginImport                  = gdnative.NewLazyMethodBind("EditorImportPlugin", "import")
+)
+
 /*
-        Get the options and default values for the preset at this index. Returns an Array of Dictionaries with the following keys: "name", "default_value", "property_hint" (optional), "hint_string" (optional), "usage" (optional).
-	Args: [{ false preset int}], Returns: Array
+	        Get the options and default values for the preset at this index. Returns an Array of Dictionaries with the following keys: "name", "default_value", "property_hint" (optional), "hint_string" (optional), "usage" (optional).
+		Args: [{ false preset int}], Returns: Array
 */
 func (o *EditorImportPlugin) GetImportOptions(preset gdnative.Int) gdnative.Array {
 	//log.Println("Calling EditorImportPlugin.GetImportOptions()")
@@ -46,7 +72,7 @@ func (o *EditorImportPlugin) GetImportOptions(preset gdnative.Int) gdnative.Arra
 	ptrArguments[0] = gdnative.NewPointerFromInt(preset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_import_options")
+	methodBind := methodBindEditorImportPluginGetImportOptions.Get()
 
 	// Call the parent method.
 	// Array
@@ -59,8 +85,8 @@ func (o *EditorImportPlugin) GetImportOptions(preset gdnative.Int) gdnative.Arra
 }
 
 /*
-        Get the order of this importer to be run when importing resources. Higher values will be called later. Use this to ensure the importer runs after the dependencies are already imported.
-	Args: [], Returns: int
+	        Get the order of this importer to be run when importing resources. Higher values will be called later. Use this to ensure the importer runs after the dependencies are already imported.
+		Args: [], Returns: int
 */
 func (o *EditorImportPlugin) GetImportOrder() gdnative.Int {
 	//log.Println("Calling EditorImportPlugin.GetImportOrder()")
@@ -69,7 +95,7 @@ func (o *EditorImportPlugin) GetImportOrder() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_import_order")
+	methodBind := methodBindEditorImportPluginGetImportOrder.Get()
 
 	// Call the parent method.
 	// int
@@ -82,8 +108,8 @@ func (o *EditorImportPlugin) GetImportOrder() gdnative.Int {
 }
 
 /*
-        Get the unique name of the importer.
-	Args: [], Returns: String
+	        Get the unique name of the importer.
+		Args: [], Returns: String
 */
 func (o *EditorImportPlugin) GetImporterName() gdnative.String {
 	//log.Println("Calling EditorImportPlugin.GetImporterName()")
@@ -92,7 +118,7 @@ func (o *EditorImportPlugin) GetImporterName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_importer_name")
+	methodBind := methodBindEditorImportPluginGetImporterName.Get()
 
 	// Call the parent method.
 	// String
@@ -105,8 +131,7 @@ func (o *EditorImportPlugin) GetImporterName() gdnative.String {
 }
 
 /*
-
-	Args: [{ false option String} { false options Dictionary}], Returns: bool
+Args: [{ false option String} { false options Dictionary}], Returns: bool
 */
 func (o *EditorImportPlugin) GetOptionVisibility(option gdnative.String, options gdnative.Dictionary) gdnative.Bool {
 	//log.Println("Calling EditorImportPlugin.GetOptionVisibility()")
@@ -117,7 +142,7 @@ func (o *EditorImportPlugin) GetOptionVisibility(option gdnative.String, options
 	ptrArguments[1] = gdnative.NewPointerFromDictionary(options)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_option_visibility")
+	methodBind := methodBindEditorImportPluginGetOptionVisibility.Get()
 
 	// Call the parent method.
 	// bool
@@ -130,8 +155,8 @@ func (o *EditorImportPlugin) GetOptionVisibility(option gdnative.String, options
 }
 
 /*
-        Get the number of initial presets defined by the plugin. Use [method get_import_options] to get the default options for the preset and [method get_preset_name] to get the name of the preset.
-	Args: [], Returns: int
+	        Get the number of initial presets defined by the plugin. Use [method get_import_options] to get the default options for the preset and [method get_preset_name] to get the name of the preset.
+		Args: [], Returns: int
 */
 func (o *EditorImportPlugin) GetPresetCount() gdnative.Int {
 	//log.Println("Calling EditorImportPlugin.GetPresetCount()")
@@ -140,7 +165,7 @@ func (o *EditorImportPlugin) GetPresetCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_preset_count")
+	methodBind := methodBindEditorImportPluginGetPresetCount.Get()
 
 	// Call the parent method.
 	// int
@@ -153,8 +178,8 @@ func (o *EditorImportPlugin) GetPresetCount() gdnative.Int {
 }
 
 /*
-        Get the name of the options preset at this index.
-	Args: [{ false preset int}], Returns: String
+	        Get the name of the options preset at this index.
+		Args: [{ false preset int}], Returns: String
 */
 func (o *EditorImportPlugin) GetPresetName(preset gdnative.Int) gdnative.String {
 	//log.Println("Calling EditorImportPlugin.GetPresetName()")
@@ -164,7 +189,7 @@ func (o *EditorImportPlugin) GetPresetName(preset gdnative.Int) gdnative.String
 	ptrArguments[0] = gdnative.NewPointerFromInt(preset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_preset_name")
+	methodBind := methodBindEditorImportPluginGetPresetName.Get()
 
 	// Call the parent method.
 	// String
@@ -177,8 +202,8 @@ func (o *EditorImportPlugin) GetPresetName(preset gdnative.Int) gdnative.String
 }
 
 /*
-        Get the priority of this plugin for the recognized extension. Higher priority plugins will be preferred. Default value is 1.0.
-	Args: [], Returns: float
+	        Get the priority of this plugin for the recognized extension. Higher priority plugins will be preferred. Default value is 1.0.
+		Args: [], Returns: float
 */
 func (o *EditorImportPlugin) GetPriority() gdnative.Real {
 	//log.Println("Calling EditorImportPlugin.GetPriority()")
@@ -187,7 +212,7 @@ func (o *EditorImportPlugin) GetPriority() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_priority")
+	methodBind := methodBindEditorImportPluginGetPriority.Get()
 
 	// Call the parent method.
 	// float
@@ -200,8 +225,8 @@ func (o *EditorImportPlugin) GetPriority() gdnative.Real {
 }
 
 /*
-        Get the list of file extensions to associate with this loader (case insensitive). e.g. ["obj"].
-	Args: [], Returns: Array
+	        Get the list of file extensions to associate with this loader (case insensitive). e.g. ["obj"].
+		Args: [], Returns: Array
 */
 func (o *EditorImportPlugin) GetRecognizedExtensions() gdnative.Array {
 	//log.Println("Calling EditorImportPlugin.GetRecognizedExtensions()")
@@ -210,7 +235,7 @@ func (o *EditorImportPlugin) GetRecognizedExtensions() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_recognized_extensions")
+	methodBind := methodBindEditorImportPluginGetRecognizedExtensions.Get()
 
 	// Call the parent method.
 	// Array
@@ -223,8 +248,8 @@ func (o *EditorImportPlugin) GetRecognizedExtensions() gdnative.Array {
 }
 
 /*
-        Get the godot resource type associated with this loader. e.g. "Mesh" or "Animation".
-	Args: [], Returns: String
+	        Get the godot resource type associated with this loader. e.g. "Mesh" or "Animation".
+		Args: [], Returns: String
 */
 func (o *EditorImportPlugin) GetResourceType() gdnative.String {
 	//log.Println("Calling EditorImportPlugin.GetResourceType()")
@@ -233,7 +258,7 @@ func (o *EditorImportPlugin) GetResourceType() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_resource_type")
+	methodBind := methodBindEditorImportPluginGetResourceType.Get()
 
 	// Call the parent method.
 	// String
@@ -246,8 +271,8 @@ func (o *EditorImportPlugin) GetResourceType() gdnative.String {
 }
 
 /*
-        Get the extension used to save this resource in the [code].import[/code] directory.
-	Args: [], Returns: String
+	        Get the extension used to save this resource in the [code].import[/code] directory.
+		Args: [], Returns: String
 */
 func (o *EditorImportPlugin) GetSaveExtension() gdnative.String {
 	//log.Println("Calling EditorImportPlugin.GetSaveExtension()")
@@ -256,7 +281,7 @@ func (o *EditorImportPlugin) GetSaveExtension() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_save_extension")
+	methodBind := methodBindEditorImportPluginGetSaveExtension.Get()
 
 	// Call the parent method.
 	// String
@@ -269,8 +294,8 @@ func (o *EditorImportPlugin) GetSaveExtension() gdnative.String {
 }
 
 /*
-        Get the name to display in the import window.
-	Args: [], Returns: String
+	        Get the name to display in the import window.
+		Args: [], Returns: String
 */
 func (o *EditorImportPlugin) GetVisibleName() gdnative.String {
 	//log.Println("Calling EditorImportPlugin.GetVisibleName()")
@@ -279,7 +304,7 @@ func (o *EditorImportPlugin) GetVisibleName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "get_visible_name")
+	methodBind := methodBindEditorImportPluginGetVisibleName.Get()
 
 	// Call the parent method.
 	// String
@@ -292,8 +317,7 @@ func (o *EditorImportPlugin) GetVisibleName() gdnative.String {
 }
 
 /*
-
-	Args: [{ false source_file String} { false save_path String} { false options Dictionary} { false r_platform_variants Array} { false r_gen_files Array}], Returns: int
+Args: [{ false source_file String} { false save_path String} { false options Dictionary} { false r_platform_variants Array} { false r_gen_files Array}], Returns: int
 */
 func (o *EditorImportPlugin) Import(sourceFile gdnative.String, savePath gdnative.String, options gdnative.Dictionary, rPlatformVariants gdnative.Array, rGenFiles gdnative.Array) gdnative.Int {
 	//log.Println("Calling EditorImportPlugin.Import()")
@@ -307,7 +331,7 @@ func (o *EditorImportPlugin) Import(sourceFile gdnative.String, savePath gdnativ
 	ptrArguments[4] = gdnative.NewPointerFromArray(rGenFiles)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorImportPlugin", "import")
+	methodBind := methodBindEditorImportPluginImport.Get()
 
 	// Call the parent method.
 	// int
@@ -322,7 +346,7 @@ func (o *EditorImportPlugin) Import(sourceFile gdnative.String, savePath gdnativ
 // EditorImportPluginImplementer is an interface that implements the methods
 // of the EditorImportPlugin class.
 type EditorImportPluginImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetImportOptions(preset gdnative.Int) gdnative.Array
 	GetImportOrder() gdnative.Int
 	GetImporterName() gdnative.String
@@ -1,7 +1,9 @@
-package godot
+package editor
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/control"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +15,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewEditorInterfaceFromPointer(ptr gdnative.Pointer) EditorInterface {
-func newEditorInterfaceFromPointer(ptr gdnative.Pointer) EditorInterface {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EditorInterface{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Editor interface. Allows saving and (re-)loading scenes, rendering mesh previews, inspecting and editing resources and objects and provides access to [EditorSettings], [EditorFileSystem], [EditorResourcePreview]\ er, [ScriptEditor], the editor viewport, as well as information about scenes. Also see [EditorPlugin] and [EditorScript].
 */
 type EditorInterface struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
@@ -34,19 +27,44 @@ func (o *EditorInterface) BaseClass() string {
 	return "EditorInterface"
 }
 
+// Method binds of the EditorInterface class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorInterfaceEditResource          = gdnative.NewLazyMethodBind("EditorInterface", "edit_resource")
+	methodBindEditorInterfaceGetBaseControl        = gdnative.NewLazyMethodBind("EditorInterface", "get_base_control")
+	methodBindEditorInterfaceGetEditedSceneRoot    = gdnative.NewLazyMethodBind("EditorInterface", "get_edited_scene_root")
+	methodBindEditorInterfaceGetEditorSettings     = gdnative.NewLazyMethodBind("EditorInterface", "get_editor_settings")
+	methodBindEditorInterfaceGetEditorViewport     = gdnative.NewLazyMethodBind("EditorInterface", "get_editor_viewport")
+	methodBindEditorInterfaceGetOpenScenes         = gdnative.NewLazyMethodBind("EditorInterface", "get_open_scenes")
+	methodBindEditorInterfaceGetResourceFilesystem = gdnative.NewLazyMethodBind("EditorInterface", "get_resource_filesystem")
+	methodBindEditorInterfaceGetResourcePreviewer  = gdnative.NewLazyMethodBind("EditorInterface", "get_resource_previewer")
+	methodBindEditorInterfaceGetScriptEditor       = gdnative.NewLazyMethodBind("EditorInterface", "get_script_editor")
+	methodBindEditorInterfaceGetSelectedPath       = gdnative.NewLazyMethodBind("EditorInterface", "get_selected_path")
+	methodBindEditorInterfaceGetSelection          = gdnative.NewLazyMethodBind("EditorInterface", "get_selection")
+	methodBindEditorInterfaceInspectObject         = gdnative.NewLazyMethodBind("EditorInterface", "inspect_object")
+	methodBindEditorInterfaceIsPluginEnabled       = gdnative.NewLazyMethodBind("EditorInterface", "is_plugin_enabled")
+	methodBindEditorInterfaceMakeMeshPreviews      = gdnative.NewLazyMethodBind("EditorInterface", "make_mesh_previews")
+	methodBindEditorInterfaceOpenSceneFromPath     = gdnative.NewLazyMethodBind("EditorInterface", "open_scene_from_path")
+	methodBindEditorInterfaceReloadSceneFromPath   = gdnative.NewLazyMethodBind("EditorInterface", "reload_scene_from_path")
+	methodBindEditorInterfaceSaveScene             = gdnative.NewLazyMethodBind("EditorInterface", "save_scene")
+	methodBindEditorInterfaceSaveSceneAs           = gdnative.NewLazyMethodBind("EditorInterface", "save_scene_as")
+	methodBindEditorInterfaceSelectFile            = gdnative.NewLazyMethodBind("EditorInterface", "select_file")
+	methodBindEditorInterfaceSetPluginEnabled      = gdnative.NewLazyMethodBind("EditorInterface", "set_plugin_enabled")
+)
+
 /*
-        Edits the given [Resource].
-	Args: [{ false resource Resource}], Returns: void
+	        Edits the given [Resource].
+		Args: [{ false resource Resource}], Returns: void
 */
-func (o *EditorInterface) EditResource(resource ResourceImplementer) {
+func (o *EditorInterface) EditResource(resource godot.ResourceImplementer) {
 	//log.Println("Calling EditorInterface.EditResource()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(resource.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "edit_resource")
+	methodBind := methodBindEditorInterfaceEditResource.Get()
 
 	// Call the parent method.
 	// void
@@ -56,17 +74,17 @@ func (o *EditorInterface) EditResource(resource ResourceImplementer) {
 }
 
 /*
-        Returns the base [Control].
-	Args: [], Returns: Control
+	        Returns the base [Control].
+		Args: [], Returns: Control
 */
-func (o *EditorInterface) GetBaseControl() ControlImplementer {
+func (o *EditorInterface) GetBaseControl() godot.ControlImplementer {
 	//log.Println("Calling EditorInterface.GetBaseControl()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_base_control")
+	methodBind := methodBindEditorInterfaceGetBaseControl.Get()
 
 	// Call the parent method.
 	// Control
@@ -74,36 +92,34 @@ func (o *EditorInterface) GetBaseControl() ControlImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newControlFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ControlImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Control" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ControlImplementer)
+	ret := godot.Control{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ControlImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the edited scene's root [Node].
-	Args: [], Returns: Node
+	        Returns the edited scene's root [Node].
+		Args: [], Returns: Node
 */
-func (o *EditorInterface) GetEditedSceneRoot() NodeImplementer {
+func (o *EditorInterface) GetEditedSceneRoot() godot.NodeImplementer {
 	//log.Println("Calling EditorInterface.GetEditedSceneRoot()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_edited_scene_root")
+	methodBind := methodBindEditorInterfaceGetEditedSceneRoot.Get()
 
 	// Call the parent method.
 	// Node
@@ -111,27 +127,25 @@ func (o *EditorInterface) GetEditedSceneRoot() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := godot.Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the [EditorSettings].
-	Args: [], Returns: EditorSettings
+	        Returns the [EditorSettings].
+		Args: [], Returns: EditorSettings
 */
 func (o *EditorInterface) GetEditorSettings() EditorSettingsImplementer {
 	//log.Println("Calling EditorInterface.GetEditorSettings()")
@@ -140,7 +154,7 @@ func (o *EditorInterface) GetEditorSettings() EditorSettingsImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_editor_settings")
+	methodBind := methodBindEditorInterfaceGetEditorSettings.Get()
 
 	// Call the parent method.
 	// EditorSettings
@@ -148,36 +162,34 @@ func (o *EditorInterface) GetEditorSettings() EditorSettingsImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEditorSettingsFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EditorSettingsImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "EditorSettings" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EditorSettingsImplementer)
+	ret := EditorSettings{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorSettingsImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the editor [Viewport].
-	Args: [], Returns: Control
+	        Returns the editor [Viewport].
+		Args: [], Returns: Control
 */
-func (o *EditorInterface) GetEditorViewport() ControlImplementer {
+func (o *EditorInterface) GetEditorViewport() godot.ControlImplementer {
 	//log.Println("Calling EditorInterface.GetEditorViewport()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_editor_viewport")
+	methodBind := methodBindEditorInterfaceGetEditorViewport.Get()
 
 	// Call the parent method.
 	// Control
@@ -185,27 +197,25 @@ func (o *EditorInterface) GetEditorViewport() ControlImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newControlFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ControlImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Control" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ControlImplementer)
+	ret := godot.Control{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ControlImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns an [Array] of the currently opened scenes.
-	Args: [], Returns: Array
+	        Returns an [Array] of the currently opened scenes.
+		Args: [], Returns: Array
 */
 func (o *EditorInterface) GetOpenScenes() gdnative.Array {
 	//log.Println("Calling EditorInterface.GetOpenScenes()")
@@ -214,7 +224,7 @@ func (o *EditorInterface) GetOpenScenes() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_open_scenes")
+	methodBind := methodBindEditorInterfaceGetOpenScenes.Get()
 
 	// Call the parent method.
 	// Array
@@ -227,8 +237,8 @@ func (o *EditorInterface) GetOpenScenes() gdnative.Array {
 }
 
 /*
-        Returns the [EditorFileSystem].
-	Args: [], Returns: EditorFileSystem
+	        Returns the [EditorFileSystem].
+		Args: [], Returns: EditorFileSystem
 */
 func (o *EditorInterface) GetResourceFilesystem() EditorFileSystemImplementer {
 	//log.Println("Calling EditorInterface.GetResourceFilesystem()")
@@ -237,7 +247,7 @@ func (o *EditorInterface) GetResourceFilesystem() EditorFileSystemImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_resource_filesystem")
+	methodBind := methodBindEditorInterfaceGetResourceFilesystem.Get()
 
 	// Call the parent method.
 	// EditorFileSystem
@@ -245,27 +255,25 @@ func (o *EditorInterface) GetResourceFilesystem() EditorFileSystemImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEditorFileSystemFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EditorFileSystemImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "EditorFileSystem" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EditorFileSystemImplementer)
+	ret := EditorFileSystem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorFileSystemImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the [EditorResourcePreview]\ er.
-	Args: [], Returns: EditorResourcePreview
+	        Returns the [EditorResourcePreview]\ er.
+		Args: [], Returns: EditorResourcePreview
 */
 func (o *EditorInterface) GetResourcePreviewer() EditorResourcePreviewImplementer {
 	//log.Println("Calling EditorInterface.GetResourcePreviewer()")
@@ -274,7 +282,7 @@ func (o *EditorInterface) GetResourcePreviewer() EditorResourcePreviewImplemente
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_resource_previewer")
+	methodBind := methodBindEditorInterfaceGetResourcePreviewer.Get()
 
 	// Call the parent method.
 	// EditorResourcePreview
@@ -282,36 +290,34 @@ func (o *EditorInterface) GetResourcePreviewer() EditorResourcePreviewImplemente
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEditorResourcePreviewFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EditorResourcePreviewImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "EditorResourcePreview" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EditorResourcePreviewImplementer)
+	ret := EditorResourcePreview{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorResourcePreviewImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the [ScriptEditor].
-	Args: [], Returns: ScriptEditor
+	        Returns the [ScriptEditor].
+		Args: [], Returns: ScriptEditor
 */
-func (o *EditorInterface) GetScriptEditor() ScriptEditorImplementer {
+func (o *EditorInterface) GetScriptEditor() control.ScriptEditorImplementer {
 	//log.Println("Calling EditorInterface.GetScriptEditor()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_script_editor")
+	methodBind := methodBindEditorInterfaceGetScriptEditor.Get()
 
 	// Call the parent method.
 	// ScriptEditor
@@ -319,27 +325,24 @@ func (o *EditorInterface) GetScriptEditor() ScriptEditorImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newScriptEditorFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ScriptEditorImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ScriptEditor" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ScriptEditorImplementer)
+	ret := control.ScriptEditor{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(control.ScriptEditorImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: String
+Args: [], Returns: String
 */
 func (o *EditorInterface) GetSelectedPath() gdnative.String {
 	//log.Println("Calling EditorInterface.GetSelectedPath()")
@@ -348,7 +351,7 @@ func (o *EditorInterface) GetSelectedPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_selected_path")
+	methodBind := methodBindEditorInterfaceGetSelectedPath.Get()
 
 	// Call the parent method.
 	// String
@@ -361,8 +364,8 @@ func (o *EditorInterface) GetSelectedPath() gdnative.String {
 }
 
 /*
-        Returns the [EditorSelection].
-	Args: [], Returns: EditorSelection
+	        Returns the [EditorSelection].
+		Args: [], Returns: EditorSelection
 */
 func (o *EditorInterface) GetSelection() EditorSelectionImplementer {
 	//log.Println("Calling EditorInterface.GetSelection()")
@@ -371,7 +374,7 @@ func (o *EditorInterface) GetSelection() EditorSelectionImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "get_selection")
+	methodBind := methodBindEditorInterfaceGetSelection.Get()
 
 	// Call the parent method.
 	// EditorSelection
@@ -379,38 +382,36 @@ func (o *EditorInterface) GetSelection() EditorSelectionImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEditorSelectionFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EditorSelectionImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "EditorSelection" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EditorSelectionImplementer)
+	ret := EditorSelection{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorSelectionImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Shows the given property on the given [code]object[/code] in the Editor's Inspector dock.
-	Args: [{ false object Object} { true for_property String}], Returns: void
+	        Shows the given property on the given [code]object[/code] in the Editor's Inspector dock.
+		Args: [{ false object Object} { true for_property String}], Returns: void
 */
-func (o *EditorInterface) InspectObject(object ObjectImplementer, forProperty gdnative.String) {
+func (o *EditorInterface) InspectObject(object godot.ObjectImplementer, forProperty gdnative.String) {
 	//log.Println("Calling EditorInterface.InspectObject()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(object.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
 	ptrArguments[1] = gdnative.NewPointerFromString(forProperty)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "inspect_object")
+	methodBind := methodBindEditorInterfaceInspectObject.Get()
 
 	// Call the parent method.
 	// void
@@ -420,8 +421,8 @@ func (o *EditorInterface) InspectObject(object ObjectImplementer, forProperty gd
 }
 
 /*
-        Returns the enabled status of a plugin. The plugin name is the same as its directory name.
-	Args: [{ false plugin String}], Returns: bool
+	        Returns the enabled status of a plugin. The plugin name is the same as its directory name.
+		Args: [{ false plugin String}], Returns: bool
 */
 func (o *EditorInterface) IsPluginEnabled(plugin gdnative.String) gdnative.Bool {
 	//log.Println("Calling EditorInterface.IsPluginEnabled()")
@@ -431,7 +432,7 @@ func (o *EditorInterface) IsPluginEnabled(plugin gdnative.String) gdnative.Bool
 	ptrArguments[0] = gdnative.NewPointerFromString(plugin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "is_plugin_enabled")
+	methodBind := methodBindEditorInterfaceIsPluginEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -444,8 +445,8 @@ func (o *EditorInterface) IsPluginEnabled(plugin gdnative.String) gdnative.Bool
 }
 
 /*
-        Returns mesh previews rendered at the given size as an [Array] of [Texture]s.
-	Args: [{ false meshes Array} { false preview_size int}], Returns: Array
+	        Returns mesh previews rendered at the given size as an [Array] of [Texture]s.
+		Args: [{ false meshes Array} { false preview_size int}], Returns: Array
 */
 func (o *EditorInterface) MakeMeshPreviews(meshes gdnative.Array, previewSize gdnative.Int) gdnative.Array {
 	//log.Println("Calling EditorInterface.MakeMeshPreviews()")
@@ -456,7 +457,7 @@ func (o *EditorInterface) MakeMeshPreviews(meshes gdnative.Array, previewSize gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(previewSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "make_mesh_previews")
+	methodBind := methodBindEditorInterfaceMakeMeshPreviews.Get()
 
 	// Call the parent method.
 	// Array
@@ -469,8 +470,8 @@ func (o *EditorInterface) MakeMeshPreviews(meshes gdnative.Array, previewSize gd
 }
 
 /*
-        Opens the scene at the given path.
-	Args: [{ false scene_filepath String}], Returns: void
+	        Opens the scene at the given path.
+		Args: [{ false scene_filepath String}], Returns: void
 */
 func (o *EditorInterface) OpenSceneFromPath(sceneFilepath gdnative.String) {
 	//log.Println("Calling EditorInterface.OpenSceneFromPath()")
@@ -480,7 +481,7 @@ func (o *EditorInterface) OpenSceneFromPath(sceneFilepath gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(sceneFilepath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "open_scene_from_path")
+	methodBind := methodBindEditorInterfaceOpenSceneFromPath.Get()
 
 	// Call the parent method.
 	// void
@@ -490,8 +491,8 @@ func (o *EditorInterface) OpenSceneFromPath(sceneFilepath gdnative.String) {
 }
 
 /*
-        Reloads the scene at the given path.
-	Args: [{ false scene_filepath String}], Returns: void
+	        Reloads the scene at the given path.
+		Args: [{ false scene_filepath String}], Returns: void
 */
 func (o *EditorInterface) ReloadSceneFromPath(sceneFilepath gdnative.String) {
 	//log.Println("Calling EditorInterface.ReloadSceneFromPath()")
@@ -501,7 +502,7 @@ func (o *EditorInterface) ReloadSceneFromPath(sceneFilepath gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(sceneFilepath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "reload_scene_from_path")
+	methodBind := methodBindEditorInterfaceReloadSceneFromPath.Get()
 
 	// Call the parent method.
 	// void
@@ -511,8 +512,8 @@ func (o *EditorInterface) ReloadSceneFromPath(sceneFilepath gdnative.String) {
 }
 
 /*
-        Saves the scene. Returns either OK or ERR_CANT_CREATE. See [@GlobalScope] constants.
-	Args: [], Returns: enum.Error
+	        Saves the scene. Returns either OK or ERR_CANT_CREATE. See [@GlobalScope] constants.
+		Args: [], Returns: enum.Error
 */
 func (o *EditorInterface) SaveScene() gdnative.Error {
 	//log.Println("Calling EditorInterface.SaveScene()")
@@ -521,7 +522,7 @@ func (o *EditorInterface) SaveScene() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "save_scene")
+	methodBind := methodBindEditorInterfaceSaveScene.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -534,8 +535,8 @@ func (o *EditorInterface) SaveScene() gdnative.Error {
 }
 
 /*
-        Saves the scene as a file at [code]path[/code].
-	Args: [{ false path String} {True true with_preview bool}], Returns: void
+	        Saves the scene as a file at [code]path[/code].
+		Args: [{ false path String} {True true with_preview bool}], Returns: void
 */
 func (o *EditorInterface) SaveSceneAs(path gdnative.String, withPreview gdnative.Bool) {
 	//log.Println("Calling EditorInterface.SaveSceneAs()")
@@ -546,7 +547,7 @@ func (o *EditorInterface) SaveSceneAs(path gdnative.String, withPreview gdnative
 	ptrArguments[1] = gdnative.NewPointerFromBool(withPreview)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "save_scene_as")
+	methodBind := methodBindEditorInterfaceSaveSceneAs.Get()
 
 	// Call the parent method.
 	// void
@@ -556,8 +557,7 @@ func (o *EditorInterface) SaveSceneAs(path gdnative.String, withPreview gdnative
 }
 
 /*
-
-	Args: [{ false p_file String}], Returns: void
+Args: [{ false p_file String}], Returns: void
 */
 func (o *EditorInterface) SelectFile(pFile gdnative.String) {
 	//log.Println("Calling EditorInterface.SelectFile()")
@@ -567,7 +567,7 @@ func (o *EditorInterface) SelectFile(pFile gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(pFile)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "select_file")
+	methodBind := methodBindEditorInterfaceSelectFile.Get()
 
 	// Call the parent method.
 	// void
@@ -577,8 +577,8 @@ func (o *EditorInterface) SelectFile(pFile gdnative.String) {
 }
 
 /*
-        Sets the enabled status of a plugin. The plugin name is the same as its directory name.
-	Args: [{ false plugin String} { false enabled bool}], Returns: void
+	        Sets the enabled status of a plugin. The plugin name is the same as its directory name.
+		Args: [{ false plugin String} { false enabled bool}], Returns: void
 */
 func (o *EditorInterface) SetPluginEnabled(plugin gdnative.String, enabled gdnative.Bool) {
 	//log.Println("Calling EditorInterface.SetPluginEnabled()")
@@ -589,7 +589,7 @@ func (o *EditorInterface) SetPluginEnabled(plugin gdnative.String, enabled gdnat
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorInterface", "set_plugin_enabled")
+	methodBind := methodBindEditorInterfaceSetPluginEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -598,27 +598,40 @@ func (o *EditorInterface) SetPluginEnabled(plugin gdnative.String, enabled gdnat
 
 }
 
+// InspectObjectWithDefaults will call InspectObject using the default values for: for_property.
+func (o *EditorInterface) InspectObjectWithDefaults(object godot.ObjectImplementer) {
+	o.InspectObject(object, "")
+}
+
+// SaveSceneAsWithDefaults will call SaveSceneAs using the default values for: with_preview.
+func (o *EditorInterface) SaveSceneAsWithDefaults(path gdnative.String) {
+	o.SaveSceneAs(path, true)
+}
+
 // EditorInterfaceImplementer is an interface that implements the methods
 // of the EditorInterface class.
 type EditorInterfaceImplementer interface {
-	NodeImplementer
-	EditResource(resource ResourceImplementer)
-	GetBaseControl() ControlImplementer
-	GetEditedSceneRoot() NodeImplementer
+	godot.NodeImplementer
+	EditResource(resource godot.ResourceImplementer)
+	GetBaseControl() godot.ControlImplementer
+	GetEditedSceneRoot() godot.NodeImplementer
 	GetEditorSettings() EditorSettingsImplementer
-	GetEditorViewport() ControlImplementer
+	GetEditorViewport() godot.ControlImplementer
 	GetOpenScenes() gdnative.Array
 	GetResourceFilesystem() EditorFileSystemImplementer
 	GetResourcePreviewer() EditorResourcePreviewImplementer
-	GetScriptEditor() ScriptEditorImplementer
+	GetScriptEditor() control.ScriptEditorImplementer
 	GetSelectedPath() gdnative.String
 	GetSelection() EditorSelectionImplementer
-	InspectObject(object ObjectImplementer, forProperty gdnative.String)
+	InspectObject(object godot.ObjectImplementer, forProperty gdnative.String)
 	IsPluginEnabled(plugin gdnative.String) gdnative.Bool
 	MakeMeshPreviews(meshes gdnative.Array, previewSize gdnative.Int) gdnative.Array
 	OpenSceneFromPath(sceneFilepath gdnative.String)
 	ReloadSceneFromPath(sceneFilepath gdnative.String)
+	SaveScene() gdnative.Error
 	SaveSceneAs(path gdnative.String, withPreview gdnative.Bool)
 	SelectFile(pFile gdnative.String)
 	SetPluginEnabled(plugin gdnative.String, enabled gdnative.Bool)
+	InspectObjectWithDefaults(object godot.ObjectImplementer)
+	SaveSceneAsWithDefaults(path gdnative.String)
 }
@@ -0,0 +1,1305 @@
+package editor
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/control"
+	"github.com/shadowapex/godot-go/godot/object"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// EditorPluginCustomControlContainer is an enum for CustomControlContainer values.
+type EditorPluginCustomControlContainer int
+
+const (
+	EditorPluginContainerCanvasEditorBottom   EditorPluginCustomControlContainer = 6
+	EditorPluginContainerCanvasEditorMenu     EditorPluginCustomControlContainer = 4
+	EditorPluginContainerCanvasEditorSide     EditorPluginCustomControlContainer = 5
+	EditorPluginContainerPropertyEditorBottom EditorPluginCustomControlContainer = 7
+	EditorPluginContainerSpatialEditorBottom  EditorPluginCustomControlContainer = 3
+	EditorPluginContainerSpatialEditorMenu    EditorPluginCustomControlContainer = 1
+	EditorPluginContainerSpatialEditorSide    EditorPluginCustomControlContainer = 2
+	EditorPluginContainerToolbar              EditorPluginCustomControlContainer = 0
+)
+
+// String will return the Godot name of the EditorPluginCustomControlContainer value.
+func (e EditorPluginCustomControlContainer) String() string {
+	switch e {
+	case EditorPluginContainerCanvasEditorBottom:
+		return "CONTAINER_CANVAS_EDITOR_BOTTOM"
+	case EditorPluginContainerCanvasEditorMenu:
+		return "CONTAINER_CANVAS_EDITOR_MENU"
+	case EditorPluginContainerCanvasEditorSide:
+		return "CONTAINER_CANVAS_EDITOR_SIDE"
+	case EditorPluginContainerPropertyEditorBottom:
+		return "CONTAINER_PROPERTY_EDITOR_BOTTOM"
+	case EditorPluginContainerSpatialEditorBottom:
+		return "CONTAINER_SPATIAL_EDITOR_BOTTOM"
+	case EditorPluginContainerSpatialEditorMenu:
+		return "CONTAINER_SPATIAL_EDITOR_MENU"
+	case EditorPluginContainerSpatialEditorSide:
+		return "CONTAINER_SPATIAL_EDITOR_SIDE"
+	case EditorPluginContainerToolbar:
+		return "CONTAINER_TOOLBAR"
+	}
+	return fmt.Sprintf("EditorPluginCustomControlContainer(%d)", int(e))
+}
+
+// ParseEditorPluginCustomControlContainer will return the EditorPluginCustomControlContainer value with the given Godot name.
+func ParseEditorPluginCustomControlContainer(name string) (EditorPluginCustomControlContainer, error) {
+	switch name {
+	case "CONTAINER_CANVAS_EDITOR_BOTTOM":
+		return EditorPluginContainerCanvasEditorBottom, nil
+	case "CONTAINER_CANVAS_EDITOR_MENU":
+		return EditorPluginContainerCanvasEditorMenu, nil
+	case "CONTAINER_CANVAS_EDITOR_SIDE":
+		return EditorPluginContainerCanvasEditorSide, nil
+	case "CONTAINER_PROPERTY_EDITOR_BOTTOM":
+		return EditorPluginContainerPropertyEditorBottom, nil
+	case "CONTAINER_SPATIAL_EDITOR_BOTTOM":
+		return EditorPluginContainerSpatialEditorBottom, nil
+	case "CONTAINER_SPATIAL_EDITOR_MENU":
+		return EditorPluginContainerSpatialEditorMenu, nil
+	case "CONTAINER_SPATIAL_EDITOR_SIDE":
+		return EditorPluginContainerSpatialEditorSide, nil
+	case "CONTAINER_TOOLBAR":
+		return EditorPluginContainerToolbar, nil
+	}
+	return 0, fmt.Errorf("invalid EditorPluginCustomControlContainer value %q", name)
+}
+
+// EditorPluginDockSlot is an enum for DockSlot values.
+type EditorPluginDockSlot int
+
+const (
+	EditorPluginDockSlotLeftBl  EditorPluginDockSlot = 1
+	EditorPluginDockSlotLeftBr  EditorPluginDockSlot = 3
+	EditorPluginDockSlotLeftUl  EditorPluginDockSlot = 0
+	EditorPluginDockSlotLeftUr  EditorPluginDockSlot = 2
+	EditorPluginDockSlotMax     EditorPluginDockSlot = 8
+	EditorPluginDockSlotRightBl EditorPluginDockSlot = 5
+	EditorPluginDockSlotRightBr EditorPluginDockSlot = 7
+	EditorPluginDockSlotRightUl EditorPluginDockSlot = 4
+	EditorPluginDockSlotRightUr EditorPluginDockSlot = 6
+)
+
+// String will return the Godot name of the EditorPluginDockSlot value.
+func (e EditorPluginDockSlot) String() string {
+	switch e {
+	case EditorPluginDockSlotLeftBl:
+		return "DOCK_SLOT_LEFT_BL"
+	case EditorPluginDockSlotLeftBr:
+		return "DOCK_SLOT_LEFT_BR"
+	case EditorPluginDockSlotLeftUl:
+		return "DOCK_SLOT_LEFT_UL"
+	case EditorPluginDockSlotLeftUr:
+		return "DOCK_SLOT_LEFT_UR"
+	case EditorPluginDockSlotMax:
+		return "DOCK_SLOT_MAX"
+	case EditorPluginDockSlotRightBl:
+		return "DOCK_SLOT_RIGHT_BL"
+	case EditorPluginDockSlotRightBr:
+		return "DOCK_SLOT_RIGHT_BR"
+	case EditorPluginDockSlotRightUl:
+		return "DOCK_SLOT_RIGHT_UL"
+	case EditorPluginDockSlotRightUr:
+		return "DOCK_SLOT_RIGHT_UR"
+	}
+	return fmt.Sprintf("EditorPluginDockSlot(%d)", int(e))
+}
+
+// ParseEditorPluginDockSlot will return the EditorPluginDockSlot value with the given Godot name.
+func ParseEditorPluginDockSlot(name string) (EditorPluginDockSlot, error) {
+	switch name {
+	case "DOCK_SLOT_LEFT_BL":
+		return EditorPluginDockSlotLeftBl, nil
+	case "DOCK_SLOT_LEFT_BR":
+		return EditorPluginDockSlotLeftBr, nil
+	case "DOCK_SLOT_LEFT_UL":
+		return EditorPluginDockSlotLeftUl, nil
+	case "DOCK_SLOT_LEFT_UR":
+		return EditorPluginDockSlotLeftUr, nil
+	case "DOCK_SLOT_MAX":
+		return EditorPluginDockSlotMax, nil
+	case "DOCK_SLOT_RIGHT_BL":
+		return EditorPluginDockSlotRightBl, nil
+	case "DOCK_SLOT_RIGHT_BR":
+		return EditorPluginDockSlotRightBr, nil
+	case "DOCK_SLOT_RIGHT_UL":
+		return EditorPluginDockSlotRightUl, nil
+	case "DOCK_SLOT_RIGHT_UR":
+		return EditorPluginDockSlotRightUr, nil
+	}
+	return 0, fmt.Errorf("invalid EditorPluginDockSlot value %q", name)
+}
+
+/*
+Plugins are used by the editor to extend functionality. The most common types of plugins are those which edit a given node or resource type, import plugins and export plugins.
+*/
+type EditorPlugin struct {
+	godot.Node
+	owner gdnative.Object
+}
+
+// classConstructorEditorPlugin is the class constructor of the EditorPlugin class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorPlugin = gdnative.NewLazyClassConstructor("EditorPlugin")
+
+// NewEditorPlugin will create a new instance of the EditorPlugin class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewEditorPlugin() *EditorPlugin {
+	obj := &EditorPlugin{}
+	obj.SetBaseObject(classConstructorEditorPlugin.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorPlugin) BaseClass() string {
+	return "EditorPlugin"
+}
+
+// Method binds of the EditorPlugin class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorPluginAddControlToBottomPanel              = gdnative.NewLazyMethodBind("EditorPlugin", "add_control_to_bottom_panel")
+	methodBindEditorPluginAddControlToContainer                = gdnative.NewLazyMethodBind("EditorPlugin", "add_control_to_container")
+	methodBindEditorPluginAddControlToDock                     = gdnative.NewLazyMethodBind("EditorPlugin", "add_control_to_dock")
+	methodBindEditorPluginAddCustomType                        = gdnative.NewLazyMethodBind("EditorPlugin", "add_custom_type")
+	methodBindEditorPluginAddExportPlugin                      = gdnative.NewLazyMethodBind("EditorPlugin", "add_export_plugin")
+	methodBindEditorPluginAddImportPlugin                      = gdnative.NewLazyMethodBind("EditorPlugin", "add_import_plugin")
+	methodBindEditorPluginAddSceneImportPlugin                 = gdnative.NewLazyMethodBind("EditorPlugin", "add_scene_import_plugin")
+	methodBindEditorPluginAddToolSubmenuItem                   = gdnative.NewLazyMethodBind("EditorPlugin", "add_tool_submenu_item")
+	methodBindEditorPluginApplyChanges                         = gdnative.NewLazyMethodBind("EditorPlugin", "apply_changes")
+	methodBindEditorPluginClear                                = gdnative.NewLazyMethodBind("EditorPlugin", "clear")
+	methodBindEditorPluginCreateSpatialGizmo                   = gdnative.NewLazyMethodBind("EditorPlugin", "create_spatial_gizmo")
+	methodBindEditorPluginEdit                                 = gdnative.NewLazyMethodBind("EditorPlugin", "edit")
+	methodBindEditorPluginForwardCanvasGuiInput                = gdnative.NewLazyMethodBind("EditorPlugin", "forward_canvas_gui_input")
+	methodBindEditorPluginForwardDrawOverViewport              = gdnative.NewLazyMethodBind("EditorPlugin", "forward_draw_over_viewport")
+	methodBindEditorPluginForwardForceDrawOverViewport         = gdnative.NewLazyMethodBind("EditorPlugin", "forward_force_draw_over_viewport")
+	methodBindEditorPluginForwardSpatialGuiInput               = gdnative.NewLazyMethodBind("EditorPlugin", "forward_spatial_gui_input")
+	methodBindEditorPluginGetBreakpoints                       = gdnative.NewLazyMethodBind("EditorPlugin", "get_breakpoints")
+	methodBindEditorPluginGetEditorInterface                   = gdnative.NewLazyMethodBind("EditorPlugin", "get_editor_interface")
+	methodBindEditorPluginGetPluginIcon                        = gdnative.NewLazyMethodBind("EditorPlugin", "get_plugin_icon")
+	methodBindEditorPluginGetPluginName                        = gdnative.NewLazyMethodBind("EditorPlugin", "get_plugin_name")
+	methodBindEditorPluginGetState                             = gdnative.NewLazyMethodBind("EditorPlugin", "get_state")
+	methodBindEditorPluginGetUndoRedo                          = gdnative.NewLazyMethodBind("EditorPlugin", "get_undo_redo")
+	methodBindEditorPluginGetWindowLayout                      = gdnative.NewLazyMethodBind("EditorPlugin", "get_window_layout")
+	methodBindEditorPluginHandles                              = gdnative.NewLazyMethodBind("EditorPlugin", "handles")
+	methodBindEditorPluginHasMainScreen                        = gdnative.NewLazyMethodBind("EditorPlugin", "has_main_screen")
+	methodBindEditorPluginHideBottomPanel                      = gdnative.NewLazyMethodBind("EditorPlugin", "hide_bottom_panel")
+	methodBindEditorPluginMakeBottomPanelItemVisible           = gdnative.NewLazyMethodBind("EditorPlugin", "make_bottom_panel_item_visible")
+	methodBindEditorPluginMakeVisible                          = gdnative.NewLazyMethodBind("EditorPlugin", "make_visible")
+	methodBindEditorPluginQueueSaveLayout                      = gdnative.NewLazyMethodBind("EditorPlugin", "queue_save_layout")
+	methodBindEditorPluginRemoveControlFromBottomPanel         = gdnative.NewLazyMethodBind("EditorPlugin", "remove_control_from_bottom_panel")
+	methodBindEditorPluginRemoveControlFromContainer           = gdnative.NewLazyMethodBind("EditorPlugin", "remove_control_from_container")
+	methodBindEditorPluginRemoveControlFromDocks               = gdnative.NewLazyMethodBind("EditorPlugin", "remove_control_from_docks")
+	methodBindEditorPluginRemoveCustomType                     = gdnative.NewLazyMethodBind("EditorPlugin", "remove_custom_type")
+	methodBindEditorPluginRemoveExportPlugin                   = gdnative.NewLazyMethodBind("EditorPlugin", "remove_export_plugin")
+	methodBindEditorPluginRemoveImportPlugin                   = gdnative.NewLazyMethodBind("EditorPlugin", "remove_import_plugin")
+	methodBindEditorPluginRemoveSceneImportPlugin              = gdnative.NewLazyMethodBind("EditorPlugin", "remove_scene_import_plugin")
+	methodBindEditorPluginSaveExternalData                     = gdnative.NewLazyMethodBind("EditorPlugin", "save_external_data")
+	methodBindEditorPluginSetForceDrawOverForwardingEnabled    = gdnative.NewLazyMethodBind("EditorPlugin", "set_force_draw_over_forwarding_enabled")
+	methodBindEditorPluginSetInputEventForwardingAlwaysEnabled = gdnative.NewLazyMethodBind("EditorPlugin", "set_input_event_forwarding_always_enabled")
+	methodBindEditorPluginSetState                             = gdnative.NewLazyMethodBind("EditorPlugin", "set_state")
+	methodBindEditorPluginSetWindowLayout                      = gdnative.NewLazyMethodBind("EditorPlugin", "set_window_layout")
+	methodBindEditorPluginUpdateOverlays                       = gdnative.NewLazyMethodBind("EditorPlugin", "update_overlays")
+)
+
+/*
+	        Add a control to the bottom panel (together with Output, Debug, Animation, etc). Returns a reference to the button added. It's up to you to hide/show the button when needed. If your plugin is being removed, also make sure to remove your control by calling [method remove_control_from_bottom_panel].
+		Args: [{ false control Object} { false title String}], Returns: ToolButton
+*/
+func (o *EditorPlugin) AddControlToBottomPanel(aControl godot.ObjectImplementer, title gdnative.String) control.ToolButtonImplementer {
+	//log.Println("Calling EditorPlugin.AddControlToBottomPanel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(aControl))
+	ptrArguments[1] = gdnative.NewPointerFromString(title)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddControlToBottomPanel.Get()
+
+	// Call the parent method.
+	// ToolButton
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := control.ToolButton{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(control.ToolButtonImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Add a custom control to a container (see CONTAINER_* enum). There are many locations where custom controls can be added in the editor UI. Please remember that you have to manage the visibility of your custom controls yourself (and likely hide it after adding it). If your plugin is being removed, also make sure to remove your custom controls too.
+		Args: [{ false container int} { false control Object}], Returns: void
+*/
+func (o *EditorPlugin) AddControlToContainer(container gdnative.Int, aControl godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.AddControlToContainer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(container)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(aControl))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddControlToContainer.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add the control to a specific dock slot (see DOCK_* enum for options). If the dock is repositioned and as long as the plugin is active, the editor will save the dock position on further sessions. If your plugin is being removed, also make sure to remove your control by calling [method remove_control_from_docks].
+		Args: [{ false slot int} { false control Object}], Returns: void
+*/
+func (o *EditorPlugin) AddControlToDock(slot gdnative.Int, aControl godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.AddControlToDock()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(slot)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(aControl))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddControlToDock.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add a custom type, which will appear in the list of nodes or resources. An icon can be optionally passed. When given node or resource is selected, the base type will be instanced (ie, "Spatial", "Control", "Resource"), then the script will be loaded and set to this object. You can use the [method EditorPlugin.handles] to check if your custom object is being edited by checking the script or using 'is' keyword. During run-time, this will be a simple object with a script so this function does not need to be called then.
+		Args: [{ false type String} { false base String} { false script Script} { false icon Texture}], Returns: void
+*/
+func (o *EditorPlugin) AddCustomType(aType gdnative.String, base gdnative.String, script godot.ScriptImplementer, icon godot.TextureImplementer) {
+	//log.Println("Calling EditorPlugin.AddCustomType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromString(aType)
+	ptrArguments[1] = gdnative.NewPointerFromString(base)
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(script))
+	ptrArguments[3] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddCustomType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false exporter EditorExportPlugin}], Returns: void
+*/
+func (o *EditorPlugin) AddExportPlugin(exporter EditorExportPluginImplementer) {
+	//log.Println("Calling EditorPlugin.AddExportPlugin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(exporter))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddExportPlugin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false importer EditorImportPlugin}], Returns: void
+*/
+func (o *EditorPlugin) AddImportPlugin(importer EditorImportPluginImplementer) {
+	//log.Println("Calling EditorPlugin.AddImportPlugin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(importer))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddImportPlugin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false scene_importer EditorSceneImporter}], Returns: void
+*/
+func (o *EditorPlugin) AddSceneImportPlugin(sceneImporter EditorSceneImporterImplementer) {
+	//log.Println("Calling EditorPlugin.AddSceneImportPlugin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(sceneImporter))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddSceneImportPlugin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false name String} { false submenu Object}], Returns: void
+*/
+func (o *EditorPlugin) AddToolSubmenuItem(name gdnative.String, submenu godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.AddToolSubmenuItem()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(submenu))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginAddToolSubmenuItem.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        This method is called when the editor is about to save the project, switch to another tab, etc. It asks the plugin to apply any pending state changes to ensure consistency. This is used, for example, in shader editors to let the plugin know that it must apply the shader code being written by the user to the object.
+		Args: [], Returns: void
+*/
+func (o *EditorPlugin) ApplyChanges() {
+	//log.Println("Calling EditorPlugin.ApplyChanges()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginApplyChanges.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Clear all the state and reset the object being edited to zero. This ensures your plugin does not keep editing a currently existing node, or a node from the wrong scene.
+		Args: [], Returns: void
+*/
+func (o *EditorPlugin) Clear() {
+	//log.Println("Calling EditorPlugin.Clear()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginClear.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        This is used for plugins that create gizmos used by the spatial editor. Just check that the node passed in the "for_spatial" argument matches your plugin.
+		Args: [{ false for_spatial Spatial}], Returns: EditorSpatialGizmo
+*/
+func (o *EditorPlugin) CreateSpatialGizmo(forSpatial godot.SpatialImplementer) EditorSpatialGizmoImplementer {
+	//log.Println("Calling EditorPlugin.CreateSpatialGizmo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(forSpatial))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginCreateSpatialGizmo.Get()
+
+	// Call the parent method.
+	// EditorSpatialGizmo
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := EditorSpatialGizmo{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorSpatialGizmoImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        This function is used for plugins that edit specific object types (nodes or resources). It requests the editor to edit the given object.
+		Args: [{ false object Object}], Returns: void
+*/
+func (o *EditorPlugin) Edit(aObject godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.Edit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(aObject))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginEdit.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false event InputEvent}], Returns: bool
+*/
+func (o *EditorPlugin) ForwardCanvasGuiInput(event godot.InputEventImplementer) gdnative.Bool {
+	//log.Println("Calling EditorPlugin.ForwardCanvasGuiInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginForwardCanvasGuiInput.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [{ false overlay Control}], Returns: void
+*/
+func (o *EditorPlugin) ForwardDrawOverViewport(overlay godot.ControlImplementer) {
+	//log.Println("Calling EditorPlugin.ForwardDrawOverViewport()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(overlay))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginForwardDrawOverViewport.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false overlay Control}], Returns: void
+*/
+func (o *EditorPlugin) ForwardForceDrawOverViewport(overlay godot.ControlImplementer) {
+	//log.Println("Calling EditorPlugin.ForwardForceDrawOverViewport()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(overlay))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginForwardForceDrawOverViewport.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Implement this function if you are interested in 3D view screen input events. It will be called only if currently selected node is handled by your plugin. If you would like to always gets those input events then additionally use [method set_input_forwarding_always_enabled].
+		Args: [{ false camera Camera} { false event InputEvent}], Returns: bool
+*/
+func (o *EditorPlugin) ForwardSpatialGuiInput(camera godot.CameraImplementer, event godot.InputEventImplementer) gdnative.Bool {
+	//log.Println("Calling EditorPlugin.ForwardSpatialGuiInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(camera))
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginForwardSpatialGuiInput.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        This is for editors that edit script based objects. You can return a list of breakpoints in the format (script:line), for example: res://path_to_script.gd:25
+		Args: [], Returns: PoolStringArray
+*/
+func (o *EditorPlugin) GetBreakpoints() gdnative.PoolStringArray {
+	//log.Println("Calling EditorPlugin.GetBreakpoints()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginGetBreakpoints.Get()
+
+	// Call the parent method.
+	// PoolStringArray
+	retPtr := gdnative.NewEmptyPoolStringArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewPoolStringArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [], Returns: EditorInterface
+*/
+func (o *EditorPlugin) GetEditorInterface() EditorInterfaceImplementer {
+	//log.Println("Calling EditorPlugin.GetEditorInterface()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginGetEditorInterface.Get()
+
+	// Call the parent method.
+	// EditorInterface
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := EditorInterface{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorInterfaceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [], Returns: Object
+*/
+func (o *EditorPlugin) GetPluginIcon() godot.ObjectImplementer {
+	//log.Println("Calling EditorPlugin.GetPluginIcon()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginGetPluginIcon.Get()
+
+	// Call the parent method.
+	// Object
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [], Returns: String
+*/
+func (o *EditorPlugin) GetPluginName() gdnative.String {
+	//log.Println("Calling EditorPlugin.GetPluginName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginGetPluginName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Get the state of your plugin editor. This is used when saving the scene (so state is kept when opening it again) and for switching tabs (so state can be restored when the tab returns).
+		Args: [], Returns: Dictionary
+*/
+func (o *EditorPlugin) GetState() gdnative.Dictionary {
+	//log.Println("Calling EditorPlugin.GetState()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginGetState.Get()
+
+	// Call the parent method.
+	// Dictionary
+	retPtr := gdnative.NewEmptyDictionary()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewDictionaryFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Get the undo/redo object. Most actions in the editor can be undoable, so use this object to make sure this happens when it's worth it.
+		Args: [], Returns: UndoRedo
+*/
+func (o *EditorPlugin) GetUndoRedo() object.UndoRedoImplementer {
+	//log.Println("Calling EditorPlugin.GetUndoRedo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginGetUndoRedo.Get()
+
+	// Call the parent method.
+	// UndoRedo
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := object.UndoRedo{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(object.UndoRedoImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Get the GUI layout of the plugin. This is used to save the project's editor layout when the [method EditorPlugin.queue_save_layout] is called or the editor layout was changed(For example changing the position of a dock).
+		Args: [{ false layout ConfigFile}], Returns: void
+*/
+func (o *EditorPlugin) GetWindowLayout(layout godot.ConfigFileImplementer) {
+	//log.Println("Calling EditorPlugin.GetWindowLayout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(layout))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginGetWindowLayout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Implement this function if your plugin edits a specific type of object (Resource or Node). If you return true, then you will get the functions [method EditorPlugin.edit] and [method EditorPlugin.make_visible] called when the editor requests them.
+		Args: [{ false object Object}], Returns: bool
+*/
+func (o *EditorPlugin) Handles(aObject godot.ObjectImplementer) gdnative.Bool {
+	//log.Println("Calling EditorPlugin.Handles()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(aObject))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginHandles.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Return true if this is a main screen editor plugin (it goes in the main screen selector together with 2D, 3D, Script).
+		Args: [], Returns: bool
+*/
+func (o *EditorPlugin) HasMainScreen() gdnative.Bool {
+	//log.Println("Calling EditorPlugin.HasMainScreen()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginHasMainScreen.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [], Returns: void
+*/
+func (o *EditorPlugin) HideBottomPanel() {
+	//log.Println("Calling EditorPlugin.HideBottomPanel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginHideBottomPanel.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false item Object}], Returns: void
+*/
+func (o *EditorPlugin) MakeBottomPanelItemVisible(item godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.MakeBottomPanelItemVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(item))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginMakeBottomPanelItemVisible.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        This function will be called when the editor is requested to become visible. It is used for plugins that edit a specific object type. Remember that you have to manage the visibility of all your editor controls manually.
+		Args: [{ false visible bool}], Returns: void
+*/
+func (o *EditorPlugin) MakeVisible(visible gdnative.Bool) {
+	//log.Println("Calling EditorPlugin.MakeVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(visible)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginMakeVisible.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Queue save the project's editor layout.
+		Args: [], Returns: void
+*/
+func (o *EditorPlugin) QueueSaveLayout() {
+	//log.Println("Calling EditorPlugin.QueueSaveLayout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginQueueSaveLayout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Remove the control from the bottom panel. Don't forget to call this if you added one, so the editor can remove it cleanly.
+		Args: [{ false control Object}], Returns: void
+*/
+func (o *EditorPlugin) RemoveControlFromBottomPanel(aControl godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.RemoveControlFromBottomPanel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(aControl))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginRemoveControlFromBottomPanel.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Remove the control from the specified container. Use it when cleaning up after adding a control with [method add_control_to_container]. Note that you can simply free the control if you won't use it anymore.
+		Args: [{ false container int} { false control Object}], Returns: void
+*/
+func (o *EditorPlugin) RemoveControlFromContainer(container gdnative.Int, aControl godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.RemoveControlFromContainer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(container)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(aControl))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginRemoveControlFromContainer.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Remove the control from the dock. Don't forget to call this if you added one, so the editor can save the layout and remove it cleanly.
+		Args: [{ false control Object}], Returns: void
+*/
+func (o *EditorPlugin) RemoveControlFromDocks(aControl godot.ObjectImplementer) {
+	//log.Println("Calling EditorPlugin.RemoveControlFromDocks()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(aControl))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginRemoveControlFromDocks.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Remove a custom type added by [method EditorPlugin.add_custom_type]
+		Args: [{ false type String}], Returns: void
+*/
+func (o *EditorPlugin) RemoveCustomType(aType gdnative.String) {
+	//log.Println("Calling EditorPlugin.RemoveCustomType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(aType)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginRemoveCustomType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false exporter EditorExportPlugin}], Returns: void
+*/
+func (o *EditorPlugin) RemoveExportPlugin(exporter EditorExportPluginImplementer) {
+	//log.Println("Calling EditorPlugin.RemoveExportPlugin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(exporter))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginRemoveExportPlugin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false importer EditorImportPlugin}], Returns: void
+*/
+func (o *EditorPlugin) RemoveImportPlugin(importer EditorImportPluginImplementer) {
+	//log.Println("Calling EditorPlugin.RemoveImportPlugin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(importer))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginRemoveImportPlugin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false scene_importer EditorSceneImporter}], Returns: void
+*/
+func (o *EditorPlugin) RemoveSceneImportPlugin(sceneImporter EditorSceneImporterImplementer) {
+	//log.Println("Calling EditorPlugin.RemoveSceneImportPlugin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(sceneImporter))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginRemoveSceneImportPlugin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        This method is called after the editor saves the project or when it's closed. It asks the plugin to save edited external scenes/resources.
+		Args: [], Returns: void
+*/
+func (o *EditorPlugin) SaveExternalData() {
+	//log.Println("Calling EditorPlugin.SaveExternalData()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginSaveExternalData.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [], Returns: void
+*/
+func (o *EditorPlugin) SetForceDrawOverForwardingEnabled() {
+	//log.Println("Calling EditorPlugin.SetForceDrawOverForwardingEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginSetForceDrawOverForwardingEnabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Use this method if you always want to receive inputs from 3D view screen inside [method forward_spatial_gui_input]. It might be especially usable if your plugin will want to use raycast in the scene.
+		Args: [], Returns: void
+*/
+func (o *EditorPlugin) SetInputEventForwardingAlwaysEnabled() {
+	//log.Println("Calling EditorPlugin.SetInputEventForwardingAlwaysEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginSetInputEventForwardingAlwaysEnabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Restore the state saved by [method EditorPlugin.get_state].
+		Args: [{ false state Dictionary}], Returns: void
+*/
+func (o *EditorPlugin) SetState(state gdnative.Dictionary) {
+	//log.Println("Calling EditorPlugin.SetState()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromDictionary(state)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginSetState.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Restore the plugin GUI layout saved by [method EditorPlugin.get_window_layout].
+		Args: [{ false layout ConfigFile}], Returns: void
+*/
+func (o *EditorPlugin) SetWindowLayout(layout godot.ConfigFileImplementer) {
+	//log.Println("Calling EditorPlugin.SetWindowLayout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(layout))
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginSetWindowLayout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [], Returns: int
+*/
+func (o *EditorPlugin) UpdateOverlays() gdnative.Int {
+	//log.Println("Calling EditorPlugin.UpdateOverlays()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorPluginUpdateOverlays.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+// Signals of the EditorPlugin class.
+const (
+	EditorPluginSignalMainScreenChanged gdnative.String = "main_screen_changed"
+	EditorPluginSignalSceneChanged      gdnative.String = "scene_changed"
+	EditorPluginSignalSceneClosed       gdnative.String = "scene_closed"
+)
+
+// EditorPluginMainScreenChangedArgs holds the arguments of the "main_screen_changed" signal.
+type EditorPluginMainScreenChangedArgs struct {
+	ScreenName gdnative.String
+}
+
+// ConnectMainScreenChanged will connect the "main_screen_changed" signal to the given method of the target object.
+func (o *EditorPlugin) ConnectMainScreenChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorPluginSignalMainScreenChanged, target, method, binds, 0)
+}
+
+// EmitMainScreenChanged will emit the "main_screen_changed" signal.
+func (o *EditorPlugin) EmitMainScreenChanged(args EditorPluginMainScreenChangedArgs) error {
+	arg0 := gdnative.NewVariantString(args.ScreenName)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorPluginSignalMainScreenChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorPluginSceneChangedArgs holds the arguments of the "scene_changed" signal.
+type EditorPluginSceneChangedArgs struct {
+	SceneRoot godot.NodeImplementer
+}
+
+// ConnectSceneChanged will connect the "scene_changed" signal to the given method of the target object.
+func (o *EditorPlugin) ConnectSceneChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorPluginSignalSceneChanged, target, method, binds, 0)
+}
+
+// EmitSceneChanged will emit the "scene_changed" signal.
+func (o *EditorPlugin) EmitSceneChanged(args EditorPluginSceneChangedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.SceneRoot))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorPluginSignalSceneChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorPluginSceneClosedArgs holds the arguments of the "scene_closed" signal.
+type EditorPluginSceneClosedArgs struct {
+	Filepath gdnative.String
+}
+
+// ConnectSceneClosed will connect the "scene_closed" signal to the given method of the target object.
+func (o *EditorPlugin) ConnectSceneClosed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorPluginSignalSceneClosed, target, method, binds, 0)
+}
+
+// EmitSceneClosed will emit the "scene_closed" signal.
+func (o *EditorPlugin) EmitSceneClosed(args EditorPluginSceneClosedArgs) error {
+	arg0 := gdnative.NewVariantString(args.Filepath)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorPluginSignalSceneClosed, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorPluginImplementer is an interface that implements the methods
+// of the EditorPlugin class.
+type EditorPluginImplementer interface {
+	godot.NodeImplementer
+	AddControlToBottomPanel(aControl godot.ObjectImplementer, title gdnative.String) control.ToolButtonImplementer
+	AddControlToContainer(container gdnative.Int, aControl godot.ObjectImplementer)
+	AddControlToDock(slot gdnative.Int, aControl godot.ObjectImplementer)
+	AddCustomType(aType gdnative.String, base gdnative.String, script godot.ScriptImplementer, icon godot.TextureImplementer)
+	AddExportPlugin(exporter EditorExportPluginImplementer)
+	AddImportPlugin(importer EditorImportPluginImplementer)
+	AddSceneImportPlugin(sceneImporter EditorSceneImporterImplementer)
+	AddToolSubmenuItem(name gdnative.String, submenu godot.ObjectImplementer)
+	ApplyChanges()
+	Clear()
+	CreateSpatialGizmo(forSpatial godot.SpatialImplementer) EditorSpatialGizmoImplementer
+	Edit(aObject godot.ObjectImplementer)
+	ForwardCanvasGuiInput(event godot.InputEventImplementer) gdnative.Bool
+	ForwardDrawOverViewport(overlay godot.ControlImplementer)
+	ForwardForceDrawOverViewport(overlay godot.ControlImplementer)
+	ForwardSpatialGuiInput(camera godot.CameraImplementer, event godot.InputEventImplementer) gdnative.Bool
+	GetBreakpoints() gdnative.PoolStringArray
+	GetEditorInterface() EditorInterfaceImplementer
+	GetPluginIcon() godot.ObjectImplementer
+	GetPluginName() gdnative.String
+	GetState() gdnative.Dictionary
+	GetUndoRedo() object.UndoRedoImplementer
+	GetWindowLayout(layout godot.ConfigFileImplementer)
+	Handles(aObject godot.ObjectImplementer) gdnative.Bool
+	HasMainScreen() gdnative.Bool
+	HideBottomPanel()
+	MakeBottomPanelItemVisible(item godot.ObjectImplementer)
+	MakeVisible(visible gdnative.Bool)
+	QueueSaveLayout()
+	RemoveControlFromBottomPanel(aControl godot.ObjectImplementer)
+	RemoveControlFromContainer(container gdnative.Int, aControl godot.ObjectImplementer)
+	RemoveControlFromDocks(aControl godot.ObjectImplementer)
+	RemoveCustomType(aType gdnative.String)
+	RemoveExportPlugin(exporter EditorExportPluginImplementer)
+	RemoveImportPlugin(importer EditorImportPluginImplementer)
+	RemoveSceneImportPlugin(sceneImporter EditorSceneImporterImplementer)
+	SaveExternalData()
+	SetForceDrawOverForwardingEnabled()
+	SetInputEventForwardingAlwaysEnabled()
+	SetState(state gdnative.Dictionary)
+	SetWindowLayout(layout godot.ConfigFileImplementer)
+	UpdateOverlays() gdnative.Int
+	ConnectMainScreenChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMainScreenChanged(args EditorPluginMainScreenChangedArgs) error
+	ConnectSceneChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSceneChanged(args EditorPluginSceneChangedArgs) error
+	ConnectSceneClosed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSceneClosed(args EditorPluginSceneClosedArgs) error
+}
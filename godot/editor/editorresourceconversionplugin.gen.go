@@ -0,0 +1,115 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+ */
+type EditorResourceConversionPlugin struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorEditorResourceConversionPlugin is the class constructor of the EditorResourceConversionPlugin class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorResourceConversionPlugin = gdnative.NewLazyClassConstructor("EditorResourceConversionPlugin")
+
+// NewEditorResourceConversionPlugin will create a new instance of the EditorResourceConversionPlugin class.
+// EditorResourceConversionPlugin is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorResourceConversionPlugin() *EditorResourceConversionPlugin {
+	obj := &EditorResourceConversionPlugin{}
+	obj.SetBaseObject(classConstructorEditorResourceConversionPlugin.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorResourceConversionPlugin) BaseClass() string {
+	return "EditorResourceConversionPlugin"
+}
+
+// Method binds of the EditorResourceConversionPlugin class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorResourceConversionPluginX_Convert    = gdnative.NewLazyMethodBind("EditorResourceConversionPlugin", "_convert")
+	methodBindEditorResourceConversionPluginX_ConvertsTo = gdnative.NewLazyMethodBind("EditorResourceConversionPlugin", "_converts_to")
+)
+
+/*
+Args: [{ false resource Resource}], Returns: Resource
+*/
+func (o *EditorResourceConversionPlugin) X_Convert(resource godot.ResourceImplementer) godot.ResourceImplementer {
+	//log.Println("Calling EditorResourceConversionPlugin.X_Convert()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
+
+	// Get the method bind
+	methodBind := methodBindEditorResourceConversionPluginX_Convert.Get()
+
+	// Call the parent method.
+	// Resource
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ResourceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [], Returns: String
+*/
+func (o *EditorResourceConversionPlugin) X_ConvertsTo() gdnative.String {
+	//log.Println("Calling EditorResourceConversionPlugin.X_ConvertsTo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorResourceConversionPluginX_ConvertsTo.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+// EditorResourceConversionPluginImplementer is an interface that implements the methods
+// of the EditorResourceConversionPlugin class.
+type EditorResourceConversionPluginImplementer interface {
+	godot.ReferenceImplementer
+	X_Convert(resource godot.ResourceImplementer) godot.ResourceImplementer
+	X_ConvertsTo() gdnative.String
+}
@@ -0,0 +1,216 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+This object is used to generate previews for resources of files.
+*/
+type EditorResourcePreview struct {
+	godot.Node
+	owner gdnative.Object
+}
+
+func (o *EditorResourcePreview) BaseClass() string {
+	return "EditorResourcePreview"
+}
+
+// Method binds of the EditorResourcePreview class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorResourcePreviewX_PreviewReady             = gdnative.NewLazyMethodBind("EditorResourcePreview", "_preview_ready")
+	methodBindEditorResourcePreviewAddPreviewGenerator        = gdnative.NewLazyMethodBind("EditorResourcePreview", "add_preview_generator")
+	methodBindEditorResourcePreviewCheckForInvalidation       = gdnative.NewLazyMethodBind("EditorResourcePreview", "check_for_invalidation")
+	methodBindEditorResourcePreviewQueueEditedResourcePreview = gdnative.NewLazyMethodBind("EditorResourcePreview", "queue_edited_resource_preview")
+	methodBindEditorResourcePreviewQueueResourcePreview       = gdnative.NewLazyMethodBind("EditorResourcePreview", "queue_resource_preview")
+	methodBindEditorResourcePreviewRemovePreviewGenerator     = gdnative.NewLazyMethodBind("EditorResourcePreview", "remove_preview_generator")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 String} { false arg1 Texture} { false arg2 int} { false arg3 String} { false arg4 Variant}], Returns: void
+*/
+func (o *EditorResourcePreview) X_PreviewReady(arg0 gdnative.String, arg1 godot.TextureImplementer, arg2 gdnative.Int, arg3 gdnative.String, arg4 gdnative.Variant) {
+	//log.Println("Calling EditorResourcePreview.X_PreviewReady()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg1))
+	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
+	ptrArguments[3] = gdnative.NewPointerFromString(arg3)
+	ptrArguments[4] = gdnative.NewPointerFromVariant(arg4)
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewX_PreviewReady.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Create an own, custom preview generator.
+		Args: [{ false generator EditorResourcePreviewGenerator}], Returns: void
+*/
+func (o *EditorResourcePreview) AddPreviewGenerator(generator EditorResourcePreviewGeneratorImplementer) {
+	//log.Println("Calling EditorResourcePreview.AddPreviewGenerator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(generator))
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewAddPreviewGenerator.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Check if the resource changed, if so it will be invalidated and the corresponding signal emitted.
+		Args: [{ false path String}], Returns: void
+*/
+func (o *EditorResourcePreview) CheckForInvalidation(path gdnative.String) {
+	//log.Println("Calling EditorResourcePreview.CheckForInvalidation()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewCheckForInvalidation.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Queue a resource being edited for preview (using an instance). Once the preview is ready, your receiver.receiver_func will be called either containing the preview texture or an empty texture (if no preview was possible). Callback must have the format: (path,texture,userdata). Userdata can be anything.
+		Args: [{ false resource Resource} { false receiver Object} { false receiver_func String} { false userdata Variant}], Returns: void
+*/
+func (o *EditorResourcePreview) QueueEditedResourcePreview(resource godot.ResourceImplementer, receiver godot.ObjectImplementer, receiverFunc gdnative.String, userdata gdnative.Variant) {
+	//log.Println("Calling EditorResourcePreview.QueueEditedResourcePreview()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(receiver))
+	ptrArguments[2] = gdnative.NewPointerFromString(receiverFunc)
+	ptrArguments[3] = gdnative.NewPointerFromVariant(userdata)
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewQueueEditedResourcePreview.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Queue a resource file for preview (using a path). Once the preview is ready, your receiver.receiver_func will be called either containing the preview texture or an empty texture (if no preview was possible). Callback must have the format: (path,texture,userdata). Userdata can be anything.
+		Args: [{ false path String} { false receiver Object} { false receiver_func String} { false userdata Variant}], Returns: void
+*/
+func (o *EditorResourcePreview) QueueResourcePreview(path gdnative.String, receiver godot.ObjectImplementer, receiverFunc gdnative.String, userdata gdnative.Variant) {
+	//log.Println("Calling EditorResourcePreview.QueueResourcePreview()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(receiver))
+	ptrArguments[2] = gdnative.NewPointerFromString(receiverFunc)
+	ptrArguments[3] = gdnative.NewPointerFromVariant(userdata)
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewQueueResourcePreview.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Remove a custom preview generator.
+		Args: [{ false generator EditorResourcePreviewGenerator}], Returns: void
+*/
+func (o *EditorResourcePreview) RemovePreviewGenerator(generator EditorResourcePreviewGeneratorImplementer) {
+	//log.Println("Calling EditorResourcePreview.RemovePreviewGenerator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(generator))
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewRemovePreviewGenerator.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Signals of the EditorResourcePreview class.
+const (
+	EditorResourcePreviewSignalPreviewInvalidated gdnative.String = "preview_invalidated"
+)
+
+// EditorResourcePreviewPreviewInvalidatedArgs holds the arguments of the "preview_invalidated" signal.
+type EditorResourcePreviewPreviewInvalidatedArgs struct {
+	Path gdnative.String
+}
+
+// ConnectPreviewInvalidated will connect the "preview_invalidated" signal to the given method of the target object.
+func (o *EditorResourcePreview) ConnectPreviewInvalidated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorResourcePreviewSignalPreviewInvalidated, target, method, binds, 0)
+}
+
+// EmitPreviewInvalidated will emit the "preview_invalidated" signal.
+func (o *EditorResourcePreview) EmitPreviewInvalidated(args EditorResourcePreviewPreviewInvalidatedArgs) error {
+	arg0 := gdnative.NewVariantString(args.Path)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(EditorResourcePreviewSignalPreviewInvalidated, arg0)
+	ret.Destroy()
+	return err
+}
+
+// EditorResourcePreviewImplementer is an interface that implements the methods
+// of the EditorResourcePreview class.
+type EditorResourcePreviewImplementer interface {
+	godot.NodeImplementer
+	X_PreviewReady(arg0 gdnative.String, arg1 godot.TextureImplementer, arg2 gdnative.Int, arg3 gdnative.String, arg4 gdnative.Variant)
+	AddPreviewGenerator(generator EditorResourcePreviewGeneratorImplementer)
+	CheckForInvalidation(path gdnative.String)
+	QueueEditedResourcePreview(resource godot.ResourceImplementer, receiver godot.ObjectImplementer, receiverFunc gdnative.String, userdata gdnative.Variant)
+	QueueResourcePreview(path gdnative.String, receiver godot.ObjectImplementer, receiverFunc gdnative.String, userdata gdnative.Variant)
+	RemovePreviewGenerator(generator EditorResourcePreviewGeneratorImplementer)
+	ConnectPreviewInvalidated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPreviewInvalidated(args EditorResourcePreviewPreviewInvalidatedArgs) error
+}
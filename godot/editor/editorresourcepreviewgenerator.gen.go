@@ -0,0 +1,157 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Custom code to generate previews. Please check "file_dialog/thumbnail_size" in EditorSettings to find out the right size to do previews at.
+*/
+type EditorResourcePreviewGenerator struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorEditorResourcePreviewGenerator is the class constructor of the EditorResourcePreviewGenerator class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorResourcePreviewGenerator = gdnative.NewLazyClassConstructor("EditorResourcePreviewGenerator")
+
+// NewEditorResourcePreviewGenerator will create a new instance of the EditorResourcePreviewGenerator class.
+// EditorResourcePreviewGenerator is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorResourcePreviewGenerator() *EditorResourcePreviewGenerator {
+	obj := &EditorResourcePreviewGenerator{}
+	obj.SetBaseObject(classConstructorEditorResourcePreviewGenerator.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorResourcePreviewGenerator) BaseClass() string {
+	return "EditorResourcePreviewGenerator"
+}
+
+// Method binds of the EditorResourcePreviewGenerator class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorResourcePreviewGeneratorGenerate         = gdnative.NewLazyMethodBind("EditorResourcePreviewGenerator", "generate")
+	methodBindEditorResourcePreviewGeneratorGenerateFromPath = gdnative.NewLazyMethodBind("EditorResourcePreviewGenerator", "generate_from_path")
+	methodBindEditorResourcePreviewGeneratorHandles          = gdnative.NewLazyMethodBind("EditorResourcePreviewGenerator", "handles")
+)
+
+/*
+	        Generate a preview from a given resource. This must be always implemented. Returning an empty texture is an OK way to fail and let another generator take care. Care must be taken because this function is always called from a thread (not the main thread).
+		Args: [{ false from Resource}], Returns: Texture
+*/
+func (o *EditorResourcePreviewGenerator) Generate(from godot.ResourceImplementer) godot.TextureImplementer {
+	//log.Println("Calling EditorResourcePreviewGenerator.Generate()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(from))
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewGeneratorGenerate.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Generate a preview directly from a path, implementing this is optional, as default code will load and call generate() Returning an empty texture is an OK way to fail and let another generator take care. Care must be taken because this function is always called from a thread (not the main thread).
+		Args: [{ false path String}], Returns: Texture
+*/
+func (o *EditorResourcePreviewGenerator) GenerateFromPath(path gdnative.String) godot.TextureImplementer {
+	//log.Println("Calling EditorResourcePreviewGenerator.GenerateFromPath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewGeneratorGenerateFromPath.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Return if your generator supports this resource type.
+		Args: [{ false type String}], Returns: bool
+*/
+func (o *EditorResourcePreviewGenerator) Handles(aType gdnative.String) gdnative.Bool {
+	//log.Println("Calling EditorResourcePreviewGenerator.Handles()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(aType)
+
+	// Get the method bind
+	methodBind := methodBindEditorResourcePreviewGeneratorHandles.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+// EditorResourcePreviewGeneratorImplementer is an interface that implements the methods
+// of the EditorResourcePreviewGenerator class.
+type EditorResourcePreviewGeneratorImplementer interface {
+	godot.ReferenceImplementer
+	Generate(from godot.ResourceImplementer) godot.TextureImplementer
+	GenerateFromPath(path gdnative.String) godot.TextureImplementer
+	Handles(aType gdnative.String) gdnative.Bool
+}
@@ -0,0 +1,273 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/animation"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// Constants of the EditorSceneImporter class.
+const (
+	EditorSceneImporterImportAnimation                         gdnative.Int = 2
+	EditorSceneImporterImportAnimationDetectLoop               gdnative.Int = 4
+	EditorSceneImporterImportAnimationForceAllTracksInAllClips gdnative.Int = 16
+	EditorSceneImporterImportAnimationKeepValueTracks          gdnative.Int = 32
+	EditorSceneImporterImportAnimationOptimize                 gdnative.Int = 8
+	EditorSceneImporterImportFailOnMissingDependencies         gdnative.Int = 512
+	EditorSceneImporterImportGenerateTangentArrays             gdnative.Int = 256
+	EditorSceneImporterImportMaterialsInInstances              gdnative.Int = 1024
+	EditorSceneImporterImportScene                             gdnative.Int = 1
+	EditorSceneImporterImportUseCompression                    gdnative.Int = 2048
+)
+
+/*
+ */
+type EditorSceneImporter struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorEditorSceneImporter is the class constructor of the EditorSceneImporter class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorSceneImporter = gdnative.NewLazyClassConstructor("EditorSceneImporter")
+
+// NewEditorSceneImporter will create a new instance of the EditorSceneImporter class.
+// EditorSceneImporter is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorSceneImporter() *EditorSceneImporter {
+	obj := &EditorSceneImporter{}
+	obj.SetBaseObject(classConstructorEditorSceneImporter.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorSceneImporter) BaseClass() string {
+	return "EditorSceneImporter"
+}
+
+// Method binds of the EditorSceneImporter class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorSceneImporterX_GetExtensions                  = gdnative.NewLazyMethodBind("EditorSceneImporter", "_get_extensions")
+	methodBindEditorSceneImporterX_GetImportFlags                 = gdnative.NewLazyMethodBind("EditorSceneImporter", "_get_import_flags")
+	methodBindEditorSceneImporterX_ImportAnimation                = gdnative.NewLazyMethodBind("EditorSceneImporter", "_import_animation")
+	methodBindEditorSceneImporterX_ImportScene                    = gdnative.NewLazyMethodBind("EditorSceneImporter", "_import_scene")
+	methodBindEditorSceneImporterImportAnimationFromOtherImporter = gdnative.NewLazyMethodBind("EditorSceneImporter", "import_animation_from_other_importer")
+	methodBindEditorSceneImporterImportSceneFromOtherImporter     = gdnative.NewLazyMethodBind("EditorSceneImporter", "import_scene_from_other_importer")
+)
+
+/*
+Args: [], Returns: Array
+*/
+func (o *EditorSceneImporter) X_GetExtensions() gdnative.Array {
+	//log.Println("Calling EditorSceneImporter.X_GetExtensions()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSceneImporterX_GetExtensions.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [], Returns: int
+*/
+func (o *EditorSceneImporter) X_GetImportFlags() gdnative.Int {
+	//log.Println("Calling EditorSceneImporter.X_GetImportFlags()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSceneImporterX_GetImportFlags.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [{ false path String} { false flags int} { false bake_fps int}], Returns: Animation
+*/
+func (o *EditorSceneImporter) X_ImportAnimation(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) animation.AnimationImplementer {
+	//log.Println("Calling EditorSceneImporter.X_ImportAnimation()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
+	ptrArguments[2] = gdnative.NewPointerFromInt(bakeFps)
+
+	// Get the method bind
+	methodBind := methodBindEditorSceneImporterX_ImportAnimation.Get()
+
+	// Call the parent method.
+	// Animation
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := animation.Animation{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(animation.AnimationImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [{ false path String} { false flags int} { false bake_fps int}], Returns: Node
+*/
+func (o *EditorSceneImporter) X_ImportScene(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) godot.NodeImplementer {
+	//log.Println("Calling EditorSceneImporter.X_ImportScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
+	ptrArguments[2] = gdnative.NewPointerFromInt(bakeFps)
+
+	// Get the method bind
+	methodBind := methodBindEditorSceneImporterX_ImportScene.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [{ false path String} { false flags int} { false bake_fps int}], Returns: Animation
+*/
+func (o *EditorSceneImporter) ImportAnimationFromOtherImporter(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) animation.AnimationImplementer {
+	//log.Println("Calling EditorSceneImporter.ImportAnimationFromOtherImporter()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
+	ptrArguments[2] = gdnative.NewPointerFromInt(bakeFps)
+
+	// Get the method bind
+	methodBind := methodBindEditorSceneImporterImportAnimationFromOtherImporter.Get()
+
+	// Call the parent method.
+	// Animation
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := animation.Animation{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(animation.AnimationImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+Args: [{ false path String} { false flags int} { false bake_fps int}], Returns: Node
+*/
+func (o *EditorSceneImporter) ImportSceneFromOtherImporter(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) godot.NodeImplementer {
+	//log.Println("Calling EditorSceneImporter.ImportSceneFromOtherImporter()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
+	ptrArguments[2] = gdnative.NewPointerFromInt(bakeFps)
+
+	// Get the method bind
+	methodBind := methodBindEditorSceneImporterImportSceneFromOtherImporter.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+// EditorSceneImporterImplementer is an interface that implements the methods
+// of the EditorSceneImporter class.
+type EditorSceneImporterImplementer interface {
+	godot.ReferenceImplementer
+	X_GetExtensions() gdnative.Array
+	X_GetImportFlags() gdnative.Int
+	X_ImportAnimation(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) animation.AnimationImplementer
+	X_ImportScene(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) godot.NodeImplementer
+	ImportAnimationFromOtherImporter(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) animation.AnimationImplementer
+	ImportSceneFromOtherImporter(path gdnative.String, flags gdnative.Int, bakeFps gdnative.Int) godot.NodeImplementer
+}
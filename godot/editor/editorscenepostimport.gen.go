@@ -0,0 +1,76 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+ */
+type EditorScenePostImport struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorEditorScenePostImport is the class constructor of the EditorScenePostImport class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorScenePostImport = gdnative.NewLazyClassConstructor("EditorScenePostImport")
+
+// NewEditorScenePostImport will create a new instance of the EditorScenePostImport class.
+// EditorScenePostImport is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorScenePostImport() *EditorScenePostImport {
+	obj := &EditorScenePostImport{}
+	obj.SetBaseObject(classConstructorEditorScenePostImport.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorScenePostImport) BaseClass() string {
+	return "EditorScenePostImport"
+}
+
+// Method binds of the EditorScenePostImport class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorScenePostImportPostImport = gdnative.NewLazyMethodBind("EditorScenePostImport", "post_import")
+)
+
+/*
+Args: [{ false scene Object}], Returns: void
+*/
+func (o *EditorScenePostImport) PostImport(scene godot.ObjectImplementer) {
+	//log.Println("Calling EditorScenePostImport.PostImport()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(scene))
+
+	// Get the method bind
+	methodBind := methodBindEditorScenePostImportPostImport.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// EditorScenePostImportImplementer is an interface that implements the methods
+// of the EditorScenePostImport class.
+type EditorScenePostImportImplementer interface {
+	godot.ReferenceImplementer
+	PostImport(scene godot.ObjectImplementer)
+}
@@ -0,0 +1,174 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Scripts extending this class and implementing its [code]_run()[/code] method can be executed from the Script Editor's [code]File -> Run[/code] menu option (or by pressing [code]CTRL+Shift+X[/code]) while the editor is running. This is useful for adding custom in-editor functionality to Godot. For more complex additions, consider using [EditorPlugin]s instead. Note that extending scripts need to have [code]tool mode[/code] enabled. Example script: [codeblock] tool extends EditorScript func _run(): print("Hello from the Godot Editor!") [/codeblock] Note that the script is run in the Editor context, which means the output is visible in the console window started with the Editor (STDOUT) instead of the usual Godot [i]Output[/i] dock.
+*/
+type EditorScript struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorEditorScript is the class constructor of the EditorScript class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorScript = gdnative.NewLazyClassConstructor("EditorScript")
+
+// NewEditorScript will create a new instance of the EditorScript class.
+// EditorScript is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorScript() *EditorScript {
+	obj := &EditorScript{}
+	obj.SetBaseObject(classConstructorEditorScript.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorScript) BaseClass() string {
+	return "EditorScript"
+}
+
+// Method binds of the EditorScript class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorScriptX_Run              = gdnative.NewLazyMethodBind("EditorScript", "_run")
+	methodBindEditorScriptAddRootNode        = gdnative.NewLazyMethodBind("EditorScript", "add_root_node")
+	methodBindEditorScriptGetEditorInterface = gdnative.NewLazyMethodBind("EditorScript", "get_editor_interface")
+	methodBindEditorScriptGetScene           = gdnative.NewLazyMethodBind("EditorScript", "get_scene")
+)
+
+/*
+	        This method is executed by the Editor when [code]File -> Run[/code] is used.
+		Args: [], Returns: void
+*/
+func (o *EditorScript) X_Run() {
+	//log.Println("Calling EditorScript.X_Run()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorScriptX_Run.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Adds [code]node[/code] as a child of the root node in the editor context. WARNING: The implementation of this method is currently disabled.
+		Args: [{ false node Object}], Returns: void
+*/
+func (o *EditorScript) AddRootNode(node godot.ObjectImplementer) {
+	//log.Println("Calling EditorScript.AddRootNode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
+
+	// Get the method bind
+	methodBind := methodBindEditorScriptAddRootNode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Returns the [EditorInterface] singleton instance.
+		Args: [], Returns: EditorInterface
+*/
+func (o *EditorScript) GetEditorInterface() EditorInterfaceImplementer {
+	//log.Println("Calling EditorScript.GetEditorInterface()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorScriptGetEditorInterface.Get()
+
+	// Call the parent method.
+	// EditorInterface
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := EditorInterface{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EditorInterfaceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the Editor's currently active scene.
+		Args: [], Returns: Node
+*/
+func (o *EditorScript) GetScene() godot.NodeImplementer {
+	//log.Println("Calling EditorScript.GetScene()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorScriptGetScene.Get()
+
+	// Call the parent method.
+	// Node
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NodeImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+// EditorScriptImplementer is an interface that implements the methods
+// of the EditorScript class.
+type EditorScriptImplementer interface {
+	godot.ReferenceImplementer
+	X_Run()
+	AddRootNode(node godot.ObjectImplementer)
+	GetEditorInterface() EditorInterfaceImplementer
+	GetScene() godot.NodeImplementer
+}
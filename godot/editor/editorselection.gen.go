@@ -0,0 +1,238 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+This object manages the SceneTree selection in the editor.
+*/
+type EditorSelection struct {
+	godot.Object
+	owner gdnative.Object
+}
+
+// classConstructorEditorSelection is the class constructor of the EditorSelection class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorSelection = gdnative.NewLazyClassConstructor("EditorSelection")
+
+// NewEditorSelection will create a new instance of the EditorSelection class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewEditorSelection() *EditorSelection {
+	obj := &EditorSelection{}
+	obj.SetBaseObject(classConstructorEditorSelection.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorSelection) BaseClass() string {
+	return "EditorSelection"
+}
+
+// Method binds of the EditorSelection class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorSelectionX_EmitChange                  = gdnative.NewLazyMethodBind("EditorSelection", "_emit_change")
+	methodBindEditorSelectionX_NodeRemoved                 = gdnative.NewLazyMethodBind("EditorSelection", "_node_removed")
+	methodBindEditorSelectionAddNode                       = gdnative.NewLazyMethodBind("EditorSelection", "add_node")
+	methodBindEditorSelectionClear                         = gdnative.NewLazyMethodBind("EditorSelection", "clear")
+	methodBindEditorSelectionGetSelectedNodes              = gdnative.NewLazyMethodBind("EditorSelection", "get_selected_nodes")
+	methodBindEditorSelectionGetTransformableSelectedNodes = gdnative.NewLazyMethodBind("EditorSelection", "get_transformable_selected_nodes")
+	methodBindEditorSelectionRemoveNode                    = gdnative.NewLazyMethodBind("EditorSelection", "remove_node")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *EditorSelection) X_EmitChange() {
+	//log.Println("Calling EditorSelection.X_EmitChange()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSelectionX_EmitChange.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
+*/
+func (o *EditorSelection) X_NodeRemoved(arg0 godot.ObjectImplementer) {
+	//log.Println("Calling EditorSelection.X_NodeRemoved()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindEditorSelectionX_NodeRemoved.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add a node to the selection.
+		Args: [{ false node Object}], Returns: void
+*/
+func (o *EditorSelection) AddNode(node godot.ObjectImplementer) {
+	//log.Println("Calling EditorSelection.AddNode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
+
+	// Get the method bind
+	methodBind := methodBindEditorSelectionAddNode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Clear the selection.
+		Args: [], Returns: void
+*/
+func (o *EditorSelection) Clear() {
+	//log.Println("Calling EditorSelection.Clear()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSelectionClear.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Get the list of selected nodes.
+		Args: [], Returns: Array
+*/
+func (o *EditorSelection) GetSelectedNodes() gdnative.Array {
+	//log.Println("Calling EditorSelection.GetSelectedNodes()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSelectionGetSelectedNodes.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Get the list of selected nodes, optimized for transform operations (ie, moving them, rotating, etc). This list avoids situations where a node is selected and also chid/grandchild.
+		Args: [], Returns: Array
+*/
+func (o *EditorSelection) GetTransformableSelectedNodes() gdnative.Array {
+	//log.Println("Calling EditorSelection.GetTransformableSelectedNodes()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSelectionGetTransformableSelectedNodes.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Remove a node from the selection.
+		Args: [{ false node Object}], Returns: void
+*/
+func (o *EditorSelection) RemoveNode(node godot.ObjectImplementer) {
+	//log.Println("Calling EditorSelection.RemoveNode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
+
+	// Get the method bind
+	methodBind := methodBindEditorSelectionRemoveNode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Signals of the EditorSelection class.
+const (
+	EditorSelectionSignalSelectionChanged gdnative.String = "selection_changed"
+)
+
+// ConnectSelectionChanged will connect the "selection_changed" signal to the given method of the target object.
+func (o *EditorSelection) ConnectSelectionChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorSelectionSignalSelectionChanged, target, method, binds, 0)
+}
+
+// EmitSelectionChanged will emit the "selection_changed" signal.
+func (o *EditorSelection) EmitSelectionChanged() error {
+
+	ret, err := o.EmitSignal(EditorSelectionSignalSelectionChanged)
+	ret.Destroy()
+	return err
+}
+
+// EditorSelectionImplementer is an interface that implements the methods
+// of the EditorSelection class.
+type EditorSelectionImplementer interface {
+	godot.ObjectImplementer
+	X_EmitChange()
+	X_NodeRemoved(arg0 godot.ObjectImplementer)
+	AddNode(node godot.ObjectImplementer)
+	Clear()
+	GetSelectedNodes() gdnative.Array
+	GetTransformableSelectedNodes() gdnative.Array
+	RemoveNode(node godot.ObjectImplementer)
+	ConnectSelectionChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSelectionChanged() error
+}
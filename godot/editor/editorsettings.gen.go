@@ -1,7 +1,8 @@
-package godot
+package editor
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewEditorSettingsFromPointer(ptr gdnative.Pointer) EditorSettings {
-func newEditorSettingsFromPointer(ptr gdnative.Pointer) EditorSettings {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EditorSettings{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Object that holds the project-independent editor settings. These settings are generally visible in the Editor Settings menu. Accessing the settings is done by using the regular [Object] API, such as: [codeblock] settings.set(prop,value) settings.get(prop) list_of_settings = settings.get_property_list() [/codeblock]
 */
 type EditorSettings struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
@@ -34,9 +26,28 @@ func (o *EditorSettings) BaseClass() string {
 	return "EditorSettings"
 }
 
+// Method binds of the EditorSettings class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorSettingsAddPropertyInfo       = gdnative.NewLazyMethodBind("EditorSettings", "add_property_info")
+	methodBindEditorSettingsErase                 = gdnative.NewLazyMethodBind("EditorSettings", "erase")
+	methodBindEditorSettingsGetFavoriteDirs       = gdnative.NewLazyMethodBind("EditorSettings", "get_favorite_dirs")
+	methodBindEditorSettingsGetProjectSettingsDir = gdnative.NewLazyMethodBind("EditorSettings", "get_project_settings_dir")
+	methodBindEditorSettingsGetRecentDirs         = gdnative.NewLazyMethodBind("EditorSettings", "get_recent_dirs")
+	methodBindEditorSettingsGetSetting            = gdnative.NewLazyMethodBind("EditorSettings", "get_setting")
+	methodBindEditorSettingsGetSettingsDir        = gdnative.NewLazyMethodBind("EditorSettings", "get_settings_dir")
+	methodBindEditorSettingsHasSetting            = gdnative.NewLazyMethodBind("EditorSettings", "has_setting")
+	methodBindEditorSettingsPropertyCanRevert     = gdnative.NewLazyMethodBind("EditorSettings", "property_can_revert")
+	methodBindEditorSettingsPropertyGetRevert     = gdnative.NewLazyMethodBind("EditorSettings", "property_get_revert")
+	methodBindEditorSettingsSetFavoriteDirs       = gdnative.NewLazyMethodBind("EditorSettings", "set_favorite_dirs")
+	methodBindEditorSettingsSetInitialValue       = gdnative.NewLazyMethodBind("EditorSettings", "set_initial_value")
+	methodBindEditorSettingsSetRecentDirs         = gdnative.NewLazyMethodBind("EditorSettings", "set_recent_dirs")
+	methodBindEditorSettingsSetSetting            = gdnative.NewLazyMethodBind("EditorSettings", "set_setting")
+)
+
 /*
-        Add a custom property info to a property. The dictionary must contain: name:[String](the name of the property) and type:[int](see TYPE_* in [@GlobalScope]), and optionally hint:[int](see PROPERTY_HINT_* in [@GlobalScope]), hint_string:[String]. Example: [codeblock] editor_settings.set("category/property_name", 0) var property_info = { "name": "category/property_name", "type": TYPE_INT, "hint": PROPERTY_HINT_ENUM, "hint_string": "one,two,three" } editor_settings.add_property_info(property_info) [/codeblock]
-	Args: [{ false info Dictionary}], Returns: void
+	        Add a custom property info to a property. The dictionary must contain: name:[String](the name of the property) and type:[int](see TYPE_* in [@GlobalScope]), and optionally hint:[int](see PROPERTY_HINT_* in [@GlobalScope]), hint_string:[String]. Example: [codeblock] editor_settings.set("category/property_name", 0) var property_info = { "name": "category/property_name", "type": TYPE_INT, "hint": PROPERTY_HINT_ENUM, "hint_string": "one,two,three" } editor_settings.add_property_info(property_info) [/codeblock]
+		Args: [{ false info Dictionary}], Returns: void
 */
 func (o *EditorSettings) AddPropertyInfo(info gdnative.Dictionary) {
 	//log.Println("Calling EditorSettings.AddPropertyInfo()")
@@ -46,7 +57,7 @@ func (o *EditorSettings) AddPropertyInfo(info gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(info)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "add_property_info")
+	methodBind := methodBindEditorSettingsAddPropertyInfo.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +67,8 @@ func (o *EditorSettings) AddPropertyInfo(info gdnative.Dictionary) {
 }
 
 /*
-        Erase a given setting (pass full property path).
-	Args: [{ false property String}], Returns: void
+	        Erase a given setting (pass full property path).
+		Args: [{ false property String}], Returns: void
 */
 func (o *EditorSettings) Erase(property gdnative.String) {
 	//log.Println("Calling EditorSettings.Erase()")
@@ -67,7 +78,7 @@ func (o *EditorSettings) Erase(property gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(property)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "erase")
+	methodBind := methodBindEditorSettingsErase.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +88,8 @@ func (o *EditorSettings) Erase(property gdnative.String) {
 }
 
 /*
-        Get the list of favorite directories for this project.
-	Args: [], Returns: PoolStringArray
+	        Get the list of favorite directories for this project.
+		Args: [], Returns: PoolStringArray
 */
 func (o *EditorSettings) GetFavoriteDirs() gdnative.PoolStringArray {
 	//log.Println("Calling EditorSettings.GetFavoriteDirs()")
@@ -87,7 +98,7 @@ func (o *EditorSettings) GetFavoriteDirs() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "get_favorite_dirs")
+	methodBind := methodBindEditorSettingsGetFavoriteDirs.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -100,8 +111,8 @@ func (o *EditorSettings) GetFavoriteDirs() gdnative.PoolStringArray {
 }
 
 /*
-        Get the specific project settings path. Projects all have a unique sub-directory inside the settings path where project specific settings are saved.
-	Args: [], Returns: String
+	        Get the specific project settings path. Projects all have a unique sub-directory inside the settings path where project specific settings are saved.
+		Args: [], Returns: String
 */
 func (o *EditorSettings) GetProjectSettingsDir() gdnative.String {
 	//log.Println("Calling EditorSettings.GetProjectSettingsDir()")
@@ -110,7 +121,7 @@ func (o *EditorSettings) GetProjectSettingsDir() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "get_project_settings_dir")
+	methodBind := methodBindEditorSettingsGetProjectSettingsDir.Get()
 
 	// Call the parent method.
 	// String
@@ -123,8 +134,8 @@ func (o *EditorSettings) GetProjectSettingsDir() gdnative.String {
 }
 
 /*
-        Get the list of recently visited folders in the file dialog for this project.
-	Args: [], Returns: PoolStringArray
+	        Get the list of recently visited folders in the file dialog for this project.
+		Args: [], Returns: PoolStringArray
 */
 func (o *EditorSettings) GetRecentDirs() gdnative.PoolStringArray {
 	//log.Println("Calling EditorSettings.GetRecentDirs()")
@@ -133,7 +144,7 @@ func (o *EditorSettings) GetRecentDirs() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "get_recent_dirs")
+	methodBind := methodBindEditorSettingsGetRecentDirs.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -146,8 +157,7 @@ func (o *EditorSettings) GetRecentDirs() gdnative.PoolStringArray {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: Variant
+Args: [{ false name String}], Returns: Variant
 */
 func (o *EditorSettings) GetSetting(name gdnative.String) gdnative.Variant {
 	//log.Println("Calling EditorSettings.GetSetting()")
@@ -157,7 +167,7 @@ func (o *EditorSettings) GetSetting(name gdnative.String) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "get_setting")
+	methodBind := methodBindEditorSettingsGetSetting.Get()
 
 	// Call the parent method.
 	// Variant
@@ -170,8 +180,8 @@ func (o *EditorSettings) GetSetting(name gdnative.String) gdnative.Variant {
 }
 
 /*
-        Get the global settings path for the engine. Inside this path you can find some standard paths such as: settings/tmp - used for temporary storage of files settings/templates - where export templates are located
-	Args: [], Returns: String
+	        Get the global settings path for the engine. Inside this path you can find some standard paths such as: settings/tmp - used for temporary storage of files settings/templates - where export templates are located
+		Args: [], Returns: String
 */
 func (o *EditorSettings) GetSettingsDir() gdnative.String {
 	//log.Println("Calling EditorSettings.GetSettingsDir()")
@@ -180,7 +190,7 @@ func (o *EditorSettings) GetSettingsDir() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "get_settings_dir")
+	methodBind := methodBindEditorSettingsGetSettingsDir.Get()
 
 	// Call the parent method.
 	// String
@@ -193,8 +203,7 @@ func (o *EditorSettings) GetSettingsDir() gdnative.String {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *EditorSettings) HasSetting(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling EditorSettings.HasSetting()")
@@ -204,7 +213,7 @@ func (o *EditorSettings) HasSetting(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "has_setting")
+	methodBind := methodBindEditorSettingsHasSetting.Get()
 
 	// Call the parent method.
 	// bool
@@ -217,8 +226,7 @@ func (o *EditorSettings) HasSetting(name gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *EditorSettings) PropertyCanRevert(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling EditorSettings.PropertyCanRevert()")
@@ -228,7 +236,7 @@ func (o *EditorSettings) PropertyCanRevert(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "property_can_revert")
+	methodBind := methodBindEditorSettingsPropertyCanRevert.Get()
 
 	// Call the parent method.
 	// bool
@@ -241,8 +249,7 @@ func (o *EditorSettings) PropertyCanRevert(name gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: Variant
+Args: [{ false name String}], Returns: Variant
 */
 func (o *EditorSettings) PropertyGetRevert(name gdnative.String) gdnative.Variant {
 	//log.Println("Calling EditorSettings.PropertyGetRevert()")
@@ -252,7 +259,7 @@ func (o *EditorSettings) PropertyGetRevert(name gdnative.String) gdnative.Varian
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "property_get_revert")
+	methodBind := methodBindEditorSettingsPropertyGetRevert.Get()
 
 	// Call the parent method.
 	// Variant
@@ -265,8 +272,8 @@ func (o *EditorSettings) PropertyGetRevert(name gdnative.String) gdnative.Varian
 }
 
 /*
-        Set the list of favorite directories for this project.
-	Args: [{ false dirs PoolStringArray}], Returns: void
+	        Set the list of favorite directories for this project.
+		Args: [{ false dirs PoolStringArray}], Returns: void
 */
 func (o *EditorSettings) SetFavoriteDirs(dirs gdnative.PoolStringArray) {
 	//log.Println("Calling EditorSettings.SetFavoriteDirs()")
@@ -276,7 +283,7 @@ func (o *EditorSettings) SetFavoriteDirs(dirs gdnative.PoolStringArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolStringArray(dirs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "set_favorite_dirs")
+	methodBind := methodBindEditorSettingsSetFavoriteDirs.Get()
 
 	// Call the parent method.
 	// void
@@ -286,8 +293,7 @@ func (o *EditorSettings) SetFavoriteDirs(dirs gdnative.PoolStringArray) {
 }
 
 /*
-
-	Args: [{ false name String} { false value Variant} { false update_current bool}], Returns: void
+Args: [{ false name String} { false value Variant} { false update_current bool}], Returns: void
 */
 func (o *EditorSettings) SetInitialValue(name gdnative.String, value gdnative.Variant, updateCurrent gdnative.Bool) {
 	//log.Println("Calling EditorSettings.SetInitialValue()")
@@ -299,7 +305,7 @@ func (o *EditorSettings) SetInitialValue(name gdnative.String, value gdnative.Va
 	ptrArguments[2] = gdnative.NewPointerFromBool(updateCurrent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "set_initial_value")
+	methodBind := methodBindEditorSettingsSetInitialValue.Get()
 
 	// Call the parent method.
 	// void
@@ -309,8 +315,8 @@ func (o *EditorSettings) SetInitialValue(name gdnative.String, value gdnative.Va
 }
 
 /*
-        Set the list of recently visited folders in the file dialog for this project.
-	Args: [{ false dirs PoolStringArray}], Returns: void
+	        Set the list of recently visited folders in the file dialog for this project.
+		Args: [{ false dirs PoolStringArray}], Returns: void
 */
 func (o *EditorSettings) SetRecentDirs(dirs gdnative.PoolStringArray) {
 	//log.Println("Calling EditorSettings.SetRecentDirs()")
@@ -320,7 +326,7 @@ func (o *EditorSettings) SetRecentDirs(dirs gdnative.PoolStringArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolStringArray(dirs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "set_recent_dirs")
+	methodBind := methodBindEditorSettingsSetRecentDirs.Get()
 
 	// Call the parent method.
 	// void
@@ -330,8 +336,7 @@ func (o *EditorSettings) SetRecentDirs(dirs gdnative.PoolStringArray) {
 }
 
 /*
-
-	Args: [{ false name String} { false value Variant}], Returns: void
+Args: [{ false name String} { false value Variant}], Returns: void
 */
 func (o *EditorSettings) SetSetting(name gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling EditorSettings.SetSetting()")
@@ -342,7 +347,7 @@ func (o *EditorSettings) SetSetting(name gdnative.String, value gdnative.Variant
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorSettings", "set_setting")
+	methodBind := methodBindEditorSettingsSetSetting.Get()
 
 	// Call the parent method.
 	// void
@@ -351,10 +356,31 @@ func (o *EditorSettings) SetSetting(name gdnative.String, value gdnative.Variant
 
 }
 
+// Signals of the EditorSettings class.
+const (
+	EditorSettingsSignalSettingsChanged gdnative.String = "settings_changed"
+)
+
+// ConnectSettingsChanged will connect the "settings_changed" signal to the given method of the target object.
+func (o *EditorSettings) ConnectSettingsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(EditorSettingsSignalSettingsChanged, target, method, binds, 0)
+}
+
+// EmitSettingsChanged will emit the "settings_changed" signal.
+func (o *EditorSettings) EmitSettingsChanged() error {
+
+	ret, err := o.EmitSignal(EditorSettingsSignalSettingsChanged)
+	ret.Destroy()
+	return err
+}
+
 // EditorSettingsImplementer is an interface that implements the methods
 // of the EditorSettings class.
 type EditorSettingsImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	AddPropertyInfo(info gdnative.Dictionary)
 	Erase(property gdnative.String)
 	GetFavoriteDirs() gdnative.PoolStringArray
@@ -369,4 +395,6 @@ type EditorSettingsImplementer interface {
 	SetInitialValue(name gdnative.String, value gdnative.Variant, updateCurrent gdnative.Bool)
 	SetRecentDirs(dirs gdnative.PoolStringArray)
 	SetSetting(name gdnative.String, value gdnative.Variant)
+	ConnectSettingsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSettingsChanged() error
 }
@@ -0,0 +1,390 @@
+package editor
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Custom gizmo that is used for providing custom visualization and editing (handles) for 3D Spatial objects. These are created by [method EditorPlugin.create_spatial_gizmo].
+*/
+type EditorSpatialGizmo struct {
+	godot.SpatialGizmo
+	owner gdnative.Object
+}
+
+// classConstructorEditorSpatialGizmo is the class constructor of the EditorSpatialGizmo class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEditorSpatialGizmo = gdnative.NewLazyClassConstructor("EditorSpatialGizmo")
+
+// NewEditorSpatialGizmo will create a new instance of the EditorSpatialGizmo class.
+// EditorSpatialGizmo is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEditorSpatialGizmo() *EditorSpatialGizmo {
+	obj := &EditorSpatialGizmo{}
+	obj.SetBaseObject(classConstructorEditorSpatialGizmo.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *EditorSpatialGizmo) BaseClass() string {
+	return "EditorSpatialGizmo"
+}
+
+// Method binds of the EditorSpatialGizmo class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEditorSpatialGizmoAddCollisionSegments  = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "add_collision_segments")
+	methodBindEditorSpatialGizmoAddCollisionTriangles = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "add_collision_triangles")
+	methodBindEditorSpatialGizmoAddHandles            = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "add_handles")
+	methodBindEditorSpatialGizmoAddLines              = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "add_lines")
+	methodBindEditorSpatialGizmoAddMesh               = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "add_mesh")
+	methodBindEditorSpatialGizmoAddUnscaledBillboard  = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "add_unscaled_billboard")
+	methodBindEditorSpatialGizmoClear                 = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "clear")
+	methodBindEditorSpatialGizmoCommitHandle          = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "commit_handle")
+	methodBindEditorSpatialGizmoGetHandleName         = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "get_handle_name")
+	methodBindEditorSpatialGizmoGetHandleValue        = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "get_handle_value")
+	methodBindEditorSpatialGizmoRedraw                = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "redraw")
+	methodBindEditorSpatialGizmoSetHandle             = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "set_handle")
+	methodBindEditorSpatialGizmoSetSpatialNode        = gdnative.NewLazyMethodBind("EditorSpatialGizmo", "set_spatial_node")
+)
+
+/*
+Args: [{ false segments PoolVector3Array}], Returns: void
+*/
+func (o *EditorSpatialGizmo) AddCollisionSegments(segments gdnative.PoolVector3Array) {
+	//log.Println("Calling EditorSpatialGizmo.AddCollisionSegments()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromPoolVector3Array(segments)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoAddCollisionSegments.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add collision triangles to the gizmo for picking. A [TriangleMesh] can be generated from a regular [Mesh] too. Call this function during [method redraw].
+		Args: [{ false triangles TriangleMesh} { false bounds AABB}], Returns: void
+*/
+func (o *EditorSpatialGizmo) AddCollisionTriangles(triangles godot.TriangleMeshImplementer, bounds gdnative.Aabb) {
+	//log.Println("Calling EditorSpatialGizmo.AddCollisionTriangles()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(triangles))
+	ptrArguments[1] = gdnative.NewPointerFromAabb(bounds)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoAddCollisionTriangles.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add a list of handles (points) which can be used to deform the object being edited. There are virtual functions which will be called upon editing of these handles. Call this function during [method redraw].
+		Args: [{ false handles PoolVector3Array} {False true billboard bool} {False true secondary bool}], Returns: void
+*/
+func (o *EditorSpatialGizmo) AddHandles(handles gdnative.PoolVector3Array, billboard gdnative.Bool, secondary gdnative.Bool) {
+	//log.Println("Calling EditorSpatialGizmo.AddHandles()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromPoolVector3Array(handles)
+	ptrArguments[1] = gdnative.NewPointerFromBool(billboard)
+	ptrArguments[2] = gdnative.NewPointerFromBool(secondary)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoAddHandles.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add lines to the gizmo (as sets of 2 points), with a given material. The lines are used for visualizing the gizmo. Call this function during [method redraw].
+		Args: [{ false lines PoolVector3Array} { false material Material} {False true billboard bool}], Returns: void
+*/
+func (o *EditorSpatialGizmo) AddLines(lines gdnative.PoolVector3Array, material godot.MaterialImplementer, billboard gdnative.Bool) {
+	//log.Println("Calling EditorSpatialGizmo.AddLines()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromPoolVector3Array(lines)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
+	ptrArguments[2] = gdnative.NewPointerFromBool(billboard)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoAddLines.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false mesh ArrayMesh} {False true billboard bool} {[RID] true skeleton RID}], Returns: void
+*/
+func (o *EditorSpatialGizmo) AddMesh(mesh godot.ArrayMeshImplementer, billboard gdnative.Bool, skeleton gdnative.Rid) {
+	//log.Println("Calling EditorSpatialGizmo.AddMesh()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
+	ptrArguments[1] = gdnative.NewPointerFromBool(billboard)
+	ptrArguments[2] = gdnative.NewPointerFromRid(skeleton)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoAddMesh.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add an unscaled billboard for visualization. Call this function during [method redraw].
+		Args: [{ false material Material} {1 true default_scale float}], Returns: void
+*/
+func (o *EditorSpatialGizmo) AddUnscaledBillboard(material godot.MaterialImplementer, defaultScale gdnative.Real) {
+	//log.Println("Calling EditorSpatialGizmo.AddUnscaledBillboard()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
+	ptrArguments[1] = gdnative.NewPointerFromReal(defaultScale)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoAddUnscaledBillboard.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [], Returns: void
+*/
+func (o *EditorSpatialGizmo) Clear() {
+	//log.Println("Calling EditorSpatialGizmo.Clear()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoClear.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Commit a handle being edited (handles must have been previously added by [method add_handles]). If the cancel parameter is true, an option to restore the edited value to the original is provided.
+		Args: [{ false index int} { false restore Variant} { false cancel bool}], Returns: void
+*/
+func (o *EditorSpatialGizmo) CommitHandle(index gdnative.Int, restore gdnative.Variant, cancel gdnative.Bool) {
+	//log.Println("Calling EditorSpatialGizmo.CommitHandle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromInt(index)
+	ptrArguments[1] = gdnative.NewPointerFromVariant(restore)
+	ptrArguments[2] = gdnative.NewPointerFromBool(cancel)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoCommitHandle.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Get the name of an edited handle (handles must have been previously added by [method add_handles]). Handles can be named for reference to the user when editing.
+		Args: [{ false index int}], Returns: String
+*/
+func (o *EditorSpatialGizmo) GetHandleName(index gdnative.Int) gdnative.String {
+	//log.Println("Calling EditorSpatialGizmo.GetHandleName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(index)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoGetHandleName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Get actual value of a handle. This value can be anything and used for eventually undoing the motion when calling [method commit_handle]
+		Args: [{ false index int}], Returns: Variant
+*/
+func (o *EditorSpatialGizmo) GetHandleValue(index gdnative.Int) gdnative.Variant {
+	//log.Println("Calling EditorSpatialGizmo.GetHandleValue()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(index)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoGetHandleValue.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        This function is called when the Spatial this gizmo refers to changes (the [method Spatial.update_gizmo] is called).
+		Args: [], Returns: void
+*/
+func (o *EditorSpatialGizmo) Redraw() {
+	//log.Println("Calling EditorSpatialGizmo.Redraw()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoRedraw.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        This function is used when the user drags a gizmo handle (previously added with [method add_handles]) in screen coordinates. The [Camera] is also provided so screen coordinates can be converted to raycasts.
+		Args: [{ false index int} { false camera Camera} { false point Vector2}], Returns: void
+*/
+func (o *EditorSpatialGizmo) SetHandle(index gdnative.Int, camera godot.CameraImplementer, point gdnative.Vector2) {
+	//log.Println("Calling EditorSpatialGizmo.SetHandle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromInt(index)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(camera))
+	ptrArguments[2] = gdnative.NewPointerFromVector2(point)
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoSetHandle.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false node Object}], Returns: void
+*/
+func (o *EditorSpatialGizmo) SetSpatialNode(node godot.ObjectImplementer) {
+	//log.Println("Calling EditorSpatialGizmo.SetSpatialNode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
+
+	// Get the method bind
+	methodBind := methodBindEditorSpatialGizmoSetSpatialNode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AddHandlesWithDefaults will call AddHandles using the default values for: billboard, secondary.
+func (o *EditorSpatialGizmo) AddHandlesWithDefaults(handles gdnative.PoolVector3Array) {
+	o.AddHandles(handles, false, false)
+}
+
+// AddLinesWithDefaults will call AddLines using the default values for: billboard.
+func (o *EditorSpatialGizmo) AddLinesWithDefaults(lines gdnative.PoolVector3Array, material godot.MaterialImplementer) {
+	o.AddLines(lines, material, false)
+}
+
+// AddMeshWithDefaults will call AddMesh using the default values for: billboard, skeleton.
+func (o *EditorSpatialGizmo) AddMeshWithDefaults(mesh godot.ArrayMeshImplementer) {
+	o.AddMesh(mesh, false, gdnative.NewRid())
+}
+
+// AddUnscaledBillboardWithDefaults will call AddUnscaledBillboard using the default values for: default_scale.
+func (o *EditorSpatialGizmo) AddUnscaledBillboardWithDefaults(material godot.MaterialImplementer) {
+	o.AddUnscaledBillboard(material, 1)
+}
+
+// EditorSpatialGizmoImplementer is an interface that implements the methods
+// of the EditorSpatialGizmo class.
+type EditorSpatialGizmoImplementer interface {
+	godot.SpatialGizmoImplementer
+	AddCollisionSegments(segments gdnative.PoolVector3Array)
+	AddCollisionTriangles(triangles godot.TriangleMeshImplementer, bounds gdnative.Aabb)
+	AddHandles(handles gdnative.PoolVector3Array, billboard gdnative.Bool, secondary gdnative.Bool)
+	AddLines(lines gdnative.PoolVector3Array, material godot.MaterialImplementer, billboard gdnative.Bool)
+	AddMesh(mesh godot.ArrayMeshImplementer, billboard gdnative.Bool, skeleton gdnative.Rid)
+	AddUnscaledBillboard(material godot.MaterialImplementer, defaultScale gdnative.Real)
+	Clear()
+	CommitHandle(index gdnative.Int, restore gdnative.Variant, cancel gdnative.Bool)
+	GetHandleName(index gdnative.Int) gdnative.String
+	GetHandleValue(index gdnative.Int) gdnative.Variant
+	Redraw()
+	SetHandle(index gdnative.Int, camera godot.CameraImplementer, point gdnative.Vector2)
+	SetSpatialNode(node godot.ObjectImplementer)
+	AddHandlesWithDefaults(handles gdnative.PoolVector3Array)
+	AddLinesWithDefaults(lines gdnative.PoolVector3Array, material godot.MaterialImplementer)
+	AddMeshWithDefaults(mesh godot.ArrayMeshImplementer)
+	AddUnscaledBillboardWithDefaults(material godot.MaterialImplementer)
+}
@@ -1,104 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewEditorResourceConversionPluginFromPointer(ptr gdnative.Pointer) EditorResourceConversionPlugin {
-func newEditorResourceConversionPluginFromPointer(ptr gdnative.Pointer) EditorResourceConversionPlugin {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EditorResourceConversionPlugin{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-
- */
-type EditorResourceConversionPlugin struct {
-	Reference
-	owner gdnative.Object
-}
-
-func (o *EditorResourceConversionPlugin) BaseClass() string {
-	return "EditorResourceConversionPlugin"
-}
-
-/*
-
-	Args: [{ false resource Resource}], Returns: Resource
-*/
-func (o *EditorResourceConversionPlugin) X_Convert(resource ResourceImplementer) ResourceImplementer {
-	//log.Println("Calling EditorResourceConversionPlugin.X_Convert()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(resource.GetBaseObject())
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorResourceConversionPlugin", "_convert")
-
-	// Call the parent method.
-	// Resource
-	retPtr := gdnative.NewEmptyObject()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newResourceFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ResourceImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Resource" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ResourceImplementer)
-	}
-
-	return &ret
-}
-
-/*
-
-	Args: [], Returns: String
-*/
-func (o *EditorResourceConversionPlugin) X_ConvertsTo() gdnative.String {
-	//log.Println("Calling EditorResourceConversionPlugin.X_ConvertsTo()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EditorResourceConversionPlugin", "_converts_to")
-
-	// Call the parent method.
-	// String
-	retPtr := gdnative.NewEmptyString()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewStringFromPointer(retPtr)
-	return ret
-}
-
-// EditorResourceConversionPluginImplementer is an interface that implements the methods
-// of the EditorResourceConversionPlugin class.
-type EditorResourceConversionPluginImplementer interface {
-	ReferenceImplementer
-	X_Convert(resource ResourceImplementer) ResourceImplementer
-	X_ConvertsTo() gdnative.String
-}
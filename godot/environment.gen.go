@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -26,6 +28,48 @@ const (
 	EnvironmentBgSky        EnvironmentBGMode = 2
 )
 
+// String will return the Godot name of the EnvironmentBGMode value.
+func (e EnvironmentBGMode) String() string {
+	switch e {
+	case EnvironmentBgCanvas:
+		return "BG_CANVAS"
+	case EnvironmentBgClearColor:
+		return "BG_CLEAR_COLOR"
+	case EnvironmentBgColor:
+		return "BG_COLOR"
+	case EnvironmentBgColorSky:
+		return "BG_COLOR_SKY"
+	case EnvironmentBgKeep:
+		return "BG_KEEP"
+	case EnvironmentBgMax:
+		return "BG_MAX"
+	case EnvironmentBgSky:
+		return "BG_SKY"
+	}
+	return fmt.Sprintf("EnvironmentBGMode(%d)", int(e))
+}
+
+// ParseEnvironmentBGMode will return the EnvironmentBGMode value with the given Godot name.
+func ParseEnvironmentBGMode(name string) (EnvironmentBGMode, error) {
+	switch name {
+	case "BG_CANVAS":
+		return EnvironmentBgCanvas, nil
+	case "BG_CLEAR_COLOR":
+		return EnvironmentBgClearColor, nil
+	case "BG_COLOR":
+		return EnvironmentBgColor, nil
+	case "BG_COLOR_SKY":
+		return EnvironmentBgColorSky, nil
+	case "BG_KEEP":
+		return EnvironmentBgKeep, nil
+	case "BG_MAX":
+		return EnvironmentBgMax, nil
+	case "BG_SKY":
+		return EnvironmentBgSky, nil
+	}
+	return 0, fmt.Errorf("invalid EnvironmentBGMode value %q", name)
+}
+
 // EnvironmentDOFBlurQuality is an enum for DOFBlurQuality values.
 type EnvironmentDOFBlurQuality int
 
@@ -35,6 +79,32 @@ const (
 	EnvironmentDofBlurQualityMedium EnvironmentDOFBlurQuality = 1
 )
 
+// String will return the Godot name of the EnvironmentDOFBlurQuality value.
+func (e EnvironmentDOFBlurQuality) String() string {
+	switch e {
+	case EnvironmentDofBlurQualityHigh:
+		return "DOF_BLUR_QUALITY_HIGH"
+	case EnvironmentDofBlurQualityLow:
+		return "DOF_BLUR_QUALITY_LOW"
+	case EnvironmentDofBlurQualityMedium:
+		return "DOF_BLUR_QUALITY_MEDIUM"
+	}
+	return fmt.Sprintf("EnvironmentDOFBlurQuality(%d)", int(e))
+}
+
+// ParseEnvironmentDOFBlurQuality will return the EnvironmentDOFBlurQuality value with the given Godot name.
+func ParseEnvironmentDOFBlurQuality(name string) (EnvironmentDOFBlurQuality, error) {
+	switch name {
+	case "DOF_BLUR_QUALITY_HIGH":
+		return EnvironmentDofBlurQualityHigh, nil
+	case "DOF_BLUR_QUALITY_LOW":
+		return EnvironmentDofBlurQualityLow, nil
+	case "DOF_BLUR_QUALITY_MEDIUM":
+		return EnvironmentDofBlurQualityMedium, nil
+	}
+	return 0, fmt.Errorf("invalid EnvironmentDOFBlurQuality value %q", name)
+}
+
 // EnvironmentGlowBlendMode is an enum for GlowBlendMode values.
 type EnvironmentGlowBlendMode int
 
@@ -45,6 +115,36 @@ const (
 	EnvironmentGlowBlendModeSoftlight EnvironmentGlowBlendMode = 2
 )
 
+// String will return the Godot name of the EnvironmentGlowBlendMode value.
+func (e EnvironmentGlowBlendMode) String() string {
+	switch e {
+	case EnvironmentGlowBlendModeAdditive:
+		return "GLOW_BLEND_MODE_ADDITIVE"
+	case EnvironmentGlowBlendModeReplace:
+		return "GLOW_BLEND_MODE_REPLACE"
+	case EnvironmentGlowBlendModeScreen:
+		return "GLOW_BLEND_MODE_SCREEN"
+	case EnvironmentGlowBlendModeSoftlight:
+		return "GLOW_BLEND_MODE_SOFTLIGHT"
+	}
+	return fmt.Sprintf("EnvironmentGlowBlendMode(%d)", int(e))
+}
+
+// ParseEnvironmentGlowBlendMode will return the EnvironmentGlowBlendMode value with the given Godot name.
+func ParseEnvironmentGlowBlendMode(name string) (EnvironmentGlowBlendMode, error) {
+	switch name {
+	case "GLOW_BLEND_MODE_ADDITIVE":
+		return EnvironmentGlowBlendModeAdditive, nil
+	case "GLOW_BLEND_MODE_REPLACE":
+		return EnvironmentGlowBlendModeReplace, nil
+	case "GLOW_BLEND_MODE_SCREEN":
+		return EnvironmentGlowBlendModeScreen, nil
+	case "GLOW_BLEND_MODE_SOFTLIGHT":
+		return EnvironmentGlowBlendModeSoftlight, nil
+	}
+	return 0, fmt.Errorf("invalid EnvironmentGlowBlendMode value %q", name)
+}
+
 // EnvironmentSSAOBlur is an enum for SSAOBlur values.
 type EnvironmentSSAOBlur int
 
@@ -55,6 +155,36 @@ const (
 	EnvironmentSsaoBlurDisabled EnvironmentSSAOBlur = 0
 )
 
+// String will return the Godot name of the EnvironmentSSAOBlur value.
+func (e EnvironmentSSAOBlur) String() string {
+	switch e {
+	case EnvironmentSsaoBlur1X1:
+		return "SSAO_BLUR_1x1"
+	case EnvironmentSsaoBlur2X2:
+		return "SSAO_BLUR_2x2"
+	case EnvironmentSsaoBlur3X3:
+		return "SSAO_BLUR_3x3"
+	case EnvironmentSsaoBlurDisabled:
+		return "SSAO_BLUR_DISABLED"
+	}
+	return fmt.Sprintf("EnvironmentSSAOBlur(%d)", int(e))
+}
+
+// ParseEnvironmentSSAOBlur will return the EnvironmentSSAOBlur value with the given Godot name.
+func ParseEnvironmentSSAOBlur(name string) (EnvironmentSSAOBlur, error) {
+	switch name {
+	case "SSAO_BLUR_1x1":
+		return EnvironmentSsaoBlur1X1, nil
+	case "SSAO_BLUR_2x2":
+		return EnvironmentSsaoBlur2X2, nil
+	case "SSAO_BLUR_3x3":
+		return EnvironmentSsaoBlur3X3, nil
+	case "SSAO_BLUR_DISABLED":
+		return EnvironmentSsaoBlurDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid EnvironmentSSAOBlur value %q", name)
+}
+
 // EnvironmentSSAOQuality is an enum for SSAOQuality values.
 type EnvironmentSSAOQuality int
 
@@ -64,6 +194,32 @@ const (
 	EnvironmentSsaoQualityMedium EnvironmentSSAOQuality = 1
 )
 
+// String will return the Godot name of the EnvironmentSSAOQuality value.
+func (e EnvironmentSSAOQuality) String() string {
+	switch e {
+	case EnvironmentSsaoQualityHigh:
+		return "SSAO_QUALITY_HIGH"
+	case EnvironmentSsaoQualityLow:
+		return "SSAO_QUALITY_LOW"
+	case EnvironmentSsaoQualityMedium:
+		return "SSAO_QUALITY_MEDIUM"
+	}
+	return fmt.Sprintf("EnvironmentSSAOQuality(%d)", int(e))
+}
+
+// ParseEnvironmentSSAOQuality will return the EnvironmentSSAOQuality value with the given Godot name.
+func ParseEnvironmentSSAOQuality(name string) (EnvironmentSSAOQuality, error) {
+	switch name {
+	case "SSAO_QUALITY_HIGH":
+		return EnvironmentSsaoQualityHigh, nil
+	case "SSAO_QUALITY_LOW":
+		return EnvironmentSsaoQualityLow, nil
+	case "SSAO_QUALITY_MEDIUM":
+		return EnvironmentSsaoQualityMedium, nil
+	}
+	return 0, fmt.Errorf("invalid EnvironmentSSAOQuality value %q", name)
+}
+
 // EnvironmentToneMapper is an enum for ToneMapper values.
 type EnvironmentToneMapper int
 
@@ -74,13 +230,34 @@ const (
 	EnvironmentToneMapperReinhardt EnvironmentToneMapper = 1
 )
 
-//func NewEnvironmentFromPointer(ptr gdnative.Pointer) Environment {
-func newEnvironmentFromPointer(ptr gdnative.Pointer) Environment {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Environment{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the EnvironmentToneMapper value.
+func (e EnvironmentToneMapper) String() string {
+	switch e {
+	case EnvironmentToneMapperAces:
+		return "TONE_MAPPER_ACES"
+	case EnvironmentToneMapperFilmic:
+		return "TONE_MAPPER_FILMIC"
+	case EnvironmentToneMapperLinear:
+		return "TONE_MAPPER_LINEAR"
+	case EnvironmentToneMapperReinhardt:
+		return "TONE_MAPPER_REINHARDT"
+	}
+	return fmt.Sprintf("EnvironmentToneMapper(%d)", int(e))
+}
+
+// ParseEnvironmentToneMapper will return the EnvironmentToneMapper value with the given Godot name.
+func ParseEnvironmentToneMapper(name string) (EnvironmentToneMapper, error) {
+	switch name {
+	case "TONE_MAPPER_ACES":
+		return EnvironmentToneMapperAces, nil
+	case "TONE_MAPPER_FILMIC":
+		return EnvironmentToneMapperFilmic, nil
+	case "TONE_MAPPER_LINEAR":
+		return EnvironmentToneMapperLinear, nil
+	case "TONE_MAPPER_REINHARDT":
+		return EnvironmentToneMapperReinhardt, nil
+	}
+	return 0, fmt.Errorf("invalid EnvironmentToneMapper value %q", name)
 }
 
 /*
@@ -91,13 +268,177 @@ type Environment struct {
 	owner gdnative.Object
 }
 
+// classConstructorEnvironment is the class constructor of the Environment class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEnvironment = gdnative.NewLazyClassConstructor("Environment")
+
+// NewEnvironment will create a new instance of the Environment class.
+// Environment is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEnvironment() *Environment {
+	obj := &Environment{}
+	obj.SetBaseObject(classConstructorEnvironment.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Environment) BaseClass() string {
 	return "Environment"
 }
 
+// Method binds of the Environment class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEnvironmentGetAdjustmentBrightness        = gdnative.NewLazyMethodBind("Environment", "get_adjustment_brightness")
+	methodBindEnvironmentGetAdjustmentColorCorrection   = gdnative.NewLazyMethodBind("Environment", "get_adjustment_color_correction")
+	methodBindEnvironmentGetAdjustmentContrast          = gdnative.NewLazyMethodBind("Environment", "get_adjustment_contrast")
+	methodBindEnvironmentGetAdjustmentSaturation        = gdnative.NewLazyMethodBind("Environment", "get_adjustment_saturation")
+	methodBindEnvironmentGetAmbientLightColor           = gdnative.NewLazyMethodBind("Environment", "get_ambient_light_color")
+	methodBindEnvironmentGetAmbientLightEnergy          = gdnative.NewLazyMethodBind("Environment", "get_ambient_light_energy")
+	methodBindEnvironmentGetAmbientLightSkyContribution = gdnative.NewLazyMethodBind("Environment", "get_ambient_light_sky_contribution")
+	methodBindEnvironmentGetBackground                  = gdnative.NewLazyMethodBind("Environment", "get_background")
+	methodBindEnvironmentGetBgColor                     = gdnative.NewLazyMethodBind("Environment", "get_bg_color")
+	methodBindEnvironmentGetBgEnergy                    = gdnative.NewLazyMethodBind("Environment", "get_bg_energy")
+	methodBindEnvironmentGetCanvasMaxLayer              = gdnative.NewLazyMethodBind("Environment", "get_canvas_max_layer")
+	methodBindEnvironmentGetDofBlurFarAmount            = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_far_amount")
+	methodBindEnvironmentGetDofBlurFarDistance          = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_far_distance")
+	methodBindEnvironmentGetDofBlurFarQuality           = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_far_quality")
+	methodBindEnvironmentGetDofBlurFarTransition        = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_far_transition")
+	methodBindEnvironmentGetDofBlurNearAmount           = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_near_amount")
+	methodBindEnvironmentGetDofBlurNearDistance         = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_near_distance")
+	methodBindEnvironmentGetDofBlurNearQuality          = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_near_quality")
+	methodBindEnvironmentGetDofBlurNearTransition       = gdnative.NewLazyMethodBind("Environment", "get_dof_blur_near_transition")
+	methodBindEnvironmentGetFogColor                    = gdnative.NewLazyMethodBind("Environment", "get_fog_color")
+	methodBindEnvironmentGetFogDepthBegin               = gdnative.NewLazyMethodBind("Environment", "get_fog_depth_begin")
+	methodBindEnvironmentGetFogDepthCurve               = gdnative.NewLazyMethodBind("Environment", "get_fog_depth_curve")
+	methodBindEnvironmentGetFogHeightCurve              = gdnative.NewLazyMethodBind("Environment", "get_fog_height_curve")
+	methodBindEnvironmentGetFogHeightMax                = gdnative.NewLazyMethodBind("Environment", "get_fog_height_max")
+	methodBindEnvironmentGetFogHeightMin                = gdnative.NewLazyMethodBind("Environment", "get_fog_height_min")
+	methodBindEnvironmentGetFogSunAmount                = gdnative.NewLazyMethodBind("Environment", "get_fog_sun_amount")
+	methodBindEnvironmentGetFogSunColor                 = gdnative.NewLazyMethodBind("Environment", "get_fog_sun_color")
+	methodBindEnvironmentGetFogTransmitCurve            = gdnative.NewLazyMethodBind("Environment", "get_fog_transmit_curve")
+	methodBindEnvironmentGetGlowBlendMode               = gdnative.NewLazyMethodBind("Environment", "get_glow_blend_mode")
+	methodBindEnvironmentGetGlowBloom                   = gdnative.NewLazyMethodBind("Environment", "get_glow_bloom")
+	methodBindEnvironmentGetGlowHdrBleedScale           = gdnative.NewLazyMethodBind("Environment", "get_glow_hdr_bleed_scale")
+	methodBindEnvironmentGetGlowHdrBleedThreshold       = gdnative.NewLazyMethodBind("Environment", "get_glow_hdr_bleed_threshold")
+	methodBindEnvironmentGetGlowIntensity               = gdnative.NewLazyMethodBind("Environment", "get_glow_intensity")
+	methodBindEnvironmentGetGlowStrength                = gdnative.NewLazyMethodBind("Environment", "get_glow_strength")
+	methodBindEnvironmentGetSky                         = gdnative.NewLazyMethodBind("Environment", "get_sky")
+	methodBindEnvironmentGetSkyCustomFov                = gdnative.NewLazyMethodBind("Environment", "get_sky_custom_fov")
+	methodBindEnvironmentGetSsaoBias                    = gdnative.NewLazyMethodBind("Environment", "get_ssao_bias")
+	methodBindEnvironmentGetSsaoBlur                    = gdnative.NewLazyMethodBind("Environment", "get_ssao_blur")
+	methodBindEnvironmentGetSsaoColor                   = gdnative.NewLazyMethodBind("Environment", "get_ssao_color")
+	methodBindEnvironmentGetSsaoDirectLightAffect       = gdnative.NewLazyMethodBind("Environment", "get_ssao_direct_light_affect")
+	methodBindEnvironmentGetSsaoEdgeSharpness           = gdnative.NewLazyMethodBind("Environment", "get_ssao_edge_sharpness")
+	methodBindEnvironmentGetSsaoIntensity               = gdnative.NewLazyMethodBind("Environment", "get_ssao_intensity")
+	methodBindEnvironmentGetSsaoIntensity2              = gdnative.NewLazyMethodBind("Environment", "get_ssao_intensity2")
+	methodBindEnvironmentGetSsaoQuality                 = gdnative.NewLazyMethodBind("Environment", "get_ssao_quality")
+	methodBindEnvironmentGetSsaoRadius                  = gdnative.NewLazyMethodBind("Environment", "get_ssao_radius")
+	methodBindEnvironmentGetSsaoRadius2                 = gdnative.NewLazyMethodBind("Environment", "get_ssao_radius2")
+	methodBindEnvironmentGetSsrDepthTolerance           = gdnative.NewLazyMethodBind("Environment", "get_ssr_depth_tolerance")
+	methodBindEnvironmentGetSsrFadeIn                   = gdnative.NewLazyMethodBind("Environment", "get_ssr_fade_in")
+	methodBindEnvironmentGetSsrFadeOut                  = gdnative.NewLazyMethodBind("Environment", "get_ssr_fade_out")
+	methodBindEnvironmentGetSsrMaxSteps                 = gdnative.NewLazyMethodBind("Environment", "get_ssr_max_steps")
+	methodBindEnvironmentGetTonemapAutoExposure         = gdnative.NewLazyMethodBind("Environment", "get_tonemap_auto_exposure")
+	methodBindEnvironmentGetTonemapAutoExposureGrey     = gdnative.NewLazyMethodBind("Environment", "get_tonemap_auto_exposure_grey")
+	methodBindEnvironmentGetTonemapAutoExposureMax      = gdnative.NewLazyMethodBind("Environment", "get_tonemap_auto_exposure_max")
+	methodBindEnvironmentGetTonemapAutoExposureMin      = gdnative.NewLazyMethodBind("Environment", "get_tonemap_auto_exposure_min")
+	methodBindEnvironmentGetTonemapAutoExposureSpeed    = gdnative.NewLazyMethodBind("Environment", "get_tonemap_auto_exposure_speed")
+	methodBindEnvironmentGetTonemapExposure             = gdnative.NewLazyMethodBind("Environment", "get_tonemap_exposure")
+	methodBindEnvironmentGetTonemapWhite                = gdnative.NewLazyMethodBind("Environment", "get_tonemap_white")
+	methodBindEnvironmentGetTonemapper                  = gdnative.NewLazyMethodBind("Environment", "get_tonemapper")
+	methodBindEnvironmentIsAdjustmentEnabled            = gdnative.NewLazyMethodBind("Environment", "is_adjustment_enabled")
+	methodBindEnvironmentIsDofBlurFarEnabled            = gdnative.NewLazyMethodBind("Environment", "is_dof_blur_far_enabled")
+	methodBindEnvironmentIsDofBlurNearEnabled           = gdnative.NewLazyMethodBind("Environment", "is_dof_blur_near_enabled")
+	methodBindEnvironmentIsFogDepthEnabled              = gdnative.NewLazyMethodBind("Environment", "is_fog_depth_enabled")
+	methodBindEnvironmentIsFogEnabled                   = gdnative.NewLazyMethodBind("Environment", "is_fog_enabled")
+	methodBindEnvironmentIsFogHeightEnabled             = gdnative.NewLazyMethodBind("Environment", "is_fog_height_enabled")
+	methodBindEnvironmentIsFogTransmitEnabled           = gdnative.NewLazyMethodBind("Environment", "is_fog_transmit_enabled")
+	methodBindEnvironmentIsGlowBicubicUpscaleEnabled    = gdnative.NewLazyMethodBind("Environment", "is_glow_bicubic_upscale_enabled")
+	methodBindEnvironmentIsGlowEnabled                  = gdnative.NewLazyMethodBind("Environment", "is_glow_enabled")
+	methodBindEnvironmentIsGlowLevelEnabled             = gdnative.NewLazyMethodBind("Environment", "is_glow_level_enabled")
+	methodBindEnvironmentIsSsaoEnabled                  = gdnative.NewLazyMethodBind("Environment", "is_ssao_enabled")
+	methodBindEnvironmentIsSsrEnabled                   = gdnative.NewLazyMethodBind("Environment", "is_ssr_enabled")
+	methodBindEnvironmentIsSsrRough                     = gdnative.NewLazyMethodBind("Environment", "is_ssr_rough")
+	methodBindEnvironmentSetAdjustmentBrightness        = gdnative.NewLazyMethodBind("Environment", "set_adjustment_brightness")
+	methodBindEnvironmentSetAdjustmentColorCorrection   = gdnative.NewLazyMethodBind("Environment", "set_adjustment_color_correction")
+	methodBindEnvironmentSetAdjustmentContrast          = gdnative.NewLazyMethodBind("Environment", "set_adjustment_contrast")
+	methodBindEnvironmentSetAdjustmentEnable            = gdnative.NewLazyMethodBind("Environment", "set_adjustment_enable")
+	methodBindEnvironmentSetAdjustmentSaturation        = gdnative.NewLazyMethodBind("Environment", "set_adjustment_saturation")
+	methodBindEnvironmentSetAmbientLightColor           = gdnative.NewLazyMethodBind("Environment", "set_ambient_light_color")
+	methodBindEnvironmentSetAmbientLightEnergy          = gdnative.NewLazyMethodBind("Environment", "set_ambient_light_energy")
+	methodBindEnvironmentSetAmbientLightSkyContribution = gdnative.NewLazyMethodBind("Environment", "set_ambient_light_sky_contribution")
+	methodBindEnvironmentSetBackground                  = gdnative.NewLazyMethodBind("Environment", "set_background")
+	methodBindEnvironmentSetBgColor                     = gdnative.NewLazyMethodBind("Environment", "set_bg_color")
+	methodBindEnvironmentSetBgEnergy                    = gdnative.NewLazyMethodBind("Environment", "set_bg_energy")
+	methodBindEnvironmentSetCanvasMaxLayer              = gdnative.NewLazyMethodBind("Environment", "set_canvas_max_layer")
+	methodBindEnvironmentSetDofBlurFarAmount            = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_far_amount")
+	methodBindEnvironmentSetDofBlurFarDistance          = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_far_distance")
+	methodBindEnvironmentSetDofBlurFarEnabled           = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_far_enabled")
+	methodBindEnvironmentSetDofBlurFarQuality           = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_far_quality")
+	methodBindEnvironmentSetDofBlurFarTransition        = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_far_transition")
+	methodBindEnvironmentSetDofBlurNearAmount           = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_near_amount")
+	methodBindEnvironmentSetDofBlurNearDistance         = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_near_distance")
+	methodBindEnvironmentSetDofBlurNearEnabled          = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_near_enabled")
+	methodBindEnvironmentSetDofBlurNearQuality          = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_near_quality")
+	methodBindEnvironmentSetDofBlurNearTransition       = gdnative.NewLazyMethodBind("Environment", "set_dof_blur_near_transition")
+	methodBindEnvironmentSetFogColor                    = gdnative.NewLazyMethodBind("Environment", "set_fog_color")
+	methodBindEnvironmentSetFogDepthBegin               = gdnative.NewLazyMethodBind("Environment", "set_fog_depth_begin")
+	methodBindEnvironmentSetFogDepthCurve               = gdnative.NewLazyMethodBind("Environment", "set_fog_depth_curve")
+	methodBindEnvironmentSetFogDepthEnabled             = gdnative.NewLazyMethodBind("Environment", "set_fog_depth_enabled")
+	methodBindEnvironmentSetFogEnabled                  = gdnative.NewLazyMethodBind("Environment", "set_fog_enabled")
+	methodBindEnvironmentSetFogHeightCurve              = gdnative.NewLazyMethodBind("Environment", "set_fog_height_curve")
+	methodBindEnvironmentSetFogHeightEnabled            = gdnative.NewLazyMethodBind("Environment", "set_fog_height_enabled")
+	methodBindEnvironmentSetFogHeightMax                = gdnative.NewLazyMethodBind("Environment", "set_fog_height_max")
+	methodBindEnvironmentSetFogHeightMin                = gdnative.NewLazyMethodBind("Environment", "set_fog_height_min")
+	methodBindEnvironmentSetFogSunAmount                = gdnative.NewLazyMethodBind("Environment", "set_fog_sun_amount")
+	methodBindEnvironmentSetFogSunColor                 = gdnative.NewLazyMethodBind("Environment", "set_fog_sun_color")
+	methodBindEnvironmentSetFogTransmitCurve            = gdnative.NewLazyMethodBind("Environment", "set_fog_transmit_curve")
+	methodBindEnvironmentSetFogTransmitEnabled          = gdnative.NewLazyMethodBind("Environment", "set_fog_transmit_enabled")
+	methodBindEnvironmentSetGlowBicubicUpscale          = gdnative.NewLazyMethodBind("Environment", "set_glow_bicubic_upscale")
+	methodBindEnvironmentSetGlowBlendMode               = gdnative.NewLazyMethodBind("Environment", "set_glow_blend_mode")
+	methodBindEnvironmentSetGlowBloom                   = gdnative.NewLazyMethodBind("Environment", "set_glow_bloom")
+	methodBindEnvironmentSetGlowEnabled                 = gdnative.NewLazyMethodBind("Environment", "set_glow_enabled")
+	methodBindEnvironmentSetGlowHdrBleedScale           = gdnative.NewLazyMethodBind("Environment", "set_glow_hdr_bleed_scale")
+	methodBindEnvironmentSetGlowHdrBleedThreshold       = gdnative.NewLazyMethodBind("Environment", "set_glow_hdr_bleed_threshold")
+	methodBindEnvironmentSetGlowIntensity               = gdnative.NewLazyMethodBind("Environment", "set_glow_intensity")
+	methodBindEnvironmentSetGlowLevel                   = gdnative.NewLazyMethodBind("Environment", "set_glow_level")
+	methodBindEnvironmentSetGlowStrength                = gdnative.NewLazyMethodBind("Environment", "set_glow_strength")
+	methodBindEnvironmentSetSky                         = gdnative.NewLazyMethodBind("Environment", "set_sky")
+	methodBindEnvironmentSetSkyCustomFov                = gdnative.NewLazyMethodBind("Environment", "set_sky_custom_fov")
+	methodBindEnvironmentSetSsaoBias                    = gdnative.NewLazyMethodBind("Environment", "set_ssao_bias")
+	methodBindEnvironmentSetSsaoBlur                    = gdnative.NewLazyMethodBind("Environment", "set_ssao_blur")
+	methodBindEnvironmentSetSsaoColor                   = gdnative.NewLazyMethodBind("Environment", "set_ssao_color")
+	methodBindEnvironmentSetSsaoDirectLightAffect       = gdnative.NewLazyMethodBind("Environment", "set_ssao_direct_light_affect")
+	methodBindEnvironmentSetSsaoEdgeSharpness           = gdnative.NewLazyMethodBind("Environment", "set_ssao_edge_sharpness")
+	methodBindEnvironmentSetSsaoEnabled                 = gdnative.NewLazyMethodBind("Environment", "set_ssao_enabled")
+	methodBindEnvironmentSetSsaoIntensity               = gdnative.NewLazyMethodBind("Environment", "set_ssao_intensity")
+	methodBindEnvironmentSetSsaoIntensity2              = gdnative.NewLazyMethodBind("Environment", "set_ssao_intensity2")
+	methodBindEnvironmentSetSsaoQuality                 = gdnative.NewLazyMethodBind("Environment", "set_ssao_quality")
+	methodBindEnvironmentSetSsaoRadius                  = gdnative.NewLazyMethodBind("Environment", "set_ssao_radius")
+	methodBindEnvironmentSetSsaoRadius2                 = gdnative.NewLazyMethodBind("Environment", "set_ssao_radius2")
+	methodBindEnvironmentSetSsrDepthTolerance           = gdnative.NewLazyMethodBind("Environment", "set_ssr_depth_tolerance")
+	methodBindEnvironmentSetSsrEnabled                  = gdnative.NewLazyMethodBind("Environment", "set_ssr_enabled")
+	methodBindEnvironmentSetSsrFadeIn                   = gdnative.NewLazyMethodBind("Environment", "set_ssr_fade_in")
+	methodBindEnvironmentSetSsrFadeOut                  = gdnative.NewLazyMethodBind("Environment", "set_ssr_fade_out")
+	methodBindEnvironmentSetSsrMaxSteps                 = gdnative.NewLazyMethodBind("Environment", "set_ssr_max_steps")
+	methodBindEnvironmentSetSsrRough                    = gdnative.NewLazyMethodBind("Environment", "set_ssr_rough")
+	methodBindEnvironmentSetTonemapAutoExposure         = gdnative.NewLazyMethodBind("Environment", "set_tonemap_auto_exposure")
+	methodBindEnvironmentSetTonemapAutoExposureGrey     = gdnative.NewLazyMethodBind("Environment", "set_tonemap_auto_exposure_grey")
+	methodBindEnvironmentSetTonemapAutoExposureMax      = gdnative.NewLazyMethodBind("Environment", "set_tonemap_auto_exposure_max")
+	methodBindEnvironmentSetTonemapAutoExposureMin      = gdnative.NewLazyMethodBind("Environment", "set_tonemap_auto_exposure_min")
+	methodBindEnvironmentSetTonemapAutoExposureSpeed    = gdnative.NewLazyMethodBind("Environment", "set_tonemap_auto_exposure_speed")
+	methodBindEnvironmentSetTonemapExposure             = gdnative.NewLazyMethodBind("Environment", "set_tonemap_exposure")
+	methodBindEnvironmentSetTonemapWhite                = gdnative.NewLazyMethodBind("Environment", "set_tonemap_white")
+	methodBindEnvironmentSetTonemapper                  = gdnative.NewLazyMethodBind("Environment", "set_tonemapper")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetAdjustmentBrightness() gdnative.Real {
 	//log.Println("Calling Environment.GetAdjustmentBrightness()")
@@ -106,7 +447,7 @@ func (o *Environment) GetAdjustmentBrightness() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_adjustment_brightness")
+	methodBind := methodBindEnvironmentGetAdjustmentBrightness.Get()
 
 	// Call the parent method.
 	// float
@@ -119,8 +460,8 @@ func (o *Environment) GetAdjustmentBrightness() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
 func (o *Environment) GetAdjustmentColorCorrection() TextureImplementer {
 	//log.Println("Calling Environment.GetAdjustmentColorCorrection()")
@@ -129,7 +470,7 @@ func (o *Environment) GetAdjustmentColorCorrection() TextureImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_adjustment_color_correction")
+	methodBind := methodBindEnvironmentGetAdjustmentColorCorrection.Get()
 
 	// Call the parent method.
 	// Texture
@@ -137,27 +478,25 @@ func (o *Environment) GetAdjustmentColorCorrection() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetAdjustmentContrast() gdnative.Real {
 	//log.Println("Calling Environment.GetAdjustmentContrast()")
@@ -166,7 +505,7 @@ func (o *Environment) GetAdjustmentContrast() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_adjustment_contrast")
+	methodBind := methodBindEnvironmentGetAdjustmentContrast.Get()
 
 	// Call the parent method.
 	// float
@@ -179,8 +518,8 @@ func (o *Environment) GetAdjustmentContrast() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetAdjustmentSaturation() gdnative.Real {
 	//log.Println("Calling Environment.GetAdjustmentSaturation()")
@@ -189,7 +528,7 @@ func (o *Environment) GetAdjustmentSaturation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_adjustment_saturation")
+	methodBind := methodBindEnvironmentGetAdjustmentSaturation.Get()
 
 	// Call the parent method.
 	// float
@@ -202,8 +541,8 @@ func (o *Environment) GetAdjustmentSaturation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Environment) GetAmbientLightColor() gdnative.Color {
 	//log.Println("Calling Environment.GetAmbientLightColor()")
@@ -212,7 +551,7 @@ func (o *Environment) GetAmbientLightColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ambient_light_color")
+	methodBind := methodBindEnvironmentGetAmbientLightColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -225,8 +564,8 @@ func (o *Environment) GetAmbientLightColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetAmbientLightEnergy() gdnative.Real {
 	//log.Println("Calling Environment.GetAmbientLightEnergy()")
@@ -235,7 +574,7 @@ func (o *Environment) GetAmbientLightEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ambient_light_energy")
+	methodBind := methodBindEnvironmentGetAmbientLightEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -248,8 +587,8 @@ func (o *Environment) GetAmbientLightEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetAmbientLightSkyContribution() gdnative.Real {
 	//log.Println("Calling Environment.GetAmbientLightSkyContribution()")
@@ -258,7 +597,7 @@ func (o *Environment) GetAmbientLightSkyContribution() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ambient_light_sky_contribution")
+	methodBind := methodBindEnvironmentGetAmbientLightSkyContribution.Get()
 
 	// Call the parent method.
 	// float
@@ -271,8 +610,8 @@ func (o *Environment) GetAmbientLightSkyContribution() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Environment::BGMode
+	        Undocumented
+		Args: [], Returns: enum.Environment::BGMode
 */
 func (o *Environment) GetBackground() EnvironmentBGMode {
 	//log.Println("Calling Environment.GetBackground()")
@@ -281,7 +620,7 @@ func (o *Environment) GetBackground() EnvironmentBGMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_background")
+	methodBind := methodBindEnvironmentGetBackground.Get()
 
 	// Call the parent method.
 	// enum.Environment::BGMode
@@ -294,8 +633,8 @@ func (o *Environment) GetBackground() EnvironmentBGMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Environment) GetBgColor() gdnative.Color {
 	//log.Println("Calling Environment.GetBgColor()")
@@ -304,7 +643,7 @@ func (o *Environment) GetBgColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_bg_color")
+	methodBind := methodBindEnvironmentGetBgColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -317,8 +656,8 @@ func (o *Environment) GetBgColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetBgEnergy() gdnative.Real {
 	//log.Println("Calling Environment.GetBgEnergy()")
@@ -327,7 +666,7 @@ func (o *Environment) GetBgEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_bg_energy")
+	methodBind := methodBindEnvironmentGetBgEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -340,8 +679,8 @@ func (o *Environment) GetBgEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Environment) GetCanvasMaxLayer() gdnative.Int {
 	//log.Println("Calling Environment.GetCanvasMaxLayer()")
@@ -350,7 +689,7 @@ func (o *Environment) GetCanvasMaxLayer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_canvas_max_layer")
+	methodBind := methodBindEnvironmentGetCanvasMaxLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -363,8 +702,8 @@ func (o *Environment) GetCanvasMaxLayer() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetDofBlurFarAmount() gdnative.Real {
 	//log.Println("Calling Environment.GetDofBlurFarAmount()")
@@ -373,7 +712,7 @@ func (o *Environment) GetDofBlurFarAmount() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_far_amount")
+	methodBind := methodBindEnvironmentGetDofBlurFarAmount.Get()
 
 	// Call the parent method.
 	// float
@@ -386,8 +725,8 @@ func (o *Environment) GetDofBlurFarAmount() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetDofBlurFarDistance() gdnative.Real {
 	//log.Println("Calling Environment.GetDofBlurFarDistance()")
@@ -396,7 +735,7 @@ func (o *Environment) GetDofBlurFarDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_far_distance")
+	methodBind := methodBindEnvironmentGetDofBlurFarDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -409,8 +748,8 @@ func (o *Environment) GetDofBlurFarDistance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Environment::DOFBlurQuality
+	        Undocumented
+		Args: [], Returns: enum.Environment::DOFBlurQuality
 */
 func (o *Environment) GetDofBlurFarQuality() EnvironmentDOFBlurQuality {
 	//log.Println("Calling Environment.GetDofBlurFarQuality()")
@@ -419,7 +758,7 @@ func (o *Environment) GetDofBlurFarQuality() EnvironmentDOFBlurQuality {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_far_quality")
+	methodBind := methodBindEnvironmentGetDofBlurFarQuality.Get()
 
 	// Call the parent method.
 	// enum.Environment::DOFBlurQuality
@@ -432,8 +771,8 @@ func (o *Environment) GetDofBlurFarQuality() EnvironmentDOFBlurQuality {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetDofBlurFarTransition() gdnative.Real {
 	//log.Println("Calling Environment.GetDofBlurFarTransition()")
@@ -442,7 +781,7 @@ func (o *Environment) GetDofBlurFarTransition() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_far_transition")
+	methodBind := methodBindEnvironmentGetDofBlurFarTransition.Get()
 
 	// Call the parent method.
 	// float
@@ -455,8 +794,8 @@ func (o *Environment) GetDofBlurFarTransition() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetDofBlurNearAmount() gdnative.Real {
 	//log.Println("Calling Environment.GetDofBlurNearAmount()")
@@ -465,7 +804,7 @@ func (o *Environment) GetDofBlurNearAmount() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_near_amount")
+	methodBind := methodBindEnvironmentGetDofBlurNearAmount.Get()
 
 	// Call the parent method.
 	// float
@@ -478,8 +817,8 @@ func (o *Environment) GetDofBlurNearAmount() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetDofBlurNearDistance() gdnative.Real {
 	//log.Println("Calling Environment.GetDofBlurNearDistance()")
@@ -488,7 +827,7 @@ func (o *Environment) GetDofBlurNearDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_near_distance")
+	methodBind := methodBindEnvironmentGetDofBlurNearDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -501,8 +840,8 @@ func (o *Environment) GetDofBlurNearDistance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Environment::DOFBlurQuality
+	        Undocumented
+		Args: [], Returns: enum.Environment::DOFBlurQuality
 */
 func (o *Environment) GetDofBlurNearQuality() EnvironmentDOFBlurQuality {
 	//log.Println("Calling Environment.GetDofBlurNearQuality()")
@@ -511,7 +850,7 @@ func (o *Environment) GetDofBlurNearQuality() EnvironmentDOFBlurQuality {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_near_quality")
+	methodBind := methodBindEnvironmentGetDofBlurNearQuality.Get()
 
 	// Call the parent method.
 	// enum.Environment::DOFBlurQuality
@@ -524,8 +863,8 @@ func (o *Environment) GetDofBlurNearQuality() EnvironmentDOFBlurQuality {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetDofBlurNearTransition() gdnative.Real {
 	//log.Println("Calling Environment.GetDofBlurNearTransition()")
@@ -534,7 +873,7 @@ func (o *Environment) GetDofBlurNearTransition() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_dof_blur_near_transition")
+	methodBind := methodBindEnvironmentGetDofBlurNearTransition.Get()
 
 	// Call the parent method.
 	// float
@@ -547,8 +886,8 @@ func (o *Environment) GetDofBlurNearTransition() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Environment) GetFogColor() gdnative.Color {
 	//log.Println("Calling Environment.GetFogColor()")
@@ -557,7 +896,7 @@ func (o *Environment) GetFogColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_color")
+	methodBind := methodBindEnvironmentGetFogColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -570,8 +909,8 @@ func (o *Environment) GetFogColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetFogDepthBegin() gdnative.Real {
 	//log.Println("Calling Environment.GetFogDepthBegin()")
@@ -580,7 +919,7 @@ func (o *Environment) GetFogDepthBegin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_depth_begin")
+	methodBind := methodBindEnvironmentGetFogDepthBegin.Get()
 
 	// Call the parent method.
 	// float
@@ -593,8 +932,8 @@ func (o *Environment) GetFogDepthBegin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetFogDepthCurve() gdnative.Real {
 	//log.Println("Calling Environment.GetFogDepthCurve()")
@@ -603,7 +942,7 @@ func (o *Environment) GetFogDepthCurve() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_depth_curve")
+	methodBind := methodBindEnvironmentGetFogDepthCurve.Get()
 
 	// Call the parent method.
 	// float
@@ -616,8 +955,8 @@ func (o *Environment) GetFogDepthCurve() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetFogHeightCurve() gdnative.Real {
 	//log.Println("Calling Environment.GetFogHeightCurve()")
@@ -626,7 +965,7 @@ func (o *Environment) GetFogHeightCurve() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_height_curve")
+	methodBind := methodBindEnvironmentGetFogHeightCurve.Get()
 
 	// Call the parent method.
 	// float
@@ -639,8 +978,8 @@ func (o *Environment) GetFogHeightCurve() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetFogHeightMax() gdnative.Real {
 	//log.Println("Calling Environment.GetFogHeightMax()")
@@ -649,7 +988,7 @@ func (o *Environment) GetFogHeightMax() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_height_max")
+	methodBind := methodBindEnvironmentGetFogHeightMax.Get()
 
 	// Call the parent method.
 	// float
@@ -662,8 +1001,8 @@ func (o *Environment) GetFogHeightMax() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetFogHeightMin() gdnative.Real {
 	//log.Println("Calling Environment.GetFogHeightMin()")
@@ -672,7 +1011,7 @@ func (o *Environment) GetFogHeightMin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_height_min")
+	methodBind := methodBindEnvironmentGetFogHeightMin.Get()
 
 	// Call the parent method.
 	// float
@@ -685,8 +1024,8 @@ func (o *Environment) GetFogHeightMin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetFogSunAmount() gdnative.Real {
 	//log.Println("Calling Environment.GetFogSunAmount()")
@@ -695,7 +1034,7 @@ func (o *Environment) GetFogSunAmount() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_sun_amount")
+	methodBind := methodBindEnvironmentGetFogSunAmount.Get()
 
 	// Call the parent method.
 	// float
@@ -708,8 +1047,8 @@ func (o *Environment) GetFogSunAmount() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Environment) GetFogSunColor() gdnative.Color {
 	//log.Println("Calling Environment.GetFogSunColor()")
@@ -718,7 +1057,7 @@ func (o *Environment) GetFogSunColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_sun_color")
+	methodBind := methodBindEnvironmentGetFogSunColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -731,8 +1070,8 @@ func (o *Environment) GetFogSunColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetFogTransmitCurve() gdnative.Real {
 	//log.Println("Calling Environment.GetFogTransmitCurve()")
@@ -741,7 +1080,7 @@ func (o *Environment) GetFogTransmitCurve() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_fog_transmit_curve")
+	methodBind := methodBindEnvironmentGetFogTransmitCurve.Get()
 
 	// Call the parent method.
 	// float
@@ -754,8 +1093,8 @@ func (o *Environment) GetFogTransmitCurve() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Environment::GlowBlendMode
+	        Undocumented
+		Args: [], Returns: enum.Environment::GlowBlendMode
 */
 func (o *Environment) GetGlowBlendMode() EnvironmentGlowBlendMode {
 	//log.Println("Calling Environment.GetGlowBlendMode()")
@@ -764,7 +1103,7 @@ func (o *Environment) GetGlowBlendMode() EnvironmentGlowBlendMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_glow_blend_mode")
+	methodBind := methodBindEnvironmentGetGlowBlendMode.Get()
 
 	// Call the parent method.
 	// enum.Environment::GlowBlendMode
@@ -777,8 +1116,8 @@ func (o *Environment) GetGlowBlendMode() EnvironmentGlowBlendMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetGlowBloom() gdnative.Real {
 	//log.Println("Calling Environment.GetGlowBloom()")
@@ -787,7 +1126,7 @@ func (o *Environment) GetGlowBloom() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_glow_bloom")
+	methodBind := methodBindEnvironmentGetGlowBloom.Get()
 
 	// Call the parent method.
 	// float
@@ -800,8 +1139,8 @@ func (o *Environment) GetGlowBloom() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetGlowHdrBleedScale() gdnative.Real {
 	//log.Println("Calling Environment.GetGlowHdrBleedScale()")
@@ -810,7 +1149,7 @@ func (o *Environment) GetGlowHdrBleedScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_glow_hdr_bleed_scale")
+	methodBind := methodBindEnvironmentGetGlowHdrBleedScale.Get()
 
 	// Call the parent method.
 	// float
@@ -823,8 +1162,8 @@ func (o *Environment) GetGlowHdrBleedScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetGlowHdrBleedThreshold() gdnative.Real {
 	//log.Println("Calling Environment.GetGlowHdrBleedThreshold()")
@@ -833,7 +1172,7 @@ func (o *Environment) GetGlowHdrBleedThreshold() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_glow_hdr_bleed_threshold")
+	methodBind := methodBindEnvironmentGetGlowHdrBleedThreshold.Get()
 
 	// Call the parent method.
 	// float
@@ -846,8 +1185,8 @@ func (o *Environment) GetGlowHdrBleedThreshold() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetGlowIntensity() gdnative.Real {
 	//log.Println("Calling Environment.GetGlowIntensity()")
@@ -856,7 +1195,7 @@ func (o *Environment) GetGlowIntensity() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_glow_intensity")
+	methodBind := methodBindEnvironmentGetGlowIntensity.Get()
 
 	// Call the parent method.
 	// float
@@ -869,8 +1208,8 @@ func (o *Environment) GetGlowIntensity() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetGlowStrength() gdnative.Real {
 	//log.Println("Calling Environment.GetGlowStrength()")
@@ -879,7 +1218,7 @@ func (o *Environment) GetGlowStrength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_glow_strength")
+	methodBind := methodBindEnvironmentGetGlowStrength.Get()
 
 	// Call the parent method.
 	// float
@@ -892,8 +1231,8 @@ func (o *Environment) GetGlowStrength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Sky
+	        Undocumented
+		Args: [], Returns: Sky
 */
 func (o *Environment) GetSky() SkyImplementer {
 	//log.Println("Calling Environment.GetSky()")
@@ -902,7 +1241,7 @@ func (o *Environment) GetSky() SkyImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_sky")
+	methodBind := methodBindEnvironmentGetSky.Get()
 
 	// Call the parent method.
 	// Sky
@@ -910,27 +1249,25 @@ func (o *Environment) GetSky() SkyImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSkyFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SkyImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Sky" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SkyImplementer)
+	ret := Sky{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(SkyImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSkyCustomFov() gdnative.Real {
 	//log.Println("Calling Environment.GetSkyCustomFov()")
@@ -939,7 +1276,7 @@ func (o *Environment) GetSkyCustomFov() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_sky_custom_fov")
+	methodBind := methodBindEnvironmentGetSkyCustomFov.Get()
 
 	// Call the parent method.
 	// float
@@ -952,8 +1289,8 @@ func (o *Environment) GetSkyCustomFov() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsaoBias() gdnative.Real {
 	//log.Println("Calling Environment.GetSsaoBias()")
@@ -962,7 +1299,7 @@ func (o *Environment) GetSsaoBias() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_bias")
+	methodBind := methodBindEnvironmentGetSsaoBias.Get()
 
 	// Call the parent method.
 	// float
@@ -975,8 +1312,8 @@ func (o *Environment) GetSsaoBias() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Environment::SSAOBlur
+	        Undocumented
+		Args: [], Returns: enum.Environment::SSAOBlur
 */
 func (o *Environment) GetSsaoBlur() EnvironmentSSAOBlur {
 	//log.Println("Calling Environment.GetSsaoBlur()")
@@ -985,7 +1322,7 @@ func (o *Environment) GetSsaoBlur() EnvironmentSSAOBlur {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_blur")
+	methodBind := methodBindEnvironmentGetSsaoBlur.Get()
 
 	// Call the parent method.
 	// enum.Environment::SSAOBlur
@@ -998,8 +1335,8 @@ func (o *Environment) GetSsaoBlur() EnvironmentSSAOBlur {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *Environment) GetSsaoColor() gdnative.Color {
 	//log.Println("Calling Environment.GetSsaoColor()")
@@ -1008,7 +1345,7 @@ func (o *Environment) GetSsaoColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_color")
+	methodBind := methodBindEnvironmentGetSsaoColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -1021,8 +1358,8 @@ func (o *Environment) GetSsaoColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsaoDirectLightAffect() gdnative.Real {
 	//log.Println("Calling Environment.GetSsaoDirectLightAffect()")
@@ -1031,7 +1368,7 @@ func (o *Environment) GetSsaoDirectLightAffect() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_direct_light_affect")
+	methodBind := methodBindEnvironmentGetSsaoDirectLightAffect.Get()
 
 	// Call the parent method.
 	// float
@@ -1044,8 +1381,8 @@ func (o *Environment) GetSsaoDirectLightAffect() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsaoEdgeSharpness() gdnative.Real {
 	//log.Println("Calling Environment.GetSsaoEdgeSharpness()")
@@ -1054,7 +1391,7 @@ func (o *Environment) GetSsaoEdgeSharpness() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_edge_sharpness")
+	methodBind := methodBindEnvironmentGetSsaoEdgeSharpness.Get()
 
 	// Call the parent method.
 	// float
@@ -1067,8 +1404,8 @@ func (o *Environment) GetSsaoEdgeSharpness() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsaoIntensity() gdnative.Real {
 	//log.Println("Calling Environment.GetSsaoIntensity()")
@@ -1077,7 +1414,7 @@ func (o *Environment) GetSsaoIntensity() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_intensity")
+	methodBind := methodBindEnvironmentGetSsaoIntensity.Get()
 
 	// Call the parent method.
 	// float
@@ -1090,8 +1427,8 @@ func (o *Environment) GetSsaoIntensity() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsaoIntensity2() gdnative.Real {
 	//log.Println("Calling Environment.GetSsaoIntensity2()")
@@ -1100,7 +1437,7 @@ func (o *Environment) GetSsaoIntensity2() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_intensity2")
+	methodBind := methodBindEnvironmentGetSsaoIntensity2.Get()
 
 	// Call the parent method.
 	// float
@@ -1113,8 +1450,8 @@ func (o *Environment) GetSsaoIntensity2() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Environment::SSAOQuality
+	        Undocumented
+		Args: [], Returns: enum.Environment::SSAOQuality
 */
 func (o *Environment) GetSsaoQuality() EnvironmentSSAOQuality {
 	//log.Println("Calling Environment.GetSsaoQuality()")
@@ -1123,7 +1460,7 @@ func (o *Environment) GetSsaoQuality() EnvironmentSSAOQuality {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_quality")
+	methodBind := methodBindEnvironmentGetSsaoQuality.Get()
 
 	// Call the parent method.
 	// enum.Environment::SSAOQuality
@@ -1136,8 +1473,8 @@ func (o *Environment) GetSsaoQuality() EnvironmentSSAOQuality {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsaoRadius() gdnative.Real {
 	//log.Println("Calling Environment.GetSsaoRadius()")
@@ -1146,7 +1483,7 @@ func (o *Environment) GetSsaoRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_radius")
+	methodBind := methodBindEnvironmentGetSsaoRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -1159,8 +1496,8 @@ func (o *Environment) GetSsaoRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsaoRadius2() gdnative.Real {
 	//log.Println("Calling Environment.GetSsaoRadius2()")
@@ -1169,7 +1506,7 @@ func (o *Environment) GetSsaoRadius2() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssao_radius2")
+	methodBind := methodBindEnvironmentGetSsaoRadius2.Get()
 
 	// Call the parent method.
 	// float
@@ -1182,8 +1519,8 @@ func (o *Environment) GetSsaoRadius2() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsrDepthTolerance() gdnative.Real {
 	//log.Println("Calling Environment.GetSsrDepthTolerance()")
@@ -1192,7 +1529,7 @@ func (o *Environment) GetSsrDepthTolerance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssr_depth_tolerance")
+	methodBind := methodBindEnvironmentGetSsrDepthTolerance.Get()
 
 	// Call the parent method.
 	// float
@@ -1205,8 +1542,8 @@ func (o *Environment) GetSsrDepthTolerance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsrFadeIn() gdnative.Real {
 	//log.Println("Calling Environment.GetSsrFadeIn()")
@@ -1215,7 +1552,7 @@ func (o *Environment) GetSsrFadeIn() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssr_fade_in")
+	methodBind := methodBindEnvironmentGetSsrFadeIn.Get()
 
 	// Call the parent method.
 	// float
@@ -1228,8 +1565,8 @@ func (o *Environment) GetSsrFadeIn() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetSsrFadeOut() gdnative.Real {
 	//log.Println("Calling Environment.GetSsrFadeOut()")
@@ -1238,7 +1575,7 @@ func (o *Environment) GetSsrFadeOut() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssr_fade_out")
+	methodBind := methodBindEnvironmentGetSsrFadeOut.Get()
 
 	// Call the parent method.
 	// float
@@ -1251,8 +1588,8 @@ func (o *Environment) GetSsrFadeOut() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Environment) GetSsrMaxSteps() gdnative.Int {
 	//log.Println("Calling Environment.GetSsrMaxSteps()")
@@ -1261,7 +1598,7 @@ func (o *Environment) GetSsrMaxSteps() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_ssr_max_steps")
+	methodBind := methodBindEnvironmentGetSsrMaxSteps.Get()
 
 	// Call the parent method.
 	// int
@@ -1274,8 +1611,8 @@ func (o *Environment) GetSsrMaxSteps() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) GetTonemapAutoExposure() gdnative.Bool {
 	//log.Println("Calling Environment.GetTonemapAutoExposure()")
@@ -1284,7 +1621,7 @@ func (o *Environment) GetTonemapAutoExposure() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemap_auto_exposure")
+	methodBind := methodBindEnvironmentGetTonemapAutoExposure.Get()
 
 	// Call the parent method.
 	// bool
@@ -1297,8 +1634,8 @@ func (o *Environment) GetTonemapAutoExposure() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetTonemapAutoExposureGrey() gdnative.Real {
 	//log.Println("Calling Environment.GetTonemapAutoExposureGrey()")
@@ -1307,7 +1644,7 @@ func (o *Environment) GetTonemapAutoExposureGrey() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemap_auto_exposure_grey")
+	methodBind := methodBindEnvironmentGetTonemapAutoExposureGrey.Get()
 
 	// Call the parent method.
 	// float
@@ -1320,8 +1657,8 @@ func (o *Environment) GetTonemapAutoExposureGrey() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetTonemapAutoExposureMax() gdnative.Real {
 	//log.Println("Calling Environment.GetTonemapAutoExposureMax()")
@@ -1330,7 +1667,7 @@ func (o *Environment) GetTonemapAutoExposureMax() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemap_auto_exposure_max")
+	methodBind := methodBindEnvironmentGetTonemapAutoExposureMax.Get()
 
 	// Call the parent method.
 	// float
@@ -1343,8 +1680,8 @@ func (o *Environment) GetTonemapAutoExposureMax() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetTonemapAutoExposureMin() gdnative.Real {
 	//log.Println("Calling Environment.GetTonemapAutoExposureMin()")
@@ -1353,7 +1690,7 @@ func (o *Environment) GetTonemapAutoExposureMin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemap_auto_exposure_min")
+	methodBind := methodBindEnvironmentGetTonemapAutoExposureMin.Get()
 
 	// Call the parent method.
 	// float
@@ -1366,8 +1703,8 @@ func (o *Environment) GetTonemapAutoExposureMin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetTonemapAutoExposureSpeed() gdnative.Real {
 	//log.Println("Calling Environment.GetTonemapAutoExposureSpeed()")
@@ -1376,7 +1713,7 @@ func (o *Environment) GetTonemapAutoExposureSpeed() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemap_auto_exposure_speed")
+	methodBind := methodBindEnvironmentGetTonemapAutoExposureSpeed.Get()
 
 	// Call the parent method.
 	// float
@@ -1389,8 +1726,8 @@ func (o *Environment) GetTonemapAutoExposureSpeed() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetTonemapExposure() gdnative.Real {
 	//log.Println("Calling Environment.GetTonemapExposure()")
@@ -1399,7 +1736,7 @@ func (o *Environment) GetTonemapExposure() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemap_exposure")
+	methodBind := methodBindEnvironmentGetTonemapExposure.Get()
 
 	// Call the parent method.
 	// float
@@ -1412,8 +1749,8 @@ func (o *Environment) GetTonemapExposure() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Environment) GetTonemapWhite() gdnative.Real {
 	//log.Println("Calling Environment.GetTonemapWhite()")
@@ -1422,7 +1759,7 @@ func (o *Environment) GetTonemapWhite() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemap_white")
+	methodBind := methodBindEnvironmentGetTonemapWhite.Get()
 
 	// Call the parent method.
 	// float
@@ -1435,8 +1772,8 @@ func (o *Environment) GetTonemapWhite() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Environment::ToneMapper
+	        Undocumented
+		Args: [], Returns: enum.Environment::ToneMapper
 */
 func (o *Environment) GetTonemapper() EnvironmentToneMapper {
 	//log.Println("Calling Environment.GetTonemapper()")
@@ -1445,7 +1782,7 @@ func (o *Environment) GetTonemapper() EnvironmentToneMapper {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "get_tonemapper")
+	methodBind := methodBindEnvironmentGetTonemapper.Get()
 
 	// Call the parent method.
 	// enum.Environment::ToneMapper
@@ -1458,8 +1795,8 @@ func (o *Environment) GetTonemapper() EnvironmentToneMapper {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsAdjustmentEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsAdjustmentEnabled()")
@@ -1468,7 +1805,7 @@ func (o *Environment) IsAdjustmentEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_adjustment_enabled")
+	methodBind := methodBindEnvironmentIsAdjustmentEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1481,8 +1818,8 @@ func (o *Environment) IsAdjustmentEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsDofBlurFarEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsDofBlurFarEnabled()")
@@ -1491,7 +1828,7 @@ func (o *Environment) IsDofBlurFarEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_dof_blur_far_enabled")
+	methodBind := methodBindEnvironmentIsDofBlurFarEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1504,8 +1841,8 @@ func (o *Environment) IsDofBlurFarEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsDofBlurNearEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsDofBlurNearEnabled()")
@@ -1514,7 +1851,7 @@ func (o *Environment) IsDofBlurNearEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_dof_blur_near_enabled")
+	methodBind := methodBindEnvironmentIsDofBlurNearEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1527,8 +1864,8 @@ func (o *Environment) IsDofBlurNearEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsFogDepthEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsFogDepthEnabled()")
@@ -1537,7 +1874,7 @@ func (o *Environment) IsFogDepthEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_fog_depth_enabled")
+	methodBind := methodBindEnvironmentIsFogDepthEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1550,8 +1887,8 @@ func (o *Environment) IsFogDepthEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsFogEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsFogEnabled()")
@@ -1560,7 +1897,7 @@ func (o *Environment) IsFogEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_fog_enabled")
+	methodBind := methodBindEnvironmentIsFogEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1573,8 +1910,8 @@ func (o *Environment) IsFogEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsFogHeightEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsFogHeightEnabled()")
@@ -1583,7 +1920,7 @@ func (o *Environment) IsFogHeightEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_fog_height_enabled")
+	methodBind := methodBindEnvironmentIsFogHeightEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1596,8 +1933,8 @@ func (o *Environment) IsFogHeightEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsFogTransmitEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsFogTransmitEnabled()")
@@ -1606,7 +1943,7 @@ func (o *Environment) IsFogTransmitEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_fog_transmit_enabled")
+	methodBind := methodBindEnvironmentIsFogTransmitEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1619,8 +1956,8 @@ func (o *Environment) IsFogTransmitEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsGlowBicubicUpscaleEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsGlowBicubicUpscaleEnabled()")
@@ -1629,7 +1966,7 @@ func (o *Environment) IsGlowBicubicUpscaleEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_glow_bicubic_upscale_enabled")
+	methodBind := methodBindEnvironmentIsGlowBicubicUpscaleEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1642,8 +1979,8 @@ func (o *Environment) IsGlowBicubicUpscaleEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsGlowEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsGlowEnabled()")
@@ -1652,7 +1989,7 @@ func (o *Environment) IsGlowEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_glow_enabled")
+	methodBind := methodBindEnvironmentIsGlowEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1665,8 +2002,8 @@ func (o *Environment) IsGlowEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: bool
+	        Undocumented
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *Environment) IsGlowLevelEnabled(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling Environment.IsGlowLevelEnabled()")
@@ -1676,7 +2013,7 @@ func (o *Environment) IsGlowLevelEnabled(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_glow_level_enabled")
+	methodBind := methodBindEnvironmentIsGlowLevelEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1689,8 +2026,8 @@ func (o *Environment) IsGlowLevelEnabled(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsSsaoEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsSsaoEnabled()")
@@ -1699,7 +2036,7 @@ func (o *Environment) IsSsaoEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_ssao_enabled")
+	methodBind := methodBindEnvironmentIsSsaoEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1712,8 +2049,8 @@ func (o *Environment) IsSsaoEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsSsrEnabled() gdnative.Bool {
 	//log.Println("Calling Environment.IsSsrEnabled()")
@@ -1722,7 +2059,7 @@ func (o *Environment) IsSsrEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_ssr_enabled")
+	methodBind := methodBindEnvironmentIsSsrEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1735,8 +2072,8 @@ func (o *Environment) IsSsrEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Environment) IsSsrRough() gdnative.Bool {
 	//log.Println("Calling Environment.IsSsrRough()")
@@ -1745,7 +2082,7 @@ func (o *Environment) IsSsrRough() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "is_ssr_rough")
+	methodBind := methodBindEnvironmentIsSsrRough.Get()
 
 	// Call the parent method.
 	// bool
@@ -1758,8 +2095,8 @@ func (o *Environment) IsSsrRough() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false brightness float}], Returns: void
+	        Undocumented
+		Args: [{ false brightness float}], Returns: void
 */
 func (o *Environment) SetAdjustmentBrightness(brightness gdnative.Real) {
 	//log.Println("Calling Environment.SetAdjustmentBrightness()")
@@ -1769,7 +2106,7 @@ func (o *Environment) SetAdjustmentBrightness(brightness gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(brightness)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_adjustment_brightness")
+	methodBind := methodBindEnvironmentSetAdjustmentBrightness.Get()
 
 	// Call the parent method.
 	// void
@@ -1779,18 +2116,18 @@ func (o *Environment) SetAdjustmentBrightness(brightness gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color_correction Texture}], Returns: void
+	        Undocumented
+		Args: [{ false color_correction Texture}], Returns: void
 */
 func (o *Environment) SetAdjustmentColorCorrection(colorCorrection TextureImplementer) {
 	//log.Println("Calling Environment.SetAdjustmentColorCorrection()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(colorCorrection.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(colorCorrection))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_adjustment_color_correction")
+	methodBind := methodBindEnvironmentSetAdjustmentColorCorrection.Get()
 
 	// Call the parent method.
 	// void
@@ -1800,8 +2137,8 @@ func (o *Environment) SetAdjustmentColorCorrection(colorCorrection TextureImplem
 }
 
 /*
-        Undocumented
-	Args: [{ false contrast float}], Returns: void
+	        Undocumented
+		Args: [{ false contrast float}], Returns: void
 */
 func (o *Environment) SetAdjustmentContrast(contrast gdnative.Real) {
 	//log.Println("Calling Environment.SetAdjustmentContrast()")
@@ -1811,7 +2148,7 @@ func (o *Environment) SetAdjustmentContrast(contrast gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(contrast)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_adjustment_contrast")
+	methodBind := methodBindEnvironmentSetAdjustmentContrast.Get()
 
 	// Call the parent method.
 	// void
@@ -1821,8 +2158,8 @@ func (o *Environment) SetAdjustmentContrast(contrast gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetAdjustmentEnable(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetAdjustmentEnable()")
@@ -1832,7 +2169,7 @@ func (o *Environment) SetAdjustmentEnable(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_adjustment_enable")
+	methodBind := methodBindEnvironmentSetAdjustmentEnable.Get()
 
 	// Call the parent method.
 	// void
@@ -1842,8 +2179,8 @@ func (o *Environment) SetAdjustmentEnable(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false saturation float}], Returns: void
+	        Undocumented
+		Args: [{ false saturation float}], Returns: void
 */
 func (o *Environment) SetAdjustmentSaturation(saturation gdnative.Real) {
 	//log.Println("Calling Environment.SetAdjustmentSaturation()")
@@ -1853,7 +2190,7 @@ func (o *Environment) SetAdjustmentSaturation(saturation gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(saturation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_adjustment_saturation")
+	methodBind := methodBindEnvironmentSetAdjustmentSaturation.Get()
 
 	// Call the parent method.
 	// void
@@ -1863,8 +2200,8 @@ func (o *Environment) SetAdjustmentSaturation(saturation gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Environment) SetAmbientLightColor(color gdnative.Color) {
 	//log.Println("Calling Environment.SetAmbientLightColor()")
@@ -1874,7 +2211,7 @@ func (o *Environment) SetAmbientLightColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ambient_light_color")
+	methodBind := methodBindEnvironmentSetAmbientLightColor.Get()
 
 	// Call the parent method.
 	// void
@@ -1884,8 +2221,8 @@ func (o *Environment) SetAmbientLightColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *Environment) SetAmbientLightEnergy(energy gdnative.Real) {
 	//log.Println("Calling Environment.SetAmbientLightEnergy()")
@@ -1895,7 +2232,7 @@ func (o *Environment) SetAmbientLightEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ambient_light_energy")
+	methodBind := methodBindEnvironmentSetAmbientLightEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -1905,8 +2242,8 @@ func (o *Environment) SetAmbientLightEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *Environment) SetAmbientLightSkyContribution(energy gdnative.Real) {
 	//log.Println("Calling Environment.SetAmbientLightSkyContribution()")
@@ -1916,7 +2253,7 @@ func (o *Environment) SetAmbientLightSkyContribution(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ambient_light_sky_contribution")
+	methodBind := methodBindEnvironmentSetAmbientLightSkyContribution.Get()
 
 	// Call the parent method.
 	// void
@@ -1926,18 +2263,18 @@ func (o *Environment) SetAmbientLightSkyContribution(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Environment::BGMode}], Returns: void
 */
-func (o *Environment) SetBackground(mode gdnative.Int) {
+func (o *Environment) SetBackground(mode EnvironmentBGMode) {
 	//log.Println("Calling Environment.SetBackground()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_background")
+	methodBind := methodBindEnvironmentSetBackground.Get()
 
 	// Call the parent method.
 	// void
@@ -1947,8 +2284,8 @@ func (o *Environment) SetBackground(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Environment) SetBgColor(color gdnative.Color) {
 	//log.Println("Calling Environment.SetBgColor()")
@@ -1958,7 +2295,7 @@ func (o *Environment) SetBgColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_bg_color")
+	methodBind := methodBindEnvironmentSetBgColor.Get()
 
 	// Call the parent method.
 	// void
@@ -1968,8 +2305,8 @@ func (o *Environment) SetBgColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *Environment) SetBgEnergy(energy gdnative.Real) {
 	//log.Println("Calling Environment.SetBgEnergy()")
@@ -1979,7 +2316,7 @@ func (o *Environment) SetBgEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_bg_energy")
+	methodBind := methodBindEnvironmentSetBgEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -1989,8 +2326,8 @@ func (o *Environment) SetBgEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *Environment) SetCanvasMaxLayer(layer gdnative.Int) {
 	//log.Println("Calling Environment.SetCanvasMaxLayer()")
@@ -2000,7 +2337,7 @@ func (o *Environment) SetCanvasMaxLayer(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_canvas_max_layer")
+	methodBind := methodBindEnvironmentSetCanvasMaxLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -2010,8 +2347,8 @@ func (o *Environment) SetCanvasMaxLayer(layer gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetDofBlurFarAmount(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetDofBlurFarAmount()")
@@ -2021,7 +2358,7 @@ func (o *Environment) SetDofBlurFarAmount(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_far_amount")
+	methodBind := methodBindEnvironmentSetDofBlurFarAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -2031,8 +2368,8 @@ func (o *Environment) SetDofBlurFarAmount(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetDofBlurFarDistance(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetDofBlurFarDistance()")
@@ -2042,7 +2379,7 @@ func (o *Environment) SetDofBlurFarDistance(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_far_distance")
+	methodBind := methodBindEnvironmentSetDofBlurFarDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -2052,8 +2389,8 @@ func (o *Environment) SetDofBlurFarDistance(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetDofBlurFarEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetDofBlurFarEnabled()")
@@ -2063,7 +2400,7 @@ func (o *Environment) SetDofBlurFarEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_far_enabled")
+	methodBind := methodBindEnvironmentSetDofBlurFarEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2073,18 +2410,18 @@ func (o *Environment) SetDofBlurFarEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity int}], Returns: void
+	        Undocumented
+		Args: [{ false intensity enum.Environment::DOFBlurQuality}], Returns: void
 */
-func (o *Environment) SetDofBlurFarQuality(intensity gdnative.Int) {
+func (o *Environment) SetDofBlurFarQuality(intensity EnvironmentDOFBlurQuality) {
 	//log.Println("Calling Environment.SetDofBlurFarQuality()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(intensity)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(intensity))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_far_quality")
+	methodBind := methodBindEnvironmentSetDofBlurFarQuality.Get()
 
 	// Call the parent method.
 	// void
@@ -2094,8 +2431,8 @@ func (o *Environment) SetDofBlurFarQuality(intensity gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetDofBlurFarTransition(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetDofBlurFarTransition()")
@@ -2105,7 +2442,7 @@ func (o *Environment) SetDofBlurFarTransition(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_far_transition")
+	methodBind := methodBindEnvironmentSetDofBlurFarTransition.Get()
 
 	// Call the parent method.
 	// void
@@ -2115,8 +2452,8 @@ func (o *Environment) SetDofBlurFarTransition(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetDofBlurNearAmount(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetDofBlurNearAmount()")
@@ -2126,7 +2463,7 @@ func (o *Environment) SetDofBlurNearAmount(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_near_amount")
+	methodBind := methodBindEnvironmentSetDofBlurNearAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -2136,8 +2473,8 @@ func (o *Environment) SetDofBlurNearAmount(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetDofBlurNearDistance(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetDofBlurNearDistance()")
@@ -2147,7 +2484,7 @@ func (o *Environment) SetDofBlurNearDistance(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_near_distance")
+	methodBind := methodBindEnvironmentSetDofBlurNearDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -2157,8 +2494,8 @@ func (o *Environment) SetDofBlurNearDistance(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetDofBlurNearEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetDofBlurNearEnabled()")
@@ -2168,7 +2505,7 @@ func (o *Environment) SetDofBlurNearEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_near_enabled")
+	methodBind := methodBindEnvironmentSetDofBlurNearEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2178,18 +2515,18 @@ func (o *Environment) SetDofBlurNearEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false level int}], Returns: void
+	        Undocumented
+		Args: [{ false level enum.Environment::DOFBlurQuality}], Returns: void
 */
-func (o *Environment) SetDofBlurNearQuality(level gdnative.Int) {
+func (o *Environment) SetDofBlurNearQuality(level EnvironmentDOFBlurQuality) {
 	//log.Println("Calling Environment.SetDofBlurNearQuality()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(level)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(level))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_near_quality")
+	methodBind := methodBindEnvironmentSetDofBlurNearQuality.Get()
 
 	// Call the parent method.
 	// void
@@ -2199,8 +2536,8 @@ func (o *Environment) SetDofBlurNearQuality(level gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetDofBlurNearTransition(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetDofBlurNearTransition()")
@@ -2210,7 +2547,7 @@ func (o *Environment) SetDofBlurNearTransition(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_dof_blur_near_transition")
+	methodBind := methodBindEnvironmentSetDofBlurNearTransition.Get()
 
 	// Call the parent method.
 	// void
@@ -2220,8 +2557,8 @@ func (o *Environment) SetDofBlurNearTransition(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Environment) SetFogColor(color gdnative.Color) {
 	//log.Println("Calling Environment.SetFogColor()")
@@ -2231,7 +2568,7 @@ func (o *Environment) SetFogColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_color")
+	methodBind := methodBindEnvironmentSetFogColor.Get()
 
 	// Call the parent method.
 	// void
@@ -2241,8 +2578,8 @@ func (o *Environment) SetFogColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false distance float}], Returns: void
+	        Undocumented
+		Args: [{ false distance float}], Returns: void
 */
 func (o *Environment) SetFogDepthBegin(distance gdnative.Real) {
 	//log.Println("Calling Environment.SetFogDepthBegin()")
@@ -2252,7 +2589,7 @@ func (o *Environment) SetFogDepthBegin(distance gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(distance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_depth_begin")
+	methodBind := methodBindEnvironmentSetFogDepthBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -2262,8 +2599,8 @@ func (o *Environment) SetFogDepthBegin(distance gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false curve float}], Returns: void
+	        Undocumented
+		Args: [{ false curve float}], Returns: void
 */
 func (o *Environment) SetFogDepthCurve(curve gdnative.Real) {
 	//log.Println("Calling Environment.SetFogDepthCurve()")
@@ -2273,7 +2610,7 @@ func (o *Environment) SetFogDepthCurve(curve gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(curve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_depth_curve")
+	methodBind := methodBindEnvironmentSetFogDepthCurve.Get()
 
 	// Call the parent method.
 	// void
@@ -2283,8 +2620,8 @@ func (o *Environment) SetFogDepthCurve(curve gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetFogDepthEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetFogDepthEnabled()")
@@ -2294,7 +2631,7 @@ func (o *Environment) SetFogDepthEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_depth_enabled")
+	methodBind := methodBindEnvironmentSetFogDepthEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2304,8 +2641,8 @@ func (o *Environment) SetFogDepthEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetFogEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetFogEnabled()")
@@ -2315,7 +2652,7 @@ func (o *Environment) SetFogEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_enabled")
+	methodBind := methodBindEnvironmentSetFogEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2325,8 +2662,8 @@ func (o *Environment) SetFogEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false curve float}], Returns: void
+	        Undocumented
+		Args: [{ false curve float}], Returns: void
 */
 func (o *Environment) SetFogHeightCurve(curve gdnative.Real) {
 	//log.Println("Calling Environment.SetFogHeightCurve()")
@@ -2336,7 +2673,7 @@ func (o *Environment) SetFogHeightCurve(curve gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(curve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_height_curve")
+	methodBind := methodBindEnvironmentSetFogHeightCurve.Get()
 
 	// Call the parent method.
 	// void
@@ -2346,8 +2683,8 @@ func (o *Environment) SetFogHeightCurve(curve gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetFogHeightEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetFogHeightEnabled()")
@@ -2357,7 +2694,7 @@ func (o *Environment) SetFogHeightEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_height_enabled")
+	methodBind := methodBindEnvironmentSetFogHeightEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2367,8 +2704,8 @@ func (o *Environment) SetFogHeightEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false height float}], Returns: void
+	        Undocumented
+		Args: [{ false height float}], Returns: void
 */
 func (o *Environment) SetFogHeightMax(height gdnative.Real) {
 	//log.Println("Calling Environment.SetFogHeightMax()")
@@ -2378,7 +2715,7 @@ func (o *Environment) SetFogHeightMax(height gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_height_max")
+	methodBind := methodBindEnvironmentSetFogHeightMax.Get()
 
 	// Call the parent method.
 	// void
@@ -2388,8 +2725,8 @@ func (o *Environment) SetFogHeightMax(height gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false height float}], Returns: void
+	        Undocumented
+		Args: [{ false height float}], Returns: void
 */
 func (o *Environment) SetFogHeightMin(height gdnative.Real) {
 	//log.Println("Calling Environment.SetFogHeightMin()")
@@ -2399,7 +2736,7 @@ func (o *Environment) SetFogHeightMin(height gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_height_min")
+	methodBind := methodBindEnvironmentSetFogHeightMin.Get()
 
 	// Call the parent method.
 	// void
@@ -2409,8 +2746,8 @@ func (o *Environment) SetFogHeightMin(height gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *Environment) SetFogSunAmount(amount gdnative.Real) {
 	//log.Println("Calling Environment.SetFogSunAmount()")
@@ -2420,7 +2757,7 @@ func (o *Environment) SetFogSunAmount(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_sun_amount")
+	methodBind := methodBindEnvironmentSetFogSunAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -2430,8 +2767,8 @@ func (o *Environment) SetFogSunAmount(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Environment) SetFogSunColor(color gdnative.Color) {
 	//log.Println("Calling Environment.SetFogSunColor()")
@@ -2441,7 +2778,7 @@ func (o *Environment) SetFogSunColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_sun_color")
+	methodBind := methodBindEnvironmentSetFogSunColor.Get()
 
 	// Call the parent method.
 	// void
@@ -2451,8 +2788,8 @@ func (o *Environment) SetFogSunColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false curve float}], Returns: void
+	        Undocumented
+		Args: [{ false curve float}], Returns: void
 */
 func (o *Environment) SetFogTransmitCurve(curve gdnative.Real) {
 	//log.Println("Calling Environment.SetFogTransmitCurve()")
@@ -2462,7 +2799,7 @@ func (o *Environment) SetFogTransmitCurve(curve gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(curve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_transmit_curve")
+	methodBind := methodBindEnvironmentSetFogTransmitCurve.Get()
 
 	// Call the parent method.
 	// void
@@ -2472,8 +2809,8 @@ func (o *Environment) SetFogTransmitCurve(curve gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetFogTransmitEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetFogTransmitEnabled()")
@@ -2483,7 +2820,7 @@ func (o *Environment) SetFogTransmitEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_fog_transmit_enabled")
+	methodBind := methodBindEnvironmentSetFogTransmitEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2493,8 +2830,8 @@ func (o *Environment) SetFogTransmitEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetGlowBicubicUpscale(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetGlowBicubicUpscale()")
@@ -2504,7 +2841,7 @@ func (o *Environment) SetGlowBicubicUpscale(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_bicubic_upscale")
+	methodBind := methodBindEnvironmentSetGlowBicubicUpscale.Get()
 
 	// Call the parent method.
 	// void
@@ -2514,18 +2851,18 @@ func (o *Environment) SetGlowBicubicUpscale(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Environment::GlowBlendMode}], Returns: void
 */
-func (o *Environment) SetGlowBlendMode(mode gdnative.Int) {
+func (o *Environment) SetGlowBlendMode(mode EnvironmentGlowBlendMode) {
 	//log.Println("Calling Environment.SetGlowBlendMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_blend_mode")
+	methodBind := methodBindEnvironmentSetGlowBlendMode.Get()
 
 	// Call the parent method.
 	// void
@@ -2535,8 +2872,8 @@ func (o *Environment) SetGlowBlendMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *Environment) SetGlowBloom(amount gdnative.Real) {
 	//log.Println("Calling Environment.SetGlowBloom()")
@@ -2546,7 +2883,7 @@ func (o *Environment) SetGlowBloom(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_bloom")
+	methodBind := methodBindEnvironmentSetGlowBloom.Get()
 
 	// Call the parent method.
 	// void
@@ -2556,8 +2893,8 @@ func (o *Environment) SetGlowBloom(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetGlowEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetGlowEnabled()")
@@ -2567,7 +2904,7 @@ func (o *Environment) SetGlowEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_enabled")
+	methodBind := methodBindEnvironmentSetGlowEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2577,8 +2914,8 @@ func (o *Environment) SetGlowEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale float}], Returns: void
+	        Undocumented
+		Args: [{ false scale float}], Returns: void
 */
 func (o *Environment) SetGlowHdrBleedScale(scale gdnative.Real) {
 	//log.Println("Calling Environment.SetGlowHdrBleedScale()")
@@ -2588,7 +2925,7 @@ func (o *Environment) SetGlowHdrBleedScale(scale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_hdr_bleed_scale")
+	methodBind := methodBindEnvironmentSetGlowHdrBleedScale.Get()
 
 	// Call the parent method.
 	// void
@@ -2598,8 +2935,8 @@ func (o *Environment) SetGlowHdrBleedScale(scale gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false threshold float}], Returns: void
+	        Undocumented
+		Args: [{ false threshold float}], Returns: void
 */
 func (o *Environment) SetGlowHdrBleedThreshold(threshold gdnative.Real) {
 	//log.Println("Calling Environment.SetGlowHdrBleedThreshold()")
@@ -2609,7 +2946,7 @@ func (o *Environment) SetGlowHdrBleedThreshold(threshold gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(threshold)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_hdr_bleed_threshold")
+	methodBind := methodBindEnvironmentSetGlowHdrBleedThreshold.Get()
 
 	// Call the parent method.
 	// void
@@ -2619,8 +2956,8 @@ func (o *Environment) SetGlowHdrBleedThreshold(threshold gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetGlowIntensity(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetGlowIntensity()")
@@ -2630,7 +2967,7 @@ func (o *Environment) SetGlowIntensity(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_intensity")
+	methodBind := methodBindEnvironmentSetGlowIntensity.Get()
 
 	// Call the parent method.
 	// void
@@ -2640,8 +2977,8 @@ func (o *Environment) SetGlowIntensity(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int} { false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false idx int} { false enabled bool}], Returns: void
 */
 func (o *Environment) SetGlowLevel(idx gdnative.Int, enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetGlowLevel()")
@@ -2652,7 +2989,7 @@ func (o *Environment) SetGlowLevel(idx gdnative.Int, enabled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_level")
+	methodBind := methodBindEnvironmentSetGlowLevel.Get()
 
 	// Call the parent method.
 	// void
@@ -2662,8 +2999,8 @@ func (o *Environment) SetGlowLevel(idx gdnative.Int, enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false strength float}], Returns: void
+	        Undocumented
+		Args: [{ false strength float}], Returns: void
 */
 func (o *Environment) SetGlowStrength(strength gdnative.Real) {
 	//log.Println("Calling Environment.SetGlowStrength()")
@@ -2673,7 +3010,7 @@ func (o *Environment) SetGlowStrength(strength gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(strength)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_glow_strength")
+	methodBind := methodBindEnvironmentSetGlowStrength.Get()
 
 	// Call the parent method.
 	// void
@@ -2683,18 +3020,18 @@ func (o *Environment) SetGlowStrength(strength gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false sky Sky}], Returns: void
+	        Undocumented
+		Args: [{ false sky Sky}], Returns: void
 */
 func (o *Environment) SetSky(sky SkyImplementer) {
 	//log.Println("Calling Environment.SetSky()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(sky.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(sky))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_sky")
+	methodBind := methodBindEnvironmentSetSky.Get()
 
 	// Call the parent method.
 	// void
@@ -2704,8 +3041,8 @@ func (o *Environment) SetSky(sky SkyImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale float}], Returns: void
+	        Undocumented
+		Args: [{ false scale float}], Returns: void
 */
 func (o *Environment) SetSkyCustomFov(scale gdnative.Real) {
 	//log.Println("Calling Environment.SetSkyCustomFov()")
@@ -2715,7 +3052,7 @@ func (o *Environment) SetSkyCustomFov(scale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_sky_custom_fov")
+	methodBind := methodBindEnvironmentSetSkyCustomFov.Get()
 
 	// Call the parent method.
 	// void
@@ -2725,8 +3062,8 @@ func (o *Environment) SetSkyCustomFov(scale gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bias float}], Returns: void
+	        Undocumented
+		Args: [{ false bias float}], Returns: void
 */
 func (o *Environment) SetSsaoBias(bias gdnative.Real) {
 	//log.Println("Calling Environment.SetSsaoBias()")
@@ -2736,7 +3073,7 @@ func (o *Environment) SetSsaoBias(bias gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(bias)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_bias")
+	methodBind := methodBindEnvironmentSetSsaoBias.Get()
 
 	// Call the parent method.
 	// void
@@ -2746,18 +3083,18 @@ func (o *Environment) SetSsaoBias(bias gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Environment::SSAOBlur}], Returns: void
 */
-func (o *Environment) SetSsaoBlur(mode gdnative.Int) {
+func (o *Environment) SetSsaoBlur(mode EnvironmentSSAOBlur) {
 	//log.Println("Calling Environment.SetSsaoBlur()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_blur")
+	methodBind := methodBindEnvironmentSetSsaoBlur.Get()
 
 	// Call the parent method.
 	// void
@@ -2767,8 +3104,8 @@ func (o *Environment) SetSsaoBlur(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Environment) SetSsaoColor(color gdnative.Color) {
 	//log.Println("Calling Environment.SetSsaoColor()")
@@ -2778,7 +3115,7 @@ func (o *Environment) SetSsaoColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_color")
+	methodBind := methodBindEnvironmentSetSsaoColor.Get()
 
 	// Call the parent method.
 	// void
@@ -2788,8 +3125,8 @@ func (o *Environment) SetSsaoColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount float}], Returns: void
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
 */
 func (o *Environment) SetSsaoDirectLightAffect(amount gdnative.Real) {
 	//log.Println("Calling Environment.SetSsaoDirectLightAffect()")
@@ -2799,7 +3136,7 @@ func (o *Environment) SetSsaoDirectLightAffect(amount gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_direct_light_affect")
+	methodBind := methodBindEnvironmentSetSsaoDirectLightAffect.Get()
 
 	// Call the parent method.
 	// void
@@ -2809,8 +3146,8 @@ func (o *Environment) SetSsaoDirectLightAffect(amount gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false edge_sharpness float}], Returns: void
+	        Undocumented
+		Args: [{ false edge_sharpness float}], Returns: void
 */
 func (o *Environment) SetSsaoEdgeSharpness(edgeSharpness gdnative.Real) {
 	//log.Println("Calling Environment.SetSsaoEdgeSharpness()")
@@ -2820,7 +3157,7 @@ func (o *Environment) SetSsaoEdgeSharpness(edgeSharpness gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(edgeSharpness)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_edge_sharpness")
+	methodBind := methodBindEnvironmentSetSsaoEdgeSharpness.Get()
 
 	// Call the parent method.
 	// void
@@ -2830,8 +3167,8 @@ func (o *Environment) SetSsaoEdgeSharpness(edgeSharpness gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetSsaoEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetSsaoEnabled()")
@@ -2841,7 +3178,7 @@ func (o *Environment) SetSsaoEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_enabled")
+	methodBind := methodBindEnvironmentSetSsaoEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2851,8 +3188,8 @@ func (o *Environment) SetSsaoEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetSsaoIntensity(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetSsaoIntensity()")
@@ -2862,7 +3199,7 @@ func (o *Environment) SetSsaoIntensity(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_intensity")
+	methodBind := methodBindEnvironmentSetSsaoIntensity.Get()
 
 	// Call the parent method.
 	// void
@@ -2872,8 +3209,8 @@ func (o *Environment) SetSsaoIntensity(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *Environment) SetSsaoIntensity2(intensity gdnative.Real) {
 	//log.Println("Calling Environment.SetSsaoIntensity2()")
@@ -2883,7 +3220,7 @@ func (o *Environment) SetSsaoIntensity2(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_intensity2")
+	methodBind := methodBindEnvironmentSetSsaoIntensity2.Get()
 
 	// Call the parent method.
 	// void
@@ -2893,18 +3230,18 @@ func (o *Environment) SetSsaoIntensity2(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false quality int}], Returns: void
+	        Undocumented
+		Args: [{ false quality enum.Environment::SSAOQuality}], Returns: void
 */
-func (o *Environment) SetSsaoQuality(quality gdnative.Int) {
+func (o *Environment) SetSsaoQuality(quality EnvironmentSSAOQuality) {
 	//log.Println("Calling Environment.SetSsaoQuality()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(quality)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(quality))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_quality")
+	methodBind := methodBindEnvironmentSetSsaoQuality.Get()
 
 	// Call the parent method.
 	// void
@@ -2914,8 +3251,8 @@ func (o *Environment) SetSsaoQuality(quality gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *Environment) SetSsaoRadius(radius gdnative.Real) {
 	//log.Println("Calling Environment.SetSsaoRadius()")
@@ -2925,7 +3262,7 @@ func (o *Environment) SetSsaoRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_radius")
+	methodBind := methodBindEnvironmentSetSsaoRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -2935,8 +3272,8 @@ func (o *Environment) SetSsaoRadius(radius gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *Environment) SetSsaoRadius2(radius gdnative.Real) {
 	//log.Println("Calling Environment.SetSsaoRadius2()")
@@ -2946,7 +3283,7 @@ func (o *Environment) SetSsaoRadius2(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssao_radius2")
+	methodBind := methodBindEnvironmentSetSsaoRadius2.Get()
 
 	// Call the parent method.
 	// void
@@ -2956,8 +3293,8 @@ func (o *Environment) SetSsaoRadius2(radius gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false depth_tolerance float}], Returns: void
+	        Undocumented
+		Args: [{ false depth_tolerance float}], Returns: void
 */
 func (o *Environment) SetSsrDepthTolerance(depthTolerance gdnative.Real) {
 	//log.Println("Calling Environment.SetSsrDepthTolerance()")
@@ -2967,7 +3304,7 @@ func (o *Environment) SetSsrDepthTolerance(depthTolerance gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(depthTolerance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssr_depth_tolerance")
+	methodBind := methodBindEnvironmentSetSsrDepthTolerance.Get()
 
 	// Call the parent method.
 	// void
@@ -2977,8 +3314,8 @@ func (o *Environment) SetSsrDepthTolerance(depthTolerance gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Environment) SetSsrEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling Environment.SetSsrEnabled()")
@@ -2988,7 +3325,7 @@ func (o *Environment) SetSsrEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssr_enabled")
+	methodBind := methodBindEnvironmentSetSsrEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2998,8 +3335,8 @@ func (o *Environment) SetSsrEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false fade_in float}], Returns: void
+	        Undocumented
+		Args: [{ false fade_in float}], Returns: void
 */
 func (o *Environment) SetSsrFadeIn(fadeIn gdnative.Real) {
 	//log.Println("Calling Environment.SetSsrFadeIn()")
@@ -3009,7 +3346,7 @@ func (o *Environment) SetSsrFadeIn(fadeIn gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fadeIn)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssr_fade_in")
+	methodBind := methodBindEnvironmentSetSsrFadeIn.Get()
 
 	// Call the parent method.
 	// void
@@ -3019,8 +3356,8 @@ func (o *Environment) SetSsrFadeIn(fadeIn gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false fade_out float}], Returns: void
+	        Undocumented
+		Args: [{ false fade_out float}], Returns: void
 */
 func (o *Environment) SetSsrFadeOut(fadeOut gdnative.Real) {
 	//log.Println("Calling Environment.SetSsrFadeOut()")
@@ -3030,7 +3367,7 @@ func (o *Environment) SetSsrFadeOut(fadeOut gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fadeOut)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssr_fade_out")
+	methodBind := methodBindEnvironmentSetSsrFadeOut.Get()
 
 	// Call the parent method.
 	// void
@@ -3040,8 +3377,8 @@ func (o *Environment) SetSsrFadeOut(fadeOut gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false max_steps int}], Returns: void
+	        Undocumented
+		Args: [{ false max_steps int}], Returns: void
 */
 func (o *Environment) SetSsrMaxSteps(maxSteps gdnative.Int) {
 	//log.Println("Calling Environment.SetSsrMaxSteps()")
@@ -3051,7 +3388,7 @@ func (o *Environment) SetSsrMaxSteps(maxSteps gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(maxSteps)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssr_max_steps")
+	methodBind := methodBindEnvironmentSetSsrMaxSteps.Get()
 
 	// Call the parent method.
 	// void
@@ -3061,8 +3398,8 @@ func (o *Environment) SetSsrMaxSteps(maxSteps gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rough bool}], Returns: void
+	        Undocumented
+		Args: [{ false rough bool}], Returns: void
 */
 func (o *Environment) SetSsrRough(rough gdnative.Bool) {
 	//log.Println("Calling Environment.SetSsrRough()")
@@ -3072,7 +3409,7 @@ func (o *Environment) SetSsrRough(rough gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(rough)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_ssr_rough")
+	methodBind := methodBindEnvironmentSetSsrRough.Get()
 
 	// Call the parent method.
 	// void
@@ -3082,8 +3419,8 @@ func (o *Environment) SetSsrRough(rough gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false auto_exposure bool}], Returns: void
+	        Undocumented
+		Args: [{ false auto_exposure bool}], Returns: void
 */
 func (o *Environment) SetTonemapAutoExposure(autoExposure gdnative.Bool) {
 	//log.Println("Calling Environment.SetTonemapAutoExposure()")
@@ -3093,7 +3430,7 @@ func (o *Environment) SetTonemapAutoExposure(autoExposure gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(autoExposure)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemap_auto_exposure")
+	methodBind := methodBindEnvironmentSetTonemapAutoExposure.Get()
 
 	// Call the parent method.
 	// void
@@ -3103,8 +3440,8 @@ func (o *Environment) SetTonemapAutoExposure(autoExposure gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false exposure_grey float}], Returns: void
+	        Undocumented
+		Args: [{ false exposure_grey float}], Returns: void
 */
 func (o *Environment) SetTonemapAutoExposureGrey(exposureGrey gdnative.Real) {
 	//log.Println("Calling Environment.SetTonemapAutoExposureGrey()")
@@ -3114,7 +3451,7 @@ func (o *Environment) SetTonemapAutoExposureGrey(exposureGrey gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(exposureGrey)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemap_auto_exposure_grey")
+	methodBind := methodBindEnvironmentSetTonemapAutoExposureGrey.Get()
 
 	// Call the parent method.
 	// void
@@ -3124,8 +3461,8 @@ func (o *Environment) SetTonemapAutoExposureGrey(exposureGrey gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false exposure_max float}], Returns: void
+	        Undocumented
+		Args: [{ false exposure_max float}], Returns: void
 */
 func (o *Environment) SetTonemapAutoExposureMax(exposureMax gdnative.Real) {
 	//log.Println("Calling Environment.SetTonemapAutoExposureMax()")
@@ -3135,7 +3472,7 @@ func (o *Environment) SetTonemapAutoExposureMax(exposureMax gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(exposureMax)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemap_auto_exposure_max")
+	methodBind := methodBindEnvironmentSetTonemapAutoExposureMax.Get()
 
 	// Call the parent method.
 	// void
@@ -3145,8 +3482,8 @@ func (o *Environment) SetTonemapAutoExposureMax(exposureMax gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false exposure_min float}], Returns: void
+	        Undocumented
+		Args: [{ false exposure_min float}], Returns: void
 */
 func (o *Environment) SetTonemapAutoExposureMin(exposureMin gdnative.Real) {
 	//log.Println("Calling Environment.SetTonemapAutoExposureMin()")
@@ -3156,7 +3493,7 @@ func (o *Environment) SetTonemapAutoExposureMin(exposureMin gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(exposureMin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemap_auto_exposure_min")
+	methodBind := methodBindEnvironmentSetTonemapAutoExposureMin.Get()
 
 	// Call the parent method.
 	// void
@@ -3166,8 +3503,8 @@ func (o *Environment) SetTonemapAutoExposureMin(exposureMin gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false exposure_speed float}], Returns: void
+	        Undocumented
+		Args: [{ false exposure_speed float}], Returns: void
 */
 func (o *Environment) SetTonemapAutoExposureSpeed(exposureSpeed gdnative.Real) {
 	//log.Println("Calling Environment.SetTonemapAutoExposureSpeed()")
@@ -3177,7 +3514,7 @@ func (o *Environment) SetTonemapAutoExposureSpeed(exposureSpeed gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(exposureSpeed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemap_auto_exposure_speed")
+	methodBind := methodBindEnvironmentSetTonemapAutoExposureSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -3187,8 +3524,8 @@ func (o *Environment) SetTonemapAutoExposureSpeed(exposureSpeed gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false exposure float}], Returns: void
+	        Undocumented
+		Args: [{ false exposure float}], Returns: void
 */
 func (o *Environment) SetTonemapExposure(exposure gdnative.Real) {
 	//log.Println("Calling Environment.SetTonemapExposure()")
@@ -3198,7 +3535,7 @@ func (o *Environment) SetTonemapExposure(exposure gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(exposure)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemap_exposure")
+	methodBind := methodBindEnvironmentSetTonemapExposure.Get()
 
 	// Call the parent method.
 	// void
@@ -3208,8 +3545,8 @@ func (o *Environment) SetTonemapExposure(exposure gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false white float}], Returns: void
+	        Undocumented
+		Args: [{ false white float}], Returns: void
 */
 func (o *Environment) SetTonemapWhite(white gdnative.Real) {
 	//log.Println("Calling Environment.SetTonemapWhite()")
@@ -3219,7 +3556,7 @@ func (o *Environment) SetTonemapWhite(white gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(white)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemap_white")
+	methodBind := methodBindEnvironmentSetTonemapWhite.Get()
 
 	// Call the parent method.
 	// void
@@ -3229,18 +3566,18 @@ func (o *Environment) SetTonemapWhite(white gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Environment::ToneMapper}], Returns: void
 */
-func (o *Environment) SetTonemapper(mode gdnative.Int) {
+func (o *Environment) SetTonemapper(mode EnvironmentToneMapper) {
 	//log.Println("Calling Environment.SetTonemapper()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Environment", "set_tonemapper")
+	methodBind := methodBindEnvironmentSetTonemapper.Get()
 
 	// Call the parent method.
 	// void
@@ -3249,6 +3586,536 @@ func (o *Environment) SetTonemapper(mode gdnative.Int) {
 
 }
 
+// AdjustmentBrightness will return the value of the "adjustment_brightness" property.
+func (o *Environment) AdjustmentBrightness() gdnative.Real {
+	return o.GetAdjustmentBrightness()
+}
+
+// AdjustmentColorCorrection will return the value of the "adjustment_color_correction" property.
+func (o *Environment) AdjustmentColorCorrection() TextureImplementer {
+	return o.GetAdjustmentColorCorrection()
+}
+
+// AdjustmentContrast will return the value of the "adjustment_contrast" property.
+func (o *Environment) AdjustmentContrast() gdnative.Real {
+	return o.GetAdjustmentContrast()
+}
+
+// AdjustmentEnabled will return the value of the "adjustment_enabled" property.
+func (o *Environment) AdjustmentEnabled() gdnative.Bool {
+	return o.IsAdjustmentEnabled()
+}
+
+// SetAdjustmentEnabled will set the value of the "adjustment_enabled" property.
+func (o *Environment) SetAdjustmentEnabled(value gdnative.Bool) {
+	o.SetAdjustmentEnable(value)
+}
+
+// AdjustmentSaturation will return the value of the "adjustment_saturation" property.
+func (o *Environment) AdjustmentSaturation() gdnative.Real {
+	return o.GetAdjustmentSaturation()
+}
+
+// AmbientLightColor will return the value of the "ambient_light_color" property.
+func (o *Environment) AmbientLightColor() gdnative.Color {
+	return o.GetAmbientLightColor()
+}
+
+// AmbientLightEnergy will return the value of the "ambient_light_energy" property.
+func (o *Environment) AmbientLightEnergy() gdnative.Real {
+	return o.GetAmbientLightEnergy()
+}
+
+// AmbientLightSkyContribution will return the value of the "ambient_light_sky_contribution" property.
+func (o *Environment) AmbientLightSkyContribution() gdnative.Real {
+	return o.GetAmbientLightSkyContribution()
+}
+
+// AutoExposureEnabled will return the value of the "auto_exposure_enabled" property.
+func (o *Environment) AutoExposureEnabled() gdnative.Bool {
+	return o.GetTonemapAutoExposure()
+}
+
+// SetAutoExposureEnabled will set the value of the "auto_exposure_enabled" property.
+func (o *Environment) SetAutoExposureEnabled(value gdnative.Bool) {
+	o.SetTonemapAutoExposure(value)
+}
+
+// AutoExposureMaxLuma will return the value of the "auto_exposure_max_luma" property.
+func (o *Environment) AutoExposureMaxLuma() gdnative.Real {
+	return o.GetTonemapAutoExposureMax()
+}
+
+// SetAutoExposureMaxLuma will set the value of the "auto_exposure_max_luma" property.
+func (o *Environment) SetAutoExposureMaxLuma(value gdnative.Real) {
+	o.SetTonemapAutoExposureMax(value)
+}
+
+// AutoExposureMinLuma will return the value of the "auto_exposure_min_luma" property.
+func (o *Environment) AutoExposureMinLuma() gdnative.Real {
+	return o.GetTonemapAutoExposureMin()
+}
+
+// SetAutoExposureMinLuma will set the value of the "auto_exposure_min_luma" property.
+func (o *Environment) SetAutoExposureMinLuma(value gdnative.Real) {
+	o.SetTonemapAutoExposureMin(value)
+}
+
+// AutoExposureScale will return the value of the "auto_exposure_scale" property.
+func (o *Environment) AutoExposureScale() gdnative.Real {
+	return o.GetTonemapAutoExposureGrey()
+}
+
+// SetAutoExposureScale will set the value of the "auto_exposure_scale" property.
+func (o *Environment) SetAutoExposureScale(value gdnative.Real) {
+	o.SetTonemapAutoExposureGrey(value)
+}
+
+// AutoExposureSpeed will return the value of the "auto_exposure_speed" property.
+func (o *Environment) AutoExposureSpeed() gdnative.Real {
+	return o.GetTonemapAutoExposureSpeed()
+}
+
+// SetAutoExposureSpeed will set the value of the "auto_exposure_speed" property.
+func (o *Environment) SetAutoExposureSpeed(value gdnative.Real) {
+	o.SetTonemapAutoExposureSpeed(value)
+}
+
+// BackgroundCanvasMaxLayer will return the value of the "background_canvas_max_layer" property.
+func (o *Environment) BackgroundCanvasMaxLayer() gdnative.Int {
+	return o.GetCanvasMaxLayer()
+}
+
+// SetBackgroundCanvasMaxLayer will set the value of the "background_canvas_max_layer" property.
+func (o *Environment) SetBackgroundCanvasMaxLayer(value gdnative.Int) {
+	o.SetCanvasMaxLayer(value)
+}
+
+// BackgroundColor will return the value of the "background_color" property.
+func (o *Environment) BackgroundColor() gdnative.Color {
+	return o.GetBgColor()
+}
+
+// SetBackgroundColor will set the value of the "background_color" property.
+func (o *Environment) SetBackgroundColor(value gdnative.Color) {
+	o.SetBgColor(value)
+}
+
+// BackgroundEnergy will return the value of the "background_energy" property.
+func (o *Environment) BackgroundEnergy() gdnative.Real {
+	return o.GetBgEnergy()
+}
+
+// SetBackgroundEnergy will set the value of the "background_energy" property.
+func (o *Environment) SetBackgroundEnergy(value gdnative.Real) {
+	o.SetBgEnergy(value)
+}
+
+// BackgroundMode will return the value of the "background_mode" property.
+func (o *Environment) BackgroundMode() EnvironmentBGMode {
+	return o.GetBackground()
+}
+
+// SetBackgroundMode will set the value of the "background_mode" property.
+func (o *Environment) SetBackgroundMode(value EnvironmentBGMode) {
+	o.SetBackground(value)
+}
+
+// BackgroundSky will return the value of the "background_sky" property.
+func (o *Environment) BackgroundSky() SkyImplementer {
+	return o.GetSky()
+}
+
+// SetBackgroundSky will set the value of the "background_sky" property.
+func (o *Environment) SetBackgroundSky(value SkyImplementer) {
+	o.SetSky(value)
+}
+
+// BackgroundSkyCustomFov will return the value of the "background_sky_custom_fov" property.
+func (o *Environment) BackgroundSkyCustomFov() gdnative.Real {
+	return o.GetSkyCustomFov()
+}
+
+// SetBackgroundSkyCustomFov will set the value of the "background_sky_custom_fov" property.
+func (o *Environment) SetBackgroundSkyCustomFov(value gdnative.Real) {
+	o.SetSkyCustomFov(value)
+}
+
+// DofBlurFarAmount will return the value of the "dof_blur_far_amount" property.
+func (o *Environment) DofBlurFarAmount() gdnative.Real {
+	return o.GetDofBlurFarAmount()
+}
+
+// DofBlurFarDistance will return the value of the "dof_blur_far_distance" property.
+func (o *Environment) DofBlurFarDistance() gdnative.Real {
+	return o.GetDofBlurFarDistance()
+}
+
+// DofBlurFarEnabled will return the value of the "dof_blur_far_enabled" property.
+func (o *Environment) DofBlurFarEnabled() gdnative.Bool {
+	return o.IsDofBlurFarEnabled()
+}
+
+// DofBlurFarQuality will return the value of the "dof_blur_far_quality" property.
+func (o *Environment) DofBlurFarQuality() EnvironmentDOFBlurQuality {
+	return o.GetDofBlurFarQuality()
+}
+
+// DofBlurFarTransition will return the value of the "dof_blur_far_transition" property.
+func (o *Environment) DofBlurFarTransition() gdnative.Real {
+	return o.GetDofBlurFarTransition()
+}
+
+// DofBlurNearAmount will return the value of the "dof_blur_near_amount" property.
+func (o *Environment) DofBlurNearAmount() gdnative.Real {
+	return o.GetDofBlurNearAmount()
+}
+
+// DofBlurNearDistance will return the value of the "dof_blur_near_distance" property.
+func (o *Environment) DofBlurNearDistance() gdnative.Real {
+	return o.GetDofBlurNearDistance()
+}
+
+// DofBlurNearEnabled will return the value of the "dof_blur_near_enabled" property.
+func (o *Environment) DofBlurNearEnabled() gdnative.Bool {
+	return o.IsDofBlurNearEnabled()
+}
+
+// DofBlurNearQuality will return the value of the "dof_blur_near_quality" property.
+func (o *Environment) DofBlurNearQuality() EnvironmentDOFBlurQuality {
+	return o.GetDofBlurNearQuality()
+}
+
+// DofBlurNearTransition will return the value of the "dof_blur_near_transition" property.
+func (o *Environment) DofBlurNearTransition() gdnative.Real {
+	return o.GetDofBlurNearTransition()
+}
+
+// FogColor will return the value of the "fog_color" property.
+func (o *Environment) FogColor() gdnative.Color {
+	return o.GetFogColor()
+}
+
+// FogDepthBegin will return the value of the "fog_depth_begin" property.
+func (o *Environment) FogDepthBegin() gdnative.Real {
+	return o.GetFogDepthBegin()
+}
+
+// FogDepthCurve will return the value of the "fog_depth_curve" property.
+func (o *Environment) FogDepthCurve() gdnative.Real {
+	return o.GetFogDepthCurve()
+}
+
+// FogDepthEnabled will return the value of the "fog_depth_enabled" property.
+func (o *Environment) FogDepthEnabled() gdnative.Bool {
+	return o.IsFogDepthEnabled()
+}
+
+// FogEnabled will return the value of the "fog_enabled" property.
+func (o *Environment) FogEnabled() gdnative.Bool {
+	return o.IsFogEnabled()
+}
+
+// FogHeightCurve will return the value of the "fog_height_curve" property.
+func (o *Environment) FogHeightCurve() gdnative.Real {
+	return o.GetFogHeightCurve()
+}
+
+// FogHeightEnabled will return the value of the "fog_height_enabled" property.
+func (o *Environment) FogHeightEnabled() gdnative.Bool {
+	return o.IsFogHeightEnabled()
+}
+
+// FogHeightMax will return the value of the "fog_height_max" property.
+func (o *Environment) FogHeightMax() gdnative.Real {
+	return o.GetFogHeightMax()
+}
+
+// FogHeightMin will return the value of the "fog_height_min" property.
+func (o *Environment) FogHeightMin() gdnative.Real {
+	return o.GetFogHeightMin()
+}
+
+// FogSunAmount will return the value of the "fog_sun_amount" property.
+func (o *Environment) FogSunAmount() gdnative.Real {
+	return o.GetFogSunAmount()
+}
+
+// FogSunColor will return the value of the "fog_sun_color" property.
+func (o *Environment) FogSunColor() gdnative.Color {
+	return o.GetFogSunColor()
+}
+
+// FogTransmitCurve will return the value of the "fog_transmit_curve" property.
+func (o *Environment) FogTransmitCurve() gdnative.Real {
+	return o.GetFogTransmitCurve()
+}
+
+// FogTransmitEnabled will return the value of the "fog_transmit_enabled" property.
+func (o *Environment) FogTransmitEnabled() gdnative.Bool {
+	return o.IsFogTransmitEnabled()
+}
+
+// GlowBicubicUpscale will return the value of the "glow_bicubic_upscale" property.
+func (o *Environment) GlowBicubicUpscale() gdnative.Bool {
+	return o.IsGlowBicubicUpscaleEnabled()
+}
+
+// GlowBlendMode will return the value of the "glow_blend_mode" property.
+func (o *Environment) GlowBlendMode() EnvironmentGlowBlendMode {
+	return o.GetGlowBlendMode()
+}
+
+// GlowBloom will return the value of the "glow_bloom" property.
+func (o *Environment) GlowBloom() gdnative.Real {
+	return o.GetGlowBloom()
+}
+
+// GlowEnabled will return the value of the "glow_enabled" property.
+func (o *Environment) GlowEnabled() gdnative.Bool {
+	return o.IsGlowEnabled()
+}
+
+// GlowHdrScale will return the value of the "glow_hdr_scale" property.
+func (o *Environment) GlowHdrScale() gdnative.Real {
+	return o.GetGlowHdrBleedScale()
+}
+
+// SetGlowHdrScale will set the value of the "glow_hdr_scale" property.
+func (o *Environment) SetGlowHdrScale(value gdnative.Real) {
+	o.SetGlowHdrBleedScale(value)
+}
+
+// GlowHdrThreshold will return the value of the "glow_hdr_threshold" property.
+func (o *Environment) GlowHdrThreshold() gdnative.Real {
+	return o.GetGlowHdrBleedThreshold()
+}
+
+// SetGlowHdrThreshold will set the value of the "glow_hdr_threshold" property.
+func (o *Environment) SetGlowHdrThreshold(value gdnative.Real) {
+	o.SetGlowHdrBleedThreshold(value)
+}
+
+// GlowIntensity will return the value of the "glow_intensity" property.
+func (o *Environment) GlowIntensity() gdnative.Real {
+	return o.GetGlowIntensity()
+}
+
+// GlowLevels1 will return the value of the "glow_levels/1" property.
+func (o *Environment) GlowLevels1() gdnative.Bool {
+	return o.IsGlowLevelEnabled(0)
+}
+
+// SetGlowLevels1 will set the value of the "glow_levels/1" property.
+func (o *Environment) SetGlowLevels1(value gdnative.Bool) {
+	o.SetGlowLevel(0, value)
+}
+
+// GlowLevels2 will return the value of the "glow_levels/2" property.
+func (o *Environment) GlowLevels2() gdnative.Bool {
+	return o.IsGlowLevelEnabled(1)
+}
+
+// SetGlowLevels2 will set the value of the "glow_levels/2" property.
+func (o *Environment) SetGlowLevels2(value gdnative.Bool) {
+	o.SetGlowLevel(1, value)
+}
+
+// GlowLevels3 will return the value of the "glow_levels/3" property.
+func (o *Environment) GlowLevels3() gdnative.Bool {
+	return o.IsGlowLevelEnabled(2)
+}
+
+// SetGlowLevels3 will set the value of the "glow_levels/3" property.
+func (o *Environment) SetGlowLevels3(value gdnative.Bool) {
+	o.SetGlowLevel(2, value)
+}
+
+// GlowLevels4 will return the value of the "glow_levels/4" property.
+func (o *Environment) GlowLevels4() gdnative.Bool {
+	return o.IsGlowLevelEnabled(3)
+}
+
+// SetGlowLevels4 will set the value of the "glow_levels/4" property.
+func (o *Environment) SetGlowLevels4(value gdnative.Bool) {
+	o.SetGlowLevel(3, value)
+}
+
+// GlowLevels5 will return the value of the "glow_levels/5" property.
+func (o *Environment) GlowLevels5() gdnative.Bool {
+	return o.IsGlowLevelEnabled(4)
+}
+
+// SetGlowLevels5 will set the value of the "glow_levels/5" property.
+func (o *Environment) SetGlowLevels5(value gdnative.Bool) {
+	o.SetGlowLevel(4, value)
+}
+
+// GlowLevels6 will return the value of the "glow_levels/6" property.
+func (o *Environment) GlowLevels6() gdnative.Bool {
+	return o.IsGlowLevelEnabled(5)
+}
+
+// SetGlowLevels6 will set the value of the "glow_levels/6" property.
+func (o *Environment) SetGlowLevels6(value gdnative.Bool) {
+	o.SetGlowLevel(5, value)
+}
+
+// GlowLevels7 will return the value of the "glow_levels/7" property.
+func (o *Environment) GlowLevels7() gdnative.Bool {
+	return o.IsGlowLevelEnabled(6)
+}
+
+// SetGlowLevels7 will set the value of the "glow_levels/7" property.
+func (o *Environment) SetGlowLevels7(value gdnative.Bool) {
+	o.SetGlowLevel(6, value)
+}
+
+// GlowStrength will return the value of the "glow_strength" property.
+func (o *Environment) GlowStrength() gdnative.Real {
+	return o.GetGlowStrength()
+}
+
+// SsReflectionsDepthTolerance will return the value of the "ss_reflections_depth_tolerance" property.
+func (o *Environment) SsReflectionsDepthTolerance() gdnative.Real {
+	return o.GetSsrDepthTolerance()
+}
+
+// SetSsReflectionsDepthTolerance will set the value of the "ss_reflections_depth_tolerance" property.
+func (o *Environment) SetSsReflectionsDepthTolerance(value gdnative.Real) {
+	o.SetSsrDepthTolerance(value)
+}
+
+// SsReflectionsEnabled will return the value of the "ss_reflections_enabled" property.
+func (o *Environment) SsReflectionsEnabled() gdnative.Bool {
+	return o.IsSsrEnabled()
+}
+
+// SetSsReflectionsEnabled will set the value of the "ss_reflections_enabled" property.
+func (o *Environment) SetSsReflectionsEnabled(value gdnative.Bool) {
+	o.SetSsrEnabled(value)
+}
+
+// SsReflectionsFadeIn will return the value of the "ss_reflections_fade_in" property.
+func (o *Environment) SsReflectionsFadeIn() gdnative.Real {
+	return o.GetSsrFadeIn()
+}
+
+// SetSsReflectionsFadeIn will set the value of the "ss_reflections_fade_in" property.
+func (o *Environment) SetSsReflectionsFadeIn(value gdnative.Real) {
+	o.SetSsrFadeIn(value)
+}
+
+// SsReflectionsFadeOut will return the value of the "ss_reflections_fade_out" property.
+func (o *Environment) SsReflectionsFadeOut() gdnative.Real {
+	return o.GetSsrFadeOut()
+}
+
+// SetSsReflectionsFadeOut will set the value of the "ss_reflections_fade_out" property.
+func (o *Environment) SetSsReflectionsFadeOut(value gdnative.Real) {
+	o.SetSsrFadeOut(value)
+}
+
+// SsReflectionsMaxSteps will return the value of the "ss_reflections_max_steps" property.
+func (o *Environment) SsReflectionsMaxSteps() gdnative.Int {
+	return o.GetSsrMaxSteps()
+}
+
+// SetSsReflectionsMaxSteps will set the value of the "ss_reflections_max_steps" property.
+func (o *Environment) SetSsReflectionsMaxSteps(value gdnative.Int) {
+	o.SetSsrMaxSteps(value)
+}
+
+// SsReflectionsRoughness will return the value of the "ss_reflections_roughness" property.
+func (o *Environment) SsReflectionsRoughness() gdnative.Bool {
+	return o.IsSsrRough()
+}
+
+// SetSsReflectionsRoughness will set the value of the "ss_reflections_roughness" property.
+func (o *Environment) SetSsReflectionsRoughness(value gdnative.Bool) {
+	o.SetSsrRough(value)
+}
+
+// SsaoBias will return the value of the "ssao_bias" property.
+func (o *Environment) SsaoBias() gdnative.Real {
+	return o.GetSsaoBias()
+}
+
+// SsaoBlur will return the value of the "ssao_blur" property.
+func (o *Environment) SsaoBlur() EnvironmentSSAOBlur {
+	return o.GetSsaoBlur()
+}
+
+// SsaoColor will return the value of the "ssao_color" property.
+func (o *Environment) SsaoColor() gdnative.Color {
+	return o.GetSsaoColor()
+}
+
+// SsaoEdgeSharpness will return the value of the "ssao_edge_sharpness" property.
+func (o *Environment) SsaoEdgeSharpness() gdnative.Real {
+	return o.GetSsaoEdgeSharpness()
+}
+
+// SsaoEnabled will return the value of the "ssao_enabled" property.
+func (o *Environment) SsaoEnabled() gdnative.Bool {
+	return o.IsSsaoEnabled()
+}
+
+// SsaoIntensity will return the value of the "ssao_intensity" property.
+func (o *Environment) SsaoIntensity() gdnative.Real {
+	return o.GetSsaoIntensity()
+}
+
+// SsaoIntensity2 will return the value of the "ssao_intensity2" property.
+func (o *Environment) SsaoIntensity2() gdnative.Real {
+	return o.GetSsaoIntensity2()
+}
+
+// SsaoLightAffect will return the value of the "ssao_light_affect" property.
+func (o *Environment) SsaoLightAffect() gdnative.Real {
+	return o.GetSsaoDirectLightAffect()
+}
+
+// SetSsaoLightAffect will set the value of the "ssao_light_affect" property.
+func (o *Environment) SetSsaoLightAffect(value gdnative.Real) {
+	o.SetSsaoDirectLightAffect(value)
+}
+
+// SsaoQuality will return the value of the "ssao_quality" property.
+func (o *Environment) SsaoQuality() EnvironmentSSAOQuality {
+	return o.GetSsaoQuality()
+}
+
+// SsaoRadius will return the value of the "ssao_radius" property.
+func (o *Environment) SsaoRadius() gdnative.Real {
+	return o.GetSsaoRadius()
+}
+
+// SsaoRadius2 will return the value of the "ssao_radius2" property.
+func (o *Environment) SsaoRadius2() gdnative.Real {
+	return o.GetSsaoRadius2()
+}
+
+// TonemapExposure will return the value of the "tonemap_exposure" property.
+func (o *Environment) TonemapExposure() gdnative.Real {
+	return o.GetTonemapExposure()
+}
+
+// TonemapMode will return the value of the "tonemap_mode" property.
+func (o *Environment) TonemapMode() EnvironmentToneMapper {
+	return o.GetTonemapper()
+}
+
+// SetTonemapMode will set the value of the "tonemap_mode" property.
+func (o *Environment) SetTonemapMode(value EnvironmentToneMapper) {
+	o.SetTonemapper(value)
+}
+
+// TonemapWhite will return the value of the "tonemap_white" property.
+func (o *Environment) TonemapWhite() gdnative.Real {
+	return o.GetTonemapWhite()
+}
+
 // EnvironmentImplementer is an interface that implements the methods
 // of the Environment class.
 type EnvironmentImplementer interface {
@@ -3260,14 +4127,17 @@ type EnvironmentImplementer interface {
 	GetAmbientLightColor() gdnative.Color
 	GetAmbientLightEnergy() gdnative.Real
 	GetAmbientLightSkyContribution() gdnative.Real
+	GetBackground() EnvironmentBGMode
 	GetBgColor() gdnative.Color
 	GetBgEnergy() gdnative.Real
 	GetCanvasMaxLayer() gdnative.Int
 	GetDofBlurFarAmount() gdnative.Real
 	GetDofBlurFarDistance() gdnative.Real
+	GetDofBlurFarQuality() EnvironmentDOFBlurQuality
 	GetDofBlurFarTransition() gdnative.Real
 	GetDofBlurNearAmount() gdnative.Real
 	GetDofBlurNearDistance() gdnative.Real
+	GetDofBlurNearQuality() EnvironmentDOFBlurQuality
 	GetDofBlurNearTransition() gdnative.Real
 	GetFogColor() gdnative.Color
 	GetFogDepthBegin() gdnative.Real
@@ -3278,6 +4148,7 @@ type EnvironmentImplementer interface {
 	GetFogSunAmount() gdnative.Real
 	GetFogSunColor() gdnative.Color
 	GetFogTransmitCurve() gdnative.Real
+	GetGlowBlendMode() EnvironmentGlowBlendMode
 	GetGlowBloom() gdnative.Real
 	GetGlowHdrBleedScale() gdnative.Real
 	GetGlowHdrBleedThreshold() gdnative.Real
@@ -3286,11 +4157,13 @@ type EnvironmentImplementer interface {
 	GetSky() SkyImplementer
 	GetSkyCustomFov() gdnative.Real
 	GetSsaoBias() gdnative.Real
+	GetSsaoBlur() EnvironmentSSAOBlur
 	GetSsaoColor() gdnative.Color
 	GetSsaoDirectLightAffect() gdnative.Real
 	GetSsaoEdgeSharpness() gdnative.Real
 	GetSsaoIntensity() gdnative.Real
 	GetSsaoIntensity2() gdnative.Real
+	GetSsaoQuality() EnvironmentSSAOQuality
 	GetSsaoRadius() gdnative.Real
 	GetSsaoRadius2() gdnative.Real
 	GetSsrDepthTolerance() gdnative.Real
@@ -3304,6 +4177,7 @@ type EnvironmentImplementer interface {
 	GetTonemapAutoExposureSpeed() gdnative.Real
 	GetTonemapExposure() gdnative.Real
 	GetTonemapWhite() gdnative.Real
+	GetTonemapper() EnvironmentToneMapper
 	IsAdjustmentEnabled() gdnative.Bool
 	IsDofBlurFarEnabled() gdnative.Bool
 	IsDofBlurNearEnabled() gdnative.Bool
@@ -3325,19 +4199,19 @@ type EnvironmentImplementer interface {
 	SetAmbientLightColor(color gdnative.Color)
 	SetAmbientLightEnergy(energy gdnative.Real)
 	SetAmbientLightSkyContribution(energy gdnative.Real)
-	SetBackground(mode gdnative.Int)
+	SetBackground(mode EnvironmentBGMode)
 	SetBgColor(color gdnative.Color)
 	SetBgEnergy(energy gdnative.Real)
 	SetCanvasMaxLayer(layer gdnative.Int)
 	SetDofBlurFarAmount(intensity gdnative.Real)
 	SetDofBlurFarDistance(intensity gdnative.Real)
 	SetDofBlurFarEnabled(enabled gdnative.Bool)
-	SetDofBlurFarQuality(intensity gdnative.Int)
+	SetDofBlurFarQuality(intensity EnvironmentDOFBlurQuality)
 	SetDofBlurFarTransition(intensity gdnative.Real)
 	SetDofBlurNearAmount(intensity gdnative.Real)
 	SetDofBlurNearDistance(intensity gdnative.Real)
 	SetDofBlurNearEnabled(enabled gdnative.Bool)
-	SetDofBlurNearQuality(level gdnative.Int)
+	SetDofBlurNearQuality(level EnvironmentDOFBlurQuality)
 	SetDofBlurNearTransition(intensity gdnative.Real)
 	SetFogColor(color gdnative.Color)
 	SetFogDepthBegin(distance gdnative.Real)
@@ -3353,7 +4227,7 @@ type EnvironmentImplementer interface {
 	SetFogTransmitCurve(curve gdnative.Real)
 	SetFogTransmitEnabled(enabled gdnative.Bool)
 	SetGlowBicubicUpscale(enabled gdnative.Bool)
-	SetGlowBlendMode(mode gdnative.Int)
+	SetGlowBlendMode(mode EnvironmentGlowBlendMode)
 	SetGlowBloom(amount gdnative.Real)
 	SetGlowEnabled(enabled gdnative.Bool)
 	SetGlowHdrBleedScale(scale gdnative.Real)
@@ -3364,14 +4238,14 @@ type EnvironmentImplementer interface {
 	SetSky(sky SkyImplementer)
 	SetSkyCustomFov(scale gdnative.Real)
 	SetSsaoBias(bias gdnative.Real)
-	SetSsaoBlur(mode gdnative.Int)
+	SetSsaoBlur(mode EnvironmentSSAOBlur)
 	SetSsaoColor(color gdnative.Color)
 	SetSsaoDirectLightAffect(amount gdnative.Real)
 	SetSsaoEdgeSharpness(edgeSharpness gdnative.Real)
 	SetSsaoEnabled(enabled gdnative.Bool)
 	SetSsaoIntensity(intensity gdnative.Real)
 	SetSsaoIntensity2(intensity gdnative.Real)
-	SetSsaoQuality(quality gdnative.Int)
+	SetSsaoQuality(quality EnvironmentSSAOQuality)
 	SetSsaoRadius(radius gdnative.Real)
 	SetSsaoRadius2(radius gdnative.Real)
 	SetSsrDepthTolerance(depthTolerance gdnative.Real)
@@ -3387,5 +4261,111 @@ type EnvironmentImplementer interface {
 	SetTonemapAutoExposureSpeed(exposureSpeed gdnative.Real)
 	SetTonemapExposure(exposure gdnative.Real)
 	SetTonemapWhite(white gdnative.Real)
-	SetTonemapper(mode gdnative.Int)
+	SetTonemapper(mode EnvironmentToneMapper)
+	AdjustmentBrightness() gdnative.Real
+	AdjustmentColorCorrection() TextureImplementer
+	AdjustmentContrast() gdnative.Real
+	AdjustmentEnabled() gdnative.Bool
+	SetAdjustmentEnabled(value gdnative.Bool)
+	AdjustmentSaturation() gdnative.Real
+	AmbientLightColor() gdnative.Color
+	AmbientLightEnergy() gdnative.Real
+	AmbientLightSkyContribution() gdnative.Real
+	AutoExposureEnabled() gdnative.Bool
+	SetAutoExposureEnabled(value gdnative.Bool)
+	AutoExposureMaxLuma() gdnative.Real
+	SetAutoExposureMaxLuma(value gdnative.Real)
+	AutoExposureMinLuma() gdnative.Real
+	SetAutoExposureMinLuma(value gdnative.Real)
+	AutoExposureScale() gdnative.Real
+	SetAutoExposureScale(value gdnative.Real)
+	AutoExposureSpeed() gdnative.Real
+	SetAutoExposureSpeed(value gdnative.Real)
+	BackgroundCanvasMaxLayer() gdnative.Int
+	SetBackgroundCanvasMaxLayer(value gdnative.Int)
+	BackgroundColor() gdnative.Color
+	SetBackgroundColor(value gdnative.Color)
+	BackgroundEnergy() gdnative.Real
+	SetBackgroundEnergy(value gdnative.Real)
+	BackgroundMode() EnvironmentBGMode
+	SetBackgroundMode(value EnvironmentBGMode)
+	BackgroundSky() SkyImplementer
+	SetBackgroundSky(value SkyImplementer)
+	BackgroundSkyCustomFov() gdnative.Real
+	SetBackgroundSkyCustomFov(value gdnative.Real)
+	DofBlurFarAmount() gdnative.Real
+	DofBlurFarDistance() gdnative.Real
+	DofBlurFarEnabled() gdnative.Bool
+	DofBlurFarQuality() EnvironmentDOFBlurQuality
+	DofBlurFarTransition() gdnative.Real
+	DofBlurNearAmount() gdnative.Real
+	DofBlurNearDistance() gdnative.Real
+	DofBlurNearEnabled() gdnative.Bool
+	DofBlurNearQuality() EnvironmentDOFBlurQuality
+	DofBlurNearTransition() gdnative.Real
+	FogColor() gdnative.Color
+	FogDepthBegin() gdnative.Real
+	FogDepthCurve() gdnative.Real
+	FogDepthEnabled() gdnative.Bool
+	FogEnabled() gdnative.Bool
+	FogHeightCurve() gdnative.Real
+	FogHeightEnabled() gdnative.Bool
+	FogHeightMax() gdnative.Real
+	FogHeightMin() gdnative.Real
+	FogSunAmount() gdnative.Real
+	FogSunColor() gdnative.Color
+	FogTransmitCurve() gdnative.Real
+	FogTransmitEnabled() gdnative.Bool
+	GlowBicubicUpscale() gdnative.Bool
+	GlowBlendMode() EnvironmentGlowBlendMode
+	GlowBloom() gdnative.Real
+	GlowEnabled() gdnative.Bool
+	GlowHdrScale() gdnative.Real
+	SetGlowHdrScale(value gdnative.Real)
+	GlowHdrThreshold() gdnative.Real
+	SetGlowHdrThreshold(value gdnative.Real)
+	GlowIntensity() gdnative.Real
+	GlowLevels1() gdnative.Bool
+	SetGlowLevels1(value gdnative.Bool)
+	GlowLevels2() gdnative.Bool
+	SetGlowLevels2(value gdnative.Bool)
+	GlowLevels3() gdnative.Bool
+	SetGlowLevels3(value gdnative.Bool)
+	GlowLevels4() gdnative.Bool
+	SetGlowLevels4(value gdnative.Bool)
+	GlowLevels5() gdnative.Bool
+	SetGlowLevels5(value gdnative.Bool)
+	GlowLevels6() gdnative.Bool
+	SetGlowLevels6(value gdnative.Bool)
+	GlowLevels7() gdnative.Bool
+	SetGlowLevels7(value gdnative.Bool)
+	GlowStrength() gdnative.Real
+	SsReflectionsDepthTolerance() gdnative.Real
+	SetSsReflectionsDepthTolerance(value gdnative.Real)
+	SsReflectionsEnabled() gdnative.Bool
+	SetSsReflectionsEnabled(value gdnative.Bool)
+	SsReflectionsFadeIn() gdnative.Real
+	SetSsReflectionsFadeIn(value gdnative.Real)
+	SsReflectionsFadeOut() gdnative.Real
+	SetSsReflectionsFadeOut(value gdnative.Real)
+	SsReflectionsMaxSteps() gdnative.Int
+	SetSsReflectionsMaxSteps(value gdnative.Int)
+	SsReflectionsRoughness() gdnative.Bool
+	SetSsReflectionsRoughness(value gdnative.Bool)
+	SsaoBias() gdnative.Real
+	SsaoBlur() EnvironmentSSAOBlur
+	SsaoColor() gdnative.Color
+	SsaoEdgeSharpness() gdnative.Real
+	SsaoEnabled() gdnative.Bool
+	SsaoIntensity() gdnative.Real
+	SsaoIntensity2() gdnative.Real
+	SsaoLightAffect() gdnative.Real
+	SetSsaoLightAffect(value gdnative.Real)
+	SsaoQuality() EnvironmentSSAOQuality
+	SsaoRadius() gdnative.Real
+	SsaoRadius2() gdnative.Real
+	TonemapExposure() gdnative.Real
+	TonemapMode() EnvironmentToneMapper
+	SetTonemapMode(value EnvironmentToneMapper)
+	TonemapWhite() gdnative.Real
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewFontFromPointer(ptr gdnative.Pointer) Font {
-func newFontFromPointer(ptr gdnative.Pointer) Font {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Font{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Font contains a unicode compatible character set, as well as the ability to draw it with variable width, ascent, descent and kerning. For creating fonts from TTF files (or other font formats), see the editor support for fonts. TODO check wikipedia for graph of ascent/baseline/descent/height/etc.
 */
@@ -34,9 +25,22 @@ func (o *Font) BaseClass() string {
 	return "Font"
 }
 
+// Method binds of the Font class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindFontDraw                = gdnative.NewLazyMethodBind("Font", "draw")
+	methodBindFontDrawChar            = gdnative.NewLazyMethodBind("Font", "draw_char")
+	methodBindFontGetAscent           = gdnative.NewLazyMethodBind("Font", "get_ascent")
+	methodBindFontGetDescent          = gdnative.NewLazyMethodBind("Font", "get_descent")
+	methodBindFontGetHeight           = gdnative.NewLazyMethodBind("Font", "get_height")
+	methodBindFontGetStringSize       = gdnative.NewLazyMethodBind("Font", "get_string_size")
+	methodBindFontIsDistanceFieldHint = gdnative.NewLazyMethodBind("Font", "is_distance_field_hint")
+	methodBindFontUpdateChanges       = gdnative.NewLazyMethodBind("Font", "update_changes")
+)
+
 /*
-        Draw "string" into a canvas item using the font at a given position, with "modulate" color, and optionally clipping the width. "position" specifies the baseline, not the top. To draw from the top, [i]ascent[/i] must be added to the Y axis.
-	Args: [{ false canvas_item RID} { false position Vector2} { false string String} {1,1,1,1 true modulate Color} {-1 true clip_w int}], Returns: void
+	        Draw "string" into a canvas item using the font at a given position, with "modulate" color, and optionally clipping the width. "position" specifies the baseline, not the top. To draw from the top, [i]ascent[/i] must be added to the Y axis.
+		Args: [{ false canvas_item RID} { false position Vector2} { false string String} {1,1,1,1 true modulate Color} {-1 true clip_w int}], Returns: void
 */
 func (o *Font) Draw(canvasItem gdnative.Rid, position gdnative.Vector2, string gdnative.String, modulate gdnative.Color, clipW gdnative.Int) {
 	//log.Println("Calling Font.Draw()")
@@ -50,7 +54,7 @@ func (o *Font) Draw(canvasItem gdnative.Rid, position gdnative.Vector2, string g
 	ptrArguments[4] = gdnative.NewPointerFromInt(clipW)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "draw")
+	methodBind := methodBindFontDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -60,8 +64,8 @@ func (o *Font) Draw(canvasItem gdnative.Rid, position gdnative.Vector2, string g
 }
 
 /*
-        Draw character "char" into a canvas item using the font at a given position, with "modulate" color, and optionally kerning if "next" is passed. clipping the width. "position" specifies the baseline, not the top. To draw from the top, [i]ascent[/i] must be added to the Y axis. The width used by the character is returned, making this function useful for drawing strings character by character.
-	Args: [{ false canvas_item RID} { false position Vector2} { false char int} {-1 true next int} {1,1,1,1 true modulate Color}], Returns: float
+	        Draw character "char" into a canvas item using the font at a given position, with "modulate" color, and optionally kerning if "next" is passed. clipping the width. "position" specifies the baseline, not the top. To draw from the top, [i]ascent[/i] must be added to the Y axis. The width used by the character is returned, making this function useful for drawing strings character by character.
+		Args: [{ false canvas_item RID} { false position Vector2} { false char int} {-1 true next int} {1,1,1,1 true modulate Color}], Returns: float
 */
 func (o *Font) DrawChar(canvasItem gdnative.Rid, position gdnative.Vector2, char gdnative.Int, next gdnative.Int, modulate gdnative.Color) gdnative.Real {
 	//log.Println("Calling Font.DrawChar()")
@@ -75,7 +79,7 @@ func (o *Font) DrawChar(canvasItem gdnative.Rid, position gdnative.Vector2, char
 	ptrArguments[4] = gdnative.NewPointerFromColor(modulate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "draw_char")
+	methodBind := methodBindFontDrawChar.Get()
 
 	// Call the parent method.
 	// float
@@ -88,8 +92,8 @@ func (o *Font) DrawChar(canvasItem gdnative.Rid, position gdnative.Vector2, char
 }
 
 /*
-        Return the font ascent (number of pixels above the baseline).
-	Args: [], Returns: float
+	        Return the font ascent (number of pixels above the baseline).
+		Args: [], Returns: float
 */
 func (o *Font) GetAscent() gdnative.Real {
 	//log.Println("Calling Font.GetAscent()")
@@ -98,7 +102,7 @@ func (o *Font) GetAscent() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "get_ascent")
+	methodBind := methodBindFontGetAscent.Get()
 
 	// Call the parent method.
 	// float
@@ -111,8 +115,8 @@ func (o *Font) GetAscent() gdnative.Real {
 }
 
 /*
-        Return the font descent (number of pixels below the baseline).
-	Args: [], Returns: float
+	        Return the font descent (number of pixels below the baseline).
+		Args: [], Returns: float
 */
 func (o *Font) GetDescent() gdnative.Real {
 	//log.Println("Calling Font.GetDescent()")
@@ -121,7 +125,7 @@ func (o *Font) GetDescent() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "get_descent")
+	methodBind := methodBindFontGetDescent.Get()
 
 	// Call the parent method.
 	// float
@@ -134,8 +138,8 @@ func (o *Font) GetDescent() gdnative.Real {
 }
 
 /*
-        Return the total font height (ascent plus descent) in pixels.
-	Args: [], Returns: float
+	        Return the total font height (ascent plus descent) in pixels.
+		Args: [], Returns: float
 */
 func (o *Font) GetHeight() gdnative.Real {
 	//log.Println("Calling Font.GetHeight()")
@@ -144,7 +148,7 @@ func (o *Font) GetHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "get_height")
+	methodBind := methodBindFontGetHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -157,8 +161,8 @@ func (o *Font) GetHeight() gdnative.Real {
 }
 
 /*
-        Return the size of a string, taking kerning and advance into account.
-	Args: [{ false string String}], Returns: Vector2
+	        Return the size of a string, taking kerning and advance into account.
+		Args: [{ false string String}], Returns: Vector2
 */
 func (o *Font) GetStringSize(string gdnative.String) gdnative.Vector2 {
 	//log.Println("Calling Font.GetStringSize()")
@@ -168,7 +172,7 @@ func (o *Font) GetStringSize(string gdnative.String) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromString(string)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "get_string_size")
+	methodBind := methodBindFontGetStringSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -181,8 +185,7 @@ func (o *Font) GetStringSize(string gdnative.String) gdnative.Vector2 {
 }
 
 /*
-
-	Args: [], Returns: bool
+Args: [], Returns: bool
 */
 func (o *Font) IsDistanceFieldHint() gdnative.Bool {
 	//log.Println("Calling Font.IsDistanceFieldHint()")
@@ -191,7 +194,7 @@ func (o *Font) IsDistanceFieldHint() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "is_distance_field_hint")
+	methodBind := methodBindFontIsDistanceFieldHint.Get()
 
 	// Call the parent method.
 	// bool
@@ -204,8 +207,8 @@ func (o *Font) IsDistanceFieldHint() gdnative.Bool {
 }
 
 /*
-        After editing a font (changing size, ascent, char rects, etc.). Call this function to propagate changes to controls that might use it.
-	Args: [], Returns: void
+	        After editing a font (changing size, ascent, char rects, etc.). Call this function to propagate changes to controls that might use it.
+		Args: [], Returns: void
 */
 func (o *Font) UpdateChanges() {
 	//log.Println("Calling Font.UpdateChanges()")
@@ -214,7 +217,7 @@ func (o *Font) UpdateChanges() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Font", "update_changes")
+	methodBind := methodBindFontUpdateChanges.Get()
 
 	// Call the parent method.
 	// void
@@ -223,6 +226,16 @@ func (o *Font) UpdateChanges() {
 
 }
 
+// DrawWithDefaults will call Draw using the default values for: modulate, clip_w.
+func (o *Font) DrawWithDefaults(canvasItem gdnative.Rid, position gdnative.Vector2, string gdnative.String) {
+	o.Draw(canvasItem, position, string, gdnative.NewColorRgba(1, 1, 1, 1), -1)
+}
+
+// DrawCharWithDefaults will call DrawChar using the default values for: next, modulate.
+func (o *Font) DrawCharWithDefaults(canvasItem gdnative.Rid, position gdnative.Vector2, char gdnative.Int) gdnative.Real {
+	return o.DrawChar(canvasItem, position, char, -1, gdnative.NewColorRgba(1, 1, 1, 1))
+}
+
 // FontImplementer is an interface that implements the methods
 // of the Font class.
 type FontImplementer interface {
@@ -235,4 +248,6 @@ type FontImplementer interface {
 	GetStringSize(string gdnative.String) gdnative.Vector2
 	IsDistanceFieldHint() gdnative.Bool
 	UpdateChanges()
+	DrawWithDefaults(canvasItem gdnative.Rid, position gdnative.Vector2, string gdnative.String)
+	DrawCharWithDefaults(canvasItem gdnative.Rid, position gdnative.Vector2, char gdnative.Int) gdnative.Real
 }
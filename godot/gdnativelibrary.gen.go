@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGDNativeLibraryFromPointer(ptr gdnative.Pointer) GDNativeLibrary {
-func newGDNativeLibraryFromPointer(ptr gdnative.Pointer) GDNativeLibrary {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GDNativeLibrary{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +21,46 @@ type GDNativeLibrary struct {
 	owner gdnative.Object
 }
 
+// classConstructorGDNativeLibrary is the class constructor of the GDNativeLibrary class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGDNativeLibrary = gdnative.NewLazyClassConstructor("GDNativeLibrary")
+
+// NewGDNativeLibrary will create a new instance of the GDNativeLibrary class.
+// GDNativeLibrary is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewGDNativeLibrary() *GDNativeLibrary {
+	obj := &GDNativeLibrary{}
+	obj.SetBaseObject(classConstructorGDNativeLibrary.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GDNativeLibrary) BaseClass() string {
 	return "GDNativeLibrary"
 }
 
+// Method binds of the GDNativeLibrary class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGDNativeLibraryGetConfigFile          = gdnative.NewLazyMethodBind("GDNativeLibrary", "get_config_file")
+	methodBindGDNativeLibraryGetCurrentDependencies = gdnative.NewLazyMethodBind("GDNativeLibrary", "get_current_dependencies")
+	methodBindGDNativeLibraryGetCurrentLibraryPath  = gdnative.NewLazyMethodBind("GDNativeLibrary", "get_current_library_path")
+	methodBindGDNativeLibraryGetSymbolPrefix        = gdnative.NewLazyMethodBind("GDNativeLibrary", "get_symbol_prefix")
+	methodBindGDNativeLibraryIsReloadable           = gdnative.NewLazyMethodBind("GDNativeLibrary", "is_reloadable")
+	methodBindGDNativeLibraryIsSingleton            = gdnative.NewLazyMethodBind("GDNativeLibrary", "is_singleton")
+	methodBindGDNativeLibrarySetLoadOnce            = gdnative.NewLazyMethodBind("GDNativeLibrary", "set_load_once")
+	methodBindGDNativeLibrarySetReloadable          = gdnative.NewLazyMethodBind("GDNativeLibrary", "set_reloadable")
+	methodBindGDNativeLibrarySetSingleton           = gdnative.NewLazyMethodBind("GDNativeLibrary", "set_singleton")
+	methodBindGDNativeLibrarySetSymbolPrefix        = gdnative.NewLazyMethodBind("GDNativeLibrary", "set_symbol_prefix")
+	methodBindGDNativeLibraryShouldLoadOnce         = gdnative.NewLazyMethodBind("GDNativeLibrary", "should_load_once")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: ConfigFile
+	        Undocumented
+		Args: [], Returns: ConfigFile
 */
 func (o *GDNativeLibrary) GetConfigFile() ConfigFileImplementer {
 	//log.Println("Calling GDNativeLibrary.GetConfigFile()")
@@ -45,7 +69,7 @@ func (o *GDNativeLibrary) GetConfigFile() ConfigFileImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "get_config_file")
+	methodBind := methodBindGDNativeLibraryGetConfigFile.Get()
 
 	// Call the parent method.
 	// ConfigFile
@@ -53,27 +77,25 @@ func (o *GDNativeLibrary) GetConfigFile() ConfigFileImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newConfigFileFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ConfigFileImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ConfigFile" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ConfigFileImplementer)
+	ret := ConfigFile{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ConfigFileImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolStringArray
+	        Undocumented
+		Args: [], Returns: PoolStringArray
 */
 func (o *GDNativeLibrary) GetCurrentDependencies() gdnative.PoolStringArray {
 	//log.Println("Calling GDNativeLibrary.GetCurrentDependencies()")
@@ -82,7 +104,7 @@ func (o *GDNativeLibrary) GetCurrentDependencies() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "get_current_dependencies")
+	methodBind := methodBindGDNativeLibraryGetCurrentDependencies.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -95,8 +117,8 @@ func (o *GDNativeLibrary) GetCurrentDependencies() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *GDNativeLibrary) GetCurrentLibraryPath() gdnative.String {
 	//log.Println("Calling GDNativeLibrary.GetCurrentLibraryPath()")
@@ -105,7 +127,7 @@ func (o *GDNativeLibrary) GetCurrentLibraryPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "get_current_library_path")
+	methodBind := methodBindGDNativeLibraryGetCurrentLibraryPath.Get()
 
 	// Call the parent method.
 	// String
@@ -118,8 +140,8 @@ func (o *GDNativeLibrary) GetCurrentLibraryPath() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *GDNativeLibrary) GetSymbolPrefix() gdnative.String {
 	//log.Println("Calling GDNativeLibrary.GetSymbolPrefix()")
@@ -128,7 +150,7 @@ func (o *GDNativeLibrary) GetSymbolPrefix() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "get_symbol_prefix")
+	methodBind := methodBindGDNativeLibraryGetSymbolPrefix.Get()
 
 	// Call the parent method.
 	// String
@@ -141,8 +163,8 @@ func (o *GDNativeLibrary) GetSymbolPrefix() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GDNativeLibrary) IsReloadable() gdnative.Bool {
 	//log.Println("Calling GDNativeLibrary.IsReloadable()")
@@ -151,7 +173,7 @@ func (o *GDNativeLibrary) IsReloadable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "is_reloadable")
+	methodBind := methodBindGDNativeLibraryIsReloadable.Get()
 
 	// Call the parent method.
 	// bool
@@ -164,8 +186,8 @@ func (o *GDNativeLibrary) IsReloadable() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GDNativeLibrary) IsSingleton() gdnative.Bool {
 	//log.Println("Calling GDNativeLibrary.IsSingleton()")
@@ -174,7 +196,7 @@ func (o *GDNativeLibrary) IsSingleton() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "is_singleton")
+	methodBind := methodBindGDNativeLibraryIsSingleton.Get()
 
 	// Call the parent method.
 	// bool
@@ -187,8 +209,8 @@ func (o *GDNativeLibrary) IsSingleton() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false load_once bool}], Returns: void
+	        Undocumented
+		Args: [{ false load_once bool}], Returns: void
 */
 func (o *GDNativeLibrary) SetLoadOnce(loadOnce gdnative.Bool) {
 	//log.Println("Calling GDNativeLibrary.SetLoadOnce()")
@@ -198,7 +220,7 @@ func (o *GDNativeLibrary) SetLoadOnce(loadOnce gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(loadOnce)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "set_load_once")
+	methodBind := methodBindGDNativeLibrarySetLoadOnce.Get()
 
 	// Call the parent method.
 	// void
@@ -208,8 +230,8 @@ func (o *GDNativeLibrary) SetLoadOnce(loadOnce gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false reloadable bool}], Returns: void
+	        Undocumented
+		Args: [{ false reloadable bool}], Returns: void
 */
 func (o *GDNativeLibrary) SetReloadable(reloadable gdnative.Bool) {
 	//log.Println("Calling GDNativeLibrary.SetReloadable()")
@@ -219,7 +241,7 @@ func (o *GDNativeLibrary) SetReloadable(reloadable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(reloadable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "set_reloadable")
+	methodBind := methodBindGDNativeLibrarySetReloadable.Get()
 
 	// Call the parent method.
 	// void
@@ -229,8 +251,8 @@ func (o *GDNativeLibrary) SetReloadable(reloadable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false singleton bool}], Returns: void
+	        Undocumented
+		Args: [{ false singleton bool}], Returns: void
 */
 func (o *GDNativeLibrary) SetSingleton(singleton gdnative.Bool) {
 	//log.Println("Calling GDNativeLibrary.SetSingleton()")
@@ -240,7 +262,7 @@ func (o *GDNativeLibrary) SetSingleton(singleton gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(singleton)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "set_singleton")
+	methodBind := methodBindGDNativeLibrarySetSingleton.Get()
 
 	// Call the parent method.
 	// void
@@ -250,8 +272,8 @@ func (o *GDNativeLibrary) SetSingleton(singleton gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false symbol_prefix String}], Returns: void
+	        Undocumented
+		Args: [{ false symbol_prefix String}], Returns: void
 */
 func (o *GDNativeLibrary) SetSymbolPrefix(symbolPrefix gdnative.String) {
 	//log.Println("Calling GDNativeLibrary.SetSymbolPrefix()")
@@ -261,7 +283,7 @@ func (o *GDNativeLibrary) SetSymbolPrefix(symbolPrefix gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(symbolPrefix)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "set_symbol_prefix")
+	methodBind := methodBindGDNativeLibrarySetSymbolPrefix.Get()
 
 	// Call the parent method.
 	// void
@@ -271,8 +293,8 @@ func (o *GDNativeLibrary) SetSymbolPrefix(symbolPrefix gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GDNativeLibrary) ShouldLoadOnce() gdnative.Bool {
 	//log.Println("Calling GDNativeLibrary.ShouldLoadOnce()")
@@ -281,7 +303,7 @@ func (o *GDNativeLibrary) ShouldLoadOnce() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNativeLibrary", "should_load_once")
+	methodBind := methodBindGDNativeLibraryShouldLoadOnce.Get()
 
 	// Call the parent method.
 	// bool
@@ -293,6 +315,26 @@ func (o *GDNativeLibrary) ShouldLoadOnce() gdnative.Bool {
 	return ret
 }
 
+// LoadOnce will return the value of the "load_once" property.
+func (o *GDNativeLibrary) LoadOnce() gdnative.Bool {
+	return o.ShouldLoadOnce()
+}
+
+// Reloadable will return the value of the "reloadable" property.
+func (o *GDNativeLibrary) Reloadable() gdnative.Bool {
+	return o.IsReloadable()
+}
+
+// Singleton will return the value of the "singleton" property.
+func (o *GDNativeLibrary) Singleton() gdnative.Bool {
+	return o.IsSingleton()
+}
+
+// SymbolPrefix will return the value of the "symbol_prefix" property.
+func (o *GDNativeLibrary) SymbolPrefix() gdnative.String {
+	return o.GetSymbolPrefix()
+}
+
 // GDNativeLibraryImplementer is an interface that implements the methods
 // of the GDNativeLibrary class.
 type GDNativeLibraryImplementer interface {
@@ -308,4 +350,8 @@ type GDNativeLibraryImplementer interface {
 	SetSingleton(singleton gdnative.Bool)
 	SetSymbolPrefix(symbolPrefix gdnative.String)
 	ShouldLoadOnce() gdnative.Bool
+	LoadOnce() gdnative.Bool
+	Reloadable() gdnative.Bool
+	Singleton() gdnative.Bool
+	SymbolPrefix() gdnative.String
 }
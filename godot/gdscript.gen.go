@@ -1,103 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewGDScriptFromPointer(ptr gdnative.Pointer) GDScript {
-func newGDScriptFromPointer(ptr gdnative.Pointer) GDScript {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GDScript{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Undocumented
-*/
-type GDScript struct {
-	Script
-	owner gdnative.Object
-}
-
-func (o *GDScript) BaseClass() string {
-	return "GDScript"
-}
-
-/*
-        Undocumented
-	Args: [], Returns: PoolByteArray
-*/
-func (o *GDScript) GetAsByteCode() gdnative.PoolByteArray {
-	//log.Println("Calling GDScript.GetAsByteCode()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDScript", "get_as_byte_code")
-
-	// Call the parent method.
-	// PoolByteArray
-	retPtr := gdnative.NewEmptyPoolByteArray()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewPoolByteArrayFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Object
-*/
-func (o *GDScript) New() ObjectImplementer {
-	//log.Println("Calling GDScript.New()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDScript", "new")
-
-	// Call the parent method.
-	// Object
-	retPtr := gdnative.NewEmptyObject()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
-	}
-
-	return &ret
-}
-
-// GDScriptImplementer is an interface that implements the methods
-// of the GDScript class.
-type GDScriptImplementer interface {
-	ScriptImplementer
-	GetAsByteCode() gdnative.PoolByteArray
-	New() ObjectImplementer
-}
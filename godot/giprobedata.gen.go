@@ -13,30 +13,64 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGIProbeDataFromPointer(ptr gdnative.Pointer) GIProbeData {
-func newGIProbeDataFromPointer(ptr gdnative.Pointer) GIProbeData {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GIProbeData{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type GIProbeData struct {
 	Resource
 	owner gdnative.Object
 }
 
+// classConstructorGIProbeData is the class constructor of the GIProbeData class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGIProbeData = gdnative.NewLazyClassConstructor("GIProbeData")
+
+// NewGIProbeData will create a new instance of the GIProbeData class.
+// GIProbeData is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewGIProbeData() *GIProbeData {
+	obj := &GIProbeData{}
+	obj.SetBaseObject(classConstructorGIProbeData.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GIProbeData) BaseClass() string {
 	return "GIProbeData"
 }
 
+// Method binds of the GIProbeData class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGIProbeDataGetBias         = gdnative.NewLazyMethodBind("GIProbeData", "get_bias")
+	methodBindGIProbeDataGetBounds       = gdnative.NewLazyMethodBind("GIProbeData", "get_bounds")
+	methodBindGIProbeDataGetCellSize     = gdnative.NewLazyMethodBind("GIProbeData", "get_cell_size")
+	methodBindGIProbeDataGetDynamicData  = gdnative.NewLazyMethodBind("GIProbeData", "get_dynamic_data")
+	methodBindGIProbeDataGetDynamicRange = gdnative.NewLazyMethodBind("GIProbeData", "get_dynamic_range")
+	methodBindGIProbeDataGetEnergy       = gdnative.NewLazyMethodBind("GIProbeData", "get_energy")
+	methodBindGIProbeDataGetNormalBias   = gdnative.NewLazyMethodBind("GIProbeData", "get_normal_bias")
+	methodBindGIProbeDataGetPropagation  = gdnative.NewLazyMethodBind("GIProbeData", "get_propagation")
+	methodBindGIProbeDataGetToCellXform  = gdnative.NewLazyMethodBind("GIProbeData", "get_to_cell_xform")
+	methodBindGIProbeDataIsCompressed    = gdnative.NewLazyMethodBind("GIProbeData", "is_compressed")
+	methodBindGIProbeDataIsInterior      = gdnative.NewLazyMethodBind("GIProbeData", "is_interior")
+	methodBindGIProbeDataSetBias         = gdnative.NewLazyMethodBind("GIProbeData", "set_bias")
+	methodBindGIProbeDataSetBounds       = gdnative.NewLazyMethodBind("GIProbeData", "set_bounds")
+	methodBindGIProbeDataSetCellSize     = gdnative.NewLazyMethodBind("GIProbeData", "set_cell_size")
+	methodBindGIProbeDataSetCompress     = gdnative.NewLazyMethodBind("GIProbeData", "set_compress")
+	methodBindGIProbeDataSetDynamicData  = gdnative.NewLazyMethodBind("GIProbeData", "set_dynamic_data")
+	methodBindGIProbeDataSetDynamicRange = gdnative.NewLazyMethodBind("GIProbeData", "set_dynamic_range")
+	methodBindGIProbeDataSetEnergy       = gdnative.NewLazyMethodBind("GIProbeData", "set_energy")
+	methodBindGIProbeDataSetInterior     = gdnative.NewLazyMethodBind("GIProbeData", "set_interior")
+	methodBindGIProbeDataSetNormalBias   = gdnative.NewLazyMethodBind("GIProbeData", "set_normal_bias")
+	methodBindGIProbeDataSetPropagation  = gdnative.NewLazyMethodBind("GIProbeData", "set_propagation")
+	methodBindGIProbeDataSetToCellXform  = gdnative.NewLazyMethodBind("GIProbeData", "set_to_cell_xform")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbeData) GetBias() gdnative.Real {
 	//log.Println("Calling GIProbeData.GetBias()")
@@ -45,7 +79,7 @@ func (o *GIProbeData) GetBias() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_bias")
+	methodBind := methodBindGIProbeDataGetBias.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +92,8 @@ func (o *GIProbeData) GetBias() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: AABB
+	        Undocumented
+		Args: [], Returns: AABB
 */
 func (o *GIProbeData) GetBounds() gdnative.Aabb {
 	//log.Println("Calling GIProbeData.GetBounds()")
@@ -68,7 +102,7 @@ func (o *GIProbeData) GetBounds() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_bounds")
+	methodBind := methodBindGIProbeDataGetBounds.Get()
 
 	// Call the parent method.
 	// AABB
@@ -81,8 +115,8 @@ func (o *GIProbeData) GetBounds() gdnative.Aabb {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbeData) GetCellSize() gdnative.Real {
 	//log.Println("Calling GIProbeData.GetCellSize()")
@@ -91,7 +125,7 @@ func (o *GIProbeData) GetCellSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_cell_size")
+	methodBind := methodBindGIProbeDataGetCellSize.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +138,8 @@ func (o *GIProbeData) GetCellSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolIntArray
+	        Undocumented
+		Args: [], Returns: PoolIntArray
 */
 func (o *GIProbeData) GetDynamicData() gdnative.PoolIntArray {
 	//log.Println("Calling GIProbeData.GetDynamicData()")
@@ -114,7 +148,7 @@ func (o *GIProbeData) GetDynamicData() gdnative.PoolIntArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_dynamic_data")
+	methodBind := methodBindGIProbeDataGetDynamicData.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -127,8 +161,8 @@ func (o *GIProbeData) GetDynamicData() gdnative.PoolIntArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *GIProbeData) GetDynamicRange() gdnative.Int {
 	//log.Println("Calling GIProbeData.GetDynamicRange()")
@@ -137,7 +171,7 @@ func (o *GIProbeData) GetDynamicRange() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_dynamic_range")
+	methodBind := methodBindGIProbeDataGetDynamicRange.Get()
 
 	// Call the parent method.
 	// int
@@ -150,8 +184,8 @@ func (o *GIProbeData) GetDynamicRange() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbeData) GetEnergy() gdnative.Real {
 	//log.Println("Calling GIProbeData.GetEnergy()")
@@ -160,7 +194,7 @@ func (o *GIProbeData) GetEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_energy")
+	methodBind := methodBindGIProbeDataGetEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -173,8 +207,8 @@ func (o *GIProbeData) GetEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbeData) GetNormalBias() gdnative.Real {
 	//log.Println("Calling GIProbeData.GetNormalBias()")
@@ -183,7 +217,7 @@ func (o *GIProbeData) GetNormalBias() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_normal_bias")
+	methodBind := methodBindGIProbeDataGetNormalBias.Get()
 
 	// Call the parent method.
 	// float
@@ -196,8 +230,8 @@ func (o *GIProbeData) GetNormalBias() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbeData) GetPropagation() gdnative.Real {
 	//log.Println("Calling GIProbeData.GetPropagation()")
@@ -206,7 +240,7 @@ func (o *GIProbeData) GetPropagation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_propagation")
+	methodBind := methodBindGIProbeDataGetPropagation.Get()
 
 	// Call the parent method.
 	// float
@@ -219,8 +253,8 @@ func (o *GIProbeData) GetPropagation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform
+	        Undocumented
+		Args: [], Returns: Transform
 */
 func (o *GIProbeData) GetToCellXform() gdnative.Transform {
 	//log.Println("Calling GIProbeData.GetToCellXform()")
@@ -229,7 +263,7 @@ func (o *GIProbeData) GetToCellXform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "get_to_cell_xform")
+	methodBind := methodBindGIProbeDataGetToCellXform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -242,8 +276,8 @@ func (o *GIProbeData) GetToCellXform() gdnative.Transform {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GIProbeData) IsCompressed() gdnative.Bool {
 	//log.Println("Calling GIProbeData.IsCompressed()")
@@ -252,7 +286,7 @@ func (o *GIProbeData) IsCompressed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "is_compressed")
+	methodBind := methodBindGIProbeDataIsCompressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -265,8 +299,8 @@ func (o *GIProbeData) IsCompressed() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GIProbeData) IsInterior() gdnative.Bool {
 	//log.Println("Calling GIProbeData.IsInterior()")
@@ -275,7 +309,7 @@ func (o *GIProbeData) IsInterior() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "is_interior")
+	methodBind := methodBindGIProbeDataIsInterior.Get()
 
 	// Call the parent method.
 	// bool
@@ -288,8 +322,8 @@ func (o *GIProbeData) IsInterior() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false bias float}], Returns: void
+	        Undocumented
+		Args: [{ false bias float}], Returns: void
 */
 func (o *GIProbeData) SetBias(bias gdnative.Real) {
 	//log.Println("Calling GIProbeData.SetBias()")
@@ -299,7 +333,7 @@ func (o *GIProbeData) SetBias(bias gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(bias)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_bias")
+	methodBind := methodBindGIProbeDataSetBias.Get()
 
 	// Call the parent method.
 	// void
@@ -309,8 +343,8 @@ func (o *GIProbeData) SetBias(bias gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bounds AABB}], Returns: void
+	        Undocumented
+		Args: [{ false bounds AABB}], Returns: void
 */
 func (o *GIProbeData) SetBounds(bounds gdnative.Aabb) {
 	//log.Println("Calling GIProbeData.SetBounds()")
@@ -320,7 +354,7 @@ func (o *GIProbeData) SetBounds(bounds gdnative.Aabb) {
 	ptrArguments[0] = gdnative.NewPointerFromAabb(bounds)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_bounds")
+	methodBind := methodBindGIProbeDataSetBounds.Get()
 
 	// Call the parent method.
 	// void
@@ -330,8 +364,8 @@ func (o *GIProbeData) SetBounds(bounds gdnative.Aabb) {
 }
 
 /*
-        Undocumented
-	Args: [{ false cell_size float}], Returns: void
+	        Undocumented
+		Args: [{ false cell_size float}], Returns: void
 */
 func (o *GIProbeData) SetCellSize(cellSize gdnative.Real) {
 	//log.Println("Calling GIProbeData.SetCellSize()")
@@ -341,7 +375,7 @@ func (o *GIProbeData) SetCellSize(cellSize gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(cellSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_cell_size")
+	methodBind := methodBindGIProbeDataSetCellSize.Get()
 
 	// Call the parent method.
 	// void
@@ -351,8 +385,8 @@ func (o *GIProbeData) SetCellSize(cellSize gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false compress bool}], Returns: void
+	        Undocumented
+		Args: [{ false compress bool}], Returns: void
 */
 func (o *GIProbeData) SetCompress(compress gdnative.Bool) {
 	//log.Println("Calling GIProbeData.SetCompress()")
@@ -362,7 +396,7 @@ func (o *GIProbeData) SetCompress(compress gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(compress)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_compress")
+	methodBind := methodBindGIProbeDataSetCompress.Get()
 
 	// Call the parent method.
 	// void
@@ -372,8 +406,8 @@ func (o *GIProbeData) SetCompress(compress gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false dynamic_data PoolIntArray}], Returns: void
+	        Undocumented
+		Args: [{ false dynamic_data PoolIntArray}], Returns: void
 */
 func (o *GIProbeData) SetDynamicData(dynamicData gdnative.PoolIntArray) {
 	//log.Println("Calling GIProbeData.SetDynamicData()")
@@ -383,7 +417,7 @@ func (o *GIProbeData) SetDynamicData(dynamicData gdnative.PoolIntArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolIntArray(dynamicData)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_dynamic_data")
+	methodBind := methodBindGIProbeDataSetDynamicData.Get()
 
 	// Call the parent method.
 	// void
@@ -393,8 +427,8 @@ func (o *GIProbeData) SetDynamicData(dynamicData gdnative.PoolIntArray) {
 }
 
 /*
-        Undocumented
-	Args: [{ false dynamic_range int}], Returns: void
+	        Undocumented
+		Args: [{ false dynamic_range int}], Returns: void
 */
 func (o *GIProbeData) SetDynamicRange(dynamicRange gdnative.Int) {
 	//log.Println("Calling GIProbeData.SetDynamicRange()")
@@ -404,7 +438,7 @@ func (o *GIProbeData) SetDynamicRange(dynamicRange gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(dynamicRange)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_dynamic_range")
+	methodBind := methodBindGIProbeDataSetDynamicRange.Get()
 
 	// Call the parent method.
 	// void
@@ -414,8 +448,8 @@ func (o *GIProbeData) SetDynamicRange(dynamicRange gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *GIProbeData) SetEnergy(energy gdnative.Real) {
 	//log.Println("Calling GIProbeData.SetEnergy()")
@@ -425,7 +459,7 @@ func (o *GIProbeData) SetEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_energy")
+	methodBind := methodBindGIProbeDataSetEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -435,8 +469,8 @@ func (o *GIProbeData) SetEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false interior bool}], Returns: void
+	        Undocumented
+		Args: [{ false interior bool}], Returns: void
 */
 func (o *GIProbeData) SetInterior(interior gdnative.Bool) {
 	//log.Println("Calling GIProbeData.SetInterior()")
@@ -446,7 +480,7 @@ func (o *GIProbeData) SetInterior(interior gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(interior)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_interior")
+	methodBind := methodBindGIProbeDataSetInterior.Get()
 
 	// Call the parent method.
 	// void
@@ -456,8 +490,8 @@ func (o *GIProbeData) SetInterior(interior gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bias float}], Returns: void
+	        Undocumented
+		Args: [{ false bias float}], Returns: void
 */
 func (o *GIProbeData) SetNormalBias(bias gdnative.Real) {
 	//log.Println("Calling GIProbeData.SetNormalBias()")
@@ -467,7 +501,7 @@ func (o *GIProbeData) SetNormalBias(bias gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(bias)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_normal_bias")
+	methodBind := methodBindGIProbeDataSetNormalBias.Get()
 
 	// Call the parent method.
 	// void
@@ -477,8 +511,8 @@ func (o *GIProbeData) SetNormalBias(bias gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false propagation float}], Returns: void
+	        Undocumented
+		Args: [{ false propagation float}], Returns: void
 */
 func (o *GIProbeData) SetPropagation(propagation gdnative.Real) {
 	//log.Println("Calling GIProbeData.SetPropagation()")
@@ -488,7 +522,7 @@ func (o *GIProbeData) SetPropagation(propagation gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(propagation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_propagation")
+	methodBind := methodBindGIProbeDataSetPropagation.Get()
 
 	// Call the parent method.
 	// void
@@ -498,8 +532,8 @@ func (o *GIProbeData) SetPropagation(propagation gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false to_cell_xform Transform}], Returns: void
+	        Undocumented
+		Args: [{ false to_cell_xform Transform}], Returns: void
 */
 func (o *GIProbeData) SetToCellXform(toCellXform gdnative.Transform) {
 	//log.Println("Calling GIProbeData.SetToCellXform()")
@@ -509,7 +543,7 @@ func (o *GIProbeData) SetToCellXform(toCellXform gdnative.Transform) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform(toCellXform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbeData", "set_to_cell_xform")
+	methodBind := methodBindGIProbeDataSetToCellXform.Get()
 
 	// Call the parent method.
 	// void
@@ -518,6 +552,61 @@ func (o *GIProbeData) SetToCellXform(toCellXform gdnative.Transform) {
 
 }
 
+// Bias will return the value of the "bias" property.
+func (o *GIProbeData) Bias() gdnative.Real {
+	return o.GetBias()
+}
+
+// Bounds will return the value of the "bounds" property.
+func (o *GIProbeData) Bounds() gdnative.Aabb {
+	return o.GetBounds()
+}
+
+// CellSize will return the value of the "cell_size" property.
+func (o *GIProbeData) CellSize() gdnative.Real {
+	return o.GetCellSize()
+}
+
+// Compress will return the value of the "compress" property.
+func (o *GIProbeData) Compress() gdnative.Bool {
+	return o.IsCompressed()
+}
+
+// DynamicData will return the value of the "dynamic_data" property.
+func (o *GIProbeData) DynamicData() gdnative.PoolIntArray {
+	return o.GetDynamicData()
+}
+
+// DynamicRange will return the value of the "dynamic_range" property.
+func (o *GIProbeData) DynamicRange() gdnative.Int {
+	return o.GetDynamicRange()
+}
+
+// Energy will return the value of the "energy" property.
+func (o *GIProbeData) Energy() gdnative.Real {
+	return o.GetEnergy()
+}
+
+// Interior will return the value of the "interior" property.
+func (o *GIProbeData) Interior() gdnative.Bool {
+	return o.IsInterior()
+}
+
+// NormalBias will return the value of the "normal_bias" property.
+func (o *GIProbeData) NormalBias() gdnative.Real {
+	return o.GetNormalBias()
+}
+
+// Propagation will return the value of the "propagation" property.
+func (o *GIProbeData) Propagation() gdnative.Real {
+	return o.GetPropagation()
+}
+
+// ToCellXform will return the value of the "to_cell_xform" property.
+func (o *GIProbeData) ToCellXform() gdnative.Transform {
+	return o.GetToCellXform()
+}
+
 // GIProbeDataImplementer is an interface that implements the methods
 // of the GIProbeData class.
 type GIProbeDataImplementer interface {
@@ -544,4 +633,15 @@ type GIProbeDataImplementer interface {
 	SetNormalBias(bias gdnative.Real)
 	SetPropagation(propagation gdnative.Real)
 	SetToCellXform(toCellXform gdnative.Transform)
+	Bias() gdnative.Real
+	Bounds() gdnative.Aabb
+	CellSize() gdnative.Real
+	Compress() gdnative.Bool
+	DynamicData() gdnative.PoolIntArray
+	DynamicRange() gdnative.Int
+	Energy() gdnative.Real
+	Interior() gdnative.Bool
+	NormalBias() gdnative.Real
+	Propagation() gdnative.Real
+	ToCellXform() gdnative.Transform
 }
@@ -0,0 +1,2489 @@
+package godot
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "globalconstants.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// Margin is an enum for the global Margin values.
+type Margin int
+
+// Margin values from the global constants.
+const (
+	MarginBottom Margin = 3
+	MarginLeft   Margin = 0
+	MarginRight  Margin = 2
+	MarginTop    Margin = 1
+)
+
+// String will return the Godot name of the Margin value.
+func (e Margin) String() string {
+	switch e {
+	case MarginBottom:
+		return "MARGIN_BOTTOM"
+	case MarginLeft:
+		return "MARGIN_LEFT"
+	case MarginRight:
+		return "MARGIN_RIGHT"
+	case MarginTop:
+		return "MARGIN_TOP"
+	}
+	return fmt.Sprintf("Margin(%d)", int(e))
+}
+
+// ParseMargin will return the Margin value with the given Godot name.
+func ParseMargin(name string) (Margin, error) {
+	switch name {
+	case "MARGIN_BOTTOM":
+		return MarginBottom, nil
+	case "MARGIN_LEFT":
+		return MarginLeft, nil
+	case "MARGIN_RIGHT":
+		return MarginRight, nil
+	case "MARGIN_TOP":
+		return MarginTop, nil
+	}
+	return 0, fmt.Errorf("invalid Margin value %q", name)
+}
+
+// Corner is an enum for the global Corner values.
+type Corner int
+
+// Corner values from the global constants.
+const (
+	CornerBottomLeft  Corner = 3
+	CornerBottomRight Corner = 2
+	CornerTopLeft     Corner = 0
+	CornerTopRight    Corner = 1
+)
+
+// String will return the Godot name of the Corner value.
+func (e Corner) String() string {
+	switch e {
+	case CornerBottomLeft:
+		return "CORNER_BOTTOM_LEFT"
+	case CornerBottomRight:
+		return "CORNER_BOTTOM_RIGHT"
+	case CornerTopLeft:
+		return "CORNER_TOP_LEFT"
+	case CornerTopRight:
+		return "CORNER_TOP_RIGHT"
+	}
+	return fmt.Sprintf("Corner(%d)", int(e))
+}
+
+// ParseCorner will return the Corner value with the given Godot name.
+func ParseCorner(name string) (Corner, error) {
+	switch name {
+	case "CORNER_BOTTOM_LEFT":
+		return CornerBottomLeft, nil
+	case "CORNER_BOTTOM_RIGHT":
+		return CornerBottomRight, nil
+	case "CORNER_TOP_LEFT":
+		return CornerTopLeft, nil
+	case "CORNER_TOP_RIGHT":
+		return CornerTopRight, nil
+	}
+	return 0, fmt.Errorf("invalid Corner value %q", name)
+}
+
+// Orientation is an enum for the global Orientation values.
+type Orientation int
+
+// Orientation values from the global constants.
+const (
+	Horizontal Orientation = 0
+	Vertical   Orientation = 1
+)
+
+// String will return the Godot name of the Orientation value.
+func (e Orientation) String() string {
+	switch e {
+	case Horizontal:
+		return "HORIZONTAL"
+	case Vertical:
+		return "VERTICAL"
+	}
+	return fmt.Sprintf("Orientation(%d)", int(e))
+}
+
+// ParseOrientation will return the Orientation value with the given Godot name.
+func ParseOrientation(name string) (Orientation, error) {
+	switch name {
+	case "HORIZONTAL":
+		return Horizontal, nil
+	case "VERTICAL":
+		return Vertical, nil
+	}
+	return 0, fmt.Errorf("invalid Orientation value %q", name)
+}
+
+// HAlign is an enum for the global HAlign values.
+type HAlign int
+
+// HAlign values from the global constants.
+const (
+	HalignCenter HAlign = 1
+	HalignLeft   HAlign = 0
+	HalignRight  HAlign = 2
+)
+
+// String will return the Godot name of the HAlign value.
+func (e HAlign) String() string {
+	switch e {
+	case HalignCenter:
+		return "HALIGN_CENTER"
+	case HalignLeft:
+		return "HALIGN_LEFT"
+	case HalignRight:
+		return "HALIGN_RIGHT"
+	}
+	return fmt.Sprintf("HAlign(%d)", int(e))
+}
+
+// ParseHAlign will return the HAlign value with the given Godot name.
+func ParseHAlign(name string) (HAlign, error) {
+	switch name {
+	case "HALIGN_CENTER":
+		return HalignCenter, nil
+	case "HALIGN_LEFT":
+		return HalignLeft, nil
+	case "HALIGN_RIGHT":
+		return HalignRight, nil
+	}
+	return 0, fmt.Errorf("invalid HAlign value %q", name)
+}
+
+// VAlign is an enum for the global VAlign values.
+type VAlign int
+
+// VAlign values from the global constants.
+const (
+	ValignBottom VAlign = 2
+	ValignCenter VAlign = 1
+	ValignTop    VAlign = 0
+)
+
+// String will return the Godot name of the VAlign value.
+func (e VAlign) String() string {
+	switch e {
+	case ValignBottom:
+		return "VALIGN_BOTTOM"
+	case ValignCenter:
+		return "VALIGN_CENTER"
+	case ValignTop:
+		return "VALIGN_TOP"
+	}
+	return fmt.Sprintf("VAlign(%d)", int(e))
+}
+
+// ParseVAlign will return the VAlign value with the given Godot name.
+func ParseVAlign(name string) (VAlign, error) {
+	switch name {
+	case "VALIGN_BOTTOM":
+		return ValignBottom, nil
+	case "VALIGN_CENTER":
+		return ValignCenter, nil
+	case "VALIGN_TOP":
+		return ValignTop, nil
+	}
+	return 0, fmt.Errorf("invalid VAlign value %q", name)
+}
+
+// KeyModifierMask is an enum for the global KeyModifierMask values.
+type KeyModifierMask int
+
+// KeyModifierMask values from the global constants.
+const (
+	KeyCodeMask          KeyModifierMask = 33554431
+	KeyMaskAlt           KeyModifierMask = 67108864
+	KeyMaskCmd           KeyModifierMask = 268435456
+	KeyMaskCtrl          KeyModifierMask = 268435456
+	KeyMaskGroupSwitch   KeyModifierMask = 1073741824
+	KeyMaskKpad          KeyModifierMask = 536870912
+	KeyMaskMeta          KeyModifierMask = 134217728
+	KeyMaskShift         KeyModifierMask = 33554432
+	KeyModifierMaskValue KeyModifierMask = -16777216
+)
+
+// String will return the Godot name of the KeyModifierMask value.
+func (e KeyModifierMask) String() string {
+	switch e {
+	case KeyCodeMask:
+		return "KEY_CODE_MASK"
+	case KeyMaskAlt:
+		return "KEY_MASK_ALT"
+	case KeyMaskCmd:
+		return "KEY_MASK_CMD"
+	case KeyMaskGroupSwitch:
+		return "KEY_MASK_GROUP_SWITCH"
+	case KeyMaskKpad:
+		return "KEY_MASK_KPAD"
+	case KeyMaskMeta:
+		return "KEY_MASK_META"
+	case KeyMaskShift:
+		return "KEY_MASK_SHIFT"
+	case KeyModifierMaskValue:
+		return "KEY_MODIFIER_MASK"
+	}
+	return fmt.Sprintf("KeyModifierMask(%d)", int(e))
+}
+
+// ParseKeyModifierMask will return the KeyModifierMask value with the given Godot name.
+func ParseKeyModifierMask(name string) (KeyModifierMask, error) {
+	switch name {
+	case "KEY_CODE_MASK":
+		return KeyCodeMask, nil
+	case "KEY_MASK_ALT":
+		return KeyMaskAlt, nil
+	case "KEY_MASK_CMD":
+		return KeyMaskCmd, nil
+	case "KEY_MASK_CTRL":
+		return KeyMaskCtrl, nil
+	case "KEY_MASK_GROUP_SWITCH":
+		return KeyMaskGroupSwitch, nil
+	case "KEY_MASK_KPAD":
+		return KeyMaskKpad, nil
+	case "KEY_MASK_META":
+		return KeyMaskMeta, nil
+	case "KEY_MASK_SHIFT":
+		return KeyMaskShift, nil
+	case "KEY_MODIFIER_MASK":
+		return KeyModifierMaskValue, nil
+	}
+	return 0, fmt.Errorf("invalid KeyModifierMask value %q", name)
+}
+
+// KeyList is an enum for the global KeyList values.
+type KeyList int
+
+// KeyList values from the global constants.
+const (
+	Key0              KeyList = 48
+	Key1              KeyList = 49
+	Key2              KeyList = 50
+	Key3              KeyList = 51
+	Key4              KeyList = 52
+	Key5              KeyList = 53
+	Key6              KeyList = 54
+	Key7              KeyList = 55
+	Key8              KeyList = 56
+	Key9              KeyList = 57
+	KeyA              KeyList = 65
+	KeyAacute         KeyList = 193
+	KeyAcircumflex    KeyList = 194
+	KeyAcute          KeyList = 180
+	KeyAdiaeresis     KeyList = 196
+	KeyAe             KeyList = 198
+	KeyAgrave         KeyList = 192
+	KeyAlt            KeyList = 16777240
+	KeyAmpersand      KeyList = 38
+	KeyApostrophe     KeyList = 39
+	KeyAring          KeyList = 197
+	KeyAsciicircum    KeyList = 94
+	KeyAsciitilde     KeyList = 126
+	KeyAsterisk       KeyList = 42
+	KeyAt             KeyList = 64
+	KeyAtilde         KeyList = 195
+	KeyB              KeyList = 66
+	KeyBack           KeyList = 16777280
+	KeyBackslash      KeyList = 92
+	KeyBackspace      KeyList = 16777220
+	KeyBacktab        KeyList = 16777219
+	KeyBar            KeyList = 124
+	KeyBassboost      KeyList = 16777287
+	KeyBassdown       KeyList = 16777289
+	KeyBassup         KeyList = 16777288
+	KeyBraceleft      KeyList = 123
+	KeyBraceright     KeyList = 125
+	KeyBracketleft    KeyList = 91
+	KeyBracketright   KeyList = 93
+	KeyBrokenbar      KeyList = 166
+	KeyC              KeyList = 67
+	KeyCapslock       KeyList = 16777241
+	KeyCcedilla       KeyList = 199
+	KeyCedilla        KeyList = 184
+	KeyCent           KeyList = 162
+	KeyClear          KeyList = 16777228
+	KeyColon          KeyList = 58
+	KeyComma          KeyList = 44
+	KeyControl        KeyList = 16777238
+	KeyCopyright      KeyList = 169
+	KeyCurrency       KeyList = 164
+	KeyD              KeyList = 68
+	KeyDegree         KeyList = 176
+	KeyDelete         KeyList = 16777224
+	KeyDiaeresis      KeyList = 168
+	KeyDirectionL     KeyList = 16777266
+	KeyDirectionR     KeyList = 16777267
+	KeyDivision       KeyList = 247
+	KeyDollar         KeyList = 36
+	KeyDown           KeyList = 16777234
+	KeyE              KeyList = 69
+	KeyEacute         KeyList = 201
+	KeyEcircumflex    KeyList = 202
+	KeyEdiaeresis     KeyList = 203
+	KeyEgrave         KeyList = 200
+	KeyEnd            KeyList = 16777230
+	KeyEnter          KeyList = 16777221
+	KeyEqual          KeyList = 61
+	KeyEscape         KeyList = 16777217
+	KeyEth            KeyList = 208
+	KeyExclam         KeyList = 33
+	KeyExclamdown     KeyList = 161
+	KeyF              KeyList = 70
+	KeyF1             KeyList = 16777244
+	KeyF10            KeyList = 16777253
+	KeyF11            KeyList = 16777254
+	KeyF12            KeyList = 16777255
+	KeyF13            KeyList = 16777256
+	KeyF14            KeyList = 16777257
+	KeyF15            KeyList = 16777258
+	KeyF16            KeyList = 16777259
+	KeyF2             KeyList = 16777245
+	KeyF3             KeyList = 16777246
+	KeyF4             KeyList = 16777247
+	KeyF5             KeyList = 16777248
+	KeyF6             KeyList = 16777249
+	KeyF7             KeyList = 16777250
+	KeyF8             KeyList = 16777251
+	KeyF9             KeyList = 16777252
+	KeyFavorites      KeyList = 16777298
+	KeyForward        KeyList = 16777281
+	KeyG              KeyList = 71
+	KeyGreater        KeyList = 62
+	KeyGuillemotleft  KeyList = 171
+	KeyGuillemotright KeyList = 187
+	KeyH              KeyList = 72
+	KeyHelp           KeyList = 16777265
+	KeyHome           KeyList = 16777229
+	KeyHomepage       KeyList = 16777297
+	KeyHyperL         KeyList = 16777263
+	KeyHyperR         KeyList = 16777264
+	KeyHyphen         KeyList = 173
+	KeyI              KeyList = 73
+	KeyIacute         KeyList = 205
+	KeyIcircumflex    KeyList = 206
+	KeyIdiaeresis     KeyList = 207
+	KeyIgrave         KeyList = 204
+	KeyInsert         KeyList = 16777223
+	KeyJ              KeyList = 74
+	KeyK              KeyList = 75
+	KeyKp0            KeyList = 16777350
+	KeyKp1            KeyList = 16777351
+	KeyKp2            KeyList = 16777352
+	KeyKp3            KeyList = 16777353
+	KeyKp4            KeyList = 16777354
+	KeyKp5            KeyList = 16777355
+	KeyKp6            KeyList = 16777356
+	KeyKp7            KeyList = 16777357
+	KeyKp8            KeyList = 16777358
+	KeyKp9            KeyList = 16777359
+	KeyKpAdd          KeyList = 16777349
+	KeyKpDivide       KeyList = 16777346
+	KeyKpEnter        KeyList = 16777222
+	KeyKpMultiply     KeyList = 16777345
+	KeyKpPeriod       KeyList = 16777348
+	KeyKpSubtract     KeyList = 16777347
+	KeyL              KeyList = 76
+	KeyLaunch0        KeyList = 16777304
+	KeyLaunch1        KeyList = 16777305
+	KeyLaunch2        KeyList = 16777306
+	KeyLaunch3        KeyList = 16777307
+	KeyLaunch4        KeyList = 16777308
+	KeyLaunch5        KeyList = 16777309
+	KeyLaunch6        KeyList = 16777310
+	KeyLaunch7        KeyList = 16777311
+	KeyLaunch8        KeyList = 16777312
+	KeyLaunch9        KeyList = 16777313
+	KeyLauncha        KeyList = 16777314
+	KeyLaunchb        KeyList = 16777315
+	KeyLaunchc        KeyList = 16777316
+	KeyLaunchd        KeyList = 16777317
+	KeyLaunche        KeyList = 16777318
+	KeyLaunchf        KeyList = 16777319
+	KeyLaunchmail     KeyList = 16777302
+	KeyLaunchmedia    KeyList = 16777303
+	KeyLeft           KeyList = 16777231
+	KeyLess           KeyList = 60
+	KeyM              KeyList = 77
+	KeyMacron         KeyList = 175
+	KeyMasculine      KeyList = 186
+	KeyMedianext      KeyList = 16777295
+	KeyMediaplay      KeyList = 16777292
+	KeyMediaprevious  KeyList = 16777294
+	KeyMediarecord    KeyList = 16777296
+	KeyMediastop      KeyList = 16777293
+	KeyMenu           KeyList = 16777262
+	KeyMeta           KeyList = 16777239
+	KeyMinus          KeyList = 45
+	KeyMu             KeyList = 181
+	KeyMultiply       KeyList = 215
+	KeyN              KeyList = 78
+	KeyNobreakspace   KeyList = 160
+	KeyNotsign        KeyList = 172
+	KeyNtilde         KeyList = 209
+	KeyNumbersign     KeyList = 35
+	KeyNumlock        KeyList = 16777242
+	KeyO              KeyList = 79
+	KeyOacute         KeyList = 211
+	KeyOcircumflex    KeyList = 212
+	KeyOdiaeresis     KeyList = 214
+	KeyOgrave         KeyList = 210
+	KeyOnehalf        KeyList = 189
+	KeyOnequarter     KeyList = 188
+	KeyOnesuperior    KeyList = 185
+	KeyOoblique       KeyList = 216
+	KeyOpenurl        KeyList = 16777301
+	KeyOrdfeminine    KeyList = 170
+	KeyOtilde         KeyList = 213
+	KeyP              KeyList = 80
+	KeyPagedown       KeyList = 16777236
+	KeyPageup         KeyList = 16777235
+	KeyParagraph      KeyList = 182
+	KeyParenleft      KeyList = 40
+	KeyParenright     KeyList = 41
+	KeyPause          KeyList = 16777225
+	KeyPercent        KeyList = 37
+	KeyPeriod         KeyList = 46
+	KeyPeriodcentered KeyList = 183
+	KeyPlus           KeyList = 43
+	KeyPlusminus      KeyList = 177
+	KeyPrint          KeyList = 16777226
+	KeyQ              KeyList = 81
+	KeyQuestion       KeyList = 63
+	KeyQuestiondown   KeyList = 191
+	KeyQuotedbl       KeyList = 34
+	KeyQuoteleft      KeyList = 96
+	KeyR              KeyList = 82
+	KeyRefresh        KeyList = 16777283
+	KeyRegistered     KeyList = 174
+	KeyRight          KeyList = 16777233
+	KeyS              KeyList = 83
+	KeyScrolllock     KeyList = 16777243
+	KeySearch         KeyList = 16777299
+	KeySection        KeyList = 167
+	KeySemicolon      KeyList = 59
+	KeyShift          KeyList = 16777237
+	KeySlash          KeyList = 47
+	KeySpace          KeyList = 32
+	KeySsharp         KeyList = 223
+	KeyStandby        KeyList = 16777300
+	KeySterling       KeyList = 163
+	KeyStop           KeyList = 16777282
+	KeySuperL         KeyList = 16777260
+	KeySuperR         KeyList = 16777261
+	KeySysreq         KeyList = 16777227
+	KeyT              KeyList = 84
+	KeyTab            KeyList = 16777218
+	KeyThorn          KeyList = 222
+	KeyThreequarters  KeyList = 190
+	KeyThreesuperior  KeyList = 179
+	KeyTrebledown     KeyList = 16777291
+	KeyTrebleup       KeyList = 16777290
+	KeyTwosuperior    KeyList = 178
+	KeyU              KeyList = 85
+	KeyUacute         KeyList = 218
+	KeyUcircumflex    KeyList = 219
+	KeyUdiaeresis     KeyList = 220
+	KeyUgrave         KeyList = 217
+	KeyUnderscore     KeyList = 95
+	KeyUnknown        KeyList = 33554431
+	KeyUp             KeyList = 16777232
+	KeyV              KeyList = 86
+	KeyVolumedown     KeyList = 16777284
+	KeyVolumemute     KeyList = 16777285
+	KeyVolumeup       KeyList = 16777286
+	KeyW              KeyList = 87
+	KeyX              KeyList = 88
+	KeyY              KeyList = 89
+	KeyYacute         KeyList = 221
+	KeyYdiaeresis     KeyList = 255
+	KeyYen            KeyList = 165
+	KeyZ              KeyList = 90
+)
+
+// String will return the Godot name of the KeyList value.
+func (e KeyList) String() string {
+	switch e {
+	case Key0:
+		return "KEY_0"
+	case Key1:
+		return "KEY_1"
+	case Key2:
+		return "KEY_2"
+	case Key3:
+		return "KEY_3"
+	case Key4:
+		return "KEY_4"
+	case Key5:
+		return "KEY_5"
+	case Key6:
+		return "KEY_6"
+	case Key7:
+		return "KEY_7"
+	case Key8:
+		return "KEY_8"
+	case Key9:
+		return "KEY_9"
+	case KeyA:
+		return "KEY_A"
+	case KeyAacute:
+		return "KEY_AACUTE"
+	case KeyAcircumflex:
+		return "KEY_ACIRCUMFLEX"
+	case KeyAcute:
+		return "KEY_ACUTE"
+	case KeyAdiaeresis:
+		return "KEY_ADIAERESIS"
+	case KeyAe:
+		return "KEY_AE"
+	case KeyAgrave:
+		return "KEY_AGRAVE"
+	case KeyAlt:
+		return "KEY_ALT"
+	case KeyAmpersand:
+		return "KEY_AMPERSAND"
+	case KeyApostrophe:
+		return "KEY_APOSTROPHE"
+	case KeyAring:
+		return "KEY_ARING"
+	case KeyAsciicircum:
+		return "KEY_ASCIICIRCUM"
+	case KeyAsciitilde:
+		return "KEY_ASCIITILDE"
+	case KeyAsterisk:
+		return "KEY_ASTERISK"
+	case KeyAt:
+		return "KEY_AT"
+	case KeyAtilde:
+		return "KEY_ATILDE"
+	case KeyB:
+		return "KEY_B"
+	case KeyBack:
+		return "KEY_BACK"
+	case KeyBackslash:
+		return "KEY_BACKSLASH"
+	case KeyBackspace:
+		return "KEY_BACKSPACE"
+	case KeyBacktab:
+		return "KEY_BACKTAB"
+	case KeyBar:
+		return "KEY_BAR"
+	case KeyBassboost:
+		return "KEY_BASSBOOST"
+	case KeyBassdown:
+		return "KEY_BASSDOWN"
+	case KeyBassup:
+		return "KEY_BASSUP"
+	case KeyBraceleft:
+		return "KEY_BRACELEFT"
+	case KeyBraceright:
+		return "KEY_BRACERIGHT"
+	case KeyBracketleft:
+		return "KEY_BRACKETLEFT"
+	case KeyBracketright:
+		return "KEY_BRACKETRIGHT"
+	case KeyBrokenbar:
+		return "KEY_BROKENBAR"
+	case KeyC:
+		return "KEY_C"
+	case KeyCapslock:
+		return "KEY_CAPSLOCK"
+	case KeyCcedilla:
+		return "KEY_CCEDILLA"
+	case KeyCedilla:
+		return "KEY_CEDILLA"
+	case KeyCent:
+		return "KEY_CENT"
+	case KeyClear:
+		return "KEY_CLEAR"
+	case KeyColon:
+		return "KEY_COLON"
+	case KeyComma:
+		return "KEY_COMMA"
+	case KeyControl:
+		return "KEY_CONTROL"
+	case KeyCopyright:
+		return "KEY_COPYRIGHT"
+	case KeyCurrency:
+		return "KEY_CURRENCY"
+	case KeyD:
+		return "KEY_D"
+	case KeyDegree:
+		return "KEY_DEGREE"
+	case KeyDelete:
+		return "KEY_DELETE"
+	case KeyDiaeresis:
+		return "KEY_DIAERESIS"
+	case KeyDirectionL:
+		return "KEY_DIRECTION_L"
+	case KeyDirectionR:
+		return "KEY_DIRECTION_R"
+	case KeyDivision:
+		return "KEY_DIVISION"
+	case KeyDollar:
+		return "KEY_DOLLAR"
+	case KeyDown:
+		return "KEY_DOWN"
+	case KeyE:
+		return "KEY_E"
+	case KeyEacute:
+		return "KEY_EACUTE"
+	case KeyEcircumflex:
+		return "KEY_ECIRCUMFLEX"
+	case KeyEdiaeresis:
+		return "KEY_EDIAERESIS"
+	case KeyEgrave:
+		return "KEY_EGRAVE"
+	case KeyEnd:
+		return "KEY_END"
+	case KeyEnter:
+		return "KEY_ENTER"
+	case KeyEqual:
+		return "KEY_EQUAL"
+	case KeyEscape:
+		return "KEY_ESCAPE"
+	case KeyEth:
+		return "KEY_ETH"
+	case KeyExclam:
+		return "KEY_EXCLAM"
+	case KeyExclamdown:
+		return "KEY_EXCLAMDOWN"
+	case KeyF:
+		return "KEY_F"
+	case KeyF1:
+		return "KEY_F1"
+	case KeyF10:
+		return "KEY_F10"
+	case KeyF11:
+		return "KEY_F11"
+	case KeyF12:
+		return "KEY_F12"
+	case KeyF13:
+		return "KEY_F13"
+	case KeyF14:
+		return "KEY_F14"
+	case KeyF15:
+		return "KEY_F15"
+	case KeyF16:
+		return "KEY_F16"
+	case KeyF2:
+		return "KEY_F2"
+	case KeyF3:
+		return "KEY_F3"
+	case KeyF4:
+		return "KEY_F4"
+	case KeyF5:
+		return "KEY_F5"
+	case KeyF6:
+		return "KEY_F6"
+	case KeyF7:
+		return "KEY_F7"
+	case KeyF8:
+		return "KEY_F8"
+	case KeyF9:
+		return "KEY_F9"
+	case KeyFavorites:
+		return "KEY_FAVORITES"
+	case KeyForward:
+		return "KEY_FORWARD"
+	case KeyG:
+		return "KEY_G"
+	case KeyGreater:
+		return "KEY_GREATER"
+	case KeyGuillemotleft:
+		return "KEY_GUILLEMOTLEFT"
+	case KeyGuillemotright:
+		return "KEY_GUILLEMOTRIGHT"
+	case KeyH:
+		return "KEY_H"
+	case KeyHelp:
+		return "KEY_HELP"
+	case KeyHome:
+		return "KEY_HOME"
+	case KeyHomepage:
+		return "KEY_HOMEPAGE"
+	case KeyHyperL:
+		return "KEY_HYPER_L"
+	case KeyHyperR:
+		return "KEY_HYPER_R"
+	case KeyHyphen:
+		return "KEY_HYPHEN"
+	case KeyI:
+		return "KEY_I"
+	case KeyIacute:
+		return "KEY_IACUTE"
+	case KeyIcircumflex:
+		return "KEY_ICIRCUMFLEX"
+	case KeyIdiaeresis:
+		return "KEY_IDIAERESIS"
+	case KeyIgrave:
+		return "KEY_IGRAVE"
+	case KeyInsert:
+		return "KEY_INSERT"
+	case KeyJ:
+		return "KEY_J"
+	case KeyK:
+		return "KEY_K"
+	case KeyKp0:
+		return "KEY_KP_0"
+	case KeyKp1:
+		return "KEY_KP_1"
+	case KeyKp2:
+		return "KEY_KP_2"
+	case KeyKp3:
+		return "KEY_KP_3"
+	case KeyKp4:
+		return "KEY_KP_4"
+	case KeyKp5:
+		return "KEY_KP_5"
+	case KeyKp6:
+		return "KEY_KP_6"
+	case KeyKp7:
+		return "KEY_KP_7"
+	case KeyKp8:
+		return "KEY_KP_8"
+	case KeyKp9:
+		return "KEY_KP_9"
+	case KeyKpAdd:
+		return "KEY_KP_ADD"
+	case KeyKpDivide:
+		return "KEY_KP_DIVIDE"
+	case KeyKpEnter:
+		return "KEY_KP_ENTER"
+	case KeyKpMultiply:
+		return "KEY_KP_MULTIPLY"
+	case KeyKpPeriod:
+		return "KEY_KP_PERIOD"
+	case KeyKpSubtract:
+		return "KEY_KP_SUBTRACT"
+	case KeyL:
+		return "KEY_L"
+	case KeyLaunch0:
+		return "KEY_LAUNCH0"
+	case KeyLaunch1:
+		return "KEY_LAUNCH1"
+	case KeyLaunch2:
+		return "KEY_LAUNCH2"
+	case KeyLaunch3:
+		return "KEY_LAUNCH3"
+	case KeyLaunch4:
+		return "KEY_LAUNCH4"
+	case KeyLaunch5:
+		return "KEY_LAUNCH5"
+	case KeyLaunch6:
+		return "KEY_LAUNCH6"
+	case KeyLaunch7:
+		return "KEY_LAUNCH7"
+	case KeyLaunch8:
+		return "KEY_LAUNCH8"
+	case KeyLaunch9:
+		return "KEY_LAUNCH9"
+	case KeyLauncha:
+		return "KEY_LAUNCHA"
+	case KeyLaunchb:
+		return "KEY_LAUNCHB"
+	case KeyLaunchc:
+		return "KEY_LAUNCHC"
+	case KeyLaunchd:
+		return "KEY_LAUNCHD"
+	case KeyLaunche:
+		return "KEY_LAUNCHE"
+	case KeyLaunchf:
+		return "KEY_LAUNCHF"
+	case KeyLaunchmail:
+		return "KEY_LAUNCHMAIL"
+	case KeyLaunchmedia:
+		return "KEY_LAUNCHMEDIA"
+	case KeyLeft:
+		return "KEY_LEFT"
+	case KeyLess:
+		return "KEY_LESS"
+	case KeyM:
+		return "KEY_M"
+	case KeyMacron:
+		return "KEY_MACRON"
+	case KeyMasculine:
+		return "KEY_MASCULINE"
+	case KeyMedianext:
+		return "KEY_MEDIANEXT"
+	case KeyMediaplay:
+		return "KEY_MEDIAPLAY"
+	case KeyMediaprevious:
+		return "KEY_MEDIAPREVIOUS"
+	case KeyMediarecord:
+		return "KEY_MEDIARECORD"
+	case KeyMediastop:
+		return "KEY_MEDIASTOP"
+	case KeyMenu:
+		return "KEY_MENU"
+	case KeyMeta:
+		return "KEY_META"
+	case KeyMinus:
+		return "KEY_MINUS"
+	case KeyMu:
+		return "KEY_MU"
+	case KeyMultiply:
+		return "KEY_MULTIPLY"
+	case KeyN:
+		return "KEY_N"
+	case KeyNobreakspace:
+		return "KEY_NOBREAKSPACE"
+	case KeyNotsign:
+		return "KEY_NOTSIGN"
+	case KeyNtilde:
+		return "KEY_NTILDE"
+	case KeyNumbersign:
+		return "KEY_NUMBERSIGN"
+	case KeyNumlock:
+		return "KEY_NUMLOCK"
+	case KeyO:
+		return "KEY_O"
+	case KeyOacute:
+		return "KEY_OACUTE"
+	case KeyOcircumflex:
+		return "KEY_OCIRCUMFLEX"
+	case KeyOdiaeresis:
+		return "KEY_ODIAERESIS"
+	case KeyOgrave:
+		return "KEY_OGRAVE"
+	case KeyOnehalf:
+		return "KEY_ONEHALF"
+	case KeyOnequarter:
+		return "KEY_ONEQUARTER"
+	case KeyOnesuperior:
+		return "KEY_ONESUPERIOR"
+	case KeyOoblique:
+		return "KEY_OOBLIQUE"
+	case KeyOpenurl:
+		return "KEY_OPENURL"
+	case KeyOrdfeminine:
+		return "KEY_ORDFEMININE"
+	case KeyOtilde:
+		return "KEY_OTILDE"
+	case KeyP:
+		return "KEY_P"
+	case KeyPagedown:
+		return "KEY_PAGEDOWN"
+	case KeyPageup:
+		return "KEY_PAGEUP"
+	case KeyParagraph:
+		return "KEY_PARAGRAPH"
+	case KeyParenleft:
+		return "KEY_PARENLEFT"
+	case KeyParenright:
+		return "KEY_PARENRIGHT"
+	case KeyPause:
+		return "KEY_PAUSE"
+	case KeyPercent:
+		return "KEY_PERCENT"
+	case KeyPeriod:
+		return "KEY_PERIOD"
+	case KeyPeriodcentered:
+		return "KEY_PERIODCENTERED"
+	case KeyPlus:
+		return "KEY_PLUS"
+	case KeyPlusminus:
+		return "KEY_PLUSMINUS"
+	case KeyPrint:
+		return "KEY_PRINT"
+	case KeyQ:
+		return "KEY_Q"
+	case KeyQuestion:
+		return "KEY_QUESTION"
+	case KeyQuestiondown:
+		return "KEY_QUESTIONDOWN"
+	case KeyQuotedbl:
+		return "KEY_QUOTEDBL"
+	case KeyQuoteleft:
+		return "KEY_QUOTELEFT"
+	case KeyR:
+		return "KEY_R"
+	case KeyRefresh:
+		return "KEY_REFRESH"
+	case KeyRegistered:
+		return "KEY_REGISTERED"
+	case KeyRight:
+		return "KEY_RIGHT"
+	case KeyS:
+		return "KEY_S"
+	case KeyScrolllock:
+		return "KEY_SCROLLLOCK"
+	case KeySearch:
+		return "KEY_SEARCH"
+	case KeySection:
+		return "KEY_SECTION"
+	case KeySemicolon:
+		return "KEY_SEMICOLON"
+	case KeyShift:
+		return "KEY_SHIFT"
+	case KeySlash:
+		return "KEY_SLASH"
+	case KeySpace:
+		return "KEY_SPACE"
+	case KeySsharp:
+		return "KEY_SSHARP"
+	case KeyStandby:
+		return "KEY_STANDBY"
+	case KeySterling:
+		return "KEY_STERLING"
+	case KeyStop:
+		return "KEY_STOP"
+	case KeySuperL:
+		return "KEY_SUPER_L"
+	case KeySuperR:
+		return "KEY_SUPER_R"
+	case KeySysreq:
+		return "KEY_SYSREQ"
+	case KeyT:
+		return "KEY_T"
+	case KeyTab:
+		return "KEY_TAB"
+	case KeyThorn:
+		return "KEY_THORN"
+	case KeyThreequarters:
+		return "KEY_THREEQUARTERS"
+	case KeyThreesuperior:
+		return "KEY_THREESUPERIOR"
+	case KeyTrebledown:
+		return "KEY_TREBLEDOWN"
+	case KeyTrebleup:
+		return "KEY_TREBLEUP"
+	case KeyTwosuperior:
+		return "KEY_TWOSUPERIOR"
+	case KeyU:
+		return "KEY_U"
+	case KeyUacute:
+		return "KEY_UACUTE"
+	case KeyUcircumflex:
+		return "KEY_UCIRCUMFLEX"
+	case KeyUdiaeresis:
+		return "KEY_UDIAERESIS"
+	case KeyUgrave:
+		return "KEY_UGRAVE"
+	case KeyUnderscore:
+		return "KEY_UNDERSCORE"
+	case KeyUnknown:
+		return "KEY_UNKNOWN"
+	case KeyUp:
+		return "KEY_UP"
+	case KeyV:
+		return "KEY_V"
+	case KeyVolumedown:
+		return "KEY_VOLUMEDOWN"
+	case KeyVolumemute:
+		return "KEY_VOLUMEMUTE"
+	case KeyVolumeup:
+		return "KEY_VOLUMEUP"
+	case KeyW:
+		return "KEY_W"
+	case KeyX:
+		return "KEY_X"
+	case KeyY:
+		return "KEY_Y"
+	case KeyYacute:
+		return "KEY_YACUTE"
+	case KeyYdiaeresis:
+		return "KEY_YDIAERESIS"
+	case KeyYen:
+		return "KEY_YEN"
+	case KeyZ:
+		return "KEY_Z"
+	}
+	return fmt.Sprintf("KeyList(%d)", int(e))
+}
+
+// ParseKeyList will return the KeyList value with the given Godot name.
+func ParseKeyList(name string) (KeyList, error) {
+	switch name {
+	case "KEY_0":
+		return Key0, nil
+	case "KEY_1":
+		return Key1, nil
+	case "KEY_2":
+		return Key2, nil
+	case "KEY_3":
+		return Key3, nil
+	case "KEY_4":
+		return Key4, nil
+	case "KEY_5":
+		return Key5, nil
+	case "KEY_6":
+		return Key6, nil
+	case "KEY_7":
+		return Key7, nil
+	case "KEY_8":
+		return Key8, nil
+	case "KEY_9":
+		return Key9, nil
+	case "KEY_A":
+		return KeyA, nil
+	case "KEY_AACUTE":
+		return KeyAacute, nil
+	case "KEY_ACIRCUMFLEX":
+		return KeyAcircumflex, nil
+	case "KEY_ACUTE":
+		return KeyAcute, nil
+	case "KEY_ADIAERESIS":
+		return KeyAdiaeresis, nil
+	case "KEY_AE":
+		return KeyAe, nil
+	case "KEY_AGRAVE":
+		return KeyAgrave, nil
+	case "KEY_ALT":
+		return KeyAlt, nil
+	case "KEY_AMPERSAND":
+		return KeyAmpersand, nil
+	case "KEY_APOSTROPHE":
+		return KeyApostrophe, nil
+	case "KEY_ARING":
+		return KeyAring, nil
+	case "KEY_ASCIICIRCUM":
+		return KeyAsciicircum, nil
+	case "KEY_ASCIITILDE":
+		return KeyAsciitilde, nil
+	case "KEY_ASTERISK":
+		return KeyAsterisk, nil
+	case "KEY_AT":
+		return KeyAt, nil
+	case "KEY_ATILDE":
+		return KeyAtilde, nil
+	case "KEY_B":
+		return KeyB, nil
+	case "KEY_BACK":
+		return KeyBack, nil
+	case "KEY_BACKSLASH":
+		return KeyBackslash, nil
+	case "KEY_BACKSPACE":
+		return KeyBackspace, nil
+	case "KEY_BACKTAB":
+		return KeyBacktab, nil
+	case "KEY_BAR":
+		return KeyBar, nil
+	case "KEY_BASSBOOST":
+		return KeyBassboost, nil
+	case "KEY_BASSDOWN":
+		return KeyBassdown, nil
+	case "KEY_BASSUP":
+		return KeyBassup, nil
+	case "KEY_BRACELEFT":
+		return KeyBraceleft, nil
+	case "KEY_BRACERIGHT":
+		return KeyBraceright, nil
+	case "KEY_BRACKETLEFT":
+		return KeyBracketleft, nil
+	case "KEY_BRACKETRIGHT":
+		return KeyBracketright, nil
+	case "KEY_BROKENBAR":
+		return KeyBrokenbar, nil
+	case "KEY_C":
+		return KeyC, nil
+	case "KEY_CAPSLOCK":
+		return KeyCapslock, nil
+	case "KEY_CCEDILLA":
+		return KeyCcedilla, nil
+	case "KEY_CEDILLA":
+		return KeyCedilla, nil
+	case "KEY_CENT":
+		return KeyCent, nil
+	case "KEY_CLEAR":
+		return KeyClear, nil
+	case "KEY_COLON":
+		return KeyColon, nil
+	case "KEY_COMMA":
+		return KeyComma, nil
+	case "KEY_CONTROL":
+		return KeyControl, nil
+	case "KEY_COPYRIGHT":
+		return KeyCopyright, nil
+	case "KEY_CURRENCY":
+		return KeyCurrency, nil
+	case "KEY_D":
+		return KeyD, nil
+	case "KEY_DEGREE":
+		return KeyDegree, nil
+	case "KEY_DELETE":
+		return KeyDelete, nil
+	case "KEY_DIAERESIS":
+		return KeyDiaeresis, nil
+	case "KEY_DIRECTION_L":
+		return KeyDirectionL, nil
+	case "KEY_DIRECTION_R":
+		return KeyDirectionR, nil
+	case "KEY_DIVISION":
+		return KeyDivision, nil
+	case "KEY_DOLLAR":
+		return KeyDollar, nil
+	case "KEY_DOWN":
+		return KeyDown, nil
+	case "KEY_E":
+		return KeyE, nil
+	case "KEY_EACUTE":
+		return KeyEacute, nil
+	case "KEY_ECIRCUMFLEX":
+		return KeyEcircumflex, nil
+	case "KEY_EDIAERESIS":
+		return KeyEdiaeresis, nil
+	case "KEY_EGRAVE":
+		return KeyEgrave, nil
+	case "KEY_END":
+		return KeyEnd, nil
+	case "KEY_ENTER":
+		return KeyEnter, nil
+	case "KEY_EQUAL":
+		return KeyEqual, nil
+	case "KEY_ESCAPE":
+		return KeyEscape, nil
+	case "KEY_ETH":
+		return KeyEth, nil
+	case "KEY_EXCLAM":
+		return KeyExclam, nil
+	case "KEY_EXCLAMDOWN":
+		return KeyExclamdown, nil
+	case "KEY_F":
+		return KeyF, nil
+	case "KEY_F1":
+		return KeyF1, nil
+	case "KEY_F10":
+		return KeyF10, nil
+	case "KEY_F11":
+		return KeyF11, nil
+	case "KEY_F12":
+		return KeyF12, nil
+	case "KEY_F13":
+		return KeyF13, nil
+	case "KEY_F14":
+		return KeyF14, nil
+	case "KEY_F15":
+		return KeyF15, nil
+	case "KEY_F16":
+		return KeyF16, nil
+	case "KEY_F2":
+		return KeyF2, nil
+	case "KEY_F3":
+		return KeyF3, nil
+	case "KEY_F4":
+		return KeyF4, nil
+	case "KEY_F5":
+		return KeyF5, nil
+	case "KEY_F6":
+		return KeyF6, nil
+	case "KEY_F7":
+		return KeyF7, nil
+	case "KEY_F8":
+		return KeyF8, nil
+	case "KEY_F9":
+		return KeyF9, nil
+	case "KEY_FAVORITES":
+		return KeyFavorites, nil
+	case "KEY_FORWARD":
+		return KeyForward, nil
+	case "KEY_G":
+		return KeyG, nil
+	case "KEY_GREATER":
+		return KeyGreater, nil
+	case "KEY_GUILLEMOTLEFT":
+		return KeyGuillemotleft, nil
+	case "KEY_GUILLEMOTRIGHT":
+		return KeyGuillemotright, nil
+	case "KEY_H":
+		return KeyH, nil
+	case "KEY_HELP":
+		return KeyHelp, nil
+	case "KEY_HOME":
+		return KeyHome, nil
+	case "KEY_HOMEPAGE":
+		return KeyHomepage, nil
+	case "KEY_HYPER_L":
+		return KeyHyperL, nil
+	case "KEY_HYPER_R":
+		return KeyHyperR, nil
+	case "KEY_HYPHEN":
+		return KeyHyphen, nil
+	case "KEY_I":
+		return KeyI, nil
+	case "KEY_IACUTE":
+		return KeyIacute, nil
+	case "KEY_ICIRCUMFLEX":
+		return KeyIcircumflex, nil
+	case "KEY_IDIAERESIS":
+		return KeyIdiaeresis, nil
+	case "KEY_IGRAVE":
+		return KeyIgrave, nil
+	case "KEY_INSERT":
+		return KeyInsert, nil
+	case "KEY_J":
+		return KeyJ, nil
+	case "KEY_K":
+		return KeyK, nil
+	case "KEY_KP_0":
+		return KeyKp0, nil
+	case "KEY_KP_1":
+		return KeyKp1, nil
+	case "KEY_KP_2":
+		return KeyKp2, nil
+	case "KEY_KP_3":
+		return KeyKp3, nil
+	case "KEY_KP_4":
+		return KeyKp4, nil
+	case "KEY_KP_5":
+		return KeyKp5, nil
+	case "KEY_KP_6":
+		return KeyKp6, nil
+	case "KEY_KP_7":
+		return KeyKp7, nil
+	case "KEY_KP_8":
+		return KeyKp8, nil
+	case "KEY_KP_9":
+		return KeyKp9, nil
+	case "KEY_KP_ADD":
+		return KeyKpAdd, nil
+	case "KEY_KP_DIVIDE":
+		return KeyKpDivide, nil
+	case "KEY_KP_ENTER":
+		return KeyKpEnter, nil
+	case "KEY_KP_MULTIPLY":
+		return KeyKpMultiply, nil
+	case "KEY_KP_PERIOD":
+		return KeyKpPeriod, nil
+	case "KEY_KP_SUBTRACT":
+		return KeyKpSubtract, nil
+	case "KEY_L":
+		return KeyL, nil
+	case "KEY_LAUNCH0":
+		return KeyLaunch0, nil
+	case "KEY_LAUNCH1":
+		return KeyLaunch1, nil
+	case "KEY_LAUNCH2":
+		return KeyLaunch2, nil
+	case "KEY_LAUNCH3":
+		return KeyLaunch3, nil
+	case "KEY_LAUNCH4":
+		return KeyLaunch4, nil
+	case "KEY_LAUNCH5":
+		return KeyLaunch5, nil
+	case "KEY_LAUNCH6":
+		return KeyLaunch6, nil
+	case "KEY_LAUNCH7":
+		return KeyLaunch7, nil
+	case "KEY_LAUNCH8":
+		return KeyLaunch8, nil
+	case "KEY_LAUNCH9":
+		return KeyLaunch9, nil
+	case "KEY_LAUNCHA":
+		return KeyLauncha, nil
+	case "KEY_LAUNCHB":
+		return KeyLaunchb, nil
+	case "KEY_LAUNCHC":
+		return KeyLaunchc, nil
+	case "KEY_LAUNCHD":
+		return KeyLaunchd, nil
+	case "KEY_LAUNCHE":
+		return KeyLaunche, nil
+	case "KEY_LAUNCHF":
+		return KeyLaunchf, nil
+	case "KEY_LAUNCHMAIL":
+		return KeyLaunchmail, nil
+	case "KEY_LAUNCHMEDIA":
+		return KeyLaunchmedia, nil
+	case "KEY_LEFT":
+		return KeyLeft, nil
+	case "KEY_LESS":
+		return KeyLess, nil
+	case "KEY_M":
+		return KeyM, nil
+	case "KEY_MACRON":
+		return KeyMacron, nil
+	case "KEY_MASCULINE":
+		return KeyMasculine, nil
+	case "KEY_MEDIANEXT":
+		return KeyMedianext, nil
+	case "KEY_MEDIAPLAY":
+		return KeyMediaplay, nil
+	case "KEY_MEDIAPREVIOUS":
+		return KeyMediaprevious, nil
+	case "KEY_MEDIARECORD":
+		return KeyMediarecord, nil
+	case "KEY_MEDIASTOP":
+		return KeyMediastop, nil
+	case "KEY_MENU":
+		return KeyMenu, nil
+	case "KEY_META":
+		return KeyMeta, nil
+	case "KEY_MINUS":
+		return KeyMinus, nil
+	case "KEY_MU":
+		return KeyMu, nil
+	case "KEY_MULTIPLY":
+		return KeyMultiply, nil
+	case "KEY_N":
+		return KeyN, nil
+	case "KEY_NOBREAKSPACE":
+		return KeyNobreakspace, nil
+	case "KEY_NOTSIGN":
+		return KeyNotsign, nil
+	case "KEY_NTILDE":
+		return KeyNtilde, nil
+	case "KEY_NUMBERSIGN":
+		return KeyNumbersign, nil
+	case "KEY_NUMLOCK":
+		return KeyNumlock, nil
+	case "KEY_O":
+		return KeyO, nil
+	case "KEY_OACUTE":
+		return KeyOacute, nil
+	case "KEY_OCIRCUMFLEX":
+		return KeyOcircumflex, nil
+	case "KEY_ODIAERESIS":
+		return KeyOdiaeresis, nil
+	case "KEY_OGRAVE":
+		return KeyOgrave, nil
+	case "KEY_ONEHALF":
+		return KeyOnehalf, nil
+	case "KEY_ONEQUARTER":
+		return KeyOnequarter, nil
+	case "KEY_ONESUPERIOR":
+		return KeyOnesuperior, nil
+	case "KEY_OOBLIQUE":
+		return KeyOoblique, nil
+	case "KEY_OPENURL":
+		return KeyOpenurl, nil
+	case "KEY_ORDFEMININE":
+		return KeyOrdfeminine, nil
+	case "KEY_OTILDE":
+		return KeyOtilde, nil
+	case "KEY_P":
+		return KeyP, nil
+	case "KEY_PAGEDOWN":
+		return KeyPagedown, nil
+	case "KEY_PAGEUP":
+		return KeyPageup, nil
+	case "KEY_PARAGRAPH":
+		return KeyParagraph, nil
+	case "KEY_PARENLEFT":
+		return KeyParenleft, nil
+	case "KEY_PARENRIGHT":
+		return KeyParenright, nil
+	case "KEY_PAUSE":
+		return KeyPause, nil
+	case "KEY_PERCENT":
+		return KeyPercent, nil
+	case "KEY_PERIOD":
+		return KeyPeriod, nil
+	case "KEY_PERIODCENTERED":
+		return KeyPeriodcentered, nil
+	case "KEY_PLUS":
+		return KeyPlus, nil
+	case "KEY_PLUSMINUS":
+		return KeyPlusminus, nil
+	case "KEY_PRINT":
+		return KeyPrint, nil
+	case "KEY_Q":
+		return KeyQ, nil
+	case "KEY_QUESTION":
+		return KeyQuestion, nil
+	case "KEY_QUESTIONDOWN":
+		return KeyQuestiondown, nil
+	case "KEY_QUOTEDBL":
+		return KeyQuotedbl, nil
+	case "KEY_QUOTELEFT":
+		return KeyQuoteleft, nil
+	case "KEY_R":
+		return KeyR, nil
+	case "KEY_REFRESH":
+		return KeyRefresh, nil
+	case "KEY_REGISTERED":
+		return KeyRegistered, nil
+	case "KEY_RIGHT":
+		return KeyRight, nil
+	case "KEY_S":
+		return KeyS, nil
+	case "KEY_SCROLLLOCK":
+		return KeyScrolllock, nil
+	case "KEY_SEARCH":
+		return KeySearch, nil
+	case "KEY_SECTION":
+		return KeySection, nil
+	case "KEY_SEMICOLON":
+		return KeySemicolon, nil
+	case "KEY_SHIFT":
+		return KeyShift, nil
+	case "KEY_SLASH":
+		return KeySlash, nil
+	case "KEY_SPACE":
+		return KeySpace, nil
+	case "KEY_SSHARP":
+		return KeySsharp, nil
+	case "KEY_STANDBY":
+		return KeyStandby, nil
+	case "KEY_STERLING":
+		return KeySterling, nil
+	case "KEY_STOP":
+		return KeyStop, nil
+	case "KEY_SUPER_L":
+		return KeySuperL, nil
+	case "KEY_SUPER_R":
+		return KeySuperR, nil
+	case "KEY_SYSREQ":
+		return KeySysreq, nil
+	case "KEY_T":
+		return KeyT, nil
+	case "KEY_TAB":
+		return KeyTab, nil
+	case "KEY_THORN":
+		return KeyThorn, nil
+	case "KEY_THREEQUARTERS":
+		return KeyThreequarters, nil
+	case "KEY_THREESUPERIOR":
+		return KeyThreesuperior, nil
+	case "KEY_TREBLEDOWN":
+		return KeyTrebledown, nil
+	case "KEY_TREBLEUP":
+		return KeyTrebleup, nil
+	case "KEY_TWOSUPERIOR":
+		return KeyTwosuperior, nil
+	case "KEY_U":
+		return KeyU, nil
+	case "KEY_UACUTE":
+		return KeyUacute, nil
+	case "KEY_UCIRCUMFLEX":
+		return KeyUcircumflex, nil
+	case "KEY_UDIAERESIS":
+		return KeyUdiaeresis, nil
+	case "KEY_UGRAVE":
+		return KeyUgrave, nil
+	case "KEY_UNDERSCORE":
+		return KeyUnderscore, nil
+	case "KEY_UNKNOWN":
+		return KeyUnknown, nil
+	case "KEY_UP":
+		return KeyUp, nil
+	case "KEY_V":
+		return KeyV, nil
+	case "KEY_VOLUMEDOWN":
+		return KeyVolumedown, nil
+	case "KEY_VOLUMEMUTE":
+		return KeyVolumemute, nil
+	case "KEY_VOLUMEUP":
+		return KeyVolumeup, nil
+	case "KEY_W":
+		return KeyW, nil
+	case "KEY_X":
+		return KeyX, nil
+	case "KEY_Y":
+		return KeyY, nil
+	case "KEY_YACUTE":
+		return KeyYacute, nil
+	case "KEY_YDIAERESIS":
+		return KeyYdiaeresis, nil
+	case "KEY_YEN":
+		return KeyYen, nil
+	case "KEY_Z":
+		return KeyZ, nil
+	}
+	return 0, fmt.Errorf("invalid KeyList value %q", name)
+}
+
+// ButtonList is an enum for the global ButtonList values.
+type ButtonList int
+
+// ButtonList values from the global constants.
+const (
+	ButtonLeft       ButtonList = 1
+	ButtonMaskLeft   ButtonList = 1
+	ButtonMaskMiddle ButtonList = 4
+	ButtonMaskRight  ButtonList = 2
+	ButtonMiddle     ButtonList = 3
+	ButtonRight      ButtonList = 2
+	ButtonWheelDown  ButtonList = 5
+	ButtonWheelLeft  ButtonList = 6
+	ButtonWheelRight ButtonList = 7
+	ButtonWheelUp    ButtonList = 4
+)
+
+// String will return the Godot name of the ButtonList value.
+func (e ButtonList) String() string {
+	switch e {
+	case ButtonLeft:
+		return "BUTTON_LEFT"
+	case ButtonMaskMiddle:
+		return "BUTTON_MASK_MIDDLE"
+	case ButtonMaskRight:
+		return "BUTTON_MASK_RIGHT"
+	case ButtonMiddle:
+		return "BUTTON_MIDDLE"
+	case ButtonWheelDown:
+		return "BUTTON_WHEEL_DOWN"
+	case ButtonWheelLeft:
+		return "BUTTON_WHEEL_LEFT"
+	case ButtonWheelRight:
+		return "BUTTON_WHEEL_RIGHT"
+	}
+	return fmt.Sprintf("ButtonList(%d)", int(e))
+}
+
+// ParseButtonList will return the ButtonList value with the given Godot name.
+func ParseButtonList(name string) (ButtonList, error) {
+	switch name {
+	case "BUTTON_LEFT":
+		return ButtonLeft, nil
+	case "BUTTON_MASK_LEFT":
+		return ButtonMaskLeft, nil
+	case "BUTTON_MASK_MIDDLE":
+		return ButtonMaskMiddle, nil
+	case "BUTTON_MASK_RIGHT":
+		return ButtonMaskRight, nil
+	case "BUTTON_MIDDLE":
+		return ButtonMiddle, nil
+	case "BUTTON_RIGHT":
+		return ButtonRight, nil
+	case "BUTTON_WHEEL_DOWN":
+		return ButtonWheelDown, nil
+	case "BUTTON_WHEEL_LEFT":
+		return ButtonWheelLeft, nil
+	case "BUTTON_WHEEL_RIGHT":
+		return ButtonWheelRight, nil
+	case "BUTTON_WHEEL_UP":
+		return ButtonWheelUp, nil
+	}
+	return 0, fmt.Errorf("invalid ButtonList value %q", name)
+}
+
+// JoystickList is an enum for the global JoystickList values.
+type JoystickList int
+
+// JoystickList values from the global constants.
+const (
+	JoyAnalogL2     JoystickList = 6
+	JoyAnalogLx     JoystickList = 0
+	JoyAnalogLy     JoystickList = 1
+	JoyAnalogR2     JoystickList = 7
+	JoyAnalogRx     JoystickList = 2
+	JoyAnalogRy     JoystickList = 3
+	JoyAxis0        JoystickList = 0
+	JoyAxis1        JoystickList = 1
+	JoyAxis2        JoystickList = 2
+	JoyAxis3        JoystickList = 3
+	JoyAxis4        JoystickList = 4
+	JoyAxis5        JoystickList = 5
+	JoyAxis6        JoystickList = 6
+	JoyAxis7        JoystickList = 7
+	JoyAxis8        JoystickList = 8
+	JoyAxis9        JoystickList = 9
+	JoyAxisMax      JoystickList = 10
+	JoyButton0      JoystickList = 0
+	JoyButton1      JoystickList = 1
+	JoyButton10     JoystickList = 10
+	JoyButton11     JoystickList = 11
+	JoyButton12     JoystickList = 12
+	JoyButton13     JoystickList = 13
+	JoyButton14     JoystickList = 14
+	JoyButton15     JoystickList = 15
+	JoyButton2      JoystickList = 2
+	JoyButton3      JoystickList = 3
+	JoyButton4      JoystickList = 4
+	JoyButton5      JoystickList = 5
+	JoyButton6      JoystickList = 6
+	JoyButton7      JoystickList = 7
+	JoyButton8      JoystickList = 8
+	JoyButton9      JoystickList = 9
+	JoyButtonMax    JoystickList = 16
+	JoyDpadDown     JoystickList = 13
+	JoyDpadLeft     JoystickList = 14
+	JoyDpadRight    JoystickList = 15
+	JoyDpadUp       JoystickList = 12
+	JoyDsA          JoystickList = 1
+	JoyDsB          JoystickList = 0
+	JoyDsX          JoystickList = 3
+	JoyDsY          JoystickList = 2
+	JoyL            JoystickList = 4
+	JoyL2           JoystickList = 6
+	JoyL3           JoystickList = 8
+	JoyR            JoystickList = 5
+	JoyR2           JoystickList = 7
+	JoyR3           JoystickList = 9
+	JoySelect       JoystickList = 10
+	JoySonyCircle   JoystickList = 1
+	JoySonySquare   JoystickList = 2
+	JoySonyTriangle JoystickList = 3
+	JoySonyX        JoystickList = 0
+	JoyStart        JoystickList = 11
+	JoyXboxA        JoystickList = 0
+	JoyXboxB        JoystickList = 1
+	JoyXboxX        JoystickList = 2
+	JoyXboxY        JoystickList = 3
+)
+
+// String will return the Godot name of the JoystickList value.
+func (e JoystickList) String() string {
+	switch e {
+	case JoyAnalogL2:
+		return "JOY_ANALOG_L2"
+	case JoyAnalogLx:
+		return "JOY_ANALOG_LX"
+	case JoyAnalogLy:
+		return "JOY_ANALOG_LY"
+	case JoyAnalogR2:
+		return "JOY_ANALOG_R2"
+	case JoyAnalogRx:
+		return "JOY_ANALOG_RX"
+	case JoyAnalogRy:
+		return "JOY_ANALOG_RY"
+	case JoyAxis4:
+		return "JOY_AXIS_4"
+	case JoyAxis5:
+		return "JOY_AXIS_5"
+	case JoyAxis8:
+		return "JOY_AXIS_8"
+	case JoyAxis9:
+		return "JOY_AXIS_9"
+	case JoyAxisMax:
+		return "JOY_AXIS_MAX"
+	case JoyButton11:
+		return "JOY_BUTTON_11"
+	case JoyButton12:
+		return "JOY_BUTTON_12"
+	case JoyButton13:
+		return "JOY_BUTTON_13"
+	case JoyButton14:
+		return "JOY_BUTTON_14"
+	case JoyButton15:
+		return "JOY_BUTTON_15"
+	case JoyButtonMax:
+		return "JOY_BUTTON_MAX"
+	}
+	return fmt.Sprintf("JoystickList(%d)", int(e))
+}
+
+// ParseJoystickList will return the JoystickList value with the given Godot name.
+func ParseJoystickList(name string) (JoystickList, error) {
+	switch name {
+	case "JOY_ANALOG_L2":
+		return JoyAnalogL2, nil
+	case "JOY_ANALOG_LX":
+		return JoyAnalogLx, nil
+	case "JOY_ANALOG_LY":
+		return JoyAnalogLy, nil
+	case "JOY_ANALOG_R2":
+		return JoyAnalogR2, nil
+	case "JOY_ANALOG_RX":
+		return JoyAnalogRx, nil
+	case "JOY_ANALOG_RY":
+		return JoyAnalogRy, nil
+	case "JOY_AXIS_0":
+		return JoyAxis0, nil
+	case "JOY_AXIS_1":
+		return JoyAxis1, nil
+	case "JOY_AXIS_2":
+		return JoyAxis2, nil
+	case "JOY_AXIS_3":
+		return JoyAxis3, nil
+	case "JOY_AXIS_4":
+		return JoyAxis4, nil
+	case "JOY_AXIS_5":
+		return JoyAxis5, nil
+	case "JOY_AXIS_6":
+		return JoyAxis6, nil
+	case "JOY_AXIS_7":
+		return JoyAxis7, nil
+	case "JOY_AXIS_8":
+		return JoyAxis8, nil
+	case "JOY_AXIS_9":
+		return JoyAxis9, nil
+	case "JOY_AXIS_MAX":
+		return JoyAxisMax, nil
+	case "JOY_BUTTON_0":
+		return JoyButton0, nil
+	case "JOY_BUTTON_1":
+		return JoyButton1, nil
+	case "JOY_BUTTON_10":
+		return JoyButton10, nil
+	case "JOY_BUTTON_11":
+		return JoyButton11, nil
+	case "JOY_BUTTON_12":
+		return JoyButton12, nil
+	case "JOY_BUTTON_13":
+		return JoyButton13, nil
+	case "JOY_BUTTON_14":
+		return JoyButton14, nil
+	case "JOY_BUTTON_15":
+		return JoyButton15, nil
+	case "JOY_BUTTON_2":
+		return JoyButton2, nil
+	case "JOY_BUTTON_3":
+		return JoyButton3, nil
+	case "JOY_BUTTON_4":
+		return JoyButton4, nil
+	case "JOY_BUTTON_5":
+		return JoyButton5, nil
+	case "JOY_BUTTON_6":
+		return JoyButton6, nil
+	case "JOY_BUTTON_7":
+		return JoyButton7, nil
+	case "JOY_BUTTON_8":
+		return JoyButton8, nil
+	case "JOY_BUTTON_9":
+		return JoyButton9, nil
+	case "JOY_BUTTON_MAX":
+		return JoyButtonMax, nil
+	case "JOY_DPAD_DOWN":
+		return JoyDpadDown, nil
+	case "JOY_DPAD_LEFT":
+		return JoyDpadLeft, nil
+	case "JOY_DPAD_RIGHT":
+		return JoyDpadRight, nil
+	case "JOY_DPAD_UP":
+		return JoyDpadUp, nil
+	case "JOY_DS_A":
+		return JoyDsA, nil
+	case "JOY_DS_B":
+		return JoyDsB, nil
+	case "JOY_DS_X":
+		return JoyDsX, nil
+	case "JOY_DS_Y":
+		return JoyDsY, nil
+	case "JOY_L":
+		return JoyL, nil
+	case "JOY_L2":
+		return JoyL2, nil
+	case "JOY_L3":
+		return JoyL3, nil
+	case "JOY_R":
+		return JoyR, nil
+	case "JOY_R2":
+		return JoyR2, nil
+	case "JOY_R3":
+		return JoyR3, nil
+	case "JOY_SELECT":
+		return JoySelect, nil
+	case "JOY_SONY_CIRCLE":
+		return JoySonyCircle, nil
+	case "JOY_SONY_SQUARE":
+		return JoySonySquare, nil
+	case "JOY_SONY_TRIANGLE":
+		return JoySonyTriangle, nil
+	case "JOY_SONY_X":
+		return JoySonyX, nil
+	case "JOY_START":
+		return JoyStart, nil
+	case "JOY_XBOX_A":
+		return JoyXboxA, nil
+	case "JOY_XBOX_B":
+		return JoyXboxB, nil
+	case "JOY_XBOX_X":
+		return JoyXboxX, nil
+	case "JOY_XBOX_Y":
+		return JoyXboxY, nil
+	}
+	return 0, fmt.Errorf("invalid JoystickList value %q", name)
+}
+
+// Error values from the global constants.
+const (
+	ErrAlreadyExists           gdnative.Error = 32
+	ErrAlreadyInUse            gdnative.Error = 22
+	ErrBug                     gdnative.Error = 47
+	ErrBusy                    gdnative.Error = 44
+	ErrCantAcquireResource     gdnative.Error = 28
+	ErrCantCreate              gdnative.Error = 20
+	ErrCantOpen                gdnative.Error = 19
+	ErrCompilationFailed       gdnative.Error = 36
+	ErrCyclicLink              gdnative.Error = 40
+	ErrDatabaseCantRead        gdnative.Error = 34
+	ErrDatabaseCantWrite       gdnative.Error = 35
+	ErrDoesNotExist            gdnative.Error = 33
+	ErrFileAlreadyInUse        gdnative.Error = 11
+	ErrFileBadDrive            gdnative.Error = 8
+	ErrFileBadPath             gdnative.Error = 9
+	ErrFileCantOpen            gdnative.Error = 12
+	ErrFileCantRead            gdnative.Error = 14
+	ErrFileCantWrite           gdnative.Error = 13
+	ErrFileCorrupt             gdnative.Error = 16
+	ErrFileEof                 gdnative.Error = 18
+	ErrFileMissingDependencies gdnative.Error = 17
+	ErrFileNotFound            gdnative.Error = 7
+	ErrFileNoPermission        gdnative.Error = 10
+	ErrFileUnrecognized        gdnative.Error = 15
+	ErrHelp                    gdnative.Error = 46
+	ErrInvalidData             gdnative.Error = 30
+	ErrInvalidParameter        gdnative.Error = 31
+	ErrLinkFailed              gdnative.Error = 38
+	ErrLocked                  gdnative.Error = 23
+	ErrMethodNotFound          gdnative.Error = 37
+	ErrOutOfMemory             gdnative.Error = 6
+	ErrParameterRangeError     gdnative.Error = 5
+	ErrParseError              gdnative.Error = 43
+	ErrQueryFailed             gdnative.Error = 21
+	ErrScriptFailed            gdnative.Error = 39
+	ErrTimeout                 gdnative.Error = 24
+	ErrUnauthorized            gdnative.Error = 4
+	ErrUnavailable             gdnative.Error = 2
+	ErrUnconfigured            gdnative.Error = 3
+	Failed                     gdnative.Error = 1
+	Ok                         gdnative.Error = 0
+)
+
+// PropertyHint values from the global constants.
+const (
+	PropertyHintColorNoAlpha          gdnative.PropertyHint = 19
+	PropertyHintDir                   gdnative.PropertyHint = 14
+	PropertyHintEnum                  gdnative.PropertyHint = 3
+	PropertyHintExpEasing             gdnative.PropertyHint = 4
+	PropertyHintExpRange              gdnative.PropertyHint = 2
+	PropertyHintFile                  gdnative.PropertyHint = 13
+	PropertyHintFlags                 gdnative.PropertyHint = 8
+	PropertyHintGlobalDir             gdnative.PropertyHint = 16
+	PropertyHintGlobalFile            gdnative.PropertyHint = 15
+	PropertyHintImageCompressLossless gdnative.PropertyHint = 21
+	PropertyHintImageCompressLossy    gdnative.PropertyHint = 20
+	PropertyHintKeyAccel              gdnative.PropertyHint = 7
+	PropertyHintLayers2DPhysics       gdnative.PropertyHint = 10
+	PropertyHintLayers2DRender        gdnative.PropertyHint = 9
+	PropertyHintLayers3DPhysics       gdnative.PropertyHint = 12
+	PropertyHintLayers3DRender        gdnative.PropertyHint = 11
+	PropertyHintLength                gdnative.PropertyHint = 5
+	PropertyHintMultilineText         gdnative.PropertyHint = 18
+	PropertyHintNone                  gdnative.PropertyHint = 0
+	PropertyHintRange                 gdnative.PropertyHint = 1
+	PropertyHintResourceType          gdnative.PropertyHint = 17
+)
+
+// PropertyUsageFlags values from the global constants.
+const (
+	PropertyUsageCategory          gdnative.PropertyUsageFlags = 256
+	PropertyUsageCheckable         gdnative.PropertyUsageFlags = 16
+	PropertyUsageChecked           gdnative.PropertyUsageFlags = 32
+	PropertyUsageDefault           gdnative.PropertyUsageFlags = 7
+	PropertyUsageDefaultIntl       gdnative.PropertyUsageFlags = 71
+	PropertyUsageEditor            gdnative.PropertyUsageFlags = 2
+	PropertyUsageEditorHelper      gdnative.PropertyUsageFlags = 8
+	PropertyUsageGroup             gdnative.PropertyUsageFlags = 128
+	PropertyUsageInternationalized gdnative.PropertyUsageFlags = 64
+	PropertyUsageNetwork           gdnative.PropertyUsageFlags = 4
+	PropertyUsageNoeditor          gdnative.PropertyUsageFlags = 5
+	PropertyUsageNoInstanceState   gdnative.PropertyUsageFlags = 2048
+	PropertyUsageRestartIfChanged  gdnative.PropertyUsageFlags = 4096
+	PropertyUsageScriptVariable    gdnative.PropertyUsageFlags = 8192
+	PropertyUsageStorage           gdnative.PropertyUsageFlags = 1
+	PropertyUsageStoreIfNonone     gdnative.PropertyUsageFlags = 1024
+	PropertyUsageStoreIfNonzero    gdnative.PropertyUsageFlags = 512
+)
+
+// MethodFlags is an enum for the global MethodFlags values.
+type MethodFlags int
+
+// MethodFlags values from the global constants.
+const (
+	MethodFlagsDefault   MethodFlags = 1
+	MethodFlagConst      MethodFlags = 8
+	MethodFlagEditor     MethodFlags = 2
+	MethodFlagFromScript MethodFlags = 64
+	MethodFlagNormal     MethodFlags = 1
+	MethodFlagNoscript   MethodFlags = 4
+	MethodFlagReverse    MethodFlags = 16
+	MethodFlagVirtual    MethodFlags = 32
+)
+
+// String will return the Godot name of the MethodFlags value.
+func (e MethodFlags) String() string {
+	switch e {
+	case MethodFlagsDefault:
+		return "METHOD_FLAGS_DEFAULT"
+	case MethodFlagConst:
+		return "METHOD_FLAG_CONST"
+	case MethodFlagEditor:
+		return "METHOD_FLAG_EDITOR"
+	case MethodFlagFromScript:
+		return "METHOD_FLAG_FROM_SCRIPT"
+	case MethodFlagNoscript:
+		return "METHOD_FLAG_NOSCRIPT"
+	case MethodFlagReverse:
+		return "METHOD_FLAG_REVERSE"
+	case MethodFlagVirtual:
+		return "METHOD_FLAG_VIRTUAL"
+	}
+	return fmt.Sprintf("MethodFlags(%d)", int(e))
+}
+
+// ParseMethodFlags will return the MethodFlags value with the given Godot name.
+func ParseMethodFlags(name string) (MethodFlags, error) {
+	switch name {
+	case "METHOD_FLAGS_DEFAULT":
+		return MethodFlagsDefault, nil
+	case "METHOD_FLAG_CONST":
+		return MethodFlagConst, nil
+	case "METHOD_FLAG_EDITOR":
+		return MethodFlagEditor, nil
+	case "METHOD_FLAG_FROM_SCRIPT":
+		return MethodFlagFromScript, nil
+	case "METHOD_FLAG_NORMAL":
+		return MethodFlagNormal, nil
+	case "METHOD_FLAG_NOSCRIPT":
+		return MethodFlagNoscript, nil
+	case "METHOD_FLAG_REVERSE":
+		return MethodFlagReverse, nil
+	case "METHOD_FLAG_VIRTUAL":
+		return MethodFlagVirtual, nil
+	}
+	return 0, fmt.Errorf("invalid MethodFlags value %q", name)
+}
+
+// VariantType values from the global constants.
+const (
+	TypeAabb         gdnative.VariantType = 11
+	TypeArray        gdnative.VariantType = 19
+	TypeBasis        gdnative.VariantType = 12
+	TypeBool         gdnative.VariantType = 1
+	TypeColor        gdnative.VariantType = 14
+	TypeColorArray   gdnative.VariantType = 26
+	TypeDictionary   gdnative.VariantType = 18
+	TypeInt          gdnative.VariantType = 2
+	TypeIntArray     gdnative.VariantType = 21
+	TypeMax          gdnative.VariantType = 27
+	TypeNil          gdnative.VariantType = 0
+	TypeNodePath     gdnative.VariantType = 15
+	TypeObject       gdnative.VariantType = 17
+	TypePlane        gdnative.VariantType = 9
+	TypeQuat         gdnative.VariantType = 10
+	TypeRawArray     gdnative.VariantType = 20
+	TypeReal         gdnative.VariantType = 3
+	TypeRealArray    gdnative.VariantType = 22
+	TypeRect2        gdnative.VariantType = 6
+	TypeRid          gdnative.VariantType = 16
+	TypeString       gdnative.VariantType = 4
+	TypeStringArray  gdnative.VariantType = 23
+	TypeTransform    gdnative.VariantType = 13
+	TypeTransform2D  gdnative.VariantType = 8
+	TypeVector2      gdnative.VariantType = 5
+	TypeVector2Array gdnative.VariantType = 24
+	TypeVector3      gdnative.VariantType = 7
+	TypeVector3Array gdnative.VariantType = 25
+)
+
+// VariantOperator values from the global constants.
+const (
+	OpAdd          gdnative.VariantOperator = 6
+	OpAnd          gdnative.VariantOperator = 20
+	OpBitAnd       gdnative.VariantOperator = 16
+	OpBitNegate    gdnative.VariantOperator = 19
+	OpBitOr        gdnative.VariantOperator = 17
+	OpBitXor       gdnative.VariantOperator = 18
+	OpDivide       gdnative.VariantOperator = 9
+	OpEqual        gdnative.VariantOperator = 0
+	OpGreater      gdnative.VariantOperator = 4
+	OpGreaterEqual gdnative.VariantOperator = 5
+	OpIn           gdnative.VariantOperator = 24
+	OpLess         gdnative.VariantOperator = 2
+	OpLessEqual    gdnative.VariantOperator = 3
+	OpMax          gdnative.VariantOperator = 25
+	OpModule       gdnative.VariantOperator = 12
+	OpMultiply     gdnative.VariantOperator = 8
+	OpNegate       gdnative.VariantOperator = 10
+	OpNot          gdnative.VariantOperator = 23
+	OpNotEqual     gdnative.VariantOperator = 1
+	OpOr           gdnative.VariantOperator = 21
+	OpPositive     gdnative.VariantOperator = 11
+	OpShiftLeft    gdnative.VariantOperator = 14
+	OpShiftRight   gdnative.VariantOperator = 15
+	OpStringConcat gdnative.VariantOperator = 13
+	OpSubtract     gdnative.VariantOperator = 7
+	OpXor          gdnative.VariantOperator = 22
+)
+
+// Global constants that are not part of an enum.
+const (
+	Spkey gdnative.Int = 16777216
+)
+
+// GlobalConstantsLookupMap is a lookup table of all the global constant values,
+// using their Godot names as keys.
+var GlobalConstantsLookupMap = map[string]int64{
+	"MARGIN_BOTTOM":                         3,
+	"MARGIN_LEFT":                           0,
+	"MARGIN_RIGHT":                          2,
+	"MARGIN_TOP":                            1,
+	"CORNER_BOTTOM_LEFT":                    3,
+	"CORNER_BOTTOM_RIGHT":                   2,
+	"CORNER_TOP_LEFT":                       0,
+	"CORNER_TOP_RIGHT":                      1,
+	"HORIZONTAL":                            0,
+	"VERTICAL":                              1,
+	"HALIGN_CENTER":                         1,
+	"HALIGN_LEFT":                           0,
+	"HALIGN_RIGHT":                          2,
+	"VALIGN_BOTTOM":                         2,
+	"VALIGN_CENTER":                         1,
+	"VALIGN_TOP":                            0,
+	"KEY_CODE_MASK":                         33554431,
+	"KEY_MASK_ALT":                          67108864,
+	"KEY_MASK_CMD":                          268435456,
+	"KEY_MASK_CTRL":                         268435456,
+	"KEY_MASK_GROUP_SWITCH":                 1073741824,
+	"KEY_MASK_KPAD":                         536870912,
+	"KEY_MASK_META":                         134217728,
+	"KEY_MASK_SHIFT":                        33554432,
+	"KEY_MODIFIER_MASK":                     -16777216,
+	"KEY_0":                                 48,
+	"KEY_1":                                 49,
+	"KEY_2":                                 50,
+	"KEY_3":                                 51,
+	"KEY_4":                                 52,
+	"KEY_5":                                 53,
+	"KEY_6":                                 54,
+	"KEY_7":                                 55,
+	"KEY_8":                                 56,
+	"KEY_9":                                 57,
+	"KEY_A":                                 65,
+	"KEY_AACUTE":                            193,
+	"KEY_ACIRCUMFLEX":                       194,
+	"KEY_ACUTE":                             180,
+	"KEY_ADIAERESIS":                        196,
+	"KEY_AE":                                198,
+	"KEY_AGRAVE":                            192,
+	"KEY_ALT":                               16777240,
+	"KEY_AMPERSAND":                         38,
+	"KEY_APOSTROPHE":                        39,
+	"KEY_ARING":                             197,
+	"KEY_ASCIICIRCUM":                       94,
+	"KEY_ASCIITILDE":                        126,
+	"KEY_ASTERISK":                          42,
+	"KEY_AT":                                64,
+	"KEY_ATILDE":                            195,
+	"KEY_B":                                 66,
+	"KEY_BACK":                              16777280,
+	"KEY_BACKSLASH":                         92,
+	"KEY_BACKSPACE":                         16777220,
+	"KEY_BACKTAB":                           16777219,
+	"KEY_BAR":                               124,
+	"KEY_BASSBOOST":                         16777287,
+	"KEY_BASSDOWN":                          16777289,
+	"KEY_BASSUP":                            16777288,
+	"KEY_BRACELEFT":                         123,
+	"KEY_BRACERIGHT":                        125,
+	"KEY_BRACKETLEFT":                       91,
+	"KEY_BRACKETRIGHT":                      93,
+	"KEY_BROKENBAR":                         166,
+	"KEY_C":                                 67,
+	"KEY_CAPSLOCK":                          16777241,
+	"KEY_CCEDILLA":                          199,
+	"KEY_CEDILLA":                           184,
+	"KEY_CENT":                              162,
+	"KEY_CLEAR":                             16777228,
+	"KEY_COLON":                             58,
+	"KEY_COMMA":                             44,
+	"KEY_CONTROL":                           16777238,
+	"KEY_COPYRIGHT":                         169,
+	"KEY_CURRENCY":                          164,
+	"KEY_D":                                 68,
+	"KEY_DEGREE":                            176,
+	"KEY_DELETE":                            16777224,
+	"KEY_DIAERESIS":                         168,
+	"KEY_DIRECTION_L":                       16777266,
+	"KEY_DIRECTION_R":                       16777267,
+	"KEY_DIVISION":                          247,
+	"KEY_DOLLAR":                            36,
+	"KEY_DOWN":                              16777234,
+	"KEY_E":                                 69,
+	"KEY_EACUTE":                            201,
+	"KEY_ECIRCUMFLEX":                       202,
+	"KEY_EDIAERESIS":                        203,
+	"KEY_EGRAVE":                            200,
+	"KEY_END":                               16777230,
+	"KEY_ENTER":                             16777221,
+	"KEY_EQUAL":                             61,
+	"KEY_ESCAPE":                            16777217,
+	"KEY_ETH":                               208,
+	"KEY_EXCLAM":                            33,
+	"KEY_EXCLAMDOWN":                        161,
+	"KEY_F":                                 70,
+	"KEY_F1":                                16777244,
+	"KEY_F10":                               16777253,
+	"KEY_F11":                               16777254,
+	"KEY_F12":                               16777255,
+	"KEY_F13":                               16777256,
+	"KEY_F14":                               16777257,
+	"KEY_F15":                               16777258,
+	"KEY_F16":                               16777259,
+	"KEY_F2":                                16777245,
+	"KEY_F3":                                16777246,
+	"KEY_F4":                                16777247,
+	"KEY_F5":                                16777248,
+	"KEY_F6":                                16777249,
+	"KEY_F7":                                16777250,
+	"KEY_F8":                                16777251,
+	"KEY_F9":                                16777252,
+	"KEY_FAVORITES":                         16777298,
+	"KEY_FORWARD":                           16777281,
+	"KEY_G":                                 71,
+	"KEY_GREATER":                           62,
+	"KEY_GUILLEMOTLEFT":                     171,
+	"KEY_GUILLEMOTRIGHT":                    187,
+	"KEY_H":                                 72,
+	"KEY_HELP":                              16777265,
+	"KEY_HOME":                              16777229,
+	"KEY_HOMEPAGE":                          16777297,
+	"KEY_HYPER_L":                           16777263,
+	"KEY_HYPER_R":                           16777264,
+	"KEY_HYPHEN":                            173,
+	"KEY_I":                                 73,
+	"KEY_IACUTE":                            205,
+	"KEY_ICIRCUMFLEX":                       206,
+	"KEY_IDIAERESIS":                        207,
+	"KEY_IGRAVE":                            204,
+	"KEY_INSERT":                            16777223,
+	"KEY_J":                                 74,
+	"KEY_K":                                 75,
+	"KEY_KP_0":                              16777350,
+	"KEY_KP_1":                              16777351,
+	"KEY_KP_2":                              16777352,
+	"KEY_KP_3":                              16777353,
+	"KEY_KP_4":                              16777354,
+	"KEY_KP_5":                              16777355,
+	"KEY_KP_6":                              16777356,
+	"KEY_KP_7":                              16777357,
+	"KEY_KP_8":                              16777358,
+	"KEY_KP_9":                              16777359,
+	"KEY_KP_ADD":                            16777349,
+	"KEY_KP_DIVIDE":                         16777346,
+	"KEY_KP_ENTER":                          16777222,
+	"KEY_KP_MULTIPLY":                       16777345,
+	"KEY_KP_PERIOD":                         16777348,
+	"KEY_KP_SUBTRACT":                       16777347,
+	"KEY_L":                                 76,
+	"KEY_LAUNCH0":                           16777304,
+	"KEY_LAUNCH1":                           16777305,
+	"KEY_LAUNCH2":                           16777306,
+	"KEY_LAUNCH3":                           16777307,
+	"KEY_LAUNCH4":                           16777308,
+	"KEY_LAUNCH5":                           16777309,
+	"KEY_LAUNCH6":                           16777310,
+	"KEY_LAUNCH7":                           16777311,
+	"KEY_LAUNCH8":                           16777312,
+	"KEY_LAUNCH9":                           16777313,
+	"KEY_LAUNCHA":                           16777314,
+	"KEY_LAUNCHB":                           16777315,
+	"KEY_LAUNCHC":                           16777316,
+	"KEY_LAUNCHD":                           16777317,
+	"KEY_LAUNCHE":                           16777318,
+	"KEY_LAUNCHF":                           16777319,
+	"KEY_LAUNCHMAIL":                        16777302,
+	"KEY_LAUNCHMEDIA":                       16777303,
+	"KEY_LEFT":                              16777231,
+	"KEY_LESS":                              60,
+	"KEY_M":                                 77,
+	"KEY_MACRON":                            175,
+	"KEY_MASCULINE":                         186,
+	"KEY_MEDIANEXT":                         16777295,
+	"KEY_MEDIAPLAY":                         16777292,
+	"KEY_MEDIAPREVIOUS":                     16777294,
+	"KEY_MEDIARECORD":                       16777296,
+	"KEY_MEDIASTOP":                         16777293,
+	"KEY_MENU":                              16777262,
+	"KEY_META":                              16777239,
+	"KEY_MINUS":                             45,
+	"KEY_MU":                                181,
+	"KEY_MULTIPLY":                          215,
+	"KEY_N":                                 78,
+	"KEY_NOBREAKSPACE":                      160,
+	"KEY_NOTSIGN":                           172,
+	"KEY_NTILDE":                            209,
+	"KEY_NUMBERSIGN":                        35,
+	"KEY_NUMLOCK":                           16777242,
+	"KEY_O":                                 79,
+	"KEY_OACUTE":                            211,
+	"KEY_OCIRCUMFLEX":                       212,
+	"KEY_ODIAERESIS":                        214,
+	"KEY_OGRAVE":                            210,
+	"KEY_ONEHALF":                           189,
+	"KEY_ONEQUARTER":                        188,
+	"KEY_ONESUPERIOR":                       185,
+	"KEY_OOBLIQUE":                          216,
+	"KEY_OPENURL":                           16777301,
+	"KEY_ORDFEMININE":                       170,
+	"KEY_OTILDE":                            213,
+	"KEY_P":                                 80,
+	"KEY_PAGEDOWN":                          16777236,
+	"KEY_PAGEUP":                            16777235,
+	"KEY_PARAGRAPH":                         182,
+	"KEY_PARENLEFT":                         40,
+	"KEY_PARENRIGHT":                        41,
+	"KEY_PAUSE":                             16777225,
+	"KEY_PERCENT":                           37,
+	"KEY_PERIOD":                            46,
+	"KEY_PERIODCENTERED":                    183,
+	"KEY_PLUS":                              43,
+	"KEY_PLUSMINUS":                         177,
+	"KEY_PRINT":                             16777226,
+	"KEY_Q":                                 81,
+	"KEY_QUESTION":                          63,
+	"KEY_QUESTIONDOWN":                      191,
+	"KEY_QUOTEDBL":                          34,
+	"KEY_QUOTELEFT":                         96,
+	"KEY_R":                                 82,
+	"KEY_REFRESH":                           16777283,
+	"KEY_REGISTERED":                        174,
+	"KEY_RIGHT":                             16777233,
+	"KEY_S":                                 83,
+	"KEY_SCROLLLOCK":                        16777243,
+	"KEY_SEARCH":                            16777299,
+	"KEY_SECTION":                           167,
+	"KEY_SEMICOLON":                         59,
+	"KEY_SHIFT":                             16777237,
+	"KEY_SLASH":                             47,
+	"KEY_SPACE":                             32,
+	"KEY_SSHARP":                            223,
+	"KEY_STANDBY":                           16777300,
+	"KEY_STERLING":                          163,
+	"KEY_STOP":                              16777282,
+	"KEY_SUPER_L":                           16777260,
+	"KEY_SUPER_R":                           16777261,
+	"KEY_SYSREQ":                            16777227,
+	"KEY_T":                                 84,
+	"KEY_TAB":                               16777218,
+	"KEY_THORN":                             222,
+	"KEY_THREEQUARTERS":                     190,
+	"KEY_THREESUPERIOR":                     179,
+	"KEY_TREBLEDOWN":                        16777291,
+	"KEY_TREBLEUP":                          16777290,
+	"KEY_TWOSUPERIOR":                       178,
+	"KEY_U":                                 85,
+	"KEY_UACUTE":                            218,
+	"KEY_UCIRCUMFLEX":                       219,
+	"KEY_UDIAERESIS":                        220,
+	"KEY_UGRAVE":                            217,
+	"KEY_UNDERSCORE":                        95,
+	"KEY_UNKNOWN":                           33554431,
+	"KEY_UP":                                16777232,
+	"KEY_V":                                 86,
+	"KEY_VOLUMEDOWN":                        16777284,
+	"KEY_VOLUMEMUTE":                        16777285,
+	"KEY_VOLUMEUP":                          16777286,
+	"KEY_W":                                 87,
+	"KEY_X":                                 88,
+	"KEY_Y":                                 89,
+	"KEY_YACUTE":                            221,
+	"KEY_YDIAERESIS":                        255,
+	"KEY_YEN":                               165,
+	"KEY_Z":                                 90,
+	"BUTTON_LEFT":                           1,
+	"BUTTON_MASK_LEFT":                      1,
+	"BUTTON_MASK_MIDDLE":                    4,
+	"BUTTON_MASK_RIGHT":                     2,
+	"BUTTON_MIDDLE":                         3,
+	"BUTTON_RIGHT":                          2,
+	"BUTTON_WHEEL_DOWN":                     5,
+	"BUTTON_WHEEL_LEFT":                     6,
+	"BUTTON_WHEEL_RIGHT":                    7,
+	"BUTTON_WHEEL_UP":                       4,
+	"JOY_ANALOG_L2":                         6,
+	"JOY_ANALOG_LX":                         0,
+	"JOY_ANALOG_LY":                         1,
+	"JOY_ANALOG_R2":                         7,
+	"JOY_ANALOG_RX":                         2,
+	"JOY_ANALOG_RY":                         3,
+	"JOY_AXIS_0":                            0,
+	"JOY_AXIS_1":                            1,
+	"JOY_AXIS_2":                            2,
+	"JOY_AXIS_3":                            3,
+	"JOY_AXIS_4":                            4,
+	"JOY_AXIS_5":                            5,
+	"JOY_AXIS_6":                            6,
+	"JOY_AXIS_7":                            7,
+	"JOY_AXIS_8":                            8,
+	"JOY_AXIS_9":                            9,
+	"JOY_AXIS_MAX":                          10,
+	"JOY_BUTTON_0":                          0,
+	"JOY_BUTTON_1":                          1,
+	"JOY_BUTTON_10":                         10,
+	"JOY_BUTTON_11":                         11,
+	"JOY_BUTTON_12":                         12,
+	"JOY_BUTTON_13":                         13,
+	"JOY_BUTTON_14":                         14,
+	"JOY_BUTTON_15":                         15,
+	"JOY_BUTTON_2":                          2,
+	"JOY_BUTTON_3":                          3,
+	"JOY_BUTTON_4":                          4,
+	"JOY_BUTTON_5":                          5,
+	"JOY_BUTTON_6":                          6,
+	"JOY_BUTTON_7":                          7,
+	"JOY_BUTTON_8":                          8,
+	"JOY_BUTTON_9":                          9,
+	"JOY_BUTTON_MAX":                        16,
+	"JOY_DPAD_DOWN":                         13,
+	"JOY_DPAD_LEFT":                         14,
+	"JOY_DPAD_RIGHT":                        15,
+	"JOY_DPAD_UP":                           12,
+	"JOY_DS_A":                              1,
+	"JOY_DS_B":                              0,
+	"JOY_DS_X":                              3,
+	"JOY_DS_Y":                              2,
+	"JOY_L":                                 4,
+	"JOY_L2":                                6,
+	"JOY_L3":                                8,
+	"JOY_R":                                 5,
+	"JOY_R2":                                7,
+	"JOY_R3":                                9,
+	"JOY_SELECT":                            10,
+	"JOY_SONY_CIRCLE":                       1,
+	"JOY_SONY_SQUARE":                       2,
+	"JOY_SONY_TRIANGLE":                     3,
+	"JOY_SONY_X":                            0,
+	"JOY_START":                             11,
+	"JOY_XBOX_A":                            0,
+	"JOY_XBOX_B":                            1,
+	"JOY_XBOX_X":                            2,
+	"JOY_XBOX_Y":                            3,
+	"ERR_ALREADY_EXISTS":                    32,
+	"ERR_ALREADY_IN_USE":                    22,
+	"ERR_BUG":                               47,
+	"ERR_BUSY":                              44,
+	"ERR_CANT_ACQUIRE_RESOURCE":             28,
+	"ERR_CANT_CREATE":                       20,
+	"ERR_CANT_OPEN":                         19,
+	"ERR_COMPILATION_FAILED":                36,
+	"ERR_CYCLIC_LINK":                       40,
+	"ERR_DATABASE_CANT_READ":                34,
+	"ERR_DATABASE_CANT_WRITE":               35,
+	"ERR_DOES_NOT_EXIST":                    33,
+	"ERR_FILE_ALREADY_IN_USE":               11,
+	"ERR_FILE_BAD_DRIVE":                    8,
+	"ERR_FILE_BAD_PATH":                     9,
+	"ERR_FILE_CANT_OPEN":                    12,
+	"ERR_FILE_CANT_READ":                    14,
+	"ERR_FILE_CANT_WRITE":                   13,
+	"ERR_FILE_CORRUPT":                      16,
+	"ERR_FILE_EOF":                          18,
+	"ERR_FILE_MISSING_DEPENDENCIES":         17,
+	"ERR_FILE_NOT_FOUND":                    7,
+	"ERR_FILE_NO_PERMISSION":                10,
+	"ERR_FILE_UNRECOGNIZED":                 15,
+	"ERR_HELP":                              46,
+	"ERR_INVALID_DATA":                      30,
+	"ERR_INVALID_PARAMETER":                 31,
+	"ERR_LINK_FAILED":                       38,
+	"ERR_LOCKED":                            23,
+	"ERR_METHOD_NOT_FOUND":                  37,
+	"ERR_OUT_OF_MEMORY":                     6,
+	"ERR_PARAMETER_RANGE_ERROR":             5,
+	"ERR_PARSE_ERROR":                       43,
+	"ERR_QUERY_FAILED":                      21,
+	"ERR_SCRIPT_FAILED":                     39,
+	"ERR_TIMEOUT":                           24,
+	"ERR_UNAUTHORIZED":                      4,
+	"ERR_UNAVAILABLE":                       2,
+	"ERR_UNCONFIGURED":                      3,
+	"FAILED":                                1,
+	"OK":                                    0,
+	"PROPERTY_HINT_COLOR_NO_ALPHA":          19,
+	"PROPERTY_HINT_DIR":                     14,
+	"PROPERTY_HINT_ENUM":                    3,
+	"PROPERTY_HINT_EXP_EASING":              4,
+	"PROPERTY_HINT_EXP_RANGE":               2,
+	"PROPERTY_HINT_FILE":                    13,
+	"PROPERTY_HINT_FLAGS":                   8,
+	"PROPERTY_HINT_GLOBAL_DIR":              16,
+	"PROPERTY_HINT_GLOBAL_FILE":             15,
+	"PROPERTY_HINT_IMAGE_COMPRESS_LOSSLESS": 21,
+	"PROPERTY_HINT_IMAGE_COMPRESS_LOSSY":    20,
+	"PROPERTY_HINT_KEY_ACCEL":               7,
+	"PROPERTY_HINT_LAYERS_2D_PHYSICS":       10,
+	"PROPERTY_HINT_LAYERS_2D_RENDER":        9,
+	"PROPERTY_HINT_LAYERS_3D_PHYSICS":       12,
+	"PROPERTY_HINT_LAYERS_3D_RENDER":        11,
+	"PROPERTY_HINT_LENGTH":                  5,
+	"PROPERTY_HINT_MULTILINE_TEXT":          18,
+	"PROPERTY_HINT_NONE":                    0,
+	"PROPERTY_HINT_RANGE":                   1,
+	"PROPERTY_HINT_RESOURCE_TYPE":           17,
+	"PROPERTY_USAGE_CATEGORY":               256,
+	"PROPERTY_USAGE_CHECKABLE":              16,
+	"PROPERTY_USAGE_CHECKED":                32,
+	"PROPERTY_USAGE_DEFAULT":                7,
+	"PROPERTY_USAGE_DEFAULT_INTL":           71,
+	"PROPERTY_USAGE_EDITOR":                 2,
+	"PROPERTY_USAGE_EDITOR_HELPER":          8,
+	"PROPERTY_USAGE_GROUP":                  128,
+	"PROPERTY_USAGE_INTERNATIONALIZED":      64,
+	"PROPERTY_USAGE_NETWORK":                4,
+	"PROPERTY_USAGE_NOEDITOR":               5,
+	"PROPERTY_USAGE_NO_INSTANCE_STATE":      2048,
+	"PROPERTY_USAGE_RESTART_IF_CHANGED":     4096,
+	"PROPERTY_USAGE_SCRIPT_VARIABLE":        8192,
+	"PROPERTY_USAGE_STORAGE":                1,
+	"PROPERTY_USAGE_STORE_IF_NONONE":        1024,
+	"PROPERTY_USAGE_STORE_IF_NONZERO":       512,
+	"METHOD_FLAGS_DEFAULT":                  1,
+	"METHOD_FLAG_CONST":                     8,
+	"METHOD_FLAG_EDITOR":                    2,
+	"METHOD_FLAG_FROM_SCRIPT":               64,
+	"METHOD_FLAG_NORMAL":                    1,
+	"METHOD_FLAG_NOSCRIPT":                  4,
+	"METHOD_FLAG_REVERSE":                   16,
+	"METHOD_FLAG_VIRTUAL":                   32,
+	"TYPE_AABB":                             11,
+	"TYPE_ARRAY":                            19,
+	"TYPE_BASIS":                            12,
+	"TYPE_BOOL":                             1,
+	"TYPE_COLOR":                            14,
+	"TYPE_COLOR_ARRAY":                      26,
+	"TYPE_DICTIONARY":                       18,
+	"TYPE_INT":                              2,
+	"TYPE_INT_ARRAY":                        21,
+	"TYPE_MAX":                              27,
+	"TYPE_NIL":                              0,
+	"TYPE_NODE_PATH":                        15,
+	"TYPE_OBJECT":                           17,
+	"TYPE_PLANE":                            9,
+	"TYPE_QUAT":                             10,
+	"TYPE_RAW_ARRAY":                        20,
+	"TYPE_REAL":                             3,
+	"TYPE_REAL_ARRAY":                       22,
+	"TYPE_RECT2":                            6,
+	"TYPE_RID":                              16,
+	"TYPE_STRING":                           4,
+	"TYPE_STRING_ARRAY":                     23,
+	"TYPE_TRANSFORM":                        13,
+	"TYPE_TRANSFORM2D":                      8,
+	"TYPE_VECTOR2":                          5,
+	"TYPE_VECTOR2_ARRAY":                    24,
+	"TYPE_VECTOR3":                          7,
+	"TYPE_VECTOR3_ARRAY":                    25,
+	"OP_ADD":                                6,
+	"OP_AND":                                20,
+	"OP_BIT_AND":                            16,
+	"OP_BIT_NEGATE":                         19,
+	"OP_BIT_OR":                             17,
+	"OP_BIT_XOR":                            18,
+	"OP_DIVIDE":                             9,
+	"OP_EQUAL":                              0,
+	"OP_GREATER":                            4,
+	"OP_GREATER_EQUAL":                      5,
+	"OP_IN":                                 24,
+	"OP_LESS":                               2,
+	"OP_LESS_EQUAL":                         3,
+	"OP_MAX":                                25,
+	"OP_MODULE":                             12,
+	"OP_MULTIPLY":                           8,
+	"OP_NEGATE":                             10,
+	"OP_NOT":                                23,
+	"OP_NOT_EQUAL":                          1,
+	"OP_OR":                                 21,
+	"OP_POSITIVE":                           11,
+	"OP_SHIFT_LEFT":                         14,
+	"OP_SHIFT_RIGHT":                        15,
+	"OP_STRING_CONCAT":                      13,
+	"OP_SUBTRACT":                           7,
+	"OP_XOR":                                22,
+	"SPKEY":                                 16777216,
+}
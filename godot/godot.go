@@ -25,9 +25,17 @@ func init() {
 	// Configure GDNative to use our own NativeScript init function.
 	gdnative.SetNativeScriptInit(
 		configureLogging,
+		registerInstanceBindings,
+		checkGlobalConstants,
 		registerClasses,
 		autoRegisterClasses,
 	)
+
+	// Free the objects we created before the library is unloaded.
+	gdnative.SetNativeScriptTerminate(
+		freeDispatcher,
+		freeSignalTrampolineScript,
+	)
 }
 
 // configureLogging will set up the Go logger to output to the Godot console log.
@@ -37,33 +45,12 @@ func configureLogging() {
 	log.SetOutput(Log)
 }
 
-// registerClasses will loop through all classes to register and register them
-// with Godot. It is the caller's responsibility for registering properties and
-// methods on this class.
+// registerClasses will loop through all class builders to register and register
+// them with Godot, along with the methods, properties and signals that were added
+// to them.
 func registerClasses() {
-	for classString, constructor := range godotConstructorsToRegister {
-		if debug {
-			log.Println("Registering class:", classString)
-		}
-		// Call the constructor to get the BaseClass
-		class := constructor()
-
-		// Get the type of the class so we can register it.
-		classType := reflect.TypeOf(class)
-
-		// Create a registered class structure that will hold information about the
-		// cass and its methods.
-		regClass := newRegisteredClass(classType)
-
-		// Register our class in our Go registry.
-		classRegistry[classString] = regClass
-
-		// Set up our constructor and destructor function structs.
-		createFunc := createConstructor(classString, constructor)
-		destroyFunc := createDestructor(classString)
-
-		// Register our class with Godot.
-		gdnative.NativeScript.RegisterClass(classString, class.BaseClass(), createFunc, destroyFunc)
+	for _, builder := range godotClassesToRegister {
+		builder.register()
 	}
 }
 
@@ -92,8 +79,15 @@ func autoRegisterClasses() {
 		// cass and its methods.
 		regClass := newRegisteredClass(classType)
 
+		// Get the documentation and argument names of the class, if it provides
+		// them.
+		docs := classDocs(class)
+		argNames := classArgNames(class)
+		rpcModes := classRPCModes(class)
+		argDefaults := classArgDefaults(class)
+
 		// Call the "BaseClass" method on the class to get the base class.
-		baseClass := class.(Class).BaseClass()
+		baseClass := class.BaseClass()
 		if debug {
 			log.Println("  Using Base Class:", baseClass)
 		}
@@ -102,8 +96,18 @@ func autoRegisterClasses() {
 		createFunc := createConstructor(classString, constructor)
 		destroyFunc := createDestructor(classString)
 
-		// Register our class with Godot.
-		gdnative.NativeScript.RegisterClass(classString, baseClass, createFunc, destroyFunc)
+		// Register our class with Godot. Tool classes will also be instanced
+		// inside the editor.
+		if isToolClass(class) {
+			if debug {
+				log.Println("  Registering as tool class")
+			}
+			gdnative.NativeScript.RegisterToolClass(classString, baseClass, createFunc, destroyFunc)
+		} else {
+			gdnative.NativeScript.RegisterClass(classString, baseClass, createFunc, destroyFunc)
+		}
+		gdnative.NativeScript.SetTypeTag(classString)
+		gdnative.NativeScript.SetClassDocumentation(classString, gdnative.String(docs[""]))
 
 		// Loop through our class's struct fields. We do this to register properties as well
 		// as find the embedded parent struct to ensure we don't register those methods.
@@ -143,58 +147,15 @@ func autoRegisterClasses() {
 				signalField := classValue.Elem().FieldByName(classField.Name)
 				signalValue := signalField.Interface().(Signal)
 
-				// Construct our GDNative Signal struct from the discovered
-				// signal object.
-				signal := &gdnative.Signal{}
-				signal.Name = gdnative.String(signalValue.Name)
-				signal.NumArgs = gdnative.Int(len(signalValue.Args))
-				signal.NumDefaultArgs = gdnative.Int(len(signalValue.DefaultArgs))
-				signal.Args = []gdnative.SignalArgument{}
-				signal.DefaultArgs = []gdnative.Variant{}
-
-				// Construct the arguments for our GDNative Signal struct
-				for _, argValue := range signalValue.Args {
-					var arg gdnative.SignalArgument
-					arg.Name = argValue.Name
-					arg.Hint = argValue.Hint
-					arg.HintString = argValue.HintString
-					arg.Usage = argValue.Usage
-
-					// Get the type of the arg value
-					argType := reflect.TypeOf(argValue.Value)
-					if isGodotClass(argType) {
-						arg.Type = gdnative.Int(gdnative.VariantTypeObject)
-					} else {
-						arg.Type = gdnative.Int(VariantTypeToConstant(argType))
-					}
-
-					signal.Args = append(signal.Args, arg)
-				}
-
-				// Construct the default arguments for our GDNative Signal struct
-				for _, argValue := range signalValue.DefaultArgs {
-					var variant gdnative.Variant
-
-					// Get the type of the arg value
-					argType := reflect.TypeOf(argValue)
-					if isGodotClass(argType) {
-						obj := argValue.(Class).GetBaseObject()
-						variant = gdnative.NewVariantObject(obj)
-					} else {
-						variant = GoTypeToVariant(reflect.ValueOf(argValue))
-					}
-
-					signal.DefaultArgs = append(signal.DefaultArgs, variant)
-				}
-
-				gdnative.NativeScript.RegisterSignal(classString, signal)
+				gdnative.NativeScript.RegisterSignal(classString, newGDNativeSignal(signalValue))
+				gdnative.NativeScript.SetSignalDocumentation(classString, signalValue.Name, gdnative.String(docs[classField.Name]))
 				continue
 			}
 
 			// Create our property getter/setter structs that we will register with Godot.
 			setPropertyFunc := createPropertySetter(classString, classField.Name, classField.Type)
 			getPropertyFunc := createPropertyGetter(classString, classField.Name, classField.Type)
-			propertyAttrs := createPropertyAttributes(classField)
+			propertyAttrs := createPropertyAttributes(classField, reflect.ValueOf(class).Elem().Field(i))
 
 			// Register the public property with Godot.
 			gdnative.NativeScript.RegisterProperty(
@@ -204,6 +165,7 @@ func autoRegisterClasses() {
 				setPropertyFunc,
 				getPropertyFunc,
 			)
+			gdnative.NativeScript.SetPropertyDocumentation(classString, classField.Name, gdnative.String(propertyDoc(classField, docs)))
 		}
 
 		// Loop through our class's methods that are attached to it.
@@ -235,6 +197,11 @@ func autoRegisterClasses() {
 			// Construct a registered method structure that inspects all of the
 			// arguments and return types.
 			regMethod := newRegisteredMethod(classMethod)
+			if defaults, ok := argDefaults[classMethod.Name]; ok {
+				if err := regMethod.setDefaults(defaults); err != nil {
+					Log.Error("Unable to set default arguments of ", classString, ".", classMethod.Name, ": ", err.Error())
+				}
+			}
 			regClass.addMethod(classMethod.Name, regMethod)
 			if debug {
 				log.Println("    Method Arguments:", len(regMethod.arguments))
@@ -243,14 +210,9 @@ func autoRegisterClasses() {
 				log.Println("    Method Returns:", regMethod.returns)
 			}
 
-			// Skip the method if its a Class interface method
-			skip := false
-			for _, exclude := range []string{"BaseClass", "GetBaseObject", "SetBaseObject"} {
-				if classMethod.Name == exclude {
-					skip = true
-				}
-			}
-			if skip {
+			// Skip the method if its a Class interface method, or one of the
+			// optional interfaces that describe the class.
+			if classInterfaceMethods[classMethod.Name] {
 				continue
 			}
 
@@ -267,8 +229,26 @@ func autoRegisterClasses() {
 				RPCType: gdnative.MethodRpcModeDisabled,
 			}
 
+			// Use the RPC mode of the method if the class has one for it, as long as
+			// its arguments can be sent over the network.
+			if rpcMode, ok := rpcModes[goMethodName]; ok {
+				if err := checkRPCMethod(regMethod); err != nil {
+					Log.Error("Unable to register method ", classString, ".", goMethodName, " for RPC: ", err.Error())
+				} else {
+					attributes.RPCType = rpcMode
+				}
+				delete(rpcModes, goMethodName)
+			}
+
 			// Register the method.
 			gdnative.NativeScript.RegisterMethod(classString, godotMethodName, attributes, method)
+			gdnative.NativeScript.SetMethodArgumentInformation(classString, godotMethodName, methodArguments(regMethod, argNames[goMethodName]))
+			gdnative.NativeScript.SetMethodDocumentation(classString, godotMethodName, gdnative.String(docs[goMethodName]))
+		}
+
+		// Any RPC modes that are left over do not belong to a registered method.
+		for goMethodName := range rpcModes {
+			Log.Error("Unable to set RPC mode of ", classString, ".", goMethodName, ": the class has no such method.")
 		}
 
 		// Register our class in our Go registry.
@@ -277,6 +257,19 @@ func autoRegisterClasses() {
 	}
 }
 
+// classInterfaceMethods is a set of methods that are used to describe a class to
+// AutoRegister, and will not be registered as Godot methods.
+var classInterfaceMethods = map[string]bool{
+	"BaseClass":     true,
+	"GetBaseObject": true,
+	"SetBaseObject": true,
+	"IsTool":        true,
+	"Doc":           true,
+	"ArgNames":      true,
+	"ArgDefaults":   true,
+	"RPCModes":      true,
+}
+
 // CreateConstructor will create the InstanceCreateFunc structure with the given class name
 // and constructor. This structure can be used when registering a class with Godot.
 func createConstructor(classString string, constructor ClassConstructor) *gdnative.InstanceCreateFunc {
@@ -290,10 +283,14 @@ func createConstructor(classString string, constructor ClassConstructor) *gdnati
 
 		// Add the Godot object pointer to the class structure.
 		class.SetBaseObject(object)
+		bindSignals(class)
 
 		// Add the instance to our instance registry.
 		InstanceRegistry.Add(object.ID(), class)
 
+		// Make sure funcs queued with RunOnMain can be run.
+		ensureDispatcher()
+
 		// Return the instance string. This will be passed to the method function as userData, so we
 		// can look up the instance in our registry.
 		return object.ID()
@@ -328,12 +325,13 @@ func createDestructor(classString string) *gdnative.InstanceDestroyFunc {
 func createMethod(classString, methodString string) *gdnative.InstanceMethod {
 	var methodFunc gdnative.InstanceMethod
 	methodFunc.Method = func(object gdnative.Object, classMethod, instanceString string, numArgs int, args []gdnative.Variant) gdnative.Variant {
-		var ret gdnative.Variant
+		// Make sure funcs queued with RunOnMain can be run.
+		ensureDispatcher()
 
 		// Get the object instance based on the instance string given in userData.
-		class, ok := InstanceRegistry.Get(instanceString)
+		class, ok := InstanceRegistry.getInstance("Method", classMethod, instanceString)
 		if !ok {
-			panic("Method " + classMethod + " was called on instance (" + instanceString + "), but does not exist in the instance registry!")
+			return gdnative.NewVariantNil()
 		}
 		classValue := reflect.ValueOf(class)
 
@@ -347,15 +345,6 @@ func createMethod(classString, methodString string) *gdnative.InstanceMethod {
 			log.Println("  instanceString (userData):", instanceString)
 		}
 
-		// Create a slice of Godot Variant arguments
-		goArgsSlice := []reflect.Value{}
-
-		// If we have arguments, append the first argument.
-		for _, arg := range args {
-			// Convert the variant into its base type
-			goArgsSlice = append(goArgsSlice, VariantToGoType(arg))
-		}
-
 		// Use the method string to get the class name and method name.
 		if debug {
 			log.Println("  Getting class name and method name...")
@@ -376,25 +365,32 @@ func createMethod(classString, methodString string) *gdnative.InstanceMethod {
 		}
 		regClass := classRegistry[className]
 		if regClass == nil {
-			log.Fatal("  This class has not been registered! Class name: ", className, " Method name: ", methodName)
+			Log.Error("This class has not been registered! Class name: ", className, " Method name: ", methodName)
+			return gdnative.NewVariantNil()
 		}
 		if debug {
 			log.Println("  Looked up class:", regClass)
 			log.Println("  Methods in class:", regClass.methods)
 		}
-		regMethod := regClass.methods[methodName]
+		regMethod, ok := regClass.methods[methodName]
+		if !ok {
+			Log.Error("This method has not been registered! Class name: ", className, " Method name: ", methodName)
+			return gdnative.NewVariantNil()
+		}
+
+		// Convert the arguments into the types the method takes, filling in
+		// default values and variadic arguments.
+		goArgsSlice, err := regMethod.callArgs(args)
+		if err != nil {
+			Log.Error("Invalid arguments passed to ", classMethod, ": ", err.Error())
+			return gdnative.NewVariantNil()
+		}
 
 		if debug {
 			log.Println("  Registered method arguments:", regMethod.arguments)
 			log.Println("  Arguments to pass:", goArgsSlice)
 		}
 
-		// Check to ensure the method has the same number of arguments we expect
-		if len(regMethod.arguments)-1 != int(numArgs) {
-			gdnative.Log.Error("Invalid number of arguments. Expected ", numArgs, " arguments. (Got ", len(regMethod.arguments), ")")
-			panic("Invalid number of arguments.")
-		}
-
 		// Get the value of the class, so we can call methods on it.
 		method := classValue.MethodByName(methodName)
 		rawRet := method.Call(goArgsSlice)
@@ -402,57 +398,8 @@ func createMethod(classString, methodString string) *gdnative.InstanceMethod {
 			log.Println("Got raw return value after method call:", rawRet)
 		}
 
-		// Check to see if this returns anything.
-		if len(rawRet) == 0 {
-			nilReturn := gdnative.NewVariantNil()
-			return nilReturn
-		}
-
-		// Convert our returned value into a Godot Variant.
-		rawRetInterface := rawRet[0].Interface()
-		switch regMethod.returns[0].String() {
-		case "bool":
-			base := gdnative.Bool(rawRetInterface.(bool))
-			variant := gdnative.NewVariantBool(base)
-			ret = variant
-		case "int64":
-			base := gdnative.Int64T(rawRetInterface.(int64))
-			variant := gdnative.NewVariantInt(base)
-			ret = variant
-		case "int32":
-			base := gdnative.Int64T(rawRetInterface.(int32))
-			variant := gdnative.NewVariantInt(base)
-			ret = variant
-		case "int":
-			base := gdnative.Int64T(rawRetInterface.(int))
-			variant := gdnative.NewVariantInt(base)
-			ret = variant
-		case "uint64":
-			base := gdnative.Uint64T(rawRetInterface.(uint64))
-			variant := gdnative.NewVariantUint(base)
-			ret = variant
-		case "uint32":
-			base := gdnative.Uint64T(rawRetInterface.(uint32))
-			variant := gdnative.NewVariantUint(base)
-			ret = variant
-		case "uint":
-			base := gdnative.Uint64T(rawRetInterface.(uint))
-			variant := gdnative.NewVariantUint(base)
-			ret = variant
-		case "float64":
-			base := gdnative.Double(rawRetInterface.(float64))
-			variant := gdnative.NewVariantReal(base)
-			ret = variant
-		case "string":
-			base := gdnative.WcharT(rawRetInterface.(string))
-			baseStr := base.AsString()
-			variant := gdnative.NewVariantString(baseStr)
-			ret = variant
-		default:
-			panic("The return was not valid. Should be Godot Variant or built-in Go type. Received: " + regMethod.returns[0].String())
-		}
-
-		return ret
+		// Convert our returned values into a Godot Variant.
+		return returnsToVariant(classMethod, rawRet)
 	}
 	methodFunc.MethodData = classString + "::" + methodString
 	methodFunc.FreeFunc = func(methodData string) {}
@@ -466,38 +413,24 @@ func createPropertySetter(classString, propertyString string, propertyType refle
 	var propertySetFunc gdnative.InstancePropertySet
 	propertySetFunc.SetFunc = func(object gdnative.Object, classProperty, instanceString string, property gdnative.Variant) {
 		// Get the object instance based on the instance string given in userData.
-		class, ok := InstanceRegistry.Get(instanceString)
+		class, ok := InstanceRegistry.getInstance("Set property", classProperty, instanceString)
 		if !ok {
-			panic("Set property " + classProperty + " was called on instance (" + instanceString + "), but does not exist in the instance registry!")
+			return
 		}
 
 		// Get the actual class value and the struct field of the property.
 		classValue := reflect.ValueOf(class)
 		propertyField := classValue.Elem().FieldByName(propertyString)
 
-		// Check to see what kind of type this is. If it is a Godot class,
-		// we need to convert our variant into an object.
-		if isGodotClass(propertyType) {
-
-			// Create the variant as an object so we can get its class type.
-			propAsObject := &Object{}
-			propAsObject.SetBaseObject(property.AsObject())
-			typeString := propAsObject.GetClass()
-
-			// Get the actual class object.
-			obj := getActualClass(typeString, propAsObject.GetBaseObject())
-			if debug {
-				log.Println("Setting property '" + classString + "." + propertyString + "' (" + instanceString + ") with type '" + string(typeString) + "' as Object with ID (" + obj.GetBaseObject().ID() + ")")
-			}
-			propertyField.Set(reflect.ValueOf(obj))
-			return
-		}
-
-		// Otherwise, this should be a base variant type.
+		// Convert the variant into the type of the struct field.
 		if debug {
-			log.Println("Setting property '" + classString + "." + propertyString + "' as a Variant on instance (" + instanceString + ")")
+			log.Println("Setting property '" + classString + "." + propertyString + "' on instance (" + instanceString + ")")
+		}
+		value, err := decodeValue(property, propertyType)
+		if err != nil {
+			Log.Error("Unable to set property ", classProperty, ": ", err)
+			return
 		}
-		value := VariantToGoType(property)
 		propertyField.Set(value)
 	}
 
@@ -515,32 +448,24 @@ func createPropertyGetter(classString, propertyString string, propertyType refle
 	var propertyGetFunc gdnative.InstancePropertyGet
 	propertyGetFunc.GetFunc = func(object gdnative.Object, classProperty, instanceString string) gdnative.Variant {
 		// Get the object instance based on the instance string given in userData.
-		class, ok := InstanceRegistry.Get(instanceString)
+		class, ok := InstanceRegistry.getInstance("Get property", classProperty, instanceString)
 		if !ok {
-			panic("Get property " + classProperty + " was called on instance (" + instanceString + "), but does not exist in the instance registry!")
+			return gdnative.NewVariantNil()
 		}
 		classValue := reflect.ValueOf(class)
-		propertyField := classValue.Elem().FieldByName(classProperty)
-
-		// Check to see what kind of type this is. If it is a Godot class,
-		// we need to convert our object into a variant.
-		if isGodotClass(propertyType) {
-			if debug {
-				log.Println("Getting property '" + classString + "." + propertyString + "' as Object on instance (" + instanceString + ")")
-			}
-
-			// Get the value of the field as an interface.
-			property := propertyField.Interface()
-
-			object := property.(ObjectImplementer).GetBaseObject()
-			return gdnative.NewVariantObject(object)
-		}
+		propertyField := classValue.Elem().FieldByName(propertyString)
 
-		// Otherwise, convert the property to a base variant.
+		// Convert the struct field into a variant.
 		if debug {
-			log.Println("Getting property '" + classString + "." + propertyString + "' as a Variant on instance (" + instanceString + ")")
+			log.Println("Getting property '" + classString + "." + propertyString + "' on instance (" + instanceString + ")")
+		}
+		variant, err := encodeValue(propertyField)
+		if err != nil {
+			Log.Error("Unable to get property ", classProperty, ": ", err)
+			return gdnative.NewVariantNil()
 		}
-		return GoTypeToVariant(propertyField)
+
+		return variant
 	}
 	propertyGetFunc.MethodData = classString + "::" + propertyString
 	propertyGetFunc.FreeFunc = func(methodData string) {}
@@ -548,13 +473,15 @@ func createPropertyGetter(classString, propertyString string, propertyType refle
 	return &propertyGetFunc
 }
 
-func createPropertyAttributes(field reflect.StructField) *gdnative.PropertyAttributes {
+// createPropertyAttributes will create the property attributes of the given struct
+// field. The value is the field of an instance created by the class constructor,
+// and is used as the default value of the property.
+func createPropertyAttributes(field reflect.StructField, value reflect.Value) *gdnative.PropertyAttributes {
 	// Create our property attributes struct that we will fill.
 	var propertyAttrs gdnative.PropertyAttributes
 
-	// Set the default value to nil.
-	// TODO: Figure out a way to let the user define a default value.
-	propertyAttrs.DefaultValue = gdnative.NewVariantNil()
+	// Set the default value from the `default` tag or the constructor.
+	propertyAttrs.DefaultValue = propertyDefault(field, value)
 
 	// Inspect the struct field for any tags. We will use this for setting the
 	// usage, hint, hint string, etc. If none are found, defaults will be used.
@@ -572,7 +499,8 @@ func createPropertyAttributes(field reflect.StructField) *gdnative.PropertyAttri
 			for key, _ := range gdnative.MethodRpcModeLookupMap {
 				validTypes += " " + strings.Replace(key, "MethodRpcMode", "", 1)
 			}
-			panic("rset_type must be one of the following: " + validTypes)
+			Log.Error("The rset_type tag of property ", field.Name, " must be one of the following:", validTypes)
+			propertyAttrs.RsetType = gdnative.MethodRpcModeDisabled
 		}
 	} else {
 		propertyAttrs.RsetType = gdnative.MethodRpcModeDisabled
@@ -586,7 +514,8 @@ func createPropertyAttributes(field reflect.StructField) *gdnative.PropertyAttri
 			for key, _ := range gdnative.PropertyUsageFlagsLookupMap {
 				validTypes += " " + strings.Replace(key, "PropertyUsage", "", 1)
 			}
-			panic("usage must be one of the following: " + validTypes)
+			Log.Error("The usage tag of property ", field.Name, " must be one of the following:", validTypes)
+			propertyAttrs.Usage = gdnative.PropertyUsageDefault
 		}
 	} else {
 		propertyAttrs.Usage = gdnative.PropertyUsageDefault
@@ -600,7 +529,8 @@ func createPropertyAttributes(field reflect.StructField) *gdnative.PropertyAttri
 			for key, _ := range gdnative.PropertyHintLookupMap {
 				validTypes += " " + strings.Replace(key, "PropertyHint", "", 1)
 			}
-			panic("hint must be one of the following: " + validTypes)
+			Log.Error("The hint tag of property ", field.Name, " must be one of the following:", validTypes)
+			propertyAttrs.Hint = gdnative.PropertyHintNone
 		}
 	} else {
 		propertyAttrs.Hint = gdnative.PropertyHintNone
@@ -681,132 +611,230 @@ func VariantToGoType(variant gdnative.Variant) reflect.Value {
 }
 
 // GoTypeToVariant will check the given Go type and convert it to its
-// Variant type. The value is returned as a gdnative.Variant.
+// Variant type. The value is returned as a gdnative.Variant. Types that have no
+// Variant type are logged with Log.Error and returned as Nil.
 func GoTypeToVariant(value reflect.Value) gdnative.Variant {
+	if variant, ok := goTypeToVariant(value); ok {
+		return variant
+	}
+	Log.Error("Unknown type of godot argument: ", value.Type().String())
+	return gdnative.NewVariantNil()
+}
+
+// goTypeToVariant will convert the given gdnative value into a Variant, and
+// return false if its type has no Variant type.
+func goTypeToVariant(value reflect.Value) (gdnative.Variant, bool) {
 	valueInterface := value.Interface()
 	switch v := valueInterface.(type) {
 	case gdnative.Bool:
-		return gdnative.NewVariantBool(v)
+		return gdnative.NewVariantBool(v), true
 	case gdnative.Int:
-		return gdnative.NewVariantInt(gdnative.Int64T(v))
+		return gdnative.NewVariantInt(gdnative.Int64T(v)), true
 	case gdnative.Int64T:
-		return gdnative.NewVariantInt(v)
+		return gdnative.NewVariantInt(v), true
 	case gdnative.Double:
-		return gdnative.NewVariantReal(v)
+		return gdnative.NewVariantReal(v), true
 	case gdnative.Real:
-		return gdnative.NewVariantReal(gdnative.Double(v))
+		return gdnative.NewVariantReal(gdnative.Double(v)), true
 	case gdnative.String:
-		return gdnative.NewVariantString(v)
+		return gdnative.NewVariantString(v), true
 	case gdnative.Vector2:
-		return gdnative.NewVariantVector2(v)
+		return gdnative.NewVariantVector2(v), true
 	case gdnative.Rect2:
-		return gdnative.NewVariantRect2(v)
+		return gdnative.NewVariantRect2(v), true
 	case gdnative.Vector3:
-		return gdnative.NewVariantVector3(v)
+		return gdnative.NewVariantVector3(v), true
 	case gdnative.Transform2D:
-		return gdnative.NewVariantTransform2D(v)
+		return gdnative.NewVariantTransform2D(v), true
 	case gdnative.Plane:
-		return gdnative.NewVariantPlane(v)
+		return gdnative.NewVariantPlane(v), true
 	case gdnative.Quat:
-		return gdnative.NewVariantQuat(v)
+		return gdnative.NewVariantQuat(v), true
 	case gdnative.Aabb:
-		return gdnative.NewVariantAabb(v)
+		return gdnative.NewVariantAabb(v), true
 	case gdnative.Basis:
-		return gdnative.NewVariantBasis(v)
+		return gdnative.NewVariantBasis(v), true
 	case gdnative.Transform:
-		return gdnative.NewVariantTransform(v)
+		return gdnative.NewVariantTransform(v), true
 	case gdnative.Color:
-		return gdnative.NewVariantColor(v)
+		return gdnative.NewVariantColor(v), true
 	case gdnative.NodePath:
-		return gdnative.NewVariantNodePath(v)
+		return gdnative.NewVariantNodePath(v), true
 	case gdnative.Rid:
-		return gdnative.NewVariantRid(v)
+		return gdnative.NewVariantRid(v), true
 	case gdnative.Object:
-		return gdnative.NewVariantObject(v)
+		return gdnative.NewVariantObject(v), true
 	case gdnative.Dictionary:
-		return gdnative.NewVariantDictionary(v)
+		return gdnative.NewVariantDictionary(v), true
 	case gdnative.Array:
-		return gdnative.NewVariantArray(v)
+		return gdnative.NewVariantArray(v), true
 	case gdnative.PoolByteArray:
-		return gdnative.NewVariantPoolByteArray(v)
+		return gdnative.NewVariantPoolByteArray(v), true
 	case gdnative.PoolIntArray:
-		return gdnative.NewVariantPoolIntArray(v)
+		return gdnative.NewVariantPoolIntArray(v), true
 	case gdnative.PoolRealArray:
-		return gdnative.NewVariantPoolRealArray(v)
+		return gdnative.NewVariantPoolRealArray(v), true
 	case gdnative.PoolStringArray:
-		return gdnative.NewVariantPoolStringArray(v)
+		return gdnative.NewVariantPoolStringArray(v), true
 	case gdnative.PoolVector2Array:
-		return gdnative.NewVariantPoolVector2Array(v)
+		return gdnative.NewVariantPoolVector2Array(v), true
 	case gdnative.PoolVector3Array:
-		return gdnative.NewVariantPoolVector3Array(v)
+		return gdnative.NewVariantPoolVector3Array(v), true
 	case gdnative.PoolColorArray:
-		return gdnative.NewVariantPoolColorArray(v)
+		return gdnative.NewVariantPoolColorArray(v), true
+	}
+	return gdnative.Variant{}, false
+}
+
+// errorType is the reflected type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// returnsToVariant will convert the values returned by a Go method into the
+// single Variant that is returned to Godot:
+//
+//   - No return values will return Nil.
+//   - If the last return value is an error, it is not returned to Godot. If the
+//     error is not nil, it will be logged with Log.Error and Nil will be returned.
+//   - A single remaining value will be converted with the Variant codec.
+//   - Multiple remaining values will be converted and packed into an Array.
+func returnsToVariant(methodName string, returns []reflect.Value) gdnative.Variant {
+	if len(returns) > 0 {
+		last := returns[len(returns)-1]
+		if last.Type() == errorType {
+			if !last.IsNil() {
+				Log.Error(methodName, " returned an error: ", last.Interface().(error).Error())
+				return gdnative.NewVariantNil()
+			}
+			returns = returns[:len(returns)-1]
+		}
 	}
-	panic("Unknown type of godot argument: " + value.String())
+
+	var value reflect.Value
+	switch len(returns) {
+	case 0:
+		return gdnative.NewVariantNil()
+	case 1:
+		value = returns[0]
+	default:
+		values := make([]interface{}, len(returns))
+		for i, ret := range returns {
+			values[i] = ret.Interface()
+		}
+		value = reflect.ValueOf(values)
+	}
+
+	variant, err := encodeValue(value)
+	if err != nil {
+		Log.Error("Invalid value returned by ", methodName, ": ", err)
+		return gdnative.NewVariantNil()
+	}
+
+	return variant
 }
 
 // VariantTypeToConstant will check the given field to see what kind of variant
-// it is and return its type as a VariantType int. This will panic if the type
-// is not a valid Godot type.
+// it is and return its type as a VariantType int. Go types that are not
+// gdnative types return the variant type they are converted into by the Variant
+// codec. This will panic if the type can't be converted into a Variant.
 func VariantTypeToConstant(t reflect.Type) gdnative.VariantType {
+	if variantType, ok := variantTypeOf(t); ok {
+		return variantType
+	}
+	if strings.HasPrefix(t.String(), "godot.") {
+		panic("Unknown type of exported godot field: " + t.String() + ". You probably need to use *" + t.String() + " or " + t.String() + "Implementer for this field.")
+	}
+	panic("Unknown type of exported godot field: " + t.String())
+}
+
+// variantTypeOf will return the variant type of the given type, and false if the
+// type can't be converted into a Variant.
+func variantTypeOf(t reflect.Type) (gdnative.VariantType, bool) {
 	switch t.String() {
 	case "gdnative.Bool":
-		return gdnative.VariantTypeBool
+		return gdnative.VariantTypeBool, true
 	case "gdnative.Int":
-		return gdnative.VariantTypeInt
+		return gdnative.VariantTypeInt, true
 	case "gdnative.Real":
-		return gdnative.VariantTypeReal
+		return gdnative.VariantTypeReal, true
 	case "gdnative.String":
-		return gdnative.VariantTypeString
+		return gdnative.VariantTypeString, true
 	case "gdnative.Vector2":
-		return gdnative.VariantTypeVector2
+		return gdnative.VariantTypeVector2, true
 	case "gdnative.Rect2":
-		return gdnative.VariantTypeRect2
+		return gdnative.VariantTypeRect2, true
 	case "gdnative.Vector3":
-		return gdnative.VariantTypeVector3
+		return gdnative.VariantTypeVector3, true
 	case "gdnative.Transform2D":
-		return gdnative.VariantTypeTransform2D
+		return gdnative.VariantTypeTransform2D, true
 	case "gdnative.Plane":
-		return gdnative.VariantTypePlane
+		return gdnative.VariantTypePlane, true
 	case "gdnative.Quat":
-		return gdnative.VariantTypeQuat
+		return gdnative.VariantTypeQuat, true
 	case "gdnative.Aabb":
-		return gdnative.VariantTypeAabb
+		return gdnative.VariantTypeAabb, true
 	case "gdnative.Basis":
-		return gdnative.VariantTypeBasis
+		return gdnative.VariantTypeBasis, true
 	case "gdnative.Transform":
-		return gdnative.VariantTypeTransform
+		return gdnative.VariantTypeTransform, true
 	case "gdnative.Color":
-		return gdnative.VariantTypeColor
+		return gdnative.VariantTypeColor, true
 	case "gdnative.NodePath":
-		return gdnative.VariantTypeNodePath
+		return gdnative.VariantTypeNodePath, true
 	case "gdnative.Rid":
-		return gdnative.VariantTypeRid
+		return gdnative.VariantTypeRid, true
 	case "gdnative.Object":
-		return gdnative.VariantTypeObject
+		return gdnative.VariantTypeObject, true
 	case "gdnative.Dictionary":
-		return gdnative.VariantTypeDictionary
+		return gdnative.VariantTypeDictionary, true
 	case "gdnative.Array":
-		return gdnative.VariantTypeArray
+		return gdnative.VariantTypeArray, true
 	case "gdnative.PoolByteArray":
-		return gdnative.VariantTypePoolByteArray
+		return gdnative.VariantTypePoolByteArray, true
 	case "gdnative.PoolIntArray":
-		return gdnative.VariantTypePoolIntArray
+		return gdnative.VariantTypePoolIntArray, true
 	case "gdnative.PoolRealArray":
-		return gdnative.VariantTypePoolRealArray
+		return gdnative.VariantTypePoolRealArray, true
 	case "gdnative.PoolStringArray":
-		return gdnative.VariantTypePoolStringArray
+		return gdnative.VariantTypePoolStringArray, true
 	case "gdnative.PoolVector2Array":
-		return gdnative.VariantTypePoolVector2Array
+		return gdnative.VariantTypePoolVector2Array, true
 	case "gdnative.PoolVector3Array":
-		return gdnative.VariantTypePoolVector3Array
+		return gdnative.VariantTypePoolVector3Array, true
 	case "gdnative.PoolColorArray":
-		return gdnative.VariantTypePoolColorArray
+		return gdnative.VariantTypePoolColorArray, true
 	}
-	if strings.HasPrefix(t.String(), "godot.") {
-		panic("Unknown type of exported godot field: " + t.String() + ". You probably need to use *" + t.String() + " or " + t.String() + "Implementer for this field.")
+
+	// Otherwise, use the variant type that the Variant codec converts the
+	// type into. Variants and empty interfaces can hold any type.
+	switch {
+	case t == variantType, t.Kind() == reflect.Interface && t.NumMethod() == 0:
+		return gdnative.VariantTypeNil, true
+	case t.Implements(classType):
+		return gdnative.VariantTypeObject, true
 	}
-	panic("Unknown type of exported godot field: " + t.String())
+	switch t.Kind() {
+	case reflect.Bool:
+		return gdnative.VariantTypeBool, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return gdnative.VariantTypeInt, true
+	case reflect.Float32, reflect.Float64:
+		return gdnative.VariantTypeReal, true
+	case reflect.String:
+		return gdnative.VariantTypeString, true
+	case reflect.Slice, reflect.Array:
+		return sliceVariantType(t), true
+	case reflect.Map:
+		return gdnative.VariantTypeDictionary, true
+	case reflect.Struct:
+		if t.PkgPath() != gdnativePath {
+			return gdnative.VariantTypeDictionary, true
+		}
+	case reflect.Ptr:
+		return variantTypeOf(t.Elem())
+	}
+
+	return gdnative.VariantTypeNil, false
 }
 
 // isGodotClass will check to see if the given type implements the ObjectImplementer
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,6 +24,32 @@ const (
 	ImageAlphaNone  ImageAlphaMode = 0
 )
 
+// String will return the Godot name of the ImageAlphaMode value.
+func (e ImageAlphaMode) String() string {
+	switch e {
+	case ImageAlphaBit:
+		return "ALPHA_BIT"
+	case ImageAlphaBlend:
+		return "ALPHA_BLEND"
+	case ImageAlphaNone:
+		return "ALPHA_NONE"
+	}
+	return fmt.Sprintf("ImageAlphaMode(%d)", int(e))
+}
+
+// ParseImageAlphaMode will return the ImageAlphaMode value with the given Godot name.
+func ParseImageAlphaMode(name string) (ImageAlphaMode, error) {
+	switch name {
+	case "ALPHA_BIT":
+		return ImageAlphaBit, nil
+	case "ALPHA_BLEND":
+		return ImageAlphaBlend, nil
+	case "ALPHA_NONE":
+		return ImageAlphaNone, nil
+	}
+	return 0, fmt.Errorf("invalid ImageAlphaMode value %q", name)
+}
+
 // ImageCompressMode is an enum for CompressMode values.
 type ImageCompressMode int
 
@@ -33,6 +61,40 @@ const (
 	ImageCompressS3Tc   ImageCompressMode = 0
 )
 
+// String will return the Godot name of the ImageCompressMode value.
+func (e ImageCompressMode) String() string {
+	switch e {
+	case ImageCompressEtc:
+		return "COMPRESS_ETC"
+	case ImageCompressEtc2:
+		return "COMPRESS_ETC2"
+	case ImageCompressPvrtc2:
+		return "COMPRESS_PVRTC2"
+	case ImageCompressPvrtc4:
+		return "COMPRESS_PVRTC4"
+	case ImageCompressS3Tc:
+		return "COMPRESS_S3TC"
+	}
+	return fmt.Sprintf("ImageCompressMode(%d)", int(e))
+}
+
+// ParseImageCompressMode will return the ImageCompressMode value with the given Godot name.
+func ParseImageCompressMode(name string) (ImageCompressMode, error) {
+	switch name {
+	case "COMPRESS_ETC":
+		return ImageCompressEtc, nil
+	case "COMPRESS_ETC2":
+		return ImageCompressEtc2, nil
+	case "COMPRESS_PVRTC2":
+		return ImageCompressPvrtc2, nil
+	case "COMPRESS_PVRTC4":
+		return ImageCompressPvrtc4, nil
+	case "COMPRESS_S3TC":
+		return ImageCompressS3Tc, nil
+	}
+	return 0, fmt.Errorf("invalid ImageCompressMode value %q", name)
+}
+
 // ImageCompressSource is an enum for CompressSource values.
 type ImageCompressSource int
 
@@ -42,6 +104,32 @@ const (
 	ImageCompressSourceSrgb    ImageCompressSource = 1
 )
 
+// String will return the Godot name of the ImageCompressSource value.
+func (e ImageCompressSource) String() string {
+	switch e {
+	case ImageCompressSourceGeneric:
+		return "COMPRESS_SOURCE_GENERIC"
+	case ImageCompressSourceNormal:
+		return "COMPRESS_SOURCE_NORMAL"
+	case ImageCompressSourceSrgb:
+		return "COMPRESS_SOURCE_SRGB"
+	}
+	return fmt.Sprintf("ImageCompressSource(%d)", int(e))
+}
+
+// ParseImageCompressSource will return the ImageCompressSource value with the given Godot name.
+func ParseImageCompressSource(name string) (ImageCompressSource, error) {
+	switch name {
+	case "COMPRESS_SOURCE_GENERIC":
+		return ImageCompressSourceGeneric, nil
+	case "COMPRESS_SOURCE_NORMAL":
+		return ImageCompressSourceNormal, nil
+	case "COMPRESS_SOURCE_SRGB":
+		return ImageCompressSourceSrgb, nil
+	}
+	return 0, fmt.Errorf("invalid ImageCompressSource value %q", name)
+}
+
 // ImageFormat is an enum for Format values.
 type ImageFormat int
 
@@ -86,6 +174,172 @@ const (
 	ImageFormatRh         ImageFormat = 12
 )
 
+// String will return the Godot name of the ImageFormat value.
+func (e ImageFormat) String() string {
+	switch e {
+	case ImageFormatBptcRgba:
+		return "FORMAT_BPTC_RGBA"
+	case ImageFormatBptcRgbf:
+		return "FORMAT_BPTC_RGBF"
+	case ImageFormatBptcRgbfu:
+		return "FORMAT_BPTC_RGBFU"
+	case ImageFormatDxt1:
+		return "FORMAT_DXT1"
+	case ImageFormatDxt3:
+		return "FORMAT_DXT3"
+	case ImageFormatDxt5:
+		return "FORMAT_DXT5"
+	case ImageFormatEtc:
+		return "FORMAT_ETC"
+	case ImageFormatEtc2R11:
+		return "FORMAT_ETC2_R11"
+	case ImageFormatEtc2R11S:
+		return "FORMAT_ETC2_R11S"
+	case ImageFormatEtc2Rg11:
+		return "FORMAT_ETC2_RG11"
+	case ImageFormatEtc2Rg11S:
+		return "FORMAT_ETC2_RG11S"
+	case ImageFormatEtc2Rgb8:
+		return "FORMAT_ETC2_RGB8"
+	case ImageFormatEtc2Rgb8A1:
+		return "FORMAT_ETC2_RGB8A1"
+	case ImageFormatEtc2Rgba8:
+		return "FORMAT_ETC2_RGBA8"
+	case ImageFormatL8:
+		return "FORMAT_L8"
+	case ImageFormatLa8:
+		return "FORMAT_LA8"
+	case ImageFormatMax:
+		return "FORMAT_MAX"
+	case ImageFormatPvrtc2:
+		return "FORMAT_PVRTC2"
+	case ImageFormatPvrtc2A:
+		return "FORMAT_PVRTC2A"
+	case ImageFormatPvrtc4:
+		return "FORMAT_PVRTC4"
+	case ImageFormatPvrtc4A:
+		return "FORMAT_PVRTC4A"
+	case ImageFormatR8:
+		return "FORMAT_R8"
+	case ImageFormatRf:
+		return "FORMAT_RF"
+	case ImageFormatRg8:
+		return "FORMAT_RG8"
+	case ImageFormatRgb8:
+		return "FORMAT_RGB8"
+	case ImageFormatRgba4444:
+		return "FORMAT_RGBA4444"
+	case ImageFormatRgba5551:
+		return "FORMAT_RGBA5551"
+	case ImageFormatRgba8:
+		return "FORMAT_RGBA8"
+	case ImageFormatRgbaf:
+		return "FORMAT_RGBAF"
+	case ImageFormatRgbah:
+		return "FORMAT_RGBAH"
+	case ImageFormatRgbe9995:
+		return "FORMAT_RGBE9995"
+	case ImageFormatRgbf:
+		return "FORMAT_RGBF"
+	case ImageFormatRgbh:
+		return "FORMAT_RGBH"
+	case ImageFormatRgf:
+		return "FORMAT_RGF"
+	case ImageFormatRgh:
+		return "FORMAT_RGH"
+	case ImageFormatRgtcR:
+		return "FORMAT_RGTC_R"
+	case ImageFormatRgtcRg:
+		return "FORMAT_RGTC_RG"
+	case ImageFormatRh:
+		return "FORMAT_RH"
+	}
+	return fmt.Sprintf("ImageFormat(%d)", int(e))
+}
+
+// ParseImageFormat will return the ImageFormat value with the given Godot name.
+func ParseImageFormat(name string) (ImageFormat, error) {
+	switch name {
+	case "FORMAT_BPTC_RGBA":
+		return ImageFormatBptcRgba, nil
+	case "FORMAT_BPTC_RGBF":
+		return ImageFormatBptcRgbf, nil
+	case "FORMAT_BPTC_RGBFU":
+		return ImageFormatBptcRgbfu, nil
+	case "FORMAT_DXT1":
+		return ImageFormatDxt1, nil
+	case "FORMAT_DXT3":
+		return ImageFormatDxt3, nil
+	case "FORMAT_DXT5":
+		return ImageFormatDxt5, nil
+	case "FORMAT_ETC":
+		return ImageFormatEtc, nil
+	case "FORMAT_ETC2_R11":
+		return ImageFormatEtc2R11, nil
+	case "FORMAT_ETC2_R11S":
+		return ImageFormatEtc2R11S, nil
+	case "FORMAT_ETC2_RG11":
+		return ImageFormatEtc2Rg11, nil
+	case "FORMAT_ETC2_RG11S":
+		return ImageFormatEtc2Rg11S, nil
+	case "FORMAT_ETC2_RGB8":
+		return ImageFormatEtc2Rgb8, nil
+	case "FORMAT_ETC2_RGB8A1":
+		return ImageFormatEtc2Rgb8A1, nil
+	case "FORMAT_ETC2_RGBA8":
+		return ImageFormatEtc2Rgba8, nil
+	case "FORMAT_L8":
+		return ImageFormatL8, nil
+	case "FORMAT_LA8":
+		return ImageFormatLa8, nil
+	case "FORMAT_MAX":
+		return ImageFormatMax, nil
+	case "FORMAT_PVRTC2":
+		return ImageFormatPvrtc2, nil
+	case "FORMAT_PVRTC2A":
+		return ImageFormatPvrtc2A, nil
+	case "FORMAT_PVRTC4":
+		return ImageFormatPvrtc4, nil
+	case "FORMAT_PVRTC4A":
+		return ImageFormatPvrtc4A, nil
+	case "FORMAT_R8":
+		return ImageFormatR8, nil
+	case "FORMAT_RF":
+		return ImageFormatRf, nil
+	case "FORMAT_RG8":
+		return ImageFormatRg8, nil
+	case "FORMAT_RGB8":
+		return ImageFormatRgb8, nil
+	case "FORMAT_RGBA4444":
+		return ImageFormatRgba4444, nil
+	case "FORMAT_RGBA5551":
+		return ImageFormatRgba5551, nil
+	case "FORMAT_RGBA8":
+		return ImageFormatRgba8, nil
+	case "FORMAT_RGBAF":
+		return ImageFormatRgbaf, nil
+	case "FORMAT_RGBAH":
+		return ImageFormatRgbah, nil
+	case "FORMAT_RGBE9995":
+		return ImageFormatRgbe9995, nil
+	case "FORMAT_RGBF":
+		return ImageFormatRgbf, nil
+	case "FORMAT_RGBH":
+		return ImageFormatRgbh, nil
+	case "FORMAT_RGF":
+		return ImageFormatRgf, nil
+	case "FORMAT_RGH":
+		return ImageFormatRgh, nil
+	case "FORMAT_RGTC_R":
+		return ImageFormatRgtcR, nil
+	case "FORMAT_RGTC_RG":
+		return ImageFormatRgtcRg, nil
+	case "FORMAT_RH":
+		return ImageFormatRh, nil
+	}
+	return 0, fmt.Errorf("invalid ImageFormat value %q", name)
+}
+
 // ImageInterpolation is an enum for Interpolation values.
 type ImageInterpolation int
 
@@ -95,13 +349,30 @@ const (
 	ImageInterpolateNearest  ImageInterpolation = 0
 )
 
-//func NewImageFromPointer(ptr gdnative.Pointer) Image {
-func newImageFromPointer(ptr gdnative.Pointer) Image {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Image{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ImageInterpolation value.
+func (e ImageInterpolation) String() string {
+	switch e {
+	case ImageInterpolateBilinear:
+		return "INTERPOLATE_BILINEAR"
+	case ImageInterpolateCubic:
+		return "INTERPOLATE_CUBIC"
+	case ImageInterpolateNearest:
+		return "INTERPOLATE_NEAREST"
+	}
+	return fmt.Sprintf("ImageInterpolation(%d)", int(e))
+}
 
-	return obj
+// ParseImageInterpolation will return the ImageInterpolation value with the given Godot name.
+func ParseImageInterpolation(name string) (ImageInterpolation, error) {
+	switch name {
+	case "INTERPOLATE_BILINEAR":
+		return ImageInterpolateBilinear, nil
+	case "INTERPOLATE_CUBIC":
+		return ImageInterpolateCubic, nil
+	case "INTERPOLATE_NEAREST":
+		return ImageInterpolateNearest, nil
+	}
+	return 0, fmt.Errorf("invalid ImageInterpolation value %q", name)
 }
 
 /*
@@ -112,13 +383,82 @@ type Image struct {
 	owner gdnative.Object
 }
 
+// classConstructorImage is the class constructor of the Image class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorImage = gdnative.NewLazyClassConstructor("Image")
+
+// NewImage will create a new instance of the Image class.
+// Image is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewImage() *Image {
+	obj := &Image{}
+	obj.SetBaseObject(classConstructorImage.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Image) BaseClass() string {
 	return "Image"
 }
 
+// Method binds of the Image class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindImageX_GetData         = gdnative.NewLazyMethodBind("Image", "_get_data")
+	methodBindImageX_SetData         = gdnative.NewLazyMethodBind("Image", "_set_data")
+	methodBindImageBlendRect         = gdnative.NewLazyMethodBind("Image", "blend_rect")
+	methodBindImageBlendRectMask     = gdnative.NewLazyMethodBind("Image", "blend_rect_mask")
+	methodBindImageBlitRect          = gdnative.NewLazyMethodBind("Image", "blit_rect")
+	methodBindImageBlitRectMask      = gdnative.NewLazyMethodBind("Image", "blit_rect_mask")
+	methodBindImageClearMipmaps      = gdnative.NewLazyMethodBind("Image", "clear_mipmaps")
+	methodBindImageCompress          = gdnative.NewLazyMethodBind("Image", "compress")
+	methodBindImageConvert           = gdnative.NewLazyMethodBind("Image", "convert")
+	methodBindImageCopyFrom          = gdnative.NewLazyMethodBind("Image", "copy_from")
+	methodBindImageCreate            = gdnative.NewLazyMethodBind("Image", "create")
+	methodBindImageCreateFromData    = gdnative.NewLazyMethodBind("Image", "create_from_data")
+	methodBindImageCrop              = gdnative.NewLazyMethodBind("Image", "crop")
+	methodBindImageDecompress        = gdnative.NewLazyMethodBind("Image", "decompress")
+	methodBindImageDetectAlpha       = gdnative.NewLazyMethodBind("Image", "detect_alpha")
+	methodBindImageExpandX2Hq2X      = gdnative.NewLazyMethodBind("Image", "expand_x2_hq2x")
+	methodBindImageFill              = gdnative.NewLazyMethodBind("Image", "fill")
+	methodBindImageFixAlphaEdges     = gdnative.NewLazyMethodBind("Image", "fix_alpha_edges")
+	methodBindImageFlipX             = gdnative.NewLazyMethodBind("Image", "flip_x")
+	methodBindImageFlipY             = gdnative.NewLazyMethodBind("Image", "flip_y")
+	methodBindImageGenerateMipmaps   = gdnative.NewLazyMethodBind("Image", "generate_mipmaps")
+	methodBindImageGetData           = gdnative.NewLazyMethodBind("Image", "get_data")
+	methodBindImageGetFormat         = gdnative.NewLazyMethodBind("Image", "get_format")
+	methodBindImageGetHeight         = gdnative.NewLazyMethodBind("Image", "get_height")
+	methodBindImageGetMipmapOffset   = gdnative.NewLazyMethodBind("Image", "get_mipmap_offset")
+	methodBindImageGetPixel          = gdnative.NewLazyMethodBind("Image", "get_pixel")
+	methodBindImageGetRect           = gdnative.NewLazyMethodBind("Image", "get_rect")
+	methodBindImageGetSize           = gdnative.NewLazyMethodBind("Image", "get_size")
+	methodBindImageGetUsedRect       = gdnative.NewLazyMethodBind("Image", "get_used_rect")
+	methodBindImageGetWidth          = gdnative.NewLazyMethodBind("Image", "get_width")
+	methodBindImageHasMipmaps        = gdnative.NewLazyMethodBind("Image", "has_mipmaps")
+	methodBindImageIsCompressed      = gdnative.NewLazyMethodBind("Image", "is_compressed")
+	methodBindImageIsEmpty           = gdnative.NewLazyMethodBind("Image", "is_empty")
+	methodBindImageIsInvisible       = gdnative.NewLazyMethodBind("Image", "is_invisible")
+	methodBindImageLoad              = gdnative.NewLazyMethodBind("Image", "load")
+	methodBindImageLoadJpgFromBuffer = gdnative.NewLazyMethodBind("Image", "load_jpg_from_buffer")
+	methodBindImageLoadPngFromBuffer = gdnative.NewLazyMethodBind("Image", "load_png_from_buffer")
+	methodBindImageLock              = gdnative.NewLazyMethodBind("Image", "lock")
+	methodBindImageNormalmapToXy     = gdnative.NewLazyMethodBind("Image", "normalmap_to_xy")
+	methodBindImagePremultiplyAlpha  = gdnative.NewLazyMethodBind("Image", "premultiply_alpha")
+	methodBindImageResize            = gdnative.NewLazyMethodBind("Image", "resize")
+	methodBindImageResizeToPo2       = gdnative.NewLazyMethodBind("Image", "resize_to_po2")
+	methodBindImageSavePng           = gdnative.NewLazyMethodBind("Image", "save_png")
+	methodBindImageSetPixel          = gdnative.NewLazyMethodBind("Image", "set_pixel")
+	methodBindImageShrinkX2          = gdnative.NewLazyMethodBind("Image", "shrink_x2")
+	methodBindImageSrgbToLinear      = gdnative.NewLazyMethodBind("Image", "srgb_to_linear")
+	methodBindImageUnlock            = gdnative.NewLazyMethodBind("Image", "unlock")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *Image) X_GetData() gdnative.Dictionary {
 	//log.Println("Calling Image.X_GetData()")
@@ -127,7 +467,7 @@ func (o *Image) X_GetData() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "_get_data")
+	methodBind := methodBindImageX_GetData.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -140,8 +480,8 @@ func (o *Image) X_GetData() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false data Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false data Dictionary}], Returns: void
 */
 func (o *Image) X_SetData(data gdnative.Dictionary) {
 	//log.Println("Calling Image.X_SetData()")
@@ -151,7 +491,7 @@ func (o *Image) X_SetData(data gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "_set_data")
+	methodBind := methodBindImageX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -161,20 +501,20 @@ func (o *Image) X_SetData(data gdnative.Dictionary) {
 }
 
 /*
-        Alpha-blends [code]src_rect[/code] from [code]src[/code] image to this image at coordinates [code]dest[/code].
-	Args: [{ false src Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
+	        Alpha-blends [code]src_rect[/code] from [code]src[/code] image to this image at coordinates [code]dest[/code].
+		Args: [{ false src Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
 */
 func (o *Image) BlendRect(src ImageImplementer, srcRect gdnative.Rect2, dst gdnative.Vector2) {
 	//log.Println("Calling Image.BlendRect()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(src.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(src))
 	ptrArguments[1] = gdnative.NewPointerFromRect2(srcRect)
 	ptrArguments[2] = gdnative.NewPointerFromVector2(dst)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "blend_rect")
+	methodBind := methodBindImageBlendRect.Get()
 
 	// Call the parent method.
 	// void
@@ -184,21 +524,21 @@ func (o *Image) BlendRect(src ImageImplementer, srcRect gdnative.Rect2, dst gdna
 }
 
 /*
-        Alpha-blends [code]src_rect[/code] from [code]src[/code] image to this image using [code]mask[/code] image at coordinates [code]dst[/code]. Alpha channels are required for both [code]src[/code] and [code]mask[/code]. [code]dst[/code] pixels and [code]src[/code] pixels will blend if the corresponding mask pixel's alpha value is not 0. [code]src[/code] image and [code]mask[/code] image [b]must[/b] have the same size (width and height) but they can have different formats.
-	Args: [{ false src Image} { false mask Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
+	        Alpha-blends [code]src_rect[/code] from [code]src[/code] image to this image using [code]mask[/code] image at coordinates [code]dst[/code]. Alpha channels are required for both [code]src[/code] and [code]mask[/code]. [code]dst[/code] pixels and [code]src[/code] pixels will blend if the corresponding mask pixel's alpha value is not 0. [code]src[/code] image and [code]mask[/code] image [b]must[/b] have the same size (width and height) but they can have different formats.
+		Args: [{ false src Image} { false mask Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
 */
 func (o *Image) BlendRectMask(src ImageImplementer, mask ImageImplementer, srcRect gdnative.Rect2, dst gdnative.Vector2) {
 	//log.Println("Calling Image.BlendRectMask()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(src.GetBaseObject())
-	ptrArguments[1] = gdnative.NewPointerFromObject(mask.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(src))
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(mask))
 	ptrArguments[2] = gdnative.NewPointerFromRect2(srcRect)
 	ptrArguments[3] = gdnative.NewPointerFromVector2(dst)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "blend_rect_mask")
+	methodBind := methodBindImageBlendRectMask.Get()
 
 	// Call the parent method.
 	// void
@@ -208,20 +548,20 @@ func (o *Image) BlendRectMask(src ImageImplementer, mask ImageImplementer, srcRe
 }
 
 /*
-        Copies [code]src_rect[/code] from [code]src[/code] image to this image at coordinates [code]dst[/code].
-	Args: [{ false src Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
+	        Copies [code]src_rect[/code] from [code]src[/code] image to this image at coordinates [code]dst[/code].
+		Args: [{ false src Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
 */
 func (o *Image) BlitRect(src ImageImplementer, srcRect gdnative.Rect2, dst gdnative.Vector2) {
 	//log.Println("Calling Image.BlitRect()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(src.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(src))
 	ptrArguments[1] = gdnative.NewPointerFromRect2(srcRect)
 	ptrArguments[2] = gdnative.NewPointerFromVector2(dst)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "blit_rect")
+	methodBind := methodBindImageBlitRect.Get()
 
 	// Call the parent method.
 	// void
@@ -231,21 +571,21 @@ func (o *Image) BlitRect(src ImageImplementer, srcRect gdnative.Rect2, dst gdnat
 }
 
 /*
-        Blits [code]src_rect[/code] area from [code]src[/code] image to this image at the coordinates given by [code]dst[/code]. [code]src[/code] pixel is copied onto [code]dst[/code] if the corresponding [code]mask[/code] pixel's alpha value is not 0. [code]src[/code] image and [code]mask[/code] image [b]must[/b] have the same size (width and height) but they can have different formats.
-	Args: [{ false src Image} { false mask Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
+	        Blits [code]src_rect[/code] area from [code]src[/code] image to this image at the coordinates given by [code]dst[/code]. [code]src[/code] pixel is copied onto [code]dst[/code] if the corresponding [code]mask[/code] pixel's alpha value is not 0. [code]src[/code] image and [code]mask[/code] image [b]must[/b] have the same size (width and height) but they can have different formats.
+		Args: [{ false src Image} { false mask Image} { false src_rect Rect2} { false dst Vector2}], Returns: void
 */
 func (o *Image) BlitRectMask(src ImageImplementer, mask ImageImplementer, srcRect gdnative.Rect2, dst gdnative.Vector2) {
 	//log.Println("Calling Image.BlitRectMask()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(src.GetBaseObject())
-	ptrArguments[1] = gdnative.NewPointerFromObject(mask.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(src))
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(mask))
 	ptrArguments[2] = gdnative.NewPointerFromRect2(srcRect)
 	ptrArguments[3] = gdnative.NewPointerFromVector2(dst)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "blit_rect_mask")
+	methodBind := methodBindImageBlitRectMask.Get()
 
 	// Call the parent method.
 	// void
@@ -255,8 +595,8 @@ func (o *Image) BlitRectMask(src ImageImplementer, mask ImageImplementer, srcRec
 }
 
 /*
-        Removes the image's mipmaps.
-	Args: [], Returns: void
+	        Removes the image's mipmaps.
+		Args: [], Returns: void
 */
 func (o *Image) ClearMipmaps() {
 	//log.Println("Calling Image.ClearMipmaps()")
@@ -265,7 +605,7 @@ func (o *Image) ClearMipmaps() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "clear_mipmaps")
+	methodBind := methodBindImageClearMipmaps.Get()
 
 	// Call the parent method.
 	// void
@@ -275,8 +615,8 @@ func (o *Image) ClearMipmaps() {
 }
 
 /*
-        Compresses the image to use less memory. Can not directly access pixel data while the image is compressed. Returns error if the chosen compression mode is not available. See [code]COMPRESS_*[/code] constants.
-	Args: [{ false mode int} { false source int} { false lossy_quality float}], Returns: enum.Error
+	        Compresses the image to use less memory. Can not directly access pixel data while the image is compressed. Returns error if the chosen compression mode is not available. See [code]COMPRESS_*[/code] constants.
+		Args: [{ false mode int} { false source int} { false lossy_quality float}], Returns: enum.Error
 */
 func (o *Image) Compress(mode gdnative.Int, source gdnative.Int, lossyQuality gdnative.Real) gdnative.Error {
 	//log.Println("Calling Image.Compress()")
@@ -288,7 +628,7 @@ func (o *Image) Compress(mode gdnative.Int, source gdnative.Int, lossyQuality gd
 	ptrArguments[2] = gdnative.NewPointerFromReal(lossyQuality)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "compress")
+	methodBind := methodBindImageCompress.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -301,18 +641,18 @@ func (o *Image) Compress(mode gdnative.Int, source gdnative.Int, lossyQuality gd
 }
 
 /*
-        Converts the image's format. See [code]FORMAT_*[/code] constants.
-	Args: [{ false format int}], Returns: void
+	        Converts the image's format. See [code]FORMAT_*[/code] constants.
+		Args: [{ false format enum.Image::Format}], Returns: void
 */
-func (o *Image) Convert(format gdnative.Int) {
+func (o *Image) Convert(format ImageFormat) {
 	//log.Println("Calling Image.Convert()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(format)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(format))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "convert")
+	methodBind := methodBindImageConvert.Get()
 
 	// Call the parent method.
 	// void
@@ -322,18 +662,18 @@ func (o *Image) Convert(format gdnative.Int) {
 }
 
 /*
-        Copies [code]src[/code] image to this image.
-	Args: [{ false src Image}], Returns: void
+	        Copies [code]src[/code] image to this image.
+		Args: [{ false src Image}], Returns: void
 */
 func (o *Image) CopyFrom(src ImageImplementer) {
 	//log.Println("Calling Image.CopyFrom()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(src.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(src))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "copy_from")
+	methodBind := methodBindImageCopyFrom.Get()
 
 	// Call the parent method.
 	// void
@@ -343,10 +683,10 @@ func (o *Image) CopyFrom(src ImageImplementer) {
 }
 
 /*
-        Creates an empty image of given size and format. See [code]FORMAT_*[/code] constants. If [code]use_mipmaps[/code] is true then generate mipmaps for this image. See the [code]generate_mipmaps[/code] method.
-	Args: [{ false width int} { false height int} { false use_mipmaps bool} { false format int}], Returns: void
+	        Creates an empty image of given size and format. See [code]FORMAT_*[/code] constants. If [code]use_mipmaps[/code] is true then generate mipmaps for this image. See the [code]generate_mipmaps[/code] method.
+		Args: [{ false width int} { false height int} { false use_mipmaps bool} { false format enum.Image::Format}], Returns: void
 */
-func (o *Image) Create(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format gdnative.Int) {
+func (o *Image) Create(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format ImageFormat) {
 	//log.Println("Calling Image.Create()")
 
 	// Build out the method's arguments
@@ -354,10 +694,10 @@ func (o *Image) Create(width gdnative.Int, height gdnative.Int, useMipmaps gdnat
 	ptrArguments[0] = gdnative.NewPointerFromInt(width)
 	ptrArguments[1] = gdnative.NewPointerFromInt(height)
 	ptrArguments[2] = gdnative.NewPointerFromBool(useMipmaps)
-	ptrArguments[3] = gdnative.NewPointerFromInt(format)
+	ptrArguments[3] = gdnative.NewPointerFromInt(gdnative.Int(format))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "create")
+	methodBind := methodBindImageCreate.Get()
 
 	// Call the parent method.
 	// void
@@ -367,10 +707,10 @@ func (o *Image) Create(width gdnative.Int, height gdnative.Int, useMipmaps gdnat
 }
 
 /*
-        Creates a new image of given size and format. See [code]FORMAT_*[/code] constants. Fills the image with the given raw data. If [code]use_mipmaps[/code] is true then generate mipmaps for this image. See the [code]generate_mipmaps[/code] method.
-	Args: [{ false width int} { false height int} { false use_mipmaps bool} { false format int} { false data PoolByteArray}], Returns: void
+	        Creates a new image of given size and format. See [code]FORMAT_*[/code] constants. Fills the image with the given raw data. If [code]use_mipmaps[/code] is true then generate mipmaps for this image. See the [code]generate_mipmaps[/code] method.
+		Args: [{ false width int} { false height int} { false use_mipmaps bool} { false format enum.Image::Format} { false data PoolByteArray}], Returns: void
 */
-func (o *Image) CreateFromData(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format gdnative.Int, data gdnative.PoolByteArray) {
+func (o *Image) CreateFromData(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format ImageFormat, data gdnative.PoolByteArray) {
 	//log.Println("Calling Image.CreateFromData()")
 
 	// Build out the method's arguments
@@ -378,11 +718,11 @@ func (o *Image) CreateFromData(width gdnative.Int, height gdnative.Int, useMipma
 	ptrArguments[0] = gdnative.NewPointerFromInt(width)
 	ptrArguments[1] = gdnative.NewPointerFromInt(height)
 	ptrArguments[2] = gdnative.NewPointerFromBool(useMipmaps)
-	ptrArguments[3] = gdnative.NewPointerFromInt(format)
+	ptrArguments[3] = gdnative.NewPointerFromInt(gdnative.Int(format))
 	ptrArguments[4] = gdnative.NewPointerFromPoolByteArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "create_from_data")
+	methodBind := methodBindImageCreateFromData.Get()
 
 	// Call the parent method.
 	// void
@@ -392,8 +732,8 @@ func (o *Image) CreateFromData(width gdnative.Int, height gdnative.Int, useMipma
 }
 
 /*
-        Crops the image to the given [code]width[/code] and [code]height[/code]. If the specified size is larger than the current size, the extra area is filled with black pixels.
-	Args: [{ false width int} { false height int}], Returns: void
+	        Crops the image to the given [code]width[/code] and [code]height[/code]. If the specified size is larger than the current size, the extra area is filled with black pixels.
+		Args: [{ false width int} { false height int}], Returns: void
 */
 func (o *Image) Crop(width gdnative.Int, height gdnative.Int) {
 	//log.Println("Calling Image.Crop()")
@@ -404,7 +744,7 @@ func (o *Image) Crop(width gdnative.Int, height gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "crop")
+	methodBind := methodBindImageCrop.Get()
 
 	// Call the parent method.
 	// void
@@ -414,8 +754,8 @@ func (o *Image) Crop(width gdnative.Int, height gdnative.Int) {
 }
 
 /*
-        Decompresses the image if it is compressed. Returns an error if decompress function is not available.
-	Args: [], Returns: enum.Error
+	        Decompresses the image if it is compressed. Returns an error if decompress function is not available.
+		Args: [], Returns: enum.Error
 */
 func (o *Image) Decompress() gdnative.Error {
 	//log.Println("Calling Image.Decompress()")
@@ -424,7 +764,7 @@ func (o *Image) Decompress() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "decompress")
+	methodBind := methodBindImageDecompress.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -437,8 +777,8 @@ func (o *Image) Decompress() gdnative.Error {
 }
 
 /*
-        Returns ALPHA_BLEND if the image has data for alpha values. Returns ALPHA_BIT if all the alpha values are below a certain threshold or the maximum value. Returns ALPHA_NONE if no data for alpha values is found.
-	Args: [], Returns: enum.Image::AlphaMode
+	        Returns ALPHA_BLEND if the image has data for alpha values. Returns ALPHA_BIT if all the alpha values are below a certain threshold or the maximum value. Returns ALPHA_NONE if no data for alpha values is found.
+		Args: [], Returns: enum.Image::AlphaMode
 */
 func (o *Image) DetectAlpha() ImageAlphaMode {
 	//log.Println("Calling Image.DetectAlpha()")
@@ -447,7 +787,7 @@ func (o *Image) DetectAlpha() ImageAlphaMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "detect_alpha")
+	methodBind := methodBindImageDetectAlpha.Get()
 
 	// Call the parent method.
 	// enum.Image::AlphaMode
@@ -460,8 +800,8 @@ func (o *Image) DetectAlpha() ImageAlphaMode {
 }
 
 /*
-        Stretches the image and enlarges it by a factor of 2. No interpolation is done.
-	Args: [], Returns: void
+	        Stretches the image and enlarges it by a factor of 2. No interpolation is done.
+		Args: [], Returns: void
 */
 func (o *Image) ExpandX2Hq2X() {
 	//log.Println("Calling Image.ExpandX2Hq2X()")
@@ -470,7 +810,7 @@ func (o *Image) ExpandX2Hq2X() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "expand_x2_hq2x")
+	methodBind := methodBindImageExpandX2Hq2X.Get()
 
 	// Call the parent method.
 	// void
@@ -480,8 +820,8 @@ func (o *Image) ExpandX2Hq2X() {
 }
 
 /*
-        Fills the image with a given [Color].
-	Args: [{ false color Color}], Returns: void
+	        Fills the image with a given [Color].
+		Args: [{ false color Color}], Returns: void
 */
 func (o *Image) Fill(color gdnative.Color) {
 	//log.Println("Calling Image.Fill()")
@@ -491,7 +831,7 @@ func (o *Image) Fill(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "fill")
+	methodBind := methodBindImageFill.Get()
 
 	// Call the parent method.
 	// void
@@ -501,8 +841,8 @@ func (o *Image) Fill(color gdnative.Color) {
 }
 
 /*
-        Blends low-alpha pixels with nearby pixels.
-	Args: [], Returns: void
+	        Blends low-alpha pixels with nearby pixels.
+		Args: [], Returns: void
 */
 func (o *Image) FixAlphaEdges() {
 	//log.Println("Calling Image.FixAlphaEdges()")
@@ -511,7 +851,7 @@ func (o *Image) FixAlphaEdges() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "fix_alpha_edges")
+	methodBind := methodBindImageFixAlphaEdges.Get()
 
 	// Call the parent method.
 	// void
@@ -521,8 +861,8 @@ func (o *Image) FixAlphaEdges() {
 }
 
 /*
-        Flips the image horizontally.
-	Args: [], Returns: void
+	        Flips the image horizontally.
+		Args: [], Returns: void
 */
 func (o *Image) FlipX() {
 	//log.Println("Calling Image.FlipX()")
@@ -531,7 +871,7 @@ func (o *Image) FlipX() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "flip_x")
+	methodBind := methodBindImageFlipX.Get()
 
 	// Call the parent method.
 	// void
@@ -541,8 +881,8 @@ func (o *Image) FlipX() {
 }
 
 /*
-        Flips the image vertically.
-	Args: [], Returns: void
+	        Flips the image vertically.
+		Args: [], Returns: void
 */
 func (o *Image) FlipY() {
 	//log.Println("Calling Image.FlipY()")
@@ -551,7 +891,7 @@ func (o *Image) FlipY() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "flip_y")
+	methodBind := methodBindImageFlipY.Get()
 
 	// Call the parent method.
 	// void
@@ -561,8 +901,8 @@ func (o *Image) FlipY() {
 }
 
 /*
-        Generates mipmaps for the image. Mipmaps are pre-calculated and lower resolution copies of the image. Mipmaps are automatically used if the image needs to be scaled down when rendered. This improves image quality and the performance of the rendering. Returns an error if the image is compressed, in a custom format or if the image's width/height is 0.
-	Args: [], Returns: enum.Error
+	        Generates mipmaps for the image. Mipmaps are pre-calculated and lower resolution copies of the image. Mipmaps are automatically used if the image needs to be scaled down when rendered. This improves image quality and the performance of the rendering. Returns an error if the image is compressed, in a custom format or if the image's width/height is 0.
+		Args: [], Returns: enum.Error
 */
 func (o *Image) GenerateMipmaps() gdnative.Error {
 	//log.Println("Calling Image.GenerateMipmaps()")
@@ -571,7 +911,7 @@ func (o *Image) GenerateMipmaps() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "generate_mipmaps")
+	methodBind := methodBindImageGenerateMipmaps.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -584,8 +924,8 @@ func (o *Image) GenerateMipmaps() gdnative.Error {
 }
 
 /*
-        Returns the image's raw data.
-	Args: [], Returns: PoolByteArray
+	        Returns the image's raw data.
+		Args: [], Returns: PoolByteArray
 */
 func (o *Image) GetData() gdnative.PoolByteArray {
 	//log.Println("Calling Image.GetData()")
@@ -594,7 +934,7 @@ func (o *Image) GetData() gdnative.PoolByteArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_data")
+	methodBind := methodBindImageGetData.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -607,8 +947,8 @@ func (o *Image) GetData() gdnative.PoolByteArray {
 }
 
 /*
-        Returns the image’s format. See [code]FORMAT_*[/code] constants.
-	Args: [], Returns: enum.Image::Format
+	        Returns the image’s format. See [code]FORMAT_*[/code] constants.
+		Args: [], Returns: enum.Image::Format
 */
 func (o *Image) GetFormat() ImageFormat {
 	//log.Println("Calling Image.GetFormat()")
@@ -617,7 +957,7 @@ func (o *Image) GetFormat() ImageFormat {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_format")
+	methodBind := methodBindImageGetFormat.Get()
 
 	// Call the parent method.
 	// enum.Image::Format
@@ -630,8 +970,8 @@ func (o *Image) GetFormat() ImageFormat {
 }
 
 /*
-        Returns the image's height.
-	Args: [], Returns: int
+	        Returns the image's height.
+		Args: [], Returns: int
 */
 func (o *Image) GetHeight() gdnative.Int {
 	//log.Println("Calling Image.GetHeight()")
@@ -640,7 +980,7 @@ func (o *Image) GetHeight() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_height")
+	methodBind := methodBindImageGetHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -653,8 +993,8 @@ func (o *Image) GetHeight() gdnative.Int {
 }
 
 /*
-        Returns the offset where the image's mipmap with index [code]mipmap[/code] is stored in the [code]data[/code] dictionary.
-	Args: [{ false mipmap int}], Returns: int
+	        Returns the offset where the image's mipmap with index [code]mipmap[/code] is stored in the [code]data[/code] dictionary.
+		Args: [{ false mipmap int}], Returns: int
 */
 func (o *Image) GetMipmapOffset(mipmap gdnative.Int) gdnative.Int {
 	//log.Println("Calling Image.GetMipmapOffset()")
@@ -664,7 +1004,7 @@ func (o *Image) GetMipmapOffset(mipmap gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mipmap)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_mipmap_offset")
+	methodBind := methodBindImageGetMipmapOffset.Get()
 
 	// Call the parent method.
 	// int
@@ -677,8 +1017,8 @@ func (o *Image) GetMipmapOffset(mipmap gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the color of the pixel at [code](x, y)[/code] if the image is locked. If the image is unlocked it always returns a [Color] with the value [code](0, 0, 0, 1.0)[/code].
-	Args: [{ false x int} { false y int}], Returns: Color
+	        Returns the color of the pixel at [code](x, y)[/code] if the image is locked. If the image is unlocked it always returns a [Color] with the value [code](0, 0, 0, 1.0)[/code].
+		Args: [{ false x int} { false y int}], Returns: Color
 */
 func (o *Image) GetPixel(x gdnative.Int, y gdnative.Int) gdnative.Color {
 	//log.Println("Calling Image.GetPixel()")
@@ -689,7 +1029,7 @@ func (o *Image) GetPixel(x gdnative.Int, y gdnative.Int) gdnative.Color {
 	ptrArguments[1] = gdnative.NewPointerFromInt(y)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_pixel")
+	methodBind := methodBindImageGetPixel.Get()
 
 	// Call the parent method.
 	// Color
@@ -702,8 +1042,8 @@ func (o *Image) GetPixel(x gdnative.Int, y gdnative.Int) gdnative.Color {
 }
 
 /*
-        Returns a new image that is a copy of the image's area specified with [code]rect[/code].
-	Args: [{ false rect Rect2}], Returns: Image
+	        Returns a new image that is a copy of the image's area specified with [code]rect[/code].
+		Args: [{ false rect Rect2}], Returns: Image
 */
 func (o *Image) GetRect(rect gdnative.Rect2) ImageImplementer {
 	//log.Println("Calling Image.GetRect()")
@@ -713,7 +1053,7 @@ func (o *Image) GetRect(rect gdnative.Rect2) ImageImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_rect")
+	methodBind := methodBindImageGetRect.Get()
 
 	// Call the parent method.
 	// Image
@@ -721,27 +1061,25 @@ func (o *Image) GetRect(rect gdnative.Rect2) ImageImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newImageFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ImageImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Image" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ImageImplementer)
+	ret := Image{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ImageImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the image's size (width and height).
-	Args: [], Returns: Vector2
+	        Returns the image's size (width and height).
+		Args: [], Returns: Vector2
 */
 func (o *Image) GetSize() gdnative.Vector2 {
 	//log.Println("Calling Image.GetSize()")
@@ -750,7 +1088,7 @@ func (o *Image) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_size")
+	methodBind := methodBindImageGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -763,8 +1101,8 @@ func (o *Image) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Returns a [Rect2] enclosing the visible portion of the image.
-	Args: [], Returns: Rect2
+	        Returns a [Rect2] enclosing the visible portion of the image.
+		Args: [], Returns: Rect2
 */
 func (o *Image) GetUsedRect() gdnative.Rect2 {
 	//log.Println("Calling Image.GetUsedRect()")
@@ -773,7 +1111,7 @@ func (o *Image) GetUsedRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_used_rect")
+	methodBind := methodBindImageGetUsedRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -786,8 +1124,8 @@ func (o *Image) GetUsedRect() gdnative.Rect2 {
 }
 
 /*
-        Returns the image's width.
-	Args: [], Returns: int
+	        Returns the image's width.
+		Args: [], Returns: int
 */
 func (o *Image) GetWidth() gdnative.Int {
 	//log.Println("Calling Image.GetWidth()")
@@ -796,7 +1134,7 @@ func (o *Image) GetWidth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "get_width")
+	methodBind := methodBindImageGetWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -809,8 +1147,8 @@ func (o *Image) GetWidth() gdnative.Int {
 }
 
 /*
-        Returns [code]true[/code] if the image has generated mipmaps.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the image has generated mipmaps.
+		Args: [], Returns: bool
 */
 func (o *Image) HasMipmaps() gdnative.Bool {
 	//log.Println("Calling Image.HasMipmaps()")
@@ -819,7 +1157,7 @@ func (o *Image) HasMipmaps() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "has_mipmaps")
+	methodBind := methodBindImageHasMipmaps.Get()
 
 	// Call the parent method.
 	// bool
@@ -832,8 +1170,8 @@ func (o *Image) HasMipmaps() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the image is compressed.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the image is compressed.
+		Args: [], Returns: bool
 */
 func (o *Image) IsCompressed() gdnative.Bool {
 	//log.Println("Calling Image.IsCompressed()")
@@ -842,7 +1180,7 @@ func (o *Image) IsCompressed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "is_compressed")
+	methodBind := methodBindImageIsCompressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -855,8 +1193,8 @@ func (o *Image) IsCompressed() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the image has no data.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the image has no data.
+		Args: [], Returns: bool
 */
 func (o *Image) IsEmpty() gdnative.Bool {
 	//log.Println("Calling Image.IsEmpty()")
@@ -865,7 +1203,7 @@ func (o *Image) IsEmpty() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "is_empty")
+	methodBind := methodBindImageIsEmpty.Get()
 
 	// Call the parent method.
 	// bool
@@ -878,8 +1216,8 @@ func (o *Image) IsEmpty() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if all the image's pixels have an alpha value of 0. Returns [code]false[/code] if any pixel has an alpha value higher than 0.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if all the image's pixels have an alpha value of 0. Returns [code]false[/code] if any pixel has an alpha value higher than 0.
+		Args: [], Returns: bool
 */
 func (o *Image) IsInvisible() gdnative.Bool {
 	//log.Println("Calling Image.IsInvisible()")
@@ -888,7 +1226,7 @@ func (o *Image) IsInvisible() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "is_invisible")
+	methodBind := methodBindImageIsInvisible.Get()
 
 	// Call the parent method.
 	// bool
@@ -901,8 +1239,8 @@ func (o *Image) IsInvisible() gdnative.Bool {
 }
 
 /*
-        Loads an image from file [code]path[/code].
-	Args: [{ false path String}], Returns: enum.Error
+	        Loads an image from file [code]path[/code].
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *Image) Load(path gdnative.String) gdnative.Error {
 	//log.Println("Calling Image.Load()")
@@ -912,7 +1250,7 @@ func (o *Image) Load(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "load")
+	methodBind := methodBindImageLoad.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -925,8 +1263,7 @@ func (o *Image) Load(path gdnative.String) gdnative.Error {
 }
 
 /*
-
-	Args: [{ false buffer PoolByteArray}], Returns: enum.Error
+Args: [{ false buffer PoolByteArray}], Returns: enum.Error
 */
 func (o *Image) LoadJpgFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error {
 	//log.Println("Calling Image.LoadJpgFromBuffer()")
@@ -936,7 +1273,7 @@ func (o *Image) LoadJpgFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(buffer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "load_jpg_from_buffer")
+	methodBind := methodBindImageLoadJpgFromBuffer.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -949,8 +1286,7 @@ func (o *Image) LoadJpgFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error
 }
 
 /*
-
-	Args: [{ false buffer PoolByteArray}], Returns: enum.Error
+Args: [{ false buffer PoolByteArray}], Returns: enum.Error
 */
 func (o *Image) LoadPngFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error {
 	//log.Println("Calling Image.LoadPngFromBuffer()")
@@ -960,7 +1296,7 @@ func (o *Image) LoadPngFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(buffer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "load_png_from_buffer")
+	methodBind := methodBindImageLoadPngFromBuffer.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -973,8 +1309,8 @@ func (o *Image) LoadPngFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error
 }
 
 /*
-        Locks the data for writing access.
-	Args: [], Returns: void
+	        Locks the data for writing access.
+		Args: [], Returns: void
 */
 func (o *Image) Lock() {
 	//log.Println("Calling Image.Lock()")
@@ -983,7 +1319,7 @@ func (o *Image) Lock() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "lock")
+	methodBind := methodBindImageLock.Get()
 
 	// Call the parent method.
 	// void
@@ -993,8 +1329,8 @@ func (o *Image) Lock() {
 }
 
 /*
-        Converts the image's data to represent coordinates on a 3D plane. This is used when the image represents a normalmap. A normalmap can add lots of detail to a 3D surface without increasing the polygon count.
-	Args: [], Returns: void
+	        Converts the image's data to represent coordinates on a 3D plane. This is used when the image represents a normalmap. A normalmap can add lots of detail to a 3D surface without increasing the polygon count.
+		Args: [], Returns: void
 */
 func (o *Image) NormalmapToXy() {
 	//log.Println("Calling Image.NormalmapToXy()")
@@ -1003,7 +1339,7 @@ func (o *Image) NormalmapToXy() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "normalmap_to_xy")
+	methodBind := methodBindImageNormalmapToXy.Get()
 
 	// Call the parent method.
 	// void
@@ -1013,8 +1349,8 @@ func (o *Image) NormalmapToXy() {
 }
 
 /*
-        Multiplies color values with alpha values. Resulting color values for a pixel are [code](color * alpha)/256[/code].
-	Args: [], Returns: void
+	        Multiplies color values with alpha values. Resulting color values for a pixel are [code](color * alpha)/256[/code].
+		Args: [], Returns: void
 */
 func (o *Image) PremultiplyAlpha() {
 	//log.Println("Calling Image.PremultiplyAlpha()")
@@ -1023,7 +1359,7 @@ func (o *Image) PremultiplyAlpha() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "premultiply_alpha")
+	methodBind := methodBindImagePremultiplyAlpha.Get()
 
 	// Call the parent method.
 	// void
@@ -1033,20 +1369,20 @@ func (o *Image) PremultiplyAlpha() {
 }
 
 /*
-        Resizes the image to the given [code]width[/code] and [code]height[/code]. New pixels are calculated using [code]interpolation[/code]. See [code]interpolation[/code] constants.
-	Args: [{ false width int} { false height int} {1 true interpolation int}], Returns: void
+	        Resizes the image to the given [code]width[/code] and [code]height[/code]. New pixels are calculated using [code]interpolation[/code]. See [code]interpolation[/code] constants.
+		Args: [{ false width int} { false height int} {1 true interpolation enum.Image::Interpolation}], Returns: void
 */
-func (o *Image) Resize(width gdnative.Int, height gdnative.Int, interpolation gdnative.Int) {
+func (o *Image) Resize(width gdnative.Int, height gdnative.Int, interpolation ImageInterpolation) {
 	//log.Println("Calling Image.Resize()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromInt(width)
 	ptrArguments[1] = gdnative.NewPointerFromInt(height)
-	ptrArguments[2] = gdnative.NewPointerFromInt(interpolation)
+	ptrArguments[2] = gdnative.NewPointerFromInt(gdnative.Int(interpolation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "resize")
+	methodBind := methodBindImageResize.Get()
 
 	// Call the parent method.
 	// void
@@ -1056,8 +1392,8 @@ func (o *Image) Resize(width gdnative.Int, height gdnative.Int, interpolation gd
 }
 
 /*
-        Resizes the image to the nearest power of 2 for the width and height. If [code]square[/code] is [code]true[/code] then set width and height to be the same.
-	Args: [{False true square bool}], Returns: void
+	        Resizes the image to the nearest power of 2 for the width and height. If [code]square[/code] is [code]true[/code] then set width and height to be the same.
+		Args: [{False true square bool}], Returns: void
 */
 func (o *Image) ResizeToPo2(square gdnative.Bool) {
 	//log.Println("Calling Image.ResizeToPo2()")
@@ -1067,7 +1403,7 @@ func (o *Image) ResizeToPo2(square gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(square)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "resize_to_po2")
+	methodBind := methodBindImageResizeToPo2.Get()
 
 	// Call the parent method.
 	// void
@@ -1077,8 +1413,8 @@ func (o *Image) ResizeToPo2(square gdnative.Bool) {
 }
 
 /*
-        Saves the image as a PNG file to [code]path[/code].
-	Args: [{ false path String}], Returns: enum.Error
+	        Saves the image as a PNG file to [code]path[/code].
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *Image) SavePng(path gdnative.String) gdnative.Error {
 	//log.Println("Calling Image.SavePng()")
@@ -1088,7 +1424,7 @@ func (o *Image) SavePng(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "save_png")
+	methodBind := methodBindImageSavePng.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -1101,8 +1437,8 @@ func (o *Image) SavePng(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Sets the [Color] of the pixel at [code](x, y)[/code] if the image is locked. Example: [codeblock] var img = Image.new() img.create(img_width, img_height, false, Image.FORMAT_RGBA8) img.lock() img.set_pixel(x, y, color) # Works img.unlock() img.set_pixel(x, y, color) # Does not have an effect [/codeblock]
-	Args: [{ false x int} { false y int} { false color Color}], Returns: void
+	        Sets the [Color] of the pixel at [code](x, y)[/code] if the image is locked. Example: [codeblock] var img = Image.new() img.create(img_width, img_height, false, Image.FORMAT_RGBA8) img.lock() img.set_pixel(x, y, color) # Works img.unlock() img.set_pixel(x, y, color) # Does not have an effect [/codeblock]
+		Args: [{ false x int} { false y int} { false color Color}], Returns: void
 */
 func (o *Image) SetPixel(x gdnative.Int, y gdnative.Int, color gdnative.Color) {
 	//log.Println("Calling Image.SetPixel()")
@@ -1114,7 +1450,7 @@ func (o *Image) SetPixel(x gdnative.Int, y gdnative.Int, color gdnative.Color) {
 	ptrArguments[2] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "set_pixel")
+	methodBind := methodBindImageSetPixel.Get()
 
 	// Call the parent method.
 	// void
@@ -1124,8 +1460,8 @@ func (o *Image) SetPixel(x gdnative.Int, y gdnative.Int, color gdnative.Color) {
 }
 
 /*
-        Shrinks the image by a factor of 2.
-	Args: [], Returns: void
+	        Shrinks the image by a factor of 2.
+		Args: [], Returns: void
 */
 func (o *Image) ShrinkX2() {
 	//log.Println("Calling Image.ShrinkX2()")
@@ -1134,7 +1470,7 @@ func (o *Image) ShrinkX2() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "shrink_x2")
+	methodBind := methodBindImageShrinkX2.Get()
 
 	// Call the parent method.
 	// void
@@ -1144,8 +1480,8 @@ func (o *Image) ShrinkX2() {
 }
 
 /*
-        Converts the raw data from the sRGB colorspace to a linear scale.
-	Args: [], Returns: void
+	        Converts the raw data from the sRGB colorspace to a linear scale.
+		Args: [], Returns: void
 */
 func (o *Image) SrgbToLinear() {
 	//log.Println("Calling Image.SrgbToLinear()")
@@ -1154,7 +1490,7 @@ func (o *Image) SrgbToLinear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "srgb_to_linear")
+	methodBind := methodBindImageSrgbToLinear.Get()
 
 	// Call the parent method.
 	// void
@@ -1164,8 +1500,8 @@ func (o *Image) SrgbToLinear() {
 }
 
 /*
-        Unlocks the data and prevents changes.
-	Args: [], Returns: void
+	        Unlocks the data and prevents changes.
+		Args: [], Returns: void
 */
 func (o *Image) Unlock() {
 	//log.Println("Calling Image.Unlock()")
@@ -1174,7 +1510,7 @@ func (o *Image) Unlock() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Image", "unlock")
+	methodBind := methodBindImageUnlock.Get()
 
 	// Call the parent method.
 	// void
@@ -1183,6 +1519,26 @@ func (o *Image) Unlock() {
 
 }
 
+// ResizeWithDefaults will call Resize using the default values for: interpolation.
+func (o *Image) ResizeWithDefaults(width gdnative.Int, height gdnative.Int) {
+	o.Resize(width, height, 1)
+}
+
+// ResizeToPo2WithDefaults will call ResizeToPo2 using the default values for: square.
+func (o *Image) ResizeToPo2WithDefaults() {
+	o.ResizeToPo2(false)
+}
+
+// Data will return the value of the "data" property.
+func (o *Image) Data() gdnative.Dictionary {
+	return o.X_GetData()
+}
+
+// SetData will set the value of the "data" property.
+func (o *Image) SetData(value gdnative.Dictionary) {
+	o.X_SetData(value)
+}
+
 // ImageImplementer is an interface that implements the methods
 // of the Image class.
 type ImageImplementer interface {
@@ -1194,17 +1550,22 @@ type ImageImplementer interface {
 	BlitRect(src ImageImplementer, srcRect gdnative.Rect2, dst gdnative.Vector2)
 	BlitRectMask(src ImageImplementer, mask ImageImplementer, srcRect gdnative.Rect2, dst gdnative.Vector2)
 	ClearMipmaps()
-	Convert(format gdnative.Int)
+	Compress(mode gdnative.Int, source gdnative.Int, lossyQuality gdnative.Real) gdnative.Error
+	Convert(format ImageFormat)
 	CopyFrom(src ImageImplementer)
-	Create(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format gdnative.Int)
-	CreateFromData(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format gdnative.Int, data gdnative.PoolByteArray)
+	Create(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format ImageFormat)
+	CreateFromData(width gdnative.Int, height gdnative.Int, useMipmaps gdnative.Bool, format ImageFormat, data gdnative.PoolByteArray)
 	Crop(width gdnative.Int, height gdnative.Int)
+	Decompress() gdnative.Error
+	DetectAlpha() ImageAlphaMode
 	ExpandX2Hq2X()
 	Fill(color gdnative.Color)
 	FixAlphaEdges()
 	FlipX()
 	FlipY()
+	GenerateMipmaps() gdnative.Error
 	GetData() gdnative.PoolByteArray
+	GetFormat() ImageFormat
 	GetHeight() gdnative.Int
 	GetMipmapOffset(mipmap gdnative.Int) gdnative.Int
 	GetPixel(x gdnative.Int, y gdnative.Int) gdnative.Color
@@ -1216,13 +1577,21 @@ type ImageImplementer interface {
 	IsCompressed() gdnative.Bool
 	IsEmpty() gdnative.Bool
 	IsInvisible() gdnative.Bool
+	Load(path gdnative.String) gdnative.Error
+	LoadJpgFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error
+	LoadPngFromBuffer(buffer gdnative.PoolByteArray) gdnative.Error
 	Lock()
 	NormalmapToXy()
 	PremultiplyAlpha()
-	Resize(width gdnative.Int, height gdnative.Int, interpolation gdnative.Int)
+	Resize(width gdnative.Int, height gdnative.Int, interpolation ImageInterpolation)
 	ResizeToPo2(square gdnative.Bool)
+	SavePng(path gdnative.String) gdnative.Error
 	SetPixel(x gdnative.Int, y gdnative.Int, color gdnative.Color)
 	ShrinkX2()
 	SrgbToLinear()
 	Unlock()
+	ResizeWithDefaults(width gdnative.Int, height gdnative.Int)
+	ResizeToPo2WithDefaults()
+	Data() gdnative.Dictionary
+	SetData(value gdnative.Dictionary)
 }
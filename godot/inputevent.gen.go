@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventFromPointer(ptr gdnative.Pointer) InputEvent {
-func newInputEventFromPointer(ptr gdnative.Pointer) InputEvent {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEvent{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base class of all sort of input event. See [method Node._input].
 */
@@ -34,19 +25,36 @@ func (o *InputEvent) BaseClass() string {
 	return "InputEvent"
 }
 
+// Method binds of the InputEvent class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventActionMatch      = gdnative.NewLazyMethodBind("InputEvent", "action_match")
+	methodBindInputEventAsText           = gdnative.NewLazyMethodBind("InputEvent", "as_text")
+	methodBindInputEventGetDevice        = gdnative.NewLazyMethodBind("InputEvent", "get_device")
+	methodBindInputEventIsAction         = gdnative.NewLazyMethodBind("InputEvent", "is_action")
+	methodBindInputEventIsActionPressed  = gdnative.NewLazyMethodBind("InputEvent", "is_action_pressed")
+	methodBindInputEventIsActionReleased = gdnative.NewLazyMethodBind("InputEvent", "is_action_released")
+	methodBindInputEventIsActionType     = gdnative.NewLazyMethodBind("InputEvent", "is_action_type")
+	methodBindInputEventIsEcho           = gdnative.NewLazyMethodBind("InputEvent", "is_echo")
+	methodBindInputEventIsPressed        = gdnative.NewLazyMethodBind("InputEvent", "is_pressed")
+	methodBindInputEventSetDevice        = gdnative.NewLazyMethodBind("InputEvent", "set_device")
+	methodBindInputEventShortcutMatch    = gdnative.NewLazyMethodBind("InputEvent", "shortcut_match")
+	methodBindInputEventXformedBy        = gdnative.NewLazyMethodBind("InputEvent", "xformed_by")
+)
+
 /*
-        Returns [code]true[/code] if this event matches [code]event[/code].
-	Args: [{ false event InputEvent}], Returns: bool
+	        Returns [code]true[/code] if this event matches [code]event[/code].
+		Args: [{ false event InputEvent}], Returns: bool
 */
 func (o *InputEvent) ActionMatch(event InputEventImplementer) gdnative.Bool {
 	//log.Println("Calling InputEvent.ActionMatch()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "action_match")
+	methodBind := methodBindInputEventActionMatch.Get()
 
 	// Call the parent method.
 	// bool
@@ -59,8 +67,8 @@ func (o *InputEvent) ActionMatch(event InputEventImplementer) gdnative.Bool {
 }
 
 /*
-        Returns a [String] representation of the event.
-	Args: [], Returns: String
+	        Returns a [String] representation of the event.
+		Args: [], Returns: String
 */
 func (o *InputEvent) AsText() gdnative.String {
 	//log.Println("Calling InputEvent.AsText()")
@@ -69,7 +77,7 @@ func (o *InputEvent) AsText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "as_text")
+	methodBind := methodBindInputEventAsText.Get()
 
 	// Call the parent method.
 	// String
@@ -82,8 +90,8 @@ func (o *InputEvent) AsText() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEvent) GetDevice() gdnative.Int {
 	//log.Println("Calling InputEvent.GetDevice()")
@@ -92,7 +100,7 @@ func (o *InputEvent) GetDevice() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "get_device")
+	methodBind := methodBindInputEventGetDevice.Get()
 
 	// Call the parent method.
 	// int
@@ -105,8 +113,8 @@ func (o *InputEvent) GetDevice() gdnative.Int {
 }
 
 /*
-        Returns [code]true[/code] if this input event matches a pre-defined action of any type.
-	Args: [{ false action String}], Returns: bool
+	        Returns [code]true[/code] if this input event matches a pre-defined action of any type.
+		Args: [{ false action String}], Returns: bool
 */
 func (o *InputEvent) IsAction(action gdnative.String) gdnative.Bool {
 	//log.Println("Calling InputEvent.IsAction()")
@@ -116,7 +124,7 @@ func (o *InputEvent) IsAction(action gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "is_action")
+	methodBind := methodBindInputEventIsAction.Get()
 
 	// Call the parent method.
 	// bool
@@ -129,8 +137,8 @@ func (o *InputEvent) IsAction(action gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the given action is being pressed (and is not an echo event for KEY events). Not relevant for the event types [code]MOUSE_MOTION[/code], [code]SCREEN_DRAG[/code] or [code]NONE[/code].
-	Args: [{ false action String}], Returns: bool
+	        Returns [code]true[/code] if the given action is being pressed (and is not an echo event for KEY events). Not relevant for the event types [code]MOUSE_MOTION[/code], [code]SCREEN_DRAG[/code] or [code]NONE[/code].
+		Args: [{ false action String}], Returns: bool
 */
 func (o *InputEvent) IsActionPressed(action gdnative.String) gdnative.Bool {
 	//log.Println("Calling InputEvent.IsActionPressed()")
@@ -140,7 +148,7 @@ func (o *InputEvent) IsActionPressed(action gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "is_action_pressed")
+	methodBind := methodBindInputEventIsActionPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -153,8 +161,8 @@ func (o *InputEvent) IsActionPressed(action gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the given action is released (i.e. not pressed). Not relevant for the event types [code]MOUSE_MOTION[/code], [code]SCREEN_DRAG[/code] or [code]NONE[/code].
-	Args: [{ false action String}], Returns: bool
+	        Returns [code]true[/code] if the given action is released (i.e. not pressed). Not relevant for the event types [code]MOUSE_MOTION[/code], [code]SCREEN_DRAG[/code] or [code]NONE[/code].
+		Args: [{ false action String}], Returns: bool
 */
 func (o *InputEvent) IsActionReleased(action gdnative.String) gdnative.Bool {
 	//log.Println("Calling InputEvent.IsActionReleased()")
@@ -164,7 +172,7 @@ func (o *InputEvent) IsActionReleased(action gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "is_action_released")
+	methodBind := methodBindInputEventIsActionReleased.Get()
 
 	// Call the parent method.
 	// bool
@@ -177,8 +185,8 @@ func (o *InputEvent) IsActionReleased(action gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if this input event's type is one of the [code]InputEvent[/code] constants.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if this input event's type is one of the [code]InputEvent[/code] constants.
+		Args: [], Returns: bool
 */
 func (o *InputEvent) IsActionType() gdnative.Bool {
 	//log.Println("Calling InputEvent.IsActionType()")
@@ -187,7 +195,7 @@ func (o *InputEvent) IsActionType() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "is_action_type")
+	methodBind := methodBindInputEventIsActionType.Get()
 
 	// Call the parent method.
 	// bool
@@ -200,8 +208,8 @@ func (o *InputEvent) IsActionType() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if this input event is an echo event (only for events of type KEY).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if this input event is an echo event (only for events of type KEY).
+		Args: [], Returns: bool
 */
 func (o *InputEvent) IsEcho() gdnative.Bool {
 	//log.Println("Calling InputEvent.IsEcho()")
@@ -210,7 +218,7 @@ func (o *InputEvent) IsEcho() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "is_echo")
+	methodBind := methodBindInputEventIsEcho.Get()
 
 	// Call the parent method.
 	// bool
@@ -223,8 +231,8 @@ func (o *InputEvent) IsEcho() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if this input event is pressed. Not relevant for the event types [code]MOUSE_MOTION[/code], [code]SCREEN_DRAG[/code] or [code]NONE[/code].
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if this input event is pressed. Not relevant for the event types [code]MOUSE_MOTION[/code], [code]SCREEN_DRAG[/code] or [code]NONE[/code].
+		Args: [], Returns: bool
 */
 func (o *InputEvent) IsPressed() gdnative.Bool {
 	//log.Println("Calling InputEvent.IsPressed()")
@@ -233,7 +241,7 @@ func (o *InputEvent) IsPressed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "is_pressed")
+	methodBind := methodBindInputEventIsPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -246,8 +254,8 @@ func (o *InputEvent) IsPressed() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false device int}], Returns: void
+	        Undocumented
+		Args: [{ false device int}], Returns: void
 */
 func (o *InputEvent) SetDevice(device gdnative.Int) {
 	//log.Println("Calling InputEvent.SetDevice()")
@@ -257,7 +265,7 @@ func (o *InputEvent) SetDevice(device gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(device)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "set_device")
+	methodBind := methodBindInputEventSetDevice.Get()
 
 	// Call the parent method.
 	// void
@@ -267,18 +275,17 @@ func (o *InputEvent) SetDevice(device gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false event InputEvent}], Returns: bool
+Args: [{ false event InputEvent}], Returns: bool
 */
 func (o *InputEvent) ShortcutMatch(event InputEventImplementer) gdnative.Bool {
 	//log.Println("Calling InputEvent.ShortcutMatch()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "shortcut_match")
+	methodBind := methodBindInputEventShortcutMatch.Get()
 
 	// Call the parent method.
 	// bool
@@ -291,8 +298,7 @@ func (o *InputEvent) ShortcutMatch(event InputEventImplementer) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false xform Transform2D} {(0, 0) true local_ofs Vector2}], Returns: InputEvent
+Args: [{ false xform Transform2D} {(0, 0) true local_ofs Vector2}], Returns: InputEvent
 */
 func (o *InputEvent) XformedBy(xform gdnative.Transform2D, localOfs gdnative.Vector2) InputEventImplementer {
 	//log.Println("Calling InputEvent.XformedBy()")
@@ -303,7 +309,7 @@ func (o *InputEvent) XformedBy(xform gdnative.Transform2D, localOfs gdnative.Vec
 	ptrArguments[1] = gdnative.NewPointerFromVector2(localOfs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEvent", "xformed_by")
+	methodBind := methodBindInputEventXformedBy.Get()
 
 	// Call the parent method.
 	// InputEvent
@@ -311,24 +317,32 @@ func (o *InputEvent) XformedBy(xform gdnative.Transform2D, localOfs gdnative.Vec
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newInputEventFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(InputEventImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "InputEvent" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(InputEventImplementer)
+	ret := InputEvent{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(InputEventImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
+// XformedByWithDefaults will call XformedBy using the default values for: local_ofs.
+func (o *InputEvent) XformedByWithDefaults(xform gdnative.Transform2D) InputEventImplementer {
+	return o.XformedBy(xform, gdnative.NewVector2(0, 0))
+}
+
+// Device will return the value of the "device" property.
+func (o *InputEvent) Device() gdnative.Int {
+	return o.GetDevice()
+}
+
 // InputEventImplementer is an interface that implements the methods
 // of the InputEvent class.
 type InputEventImplementer interface {
@@ -345,4 +359,6 @@ type InputEventImplementer interface {
 	SetDevice(device gdnative.Int)
 	ShortcutMatch(event InputEventImplementer) gdnative.Bool
 	XformedBy(xform gdnative.Transform2D, localOfs gdnative.Vector2) InputEventImplementer
+	XformedByWithDefaults(xform gdnative.Transform2D) InputEventImplementer
+	Device() gdnative.Int
 }
@@ -0,0 +1,75 @@
+package inputevent
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("InputEventAction", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventAction{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventGesture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventGesture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventJoypadButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventJoypadButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventJoypadMotion", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventJoypadMotion{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventMagnifyGesture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventMagnifyGesture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventMouse", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventMouse{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventMouseButton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventMouseButton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventMouseMotion", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventMouseMotion{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventPanGesture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventPanGesture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventScreenDrag", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventScreenDrag{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InputEventScreenTouch", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputEventScreenTouch{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
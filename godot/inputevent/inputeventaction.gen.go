@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventActionFromPointer(ptr gdnative.Pointer) InputEventAction {
-func newInputEventActionFromPointer(ptr gdnative.Pointer) InputEventAction {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventAction{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Contains a generic action which can be targeted from several type of inputs. Actions can be created from the project settings menu [code]Project > Project Settings > Input Map[/code]. See [method Node._input].
 */
 type InputEventAction struct {
-	InputEvent
+	godot.InputEvent
 	owner gdnative.Object
 }
 
+// classConstructorInputEventAction is the class constructor of the InputEventAction class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventAction = gdnative.NewLazyClassConstructor("InputEventAction")
+
+// NewInputEventAction will create a new instance of the InputEventAction class.
+// InputEventAction is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventAction() *InputEventAction {
+	obj := &InputEventAction{}
+	obj.SetBaseObject(classConstructorInputEventAction.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventAction) BaseClass() string {
 	return "InputEventAction"
 }
 
+// Method binds of the InputEventAction class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventActionGetAction  = gdnative.NewLazyMethodBind("InputEventAction", "get_action")
+	methodBindInputEventActionSetAction  = gdnative.NewLazyMethodBind("InputEventAction", "set_action")
+	methodBindInputEventActionSetPressed = gdnative.NewLazyMethodBind("InputEventAction", "set_pressed")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *InputEventAction) GetAction() gdnative.String {
 	//log.Println("Calling InputEventAction.GetAction()")
@@ -45,7 +62,7 @@ func (o *InputEventAction) GetAction() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventAction", "get_action")
+	methodBind := methodBindInputEventActionGetAction.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +75,8 @@ func (o *InputEventAction) GetAction() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false action String}], Returns: void
+	        Undocumented
+		Args: [{ false action String}], Returns: void
 */
 func (o *InputEventAction) SetAction(action gdnative.String) {
 	//log.Println("Calling InputEventAction.SetAction()")
@@ -69,7 +86,7 @@ func (o *InputEventAction) SetAction(action gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventAction", "set_action")
+	methodBind := methodBindInputEventActionSetAction.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +96,8 @@ func (o *InputEventAction) SetAction(action gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pressed bool}], Returns: void
+	        Undocumented
+		Args: [{ false pressed bool}], Returns: void
 */
 func (o *InputEventAction) SetPressed(pressed gdnative.Bool) {
 	//log.Println("Calling InputEventAction.SetPressed()")
@@ -90,7 +107,7 @@ func (o *InputEventAction) SetPressed(pressed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(pressed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventAction", "set_pressed")
+	methodBind := methodBindInputEventActionSetPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -99,11 +116,23 @@ func (o *InputEventAction) SetPressed(pressed gdnative.Bool) {
 
 }
 
+// Action will return the value of the "action" property.
+func (o *InputEventAction) Action() gdnative.String {
+	return o.GetAction()
+}
+
+// Pressed will return the value of the "pressed" property.
+func (o *InputEventAction) Pressed() gdnative.Bool {
+	return o.IsPressed()
+}
+
 // InputEventActionImplementer is an interface that implements the methods
 // of the InputEventAction class.
 type InputEventActionImplementer interface {
-	InputEventImplementer
+	godot.InputEventImplementer
 	GetAction() gdnative.String
 	SetAction(action gdnative.String)
 	SetPressed(pressed gdnative.Bool)
+	Action() gdnative.String
+	Pressed() gdnative.Bool
 }
@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventGestureFromPointer(ptr gdnative.Pointer) InputEventGesture {
-func newInputEventGestureFromPointer(ptr gdnative.Pointer) InputEventGesture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventGesture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type InputEventGesture struct {
-	InputEventWithModifiers
+	godot.InputEventWithModifiers
 	owner gdnative.Object
 }
 
@@ -34,9 +25,16 @@ func (o *InputEventGesture) BaseClass() string {
 	return "InputEventGesture"
 }
 
+// Method binds of the InputEventGesture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventGestureGetPosition = gdnative.NewLazyMethodBind("InputEventGesture", "get_position")
+	methodBindInputEventGestureSetPosition = gdnative.NewLazyMethodBind("InputEventGesture", "set_position")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventGesture) GetPosition() gdnative.Vector2 {
 	//log.Println("Calling InputEventGesture.GetPosition()")
@@ -45,7 +43,7 @@ func (o *InputEventGesture) GetPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventGesture", "get_position")
+	methodBind := methodBindInputEventGestureGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +56,8 @@ func (o *InputEventGesture) GetPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *InputEventGesture) SetPosition(position gdnative.Vector2) {
 	//log.Println("Calling InputEventGesture.SetPosition()")
@@ -69,7 +67,7 @@ func (o *InputEventGesture) SetPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventGesture", "set_position")
+	methodBind := methodBindInputEventGestureSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +76,16 @@ func (o *InputEventGesture) SetPosition(position gdnative.Vector2) {
 
 }
 
+// Position will return the value of the "position" property.
+func (o *InputEventGesture) Position() gdnative.Vector2 {
+	return o.GetPosition()
+}
+
 // InputEventGestureImplementer is an interface that implements the methods
 // of the InputEventGesture class.
 type InputEventGestureImplementer interface {
-	InputEventWithModifiersImplementer
+	godot.InputEventWithModifiersImplementer
 	GetPosition() gdnative.Vector2
 	SetPosition(position gdnative.Vector2)
+	Position() gdnative.Vector2
 }
@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,48 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventJoypadButtonFromPointer(ptr gdnative.Pointer) InputEventJoypadButton {
-func newInputEventJoypadButtonFromPointer(ptr gdnative.Pointer) InputEventJoypadButton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventJoypadButton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Input event type for gamepad buttons. For joysticks see [InputEventJoypadMotion].
 */
 type InputEventJoypadButton struct {
-	InputEvent
+	godot.InputEvent
 	owner gdnative.Object
 }
 
+// classConstructorInputEventJoypadButton is the class constructor of the InputEventJoypadButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventJoypadButton = gdnative.NewLazyClassConstructor("InputEventJoypadButton")
+
+// NewInputEventJoypadButton will create a new instance of the InputEventJoypadButton class.
+// InputEventJoypadButton is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventJoypadButton() *InputEventJoypadButton {
+	obj := &InputEventJoypadButton{}
+	obj.SetBaseObject(classConstructorInputEventJoypadButton.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventJoypadButton) BaseClass() string {
 	return "InputEventJoypadButton"
 }
 
+// Method binds of the InputEventJoypadButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventJoypadButtonGetButtonIndex = gdnative.NewLazyMethodBind("InputEventJoypadButton", "get_button_index")
+	methodBindInputEventJoypadButtonGetPressure    = gdnative.NewLazyMethodBind("InputEventJoypadButton", "get_pressure")
+	methodBindInputEventJoypadButtonSetButtonIndex = gdnative.NewLazyMethodBind("InputEventJoypadButton", "set_button_index")
+	methodBindInputEventJoypadButtonSetPressed     = gdnative.NewLazyMethodBind("InputEventJoypadButton", "set_pressed")
+	methodBindInputEventJoypadButtonSetPressure    = gdnative.NewLazyMethodBind("InputEventJoypadButton", "set_pressure")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventJoypadButton) GetButtonIndex() gdnative.Int {
 	//log.Println("Calling InputEventJoypadButton.GetButtonIndex()")
@@ -45,7 +64,7 @@ func (o *InputEventJoypadButton) GetButtonIndex() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadButton", "get_button_index")
+	methodBind := methodBindInputEventJoypadButtonGetButtonIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +77,8 @@ func (o *InputEventJoypadButton) GetButtonIndex() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *InputEventJoypadButton) GetPressure() gdnative.Real {
 	//log.Println("Calling InputEventJoypadButton.GetPressure()")
@@ -68,7 +87,7 @@ func (o *InputEventJoypadButton) GetPressure() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadButton", "get_pressure")
+	methodBind := methodBindInputEventJoypadButtonGetPressure.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +100,8 @@ func (o *InputEventJoypadButton) GetPressure() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false button_index int}], Returns: void
+	        Undocumented
+		Args: [{ false button_index int}], Returns: void
 */
 func (o *InputEventJoypadButton) SetButtonIndex(buttonIndex gdnative.Int) {
 	//log.Println("Calling InputEventJoypadButton.SetButtonIndex()")
@@ -92,7 +111,7 @@ func (o *InputEventJoypadButton) SetButtonIndex(buttonIndex gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(buttonIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadButton", "set_button_index")
+	methodBind := methodBindInputEventJoypadButtonSetButtonIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +121,8 @@ func (o *InputEventJoypadButton) SetButtonIndex(buttonIndex gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pressed bool}], Returns: void
+	        Undocumented
+		Args: [{ false pressed bool}], Returns: void
 */
 func (o *InputEventJoypadButton) SetPressed(pressed gdnative.Bool) {
 	//log.Println("Calling InputEventJoypadButton.SetPressed()")
@@ -113,7 +132,7 @@ func (o *InputEventJoypadButton) SetPressed(pressed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(pressed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadButton", "set_pressed")
+	methodBind := methodBindInputEventJoypadButtonSetPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +142,8 @@ func (o *InputEventJoypadButton) SetPressed(pressed gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pressure float}], Returns: void
+	        Undocumented
+		Args: [{ false pressure float}], Returns: void
 */
 func (o *InputEventJoypadButton) SetPressure(pressure gdnative.Real) {
 	//log.Println("Calling InputEventJoypadButton.SetPressure()")
@@ -134,7 +153,7 @@ func (o *InputEventJoypadButton) SetPressure(pressure gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(pressure)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadButton", "set_pressure")
+	methodBind := methodBindInputEventJoypadButtonSetPressure.Get()
 
 	// Call the parent method.
 	// void
@@ -143,13 +162,31 @@ func (o *InputEventJoypadButton) SetPressure(pressure gdnative.Real) {
 
 }
 
+// ButtonIndex will return the value of the "button_index" property.
+func (o *InputEventJoypadButton) ButtonIndex() gdnative.Int {
+	return o.GetButtonIndex()
+}
+
+// Pressed will return the value of the "pressed" property.
+func (o *InputEventJoypadButton) Pressed() gdnative.Bool {
+	return o.IsPressed()
+}
+
+// Pressure will return the value of the "pressure" property.
+func (o *InputEventJoypadButton) Pressure() gdnative.Real {
+	return o.GetPressure()
+}
+
 // InputEventJoypadButtonImplementer is an interface that implements the methods
 // of the InputEventJoypadButton class.
 type InputEventJoypadButtonImplementer interface {
-	InputEventImplementer
+	godot.InputEventImplementer
 	GetButtonIndex() gdnative.Int
 	GetPressure() gdnative.Real
 	SetButtonIndex(buttonIndex gdnative.Int)
 	SetPressed(pressed gdnative.Bool)
 	SetPressure(pressure gdnative.Real)
+	ButtonIndex() gdnative.Int
+	Pressed() gdnative.Bool
+	Pressure() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventJoypadMotionFromPointer(ptr gdnative.Pointer) InputEventJoypadMotion {
-func newInputEventJoypadMotionFromPointer(ptr gdnative.Pointer) InputEventJoypadMotion {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventJoypadMotion{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Stores information about joystick motions. One [code]InputEventJoypadMotion[/code] represents one axis at a time.
 */
 type InputEventJoypadMotion struct {
-	InputEvent
+	godot.InputEvent
 	owner gdnative.Object
 }
 
+// classConstructorInputEventJoypadMotion is the class constructor of the InputEventJoypadMotion class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventJoypadMotion = gdnative.NewLazyClassConstructor("InputEventJoypadMotion")
+
+// NewInputEventJoypadMotion will create a new instance of the InputEventJoypadMotion class.
+// InputEventJoypadMotion is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventJoypadMotion() *InputEventJoypadMotion {
+	obj := &InputEventJoypadMotion{}
+	obj.SetBaseObject(classConstructorInputEventJoypadMotion.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventJoypadMotion) BaseClass() string {
 	return "InputEventJoypadMotion"
 }
 
+// Method binds of the InputEventJoypadMotion class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventJoypadMotionGetAxis      = gdnative.NewLazyMethodBind("InputEventJoypadMotion", "get_axis")
+	methodBindInputEventJoypadMotionGetAxisValue = gdnative.NewLazyMethodBind("InputEventJoypadMotion", "get_axis_value")
+	methodBindInputEventJoypadMotionSetAxis      = gdnative.NewLazyMethodBind("InputEventJoypadMotion", "set_axis")
+	methodBindInputEventJoypadMotionSetAxisValue = gdnative.NewLazyMethodBind("InputEventJoypadMotion", "set_axis_value")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventJoypadMotion) GetAxis() gdnative.Int {
 	//log.Println("Calling InputEventJoypadMotion.GetAxis()")
@@ -45,7 +63,7 @@ func (o *InputEventJoypadMotion) GetAxis() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadMotion", "get_axis")
+	methodBind := methodBindInputEventJoypadMotionGetAxis.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +76,8 @@ func (o *InputEventJoypadMotion) GetAxis() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *InputEventJoypadMotion) GetAxisValue() gdnative.Real {
 	//log.Println("Calling InputEventJoypadMotion.GetAxisValue()")
@@ -68,7 +86,7 @@ func (o *InputEventJoypadMotion) GetAxisValue() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadMotion", "get_axis_value")
+	methodBind := methodBindInputEventJoypadMotionGetAxisValue.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +99,8 @@ func (o *InputEventJoypadMotion) GetAxisValue() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false axis int}], Returns: void
+	        Undocumented
+		Args: [{ false axis int}], Returns: void
 */
 func (o *InputEventJoypadMotion) SetAxis(axis gdnative.Int) {
 	//log.Println("Calling InputEventJoypadMotion.SetAxis()")
@@ -92,7 +110,7 @@ func (o *InputEventJoypadMotion) SetAxis(axis gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadMotion", "set_axis")
+	methodBind := methodBindInputEventJoypadMotionSetAxis.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *InputEventJoypadMotion) SetAxis(axis gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false axis_value float}], Returns: void
+	        Undocumented
+		Args: [{ false axis_value float}], Returns: void
 */
 func (o *InputEventJoypadMotion) SetAxisValue(axisValue gdnative.Real) {
 	//log.Println("Calling InputEventJoypadMotion.SetAxisValue()")
@@ -113,7 +131,7 @@ func (o *InputEventJoypadMotion) SetAxisValue(axisValue gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(axisValue)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventJoypadMotion", "set_axis_value")
+	methodBind := methodBindInputEventJoypadMotionSetAxisValue.Get()
 
 	// Call the parent method.
 	// void
@@ -122,12 +140,24 @@ func (o *InputEventJoypadMotion) SetAxisValue(axisValue gdnative.Real) {
 
 }
 
+// Axis will return the value of the "axis" property.
+func (o *InputEventJoypadMotion) Axis() gdnative.Int {
+	return o.GetAxis()
+}
+
+// AxisValue will return the value of the "axis_value" property.
+func (o *InputEventJoypadMotion) AxisValue() gdnative.Real {
+	return o.GetAxisValue()
+}
+
 // InputEventJoypadMotionImplementer is an interface that implements the methods
 // of the InputEventJoypadMotion class.
 type InputEventJoypadMotionImplementer interface {
-	InputEventImplementer
+	godot.InputEventImplementer
 	GetAxis() gdnative.Int
 	GetAxisValue() gdnative.Real
 	SetAxis(axis gdnative.Int)
 	SetAxisValue(axisValue gdnative.Real)
+	Axis() gdnative.Int
+	AxisValue() gdnative.Real
 }
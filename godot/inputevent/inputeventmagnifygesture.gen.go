@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,44 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventMagnifyGestureFromPointer(ptr gdnative.Pointer) InputEventMagnifyGesture {
-func newInputEventMagnifyGestureFromPointer(ptr gdnative.Pointer) InputEventMagnifyGesture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventMagnifyGesture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type InputEventMagnifyGesture struct {
 	InputEventGesture
 	owner gdnative.Object
 }
 
+// classConstructorInputEventMagnifyGesture is the class constructor of the InputEventMagnifyGesture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventMagnifyGesture = gdnative.NewLazyClassConstructor("InputEventMagnifyGesture")
+
+// NewInputEventMagnifyGesture will create a new instance of the InputEventMagnifyGesture class.
+// InputEventMagnifyGesture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventMagnifyGesture() *InputEventMagnifyGesture {
+	obj := &InputEventMagnifyGesture{}
+	obj.SetBaseObject(classConstructorInputEventMagnifyGesture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventMagnifyGesture) BaseClass() string {
 	return "InputEventMagnifyGesture"
 }
 
+// Method binds of the InputEventMagnifyGesture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventMagnifyGestureGetFactor = gdnative.NewLazyMethodBind("InputEventMagnifyGesture", "get_factor")
+	methodBindInputEventMagnifyGestureSetFactor = gdnative.NewLazyMethodBind("InputEventMagnifyGesture", "set_factor")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *InputEventMagnifyGesture) GetFactor() gdnative.Real {
 	//log.Println("Calling InputEventMagnifyGesture.GetFactor()")
@@ -45,7 +60,7 @@ func (o *InputEventMagnifyGesture) GetFactor() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMagnifyGesture", "get_factor")
+	methodBind := methodBindInputEventMagnifyGestureGetFactor.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +73,8 @@ func (o *InputEventMagnifyGesture) GetFactor() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false factor float}], Returns: void
+	        Undocumented
+		Args: [{ false factor float}], Returns: void
 */
 func (o *InputEventMagnifyGesture) SetFactor(factor gdnative.Real) {
 	//log.Println("Calling InputEventMagnifyGesture.SetFactor()")
@@ -69,7 +84,7 @@ func (o *InputEventMagnifyGesture) SetFactor(factor gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(factor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMagnifyGesture", "set_factor")
+	methodBind := methodBindInputEventMagnifyGestureSetFactor.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +93,16 @@ func (o *InputEventMagnifyGesture) SetFactor(factor gdnative.Real) {
 
 }
 
+// Factor will return the value of the "factor" property.
+func (o *InputEventMagnifyGesture) Factor() gdnative.Real {
+	return o.GetFactor()
+}
+
 // InputEventMagnifyGestureImplementer is an interface that implements the methods
 // of the InputEventMagnifyGesture class.
 type InputEventMagnifyGestureImplementer interface {
 	InputEventGestureImplementer
 	GetFactor() gdnative.Real
 	SetFactor(factor gdnative.Real)
+	Factor() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventMouseFromPointer(ptr gdnative.Pointer) InputEventMouse {
-func newInputEventMouseFromPointer(ptr gdnative.Pointer) InputEventMouse {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventMouse{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Stores general mouse events information.
 */
 type InputEventMouse struct {
-	InputEventWithModifiers
+	godot.InputEventWithModifiers
 	owner gdnative.Object
 }
 
@@ -34,9 +26,20 @@ func (o *InputEventMouse) BaseClass() string {
 	return "InputEventMouse"
 }
 
+// Method binds of the InputEventMouse class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventMouseGetButtonMask     = gdnative.NewLazyMethodBind("InputEventMouse", "get_button_mask")
+	methodBindInputEventMouseGetGlobalPosition = gdnative.NewLazyMethodBind("InputEventMouse", "get_global_position")
+	methodBindInputEventMouseGetPosition       = gdnative.NewLazyMethodBind("InputEventMouse", "get_position")
+	methodBindInputEventMouseSetButtonMask     = gdnative.NewLazyMethodBind("InputEventMouse", "set_button_mask")
+	methodBindInputEventMouseSetGlobalPosition = gdnative.NewLazyMethodBind("InputEventMouse", "set_global_position")
+	methodBindInputEventMouseSetPosition       = gdnative.NewLazyMethodBind("InputEventMouse", "set_position")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventMouse) GetButtonMask() gdnative.Int {
 	//log.Println("Calling InputEventMouse.GetButtonMask()")
@@ -45,7 +48,7 @@ func (o *InputEventMouse) GetButtonMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouse", "get_button_mask")
+	methodBind := methodBindInputEventMouseGetButtonMask.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +61,8 @@ func (o *InputEventMouse) GetButtonMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventMouse) GetGlobalPosition() gdnative.Vector2 {
 	//log.Println("Calling InputEventMouse.GetGlobalPosition()")
@@ -68,7 +71,7 @@ func (o *InputEventMouse) GetGlobalPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouse", "get_global_position")
+	methodBind := methodBindInputEventMouseGetGlobalPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +84,8 @@ func (o *InputEventMouse) GetGlobalPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventMouse) GetPosition() gdnative.Vector2 {
 	//log.Println("Calling InputEventMouse.GetPosition()")
@@ -91,7 +94,7 @@ func (o *InputEventMouse) GetPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouse", "get_position")
+	methodBind := methodBindInputEventMouseGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -104,8 +107,8 @@ func (o *InputEventMouse) GetPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false button_mask int}], Returns: void
+	        Undocumented
+		Args: [{ false button_mask int}], Returns: void
 */
 func (o *InputEventMouse) SetButtonMask(buttonMask gdnative.Int) {
 	//log.Println("Calling InputEventMouse.SetButtonMask()")
@@ -115,7 +118,7 @@ func (o *InputEventMouse) SetButtonMask(buttonMask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(buttonMask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouse", "set_button_mask")
+	methodBind := methodBindInputEventMouseSetButtonMask.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +128,8 @@ func (o *InputEventMouse) SetButtonMask(buttonMask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false global_position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false global_position Vector2}], Returns: void
 */
 func (o *InputEventMouse) SetGlobalPosition(globalPosition gdnative.Vector2) {
 	//log.Println("Calling InputEventMouse.SetGlobalPosition()")
@@ -136,7 +139,7 @@ func (o *InputEventMouse) SetGlobalPosition(globalPosition gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(globalPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouse", "set_global_position")
+	methodBind := methodBindInputEventMouseSetGlobalPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +149,8 @@ func (o *InputEventMouse) SetGlobalPosition(globalPosition gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *InputEventMouse) SetPosition(position gdnative.Vector2) {
 	//log.Println("Calling InputEventMouse.SetPosition()")
@@ -157,7 +160,7 @@ func (o *InputEventMouse) SetPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouse", "set_position")
+	methodBind := methodBindInputEventMouseSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -166,14 +169,32 @@ func (o *InputEventMouse) SetPosition(position gdnative.Vector2) {
 
 }
 
+// ButtonMask will return the value of the "button_mask" property.
+func (o *InputEventMouse) ButtonMask() gdnative.Int {
+	return o.GetButtonMask()
+}
+
+// GlobalPosition will return the value of the "global_position" property.
+func (o *InputEventMouse) GlobalPosition() gdnative.Vector2 {
+	return o.GetGlobalPosition()
+}
+
+// Position will return the value of the "position" property.
+func (o *InputEventMouse) Position() gdnative.Vector2 {
+	return o.GetPosition()
+}
+
 // InputEventMouseImplementer is an interface that implements the methods
 // of the InputEventMouse class.
 type InputEventMouseImplementer interface {
-	InputEventWithModifiersImplementer
+	godot.InputEventWithModifiersImplementer
 	GetButtonMask() gdnative.Int
 	GetGlobalPosition() gdnative.Vector2
 	GetPosition() gdnative.Vector2
 	SetButtonMask(buttonMask gdnative.Int)
 	SetGlobalPosition(globalPosition gdnative.Vector2)
 	SetPosition(position gdnative.Vector2)
+	ButtonMask() gdnative.Int
+	GlobalPosition() gdnative.Vector2
+	Position() gdnative.Vector2
 }
@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventMouseButtonFromPointer(ptr gdnative.Pointer) InputEventMouseButton {
-func newInputEventMouseButtonFromPointer(ptr gdnative.Pointer) InputEventMouseButton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventMouseButton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Contains mouse click information. See [method Node._input].
 */
@@ -30,13 +22,42 @@ type InputEventMouseButton struct {
 	owner gdnative.Object
 }
 
+// classConstructorInputEventMouseButton is the class constructor of the InputEventMouseButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventMouseButton = gdnative.NewLazyClassConstructor("InputEventMouseButton")
+
+// NewInputEventMouseButton will create a new instance of the InputEventMouseButton class.
+// InputEventMouseButton is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventMouseButton() *InputEventMouseButton {
+	obj := &InputEventMouseButton{}
+	obj.SetBaseObject(classConstructorInputEventMouseButton.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventMouseButton) BaseClass() string {
 	return "InputEventMouseButton"
 }
 
+// Method binds of the InputEventMouseButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventMouseButtonGetButtonIndex = gdnative.NewLazyMethodBind("InputEventMouseButton", "get_button_index")
+	methodBindInputEventMouseButtonGetFactor      = gdnative.NewLazyMethodBind("InputEventMouseButton", "get_factor")
+	methodBindInputEventMouseButtonIsDoubleclick  = gdnative.NewLazyMethodBind("InputEventMouseButton", "is_doubleclick")
+	methodBindInputEventMouseButtonSetButtonIndex = gdnative.NewLazyMethodBind("InputEventMouseButton", "set_button_index")
+	methodBindInputEventMouseButtonSetDoubleclick = gdnative.NewLazyMethodBind("InputEventMouseButton", "set_doubleclick")
+	methodBindInputEventMouseButtonSetFactor      = gdnative.NewLazyMethodBind("InputEventMouseButton", "set_factor")
+	methodBindInputEventMouseButtonSetPressed     = gdnative.NewLazyMethodBind("InputEventMouseButton", "set_pressed")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventMouseButton) GetButtonIndex() gdnative.Int {
 	//log.Println("Calling InputEventMouseButton.GetButtonIndex()")
@@ -45,7 +66,7 @@ func (o *InputEventMouseButton) GetButtonIndex() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseButton", "get_button_index")
+	methodBind := methodBindInputEventMouseButtonGetButtonIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +79,8 @@ func (o *InputEventMouseButton) GetButtonIndex() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *InputEventMouseButton) GetFactor() gdnative.Real {
 	//log.Println("Calling InputEventMouseButton.GetFactor()")
@@ -68,7 +89,7 @@ func (o *InputEventMouseButton) GetFactor() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseButton", "get_factor")
+	methodBind := methodBindInputEventMouseButtonGetFactor.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +102,8 @@ func (o *InputEventMouseButton) GetFactor() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *InputEventMouseButton) IsDoubleclick() gdnative.Bool {
 	//log.Println("Calling InputEventMouseButton.IsDoubleclick()")
@@ -91,7 +112,7 @@ func (o *InputEventMouseButton) IsDoubleclick() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseButton", "is_doubleclick")
+	methodBind := methodBindInputEventMouseButtonIsDoubleclick.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +125,8 @@ func (o *InputEventMouseButton) IsDoubleclick() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false button_index int}], Returns: void
+	        Undocumented
+		Args: [{ false button_index int}], Returns: void
 */
 func (o *InputEventMouseButton) SetButtonIndex(buttonIndex gdnative.Int) {
 	//log.Println("Calling InputEventMouseButton.SetButtonIndex()")
@@ -115,7 +136,7 @@ func (o *InputEventMouseButton) SetButtonIndex(buttonIndex gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(buttonIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseButton", "set_button_index")
+	methodBind := methodBindInputEventMouseButtonSetButtonIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +146,8 @@ func (o *InputEventMouseButton) SetButtonIndex(buttonIndex gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false doubleclick bool}], Returns: void
+	        Undocumented
+		Args: [{ false doubleclick bool}], Returns: void
 */
 func (o *InputEventMouseButton) SetDoubleclick(doubleclick gdnative.Bool) {
 	//log.Println("Calling InputEventMouseButton.SetDoubleclick()")
@@ -136,7 +157,7 @@ func (o *InputEventMouseButton) SetDoubleclick(doubleclick gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(doubleclick)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseButton", "set_doubleclick")
+	methodBind := methodBindInputEventMouseButtonSetDoubleclick.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +167,8 @@ func (o *InputEventMouseButton) SetDoubleclick(doubleclick gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false factor float}], Returns: void
+	        Undocumented
+		Args: [{ false factor float}], Returns: void
 */
 func (o *InputEventMouseButton) SetFactor(factor gdnative.Real) {
 	//log.Println("Calling InputEventMouseButton.SetFactor()")
@@ -157,7 +178,7 @@ func (o *InputEventMouseButton) SetFactor(factor gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(factor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseButton", "set_factor")
+	methodBind := methodBindInputEventMouseButtonSetFactor.Get()
 
 	// Call the parent method.
 	// void
@@ -167,8 +188,8 @@ func (o *InputEventMouseButton) SetFactor(factor gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pressed bool}], Returns: void
+	        Undocumented
+		Args: [{ false pressed bool}], Returns: void
 */
 func (o *InputEventMouseButton) SetPressed(pressed gdnative.Bool) {
 	//log.Println("Calling InputEventMouseButton.SetPressed()")
@@ -178,7 +199,7 @@ func (o *InputEventMouseButton) SetPressed(pressed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(pressed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseButton", "set_pressed")
+	methodBind := methodBindInputEventMouseButtonSetPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -187,6 +208,26 @@ func (o *InputEventMouseButton) SetPressed(pressed gdnative.Bool) {
 
 }
 
+// ButtonIndex will return the value of the "button_index" property.
+func (o *InputEventMouseButton) ButtonIndex() gdnative.Int {
+	return o.GetButtonIndex()
+}
+
+// Doubleclick will return the value of the "doubleclick" property.
+func (o *InputEventMouseButton) Doubleclick() gdnative.Bool {
+	return o.IsDoubleclick()
+}
+
+// Factor will return the value of the "factor" property.
+func (o *InputEventMouseButton) Factor() gdnative.Real {
+	return o.GetFactor()
+}
+
+// Pressed will return the value of the "pressed" property.
+func (o *InputEventMouseButton) Pressed() gdnative.Bool {
+	return o.IsPressed()
+}
+
 // InputEventMouseButtonImplementer is an interface that implements the methods
 // of the InputEventMouseButton class.
 type InputEventMouseButtonImplementer interface {
@@ -198,4 +239,8 @@ type InputEventMouseButtonImplementer interface {
 	SetDoubleclick(doubleclick gdnative.Bool)
 	SetFactor(factor gdnative.Real)
 	SetPressed(pressed gdnative.Bool)
+	ButtonIndex() gdnative.Int
+	Doubleclick() gdnative.Bool
+	Factor() gdnative.Real
+	Pressed() gdnative.Bool
 }
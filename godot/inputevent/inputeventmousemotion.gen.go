@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventMouseMotionFromPointer(ptr gdnative.Pointer) InputEventMouseMotion {
-func newInputEventMouseMotionFromPointer(ptr gdnative.Pointer) InputEventMouseMotion {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventMouseMotion{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Contains mouse motion information. Supports relative, absolute positions and speed. See [method Node._input].
 */
@@ -30,13 +22,39 @@ type InputEventMouseMotion struct {
 	owner gdnative.Object
 }
 
+// classConstructorInputEventMouseMotion is the class constructor of the InputEventMouseMotion class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventMouseMotion = gdnative.NewLazyClassConstructor("InputEventMouseMotion")
+
+// NewInputEventMouseMotion will create a new instance of the InputEventMouseMotion class.
+// InputEventMouseMotion is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventMouseMotion() *InputEventMouseMotion {
+	obj := &InputEventMouseMotion{}
+	obj.SetBaseObject(classConstructorInputEventMouseMotion.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventMouseMotion) BaseClass() string {
 	return "InputEventMouseMotion"
 }
 
+// Method binds of the InputEventMouseMotion class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventMouseMotionGetRelative = gdnative.NewLazyMethodBind("InputEventMouseMotion", "get_relative")
+	methodBindInputEventMouseMotionGetSpeed    = gdnative.NewLazyMethodBind("InputEventMouseMotion", "get_speed")
+	methodBindInputEventMouseMotionSetRelative = gdnative.NewLazyMethodBind("InputEventMouseMotion", "set_relative")
+	methodBindInputEventMouseMotionSetSpeed    = gdnative.NewLazyMethodBind("InputEventMouseMotion", "set_speed")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventMouseMotion) GetRelative() gdnative.Vector2 {
 	//log.Println("Calling InputEventMouseMotion.GetRelative()")
@@ -45,7 +63,7 @@ func (o *InputEventMouseMotion) GetRelative() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseMotion", "get_relative")
+	methodBind := methodBindInputEventMouseMotionGetRelative.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +76,8 @@ func (o *InputEventMouseMotion) GetRelative() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventMouseMotion) GetSpeed() gdnative.Vector2 {
 	//log.Println("Calling InputEventMouseMotion.GetSpeed()")
@@ -68,7 +86,7 @@ func (o *InputEventMouseMotion) GetSpeed() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseMotion", "get_speed")
+	methodBind := methodBindInputEventMouseMotionGetSpeed.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +99,8 @@ func (o *InputEventMouseMotion) GetSpeed() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false relative Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false relative Vector2}], Returns: void
 */
 func (o *InputEventMouseMotion) SetRelative(relative gdnative.Vector2) {
 	//log.Println("Calling InputEventMouseMotion.SetRelative()")
@@ -92,7 +110,7 @@ func (o *InputEventMouseMotion) SetRelative(relative gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(relative)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseMotion", "set_relative")
+	methodBind := methodBindInputEventMouseMotionSetRelative.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *InputEventMouseMotion) SetRelative(relative gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false speed Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false speed Vector2}], Returns: void
 */
 func (o *InputEventMouseMotion) SetSpeed(speed gdnative.Vector2) {
 	//log.Println("Calling InputEventMouseMotion.SetSpeed()")
@@ -113,7 +131,7 @@ func (o *InputEventMouseMotion) SetSpeed(speed gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(speed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventMouseMotion", "set_speed")
+	methodBind := methodBindInputEventMouseMotionSetSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -122,6 +140,16 @@ func (o *InputEventMouseMotion) SetSpeed(speed gdnative.Vector2) {
 
 }
 
+// Relative will return the value of the "relative" property.
+func (o *InputEventMouseMotion) Relative() gdnative.Vector2 {
+	return o.GetRelative()
+}
+
+// Speed will return the value of the "speed" property.
+func (o *InputEventMouseMotion) Speed() gdnative.Vector2 {
+	return o.GetSpeed()
+}
+
 // InputEventMouseMotionImplementer is an interface that implements the methods
 // of the InputEventMouseMotion class.
 type InputEventMouseMotionImplementer interface {
@@ -130,4 +158,6 @@ type InputEventMouseMotionImplementer interface {
 	GetSpeed() gdnative.Vector2
 	SetRelative(relative gdnative.Vector2)
 	SetSpeed(speed gdnative.Vector2)
+	Relative() gdnative.Vector2
+	Speed() gdnative.Vector2
 }
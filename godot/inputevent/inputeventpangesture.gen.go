@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,44 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventPanGestureFromPointer(ptr gdnative.Pointer) InputEventPanGesture {
-func newInputEventPanGestureFromPointer(ptr gdnative.Pointer) InputEventPanGesture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventPanGesture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type InputEventPanGesture struct {
 	InputEventGesture
 	owner gdnative.Object
 }
 
+// classConstructorInputEventPanGesture is the class constructor of the InputEventPanGesture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventPanGesture = gdnative.NewLazyClassConstructor("InputEventPanGesture")
+
+// NewInputEventPanGesture will create a new instance of the InputEventPanGesture class.
+// InputEventPanGesture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventPanGesture() *InputEventPanGesture {
+	obj := &InputEventPanGesture{}
+	obj.SetBaseObject(classConstructorInputEventPanGesture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventPanGesture) BaseClass() string {
 	return "InputEventPanGesture"
 }
 
+// Method binds of the InputEventPanGesture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventPanGestureGetDelta = gdnative.NewLazyMethodBind("InputEventPanGesture", "get_delta")
+	methodBindInputEventPanGestureSetDelta = gdnative.NewLazyMethodBind("InputEventPanGesture", "set_delta")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventPanGesture) GetDelta() gdnative.Vector2 {
 	//log.Println("Calling InputEventPanGesture.GetDelta()")
@@ -45,7 +60,7 @@ func (o *InputEventPanGesture) GetDelta() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventPanGesture", "get_delta")
+	methodBind := methodBindInputEventPanGestureGetDelta.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +73,8 @@ func (o *InputEventPanGesture) GetDelta() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false delta Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false delta Vector2}], Returns: void
 */
 func (o *InputEventPanGesture) SetDelta(delta gdnative.Vector2) {
 	//log.Println("Calling InputEventPanGesture.SetDelta()")
@@ -69,7 +84,7 @@ func (o *InputEventPanGesture) SetDelta(delta gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventPanGesture", "set_delta")
+	methodBind := methodBindInputEventPanGestureSetDelta.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +93,16 @@ func (o *InputEventPanGesture) SetDelta(delta gdnative.Vector2) {
 
 }
 
+// Delta will return the value of the "delta" property.
+func (o *InputEventPanGesture) Delta() gdnative.Vector2 {
+	return o.GetDelta()
+}
+
 // InputEventPanGestureImplementer is an interface that implements the methods
 // of the InputEventPanGesture class.
 type InputEventPanGestureImplementer interface {
 	InputEventGestureImplementer
 	GetDelta() gdnative.Vector2
 	SetDelta(delta gdnative.Vector2)
+	Delta() gdnative.Vector2
 }
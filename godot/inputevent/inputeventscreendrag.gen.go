@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,51 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventScreenDragFromPointer(ptr gdnative.Pointer) InputEventScreenDrag {
-func newInputEventScreenDragFromPointer(ptr gdnative.Pointer) InputEventScreenDrag {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventScreenDrag{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Contains screen drag information. See [method Node._input].
 */
 type InputEventScreenDrag struct {
-	InputEvent
+	godot.InputEvent
 	owner gdnative.Object
 }
 
+// classConstructorInputEventScreenDrag is the class constructor of the InputEventScreenDrag class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventScreenDrag = gdnative.NewLazyClassConstructor("InputEventScreenDrag")
+
+// NewInputEventScreenDrag will create a new instance of the InputEventScreenDrag class.
+// InputEventScreenDrag is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventScreenDrag() *InputEventScreenDrag {
+	obj := &InputEventScreenDrag{}
+	obj.SetBaseObject(classConstructorInputEventScreenDrag.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventScreenDrag) BaseClass() string {
 	return "InputEventScreenDrag"
 }
 
+// Method binds of the InputEventScreenDrag class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventScreenDragGetIndex    = gdnative.NewLazyMethodBind("InputEventScreenDrag", "get_index")
+	methodBindInputEventScreenDragGetPosition = gdnative.NewLazyMethodBind("InputEventScreenDrag", "get_position")
+	methodBindInputEventScreenDragGetRelative = gdnative.NewLazyMethodBind("InputEventScreenDrag", "get_relative")
+	methodBindInputEventScreenDragGetSpeed    = gdnative.NewLazyMethodBind("InputEventScreenDrag", "get_speed")
+	methodBindInputEventScreenDragSetIndex    = gdnative.NewLazyMethodBind("InputEventScreenDrag", "set_index")
+	methodBindInputEventScreenDragSetPosition = gdnative.NewLazyMethodBind("InputEventScreenDrag", "set_position")
+	methodBindInputEventScreenDragSetRelative = gdnative.NewLazyMethodBind("InputEventScreenDrag", "set_relative")
+	methodBindInputEventScreenDragSetSpeed    = gdnative.NewLazyMethodBind("InputEventScreenDrag", "set_speed")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventScreenDrag) GetIndex() gdnative.Int {
 	//log.Println("Calling InputEventScreenDrag.GetIndex()")
@@ -45,7 +67,7 @@ func (o *InputEventScreenDrag) GetIndex() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "get_index")
+	methodBind := methodBindInputEventScreenDragGetIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +80,8 @@ func (o *InputEventScreenDrag) GetIndex() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventScreenDrag) GetPosition() gdnative.Vector2 {
 	//log.Println("Calling InputEventScreenDrag.GetPosition()")
@@ -68,7 +90,7 @@ func (o *InputEventScreenDrag) GetPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "get_position")
+	methodBind := methodBindInputEventScreenDragGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +103,8 @@ func (o *InputEventScreenDrag) GetPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventScreenDrag) GetRelative() gdnative.Vector2 {
 	//log.Println("Calling InputEventScreenDrag.GetRelative()")
@@ -91,7 +113,7 @@ func (o *InputEventScreenDrag) GetRelative() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "get_relative")
+	methodBind := methodBindInputEventScreenDragGetRelative.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -104,8 +126,8 @@ func (o *InputEventScreenDrag) GetRelative() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventScreenDrag) GetSpeed() gdnative.Vector2 {
 	//log.Println("Calling InputEventScreenDrag.GetSpeed()")
@@ -114,7 +136,7 @@ func (o *InputEventScreenDrag) GetSpeed() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "get_speed")
+	methodBind := methodBindInputEventScreenDragGetSpeed.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -127,8 +149,8 @@ func (o *InputEventScreenDrag) GetSpeed() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false index int}], Returns: void
+	        Undocumented
+		Args: [{ false index int}], Returns: void
 */
 func (o *InputEventScreenDrag) SetIndex(index gdnative.Int) {
 	//log.Println("Calling InputEventScreenDrag.SetIndex()")
@@ -138,7 +160,7 @@ func (o *InputEventScreenDrag) SetIndex(index gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "set_index")
+	methodBind := methodBindInputEventScreenDragSetIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +170,8 @@ func (o *InputEventScreenDrag) SetIndex(index gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *InputEventScreenDrag) SetPosition(position gdnative.Vector2) {
 	//log.Println("Calling InputEventScreenDrag.SetPosition()")
@@ -159,7 +181,7 @@ func (o *InputEventScreenDrag) SetPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "set_position")
+	methodBind := methodBindInputEventScreenDragSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +191,8 @@ func (o *InputEventScreenDrag) SetPosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false relative Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false relative Vector2}], Returns: void
 */
 func (o *InputEventScreenDrag) SetRelative(relative gdnative.Vector2) {
 	//log.Println("Calling InputEventScreenDrag.SetRelative()")
@@ -180,7 +202,7 @@ func (o *InputEventScreenDrag) SetRelative(relative gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(relative)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "set_relative")
+	methodBind := methodBindInputEventScreenDragSetRelative.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +212,8 @@ func (o *InputEventScreenDrag) SetRelative(relative gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false speed Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false speed Vector2}], Returns: void
 */
 func (o *InputEventScreenDrag) SetSpeed(speed gdnative.Vector2) {
 	//log.Println("Calling InputEventScreenDrag.SetSpeed()")
@@ -201,7 +223,7 @@ func (o *InputEventScreenDrag) SetSpeed(speed gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(speed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenDrag", "set_speed")
+	methodBind := methodBindInputEventScreenDragSetSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -210,10 +232,30 @@ func (o *InputEventScreenDrag) SetSpeed(speed gdnative.Vector2) {
 
 }
 
+// Index will return the value of the "index" property.
+func (o *InputEventScreenDrag) Index() gdnative.Int {
+	return o.GetIndex()
+}
+
+// Position will return the value of the "position" property.
+func (o *InputEventScreenDrag) Position() gdnative.Vector2 {
+	return o.GetPosition()
+}
+
+// Relative will return the value of the "relative" property.
+func (o *InputEventScreenDrag) Relative() gdnative.Vector2 {
+	return o.GetRelative()
+}
+
+// Speed will return the value of the "speed" property.
+func (o *InputEventScreenDrag) Speed() gdnative.Vector2 {
+	return o.GetSpeed()
+}
+
 // InputEventScreenDragImplementer is an interface that implements the methods
 // of the InputEventScreenDrag class.
 type InputEventScreenDragImplementer interface {
-	InputEventImplementer
+	godot.InputEventImplementer
 	GetIndex() gdnative.Int
 	GetPosition() gdnative.Vector2
 	GetRelative() gdnative.Vector2
@@ -222,4 +264,8 @@ type InputEventScreenDragImplementer interface {
 	SetPosition(position gdnative.Vector2)
 	SetRelative(relative gdnative.Vector2)
 	SetSpeed(speed gdnative.Vector2)
+	Index() gdnative.Int
+	Position() gdnative.Vector2
+	Relative() gdnative.Vector2
+	Speed() gdnative.Vector2
 }
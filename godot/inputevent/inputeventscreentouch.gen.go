@@ -1,7 +1,8 @@
-package godot
+package inputevent
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,48 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventScreenTouchFromPointer(ptr gdnative.Pointer) InputEventScreenTouch {
-func newInputEventScreenTouchFromPointer(ptr gdnative.Pointer) InputEventScreenTouch {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventScreenTouch{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Stores multi-touch press/release information. Supports touch press, touch release and [member index] for multi-touch count and order.
 */
 type InputEventScreenTouch struct {
-	InputEvent
+	godot.InputEvent
 	owner gdnative.Object
 }
 
+// classConstructorInputEventScreenTouch is the class constructor of the InputEventScreenTouch class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventScreenTouch = gdnative.NewLazyClassConstructor("InputEventScreenTouch")
+
+// NewInputEventScreenTouch will create a new instance of the InputEventScreenTouch class.
+// InputEventScreenTouch is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventScreenTouch() *InputEventScreenTouch {
+	obj := &InputEventScreenTouch{}
+	obj.SetBaseObject(classConstructorInputEventScreenTouch.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventScreenTouch) BaseClass() string {
 	return "InputEventScreenTouch"
 }
 
+// Method binds of the InputEventScreenTouch class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventScreenTouchGetIndex    = gdnative.NewLazyMethodBind("InputEventScreenTouch", "get_index")
+	methodBindInputEventScreenTouchGetPosition = gdnative.NewLazyMethodBind("InputEventScreenTouch", "get_position")
+	methodBindInputEventScreenTouchSetIndex    = gdnative.NewLazyMethodBind("InputEventScreenTouch", "set_index")
+	methodBindInputEventScreenTouchSetPosition = gdnative.NewLazyMethodBind("InputEventScreenTouch", "set_position")
+	methodBindInputEventScreenTouchSetPressed  = gdnative.NewLazyMethodBind("InputEventScreenTouch", "set_pressed")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventScreenTouch) GetIndex() gdnative.Int {
 	//log.Println("Calling InputEventScreenTouch.GetIndex()")
@@ -45,7 +64,7 @@ func (o *InputEventScreenTouch) GetIndex() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenTouch", "get_index")
+	methodBind := methodBindInputEventScreenTouchGetIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +77,8 @@ func (o *InputEventScreenTouch) GetIndex() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *InputEventScreenTouch) GetPosition() gdnative.Vector2 {
 	//log.Println("Calling InputEventScreenTouch.GetPosition()")
@@ -68,7 +87,7 @@ func (o *InputEventScreenTouch) GetPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenTouch", "get_position")
+	methodBind := methodBindInputEventScreenTouchGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +100,8 @@ func (o *InputEventScreenTouch) GetPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false index int}], Returns: void
+	        Undocumented
+		Args: [{ false index int}], Returns: void
 */
 func (o *InputEventScreenTouch) SetIndex(index gdnative.Int) {
 	//log.Println("Calling InputEventScreenTouch.SetIndex()")
@@ -92,7 +111,7 @@ func (o *InputEventScreenTouch) SetIndex(index gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenTouch", "set_index")
+	methodBind := methodBindInputEventScreenTouchSetIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +121,8 @@ func (o *InputEventScreenTouch) SetIndex(index gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *InputEventScreenTouch) SetPosition(position gdnative.Vector2) {
 	//log.Println("Calling InputEventScreenTouch.SetPosition()")
@@ -113,7 +132,7 @@ func (o *InputEventScreenTouch) SetPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenTouch", "set_position")
+	methodBind := methodBindInputEventScreenTouchSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +142,8 @@ func (o *InputEventScreenTouch) SetPosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pressed bool}], Returns: void
+	        Undocumented
+		Args: [{ false pressed bool}], Returns: void
 */
 func (o *InputEventScreenTouch) SetPressed(pressed gdnative.Bool) {
 	//log.Println("Calling InputEventScreenTouch.SetPressed()")
@@ -134,7 +153,7 @@ func (o *InputEventScreenTouch) SetPressed(pressed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(pressed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventScreenTouch", "set_pressed")
+	methodBind := methodBindInputEventScreenTouchSetPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -143,13 +162,31 @@ func (o *InputEventScreenTouch) SetPressed(pressed gdnative.Bool) {
 
 }
 
+// Index will return the value of the "index" property.
+func (o *InputEventScreenTouch) Index() gdnative.Int {
+	return o.GetIndex()
+}
+
+// Position will return the value of the "position" property.
+func (o *InputEventScreenTouch) Position() gdnative.Vector2 {
+	return o.GetPosition()
+}
+
+// Pressed will return the value of the "pressed" property.
+func (o *InputEventScreenTouch) Pressed() gdnative.Bool {
+	return o.IsPressed()
+}
+
 // InputEventScreenTouchImplementer is an interface that implements the methods
 // of the InputEventScreenTouch class.
 type InputEventScreenTouchImplementer interface {
-	InputEventImplementer
+	godot.InputEventImplementer
 	GetIndex() gdnative.Int
 	GetPosition() gdnative.Vector2
 	SetIndex(index gdnative.Int)
 	SetPosition(position gdnative.Vector2)
 	SetPressed(pressed gdnative.Bool)
+	Index() gdnative.Int
+	Position() gdnative.Vector2
+	Pressed() gdnative.Bool
 }
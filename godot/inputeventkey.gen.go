@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventKeyFromPointer(ptr gdnative.Pointer) InputEventKey {
-func newInputEventKeyFromPointer(ptr gdnative.Pointer) InputEventKey {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventKey{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Stores key presses on the keyboard. Supports key presses, key releases and [member echo] events.
 */
@@ -30,13 +21,42 @@ type InputEventKey struct {
 	owner gdnative.Object
 }
 
+// classConstructorInputEventKey is the class constructor of the InputEventKey class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInputEventKey = gdnative.NewLazyClassConstructor("InputEventKey")
+
+// NewInputEventKey will create a new instance of the InputEventKey class.
+// InputEventKey is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewInputEventKey() *InputEventKey {
+	obj := &InputEventKey{}
+	obj.SetBaseObject(classConstructorInputEventKey.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InputEventKey) BaseClass() string {
 	return "InputEventKey"
 }
 
+// Method binds of the InputEventKey class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventKeyGetScancode              = gdnative.NewLazyMethodBind("InputEventKey", "get_scancode")
+	methodBindInputEventKeyGetScancodeWithModifiers = gdnative.NewLazyMethodBind("InputEventKey", "get_scancode_with_modifiers")
+	methodBindInputEventKeyGetUnicode               = gdnative.NewLazyMethodBind("InputEventKey", "get_unicode")
+	methodBindInputEventKeySetEcho                  = gdnative.NewLazyMethodBind("InputEventKey", "set_echo")
+	methodBindInputEventKeySetPressed               = gdnative.NewLazyMethodBind("InputEventKey", "set_pressed")
+	methodBindInputEventKeySetScancode              = gdnative.NewLazyMethodBind("InputEventKey", "set_scancode")
+	methodBindInputEventKeySetUnicode               = gdnative.NewLazyMethodBind("InputEventKey", "set_unicode")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventKey) GetScancode() gdnative.Int {
 	//log.Println("Calling InputEventKey.GetScancode()")
@@ -45,7 +65,7 @@ func (o *InputEventKey) GetScancode() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventKey", "get_scancode")
+	methodBind := methodBindInputEventKeyGetScancode.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +78,7 @@ func (o *InputEventKey) GetScancode() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *InputEventKey) GetScancodeWithModifiers() gdnative.Int {
 	//log.Println("Calling InputEventKey.GetScancodeWithModifiers()")
@@ -68,7 +87,7 @@ func (o *InputEventKey) GetScancodeWithModifiers() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventKey", "get_scancode_with_modifiers")
+	methodBind := methodBindInputEventKeyGetScancodeWithModifiers.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +100,8 @@ func (o *InputEventKey) GetScancodeWithModifiers() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *InputEventKey) GetUnicode() gdnative.Int {
 	//log.Println("Calling InputEventKey.GetUnicode()")
@@ -91,7 +110,7 @@ func (o *InputEventKey) GetUnicode() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventKey", "get_unicode")
+	methodBind := methodBindInputEventKeyGetUnicode.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +123,8 @@ func (o *InputEventKey) GetUnicode() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false echo bool}], Returns: void
+	        Undocumented
+		Args: [{ false echo bool}], Returns: void
 */
 func (o *InputEventKey) SetEcho(echo gdnative.Bool) {
 	//log.Println("Calling InputEventKey.SetEcho()")
@@ -115,7 +134,7 @@ func (o *InputEventKey) SetEcho(echo gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(echo)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventKey", "set_echo")
+	methodBind := methodBindInputEventKeySetEcho.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +144,8 @@ func (o *InputEventKey) SetEcho(echo gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pressed bool}], Returns: void
+	        Undocumented
+		Args: [{ false pressed bool}], Returns: void
 */
 func (o *InputEventKey) SetPressed(pressed gdnative.Bool) {
 	//log.Println("Calling InputEventKey.SetPressed()")
@@ -136,7 +155,7 @@ func (o *InputEventKey) SetPressed(pressed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(pressed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventKey", "set_pressed")
+	methodBind := methodBindInputEventKeySetPressed.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +165,8 @@ func (o *InputEventKey) SetPressed(pressed gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scancode int}], Returns: void
+	        Undocumented
+		Args: [{ false scancode int}], Returns: void
 */
 func (o *InputEventKey) SetScancode(scancode gdnative.Int) {
 	//log.Println("Calling InputEventKey.SetScancode()")
@@ -157,7 +176,7 @@ func (o *InputEventKey) SetScancode(scancode gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(scancode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventKey", "set_scancode")
+	methodBind := methodBindInputEventKeySetScancode.Get()
 
 	// Call the parent method.
 	// void
@@ -167,8 +186,8 @@ func (o *InputEventKey) SetScancode(scancode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false unicode int}], Returns: void
+	        Undocumented
+		Args: [{ false unicode int}], Returns: void
 */
 func (o *InputEventKey) SetUnicode(unicode gdnative.Int) {
 	//log.Println("Calling InputEventKey.SetUnicode()")
@@ -178,7 +197,7 @@ func (o *InputEventKey) SetUnicode(unicode gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(unicode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventKey", "set_unicode")
+	methodBind := methodBindInputEventKeySetUnicode.Get()
 
 	// Call the parent method.
 	// void
@@ -187,6 +206,26 @@ func (o *InputEventKey) SetUnicode(unicode gdnative.Int) {
 
 }
 
+// Echo will return the value of the "echo" property.
+func (o *InputEventKey) Echo() gdnative.Bool {
+	return o.IsEcho()
+}
+
+// Pressed will return the value of the "pressed" property.
+func (o *InputEventKey) Pressed() gdnative.Bool {
+	return o.IsPressed()
+}
+
+// Scancode will return the value of the "scancode" property.
+func (o *InputEventKey) Scancode() gdnative.Int {
+	return o.GetScancode()
+}
+
+// Unicode will return the value of the "unicode" property.
+func (o *InputEventKey) Unicode() gdnative.Int {
+	return o.GetUnicode()
+}
+
 // InputEventKeyImplementer is an interface that implements the methods
 // of the InputEventKey class.
 type InputEventKeyImplementer interface {
@@ -198,4 +237,8 @@ type InputEventKeyImplementer interface {
 	SetPressed(pressed gdnative.Bool)
 	SetScancode(scancode gdnative.Int)
 	SetUnicode(unicode gdnative.Int)
+	Echo() gdnative.Bool
+	Pressed() gdnative.Bool
+	Scancode() gdnative.Int
+	Unicode() gdnative.Int
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputEventWithModifiersFromPointer(ptr gdnative.Pointer) InputEventWithModifiers {
-func newInputEventWithModifiersFromPointer(ptr gdnative.Pointer) InputEventWithModifiers {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputEventWithModifiers{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Contains keys events information with modifiers support like [code]SHIFT[/code] or [code]ALT[/code]. See [method Node._input].
 */
@@ -34,9 +25,24 @@ func (o *InputEventWithModifiers) BaseClass() string {
 	return "InputEventWithModifiers"
 }
 
+// Method binds of the InputEventWithModifiers class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputEventWithModifiersGetAlt     = gdnative.NewLazyMethodBind("InputEventWithModifiers", "get_alt")
+	methodBindInputEventWithModifiersGetCommand = gdnative.NewLazyMethodBind("InputEventWithModifiers", "get_command")
+	methodBindInputEventWithModifiersGetControl = gdnative.NewLazyMethodBind("InputEventWithModifiers", "get_control")
+	methodBindInputEventWithModifiersGetMetakey = gdnative.NewLazyMethodBind("InputEventWithModifiers", "get_metakey")
+	methodBindInputEventWithModifiersGetShift   = gdnative.NewLazyMethodBind("InputEventWithModifiers", "get_shift")
+	methodBindInputEventWithModifiersSetAlt     = gdnative.NewLazyMethodBind("InputEventWithModifiers", "set_alt")
+	methodBindInputEventWithModifiersSetCommand = gdnative.NewLazyMethodBind("InputEventWithModifiers", "set_command")
+	methodBindInputEventWithModifiersSetControl = gdnative.NewLazyMethodBind("InputEventWithModifiers", "set_control")
+	methodBindInputEventWithModifiersSetMetakey = gdnative.NewLazyMethodBind("InputEventWithModifiers", "set_metakey")
+	methodBindInputEventWithModifiersSetShift   = gdnative.NewLazyMethodBind("InputEventWithModifiers", "set_shift")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *InputEventWithModifiers) GetAlt() gdnative.Bool {
 	//log.Println("Calling InputEventWithModifiers.GetAlt()")
@@ -45,7 +51,7 @@ func (o *InputEventWithModifiers) GetAlt() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "get_alt")
+	methodBind := methodBindInputEventWithModifiersGetAlt.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +64,8 @@ func (o *InputEventWithModifiers) GetAlt() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *InputEventWithModifiers) GetCommand() gdnative.Bool {
 	//log.Println("Calling InputEventWithModifiers.GetCommand()")
@@ -68,7 +74,7 @@ func (o *InputEventWithModifiers) GetCommand() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "get_command")
+	methodBind := methodBindInputEventWithModifiersGetCommand.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +87,8 @@ func (o *InputEventWithModifiers) GetCommand() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *InputEventWithModifiers) GetControl() gdnative.Bool {
 	//log.Println("Calling InputEventWithModifiers.GetControl()")
@@ -91,7 +97,7 @@ func (o *InputEventWithModifiers) GetControl() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "get_control")
+	methodBind := methodBindInputEventWithModifiersGetControl.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +110,8 @@ func (o *InputEventWithModifiers) GetControl() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *InputEventWithModifiers) GetMetakey() gdnative.Bool {
 	//log.Println("Calling InputEventWithModifiers.GetMetakey()")
@@ -114,7 +120,7 @@ func (o *InputEventWithModifiers) GetMetakey() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "get_metakey")
+	methodBind := methodBindInputEventWithModifiersGetMetakey.Get()
 
 	// Call the parent method.
 	// bool
@@ -127,8 +133,8 @@ func (o *InputEventWithModifiers) GetMetakey() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *InputEventWithModifiers) GetShift() gdnative.Bool {
 	//log.Println("Calling InputEventWithModifiers.GetShift()")
@@ -137,7 +143,7 @@ func (o *InputEventWithModifiers) GetShift() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "get_shift")
+	methodBind := methodBindInputEventWithModifiersGetShift.Get()
 
 	// Call the parent method.
 	// bool
@@ -150,8 +156,8 @@ func (o *InputEventWithModifiers) GetShift() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *InputEventWithModifiers) SetAlt(enable gdnative.Bool) {
 	//log.Println("Calling InputEventWithModifiers.SetAlt()")
@@ -161,7 +167,7 @@ func (o *InputEventWithModifiers) SetAlt(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "set_alt")
+	methodBind := methodBindInputEventWithModifiersSetAlt.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +177,8 @@ func (o *InputEventWithModifiers) SetAlt(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *InputEventWithModifiers) SetCommand(enable gdnative.Bool) {
 	//log.Println("Calling InputEventWithModifiers.SetCommand()")
@@ -182,7 +188,7 @@ func (o *InputEventWithModifiers) SetCommand(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "set_command")
+	methodBind := methodBindInputEventWithModifiersSetCommand.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +198,8 @@ func (o *InputEventWithModifiers) SetCommand(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *InputEventWithModifiers) SetControl(enable gdnative.Bool) {
 	//log.Println("Calling InputEventWithModifiers.SetControl()")
@@ -203,7 +209,7 @@ func (o *InputEventWithModifiers) SetControl(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "set_control")
+	methodBind := methodBindInputEventWithModifiersSetControl.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +219,8 @@ func (o *InputEventWithModifiers) SetControl(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *InputEventWithModifiers) SetMetakey(enable gdnative.Bool) {
 	//log.Println("Calling InputEventWithModifiers.SetMetakey()")
@@ -224,7 +230,7 @@ func (o *InputEventWithModifiers) SetMetakey(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "set_metakey")
+	methodBind := methodBindInputEventWithModifiersSetMetakey.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +240,8 @@ func (o *InputEventWithModifiers) SetMetakey(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *InputEventWithModifiers) SetShift(enable gdnative.Bool) {
 	//log.Println("Calling InputEventWithModifiers.SetShift()")
@@ -245,7 +251,7 @@ func (o *InputEventWithModifiers) SetShift(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputEventWithModifiers", "set_shift")
+	methodBind := methodBindInputEventWithModifiersSetShift.Get()
 
 	// Call the parent method.
 	// void
@@ -254,6 +260,31 @@ func (o *InputEventWithModifiers) SetShift(enable gdnative.Bool) {
 
 }
 
+// Alt will return the value of the "alt" property.
+func (o *InputEventWithModifiers) Alt() gdnative.Bool {
+	return o.GetAlt()
+}
+
+// Command will return the value of the "command" property.
+func (o *InputEventWithModifiers) Command() gdnative.Bool {
+	return o.GetCommand()
+}
+
+// Control will return the value of the "control" property.
+func (o *InputEventWithModifiers) Control() gdnative.Bool {
+	return o.GetControl()
+}
+
+// Meta will return the value of the "meta" property.
+func (o *InputEventWithModifiers) Meta() gdnative.Bool {
+	return o.GetMetakey()
+}
+
+// Shift will return the value of the "shift" property.
+func (o *InputEventWithModifiers) Shift() gdnative.Bool {
+	return o.GetShift()
+}
+
 // InputEventWithModifiersImplementer is an interface that implements the methods
 // of the InputEventWithModifiers class.
 type InputEventWithModifiersImplementer interface {
@@ -268,4 +299,9 @@ type InputEventWithModifiersImplementer interface {
 	SetControl(enable gdnative.Bool)
 	SetMetakey(enable gdnative.Bool)
 	SetShift(enable gdnative.Bool)
+	Alt() gdnative.Bool
+	Command() gdnative.Bool
+	Control() gdnative.Bool
+	Meta() gdnative.Bool
+	Shift() gdnative.Bool
 }
@@ -1,7 +1,10 @@
-package godot
+package ip
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,6 +26,36 @@ const (
 	IPResolverStatusWaiting IPResolverStatus = 1
 )
 
+// String will return the Godot name of the IPResolverStatus value.
+func (e IPResolverStatus) String() string {
+	switch e {
+	case IPResolverStatusDone:
+		return "RESOLVER_STATUS_DONE"
+	case IPResolverStatusError:
+		return "RESOLVER_STATUS_ERROR"
+	case IPResolverStatusNone:
+		return "RESOLVER_STATUS_NONE"
+	case IPResolverStatusWaiting:
+		return "RESOLVER_STATUS_WAITING"
+	}
+	return fmt.Sprintf("IPResolverStatus(%d)", int(e))
+}
+
+// ParseIPResolverStatus will return the IPResolverStatus value with the given Godot name.
+func ParseIPResolverStatus(name string) (IPResolverStatus, error) {
+	switch name {
+	case "RESOLVER_STATUS_DONE":
+		return IPResolverStatusDone, nil
+	case "RESOLVER_STATUS_ERROR":
+		return IPResolverStatusError, nil
+	case "RESOLVER_STATUS_NONE":
+		return IPResolverStatusNone, nil
+	case "RESOLVER_STATUS_WAITING":
+		return IPResolverStatusWaiting, nil
+	}
+	return 0, fmt.Errorf("invalid IPResolverStatus value %q", name)
+}
+
 // IPType is an enum for Type values.
 type IPType int
 
@@ -33,21 +66,48 @@ const (
 	IPTypeNone IPType = 0
 )
 
-//func NewipFromPointer(ptr gdnative.Pointer) ip {
-func newIPFromPointer(ptr gdnative.Pointer) ip {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ip{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the IPType value.
+func (e IPType) String() string {
+	switch e {
+	case IPTypeAny:
+		return "TYPE_ANY"
+	case IPTypeIpv4:
+		return "TYPE_IPV4"
+	case IPTypeIpv6:
+		return "TYPE_IPV6"
+	case IPTypeNone:
+		return "TYPE_NONE"
+	}
+	return fmt.Sprintf("IPType(%d)", int(e))
+}
 
-	return obj
+// ParseIPType will return the IPType value with the given Godot name.
+func ParseIPType(name string) (IPType, error) {
+	switch name {
+	case "TYPE_ANY":
+		return IPTypeAny, nil
+	case "TYPE_IPV4":
+		return IPTypeIpv4, nil
+	case "TYPE_IPV6":
+		return IPTypeIpv6, nil
+	case "TYPE_NONE":
+		return IPTypeNone, nil
+	}
+	return 0, fmt.Errorf("invalid IPType value %q", name)
 }
 
+// Constants of the IP class.
+const (
+	IPResolverInvalidId  gdnative.Int = -1
+	IPResolverMaxQueries gdnative.Int = 32
+)
+
 func newSingletonIP() *ip {
 	return &ip{}
 }
 
 /*
-   IP contains support functions for the Internet Protocol (IP). TCP/IP support is in different classes (see [StreamPeerTCP] and [TCP_Server]). IP provides DNS hostname resolution support, both blocking and threaded.
+IP contains support functions for the Internet Protocol (IP). TCP/IP support is in different classes (see [StreamPeerTCP] and [TCP_Server]). IP provides DNS hostname resolution support, both blocking and threaded.
 */
 var IP = newSingletonIP()
 
@@ -55,7 +115,7 @@ var IP = newSingletonIP()
 IP contains support functions for the Internet Protocol (IP). TCP/IP support is in different classes (see [StreamPeerTCP] and [TCP_Server]). IP provides DNS hostname resolution support, both blocking and threaded.
 */
 type ip struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -76,9 +136,21 @@ func (o *ip) BaseClass() string {
 	return "IP"
 }
 
+// Method binds of the IP class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindIPClearCache               = gdnative.NewLazyMethodBind("IP", "clear_cache")
+	methodBindIPEraseResolveItem         = gdnative.NewLazyMethodBind("IP", "erase_resolve_item")
+	methodBindIPGetLocalAddresses        = gdnative.NewLazyMethodBind("IP", "get_local_addresses")
+	methodBindIPGetResolveItemAddress    = gdnative.NewLazyMethodBind("IP", "get_resolve_item_address")
+	methodBindIPGetResolveItemStatus     = gdnative.NewLazyMethodBind("IP", "get_resolve_item_status")
+	methodBindIPResolveHostname          = gdnative.NewLazyMethodBind("IP", "resolve_hostname")
+	methodBindIPResolveHostnameQueueItem = gdnative.NewLazyMethodBind("IP", "resolve_hostname_queue_item")
+)
+
 /*
-        Removes all of a "hostname"'s cached references. If no "hostname" is given then all cached IP addresses are removed.
-	Args: [{ true hostname String}], Returns: void
+	        Removes all of a "hostname"'s cached references. If no "hostname" is given then all cached IP addresses are removed.
+		Args: [{ true hostname String}], Returns: void
 */
 func (o *ip) ClearCache(hostname gdnative.String) {
 	o.ensureSingleton()
@@ -89,7 +161,7 @@ func (o *ip) ClearCache(hostname gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(hostname)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("IP", "clear_cache")
+	methodBind := methodBindIPClearCache.Get()
 
 	// Call the parent method.
 	// void
@@ -99,8 +171,8 @@ func (o *ip) ClearCache(hostname gdnative.String) {
 }
 
 /*
-        Removes a given item "id" from the queue. This should be used to free a queue after it has completed to enable more queries to happen.
-	Args: [{ false id int}], Returns: void
+	        Removes a given item "id" from the queue. This should be used to free a queue after it has completed to enable more queries to happen.
+		Args: [{ false id int}], Returns: void
 */
 func (o *ip) EraseResolveItem(id gdnative.Int) {
 	o.ensureSingleton()
@@ -111,7 +183,7 @@ func (o *ip) EraseResolveItem(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("IP", "erase_resolve_item")
+	methodBind := methodBindIPEraseResolveItem.Get()
 
 	// Call the parent method.
 	// void
@@ -121,8 +193,8 @@ func (o *ip) EraseResolveItem(id gdnative.Int) {
 }
 
 /*
-        Returns all of the user's current IPv4 and IPv6 addresses as an array.
-	Args: [], Returns: Array
+	        Returns all of the user's current IPv4 and IPv6 addresses as an array.
+		Args: [], Returns: Array
 */
 func (o *ip) GetLocalAddresses() gdnative.Array {
 	o.ensureSingleton()
@@ -132,7 +204,7 @@ func (o *ip) GetLocalAddresses() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("IP", "get_local_addresses")
+	methodBind := methodBindIPGetLocalAddresses.Get()
 
 	// Call the parent method.
 	// Array
@@ -145,8 +217,8 @@ func (o *ip) GetLocalAddresses() gdnative.Array {
 }
 
 /*
-        Returns a queued hostname's IP address, given its queue "id". Returns an empty string on error or if resolution hasn't happened yet (see [method get_resolve_item_status]).
-	Args: [{ false id int}], Returns: String
+	        Returns a queued hostname's IP address, given its queue "id". Returns an empty string on error or if resolution hasn't happened yet (see [method get_resolve_item_status]).
+		Args: [{ false id int}], Returns: String
 */
 func (o *ip) GetResolveItemAddress(id gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -157,7 +229,7 @@ func (o *ip) GetResolveItemAddress(id gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("IP", "get_resolve_item_address")
+	methodBind := methodBindIPGetResolveItemAddress.Get()
 
 	// Call the parent method.
 	// String
@@ -170,8 +242,8 @@ func (o *ip) GetResolveItemAddress(id gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns a queued hostname's status as a RESOLVER_STATUS_* constant, given its queue "id".
-	Args: [{ false id int}], Returns: enum.IP::ResolverStatus
+	        Returns a queued hostname's status as a RESOLVER_STATUS_* constant, given its queue "id".
+		Args: [{ false id int}], Returns: enum.IP::ResolverStatus
 */
 func (o *ip) GetResolveItemStatus(id gdnative.Int) IPResolverStatus {
 	o.ensureSingleton()
@@ -182,7 +254,7 @@ func (o *ip) GetResolveItemStatus(id gdnative.Int) IPResolverStatus {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("IP", "get_resolve_item_status")
+	methodBind := methodBindIPGetResolveItemStatus.Get()
 
 	// Call the parent method.
 	// enum.IP::ResolverStatus
@@ -195,8 +267,8 @@ func (o *ip) GetResolveItemStatus(id gdnative.Int) IPResolverStatus {
 }
 
 /*
-        Returns a given hostname's IPv4 or IPv6 address when resolved (blocking-type method). The address type returned depends on the TYPE_* constant given as "ip_type".
-	Args: [{ false host String} {3 true ip_type int}], Returns: String
+	        Returns a given hostname's IPv4 or IPv6 address when resolved (blocking-type method). The address type returned depends on the TYPE_* constant given as "ip_type".
+		Args: [{ false host String} {3 true ip_type int}], Returns: String
 */
 func (o *ip) ResolveHostname(host gdnative.String, ipType gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -208,7 +280,7 @@ func (o *ip) ResolveHostname(host gdnative.String, ipType gdnative.Int) gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(ipType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("IP", "resolve_hostname")
+	methodBind := methodBindIPResolveHostname.Get()
 
 	// Call the parent method.
 	// String
@@ -221,8 +293,8 @@ func (o *ip) ResolveHostname(host gdnative.String, ipType gdnative.Int) gdnative
 }
 
 /*
-        Creates a queue item to resolve a hostname to an IPv4 or IPv6 address depending on the TYPE_* constant given as "ip_type". Returns the queue ID if successful, or RESOLVER_INVALID_ID on error.
-	Args: [{ false host String} {3 true ip_type int}], Returns: int
+	        Creates a queue item to resolve a hostname to an IPv4 or IPv6 address depending on the TYPE_* constant given as "ip_type". Returns the queue ID if successful, or RESOLVER_INVALID_ID on error.
+		Args: [{ false host String} {3 true ip_type int}], Returns: int
 */
 func (o *ip) ResolveHostnameQueueItem(host gdnative.String, ipType gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -234,7 +306,7 @@ func (o *ip) ResolveHostnameQueueItem(host gdnative.String, ipType gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(ipType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("IP", "resolve_hostname_queue_item")
+	methodBind := methodBindIPResolveHostnameQueueItem.Get()
 
 	// Call the parent method.
 	// int
@@ -246,14 +318,33 @@ func (o *ip) ResolveHostnameQueueItem(host gdnative.String, ipType gdnative.Int)
 	return ret
 }
 
+// ClearCacheWithDefaults will call ClearCache using the default values for: hostname.
+func (o *ip) ClearCacheWithDefaults() {
+	o.ClearCache("")
+}
+
+// ResolveHostnameWithDefaults will call ResolveHostname using the default values for: ip_type.
+func (o *ip) ResolveHostnameWithDefaults(host gdnative.String) gdnative.String {
+	return o.ResolveHostname(host, 3)
+}
+
+// ResolveHostnameQueueItemWithDefaults will call ResolveHostnameQueueItem using the default values for: ip_type.
+func (o *ip) ResolveHostnameQueueItemWithDefaults(host gdnative.String) gdnative.Int {
+	return o.ResolveHostnameQueueItem(host, 3)
+}
+
 // IPImplementer is an interface that implements the methods
 // of the IP class.
 type IPImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	ClearCache(hostname gdnative.String)
 	EraseResolveItem(id gdnative.Int)
 	GetLocalAddresses() gdnative.Array
 	GetResolveItemAddress(id gdnative.Int) gdnative.String
+	GetResolveItemStatus(id gdnative.Int) IPResolverStatus
 	ResolveHostname(host gdnative.String, ipType gdnative.Int) gdnative.String
 	ResolveHostnameQueueItem(host gdnative.String, ipType gdnative.Int) gdnative.Int
+	ClearCacheWithDefaults()
+	ResolveHostnameWithDefaults(host gdnative.String) gdnative.String
+	ResolveHostnameQueueItemWithDefaults(host gdnative.String) gdnative.Int
 }
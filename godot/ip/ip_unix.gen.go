@@ -1,4 +1,4 @@
-package godot
+package ip
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewIP_UnixFromPointer(ptr gdnative.Pointer) IP_Unix {
-func newIP_UnixFromPointer(ptr gdnative.Pointer) IP_Unix {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := IP_Unix{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Unix-specific implementation of IP support functions. See [IP].
 */
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewKinematicCollisionFromPointer(ptr gdnative.Pointer) KinematicCollision {
-func newKinematicCollisionFromPointer(ptr gdnative.Pointer) KinematicCollision {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := KinematicCollision{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Contains collision data for KinematicBody collisions. When a [KinematicBody] is moved using [method KinematicBody.move_and_collide], it stops if it detects a collision with another body. If a collision is detected, a KinematicCollision object is returned. This object contains information about the collision, including the colliding object, the remaining motion, and the collision position. This information can be used to calculate a collision response.
 */
@@ -30,13 +21,46 @@ type KinematicCollision struct {
 	owner gdnative.Object
 }
 
+// classConstructorKinematicCollision is the class constructor of the KinematicCollision class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorKinematicCollision = gdnative.NewLazyClassConstructor("KinematicCollision")
+
+// NewKinematicCollision will create a new instance of the KinematicCollision class.
+// KinematicCollision is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewKinematicCollision() *KinematicCollision {
+	obj := &KinematicCollision{}
+	obj.SetBaseObject(classConstructorKinematicCollision.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *KinematicCollision) BaseClass() string {
 	return "KinematicCollision"
 }
 
+// Method binds of the KinematicCollision class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindKinematicCollisionGetCollider           = gdnative.NewLazyMethodBind("KinematicCollision", "get_collider")
+	methodBindKinematicCollisionGetColliderId         = gdnative.NewLazyMethodBind("KinematicCollision", "get_collider_id")
+	methodBindKinematicCollisionGetColliderMetadata   = gdnative.NewLazyMethodBind("KinematicCollision", "get_collider_metadata")
+	methodBindKinematicCollisionGetColliderShape      = gdnative.NewLazyMethodBind("KinematicCollision", "get_collider_shape")
+	methodBindKinematicCollisionGetColliderShapeIndex = gdnative.NewLazyMethodBind("KinematicCollision", "get_collider_shape_index")
+	methodBindKinematicCollisionGetColliderVelocity   = gdnative.NewLazyMethodBind("KinematicCollision", "get_collider_velocity")
+	methodBindKinematicCollisionGetLocalShape         = gdnative.NewLazyMethodBind("KinematicCollision", "get_local_shape")
+	methodBindKinematicCollisionGetNormal             = gdnative.NewLazyMethodBind("KinematicCollision", "get_normal")
+	methodBindKinematicCollisionGetPosition           = gdnative.NewLazyMethodBind("KinematicCollision", "get_position")
+	methodBindKinematicCollisionGetRemainder          = gdnative.NewLazyMethodBind("KinematicCollision", "get_remainder")
+	methodBindKinematicCollisionGetTravel             = gdnative.NewLazyMethodBind("KinematicCollision", "get_travel")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Object
+	        Undocumented
+		Args: [], Returns: Object
 */
 func (o *KinematicCollision) GetCollider() ObjectImplementer {
 	//log.Println("Calling KinematicCollision.GetCollider()")
@@ -45,7 +69,7 @@ func (o *KinematicCollision) GetCollider() ObjectImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_collider")
+	methodBind := methodBindKinematicCollisionGetCollider.Get()
 
 	// Call the parent method.
 	// Object
@@ -53,27 +77,25 @@ func (o *KinematicCollision) GetCollider() ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *KinematicCollision) GetColliderId() gdnative.Int {
 	//log.Println("Calling KinematicCollision.GetColliderId()")
@@ -82,7 +104,7 @@ func (o *KinematicCollision) GetColliderId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_collider_id")
+	methodBind := methodBindKinematicCollisionGetColliderId.Get()
 
 	// Call the parent method.
 	// int
@@ -95,8 +117,8 @@ func (o *KinematicCollision) GetColliderId() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Variant
+	        Undocumented
+		Args: [], Returns: Variant
 */
 func (o *KinematicCollision) GetColliderMetadata() gdnative.Variant {
 	//log.Println("Calling KinematicCollision.GetColliderMetadata()")
@@ -105,7 +127,7 @@ func (o *KinematicCollision) GetColliderMetadata() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_collider_metadata")
+	methodBind := methodBindKinematicCollisionGetColliderMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -118,8 +140,8 @@ func (o *KinematicCollision) GetColliderMetadata() gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Object
+	        Undocumented
+		Args: [], Returns: Object
 */
 func (o *KinematicCollision) GetColliderShape() ObjectImplementer {
 	//log.Println("Calling KinematicCollision.GetColliderShape()")
@@ -128,7 +150,7 @@ func (o *KinematicCollision) GetColliderShape() ObjectImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_collider_shape")
+	methodBind := methodBindKinematicCollisionGetColliderShape.Get()
 
 	// Call the parent method.
 	// Object
@@ -136,27 +158,25 @@ func (o *KinematicCollision) GetColliderShape() ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *KinematicCollision) GetColliderShapeIndex() gdnative.Int {
 	//log.Println("Calling KinematicCollision.GetColliderShapeIndex()")
@@ -165,7 +185,7 @@ func (o *KinematicCollision) GetColliderShapeIndex() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_collider_shape_index")
+	methodBind := methodBindKinematicCollisionGetColliderShapeIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -178,8 +198,8 @@ func (o *KinematicCollision) GetColliderShapeIndex() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *KinematicCollision) GetColliderVelocity() gdnative.Vector3 {
 	//log.Println("Calling KinematicCollision.GetColliderVelocity()")
@@ -188,7 +208,7 @@ func (o *KinematicCollision) GetColliderVelocity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_collider_velocity")
+	methodBind := methodBindKinematicCollisionGetColliderVelocity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -201,8 +221,8 @@ func (o *KinematicCollision) GetColliderVelocity() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Object
+	        Undocumented
+		Args: [], Returns: Object
 */
 func (o *KinematicCollision) GetLocalShape() ObjectImplementer {
 	//log.Println("Calling KinematicCollision.GetLocalShape()")
@@ -211,7 +231,7 @@ func (o *KinematicCollision) GetLocalShape() ObjectImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_local_shape")
+	methodBind := methodBindKinematicCollisionGetLocalShape.Get()
 
 	// Call the parent method.
 	// Object
@@ -219,27 +239,25 @@ func (o *KinematicCollision) GetLocalShape() ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *KinematicCollision) GetNormal() gdnative.Vector3 {
 	//log.Println("Calling KinematicCollision.GetNormal()")
@@ -248,7 +266,7 @@ func (o *KinematicCollision) GetNormal() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_normal")
+	methodBind := methodBindKinematicCollisionGetNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -261,8 +279,8 @@ func (o *KinematicCollision) GetNormal() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *KinematicCollision) GetPosition() gdnative.Vector3 {
 	//log.Println("Calling KinematicCollision.GetPosition()")
@@ -271,7 +289,7 @@ func (o *KinematicCollision) GetPosition() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_position")
+	methodBind := methodBindKinematicCollisionGetPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -284,8 +302,8 @@ func (o *KinematicCollision) GetPosition() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *KinematicCollision) GetRemainder() gdnative.Vector3 {
 	//log.Println("Calling KinematicCollision.GetRemainder()")
@@ -294,7 +312,7 @@ func (o *KinematicCollision) GetRemainder() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_remainder")
+	methodBind := methodBindKinematicCollisionGetRemainder.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -307,8 +325,8 @@ func (o *KinematicCollision) GetRemainder() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *KinematicCollision) GetTravel() gdnative.Vector3 {
 	//log.Println("Calling KinematicCollision.GetTravel()")
@@ -317,7 +335,7 @@ func (o *KinematicCollision) GetTravel() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision", "get_travel")
+	methodBind := methodBindKinematicCollisionGetTravel.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -329,6 +347,61 @@ func (o *KinematicCollision) GetTravel() gdnative.Vector3 {
 	return ret
 }
 
+// Collider will return the value of the "collider" property.
+func (o *KinematicCollision) Collider() ObjectImplementer {
+	return o.GetCollider()
+}
+
+// ColliderId will return the value of the "collider_id" property.
+func (o *KinematicCollision) ColliderId() gdnative.Int {
+	return o.GetColliderId()
+}
+
+// ColliderMetadata will return the value of the "collider_metadata" property.
+func (o *KinematicCollision) ColliderMetadata() gdnative.Variant {
+	return o.GetColliderMetadata()
+}
+
+// ColliderShape will return the value of the "collider_shape" property.
+func (o *KinematicCollision) ColliderShape() ObjectImplementer {
+	return o.GetColliderShape()
+}
+
+// ColliderShapeIndex will return the value of the "collider_shape_index" property.
+func (o *KinematicCollision) ColliderShapeIndex() gdnative.Int {
+	return o.GetColliderShapeIndex()
+}
+
+// ColliderVelocity will return the value of the "collider_velocity" property.
+func (o *KinematicCollision) ColliderVelocity() gdnative.Vector3 {
+	return o.GetColliderVelocity()
+}
+
+// LocalShape will return the value of the "local_shape" property.
+func (o *KinematicCollision) LocalShape() ObjectImplementer {
+	return o.GetLocalShape()
+}
+
+// Normal will return the value of the "normal" property.
+func (o *KinematicCollision) Normal() gdnative.Vector3 {
+	return o.GetNormal()
+}
+
+// Position will return the value of the "position" property.
+func (o *KinematicCollision) Position() gdnative.Vector3 {
+	return o.GetPosition()
+}
+
+// Remainder will return the value of the "remainder" property.
+func (o *KinematicCollision) Remainder() gdnative.Vector3 {
+	return o.GetRemainder()
+}
+
+// Travel will return the value of the "travel" property.
+func (o *KinematicCollision) Travel() gdnative.Vector3 {
+	return o.GetTravel()
+}
+
 // KinematicCollisionImplementer is an interface that implements the methods
 // of the KinematicCollision class.
 type KinematicCollisionImplementer interface {
@@ -344,4 +417,15 @@ type KinematicCollisionImplementer interface {
 	GetPosition() gdnative.Vector3
 	GetRemainder() gdnative.Vector3
 	GetTravel() gdnative.Vector3
+	Collider() ObjectImplementer
+	ColliderId() gdnative.Int
+	ColliderMetadata() gdnative.Variant
+	ColliderShape() ObjectImplementer
+	ColliderShapeIndex() gdnative.Int
+	ColliderVelocity() gdnative.Vector3
+	LocalShape() ObjectImplementer
+	Normal() gdnative.Vector3
+	Position() gdnative.Vector3
+	Remainder() gdnative.Vector3
+	Travel() gdnative.Vector3
 }
@@ -1,347 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewKinematicCollision2DFromPointer(ptr gdnative.Pointer) KinematicCollision2D {
-func newKinematicCollision2DFromPointer(ptr gdnative.Pointer) KinematicCollision2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := KinematicCollision2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Contains collision data for KinematicBody2D collisions. When a [KinematicBody2D] is moved using [method KinematicBody2D.move_and_collide], it stops if it detects a collision with another body. If a collision is detected, a KinematicCollision2D object is returned. This object contains information about the collision, including the colliding object, the remaining motion, and the collision position. This information can be used to calculate a collision response.
-*/
-type KinematicCollision2D struct {
-	Reference
-	owner gdnative.Object
-}
-
-func (o *KinematicCollision2D) BaseClass() string {
-	return "KinematicCollision2D"
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Object
-*/
-func (o *KinematicCollision2D) GetCollider() ObjectImplementer {
-	//log.Println("Calling KinematicCollision2D.GetCollider()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_collider")
-
-	// Call the parent method.
-	// Object
-	retPtr := gdnative.NewEmptyObject()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
-	}
-
-	return &ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: int
-*/
-func (o *KinematicCollision2D) GetColliderId() gdnative.Int {
-	//log.Println("Calling KinematicCollision2D.GetColliderId()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_collider_id")
-
-	// Call the parent method.
-	// int
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Variant
-*/
-func (o *KinematicCollision2D) GetColliderMetadata() gdnative.Variant {
-	//log.Println("Calling KinematicCollision2D.GetColliderMetadata()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_collider_metadata")
-
-	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Object
-*/
-func (o *KinematicCollision2D) GetColliderShape() ObjectImplementer {
-	//log.Println("Calling KinematicCollision2D.GetColliderShape()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_collider_shape")
-
-	// Call the parent method.
-	// Object
-	retPtr := gdnative.NewEmptyObject()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
-	}
-
-	return &ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: int
-*/
-func (o *KinematicCollision2D) GetColliderShapeIndex() gdnative.Int {
-	//log.Println("Calling KinematicCollision2D.GetColliderShapeIndex()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_collider_shape_index")
-
-	// Call the parent method.
-	// int
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Vector2
-*/
-func (o *KinematicCollision2D) GetColliderVelocity() gdnative.Vector2 {
-	//log.Println("Calling KinematicCollision2D.GetColliderVelocity()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_collider_velocity")
-
-	// Call the parent method.
-	// Vector2
-	retPtr := gdnative.NewEmptyVector2()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVector2FromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Object
-*/
-func (o *KinematicCollision2D) GetLocalShape() ObjectImplementer {
-	//log.Println("Calling KinematicCollision2D.GetLocalShape()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_local_shape")
-
-	// Call the parent method.
-	// Object
-	retPtr := gdnative.NewEmptyObject()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
-	}
-
-	return &ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Vector2
-*/
-func (o *KinematicCollision2D) GetNormal() gdnative.Vector2 {
-	//log.Println("Calling KinematicCollision2D.GetNormal()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_normal")
-
-	// Call the parent method.
-	// Vector2
-	retPtr := gdnative.NewEmptyVector2()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVector2FromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Vector2
-*/
-func (o *KinematicCollision2D) GetPosition() gdnative.Vector2 {
-	//log.Println("Calling KinematicCollision2D.GetPosition()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_position")
-
-	// Call the parent method.
-	// Vector2
-	retPtr := gdnative.NewEmptyVector2()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVector2FromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Vector2
-*/
-func (o *KinematicCollision2D) GetRemainder() gdnative.Vector2 {
-	//log.Println("Calling KinematicCollision2D.GetRemainder()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_remainder")
-
-	// Call the parent method.
-	// Vector2
-	retPtr := gdnative.NewEmptyVector2()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVector2FromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Vector2
-*/
-func (o *KinematicCollision2D) GetTravel() gdnative.Vector2 {
-	//log.Println("Calling KinematicCollision2D.GetTravel()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("KinematicCollision2D", "get_travel")
-
-	// Call the parent method.
-	// Vector2
-	retPtr := gdnative.NewEmptyVector2()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVector2FromPointer(retPtr)
-	return ret
-}
-
-// KinematicCollision2DImplementer is an interface that implements the methods
-// of the KinematicCollision2D class.
-type KinematicCollision2DImplementer interface {
-	ReferenceImplementer
-	GetCollider() ObjectImplementer
-	GetColliderId() gdnative.Int
-	GetColliderMetadata() gdnative.Variant
-	GetColliderShape() ObjectImplementer
-	GetColliderShapeIndex() gdnative.Int
-	GetColliderVelocity() gdnative.Vector2
-	GetLocalShape() ObjectImplementer
-	GetNormal() gdnative.Vector2
-	GetPosition() gdnative.Vector2
-	GetRemainder() gdnative.Vector2
-	GetTravel() gdnative.Vector2
-}
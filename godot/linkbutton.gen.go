@@ -1,141 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-// LinkButtonUnderlineMode is an enum for UnderlineMode values.
-type LinkButtonUnderlineMode int
-
-const (
-	LinkButtonUnderlineModeAlways  LinkButtonUnderlineMode = 0
-	LinkButtonUnderlineModeNever   LinkButtonUnderlineMode = 2
-	LinkButtonUnderlineModeOnHover LinkButtonUnderlineMode = 1
-)
-
-//func NewLinkButtonFromPointer(ptr gdnative.Pointer) LinkButton {
-func newLinkButtonFromPointer(ptr gdnative.Pointer) LinkButton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := LinkButton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-This kind of buttons are primarily used when the interaction with the button causes a context change (like linking to a web page).
-*/
-type LinkButton struct {
-	BaseButton
-	owner gdnative.Object
-}
-
-func (o *LinkButton) BaseClass() string {
-	return "LinkButton"
-}
-
-/*
-        Undocumented
-	Args: [], Returns: String
-*/
-func (o *LinkButton) GetText() gdnative.String {
-	//log.Println("Calling LinkButton.GetText()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LinkButton", "get_text")
-
-	// Call the parent method.
-	// String
-	retPtr := gdnative.NewEmptyString()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewStringFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [], Returns: enum.LinkButton::UnderlineMode
-*/
-func (o *LinkButton) GetUnderlineMode() LinkButtonUnderlineMode {
-	//log.Println("Calling LinkButton.GetUnderlineMode()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LinkButton", "get_underline_mode")
-
-	// Call the parent method.
-	// enum.LinkButton::UnderlineMode
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return LinkButtonUnderlineMode(ret)
-}
-
-/*
-        Undocumented
-	Args: [{ false text String}], Returns: void
-*/
-func (o *LinkButton) SetText(text gdnative.String) {
-	//log.Println("Calling LinkButton.SetText()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(text)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LinkButton", "set_text")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false underline_mode int}], Returns: void
-*/
-func (o *LinkButton) SetUnderlineMode(underlineMode gdnative.Int) {
-	//log.Println("Calling LinkButton.SetUnderlineMode()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(underlineMode)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LinkButton", "set_underline_mode")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-// LinkButtonImplementer is an interface that implements the methods
-// of the LinkButton class.
-type LinkButtonImplementer interface {
-	BaseButtonImplementer
-	GetText() gdnative.String
-	SetText(text gdnative.String)
-	SetUnderlineMode(underlineMode gdnative.Int)
-}
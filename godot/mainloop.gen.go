@@ -13,14 +13,19 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMainLoopFromPointer(ptr gdnative.Pointer) MainLoop {
-func newMainLoopFromPointer(ptr gdnative.Pointer) MainLoop {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := MainLoop{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
+// Constants of the MainLoop class.
+const (
+	MainLoopNotificationOsMemoryWarning    gdnative.Int = 9
+	MainLoopNotificationTranslationChanged gdnative.Int = 90
+	MainLoopNotificationWmAbout            gdnative.Int = 91
+	MainLoopNotificationWmFocusIn          gdnative.Int = 4
+	MainLoopNotificationWmFocusOut         gdnative.Int = 5
+	MainLoopNotificationWmGoBackRequest    gdnative.Int = 7
+	MainLoopNotificationWmMouseEnter       gdnative.Int = 2
+	MainLoopNotificationWmMouseExit        gdnative.Int = 3
+	MainLoopNotificationWmQuitRequest      gdnative.Int = 6
+	MainLoopNotificationWmUnfocusRequest   gdnative.Int = 8
+)
 
 /*
 Main loop is the abstract main loop base class. All other main loop classes are derived from it. Upon application start, a [code]MainLoop[/code] has to be provided to OS, else the application will exit. This happens automatically (and a [SceneTree] is created), unless a main [Script] is supplied, which may or not create and return a [code]MainLoop[/code].
@@ -30,13 +35,44 @@ type MainLoop struct {
 	owner gdnative.Object
 }
 
+// classConstructorMainLoop is the class constructor of the MainLoop class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMainLoop = gdnative.NewLazyClassConstructor("MainLoop")
+
+// NewMainLoop will create a new instance of the MainLoop class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewMainLoop() *MainLoop {
+	obj := &MainLoop{}
+	obj.SetBaseObject(classConstructorMainLoop.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *MainLoop) BaseClass() string {
 	return "MainLoop"
 }
 
-/*
+// Method binds of the MainLoop class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMainLoopX_DropFiles      = gdnative.NewLazyMethodBind("MainLoop", "_drop_files")
+	methodBindMainLoopX_Finalize       = gdnative.NewLazyMethodBind("MainLoop", "_finalize")
+	methodBindMainLoopX_Idle           = gdnative.NewLazyMethodBind("MainLoop", "_idle")
+	methodBindMainLoopX_Initialize     = gdnative.NewLazyMethodBind("MainLoop", "_initialize")
+	methodBindMainLoopX_InputEvent     = gdnative.NewLazyMethodBind("MainLoop", "_input_event")
+	methodBindMainLoopX_InputText      = gdnative.NewLazyMethodBind("MainLoop", "_input_text")
+	methodBindMainLoopX_Iteration      = gdnative.NewLazyMethodBind("MainLoop", "_iteration")
+	methodBindMainLoopFinish           = gdnative.NewLazyMethodBind("MainLoop", "finish")
+	methodBindMainLoopIdle             = gdnative.NewLazyMethodBind("MainLoop", "idle")
+	methodBindMainLoopInit             = gdnative.NewLazyMethodBind("MainLoop", "init")
+	methodBindMainLoopInputEventMethod = gdnative.NewLazyMethodBind("MainLoop", "input_event")
+	methodBindMainLoopInputText        = gdnative.NewLazyMethodBind("MainLoop", "input_text")
+	methodBindMainLoopIteration        = gdnative.NewLazyMethodBind("MainLoop", "iteration")
+)
 
-	Args: [{ false files PoolStringArray} { false screen int}], Returns: void
+/*
+Args: [{ false files PoolStringArray} { false screen int}], Returns: void
 */
 func (o *MainLoop) X_DropFiles(files gdnative.PoolStringArray, screen gdnative.Int) {
 	//log.Println("Calling MainLoop.X_DropFiles()")
@@ -47,7 +83,7 @@ func (o *MainLoop) X_DropFiles(files gdnative.PoolStringArray, screen gdnative.I
 	ptrArguments[1] = gdnative.NewPointerFromInt(screen)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "_drop_files")
+	methodBind := methodBindMainLoopX_DropFiles.Get()
 
 	// Call the parent method.
 	// void
@@ -57,8 +93,8 @@ func (o *MainLoop) X_DropFiles(files gdnative.PoolStringArray, screen gdnative.I
 }
 
 /*
-        Called before the program exits.
-	Args: [], Returns: void
+	        Called before the program exits.
+		Args: [], Returns: void
 */
 func (o *MainLoop) X_Finalize() {
 	//log.Println("Calling MainLoop.X_Finalize()")
@@ -67,7 +103,7 @@ func (o *MainLoop) X_Finalize() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "_finalize")
+	methodBind := methodBindMainLoopX_Finalize.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +113,8 @@ func (o *MainLoop) X_Finalize() {
 }
 
 /*
-        Called each idle frame with time since last call as an only argument.
-	Args: [{ false delta float}], Returns: void
+	        Called each idle frame with time since last call as an only argument.
+		Args: [{ false delta float}], Returns: void
 */
 func (o *MainLoop) X_Idle(delta gdnative.Real) {
 	//log.Println("Calling MainLoop.X_Idle()")
@@ -88,7 +124,7 @@ func (o *MainLoop) X_Idle(delta gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "_idle")
+	methodBind := methodBindMainLoopX_Idle.Get()
 
 	// Call the parent method.
 	// void
@@ -98,8 +134,8 @@ func (o *MainLoop) X_Idle(delta gdnative.Real) {
 }
 
 /*
-        Called once during initialization.
-	Args: [], Returns: void
+	        Called once during initialization.
+		Args: [], Returns: void
 */
 func (o *MainLoop) X_Initialize() {
 	//log.Println("Calling MainLoop.X_Initialize()")
@@ -108,7 +144,7 @@ func (o *MainLoop) X_Initialize() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "_initialize")
+	methodBind := methodBindMainLoopX_Initialize.Get()
 
 	// Call the parent method.
 	// void
@@ -118,18 +154,17 @@ func (o *MainLoop) X_Initialize() {
 }
 
 /*
-
-	Args: [{ false ev InputEvent}], Returns: void
+Args: [{ false ev InputEvent}], Returns: void
 */
 func (o *MainLoop) X_InputEvent(ev InputEventImplementer) {
 	//log.Println("Calling MainLoop.X_InputEvent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(ev.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(ev))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "_input_event")
+	methodBind := methodBindMainLoopX_InputEvent.Get()
 
 	// Call the parent method.
 	// void
@@ -139,8 +174,7 @@ func (o *MainLoop) X_InputEvent(ev InputEventImplementer) {
 }
 
 /*
-
-	Args: [{ false text String}], Returns: void
+Args: [{ false text String}], Returns: void
 */
 func (o *MainLoop) X_InputText(text gdnative.String) {
 	//log.Println("Calling MainLoop.X_InputText()")
@@ -150,7 +184,7 @@ func (o *MainLoop) X_InputText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "_input_text")
+	methodBind := methodBindMainLoopX_InputText.Get()
 
 	// Call the parent method.
 	// void
@@ -160,8 +194,7 @@ func (o *MainLoop) X_InputText(text gdnative.String) {
 }
 
 /*
-
-	Args: [{ false delta float}], Returns: void
+Args: [{ false delta float}], Returns: void
 */
 func (o *MainLoop) X_Iteration(delta gdnative.Real) {
 	//log.Println("Calling MainLoop.X_Iteration()")
@@ -171,7 +204,7 @@ func (o *MainLoop) X_Iteration(delta gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "_iteration")
+	methodBind := methodBindMainLoopX_Iteration.Get()
 
 	// Call the parent method.
 	// void
@@ -181,8 +214,7 @@ func (o *MainLoop) X_Iteration(delta gdnative.Real) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *MainLoop) Finish() {
 	//log.Println("Calling MainLoop.Finish()")
@@ -191,7 +223,7 @@ func (o *MainLoop) Finish() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "finish")
+	methodBind := methodBindMainLoopFinish.Get()
 
 	// Call the parent method.
 	// void
@@ -201,8 +233,7 @@ func (o *MainLoop) Finish() {
 }
 
 /*
-
-	Args: [{ false delta float}], Returns: bool
+Args: [{ false delta float}], Returns: bool
 */
 func (o *MainLoop) Idle(delta gdnative.Real) gdnative.Bool {
 	//log.Println("Calling MainLoop.Idle()")
@@ -212,7 +243,7 @@ func (o *MainLoop) Idle(delta gdnative.Real) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "idle")
+	methodBind := methodBindMainLoopIdle.Get()
 
 	// Call the parent method.
 	// bool
@@ -225,8 +256,7 @@ func (o *MainLoop) Idle(delta gdnative.Real) gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *MainLoop) Init() {
 	//log.Println("Calling MainLoop.Init()")
@@ -235,7 +265,7 @@ func (o *MainLoop) Init() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "init")
+	methodBind := methodBindMainLoopInit.Get()
 
 	// Call the parent method.
 	// void
@@ -245,18 +275,17 @@ func (o *MainLoop) Init() {
 }
 
 /*
-
-	Args: [{ false ev InputEvent}], Returns: void
+Args: [{ false ev InputEvent}], Returns: void
 */
 func (o *MainLoop) InputEventMethod(ev InputEventImplementer) {
 	//log.Println("Calling MainLoop.InputEventMethod()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(ev.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(ev))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "input_event")
+	methodBind := methodBindMainLoopInputEventMethod.Get()
 
 	// Call the parent method.
 	// void
@@ -266,8 +295,7 @@ func (o *MainLoop) InputEventMethod(ev InputEventImplementer) {
 }
 
 /*
-
-	Args: [{ false text String}], Returns: void
+Args: [{ false text String}], Returns: void
 */
 func (o *MainLoop) InputText(text gdnative.String) {
 	//log.Println("Calling MainLoop.InputText()")
@@ -277,7 +305,7 @@ func (o *MainLoop) InputText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "input_text")
+	methodBind := methodBindMainLoopInputText.Get()
 
 	// Call the parent method.
 	// void
@@ -287,8 +315,7 @@ func (o *MainLoop) InputText(text gdnative.String) {
 }
 
 /*
-
-	Args: [{ false delta float}], Returns: bool
+Args: [{ false delta float}], Returns: bool
 */
 func (o *MainLoop) Iteration(delta gdnative.Real) gdnative.Bool {
 	//log.Println("Calling MainLoop.Iteration()")
@@ -298,7 +325,7 @@ func (o *MainLoop) Iteration(delta gdnative.Real) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MainLoop", "iteration")
+	methodBind := methodBindMainLoopIteration.Get()
 
 	// Call the parent method.
 	// bool
@@ -13,14 +13,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMaterialFromPointer(ptr gdnative.Pointer) Material {
-func newMaterialFromPointer(ptr gdnative.Pointer) Material {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Material{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
+// Constants of the Material class.
+const (
+	MaterialRenderPriorityMax gdnative.Int = 127
+	MaterialRenderPriorityMin gdnative.Int = -128
+)
 
 /*
 Material is a base [Resource] used for coloring and shading geometry. All materials inherit from it and almost all [VisualInstance] derived nodes carry a Material. A few flags and parameters are shared between all material types and are configured here.
@@ -34,9 +31,18 @@ func (o *Material) BaseClass() string {
 	return "Material"
 }
 
+// Method binds of the Material class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMaterialGetNextPass       = gdnative.NewLazyMethodBind("Material", "get_next_pass")
+	methodBindMaterialGetRenderPriority = gdnative.NewLazyMethodBind("Material", "get_render_priority")
+	methodBindMaterialSetNextPass       = gdnative.NewLazyMethodBind("Material", "set_next_pass")
+	methodBindMaterialSetRenderPriority = gdnative.NewLazyMethodBind("Material", "set_render_priority")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Material
+	        Undocumented
+		Args: [], Returns: Material
 */
 func (o *Material) GetNextPass() MaterialImplementer {
 	//log.Println("Calling Material.GetNextPass()")
@@ -45,7 +51,7 @@ func (o *Material) GetNextPass() MaterialImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Material", "get_next_pass")
+	methodBind := methodBindMaterialGetNextPass.Get()
 
 	// Call the parent method.
 	// Material
@@ -53,27 +59,25 @@ func (o *Material) GetNextPass() MaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Material) GetRenderPriority() gdnative.Int {
 	//log.Println("Calling Material.GetRenderPriority()")
@@ -82,7 +86,7 @@ func (o *Material) GetRenderPriority() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Material", "get_render_priority")
+	methodBind := methodBindMaterialGetRenderPriority.Get()
 
 	// Call the parent method.
 	// int
@@ -95,18 +99,18 @@ func (o *Material) GetRenderPriority() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false next_pass Material}], Returns: void
+	        Undocumented
+		Args: [{ false next_pass Material}], Returns: void
 */
 func (o *Material) SetNextPass(nextPass MaterialImplementer) {
 	//log.Println("Calling Material.SetNextPass()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(nextPass.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(nextPass))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Material", "set_next_pass")
+	methodBind := methodBindMaterialSetNextPass.Get()
 
 	// Call the parent method.
 	// void
@@ -116,8 +120,8 @@ func (o *Material) SetNextPass(nextPass MaterialImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false priority int}], Returns: void
+	        Undocumented
+		Args: [{ false priority int}], Returns: void
 */
 func (o *Material) SetRenderPriority(priority gdnative.Int) {
 	//log.Println("Calling Material.SetRenderPriority()")
@@ -127,7 +131,7 @@ func (o *Material) SetRenderPriority(priority gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(priority)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Material", "set_render_priority")
+	methodBind := methodBindMaterialSetRenderPriority.Get()
 
 	// Call the parent method.
 	// void
@@ -136,6 +140,16 @@ func (o *Material) SetRenderPriority(priority gdnative.Int) {
 
 }
 
+// NextPass will return the value of the "next_pass" property.
+func (o *Material) NextPass() MaterialImplementer {
+	return o.GetNextPass()
+}
+
+// RenderPriority will return the value of the "render_priority" property.
+func (o *Material) RenderPriority() gdnative.Int {
+	return o.GetRenderPriority()
+}
+
 // MaterialImplementer is an interface that implements the methods
 // of the Material class.
 type MaterialImplementer interface {
@@ -144,4 +158,6 @@ type MaterialImplementer interface {
 	GetRenderPriority() gdnative.Int
 	SetNextPass(nextPass MaterialImplementer)
 	SetRenderPriority(priority gdnative.Int)
+	NextPass() MaterialImplementer
+	RenderPriority() gdnative.Int
 }
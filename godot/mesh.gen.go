@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -41,6 +43,108 @@ const (
 	MeshArrayFormatWeights     MeshArrayFormat = 128
 )
 
+// String will return the Godot name of the MeshArrayFormat value.
+func (e MeshArrayFormat) String() string {
+	switch e {
+	case MeshArrayCompressBase:
+		return "ARRAY_COMPRESS_BASE"
+	case MeshArrayCompressBones:
+		return "ARRAY_COMPRESS_BONES"
+	case MeshArrayCompressColor:
+		return "ARRAY_COMPRESS_COLOR"
+	case MeshArrayCompressDefault:
+		return "ARRAY_COMPRESS_DEFAULT"
+	case MeshArrayCompressIndex:
+		return "ARRAY_COMPRESS_INDEX"
+	case MeshArrayCompressNormal:
+		return "ARRAY_COMPRESS_NORMAL"
+	case MeshArrayCompressTangent:
+		return "ARRAY_COMPRESS_TANGENT"
+	case MeshArrayCompressTexUv:
+		return "ARRAY_COMPRESS_TEX_UV"
+	case MeshArrayCompressTexUv2:
+		return "ARRAY_COMPRESS_TEX_UV2"
+	case MeshArrayCompressVertex:
+		return "ARRAY_COMPRESS_VERTEX"
+	case MeshArrayCompressWeights:
+		return "ARRAY_COMPRESS_WEIGHTS"
+	case MeshArrayFlagUse16BitBones:
+		return "ARRAY_FLAG_USE_16_BIT_BONES"
+	case MeshArrayFlagUse2DVertices:
+		return "ARRAY_FLAG_USE_2D_VERTICES"
+	case MeshArrayFormatBones:
+		return "ARRAY_FORMAT_BONES"
+	case MeshArrayFormatColor:
+		return "ARRAY_FORMAT_COLOR"
+	case MeshArrayFormatIndex:
+		return "ARRAY_FORMAT_INDEX"
+	case MeshArrayFormatNormal:
+		return "ARRAY_FORMAT_NORMAL"
+	case MeshArrayFormatTangent:
+		return "ARRAY_FORMAT_TANGENT"
+	case MeshArrayFormatTexUv:
+		return "ARRAY_FORMAT_TEX_UV"
+	case MeshArrayFormatTexUv2:
+		return "ARRAY_FORMAT_TEX_UV2"
+	case MeshArrayFormatVertex:
+		return "ARRAY_FORMAT_VERTEX"
+	case MeshArrayFormatWeights:
+		return "ARRAY_FORMAT_WEIGHTS"
+	}
+	return fmt.Sprintf("MeshArrayFormat(%d)", int(e))
+}
+
+// ParseMeshArrayFormat will return the MeshArrayFormat value with the given Godot name.
+func ParseMeshArrayFormat(name string) (MeshArrayFormat, error) {
+	switch name {
+	case "ARRAY_COMPRESS_BASE":
+		return MeshArrayCompressBase, nil
+	case "ARRAY_COMPRESS_BONES":
+		return MeshArrayCompressBones, nil
+	case "ARRAY_COMPRESS_COLOR":
+		return MeshArrayCompressColor, nil
+	case "ARRAY_COMPRESS_DEFAULT":
+		return MeshArrayCompressDefault, nil
+	case "ARRAY_COMPRESS_INDEX":
+		return MeshArrayCompressIndex, nil
+	case "ARRAY_COMPRESS_NORMAL":
+		return MeshArrayCompressNormal, nil
+	case "ARRAY_COMPRESS_TANGENT":
+		return MeshArrayCompressTangent, nil
+	case "ARRAY_COMPRESS_TEX_UV":
+		return MeshArrayCompressTexUv, nil
+	case "ARRAY_COMPRESS_TEX_UV2":
+		return MeshArrayCompressTexUv2, nil
+	case "ARRAY_COMPRESS_VERTEX":
+		return MeshArrayCompressVertex, nil
+	case "ARRAY_COMPRESS_WEIGHTS":
+		return MeshArrayCompressWeights, nil
+	case "ARRAY_FLAG_USE_16_BIT_BONES":
+		return MeshArrayFlagUse16BitBones, nil
+	case "ARRAY_FLAG_USE_2D_VERTICES":
+		return MeshArrayFlagUse2DVertices, nil
+	case "ARRAY_FORMAT_BONES":
+		return MeshArrayFormatBones, nil
+	case "ARRAY_FORMAT_COLOR":
+		return MeshArrayFormatColor, nil
+	case "ARRAY_FORMAT_INDEX":
+		return MeshArrayFormatIndex, nil
+	case "ARRAY_FORMAT_NORMAL":
+		return MeshArrayFormatNormal, nil
+	case "ARRAY_FORMAT_TANGENT":
+		return MeshArrayFormatTangent, nil
+	case "ARRAY_FORMAT_TEX_UV":
+		return MeshArrayFormatTexUv, nil
+	case "ARRAY_FORMAT_TEX_UV2":
+		return MeshArrayFormatTexUv2, nil
+	case "ARRAY_FORMAT_VERTEX":
+		return MeshArrayFormatVertex, nil
+	case "ARRAY_FORMAT_WEIGHTS":
+		return MeshArrayFormatWeights, nil
+	}
+	return 0, fmt.Errorf("invalid MeshArrayFormat value %q", name)
+}
+
 // MeshArrayType is an enum for ArrayType values.
 type MeshArrayType int
 
@@ -57,6 +161,60 @@ const (
 	MeshArrayWeights MeshArrayType = 7
 )
 
+// String will return the Godot name of the MeshArrayType value.
+func (e MeshArrayType) String() string {
+	switch e {
+	case MeshArrayBones:
+		return "ARRAY_BONES"
+	case MeshArrayColor:
+		return "ARRAY_COLOR"
+	case MeshArrayIndex:
+		return "ARRAY_INDEX"
+	case MeshArrayMax:
+		return "ARRAY_MAX"
+	case MeshArrayNormal:
+		return "ARRAY_NORMAL"
+	case MeshArrayTangent:
+		return "ARRAY_TANGENT"
+	case MeshArrayTexUv:
+		return "ARRAY_TEX_UV"
+	case MeshArrayTexUv2:
+		return "ARRAY_TEX_UV2"
+	case MeshArrayVertex:
+		return "ARRAY_VERTEX"
+	case MeshArrayWeights:
+		return "ARRAY_WEIGHTS"
+	}
+	return fmt.Sprintf("MeshArrayType(%d)", int(e))
+}
+
+// ParseMeshArrayType will return the MeshArrayType value with the given Godot name.
+func ParseMeshArrayType(name string) (MeshArrayType, error) {
+	switch name {
+	case "ARRAY_BONES":
+		return MeshArrayBones, nil
+	case "ARRAY_COLOR":
+		return MeshArrayColor, nil
+	case "ARRAY_INDEX":
+		return MeshArrayIndex, nil
+	case "ARRAY_MAX":
+		return MeshArrayMax, nil
+	case "ARRAY_NORMAL":
+		return MeshArrayNormal, nil
+	case "ARRAY_TANGENT":
+		return MeshArrayTangent, nil
+	case "ARRAY_TEX_UV":
+		return MeshArrayTexUv, nil
+	case "ARRAY_TEX_UV2":
+		return MeshArrayTexUv2, nil
+	case "ARRAY_VERTEX":
+		return MeshArrayVertex, nil
+	case "ARRAY_WEIGHTS":
+		return MeshArrayWeights, nil
+	}
+	return 0, fmt.Errorf("invalid MeshArrayType value %q", name)
+}
+
 // MeshBlendShapeMode is an enum for BlendShapeMode values.
 type MeshBlendShapeMode int
 
@@ -65,6 +223,28 @@ const (
 	MeshBlendShapeModeRelative   MeshBlendShapeMode = 1
 )
 
+// String will return the Godot name of the MeshBlendShapeMode value.
+func (e MeshBlendShapeMode) String() string {
+	switch e {
+	case MeshBlendShapeModeNormalized:
+		return "BLEND_SHAPE_MODE_NORMALIZED"
+	case MeshBlendShapeModeRelative:
+		return "BLEND_SHAPE_MODE_RELATIVE"
+	}
+	return fmt.Sprintf("MeshBlendShapeMode(%d)", int(e))
+}
+
+// ParseMeshBlendShapeMode will return the MeshBlendShapeMode value with the given Godot name.
+func ParseMeshBlendShapeMode(name string) (MeshBlendShapeMode, error) {
+	switch name {
+	case "BLEND_SHAPE_MODE_NORMALIZED":
+		return MeshBlendShapeModeNormalized, nil
+	case "BLEND_SHAPE_MODE_RELATIVE":
+		return MeshBlendShapeModeRelative, nil
+	}
+	return 0, fmt.Errorf("invalid MeshBlendShapeMode value %q", name)
+}
+
 // MeshPrimitiveType is an enum for PrimitiveType values.
 type MeshPrimitiveType int
 
@@ -78,13 +258,46 @@ const (
 	MeshPrimitiveTriangleStrip MeshPrimitiveType = 5
 )
 
-//func NewMeshFromPointer(ptr gdnative.Pointer) Mesh {
-func newMeshFromPointer(ptr gdnative.Pointer) Mesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Mesh{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the MeshPrimitiveType value.
+func (e MeshPrimitiveType) String() string {
+	switch e {
+	case MeshPrimitiveLines:
+		return "PRIMITIVE_LINES"
+	case MeshPrimitiveLineLoop:
+		return "PRIMITIVE_LINE_LOOP"
+	case MeshPrimitiveLineStrip:
+		return "PRIMITIVE_LINE_STRIP"
+	case MeshPrimitivePoints:
+		return "PRIMITIVE_POINTS"
+	case MeshPrimitiveTriangles:
+		return "PRIMITIVE_TRIANGLES"
+	case MeshPrimitiveTriangleFan:
+		return "PRIMITIVE_TRIANGLE_FAN"
+	case MeshPrimitiveTriangleStrip:
+		return "PRIMITIVE_TRIANGLE_STRIP"
+	}
+	return fmt.Sprintf("MeshPrimitiveType(%d)", int(e))
+}
 
-	return obj
+// ParseMeshPrimitiveType will return the MeshPrimitiveType value with the given Godot name.
+func ParseMeshPrimitiveType(name string) (MeshPrimitiveType, error) {
+	switch name {
+	case "PRIMITIVE_LINES":
+		return MeshPrimitiveLines, nil
+	case "PRIMITIVE_LINE_LOOP":
+		return MeshPrimitiveLineLoop, nil
+	case "PRIMITIVE_LINE_STRIP":
+		return MeshPrimitiveLineStrip, nil
+	case "PRIMITIVE_POINTS":
+		return MeshPrimitivePoints, nil
+	case "PRIMITIVE_TRIANGLES":
+		return MeshPrimitiveTriangles, nil
+	case "PRIMITIVE_TRIANGLE_FAN":
+		return MeshPrimitiveTriangleFan, nil
+	case "PRIMITIVE_TRIANGLE_STRIP":
+		return MeshPrimitiveTriangleStrip, nil
+	}
+	return 0, fmt.Errorf("invalid MeshPrimitiveType value %q", name)
 }
 
 /*
@@ -99,9 +312,21 @@ func (o *Mesh) BaseClass() string {
 	return "Mesh"
 }
 
+// Method binds of the Mesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMeshCreateConvexShape    = gdnative.NewLazyMethodBind("Mesh", "create_convex_shape")
+	methodBindMeshCreateOutline        = gdnative.NewLazyMethodBind("Mesh", "create_outline")
+	methodBindMeshCreateTrimeshShape   = gdnative.NewLazyMethodBind("Mesh", "create_trimesh_shape")
+	methodBindMeshGenerateTriangleMesh = gdnative.NewLazyMethodBind("Mesh", "generate_triangle_mesh")
+	methodBindMeshGetFaces             = gdnative.NewLazyMethodBind("Mesh", "get_faces")
+	methodBindMeshGetLightmapSizeHint  = gdnative.NewLazyMethodBind("Mesh", "get_lightmap_size_hint")
+	methodBindMeshSetLightmapSizeHint  = gdnative.NewLazyMethodBind("Mesh", "set_lightmap_size_hint")
+)
+
 /*
-        Calculate a [ConvexPolygonShape] from the mesh.
-	Args: [], Returns: Shape
+	        Calculate a [ConvexPolygonShape] from the mesh.
+		Args: [], Returns: Shape
 */
 func (o *Mesh) CreateConvexShape() ShapeImplementer {
 	//log.Println("Calling Mesh.CreateConvexShape()")
@@ -110,7 +335,7 @@ func (o *Mesh) CreateConvexShape() ShapeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Mesh", "create_convex_shape")
+	methodBind := methodBindMeshCreateConvexShape.Get()
 
 	// Call the parent method.
 	// Shape
@@ -118,27 +343,25 @@ func (o *Mesh) CreateConvexShape() ShapeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShapeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShapeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shape" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShapeImplementer)
+	ret := Shape{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ShapeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Calculate an outline mesh at a defined offset (margin) from the original mesh. Note: Typically returns the vertices in reverse order (e.g. clockwise to anti-clockwise).
-	Args: [{ false margin float}], Returns: Mesh
+	        Calculate an outline mesh at a defined offset (margin) from the original mesh. Note: Typically returns the vertices in reverse order (e.g. clockwise to anti-clockwise).
+		Args: [{ false margin float}], Returns: Mesh
 */
 func (o *Mesh) CreateOutline(margin gdnative.Real) MeshImplementer {
 	//log.Println("Calling Mesh.CreateOutline()")
@@ -148,7 +371,7 @@ func (o *Mesh) CreateOutline(margin gdnative.Real) MeshImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromReal(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Mesh", "create_outline")
+	methodBind := methodBindMeshCreateOutline.Get()
 
 	// Call the parent method.
 	// Mesh
@@ -156,27 +379,25 @@ func (o *Mesh) CreateOutline(margin gdnative.Real) MeshImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Mesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MeshImplementer)
+	ret := Mesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(MeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Calculate a [ConcavePolygonShape] from the mesh.
-	Args: [], Returns: Shape
+	        Calculate a [ConcavePolygonShape] from the mesh.
+		Args: [], Returns: Shape
 */
 func (o *Mesh) CreateTrimeshShape() ShapeImplementer {
 	//log.Println("Calling Mesh.CreateTrimeshShape()")
@@ -185,7 +406,7 @@ func (o *Mesh) CreateTrimeshShape() ShapeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Mesh", "create_trimesh_shape")
+	methodBind := methodBindMeshCreateTrimeshShape.Get()
 
 	// Call the parent method.
 	// Shape
@@ -193,27 +414,25 @@ func (o *Mesh) CreateTrimeshShape() ShapeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShapeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShapeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shape" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShapeImplementer)
+	ret := Shape{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ShapeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Generate a [TriangleMesh] from the mesh.
-	Args: [], Returns: TriangleMesh
+	        Generate a [TriangleMesh] from the mesh.
+		Args: [], Returns: TriangleMesh
 */
 func (o *Mesh) GenerateTriangleMesh() TriangleMeshImplementer {
 	//log.Println("Calling Mesh.GenerateTriangleMesh()")
@@ -222,7 +441,7 @@ func (o *Mesh) GenerateTriangleMesh() TriangleMeshImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Mesh", "generate_triangle_mesh")
+	methodBind := methodBindMeshGenerateTriangleMesh.Get()
 
 	// Call the parent method.
 	// TriangleMesh
@@ -230,27 +449,25 @@ func (o *Mesh) GenerateTriangleMesh() TriangleMeshImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTriangleMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TriangleMeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TriangleMesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TriangleMeshImplementer)
+	ret := TriangleMesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TriangleMeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns all the vertices that make up the faces of the mesh. Each three vertices represent one triangle.
-	Args: [], Returns: PoolVector3Array
+	        Returns all the vertices that make up the faces of the mesh. Each three vertices represent one triangle.
+		Args: [], Returns: PoolVector3Array
 */
 func (o *Mesh) GetFaces() gdnative.PoolVector3Array {
 	//log.Println("Calling Mesh.GetFaces()")
@@ -259,7 +476,7 @@ func (o *Mesh) GetFaces() gdnative.PoolVector3Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Mesh", "get_faces")
+	methodBind := methodBindMeshGetFaces.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -272,8 +489,8 @@ func (o *Mesh) GetFaces() gdnative.PoolVector3Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Mesh) GetLightmapSizeHint() gdnative.Vector2 {
 	//log.Println("Calling Mesh.GetLightmapSizeHint()")
@@ -282,7 +499,7 @@ func (o *Mesh) GetLightmapSizeHint() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Mesh", "get_lightmap_size_hint")
+	methodBind := methodBindMeshGetLightmapSizeHint.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -295,8 +512,8 @@ func (o *Mesh) GetLightmapSizeHint() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *Mesh) SetLightmapSizeHint(size gdnative.Vector2) {
 	//log.Println("Calling Mesh.SetLightmapSizeHint()")
@@ -306,7 +523,7 @@ func (o *Mesh) SetLightmapSizeHint(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Mesh", "set_lightmap_size_hint")
+	methodBind := methodBindMeshSetLightmapSizeHint.Get()
 
 	// Call the parent method.
 	// void
@@ -315,6 +532,11 @@ func (o *Mesh) SetLightmapSizeHint(size gdnative.Vector2) {
 
 }
 
+// LightmapSizeHint will return the value of the "lightmap_size_hint" property.
+func (o *Mesh) LightmapSizeHint() gdnative.Vector2 {
+	return o.GetLightmapSizeHint()
+}
+
 // MeshImplementer is an interface that implements the methods
 // of the Mesh class.
 type MeshImplementer interface {
@@ -326,4 +548,5 @@ type MeshImplementer interface {
 	GetFaces() gdnative.PoolVector3Array
 	GetLightmapSizeHint() gdnative.Vector2
 	SetLightmapSizeHint(size gdnative.Vector2)
+	LightmapSizeHint() gdnative.Vector2
 }
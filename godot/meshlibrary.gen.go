@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMeshLibraryFromPointer(ptr gdnative.Pointer) MeshLibrary {
-func newMeshLibraryFromPointer(ptr gdnative.Pointer) MeshLibrary {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := MeshLibrary{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Library of meshes. Contains a list of [Mesh] resources, each with name and ID. Useful for GridMap or painting Terrain.
 */
@@ -30,13 +21,51 @@ type MeshLibrary struct {
 	owner gdnative.Object
 }
 
+// classConstructorMeshLibrary is the class constructor of the MeshLibrary class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMeshLibrary = gdnative.NewLazyClassConstructor("MeshLibrary")
+
+// NewMeshLibrary will create a new instance of the MeshLibrary class.
+// MeshLibrary is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewMeshLibrary() *MeshLibrary {
+	obj := &MeshLibrary{}
+	obj.SetBaseObject(classConstructorMeshLibrary.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *MeshLibrary) BaseClass() string {
 	return "MeshLibrary"
 }
 
+// Method binds of the MeshLibrary class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMeshLibraryClear               = gdnative.NewLazyMethodBind("MeshLibrary", "clear")
+	methodBindMeshLibraryCreateItem          = gdnative.NewLazyMethodBind("MeshLibrary", "create_item")
+	methodBindMeshLibraryFindItemByName      = gdnative.NewLazyMethodBind("MeshLibrary", "find_item_by_name")
+	methodBindMeshLibraryGetItemList         = gdnative.NewLazyMethodBind("MeshLibrary", "get_item_list")
+	methodBindMeshLibraryGetItemMesh         = gdnative.NewLazyMethodBind("MeshLibrary", "get_item_mesh")
+	methodBindMeshLibraryGetItemName         = gdnative.NewLazyMethodBind("MeshLibrary", "get_item_name")
+	methodBindMeshLibraryGetItemNavmesh      = gdnative.NewLazyMethodBind("MeshLibrary", "get_item_navmesh")
+	methodBindMeshLibraryGetItemPreview      = gdnative.NewLazyMethodBind("MeshLibrary", "get_item_preview")
+	methodBindMeshLibraryGetItemShapes       = gdnative.NewLazyMethodBind("MeshLibrary", "get_item_shapes")
+	methodBindMeshLibraryGetLastUnusedItemId = gdnative.NewLazyMethodBind("MeshLibrary", "get_last_unused_item_id")
+	methodBindMeshLibraryRemoveItem          = gdnative.NewLazyMethodBind("MeshLibrary", "remove_item")
+	methodBindMeshLibrarySetItemMesh         = gdnative.NewLazyMethodBind("MeshLibrary", "set_item_mesh")
+	methodBindMeshLibrarySetItemName         = gdnative.NewLazyMethodBind("MeshLibrary", "set_item_name")
+	methodBindMeshLibrarySetItemNavmesh      = gdnative.NewLazyMethodBind("MeshLibrary", "set_item_navmesh")
+	methodBindMeshLibrarySetItemPreview      = gdnative.NewLazyMethodBind("MeshLibrary", "set_item_preview")
+	methodBindMeshLibrarySetItemShapes       = gdnative.NewLazyMethodBind("MeshLibrary", "set_item_shapes")
+)
+
 /*
-        Clear the library.
-	Args: [], Returns: void
+	        Clear the library.
+		Args: [], Returns: void
 */
 func (o *MeshLibrary) Clear() {
 	//log.Println("Calling MeshLibrary.Clear()")
@@ -45,7 +74,7 @@ func (o *MeshLibrary) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "clear")
+	methodBind := methodBindMeshLibraryClear.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +84,8 @@ func (o *MeshLibrary) Clear() {
 }
 
 /*
-        Create a new item in the library, supplied an id.
-	Args: [{ false id int}], Returns: void
+	        Create a new item in the library, supplied an id.
+		Args: [{ false id int}], Returns: void
 */
 func (o *MeshLibrary) CreateItem(id gdnative.Int) {
 	//log.Println("Calling MeshLibrary.CreateItem()")
@@ -66,7 +95,7 @@ func (o *MeshLibrary) CreateItem(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "create_item")
+	methodBind := methodBindMeshLibraryCreateItem.Get()
 
 	// Call the parent method.
 	// void
@@ -76,8 +105,7 @@ func (o *MeshLibrary) CreateItem(id gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: int
+Args: [{ false name String}], Returns: int
 */
 func (o *MeshLibrary) FindItemByName(name gdnative.String) gdnative.Int {
 	//log.Println("Calling MeshLibrary.FindItemByName()")
@@ -87,7 +115,7 @@ func (o *MeshLibrary) FindItemByName(name gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "find_item_by_name")
+	methodBind := methodBindMeshLibraryFindItemByName.Get()
 
 	// Call the parent method.
 	// int
@@ -100,8 +128,8 @@ func (o *MeshLibrary) FindItemByName(name gdnative.String) gdnative.Int {
 }
 
 /*
-        Return the list of items.
-	Args: [], Returns: PoolIntArray
+	        Return the list of items.
+		Args: [], Returns: PoolIntArray
 */
 func (o *MeshLibrary) GetItemList() gdnative.PoolIntArray {
 	//log.Println("Calling MeshLibrary.GetItemList()")
@@ -110,7 +138,7 @@ func (o *MeshLibrary) GetItemList() gdnative.PoolIntArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "get_item_list")
+	methodBind := methodBindMeshLibraryGetItemList.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -123,8 +151,8 @@ func (o *MeshLibrary) GetItemList() gdnative.PoolIntArray {
 }
 
 /*
-        Return the mesh of the item.
-	Args: [{ false id int}], Returns: Mesh
+	        Return the mesh of the item.
+		Args: [{ false id int}], Returns: Mesh
 */
 func (o *MeshLibrary) GetItemMesh(id gdnative.Int) MeshImplementer {
 	//log.Println("Calling MeshLibrary.GetItemMesh()")
@@ -134,7 +162,7 @@ func (o *MeshLibrary) GetItemMesh(id gdnative.Int) MeshImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "get_item_mesh")
+	methodBind := methodBindMeshLibraryGetItemMesh.Get()
 
 	// Call the parent method.
 	// Mesh
@@ -142,27 +170,25 @@ func (o *MeshLibrary) GetItemMesh(id gdnative.Int) MeshImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Mesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MeshImplementer)
+	ret := Mesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(MeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the name of the item.
-	Args: [{ false id int}], Returns: String
+	        Return the name of the item.
+		Args: [{ false id int}], Returns: String
 */
 func (o *MeshLibrary) GetItemName(id gdnative.Int) gdnative.String {
 	//log.Println("Calling MeshLibrary.GetItemName()")
@@ -172,7 +198,7 @@ func (o *MeshLibrary) GetItemName(id gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "get_item_name")
+	methodBind := methodBindMeshLibraryGetItemName.Get()
 
 	// Call the parent method.
 	// String
@@ -185,8 +211,7 @@ func (o *MeshLibrary) GetItemName(id gdnative.Int) gdnative.String {
 }
 
 /*
-
-	Args: [{ false id int}], Returns: NavigationMesh
+Args: [{ false id int}], Returns: NavigationMesh
 */
 func (o *MeshLibrary) GetItemNavmesh(id gdnative.Int) NavigationMeshImplementer {
 	//log.Println("Calling MeshLibrary.GetItemNavmesh()")
@@ -196,7 +221,7 @@ func (o *MeshLibrary) GetItemNavmesh(id gdnative.Int) NavigationMeshImplementer
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "get_item_navmesh")
+	methodBind := methodBindMeshLibraryGetItemNavmesh.Get()
 
 	// Call the parent method.
 	// NavigationMesh
@@ -204,27 +229,24 @@ func (o *MeshLibrary) GetItemNavmesh(id gdnative.Int) NavigationMeshImplementer
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNavigationMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NavigationMeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "NavigationMesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NavigationMeshImplementer)
+	ret := NavigationMesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NavigationMeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false id int}], Returns: Texture
+Args: [{ false id int}], Returns: Texture
 */
 func (o *MeshLibrary) GetItemPreview(id gdnative.Int) TextureImplementer {
 	//log.Println("Calling MeshLibrary.GetItemPreview()")
@@ -234,7 +256,7 @@ func (o *MeshLibrary) GetItemPreview(id gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "get_item_preview")
+	methodBind := methodBindMeshLibraryGetItemPreview.Get()
 
 	// Call the parent method.
 	// Texture
@@ -242,27 +264,24 @@ func (o *MeshLibrary) GetItemPreview(id gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false id int}], Returns: Array
+Args: [{ false id int}], Returns: Array
 */
 func (o *MeshLibrary) GetItemShapes(id gdnative.Int) gdnative.Array {
 	//log.Println("Calling MeshLibrary.GetItemShapes()")
@@ -272,7 +291,7 @@ func (o *MeshLibrary) GetItemShapes(id gdnative.Int) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "get_item_shapes")
+	methodBind := methodBindMeshLibraryGetItemShapes.Get()
 
 	// Call the parent method.
 	// Array
@@ -285,8 +304,8 @@ func (o *MeshLibrary) GetItemShapes(id gdnative.Int) gdnative.Array {
 }
 
 /*
-        Get an unused id for a new item.
-	Args: [], Returns: int
+	        Get an unused id for a new item.
+		Args: [], Returns: int
 */
 func (o *MeshLibrary) GetLastUnusedItemId() gdnative.Int {
 	//log.Println("Calling MeshLibrary.GetLastUnusedItemId()")
@@ -295,7 +314,7 @@ func (o *MeshLibrary) GetLastUnusedItemId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "get_last_unused_item_id")
+	methodBind := methodBindMeshLibraryGetLastUnusedItemId.Get()
 
 	// Call the parent method.
 	// int
@@ -308,8 +327,8 @@ func (o *MeshLibrary) GetLastUnusedItemId() gdnative.Int {
 }
 
 /*
-        Remove the item.
-	Args: [{ false id int}], Returns: void
+	        Remove the item.
+		Args: [{ false id int}], Returns: void
 */
 func (o *MeshLibrary) RemoveItem(id gdnative.Int) {
 	//log.Println("Calling MeshLibrary.RemoveItem()")
@@ -319,7 +338,7 @@ func (o *MeshLibrary) RemoveItem(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "remove_item")
+	methodBind := methodBindMeshLibraryRemoveItem.Get()
 
 	// Call the parent method.
 	// void
@@ -329,8 +348,8 @@ func (o *MeshLibrary) RemoveItem(id gdnative.Int) {
 }
 
 /*
-        Set the mesh of the item.
-	Args: [{ false id int} { false mesh Mesh}], Returns: void
+	        Set the mesh of the item.
+		Args: [{ false id int} { false mesh Mesh}], Returns: void
 */
 func (o *MeshLibrary) SetItemMesh(id gdnative.Int, mesh MeshImplementer) {
 	//log.Println("Calling MeshLibrary.SetItemMesh()")
@@ -338,10 +357,10 @@ func (o *MeshLibrary) SetItemMesh(id gdnative.Int, mesh MeshImplementer) {
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(mesh))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "set_item_mesh")
+	methodBind := methodBindMeshLibrarySetItemMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -351,8 +370,8 @@ func (o *MeshLibrary) SetItemMesh(id gdnative.Int, mesh MeshImplementer) {
 }
 
 /*
-        Set the name of the item.
-	Args: [{ false id int} { false name String}], Returns: void
+	        Set the name of the item.
+		Args: [{ false id int} { false name String}], Returns: void
 */
 func (o *MeshLibrary) SetItemName(id gdnative.Int, name gdnative.String) {
 	//log.Println("Calling MeshLibrary.SetItemName()")
@@ -363,7 +382,7 @@ func (o *MeshLibrary) SetItemName(id gdnative.Int, name gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "set_item_name")
+	methodBind := methodBindMeshLibrarySetItemName.Get()
 
 	// Call the parent method.
 	// void
@@ -373,8 +392,7 @@ func (o *MeshLibrary) SetItemName(id gdnative.Int, name gdnative.String) {
 }
 
 /*
-
-	Args: [{ false id int} { false navmesh NavigationMesh}], Returns: void
+Args: [{ false id int} { false navmesh NavigationMesh}], Returns: void
 */
 func (o *MeshLibrary) SetItemNavmesh(id gdnative.Int, navmesh NavigationMeshImplementer) {
 	//log.Println("Calling MeshLibrary.SetItemNavmesh()")
@@ -382,10 +400,10 @@ func (o *MeshLibrary) SetItemNavmesh(id gdnative.Int, navmesh NavigationMeshImpl
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(navmesh.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(navmesh))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "set_item_navmesh")
+	methodBind := methodBindMeshLibrarySetItemNavmesh.Get()
 
 	// Call the parent method.
 	// void
@@ -395,8 +413,7 @@ func (o *MeshLibrary) SetItemNavmesh(id gdnative.Int, navmesh NavigationMeshImpl
 }
 
 /*
-
-	Args: [{ false id int} { false texture Texture}], Returns: void
+Args: [{ false id int} { false texture Texture}], Returns: void
 */
 func (o *MeshLibrary) SetItemPreview(id gdnative.Int, texture TextureImplementer) {
 	//log.Println("Calling MeshLibrary.SetItemPreview()")
@@ -404,10 +421,10 @@ func (o *MeshLibrary) SetItemPreview(id gdnative.Int, texture TextureImplementer
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "set_item_preview")
+	methodBind := methodBindMeshLibrarySetItemPreview.Get()
 
 	// Call the parent method.
 	// void
@@ -417,8 +434,7 @@ func (o *MeshLibrary) SetItemPreview(id gdnative.Int, texture TextureImplementer
 }
 
 /*
-
-	Args: [{ false id int} { false shapes Array}], Returns: void
+Args: [{ false id int} { false shapes Array}], Returns: void
 */
 func (o *MeshLibrary) SetItemShapes(id gdnative.Int, shapes gdnative.Array) {
 	//log.Println("Calling MeshLibrary.SetItemShapes()")
@@ -429,7 +445,7 @@ func (o *MeshLibrary) SetItemShapes(id gdnative.Int, shapes gdnative.Array) {
 	ptrArguments[1] = gdnative.NewPointerFromArray(shapes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshLibrary", "set_item_shapes")
+	methodBind := methodBindMeshLibrarySetItemShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -13,15 +15,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewNativeScriptFromPointer(ptr gdnative.Pointer) NativeScript {
-func newNativeScriptFromPointer(ptr gdnative.Pointer) NativeScript {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := NativeScript{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +23,40 @@ type NativeScript struct {
 	owner gdnative.Object
 }
 
+// classConstructorNativeScript is the class constructor of the NativeScript class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNativeScript = gdnative.NewLazyClassConstructor("NativeScript")
+
+// NewNativeScript will create a new instance of the NativeScript class.
+// NativeScript is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewNativeScript() *NativeScript {
+	obj := &NativeScript{}
+	obj.SetBaseObject(classConstructorNativeScript.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *NativeScript) BaseClass() string {
 	return "NativeScript"
 }
 
+// Method binds of the NativeScript class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNativeScriptGetClassName = gdnative.NewLazyMethodBind("NativeScript", "get_class_name")
+	methodBindNativeScriptGetLibrary   = gdnative.NewLazyMethodBind("NativeScript", "get_library")
+	methodBindNativeScriptNew          = gdnative.NewLazyMethodBind("NativeScript", "new")
+	methodBindNativeScriptSetClassName = gdnative.NewLazyMethodBind("NativeScript", "set_class_name")
+	methodBindNativeScriptSetLibrary   = gdnative.NewLazyMethodBind("NativeScript", "set_library")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *NativeScript) GetClassName() gdnative.String {
 	//log.Println("Calling NativeScript.GetClassName()")
@@ -45,7 +65,7 @@ func (o *NativeScript) GetClassName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NativeScript", "get_class_name")
+	methodBind := methodBindNativeScriptGetClassName.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +78,8 @@ func (o *NativeScript) GetClassName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: GDNativeLibrary
+	        Undocumented
+		Args: [], Returns: GDNativeLibrary
 */
 func (o *NativeScript) GetLibrary() GDNativeLibraryImplementer {
 	//log.Println("Calling NativeScript.GetLibrary()")
@@ -68,7 +88,7 @@ func (o *NativeScript) GetLibrary() GDNativeLibraryImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NativeScript", "get_library")
+	methodBind := methodBindNativeScriptGetLibrary.Get()
 
 	// Call the parent method.
 	// GDNativeLibrary
@@ -76,64 +96,71 @@ func (o *NativeScript) GetLibrary() GDNativeLibraryImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newGDNativeLibraryFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(GDNativeLibraryImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "GDNativeLibrary" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(GDNativeLibraryImplementer)
+	ret := GDNativeLibrary{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(GDNativeLibraryImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Object
+	        Undocumented
+		Args: [], Returns: Object
 */
-func (o *NativeScript) New() ObjectImplementer {
+func (o *NativeScript) New(args ...gdnative.Variant) (ObjectImplementer, error) {
 	//log.Println("Calling NativeScript.New()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 0+len(args))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NativeScript", "new")
+	methodBind := methodBindNativeScriptNew.Get()
 
 	// Call the parent method.
-	// Object
-	retPtr := gdnative.NewEmptyObject()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
+	// The returned variant is only needed to get the object.
+	defer ret.Destroy()
+	if err != nil {
+		return nil, err
 	}
 
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	// Convert the returned variant into its actual object.
+	retObject := ret.AsObject()
+	if retObject.IsNil() {
+		return nil, nil
 	}
-
-	return &ret
+	className := Object{}
+	className.SetBaseObject(retObject)
+
+	// Destroying the returned variant drops its reference, so take our own
+	// reference to returned reference types. The caller must drop it with
+	// Release().
+	if className.IsClass("Reference") {
+		reference := Reference{}
+		reference.SetBaseObject(retObject)
+		reference.ReferenceMethod()
+	}
+	if actualRet, ok := GetActualClass(className.GetClass(), retObject).(ObjectImplementer); ok {
+		return actualRet, nil
+	}
+	return nil, fmt.Errorf("object of class %s can't be returned as Object", className.GetClass())
 }
 
 /*
-        Undocumented
-	Args: [{ false class_name String}], Returns: void
+	        Undocumented
+		Args: [{ false class_name String}], Returns: void
 */
 func (o *NativeScript) SetClassName(className gdnative.String) {
 	//log.Println("Calling NativeScript.SetClassName()")
@@ -143,7 +170,7 @@ func (o *NativeScript) SetClassName(className gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(className)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NativeScript", "set_class_name")
+	methodBind := methodBindNativeScriptSetClassName.Get()
 
 	// Call the parent method.
 	// void
@@ -153,18 +180,18 @@ func (o *NativeScript) SetClassName(className gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false library GDNativeLibrary}], Returns: void
+	        Undocumented
+		Args: [{ false library GDNativeLibrary}], Returns: void
 */
 func (o *NativeScript) SetLibrary(library GDNativeLibraryImplementer) {
 	//log.Println("Calling NativeScript.SetLibrary()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(library.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(library))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NativeScript", "set_library")
+	methodBind := methodBindNativeScriptSetLibrary.Get()
 
 	// Call the parent method.
 	// void
@@ -173,13 +200,25 @@ func (o *NativeScript) SetLibrary(library GDNativeLibraryImplementer) {
 
 }
 
+// ClassName will return the value of the "class_name" property.
+func (o *NativeScript) ClassName() gdnative.String {
+	return o.GetClassName()
+}
+
+// Library will return the value of the "library" property.
+func (o *NativeScript) Library() GDNativeLibraryImplementer {
+	return o.GetLibrary()
+}
+
 // NativeScriptImplementer is an interface that implements the methods
 // of the NativeScript class.
 type NativeScriptImplementer interface {
 	ScriptImplementer
 	GetClassName() gdnative.String
 	GetLibrary() GDNativeLibraryImplementer
-	New() ObjectImplementer
+	New(args ...gdnative.Variant) (ObjectImplementer, error)
 	SetClassName(className gdnative.String)
 	SetLibrary(library GDNativeLibraryImplementer)
+	ClassName() gdnative.String
+	Library() GDNativeLibraryImplementer
 }
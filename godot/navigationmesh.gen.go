@@ -13,30 +13,92 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewNavigationMeshFromPointer(ptr gdnative.Pointer) NavigationMesh {
-func newNavigationMeshFromPointer(ptr gdnative.Pointer) NavigationMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := NavigationMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
+// Constants of the NavigationMesh class.
+const (
+	NavigationMeshSamplePartitionLayers    gdnative.Int = 2
+	NavigationMeshSamplePartitionMonotone  gdnative.Int = 1
+	NavigationMeshSamplePartitionWatershed gdnative.Int = 0
+)
 
 /*
-
  */
 type NavigationMesh struct {
 	Resource
 	owner gdnative.Object
 }
 
+// classConstructorNavigationMesh is the class constructor of the NavigationMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNavigationMesh = gdnative.NewLazyClassConstructor("NavigationMesh")
+
+// NewNavigationMesh will create a new instance of the NavigationMesh class.
+// NavigationMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewNavigationMesh() *NavigationMesh {
+	obj := &NavigationMesh{}
+	obj.SetBaseObject(classConstructorNavigationMesh.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *NavigationMesh) BaseClass() string {
 	return "NavigationMesh"
 }
 
+// Method binds of the NavigationMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNavigationMeshX_GetPolygons                   = gdnative.NewLazyMethodBind("NavigationMesh", "_get_polygons")
+	methodBindNavigationMeshX_SetPolygons                   = gdnative.NewLazyMethodBind("NavigationMesh", "_set_polygons")
+	methodBindNavigationMeshAddPolygon                      = gdnative.NewLazyMethodBind("NavigationMesh", "add_polygon")
+	methodBindNavigationMeshClearPolygons                   = gdnative.NewLazyMethodBind("NavigationMesh", "clear_polygons")
+	methodBindNavigationMeshCreateFromMesh                  = gdnative.NewLazyMethodBind("NavigationMesh", "create_from_mesh")
+	methodBindNavigationMeshGetAgentHeight                  = gdnative.NewLazyMethodBind("NavigationMesh", "get_agent_height")
+	methodBindNavigationMeshGetAgentMaxClimb                = gdnative.NewLazyMethodBind("NavigationMesh", "get_agent_max_climb")
+	methodBindNavigationMeshGetAgentMaxSlope                = gdnative.NewLazyMethodBind("NavigationMesh", "get_agent_max_slope")
+	methodBindNavigationMeshGetAgentRadius                  = gdnative.NewLazyMethodBind("NavigationMesh", "get_agent_radius")
+	methodBindNavigationMeshGetCellHeight                   = gdnative.NewLazyMethodBind("NavigationMesh", "get_cell_height")
+	methodBindNavigationMeshGetCellSize                     = gdnative.NewLazyMethodBind("NavigationMesh", "get_cell_size")
+	methodBindNavigationMeshGetDetailSampleDistance         = gdnative.NewLazyMethodBind("NavigationMesh", "get_detail_sample_distance")
+	methodBindNavigationMeshGetDetailSampleMaxError         = gdnative.NewLazyMethodBind("NavigationMesh", "get_detail_sample_max_error")
+	methodBindNavigationMeshGetEdgeMaxError                 = gdnative.NewLazyMethodBind("NavigationMesh", "get_edge_max_error")
+	methodBindNavigationMeshGetEdgeMaxLength                = gdnative.NewLazyMethodBind("NavigationMesh", "get_edge_max_length")
+	methodBindNavigationMeshGetFilterLedgeSpans             = gdnative.NewLazyMethodBind("NavigationMesh", "get_filter_ledge_spans")
+	methodBindNavigationMeshGetFilterLowHangingObstacles    = gdnative.NewLazyMethodBind("NavigationMesh", "get_filter_low_hanging_obstacles")
+	methodBindNavigationMeshGetFilterWalkableLowHeightSpans = gdnative.NewLazyMethodBind("NavigationMesh", "get_filter_walkable_low_height_spans")
+	methodBindNavigationMeshGetPolygon                      = gdnative.NewLazyMethodBind("NavigationMesh", "get_polygon")
+	methodBindNavigationMeshGetPolygonCount                 = gdnative.NewLazyMethodBind("NavigationMesh", "get_polygon_count")
+	methodBindNavigationMeshGetRegionMergeSize              = gdnative.NewLazyMethodBind("NavigationMesh", "get_region_merge_size")
+	methodBindNavigationMeshGetRegionMinSize                = gdnative.NewLazyMethodBind("NavigationMesh", "get_region_min_size")
+	methodBindNavigationMeshGetSamplePartitionType          = gdnative.NewLazyMethodBind("NavigationMesh", "get_sample_partition_type")
+	methodBindNavigationMeshGetVertices                     = gdnative.NewLazyMethodBind("NavigationMesh", "get_vertices")
+	methodBindNavigationMeshGetVertsPerPoly                 = gdnative.NewLazyMethodBind("NavigationMesh", "get_verts_per_poly")
+	methodBindNavigationMeshSetAgentHeight                  = gdnative.NewLazyMethodBind("NavigationMesh", "set_agent_height")
+	methodBindNavigationMeshSetAgentMaxClimb                = gdnative.NewLazyMethodBind("NavigationMesh", "set_agent_max_climb")
+	methodBindNavigationMeshSetAgentMaxSlope                = gdnative.NewLazyMethodBind("NavigationMesh", "set_agent_max_slope")
+	methodBindNavigationMeshSetAgentRadius                  = gdnative.NewLazyMethodBind("NavigationMesh", "set_agent_radius")
+	methodBindNavigationMeshSetCellHeight                   = gdnative.NewLazyMethodBind("NavigationMesh", "set_cell_height")
+	methodBindNavigationMeshSetCellSize                     = gdnative.NewLazyMethodBind("NavigationMesh", "set_cell_size")
+	methodBindNavigationMeshSetDetailSampleDistance         = gdnative.NewLazyMethodBind("NavigationMesh", "set_detail_sample_distance")
+	methodBindNavigationMeshSetDetailSampleMaxError         = gdnative.NewLazyMethodBind("NavigationMesh", "set_detail_sample_max_error")
+	methodBindNavigationMeshSetEdgeMaxError                 = gdnative.NewLazyMethodBind("NavigationMesh", "set_edge_max_error")
+	methodBindNavigationMeshSetEdgeMaxLength                = gdnative.NewLazyMethodBind("NavigationMesh", "set_edge_max_length")
+	methodBindNavigationMeshSetFilterLedgeSpans             = gdnative.NewLazyMethodBind("NavigationMesh", "set_filter_ledge_spans")
+	methodBindNavigationMeshSetFilterLowHangingObstacles    = gdnative.NewLazyMethodBind("NavigationMesh", "set_filter_low_hanging_obstacles")
+	methodBindNavigationMeshSetFilterWalkableLowHeightSpans = gdnative.NewLazyMethodBind("NavigationMesh", "set_filter_walkable_low_height_spans")
+	methodBindNavigationMeshSetRegionMergeSize              = gdnative.NewLazyMethodBind("NavigationMesh", "set_region_merge_size")
+	methodBindNavigationMeshSetRegionMinSize                = gdnative.NewLazyMethodBind("NavigationMesh", "set_region_min_size")
+	methodBindNavigationMeshSetSamplePartitionType          = gdnative.NewLazyMethodBind("NavigationMesh", "set_sample_partition_type")
+	methodBindNavigationMeshSetVertices                     = gdnative.NewLazyMethodBind("NavigationMesh", "set_vertices")
+	methodBindNavigationMeshSetVertsPerPoly                 = gdnative.NewLazyMethodBind("NavigationMesh", "set_verts_per_poly")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *NavigationMesh) X_GetPolygons() gdnative.Array {
 	//log.Println("Calling NavigationMesh.X_GetPolygons()")
@@ -45,7 +107,7 @@ func (o *NavigationMesh) X_GetPolygons() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "_get_polygons")
+	methodBind := methodBindNavigationMeshX_GetPolygons.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +120,8 @@ func (o *NavigationMesh) X_GetPolygons() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false polygons Array}], Returns: void
+	        Undocumented
+		Args: [{ false polygons Array}], Returns: void
 */
 func (o *NavigationMesh) X_SetPolygons(polygons gdnative.Array) {
 	//log.Println("Calling NavigationMesh.X_SetPolygons()")
@@ -69,7 +131,7 @@ func (o *NavigationMesh) X_SetPolygons(polygons gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(polygons)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "_set_polygons")
+	methodBind := methodBindNavigationMeshX_SetPolygons.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +141,7 @@ func (o *NavigationMesh) X_SetPolygons(polygons gdnative.Array) {
 }
 
 /*
-
-	Args: [{ false polygon PoolIntArray}], Returns: void
+Args: [{ false polygon PoolIntArray}], Returns: void
 */
 func (o *NavigationMesh) AddPolygon(polygon gdnative.PoolIntArray) {
 	//log.Println("Calling NavigationMesh.AddPolygon()")
@@ -90,7 +151,7 @@ func (o *NavigationMesh) AddPolygon(polygon gdnative.PoolIntArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolIntArray(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "add_polygon")
+	methodBind := methodBindNavigationMeshAddPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +161,7 @@ func (o *NavigationMesh) AddPolygon(polygon gdnative.PoolIntArray) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *NavigationMesh) ClearPolygons() {
 	//log.Println("Calling NavigationMesh.ClearPolygons()")
@@ -110,7 +170,7 @@ func (o *NavigationMesh) ClearPolygons() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "clear_polygons")
+	methodBind := methodBindNavigationMeshClearPolygons.Get()
 
 	// Call the parent method.
 	// void
@@ -120,18 +180,17 @@ func (o *NavigationMesh) ClearPolygons() {
 }
 
 /*
-
-	Args: [{ false mesh Mesh}], Returns: void
+Args: [{ false mesh Mesh}], Returns: void
 */
 func (o *NavigationMesh) CreateFromMesh(mesh MeshImplementer) {
 	//log.Println("Calling NavigationMesh.CreateFromMesh()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(mesh))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "create_from_mesh")
+	methodBind := methodBindNavigationMeshCreateFromMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -141,8 +200,8 @@ func (o *NavigationMesh) CreateFromMesh(mesh MeshImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetAgentHeight() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetAgentHeight()")
@@ -151,7 +210,7 @@ func (o *NavigationMesh) GetAgentHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_agent_height")
+	methodBind := methodBindNavigationMeshGetAgentHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -164,8 +223,8 @@ func (o *NavigationMesh) GetAgentHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetAgentMaxClimb() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetAgentMaxClimb()")
@@ -174,7 +233,7 @@ func (o *NavigationMesh) GetAgentMaxClimb() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_agent_max_climb")
+	methodBind := methodBindNavigationMeshGetAgentMaxClimb.Get()
 
 	// Call the parent method.
 	// float
@@ -187,8 +246,8 @@ func (o *NavigationMesh) GetAgentMaxClimb() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetAgentMaxSlope() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetAgentMaxSlope()")
@@ -197,7 +256,7 @@ func (o *NavigationMesh) GetAgentMaxSlope() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_agent_max_slope")
+	methodBind := methodBindNavigationMeshGetAgentMaxSlope.Get()
 
 	// Call the parent method.
 	// float
@@ -210,8 +269,8 @@ func (o *NavigationMesh) GetAgentMaxSlope() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetAgentRadius() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetAgentRadius()")
@@ -220,7 +279,7 @@ func (o *NavigationMesh) GetAgentRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_agent_radius")
+	methodBind := methodBindNavigationMeshGetAgentRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -233,8 +292,8 @@ func (o *NavigationMesh) GetAgentRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetCellHeight() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetCellHeight()")
@@ -243,7 +302,7 @@ func (o *NavigationMesh) GetCellHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_cell_height")
+	methodBind := methodBindNavigationMeshGetCellHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -256,8 +315,8 @@ func (o *NavigationMesh) GetCellHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetCellSize() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetCellSize()")
@@ -266,7 +325,7 @@ func (o *NavigationMesh) GetCellSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_cell_size")
+	methodBind := methodBindNavigationMeshGetCellSize.Get()
 
 	// Call the parent method.
 	// float
@@ -279,8 +338,8 @@ func (o *NavigationMesh) GetCellSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetDetailSampleDistance() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetDetailSampleDistance()")
@@ -289,7 +348,7 @@ func (o *NavigationMesh) GetDetailSampleDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_detail_sample_distance")
+	methodBind := methodBindNavigationMeshGetDetailSampleDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -302,8 +361,8 @@ func (o *NavigationMesh) GetDetailSampleDistance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetDetailSampleMaxError() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetDetailSampleMaxError()")
@@ -312,7 +371,7 @@ func (o *NavigationMesh) GetDetailSampleMaxError() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_detail_sample_max_error")
+	methodBind := methodBindNavigationMeshGetDetailSampleMaxError.Get()
 
 	// Call the parent method.
 	// float
@@ -325,8 +384,8 @@ func (o *NavigationMesh) GetDetailSampleMaxError() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetEdgeMaxError() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetEdgeMaxError()")
@@ -335,7 +394,7 @@ func (o *NavigationMesh) GetEdgeMaxError() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_edge_max_error")
+	methodBind := methodBindNavigationMeshGetEdgeMaxError.Get()
 
 	// Call the parent method.
 	// float
@@ -348,8 +407,8 @@ func (o *NavigationMesh) GetEdgeMaxError() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetEdgeMaxLength() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetEdgeMaxLength()")
@@ -358,7 +417,7 @@ func (o *NavigationMesh) GetEdgeMaxLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_edge_max_length")
+	methodBind := methodBindNavigationMeshGetEdgeMaxLength.Get()
 
 	// Call the parent method.
 	// float
@@ -371,8 +430,8 @@ func (o *NavigationMesh) GetEdgeMaxLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *NavigationMesh) GetFilterLedgeSpans() gdnative.Bool {
 	//log.Println("Calling NavigationMesh.GetFilterLedgeSpans()")
@@ -381,7 +440,7 @@ func (o *NavigationMesh) GetFilterLedgeSpans() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_filter_ledge_spans")
+	methodBind := methodBindNavigationMeshGetFilterLedgeSpans.Get()
 
 	// Call the parent method.
 	// bool
@@ -394,8 +453,8 @@ func (o *NavigationMesh) GetFilterLedgeSpans() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *NavigationMesh) GetFilterLowHangingObstacles() gdnative.Bool {
 	//log.Println("Calling NavigationMesh.GetFilterLowHangingObstacles()")
@@ -404,7 +463,7 @@ func (o *NavigationMesh) GetFilterLowHangingObstacles() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_filter_low_hanging_obstacles")
+	methodBind := methodBindNavigationMeshGetFilterLowHangingObstacles.Get()
 
 	// Call the parent method.
 	// bool
@@ -417,8 +476,8 @@ func (o *NavigationMesh) GetFilterLowHangingObstacles() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *NavigationMesh) GetFilterWalkableLowHeightSpans() gdnative.Bool {
 	//log.Println("Calling NavigationMesh.GetFilterWalkableLowHeightSpans()")
@@ -427,7 +486,7 @@ func (o *NavigationMesh) GetFilterWalkableLowHeightSpans() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_filter_walkable_low_height_spans")
+	methodBind := methodBindNavigationMeshGetFilterWalkableLowHeightSpans.Get()
 
 	// Call the parent method.
 	// bool
@@ -440,8 +499,7 @@ func (o *NavigationMesh) GetFilterWalkableLowHeightSpans() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolIntArray
+Args: [{ false idx int}], Returns: PoolIntArray
 */
 func (o *NavigationMesh) GetPolygon(idx gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling NavigationMesh.GetPolygon()")
@@ -451,7 +509,7 @@ func (o *NavigationMesh) GetPolygon(idx gdnative.Int) gdnative.PoolIntArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_polygon")
+	methodBind := methodBindNavigationMeshGetPolygon.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -464,8 +522,7 @@ func (o *NavigationMesh) GetPolygon(idx gdnative.Int) gdnative.PoolIntArray {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *NavigationMesh) GetPolygonCount() gdnative.Int {
 	//log.Println("Calling NavigationMesh.GetPolygonCount()")
@@ -474,7 +531,7 @@ func (o *NavigationMesh) GetPolygonCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_polygon_count")
+	methodBind := methodBindNavigationMeshGetPolygonCount.Get()
 
 	// Call the parent method.
 	// int
@@ -487,8 +544,8 @@ func (o *NavigationMesh) GetPolygonCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetRegionMergeSize() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetRegionMergeSize()")
@@ -497,7 +554,7 @@ func (o *NavigationMesh) GetRegionMergeSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_region_merge_size")
+	methodBind := methodBindNavigationMeshGetRegionMergeSize.Get()
 
 	// Call the parent method.
 	// float
@@ -510,8 +567,8 @@ func (o *NavigationMesh) GetRegionMergeSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetRegionMinSize() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetRegionMinSize()")
@@ -520,7 +577,7 @@ func (o *NavigationMesh) GetRegionMinSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_region_min_size")
+	methodBind := methodBindNavigationMeshGetRegionMinSize.Get()
 
 	// Call the parent method.
 	// float
@@ -533,8 +590,8 @@ func (o *NavigationMesh) GetRegionMinSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *NavigationMesh) GetSamplePartitionType() gdnative.Int {
 	//log.Println("Calling NavigationMesh.GetSamplePartitionType()")
@@ -543,7 +600,7 @@ func (o *NavigationMesh) GetSamplePartitionType() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_sample_partition_type")
+	methodBind := methodBindNavigationMeshGetSamplePartitionType.Get()
 
 	// Call the parent method.
 	// int
@@ -556,8 +613,7 @@ func (o *NavigationMesh) GetSamplePartitionType() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: PoolVector3Array
+Args: [], Returns: PoolVector3Array
 */
 func (o *NavigationMesh) GetVertices() gdnative.PoolVector3Array {
 	//log.Println("Calling NavigationMesh.GetVertices()")
@@ -566,7 +622,7 @@ func (o *NavigationMesh) GetVertices() gdnative.PoolVector3Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_vertices")
+	methodBind := methodBindNavigationMeshGetVertices.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -579,8 +635,8 @@ func (o *NavigationMesh) GetVertices() gdnative.PoolVector3Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *NavigationMesh) GetVertsPerPoly() gdnative.Real {
 	//log.Println("Calling NavigationMesh.GetVertsPerPoly()")
@@ -589,7 +645,7 @@ func (o *NavigationMesh) GetVertsPerPoly() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "get_verts_per_poly")
+	methodBind := methodBindNavigationMeshGetVertsPerPoly.Get()
 
 	// Call the parent method.
 	// float
@@ -602,8 +658,8 @@ func (o *NavigationMesh) GetVertsPerPoly() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false agent_height float}], Returns: void
+	        Undocumented
+		Args: [{ false agent_height float}], Returns: void
 */
 func (o *NavigationMesh) SetAgentHeight(agentHeight gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetAgentHeight()")
@@ -613,7 +669,7 @@ func (o *NavigationMesh) SetAgentHeight(agentHeight gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(agentHeight)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_agent_height")
+	methodBind := methodBindNavigationMeshSetAgentHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -623,8 +679,8 @@ func (o *NavigationMesh) SetAgentHeight(agentHeight gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false agent_max_climb float}], Returns: void
+	        Undocumented
+		Args: [{ false agent_max_climb float}], Returns: void
 */
 func (o *NavigationMesh) SetAgentMaxClimb(agentMaxClimb gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetAgentMaxClimb()")
@@ -634,7 +690,7 @@ func (o *NavigationMesh) SetAgentMaxClimb(agentMaxClimb gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(agentMaxClimb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_agent_max_climb")
+	methodBind := methodBindNavigationMeshSetAgentMaxClimb.Get()
 
 	// Call the parent method.
 	// void
@@ -644,8 +700,8 @@ func (o *NavigationMesh) SetAgentMaxClimb(agentMaxClimb gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false agent_max_slope float}], Returns: void
+	        Undocumented
+		Args: [{ false agent_max_slope float}], Returns: void
 */
 func (o *NavigationMesh) SetAgentMaxSlope(agentMaxSlope gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetAgentMaxSlope()")
@@ -655,7 +711,7 @@ func (o *NavigationMesh) SetAgentMaxSlope(agentMaxSlope gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(agentMaxSlope)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_agent_max_slope")
+	methodBind := methodBindNavigationMeshSetAgentMaxSlope.Get()
 
 	// Call the parent method.
 	// void
@@ -665,8 +721,8 @@ func (o *NavigationMesh) SetAgentMaxSlope(agentMaxSlope gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false agent_radius float}], Returns: void
+	        Undocumented
+		Args: [{ false agent_radius float}], Returns: void
 */
 func (o *NavigationMesh) SetAgentRadius(agentRadius gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetAgentRadius()")
@@ -676,7 +732,7 @@ func (o *NavigationMesh) SetAgentRadius(agentRadius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(agentRadius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_agent_radius")
+	methodBind := methodBindNavigationMeshSetAgentRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -686,8 +742,8 @@ func (o *NavigationMesh) SetAgentRadius(agentRadius gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false cell_height float}], Returns: void
+	        Undocumented
+		Args: [{ false cell_height float}], Returns: void
 */
 func (o *NavigationMesh) SetCellHeight(cellHeight gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetCellHeight()")
@@ -697,7 +753,7 @@ func (o *NavigationMesh) SetCellHeight(cellHeight gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(cellHeight)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_cell_height")
+	methodBind := methodBindNavigationMeshSetCellHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -707,8 +763,8 @@ func (o *NavigationMesh) SetCellHeight(cellHeight gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false cell_size float}], Returns: void
+	        Undocumented
+		Args: [{ false cell_size float}], Returns: void
 */
 func (o *NavigationMesh) SetCellSize(cellSize gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetCellSize()")
@@ -718,7 +774,7 @@ func (o *NavigationMesh) SetCellSize(cellSize gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(cellSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_cell_size")
+	methodBind := methodBindNavigationMeshSetCellSize.Get()
 
 	// Call the parent method.
 	// void
@@ -728,8 +784,8 @@ func (o *NavigationMesh) SetCellSize(cellSize gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false detail_sample_dist float}], Returns: void
+	        Undocumented
+		Args: [{ false detail_sample_dist float}], Returns: void
 */
 func (o *NavigationMesh) SetDetailSampleDistance(detailSampleDist gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetDetailSampleDistance()")
@@ -739,7 +795,7 @@ func (o *NavigationMesh) SetDetailSampleDistance(detailSampleDist gdnative.Real)
 	ptrArguments[0] = gdnative.NewPointerFromReal(detailSampleDist)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_detail_sample_distance")
+	methodBind := methodBindNavigationMeshSetDetailSampleDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -749,8 +805,8 @@ func (o *NavigationMesh) SetDetailSampleDistance(detailSampleDist gdnative.Real)
 }
 
 /*
-        Undocumented
-	Args: [{ false detail_sample_max_error float}], Returns: void
+	        Undocumented
+		Args: [{ false detail_sample_max_error float}], Returns: void
 */
 func (o *NavigationMesh) SetDetailSampleMaxError(detailSampleMaxError gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetDetailSampleMaxError()")
@@ -760,7 +816,7 @@ func (o *NavigationMesh) SetDetailSampleMaxError(detailSampleMaxError gdnative.R
 	ptrArguments[0] = gdnative.NewPointerFromReal(detailSampleMaxError)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_detail_sample_max_error")
+	methodBind := methodBindNavigationMeshSetDetailSampleMaxError.Get()
 
 	// Call the parent method.
 	// void
@@ -770,8 +826,8 @@ func (o *NavigationMesh) SetDetailSampleMaxError(detailSampleMaxError gdnative.R
 }
 
 /*
-        Undocumented
-	Args: [{ false edge_max_error float}], Returns: void
+	        Undocumented
+		Args: [{ false edge_max_error float}], Returns: void
 */
 func (o *NavigationMesh) SetEdgeMaxError(edgeMaxError gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetEdgeMaxError()")
@@ -781,7 +837,7 @@ func (o *NavigationMesh) SetEdgeMaxError(edgeMaxError gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(edgeMaxError)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_edge_max_error")
+	methodBind := methodBindNavigationMeshSetEdgeMaxError.Get()
 
 	// Call the parent method.
 	// void
@@ -791,8 +847,8 @@ func (o *NavigationMesh) SetEdgeMaxError(edgeMaxError gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false edge_max_length float}], Returns: void
+	        Undocumented
+		Args: [{ false edge_max_length float}], Returns: void
 */
 func (o *NavigationMesh) SetEdgeMaxLength(edgeMaxLength gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetEdgeMaxLength()")
@@ -802,7 +858,7 @@ func (o *NavigationMesh) SetEdgeMaxLength(edgeMaxLength gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(edgeMaxLength)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_edge_max_length")
+	methodBind := methodBindNavigationMeshSetEdgeMaxLength.Get()
 
 	// Call the parent method.
 	// void
@@ -812,8 +868,8 @@ func (o *NavigationMesh) SetEdgeMaxLength(edgeMaxLength gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false filter_ledge_spans bool}], Returns: void
+	        Undocumented
+		Args: [{ false filter_ledge_spans bool}], Returns: void
 */
 func (o *NavigationMesh) SetFilterLedgeSpans(filterLedgeSpans gdnative.Bool) {
 	//log.Println("Calling NavigationMesh.SetFilterLedgeSpans()")
@@ -823,7 +879,7 @@ func (o *NavigationMesh) SetFilterLedgeSpans(filterLedgeSpans gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(filterLedgeSpans)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_filter_ledge_spans")
+	methodBind := methodBindNavigationMeshSetFilterLedgeSpans.Get()
 
 	// Call the parent method.
 	// void
@@ -833,8 +889,8 @@ func (o *NavigationMesh) SetFilterLedgeSpans(filterLedgeSpans gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false filter_low_hanging_obstacles bool}], Returns: void
+	        Undocumented
+		Args: [{ false filter_low_hanging_obstacles bool}], Returns: void
 */
 func (o *NavigationMesh) SetFilterLowHangingObstacles(filterLowHangingObstacles gdnative.Bool) {
 	//log.Println("Calling NavigationMesh.SetFilterLowHangingObstacles()")
@@ -844,7 +900,7 @@ func (o *NavigationMesh) SetFilterLowHangingObstacles(filterLowHangingObstacles
 	ptrArguments[0] = gdnative.NewPointerFromBool(filterLowHangingObstacles)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_filter_low_hanging_obstacles")
+	methodBind := methodBindNavigationMeshSetFilterLowHangingObstacles.Get()
 
 	// Call the parent method.
 	// void
@@ -854,8 +910,8 @@ func (o *NavigationMesh) SetFilterLowHangingObstacles(filterLowHangingObstacles
 }
 
 /*
-        Undocumented
-	Args: [{ false filter_walkable_low_height_spans bool}], Returns: void
+	        Undocumented
+		Args: [{ false filter_walkable_low_height_spans bool}], Returns: void
 */
 func (o *NavigationMesh) SetFilterWalkableLowHeightSpans(filterWalkableLowHeightSpans gdnative.Bool) {
 	//log.Println("Calling NavigationMesh.SetFilterWalkableLowHeightSpans()")
@@ -865,7 +921,7 @@ func (o *NavigationMesh) SetFilterWalkableLowHeightSpans(filterWalkableLowHeight
 	ptrArguments[0] = gdnative.NewPointerFromBool(filterWalkableLowHeightSpans)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_filter_walkable_low_height_spans")
+	methodBind := methodBindNavigationMeshSetFilterWalkableLowHeightSpans.Get()
 
 	// Call the parent method.
 	// void
@@ -875,8 +931,8 @@ func (o *NavigationMesh) SetFilterWalkableLowHeightSpans(filterWalkableLowHeight
 }
 
 /*
-        Undocumented
-	Args: [{ false region_merge_size float}], Returns: void
+	        Undocumented
+		Args: [{ false region_merge_size float}], Returns: void
 */
 func (o *NavigationMesh) SetRegionMergeSize(regionMergeSize gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetRegionMergeSize()")
@@ -886,7 +942,7 @@ func (o *NavigationMesh) SetRegionMergeSize(regionMergeSize gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(regionMergeSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_region_merge_size")
+	methodBind := methodBindNavigationMeshSetRegionMergeSize.Get()
 
 	// Call the parent method.
 	// void
@@ -896,8 +952,8 @@ func (o *NavigationMesh) SetRegionMergeSize(regionMergeSize gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false region_min_size float}], Returns: void
+	        Undocumented
+		Args: [{ false region_min_size float}], Returns: void
 */
 func (o *NavigationMesh) SetRegionMinSize(regionMinSize gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetRegionMinSize()")
@@ -907,7 +963,7 @@ func (o *NavigationMesh) SetRegionMinSize(regionMinSize gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(regionMinSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_region_min_size")
+	methodBind := methodBindNavigationMeshSetRegionMinSize.Get()
 
 	// Call the parent method.
 	// void
@@ -917,8 +973,8 @@ func (o *NavigationMesh) SetRegionMinSize(regionMinSize gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false sample_partition_type int}], Returns: void
+	        Undocumented
+		Args: [{ false sample_partition_type int}], Returns: void
 */
 func (o *NavigationMesh) SetSamplePartitionType(samplePartitionType gdnative.Int) {
 	//log.Println("Calling NavigationMesh.SetSamplePartitionType()")
@@ -928,7 +984,7 @@ func (o *NavigationMesh) SetSamplePartitionType(samplePartitionType gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromInt(samplePartitionType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_sample_partition_type")
+	methodBind := methodBindNavigationMeshSetSamplePartitionType.Get()
 
 	// Call the parent method.
 	// void
@@ -938,8 +994,7 @@ func (o *NavigationMesh) SetSamplePartitionType(samplePartitionType gdnative.Int
 }
 
 /*
-
-	Args: [{ false vertices PoolVector3Array}], Returns: void
+Args: [{ false vertices PoolVector3Array}], Returns: void
 */
 func (o *NavigationMesh) SetVertices(vertices gdnative.PoolVector3Array) {
 	//log.Println("Calling NavigationMesh.SetVertices()")
@@ -949,7 +1004,7 @@ func (o *NavigationMesh) SetVertices(vertices gdnative.PoolVector3Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector3Array(vertices)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_vertices")
+	methodBind := methodBindNavigationMeshSetVertices.Get()
 
 	// Call the parent method.
 	// void
@@ -959,8 +1014,8 @@ func (o *NavigationMesh) SetVertices(vertices gdnative.PoolVector3Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false verts_per_poly float}], Returns: void
+	        Undocumented
+		Args: [{ false verts_per_poly float}], Returns: void
 */
 func (o *NavigationMesh) SetVertsPerPoly(vertsPerPoly gdnative.Real) {
 	//log.Println("Calling NavigationMesh.SetVertsPerPoly()")
@@ -970,7 +1025,7 @@ func (o *NavigationMesh) SetVertsPerPoly(vertsPerPoly gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(vertsPerPoly)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationMesh", "set_verts_per_poly")
+	methodBind := methodBindNavigationMeshSetVertsPerPoly.Get()
 
 	// Call the parent method.
 	// void
@@ -979,6 +1034,121 @@ func (o *NavigationMesh) SetVertsPerPoly(vertsPerPoly gdnative.Real) {
 
 }
 
+// AgentHeight will return the value of the "agent/height" property.
+func (o *NavigationMesh) AgentHeight() gdnative.Real {
+	return o.GetAgentHeight()
+}
+
+// AgentMaxClimb will return the value of the "agent/max_climb" property.
+func (o *NavigationMesh) AgentMaxClimb() gdnative.Real {
+	return o.GetAgentMaxClimb()
+}
+
+// AgentMaxSlope will return the value of the "agent/max_slope" property.
+func (o *NavigationMesh) AgentMaxSlope() gdnative.Real {
+	return o.GetAgentMaxSlope()
+}
+
+// AgentRadius will return the value of the "agent/radius" property.
+func (o *NavigationMesh) AgentRadius() gdnative.Real {
+	return o.GetAgentRadius()
+}
+
+// CellHeight will return the value of the "cell/height" property.
+func (o *NavigationMesh) CellHeight() gdnative.Real {
+	return o.GetCellHeight()
+}
+
+// CellSize will return the value of the "cell/size" property.
+func (o *NavigationMesh) CellSize() gdnative.Real {
+	return o.GetCellSize()
+}
+
+// DetailSampleDistance will return the value of the "detail/sample_distance" property.
+func (o *NavigationMesh) DetailSampleDistance() gdnative.Real {
+	return o.GetDetailSampleDistance()
+}
+
+// DetailSampleMaxError will return the value of the "detail/sample_max_error" property.
+func (o *NavigationMesh) DetailSampleMaxError() gdnative.Real {
+	return o.GetDetailSampleMaxError()
+}
+
+// EdgeMaxError will return the value of the "edge/max_error" property.
+func (o *NavigationMesh) EdgeMaxError() gdnative.Real {
+	return o.GetEdgeMaxError()
+}
+
+// EdgeMaxLength will return the value of the "edge/max_length" property.
+func (o *NavigationMesh) EdgeMaxLength() gdnative.Real {
+	return o.GetEdgeMaxLength()
+}
+
+// FilterFilterWalkableLowHeightSpans will return the value of the "filter/filter_walkable_low_height_spans" property.
+func (o *NavigationMesh) FilterFilterWalkableLowHeightSpans() gdnative.Bool {
+	return o.GetFilterWalkableLowHeightSpans()
+}
+
+// SetFilterFilterWalkableLowHeightSpans will set the value of the "filter/filter_walkable_low_height_spans" property.
+func (o *NavigationMesh) SetFilterFilterWalkableLowHeightSpans(value gdnative.Bool) {
+	o.SetFilterWalkableLowHeightSpans(value)
+}
+
+// FilterLedgeSpans will return the value of the "filter/ledge_spans" property.
+func (o *NavigationMesh) FilterLedgeSpans() gdnative.Bool {
+	return o.GetFilterLedgeSpans()
+}
+
+// FilterLowHangingObstacles will return the value of the "filter/low_hanging_obstacles" property.
+func (o *NavigationMesh) FilterLowHangingObstacles() gdnative.Bool {
+	return o.GetFilterLowHangingObstacles()
+}
+
+// PolygonVertsPerPoly will return the value of the "polygon/verts_per_poly" property.
+func (o *NavigationMesh) PolygonVertsPerPoly() gdnative.Real {
+	return o.GetVertsPerPoly()
+}
+
+// SetPolygonVertsPerPoly will set the value of the "polygon/verts_per_poly" property.
+func (o *NavigationMesh) SetPolygonVertsPerPoly(value gdnative.Real) {
+	o.SetVertsPerPoly(value)
+}
+
+// Polygons will return the value of the "polygons" property.
+func (o *NavigationMesh) Polygons() gdnative.Array {
+	return o.X_GetPolygons()
+}
+
+// SetPolygons will set the value of the "polygons" property.
+func (o *NavigationMesh) SetPolygons(value gdnative.Array) {
+	o.X_SetPolygons(value)
+}
+
+// RegionMergeSize will return the value of the "region/merge_size" property.
+func (o *NavigationMesh) RegionMergeSize() gdnative.Real {
+	return o.GetRegionMergeSize()
+}
+
+// RegionMinSize will return the value of the "region/min_size" property.
+func (o *NavigationMesh) RegionMinSize() gdnative.Real {
+	return o.GetRegionMinSize()
+}
+
+// SamplePartitionTypeSamplePartitionType will return the value of the "sample_partition_type/sample_partition_type" property.
+func (o *NavigationMesh) SamplePartitionTypeSamplePartitionType() gdnative.Int {
+	return o.GetSamplePartitionType()
+}
+
+// SetSamplePartitionTypeSamplePartitionType will set the value of the "sample_partition_type/sample_partition_type" property.
+func (o *NavigationMesh) SetSamplePartitionTypeSamplePartitionType(value gdnative.Int) {
+	o.SetSamplePartitionType(value)
+}
+
+// Vertices will return the value of the "vertices" property.
+func (o *NavigationMesh) Vertices() gdnative.PoolVector3Array {
+	return o.GetVertices()
+}
+
 // NavigationMeshImplementer is an interface that implements the methods
 // of the NavigationMesh class.
 type NavigationMeshImplementer interface {
@@ -1026,4 +1196,27 @@ type NavigationMeshImplementer interface {
 	SetSamplePartitionType(samplePartitionType gdnative.Int)
 	SetVertices(vertices gdnative.PoolVector3Array)
 	SetVertsPerPoly(vertsPerPoly gdnative.Real)
+	AgentHeight() gdnative.Real
+	AgentMaxClimb() gdnative.Real
+	AgentMaxSlope() gdnative.Real
+	AgentRadius() gdnative.Real
+	CellHeight() gdnative.Real
+	CellSize() gdnative.Real
+	DetailSampleDistance() gdnative.Real
+	DetailSampleMaxError() gdnative.Real
+	EdgeMaxError() gdnative.Real
+	EdgeMaxLength() gdnative.Real
+	FilterFilterWalkableLowHeightSpans() gdnative.Bool
+	SetFilterFilterWalkableLowHeightSpans(value gdnative.Bool)
+	FilterLedgeSpans() gdnative.Bool
+	FilterLowHangingObstacles() gdnative.Bool
+	PolygonVertsPerPoly() gdnative.Real
+	SetPolygonVertsPerPoly(value gdnative.Real)
+	Polygons() gdnative.Array
+	SetPolygons(value gdnative.Array)
+	RegionMergeSize() gdnative.Real
+	RegionMinSize() gdnative.Real
+	SamplePartitionTypeSamplePartitionType() gdnative.Int
+	SetSamplePartitionTypeSamplePartitionType(value gdnative.Int)
+	Vertices() gdnative.PoolVector3Array
 }
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,6 +24,32 @@ const (
 	NetworkedMultiplayerPeerConnectionDisconnected NetworkedMultiplayerPeerConnectionStatus = 0
 )
 
+// String will return the Godot name of the NetworkedMultiplayerPeerConnectionStatus value.
+func (e NetworkedMultiplayerPeerConnectionStatus) String() string {
+	switch e {
+	case NetworkedMultiplayerPeerConnectionConnected:
+		return "CONNECTION_CONNECTED"
+	case NetworkedMultiplayerPeerConnectionConnecting:
+		return "CONNECTION_CONNECTING"
+	case NetworkedMultiplayerPeerConnectionDisconnected:
+		return "CONNECTION_DISCONNECTED"
+	}
+	return fmt.Sprintf("NetworkedMultiplayerPeerConnectionStatus(%d)", int(e))
+}
+
+// ParseNetworkedMultiplayerPeerConnectionStatus will return the NetworkedMultiplayerPeerConnectionStatus value with the given Godot name.
+func ParseNetworkedMultiplayerPeerConnectionStatus(name string) (NetworkedMultiplayerPeerConnectionStatus, error) {
+	switch name {
+	case "CONNECTION_CONNECTED":
+		return NetworkedMultiplayerPeerConnectionConnected, nil
+	case "CONNECTION_CONNECTING":
+		return NetworkedMultiplayerPeerConnectionConnecting, nil
+	case "CONNECTION_DISCONNECTED":
+		return NetworkedMultiplayerPeerConnectionDisconnected, nil
+	}
+	return 0, fmt.Errorf("invalid NetworkedMultiplayerPeerConnectionStatus value %q", name)
+}
+
 // NetworkedMultiplayerPeerTransferMode is an enum for TransferMode values.
 type NetworkedMultiplayerPeerTransferMode int
 
@@ -31,15 +59,38 @@ const (
 	NetworkedMultiplayerPeerTransferModeUnreliableOrdered NetworkedMultiplayerPeerTransferMode = 1
 )
 
-//func NewNetworkedMultiplayerPeerFromPointer(ptr gdnative.Pointer) NetworkedMultiplayerPeer {
-func newNetworkedMultiplayerPeerFromPointer(ptr gdnative.Pointer) NetworkedMultiplayerPeer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := NetworkedMultiplayerPeer{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the NetworkedMultiplayerPeerTransferMode value.
+func (e NetworkedMultiplayerPeerTransferMode) String() string {
+	switch e {
+	case NetworkedMultiplayerPeerTransferModeReliable:
+		return "TRANSFER_MODE_RELIABLE"
+	case NetworkedMultiplayerPeerTransferModeUnreliable:
+		return "TRANSFER_MODE_UNRELIABLE"
+	case NetworkedMultiplayerPeerTransferModeUnreliableOrdered:
+		return "TRANSFER_MODE_UNRELIABLE_ORDERED"
+	}
+	return fmt.Sprintf("NetworkedMultiplayerPeerTransferMode(%d)", int(e))
+}
 
-	return obj
+// ParseNetworkedMultiplayerPeerTransferMode will return the NetworkedMultiplayerPeerTransferMode value with the given Godot name.
+func ParseNetworkedMultiplayerPeerTransferMode(name string) (NetworkedMultiplayerPeerTransferMode, error) {
+	switch name {
+	case "TRANSFER_MODE_RELIABLE":
+		return NetworkedMultiplayerPeerTransferModeReliable, nil
+	case "TRANSFER_MODE_UNRELIABLE":
+		return NetworkedMultiplayerPeerTransferModeUnreliable, nil
+	case "TRANSFER_MODE_UNRELIABLE_ORDERED":
+		return NetworkedMultiplayerPeerTransferModeUnreliableOrdered, nil
+	}
+	return 0, fmt.Errorf("invalid NetworkedMultiplayerPeerTransferMode value %q", name)
 }
 
+// Constants of the NetworkedMultiplayerPeer class.
+const (
+	NetworkedMultiplayerPeerTargetPeerBroadcast gdnative.Int = 0
+	NetworkedMultiplayerPeerTargetPeerServer    gdnative.Int = 1
+)
+
 /*
 Manages the connection to network peers. Assigns unique IDs to each client connected to the server.
 */
@@ -52,9 +103,23 @@ func (o *NetworkedMultiplayerPeer) BaseClass() string {
 	return "NetworkedMultiplayerPeer"
 }
 
+// Method binds of the NetworkedMultiplayerPeer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNetworkedMultiplayerPeerGetConnectionStatus      = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "get_connection_status")
+	methodBindNetworkedMultiplayerPeerGetPacketPeer            = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "get_packet_peer")
+	methodBindNetworkedMultiplayerPeerGetTransferMode          = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "get_transfer_mode")
+	methodBindNetworkedMultiplayerPeerGetUniqueId              = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "get_unique_id")
+	methodBindNetworkedMultiplayerPeerIsRefusingNewConnections = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "is_refusing_new_connections")
+	methodBindNetworkedMultiplayerPeerPoll                     = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "poll")
+	methodBindNetworkedMultiplayerPeerSetRefuseNewConnections  = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "set_refuse_new_connections")
+	methodBindNetworkedMultiplayerPeerSetTargetPeer            = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "set_target_peer")
+	methodBindNetworkedMultiplayerPeerSetTransferMode          = gdnative.NewLazyMethodBind("NetworkedMultiplayerPeer", "set_transfer_mode")
+)
+
 /*
-        Returns the current state of the connection. See [enum ConnectionStatus].
-	Args: [], Returns: enum.NetworkedMultiplayerPeer::ConnectionStatus
+	        Returns the current state of the connection. See [enum ConnectionStatus].
+		Args: [], Returns: enum.NetworkedMultiplayerPeer::ConnectionStatus
 */
 func (o *NetworkedMultiplayerPeer) GetConnectionStatus() NetworkedMultiplayerPeerConnectionStatus {
 	//log.Println("Calling NetworkedMultiplayerPeer.GetConnectionStatus()")
@@ -63,7 +128,7 @@ func (o *NetworkedMultiplayerPeer) GetConnectionStatus() NetworkedMultiplayerPee
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "get_connection_status")
+	methodBind := methodBindNetworkedMultiplayerPeerGetConnectionStatus.Get()
 
 	// Call the parent method.
 	// enum.NetworkedMultiplayerPeer::ConnectionStatus
@@ -76,8 +141,8 @@ func (o *NetworkedMultiplayerPeer) GetConnectionStatus() NetworkedMultiplayerPee
 }
 
 /*
-        Returns the ID of the [code]NetworkedMultiplayerPeer[/code] who sent the most recent packet.
-	Args: [], Returns: int
+	        Returns the ID of the [code]NetworkedMultiplayerPeer[/code] who sent the most recent packet.
+		Args: [], Returns: int
 */
 func (o *NetworkedMultiplayerPeer) GetPacketPeer() gdnative.Int {
 	//log.Println("Calling NetworkedMultiplayerPeer.GetPacketPeer()")
@@ -86,7 +151,7 @@ func (o *NetworkedMultiplayerPeer) GetPacketPeer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "get_packet_peer")
+	methodBind := methodBindNetworkedMultiplayerPeerGetPacketPeer.Get()
 
 	// Call the parent method.
 	// int
@@ -99,8 +164,8 @@ func (o *NetworkedMultiplayerPeer) GetPacketPeer() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.NetworkedMultiplayerPeer::TransferMode
+	        Undocumented
+		Args: [], Returns: enum.NetworkedMultiplayerPeer::TransferMode
 */
 func (o *NetworkedMultiplayerPeer) GetTransferMode() NetworkedMultiplayerPeerTransferMode {
 	//log.Println("Calling NetworkedMultiplayerPeer.GetTransferMode()")
@@ -109,7 +174,7 @@ func (o *NetworkedMultiplayerPeer) GetTransferMode() NetworkedMultiplayerPeerTra
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "get_transfer_mode")
+	methodBind := methodBindNetworkedMultiplayerPeerGetTransferMode.Get()
 
 	// Call the parent method.
 	// enum.NetworkedMultiplayerPeer::TransferMode
@@ -122,8 +187,8 @@ func (o *NetworkedMultiplayerPeer) GetTransferMode() NetworkedMultiplayerPeerTra
 }
 
 /*
-        Returns the ID of this [code]NetworkedMultiplayerPeer[/code].
-	Args: [], Returns: int
+	        Returns the ID of this [code]NetworkedMultiplayerPeer[/code].
+		Args: [], Returns: int
 */
 func (o *NetworkedMultiplayerPeer) GetUniqueId() gdnative.Int {
 	//log.Println("Calling NetworkedMultiplayerPeer.GetUniqueId()")
@@ -132,7 +197,7 @@ func (o *NetworkedMultiplayerPeer) GetUniqueId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "get_unique_id")
+	methodBind := methodBindNetworkedMultiplayerPeerGetUniqueId.Get()
 
 	// Call the parent method.
 	// int
@@ -145,8 +210,8 @@ func (o *NetworkedMultiplayerPeer) GetUniqueId() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *NetworkedMultiplayerPeer) IsRefusingNewConnections() gdnative.Bool {
 	//log.Println("Calling NetworkedMultiplayerPeer.IsRefusingNewConnections()")
@@ -155,7 +220,7 @@ func (o *NetworkedMultiplayerPeer) IsRefusingNewConnections() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "is_refusing_new_connections")
+	methodBind := methodBindNetworkedMultiplayerPeerIsRefusingNewConnections.Get()
 
 	// Call the parent method.
 	// bool
@@ -168,8 +233,8 @@ func (o *NetworkedMultiplayerPeer) IsRefusingNewConnections() gdnative.Bool {
 }
 
 /*
-        Waits up to 1 second to receive a new network event.
-	Args: [], Returns: void
+	        Waits up to 1 second to receive a new network event.
+		Args: [], Returns: void
 */
 func (o *NetworkedMultiplayerPeer) Poll() {
 	//log.Println("Calling NetworkedMultiplayerPeer.Poll()")
@@ -178,7 +243,7 @@ func (o *NetworkedMultiplayerPeer) Poll() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "poll")
+	methodBind := methodBindNetworkedMultiplayerPeerPoll.Get()
 
 	// Call the parent method.
 	// void
@@ -188,8 +253,8 @@ func (o *NetworkedMultiplayerPeer) Poll() {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *NetworkedMultiplayerPeer) SetRefuseNewConnections(enable gdnative.Bool) {
 	//log.Println("Calling NetworkedMultiplayerPeer.SetRefuseNewConnections()")
@@ -199,7 +264,7 @@ func (o *NetworkedMultiplayerPeer) SetRefuseNewConnections(enable gdnative.Bool)
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "set_refuse_new_connections")
+	methodBind := methodBindNetworkedMultiplayerPeerSetRefuseNewConnections.Get()
 
 	// Call the parent method.
 	// void
@@ -209,8 +274,8 @@ func (o *NetworkedMultiplayerPeer) SetRefuseNewConnections(enable gdnative.Bool)
 }
 
 /*
-        The peer to which packets will be sent. Default value: [code]0[/code].
-	Args: [{ false id int}], Returns: void
+	        The peer to which packets will be sent. Default value: [code]0[/code].
+		Args: [{ false id int}], Returns: void
 */
 func (o *NetworkedMultiplayerPeer) SetTargetPeer(id gdnative.Int) {
 	//log.Println("Calling NetworkedMultiplayerPeer.SetTargetPeer()")
@@ -220,7 +285,7 @@ func (o *NetworkedMultiplayerPeer) SetTargetPeer(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "set_target_peer")
+	methodBind := methodBindNetworkedMultiplayerPeerSetTargetPeer.Get()
 
 	// Call the parent method.
 	// void
@@ -230,18 +295,18 @@ func (o *NetworkedMultiplayerPeer) SetTargetPeer(id gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.NetworkedMultiplayerPeer::TransferMode}], Returns: void
 */
-func (o *NetworkedMultiplayerPeer) SetTransferMode(mode gdnative.Int) {
+func (o *NetworkedMultiplayerPeer) SetTransferMode(mode NetworkedMultiplayerPeerTransferMode) {
 	//log.Println("Calling NetworkedMultiplayerPeer.SetTransferMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NetworkedMultiplayerPeer", "set_transfer_mode")
+	methodBind := methodBindNetworkedMultiplayerPeerSetTransferMode.Get()
 
 	// Call the parent method.
 	// void
@@ -250,15 +315,142 @@ func (o *NetworkedMultiplayerPeer) SetTransferMode(mode gdnative.Int) {
 
 }
 
+// RefuseNewConnections will return the value of the "refuse_new_connections" property.
+func (o *NetworkedMultiplayerPeer) RefuseNewConnections() gdnative.Bool {
+	return o.IsRefusingNewConnections()
+}
+
+// TransferMode will return the value of the "transfer_mode" property.
+func (o *NetworkedMultiplayerPeer) TransferMode() NetworkedMultiplayerPeerTransferMode {
+	return o.GetTransferMode()
+}
+
+// Signals of the NetworkedMultiplayerPeer class.
+const (
+	NetworkedMultiplayerPeerSignalConnectionFailed    gdnative.String = "connection_failed"
+	NetworkedMultiplayerPeerSignalConnectionSucceeded gdnative.String = "connection_succeeded"
+	NetworkedMultiplayerPeerSignalPeerConnected       gdnative.String = "peer_connected"
+	NetworkedMultiplayerPeerSignalPeerDisconnected    gdnative.String = "peer_disconnected"
+	NetworkedMultiplayerPeerSignalServerDisconnected  gdnative.String = "server_disconnected"
+)
+
+// ConnectConnectionFailed will connect the "connection_failed" signal to the given method of the target object.
+func (o *NetworkedMultiplayerPeer) ConnectConnectionFailed(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NetworkedMultiplayerPeerSignalConnectionFailed, target, method, binds, 0)
+}
+
+// EmitConnectionFailed will emit the "connection_failed" signal.
+func (o *NetworkedMultiplayerPeer) EmitConnectionFailed() error {
+
+	ret, err := o.EmitSignal(NetworkedMultiplayerPeerSignalConnectionFailed)
+	ret.Destroy()
+	return err
+}
+
+// ConnectConnectionSucceeded will connect the "connection_succeeded" signal to the given method of the target object.
+func (o *NetworkedMultiplayerPeer) ConnectConnectionSucceeded(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NetworkedMultiplayerPeerSignalConnectionSucceeded, target, method, binds, 0)
+}
+
+// EmitConnectionSucceeded will emit the "connection_succeeded" signal.
+func (o *NetworkedMultiplayerPeer) EmitConnectionSucceeded() error {
+
+	ret, err := o.EmitSignal(NetworkedMultiplayerPeerSignalConnectionSucceeded)
+	ret.Destroy()
+	return err
+}
+
+// NetworkedMultiplayerPeerPeerConnectedArgs holds the arguments of the "peer_connected" signal.
+type NetworkedMultiplayerPeerPeerConnectedArgs struct {
+	Id gdnative.Int
+}
+
+// ConnectPeerConnected will connect the "peer_connected" signal to the given method of the target object.
+func (o *NetworkedMultiplayerPeer) ConnectPeerConnected(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NetworkedMultiplayerPeerSignalPeerConnected, target, method, binds, 0)
+}
+
+// EmitPeerConnected will emit the "peer_connected" signal.
+func (o *NetworkedMultiplayerPeer) EmitPeerConnected(args NetworkedMultiplayerPeerPeerConnectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(NetworkedMultiplayerPeerSignalPeerConnected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// NetworkedMultiplayerPeerPeerDisconnectedArgs holds the arguments of the "peer_disconnected" signal.
+type NetworkedMultiplayerPeerPeerDisconnectedArgs struct {
+	Id gdnative.Int
+}
+
+// ConnectPeerDisconnected will connect the "peer_disconnected" signal to the given method of the target object.
+func (o *NetworkedMultiplayerPeer) ConnectPeerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NetworkedMultiplayerPeerSignalPeerDisconnected, target, method, binds, 0)
+}
+
+// EmitPeerDisconnected will emit the "peer_disconnected" signal.
+func (o *NetworkedMultiplayerPeer) EmitPeerDisconnected(args NetworkedMultiplayerPeerPeerDisconnectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(NetworkedMultiplayerPeerSignalPeerDisconnected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectServerDisconnected will connect the "server_disconnected" signal to the given method of the target object.
+func (o *NetworkedMultiplayerPeer) ConnectServerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NetworkedMultiplayerPeerSignalServerDisconnected, target, method, binds, 0)
+}
+
+// EmitServerDisconnected will emit the "server_disconnected" signal.
+func (o *NetworkedMultiplayerPeer) EmitServerDisconnected() error {
+
+	ret, err := o.EmitSignal(NetworkedMultiplayerPeerSignalServerDisconnected)
+	ret.Destroy()
+	return err
+}
+
 // NetworkedMultiplayerPeerImplementer is an interface that implements the methods
 // of the NetworkedMultiplayerPeer class.
 type NetworkedMultiplayerPeerImplementer interface {
 	PacketPeerImplementer
+	GetConnectionStatus() NetworkedMultiplayerPeerConnectionStatus
 	GetPacketPeer() gdnative.Int
+	GetTransferMode() NetworkedMultiplayerPeerTransferMode
 	GetUniqueId() gdnative.Int
 	IsRefusingNewConnections() gdnative.Bool
 	Poll()
 	SetRefuseNewConnections(enable gdnative.Bool)
 	SetTargetPeer(id gdnative.Int)
-	SetTransferMode(mode gdnative.Int)
+	SetTransferMode(mode NetworkedMultiplayerPeerTransferMode)
+	RefuseNewConnections() gdnative.Bool
+	TransferMode() NetworkedMultiplayerPeerTransferMode
+	ConnectConnectionFailed(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitConnectionFailed() error
+	ConnectConnectionSucceeded(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitConnectionSucceeded() error
+	ConnectPeerConnected(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPeerConnected(args NetworkedMultiplayerPeerPeerConnectedArgs) error
+	ConnectPeerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPeerDisconnected(args NetworkedMultiplayerPeerPeerDisconnectedArgs) error
+	ConnectServerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitServerDisconnected() error
 }
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -23,6 +25,36 @@ const (
 	NodeDuplicateUseInstancing NodeDuplicateFlags = 8
 )
 
+// String will return the Godot name of the NodeDuplicateFlags value.
+func (e NodeDuplicateFlags) String() string {
+	switch e {
+	case NodeDuplicateGroups:
+		return "DUPLICATE_GROUPS"
+	case NodeDuplicateScripts:
+		return "DUPLICATE_SCRIPTS"
+	case NodeDuplicateSignals:
+		return "DUPLICATE_SIGNALS"
+	case NodeDuplicateUseInstancing:
+		return "DUPLICATE_USE_INSTANCING"
+	}
+	return fmt.Sprintf("NodeDuplicateFlags(%d)", int(e))
+}
+
+// ParseNodeDuplicateFlags will return the NodeDuplicateFlags value with the given Godot name.
+func ParseNodeDuplicateFlags(name string) (NodeDuplicateFlags, error) {
+	switch name {
+	case "DUPLICATE_GROUPS":
+		return NodeDuplicateGroups, nil
+	case "DUPLICATE_SCRIPTS":
+		return NodeDuplicateScripts, nil
+	case "DUPLICATE_SIGNALS":
+		return NodeDuplicateSignals, nil
+	case "DUPLICATE_USE_INSTANCING":
+		return NodeDuplicateUseInstancing, nil
+	}
+	return 0, fmt.Errorf("invalid NodeDuplicateFlags value %q", name)
+}
+
 // NodePauseMode is an enum for PauseMode values.
 type NodePauseMode int
 
@@ -32,6 +64,32 @@ const (
 	NodePauseModeStop    NodePauseMode = 1
 )
 
+// String will return the Godot name of the NodePauseMode value.
+func (e NodePauseMode) String() string {
+	switch e {
+	case NodePauseModeInherit:
+		return "PAUSE_MODE_INHERIT"
+	case NodePauseModeProcess:
+		return "PAUSE_MODE_PROCESS"
+	case NodePauseModeStop:
+		return "PAUSE_MODE_STOP"
+	}
+	return fmt.Sprintf("NodePauseMode(%d)", int(e))
+}
+
+// ParseNodePauseMode will return the NodePauseMode value with the given Godot name.
+func ParseNodePauseMode(name string) (NodePauseMode, error) {
+	switch name {
+	case "PAUSE_MODE_INHERIT":
+		return NodePauseModeInherit, nil
+	case "PAUSE_MODE_PROCESS":
+		return NodePauseModeProcess, nil
+	case "PAUSE_MODE_STOP":
+		return NodePauseModeStop, nil
+	}
+	return 0, fmt.Errorf("invalid NodePauseMode value %q", name)
+}
+
 // NodeRPCMode is an enum for RPCMode values.
 type NodeRPCMode int
 
@@ -43,15 +101,61 @@ const (
 	NodeRpcModeSync     NodeRPCMode = 2
 )
 
-//func NewNodeFromPointer(ptr gdnative.Pointer) Node {
-func newNodeFromPointer(ptr gdnative.Pointer) Node {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Node{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the NodeRPCMode value.
+func (e NodeRPCMode) String() string {
+	switch e {
+	case NodeRpcModeDisabled:
+		return "RPC_MODE_DISABLED"
+	case NodeRpcModeMaster:
+		return "RPC_MODE_MASTER"
+	case NodeRpcModeRemote:
+		return "RPC_MODE_REMOTE"
+	case NodeRpcModeSlave:
+		return "RPC_MODE_SLAVE"
+	case NodeRpcModeSync:
+		return "RPC_MODE_SYNC"
+	}
+	return fmt.Sprintf("NodeRPCMode(%d)", int(e))
+}
+
+// ParseNodeRPCMode will return the NodeRPCMode value with the given Godot name.
+func ParseNodeRPCMode(name string) (NodeRPCMode, error) {
+	switch name {
+	case "RPC_MODE_DISABLED":
+		return NodeRpcModeDisabled, nil
+	case "RPC_MODE_MASTER":
+		return NodeRpcModeMaster, nil
+	case "RPC_MODE_REMOTE":
+		return NodeRpcModeRemote, nil
+	case "RPC_MODE_SLAVE":
+		return NodeRpcModeSlave, nil
+	case "RPC_MODE_SYNC":
+		return NodeRpcModeSync, nil
+	}
+	return 0, fmt.Errorf("invalid NodeRPCMode value %q", name)
 }
 
+// Constants of the Node class.
+const (
+	NodeNotificationDragBegin              gdnative.Int = 21
+	NodeNotificationDragEnd                gdnative.Int = 22
+	NodeNotificationEnterTree              gdnative.Int = 10
+	NodeNotificationExitTree               gdnative.Int = 11
+	NodeNotificationInstanced              gdnative.Int = 20
+	NodeNotificationInternalPhysicsProcess gdnative.Int = 26
+	NodeNotificationInternalProcess        gdnative.Int = 25
+	NodeNotificationMovedInParent          gdnative.Int = 12
+	NodeNotificationParented               gdnative.Int = 18
+	NodeNotificationPathChanged            gdnative.Int = 23
+	NodeNotificationPaused                 gdnative.Int = 14
+	NodeNotificationPhysicsProcess         gdnative.Int = 16
+	NodeNotificationProcess                gdnative.Int = 17
+	NodeNotificationReady                  gdnative.Int = 13
+	NodeNotificationTranslationChanged     gdnative.Int = 24
+	NodeNotificationUnparented             gdnative.Int = 19
+	NodeNotificationUnpaused               gdnative.Int = 15
+)
+
 /*
 Nodes are Godot's building blocks. They can be assigned as the child of another node, resulting in a tree arrangement. A given node can contain any number of nodes as children with the requirement that all siblings (direct children of a node) should have unique names. A tree of nodes is called a [i]scene[/i]. Scenes can be saved to the disk and then instanced into other scenes. This allows for very high flexibility in the architecture and data model of Godot projects. Nodes can also optionally be added to groups. This makes it possible to access a number of nodes from code (an "enemies" group, for example) to perform grouped actions. [b]Scene tree:[/b] The [SceneTree] contains the active tree of nodes. When a node is added to the scene tree, it receives the NOTIFICATION_ENTER_TREE notification and its [method _enter_tree] callback is triggered. Child nodes are always added [i]after[/i] their parent node, i.e. the [method _enter_tree] callback of a parent node will be triggered before its child's. Once all nodes have been added in the scene tree, they receive the NOTIFICATION_READY notification and their respective [method _ready] callbacks are triggered. For groups of nodes, the [method _ready] callback is called in reverse order, starting with the children and moving up to the parent nodes. This means that when adding a node to the scene tree, the following order will be used for the callbacks: [method _enter_tree] of the parent, [method _enter_tree] of the children, [method _ready] of the children and finally [method _ready] of the parent (recursively for the entire scene tree). [b]Processing:[/b] Nodes can override the "process" state, so that they receive a callback on each frame requesting them to process (do something). Normal processing (callback [method _process], toggled with [method set_process]) happens as fast as possible and is dependent on the frame rate, so the processing time [i]delta[/i] is passed as an argument. Physics processing (callback [method _physics_process], toggled with [method set_physics_process]) happens a fixed number of times per second (60 by default) and is useful for code related to the physics engine. Nodes can also process input events. When present, the [method _input] function will be called for each input that the program receives. In many cases, this can be overkill (unless used for simple projects), and the [method _unhandled_input] function might be preferred; it is called when the input event was not handled by anyone else (typically, GUI [Control] nodes), ensuring that the node only receives the events that were meant for it. To keep track of the scene hierarchy (especially when instancing scenes into other scenes), an "owner" can be set for the node with [method set_owner]. This keeps track of who instanced what. This is mostly useful when writing editors and tools, though. Finally, when a node is freed with [method free] or [method queue_free], it will also free all its children. [b]Groups:[/b] Nodes can be added to as many groups as you want to be easy to manage, you could create groups like "enemies" or "collectables" for example, depending on your game. See [method add_to_group], [method is_in_group] and [method remove_from_group]. You can then retrieve all nodes in these groups, iterate them and even call methods on groups via the methods on [SceneTree]. [b]Networking with nodes:[/b] After connecting to a server (or making one, see [NetworkedMultiplayerENet]) it is possible to use the built-in RPC (remote procedure call) system to communicate over the network. By calling [method rpc] with a method name, it will be called locally and in all connected peers (peers = clients and the server that accepts connections). To identify which node receives the RPC call Godot will use its [NodePath] (make sure node names are the same on all peers). Also take a look at the high-level networking tutorial and corresponding demos.
 */
@@ -60,13 +164,120 @@ type Node struct {
 	owner gdnative.Object
 }
 
+// classConstructorNode is the class constructor of the Node class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNode = gdnative.NewLazyClassConstructor("Node")
+
+// NewNode will create a new instance of the Node class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNode() *Node {
+	obj := &Node{}
+	obj.SetBaseObject(classConstructorNode.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Node) BaseClass() string {
 	return "Node"
 }
 
+// Method binds of the Node class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNodeX_EnterTree                     = gdnative.NewLazyMethodBind("Node", "_enter_tree")
+	methodBindNodeX_ExitTree                      = gdnative.NewLazyMethodBind("Node", "_exit_tree")
+	methodBindNodeX_GetImportPath                 = gdnative.NewLazyMethodBind("Node", "_get_import_path")
+	methodBindNodeX_Input                         = gdnative.NewLazyMethodBind("Node", "_input")
+	methodBindNodeX_PhysicsProcess                = gdnative.NewLazyMethodBind("Node", "_physics_process")
+	methodBindNodeX_Process                       = gdnative.NewLazyMethodBind("Node", "_process")
+	methodBindNodeX_Ready                         = gdnative.NewLazyMethodBind("Node", "_ready")
+	methodBindNodeX_SetImportPath                 = gdnative.NewLazyMethodBind("Node", "_set_import_path")
+	methodBindNodeX_UnhandledInput                = gdnative.NewLazyMethodBind("Node", "_unhandled_input")
+	methodBindNodeX_UnhandledKeyInput             = gdnative.NewLazyMethodBind("Node", "_unhandled_key_input")
+	methodBindNodeAddChild                        = gdnative.NewLazyMethodBind("Node", "add_child")
+	methodBindNodeAddChildBelowNode               = gdnative.NewLazyMethodBind("Node", "add_child_below_node")
+	methodBindNodeAddToGroup                      = gdnative.NewLazyMethodBind("Node", "add_to_group")
+	methodBindNodeCanProcess                      = gdnative.NewLazyMethodBind("Node", "can_process")
+	methodBindNodeDuplicate                       = gdnative.NewLazyMethodBind("Node", "duplicate")
+	methodBindNodeFindNode                        = gdnative.NewLazyMethodBind("Node", "find_node")
+	methodBindNodeGetChild                        = gdnative.NewLazyMethodBind("Node", "get_child")
+	methodBindNodeGetChildCount                   = gdnative.NewLazyMethodBind("Node", "get_child_count")
+	methodBindNodeGetChildren                     = gdnative.NewLazyMethodBind("Node", "get_children")
+	methodBindNodeGetFilename                     = gdnative.NewLazyMethodBind("Node", "get_filename")
+	methodBindNodeGetGroups                       = gdnative.NewLazyMethodBind("Node", "get_groups")
+	methodBindNodeGetIndex                        = gdnative.NewLazyMethodBind("Node", "get_index")
+	methodBindNodeGetName                         = gdnative.NewLazyMethodBind("Node", "get_name")
+	methodBindNodeGetNetworkMaster                = gdnative.NewLazyMethodBind("Node", "get_network_master")
+	methodBindNodeGetNode                         = gdnative.NewLazyMethodBind("Node", "get_node")
+	methodBindNodeGetNodeAndResource              = gdnative.NewLazyMethodBind("Node", "get_node_and_resource")
+	methodBindNodeGetOwner                        = gdnative.NewLazyMethodBind("Node", "get_owner")
+	methodBindNodeGetParent                       = gdnative.NewLazyMethodBind("Node", "get_parent")
+	methodBindNodeGetPath                         = gdnative.NewLazyMethodBind("Node", "get_path")
+	methodBindNodeGetPathTo                       = gdnative.NewLazyMethodBind("Node", "get_path_to")
+	methodBindNodeGetPauseMode                    = gdnative.NewLazyMethodBind("Node", "get_pause_mode")
+	methodBindNodeGetPhysicsProcessDeltaTime      = gdnative.NewLazyMethodBind("Node", "get_physics_process_delta_time")
+	methodBindNodeGetPositionInParent             = gdnative.NewLazyMethodBind("Node", "get_position_in_parent")
+	methodBindNodeGetProcessDeltaTime             = gdnative.NewLazyMethodBind("Node", "get_process_delta_time")
+	methodBindNodeGetSceneInstanceLoadPlaceholder = gdnative.NewLazyMethodBind("Node", "get_scene_instance_load_placeholder")
+	methodBindNodeGetTree                         = gdnative.NewLazyMethodBind("Node", "get_tree")
+	methodBindNodeGetViewport                     = gdnative.NewLazyMethodBind("Node", "get_viewport")
+	methodBindNodeHasNode                         = gdnative.NewLazyMethodBind("Node", "has_node")
+	methodBindNodeHasNodeAndResource              = gdnative.NewLazyMethodBind("Node", "has_node_and_resource")
+	methodBindNodeIsAParentOf                     = gdnative.NewLazyMethodBind("Node", "is_a_parent_of")
+	methodBindNodeIsDisplayedFolded               = gdnative.NewLazyMethodBind("Node", "is_displayed_folded")
+	methodBindNodeIsGreaterThan                   = gdnative.NewLazyMethodBind("Node", "is_greater_than")
+	methodBindNodeIsInGroup                       = gdnative.NewLazyMethodBind("Node", "is_in_group")
+	methodBindNodeIsInsideTree                    = gdnative.NewLazyMethodBind("Node", "is_inside_tree")
+	methodBindNodeIsNetworkMaster                 = gdnative.NewLazyMethodBind("Node", "is_network_master")
+	methodBindNodeIsPhysicsProcessing             = gdnative.NewLazyMethodBind("Node", "is_physics_processing")
+	methodBindNodeIsPhysicsProcessingInternal     = gdnative.NewLazyMethodBind("Node", "is_physics_processing_internal")
+	methodBindNodeIsProcessing                    = gdnative.NewLazyMethodBind("Node", "is_processing")
+	methodBindNodeIsProcessingInput               = gdnative.NewLazyMethodBind("Node", "is_processing_input")
+	methodBindNodeIsProcessingInternal            = gdnative.NewLazyMethodBind("Node", "is_processing_internal")
+	methodBindNodeIsProcessingUnhandledInput      = gdnative.NewLazyMethodBind("Node", "is_processing_unhandled_input")
+	methodBindNodeIsProcessingUnhandledKeyInput   = gdnative.NewLazyMethodBind("Node", "is_processing_unhandled_key_input")
+	methodBindNodeMoveChild                       = gdnative.NewLazyMethodBind("Node", "move_child")
+	methodBindNodePrintStrayNodes                 = gdnative.NewLazyMethodBind("Node", "print_stray_nodes")
+	methodBindNodePrintTree                       = gdnative.NewLazyMethodBind("Node", "print_tree")
+	methodBindNodePropagateCall                   = gdnative.NewLazyMethodBind("Node", "propagate_call")
+	methodBindNodePropagateNotification           = gdnative.NewLazyMethodBind("Node", "propagate_notification")
+	methodBindNodeQueueFree                       = gdnative.NewLazyMethodBind("Node", "queue_free")
+	methodBindNodeRaise                           = gdnative.NewLazyMethodBind("Node", "raise")
+	methodBindNodeRemoveAndSkip                   = gdnative.NewLazyMethodBind("Node", "remove_and_skip")
+	methodBindNodeRemoveChild                     = gdnative.NewLazyMethodBind("Node", "remove_child")
+	methodBindNodeRemoveFromGroup                 = gdnative.NewLazyMethodBind("Node", "remove_from_group")
+	methodBindNodeReplaceBy                       = gdnative.NewLazyMethodBind("Node", "replace_by")
+	methodBindNodeRequestReady                    = gdnative.NewLazyMethodBind("Node", "request_ready")
+	methodBindNodeRpc                             = gdnative.NewLazyMethodBind("Node", "rpc")
+	methodBindNodeRpcConfig                       = gdnative.NewLazyMethodBind("Node", "rpc_config")
+	methodBindNodeRpcId                           = gdnative.NewLazyMethodBind("Node", "rpc_id")
+	methodBindNodeRpcUnreliable                   = gdnative.NewLazyMethodBind("Node", "rpc_unreliable")
+	methodBindNodeRpcUnreliableId                 = gdnative.NewLazyMethodBind("Node", "rpc_unreliable_id")
+	methodBindNodeRset                            = gdnative.NewLazyMethodBind("Node", "rset")
+	methodBindNodeRsetConfig                      = gdnative.NewLazyMethodBind("Node", "rset_config")
+	methodBindNodeRsetId                          = gdnative.NewLazyMethodBind("Node", "rset_id")
+	methodBindNodeRsetUnreliable                  = gdnative.NewLazyMethodBind("Node", "rset_unreliable")
+	methodBindNodeRsetUnreliableId                = gdnative.NewLazyMethodBind("Node", "rset_unreliable_id")
+	methodBindNodeSetDisplayFolded                = gdnative.NewLazyMethodBind("Node", "set_display_folded")
+	methodBindNodeSetFilename                     = gdnative.NewLazyMethodBind("Node", "set_filename")
+	methodBindNodeSetName                         = gdnative.NewLazyMethodBind("Node", "set_name")
+	methodBindNodeSetNetworkMaster                = gdnative.NewLazyMethodBind("Node", "set_network_master")
+	methodBindNodeSetOwner                        = gdnative.NewLazyMethodBind("Node", "set_owner")
+	methodBindNodeSetPauseMode                    = gdnative.NewLazyMethodBind("Node", "set_pause_mode")
+	methodBindNodeSetPhysicsProcess               = gdnative.NewLazyMethodBind("Node", "set_physics_process")
+	methodBindNodeSetPhysicsProcessInternal       = gdnative.NewLazyMethodBind("Node", "set_physics_process_internal")
+	methodBindNodeSetProcess                      = gdnative.NewLazyMethodBind("Node", "set_process")
+	methodBindNodeSetProcessInput                 = gdnative.NewLazyMethodBind("Node", "set_process_input")
+	methodBindNodeSetProcessInternal              = gdnative.NewLazyMethodBind("Node", "set_process_internal")
+	methodBindNodeSetProcessUnhandledInput        = gdnative.NewLazyMethodBind("Node", "set_process_unhandled_input")
+	methodBindNodeSetProcessUnhandledKeyInput     = gdnative.NewLazyMethodBind("Node", "set_process_unhandled_key_input")
+	methodBindNodeSetSceneInstanceLoadPlaceholder = gdnative.NewLazyMethodBind("Node", "set_scene_instance_load_placeholder")
+)
+
 /*
-        Called when the node enters the [SceneTree] (e.g. upon instancing, scene changing, or after calling [method add_child] in a script). If the node has children, its [method _enter_tree] callback will be called first, and then that of the children. Corresponds to the NOTIFICATION_ENTER_TREE notification in [method Object._notification].
-	Args: [], Returns: void
+	        Called when the node enters the [SceneTree] (e.g. upon instancing, scene changing, or after calling [method add_child] in a script). If the node has children, its [method _enter_tree] callback will be called first, and then that of the children. Corresponds to the NOTIFICATION_ENTER_TREE notification in [method Object._notification].
+		Args: [], Returns: void
 */
 func (o *Node) X_EnterTree() {
 	//log.Println("Calling Node.X_EnterTree()")
@@ -75,7 +286,7 @@ func (o *Node) X_EnterTree() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_enter_tree")
+	methodBind := methodBindNodeX_EnterTree.Get()
 
 	// Call the parent method.
 	// void
@@ -85,8 +296,8 @@ func (o *Node) X_EnterTree() {
 }
 
 /*
-        Called when the node is about to leave the [SceneTree] (e.g. upon freeing, scene changing, or after calling [method remove_child] in a script). If the node has children, its [method _exit_tree] callback will be called last, after all its children have left the tree. Corresponds to the NOTIFICATION_EXIT_TREE notification in [method Object._notification] and signal [signal tree_exiting]. To get notified when the node has already left the active tree, connect to the [signal tree_exited]
-	Args: [], Returns: void
+	        Called when the node is about to leave the [SceneTree] (e.g. upon freeing, scene changing, or after calling [method remove_child] in a script). If the node has children, its [method _exit_tree] callback will be called last, after all its children have left the tree. Corresponds to the NOTIFICATION_EXIT_TREE notification in [method Object._notification] and signal [signal tree_exiting]. To get notified when the node has already left the active tree, connect to the [signal tree_exited]
+		Args: [], Returns: void
 */
 func (o *Node) X_ExitTree() {
 	//log.Println("Calling Node.X_ExitTree()")
@@ -95,7 +306,7 @@ func (o *Node) X_ExitTree() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_exit_tree")
+	methodBind := methodBindNodeX_ExitTree.Get()
 
 	// Call the parent method.
 	// void
@@ -105,8 +316,8 @@ func (o *Node) X_ExitTree() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *Node) X_GetImportPath() gdnative.NodePath {
 	//log.Println("Calling Node.X_GetImportPath()")
@@ -115,7 +326,7 @@ func (o *Node) X_GetImportPath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_get_import_path")
+	methodBind := methodBindNodeX_GetImportPath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -128,18 +339,18 @@ func (o *Node) X_GetImportPath() gdnative.NodePath {
 }
 
 /*
-        Called when there is an input event. The input event propagates through the node tree until a node consumes it. It is only called if input processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process_input]. To consume the input event and stop it propagating further to other nodes, [method SceneTree.set_input_as_handled] can be called. For gameplay input, [method _unhandled_input] and [method _unhandled_key_input] are usually a better fit as they allow the GUI to intercept the events first.
-	Args: [{ false event InputEvent}], Returns: void
+	        Called when there is an input event. The input event propagates through the node tree until a node consumes it. It is only called if input processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process_input]. To consume the input event and stop it propagating further to other nodes, [method SceneTree.set_input_as_handled] can be called. For gameplay input, [method _unhandled_input] and [method _unhandled_key_input] are usually a better fit as they allow the GUI to intercept the events first.
+		Args: [{ false event InputEvent}], Returns: void
 */
 func (o *Node) X_Input(event InputEventImplementer) {
 	//log.Println("Calling Node.X_Input()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_input")
+	methodBind := methodBindNodeX_Input.Get()
 
 	// Call the parent method.
 	// void
@@ -149,8 +360,8 @@ func (o *Node) X_Input(event InputEventImplementer) {
 }
 
 /*
-        Called during the physics processing step of the main loop. Physics processing means that the frame rate is synced to the physics, i.e. the [code]delta[/code] variable should be constant. It is only called if physics processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_physics_process]. Corresponds to the NOTIFICATION_PHYSICS_PROCESS notification in [method Object._notification].
-	Args: [{ false delta float}], Returns: void
+	        Called during the physics processing step of the main loop. Physics processing means that the frame rate is synced to the physics, i.e. the [code]delta[/code] variable should be constant. It is only called if physics processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_physics_process]. Corresponds to the NOTIFICATION_PHYSICS_PROCESS notification in [method Object._notification].
+		Args: [{ false delta float}], Returns: void
 */
 func (o *Node) X_PhysicsProcess(delta gdnative.Real) {
 	//log.Println("Calling Node.X_PhysicsProcess()")
@@ -160,7 +371,7 @@ func (o *Node) X_PhysicsProcess(delta gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_physics_process")
+	methodBind := methodBindNodeX_PhysicsProcess.Get()
 
 	// Call the parent method.
 	// void
@@ -170,8 +381,8 @@ func (o *Node) X_PhysicsProcess(delta gdnative.Real) {
 }
 
 /*
-        Called during the processing step of the main loop. Processing happens at every frame and as fast as possible, so the [code]delta[/code] time since the previous frame is not constant. It is only called if processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process]. Corresponds to the NOTIFICATION_PROCESS notification in [method Object._notification].
-	Args: [{ false delta float}], Returns: void
+	        Called during the processing step of the main loop. Processing happens at every frame and as fast as possible, so the [code]delta[/code] time since the previous frame is not constant. It is only called if processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process]. Corresponds to the NOTIFICATION_PROCESS notification in [method Object._notification].
+		Args: [{ false delta float}], Returns: void
 */
 func (o *Node) X_Process(delta gdnative.Real) {
 	//log.Println("Calling Node.X_Process()")
@@ -181,7 +392,7 @@ func (o *Node) X_Process(delta gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(delta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_process")
+	methodBind := methodBindNodeX_Process.Get()
 
 	// Call the parent method.
 	// void
@@ -191,8 +402,8 @@ func (o *Node) X_Process(delta gdnative.Real) {
 }
 
 /*
-        Called when the node is "ready", i.e. when both the node and its children have entered the scene tree. If the node has children, their [method _ready] callbacks get triggered first, and the parent node will receive the ready notification afterwards. Corresponds to the NOTIFICATION_READY notification in [method Object._notification]. See also the [code]onready[/code] keyword for variables. Usually used for initialization. For even earlier initialization, [method Object._init] may be used. Also see [method _enter_tree].
-	Args: [], Returns: void
+	        Called when the node is "ready", i.e. when both the node and its children have entered the scene tree. If the node has children, their [method _ready] callbacks get triggered first, and the parent node will receive the ready notification afterwards. Corresponds to the NOTIFICATION_READY notification in [method Object._notification]. See also the [code]onready[/code] keyword for variables. Usually used for initialization. For even earlier initialization, [method Object._init] may be used. Also see [method _enter_tree].
+		Args: [], Returns: void
 */
 func (o *Node) X_Ready() {
 	//log.Println("Calling Node.X_Ready()")
@@ -201,7 +412,7 @@ func (o *Node) X_Ready() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_ready")
+	methodBind := methodBindNodeX_Ready.Get()
 
 	// Call the parent method.
 	// void
@@ -211,8 +422,8 @@ func (o *Node) X_Ready() {
 }
 
 /*
-        Undocumented
-	Args: [{ false import_path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false import_path NodePath}], Returns: void
 */
 func (o *Node) X_SetImportPath(importPath gdnative.NodePath) {
 	//log.Println("Calling Node.X_SetImportPath()")
@@ -222,7 +433,7 @@ func (o *Node) X_SetImportPath(importPath gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(importPath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_set_import_path")
+	methodBind := methodBindNodeX_SetImportPath.Get()
 
 	// Call the parent method.
 	// void
@@ -232,18 +443,18 @@ func (o *Node) X_SetImportPath(importPath gdnative.NodePath) {
 }
 
 /*
-        Propagated to all nodes when the previous [InputEvent] is not consumed by any nodes. It is only called if unhandled input processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process_unhandled_input]. To consume the input event and stop it propagating further to other nodes, [method SceneTree.set_input_as_handled] can be called. For gameplay input, this and [method _unhandled_key_input] are usually a better fit than [method _input] as they allow the GUI to intercept the events first.
-	Args: [{ false event InputEvent}], Returns: void
+	        Propagated to all nodes when the previous [InputEvent] is not consumed by any nodes. It is only called if unhandled input processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process_unhandled_input]. To consume the input event and stop it propagating further to other nodes, [method SceneTree.set_input_as_handled] can be called. For gameplay input, this and [method _unhandled_key_input] are usually a better fit than [method _input] as they allow the GUI to intercept the events first.
+		Args: [{ false event InputEvent}], Returns: void
 */
 func (o *Node) X_UnhandledInput(event InputEventImplementer) {
 	//log.Println("Calling Node.X_UnhandledInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_unhandled_input")
+	methodBind := methodBindNodeX_UnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -253,18 +464,18 @@ func (o *Node) X_UnhandledInput(event InputEventImplementer) {
 }
 
 /*
-        Propagated to all nodes when the previous [InputEventKey] is not consumed by any nodes. It is only called if unhandled key input processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process_unhandled_key_input]. To consume the input event and stop it propagating further to other nodes, [method SceneTree.set_input_as_handled] can be called. For gameplay input, this and [method _unhandled_input] are usually a better fit than [method _input] as they allow the GUI to intercept the events first.
-	Args: [{ false event InputEventKey}], Returns: void
+	        Propagated to all nodes when the previous [InputEventKey] is not consumed by any nodes. It is only called if unhandled key input processing is enabled, which is done automatically if this method is overridden, and can be toggled with [method set_process_unhandled_key_input]. To consume the input event and stop it propagating further to other nodes, [method SceneTree.set_input_as_handled] can be called. For gameplay input, this and [method _unhandled_input] are usually a better fit than [method _input] as they allow the GUI to intercept the events first.
+		Args: [{ false event InputEventKey}], Returns: void
 */
 func (o *Node) X_UnhandledKeyInput(event InputEventKeyImplementer) {
 	//log.Println("Calling Node.X_UnhandledKeyInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "_unhandled_key_input")
+	methodBind := methodBindNodeX_UnhandledKeyInput.Get()
 
 	// Call the parent method.
 	// void
@@ -274,19 +485,19 @@ func (o *Node) X_UnhandledKeyInput(event InputEventKeyImplementer) {
 }
 
 /*
-        Adds a child node. Nodes can have any number of children, but every child must have a unique name. Child nodes are automatically deleted when the parent node is deleted, so an entire scene can be removed by deleting its topmost node. Setting "legible_unique_name" [code]true[/code] creates child nodes with human-readable names, based on the name of the node being instanced instead of its type.
-	Args: [{ false node Object} {False true legible_unique_name bool}], Returns: void
+	        Adds a child node. Nodes can have any number of children, but every child must have a unique name. Child nodes are automatically deleted when the parent node is deleted, so an entire scene can be removed by deleting its topmost node. Setting "legible_unique_name" [code]true[/code] creates child nodes with human-readable names, based on the name of the node being instanced instead of its type.
+		Args: [{ false node Object} {False true legible_unique_name bool}], Returns: void
 */
 func (o *Node) AddChild(node ObjectImplementer, legibleUniqueName gdnative.Bool) {
 	//log.Println("Calling Node.AddChild()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
 	ptrArguments[1] = gdnative.NewPointerFromBool(legibleUniqueName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "add_child")
+	methodBind := methodBindNodeAddChild.Get()
 
 	// Call the parent method.
 	// void
@@ -296,20 +507,20 @@ func (o *Node) AddChild(node ObjectImplementer, legibleUniqueName gdnative.Bool)
 }
 
 /*
-        Adds a child node. The child is placed below the given node in the list of children. Setting "legible_unique_name" [code]true[/code] creates child nodes with human-readable names, based on the name of the node being instanced instead of its type.
-	Args: [{ false node Object} { false child_node Object} {False true legible_unique_name bool}], Returns: void
+	        Adds a child node. The child is placed below the given node in the list of children. Setting "legible_unique_name" [code]true[/code] creates child nodes with human-readable names, based on the name of the node being instanced instead of its type.
+		Args: [{ false node Object} { false child_node Object} {False true legible_unique_name bool}], Returns: void
 */
 func (o *Node) AddChildBelowNode(node ObjectImplementer, childNode ObjectImplementer, legibleUniqueName gdnative.Bool) {
 	//log.Println("Calling Node.AddChildBelowNode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
-	ptrArguments[1] = gdnative.NewPointerFromObject(childNode.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(childNode))
 	ptrArguments[2] = gdnative.NewPointerFromBool(legibleUniqueName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "add_child_below_node")
+	methodBind := methodBindNodeAddChildBelowNode.Get()
 
 	// Call the parent method.
 	// void
@@ -319,8 +530,8 @@ func (o *Node) AddChildBelowNode(node ObjectImplementer, childNode ObjectImpleme
 }
 
 /*
-        Adds the node to a group. Groups are helpers to name and organize a subset of nodes, for example "enemies" or "collectables". A node can be in any number of groups. Nodes can be assigned a group at any time, but will not be added until they are inside the scene tree (see [method is_inside_tree]). See notes in the description, and the group methods in [SceneTree].
-	Args: [{ false group String} {False true persistent bool}], Returns: void
+	        Adds the node to a group. Groups are helpers to name and organize a subset of nodes, for example "enemies" or "collectables". A node can be in any number of groups. Nodes can be assigned a group at any time, but will not be added until they are inside the scene tree (see [method is_inside_tree]). See notes in the description, and the group methods in [SceneTree].
+		Args: [{ false group String} {False true persistent bool}], Returns: void
 */
 func (o *Node) AddToGroup(group gdnative.String, persistent gdnative.Bool) {
 	//log.Println("Calling Node.AddToGroup()")
@@ -331,7 +542,7 @@ func (o *Node) AddToGroup(group gdnative.String, persistent gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(persistent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "add_to_group")
+	methodBind := methodBindNodeAddToGroup.Get()
 
 	// Call the parent method.
 	// void
@@ -341,8 +552,8 @@ func (o *Node) AddToGroup(group gdnative.String, persistent gdnative.Bool) {
 }
 
 /*
-        Returns [code]true[/code] if the node can process while the scene tree is paused (see [method set_pause_mode]). Always returns [code]true[/code] if the scene tree is not paused, and [code]false[/code] if the node is not in the tree. FIXME: Why FAIL_COND?
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the node can process while the scene tree is paused (see [method set_pause_mode]). Always returns [code]true[/code] if the scene tree is not paused, and [code]false[/code] if the node is not in the tree. FIXME: Why FAIL_COND?
+		Args: [], Returns: bool
 */
 func (o *Node) CanProcess() gdnative.Bool {
 	//log.Println("Calling Node.CanProcess()")
@@ -351,7 +562,7 @@ func (o *Node) CanProcess() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "can_process")
+	methodBind := methodBindNodeCanProcess.Get()
 
 	// Call the parent method.
 	// bool
@@ -364,8 +575,8 @@ func (o *Node) CanProcess() gdnative.Bool {
 }
 
 /*
-        Duplicates the node, returning a new node. You can fine-tune the behavior using the [code]flags[/code]. See DUPLICATE_* constants.
-	Args: [{15 true flags int}], Returns: Node
+	        Duplicates the node, returning a new node. You can fine-tune the behavior using the [code]flags[/code]. See DUPLICATE_* constants.
+		Args: [{15 true flags int}], Returns: Node
 */
 func (o *Node) Duplicate(flags gdnative.Int) NodeImplementer {
 	//log.Println("Calling Node.Duplicate()")
@@ -375,7 +586,7 @@ func (o *Node) Duplicate(flags gdnative.Int) NodeImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "duplicate")
+	methodBind := methodBindNodeDuplicate.Get()
 
 	// Call the parent method.
 	// Node
@@ -383,27 +594,25 @@ func (o *Node) Duplicate(flags gdnative.Int) NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Finds a descendant of this node whose name matches [code]mask[/code] as in [method String.match] (i.e. case sensitive, but '*' matches zero or more characters and '?' matches any single character except '.'). Note that it does not match against the full path, just against individual node names.
-	Args: [{ false mask String} {True true recursive bool} {True true owned bool}], Returns: Node
+	        Finds a descendant of this node whose name matches [code]mask[/code] as in [method String.match] (i.e. case sensitive, but '*' matches zero or more characters and '?' matches any single character except '.'). Note that it does not match against the full path, just against individual node names.
+		Args: [{ false mask String} {True true recursive bool} {True true owned bool}], Returns: Node
 */
 func (o *Node) FindNode(mask gdnative.String, recursive gdnative.Bool, owned gdnative.Bool) NodeImplementer {
 	//log.Println("Calling Node.FindNode()")
@@ -415,7 +624,7 @@ func (o *Node) FindNode(mask gdnative.String, recursive gdnative.Bool, owned gdn
 	ptrArguments[2] = gdnative.NewPointerFromBool(owned)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "find_node")
+	methodBind := methodBindNodeFindNode.Get()
 
 	// Call the parent method.
 	// Node
@@ -423,27 +632,25 @@ func (o *Node) FindNode(mask gdnative.String, recursive gdnative.Bool, owned gdn
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns a child node by its index (see [method get_child_count]). This method is often used for iterating all children of a node.
-	Args: [{ false idx int}], Returns: Node
+	        Returns a child node by its index (see [method get_child_count]). This method is often used for iterating all children of a node.
+		Args: [{ false idx int}], Returns: Node
 */
 func (o *Node) GetChild(idx gdnative.Int) NodeImplementer {
 	//log.Println("Calling Node.GetChild()")
@@ -453,7 +660,7 @@ func (o *Node) GetChild(idx gdnative.Int) NodeImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_child")
+	methodBind := methodBindNodeGetChild.Get()
 
 	// Call the parent method.
 	// Node
@@ -461,27 +668,25 @@ func (o *Node) GetChild(idx gdnative.Int) NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of child nodes.
-	Args: [], Returns: int
+	        Returns the number of child nodes.
+		Args: [], Returns: int
 */
 func (o *Node) GetChildCount() gdnative.Int {
 	//log.Println("Calling Node.GetChildCount()")
@@ -490,7 +695,7 @@ func (o *Node) GetChildCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_child_count")
+	methodBind := methodBindNodeGetChildCount.Get()
 
 	// Call the parent method.
 	// int
@@ -503,8 +708,8 @@ func (o *Node) GetChildCount() gdnative.Int {
 }
 
 /*
-        Returns an array of references to node's children.
-	Args: [], Returns: Array
+	        Returns an array of references to node's children.
+		Args: [], Returns: Array
 */
 func (o *Node) GetChildren() gdnative.Array {
 	//log.Println("Calling Node.GetChildren()")
@@ -513,7 +718,7 @@ func (o *Node) GetChildren() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_children")
+	methodBind := methodBindNodeGetChildren.Get()
 
 	// Call the parent method.
 	// Array
@@ -526,8 +731,8 @@ func (o *Node) GetChildren() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Node) GetFilename() gdnative.String {
 	//log.Println("Calling Node.GetFilename()")
@@ -536,7 +741,7 @@ func (o *Node) GetFilename() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_filename")
+	methodBind := methodBindNodeGetFilename.Get()
 
 	// Call the parent method.
 	// String
@@ -549,8 +754,8 @@ func (o *Node) GetFilename() gdnative.String {
 }
 
 /*
-        Returns an array listing the groups that the node is a member of.
-	Args: [], Returns: Array
+	        Returns an array listing the groups that the node is a member of.
+		Args: [], Returns: Array
 */
 func (o *Node) GetGroups() gdnative.Array {
 	//log.Println("Calling Node.GetGroups()")
@@ -559,7 +764,7 @@ func (o *Node) GetGroups() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_groups")
+	methodBind := methodBindNodeGetGroups.Get()
 
 	// Call the parent method.
 	// Array
@@ -572,8 +777,8 @@ func (o *Node) GetGroups() gdnative.Array {
 }
 
 /*
-        Returns the node's index, i.e. its position among the siblings of its parent.
-	Args: [], Returns: int
+	        Returns the node's index, i.e. its position among the siblings of its parent.
+		Args: [], Returns: int
 */
 func (o *Node) GetIndex() gdnative.Int {
 	//log.Println("Calling Node.GetIndex()")
@@ -582,7 +787,7 @@ func (o *Node) GetIndex() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_index")
+	methodBind := methodBindNodeGetIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -595,8 +800,8 @@ func (o *Node) GetIndex() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Node) GetName() gdnative.String {
 	//log.Println("Calling Node.GetName()")
@@ -605,7 +810,7 @@ func (o *Node) GetName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_name")
+	methodBind := methodBindNodeGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -618,8 +823,8 @@ func (o *Node) GetName() gdnative.String {
 }
 
 /*
-        Returns the peer ID of the network master for this node. See [method set_network_master].
-	Args: [], Returns: int
+	        Returns the peer ID of the network master for this node. See [method set_network_master].
+		Args: [], Returns: int
 */
 func (o *Node) GetNetworkMaster() gdnative.Int {
 	//log.Println("Calling Node.GetNetworkMaster()")
@@ -628,7 +833,7 @@ func (o *Node) GetNetworkMaster() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_network_master")
+	methodBind := methodBindNodeGetNetworkMaster.Get()
 
 	// Call the parent method.
 	// int
@@ -641,8 +846,8 @@ func (o *Node) GetNetworkMaster() gdnative.Int {
 }
 
 /*
-        Fetches a node. The [NodePath] can be either a relative path (from the current node) or an absolute path (in the scene tree) to a node. If the path does not exist, a [code]null instance[/code] is returned and attempts to access it will result in an "Attempt to call <method> on a null instance." error. Note: fetching absolute paths only works when the node is inside the scene tree (see [method is_inside_tree]). [i]Example:[/i] Assume your current node is Character and the following tree: [codeblock] /root /root/Character /root/Character/Sword /root/Character/Backpack/Dagger /root/MyGame /root/Swamp/Alligator /root/Swamp/Mosquito /root/Swamp/Goblin [/codeblock] Possible paths are: [codeblock] get_node("Sword") get_node("Backpack/Dagger") get_node("../Swamp/Alligator") get_node("/root/MyGame") [/codeblock]
-	Args: [{ false path NodePath}], Returns: Node
+	        Fetches a node. The [NodePath] can be either a relative path (from the current node) or an absolute path (in the scene tree) to a node. If the path does not exist, a [code]null instance[/code] is returned and attempts to access it will result in an "Attempt to call <method> on a null instance." error. Note: fetching absolute paths only works when the node is inside the scene tree (see [method is_inside_tree]). [i]Example:[/i] Assume your current node is Character and the following tree: [codeblock] /root /root/Character /root/Character/Sword /root/Character/Backpack/Dagger /root/MyGame /root/Swamp/Alligator /root/Swamp/Mosquito /root/Swamp/Goblin [/codeblock] Possible paths are: [codeblock] get_node("Sword") get_node("Backpack/Dagger") get_node("../Swamp/Alligator") get_node("/root/MyGame") [/codeblock]
+		Args: [{ false path NodePath}], Returns: Node
 */
 func (o *Node) GetNode(path gdnative.NodePath) NodeImplementer {
 	//log.Println("Calling Node.GetNode()")
@@ -652,7 +857,7 @@ func (o *Node) GetNode(path gdnative.NodePath) NodeImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_node")
+	methodBind := methodBindNodeGetNode.Get()
 
 	// Call the parent method.
 	// Node
@@ -660,27 +865,24 @@ func (o *Node) GetNode(path gdnative.NodePath) NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false path NodePath}], Returns: Array
+Args: [{ false path NodePath}], Returns: Array
 */
 func (o *Node) GetNodeAndResource(path gdnative.NodePath) gdnative.Array {
 	//log.Println("Calling Node.GetNodeAndResource()")
@@ -690,7 +892,7 @@ func (o *Node) GetNodeAndResource(path gdnative.NodePath) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_node_and_resource")
+	methodBind := methodBindNodeGetNodeAndResource.Get()
 
 	// Call the parent method.
 	// Array
@@ -703,8 +905,8 @@ func (o *Node) GetNodeAndResource(path gdnative.NodePath) gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Node
+	        Undocumented
+		Args: [], Returns: Node
 */
 func (o *Node) GetOwner() NodeImplementer {
 	//log.Println("Calling Node.GetOwner()")
@@ -713,7 +915,7 @@ func (o *Node) GetOwner() NodeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_owner")
+	methodBind := methodBindNodeGetOwner.Get()
 
 	// Call the parent method.
 	// Node
@@ -721,27 +923,25 @@ func (o *Node) GetOwner() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the parent node of the current node, or an empty [code]Node[/code] if the node lacks a parent.
-	Args: [], Returns: Node
+	        Returns the parent node of the current node, or an empty [code]Node[/code] if the node lacks a parent.
+		Args: [], Returns: Node
 */
 func (o *Node) GetParent() NodeImplementer {
 	//log.Println("Calling Node.GetParent()")
@@ -750,7 +950,7 @@ func (o *Node) GetParent() NodeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_parent")
+	methodBind := methodBindNodeGetParent.Get()
 
 	// Call the parent method.
 	// Node
@@ -758,27 +958,25 @@ func (o *Node) GetParent() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the absolute path of the current node. This only works if the current node is inside the scene tree (see [method is_inside_tree]).
-	Args: [], Returns: NodePath
+	        Returns the absolute path of the current node. This only works if the current node is inside the scene tree (see [method is_inside_tree]).
+		Args: [], Returns: NodePath
 */
 func (o *Node) GetPath() gdnative.NodePath {
 	//log.Println("Calling Node.GetPath()")
@@ -787,7 +985,7 @@ func (o *Node) GetPath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_path")
+	methodBind := methodBindNodeGetPath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -800,18 +998,18 @@ func (o *Node) GetPath() gdnative.NodePath {
 }
 
 /*
-        Returns the relative [NodePath] from this node to the specified [code]node[/code]. Both nodes must be in the same scene or the function will fail.
-	Args: [{ false node Object}], Returns: NodePath
+	        Returns the relative [NodePath] from this node to the specified [code]node[/code]. Both nodes must be in the same scene or the function will fail.
+		Args: [{ false node Object}], Returns: NodePath
 */
 func (o *Node) GetPathTo(node ObjectImplementer) gdnative.NodePath {
 	//log.Println("Calling Node.GetPathTo()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_path_to")
+	methodBind := methodBindNodeGetPathTo.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -824,8 +1022,8 @@ func (o *Node) GetPathTo(node ObjectImplementer) gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Node::PauseMode
+	        Undocumented
+		Args: [], Returns: enum.Node::PauseMode
 */
 func (o *Node) GetPauseMode() NodePauseMode {
 	//log.Println("Calling Node.GetPauseMode()")
@@ -834,7 +1032,7 @@ func (o *Node) GetPauseMode() NodePauseMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_pause_mode")
+	methodBind := methodBindNodeGetPauseMode.Get()
 
 	// Call the parent method.
 	// enum.Node::PauseMode
@@ -847,8 +1045,8 @@ func (o *Node) GetPauseMode() NodePauseMode {
 }
 
 /*
-        Returns the time elapsed since the last physics-bound frame (see [method _physics_process]). This is always a constant value in physics processing unless the frames per second is changed in [OS].
-	Args: [], Returns: float
+	        Returns the time elapsed since the last physics-bound frame (see [method _physics_process]). This is always a constant value in physics processing unless the frames per second is changed in [OS].
+		Args: [], Returns: float
 */
 func (o *Node) GetPhysicsProcessDeltaTime() gdnative.Real {
 	//log.Println("Calling Node.GetPhysicsProcessDeltaTime()")
@@ -857,7 +1055,7 @@ func (o *Node) GetPhysicsProcessDeltaTime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_physics_process_delta_time")
+	methodBind := methodBindNodeGetPhysicsProcessDeltaTime.Get()
 
 	// Call the parent method.
 	// float
@@ -870,8 +1068,8 @@ func (o *Node) GetPhysicsProcessDeltaTime() gdnative.Real {
 }
 
 /*
-        Returns the node's order in the scene tree branch. For example, if called on the first child node the position is [code]0[/code].
-	Args: [], Returns: int
+	        Returns the node's order in the scene tree branch. For example, if called on the first child node the position is [code]0[/code].
+		Args: [], Returns: int
 */
 func (o *Node) GetPositionInParent() gdnative.Int {
 	//log.Println("Calling Node.GetPositionInParent()")
@@ -880,7 +1078,7 @@ func (o *Node) GetPositionInParent() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_position_in_parent")
+	methodBind := methodBindNodeGetPositionInParent.Get()
 
 	// Call the parent method.
 	// int
@@ -893,8 +1091,8 @@ func (o *Node) GetPositionInParent() gdnative.Int {
 }
 
 /*
-        Returns the time elapsed (in seconds) since the last process callback. This value may vary from frame to frame.
-	Args: [], Returns: float
+	        Returns the time elapsed (in seconds) since the last process callback. This value may vary from frame to frame.
+		Args: [], Returns: float
 */
 func (o *Node) GetProcessDeltaTime() gdnative.Real {
 	//log.Println("Calling Node.GetProcessDeltaTime()")
@@ -903,7 +1101,7 @@ func (o *Node) GetProcessDeltaTime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_process_delta_time")
+	methodBind := methodBindNodeGetProcessDeltaTime.Get()
 
 	// Call the parent method.
 	// float
@@ -916,8 +1114,8 @@ func (o *Node) GetProcessDeltaTime() gdnative.Real {
 }
 
 /*
-        Returns [code]true[/code] if this is an instance load placeholder. See [InstancePlaceholder].
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if this is an instance load placeholder. See [InstancePlaceholder].
+		Args: [], Returns: bool
 */
 func (o *Node) GetSceneInstanceLoadPlaceholder() gdnative.Bool {
 	//log.Println("Calling Node.GetSceneInstanceLoadPlaceholder()")
@@ -926,7 +1124,7 @@ func (o *Node) GetSceneInstanceLoadPlaceholder() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_scene_instance_load_placeholder")
+	methodBind := methodBindNodeGetSceneInstanceLoadPlaceholder.Get()
 
 	// Call the parent method.
 	// bool
@@ -939,8 +1137,8 @@ func (o *Node) GetSceneInstanceLoadPlaceholder() gdnative.Bool {
 }
 
 /*
-        Returns the [SceneTree] that contains this node.
-	Args: [], Returns: SceneTree
+	        Returns the [SceneTree] that contains this node.
+		Args: [], Returns: SceneTree
 */
 func (o *Node) GetTree() SceneTreeImplementer {
 	//log.Println("Calling Node.GetTree()")
@@ -949,7 +1147,7 @@ func (o *Node) GetTree() SceneTreeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_tree")
+	methodBind := methodBindNodeGetTree.Get()
 
 	// Call the parent method.
 	// SceneTree
@@ -957,27 +1155,25 @@ func (o *Node) GetTree() SceneTreeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSceneTreeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SceneTreeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "SceneTree" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SceneTreeImplementer)
+	ret := SceneTree{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(SceneTreeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the node's [Viewport].
-	Args: [], Returns: Viewport
+	        Returns the node's [Viewport].
+		Args: [], Returns: Viewport
 */
 func (o *Node) GetViewport() ViewportImplementer {
 	//log.Println("Calling Node.GetViewport()")
@@ -986,7 +1182,7 @@ func (o *Node) GetViewport() ViewportImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "get_viewport")
+	methodBind := methodBindNodeGetViewport.Get()
 
 	// Call the parent method.
 	// Viewport
@@ -994,27 +1190,25 @@ func (o *Node) GetViewport() ViewportImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newViewportFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ViewportImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Viewport" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ViewportImplementer)
+	ret := Viewport{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ViewportImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns [code]true[/code] if the node that the [NodePath] points to exists.
-	Args: [{ false path NodePath}], Returns: bool
+	        Returns [code]true[/code] if the node that the [NodePath] points to exists.
+		Args: [{ false path NodePath}], Returns: bool
 */
 func (o *Node) HasNode(path gdnative.NodePath) gdnative.Bool {
 	//log.Println("Calling Node.HasNode()")
@@ -1024,7 +1218,7 @@ func (o *Node) HasNode(path gdnative.NodePath) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "has_node")
+	methodBind := methodBindNodeHasNode.Get()
 
 	// Call the parent method.
 	// bool
@@ -1037,8 +1231,7 @@ func (o *Node) HasNode(path gdnative.NodePath) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false path NodePath}], Returns: bool
+Args: [{ false path NodePath}], Returns: bool
 */
 func (o *Node) HasNodeAndResource(path gdnative.NodePath) gdnative.Bool {
 	//log.Println("Calling Node.HasNodeAndResource()")
@@ -1048,7 +1241,7 @@ func (o *Node) HasNodeAndResource(path gdnative.NodePath) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "has_node_and_resource")
+	methodBind := methodBindNodeHasNodeAndResource.Get()
 
 	// Call the parent method.
 	// bool
@@ -1061,18 +1254,18 @@ func (o *Node) HasNodeAndResource(path gdnative.NodePath) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the given node is a direct or indirect child of the current node.
-	Args: [{ false node Object}], Returns: bool
+	        Returns [code]true[/code] if the given node is a direct or indirect child of the current node.
+		Args: [{ false node Object}], Returns: bool
 */
 func (o *Node) IsAParentOf(node ObjectImplementer) gdnative.Bool {
 	//log.Println("Calling Node.IsAParentOf()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_a_parent_of")
+	methodBind := methodBindNodeIsAParentOf.Get()
 
 	// Call the parent method.
 	// bool
@@ -1085,8 +1278,8 @@ func (o *Node) IsAParentOf(node ObjectImplementer) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the node is folded (collapsed) in the Scene dock.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the node is folded (collapsed) in the Scene dock.
+		Args: [], Returns: bool
 */
 func (o *Node) IsDisplayedFolded() gdnative.Bool {
 	//log.Println("Calling Node.IsDisplayedFolded()")
@@ -1095,7 +1288,7 @@ func (o *Node) IsDisplayedFolded() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_displayed_folded")
+	methodBind := methodBindNodeIsDisplayedFolded.Get()
 
 	// Call the parent method.
 	// bool
@@ -1108,18 +1301,18 @@ func (o *Node) IsDisplayedFolded() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the given node occurs later in the scene hierarchy than the current node.
-	Args: [{ false node Object}], Returns: bool
+	        Returns [code]true[/code] if the given node occurs later in the scene hierarchy than the current node.
+		Args: [{ false node Object}], Returns: bool
 */
 func (o *Node) IsGreaterThan(node ObjectImplementer) gdnative.Bool {
 	//log.Println("Calling Node.IsGreaterThan()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_greater_than")
+	methodBind := methodBindNodeIsGreaterThan.Get()
 
 	// Call the parent method.
 	// bool
@@ -1132,8 +1325,8 @@ func (o *Node) IsGreaterThan(node ObjectImplementer) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if this node is in the specified group. See notes in the description, and the group methods in [SceneTree].
-	Args: [{ false group String}], Returns: bool
+	        Returns [code]true[/code] if this node is in the specified group. See notes in the description, and the group methods in [SceneTree].
+		Args: [{ false group String}], Returns: bool
 */
 func (o *Node) IsInGroup(group gdnative.String) gdnative.Bool {
 	//log.Println("Calling Node.IsInGroup()")
@@ -1143,7 +1336,7 @@ func (o *Node) IsInGroup(group gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(group)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_in_group")
+	methodBind := methodBindNodeIsInGroup.Get()
 
 	// Call the parent method.
 	// bool
@@ -1156,8 +1349,8 @@ func (o *Node) IsInGroup(group gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if this node is currently inside a [SceneTree].
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if this node is currently inside a [SceneTree].
+		Args: [], Returns: bool
 */
 func (o *Node) IsInsideTree() gdnative.Bool {
 	//log.Println("Calling Node.IsInsideTree()")
@@ -1166,7 +1359,7 @@ func (o *Node) IsInsideTree() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_inside_tree")
+	methodBind := methodBindNodeIsInsideTree.Get()
 
 	// Call the parent method.
 	// bool
@@ -1179,8 +1372,8 @@ func (o *Node) IsInsideTree() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the local system is the master of this node.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the local system is the master of this node.
+		Args: [], Returns: bool
 */
 func (o *Node) IsNetworkMaster() gdnative.Bool {
 	//log.Println("Calling Node.IsNetworkMaster()")
@@ -1189,7 +1382,7 @@ func (o *Node) IsNetworkMaster() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_network_master")
+	methodBind := methodBindNodeIsNetworkMaster.Get()
 
 	// Call the parent method.
 	// bool
@@ -1202,8 +1395,8 @@ func (o *Node) IsNetworkMaster() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if physics processing is enabled (see [method set_physics_process]).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if physics processing is enabled (see [method set_physics_process]).
+		Args: [], Returns: bool
 */
 func (o *Node) IsPhysicsProcessing() gdnative.Bool {
 	//log.Println("Calling Node.IsPhysicsProcessing()")
@@ -1212,7 +1405,7 @@ func (o *Node) IsPhysicsProcessing() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_physics_processing")
+	methodBind := methodBindNodeIsPhysicsProcessing.Get()
 
 	// Call the parent method.
 	// bool
@@ -1225,8 +1418,8 @@ func (o *Node) IsPhysicsProcessing() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if internal physics processing is enabled (see [method set_physics_process_internal]).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if internal physics processing is enabled (see [method set_physics_process_internal]).
+		Args: [], Returns: bool
 */
 func (o *Node) IsPhysicsProcessingInternal() gdnative.Bool {
 	//log.Println("Calling Node.IsPhysicsProcessingInternal()")
@@ -1235,7 +1428,7 @@ func (o *Node) IsPhysicsProcessingInternal() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_physics_processing_internal")
+	methodBind := methodBindNodeIsPhysicsProcessingInternal.Get()
 
 	// Call the parent method.
 	// bool
@@ -1248,8 +1441,8 @@ func (o *Node) IsPhysicsProcessingInternal() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if processing is enabled (see [method set_process]).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if processing is enabled (see [method set_process]).
+		Args: [], Returns: bool
 */
 func (o *Node) IsProcessing() gdnative.Bool {
 	//log.Println("Calling Node.IsProcessing()")
@@ -1258,7 +1451,7 @@ func (o *Node) IsProcessing() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_processing")
+	methodBind := methodBindNodeIsProcessing.Get()
 
 	// Call the parent method.
 	// bool
@@ -1271,8 +1464,8 @@ func (o *Node) IsProcessing() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the node is processing input (see [method set_process_input]).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the node is processing input (see [method set_process_input]).
+		Args: [], Returns: bool
 */
 func (o *Node) IsProcessingInput() gdnative.Bool {
 	//log.Println("Calling Node.IsProcessingInput()")
@@ -1281,7 +1474,7 @@ func (o *Node) IsProcessingInput() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_processing_input")
+	methodBind := methodBindNodeIsProcessingInput.Get()
 
 	// Call the parent method.
 	// bool
@@ -1294,8 +1487,8 @@ func (o *Node) IsProcessingInput() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if internal processing is enabled (see [method set_process_internal]).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if internal processing is enabled (see [method set_process_internal]).
+		Args: [], Returns: bool
 */
 func (o *Node) IsProcessingInternal() gdnative.Bool {
 	//log.Println("Calling Node.IsProcessingInternal()")
@@ -1304,7 +1497,7 @@ func (o *Node) IsProcessingInternal() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_processing_internal")
+	methodBind := methodBindNodeIsProcessingInternal.Get()
 
 	// Call the parent method.
 	// bool
@@ -1317,8 +1510,8 @@ func (o *Node) IsProcessingInternal() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the node is processing unhandled input (see [method set_process_unhandled_input]).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the node is processing unhandled input (see [method set_process_unhandled_input]).
+		Args: [], Returns: bool
 */
 func (o *Node) IsProcessingUnhandledInput() gdnative.Bool {
 	//log.Println("Calling Node.IsProcessingUnhandledInput()")
@@ -1327,7 +1520,7 @@ func (o *Node) IsProcessingUnhandledInput() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_processing_unhandled_input")
+	methodBind := methodBindNodeIsProcessingUnhandledInput.Get()
 
 	// Call the parent method.
 	// bool
@@ -1340,8 +1533,8 @@ func (o *Node) IsProcessingUnhandledInput() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the node is processing unhandled key input (see [method set_process_unhandled_key_input]).
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the node is processing unhandled key input (see [method set_process_unhandled_key_input]).
+		Args: [], Returns: bool
 */
 func (o *Node) IsProcessingUnhandledKeyInput() gdnative.Bool {
 	//log.Println("Calling Node.IsProcessingUnhandledKeyInput()")
@@ -1350,7 +1543,7 @@ func (o *Node) IsProcessingUnhandledKeyInput() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "is_processing_unhandled_key_input")
+	methodBind := methodBindNodeIsProcessingUnhandledKeyInput.Get()
 
 	// Call the parent method.
 	// bool
@@ -1363,19 +1556,19 @@ func (o *Node) IsProcessingUnhandledKeyInput() gdnative.Bool {
 }
 
 /*
-        Moves a child node to a different position (order) amongst the other children. Since calls, signals, etc are performed by tree order, changing the order of children nodes may be useful.
-	Args: [{ false child_node Object} { false to_position int}], Returns: void
+	        Moves a child node to a different position (order) amongst the other children. Since calls, signals, etc are performed by tree order, changing the order of children nodes may be useful.
+		Args: [{ false child_node Object} { false to_position int}], Returns: void
 */
 func (o *Node) MoveChild(childNode ObjectImplementer, toPosition gdnative.Int) {
 	//log.Println("Calling Node.MoveChild()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(childNode.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(childNode))
 	ptrArguments[1] = gdnative.NewPointerFromInt(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "move_child")
+	methodBind := methodBindNodeMoveChild.Get()
 
 	// Call the parent method.
 	// void
@@ -1385,8 +1578,8 @@ func (o *Node) MoveChild(childNode ObjectImplementer, toPosition gdnative.Int) {
 }
 
 /*
-        Prints all stray nodes (nodes outside the [SceneTree]). Used for debugging. Works only in debug builds.
-	Args: [], Returns: void
+	        Prints all stray nodes (nodes outside the [SceneTree]). Used for debugging. Works only in debug builds.
+		Args: [], Returns: void
 */
 func (o *Node) PrintStrayNodes() {
 	//log.Println("Calling Node.PrintStrayNodes()")
@@ -1395,7 +1588,7 @@ func (o *Node) PrintStrayNodes() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "print_stray_nodes")
+	methodBind := methodBindNodePrintStrayNodes.Get()
 
 	// Call the parent method.
 	// void
@@ -1405,8 +1598,8 @@ func (o *Node) PrintStrayNodes() {
 }
 
 /*
-        Prints the scene hierarchy of this node and all it's children to stdout. Used mainly for debugging purposes.
-	Args: [], Returns: void
+	        Prints the scene hierarchy of this node and all it's children to stdout. Used mainly for debugging purposes.
+		Args: [], Returns: void
 */
 func (o *Node) PrintTree() {
 	//log.Println("Calling Node.PrintTree()")
@@ -1415,7 +1608,7 @@ func (o *Node) PrintTree() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "print_tree")
+	methodBind := methodBindNodePrintTree.Get()
 
 	// Call the parent method.
 	// void
@@ -1425,8 +1618,8 @@ func (o *Node) PrintTree() {
 }
 
 /*
-        Calls the given method (if present) with the arguments given in [code]args[/code] on this node and recursively on all its children. If the parent_first argument is [code]true[/code] then the method will be called on the current node first, then on all children. If it is [code]false[/code] then the children will be called first.
-	Args: [{ false method String} {[] true args Array} {False true parent_first bool}], Returns: void
+	        Calls the given method (if present) with the arguments given in [code]args[/code] on this node and recursively on all its children. If the parent_first argument is [code]true[/code] then the method will be called on the current node first, then on all children. If it is [code]false[/code] then the children will be called first.
+		Args: [{ false method String} {[] true args Array} {False true parent_first bool}], Returns: void
 */
 func (o *Node) PropagateCall(method gdnative.String, args gdnative.Array, parentFirst gdnative.Bool) {
 	//log.Println("Calling Node.PropagateCall()")
@@ -1438,7 +1631,7 @@ func (o *Node) PropagateCall(method gdnative.String, args gdnative.Array, parent
 	ptrArguments[2] = gdnative.NewPointerFromBool(parentFirst)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "propagate_call")
+	methodBind := methodBindNodePropagateCall.Get()
 
 	// Call the parent method.
 	// void
@@ -1448,8 +1641,8 @@ func (o *Node) PropagateCall(method gdnative.String, args gdnative.Array, parent
 }
 
 /*
-        Notifies the current node and all its children recursively by calling notification() on all of them.
-	Args: [{ false what int}], Returns: void
+	        Notifies the current node and all its children recursively by calling notification() on all of them.
+		Args: [{ false what int}], Returns: void
 */
 func (o *Node) PropagateNotification(what gdnative.Int) {
 	//log.Println("Calling Node.PropagateNotification()")
@@ -1459,7 +1652,7 @@ func (o *Node) PropagateNotification(what gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(what)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "propagate_notification")
+	methodBind := methodBindNodePropagateNotification.Get()
 
 	// Call the parent method.
 	// void
@@ -1469,8 +1662,8 @@ func (o *Node) PropagateNotification(what gdnative.Int) {
 }
 
 /*
-        Queues a node for deletion at the end of the current frame. When deleted, all of its child nodes will be deleted as well. This method ensures it's safe to delete the node, contrary to [method Object.free]. Use [method Object.is_queued_for_deletion] to check whether a node will be deleted at the end of the frame.
-	Args: [], Returns: void
+	        Queues a node for deletion at the end of the current frame. When deleted, all of its child nodes will be deleted as well. This method ensures it's safe to delete the node, contrary to [method Object.free]. Use [method Object.is_queued_for_deletion] to check whether a node will be deleted at the end of the frame.
+		Args: [], Returns: void
 */
 func (o *Node) QueueFree() {
 	//log.Println("Calling Node.QueueFree()")
@@ -1479,7 +1672,7 @@ func (o *Node) QueueFree() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "queue_free")
+	methodBind := methodBindNodeQueueFree.Get()
 
 	// Call the parent method.
 	// void
@@ -1489,8 +1682,8 @@ func (o *Node) QueueFree() {
 }
 
 /*
-        Moves this node to the top of the array of nodes of the parent node. This is often useful in GUIs ([Control] nodes), because their order of drawing depends on their order in the tree.
-	Args: [], Returns: void
+	        Moves this node to the top of the array of nodes of the parent node. This is often useful in GUIs ([Control] nodes), because their order of drawing depends on their order in the tree.
+		Args: [], Returns: void
 */
 func (o *Node) Raise() {
 	//log.Println("Calling Node.Raise()")
@@ -1499,7 +1692,7 @@ func (o *Node) Raise() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "raise")
+	methodBind := methodBindNodeRaise.Get()
 
 	// Call the parent method.
 	// void
@@ -1509,8 +1702,8 @@ func (o *Node) Raise() {
 }
 
 /*
-        Removes a node and sets all its children as children of the parent node (if it exists). All event subscriptions that pass by the removed node will be unsubscribed.
-	Args: [], Returns: void
+	        Removes a node and sets all its children as children of the parent node (if it exists). All event subscriptions that pass by the removed node will be unsubscribed.
+		Args: [], Returns: void
 */
 func (o *Node) RemoveAndSkip() {
 	//log.Println("Calling Node.RemoveAndSkip()")
@@ -1519,7 +1712,7 @@ func (o *Node) RemoveAndSkip() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "remove_and_skip")
+	methodBind := methodBindNodeRemoveAndSkip.Get()
 
 	// Call the parent method.
 	// void
@@ -1529,18 +1722,18 @@ func (o *Node) RemoveAndSkip() {
 }
 
 /*
-        Removes a child node. The node is NOT deleted and must be deleted manually.
-	Args: [{ false node Object}], Returns: void
+	        Removes a child node. The node is NOT deleted and must be deleted manually.
+		Args: [{ false node Object}], Returns: void
 */
 func (o *Node) RemoveChild(node ObjectImplementer) {
 	//log.Println("Calling Node.RemoveChild()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "remove_child")
+	methodBind := methodBindNodeRemoveChild.Get()
 
 	// Call the parent method.
 	// void
@@ -1550,8 +1743,8 @@ func (o *Node) RemoveChild(node ObjectImplementer) {
 }
 
 /*
-        Removes a node from a group. See notes in the description, and the group methods in [SceneTree].
-	Args: [{ false group String}], Returns: void
+	        Removes a node from a group. See notes in the description, and the group methods in [SceneTree].
+		Args: [{ false group String}], Returns: void
 */
 func (o *Node) RemoveFromGroup(group gdnative.String) {
 	//log.Println("Calling Node.RemoveFromGroup()")
@@ -1561,7 +1754,7 @@ func (o *Node) RemoveFromGroup(group gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(group)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "remove_from_group")
+	methodBind := methodBindNodeRemoveFromGroup.Get()
 
 	// Call the parent method.
 	// void
@@ -1571,19 +1764,19 @@ func (o *Node) RemoveFromGroup(group gdnative.String) {
 }
 
 /*
-        Replaces a node in a scene by the given one. Subscriptions that pass through this node will be lost.
-	Args: [{ false node Object} {False true keep_data bool}], Returns: void
+	        Replaces a node in a scene by the given one. Subscriptions that pass through this node will be lost.
+		Args: [{ false node Object} {False true keep_data bool}], Returns: void
 */
 func (o *Node) ReplaceBy(node ObjectImplementer, keepData gdnative.Bool) {
 	//log.Println("Calling Node.ReplaceBy()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(node))
 	ptrArguments[1] = gdnative.NewPointerFromBool(keepData)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "replace_by")
+	methodBind := methodBindNodeReplaceBy.Get()
 
 	// Call the parent method.
 	// void
@@ -1593,8 +1786,8 @@ func (o *Node) ReplaceBy(node ObjectImplementer, keepData gdnative.Bool) {
 }
 
 /*
-        Requests that [code]_ready[/code] be called again.
-	Args: [], Returns: void
+	        Requests that [code]_ready[/code] be called again.
+		Args: [], Returns: void
 */
 func (o *Node) RequestReady() {
 	//log.Println("Calling Node.RequestReady()")
@@ -1603,7 +1796,7 @@ func (o *Node) RequestReady() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "request_ready")
+	methodBind := methodBindNodeRequestReady.Get()
 
 	// Call the parent method.
 	// void
@@ -1613,32 +1806,36 @@ func (o *Node) RequestReady() {
 }
 
 /*
-        Sends a remote procedure call request for the given [code]method[/code] to peers on the network (and locally), optionally sending all additional arguments as arguments to the method called by the RPC. The call request will only be received by nodes with the same [NodePath], including the exact same node name. Behaviour depends on the RPC configuration for the given method, see [method rpc_config]. Methods are not exposed to RPCs by default. Also see [method rset] and [method rset_config] for properties. Returns an empty [Variant]. Note that you can only safely use RPCs on clients after you received the [code]connected_to_server[/code] signal from the [SceneTree]. You also need to keep track of the connection state, either by the [SceneTree] signals like [code]server_disconnected[/code] or by checking [code]SceneTree.network_peer.get_connection_status() == CONNECTION_CONNECTED[/code].
-	Args: [{ false method String}], Returns: Variant
+	        Sends a remote procedure call request for the given [code]method[/code] to peers on the network (and locally), optionally sending all additional arguments as arguments to the method called by the RPC. The call request will only be received by nodes with the same [NodePath], including the exact same node name. Behaviour depends on the RPC configuration for the given method, see [method rpc_config]. Methods are not exposed to RPCs by default. Also see [method rset] and [method rset_config] for properties. Returns an empty [Variant]. Note that you can only safely use RPCs on clients after you received the [code]connected_to_server[/code] signal from the [SceneTree]. You also need to keep track of the connection state, either by the [SceneTree] signals like [code]server_disconnected[/code] or by checking [code]SceneTree.network_peer.get_connection_status() == CONNECTION_CONNECTED[/code].
+		Args: [{ false method String}], Returns: Variant
 */
-func (o *Node) Rpc(method gdnative.String) gdnative.Variant {
+func (o *Node) Rpc(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling Node.Rpc()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rpc")
+	methodBind := methodBindNodeRpc.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-        Changes the RPC mode for the given [code]method[/code] to the given [code]mode[/code]. See [enum RPCMode]. An alternative is annotating methods and properties with the corresponding keywords ([code]remote[/code], [code]sync[/code], [code]master[/code], [code]slave[/code]). By default, methods are not exposed to networking (and RPCs). Also see [method rset] and [method rset_config] for properties.
-	Args: [{ false method String} { false mode int}], Returns: void
+	        Changes the RPC mode for the given [code]method[/code] to the given [code]mode[/code]. See [enum RPCMode]. An alternative is annotating methods and properties with the corresponding keywords ([code]remote[/code], [code]sync[/code], [code]master[/code], [code]slave[/code]). By default, methods are not exposed to networking (and RPCs). Also see [method rset] and [method rset_config] for properties.
+		Args: [{ false method String} { false mode int}], Returns: void
 */
 func (o *Node) RpcConfig(method gdnative.String, mode gdnative.Int) {
 	//log.Println("Calling Node.RpcConfig()")
@@ -1649,7 +1846,7 @@ func (o *Node) RpcConfig(method gdnative.String, mode gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rpc_config")
+	methodBind := methodBindNodeRpcConfig.Get()
 
 	// Call the parent method.
 	// void
@@ -1659,82 +1856,94 @@ func (o *Node) RpcConfig(method gdnative.String, mode gdnative.Int) {
 }
 
 /*
-        Sends a [method rpc] to a specific peer identified by [code]peer_id[/code]. Returns an empty [Variant].
-	Args: [{ false peer_id int} { false method String}], Returns: Variant
+	        Sends a [method rpc] to a specific peer identified by [code]peer_id[/code]. Returns an empty [Variant].
+		Args: [{ false peer_id int} { false method String}], Returns: Variant
 */
-func (o *Node) RpcId(peerId gdnative.Int, method gdnative.String) gdnative.Variant {
+func (o *Node) RpcId(peerId gdnative.Int, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling Node.RpcId()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromInt(peerId)
-	ptrArguments[1] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 2+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantInt(gdnative.Int64T(peerId)))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rpc_id")
+	methodBind := methodBindNodeRpcId.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 2; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-        Sends a [method rpc] using an unreliable protocol. Returns an empty [Variant].
-	Args: [{ false method String}], Returns: Variant
+	        Sends a [method rpc] using an unreliable protocol. Returns an empty [Variant].
+		Args: [{ false method String}], Returns: Variant
 */
-func (o *Node) RpcUnreliable(method gdnative.String) gdnative.Variant {
+func (o *Node) RpcUnreliable(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling Node.RpcUnreliable()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rpc_unreliable")
+	methodBind := methodBindNodeRpcUnreliable.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-        Sends a [method rpc] to a specific peer identified by [code]peer_id[/code] using an unreliable protocol. Returns an empty [Variant].
-	Args: [{ false peer_id int} { false method String}], Returns: Variant
+	        Sends a [method rpc] to a specific peer identified by [code]peer_id[/code] using an unreliable protocol. Returns an empty [Variant].
+		Args: [{ false peer_id int} { false method String}], Returns: Variant
 */
-func (o *Node) RpcUnreliableId(peerId gdnative.Int, method gdnative.String) gdnative.Variant {
+func (o *Node) RpcUnreliableId(peerId gdnative.Int, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling Node.RpcUnreliableId()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromInt(peerId)
-	ptrArguments[1] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 2+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantInt(gdnative.Int64T(peerId)))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rpc_unreliable_id")
+	methodBind := methodBindNodeRpcUnreliableId.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 2; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-        Remotely changes a property's value on other peers (and locally). Behaviour depends on the RPC configuration for the given property, see [method rset_config]. Also see [method rpc] for RPCs for methods, most information applies to this method as well.
-	Args: [{ false property String} { false value Variant}], Returns: void
+	        Remotely changes a property's value on other peers (and locally). Behaviour depends on the RPC configuration for the given property, see [method rset_config]. Also see [method rpc] for RPCs for methods, most information applies to this method as well.
+		Args: [{ false property String} { false value Variant}], Returns: void
 */
 func (o *Node) Rset(property gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling Node.Rset()")
@@ -1745,7 +1954,7 @@ func (o *Node) Rset(property gdnative.String, value gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rset")
+	methodBind := methodBindNodeRset.Get()
 
 	// Call the parent method.
 	// void
@@ -1755,8 +1964,8 @@ func (o *Node) Rset(property gdnative.String, value gdnative.Variant) {
 }
 
 /*
-        Changes the RPC mode for the given [code]property[/code] to the given [code]mode[/code]. See [enum RPCMode]. An alternative is annotating methods and properties with the corresponding keywords ([code]remote[/code], [code]sync[/code], [code]master[/code], [code]slave[/code]). By default, properties are not exposed to networking (and RPCs). Also see [method rpc] and [method rpc_config] for methods.
-	Args: [{ false property String} { false mode int}], Returns: void
+	        Changes the RPC mode for the given [code]property[/code] to the given [code]mode[/code]. See [enum RPCMode]. An alternative is annotating methods and properties with the corresponding keywords ([code]remote[/code], [code]sync[/code], [code]master[/code], [code]slave[/code]). By default, properties are not exposed to networking (and RPCs). Also see [method rpc] and [method rpc_config] for methods.
+		Args: [{ false property String} { false mode int}], Returns: void
 */
 func (o *Node) RsetConfig(property gdnative.String, mode gdnative.Int) {
 	//log.Println("Calling Node.RsetConfig()")
@@ -1767,7 +1976,7 @@ func (o *Node) RsetConfig(property gdnative.String, mode gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rset_config")
+	methodBind := methodBindNodeRsetConfig.Get()
 
 	// Call the parent method.
 	// void
@@ -1777,8 +1986,8 @@ func (o *Node) RsetConfig(property gdnative.String, mode gdnative.Int) {
 }
 
 /*
-        Remotely changes the property's value on a specific peer identified by [code]peer_id[/code].
-	Args: [{ false peer_id int} { false property String} { false value Variant}], Returns: void
+	        Remotely changes the property's value on a specific peer identified by [code]peer_id[/code].
+		Args: [{ false peer_id int} { false property String} { false value Variant}], Returns: void
 */
 func (o *Node) RsetId(peerId gdnative.Int, property gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling Node.RsetId()")
@@ -1790,7 +1999,7 @@ func (o *Node) RsetId(peerId gdnative.Int, property gdnative.String, value gdnat
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rset_id")
+	methodBind := methodBindNodeRsetId.Get()
 
 	// Call the parent method.
 	// void
@@ -1800,8 +2009,8 @@ func (o *Node) RsetId(peerId gdnative.Int, property gdnative.String, value gdnat
 }
 
 /*
-        Remotely changes the property's value on other peers (and locally) using an unreliable protocol.
-	Args: [{ false property String} { false value Variant}], Returns: void
+	        Remotely changes the property's value on other peers (and locally) using an unreliable protocol.
+		Args: [{ false property String} { false value Variant}], Returns: void
 */
 func (o *Node) RsetUnreliable(property gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling Node.RsetUnreliable()")
@@ -1812,7 +2021,7 @@ func (o *Node) RsetUnreliable(property gdnative.String, value gdnative.Variant)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rset_unreliable")
+	methodBind := methodBindNodeRsetUnreliable.Get()
 
 	// Call the parent method.
 	// void
@@ -1822,8 +2031,8 @@ func (o *Node) RsetUnreliable(property gdnative.String, value gdnative.Variant)
 }
 
 /*
-        Remotely changes property's value on a specific peer identified by [code]peer_id[/code] using an unreliable protocol.
-	Args: [{ false peer_id int} { false property String} { false value Variant}], Returns: void
+	        Remotely changes property's value on a specific peer identified by [code]peer_id[/code] using an unreliable protocol.
+		Args: [{ false peer_id int} { false property String} { false value Variant}], Returns: void
 */
 func (o *Node) RsetUnreliableId(peerId gdnative.Int, property gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling Node.RsetUnreliableId()")
@@ -1835,7 +2044,7 @@ func (o *Node) RsetUnreliableId(peerId gdnative.Int, property gdnative.String, v
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "rset_unreliable_id")
+	methodBind := methodBindNodeRsetUnreliableId.Get()
 
 	// Call the parent method.
 	// void
@@ -1845,8 +2054,8 @@ func (o *Node) RsetUnreliableId(peerId gdnative.Int, property gdnative.String, v
 }
 
 /*
-        Sets the folded state of the node in the Scene dock.
-	Args: [{ false fold bool}], Returns: void
+	        Sets the folded state of the node in the Scene dock.
+		Args: [{ false fold bool}], Returns: void
 */
 func (o *Node) SetDisplayFolded(fold gdnative.Bool) {
 	//log.Println("Calling Node.SetDisplayFolded()")
@@ -1856,7 +2065,7 @@ func (o *Node) SetDisplayFolded(fold gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(fold)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_display_folded")
+	methodBind := methodBindNodeSetDisplayFolded.Get()
 
 	// Call the parent method.
 	// void
@@ -1866,8 +2075,8 @@ func (o *Node) SetDisplayFolded(fold gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false filename String}], Returns: void
+	        Undocumented
+		Args: [{ false filename String}], Returns: void
 */
 func (o *Node) SetFilename(filename gdnative.String) {
 	//log.Println("Calling Node.SetFilename()")
@@ -1877,7 +2086,7 @@ func (o *Node) SetFilename(filename gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(filename)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_filename")
+	methodBind := methodBindNodeSetFilename.Get()
 
 	// Call the parent method.
 	// void
@@ -1887,8 +2096,8 @@ func (o *Node) SetFilename(filename gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *Node) SetName(name gdnative.String) {
 	//log.Println("Calling Node.SetName()")
@@ -1898,7 +2107,7 @@ func (o *Node) SetName(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_name")
+	methodBind := methodBindNodeSetName.Get()
 
 	// Call the parent method.
 	// void
@@ -1908,8 +2117,8 @@ func (o *Node) SetName(name gdnative.String) {
 }
 
 /*
-        Sets the node's network master to the peer with the given peer ID. The network master is the peer that has authority over the node on the network. Useful in conjunction with the [code]master[/code] and [code]slave[/code] keywords. Inherited from the parent node by default, which ultimately defaults to peer ID 1 (the server). If [code]recursive[/code], the given peer is recursively set as the master for all children of this node.
-	Args: [{ false id int} {True true recursive bool}], Returns: void
+	        Sets the node's network master to the peer with the given peer ID. The network master is the peer that has authority over the node on the network. Useful in conjunction with the [code]master[/code] and [code]slave[/code] keywords. Inherited from the parent node by default, which ultimately defaults to peer ID 1 (the server). If [code]recursive[/code], the given peer is recursively set as the master for all children of this node.
+		Args: [{ false id int} {True true recursive bool}], Returns: void
 */
 func (o *Node) SetNetworkMaster(id gdnative.Int, recursive gdnative.Bool) {
 	//log.Println("Calling Node.SetNetworkMaster()")
@@ -1920,7 +2129,7 @@ func (o *Node) SetNetworkMaster(id gdnative.Int, recursive gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(recursive)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_network_master")
+	methodBind := methodBindNodeSetNetworkMaster.Get()
 
 	// Call the parent method.
 	// void
@@ -1930,18 +2139,18 @@ func (o *Node) SetNetworkMaster(id gdnative.Int, recursive gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false owner Object}], Returns: void
+	        Undocumented
+		Args: [{ false owner Object}], Returns: void
 */
 func (o *Node) SetOwner(owner ObjectImplementer) {
 	//log.Println("Calling Node.SetOwner()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(owner.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(owner))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_owner")
+	methodBind := methodBindNodeSetOwner.Get()
 
 	// Call the parent method.
 	// void
@@ -1951,18 +2160,18 @@ func (o *Node) SetOwner(owner ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Node::PauseMode}], Returns: void
 */
-func (o *Node) SetPauseMode(mode gdnative.Int) {
+func (o *Node) SetPauseMode(mode NodePauseMode) {
 	//log.Println("Calling Node.SetPauseMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_pause_mode")
+	methodBind := methodBindNodeSetPauseMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1972,8 +2181,8 @@ func (o *Node) SetPauseMode(mode gdnative.Int) {
 }
 
 /*
-        Enables or disables physics (i.e. fixed framerate) processing. When a node is being processed, it will receive a NOTIFICATION_PHYSICS_PROCESS at a fixed (usually 60 fps, see [OS] to change) interval (and the [method _physics_process] callback will be called if exists). Enabled automatically if [method _physics_process] is overridden. Any calls to this before [method _ready] will be ignored.
-	Args: [{ false enable bool}], Returns: void
+	        Enables or disables physics (i.e. fixed framerate) processing. When a node is being processed, it will receive a NOTIFICATION_PHYSICS_PROCESS at a fixed (usually 60 fps, see [OS] to change) interval (and the [method _physics_process] callback will be called if exists). Enabled automatically if [method _physics_process] is overridden. Any calls to this before [method _ready] will be ignored.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node) SetPhysicsProcess(enable gdnative.Bool) {
 	//log.Println("Calling Node.SetPhysicsProcess()")
@@ -1983,7 +2192,7 @@ func (o *Node) SetPhysicsProcess(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_physics_process")
+	methodBind := methodBindNodeSetPhysicsProcess.Get()
 
 	// Call the parent method.
 	// void
@@ -1993,8 +2202,8 @@ func (o *Node) SetPhysicsProcess(enable gdnative.Bool) {
 }
 
 /*
-        Enables or disables internal physics for this node. Internal physics processing happens in isolation from the normal [method]_physics_process[/code] calls and is used by some nodes internally to guarantee proper functioning even if the node is paused or physics processing is disabled for scripting ([method set_physics_process]). Only useful for advanced uses to manipulate built-in nodes behaviour.
-	Args: [{ false enable bool}], Returns: void
+	        Enables or disables internal physics for this node. Internal physics processing happens in isolation from the normal [method]_physics_process[/code] calls and is used by some nodes internally to guarantee proper functioning even if the node is paused or physics processing is disabled for scripting ([method set_physics_process]). Only useful for advanced uses to manipulate built-in nodes behaviour.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node) SetPhysicsProcessInternal(enable gdnative.Bool) {
 	//log.Println("Calling Node.SetPhysicsProcessInternal()")
@@ -2004,7 +2213,7 @@ func (o *Node) SetPhysicsProcessInternal(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_physics_process_internal")
+	methodBind := methodBindNodeSetPhysicsProcessInternal.Get()
 
 	// Call the parent method.
 	// void
@@ -2014,8 +2223,8 @@ func (o *Node) SetPhysicsProcessInternal(enable gdnative.Bool) {
 }
 
 /*
-        Enables or disables processing. When a node is being processed, it will receive a NOTIFICATION_PROCESS on every drawn frame (and the [method _process] callback will be called if exists). Enabled automatically if [method _process] is overridden. Any calls to this before [method _ready] will be ignored.
-	Args: [{ false enable bool}], Returns: void
+	        Enables or disables processing. When a node is being processed, it will receive a NOTIFICATION_PROCESS on every drawn frame (and the [method _process] callback will be called if exists). Enabled automatically if [method _process] is overridden. Any calls to this before [method _ready] will be ignored.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node) SetProcess(enable gdnative.Bool) {
 	//log.Println("Calling Node.SetProcess()")
@@ -2025,7 +2234,7 @@ func (o *Node) SetProcess(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_process")
+	methodBind := methodBindNodeSetProcess.Get()
 
 	// Call the parent method.
 	// void
@@ -2035,8 +2244,8 @@ func (o *Node) SetProcess(enable gdnative.Bool) {
 }
 
 /*
-        Enables or disables input processing. This is not required for GUI controls! Enabled automatically if [method _input] is overridden. Any calls to this before [method _ready] will be ignored.
-	Args: [{ false enable bool}], Returns: void
+	        Enables or disables input processing. This is not required for GUI controls! Enabled automatically if [method _input] is overridden. Any calls to this before [method _ready] will be ignored.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node) SetProcessInput(enable gdnative.Bool) {
 	//log.Println("Calling Node.SetProcessInput()")
@@ -2046,7 +2255,7 @@ func (o *Node) SetProcessInput(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_process_input")
+	methodBind := methodBindNodeSetProcessInput.Get()
 
 	// Call the parent method.
 	// void
@@ -2056,8 +2265,8 @@ func (o *Node) SetProcessInput(enable gdnative.Bool) {
 }
 
 /*
-        Enables or disabled internal processing for this node. Internal processing happens in isolation from the normal [method]_process[/code] calls and is used by some nodes internally to guarantee proper functioning even if the node is paused or processing is disabled for scripting ([method set_process]). Only useful for advanced uses to manipulate built-in nodes behaviour.
-	Args: [{ false enable bool}], Returns: void
+	        Enables or disabled internal processing for this node. Internal processing happens in isolation from the normal [method]_process[/code] calls and is used by some nodes internally to guarantee proper functioning even if the node is paused or processing is disabled for scripting ([method set_process]). Only useful for advanced uses to manipulate built-in nodes behaviour.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node) SetProcessInternal(enable gdnative.Bool) {
 	//log.Println("Calling Node.SetProcessInternal()")
@@ -2067,7 +2276,7 @@ func (o *Node) SetProcessInternal(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_process_internal")
+	methodBind := methodBindNodeSetProcessInternal.Get()
 
 	// Call the parent method.
 	// void
@@ -2077,8 +2286,8 @@ func (o *Node) SetProcessInternal(enable gdnative.Bool) {
 }
 
 /*
-        Enables unhandled input processing. This is not required for GUI controls! It enables the node to receive all input that was not previously handled (usually by a [Control]). Enabled automatically if [method _unhandled_input] is overridden. Any calls to this before [method _ready] will be ignored.
-	Args: [{ false enable bool}], Returns: void
+	        Enables unhandled input processing. This is not required for GUI controls! It enables the node to receive all input that was not previously handled (usually by a [Control]). Enabled automatically if [method _unhandled_input] is overridden. Any calls to this before [method _ready] will be ignored.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node) SetProcessUnhandledInput(enable gdnative.Bool) {
 	//log.Println("Calling Node.SetProcessUnhandledInput()")
@@ -2088,7 +2297,7 @@ func (o *Node) SetProcessUnhandledInput(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_process_unhandled_input")
+	methodBind := methodBindNodeSetProcessUnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -2098,8 +2307,8 @@ func (o *Node) SetProcessUnhandledInput(enable gdnative.Bool) {
 }
 
 /*
-        Enables unhandled key input processing. Enabled automatically if [method _unhandled_key_input] is overridden. Any calls to this before [method _ready] will be ignored.
-	Args: [{ false enable bool}], Returns: void
+	        Enables unhandled key input processing. Enabled automatically if [method _unhandled_key_input] is overridden. Any calls to this before [method _ready] will be ignored.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node) SetProcessUnhandledKeyInput(enable gdnative.Bool) {
 	//log.Println("Calling Node.SetProcessUnhandledKeyInput()")
@@ -2109,7 +2318,7 @@ func (o *Node) SetProcessUnhandledKeyInput(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_process_unhandled_key_input")
+	methodBind := methodBindNodeSetProcessUnhandledKeyInput.Get()
 
 	// Call the parent method.
 	// void
@@ -2119,8 +2328,8 @@ func (o *Node) SetProcessUnhandledKeyInput(enable gdnative.Bool) {
 }
 
 /*
-        Sets whether this is an instance load placeholder. See [InstancePlaceholder].
-	Args: [{ false load_placeholder bool}], Returns: void
+	        Sets whether this is an instance load placeholder. See [InstancePlaceholder].
+		Args: [{ false load_placeholder bool}], Returns: void
 */
 func (o *Node) SetSceneInstanceLoadPlaceholder(loadPlaceholder gdnative.Bool) {
 	//log.Println("Calling Node.SetSceneInstanceLoadPlaceholder()")
@@ -2130,7 +2339,7 @@ func (o *Node) SetSceneInstanceLoadPlaceholder(loadPlaceholder gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(loadPlaceholder)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node", "set_scene_instance_load_placeholder")
+	methodBind := methodBindNodeSetSceneInstanceLoadPlaceholder.Get()
 
 	// Call the parent method.
 	// void
@@ -2139,6 +2348,150 @@ func (o *Node) SetSceneInstanceLoadPlaceholder(loadPlaceholder gdnative.Bool) {
 
 }
 
+// AddChildWithDefaults will call AddChild using the default values for: legible_unique_name.
+func (o *Node) AddChildWithDefaults(node ObjectImplementer) {
+	o.AddChild(node, false)
+}
+
+// AddChildBelowNodeWithDefaults will call AddChildBelowNode using the default values for: legible_unique_name.
+func (o *Node) AddChildBelowNodeWithDefaults(node ObjectImplementer, childNode ObjectImplementer) {
+	o.AddChildBelowNode(node, childNode, false)
+}
+
+// AddToGroupWithDefaults will call AddToGroup using the default values for: persistent.
+func (o *Node) AddToGroupWithDefaults(group gdnative.String) {
+	o.AddToGroup(group, false)
+}
+
+// DuplicateWithDefaults will call Duplicate using the default values for: flags.
+func (o *Node) DuplicateWithDefaults() NodeImplementer {
+	return o.Duplicate(15)
+}
+
+// FindNodeWithDefaults will call FindNode using the default values for: recursive, owned.
+func (o *Node) FindNodeWithDefaults(mask gdnative.String) NodeImplementer {
+	return o.FindNode(mask, true, true)
+}
+
+// PropagateCallWithDefaults will call PropagateCall using the default values for: args, parent_first.
+func (o *Node) PropagateCallWithDefaults(method gdnative.String) {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	o.PropagateCall(method, default0, false)
+}
+
+// ReplaceByWithDefaults will call ReplaceBy using the default values for: keep_data.
+func (o *Node) ReplaceByWithDefaults(node ObjectImplementer) {
+	o.ReplaceBy(node, false)
+}
+
+// SetNetworkMasterWithDefaults will call SetNetworkMaster using the default values for: recursive.
+func (o *Node) SetNetworkMasterWithDefaults(id gdnative.Int) {
+	o.SetNetworkMaster(id, true)
+}
+
+// EditorDisplayFolded will return the value of the "editor/display_folded" property.
+func (o *Node) EditorDisplayFolded() gdnative.Bool {
+	return o.IsDisplayedFolded()
+}
+
+// SetEditorDisplayFolded will set the value of the "editor/display_folded" property.
+func (o *Node) SetEditorDisplayFolded(value gdnative.Bool) {
+	o.SetDisplayFolded(value)
+}
+
+// Filename will return the value of the "filename" property.
+func (o *Node) Filename() gdnative.String {
+	return o.GetFilename()
+}
+
+// Name will return the value of the "name" property.
+func (o *Node) Name() gdnative.String {
+	return o.GetName()
+}
+
+// Owner will return the value of the "owner" property.
+func (o *Node) Owner() NodeImplementer {
+	return o.GetOwner()
+}
+
+// PauseMode will return the value of the "pause_mode" property.
+func (o *Node) PauseMode() NodePauseMode {
+	return o.GetPauseMode()
+}
+
+// Signals of the Node class.
+const (
+	NodeSignalRenamed     gdnative.String = "renamed"
+	NodeSignalTreeEntered gdnative.String = "tree_entered"
+	NodeSignalTreeExited  gdnative.String = "tree_exited"
+	NodeSignalTreeExiting gdnative.String = "tree_exiting"
+)
+
+// ConnectRenamed will connect the "renamed" signal to the given method of the target object.
+func (o *Node) ConnectRenamed(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalRenamed, target, method, binds, 0)
+}
+
+// EmitRenamed will emit the "renamed" signal.
+func (o *Node) EmitRenamed() error {
+
+	ret, err := o.EmitSignal(NodeSignalRenamed)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTreeEntered will connect the "tree_entered" signal to the given method of the target object.
+func (o *Node) ConnectTreeEntered(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalTreeEntered, target, method, binds, 0)
+}
+
+// EmitTreeEntered will emit the "tree_entered" signal.
+func (o *Node) EmitTreeEntered() error {
+
+	ret, err := o.EmitSignal(NodeSignalTreeEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTreeExited will connect the "tree_exited" signal to the given method of the target object.
+func (o *Node) ConnectTreeExited(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalTreeExited, target, method, binds, 0)
+}
+
+// EmitTreeExited will emit the "tree_exited" signal.
+func (o *Node) EmitTreeExited() error {
+
+	ret, err := o.EmitSignal(NodeSignalTreeExited)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTreeExiting will connect the "tree_exiting" signal to the given method of the target object.
+func (o *Node) ConnectTreeExiting(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(NodeSignalTreeExiting, target, method, binds, 0)
+}
+
+// EmitTreeExiting will emit the "tree_exiting" signal.
+func (o *Node) EmitTreeExiting() error {
+
+	ret, err := o.EmitSignal(NodeSignalTreeExiting)
+	ret.Destroy()
+	return err
+}
+
 // NodeImplementer is an interface that implements the methods
 // of the Node class.
 type NodeImplementer interface {
@@ -2173,6 +2526,7 @@ type NodeImplementer interface {
 	GetParent() NodeImplementer
 	GetPath() gdnative.NodePath
 	GetPathTo(node ObjectImplementer) gdnative.NodePath
+	GetPauseMode() NodePauseMode
 	GetPhysicsProcessDeltaTime() gdnative.Real
 	GetPositionInParent() gdnative.Int
 	GetProcessDeltaTime() gdnative.Real
@@ -2206,11 +2560,11 @@ type NodeImplementer interface {
 	RemoveFromGroup(group gdnative.String)
 	ReplaceBy(node ObjectImplementer, keepData gdnative.Bool)
 	RequestReady()
-	Rpc(method gdnative.String) gdnative.Variant
+	Rpc(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
 	RpcConfig(method gdnative.String, mode gdnative.Int)
-	RpcId(peerId gdnative.Int, method gdnative.String) gdnative.Variant
-	RpcUnreliable(method gdnative.String) gdnative.Variant
-	RpcUnreliableId(peerId gdnative.Int, method gdnative.String) gdnative.Variant
+	RpcId(peerId gdnative.Int, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	RpcUnreliable(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	RpcUnreliableId(peerId gdnative.Int, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
 	Rset(property gdnative.String, value gdnative.Variant)
 	RsetConfig(property gdnative.String, mode gdnative.Int)
 	RsetId(peerId gdnative.Int, property gdnative.String, value gdnative.Variant)
@@ -2221,7 +2575,7 @@ type NodeImplementer interface {
 	SetName(name gdnative.String)
 	SetNetworkMaster(id gdnative.Int, recursive gdnative.Bool)
 	SetOwner(owner ObjectImplementer)
-	SetPauseMode(mode gdnative.Int)
+	SetPauseMode(mode NodePauseMode)
 	SetPhysicsProcess(enable gdnative.Bool)
 	SetPhysicsProcessInternal(enable gdnative.Bool)
 	SetProcess(enable gdnative.Bool)
@@ -2230,4 +2584,26 @@ type NodeImplementer interface {
 	SetProcessUnhandledInput(enable gdnative.Bool)
 	SetProcessUnhandledKeyInput(enable gdnative.Bool)
 	SetSceneInstanceLoadPlaceholder(loadPlaceholder gdnative.Bool)
+	AddChildWithDefaults(node ObjectImplementer)
+	AddChildBelowNodeWithDefaults(node ObjectImplementer, childNode ObjectImplementer)
+	AddToGroupWithDefaults(group gdnative.String)
+	DuplicateWithDefaults() NodeImplementer
+	FindNodeWithDefaults(mask gdnative.String) NodeImplementer
+	PropagateCallWithDefaults(method gdnative.String)
+	ReplaceByWithDefaults(node ObjectImplementer)
+	SetNetworkMasterWithDefaults(id gdnative.Int)
+	EditorDisplayFolded() gdnative.Bool
+	SetEditorDisplayFolded(value gdnative.Bool)
+	Filename() gdnative.String
+	Name() gdnative.String
+	Owner() NodeImplementer
+	PauseMode() NodePauseMode
+	ConnectRenamed(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRenamed() error
+	ConnectTreeEntered(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTreeEntered() error
+	ConnectTreeExited(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTreeExited() error
+	ConnectTreeExiting(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTreeExiting() error
 }
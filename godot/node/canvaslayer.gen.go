@@ -1,7 +1,8 @@
-package godot
+package node
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,39 +14,64 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCanvasLayerFromPointer(ptr gdnative.Pointer) CanvasLayer {
-func newCanvasLayerFromPointer(ptr gdnative.Pointer) CanvasLayer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CanvasLayer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Canvas drawing layer. [CanvasItem] nodes that are direct or indirect children of a [code]CanvasLayer[/code] will be drawn in that layer. The layer is a numeric index that defines the draw order. The default 2D scene renders with index 0, so a [code]CanvasLayer[/code] with index -1 will be drawn below, and one with index 1 will be drawn above. This is very useful for HUDs (in layer 1+ or above), or backgrounds (in layer -1 or below).
 */
 type CanvasLayer struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
+// classConstructorCanvasLayer is the class constructor of the CanvasLayer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCanvasLayer = gdnative.NewLazyClassConstructor("CanvasLayer")
+
+// NewCanvasLayer will create a new instance of the CanvasLayer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCanvasLayer() *CanvasLayer {
+	obj := &CanvasLayer{}
+	obj.SetBaseObject(classConstructorCanvasLayer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CanvasLayer) BaseClass() string {
 	return "CanvasLayer"
 }
 
+// Method binds of the CanvasLayer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCanvasLayerGetCustomViewport  = gdnative.NewLazyMethodBind("CanvasLayer", "get_custom_viewport")
+	methodBindCanvasLayerGetLayer           = gdnative.NewLazyMethodBind("CanvasLayer", "get_layer")
+	methodBindCanvasLayerGetOffset          = gdnative.NewLazyMethodBind("CanvasLayer", "get_offset")
+	methodBindCanvasLayerGetRotation        = gdnative.NewLazyMethodBind("CanvasLayer", "get_rotation")
+	methodBindCanvasLayerGetRotationDegrees = gdnative.NewLazyMethodBind("CanvasLayer", "get_rotation_degrees")
+	methodBindCanvasLayerGetScale           = gdnative.NewLazyMethodBind("CanvasLayer", "get_scale")
+	methodBindCanvasLayerGetTransform       = gdnative.NewLazyMethodBind("CanvasLayer", "get_transform")
+	methodBindCanvasLayerGetWorld2D         = gdnative.NewLazyMethodBind("CanvasLayer", "get_world_2d")
+	methodBindCanvasLayerSetCustomViewport  = gdnative.NewLazyMethodBind("CanvasLayer", "set_custom_viewport")
+	methodBindCanvasLayerSetLayer           = gdnative.NewLazyMethodBind("CanvasLayer", "set_layer")
+	methodBindCanvasLayerSetOffset          = gdnative.NewLazyMethodBind("CanvasLayer", "set_offset")
+	methodBindCanvasLayerSetRotation        = gdnative.NewLazyMethodBind("CanvasLayer", "set_rotation")
+	methodBindCanvasLayerSetRotationDegrees = gdnative.NewLazyMethodBind("CanvasLayer", "set_rotation_degrees")
+	methodBindCanvasLayerSetScale           = gdnative.NewLazyMethodBind("CanvasLayer", "set_scale")
+	methodBindCanvasLayerSetTransform       = gdnative.NewLazyMethodBind("CanvasLayer", "set_transform")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Node
+	        Undocumented
+		Args: [], Returns: Node
 */
-func (o *CanvasLayer) GetCustomViewport() NodeImplementer {
+func (o *CanvasLayer) GetCustomViewport() godot.NodeImplementer {
 	//log.Println("Calling CanvasLayer.GetCustomViewport()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_custom_viewport")
+	methodBind := methodBindCanvasLayerGetCustomViewport.Get()
 
 	// Call the parent method.
 	// Node
@@ -53,27 +79,25 @@ func (o *CanvasLayer) GetCustomViewport() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := godot.Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CanvasLayer) GetLayer() gdnative.Int {
 	//log.Println("Calling CanvasLayer.GetLayer()")
@@ -82,7 +106,7 @@ func (o *CanvasLayer) GetLayer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_layer")
+	methodBind := methodBindCanvasLayerGetLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -95,8 +119,8 @@ func (o *CanvasLayer) GetLayer() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *CanvasLayer) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling CanvasLayer.GetOffset()")
@@ -105,7 +129,7 @@ func (o *CanvasLayer) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_offset")
+	methodBind := methodBindCanvasLayerGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -118,8 +142,8 @@ func (o *CanvasLayer) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CanvasLayer) GetRotation() gdnative.Real {
 	//log.Println("Calling CanvasLayer.GetRotation()")
@@ -128,7 +152,7 @@ func (o *CanvasLayer) GetRotation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_rotation")
+	methodBind := methodBindCanvasLayerGetRotation.Get()
 
 	// Call the parent method.
 	// float
@@ -141,8 +165,8 @@ func (o *CanvasLayer) GetRotation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CanvasLayer) GetRotationDegrees() gdnative.Real {
 	//log.Println("Calling CanvasLayer.GetRotationDegrees()")
@@ -151,7 +175,7 @@ func (o *CanvasLayer) GetRotationDegrees() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_rotation_degrees")
+	methodBind := methodBindCanvasLayerGetRotationDegrees.Get()
 
 	// Call the parent method.
 	// float
@@ -164,8 +188,8 @@ func (o *CanvasLayer) GetRotationDegrees() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *CanvasLayer) GetScale() gdnative.Vector2 {
 	//log.Println("Calling CanvasLayer.GetScale()")
@@ -174,7 +198,7 @@ func (o *CanvasLayer) GetScale() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_scale")
+	methodBind := methodBindCanvasLayerGetScale.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -187,8 +211,8 @@ func (o *CanvasLayer) GetScale() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform2D
+	        Undocumented
+		Args: [], Returns: Transform2D
 */
 func (o *CanvasLayer) GetTransform() gdnative.Transform2D {
 	//log.Println("Calling CanvasLayer.GetTransform()")
@@ -197,7 +221,7 @@ func (o *CanvasLayer) GetTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_transform")
+	methodBind := methodBindCanvasLayerGetTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -210,17 +234,17 @@ func (o *CanvasLayer) GetTransform() gdnative.Transform2D {
 }
 
 /*
-        Return the [World2D] used by this layer.
-	Args: [], Returns: World2D
+	        Return the [World2D] used by this layer.
+		Args: [], Returns: World2D
 */
-func (o *CanvasLayer) GetWorld2D() World2DImplementer {
+func (o *CanvasLayer) GetWorld2D() godot.World2DImplementer {
 	//log.Println("Calling CanvasLayer.GetWorld2D()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "get_world_2d")
+	methodBind := methodBindCanvasLayerGetWorld2D.Get()
 
 	// Call the parent method.
 	// World2D
@@ -228,37 +252,35 @@ func (o *CanvasLayer) GetWorld2D() World2DImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newWorld2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(World2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "World2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(World2DImplementer)
+	ret := godot.World2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.World2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false viewport Object}], Returns: void
+	        Undocumented
+		Args: [{ false viewport Object}], Returns: void
 */
-func (o *CanvasLayer) SetCustomViewport(viewport ObjectImplementer) {
+func (o *CanvasLayer) SetCustomViewport(viewport godot.ObjectImplementer) {
 	//log.Println("Calling CanvasLayer.SetCustomViewport()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(viewport.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(viewport))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "set_custom_viewport")
+	methodBind := methodBindCanvasLayerSetCustomViewport.Get()
 
 	// Call the parent method.
 	// void
@@ -268,8 +290,8 @@ func (o *CanvasLayer) SetCustomViewport(viewport ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *CanvasLayer) SetLayer(layer gdnative.Int) {
 	//log.Println("Calling CanvasLayer.SetLayer()")
@@ -279,7 +301,7 @@ func (o *CanvasLayer) SetLayer(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "set_layer")
+	methodBind := methodBindCanvasLayerSetLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -289,8 +311,8 @@ func (o *CanvasLayer) SetLayer(layer gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *CanvasLayer) SetOffset(offset gdnative.Vector2) {
 	//log.Println("Calling CanvasLayer.SetOffset()")
@@ -300,7 +322,7 @@ func (o *CanvasLayer) SetOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "set_offset")
+	methodBind := methodBindCanvasLayerSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -310,8 +332,8 @@ func (o *CanvasLayer) SetOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radians float}], Returns: void
+	        Undocumented
+		Args: [{ false radians float}], Returns: void
 */
 func (o *CanvasLayer) SetRotation(radians gdnative.Real) {
 	//log.Println("Calling CanvasLayer.SetRotation()")
@@ -321,7 +343,7 @@ func (o *CanvasLayer) SetRotation(radians gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radians)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "set_rotation")
+	methodBind := methodBindCanvasLayerSetRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -331,8 +353,8 @@ func (o *CanvasLayer) SetRotation(radians gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *CanvasLayer) SetRotationDegrees(degrees gdnative.Real) {
 	//log.Println("Calling CanvasLayer.SetRotationDegrees()")
@@ -342,7 +364,7 @@ func (o *CanvasLayer) SetRotationDegrees(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "set_rotation_degrees")
+	methodBind := methodBindCanvasLayerSetRotationDegrees.Get()
 
 	// Call the parent method.
 	// void
@@ -352,8 +374,8 @@ func (o *CanvasLayer) SetRotationDegrees(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false scale Vector2}], Returns: void
 */
 func (o *CanvasLayer) SetScale(scale gdnative.Vector2) {
 	//log.Println("Calling CanvasLayer.SetScale()")
@@ -363,7 +385,7 @@ func (o *CanvasLayer) SetScale(scale gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "set_scale")
+	methodBind := methodBindCanvasLayerSetScale.Get()
 
 	// Call the parent method.
 	// void
@@ -373,8 +395,8 @@ func (o *CanvasLayer) SetScale(scale gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false transform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false transform Transform2D}], Returns: void
 */
 func (o *CanvasLayer) SetTransform(transform gdnative.Transform2D) {
 	//log.Println("Calling CanvasLayer.SetTransform()")
@@ -384,7 +406,7 @@ func (o *CanvasLayer) SetTransform(transform gdnative.Transform2D) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CanvasLayer", "set_transform")
+	methodBind := methodBindCanvasLayerSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -393,23 +415,65 @@ func (o *CanvasLayer) SetTransform(transform gdnative.Transform2D) {
 
 }
 
+// CustomViewport will return the value of the "custom_viewport" property.
+func (o *CanvasLayer) CustomViewport() godot.NodeImplementer {
+	return o.GetCustomViewport()
+}
+
+// Layer will return the value of the "layer" property.
+func (o *CanvasLayer) Layer() gdnative.Int {
+	return o.GetLayer()
+}
+
+// Offset will return the value of the "offset" property.
+func (o *CanvasLayer) Offset() gdnative.Vector2 {
+	return o.GetOffset()
+}
+
+// Rotation will return the value of the "rotation" property.
+func (o *CanvasLayer) Rotation() gdnative.Real {
+	return o.GetRotation()
+}
+
+// RotationDegrees will return the value of the "rotation_degrees" property.
+func (o *CanvasLayer) RotationDegrees() gdnative.Real {
+	return o.GetRotationDegrees()
+}
+
+// Scale will return the value of the "scale" property.
+func (o *CanvasLayer) Scale() gdnative.Vector2 {
+	return o.GetScale()
+}
+
+// Transform will return the value of the "transform" property.
+func (o *CanvasLayer) Transform() gdnative.Transform2D {
+	return o.GetTransform()
+}
+
 // CanvasLayerImplementer is an interface that implements the methods
 // of the CanvasLayer class.
 type CanvasLayerImplementer interface {
-	NodeImplementer
-	GetCustomViewport() NodeImplementer
+	godot.NodeImplementer
+	GetCustomViewport() godot.NodeImplementer
 	GetLayer() gdnative.Int
 	GetOffset() gdnative.Vector2
 	GetRotation() gdnative.Real
 	GetRotationDegrees() gdnative.Real
 	GetScale() gdnative.Vector2
 	GetTransform() gdnative.Transform2D
-	GetWorld2D() World2DImplementer
-	SetCustomViewport(viewport ObjectImplementer)
+	GetWorld2D() godot.World2DImplementer
+	SetCustomViewport(viewport godot.ObjectImplementer)
 	SetLayer(layer gdnative.Int)
 	SetOffset(offset gdnative.Vector2)
 	SetRotation(radians gdnative.Real)
 	SetRotationDegrees(degrees gdnative.Real)
 	SetScale(scale gdnative.Vector2)
 	SetTransform(transform gdnative.Transform2D)
+	CustomViewport() godot.NodeImplementer
+	Layer() gdnative.Int
+	Offset() gdnative.Vector2
+	Rotation() gdnative.Real
+	RotationDegrees() gdnative.Real
+	Scale() gdnative.Vector2
+	Transform() gdnative.Transform2D
 }
@@ -0,0 +1,55 @@
+package node
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("CanvasLayer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CanvasLayer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HTTPRequest", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HTTPRequest{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InstancePlaceholder", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InstancePlaceholder{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ParallaxBackground", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ParallaxBackground{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Timer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Timer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Tween", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Tween{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("WorldEnvironment", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &WorldEnvironment{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,11 @@
-package godot
+package node
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/reference"
 )
 
 /*------------------------------------------------------------------------------
@@ -31,30 +35,117 @@ const (
 	HTTPRequestResultSuccess                 HTTPRequestResult = 0
 )
 
-//func NewHTTPRequestFromPointer(ptr gdnative.Pointer) HTTPRequest {
-func newHTTPRequestFromPointer(ptr gdnative.Pointer) HTTPRequest {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := HTTPRequest{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the HTTPRequestResult value.
+func (e HTTPRequestResult) String() string {
+	switch e {
+	case HTTPRequestResultBodySizeLimitExceeded:
+		return "RESULT_BODY_SIZE_LIMIT_EXCEEDED"
+	case HTTPRequestResultCantConnect:
+		return "RESULT_CANT_CONNECT"
+	case HTTPRequestResultCantResolve:
+		return "RESULT_CANT_RESOLVE"
+	case HTTPRequestResultChunkedBodySizeMismatch:
+		return "RESULT_CHUNKED_BODY_SIZE_MISMATCH"
+	case HTTPRequestResultConnectionError:
+		return "RESULT_CONNECTION_ERROR"
+	case HTTPRequestResultDownloadFileCantOpen:
+		return "RESULT_DOWNLOAD_FILE_CANT_OPEN"
+	case HTTPRequestResultDownloadFileWriteError:
+		return "RESULT_DOWNLOAD_FILE_WRITE_ERROR"
+	case HTTPRequestResultNoResponse:
+		return "RESULT_NO_RESPONSE"
+	case HTTPRequestResultRedirectLimitReached:
+		return "RESULT_REDIRECT_LIMIT_REACHED"
+	case HTTPRequestResultRequestFailed:
+		return "RESULT_REQUEST_FAILED"
+	case HTTPRequestResultSslHandshakeError:
+		return "RESULT_SSL_HANDSHAKE_ERROR"
+	case HTTPRequestResultSuccess:
+		return "RESULT_SUCCESS"
+	}
+	return fmt.Sprintf("HTTPRequestResult(%d)", int(e))
+}
 
-	return obj
+// ParseHTTPRequestResult will return the HTTPRequestResult value with the given Godot name.
+func ParseHTTPRequestResult(name string) (HTTPRequestResult, error) {
+	switch name {
+	case "RESULT_BODY_SIZE_LIMIT_EXCEEDED":
+		return HTTPRequestResultBodySizeLimitExceeded, nil
+	case "RESULT_CANT_CONNECT":
+		return HTTPRequestResultCantConnect, nil
+	case "RESULT_CANT_RESOLVE":
+		return HTTPRequestResultCantResolve, nil
+	case "RESULT_CHUNKED_BODY_SIZE_MISMATCH":
+		return HTTPRequestResultChunkedBodySizeMismatch, nil
+	case "RESULT_CONNECTION_ERROR":
+		return HTTPRequestResultConnectionError, nil
+	case "RESULT_DOWNLOAD_FILE_CANT_OPEN":
+		return HTTPRequestResultDownloadFileCantOpen, nil
+	case "RESULT_DOWNLOAD_FILE_WRITE_ERROR":
+		return HTTPRequestResultDownloadFileWriteError, nil
+	case "RESULT_NO_RESPONSE":
+		return HTTPRequestResultNoResponse, nil
+	case "RESULT_REDIRECT_LIMIT_REACHED":
+		return HTTPRequestResultRedirectLimitReached, nil
+	case "RESULT_REQUEST_FAILED":
+		return HTTPRequestResultRequestFailed, nil
+	case "RESULT_SSL_HANDSHAKE_ERROR":
+		return HTTPRequestResultSslHandshakeError, nil
+	case "RESULT_SUCCESS":
+		return HTTPRequestResultSuccess, nil
+	}
+	return 0, fmt.Errorf("invalid HTTPRequestResult value %q", name)
 }
 
 /*
 A node with the ability to send HTTP requests. Uses [HTTPClient] internally. Can be used to make HTTP requests, i.e. download or upload files or web content via HTTP.
 */
 type HTTPRequest struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
+// classConstructorHTTPRequest is the class constructor of the HTTPRequest class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHTTPRequest = gdnative.NewLazyClassConstructor("HTTPRequest")
+
+// NewHTTPRequest will create a new instance of the HTTPRequest class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewHTTPRequest() *HTTPRequest {
+	obj := &HTTPRequest{}
+	obj.SetBaseObject(classConstructorHTTPRequest.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *HTTPRequest) BaseClass() string {
 	return "HTTPRequest"
 }
 
+// Method binds of the HTTPRequest class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindHTTPRequestX_RedirectRequest   = gdnative.NewLazyMethodBind("HTTPRequest", "_redirect_request")
+	methodBindHTTPRequestX_RequestDone       = gdnative.NewLazyMethodBind("HTTPRequest", "_request_done")
+	methodBindHTTPRequestCancelRequest       = gdnative.NewLazyMethodBind("HTTPRequest", "cancel_request")
+	methodBindHTTPRequestGetBodySize         = gdnative.NewLazyMethodBind("HTTPRequest", "get_body_size")
+	methodBindHTTPRequestGetBodySizeLimit    = gdnative.NewLazyMethodBind("HTTPRequest", "get_body_size_limit")
+	methodBindHTTPRequestGetDownloadFile     = gdnative.NewLazyMethodBind("HTTPRequest", "get_download_file")
+	methodBindHTTPRequestGetDownloadedBytes  = gdnative.NewLazyMethodBind("HTTPRequest", "get_downloaded_bytes")
+	methodBindHTTPRequestGetHttpClientStatus = gdnative.NewLazyMethodBind("HTTPRequest", "get_http_client_status")
+	methodBindHTTPRequestGetMaxRedirects     = gdnative.NewLazyMethodBind("HTTPRequest", "get_max_redirects")
+	methodBindHTTPRequestIsUsingThreads      = gdnative.NewLazyMethodBind("HTTPRequest", "is_using_threads")
+	methodBindHTTPRequestRequest             = gdnative.NewLazyMethodBind("HTTPRequest", "request")
+	methodBindHTTPRequestSetBodySizeLimit    = gdnative.NewLazyMethodBind("HTTPRequest", "set_body_size_limit")
+	methodBindHTTPRequestSetDownloadFile     = gdnative.NewLazyMethodBind("HTTPRequest", "set_download_file")
+	methodBindHTTPRequestSetMaxRedirects     = gdnative.NewLazyMethodBind("HTTPRequest", "set_max_redirects")
+	methodBindHTTPRequestSetUseThreads       = gdnative.NewLazyMethodBind("HTTPRequest", "set_use_threads")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 String}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
 */
 func (o *HTTPRequest) X_RedirectRequest(arg0 gdnative.String) {
 	//log.Println("Calling HTTPRequest.X_RedirectRequest()")
@@ -64,7 +155,7 @@ func (o *HTTPRequest) X_RedirectRequest(arg0 gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "_redirect_request")
+	methodBind := methodBindHTTPRequestX_RedirectRequest.Get()
 
 	// Call the parent method.
 	// void
@@ -74,8 +165,8 @@ func (o *HTTPRequest) X_RedirectRequest(arg0 gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int} { false arg1 int} { false arg2 PoolStringArray} { false arg3 PoolByteArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 int} { false arg2 PoolStringArray} { false arg3 PoolByteArray}], Returns: void
 */
 func (o *HTTPRequest) X_RequestDone(arg0 gdnative.Int, arg1 gdnative.Int, arg2 gdnative.PoolStringArray, arg3 gdnative.PoolByteArray) {
 	//log.Println("Calling HTTPRequest.X_RequestDone()")
@@ -88,7 +179,7 @@ func (o *HTTPRequest) X_RequestDone(arg0 gdnative.Int, arg1 gdnative.Int, arg2 g
 	ptrArguments[3] = gdnative.NewPointerFromPoolByteArray(arg3)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "_request_done")
+	methodBind := methodBindHTTPRequestX_RequestDone.Get()
 
 	// Call the parent method.
 	// void
@@ -98,8 +189,8 @@ func (o *HTTPRequest) X_RequestDone(arg0 gdnative.Int, arg1 gdnative.Int, arg2 g
 }
 
 /*
-        Cancels the current request.
-	Args: [], Returns: void
+	        Cancels the current request.
+		Args: [], Returns: void
 */
 func (o *HTTPRequest) CancelRequest() {
 	//log.Println("Calling HTTPRequest.CancelRequest()")
@@ -108,7 +199,7 @@ func (o *HTTPRequest) CancelRequest() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "cancel_request")
+	methodBind := methodBindHTTPRequestCancelRequest.Get()
 
 	// Call the parent method.
 	// void
@@ -118,8 +209,8 @@ func (o *HTTPRequest) CancelRequest() {
 }
 
 /*
-        Returns the response body length.
-	Args: [], Returns: int
+	        Returns the response body length.
+		Args: [], Returns: int
 */
 func (o *HTTPRequest) GetBodySize() gdnative.Int {
 	//log.Println("Calling HTTPRequest.GetBodySize()")
@@ -128,7 +219,7 @@ func (o *HTTPRequest) GetBodySize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "get_body_size")
+	methodBind := methodBindHTTPRequestGetBodySize.Get()
 
 	// Call the parent method.
 	// int
@@ -141,8 +232,8 @@ func (o *HTTPRequest) GetBodySize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *HTTPRequest) GetBodySizeLimit() gdnative.Int {
 	//log.Println("Calling HTTPRequest.GetBodySizeLimit()")
@@ -151,7 +242,7 @@ func (o *HTTPRequest) GetBodySizeLimit() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "get_body_size_limit")
+	methodBind := methodBindHTTPRequestGetBodySizeLimit.Get()
 
 	// Call the parent method.
 	// int
@@ -164,8 +255,8 @@ func (o *HTTPRequest) GetBodySizeLimit() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *HTTPRequest) GetDownloadFile() gdnative.String {
 	//log.Println("Calling HTTPRequest.GetDownloadFile()")
@@ -174,7 +265,7 @@ func (o *HTTPRequest) GetDownloadFile() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "get_download_file")
+	methodBind := methodBindHTTPRequestGetDownloadFile.Get()
 
 	// Call the parent method.
 	// String
@@ -187,8 +278,8 @@ func (o *HTTPRequest) GetDownloadFile() gdnative.String {
 }
 
 /*
-        Returns the amount of bytes this HTTPRequest downloaded.
-	Args: [], Returns: int
+	        Returns the amount of bytes this HTTPRequest downloaded.
+		Args: [], Returns: int
 */
 func (o *HTTPRequest) GetDownloadedBytes() gdnative.Int {
 	//log.Println("Calling HTTPRequest.GetDownloadedBytes()")
@@ -197,7 +288,7 @@ func (o *HTTPRequest) GetDownloadedBytes() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "get_downloaded_bytes")
+	methodBind := methodBindHTTPRequestGetDownloadedBytes.Get()
 
 	// Call the parent method.
 	// int
@@ -210,17 +301,17 @@ func (o *HTTPRequest) GetDownloadedBytes() gdnative.Int {
 }
 
 /*
-        Returns the current status of the underlying [HTTPClient]. See [code]STATUS_*[/code] enum on [HTTPClient].
-	Args: [], Returns: enum.HTTPClient::Status
+	        Returns the current status of the underlying [HTTPClient]. See [code]STATUS_*[/code] enum on [HTTPClient].
+		Args: [], Returns: enum.HTTPClient::Status
 */
-func (o *HTTPRequest) GetHttpClientStatus() HTTPClientStatus {
+func (o *HTTPRequest) GetHttpClientStatus() reference.HTTPClientStatus {
 	//log.Println("Calling HTTPRequest.GetHttpClientStatus()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "get_http_client_status")
+	methodBind := methodBindHTTPRequestGetHttpClientStatus.Get()
 
 	// Call the parent method.
 	// enum.HTTPClient::Status
@@ -229,12 +320,12 @@ func (o *HTTPRequest) GetHttpClientStatus() HTTPClientStatus {
 
 	// If we have a return type, convert it from a pointer into its actual object.
 	ret := gdnative.NewIntFromPointer(retPtr)
-	return HTTPClientStatus(ret)
+	return reference.HTTPClientStatus(ret)
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *HTTPRequest) GetMaxRedirects() gdnative.Int {
 	//log.Println("Calling HTTPRequest.GetMaxRedirects()")
@@ -243,7 +334,7 @@ func (o *HTTPRequest) GetMaxRedirects() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "get_max_redirects")
+	methodBind := methodBindHTTPRequestGetMaxRedirects.Get()
 
 	// Call the parent method.
 	// int
@@ -256,8 +347,8 @@ func (o *HTTPRequest) GetMaxRedirects() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *HTTPRequest) IsUsingThreads() gdnative.Bool {
 	//log.Println("Calling HTTPRequest.IsUsingThreads()")
@@ -266,7 +357,7 @@ func (o *HTTPRequest) IsUsingThreads() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "is_using_threads")
+	methodBind := methodBindHTTPRequestIsUsingThreads.Get()
 
 	// Call the parent method.
 	// bool
@@ -279,8 +370,7 @@ func (o *HTTPRequest) IsUsingThreads() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false url String} {[] true custom_headers PoolStringArray} {True true ssl_validate_domain bool} {0 true method int} { true request_data String}], Returns: enum.Error
+Args: [{ false url String} {[] true custom_headers PoolStringArray} {True true ssl_validate_domain bool} {0 true method int} { true request_data String}], Returns: enum.Error
 */
 func (o *HTTPRequest) Request(url gdnative.String, customHeaders gdnative.PoolStringArray, sslValidateDomain gdnative.Bool, method gdnative.Int, requestData gdnative.String) gdnative.Error {
 	//log.Println("Calling HTTPRequest.Request()")
@@ -294,7 +384,7 @@ func (o *HTTPRequest) Request(url gdnative.String, customHeaders gdnative.PoolSt
 	ptrArguments[4] = gdnative.NewPointerFromString(requestData)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "request")
+	methodBind := methodBindHTTPRequestRequest.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -307,8 +397,8 @@ func (o *HTTPRequest) Request(url gdnative.String, customHeaders gdnative.PoolSt
 }
 
 /*
-        Undocumented
-	Args: [{ false bytes int}], Returns: void
+	        Undocumented
+		Args: [{ false bytes int}], Returns: void
 */
 func (o *HTTPRequest) SetBodySizeLimit(bytes gdnative.Int) {
 	//log.Println("Calling HTTPRequest.SetBodySizeLimit()")
@@ -318,7 +408,7 @@ func (o *HTTPRequest) SetBodySizeLimit(bytes gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bytes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "set_body_size_limit")
+	methodBind := methodBindHTTPRequestSetBodySizeLimit.Get()
 
 	// Call the parent method.
 	// void
@@ -328,8 +418,8 @@ func (o *HTTPRequest) SetBodySizeLimit(bytes gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: void
+	        Undocumented
+		Args: [{ false path String}], Returns: void
 */
 func (o *HTTPRequest) SetDownloadFile(path gdnative.String) {
 	//log.Println("Calling HTTPRequest.SetDownloadFile()")
@@ -339,7 +429,7 @@ func (o *HTTPRequest) SetDownloadFile(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "set_download_file")
+	methodBind := methodBindHTTPRequestSetDownloadFile.Get()
 
 	// Call the parent method.
 	// void
@@ -349,8 +439,8 @@ func (o *HTTPRequest) SetDownloadFile(path gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *HTTPRequest) SetMaxRedirects(amount gdnative.Int) {
 	//log.Println("Calling HTTPRequest.SetMaxRedirects()")
@@ -360,7 +450,7 @@ func (o *HTTPRequest) SetMaxRedirects(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "set_max_redirects")
+	methodBind := methodBindHTTPRequestSetMaxRedirects.Get()
 
 	// Call the parent method.
 	// void
@@ -370,8 +460,8 @@ func (o *HTTPRequest) SetMaxRedirects(amount gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *HTTPRequest) SetUseThreads(enable gdnative.Bool) {
 	//log.Println("Calling HTTPRequest.SetUseThreads()")
@@ -381,7 +471,7 @@ func (o *HTTPRequest) SetUseThreads(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("HTTPRequest", "set_use_threads")
+	methodBind := methodBindHTTPRequestSetUseThreads.Get()
 
 	// Call the parent method.
 	// void
@@ -390,10 +480,74 @@ func (o *HTTPRequest) SetUseThreads(enable gdnative.Bool) {
 
 }
 
+// RequestWithDefaults will call Request using the default values for: custom_headers, ssl_validate_domain, method, request_data.
+func (o *HTTPRequest) RequestWithDefaults(url gdnative.String) gdnative.Error {
+	default0 := gdnative.NewPoolStringArray()
+	defer default0.Destroy()
+	return o.Request(url, default0, true, 0, "")
+}
+
+// BodySizeLimit will return the value of the "body_size_limit" property.
+func (o *HTTPRequest) BodySizeLimit() gdnative.Int {
+	return o.GetBodySizeLimit()
+}
+
+// DownloadFile will return the value of the "download_file" property.
+func (o *HTTPRequest) DownloadFile() gdnative.String {
+	return o.GetDownloadFile()
+}
+
+// MaxRedirects will return the value of the "max_redirects" property.
+func (o *HTTPRequest) MaxRedirects() gdnative.Int {
+	return o.GetMaxRedirects()
+}
+
+// UseThreads will return the value of the "use_threads" property.
+func (o *HTTPRequest) UseThreads() gdnative.Bool {
+	return o.IsUsingThreads()
+}
+
+// Signals of the HTTPRequest class.
+const (
+	HTTPRequestSignalRequestCompleted gdnative.String = "request_completed"
+)
+
+// HTTPRequestRequestCompletedArgs holds the arguments of the "request_completed" signal.
+type HTTPRequestRequestCompletedArgs struct {
+	Result       gdnative.Int
+	ResponseCode gdnative.Int
+	Headers      gdnative.PoolStringArray
+	Body         gdnative.PoolByteArray
+}
+
+// ConnectRequestCompleted will connect the "request_completed" signal to the given method of the target object.
+func (o *HTTPRequest) ConnectRequestCompleted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(HTTPRequestSignalRequestCompleted, target, method, binds, 0)
+}
+
+// EmitRequestCompleted will emit the "request_completed" signal.
+func (o *HTTPRequest) EmitRequestCompleted(args HTTPRequestRequestCompletedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Result))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.ResponseCode))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantPoolStringArray(args.Headers)
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantPoolByteArray(args.Body)
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(HTTPRequestSignalRequestCompleted, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
 // HTTPRequestImplementer is an interface that implements the methods
 // of the HTTPRequest class.
 type HTTPRequestImplementer interface {
-	NodeImplementer
+	godot.NodeImplementer
 	X_RedirectRequest(arg0 gdnative.String)
 	X_RequestDone(arg0 gdnative.Int, arg1 gdnative.Int, arg2 gdnative.PoolStringArray, arg3 gdnative.PoolByteArray)
 	CancelRequest()
@@ -401,10 +555,19 @@ type HTTPRequestImplementer interface {
 	GetBodySizeLimit() gdnative.Int
 	GetDownloadFile() gdnative.String
 	GetDownloadedBytes() gdnative.Int
+	GetHttpClientStatus() reference.HTTPClientStatus
 	GetMaxRedirects() gdnative.Int
 	IsUsingThreads() gdnative.Bool
+	Request(url gdnative.String, customHeaders gdnative.PoolStringArray, sslValidateDomain gdnative.Bool, method gdnative.Int, requestData gdnative.String) gdnative.Error
 	SetBodySizeLimit(bytes gdnative.Int)
 	SetDownloadFile(path gdnative.String)
 	SetMaxRedirects(amount gdnative.Int)
 	SetUseThreads(enable gdnative.Bool)
+	RequestWithDefaults(url gdnative.String) gdnative.Error
+	BodySizeLimit() gdnative.Int
+	DownloadFile() gdnative.String
+	MaxRedirects() gdnative.Int
+	UseThreads() gdnative.Bool
+	ConnectRequestCompleted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRequestCompleted(args HTTPRequestRequestCompletedArgs) error
 }
@@ -1,7 +1,8 @@
-package godot
+package node
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInstancePlaceholderFromPointer(ptr gdnative.Pointer) InstancePlaceholder {
-func newInstancePlaceholderFromPointer(ptr gdnative.Pointer) InstancePlaceholder {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InstancePlaceholder{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Turning on the option [b]Load As Placeholder[/b] for an instanced scene in the editor causes it to be replaced by an InstacePlaceholder when running the game. This makes it possible to delay actually loading the scene until calling [method replace_by_instance]. This is useful to avoid loading large scenes all at once by loading parts of it selectively. The InstancePlaceholder does not have a transform. This causes any child nodes to be positioned relatively to the Viewport from point (0,0), rather than their parent as displayed in the editor. Replacing the placeholder with a scene with a transform will transform children relatively to their parent again.
 */
 type InstancePlaceholder struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
@@ -34,9 +26,17 @@ func (o *InstancePlaceholder) BaseClass() string {
 	return "InstancePlaceholder"
 }
 
+// Method binds of the InstancePlaceholder class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInstancePlaceholderGetInstancePath   = gdnative.NewLazyMethodBind("InstancePlaceholder", "get_instance_path")
+	methodBindInstancePlaceholderGetStoredValues   = gdnative.NewLazyMethodBind("InstancePlaceholder", "get_stored_values")
+	methodBindInstancePlaceholderReplaceByInstance = gdnative.NewLazyMethodBind("InstancePlaceholder", "replace_by_instance")
+)
+
 /*
-        Retrieve the path to the [PackedScene] resource file that is loaded by default when calling [method replace_by_instance].
-	Args: [], Returns: String
+	        Retrieve the path to the [PackedScene] resource file that is loaded by default when calling [method replace_by_instance].
+		Args: [], Returns: String
 */
 func (o *InstancePlaceholder) GetInstancePath() gdnative.String {
 	//log.Println("Calling InstancePlaceholder.GetInstancePath()")
@@ -45,7 +45,7 @@ func (o *InstancePlaceholder) GetInstancePath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InstancePlaceholder", "get_instance_path")
+	methodBind := methodBindInstancePlaceholderGetInstancePath.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +58,7 @@ func (o *InstancePlaceholder) GetInstancePath() gdnative.String {
 }
 
 /*
-
-	Args: [{False true with_order bool}], Returns: Dictionary
+Args: [{False true with_order bool}], Returns: Dictionary
 */
 func (o *InstancePlaceholder) GetStoredValues(withOrder gdnative.Bool) gdnative.Dictionary {
 	//log.Println("Calling InstancePlaceholder.GetStoredValues()")
@@ -69,7 +68,7 @@ func (o *InstancePlaceholder) GetStoredValues(withOrder gdnative.Bool) gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromBool(withOrder)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InstancePlaceholder", "get_stored_values")
+	methodBind := methodBindInstancePlaceholderGetStoredValues.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -82,18 +81,18 @@ func (o *InstancePlaceholder) GetStoredValues(withOrder gdnative.Bool) gdnative.
 }
 
 /*
-        Replace this placeholder by the scene handed as an argument, or the original scene if no argument is given. As for all resources, the scene is loaded only if it's not loaded already. By manually loading the scene beforehand, delays caused by this function can be avoided.
-	Args: [{Null true custom_scene PackedScene}], Returns: void
+	        Replace this placeholder by the scene handed as an argument, or the original scene if no argument is given. As for all resources, the scene is loaded only if it's not loaded already. By manually loading the scene beforehand, delays caused by this function can be avoided.
+		Args: [{Null true custom_scene PackedScene}], Returns: void
 */
-func (o *InstancePlaceholder) ReplaceByInstance(customScene PackedSceneImplementer) {
+func (o *InstancePlaceholder) ReplaceByInstance(customScene godot.PackedSceneImplementer) {
 	//log.Println("Calling InstancePlaceholder.ReplaceByInstance()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(customScene.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(customScene))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InstancePlaceholder", "replace_by_instance")
+	methodBind := methodBindInstancePlaceholderReplaceByInstance.Get()
 
 	// Call the parent method.
 	// void
@@ -102,11 +101,23 @@ func (o *InstancePlaceholder) ReplaceByInstance(customScene PackedSceneImplement
 
 }
 
+// GetStoredValuesWithDefaults will call GetStoredValues using the default values for: with_order.
+func (o *InstancePlaceholder) GetStoredValuesWithDefaults() gdnative.Dictionary {
+	return o.GetStoredValues(false)
+}
+
+// ReplaceByInstanceWithDefaults will call ReplaceByInstance using the default values for: custom_scene.
+func (o *InstancePlaceholder) ReplaceByInstanceWithDefaults() {
+	o.ReplaceByInstance(nil)
+}
+
 // InstancePlaceholderImplementer is an interface that implements the methods
 // of the InstancePlaceholder class.
 type InstancePlaceholderImplementer interface {
-	NodeImplementer
+	godot.NodeImplementer
 	GetInstancePath() gdnative.String
 	GetStoredValues(withOrder gdnative.Bool) gdnative.Dictionary
-	ReplaceByInstance(customScene PackedSceneImplementer)
+	ReplaceByInstance(customScene godot.PackedSceneImplementer)
+	GetStoredValuesWithDefaults() gdnative.Dictionary
+	ReplaceByInstanceWithDefaults()
 }
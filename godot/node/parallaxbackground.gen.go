@@ -1,7 +1,8 @@
-package godot
+package node
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewParallaxBackgroundFromPointer(ptr gdnative.Pointer) ParallaxBackground {
-func newParallaxBackgroundFromPointer(ptr gdnative.Pointer) ParallaxBackground {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ParallaxBackground{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A ParallaxBackground uses one or more [ParallaxLayer] child nodes to create a parallax effect. Each [ParallaxLayer] can move at a different speed using [member ParallaxLayer.motion_offset]. This creates an illusion of depth in a 2D game. If not used with a [Camera2D], you must manually calculate the [member scroll_offset].
 */
@@ -30,13 +22,45 @@ type ParallaxBackground struct {
 	owner gdnative.Object
 }
 
+// classConstructorParallaxBackground is the class constructor of the ParallaxBackground class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorParallaxBackground = gdnative.NewLazyClassConstructor("ParallaxBackground")
+
+// NewParallaxBackground will create a new instance of the ParallaxBackground class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewParallaxBackground() *ParallaxBackground {
+	obj := &ParallaxBackground{}
+	obj.SetBaseObject(classConstructorParallaxBackground.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ParallaxBackground) BaseClass() string {
 	return "ParallaxBackground"
 }
 
+// Method binds of the ParallaxBackground class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindParallaxBackgroundX_CameraMoved       = gdnative.NewLazyMethodBind("ParallaxBackground", "_camera_moved")
+	methodBindParallaxBackgroundGetLimitBegin       = gdnative.NewLazyMethodBind("ParallaxBackground", "get_limit_begin")
+	methodBindParallaxBackgroundGetLimitEnd         = gdnative.NewLazyMethodBind("ParallaxBackground", "get_limit_end")
+	methodBindParallaxBackgroundGetScrollBaseOffset = gdnative.NewLazyMethodBind("ParallaxBackground", "get_scroll_base_offset")
+	methodBindParallaxBackgroundGetScrollBaseScale  = gdnative.NewLazyMethodBind("ParallaxBackground", "get_scroll_base_scale")
+	methodBindParallaxBackgroundGetScrollOffset     = gdnative.NewLazyMethodBind("ParallaxBackground", "get_scroll_offset")
+	methodBindParallaxBackgroundIsIgnoreCameraZoom  = gdnative.NewLazyMethodBind("ParallaxBackground", "is_ignore_camera_zoom")
+	methodBindParallaxBackgroundSetIgnoreCameraZoom = gdnative.NewLazyMethodBind("ParallaxBackground", "set_ignore_camera_zoom")
+	methodBindParallaxBackgroundSetLimitBegin       = gdnative.NewLazyMethodBind("ParallaxBackground", "set_limit_begin")
+	methodBindParallaxBackgroundSetLimitEnd         = gdnative.NewLazyMethodBind("ParallaxBackground", "set_limit_end")
+	methodBindParallaxBackgroundSetScrollBaseOffset = gdnative.NewLazyMethodBind("ParallaxBackground", "set_scroll_base_offset")
+	methodBindParallaxBackgroundSetScrollBaseScale  = gdnative.NewLazyMethodBind("ParallaxBackground", "set_scroll_base_scale")
+	methodBindParallaxBackgroundSetScrollOffset     = gdnative.NewLazyMethodBind("ParallaxBackground", "set_scroll_offset")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 Transform2D} { false arg1 Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Transform2D} { false arg1 Vector2}], Returns: void
 */
 func (o *ParallaxBackground) X_CameraMoved(arg0 gdnative.Transform2D, arg1 gdnative.Vector2) {
 	//log.Println("Calling ParallaxBackground.X_CameraMoved()")
@@ -47,7 +71,7 @@ func (o *ParallaxBackground) X_CameraMoved(arg0 gdnative.Transform2D, arg1 gdnat
 	ptrArguments[1] = gdnative.NewPointerFromVector2(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "_camera_moved")
+	methodBind := methodBindParallaxBackgroundX_CameraMoved.Get()
 
 	// Call the parent method.
 	// void
@@ -57,8 +81,8 @@ func (o *ParallaxBackground) X_CameraMoved(arg0 gdnative.Transform2D, arg1 gdnat
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxBackground) GetLimitBegin() gdnative.Vector2 {
 	//log.Println("Calling ParallaxBackground.GetLimitBegin()")
@@ -67,7 +91,7 @@ func (o *ParallaxBackground) GetLimitBegin() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "get_limit_begin")
+	methodBind := methodBindParallaxBackgroundGetLimitBegin.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -80,8 +104,8 @@ func (o *ParallaxBackground) GetLimitBegin() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxBackground) GetLimitEnd() gdnative.Vector2 {
 	//log.Println("Calling ParallaxBackground.GetLimitEnd()")
@@ -90,7 +114,7 @@ func (o *ParallaxBackground) GetLimitEnd() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "get_limit_end")
+	methodBind := methodBindParallaxBackgroundGetLimitEnd.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -103,8 +127,8 @@ func (o *ParallaxBackground) GetLimitEnd() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxBackground) GetScrollBaseOffset() gdnative.Vector2 {
 	//log.Println("Calling ParallaxBackground.GetScrollBaseOffset()")
@@ -113,7 +137,7 @@ func (o *ParallaxBackground) GetScrollBaseOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "get_scroll_base_offset")
+	methodBind := methodBindParallaxBackgroundGetScrollBaseOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -126,8 +150,8 @@ func (o *ParallaxBackground) GetScrollBaseOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxBackground) GetScrollBaseScale() gdnative.Vector2 {
 	//log.Println("Calling ParallaxBackground.GetScrollBaseScale()")
@@ -136,7 +160,7 @@ func (o *ParallaxBackground) GetScrollBaseScale() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "get_scroll_base_scale")
+	methodBind := methodBindParallaxBackgroundGetScrollBaseScale.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -149,8 +173,8 @@ func (o *ParallaxBackground) GetScrollBaseScale() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *ParallaxBackground) GetScrollOffset() gdnative.Vector2 {
 	//log.Println("Calling ParallaxBackground.GetScrollOffset()")
@@ -159,7 +183,7 @@ func (o *ParallaxBackground) GetScrollOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "get_scroll_offset")
+	methodBind := methodBindParallaxBackgroundGetScrollOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -172,8 +196,8 @@ func (o *ParallaxBackground) GetScrollOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ParallaxBackground) IsIgnoreCameraZoom() gdnative.Bool {
 	//log.Println("Calling ParallaxBackground.IsIgnoreCameraZoom()")
@@ -182,7 +206,7 @@ func (o *ParallaxBackground) IsIgnoreCameraZoom() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "is_ignore_camera_zoom")
+	methodBind := methodBindParallaxBackgroundIsIgnoreCameraZoom.Get()
 
 	// Call the parent method.
 	// bool
@@ -195,8 +219,8 @@ func (o *ParallaxBackground) IsIgnoreCameraZoom() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false ignore bool}], Returns: void
+	        Undocumented
+		Args: [{ false ignore bool}], Returns: void
 */
 func (o *ParallaxBackground) SetIgnoreCameraZoom(ignore gdnative.Bool) {
 	//log.Println("Calling ParallaxBackground.SetIgnoreCameraZoom()")
@@ -206,7 +230,7 @@ func (o *ParallaxBackground) SetIgnoreCameraZoom(ignore gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(ignore)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "set_ignore_camera_zoom")
+	methodBind := methodBindParallaxBackgroundSetIgnoreCameraZoom.Get()
 
 	// Call the parent method.
 	// void
@@ -216,8 +240,8 @@ func (o *ParallaxBackground) SetIgnoreCameraZoom(ignore gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false ofs Vector2}], Returns: void
 */
 func (o *ParallaxBackground) SetLimitBegin(ofs gdnative.Vector2) {
 	//log.Println("Calling ParallaxBackground.SetLimitBegin()")
@@ -227,7 +251,7 @@ func (o *ParallaxBackground) SetLimitBegin(ofs gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "set_limit_begin")
+	methodBind := methodBindParallaxBackgroundSetLimitBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -237,8 +261,8 @@ func (o *ParallaxBackground) SetLimitBegin(ofs gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false ofs Vector2}], Returns: void
 */
 func (o *ParallaxBackground) SetLimitEnd(ofs gdnative.Vector2) {
 	//log.Println("Calling ParallaxBackground.SetLimitEnd()")
@@ -248,7 +272,7 @@ func (o *ParallaxBackground) SetLimitEnd(ofs gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "set_limit_end")
+	methodBind := methodBindParallaxBackgroundSetLimitEnd.Get()
 
 	// Call the parent method.
 	// void
@@ -258,8 +282,8 @@ func (o *ParallaxBackground) SetLimitEnd(ofs gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false ofs Vector2}], Returns: void
 */
 func (o *ParallaxBackground) SetScrollBaseOffset(ofs gdnative.Vector2) {
 	//log.Println("Calling ParallaxBackground.SetScrollBaseOffset()")
@@ -269,7 +293,7 @@ func (o *ParallaxBackground) SetScrollBaseOffset(ofs gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "set_scroll_base_offset")
+	methodBind := methodBindParallaxBackgroundSetScrollBaseOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -279,8 +303,8 @@ func (o *ParallaxBackground) SetScrollBaseOffset(ofs gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false scale Vector2}], Returns: void
 */
 func (o *ParallaxBackground) SetScrollBaseScale(scale gdnative.Vector2) {
 	//log.Println("Calling ParallaxBackground.SetScrollBaseScale()")
@@ -290,7 +314,7 @@ func (o *ParallaxBackground) SetScrollBaseScale(scale gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "set_scroll_base_scale")
+	methodBind := methodBindParallaxBackgroundSetScrollBaseScale.Get()
 
 	// Call the parent method.
 	// void
@@ -300,8 +324,8 @@ func (o *ParallaxBackground) SetScrollBaseScale(scale gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ofs Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false ofs Vector2}], Returns: void
 */
 func (o *ParallaxBackground) SetScrollOffset(ofs gdnative.Vector2) {
 	//log.Println("Calling ParallaxBackground.SetScrollOffset()")
@@ -311,7 +335,7 @@ func (o *ParallaxBackground) SetScrollOffset(ofs gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ParallaxBackground", "set_scroll_offset")
+	methodBind := methodBindParallaxBackgroundSetScrollOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -320,6 +344,51 @@ func (o *ParallaxBackground) SetScrollOffset(ofs gdnative.Vector2) {
 
 }
 
+// ScrollBaseOffset will return the value of the "scroll_base_offset" property.
+func (o *ParallaxBackground) ScrollBaseOffset() gdnative.Vector2 {
+	return o.GetScrollBaseOffset()
+}
+
+// ScrollBaseScale will return the value of the "scroll_base_scale" property.
+func (o *ParallaxBackground) ScrollBaseScale() gdnative.Vector2 {
+	return o.GetScrollBaseScale()
+}
+
+// ScrollIgnoreCameraZoom will return the value of the "scroll_ignore_camera_zoom" property.
+func (o *ParallaxBackground) ScrollIgnoreCameraZoom() gdnative.Bool {
+	return o.IsIgnoreCameraZoom()
+}
+
+// SetScrollIgnoreCameraZoom will set the value of the "scroll_ignore_camera_zoom" property.
+func (o *ParallaxBackground) SetScrollIgnoreCameraZoom(value gdnative.Bool) {
+	o.SetIgnoreCameraZoom(value)
+}
+
+// ScrollLimitBegin will return the value of the "scroll_limit_begin" property.
+func (o *ParallaxBackground) ScrollLimitBegin() gdnative.Vector2 {
+	return o.GetLimitBegin()
+}
+
+// SetScrollLimitBegin will set the value of the "scroll_limit_begin" property.
+func (o *ParallaxBackground) SetScrollLimitBegin(value gdnative.Vector2) {
+	o.SetLimitBegin(value)
+}
+
+// ScrollLimitEnd will return the value of the "scroll_limit_end" property.
+func (o *ParallaxBackground) ScrollLimitEnd() gdnative.Vector2 {
+	return o.GetLimitEnd()
+}
+
+// SetScrollLimitEnd will set the value of the "scroll_limit_end" property.
+func (o *ParallaxBackground) SetScrollLimitEnd(value gdnative.Vector2) {
+	o.SetLimitEnd(value)
+}
+
+// ScrollOffset will return the value of the "scroll_offset" property.
+func (o *ParallaxBackground) ScrollOffset() gdnative.Vector2 {
+	return o.GetScrollOffset()
+}
+
 // ParallaxBackgroundImplementer is an interface that implements the methods
 // of the ParallaxBackground class.
 type ParallaxBackgroundImplementer interface {
@@ -337,4 +406,13 @@ type ParallaxBackgroundImplementer interface {
 	SetScrollBaseOffset(ofs gdnative.Vector2)
 	SetScrollBaseScale(scale gdnative.Vector2)
 	SetScrollOffset(ofs gdnative.Vector2)
+	ScrollBaseOffset() gdnative.Vector2
+	ScrollBaseScale() gdnative.Vector2
+	ScrollIgnoreCameraZoom() gdnative.Bool
+	SetScrollIgnoreCameraZoom(value gdnative.Bool)
+	ScrollLimitBegin() gdnative.Vector2
+	SetScrollLimitBegin(value gdnative.Vector2)
+	ScrollLimitEnd() gdnative.Vector2
+	SetScrollLimitEnd(value gdnative.Vector2)
+	ScrollOffset() gdnative.Vector2
 }
@@ -0,0 +1,483 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TimerTimerProcessMode is an enum for TimerProcessMode values.
+type TimerTimerProcessMode int
+
+const (
+	TimerTimerProcessIdle    TimerTimerProcessMode = 1
+	TimerTimerProcessPhysics TimerTimerProcessMode = 0
+)
+
+// String will return the Godot name of the TimerTimerProcessMode value.
+func (e TimerTimerProcessMode) String() string {
+	switch e {
+	case TimerTimerProcessIdle:
+		return "TIMER_PROCESS_IDLE"
+	case TimerTimerProcessPhysics:
+		return "TIMER_PROCESS_PHYSICS"
+	}
+	return fmt.Sprintf("TimerTimerProcessMode(%d)", int(e))
+}
+
+// ParseTimerTimerProcessMode will return the TimerTimerProcessMode value with the given Godot name.
+func ParseTimerTimerProcessMode(name string) (TimerTimerProcessMode, error) {
+	switch name {
+	case "TIMER_PROCESS_IDLE":
+		return TimerTimerProcessIdle, nil
+	case "TIMER_PROCESS_PHYSICS":
+		return TimerTimerProcessPhysics, nil
+	}
+	return 0, fmt.Errorf("invalid TimerTimerProcessMode value %q", name)
+}
+
+/*
+Counts down a specified interval and emits a signal on reaching 0. Can be set to repeat or "one shot" mode.
+*/
+type Timer struct {
+	godot.Node
+	owner gdnative.Object
+}
+
+// classConstructorTimer is the class constructor of the Timer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTimer = gdnative.NewLazyClassConstructor("Timer")
+
+// NewTimer will create a new instance of the Timer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTimer() *Timer {
+	obj := &Timer{}
+	obj.SetBaseObject(classConstructorTimer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Timer) BaseClass() string {
+	return "Timer"
+}
+
+// Method binds of the Timer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTimerGetTimeLeft         = gdnative.NewLazyMethodBind("Timer", "get_time_left")
+	methodBindTimerGetTimerProcessMode = gdnative.NewLazyMethodBind("Timer", "get_timer_process_mode")
+	methodBindTimerGetWaitTime         = gdnative.NewLazyMethodBind("Timer", "get_wait_time")
+	methodBindTimerHasAutostart        = gdnative.NewLazyMethodBind("Timer", "has_autostart")
+	methodBindTimerIsOneShot           = gdnative.NewLazyMethodBind("Timer", "is_one_shot")
+	methodBindTimerIsPaused            = gdnative.NewLazyMethodBind("Timer", "is_paused")
+	methodBindTimerIsStopped           = gdnative.NewLazyMethodBind("Timer", "is_stopped")
+	methodBindTimerSetAutostart        = gdnative.NewLazyMethodBind("Timer", "set_autostart")
+	methodBindTimerSetOneShot          = gdnative.NewLazyMethodBind("Timer", "set_one_shot")
+	methodBindTimerSetPaused           = gdnative.NewLazyMethodBind("Timer", "set_paused")
+	methodBindTimerSetTimerProcessMode = gdnative.NewLazyMethodBind("Timer", "set_timer_process_mode")
+	methodBindTimerSetWaitTime         = gdnative.NewLazyMethodBind("Timer", "set_wait_time")
+	methodBindTimerStart               = gdnative.NewLazyMethodBind("Timer", "start")
+	methodBindTimerStop                = gdnative.NewLazyMethodBind("Timer", "stop")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Timer) GetTimeLeft() gdnative.Real {
+	//log.Println("Calling Timer.GetTimeLeft()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerGetTimeLeft.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Timer::TimerProcessMode
+*/
+func (o *Timer) GetTimerProcessMode() TimerTimerProcessMode {
+	//log.Println("Calling Timer.GetTimerProcessMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerGetTimerProcessMode.Get()
+
+	// Call the parent method.
+	// enum.Timer::TimerProcessMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TimerTimerProcessMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Timer) GetWaitTime() gdnative.Real {
+	//log.Println("Calling Timer.GetWaitTime()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerGetWaitTime.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Timer) HasAutostart() gdnative.Bool {
+	//log.Println("Calling Timer.HasAutostart()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerHasAutostart.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Timer) IsOneShot() gdnative.Bool {
+	//log.Println("Calling Timer.IsOneShot()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerIsOneShot.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Timer) IsPaused() gdnative.Bool {
+	//log.Println("Calling Timer.IsPaused()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerIsPaused.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns [code]true[/code] if the timer is stopped.
+		Args: [], Returns: bool
+*/
+func (o *Timer) IsStopped() gdnative.Bool {
+	//log.Println("Calling Timer.IsStopped()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerIsStopped.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Timer) SetAutostart(enable gdnative.Bool) {
+	//log.Println("Calling Timer.SetAutostart()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindTimerSetAutostart.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Timer) SetOneShot(enable gdnative.Bool) {
+	//log.Println("Calling Timer.SetOneShot()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindTimerSetOneShot.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false paused bool}], Returns: void
+*/
+func (o *Timer) SetPaused(paused gdnative.Bool) {
+	//log.Println("Calling Timer.SetPaused()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(paused)
+
+	// Get the method bind
+	methodBind := methodBindTimerSetPaused.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.Timer::TimerProcessMode}], Returns: void
+*/
+func (o *Timer) SetTimerProcessMode(mode TimerTimerProcessMode) {
+	//log.Println("Calling Timer.SetTimerProcessMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindTimerSetTimerProcessMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false time_sec float}], Returns: void
+*/
+func (o *Timer) SetWaitTime(timeSec gdnative.Real) {
+	//log.Println("Calling Timer.SetWaitTime()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(timeSec)
+
+	// Get the method bind
+	methodBind := methodBindTimerSetWaitTime.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Starts the timer. This also resets the remaining time to [code]wait_time[/code]. Note: this method will not resume a paused timer. See [method set_paused].
+		Args: [], Returns: void
+*/
+func (o *Timer) Start() {
+	//log.Println("Calling Timer.Start()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerStart.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Stop (cancel) the Timer.
+		Args: [], Returns: void
+*/
+func (o *Timer) Stop() {
+	//log.Println("Calling Timer.Stop()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTimerStop.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Autostart will return the value of the "autostart" property.
+func (o *Timer) Autostart() gdnative.Bool {
+	return o.HasAutostart()
+}
+
+// OneShot will return the value of the "one_shot" property.
+func (o *Timer) OneShot() gdnative.Bool {
+	return o.IsOneShot()
+}
+
+// Paused will return the value of the "paused" property.
+func (o *Timer) Paused() gdnative.Bool {
+	return o.IsPaused()
+}
+
+// ProcessMode will return the value of the "process_mode" property.
+func (o *Timer) ProcessMode() TimerTimerProcessMode {
+	return o.GetTimerProcessMode()
+}
+
+// SetProcessMode will set the value of the "process_mode" property.
+func (o *Timer) SetProcessMode(value TimerTimerProcessMode) {
+	o.SetTimerProcessMode(value)
+}
+
+// TimeLeft will return the value of the "time_left" property.
+func (o *Timer) TimeLeft() gdnative.Real {
+	return o.GetTimeLeft()
+}
+
+// WaitTime will return the value of the "wait_time" property.
+func (o *Timer) WaitTime() gdnative.Real {
+	return o.GetWaitTime()
+}
+
+// Signals of the Timer class.
+const (
+	TimerSignalTimeout gdnative.String = "timeout"
+)
+
+// ConnectTimeout will connect the "timeout" signal to the given method of the target object.
+func (o *Timer) ConnectTimeout(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TimerSignalTimeout, target, method, binds, 0)
+}
+
+// EmitTimeout will emit the "timeout" signal.
+func (o *Timer) EmitTimeout() error {
+
+	ret, err := o.EmitSignal(TimerSignalTimeout)
+	ret.Destroy()
+	return err
+}
+
+// TimerImplementer is an interface that implements the methods
+// of the Timer class.
+type TimerImplementer interface {
+	godot.NodeImplementer
+	GetTimeLeft() gdnative.Real
+	GetTimerProcessMode() TimerTimerProcessMode
+	GetWaitTime() gdnative.Real
+	HasAutostart() gdnative.Bool
+	IsOneShot() gdnative.Bool
+	IsPaused() gdnative.Bool
+	IsStopped() gdnative.Bool
+	SetAutostart(enable gdnative.Bool)
+	SetOneShot(enable gdnative.Bool)
+	SetPaused(paused gdnative.Bool)
+	SetTimerProcessMode(mode TimerTimerProcessMode)
+	SetWaitTime(timeSec gdnative.Real)
+	Start()
+	Stop()
+	Autostart() gdnative.Bool
+	OneShot() gdnative.Bool
+	Paused() gdnative.Bool
+	ProcessMode() TimerTimerProcessMode
+	SetProcessMode(value TimerTimerProcessMode)
+	TimeLeft() gdnative.Real
+	WaitTime() gdnative.Real
+	ConnectTimeout(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTimeout() error
+}
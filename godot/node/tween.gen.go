@@ -0,0 +1,1212 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TweenEaseType is an enum for EaseType values.
+type TweenEaseType int
+
+const (
+	TweenEaseIn    TweenEaseType = 0
+	TweenEaseInOut TweenEaseType = 2
+	TweenEaseOut   TweenEaseType = 1
+	TweenEaseOutIn TweenEaseType = 3
+)
+
+// String will return the Godot name of the TweenEaseType value.
+func (e TweenEaseType) String() string {
+	switch e {
+	case TweenEaseIn:
+		return "EASE_IN"
+	case TweenEaseInOut:
+		return "EASE_IN_OUT"
+	case TweenEaseOut:
+		return "EASE_OUT"
+	case TweenEaseOutIn:
+		return "EASE_OUT_IN"
+	}
+	return fmt.Sprintf("TweenEaseType(%d)", int(e))
+}
+
+// ParseTweenEaseType will return the TweenEaseType value with the given Godot name.
+func ParseTweenEaseType(name string) (TweenEaseType, error) {
+	switch name {
+	case "EASE_IN":
+		return TweenEaseIn, nil
+	case "EASE_IN_OUT":
+		return TweenEaseInOut, nil
+	case "EASE_OUT":
+		return TweenEaseOut, nil
+	case "EASE_OUT_IN":
+		return TweenEaseOutIn, nil
+	}
+	return 0, fmt.Errorf("invalid TweenEaseType value %q", name)
+}
+
+// TweenTransitionType is an enum for TransitionType values.
+type TweenTransitionType int
+
+const (
+	TweenTransBack    TweenTransitionType = 10
+	TweenTransBounce  TweenTransitionType = 9
+	TweenTransCirc    TweenTransitionType = 8
+	TweenTransCubic   TweenTransitionType = 7
+	TweenTransElastic TweenTransitionType = 6
+	TweenTransExpo    TweenTransitionType = 5
+	TweenTransLinear  TweenTransitionType = 0
+	TweenTransQuad    TweenTransitionType = 4
+	TweenTransQuart   TweenTransitionType = 3
+	TweenTransQuint   TweenTransitionType = 2
+	TweenTransSine    TweenTransitionType = 1
+)
+
+// String will return the Godot name of the TweenTransitionType value.
+func (e TweenTransitionType) String() string {
+	switch e {
+	case TweenTransBack:
+		return "TRANS_BACK"
+	case TweenTransBounce:
+		return "TRANS_BOUNCE"
+	case TweenTransCirc:
+		return "TRANS_CIRC"
+	case TweenTransCubic:
+		return "TRANS_CUBIC"
+	case TweenTransElastic:
+		return "TRANS_ELASTIC"
+	case TweenTransExpo:
+		return "TRANS_EXPO"
+	case TweenTransLinear:
+		return "TRANS_LINEAR"
+	case TweenTransQuad:
+		return "TRANS_QUAD"
+	case TweenTransQuart:
+		return "TRANS_QUART"
+	case TweenTransQuint:
+		return "TRANS_QUINT"
+	case TweenTransSine:
+		return "TRANS_SINE"
+	}
+	return fmt.Sprintf("TweenTransitionType(%d)", int(e))
+}
+
+// ParseTweenTransitionType will return the TweenTransitionType value with the given Godot name.
+func ParseTweenTransitionType(name string) (TweenTransitionType, error) {
+	switch name {
+	case "TRANS_BACK":
+		return TweenTransBack, nil
+	case "TRANS_BOUNCE":
+		return TweenTransBounce, nil
+	case "TRANS_CIRC":
+		return TweenTransCirc, nil
+	case "TRANS_CUBIC":
+		return TweenTransCubic, nil
+	case "TRANS_ELASTIC":
+		return TweenTransElastic, nil
+	case "TRANS_EXPO":
+		return TweenTransExpo, nil
+	case "TRANS_LINEAR":
+		return TweenTransLinear, nil
+	case "TRANS_QUAD":
+		return TweenTransQuad, nil
+	case "TRANS_QUART":
+		return TweenTransQuart, nil
+	case "TRANS_QUINT":
+		return TweenTransQuint, nil
+	case "TRANS_SINE":
+		return TweenTransSine, nil
+	}
+	return 0, fmt.Errorf("invalid TweenTransitionType value %q", name)
+}
+
+// TweenTweenProcessMode is an enum for TweenProcessMode values.
+type TweenTweenProcessMode int
+
+const (
+	TweenTweenProcessIdle    TweenTweenProcessMode = 1
+	TweenTweenProcessPhysics TweenTweenProcessMode = 0
+)
+
+// String will return the Godot name of the TweenTweenProcessMode value.
+func (e TweenTweenProcessMode) String() string {
+	switch e {
+	case TweenTweenProcessIdle:
+		return "TWEEN_PROCESS_IDLE"
+	case TweenTweenProcessPhysics:
+		return "TWEEN_PROCESS_PHYSICS"
+	}
+	return fmt.Sprintf("TweenTweenProcessMode(%d)", int(e))
+}
+
+// ParseTweenTweenProcessMode will return the TweenTweenProcessMode value with the given Godot name.
+func ParseTweenTweenProcessMode(name string) (TweenTweenProcessMode, error) {
+	switch name {
+	case "TWEEN_PROCESS_IDLE":
+		return TweenTweenProcessIdle, nil
+	case "TWEEN_PROCESS_PHYSICS":
+		return TweenTweenProcessPhysics, nil
+	}
+	return 0, fmt.Errorf("invalid TweenTweenProcessMode value %q", name)
+}
+
+/*
+Node useful for animations with unknown start and end points, procedural animations, making one node follow another, and other simple behavior. Because it is easy to get it wrong, here is a quick usage example: [codeblock] var tween = get_node("Tween") tween.interpolate_property(get_node("Node2D_to_move"), "transform/origin", Vector2(0,0), Vector2(100,100), 1, Tween.TRANS_LINEAR, Tween.EASE_IN_OUT) tween.start() [/codeblock] Some of the methods of this class require a property name. You can get the property name by hovering over the property in the inspector of the editor. Many of the methods accept [code]trans_type[/code] and [code]ease_type[/code]. The first accepts an TRANS_* constant, and refers to the way the timing of the animation is handled (you might want to see [code]http://easings.net/[/code] for some examples). The second accepts an EASE_* constant, and controls the where [code]trans_type[/code] is applied to the interpolation (in the beginning, the end, or both). If you don't know which transition and easing to pick, you can try different TRANS_* constants with EASE_IN_OUT, and use the one that looks best.
+*/
+type Tween struct {
+	godot.Node
+	owner gdnative.Object
+}
+
+// classConstructorTween is the class constructor of the Tween class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTween = gdnative.NewLazyClassConstructor("Tween")
+
+// NewTween will create a new instance of the Tween class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTween() *Tween {
+	obj := &Tween{}
+	obj.SetBaseObject(classConstructorTween.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Tween) BaseClass() string {
+	return "Tween"
+}
+
+// Method binds of the Tween class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTweenX_Remove                    = gdnative.NewLazyMethodBind("Tween", "_remove")
+	methodBindTweenFollowMethod                = gdnative.NewLazyMethodBind("Tween", "follow_method")
+	methodBindTweenFollowProperty              = gdnative.NewLazyMethodBind("Tween", "follow_property")
+	methodBindTweenGetRuntime                  = gdnative.NewLazyMethodBind("Tween", "get_runtime")
+	methodBindTweenGetSpeedScale               = gdnative.NewLazyMethodBind("Tween", "get_speed_scale")
+	methodBindTweenGetTweenProcessMode         = gdnative.NewLazyMethodBind("Tween", "get_tween_process_mode")
+	methodBindTweenInterpolateCallback         = gdnative.NewLazyMethodBind("Tween", "interpolate_callback")
+	methodBindTweenInterpolateDeferredCallback = gdnative.NewLazyMethodBind("Tween", "interpolate_deferred_callback")
+	methodBindTweenInterpolateMethod           = gdnative.NewLazyMethodBind("Tween", "interpolate_method")
+	methodBindTweenInterpolateProperty         = gdnative.NewLazyMethodBind("Tween", "interpolate_property")
+	methodBindTweenIsActive                    = gdnative.NewLazyMethodBind("Tween", "is_active")
+	methodBindTweenIsRepeat                    = gdnative.NewLazyMethodBind("Tween", "is_repeat")
+	methodBindTweenRemove                      = gdnative.NewLazyMethodBind("Tween", "remove")
+	methodBindTweenRemoveAll                   = gdnative.NewLazyMethodBind("Tween", "remove_all")
+	methodBindTweenReset                       = gdnative.NewLazyMethodBind("Tween", "reset")
+	methodBindTweenResetAll                    = gdnative.NewLazyMethodBind("Tween", "reset_all")
+	methodBindTweenResume                      = gdnative.NewLazyMethodBind("Tween", "resume")
+	methodBindTweenResumeAll                   = gdnative.NewLazyMethodBind("Tween", "resume_all")
+	methodBindTweenSeek                        = gdnative.NewLazyMethodBind("Tween", "seek")
+	methodBindTweenSetActive                   = gdnative.NewLazyMethodBind("Tween", "set_active")
+	methodBindTweenSetRepeat                   = gdnative.NewLazyMethodBind("Tween", "set_repeat")
+	methodBindTweenSetSpeedScale               = gdnative.NewLazyMethodBind("Tween", "set_speed_scale")
+	methodBindTweenSetTweenProcessMode         = gdnative.NewLazyMethodBind("Tween", "set_tween_process_mode")
+	methodBindTweenStart                       = gdnative.NewLazyMethodBind("Tween", "start")
+	methodBindTweenStop                        = gdnative.NewLazyMethodBind("Tween", "stop")
+	methodBindTweenStopAll                     = gdnative.NewLazyMethodBind("Tween", "stop_all")
+	methodBindTweenTargetingMethod             = gdnative.NewLazyMethodBind("Tween", "targeting_method")
+	methodBindTweenTargetingProperty           = gdnative.NewLazyMethodBind("Tween", "targeting_property")
+	methodBindTweenTell                        = gdnative.NewLazyMethodBind("Tween", "tell")
+)
+
+/*
+	        Undocumented
+		Args: [{ false object Object} { false key String} { false first_only bool}], Returns: void
+*/
+func (o *Tween) X_Remove(object godot.ObjectImplementer, key gdnative.String, firstOnly gdnative.Bool) {
+	//log.Println("Calling Tween.X_Remove()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(key)
+	ptrArguments[2] = gdnative.NewPointerFromBool(firstOnly)
+
+	// Get the method bind
+	methodBind := methodBindTweenX_Remove.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Follow [code]method[/code] of [code]object[/code] and apply the returned value on [code]target_method[/code] of [code]target[/code], beginning from [code]initial_val[/code] for [code]duration[/code] seconds, [code]delay[/code] later. Methods are animated by calling them with consequitive values. [code]trans_type[/code] accepts TRANS_* constants, and is the way the animation is interpolated, while [code]ease_type[/code] accepts EASE_* constants, and controls the place of the interpolation (the beginning, the end, or both). You can read more about them in the class description.
+		Args: [{ false object Object} { false method String} { false initial_val Variant} { false target Object} { false target_method String} { false duration float} { false trans_type int} { false ease_type enum.Tween::EaseType} {0 true delay float}], Returns: bool
+*/
+func (o *Tween) FollowMethod(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, target godot.ObjectImplementer, targetMethod gdnative.String, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool {
+	//log.Println("Calling Tween.FollowMethod()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 9, 9)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(method)
+	ptrArguments[2] = gdnative.NewPointerFromVariant(initialVal)
+	ptrArguments[3] = gdnative.NewPointerFromObject(godot.GetBaseObject(target))
+	ptrArguments[4] = gdnative.NewPointerFromString(targetMethod)
+	ptrArguments[5] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[6] = gdnative.NewPointerFromInt(transType)
+	ptrArguments[7] = gdnative.NewPointerFromInt(gdnative.Int(easeType))
+	ptrArguments[8] = gdnative.NewPointerFromReal(delay)
+
+	// Get the method bind
+	methodBind := methodBindTweenFollowMethod.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Follow [code]property[/code] of [code]object[/code] and apply it on [code]target_property[/code] of [code]target[/code], beginning from [code]initial_val[/code] for [code]duration[/code] seconds, [code]delay[/code] seconds later. Note that [code]target:target_property[/code] would equal [code]object:property[/code] at the end of the tween. [code]trans_type[/code] accepts TRANS_* constants, and is the way the animation is interpolated, while [code]ease_type[/code] accepts EASE_* constants, and controls the place of the interpolation (the beginning, the end, or both). You can read more about them in the class description.
+		Args: [{ false object Object} { false property NodePath} { false initial_val Variant} { false target Object} { false target_property NodePath} { false duration float} { false trans_type int} { false ease_type enum.Tween::EaseType} {0 true delay float}], Returns: bool
+*/
+func (o *Tween) FollowProperty(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, target godot.ObjectImplementer, targetProperty gdnative.NodePath, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool {
+	//log.Println("Calling Tween.FollowProperty()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 9, 9)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromNodePath(property)
+	ptrArguments[2] = gdnative.NewPointerFromVariant(initialVal)
+	ptrArguments[3] = gdnative.NewPointerFromObject(godot.GetBaseObject(target))
+	ptrArguments[4] = gdnative.NewPointerFromNodePath(targetProperty)
+	ptrArguments[5] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[6] = gdnative.NewPointerFromInt(transType)
+	ptrArguments[7] = gdnative.NewPointerFromInt(gdnative.Int(easeType))
+	ptrArguments[8] = gdnative.NewPointerFromReal(delay)
+
+	// Get the method bind
+	methodBind := methodBindTweenFollowProperty.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the time needed for all tweens to end in seconds, measured from the start. Thus, if you have two tweens, one ending 10 seconds after the start and the other - 20 seconds, it would return 20 seconds, as by that time all tweens would have finished.
+		Args: [], Returns: float
+*/
+func (o *Tween) GetRuntime() gdnative.Real {
+	//log.Println("Calling Tween.GetRuntime()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenGetRuntime.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Tween) GetSpeedScale() gdnative.Real {
+	//log.Println("Calling Tween.GetSpeedScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenGetSpeedScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Tween::TweenProcessMode
+*/
+func (o *Tween) GetTweenProcessMode() TweenTweenProcessMode {
+	//log.Println("Calling Tween.GetTweenProcessMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenGetTweenProcessMode.Get()
+
+	// Call the parent method.
+	// enum.Tween::TweenProcessMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TweenTweenProcessMode(ret)
+}
+
+/*
+	        Call [code]callback[/code] of [code]object[/code] after [code]duration[/code]. [code]arg1[/code]-[code]arg5[/code] are arguments to be passed to the callback.
+		Args: [{ false object Object} { false duration float} { false callback String} {Null true arg1 Variant} {Null true arg2 Variant} {Null true arg3 Variant} {Null true arg4 Variant} {Null true arg5 Variant}], Returns: bool
+*/
+func (o *Tween) InterpolateCallback(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String, arg1 gdnative.Variant, arg2 gdnative.Variant, arg3 gdnative.Variant, arg4 gdnative.Variant, arg5 gdnative.Variant) gdnative.Bool {
+	//log.Println("Calling Tween.InterpolateCallback()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 8, 8)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[2] = gdnative.NewPointerFromString(callback)
+	ptrArguments[3] = gdnative.NewPointerFromVariant(arg1)
+	ptrArguments[4] = gdnative.NewPointerFromVariant(arg2)
+	ptrArguments[5] = gdnative.NewPointerFromVariant(arg3)
+	ptrArguments[6] = gdnative.NewPointerFromVariant(arg4)
+	ptrArguments[7] = gdnative.NewPointerFromVariant(arg5)
+
+	// Get the method bind
+	methodBind := methodBindTweenInterpolateCallback.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Call [code]callback[/code] of [code]object[/code] after [code]duration[/code] on the main thread (similar to [method Object.call_deferred]). [code]arg1[/code]-[code]arg5[/code] are arguments to be passed to the callback.
+		Args: [{ false object Object} { false duration float} { false callback String} {Null true arg1 Variant} {Null true arg2 Variant} {Null true arg3 Variant} {Null true arg4 Variant} {Null true arg5 Variant}], Returns: bool
+*/
+func (o *Tween) InterpolateDeferredCallback(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String, arg1 gdnative.Variant, arg2 gdnative.Variant, arg3 gdnative.Variant, arg4 gdnative.Variant, arg5 gdnative.Variant) gdnative.Bool {
+	//log.Println("Calling Tween.InterpolateDeferredCallback()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 8, 8)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[2] = gdnative.NewPointerFromString(callback)
+	ptrArguments[3] = gdnative.NewPointerFromVariant(arg1)
+	ptrArguments[4] = gdnative.NewPointerFromVariant(arg2)
+	ptrArguments[5] = gdnative.NewPointerFromVariant(arg3)
+	ptrArguments[6] = gdnative.NewPointerFromVariant(arg4)
+	ptrArguments[7] = gdnative.NewPointerFromVariant(arg5)
+
+	// Get the method bind
+	methodBind := methodBindTweenInterpolateDeferredCallback.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Animate [code]method[/code] of [code]object[/code] from [code]initial_val[/code] to [code]final_val[/code] for [code]duration[/code] seconds, [code]delay[/code] seconds later. Methods are animated by calling them with consecutive values. [code]trans_type[/code] accepts TRANS_* constants, and is the way the animation is interpolated, while [code]ease_type[/code] accepts EASE_* constants, and controls the place of the interpolation (the beginning, the end, or both). You can read more about them in the class description.
+		Args: [{ false object Object} { false method String} { false initial_val Variant} { false final_val Variant} { false duration float} { false trans_type int} { false ease_type enum.Tween::EaseType} {0 true delay float}], Returns: bool
+*/
+func (o *Tween) InterpolateMethod(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool {
+	//log.Println("Calling Tween.InterpolateMethod()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 8, 8)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(method)
+	ptrArguments[2] = gdnative.NewPointerFromVariant(initialVal)
+	ptrArguments[3] = gdnative.NewPointerFromVariant(finalVal)
+	ptrArguments[4] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[5] = gdnative.NewPointerFromInt(transType)
+	ptrArguments[6] = gdnative.NewPointerFromInt(gdnative.Int(easeType))
+	ptrArguments[7] = gdnative.NewPointerFromReal(delay)
+
+	// Get the method bind
+	methodBind := methodBindTweenInterpolateMethod.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Animate [code]property[/code] of [code]object[/code] from [code]initial_val[/code] to [code]final_val[/code] for [code]duration[/code] seconds, [code]delay[/code] seconds later. [code]trans_type[/code] accepts TRANS_* constants, and is the way the animation is interpolated, while [code]ease_type[/code] accepts EASE_* constants, and controls the place of the interpolation (the beginning, the end, or both). You can read more about them in the class description.
+		Args: [{ false object Object} { false property NodePath} { false initial_val Variant} { false final_val Variant} { false duration float} { false trans_type int} { false ease_type enum.Tween::EaseType} {0 true delay float}], Returns: bool
+*/
+func (o *Tween) InterpolateProperty(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool {
+	//log.Println("Calling Tween.InterpolateProperty()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 8, 8)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromNodePath(property)
+	ptrArguments[2] = gdnative.NewPointerFromVariant(initialVal)
+	ptrArguments[3] = gdnative.NewPointerFromVariant(finalVal)
+	ptrArguments[4] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[5] = gdnative.NewPointerFromInt(transType)
+	ptrArguments[6] = gdnative.NewPointerFromInt(gdnative.Int(easeType))
+	ptrArguments[7] = gdnative.NewPointerFromReal(delay)
+
+	// Get the method bind
+	methodBind := methodBindTweenInterpolateProperty.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns true if any tweens are currently running, and false otherwise. Note that this method doesn't consider tweens that have ended.
+		Args: [], Returns: bool
+*/
+func (o *Tween) IsActive() gdnative.Bool {
+	//log.Println("Calling Tween.IsActive()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenIsActive.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Tween) IsRepeat() gdnative.Bool {
+	//log.Println("Calling Tween.IsRepeat()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenIsRepeat.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Stop animating and completely remove a tween, given its object and property/method pair. Passing empty String as key will remove all tweens for given object.
+		Args: [{ false object Object} { true key String}], Returns: bool
+*/
+func (o *Tween) Remove(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool {
+	//log.Println("Calling Tween.Remove()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(key)
+
+	// Get the method bind
+	methodBind := methodBindTweenRemove.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Stop animating and completely remove all tweens.
+		Args: [], Returns: bool
+*/
+func (o *Tween) RemoveAll() gdnative.Bool {
+	//log.Println("Calling Tween.RemoveAll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenRemoveAll.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Resets a tween to the initial value (the one given, not the one before the tween), given its object and property/method pair. Passing empty String as key will reset all tweens for given object.
+		Args: [{ false object Object} { true key String}], Returns: bool
+*/
+func (o *Tween) Reset(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool {
+	//log.Println("Calling Tween.Reset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(key)
+
+	// Get the method bind
+	methodBind := methodBindTweenReset.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Resets all tweens to their initial values (the ones given, not those before the tween).
+		Args: [], Returns: bool
+*/
+func (o *Tween) ResetAll() gdnative.Bool {
+	//log.Println("Calling Tween.ResetAll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenResetAll.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Continue animating a stopped tween, given its object and property/method pair. Passing empty String as key will resume all tweens for given object.
+		Args: [{ false object Object} { true key String}], Returns: bool
+*/
+func (o *Tween) Resume(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool {
+	//log.Println("Calling Tween.Resume()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(key)
+
+	// Get the method bind
+	methodBind := methodBindTweenResume.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Continue animating all stopped tweens.
+		Args: [], Returns: bool
+*/
+func (o *Tween) ResumeAll() gdnative.Bool {
+	//log.Println("Calling Tween.ResumeAll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenResumeAll.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Seek the animation to the given [code]time[/code] in seconds.
+		Args: [{ false time float}], Returns: bool
+*/
+func (o *Tween) Seek(time gdnative.Real) gdnative.Bool {
+	//log.Println("Calling Tween.Seek()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(time)
+
+	// Get the method bind
+	methodBind := methodBindTweenSeek.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Activate/deactivate the tween. You can use this for pausing animations, though [method stop_all] and [method resume_all] might be more fit for this.
+		Args: [{ false active bool}], Returns: void
+*/
+func (o *Tween) SetActive(active gdnative.Bool) {
+	//log.Println("Calling Tween.SetActive()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(active)
+
+	// Get the method bind
+	methodBind := methodBindTweenSetActive.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false repeat bool}], Returns: void
+*/
+func (o *Tween) SetRepeat(repeat gdnative.Bool) {
+	//log.Println("Calling Tween.SetRepeat()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(repeat)
+
+	// Get the method bind
+	methodBind := methodBindTweenSetRepeat.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false speed float}], Returns: void
+*/
+func (o *Tween) SetSpeedScale(speed gdnative.Real) {
+	//log.Println("Calling Tween.SetSpeedScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(speed)
+
+	// Get the method bind
+	methodBind := methodBindTweenSetSpeedScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.Tween::TweenProcessMode}], Returns: void
+*/
+func (o *Tween) SetTweenProcessMode(mode TweenTweenProcessMode) {
+	//log.Println("Calling Tween.SetTweenProcessMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindTweenSetTweenProcessMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Start the tween node. You can define tweens both before and after this.
+		Args: [], Returns: bool
+*/
+func (o *Tween) Start() gdnative.Bool {
+	//log.Println("Calling Tween.Start()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenStart.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Stop animating a tween, given its object and property/method pair. Passing empty String as key will stop all tweens for given object.
+		Args: [{ false object Object} { true key String}], Returns: bool
+*/
+func (o *Tween) Stop(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool {
+	//log.Println("Calling Tween.Stop()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(key)
+
+	// Get the method bind
+	methodBind := methodBindTweenStop.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Stop animating all tweens.
+		Args: [], Returns: bool
+*/
+func (o *Tween) StopAll() gdnative.Bool {
+	//log.Println("Calling Tween.StopAll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenStopAll.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Animate [code]method[/code] of [code]object[/code] from the value returned by [code]initial.initial_method[/code] to [code]final_val[/code] for [code]duration[/code] seconds, [code]delay[/code] seconds later. Methods are animated by calling them with consecutive values. [code]trans_type[/code] accepts TRANS_* constants, and is the way the animation is interpolated, while [code]ease_type[/code] accepts EASE_* constants, and controls the place of the interpolation (the beginning, the end, or both). You can read more about them in the class description.
+		Args: [{ false object Object} { false method String} { false initial Object} { false initial_method String} { false final_val Variant} { false duration float} { false trans_type int} { false ease_type enum.Tween::EaseType} {0 true delay float}], Returns: bool
+*/
+func (o *Tween) TargetingMethod(object godot.ObjectImplementer, method gdnative.String, initial godot.ObjectImplementer, initialMethod gdnative.String, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool {
+	//log.Println("Calling Tween.TargetingMethod()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 9, 9)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(method)
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(initial))
+	ptrArguments[3] = gdnative.NewPointerFromString(initialMethod)
+	ptrArguments[4] = gdnative.NewPointerFromVariant(finalVal)
+	ptrArguments[5] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[6] = gdnative.NewPointerFromInt(transType)
+	ptrArguments[7] = gdnative.NewPointerFromInt(gdnative.Int(easeType))
+	ptrArguments[8] = gdnative.NewPointerFromReal(delay)
+
+	// Get the method bind
+	methodBind := methodBindTweenTargetingMethod.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Animate [code]property[/code] of [code]object[/code] from the current value of the [code]initial_val[/code] property of [code]initial[/code] to [code]final_val[/code] for [code]duration[/code] seconds, [code]delay[/code] seconds later. [code]trans_type[/code] accepts TRANS_* constants, and is the way the animation is interpolated, while [code]ease_type[/code] accepts EASE_* constants, and controls the place of the interpolation (the beginning, the end, or both). You can read more about them in the class description.
+		Args: [{ false object Object} { false property NodePath} { false initial Object} { false initial_val NodePath} { false final_val Variant} { false duration float} { false trans_type int} { false ease_type enum.Tween::EaseType} {0 true delay float}], Returns: bool
+*/
+func (o *Tween) TargetingProperty(object godot.ObjectImplementer, property gdnative.NodePath, initial godot.ObjectImplementer, initialVal gdnative.NodePath, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool {
+	//log.Println("Calling Tween.TargetingProperty()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 9, 9)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromNodePath(property)
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(initial))
+	ptrArguments[3] = gdnative.NewPointerFromNodePath(initialVal)
+	ptrArguments[4] = gdnative.NewPointerFromVariant(finalVal)
+	ptrArguments[5] = gdnative.NewPointerFromReal(duration)
+	ptrArguments[6] = gdnative.NewPointerFromInt(transType)
+	ptrArguments[7] = gdnative.NewPointerFromInt(gdnative.Int(easeType))
+	ptrArguments[8] = gdnative.NewPointerFromReal(delay)
+
+	// Get the method bind
+	methodBind := methodBindTweenTargetingProperty.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the current time of the tween.
+		Args: [], Returns: float
+*/
+func (o *Tween) Tell() gdnative.Real {
+	//log.Println("Calling Tween.Tell()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTweenTell.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+// FollowMethodWithDefaults will call FollowMethod using the default values for: delay.
+func (o *Tween) FollowMethodWithDefaults(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, target godot.ObjectImplementer, targetMethod gdnative.String, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool {
+	return o.FollowMethod(object, method, initialVal, target, targetMethod, duration, transType, easeType, 0)
+}
+
+// FollowPropertyWithDefaults will call FollowProperty using the default values for: delay.
+func (o *Tween) FollowPropertyWithDefaults(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, target godot.ObjectImplementer, targetProperty gdnative.NodePath, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool {
+	return o.FollowProperty(object, property, initialVal, target, targetProperty, duration, transType, easeType, 0)
+}
+
+// InterpolateCallbackWithDefaults will call InterpolateCallback using the default values for: arg1, arg2, arg3, arg4, arg5.
+func (o *Tween) InterpolateCallbackWithDefaults(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String) gdnative.Bool {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	default1 := gdnative.NewVariantNil()
+	defer default1.Destroy()
+	default2 := gdnative.NewVariantNil()
+	defer default2.Destroy()
+	default3 := gdnative.NewVariantNil()
+	defer default3.Destroy()
+	default4 := gdnative.NewVariantNil()
+	defer default4.Destroy()
+	return o.InterpolateCallback(object, duration, callback, default0, default1, default2, default3, default4)
+}
+
+// InterpolateDeferredCallbackWithDefaults will call InterpolateDeferredCallback using the default values for: arg1, arg2, arg3, arg4, arg5.
+func (o *Tween) InterpolateDeferredCallbackWithDefaults(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String) gdnative.Bool {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	default1 := gdnative.NewVariantNil()
+	defer default1.Destroy()
+	default2 := gdnative.NewVariantNil()
+	defer default2.Destroy()
+	default3 := gdnative.NewVariantNil()
+	defer default3.Destroy()
+	default4 := gdnative.NewVariantNil()
+	defer default4.Destroy()
+	return o.InterpolateDeferredCallback(object, duration, callback, default0, default1, default2, default3, default4)
+}
+
+// InterpolateMethodWithDefaults will call InterpolateMethod using the default values for: delay.
+func (o *Tween) InterpolateMethodWithDefaults(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool {
+	return o.InterpolateMethod(object, method, initialVal, finalVal, duration, transType, easeType, 0)
+}
+
+// InterpolatePropertyWithDefaults will call InterpolateProperty using the default values for: delay.
+func (o *Tween) InterpolatePropertyWithDefaults(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool {
+	return o.InterpolateProperty(object, property, initialVal, finalVal, duration, transType, easeType, 0)
+}
+
+// RemoveWithDefaults will call Remove using the default values for: key.
+func (o *Tween) RemoveWithDefaults(object godot.ObjectImplementer) gdnative.Bool {
+	return o.Remove(object, "")
+}
+
+// ResetWithDefaults will call Reset using the default values for: key.
+func (o *Tween) ResetWithDefaults(object godot.ObjectImplementer) gdnative.Bool {
+	return o.Reset(object, "")
+}
+
+// ResumeWithDefaults will call Resume using the default values for: key.
+func (o *Tween) ResumeWithDefaults(object godot.ObjectImplementer) gdnative.Bool {
+	return o.Resume(object, "")
+}
+
+// StopWithDefaults will call Stop using the default values for: key.
+func (o *Tween) StopWithDefaults(object godot.ObjectImplementer) gdnative.Bool {
+	return o.Stop(object, "")
+}
+
+// TargetingMethodWithDefaults will call TargetingMethod using the default values for: delay.
+func (o *Tween) TargetingMethodWithDefaults(object godot.ObjectImplementer, method gdnative.String, initial godot.ObjectImplementer, initialMethod gdnative.String, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool {
+	return o.TargetingMethod(object, method, initial, initialMethod, finalVal, duration, transType, easeType, 0)
+}
+
+// TargetingPropertyWithDefaults will call TargetingProperty using the default values for: delay.
+func (o *Tween) TargetingPropertyWithDefaults(object godot.ObjectImplementer, property gdnative.NodePath, initial godot.ObjectImplementer, initialVal gdnative.NodePath, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool {
+	return o.TargetingProperty(object, property, initial, initialVal, finalVal, duration, transType, easeType, 0)
+}
+
+// PlaybackProcessMode will return the value of the "playback_process_mode" property.
+func (o *Tween) PlaybackProcessMode() TweenTweenProcessMode {
+	return o.GetTweenProcessMode()
+}
+
+// SetPlaybackProcessMode will set the value of the "playback_process_mode" property.
+func (o *Tween) SetPlaybackProcessMode(value TweenTweenProcessMode) {
+	o.SetTweenProcessMode(value)
+}
+
+// PlaybackSpeed will return the value of the "playback_speed" property.
+func (o *Tween) PlaybackSpeed() gdnative.Real {
+	return o.GetSpeedScale()
+}
+
+// SetPlaybackSpeed will set the value of the "playback_speed" property.
+func (o *Tween) SetPlaybackSpeed(value gdnative.Real) {
+	o.SetSpeedScale(value)
+}
+
+// Repeat will return the value of the "repeat" property.
+func (o *Tween) Repeat() gdnative.Bool {
+	return o.IsRepeat()
+}
+
+// Signals of the Tween class.
+const (
+	TweenSignalTweenCompleted gdnative.String = "tween_completed"
+	TweenSignalTweenStarted   gdnative.String = "tween_started"
+	TweenSignalTweenStep      gdnative.String = "tween_step"
+)
+
+// TweenTweenCompletedArgs holds the arguments of the "tween_completed" signal.
+type TweenTweenCompletedArgs struct {
+	Object godot.ObjectImplementer
+	Key    gdnative.NodePath
+}
+
+// ConnectTweenCompleted will connect the "tween_completed" signal to the given method of the target object.
+func (o *Tween) ConnectTweenCompleted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TweenSignalTweenCompleted, target, method, binds, 0)
+}
+
+// EmitTweenCompleted will emit the "tween_completed" signal.
+func (o *Tween) EmitTweenCompleted(args TweenTweenCompletedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Object))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantNodePath(args.Key)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(TweenSignalTweenCompleted, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// TweenTweenStartedArgs holds the arguments of the "tween_started" signal.
+type TweenTweenStartedArgs struct {
+	Object godot.ObjectImplementer
+	Key    gdnative.NodePath
+}
+
+// ConnectTweenStarted will connect the "tween_started" signal to the given method of the target object.
+func (o *Tween) ConnectTweenStarted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TweenSignalTweenStarted, target, method, binds, 0)
+}
+
+// EmitTweenStarted will emit the "tween_started" signal.
+func (o *Tween) EmitTweenStarted(args TweenTweenStartedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Object))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantNodePath(args.Key)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(TweenSignalTweenStarted, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// TweenTweenStepArgs holds the arguments of the "tween_step" signal.
+type TweenTweenStepArgs struct {
+	Object  godot.ObjectImplementer
+	Key     gdnative.NodePath
+	Elapsed gdnative.Real
+	Value   godot.ObjectImplementer
+}
+
+// ConnectTweenStep will connect the "tween_step" signal to the given method of the target object.
+func (o *Tween) ConnectTweenStep(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TweenSignalTweenStep, target, method, binds, 0)
+}
+
+// EmitTweenStep will emit the "tween_step" signal.
+func (o *Tween) EmitTweenStep(args TweenTweenStepArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Object))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantNodePath(args.Key)
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantReal(gdnative.Double(args.Elapsed))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantObject(godot.GetBaseObject(args.Value))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(TweenSignalTweenStep, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// TweenImplementer is an interface that implements the methods
+// of the Tween class.
+type TweenImplementer interface {
+	godot.NodeImplementer
+	X_Remove(object godot.ObjectImplementer, key gdnative.String, firstOnly gdnative.Bool)
+	FollowMethod(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, target godot.ObjectImplementer, targetMethod gdnative.String, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool
+	FollowProperty(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, target godot.ObjectImplementer, targetProperty gdnative.NodePath, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool
+	GetRuntime() gdnative.Real
+	GetSpeedScale() gdnative.Real
+	GetTweenProcessMode() TweenTweenProcessMode
+	InterpolateCallback(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String, arg1 gdnative.Variant, arg2 gdnative.Variant, arg3 gdnative.Variant, arg4 gdnative.Variant, arg5 gdnative.Variant) gdnative.Bool
+	InterpolateDeferredCallback(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String, arg1 gdnative.Variant, arg2 gdnative.Variant, arg3 gdnative.Variant, arg4 gdnative.Variant, arg5 gdnative.Variant) gdnative.Bool
+	InterpolateMethod(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool
+	InterpolateProperty(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool
+	IsActive() gdnative.Bool
+	IsRepeat() gdnative.Bool
+	Remove(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool
+	RemoveAll() gdnative.Bool
+	Reset(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool
+	ResetAll() gdnative.Bool
+	Resume(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool
+	ResumeAll() gdnative.Bool
+	Seek(time gdnative.Real) gdnative.Bool
+	SetActive(active gdnative.Bool)
+	SetRepeat(repeat gdnative.Bool)
+	SetSpeedScale(speed gdnative.Real)
+	SetTweenProcessMode(mode TweenTweenProcessMode)
+	Start() gdnative.Bool
+	Stop(object godot.ObjectImplementer, key gdnative.String) gdnative.Bool
+	StopAll() gdnative.Bool
+	TargetingMethod(object godot.ObjectImplementer, method gdnative.String, initial godot.ObjectImplementer, initialMethod gdnative.String, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool
+	TargetingProperty(object godot.ObjectImplementer, property gdnative.NodePath, initial godot.ObjectImplementer, initialVal gdnative.NodePath, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType, delay gdnative.Real) gdnative.Bool
+	Tell() gdnative.Real
+	FollowMethodWithDefaults(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, target godot.ObjectImplementer, targetMethod gdnative.String, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool
+	FollowPropertyWithDefaults(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, target godot.ObjectImplementer, targetProperty gdnative.NodePath, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool
+	InterpolateCallbackWithDefaults(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String) gdnative.Bool
+	InterpolateDeferredCallbackWithDefaults(object godot.ObjectImplementer, duration gdnative.Real, callback gdnative.String) gdnative.Bool
+	InterpolateMethodWithDefaults(object godot.ObjectImplementer, method gdnative.String, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool
+	InterpolatePropertyWithDefaults(object godot.ObjectImplementer, property gdnative.NodePath, initialVal gdnative.Variant, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool
+	RemoveWithDefaults(object godot.ObjectImplementer) gdnative.Bool
+	ResetWithDefaults(object godot.ObjectImplementer) gdnative.Bool
+	ResumeWithDefaults(object godot.ObjectImplementer) gdnative.Bool
+	StopWithDefaults(object godot.ObjectImplementer) gdnative.Bool
+	TargetingMethodWithDefaults(object godot.ObjectImplementer, method gdnative.String, initial godot.ObjectImplementer, initialMethod gdnative.String, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool
+	TargetingPropertyWithDefaults(object godot.ObjectImplementer, property gdnative.NodePath, initial godot.ObjectImplementer, initialVal gdnative.NodePath, finalVal gdnative.Variant, duration gdnative.Real, transType gdnative.Int, easeType TweenEaseType) gdnative.Bool
+	PlaybackProcessMode() TweenTweenProcessMode
+	SetPlaybackProcessMode(value TweenTweenProcessMode)
+	PlaybackSpeed() gdnative.Real
+	SetPlaybackSpeed(value gdnative.Real)
+	Repeat() gdnative.Bool
+	ConnectTweenCompleted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTweenCompleted(args TweenTweenCompletedArgs) error
+	ConnectTweenStarted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTweenStarted(args TweenTweenStartedArgs) error
+	ConnectTweenStep(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTweenStep(args TweenTweenStepArgs) error
+}
@@ -0,0 +1,118 @@
+package node
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+The [code]WorldEnvironment[/code] node is used to configure the default [Environment] for the scene. The parameters defined in the [code]WorldEnvironment[/code] can be overridden by an [Environment] node set on the current [Camera]. Additionally, only one [code]WorldEnvironment[/code] may be instanced in a given scene at a time. The [code]WorldEnvironment[/code] allows the user to specify default lighting parameters (e.g. ambient lighting), various post-processing effects (e.g. SSAO, DOF, Tonemapping), and how to draw the background (e.g. solid color, skybox). Usually, these are added in order to improve the realism/color balance of the scene.
+*/
+type WorldEnvironment struct {
+	godot.Node
+	owner gdnative.Object
+}
+
+// classConstructorWorldEnvironment is the class constructor of the WorldEnvironment class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorWorldEnvironment = gdnative.NewLazyClassConstructor("WorldEnvironment")
+
+// NewWorldEnvironment will create a new instance of the WorldEnvironment class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewWorldEnvironment() *WorldEnvironment {
+	obj := &WorldEnvironment{}
+	obj.SetBaseObject(classConstructorWorldEnvironment.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *WorldEnvironment) BaseClass() string {
+	return "WorldEnvironment"
+}
+
+// Method binds of the WorldEnvironment class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindWorldEnvironmentGetEnvironment = gdnative.NewLazyMethodBind("WorldEnvironment", "get_environment")
+	methodBindWorldEnvironmentSetEnvironment = gdnative.NewLazyMethodBind("WorldEnvironment", "set_environment")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Environment
+*/
+func (o *WorldEnvironment) GetEnvironment() godot.EnvironmentImplementer {
+	//log.Println("Calling WorldEnvironment.GetEnvironment()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindWorldEnvironmentGetEnvironment.Get()
+
+	// Call the parent method.
+	// Environment
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Environment{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.EnvironmentImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false env Environment}], Returns: void
+*/
+func (o *WorldEnvironment) SetEnvironment(env godot.EnvironmentImplementer) {
+	//log.Println("Calling WorldEnvironment.SetEnvironment()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(env))
+
+	// Get the method bind
+	methodBind := methodBindWorldEnvironmentSetEnvironment.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Environment will return the value of the "environment" property.
+func (o *WorldEnvironment) Environment() godot.EnvironmentImplementer {
+	return o.GetEnvironment()
+}
+
+// WorldEnvironmentImplementer is an interface that implements the methods
+// of the WorldEnvironment class.
+type WorldEnvironmentImplementer interface {
+	godot.NodeImplementer
+	GetEnvironment() godot.EnvironmentImplementer
+	SetEnvironment(env godot.EnvironmentImplementer)
+	Environment() godot.EnvironmentImplementer
+}
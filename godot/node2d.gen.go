@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewNode2DFromPointer(ptr gdnative.Pointer) Node2D {
-func newNode2DFromPointer(ptr gdnative.Pointer) Node2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Node2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A 2D game object, with a position, rotation and scale. All 2D physics nodes and sprites inherit from Node2D. Use Node2D as a parent node to move, scale and rotate children in a 2D project. Also gives control on the node's render order.
 */
@@ -30,13 +21,65 @@ type Node2D struct {
 	owner gdnative.Object
 }
 
+// classConstructorNode2D is the class constructor of the Node2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNode2D = gdnative.NewLazyClassConstructor("Node2D")
+
+// NewNode2D will create a new instance of the Node2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNode2D() *Node2D {
+	obj := &Node2D{}
+	obj.SetBaseObject(classConstructorNode2D.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Node2D) BaseClass() string {
 	return "Node2D"
 }
 
+// Method binds of the Node2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNode2DApplyScale                   = gdnative.NewLazyMethodBind("Node2D", "apply_scale")
+	methodBindNode2DGetAngleTo                   = gdnative.NewLazyMethodBind("Node2D", "get_angle_to")
+	methodBindNode2DGetGlobalPosition            = gdnative.NewLazyMethodBind("Node2D", "get_global_position")
+	methodBindNode2DGetGlobalRotation            = gdnative.NewLazyMethodBind("Node2D", "get_global_rotation")
+	methodBindNode2DGetGlobalRotationDegrees     = gdnative.NewLazyMethodBind("Node2D", "get_global_rotation_degrees")
+	methodBindNode2DGetGlobalScale               = gdnative.NewLazyMethodBind("Node2D", "get_global_scale")
+	methodBindNode2DGetPosition                  = gdnative.NewLazyMethodBind("Node2D", "get_position")
+	methodBindNode2DGetRelativeTransformToParent = gdnative.NewLazyMethodBind("Node2D", "get_relative_transform_to_parent")
+	methodBindNode2DGetRotation                  = gdnative.NewLazyMethodBind("Node2D", "get_rotation")
+	methodBindNode2DGetRotationDegrees           = gdnative.NewLazyMethodBind("Node2D", "get_rotation_degrees")
+	methodBindNode2DGetScale                     = gdnative.NewLazyMethodBind("Node2D", "get_scale")
+	methodBindNode2DGetZIndex                    = gdnative.NewLazyMethodBind("Node2D", "get_z_index")
+	methodBindNode2DGlobalTranslate              = gdnative.NewLazyMethodBind("Node2D", "global_translate")
+	methodBindNode2DIsZRelative                  = gdnative.NewLazyMethodBind("Node2D", "is_z_relative")
+	methodBindNode2DLookAt                       = gdnative.NewLazyMethodBind("Node2D", "look_at")
+	methodBindNode2DMoveLocalX                   = gdnative.NewLazyMethodBind("Node2D", "move_local_x")
+	methodBindNode2DMoveLocalY                   = gdnative.NewLazyMethodBind("Node2D", "move_local_y")
+	methodBindNode2DRotate                       = gdnative.NewLazyMethodBind("Node2D", "rotate")
+	methodBindNode2DSetGlobalPosition            = gdnative.NewLazyMethodBind("Node2D", "set_global_position")
+	methodBindNode2DSetGlobalRotation            = gdnative.NewLazyMethodBind("Node2D", "set_global_rotation")
+	methodBindNode2DSetGlobalRotationDegrees     = gdnative.NewLazyMethodBind("Node2D", "set_global_rotation_degrees")
+	methodBindNode2DSetGlobalScale               = gdnative.NewLazyMethodBind("Node2D", "set_global_scale")
+	methodBindNode2DSetGlobalTransform           = gdnative.NewLazyMethodBind("Node2D", "set_global_transform")
+	methodBindNode2DSetPosition                  = gdnative.NewLazyMethodBind("Node2D", "set_position")
+	methodBindNode2DSetRotation                  = gdnative.NewLazyMethodBind("Node2D", "set_rotation")
+	methodBindNode2DSetRotationDegrees           = gdnative.NewLazyMethodBind("Node2D", "set_rotation_degrees")
+	methodBindNode2DSetScale                     = gdnative.NewLazyMethodBind("Node2D", "set_scale")
+	methodBindNode2DSetTransform                 = gdnative.NewLazyMethodBind("Node2D", "set_transform")
+	methodBindNode2DSetZAsRelative               = gdnative.NewLazyMethodBind("Node2D", "set_z_as_relative")
+	methodBindNode2DSetZIndex                    = gdnative.NewLazyMethodBind("Node2D", "set_z_index")
+	methodBindNode2DToGlobal                     = gdnative.NewLazyMethodBind("Node2D", "to_global")
+	methodBindNode2DToLocal                      = gdnative.NewLazyMethodBind("Node2D", "to_local")
+	methodBindNode2DTranslate                    = gdnative.NewLazyMethodBind("Node2D", "translate")
+)
+
 /*
-        Multiplies the current scale by the 'ratio' vector.
-	Args: [{ false ratio Vector2}], Returns: void
+	        Multiplies the current scale by the 'ratio' vector.
+		Args: [{ false ratio Vector2}], Returns: void
 */
 func (o *Node2D) ApplyScale(ratio gdnative.Vector2) {
 	//log.Println("Calling Node2D.ApplyScale()")
@@ -46,7 +89,7 @@ func (o *Node2D) ApplyScale(ratio gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "apply_scale")
+	methodBind := methodBindNode2DApplyScale.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +99,8 @@ func (o *Node2D) ApplyScale(ratio gdnative.Vector2) {
 }
 
 /*
-        Returns the angle between the node and the 'point' in radians.
-	Args: [{ false point Vector2}], Returns: float
+	        Returns the angle between the node and the 'point' in radians.
+		Args: [{ false point Vector2}], Returns: float
 */
 func (o *Node2D) GetAngleTo(point gdnative.Vector2) gdnative.Real {
 	//log.Println("Calling Node2D.GetAngleTo()")
@@ -67,7 +110,7 @@ func (o *Node2D) GetAngleTo(point gdnative.Vector2) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_angle_to")
+	methodBind := methodBindNode2DGetAngleTo.Get()
 
 	// Call the parent method.
 	// float
@@ -80,8 +123,8 @@ func (o *Node2D) GetAngleTo(point gdnative.Vector2) gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Node2D) GetGlobalPosition() gdnative.Vector2 {
 	//log.Println("Calling Node2D.GetGlobalPosition()")
@@ -90,7 +133,7 @@ func (o *Node2D) GetGlobalPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_global_position")
+	methodBind := methodBindNode2DGetGlobalPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -103,8 +146,8 @@ func (o *Node2D) GetGlobalPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Node2D) GetGlobalRotation() gdnative.Real {
 	//log.Println("Calling Node2D.GetGlobalRotation()")
@@ -113,7 +156,7 @@ func (o *Node2D) GetGlobalRotation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_global_rotation")
+	methodBind := methodBindNode2DGetGlobalRotation.Get()
 
 	// Call the parent method.
 	// float
@@ -126,8 +169,8 @@ func (o *Node2D) GetGlobalRotation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Node2D) GetGlobalRotationDegrees() gdnative.Real {
 	//log.Println("Calling Node2D.GetGlobalRotationDegrees()")
@@ -136,7 +179,7 @@ func (o *Node2D) GetGlobalRotationDegrees() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_global_rotation_degrees")
+	methodBind := methodBindNode2DGetGlobalRotationDegrees.Get()
 
 	// Call the parent method.
 	// float
@@ -149,8 +192,8 @@ func (o *Node2D) GetGlobalRotationDegrees() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Node2D) GetGlobalScale() gdnative.Vector2 {
 	//log.Println("Calling Node2D.GetGlobalScale()")
@@ -159,7 +202,7 @@ func (o *Node2D) GetGlobalScale() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_global_scale")
+	methodBind := methodBindNode2DGetGlobalScale.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -172,8 +215,8 @@ func (o *Node2D) GetGlobalScale() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Node2D) GetPosition() gdnative.Vector2 {
 	//log.Println("Calling Node2D.GetPosition()")
@@ -182,7 +225,7 @@ func (o *Node2D) GetPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_position")
+	methodBind := methodBindNode2DGetPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -195,18 +238,18 @@ func (o *Node2D) GetPosition() gdnative.Vector2 {
 }
 
 /*
-        Returns the [Transform2D] relative to this node's parent.
-	Args: [{ false parent Object}], Returns: Transform2D
+	        Returns the [Transform2D] relative to this node's parent.
+		Args: [{ false parent Object}], Returns: Transform2D
 */
 func (o *Node2D) GetRelativeTransformToParent(parent ObjectImplementer) gdnative.Transform2D {
 	//log.Println("Calling Node2D.GetRelativeTransformToParent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(parent.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(parent))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_relative_transform_to_parent")
+	methodBind := methodBindNode2DGetRelativeTransformToParent.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -219,8 +262,8 @@ func (o *Node2D) GetRelativeTransformToParent(parent ObjectImplementer) gdnative
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Node2D) GetRotation() gdnative.Real {
 	//log.Println("Calling Node2D.GetRotation()")
@@ -229,7 +272,7 @@ func (o *Node2D) GetRotation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_rotation")
+	methodBind := methodBindNode2DGetRotation.Get()
 
 	// Call the parent method.
 	// float
@@ -242,8 +285,8 @@ func (o *Node2D) GetRotation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Node2D) GetRotationDegrees() gdnative.Real {
 	//log.Println("Calling Node2D.GetRotationDegrees()")
@@ -252,7 +295,7 @@ func (o *Node2D) GetRotationDegrees() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_rotation_degrees")
+	methodBind := methodBindNode2DGetRotationDegrees.Get()
 
 	// Call the parent method.
 	// float
@@ -265,8 +308,8 @@ func (o *Node2D) GetRotationDegrees() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Node2D) GetScale() gdnative.Vector2 {
 	//log.Println("Calling Node2D.GetScale()")
@@ -275,7 +318,7 @@ func (o *Node2D) GetScale() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_scale")
+	methodBind := methodBindNode2DGetScale.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -288,8 +331,8 @@ func (o *Node2D) GetScale() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Node2D) GetZIndex() gdnative.Int {
 	//log.Println("Calling Node2D.GetZIndex()")
@@ -298,7 +341,7 @@ func (o *Node2D) GetZIndex() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "get_z_index")
+	methodBind := methodBindNode2DGetZIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -311,8 +354,8 @@ func (o *Node2D) GetZIndex() gdnative.Int {
 }
 
 /*
-        Adds the 'offset' vector to the node's global position.
-	Args: [{ false offset Vector2}], Returns: void
+	        Adds the 'offset' vector to the node's global position.
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *Node2D) GlobalTranslate(offset gdnative.Vector2) {
 	//log.Println("Calling Node2D.GlobalTranslate()")
@@ -322,7 +365,7 @@ func (o *Node2D) GlobalTranslate(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "global_translate")
+	methodBind := methodBindNode2DGlobalTranslate.Get()
 
 	// Call the parent method.
 	// void
@@ -332,8 +375,8 @@ func (o *Node2D) GlobalTranslate(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Node2D) IsZRelative() gdnative.Bool {
 	//log.Println("Calling Node2D.IsZRelative()")
@@ -342,7 +385,7 @@ func (o *Node2D) IsZRelative() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "is_z_relative")
+	methodBind := methodBindNode2DIsZRelative.Get()
 
 	// Call the parent method.
 	// bool
@@ -355,8 +398,8 @@ func (o *Node2D) IsZRelative() gdnative.Bool {
 }
 
 /*
-        Rotates the node so it points towards the 'point'.
-	Args: [{ false point Vector2}], Returns: void
+	        Rotates the node so it points towards the 'point'.
+		Args: [{ false point Vector2}], Returns: void
 */
 func (o *Node2D) LookAt(point gdnative.Vector2) {
 	//log.Println("Calling Node2D.LookAt()")
@@ -366,7 +409,7 @@ func (o *Node2D) LookAt(point gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "look_at")
+	methodBind := methodBindNode2DLookAt.Get()
 
 	// Call the parent method.
 	// void
@@ -376,8 +419,8 @@ func (o *Node2D) LookAt(point gdnative.Vector2) {
 }
 
 /*
-        Applies a local translation on the node's X axis based on the [method Node._process]'s [code]delta[/code]. If [code]scaled[/code] is false, normalizes the movement.
-	Args: [{ false delta float} {False true scaled bool}], Returns: void
+	        Applies a local translation on the node's X axis based on the [method Node._process]'s [code]delta[/code]. If [code]scaled[/code] is false, normalizes the movement.
+		Args: [{ false delta float} {False true scaled bool}], Returns: void
 */
 func (o *Node2D) MoveLocalX(delta gdnative.Real, scaled gdnative.Bool) {
 	//log.Println("Calling Node2D.MoveLocalX()")
@@ -388,7 +431,7 @@ func (o *Node2D) MoveLocalX(delta gdnative.Real, scaled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(scaled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "move_local_x")
+	methodBind := methodBindNode2DMoveLocalX.Get()
 
 	// Call the parent method.
 	// void
@@ -398,8 +441,8 @@ func (o *Node2D) MoveLocalX(delta gdnative.Real, scaled gdnative.Bool) {
 }
 
 /*
-        Applies a local translation on the node's Y axis based on the [method Node._process]'s [code]delta[/code]. If [code]scaled[/code] is false, normalizes the movement.
-	Args: [{ false delta float} {False true scaled bool}], Returns: void
+	        Applies a local translation on the node's Y axis based on the [method Node._process]'s [code]delta[/code]. If [code]scaled[/code] is false, normalizes the movement.
+		Args: [{ false delta float} {False true scaled bool}], Returns: void
 */
 func (o *Node2D) MoveLocalY(delta gdnative.Real, scaled gdnative.Bool) {
 	//log.Println("Calling Node2D.MoveLocalY()")
@@ -410,7 +453,7 @@ func (o *Node2D) MoveLocalY(delta gdnative.Real, scaled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(scaled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "move_local_y")
+	methodBind := methodBindNode2DMoveLocalY.Get()
 
 	// Call the parent method.
 	// void
@@ -420,8 +463,8 @@ func (o *Node2D) MoveLocalY(delta gdnative.Real, scaled gdnative.Bool) {
 }
 
 /*
-        Applies a rotation to the node, in radians, starting from its current rotation.
-	Args: [{ false radians float}], Returns: void
+	        Applies a rotation to the node, in radians, starting from its current rotation.
+		Args: [{ false radians float}], Returns: void
 */
 func (o *Node2D) Rotate(radians gdnative.Real) {
 	//log.Println("Calling Node2D.Rotate()")
@@ -431,7 +474,7 @@ func (o *Node2D) Rotate(radians gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radians)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "rotate")
+	methodBind := methodBindNode2DRotate.Get()
 
 	// Call the parent method.
 	// void
@@ -441,8 +484,8 @@ func (o *Node2D) Rotate(radians gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *Node2D) SetGlobalPosition(position gdnative.Vector2) {
 	//log.Println("Calling Node2D.SetGlobalPosition()")
@@ -452,7 +495,7 @@ func (o *Node2D) SetGlobalPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_global_position")
+	methodBind := methodBindNode2DSetGlobalPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -462,8 +505,8 @@ func (o *Node2D) SetGlobalPosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radians float}], Returns: void
+	        Undocumented
+		Args: [{ false radians float}], Returns: void
 */
 func (o *Node2D) SetGlobalRotation(radians gdnative.Real) {
 	//log.Println("Calling Node2D.SetGlobalRotation()")
@@ -473,7 +516,7 @@ func (o *Node2D) SetGlobalRotation(radians gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radians)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_global_rotation")
+	methodBind := methodBindNode2DSetGlobalRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -483,8 +526,8 @@ func (o *Node2D) SetGlobalRotation(radians gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *Node2D) SetGlobalRotationDegrees(degrees gdnative.Real) {
 	//log.Println("Calling Node2D.SetGlobalRotationDegrees()")
@@ -494,7 +537,7 @@ func (o *Node2D) SetGlobalRotationDegrees(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_global_rotation_degrees")
+	methodBind := methodBindNode2DSetGlobalRotationDegrees.Get()
 
 	// Call the parent method.
 	// void
@@ -504,8 +547,8 @@ func (o *Node2D) SetGlobalRotationDegrees(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false scale Vector2}], Returns: void
 */
 func (o *Node2D) SetGlobalScale(scale gdnative.Vector2) {
 	//log.Println("Calling Node2D.SetGlobalScale()")
@@ -515,7 +558,7 @@ func (o *Node2D) SetGlobalScale(scale gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_global_scale")
+	methodBind := methodBindNode2DSetGlobalScale.Get()
 
 	// Call the parent method.
 	// void
@@ -525,8 +568,8 @@ func (o *Node2D) SetGlobalScale(scale gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false xform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false xform Transform2D}], Returns: void
 */
 func (o *Node2D) SetGlobalTransform(xform gdnative.Transform2D) {
 	//log.Println("Calling Node2D.SetGlobalTransform()")
@@ -536,7 +579,7 @@ func (o *Node2D) SetGlobalTransform(xform gdnative.Transform2D) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_global_transform")
+	methodBind := methodBindNode2DSetGlobalTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -546,8 +589,8 @@ func (o *Node2D) SetGlobalTransform(xform gdnative.Transform2D) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *Node2D) SetPosition(position gdnative.Vector2) {
 	//log.Println("Calling Node2D.SetPosition()")
@@ -557,7 +600,7 @@ func (o *Node2D) SetPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_position")
+	methodBind := methodBindNode2DSetPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -567,8 +610,8 @@ func (o *Node2D) SetPosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radians float}], Returns: void
+	        Undocumented
+		Args: [{ false radians float}], Returns: void
 */
 func (o *Node2D) SetRotation(radians gdnative.Real) {
 	//log.Println("Calling Node2D.SetRotation()")
@@ -578,7 +621,7 @@ func (o *Node2D) SetRotation(radians gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radians)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_rotation")
+	methodBind := methodBindNode2DSetRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -588,8 +631,8 @@ func (o *Node2D) SetRotation(radians gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *Node2D) SetRotationDegrees(degrees gdnative.Real) {
 	//log.Println("Calling Node2D.SetRotationDegrees()")
@@ -599,7 +642,7 @@ func (o *Node2D) SetRotationDegrees(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_rotation_degrees")
+	methodBind := methodBindNode2DSetRotationDegrees.Get()
 
 	// Call the parent method.
 	// void
@@ -609,8 +652,8 @@ func (o *Node2D) SetRotationDegrees(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false scale Vector2}], Returns: void
 */
 func (o *Node2D) SetScale(scale gdnative.Vector2) {
 	//log.Println("Calling Node2D.SetScale()")
@@ -620,7 +663,7 @@ func (o *Node2D) SetScale(scale gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_scale")
+	methodBind := methodBindNode2DSetScale.Get()
 
 	// Call the parent method.
 	// void
@@ -630,8 +673,8 @@ func (o *Node2D) SetScale(scale gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false xform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false xform Transform2D}], Returns: void
 */
 func (o *Node2D) SetTransform(xform gdnative.Transform2D) {
 	//log.Println("Calling Node2D.SetTransform()")
@@ -641,7 +684,7 @@ func (o *Node2D) SetTransform(xform gdnative.Transform2D) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_transform")
+	methodBind := methodBindNode2DSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -651,8 +694,8 @@ func (o *Node2D) SetTransform(xform gdnative.Transform2D) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Node2D) SetZAsRelative(enable gdnative.Bool) {
 	//log.Println("Calling Node2D.SetZAsRelative()")
@@ -662,7 +705,7 @@ func (o *Node2D) SetZAsRelative(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_z_as_relative")
+	methodBind := methodBindNode2DSetZAsRelative.Get()
 
 	// Call the parent method.
 	// void
@@ -672,8 +715,8 @@ func (o *Node2D) SetZAsRelative(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false z_index int}], Returns: void
+	        Undocumented
+		Args: [{ false z_index int}], Returns: void
 */
 func (o *Node2D) SetZIndex(zIndex gdnative.Int) {
 	//log.Println("Calling Node2D.SetZIndex()")
@@ -683,7 +726,7 @@ func (o *Node2D) SetZIndex(zIndex gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(zIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "set_z_index")
+	methodBind := methodBindNode2DSetZIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -693,8 +736,8 @@ func (o *Node2D) SetZIndex(zIndex gdnative.Int) {
 }
 
 /*
-        Converts a local point's coordinates to global coordinates.
-	Args: [{ false local_point Vector2}], Returns: Vector2
+	        Converts a local point's coordinates to global coordinates.
+		Args: [{ false local_point Vector2}], Returns: Vector2
 */
 func (o *Node2D) ToGlobal(localPoint gdnative.Vector2) gdnative.Vector2 {
 	//log.Println("Calling Node2D.ToGlobal()")
@@ -704,7 +747,7 @@ func (o *Node2D) ToGlobal(localPoint gdnative.Vector2) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(localPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "to_global")
+	methodBind := methodBindNode2DToGlobal.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -717,8 +760,8 @@ func (o *Node2D) ToGlobal(localPoint gdnative.Vector2) gdnative.Vector2 {
 }
 
 /*
-        Converts a global point's coordinates to local coordinates.
-	Args: [{ false global_point Vector2}], Returns: Vector2
+	        Converts a global point's coordinates to local coordinates.
+		Args: [{ false global_point Vector2}], Returns: Vector2
 */
 func (o *Node2D) ToLocal(globalPoint gdnative.Vector2) gdnative.Vector2 {
 	//log.Println("Calling Node2D.ToLocal()")
@@ -728,7 +771,7 @@ func (o *Node2D) ToLocal(globalPoint gdnative.Vector2) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(globalPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "to_local")
+	methodBind := methodBindNode2DToLocal.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -741,8 +784,8 @@ func (o *Node2D) ToLocal(globalPoint gdnative.Vector2) gdnative.Vector2 {
 }
 
 /*
-        Translates the node by the given [code]offset[/code] in local coordinates.
-	Args: [{ false offset Vector2}], Returns: void
+	        Translates the node by the given [code]offset[/code] in local coordinates.
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *Node2D) Translate(offset gdnative.Vector2) {
 	//log.Println("Calling Node2D.Translate()")
@@ -752,7 +795,7 @@ func (o *Node2D) Translate(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Node2D", "translate")
+	methodBind := methodBindNode2DTranslate.Get()
 
 	// Call the parent method.
 	// void
@@ -761,6 +804,76 @@ func (o *Node2D) Translate(offset gdnative.Vector2) {
 
 }
 
+// MoveLocalXWithDefaults will call MoveLocalX using the default values for: scaled.
+func (o *Node2D) MoveLocalXWithDefaults(delta gdnative.Real) {
+	o.MoveLocalX(delta, false)
+}
+
+// MoveLocalYWithDefaults will call MoveLocalY using the default values for: scaled.
+func (o *Node2D) MoveLocalYWithDefaults(delta gdnative.Real) {
+	o.MoveLocalY(delta, false)
+}
+
+// GlobalPosition will return the value of the "global_position" property.
+func (o *Node2D) GlobalPosition() gdnative.Vector2 {
+	return o.GetGlobalPosition()
+}
+
+// GlobalRotation will return the value of the "global_rotation" property.
+func (o *Node2D) GlobalRotation() gdnative.Real {
+	return o.GetGlobalRotation()
+}
+
+// GlobalRotationDegrees will return the value of the "global_rotation_degrees" property.
+func (o *Node2D) GlobalRotationDegrees() gdnative.Real {
+	return o.GetGlobalRotationDegrees()
+}
+
+// GlobalScale will return the value of the "global_scale" property.
+func (o *Node2D) GlobalScale() gdnative.Vector2 {
+	return o.GetGlobalScale()
+}
+
+// GlobalTransform will return the value of the "global_transform" property.
+func (o *Node2D) GlobalTransform() gdnative.Transform2D {
+	return o.GetGlobalTransform()
+}
+
+// Position will return the value of the "position" property.
+func (o *Node2D) Position() gdnative.Vector2 {
+	return o.GetPosition()
+}
+
+// Rotation will return the value of the "rotation" property.
+func (o *Node2D) Rotation() gdnative.Real {
+	return o.GetRotation()
+}
+
+// RotationDegrees will return the value of the "rotation_degrees" property.
+func (o *Node2D) RotationDegrees() gdnative.Real {
+	return o.GetRotationDegrees()
+}
+
+// Scale will return the value of the "scale" property.
+func (o *Node2D) Scale() gdnative.Vector2 {
+	return o.GetScale()
+}
+
+// Transform will return the value of the "transform" property.
+func (o *Node2D) Transform() gdnative.Transform2D {
+	return o.GetTransform()
+}
+
+// ZAsRelative will return the value of the "z_as_relative" property.
+func (o *Node2D) ZAsRelative() gdnative.Bool {
+	return o.IsZRelative()
+}
+
+// ZIndex will return the value of the "z_index" property.
+func (o *Node2D) ZIndex() gdnative.Int {
+	return o.GetZIndex()
+}
+
 // Node2DImplementer is an interface that implements the methods
 // of the Node2D class.
 type Node2DImplementer interface {
@@ -798,4 +911,18 @@ type Node2DImplementer interface {
 	ToGlobal(localPoint gdnative.Vector2) gdnative.Vector2
 	ToLocal(globalPoint gdnative.Vector2) gdnative.Vector2
 	Translate(offset gdnative.Vector2)
+	MoveLocalXWithDefaults(delta gdnative.Real)
+	MoveLocalYWithDefaults(delta gdnative.Real)
+	GlobalPosition() gdnative.Vector2
+	GlobalRotation() gdnative.Real
+	GlobalRotationDegrees() gdnative.Real
+	GlobalScale() gdnative.Vector2
+	GlobalTransform() gdnative.Transform2D
+	Position() gdnative.Vector2
+	Rotation() gdnative.Real
+	RotationDegrees() gdnative.Real
+	Scale() gdnative.Vector2
+	Transform() gdnative.Transform2D
+	ZAsRelative() gdnative.Bool
+	ZIndex() gdnative.Int
 }
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,15 +24,38 @@ const (
 	ObjectConnectPersist  ObjectConnectFlags = 2
 )
 
-//func NewObjectFromPointer(ptr gdnative.Pointer) Object {
-func newObjectFromPointer(ptr gdnative.Pointer) Object {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Object{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ObjectConnectFlags value.
+func (e ObjectConnectFlags) String() string {
+	switch e {
+	case ObjectConnectDeferred:
+		return "CONNECT_DEFERRED"
+	case ObjectConnectOneshot:
+		return "CONNECT_ONESHOT"
+	case ObjectConnectPersist:
+		return "CONNECT_PERSIST"
+	}
+	return fmt.Sprintf("ObjectConnectFlags(%d)", int(e))
+}
 
-	return obj
+// ParseObjectConnectFlags will return the ObjectConnectFlags value with the given Godot name.
+func ParseObjectConnectFlags(name string) (ObjectConnectFlags, error) {
+	switch name {
+	case "CONNECT_DEFERRED":
+		return ObjectConnectDeferred, nil
+	case "CONNECT_ONESHOT":
+		return ObjectConnectOneshot, nil
+	case "CONNECT_PERSIST":
+		return ObjectConnectPersist, nil
+	}
+	return 0, fmt.Errorf("invalid ObjectConnectFlags value %q", name)
 }
 
+// Constants of the Object class.
+const (
+	ObjectNotificationPostinitialize gdnative.Int = 0
+	ObjectNotificationPredelete      gdnative.Int = 1
+)
+
 /*
 Base class for all non built-in types. Everything not a built-in type starts the inheritance chain from this class. Objects do not manage memory, if inheriting from one the object will most likely have to be deleted manually (call the [method free] function from the script or delete from C++). Some derivatives add memory management, such as [Reference] (which keeps a reference count and deletes itself automatically when no longer referenced) and [Node], which deletes the children tree when deleted. Objects export properties, which are mainly useful for storage and editing, but not really so much in programming. Properties are exported in [method _get_property_list] and handled in [method _get] and [method _set]. However, scripting languages and C++ have simpler means to export them. Objects also receive notifications ([method _notification]). Notifications are a simple way to notify the object about simple events, so they can all be handled together.
 */
@@ -38,6 +63,20 @@ type Object struct {
 	owner gdnative.Object
 }
 
+// classConstructorObject is the class constructor of the Object class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorObject = gdnative.NewLazyClassConstructor("Object")
+
+// NewObject will create a new instance of the Object class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewObject() *Object {
+	obj := &Object{}
+	obj.SetBaseObject(classConstructorObject.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Object) BaseClass() string {
 	return "Object"
 }
@@ -52,9 +91,68 @@ func (o *Object) GetBaseObject() gdnative.Object {
 	return o.owner
 }
 
+// Destroy will free the underlying Godot object. This can only be used on
+// objects that do not inherit from Reference, since those are freed by Godot
+// once their last reference is dropped.
+func (o *Object) Destroy() {
+	if o.IsClass("Reference") {
+		Log.Error("Unable to destroy object of class ", o.GetClass(), ": Reference types are freed by Godot.")
+		return
+	}
+	o.owner.Destroy()
+	o.owner = gdnative.Object{}
+}
+
+// Method binds of the Object class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindObjectX_Get                     = gdnative.NewLazyMethodBind("Object", "_get")
+	methodBindObjectX_GetPropertyList         = gdnative.NewLazyMethodBind("Object", "_get_property_list")
+	methodBindObjectX_Init                    = gdnative.NewLazyMethodBind("Object", "_init")
+	methodBindObjectX_Notification            = gdnative.NewLazyMethodBind("Object", "_notification")
+	methodBindObjectX_Set                     = gdnative.NewLazyMethodBind("Object", "_set")
+	methodBindObjectAddUserSignal             = gdnative.NewLazyMethodBind("Object", "add_user_signal")
+	methodBindObjectCall                      = gdnative.NewLazyMethodBind("Object", "call")
+	methodBindObjectCallDeferred              = gdnative.NewLazyMethodBind("Object", "call_deferred")
+	methodBindObjectCallv                     = gdnative.NewLazyMethodBind("Object", "callv")
+	methodBindObjectCanTranslateMessages      = gdnative.NewLazyMethodBind("Object", "can_translate_messages")
+	methodBindObjectConnect                   = gdnative.NewLazyMethodBind("Object", "connect")
+	methodBindObjectDisconnect                = gdnative.NewLazyMethodBind("Object", "disconnect")
+	methodBindObjectEmitSignal                = gdnative.NewLazyMethodBind("Object", "emit_signal")
+	methodBindObjectFree                      = gdnative.NewLazyMethodBind("Object", "free")
+	methodBindObjectGet                       = gdnative.NewLazyMethodBind("Object", "get")
+	methodBindObjectGetClass                  = gdnative.NewLazyMethodBind("Object", "get_class")
+	methodBindObjectGetIncomingConnections    = gdnative.NewLazyMethodBind("Object", "get_incoming_connections")
+	methodBindObjectGetIndexed                = gdnative.NewLazyMethodBind("Object", "get_indexed")
+	methodBindObjectGetInstanceId             = gdnative.NewLazyMethodBind("Object", "get_instance_id")
+	methodBindObjectGetMeta                   = gdnative.NewLazyMethodBind("Object", "get_meta")
+	methodBindObjectGetMetaList               = gdnative.NewLazyMethodBind("Object", "get_meta_list")
+	methodBindObjectGetMethodList             = gdnative.NewLazyMethodBind("Object", "get_method_list")
+	methodBindObjectGetPropertyList           = gdnative.NewLazyMethodBind("Object", "get_property_list")
+	methodBindObjectGetScript                 = gdnative.NewLazyMethodBind("Object", "get_script")
+	methodBindObjectGetSignalConnectionList   = gdnative.NewLazyMethodBind("Object", "get_signal_connection_list")
+	methodBindObjectGetSignalList             = gdnative.NewLazyMethodBind("Object", "get_signal_list")
+	methodBindObjectHasMeta                   = gdnative.NewLazyMethodBind("Object", "has_meta")
+	methodBindObjectHasMethod                 = gdnative.NewLazyMethodBind("Object", "has_method")
+	methodBindObjectHasUserSignal             = gdnative.NewLazyMethodBind("Object", "has_user_signal")
+	methodBindObjectIsBlockingSignals         = gdnative.NewLazyMethodBind("Object", "is_blocking_signals")
+	methodBindObjectIsClass                   = gdnative.NewLazyMethodBind("Object", "is_class")
+	methodBindObjectIsConnected               = gdnative.NewLazyMethodBind("Object", "is_connected")
+	methodBindObjectIsQueuedForDeletion       = gdnative.NewLazyMethodBind("Object", "is_queued_for_deletion")
+	methodBindObjectNotification              = gdnative.NewLazyMethodBind("Object", "notification")
+	methodBindObjectPropertyListChangedNotify = gdnative.NewLazyMethodBind("Object", "property_list_changed_notify")
+	methodBindObjectSet                       = gdnative.NewLazyMethodBind("Object", "set")
+	methodBindObjectSetBlockSignals           = gdnative.NewLazyMethodBind("Object", "set_block_signals")
+	methodBindObjectSetIndexed                = gdnative.NewLazyMethodBind("Object", "set_indexed")
+	methodBindObjectSetMessageTranslation     = gdnative.NewLazyMethodBind("Object", "set_message_translation")
+	methodBindObjectSetMeta                   = gdnative.NewLazyMethodBind("Object", "set_meta")
+	methodBindObjectSetScript                 = gdnative.NewLazyMethodBind("Object", "set_script")
+	methodBindObjectTr                        = gdnative.NewLazyMethodBind("Object", "tr")
+)
+
 /*
-        Returns the given property. Returns [code]null[/code] if the [code]property[/code] does not exist.
-	Args: [{ false property String}], Returns: void
+	        Returns the given property. Returns [code]null[/code] if the [code]property[/code] does not exist.
+		Args: [{ false property String}], Returns: void
 */
 func (o *Object) X_Get(property gdnative.String) {
 	//log.Println("Calling Object.X_Get()")
@@ -64,7 +162,7 @@ func (o *Object) X_Get(property gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(property)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "_get")
+	methodBind := methodBindObjectX_Get.Get()
 
 	// Call the parent method.
 	// void
@@ -74,8 +172,8 @@ func (o *Object) X_Get(property gdnative.String) {
 }
 
 /*
-        Returns the object's property list as an [Array] of dictionaries. Dictionaries must contain: name:String, type:int (see TYPE_* enum in [@GlobalScope]) and optionally: hint:int (see PROPERTY_HINT_* in [@GlobalScope]), hint_string:String, usage:int (see PROPERTY_USAGE_* in [@GlobalScope]).
-	Args: [], Returns: Array
+	        Returns the object's property list as an [Array] of dictionaries. Dictionaries must contain: name:String, type:int (see TYPE_* enum in [@GlobalScope]) and optionally: hint:int (see PROPERTY_HINT_* in [@GlobalScope]), hint_string:String, usage:int (see PROPERTY_USAGE_* in [@GlobalScope]).
+		Args: [], Returns: Array
 */
 func (o *Object) X_GetPropertyList() gdnative.Array {
 	//log.Println("Calling Object.X_GetPropertyList()")
@@ -84,7 +182,7 @@ func (o *Object) X_GetPropertyList() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "_get_property_list")
+	methodBind := methodBindObjectX_GetPropertyList.Get()
 
 	// Call the parent method.
 	// Array
@@ -97,8 +195,8 @@ func (o *Object) X_GetPropertyList() gdnative.Array {
 }
 
 /*
-        The virtual method called upon initialization.
-	Args: [], Returns: void
+	        The virtual method called upon initialization.
+		Args: [], Returns: void
 */
 func (o *Object) X_Init() {
 	//log.Println("Calling Object.X_Init()")
@@ -107,7 +205,7 @@ func (o *Object) X_Init() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "_init")
+	methodBind := methodBindObjectX_Init.Get()
 
 	// Call the parent method.
 	// void
@@ -117,8 +215,8 @@ func (o *Object) X_Init() {
 }
 
 /*
-        Notify the object internally using an ID.
-	Args: [{ false what int}], Returns: void
+	        Notify the object internally using an ID.
+		Args: [{ false what int}], Returns: void
 */
 func (o *Object) X_Notification(what gdnative.Int) {
 	//log.Println("Calling Object.X_Notification()")
@@ -128,7 +226,7 @@ func (o *Object) X_Notification(what gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(what)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "_notification")
+	methodBind := methodBindObjectX_Notification.Get()
 
 	// Call the parent method.
 	// void
@@ -138,8 +236,8 @@ func (o *Object) X_Notification(what gdnative.Int) {
 }
 
 /*
-        Sets a property. Returns [code]true[/code] if the [code]property[/code] exists.
-	Args: [{ false property String} { false value Variant}], Returns: bool
+	        Sets a property. Returns [code]true[/code] if the [code]property[/code] exists.
+		Args: [{ false property String} { false value Variant}], Returns: bool
 */
 func (o *Object) X_Set(property gdnative.String, value gdnative.Variant) gdnative.Bool {
 	//log.Println("Calling Object.X_Set()")
@@ -150,7 +248,7 @@ func (o *Object) X_Set(property gdnative.String, value gdnative.Variant) gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "_set")
+	methodBind := methodBindObjectX_Set.Get()
 
 	// Call the parent method.
 	// bool
@@ -163,8 +261,8 @@ func (o *Object) X_Set(property gdnative.String, value gdnative.Variant) gdnativ
 }
 
 /*
-        Adds a user-defined [code]signal[/code]. Arguments are optional, but can be added as an [Array] of dictionaries, each containing "name" and "type" (from [@GlobalScope] TYPE_*).
-	Args: [{ false signal String} {[] true arguments Array}], Returns: void
+	        Adds a user-defined [code]signal[/code]. Arguments are optional, but can be added as an [Array] of dictionaries, each containing "name" and "type" (from [@GlobalScope] TYPE_*).
+		Args: [{ false signal String} {[] true arguments Array}], Returns: void
 */
 func (o *Object) AddUserSignal(signal gdnative.String, arguments gdnative.Array) {
 	//log.Println("Calling Object.AddUserSignal()")
@@ -175,7 +273,7 @@ func (o *Object) AddUserSignal(signal gdnative.String, arguments gdnative.Array)
 	ptrArguments[1] = gdnative.NewPointerFromArray(arguments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "add_user_signal")
+	methodBind := methodBindObjectAddUserSignal.Get()
 
 	// Call the parent method.
 	// void
@@ -185,56 +283,64 @@ func (o *Object) AddUserSignal(signal gdnative.String, arguments gdnative.Array)
 }
 
 /*
-        Calls the [code]method[/code] on the object and returns a result. Pass parameters as a comma separated list.
-	Args: [{ false method String}], Returns: Variant
+	        Calls the [code]method[/code] on the object and returns a result. Pass parameters as a comma separated list.
+		Args: [{ false method String}], Returns: Variant
 */
-func (o *Object) Call(method gdnative.String) gdnative.Variant {
+func (o *Object) Call(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling Object.Call()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "call")
+	methodBind := methodBindObjectCall.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-        Calls the [code]method[/code] on the object during idle time and returns a result. Pass parameters as a comma separated list.
-	Args: [{ false method String}], Returns: Variant
+	        Calls the [code]method[/code] on the object during idle time and returns a result. Pass parameters as a comma separated list.
+		Args: [{ false method String}], Returns: Variant
 */
-func (o *Object) CallDeferred(method gdnative.String) gdnative.Variant {
+func (o *Object) CallDeferred(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling Object.CallDeferred()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "call_deferred")
+	methodBind := methodBindObjectCallDeferred.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-        Calls the [code]method[/code] on the object and returns a result. Pass parameters as an [Array].
-	Args: [{ false method String} { false arg_array Array}], Returns: Variant
+	        Calls the [code]method[/code] on the object and returns a result. Pass parameters as an [Array].
+		Args: [{ false method String} { false arg_array Array}], Returns: Variant
 */
 func (o *Object) Callv(method gdnative.String, argArray gdnative.Array) gdnative.Variant {
 	//log.Println("Calling Object.Callv()")
@@ -245,7 +351,7 @@ func (o *Object) Callv(method gdnative.String, argArray gdnative.Array) gdnative
 	ptrArguments[1] = gdnative.NewPointerFromArray(argArray)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "callv")
+	methodBind := methodBindObjectCallv.Get()
 
 	// Call the parent method.
 	// Variant
@@ -258,8 +364,8 @@ func (o *Object) Callv(method gdnative.String, argArray gdnative.Array) gdnative
 }
 
 /*
-        Returns [code]true[/code] if the object can translate strings.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the object can translate strings.
+		Args: [], Returns: bool
 */
 func (o *Object) CanTranslateMessages() gdnative.Bool {
 	//log.Println("Calling Object.CanTranslateMessages()")
@@ -268,7 +374,7 @@ func (o *Object) CanTranslateMessages() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "can_translate_messages")
+	methodBind := methodBindObjectCanTranslateMessages.Get()
 
 	// Call the parent method.
 	// bool
@@ -281,8 +387,8 @@ func (o *Object) CanTranslateMessages() gdnative.Bool {
 }
 
 /*
-        Connects a [code]signal[/code] to a [code]method[/code] on a [code]target[/code] object. Pass optional [code]binds[/code] to the call. Use [code]flags[/code] to set deferred or one shot connections. See [code]CONNECT_*[/code] constants. A [code]signal[/code] can only be connected once to a [code]method[/code]. It will throw an error if already connected. To avoid this, first use [method is_connected] to check for existing connections.
-	Args: [{ false signal String} { false target Object} { false method String} {[] true binds Array} {0 true flags int}], Returns: enum.Error
+	        Connects a [code]signal[/code] to a [code]method[/code] on a [code]target[/code] object. Pass optional [code]binds[/code] to the call. Use [code]flags[/code] to set deferred or one shot connections. See [code]CONNECT_*[/code] constants. A [code]signal[/code] can only be connected once to a [code]method[/code]. It will throw an error if already connected. To avoid this, first use [method is_connected] to check for existing connections.
+		Args: [{ false signal String} { false target Object} { false method String} {[] true binds Array} {0 true flags int}], Returns: enum.Error
 */
 func (o *Object) Connect(signal gdnative.String, target ObjectImplementer, method gdnative.String, binds gdnative.Array, flags gdnative.Int) gdnative.Error {
 	//log.Println("Calling Object.Connect()")
@@ -290,13 +396,13 @@ func (o *Object) Connect(signal gdnative.String, target ObjectImplementer, metho
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
 	ptrArguments[0] = gdnative.NewPointerFromString(signal)
-	ptrArguments[1] = gdnative.NewPointerFromObject(target.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(target))
 	ptrArguments[2] = gdnative.NewPointerFromString(method)
 	ptrArguments[3] = gdnative.NewPointerFromArray(binds)
 	ptrArguments[4] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "connect")
+	methodBind := methodBindObjectConnect.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -309,8 +415,8 @@ func (o *Object) Connect(signal gdnative.String, target ObjectImplementer, metho
 }
 
 /*
-        Disconnects a [code]signal[/code] from a [code]method[/code] on the given [code]target[/code].
-	Args: [{ false signal String} { false target Object} { false method String}], Returns: void
+	        Disconnects a [code]signal[/code] from a [code]method[/code] on the given [code]target[/code].
+		Args: [{ false signal String} { false target Object} { false method String}], Returns: void
 */
 func (o *Object) Disconnect(signal gdnative.String, target ObjectImplementer, method gdnative.String) {
 	//log.Println("Calling Object.Disconnect()")
@@ -318,11 +424,11 @@ func (o *Object) Disconnect(signal gdnative.String, target ObjectImplementer, me
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(signal)
-	ptrArguments[1] = gdnative.NewPointerFromObject(target.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(target))
 	ptrArguments[2] = gdnative.NewPointerFromString(method)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "disconnect")
+	methodBind := methodBindObjectDisconnect.Get()
 
 	// Call the parent method.
 	// void
@@ -332,32 +438,36 @@ func (o *Object) Disconnect(signal gdnative.String, target ObjectImplementer, me
 }
 
 /*
-        Emits the given [code]signal[/code].
-	Args: [{ false signal String}], Returns: Variant
+	        Emits the given [code]signal[/code].
+		Args: [{ false signal String}], Returns: Variant
 */
-func (o *Object) EmitSignal(signal gdnative.String) gdnative.Variant {
+func (o *Object) EmitSignal(signal gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling Object.EmitSignal()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromString(signal)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 1+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(signal))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "emit_signal")
+	methodBind := methodBindObjectEmitSignal.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 1; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-        Deletes the object from memory.
-	Args: [], Returns: void
+	        Deletes the object from memory.
+		Args: [], Returns: void
 */
 func (o *Object) Free() {
 	//log.Println("Calling Object.Free()")
@@ -366,7 +476,7 @@ func (o *Object) Free() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "free")
+	methodBind := methodBindObjectFree.Get()
 
 	// Call the parent method.
 	// void
@@ -376,8 +486,8 @@ func (o *Object) Free() {
 }
 
 /*
-        Returns a [Variant] for a [code]property[/code].
-	Args: [{ false property String}], Returns: Variant
+	        Returns a [Variant] for a [code]property[/code].
+		Args: [{ false property String}], Returns: Variant
 */
 func (o *Object) Get(property gdnative.String) gdnative.Variant {
 	//log.Println("Calling Object.Get()")
@@ -387,7 +497,7 @@ func (o *Object) Get(property gdnative.String) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromString(property)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get")
+	methodBind := methodBindObjectGet.Get()
 
 	// Call the parent method.
 	// Variant
@@ -400,8 +510,8 @@ func (o *Object) Get(property gdnative.String) gdnative.Variant {
 }
 
 /*
-        Returns the object's class as a [String].
-	Args: [], Returns: String
+	        Returns the object's class as a [String].
+		Args: [], Returns: String
 */
 func (o *Object) GetClass() gdnative.String {
 	//log.Println("Calling Object.GetClass()")
@@ -410,7 +520,7 @@ func (o *Object) GetClass() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_class")
+	methodBind := methodBindObjectGetClass.Get()
 
 	// Call the parent method.
 	// String
@@ -423,8 +533,8 @@ func (o *Object) GetClass() gdnative.String {
 }
 
 /*
-        Returns an [Array] of dictionaries with information about signals that are connected to the object. Inside each [Dictionary] there are 3 fields: - "source" is a reference to signal emitter. - "signal_name" is name of connected signal. - "method_name" is a name of method to which signal is connected.
-	Args: [], Returns: Array
+	        Returns an [Array] of dictionaries with information about signals that are connected to the object. Inside each [Dictionary] there are 3 fields: - "source" is a reference to signal emitter. - "signal_name" is name of connected signal. - "method_name" is a name of method to which signal is connected.
+		Args: [], Returns: Array
 */
 func (o *Object) GetIncomingConnections() gdnative.Array {
 	//log.Println("Calling Object.GetIncomingConnections()")
@@ -433,7 +543,7 @@ func (o *Object) GetIncomingConnections() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_incoming_connections")
+	methodBind := methodBindObjectGetIncomingConnections.Get()
 
 	// Call the parent method.
 	// Array
@@ -446,8 +556,7 @@ func (o *Object) GetIncomingConnections() gdnative.Array {
 }
 
 /*
-
-	Args: [{ false property NodePath}], Returns: Variant
+Args: [{ false property NodePath}], Returns: Variant
 */
 func (o *Object) GetIndexed(property gdnative.NodePath) gdnative.Variant {
 	//log.Println("Calling Object.GetIndexed()")
@@ -457,7 +566,7 @@ func (o *Object) GetIndexed(property gdnative.NodePath) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(property)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_indexed")
+	methodBind := methodBindObjectGetIndexed.Get()
 
 	// Call the parent method.
 	// Variant
@@ -470,8 +579,8 @@ func (o *Object) GetIndexed(property gdnative.NodePath) gdnative.Variant {
 }
 
 /*
-        Returns the object's unique instance ID.
-	Args: [], Returns: int
+	        Returns the object's unique instance ID.
+		Args: [], Returns: int
 */
 func (o *Object) GetInstanceId() gdnative.Int {
 	//log.Println("Calling Object.GetInstanceId()")
@@ -480,7 +589,7 @@ func (o *Object) GetInstanceId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_instance_id")
+	methodBind := methodBindObjectGetInstanceId.Get()
 
 	// Call the parent method.
 	// int
@@ -493,8 +602,8 @@ func (o *Object) GetInstanceId() gdnative.Int {
 }
 
 /*
-        Returns the object's metadata for the given [code]name[/code].
-	Args: [{ false name String}], Returns: Variant
+	        Returns the object's metadata for the given [code]name[/code].
+		Args: [{ false name String}], Returns: Variant
 */
 func (o *Object) GetMeta(name gdnative.String) gdnative.Variant {
 	//log.Println("Calling Object.GetMeta()")
@@ -504,7 +613,7 @@ func (o *Object) GetMeta(name gdnative.String) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_meta")
+	methodBind := methodBindObjectGetMeta.Get()
 
 	// Call the parent method.
 	// Variant
@@ -517,8 +626,8 @@ func (o *Object) GetMeta(name gdnative.String) gdnative.Variant {
 }
 
 /*
-        Returns the object's metadata as a [PoolStringArray].
-	Args: [], Returns: PoolStringArray
+	        Returns the object's metadata as a [PoolStringArray].
+		Args: [], Returns: PoolStringArray
 */
 func (o *Object) GetMetaList() gdnative.PoolStringArray {
 	//log.Println("Calling Object.GetMetaList()")
@@ -527,7 +636,7 @@ func (o *Object) GetMetaList() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_meta_list")
+	methodBind := methodBindObjectGetMetaList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -540,8 +649,8 @@ func (o *Object) GetMetaList() gdnative.PoolStringArray {
 }
 
 /*
-        Returns the object's methods and their signatures as an [Array].
-	Args: [], Returns: Array
+	        Returns the object's methods and their signatures as an [Array].
+		Args: [], Returns: Array
 */
 func (o *Object) GetMethodList() gdnative.Array {
 	//log.Println("Calling Object.GetMethodList()")
@@ -550,7 +659,7 @@ func (o *Object) GetMethodList() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_method_list")
+	methodBind := methodBindObjectGetMethodList.Get()
 
 	// Call the parent method.
 	// Array
@@ -563,8 +672,8 @@ func (o *Object) GetMethodList() gdnative.Array {
 }
 
 /*
-        Returns the list of properties as an [Array] of dictionaries. Dictionaries contain: name:String, type:int (see TYPE_* enum in [@GlobalScope]) and optionally: hint:int (see PROPERTY_HINT_* in [@GlobalScope]), hint_string:String, usage:int (see PROPERTY_USAGE_* in [@GlobalScope]).
-	Args: [], Returns: Array
+	        Returns the list of properties as an [Array] of dictionaries. Dictionaries contain: name:String, type:int (see TYPE_* enum in [@GlobalScope]) and optionally: hint:int (see PROPERTY_HINT_* in [@GlobalScope]), hint_string:String, usage:int (see PROPERTY_USAGE_* in [@GlobalScope]).
+		Args: [], Returns: Array
 */
 func (o *Object) GetPropertyList() gdnative.Array {
 	//log.Println("Calling Object.GetPropertyList()")
@@ -573,7 +682,7 @@ func (o *Object) GetPropertyList() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_property_list")
+	methodBind := methodBindObjectGetPropertyList.Get()
 
 	// Call the parent method.
 	// Array
@@ -586,8 +695,8 @@ func (o *Object) GetPropertyList() gdnative.Array {
 }
 
 /*
-        Returns the object's [Script] or [code]null[/code] if one doesn't exist.
-	Args: [], Returns: Reference
+	        Returns the object's [Script] or [code]null[/code] if one doesn't exist.
+		Args: [], Returns: Reference
 */
 func (o *Object) GetScript() ReferenceImplementer {
 	//log.Println("Calling Object.GetScript()")
@@ -596,7 +705,7 @@ func (o *Object) GetScript() ReferenceImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_script")
+	methodBind := methodBindObjectGetScript.Get()
 
 	// Call the parent method.
 	// Reference
@@ -604,27 +713,25 @@ func (o *Object) GetScript() ReferenceImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newReferenceFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ReferenceImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Reference" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ReferenceImplementer)
+	ret := Reference{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ReferenceImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns an [Array] of connections for the given [code]signal[/code].
-	Args: [{ false signal String}], Returns: Array
+	        Returns an [Array] of connections for the given [code]signal[/code].
+		Args: [{ false signal String}], Returns: Array
 */
 func (o *Object) GetSignalConnectionList(signal gdnative.String) gdnative.Array {
 	//log.Println("Calling Object.GetSignalConnectionList()")
@@ -634,7 +741,7 @@ func (o *Object) GetSignalConnectionList(signal gdnative.String) gdnative.Array
 	ptrArguments[0] = gdnative.NewPointerFromString(signal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_signal_connection_list")
+	methodBind := methodBindObjectGetSignalConnectionList.Get()
 
 	// Call the parent method.
 	// Array
@@ -647,8 +754,8 @@ func (o *Object) GetSignalConnectionList(signal gdnative.String) gdnative.Array
 }
 
 /*
-        Returns the list of signals as an [Array] of dictionaries.
-	Args: [], Returns: Array
+	        Returns the list of signals as an [Array] of dictionaries.
+		Args: [], Returns: Array
 */
 func (o *Object) GetSignalList() gdnative.Array {
 	//log.Println("Calling Object.GetSignalList()")
@@ -657,7 +764,7 @@ func (o *Object) GetSignalList() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "get_signal_list")
+	methodBind := methodBindObjectGetSignalList.Get()
 
 	// Call the parent method.
 	// Array
@@ -670,8 +777,8 @@ func (o *Object) GetSignalList() gdnative.Array {
 }
 
 /*
-        Returns [code]true[/code] if a metadata is found with the given [code]name[/code].
-	Args: [{ false name String}], Returns: bool
+	        Returns [code]true[/code] if a metadata is found with the given [code]name[/code].
+		Args: [{ false name String}], Returns: bool
 */
 func (o *Object) HasMeta(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Object.HasMeta()")
@@ -681,7 +788,7 @@ func (o *Object) HasMeta(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "has_meta")
+	methodBind := methodBindObjectHasMeta.Get()
 
 	// Call the parent method.
 	// bool
@@ -694,8 +801,8 @@ func (o *Object) HasMeta(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the object contains the given [code]method[/code].
-	Args: [{ false method String}], Returns: bool
+	        Returns [code]true[/code] if the object contains the given [code]method[/code].
+		Args: [{ false method String}], Returns: bool
 */
 func (o *Object) HasMethod(method gdnative.String) gdnative.Bool {
 	//log.Println("Calling Object.HasMethod()")
@@ -705,7 +812,7 @@ func (o *Object) HasMethod(method gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(method)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "has_method")
+	methodBind := methodBindObjectHasMethod.Get()
 
 	// Call the parent method.
 	// bool
@@ -718,8 +825,8 @@ func (o *Object) HasMethod(method gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the given user-defined [code]signal[/code] exists.
-	Args: [{ false signal String}], Returns: bool
+	        Returns [code]true[/code] if the given user-defined [code]signal[/code] exists.
+		Args: [{ false signal String}], Returns: bool
 */
 func (o *Object) HasUserSignal(signal gdnative.String) gdnative.Bool {
 	//log.Println("Calling Object.HasUserSignal()")
@@ -729,7 +836,7 @@ func (o *Object) HasUserSignal(signal gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(signal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "has_user_signal")
+	methodBind := methodBindObjectHasUserSignal.Get()
 
 	// Call the parent method.
 	// bool
@@ -742,8 +849,8 @@ func (o *Object) HasUserSignal(signal gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if signal emission blocking is enabled.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if signal emission blocking is enabled.
+		Args: [], Returns: bool
 */
 func (o *Object) IsBlockingSignals() gdnative.Bool {
 	//log.Println("Calling Object.IsBlockingSignals()")
@@ -752,7 +859,7 @@ func (o *Object) IsBlockingSignals() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "is_blocking_signals")
+	methodBind := methodBindObjectIsBlockingSignals.Get()
 
 	// Call the parent method.
 	// bool
@@ -765,8 +872,8 @@ func (o *Object) IsBlockingSignals() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if the object inherits from the given [code]type[/code].
-	Args: [{ false type String}], Returns: bool
+	        Returns [code]true[/code] if the object inherits from the given [code]type[/code].
+		Args: [{ false type String}], Returns: bool
 */
 func (o *Object) IsClass(aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Object.IsClass()")
@@ -776,7 +883,7 @@ func (o *Object) IsClass(aType gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "is_class")
+	methodBind := methodBindObjectIsClass.Get()
 
 	// Call the parent method.
 	// bool
@@ -789,8 +896,8 @@ func (o *Object) IsClass(aType gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if a connection exists for a given [code]signal[/code], [code]target[/code], and [code]method[/code].
-	Args: [{ false signal String} { false target Object} { false method String}], Returns: bool
+	        Returns [code]true[/code] if a connection exists for a given [code]signal[/code], [code]target[/code], and [code]method[/code].
+		Args: [{ false signal String} { false target Object} { false method String}], Returns: bool
 */
 func (o *Object) IsConnected(signal gdnative.String, target ObjectImplementer, method gdnative.String) gdnative.Bool {
 	//log.Println("Calling Object.IsConnected()")
@@ -798,11 +905,11 @@ func (o *Object) IsConnected(signal gdnative.String, target ObjectImplementer, m
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(signal)
-	ptrArguments[1] = gdnative.NewPointerFromObject(target.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(target))
 	ptrArguments[2] = gdnative.NewPointerFromString(method)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "is_connected")
+	methodBind := methodBindObjectIsConnected.Get()
 
 	// Call the parent method.
 	// bool
@@ -815,8 +922,8 @@ func (o *Object) IsConnected(signal gdnative.String, target ObjectImplementer, m
 }
 
 /*
-        Returns [code]true[/code] if the [code]queue_free[/code] method was called for the object.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the [code]queue_free[/code] method was called for the object.
+		Args: [], Returns: bool
 */
 func (o *Object) IsQueuedForDeletion() gdnative.Bool {
 	//log.Println("Calling Object.IsQueuedForDeletion()")
@@ -825,7 +932,7 @@ func (o *Object) IsQueuedForDeletion() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "is_queued_for_deletion")
+	methodBind := methodBindObjectIsQueuedForDeletion.Get()
 
 	// Call the parent method.
 	// bool
@@ -838,8 +945,8 @@ func (o *Object) IsQueuedForDeletion() gdnative.Bool {
 }
 
 /*
-        Notify the object of something.
-	Args: [{ false what int} {False true reversed bool}], Returns: void
+	        Notify the object of something.
+		Args: [{ false what int} {False true reversed bool}], Returns: void
 */
 func (o *Object) Notification(what gdnative.Int, reversed gdnative.Bool) {
 	//log.Println("Calling Object.Notification()")
@@ -850,7 +957,7 @@ func (o *Object) Notification(what gdnative.Int, reversed gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(reversed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "notification")
+	methodBind := methodBindObjectNotification.Get()
 
 	// Call the parent method.
 	// void
@@ -860,8 +967,7 @@ func (o *Object) Notification(what gdnative.Int, reversed gdnative.Bool) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *Object) PropertyListChangedNotify() {
 	//log.Println("Calling Object.PropertyListChangedNotify()")
@@ -870,7 +976,7 @@ func (o *Object) PropertyListChangedNotify() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "property_list_changed_notify")
+	methodBind := methodBindObjectPropertyListChangedNotify.Get()
 
 	// Call the parent method.
 	// void
@@ -880,8 +986,8 @@ func (o *Object) PropertyListChangedNotify() {
 }
 
 /*
-        Set property into the object.
-	Args: [{ false property String} { false value Variant}], Returns: void
+	        Set property into the object.
+		Args: [{ false property String} { false value Variant}], Returns: void
 */
 func (o *Object) Set(property gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling Object.Set()")
@@ -892,7 +998,7 @@ func (o *Object) Set(property gdnative.String, value gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "set")
+	methodBind := methodBindObjectSet.Get()
 
 	// Call the parent method.
 	// void
@@ -902,8 +1008,8 @@ func (o *Object) Set(property gdnative.String, value gdnative.Variant) {
 }
 
 /*
-        If set to true, signal emission is blocked.
-	Args: [{ false enable bool}], Returns: void
+	        If set to true, signal emission is blocked.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Object) SetBlockSignals(enable gdnative.Bool) {
 	//log.Println("Calling Object.SetBlockSignals()")
@@ -913,7 +1019,7 @@ func (o *Object) SetBlockSignals(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "set_block_signals")
+	methodBind := methodBindObjectSetBlockSignals.Get()
 
 	// Call the parent method.
 	// void
@@ -923,8 +1029,7 @@ func (o *Object) SetBlockSignals(enable gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false property NodePath} { false value Variant}], Returns: void
+Args: [{ false property NodePath} { false value Variant}], Returns: void
 */
 func (o *Object) SetIndexed(property gdnative.NodePath, value gdnative.Variant) {
 	//log.Println("Calling Object.SetIndexed()")
@@ -935,7 +1040,7 @@ func (o *Object) SetIndexed(property gdnative.NodePath, value gdnative.Variant)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "set_indexed")
+	methodBind := methodBindObjectSetIndexed.Get()
 
 	// Call the parent method.
 	// void
@@ -945,8 +1050,8 @@ func (o *Object) SetIndexed(property gdnative.NodePath, value gdnative.Variant)
 }
 
 /*
-        Define whether the object can translate strings (with calls to [method tr]). Default is true.
-	Args: [{ false enable bool}], Returns: void
+	        Define whether the object can translate strings (with calls to [method tr]). Default is true.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Object) SetMessageTranslation(enable gdnative.Bool) {
 	//log.Println("Calling Object.SetMessageTranslation()")
@@ -956,7 +1061,7 @@ func (o *Object) SetMessageTranslation(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "set_message_translation")
+	methodBind := methodBindObjectSetMessageTranslation.Get()
 
 	// Call the parent method.
 	// void
@@ -966,8 +1071,8 @@ func (o *Object) SetMessageTranslation(enable gdnative.Bool) {
 }
 
 /*
-        Set a metadata into the object. Metadata is serialized. Metadata can be [i]anything[/i].
-	Args: [{ false name String} { false value Variant}], Returns: void
+	        Set a metadata into the object. Metadata is serialized. Metadata can be [i]anything[/i].
+		Args: [{ false name String} { false value Variant}], Returns: void
 */
 func (o *Object) SetMeta(name gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling Object.SetMeta()")
@@ -978,7 +1083,7 @@ func (o *Object) SetMeta(name gdnative.String, value gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "set_meta")
+	methodBind := methodBindObjectSetMeta.Get()
 
 	// Call the parent method.
 	// void
@@ -988,18 +1093,18 @@ func (o *Object) SetMeta(name gdnative.String, value gdnative.Variant) {
 }
 
 /*
-        Set a script into the object, scripts extend the object functionality.
-	Args: [{ false script Reference}], Returns: void
+	        Set a script into the object, scripts extend the object functionality.
+		Args: [{ false script Reference}], Returns: void
 */
 func (o *Object) SetScript(script ReferenceImplementer) {
 	//log.Println("Calling Object.SetScript()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(script.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(script))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "set_script")
+	methodBind := methodBindObjectSetScript.Get()
 
 	// Call the parent method.
 	// void
@@ -1009,8 +1114,8 @@ func (o *Object) SetScript(script ReferenceImplementer) {
 }
 
 /*
-        Translate a message. Only works if message translation is enabled (which it is by default). See [method set_message_translation].
-	Args: [{ false message String}], Returns: String
+	        Translate a message. Only works if message translation is enabled (which it is by default). See [method set_message_translation].
+		Args: [{ false message String}], Returns: String
 */
 func (o *Object) Tr(message gdnative.String) gdnative.String {
 	//log.Println("Calling Object.Tr()")
@@ -1020,7 +1125,7 @@ func (o *Object) Tr(message gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(message)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Object", "tr")
+	methodBind := methodBindObjectTr.Get()
 
 	// Call the parent method.
 	// String
@@ -1032,6 +1137,46 @@ func (o *Object) Tr(message gdnative.String) gdnative.String {
 	return ret
 }
 
+// AddUserSignalWithDefaults will call AddUserSignal using the default values for: arguments.
+func (o *Object) AddUserSignalWithDefaults(signal gdnative.String) {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	o.AddUserSignal(signal, default0)
+}
+
+// ConnectWithDefaults will call Connect using the default values for: binds, flags.
+func (o *Object) ConnectWithDefaults(signal gdnative.String, target ObjectImplementer, method gdnative.String) gdnative.Error {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	return o.Connect(signal, target, method, default0, 0)
+}
+
+// NotificationWithDefaults will call Notification using the default values for: reversed.
+func (o *Object) NotificationWithDefaults(what gdnative.Int) {
+	o.Notification(what, false)
+}
+
+// Signals of the Object class.
+const (
+	ObjectSignalScriptChanged gdnative.String = "script_changed"
+)
+
+// ConnectScriptChanged will connect the "script_changed" signal to the given method of the target object.
+func (o *Object) ConnectScriptChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ObjectSignalScriptChanged, target, method, binds, 0)
+}
+
+// EmitScriptChanged will emit the "script_changed" signal.
+func (o *Object) EmitScriptChanged() error {
+
+	ret, err := o.EmitSignal(ObjectSignalScriptChanged)
+	ret.Destroy()
+	return err
+}
+
 // ObjectImplementer is an interface that implements the methods
 // of the Object class.
 type ObjectImplementer interface {
@@ -1042,12 +1187,13 @@ type ObjectImplementer interface {
 	X_Notification(what gdnative.Int)
 	X_Set(property gdnative.String, value gdnative.Variant) gdnative.Bool
 	AddUserSignal(signal gdnative.String, arguments gdnative.Array)
-	Call(method gdnative.String) gdnative.Variant
-	CallDeferred(method gdnative.String) gdnative.Variant
+	Call(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	CallDeferred(method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
 	Callv(method gdnative.String, argArray gdnative.Array) gdnative.Variant
 	CanTranslateMessages() gdnative.Bool
+	Connect(signal gdnative.String, target ObjectImplementer, method gdnative.String, binds gdnative.Array, flags gdnative.Int) gdnative.Error
 	Disconnect(signal gdnative.String, target ObjectImplementer, method gdnative.String)
-	EmitSignal(signal gdnative.String) gdnative.Variant
+	EmitSignal(signal gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
 	Free()
 	Get(property gdnative.String) gdnative.Variant
 	GetClass() gdnative.String
@@ -1077,4 +1223,9 @@ type ObjectImplementer interface {
 	SetMeta(name gdnative.String, value gdnative.Variant)
 	SetScript(script ReferenceImplementer)
 	Tr(message gdnative.String) gdnative.String
+	AddUserSignalWithDefaults(signal gdnative.String)
+	ConnectWithDefaults(signal gdnative.String, target ObjectImplementer, method gdnative.String) gdnative.Error
+	NotificationWithDefaults(what gdnative.Int)
+	ConnectScriptChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScriptChanged() error
 }
@@ -1,7 +1,11 @@
-package godot
+package object
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/audioeffect"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,13 +26,30 @@ const (
 	AudioServerSpeakerSurround71 AudioServerSpeakerMode = 3
 )
 
-//func NewaudioServerFromPointer(ptr gdnative.Pointer) audioServer {
-func newAudioServerFromPointer(ptr gdnative.Pointer) audioServer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := audioServer{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the AudioServerSpeakerMode value.
+func (e AudioServerSpeakerMode) String() string {
+	switch e {
+	case AudioServerSpeakerModeStereo:
+		return "SPEAKER_MODE_STEREO"
+	case AudioServerSpeakerSurround51:
+		return "SPEAKER_SURROUND_51"
+	case AudioServerSpeakerSurround71:
+		return "SPEAKER_SURROUND_71"
+	}
+	return fmt.Sprintf("AudioServerSpeakerMode(%d)", int(e))
+}
+
+// ParseAudioServerSpeakerMode will return the AudioServerSpeakerMode value with the given Godot name.
+func ParseAudioServerSpeakerMode(name string) (AudioServerSpeakerMode, error) {
+	switch name {
+	case "SPEAKER_MODE_STEREO":
+		return AudioServerSpeakerModeStereo, nil
+	case "SPEAKER_SURROUND_51":
+		return AudioServerSpeakerSurround51, nil
+	case "SPEAKER_SURROUND_71":
+		return AudioServerSpeakerSurround71, nil
+	}
+	return 0, fmt.Errorf("invalid AudioServerSpeakerMode value %q", name)
 }
 
 func newSingletonAudioServer() *audioServer {
@@ -36,7 +57,7 @@ func newSingletonAudioServer() *audioServer {
 }
 
 /*
-   AudioServer is a low level server interface for audio access. It is in charge of creating sample data (playable audio) as well as its playback via a voice interface.
+AudioServer is a low level server interface for audio access. It is in charge of creating sample data (playable audio) as well as its playback via a voice interface.
 */
 var AudioServer = newSingletonAudioServer()
 
@@ -44,7 +65,7 @@ var AudioServer = newSingletonAudioServer()
 AudioServer is a low level server interface for audio access. It is in charge of creating sample data (playable audio) as well as its playback via a voice interface.
 */
 type audioServer struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -65,9 +86,47 @@ func (o *audioServer) BaseClass() string {
 	return "AudioServer"
 }
 
+// Method binds of the AudioServer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAudioServerAddBus                  = gdnative.NewLazyMethodBind("AudioServer", "add_bus")
+	methodBindAudioServerAddBusEffect            = gdnative.NewLazyMethodBind("AudioServer", "add_bus_effect")
+	methodBindAudioServerGenerateBusLayout       = gdnative.NewLazyMethodBind("AudioServer", "generate_bus_layout")
+	methodBindAudioServerGetBusCount             = gdnative.NewLazyMethodBind("AudioServer", "get_bus_count")
+	methodBindAudioServerGetBusEffect            = gdnative.NewLazyMethodBind("AudioServer", "get_bus_effect")
+	methodBindAudioServerGetBusEffectCount       = gdnative.NewLazyMethodBind("AudioServer", "get_bus_effect_count")
+	methodBindAudioServerGetBusIndex             = gdnative.NewLazyMethodBind("AudioServer", "get_bus_index")
+	methodBindAudioServerGetBusName              = gdnative.NewLazyMethodBind("AudioServer", "get_bus_name")
+	methodBindAudioServerGetBusPeakVolumeLeftDb  = gdnative.NewLazyMethodBind("AudioServer", "get_bus_peak_volume_left_db")
+	methodBindAudioServerGetBusPeakVolumeRightDb = gdnative.NewLazyMethodBind("AudioServer", "get_bus_peak_volume_right_db")
+	methodBindAudioServerGetBusSend              = gdnative.NewLazyMethodBind("AudioServer", "get_bus_send")
+	methodBindAudioServerGetBusVolumeDb          = gdnative.NewLazyMethodBind("AudioServer", "get_bus_volume_db")
+	methodBindAudioServerGetMixRate              = gdnative.NewLazyMethodBind("AudioServer", "get_mix_rate")
+	methodBindAudioServerGetSpeakerMode          = gdnative.NewLazyMethodBind("AudioServer", "get_speaker_mode")
+	methodBindAudioServerIsBusBypassingEffects   = gdnative.NewLazyMethodBind("AudioServer", "is_bus_bypassing_effects")
+	methodBindAudioServerIsBusEffectEnabled      = gdnative.NewLazyMethodBind("AudioServer", "is_bus_effect_enabled")
+	methodBindAudioServerIsBusMute               = gdnative.NewLazyMethodBind("AudioServer", "is_bus_mute")
+	methodBindAudioServerIsBusSolo               = gdnative.NewLazyMethodBind("AudioServer", "is_bus_solo")
+	methodBindAudioServerLock                    = gdnative.NewLazyMethodBind("AudioServer", "lock")
+	methodBindAudioServerMoveBus                 = gdnative.NewLazyMethodBind("AudioServer", "move_bus")
+	methodBindAudioServerRemoveBus               = gdnative.NewLazyMethodBind("AudioServer", "remove_bus")
+	methodBindAudioServerRemoveBusEffect         = gdnative.NewLazyMethodBind("AudioServer", "remove_bus_effect")
+	methodBindAudioServerSetBusBypassEffects     = gdnative.NewLazyMethodBind("AudioServer", "set_bus_bypass_effects")
+	methodBindAudioServerSetBusCount             = gdnative.NewLazyMethodBind("AudioServer", "set_bus_count")
+	methodBindAudioServerSetBusEffectEnabled     = gdnative.NewLazyMethodBind("AudioServer", "set_bus_effect_enabled")
+	methodBindAudioServerSetBusLayout            = gdnative.NewLazyMethodBind("AudioServer", "set_bus_layout")
+	methodBindAudioServerSetBusMute              = gdnative.NewLazyMethodBind("AudioServer", "set_bus_mute")
+	methodBindAudioServerSetBusName              = gdnative.NewLazyMethodBind("AudioServer", "set_bus_name")
+	methodBindAudioServerSetBusSend              = gdnative.NewLazyMethodBind("AudioServer", "set_bus_send")
+	methodBindAudioServerSetBusSolo              = gdnative.NewLazyMethodBind("AudioServer", "set_bus_solo")
+	methodBindAudioServerSetBusVolumeDb          = gdnative.NewLazyMethodBind("AudioServer", "set_bus_volume_db")
+	methodBindAudioServerSwapBusEffects          = gdnative.NewLazyMethodBind("AudioServer", "swap_bus_effects")
+	methodBindAudioServerUnlock                  = gdnative.NewLazyMethodBind("AudioServer", "unlock")
+)
+
 /*
-        Adds a bus at [code]at_position[/code].
-	Args: [{-1 true at_position int}], Returns: void
+	        Adds a bus at [code]at_position[/code].
+		Args: [{-1 true at_position int}], Returns: void
 */
 func (o *audioServer) AddBus(atPosition gdnative.Int) {
 	o.ensureSingleton()
@@ -78,7 +137,7 @@ func (o *audioServer) AddBus(atPosition gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(atPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "add_bus")
+	methodBind := methodBindAudioServerAddBus.Get()
 
 	// Call the parent method.
 	// void
@@ -88,21 +147,21 @@ func (o *audioServer) AddBus(atPosition gdnative.Int) {
 }
 
 /*
-        Adds an [AudioEffect] effect to the bus [code]bus_idx[/code] at [code]at_position[/code].
-	Args: [{ false bus_idx int} { false effect AudioEffect} {-1 true at_position int}], Returns: void
+	        Adds an [AudioEffect] effect to the bus [code]bus_idx[/code] at [code]at_position[/code].
+		Args: [{ false bus_idx int} { false effect AudioEffect} {-1 true at_position int}], Returns: void
 */
-func (o *audioServer) AddBusEffect(busIdx gdnative.Int, effect AudioEffectImplementer, atPosition gdnative.Int) {
+func (o *audioServer) AddBusEffect(busIdx gdnative.Int, effect audioeffect.AudioEffectImplementer, atPosition gdnative.Int) {
 	o.ensureSingleton()
 	//log.Println("Calling AudioServer.AddBusEffect()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(effect.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(effect))
 	ptrArguments[2] = gdnative.NewPointerFromInt(atPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "add_bus_effect")
+	methodBind := methodBindAudioServerAddBusEffect.Get()
 
 	// Call the parent method.
 	// void
@@ -112,10 +171,10 @@ func (o *audioServer) AddBusEffect(busIdx gdnative.Int, effect AudioEffectImplem
 }
 
 /*
-        Generates an [AudioBusLayout] using the available busses and effects.
-	Args: [], Returns: AudioBusLayout
+	        Generates an [AudioBusLayout] using the available busses and effects.
+		Args: [], Returns: AudioBusLayout
 */
-func (o *audioServer) GenerateBusLayout() AudioBusLayoutImplementer {
+func (o *audioServer) GenerateBusLayout() godot.AudioBusLayoutImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling AudioServer.GenerateBusLayout()")
 
@@ -123,7 +182,7 @@ func (o *audioServer) GenerateBusLayout() AudioBusLayoutImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "generate_bus_layout")
+	methodBind := methodBindAudioServerGenerateBusLayout.Get()
 
 	// Call the parent method.
 	// AudioBusLayout
@@ -131,27 +190,25 @@ func (o *audioServer) GenerateBusLayout() AudioBusLayoutImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAudioBusLayoutFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AudioBusLayoutImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "AudioBusLayout" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AudioBusLayoutImplementer)
+	ret := godot.AudioBusLayout{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.AudioBusLayoutImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of available busses.
-	Args: [], Returns: int
+	        Returns the number of available busses.
+		Args: [], Returns: int
 */
 func (o *audioServer) GetBusCount() gdnative.Int {
 	o.ensureSingleton()
@@ -161,7 +218,7 @@ func (o *audioServer) GetBusCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_count")
+	methodBind := methodBindAudioServerGetBusCount.Get()
 
 	// Call the parent method.
 	// int
@@ -174,10 +231,10 @@ func (o *audioServer) GetBusCount() gdnative.Int {
 }
 
 /*
-        Returns the [AudioEffect] at position [code]effect_idx[/code] in bus [code]bus_idx[/code].
-	Args: [{ false bus_idx int} { false effect_idx int}], Returns: AudioEffect
+	        Returns the [AudioEffect] at position [code]effect_idx[/code] in bus [code]bus_idx[/code].
+		Args: [{ false bus_idx int} { false effect_idx int}], Returns: AudioEffect
 */
-func (o *audioServer) GetBusEffect(busIdx gdnative.Int, effectIdx gdnative.Int) AudioEffectImplementer {
+func (o *audioServer) GetBusEffect(busIdx gdnative.Int, effectIdx gdnative.Int) audioeffect.AudioEffectImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling AudioServer.GetBusEffect()")
 
@@ -187,7 +244,7 @@ func (o *audioServer) GetBusEffect(busIdx gdnative.Int, effectIdx gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(effectIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_effect")
+	methodBind := methodBindAudioServerGetBusEffect.Get()
 
 	// Call the parent method.
 	// AudioEffect
@@ -195,27 +252,25 @@ func (o *audioServer) GetBusEffect(busIdx gdnative.Int, effectIdx gdnative.Int)
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newAudioEffectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(AudioEffectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "AudioEffect" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(AudioEffectImplementer)
+	ret := audioeffect.AudioEffect{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(audioeffect.AudioEffectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of effects on the bus at [code]bus_idx[/code].
-	Args: [{ false bus_idx int}], Returns: int
+	        Returns the number of effects on the bus at [code]bus_idx[/code].
+		Args: [{ false bus_idx int}], Returns: int
 */
 func (o *audioServer) GetBusEffectCount(busIdx gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -226,7 +281,7 @@ func (o *audioServer) GetBusEffectCount(busIdx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_effect_count")
+	methodBind := methodBindAudioServerGetBusEffectCount.Get()
 
 	// Call the parent method.
 	// int
@@ -239,8 +294,8 @@ func (o *audioServer) GetBusEffectCount(busIdx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the index of the bus with the name [code]bus_name[/code].
-	Args: [{ false bus_name String}], Returns: int
+	        Returns the index of the bus with the name [code]bus_name[/code].
+		Args: [{ false bus_name String}], Returns: int
 */
 func (o *audioServer) GetBusIndex(busName gdnative.String) gdnative.Int {
 	o.ensureSingleton()
@@ -251,7 +306,7 @@ func (o *audioServer) GetBusIndex(busName gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(busName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_index")
+	methodBind := methodBindAudioServerGetBusIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -264,8 +319,8 @@ func (o *audioServer) GetBusIndex(busName gdnative.String) gdnative.Int {
 }
 
 /*
-        Returns the name of the bus with the index [code]bus_idx[/code].
-	Args: [{ false bus_idx int}], Returns: String
+	        Returns the name of the bus with the index [code]bus_idx[/code].
+		Args: [{ false bus_idx int}], Returns: String
 */
 func (o *audioServer) GetBusName(busIdx gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -276,7 +331,7 @@ func (o *audioServer) GetBusName(busIdx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_name")
+	methodBind := methodBindAudioServerGetBusName.Get()
 
 	// Call the parent method.
 	// String
@@ -289,8 +344,8 @@ func (o *audioServer) GetBusName(busIdx gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the peak volume of the left speaker at bus index [code]bus_idx[/code] and channel index [code]channel[/code].
-	Args: [{ false bus_idx int} { false channel int}], Returns: float
+	        Returns the peak volume of the left speaker at bus index [code]bus_idx[/code] and channel index [code]channel[/code].
+		Args: [{ false bus_idx int} { false channel int}], Returns: float
 */
 func (o *audioServer) GetBusPeakVolumeLeftDb(busIdx gdnative.Int, channel gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -302,7 +357,7 @@ func (o *audioServer) GetBusPeakVolumeLeftDb(busIdx gdnative.Int, channel gdnati
 	ptrArguments[1] = gdnative.NewPointerFromInt(channel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_peak_volume_left_db")
+	methodBind := methodBindAudioServerGetBusPeakVolumeLeftDb.Get()
 
 	// Call the parent method.
 	// float
@@ -315,8 +370,8 @@ func (o *audioServer) GetBusPeakVolumeLeftDb(busIdx gdnative.Int, channel gdnati
 }
 
 /*
-        Returns the peak volume of the right speaker at bus index [code]bus_idx[/code] and channel index [code]channel[/code].
-	Args: [{ false bus_idx int} { false channel int}], Returns: float
+	        Returns the peak volume of the right speaker at bus index [code]bus_idx[/code] and channel index [code]channel[/code].
+		Args: [{ false bus_idx int} { false channel int}], Returns: float
 */
 func (o *audioServer) GetBusPeakVolumeRightDb(busIdx gdnative.Int, channel gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -328,7 +383,7 @@ func (o *audioServer) GetBusPeakVolumeRightDb(busIdx gdnative.Int, channel gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(channel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_peak_volume_right_db")
+	methodBind := methodBindAudioServerGetBusPeakVolumeRightDb.Get()
 
 	// Call the parent method.
 	// float
@@ -341,8 +396,8 @@ func (o *audioServer) GetBusPeakVolumeRightDb(busIdx gdnative.Int, channel gdnat
 }
 
 /*
-        Returns the name of the bus that the bus at index [code]bus_idx[/code] sends to.
-	Args: [{ false bus_idx int}], Returns: String
+	        Returns the name of the bus that the bus at index [code]bus_idx[/code] sends to.
+		Args: [{ false bus_idx int}], Returns: String
 */
 func (o *audioServer) GetBusSend(busIdx gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -353,7 +408,7 @@ func (o *audioServer) GetBusSend(busIdx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_send")
+	methodBind := methodBindAudioServerGetBusSend.Get()
 
 	// Call the parent method.
 	// String
@@ -366,8 +421,8 @@ func (o *audioServer) GetBusSend(busIdx gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the volume of the bus at index [code]bus_idx[/code] in dB.
-	Args: [{ false bus_idx int}], Returns: float
+	        Returns the volume of the bus at index [code]bus_idx[/code] in dB.
+		Args: [{ false bus_idx int}], Returns: float
 */
 func (o *audioServer) GetBusVolumeDb(busIdx gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -378,7 +433,7 @@ func (o *audioServer) GetBusVolumeDb(busIdx gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_bus_volume_db")
+	methodBind := methodBindAudioServerGetBusVolumeDb.Get()
 
 	// Call the parent method.
 	// float
@@ -391,8 +446,8 @@ func (o *audioServer) GetBusVolumeDb(busIdx gdnative.Int) gdnative.Real {
 }
 
 /*
-        Returns the sample rate at the output of the audioserver.
-	Args: [], Returns: float
+	        Returns the sample rate at the output of the audioserver.
+		Args: [], Returns: float
 */
 func (o *audioServer) GetMixRate() gdnative.Real {
 	o.ensureSingleton()
@@ -402,7 +457,7 @@ func (o *audioServer) GetMixRate() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_mix_rate")
+	methodBind := methodBindAudioServerGetMixRate.Get()
 
 	// Call the parent method.
 	// float
@@ -415,8 +470,8 @@ func (o *audioServer) GetMixRate() gdnative.Real {
 }
 
 /*
-        Returns the speaker configuration.
-	Args: [], Returns: enum.AudioServer::SpeakerMode
+	        Returns the speaker configuration.
+		Args: [], Returns: enum.AudioServer::SpeakerMode
 */
 func (o *audioServer) GetSpeakerMode() AudioServerSpeakerMode {
 	o.ensureSingleton()
@@ -426,7 +481,7 @@ func (o *audioServer) GetSpeakerMode() AudioServerSpeakerMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "get_speaker_mode")
+	methodBind := methodBindAudioServerGetSpeakerMode.Get()
 
 	// Call the parent method.
 	// enum.AudioServer::SpeakerMode
@@ -439,8 +494,8 @@ func (o *audioServer) GetSpeakerMode() AudioServerSpeakerMode {
 }
 
 /*
-        If [code]true[/code] the bus at index [code]bus_idx[/code] is bypassing effects.
-	Args: [{ false bus_idx int}], Returns: bool
+	        If [code]true[/code] the bus at index [code]bus_idx[/code] is bypassing effects.
+		Args: [{ false bus_idx int}], Returns: bool
 */
 func (o *audioServer) IsBusBypassingEffects(busIdx gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -451,7 +506,7 @@ func (o *audioServer) IsBusBypassingEffects(busIdx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "is_bus_bypassing_effects")
+	methodBind := methodBindAudioServerIsBusBypassingEffects.Get()
 
 	// Call the parent method.
 	// bool
@@ -464,8 +519,8 @@ func (o *audioServer) IsBusBypassingEffects(busIdx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        If [code]true[/code] the effect at index [code]effect_idx[/code] on the bus at index [code]bus_idx[/code] is enabled.
-	Args: [{ false bus_idx int} { false effect_idx int}], Returns: bool
+	        If [code]true[/code] the effect at index [code]effect_idx[/code] on the bus at index [code]bus_idx[/code] is enabled.
+		Args: [{ false bus_idx int} { false effect_idx int}], Returns: bool
 */
 func (o *audioServer) IsBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -477,7 +532,7 @@ func (o *audioServer) IsBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(effectIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "is_bus_effect_enabled")
+	methodBind := methodBindAudioServerIsBusEffectEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -490,8 +545,8 @@ func (o *audioServer) IsBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnative
 }
 
 /*
-        If [code]true[/code] the bus at index [code]bus_idx[/code] is muted.
-	Args: [{ false bus_idx int}], Returns: bool
+	        If [code]true[/code] the bus at index [code]bus_idx[/code] is muted.
+		Args: [{ false bus_idx int}], Returns: bool
 */
 func (o *audioServer) IsBusMute(busIdx gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -502,7 +557,7 @@ func (o *audioServer) IsBusMute(busIdx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "is_bus_mute")
+	methodBind := methodBindAudioServerIsBusMute.Get()
 
 	// Call the parent method.
 	// bool
@@ -515,8 +570,8 @@ func (o *audioServer) IsBusMute(busIdx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        If [code]true[/code] the bus at index [code]bus_idx[/code] is in solo mode.
-	Args: [{ false bus_idx int}], Returns: bool
+	        If [code]true[/code] the bus at index [code]bus_idx[/code] is in solo mode.
+		Args: [{ false bus_idx int}], Returns: bool
 */
 func (o *audioServer) IsBusSolo(busIdx gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -527,7 +582,7 @@ func (o *audioServer) IsBusSolo(busIdx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(busIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "is_bus_solo")
+	methodBind := methodBindAudioServerIsBusSolo.Get()
 
 	// Call the parent method.
 	// bool
@@ -540,8 +595,8 @@ func (o *audioServer) IsBusSolo(busIdx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Locks the audio drivers mainloop. Remember to unlock it afterwards.
-	Args: [], Returns: void
+	        Locks the audio drivers mainloop. Remember to unlock it afterwards.
+		Args: [], Returns: void
 */
 func (o *audioServer) Lock() {
 	o.ensureSingleton()
@@ -551,7 +606,7 @@ func (o *audioServer) Lock() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "lock")
+	methodBind := methodBindAudioServerLock.Get()
 
 	// Call the parent method.
 	// void
@@ -561,8 +616,8 @@ func (o *audioServer) Lock() {
 }
 
 /*
-        Moves the bus from index [code]index[/code] to index [code]to_index[/code].
-	Args: [{ false index int} { false to_index int}], Returns: void
+	        Moves the bus from index [code]index[/code] to index [code]to_index[/code].
+		Args: [{ false index int} { false to_index int}], Returns: void
 */
 func (o *audioServer) MoveBus(index gdnative.Int, toIndex gdnative.Int) {
 	o.ensureSingleton()
@@ -574,7 +629,7 @@ func (o *audioServer) MoveBus(index gdnative.Int, toIndex gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(toIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "move_bus")
+	methodBind := methodBindAudioServerMoveBus.Get()
 
 	// Call the parent method.
 	// void
@@ -584,8 +639,8 @@ func (o *audioServer) MoveBus(index gdnative.Int, toIndex gdnative.Int) {
 }
 
 /*
-        Removes the bus at index [code]index[/code].
-	Args: [{ false index int}], Returns: void
+	        Removes the bus at index [code]index[/code].
+		Args: [{ false index int}], Returns: void
 */
 func (o *audioServer) RemoveBus(index gdnative.Int) {
 	o.ensureSingleton()
@@ -596,7 +651,7 @@ func (o *audioServer) RemoveBus(index gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "remove_bus")
+	methodBind := methodBindAudioServerRemoveBus.Get()
 
 	// Call the parent method.
 	// void
@@ -606,8 +661,8 @@ func (o *audioServer) RemoveBus(index gdnative.Int) {
 }
 
 /*
-        Removes the effect at index [code]effect_idx[/code] from the bus at index [code]bus_idx[/code].
-	Args: [{ false bus_idx int} { false effect_idx int}], Returns: void
+	        Removes the effect at index [code]effect_idx[/code] from the bus at index [code]bus_idx[/code].
+		Args: [{ false bus_idx int} { false effect_idx int}], Returns: void
 */
 func (o *audioServer) RemoveBusEffect(busIdx gdnative.Int, effectIdx gdnative.Int) {
 	o.ensureSingleton()
@@ -619,7 +674,7 @@ func (o *audioServer) RemoveBusEffect(busIdx gdnative.Int, effectIdx gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(effectIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "remove_bus_effect")
+	methodBind := methodBindAudioServerRemoveBusEffect.Get()
 
 	// Call the parent method.
 	// void
@@ -629,8 +684,8 @@ func (o *audioServer) RemoveBusEffect(busIdx gdnative.Int, effectIdx gdnative.In
 }
 
 /*
-        If [code]true[/code] the bus at index [code]bus_idx[/code] is bypassing effects.
-	Args: [{ false bus_idx int} { false enable bool}], Returns: void
+	        If [code]true[/code] the bus at index [code]bus_idx[/code] is bypassing effects.
+		Args: [{ false bus_idx int} { false enable bool}], Returns: void
 */
 func (o *audioServer) SetBusBypassEffects(busIdx gdnative.Int, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -642,7 +697,7 @@ func (o *audioServer) SetBusBypassEffects(busIdx gdnative.Int, enable gdnative.B
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_bypass_effects")
+	methodBind := methodBindAudioServerSetBusBypassEffects.Get()
 
 	// Call the parent method.
 	// void
@@ -652,8 +707,8 @@ func (o *audioServer) SetBusBypassEffects(busIdx gdnative.Int, enable gdnative.B
 }
 
 /*
-        Adds and removes busses to make the number of busses match [code]amount[/code].
-	Args: [{ false amount int}], Returns: void
+	        Adds and removes busses to make the number of busses match [code]amount[/code].
+		Args: [{ false amount int}], Returns: void
 */
 func (o *audioServer) SetBusCount(amount gdnative.Int) {
 	o.ensureSingleton()
@@ -664,7 +719,7 @@ func (o *audioServer) SetBusCount(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_count")
+	methodBind := methodBindAudioServerSetBusCount.Get()
 
 	// Call the parent method.
 	// void
@@ -674,8 +729,8 @@ func (o *audioServer) SetBusCount(amount gdnative.Int) {
 }
 
 /*
-        If [code]true[/code] the effect at index [code]effect_idx[/code] on the bus at index [code]bus_idx[/code] is enabled.
-	Args: [{ false bus_idx int} { false effect_idx int} { false enabled bool}], Returns: void
+	        If [code]true[/code] the effect at index [code]effect_idx[/code] on the bus at index [code]bus_idx[/code] is enabled.
+		Args: [{ false bus_idx int} { false effect_idx int} { false enabled bool}], Returns: void
 */
 func (o *audioServer) SetBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnative.Int, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -688,7 +743,7 @@ func (o *audioServer) SetBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_effect_enabled")
+	methodBind := methodBindAudioServerSetBusEffectEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -698,19 +753,19 @@ func (o *audioServer) SetBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnativ
 }
 
 /*
-        Overwrites the currently used [AudioBusLayout].
-	Args: [{ false bus_layout AudioBusLayout}], Returns: void
+	        Overwrites the currently used [AudioBusLayout].
+		Args: [{ false bus_layout AudioBusLayout}], Returns: void
 */
-func (o *audioServer) SetBusLayout(busLayout AudioBusLayoutImplementer) {
+func (o *audioServer) SetBusLayout(busLayout godot.AudioBusLayoutImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling AudioServer.SetBusLayout()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(busLayout.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(busLayout))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_layout")
+	methodBind := methodBindAudioServerSetBusLayout.Get()
 
 	// Call the parent method.
 	// void
@@ -720,8 +775,8 @@ func (o *audioServer) SetBusLayout(busLayout AudioBusLayoutImplementer) {
 }
 
 /*
-        If [code]true[/code] the bus at index [code]bus_idx[/code] is muted.
-	Args: [{ false bus_idx int} { false enable bool}], Returns: void
+	        If [code]true[/code] the bus at index [code]bus_idx[/code] is muted.
+		Args: [{ false bus_idx int} { false enable bool}], Returns: void
 */
 func (o *audioServer) SetBusMute(busIdx gdnative.Int, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -733,7 +788,7 @@ func (o *audioServer) SetBusMute(busIdx gdnative.Int, enable gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_mute")
+	methodBind := methodBindAudioServerSetBusMute.Get()
 
 	// Call the parent method.
 	// void
@@ -743,8 +798,8 @@ func (o *audioServer) SetBusMute(busIdx gdnative.Int, enable gdnative.Bool) {
 }
 
 /*
-        Sets the name of the bus at index [code]bus_idx[/code] to [code]name[/code].
-	Args: [{ false bus_idx int} { false name String}], Returns: void
+	        Sets the name of the bus at index [code]bus_idx[/code] to [code]name[/code].
+		Args: [{ false bus_idx int} { false name String}], Returns: void
 */
 func (o *audioServer) SetBusName(busIdx gdnative.Int, name gdnative.String) {
 	o.ensureSingleton()
@@ -756,7 +811,7 @@ func (o *audioServer) SetBusName(busIdx gdnative.Int, name gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_name")
+	methodBind := methodBindAudioServerSetBusName.Get()
 
 	// Call the parent method.
 	// void
@@ -766,8 +821,8 @@ func (o *audioServer) SetBusName(busIdx gdnative.Int, name gdnative.String) {
 }
 
 /*
-        Connects the output of the bus at [code]bus_idx[/code] to the bus named [code]send[/code].
-	Args: [{ false bus_idx int} { false send String}], Returns: void
+	        Connects the output of the bus at [code]bus_idx[/code] to the bus named [code]send[/code].
+		Args: [{ false bus_idx int} { false send String}], Returns: void
 */
 func (o *audioServer) SetBusSend(busIdx gdnative.Int, send gdnative.String) {
 	o.ensureSingleton()
@@ -779,7 +834,7 @@ func (o *audioServer) SetBusSend(busIdx gdnative.Int, send gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(send)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_send")
+	methodBind := methodBindAudioServerSetBusSend.Get()
 
 	// Call the parent method.
 	// void
@@ -789,8 +844,8 @@ func (o *audioServer) SetBusSend(busIdx gdnative.Int, send gdnative.String) {
 }
 
 /*
-        If [code]true[/code] the bus at index [code]bus_idx[/code] is in solo mode.
-	Args: [{ false bus_idx int} { false enable bool}], Returns: void
+	        If [code]true[/code] the bus at index [code]bus_idx[/code] is in solo mode.
+		Args: [{ false bus_idx int} { false enable bool}], Returns: void
 */
 func (o *audioServer) SetBusSolo(busIdx gdnative.Int, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -802,7 +857,7 @@ func (o *audioServer) SetBusSolo(busIdx gdnative.Int, enable gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_solo")
+	methodBind := methodBindAudioServerSetBusSolo.Get()
 
 	// Call the parent method.
 	// void
@@ -812,8 +867,8 @@ func (o *audioServer) SetBusSolo(busIdx gdnative.Int, enable gdnative.Bool) {
 }
 
 /*
-        Sets the volume of the bus at index [code]bus_idx[/code] to [code]volume_db[/code].
-	Args: [{ false bus_idx int} { false volume_db float}], Returns: void
+	        Sets the volume of the bus at index [code]bus_idx[/code] to [code]volume_db[/code].
+		Args: [{ false bus_idx int} { false volume_db float}], Returns: void
 */
 func (o *audioServer) SetBusVolumeDb(busIdx gdnative.Int, volumeDb gdnative.Real) {
 	o.ensureSingleton()
@@ -825,7 +880,7 @@ func (o *audioServer) SetBusVolumeDb(busIdx gdnative.Int, volumeDb gdnative.Real
 	ptrArguments[1] = gdnative.NewPointerFromReal(volumeDb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "set_bus_volume_db")
+	methodBind := methodBindAudioServerSetBusVolumeDb.Get()
 
 	// Call the parent method.
 	// void
@@ -835,8 +890,8 @@ func (o *audioServer) SetBusVolumeDb(busIdx gdnative.Int, volumeDb gdnative.Real
 }
 
 /*
-        Swaps the position of two effects in bus [code]bus_idx[/code].
-	Args: [{ false bus_idx int} { false effect_idx int} { false by_effect_idx int}], Returns: void
+	        Swaps the position of two effects in bus [code]bus_idx[/code].
+		Args: [{ false bus_idx int} { false effect_idx int} { false by_effect_idx int}], Returns: void
 */
 func (o *audioServer) SwapBusEffects(busIdx gdnative.Int, effectIdx gdnative.Int, byEffectIdx gdnative.Int) {
 	o.ensureSingleton()
@@ -849,7 +904,7 @@ func (o *audioServer) SwapBusEffects(busIdx gdnative.Int, effectIdx gdnative.Int
 	ptrArguments[2] = gdnative.NewPointerFromInt(byEffectIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "swap_bus_effects")
+	methodBind := methodBindAudioServerSwapBusEffects.Get()
 
 	// Call the parent method.
 	// void
@@ -859,8 +914,8 @@ func (o *audioServer) SwapBusEffects(busIdx gdnative.Int, effectIdx gdnative.Int
 }
 
 /*
-        Unlocks the audiodriver's main loop. After locking it always unlock it.
-	Args: [], Returns: void
+	        Unlocks the audiodriver's main loop. After locking it always unlock it.
+		Args: [], Returns: void
 */
 func (o *audioServer) Unlock() {
 	o.ensureSingleton()
@@ -870,7 +925,7 @@ func (o *audioServer) Unlock() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AudioServer", "unlock")
+	methodBind := methodBindAudioServerUnlock.Get()
 
 	// Call the parent method.
 	// void
@@ -879,15 +934,48 @@ func (o *audioServer) Unlock() {
 
 }
 
+// AddBusWithDefaults will call AddBus using the default values for: at_position.
+func (o *audioServer) AddBusWithDefaults() {
+	o.AddBus(-1)
+}
+
+// AddBusEffectWithDefaults will call AddBusEffect using the default values for: at_position.
+func (o *audioServer) AddBusEffectWithDefaults(busIdx gdnative.Int, effect audioeffect.AudioEffectImplementer) {
+	o.AddBusEffect(busIdx, effect, -1)
+}
+
+// Signals of the AudioServer class.
+const (
+	AudioServerSignalBusLayoutChanged gdnative.String = "bus_layout_changed"
+)
+
+// ConnectBusLayoutChanged will connect the "bus_layout_changed" signal to the given method of the target object.
+func (o *audioServer) ConnectBusLayoutChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AudioServerSignalBusLayoutChanged, target, method, binds, 0)
+}
+
+// EmitBusLayoutChanged will emit the "bus_layout_changed" signal.
+func (o *audioServer) EmitBusLayoutChanged() error {
+	o.ensureSingleton()
+
+	ret, err := o.EmitSignal(AudioServerSignalBusLayoutChanged)
+	ret.Destroy()
+	return err
+}
+
 // AudioServerImplementer is an interface that implements the methods
 // of the AudioServer class.
 type AudioServerImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	AddBus(atPosition gdnative.Int)
-	AddBusEffect(busIdx gdnative.Int, effect AudioEffectImplementer, atPosition gdnative.Int)
-	GenerateBusLayout() AudioBusLayoutImplementer
+	AddBusEffect(busIdx gdnative.Int, effect audioeffect.AudioEffectImplementer, atPosition gdnative.Int)
+	GenerateBusLayout() godot.AudioBusLayoutImplementer
 	GetBusCount() gdnative.Int
-	GetBusEffect(busIdx gdnative.Int, effectIdx gdnative.Int) AudioEffectImplementer
+	GetBusEffect(busIdx gdnative.Int, effectIdx gdnative.Int) audioeffect.AudioEffectImplementer
 	GetBusEffectCount(busIdx gdnative.Int) gdnative.Int
 	GetBusIndex(busName gdnative.String) gdnative.Int
 	GetBusName(busIdx gdnative.Int) gdnative.String
@@ -896,6 +984,7 @@ type AudioServerImplementer interface {
 	GetBusSend(busIdx gdnative.Int) gdnative.String
 	GetBusVolumeDb(busIdx gdnative.Int) gdnative.Real
 	GetMixRate() gdnative.Real
+	GetSpeakerMode() AudioServerSpeakerMode
 	IsBusBypassingEffects(busIdx gdnative.Int) gdnative.Bool
 	IsBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnative.Int) gdnative.Bool
 	IsBusMute(busIdx gdnative.Int) gdnative.Bool
@@ -907,7 +996,7 @@ type AudioServerImplementer interface {
 	SetBusBypassEffects(busIdx gdnative.Int, enable gdnative.Bool)
 	SetBusCount(amount gdnative.Int)
 	SetBusEffectEnabled(busIdx gdnative.Int, effectIdx gdnative.Int, enabled gdnative.Bool)
-	SetBusLayout(busLayout AudioBusLayoutImplementer)
+	SetBusLayout(busLayout godot.AudioBusLayoutImplementer)
 	SetBusMute(busIdx gdnative.Int, enable gdnative.Bool)
 	SetBusName(busIdx gdnative.Int, name gdnative.String)
 	SetBusSend(busIdx gdnative.Int, send gdnative.String)
@@ -915,4 +1004,8 @@ type AudioServerImplementer interface {
 	SetBusVolumeDb(busIdx gdnative.Int, volumeDb gdnative.Real)
 	SwapBusEffects(busIdx gdnative.Int, effectIdx gdnative.Int, byEffectIdx gdnative.Int)
 	Unlock()
+	AddBusWithDefaults()
+	AddBusEffectWithDefaults(busIdx gdnative.Int, effect audioeffect.AudioEffectImplementer)
+	ConnectBusLayoutChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBusLayoutChanged() error
 }
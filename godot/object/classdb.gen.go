@@ -1,7 +1,8 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +14,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewclassDbFromPointer(ptr gdnative.Pointer) classDb {
-func new_ClassDBFromPointer(ptr gdnative.Pointer) classDb {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := classDb{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonClassDB() *classDb {
 	return &classDb{}
 }
 
 /*
-   Provides access to metadata stored for every available class.
+Provides access to metadata stored for every available class.
 */
 var ClassDB = newSingletonClassDB()
 
@@ -35,7 +27,7 @@ var ClassDB = newSingletonClassDB()
 Provides access to metadata stored for every available class.
 */
 type classDb struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,9 +48,34 @@ func (o *classDb) BaseClass() string {
 	return "_ClassDB"
 }
 
+// Method binds of the ClassDB class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindClassDBCanInstance                 = gdnative.NewLazyMethodBind("_ClassDB", "can_instance")
+	methodBindClassDBClassExists                 = gdnative.NewLazyMethodBind("_ClassDB", "class_exists")
+	methodBindClassDBClassGetCategory            = gdnative.NewLazyMethodBind("_ClassDB", "class_get_category")
+	methodBindClassDBClassGetIntegerConstant     = gdnative.NewLazyMethodBind("_ClassDB", "class_get_integer_constant")
+	methodBindClassDBClassGetIntegerConstantList = gdnative.NewLazyMethodBind("_ClassDB", "class_get_integer_constant_list")
+	methodBindClassDBClassGetMethodList          = gdnative.NewLazyMethodBind("_ClassDB", "class_get_method_list")
+	methodBindClassDBClassGetProperty            = gdnative.NewLazyMethodBind("_ClassDB", "class_get_property")
+	methodBindClassDBClassGetPropertyList        = gdnative.NewLazyMethodBind("_ClassDB", "class_get_property_list")
+	methodBindClassDBClassGetSignal              = gdnative.NewLazyMethodBind("_ClassDB", "class_get_signal")
+	methodBindClassDBClassGetSignalList          = gdnative.NewLazyMethodBind("_ClassDB", "class_get_signal_list")
+	methodBindClassDBClassHasIntegerConstant     = gdnative.NewLazyMethodBind("_ClassDB", "class_has_integer_constant")
+	methodBindClassDBClassHasMethod              = gdnative.NewLazyMethodBind("_ClassDB", "class_has_method")
+	methodBindClassDBClassHasSignal              = gdnative.NewLazyMethodBind("_ClassDB", "class_has_signal")
+	methodBindClassDBClassSetProperty            = gdnative.NewLazyMethodBind("_ClassDB", "class_set_property")
+	methodBindClassDBGetClassList                = gdnative.NewLazyMethodBind("_ClassDB", "get_class_list")
+	methodBindClassDBGetInheritersFromClass      = gdnative.NewLazyMethodBind("_ClassDB", "get_inheriters_from_class")
+	methodBindClassDBGetParentClass              = gdnative.NewLazyMethodBind("_ClassDB", "get_parent_class")
+	methodBindClassDBInstance                    = gdnative.NewLazyMethodBind("_ClassDB", "instance")
+	methodBindClassDBIsClassEnabled              = gdnative.NewLazyMethodBind("_ClassDB", "is_class_enabled")
+	methodBindClassDBIsParentClass               = gdnative.NewLazyMethodBind("_ClassDB", "is_parent_class")
+)
+
 /*
-        Undocumented
-	Args: [{ false class String}], Returns: bool
+	        Undocumented
+		Args: [{ false class String}], Returns: bool
 */
 func (o *classDb) CanInstance(class gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -69,7 +86,7 @@ func (o *classDb) CanInstance(class gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(class)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "can_instance")
+	methodBind := methodBindClassDBCanInstance.Get()
 
 	// Call the parent method.
 	// bool
@@ -82,8 +99,8 @@ func (o *classDb) CanInstance(class gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false class String}], Returns: bool
+	        Undocumented
+		Args: [{ false class String}], Returns: bool
 */
 func (o *classDb) ClassExists(class gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -94,7 +111,7 @@ func (o *classDb) ClassExists(class gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(class)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_exists")
+	methodBind := methodBindClassDBClassExists.Get()
 
 	// Call the parent method.
 	// bool
@@ -107,8 +124,8 @@ func (o *classDb) ClassExists(class gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false class String}], Returns: String
+	        Undocumented
+		Args: [{ false class String}], Returns: String
 */
 func (o *classDb) ClassGetCategory(class gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -119,7 +136,7 @@ func (o *classDb) ClassGetCategory(class gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(class)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_category")
+	methodBind := methodBindClassDBClassGetCategory.Get()
 
 	// Call the parent method.
 	// String
@@ -132,8 +149,8 @@ func (o *classDb) ClassGetCategory(class gdnative.String) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} { false name String}], Returns: int
+	        Undocumented
+		Args: [{ false class String} { false name String}], Returns: int
 */
 func (o *classDb) ClassGetIntegerConstant(class gdnative.String, name gdnative.String) gdnative.Int {
 	o.ensureSingleton()
@@ -145,7 +162,7 @@ func (o *classDb) ClassGetIntegerConstant(class gdnative.String, name gdnative.S
 	ptrArguments[1] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_integer_constant")
+	methodBind := methodBindClassDBClassGetIntegerConstant.Get()
 
 	// Call the parent method.
 	// int
@@ -158,8 +175,8 @@ func (o *classDb) ClassGetIntegerConstant(class gdnative.String, name gdnative.S
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} {False true no_inheritance bool}], Returns: PoolStringArray
+	        Undocumented
+		Args: [{ false class String} {False true no_inheritance bool}], Returns: PoolStringArray
 */
 func (o *classDb) ClassGetIntegerConstantList(class gdnative.String, noInheritance gdnative.Bool) gdnative.PoolStringArray {
 	o.ensureSingleton()
@@ -171,7 +188,7 @@ func (o *classDb) ClassGetIntegerConstantList(class gdnative.String, noInheritan
 	ptrArguments[1] = gdnative.NewPointerFromBool(noInheritance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_integer_constant_list")
+	methodBind := methodBindClassDBClassGetIntegerConstantList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -184,8 +201,8 @@ func (o *classDb) ClassGetIntegerConstantList(class gdnative.String, noInheritan
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} {False true no_inheritance bool}], Returns: Array
+	        Undocumented
+		Args: [{ false class String} {False true no_inheritance bool}], Returns: Array
 */
 func (o *classDb) ClassGetMethodList(class gdnative.String, noInheritance gdnative.Bool) gdnative.Array {
 	o.ensureSingleton()
@@ -197,7 +214,7 @@ func (o *classDb) ClassGetMethodList(class gdnative.String, noInheritance gdnati
 	ptrArguments[1] = gdnative.NewPointerFromBool(noInheritance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_method_list")
+	methodBind := methodBindClassDBClassGetMethodList.Get()
 
 	// Call the parent method.
 	// Array
@@ -210,20 +227,20 @@ func (o *classDb) ClassGetMethodList(class gdnative.String, noInheritance gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false object Object} { false property String}], Returns: Variant
+	        Undocumented
+		Args: [{ false object Object} { false property String}], Returns: Variant
 */
-func (o *classDb) ClassGetProperty(object ObjectImplementer, property gdnative.String) gdnative.Variant {
+func (o *classDb) ClassGetProperty(object godot.ObjectImplementer, property gdnative.String) gdnative.Variant {
 	o.ensureSingleton()
 	//log.Println("Calling _ClassDB.ClassGetProperty()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(object.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
 	ptrArguments[1] = gdnative.NewPointerFromString(property)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_property")
+	methodBind := methodBindClassDBClassGetProperty.Get()
 
 	// Call the parent method.
 	// Variant
@@ -236,8 +253,8 @@ func (o *classDb) ClassGetProperty(object ObjectImplementer, property gdnative.S
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} {False true no_inheritance bool}], Returns: Array
+	        Undocumented
+		Args: [{ false class String} {False true no_inheritance bool}], Returns: Array
 */
 func (o *classDb) ClassGetPropertyList(class gdnative.String, noInheritance gdnative.Bool) gdnative.Array {
 	o.ensureSingleton()
@@ -249,7 +266,7 @@ func (o *classDb) ClassGetPropertyList(class gdnative.String, noInheritance gdna
 	ptrArguments[1] = gdnative.NewPointerFromBool(noInheritance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_property_list")
+	methodBind := methodBindClassDBClassGetPropertyList.Get()
 
 	// Call the parent method.
 	// Array
@@ -262,8 +279,8 @@ func (o *classDb) ClassGetPropertyList(class gdnative.String, noInheritance gdna
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} { false signal String}], Returns: Dictionary
+	        Undocumented
+		Args: [{ false class String} { false signal String}], Returns: Dictionary
 */
 func (o *classDb) ClassGetSignal(class gdnative.String, signal gdnative.String) gdnative.Dictionary {
 	o.ensureSingleton()
@@ -275,7 +292,7 @@ func (o *classDb) ClassGetSignal(class gdnative.String, signal gdnative.String)
 	ptrArguments[1] = gdnative.NewPointerFromString(signal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_signal")
+	methodBind := methodBindClassDBClassGetSignal.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -288,8 +305,8 @@ func (o *classDb) ClassGetSignal(class gdnative.String, signal gdnative.String)
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} {False true no_inheritance bool}], Returns: Array
+	        Undocumented
+		Args: [{ false class String} {False true no_inheritance bool}], Returns: Array
 */
 func (o *classDb) ClassGetSignalList(class gdnative.String, noInheritance gdnative.Bool) gdnative.Array {
 	o.ensureSingleton()
@@ -301,7 +318,7 @@ func (o *classDb) ClassGetSignalList(class gdnative.String, noInheritance gdnati
 	ptrArguments[1] = gdnative.NewPointerFromBool(noInheritance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_get_signal_list")
+	methodBind := methodBindClassDBClassGetSignalList.Get()
 
 	// Call the parent method.
 	// Array
@@ -314,8 +331,8 @@ func (o *classDb) ClassGetSignalList(class gdnative.String, noInheritance gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} { false name String}], Returns: bool
+	        Undocumented
+		Args: [{ false class String} { false name String}], Returns: bool
 */
 func (o *classDb) ClassHasIntegerConstant(class gdnative.String, name gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -327,7 +344,7 @@ func (o *classDb) ClassHasIntegerConstant(class gdnative.String, name gdnative.S
 	ptrArguments[1] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_has_integer_constant")
+	methodBind := methodBindClassDBClassHasIntegerConstant.Get()
 
 	// Call the parent method.
 	// bool
@@ -340,8 +357,8 @@ func (o *classDb) ClassHasIntegerConstant(class gdnative.String, name gdnative.S
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} { false method String} {False true no_inheritance bool}], Returns: bool
+	        Undocumented
+		Args: [{ false class String} { false method String} {False true no_inheritance bool}], Returns: bool
 */
 func (o *classDb) ClassHasMethod(class gdnative.String, method gdnative.String, noInheritance gdnative.Bool) gdnative.Bool {
 	o.ensureSingleton()
@@ -354,7 +371,7 @@ func (o *classDb) ClassHasMethod(class gdnative.String, method gdnative.String,
 	ptrArguments[2] = gdnative.NewPointerFromBool(noInheritance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_has_method")
+	methodBind := methodBindClassDBClassHasMethod.Get()
 
 	// Call the parent method.
 	// bool
@@ -367,8 +384,8 @@ func (o *classDb) ClassHasMethod(class gdnative.String, method gdnative.String,
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} { false signal String}], Returns: bool
+	        Undocumented
+		Args: [{ false class String} { false signal String}], Returns: bool
 */
 func (o *classDb) ClassHasSignal(class gdnative.String, signal gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -380,7 +397,7 @@ func (o *classDb) ClassHasSignal(class gdnative.String, signal gdnative.String)
 	ptrArguments[1] = gdnative.NewPointerFromString(signal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_has_signal")
+	methodBind := methodBindClassDBClassHasSignal.Get()
 
 	// Call the parent method.
 	// bool
@@ -393,21 +410,21 @@ func (o *classDb) ClassHasSignal(class gdnative.String, signal gdnative.String)
 }
 
 /*
-        Undocumented
-	Args: [{ false object Object} { false property String} { false value Variant}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false object Object} { false property String} { false value Variant}], Returns: enum.Error
 */
-func (o *classDb) ClassSetProperty(object ObjectImplementer, property gdnative.String, value gdnative.Variant) gdnative.Error {
+func (o *classDb) ClassSetProperty(object godot.ObjectImplementer, property gdnative.String, value gdnative.Variant) gdnative.Error {
 	o.ensureSingleton()
 	//log.Println("Calling _ClassDB.ClassSetProperty()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(object.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
 	ptrArguments[1] = gdnative.NewPointerFromString(property)
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "class_set_property")
+	methodBind := methodBindClassDBClassSetProperty.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -420,8 +437,8 @@ func (o *classDb) ClassSetProperty(object ObjectImplementer, property gdnative.S
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolStringArray
+	        Undocumented
+		Args: [], Returns: PoolStringArray
 */
 func (o *classDb) GetClassList() gdnative.PoolStringArray {
 	o.ensureSingleton()
@@ -431,7 +448,7 @@ func (o *classDb) GetClassList() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "get_class_list")
+	methodBind := methodBindClassDBGetClassList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -444,8 +461,8 @@ func (o *classDb) GetClassList() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [{ false class String}], Returns: PoolStringArray
+	        Undocumented
+		Args: [{ false class String}], Returns: PoolStringArray
 */
 func (o *classDb) GetInheritersFromClass(class gdnative.String) gdnative.PoolStringArray {
 	o.ensureSingleton()
@@ -456,7 +473,7 @@ func (o *classDb) GetInheritersFromClass(class gdnative.String) gdnative.PoolStr
 	ptrArguments[0] = gdnative.NewPointerFromString(class)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "get_inheriters_from_class")
+	methodBind := methodBindClassDBGetInheritersFromClass.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -469,8 +486,8 @@ func (o *classDb) GetInheritersFromClass(class gdnative.String) gdnative.PoolStr
 }
 
 /*
-        Undocumented
-	Args: [{ false class String}], Returns: String
+	        Undocumented
+		Args: [{ false class String}], Returns: String
 */
 func (o *classDb) GetParentClass(class gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -481,7 +498,7 @@ func (o *classDb) GetParentClass(class gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(class)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "get_parent_class")
+	methodBind := methodBindClassDBGetParentClass.Get()
 
 	// Call the parent method.
 	// String
@@ -494,8 +511,8 @@ func (o *classDb) GetParentClass(class gdnative.String) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false class String}], Returns: Variant
+	        Undocumented
+		Args: [{ false class String}], Returns: Variant
 */
 func (o *classDb) Instance(class gdnative.String) gdnative.Variant {
 	o.ensureSingleton()
@@ -506,7 +523,7 @@ func (o *classDb) Instance(class gdnative.String) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromString(class)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "instance")
+	methodBind := methodBindClassDBInstance.Get()
 
 	// Call the parent method.
 	// Variant
@@ -519,8 +536,8 @@ func (o *classDb) Instance(class gdnative.String) gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [{ false class String}], Returns: bool
+	        Undocumented
+		Args: [{ false class String}], Returns: bool
 */
 func (o *classDb) IsClassEnabled(class gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -531,7 +548,7 @@ func (o *classDb) IsClassEnabled(class gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(class)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "is_class_enabled")
+	methodBind := methodBindClassDBIsClassEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -544,8 +561,8 @@ func (o *classDb) IsClassEnabled(class gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false class String} { false inherits String}], Returns: bool
+	        Undocumented
+		Args: [{ false class String} { false inherits String}], Returns: bool
 */
 func (o *classDb) IsParentClass(class gdnative.String, inherits gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -557,7 +574,7 @@ func (o *classDb) IsParentClass(class gdnative.String, inherits gdnative.String)
 	ptrArguments[1] = gdnative.NewPointerFromString(inherits)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ClassDB", "is_parent_class")
+	methodBind := methodBindClassDBIsParentClass.Get()
 
 	// Call the parent method.
 	// bool
@@ -569,27 +586,58 @@ func (o *classDb) IsParentClass(class gdnative.String, inherits gdnative.String)
 	return ret
 }
 
+// ClassGetIntegerConstantListWithDefaults will call ClassGetIntegerConstantList using the default values for: no_inheritance.
+func (o *classDb) ClassGetIntegerConstantListWithDefaults(class gdnative.String) gdnative.PoolStringArray {
+	return o.ClassGetIntegerConstantList(class, false)
+}
+
+// ClassGetMethodListWithDefaults will call ClassGetMethodList using the default values for: no_inheritance.
+func (o *classDb) ClassGetMethodListWithDefaults(class gdnative.String) gdnative.Array {
+	return o.ClassGetMethodList(class, false)
+}
+
+// ClassGetPropertyListWithDefaults will call ClassGetPropertyList using the default values for: no_inheritance.
+func (o *classDb) ClassGetPropertyListWithDefaults(class gdnative.String) gdnative.Array {
+	return o.ClassGetPropertyList(class, false)
+}
+
+// ClassGetSignalListWithDefaults will call ClassGetSignalList using the default values for: no_inheritance.
+func (o *classDb) ClassGetSignalListWithDefaults(class gdnative.String) gdnative.Array {
+	return o.ClassGetSignalList(class, false)
+}
+
+// ClassHasMethodWithDefaults will call ClassHasMethod using the default values for: no_inheritance.
+func (o *classDb) ClassHasMethodWithDefaults(class gdnative.String, method gdnative.String) gdnative.Bool {
+	return o.ClassHasMethod(class, method, false)
+}
+
 // ClassDBImplementer is an interface that implements the methods
 // of the ClassDB class.
 type ClassDBImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	CanInstance(class gdnative.String) gdnative.Bool
 	ClassExists(class gdnative.String) gdnative.Bool
 	ClassGetCategory(class gdnative.String) gdnative.String
 	ClassGetIntegerConstant(class gdnative.String, name gdnative.String) gdnative.Int
 	ClassGetIntegerConstantList(class gdnative.String, noInheritance gdnative.Bool) gdnative.PoolStringArray
 	ClassGetMethodList(class gdnative.String, noInheritance gdnative.Bool) gdnative.Array
-	ClassGetProperty(object ObjectImplementer, property gdnative.String) gdnative.Variant
+	ClassGetProperty(object godot.ObjectImplementer, property gdnative.String) gdnative.Variant
 	ClassGetPropertyList(class gdnative.String, noInheritance gdnative.Bool) gdnative.Array
 	ClassGetSignal(class gdnative.String, signal gdnative.String) gdnative.Dictionary
 	ClassGetSignalList(class gdnative.String, noInheritance gdnative.Bool) gdnative.Array
 	ClassHasIntegerConstant(class gdnative.String, name gdnative.String) gdnative.Bool
 	ClassHasMethod(class gdnative.String, method gdnative.String, noInheritance gdnative.Bool) gdnative.Bool
 	ClassHasSignal(class gdnative.String, signal gdnative.String) gdnative.Bool
+	ClassSetProperty(object godot.ObjectImplementer, property gdnative.String, value gdnative.Variant) gdnative.Error
 	GetClassList() gdnative.PoolStringArray
 	GetInheritersFromClass(class gdnative.String) gdnative.PoolStringArray
 	GetParentClass(class gdnative.String) gdnative.String
 	Instance(class gdnative.String) gdnative.Variant
 	IsClassEnabled(class gdnative.String) gdnative.Bool
 	IsParentClass(class gdnative.String, inherits gdnative.String) gdnative.Bool
+	ClassGetIntegerConstantListWithDefaults(class gdnative.String) gdnative.PoolStringArray
+	ClassGetMethodListWithDefaults(class gdnative.String) gdnative.Array
+	ClassGetPropertyListWithDefaults(class gdnative.String) gdnative.Array
+	ClassGetSignalListWithDefaults(class gdnative.String) gdnative.Array
+	ClassHasMethodWithDefaults(class gdnative.String, method gdnative.String) gdnative.Bool
 }
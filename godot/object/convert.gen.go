@@ -0,0 +1,30 @@
+package object
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("InputDefault", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InputDefault{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("UndoRedo", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &UndoRedo{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
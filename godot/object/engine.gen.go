@@ -0,0 +1,492 @@
+package object
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+func newSingletonEngine() *engine {
+	return &engine{}
+}
+
+/*
+The [code]Engine[/code] class allows you to query and modify the game's run-time parameters, such as frames per second, time scale, and others.
+*/
+var Engine = newSingletonEngine()
+
+/*
+The [code]Engine[/code] class allows you to query and modify the game's run-time parameters, such as frames per second, time scale, and others.
+*/
+type engine struct {
+	godot.Object
+	owner       gdnative.Object
+	initialized bool
+}
+
+// EnsureSingleton will check to see if we have an object for it. If not, it will fetch its
+// GDNative object and set it.
+func (o *engine) ensureSingleton() {
+	if o.initialized == true {
+		return
+	}
+	//log.Println("Singleton not found. Fetching from GDNative...")
+	base := gdnative.GetSingleton("_Engine")
+	o.SetBaseObject(base)
+	o.initialized = true
+}
+
+func (o *engine) BaseClass() string {
+	return "_Engine"
+}
+
+// Method binds of the Engine class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEngineGetFramesDrawn         = gdnative.NewLazyMethodBind("_Engine", "get_frames_drawn")
+	methodBindEngineGetFramesPerSecond     = gdnative.NewLazyMethodBind("_Engine", "get_frames_per_second")
+	methodBindEngineGetIterationsPerSecond = gdnative.NewLazyMethodBind("_Engine", "get_iterations_per_second")
+	methodBindEngineGetMainLoop            = gdnative.NewLazyMethodBind("_Engine", "get_main_loop")
+	methodBindEngineGetSingleton           = gdnative.NewLazyMethodBind("_Engine", "get_singleton")
+	methodBindEngineGetTargetFps           = gdnative.NewLazyMethodBind("_Engine", "get_target_fps")
+	methodBindEngineGetTimeScale           = gdnative.NewLazyMethodBind("_Engine", "get_time_scale")
+	methodBindEngineGetVersionInfo         = gdnative.NewLazyMethodBind("_Engine", "get_version_info")
+	methodBindEngineHasSingleton           = gdnative.NewLazyMethodBind("_Engine", "has_singleton")
+	methodBindEngineIsEditorHint           = gdnative.NewLazyMethodBind("_Engine", "is_editor_hint")
+	methodBindEngineIsInPhysicsFrame       = gdnative.NewLazyMethodBind("_Engine", "is_in_physics_frame")
+	methodBindEngineSetEditorHint          = gdnative.NewLazyMethodBind("_Engine", "set_editor_hint")
+	methodBindEngineSetIterationsPerSecond = gdnative.NewLazyMethodBind("_Engine", "set_iterations_per_second")
+	methodBindEngineSetTargetFps           = gdnative.NewLazyMethodBind("_Engine", "set_target_fps")
+	methodBindEngineSetTimeScale           = gdnative.NewLazyMethodBind("_Engine", "set_time_scale")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *engine) GetFramesDrawn() gdnative.Int {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetFramesDrawn()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetFramesDrawn.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *engine) GetFramesPerSecond() gdnative.Real {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetFramesPerSecond()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetFramesPerSecond.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *engine) GetIterationsPerSecond() gdnative.Int {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetIterationsPerSecond()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetIterationsPerSecond.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: MainLoop
+*/
+func (o *engine) GetMainLoop() godot.MainLoopImplementer {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetMainLoop()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetMainLoop.Get()
+
+	// Call the parent method.
+	// MainLoop
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.MainLoop{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MainLoopImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: Object
+*/
+func (o *engine) GetSingleton(name gdnative.String) godot.ObjectImplementer {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetSingleton()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetSingleton.Get()
+
+	// Call the parent method.
+	// Object
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *engine) GetTargetFps() gdnative.Int {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetTargetFps()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetTargetFps.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *engine) GetTimeScale() gdnative.Real {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetTimeScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetTimeScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Dictionary
+*/
+func (o *engine) GetVersionInfo() gdnative.Dictionary {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.GetVersionInfo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineGetVersionInfo.Get()
+
+	// Call the parent method.
+	// Dictionary
+	retPtr := gdnative.NewEmptyDictionary()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewDictionaryFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: bool
+*/
+func (o *engine) HasSingleton(name gdnative.String) gdnative.Bool {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.HasSingleton()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindEngineHasSingleton.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *engine) IsEditorHint() gdnative.Bool {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.IsEditorHint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineIsEditorHint.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *engine) IsInPhysicsFrame() gdnative.Bool {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.IsInPhysicsFrame()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindEngineIsInPhysicsFrame.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *engine) SetEditorHint(enabled gdnative.Bool) {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.SetEditorHint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindEngineSetEditorHint.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false iterations_per_second int}], Returns: void
+*/
+func (o *engine) SetIterationsPerSecond(iterationsPerSecond gdnative.Int) {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.SetIterationsPerSecond()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(iterationsPerSecond)
+
+	// Get the method bind
+	methodBind := methodBindEngineSetIterationsPerSecond.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false target_fps int}], Returns: void
+*/
+func (o *engine) SetTargetFps(targetFps gdnative.Int) {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.SetTargetFps()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(targetFps)
+
+	// Get the method bind
+	methodBind := methodBindEngineSetTargetFps.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false time_scale float}], Returns: void
+*/
+func (o *engine) SetTimeScale(timeScale gdnative.Real) {
+	o.ensureSingleton()
+	//log.Println("Calling _Engine.SetTimeScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(timeScale)
+
+	// Get the method bind
+	methodBind := methodBindEngineSetTimeScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// EditorHint will return the value of the "editor_hint" property.
+func (o *engine) EditorHint() gdnative.Bool {
+	return o.IsEditorHint()
+}
+
+// IterationsPerSecond will return the value of the "iterations_per_second" property.
+func (o *engine) IterationsPerSecond() gdnative.Int {
+	return o.GetIterationsPerSecond()
+}
+
+// TargetFps will return the value of the "target_fps" property.
+func (o *engine) TargetFps() gdnative.Int {
+	return o.GetTargetFps()
+}
+
+// TimeScale will return the value of the "time_scale" property.
+func (o *engine) TimeScale() gdnative.Real {
+	return o.GetTimeScale()
+}
+
+// EngineImplementer is an interface that implements the methods
+// of the Engine class.
+type EngineImplementer interface {
+	godot.ObjectImplementer
+	GetFramesDrawn() gdnative.Int
+	GetFramesPerSecond() gdnative.Real
+	GetIterationsPerSecond() gdnative.Int
+	GetMainLoop() godot.MainLoopImplementer
+	GetSingleton(name gdnative.String) godot.ObjectImplementer
+	GetTargetFps() gdnative.Int
+	GetTimeScale() gdnative.Real
+	GetVersionInfo() gdnative.Dictionary
+	HasSingleton(name gdnative.String) gdnative.Bool
+	IsEditorHint() gdnative.Bool
+	IsInPhysicsFrame() gdnative.Bool
+	SetEditorHint(enabled gdnative.Bool)
+	SetIterationsPerSecond(iterationsPerSecond gdnative.Int)
+	SetTargetFps(targetFps gdnative.Int)
+	SetTimeScale(timeScale gdnative.Real)
+	EditorHint() gdnative.Bool
+	IterationsPerSecond() gdnative.Int
+	TargetFps() gdnative.Int
+	TimeScale() gdnative.Real
+}
@@ -1,7 +1,8 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,29 +14,18 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewgeometryFromPointer(ptr gdnative.Pointer) geometry {
-func new_GeometryFromPointer(ptr gdnative.Pointer) geometry {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := geometry{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonGeometry() *geometry {
 	return &geometry{}
 }
 
 /*
-
  */
 var Geometry = newSingletonGeometry()
 
 /*
-
  */
 type geometry struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,9 +46,36 @@ func (o *geometry) BaseClass() string {
 	return "_Geometry"
 }
 
+// Method binds of the Geometry class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGeometryBuildBoxPlanes                     = gdnative.NewLazyMethodBind("_Geometry", "build_box_planes")
+	methodBindGeometryBuildCapsulePlanes                 = gdnative.NewLazyMethodBind("_Geometry", "build_capsule_planes")
+	methodBindGeometryBuildCylinderPlanes                = gdnative.NewLazyMethodBind("_Geometry", "build_cylinder_planes")
+	methodBindGeometryClipPolygon                        = gdnative.NewLazyMethodBind("_Geometry", "clip_polygon")
+	methodBindGeometryConvexHull2D                       = gdnative.NewLazyMethodBind("_Geometry", "convex_hull_2d")
+	methodBindGeometryGetClosestPointToSegment           = gdnative.NewLazyMethodBind("_Geometry", "get_closest_point_to_segment")
+	methodBindGeometryGetClosestPointToSegment2D         = gdnative.NewLazyMethodBind("_Geometry", "get_closest_point_to_segment_2d")
+	methodBindGeometryGetClosestPointToSegmentUncapped   = gdnative.NewLazyMethodBind("_Geometry", "get_closest_point_to_segment_uncapped")
+	methodBindGeometryGetClosestPointToSegmentUncapped2D = gdnative.NewLazyMethodBind("_Geometry", "get_closest_point_to_segment_uncapped_2d")
+	methodBindGeometryGetClosestPointsBetweenSegments    = gdnative.NewLazyMethodBind("_Geometry", "get_closest_points_between_segments")
+	methodBindGeometryGetClosestPointsBetweenSegments2D  = gdnative.NewLazyMethodBind("_Geometry", "get_closest_points_between_segments_2d")
+	methodBindGeometryGetUv84NormalBit                   = gdnative.NewLazyMethodBind("_Geometry", "get_uv84_normal_bit")
+	methodBindGeometryMakeAtlas                          = gdnative.NewLazyMethodBind("_Geometry", "make_atlas")
+	methodBindGeometryPointIsInsideTriangle              = gdnative.NewLazyMethodBind("_Geometry", "point_is_inside_triangle")
+	methodBindGeometryRayIntersectsTriangle              = gdnative.NewLazyMethodBind("_Geometry", "ray_intersects_triangle")
+	methodBindGeometrySegmentIntersectsCircle            = gdnative.NewLazyMethodBind("_Geometry", "segment_intersects_circle")
+	methodBindGeometrySegmentIntersectsConvex            = gdnative.NewLazyMethodBind("_Geometry", "segment_intersects_convex")
+	methodBindGeometrySegmentIntersectsCylinder          = gdnative.NewLazyMethodBind("_Geometry", "segment_intersects_cylinder")
+	methodBindGeometrySegmentIntersectsSegment2D         = gdnative.NewLazyMethodBind("_Geometry", "segment_intersects_segment_2d")
+	methodBindGeometrySegmentIntersectsSphere            = gdnative.NewLazyMethodBind("_Geometry", "segment_intersects_sphere")
+	methodBindGeometrySegmentIntersectsTriangle          = gdnative.NewLazyMethodBind("_Geometry", "segment_intersects_triangle")
+	methodBindGeometryTriangulatePolygon                 = gdnative.NewLazyMethodBind("_Geometry", "triangulate_polygon")
+)
+
 /*
-        Undocumented
-	Args: [{ false extents Vector3}], Returns: Array
+	        Undocumented
+		Args: [{ false extents Vector3}], Returns: Array
 */
 func (o *geometry) BuildBoxPlanes(extents gdnative.Vector3) gdnative.Array {
 	o.ensureSingleton()
@@ -69,7 +86,7 @@ func (o *geometry) BuildBoxPlanes(extents gdnative.Vector3) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(extents)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "build_box_planes")
+	methodBind := methodBindGeometryBuildBoxPlanes.Get()
 
 	// Call the parent method.
 	// Array
@@ -82,8 +99,8 @@ func (o *geometry) BuildBoxPlanes(extents gdnative.Vector3) gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float} { false height float} { false sides int} { false lats int} {2 true axis int}], Returns: Array
+	        Undocumented
+		Args: [{ false radius float} { false height float} { false sides int} { false lats int} {2 true axis int}], Returns: Array
 */
 func (o *geometry) BuildCapsulePlanes(radius gdnative.Real, height gdnative.Real, sides gdnative.Int, lats gdnative.Int, axis gdnative.Int) gdnative.Array {
 	o.ensureSingleton()
@@ -98,7 +115,7 @@ func (o *geometry) BuildCapsulePlanes(radius gdnative.Real, height gdnative.Real
 	ptrArguments[4] = gdnative.NewPointerFromInt(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "build_capsule_planes")
+	methodBind := methodBindGeometryBuildCapsulePlanes.Get()
 
 	// Call the parent method.
 	// Array
@@ -111,8 +128,8 @@ func (o *geometry) BuildCapsulePlanes(radius gdnative.Real, height gdnative.Real
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float} { false height float} { false sides int} {2 true axis int}], Returns: Array
+	        Undocumented
+		Args: [{ false radius float} { false height float} { false sides int} {2 true axis int}], Returns: Array
 */
 func (o *geometry) BuildCylinderPlanes(radius gdnative.Real, height gdnative.Real, sides gdnative.Int, axis gdnative.Int) gdnative.Array {
 	o.ensureSingleton()
@@ -126,7 +143,7 @@ func (o *geometry) BuildCylinderPlanes(radius gdnative.Real, height gdnative.Rea
 	ptrArguments[3] = gdnative.NewPointerFromInt(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "build_cylinder_planes")
+	methodBind := methodBindGeometryBuildCylinderPlanes.Get()
 
 	// Call the parent method.
 	// Array
@@ -139,8 +156,8 @@ func (o *geometry) BuildCylinderPlanes(radius gdnative.Real, height gdnative.Rea
 }
 
 /*
-        Undocumented
-	Args: [{ false points PoolVector3Array} { false plane Plane}], Returns: PoolVector3Array
+	        Undocumented
+		Args: [{ false points PoolVector3Array} { false plane Plane}], Returns: PoolVector3Array
 */
 func (o *geometry) ClipPolygon(points gdnative.PoolVector3Array, plane gdnative.Plane) gdnative.PoolVector3Array {
 	o.ensureSingleton()
@@ -152,7 +169,7 @@ func (o *geometry) ClipPolygon(points gdnative.PoolVector3Array, plane gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromPlane(plane)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "clip_polygon")
+	methodBind := methodBindGeometryClipPolygon.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -165,8 +182,8 @@ func (o *geometry) ClipPolygon(points gdnative.PoolVector3Array, plane gdnative.
 }
 
 /*
-        Undocumented
-	Args: [{ false points PoolVector2Array}], Returns: PoolVector2Array
+	        Undocumented
+		Args: [{ false points PoolVector2Array}], Returns: PoolVector2Array
 */
 func (o *geometry) ConvexHull2D(points gdnative.PoolVector2Array) gdnative.PoolVector2Array {
 	o.ensureSingleton()
@@ -177,7 +194,7 @@ func (o *geometry) ConvexHull2D(points gdnative.PoolVector2Array) gdnative.PoolV
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(points)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "convex_hull_2d")
+	methodBind := methodBindGeometryConvexHull2D.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -190,8 +207,8 @@ func (o *geometry) ConvexHull2D(points gdnative.PoolVector2Array) gdnative.PoolV
 }
 
 /*
-        Undocumented
-	Args: [{ false point Vector3} { false s1 Vector3} { false s2 Vector3}], Returns: Vector3
+	        Undocumented
+		Args: [{ false point Vector3} { false s1 Vector3} { false s2 Vector3}], Returns: Vector3
 */
 func (o *geometry) GetClosestPointToSegment(point gdnative.Vector3, s1 gdnative.Vector3, s2 gdnative.Vector3) gdnative.Vector3 {
 	o.ensureSingleton()
@@ -204,7 +221,7 @@ func (o *geometry) GetClosestPointToSegment(point gdnative.Vector3, s1 gdnative.
 	ptrArguments[2] = gdnative.NewPointerFromVector3(s2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "get_closest_point_to_segment")
+	methodBind := methodBindGeometryGetClosestPointToSegment.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -217,8 +234,8 @@ func (o *geometry) GetClosestPointToSegment(point gdnative.Vector3, s1 gdnative.
 }
 
 /*
-        Undocumented
-	Args: [{ false point Vector2} { false s1 Vector2} { false s2 Vector2}], Returns: Vector2
+	        Undocumented
+		Args: [{ false point Vector2} { false s1 Vector2} { false s2 Vector2}], Returns: Vector2
 */
 func (o *geometry) GetClosestPointToSegment2D(point gdnative.Vector2, s1 gdnative.Vector2, s2 gdnative.Vector2) gdnative.Vector2 {
 	o.ensureSingleton()
@@ -231,7 +248,7 @@ func (o *geometry) GetClosestPointToSegment2D(point gdnative.Vector2, s1 gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromVector2(s2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "get_closest_point_to_segment_2d")
+	methodBind := methodBindGeometryGetClosestPointToSegment2D.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -244,8 +261,8 @@ func (o *geometry) GetClosestPointToSegment2D(point gdnative.Vector2, s1 gdnativ
 }
 
 /*
-        Undocumented
-	Args: [{ false point Vector3} { false s1 Vector3} { false s2 Vector3}], Returns: Vector3
+	        Undocumented
+		Args: [{ false point Vector3} { false s1 Vector3} { false s2 Vector3}], Returns: Vector3
 */
 func (o *geometry) GetClosestPointToSegmentUncapped(point gdnative.Vector3, s1 gdnative.Vector3, s2 gdnative.Vector3) gdnative.Vector3 {
 	o.ensureSingleton()
@@ -258,7 +275,7 @@ func (o *geometry) GetClosestPointToSegmentUncapped(point gdnative.Vector3, s1 g
 	ptrArguments[2] = gdnative.NewPointerFromVector3(s2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "get_closest_point_to_segment_uncapped")
+	methodBind := methodBindGeometryGetClosestPointToSegmentUncapped.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -271,8 +288,8 @@ func (o *geometry) GetClosestPointToSegmentUncapped(point gdnative.Vector3, s1 g
 }
 
 /*
-        Undocumented
-	Args: [{ false point Vector2} { false s1 Vector2} { false s2 Vector2}], Returns: Vector2
+	        Undocumented
+		Args: [{ false point Vector2} { false s1 Vector2} { false s2 Vector2}], Returns: Vector2
 */
 func (o *geometry) GetClosestPointToSegmentUncapped2D(point gdnative.Vector2, s1 gdnative.Vector2, s2 gdnative.Vector2) gdnative.Vector2 {
 	o.ensureSingleton()
@@ -285,7 +302,7 @@ func (o *geometry) GetClosestPointToSegmentUncapped2D(point gdnative.Vector2, s1
 	ptrArguments[2] = gdnative.NewPointerFromVector2(s2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "get_closest_point_to_segment_uncapped_2d")
+	methodBind := methodBindGeometryGetClosestPointToSegmentUncapped2D.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -298,8 +315,8 @@ func (o *geometry) GetClosestPointToSegmentUncapped2D(point gdnative.Vector2, s1
 }
 
 /*
-        Undocumented
-	Args: [{ false p1 Vector3} { false p2 Vector3} { false q1 Vector3} { false q2 Vector3}], Returns: PoolVector3Array
+	        Undocumented
+		Args: [{ false p1 Vector3} { false p2 Vector3} { false q1 Vector3} { false q2 Vector3}], Returns: PoolVector3Array
 */
 func (o *geometry) GetClosestPointsBetweenSegments(p1 gdnative.Vector3, p2 gdnative.Vector3, q1 gdnative.Vector3, q2 gdnative.Vector3) gdnative.PoolVector3Array {
 	o.ensureSingleton()
@@ -313,7 +330,7 @@ func (o *geometry) GetClosestPointsBetweenSegments(p1 gdnative.Vector3, p2 gdnat
 	ptrArguments[3] = gdnative.NewPointerFromVector3(q2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "get_closest_points_between_segments")
+	methodBind := methodBindGeometryGetClosestPointsBetweenSegments.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -326,8 +343,8 @@ func (o *geometry) GetClosestPointsBetweenSegments(p1 gdnative.Vector3, p2 gdnat
 }
 
 /*
-        Undocumented
-	Args: [{ false p1 Vector2} { false q1 Vector2} { false p2 Vector2} { false q2 Vector2}], Returns: PoolVector2Array
+	        Undocumented
+		Args: [{ false p1 Vector2} { false q1 Vector2} { false p2 Vector2} { false q2 Vector2}], Returns: PoolVector2Array
 */
 func (o *geometry) GetClosestPointsBetweenSegments2D(p1 gdnative.Vector2, q1 gdnative.Vector2, p2 gdnative.Vector2, q2 gdnative.Vector2) gdnative.PoolVector2Array {
 	o.ensureSingleton()
@@ -341,7 +358,7 @@ func (o *geometry) GetClosestPointsBetweenSegments2D(p1 gdnative.Vector2, q1 gdn
 	ptrArguments[3] = gdnative.NewPointerFromVector2(q2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "get_closest_points_between_segments_2d")
+	methodBind := methodBindGeometryGetClosestPointsBetweenSegments2D.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -354,8 +371,8 @@ func (o *geometry) GetClosestPointsBetweenSegments2D(p1 gdnative.Vector2, q1 gdn
 }
 
 /*
-        Undocumented
-	Args: [{ false normal Vector3}], Returns: int
+	        Undocumented
+		Args: [{ false normal Vector3}], Returns: int
 */
 func (o *geometry) GetUv84NormalBit(normal gdnative.Vector3) gdnative.Int {
 	o.ensureSingleton()
@@ -366,7 +383,7 @@ func (o *geometry) GetUv84NormalBit(normal gdnative.Vector3) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(normal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "get_uv84_normal_bit")
+	methodBind := methodBindGeometryGetUv84NormalBit.Get()
 
 	// Call the parent method.
 	// int
@@ -379,8 +396,8 @@ func (o *geometry) GetUv84NormalBit(normal gdnative.Vector3) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false sizes PoolVector2Array}], Returns: Dictionary
+	        Undocumented
+		Args: [{ false sizes PoolVector2Array}], Returns: Dictionary
 */
 func (o *geometry) MakeAtlas(sizes gdnative.PoolVector2Array) gdnative.Dictionary {
 	o.ensureSingleton()
@@ -391,7 +408,7 @@ func (o *geometry) MakeAtlas(sizes gdnative.PoolVector2Array) gdnative.Dictionar
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(sizes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "make_atlas")
+	methodBind := methodBindGeometryMakeAtlas.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -404,8 +421,8 @@ func (o *geometry) MakeAtlas(sizes gdnative.PoolVector2Array) gdnative.Dictionar
 }
 
 /*
-        Undocumented
-	Args: [{ false point Vector2} { false a Vector2} { false b Vector2} { false c Vector2}], Returns: bool
+	        Undocumented
+		Args: [{ false point Vector2} { false a Vector2} { false b Vector2} { false c Vector2}], Returns: bool
 */
 func (o *geometry) PointIsInsideTriangle(point gdnative.Vector2, a gdnative.Vector2, b gdnative.Vector2, c gdnative.Vector2) gdnative.Bool {
 	o.ensureSingleton()
@@ -419,7 +436,7 @@ func (o *geometry) PointIsInsideTriangle(point gdnative.Vector2, a gdnative.Vect
 	ptrArguments[3] = gdnative.NewPointerFromVector2(c)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "point_is_inside_triangle")
+	methodBind := methodBindGeometryPointIsInsideTriangle.Get()
 
 	// Call the parent method.
 	// bool
@@ -432,8 +449,8 @@ func (o *geometry) PointIsInsideTriangle(point gdnative.Vector2, a gdnative.Vect
 }
 
 /*
-        Undocumented
-	Args: [{ false from Vector3} { false dir Vector3} { false a Vector3} { false b Vector3} { false c Vector3}], Returns: Variant
+	        Undocumented
+		Args: [{ false from Vector3} { false dir Vector3} { false a Vector3} { false b Vector3} { false c Vector3}], Returns: Variant
 */
 func (o *geometry) RayIntersectsTriangle(from gdnative.Vector3, dir gdnative.Vector3, a gdnative.Vector3, b gdnative.Vector3, c gdnative.Vector3) gdnative.Variant {
 	o.ensureSingleton()
@@ -448,7 +465,7 @@ func (o *geometry) RayIntersectsTriangle(from gdnative.Vector3, dir gdnative.Vec
 	ptrArguments[4] = gdnative.NewPointerFromVector3(c)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "ray_intersects_triangle")
+	methodBind := methodBindGeometryRayIntersectsTriangle.Get()
 
 	// Call the parent method.
 	// Variant
@@ -461,8 +478,8 @@ func (o *geometry) RayIntersectsTriangle(from gdnative.Vector3, dir gdnative.Vec
 }
 
 /*
-        Undocumented
-	Args: [{ false segment_from Vector2} { false segment_to Vector2} { false circle_position Vector2} { false circle_radius float}], Returns: float
+	        Undocumented
+		Args: [{ false segment_from Vector2} { false segment_to Vector2} { false circle_position Vector2} { false circle_radius float}], Returns: float
 */
 func (o *geometry) SegmentIntersectsCircle(segmentFrom gdnative.Vector2, segmentTo gdnative.Vector2, circlePosition gdnative.Vector2, circleRadius gdnative.Real) gdnative.Real {
 	o.ensureSingleton()
@@ -476,7 +493,7 @@ func (o *geometry) SegmentIntersectsCircle(segmentFrom gdnative.Vector2, segment
 	ptrArguments[3] = gdnative.NewPointerFromReal(circleRadius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "segment_intersects_circle")
+	methodBind := methodBindGeometrySegmentIntersectsCircle.Get()
 
 	// Call the parent method.
 	// float
@@ -489,8 +506,8 @@ func (o *geometry) SegmentIntersectsCircle(segmentFrom gdnative.Vector2, segment
 }
 
 /*
-        Undocumented
-	Args: [{ false from Vector3} { false to Vector3} { false planes Array}], Returns: PoolVector3Array
+	        Undocumented
+		Args: [{ false from Vector3} { false to Vector3} { false planes Array}], Returns: PoolVector3Array
 */
 func (o *geometry) SegmentIntersectsConvex(from gdnative.Vector3, to gdnative.Vector3, planes gdnative.Array) gdnative.PoolVector3Array {
 	o.ensureSingleton()
@@ -503,7 +520,7 @@ func (o *geometry) SegmentIntersectsConvex(from gdnative.Vector3, to gdnative.Ve
 	ptrArguments[2] = gdnative.NewPointerFromArray(planes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "segment_intersects_convex")
+	methodBind := methodBindGeometrySegmentIntersectsConvex.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -516,8 +533,8 @@ func (o *geometry) SegmentIntersectsConvex(from gdnative.Vector3, to gdnative.Ve
 }
 
 /*
-        Undocumented
-	Args: [{ false from Vector3} { false to Vector3} { false height float} { false radius float}], Returns: PoolVector3Array
+	        Undocumented
+		Args: [{ false from Vector3} { false to Vector3} { false height float} { false radius float}], Returns: PoolVector3Array
 */
 func (o *geometry) SegmentIntersectsCylinder(from gdnative.Vector3, to gdnative.Vector3, height gdnative.Real, radius gdnative.Real) gdnative.PoolVector3Array {
 	o.ensureSingleton()
@@ -531,7 +548,7 @@ func (o *geometry) SegmentIntersectsCylinder(from gdnative.Vector3, to gdnative.
 	ptrArguments[3] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "segment_intersects_cylinder")
+	methodBind := methodBindGeometrySegmentIntersectsCylinder.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -544,8 +561,8 @@ func (o *geometry) SegmentIntersectsCylinder(from gdnative.Vector3, to gdnative.
 }
 
 /*
-        Undocumented
-	Args: [{ false from_a Vector2} { false to_a Vector2} { false from_b Vector2} { false to_b Vector2}], Returns: Variant
+	        Undocumented
+		Args: [{ false from_a Vector2} { false to_a Vector2} { false from_b Vector2} { false to_b Vector2}], Returns: Variant
 */
 func (o *geometry) SegmentIntersectsSegment2D(fromA gdnative.Vector2, toA gdnative.Vector2, fromB gdnative.Vector2, toB gdnative.Vector2) gdnative.Variant {
 	o.ensureSingleton()
@@ -559,7 +576,7 @@ func (o *geometry) SegmentIntersectsSegment2D(fromA gdnative.Vector2, toA gdnati
 	ptrArguments[3] = gdnative.NewPointerFromVector2(toB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "segment_intersects_segment_2d")
+	methodBind := methodBindGeometrySegmentIntersectsSegment2D.Get()
 
 	// Call the parent method.
 	// Variant
@@ -572,8 +589,8 @@ func (o *geometry) SegmentIntersectsSegment2D(fromA gdnative.Vector2, toA gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false from Vector3} { false to Vector3} { false sphere_position Vector3} { false sphere_radius float}], Returns: PoolVector3Array
+	        Undocumented
+		Args: [{ false from Vector3} { false to Vector3} { false sphere_position Vector3} { false sphere_radius float}], Returns: PoolVector3Array
 */
 func (o *geometry) SegmentIntersectsSphere(from gdnative.Vector3, to gdnative.Vector3, spherePosition gdnative.Vector3, sphereRadius gdnative.Real) gdnative.PoolVector3Array {
 	o.ensureSingleton()
@@ -587,7 +604,7 @@ func (o *geometry) SegmentIntersectsSphere(from gdnative.Vector3, to gdnative.Ve
 	ptrArguments[3] = gdnative.NewPointerFromReal(sphereRadius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "segment_intersects_sphere")
+	methodBind := methodBindGeometrySegmentIntersectsSphere.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -600,8 +617,8 @@ func (o *geometry) SegmentIntersectsSphere(from gdnative.Vector3, to gdnative.Ve
 }
 
 /*
-        Undocumented
-	Args: [{ false from Vector3} { false to Vector3} { false a Vector3} { false b Vector3} { false c Vector3}], Returns: Variant
+	        Undocumented
+		Args: [{ false from Vector3} { false to Vector3} { false a Vector3} { false b Vector3} { false c Vector3}], Returns: Variant
 */
 func (o *geometry) SegmentIntersectsTriangle(from gdnative.Vector3, to gdnative.Vector3, a gdnative.Vector3, b gdnative.Vector3, c gdnative.Vector3) gdnative.Variant {
 	o.ensureSingleton()
@@ -616,7 +633,7 @@ func (o *geometry) SegmentIntersectsTriangle(from gdnative.Vector3, to gdnative.
 	ptrArguments[4] = gdnative.NewPointerFromVector3(c)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "segment_intersects_triangle")
+	methodBind := methodBindGeometrySegmentIntersectsTriangle.Get()
 
 	// Call the parent method.
 	// Variant
@@ -629,8 +646,8 @@ func (o *geometry) SegmentIntersectsTriangle(from gdnative.Vector3, to gdnative.
 }
 
 /*
-        Undocumented
-	Args: [{ false polygon PoolVector2Array}], Returns: PoolIntArray
+	        Undocumented
+		Args: [{ false polygon PoolVector2Array}], Returns: PoolIntArray
 */
 func (o *geometry) TriangulatePolygon(polygon gdnative.PoolVector2Array) gdnative.PoolIntArray {
 	o.ensureSingleton()
@@ -641,7 +658,7 @@ func (o *geometry) TriangulatePolygon(polygon gdnative.PoolVector2Array) gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Geometry", "triangulate_polygon")
+	methodBind := methodBindGeometryTriangulatePolygon.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -653,10 +670,20 @@ func (o *geometry) TriangulatePolygon(polygon gdnative.PoolVector2Array) gdnativ
 	return ret
 }
 
+// BuildCapsulePlanesWithDefaults will call BuildCapsulePlanes using the default values for: axis.
+func (o *geometry) BuildCapsulePlanesWithDefaults(radius gdnative.Real, height gdnative.Real, sides gdnative.Int, lats gdnative.Int) gdnative.Array {
+	return o.BuildCapsulePlanes(radius, height, sides, lats, 2)
+}
+
+// BuildCylinderPlanesWithDefaults will call BuildCylinderPlanes using the default values for: axis.
+func (o *geometry) BuildCylinderPlanesWithDefaults(radius gdnative.Real, height gdnative.Real, sides gdnative.Int) gdnative.Array {
+	return o.BuildCylinderPlanes(radius, height, sides, 2)
+}
+
 // GeometryImplementer is an interface that implements the methods
 // of the Geometry class.
 type GeometryImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	BuildBoxPlanes(extents gdnative.Vector3) gdnative.Array
 	BuildCapsulePlanes(radius gdnative.Real, height gdnative.Real, sides gdnative.Int, lats gdnative.Int, axis gdnative.Int) gdnative.Array
 	BuildCylinderPlanes(radius gdnative.Real, height gdnative.Real, sides gdnative.Int, axis gdnative.Int) gdnative.Array
@@ -679,4 +706,6 @@ type GeometryImplementer interface {
 	SegmentIntersectsSphere(from gdnative.Vector3, to gdnative.Vector3, spherePosition gdnative.Vector3, sphereRadius gdnative.Real) gdnative.PoolVector3Array
 	SegmentIntersectsTriangle(from gdnative.Vector3, to gdnative.Vector3, a gdnative.Vector3, b gdnative.Vector3, c gdnative.Vector3) gdnative.Variant
 	TriangulatePolygon(polygon gdnative.PoolVector2Array) gdnative.PoolIntArray
+	BuildCapsulePlanesWithDefaults(radius gdnative.Real, height gdnative.Real, sides gdnative.Int, lats gdnative.Int) gdnative.Array
+	BuildCylinderPlanesWithDefaults(radius gdnative.Real, height gdnative.Real, sides gdnative.Int) gdnative.Array
 }
@@ -1,7 +1,10 @@
-package godot
+package object
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -36,6 +39,88 @@ const (
 	InputCursorWait         InputCursorShape = 4
 )
 
+// String will return the Godot name of the InputCursorShape value.
+func (e InputCursorShape) String() string {
+	switch e {
+	case InputCursorArrow:
+		return "CURSOR_ARROW"
+	case InputCursorBdiagsize:
+		return "CURSOR_BDIAGSIZE"
+	case InputCursorBusy:
+		return "CURSOR_BUSY"
+	case InputCursorCanDrop:
+		return "CURSOR_CAN_DROP"
+	case InputCursorCross:
+		return "CURSOR_CROSS"
+	case InputCursorDrag:
+		return "CURSOR_DRAG"
+	case InputCursorFdiagsize:
+		return "CURSOR_FDIAGSIZE"
+	case InputCursorForbidden:
+		return "CURSOR_FORBIDDEN"
+	case InputCursorHelp:
+		return "CURSOR_HELP"
+	case InputCursorHsize:
+		return "CURSOR_HSIZE"
+	case InputCursorHsplit:
+		return "CURSOR_HSPLIT"
+	case InputCursorIbeam:
+		return "CURSOR_IBEAM"
+	case InputCursorMove:
+		return "CURSOR_MOVE"
+	case InputCursorPointingHand:
+		return "CURSOR_POINTING_HAND"
+	case InputCursorVsize:
+		return "CURSOR_VSIZE"
+	case InputCursorVsplit:
+		return "CURSOR_VSPLIT"
+	case InputCursorWait:
+		return "CURSOR_WAIT"
+	}
+	return fmt.Sprintf("InputCursorShape(%d)", int(e))
+}
+
+// ParseInputCursorShape will return the InputCursorShape value with the given Godot name.
+func ParseInputCursorShape(name string) (InputCursorShape, error) {
+	switch name {
+	case "CURSOR_ARROW":
+		return InputCursorArrow, nil
+	case "CURSOR_BDIAGSIZE":
+		return InputCursorBdiagsize, nil
+	case "CURSOR_BUSY":
+		return InputCursorBusy, nil
+	case "CURSOR_CAN_DROP":
+		return InputCursorCanDrop, nil
+	case "CURSOR_CROSS":
+		return InputCursorCross, nil
+	case "CURSOR_DRAG":
+		return InputCursorDrag, nil
+	case "CURSOR_FDIAGSIZE":
+		return InputCursorFdiagsize, nil
+	case "CURSOR_FORBIDDEN":
+		return InputCursorForbidden, nil
+	case "CURSOR_HELP":
+		return InputCursorHelp, nil
+	case "CURSOR_HSIZE":
+		return InputCursorHsize, nil
+	case "CURSOR_HSPLIT":
+		return InputCursorHsplit, nil
+	case "CURSOR_IBEAM":
+		return InputCursorIbeam, nil
+	case "CURSOR_MOVE":
+		return InputCursorMove, nil
+	case "CURSOR_POINTING_HAND":
+		return InputCursorPointingHand, nil
+	case "CURSOR_VSIZE":
+		return InputCursorVsize, nil
+	case "CURSOR_VSPLIT":
+		return InputCursorVsplit, nil
+	case "CURSOR_WAIT":
+		return InputCursorWait, nil
+	}
+	return 0, fmt.Errorf("invalid InputCursorShape value %q", name)
+}
+
 // InputMouseMode is an enum for MouseMode values.
 type InputMouseMode int
 
@@ -46,13 +131,34 @@ const (
 	InputMouseModeVisible  InputMouseMode = 0
 )
 
-//func NewinputFromPointer(ptr gdnative.Pointer) input {
-func newInputFromPointer(ptr gdnative.Pointer) input {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := input{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the InputMouseMode value.
+func (e InputMouseMode) String() string {
+	switch e {
+	case InputMouseModeCaptured:
+		return "MOUSE_MODE_CAPTURED"
+	case InputMouseModeConfined:
+		return "MOUSE_MODE_CONFINED"
+	case InputMouseModeHidden:
+		return "MOUSE_MODE_HIDDEN"
+	case InputMouseModeVisible:
+		return "MOUSE_MODE_VISIBLE"
+	}
+	return fmt.Sprintf("InputMouseMode(%d)", int(e))
+}
 
-	return obj
+// ParseInputMouseMode will return the InputMouseMode value with the given Godot name.
+func ParseInputMouseMode(name string) (InputMouseMode, error) {
+	switch name {
+	case "MOUSE_MODE_CAPTURED":
+		return InputMouseModeCaptured, nil
+	case "MOUSE_MODE_CONFINED":
+		return InputMouseModeConfined, nil
+	case "MOUSE_MODE_HIDDEN":
+		return InputMouseModeHidden, nil
+	case "MOUSE_MODE_VISIBLE":
+		return InputMouseModeVisible, nil
+	}
+	return 0, fmt.Errorf("invalid InputMouseMode value %q", name)
 }
 
 func newSingletonInput() *input {
@@ -60,7 +166,7 @@ func newSingletonInput() *input {
 }
 
 /*
-   A Singleton that deals with inputs. This includes key presses, mouse buttons and movement, joypads, and input actions. Actions and their events can be set in the Project Settings / Input Map tab. Or be set with [InputMap].
+A Singleton that deals with inputs. This includes key presses, mouse buttons and movement, joypads, and input actions. Actions and their events can be set in the Project Settings / Input Map tab. Or be set with [InputMap].
 */
 var Input = newSingletonInput()
 
@@ -68,7 +174,7 @@ var Input = newSingletonInput()
 A Singleton that deals with inputs. This includes key presses, mouse buttons and movement, joypads, and input actions. Actions and their events can be set in the Project Settings / Input Map tab. Or be set with [InputMap].
 */
 type input struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -89,9 +195,49 @@ func (o *input) BaseClass() string {
 	return "Input"
 }
 
+// Method binds of the Input class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputActionPress                 = gdnative.NewLazyMethodBind("Input", "action_press")
+	methodBindInputActionRelease               = gdnative.NewLazyMethodBind("Input", "action_release")
+	methodBindInputAddJoyMapping               = gdnative.NewLazyMethodBind("Input", "add_joy_mapping")
+	methodBindInputGetAccelerometer            = gdnative.NewLazyMethodBind("Input", "get_accelerometer")
+	methodBindInputGetConnectedJoypads         = gdnative.NewLazyMethodBind("Input", "get_connected_joypads")
+	methodBindInputGetGravity                  = gdnative.NewLazyMethodBind("Input", "get_gravity")
+	methodBindInputGetGyroscope                = gdnative.NewLazyMethodBind("Input", "get_gyroscope")
+	methodBindInputGetJoyAxis                  = gdnative.NewLazyMethodBind("Input", "get_joy_axis")
+	methodBindInputGetJoyAxisIndexFromString   = gdnative.NewLazyMethodBind("Input", "get_joy_axis_index_from_string")
+	methodBindInputGetJoyAxisString            = gdnative.NewLazyMethodBind("Input", "get_joy_axis_string")
+	methodBindInputGetJoyButtonIndexFromString = gdnative.NewLazyMethodBind("Input", "get_joy_button_index_from_string")
+	methodBindInputGetJoyButtonString          = gdnative.NewLazyMethodBind("Input", "get_joy_button_string")
+	methodBindInputGetJoyGuid                  = gdnative.NewLazyMethodBind("Input", "get_joy_guid")
+	methodBindInputGetJoyName                  = gdnative.NewLazyMethodBind("Input", "get_joy_name")
+	methodBindInputGetJoyVibrationDuration     = gdnative.NewLazyMethodBind("Input", "get_joy_vibration_duration")
+	methodBindInputGetJoyVibrationStrength     = gdnative.NewLazyMethodBind("Input", "get_joy_vibration_strength")
+	methodBindInputGetLastMouseSpeed           = gdnative.NewLazyMethodBind("Input", "get_last_mouse_speed")
+	methodBindInputGetMagnetometer             = gdnative.NewLazyMethodBind("Input", "get_magnetometer")
+	methodBindInputGetMouseButtonMask          = gdnative.NewLazyMethodBind("Input", "get_mouse_button_mask")
+	methodBindInputGetMouseMode                = gdnative.NewLazyMethodBind("Input", "get_mouse_mode")
+	methodBindInputIsActionJustPressed         = gdnative.NewLazyMethodBind("Input", "is_action_just_pressed")
+	methodBindInputIsActionJustReleased        = gdnative.NewLazyMethodBind("Input", "is_action_just_released")
+	methodBindInputIsActionPressed             = gdnative.NewLazyMethodBind("Input", "is_action_pressed")
+	methodBindInputIsJoyButtonPressed          = gdnative.NewLazyMethodBind("Input", "is_joy_button_pressed")
+	methodBindInputIsJoyKnown                  = gdnative.NewLazyMethodBind("Input", "is_joy_known")
+	methodBindInputIsKeyPressed                = gdnative.NewLazyMethodBind("Input", "is_key_pressed")
+	methodBindInputIsMouseButtonPressed        = gdnative.NewLazyMethodBind("Input", "is_mouse_button_pressed")
+	methodBindInputJoyConnectionChanged        = gdnative.NewLazyMethodBind("Input", "joy_connection_changed")
+	methodBindInputParseInputEvent             = gdnative.NewLazyMethodBind("Input", "parse_input_event")
+	methodBindInputRemoveJoyMapping            = gdnative.NewLazyMethodBind("Input", "remove_joy_mapping")
+	methodBindInputSetCustomMouseCursor        = gdnative.NewLazyMethodBind("Input", "set_custom_mouse_cursor")
+	methodBindInputSetMouseMode                = gdnative.NewLazyMethodBind("Input", "set_mouse_mode")
+	methodBindInputStartJoyVibration           = gdnative.NewLazyMethodBind("Input", "start_joy_vibration")
+	methodBindInputStopJoyVibration            = gdnative.NewLazyMethodBind("Input", "stop_joy_vibration")
+	methodBindInputWarpMousePosition           = gdnative.NewLazyMethodBind("Input", "warp_mouse_position")
+)
+
 /*
-        This will simulate pressing the specified action.
-	Args: [{ false action String}], Returns: void
+	        This will simulate pressing the specified action.
+		Args: [{ false action String}], Returns: void
 */
 func (o *input) ActionPress(action gdnative.String) {
 	o.ensureSingleton()
@@ -102,7 +248,7 @@ func (o *input) ActionPress(action gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "action_press")
+	methodBind := methodBindInputActionPress.Get()
 
 	// Call the parent method.
 	// void
@@ -112,8 +258,8 @@ func (o *input) ActionPress(action gdnative.String) {
 }
 
 /*
-        If the specified action is already pressed, this will release it.
-	Args: [{ false action String}], Returns: void
+	        If the specified action is already pressed, this will release it.
+		Args: [{ false action String}], Returns: void
 */
 func (o *input) ActionRelease(action gdnative.String) {
 	o.ensureSingleton()
@@ -124,7 +270,7 @@ func (o *input) ActionRelease(action gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "action_release")
+	methodBind := methodBindInputActionRelease.Get()
 
 	// Call the parent method.
 	// void
@@ -134,8 +280,8 @@ func (o *input) ActionRelease(action gdnative.String) {
 }
 
 /*
-        Add a new mapping entry (in SDL2 format) to the mapping database. Optionally update already connected devices.
-	Args: [{ false mapping String} {False true update_existing bool}], Returns: void
+	        Add a new mapping entry (in SDL2 format) to the mapping database. Optionally update already connected devices.
+		Args: [{ false mapping String} {False true update_existing bool}], Returns: void
 */
 func (o *input) AddJoyMapping(mapping gdnative.String, updateExisting gdnative.Bool) {
 	o.ensureSingleton()
@@ -147,7 +293,7 @@ func (o *input) AddJoyMapping(mapping gdnative.String, updateExisting gdnative.B
 	ptrArguments[1] = gdnative.NewPointerFromBool(updateExisting)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "add_joy_mapping")
+	methodBind := methodBindInputAddJoyMapping.Get()
 
 	// Call the parent method.
 	// void
@@ -157,8 +303,8 @@ func (o *input) AddJoyMapping(mapping gdnative.String, updateExisting gdnative.B
 }
 
 /*
-        If the device has an accelerometer, this will return the acceleration. Otherwise, it returns an empty [Vector3].
-	Args: [], Returns: Vector3
+	        If the device has an accelerometer, this will return the acceleration. Otherwise, it returns an empty [Vector3].
+		Args: [], Returns: Vector3
 */
 func (o *input) GetAccelerometer() gdnative.Vector3 {
 	o.ensureSingleton()
@@ -168,7 +314,7 @@ func (o *input) GetAccelerometer() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_accelerometer")
+	methodBind := methodBindInputGetAccelerometer.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -181,8 +327,8 @@ func (o *input) GetAccelerometer() gdnative.Vector3 {
 }
 
 /*
-        Returns an [Array] containing the device IDs of all currently connected joypads.
-	Args: [], Returns: Array
+	        Returns an [Array] containing the device IDs of all currently connected joypads.
+		Args: [], Returns: Array
 */
 func (o *input) GetConnectedJoypads() gdnative.Array {
 	o.ensureSingleton()
@@ -192,7 +338,7 @@ func (o *input) GetConnectedJoypads() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_connected_joypads")
+	methodBind := methodBindInputGetConnectedJoypads.Get()
 
 	// Call the parent method.
 	// Array
@@ -205,8 +351,8 @@ func (o *input) GetConnectedJoypads() gdnative.Array {
 }
 
 /*
-        If the device has an accelerometer, this will return the gravity. Otherwise, it returns an empty [Vector3].
-	Args: [], Returns: Vector3
+	        If the device has an accelerometer, this will return the gravity. Otherwise, it returns an empty [Vector3].
+		Args: [], Returns: Vector3
 */
 func (o *input) GetGravity() gdnative.Vector3 {
 	o.ensureSingleton()
@@ -216,7 +362,7 @@ func (o *input) GetGravity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_gravity")
+	methodBind := methodBindInputGetGravity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -229,8 +375,8 @@ func (o *input) GetGravity() gdnative.Vector3 {
 }
 
 /*
-        If the device has a gyroscope, this will return the rate of rotation in rad/s around a device's x, y, and z axis. Otherwise, it returns an empty [Vector3].
-	Args: [], Returns: Vector3
+	        If the device has a gyroscope, this will return the rate of rotation in rad/s around a device's x, y, and z axis. Otherwise, it returns an empty [Vector3].
+		Args: [], Returns: Vector3
 */
 func (o *input) GetGyroscope() gdnative.Vector3 {
 	o.ensureSingleton()
@@ -240,7 +386,7 @@ func (o *input) GetGyroscope() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_gyroscope")
+	methodBind := methodBindInputGetGyroscope.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -253,8 +399,8 @@ func (o *input) GetGyroscope() gdnative.Vector3 {
 }
 
 /*
-        Returns the current value of the joypad axis at given index (see [code]JOY_*[/code] constants in [@GlobalScope])
-	Args: [{ false device int} { false axis int}], Returns: float
+	        Returns the current value of the joypad axis at given index (see [code]JOY_*[/code] constants in [@GlobalScope])
+		Args: [{ false device int} { false axis int}], Returns: float
 */
 func (o *input) GetJoyAxis(device gdnative.Int, axis gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -266,7 +412,7 @@ func (o *input) GetJoyAxis(device gdnative.Int, axis gdnative.Int) gdnative.Real
 	ptrArguments[1] = gdnative.NewPointerFromInt(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_axis")
+	methodBind := methodBindInputGetJoyAxis.Get()
 
 	// Call the parent method.
 	// float
@@ -279,8 +425,7 @@ func (o *input) GetJoyAxis(device gdnative.Int, axis gdnative.Int) gdnative.Real
 }
 
 /*
-
-	Args: [{ false axis String}], Returns: int
+Args: [{ false axis String}], Returns: int
 */
 func (o *input) GetJoyAxisIndexFromString(axis gdnative.String) gdnative.Int {
 	o.ensureSingleton()
@@ -291,7 +436,7 @@ func (o *input) GetJoyAxisIndexFromString(axis gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_axis_index_from_string")
+	methodBind := methodBindInputGetJoyAxisIndexFromString.Get()
 
 	// Call the parent method.
 	// int
@@ -304,8 +449,7 @@ func (o *input) GetJoyAxisIndexFromString(axis gdnative.String) gdnative.Int {
 }
 
 /*
-
-	Args: [{ false axis_index int}], Returns: String
+Args: [{ false axis_index int}], Returns: String
 */
 func (o *input) GetJoyAxisString(axisIndex gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -316,7 +460,7 @@ func (o *input) GetJoyAxisString(axisIndex gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(axisIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_axis_string")
+	methodBind := methodBindInputGetJoyAxisString.Get()
 
 	// Call the parent method.
 	// String
@@ -329,8 +473,7 @@ func (o *input) GetJoyAxisString(axisIndex gdnative.Int) gdnative.String {
 }
 
 /*
-
-	Args: [{ false button String}], Returns: int
+Args: [{ false button String}], Returns: int
 */
 func (o *input) GetJoyButtonIndexFromString(button gdnative.String) gdnative.Int {
 	o.ensureSingleton()
@@ -341,7 +484,7 @@ func (o *input) GetJoyButtonIndexFromString(button gdnative.String) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromString(button)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_button_index_from_string")
+	methodBind := methodBindInputGetJoyButtonIndexFromString.Get()
 
 	// Call the parent method.
 	// int
@@ -354,8 +497,7 @@ func (o *input) GetJoyButtonIndexFromString(button gdnative.String) gdnative.Int
 }
 
 /*
-
-	Args: [{ false button_index int}], Returns: String
+Args: [{ false button_index int}], Returns: String
 */
 func (o *input) GetJoyButtonString(buttonIndex gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -366,7 +508,7 @@ func (o *input) GetJoyButtonString(buttonIndex gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(buttonIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_button_string")
+	methodBind := methodBindInputGetJoyButtonString.Get()
 
 	// Call the parent method.
 	// String
@@ -379,8 +521,8 @@ func (o *input) GetJoyButtonString(buttonIndex gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns a SDL2 compatible device guid on platforms that use gamepad remapping. Returns "Default Gamepad" otherwise.
-	Args: [{ false device int}], Returns: String
+	        Returns a SDL2 compatible device guid on platforms that use gamepad remapping. Returns "Default Gamepad" otherwise.
+		Args: [{ false device int}], Returns: String
 */
 func (o *input) GetJoyGuid(device gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -391,7 +533,7 @@ func (o *input) GetJoyGuid(device gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(device)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_guid")
+	methodBind := methodBindInputGetJoyGuid.Get()
 
 	// Call the parent method.
 	// String
@@ -404,8 +546,8 @@ func (o *input) GetJoyGuid(device gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the name of the joypad at the specified device index
-	Args: [{ false device int}], Returns: String
+	        Returns the name of the joypad at the specified device index
+		Args: [{ false device int}], Returns: String
 */
 func (o *input) GetJoyName(device gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -416,7 +558,7 @@ func (o *input) GetJoyName(device gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(device)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_name")
+	methodBind := methodBindInputGetJoyName.Get()
 
 	// Call the parent method.
 	// String
@@ -429,8 +571,8 @@ func (o *input) GetJoyName(device gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the duration of the current vibration effect in seconds.
-	Args: [{ false device int}], Returns: float
+	        Returns the duration of the current vibration effect in seconds.
+		Args: [{ false device int}], Returns: float
 */
 func (o *input) GetJoyVibrationDuration(device gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -441,7 +583,7 @@ func (o *input) GetJoyVibrationDuration(device gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(device)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_vibration_duration")
+	methodBind := methodBindInputGetJoyVibrationDuration.Get()
 
 	// Call the parent method.
 	// float
@@ -454,8 +596,8 @@ func (o *input) GetJoyVibrationDuration(device gdnative.Int) gdnative.Real {
 }
 
 /*
-        Returns the strength of the joypad vibration: x is the strength of the weak motor, and y is the strength of the strong motor.
-	Args: [{ false device int}], Returns: Vector2
+	        Returns the strength of the joypad vibration: x is the strength of the weak motor, and y is the strength of the strong motor.
+		Args: [{ false device int}], Returns: Vector2
 */
 func (o *input) GetJoyVibrationStrength(device gdnative.Int) gdnative.Vector2 {
 	o.ensureSingleton()
@@ -466,7 +608,7 @@ func (o *input) GetJoyVibrationStrength(device gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(device)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_joy_vibration_strength")
+	methodBind := methodBindInputGetJoyVibrationStrength.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -479,8 +621,8 @@ func (o *input) GetJoyVibrationStrength(device gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Returns the mouse speed for the last time the cursor was moved, and this until the next frame where the mouse moves. This means that even if the mouse is not moving, this function will still return the value of the last motion.
-	Args: [], Returns: Vector2
+	        Returns the mouse speed for the last time the cursor was moved, and this until the next frame where the mouse moves. This means that even if the mouse is not moving, this function will still return the value of the last motion.
+		Args: [], Returns: Vector2
 */
 func (o *input) GetLastMouseSpeed() gdnative.Vector2 {
 	o.ensureSingleton()
@@ -490,7 +632,7 @@ func (o *input) GetLastMouseSpeed() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_last_mouse_speed")
+	methodBind := methodBindInputGetLastMouseSpeed.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -503,8 +645,8 @@ func (o *input) GetLastMouseSpeed() gdnative.Vector2 {
 }
 
 /*
-        If the device has a magnetometer, this will return the magnetic field strength in micro-Tesla for all axes.
-	Args: [], Returns: Vector3
+	        If the device has a magnetometer, this will return the magnetic field strength in micro-Tesla for all axes.
+		Args: [], Returns: Vector3
 */
 func (o *input) GetMagnetometer() gdnative.Vector3 {
 	o.ensureSingleton()
@@ -514,7 +656,7 @@ func (o *input) GetMagnetometer() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_magnetometer")
+	methodBind := methodBindInputGetMagnetometer.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -527,8 +669,8 @@ func (o *input) GetMagnetometer() gdnative.Vector3 {
 }
 
 /*
-        Returns mouse buttons as a bitmask. If multiple mouse buttons are pressed at the same time the bits are added together.
-	Args: [], Returns: int
+	        Returns mouse buttons as a bitmask. If multiple mouse buttons are pressed at the same time the bits are added together.
+		Args: [], Returns: int
 */
 func (o *input) GetMouseButtonMask() gdnative.Int {
 	o.ensureSingleton()
@@ -538,7 +680,7 @@ func (o *input) GetMouseButtonMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_mouse_button_mask")
+	methodBind := methodBindInputGetMouseButtonMask.Get()
 
 	// Call the parent method.
 	// int
@@ -551,8 +693,8 @@ func (o *input) GetMouseButtonMask() gdnative.Int {
 }
 
 /*
-        Return the mouse mode. See the constants for more information.
-	Args: [], Returns: enum.Input::MouseMode
+	        Return the mouse mode. See the constants for more information.
+		Args: [], Returns: enum.Input::MouseMode
 */
 func (o *input) GetMouseMode() InputMouseMode {
 	o.ensureSingleton()
@@ -562,7 +704,7 @@ func (o *input) GetMouseMode() InputMouseMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "get_mouse_mode")
+	methodBind := methodBindInputGetMouseMode.Get()
 
 	// Call the parent method.
 	// enum.Input::MouseMode
@@ -575,8 +717,8 @@ func (o *input) GetMouseMode() InputMouseMode {
 }
 
 /*
-        Returns [code]true[/code] when you start pressing the action event.
-	Args: [{ false action String}], Returns: bool
+	        Returns [code]true[/code] when you start pressing the action event.
+		Args: [{ false action String}], Returns: bool
 */
 func (o *input) IsActionJustPressed(action gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -587,7 +729,7 @@ func (o *input) IsActionJustPressed(action gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "is_action_just_pressed")
+	methodBind := methodBindInputIsActionJustPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -600,8 +742,8 @@ func (o *input) IsActionJustPressed(action gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] when you stop pressing the action event.
-	Args: [{ false action String}], Returns: bool
+	        Returns [code]true[/code] when you stop pressing the action event.
+		Args: [{ false action String}], Returns: bool
 */
 func (o *input) IsActionJustReleased(action gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -612,7 +754,7 @@ func (o *input) IsActionJustReleased(action gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "is_action_just_released")
+	methodBind := methodBindInputIsActionJustReleased.Get()
 
 	// Call the parent method.
 	// bool
@@ -625,8 +767,8 @@ func (o *input) IsActionJustReleased(action gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if you are pressing the action event.
-	Args: [{ false action String}], Returns: bool
+	        Returns [code]true[/code] if you are pressing the action event.
+		Args: [{ false action String}], Returns: bool
 */
 func (o *input) IsActionPressed(action gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -637,7 +779,7 @@ func (o *input) IsActionPressed(action gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "is_action_pressed")
+	methodBind := methodBindInputIsActionPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -650,8 +792,8 @@ func (o *input) IsActionPressed(action gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if you are pressing the joypad button. (see [code]JOY_*[/code] constants in [@GlobalScope])
-	Args: [{ false device int} { false button int}], Returns: bool
+	        Returns [code]true[/code] if you are pressing the joypad button. (see [code]JOY_*[/code] constants in [@GlobalScope])
+		Args: [{ false device int} { false button int}], Returns: bool
 */
 func (o *input) IsJoyButtonPressed(device gdnative.Int, button gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -663,7 +805,7 @@ func (o *input) IsJoyButtonPressed(device gdnative.Int, button gdnative.Int) gdn
 	ptrArguments[1] = gdnative.NewPointerFromInt(button)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "is_joy_button_pressed")
+	methodBind := methodBindInputIsJoyButtonPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -676,8 +818,8 @@ func (o *input) IsJoyButtonPressed(device gdnative.Int, button gdnative.Int) gdn
 }
 
 /*
-        Returns [code]true[/code] if the system knows the specified device. This means that it sets all button and axis indices exactly as defined in the [code]JOY_*[/code] constants (see [@GlobalScope]). Unknown joypads are not expected to match these constants, but you can still retrieve events from them.
-	Args: [{ false device int}], Returns: bool
+	        Returns [code]true[/code] if the system knows the specified device. This means that it sets all button and axis indices exactly as defined in the [code]JOY_*[/code] constants (see [@GlobalScope]). Unknown joypads are not expected to match these constants, but you can still retrieve events from them.
+		Args: [{ false device int}], Returns: bool
 */
 func (o *input) IsJoyKnown(device gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -688,7 +830,7 @@ func (o *input) IsJoyKnown(device gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(device)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "is_joy_known")
+	methodBind := methodBindInputIsJoyKnown.Get()
 
 	// Call the parent method.
 	// bool
@@ -701,8 +843,8 @@ func (o *input) IsJoyKnown(device gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if you are pressing the key. You can pass [code]KEY_*[/code], which are pre-defined constants listed in [@GlobalScope].
-	Args: [{ false scancode int}], Returns: bool
+	        Returns [code]true[/code] if you are pressing the key. You can pass [code]KEY_*[/code], which are pre-defined constants listed in [@GlobalScope].
+		Args: [{ false scancode int}], Returns: bool
 */
 func (o *input) IsKeyPressed(scancode gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -713,7 +855,7 @@ func (o *input) IsKeyPressed(scancode gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(scancode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "is_key_pressed")
+	methodBind := methodBindInputIsKeyPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -726,8 +868,8 @@ func (o *input) IsKeyPressed(scancode gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if you are pressing the mouse button. You can pass [code]BUTTON_*[/code], which are pre-defined constants listed in [@GlobalScope].
-	Args: [{ false button int}], Returns: bool
+	        Returns [code]true[/code] if you are pressing the mouse button. You can pass [code]BUTTON_*[/code], which are pre-defined constants listed in [@GlobalScope].
+		Args: [{ false button int}], Returns: bool
 */
 func (o *input) IsMouseButtonPressed(button gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -738,7 +880,7 @@ func (o *input) IsMouseButtonPressed(button gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(button)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "is_mouse_button_pressed")
+	methodBind := methodBindInputIsMouseButtonPressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -751,8 +893,7 @@ func (o *input) IsMouseButtonPressed(button gdnative.Int) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false device int} { false connected bool} { false name String} { false guid String}], Returns: void
+Args: [{ false device int} { false connected bool} { false name String} { false guid String}], Returns: void
 */
 func (o *input) JoyConnectionChanged(device gdnative.Int, connected gdnative.Bool, name gdnative.String, guid gdnative.String) {
 	o.ensureSingleton()
@@ -766,7 +907,7 @@ func (o *input) JoyConnectionChanged(device gdnative.Int, connected gdnative.Boo
 	ptrArguments[3] = gdnative.NewPointerFromString(guid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "joy_connection_changed")
+	methodBind := methodBindInputJoyConnectionChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -776,19 +917,19 @@ func (o *input) JoyConnectionChanged(device gdnative.Int, connected gdnative.Boo
 }
 
 /*
-        Feeds an [InputEvent] to the game. Can be used to artificially trigger input events from code.
-	Args: [{ false event InputEvent}], Returns: void
+	        Feeds an [InputEvent] to the game. Can be used to artificially trigger input events from code.
+		Args: [{ false event InputEvent}], Returns: void
 */
-func (o *input) ParseInputEvent(event InputEventImplementer) {
+func (o *input) ParseInputEvent(event godot.InputEventImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling Input.ParseInputEvent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "parse_input_event")
+	methodBind := methodBindInputParseInputEvent.Get()
 
 	// Call the parent method.
 	// void
@@ -798,8 +939,8 @@ func (o *input) ParseInputEvent(event InputEventImplementer) {
 }
 
 /*
-        Removes all mappings from the internal db that match the given uid.
-	Args: [{ false guid String}], Returns: void
+	        Removes all mappings from the internal db that match the given uid.
+		Args: [{ false guid String}], Returns: void
 */
 func (o *input) RemoveJoyMapping(guid gdnative.String) {
 	o.ensureSingleton()
@@ -810,7 +951,7 @@ func (o *input) RemoveJoyMapping(guid gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(guid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "remove_joy_mapping")
+	methodBind := methodBindInputRemoveJoyMapping.Get()
 
 	// Call the parent method.
 	// void
@@ -820,21 +961,21 @@ func (o *input) RemoveJoyMapping(guid gdnative.String) {
 }
 
 /*
-        Set a custom mouse cursor image, which is only visible inside the game window. The hotspot can also be specified. See enum [code]CURSOR_*[/code] for the list of shapes.
-	Args: [{ false image Resource} {0 true shape int} {(0, 0) true hotspot Vector2}], Returns: void
+	        Set a custom mouse cursor image, which is only visible inside the game window. The hotspot can also be specified. See enum [code]CURSOR_*[/code] for the list of shapes.
+		Args: [{ false image Resource} {0 true shape int} {(0, 0) true hotspot Vector2}], Returns: void
 */
-func (o *input) SetCustomMouseCursor(image ResourceImplementer, shape gdnative.Int, hotspot gdnative.Vector2) {
+func (o *input) SetCustomMouseCursor(image godot.ResourceImplementer, shape gdnative.Int, hotspot gdnative.Vector2) {
 	o.ensureSingleton()
 	//log.Println("Calling Input.SetCustomMouseCursor()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(image.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
 	ptrArguments[1] = gdnative.NewPointerFromInt(shape)
 	ptrArguments[2] = gdnative.NewPointerFromVector2(hotspot)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "set_custom_mouse_cursor")
+	methodBind := methodBindInputSetCustomMouseCursor.Get()
 
 	// Call the parent method.
 	// void
@@ -844,19 +985,19 @@ func (o *input) SetCustomMouseCursor(image ResourceImplementer, shape gdnative.I
 }
 
 /*
-        Set the mouse mode. See the constants for more information.
-	Args: [{ false mode int}], Returns: void
+	        Set the mouse mode. See the constants for more information.
+		Args: [{ false mode enum.Input::MouseMode}], Returns: void
 */
-func (o *input) SetMouseMode(mode gdnative.Int) {
+func (o *input) SetMouseMode(mode InputMouseMode) {
 	o.ensureSingleton()
 	//log.Println("Calling Input.SetMouseMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "set_mouse_mode")
+	methodBind := methodBindInputSetMouseMode.Get()
 
 	// Call the parent method.
 	// void
@@ -866,8 +1007,8 @@ func (o *input) SetMouseMode(mode gdnative.Int) {
 }
 
 /*
-        Starts to vibrate the joypad. Joypads usually come with two rumble motors, a strong and a weak one. weak_magnitude is the strength of the weak motor (between 0 and 1) and strong_magnitude is the strength of the strong motor (between 0 and 1). duration is the duration of the effect in seconds (a duration of 0 will try to play the vibration indefinitely). Note that not every hardware is compatible with long effect durations, it is recommended to restart an effect if in need to play it for more than a few seconds.
-	Args: [{ false device int} { false weak_magnitude float} { false strong_magnitude float} {0 true duration float}], Returns: void
+	        Starts to vibrate the joypad. Joypads usually come with two rumble motors, a strong and a weak one. weak_magnitude is the strength of the weak motor (between 0 and 1) and strong_magnitude is the strength of the strong motor (between 0 and 1). duration is the duration of the effect in seconds (a duration of 0 will try to play the vibration indefinitely). Note that not every hardware is compatible with long effect durations, it is recommended to restart an effect if in need to play it for more than a few seconds.
+		Args: [{ false device int} { false weak_magnitude float} { false strong_magnitude float} {0 true duration float}], Returns: void
 */
 func (o *input) StartJoyVibration(device gdnative.Int, weakMagnitude gdnative.Real, strongMagnitude gdnative.Real, duration gdnative.Real) {
 	o.ensureSingleton()
@@ -881,7 +1022,7 @@ func (o *input) StartJoyVibration(device gdnative.Int, weakMagnitude gdnative.Re
 	ptrArguments[3] = gdnative.NewPointerFromReal(duration)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "start_joy_vibration")
+	methodBind := methodBindInputStartJoyVibration.Get()
 
 	// Call the parent method.
 	// void
@@ -891,8 +1032,8 @@ func (o *input) StartJoyVibration(device gdnative.Int, weakMagnitude gdnative.Re
 }
 
 /*
-        Stops the vibration of the joypad.
-	Args: [{ false device int}], Returns: void
+	        Stops the vibration of the joypad.
+		Args: [{ false device int}], Returns: void
 */
 func (o *input) StopJoyVibration(device gdnative.Int) {
 	o.ensureSingleton()
@@ -903,7 +1044,7 @@ func (o *input) StopJoyVibration(device gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(device)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "stop_joy_vibration")
+	methodBind := methodBindInputStopJoyVibration.Get()
 
 	// Call the parent method.
 	// void
@@ -913,8 +1054,8 @@ func (o *input) StopJoyVibration(device gdnative.Int) {
 }
 
 /*
-        Sets the mouse position to the specified vector.
-	Args: [{ false to Vector2}], Returns: void
+	        Sets the mouse position to the specified vector.
+		Args: [{ false to Vector2}], Returns: void
 */
 func (o *input) WarpMousePosition(to gdnative.Vector2) {
 	o.ensureSingleton()
@@ -925,7 +1066,7 @@ func (o *input) WarpMousePosition(to gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(to)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Input", "warp_mouse_position")
+	methodBind := methodBindInputWarpMousePosition.Get()
 
 	// Call the parent method.
 	// void
@@ -934,10 +1075,58 @@ func (o *input) WarpMousePosition(to gdnative.Vector2) {
 
 }
 
+// AddJoyMappingWithDefaults will call AddJoyMapping using the default values for: update_existing.
+func (o *input) AddJoyMappingWithDefaults(mapping gdnative.String) {
+	o.AddJoyMapping(mapping, false)
+}
+
+// SetCustomMouseCursorWithDefaults will call SetCustomMouseCursor using the default values for: shape, hotspot.
+func (o *input) SetCustomMouseCursorWithDefaults(image godot.ResourceImplementer) {
+	o.SetCustomMouseCursor(image, 0, gdnative.NewVector2(0, 0))
+}
+
+// StartJoyVibrationWithDefaults will call StartJoyVibration using the default values for: duration.
+func (o *input) StartJoyVibrationWithDefaults(device gdnative.Int, weakMagnitude gdnative.Real, strongMagnitude gdnative.Real) {
+	o.StartJoyVibration(device, weakMagnitude, strongMagnitude, 0)
+}
+
+// Signals of the Input class.
+const (
+	InputSignalJoyConnectionChanged gdnative.String = "joy_connection_changed"
+)
+
+// InputJoyConnectionChangedArgs holds the arguments of the "joy_connection_changed" signal.
+type InputJoyConnectionChangedArgs struct {
+	Index     gdnative.Int
+	Connected gdnative.Bool
+}
+
+// ConnectJoyConnectionChanged will connect the "joy_connection_changed" signal to the given method of the target object.
+func (o *input) ConnectJoyConnectionChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(InputSignalJoyConnectionChanged, target, method, binds, 0)
+}
+
+// EmitJoyConnectionChanged will emit the "joy_connection_changed" signal.
+func (o *input) EmitJoyConnectionChanged(args InputJoyConnectionChangedArgs) error {
+	o.ensureSingleton()
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Index))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantBool(args.Connected)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(InputSignalJoyConnectionChanged, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
 // InputImplementer is an interface that implements the methods
 // of the Input class.
 type InputImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	ActionPress(action gdnative.String)
 	ActionRelease(action gdnative.String)
 	AddJoyMapping(mapping gdnative.String, updateExisting gdnative.Bool)
@@ -957,6 +1146,7 @@ type InputImplementer interface {
 	GetLastMouseSpeed() gdnative.Vector2
 	GetMagnetometer() gdnative.Vector3
 	GetMouseButtonMask() gdnative.Int
+	GetMouseMode() InputMouseMode
 	IsActionJustPressed(action gdnative.String) gdnative.Bool
 	IsActionJustReleased(action gdnative.String) gdnative.Bool
 	IsActionPressed(action gdnative.String) gdnative.Bool
@@ -965,11 +1155,16 @@ type InputImplementer interface {
 	IsKeyPressed(scancode gdnative.Int) gdnative.Bool
 	IsMouseButtonPressed(button gdnative.Int) gdnative.Bool
 	JoyConnectionChanged(device gdnative.Int, connected gdnative.Bool, name gdnative.String, guid gdnative.String)
-	ParseInputEvent(event InputEventImplementer)
+	ParseInputEvent(event godot.InputEventImplementer)
 	RemoveJoyMapping(guid gdnative.String)
-	SetCustomMouseCursor(image ResourceImplementer, shape gdnative.Int, hotspot gdnative.Vector2)
-	SetMouseMode(mode gdnative.Int)
+	SetCustomMouseCursor(image godot.ResourceImplementer, shape gdnative.Int, hotspot gdnative.Vector2)
+	SetMouseMode(mode InputMouseMode)
 	StartJoyVibration(device gdnative.Int, weakMagnitude gdnative.Real, strongMagnitude gdnative.Real, duration gdnative.Real)
 	StopJoyVibration(device gdnative.Int)
 	WarpMousePosition(to gdnative.Vector2)
+	AddJoyMappingWithDefaults(mapping gdnative.String)
+	SetCustomMouseCursorWithDefaults(image godot.ResourceImplementer)
+	StartJoyVibrationWithDefaults(device gdnative.Int, weakMagnitude gdnative.Real, strongMagnitude gdnative.Real)
+	ConnectJoyConnectionChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitJoyConnectionChanged(args InputJoyConnectionChangedArgs) error
 }
@@ -1,4 +1,4 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInputDefaultFromPointer(ptr gdnative.Pointer) InputDefault {
-func newInputDefaultFromPointer(ptr gdnative.Pointer) InputDefault {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InputDefault{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Default implementation of the [Input] class, used internally by the editor and games for default input management.
 */
@@ -1,7 +1,8 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +14,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewinputMapFromPointer(ptr gdnative.Pointer) inputMap {
-func newInputMapFromPointer(ptr gdnative.Pointer) inputMap {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := inputMap{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonInputMap() *inputMap {
 	return &inputMap{}
 }
 
 /*
-   Manages all [InputEventAction] which can be created/modified from the project settings menu [code]Project > Project Settings > Input Map[/code] or in code with [method add_action] and [method action_add_event]. See [method Node._input].
+Manages all [InputEventAction] which can be created/modified from the project settings menu [code]Project > Project Settings > Input Map[/code] or in code with [method add_action] and [method action_add_event]. See [method Node._input].
 */
 var InputMap = newSingletonInputMap()
 
@@ -35,7 +27,7 @@ var InputMap = newSingletonInputMap()
 Manages all [InputEventAction] which can be created/modified from the project settings menu [code]Project > Project Settings > Input Map[/code] or in code with [method add_action] and [method action_add_event]. See [method Node._input].
 */
 type inputMap struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,21 +48,36 @@ func (o *inputMap) BaseClass() string {
 	return "InputMap"
 }
 
+// Method binds of the InputMap class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInputMapActionAddEvent   = gdnative.NewLazyMethodBind("InputMap", "action_add_event")
+	methodBindInputMapActionEraseEvent = gdnative.NewLazyMethodBind("InputMap", "action_erase_event")
+	methodBindInputMapActionHasEvent   = gdnative.NewLazyMethodBind("InputMap", "action_has_event")
+	methodBindInputMapAddAction        = gdnative.NewLazyMethodBind("InputMap", "add_action")
+	methodBindInputMapEraseAction      = gdnative.NewLazyMethodBind("InputMap", "erase_action")
+	methodBindInputMapEventIsAction    = gdnative.NewLazyMethodBind("InputMap", "event_is_action")
+	methodBindInputMapGetActionList    = gdnative.NewLazyMethodBind("InputMap", "get_action_list")
+	methodBindInputMapGetActions       = gdnative.NewLazyMethodBind("InputMap", "get_actions")
+	methodBindInputMapHasAction        = gdnative.NewLazyMethodBind("InputMap", "has_action")
+	methodBindInputMapLoadFromGlobals  = gdnative.NewLazyMethodBind("InputMap", "load_from_globals")
+)
+
 /*
-        Adds an [InputEvent] to an action. This [InputEvent] will trigger the action.
-	Args: [{ false action String} { false event InputEvent}], Returns: void
+	        Adds an [InputEvent] to an action. This [InputEvent] will trigger the action.
+		Args: [{ false action String} { false event InputEvent}], Returns: void
 */
-func (o *inputMap) ActionAddEvent(action gdnative.String, event InputEventImplementer) {
+func (o *inputMap) ActionAddEvent(action gdnative.String, event godot.InputEventImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling InputMap.ActionAddEvent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
-	ptrArguments[1] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "action_add_event")
+	methodBind := methodBindInputMapActionAddEvent.Get()
 
 	// Call the parent method.
 	// void
@@ -80,20 +87,20 @@ func (o *inputMap) ActionAddEvent(action gdnative.String, event InputEventImplem
 }
 
 /*
-        Removes an [InputEvent] from an action.
-	Args: [{ false action String} { false event InputEvent}], Returns: void
+	        Removes an [InputEvent] from an action.
+		Args: [{ false action String} { false event InputEvent}], Returns: void
 */
-func (o *inputMap) ActionEraseEvent(action gdnative.String, event InputEventImplementer) {
+func (o *inputMap) ActionEraseEvent(action gdnative.String, event godot.InputEventImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling InputMap.ActionEraseEvent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
-	ptrArguments[1] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "action_erase_event")
+	methodBind := methodBindInputMapActionEraseEvent.Get()
 
 	// Call the parent method.
 	// void
@@ -103,20 +110,20 @@ func (o *inputMap) ActionEraseEvent(action gdnative.String, event InputEventImpl
 }
 
 /*
-        Returns [true] if an action has an [InputEvent] associated with it.
-	Args: [{ false action String} { false event InputEvent}], Returns: bool
+	        Returns [true] if an action has an [InputEvent] associated with it.
+		Args: [{ false action String} { false event InputEvent}], Returns: bool
 */
-func (o *inputMap) ActionHasEvent(action gdnative.String, event InputEventImplementer) gdnative.Bool {
+func (o *inputMap) ActionHasEvent(action gdnative.String, event godot.InputEventImplementer) gdnative.Bool {
 	o.ensureSingleton()
 	//log.Println("Calling InputMap.ActionHasEvent()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
-	ptrArguments[1] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "action_has_event")
+	methodBind := methodBindInputMapActionHasEvent.Get()
 
 	// Call the parent method.
 	// bool
@@ -129,8 +136,8 @@ func (o *inputMap) ActionHasEvent(action gdnative.String, event InputEventImplem
 }
 
 /*
-        Adds an (empty) action to the [code]InputMap[/code]. An [InputEvent] can then be added to this action with [method action_add_event].
-	Args: [{ false action String}], Returns: void
+	        Adds an (empty) action to the [code]InputMap[/code]. An [InputEvent] can then be added to this action with [method action_add_event].
+		Args: [{ false action String}], Returns: void
 */
 func (o *inputMap) AddAction(action gdnative.String) {
 	o.ensureSingleton()
@@ -141,7 +148,7 @@ func (o *inputMap) AddAction(action gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "add_action")
+	methodBind := methodBindInputMapAddAction.Get()
 
 	// Call the parent method.
 	// void
@@ -151,8 +158,8 @@ func (o *inputMap) AddAction(action gdnative.String) {
 }
 
 /*
-        Removes an action from the [code]InputMap[/code].
-	Args: [{ false action String}], Returns: void
+	        Removes an action from the [code]InputMap[/code].
+		Args: [{ false action String}], Returns: void
 */
 func (o *inputMap) EraseAction(action gdnative.String) {
 	o.ensureSingleton()
@@ -163,7 +170,7 @@ func (o *inputMap) EraseAction(action gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "erase_action")
+	methodBind := methodBindInputMapEraseAction.Get()
 
 	// Call the parent method.
 	// void
@@ -173,20 +180,20 @@ func (o *inputMap) EraseAction(action gdnative.String) {
 }
 
 /*
-        Returns [true] if the given event is part of an existing action. This method ignores keyboard modifiers if the given [InputEvent] is not pressed (for proper release detection). See [method action_has_event] if you don't want this behavior.
-	Args: [{ false event InputEvent} { false action String}], Returns: bool
+	        Returns [true] if the given event is part of an existing action. This method ignores keyboard modifiers if the given [InputEvent] is not pressed (for proper release detection). See [method action_has_event] if you don't want this behavior.
+		Args: [{ false event InputEvent} { false action String}], Returns: bool
 */
-func (o *inputMap) EventIsAction(event InputEventImplementer, action gdnative.String) gdnative.Bool {
+func (o *inputMap) EventIsAction(event godot.InputEventImplementer, action gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
 	//log.Println("Calling InputMap.EventIsAction()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
 	ptrArguments[1] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "event_is_action")
+	methodBind := methodBindInputMapEventIsAction.Get()
 
 	// Call the parent method.
 	// bool
@@ -199,8 +206,8 @@ func (o *inputMap) EventIsAction(event InputEventImplementer, action gdnative.St
 }
 
 /*
-        Returns an array of [InputEvent]s associated with a given action.
-	Args: [{ false action String}], Returns: Array
+	        Returns an array of [InputEvent]s associated with a given action.
+		Args: [{ false action String}], Returns: Array
 */
 func (o *inputMap) GetActionList(action gdnative.String) gdnative.Array {
 	o.ensureSingleton()
@@ -211,7 +218,7 @@ func (o *inputMap) GetActionList(action gdnative.String) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "get_action_list")
+	methodBind := methodBindInputMapGetActionList.Get()
 
 	// Call the parent method.
 	// Array
@@ -224,8 +231,8 @@ func (o *inputMap) GetActionList(action gdnative.String) gdnative.Array {
 }
 
 /*
-        Returns an array of all actions in the [code]InputMap[/code].
-	Args: [], Returns: Array
+	        Returns an array of all actions in the [code]InputMap[/code].
+		Args: [], Returns: Array
 */
 func (o *inputMap) GetActions() gdnative.Array {
 	o.ensureSingleton()
@@ -235,7 +242,7 @@ func (o *inputMap) GetActions() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "get_actions")
+	methodBind := methodBindInputMapGetActions.Get()
 
 	// Call the parent method.
 	// Array
@@ -248,8 +255,8 @@ func (o *inputMap) GetActions() gdnative.Array {
 }
 
 /*
-        Returns [code]true[/code] if the [code]InputMap[/code] has a registered action with the given name.
-	Args: [{ false action String}], Returns: bool
+	        Returns [code]true[/code] if the [code]InputMap[/code] has a registered action with the given name.
+		Args: [{ false action String}], Returns: bool
 */
 func (o *inputMap) HasAction(action gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -260,7 +267,7 @@ func (o *inputMap) HasAction(action gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(action)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "has_action")
+	methodBind := methodBindInputMapHasAction.Get()
 
 	// Call the parent method.
 	// bool
@@ -273,8 +280,8 @@ func (o *inputMap) HasAction(action gdnative.String) gdnative.Bool {
 }
 
 /*
-        Clears all [InputEventAction] in the [code]InputMap[/code] and load it anew from [ProjectSettings].
-	Args: [], Returns: void
+	        Clears all [InputEventAction] in the [code]InputMap[/code] and load it anew from [ProjectSettings].
+		Args: [], Returns: void
 */
 func (o *inputMap) LoadFromGlobals() {
 	o.ensureSingleton()
@@ -284,7 +291,7 @@ func (o *inputMap) LoadFromGlobals() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InputMap", "load_from_globals")
+	methodBind := methodBindInputMapLoadFromGlobals.Get()
 
 	// Call the parent method.
 	// void
@@ -296,13 +303,13 @@ func (o *inputMap) LoadFromGlobals() {
 // InputMapImplementer is an interface that implements the methods
 // of the InputMap class.
 type InputMapImplementer interface {
-	ObjectImplementer
-	ActionAddEvent(action gdnative.String, event InputEventImplementer)
-	ActionEraseEvent(action gdnative.String, event InputEventImplementer)
-	ActionHasEvent(action gdnative.String, event InputEventImplementer) gdnative.Bool
+	godot.ObjectImplementer
+	ActionAddEvent(action gdnative.String, event godot.InputEventImplementer)
+	ActionEraseEvent(action gdnative.String, event godot.InputEventImplementer)
+	ActionHasEvent(action gdnative.String, event godot.InputEventImplementer) gdnative.Bool
 	AddAction(action gdnative.String)
 	EraseAction(action gdnative.String)
-	EventIsAction(event InputEventImplementer, action gdnative.String) gdnative.Bool
+	EventIsAction(event godot.InputEventImplementer, action gdnative.String) gdnative.Bool
 	GetActionList(action gdnative.String) gdnative.Array
 	GetActions() gdnative.Array
 	HasAction(action gdnative.String) gdnative.Bool
@@ -1,7 +1,8 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +14,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewjavaScriptFromPointer(ptr gdnative.Pointer) javaScript {
-func newJavaScriptFromPointer(ptr gdnative.Pointer) javaScript {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := javaScript{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonJavaScript() *javaScript {
 	return &javaScript{}
 }
 
 /*
-   The JavaScript singleton is implemented only in HTML5 export. It's used to access the browser's JavaScript context. This allows interaction with embedding pages or calling third-party JavaScript APIs.
+The JavaScript singleton is implemented only in HTML5 export. It's used to access the browser's JavaScript context. This allows interaction with embedding pages or calling third-party JavaScript APIs.
 */
 var JavaScript = newSingletonJavaScript()
 
@@ -35,7 +27,7 @@ var JavaScript = newSingletonJavaScript()
 The JavaScript singleton is implemented only in HTML5 export. It's used to access the browser's JavaScript context. This allows interaction with embedding pages or calling third-party JavaScript APIs.
 */
 type javaScript struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,9 +48,15 @@ func (o *javaScript) BaseClass() string {
 	return "JavaScript"
 }
 
+// Method binds of the JavaScript class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindJavaScriptEval = gdnative.NewLazyMethodBind("JavaScript", "eval")
+)
+
 /*
-        Execute the string [code]code[/code] as JavaScript code within the browser window. This is a call to the actual global JavaScript function [code]eval()[/code]. If [code]use_global_execution_context[/code] is [code]true[/code], the code will be evaluated in the global execution context. Otherwise, it is evaluated in the execution context of a function within the engine's runtime environment.
-	Args: [{ false code String} {False true use_global_execution_context bool}], Returns: Variant
+	        Execute the string [code]code[/code] as JavaScript code within the browser window. This is a call to the actual global JavaScript function [code]eval()[/code]. If [code]use_global_execution_context[/code] is [code]true[/code], the code will be evaluated in the global execution context. Otherwise, it is evaluated in the execution context of a function within the engine's runtime environment.
+		Args: [{ false code String} {False true use_global_execution_context bool}], Returns: Variant
 */
 func (o *javaScript) Eval(code gdnative.String, useGlobalExecutionContext gdnative.Bool) gdnative.Variant {
 	o.ensureSingleton()
@@ -70,7 +68,7 @@ func (o *javaScript) Eval(code gdnative.String, useGlobalExecutionContext gdnati
 	ptrArguments[1] = gdnative.NewPointerFromBool(useGlobalExecutionContext)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JavaScript", "eval")
+	methodBind := methodBindJavaScriptEval.Get()
 
 	// Call the parent method.
 	// Variant
@@ -82,9 +80,15 @@ func (o *javaScript) Eval(code gdnative.String, useGlobalExecutionContext gdnati
 	return ret
 }
 
+// EvalWithDefaults will call Eval using the default values for: use_global_execution_context.
+func (o *javaScript) EvalWithDefaults(code gdnative.String) gdnative.Variant {
+	return o.Eval(code, false)
+}
+
 // JavaScriptImplementer is an interface that implements the methods
 // of the JavaScript class.
 type JavaScriptImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	Eval(code gdnative.String, useGlobalExecutionContext gdnative.Bool) gdnative.Variant
+	EvalWithDefaults(code gdnative.String) gdnative.Variant
 }
@@ -1,7 +1,9 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/reference"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +15,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewjsonFromPointer(ptr gdnative.Pointer) json {
-func new_JSONFromPointer(ptr gdnative.Pointer) json {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := json{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonJSON() *json {
 	return &json{}
 }
 
 /*
-   Helper class for parsing JSON data. For usage example and other important hints, see [JSONParseResult].
+Helper class for parsing JSON data. For usage example and other important hints, see [JSONParseResult].
 */
 var JSON = newSingletonJSON()
 
@@ -35,7 +28,7 @@ var JSON = newSingletonJSON()
 Helper class for parsing JSON data. For usage example and other important hints, see [JSONParseResult].
 */
 type json struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,11 +49,18 @@ func (o *json) BaseClass() string {
 	return "_JSON"
 }
 
+// Method binds of the JSON class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindJSONParse = gdnative.NewLazyMethodBind("_JSON", "parse")
+	methodBindJSONPrint = gdnative.NewLazyMethodBind("_JSON", "print")
+)
+
 /*
-        Undocumented
-	Args: [{ false json String}], Returns: JSONParseResult
+	        Undocumented
+		Args: [{ false json String}], Returns: JSONParseResult
 */
-func (o *json) Parse(json gdnative.String) JSONParseResultImplementer {
+func (o *json) Parse(json gdnative.String) reference.JSONParseResultImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling _JSON.Parse()")
 
@@ -69,7 +69,7 @@ func (o *json) Parse(json gdnative.String) JSONParseResultImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromString(json)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_JSON", "parse")
+	methodBind := methodBindJSONParse.Get()
 
 	// Call the parent method.
 	// JSONParseResult
@@ -77,27 +77,25 @@ func (o *json) Parse(json gdnative.String) JSONParseResultImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newJSONParseResultFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(JSONParseResultImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "JSONParseResult" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(JSONParseResultImplementer)
+	ret := reference.JSONParseResult{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(reference.JSONParseResultImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false value Variant} { true indent String} {False true sort_keys bool}], Returns: String
+	        Undocumented
+		Args: [{ false value Variant} { true indent String} {False true sort_keys bool}], Returns: String
 */
 func (o *json) Print(value gdnative.Variant, indent gdnative.String, sortKeys gdnative.Bool) gdnative.String {
 	o.ensureSingleton()
@@ -110,7 +108,7 @@ func (o *json) Print(value gdnative.Variant, indent gdnative.String, sortKeys gd
 	ptrArguments[2] = gdnative.NewPointerFromBool(sortKeys)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_JSON", "print")
+	methodBind := methodBindJSONPrint.Get()
 
 	// Call the parent method.
 	// String
@@ -122,10 +120,16 @@ func (o *json) Print(value gdnative.Variant, indent gdnative.String, sortKeys gd
 	return ret
 }
 
+// PrintWithDefaults will call Print using the default values for: indent, sort_keys.
+func (o *json) PrintWithDefaults(value gdnative.Variant) gdnative.String {
+	return o.Print(value, "", false)
+}
+
 // JSONImplementer is an interface that implements the methods
 // of the JSON class.
 type JSONImplementer interface {
-	ObjectImplementer
-	Parse(json gdnative.String) JSONParseResultImplementer
+	godot.ObjectImplementer
+	Parse(json gdnative.String) reference.JSONParseResultImplementer
 	Print(value gdnative.Variant, indent gdnative.String, sortKeys gdnative.Bool) gdnative.String
+	PrintWithDefaults(value gdnative.Variant) gdnative.String
 }
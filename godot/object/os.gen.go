@@ -1,7 +1,10 @@
-package godot
+package object
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -31,6 +34,68 @@ const (
 	_OSMonthSeptember _OSMonth = 9
 )
 
+// String will return the Godot name of the _OSMonth value.
+func (e _OSMonth) String() string {
+	switch e {
+	case _OSMonthApril:
+		return "MONTH_APRIL"
+	case _OSMonthAugust:
+		return "MONTH_AUGUST"
+	case _OSMonthDecember:
+		return "MONTH_DECEMBER"
+	case _OSMonthFebruary:
+		return "MONTH_FEBRUARY"
+	case _OSMonthJanuary:
+		return "MONTH_JANUARY"
+	case _OSMonthJuly:
+		return "MONTH_JULY"
+	case _OSMonthJune:
+		return "MONTH_JUNE"
+	case _OSMonthMarch:
+		return "MONTH_MARCH"
+	case _OSMonthMay:
+		return "MONTH_MAY"
+	case _OSMonthNovember:
+		return "MONTH_NOVEMBER"
+	case _OSMonthOctober:
+		return "MONTH_OCTOBER"
+	case _OSMonthSeptember:
+		return "MONTH_SEPTEMBER"
+	}
+	return fmt.Sprintf("_OSMonth(%d)", int(e))
+}
+
+// Parse_OSMonth will return the _OSMonth value with the given Godot name.
+func Parse_OSMonth(name string) (_OSMonth, error) {
+	switch name {
+	case "MONTH_APRIL":
+		return _OSMonthApril, nil
+	case "MONTH_AUGUST":
+		return _OSMonthAugust, nil
+	case "MONTH_DECEMBER":
+		return _OSMonthDecember, nil
+	case "MONTH_FEBRUARY":
+		return _OSMonthFebruary, nil
+	case "MONTH_JANUARY":
+		return _OSMonthJanuary, nil
+	case "MONTH_JULY":
+		return _OSMonthJuly, nil
+	case "MONTH_JUNE":
+		return _OSMonthJune, nil
+	case "MONTH_MARCH":
+		return _OSMonthMarch, nil
+	case "MONTH_MAY":
+		return _OSMonthMay, nil
+	case "MONTH_NOVEMBER":
+		return _OSMonthNovember, nil
+	case "MONTH_OCTOBER":
+		return _OSMonthOctober, nil
+	case "MONTH_SEPTEMBER":
+		return _OSMonthSeptember, nil
+	}
+	return 0, fmt.Errorf("invalid _OSMonth value %q", name)
+}
+
 // _OSPowerState is an enum for PowerState values.
 type _OSPowerState int
 
@@ -42,6 +107,40 @@ const (
 	_OSPowerstateUnknown   _OSPowerState = 0
 )
 
+// String will return the Godot name of the _OSPowerState value.
+func (e _OSPowerState) String() string {
+	switch e {
+	case _OSPowerstateCharged:
+		return "POWERSTATE_CHARGED"
+	case _OSPowerstateCharging:
+		return "POWERSTATE_CHARGING"
+	case _OSPowerstateNoBattery:
+		return "POWERSTATE_NO_BATTERY"
+	case _OSPowerstateOnBattery:
+		return "POWERSTATE_ON_BATTERY"
+	case _OSPowerstateUnknown:
+		return "POWERSTATE_UNKNOWN"
+	}
+	return fmt.Sprintf("_OSPowerState(%d)", int(e))
+}
+
+// Parse_OSPowerState will return the _OSPowerState value with the given Godot name.
+func Parse_OSPowerState(name string) (_OSPowerState, error) {
+	switch name {
+	case "POWERSTATE_CHARGED":
+		return _OSPowerstateCharged, nil
+	case "POWERSTATE_CHARGING":
+		return _OSPowerstateCharging, nil
+	case "POWERSTATE_NO_BATTERY":
+		return _OSPowerstateNoBattery, nil
+	case "POWERSTATE_ON_BATTERY":
+		return _OSPowerstateOnBattery, nil
+	case "POWERSTATE_UNKNOWN":
+		return _OSPowerstateUnknown, nil
+	}
+	return 0, fmt.Errorf("invalid _OSPowerState value %q", name)
+}
+
 // _OSScreenOrientation is an enum for ScreenOrientation values.
 type _OSScreenOrientation int
 
@@ -55,6 +154,48 @@ const (
 	_OSScreenOrientationSensorPortrait   _OSScreenOrientation = 5
 )
 
+// String will return the Godot name of the _OSScreenOrientation value.
+func (e _OSScreenOrientation) String() string {
+	switch e {
+	case _OSScreenOrientationLandscape:
+		return "SCREEN_ORIENTATION_LANDSCAPE"
+	case _OSScreenOrientationPortrait:
+		return "SCREEN_ORIENTATION_PORTRAIT"
+	case _OSScreenOrientationReverseLandscape:
+		return "SCREEN_ORIENTATION_REVERSE_LANDSCAPE"
+	case _OSScreenOrientationReversePortrait:
+		return "SCREEN_ORIENTATION_REVERSE_PORTRAIT"
+	case _OSScreenOrientationSensor:
+		return "SCREEN_ORIENTATION_SENSOR"
+	case _OSScreenOrientationSensorLandscape:
+		return "SCREEN_ORIENTATION_SENSOR_LANDSCAPE"
+	case _OSScreenOrientationSensorPortrait:
+		return "SCREEN_ORIENTATION_SENSOR_PORTRAIT"
+	}
+	return fmt.Sprintf("_OSScreenOrientation(%d)", int(e))
+}
+
+// Parse_OSScreenOrientation will return the _OSScreenOrientation value with the given Godot name.
+func Parse_OSScreenOrientation(name string) (_OSScreenOrientation, error) {
+	switch name {
+	case "SCREEN_ORIENTATION_LANDSCAPE":
+		return _OSScreenOrientationLandscape, nil
+	case "SCREEN_ORIENTATION_PORTRAIT":
+		return _OSScreenOrientationPortrait, nil
+	case "SCREEN_ORIENTATION_REVERSE_LANDSCAPE":
+		return _OSScreenOrientationReverseLandscape, nil
+	case "SCREEN_ORIENTATION_REVERSE_PORTRAIT":
+		return _OSScreenOrientationReversePortrait, nil
+	case "SCREEN_ORIENTATION_SENSOR":
+		return _OSScreenOrientationSensor, nil
+	case "SCREEN_ORIENTATION_SENSOR_LANDSCAPE":
+		return _OSScreenOrientationSensorLandscape, nil
+	case "SCREEN_ORIENTATION_SENSOR_PORTRAIT":
+		return _OSScreenOrientationSensorPortrait, nil
+	}
+	return 0, fmt.Errorf("invalid _OSScreenOrientation value %q", name)
+}
+
 // _OSSystemDir is an enum for SystemDir values.
 type _OSSystemDir int
 
@@ -69,6 +210,52 @@ const (
 	_OSSystemDirRingtones _OSSystemDir = 7
 )
 
+// String will return the Godot name of the _OSSystemDir value.
+func (e _OSSystemDir) String() string {
+	switch e {
+	case _OSSystemDirDcim:
+		return "SYSTEM_DIR_DCIM"
+	case _OSSystemDirDesktop:
+		return "SYSTEM_DIR_DESKTOP"
+	case _OSSystemDirDocuments:
+		return "SYSTEM_DIR_DOCUMENTS"
+	case _OSSystemDirDownloads:
+		return "SYSTEM_DIR_DOWNLOADS"
+	case _OSSystemDirMovies:
+		return "SYSTEM_DIR_MOVIES"
+	case _OSSystemDirMusic:
+		return "SYSTEM_DIR_MUSIC"
+	case _OSSystemDirPictures:
+		return "SYSTEM_DIR_PICTURES"
+	case _OSSystemDirRingtones:
+		return "SYSTEM_DIR_RINGTONES"
+	}
+	return fmt.Sprintf("_OSSystemDir(%d)", int(e))
+}
+
+// Parse_OSSystemDir will return the _OSSystemDir value with the given Godot name.
+func Parse_OSSystemDir(name string) (_OSSystemDir, error) {
+	switch name {
+	case "SYSTEM_DIR_DCIM":
+		return _OSSystemDirDcim, nil
+	case "SYSTEM_DIR_DESKTOP":
+		return _OSSystemDirDesktop, nil
+	case "SYSTEM_DIR_DOCUMENTS":
+		return _OSSystemDirDocuments, nil
+	case "SYSTEM_DIR_DOWNLOADS":
+		return _OSSystemDirDownloads, nil
+	case "SYSTEM_DIR_MOVIES":
+		return _OSSystemDirMovies, nil
+	case "SYSTEM_DIR_MUSIC":
+		return _OSSystemDirMusic, nil
+	case "SYSTEM_DIR_PICTURES":
+		return _OSSystemDirPictures, nil
+	case "SYSTEM_DIR_RINGTONES":
+		return _OSSystemDirRingtones, nil
+	}
+	return 0, fmt.Errorf("invalid _OSSystemDir value %q", name)
+}
+
 // _OSWeekday is an enum for Weekday values.
 type _OSWeekday int
 
@@ -82,13 +269,46 @@ const (
 	_OSDayWednesday _OSWeekday = 3
 )
 
-//func NewosFromPointer(ptr gdnative.Pointer) os {
-func new_OSFromPointer(ptr gdnative.Pointer) os {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := os{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the _OSWeekday value.
+func (e _OSWeekday) String() string {
+	switch e {
+	case _OSDayFriday:
+		return "DAY_FRIDAY"
+	case _OSDayMonday:
+		return "DAY_MONDAY"
+	case _OSDaySaturday:
+		return "DAY_SATURDAY"
+	case _OSDaySunday:
+		return "DAY_SUNDAY"
+	case _OSDayThursday:
+		return "DAY_THURSDAY"
+	case _OSDayTuesday:
+		return "DAY_TUESDAY"
+	case _OSDayWednesday:
+		return "DAY_WEDNESDAY"
+	}
+	return fmt.Sprintf("_OSWeekday(%d)", int(e))
+}
+
+// Parse_OSWeekday will return the _OSWeekday value with the given Godot name.
+func Parse_OSWeekday(name string) (_OSWeekday, error) {
+	switch name {
+	case "DAY_FRIDAY":
+		return _OSDayFriday, nil
+	case "DAY_MONDAY":
+		return _OSDayMonday, nil
+	case "DAY_SATURDAY":
+		return _OSDaySaturday, nil
+	case "DAY_SUNDAY":
+		return _OSDaySunday, nil
+	case "DAY_THURSDAY":
+		return _OSDayThursday, nil
+	case "DAY_TUESDAY":
+		return _OSDayTuesday, nil
+	case "DAY_WEDNESDAY":
+		return _OSDayWednesday, nil
+	}
+	return 0, fmt.Errorf("invalid _OSWeekday value %q", name)
 }
 
 func newSingletonOS() *os {
@@ -96,7 +316,7 @@ func newSingletonOS() *os {
 }
 
 /*
-   Operating System functions. OS Wraps the most common functionality to communicate with the host Operating System, such as: mouse grabbing, mouse cursors, clipboard, video mode, date and time, timers, environment variables, execution of binaries, command line, etc.
+Operating System functions. OS Wraps the most common functionality to communicate with the host Operating System, such as: mouse grabbing, mouse cursors, clipboard, video mode, date and time, timers, environment variables, execution of binaries, command line, etc.
 */
 var OS = newSingletonOS()
 
@@ -104,7 +324,7 @@ var OS = newSingletonOS()
 Operating System functions. OS Wraps the most common functionality to communicate with the host Operating System, such as: mouse grabbing, mouse cursors, clipboard, video mode, date and time, timers, environment variables, execution of binaries, command line, etc.
 */
 type os struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -125,9 +345,115 @@ func (o *os) BaseClass() string {
 	return "_OS"
 }
 
+// Method binds of the OS class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindOSAlert                       = gdnative.NewLazyMethodBind("_OS", "alert")
+	methodBindOSCanDraw                     = gdnative.NewLazyMethodBind("_OS", "can_draw")
+	methodBindOSCanUseThreads               = gdnative.NewLazyMethodBind("_OS", "can_use_threads")
+	methodBindOSCenterWindow                = gdnative.NewLazyMethodBind("_OS", "center_window")
+	methodBindOSDelayMsec                   = gdnative.NewLazyMethodBind("_OS", "delay_msec")
+	methodBindOSDelayUsec                   = gdnative.NewLazyMethodBind("_OS", "delay_usec")
+	methodBindOSDumpMemoryToFile            = gdnative.NewLazyMethodBind("_OS", "dump_memory_to_file")
+	methodBindOSDumpResourcesToFile         = gdnative.NewLazyMethodBind("_OS", "dump_resources_to_file")
+	methodBindOSExecute                     = gdnative.NewLazyMethodBind("_OS", "execute")
+	methodBindOSFindScancodeFromString      = gdnative.NewLazyMethodBind("_OS", "find_scancode_from_string")
+	methodBindOSGetBorderlessWindow         = gdnative.NewLazyMethodBind("_OS", "get_borderless_window")
+	methodBindOSGetClipboard                = gdnative.NewLazyMethodBind("_OS", "get_clipboard")
+	methodBindOSGetCmdlineArgs              = gdnative.NewLazyMethodBind("_OS", "get_cmdline_args")
+	methodBindOSGetCurrentScreen            = gdnative.NewLazyMethodBind("_OS", "get_current_screen")
+	methodBindOSGetDate                     = gdnative.NewLazyMethodBind("_OS", "get_date")
+	methodBindOSGetDatetime                 = gdnative.NewLazyMethodBind("_OS", "get_datetime")
+	methodBindOSGetDatetimeFromUnixTime     = gdnative.NewLazyMethodBind("_OS", "get_datetime_from_unix_time")
+	methodBindOSGetDynamicMemoryUsage       = gdnative.NewLazyMethodBind("_OS", "get_dynamic_memory_usage")
+	methodBindOSGetEnvironment              = gdnative.NewLazyMethodBind("_OS", "get_environment")
+	methodBindOSGetExecutablePath           = gdnative.NewLazyMethodBind("_OS", "get_executable_path")
+	methodBindOSGetExitCode                 = gdnative.NewLazyMethodBind("_OS", "get_exit_code")
+	methodBindOSGetLatinKeyboardVariant     = gdnative.NewLazyMethodBind("_OS", "get_latin_keyboard_variant")
+	methodBindOSGetLocale                   = gdnative.NewLazyMethodBind("_OS", "get_locale")
+	methodBindOSGetModelName                = gdnative.NewLazyMethodBind("_OS", "get_model_name")
+	methodBindOSGetName                     = gdnative.NewLazyMethodBind("_OS", "get_name")
+	methodBindOSGetPowerPercentLeft         = gdnative.NewLazyMethodBind("_OS", "get_power_percent_left")
+	methodBindOSGetPowerSecondsLeft         = gdnative.NewLazyMethodBind("_OS", "get_power_seconds_left")
+	methodBindOSGetPowerState               = gdnative.NewLazyMethodBind("_OS", "get_power_state")
+	methodBindOSGetProcessId                = gdnative.NewLazyMethodBind("_OS", "get_process_id")
+	methodBindOSGetProcessorCount           = gdnative.NewLazyMethodBind("_OS", "get_processor_count")
+	methodBindOSGetRealWindowSize           = gdnative.NewLazyMethodBind("_OS", "get_real_window_size")
+	methodBindOSGetScancodeString           = gdnative.NewLazyMethodBind("_OS", "get_scancode_string")
+	methodBindOSGetScreenCount              = gdnative.NewLazyMethodBind("_OS", "get_screen_count")
+	methodBindOSGetScreenDpi                = gdnative.NewLazyMethodBind("_OS", "get_screen_dpi")
+	methodBindOSGetScreenOrientation        = gdnative.NewLazyMethodBind("_OS", "get_screen_orientation")
+	methodBindOSGetScreenPosition           = gdnative.NewLazyMethodBind("_OS", "get_screen_position")
+	methodBindOSGetScreenSize               = gdnative.NewLazyMethodBind("_OS", "get_screen_size")
+	methodBindOSGetSplashTickMsec           = gdnative.NewLazyMethodBind("_OS", "get_splash_tick_msec")
+	methodBindOSGetStaticMemoryPeakUsage    = gdnative.NewLazyMethodBind("_OS", "get_static_memory_peak_usage")
+	methodBindOSGetStaticMemoryUsage        = gdnative.NewLazyMethodBind("_OS", "get_static_memory_usage")
+	methodBindOSGetSystemDir                = gdnative.NewLazyMethodBind("_OS", "get_system_dir")
+	methodBindOSGetSystemTimeSecs           = gdnative.NewLazyMethodBind("_OS", "get_system_time_secs")
+	methodBindOSGetTicksMsec                = gdnative.NewLazyMethodBind("_OS", "get_ticks_msec")
+	methodBindOSGetTime                     = gdnative.NewLazyMethodBind("_OS", "get_time")
+	methodBindOSGetTimeZoneInfo             = gdnative.NewLazyMethodBind("_OS", "get_time_zone_info")
+	methodBindOSGetUniqueId                 = gdnative.NewLazyMethodBind("_OS", "get_unique_id")
+	methodBindOSGetUnixTime                 = gdnative.NewLazyMethodBind("_OS", "get_unix_time")
+	methodBindOSGetUnixTimeFromDatetime     = gdnative.NewLazyMethodBind("_OS", "get_unix_time_from_datetime")
+	methodBindOSGetUserDataDir              = gdnative.NewLazyMethodBind("_OS", "get_user_data_dir")
+	methodBindOSGetVirtualKeyboardHeight    = gdnative.NewLazyMethodBind("_OS", "get_virtual_keyboard_height")
+	methodBindOSGetWindowPosition           = gdnative.NewLazyMethodBind("_OS", "get_window_position")
+	methodBindOSGetWindowSize               = gdnative.NewLazyMethodBind("_OS", "get_window_size")
+	methodBindOSHasEnvironment              = gdnative.NewLazyMethodBind("_OS", "has_environment")
+	methodBindOSHasFeature                  = gdnative.NewLazyMethodBind("_OS", "has_feature")
+	methodBindOSHasTouchscreenUiHint        = gdnative.NewLazyMethodBind("_OS", "has_touchscreen_ui_hint")
+	methodBindOSHasVirtualKeyboard          = gdnative.NewLazyMethodBind("_OS", "has_virtual_keyboard")
+	methodBindOSHideVirtualKeyboard         = gdnative.NewLazyMethodBind("_OS", "hide_virtual_keyboard")
+	methodBindOSIsDebugBuild                = gdnative.NewLazyMethodBind("_OS", "is_debug_build")
+	methodBindOSIsInLowProcessorUsageMode   = gdnative.NewLazyMethodBind("_OS", "is_in_low_processor_usage_mode")
+	methodBindOSIsKeepScreenOn              = gdnative.NewLazyMethodBind("_OS", "is_keep_screen_on")
+	methodBindOSIsOkLeftAndCancelRight      = gdnative.NewLazyMethodBind("_OS", "is_ok_left_and_cancel_right")
+	methodBindOSIsScancodeUnicode           = gdnative.NewLazyMethodBind("_OS", "is_scancode_unicode")
+	methodBindOSIsStdoutVerbose             = gdnative.NewLazyMethodBind("_OS", "is_stdout_verbose")
+	methodBindOSIsUserfsPersistent          = gdnative.NewLazyMethodBind("_OS", "is_userfs_persistent")
+	methodBindOSIsVsyncEnabled              = gdnative.NewLazyMethodBind("_OS", "is_vsync_enabled")
+	methodBindOSIsWindowFullscreen          = gdnative.NewLazyMethodBind("_OS", "is_window_fullscreen")
+	methodBindOSIsWindowMaximized           = gdnative.NewLazyMethodBind("_OS", "is_window_maximized")
+	methodBindOSIsWindowMinimized           = gdnative.NewLazyMethodBind("_OS", "is_window_minimized")
+	methodBindOSIsWindowResizable           = gdnative.NewLazyMethodBind("_OS", "is_window_resizable")
+	methodBindOSKill                        = gdnative.NewLazyMethodBind("_OS", "kill")
+	methodBindOSNativeVideoIsPlaying        = gdnative.NewLazyMethodBind("_OS", "native_video_is_playing")
+	methodBindOSNativeVideoPause            = gdnative.NewLazyMethodBind("_OS", "native_video_pause")
+	methodBindOSNativeVideoPlay             = gdnative.NewLazyMethodBind("_OS", "native_video_play")
+	methodBindOSNativeVideoStop             = gdnative.NewLazyMethodBind("_OS", "native_video_stop")
+	methodBindOSNativeVideoUnpause          = gdnative.NewLazyMethodBind("_OS", "native_video_unpause")
+	methodBindOSPrintAllResources           = gdnative.NewLazyMethodBind("_OS", "print_all_resources")
+	methodBindOSPrintAllTexturesBySize      = gdnative.NewLazyMethodBind("_OS", "print_all_textures_by_size")
+	methodBindOSPrintResourcesByType        = gdnative.NewLazyMethodBind("_OS", "print_resources_by_type")
+	methodBindOSPrintResourcesInUse         = gdnative.NewLazyMethodBind("_OS", "print_resources_in_use")
+	methodBindOSRequestAttention            = gdnative.NewLazyMethodBind("_OS", "request_attention")
+	methodBindOSSetBorderlessWindow         = gdnative.NewLazyMethodBind("_OS", "set_borderless_window")
+	methodBindOSSetClipboard                = gdnative.NewLazyMethodBind("_OS", "set_clipboard")
+	methodBindOSSetCurrentScreen            = gdnative.NewLazyMethodBind("_OS", "set_current_screen")
+	methodBindOSSetExitCode                 = gdnative.NewLazyMethodBind("_OS", "set_exit_code")
+	methodBindOSSetIcon                     = gdnative.NewLazyMethodBind("_OS", "set_icon")
+	methodBindOSSetImePosition              = gdnative.NewLazyMethodBind("_OS", "set_ime_position")
+	methodBindOSSetKeepScreenOn             = gdnative.NewLazyMethodBind("_OS", "set_keep_screen_on")
+	methodBindOSSetLowProcessorUsageMode    = gdnative.NewLazyMethodBind("_OS", "set_low_processor_usage_mode")
+	methodBindOSSetScreenOrientation        = gdnative.NewLazyMethodBind("_OS", "set_screen_orientation")
+	methodBindOSSetThreadName               = gdnative.NewLazyMethodBind("_OS", "set_thread_name")
+	methodBindOSSetUseFileAccessSaveAndSwap = gdnative.NewLazyMethodBind("_OS", "set_use_file_access_save_and_swap")
+	methodBindOSSetUseVsync                 = gdnative.NewLazyMethodBind("_OS", "set_use_vsync")
+	methodBindOSSetWindowFullscreen         = gdnative.NewLazyMethodBind("_OS", "set_window_fullscreen")
+	methodBindOSSetWindowMaximized          = gdnative.NewLazyMethodBind("_OS", "set_window_maximized")
+	methodBindOSSetWindowMinimized          = gdnative.NewLazyMethodBind("_OS", "set_window_minimized")
+	methodBindOSSetWindowPosition           = gdnative.NewLazyMethodBind("_OS", "set_window_position")
+	methodBindOSSetWindowResizable          = gdnative.NewLazyMethodBind("_OS", "set_window_resizable")
+	methodBindOSSetWindowSize               = gdnative.NewLazyMethodBind("_OS", "set_window_size")
+	methodBindOSSetWindowTitle              = gdnative.NewLazyMethodBind("_OS", "set_window_title")
+	methodBindOSShellOpen                   = gdnative.NewLazyMethodBind("_OS", "shell_open")
+	methodBindOSShowVirtualKeyboard         = gdnative.NewLazyMethodBind("_OS", "show_virtual_keyboard")
+)
+
 /*
-        Undocumented
-	Args: [{ false text String} {Alert! true title String}], Returns: void
+	        Undocumented
+		Args: [{ false text String} {Alert! true title String}], Returns: void
 */
 func (o *os) Alert(text gdnative.String, title gdnative.String) {
 	o.ensureSingleton()
@@ -139,7 +465,7 @@ func (o *os) Alert(text gdnative.String, title gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(title)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "alert")
+	methodBind := methodBindOSAlert.Get()
 
 	// Call the parent method.
 	// void
@@ -149,8 +475,8 @@ func (o *os) Alert(text gdnative.String, title gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) CanDraw() gdnative.Bool {
 	o.ensureSingleton()
@@ -160,7 +486,7 @@ func (o *os) CanDraw() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "can_draw")
+	methodBind := methodBindOSCanDraw.Get()
 
 	// Call the parent method.
 	// bool
@@ -173,8 +499,8 @@ func (o *os) CanDraw() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) CanUseThreads() gdnative.Bool {
 	o.ensureSingleton()
@@ -184,7 +510,7 @@ func (o *os) CanUseThreads() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "can_use_threads")
+	methodBind := methodBindOSCanUseThreads.Get()
 
 	// Call the parent method.
 	// bool
@@ -197,8 +523,8 @@ func (o *os) CanUseThreads() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *os) CenterWindow() {
 	o.ensureSingleton()
@@ -208,7 +534,7 @@ func (o *os) CenterWindow() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "center_window")
+	methodBind := methodBindOSCenterWindow.Get()
 
 	// Call the parent method.
 	// void
@@ -218,8 +544,8 @@ func (o *os) CenterWindow() {
 }
 
 /*
-        Undocumented
-	Args: [{ false msec int}], Returns: void
+	        Undocumented
+		Args: [{ false msec int}], Returns: void
 */
 func (o *os) DelayMsec(msec gdnative.Int) {
 	o.ensureSingleton()
@@ -230,7 +556,7 @@ func (o *os) DelayMsec(msec gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(msec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "delay_msec")
+	methodBind := methodBindOSDelayMsec.Get()
 
 	// Call the parent method.
 	// void
@@ -240,8 +566,8 @@ func (o *os) DelayMsec(msec gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false usec int}], Returns: void
+	        Undocumented
+		Args: [{ false usec int}], Returns: void
 */
 func (o *os) DelayUsec(usec gdnative.Int) {
 	o.ensureSingleton()
@@ -252,7 +578,7 @@ func (o *os) DelayUsec(usec gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(usec)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "delay_usec")
+	methodBind := methodBindOSDelayUsec.Get()
 
 	// Call the parent method.
 	// void
@@ -262,8 +588,8 @@ func (o *os) DelayUsec(usec gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false file String}], Returns: void
+	        Undocumented
+		Args: [{ false file String}], Returns: void
 */
 func (o *os) DumpMemoryToFile(file gdnative.String) {
 	o.ensureSingleton()
@@ -274,7 +600,7 @@ func (o *os) DumpMemoryToFile(file gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "dump_memory_to_file")
+	methodBind := methodBindOSDumpMemoryToFile.Get()
 
 	// Call the parent method.
 	// void
@@ -284,8 +610,8 @@ func (o *os) DumpMemoryToFile(file gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false file String}], Returns: void
+	        Undocumented
+		Args: [{ false file String}], Returns: void
 */
 func (o *os) DumpResourcesToFile(file gdnative.String) {
 	o.ensureSingleton()
@@ -296,7 +622,7 @@ func (o *os) DumpResourcesToFile(file gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "dump_resources_to_file")
+	methodBind := methodBindOSDumpResourcesToFile.Get()
 
 	// Call the parent method.
 	// void
@@ -306,8 +632,8 @@ func (o *os) DumpResourcesToFile(file gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { false arguments PoolStringArray} { false blocking bool} {[] true output Array}], Returns: int
+	        Undocumented
+		Args: [{ false path String} { false arguments PoolStringArray} { false blocking bool} {[] true output Array}], Returns: int
 */
 func (o *os) Execute(path gdnative.String, arguments gdnative.PoolStringArray, blocking gdnative.Bool, output gdnative.Array) gdnative.Int {
 	o.ensureSingleton()
@@ -321,7 +647,7 @@ func (o *os) Execute(path gdnative.String, arguments gdnative.PoolStringArray, b
 	ptrArguments[3] = gdnative.NewPointerFromArray(output)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "execute")
+	methodBind := methodBindOSExecute.Get()
 
 	// Call the parent method.
 	// int
@@ -334,8 +660,8 @@ func (o *os) Execute(path gdnative.String, arguments gdnative.PoolStringArray, b
 }
 
 /*
-        Undocumented
-	Args: [{ false string String}], Returns: int
+	        Undocumented
+		Args: [{ false string String}], Returns: int
 */
 func (o *os) FindScancodeFromString(string gdnative.String) gdnative.Int {
 	o.ensureSingleton()
@@ -346,7 +672,7 @@ func (o *os) FindScancodeFromString(string gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(string)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "find_scancode_from_string")
+	methodBind := methodBindOSFindScancodeFromString.Get()
 
 	// Call the parent method.
 	// int
@@ -359,8 +685,8 @@ func (o *os) FindScancodeFromString(string gdnative.String) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) GetBorderlessWindow() gdnative.Bool {
 	o.ensureSingleton()
@@ -370,7 +696,7 @@ func (o *os) GetBorderlessWindow() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_borderless_window")
+	methodBind := methodBindOSGetBorderlessWindow.Get()
 
 	// Call the parent method.
 	// bool
@@ -383,8 +709,8 @@ func (o *os) GetBorderlessWindow() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetClipboard() gdnative.String {
 	o.ensureSingleton()
@@ -394,7 +720,7 @@ func (o *os) GetClipboard() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_clipboard")
+	methodBind := methodBindOSGetClipboard.Get()
 
 	// Call the parent method.
 	// String
@@ -407,8 +733,8 @@ func (o *os) GetClipboard() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolStringArray
+	        Undocumented
+		Args: [], Returns: PoolStringArray
 */
 func (o *os) GetCmdlineArgs() gdnative.PoolStringArray {
 	o.ensureSingleton()
@@ -418,7 +744,7 @@ func (o *os) GetCmdlineArgs() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_cmdline_args")
+	methodBind := methodBindOSGetCmdlineArgs.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -431,8 +757,8 @@ func (o *os) GetCmdlineArgs() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetCurrentScreen() gdnative.Int {
 	o.ensureSingleton()
@@ -442,7 +768,7 @@ func (o *os) GetCurrentScreen() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_current_screen")
+	methodBind := methodBindOSGetCurrentScreen.Get()
 
 	// Call the parent method.
 	// int
@@ -455,8 +781,8 @@ func (o *os) GetCurrentScreen() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{False true utc bool}], Returns: Dictionary
+	        Undocumented
+		Args: [{False true utc bool}], Returns: Dictionary
 */
 func (o *os) GetDate(utc gdnative.Bool) gdnative.Dictionary {
 	o.ensureSingleton()
@@ -467,7 +793,7 @@ func (o *os) GetDate(utc gdnative.Bool) gdnative.Dictionary {
 	ptrArguments[0] = gdnative.NewPointerFromBool(utc)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_date")
+	methodBind := methodBindOSGetDate.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -480,8 +806,8 @@ func (o *os) GetDate(utc gdnative.Bool) gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{False true utc bool}], Returns: Dictionary
+	        Undocumented
+		Args: [{False true utc bool}], Returns: Dictionary
 */
 func (o *os) GetDatetime(utc gdnative.Bool) gdnative.Dictionary {
 	o.ensureSingleton()
@@ -492,7 +818,7 @@ func (o *os) GetDatetime(utc gdnative.Bool) gdnative.Dictionary {
 	ptrArguments[0] = gdnative.NewPointerFromBool(utc)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_datetime")
+	methodBind := methodBindOSGetDatetime.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -505,8 +831,8 @@ func (o *os) GetDatetime(utc gdnative.Bool) gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false unix_time_val int}], Returns: Dictionary
+	        Undocumented
+		Args: [{ false unix_time_val int}], Returns: Dictionary
 */
 func (o *os) GetDatetimeFromUnixTime(unixTimeVal gdnative.Int) gdnative.Dictionary {
 	o.ensureSingleton()
@@ -517,7 +843,7 @@ func (o *os) GetDatetimeFromUnixTime(unixTimeVal gdnative.Int) gdnative.Dictiona
 	ptrArguments[0] = gdnative.NewPointerFromInt(unixTimeVal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_datetime_from_unix_time")
+	methodBind := methodBindOSGetDatetimeFromUnixTime.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -530,8 +856,8 @@ func (o *os) GetDatetimeFromUnixTime(unixTimeVal gdnative.Int) gdnative.Dictiona
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetDynamicMemoryUsage() gdnative.Int {
 	o.ensureSingleton()
@@ -541,7 +867,7 @@ func (o *os) GetDynamicMemoryUsage() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_dynamic_memory_usage")
+	methodBind := methodBindOSGetDynamicMemoryUsage.Get()
 
 	// Call the parent method.
 	// int
@@ -554,8 +880,8 @@ func (o *os) GetDynamicMemoryUsage() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false environment String}], Returns: String
+	        Undocumented
+		Args: [{ false environment String}], Returns: String
 */
 func (o *os) GetEnvironment(environment gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -566,7 +892,7 @@ func (o *os) GetEnvironment(environment gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(environment)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_environment")
+	methodBind := methodBindOSGetEnvironment.Get()
 
 	// Call the parent method.
 	// String
@@ -579,8 +905,8 @@ func (o *os) GetEnvironment(environment gdnative.String) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetExecutablePath() gdnative.String {
 	o.ensureSingleton()
@@ -590,7 +916,7 @@ func (o *os) GetExecutablePath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_executable_path")
+	methodBind := methodBindOSGetExecutablePath.Get()
 
 	// Call the parent method.
 	// String
@@ -603,8 +929,8 @@ func (o *os) GetExecutablePath() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetExitCode() gdnative.Int {
 	o.ensureSingleton()
@@ -614,7 +940,7 @@ func (o *os) GetExitCode() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_exit_code")
+	methodBind := methodBindOSGetExitCode.Get()
 
 	// Call the parent method.
 	// int
@@ -627,8 +953,8 @@ func (o *os) GetExitCode() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetLatinKeyboardVariant() gdnative.String {
 	o.ensureSingleton()
@@ -638,7 +964,7 @@ func (o *os) GetLatinKeyboardVariant() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_latin_keyboard_variant")
+	methodBind := methodBindOSGetLatinKeyboardVariant.Get()
 
 	// Call the parent method.
 	// String
@@ -651,8 +977,8 @@ func (o *os) GetLatinKeyboardVariant() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetLocale() gdnative.String {
 	o.ensureSingleton()
@@ -662,7 +988,7 @@ func (o *os) GetLocale() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_locale")
+	methodBind := methodBindOSGetLocale.Get()
 
 	// Call the parent method.
 	// String
@@ -675,8 +1001,8 @@ func (o *os) GetLocale() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetModelName() gdnative.String {
 	o.ensureSingleton()
@@ -686,7 +1012,7 @@ func (o *os) GetModelName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_model_name")
+	methodBind := methodBindOSGetModelName.Get()
 
 	// Call the parent method.
 	// String
@@ -699,8 +1025,8 @@ func (o *os) GetModelName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetName() gdnative.String {
 	o.ensureSingleton()
@@ -710,7 +1036,7 @@ func (o *os) GetName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_name")
+	methodBind := methodBindOSGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -723,8 +1049,8 @@ func (o *os) GetName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetPowerPercentLeft() gdnative.Int {
 	o.ensureSingleton()
@@ -734,7 +1060,7 @@ func (o *os) GetPowerPercentLeft() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_power_percent_left")
+	methodBind := methodBindOSGetPowerPercentLeft.Get()
 
 	// Call the parent method.
 	// int
@@ -747,8 +1073,8 @@ func (o *os) GetPowerPercentLeft() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetPowerSecondsLeft() gdnative.Int {
 	o.ensureSingleton()
@@ -758,7 +1084,7 @@ func (o *os) GetPowerSecondsLeft() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_power_seconds_left")
+	methodBind := methodBindOSGetPowerSecondsLeft.Get()
 
 	// Call the parent method.
 	// int
@@ -771,8 +1097,8 @@ func (o *os) GetPowerSecondsLeft() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum._OS::PowerState
+	        Undocumented
+		Args: [], Returns: enum._OS::PowerState
 */
 func (o *os) GetPowerState() _OSPowerState {
 	o.ensureSingleton()
@@ -782,7 +1108,7 @@ func (o *os) GetPowerState() _OSPowerState {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_power_state")
+	methodBind := methodBindOSGetPowerState.Get()
 
 	// Call the parent method.
 	// enum._OS::PowerState
@@ -795,8 +1121,8 @@ func (o *os) GetPowerState() _OSPowerState {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetProcessId() gdnative.Int {
 	o.ensureSingleton()
@@ -806,7 +1132,7 @@ func (o *os) GetProcessId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_process_id")
+	methodBind := methodBindOSGetProcessId.Get()
 
 	// Call the parent method.
 	// int
@@ -819,8 +1145,8 @@ func (o *os) GetProcessId() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetProcessorCount() gdnative.Int {
 	o.ensureSingleton()
@@ -830,7 +1156,7 @@ func (o *os) GetProcessorCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_processor_count")
+	methodBind := methodBindOSGetProcessorCount.Get()
 
 	// Call the parent method.
 	// int
@@ -843,8 +1169,8 @@ func (o *os) GetProcessorCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *os) GetRealWindowSize() gdnative.Vector2 {
 	o.ensureSingleton()
@@ -854,7 +1180,7 @@ func (o *os) GetRealWindowSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_real_window_size")
+	methodBind := methodBindOSGetRealWindowSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -867,8 +1193,8 @@ func (o *os) GetRealWindowSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false code int}], Returns: String
+	        Undocumented
+		Args: [{ false code int}], Returns: String
 */
 func (o *os) GetScancodeString(code gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -879,7 +1205,7 @@ func (o *os) GetScancodeString(code gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(code)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_scancode_string")
+	methodBind := methodBindOSGetScancodeString.Get()
 
 	// Call the parent method.
 	// String
@@ -892,8 +1218,8 @@ func (o *os) GetScancodeString(code gdnative.Int) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetScreenCount() gdnative.Int {
 	o.ensureSingleton()
@@ -903,7 +1229,7 @@ func (o *os) GetScreenCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_screen_count")
+	methodBind := methodBindOSGetScreenCount.Get()
 
 	// Call the parent method.
 	// int
@@ -916,8 +1242,8 @@ func (o *os) GetScreenCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{-1 true screen int}], Returns: int
+	        Undocumented
+		Args: [{-1 true screen int}], Returns: int
 */
 func (o *os) GetScreenDpi(screen gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -928,7 +1254,7 @@ func (o *os) GetScreenDpi(screen gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(screen)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_screen_dpi")
+	methodBind := methodBindOSGetScreenDpi.Get()
 
 	// Call the parent method.
 	// int
@@ -941,8 +1267,8 @@ func (o *os) GetScreenDpi(screen gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum._OS::ScreenOrientation
+	        Undocumented
+		Args: [], Returns: enum._OS::ScreenOrientation
 */
 func (o *os) GetScreenOrientation() _OSScreenOrientation {
 	o.ensureSingleton()
@@ -952,7 +1278,7 @@ func (o *os) GetScreenOrientation() _OSScreenOrientation {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_screen_orientation")
+	methodBind := methodBindOSGetScreenOrientation.Get()
 
 	// Call the parent method.
 	// enum._OS::ScreenOrientation
@@ -965,8 +1291,8 @@ func (o *os) GetScreenOrientation() _OSScreenOrientation {
 }
 
 /*
-        Undocumented
-	Args: [{-1 true screen int}], Returns: Vector2
+	        Undocumented
+		Args: [{-1 true screen int}], Returns: Vector2
 */
 func (o *os) GetScreenPosition(screen gdnative.Int) gdnative.Vector2 {
 	o.ensureSingleton()
@@ -977,7 +1303,7 @@ func (o *os) GetScreenPosition(screen gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(screen)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_screen_position")
+	methodBind := methodBindOSGetScreenPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -990,8 +1316,8 @@ func (o *os) GetScreenPosition(screen gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{-1 true screen int}], Returns: Vector2
+	        Undocumented
+		Args: [{-1 true screen int}], Returns: Vector2
 */
 func (o *os) GetScreenSize(screen gdnative.Int) gdnative.Vector2 {
 	o.ensureSingleton()
@@ -1002,7 +1328,7 @@ func (o *os) GetScreenSize(screen gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(screen)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_screen_size")
+	methodBind := methodBindOSGetScreenSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1015,8 +1341,8 @@ func (o *os) GetScreenSize(screen gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetSplashTickMsec() gdnative.Int {
 	o.ensureSingleton()
@@ -1026,7 +1352,7 @@ func (o *os) GetSplashTickMsec() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_splash_tick_msec")
+	methodBind := methodBindOSGetSplashTickMsec.Get()
 
 	// Call the parent method.
 	// int
@@ -1039,8 +1365,8 @@ func (o *os) GetSplashTickMsec() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetStaticMemoryPeakUsage() gdnative.Int {
 	o.ensureSingleton()
@@ -1050,7 +1376,7 @@ func (o *os) GetStaticMemoryPeakUsage() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_static_memory_peak_usage")
+	methodBind := methodBindOSGetStaticMemoryPeakUsage.Get()
 
 	// Call the parent method.
 	// int
@@ -1063,8 +1389,8 @@ func (o *os) GetStaticMemoryPeakUsage() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetStaticMemoryUsage() gdnative.Int {
 	o.ensureSingleton()
@@ -1074,7 +1400,7 @@ func (o *os) GetStaticMemoryUsage() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_static_memory_usage")
+	methodBind := methodBindOSGetStaticMemoryUsage.Get()
 
 	// Call the parent method.
 	// int
@@ -1087,8 +1413,8 @@ func (o *os) GetStaticMemoryUsage() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false dir int}], Returns: String
+	        Undocumented
+		Args: [{ false dir int}], Returns: String
 */
 func (o *os) GetSystemDir(dir gdnative.Int) gdnative.String {
 	o.ensureSingleton()
@@ -1099,7 +1425,7 @@ func (o *os) GetSystemDir(dir gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(dir)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_system_dir")
+	methodBind := methodBindOSGetSystemDir.Get()
 
 	// Call the parent method.
 	// String
@@ -1112,8 +1438,8 @@ func (o *os) GetSystemDir(dir gdnative.Int) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetSystemTimeSecs() gdnative.Int {
 	o.ensureSingleton()
@@ -1123,7 +1449,7 @@ func (o *os) GetSystemTimeSecs() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_system_time_secs")
+	methodBind := methodBindOSGetSystemTimeSecs.Get()
 
 	// Call the parent method.
 	// int
@@ -1136,8 +1462,8 @@ func (o *os) GetSystemTimeSecs() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetTicksMsec() gdnative.Int {
 	o.ensureSingleton()
@@ -1147,7 +1473,7 @@ func (o *os) GetTicksMsec() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_ticks_msec")
+	methodBind := methodBindOSGetTicksMsec.Get()
 
 	// Call the parent method.
 	// int
@@ -1160,8 +1486,8 @@ func (o *os) GetTicksMsec() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{False true utc bool}], Returns: Dictionary
+	        Undocumented
+		Args: [{False true utc bool}], Returns: Dictionary
 */
 func (o *os) GetTime(utc gdnative.Bool) gdnative.Dictionary {
 	o.ensureSingleton()
@@ -1172,7 +1498,7 @@ func (o *os) GetTime(utc gdnative.Bool) gdnative.Dictionary {
 	ptrArguments[0] = gdnative.NewPointerFromBool(utc)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_time")
+	methodBind := methodBindOSGetTime.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -1185,8 +1511,8 @@ func (o *os) GetTime(utc gdnative.Bool) gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *os) GetTimeZoneInfo() gdnative.Dictionary {
 	o.ensureSingleton()
@@ -1196,7 +1522,7 @@ func (o *os) GetTimeZoneInfo() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_time_zone_info")
+	methodBind := methodBindOSGetTimeZoneInfo.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -1209,8 +1535,8 @@ func (o *os) GetTimeZoneInfo() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetUniqueId() gdnative.String {
 	o.ensureSingleton()
@@ -1220,7 +1546,7 @@ func (o *os) GetUniqueId() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_unique_id")
+	methodBind := methodBindOSGetUniqueId.Get()
 
 	// Call the parent method.
 	// String
@@ -1233,8 +1559,8 @@ func (o *os) GetUniqueId() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetUnixTime() gdnative.Int {
 	o.ensureSingleton()
@@ -1244,7 +1570,7 @@ func (o *os) GetUnixTime() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_unix_time")
+	methodBind := methodBindOSGetUnixTime.Get()
 
 	// Call the parent method.
 	// int
@@ -1257,8 +1583,8 @@ func (o *os) GetUnixTime() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false datetime Dictionary}], Returns: int
+	        Undocumented
+		Args: [{ false datetime Dictionary}], Returns: int
 */
 func (o *os) GetUnixTimeFromDatetime(datetime gdnative.Dictionary) gdnative.Int {
 	o.ensureSingleton()
@@ -1269,7 +1595,7 @@ func (o *os) GetUnixTimeFromDatetime(datetime gdnative.Dictionary) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(datetime)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_unix_time_from_datetime")
+	methodBind := methodBindOSGetUnixTimeFromDatetime.Get()
 
 	// Call the parent method.
 	// int
@@ -1282,8 +1608,8 @@ func (o *os) GetUnixTimeFromDatetime(datetime gdnative.Dictionary) gdnative.Int
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *os) GetUserDataDir() gdnative.String {
 	o.ensureSingleton()
@@ -1293,7 +1619,7 @@ func (o *os) GetUserDataDir() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_user_data_dir")
+	methodBind := methodBindOSGetUserDataDir.Get()
 
 	// Call the parent method.
 	// String
@@ -1306,8 +1632,8 @@ func (o *os) GetUserDataDir() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *os) GetVirtualKeyboardHeight() gdnative.Int {
 	o.ensureSingleton()
@@ -1317,7 +1643,7 @@ func (o *os) GetVirtualKeyboardHeight() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_virtual_keyboard_height")
+	methodBind := methodBindOSGetVirtualKeyboardHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -1330,8 +1656,8 @@ func (o *os) GetVirtualKeyboardHeight() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *os) GetWindowPosition() gdnative.Vector2 {
 	o.ensureSingleton()
@@ -1341,7 +1667,7 @@ func (o *os) GetWindowPosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_window_position")
+	methodBind := methodBindOSGetWindowPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1354,8 +1680,8 @@ func (o *os) GetWindowPosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *os) GetWindowSize() gdnative.Vector2 {
 	o.ensureSingleton()
@@ -1365,7 +1691,7 @@ func (o *os) GetWindowSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "get_window_size")
+	methodBind := methodBindOSGetWindowSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -1378,8 +1704,8 @@ func (o *os) GetWindowSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false environment String}], Returns: bool
+	        Undocumented
+		Args: [{ false environment String}], Returns: bool
 */
 func (o *os) HasEnvironment(environment gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -1390,7 +1716,7 @@ func (o *os) HasEnvironment(environment gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(environment)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "has_environment")
+	methodBind := methodBindOSHasEnvironment.Get()
 
 	// Call the parent method.
 	// bool
@@ -1403,8 +1729,8 @@ func (o *os) HasEnvironment(environment gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false tag_name String}], Returns: bool
+	        Undocumented
+		Args: [{ false tag_name String}], Returns: bool
 */
 func (o *os) HasFeature(tagName gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -1415,7 +1741,7 @@ func (o *os) HasFeature(tagName gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(tagName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "has_feature")
+	methodBind := methodBindOSHasFeature.Get()
 
 	// Call the parent method.
 	// bool
@@ -1428,8 +1754,8 @@ func (o *os) HasFeature(tagName gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) HasTouchscreenUiHint() gdnative.Bool {
 	o.ensureSingleton()
@@ -1439,7 +1765,7 @@ func (o *os) HasTouchscreenUiHint() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "has_touchscreen_ui_hint")
+	methodBind := methodBindOSHasTouchscreenUiHint.Get()
 
 	// Call the parent method.
 	// bool
@@ -1452,8 +1778,8 @@ func (o *os) HasTouchscreenUiHint() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) HasVirtualKeyboard() gdnative.Bool {
 	o.ensureSingleton()
@@ -1463,7 +1789,7 @@ func (o *os) HasVirtualKeyboard() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "has_virtual_keyboard")
+	methodBind := methodBindOSHasVirtualKeyboard.Get()
 
 	// Call the parent method.
 	// bool
@@ -1476,8 +1802,8 @@ func (o *os) HasVirtualKeyboard() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *os) HideVirtualKeyboard() {
 	o.ensureSingleton()
@@ -1487,7 +1813,7 @@ func (o *os) HideVirtualKeyboard() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "hide_virtual_keyboard")
+	methodBind := methodBindOSHideVirtualKeyboard.Get()
 
 	// Call the parent method.
 	// void
@@ -1497,8 +1823,8 @@ func (o *os) HideVirtualKeyboard() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsDebugBuild() gdnative.Bool {
 	o.ensureSingleton()
@@ -1508,7 +1834,7 @@ func (o *os) IsDebugBuild() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_debug_build")
+	methodBind := methodBindOSIsDebugBuild.Get()
 
 	// Call the parent method.
 	// bool
@@ -1521,8 +1847,8 @@ func (o *os) IsDebugBuild() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsInLowProcessorUsageMode() gdnative.Bool {
 	o.ensureSingleton()
@@ -1532,7 +1858,7 @@ func (o *os) IsInLowProcessorUsageMode() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_in_low_processor_usage_mode")
+	methodBind := methodBindOSIsInLowProcessorUsageMode.Get()
 
 	// Call the parent method.
 	// bool
@@ -1545,8 +1871,8 @@ func (o *os) IsInLowProcessorUsageMode() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsKeepScreenOn() gdnative.Bool {
 	o.ensureSingleton()
@@ -1556,7 +1882,7 @@ func (o *os) IsKeepScreenOn() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_keep_screen_on")
+	methodBind := methodBindOSIsKeepScreenOn.Get()
 
 	// Call the parent method.
 	// bool
@@ -1569,8 +1895,8 @@ func (o *os) IsKeepScreenOn() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsOkLeftAndCancelRight() gdnative.Bool {
 	o.ensureSingleton()
@@ -1580,7 +1906,7 @@ func (o *os) IsOkLeftAndCancelRight() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_ok_left_and_cancel_right")
+	methodBind := methodBindOSIsOkLeftAndCancelRight.Get()
 
 	// Call the parent method.
 	// bool
@@ -1593,8 +1919,8 @@ func (o *os) IsOkLeftAndCancelRight() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false code int}], Returns: bool
+	        Undocumented
+		Args: [{ false code int}], Returns: bool
 */
 func (o *os) IsScancodeUnicode(code gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -1605,7 +1931,7 @@ func (o *os) IsScancodeUnicode(code gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(code)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_scancode_unicode")
+	methodBind := methodBindOSIsScancodeUnicode.Get()
 
 	// Call the parent method.
 	// bool
@@ -1618,8 +1944,8 @@ func (o *os) IsScancodeUnicode(code gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsStdoutVerbose() gdnative.Bool {
 	o.ensureSingleton()
@@ -1629,7 +1955,7 @@ func (o *os) IsStdoutVerbose() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_stdout_verbose")
+	methodBind := methodBindOSIsStdoutVerbose.Get()
 
 	// Call the parent method.
 	// bool
@@ -1642,8 +1968,8 @@ func (o *os) IsStdoutVerbose() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsUserfsPersistent() gdnative.Bool {
 	o.ensureSingleton()
@@ -1653,7 +1979,7 @@ func (o *os) IsUserfsPersistent() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_userfs_persistent")
+	methodBind := methodBindOSIsUserfsPersistent.Get()
 
 	// Call the parent method.
 	// bool
@@ -1666,8 +1992,8 @@ func (o *os) IsUserfsPersistent() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsVsyncEnabled() gdnative.Bool {
 	o.ensureSingleton()
@@ -1677,7 +2003,7 @@ func (o *os) IsVsyncEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_vsync_enabled")
+	methodBind := methodBindOSIsVsyncEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1690,8 +2016,8 @@ func (o *os) IsVsyncEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsWindowFullscreen() gdnative.Bool {
 	o.ensureSingleton()
@@ -1701,7 +2027,7 @@ func (o *os) IsWindowFullscreen() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_window_fullscreen")
+	methodBind := methodBindOSIsWindowFullscreen.Get()
 
 	// Call the parent method.
 	// bool
@@ -1714,8 +2040,8 @@ func (o *os) IsWindowFullscreen() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsWindowMaximized() gdnative.Bool {
 	o.ensureSingleton()
@@ -1725,7 +2051,7 @@ func (o *os) IsWindowMaximized() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_window_maximized")
+	methodBind := methodBindOSIsWindowMaximized.Get()
 
 	// Call the parent method.
 	// bool
@@ -1738,8 +2064,8 @@ func (o *os) IsWindowMaximized() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsWindowMinimized() gdnative.Bool {
 	o.ensureSingleton()
@@ -1749,7 +2075,7 @@ func (o *os) IsWindowMinimized() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_window_minimized")
+	methodBind := methodBindOSIsWindowMinimized.Get()
 
 	// Call the parent method.
 	// bool
@@ -1762,8 +2088,8 @@ func (o *os) IsWindowMinimized() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) IsWindowResizable() gdnative.Bool {
 	o.ensureSingleton()
@@ -1773,7 +2099,7 @@ func (o *os) IsWindowResizable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "is_window_resizable")
+	methodBind := methodBindOSIsWindowResizable.Get()
 
 	// Call the parent method.
 	// bool
@@ -1786,8 +2112,8 @@ func (o *os) IsWindowResizable() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false pid int}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false pid int}], Returns: enum.Error
 */
 func (o *os) Kill(pid gdnative.Int) gdnative.Error {
 	o.ensureSingleton()
@@ -1798,7 +2124,7 @@ func (o *os) Kill(pid gdnative.Int) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromInt(pid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "kill")
+	methodBind := methodBindOSKill.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -1811,8 +2137,8 @@ func (o *os) Kill(pid gdnative.Int) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *os) NativeVideoIsPlaying() gdnative.Bool {
 	o.ensureSingleton()
@@ -1822,7 +2148,7 @@ func (o *os) NativeVideoIsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "native_video_is_playing")
+	methodBind := methodBindOSNativeVideoIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -1835,8 +2161,8 @@ func (o *os) NativeVideoIsPlaying() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *os) NativeVideoPause() {
 	o.ensureSingleton()
@@ -1846,7 +2172,7 @@ func (o *os) NativeVideoPause() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "native_video_pause")
+	methodBind := methodBindOSNativeVideoPause.Get()
 
 	// Call the parent method.
 	// void
@@ -1856,8 +2182,8 @@ func (o *os) NativeVideoPause() {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { false volume float} { false audio_track String} { false subtitle_track String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String} { false volume float} { false audio_track String} { false subtitle_track String}], Returns: enum.Error
 */
 func (o *os) NativeVideoPlay(path gdnative.String, volume gdnative.Real, audioTrack gdnative.String, subtitleTrack gdnative.String) gdnative.Error {
 	o.ensureSingleton()
@@ -1871,7 +2197,7 @@ func (o *os) NativeVideoPlay(path gdnative.String, volume gdnative.Real, audioTr
 	ptrArguments[3] = gdnative.NewPointerFromString(subtitleTrack)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "native_video_play")
+	methodBind := methodBindOSNativeVideoPlay.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -1884,8 +2210,8 @@ func (o *os) NativeVideoPlay(path gdnative.String, volume gdnative.Real, audioTr
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *os) NativeVideoStop() {
 	o.ensureSingleton()
@@ -1895,7 +2221,7 @@ func (o *os) NativeVideoStop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "native_video_stop")
+	methodBind := methodBindOSNativeVideoStop.Get()
 
 	// Call the parent method.
 	// void
@@ -1905,8 +2231,8 @@ func (o *os) NativeVideoStop() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *os) NativeVideoUnpause() {
 	o.ensureSingleton()
@@ -1916,7 +2242,7 @@ func (o *os) NativeVideoUnpause() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "native_video_unpause")
+	methodBind := methodBindOSNativeVideoUnpause.Get()
 
 	// Call the parent method.
 	// void
@@ -1926,8 +2252,8 @@ func (o *os) NativeVideoUnpause() {
 }
 
 /*
-        Undocumented
-	Args: [{ true tofile String}], Returns: void
+	        Undocumented
+		Args: [{ true tofile String}], Returns: void
 */
 func (o *os) PrintAllResources(tofile gdnative.String) {
 	o.ensureSingleton()
@@ -1938,7 +2264,7 @@ func (o *os) PrintAllResources(tofile gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(tofile)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "print_all_resources")
+	methodBind := methodBindOSPrintAllResources.Get()
 
 	// Call the parent method.
 	// void
@@ -1948,8 +2274,8 @@ func (o *os) PrintAllResources(tofile gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *os) PrintAllTexturesBySize() {
 	o.ensureSingleton()
@@ -1959,7 +2285,7 @@ func (o *os) PrintAllTexturesBySize() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "print_all_textures_by_size")
+	methodBind := methodBindOSPrintAllTexturesBySize.Get()
 
 	// Call the parent method.
 	// void
@@ -1969,8 +2295,8 @@ func (o *os) PrintAllTexturesBySize() {
 }
 
 /*
-        Undocumented
-	Args: [{ false types PoolStringArray}], Returns: void
+	        Undocumented
+		Args: [{ false types PoolStringArray}], Returns: void
 */
 func (o *os) PrintResourcesByType(types gdnative.PoolStringArray) {
 	o.ensureSingleton()
@@ -1981,7 +2307,7 @@ func (o *os) PrintResourcesByType(types gdnative.PoolStringArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolStringArray(types)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "print_resources_by_type")
+	methodBind := methodBindOSPrintResourcesByType.Get()
 
 	// Call the parent method.
 	// void
@@ -1991,8 +2317,8 @@ func (o *os) PrintResourcesByType(types gdnative.PoolStringArray) {
 }
 
 /*
-        Undocumented
-	Args: [{False true short bool}], Returns: void
+	        Undocumented
+		Args: [{False true short bool}], Returns: void
 */
 func (o *os) PrintResourcesInUse(short gdnative.Bool) {
 	o.ensureSingleton()
@@ -2003,7 +2329,7 @@ func (o *os) PrintResourcesInUse(short gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(short)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "print_resources_in_use")
+	methodBind := methodBindOSPrintResourcesInUse.Get()
 
 	// Call the parent method.
 	// void
@@ -2013,8 +2339,8 @@ func (o *os) PrintResourcesInUse(short gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *os) RequestAttention() {
 	o.ensureSingleton()
@@ -2024,7 +2350,7 @@ func (o *os) RequestAttention() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "request_attention")
+	methodBind := methodBindOSRequestAttention.Get()
 
 	// Call the parent method.
 	// void
@@ -2034,8 +2360,8 @@ func (o *os) RequestAttention() {
 }
 
 /*
-        Undocumented
-	Args: [{ false borderless bool}], Returns: void
+	        Undocumented
+		Args: [{ false borderless bool}], Returns: void
 */
 func (o *os) SetBorderlessWindow(borderless gdnative.Bool) {
 	o.ensureSingleton()
@@ -2046,7 +2372,7 @@ func (o *os) SetBorderlessWindow(borderless gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(borderless)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_borderless_window")
+	methodBind := methodBindOSSetBorderlessWindow.Get()
 
 	// Call the parent method.
 	// void
@@ -2056,8 +2382,8 @@ func (o *os) SetBorderlessWindow(borderless gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false clipboard String}], Returns: void
+	        Undocumented
+		Args: [{ false clipboard String}], Returns: void
 */
 func (o *os) SetClipboard(clipboard gdnative.String) {
 	o.ensureSingleton()
@@ -2068,7 +2394,7 @@ func (o *os) SetClipboard(clipboard gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(clipboard)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_clipboard")
+	methodBind := methodBindOSSetClipboard.Get()
 
 	// Call the parent method.
 	// void
@@ -2078,8 +2404,8 @@ func (o *os) SetClipboard(clipboard gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false screen int}], Returns: void
+	        Undocumented
+		Args: [{ false screen int}], Returns: void
 */
 func (o *os) SetCurrentScreen(screen gdnative.Int) {
 	o.ensureSingleton()
@@ -2090,7 +2416,7 @@ func (o *os) SetCurrentScreen(screen gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(screen)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_current_screen")
+	methodBind := methodBindOSSetCurrentScreen.Get()
 
 	// Call the parent method.
 	// void
@@ -2100,8 +2426,8 @@ func (o *os) SetCurrentScreen(screen gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false code int}], Returns: void
+	        Undocumented
+		Args: [{ false code int}], Returns: void
 */
 func (o *os) SetExitCode(code gdnative.Int) {
 	o.ensureSingleton()
@@ -2112,7 +2438,7 @@ func (o *os) SetExitCode(code gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(code)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_exit_code")
+	methodBind := methodBindOSSetExitCode.Get()
 
 	// Call the parent method.
 	// void
@@ -2122,19 +2448,19 @@ func (o *os) SetExitCode(code gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false icon Image}], Returns: void
+	        Undocumented
+		Args: [{ false icon Image}], Returns: void
 */
-func (o *os) SetIcon(icon ImageImplementer) {
+func (o *os) SetIcon(icon godot.ImageImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling _OS.SetIcon()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(icon.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_icon")
+	methodBind := methodBindOSSetIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -2144,8 +2470,8 @@ func (o *os) SetIcon(icon ImageImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *os) SetImePosition(position gdnative.Vector2) {
 	o.ensureSingleton()
@@ -2156,7 +2482,7 @@ func (o *os) SetImePosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_ime_position")
+	methodBind := methodBindOSSetImePosition.Get()
 
 	// Call the parent method.
 	// void
@@ -2166,8 +2492,8 @@ func (o *os) SetImePosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *os) SetKeepScreenOn(enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2178,7 +2504,7 @@ func (o *os) SetKeepScreenOn(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_keep_screen_on")
+	methodBind := methodBindOSSetKeepScreenOn.Get()
 
 	// Call the parent method.
 	// void
@@ -2188,8 +2514,8 @@ func (o *os) SetKeepScreenOn(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *os) SetLowProcessorUsageMode(enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -2200,7 +2526,7 @@ func (o *os) SetLowProcessorUsageMode(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_low_processor_usage_mode")
+	methodBind := methodBindOSSetLowProcessorUsageMode.Get()
 
 	// Call the parent method.
 	// void
@@ -2210,19 +2536,19 @@ func (o *os) SetLowProcessorUsageMode(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false orientation int}], Returns: void
+	        Undocumented
+		Args: [{ false orientation enum._OS::ScreenOrientation}], Returns: void
 */
-func (o *os) SetScreenOrientation(orientation gdnative.Int) {
+func (o *os) SetScreenOrientation(orientation _OSScreenOrientation) {
 	o.ensureSingleton()
 	//log.Println("Calling _OS.SetScreenOrientation()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(orientation)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(orientation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_screen_orientation")
+	methodBind := methodBindOSSetScreenOrientation.Get()
 
 	// Call the parent method.
 	// void
@@ -2232,8 +2558,8 @@ func (o *os) SetScreenOrientation(orientation gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false name String}], Returns: enum.Error
 */
 func (o *os) SetThreadName(name gdnative.String) gdnative.Error {
 	o.ensureSingleton()
@@ -2244,7 +2570,7 @@ func (o *os) SetThreadName(name gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_thread_name")
+	methodBind := methodBindOSSetThreadName.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -2257,8 +2583,8 @@ func (o *os) SetThreadName(name gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *os) SetUseFileAccessSaveAndSwap(enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2269,7 +2595,7 @@ func (o *os) SetUseFileAccessSaveAndSwap(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_use_file_access_save_and_swap")
+	methodBind := methodBindOSSetUseFileAccessSaveAndSwap.Get()
 
 	// Call the parent method.
 	// void
@@ -2279,8 +2605,8 @@ func (o *os) SetUseFileAccessSaveAndSwap(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *os) SetUseVsync(enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -2291,7 +2617,7 @@ func (o *os) SetUseVsync(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_use_vsync")
+	methodBind := methodBindOSSetUseVsync.Get()
 
 	// Call the parent method.
 	// void
@@ -2301,8 +2627,8 @@ func (o *os) SetUseVsync(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *os) SetWindowFullscreen(enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2313,7 +2639,7 @@ func (o *os) SetWindowFullscreen(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_window_fullscreen")
+	methodBind := methodBindOSSetWindowFullscreen.Get()
 
 	// Call the parent method.
 	// void
@@ -2323,8 +2649,8 @@ func (o *os) SetWindowFullscreen(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *os) SetWindowMaximized(enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2335,7 +2661,7 @@ func (o *os) SetWindowMaximized(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_window_maximized")
+	methodBind := methodBindOSSetWindowMaximized.Get()
 
 	// Call the parent method.
 	// void
@@ -2345,8 +2671,8 @@ func (o *os) SetWindowMaximized(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *os) SetWindowMinimized(enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2357,7 +2683,7 @@ func (o *os) SetWindowMinimized(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_window_minimized")
+	methodBind := methodBindOSSetWindowMinimized.Get()
 
 	// Call the parent method.
 	// void
@@ -2367,8 +2693,8 @@ func (o *os) SetWindowMinimized(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false position Vector2}], Returns: void
 */
 func (o *os) SetWindowPosition(position gdnative.Vector2) {
 	o.ensureSingleton()
@@ -2379,7 +2705,7 @@ func (o *os) SetWindowPosition(position gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_window_position")
+	methodBind := methodBindOSSetWindowPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -2389,8 +2715,8 @@ func (o *os) SetWindowPosition(position gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *os) SetWindowResizable(enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2401,7 +2727,7 @@ func (o *os) SetWindowResizable(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_window_resizable")
+	methodBind := methodBindOSSetWindowResizable.Get()
 
 	// Call the parent method.
 	// void
@@ -2411,8 +2737,8 @@ func (o *os) SetWindowResizable(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *os) SetWindowSize(size gdnative.Vector2) {
 	o.ensureSingleton()
@@ -2423,7 +2749,7 @@ func (o *os) SetWindowSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_window_size")
+	methodBind := methodBindOSSetWindowSize.Get()
 
 	// Call the parent method.
 	// void
@@ -2433,8 +2759,8 @@ func (o *os) SetWindowSize(size gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false title String}], Returns: void
+	        Undocumented
+		Args: [{ false title String}], Returns: void
 */
 func (o *os) SetWindowTitle(title gdnative.String) {
 	o.ensureSingleton()
@@ -2445,7 +2771,7 @@ func (o *os) SetWindowTitle(title gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(title)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "set_window_title")
+	methodBind := methodBindOSSetWindowTitle.Get()
 
 	// Call the parent method.
 	// void
@@ -2455,8 +2781,8 @@ func (o *os) SetWindowTitle(title gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false uri String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false uri String}], Returns: enum.Error
 */
 func (o *os) ShellOpen(uri gdnative.String) gdnative.Error {
 	o.ensureSingleton()
@@ -2467,7 +2793,7 @@ func (o *os) ShellOpen(uri gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(uri)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "shell_open")
+	methodBind := methodBindOSShellOpen.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -2480,8 +2806,8 @@ func (o *os) ShellOpen(uri gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ true existing_text String}], Returns: void
+	        Undocumented
+		Args: [{ true existing_text String}], Returns: void
 */
 func (o *os) ShowVirtualKeyboard(existingText gdnative.String) {
 	o.ensureSingleton()
@@ -2492,7 +2818,7 @@ func (o *os) ShowVirtualKeyboard(existingText gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(existingText)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_OS", "show_virtual_keyboard")
+	methodBind := methodBindOSShowVirtualKeyboard.Get()
 
 	// Call the parent method.
 	// void
@@ -2501,10 +2827,147 @@ func (o *os) ShowVirtualKeyboard(existingText gdnative.String) {
 
 }
 
+// AlertWithDefaults will call Alert using the default values for: title.
+func (o *os) AlertWithDefaults(text gdnative.String) {
+	o.Alert(text, "Alert!")
+}
+
+// ExecuteWithDefaults will call Execute using the default values for: output.
+func (o *os) ExecuteWithDefaults(path gdnative.String, arguments gdnative.PoolStringArray, blocking gdnative.Bool) gdnative.Int {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	return o.Execute(path, arguments, blocking, default0)
+}
+
+// GetDateWithDefaults will call GetDate using the default values for: utc.
+func (o *os) GetDateWithDefaults() gdnative.Dictionary {
+	return o.GetDate(false)
+}
+
+// GetDatetimeWithDefaults will call GetDatetime using the default values for: utc.
+func (o *os) GetDatetimeWithDefaults() gdnative.Dictionary {
+	return o.GetDatetime(false)
+}
+
+// GetScreenDpiWithDefaults will call GetScreenDpi using the default values for: screen.
+func (o *os) GetScreenDpiWithDefaults() gdnative.Int {
+	return o.GetScreenDpi(-1)
+}
+
+// GetScreenPositionWithDefaults will call GetScreenPosition using the default values for: screen.
+func (o *os) GetScreenPositionWithDefaults() gdnative.Vector2 {
+	return o.GetScreenPosition(-1)
+}
+
+// GetScreenSizeWithDefaults will call GetScreenSize using the default values for: screen.
+func (o *os) GetScreenSizeWithDefaults() gdnative.Vector2 {
+	return o.GetScreenSize(-1)
+}
+
+// GetTimeWithDefaults will call GetTime using the default values for: utc.
+func (o *os) GetTimeWithDefaults() gdnative.Dictionary {
+	return o.GetTime(false)
+}
+
+// PrintAllResourcesWithDefaults will call PrintAllResources using the default values for: tofile.
+func (o *os) PrintAllResourcesWithDefaults() {
+	o.PrintAllResources("")
+}
+
+// PrintResourcesInUseWithDefaults will call PrintResourcesInUse using the default values for: short.
+func (o *os) PrintResourcesInUseWithDefaults() {
+	o.PrintResourcesInUse(false)
+}
+
+// ShowVirtualKeyboardWithDefaults will call ShowVirtualKeyboard using the default values for: existing_text.
+func (o *os) ShowVirtualKeyboardWithDefaults() {
+	o.ShowVirtualKeyboard("")
+}
+
+// Clipboard will return the value of the "clipboard" property.
+func (o *os) Clipboard() gdnative.String {
+	return o.GetClipboard()
+}
+
+// CurrentScreen will return the value of the "current_screen" property.
+func (o *os) CurrentScreen() gdnative.Int {
+	return o.GetCurrentScreen()
+}
+
+// ExitCode will return the value of the "exit_code" property.
+func (o *os) ExitCode() gdnative.Int {
+	return o.GetExitCode()
+}
+
+// KeepScreenOn will return the value of the "keep_screen_on" property.
+func (o *os) KeepScreenOn() gdnative.Bool {
+	return o.IsKeepScreenOn()
+}
+
+// LowProcessorUsageMode will return the value of the "low_processor_usage_mode" property.
+func (o *os) LowProcessorUsageMode() gdnative.Bool {
+	return o.IsInLowProcessorUsageMode()
+}
+
+// ScreenOrientation will return the value of the "screen_orientation" property.
+func (o *os) ScreenOrientation() _OSScreenOrientation {
+	return o.GetScreenOrientation()
+}
+
+// VsyncEnabled will return the value of the "vsync_enabled" property.
+func (o *os) VsyncEnabled() gdnative.Bool {
+	return o.IsVsyncEnabled()
+}
+
+// SetVsyncEnabled will set the value of the "vsync_enabled" property.
+func (o *os) SetVsyncEnabled(value gdnative.Bool) {
+	o.SetUseVsync(value)
+}
+
+// WindowBorderless will return the value of the "window_borderless" property.
+func (o *os) WindowBorderless() gdnative.Bool {
+	return o.GetBorderlessWindow()
+}
+
+// SetWindowBorderless will set the value of the "window_borderless" property.
+func (o *os) SetWindowBorderless(value gdnative.Bool) {
+	o.SetBorderlessWindow(value)
+}
+
+// WindowFullscreen will return the value of the "window_fullscreen" property.
+func (o *os) WindowFullscreen() gdnative.Bool {
+	return o.IsWindowFullscreen()
+}
+
+// WindowMaximized will return the value of the "window_maximized" property.
+func (o *os) WindowMaximized() gdnative.Bool {
+	return o.IsWindowMaximized()
+}
+
+// WindowMinimized will return the value of the "window_minimized" property.
+func (o *os) WindowMinimized() gdnative.Bool {
+	return o.IsWindowMinimized()
+}
+
+// WindowPosition will return the value of the "window_position" property.
+func (o *os) WindowPosition() gdnative.Vector2 {
+	return o.GetWindowPosition()
+}
+
+// WindowResizable will return the value of the "window_resizable" property.
+func (o *os) WindowResizable() gdnative.Bool {
+	return o.IsWindowResizable()
+}
+
+// WindowSize will return the value of the "window_size" property.
+func (o *os) WindowSize() gdnative.Vector2 {
+	return o.GetWindowSize()
+}
+
 // OSImplementer is an interface that implements the methods
 // of the OS class.
 type OSImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	Alert(text gdnative.String, title gdnative.String)
 	CanDraw() gdnative.Bool
 	CanUseThreads() gdnative.Bool
@@ -2532,12 +2995,14 @@ type OSImplementer interface {
 	GetName() gdnative.String
 	GetPowerPercentLeft() gdnative.Int
 	GetPowerSecondsLeft() gdnative.Int
+	GetPowerState() _OSPowerState
 	GetProcessId() gdnative.Int
 	GetProcessorCount() gdnative.Int
 	GetRealWindowSize() gdnative.Vector2
 	GetScancodeString(code gdnative.Int) gdnative.String
 	GetScreenCount() gdnative.Int
 	GetScreenDpi(screen gdnative.Int) gdnative.Int
+	GetScreenOrientation() _OSScreenOrientation
 	GetScreenPosition(screen gdnative.Int) gdnative.Vector2
 	GetScreenSize(screen gdnative.Int) gdnative.Vector2
 	GetSplashTickMsec() gdnative.Int
@@ -2572,8 +3037,10 @@ type OSImplementer interface {
 	IsWindowMaximized() gdnative.Bool
 	IsWindowMinimized() gdnative.Bool
 	IsWindowResizable() gdnative.Bool
+	Kill(pid gdnative.Int) gdnative.Error
 	NativeVideoIsPlaying() gdnative.Bool
 	NativeVideoPause()
+	NativeVideoPlay(path gdnative.String, volume gdnative.Real, audioTrack gdnative.String, subtitleTrack gdnative.String) gdnative.Error
 	NativeVideoStop()
 	NativeVideoUnpause()
 	PrintAllResources(tofile gdnative.String)
@@ -2585,11 +3052,12 @@ type OSImplementer interface {
 	SetClipboard(clipboard gdnative.String)
 	SetCurrentScreen(screen gdnative.Int)
 	SetExitCode(code gdnative.Int)
-	SetIcon(icon ImageImplementer)
+	SetIcon(icon godot.ImageImplementer)
 	SetImePosition(position gdnative.Vector2)
 	SetKeepScreenOn(enabled gdnative.Bool)
 	SetLowProcessorUsageMode(enable gdnative.Bool)
-	SetScreenOrientation(orientation gdnative.Int)
+	SetScreenOrientation(orientation _OSScreenOrientation)
+	SetThreadName(name gdnative.String) gdnative.Error
 	SetUseFileAccessSaveAndSwap(enabled gdnative.Bool)
 	SetUseVsync(enable gdnative.Bool)
 	SetWindowFullscreen(enabled gdnative.Bool)
@@ -2599,5 +3067,33 @@ type OSImplementer interface {
 	SetWindowResizable(enabled gdnative.Bool)
 	SetWindowSize(size gdnative.Vector2)
 	SetWindowTitle(title gdnative.String)
+	ShellOpen(uri gdnative.String) gdnative.Error
 	ShowVirtualKeyboard(existingText gdnative.String)
+	AlertWithDefaults(text gdnative.String)
+	ExecuteWithDefaults(path gdnative.String, arguments gdnative.PoolStringArray, blocking gdnative.Bool) gdnative.Int
+	GetDateWithDefaults() gdnative.Dictionary
+	GetDatetimeWithDefaults() gdnative.Dictionary
+	GetScreenDpiWithDefaults() gdnative.Int
+	GetScreenPositionWithDefaults() gdnative.Vector2
+	GetScreenSizeWithDefaults() gdnative.Vector2
+	GetTimeWithDefaults() gdnative.Dictionary
+	PrintAllResourcesWithDefaults()
+	PrintResourcesInUseWithDefaults()
+	ShowVirtualKeyboardWithDefaults()
+	Clipboard() gdnative.String
+	CurrentScreen() gdnative.Int
+	ExitCode() gdnative.Int
+	KeepScreenOn() gdnative.Bool
+	LowProcessorUsageMode() gdnative.Bool
+	ScreenOrientation() _OSScreenOrientation
+	VsyncEnabled() gdnative.Bool
+	SetVsyncEnabled(value gdnative.Bool)
+	WindowBorderless() gdnative.Bool
+	SetWindowBorderless(value gdnative.Bool)
+	WindowFullscreen() gdnative.Bool
+	WindowMaximized() gdnative.Bool
+	WindowMinimized() gdnative.Bool
+	WindowPosition() gdnative.Vector2
+	WindowResizable() gdnative.Bool
+	WindowSize() gdnative.Vector2
 }
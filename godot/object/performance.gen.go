@@ -0,0 +1,249 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// PerformanceMonitor is an enum for Monitor values.
+type PerformanceMonitor int
+
+const (
+	PerformanceMemoryDynamic                PerformanceMonitor = 4
+	PerformanceMemoryDynamicMax             PerformanceMonitor = 6
+	PerformanceMemoryMessageBufferMax       PerformanceMonitor = 7
+	PerformanceMemoryStatic                 PerformanceMonitor = 3
+	PerformanceMemoryStaticMax              PerformanceMonitor = 5
+	PerformanceMonitorMax                   PerformanceMonitor = 27
+	PerformanceObjectCount                  PerformanceMonitor = 8
+	PerformanceObjectNodeCount              PerformanceMonitor = 10
+	PerformanceObjectResourceCount          PerformanceMonitor = 9
+	PerformancePhysics2DActiveObjects       PerformanceMonitor = 21
+	PerformancePhysics2DCollisionPairs      PerformanceMonitor = 22
+	PerformancePhysics2DIslandCount         PerformanceMonitor = 23
+	PerformancePhysics3DActiveObjects       PerformanceMonitor = 24
+	PerformancePhysics3DCollisionPairs      PerformanceMonitor = 25
+	PerformancePhysics3DIslandCount         PerformanceMonitor = 26
+	PerformanceRenderDrawCallsInFrame       PerformanceMonitor = 16
+	PerformanceRenderMaterialChangesInFrame PerformanceMonitor = 13
+	PerformanceRenderObjectsInFrame         PerformanceMonitor = 11
+	PerformanceRenderShaderChangesInFrame   PerformanceMonitor = 14
+	PerformanceRenderSurfaceChangesInFrame  PerformanceMonitor = 15
+	PerformanceRenderTextureMemUsed         PerformanceMonitor = 18
+	PerformanceRenderUsageVideoMemTotal     PerformanceMonitor = 20
+	PerformanceRenderVertexMemUsed          PerformanceMonitor = 19
+	PerformanceRenderVerticesInFrame        PerformanceMonitor = 12
+	PerformanceRenderVideoMemUsed           PerformanceMonitor = 17
+	PerformanceTimeFps                      PerformanceMonitor = 0
+	PerformanceTimePhysicsProcess           PerformanceMonitor = 2
+	PerformanceTimeProcess                  PerformanceMonitor = 1
+)
+
+// String will return the Godot name of the PerformanceMonitor value.
+func (e PerformanceMonitor) String() string {
+	switch e {
+	case PerformanceMemoryDynamic:
+		return "MEMORY_DYNAMIC"
+	case PerformanceMemoryDynamicMax:
+		return "MEMORY_DYNAMIC_MAX"
+	case PerformanceMemoryMessageBufferMax:
+		return "MEMORY_MESSAGE_BUFFER_MAX"
+	case PerformanceMemoryStatic:
+		return "MEMORY_STATIC"
+	case PerformanceMemoryStaticMax:
+		return "MEMORY_STATIC_MAX"
+	case PerformanceMonitorMax:
+		return "MONITOR_MAX"
+	case PerformanceObjectCount:
+		return "OBJECT_COUNT"
+	case PerformanceObjectNodeCount:
+		return "OBJECT_NODE_COUNT"
+	case PerformanceObjectResourceCount:
+		return "OBJECT_RESOURCE_COUNT"
+	case PerformancePhysics2DActiveObjects:
+		return "PHYSICS_2D_ACTIVE_OBJECTS"
+	case PerformancePhysics2DCollisionPairs:
+		return "PHYSICS_2D_COLLISION_PAIRS"
+	case PerformancePhysics2DIslandCount:
+		return "PHYSICS_2D_ISLAND_COUNT"
+	case PerformancePhysics3DActiveObjects:
+		return "PHYSICS_3D_ACTIVE_OBJECTS"
+	case PerformancePhysics3DCollisionPairs:
+		return "PHYSICS_3D_COLLISION_PAIRS"
+	case PerformancePhysics3DIslandCount:
+		return "PHYSICS_3D_ISLAND_COUNT"
+	case PerformanceRenderDrawCallsInFrame:
+		return "RENDER_DRAW_CALLS_IN_FRAME"
+	case PerformanceRenderMaterialChangesInFrame:
+		return "RENDER_MATERIAL_CHANGES_IN_FRAME"
+	case PerformanceRenderObjectsInFrame:
+		return "RENDER_OBJECTS_IN_FRAME"
+	case PerformanceRenderShaderChangesInFrame:
+		return "RENDER_SHADER_CHANGES_IN_FRAME"
+	case PerformanceRenderSurfaceChangesInFrame:
+		return "RENDER_SURFACE_CHANGES_IN_FRAME"
+	case PerformanceRenderTextureMemUsed:
+		return "RENDER_TEXTURE_MEM_USED"
+	case PerformanceRenderUsageVideoMemTotal:
+		return "RENDER_USAGE_VIDEO_MEM_TOTAL"
+	case PerformanceRenderVertexMemUsed:
+		return "RENDER_VERTEX_MEM_USED"
+	case PerformanceRenderVerticesInFrame:
+		return "RENDER_VERTICES_IN_FRAME"
+	case PerformanceRenderVideoMemUsed:
+		return "RENDER_VIDEO_MEM_USED"
+	case PerformanceTimeFps:
+		return "TIME_FPS"
+	case PerformanceTimePhysicsProcess:
+		return "TIME_PHYSICS_PROCESS"
+	case PerformanceTimeProcess:
+		return "TIME_PROCESS"
+	}
+	return fmt.Sprintf("PerformanceMonitor(%d)", int(e))
+}
+
+// ParsePerformanceMonitor will return the PerformanceMonitor value with the given Godot name.
+func ParsePerformanceMonitor(name string) (PerformanceMonitor, error) {
+	switch name {
+	case "MEMORY_DYNAMIC":
+		return PerformanceMemoryDynamic, nil
+	case "MEMORY_DYNAMIC_MAX":
+		return PerformanceMemoryDynamicMax, nil
+	case "MEMORY_MESSAGE_BUFFER_MAX":
+		return PerformanceMemoryMessageBufferMax, nil
+	case "MEMORY_STATIC":
+		return PerformanceMemoryStatic, nil
+	case "MEMORY_STATIC_MAX":
+		return PerformanceMemoryStaticMax, nil
+	case "MONITOR_MAX":
+		return PerformanceMonitorMax, nil
+	case "OBJECT_COUNT":
+		return PerformanceObjectCount, nil
+	case "OBJECT_NODE_COUNT":
+		return PerformanceObjectNodeCount, nil
+	case "OBJECT_RESOURCE_COUNT":
+		return PerformanceObjectResourceCount, nil
+	case "PHYSICS_2D_ACTIVE_OBJECTS":
+		return PerformancePhysics2DActiveObjects, nil
+	case "PHYSICS_2D_COLLISION_PAIRS":
+		return PerformancePhysics2DCollisionPairs, nil
+	case "PHYSICS_2D_ISLAND_COUNT":
+		return PerformancePhysics2DIslandCount, nil
+	case "PHYSICS_3D_ACTIVE_OBJECTS":
+		return PerformancePhysics3DActiveObjects, nil
+	case "PHYSICS_3D_COLLISION_PAIRS":
+		return PerformancePhysics3DCollisionPairs, nil
+	case "PHYSICS_3D_ISLAND_COUNT":
+		return PerformancePhysics3DIslandCount, nil
+	case "RENDER_DRAW_CALLS_IN_FRAME":
+		return PerformanceRenderDrawCallsInFrame, nil
+	case "RENDER_MATERIAL_CHANGES_IN_FRAME":
+		return PerformanceRenderMaterialChangesInFrame, nil
+	case "RENDER_OBJECTS_IN_FRAME":
+		return PerformanceRenderObjectsInFrame, nil
+	case "RENDER_SHADER_CHANGES_IN_FRAME":
+		return PerformanceRenderShaderChangesInFrame, nil
+	case "RENDER_SURFACE_CHANGES_IN_FRAME":
+		return PerformanceRenderSurfaceChangesInFrame, nil
+	case "RENDER_TEXTURE_MEM_USED":
+		return PerformanceRenderTextureMemUsed, nil
+	case "RENDER_USAGE_VIDEO_MEM_TOTAL":
+		return PerformanceRenderUsageVideoMemTotal, nil
+	case "RENDER_VERTEX_MEM_USED":
+		return PerformanceRenderVertexMemUsed, nil
+	case "RENDER_VERTICES_IN_FRAME":
+		return PerformanceRenderVerticesInFrame, nil
+	case "RENDER_VIDEO_MEM_USED":
+		return PerformanceRenderVideoMemUsed, nil
+	case "TIME_FPS":
+		return PerformanceTimeFps, nil
+	case "TIME_PHYSICS_PROCESS":
+		return PerformanceTimePhysicsProcess, nil
+	case "TIME_PROCESS":
+		return PerformanceTimeProcess, nil
+	}
+	return 0, fmt.Errorf("invalid PerformanceMonitor value %q", name)
+}
+
+func newSingletonPerformance() *performance {
+	return &performance{}
+}
+
+/*
+This class provides access to a number of different monitors related to performance, such as memory usage, draw calls, and FPS. These are the same as the values displayed in the [i]Monitor[/i] tab in the editor's [i]Debugger[/i] panel. By using the [method get_monitor] method of this class, you can access this data from your code. Note that a few of these monitors are only available in debug mode and will always return 0 when used in a release build. Many of these monitors are not updated in real-time, so there may be a short delay between changes.
+*/
+var Performance = newSingletonPerformance()
+
+/*
+This class provides access to a number of different monitors related to performance, such as memory usage, draw calls, and FPS. These are the same as the values displayed in the [i]Monitor[/i] tab in the editor's [i]Debugger[/i] panel. By using the [method get_monitor] method of this class, you can access this data from your code. Note that a few of these monitors are only available in debug mode and will always return 0 when used in a release build. Many of these monitors are not updated in real-time, so there may be a short delay between changes.
+*/
+type performance struct {
+	godot.Object
+	owner       gdnative.Object
+	initialized bool
+}
+
+// EnsureSingleton will check to see if we have an object for it. If not, it will fetch its
+// GDNative object and set it.
+func (o *performance) ensureSingleton() {
+	if o.initialized == true {
+		return
+	}
+	//log.Println("Singleton not found. Fetching from GDNative...")
+	base := gdnative.GetSingleton("Performance")
+	o.SetBaseObject(base)
+	o.initialized = true
+}
+
+func (o *performance) BaseClass() string {
+	return "Performance"
+}
+
+// Method binds of the Performance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPerformanceGetMonitor = gdnative.NewLazyMethodBind("Performance", "get_monitor")
+)
+
+/*
+	        Returns the value of one of the available monitors. You should provide one of this class's constants as the argument, like this: [codeblock] print(Performance.get_monitor(Performance.TIME_FPS)) # Prints the FPS to the console [/codeblock]
+		Args: [{ false monitor enum.Performance::Monitor}], Returns: float
+*/
+func (o *performance) GetMonitor(monitor PerformanceMonitor) gdnative.Real {
+	o.ensureSingleton()
+	//log.Println("Calling Performance.GetMonitor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(monitor))
+
+	// Get the method bind
+	methodBind := methodBindPerformanceGetMonitor.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+// PerformanceImplementer is an interface that implements the methods
+// of the Performance class.
+type PerformanceImplementer interface {
+	godot.ObjectImplementer
+	GetMonitor(monitor PerformanceMonitor) gdnative.Real
+}
@@ -1,7 +1,8 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +14,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewprojectSettingsFromPointer(ptr gdnative.Pointer) projectSettings {
-func newProjectSettingsFromPointer(ptr gdnative.Pointer) projectSettings {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := projectSettings{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonProjectSettings() *projectSettings {
 	return &projectSettings{}
 }
 
 /*
-   Contains global variables accessible from everywhere. Use "ProjectSettings.get_setting(variable)", "ProjectSettings.set_setting(variable,value)" or "ProjectSettings.has_setting(variable)" to access them. Variables stored in project.godot are also loaded into ProjectSettings, making this object very useful for reading custom game configuration options.
+Contains global variables accessible from everywhere. Use "ProjectSettings.get_setting(variable)", "ProjectSettings.set_setting(variable,value)" or "ProjectSettings.has_setting(variable)" to access them. Variables stored in project.godot are also loaded into ProjectSettings, making this object very useful for reading custom game configuration options.
 */
 var ProjectSettings = newSingletonProjectSettings()
 
@@ -35,7 +27,7 @@ var ProjectSettings = newSingletonProjectSettings()
 Contains global variables accessible from everywhere. Use "ProjectSettings.get_setting(variable)", "ProjectSettings.set_setting(variable,value)" or "ProjectSettings.has_setting(variable)" to access them. Variables stored in project.godot are also loaded into ProjectSettings, making this object very useful for reading custom game configuration options.
 */
 type projectSettings struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,9 +48,29 @@ func (o *projectSettings) BaseClass() string {
 	return "ProjectSettings"
 }
 
+// Method binds of the ProjectSettings class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindProjectSettingsAddPropertyInfo   = gdnative.NewLazyMethodBind("ProjectSettings", "add_property_info")
+	methodBindProjectSettingsClear             = gdnative.NewLazyMethodBind("ProjectSettings", "clear")
+	methodBindProjectSettingsGetOrder          = gdnative.NewLazyMethodBind("ProjectSettings", "get_order")
+	methodBindProjectSettingsGetSetting        = gdnative.NewLazyMethodBind("ProjectSettings", "get_setting")
+	methodBindProjectSettingsGlobalizePath     = gdnative.NewLazyMethodBind("ProjectSettings", "globalize_path")
+	methodBindProjectSettingsHasSetting        = gdnative.NewLazyMethodBind("ProjectSettings", "has_setting")
+	methodBindProjectSettingsLoadResourcePack  = gdnative.NewLazyMethodBind("ProjectSettings", "load_resource_pack")
+	methodBindProjectSettingsLocalizePath      = gdnative.NewLazyMethodBind("ProjectSettings", "localize_path")
+	methodBindProjectSettingsPropertyCanRevert = gdnative.NewLazyMethodBind("ProjectSettings", "property_can_revert")
+	methodBindProjectSettingsPropertyGetRevert = gdnative.NewLazyMethodBind("ProjectSettings", "property_get_revert")
+	methodBindProjectSettingsSave              = gdnative.NewLazyMethodBind("ProjectSettings", "save")
+	methodBindProjectSettingsSaveCustom        = gdnative.NewLazyMethodBind("ProjectSettings", "save_custom")
+	methodBindProjectSettingsSetInitialValue   = gdnative.NewLazyMethodBind("ProjectSettings", "set_initial_value")
+	methodBindProjectSettingsSetOrder          = gdnative.NewLazyMethodBind("ProjectSettings", "set_order")
+	methodBindProjectSettingsSetSetting        = gdnative.NewLazyMethodBind("ProjectSettings", "set_setting")
+)
+
 /*
-        Add a custom property info to a property. The dictionary must contain: name:[String](the name of the property) and type:[int](see TYPE_* in [@GlobalScope]), and optionally hint:[int](see PROPERTY_HINT_* in [@GlobalScope]), hint_string:[String]. Example: [codeblock] ProjectSettings.set("category/property_name", 0) var property_info = { "name": "category/property_name", "type": TYPE_INT, "hint": PROPERTY_HINT_ENUM, "hint_string": "one,two,three" } ProjectSettings.add_property_info(property_info) [/codeblock]
-	Args: [{ false hint Dictionary}], Returns: void
+	        Add a custom property info to a property. The dictionary must contain: name:[String](the name of the property) and type:[int](see TYPE_* in [@GlobalScope]), and optionally hint:[int](see PROPERTY_HINT_* in [@GlobalScope]), hint_string:[String]. Example: [codeblock] ProjectSettings.set("category/property_name", 0) var property_info = { "name": "category/property_name", "type": TYPE_INT, "hint": PROPERTY_HINT_ENUM, "hint_string": "one,two,three" } ProjectSettings.add_property_info(property_info) [/codeblock]
+		Args: [{ false hint Dictionary}], Returns: void
 */
 func (o *projectSettings) AddPropertyInfo(hint gdnative.Dictionary) {
 	o.ensureSingleton()
@@ -69,7 +81,7 @@ func (o *projectSettings) AddPropertyInfo(hint gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(hint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "add_property_info")
+	methodBind := methodBindProjectSettingsAddPropertyInfo.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +91,8 @@ func (o *projectSettings) AddPropertyInfo(hint gdnative.Dictionary) {
 }
 
 /*
-        Clear the whole configuration (not recommended, may break things).
-	Args: [{ false name String}], Returns: void
+	        Clear the whole configuration (not recommended, may break things).
+		Args: [{ false name String}], Returns: void
 */
 func (o *projectSettings) Clear(name gdnative.String) {
 	o.ensureSingleton()
@@ -91,7 +103,7 @@ func (o *projectSettings) Clear(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "clear")
+	methodBind := methodBindProjectSettingsClear.Get()
 
 	// Call the parent method.
 	// void
@@ -101,8 +113,8 @@ func (o *projectSettings) Clear(name gdnative.String) {
 }
 
 /*
-        Return the order of a configuration value (influences when saved to the config file).
-	Args: [{ false name String}], Returns: int
+	        Return the order of a configuration value (influences when saved to the config file).
+		Args: [{ false name String}], Returns: int
 */
 func (o *projectSettings) GetOrder(name gdnative.String) gdnative.Int {
 	o.ensureSingleton()
@@ -113,7 +125,7 @@ func (o *projectSettings) GetOrder(name gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "get_order")
+	methodBind := methodBindProjectSettingsGetOrder.Get()
 
 	// Call the parent method.
 	// int
@@ -126,8 +138,7 @@ func (o *projectSettings) GetOrder(name gdnative.String) gdnative.Int {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: Variant
+Args: [{ false name String}], Returns: Variant
 */
 func (o *projectSettings) GetSetting(name gdnative.String) gdnative.Variant {
 	o.ensureSingleton()
@@ -138,7 +149,7 @@ func (o *projectSettings) GetSetting(name gdnative.String) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "get_setting")
+	methodBind := methodBindProjectSettingsGetSetting.Get()
 
 	// Call the parent method.
 	// Variant
@@ -151,8 +162,8 @@ func (o *projectSettings) GetSetting(name gdnative.String) gdnative.Variant {
 }
 
 /*
-        Convert a localized path (res://) to a full native OS path.
-	Args: [{ false path String}], Returns: String
+	        Convert a localized path (res://) to a full native OS path.
+		Args: [{ false path String}], Returns: String
 */
 func (o *projectSettings) GlobalizePath(path gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -163,7 +174,7 @@ func (o *projectSettings) GlobalizePath(path gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "globalize_path")
+	methodBind := methodBindProjectSettingsGlobalizePath.Get()
 
 	// Call the parent method.
 	// String
@@ -176,8 +187,8 @@ func (o *projectSettings) GlobalizePath(path gdnative.String) gdnative.String {
 }
 
 /*
-        Return true if a configuration value is present.
-	Args: [{ false name String}], Returns: bool
+	        Return true if a configuration value is present.
+		Args: [{ false name String}], Returns: bool
 */
 func (o *projectSettings) HasSetting(name gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -188,7 +199,7 @@ func (o *projectSettings) HasSetting(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "has_setting")
+	methodBind := methodBindProjectSettingsHasSetting.Get()
 
 	// Call the parent method.
 	// bool
@@ -201,8 +212,7 @@ func (o *projectSettings) HasSetting(name gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false pack String}], Returns: bool
+Args: [{ false pack String}], Returns: bool
 */
 func (o *projectSettings) LoadResourcePack(pack gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -213,7 +223,7 @@ func (o *projectSettings) LoadResourcePack(pack gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(pack)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "load_resource_pack")
+	methodBind := methodBindProjectSettingsLoadResourcePack.Get()
 
 	// Call the parent method.
 	// bool
@@ -226,8 +236,8 @@ func (o *projectSettings) LoadResourcePack(pack gdnative.String) gdnative.Bool {
 }
 
 /*
-        Convert a path to a localized path (res:// path).
-	Args: [{ false path String}], Returns: String
+	        Convert a path to a localized path (res:// path).
+		Args: [{ false path String}], Returns: String
 */
 func (o *projectSettings) LocalizePath(path gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -238,7 +248,7 @@ func (o *projectSettings) LocalizePath(path gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "localize_path")
+	methodBind := methodBindProjectSettingsLocalizePath.Get()
 
 	// Call the parent method.
 	// String
@@ -251,8 +261,7 @@ func (o *projectSettings) LocalizePath(path gdnative.String) gdnative.String {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *projectSettings) PropertyCanRevert(name gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -263,7 +272,7 @@ func (o *projectSettings) PropertyCanRevert(name gdnative.String) gdnative.Bool
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "property_can_revert")
+	methodBind := methodBindProjectSettingsPropertyCanRevert.Get()
 
 	// Call the parent method.
 	// bool
@@ -276,8 +285,7 @@ func (o *projectSettings) PropertyCanRevert(name gdnative.String) gdnative.Bool
 }
 
 /*
-
-	Args: [{ false name String}], Returns: Variant
+Args: [{ false name String}], Returns: Variant
 */
 func (o *projectSettings) PropertyGetRevert(name gdnative.String) gdnative.Variant {
 	o.ensureSingleton()
@@ -288,7 +296,7 @@ func (o *projectSettings) PropertyGetRevert(name gdnative.String) gdnative.Varia
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "property_get_revert")
+	methodBind := methodBindProjectSettingsPropertyGetRevert.Get()
 
 	// Call the parent method.
 	// Variant
@@ -301,8 +309,7 @@ func (o *projectSettings) PropertyGetRevert(name gdnative.String) gdnative.Varia
 }
 
 /*
-
-	Args: [], Returns: enum.Error
+Args: [], Returns: enum.Error
 */
 func (o *projectSettings) Save() gdnative.Error {
 	o.ensureSingleton()
@@ -312,7 +319,7 @@ func (o *projectSettings) Save() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "save")
+	methodBind := methodBindProjectSettingsSave.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -325,8 +332,7 @@ func (o *projectSettings) Save() gdnative.Error {
 }
 
 /*
-
-	Args: [{ false file String}], Returns: enum.Error
+Args: [{ false file String}], Returns: enum.Error
 */
 func (o *projectSettings) SaveCustom(file gdnative.String) gdnative.Error {
 	o.ensureSingleton()
@@ -337,7 +343,7 @@ func (o *projectSettings) SaveCustom(file gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "save_custom")
+	methodBind := methodBindProjectSettingsSaveCustom.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -350,8 +356,7 @@ func (o *projectSettings) SaveCustom(file gdnative.String) gdnative.Error {
 }
 
 /*
-
-	Args: [{ false name String} { false value Variant}], Returns: void
+Args: [{ false name String} { false value Variant}], Returns: void
 */
 func (o *projectSettings) SetInitialValue(name gdnative.String, value gdnative.Variant) {
 	o.ensureSingleton()
@@ -363,7 +368,7 @@ func (o *projectSettings) SetInitialValue(name gdnative.String, value gdnative.V
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "set_initial_value")
+	methodBind := methodBindProjectSettingsSetInitialValue.Get()
 
 	// Call the parent method.
 	// void
@@ -373,8 +378,8 @@ func (o *projectSettings) SetInitialValue(name gdnative.String, value gdnative.V
 }
 
 /*
-        Set the order of a configuration value (influences when saved to the config file).
-	Args: [{ false name String} { false position int}], Returns: void
+	        Set the order of a configuration value (influences when saved to the config file).
+		Args: [{ false name String} { false position int}], Returns: void
 */
 func (o *projectSettings) SetOrder(name gdnative.String, position gdnative.Int) {
 	o.ensureSingleton()
@@ -386,7 +391,7 @@ func (o *projectSettings) SetOrder(name gdnative.String, position gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "set_order")
+	methodBind := methodBindProjectSettingsSetOrder.Get()
 
 	// Call the parent method.
 	// void
@@ -396,8 +401,7 @@ func (o *projectSettings) SetOrder(name gdnative.String, position gdnative.Int)
 }
 
 /*
-
-	Args: [{ false name String} { false value Variant}], Returns: void
+Args: [{ false name String} { false value Variant}], Returns: void
 */
 func (o *projectSettings) SetSetting(name gdnative.String, value gdnative.Variant) {
 	o.ensureSingleton()
@@ -409,7 +413,7 @@ func (o *projectSettings) SetSetting(name gdnative.String, value gdnative.Varian
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProjectSettings", "set_setting")
+	methodBind := methodBindProjectSettingsSetSetting.Get()
 
 	// Call the parent method.
 	// void
@@ -421,7 +425,7 @@ func (o *projectSettings) SetSetting(name gdnative.String, value gdnative.Varian
 // ProjectSettingsImplementer is an interface that implements the methods
 // of the ProjectSettings class.
 type ProjectSettingsImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	AddPropertyInfo(hint gdnative.Dictionary)
 	Clear(name gdnative.String)
 	GetOrder(name gdnative.String) gdnative.Int
@@ -432,6 +436,8 @@ type ProjectSettingsImplementer interface {
 	LocalizePath(path gdnative.String) gdnative.String
 	PropertyCanRevert(name gdnative.String) gdnative.Bool
 	PropertyGetRevert(name gdnative.String) gdnative.Variant
+	Save() gdnative.Error
+	SaveCustom(file gdnative.String) gdnative.Error
 	SetInitialValue(name gdnative.String, value gdnative.Variant)
 	SetOrder(name gdnative.String, position gdnative.Int)
 	SetSetting(name gdnative.String, value gdnative.Variant)
@@ -1,7 +1,9 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +15,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewresourceLoaderFromPointer(ptr gdnative.Pointer) resourceLoader {
-func new_ResourceLoaderFromPointer(ptr gdnative.Pointer) resourceLoader {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := resourceLoader{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonResourceLoader() *resourceLoader {
 	return &resourceLoader{}
 }
 
 /*
-   Resource Loader. This is a static object accessible as [code]ResourceLoader[/code]. GDScript has a simplified load() function, though.
+Resource Loader. This is a static object accessible as [code]ResourceLoader[/code]. GDScript has a simplified load() function, though.
 */
 var ResourceLoader = newSingletonResourceLoader()
 
@@ -35,7 +28,7 @@ var ResourceLoader = newSingletonResourceLoader()
 Resource Loader. This is a static object accessible as [code]ResourceLoader[/code]. GDScript has a simplified load() function, though.
 */
 type resourceLoader struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,9 +49,20 @@ func (o *resourceLoader) BaseClass() string {
 	return "_ResourceLoader"
 }
 
+// Method binds of the ResourceLoader class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindResourceLoaderGetDependencies                = gdnative.NewLazyMethodBind("_ResourceLoader", "get_dependencies")
+	methodBindResourceLoaderGetRecognizedExtensionsForType = gdnative.NewLazyMethodBind("_ResourceLoader", "get_recognized_extensions_for_type")
+	methodBindResourceLoaderHas                            = gdnative.NewLazyMethodBind("_ResourceLoader", "has")
+	methodBindResourceLoaderLoad                           = gdnative.NewLazyMethodBind("_ResourceLoader", "load")
+	methodBindResourceLoaderLoadInteractive                = gdnative.NewLazyMethodBind("_ResourceLoader", "load_interactive")
+	methodBindResourceLoaderSetAbortOnMissingResources     = gdnative.NewLazyMethodBind("_ResourceLoader", "set_abort_on_missing_resources")
+)
+
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: PoolStringArray
+	        Undocumented
+		Args: [{ false path String}], Returns: PoolStringArray
 */
 func (o *resourceLoader) GetDependencies(path gdnative.String) gdnative.PoolStringArray {
 	o.ensureSingleton()
@@ -69,7 +73,7 @@ func (o *resourceLoader) GetDependencies(path gdnative.String) gdnative.PoolStri
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ResourceLoader", "get_dependencies")
+	methodBind := methodBindResourceLoaderGetDependencies.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -82,8 +86,8 @@ func (o *resourceLoader) GetDependencies(path gdnative.String) gdnative.PoolStri
 }
 
 /*
-        Undocumented
-	Args: [{ false type String}], Returns: PoolStringArray
+	        Undocumented
+		Args: [{ false type String}], Returns: PoolStringArray
 */
 func (o *resourceLoader) GetRecognizedExtensionsForType(aType gdnative.String) gdnative.PoolStringArray {
 	o.ensureSingleton()
@@ -94,7 +98,7 @@ func (o *resourceLoader) GetRecognizedExtensionsForType(aType gdnative.String) g
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ResourceLoader", "get_recognized_extensions_for_type")
+	methodBind := methodBindResourceLoaderGetRecognizedExtensionsForType.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -107,8 +111,8 @@ func (o *resourceLoader) GetRecognizedExtensionsForType(aType gdnative.String) g
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: bool
+	        Undocumented
+		Args: [{ false path String}], Returns: bool
 */
 func (o *resourceLoader) Has(path gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -119,7 +123,7 @@ func (o *resourceLoader) Has(path gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ResourceLoader", "has")
+	methodBind := methodBindResourceLoaderHas.Get()
 
 	// Call the parent method.
 	// bool
@@ -132,10 +136,10 @@ func (o *resourceLoader) Has(path gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { true type_hint String} {False true p_no_cache bool}], Returns: Resource
+	        Undocumented
+		Args: [{ false path String} { true type_hint String} {False true p_no_cache bool}], Returns: Resource
 */
-func (o *resourceLoader) Load(path gdnative.String, typeHint gdnative.String, pNoCache gdnative.Bool) ResourceImplementer {
+func (o *resourceLoader) Load(path gdnative.String, typeHint gdnative.String, pNoCache gdnative.Bool) godot.ResourceImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling _ResourceLoader.Load()")
 
@@ -146,7 +150,7 @@ func (o *resourceLoader) Load(path gdnative.String, typeHint gdnative.String, pN
 	ptrArguments[2] = gdnative.NewPointerFromBool(pNoCache)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ResourceLoader", "load")
+	methodBind := methodBindResourceLoaderLoad.Get()
 
 	// Call the parent method.
 	// Resource
@@ -154,29 +158,27 @@ func (o *resourceLoader) Load(path gdnative.String, typeHint gdnative.String, pN
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newResourceFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ResourceImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Resource" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ResourceImplementer)
+	ret := godot.Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ResourceImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { true type_hint String}], Returns: ResourceInteractiveLoader
+	        Undocumented
+		Args: [{ false path String} { true type_hint String}], Returns: ResourceInteractiveLoader
 */
-func (o *resourceLoader) LoadInteractive(path gdnative.String, typeHint gdnative.String) ResourceInteractiveLoaderImplementer {
+func (o *resourceLoader) LoadInteractive(path gdnative.String, typeHint gdnative.String) resource.ResourceInteractiveLoaderImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling _ResourceLoader.LoadInteractive()")
 
@@ -186,7 +188,7 @@ func (o *resourceLoader) LoadInteractive(path gdnative.String, typeHint gdnative
 	ptrArguments[1] = gdnative.NewPointerFromString(typeHint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ResourceLoader", "load_interactive")
+	methodBind := methodBindResourceLoaderLoadInteractive.Get()
 
 	// Call the parent method.
 	// ResourceInteractiveLoader
@@ -194,27 +196,25 @@ func (o *resourceLoader) LoadInteractive(path gdnative.String, typeHint gdnative
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newResourceInteractiveLoaderFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ResourceInteractiveLoaderImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ResourceInteractiveLoader" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ResourceInteractiveLoaderImplementer)
+	ret := resource.ResourceInteractiveLoader{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.ResourceInteractiveLoaderImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false abort bool}], Returns: void
+	        Undocumented
+		Args: [{ false abort bool}], Returns: void
 */
 func (o *resourceLoader) SetAbortOnMissingResources(abort gdnative.Bool) {
 	o.ensureSingleton()
@@ -225,7 +225,7 @@ func (o *resourceLoader) SetAbortOnMissingResources(abort gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(abort)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_ResourceLoader", "set_abort_on_missing_resources")
+	methodBind := methodBindResourceLoaderSetAbortOnMissingResources.Get()
 
 	// Call the parent method.
 	// void
@@ -234,14 +234,26 @@ func (o *resourceLoader) SetAbortOnMissingResources(abort gdnative.Bool) {
 
 }
 
+// LoadWithDefaults will call Load using the default values for: type_hint, p_no_cache.
+func (o *resourceLoader) LoadWithDefaults(path gdnative.String) godot.ResourceImplementer {
+	return o.Load(path, "", false)
+}
+
+// LoadInteractiveWithDefaults will call LoadInteractive using the default values for: type_hint.
+func (o *resourceLoader) LoadInteractiveWithDefaults(path gdnative.String) resource.ResourceInteractiveLoaderImplementer {
+	return o.LoadInteractive(path, "")
+}
+
 // ResourceLoaderImplementer is an interface that implements the methods
 // of the ResourceLoader class.
 type ResourceLoaderImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	GetDependencies(path gdnative.String) gdnative.PoolStringArray
 	GetRecognizedExtensionsForType(aType gdnative.String) gdnative.PoolStringArray
 	Has(path gdnative.String) gdnative.Bool
-	Load(path gdnative.String, typeHint gdnative.String, pNoCache gdnative.Bool) ResourceImplementer
-	LoadInteractive(path gdnative.String, typeHint gdnative.String) ResourceInteractiveLoaderImplementer
+	Load(path gdnative.String, typeHint gdnative.String, pNoCache gdnative.Bool) godot.ResourceImplementer
+	LoadInteractive(path gdnative.String, typeHint gdnative.String) resource.ResourceInteractiveLoaderImplementer
 	SetAbortOnMissingResources(abort gdnative.Bool)
+	LoadWithDefaults(path gdnative.String) godot.ResourceImplementer
+	LoadInteractiveWithDefaults(path gdnative.String) resource.ResourceInteractiveLoaderImplementer
 }
@@ -0,0 +1,174 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// _ResourceSaverSaverFlags is an enum for SaverFlags values.
+type _ResourceSaverSaverFlags int
+
+const (
+	_ResourceSaverFlagBundleResources      _ResourceSaverSaverFlags = 2
+	_ResourceSaverFlagChangePath           _ResourceSaverSaverFlags = 4
+	_ResourceSaverFlagCompress             _ResourceSaverSaverFlags = 32
+	_ResourceSaverFlagOmitEditorProperties _ResourceSaverSaverFlags = 8
+	_ResourceSaverFlagRelativePaths        _ResourceSaverSaverFlags = 1
+	_ResourceSaverFlagSaveBigEndian        _ResourceSaverSaverFlags = 16
+)
+
+// String will return the Godot name of the _ResourceSaverSaverFlags value.
+func (e _ResourceSaverSaverFlags) String() string {
+	switch e {
+	case _ResourceSaverFlagBundleResources:
+		return "FLAG_BUNDLE_RESOURCES"
+	case _ResourceSaverFlagChangePath:
+		return "FLAG_CHANGE_PATH"
+	case _ResourceSaverFlagCompress:
+		return "FLAG_COMPRESS"
+	case _ResourceSaverFlagOmitEditorProperties:
+		return "FLAG_OMIT_EDITOR_PROPERTIES"
+	case _ResourceSaverFlagRelativePaths:
+		return "FLAG_RELATIVE_PATHS"
+	case _ResourceSaverFlagSaveBigEndian:
+		return "FLAG_SAVE_BIG_ENDIAN"
+	}
+	return fmt.Sprintf("_ResourceSaverSaverFlags(%d)", int(e))
+}
+
+// Parse_ResourceSaverSaverFlags will return the _ResourceSaverSaverFlags value with the given Godot name.
+func Parse_ResourceSaverSaverFlags(name string) (_ResourceSaverSaverFlags, error) {
+	switch name {
+	case "FLAG_BUNDLE_RESOURCES":
+		return _ResourceSaverFlagBundleResources, nil
+	case "FLAG_CHANGE_PATH":
+		return _ResourceSaverFlagChangePath, nil
+	case "FLAG_COMPRESS":
+		return _ResourceSaverFlagCompress, nil
+	case "FLAG_OMIT_EDITOR_PROPERTIES":
+		return _ResourceSaverFlagOmitEditorProperties, nil
+	case "FLAG_RELATIVE_PATHS":
+		return _ResourceSaverFlagRelativePaths, nil
+	case "FLAG_SAVE_BIG_ENDIAN":
+		return _ResourceSaverFlagSaveBigEndian, nil
+	}
+	return 0, fmt.Errorf("invalid _ResourceSaverSaverFlags value %q", name)
+}
+
+func newSingletonResourceSaver() *resourceSaver {
+	return &resourceSaver{}
+}
+
+/*
+Resource Saving Interface. This interface is used for saving resources to disk.
+*/
+var ResourceSaver = newSingletonResourceSaver()
+
+/*
+Resource Saving Interface. This interface is used for saving resources to disk.
+*/
+type resourceSaver struct {
+	godot.Object
+	owner       gdnative.Object
+	initialized bool
+}
+
+// EnsureSingleton will check to see if we have an object for it. If not, it will fetch its
+// GDNative object and set it.
+func (o *resourceSaver) ensureSingleton() {
+	if o.initialized == true {
+		return
+	}
+	//log.Println("Singleton not found. Fetching from GDNative...")
+	base := gdnative.GetSingleton("_ResourceSaver")
+	o.SetBaseObject(base)
+	o.initialized = true
+}
+
+func (o *resourceSaver) BaseClass() string {
+	return "_ResourceSaver"
+}
+
+// Method binds of the ResourceSaver class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindResourceSaverGetRecognizedExtensions = gdnative.NewLazyMethodBind("_ResourceSaver", "get_recognized_extensions")
+	methodBindResourceSaverSave                    = gdnative.NewLazyMethodBind("_ResourceSaver", "save")
+)
+
+/*
+	        Undocumented
+		Args: [{ false type Resource}], Returns: PoolStringArray
+*/
+func (o *resourceSaver) GetRecognizedExtensions(aType godot.ResourceImplementer) gdnative.PoolStringArray {
+	o.ensureSingleton()
+	//log.Println("Calling _ResourceSaver.GetRecognizedExtensions()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(aType))
+
+	// Get the method bind
+	methodBind := methodBindResourceSaverGetRecognizedExtensions.Get()
+
+	// Call the parent method.
+	// PoolStringArray
+	retPtr := gdnative.NewEmptyPoolStringArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewPoolStringArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false path String} { false resource Resource} {0 true flags int}], Returns: enum.Error
+*/
+func (o *resourceSaver) Save(path gdnative.String, resource godot.ResourceImplementer, flags gdnative.Int) gdnative.Error {
+	o.ensureSingleton()
+	//log.Println("Calling _ResourceSaver.Save()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
+	ptrArguments[2] = gdnative.NewPointerFromInt(flags)
+
+	// Get the method bind
+	methodBind := methodBindResourceSaverSave.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+// SaveWithDefaults will call Save using the default values for: flags.
+func (o *resourceSaver) SaveWithDefaults(path gdnative.String, resource godot.ResourceImplementer) gdnative.Error {
+	return o.Save(path, resource, 0)
+}
+
+// ResourceSaverImplementer is an interface that implements the methods
+// of the ResourceSaver class.
+type ResourceSaverImplementer interface {
+	godot.ObjectImplementer
+	GetRecognizedExtensions(aType godot.ResourceImplementer) gdnative.PoolStringArray
+	Save(path gdnative.String, resource godot.ResourceImplementer, flags gdnative.Int) gdnative.Error
+	SaveWithDefaults(path gdnative.String, resource godot.ResourceImplementer) gdnative.Error
+}
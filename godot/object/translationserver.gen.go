@@ -1,7 +1,9 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,29 +15,18 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewtranslationServerFromPointer(ptr gdnative.Pointer) translationServer {
-func newTranslationServerFromPointer(ptr gdnative.Pointer) translationServer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := translationServer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonTranslationServer() *translationServer {
 	return &translationServer{}
 }
 
 /*
-
  */
 var TranslationServer = newSingletonTranslationServer()
 
 /*
-
  */
 type translationServer struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,20 +47,31 @@ func (o *translationServer) BaseClass() string {
 	return "TranslationServer"
 }
 
-/*
+// Method binds of the TranslationServer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTranslationServerAddTranslation    = gdnative.NewLazyMethodBind("TranslationServer", "add_translation")
+	methodBindTranslationServerClear             = gdnative.NewLazyMethodBind("TranslationServer", "clear")
+	methodBindTranslationServerGetLocale         = gdnative.NewLazyMethodBind("TranslationServer", "get_locale")
+	methodBindTranslationServerGetLocaleName     = gdnative.NewLazyMethodBind("TranslationServer", "get_locale_name")
+	methodBindTranslationServerRemoveTranslation = gdnative.NewLazyMethodBind("TranslationServer", "remove_translation")
+	methodBindTranslationServerSetLocale         = gdnative.NewLazyMethodBind("TranslationServer", "set_locale")
+	methodBindTranslationServerTranslate         = gdnative.NewLazyMethodBind("TranslationServer", "translate")
+)
 
-	Args: [{ false translation Translation}], Returns: void
+/*
+Args: [{ false translation Translation}], Returns: void
 */
-func (o *translationServer) AddTranslation(translation TranslationImplementer) {
+func (o *translationServer) AddTranslation(translation resource.TranslationImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling TranslationServer.AddTranslation()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(translation.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(translation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TranslationServer", "add_translation")
+	methodBind := methodBindTranslationServerAddTranslation.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +81,7 @@ func (o *translationServer) AddTranslation(translation TranslationImplementer) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *translationServer) Clear() {
 	o.ensureSingleton()
@@ -90,7 +91,7 @@ func (o *translationServer) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TranslationServer", "clear")
+	methodBind := methodBindTranslationServerClear.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +101,7 @@ func (o *translationServer) Clear() {
 }
 
 /*
-
-	Args: [], Returns: String
+Args: [], Returns: String
 */
 func (o *translationServer) GetLocale() gdnative.String {
 	o.ensureSingleton()
@@ -111,7 +111,7 @@ func (o *translationServer) GetLocale() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TranslationServer", "get_locale")
+	methodBind := methodBindTranslationServerGetLocale.Get()
 
 	// Call the parent method.
 	// String
@@ -124,8 +124,7 @@ func (o *translationServer) GetLocale() gdnative.String {
 }
 
 /*
-
-	Args: [{ false locale String}], Returns: String
+Args: [{ false locale String}], Returns: String
 */
 func (o *translationServer) GetLocaleName(locale gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -136,7 +135,7 @@ func (o *translationServer) GetLocaleName(locale gdnative.String) gdnative.Strin
 	ptrArguments[0] = gdnative.NewPointerFromString(locale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TranslationServer", "get_locale_name")
+	methodBind := methodBindTranslationServerGetLocaleName.Get()
 
 	// Call the parent method.
 	// String
@@ -149,19 +148,18 @@ func (o *translationServer) GetLocaleName(locale gdnative.String) gdnative.Strin
 }
 
 /*
-
-	Args: [{ false translation Translation}], Returns: void
+Args: [{ false translation Translation}], Returns: void
 */
-func (o *translationServer) RemoveTranslation(translation TranslationImplementer) {
+func (o *translationServer) RemoveTranslation(translation resource.TranslationImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling TranslationServer.RemoveTranslation()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(translation.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(translation))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TranslationServer", "remove_translation")
+	methodBind := methodBindTranslationServerRemoveTranslation.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +169,7 @@ func (o *translationServer) RemoveTranslation(translation TranslationImplementer
 }
 
 /*
-
-	Args: [{ false locale String}], Returns: void
+Args: [{ false locale String}], Returns: void
 */
 func (o *translationServer) SetLocale(locale gdnative.String) {
 	o.ensureSingleton()
@@ -183,7 +180,7 @@ func (o *translationServer) SetLocale(locale gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(locale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TranslationServer", "set_locale")
+	methodBind := methodBindTranslationServerSetLocale.Get()
 
 	// Call the parent method.
 	// void
@@ -193,8 +190,7 @@ func (o *translationServer) SetLocale(locale gdnative.String) {
 }
 
 /*
-
-	Args: [{ false message String}], Returns: String
+Args: [{ false message String}], Returns: String
 */
 func (o *translationServer) Translate(message gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -205,7 +201,7 @@ func (o *translationServer) Translate(message gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(message)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TranslationServer", "translate")
+	methodBind := methodBindTranslationServerTranslate.Get()
 
 	// Call the parent method.
 	// String
@@ -220,12 +216,12 @@ func (o *translationServer) Translate(message gdnative.String) gdnative.String {
 // TranslationServerImplementer is an interface that implements the methods
 // of the TranslationServer class.
 type TranslationServerImplementer interface {
-	ObjectImplementer
-	AddTranslation(translation TranslationImplementer)
+	godot.ObjectImplementer
+	AddTranslation(translation resource.TranslationImplementer)
 	Clear()
 	GetLocale() gdnative.String
 	GetLocaleName(locale gdnative.String) gdnative.String
-	RemoveTranslation(translation TranslationImplementer)
+	RemoveTranslation(translation resource.TranslationImplementer)
 	SetLocale(locale gdnative.String)
 	Translate(message gdnative.String) gdnative.String
 }
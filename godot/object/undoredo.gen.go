@@ -0,0 +1,411 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// UndoRedoMergeMode is an enum for MergeMode values.
+type UndoRedoMergeMode int
+
+const (
+	UndoRedoMergeAll     UndoRedoMergeMode = 2
+	UndoRedoMergeDisable UndoRedoMergeMode = 0
+	UndoRedoMergeEnds    UndoRedoMergeMode = 1
+)
+
+// String will return the Godot name of the UndoRedoMergeMode value.
+func (e UndoRedoMergeMode) String() string {
+	switch e {
+	case UndoRedoMergeAll:
+		return "MERGE_ALL"
+	case UndoRedoMergeDisable:
+		return "MERGE_DISABLE"
+	case UndoRedoMergeEnds:
+		return "MERGE_ENDS"
+	}
+	return fmt.Sprintf("UndoRedoMergeMode(%d)", int(e))
+}
+
+// ParseUndoRedoMergeMode will return the UndoRedoMergeMode value with the given Godot name.
+func ParseUndoRedoMergeMode(name string) (UndoRedoMergeMode, error) {
+	switch name {
+	case "MERGE_ALL":
+		return UndoRedoMergeAll, nil
+	case "MERGE_DISABLE":
+		return UndoRedoMergeDisable, nil
+	case "MERGE_ENDS":
+		return UndoRedoMergeEnds, nil
+	}
+	return 0, fmt.Errorf("invalid UndoRedoMergeMode value %q", name)
+}
+
+/*
+Helper to manage UndoRedo in the editor or custom tools. It works by storing calls to functions in both 'do' an 'undo' lists. Common behavior is to create an action, then add do/undo calls to functions or property changes, then committing the action.
+*/
+type UndoRedo struct {
+	godot.Object
+	owner gdnative.Object
+}
+
+// classConstructorUndoRedo is the class constructor of the UndoRedo class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorUndoRedo = gdnative.NewLazyClassConstructor("UndoRedo")
+
+// NewUndoRedo will create a new instance of the UndoRedo class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewUndoRedo() *UndoRedo {
+	obj := &UndoRedo{}
+	obj.SetBaseObject(classConstructorUndoRedo.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *UndoRedo) BaseClass() string {
+	return "UndoRedo"
+}
+
+// Method binds of the UndoRedo class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindUndoRedoAddDoMethod          = gdnative.NewLazyMethodBind("UndoRedo", "add_do_method")
+	methodBindUndoRedoAddDoProperty        = gdnative.NewLazyMethodBind("UndoRedo", "add_do_property")
+	methodBindUndoRedoAddDoReference       = gdnative.NewLazyMethodBind("UndoRedo", "add_do_reference")
+	methodBindUndoRedoAddUndoMethod        = gdnative.NewLazyMethodBind("UndoRedo", "add_undo_method")
+	methodBindUndoRedoAddUndoProperty      = gdnative.NewLazyMethodBind("UndoRedo", "add_undo_property")
+	methodBindUndoRedoAddUndoReference     = gdnative.NewLazyMethodBind("UndoRedo", "add_undo_reference")
+	methodBindUndoRedoClearHistory         = gdnative.NewLazyMethodBind("UndoRedo", "clear_history")
+	methodBindUndoRedoCommitAction         = gdnative.NewLazyMethodBind("UndoRedo", "commit_action")
+	methodBindUndoRedoCreateAction         = gdnative.NewLazyMethodBind("UndoRedo", "create_action")
+	methodBindUndoRedoGetCurrentActionName = gdnative.NewLazyMethodBind("UndoRedo", "get_current_action_name")
+	methodBindUndoRedoGetVersion           = gdnative.NewLazyMethodBind("UndoRedo", "get_version")
+	methodBindUndoRedoRedo                 = gdnative.NewLazyMethodBind("UndoRedo", "redo")
+	methodBindUndoRedoUndo                 = gdnative.NewLazyMethodBind("UndoRedo", "undo")
+)
+
+/*
+Args: [{ false object Object} { false method String}], Returns: Variant
+*/
+func (o *UndoRedo) AddDoMethod(object godot.ObjectImplementer, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling UndoRedo.AddDoMethod()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 2+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantObject(godot.GetBaseObject(object)))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoAddDoMethod.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 2; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Set a property with a custom value.
+		Args: [{ false object Object} { false property String} { false value Variant}], Returns: void
+*/
+func (o *UndoRedo) AddDoProperty(object godot.ObjectImplementer, property gdnative.String, value gdnative.Variant) {
+	//log.Println("Calling UndoRedo.AddDoProperty()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(property)
+	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoAddDoProperty.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add a 'do' reference that will be erased if the 'do' history is lost. This is useful mostly for new nodes created for the 'do' call. Do not use for resources.
+		Args: [{ false object Object}], Returns: void
+*/
+func (o *UndoRedo) AddDoReference(object godot.ObjectImplementer) {
+	//log.Println("Calling UndoRedo.AddDoReference()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoAddDoReference.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false object Object} { false method String}], Returns: Variant
+*/
+func (o *UndoRedo) AddUndoMethod(object godot.ObjectImplementer, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling UndoRedo.AddUndoMethod()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 2+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantObject(godot.GetBaseObject(object)))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoAddUndoMethod.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 2; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Undo setting of a property with a custom value.
+		Args: [{ false object Object} { false property String} { false value Variant}], Returns: void
+*/
+func (o *UndoRedo) AddUndoProperty(object godot.ObjectImplementer, property gdnative.String, value gdnative.Variant) {
+	//log.Println("Calling UndoRedo.AddUndoProperty()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+	ptrArguments[1] = gdnative.NewPointerFromString(property)
+	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoAddUndoProperty.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Add an 'undo' reference that will be erased if the 'undo' history is lost. This is useful mostly for nodes removed with the 'do' call (not the 'undo' call!).
+		Args: [{ false object Object}], Returns: void
+*/
+func (o *UndoRedo) AddUndoReference(object godot.ObjectImplementer) {
+	//log.Println("Calling UndoRedo.AddUndoReference()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoAddUndoReference.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Clear the undo/redo history and associated references.
+		Args: [], Returns: void
+*/
+func (o *UndoRedo) ClearHistory() {
+	//log.Println("Calling UndoRedo.ClearHistory()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoClearHistory.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Commit the action. All 'do' methods/properties are called/set when this function is called.
+		Args: [], Returns: void
+*/
+func (o *UndoRedo) CommitAction() {
+	//log.Println("Calling UndoRedo.CommitAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoCommitAction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Create a new action. After this is called, do all your calls to [method add_do_method], [method add_undo_method], [method add_do_property] and [method add_undo_property].
+		Args: [{ false name String} {0 true merge_mode enum.UndoRedo::MergeMode}], Returns: void
+*/
+func (o *UndoRedo) CreateAction(name gdnative.String, mergeMode UndoRedoMergeMode) {
+	//log.Println("Calling UndoRedo.CreateAction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(mergeMode))
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoCreateAction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Get the name of the current action.
+		Args: [], Returns: String
+*/
+func (o *UndoRedo) GetCurrentActionName() gdnative.String {
+	//log.Println("Calling UndoRedo.GetCurrentActionName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoGetCurrentActionName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Get the version, each time a new action is committed, the version number of the UndoRedo is increased automatically. This is useful mostly to check if something changed from a saved version.
+		Args: [], Returns: int
+*/
+func (o *UndoRedo) GetVersion() gdnative.Int {
+	//log.Println("Calling UndoRedo.GetVersion()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoGetVersion.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [], Returns: void
+*/
+func (o *UndoRedo) Redo() {
+	//log.Println("Calling UndoRedo.Redo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoRedo.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [], Returns: void
+*/
+func (o *UndoRedo) Undo() {
+	//log.Println("Calling UndoRedo.Undo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindUndoRedoUndo.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// CreateActionWithDefaults will call CreateAction using the default values for: merge_mode.
+func (o *UndoRedo) CreateActionWithDefaults(name gdnative.String) {
+	o.CreateAction(name, 0)
+}
+
+// UndoRedoImplementer is an interface that implements the methods
+// of the UndoRedo class.
+type UndoRedoImplementer interface {
+	godot.ObjectImplementer
+	AddDoMethod(object godot.ObjectImplementer, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	AddDoProperty(object godot.ObjectImplementer, property gdnative.String, value gdnative.Variant)
+	AddDoReference(object godot.ObjectImplementer)
+	AddUndoMethod(object godot.ObjectImplementer, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	AddUndoProperty(object godot.ObjectImplementer, property gdnative.String, value gdnative.Variant)
+	AddUndoReference(object godot.ObjectImplementer)
+	ClearHistory()
+	CommitAction()
+	CreateAction(name gdnative.String, mergeMode UndoRedoMergeMode)
+	GetCurrentActionName() gdnative.String
+	GetVersion() gdnative.Int
+	Redo()
+	Undo()
+	CreateActionWithDefaults(name gdnative.String)
+}
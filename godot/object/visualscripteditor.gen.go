@@ -1,7 +1,8 @@
-package godot
+package object
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +14,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewvisualScriptEditorFromPointer(ptr gdnative.Pointer) visualScriptEditor {
-func new_VisualScriptEditorFromPointer(ptr gdnative.Pointer) visualScriptEditor {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := visualScriptEditor{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonVisualScriptEditor() *visualScriptEditor {
 	return &visualScriptEditor{}
 }
 
 /*
-   Undocumented
+Undocumented
 */
 var VisualScriptEditor = newSingletonVisualScriptEditor()
 
@@ -35,7 +27,7 @@ var VisualScriptEditor = newSingletonVisualScriptEditor()
 Undocumented
 */
 type visualScriptEditor struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,11 +48,18 @@ func (o *visualScriptEditor) BaseClass() string {
 	return "_VisualScriptEditor"
 }
 
+// Method binds of the VisualScriptEditor class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptEditorAddCustomNode    = gdnative.NewLazyMethodBind("_VisualScriptEditor", "add_custom_node")
+	methodBindVisualScriptEditorRemoveCustomNode = gdnative.NewLazyMethodBind("_VisualScriptEditor", "remove_custom_node")
+)
+
 /*
-        Undocumented
-	Args: [{ false name String} { false category String} { false script Script}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false category String} { false script Script}], Returns: void
 */
-func (o *visualScriptEditor) AddCustomNode(name gdnative.String, category gdnative.String, script ScriptImplementer) {
+func (o *visualScriptEditor) AddCustomNode(name gdnative.String, category gdnative.String, script godot.ScriptImplementer) {
 	o.ensureSingleton()
 	//log.Println("Calling _VisualScriptEditor.AddCustomNode()")
 
@@ -68,10 +67,10 @@ func (o *visualScriptEditor) AddCustomNode(name gdnative.String, category gdnati
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 	ptrArguments[1] = gdnative.NewPointerFromString(category)
-	ptrArguments[2] = gdnative.NewPointerFromObject(script.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(script))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_VisualScriptEditor", "add_custom_node")
+	methodBind := methodBindVisualScriptEditorAddCustomNode.Get()
 
 	// Call the parent method.
 	// void
@@ -81,8 +80,8 @@ func (o *visualScriptEditor) AddCustomNode(name gdnative.String, category gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false category String}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false category String}], Returns: void
 */
 func (o *visualScriptEditor) RemoveCustomNode(name gdnative.String, category gdnative.String) {
 	o.ensureSingleton()
@@ -94,7 +93,7 @@ func (o *visualScriptEditor) RemoveCustomNode(name gdnative.String, category gdn
 	ptrArguments[1] = gdnative.NewPointerFromString(category)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_VisualScriptEditor", "remove_custom_node")
+	methodBind := methodBindVisualScriptEditorRemoveCustomNode.Get()
 
 	// Call the parent method.
 	// void
@@ -103,10 +102,35 @@ func (o *visualScriptEditor) RemoveCustomNode(name gdnative.String, category gdn
 
 }
 
+// Signals of the VisualScriptEditor class.
+const (
+	VisualScriptEditorSignalCustomNodesUpdated gdnative.String = "custom_nodes_updated"
+)
+
+// ConnectCustomNodesUpdated will connect the "custom_nodes_updated" signal to the given method of the target object.
+func (o *visualScriptEditor) ConnectCustomNodesUpdated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisualScriptEditorSignalCustomNodesUpdated, target, method, binds, 0)
+}
+
+// EmitCustomNodesUpdated will emit the "custom_nodes_updated" signal.
+func (o *visualScriptEditor) EmitCustomNodesUpdated() error {
+	o.ensureSingleton()
+
+	ret, err := o.EmitSignal(VisualScriptEditorSignalCustomNodesUpdated)
+	ret.Destroy()
+	return err
+}
+
 // VisualScriptEditorImplementer is an interface that implements the methods
 // of the VisualScriptEditor class.
 type VisualScriptEditorImplementer interface {
-	ObjectImplementer
-	AddCustomNode(name gdnative.String, category gdnative.String, script ScriptImplementer)
+	godot.ObjectImplementer
+	AddCustomNode(name gdnative.String, category gdnative.String, script godot.ScriptImplementer)
 	RemoveCustomNode(name gdnative.String, category gdnative.String)
+	ConnectCustomNodesUpdated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCustomNodesUpdated() error
 }
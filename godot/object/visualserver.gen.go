@@ -1,7 +1,10 @@
-package godot
+package object
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -40,6 +43,104 @@ const (
 	VisualServerArrayFormatWeights     VisualServerArrayFormat = 128
 )
 
+// String will return the Godot name of the VisualServerArrayFormat value.
+func (e VisualServerArrayFormat) String() string {
+	switch e {
+	case VisualServerArrayCompressBones:
+		return "ARRAY_COMPRESS_BONES"
+	case VisualServerArrayCompressColor:
+		return "ARRAY_COMPRESS_COLOR"
+	case VisualServerArrayCompressDefault:
+		return "ARRAY_COMPRESS_DEFAULT"
+	case VisualServerArrayCompressIndex:
+		return "ARRAY_COMPRESS_INDEX"
+	case VisualServerArrayCompressNormal:
+		return "ARRAY_COMPRESS_NORMAL"
+	case VisualServerArrayCompressTangent:
+		return "ARRAY_COMPRESS_TANGENT"
+	case VisualServerArrayCompressTexUv:
+		return "ARRAY_COMPRESS_TEX_UV"
+	case VisualServerArrayCompressTexUv2:
+		return "ARRAY_COMPRESS_TEX_UV2"
+	case VisualServerArrayCompressVertex:
+		return "ARRAY_COMPRESS_VERTEX"
+	case VisualServerArrayCompressWeights:
+		return "ARRAY_COMPRESS_WEIGHTS"
+	case VisualServerArrayFlagUse16BitBones:
+		return "ARRAY_FLAG_USE_16_BIT_BONES"
+	case VisualServerArrayFlagUse2DVertices:
+		return "ARRAY_FLAG_USE_2D_VERTICES"
+	case VisualServerArrayFormatBones:
+		return "ARRAY_FORMAT_BONES"
+	case VisualServerArrayFormatColor:
+		return "ARRAY_FORMAT_COLOR"
+	case VisualServerArrayFormatIndex:
+		return "ARRAY_FORMAT_INDEX"
+	case VisualServerArrayFormatNormal:
+		return "ARRAY_FORMAT_NORMAL"
+	case VisualServerArrayFormatTangent:
+		return "ARRAY_FORMAT_TANGENT"
+	case VisualServerArrayFormatTexUv:
+		return "ARRAY_FORMAT_TEX_UV"
+	case VisualServerArrayFormatTexUv2:
+		return "ARRAY_FORMAT_TEX_UV2"
+	case VisualServerArrayFormatVertex:
+		return "ARRAY_FORMAT_VERTEX"
+	case VisualServerArrayFormatWeights:
+		return "ARRAY_FORMAT_WEIGHTS"
+	}
+	return fmt.Sprintf("VisualServerArrayFormat(%d)", int(e))
+}
+
+// ParseVisualServerArrayFormat will return the VisualServerArrayFormat value with the given Godot name.
+func ParseVisualServerArrayFormat(name string) (VisualServerArrayFormat, error) {
+	switch name {
+	case "ARRAY_COMPRESS_BONES":
+		return VisualServerArrayCompressBones, nil
+	case "ARRAY_COMPRESS_COLOR":
+		return VisualServerArrayCompressColor, nil
+	case "ARRAY_COMPRESS_DEFAULT":
+		return VisualServerArrayCompressDefault, nil
+	case "ARRAY_COMPRESS_INDEX":
+		return VisualServerArrayCompressIndex, nil
+	case "ARRAY_COMPRESS_NORMAL":
+		return VisualServerArrayCompressNormal, nil
+	case "ARRAY_COMPRESS_TANGENT":
+		return VisualServerArrayCompressTangent, nil
+	case "ARRAY_COMPRESS_TEX_UV":
+		return VisualServerArrayCompressTexUv, nil
+	case "ARRAY_COMPRESS_TEX_UV2":
+		return VisualServerArrayCompressTexUv2, nil
+	case "ARRAY_COMPRESS_VERTEX":
+		return VisualServerArrayCompressVertex, nil
+	case "ARRAY_COMPRESS_WEIGHTS":
+		return VisualServerArrayCompressWeights, nil
+	case "ARRAY_FLAG_USE_16_BIT_BONES":
+		return VisualServerArrayFlagUse16BitBones, nil
+	case "ARRAY_FLAG_USE_2D_VERTICES":
+		return VisualServerArrayFlagUse2DVertices, nil
+	case "ARRAY_FORMAT_BONES":
+		return VisualServerArrayFormatBones, nil
+	case "ARRAY_FORMAT_COLOR":
+		return VisualServerArrayFormatColor, nil
+	case "ARRAY_FORMAT_INDEX":
+		return VisualServerArrayFormatIndex, nil
+	case "ARRAY_FORMAT_NORMAL":
+		return VisualServerArrayFormatNormal, nil
+	case "ARRAY_FORMAT_TANGENT":
+		return VisualServerArrayFormatTangent, nil
+	case "ARRAY_FORMAT_TEX_UV":
+		return VisualServerArrayFormatTexUv, nil
+	case "ARRAY_FORMAT_TEX_UV2":
+		return VisualServerArrayFormatTexUv2, nil
+	case "ARRAY_FORMAT_VERTEX":
+		return VisualServerArrayFormatVertex, nil
+	case "ARRAY_FORMAT_WEIGHTS":
+		return VisualServerArrayFormatWeights, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerArrayFormat value %q", name)
+}
+
 // VisualServerArrayType is an enum for ArrayType values.
 type VisualServerArrayType int
 
@@ -56,6 +157,60 @@ const (
 	VisualServerArrayWeights VisualServerArrayType = 7
 )
 
+// String will return the Godot name of the VisualServerArrayType value.
+func (e VisualServerArrayType) String() string {
+	switch e {
+	case VisualServerArrayBones:
+		return "ARRAY_BONES"
+	case VisualServerArrayColor:
+		return "ARRAY_COLOR"
+	case VisualServerArrayIndex:
+		return "ARRAY_INDEX"
+	case VisualServerArrayMax:
+		return "ARRAY_MAX"
+	case VisualServerArrayNormal:
+		return "ARRAY_NORMAL"
+	case VisualServerArrayTangent:
+		return "ARRAY_TANGENT"
+	case VisualServerArrayTexUv:
+		return "ARRAY_TEX_UV"
+	case VisualServerArrayTexUv2:
+		return "ARRAY_TEX_UV2"
+	case VisualServerArrayVertex:
+		return "ARRAY_VERTEX"
+	case VisualServerArrayWeights:
+		return "ARRAY_WEIGHTS"
+	}
+	return fmt.Sprintf("VisualServerArrayType(%d)", int(e))
+}
+
+// ParseVisualServerArrayType will return the VisualServerArrayType value with the given Godot name.
+func ParseVisualServerArrayType(name string) (VisualServerArrayType, error) {
+	switch name {
+	case "ARRAY_BONES":
+		return VisualServerArrayBones, nil
+	case "ARRAY_COLOR":
+		return VisualServerArrayColor, nil
+	case "ARRAY_INDEX":
+		return VisualServerArrayIndex, nil
+	case "ARRAY_MAX":
+		return VisualServerArrayMax, nil
+	case "ARRAY_NORMAL":
+		return VisualServerArrayNormal, nil
+	case "ARRAY_TANGENT":
+		return VisualServerArrayTangent, nil
+	case "ARRAY_TEX_UV":
+		return VisualServerArrayTexUv, nil
+	case "ARRAY_TEX_UV2":
+		return VisualServerArrayTexUv2, nil
+	case "ARRAY_VERTEX":
+		return VisualServerArrayVertex, nil
+	case "ARRAY_WEIGHTS":
+		return VisualServerArrayWeights, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerArrayType value %q", name)
+}
+
 // VisualServerBlendShapeMode is an enum for BlendShapeMode values.
 type VisualServerBlendShapeMode int
 
@@ -64,6 +219,28 @@ const (
 	VisualServerBlendShapeModeRelative   VisualServerBlendShapeMode = 1
 )
 
+// String will return the Godot name of the VisualServerBlendShapeMode value.
+func (e VisualServerBlendShapeMode) String() string {
+	switch e {
+	case VisualServerBlendShapeModeNormalized:
+		return "BLEND_SHAPE_MODE_NORMALIZED"
+	case VisualServerBlendShapeModeRelative:
+		return "BLEND_SHAPE_MODE_RELATIVE"
+	}
+	return fmt.Sprintf("VisualServerBlendShapeMode(%d)", int(e))
+}
+
+// ParseVisualServerBlendShapeMode will return the VisualServerBlendShapeMode value with the given Godot name.
+func ParseVisualServerBlendShapeMode(name string) (VisualServerBlendShapeMode, error) {
+	switch name {
+	case "BLEND_SHAPE_MODE_NORMALIZED":
+		return VisualServerBlendShapeModeNormalized, nil
+	case "BLEND_SHAPE_MODE_RELATIVE":
+		return VisualServerBlendShapeModeRelative, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerBlendShapeMode value %q", name)
+}
+
 // VisualServerCanvasLightMode is an enum for CanvasLightMode values.
 type VisualServerCanvasLightMode int
 
@@ -74,6 +251,36 @@ const (
 	VisualServerCanvasLightModeSub  VisualServerCanvasLightMode = 1
 )
 
+// String will return the Godot name of the VisualServerCanvasLightMode value.
+func (e VisualServerCanvasLightMode) String() string {
+	switch e {
+	case VisualServerCanvasLightModeAdd:
+		return "CANVAS_LIGHT_MODE_ADD"
+	case VisualServerCanvasLightModeMask:
+		return "CANVAS_LIGHT_MODE_MASK"
+	case VisualServerCanvasLightModeMix:
+		return "CANVAS_LIGHT_MODE_MIX"
+	case VisualServerCanvasLightModeSub:
+		return "CANVAS_LIGHT_MODE_SUB"
+	}
+	return fmt.Sprintf("VisualServerCanvasLightMode(%d)", int(e))
+}
+
+// ParseVisualServerCanvasLightMode will return the VisualServerCanvasLightMode value with the given Godot name.
+func ParseVisualServerCanvasLightMode(name string) (VisualServerCanvasLightMode, error) {
+	switch name {
+	case "CANVAS_LIGHT_MODE_ADD":
+		return VisualServerCanvasLightModeAdd, nil
+	case "CANVAS_LIGHT_MODE_MASK":
+		return VisualServerCanvasLightModeMask, nil
+	case "CANVAS_LIGHT_MODE_MIX":
+		return VisualServerCanvasLightModeMix, nil
+	case "CANVAS_LIGHT_MODE_SUB":
+		return VisualServerCanvasLightModeSub, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerCanvasLightMode value %q", name)
+}
+
 // VisualServerCanvasLightShadowFilter is an enum for CanvasLightShadowFilter values.
 type VisualServerCanvasLightShadowFilter int
 
@@ -86,6 +293,44 @@ const (
 	VisualServerCanvasLightFilterPcf9  VisualServerCanvasLightShadowFilter = 4
 )
 
+// String will return the Godot name of the VisualServerCanvasLightShadowFilter value.
+func (e VisualServerCanvasLightShadowFilter) String() string {
+	switch e {
+	case VisualServerCanvasLightFilterNone:
+		return "CANVAS_LIGHT_FILTER_NONE"
+	case VisualServerCanvasLightFilterPcf13:
+		return "CANVAS_LIGHT_FILTER_PCF13"
+	case VisualServerCanvasLightFilterPcf3:
+		return "CANVAS_LIGHT_FILTER_PCF3"
+	case VisualServerCanvasLightFilterPcf5:
+		return "CANVAS_LIGHT_FILTER_PCF5"
+	case VisualServerCanvasLightFilterPcf7:
+		return "CANVAS_LIGHT_FILTER_PCF7"
+	case VisualServerCanvasLightFilterPcf9:
+		return "CANVAS_LIGHT_FILTER_PCF9"
+	}
+	return fmt.Sprintf("VisualServerCanvasLightShadowFilter(%d)", int(e))
+}
+
+// ParseVisualServerCanvasLightShadowFilter will return the VisualServerCanvasLightShadowFilter value with the given Godot name.
+func ParseVisualServerCanvasLightShadowFilter(name string) (VisualServerCanvasLightShadowFilter, error) {
+	switch name {
+	case "CANVAS_LIGHT_FILTER_NONE":
+		return VisualServerCanvasLightFilterNone, nil
+	case "CANVAS_LIGHT_FILTER_PCF13":
+		return VisualServerCanvasLightFilterPcf13, nil
+	case "CANVAS_LIGHT_FILTER_PCF3":
+		return VisualServerCanvasLightFilterPcf3, nil
+	case "CANVAS_LIGHT_FILTER_PCF5":
+		return VisualServerCanvasLightFilterPcf5, nil
+	case "CANVAS_LIGHT_FILTER_PCF7":
+		return VisualServerCanvasLightFilterPcf7, nil
+	case "CANVAS_LIGHT_FILTER_PCF9":
+		return VisualServerCanvasLightFilterPcf9, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerCanvasLightShadowFilter value %q", name)
+}
+
 // VisualServerCanvasOccluderPolygonCullMode is an enum for CanvasOccluderPolygonCullMode values.
 type VisualServerCanvasOccluderPolygonCullMode int
 
@@ -95,6 +340,32 @@ const (
 	VisualServerCanvasOccluderPolygonCullDisabled         VisualServerCanvasOccluderPolygonCullMode = 0
 )
 
+// String will return the Godot name of the VisualServerCanvasOccluderPolygonCullMode value.
+func (e VisualServerCanvasOccluderPolygonCullMode) String() string {
+	switch e {
+	case VisualServerCanvasOccluderPolygonCullClockwise:
+		return "CANVAS_OCCLUDER_POLYGON_CULL_CLOCKWISE"
+	case VisualServerCanvasOccluderPolygonCullCounterClockwise:
+		return "CANVAS_OCCLUDER_POLYGON_CULL_COUNTER_CLOCKWISE"
+	case VisualServerCanvasOccluderPolygonCullDisabled:
+		return "CANVAS_OCCLUDER_POLYGON_CULL_DISABLED"
+	}
+	return fmt.Sprintf("VisualServerCanvasOccluderPolygonCullMode(%d)", int(e))
+}
+
+// ParseVisualServerCanvasOccluderPolygonCullMode will return the VisualServerCanvasOccluderPolygonCullMode value with the given Godot name.
+func ParseVisualServerCanvasOccluderPolygonCullMode(name string) (VisualServerCanvasOccluderPolygonCullMode, error) {
+	switch name {
+	case "CANVAS_OCCLUDER_POLYGON_CULL_CLOCKWISE":
+		return VisualServerCanvasOccluderPolygonCullClockwise, nil
+	case "CANVAS_OCCLUDER_POLYGON_CULL_COUNTER_CLOCKWISE":
+		return VisualServerCanvasOccluderPolygonCullCounterClockwise, nil
+	case "CANVAS_OCCLUDER_POLYGON_CULL_DISABLED":
+		return VisualServerCanvasOccluderPolygonCullDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerCanvasOccluderPolygonCullMode value %q", name)
+}
+
 // VisualServerCubeMapSide is an enum for CubeMapSide values.
 type VisualServerCubeMapSide int
 
@@ -107,6 +378,44 @@ const (
 	VisualServerCubemapTop    VisualServerCubeMapSide = 3
 )
 
+// String will return the Godot name of the VisualServerCubeMapSide value.
+func (e VisualServerCubeMapSide) String() string {
+	switch e {
+	case VisualServerCubemapBack:
+		return "CUBEMAP_BACK"
+	case VisualServerCubemapBottom:
+		return "CUBEMAP_BOTTOM"
+	case VisualServerCubemapFront:
+		return "CUBEMAP_FRONT"
+	case VisualServerCubemapLeft:
+		return "CUBEMAP_LEFT"
+	case VisualServerCubemapRight:
+		return "CUBEMAP_RIGHT"
+	case VisualServerCubemapTop:
+		return "CUBEMAP_TOP"
+	}
+	return fmt.Sprintf("VisualServerCubeMapSide(%d)", int(e))
+}
+
+// ParseVisualServerCubeMapSide will return the VisualServerCubeMapSide value with the given Godot name.
+func ParseVisualServerCubeMapSide(name string) (VisualServerCubeMapSide, error) {
+	switch name {
+	case "CUBEMAP_BACK":
+		return VisualServerCubemapBack, nil
+	case "CUBEMAP_BOTTOM":
+		return VisualServerCubemapBottom, nil
+	case "CUBEMAP_FRONT":
+		return VisualServerCubemapFront, nil
+	case "CUBEMAP_LEFT":
+		return VisualServerCubemapLeft, nil
+	case "CUBEMAP_RIGHT":
+		return VisualServerCubemapRight, nil
+	case "CUBEMAP_TOP":
+		return VisualServerCubemapTop, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerCubeMapSide value %q", name)
+}
+
 // VisualServerEnvironmentBG is an enum for EnvironmentBG values.
 type VisualServerEnvironmentBG int
 
@@ -120,6 +429,48 @@ const (
 	VisualServerEnvBgSky        VisualServerEnvironmentBG = 2
 )
 
+// String will return the Godot name of the VisualServerEnvironmentBG value.
+func (e VisualServerEnvironmentBG) String() string {
+	switch e {
+	case VisualServerEnvBgCanvas:
+		return "ENV_BG_CANVAS"
+	case VisualServerEnvBgClearColor:
+		return "ENV_BG_CLEAR_COLOR"
+	case VisualServerEnvBgColor:
+		return "ENV_BG_COLOR"
+	case VisualServerEnvBgColorSky:
+		return "ENV_BG_COLOR_SKY"
+	case VisualServerEnvBgKeep:
+		return "ENV_BG_KEEP"
+	case VisualServerEnvBgMax:
+		return "ENV_BG_MAX"
+	case VisualServerEnvBgSky:
+		return "ENV_BG_SKY"
+	}
+	return fmt.Sprintf("VisualServerEnvironmentBG(%d)", int(e))
+}
+
+// ParseVisualServerEnvironmentBG will return the VisualServerEnvironmentBG value with the given Godot name.
+func ParseVisualServerEnvironmentBG(name string) (VisualServerEnvironmentBG, error) {
+	switch name {
+	case "ENV_BG_CANVAS":
+		return VisualServerEnvBgCanvas, nil
+	case "ENV_BG_CLEAR_COLOR":
+		return VisualServerEnvBgClearColor, nil
+	case "ENV_BG_COLOR":
+		return VisualServerEnvBgColor, nil
+	case "ENV_BG_COLOR_SKY":
+		return VisualServerEnvBgColorSky, nil
+	case "ENV_BG_KEEP":
+		return VisualServerEnvBgKeep, nil
+	case "ENV_BG_MAX":
+		return VisualServerEnvBgMax, nil
+	case "ENV_BG_SKY":
+		return VisualServerEnvBgSky, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerEnvironmentBG value %q", name)
+}
+
 // VisualServerEnvironmentDOFBlurQuality is an enum for EnvironmentDOFBlurQuality values.
 type VisualServerEnvironmentDOFBlurQuality int
 
@@ -129,6 +480,32 @@ const (
 	VisualServerEnvDofBlurQualityMedium VisualServerEnvironmentDOFBlurQuality = 1
 )
 
+// String will return the Godot name of the VisualServerEnvironmentDOFBlurQuality value.
+func (e VisualServerEnvironmentDOFBlurQuality) String() string {
+	switch e {
+	case VisualServerEnvDofBlurQualityHigh:
+		return "ENV_DOF_BLUR_QUALITY_HIGH"
+	case VisualServerEnvDofBlurQualityLow:
+		return "ENV_DOF_BLUR_QUALITY_LOW"
+	case VisualServerEnvDofBlurQualityMedium:
+		return "ENV_DOF_BLUR_QUALITY_MEDIUM"
+	}
+	return fmt.Sprintf("VisualServerEnvironmentDOFBlurQuality(%d)", int(e))
+}
+
+// ParseVisualServerEnvironmentDOFBlurQuality will return the VisualServerEnvironmentDOFBlurQuality value with the given Godot name.
+func ParseVisualServerEnvironmentDOFBlurQuality(name string) (VisualServerEnvironmentDOFBlurQuality, error) {
+	switch name {
+	case "ENV_DOF_BLUR_QUALITY_HIGH":
+		return VisualServerEnvDofBlurQualityHigh, nil
+	case "ENV_DOF_BLUR_QUALITY_LOW":
+		return VisualServerEnvDofBlurQualityLow, nil
+	case "ENV_DOF_BLUR_QUALITY_MEDIUM":
+		return VisualServerEnvDofBlurQualityMedium, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerEnvironmentDOFBlurQuality value %q", name)
+}
+
 // VisualServerEnvironmentGlowBlendMode is an enum for EnvironmentGlowBlendMode values.
 type VisualServerEnvironmentGlowBlendMode int
 
@@ -139,6 +516,36 @@ const (
 	VisualServerGlowBlendModeSoftlight VisualServerEnvironmentGlowBlendMode = 2
 )
 
+// String will return the Godot name of the VisualServerEnvironmentGlowBlendMode value.
+func (e VisualServerEnvironmentGlowBlendMode) String() string {
+	switch e {
+	case VisualServerGlowBlendModeAdditive:
+		return "GLOW_BLEND_MODE_ADDITIVE"
+	case VisualServerGlowBlendModeReplace:
+		return "GLOW_BLEND_MODE_REPLACE"
+	case VisualServerGlowBlendModeScreen:
+		return "GLOW_BLEND_MODE_SCREEN"
+	case VisualServerGlowBlendModeSoftlight:
+		return "GLOW_BLEND_MODE_SOFTLIGHT"
+	}
+	return fmt.Sprintf("VisualServerEnvironmentGlowBlendMode(%d)", int(e))
+}
+
+// ParseVisualServerEnvironmentGlowBlendMode will return the VisualServerEnvironmentGlowBlendMode value with the given Godot name.
+func ParseVisualServerEnvironmentGlowBlendMode(name string) (VisualServerEnvironmentGlowBlendMode, error) {
+	switch name {
+	case "GLOW_BLEND_MODE_ADDITIVE":
+		return VisualServerGlowBlendModeAdditive, nil
+	case "GLOW_BLEND_MODE_REPLACE":
+		return VisualServerGlowBlendModeReplace, nil
+	case "GLOW_BLEND_MODE_SCREEN":
+		return VisualServerGlowBlendModeScreen, nil
+	case "GLOW_BLEND_MODE_SOFTLIGHT":
+		return VisualServerGlowBlendModeSoftlight, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerEnvironmentGlowBlendMode value %q", name)
+}
+
 // VisualServerEnvironmentSSAOBlur is an enum for EnvironmentSSAOBlur values.
 type VisualServerEnvironmentSSAOBlur int
 
@@ -149,6 +556,36 @@ const (
 	VisualServerEnvSsaoBlurDisabled VisualServerEnvironmentSSAOBlur = 0
 )
 
+// String will return the Godot name of the VisualServerEnvironmentSSAOBlur value.
+func (e VisualServerEnvironmentSSAOBlur) String() string {
+	switch e {
+	case VisualServerEnvSsaoBlur1X1:
+		return "ENV_SSAO_BLUR_1x1"
+	case VisualServerEnvSsaoBlur2X2:
+		return "ENV_SSAO_BLUR_2x2"
+	case VisualServerEnvSsaoBlur3X3:
+		return "ENV_SSAO_BLUR_3x3"
+	case VisualServerEnvSsaoBlurDisabled:
+		return "ENV_SSAO_BLUR_DISABLED"
+	}
+	return fmt.Sprintf("VisualServerEnvironmentSSAOBlur(%d)", int(e))
+}
+
+// ParseVisualServerEnvironmentSSAOBlur will return the VisualServerEnvironmentSSAOBlur value with the given Godot name.
+func ParseVisualServerEnvironmentSSAOBlur(name string) (VisualServerEnvironmentSSAOBlur, error) {
+	switch name {
+	case "ENV_SSAO_BLUR_1x1":
+		return VisualServerEnvSsaoBlur1X1, nil
+	case "ENV_SSAO_BLUR_2x2":
+		return VisualServerEnvSsaoBlur2X2, nil
+	case "ENV_SSAO_BLUR_3x3":
+		return VisualServerEnvSsaoBlur3X3, nil
+	case "ENV_SSAO_BLUR_DISABLED":
+		return VisualServerEnvSsaoBlurDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerEnvironmentSSAOBlur value %q", name)
+}
+
 // VisualServerEnvironmentSSAOQuality is an enum for EnvironmentSSAOQuality values.
 type VisualServerEnvironmentSSAOQuality int
 
@@ -158,6 +595,32 @@ const (
 	VisualServerEnvSsaoQualityMedium VisualServerEnvironmentSSAOQuality = 1
 )
 
+// String will return the Godot name of the VisualServerEnvironmentSSAOQuality value.
+func (e VisualServerEnvironmentSSAOQuality) String() string {
+	switch e {
+	case VisualServerEnvSsaoQualityHigh:
+		return "ENV_SSAO_QUALITY_HIGH"
+	case VisualServerEnvSsaoQualityLow:
+		return "ENV_SSAO_QUALITY_LOW"
+	case VisualServerEnvSsaoQualityMedium:
+		return "ENV_SSAO_QUALITY_MEDIUM"
+	}
+	return fmt.Sprintf("VisualServerEnvironmentSSAOQuality(%d)", int(e))
+}
+
+// ParseVisualServerEnvironmentSSAOQuality will return the VisualServerEnvironmentSSAOQuality value with the given Godot name.
+func ParseVisualServerEnvironmentSSAOQuality(name string) (VisualServerEnvironmentSSAOQuality, error) {
+	switch name {
+	case "ENV_SSAO_QUALITY_HIGH":
+		return VisualServerEnvSsaoQualityHigh, nil
+	case "ENV_SSAO_QUALITY_LOW":
+		return VisualServerEnvSsaoQualityLow, nil
+	case "ENV_SSAO_QUALITY_MEDIUM":
+		return VisualServerEnvSsaoQualityMedium, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerEnvironmentSSAOQuality value %q", name)
+}
+
 // VisualServerEnvironmentToneMapper is an enum for EnvironmentToneMapper values.
 type VisualServerEnvironmentToneMapper int
 
@@ -168,6 +631,36 @@ const (
 	VisualServerEnvToneMapperReinhardt VisualServerEnvironmentToneMapper = 1
 )
 
+// String will return the Godot name of the VisualServerEnvironmentToneMapper value.
+func (e VisualServerEnvironmentToneMapper) String() string {
+	switch e {
+	case VisualServerEnvToneMapperAces:
+		return "ENV_TONE_MAPPER_ACES"
+	case VisualServerEnvToneMapperFilmic:
+		return "ENV_TONE_MAPPER_FILMIC"
+	case VisualServerEnvToneMapperLinear:
+		return "ENV_TONE_MAPPER_LINEAR"
+	case VisualServerEnvToneMapperReinhardt:
+		return "ENV_TONE_MAPPER_REINHARDT"
+	}
+	return fmt.Sprintf("VisualServerEnvironmentToneMapper(%d)", int(e))
+}
+
+// ParseVisualServerEnvironmentToneMapper will return the VisualServerEnvironmentToneMapper value with the given Godot name.
+func ParseVisualServerEnvironmentToneMapper(name string) (VisualServerEnvironmentToneMapper, error) {
+	switch name {
+	case "ENV_TONE_MAPPER_ACES":
+		return VisualServerEnvToneMapperAces, nil
+	case "ENV_TONE_MAPPER_FILMIC":
+		return VisualServerEnvToneMapperFilmic, nil
+	case "ENV_TONE_MAPPER_LINEAR":
+		return VisualServerEnvToneMapperLinear, nil
+	case "ENV_TONE_MAPPER_REINHARDT":
+		return VisualServerEnvToneMapperReinhardt, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerEnvironmentToneMapper value %q", name)
+}
+
 // VisualServerFeatures is an enum for Features values.
 type VisualServerFeatures int
 
@@ -176,6 +669,28 @@ const (
 	VisualServerFeatureShaders       VisualServerFeatures = 0
 )
 
+// String will return the Godot name of the VisualServerFeatures value.
+func (e VisualServerFeatures) String() string {
+	switch e {
+	case VisualServerFeatureMultithreaded:
+		return "FEATURE_MULTITHREADED"
+	case VisualServerFeatureShaders:
+		return "FEATURE_SHADERS"
+	}
+	return fmt.Sprintf("VisualServerFeatures(%d)", int(e))
+}
+
+// ParseVisualServerFeatures will return the VisualServerFeatures value with the given Godot name.
+func ParseVisualServerFeatures(name string) (VisualServerFeatures, error) {
+	switch name {
+	case "FEATURE_MULTITHREADED":
+		return VisualServerFeatureMultithreaded, nil
+	case "FEATURE_SHADERS":
+		return VisualServerFeatureShaders, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerFeatures value %q", name)
+}
+
 // VisualServerInstanceFlags is an enum for InstanceFlags values.
 type VisualServerInstanceFlags int
 
@@ -184,6 +699,28 @@ const (
 	VisualServerInstanceFlagUseBakedLight VisualServerInstanceFlags = 0
 )
 
+// String will return the Godot name of the VisualServerInstanceFlags value.
+func (e VisualServerInstanceFlags) String() string {
+	switch e {
+	case VisualServerInstanceFlagMax:
+		return "INSTANCE_FLAG_MAX"
+	case VisualServerInstanceFlagUseBakedLight:
+		return "INSTANCE_FLAG_USE_BAKED_LIGHT"
+	}
+	return fmt.Sprintf("VisualServerInstanceFlags(%d)", int(e))
+}
+
+// ParseVisualServerInstanceFlags will return the VisualServerInstanceFlags value with the given Godot name.
+func ParseVisualServerInstanceFlags(name string) (VisualServerInstanceFlags, error) {
+	switch name {
+	case "INSTANCE_FLAG_MAX":
+		return VisualServerInstanceFlagMax, nil
+	case "INSTANCE_FLAG_USE_BAKED_LIGHT":
+		return VisualServerInstanceFlagUseBakedLight, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerInstanceFlags value %q", name)
+}
+
 // VisualServerInstanceType is an enum for InstanceType values.
 type VisualServerInstanceType int
 
@@ -201,6 +738,64 @@ const (
 	VisualServerInstanceReflectionProbe VisualServerInstanceType = 6
 )
 
+// String will return the Godot name of the VisualServerInstanceType value.
+func (e VisualServerInstanceType) String() string {
+	switch e {
+	case VisualServerInstanceGeometryMask:
+		return "INSTANCE_GEOMETRY_MASK"
+	case VisualServerInstanceGiProbe:
+		return "INSTANCE_GI_PROBE"
+	case VisualServerInstanceImmediate:
+		return "INSTANCE_IMMEDIATE"
+	case VisualServerInstanceLight:
+		return "INSTANCE_LIGHT"
+	case VisualServerInstanceLightmapCapture:
+		return "INSTANCE_LIGHTMAP_CAPTURE"
+	case VisualServerInstanceMax:
+		return "INSTANCE_MAX"
+	case VisualServerInstanceMesh:
+		return "INSTANCE_MESH"
+	case VisualServerInstanceMultimesh:
+		return "INSTANCE_MULTIMESH"
+	case VisualServerInstanceNone:
+		return "INSTANCE_NONE"
+	case VisualServerInstanceParticles:
+		return "INSTANCE_PARTICLES"
+	case VisualServerInstanceReflectionProbe:
+		return "INSTANCE_REFLECTION_PROBE"
+	}
+	return fmt.Sprintf("VisualServerInstanceType(%d)", int(e))
+}
+
+// ParseVisualServerInstanceType will return the VisualServerInstanceType value with the given Godot name.
+func ParseVisualServerInstanceType(name string) (VisualServerInstanceType, error) {
+	switch name {
+	case "INSTANCE_GEOMETRY_MASK":
+		return VisualServerInstanceGeometryMask, nil
+	case "INSTANCE_GI_PROBE":
+		return VisualServerInstanceGiProbe, nil
+	case "INSTANCE_IMMEDIATE":
+		return VisualServerInstanceImmediate, nil
+	case "INSTANCE_LIGHT":
+		return VisualServerInstanceLight, nil
+	case "INSTANCE_LIGHTMAP_CAPTURE":
+		return VisualServerInstanceLightmapCapture, nil
+	case "INSTANCE_MAX":
+		return VisualServerInstanceMax, nil
+	case "INSTANCE_MESH":
+		return VisualServerInstanceMesh, nil
+	case "INSTANCE_MULTIMESH":
+		return VisualServerInstanceMultimesh, nil
+	case "INSTANCE_NONE":
+		return VisualServerInstanceNone, nil
+	case "INSTANCE_PARTICLES":
+		return VisualServerInstanceParticles, nil
+	case "INSTANCE_REFLECTION_PROBE":
+		return VisualServerInstanceReflectionProbe, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerInstanceType value %q", name)
+}
+
 // VisualServerLightDirectionalShadowDepthRangeMode is an enum for LightDirectionalShadowDepthRangeMode values.
 type VisualServerLightDirectionalShadowDepthRangeMode int
 
@@ -209,6 +804,28 @@ const (
 	VisualServerLightDirectionalShadowDepthRangeStable    VisualServerLightDirectionalShadowDepthRangeMode = 0
 )
 
+// String will return the Godot name of the VisualServerLightDirectionalShadowDepthRangeMode value.
+func (e VisualServerLightDirectionalShadowDepthRangeMode) String() string {
+	switch e {
+	case VisualServerLightDirectionalShadowDepthRangeOptimized:
+		return "LIGHT_DIRECTIONAL_SHADOW_DEPTH_RANGE_OPTIMIZED"
+	case VisualServerLightDirectionalShadowDepthRangeStable:
+		return "LIGHT_DIRECTIONAL_SHADOW_DEPTH_RANGE_STABLE"
+	}
+	return fmt.Sprintf("VisualServerLightDirectionalShadowDepthRangeMode(%d)", int(e))
+}
+
+// ParseVisualServerLightDirectionalShadowDepthRangeMode will return the VisualServerLightDirectionalShadowDepthRangeMode value with the given Godot name.
+func ParseVisualServerLightDirectionalShadowDepthRangeMode(name string) (VisualServerLightDirectionalShadowDepthRangeMode, error) {
+	switch name {
+	case "LIGHT_DIRECTIONAL_SHADOW_DEPTH_RANGE_OPTIMIZED":
+		return VisualServerLightDirectionalShadowDepthRangeOptimized, nil
+	case "LIGHT_DIRECTIONAL_SHADOW_DEPTH_RANGE_STABLE":
+		return VisualServerLightDirectionalShadowDepthRangeStable, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerLightDirectionalShadowDepthRangeMode value %q", name)
+}
+
 // VisualServerLightDirectionalShadowMode is an enum for LightDirectionalShadowMode values.
 type VisualServerLightDirectionalShadowMode int
 
@@ -218,6 +835,32 @@ const (
 	VisualServerLightDirectionalShadowParallel4Splits VisualServerLightDirectionalShadowMode = 2
 )
 
+// String will return the Godot name of the VisualServerLightDirectionalShadowMode value.
+func (e VisualServerLightDirectionalShadowMode) String() string {
+	switch e {
+	case VisualServerLightDirectionalShadowOrthogonal:
+		return "LIGHT_DIRECTIONAL_SHADOW_ORTHOGONAL"
+	case VisualServerLightDirectionalShadowParallel2Splits:
+		return "LIGHT_DIRECTIONAL_SHADOW_PARALLEL_2_SPLITS"
+	case VisualServerLightDirectionalShadowParallel4Splits:
+		return "LIGHT_DIRECTIONAL_SHADOW_PARALLEL_4_SPLITS"
+	}
+	return fmt.Sprintf("VisualServerLightDirectionalShadowMode(%d)", int(e))
+}
+
+// ParseVisualServerLightDirectionalShadowMode will return the VisualServerLightDirectionalShadowMode value with the given Godot name.
+func ParseVisualServerLightDirectionalShadowMode(name string) (VisualServerLightDirectionalShadowMode, error) {
+	switch name {
+	case "LIGHT_DIRECTIONAL_SHADOW_ORTHOGONAL":
+		return VisualServerLightDirectionalShadowOrthogonal, nil
+	case "LIGHT_DIRECTIONAL_SHADOW_PARALLEL_2_SPLITS":
+		return VisualServerLightDirectionalShadowParallel2Splits, nil
+	case "LIGHT_DIRECTIONAL_SHADOW_PARALLEL_4_SPLITS":
+		return VisualServerLightDirectionalShadowParallel4Splits, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerLightDirectionalShadowMode value %q", name)
+}
+
 // VisualServerLightOmniShadowDetail is an enum for LightOmniShadowDetail values.
 type VisualServerLightOmniShadowDetail int
 
@@ -226,6 +869,28 @@ const (
 	VisualServerLightOmniShadowDetailVertical   VisualServerLightOmniShadowDetail = 0
 )
 
+// String will return the Godot name of the VisualServerLightOmniShadowDetail value.
+func (e VisualServerLightOmniShadowDetail) String() string {
+	switch e {
+	case VisualServerLightOmniShadowDetailHorizontal:
+		return "LIGHT_OMNI_SHADOW_DETAIL_HORIZONTAL"
+	case VisualServerLightOmniShadowDetailVertical:
+		return "LIGHT_OMNI_SHADOW_DETAIL_VERTICAL"
+	}
+	return fmt.Sprintf("VisualServerLightOmniShadowDetail(%d)", int(e))
+}
+
+// ParseVisualServerLightOmniShadowDetail will return the VisualServerLightOmniShadowDetail value with the given Godot name.
+func ParseVisualServerLightOmniShadowDetail(name string) (VisualServerLightOmniShadowDetail, error) {
+	switch name {
+	case "LIGHT_OMNI_SHADOW_DETAIL_HORIZONTAL":
+		return VisualServerLightOmniShadowDetailHorizontal, nil
+	case "LIGHT_OMNI_SHADOW_DETAIL_VERTICAL":
+		return VisualServerLightOmniShadowDetailVertical, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerLightOmniShadowDetail value %q", name)
+}
+
 // VisualServerLightOmniShadowMode is an enum for LightOmniShadowMode values.
 type VisualServerLightOmniShadowMode int
 
@@ -234,6 +899,28 @@ const (
 	VisualServerLightOmniShadowDualParaboloid VisualServerLightOmniShadowMode = 0
 )
 
+// String will return the Godot name of the VisualServerLightOmniShadowMode value.
+func (e VisualServerLightOmniShadowMode) String() string {
+	switch e {
+	case VisualServerLightOmniShadowCube:
+		return "LIGHT_OMNI_SHADOW_CUBE"
+	case VisualServerLightOmniShadowDualParaboloid:
+		return "LIGHT_OMNI_SHADOW_DUAL_PARABOLOID"
+	}
+	return fmt.Sprintf("VisualServerLightOmniShadowMode(%d)", int(e))
+}
+
+// ParseVisualServerLightOmniShadowMode will return the VisualServerLightOmniShadowMode value with the given Godot name.
+func ParseVisualServerLightOmniShadowMode(name string) (VisualServerLightOmniShadowMode, error) {
+	switch name {
+	case "LIGHT_OMNI_SHADOW_CUBE":
+		return VisualServerLightOmniShadowCube, nil
+	case "LIGHT_OMNI_SHADOW_DUAL_PARABOLOID":
+		return VisualServerLightOmniShadowDualParaboloid, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerLightOmniShadowMode value %q", name)
+}
+
 // VisualServerLightParam is an enum for LightParam values.
 type VisualServerLightParam int
 
@@ -255,6 +942,80 @@ const (
 	VisualServerLightParamSpotAttenuation      VisualServerLightParam = 6
 )
 
+// String will return the Godot name of the VisualServerLightParam value.
+func (e VisualServerLightParam) String() string {
+	switch e {
+	case VisualServerLightParamAttenuation:
+		return "LIGHT_PARAM_ATTENUATION"
+	case VisualServerLightParamContactShadowSize:
+		return "LIGHT_PARAM_CONTACT_SHADOW_SIZE"
+	case VisualServerLightParamEnergy:
+		return "LIGHT_PARAM_ENERGY"
+	case VisualServerLightParamMax:
+		return "LIGHT_PARAM_MAX"
+	case VisualServerLightParamRange:
+		return "LIGHT_PARAM_RANGE"
+	case VisualServerLightParamShadowBias:
+		return "LIGHT_PARAM_SHADOW_BIAS"
+	case VisualServerLightParamShadowBiasSplitScale:
+		return "LIGHT_PARAM_SHADOW_BIAS_SPLIT_SCALE"
+	case VisualServerLightParamShadowMaxDistance:
+		return "LIGHT_PARAM_SHADOW_MAX_DISTANCE"
+	case VisualServerLightParamShadowNormalBias:
+		return "LIGHT_PARAM_SHADOW_NORMAL_BIAS"
+	case VisualServerLightParamShadowSplit1Offset:
+		return "LIGHT_PARAM_SHADOW_SPLIT_1_OFFSET"
+	case VisualServerLightParamShadowSplit2Offset:
+		return "LIGHT_PARAM_SHADOW_SPLIT_2_OFFSET"
+	case VisualServerLightParamShadowSplit3Offset:
+		return "LIGHT_PARAM_SHADOW_SPLIT_3_OFFSET"
+	case VisualServerLightParamSpecular:
+		return "LIGHT_PARAM_SPECULAR"
+	case VisualServerLightParamSpotAngle:
+		return "LIGHT_PARAM_SPOT_ANGLE"
+	case VisualServerLightParamSpotAttenuation:
+		return "LIGHT_PARAM_SPOT_ATTENUATION"
+	}
+	return fmt.Sprintf("VisualServerLightParam(%d)", int(e))
+}
+
+// ParseVisualServerLightParam will return the VisualServerLightParam value with the given Godot name.
+func ParseVisualServerLightParam(name string) (VisualServerLightParam, error) {
+	switch name {
+	case "LIGHT_PARAM_ATTENUATION":
+		return VisualServerLightParamAttenuation, nil
+	case "LIGHT_PARAM_CONTACT_SHADOW_SIZE":
+		return VisualServerLightParamContactShadowSize, nil
+	case "LIGHT_PARAM_ENERGY":
+		return VisualServerLightParamEnergy, nil
+	case "LIGHT_PARAM_MAX":
+		return VisualServerLightParamMax, nil
+	case "LIGHT_PARAM_RANGE":
+		return VisualServerLightParamRange, nil
+	case "LIGHT_PARAM_SHADOW_BIAS":
+		return VisualServerLightParamShadowBias, nil
+	case "LIGHT_PARAM_SHADOW_BIAS_SPLIT_SCALE":
+		return VisualServerLightParamShadowBiasSplitScale, nil
+	case "LIGHT_PARAM_SHADOW_MAX_DISTANCE":
+		return VisualServerLightParamShadowMaxDistance, nil
+	case "LIGHT_PARAM_SHADOW_NORMAL_BIAS":
+		return VisualServerLightParamShadowNormalBias, nil
+	case "LIGHT_PARAM_SHADOW_SPLIT_1_OFFSET":
+		return VisualServerLightParamShadowSplit1Offset, nil
+	case "LIGHT_PARAM_SHADOW_SPLIT_2_OFFSET":
+		return VisualServerLightParamShadowSplit2Offset, nil
+	case "LIGHT_PARAM_SHADOW_SPLIT_3_OFFSET":
+		return VisualServerLightParamShadowSplit3Offset, nil
+	case "LIGHT_PARAM_SPECULAR":
+		return VisualServerLightParamSpecular, nil
+	case "LIGHT_PARAM_SPOT_ANGLE":
+		return VisualServerLightParamSpotAngle, nil
+	case "LIGHT_PARAM_SPOT_ATTENUATION":
+		return VisualServerLightParamSpotAttenuation, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerLightParam value %q", name)
+}
+
 // VisualServerLightType is an enum for LightType values.
 type VisualServerLightType int
 
@@ -264,6 +1025,32 @@ const (
 	VisualServerLightSpot        VisualServerLightType = 2
 )
 
+// String will return the Godot name of the VisualServerLightType value.
+func (e VisualServerLightType) String() string {
+	switch e {
+	case VisualServerLightDirectional:
+		return "LIGHT_DIRECTIONAL"
+	case VisualServerLightOmni:
+		return "LIGHT_OMNI"
+	case VisualServerLightSpot:
+		return "LIGHT_SPOT"
+	}
+	return fmt.Sprintf("VisualServerLightType(%d)", int(e))
+}
+
+// ParseVisualServerLightType will return the VisualServerLightType value with the given Godot name.
+func ParseVisualServerLightType(name string) (VisualServerLightType, error) {
+	switch name {
+	case "LIGHT_DIRECTIONAL":
+		return VisualServerLightDirectional, nil
+	case "LIGHT_OMNI":
+		return VisualServerLightOmni, nil
+	case "LIGHT_SPOT":
+		return VisualServerLightSpot, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerLightType value %q", name)
+}
+
 // VisualServerMultimeshColorFormat is an enum for MultimeshColorFormat values.
 type VisualServerMultimeshColorFormat int
 
@@ -273,6 +1060,32 @@ const (
 	VisualServerMultimeshColorNone  VisualServerMultimeshColorFormat = 0
 )
 
+// String will return the Godot name of the VisualServerMultimeshColorFormat value.
+func (e VisualServerMultimeshColorFormat) String() string {
+	switch e {
+	case VisualServerMultimeshColor8Bit:
+		return "MULTIMESH_COLOR_8BIT"
+	case VisualServerMultimeshColorFloat:
+		return "MULTIMESH_COLOR_FLOAT"
+	case VisualServerMultimeshColorNone:
+		return "MULTIMESH_COLOR_NONE"
+	}
+	return fmt.Sprintf("VisualServerMultimeshColorFormat(%d)", int(e))
+}
+
+// ParseVisualServerMultimeshColorFormat will return the VisualServerMultimeshColorFormat value with the given Godot name.
+func ParseVisualServerMultimeshColorFormat(name string) (VisualServerMultimeshColorFormat, error) {
+	switch name {
+	case "MULTIMESH_COLOR_8BIT":
+		return VisualServerMultimeshColor8Bit, nil
+	case "MULTIMESH_COLOR_FLOAT":
+		return VisualServerMultimeshColorFloat, nil
+	case "MULTIMESH_COLOR_NONE":
+		return VisualServerMultimeshColorNone, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerMultimeshColorFormat value %q", name)
+}
+
 // VisualServerMultimeshTransformFormat is an enum for MultimeshTransformFormat values.
 type VisualServerMultimeshTransformFormat int
 
@@ -281,6 +1094,28 @@ const (
 	VisualServerMultimeshTransform3D VisualServerMultimeshTransformFormat = 1
 )
 
+// String will return the Godot name of the VisualServerMultimeshTransformFormat value.
+func (e VisualServerMultimeshTransformFormat) String() string {
+	switch e {
+	case VisualServerMultimeshTransform2D:
+		return "MULTIMESH_TRANSFORM_2D"
+	case VisualServerMultimeshTransform3D:
+		return "MULTIMESH_TRANSFORM_3D"
+	}
+	return fmt.Sprintf("VisualServerMultimeshTransformFormat(%d)", int(e))
+}
+
+// ParseVisualServerMultimeshTransformFormat will return the VisualServerMultimeshTransformFormat value with the given Godot name.
+func ParseVisualServerMultimeshTransformFormat(name string) (VisualServerMultimeshTransformFormat, error) {
+	switch name {
+	case "MULTIMESH_TRANSFORM_2D":
+		return VisualServerMultimeshTransform2D, nil
+	case "MULTIMESH_TRANSFORM_3D":
+		return VisualServerMultimeshTransform3D, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerMultimeshTransformFormat value %q", name)
+}
+
 // VisualServerNinePatchAxisMode is an enum for NinePatchAxisMode values.
 type VisualServerNinePatchAxisMode int
 
@@ -290,6 +1125,32 @@ const (
 	VisualServerNinePatchTileFit VisualServerNinePatchAxisMode = 2
 )
 
+// String will return the Godot name of the VisualServerNinePatchAxisMode value.
+func (e VisualServerNinePatchAxisMode) String() string {
+	switch e {
+	case VisualServerNinePatchStretch:
+		return "NINE_PATCH_STRETCH"
+	case VisualServerNinePatchTile:
+		return "NINE_PATCH_TILE"
+	case VisualServerNinePatchTileFit:
+		return "NINE_PATCH_TILE_FIT"
+	}
+	return fmt.Sprintf("VisualServerNinePatchAxisMode(%d)", int(e))
+}
+
+// ParseVisualServerNinePatchAxisMode will return the VisualServerNinePatchAxisMode value with the given Godot name.
+func ParseVisualServerNinePatchAxisMode(name string) (VisualServerNinePatchAxisMode, error) {
+	switch name {
+	case "NINE_PATCH_STRETCH":
+		return VisualServerNinePatchStretch, nil
+	case "NINE_PATCH_TILE":
+		return VisualServerNinePatchTile, nil
+	case "NINE_PATCH_TILE_FIT":
+		return VisualServerNinePatchTileFit, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerNinePatchAxisMode value %q", name)
+}
+
 // VisualServerParticlesDrawOrder is an enum for ParticlesDrawOrder values.
 type VisualServerParticlesDrawOrder int
 
@@ -299,6 +1160,32 @@ const (
 	VisualServerParticlesDrawOrderViewDepth VisualServerParticlesDrawOrder = 2
 )
 
+// String will return the Godot name of the VisualServerParticlesDrawOrder value.
+func (e VisualServerParticlesDrawOrder) String() string {
+	switch e {
+	case VisualServerParticlesDrawOrderIndex:
+		return "PARTICLES_DRAW_ORDER_INDEX"
+	case VisualServerParticlesDrawOrderLifetime:
+		return "PARTICLES_DRAW_ORDER_LIFETIME"
+	case VisualServerParticlesDrawOrderViewDepth:
+		return "PARTICLES_DRAW_ORDER_VIEW_DEPTH"
+	}
+	return fmt.Sprintf("VisualServerParticlesDrawOrder(%d)", int(e))
+}
+
+// ParseVisualServerParticlesDrawOrder will return the VisualServerParticlesDrawOrder value with the given Godot name.
+func ParseVisualServerParticlesDrawOrder(name string) (VisualServerParticlesDrawOrder, error) {
+	switch name {
+	case "PARTICLES_DRAW_ORDER_INDEX":
+		return VisualServerParticlesDrawOrderIndex, nil
+	case "PARTICLES_DRAW_ORDER_LIFETIME":
+		return VisualServerParticlesDrawOrderLifetime, nil
+	case "PARTICLES_DRAW_ORDER_VIEW_DEPTH":
+		return VisualServerParticlesDrawOrderViewDepth, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerParticlesDrawOrder value %q", name)
+}
+
 // VisualServerPrimitiveType is an enum for PrimitiveType values.
 type VisualServerPrimitiveType int
 
@@ -313,6 +1200,52 @@ const (
 	VisualServerPrimitiveTriangleStrip VisualServerPrimitiveType = 5
 )
 
+// String will return the Godot name of the VisualServerPrimitiveType value.
+func (e VisualServerPrimitiveType) String() string {
+	switch e {
+	case VisualServerPrimitiveLines:
+		return "PRIMITIVE_LINES"
+	case VisualServerPrimitiveLineLoop:
+		return "PRIMITIVE_LINE_LOOP"
+	case VisualServerPrimitiveLineStrip:
+		return "PRIMITIVE_LINE_STRIP"
+	case VisualServerPrimitiveMax:
+		return "PRIMITIVE_MAX"
+	case VisualServerPrimitivePoints:
+		return "PRIMITIVE_POINTS"
+	case VisualServerPrimitiveTriangles:
+		return "PRIMITIVE_TRIANGLES"
+	case VisualServerPrimitiveTriangleFan:
+		return "PRIMITIVE_TRIANGLE_FAN"
+	case VisualServerPrimitiveTriangleStrip:
+		return "PRIMITIVE_TRIANGLE_STRIP"
+	}
+	return fmt.Sprintf("VisualServerPrimitiveType(%d)", int(e))
+}
+
+// ParseVisualServerPrimitiveType will return the VisualServerPrimitiveType value with the given Godot name.
+func ParseVisualServerPrimitiveType(name string) (VisualServerPrimitiveType, error) {
+	switch name {
+	case "PRIMITIVE_LINES":
+		return VisualServerPrimitiveLines, nil
+	case "PRIMITIVE_LINE_LOOP":
+		return VisualServerPrimitiveLineLoop, nil
+	case "PRIMITIVE_LINE_STRIP":
+		return VisualServerPrimitiveLineStrip, nil
+	case "PRIMITIVE_MAX":
+		return VisualServerPrimitiveMax, nil
+	case "PRIMITIVE_POINTS":
+		return VisualServerPrimitivePoints, nil
+	case "PRIMITIVE_TRIANGLES":
+		return VisualServerPrimitiveTriangles, nil
+	case "PRIMITIVE_TRIANGLE_FAN":
+		return VisualServerPrimitiveTriangleFan, nil
+	case "PRIMITIVE_TRIANGLE_STRIP":
+		return VisualServerPrimitiveTriangleStrip, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerPrimitiveType value %q", name)
+}
+
 // VisualServerReflectionProbeUpdateMode is an enum for ReflectionProbeUpdateMode values.
 type VisualServerReflectionProbeUpdateMode int
 
@@ -321,6 +1254,28 @@ const (
 	VisualServerReflectionProbeUpdateOnce   VisualServerReflectionProbeUpdateMode = 0
 )
 
+// String will return the Godot name of the VisualServerReflectionProbeUpdateMode value.
+func (e VisualServerReflectionProbeUpdateMode) String() string {
+	switch e {
+	case VisualServerReflectionProbeUpdateAlways:
+		return "REFLECTION_PROBE_UPDATE_ALWAYS"
+	case VisualServerReflectionProbeUpdateOnce:
+		return "REFLECTION_PROBE_UPDATE_ONCE"
+	}
+	return fmt.Sprintf("VisualServerReflectionProbeUpdateMode(%d)", int(e))
+}
+
+// ParseVisualServerReflectionProbeUpdateMode will return the VisualServerReflectionProbeUpdateMode value with the given Godot name.
+func ParseVisualServerReflectionProbeUpdateMode(name string) (VisualServerReflectionProbeUpdateMode, error) {
+	switch name {
+	case "REFLECTION_PROBE_UPDATE_ALWAYS":
+		return VisualServerReflectionProbeUpdateAlways, nil
+	case "REFLECTION_PROBE_UPDATE_ONCE":
+		return VisualServerReflectionProbeUpdateOnce, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerReflectionProbeUpdateMode value %q", name)
+}
+
 // VisualServerRenderInfo is an enum for RenderInfo values.
 type VisualServerRenderInfo int
 
@@ -337,6 +1292,60 @@ const (
 	VisualServerInfoVideoMemUsed           VisualServerRenderInfo = 7
 )
 
+// String will return the Godot name of the VisualServerRenderInfo value.
+func (e VisualServerRenderInfo) String() string {
+	switch e {
+	case VisualServerInfoDrawCallsInFrame:
+		return "INFO_DRAW_CALLS_IN_FRAME"
+	case VisualServerInfoMaterialChangesInFrame:
+		return "INFO_MATERIAL_CHANGES_IN_FRAME"
+	case VisualServerInfoObjectsInFrame:
+		return "INFO_OBJECTS_IN_FRAME"
+	case VisualServerInfoShaderChangesInFrame:
+		return "INFO_SHADER_CHANGES_IN_FRAME"
+	case VisualServerInfoSurfaceChangesInFrame:
+		return "INFO_SURFACE_CHANGES_IN_FRAME"
+	case VisualServerInfoTextureMemUsed:
+		return "INFO_TEXTURE_MEM_USED"
+	case VisualServerInfoUsageVideoMemTotal:
+		return "INFO_USAGE_VIDEO_MEM_TOTAL"
+	case VisualServerInfoVertexMemUsed:
+		return "INFO_VERTEX_MEM_USED"
+	case VisualServerInfoVerticesInFrame:
+		return "INFO_VERTICES_IN_FRAME"
+	case VisualServerInfoVideoMemUsed:
+		return "INFO_VIDEO_MEM_USED"
+	}
+	return fmt.Sprintf("VisualServerRenderInfo(%d)", int(e))
+}
+
+// ParseVisualServerRenderInfo will return the VisualServerRenderInfo value with the given Godot name.
+func ParseVisualServerRenderInfo(name string) (VisualServerRenderInfo, error) {
+	switch name {
+	case "INFO_DRAW_CALLS_IN_FRAME":
+		return VisualServerInfoDrawCallsInFrame, nil
+	case "INFO_MATERIAL_CHANGES_IN_FRAME":
+		return VisualServerInfoMaterialChangesInFrame, nil
+	case "INFO_OBJECTS_IN_FRAME":
+		return VisualServerInfoObjectsInFrame, nil
+	case "INFO_SHADER_CHANGES_IN_FRAME":
+		return VisualServerInfoShaderChangesInFrame, nil
+	case "INFO_SURFACE_CHANGES_IN_FRAME":
+		return VisualServerInfoSurfaceChangesInFrame, nil
+	case "INFO_TEXTURE_MEM_USED":
+		return VisualServerInfoTextureMemUsed, nil
+	case "INFO_USAGE_VIDEO_MEM_TOTAL":
+		return VisualServerInfoUsageVideoMemTotal, nil
+	case "INFO_VERTEX_MEM_USED":
+		return VisualServerInfoVertexMemUsed, nil
+	case "INFO_VERTICES_IN_FRAME":
+		return VisualServerInfoVerticesInFrame, nil
+	case "INFO_VIDEO_MEM_USED":
+		return VisualServerInfoVideoMemUsed, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerRenderInfo value %q", name)
+}
+
 // VisualServerScenarioDebugMode is an enum for ScenarioDebugMode values.
 type VisualServerScenarioDebugMode int
 
@@ -347,6 +1356,36 @@ const (
 	VisualServerScenarioDebugWireframe VisualServerScenarioDebugMode = 1
 )
 
+// String will return the Godot name of the VisualServerScenarioDebugMode value.
+func (e VisualServerScenarioDebugMode) String() string {
+	switch e {
+	case VisualServerScenarioDebugDisabled:
+		return "SCENARIO_DEBUG_DISABLED"
+	case VisualServerScenarioDebugOverdraw:
+		return "SCENARIO_DEBUG_OVERDRAW"
+	case VisualServerScenarioDebugShadeless:
+		return "SCENARIO_DEBUG_SHADELESS"
+	case VisualServerScenarioDebugWireframe:
+		return "SCENARIO_DEBUG_WIREFRAME"
+	}
+	return fmt.Sprintf("VisualServerScenarioDebugMode(%d)", int(e))
+}
+
+// ParseVisualServerScenarioDebugMode will return the VisualServerScenarioDebugMode value with the given Godot name.
+func ParseVisualServerScenarioDebugMode(name string) (VisualServerScenarioDebugMode, error) {
+	switch name {
+	case "SCENARIO_DEBUG_DISABLED":
+		return VisualServerScenarioDebugDisabled, nil
+	case "SCENARIO_DEBUG_OVERDRAW":
+		return VisualServerScenarioDebugOverdraw, nil
+	case "SCENARIO_DEBUG_SHADELESS":
+		return VisualServerScenarioDebugShadeless, nil
+	case "SCENARIO_DEBUG_WIREFRAME":
+		return VisualServerScenarioDebugWireframe, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerScenarioDebugMode value %q", name)
+}
+
 // VisualServerShaderMode is an enum for ShaderMode values.
 type VisualServerShaderMode int
 
@@ -357,6 +1396,36 @@ const (
 	VisualServerShaderSpatial    VisualServerShaderMode = 0
 )
 
+// String will return the Godot name of the VisualServerShaderMode value.
+func (e VisualServerShaderMode) String() string {
+	switch e {
+	case VisualServerShaderCanvasItem:
+		return "SHADER_CANVAS_ITEM"
+	case VisualServerShaderMax:
+		return "SHADER_MAX"
+	case VisualServerShaderParticles:
+		return "SHADER_PARTICLES"
+	case VisualServerShaderSpatial:
+		return "SHADER_SPATIAL"
+	}
+	return fmt.Sprintf("VisualServerShaderMode(%d)", int(e))
+}
+
+// ParseVisualServerShaderMode will return the VisualServerShaderMode value with the given Godot name.
+func ParseVisualServerShaderMode(name string) (VisualServerShaderMode, error) {
+	switch name {
+	case "SHADER_CANVAS_ITEM":
+		return VisualServerShaderCanvasItem, nil
+	case "SHADER_MAX":
+		return VisualServerShaderMax, nil
+	case "SHADER_PARTICLES":
+		return VisualServerShaderParticles, nil
+	case "SHADER_SPATIAL":
+		return VisualServerShaderSpatial, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerShaderMode value %q", name)
+}
+
 // VisualServerShadowCastingSetting is an enum for ShadowCastingSetting values.
 type VisualServerShadowCastingSetting int
 
@@ -367,6 +1436,36 @@ const (
 	VisualServerShadowCastingSettingShadowsOnly VisualServerShadowCastingSetting = 3
 )
 
+// String will return the Godot name of the VisualServerShadowCastingSetting value.
+func (e VisualServerShadowCastingSetting) String() string {
+	switch e {
+	case VisualServerShadowCastingSettingDoubleSided:
+		return "SHADOW_CASTING_SETTING_DOUBLE_SIDED"
+	case VisualServerShadowCastingSettingOff:
+		return "SHADOW_CASTING_SETTING_OFF"
+	case VisualServerShadowCastingSettingOn:
+		return "SHADOW_CASTING_SETTING_ON"
+	case VisualServerShadowCastingSettingShadowsOnly:
+		return "SHADOW_CASTING_SETTING_SHADOWS_ONLY"
+	}
+	return fmt.Sprintf("VisualServerShadowCastingSetting(%d)", int(e))
+}
+
+// ParseVisualServerShadowCastingSetting will return the VisualServerShadowCastingSetting value with the given Godot name.
+func ParseVisualServerShadowCastingSetting(name string) (VisualServerShadowCastingSetting, error) {
+	switch name {
+	case "SHADOW_CASTING_SETTING_DOUBLE_SIDED":
+		return VisualServerShadowCastingSettingDoubleSided, nil
+	case "SHADOW_CASTING_SETTING_OFF":
+		return VisualServerShadowCastingSettingOff, nil
+	case "SHADOW_CASTING_SETTING_ON":
+		return VisualServerShadowCastingSettingOn, nil
+	case "SHADOW_CASTING_SETTING_SHADOWS_ONLY":
+		return VisualServerShadowCastingSettingShadowsOnly, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerShadowCastingSetting value %q", name)
+}
+
 // VisualServerTextureFlags is an enum for TextureFlags values.
 type VisualServerTextureFlags int
 
@@ -382,6 +1481,56 @@ const (
 	VisualServerTextureFlagUsedForStreaming  VisualServerTextureFlags = 4096
 )
 
+// String will return the Godot name of the VisualServerTextureFlags value.
+func (e VisualServerTextureFlags) String() string {
+	switch e {
+	case VisualServerTextureFlagsDefault:
+		return "TEXTURE_FLAGS_DEFAULT"
+	case VisualServerTextureFlagAnisotropicFilter:
+		return "TEXTURE_FLAG_ANISOTROPIC_FILTER"
+	case VisualServerTextureFlagConvertToLinear:
+		return "TEXTURE_FLAG_CONVERT_TO_LINEAR"
+	case VisualServerTextureFlagCubemap:
+		return "TEXTURE_FLAG_CUBEMAP"
+	case VisualServerTextureFlagFilter:
+		return "TEXTURE_FLAG_FILTER"
+	case VisualServerTextureFlagMipmaps:
+		return "TEXTURE_FLAG_MIPMAPS"
+	case VisualServerTextureFlagMirroredRepeat:
+		return "TEXTURE_FLAG_MIRRORED_REPEAT"
+	case VisualServerTextureFlagRepeat:
+		return "TEXTURE_FLAG_REPEAT"
+	case VisualServerTextureFlagUsedForStreaming:
+		return "TEXTURE_FLAG_USED_FOR_STREAMING"
+	}
+	return fmt.Sprintf("VisualServerTextureFlags(%d)", int(e))
+}
+
+// ParseVisualServerTextureFlags will return the VisualServerTextureFlags value with the given Godot name.
+func ParseVisualServerTextureFlags(name string) (VisualServerTextureFlags, error) {
+	switch name {
+	case "TEXTURE_FLAGS_DEFAULT":
+		return VisualServerTextureFlagsDefault, nil
+	case "TEXTURE_FLAG_ANISOTROPIC_FILTER":
+		return VisualServerTextureFlagAnisotropicFilter, nil
+	case "TEXTURE_FLAG_CONVERT_TO_LINEAR":
+		return VisualServerTextureFlagConvertToLinear, nil
+	case "TEXTURE_FLAG_CUBEMAP":
+		return VisualServerTextureFlagCubemap, nil
+	case "TEXTURE_FLAG_FILTER":
+		return VisualServerTextureFlagFilter, nil
+	case "TEXTURE_FLAG_MIPMAPS":
+		return VisualServerTextureFlagMipmaps, nil
+	case "TEXTURE_FLAG_MIRRORED_REPEAT":
+		return VisualServerTextureFlagMirroredRepeat, nil
+	case "TEXTURE_FLAG_REPEAT":
+		return VisualServerTextureFlagRepeat, nil
+	case "TEXTURE_FLAG_USED_FOR_STREAMING":
+		return VisualServerTextureFlagUsedForStreaming, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerTextureFlags value %q", name)
+}
+
 // VisualServerViewportClearMode is an enum for ViewportClearMode values.
 type VisualServerViewportClearMode int
 
@@ -391,6 +1540,32 @@ const (
 	VisualServerViewportClearOnlyNextFrame VisualServerViewportClearMode = 2
 )
 
+// String will return the Godot name of the VisualServerViewportClearMode value.
+func (e VisualServerViewportClearMode) String() string {
+	switch e {
+	case VisualServerViewportClearAlways:
+		return "VIEWPORT_CLEAR_ALWAYS"
+	case VisualServerViewportClearNever:
+		return "VIEWPORT_CLEAR_NEVER"
+	case VisualServerViewportClearOnlyNextFrame:
+		return "VIEWPORT_CLEAR_ONLY_NEXT_FRAME"
+	}
+	return fmt.Sprintf("VisualServerViewportClearMode(%d)", int(e))
+}
+
+// ParseVisualServerViewportClearMode will return the VisualServerViewportClearMode value with the given Godot name.
+func ParseVisualServerViewportClearMode(name string) (VisualServerViewportClearMode, error) {
+	switch name {
+	case "VIEWPORT_CLEAR_ALWAYS":
+		return VisualServerViewportClearAlways, nil
+	case "VIEWPORT_CLEAR_NEVER":
+		return VisualServerViewportClearNever, nil
+	case "VIEWPORT_CLEAR_ONLY_NEXT_FRAME":
+		return VisualServerViewportClearOnlyNextFrame, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerViewportClearMode value %q", name)
+}
+
 // VisualServerViewportDebugDraw is an enum for ViewportDebugDraw values.
 type VisualServerViewportDebugDraw int
 
@@ -401,6 +1576,36 @@ const (
 	VisualServerViewportDebugDrawWireframe VisualServerViewportDebugDraw = 3
 )
 
+// String will return the Godot name of the VisualServerViewportDebugDraw value.
+func (e VisualServerViewportDebugDraw) String() string {
+	switch e {
+	case VisualServerViewportDebugDrawDisabled:
+		return "VIEWPORT_DEBUG_DRAW_DISABLED"
+	case VisualServerViewportDebugDrawOverdraw:
+		return "VIEWPORT_DEBUG_DRAW_OVERDRAW"
+	case VisualServerViewportDebugDrawUnshaded:
+		return "VIEWPORT_DEBUG_DRAW_UNSHADED"
+	case VisualServerViewportDebugDrawWireframe:
+		return "VIEWPORT_DEBUG_DRAW_WIREFRAME"
+	}
+	return fmt.Sprintf("VisualServerViewportDebugDraw(%d)", int(e))
+}
+
+// ParseVisualServerViewportDebugDraw will return the VisualServerViewportDebugDraw value with the given Godot name.
+func ParseVisualServerViewportDebugDraw(name string) (VisualServerViewportDebugDraw, error) {
+	switch name {
+	case "VIEWPORT_DEBUG_DRAW_DISABLED":
+		return VisualServerViewportDebugDrawDisabled, nil
+	case "VIEWPORT_DEBUG_DRAW_OVERDRAW":
+		return VisualServerViewportDebugDrawOverdraw, nil
+	case "VIEWPORT_DEBUG_DRAW_UNSHADED":
+		return VisualServerViewportDebugDrawUnshaded, nil
+	case "VIEWPORT_DEBUG_DRAW_WIREFRAME":
+		return VisualServerViewportDebugDrawWireframe, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerViewportDebugDraw value %q", name)
+}
+
 // VisualServerViewportMSAA is an enum for ViewportMSAA values.
 type VisualServerViewportMSAA int
 
@@ -412,6 +1617,40 @@ const (
 	VisualServerViewportMsaaDisabled VisualServerViewportMSAA = 0
 )
 
+// String will return the Godot name of the VisualServerViewportMSAA value.
+func (e VisualServerViewportMSAA) String() string {
+	switch e {
+	case VisualServerViewportMsaa16X:
+		return "VIEWPORT_MSAA_16X"
+	case VisualServerViewportMsaa2X:
+		return "VIEWPORT_MSAA_2X"
+	case VisualServerViewportMsaa4X:
+		return "VIEWPORT_MSAA_4X"
+	case VisualServerViewportMsaa8X:
+		return "VIEWPORT_MSAA_8X"
+	case VisualServerViewportMsaaDisabled:
+		return "VIEWPORT_MSAA_DISABLED"
+	}
+	return fmt.Sprintf("VisualServerViewportMSAA(%d)", int(e))
+}
+
+// ParseVisualServerViewportMSAA will return the VisualServerViewportMSAA value with the given Godot name.
+func ParseVisualServerViewportMSAA(name string) (VisualServerViewportMSAA, error) {
+	switch name {
+	case "VIEWPORT_MSAA_16X":
+		return VisualServerViewportMsaa16X, nil
+	case "VIEWPORT_MSAA_2X":
+		return VisualServerViewportMsaa2X, nil
+	case "VIEWPORT_MSAA_4X":
+		return VisualServerViewportMsaa4X, nil
+	case "VIEWPORT_MSAA_8X":
+		return VisualServerViewportMsaa8X, nil
+	case "VIEWPORT_MSAA_DISABLED":
+		return VisualServerViewportMsaaDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerViewportMSAA value %q", name)
+}
+
 // VisualServerViewportRenderInfo is an enum for ViewportRenderInfo values.
 type VisualServerViewportRenderInfo int
 
@@ -425,6 +1664,48 @@ const (
 	VisualServerViewportRenderInfoVerticesInFrame        VisualServerViewportRenderInfo = 1
 )
 
+// String will return the Godot name of the VisualServerViewportRenderInfo value.
+func (e VisualServerViewportRenderInfo) String() string {
+	switch e {
+	case VisualServerViewportRenderInfoDrawCallsInFrame:
+		return "VIEWPORT_RENDER_INFO_DRAW_CALLS_IN_FRAME"
+	case VisualServerViewportRenderInfoMaterialChangesInFrame:
+		return "VIEWPORT_RENDER_INFO_MATERIAL_CHANGES_IN_FRAME"
+	case VisualServerViewportRenderInfoMax:
+		return "VIEWPORT_RENDER_INFO_MAX"
+	case VisualServerViewportRenderInfoObjectsInFrame:
+		return "VIEWPORT_RENDER_INFO_OBJECTS_IN_FRAME"
+	case VisualServerViewportRenderInfoShaderChangesInFrame:
+		return "VIEWPORT_RENDER_INFO_SHADER_CHANGES_IN_FRAME"
+	case VisualServerViewportRenderInfoSurfaceChangesInFrame:
+		return "VIEWPORT_RENDER_INFO_SURFACE_CHANGES_IN_FRAME"
+	case VisualServerViewportRenderInfoVerticesInFrame:
+		return "VIEWPORT_RENDER_INFO_VERTICES_IN_FRAME"
+	}
+	return fmt.Sprintf("VisualServerViewportRenderInfo(%d)", int(e))
+}
+
+// ParseVisualServerViewportRenderInfo will return the VisualServerViewportRenderInfo value with the given Godot name.
+func ParseVisualServerViewportRenderInfo(name string) (VisualServerViewportRenderInfo, error) {
+	switch name {
+	case "VIEWPORT_RENDER_INFO_DRAW_CALLS_IN_FRAME":
+		return VisualServerViewportRenderInfoDrawCallsInFrame, nil
+	case "VIEWPORT_RENDER_INFO_MATERIAL_CHANGES_IN_FRAME":
+		return VisualServerViewportRenderInfoMaterialChangesInFrame, nil
+	case "VIEWPORT_RENDER_INFO_MAX":
+		return VisualServerViewportRenderInfoMax, nil
+	case "VIEWPORT_RENDER_INFO_OBJECTS_IN_FRAME":
+		return VisualServerViewportRenderInfoObjectsInFrame, nil
+	case "VIEWPORT_RENDER_INFO_SHADER_CHANGES_IN_FRAME":
+		return VisualServerViewportRenderInfoShaderChangesInFrame, nil
+	case "VIEWPORT_RENDER_INFO_SURFACE_CHANGES_IN_FRAME":
+		return VisualServerViewportRenderInfoSurfaceChangesInFrame, nil
+	case "VIEWPORT_RENDER_INFO_VERTICES_IN_FRAME":
+		return VisualServerViewportRenderInfoVerticesInFrame, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerViewportRenderInfo value %q", name)
+}
+
 // VisualServerViewportUpdateMode is an enum for ViewportUpdateMode values.
 type VisualServerViewportUpdateMode int
 
@@ -435,6 +1716,36 @@ const (
 	VisualServerViewportUpdateWhenVisible VisualServerViewportUpdateMode = 2
 )
 
+// String will return the Godot name of the VisualServerViewportUpdateMode value.
+func (e VisualServerViewportUpdateMode) String() string {
+	switch e {
+	case VisualServerViewportUpdateAlways:
+		return "VIEWPORT_UPDATE_ALWAYS"
+	case VisualServerViewportUpdateDisabled:
+		return "VIEWPORT_UPDATE_DISABLED"
+	case VisualServerViewportUpdateOnce:
+		return "VIEWPORT_UPDATE_ONCE"
+	case VisualServerViewportUpdateWhenVisible:
+		return "VIEWPORT_UPDATE_WHEN_VISIBLE"
+	}
+	return fmt.Sprintf("VisualServerViewportUpdateMode(%d)", int(e))
+}
+
+// ParseVisualServerViewportUpdateMode will return the VisualServerViewportUpdateMode value with the given Godot name.
+func ParseVisualServerViewportUpdateMode(name string) (VisualServerViewportUpdateMode, error) {
+	switch name {
+	case "VIEWPORT_UPDATE_ALWAYS":
+		return VisualServerViewportUpdateAlways, nil
+	case "VIEWPORT_UPDATE_DISABLED":
+		return VisualServerViewportUpdateDisabled, nil
+	case "VIEWPORT_UPDATE_ONCE":
+		return VisualServerViewportUpdateOnce, nil
+	case "VIEWPORT_UPDATE_WHEN_VISIBLE":
+		return VisualServerViewportUpdateWhenVisible, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerViewportUpdateMode value %q", name)
+}
+
 // VisualServerViewportUsage is an enum for ViewportUsage values.
 type VisualServerViewportUsage int
 
@@ -445,21 +1756,54 @@ const (
 	VisualServerViewportUsage3DNoEffects  VisualServerViewportUsage = 3
 )
 
-//func NewvisualServerFromPointer(ptr gdnative.Pointer) visualServer {
-func newVisualServerFromPointer(ptr gdnative.Pointer) visualServer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := visualServer{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the VisualServerViewportUsage value.
+func (e VisualServerViewportUsage) String() string {
+	switch e {
+	case VisualServerViewportUsage2D:
+		return "VIEWPORT_USAGE_2D"
+	case VisualServerViewportUsage2DNoSampling:
+		return "VIEWPORT_USAGE_2D_NO_SAMPLING"
+	case VisualServerViewportUsage3D:
+		return "VIEWPORT_USAGE_3D"
+	case VisualServerViewportUsage3DNoEffects:
+		return "VIEWPORT_USAGE_3D_NO_EFFECTS"
+	}
+	return fmt.Sprintf("VisualServerViewportUsage(%d)", int(e))
+}
+
+// ParseVisualServerViewportUsage will return the VisualServerViewportUsage value with the given Godot name.
+func ParseVisualServerViewportUsage(name string) (VisualServerViewportUsage, error) {
+	switch name {
+	case "VIEWPORT_USAGE_2D":
+		return VisualServerViewportUsage2D, nil
+	case "VIEWPORT_USAGE_2D_NO_SAMPLING":
+		return VisualServerViewportUsage2DNoSampling, nil
+	case "VIEWPORT_USAGE_3D":
+		return VisualServerViewportUsage3D, nil
+	case "VIEWPORT_USAGE_3D_NO_EFFECTS":
+		return VisualServerViewportUsage3DNoEffects, nil
+	}
+	return 0, fmt.Errorf("invalid VisualServerViewportUsage value %q", name)
 }
 
+// Constants of the VisualServer class.
+const (
+	VisualServerArrayWeightsSize          gdnative.Int = 4
+	VisualServerCanvasItemZMax            gdnative.Int = 4096
+	VisualServerCanvasItemZMin            gdnative.Int = -4096
+	VisualServerMaterialRenderPriorityMax gdnative.Int = 127
+	VisualServerMaterialRenderPriorityMin gdnative.Int = -128
+	VisualServerMaxCursors                gdnative.Int = 8
+	VisualServerMaxGlowLevels             gdnative.Int = 7
+	VisualServerNoIndexArray              gdnative.Int = -1
+)
+
 func newSingletonVisualServer() *visualServer {
 	return &visualServer{}
 }
 
 /*
-   Server for anything visible. The visual server is the API backend for everything visible. The whole scene system mounts on it to display. The visual server is completely opaque, the internals are entirely implementation specific and cannot be accessed.
+Server for anything visible. The visual server is the API backend for everything visible. The whole scene system mounts on it to display. The visual server is completely opaque, the internals are entirely implementation specific and cannot be accessed.
 */
 var VisualServer = newSingletonVisualServer()
 
@@ -467,7 +1811,7 @@ var VisualServer = newSingletonVisualServer()
 Server for anything visible. The visual server is the API backend for everything visible. The whole scene system mounts on it to display. The visual server is completely opaque, the internals are entirely implementation specific and cannot be accessed.
 */
 type visualServer struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -488,9 +1832,359 @@ func (o *visualServer) BaseClass() string {
 	return "VisualServer"
 }
 
+// Method binds of the VisualServer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualServerBlackBarsSetImages                                 = gdnative.NewLazyMethodBind("VisualServer", "black_bars_set_images")
+	methodBindVisualServerBlackBarsSetMargins                                = gdnative.NewLazyMethodBind("VisualServer", "black_bars_set_margins")
+	methodBindVisualServerCameraCreate                                       = gdnative.NewLazyMethodBind("VisualServer", "camera_create")
+	methodBindVisualServerCameraSetCullMask                                  = gdnative.NewLazyMethodBind("VisualServer", "camera_set_cull_mask")
+	methodBindVisualServerCameraSetEnvironment                               = gdnative.NewLazyMethodBind("VisualServer", "camera_set_environment")
+	methodBindVisualServerCameraSetOrthogonal                                = gdnative.NewLazyMethodBind("VisualServer", "camera_set_orthogonal")
+	methodBindVisualServerCameraSetPerspective                               = gdnative.NewLazyMethodBind("VisualServer", "camera_set_perspective")
+	methodBindVisualServerCameraSetTransform                                 = gdnative.NewLazyMethodBind("VisualServer", "camera_set_transform")
+	methodBindVisualServerCameraSetUseVerticalAspect                         = gdnative.NewLazyMethodBind("VisualServer", "camera_set_use_vertical_aspect")
+	methodBindVisualServerCanvasCreate                                       = gdnative.NewLazyMethodBind("VisualServer", "canvas_create")
+	methodBindVisualServerCanvasItemAddCircle                                = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_circle")
+	methodBindVisualServerCanvasItemAddClipIgnore                            = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_clip_ignore")
+	methodBindVisualServerCanvasItemAddLine                                  = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_line")
+	methodBindVisualServerCanvasItemAddMesh                                  = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_mesh")
+	methodBindVisualServerCanvasItemAddMultimesh                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_multimesh")
+	methodBindVisualServerCanvasItemAddNinePatch                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_nine_patch")
+	methodBindVisualServerCanvasItemAddParticles                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_particles")
+	methodBindVisualServerCanvasItemAddPolygon                               = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_polygon")
+	methodBindVisualServerCanvasItemAddPolyline                              = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_polyline")
+	methodBindVisualServerCanvasItemAddPrimitive                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_primitive")
+	methodBindVisualServerCanvasItemAddRect                                  = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_rect")
+	methodBindVisualServerCanvasItemAddSetTransform                          = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_set_transform")
+	methodBindVisualServerCanvasItemAddTextureRect                           = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_texture_rect")
+	methodBindVisualServerCanvasItemAddTextureRectRegion                     = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_texture_rect_region")
+	methodBindVisualServerCanvasItemAddTriangleArray                         = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_add_triangle_array")
+	methodBindVisualServerCanvasItemClear                                    = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_clear")
+	methodBindVisualServerCanvasItemCreate                                   = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_create")
+	methodBindVisualServerCanvasItemSetClip                                  = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_clip")
+	methodBindVisualServerCanvasItemSetCopyToBackbuffer                      = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_copy_to_backbuffer")
+	methodBindVisualServerCanvasItemSetCustomRect                            = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_custom_rect")
+	methodBindVisualServerCanvasItemSetDistanceFieldMode                     = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_distance_field_mode")
+	methodBindVisualServerCanvasItemSetDrawBehindParent                      = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_draw_behind_parent")
+	methodBindVisualServerCanvasItemSetDrawIndex                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_draw_index")
+	methodBindVisualServerCanvasItemSetLightMask                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_light_mask")
+	methodBindVisualServerCanvasItemSetMaterial                              = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_material")
+	methodBindVisualServerCanvasItemSetModulate                              = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_modulate")
+	methodBindVisualServerCanvasItemSetParent                                = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_parent")
+	methodBindVisualServerCanvasItemSetSelfModulate                          = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_self_modulate")
+	methodBindVisualServerCanvasItemSetSortChildrenByY                       = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_sort_children_by_y")
+	methodBindVisualServerCanvasItemSetTransform                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_transform")
+	methodBindVisualServerCanvasItemSetUseParentMaterial                     = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_use_parent_material")
+	methodBindVisualServerCanvasItemSetVisible                               = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_visible")
+	methodBindVisualServerCanvasItemSetZAsRelativeToParent                   = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_z_as_relative_to_parent")
+	methodBindVisualServerCanvasItemSetZIndex                                = gdnative.NewLazyMethodBind("VisualServer", "canvas_item_set_z_index")
+	methodBindVisualServerCanvasLightAttachToCanvas                          = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_attach_to_canvas")
+	methodBindVisualServerCanvasLightCreate                                  = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_create")
+	methodBindVisualServerCanvasLightOccluderAttachToCanvas                  = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_occluder_attach_to_canvas")
+	methodBindVisualServerCanvasLightOccluderCreate                          = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_occluder_create")
+	methodBindVisualServerCanvasLightOccluderSetEnabled                      = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_occluder_set_enabled")
+	methodBindVisualServerCanvasLightOccluderSetLightMask                    = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_occluder_set_light_mask")
+	methodBindVisualServerCanvasLightOccluderSetPolygon                      = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_occluder_set_polygon")
+	methodBindVisualServerCanvasLightOccluderSetTransform                    = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_occluder_set_transform")
+	methodBindVisualServerCanvasLightSetColor                                = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_color")
+	methodBindVisualServerCanvasLightSetEnabled                              = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_enabled")
+	methodBindVisualServerCanvasLightSetEnergy                               = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_energy")
+	methodBindVisualServerCanvasLightSetHeight                               = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_height")
+	methodBindVisualServerCanvasLightSetItemCullMask                         = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_item_cull_mask")
+	methodBindVisualServerCanvasLightSetItemShadowCullMask                   = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_item_shadow_cull_mask")
+	methodBindVisualServerCanvasLightSetLayerRange                           = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_layer_range")
+	methodBindVisualServerCanvasLightSetMode                                 = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_mode")
+	methodBindVisualServerCanvasLightSetScale                                = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_scale")
+	methodBindVisualServerCanvasLightSetShadowBufferSize                     = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_shadow_buffer_size")
+	methodBindVisualServerCanvasLightSetShadowColor                          = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_shadow_color")
+	methodBindVisualServerCanvasLightSetShadowEnabled                        = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_shadow_enabled")
+	methodBindVisualServerCanvasLightSetShadowFilter                         = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_shadow_filter")
+	methodBindVisualServerCanvasLightSetShadowGradientLength                 = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_shadow_gradient_length")
+	methodBindVisualServerCanvasLightSetShadowSmooth                         = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_shadow_smooth")
+	methodBindVisualServerCanvasLightSetTexture                              = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_texture")
+	methodBindVisualServerCanvasLightSetTextureOffset                        = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_texture_offset")
+	methodBindVisualServerCanvasLightSetTransform                            = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_transform")
+	methodBindVisualServerCanvasLightSetZRange                               = gdnative.NewLazyMethodBind("VisualServer", "canvas_light_set_z_range")
+	methodBindVisualServerCanvasOccluderPolygonCreate                        = gdnative.NewLazyMethodBind("VisualServer", "canvas_occluder_polygon_create")
+	methodBindVisualServerCanvasOccluderPolygonSetCullMode                   = gdnative.NewLazyMethodBind("VisualServer", "canvas_occluder_polygon_set_cull_mode")
+	methodBindVisualServerCanvasOccluderPolygonSetShape                      = gdnative.NewLazyMethodBind("VisualServer", "canvas_occluder_polygon_set_shape")
+	methodBindVisualServerCanvasOccluderPolygonSetShapeAsLines               = gdnative.NewLazyMethodBind("VisualServer", "canvas_occluder_polygon_set_shape_as_lines")
+	methodBindVisualServerCanvasSetItemMirroring                             = gdnative.NewLazyMethodBind("VisualServer", "canvas_set_item_mirroring")
+	methodBindVisualServerCanvasSetModulate                                  = gdnative.NewLazyMethodBind("VisualServer", "canvas_set_modulate")
+	methodBindVisualServerDirectionalLightCreate                             = gdnative.NewLazyMethodBind("VisualServer", "directional_light_create")
+	methodBindVisualServerDraw                                               = gdnative.NewLazyMethodBind("VisualServer", "draw")
+	methodBindVisualServerEnvironmentCreate                                  = gdnative.NewLazyMethodBind("VisualServer", "environment_create")
+	methodBindVisualServerEnvironmentSetAdjustment                           = gdnative.NewLazyMethodBind("VisualServer", "environment_set_adjustment")
+	methodBindVisualServerEnvironmentSetAmbientLight                         = gdnative.NewLazyMethodBind("VisualServer", "environment_set_ambient_light")
+	methodBindVisualServerEnvironmentSetBackground                           = gdnative.NewLazyMethodBind("VisualServer", "environment_set_background")
+	methodBindVisualServerEnvironmentSetBgColor                              = gdnative.NewLazyMethodBind("VisualServer", "environment_set_bg_color")
+	methodBindVisualServerEnvironmentSetBgEnergy                             = gdnative.NewLazyMethodBind("VisualServer", "environment_set_bg_energy")
+	methodBindVisualServerEnvironmentSetCanvasMaxLayer                       = gdnative.NewLazyMethodBind("VisualServer", "environment_set_canvas_max_layer")
+	methodBindVisualServerEnvironmentSetDofBlurFar                           = gdnative.NewLazyMethodBind("VisualServer", "environment_set_dof_blur_far")
+	methodBindVisualServerEnvironmentSetDofBlurNear                          = gdnative.NewLazyMethodBind("VisualServer", "environment_set_dof_blur_near")
+	methodBindVisualServerEnvironmentSetFog                                  = gdnative.NewLazyMethodBind("VisualServer", "environment_set_fog")
+	methodBindVisualServerEnvironmentSetFogDepth                             = gdnative.NewLazyMethodBind("VisualServer", "environment_set_fog_depth")
+	methodBindVisualServerEnvironmentSetFogHeight                            = gdnative.NewLazyMethodBind("VisualServer", "environment_set_fog_height")
+	methodBindVisualServerEnvironmentSetGlow                                 = gdnative.NewLazyMethodBind("VisualServer", "environment_set_glow")
+	methodBindVisualServerEnvironmentSetSky                                  = gdnative.NewLazyMethodBind("VisualServer", "environment_set_sky")
+	methodBindVisualServerEnvironmentSetSkyCustomFov                         = gdnative.NewLazyMethodBind("VisualServer", "environment_set_sky_custom_fov")
+	methodBindVisualServerEnvironmentSetSsao                                 = gdnative.NewLazyMethodBind("VisualServer", "environment_set_ssao")
+	methodBindVisualServerEnvironmentSetSsr                                  = gdnative.NewLazyMethodBind("VisualServer", "environment_set_ssr")
+	methodBindVisualServerEnvironmentSetTonemap                              = gdnative.NewLazyMethodBind("VisualServer", "environment_set_tonemap")
+	methodBindVisualServerFinish                                             = gdnative.NewLazyMethodBind("VisualServer", "finish")
+	methodBindVisualServerForceDraw                                          = gdnative.NewLazyMethodBind("VisualServer", "force_draw")
+	methodBindVisualServerForceSync                                          = gdnative.NewLazyMethodBind("VisualServer", "force_sync")
+	methodBindVisualServerFreeRid                                            = gdnative.NewLazyMethodBind("VisualServer", "free_rid")
+	methodBindVisualServerGetRenderInfo                                      = gdnative.NewLazyMethodBind("VisualServer", "get_render_info")
+	methodBindVisualServerGetTestCube                                        = gdnative.NewLazyMethodBind("VisualServer", "get_test_cube")
+	methodBindVisualServerGetTestTexture                                     = gdnative.NewLazyMethodBind("VisualServer", "get_test_texture")
+	methodBindVisualServerGetWhiteTexture                                    = gdnative.NewLazyMethodBind("VisualServer", "get_white_texture")
+	methodBindVisualServerGiProbeCreate                                      = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_create")
+	methodBindVisualServerGiProbeGetBias                                     = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_bias")
+	methodBindVisualServerGiProbeGetBounds                                   = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_bounds")
+	methodBindVisualServerGiProbeGetCellSize                                 = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_cell_size")
+	methodBindVisualServerGiProbeGetDynamicData                              = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_dynamic_data")
+	methodBindVisualServerGiProbeGetDynamicRange                             = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_dynamic_range")
+	methodBindVisualServerGiProbeGetEnergy                                   = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_energy")
+	methodBindVisualServerGiProbeGetNormalBias                               = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_normal_bias")
+	methodBindVisualServerGiProbeGetPropagation                              = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_propagation")
+	methodBindVisualServerGiProbeGetToCellXform                              = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_get_to_cell_xform")
+	methodBindVisualServerGiProbeIsCompressed                                = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_is_compressed")
+	methodBindVisualServerGiProbeIsInterior                                  = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_is_interior")
+	methodBindVisualServerGiProbeSetBias                                     = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_bias")
+	methodBindVisualServerGiProbeSetBounds                                   = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_bounds")
+	methodBindVisualServerGiProbeSetCellSize                                 = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_cell_size")
+	methodBindVisualServerGiProbeSetCompress                                 = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_compress")
+	methodBindVisualServerGiProbeSetDynamicData                              = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_dynamic_data")
+	methodBindVisualServerGiProbeSetDynamicRange                             = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_dynamic_range")
+	methodBindVisualServerGiProbeSetEnergy                                   = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_energy")
+	methodBindVisualServerGiProbeSetInterior                                 = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_interior")
+	methodBindVisualServerGiProbeSetNormalBias                               = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_normal_bias")
+	methodBindVisualServerGiProbeSetPropagation                              = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_propagation")
+	methodBindVisualServerGiProbeSetToCellXform                              = gdnative.NewLazyMethodBind("VisualServer", "gi_probe_set_to_cell_xform")
+	methodBindVisualServerHasChanged                                         = gdnative.NewLazyMethodBind("VisualServer", "has_changed")
+	methodBindVisualServerHasFeature                                         = gdnative.NewLazyMethodBind("VisualServer", "has_feature")
+	methodBindVisualServerHasOsFeature                                       = gdnative.NewLazyMethodBind("VisualServer", "has_os_feature")
+	methodBindVisualServerImmediateBegin                                     = gdnative.NewLazyMethodBind("VisualServer", "immediate_begin")
+	methodBindVisualServerImmediateClear                                     = gdnative.NewLazyMethodBind("VisualServer", "immediate_clear")
+	methodBindVisualServerImmediateColor                                     = gdnative.NewLazyMethodBind("VisualServer", "immediate_color")
+	methodBindVisualServerImmediateCreate                                    = gdnative.NewLazyMethodBind("VisualServer", "immediate_create")
+	methodBindVisualServerImmediateEnd                                       = gdnative.NewLazyMethodBind("VisualServer", "immediate_end")
+	methodBindVisualServerImmediateGetMaterial                               = gdnative.NewLazyMethodBind("VisualServer", "immediate_get_material")
+	methodBindVisualServerImmediateNormal                                    = gdnative.NewLazyMethodBind("VisualServer", "immediate_normal")
+	methodBindVisualServerImmediateSetMaterial                               = gdnative.NewLazyMethodBind("VisualServer", "immediate_set_material")
+	methodBindVisualServerImmediateTangent                                   = gdnative.NewLazyMethodBind("VisualServer", "immediate_tangent")
+	methodBindVisualServerImmediateUv                                        = gdnative.NewLazyMethodBind("VisualServer", "immediate_uv")
+	methodBindVisualServerImmediateUv2                                       = gdnative.NewLazyMethodBind("VisualServer", "immediate_uv2")
+	methodBindVisualServerImmediateVertex                                    = gdnative.NewLazyMethodBind("VisualServer", "immediate_vertex")
+	methodBindVisualServerImmediateVertex2D                                  = gdnative.NewLazyMethodBind("VisualServer", "immediate_vertex_2d")
+	methodBindVisualServerInit                                               = gdnative.NewLazyMethodBind("VisualServer", "init")
+	methodBindVisualServerInstanceAttachObjectInstanceId                     = gdnative.NewLazyMethodBind("VisualServer", "instance_attach_object_instance_id")
+	methodBindVisualServerInstanceAttachSkeleton                             = gdnative.NewLazyMethodBind("VisualServer", "instance_attach_skeleton")
+	methodBindVisualServerInstanceCreate                                     = gdnative.NewLazyMethodBind("VisualServer", "instance_create")
+	methodBindVisualServerInstanceCreate2                                    = gdnative.NewLazyMethodBind("VisualServer", "instance_create2")
+	methodBindVisualServerInstanceGeometrySetAsInstanceLod                   = gdnative.NewLazyMethodBind("VisualServer", "instance_geometry_set_as_instance_lod")
+	methodBindVisualServerInstanceGeometrySetCastShadowsSetting              = gdnative.NewLazyMethodBind("VisualServer", "instance_geometry_set_cast_shadows_setting")
+	methodBindVisualServerInstanceGeometrySetDrawRange                       = gdnative.NewLazyMethodBind("VisualServer", "instance_geometry_set_draw_range")
+	methodBindVisualServerInstanceGeometrySetFlag                            = gdnative.NewLazyMethodBind("VisualServer", "instance_geometry_set_flag")
+	methodBindVisualServerInstanceGeometrySetMaterialOverride                = gdnative.NewLazyMethodBind("VisualServer", "instance_geometry_set_material_override")
+	methodBindVisualServerInstanceSetBase                                    = gdnative.NewLazyMethodBind("VisualServer", "instance_set_base")
+	methodBindVisualServerInstanceSetBlendShapeWeight                        = gdnative.NewLazyMethodBind("VisualServer", "instance_set_blend_shape_weight")
+	methodBindVisualServerInstanceSetCustomAabb                              = gdnative.NewLazyMethodBind("VisualServer", "instance_set_custom_aabb")
+	methodBindVisualServerInstanceSetExterior                                = gdnative.NewLazyMethodBind("VisualServer", "instance_set_exterior")
+	methodBindVisualServerInstanceSetExtraVisibilityMargin                   = gdnative.NewLazyMethodBind("VisualServer", "instance_set_extra_visibility_margin")
+	methodBindVisualServerInstanceSetLayerMask                               = gdnative.NewLazyMethodBind("VisualServer", "instance_set_layer_mask")
+	methodBindVisualServerInstanceSetScenario                                = gdnative.NewLazyMethodBind("VisualServer", "instance_set_scenario")
+	methodBindVisualServerInstanceSetSurfaceMaterial                         = gdnative.NewLazyMethodBind("VisualServer", "instance_set_surface_material")
+	methodBindVisualServerInstanceSetTransform                               = gdnative.NewLazyMethodBind("VisualServer", "instance_set_transform")
+	methodBindVisualServerInstanceSetUseLightmap                             = gdnative.NewLazyMethodBind("VisualServer", "instance_set_use_lightmap")
+	methodBindVisualServerInstanceSetVisible                                 = gdnative.NewLazyMethodBind("VisualServer", "instance_set_visible")
+	methodBindVisualServerInstancesCullAabb                                  = gdnative.NewLazyMethodBind("VisualServer", "instances_cull_aabb")
+	methodBindVisualServerInstancesCullConvex                                = gdnative.NewLazyMethodBind("VisualServer", "instances_cull_convex")
+	methodBindVisualServerInstancesCullRay                                   = gdnative.NewLazyMethodBind("VisualServer", "instances_cull_ray")
+	methodBindVisualServerLightDirectionalSetBlendSplits                     = gdnative.NewLazyMethodBind("VisualServer", "light_directional_set_blend_splits")
+	methodBindVisualServerLightDirectionalSetShadowDepthRangeMode            = gdnative.NewLazyMethodBind("VisualServer", "light_directional_set_shadow_depth_range_mode")
+	methodBindVisualServerLightDirectionalSetShadowMode                      = gdnative.NewLazyMethodBind("VisualServer", "light_directional_set_shadow_mode")
+	methodBindVisualServerLightOmniSetShadowDetail                           = gdnative.NewLazyMethodBind("VisualServer", "light_omni_set_shadow_detail")
+	methodBindVisualServerLightOmniSetShadowMode                             = gdnative.NewLazyMethodBind("VisualServer", "light_omni_set_shadow_mode")
+	methodBindVisualServerLightSetColor                                      = gdnative.NewLazyMethodBind("VisualServer", "light_set_color")
+	methodBindVisualServerLightSetCullMask                                   = gdnative.NewLazyMethodBind("VisualServer", "light_set_cull_mask")
+	methodBindVisualServerLightSetNegative                                   = gdnative.NewLazyMethodBind("VisualServer", "light_set_negative")
+	methodBindVisualServerLightSetParam                                      = gdnative.NewLazyMethodBind("VisualServer", "light_set_param")
+	methodBindVisualServerLightSetProjector                                  = gdnative.NewLazyMethodBind("VisualServer", "light_set_projector")
+	methodBindVisualServerLightSetReverseCullFaceMode                        = gdnative.NewLazyMethodBind("VisualServer", "light_set_reverse_cull_face_mode")
+	methodBindVisualServerLightSetShadow                                     = gdnative.NewLazyMethodBind("VisualServer", "light_set_shadow")
+	methodBindVisualServerLightSetShadowColor                                = gdnative.NewLazyMethodBind("VisualServer", "light_set_shadow_color")
+	methodBindVisualServerLightmapCaptureCreate                              = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_create")
+	methodBindVisualServerLightmapCaptureGetBounds                           = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_get_bounds")
+	methodBindVisualServerLightmapCaptureGetEnergy                           = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_get_energy")
+	methodBindVisualServerLightmapCaptureGetOctree                           = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_get_octree")
+	methodBindVisualServerLightmapCaptureGetOctreeCellSubdiv                 = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_get_octree_cell_subdiv")
+	methodBindVisualServerLightmapCaptureGetOctreeCellTransform              = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_get_octree_cell_transform")
+	methodBindVisualServerLightmapCaptureSetBounds                           = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_set_bounds")
+	methodBindVisualServerLightmapCaptureSetEnergy                           = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_set_energy")
+	methodBindVisualServerLightmapCaptureSetOctree                           = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_set_octree")
+	methodBindVisualServerLightmapCaptureSetOctreeCellSubdiv                 = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_set_octree_cell_subdiv")
+	methodBindVisualServerLightmapCaptureSetOctreeCellTransform              = gdnative.NewLazyMethodBind("VisualServer", "lightmap_capture_set_octree_cell_transform")
+	methodBindVisualServerMakeSphereMesh                                     = gdnative.NewLazyMethodBind("VisualServer", "make_sphere_mesh")
+	methodBindVisualServerMaterialCreate                                     = gdnative.NewLazyMethodBind("VisualServer", "material_create")
+	methodBindVisualServerMaterialGetParam                                   = gdnative.NewLazyMethodBind("VisualServer", "material_get_param")
+	methodBindVisualServerMaterialGetShader                                  = gdnative.NewLazyMethodBind("VisualServer", "material_get_shader")
+	methodBindVisualServerMaterialSetLineWidth                               = gdnative.NewLazyMethodBind("VisualServer", "material_set_line_width")
+	methodBindVisualServerMaterialSetNextPass                                = gdnative.NewLazyMethodBind("VisualServer", "material_set_next_pass")
+	methodBindVisualServerMaterialSetParam                                   = gdnative.NewLazyMethodBind("VisualServer", "material_set_param")
+	methodBindVisualServerMaterialSetRenderPriority                          = gdnative.NewLazyMethodBind("VisualServer", "material_set_render_priority")
+	methodBindVisualServerMaterialSetShader                                  = gdnative.NewLazyMethodBind("VisualServer", "material_set_shader")
+	methodBindVisualServerMeshAddSurfaceFromArrays                           = gdnative.NewLazyMethodBind("VisualServer", "mesh_add_surface_from_arrays")
+	methodBindVisualServerMeshClear                                          = gdnative.NewLazyMethodBind("VisualServer", "mesh_clear")
+	methodBindVisualServerMeshCreate                                         = gdnative.NewLazyMethodBind("VisualServer", "mesh_create")
+	methodBindVisualServerMeshGetBlendShapeCount                             = gdnative.NewLazyMethodBind("VisualServer", "mesh_get_blend_shape_count")
+	methodBindVisualServerMeshGetBlendShapeMode                              = gdnative.NewLazyMethodBind("VisualServer", "mesh_get_blend_shape_mode")
+	methodBindVisualServerMeshGetCustomAabb                                  = gdnative.NewLazyMethodBind("VisualServer", "mesh_get_custom_aabb")
+	methodBindVisualServerMeshGetSurfaceCount                                = gdnative.NewLazyMethodBind("VisualServer", "mesh_get_surface_count")
+	methodBindVisualServerMeshRemoveSurface                                  = gdnative.NewLazyMethodBind("VisualServer", "mesh_remove_surface")
+	methodBindVisualServerMeshSetBlendShapeCount                             = gdnative.NewLazyMethodBind("VisualServer", "mesh_set_blend_shape_count")
+	methodBindVisualServerMeshSetBlendShapeMode                              = gdnative.NewLazyMethodBind("VisualServer", "mesh_set_blend_shape_mode")
+	methodBindVisualServerMeshSetCustomAabb                                  = gdnative.NewLazyMethodBind("VisualServer", "mesh_set_custom_aabb")
+	methodBindVisualServerMeshSurfaceGetAabb                                 = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_aabb")
+	methodBindVisualServerMeshSurfaceGetArray                                = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_array")
+	methodBindVisualServerMeshSurfaceGetArrayIndexLen                        = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_array_index_len")
+	methodBindVisualServerMeshSurfaceGetArrayLen                             = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_array_len")
+	methodBindVisualServerMeshSurfaceGetArrays                               = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_arrays")
+	methodBindVisualServerMeshSurfaceGetBlendShapeArrays                     = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_blend_shape_arrays")
+	methodBindVisualServerMeshSurfaceGetFormat                               = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_format")
+	methodBindVisualServerMeshSurfaceGetIndexArray                           = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_index_array")
+	methodBindVisualServerMeshSurfaceGetMaterial                             = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_material")
+	methodBindVisualServerMeshSurfaceGetPrimitiveType                        = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_primitive_type")
+	methodBindVisualServerMeshSurfaceGetSkeletonAabb                         = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_get_skeleton_aabb")
+	methodBindVisualServerMeshSurfaceSetMaterial                             = gdnative.NewLazyMethodBind("VisualServer", "mesh_surface_set_material")
+	methodBindVisualServerMultimeshAllocate                                  = gdnative.NewLazyMethodBind("VisualServer", "multimesh_allocate")
+	methodBindVisualServerMultimeshGetAabb                                   = gdnative.NewLazyMethodBind("VisualServer", "multimesh_get_aabb")
+	methodBindVisualServerMultimeshGetInstanceCount                          = gdnative.NewLazyMethodBind("VisualServer", "multimesh_get_instance_count")
+	methodBindVisualServerMultimeshGetMesh                                   = gdnative.NewLazyMethodBind("VisualServer", "multimesh_get_mesh")
+	methodBindVisualServerMultimeshGetVisibleInstances                       = gdnative.NewLazyMethodBind("VisualServer", "multimesh_get_visible_instances")
+	methodBindVisualServerMultimeshInstanceGetColor                          = gdnative.NewLazyMethodBind("VisualServer", "multimesh_instance_get_color")
+	methodBindVisualServerMultimeshInstanceGetTransform                      = gdnative.NewLazyMethodBind("VisualServer", "multimesh_instance_get_transform")
+	methodBindVisualServerMultimeshInstanceGetTransform2D                    = gdnative.NewLazyMethodBind("VisualServer", "multimesh_instance_get_transform_2d")
+	methodBindVisualServerMultimeshInstanceSetColor                          = gdnative.NewLazyMethodBind("VisualServer", "multimesh_instance_set_color")
+	methodBindVisualServerMultimeshInstanceSetTransform                      = gdnative.NewLazyMethodBind("VisualServer", "multimesh_instance_set_transform")
+	methodBindVisualServerMultimeshInstanceSetTransform2D                    = gdnative.NewLazyMethodBind("VisualServer", "multimesh_instance_set_transform_2d")
+	methodBindVisualServerMultimeshSetMesh                                   = gdnative.NewLazyMethodBind("VisualServer", "multimesh_set_mesh")
+	methodBindVisualServerMultimeshSetVisibleInstances                       = gdnative.NewLazyMethodBind("VisualServer", "multimesh_set_visible_instances")
+	methodBindVisualServerOmniLightCreate                                    = gdnative.NewLazyMethodBind("VisualServer", "omni_light_create")
+	methodBindVisualServerParticlesCreate                                    = gdnative.NewLazyMethodBind("VisualServer", "particles_create")
+	methodBindVisualServerParticlesGetCurrentAabb                            = gdnative.NewLazyMethodBind("VisualServer", "particles_get_current_aabb")
+	methodBindVisualServerParticlesGetEmitting                               = gdnative.NewLazyMethodBind("VisualServer", "particles_get_emitting")
+	methodBindVisualServerParticlesRestart                                   = gdnative.NewLazyMethodBind("VisualServer", "particles_restart")
+	methodBindVisualServerParticlesSetAmount                                 = gdnative.NewLazyMethodBind("VisualServer", "particles_set_amount")
+	methodBindVisualServerParticlesSetCustomAabb                             = gdnative.NewLazyMethodBind("VisualServer", "particles_set_custom_aabb")
+	methodBindVisualServerParticlesSetDrawOrder                              = gdnative.NewLazyMethodBind("VisualServer", "particles_set_draw_order")
+	methodBindVisualServerParticlesSetDrawPassMesh                           = gdnative.NewLazyMethodBind("VisualServer", "particles_set_draw_pass_mesh")
+	methodBindVisualServerParticlesSetDrawPasses                             = gdnative.NewLazyMethodBind("VisualServer", "particles_set_draw_passes")
+	methodBindVisualServerParticlesSetEmissionTransform                      = gdnative.NewLazyMethodBind("VisualServer", "particles_set_emission_transform")
+	methodBindVisualServerParticlesSetEmitting                               = gdnative.NewLazyMethodBind("VisualServer", "particles_set_emitting")
+	methodBindVisualServerParticlesSetExplosivenessRatio                     = gdnative.NewLazyMethodBind("VisualServer", "particles_set_explosiveness_ratio")
+	methodBindVisualServerParticlesSetFixedFps                               = gdnative.NewLazyMethodBind("VisualServer", "particles_set_fixed_fps")
+	methodBindVisualServerParticlesSetFractionalDelta                        = gdnative.NewLazyMethodBind("VisualServer", "particles_set_fractional_delta")
+	methodBindVisualServerParticlesSetLifetime                               = gdnative.NewLazyMethodBind("VisualServer", "particles_set_lifetime")
+	methodBindVisualServerParticlesSetOneShot                                = gdnative.NewLazyMethodBind("VisualServer", "particles_set_one_shot")
+	methodBindVisualServerParticlesSetPreProcessTime                         = gdnative.NewLazyMethodBind("VisualServer", "particles_set_pre_process_time")
+	methodBindVisualServerParticlesSetProcessMaterial                        = gdnative.NewLazyMethodBind("VisualServer", "particles_set_process_material")
+	methodBindVisualServerParticlesSetRandomnessRatio                        = gdnative.NewLazyMethodBind("VisualServer", "particles_set_randomness_ratio")
+	methodBindVisualServerParticlesSetSpeedScale                             = gdnative.NewLazyMethodBind("VisualServer", "particles_set_speed_scale")
+	methodBindVisualServerParticlesSetUseLocalCoordinates                    = gdnative.NewLazyMethodBind("VisualServer", "particles_set_use_local_coordinates")
+	methodBindVisualServerReflectionProbeCreate                              = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_create")
+	methodBindVisualServerReflectionProbeSetAsInterior                       = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_as_interior")
+	methodBindVisualServerReflectionProbeSetCullMask                         = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_cull_mask")
+	methodBindVisualServerReflectionProbeSetEnableBoxProjection              = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_enable_box_projection")
+	methodBindVisualServerReflectionProbeSetEnableShadows                    = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_enable_shadows")
+	methodBindVisualServerReflectionProbeSetExtents                          = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_extents")
+	methodBindVisualServerReflectionProbeSetIntensity                        = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_intensity")
+	methodBindVisualServerReflectionProbeSetInteriorAmbient                  = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_interior_ambient")
+	methodBindVisualServerReflectionProbeSetInteriorAmbientEnergy            = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_interior_ambient_energy")
+	methodBindVisualServerReflectionProbeSetInteriorAmbientProbeContribution = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_interior_ambient_probe_contribution")
+	methodBindVisualServerReflectionProbeSetMaxDistance                      = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_max_distance")
+	methodBindVisualServerReflectionProbeSetOriginOffset                     = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_origin_offset")
+	methodBindVisualServerReflectionProbeSetUpdateMode                       = gdnative.NewLazyMethodBind("VisualServer", "reflection_probe_set_update_mode")
+	methodBindVisualServerRequestFrameDrawnCallback                          = gdnative.NewLazyMethodBind("VisualServer", "request_frame_drawn_callback")
+	methodBindVisualServerScenarioCreate                                     = gdnative.NewLazyMethodBind("VisualServer", "scenario_create")
+	methodBindVisualServerScenarioSetDebug                                   = gdnative.NewLazyMethodBind("VisualServer", "scenario_set_debug")
+	methodBindVisualServerScenarioSetEnvironment                             = gdnative.NewLazyMethodBind("VisualServer", "scenario_set_environment")
+	methodBindVisualServerScenarioSetFallbackEnvironment                     = gdnative.NewLazyMethodBind("VisualServer", "scenario_set_fallback_environment")
+	methodBindVisualServerScenarioSetReflectionAtlasSize                     = gdnative.NewLazyMethodBind("VisualServer", "scenario_set_reflection_atlas_size")
+	methodBindVisualServerSetBootImage                                       = gdnative.NewLazyMethodBind("VisualServer", "set_boot_image")
+	methodBindVisualServerSetDebugGenerateWireframes                         = gdnative.NewLazyMethodBind("VisualServer", "set_debug_generate_wireframes")
+	methodBindVisualServerSetDefaultClearColor                               = gdnative.NewLazyMethodBind("VisualServer", "set_default_clear_color")
+	methodBindVisualServerShaderCreate                                       = gdnative.NewLazyMethodBind("VisualServer", "shader_create")
+	methodBindVisualServerShaderGetCode                                      = gdnative.NewLazyMethodBind("VisualServer", "shader_get_code")
+	methodBindVisualServerShaderGetDefaultTextureParam                       = gdnative.NewLazyMethodBind("VisualServer", "shader_get_default_texture_param")
+	methodBindVisualServerShaderGetParamList                                 = gdnative.NewLazyMethodBind("VisualServer", "shader_get_param_list")
+	methodBindVisualServerShaderSetCode                                      = gdnative.NewLazyMethodBind("VisualServer", "shader_set_code")
+	methodBindVisualServerShaderSetDefaultTextureParam                       = gdnative.NewLazyMethodBind("VisualServer", "shader_set_default_texture_param")
+	methodBindVisualServerSkeletonAllocate                                   = gdnative.NewLazyMethodBind("VisualServer", "skeleton_allocate")
+	methodBindVisualServerSkeletonBoneGetTransform                           = gdnative.NewLazyMethodBind("VisualServer", "skeleton_bone_get_transform")
+	methodBindVisualServerSkeletonBoneGetTransform2D                         = gdnative.NewLazyMethodBind("VisualServer", "skeleton_bone_get_transform_2d")
+	methodBindVisualServerSkeletonBoneSetTransform                           = gdnative.NewLazyMethodBind("VisualServer", "skeleton_bone_set_transform")
+	methodBindVisualServerSkeletonBoneSetTransform2D                         = gdnative.NewLazyMethodBind("VisualServer", "skeleton_bone_set_transform_2d")
+	methodBindVisualServerSkeletonCreate                                     = gdnative.NewLazyMethodBind("VisualServer", "skeleton_create")
+	methodBindVisualServerSkeletonGetBoneCount                               = gdnative.NewLazyMethodBind("VisualServer", "skeleton_get_bone_count")
+	methodBindVisualServerSkyCreate                                          = gdnative.NewLazyMethodBind("VisualServer", "sky_create")
+	methodBindVisualServerSkySetTexture                                      = gdnative.NewLazyMethodBind("VisualServer", "sky_set_texture")
+	methodBindVisualServerSpotLightCreate                                    = gdnative.NewLazyMethodBind("VisualServer", "spot_light_create")
+	methodBindVisualServerSync                                               = gdnative.NewLazyMethodBind("VisualServer", "sync")
+	methodBindVisualServerTextureAllocate                                    = gdnative.NewLazyMethodBind("VisualServer", "texture_allocate")
+	methodBindVisualServerTextureCreate                                      = gdnative.NewLazyMethodBind("VisualServer", "texture_create")
+	methodBindVisualServerTextureCreateFromImage                             = gdnative.NewLazyMethodBind("VisualServer", "texture_create_from_image")
+	methodBindVisualServerTextureDebugUsage                                  = gdnative.NewLazyMethodBind("VisualServer", "texture_debug_usage")
+	methodBindVisualServerTextureGetData                                     = gdnative.NewLazyMethodBind("VisualServer", "texture_get_data")
+	methodBindVisualServerTextureGetFlags                                    = gdnative.NewLazyMethodBind("VisualServer", "texture_get_flags")
+	methodBindVisualServerTextureGetFormat                                   = gdnative.NewLazyMethodBind("VisualServer", "texture_get_format")
+	methodBindVisualServerTextureGetHeight                                   = gdnative.NewLazyMethodBind("VisualServer", "texture_get_height")
+	methodBindVisualServerTextureGetPath                                     = gdnative.NewLazyMethodBind("VisualServer", "texture_get_path")
+	methodBindVisualServerTextureGetTexid                                    = gdnative.NewLazyMethodBind("VisualServer", "texture_get_texid")
+	methodBindVisualServerTextureGetWidth                                    = gdnative.NewLazyMethodBind("VisualServer", "texture_get_width")
+	methodBindVisualServerTextureSetData                                     = gdnative.NewLazyMethodBind("VisualServer", "texture_set_data")
+	methodBindVisualServerTextureSetFlags                                    = gdnative.NewLazyMethodBind("VisualServer", "texture_set_flags")
+	methodBindVisualServerTextureSetPath                                     = gdnative.NewLazyMethodBind("VisualServer", "texture_set_path")
+	methodBindVisualServerTextureSetShrinkAllX2OnSetData                     = gdnative.NewLazyMethodBind("VisualServer", "texture_set_shrink_all_x2_on_set_data")
+	methodBindVisualServerTextureSetSizeOverride                             = gdnative.NewLazyMethodBind("VisualServer", "texture_set_size_override")
+	methodBindVisualServerTexturesKeepOriginal                               = gdnative.NewLazyMethodBind("VisualServer", "textures_keep_original")
+	methodBindVisualServerViewportAttachCamera                               = gdnative.NewLazyMethodBind("VisualServer", "viewport_attach_camera")
+	methodBindVisualServerViewportAttachCanvas                               = gdnative.NewLazyMethodBind("VisualServer", "viewport_attach_canvas")
+	methodBindVisualServerViewportAttachToScreen                             = gdnative.NewLazyMethodBind("VisualServer", "viewport_attach_to_screen")
+	methodBindVisualServerViewportCreate                                     = gdnative.NewLazyMethodBind("VisualServer", "viewport_create")
+	methodBindVisualServerViewportDetach                                     = gdnative.NewLazyMethodBind("VisualServer", "viewport_detach")
+	methodBindVisualServerViewportGetRenderInfo                              = gdnative.NewLazyMethodBind("VisualServer", "viewport_get_render_info")
+	methodBindVisualServerViewportGetTexture                                 = gdnative.NewLazyMethodBind("VisualServer", "viewport_get_texture")
+	methodBindVisualServerViewportRemoveCanvas                               = gdnative.NewLazyMethodBind("VisualServer", "viewport_remove_canvas")
+	methodBindVisualServerViewportSetActive                                  = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_active")
+	methodBindVisualServerViewportSetCanvasLayer                             = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_canvas_layer")
+	methodBindVisualServerViewportSetCanvasTransform                         = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_canvas_transform")
+	methodBindVisualServerViewportSetClearMode                               = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_clear_mode")
+	methodBindVisualServerViewportSetDebugDraw                               = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_debug_draw")
+	methodBindVisualServerViewportSetDisable3D                               = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_disable_3d")
+	methodBindVisualServerViewportSetDisableEnvironment                      = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_disable_environment")
+	methodBindVisualServerViewportSetGlobalCanvasTransform                   = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_global_canvas_transform")
+	methodBindVisualServerViewportSetHdr                                     = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_hdr")
+	methodBindVisualServerViewportSetHideCanvas                              = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_hide_canvas")
+	methodBindVisualServerViewportSetHideScenario                            = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_hide_scenario")
+	methodBindVisualServerViewportSetMsaa                                    = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_msaa")
+	methodBindVisualServerViewportSetParentViewport                          = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_parent_viewport")
+	methodBindVisualServerViewportSetScenario                                = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_scenario")
+	methodBindVisualServerViewportSetShadowAtlasQuadrantSubdivision          = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_shadow_atlas_quadrant_subdivision")
+	methodBindVisualServerViewportSetShadowAtlasSize                         = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_shadow_atlas_size")
+	methodBindVisualServerViewportSetSize                                    = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_size")
+	methodBindVisualServerViewportSetTransparentBackground                   = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_transparent_background")
+	methodBindVisualServerViewportSetUpdateMode                              = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_update_mode")
+	methodBindVisualServerViewportSetUsage                                   = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_usage")
+	methodBindVisualServerViewportSetUseArvr                                 = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_use_arvr")
+	methodBindVisualServerViewportSetVflip                                   = gdnative.NewLazyMethodBind("VisualServer", "viewport_set_vflip")
+)
+
 /*
-        Sets images to be rendered in the window margin.
-	Args: [{ false left RID} { false top RID} { false right RID} { false bottom RID}], Returns: void
+	        Sets images to be rendered in the window margin.
+		Args: [{ false left RID} { false top RID} { false right RID} { false bottom RID}], Returns: void
 */
 func (o *visualServer) BlackBarsSetImages(left gdnative.Rid, top gdnative.Rid, right gdnative.Rid, bottom gdnative.Rid) {
 	o.ensureSingleton()
@@ -504,7 +2198,7 @@ func (o *visualServer) BlackBarsSetImages(left gdnative.Rid, top gdnative.Rid, r
 	ptrArguments[3] = gdnative.NewPointerFromRid(bottom)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "black_bars_set_images")
+	methodBind := methodBindVisualServerBlackBarsSetImages.Get()
 
 	// Call the parent method.
 	// void
@@ -514,8 +2208,8 @@ func (o *visualServer) BlackBarsSetImages(left gdnative.Rid, top gdnative.Rid, r
 }
 
 /*
-        Sets margin size, where black bars (or images, if [method black_bars_set_images] was used) are rendered.
-	Args: [{ false left int} { false top int} { false right int} { false bottom int}], Returns: void
+	        Sets margin size, where black bars (or images, if [method black_bars_set_images] was used) are rendered.
+		Args: [{ false left int} { false top int} { false right int} { false bottom int}], Returns: void
 */
 func (o *visualServer) BlackBarsSetMargins(left gdnative.Int, top gdnative.Int, right gdnative.Int, bottom gdnative.Int) {
 	o.ensureSingleton()
@@ -529,7 +2223,7 @@ func (o *visualServer) BlackBarsSetMargins(left gdnative.Int, top gdnative.Int,
 	ptrArguments[3] = gdnative.NewPointerFromInt(bottom)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "black_bars_set_margins")
+	methodBind := methodBindVisualServerBlackBarsSetMargins.Get()
 
 	// Call the parent method.
 	// void
@@ -539,8 +2233,7 @@ func (o *visualServer) BlackBarsSetMargins(left gdnative.Int, top gdnative.Int,
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) CameraCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -550,7 +2243,7 @@ func (o *visualServer) CameraCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "camera_create")
+	methodBind := methodBindVisualServerCameraCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -563,8 +2256,7 @@ func (o *visualServer) CameraCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false camera RID} { false layers int}], Returns: void
+Args: [{ false camera RID} { false layers int}], Returns: void
 */
 func (o *visualServer) CameraSetCullMask(camera gdnative.Rid, layers gdnative.Int) {
 	o.ensureSingleton()
@@ -576,7 +2268,7 @@ func (o *visualServer) CameraSetCullMask(camera gdnative.Rid, layers gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(layers)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "camera_set_cull_mask")
+	methodBind := methodBindVisualServerCameraSetCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -586,8 +2278,7 @@ func (o *visualServer) CameraSetCullMask(camera gdnative.Rid, layers gdnative.In
 }
 
 /*
-
-	Args: [{ false camera RID} { false env RID}], Returns: void
+Args: [{ false camera RID} { false env RID}], Returns: void
 */
 func (o *visualServer) CameraSetEnvironment(camera gdnative.Rid, env gdnative.Rid) {
 	o.ensureSingleton()
@@ -599,7 +2290,7 @@ func (o *visualServer) CameraSetEnvironment(camera gdnative.Rid, env gdnative.Ri
 	ptrArguments[1] = gdnative.NewPointerFromRid(env)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "camera_set_environment")
+	methodBind := methodBindVisualServerCameraSetEnvironment.Get()
 
 	// Call the parent method.
 	// void
@@ -609,8 +2300,7 @@ func (o *visualServer) CameraSetEnvironment(camera gdnative.Rid, env gdnative.Ri
 }
 
 /*
-
-	Args: [{ false camera RID} { false size float} { false z_near float} { false z_far float}], Returns: void
+Args: [{ false camera RID} { false size float} { false z_near float} { false z_far float}], Returns: void
 */
 func (o *visualServer) CameraSetOrthogonal(camera gdnative.Rid, size gdnative.Real, zNear gdnative.Real, zFar gdnative.Real) {
 	o.ensureSingleton()
@@ -624,7 +2314,7 @@ func (o *visualServer) CameraSetOrthogonal(camera gdnative.Rid, size gdnative.Re
 	ptrArguments[3] = gdnative.NewPointerFromReal(zFar)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "camera_set_orthogonal")
+	methodBind := methodBindVisualServerCameraSetOrthogonal.Get()
 
 	// Call the parent method.
 	// void
@@ -634,8 +2324,7 @@ func (o *visualServer) CameraSetOrthogonal(camera gdnative.Rid, size gdnative.Re
 }
 
 /*
-
-	Args: [{ false camera RID} { false fovy_degrees float} { false z_near float} { false z_far float}], Returns: void
+Args: [{ false camera RID} { false fovy_degrees float} { false z_near float} { false z_far float}], Returns: void
 */
 func (o *visualServer) CameraSetPerspective(camera gdnative.Rid, fovyDegrees gdnative.Real, zNear gdnative.Real, zFar gdnative.Real) {
 	o.ensureSingleton()
@@ -649,7 +2338,7 @@ func (o *visualServer) CameraSetPerspective(camera gdnative.Rid, fovyDegrees gdn
 	ptrArguments[3] = gdnative.NewPointerFromReal(zFar)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "camera_set_perspective")
+	methodBind := methodBindVisualServerCameraSetPerspective.Get()
 
 	// Call the parent method.
 	// void
@@ -659,8 +2348,7 @@ func (o *visualServer) CameraSetPerspective(camera gdnative.Rid, fovyDegrees gdn
 }
 
 /*
-
-	Args: [{ false camera RID} { false transform Transform}], Returns: void
+Args: [{ false camera RID} { false transform Transform}], Returns: void
 */
 func (o *visualServer) CameraSetTransform(camera gdnative.Rid, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -672,7 +2360,7 @@ func (o *visualServer) CameraSetTransform(camera gdnative.Rid, transform gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "camera_set_transform")
+	methodBind := methodBindVisualServerCameraSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -682,8 +2370,7 @@ func (o *visualServer) CameraSetTransform(camera gdnative.Rid, transform gdnativ
 }
 
 /*
-
-	Args: [{ false camera RID} { false enable bool}], Returns: void
+Args: [{ false camera RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) CameraSetUseVerticalAspect(camera gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -695,7 +2382,7 @@ func (o *visualServer) CameraSetUseVerticalAspect(camera gdnative.Rid, enable gd
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "camera_set_use_vertical_aspect")
+	methodBind := methodBindVisualServerCameraSetUseVerticalAspect.Get()
 
 	// Call the parent method.
 	// void
@@ -705,8 +2392,8 @@ func (o *visualServer) CameraSetUseVerticalAspect(camera gdnative.Rid, enable gd
 }
 
 /*
-        Creates a canvas and returns the assigned [RID].
-	Args: [], Returns: RID
+	        Creates a canvas and returns the assigned [RID].
+		Args: [], Returns: RID
 */
 func (o *visualServer) CanvasCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -716,7 +2403,7 @@ func (o *visualServer) CanvasCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_create")
+	methodBind := methodBindVisualServerCanvasCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -729,8 +2416,8 @@ func (o *visualServer) CanvasCreate() gdnative.Rid {
 }
 
 /*
-        Adds a circle command to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false pos Vector2} { false radius float} { false color Color}], Returns: void
+	        Adds a circle command to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false pos Vector2} { false radius float} { false color Color}], Returns: void
 */
 func (o *visualServer) CanvasItemAddCircle(item gdnative.Rid, pos gdnative.Vector2, radius gdnative.Real, color gdnative.Color) {
 	o.ensureSingleton()
@@ -744,7 +2431,7 @@ func (o *visualServer) CanvasItemAddCircle(item gdnative.Rid, pos gdnative.Vecto
 	ptrArguments[3] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_circle")
+	methodBind := methodBindVisualServerCanvasItemAddCircle.Get()
 
 	// Call the parent method.
 	// void
@@ -754,8 +2441,8 @@ func (o *visualServer) CanvasItemAddCircle(item gdnative.Rid, pos gdnative.Vecto
 }
 
 /*
-        If ignore is [code]true[/code], the VisualServer does not perform clipping.
-	Args: [{ false item RID} { false ignore bool}], Returns: void
+	        If ignore is [code]true[/code], the VisualServer does not perform clipping.
+		Args: [{ false item RID} { false ignore bool}], Returns: void
 */
 func (o *visualServer) CanvasItemAddClipIgnore(item gdnative.Rid, ignore gdnative.Bool) {
 	o.ensureSingleton()
@@ -767,7 +2454,7 @@ func (o *visualServer) CanvasItemAddClipIgnore(item gdnative.Rid, ignore gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromBool(ignore)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_clip_ignore")
+	methodBind := methodBindVisualServerCanvasItemAddClipIgnore.Get()
 
 	// Call the parent method.
 	// void
@@ -777,8 +2464,8 @@ func (o *visualServer) CanvasItemAddClipIgnore(item gdnative.Rid, ignore gdnativ
 }
 
 /*
-        Adds a line command to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false from Vector2} { false to Vector2} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
+	        Adds a line command to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false from Vector2} { false to Vector2} { false color Color} {1 true width float} {False true antialiased bool}], Returns: void
 */
 func (o *visualServer) CanvasItemAddLine(item gdnative.Rid, from gdnative.Vector2, to gdnative.Vector2, color gdnative.Color, width gdnative.Real, antialiased gdnative.Bool) {
 	o.ensureSingleton()
@@ -794,7 +2481,7 @@ func (o *visualServer) CanvasItemAddLine(item gdnative.Rid, from gdnative.Vector
 	ptrArguments[5] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_line")
+	methodBind := methodBindVisualServerCanvasItemAddLine.Get()
 
 	// Call the parent method.
 	// void
@@ -804,8 +2491,8 @@ func (o *visualServer) CanvasItemAddLine(item gdnative.Rid, from gdnative.Vector
 }
 
 /*
-        Adds a [Mesh] to the [CanvasItem]'s draw commands. Only affects its aabb at the moment.
-	Args: [{ false item RID} { false mesh RID} {[RID] true skeleton RID}], Returns: void
+	        Adds a [Mesh] to the [CanvasItem]'s draw commands. Only affects its aabb at the moment.
+		Args: [{ false item RID} { false mesh RID} {[RID] true skeleton RID}], Returns: void
 */
 func (o *visualServer) CanvasItemAddMesh(item gdnative.Rid, mesh gdnative.Rid, skeleton gdnative.Rid) {
 	o.ensureSingleton()
@@ -818,7 +2505,7 @@ func (o *visualServer) CanvasItemAddMesh(item gdnative.Rid, mesh gdnative.Rid, s
 	ptrArguments[2] = gdnative.NewPointerFromRid(skeleton)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_mesh")
+	methodBind := methodBindVisualServerCanvasItemAddMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -828,8 +2515,8 @@ func (o *visualServer) CanvasItemAddMesh(item gdnative.Rid, mesh gdnative.Rid, s
 }
 
 /*
-        Adds a [MultiMesh] to the [CanvasItem]'s draw commands. Only affects its aabb at the moment.
-	Args: [{ false item RID} { false mesh RID} {[RID] true skeleton RID}], Returns: void
+	        Adds a [MultiMesh] to the [CanvasItem]'s draw commands. Only affects its aabb at the moment.
+		Args: [{ false item RID} { false mesh RID} {[RID] true skeleton RID}], Returns: void
 */
 func (o *visualServer) CanvasItemAddMultimesh(item gdnative.Rid, mesh gdnative.Rid, skeleton gdnative.Rid) {
 	o.ensureSingleton()
@@ -842,7 +2529,7 @@ func (o *visualServer) CanvasItemAddMultimesh(item gdnative.Rid, mesh gdnative.R
 	ptrArguments[2] = gdnative.NewPointerFromRid(skeleton)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_multimesh")
+	methodBind := methodBindVisualServerCanvasItemAddMultimesh.Get()
 
 	// Call the parent method.
 	// void
@@ -852,8 +2539,8 @@ func (o *visualServer) CanvasItemAddMultimesh(item gdnative.Rid, mesh gdnative.R
 }
 
 /*
-        Adds a nine patch image to the [CanvasItem]'s draw commands. See [NinePatchRect] for more explanation.
-	Args: [{ false item RID} { false rect Rect2} { false source Rect2} { false texture RID} { false topleft Vector2} { false bottomright Vector2} {0 true x_axis_mode int} {0 true y_axis_mode int} {True true draw_center bool} {1,1,1,1 true modulate Color} {[RID] true normal_map RID}], Returns: void
+	        Adds a nine patch image to the [CanvasItem]'s draw commands. See [NinePatchRect] for more explanation.
+		Args: [{ false item RID} { false rect Rect2} { false source Rect2} { false texture RID} { false topleft Vector2} { false bottomright Vector2} {0 true x_axis_mode int} {0 true y_axis_mode int} {True true draw_center bool} {1,1,1,1 true modulate Color} {[RID] true normal_map RID}], Returns: void
 */
 func (o *visualServer) CanvasItemAddNinePatch(item gdnative.Rid, rect gdnative.Rect2, source gdnative.Rect2, texture gdnative.Rid, topleft gdnative.Vector2, bottomright gdnative.Vector2, xAxisMode gdnative.Int, yAxisMode gdnative.Int, drawCenter gdnative.Bool, modulate gdnative.Color, normalMap gdnative.Rid) {
 	o.ensureSingleton()
@@ -874,7 +2561,7 @@ func (o *visualServer) CanvasItemAddNinePatch(item gdnative.Rid, rect gdnative.R
 	ptrArguments[10] = gdnative.NewPointerFromRid(normalMap)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_nine_patch")
+	methodBind := methodBindVisualServerCanvasItemAddNinePatch.Get()
 
 	// Call the parent method.
 	// void
@@ -884,8 +2571,8 @@ func (o *visualServer) CanvasItemAddNinePatch(item gdnative.Rid, rect gdnative.R
 }
 
 /*
-        Adds a particles system to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false particles RID} { false texture RID} { false normal_map RID} { false h_frames int} { false v_frames int}], Returns: void
+	        Adds a particles system to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false particles RID} { false texture RID} { false normal_map RID} { false h_frames int} { false v_frames int}], Returns: void
 */
 func (o *visualServer) CanvasItemAddParticles(item gdnative.Rid, particles gdnative.Rid, texture gdnative.Rid, normalMap gdnative.Rid, hFrames gdnative.Int, vFrames gdnative.Int) {
 	o.ensureSingleton()
@@ -901,7 +2588,7 @@ func (o *visualServer) CanvasItemAddParticles(item gdnative.Rid, particles gdnat
 	ptrArguments[5] = gdnative.NewPointerFromInt(vFrames)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_particles")
+	methodBind := methodBindVisualServerCanvasItemAddParticles.Get()
 
 	// Call the parent method.
 	// void
@@ -911,8 +2598,8 @@ func (o *visualServer) CanvasItemAddParticles(item gdnative.Rid, particles gdnat
 }
 
 /*
-        Adds a polygon to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false points PoolVector2Array} { false colors PoolColorArray} {[] true uvs PoolVector2Array} {[RID] true texture RID} {[RID] true normal_map RID} {False true antialiased bool}], Returns: void
+	        Adds a polygon to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false points PoolVector2Array} { false colors PoolColorArray} {[] true uvs PoolVector2Array} {[RID] true texture RID} {[RID] true normal_map RID} {False true antialiased bool}], Returns: void
 */
 func (o *visualServer) CanvasItemAddPolygon(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array, texture gdnative.Rid, normalMap gdnative.Rid, antialiased gdnative.Bool) {
 	o.ensureSingleton()
@@ -929,7 +2616,7 @@ func (o *visualServer) CanvasItemAddPolygon(item gdnative.Rid, points gdnative.P
 	ptrArguments[6] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_polygon")
+	methodBind := methodBindVisualServerCanvasItemAddPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -939,8 +2626,8 @@ func (o *visualServer) CanvasItemAddPolygon(item gdnative.Rid, points gdnative.P
 }
 
 /*
-        Adds a polyline, which is a line from multiple points with a width, to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false points PoolVector2Array} { false colors PoolColorArray} {1 true width float} {False true antialiased bool}], Returns: void
+	        Adds a polyline, which is a line from multiple points with a width, to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false points PoolVector2Array} { false colors PoolColorArray} {1 true width float} {False true antialiased bool}], Returns: void
 */
 func (o *visualServer) CanvasItemAddPolyline(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, width gdnative.Real, antialiased gdnative.Bool) {
 	o.ensureSingleton()
@@ -955,7 +2642,7 @@ func (o *visualServer) CanvasItemAddPolyline(item gdnative.Rid, points gdnative.
 	ptrArguments[4] = gdnative.NewPointerFromBool(antialiased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_polyline")
+	methodBind := methodBindVisualServerCanvasItemAddPolyline.Get()
 
 	// Call the parent method.
 	// void
@@ -965,8 +2652,8 @@ func (o *visualServer) CanvasItemAddPolyline(item gdnative.Rid, points gdnative.
 }
 
 /*
-        Adds a primitive to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false points PoolVector2Array} { false colors PoolColorArray} { false uvs PoolVector2Array} { false texture RID} {1 true width float} {[RID] true normal_map RID}], Returns: void
+	        Adds a primitive to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false points PoolVector2Array} { false colors PoolColorArray} { false uvs PoolVector2Array} { false texture RID} {1 true width float} {[RID] true normal_map RID}], Returns: void
 */
 func (o *visualServer) CanvasItemAddPrimitive(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array, texture gdnative.Rid, width gdnative.Real, normalMap gdnative.Rid) {
 	o.ensureSingleton()
@@ -983,7 +2670,7 @@ func (o *visualServer) CanvasItemAddPrimitive(item gdnative.Rid, points gdnative
 	ptrArguments[6] = gdnative.NewPointerFromRid(normalMap)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_primitive")
+	methodBind := methodBindVisualServerCanvasItemAddPrimitive.Get()
 
 	// Call the parent method.
 	// void
@@ -993,8 +2680,8 @@ func (o *visualServer) CanvasItemAddPrimitive(item gdnative.Rid, points gdnative
 }
 
 /*
-        Adds a rectangle to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false rect Rect2} { false color Color}], Returns: void
+	        Adds a rectangle to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false rect Rect2} { false color Color}], Returns: void
 */
 func (o *visualServer) CanvasItemAddRect(item gdnative.Rid, rect gdnative.Rect2, color gdnative.Color) {
 	o.ensureSingleton()
@@ -1007,7 +2694,7 @@ func (o *visualServer) CanvasItemAddRect(item gdnative.Rid, rect gdnative.Rect2,
 	ptrArguments[2] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_rect")
+	methodBind := methodBindVisualServerCanvasItemAddRect.Get()
 
 	// Call the parent method.
 	// void
@@ -1017,8 +2704,8 @@ func (o *visualServer) CanvasItemAddRect(item gdnative.Rid, rect gdnative.Rect2,
 }
 
 /*
-        Adds a [Transform2D] command to the [CanvasItem]'s draw commands. This sets the extra_matrix uniform when executed. This affects the later command's of the canvas item.
-	Args: [{ false item RID} { false transform Transform2D}], Returns: void
+	        Adds a [Transform2D] command to the [CanvasItem]'s draw commands. This sets the extra_matrix uniform when executed. This affects the later command's of the canvas item.
+		Args: [{ false item RID} { false transform Transform2D}], Returns: void
 */
 func (o *visualServer) CanvasItemAddSetTransform(item gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -1030,7 +2717,7 @@ func (o *visualServer) CanvasItemAddSetTransform(item gdnative.Rid, transform gd
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_set_transform")
+	methodBind := methodBindVisualServerCanvasItemAddSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1040,8 +2727,8 @@ func (o *visualServer) CanvasItemAddSetTransform(item gdnative.Rid, transform gd
 }
 
 /*
-        Adds a textured rect to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false rect Rect2} { false texture RID} {False true tile bool} {1,1,1,1 true modulate Color} {False true transpose bool} {[RID] true normal_map RID}], Returns: void
+	        Adds a textured rect to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false rect Rect2} { false texture RID} {False true tile bool} {1,1,1,1 true modulate Color} {False true transpose bool} {[RID] true normal_map RID}], Returns: void
 */
 func (o *visualServer) CanvasItemAddTextureRect(item gdnative.Rid, rect gdnative.Rect2, texture gdnative.Rid, tile gdnative.Bool, modulate gdnative.Color, transpose gdnative.Bool, normalMap gdnative.Rid) {
 	o.ensureSingleton()
@@ -1058,7 +2745,7 @@ func (o *visualServer) CanvasItemAddTextureRect(item gdnative.Rid, rect gdnative
 	ptrArguments[6] = gdnative.NewPointerFromRid(normalMap)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_texture_rect")
+	methodBind := methodBindVisualServerCanvasItemAddTextureRect.Get()
 
 	// Call the parent method.
 	// void
@@ -1068,8 +2755,8 @@ func (o *visualServer) CanvasItemAddTextureRect(item gdnative.Rid, rect gdnative
 }
 
 /*
-        Adds a texture rect with region setting to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false rect Rect2} { false texture RID} { false src_rect Rect2} {1,1,1,1 true modulate Color} {False true transpose bool} {[RID] true normal_map RID} {True true clip_uv bool}], Returns: void
+	        Adds a texture rect with region setting to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false rect Rect2} { false texture RID} { false src_rect Rect2} {1,1,1,1 true modulate Color} {False true transpose bool} {[RID] true normal_map RID} {True true clip_uv bool}], Returns: void
 */
 func (o *visualServer) CanvasItemAddTextureRectRegion(item gdnative.Rid, rect gdnative.Rect2, texture gdnative.Rid, srcRect gdnative.Rect2, modulate gdnative.Color, transpose gdnative.Bool, normalMap gdnative.Rid, clipUv gdnative.Bool) {
 	o.ensureSingleton()
@@ -1087,7 +2774,7 @@ func (o *visualServer) CanvasItemAddTextureRectRegion(item gdnative.Rid, rect gd
 	ptrArguments[7] = gdnative.NewPointerFromBool(clipUv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_texture_rect_region")
+	methodBind := methodBindVisualServerCanvasItemAddTextureRectRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -1097,8 +2784,8 @@ func (o *visualServer) CanvasItemAddTextureRectRegion(item gdnative.Rid, rect gd
 }
 
 /*
-        Adds a triangle array to the [CanvasItem]'s draw commands.
-	Args: [{ false item RID} { false indices PoolIntArray} { false points PoolVector2Array} { false colors PoolColorArray} {[] true uvs PoolVector2Array} {[RID] true texture RID} {-1 true count int} {[RID] true normal_map RID}], Returns: void
+	        Adds a triangle array to the [CanvasItem]'s draw commands.
+		Args: [{ false item RID} { false indices PoolIntArray} { false points PoolVector2Array} { false colors PoolColorArray} {[] true uvs PoolVector2Array} {[RID] true texture RID} {-1 true count int} {[RID] true normal_map RID}], Returns: void
 */
 func (o *visualServer) CanvasItemAddTriangleArray(item gdnative.Rid, indices gdnative.PoolIntArray, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array, texture gdnative.Rid, count gdnative.Int, normalMap gdnative.Rid) {
 	o.ensureSingleton()
@@ -1116,7 +2803,7 @@ func (o *visualServer) CanvasItemAddTriangleArray(item gdnative.Rid, indices gdn
 	ptrArguments[7] = gdnative.NewPointerFromRid(normalMap)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_add_triangle_array")
+	methodBind := methodBindVisualServerCanvasItemAddTriangleArray.Get()
 
 	// Call the parent method.
 	// void
@@ -1126,8 +2813,8 @@ func (o *visualServer) CanvasItemAddTriangleArray(item gdnative.Rid, indices gdn
 }
 
 /*
-        Clears the [CanvasItem] and removes all commands in it.
-	Args: [{ false item RID}], Returns: void
+	        Clears the [CanvasItem] and removes all commands in it.
+		Args: [{ false item RID}], Returns: void
 */
 func (o *visualServer) CanvasItemClear(item gdnative.Rid) {
 	o.ensureSingleton()
@@ -1138,7 +2825,7 @@ func (o *visualServer) CanvasItemClear(item gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(item)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_clear")
+	methodBind := methodBindVisualServerCanvasItemClear.Get()
 
 	// Call the parent method.
 	// void
@@ -1148,8 +2835,8 @@ func (o *visualServer) CanvasItemClear(item gdnative.Rid) {
 }
 
 /*
-        Creates a new [CanvasItem] and returns its [RID].
-	Args: [], Returns: RID
+	        Creates a new [CanvasItem] and returns its [RID].
+		Args: [], Returns: RID
 */
 func (o *visualServer) CanvasItemCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -1159,7 +2846,7 @@ func (o *visualServer) CanvasItemCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_create")
+	methodBind := methodBindVisualServerCanvasItemCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1172,8 +2859,8 @@ func (o *visualServer) CanvasItemCreate() gdnative.Rid {
 }
 
 /*
-        Sets clipping for the [CanvasItem].
-	Args: [{ false item RID} { false clip bool}], Returns: void
+	        Sets clipping for the [CanvasItem].
+		Args: [{ false item RID} { false clip bool}], Returns: void
 */
 func (o *visualServer) CanvasItemSetClip(item gdnative.Rid, clip gdnative.Bool) {
 	o.ensureSingleton()
@@ -1185,7 +2872,7 @@ func (o *visualServer) CanvasItemSetClip(item gdnative.Rid, clip gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(clip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_clip")
+	methodBind := methodBindVisualServerCanvasItemSetClip.Get()
 
 	// Call the parent method.
 	// void
@@ -1195,8 +2882,8 @@ func (o *visualServer) CanvasItemSetClip(item gdnative.Rid, clip gdnative.Bool)
 }
 
 /*
-        Sets the [CanvasItem] to copy a rect to the backbuffer.
-	Args: [{ false item RID} { false enabled bool} { false rect Rect2}], Returns: void
+	        Sets the [CanvasItem] to copy a rect to the backbuffer.
+		Args: [{ false item RID} { false enabled bool} { false rect Rect2}], Returns: void
 */
 func (o *visualServer) CanvasItemSetCopyToBackbuffer(item gdnative.Rid, enabled gdnative.Bool, rect gdnative.Rect2) {
 	o.ensureSingleton()
@@ -1209,7 +2896,7 @@ func (o *visualServer) CanvasItemSetCopyToBackbuffer(item gdnative.Rid, enabled
 	ptrArguments[2] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_copy_to_backbuffer")
+	methodBind := methodBindVisualServerCanvasItemSetCopyToBackbuffer.Get()
 
 	// Call the parent method.
 	// void
@@ -1219,8 +2906,8 @@ func (o *visualServer) CanvasItemSetCopyToBackbuffer(item gdnative.Rid, enabled
 }
 
 /*
-        Defines a custom drawing rectangle for the [CanvasItem].
-	Args: [{ false item RID} { false use_custom_rect bool} {(0, 0, 0, 0) true rect Rect2}], Returns: void
+	        Defines a custom drawing rectangle for the [CanvasItem].
+		Args: [{ false item RID} { false use_custom_rect bool} {(0, 0, 0, 0) true rect Rect2}], Returns: void
 */
 func (o *visualServer) CanvasItemSetCustomRect(item gdnative.Rid, useCustomRect gdnative.Bool, rect gdnative.Rect2) {
 	o.ensureSingleton()
@@ -1233,7 +2920,7 @@ func (o *visualServer) CanvasItemSetCustomRect(item gdnative.Rid, useCustomRect
 	ptrArguments[2] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_custom_rect")
+	methodBind := methodBindVisualServerCanvasItemSetCustomRect.Get()
 
 	// Call the parent method.
 	// void
@@ -1243,8 +2930,7 @@ func (o *visualServer) CanvasItemSetCustomRect(item gdnative.Rid, useCustomRect
 }
 
 /*
-
-	Args: [{ false item RID} { false enabled bool}], Returns: void
+Args: [{ false item RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasItemSetDistanceFieldMode(item gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -1256,7 +2942,7 @@ func (o *visualServer) CanvasItemSetDistanceFieldMode(item gdnative.Rid, enabled
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_distance_field_mode")
+	methodBind := methodBindVisualServerCanvasItemSetDistanceFieldMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1266,8 +2952,8 @@ func (o *visualServer) CanvasItemSetDistanceFieldMode(item gdnative.Rid, enabled
 }
 
 /*
-        Sets [CanvasItem] to be drawn behind its parent.
-	Args: [{ false item RID} { false enabled bool}], Returns: void
+	        Sets [CanvasItem] to be drawn behind its parent.
+		Args: [{ false item RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasItemSetDrawBehindParent(item gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -1279,7 +2965,7 @@ func (o *visualServer) CanvasItemSetDrawBehindParent(item gdnative.Rid, enabled
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_draw_behind_parent")
+	methodBind := methodBindVisualServerCanvasItemSetDrawBehindParent.Get()
 
 	// Call the parent method.
 	// void
@@ -1289,8 +2975,8 @@ func (o *visualServer) CanvasItemSetDrawBehindParent(item gdnative.Rid, enabled
 }
 
 /*
-        Sets the index for the [CanvasItem].
-	Args: [{ false item RID} { false index int}], Returns: void
+	        Sets the index for the [CanvasItem].
+		Args: [{ false item RID} { false index int}], Returns: void
 */
 func (o *visualServer) CanvasItemSetDrawIndex(item gdnative.Rid, index gdnative.Int) {
 	o.ensureSingleton()
@@ -1302,7 +2988,7 @@ func (o *visualServer) CanvasItemSetDrawIndex(item gdnative.Rid, index gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_draw_index")
+	methodBind := methodBindVisualServerCanvasItemSetDrawIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -1312,8 +2998,8 @@ func (o *visualServer) CanvasItemSetDrawIndex(item gdnative.Rid, index gdnative.
 }
 
 /*
-        The light mask. See [LightOccluder2D] for more information on light masks.
-	Args: [{ false item RID} { false mask int}], Returns: void
+	        The light mask. See [LightOccluder2D] for more information on light masks.
+		Args: [{ false item RID} { false mask int}], Returns: void
 */
 func (o *visualServer) CanvasItemSetLightMask(item gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -1325,7 +3011,7 @@ func (o *visualServer) CanvasItemSetLightMask(item gdnative.Rid, mask gdnative.I
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_light_mask")
+	methodBind := methodBindVisualServerCanvasItemSetLightMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1335,8 +3021,8 @@ func (o *visualServer) CanvasItemSetLightMask(item gdnative.Rid, mask gdnative.I
 }
 
 /*
-        Sets a new material to the [CanvasItem].
-	Args: [{ false item RID} { false material RID}], Returns: void
+	        Sets a new material to the [CanvasItem].
+		Args: [{ false item RID} { false material RID}], Returns: void
 */
 func (o *visualServer) CanvasItemSetMaterial(item gdnative.Rid, material gdnative.Rid) {
 	o.ensureSingleton()
@@ -1348,7 +3034,7 @@ func (o *visualServer) CanvasItemSetMaterial(item gdnative.Rid, material gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromRid(material)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_material")
+	methodBind := methodBindVisualServerCanvasItemSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -1358,8 +3044,8 @@ func (o *visualServer) CanvasItemSetMaterial(item gdnative.Rid, material gdnativ
 }
 
 /*
-        Sets the color that modulates the [CanvasItem] and its children.
-	Args: [{ false item RID} { false color Color}], Returns: void
+	        Sets the color that modulates the [CanvasItem] and its children.
+		Args: [{ false item RID} { false color Color}], Returns: void
 */
 func (o *visualServer) CanvasItemSetModulate(item gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -1371,7 +3057,7 @@ func (o *visualServer) CanvasItemSetModulate(item gdnative.Rid, color gdnative.C
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_modulate")
+	methodBind := methodBindVisualServerCanvasItemSetModulate.Get()
 
 	// Call the parent method.
 	// void
@@ -1381,8 +3067,8 @@ func (o *visualServer) CanvasItemSetModulate(item gdnative.Rid, color gdnative.C
 }
 
 /*
-        Sets the parent for the [CanvasItem].
-	Args: [{ false item RID} { false parent RID}], Returns: void
+	        Sets the parent for the [CanvasItem].
+		Args: [{ false item RID} { false parent RID}], Returns: void
 */
 func (o *visualServer) CanvasItemSetParent(item gdnative.Rid, parent gdnative.Rid) {
 	o.ensureSingleton()
@@ -1394,7 +3080,7 @@ func (o *visualServer) CanvasItemSetParent(item gdnative.Rid, parent gdnative.Ri
 	ptrArguments[1] = gdnative.NewPointerFromRid(parent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_parent")
+	methodBind := methodBindVisualServerCanvasItemSetParent.Get()
 
 	// Call the parent method.
 	// void
@@ -1404,8 +3090,8 @@ func (o *visualServer) CanvasItemSetParent(item gdnative.Rid, parent gdnative.Ri
 }
 
 /*
-        Sets the color that modulates the [CanvasItem] without children.
-	Args: [{ false item RID} { false color Color}], Returns: void
+	        Sets the color that modulates the [CanvasItem] without children.
+		Args: [{ false item RID} { false color Color}], Returns: void
 */
 func (o *visualServer) CanvasItemSetSelfModulate(item gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -1417,7 +3103,7 @@ func (o *visualServer) CanvasItemSetSelfModulate(item gdnative.Rid, color gdnati
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_self_modulate")
+	methodBind := methodBindVisualServerCanvasItemSetSelfModulate.Get()
 
 	// Call the parent method.
 	// void
@@ -1427,8 +3113,8 @@ func (o *visualServer) CanvasItemSetSelfModulate(item gdnative.Rid, color gdnati
 }
 
 /*
-        Sets if [CanvasItem]'s children should be sorted by y-position.
-	Args: [{ false item RID} { false enabled bool}], Returns: void
+	        Sets if [CanvasItem]'s children should be sorted by y-position.
+		Args: [{ false item RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasItemSetSortChildrenByY(item gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -1440,7 +3126,7 @@ func (o *visualServer) CanvasItemSetSortChildrenByY(item gdnative.Rid, enabled g
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_sort_children_by_y")
+	methodBind := methodBindVisualServerCanvasItemSetSortChildrenByY.Get()
 
 	// Call the parent method.
 	// void
@@ -1450,8 +3136,8 @@ func (o *visualServer) CanvasItemSetSortChildrenByY(item gdnative.Rid, enabled g
 }
 
 /*
-        Sets the [CanvasItem]'s [Transform2D].
-	Args: [{ false item RID} { false transform Transform2D}], Returns: void
+	        Sets the [CanvasItem]'s [Transform2D].
+		Args: [{ false item RID} { false transform Transform2D}], Returns: void
 */
 func (o *visualServer) CanvasItemSetTransform(item gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -1463,7 +3149,7 @@ func (o *visualServer) CanvasItemSetTransform(item gdnative.Rid, transform gdnat
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_transform")
+	methodBind := methodBindVisualServerCanvasItemSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1473,8 +3159,8 @@ func (o *visualServer) CanvasItemSetTransform(item gdnative.Rid, transform gdnat
 }
 
 /*
-        Sets if the [CanvasItem] uses its parent's material.
-	Args: [{ false item RID} { false enabled bool}], Returns: void
+	        Sets if the [CanvasItem] uses its parent's material.
+		Args: [{ false item RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasItemSetUseParentMaterial(item gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -1486,7 +3172,7 @@ func (o *visualServer) CanvasItemSetUseParentMaterial(item gdnative.Rid, enabled
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_use_parent_material")
+	methodBind := methodBindVisualServerCanvasItemSetUseParentMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -1496,8 +3182,8 @@ func (o *visualServer) CanvasItemSetUseParentMaterial(item gdnative.Rid, enabled
 }
 
 /*
-        Sets if the canvas item (including its children) is visible.
-	Args: [{ false item RID} { false visible bool}], Returns: void
+	        Sets if the canvas item (including its children) is visible.
+		Args: [{ false item RID} { false visible bool}], Returns: void
 */
 func (o *visualServer) CanvasItemSetVisible(item gdnative.Rid, visible gdnative.Bool) {
 	o.ensureSingleton()
@@ -1509,7 +3195,7 @@ func (o *visualServer) CanvasItemSetVisible(item gdnative.Rid, visible gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromBool(visible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_visible")
+	methodBind := methodBindVisualServerCanvasItemSetVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -1519,8 +3205,8 @@ func (o *visualServer) CanvasItemSetVisible(item gdnative.Rid, visible gdnative.
 }
 
 /*
-        If this is enabled, the z-index of the parent will be added to the children's z-index.
-	Args: [{ false item RID} { false enabled bool}], Returns: void
+	        If this is enabled, the z-index of the parent will be added to the children's z-index.
+		Args: [{ false item RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasItemSetZAsRelativeToParent(item gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -1532,7 +3218,7 @@ func (o *visualServer) CanvasItemSetZAsRelativeToParent(item gdnative.Rid, enabl
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_z_as_relative_to_parent")
+	methodBind := methodBindVisualServerCanvasItemSetZAsRelativeToParent.Get()
 
 	// Call the parent method.
 	// void
@@ -1542,8 +3228,8 @@ func (o *visualServer) CanvasItemSetZAsRelativeToParent(item gdnative.Rid, enabl
 }
 
 /*
-        Sets the [CanvasItem]'s z-index, i.e. its draw order (lower indexes are drawn first).
-	Args: [{ false item RID} { false z_index int}], Returns: void
+	        Sets the [CanvasItem]'s z-index, i.e. its draw order (lower indexes are drawn first).
+		Args: [{ false item RID} { false z_index int}], Returns: void
 */
 func (o *visualServer) CanvasItemSetZIndex(item gdnative.Rid, zIndex gdnative.Int) {
 	o.ensureSingleton()
@@ -1555,7 +3241,7 @@ func (o *visualServer) CanvasItemSetZIndex(item gdnative.Rid, zIndex gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(zIndex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_item_set_z_index")
+	methodBind := methodBindVisualServerCanvasItemSetZIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -1565,8 +3251,8 @@ func (o *visualServer) CanvasItemSetZIndex(item gdnative.Rid, zIndex gdnative.In
 }
 
 /*
-        Attaches the canvas light to the canvas. Removes it from its previous canvas.
-	Args: [{ false light RID} { false canvas RID}], Returns: void
+	        Attaches the canvas light to the canvas. Removes it from its previous canvas.
+		Args: [{ false light RID} { false canvas RID}], Returns: void
 */
 func (o *visualServer) CanvasLightAttachToCanvas(light gdnative.Rid, canvas gdnative.Rid) {
 	o.ensureSingleton()
@@ -1578,7 +3264,7 @@ func (o *visualServer) CanvasLightAttachToCanvas(light gdnative.Rid, canvas gdna
 	ptrArguments[1] = gdnative.NewPointerFromRid(canvas)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_attach_to_canvas")
+	methodBind := methodBindVisualServerCanvasLightAttachToCanvas.Get()
 
 	// Call the parent method.
 	// void
@@ -1588,8 +3274,8 @@ func (o *visualServer) CanvasLightAttachToCanvas(light gdnative.Rid, canvas gdna
 }
 
 /*
-        Creates a canvas light.
-	Args: [], Returns: RID
+	        Creates a canvas light.
+		Args: [], Returns: RID
 */
 func (o *visualServer) CanvasLightCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -1599,7 +3285,7 @@ func (o *visualServer) CanvasLightCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_create")
+	methodBind := methodBindVisualServerCanvasLightCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1612,8 +3298,8 @@ func (o *visualServer) CanvasLightCreate() gdnative.Rid {
 }
 
 /*
-        Attaches a light occluder to the canvas. Removes it from its previous canvas.
-	Args: [{ false occluder RID} { false canvas RID}], Returns: void
+	        Attaches a light occluder to the canvas. Removes it from its previous canvas.
+		Args: [{ false occluder RID} { false canvas RID}], Returns: void
 */
 func (o *visualServer) CanvasLightOccluderAttachToCanvas(occluder gdnative.Rid, canvas gdnative.Rid) {
 	o.ensureSingleton()
@@ -1625,7 +3311,7 @@ func (o *visualServer) CanvasLightOccluderAttachToCanvas(occluder gdnative.Rid,
 	ptrArguments[1] = gdnative.NewPointerFromRid(canvas)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_occluder_attach_to_canvas")
+	methodBind := methodBindVisualServerCanvasLightOccluderAttachToCanvas.Get()
 
 	// Call the parent method.
 	// void
@@ -1635,8 +3321,8 @@ func (o *visualServer) CanvasLightOccluderAttachToCanvas(occluder gdnative.Rid,
 }
 
 /*
-        Creates a light occluder.
-	Args: [], Returns: RID
+	        Creates a light occluder.
+		Args: [], Returns: RID
 */
 func (o *visualServer) CanvasLightOccluderCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -1646,7 +3332,7 @@ func (o *visualServer) CanvasLightOccluderCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_occluder_create")
+	methodBind := methodBindVisualServerCanvasLightOccluderCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1659,8 +3345,8 @@ func (o *visualServer) CanvasLightOccluderCreate() gdnative.Rid {
 }
 
 /*
-        Enables or disables light occluder.
-	Args: [{ false occluder RID} { false enabled bool}], Returns: void
+	        Enables or disables light occluder.
+		Args: [{ false occluder RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasLightOccluderSetEnabled(occluder gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -1672,7 +3358,7 @@ func (o *visualServer) CanvasLightOccluderSetEnabled(occluder gdnative.Rid, enab
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_occluder_set_enabled")
+	methodBind := methodBindVisualServerCanvasLightOccluderSetEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1682,8 +3368,8 @@ func (o *visualServer) CanvasLightOccluderSetEnabled(occluder gdnative.Rid, enab
 }
 
 /*
-        The light mask. See [LightOccluder2D] for more information on light masks
-	Args: [{ false occluder RID} { false mask int}], Returns: void
+	        The light mask. See [LightOccluder2D] for more information on light masks
+		Args: [{ false occluder RID} { false mask int}], Returns: void
 */
 func (o *visualServer) CanvasLightOccluderSetLightMask(occluder gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -1695,7 +3381,7 @@ func (o *visualServer) CanvasLightOccluderSetLightMask(occluder gdnative.Rid, ma
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_occluder_set_light_mask")
+	methodBind := methodBindVisualServerCanvasLightOccluderSetLightMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1705,8 +3391,8 @@ func (o *visualServer) CanvasLightOccluderSetLightMask(occluder gdnative.Rid, ma
 }
 
 /*
-        Sets a light occluder's polygon.
-	Args: [{ false occluder RID} { false polygon RID}], Returns: void
+	        Sets a light occluder's polygon.
+		Args: [{ false occluder RID} { false polygon RID}], Returns: void
 */
 func (o *visualServer) CanvasLightOccluderSetPolygon(occluder gdnative.Rid, polygon gdnative.Rid) {
 	o.ensureSingleton()
@@ -1718,7 +3404,7 @@ func (o *visualServer) CanvasLightOccluderSetPolygon(occluder gdnative.Rid, poly
 	ptrArguments[1] = gdnative.NewPointerFromRid(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_occluder_set_polygon")
+	methodBind := methodBindVisualServerCanvasLightOccluderSetPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -1728,8 +3414,8 @@ func (o *visualServer) CanvasLightOccluderSetPolygon(occluder gdnative.Rid, poly
 }
 
 /*
-        Sets a light occluder's [Transform2D].
-	Args: [{ false occluder RID} { false transform Transform2D}], Returns: void
+	        Sets a light occluder's [Transform2D].
+		Args: [{ false occluder RID} { false transform Transform2D}], Returns: void
 */
 func (o *visualServer) CanvasLightOccluderSetTransform(occluder gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -1741,7 +3427,7 @@ func (o *visualServer) CanvasLightOccluderSetTransform(occluder gdnative.Rid, tr
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_occluder_set_transform")
+	methodBind := methodBindVisualServerCanvasLightOccluderSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1751,8 +3437,8 @@ func (o *visualServer) CanvasLightOccluderSetTransform(occluder gdnative.Rid, tr
 }
 
 /*
-        Sets the color for a light.
-	Args: [{ false light RID} { false color Color}], Returns: void
+	        Sets the color for a light.
+		Args: [{ false light RID} { false color Color}], Returns: void
 */
 func (o *visualServer) CanvasLightSetColor(light gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -1764,7 +3450,7 @@ func (o *visualServer) CanvasLightSetColor(light gdnative.Rid, color gdnative.Co
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_color")
+	methodBind := methodBindVisualServerCanvasLightSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -1774,8 +3460,8 @@ func (o *visualServer) CanvasLightSetColor(light gdnative.Rid, color gdnative.Co
 }
 
 /*
-        Enables or disables a canvas light.
-	Args: [{ false light RID} { false enabled bool}], Returns: void
+	        Enables or disables a canvas light.
+		Args: [{ false light RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasLightSetEnabled(light gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -1787,7 +3473,7 @@ func (o *visualServer) CanvasLightSetEnabled(light gdnative.Rid, enabled gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_enabled")
+	methodBind := methodBindVisualServerCanvasLightSetEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1797,8 +3483,8 @@ func (o *visualServer) CanvasLightSetEnabled(light gdnative.Rid, enabled gdnativ
 }
 
 /*
-        Sets a canvas light's energy.
-	Args: [{ false light RID} { false energy float}], Returns: void
+	        Sets a canvas light's energy.
+		Args: [{ false light RID} { false energy float}], Returns: void
 */
 func (o *visualServer) CanvasLightSetEnergy(light gdnative.Rid, energy gdnative.Real) {
 	o.ensureSingleton()
@@ -1810,7 +3496,7 @@ func (o *visualServer) CanvasLightSetEnergy(light gdnative.Rid, energy gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_energy")
+	methodBind := methodBindVisualServerCanvasLightSetEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -1820,8 +3506,8 @@ func (o *visualServer) CanvasLightSetEnergy(light gdnative.Rid, energy gdnative.
 }
 
 /*
-        Sets a canvas light's height.
-	Args: [{ false light RID} { false height float}], Returns: void
+	        Sets a canvas light's height.
+		Args: [{ false light RID} { false height float}], Returns: void
 */
 func (o *visualServer) CanvasLightSetHeight(light gdnative.Rid, height gdnative.Real) {
 	o.ensureSingleton()
@@ -1833,7 +3519,7 @@ func (o *visualServer) CanvasLightSetHeight(light gdnative.Rid, height gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_height")
+	methodBind := methodBindVisualServerCanvasLightSetHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -1843,8 +3529,8 @@ func (o *visualServer) CanvasLightSetHeight(light gdnative.Rid, height gdnative.
 }
 
 /*
-        The light mask. See [LightOccluder2D] for more information on light masks
-	Args: [{ false light RID} { false mask int}], Returns: void
+	        The light mask. See [LightOccluder2D] for more information on light masks
+		Args: [{ false light RID} { false mask int}], Returns: void
 */
 func (o *visualServer) CanvasLightSetItemCullMask(light gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -1856,7 +3542,7 @@ func (o *visualServer) CanvasLightSetItemCullMask(light gdnative.Rid, mask gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_item_cull_mask")
+	methodBind := methodBindVisualServerCanvasLightSetItemCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1866,8 +3552,8 @@ func (o *visualServer) CanvasLightSetItemCullMask(light gdnative.Rid, mask gdnat
 }
 
 /*
-        The shadow mask. binary about which layers this canvas light affects which canvas item's shadows. See [LightOccluder2D] for more information on light masks.
-	Args: [{ false light RID} { false mask int}], Returns: void
+	        The shadow mask. binary about which layers this canvas light affects which canvas item's shadows. See [LightOccluder2D] for more information on light masks.
+		Args: [{ false light RID} { false mask int}], Returns: void
 */
 func (o *visualServer) CanvasLightSetItemShadowCullMask(light gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -1879,7 +3565,7 @@ func (o *visualServer) CanvasLightSetItemShadowCullMask(light gdnative.Rid, mask
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_item_shadow_cull_mask")
+	methodBind := methodBindVisualServerCanvasLightSetItemShadowCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1889,8 +3575,8 @@ func (o *visualServer) CanvasLightSetItemShadowCullMask(light gdnative.Rid, mask
 }
 
 /*
-        The layer range that gets rendered with this light.
-	Args: [{ false light RID} { false min_layer int} { false max_layer int}], Returns: void
+	        The layer range that gets rendered with this light.
+		Args: [{ false light RID} { false min_layer int} { false max_layer int}], Returns: void
 */
 func (o *visualServer) CanvasLightSetLayerRange(light gdnative.Rid, minLayer gdnative.Int, maxLayer gdnative.Int) {
 	o.ensureSingleton()
@@ -1903,7 +3589,7 @@ func (o *visualServer) CanvasLightSetLayerRange(light gdnative.Rid, minLayer gdn
 	ptrArguments[2] = gdnative.NewPointerFromInt(maxLayer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_layer_range")
+	methodBind := methodBindVisualServerCanvasLightSetLayerRange.Get()
 
 	// Call the parent method.
 	// void
@@ -1913,8 +3599,8 @@ func (o *visualServer) CanvasLightSetLayerRange(light gdnative.Rid, minLayer gdn
 }
 
 /*
-        The mode of the light, see CANVAS_LIGHT_MODE_* constants.
-	Args: [{ false light RID} { false mode int}], Returns: void
+	        The mode of the light, see CANVAS_LIGHT_MODE_* constants.
+		Args: [{ false light RID} { false mode int}], Returns: void
 */
 func (o *visualServer) CanvasLightSetMode(light gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -1926,7 +3612,7 @@ func (o *visualServer) CanvasLightSetMode(light gdnative.Rid, mode gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_mode")
+	methodBind := methodBindVisualServerCanvasLightSetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1936,8 +3622,7 @@ func (o *visualServer) CanvasLightSetMode(light gdnative.Rid, mode gdnative.Int)
 }
 
 /*
-
-	Args: [{ false light RID} { false scale float}], Returns: void
+Args: [{ false light RID} { false scale float}], Returns: void
 */
 func (o *visualServer) CanvasLightSetScale(light gdnative.Rid, scale gdnative.Real) {
 	o.ensureSingleton()
@@ -1949,7 +3634,7 @@ func (o *visualServer) CanvasLightSetScale(light gdnative.Rid, scale gdnative.Re
 	ptrArguments[1] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_scale")
+	methodBind := methodBindVisualServerCanvasLightSetScale.Get()
 
 	// Call the parent method.
 	// void
@@ -1959,8 +3644,8 @@ func (o *visualServer) CanvasLightSetScale(light gdnative.Rid, scale gdnative.Re
 }
 
 /*
-        Sets the width of the shadow buffer, size gets scaled to the next power of two for this.
-	Args: [{ false light RID} { false size int}], Returns: void
+	        Sets the width of the shadow buffer, size gets scaled to the next power of two for this.
+		Args: [{ false light RID} { false size int}], Returns: void
 */
 func (o *visualServer) CanvasLightSetShadowBufferSize(light gdnative.Rid, size gdnative.Int) {
 	o.ensureSingleton()
@@ -1972,7 +3657,7 @@ func (o *visualServer) CanvasLightSetShadowBufferSize(light gdnative.Rid, size g
 	ptrArguments[1] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_shadow_buffer_size")
+	methodBind := methodBindVisualServerCanvasLightSetShadowBufferSize.Get()
 
 	// Call the parent method.
 	// void
@@ -1982,8 +3667,8 @@ func (o *visualServer) CanvasLightSetShadowBufferSize(light gdnative.Rid, size g
 }
 
 /*
-        Sets the color of the canvas light's shadow.
-	Args: [{ false light RID} { false color Color}], Returns: void
+	        Sets the color of the canvas light's shadow.
+		Args: [{ false light RID} { false color Color}], Returns: void
 */
 func (o *visualServer) CanvasLightSetShadowColor(light gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -1995,7 +3680,7 @@ func (o *visualServer) CanvasLightSetShadowColor(light gdnative.Rid, color gdnat
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_shadow_color")
+	methodBind := methodBindVisualServerCanvasLightSetShadowColor.Get()
 
 	// Call the parent method.
 	// void
@@ -2005,8 +3690,8 @@ func (o *visualServer) CanvasLightSetShadowColor(light gdnative.Rid, color gdnat
 }
 
 /*
-        Enables or disables the canvas light's shadow.
-	Args: [{ false light RID} { false enabled bool}], Returns: void
+	        Enables or disables the canvas light's shadow.
+		Args: [{ false light RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) CanvasLightSetShadowEnabled(light gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2018,7 +3703,7 @@ func (o *visualServer) CanvasLightSetShadowEnabled(light gdnative.Rid, enabled g
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_shadow_enabled")
+	methodBind := methodBindVisualServerCanvasLightSetShadowEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -2028,8 +3713,8 @@ func (o *visualServer) CanvasLightSetShadowEnabled(light gdnative.Rid, enabled g
 }
 
 /*
-        Sets the canvas light's shadow's filter, see CANVAS_LIGHT_SHADOW_FILTER_* constants.
-	Args: [{ false light RID} { false filter int}], Returns: void
+	        Sets the canvas light's shadow's filter, see CANVAS_LIGHT_SHADOW_FILTER_* constants.
+		Args: [{ false light RID} { false filter int}], Returns: void
 */
 func (o *visualServer) CanvasLightSetShadowFilter(light gdnative.Rid, filter gdnative.Int) {
 	o.ensureSingleton()
@@ -2041,7 +3726,7 @@ func (o *visualServer) CanvasLightSetShadowFilter(light gdnative.Rid, filter gdn
 	ptrArguments[1] = gdnative.NewPointerFromInt(filter)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_shadow_filter")
+	methodBind := methodBindVisualServerCanvasLightSetShadowFilter.Get()
 
 	// Call the parent method.
 	// void
@@ -2051,8 +3736,8 @@ func (o *visualServer) CanvasLightSetShadowFilter(light gdnative.Rid, filter gdn
 }
 
 /*
-        Sets the length of the shadow's gradient.
-	Args: [{ false light RID} { false length float}], Returns: void
+	        Sets the length of the shadow's gradient.
+		Args: [{ false light RID} { false length float}], Returns: void
 */
 func (o *visualServer) CanvasLightSetShadowGradientLength(light gdnative.Rid, length gdnative.Real) {
 	o.ensureSingleton()
@@ -2064,7 +3749,7 @@ func (o *visualServer) CanvasLightSetShadowGradientLength(light gdnative.Rid, le
 	ptrArguments[1] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_shadow_gradient_length")
+	methodBind := methodBindVisualServerCanvasLightSetShadowGradientLength.Get()
 
 	// Call the parent method.
 	// void
@@ -2074,8 +3759,8 @@ func (o *visualServer) CanvasLightSetShadowGradientLength(light gdnative.Rid, le
 }
 
 /*
-        Smoothens the shadow. The lower, the more smooth.
-	Args: [{ false light RID} { false smooth float}], Returns: void
+	        Smoothens the shadow. The lower, the more smooth.
+		Args: [{ false light RID} { false smooth float}], Returns: void
 */
 func (o *visualServer) CanvasLightSetShadowSmooth(light gdnative.Rid, smooth gdnative.Real) {
 	o.ensureSingleton()
@@ -2087,7 +3772,7 @@ func (o *visualServer) CanvasLightSetShadowSmooth(light gdnative.Rid, smooth gdn
 	ptrArguments[1] = gdnative.NewPointerFromReal(smooth)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_shadow_smooth")
+	methodBind := methodBindVisualServerCanvasLightSetShadowSmooth.Get()
 
 	// Call the parent method.
 	// void
@@ -2097,8 +3782,7 @@ func (o *visualServer) CanvasLightSetShadowSmooth(light gdnative.Rid, smooth gdn
 }
 
 /*
-
-	Args: [{ false light RID} { false texture RID}], Returns: void
+Args: [{ false light RID} { false texture RID}], Returns: void
 */
 func (o *visualServer) CanvasLightSetTexture(light gdnative.Rid, texture gdnative.Rid) {
 	o.ensureSingleton()
@@ -2110,7 +3794,7 @@ func (o *visualServer) CanvasLightSetTexture(light gdnative.Rid, texture gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_texture")
+	methodBind := methodBindVisualServerCanvasLightSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -2120,8 +3804,7 @@ func (o *visualServer) CanvasLightSetTexture(light gdnative.Rid, texture gdnativ
 }
 
 /*
-
-	Args: [{ false light RID} { false offset Vector2}], Returns: void
+Args: [{ false light RID} { false offset Vector2}], Returns: void
 */
 func (o *visualServer) CanvasLightSetTextureOffset(light gdnative.Rid, offset gdnative.Vector2) {
 	o.ensureSingleton()
@@ -2133,7 +3816,7 @@ func (o *visualServer) CanvasLightSetTextureOffset(light gdnative.Rid, offset gd
 	ptrArguments[1] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_texture_offset")
+	methodBind := methodBindVisualServerCanvasLightSetTextureOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -2143,8 +3826,8 @@ func (o *visualServer) CanvasLightSetTextureOffset(light gdnative.Rid, offset gd
 }
 
 /*
-        Sets the canvas light's [Transform2D].
-	Args: [{ false light RID} { false transform Transform2D}], Returns: void
+	        Sets the canvas light's [Transform2D].
+		Args: [{ false light RID} { false transform Transform2D}], Returns: void
 */
 func (o *visualServer) CanvasLightSetTransform(light gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -2156,7 +3839,7 @@ func (o *visualServer) CanvasLightSetTransform(light gdnative.Rid, transform gdn
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_transform")
+	methodBind := methodBindVisualServerCanvasLightSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -2166,8 +3849,7 @@ func (o *visualServer) CanvasLightSetTransform(light gdnative.Rid, transform gdn
 }
 
 /*
-
-	Args: [{ false light RID} { false min_z int} { false max_z int}], Returns: void
+Args: [{ false light RID} { false min_z int} { false max_z int}], Returns: void
 */
 func (o *visualServer) CanvasLightSetZRange(light gdnative.Rid, minZ gdnative.Int, maxZ gdnative.Int) {
 	o.ensureSingleton()
@@ -2180,7 +3862,7 @@ func (o *visualServer) CanvasLightSetZRange(light gdnative.Rid, minZ gdnative.In
 	ptrArguments[2] = gdnative.NewPointerFromInt(maxZ)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_light_set_z_range")
+	methodBind := methodBindVisualServerCanvasLightSetZRange.Get()
 
 	// Call the parent method.
 	// void
@@ -2190,8 +3872,8 @@ func (o *visualServer) CanvasLightSetZRange(light gdnative.Rid, minZ gdnative.In
 }
 
 /*
-        Creates a new light occluder polygon.
-	Args: [], Returns: RID
+	        Creates a new light occluder polygon.
+		Args: [], Returns: RID
 */
 func (o *visualServer) CanvasOccluderPolygonCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2201,7 +3883,7 @@ func (o *visualServer) CanvasOccluderPolygonCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_occluder_polygon_create")
+	methodBind := methodBindVisualServerCanvasOccluderPolygonCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2214,8 +3896,8 @@ func (o *visualServer) CanvasOccluderPolygonCreate() gdnative.Rid {
 }
 
 /*
-        Sets an occluder polygons cull mode. See CANVAS_OCCLUDER_POLYGON_CULL_MODE_* constants.
-	Args: [{ false occluder_polygon RID} { false mode int}], Returns: void
+	        Sets an occluder polygons cull mode. See CANVAS_OCCLUDER_POLYGON_CULL_MODE_* constants.
+		Args: [{ false occluder_polygon RID} { false mode int}], Returns: void
 */
 func (o *visualServer) CanvasOccluderPolygonSetCullMode(occluderPolygon gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -2227,7 +3909,7 @@ func (o *visualServer) CanvasOccluderPolygonSetCullMode(occluderPolygon gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_occluder_polygon_set_cull_mode")
+	methodBind := methodBindVisualServerCanvasOccluderPolygonSetCullMode.Get()
 
 	// Call the parent method.
 	// void
@@ -2237,8 +3919,8 @@ func (o *visualServer) CanvasOccluderPolygonSetCullMode(occluderPolygon gdnative
 }
 
 /*
-        Sets the shape of the occluder polygon.
-	Args: [{ false occluder_polygon RID} { false shape PoolVector2Array} { false closed bool}], Returns: void
+	        Sets the shape of the occluder polygon.
+		Args: [{ false occluder_polygon RID} { false shape PoolVector2Array} { false closed bool}], Returns: void
 */
 func (o *visualServer) CanvasOccluderPolygonSetShape(occluderPolygon gdnative.Rid, shape gdnative.PoolVector2Array, closed gdnative.Bool) {
 	o.ensureSingleton()
@@ -2251,7 +3933,7 @@ func (o *visualServer) CanvasOccluderPolygonSetShape(occluderPolygon gdnative.Ri
 	ptrArguments[2] = gdnative.NewPointerFromBool(closed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_occluder_polygon_set_shape")
+	methodBind := methodBindVisualServerCanvasOccluderPolygonSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -2261,8 +3943,8 @@ func (o *visualServer) CanvasOccluderPolygonSetShape(occluderPolygon gdnative.Ri
 }
 
 /*
-        Sets the shape of the occluder polygon as lines.
-	Args: [{ false occluder_polygon RID} { false shape PoolVector2Array}], Returns: void
+	        Sets the shape of the occluder polygon as lines.
+		Args: [{ false occluder_polygon RID} { false shape PoolVector2Array}], Returns: void
 */
 func (o *visualServer) CanvasOccluderPolygonSetShapeAsLines(occluderPolygon gdnative.Rid, shape gdnative.PoolVector2Array) {
 	o.ensureSingleton()
@@ -2274,7 +3956,7 @@ func (o *visualServer) CanvasOccluderPolygonSetShapeAsLines(occluderPolygon gdna
 	ptrArguments[1] = gdnative.NewPointerFromPoolVector2Array(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_occluder_polygon_set_shape_as_lines")
+	methodBind := methodBindVisualServerCanvasOccluderPolygonSetShapeAsLines.Get()
 
 	// Call the parent method.
 	// void
@@ -2284,8 +3966,8 @@ func (o *visualServer) CanvasOccluderPolygonSetShapeAsLines(occluderPolygon gdna
 }
 
 /*
-        A copy of the canvas item will be drawn with a local offset of the mirroring [Vector2].
-	Args: [{ false canvas RID} { false item RID} { false mirroring Vector2}], Returns: void
+	        A copy of the canvas item will be drawn with a local offset of the mirroring [Vector2].
+		Args: [{ false canvas RID} { false item RID} { false mirroring Vector2}], Returns: void
 */
 func (o *visualServer) CanvasSetItemMirroring(canvas gdnative.Rid, item gdnative.Rid, mirroring gdnative.Vector2) {
 	o.ensureSingleton()
@@ -2298,7 +3980,7 @@ func (o *visualServer) CanvasSetItemMirroring(canvas gdnative.Rid, item gdnative
 	ptrArguments[2] = gdnative.NewPointerFromVector2(mirroring)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_set_item_mirroring")
+	methodBind := methodBindVisualServerCanvasSetItemMirroring.Get()
 
 	// Call the parent method.
 	// void
@@ -2308,8 +3990,8 @@ func (o *visualServer) CanvasSetItemMirroring(canvas gdnative.Rid, item gdnative
 }
 
 /*
-        Modulates all colors in the given canvas.
-	Args: [{ false canvas RID} { false color Color}], Returns: void
+	        Modulates all colors in the given canvas.
+		Args: [{ false canvas RID} { false color Color}], Returns: void
 */
 func (o *visualServer) CanvasSetModulate(canvas gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -2321,7 +4003,7 @@ func (o *visualServer) CanvasSetModulate(canvas gdnative.Rid, color gdnative.Col
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "canvas_set_modulate")
+	methodBind := methodBindVisualServerCanvasSetModulate.Get()
 
 	// Call the parent method.
 	// void
@@ -2331,8 +4013,7 @@ func (o *visualServer) CanvasSetModulate(canvas gdnative.Rid, color gdnative.Col
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) DirectionalLightCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2342,7 +4023,7 @@ func (o *visualServer) DirectionalLightCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "directional_light_create")
+	methodBind := methodBindVisualServerDirectionalLightCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2355,8 +4036,8 @@ func (o *visualServer) DirectionalLightCreate() gdnative.Rid {
 }
 
 /*
-        Draws a frame.
-	Args: [{True true swap_buffers bool}], Returns: void
+	        Draws a frame.
+		Args: [{True true swap_buffers bool}], Returns: void
 */
 func (o *visualServer) Draw(swapBuffers gdnative.Bool) {
 	o.ensureSingleton()
@@ -2367,7 +4048,7 @@ func (o *visualServer) Draw(swapBuffers gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(swapBuffers)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "draw")
+	methodBind := methodBindVisualServerDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -2377,8 +4058,7 @@ func (o *visualServer) Draw(swapBuffers gdnative.Bool) {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) EnvironmentCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2388,7 +4068,7 @@ func (o *visualServer) EnvironmentCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_create")
+	methodBind := methodBindVisualServerEnvironmentCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2401,8 +4081,7 @@ func (o *visualServer) EnvironmentCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false brightness float} { false contrast float} { false saturation float} { false ramp RID}], Returns: void
+Args: [{ false env RID} { false enable bool} { false brightness float} { false contrast float} { false saturation float} { false ramp RID}], Returns: void
 */
 func (o *visualServer) EnvironmentSetAdjustment(env gdnative.Rid, enable gdnative.Bool, brightness gdnative.Real, contrast gdnative.Real, saturation gdnative.Real, ramp gdnative.Rid) {
 	o.ensureSingleton()
@@ -2418,7 +4097,7 @@ func (o *visualServer) EnvironmentSetAdjustment(env gdnative.Rid, enable gdnativ
 	ptrArguments[5] = gdnative.NewPointerFromRid(ramp)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_adjustment")
+	methodBind := methodBindVisualServerEnvironmentSetAdjustment.Get()
 
 	// Call the parent method.
 	// void
@@ -2428,8 +4107,7 @@ func (o *visualServer) EnvironmentSetAdjustment(env gdnative.Rid, enable gdnativ
 }
 
 /*
-
-	Args: [{ false env RID} { false color Color} {1 true energy float} {0 true sky_contibution float}], Returns: void
+Args: [{ false env RID} { false color Color} {1 true energy float} {0 true sky_contibution float}], Returns: void
 */
 func (o *visualServer) EnvironmentSetAmbientLight(env gdnative.Rid, color gdnative.Color, energy gdnative.Real, skyContibution gdnative.Real) {
 	o.ensureSingleton()
@@ -2443,7 +4121,7 @@ func (o *visualServer) EnvironmentSetAmbientLight(env gdnative.Rid, color gdnati
 	ptrArguments[3] = gdnative.NewPointerFromReal(skyContibution)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_ambient_light")
+	methodBind := methodBindVisualServerEnvironmentSetAmbientLight.Get()
 
 	// Call the parent method.
 	// void
@@ -2453,8 +4131,7 @@ func (o *visualServer) EnvironmentSetAmbientLight(env gdnative.Rid, color gdnati
 }
 
 /*
-
-	Args: [{ false env RID} { false bg int}], Returns: void
+Args: [{ false env RID} { false bg int}], Returns: void
 */
 func (o *visualServer) EnvironmentSetBackground(env gdnative.Rid, bg gdnative.Int) {
 	o.ensureSingleton()
@@ -2466,7 +4143,7 @@ func (o *visualServer) EnvironmentSetBackground(env gdnative.Rid, bg gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(bg)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_background")
+	methodBind := methodBindVisualServerEnvironmentSetBackground.Get()
 
 	// Call the parent method.
 	// void
@@ -2476,8 +4153,7 @@ func (o *visualServer) EnvironmentSetBackground(env gdnative.Rid, bg gdnative.In
 }
 
 /*
-
-	Args: [{ false env RID} { false color Color}], Returns: void
+Args: [{ false env RID} { false color Color}], Returns: void
 */
 func (o *visualServer) EnvironmentSetBgColor(env gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -2489,7 +4165,7 @@ func (o *visualServer) EnvironmentSetBgColor(env gdnative.Rid, color gdnative.Co
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_bg_color")
+	methodBind := methodBindVisualServerEnvironmentSetBgColor.Get()
 
 	// Call the parent method.
 	// void
@@ -2499,8 +4175,7 @@ func (o *visualServer) EnvironmentSetBgColor(env gdnative.Rid, color gdnative.Co
 }
 
 /*
-
-	Args: [{ false env RID} { false energy float}], Returns: void
+Args: [{ false env RID} { false energy float}], Returns: void
 */
 func (o *visualServer) EnvironmentSetBgEnergy(env gdnative.Rid, energy gdnative.Real) {
 	o.ensureSingleton()
@@ -2512,7 +4187,7 @@ func (o *visualServer) EnvironmentSetBgEnergy(env gdnative.Rid, energy gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_bg_energy")
+	methodBind := methodBindVisualServerEnvironmentSetBgEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -2522,8 +4197,7 @@ func (o *visualServer) EnvironmentSetBgEnergy(env gdnative.Rid, energy gdnative.
 }
 
 /*
-
-	Args: [{ false env RID} { false max_layer int}], Returns: void
+Args: [{ false env RID} { false max_layer int}], Returns: void
 */
 func (o *visualServer) EnvironmentSetCanvasMaxLayer(env gdnative.Rid, maxLayer gdnative.Int) {
 	o.ensureSingleton()
@@ -2535,7 +4209,7 @@ func (o *visualServer) EnvironmentSetCanvasMaxLayer(env gdnative.Rid, maxLayer g
 	ptrArguments[1] = gdnative.NewPointerFromInt(maxLayer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_canvas_max_layer")
+	methodBind := methodBindVisualServerEnvironmentSetCanvasMaxLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -2545,8 +4219,7 @@ func (o *visualServer) EnvironmentSetCanvasMaxLayer(env gdnative.Rid, maxLayer g
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false distance float} { false transition float} { false far_amount float} { false quality int}], Returns: void
+Args: [{ false env RID} { false enable bool} { false distance float} { false transition float} { false far_amount float} { false quality int}], Returns: void
 */
 func (o *visualServer) EnvironmentSetDofBlurFar(env gdnative.Rid, enable gdnative.Bool, distance gdnative.Real, transition gdnative.Real, farAmount gdnative.Real, quality gdnative.Int) {
 	o.ensureSingleton()
@@ -2562,7 +4235,7 @@ func (o *visualServer) EnvironmentSetDofBlurFar(env gdnative.Rid, enable gdnativ
 	ptrArguments[5] = gdnative.NewPointerFromInt(quality)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_dof_blur_far")
+	methodBind := methodBindVisualServerEnvironmentSetDofBlurFar.Get()
 
 	// Call the parent method.
 	// void
@@ -2572,8 +4245,7 @@ func (o *visualServer) EnvironmentSetDofBlurFar(env gdnative.Rid, enable gdnativ
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false distance float} { false transition float} { false far_amount float} { false quality int}], Returns: void
+Args: [{ false env RID} { false enable bool} { false distance float} { false transition float} { false far_amount float} { false quality int}], Returns: void
 */
 func (o *visualServer) EnvironmentSetDofBlurNear(env gdnative.Rid, enable gdnative.Bool, distance gdnative.Real, transition gdnative.Real, farAmount gdnative.Real, quality gdnative.Int) {
 	o.ensureSingleton()
@@ -2589,7 +4261,7 @@ func (o *visualServer) EnvironmentSetDofBlurNear(env gdnative.Rid, enable gdnati
 	ptrArguments[5] = gdnative.NewPointerFromInt(quality)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_dof_blur_near")
+	methodBind := methodBindVisualServerEnvironmentSetDofBlurNear.Get()
 
 	// Call the parent method.
 	// void
@@ -2599,8 +4271,7 @@ func (o *visualServer) EnvironmentSetDofBlurNear(env gdnative.Rid, enable gdnati
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false color Color} { false sun_color Color} { false sun_amount float}], Returns: void
+Args: [{ false env RID} { false enable bool} { false color Color} { false sun_color Color} { false sun_amount float}], Returns: void
 */
 func (o *visualServer) EnvironmentSetFog(env gdnative.Rid, enable gdnative.Bool, color gdnative.Color, sunColor gdnative.Color, sunAmount gdnative.Real) {
 	o.ensureSingleton()
@@ -2615,7 +4286,7 @@ func (o *visualServer) EnvironmentSetFog(env gdnative.Rid, enable gdnative.Bool,
 	ptrArguments[4] = gdnative.NewPointerFromReal(sunAmount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_fog")
+	methodBind := methodBindVisualServerEnvironmentSetFog.Get()
 
 	// Call the parent method.
 	// void
@@ -2625,8 +4296,7 @@ func (o *visualServer) EnvironmentSetFog(env gdnative.Rid, enable gdnative.Bool,
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false depth_begin float} { false depth_curve float} { false transmit bool} { false transmit_curve float}], Returns: void
+Args: [{ false env RID} { false enable bool} { false depth_begin float} { false depth_curve float} { false transmit bool} { false transmit_curve float}], Returns: void
 */
 func (o *visualServer) EnvironmentSetFogDepth(env gdnative.Rid, enable gdnative.Bool, depthBegin gdnative.Real, depthCurve gdnative.Real, transmit gdnative.Bool, transmitCurve gdnative.Real) {
 	o.ensureSingleton()
@@ -2642,7 +4312,7 @@ func (o *visualServer) EnvironmentSetFogDepth(env gdnative.Rid, enable gdnative.
 	ptrArguments[5] = gdnative.NewPointerFromReal(transmitCurve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_fog_depth")
+	methodBind := methodBindVisualServerEnvironmentSetFogDepth.Get()
 
 	// Call the parent method.
 	// void
@@ -2652,8 +4322,7 @@ func (o *visualServer) EnvironmentSetFogDepth(env gdnative.Rid, enable gdnative.
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false min_height float} { false max_height float} { false height_curve float}], Returns: void
+Args: [{ false env RID} { false enable bool} { false min_height float} { false max_height float} { false height_curve float}], Returns: void
 */
 func (o *visualServer) EnvironmentSetFogHeight(env gdnative.Rid, enable gdnative.Bool, minHeight gdnative.Real, maxHeight gdnative.Real, heightCurve gdnative.Real) {
 	o.ensureSingleton()
@@ -2668,7 +4337,7 @@ func (o *visualServer) EnvironmentSetFogHeight(env gdnative.Rid, enable gdnative
 	ptrArguments[4] = gdnative.NewPointerFromReal(heightCurve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_fog_height")
+	methodBind := methodBindVisualServerEnvironmentSetFogHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -2678,10 +4347,9 @@ func (o *visualServer) EnvironmentSetFogHeight(env gdnative.Rid, enable gdnative
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false level_flags int} { false intensity float} { false strength float} { false bloom_threshold float} { false blend_mode int} { false hdr_bleed_threshold float} { false hdr_bleed_scale float} { false bicubic_upscale bool}], Returns: void
+Args: [{ false env RID} { false enable bool} { false level_flags int} { false intensity float} { false strength float} { false bloom_threshold float} { false blend_mode enum.VisualServer::EnvironmentGlowBlendMode} { false hdr_bleed_threshold float} { false hdr_bleed_scale float} { false bicubic_upscale bool}], Returns: void
 */
-func (o *visualServer) EnvironmentSetGlow(env gdnative.Rid, enable gdnative.Bool, levelFlags gdnative.Int, intensity gdnative.Real, strength gdnative.Real, bloomThreshold gdnative.Real, blendMode gdnative.Int, hdrBleedThreshold gdnative.Real, hdrBleedScale gdnative.Real, bicubicUpscale gdnative.Bool) {
+func (o *visualServer) EnvironmentSetGlow(env gdnative.Rid, enable gdnative.Bool, levelFlags gdnative.Int, intensity gdnative.Real, strength gdnative.Real, bloomThreshold gdnative.Real, blendMode VisualServerEnvironmentGlowBlendMode, hdrBleedThreshold gdnative.Real, hdrBleedScale gdnative.Real, bicubicUpscale gdnative.Bool) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.EnvironmentSetGlow()")
 
@@ -2693,13 +4361,13 @@ func (o *visualServer) EnvironmentSetGlow(env gdnative.Rid, enable gdnative.Bool
 	ptrArguments[3] = gdnative.NewPointerFromReal(intensity)
 	ptrArguments[4] = gdnative.NewPointerFromReal(strength)
 	ptrArguments[5] = gdnative.NewPointerFromReal(bloomThreshold)
-	ptrArguments[6] = gdnative.NewPointerFromInt(blendMode)
+	ptrArguments[6] = gdnative.NewPointerFromInt(gdnative.Int(blendMode))
 	ptrArguments[7] = gdnative.NewPointerFromReal(hdrBleedThreshold)
 	ptrArguments[8] = gdnative.NewPointerFromReal(hdrBleedScale)
 	ptrArguments[9] = gdnative.NewPointerFromBool(bicubicUpscale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_glow")
+	methodBind := methodBindVisualServerEnvironmentSetGlow.Get()
 
 	// Call the parent method.
 	// void
@@ -2709,8 +4377,7 @@ func (o *visualServer) EnvironmentSetGlow(env gdnative.Rid, enable gdnative.Bool
 }
 
 /*
-
-	Args: [{ false env RID} { false sky RID}], Returns: void
+Args: [{ false env RID} { false sky RID}], Returns: void
 */
 func (o *visualServer) EnvironmentSetSky(env gdnative.Rid, sky gdnative.Rid) {
 	o.ensureSingleton()
@@ -2722,7 +4389,7 @@ func (o *visualServer) EnvironmentSetSky(env gdnative.Rid, sky gdnative.Rid) {
 	ptrArguments[1] = gdnative.NewPointerFromRid(sky)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_sky")
+	methodBind := methodBindVisualServerEnvironmentSetSky.Get()
 
 	// Call the parent method.
 	// void
@@ -2732,8 +4399,7 @@ func (o *visualServer) EnvironmentSetSky(env gdnative.Rid, sky gdnative.Rid) {
 }
 
 /*
-
-	Args: [{ false env RID} { false scale float}], Returns: void
+Args: [{ false env RID} { false scale float}], Returns: void
 */
 func (o *visualServer) EnvironmentSetSkyCustomFov(env gdnative.Rid, scale gdnative.Real) {
 	o.ensureSingleton()
@@ -2745,7 +4411,7 @@ func (o *visualServer) EnvironmentSetSkyCustomFov(env gdnative.Rid, scale gdnati
 	ptrArguments[1] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_sky_custom_fov")
+	methodBind := methodBindVisualServerEnvironmentSetSkyCustomFov.Get()
 
 	// Call the parent method.
 	// void
@@ -2755,8 +4421,7 @@ func (o *visualServer) EnvironmentSetSkyCustomFov(env gdnative.Rid, scale gdnati
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false radius float} { false intensity float} { false radius2 float} { false intensity2 float} { false bias float} { false light_affect float} { false color Color} { false quality int} { false blur int} { false bilateral_sharpness float}], Returns: void
+Args: [{ false env RID} { false enable bool} { false radius float} { false intensity float} { false radius2 float} { false intensity2 float} { false bias float} { false light_affect float} { false color Color} { false quality int} { false blur int} { false bilateral_sharpness float}], Returns: void
 */
 func (o *visualServer) EnvironmentSetSsao(env gdnative.Rid, enable gdnative.Bool, radius gdnative.Real, intensity gdnative.Real, radius2 gdnative.Real, intensity2 gdnative.Real, bias gdnative.Real, lightAffect gdnative.Real, color gdnative.Color, quality gdnative.Int, blur gdnative.Int, bilateralSharpness gdnative.Real) {
 	o.ensureSingleton()
@@ -2778,7 +4443,7 @@ func (o *visualServer) EnvironmentSetSsao(env gdnative.Rid, enable gdnative.Bool
 	ptrArguments[11] = gdnative.NewPointerFromReal(bilateralSharpness)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_ssao")
+	methodBind := methodBindVisualServerEnvironmentSetSsao.Get()
 
 	// Call the parent method.
 	// void
@@ -2788,8 +4453,7 @@ func (o *visualServer) EnvironmentSetSsao(env gdnative.Rid, enable gdnative.Bool
 }
 
 /*
-
-	Args: [{ false env RID} { false enable bool} { false max_steps int} { false fade_in float} { false fade_out float} { false depth_tolerance float} { false roughness bool}], Returns: void
+Args: [{ false env RID} { false enable bool} { false max_steps int} { false fade_in float} { false fade_out float} { false depth_tolerance float} { false roughness bool}], Returns: void
 */
 func (o *visualServer) EnvironmentSetSsr(env gdnative.Rid, enable gdnative.Bool, maxSteps gdnative.Int, fadeIn gdnative.Real, fadeOut gdnative.Real, depthTolerance gdnative.Real, roughness gdnative.Bool) {
 	o.ensureSingleton()
@@ -2806,7 +4470,7 @@ func (o *visualServer) EnvironmentSetSsr(env gdnative.Rid, enable gdnative.Bool,
 	ptrArguments[6] = gdnative.NewPointerFromBool(roughness)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_ssr")
+	methodBind := methodBindVisualServerEnvironmentSetSsr.Get()
 
 	// Call the parent method.
 	// void
@@ -2816,17 +4480,16 @@ func (o *visualServer) EnvironmentSetSsr(env gdnative.Rid, enable gdnative.Bool,
 }
 
 /*
-
-	Args: [{ false env RID} { false tone_mapper int} { false exposure float} { false white float} { false auto_exposure bool} { false min_luminance float} { false max_luminance float} { false auto_exp_speed float} { false auto_exp_grey float}], Returns: void
+Args: [{ false env RID} { false tone_mapper enum.VisualServer::EnvironmentToneMapper} { false exposure float} { false white float} { false auto_exposure bool} { false min_luminance float} { false max_luminance float} { false auto_exp_speed float} { false auto_exp_grey float}], Returns: void
 */
-func (o *visualServer) EnvironmentSetTonemap(env gdnative.Rid, toneMapper gdnative.Int, exposure gdnative.Real, white gdnative.Real, autoExposure gdnative.Bool, minLuminance gdnative.Real, maxLuminance gdnative.Real, autoExpSpeed gdnative.Real, autoExpGrey gdnative.Real) {
+func (o *visualServer) EnvironmentSetTonemap(env gdnative.Rid, toneMapper VisualServerEnvironmentToneMapper, exposure gdnative.Real, white gdnative.Real, autoExposure gdnative.Bool, minLuminance gdnative.Real, maxLuminance gdnative.Real, autoExpSpeed gdnative.Real, autoExpGrey gdnative.Real) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.EnvironmentSetTonemap()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 9, 9)
 	ptrArguments[0] = gdnative.NewPointerFromRid(env)
-	ptrArguments[1] = gdnative.NewPointerFromInt(toneMapper)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(toneMapper))
 	ptrArguments[2] = gdnative.NewPointerFromReal(exposure)
 	ptrArguments[3] = gdnative.NewPointerFromReal(white)
 	ptrArguments[4] = gdnative.NewPointerFromBool(autoExposure)
@@ -2836,7 +4499,7 @@ func (o *visualServer) EnvironmentSetTonemap(env gdnative.Rid, toneMapper gdnati
 	ptrArguments[8] = gdnative.NewPointerFromReal(autoExpGrey)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "environment_set_tonemap")
+	methodBind := methodBindVisualServerEnvironmentSetTonemap.Get()
 
 	// Call the parent method.
 	// void
@@ -2846,8 +4509,8 @@ func (o *visualServer) EnvironmentSetTonemap(env gdnative.Rid, toneMapper gdnati
 }
 
 /*
-        Removes buffers and clears testcubes.
-	Args: [], Returns: void
+	        Removes buffers and clears testcubes.
+		Args: [], Returns: void
 */
 func (o *visualServer) Finish() {
 	o.ensureSingleton()
@@ -2857,7 +4520,7 @@ func (o *visualServer) Finish() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "finish")
+	methodBind := methodBindVisualServerFinish.Get()
 
 	// Call the parent method.
 	// void
@@ -2867,8 +4530,8 @@ func (o *visualServer) Finish() {
 }
 
 /*
-        Draws a frame. Same as [method draw].
-	Args: [{True true swap_buffers bool}], Returns: void
+	        Draws a frame. Same as [method draw].
+		Args: [{True true swap_buffers bool}], Returns: void
 */
 func (o *visualServer) ForceDraw(swapBuffers gdnative.Bool) {
 	o.ensureSingleton()
@@ -2879,7 +4542,7 @@ func (o *visualServer) ForceDraw(swapBuffers gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(swapBuffers)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "force_draw")
+	methodBind := methodBindVisualServerForceDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -2889,8 +4552,8 @@ func (o *visualServer) ForceDraw(swapBuffers gdnative.Bool) {
 }
 
 /*
-        Synchronizes threads.
-	Args: [], Returns: void
+	        Synchronizes threads.
+		Args: [], Returns: void
 */
 func (o *visualServer) ForceSync() {
 	o.ensureSingleton()
@@ -2900,7 +4563,7 @@ func (o *visualServer) ForceSync() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "force_sync")
+	methodBind := methodBindVisualServerForceSync.Get()
 
 	// Call the parent method.
 	// void
@@ -2910,8 +4573,8 @@ func (o *visualServer) ForceSync() {
 }
 
 /*
-        Tries to free an object in the VisualServer.
-	Args: [{ false rid RID}], Returns: void
+	        Tries to free an object in the VisualServer.
+		Args: [{ false rid RID}], Returns: void
 */
 func (o *visualServer) FreeRid(rid gdnative.Rid) {
 	o.ensureSingleton()
@@ -2922,7 +4585,7 @@ func (o *visualServer) FreeRid(rid gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "free_rid")
+	methodBind := methodBindVisualServerFreeRid.Get()
 
 	// Call the parent method.
 	// void
@@ -2932,8 +4595,8 @@ func (o *visualServer) FreeRid(rid gdnative.Rid) {
 }
 
 /*
-        Returns a certain information, see RENDER_INFO_* for options.
-	Args: [{ false info int}], Returns: int
+	        Returns a certain information, see RENDER_INFO_* for options.
+		Args: [{ false info int}], Returns: int
 */
 func (o *visualServer) GetRenderInfo(info gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -2944,7 +4607,7 @@ func (o *visualServer) GetRenderInfo(info gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(info)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "get_render_info")
+	methodBind := methodBindVisualServerGetRenderInfo.Get()
 
 	// Call the parent method.
 	// int
@@ -2957,8 +4620,8 @@ func (o *visualServer) GetRenderInfo(info gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the id of the test cube. Creates one if none exists.
-	Args: [], Returns: RID
+	        Returns the id of the test cube. Creates one if none exists.
+		Args: [], Returns: RID
 */
 func (o *visualServer) GetTestCube() gdnative.Rid {
 	o.ensureSingleton()
@@ -2968,7 +4631,7 @@ func (o *visualServer) GetTestCube() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "get_test_cube")
+	methodBind := methodBindVisualServerGetTestCube.Get()
 
 	// Call the parent method.
 	// RID
@@ -2981,8 +4644,8 @@ func (o *visualServer) GetTestCube() gdnative.Rid {
 }
 
 /*
-        Returns the id of the test texture. Creates one if none exists.
-	Args: [], Returns: RID
+	        Returns the id of the test texture. Creates one if none exists.
+		Args: [], Returns: RID
 */
 func (o *visualServer) GetTestTexture() gdnative.Rid {
 	o.ensureSingleton()
@@ -2992,7 +4655,7 @@ func (o *visualServer) GetTestTexture() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "get_test_texture")
+	methodBind := methodBindVisualServerGetTestTexture.Get()
 
 	// Call the parent method.
 	// RID
@@ -3005,8 +4668,8 @@ func (o *visualServer) GetTestTexture() gdnative.Rid {
 }
 
 /*
-        Returns the id of a white texture. Creates one if none exists.
-	Args: [], Returns: RID
+	        Returns the id of a white texture. Creates one if none exists.
+		Args: [], Returns: RID
 */
 func (o *visualServer) GetWhiteTexture() gdnative.Rid {
 	o.ensureSingleton()
@@ -3016,7 +4679,7 @@ func (o *visualServer) GetWhiteTexture() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "get_white_texture")
+	methodBind := methodBindVisualServerGetWhiteTexture.Get()
 
 	// Call the parent method.
 	// RID
@@ -3029,8 +4692,7 @@ func (o *visualServer) GetWhiteTexture() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) GiProbeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -3040,7 +4702,7 @@ func (o *visualServer) GiProbeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_create")
+	methodBind := methodBindVisualServerGiProbeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -3053,8 +4715,7 @@ func (o *visualServer) GiProbeCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: float
+Args: [{ false arg0 RID}], Returns: float
 */
 func (o *visualServer) GiProbeGetBias(arg0 gdnative.Rid) gdnative.Real {
 	o.ensureSingleton()
@@ -3065,7 +4726,7 @@ func (o *visualServer) GiProbeGetBias(arg0 gdnative.Rid) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_bias")
+	methodBind := methodBindVisualServerGiProbeGetBias.Get()
 
 	// Call the parent method.
 	// float
@@ -3078,8 +4739,7 @@ func (o *visualServer) GiProbeGetBias(arg0 gdnative.Rid) gdnative.Real {
 }
 
 /*
-
-	Args: [{ false probe RID}], Returns: AABB
+Args: [{ false probe RID}], Returns: AABB
 */
 func (o *visualServer) GiProbeGetBounds(probe gdnative.Rid) gdnative.Aabb {
 	o.ensureSingleton()
@@ -3090,7 +4750,7 @@ func (o *visualServer) GiProbeGetBounds(probe gdnative.Rid) gdnative.Aabb {
 	ptrArguments[0] = gdnative.NewPointerFromRid(probe)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_bounds")
+	methodBind := methodBindVisualServerGiProbeGetBounds.Get()
 
 	// Call the parent method.
 	// AABB
@@ -3103,8 +4763,7 @@ func (o *visualServer) GiProbeGetBounds(probe gdnative.Rid) gdnative.Aabb {
 }
 
 /*
-
-	Args: [{ false probe RID}], Returns: float
+Args: [{ false probe RID}], Returns: float
 */
 func (o *visualServer) GiProbeGetCellSize(probe gdnative.Rid) gdnative.Real {
 	o.ensureSingleton()
@@ -3115,7 +4774,7 @@ func (o *visualServer) GiProbeGetCellSize(probe gdnative.Rid) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromRid(probe)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_cell_size")
+	methodBind := methodBindVisualServerGiProbeGetCellSize.Get()
 
 	// Call the parent method.
 	// float
@@ -3128,8 +4787,7 @@ func (o *visualServer) GiProbeGetCellSize(probe gdnative.Rid) gdnative.Real {
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: PoolIntArray
+Args: [{ false arg0 RID}], Returns: PoolIntArray
 */
 func (o *visualServer) GiProbeGetDynamicData(arg0 gdnative.Rid) gdnative.PoolIntArray {
 	o.ensureSingleton()
@@ -3140,7 +4798,7 @@ func (o *visualServer) GiProbeGetDynamicData(arg0 gdnative.Rid) gdnative.PoolInt
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_dynamic_data")
+	methodBind := methodBindVisualServerGiProbeGetDynamicData.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -3153,8 +4811,7 @@ func (o *visualServer) GiProbeGetDynamicData(arg0 gdnative.Rid) gdnative.PoolInt
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: int
+Args: [{ false arg0 RID}], Returns: int
 */
 func (o *visualServer) GiProbeGetDynamicRange(arg0 gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -3165,7 +4822,7 @@ func (o *visualServer) GiProbeGetDynamicRange(arg0 gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_dynamic_range")
+	methodBind := methodBindVisualServerGiProbeGetDynamicRange.Get()
 
 	// Call the parent method.
 	// int
@@ -3178,8 +4835,7 @@ func (o *visualServer) GiProbeGetDynamicRange(arg0 gdnative.Rid) gdnative.Int {
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: float
+Args: [{ false arg0 RID}], Returns: float
 */
 func (o *visualServer) GiProbeGetEnergy(arg0 gdnative.Rid) gdnative.Real {
 	o.ensureSingleton()
@@ -3190,7 +4846,7 @@ func (o *visualServer) GiProbeGetEnergy(arg0 gdnative.Rid) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_energy")
+	methodBind := methodBindVisualServerGiProbeGetEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -3203,8 +4859,7 @@ func (o *visualServer) GiProbeGetEnergy(arg0 gdnative.Rid) gdnative.Real {
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: float
+Args: [{ false arg0 RID}], Returns: float
 */
 func (o *visualServer) GiProbeGetNormalBias(arg0 gdnative.Rid) gdnative.Real {
 	o.ensureSingleton()
@@ -3215,7 +4870,7 @@ func (o *visualServer) GiProbeGetNormalBias(arg0 gdnative.Rid) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_normal_bias")
+	methodBind := methodBindVisualServerGiProbeGetNormalBias.Get()
 
 	// Call the parent method.
 	// float
@@ -3228,8 +4883,7 @@ func (o *visualServer) GiProbeGetNormalBias(arg0 gdnative.Rid) gdnative.Real {
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: float
+Args: [{ false arg0 RID}], Returns: float
 */
 func (o *visualServer) GiProbeGetPropagation(arg0 gdnative.Rid) gdnative.Real {
 	o.ensureSingleton()
@@ -3240,7 +4894,7 @@ func (o *visualServer) GiProbeGetPropagation(arg0 gdnative.Rid) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_propagation")
+	methodBind := methodBindVisualServerGiProbeGetPropagation.Get()
 
 	// Call the parent method.
 	// float
@@ -3253,8 +4907,7 @@ func (o *visualServer) GiProbeGetPropagation(arg0 gdnative.Rid) gdnative.Real {
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: Transform
+Args: [{ false arg0 RID}], Returns: Transform
 */
 func (o *visualServer) GiProbeGetToCellXform(arg0 gdnative.Rid) gdnative.Transform {
 	o.ensureSingleton()
@@ -3265,7 +4918,7 @@ func (o *visualServer) GiProbeGetToCellXform(arg0 gdnative.Rid) gdnative.Transfo
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_get_to_cell_xform")
+	methodBind := methodBindVisualServerGiProbeGetToCellXform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -3278,8 +4931,7 @@ func (o *visualServer) GiProbeGetToCellXform(arg0 gdnative.Rid) gdnative.Transfo
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: bool
+Args: [{ false arg0 RID}], Returns: bool
 */
 func (o *visualServer) GiProbeIsCompressed(arg0 gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -3290,7 +4942,7 @@ func (o *visualServer) GiProbeIsCompressed(arg0 gdnative.Rid) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_is_compressed")
+	methodBind := methodBindVisualServerGiProbeIsCompressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -3303,8 +4955,7 @@ func (o *visualServer) GiProbeIsCompressed(arg0 gdnative.Rid) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false arg0 RID}], Returns: bool
+Args: [{ false arg0 RID}], Returns: bool
 */
 func (o *visualServer) GiProbeIsInterior(arg0 gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -3315,7 +4966,7 @@ func (o *visualServer) GiProbeIsInterior(arg0 gdnative.Rid) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromRid(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_is_interior")
+	methodBind := methodBindVisualServerGiProbeIsInterior.Get()
 
 	// Call the parent method.
 	// bool
@@ -3328,8 +4979,7 @@ func (o *visualServer) GiProbeIsInterior(arg0 gdnative.Rid) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false bias RID} { false arg1 float}], Returns: void
+Args: [{ false bias RID} { false arg1 float}], Returns: void
 */
 func (o *visualServer) GiProbeSetBias(bias gdnative.Rid, arg1 gdnative.Real) {
 	o.ensureSingleton()
@@ -3341,7 +4991,7 @@ func (o *visualServer) GiProbeSetBias(bias gdnative.Rid, arg1 gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_bias")
+	methodBind := methodBindVisualServerGiProbeSetBias.Get()
 
 	// Call the parent method.
 	// void
@@ -3351,8 +5001,7 @@ func (o *visualServer) GiProbeSetBias(bias gdnative.Rid, arg1 gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false probe RID} { false bounds AABB}], Returns: void
+Args: [{ false probe RID} { false bounds AABB}], Returns: void
 */
 func (o *visualServer) GiProbeSetBounds(probe gdnative.Rid, bounds gdnative.Aabb) {
 	o.ensureSingleton()
@@ -3364,7 +5013,7 @@ func (o *visualServer) GiProbeSetBounds(probe gdnative.Rid, bounds gdnative.Aabb
 	ptrArguments[1] = gdnative.NewPointerFromAabb(bounds)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_bounds")
+	methodBind := methodBindVisualServerGiProbeSetBounds.Get()
 
 	// Call the parent method.
 	// void
@@ -3374,8 +5023,7 @@ func (o *visualServer) GiProbeSetBounds(probe gdnative.Rid, bounds gdnative.Aabb
 }
 
 /*
-
-	Args: [{ false probe RID} { false range float}], Returns: void
+Args: [{ false probe RID} { false range float}], Returns: void
 */
 func (o *visualServer) GiProbeSetCellSize(probe gdnative.Rid, rng gdnative.Real) {
 	o.ensureSingleton()
@@ -3387,7 +5035,7 @@ func (o *visualServer) GiProbeSetCellSize(probe gdnative.Rid, rng gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(rng)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_cell_size")
+	methodBind := methodBindVisualServerGiProbeSetCellSize.Get()
 
 	// Call the parent method.
 	// void
@@ -3397,8 +5045,7 @@ func (o *visualServer) GiProbeSetCellSize(probe gdnative.Rid, rng gdnative.Real)
 }
 
 /*
-
-	Args: [{ false enable RID} { false arg1 bool}], Returns: void
+Args: [{ false enable RID} { false arg1 bool}], Returns: void
 */
 func (o *visualServer) GiProbeSetCompress(enable gdnative.Rid, arg1 gdnative.Bool) {
 	o.ensureSingleton()
@@ -3410,7 +5057,7 @@ func (o *visualServer) GiProbeSetCompress(enable gdnative.Rid, arg1 gdnative.Boo
 	ptrArguments[1] = gdnative.NewPointerFromBool(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_compress")
+	methodBind := methodBindVisualServerGiProbeSetCompress.Get()
 
 	// Call the parent method.
 	// void
@@ -3420,8 +5067,7 @@ func (o *visualServer) GiProbeSetCompress(enable gdnative.Rid, arg1 gdnative.Boo
 }
 
 /*
-
-	Args: [{ false data RID} { false arg1 PoolIntArray}], Returns: void
+Args: [{ false data RID} { false arg1 PoolIntArray}], Returns: void
 */
 func (o *visualServer) GiProbeSetDynamicData(data gdnative.Rid, arg1 gdnative.PoolIntArray) {
 	o.ensureSingleton()
@@ -3433,7 +5079,7 @@ func (o *visualServer) GiProbeSetDynamicData(data gdnative.Rid, arg1 gdnative.Po
 	ptrArguments[1] = gdnative.NewPointerFromPoolIntArray(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_dynamic_data")
+	methodBind := methodBindVisualServerGiProbeSetDynamicData.Get()
 
 	// Call the parent method.
 	// void
@@ -3443,8 +5089,7 @@ func (o *visualServer) GiProbeSetDynamicData(data gdnative.Rid, arg1 gdnative.Po
 }
 
 /*
-
-	Args: [{ false range RID} { false arg1 int}], Returns: void
+Args: [{ false range RID} { false arg1 int}], Returns: void
 */
 func (o *visualServer) GiProbeSetDynamicRange(rng gdnative.Rid, arg1 gdnative.Int) {
 	o.ensureSingleton()
@@ -3456,7 +5101,7 @@ func (o *visualServer) GiProbeSetDynamicRange(rng gdnative.Rid, arg1 gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_dynamic_range")
+	methodBind := methodBindVisualServerGiProbeSetDynamicRange.Get()
 
 	// Call the parent method.
 	// void
@@ -3466,8 +5111,7 @@ func (o *visualServer) GiProbeSetDynamicRange(rng gdnative.Rid, arg1 gdnative.In
 }
 
 /*
-
-	Args: [{ false energy RID} { false arg1 float}], Returns: void
+Args: [{ false energy RID} { false arg1 float}], Returns: void
 */
 func (o *visualServer) GiProbeSetEnergy(energy gdnative.Rid, arg1 gdnative.Real) {
 	o.ensureSingleton()
@@ -3479,7 +5123,7 @@ func (o *visualServer) GiProbeSetEnergy(energy gdnative.Rid, arg1 gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_energy")
+	methodBind := methodBindVisualServerGiProbeSetEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -3489,8 +5133,7 @@ func (o *visualServer) GiProbeSetEnergy(energy gdnative.Rid, arg1 gdnative.Real)
 }
 
 /*
-
-	Args: [{ false enable RID} { false arg1 bool}], Returns: void
+Args: [{ false enable RID} { false arg1 bool}], Returns: void
 */
 func (o *visualServer) GiProbeSetInterior(enable gdnative.Rid, arg1 gdnative.Bool) {
 	o.ensureSingleton()
@@ -3502,7 +5145,7 @@ func (o *visualServer) GiProbeSetInterior(enable gdnative.Rid, arg1 gdnative.Boo
 	ptrArguments[1] = gdnative.NewPointerFromBool(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_interior")
+	methodBind := methodBindVisualServerGiProbeSetInterior.Get()
 
 	// Call the parent method.
 	// void
@@ -3512,8 +5155,7 @@ func (o *visualServer) GiProbeSetInterior(enable gdnative.Rid, arg1 gdnative.Boo
 }
 
 /*
-
-	Args: [{ false bias RID} { false arg1 float}], Returns: void
+Args: [{ false bias RID} { false arg1 float}], Returns: void
 */
 func (o *visualServer) GiProbeSetNormalBias(bias gdnative.Rid, arg1 gdnative.Real) {
 	o.ensureSingleton()
@@ -3525,7 +5167,7 @@ func (o *visualServer) GiProbeSetNormalBias(bias gdnative.Rid, arg1 gdnative.Rea
 	ptrArguments[1] = gdnative.NewPointerFromReal(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_normal_bias")
+	methodBind := methodBindVisualServerGiProbeSetNormalBias.Get()
 
 	// Call the parent method.
 	// void
@@ -3535,8 +5177,7 @@ func (o *visualServer) GiProbeSetNormalBias(bias gdnative.Rid, arg1 gdnative.Rea
 }
 
 /*
-
-	Args: [{ false propagation RID} { false arg1 float}], Returns: void
+Args: [{ false propagation RID} { false arg1 float}], Returns: void
 */
 func (o *visualServer) GiProbeSetPropagation(propagation gdnative.Rid, arg1 gdnative.Real) {
 	o.ensureSingleton()
@@ -3548,7 +5189,7 @@ func (o *visualServer) GiProbeSetPropagation(propagation gdnative.Rid, arg1 gdna
 	ptrArguments[1] = gdnative.NewPointerFromReal(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_propagation")
+	methodBind := methodBindVisualServerGiProbeSetPropagation.Get()
 
 	// Call the parent method.
 	// void
@@ -3558,8 +5199,7 @@ func (o *visualServer) GiProbeSetPropagation(propagation gdnative.Rid, arg1 gdna
 }
 
 /*
-
-	Args: [{ false xform RID} { false arg1 Transform}], Returns: void
+Args: [{ false xform RID} { false arg1 Transform}], Returns: void
 */
 func (o *visualServer) GiProbeSetToCellXform(xform gdnative.Rid, arg1 gdnative.Transform) {
 	o.ensureSingleton()
@@ -3571,7 +5211,7 @@ func (o *visualServer) GiProbeSetToCellXform(xform gdnative.Rid, arg1 gdnative.T
 	ptrArguments[1] = gdnative.NewPointerFromTransform(arg1)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "gi_probe_set_to_cell_xform")
+	methodBind := methodBindVisualServerGiProbeSetToCellXform.Get()
 
 	// Call the parent method.
 	// void
@@ -3581,8 +5221,8 @@ func (o *visualServer) GiProbeSetToCellXform(xform gdnative.Rid, arg1 gdnative.T
 }
 
 /*
-        Returns [code]true[/code] if changes have been made to the VisualServer's data. [method draw] is usually called if this happens.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if changes have been made to the VisualServer's data. [method draw] is usually called if this happens.
+		Args: [], Returns: bool
 */
 func (o *visualServer) HasChanged() gdnative.Bool {
 	o.ensureSingleton()
@@ -3592,7 +5232,7 @@ func (o *visualServer) HasChanged() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "has_changed")
+	methodBind := methodBindVisualServerHasChanged.Get()
 
 	// Call the parent method.
 	// bool
@@ -3605,8 +5245,7 @@ func (o *visualServer) HasChanged() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false feature int}], Returns: bool
+Args: [{ false feature int}], Returns: bool
 */
 func (o *visualServer) HasFeature(feature gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -3617,7 +5256,7 @@ func (o *visualServer) HasFeature(feature gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(feature)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "has_feature")
+	methodBind := methodBindVisualServerHasFeature.Get()
 
 	// Call the parent method.
 	// bool
@@ -3630,8 +5269,8 @@ func (o *visualServer) HasFeature(feature gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns true, if the OS supports a certain feature. Features might be s3tc, etc, etc2 and pvrtc,
-	Args: [{ false feature String}], Returns: bool
+	        Returns true, if the OS supports a certain feature. Features might be s3tc, etc, etc2 and pvrtc,
+		Args: [{ false feature String}], Returns: bool
 */
 func (o *visualServer) HasOsFeature(feature gdnative.String) gdnative.Bool {
 	o.ensureSingleton()
@@ -3642,7 +5281,7 @@ func (o *visualServer) HasOsFeature(feature gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(feature)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "has_os_feature")
+	methodBind := methodBindVisualServerHasOsFeature.Get()
 
 	// Call the parent method.
 	// bool
@@ -3655,8 +5294,7 @@ func (o *visualServer) HasOsFeature(feature gdnative.String) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false immediate RID} { false primitive int} {[RID] true texture RID}], Returns: void
+Args: [{ false immediate RID} { false primitive int} {[RID] true texture RID}], Returns: void
 */
 func (o *visualServer) ImmediateBegin(immediate gdnative.Rid, primitive gdnative.Int, texture gdnative.Rid) {
 	o.ensureSingleton()
@@ -3669,7 +5307,7 @@ func (o *visualServer) ImmediateBegin(immediate gdnative.Rid, primitive gdnative
 	ptrArguments[2] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_begin")
+	methodBind := methodBindVisualServerImmediateBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -3679,8 +5317,7 @@ func (o *visualServer) ImmediateBegin(immediate gdnative.Rid, primitive gdnative
 }
 
 /*
-
-	Args: [{ false immediate RID}], Returns: void
+Args: [{ false immediate RID}], Returns: void
 */
 func (o *visualServer) ImmediateClear(immediate gdnative.Rid) {
 	o.ensureSingleton()
@@ -3691,7 +5328,7 @@ func (o *visualServer) ImmediateClear(immediate gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(immediate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_clear")
+	methodBind := methodBindVisualServerImmediateClear.Get()
 
 	// Call the parent method.
 	// void
@@ -3701,8 +5338,7 @@ func (o *visualServer) ImmediateClear(immediate gdnative.Rid) {
 }
 
 /*
-
-	Args: [{ false immediate RID} { false color Color}], Returns: void
+Args: [{ false immediate RID} { false color Color}], Returns: void
 */
 func (o *visualServer) ImmediateColor(immediate gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -3714,7 +5350,7 @@ func (o *visualServer) ImmediateColor(immediate gdnative.Rid, color gdnative.Col
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_color")
+	methodBind := methodBindVisualServerImmediateColor.Get()
 
 	// Call the parent method.
 	// void
@@ -3724,8 +5360,7 @@ func (o *visualServer) ImmediateColor(immediate gdnative.Rid, color gdnative.Col
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) ImmediateCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -3735,7 +5370,7 @@ func (o *visualServer) ImmediateCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_create")
+	methodBind := methodBindVisualServerImmediateCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -3748,8 +5383,7 @@ func (o *visualServer) ImmediateCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false immediate RID}], Returns: void
+Args: [{ false immediate RID}], Returns: void
 */
 func (o *visualServer) ImmediateEnd(immediate gdnative.Rid) {
 	o.ensureSingleton()
@@ -3760,7 +5394,7 @@ func (o *visualServer) ImmediateEnd(immediate gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(immediate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_end")
+	methodBind := methodBindVisualServerImmediateEnd.Get()
 
 	// Call the parent method.
 	// void
@@ -3770,8 +5404,7 @@ func (o *visualServer) ImmediateEnd(immediate gdnative.Rid) {
 }
 
 /*
-
-	Args: [{ false immediate RID}], Returns: RID
+Args: [{ false immediate RID}], Returns: RID
 */
 func (o *visualServer) ImmediateGetMaterial(immediate gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -3782,7 +5415,7 @@ func (o *visualServer) ImmediateGetMaterial(immediate gdnative.Rid) gdnative.Rid
 	ptrArguments[0] = gdnative.NewPointerFromRid(immediate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_get_material")
+	methodBind := methodBindVisualServerImmediateGetMaterial.Get()
 
 	// Call the parent method.
 	// RID
@@ -3795,8 +5428,7 @@ func (o *visualServer) ImmediateGetMaterial(immediate gdnative.Rid) gdnative.Rid
 }
 
 /*
-
-	Args: [{ false immediate RID} { false normal Vector3}], Returns: void
+Args: [{ false immediate RID} { false normal Vector3}], Returns: void
 */
 func (o *visualServer) ImmediateNormal(immediate gdnative.Rid, normal gdnative.Vector3) {
 	o.ensureSingleton()
@@ -3808,7 +5440,7 @@ func (o *visualServer) ImmediateNormal(immediate gdnative.Rid, normal gdnative.V
 	ptrArguments[1] = gdnative.NewPointerFromVector3(normal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_normal")
+	methodBind := methodBindVisualServerImmediateNormal.Get()
 
 	// Call the parent method.
 	// void
@@ -3818,8 +5450,7 @@ func (o *visualServer) ImmediateNormal(immediate gdnative.Rid, normal gdnative.V
 }
 
 /*
-
-	Args: [{ false immediate RID} { false material RID}], Returns: void
+Args: [{ false immediate RID} { false material RID}], Returns: void
 */
 func (o *visualServer) ImmediateSetMaterial(immediate gdnative.Rid, material gdnative.Rid) {
 	o.ensureSingleton()
@@ -3831,7 +5462,7 @@ func (o *visualServer) ImmediateSetMaterial(immediate gdnative.Rid, material gdn
 	ptrArguments[1] = gdnative.NewPointerFromRid(material)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_set_material")
+	methodBind := methodBindVisualServerImmediateSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -3841,8 +5472,7 @@ func (o *visualServer) ImmediateSetMaterial(immediate gdnative.Rid, material gdn
 }
 
 /*
-
-	Args: [{ false immediate RID} { false tangent Plane}], Returns: void
+Args: [{ false immediate RID} { false tangent Plane}], Returns: void
 */
 func (o *visualServer) ImmediateTangent(immediate gdnative.Rid, tangent gdnative.Plane) {
 	o.ensureSingleton()
@@ -3854,7 +5484,7 @@ func (o *visualServer) ImmediateTangent(immediate gdnative.Rid, tangent gdnative
 	ptrArguments[1] = gdnative.NewPointerFromPlane(tangent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_tangent")
+	methodBind := methodBindVisualServerImmediateTangent.Get()
 
 	// Call the parent method.
 	// void
@@ -3864,8 +5494,7 @@ func (o *visualServer) ImmediateTangent(immediate gdnative.Rid, tangent gdnative
 }
 
 /*
-
-	Args: [{ false immediate RID} { false tex_uv Vector2}], Returns: void
+Args: [{ false immediate RID} { false tex_uv Vector2}], Returns: void
 */
 func (o *visualServer) ImmediateUv(immediate gdnative.Rid, texUv gdnative.Vector2) {
 	o.ensureSingleton()
@@ -3877,7 +5506,7 @@ func (o *visualServer) ImmediateUv(immediate gdnative.Rid, texUv gdnative.Vector
 	ptrArguments[1] = gdnative.NewPointerFromVector2(texUv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_uv")
+	methodBind := methodBindVisualServerImmediateUv.Get()
 
 	// Call the parent method.
 	// void
@@ -3887,8 +5516,7 @@ func (o *visualServer) ImmediateUv(immediate gdnative.Rid, texUv gdnative.Vector
 }
 
 /*
-
-	Args: [{ false immediate RID} { false tex_uv Vector2}], Returns: void
+Args: [{ false immediate RID} { false tex_uv Vector2}], Returns: void
 */
 func (o *visualServer) ImmediateUv2(immediate gdnative.Rid, texUv gdnative.Vector2) {
 	o.ensureSingleton()
@@ -3900,7 +5528,7 @@ func (o *visualServer) ImmediateUv2(immediate gdnative.Rid, texUv gdnative.Vecto
 	ptrArguments[1] = gdnative.NewPointerFromVector2(texUv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_uv2")
+	methodBind := methodBindVisualServerImmediateUv2.Get()
 
 	// Call the parent method.
 	// void
@@ -3910,8 +5538,7 @@ func (o *visualServer) ImmediateUv2(immediate gdnative.Rid, texUv gdnative.Vecto
 }
 
 /*
-
-	Args: [{ false immediate RID} { false vertex Vector3}], Returns: void
+Args: [{ false immediate RID} { false vertex Vector3}], Returns: void
 */
 func (o *visualServer) ImmediateVertex(immediate gdnative.Rid, vertex gdnative.Vector3) {
 	o.ensureSingleton()
@@ -3923,7 +5550,7 @@ func (o *visualServer) ImmediateVertex(immediate gdnative.Rid, vertex gdnative.V
 	ptrArguments[1] = gdnative.NewPointerFromVector3(vertex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_vertex")
+	methodBind := methodBindVisualServerImmediateVertex.Get()
 
 	// Call the parent method.
 	// void
@@ -3933,8 +5560,7 @@ func (o *visualServer) ImmediateVertex(immediate gdnative.Rid, vertex gdnative.V
 }
 
 /*
-
-	Args: [{ false immediate RID} { false vertex Vector2}], Returns: void
+Args: [{ false immediate RID} { false vertex Vector2}], Returns: void
 */
 func (o *visualServer) ImmediateVertex2D(immediate gdnative.Rid, vertex gdnative.Vector2) {
 	o.ensureSingleton()
@@ -3946,7 +5572,7 @@ func (o *visualServer) ImmediateVertex2D(immediate gdnative.Rid, vertex gdnative
 	ptrArguments[1] = gdnative.NewPointerFromVector2(vertex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "immediate_vertex_2d")
+	methodBind := methodBindVisualServerImmediateVertex2D.Get()
 
 	// Call the parent method.
 	// void
@@ -3956,8 +5582,8 @@ func (o *visualServer) ImmediateVertex2D(immediate gdnative.Rid, vertex gdnative
 }
 
 /*
-        Initializes the visual server.
-	Args: [], Returns: void
+	        Initializes the visual server.
+		Args: [], Returns: void
 */
 func (o *visualServer) Init() {
 	o.ensureSingleton()
@@ -3967,7 +5593,7 @@ func (o *visualServer) Init() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "init")
+	methodBind := methodBindVisualServerInit.Get()
 
 	// Call the parent method.
 	// void
@@ -3977,8 +5603,7 @@ func (o *visualServer) Init() {
 }
 
 /*
-
-	Args: [{ false instance RID} { false id int}], Returns: void
+Args: [{ false instance RID} { false id int}], Returns: void
 */
 func (o *visualServer) InstanceAttachObjectInstanceId(instance gdnative.Rid, id gdnative.Int) {
 	o.ensureSingleton()
@@ -3990,7 +5615,7 @@ func (o *visualServer) InstanceAttachObjectInstanceId(instance gdnative.Rid, id
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_attach_object_instance_id")
+	methodBind := methodBindVisualServerInstanceAttachObjectInstanceId.Get()
 
 	// Call the parent method.
 	// void
@@ -4000,8 +5625,7 @@ func (o *visualServer) InstanceAttachObjectInstanceId(instance gdnative.Rid, id
 }
 
 /*
-
-	Args: [{ false instance RID} { false skeleton RID}], Returns: void
+Args: [{ false instance RID} { false skeleton RID}], Returns: void
 */
 func (o *visualServer) InstanceAttachSkeleton(instance gdnative.Rid, skeleton gdnative.Rid) {
 	o.ensureSingleton()
@@ -4013,7 +5637,7 @@ func (o *visualServer) InstanceAttachSkeleton(instance gdnative.Rid, skeleton gd
 	ptrArguments[1] = gdnative.NewPointerFromRid(skeleton)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_attach_skeleton")
+	methodBind := methodBindVisualServerInstanceAttachSkeleton.Get()
 
 	// Call the parent method.
 	// void
@@ -4023,8 +5647,7 @@ func (o *visualServer) InstanceAttachSkeleton(instance gdnative.Rid, skeleton gd
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) InstanceCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -4034,7 +5657,7 @@ func (o *visualServer) InstanceCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_create")
+	methodBind := methodBindVisualServerInstanceCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -4047,8 +5670,7 @@ func (o *visualServer) InstanceCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false base RID} { false scenario RID}], Returns: RID
+Args: [{ false base RID} { false scenario RID}], Returns: RID
 */
 func (o *visualServer) InstanceCreate2(base gdnative.Rid, scenario gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -4060,7 +5682,7 @@ func (o *visualServer) InstanceCreate2(base gdnative.Rid, scenario gdnative.Rid)
 	ptrArguments[1] = gdnative.NewPointerFromRid(scenario)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_create2")
+	methodBind := methodBindVisualServerInstanceCreate2.Get()
 
 	// Call the parent method.
 	// RID
@@ -4073,8 +5695,7 @@ func (o *visualServer) InstanceCreate2(base gdnative.Rid, scenario gdnative.Rid)
 }
 
 /*
-
-	Args: [{ false instance RID} { false as_lod_of_instance RID}], Returns: void
+Args: [{ false instance RID} { false as_lod_of_instance RID}], Returns: void
 */
 func (o *visualServer) InstanceGeometrySetAsInstanceLod(instance gdnative.Rid, asLodOfInstance gdnative.Rid) {
 	o.ensureSingleton()
@@ -4086,7 +5707,7 @@ func (o *visualServer) InstanceGeometrySetAsInstanceLod(instance gdnative.Rid, a
 	ptrArguments[1] = gdnative.NewPointerFromRid(asLodOfInstance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_geometry_set_as_instance_lod")
+	methodBind := methodBindVisualServerInstanceGeometrySetAsInstanceLod.Get()
 
 	// Call the parent method.
 	// void
@@ -4096,20 +5717,19 @@ func (o *visualServer) InstanceGeometrySetAsInstanceLod(instance gdnative.Rid, a
 }
 
 /*
-
-	Args: [{ false instance RID} { false shadow_casting_setting int}], Returns: void
+Args: [{ false instance RID} { false shadow_casting_setting enum.VisualServer::ShadowCastingSetting}], Returns: void
 */
-func (o *visualServer) InstanceGeometrySetCastShadowsSetting(instance gdnative.Rid, shadowCastingSetting gdnative.Int) {
+func (o *visualServer) InstanceGeometrySetCastShadowsSetting(instance gdnative.Rid, shadowCastingSetting VisualServerShadowCastingSetting) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.InstanceGeometrySetCastShadowsSetting()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromRid(instance)
-	ptrArguments[1] = gdnative.NewPointerFromInt(shadowCastingSetting)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(shadowCastingSetting))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_geometry_set_cast_shadows_setting")
+	methodBind := methodBindVisualServerInstanceGeometrySetCastShadowsSetting.Get()
 
 	// Call the parent method.
 	// void
@@ -4119,8 +5739,7 @@ func (o *visualServer) InstanceGeometrySetCastShadowsSetting(instance gdnative.R
 }
 
 /*
-
-	Args: [{ false instance RID} { false min float} { false max float} { false min_margin float} { false max_margin float}], Returns: void
+Args: [{ false instance RID} { false min float} { false max float} { false min_margin float} { false max_margin float}], Returns: void
 */
 func (o *visualServer) InstanceGeometrySetDrawRange(instance gdnative.Rid, min gdnative.Real, max gdnative.Real, minMargin gdnative.Real, maxMargin gdnative.Real) {
 	o.ensureSingleton()
@@ -4135,7 +5754,7 @@ func (o *visualServer) InstanceGeometrySetDrawRange(instance gdnative.Rid, min g
 	ptrArguments[4] = gdnative.NewPointerFromReal(maxMargin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_geometry_set_draw_range")
+	methodBind := methodBindVisualServerInstanceGeometrySetDrawRange.Get()
 
 	// Call the parent method.
 	// void
@@ -4145,8 +5764,7 @@ func (o *visualServer) InstanceGeometrySetDrawRange(instance gdnative.Rid, min g
 }
 
 /*
-
-	Args: [{ false instance RID} { false flag int} { false enabled bool}], Returns: void
+Args: [{ false instance RID} { false flag int} { false enabled bool}], Returns: void
 */
 func (o *visualServer) InstanceGeometrySetFlag(instance gdnative.Rid, flag gdnative.Int, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -4159,7 +5777,7 @@ func (o *visualServer) InstanceGeometrySetFlag(instance gdnative.Rid, flag gdnat
 	ptrArguments[2] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_geometry_set_flag")
+	methodBind := methodBindVisualServerInstanceGeometrySetFlag.Get()
 
 	// Call the parent method.
 	// void
@@ -4169,8 +5787,7 @@ func (o *visualServer) InstanceGeometrySetFlag(instance gdnative.Rid, flag gdnat
 }
 
 /*
-
-	Args: [{ false instance RID} { false material RID}], Returns: void
+Args: [{ false instance RID} { false material RID}], Returns: void
 */
 func (o *visualServer) InstanceGeometrySetMaterialOverride(instance gdnative.Rid, material gdnative.Rid) {
 	o.ensureSingleton()
@@ -4182,7 +5799,7 @@ func (o *visualServer) InstanceGeometrySetMaterialOverride(instance gdnative.Rid
 	ptrArguments[1] = gdnative.NewPointerFromRid(material)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_geometry_set_material_override")
+	methodBind := methodBindVisualServerInstanceGeometrySetMaterialOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -4192,8 +5809,7 @@ func (o *visualServer) InstanceGeometrySetMaterialOverride(instance gdnative.Rid
 }
 
 /*
-
-	Args: [{ false instance RID} { false base RID}], Returns: void
+Args: [{ false instance RID} { false base RID}], Returns: void
 */
 func (o *visualServer) InstanceSetBase(instance gdnative.Rid, base gdnative.Rid) {
 	o.ensureSingleton()
@@ -4205,7 +5821,7 @@ func (o *visualServer) InstanceSetBase(instance gdnative.Rid, base gdnative.Rid)
 	ptrArguments[1] = gdnative.NewPointerFromRid(base)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_base")
+	methodBind := methodBindVisualServerInstanceSetBase.Get()
 
 	// Call the parent method.
 	// void
@@ -4215,8 +5831,7 @@ func (o *visualServer) InstanceSetBase(instance gdnative.Rid, base gdnative.Rid)
 }
 
 /*
-
-	Args: [{ false instance RID} { false shape int} { false weight float}], Returns: void
+Args: [{ false instance RID} { false shape int} { false weight float}], Returns: void
 */
 func (o *visualServer) InstanceSetBlendShapeWeight(instance gdnative.Rid, shape gdnative.Int, weight gdnative.Real) {
 	o.ensureSingleton()
@@ -4229,7 +5844,7 @@ func (o *visualServer) InstanceSetBlendShapeWeight(instance gdnative.Rid, shape
 	ptrArguments[2] = gdnative.NewPointerFromReal(weight)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_blend_shape_weight")
+	methodBind := methodBindVisualServerInstanceSetBlendShapeWeight.Get()
 
 	// Call the parent method.
 	// void
@@ -4239,8 +5854,7 @@ func (o *visualServer) InstanceSetBlendShapeWeight(instance gdnative.Rid, shape
 }
 
 /*
-
-	Args: [{ false instance RID} { false aabb AABB}], Returns: void
+Args: [{ false instance RID} { false aabb AABB}], Returns: void
 */
 func (o *visualServer) InstanceSetCustomAabb(instance gdnative.Rid, aabb gdnative.Aabb) {
 	o.ensureSingleton()
@@ -4252,7 +5866,7 @@ func (o *visualServer) InstanceSetCustomAabb(instance gdnative.Rid, aabb gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromAabb(aabb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_custom_aabb")
+	methodBind := methodBindVisualServerInstanceSetCustomAabb.Get()
 
 	// Call the parent method.
 	// void
@@ -4262,8 +5876,7 @@ func (o *visualServer) InstanceSetCustomAabb(instance gdnative.Rid, aabb gdnativ
 }
 
 /*
-
-	Args: [{ false instance RID} { false enabled bool}], Returns: void
+Args: [{ false instance RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) InstanceSetExterior(instance gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -4275,7 +5888,7 @@ func (o *visualServer) InstanceSetExterior(instance gdnative.Rid, enabled gdnati
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_exterior")
+	methodBind := methodBindVisualServerInstanceSetExterior.Get()
 
 	// Call the parent method.
 	// void
@@ -4285,8 +5898,7 @@ func (o *visualServer) InstanceSetExterior(instance gdnative.Rid, enabled gdnati
 }
 
 /*
-
-	Args: [{ false instance RID} { false margin float}], Returns: void
+Args: [{ false instance RID} { false margin float}], Returns: void
 */
 func (o *visualServer) InstanceSetExtraVisibilityMargin(instance gdnative.Rid, margin gdnative.Real) {
 	o.ensureSingleton()
@@ -4298,7 +5910,7 @@ func (o *visualServer) InstanceSetExtraVisibilityMargin(instance gdnative.Rid, m
 	ptrArguments[1] = gdnative.NewPointerFromReal(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_extra_visibility_margin")
+	methodBind := methodBindVisualServerInstanceSetExtraVisibilityMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -4308,8 +5920,7 @@ func (o *visualServer) InstanceSetExtraVisibilityMargin(instance gdnative.Rid, m
 }
 
 /*
-
-	Args: [{ false instance RID} { false mask int}], Returns: void
+Args: [{ false instance RID} { false mask int}], Returns: void
 */
 func (o *visualServer) InstanceSetLayerMask(instance gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -4321,7 +5932,7 @@ func (o *visualServer) InstanceSetLayerMask(instance gdnative.Rid, mask gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_layer_mask")
+	methodBind := methodBindVisualServerInstanceSetLayerMask.Get()
 
 	// Call the parent method.
 	// void
@@ -4331,8 +5942,7 @@ func (o *visualServer) InstanceSetLayerMask(instance gdnative.Rid, mask gdnative
 }
 
 /*
-
-	Args: [{ false instance RID} { false scenario RID}], Returns: void
+Args: [{ false instance RID} { false scenario RID}], Returns: void
 */
 func (o *visualServer) InstanceSetScenario(instance gdnative.Rid, scenario gdnative.Rid) {
 	o.ensureSingleton()
@@ -4344,7 +5954,7 @@ func (o *visualServer) InstanceSetScenario(instance gdnative.Rid, scenario gdnat
 	ptrArguments[1] = gdnative.NewPointerFromRid(scenario)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_scenario")
+	methodBind := methodBindVisualServerInstanceSetScenario.Get()
 
 	// Call the parent method.
 	// void
@@ -4354,8 +5964,7 @@ func (o *visualServer) InstanceSetScenario(instance gdnative.Rid, scenario gdnat
 }
 
 /*
-
-	Args: [{ false instance RID} { false surface int} { false material RID}], Returns: void
+Args: [{ false instance RID} { false surface int} { false material RID}], Returns: void
 */
 func (o *visualServer) InstanceSetSurfaceMaterial(instance gdnative.Rid, surface gdnative.Int, material gdnative.Rid) {
 	o.ensureSingleton()
@@ -4368,7 +5977,7 @@ func (o *visualServer) InstanceSetSurfaceMaterial(instance gdnative.Rid, surface
 	ptrArguments[2] = gdnative.NewPointerFromRid(material)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_surface_material")
+	methodBind := methodBindVisualServerInstanceSetSurfaceMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -4378,8 +5987,7 @@ func (o *visualServer) InstanceSetSurfaceMaterial(instance gdnative.Rid, surface
 }
 
 /*
-
-	Args: [{ false instance RID} { false transform Transform}], Returns: void
+Args: [{ false instance RID} { false transform Transform}], Returns: void
 */
 func (o *visualServer) InstanceSetTransform(instance gdnative.Rid, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -4391,7 +5999,7 @@ func (o *visualServer) InstanceSetTransform(instance gdnative.Rid, transform gdn
 	ptrArguments[1] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_transform")
+	methodBind := methodBindVisualServerInstanceSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -4401,8 +6009,7 @@ func (o *visualServer) InstanceSetTransform(instance gdnative.Rid, transform gdn
 }
 
 /*
-
-	Args: [{ false instance RID} { false lightmap_instance RID} { false lightmap RID}], Returns: void
+Args: [{ false instance RID} { false lightmap_instance RID} { false lightmap RID}], Returns: void
 */
 func (o *visualServer) InstanceSetUseLightmap(instance gdnative.Rid, lightmapInstance gdnative.Rid, lightmap gdnative.Rid) {
 	o.ensureSingleton()
@@ -4415,7 +6022,7 @@ func (o *visualServer) InstanceSetUseLightmap(instance gdnative.Rid, lightmapIns
 	ptrArguments[2] = gdnative.NewPointerFromRid(lightmap)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_use_lightmap")
+	methodBind := methodBindVisualServerInstanceSetUseLightmap.Get()
 
 	// Call the parent method.
 	// void
@@ -4425,8 +6032,7 @@ func (o *visualServer) InstanceSetUseLightmap(instance gdnative.Rid, lightmapIns
 }
 
 /*
-
-	Args: [{ false instance RID} { false visible bool}], Returns: void
+Args: [{ false instance RID} { false visible bool}], Returns: void
 */
 func (o *visualServer) InstanceSetVisible(instance gdnative.Rid, visible gdnative.Bool) {
 	o.ensureSingleton()
@@ -4438,7 +6044,7 @@ func (o *visualServer) InstanceSetVisible(instance gdnative.Rid, visible gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromBool(visible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instance_set_visible")
+	methodBind := methodBindVisualServerInstanceSetVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -4448,8 +6054,7 @@ func (o *visualServer) InstanceSetVisible(instance gdnative.Rid, visible gdnativ
 }
 
 /*
-
-	Args: [{ false aabb AABB} {[RID] true scenario RID}], Returns: Array
+Args: [{ false aabb AABB} {[RID] true scenario RID}], Returns: Array
 */
 func (o *visualServer) InstancesCullAabb(aabb gdnative.Aabb, scenario gdnative.Rid) gdnative.Array {
 	o.ensureSingleton()
@@ -4461,7 +6066,7 @@ func (o *visualServer) InstancesCullAabb(aabb gdnative.Aabb, scenario gdnative.R
 	ptrArguments[1] = gdnative.NewPointerFromRid(scenario)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instances_cull_aabb")
+	methodBind := methodBindVisualServerInstancesCullAabb.Get()
 
 	// Call the parent method.
 	// Array
@@ -4474,8 +6079,7 @@ func (o *visualServer) InstancesCullAabb(aabb gdnative.Aabb, scenario gdnative.R
 }
 
 /*
-
-	Args: [{ false convex Array} {[RID] true scenario RID}], Returns: Array
+Args: [{ false convex Array} {[RID] true scenario RID}], Returns: Array
 */
 func (o *visualServer) InstancesCullConvex(convex gdnative.Array, scenario gdnative.Rid) gdnative.Array {
 	o.ensureSingleton()
@@ -4487,7 +6091,7 @@ func (o *visualServer) InstancesCullConvex(convex gdnative.Array, scenario gdnat
 	ptrArguments[1] = gdnative.NewPointerFromRid(scenario)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instances_cull_convex")
+	methodBind := methodBindVisualServerInstancesCullConvex.Get()
 
 	// Call the parent method.
 	// Array
@@ -4500,8 +6104,7 @@ func (o *visualServer) InstancesCullConvex(convex gdnative.Array, scenario gdnat
 }
 
 /*
-
-	Args: [{ false from Vector3} { false to Vector3} {[RID] true scenario RID}], Returns: Array
+Args: [{ false from Vector3} { false to Vector3} {[RID] true scenario RID}], Returns: Array
 */
 func (o *visualServer) InstancesCullRay(from gdnative.Vector3, to gdnative.Vector3, scenario gdnative.Rid) gdnative.Array {
 	o.ensureSingleton()
@@ -4514,7 +6117,7 @@ func (o *visualServer) InstancesCullRay(from gdnative.Vector3, to gdnative.Vecto
 	ptrArguments[2] = gdnative.NewPointerFromRid(scenario)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "instances_cull_ray")
+	methodBind := methodBindVisualServerInstancesCullRay.Get()
 
 	// Call the parent method.
 	// Array
@@ -4527,8 +6130,7 @@ func (o *visualServer) InstancesCullRay(from gdnative.Vector3, to gdnative.Vecto
 }
 
 /*
-
-	Args: [{ false light RID} { false enable bool}], Returns: void
+Args: [{ false light RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) LightDirectionalSetBlendSplits(light gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -4540,7 +6142,7 @@ func (o *visualServer) LightDirectionalSetBlendSplits(light gdnative.Rid, enable
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_directional_set_blend_splits")
+	methodBind := methodBindVisualServerLightDirectionalSetBlendSplits.Get()
 
 	// Call the parent method.
 	// void
@@ -4550,20 +6152,19 @@ func (o *visualServer) LightDirectionalSetBlendSplits(light gdnative.Rid, enable
 }
 
 /*
-
-	Args: [{ false light RID} { false range_mode int}], Returns: void
+Args: [{ false light RID} { false range_mode enum.VisualServer::LightDirectionalShadowDepthRangeMode}], Returns: void
 */
-func (o *visualServer) LightDirectionalSetShadowDepthRangeMode(light gdnative.Rid, rangeMode gdnative.Int) {
+func (o *visualServer) LightDirectionalSetShadowDepthRangeMode(light gdnative.Rid, rangeMode VisualServerLightDirectionalShadowDepthRangeMode) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.LightDirectionalSetShadowDepthRangeMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromRid(light)
-	ptrArguments[1] = gdnative.NewPointerFromInt(rangeMode)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(rangeMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_directional_set_shadow_depth_range_mode")
+	methodBind := methodBindVisualServerLightDirectionalSetShadowDepthRangeMode.Get()
 
 	// Call the parent method.
 	// void
@@ -4573,8 +6174,7 @@ func (o *visualServer) LightDirectionalSetShadowDepthRangeMode(light gdnative.Ri
 }
 
 /*
-
-	Args: [{ false light RID} { false mode int}], Returns: void
+Args: [{ false light RID} { false mode int}], Returns: void
 */
 func (o *visualServer) LightDirectionalSetShadowMode(light gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -4586,7 +6186,7 @@ func (o *visualServer) LightDirectionalSetShadowMode(light gdnative.Rid, mode gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_directional_set_shadow_mode")
+	methodBind := methodBindVisualServerLightDirectionalSetShadowMode.Get()
 
 	// Call the parent method.
 	// void
@@ -4596,8 +6196,7 @@ func (o *visualServer) LightDirectionalSetShadowMode(light gdnative.Rid, mode gd
 }
 
 /*
-
-	Args: [{ false light RID} { false detail int}], Returns: void
+Args: [{ false light RID} { false detail int}], Returns: void
 */
 func (o *visualServer) LightOmniSetShadowDetail(light gdnative.Rid, detail gdnative.Int) {
 	o.ensureSingleton()
@@ -4609,7 +6208,7 @@ func (o *visualServer) LightOmniSetShadowDetail(light gdnative.Rid, detail gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(detail)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_omni_set_shadow_detail")
+	methodBind := methodBindVisualServerLightOmniSetShadowDetail.Get()
 
 	// Call the parent method.
 	// void
@@ -4619,8 +6218,7 @@ func (o *visualServer) LightOmniSetShadowDetail(light gdnative.Rid, detail gdnat
 }
 
 /*
-
-	Args: [{ false light RID} { false mode int}], Returns: void
+Args: [{ false light RID} { false mode int}], Returns: void
 */
 func (o *visualServer) LightOmniSetShadowMode(light gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -4632,7 +6230,7 @@ func (o *visualServer) LightOmniSetShadowMode(light gdnative.Rid, mode gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_omni_set_shadow_mode")
+	methodBind := methodBindVisualServerLightOmniSetShadowMode.Get()
 
 	// Call the parent method.
 	// void
@@ -4642,8 +6240,7 @@ func (o *visualServer) LightOmniSetShadowMode(light gdnative.Rid, mode gdnative.
 }
 
 /*
-
-	Args: [{ false light RID} { false color Color}], Returns: void
+Args: [{ false light RID} { false color Color}], Returns: void
 */
 func (o *visualServer) LightSetColor(light gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -4655,7 +6252,7 @@ func (o *visualServer) LightSetColor(light gdnative.Rid, color gdnative.Color) {
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_color")
+	methodBind := methodBindVisualServerLightSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -4665,8 +6262,7 @@ func (o *visualServer) LightSetColor(light gdnative.Rid, color gdnative.Color) {
 }
 
 /*
-
-	Args: [{ false light RID} { false mask int}], Returns: void
+Args: [{ false light RID} { false mask int}], Returns: void
 */
 func (o *visualServer) LightSetCullMask(light gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -4678,7 +6274,7 @@ func (o *visualServer) LightSetCullMask(light gdnative.Rid, mask gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_cull_mask")
+	methodBind := methodBindVisualServerLightSetCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -4688,8 +6284,7 @@ func (o *visualServer) LightSetCullMask(light gdnative.Rid, mask gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false light RID} { false enable bool}], Returns: void
+Args: [{ false light RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) LightSetNegative(light gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -4701,7 +6296,7 @@ func (o *visualServer) LightSetNegative(light gdnative.Rid, enable gdnative.Bool
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_negative")
+	methodBind := methodBindVisualServerLightSetNegative.Get()
 
 	// Call the parent method.
 	// void
@@ -4711,8 +6306,7 @@ func (o *visualServer) LightSetNegative(light gdnative.Rid, enable gdnative.Bool
 }
 
 /*
-
-	Args: [{ false light RID} { false param int} { false value float}], Returns: void
+Args: [{ false light RID} { false param int} { false value float}], Returns: void
 */
 func (o *visualServer) LightSetParam(light gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -4725,7 +6319,7 @@ func (o *visualServer) LightSetParam(light gdnative.Rid, param gdnative.Int, val
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_param")
+	methodBind := methodBindVisualServerLightSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -4735,8 +6329,7 @@ func (o *visualServer) LightSetParam(light gdnative.Rid, param gdnative.Int, val
 }
 
 /*
-
-	Args: [{ false light RID} { false texture RID}], Returns: void
+Args: [{ false light RID} { false texture RID}], Returns: void
 */
 func (o *visualServer) LightSetProjector(light gdnative.Rid, texture gdnative.Rid) {
 	o.ensureSingleton()
@@ -4748,7 +6341,7 @@ func (o *visualServer) LightSetProjector(light gdnative.Rid, texture gdnative.Ri
 	ptrArguments[1] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_projector")
+	methodBind := methodBindVisualServerLightSetProjector.Get()
 
 	// Call the parent method.
 	// void
@@ -4758,8 +6351,7 @@ func (o *visualServer) LightSetProjector(light gdnative.Rid, texture gdnative.Ri
 }
 
 /*
-
-	Args: [{ false light RID} { false enabled bool}], Returns: void
+Args: [{ false light RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) LightSetReverseCullFaceMode(light gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -4771,7 +6363,7 @@ func (o *visualServer) LightSetReverseCullFaceMode(light gdnative.Rid, enabled g
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_reverse_cull_face_mode")
+	methodBind := methodBindVisualServerLightSetReverseCullFaceMode.Get()
 
 	// Call the parent method.
 	// void
@@ -4781,8 +6373,7 @@ func (o *visualServer) LightSetReverseCullFaceMode(light gdnative.Rid, enabled g
 }
 
 /*
-
-	Args: [{ false light RID} { false enabled bool}], Returns: void
+Args: [{ false light RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) LightSetShadow(light gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -4794,7 +6385,7 @@ func (o *visualServer) LightSetShadow(light gdnative.Rid, enabled gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_shadow")
+	methodBind := methodBindVisualServerLightSetShadow.Get()
 
 	// Call the parent method.
 	// void
@@ -4804,8 +6395,7 @@ func (o *visualServer) LightSetShadow(light gdnative.Rid, enabled gdnative.Bool)
 }
 
 /*
-
-	Args: [{ false light RID} { false color Color}], Returns: void
+Args: [{ false light RID} { false color Color}], Returns: void
 */
 func (o *visualServer) LightSetShadowColor(light gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -4817,7 +6407,7 @@ func (o *visualServer) LightSetShadowColor(light gdnative.Rid, color gdnative.Co
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "light_set_shadow_color")
+	methodBind := methodBindVisualServerLightSetShadowColor.Get()
 
 	// Call the parent method.
 	// void
@@ -4827,8 +6417,7 @@ func (o *visualServer) LightSetShadowColor(light gdnative.Rid, color gdnative.Co
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) LightmapCaptureCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -4838,7 +6427,7 @@ func (o *visualServer) LightmapCaptureCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_create")
+	methodBind := methodBindVisualServerLightmapCaptureCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -4851,8 +6440,7 @@ func (o *visualServer) LightmapCaptureCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false capture RID}], Returns: AABB
+Args: [{ false capture RID}], Returns: AABB
 */
 func (o *visualServer) LightmapCaptureGetBounds(capture gdnative.Rid) gdnative.Aabb {
 	o.ensureSingleton()
@@ -4863,7 +6451,7 @@ func (o *visualServer) LightmapCaptureGetBounds(capture gdnative.Rid) gdnative.A
 	ptrArguments[0] = gdnative.NewPointerFromRid(capture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_get_bounds")
+	methodBind := methodBindVisualServerLightmapCaptureGetBounds.Get()
 
 	// Call the parent method.
 	// AABB
@@ -4876,8 +6464,7 @@ func (o *visualServer) LightmapCaptureGetBounds(capture gdnative.Rid) gdnative.A
 }
 
 /*
-
-	Args: [{ false capture RID}], Returns: float
+Args: [{ false capture RID}], Returns: float
 */
 func (o *visualServer) LightmapCaptureGetEnergy(capture gdnative.Rid) gdnative.Real {
 	o.ensureSingleton()
@@ -4888,7 +6475,7 @@ func (o *visualServer) LightmapCaptureGetEnergy(capture gdnative.Rid) gdnative.R
 	ptrArguments[0] = gdnative.NewPointerFromRid(capture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_get_energy")
+	methodBind := methodBindVisualServerLightmapCaptureGetEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -4901,8 +6488,7 @@ func (o *visualServer) LightmapCaptureGetEnergy(capture gdnative.Rid) gdnative.R
 }
 
 /*
-
-	Args: [{ false capture RID}], Returns: PoolByteArray
+Args: [{ false capture RID}], Returns: PoolByteArray
 */
 func (o *visualServer) LightmapCaptureGetOctree(capture gdnative.Rid) gdnative.PoolByteArray {
 	o.ensureSingleton()
@@ -4913,7 +6499,7 @@ func (o *visualServer) LightmapCaptureGetOctree(capture gdnative.Rid) gdnative.P
 	ptrArguments[0] = gdnative.NewPointerFromRid(capture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_get_octree")
+	methodBind := methodBindVisualServerLightmapCaptureGetOctree.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -4926,8 +6512,7 @@ func (o *visualServer) LightmapCaptureGetOctree(capture gdnative.Rid) gdnative.P
 }
 
 /*
-
-	Args: [{ false capture RID}], Returns: int
+Args: [{ false capture RID}], Returns: int
 */
 func (o *visualServer) LightmapCaptureGetOctreeCellSubdiv(capture gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -4938,7 +6523,7 @@ func (o *visualServer) LightmapCaptureGetOctreeCellSubdiv(capture gdnative.Rid)
 	ptrArguments[0] = gdnative.NewPointerFromRid(capture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_get_octree_cell_subdiv")
+	methodBind := methodBindVisualServerLightmapCaptureGetOctreeCellSubdiv.Get()
 
 	// Call the parent method.
 	// int
@@ -4951,8 +6536,7 @@ func (o *visualServer) LightmapCaptureGetOctreeCellSubdiv(capture gdnative.Rid)
 }
 
 /*
-
-	Args: [{ false capture RID}], Returns: Transform
+Args: [{ false capture RID}], Returns: Transform
 */
 func (o *visualServer) LightmapCaptureGetOctreeCellTransform(capture gdnative.Rid) gdnative.Transform {
 	o.ensureSingleton()
@@ -4963,7 +6547,7 @@ func (o *visualServer) LightmapCaptureGetOctreeCellTransform(capture gdnative.Ri
 	ptrArguments[0] = gdnative.NewPointerFromRid(capture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_get_octree_cell_transform")
+	methodBind := methodBindVisualServerLightmapCaptureGetOctreeCellTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -4976,8 +6560,7 @@ func (o *visualServer) LightmapCaptureGetOctreeCellTransform(capture gdnative.Ri
 }
 
 /*
-
-	Args: [{ false capture RID} { false bounds AABB}], Returns: void
+Args: [{ false capture RID} { false bounds AABB}], Returns: void
 */
 func (o *visualServer) LightmapCaptureSetBounds(capture gdnative.Rid, bounds gdnative.Aabb) {
 	o.ensureSingleton()
@@ -4989,7 +6572,7 @@ func (o *visualServer) LightmapCaptureSetBounds(capture gdnative.Rid, bounds gdn
 	ptrArguments[1] = gdnative.NewPointerFromAabb(bounds)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_set_bounds")
+	methodBind := methodBindVisualServerLightmapCaptureSetBounds.Get()
 
 	// Call the parent method.
 	// void
@@ -4999,8 +6582,7 @@ func (o *visualServer) LightmapCaptureSetBounds(capture gdnative.Rid, bounds gdn
 }
 
 /*
-
-	Args: [{ false capture RID} { false energy float}], Returns: void
+Args: [{ false capture RID} { false energy float}], Returns: void
 */
 func (o *visualServer) LightmapCaptureSetEnergy(capture gdnative.Rid, energy gdnative.Real) {
 	o.ensureSingleton()
@@ -5012,7 +6594,7 @@ func (o *visualServer) LightmapCaptureSetEnergy(capture gdnative.Rid, energy gdn
 	ptrArguments[1] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_set_energy")
+	methodBind := methodBindVisualServerLightmapCaptureSetEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -5022,8 +6604,7 @@ func (o *visualServer) LightmapCaptureSetEnergy(capture gdnative.Rid, energy gdn
 }
 
 /*
-
-	Args: [{ false capture RID} { false octree PoolByteArray}], Returns: void
+Args: [{ false capture RID} { false octree PoolByteArray}], Returns: void
 */
 func (o *visualServer) LightmapCaptureSetOctree(capture gdnative.Rid, octree gdnative.PoolByteArray) {
 	o.ensureSingleton()
@@ -5035,7 +6616,7 @@ func (o *visualServer) LightmapCaptureSetOctree(capture gdnative.Rid, octree gdn
 	ptrArguments[1] = gdnative.NewPointerFromPoolByteArray(octree)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_set_octree")
+	methodBind := methodBindVisualServerLightmapCaptureSetOctree.Get()
 
 	// Call the parent method.
 	// void
@@ -5045,8 +6626,7 @@ func (o *visualServer) LightmapCaptureSetOctree(capture gdnative.Rid, octree gdn
 }
 
 /*
-
-	Args: [{ false capture RID} { false subdiv int}], Returns: void
+Args: [{ false capture RID} { false subdiv int}], Returns: void
 */
 func (o *visualServer) LightmapCaptureSetOctreeCellSubdiv(capture gdnative.Rid, subdiv gdnative.Int) {
 	o.ensureSingleton()
@@ -5058,7 +6638,7 @@ func (o *visualServer) LightmapCaptureSetOctreeCellSubdiv(capture gdnative.Rid,
 	ptrArguments[1] = gdnative.NewPointerFromInt(subdiv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_set_octree_cell_subdiv")
+	methodBind := methodBindVisualServerLightmapCaptureSetOctreeCellSubdiv.Get()
 
 	// Call the parent method.
 	// void
@@ -5068,8 +6648,7 @@ func (o *visualServer) LightmapCaptureSetOctreeCellSubdiv(capture gdnative.Rid,
 }
 
 /*
-
-	Args: [{ false capture RID} { false xform Transform}], Returns: void
+Args: [{ false capture RID} { false xform Transform}], Returns: void
 */
 func (o *visualServer) LightmapCaptureSetOctreeCellTransform(capture gdnative.Rid, xform gdnative.Transform) {
 	o.ensureSingleton()
@@ -5081,7 +6660,7 @@ func (o *visualServer) LightmapCaptureSetOctreeCellTransform(capture gdnative.Ri
 	ptrArguments[1] = gdnative.NewPointerFromTransform(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "lightmap_capture_set_octree_cell_transform")
+	methodBind := methodBindVisualServerLightmapCaptureSetOctreeCellTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -5091,8 +6670,8 @@ func (o *visualServer) LightmapCaptureSetOctreeCellTransform(capture gdnative.Ri
 }
 
 /*
-        Returns a mesh of a sphere with the given amount of horizontal and vertical subdivisions.
-	Args: [{ false latitudes int} { false longitudes int} { false radius float}], Returns: RID
+	        Returns a mesh of a sphere with the given amount of horizontal and vertical subdivisions.
+		Args: [{ false latitudes int} { false longitudes int} { false radius float}], Returns: RID
 */
 func (o *visualServer) MakeSphereMesh(latitudes gdnative.Int, longitudes gdnative.Int, radius gdnative.Real) gdnative.Rid {
 	o.ensureSingleton()
@@ -5105,7 +6684,7 @@ func (o *visualServer) MakeSphereMesh(latitudes gdnative.Int, longitudes gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "make_sphere_mesh")
+	methodBind := methodBindVisualServerMakeSphereMesh.Get()
 
 	// Call the parent method.
 	// RID
@@ -5118,8 +6697,8 @@ func (o *visualServer) MakeSphereMesh(latitudes gdnative.Int, longitudes gdnativ
 }
 
 /*
-        Returns an empty material.
-	Args: [], Returns: RID
+	        Returns an empty material.
+		Args: [], Returns: RID
 */
 func (o *visualServer) MaterialCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -5129,7 +6708,7 @@ func (o *visualServer) MaterialCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_create")
+	methodBind := methodBindVisualServerMaterialCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -5142,8 +6721,8 @@ func (o *visualServer) MaterialCreate() gdnative.Rid {
 }
 
 /*
-        Returns the value of a certain material's parameter.
-	Args: [{ false material RID} { false parameter String}], Returns: Variant
+	        Returns the value of a certain material's parameter.
+		Args: [{ false material RID} { false parameter String}], Returns: Variant
 */
 func (o *visualServer) MaterialGetParam(material gdnative.Rid, parameter gdnative.String) gdnative.Variant {
 	o.ensureSingleton()
@@ -5155,7 +6734,7 @@ func (o *visualServer) MaterialGetParam(material gdnative.Rid, parameter gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromString(parameter)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_get_param")
+	methodBind := methodBindVisualServerMaterialGetParam.Get()
 
 	// Call the parent method.
 	// Variant
@@ -5168,8 +6747,8 @@ func (o *visualServer) MaterialGetParam(material gdnative.Rid, parameter gdnativ
 }
 
 /*
-        Returns the shader of a certain material's shader. Returns an empty RID if the material doesn't have a shader.
-	Args: [{ false shader_material RID}], Returns: RID
+	        Returns the shader of a certain material's shader. Returns an empty RID if the material doesn't have a shader.
+		Args: [{ false shader_material RID}], Returns: RID
 */
 func (o *visualServer) MaterialGetShader(shaderMaterial gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -5180,7 +6759,7 @@ func (o *visualServer) MaterialGetShader(shaderMaterial gdnative.Rid) gdnative.R
 	ptrArguments[0] = gdnative.NewPointerFromRid(shaderMaterial)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_get_shader")
+	methodBind := methodBindVisualServerMaterialGetShader.Get()
 
 	// Call the parent method.
 	// RID
@@ -5193,8 +6772,8 @@ func (o *visualServer) MaterialGetShader(shaderMaterial gdnative.Rid) gdnative.R
 }
 
 /*
-        Sets a materials line width.
-	Args: [{ false material RID} { false width float}], Returns: void
+	        Sets a materials line width.
+		Args: [{ false material RID} { false width float}], Returns: void
 */
 func (o *visualServer) MaterialSetLineWidth(material gdnative.Rid, width gdnative.Real) {
 	o.ensureSingleton()
@@ -5206,7 +6785,7 @@ func (o *visualServer) MaterialSetLineWidth(material gdnative.Rid, width gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromReal(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_set_line_width")
+	methodBind := methodBindVisualServerMaterialSetLineWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -5216,8 +6795,8 @@ func (o *visualServer) MaterialSetLineWidth(material gdnative.Rid, width gdnativ
 }
 
 /*
-        Sets an objects next material.
-	Args: [{ false material RID} { false next_material RID}], Returns: void
+	        Sets an objects next material.
+		Args: [{ false material RID} { false next_material RID}], Returns: void
 */
 func (o *visualServer) MaterialSetNextPass(material gdnative.Rid, nextMaterial gdnative.Rid) {
 	o.ensureSingleton()
@@ -5229,7 +6808,7 @@ func (o *visualServer) MaterialSetNextPass(material gdnative.Rid, nextMaterial g
 	ptrArguments[1] = gdnative.NewPointerFromRid(nextMaterial)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_set_next_pass")
+	methodBind := methodBindVisualServerMaterialSetNextPass.Get()
 
 	// Call the parent method.
 	// void
@@ -5239,8 +6818,8 @@ func (o *visualServer) MaterialSetNextPass(material gdnative.Rid, nextMaterial g
 }
 
 /*
-        Sets a materials parameter.
-	Args: [{ false material RID} { false parameter String} { false value Variant}], Returns: void
+	        Sets a materials parameter.
+		Args: [{ false material RID} { false parameter String} { false value Variant}], Returns: void
 */
 func (o *visualServer) MaterialSetParam(material gdnative.Rid, parameter gdnative.String, value gdnative.Variant) {
 	o.ensureSingleton()
@@ -5253,7 +6832,7 @@ func (o *visualServer) MaterialSetParam(material gdnative.Rid, parameter gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_set_param")
+	methodBind := methodBindVisualServerMaterialSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -5263,8 +6842,8 @@ func (o *visualServer) MaterialSetParam(material gdnative.Rid, parameter gdnativ
 }
 
 /*
-        Sets a material's render priority.
-	Args: [{ false material RID} { false priority int}], Returns: void
+	        Sets a material's render priority.
+		Args: [{ false material RID} { false priority int}], Returns: void
 */
 func (o *visualServer) MaterialSetRenderPriority(material gdnative.Rid, priority gdnative.Int) {
 	o.ensureSingleton()
@@ -5276,7 +6855,7 @@ func (o *visualServer) MaterialSetRenderPriority(material gdnative.Rid, priority
 	ptrArguments[1] = gdnative.NewPointerFromInt(priority)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_set_render_priority")
+	methodBind := methodBindVisualServerMaterialSetRenderPriority.Get()
 
 	// Call the parent method.
 	// void
@@ -5286,8 +6865,8 @@ func (o *visualServer) MaterialSetRenderPriority(material gdnative.Rid, priority
 }
 
 /*
-        Sets a shader material's shader.
-	Args: [{ false shader_material RID} { false shader RID}], Returns: void
+	        Sets a shader material's shader.
+		Args: [{ false shader_material RID} { false shader RID}], Returns: void
 */
 func (o *visualServer) MaterialSetShader(shaderMaterial gdnative.Rid, shader gdnative.Rid) {
 	o.ensureSingleton()
@@ -5299,7 +6878,7 @@ func (o *visualServer) MaterialSetShader(shaderMaterial gdnative.Rid, shader gdn
 	ptrArguments[1] = gdnative.NewPointerFromRid(shader)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "material_set_shader")
+	methodBind := methodBindVisualServerMaterialSetShader.Get()
 
 	// Call the parent method.
 	// void
@@ -5309,8 +6888,8 @@ func (o *visualServer) MaterialSetShader(shaderMaterial gdnative.Rid, shader gdn
 }
 
 /*
-        Adds a surface generated from the Arrays to a mesh. See PRIMITIVE_TYPE_* constants for types.
-	Args: [{ false mesh RID} { false primtive int} { false arrays Array} {[] true blend_shapes Array} {97792 true compress_format int}], Returns: void
+	        Adds a surface generated from the Arrays to a mesh. See PRIMITIVE_TYPE_* constants for types.
+		Args: [{ false mesh RID} { false primtive int} { false arrays Array} {[] true blend_shapes Array} {97792 true compress_format int}], Returns: void
 */
 func (o *visualServer) MeshAddSurfaceFromArrays(mesh gdnative.Rid, primtive gdnative.Int, arrays gdnative.Array, blendShapes gdnative.Array, compressFormat gdnative.Int) {
 	o.ensureSingleton()
@@ -5325,7 +6904,7 @@ func (o *visualServer) MeshAddSurfaceFromArrays(mesh gdnative.Rid, primtive gdna
 	ptrArguments[4] = gdnative.NewPointerFromInt(compressFormat)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_add_surface_from_arrays")
+	methodBind := methodBindVisualServerMeshAddSurfaceFromArrays.Get()
 
 	// Call the parent method.
 	// void
@@ -5335,8 +6914,8 @@ func (o *visualServer) MeshAddSurfaceFromArrays(mesh gdnative.Rid, primtive gdna
 }
 
 /*
-        Removes all surfaces from a mesh.
-	Args: [{ false mesh RID}], Returns: void
+	        Removes all surfaces from a mesh.
+		Args: [{ false mesh RID}], Returns: void
 */
 func (o *visualServer) MeshClear(mesh gdnative.Rid) {
 	o.ensureSingleton()
@@ -5347,7 +6926,7 @@ func (o *visualServer) MeshClear(mesh gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(mesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_clear")
+	methodBind := methodBindVisualServerMeshClear.Get()
 
 	// Call the parent method.
 	// void
@@ -5357,8 +6936,8 @@ func (o *visualServer) MeshClear(mesh gdnative.Rid) {
 }
 
 /*
-        Creates a new mesh.
-	Args: [], Returns: RID
+	        Creates a new mesh.
+		Args: [], Returns: RID
 */
 func (o *visualServer) MeshCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -5368,7 +6947,7 @@ func (o *visualServer) MeshCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_create")
+	methodBind := methodBindVisualServerMeshCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -5381,8 +6960,8 @@ func (o *visualServer) MeshCreate() gdnative.Rid {
 }
 
 /*
-        Returns a mesh's blend shape count.
-	Args: [{ false mesh RID}], Returns: int
+	        Returns a mesh's blend shape count.
+		Args: [{ false mesh RID}], Returns: int
 */
 func (o *visualServer) MeshGetBlendShapeCount(mesh gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -5393,7 +6972,7 @@ func (o *visualServer) MeshGetBlendShapeCount(mesh gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(mesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_get_blend_shape_count")
+	methodBind := methodBindVisualServerMeshGetBlendShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -5406,8 +6985,8 @@ func (o *visualServer) MeshGetBlendShapeCount(mesh gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns a mesh's blend shape mode.
-	Args: [{ false mesh RID}], Returns: enum.VisualServer::BlendShapeMode
+	        Returns a mesh's blend shape mode.
+		Args: [{ false mesh RID}], Returns: enum.VisualServer::BlendShapeMode
 */
 func (o *visualServer) MeshGetBlendShapeMode(mesh gdnative.Rid) VisualServerBlendShapeMode {
 	o.ensureSingleton()
@@ -5418,7 +6997,7 @@ func (o *visualServer) MeshGetBlendShapeMode(mesh gdnative.Rid) VisualServerBlen
 	ptrArguments[0] = gdnative.NewPointerFromRid(mesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_get_blend_shape_mode")
+	methodBind := methodBindVisualServerMeshGetBlendShapeMode.Get()
 
 	// Call the parent method.
 	// enum.VisualServer::BlendShapeMode
@@ -5431,8 +7010,8 @@ func (o *visualServer) MeshGetBlendShapeMode(mesh gdnative.Rid) VisualServerBlen
 }
 
 /*
-        Returns a mesh's custom aabb.
-	Args: [{ false mesh RID}], Returns: AABB
+	        Returns a mesh's custom aabb.
+		Args: [{ false mesh RID}], Returns: AABB
 */
 func (o *visualServer) MeshGetCustomAabb(mesh gdnative.Rid) gdnative.Aabb {
 	o.ensureSingleton()
@@ -5443,7 +7022,7 @@ func (o *visualServer) MeshGetCustomAabb(mesh gdnative.Rid) gdnative.Aabb {
 	ptrArguments[0] = gdnative.NewPointerFromRid(mesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_get_custom_aabb")
+	methodBind := methodBindVisualServerMeshGetCustomAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -5456,8 +7035,8 @@ func (o *visualServer) MeshGetCustomAabb(mesh gdnative.Rid) gdnative.Aabb {
 }
 
 /*
-        Returns a mesh's number of surfaces.
-	Args: [{ false mesh RID}], Returns: int
+	        Returns a mesh's number of surfaces.
+		Args: [{ false mesh RID}], Returns: int
 */
 func (o *visualServer) MeshGetSurfaceCount(mesh gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -5468,7 +7047,7 @@ func (o *visualServer) MeshGetSurfaceCount(mesh gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(mesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_get_surface_count")
+	methodBind := methodBindVisualServerMeshGetSurfaceCount.Get()
 
 	// Call the parent method.
 	// int
@@ -5481,8 +7060,8 @@ func (o *visualServer) MeshGetSurfaceCount(mesh gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Removes a mesh's surface.
-	Args: [{ false mesh RID} { false index int}], Returns: void
+	        Removes a mesh's surface.
+		Args: [{ false mesh RID} { false index int}], Returns: void
 */
 func (o *visualServer) MeshRemoveSurface(mesh gdnative.Rid, index gdnative.Int) {
 	o.ensureSingleton()
@@ -5494,7 +7073,7 @@ func (o *visualServer) MeshRemoveSurface(mesh gdnative.Rid, index gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_remove_surface")
+	methodBind := methodBindVisualServerMeshRemoveSurface.Get()
 
 	// Call the parent method.
 	// void
@@ -5504,8 +7083,8 @@ func (o *visualServer) MeshRemoveSurface(mesh gdnative.Rid, index gdnative.Int)
 }
 
 /*
-        Sets a mesh's blend shape count.
-	Args: [{ false mesh RID} { false amount int}], Returns: void
+	        Sets a mesh's blend shape count.
+		Args: [{ false mesh RID} { false amount int}], Returns: void
 */
 func (o *visualServer) MeshSetBlendShapeCount(mesh gdnative.Rid, amount gdnative.Int) {
 	o.ensureSingleton()
@@ -5517,7 +7096,7 @@ func (o *visualServer) MeshSetBlendShapeCount(mesh gdnative.Rid, amount gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_set_blend_shape_count")
+	methodBind := methodBindVisualServerMeshSetBlendShapeCount.Get()
 
 	// Call the parent method.
 	// void
@@ -5527,8 +7106,8 @@ func (o *visualServer) MeshSetBlendShapeCount(mesh gdnative.Rid, amount gdnative
 }
 
 /*
-        Sets a mesh's blend shape mode.
-	Args: [{ false mesh RID} { false mode int}], Returns: void
+	        Sets a mesh's blend shape mode.
+		Args: [{ false mesh RID} { false mode int}], Returns: void
 */
 func (o *visualServer) MeshSetBlendShapeMode(mesh gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -5540,7 +7119,7 @@ func (o *visualServer) MeshSetBlendShapeMode(mesh gdnative.Rid, mode gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_set_blend_shape_mode")
+	methodBind := methodBindVisualServerMeshSetBlendShapeMode.Get()
 
 	// Call the parent method.
 	// void
@@ -5550,8 +7129,8 @@ func (o *visualServer) MeshSetBlendShapeMode(mesh gdnative.Rid, mode gdnative.In
 }
 
 /*
-        Sets a mesh's custom aabb.
-	Args: [{ false mesh RID} { false aabb AABB}], Returns: void
+	        Sets a mesh's custom aabb.
+		Args: [{ false mesh RID} { false aabb AABB}], Returns: void
 */
 func (o *visualServer) MeshSetCustomAabb(mesh gdnative.Rid, aabb gdnative.Aabb) {
 	o.ensureSingleton()
@@ -5563,7 +7142,7 @@ func (o *visualServer) MeshSetCustomAabb(mesh gdnative.Rid, aabb gdnative.Aabb)
 	ptrArguments[1] = gdnative.NewPointerFromAabb(aabb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_set_custom_aabb")
+	methodBind := methodBindVisualServerMeshSetCustomAabb.Get()
 
 	// Call the parent method.
 	// void
@@ -5573,8 +7152,8 @@ func (o *visualServer) MeshSetCustomAabb(mesh gdnative.Rid, aabb gdnative.Aabb)
 }
 
 /*
-        Returns a mesh's surface's aabb.
-	Args: [{ false mesh RID} { false surface int}], Returns: AABB
+	        Returns a mesh's surface's aabb.
+		Args: [{ false mesh RID} { false surface int}], Returns: AABB
 */
 func (o *visualServer) MeshSurfaceGetAabb(mesh gdnative.Rid, surface gdnative.Int) gdnative.Aabb {
 	o.ensureSingleton()
@@ -5586,7 +7165,7 @@ func (o *visualServer) MeshSurfaceGetAabb(mesh gdnative.Rid, surface gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_aabb")
+	methodBind := methodBindVisualServerMeshSurfaceGetAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -5599,8 +7178,8 @@ func (o *visualServer) MeshSurfaceGetAabb(mesh gdnative.Rid, surface gdnative.In
 }
 
 /*
-        Returns a mesh's surface's vertex buffer.
-	Args: [{ false mesh RID} { false surface int}], Returns: PoolByteArray
+	        Returns a mesh's surface's vertex buffer.
+		Args: [{ false mesh RID} { false surface int}], Returns: PoolByteArray
 */
 func (o *visualServer) MeshSurfaceGetArray(mesh gdnative.Rid, surface gdnative.Int) gdnative.PoolByteArray {
 	o.ensureSingleton()
@@ -5612,7 +7191,7 @@ func (o *visualServer) MeshSurfaceGetArray(mesh gdnative.Rid, surface gdnative.I
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_array")
+	methodBind := methodBindVisualServerMeshSurfaceGetArray.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -5625,8 +7204,8 @@ func (o *visualServer) MeshSurfaceGetArray(mesh gdnative.Rid, surface gdnative.I
 }
 
 /*
-        Returns a mesh's surface's amount of indices.
-	Args: [{ false mesh RID} { false surface int}], Returns: int
+	        Returns a mesh's surface's amount of indices.
+		Args: [{ false mesh RID} { false surface int}], Returns: int
 */
 func (o *visualServer) MeshSurfaceGetArrayIndexLen(mesh gdnative.Rid, surface gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -5638,7 +7217,7 @@ func (o *visualServer) MeshSurfaceGetArrayIndexLen(mesh gdnative.Rid, surface gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_array_index_len")
+	methodBind := methodBindVisualServerMeshSurfaceGetArrayIndexLen.Get()
 
 	// Call the parent method.
 	// int
@@ -5651,8 +7230,8 @@ func (o *visualServer) MeshSurfaceGetArrayIndexLen(mesh gdnative.Rid, surface gd
 }
 
 /*
-        Returns a mesh's surface's amount of vertices.
-	Args: [{ false mesh RID} { false surface int}], Returns: int
+	        Returns a mesh's surface's amount of vertices.
+		Args: [{ false mesh RID} { false surface int}], Returns: int
 */
 func (o *visualServer) MeshSurfaceGetArrayLen(mesh gdnative.Rid, surface gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -5664,7 +7243,7 @@ func (o *visualServer) MeshSurfaceGetArrayLen(mesh gdnative.Rid, surface gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_array_len")
+	methodBind := methodBindVisualServerMeshSurfaceGetArrayLen.Get()
 
 	// Call the parent method.
 	// int
@@ -5677,8 +7256,8 @@ func (o *visualServer) MeshSurfaceGetArrayLen(mesh gdnative.Rid, surface gdnativ
 }
 
 /*
-        Returns a mesh's surface's buffer arrays.
-	Args: [{ false mesh RID} { false surface int}], Returns: Array
+	        Returns a mesh's surface's buffer arrays.
+		Args: [{ false mesh RID} { false surface int}], Returns: Array
 */
 func (o *visualServer) MeshSurfaceGetArrays(mesh gdnative.Rid, surface gdnative.Int) gdnative.Array {
 	o.ensureSingleton()
@@ -5690,7 +7269,7 @@ func (o *visualServer) MeshSurfaceGetArrays(mesh gdnative.Rid, surface gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_arrays")
+	methodBind := methodBindVisualServerMeshSurfaceGetArrays.Get()
 
 	// Call the parent method.
 	// Array
@@ -5703,8 +7282,8 @@ func (o *visualServer) MeshSurfaceGetArrays(mesh gdnative.Rid, surface gdnative.
 }
 
 /*
-        Returns a mesh's surface's arrays for blend shapes
-	Args: [{ false mesh RID} { false surface int}], Returns: Array
+	        Returns a mesh's surface's arrays for blend shapes
+		Args: [{ false mesh RID} { false surface int}], Returns: Array
 */
 func (o *visualServer) MeshSurfaceGetBlendShapeArrays(mesh gdnative.Rid, surface gdnative.Int) gdnative.Array {
 	o.ensureSingleton()
@@ -5716,7 +7295,7 @@ func (o *visualServer) MeshSurfaceGetBlendShapeArrays(mesh gdnative.Rid, surface
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_blend_shape_arrays")
+	methodBind := methodBindVisualServerMeshSurfaceGetBlendShapeArrays.Get()
 
 	// Call the parent method.
 	// Array
@@ -5729,8 +7308,8 @@ func (o *visualServer) MeshSurfaceGetBlendShapeArrays(mesh gdnative.Rid, surface
 }
 
 /*
-        Returns the format of a mesh's surface.
-	Args: [{ false mesh RID} { false surface int}], Returns: int
+	        Returns the format of a mesh's surface.
+		Args: [{ false mesh RID} { false surface int}], Returns: int
 */
 func (o *visualServer) MeshSurfaceGetFormat(mesh gdnative.Rid, surface gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -5742,7 +7321,7 @@ func (o *visualServer) MeshSurfaceGetFormat(mesh gdnative.Rid, surface gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_format")
+	methodBind := methodBindVisualServerMeshSurfaceGetFormat.Get()
 
 	// Call the parent method.
 	// int
@@ -5755,8 +7334,8 @@ func (o *visualServer) MeshSurfaceGetFormat(mesh gdnative.Rid, surface gdnative.
 }
 
 /*
-        Returns a mesh's surface's index buffer.
-	Args: [{ false mesh RID} { false surface int}], Returns: PoolByteArray
+	        Returns a mesh's surface's index buffer.
+		Args: [{ false mesh RID} { false surface int}], Returns: PoolByteArray
 */
 func (o *visualServer) MeshSurfaceGetIndexArray(mesh gdnative.Rid, surface gdnative.Int) gdnative.PoolByteArray {
 	o.ensureSingleton()
@@ -5768,7 +7347,7 @@ func (o *visualServer) MeshSurfaceGetIndexArray(mesh gdnative.Rid, surface gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_index_array")
+	methodBind := methodBindVisualServerMeshSurfaceGetIndexArray.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -5781,8 +7360,8 @@ func (o *visualServer) MeshSurfaceGetIndexArray(mesh gdnative.Rid, surface gdnat
 }
 
 /*
-        Returns a mesh's surface's material.
-	Args: [{ false mesh RID} { false surface int}], Returns: RID
+	        Returns a mesh's surface's material.
+		Args: [{ false mesh RID} { false surface int}], Returns: RID
 */
 func (o *visualServer) MeshSurfaceGetMaterial(mesh gdnative.Rid, surface gdnative.Int) gdnative.Rid {
 	o.ensureSingleton()
@@ -5794,7 +7373,7 @@ func (o *visualServer) MeshSurfaceGetMaterial(mesh gdnative.Rid, surface gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_material")
+	methodBind := methodBindVisualServerMeshSurfaceGetMaterial.Get()
 
 	// Call the parent method.
 	// RID
@@ -5807,8 +7386,8 @@ func (o *visualServer) MeshSurfaceGetMaterial(mesh gdnative.Rid, surface gdnativ
 }
 
 /*
-        Returns the primitive type of a mesh's surface.
-	Args: [{ false mesh RID} { false surface int}], Returns: enum.VisualServer::PrimitiveType
+	        Returns the primitive type of a mesh's surface.
+		Args: [{ false mesh RID} { false surface int}], Returns: enum.VisualServer::PrimitiveType
 */
 func (o *visualServer) MeshSurfaceGetPrimitiveType(mesh gdnative.Rid, surface gdnative.Int) VisualServerPrimitiveType {
 	o.ensureSingleton()
@@ -5820,7 +7399,7 @@ func (o *visualServer) MeshSurfaceGetPrimitiveType(mesh gdnative.Rid, surface gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_primitive_type")
+	methodBind := methodBindVisualServerMeshSurfaceGetPrimitiveType.Get()
 
 	// Call the parent method.
 	// enum.VisualServer::PrimitiveType
@@ -5833,8 +7412,8 @@ func (o *visualServer) MeshSurfaceGetPrimitiveType(mesh gdnative.Rid, surface gd
 }
 
 /*
-        Returns the aabb of a mesh's surface's skeleton.
-	Args: [{ false mesh RID} { false surface int}], Returns: Array
+	        Returns the aabb of a mesh's surface's skeleton.
+		Args: [{ false mesh RID} { false surface int}], Returns: Array
 */
 func (o *visualServer) MeshSurfaceGetSkeletonAabb(mesh gdnative.Rid, surface gdnative.Int) gdnative.Array {
 	o.ensureSingleton()
@@ -5846,7 +7425,7 @@ func (o *visualServer) MeshSurfaceGetSkeletonAabb(mesh gdnative.Rid, surface gdn
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_get_skeleton_aabb")
+	methodBind := methodBindVisualServerMeshSurfaceGetSkeletonAabb.Get()
 
 	// Call the parent method.
 	// Array
@@ -5859,8 +7438,8 @@ func (o *visualServer) MeshSurfaceGetSkeletonAabb(mesh gdnative.Rid, surface gdn
 }
 
 /*
-        Sets a mesh's surface's material.
-	Args: [{ false mesh RID} { false surface int} { false material RID}], Returns: void
+	        Sets a mesh's surface's material.
+		Args: [{ false mesh RID} { false surface int} { false material RID}], Returns: void
 */
 func (o *visualServer) MeshSurfaceSetMaterial(mesh gdnative.Rid, surface gdnative.Int, material gdnative.Rid) {
 	o.ensureSingleton()
@@ -5873,7 +7452,7 @@ func (o *visualServer) MeshSurfaceSetMaterial(mesh gdnative.Rid, surface gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromRid(material)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "mesh_surface_set_material")
+	methodBind := methodBindVisualServerMeshSurfaceSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -5883,10 +7462,9 @@ func (o *visualServer) MeshSurfaceSetMaterial(mesh gdnative.Rid, surface gdnativ
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false instances int} { false transform_format int} { false color_format int}], Returns: void
+Args: [{ false multimesh RID} { false instances int} { false transform_format enum.VisualServer::MultimeshTransformFormat} { false color_format enum.VisualServer::MultimeshColorFormat}], Returns: void
 */
-func (o *visualServer) MultimeshAllocate(multimesh gdnative.Rid, instances gdnative.Int, transformFormat gdnative.Int, colorFormat gdnative.Int) {
+func (o *visualServer) MultimeshAllocate(multimesh gdnative.Rid, instances gdnative.Int, transformFormat VisualServerMultimeshTransformFormat, colorFormat VisualServerMultimeshColorFormat) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.MultimeshAllocate()")
 
@@ -5894,11 +7472,11 @@ func (o *visualServer) MultimeshAllocate(multimesh gdnative.Rid, instances gdnat
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
 	ptrArguments[0] = gdnative.NewPointerFromRid(multimesh)
 	ptrArguments[1] = gdnative.NewPointerFromInt(instances)
-	ptrArguments[2] = gdnative.NewPointerFromInt(transformFormat)
-	ptrArguments[3] = gdnative.NewPointerFromInt(colorFormat)
+	ptrArguments[2] = gdnative.NewPointerFromInt(gdnative.Int(transformFormat))
+	ptrArguments[3] = gdnative.NewPointerFromInt(gdnative.Int(colorFormat))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_allocate")
+	methodBind := methodBindVisualServerMultimeshAllocate.Get()
 
 	// Call the parent method.
 	// void
@@ -5908,8 +7486,7 @@ func (o *visualServer) MultimeshAllocate(multimesh gdnative.Rid, instances gdnat
 }
 
 /*
-
-	Args: [{ false multimesh RID}], Returns: AABB
+Args: [{ false multimesh RID}], Returns: AABB
 */
 func (o *visualServer) MultimeshGetAabb(multimesh gdnative.Rid) gdnative.Aabb {
 	o.ensureSingleton()
@@ -5920,7 +7497,7 @@ func (o *visualServer) MultimeshGetAabb(multimesh gdnative.Rid) gdnative.Aabb {
 	ptrArguments[0] = gdnative.NewPointerFromRid(multimesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_get_aabb")
+	methodBind := methodBindVisualServerMultimeshGetAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -5933,8 +7510,7 @@ func (o *visualServer) MultimeshGetAabb(multimesh gdnative.Rid) gdnative.Aabb {
 }
 
 /*
-
-	Args: [{ false multimesh RID}], Returns: int
+Args: [{ false multimesh RID}], Returns: int
 */
 func (o *visualServer) MultimeshGetInstanceCount(multimesh gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -5945,7 +7521,7 @@ func (o *visualServer) MultimeshGetInstanceCount(multimesh gdnative.Rid) gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromRid(multimesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_get_instance_count")
+	methodBind := methodBindVisualServerMultimeshGetInstanceCount.Get()
 
 	// Call the parent method.
 	// int
@@ -5958,8 +7534,7 @@ func (o *visualServer) MultimeshGetInstanceCount(multimesh gdnative.Rid) gdnativ
 }
 
 /*
-
-	Args: [{ false multimesh RID}], Returns: RID
+Args: [{ false multimesh RID}], Returns: RID
 */
 func (o *visualServer) MultimeshGetMesh(multimesh gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -5970,7 +7545,7 @@ func (o *visualServer) MultimeshGetMesh(multimesh gdnative.Rid) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromRid(multimesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_get_mesh")
+	methodBind := methodBindVisualServerMultimeshGetMesh.Get()
 
 	// Call the parent method.
 	// RID
@@ -5983,8 +7558,7 @@ func (o *visualServer) MultimeshGetMesh(multimesh gdnative.Rid) gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false multimesh RID}], Returns: int
+Args: [{ false multimesh RID}], Returns: int
 */
 func (o *visualServer) MultimeshGetVisibleInstances(multimesh gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -5995,7 +7569,7 @@ func (o *visualServer) MultimeshGetVisibleInstances(multimesh gdnative.Rid) gdna
 	ptrArguments[0] = gdnative.NewPointerFromRid(multimesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_get_visible_instances")
+	methodBind := methodBindVisualServerMultimeshGetVisibleInstances.Get()
 
 	// Call the parent method.
 	// int
@@ -6008,8 +7582,7 @@ func (o *visualServer) MultimeshGetVisibleInstances(multimesh gdnative.Rid) gdna
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false index int}], Returns: Color
+Args: [{ false multimesh RID} { false index int}], Returns: Color
 */
 func (o *visualServer) MultimeshInstanceGetColor(multimesh gdnative.Rid, index gdnative.Int) gdnative.Color {
 	o.ensureSingleton()
@@ -6021,7 +7594,7 @@ func (o *visualServer) MultimeshInstanceGetColor(multimesh gdnative.Rid, index g
 	ptrArguments[1] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_instance_get_color")
+	methodBind := methodBindVisualServerMultimeshInstanceGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -6034,8 +7607,7 @@ func (o *visualServer) MultimeshInstanceGetColor(multimesh gdnative.Rid, index g
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false index int}], Returns: Transform
+Args: [{ false multimesh RID} { false index int}], Returns: Transform
 */
 func (o *visualServer) MultimeshInstanceGetTransform(multimesh gdnative.Rid, index gdnative.Int) gdnative.Transform {
 	o.ensureSingleton()
@@ -6047,7 +7619,7 @@ func (o *visualServer) MultimeshInstanceGetTransform(multimesh gdnative.Rid, ind
 	ptrArguments[1] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_instance_get_transform")
+	methodBind := methodBindVisualServerMultimeshInstanceGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -6060,8 +7632,7 @@ func (o *visualServer) MultimeshInstanceGetTransform(multimesh gdnative.Rid, ind
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false index int}], Returns: Transform2D
+Args: [{ false multimesh RID} { false index int}], Returns: Transform2D
 */
 func (o *visualServer) MultimeshInstanceGetTransform2D(multimesh gdnative.Rid, index gdnative.Int) gdnative.Transform2D {
 	o.ensureSingleton()
@@ -6073,7 +7644,7 @@ func (o *visualServer) MultimeshInstanceGetTransform2D(multimesh gdnative.Rid, i
 	ptrArguments[1] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_instance_get_transform_2d")
+	methodBind := methodBindVisualServerMultimeshInstanceGetTransform2D.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -6086,8 +7657,7 @@ func (o *visualServer) MultimeshInstanceGetTransform2D(multimesh gdnative.Rid, i
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false index int} { false color Color}], Returns: void
+Args: [{ false multimesh RID} { false index int} { false color Color}], Returns: void
 */
 func (o *visualServer) MultimeshInstanceSetColor(multimesh gdnative.Rid, index gdnative.Int, color gdnative.Color) {
 	o.ensureSingleton()
@@ -6100,7 +7670,7 @@ func (o *visualServer) MultimeshInstanceSetColor(multimesh gdnative.Rid, index g
 	ptrArguments[2] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_instance_set_color")
+	methodBind := methodBindVisualServerMultimeshInstanceSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -6110,8 +7680,7 @@ func (o *visualServer) MultimeshInstanceSetColor(multimesh gdnative.Rid, index g
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false index int} { false transform Transform}], Returns: void
+Args: [{ false multimesh RID} { false index int} { false transform Transform}], Returns: void
 */
 func (o *visualServer) MultimeshInstanceSetTransform(multimesh gdnative.Rid, index gdnative.Int, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -6124,7 +7693,7 @@ func (o *visualServer) MultimeshInstanceSetTransform(multimesh gdnative.Rid, ind
 	ptrArguments[2] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_instance_set_transform")
+	methodBind := methodBindVisualServerMultimeshInstanceSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -6134,8 +7703,7 @@ func (o *visualServer) MultimeshInstanceSetTransform(multimesh gdnative.Rid, ind
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false index int} { false transform Transform2D}], Returns: void
+Args: [{ false multimesh RID} { false index int} { false transform Transform2D}], Returns: void
 */
 func (o *visualServer) MultimeshInstanceSetTransform2D(multimesh gdnative.Rid, index gdnative.Int, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -6148,7 +7716,7 @@ func (o *visualServer) MultimeshInstanceSetTransform2D(multimesh gdnative.Rid, i
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_instance_set_transform_2d")
+	methodBind := methodBindVisualServerMultimeshInstanceSetTransform2D.Get()
 
 	// Call the parent method.
 	// void
@@ -6158,8 +7726,7 @@ func (o *visualServer) MultimeshInstanceSetTransform2D(multimesh gdnative.Rid, i
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false mesh RID}], Returns: void
+Args: [{ false multimesh RID} { false mesh RID}], Returns: void
 */
 func (o *visualServer) MultimeshSetMesh(multimesh gdnative.Rid, mesh gdnative.Rid) {
 	o.ensureSingleton()
@@ -6171,7 +7738,7 @@ func (o *visualServer) MultimeshSetMesh(multimesh gdnative.Rid, mesh gdnative.Ri
 	ptrArguments[1] = gdnative.NewPointerFromRid(mesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_set_mesh")
+	methodBind := methodBindVisualServerMultimeshSetMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -6181,8 +7748,7 @@ func (o *visualServer) MultimeshSetMesh(multimesh gdnative.Rid, mesh gdnative.Ri
 }
 
 /*
-
-	Args: [{ false multimesh RID} { false visible int}], Returns: void
+Args: [{ false multimesh RID} { false visible int}], Returns: void
 */
 func (o *visualServer) MultimeshSetVisibleInstances(multimesh gdnative.Rid, visible gdnative.Int) {
 	o.ensureSingleton()
@@ -6194,7 +7760,7 @@ func (o *visualServer) MultimeshSetVisibleInstances(multimesh gdnative.Rid, visi
 	ptrArguments[1] = gdnative.NewPointerFromInt(visible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "multimesh_set_visible_instances")
+	methodBind := methodBindVisualServerMultimeshSetVisibleInstances.Get()
 
 	// Call the parent method.
 	// void
@@ -6204,8 +7770,7 @@ func (o *visualServer) MultimeshSetVisibleInstances(multimesh gdnative.Rid, visi
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) OmniLightCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -6215,7 +7780,7 @@ func (o *visualServer) OmniLightCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "omni_light_create")
+	methodBind := methodBindVisualServerOmniLightCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -6228,8 +7793,7 @@ func (o *visualServer) OmniLightCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) ParticlesCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -6239,7 +7803,7 @@ func (o *visualServer) ParticlesCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_create")
+	methodBind := methodBindVisualServerParticlesCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -6252,8 +7816,7 @@ func (o *visualServer) ParticlesCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false particles RID}], Returns: AABB
+Args: [{ false particles RID}], Returns: AABB
 */
 func (o *visualServer) ParticlesGetCurrentAabb(particles gdnative.Rid) gdnative.Aabb {
 	o.ensureSingleton()
@@ -6264,7 +7827,7 @@ func (o *visualServer) ParticlesGetCurrentAabb(particles gdnative.Rid) gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromRid(particles)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_get_current_aabb")
+	methodBind := methodBindVisualServerParticlesGetCurrentAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -6277,8 +7840,7 @@ func (o *visualServer) ParticlesGetCurrentAabb(particles gdnative.Rid) gdnative.
 }
 
 /*
-
-	Args: [{ false particles RID}], Returns: bool
+Args: [{ false particles RID}], Returns: bool
 */
 func (o *visualServer) ParticlesGetEmitting(particles gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -6289,7 +7851,7 @@ func (o *visualServer) ParticlesGetEmitting(particles gdnative.Rid) gdnative.Boo
 	ptrArguments[0] = gdnative.NewPointerFromRid(particles)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_get_emitting")
+	methodBind := methodBindVisualServerParticlesGetEmitting.Get()
 
 	// Call the parent method.
 	// bool
@@ -6302,8 +7864,7 @@ func (o *visualServer) ParticlesGetEmitting(particles gdnative.Rid) gdnative.Boo
 }
 
 /*
-
-	Args: [{ false particles RID}], Returns: void
+Args: [{ false particles RID}], Returns: void
 */
 func (o *visualServer) ParticlesRestart(particles gdnative.Rid) {
 	o.ensureSingleton()
@@ -6314,7 +7875,7 @@ func (o *visualServer) ParticlesRestart(particles gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(particles)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_restart")
+	methodBind := methodBindVisualServerParticlesRestart.Get()
 
 	// Call the parent method.
 	// void
@@ -6324,8 +7885,7 @@ func (o *visualServer) ParticlesRestart(particles gdnative.Rid) {
 }
 
 /*
-
-	Args: [{ false particles RID} { false amount int}], Returns: void
+Args: [{ false particles RID} { false amount int}], Returns: void
 */
 func (o *visualServer) ParticlesSetAmount(particles gdnative.Rid, amount gdnative.Int) {
 	o.ensureSingleton()
@@ -6337,7 +7897,7 @@ func (o *visualServer) ParticlesSetAmount(particles gdnative.Rid, amount gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_amount")
+	methodBind := methodBindVisualServerParticlesSetAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -6347,8 +7907,7 @@ func (o *visualServer) ParticlesSetAmount(particles gdnative.Rid, amount gdnativ
 }
 
 /*
-
-	Args: [{ false particles RID} { false aabb AABB}], Returns: void
+Args: [{ false particles RID} { false aabb AABB}], Returns: void
 */
 func (o *visualServer) ParticlesSetCustomAabb(particles gdnative.Rid, aabb gdnative.Aabb) {
 	o.ensureSingleton()
@@ -6360,7 +7919,7 @@ func (o *visualServer) ParticlesSetCustomAabb(particles gdnative.Rid, aabb gdnat
 	ptrArguments[1] = gdnative.NewPointerFromAabb(aabb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_custom_aabb")
+	methodBind := methodBindVisualServerParticlesSetCustomAabb.Get()
 
 	// Call the parent method.
 	// void
@@ -6370,8 +7929,7 @@ func (o *visualServer) ParticlesSetCustomAabb(particles gdnative.Rid, aabb gdnat
 }
 
 /*
-
-	Args: [{ false particles RID} { false order int}], Returns: void
+Args: [{ false particles RID} { false order int}], Returns: void
 */
 func (o *visualServer) ParticlesSetDrawOrder(particles gdnative.Rid, order gdnative.Int) {
 	o.ensureSingleton()
@@ -6383,7 +7941,7 @@ func (o *visualServer) ParticlesSetDrawOrder(particles gdnative.Rid, order gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(order)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_draw_order")
+	methodBind := methodBindVisualServerParticlesSetDrawOrder.Get()
 
 	// Call the parent method.
 	// void
@@ -6393,8 +7951,7 @@ func (o *visualServer) ParticlesSetDrawOrder(particles gdnative.Rid, order gdnat
 }
 
 /*
-
-	Args: [{ false particles RID} { false pass int} { false mesh RID}], Returns: void
+Args: [{ false particles RID} { false pass int} { false mesh RID}], Returns: void
 */
 func (o *visualServer) ParticlesSetDrawPassMesh(particles gdnative.Rid, pass gdnative.Int, mesh gdnative.Rid) {
 	o.ensureSingleton()
@@ -6407,7 +7964,7 @@ func (o *visualServer) ParticlesSetDrawPassMesh(particles gdnative.Rid, pass gdn
 	ptrArguments[2] = gdnative.NewPointerFromRid(mesh)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_draw_pass_mesh")
+	methodBind := methodBindVisualServerParticlesSetDrawPassMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -6417,8 +7974,7 @@ func (o *visualServer) ParticlesSetDrawPassMesh(particles gdnative.Rid, pass gdn
 }
 
 /*
-
-	Args: [{ false particles RID} { false count int}], Returns: void
+Args: [{ false particles RID} { false count int}], Returns: void
 */
 func (o *visualServer) ParticlesSetDrawPasses(particles gdnative.Rid, count gdnative.Int) {
 	o.ensureSingleton()
@@ -6430,7 +7986,7 @@ func (o *visualServer) ParticlesSetDrawPasses(particles gdnative.Rid, count gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(count)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_draw_passes")
+	methodBind := methodBindVisualServerParticlesSetDrawPasses.Get()
 
 	// Call the parent method.
 	// void
@@ -6440,8 +7996,7 @@ func (o *visualServer) ParticlesSetDrawPasses(particles gdnative.Rid, count gdna
 }
 
 /*
-
-	Args: [{ false particles RID} { false transform Transform}], Returns: void
+Args: [{ false particles RID} { false transform Transform}], Returns: void
 */
 func (o *visualServer) ParticlesSetEmissionTransform(particles gdnative.Rid, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -6453,7 +8008,7 @@ func (o *visualServer) ParticlesSetEmissionTransform(particles gdnative.Rid, tra
 	ptrArguments[1] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_emission_transform")
+	methodBind := methodBindVisualServerParticlesSetEmissionTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -6463,8 +8018,7 @@ func (o *visualServer) ParticlesSetEmissionTransform(particles gdnative.Rid, tra
 }
 
 /*
-
-	Args: [{ false particles RID} { false emitting bool}], Returns: void
+Args: [{ false particles RID} { false emitting bool}], Returns: void
 */
 func (o *visualServer) ParticlesSetEmitting(particles gdnative.Rid, emitting gdnative.Bool) {
 	o.ensureSingleton()
@@ -6476,7 +8030,7 @@ func (o *visualServer) ParticlesSetEmitting(particles gdnative.Rid, emitting gdn
 	ptrArguments[1] = gdnative.NewPointerFromBool(emitting)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_emitting")
+	methodBind := methodBindVisualServerParticlesSetEmitting.Get()
 
 	// Call the parent method.
 	// void
@@ -6486,8 +8040,7 @@ func (o *visualServer) ParticlesSetEmitting(particles gdnative.Rid, emitting gdn
 }
 
 /*
-
-	Args: [{ false particles RID} { false ratio float}], Returns: void
+Args: [{ false particles RID} { false ratio float}], Returns: void
 */
 func (o *visualServer) ParticlesSetExplosivenessRatio(particles gdnative.Rid, ratio gdnative.Real) {
 	o.ensureSingleton()
@@ -6499,7 +8052,7 @@ func (o *visualServer) ParticlesSetExplosivenessRatio(particles gdnative.Rid, ra
 	ptrArguments[1] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_explosiveness_ratio")
+	methodBind := methodBindVisualServerParticlesSetExplosivenessRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -6509,8 +8062,7 @@ func (o *visualServer) ParticlesSetExplosivenessRatio(particles gdnative.Rid, ra
 }
 
 /*
-
-	Args: [{ false particles RID} { false fps int}], Returns: void
+Args: [{ false particles RID} { false fps int}], Returns: void
 */
 func (o *visualServer) ParticlesSetFixedFps(particles gdnative.Rid, fps gdnative.Int) {
 	o.ensureSingleton()
@@ -6522,7 +8074,7 @@ func (o *visualServer) ParticlesSetFixedFps(particles gdnative.Rid, fps gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(fps)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_fixed_fps")
+	methodBind := methodBindVisualServerParticlesSetFixedFps.Get()
 
 	// Call the parent method.
 	// void
@@ -6532,8 +8084,7 @@ func (o *visualServer) ParticlesSetFixedFps(particles gdnative.Rid, fps gdnative
 }
 
 /*
-
-	Args: [{ false particles RID} { false enable bool}], Returns: void
+Args: [{ false particles RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) ParticlesSetFractionalDelta(particles gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -6545,7 +8096,7 @@ func (o *visualServer) ParticlesSetFractionalDelta(particles gdnative.Rid, enabl
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_fractional_delta")
+	methodBind := methodBindVisualServerParticlesSetFractionalDelta.Get()
 
 	// Call the parent method.
 	// void
@@ -6555,8 +8106,7 @@ func (o *visualServer) ParticlesSetFractionalDelta(particles gdnative.Rid, enabl
 }
 
 /*
-
-	Args: [{ false particles RID} { false lifetime float}], Returns: void
+Args: [{ false particles RID} { false lifetime float}], Returns: void
 */
 func (o *visualServer) ParticlesSetLifetime(particles gdnative.Rid, lifetime gdnative.Real) {
 	o.ensureSingleton()
@@ -6568,7 +8118,7 @@ func (o *visualServer) ParticlesSetLifetime(particles gdnative.Rid, lifetime gdn
 	ptrArguments[1] = gdnative.NewPointerFromReal(lifetime)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_lifetime")
+	methodBind := methodBindVisualServerParticlesSetLifetime.Get()
 
 	// Call the parent method.
 	// void
@@ -6578,8 +8128,7 @@ func (o *visualServer) ParticlesSetLifetime(particles gdnative.Rid, lifetime gdn
 }
 
 /*
-
-	Args: [{ false particles RID} { false one_shot bool}], Returns: void
+Args: [{ false particles RID} { false one_shot bool}], Returns: void
 */
 func (o *visualServer) ParticlesSetOneShot(particles gdnative.Rid, oneShot gdnative.Bool) {
 	o.ensureSingleton()
@@ -6591,7 +8140,7 @@ func (o *visualServer) ParticlesSetOneShot(particles gdnative.Rid, oneShot gdnat
 	ptrArguments[1] = gdnative.NewPointerFromBool(oneShot)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_one_shot")
+	methodBind := methodBindVisualServerParticlesSetOneShot.Get()
 
 	// Call the parent method.
 	// void
@@ -6601,8 +8150,7 @@ func (o *visualServer) ParticlesSetOneShot(particles gdnative.Rid, oneShot gdnat
 }
 
 /*
-
-	Args: [{ false particles RID} { false time float}], Returns: void
+Args: [{ false particles RID} { false time float}], Returns: void
 */
 func (o *visualServer) ParticlesSetPreProcessTime(particles gdnative.Rid, time gdnative.Real) {
 	o.ensureSingleton()
@@ -6614,7 +8162,7 @@ func (o *visualServer) ParticlesSetPreProcessTime(particles gdnative.Rid, time g
 	ptrArguments[1] = gdnative.NewPointerFromReal(time)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_pre_process_time")
+	methodBind := methodBindVisualServerParticlesSetPreProcessTime.Get()
 
 	// Call the parent method.
 	// void
@@ -6624,8 +8172,7 @@ func (o *visualServer) ParticlesSetPreProcessTime(particles gdnative.Rid, time g
 }
 
 /*
-
-	Args: [{ false particles RID} { false material RID}], Returns: void
+Args: [{ false particles RID} { false material RID}], Returns: void
 */
 func (o *visualServer) ParticlesSetProcessMaterial(particles gdnative.Rid, material gdnative.Rid) {
 	o.ensureSingleton()
@@ -6637,7 +8184,7 @@ func (o *visualServer) ParticlesSetProcessMaterial(particles gdnative.Rid, mater
 	ptrArguments[1] = gdnative.NewPointerFromRid(material)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_process_material")
+	methodBind := methodBindVisualServerParticlesSetProcessMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -6647,8 +8194,7 @@ func (o *visualServer) ParticlesSetProcessMaterial(particles gdnative.Rid, mater
 }
 
 /*
-
-	Args: [{ false particles RID} { false ratio float}], Returns: void
+Args: [{ false particles RID} { false ratio float}], Returns: void
 */
 func (o *visualServer) ParticlesSetRandomnessRatio(particles gdnative.Rid, ratio gdnative.Real) {
 	o.ensureSingleton()
@@ -6660,7 +8206,7 @@ func (o *visualServer) ParticlesSetRandomnessRatio(particles gdnative.Rid, ratio
 	ptrArguments[1] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_randomness_ratio")
+	methodBind := methodBindVisualServerParticlesSetRandomnessRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -6670,8 +8216,7 @@ func (o *visualServer) ParticlesSetRandomnessRatio(particles gdnative.Rid, ratio
 }
 
 /*
-
-	Args: [{ false particles RID} { false scale float}], Returns: void
+Args: [{ false particles RID} { false scale float}], Returns: void
 */
 func (o *visualServer) ParticlesSetSpeedScale(particles gdnative.Rid, scale gdnative.Real) {
 	o.ensureSingleton()
@@ -6683,7 +8228,7 @@ func (o *visualServer) ParticlesSetSpeedScale(particles gdnative.Rid, scale gdna
 	ptrArguments[1] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_speed_scale")
+	methodBind := methodBindVisualServerParticlesSetSpeedScale.Get()
 
 	// Call the parent method.
 	// void
@@ -6693,8 +8238,7 @@ func (o *visualServer) ParticlesSetSpeedScale(particles gdnative.Rid, scale gdna
 }
 
 /*
-
-	Args: [{ false particles RID} { false enable bool}], Returns: void
+Args: [{ false particles RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) ParticlesSetUseLocalCoordinates(particles gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -6706,7 +8250,7 @@ func (o *visualServer) ParticlesSetUseLocalCoordinates(particles gdnative.Rid, e
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "particles_set_use_local_coordinates")
+	methodBind := methodBindVisualServerParticlesSetUseLocalCoordinates.Get()
 
 	// Call the parent method.
 	// void
@@ -6716,8 +8260,7 @@ func (o *visualServer) ParticlesSetUseLocalCoordinates(particles gdnative.Rid, e
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) ReflectionProbeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -6727,7 +8270,7 @@ func (o *visualServer) ReflectionProbeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_create")
+	methodBind := methodBindVisualServerReflectionProbeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -6740,8 +8283,7 @@ func (o *visualServer) ReflectionProbeCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false probe RID} { false enable bool}], Returns: void
+Args: [{ false probe RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetAsInterior(probe gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -6753,7 +8295,7 @@ func (o *visualServer) ReflectionProbeSetAsInterior(probe gdnative.Rid, enable g
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_as_interior")
+	methodBind := methodBindVisualServerReflectionProbeSetAsInterior.Get()
 
 	// Call the parent method.
 	// void
@@ -6763,8 +8305,7 @@ func (o *visualServer) ReflectionProbeSetAsInterior(probe gdnative.Rid, enable g
 }
 
 /*
-
-	Args: [{ false probe RID} { false layers int}], Returns: void
+Args: [{ false probe RID} { false layers int}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetCullMask(probe gdnative.Rid, layers gdnative.Int) {
 	o.ensureSingleton()
@@ -6776,7 +8317,7 @@ func (o *visualServer) ReflectionProbeSetCullMask(probe gdnative.Rid, layers gdn
 	ptrArguments[1] = gdnative.NewPointerFromInt(layers)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_cull_mask")
+	methodBind := methodBindVisualServerReflectionProbeSetCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -6786,8 +8327,7 @@ func (o *visualServer) ReflectionProbeSetCullMask(probe gdnative.Rid, layers gdn
 }
 
 /*
-
-	Args: [{ false probe RID} { false enable bool}], Returns: void
+Args: [{ false probe RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetEnableBoxProjection(probe gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -6799,7 +8339,7 @@ func (o *visualServer) ReflectionProbeSetEnableBoxProjection(probe gdnative.Rid,
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_enable_box_projection")
+	methodBind := methodBindVisualServerReflectionProbeSetEnableBoxProjection.Get()
 
 	// Call the parent method.
 	// void
@@ -6809,8 +8349,7 @@ func (o *visualServer) ReflectionProbeSetEnableBoxProjection(probe gdnative.Rid,
 }
 
 /*
-
-	Args: [{ false probe RID} { false enable bool}], Returns: void
+Args: [{ false probe RID} { false enable bool}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetEnableShadows(probe gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -6822,7 +8361,7 @@ func (o *visualServer) ReflectionProbeSetEnableShadows(probe gdnative.Rid, enabl
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_enable_shadows")
+	methodBind := methodBindVisualServerReflectionProbeSetEnableShadows.Get()
 
 	// Call the parent method.
 	// void
@@ -6832,8 +8371,7 @@ func (o *visualServer) ReflectionProbeSetEnableShadows(probe gdnative.Rid, enabl
 }
 
 /*
-
-	Args: [{ false probe RID} { false extents Vector3}], Returns: void
+Args: [{ false probe RID} { false extents Vector3}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetExtents(probe gdnative.Rid, extents gdnative.Vector3) {
 	o.ensureSingleton()
@@ -6845,7 +8383,7 @@ func (o *visualServer) ReflectionProbeSetExtents(probe gdnative.Rid, extents gdn
 	ptrArguments[1] = gdnative.NewPointerFromVector3(extents)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_extents")
+	methodBind := methodBindVisualServerReflectionProbeSetExtents.Get()
 
 	// Call the parent method.
 	// void
@@ -6855,8 +8393,7 @@ func (o *visualServer) ReflectionProbeSetExtents(probe gdnative.Rid, extents gdn
 }
 
 /*
-
-	Args: [{ false probe RID} { false intensity float}], Returns: void
+Args: [{ false probe RID} { false intensity float}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetIntensity(probe gdnative.Rid, intensity gdnative.Real) {
 	o.ensureSingleton()
@@ -6868,7 +8405,7 @@ func (o *visualServer) ReflectionProbeSetIntensity(probe gdnative.Rid, intensity
 	ptrArguments[1] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_intensity")
+	methodBind := methodBindVisualServerReflectionProbeSetIntensity.Get()
 
 	// Call the parent method.
 	// void
@@ -6878,8 +8415,7 @@ func (o *visualServer) ReflectionProbeSetIntensity(probe gdnative.Rid, intensity
 }
 
 /*
-
-	Args: [{ false probe RID} { false color Color}], Returns: void
+Args: [{ false probe RID} { false color Color}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetInteriorAmbient(probe gdnative.Rid, color gdnative.Color) {
 	o.ensureSingleton()
@@ -6891,7 +8427,7 @@ func (o *visualServer) ReflectionProbeSetInteriorAmbient(probe gdnative.Rid, col
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_interior_ambient")
+	methodBind := methodBindVisualServerReflectionProbeSetInteriorAmbient.Get()
 
 	// Call the parent method.
 	// void
@@ -6901,8 +8437,7 @@ func (o *visualServer) ReflectionProbeSetInteriorAmbient(probe gdnative.Rid, col
 }
 
 /*
-
-	Args: [{ false probe RID} { false energy float}], Returns: void
+Args: [{ false probe RID} { false energy float}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetInteriorAmbientEnergy(probe gdnative.Rid, energy gdnative.Real) {
 	o.ensureSingleton()
@@ -6914,7 +8449,7 @@ func (o *visualServer) ReflectionProbeSetInteriorAmbientEnergy(probe gdnative.Ri
 	ptrArguments[1] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_interior_ambient_energy")
+	methodBind := methodBindVisualServerReflectionProbeSetInteriorAmbientEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -6924,8 +8459,7 @@ func (o *visualServer) ReflectionProbeSetInteriorAmbientEnergy(probe gdnative.Ri
 }
 
 /*
-
-	Args: [{ false probe RID} { false contrib float}], Returns: void
+Args: [{ false probe RID} { false contrib float}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetInteriorAmbientProbeContribution(probe gdnative.Rid, contrib gdnative.Real) {
 	o.ensureSingleton()
@@ -6937,7 +8471,7 @@ func (o *visualServer) ReflectionProbeSetInteriorAmbientProbeContribution(probe
 	ptrArguments[1] = gdnative.NewPointerFromReal(contrib)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_interior_ambient_probe_contribution")
+	methodBind := methodBindVisualServerReflectionProbeSetInteriorAmbientProbeContribution.Get()
 
 	// Call the parent method.
 	// void
@@ -6947,8 +8481,7 @@ func (o *visualServer) ReflectionProbeSetInteriorAmbientProbeContribution(probe
 }
 
 /*
-
-	Args: [{ false probe RID} { false distance float}], Returns: void
+Args: [{ false probe RID} { false distance float}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetMaxDistance(probe gdnative.Rid, distance gdnative.Real) {
 	o.ensureSingleton()
@@ -6960,7 +8493,7 @@ func (o *visualServer) ReflectionProbeSetMaxDistance(probe gdnative.Rid, distanc
 	ptrArguments[1] = gdnative.NewPointerFromReal(distance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_max_distance")
+	methodBind := methodBindVisualServerReflectionProbeSetMaxDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -6970,8 +8503,7 @@ func (o *visualServer) ReflectionProbeSetMaxDistance(probe gdnative.Rid, distanc
 }
 
 /*
-
-	Args: [{ false probe RID} { false offset Vector3}], Returns: void
+Args: [{ false probe RID} { false offset Vector3}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetOriginOffset(probe gdnative.Rid, offset gdnative.Vector3) {
 	o.ensureSingleton()
@@ -6983,7 +8515,7 @@ func (o *visualServer) ReflectionProbeSetOriginOffset(probe gdnative.Rid, offset
 	ptrArguments[1] = gdnative.NewPointerFromVector3(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_origin_offset")
+	methodBind := methodBindVisualServerReflectionProbeSetOriginOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -6993,8 +8525,7 @@ func (o *visualServer) ReflectionProbeSetOriginOffset(probe gdnative.Rid, offset
 }
 
 /*
-
-	Args: [{ false probe RID} { false mode int}], Returns: void
+Args: [{ false probe RID} { false mode int}], Returns: void
 */
 func (o *visualServer) ReflectionProbeSetUpdateMode(probe gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -7006,7 +8537,7 @@ func (o *visualServer) ReflectionProbeSetUpdateMode(probe gdnative.Rid, mode gdn
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "reflection_probe_set_update_mode")
+	methodBind := methodBindVisualServerReflectionProbeSetUpdateMode.Get()
 
 	// Call the parent method.
 	// void
@@ -7016,21 +8547,21 @@ func (o *visualServer) ReflectionProbeSetUpdateMode(probe gdnative.Rid, mode gdn
 }
 
 /*
-        Schedules a callback to the corresponding named 'method' on 'where' after a frame has been drawn. The callback method must use only 1 argument which will be called with 'userdata'.
-	Args: [{ false where Object} { false method String} { false userdata Variant}], Returns: void
+	        Schedules a callback to the corresponding named 'method' on 'where' after a frame has been drawn. The callback method must use only 1 argument which will be called with 'userdata'.
+		Args: [{ false where Object} { false method String} { false userdata Variant}], Returns: void
 */
-func (o *visualServer) RequestFrameDrawnCallback(where ObjectImplementer, method gdnative.String, userdata gdnative.Variant) {
+func (o *visualServer) RequestFrameDrawnCallback(where godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.RequestFrameDrawnCallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(where.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(where))
 	ptrArguments[1] = gdnative.NewPointerFromString(method)
 	ptrArguments[2] = gdnative.NewPointerFromVariant(userdata)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "request_frame_drawn_callback")
+	methodBind := methodBindVisualServerRequestFrameDrawnCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -7040,8 +8571,7 @@ func (o *visualServer) RequestFrameDrawnCallback(where ObjectImplementer, method
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) ScenarioCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -7051,7 +8581,7 @@ func (o *visualServer) ScenarioCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "scenario_create")
+	methodBind := methodBindVisualServerScenarioCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -7064,20 +8594,19 @@ func (o *visualServer) ScenarioCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false scenario RID} { false debug_mode int}], Returns: void
+Args: [{ false scenario RID} { false debug_mode enum.VisualServer::ScenarioDebugMode}], Returns: void
 */
-func (o *visualServer) ScenarioSetDebug(scenario gdnative.Rid, debugMode gdnative.Int) {
+func (o *visualServer) ScenarioSetDebug(scenario gdnative.Rid, debugMode VisualServerScenarioDebugMode) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.ScenarioSetDebug()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromRid(scenario)
-	ptrArguments[1] = gdnative.NewPointerFromInt(debugMode)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(debugMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "scenario_set_debug")
+	methodBind := methodBindVisualServerScenarioSetDebug.Get()
 
 	// Call the parent method.
 	// void
@@ -7087,8 +8616,7 @@ func (o *visualServer) ScenarioSetDebug(scenario gdnative.Rid, debugMode gdnativ
 }
 
 /*
-
-	Args: [{ false scenario RID} { false environment RID}], Returns: void
+Args: [{ false scenario RID} { false environment RID}], Returns: void
 */
 func (o *visualServer) ScenarioSetEnvironment(scenario gdnative.Rid, environment gdnative.Rid) {
 	o.ensureSingleton()
@@ -7100,7 +8628,7 @@ func (o *visualServer) ScenarioSetEnvironment(scenario gdnative.Rid, environment
 	ptrArguments[1] = gdnative.NewPointerFromRid(environment)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "scenario_set_environment")
+	methodBind := methodBindVisualServerScenarioSetEnvironment.Get()
 
 	// Call the parent method.
 	// void
@@ -7110,8 +8638,7 @@ func (o *visualServer) ScenarioSetEnvironment(scenario gdnative.Rid, environment
 }
 
 /*
-
-	Args: [{ false scenario RID} { false environment RID}], Returns: void
+Args: [{ false scenario RID} { false environment RID}], Returns: void
 */
 func (o *visualServer) ScenarioSetFallbackEnvironment(scenario gdnative.Rid, environment gdnative.Rid) {
 	o.ensureSingleton()
@@ -7123,7 +8650,7 @@ func (o *visualServer) ScenarioSetFallbackEnvironment(scenario gdnative.Rid, env
 	ptrArguments[1] = gdnative.NewPointerFromRid(environment)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "scenario_set_fallback_environment")
+	methodBind := methodBindVisualServerScenarioSetFallbackEnvironment.Get()
 
 	// Call the parent method.
 	// void
@@ -7133,8 +8660,7 @@ func (o *visualServer) ScenarioSetFallbackEnvironment(scenario gdnative.Rid, env
 }
 
 /*
-
-	Args: [{ false scenario RID} { false p_size int} { false subdiv int}], Returns: void
+Args: [{ false scenario RID} { false p_size int} { false subdiv int}], Returns: void
 */
 func (o *visualServer) ScenarioSetReflectionAtlasSize(scenario gdnative.Rid, pSize gdnative.Int, subdiv gdnative.Int) {
 	o.ensureSingleton()
@@ -7147,7 +8673,7 @@ func (o *visualServer) ScenarioSetReflectionAtlasSize(scenario gdnative.Rid, pSi
 	ptrArguments[2] = gdnative.NewPointerFromInt(subdiv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "scenario_set_reflection_atlas_size")
+	methodBind := methodBindVisualServerScenarioSetReflectionAtlasSize.Get()
 
 	// Call the parent method.
 	// void
@@ -7157,21 +8683,21 @@ func (o *visualServer) ScenarioSetReflectionAtlasSize(scenario gdnative.Rid, pSi
 }
 
 /*
-        Sets a boot image. The color defines the background color and if scale is [code]true[/code], the image will be scaled to fit the screen size.
-	Args: [{ false image Image} { false color Color} { false scale bool}], Returns: void
+	        Sets a boot image. The color defines the background color and if scale is [code]true[/code], the image will be scaled to fit the screen size.
+		Args: [{ false image Image} { false color Color} { false scale bool}], Returns: void
 */
-func (o *visualServer) SetBootImage(image ImageImplementer, color gdnative.Color, scale gdnative.Bool) {
+func (o *visualServer) SetBootImage(image godot.ImageImplementer, color gdnative.Color, scale gdnative.Bool) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.SetBootImage()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(image.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 	ptrArguments[2] = gdnative.NewPointerFromBool(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "set_boot_image")
+	methodBind := methodBindVisualServerSetBootImage.Get()
 
 	// Call the parent method.
 	// void
@@ -7181,8 +8707,7 @@ func (o *visualServer) SetBootImage(image ImageImplementer, color gdnative.Color
 }
 
 /*
-
-	Args: [{ false generate bool}], Returns: void
+Args: [{ false generate bool}], Returns: void
 */
 func (o *visualServer) SetDebugGenerateWireframes(generate gdnative.Bool) {
 	o.ensureSingleton()
@@ -7193,7 +8718,7 @@ func (o *visualServer) SetDebugGenerateWireframes(generate gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(generate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "set_debug_generate_wireframes")
+	methodBind := methodBindVisualServerSetDebugGenerateWireframes.Get()
 
 	// Call the parent method.
 	// void
@@ -7203,8 +8728,7 @@ func (o *visualServer) SetDebugGenerateWireframes(generate gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false color Color}], Returns: void
+Args: [{ false color Color}], Returns: void
 */
 func (o *visualServer) SetDefaultClearColor(color gdnative.Color) {
 	o.ensureSingleton()
@@ -7215,7 +8739,7 @@ func (o *visualServer) SetDefaultClearColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "set_default_clear_color")
+	methodBind := methodBindVisualServerSetDefaultClearColor.Get()
 
 	// Call the parent method.
 	// void
@@ -7225,8 +8749,8 @@ func (o *visualServer) SetDefaultClearColor(color gdnative.Color) {
 }
 
 /*
-        Creates an empty shader.
-	Args: [], Returns: RID
+	        Creates an empty shader.
+		Args: [], Returns: RID
 */
 func (o *visualServer) ShaderCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -7236,7 +8760,7 @@ func (o *visualServer) ShaderCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "shader_create")
+	methodBind := methodBindVisualServerShaderCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -7249,8 +8773,8 @@ func (o *visualServer) ShaderCreate() gdnative.Rid {
 }
 
 /*
-        Returns a shader's code.
-	Args: [{ false shader RID}], Returns: String
+	        Returns a shader's code.
+		Args: [{ false shader RID}], Returns: String
 */
 func (o *visualServer) ShaderGetCode(shader gdnative.Rid) gdnative.String {
 	o.ensureSingleton()
@@ -7261,7 +8785,7 @@ func (o *visualServer) ShaderGetCode(shader gdnative.Rid) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromRid(shader)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "shader_get_code")
+	methodBind := methodBindVisualServerShaderGetCode.Get()
 
 	// Call the parent method.
 	// String
@@ -7274,8 +8798,8 @@ func (o *visualServer) ShaderGetCode(shader gdnative.Rid) gdnative.String {
 }
 
 /*
-        Returns a default texture from a shader searched by name.
-	Args: [{ false shader RID} { false name String}], Returns: RID
+	        Returns a default texture from a shader searched by name.
+		Args: [{ false shader RID} { false name String}], Returns: RID
 */
 func (o *visualServer) ShaderGetDefaultTextureParam(shader gdnative.Rid, name gdnative.String) gdnative.Rid {
 	o.ensureSingleton()
@@ -7287,7 +8811,7 @@ func (o *visualServer) ShaderGetDefaultTextureParam(shader gdnative.Rid, name gd
 	ptrArguments[1] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "shader_get_default_texture_param")
+	methodBind := methodBindVisualServerShaderGetDefaultTextureParam.Get()
 
 	// Call the parent method.
 	// RID
@@ -7300,8 +8824,8 @@ func (o *visualServer) ShaderGetDefaultTextureParam(shader gdnative.Rid, name gd
 }
 
 /*
-        Returns the parameters of a shader.
-	Args: [{ false shader RID}], Returns: Array
+	        Returns the parameters of a shader.
+		Args: [{ false shader RID}], Returns: Array
 */
 func (o *visualServer) ShaderGetParamList(shader gdnative.Rid) gdnative.Array {
 	o.ensureSingleton()
@@ -7312,7 +8836,7 @@ func (o *visualServer) ShaderGetParamList(shader gdnative.Rid) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromRid(shader)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "shader_get_param_list")
+	methodBind := methodBindVisualServerShaderGetParamList.Get()
 
 	// Call the parent method.
 	// Array
@@ -7325,8 +8849,8 @@ func (o *visualServer) ShaderGetParamList(shader gdnative.Rid) gdnative.Array {
 }
 
 /*
-        Sets a shader's code.
-	Args: [{ false shader RID} { false code String}], Returns: void
+	        Sets a shader's code.
+		Args: [{ false shader RID} { false code String}], Returns: void
 */
 func (o *visualServer) ShaderSetCode(shader gdnative.Rid, code gdnative.String) {
 	o.ensureSingleton()
@@ -7338,7 +8862,7 @@ func (o *visualServer) ShaderSetCode(shader gdnative.Rid, code gdnative.String)
 	ptrArguments[1] = gdnative.NewPointerFromString(code)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "shader_set_code")
+	methodBind := methodBindVisualServerShaderSetCode.Get()
 
 	// Call the parent method.
 	// void
@@ -7348,8 +8872,8 @@ func (o *visualServer) ShaderSetCode(shader gdnative.Rid, code gdnative.String)
 }
 
 /*
-        Sets a shader's default texture. Overwrites the texture given by name.
-	Args: [{ false shader RID} { false name String} { false texture RID}], Returns: void
+	        Sets a shader's default texture. Overwrites the texture given by name.
+		Args: [{ false shader RID} { false name String} { false texture RID}], Returns: void
 */
 func (o *visualServer) ShaderSetDefaultTextureParam(shader gdnative.Rid, name gdnative.String, texture gdnative.Rid) {
 	o.ensureSingleton()
@@ -7362,7 +8886,7 @@ func (o *visualServer) ShaderSetDefaultTextureParam(shader gdnative.Rid, name gd
 	ptrArguments[2] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "shader_set_default_texture_param")
+	methodBind := methodBindVisualServerShaderSetDefaultTextureParam.Get()
 
 	// Call the parent method.
 	// void
@@ -7372,8 +8896,7 @@ func (o *visualServer) ShaderSetDefaultTextureParam(shader gdnative.Rid, name gd
 }
 
 /*
-
-	Args: [{ false skeleton RID} { false bones int} {False true is_2d_skeleton bool}], Returns: void
+Args: [{ false skeleton RID} { false bones int} {False true is_2d_skeleton bool}], Returns: void
 */
 func (o *visualServer) SkeletonAllocate(skeleton gdnative.Rid, bones gdnative.Int, is2DSkeleton gdnative.Bool) {
 	o.ensureSingleton()
@@ -7386,7 +8909,7 @@ func (o *visualServer) SkeletonAllocate(skeleton gdnative.Rid, bones gdnative.In
 	ptrArguments[2] = gdnative.NewPointerFromBool(is2DSkeleton)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "skeleton_allocate")
+	methodBind := methodBindVisualServerSkeletonAllocate.Get()
 
 	// Call the parent method.
 	// void
@@ -7396,8 +8919,7 @@ func (o *visualServer) SkeletonAllocate(skeleton gdnative.Rid, bones gdnative.In
 }
 
 /*
-
-	Args: [{ false skeleton RID} { false bone int}], Returns: Transform
+Args: [{ false skeleton RID} { false bone int}], Returns: Transform
 */
 func (o *visualServer) SkeletonBoneGetTransform(skeleton gdnative.Rid, bone gdnative.Int) gdnative.Transform {
 	o.ensureSingleton()
@@ -7409,7 +8931,7 @@ func (o *visualServer) SkeletonBoneGetTransform(skeleton gdnative.Rid, bone gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(bone)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "skeleton_bone_get_transform")
+	methodBind := methodBindVisualServerSkeletonBoneGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -7422,8 +8944,7 @@ func (o *visualServer) SkeletonBoneGetTransform(skeleton gdnative.Rid, bone gdna
 }
 
 /*
-
-	Args: [{ false skeleton RID} { false bone int}], Returns: Transform2D
+Args: [{ false skeleton RID} { false bone int}], Returns: Transform2D
 */
 func (o *visualServer) SkeletonBoneGetTransform2D(skeleton gdnative.Rid, bone gdnative.Int) gdnative.Transform2D {
 	o.ensureSingleton()
@@ -7435,7 +8956,7 @@ func (o *visualServer) SkeletonBoneGetTransform2D(skeleton gdnative.Rid, bone gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(bone)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "skeleton_bone_get_transform_2d")
+	methodBind := methodBindVisualServerSkeletonBoneGetTransform2D.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -7448,8 +8969,7 @@ func (o *visualServer) SkeletonBoneGetTransform2D(skeleton gdnative.Rid, bone gd
 }
 
 /*
-
-	Args: [{ false skeleton RID} { false bone int} { false transform Transform}], Returns: void
+Args: [{ false skeleton RID} { false bone int} { false transform Transform}], Returns: void
 */
 func (o *visualServer) SkeletonBoneSetTransform(skeleton gdnative.Rid, bone gdnative.Int, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -7462,7 +8982,7 @@ func (o *visualServer) SkeletonBoneSetTransform(skeleton gdnative.Rid, bone gdna
 	ptrArguments[2] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "skeleton_bone_set_transform")
+	methodBind := methodBindVisualServerSkeletonBoneSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -7472,8 +8992,7 @@ func (o *visualServer) SkeletonBoneSetTransform(skeleton gdnative.Rid, bone gdna
 }
 
 /*
-
-	Args: [{ false skeleton RID} { false bone int} { false transform Transform2D}], Returns: void
+Args: [{ false skeleton RID} { false bone int} { false transform Transform2D}], Returns: void
 */
 func (o *visualServer) SkeletonBoneSetTransform2D(skeleton gdnative.Rid, bone gdnative.Int, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -7486,7 +9005,7 @@ func (o *visualServer) SkeletonBoneSetTransform2D(skeleton gdnative.Rid, bone gd
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "skeleton_bone_set_transform_2d")
+	methodBind := methodBindVisualServerSkeletonBoneSetTransform2D.Get()
 
 	// Call the parent method.
 	// void
@@ -7496,8 +9015,7 @@ func (o *visualServer) SkeletonBoneSetTransform2D(skeleton gdnative.Rid, bone gd
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) SkeletonCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -7507,7 +9025,7 @@ func (o *visualServer) SkeletonCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "skeleton_create")
+	methodBind := methodBindVisualServerSkeletonCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -7520,8 +9038,7 @@ func (o *visualServer) SkeletonCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [{ false skeleton RID}], Returns: int
+Args: [{ false skeleton RID}], Returns: int
 */
 func (o *visualServer) SkeletonGetBoneCount(skeleton gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -7532,7 +9049,7 @@ func (o *visualServer) SkeletonGetBoneCount(skeleton gdnative.Rid) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromRid(skeleton)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "skeleton_get_bone_count")
+	methodBind := methodBindVisualServerSkeletonGetBoneCount.Get()
 
 	// Call the parent method.
 	// int
@@ -7545,8 +9062,8 @@ func (o *visualServer) SkeletonGetBoneCount(skeleton gdnative.Rid) gdnative.Int
 }
 
 /*
-        Creates an empty sky.
-	Args: [], Returns: RID
+	        Creates an empty sky.
+		Args: [], Returns: RID
 */
 func (o *visualServer) SkyCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -7556,7 +9073,7 @@ func (o *visualServer) SkyCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "sky_create")
+	methodBind := methodBindVisualServerSkyCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -7569,8 +9086,8 @@ func (o *visualServer) SkyCreate() gdnative.Rid {
 }
 
 /*
-        Sets a sky's texture.
-	Args: [{ false sky RID} { false cube_map RID} { false radiance_size int}], Returns: void
+	        Sets a sky's texture.
+		Args: [{ false sky RID} { false cube_map RID} { false radiance_size int}], Returns: void
 */
 func (o *visualServer) SkySetTexture(sky gdnative.Rid, cubeMap gdnative.Rid, radianceSize gdnative.Int) {
 	o.ensureSingleton()
@@ -7583,7 +9100,7 @@ func (o *visualServer) SkySetTexture(sky gdnative.Rid, cubeMap gdnative.Rid, rad
 	ptrArguments[2] = gdnative.NewPointerFromInt(radianceSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "sky_set_texture")
+	methodBind := methodBindVisualServerSkySetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -7593,8 +9110,7 @@ func (o *visualServer) SkySetTexture(sky gdnative.Rid, cubeMap gdnative.Rid, rad
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *visualServer) SpotLightCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -7604,7 +9120,7 @@ func (o *visualServer) SpotLightCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "spot_light_create")
+	methodBind := methodBindVisualServerSpotLightCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -7617,8 +9133,7 @@ func (o *visualServer) SpotLightCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *visualServer) Sync() {
 	o.ensureSingleton()
@@ -7628,7 +9143,7 @@ func (o *visualServer) Sync() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "sync")
+	methodBind := methodBindVisualServerSync.Get()
 
 	// Call the parent method.
 	// void
@@ -7638,8 +9153,8 @@ func (o *visualServer) Sync() {
 }
 
 /*
-        Allocates space for a texture's image or video.
-	Args: [{ false texture RID} { false width int} { false height int} { false format int} {7 true flags int}], Returns: void
+	        Allocates space for a texture's image or video.
+		Args: [{ false texture RID} { false width int} { false height int} { false format int} {7 true flags int}], Returns: void
 */
 func (o *visualServer) TextureAllocate(texture gdnative.Rid, width gdnative.Int, height gdnative.Int, format gdnative.Int, flags gdnative.Int) {
 	o.ensureSingleton()
@@ -7654,7 +9169,7 @@ func (o *visualServer) TextureAllocate(texture gdnative.Rid, width gdnative.Int,
 	ptrArguments[4] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_allocate")
+	methodBind := methodBindVisualServerTextureAllocate.Get()
 
 	// Call the parent method.
 	// void
@@ -7664,8 +9179,8 @@ func (o *visualServer) TextureAllocate(texture gdnative.Rid, width gdnative.Int,
 }
 
 /*
-        Creates an empty texture.
-	Args: [], Returns: RID
+	        Creates an empty texture.
+		Args: [], Returns: RID
 */
 func (o *visualServer) TextureCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -7675,7 +9190,7 @@ func (o *visualServer) TextureCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_create")
+	methodBind := methodBindVisualServerTextureCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -7688,20 +9203,20 @@ func (o *visualServer) TextureCreate() gdnative.Rid {
 }
 
 /*
-        Creates a texture, allocates the space for an image, and fills in the image.
-	Args: [{ false image Image} {7 true flags int}], Returns: RID
+	        Creates a texture, allocates the space for an image, and fills in the image.
+		Args: [{ false image Image} {7 true flags int}], Returns: RID
 */
-func (o *visualServer) TextureCreateFromImage(image ImageImplementer, flags gdnative.Int) gdnative.Rid {
+func (o *visualServer) TextureCreateFromImage(image godot.ImageImplementer, flags gdnative.Int) gdnative.Rid {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.TextureCreateFromImage()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(image.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
 	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_create_from_image")
+	methodBind := methodBindVisualServerTextureCreateFromImage.Get()
 
 	// Call the parent method.
 	// RID
@@ -7714,8 +9229,8 @@ func (o *visualServer) TextureCreateFromImage(image ImageImplementer, flags gdna
 }
 
 /*
-        Returns a list of all the textures and their information.
-	Args: [], Returns: Array
+	        Returns a list of all the textures and their information.
+		Args: [], Returns: Array
 */
 func (o *visualServer) TextureDebugUsage() gdnative.Array {
 	o.ensureSingleton()
@@ -7725,7 +9240,7 @@ func (o *visualServer) TextureDebugUsage() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_debug_usage")
+	methodBind := methodBindVisualServerTextureDebugUsage.Get()
 
 	// Call the parent method.
 	// Array
@@ -7738,10 +9253,10 @@ func (o *visualServer) TextureDebugUsage() gdnative.Array {
 }
 
 /*
-        Returns a copy of a texture's image unless it's a CubeMap, in which case it returns the [RID] of the image at one of the cubes sides.
-	Args: [{ false texture RID} {0 true cube_side int}], Returns: Image
+	        Returns a copy of a texture's image unless it's a CubeMap, in which case it returns the [RID] of the image at one of the cubes sides.
+		Args: [{ false texture RID} {0 true cube_side int}], Returns: Image
 */
-func (o *visualServer) TextureGetData(texture gdnative.Rid, cubeSide gdnative.Int) ImageImplementer {
+func (o *visualServer) TextureGetData(texture gdnative.Rid, cubeSide gdnative.Int) godot.ImageImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.TextureGetData()")
 
@@ -7751,7 +9266,7 @@ func (o *visualServer) TextureGetData(texture gdnative.Rid, cubeSide gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(cubeSide)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_get_data")
+	methodBind := methodBindVisualServerTextureGetData.Get()
 
 	// Call the parent method.
 	// Image
@@ -7759,27 +9274,25 @@ func (o *visualServer) TextureGetData(texture gdnative.Rid, cubeSide gdnative.In
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newImageFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ImageImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Image" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ImageImplementer)
+	ret := godot.Image{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ImageImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the flags of a texture.
-	Args: [{ false texture RID}], Returns: int
+	        Returns the flags of a texture.
+		Args: [{ false texture RID}], Returns: int
 */
 func (o *visualServer) TextureGetFlags(texture gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -7790,7 +9303,7 @@ func (o *visualServer) TextureGetFlags(texture gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_get_flags")
+	methodBind := methodBindVisualServerTextureGetFlags.Get()
 
 	// Call the parent method.
 	// int
@@ -7803,10 +9316,10 @@ func (o *visualServer) TextureGetFlags(texture gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the format of the texture's image.
-	Args: [{ false texture RID}], Returns: enum.Image::Format
+	        Returns the format of the texture's image.
+		Args: [{ false texture RID}], Returns: enum.Image::Format
 */
-func (o *visualServer) TextureGetFormat(texture gdnative.Rid) ImageFormat {
+func (o *visualServer) TextureGetFormat(texture gdnative.Rid) godot.ImageFormat {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.TextureGetFormat()")
 
@@ -7815,7 +9328,7 @@ func (o *visualServer) TextureGetFormat(texture gdnative.Rid) ImageFormat {
 	ptrArguments[0] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_get_format")
+	methodBind := methodBindVisualServerTextureGetFormat.Get()
 
 	// Call the parent method.
 	// enum.Image::Format
@@ -7824,12 +9337,12 @@ func (o *visualServer) TextureGetFormat(texture gdnative.Rid) ImageFormat {
 
 	// If we have a return type, convert it from a pointer into its actual object.
 	ret := gdnative.NewIntFromPointer(retPtr)
-	return ImageFormat(ret)
+	return godot.ImageFormat(ret)
 }
 
 /*
-        Returns the texture's height.
-	Args: [{ false texture RID}], Returns: int
+	        Returns the texture's height.
+		Args: [{ false texture RID}], Returns: int
 */
 func (o *visualServer) TextureGetHeight(texture gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -7840,7 +9353,7 @@ func (o *visualServer) TextureGetHeight(texture gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_get_height")
+	methodBind := methodBindVisualServerTextureGetHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -7853,8 +9366,8 @@ func (o *visualServer) TextureGetHeight(texture gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the texture's path.
-	Args: [{ false texture RID}], Returns: String
+	        Returns the texture's path.
+		Args: [{ false texture RID}], Returns: String
 */
 func (o *visualServer) TextureGetPath(texture gdnative.Rid) gdnative.String {
 	o.ensureSingleton()
@@ -7865,7 +9378,7 @@ func (o *visualServer) TextureGetPath(texture gdnative.Rid) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_get_path")
+	methodBind := methodBindVisualServerTextureGetPath.Get()
 
 	// Call the parent method.
 	// String
@@ -7878,8 +9391,8 @@ func (o *visualServer) TextureGetPath(texture gdnative.Rid) gdnative.String {
 }
 
 /*
-        Returns the opengl id of the texture's image.
-	Args: [{ false texture RID}], Returns: int
+	        Returns the opengl id of the texture's image.
+		Args: [{ false texture RID}], Returns: int
 */
 func (o *visualServer) TextureGetTexid(texture gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -7890,7 +9403,7 @@ func (o *visualServer) TextureGetTexid(texture gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_get_texid")
+	methodBind := methodBindVisualServerTextureGetTexid.Get()
 
 	// Call the parent method.
 	// int
@@ -7903,8 +9416,8 @@ func (o *visualServer) TextureGetTexid(texture gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the texture's width.
-	Args: [{ false texture RID}], Returns: int
+	        Returns the texture's width.
+		Args: [{ false texture RID}], Returns: int
 */
 func (o *visualServer) TextureGetWidth(texture gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -7915,7 +9428,7 @@ func (o *visualServer) TextureGetWidth(texture gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(texture)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_get_width")
+	methodBind := methodBindVisualServerTextureGetWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -7928,21 +9441,21 @@ func (o *visualServer) TextureGetWidth(texture gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Sets the texture's image data. If it's a CubeMap, it sets the image data at a cube side.
-	Args: [{ false texture RID} { false image Image} {0 true cube_side int}], Returns: void
+	        Sets the texture's image data. If it's a CubeMap, it sets the image data at a cube side.
+		Args: [{ false texture RID} { false image Image} {0 true cube_side int}], Returns: void
 */
-func (o *visualServer) TextureSetData(texture gdnative.Rid, image ImageImplementer, cubeSide gdnative.Int) {
+func (o *visualServer) TextureSetData(texture gdnative.Rid, image godot.ImageImplementer, cubeSide gdnative.Int) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.TextureSetData()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromRid(texture)
-	ptrArguments[1] = gdnative.NewPointerFromObject(image.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
 	ptrArguments[2] = gdnative.NewPointerFromInt(cubeSide)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_set_data")
+	methodBind := methodBindVisualServerTextureSetData.Get()
 
 	// Call the parent method.
 	// void
@@ -7952,8 +9465,8 @@ func (o *visualServer) TextureSetData(texture gdnative.Rid, image ImageImplement
 }
 
 /*
-        Sets the texture's flags. See [enum TextureFlags] for options
-	Args: [{ false texture RID} { false flags int}], Returns: void
+	        Sets the texture's flags. See [enum TextureFlags] for options
+		Args: [{ false texture RID} { false flags int}], Returns: void
 */
 func (o *visualServer) TextureSetFlags(texture gdnative.Rid, flags gdnative.Int) {
 	o.ensureSingleton()
@@ -7965,7 +9478,7 @@ func (o *visualServer) TextureSetFlags(texture gdnative.Rid, flags gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_set_flags")
+	methodBind := methodBindVisualServerTextureSetFlags.Get()
 
 	// Call the parent method.
 	// void
@@ -7975,8 +9488,8 @@ func (o *visualServer) TextureSetFlags(texture gdnative.Rid, flags gdnative.Int)
 }
 
 /*
-        Sets the texture's path.
-	Args: [{ false texture RID} { false path String}], Returns: void
+	        Sets the texture's path.
+		Args: [{ false texture RID} { false path String}], Returns: void
 */
 func (o *visualServer) TextureSetPath(texture gdnative.Rid, path gdnative.String) {
 	o.ensureSingleton()
@@ -7988,7 +9501,7 @@ func (o *visualServer) TextureSetPath(texture gdnative.Rid, path gdnative.String
 	ptrArguments[1] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_set_path")
+	methodBind := methodBindVisualServerTextureSetPath.Get()
 
 	// Call the parent method.
 	// void
@@ -7998,8 +9511,8 @@ func (o *visualServer) TextureSetPath(texture gdnative.Rid, path gdnative.String
 }
 
 /*
-        If [code]true[/code], sets internal processes to shrink all image data to half the size.
-	Args: [{ false shrink bool}], Returns: void
+	        If [code]true[/code], sets internal processes to shrink all image data to half the size.
+		Args: [{ false shrink bool}], Returns: void
 */
 func (o *visualServer) TextureSetShrinkAllX2OnSetData(shrink gdnative.Bool) {
 	o.ensureSingleton()
@@ -8010,7 +9523,7 @@ func (o *visualServer) TextureSetShrinkAllX2OnSetData(shrink gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(shrink)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_set_shrink_all_x2_on_set_data")
+	methodBind := methodBindVisualServerTextureSetShrinkAllX2OnSetData.Get()
 
 	// Call the parent method.
 	// void
@@ -8020,8 +9533,8 @@ func (o *visualServer) TextureSetShrinkAllX2OnSetData(shrink gdnative.Bool) {
 }
 
 /*
-        Overwrites the texture's width and height.
-	Args: [{ false texture RID} { false width int} { false height int}], Returns: void
+	        Overwrites the texture's width and height.
+		Args: [{ false texture RID} { false width int} { false height int}], Returns: void
 */
 func (o *visualServer) TextureSetSizeOverride(texture gdnative.Rid, width gdnative.Int, height gdnative.Int) {
 	o.ensureSingleton()
@@ -8034,7 +9547,7 @@ func (o *visualServer) TextureSetSizeOverride(texture gdnative.Rid, width gdnati
 	ptrArguments[2] = gdnative.NewPointerFromInt(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "texture_set_size_override")
+	methodBind := methodBindVisualServerTextureSetSizeOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -8044,8 +9557,8 @@ func (o *visualServer) TextureSetSizeOverride(texture gdnative.Rid, width gdnati
 }
 
 /*
-        If [code]true[/code], the image will be stored in the texture's images array if overwritten.
-	Args: [{ false enable bool}], Returns: void
+	        If [code]true[/code], the image will be stored in the texture's images array if overwritten.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *visualServer) TexturesKeepOriginal(enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -8056,7 +9569,7 @@ func (o *visualServer) TexturesKeepOriginal(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "textures_keep_original")
+	methodBind := methodBindVisualServerTexturesKeepOriginal.Get()
 
 	// Call the parent method.
 	// void
@@ -8066,8 +9579,8 @@ func (o *visualServer) TexturesKeepOriginal(enable gdnative.Bool) {
 }
 
 /*
-        Sets a viewport's camera.
-	Args: [{ false viewport RID} { false camera RID}], Returns: void
+	        Sets a viewport's camera.
+		Args: [{ false viewport RID} { false camera RID}], Returns: void
 */
 func (o *visualServer) ViewportAttachCamera(viewport gdnative.Rid, camera gdnative.Rid) {
 	o.ensureSingleton()
@@ -8079,7 +9592,7 @@ func (o *visualServer) ViewportAttachCamera(viewport gdnative.Rid, camera gdnati
 	ptrArguments[1] = gdnative.NewPointerFromRid(camera)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_attach_camera")
+	methodBind := methodBindVisualServerViewportAttachCamera.Get()
 
 	// Call the parent method.
 	// void
@@ -8089,8 +9602,8 @@ func (o *visualServer) ViewportAttachCamera(viewport gdnative.Rid, camera gdnati
 }
 
 /*
-        Sets a viewport's canvas.
-	Args: [{ false viewport RID} { false canvas RID}], Returns: void
+	        Sets a viewport's canvas.
+		Args: [{ false viewport RID} { false canvas RID}], Returns: void
 */
 func (o *visualServer) ViewportAttachCanvas(viewport gdnative.Rid, canvas gdnative.Rid) {
 	o.ensureSingleton()
@@ -8102,7 +9615,7 @@ func (o *visualServer) ViewportAttachCanvas(viewport gdnative.Rid, canvas gdnati
 	ptrArguments[1] = gdnative.NewPointerFromRid(canvas)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_attach_canvas")
+	methodBind := methodBindVisualServerViewportAttachCanvas.Get()
 
 	// Call the parent method.
 	// void
@@ -8112,8 +9625,8 @@ func (o *visualServer) ViewportAttachCanvas(viewport gdnative.Rid, canvas gdnati
 }
 
 /*
-        Attaches a viewport to a screen.
-	Args: [{ false viewport RID} {(0, 0, 0, 0) true rect Rect2} {0 true screen int}], Returns: void
+	        Attaches a viewport to a screen.
+		Args: [{ false viewport RID} {(0, 0, 0, 0) true rect Rect2} {0 true screen int}], Returns: void
 */
 func (o *visualServer) ViewportAttachToScreen(viewport gdnative.Rid, rect gdnative.Rect2, screen gdnative.Int) {
 	o.ensureSingleton()
@@ -8126,7 +9639,7 @@ func (o *visualServer) ViewportAttachToScreen(viewport gdnative.Rid, rect gdnati
 	ptrArguments[2] = gdnative.NewPointerFromInt(screen)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_attach_to_screen")
+	methodBind := methodBindVisualServerViewportAttachToScreen.Get()
 
 	// Call the parent method.
 	// void
@@ -8136,8 +9649,8 @@ func (o *visualServer) ViewportAttachToScreen(viewport gdnative.Rid, rect gdnati
 }
 
 /*
-        Creates an empty viewport.
-	Args: [], Returns: RID
+	        Creates an empty viewport.
+		Args: [], Returns: RID
 */
 func (o *visualServer) ViewportCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -8147,7 +9660,7 @@ func (o *visualServer) ViewportCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_create")
+	methodBind := methodBindVisualServerViewportCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -8160,8 +9673,8 @@ func (o *visualServer) ViewportCreate() gdnative.Rid {
 }
 
 /*
-        Detaches the viewport from the screen.
-	Args: [{ false viewport RID}], Returns: void
+	        Detaches the viewport from the screen.
+		Args: [{ false viewport RID}], Returns: void
 */
 func (o *visualServer) ViewportDetach(viewport gdnative.Rid) {
 	o.ensureSingleton()
@@ -8172,7 +9685,7 @@ func (o *visualServer) ViewportDetach(viewport gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(viewport)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_detach")
+	methodBind := methodBindVisualServerViewportDetach.Get()
 
 	// Call the parent method.
 	// void
@@ -8182,8 +9695,8 @@ func (o *visualServer) ViewportDetach(viewport gdnative.Rid) {
 }
 
 /*
-        Returns a viewport's render info. for options see VIEWPORT_RENDER_INFO* constants.
-	Args: [{ false viewport RID} { false info int}], Returns: int
+	        Returns a viewport's render info. for options see VIEWPORT_RENDER_INFO* constants.
+		Args: [{ false viewport RID} { false info int}], Returns: int
 */
 func (o *visualServer) ViewportGetRenderInfo(viewport gdnative.Rid, info gdnative.Int) gdnative.Int {
 	o.ensureSingleton()
@@ -8195,7 +9708,7 @@ func (o *visualServer) ViewportGetRenderInfo(viewport gdnative.Rid, info gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(info)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_get_render_info")
+	methodBind := methodBindVisualServerViewportGetRenderInfo.Get()
 
 	// Call the parent method.
 	// int
@@ -8208,8 +9721,8 @@ func (o *visualServer) ViewportGetRenderInfo(viewport gdnative.Rid, info gdnativ
 }
 
 /*
-        Returns the viewport's last rendered frame.
-	Args: [{ false viewport RID}], Returns: RID
+	        Returns the viewport's last rendered frame.
+		Args: [{ false viewport RID}], Returns: RID
 */
 func (o *visualServer) ViewportGetTexture(viewport gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -8220,7 +9733,7 @@ func (o *visualServer) ViewportGetTexture(viewport gdnative.Rid) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromRid(viewport)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_get_texture")
+	methodBind := methodBindVisualServerViewportGetTexture.Get()
 
 	// Call the parent method.
 	// RID
@@ -8233,8 +9746,8 @@ func (o *visualServer) ViewportGetTexture(viewport gdnative.Rid) gdnative.Rid {
 }
 
 /*
-        Detaches a viewport from a canvas and vice versa.
-	Args: [{ false viewport RID} { false canvas RID}], Returns: void
+	        Detaches a viewport from a canvas and vice versa.
+		Args: [{ false viewport RID} { false canvas RID}], Returns: void
 */
 func (o *visualServer) ViewportRemoveCanvas(viewport gdnative.Rid, canvas gdnative.Rid) {
 	o.ensureSingleton()
@@ -8246,7 +9759,7 @@ func (o *visualServer) ViewportRemoveCanvas(viewport gdnative.Rid, canvas gdnati
 	ptrArguments[1] = gdnative.NewPointerFromRid(canvas)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_remove_canvas")
+	methodBind := methodBindVisualServerViewportRemoveCanvas.Get()
 
 	// Call the parent method.
 	// void
@@ -8256,8 +9769,8 @@ func (o *visualServer) ViewportRemoveCanvas(viewport gdnative.Rid, canvas gdnati
 }
 
 /*
-        If [code]true[/code], sets the viewport active, else sets it inactive.
-	Args: [{ false viewport RID} { false active bool}], Returns: void
+	        If [code]true[/code], sets the viewport active, else sets it inactive.
+		Args: [{ false viewport RID} { false active bool}], Returns: void
 */
 func (o *visualServer) ViewportSetActive(viewport gdnative.Rid, active gdnative.Bool) {
 	o.ensureSingleton()
@@ -8269,7 +9782,7 @@ func (o *visualServer) ViewportSetActive(viewport gdnative.Rid, active gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromBool(active)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_active")
+	methodBind := methodBindVisualServerViewportSetActive.Get()
 
 	// Call the parent method.
 	// void
@@ -8279,8 +9792,8 @@ func (o *visualServer) ViewportSetActive(viewport gdnative.Rid, active gdnative.
 }
 
 /*
-        Sets the renderlayer for a viewport's canvas.
-	Args: [{ false viewport RID} { false canvas RID} { false layer int}], Returns: void
+	        Sets the renderlayer for a viewport's canvas.
+		Args: [{ false viewport RID} { false canvas RID} { false layer int}], Returns: void
 */
 func (o *visualServer) ViewportSetCanvasLayer(viewport gdnative.Rid, canvas gdnative.Rid, layer gdnative.Int) {
 	o.ensureSingleton()
@@ -8293,7 +9806,7 @@ func (o *visualServer) ViewportSetCanvasLayer(viewport gdnative.Rid, canvas gdna
 	ptrArguments[2] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_canvas_layer")
+	methodBind := methodBindVisualServerViewportSetCanvasLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -8303,8 +9816,8 @@ func (o *visualServer) ViewportSetCanvasLayer(viewport gdnative.Rid, canvas gdna
 }
 
 /*
-        Sets the transformation of a viewport's canvas.
-	Args: [{ false viewport RID} { false canvas RID} { false offset Transform2D}], Returns: void
+	        Sets the transformation of a viewport's canvas.
+		Args: [{ false viewport RID} { false canvas RID} { false offset Transform2D}], Returns: void
 */
 func (o *visualServer) ViewportSetCanvasTransform(viewport gdnative.Rid, canvas gdnative.Rid, offset gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -8317,7 +9830,7 @@ func (o *visualServer) ViewportSetCanvasTransform(viewport gdnative.Rid, canvas
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_canvas_transform")
+	methodBind := methodBindVisualServerViewportSetCanvasTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -8327,20 +9840,20 @@ func (o *visualServer) ViewportSetCanvasTransform(viewport gdnative.Rid, canvas
 }
 
 /*
-        Sets the clear mode of a viewport. See VIEWPORT_CLEAR_MODE_* constants for options.
-	Args: [{ false viewport RID} { false clear_mode int}], Returns: void
+	        Sets the clear mode of a viewport. See VIEWPORT_CLEAR_MODE_* constants for options.
+		Args: [{ false viewport RID} { false clear_mode enum.VisualServer::ViewportClearMode}], Returns: void
 */
-func (o *visualServer) ViewportSetClearMode(viewport gdnative.Rid, clearMode gdnative.Int) {
+func (o *visualServer) ViewportSetClearMode(viewport gdnative.Rid, clearMode VisualServerViewportClearMode) {
 	o.ensureSingleton()
 	//log.Println("Calling VisualServer.ViewportSetClearMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromRid(viewport)
-	ptrArguments[1] = gdnative.NewPointerFromInt(clearMode)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(clearMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_clear_mode")
+	methodBind := methodBindVisualServerViewportSetClearMode.Get()
 
 	// Call the parent method.
 	// void
@@ -8350,8 +9863,8 @@ func (o *visualServer) ViewportSetClearMode(viewport gdnative.Rid, clearMode gdn
 }
 
 /*
-        Sets the debug draw mode of a viewport. See VIEWPORT_DEBUG_DRAW_* constants for options.
-	Args: [{ false viewport RID} { false draw int}], Returns: void
+	        Sets the debug draw mode of a viewport. See VIEWPORT_DEBUG_DRAW_* constants for options.
+		Args: [{ false viewport RID} { false draw int}], Returns: void
 */
 func (o *visualServer) ViewportSetDebugDraw(viewport gdnative.Rid, draw gdnative.Int) {
 	o.ensureSingleton()
@@ -8363,7 +9876,7 @@ func (o *visualServer) ViewportSetDebugDraw(viewport gdnative.Rid, draw gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(draw)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_debug_draw")
+	methodBind := methodBindVisualServerViewportSetDebugDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -8373,8 +9886,8 @@ func (o *visualServer) ViewportSetDebugDraw(viewport gdnative.Rid, draw gdnative
 }
 
 /*
-        If [code]true[/code] a viewport's 3D rendering should be disabled.
-	Args: [{ false viewport RID} { false disabled bool}], Returns: void
+	        If [code]true[/code] a viewport's 3D rendering should be disabled.
+		Args: [{ false viewport RID} { false disabled bool}], Returns: void
 */
 func (o *visualServer) ViewportSetDisable3D(viewport gdnative.Rid, disabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -8386,7 +9899,7 @@ func (o *visualServer) ViewportSetDisable3D(viewport gdnative.Rid, disabled gdna
 	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_disable_3d")
+	methodBind := methodBindVisualServerViewportSetDisable3D.Get()
 
 	// Call the parent method.
 	// void
@@ -8396,8 +9909,8 @@ func (o *visualServer) ViewportSetDisable3D(viewport gdnative.Rid, disabled gdna
 }
 
 /*
-        If [code]true[/code] rendering of a viewport's environment should be disabled.
-	Args: [{ false viewport RID} { false disabled bool}], Returns: void
+	        If [code]true[/code] rendering of a viewport's environment should be disabled.
+		Args: [{ false viewport RID} { false disabled bool}], Returns: void
 */
 func (o *visualServer) ViewportSetDisableEnvironment(viewport gdnative.Rid, disabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -8409,7 +9922,7 @@ func (o *visualServer) ViewportSetDisableEnvironment(viewport gdnative.Rid, disa
 	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_disable_environment")
+	methodBind := methodBindVisualServerViewportSetDisableEnvironment.Get()
 
 	// Call the parent method.
 	// void
@@ -8419,8 +9932,8 @@ func (o *visualServer) ViewportSetDisableEnvironment(viewport gdnative.Rid, disa
 }
 
 /*
-        Sets the viewport's global transformation matrix.
-	Args: [{ false viewport RID} { false transform Transform2D}], Returns: void
+	        Sets the viewport's global transformation matrix.
+		Args: [{ false viewport RID} { false transform Transform2D}], Returns: void
 */
 func (o *visualServer) ViewportSetGlobalCanvasTransform(viewport gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -8432,7 +9945,7 @@ func (o *visualServer) ViewportSetGlobalCanvasTransform(viewport gdnative.Rid, t
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_global_canvas_transform")
+	methodBind := methodBindVisualServerViewportSetGlobalCanvasTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -8442,8 +9955,8 @@ func (o *visualServer) ViewportSetGlobalCanvasTransform(viewport gdnative.Rid, t
 }
 
 /*
-        If [code]true[/code] the viewport should render to hdr.
-	Args: [{ false viewport RID} { false enabled bool}], Returns: void
+	        If [code]true[/code] the viewport should render to hdr.
+		Args: [{ false viewport RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) ViewportSetHdr(viewport gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -8455,7 +9968,7 @@ func (o *visualServer) ViewportSetHdr(viewport gdnative.Rid, enabled gdnative.Bo
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_hdr")
+	methodBind := methodBindVisualServerViewportSetHdr.Get()
 
 	// Call the parent method.
 	// void
@@ -8465,8 +9978,8 @@ func (o *visualServer) ViewportSetHdr(viewport gdnative.Rid, enabled gdnative.Bo
 }
 
 /*
-        If [code]true[/code] the viewport's canvas should not be rendered.
-	Args: [{ false viewport RID} { false hidden bool}], Returns: void
+	        If [code]true[/code] the viewport's canvas should not be rendered.
+		Args: [{ false viewport RID} { false hidden bool}], Returns: void
 */
 func (o *visualServer) ViewportSetHideCanvas(viewport gdnative.Rid, hidden gdnative.Bool) {
 	o.ensureSingleton()
@@ -8478,7 +9991,7 @@ func (o *visualServer) ViewportSetHideCanvas(viewport gdnative.Rid, hidden gdnat
 	ptrArguments[1] = gdnative.NewPointerFromBool(hidden)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_hide_canvas")
+	methodBind := methodBindVisualServerViewportSetHideCanvas.Get()
 
 	// Call the parent method.
 	// void
@@ -8488,8 +10001,7 @@ func (o *visualServer) ViewportSetHideCanvas(viewport gdnative.Rid, hidden gdnat
 }
 
 /*
-
-	Args: [{ false viewport RID} { false hidden bool}], Returns: void
+Args: [{ false viewport RID} { false hidden bool}], Returns: void
 */
 func (o *visualServer) ViewportSetHideScenario(viewport gdnative.Rid, hidden gdnative.Bool) {
 	o.ensureSingleton()
@@ -8501,7 +10013,7 @@ func (o *visualServer) ViewportSetHideScenario(viewport gdnative.Rid, hidden gdn
 	ptrArguments[1] = gdnative.NewPointerFromBool(hidden)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_hide_scenario")
+	methodBind := methodBindVisualServerViewportSetHideScenario.Get()
 
 	// Call the parent method.
 	// void
@@ -8511,8 +10023,8 @@ func (o *visualServer) ViewportSetHideScenario(viewport gdnative.Rid, hidden gdn
 }
 
 /*
-        Sets the anti-aliasing mode. see [enum ViewportMSAA] for options.
-	Args: [{ false viewport RID} { false msaa int}], Returns: void
+	        Sets the anti-aliasing mode. see [enum ViewportMSAA] for options.
+		Args: [{ false viewport RID} { false msaa int}], Returns: void
 */
 func (o *visualServer) ViewportSetMsaa(viewport gdnative.Rid, msaa gdnative.Int) {
 	o.ensureSingleton()
@@ -8524,7 +10036,7 @@ func (o *visualServer) ViewportSetMsaa(viewport gdnative.Rid, msaa gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(msaa)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_msaa")
+	methodBind := methodBindVisualServerViewportSetMsaa.Get()
 
 	// Call the parent method.
 	// void
@@ -8534,8 +10046,8 @@ func (o *visualServer) ViewportSetMsaa(viewport gdnative.Rid, msaa gdnative.Int)
 }
 
 /*
-        Sets the viewport's parent to another viewport.
-	Args: [{ false viewport RID} { false parent_viewport RID}], Returns: void
+	        Sets the viewport's parent to another viewport.
+		Args: [{ false viewport RID} { false parent_viewport RID}], Returns: void
 */
 func (o *visualServer) ViewportSetParentViewport(viewport gdnative.Rid, parentViewport gdnative.Rid) {
 	o.ensureSingleton()
@@ -8547,7 +10059,7 @@ func (o *visualServer) ViewportSetParentViewport(viewport gdnative.Rid, parentVi
 	ptrArguments[1] = gdnative.NewPointerFromRid(parentViewport)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_parent_viewport")
+	methodBind := methodBindVisualServerViewportSetParentViewport.Get()
 
 	// Call the parent method.
 	// void
@@ -8557,8 +10069,8 @@ func (o *visualServer) ViewportSetParentViewport(viewport gdnative.Rid, parentVi
 }
 
 /*
-        Sets a viewport's scenario. The scenario contains information about the [enum ScenarioDebugMode], environment information, reflection atlas etc.
-	Args: [{ false viewport RID} { false scenario RID}], Returns: void
+	        Sets a viewport's scenario. The scenario contains information about the [enum ScenarioDebugMode], environment information, reflection atlas etc.
+		Args: [{ false viewport RID} { false scenario RID}], Returns: void
 */
 func (o *visualServer) ViewportSetScenario(viewport gdnative.Rid, scenario gdnative.Rid) {
 	o.ensureSingleton()
@@ -8570,7 +10082,7 @@ func (o *visualServer) ViewportSetScenario(viewport gdnative.Rid, scenario gdnat
 	ptrArguments[1] = gdnative.NewPointerFromRid(scenario)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_scenario")
+	methodBind := methodBindVisualServerViewportSetScenario.Get()
 
 	// Call the parent method.
 	// void
@@ -8580,8 +10092,8 @@ func (o *visualServer) ViewportSetScenario(viewport gdnative.Rid, scenario gdnat
 }
 
 /*
-        Sets the shadow atlas quadrant's subdivision.
-	Args: [{ false viewport RID} { false quadrant int} { false subdivision int}], Returns: void
+	        Sets the shadow atlas quadrant's subdivision.
+		Args: [{ false viewport RID} { false quadrant int} { false subdivision int}], Returns: void
 */
 func (o *visualServer) ViewportSetShadowAtlasQuadrantSubdivision(viewport gdnative.Rid, quadrant gdnative.Int, subdivision gdnative.Int) {
 	o.ensureSingleton()
@@ -8594,7 +10106,7 @@ func (o *visualServer) ViewportSetShadowAtlasQuadrantSubdivision(viewport gdnati
 	ptrArguments[2] = gdnative.NewPointerFromInt(subdivision)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_shadow_atlas_quadrant_subdivision")
+	methodBind := methodBindVisualServerViewportSetShadowAtlasQuadrantSubdivision.Get()
 
 	// Call the parent method.
 	// void
@@ -8604,8 +10116,8 @@ func (o *visualServer) ViewportSetShadowAtlasQuadrantSubdivision(viewport gdnati
 }
 
 /*
-        Sets the size of the shadow atlas's images.
-	Args: [{ false viewport RID} { false size int}], Returns: void
+	        Sets the size of the shadow atlas's images.
+		Args: [{ false viewport RID} { false size int}], Returns: void
 */
 func (o *visualServer) ViewportSetShadowAtlasSize(viewport gdnative.Rid, size gdnative.Int) {
 	o.ensureSingleton()
@@ -8617,7 +10129,7 @@ func (o *visualServer) ViewportSetShadowAtlasSize(viewport gdnative.Rid, size gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_shadow_atlas_size")
+	methodBind := methodBindVisualServerViewportSetShadowAtlasSize.Get()
 
 	// Call the parent method.
 	// void
@@ -8627,8 +10139,8 @@ func (o *visualServer) ViewportSetShadowAtlasSize(viewport gdnative.Rid, size gd
 }
 
 /*
-        Sets the viewport's width and height.
-	Args: [{ false viewport RID} { false width int} { false height int}], Returns: void
+	        Sets the viewport's width and height.
+		Args: [{ false viewport RID} { false width int} { false height int}], Returns: void
 */
 func (o *visualServer) ViewportSetSize(viewport gdnative.Rid, width gdnative.Int, height gdnative.Int) {
 	o.ensureSingleton()
@@ -8641,7 +10153,7 @@ func (o *visualServer) ViewportSetSize(viewport gdnative.Rid, width gdnative.Int
 	ptrArguments[2] = gdnative.NewPointerFromInt(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_size")
+	methodBind := methodBindVisualServerViewportSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -8651,8 +10163,8 @@ func (o *visualServer) ViewportSetSize(viewport gdnative.Rid, width gdnative.Int
 }
 
 /*
-        If [code]true[/code] the viewport should render its background as transparent.
-	Args: [{ false viewport RID} { false enabled bool}], Returns: void
+	        If [code]true[/code] the viewport should render its background as transparent.
+		Args: [{ false viewport RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) ViewportSetTransparentBackground(viewport gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -8664,7 +10176,7 @@ func (o *visualServer) ViewportSetTransparentBackground(viewport gdnative.Rid, e
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_transparent_background")
+	methodBind := methodBindVisualServerViewportSetTransparentBackground.Get()
 
 	// Call the parent method.
 	// void
@@ -8674,8 +10186,8 @@ func (o *visualServer) ViewportSetTransparentBackground(viewport gdnative.Rid, e
 }
 
 /*
-        Sets when the viewport should be updated. See VIEWPORT_UPDATE_MODE_* constants for options.
-	Args: [{ false viewport RID} { false update_mode int}], Returns: void
+	        Sets when the viewport should be updated. See VIEWPORT_UPDATE_MODE_* constants for options.
+		Args: [{ false viewport RID} { false update_mode int}], Returns: void
 */
 func (o *visualServer) ViewportSetUpdateMode(viewport gdnative.Rid, updateMode gdnative.Int) {
 	o.ensureSingleton()
@@ -8687,7 +10199,7 @@ func (o *visualServer) ViewportSetUpdateMode(viewport gdnative.Rid, updateMode g
 	ptrArguments[1] = gdnative.NewPointerFromInt(updateMode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_update_mode")
+	methodBind := methodBindVisualServerViewportSetUpdateMode.Get()
 
 	// Call the parent method.
 	// void
@@ -8697,8 +10209,8 @@ func (o *visualServer) ViewportSetUpdateMode(viewport gdnative.Rid, updateMode g
 }
 
 /*
-        Sets what should be rendered in the viewport. See VIEWPORT_USAGE_* constants for options.
-	Args: [{ false viewport RID} { false usage int}], Returns: void
+	        Sets what should be rendered in the viewport. See VIEWPORT_USAGE_* constants for options.
+		Args: [{ false viewport RID} { false usage int}], Returns: void
 */
 func (o *visualServer) ViewportSetUsage(viewport gdnative.Rid, usage gdnative.Int) {
 	o.ensureSingleton()
@@ -8710,7 +10222,7 @@ func (o *visualServer) ViewportSetUsage(viewport gdnative.Rid, usage gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(usage)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_usage")
+	methodBind := methodBindVisualServerViewportSetUsage.Get()
 
 	// Call the parent method.
 	// void
@@ -8720,8 +10232,8 @@ func (o *visualServer) ViewportSetUsage(viewport gdnative.Rid, usage gdnative.In
 }
 
 /*
-        If [code]true[/code] the viewport should use augmented or virtual reality technologies. See [ARVRInterface].
-	Args: [{ false viewport RID} { false use_arvr bool}], Returns: void
+	        If [code]true[/code] the viewport should use augmented or virtual reality technologies. See [ARVRInterface].
+		Args: [{ false viewport RID} { false use_arvr bool}], Returns: void
 */
 func (o *visualServer) ViewportSetUseArvr(viewport gdnative.Rid, useArvr gdnative.Bool) {
 	o.ensureSingleton()
@@ -8733,7 +10245,7 @@ func (o *visualServer) ViewportSetUseArvr(viewport gdnative.Rid, useArvr gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromBool(useArvr)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_use_arvr")
+	methodBind := methodBindVisualServerViewportSetUseArvr.Get()
 
 	// Call the parent method.
 	// void
@@ -8743,8 +10255,8 @@ func (o *visualServer) ViewportSetUseArvr(viewport gdnative.Rid, useArvr gdnativ
 }
 
 /*
-        If [code]true[/code] the viewport's rendering should be flipped vertically.
-	Args: [{ false viewport RID} { false enabled bool}], Returns: void
+	        If [code]true[/code] the viewport's rendering should be flipped vertically.
+		Args: [{ false viewport RID} { false enabled bool}], Returns: void
 */
 func (o *visualServer) ViewportSetVflip(viewport gdnative.Rid, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -8756,7 +10268,7 @@ func (o *visualServer) ViewportSetVflip(viewport gdnative.Rid, enabled gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualServer", "viewport_set_vflip")
+	methodBind := methodBindVisualServerViewportSetVflip.Get()
 
 	// Call the parent method.
 	// void
@@ -8765,10 +10277,164 @@ func (o *visualServer) ViewportSetVflip(viewport gdnative.Rid, enabled gdnative.
 
 }
 
+// CanvasItemAddLineWithDefaults will call CanvasItemAddLine using the default values for: width, antialiased.
+func (o *visualServer) CanvasItemAddLineWithDefaults(item gdnative.Rid, from gdnative.Vector2, to gdnative.Vector2, color gdnative.Color) {
+	o.CanvasItemAddLine(item, from, to, color, 1, false)
+}
+
+// CanvasItemAddMeshWithDefaults will call CanvasItemAddMesh using the default values for: skeleton.
+func (o *visualServer) CanvasItemAddMeshWithDefaults(item gdnative.Rid, mesh gdnative.Rid) {
+	o.CanvasItemAddMesh(item, mesh, gdnative.NewRid())
+}
+
+// CanvasItemAddMultimeshWithDefaults will call CanvasItemAddMultimesh using the default values for: skeleton.
+func (o *visualServer) CanvasItemAddMultimeshWithDefaults(item gdnative.Rid, mesh gdnative.Rid) {
+	o.CanvasItemAddMultimesh(item, mesh, gdnative.NewRid())
+}
+
+// CanvasItemAddNinePatchWithDefaults will call CanvasItemAddNinePatch using the default values for: x_axis_mode, y_axis_mode, draw_center, modulate, normal_map.
+func (o *visualServer) CanvasItemAddNinePatchWithDefaults(item gdnative.Rid, rect gdnative.Rect2, source gdnative.Rect2, texture gdnative.Rid, topleft gdnative.Vector2, bottomright gdnative.Vector2) {
+	o.CanvasItemAddNinePatch(item, rect, source, texture, topleft, bottomright, 0, 0, true, gdnative.NewColorRgba(1, 1, 1, 1), gdnative.NewRid())
+}
+
+// CanvasItemAddPolygonWithDefaults will call CanvasItemAddPolygon using the default values for: uvs, texture, normal_map, antialiased.
+func (o *visualServer) CanvasItemAddPolygonWithDefaults(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray) {
+	default0 := gdnative.NewPoolVector2Array()
+	defer default0.Destroy()
+	o.CanvasItemAddPolygon(item, points, colors, default0, gdnative.NewRid(), gdnative.NewRid(), false)
+}
+
+// CanvasItemAddPolylineWithDefaults will call CanvasItemAddPolyline using the default values for: width, antialiased.
+func (o *visualServer) CanvasItemAddPolylineWithDefaults(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray) {
+	o.CanvasItemAddPolyline(item, points, colors, 1, false)
+}
+
+// CanvasItemAddPrimitiveWithDefaults will call CanvasItemAddPrimitive using the default values for: width, normal_map.
+func (o *visualServer) CanvasItemAddPrimitiveWithDefaults(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array, texture gdnative.Rid) {
+	o.CanvasItemAddPrimitive(item, points, colors, uvs, texture, 1, gdnative.NewRid())
+}
+
+// CanvasItemAddTextureRectWithDefaults will call CanvasItemAddTextureRect using the default values for: tile, modulate, transpose, normal_map.
+func (o *visualServer) CanvasItemAddTextureRectWithDefaults(item gdnative.Rid, rect gdnative.Rect2, texture gdnative.Rid) {
+	o.CanvasItemAddTextureRect(item, rect, texture, false, gdnative.NewColorRgba(1, 1, 1, 1), false, gdnative.NewRid())
+}
+
+// CanvasItemAddTextureRectRegionWithDefaults will call CanvasItemAddTextureRectRegion using the default values for: modulate, transpose, normal_map, clip_uv.
+func (o *visualServer) CanvasItemAddTextureRectRegionWithDefaults(item gdnative.Rid, rect gdnative.Rect2, texture gdnative.Rid, srcRect gdnative.Rect2) {
+	o.CanvasItemAddTextureRectRegion(item, rect, texture, srcRect, gdnative.NewColorRgba(1, 1, 1, 1), false, gdnative.NewRid(), true)
+}
+
+// CanvasItemAddTriangleArrayWithDefaults will call CanvasItemAddTriangleArray using the default values for: uvs, texture, count, normal_map.
+func (o *visualServer) CanvasItemAddTriangleArrayWithDefaults(item gdnative.Rid, indices gdnative.PoolIntArray, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray) {
+	default0 := gdnative.NewPoolVector2Array()
+	defer default0.Destroy()
+	o.CanvasItemAddTriangleArray(item, indices, points, colors, default0, gdnative.NewRid(), -1, gdnative.NewRid())
+}
+
+// CanvasItemSetCustomRectWithDefaults will call CanvasItemSetCustomRect using the default values for: rect.
+func (o *visualServer) CanvasItemSetCustomRectWithDefaults(item gdnative.Rid, useCustomRect gdnative.Bool) {
+	o.CanvasItemSetCustomRect(item, useCustomRect, gdnative.NewRect2(0, 0, 0, 0))
+}
+
+// DrawWithDefaults will call Draw using the default values for: swap_buffers.
+func (o *visualServer) DrawWithDefaults() {
+	o.Draw(true)
+}
+
+// EnvironmentSetAmbientLightWithDefaults will call EnvironmentSetAmbientLight using the default values for: energy, sky_contibution.
+func (o *visualServer) EnvironmentSetAmbientLightWithDefaults(env gdnative.Rid, color gdnative.Color) {
+	o.EnvironmentSetAmbientLight(env, color, 1, 0)
+}
+
+// ForceDrawWithDefaults will call ForceDraw using the default values for: swap_buffers.
+func (o *visualServer) ForceDrawWithDefaults() {
+	o.ForceDraw(true)
+}
+
+// ImmediateBeginWithDefaults will call ImmediateBegin using the default values for: texture.
+func (o *visualServer) ImmediateBeginWithDefaults(immediate gdnative.Rid, primitive gdnative.Int) {
+	o.ImmediateBegin(immediate, primitive, gdnative.NewRid())
+}
+
+// InstancesCullAabbWithDefaults will call InstancesCullAabb using the default values for: scenario.
+func (o *visualServer) InstancesCullAabbWithDefaults(aabb gdnative.Aabb) gdnative.Array {
+	return o.InstancesCullAabb(aabb, gdnative.NewRid())
+}
+
+// InstancesCullConvexWithDefaults will call InstancesCullConvex using the default values for: scenario.
+func (o *visualServer) InstancesCullConvexWithDefaults(convex gdnative.Array) gdnative.Array {
+	return o.InstancesCullConvex(convex, gdnative.NewRid())
+}
+
+// InstancesCullRayWithDefaults will call InstancesCullRay using the default values for: scenario.
+func (o *visualServer) InstancesCullRayWithDefaults(from gdnative.Vector3, to gdnative.Vector3) gdnative.Array {
+	return o.InstancesCullRay(from, to, gdnative.NewRid())
+}
+
+// MeshAddSurfaceFromArraysWithDefaults will call MeshAddSurfaceFromArrays using the default values for: blend_shapes, compress_format.
+func (o *visualServer) MeshAddSurfaceFromArraysWithDefaults(mesh gdnative.Rid, primtive gdnative.Int, arrays gdnative.Array) {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	o.MeshAddSurfaceFromArrays(mesh, primtive, arrays, default0, 97792)
+}
+
+// SkeletonAllocateWithDefaults will call SkeletonAllocate using the default values for: is_2d_skeleton.
+func (o *visualServer) SkeletonAllocateWithDefaults(skeleton gdnative.Rid, bones gdnative.Int) {
+	o.SkeletonAllocate(skeleton, bones, false)
+}
+
+// TextureAllocateWithDefaults will call TextureAllocate using the default values for: flags.
+func (o *visualServer) TextureAllocateWithDefaults(texture gdnative.Rid, width gdnative.Int, height gdnative.Int, format gdnative.Int) {
+	o.TextureAllocate(texture, width, height, format, 7)
+}
+
+// TextureCreateFromImageWithDefaults will call TextureCreateFromImage using the default values for: flags.
+func (o *visualServer) TextureCreateFromImageWithDefaults(image godot.ImageImplementer) gdnative.Rid {
+	return o.TextureCreateFromImage(image, 7)
+}
+
+// TextureGetDataWithDefaults will call TextureGetData using the default values for: cube_side.
+func (o *visualServer) TextureGetDataWithDefaults(texture gdnative.Rid) godot.ImageImplementer {
+	return o.TextureGetData(texture, 0)
+}
+
+// TextureSetDataWithDefaults will call TextureSetData using the default values for: cube_side.
+func (o *visualServer) TextureSetDataWithDefaults(texture gdnative.Rid, image godot.ImageImplementer) {
+	o.TextureSetData(texture, image, 0)
+}
+
+// ViewportAttachToScreenWithDefaults will call ViewportAttachToScreen using the default values for: rect, screen.
+func (o *visualServer) ViewportAttachToScreenWithDefaults(viewport gdnative.Rid) {
+	o.ViewportAttachToScreen(viewport, gdnative.NewRect2(0, 0, 0, 0), 0)
+}
+
+// Signals of the VisualServer class.
+const (
+	VisualServerSignalFrameDrawnInThread gdnative.String = "frame_drawn_in_thread"
+)
+
+// ConnectFrameDrawnInThread will connect the "frame_drawn_in_thread" signal to the given method of the target object.
+func (o *visualServer) ConnectFrameDrawnInThread(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	o.ensureSingleton()
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisualServerSignalFrameDrawnInThread, target, method, binds, 0)
+}
+
+// EmitFrameDrawnInThread will emit the "frame_drawn_in_thread" signal.
+func (o *visualServer) EmitFrameDrawnInThread() error {
+	o.ensureSingleton()
+
+	ret, err := o.EmitSignal(VisualServerSignalFrameDrawnInThread)
+	ret.Destroy()
+	return err
+}
+
 // VisualServerImplementer is an interface that implements the methods
 // of the VisualServer class.
 type VisualServerImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	BlackBarsSetImages(left gdnative.Rid, top gdnative.Rid, right gdnative.Rid, bottom gdnative.Rid)
 	BlackBarsSetMargins(left gdnative.Int, top gdnative.Int, right gdnative.Int, bottom gdnative.Int)
 	CameraCreate() gdnative.Rid
@@ -8860,12 +10526,12 @@ type VisualServerImplementer interface {
 	EnvironmentSetFog(env gdnative.Rid, enable gdnative.Bool, color gdnative.Color, sunColor gdnative.Color, sunAmount gdnative.Real)
 	EnvironmentSetFogDepth(env gdnative.Rid, enable gdnative.Bool, depthBegin gdnative.Real, depthCurve gdnative.Real, transmit gdnative.Bool, transmitCurve gdnative.Real)
 	EnvironmentSetFogHeight(env gdnative.Rid, enable gdnative.Bool, minHeight gdnative.Real, maxHeight gdnative.Real, heightCurve gdnative.Real)
-	EnvironmentSetGlow(env gdnative.Rid, enable gdnative.Bool, levelFlags gdnative.Int, intensity gdnative.Real, strength gdnative.Real, bloomThreshold gdnative.Real, blendMode gdnative.Int, hdrBleedThreshold gdnative.Real, hdrBleedScale gdnative.Real, bicubicUpscale gdnative.Bool)
+	EnvironmentSetGlow(env gdnative.Rid, enable gdnative.Bool, levelFlags gdnative.Int, intensity gdnative.Real, strength gdnative.Real, bloomThreshold gdnative.Real, blendMode VisualServerEnvironmentGlowBlendMode, hdrBleedThreshold gdnative.Real, hdrBleedScale gdnative.Real, bicubicUpscale gdnative.Bool)
 	EnvironmentSetSky(env gdnative.Rid, sky gdnative.Rid)
 	EnvironmentSetSkyCustomFov(env gdnative.Rid, scale gdnative.Real)
 	EnvironmentSetSsao(env gdnative.Rid, enable gdnative.Bool, radius gdnative.Real, intensity gdnative.Real, radius2 gdnative.Real, intensity2 gdnative.Real, bias gdnative.Real, lightAffect gdnative.Real, color gdnative.Color, quality gdnative.Int, blur gdnative.Int, bilateralSharpness gdnative.Real)
 	EnvironmentSetSsr(env gdnative.Rid, enable gdnative.Bool, maxSteps gdnative.Int, fadeIn gdnative.Real, fadeOut gdnative.Real, depthTolerance gdnative.Real, roughness gdnative.Bool)
-	EnvironmentSetTonemap(env gdnative.Rid, toneMapper gdnative.Int, exposure gdnative.Real, white gdnative.Real, autoExposure gdnative.Bool, minLuminance gdnative.Real, maxLuminance gdnative.Real, autoExpSpeed gdnative.Real, autoExpGrey gdnative.Real)
+	EnvironmentSetTonemap(env gdnative.Rid, toneMapper VisualServerEnvironmentToneMapper, exposure gdnative.Real, white gdnative.Real, autoExposure gdnative.Bool, minLuminance gdnative.Real, maxLuminance gdnative.Real, autoExpSpeed gdnative.Real, autoExpGrey gdnative.Real)
 	Finish()
 	ForceDraw(swapBuffers gdnative.Bool)
 	ForceSync()
@@ -8919,7 +10585,7 @@ type VisualServerImplementer interface {
 	InstanceCreate() gdnative.Rid
 	InstanceCreate2(base gdnative.Rid, scenario gdnative.Rid) gdnative.Rid
 	InstanceGeometrySetAsInstanceLod(instance gdnative.Rid, asLodOfInstance gdnative.Rid)
-	InstanceGeometrySetCastShadowsSetting(instance gdnative.Rid, shadowCastingSetting gdnative.Int)
+	InstanceGeometrySetCastShadowsSetting(instance gdnative.Rid, shadowCastingSetting VisualServerShadowCastingSetting)
 	InstanceGeometrySetDrawRange(instance gdnative.Rid, min gdnative.Real, max gdnative.Real, minMargin gdnative.Real, maxMargin gdnative.Real)
 	InstanceGeometrySetFlag(instance gdnative.Rid, flag gdnative.Int, enabled gdnative.Bool)
 	InstanceGeometrySetMaterialOverride(instance gdnative.Rid, material gdnative.Rid)
@@ -8938,7 +10604,7 @@ type VisualServerImplementer interface {
 	InstancesCullConvex(convex gdnative.Array, scenario gdnative.Rid) gdnative.Array
 	InstancesCullRay(from gdnative.Vector3, to gdnative.Vector3, scenario gdnative.Rid) gdnative.Array
 	LightDirectionalSetBlendSplits(light gdnative.Rid, enable gdnative.Bool)
-	LightDirectionalSetShadowDepthRangeMode(light gdnative.Rid, rangeMode gdnative.Int)
+	LightDirectionalSetShadowDepthRangeMode(light gdnative.Rid, rangeMode VisualServerLightDirectionalShadowDepthRangeMode)
 	LightDirectionalSetShadowMode(light gdnative.Rid, mode gdnative.Int)
 	LightOmniSetShadowDetail(light gdnative.Rid, detail gdnative.Int)
 	LightOmniSetShadowMode(light gdnative.Rid, mode gdnative.Int)
@@ -8974,6 +10640,7 @@ type VisualServerImplementer interface {
 	MeshClear(mesh gdnative.Rid)
 	MeshCreate() gdnative.Rid
 	MeshGetBlendShapeCount(mesh gdnative.Rid) gdnative.Int
+	MeshGetBlendShapeMode(mesh gdnative.Rid) VisualServerBlendShapeMode
 	MeshGetCustomAabb(mesh gdnative.Rid) gdnative.Aabb
 	MeshGetSurfaceCount(mesh gdnative.Rid) gdnative.Int
 	MeshRemoveSurface(mesh gdnative.Rid, index gdnative.Int)
@@ -8989,9 +10656,10 @@ type VisualServerImplementer interface {
 	MeshSurfaceGetFormat(mesh gdnative.Rid, surface gdnative.Int) gdnative.Int
 	MeshSurfaceGetIndexArray(mesh gdnative.Rid, surface gdnative.Int) gdnative.PoolByteArray
 	MeshSurfaceGetMaterial(mesh gdnative.Rid, surface gdnative.Int) gdnative.Rid
+	MeshSurfaceGetPrimitiveType(mesh gdnative.Rid, surface gdnative.Int) VisualServerPrimitiveType
 	MeshSurfaceGetSkeletonAabb(mesh gdnative.Rid, surface gdnative.Int) gdnative.Array
 	MeshSurfaceSetMaterial(mesh gdnative.Rid, surface gdnative.Int, material gdnative.Rid)
-	MultimeshAllocate(multimesh gdnative.Rid, instances gdnative.Int, transformFormat gdnative.Int, colorFormat gdnative.Int)
+	MultimeshAllocate(multimesh gdnative.Rid, instances gdnative.Int, transformFormat VisualServerMultimeshTransformFormat, colorFormat VisualServerMultimeshColorFormat)
 	MultimeshGetAabb(multimesh gdnative.Rid) gdnative.Aabb
 	MultimeshGetInstanceCount(multimesh gdnative.Rid) gdnative.Int
 	MultimeshGetMesh(multimesh gdnative.Rid) gdnative.Rid
@@ -9039,13 +10707,13 @@ type VisualServerImplementer interface {
 	ReflectionProbeSetMaxDistance(probe gdnative.Rid, distance gdnative.Real)
 	ReflectionProbeSetOriginOffset(probe gdnative.Rid, offset gdnative.Vector3)
 	ReflectionProbeSetUpdateMode(probe gdnative.Rid, mode gdnative.Int)
-	RequestFrameDrawnCallback(where ObjectImplementer, method gdnative.String, userdata gdnative.Variant)
+	RequestFrameDrawnCallback(where godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant)
 	ScenarioCreate() gdnative.Rid
-	ScenarioSetDebug(scenario gdnative.Rid, debugMode gdnative.Int)
+	ScenarioSetDebug(scenario gdnative.Rid, debugMode VisualServerScenarioDebugMode)
 	ScenarioSetEnvironment(scenario gdnative.Rid, environment gdnative.Rid)
 	ScenarioSetFallbackEnvironment(scenario gdnative.Rid, environment gdnative.Rid)
 	ScenarioSetReflectionAtlasSize(scenario gdnative.Rid, pSize gdnative.Int, subdiv gdnative.Int)
-	SetBootImage(image ImageImplementer, color gdnative.Color, scale gdnative.Bool)
+	SetBootImage(image godot.ImageImplementer, color gdnative.Color, scale gdnative.Bool)
 	SetDebugGenerateWireframes(generate gdnative.Bool)
 	SetDefaultClearColor(color gdnative.Color)
 	ShaderCreate() gdnative.Rid
@@ -9067,15 +10735,16 @@ type VisualServerImplementer interface {
 	Sync()
 	TextureAllocate(texture gdnative.Rid, width gdnative.Int, height gdnative.Int, format gdnative.Int, flags gdnative.Int)
 	TextureCreate() gdnative.Rid
-	TextureCreateFromImage(image ImageImplementer, flags gdnative.Int) gdnative.Rid
+	TextureCreateFromImage(image godot.ImageImplementer, flags gdnative.Int) gdnative.Rid
 	TextureDebugUsage() gdnative.Array
-	TextureGetData(texture gdnative.Rid, cubeSide gdnative.Int) ImageImplementer
+	TextureGetData(texture gdnative.Rid, cubeSide gdnative.Int) godot.ImageImplementer
 	TextureGetFlags(texture gdnative.Rid) gdnative.Int
+	TextureGetFormat(texture gdnative.Rid) godot.ImageFormat
 	TextureGetHeight(texture gdnative.Rid) gdnative.Int
 	TextureGetPath(texture gdnative.Rid) gdnative.String
 	TextureGetTexid(texture gdnative.Rid) gdnative.Int
 	TextureGetWidth(texture gdnative.Rid) gdnative.Int
-	TextureSetData(texture gdnative.Rid, image ImageImplementer, cubeSide gdnative.Int)
+	TextureSetData(texture gdnative.Rid, image godot.ImageImplementer, cubeSide gdnative.Int)
 	TextureSetFlags(texture gdnative.Rid, flags gdnative.Int)
 	TextureSetPath(texture gdnative.Rid, path gdnative.String)
 	TextureSetShrinkAllX2OnSetData(shrink gdnative.Bool)
@@ -9092,7 +10761,7 @@ type VisualServerImplementer interface {
 	ViewportSetActive(viewport gdnative.Rid, active gdnative.Bool)
 	ViewportSetCanvasLayer(viewport gdnative.Rid, canvas gdnative.Rid, layer gdnative.Int)
 	ViewportSetCanvasTransform(viewport gdnative.Rid, canvas gdnative.Rid, offset gdnative.Transform2D)
-	ViewportSetClearMode(viewport gdnative.Rid, clearMode gdnative.Int)
+	ViewportSetClearMode(viewport gdnative.Rid, clearMode VisualServerViewportClearMode)
 	ViewportSetDebugDraw(viewport gdnative.Rid, draw gdnative.Int)
 	ViewportSetDisable3D(viewport gdnative.Rid, disabled gdnative.Bool)
 	ViewportSetDisableEnvironment(viewport gdnative.Rid, disabled gdnative.Bool)
@@ -9111,4 +10780,31 @@ type VisualServerImplementer interface {
 	ViewportSetUsage(viewport gdnative.Rid, usage gdnative.Int)
 	ViewportSetUseArvr(viewport gdnative.Rid, useArvr gdnative.Bool)
 	ViewportSetVflip(viewport gdnative.Rid, enabled gdnative.Bool)
+	CanvasItemAddLineWithDefaults(item gdnative.Rid, from gdnative.Vector2, to gdnative.Vector2, color gdnative.Color)
+	CanvasItemAddMeshWithDefaults(item gdnative.Rid, mesh gdnative.Rid)
+	CanvasItemAddMultimeshWithDefaults(item gdnative.Rid, mesh gdnative.Rid)
+	CanvasItemAddNinePatchWithDefaults(item gdnative.Rid, rect gdnative.Rect2, source gdnative.Rect2, texture gdnative.Rid, topleft gdnative.Vector2, bottomright gdnative.Vector2)
+	CanvasItemAddPolygonWithDefaults(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray)
+	CanvasItemAddPolylineWithDefaults(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray)
+	CanvasItemAddPrimitiveWithDefaults(item gdnative.Rid, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uvs gdnative.PoolVector2Array, texture gdnative.Rid)
+	CanvasItemAddTextureRectWithDefaults(item gdnative.Rid, rect gdnative.Rect2, texture gdnative.Rid)
+	CanvasItemAddTextureRectRegionWithDefaults(item gdnative.Rid, rect gdnative.Rect2, texture gdnative.Rid, srcRect gdnative.Rect2)
+	CanvasItemAddTriangleArrayWithDefaults(item gdnative.Rid, indices gdnative.PoolIntArray, points gdnative.PoolVector2Array, colors gdnative.PoolColorArray)
+	CanvasItemSetCustomRectWithDefaults(item gdnative.Rid, useCustomRect gdnative.Bool)
+	DrawWithDefaults()
+	EnvironmentSetAmbientLightWithDefaults(env gdnative.Rid, color gdnative.Color)
+	ForceDrawWithDefaults()
+	ImmediateBeginWithDefaults(immediate gdnative.Rid, primitive gdnative.Int)
+	InstancesCullAabbWithDefaults(aabb gdnative.Aabb) gdnative.Array
+	InstancesCullConvexWithDefaults(convex gdnative.Array) gdnative.Array
+	InstancesCullRayWithDefaults(from gdnative.Vector3, to gdnative.Vector3) gdnative.Array
+	MeshAddSurfaceFromArraysWithDefaults(mesh gdnative.Rid, primtive gdnative.Int, arrays gdnative.Array)
+	SkeletonAllocateWithDefaults(skeleton gdnative.Rid, bones gdnative.Int)
+	TextureAllocateWithDefaults(texture gdnative.Rid, width gdnative.Int, height gdnative.Int, format gdnative.Int)
+	TextureCreateFromImageWithDefaults(image godot.ImageImplementer) gdnative.Rid
+	TextureGetDataWithDefaults(texture gdnative.Rid) godot.ImageImplementer
+	TextureSetDataWithDefaults(texture gdnative.Rid, image godot.ImageImplementer)
+	ViewportAttachToScreenWithDefaults(viewport gdnative.Rid)
+	ConnectFrameDrawnInThread(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFrameDrawnInThread() error
 }
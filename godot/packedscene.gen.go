@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,13 +24,30 @@ const (
 	PackedSceneGenEditStateMain     PackedSceneGenEditState = 2
 )
 
-//func NewPackedSceneFromPointer(ptr gdnative.Pointer) PackedScene {
-func newPackedSceneFromPointer(ptr gdnative.Pointer) PackedScene {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PackedScene{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the PackedSceneGenEditState value.
+func (e PackedSceneGenEditState) String() string {
+	switch e {
+	case PackedSceneGenEditStateDisabled:
+		return "GEN_EDIT_STATE_DISABLED"
+	case PackedSceneGenEditStateInstance:
+		return "GEN_EDIT_STATE_INSTANCE"
+	case PackedSceneGenEditStateMain:
+		return "GEN_EDIT_STATE_MAIN"
+	}
+	return fmt.Sprintf("PackedSceneGenEditState(%d)", int(e))
+}
 
-	return obj
+// ParsePackedSceneGenEditState will return the PackedSceneGenEditState value with the given Godot name.
+func ParsePackedSceneGenEditState(name string) (PackedSceneGenEditState, error) {
+	switch name {
+	case "GEN_EDIT_STATE_DISABLED":
+		return PackedSceneGenEditStateDisabled, nil
+	case "GEN_EDIT_STATE_INSTANCE":
+		return PackedSceneGenEditStateInstance, nil
+	case "GEN_EDIT_STATE_MAIN":
+		return PackedSceneGenEditStateMain, nil
+	}
+	return 0, fmt.Errorf("invalid PackedSceneGenEditState value %q", name)
 }
 
 /*
@@ -39,13 +58,41 @@ type PackedScene struct {
 	owner gdnative.Object
 }
 
+// classConstructorPackedScene is the class constructor of the PackedScene class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPackedScene = gdnative.NewLazyClassConstructor("PackedScene")
+
+// NewPackedScene will create a new instance of the PackedScene class.
+// PackedScene is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPackedScene() *PackedScene {
+	obj := &PackedScene{}
+	obj.SetBaseObject(classConstructorPackedScene.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PackedScene) BaseClass() string {
 	return "PackedScene"
 }
 
+// Method binds of the PackedScene class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPackedSceneX_GetBundledScene = gdnative.NewLazyMethodBind("PackedScene", "_get_bundled_scene")
+	methodBindPackedSceneX_SetBundledScene = gdnative.NewLazyMethodBind("PackedScene", "_set_bundled_scene")
+	methodBindPackedSceneCanInstance       = gdnative.NewLazyMethodBind("PackedScene", "can_instance")
+	methodBindPackedSceneGetState          = gdnative.NewLazyMethodBind("PackedScene", "get_state")
+	methodBindPackedSceneInstance          = gdnative.NewLazyMethodBind("PackedScene", "instance")
+	methodBindPackedScenePack              = gdnative.NewLazyMethodBind("PackedScene", "pack")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *PackedScene) X_GetBundledScene() gdnative.Dictionary {
 	//log.Println("Calling PackedScene.X_GetBundledScene()")
@@ -54,7 +101,7 @@ func (o *PackedScene) X_GetBundledScene() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedScene", "_get_bundled_scene")
+	methodBind := methodBindPackedSceneX_GetBundledScene.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -67,8 +114,8 @@ func (o *PackedScene) X_GetBundledScene() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Dictionary}], Returns: void
 */
 func (o *PackedScene) X_SetBundledScene(arg0 gdnative.Dictionary) {
 	//log.Println("Calling PackedScene.X_SetBundledScene()")
@@ -78,7 +125,7 @@ func (o *PackedScene) X_SetBundledScene(arg0 gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedScene", "_set_bundled_scene")
+	methodBind := methodBindPackedSceneX_SetBundledScene.Get()
 
 	// Call the parent method.
 	// void
@@ -88,8 +135,8 @@ func (o *PackedScene) X_SetBundledScene(arg0 gdnative.Dictionary) {
 }
 
 /*
-        Returns [code]true[/code] if the scene file has nodes.
-	Args: [], Returns: bool
+	        Returns [code]true[/code] if the scene file has nodes.
+		Args: [], Returns: bool
 */
 func (o *PackedScene) CanInstance() gdnative.Bool {
 	//log.Println("Calling PackedScene.CanInstance()")
@@ -98,7 +145,7 @@ func (o *PackedScene) CanInstance() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedScene", "can_instance")
+	methodBind := methodBindPackedSceneCanInstance.Get()
 
 	// Call the parent method.
 	// bool
@@ -111,8 +158,8 @@ func (o *PackedScene) CanInstance() gdnative.Bool {
 }
 
 /*
-        Returns the [code]SceneState[/code] representing the scene file contents.
-	Args: [], Returns: SceneState
+	        Returns the [code]SceneState[/code] representing the scene file contents.
+		Args: [], Returns: SceneState
 */
 func (o *PackedScene) GetState() SceneStateImplementer {
 	//log.Println("Calling PackedScene.GetState()")
@@ -121,7 +168,7 @@ func (o *PackedScene) GetState() SceneStateImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedScene", "get_state")
+	methodBind := methodBindPackedSceneGetState.Get()
 
 	// Call the parent method.
 	// SceneState
@@ -129,37 +176,35 @@ func (o *PackedScene) GetState() SceneStateImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSceneStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SceneStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "SceneState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SceneStateImplementer)
+	ret := SceneState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(SceneStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Instantiates the scene's node hierarchy. Triggers child scene instantiation(s). Triggers the [enum Object.NOTIFICATION_INSTANCED] notification on the root node.
-	Args: [{0 true edit_state int}], Returns: Node
+	        Instantiates the scene's node hierarchy. Triggers child scene instantiation(s). Triggers the [enum Object.NOTIFICATION_INSTANCED] notification on the root node.
+		Args: [{0 true edit_state enum.PackedScene::GenEditState}], Returns: Node
 */
-func (o *PackedScene) Instance(editState gdnative.Int) NodeImplementer {
+func (o *PackedScene) Instance(editState PackedSceneGenEditState) NodeImplementer {
 	//log.Println("Calling PackedScene.Instance()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(editState)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(editState))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedScene", "instance")
+	methodBind := methodBindPackedSceneInstance.Get()
 
 	// Call the parent method.
 	// Node
@@ -167,37 +212,35 @@ func (o *PackedScene) Instance(editState gdnative.Int) NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Pack will ignore any sub-nodes not owned by given node. See [method Node.set_owner].
-	Args: [{ false path Object}], Returns: enum.Error
+	        Pack will ignore any sub-nodes not owned by given node. See [method Node.set_owner].
+		Args: [{ false path Object}], Returns: enum.Error
 */
 func (o *PackedScene) Pack(path ObjectImplementer) gdnative.Error {
 	//log.Println("Calling PackedScene.Pack()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(path.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(path))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedScene", "pack")
+	methodBind := methodBindPackedScenePack.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -209,6 +252,11 @@ func (o *PackedScene) Pack(path ObjectImplementer) gdnative.Error {
 	return gdnative.Error(ret)
 }
 
+// InstanceWithDefaults will call Instance using the default values for: edit_state.
+func (o *PackedScene) InstanceWithDefaults() NodeImplementer {
+	return o.Instance(0)
+}
+
 // PackedSceneImplementer is an interface that implements the methods
 // of the PackedScene class.
 type PackedSceneImplementer interface {
@@ -217,5 +265,7 @@ type PackedSceneImplementer interface {
 	X_SetBundledScene(arg0 gdnative.Dictionary)
 	CanInstance() gdnative.Bool
 	GetState() SceneStateImplementer
-	Instance(editState gdnative.Int) NodeImplementer
+	Instance(editState PackedSceneGenEditState) NodeImplementer
+	Pack(path ObjectImplementer) gdnative.Error
+	InstanceWithDefaults() NodeImplementer
 }
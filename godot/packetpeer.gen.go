@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPacketPeerFromPointer(ptr gdnative.Pointer) PacketPeer {
-func newPacketPeerFromPointer(ptr gdnative.Pointer) PacketPeer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PacketPeer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 PacketPeer is an abstraction and base class for packet-based protocols (such as UDP). It provides an API for sending and receiving packets both as raw data or variables. This makes it easy to transfer data over a protocol, without having to encode data as low level bytes or having to worry about network ordering.
 */
@@ -34,9 +25,22 @@ func (o *PacketPeer) BaseClass() string {
 	return "PacketPeer"
 }
 
+// Method binds of the PacketPeer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPacketPeerGetAvailablePacketCount = gdnative.NewLazyMethodBind("PacketPeer", "get_available_packet_count")
+	methodBindPacketPeerGetPacket               = gdnative.NewLazyMethodBind("PacketPeer", "get_packet")
+	methodBindPacketPeerGetPacketError          = gdnative.NewLazyMethodBind("PacketPeer", "get_packet_error")
+	methodBindPacketPeerGetVar                  = gdnative.NewLazyMethodBind("PacketPeer", "get_var")
+	methodBindPacketPeerIsObjectDecodingAllowed = gdnative.NewLazyMethodBind("PacketPeer", "is_object_decoding_allowed")
+	methodBindPacketPeerPutPacket               = gdnative.NewLazyMethodBind("PacketPeer", "put_packet")
+	methodBindPacketPeerPutVar                  = gdnative.NewLazyMethodBind("PacketPeer", "put_var")
+	methodBindPacketPeerSetAllowObjectDecoding  = gdnative.NewLazyMethodBind("PacketPeer", "set_allow_object_decoding")
+)
+
 /*
-        Return the number of packets currently available in the ring-buffer.
-	Args: [], Returns: int
+	        Return the number of packets currently available in the ring-buffer.
+		Args: [], Returns: int
 */
 func (o *PacketPeer) GetAvailablePacketCount() gdnative.Int {
 	//log.Println("Calling PacketPeer.GetAvailablePacketCount()")
@@ -45,7 +49,7 @@ func (o *PacketPeer) GetAvailablePacketCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "get_available_packet_count")
+	methodBind := methodBindPacketPeerGetAvailablePacketCount.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +62,8 @@ func (o *PacketPeer) GetAvailablePacketCount() gdnative.Int {
 }
 
 /*
-        Get a raw packet.
-	Args: [], Returns: PoolByteArray
+	        Get a raw packet.
+		Args: [], Returns: PoolByteArray
 */
 func (o *PacketPeer) GetPacket() gdnative.PoolByteArray {
 	//log.Println("Calling PacketPeer.GetPacket()")
@@ -68,7 +72,7 @@ func (o *PacketPeer) GetPacket() gdnative.PoolByteArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "get_packet")
+	methodBind := methodBindPacketPeerGetPacket.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -81,8 +85,8 @@ func (o *PacketPeer) GetPacket() gdnative.PoolByteArray {
 }
 
 /*
-        Return the error state of the last packet received (via [method get_packet] and [method get_var]).
-	Args: [], Returns: enum.Error
+	        Return the error state of the last packet received (via [method get_packet] and [method get_var]).
+		Args: [], Returns: enum.Error
 */
 func (o *PacketPeer) GetPacketError() gdnative.Error {
 	//log.Println("Calling PacketPeer.GetPacketError()")
@@ -91,7 +95,7 @@ func (o *PacketPeer) GetPacketError() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "get_packet_error")
+	methodBind := methodBindPacketPeerGetPacketError.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -104,8 +108,8 @@ func (o *PacketPeer) GetPacketError() gdnative.Error {
 }
 
 /*
-        Get a Variant.
-	Args: [], Returns: Variant
+	        Get a Variant.
+		Args: [], Returns: Variant
 */
 func (o *PacketPeer) GetVar() gdnative.Variant {
 	//log.Println("Calling PacketPeer.GetVar()")
@@ -114,7 +118,7 @@ func (o *PacketPeer) GetVar() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "get_var")
+	methodBind := methodBindPacketPeerGetVar.Get()
 
 	// Call the parent method.
 	// Variant
@@ -127,8 +131,8 @@ func (o *PacketPeer) GetVar() gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PacketPeer) IsObjectDecodingAllowed() gdnative.Bool {
 	//log.Println("Calling PacketPeer.IsObjectDecodingAllowed()")
@@ -137,7 +141,7 @@ func (o *PacketPeer) IsObjectDecodingAllowed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "is_object_decoding_allowed")
+	methodBind := methodBindPacketPeerIsObjectDecodingAllowed.Get()
 
 	// Call the parent method.
 	// bool
@@ -150,8 +154,8 @@ func (o *PacketPeer) IsObjectDecodingAllowed() gdnative.Bool {
 }
 
 /*
-        Send a raw packet.
-	Args: [{ false buffer PoolByteArray}], Returns: enum.Error
+	        Send a raw packet.
+		Args: [{ false buffer PoolByteArray}], Returns: enum.Error
 */
 func (o *PacketPeer) PutPacket(buffer gdnative.PoolByteArray) gdnative.Error {
 	//log.Println("Calling PacketPeer.PutPacket()")
@@ -161,7 +165,7 @@ func (o *PacketPeer) PutPacket(buffer gdnative.PoolByteArray) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(buffer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "put_packet")
+	methodBind := methodBindPacketPeerPutPacket.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -174,8 +178,8 @@ func (o *PacketPeer) PutPacket(buffer gdnative.PoolByteArray) gdnative.Error {
 }
 
 /*
-        Send a Variant as a packet.
-	Args: [{ false var Variant}], Returns: enum.Error
+	        Send a Variant as a packet.
+		Args: [{ false var Variant}], Returns: enum.Error
 */
 func (o *PacketPeer) PutVar(variable gdnative.Variant) gdnative.Error {
 	//log.Println("Calling PacketPeer.PutVar()")
@@ -185,7 +189,7 @@ func (o *PacketPeer) PutVar(variable gdnative.Variant) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(variable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "put_var")
+	methodBind := methodBindPacketPeerPutVar.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -198,8 +202,8 @@ func (o *PacketPeer) PutVar(variable gdnative.Variant) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *PacketPeer) SetAllowObjectDecoding(enable gdnative.Bool) {
 	//log.Println("Calling PacketPeer.SetAllowObjectDecoding()")
@@ -209,7 +213,7 @@ func (o *PacketPeer) SetAllowObjectDecoding(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeer", "set_allow_object_decoding")
+	methodBind := methodBindPacketPeerSetAllowObjectDecoding.Get()
 
 	// Call the parent method.
 	// void
@@ -218,13 +222,22 @@ func (o *PacketPeer) SetAllowObjectDecoding(enable gdnative.Bool) {
 
 }
 
+// AllowObjectDecoding will return the value of the "allow_object_decoding" property.
+func (o *PacketPeer) AllowObjectDecoding() gdnative.Bool {
+	return o.IsObjectDecodingAllowed()
+}
+
 // PacketPeerImplementer is an interface that implements the methods
 // of the PacketPeer class.
 type PacketPeerImplementer interface {
 	ReferenceImplementer
 	GetAvailablePacketCount() gdnative.Int
 	GetPacket() gdnative.PoolByteArray
+	GetPacketError() gdnative.Error
 	GetVar() gdnative.Variant
 	IsObjectDecodingAllowed() gdnative.Bool
+	PutPacket(buffer gdnative.PoolByteArray) gdnative.Error
+	PutVar(variable gdnative.Variant) gdnative.Error
 	SetAllowObjectDecoding(enable gdnative.Bool)
+	AllowObjectDecoding() gdnative.Bool
 }
@@ -1,4 +1,4 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewBulletPhysicsServerFromPointer(ptr gdnative.Pointer) BulletPhysicsServer {
-func newBulletPhysicsServerFromPointer(ptr gdnative.Pointer) BulletPhysicsServer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BulletPhysicsServer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
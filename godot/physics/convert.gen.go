@@ -0,0 +1,60 @@
+package physics
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("BulletPhysicsServer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &BulletPhysicsServer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Physics2DDirectBodyState", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Physics2DDirectBodyState{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Physics2DDirectBodyStateSW", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Physics2DDirectBodyStateSW{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Physics2DServerSW", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Physics2DServerSW{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Physics2DShapeQueryResult", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Physics2DShapeQueryResult{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Physics2DTestMotionResult", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Physics2DTestMotionResult{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PhysicsDirectBodyState", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PhysicsDirectBodyState{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PhysicsShapeQueryResult", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PhysicsShapeQueryResult{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
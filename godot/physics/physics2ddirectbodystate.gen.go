@@ -1,7 +1,8 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysics2DDirectBodyStateFromPointer(ptr gdnative.Pointer) Physics2DDirectBodyState {
-func newPhysics2DDirectBodyStateFromPointer(ptr gdnative.Pointer) Physics2DDirectBodyState {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Physics2DDirectBodyState{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Direct access object to a physics body in the [Physics2DServer]. This object is passed via the direct state callback of rigid/character bodies, and is intended for changing the direct state of that body.
 */
 type Physics2DDirectBodyState struct {
-	Object
+	godot.Object
 	owner gdnative.Object
 }
 
@@ -34,9 +26,41 @@ func (o *Physics2DDirectBodyState) BaseClass() string {
 	return "Physics2DDirectBodyState"
 }
 
+// Method binds of the Physics2DDirectBodyState class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysics2DDirectBodyStateGetAngularVelocity                   = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_angular_velocity")
+	methodBindPhysics2DDirectBodyStateGetContactCollider                   = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_collider")
+	methodBindPhysics2DDirectBodyStateGetContactColliderId                 = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_collider_id")
+	methodBindPhysics2DDirectBodyStateGetContactColliderObject             = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_collider_object")
+	methodBindPhysics2DDirectBodyStateGetContactColliderPosition           = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_collider_position")
+	methodBindPhysics2DDirectBodyStateGetContactColliderShape              = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_collider_shape")
+	methodBindPhysics2DDirectBodyStateGetContactColliderShapeMetadata      = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_collider_shape_metadata")
+	methodBindPhysics2DDirectBodyStateGetContactColliderVelocityAtPosition = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_collider_velocity_at_position")
+	methodBindPhysics2DDirectBodyStateGetContactCount                      = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_count")
+	methodBindPhysics2DDirectBodyStateGetContactLocalNormal                = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_local_normal")
+	methodBindPhysics2DDirectBodyStateGetContactLocalPosition              = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_local_position")
+	methodBindPhysics2DDirectBodyStateGetContactLocalShape                 = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_contact_local_shape")
+	methodBindPhysics2DDirectBodyStateGetInverseInertia                    = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_inverse_inertia")
+	methodBindPhysics2DDirectBodyStateGetInverseMass                       = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_inverse_mass")
+	methodBindPhysics2DDirectBodyStateGetLinearVelocity                    = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_linear_velocity")
+	methodBindPhysics2DDirectBodyStateGetSpaceState                        = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_space_state")
+	methodBindPhysics2DDirectBodyStateGetStep                              = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_step")
+	methodBindPhysics2DDirectBodyStateGetTotalAngularDamp                  = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_total_angular_damp")
+	methodBindPhysics2DDirectBodyStateGetTotalGravity                      = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_total_gravity")
+	methodBindPhysics2DDirectBodyStateGetTotalLinearDamp                   = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_total_linear_damp")
+	methodBindPhysics2DDirectBodyStateGetTransform                         = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "get_transform")
+	methodBindPhysics2DDirectBodyStateIntegrateForces                      = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "integrate_forces")
+	methodBindPhysics2DDirectBodyStateIsSleeping                           = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "is_sleeping")
+	methodBindPhysics2DDirectBodyStateSetAngularVelocity                   = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "set_angular_velocity")
+	methodBindPhysics2DDirectBodyStateSetLinearVelocity                    = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "set_linear_velocity")
+	methodBindPhysics2DDirectBodyStateSetSleepState                        = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "set_sleep_state")
+	methodBindPhysics2DDirectBodyStateSetTransform                         = gdnative.NewLazyMethodBind("Physics2DDirectBodyState", "set_transform")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Physics2DDirectBodyState) GetAngularVelocity() gdnative.Real {
 	//log.Println("Calling Physics2DDirectBodyState.GetAngularVelocity()")
@@ -45,7 +69,7 @@ func (o *Physics2DDirectBodyState) GetAngularVelocity() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_angular_velocity")
+	methodBind := methodBindPhysics2DDirectBodyStateGetAngularVelocity.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +82,8 @@ func (o *Physics2DDirectBodyState) GetAngularVelocity() gdnative.Real {
 }
 
 /*
-        Return the [RID] of the collider.
-	Args: [{ false contact_idx int}], Returns: RID
+	        Return the [RID] of the collider.
+		Args: [{ false contact_idx int}], Returns: RID
 */
 func (o *Physics2DDirectBodyState) GetContactCollider(contactIdx gdnative.Int) gdnative.Rid {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactCollider()")
@@ -69,7 +93,7 @@ func (o *Physics2DDirectBodyState) GetContactCollider(contactIdx gdnative.Int) g
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_collider")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactCollider.Get()
 
 	// Call the parent method.
 	// RID
@@ -82,8 +106,8 @@ func (o *Physics2DDirectBodyState) GetContactCollider(contactIdx gdnative.Int) g
 }
 
 /*
-        Return the object id of the collider.
-	Args: [{ false contact_idx int}], Returns: int
+	        Return the object id of the collider.
+		Args: [{ false contact_idx int}], Returns: int
 */
 func (o *Physics2DDirectBodyState) GetContactColliderId(contactIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactColliderId()")
@@ -93,7 +117,7 @@ func (o *Physics2DDirectBodyState) GetContactColliderId(contactIdx gdnative.Int)
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_collider_id")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactColliderId.Get()
 
 	// Call the parent method.
 	// int
@@ -106,10 +130,10 @@ func (o *Physics2DDirectBodyState) GetContactColliderId(contactIdx gdnative.Int)
 }
 
 /*
-        Return the collider object, this depends on how it was created (will return a scene node if such was used to create it).
-	Args: [{ false contact_idx int}], Returns: Object
+	        Return the collider object, this depends on how it was created (will return a scene node if such was used to create it).
+		Args: [{ false contact_idx int}], Returns: Object
 */
-func (o *Physics2DDirectBodyState) GetContactColliderObject(contactIdx gdnative.Int) ObjectImplementer {
+func (o *Physics2DDirectBodyState) GetContactColliderObject(contactIdx gdnative.Int) godot.ObjectImplementer {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactColliderObject()")
 
 	// Build out the method's arguments
@@ -117,7 +141,7 @@ func (o *Physics2DDirectBodyState) GetContactColliderObject(contactIdx gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_collider_object")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactColliderObject.Get()
 
 	// Call the parent method.
 	// Object
@@ -125,27 +149,25 @@ func (o *Physics2DDirectBodyState) GetContactColliderObject(contactIdx gdnative.
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the contact position in the collider.
-	Args: [{ false contact_idx int}], Returns: Vector2
+	        Return the contact position in the collider.
+		Args: [{ false contact_idx int}], Returns: Vector2
 */
 func (o *Physics2DDirectBodyState) GetContactColliderPosition(contactIdx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactColliderPosition()")
@@ -155,7 +177,7 @@ func (o *Physics2DDirectBodyState) GetContactColliderPosition(contactIdx gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_collider_position")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactColliderPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -168,8 +190,8 @@ func (o *Physics2DDirectBodyState) GetContactColliderPosition(contactIdx gdnativ
 }
 
 /*
-        Return the collider shape index.
-	Args: [{ false contact_idx int}], Returns: int
+	        Return the collider shape index.
+		Args: [{ false contact_idx int}], Returns: int
 */
 func (o *Physics2DDirectBodyState) GetContactColliderShape(contactIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactColliderShape()")
@@ -179,7 +201,7 @@ func (o *Physics2DDirectBodyState) GetContactColliderShape(contactIdx gdnative.I
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_collider_shape")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactColliderShape.Get()
 
 	// Call the parent method.
 	// int
@@ -192,8 +214,8 @@ func (o *Physics2DDirectBodyState) GetContactColliderShape(contactIdx gdnative.I
 }
 
 /*
-        Return the metadata of the collided shape. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data].
-	Args: [{ false contact_idx int}], Returns: Variant
+	        Return the metadata of the collided shape. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data].
+		Args: [{ false contact_idx int}], Returns: Variant
 */
 func (o *Physics2DDirectBodyState) GetContactColliderShapeMetadata(contactIdx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactColliderShapeMetadata()")
@@ -203,7 +225,7 @@ func (o *Physics2DDirectBodyState) GetContactColliderShapeMetadata(contactIdx gd
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_collider_shape_metadata")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactColliderShapeMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -216,8 +238,8 @@ func (o *Physics2DDirectBodyState) GetContactColliderShapeMetadata(contactIdx gd
 }
 
 /*
-        Return the linear velocity vector at contact point of the collider.
-	Args: [{ false contact_idx int}], Returns: Vector2
+	        Return the linear velocity vector at contact point of the collider.
+		Args: [{ false contact_idx int}], Returns: Vector2
 */
 func (o *Physics2DDirectBodyState) GetContactColliderVelocityAtPosition(contactIdx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactColliderVelocityAtPosition()")
@@ -227,7 +249,7 @@ func (o *Physics2DDirectBodyState) GetContactColliderVelocityAtPosition(contactI
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_collider_velocity_at_position")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactColliderVelocityAtPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -240,8 +262,8 @@ func (o *Physics2DDirectBodyState) GetContactColliderVelocityAtPosition(contactI
 }
 
 /*
-        Return the amount of contacts this body has with other bodies. Note that by default this returns 0 unless bodies are configured to log contacts.
-	Args: [], Returns: int
+	        Return the amount of contacts this body has with other bodies. Note that by default this returns 0 unless bodies are configured to log contacts.
+		Args: [], Returns: int
 */
 func (o *Physics2DDirectBodyState) GetContactCount() gdnative.Int {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactCount()")
@@ -250,7 +272,7 @@ func (o *Physics2DDirectBodyState) GetContactCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_count")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactCount.Get()
 
 	// Call the parent method.
 	// int
@@ -263,8 +285,8 @@ func (o *Physics2DDirectBodyState) GetContactCount() gdnative.Int {
 }
 
 /*
-        Return the local normal (of this body) of the contact point.
-	Args: [{ false contact_idx int}], Returns: Vector2
+	        Return the local normal (of this body) of the contact point.
+		Args: [{ false contact_idx int}], Returns: Vector2
 */
 func (o *Physics2DDirectBodyState) GetContactLocalNormal(contactIdx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactLocalNormal()")
@@ -274,7 +296,7 @@ func (o *Physics2DDirectBodyState) GetContactLocalNormal(contactIdx gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_local_normal")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactLocalNormal.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -287,8 +309,8 @@ func (o *Physics2DDirectBodyState) GetContactLocalNormal(contactIdx gdnative.Int
 }
 
 /*
-        Return the local position (of this body) of the contact point.
-	Args: [{ false contact_idx int}], Returns: Vector2
+	        Return the local position (of this body) of the contact point.
+		Args: [{ false contact_idx int}], Returns: Vector2
 */
 func (o *Physics2DDirectBodyState) GetContactLocalPosition(contactIdx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactLocalPosition()")
@@ -298,7 +320,7 @@ func (o *Physics2DDirectBodyState) GetContactLocalPosition(contactIdx gdnative.I
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_local_position")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactLocalPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -311,8 +333,8 @@ func (o *Physics2DDirectBodyState) GetContactLocalPosition(contactIdx gdnative.I
 }
 
 /*
-        Return the local shape index of the collision.
-	Args: [{ false contact_idx int}], Returns: int
+	        Return the local shape index of the collision.
+		Args: [{ false contact_idx int}], Returns: int
 */
 func (o *Physics2DDirectBodyState) GetContactLocalShape(contactIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling Physics2DDirectBodyState.GetContactLocalShape()")
@@ -322,7 +344,7 @@ func (o *Physics2DDirectBodyState) GetContactLocalShape(contactIdx gdnative.Int)
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_contact_local_shape")
+	methodBind := methodBindPhysics2DDirectBodyStateGetContactLocalShape.Get()
 
 	// Call the parent method.
 	// int
@@ -335,8 +357,8 @@ func (o *Physics2DDirectBodyState) GetContactLocalShape(contactIdx gdnative.Int)
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Physics2DDirectBodyState) GetInverseInertia() gdnative.Real {
 	//log.Println("Calling Physics2DDirectBodyState.GetInverseInertia()")
@@ -345,7 +367,7 @@ func (o *Physics2DDirectBodyState) GetInverseInertia() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_inverse_inertia")
+	methodBind := methodBindPhysics2DDirectBodyStateGetInverseInertia.Get()
 
 	// Call the parent method.
 	// float
@@ -358,8 +380,8 @@ func (o *Physics2DDirectBodyState) GetInverseInertia() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Physics2DDirectBodyState) GetInverseMass() gdnative.Real {
 	//log.Println("Calling Physics2DDirectBodyState.GetInverseMass()")
@@ -368,7 +390,7 @@ func (o *Physics2DDirectBodyState) GetInverseMass() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_inverse_mass")
+	methodBind := methodBindPhysics2DDirectBodyStateGetInverseMass.Get()
 
 	// Call the parent method.
 	// float
@@ -381,8 +403,8 @@ func (o *Physics2DDirectBodyState) GetInverseMass() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DDirectBodyState) GetLinearVelocity() gdnative.Vector2 {
 	//log.Println("Calling Physics2DDirectBodyState.GetLinearVelocity()")
@@ -391,7 +413,7 @@ func (o *Physics2DDirectBodyState) GetLinearVelocity() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_linear_velocity")
+	methodBind := methodBindPhysics2DDirectBodyStateGetLinearVelocity.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -404,17 +426,17 @@ func (o *Physics2DDirectBodyState) GetLinearVelocity() gdnative.Vector2 {
 }
 
 /*
-        Return the current state of space, useful for queries.
-	Args: [], Returns: Physics2DDirectSpaceState
+	        Return the current state of space, useful for queries.
+		Args: [], Returns: Physics2DDirectSpaceState
 */
-func (o *Physics2DDirectBodyState) GetSpaceState() Physics2DDirectSpaceStateImplementer {
+func (o *Physics2DDirectBodyState) GetSpaceState() godot.Physics2DDirectSpaceStateImplementer {
 	//log.Println("Calling Physics2DDirectBodyState.GetSpaceState()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_space_state")
+	methodBind := methodBindPhysics2DDirectBodyStateGetSpaceState.Get()
 
 	// Call the parent method.
 	// Physics2DDirectSpaceState
@@ -422,27 +444,25 @@ func (o *Physics2DDirectBodyState) GetSpaceState() Physics2DDirectSpaceStateImpl
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysics2DDirectSpaceStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(Physics2DDirectSpaceStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Physics2DDirectSpaceState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(Physics2DDirectSpaceStateImplementer)
+	ret := godot.Physics2DDirectSpaceState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.Physics2DDirectSpaceStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Physics2DDirectBodyState) GetStep() gdnative.Real {
 	//log.Println("Calling Physics2DDirectBodyState.GetStep()")
@@ -451,7 +471,7 @@ func (o *Physics2DDirectBodyState) GetStep() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_step")
+	methodBind := methodBindPhysics2DDirectBodyStateGetStep.Get()
 
 	// Call the parent method.
 	// float
@@ -464,8 +484,8 @@ func (o *Physics2DDirectBodyState) GetStep() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Physics2DDirectBodyState) GetTotalAngularDamp() gdnative.Real {
 	//log.Println("Calling Physics2DDirectBodyState.GetTotalAngularDamp()")
@@ -474,7 +494,7 @@ func (o *Physics2DDirectBodyState) GetTotalAngularDamp() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_total_angular_damp")
+	methodBind := methodBindPhysics2DDirectBodyStateGetTotalAngularDamp.Get()
 
 	// Call the parent method.
 	// float
@@ -487,8 +507,8 @@ func (o *Physics2DDirectBodyState) GetTotalAngularDamp() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DDirectBodyState) GetTotalGravity() gdnative.Vector2 {
 	//log.Println("Calling Physics2DDirectBodyState.GetTotalGravity()")
@@ -497,7 +517,7 @@ func (o *Physics2DDirectBodyState) GetTotalGravity() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_total_gravity")
+	methodBind := methodBindPhysics2DDirectBodyStateGetTotalGravity.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -510,8 +530,8 @@ func (o *Physics2DDirectBodyState) GetTotalGravity() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Physics2DDirectBodyState) GetTotalLinearDamp() gdnative.Real {
 	//log.Println("Calling Physics2DDirectBodyState.GetTotalLinearDamp()")
@@ -520,7 +540,7 @@ func (o *Physics2DDirectBodyState) GetTotalLinearDamp() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_total_linear_damp")
+	methodBind := methodBindPhysics2DDirectBodyStateGetTotalLinearDamp.Get()
 
 	// Call the parent method.
 	// float
@@ -533,8 +553,8 @@ func (o *Physics2DDirectBodyState) GetTotalLinearDamp() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform2D
+	        Undocumented
+		Args: [], Returns: Transform2D
 */
 func (o *Physics2DDirectBodyState) GetTransform() gdnative.Transform2D {
 	//log.Println("Calling Physics2DDirectBodyState.GetTransform()")
@@ -543,7 +563,7 @@ func (o *Physics2DDirectBodyState) GetTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "get_transform")
+	methodBind := methodBindPhysics2DDirectBodyStateGetTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -556,8 +576,8 @@ func (o *Physics2DDirectBodyState) GetTransform() gdnative.Transform2D {
 }
 
 /*
-        Call the built-in force integration code.
-	Args: [], Returns: void
+	        Call the built-in force integration code.
+		Args: [], Returns: void
 */
 func (o *Physics2DDirectBodyState) IntegrateForces() {
 	//log.Println("Calling Physics2DDirectBodyState.IntegrateForces()")
@@ -566,7 +586,7 @@ func (o *Physics2DDirectBodyState) IntegrateForces() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "integrate_forces")
+	methodBind := methodBindPhysics2DDirectBodyStateIntegrateForces.Get()
 
 	// Call the parent method.
 	// void
@@ -576,8 +596,8 @@ func (o *Physics2DDirectBodyState) IntegrateForces() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Physics2DDirectBodyState) IsSleeping() gdnative.Bool {
 	//log.Println("Calling Physics2DDirectBodyState.IsSleeping()")
@@ -586,7 +606,7 @@ func (o *Physics2DDirectBodyState) IsSleeping() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "is_sleeping")
+	methodBind := methodBindPhysics2DDirectBodyStateIsSleeping.Get()
 
 	// Call the parent method.
 	// bool
@@ -599,8 +619,8 @@ func (o *Physics2DDirectBodyState) IsSleeping() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false velocity float}], Returns: void
+	        Undocumented
+		Args: [{ false velocity float}], Returns: void
 */
 func (o *Physics2DDirectBodyState) SetAngularVelocity(velocity gdnative.Real) {
 	//log.Println("Calling Physics2DDirectBodyState.SetAngularVelocity()")
@@ -610,7 +630,7 @@ func (o *Physics2DDirectBodyState) SetAngularVelocity(velocity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(velocity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "set_angular_velocity")
+	methodBind := methodBindPhysics2DDirectBodyStateSetAngularVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -620,8 +640,8 @@ func (o *Physics2DDirectBodyState) SetAngularVelocity(velocity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false velocity Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false velocity Vector2}], Returns: void
 */
 func (o *Physics2DDirectBodyState) SetLinearVelocity(velocity gdnative.Vector2) {
 	//log.Println("Calling Physics2DDirectBodyState.SetLinearVelocity()")
@@ -631,7 +651,7 @@ func (o *Physics2DDirectBodyState) SetLinearVelocity(velocity gdnative.Vector2)
 	ptrArguments[0] = gdnative.NewPointerFromVector2(velocity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "set_linear_velocity")
+	methodBind := methodBindPhysics2DDirectBodyStateSetLinearVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -641,8 +661,8 @@ func (o *Physics2DDirectBodyState) SetLinearVelocity(velocity gdnative.Vector2)
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Physics2DDirectBodyState) SetSleepState(enabled gdnative.Bool) {
 	//log.Println("Calling Physics2DDirectBodyState.SetSleepState()")
@@ -652,7 +672,7 @@ func (o *Physics2DDirectBodyState) SetSleepState(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "set_sleep_state")
+	methodBind := methodBindPhysics2DDirectBodyStateSetSleepState.Get()
 
 	// Call the parent method.
 	// void
@@ -662,8 +682,8 @@ func (o *Physics2DDirectBodyState) SetSleepState(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false transform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false transform Transform2D}], Returns: void
 */
 func (o *Physics2DDirectBodyState) SetTransform(transform gdnative.Transform2D) {
 	//log.Println("Calling Physics2DDirectBodyState.SetTransform()")
@@ -673,7 +693,7 @@ func (o *Physics2DDirectBodyState) SetTransform(transform gdnative.Transform2D)
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectBodyState", "set_transform")
+	methodBind := methodBindPhysics2DDirectBodyStateSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -682,14 +702,69 @@ func (o *Physics2DDirectBodyState) SetTransform(transform gdnative.Transform2D)
 
 }
 
+// AngularVelocity will return the value of the "angular_velocity" property.
+func (o *Physics2DDirectBodyState) AngularVelocity() gdnative.Real {
+	return o.GetAngularVelocity()
+}
+
+// InverseInertia will return the value of the "inverse_inertia" property.
+func (o *Physics2DDirectBodyState) InverseInertia() gdnative.Real {
+	return o.GetInverseInertia()
+}
+
+// InverseMass will return the value of the "inverse_mass" property.
+func (o *Physics2DDirectBodyState) InverseMass() gdnative.Real {
+	return o.GetInverseMass()
+}
+
+// LinearVelocity will return the value of the "linear_velocity" property.
+func (o *Physics2DDirectBodyState) LinearVelocity() gdnative.Vector2 {
+	return o.GetLinearVelocity()
+}
+
+// Sleeping will return the value of the "sleeping" property.
+func (o *Physics2DDirectBodyState) Sleeping() gdnative.Bool {
+	return o.IsSleeping()
+}
+
+// SetSleeping will set the value of the "sleeping" property.
+func (o *Physics2DDirectBodyState) SetSleeping(value gdnative.Bool) {
+	o.SetSleepState(value)
+}
+
+// Step will return the value of the "step" property.
+func (o *Physics2DDirectBodyState) Step() gdnative.Real {
+	return o.GetStep()
+}
+
+// TotalAngularDamp will return the value of the "total_angular_damp" property.
+func (o *Physics2DDirectBodyState) TotalAngularDamp() gdnative.Real {
+	return o.GetTotalAngularDamp()
+}
+
+// TotalGravity will return the value of the "total_gravity" property.
+func (o *Physics2DDirectBodyState) TotalGravity() gdnative.Vector2 {
+	return o.GetTotalGravity()
+}
+
+// TotalLinearDamp will return the value of the "total_linear_damp" property.
+func (o *Physics2DDirectBodyState) TotalLinearDamp() gdnative.Real {
+	return o.GetTotalLinearDamp()
+}
+
+// Transform will return the value of the "transform" property.
+func (o *Physics2DDirectBodyState) Transform() gdnative.Transform2D {
+	return o.GetTransform()
+}
+
 // Physics2DDirectBodyStateImplementer is an interface that implements the methods
 // of the Physics2DDirectBodyState class.
 type Physics2DDirectBodyStateImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	GetAngularVelocity() gdnative.Real
 	GetContactCollider(contactIdx gdnative.Int) gdnative.Rid
 	GetContactColliderId(contactIdx gdnative.Int) gdnative.Int
-	GetContactColliderObject(contactIdx gdnative.Int) ObjectImplementer
+	GetContactColliderObject(contactIdx gdnative.Int) godot.ObjectImplementer
 	GetContactColliderPosition(contactIdx gdnative.Int) gdnative.Vector2
 	GetContactColliderShape(contactIdx gdnative.Int) gdnative.Int
 	GetContactColliderShapeMetadata(contactIdx gdnative.Int) gdnative.Variant
@@ -701,7 +776,7 @@ type Physics2DDirectBodyStateImplementer interface {
 	GetInverseInertia() gdnative.Real
 	GetInverseMass() gdnative.Real
 	GetLinearVelocity() gdnative.Vector2
-	GetSpaceState() Physics2DDirectSpaceStateImplementer
+	GetSpaceState() godot.Physics2DDirectSpaceStateImplementer
 	GetStep() gdnative.Real
 	GetTotalAngularDamp() gdnative.Real
 	GetTotalGravity() gdnative.Vector2
@@ -713,4 +788,15 @@ type Physics2DDirectBodyStateImplementer interface {
 	SetLinearVelocity(velocity gdnative.Vector2)
 	SetSleepState(enabled gdnative.Bool)
 	SetTransform(transform gdnative.Transform2D)
+	AngularVelocity() gdnative.Real
+	InverseInertia() gdnative.Real
+	InverseMass() gdnative.Real
+	LinearVelocity() gdnative.Vector2
+	Sleeping() gdnative.Bool
+	SetSleeping(value gdnative.Bool)
+	Step() gdnative.Real
+	TotalAngularDamp() gdnative.Real
+	TotalGravity() gdnative.Vector2
+	TotalLinearDamp() gdnative.Real
+	Transform() gdnative.Transform2D
 }
@@ -1,4 +1,4 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysics2DDirectBodyStateSWFromPointer(ptr gdnative.Pointer) Physics2DDirectBodyStateSW {
-func newPhysics2DDirectBodyStateSWFromPointer(ptr gdnative.Pointer) Physics2DDirectBodyStateSW {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Physics2DDirectBodyStateSW{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Software implementation of [Physics2DDirectBodyState]. This object exposes no new methods or properties and should not be used, as [Physics2DDirectBodyState] selects the best implementation available.
 */
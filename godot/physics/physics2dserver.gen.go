@@ -1,7 +1,10 @@
-package godot
+package physics
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,6 +24,28 @@ const (
 	Physics2DServerAreaBodyRemoved Physics2DServerAreaBodyStatus = 1
 )
 
+// String will return the Godot name of the Physics2DServerAreaBodyStatus value.
+func (e Physics2DServerAreaBodyStatus) String() string {
+	switch e {
+	case Physics2DServerAreaBodyAdded:
+		return "AREA_BODY_ADDED"
+	case Physics2DServerAreaBodyRemoved:
+		return "AREA_BODY_REMOVED"
+	}
+	return fmt.Sprintf("Physics2DServerAreaBodyStatus(%d)", int(e))
+}
+
+// ParsePhysics2DServerAreaBodyStatus will return the Physics2DServerAreaBodyStatus value with the given Godot name.
+func ParsePhysics2DServerAreaBodyStatus(name string) (Physics2DServerAreaBodyStatus, error) {
+	switch name {
+	case "AREA_BODY_ADDED":
+		return Physics2DServerAreaBodyAdded, nil
+	case "AREA_BODY_REMOVED":
+		return Physics2DServerAreaBodyRemoved, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerAreaBodyStatus value %q", name)
+}
+
 // Physics2DServerAreaParameter is an enum for AreaParameter values.
 type Physics2DServerAreaParameter int
 
@@ -35,6 +60,52 @@ const (
 	Physics2DServerAreaParamPriority                Physics2DServerAreaParameter = 7
 )
 
+// String will return the Godot name of the Physics2DServerAreaParameter value.
+func (e Physics2DServerAreaParameter) String() string {
+	switch e {
+	case Physics2DServerAreaParamAngularDamp:
+		return "AREA_PARAM_ANGULAR_DAMP"
+	case Physics2DServerAreaParamGravity:
+		return "AREA_PARAM_GRAVITY"
+	case Physics2DServerAreaParamGravityDistanceScale:
+		return "AREA_PARAM_GRAVITY_DISTANCE_SCALE"
+	case Physics2DServerAreaParamGravityIsPoint:
+		return "AREA_PARAM_GRAVITY_IS_POINT"
+	case Physics2DServerAreaParamGravityPointAttenuation:
+		return "AREA_PARAM_GRAVITY_POINT_ATTENUATION"
+	case Physics2DServerAreaParamGravityVector:
+		return "AREA_PARAM_GRAVITY_VECTOR"
+	case Physics2DServerAreaParamLinearDamp:
+		return "AREA_PARAM_LINEAR_DAMP"
+	case Physics2DServerAreaParamPriority:
+		return "AREA_PARAM_PRIORITY"
+	}
+	return fmt.Sprintf("Physics2DServerAreaParameter(%d)", int(e))
+}
+
+// ParsePhysics2DServerAreaParameter will return the Physics2DServerAreaParameter value with the given Godot name.
+func ParsePhysics2DServerAreaParameter(name string) (Physics2DServerAreaParameter, error) {
+	switch name {
+	case "AREA_PARAM_ANGULAR_DAMP":
+		return Physics2DServerAreaParamAngularDamp, nil
+	case "AREA_PARAM_GRAVITY":
+		return Physics2DServerAreaParamGravity, nil
+	case "AREA_PARAM_GRAVITY_DISTANCE_SCALE":
+		return Physics2DServerAreaParamGravityDistanceScale, nil
+	case "AREA_PARAM_GRAVITY_IS_POINT":
+		return Physics2DServerAreaParamGravityIsPoint, nil
+	case "AREA_PARAM_GRAVITY_POINT_ATTENUATION":
+		return Physics2DServerAreaParamGravityPointAttenuation, nil
+	case "AREA_PARAM_GRAVITY_VECTOR":
+		return Physics2DServerAreaParamGravityVector, nil
+	case "AREA_PARAM_LINEAR_DAMP":
+		return Physics2DServerAreaParamLinearDamp, nil
+	case "AREA_PARAM_PRIORITY":
+		return Physics2DServerAreaParamPriority, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerAreaParameter value %q", name)
+}
+
 // Physics2DServerAreaSpaceOverrideMode is an enum for AreaSpaceOverrideMode values.
 type Physics2DServerAreaSpaceOverrideMode int
 
@@ -46,6 +117,40 @@ const (
 	Physics2DServerAreaSpaceOverrideReplaceCombine Physics2DServerAreaSpaceOverrideMode = 4
 )
 
+// String will return the Godot name of the Physics2DServerAreaSpaceOverrideMode value.
+func (e Physics2DServerAreaSpaceOverrideMode) String() string {
+	switch e {
+	case Physics2DServerAreaSpaceOverrideCombine:
+		return "AREA_SPACE_OVERRIDE_COMBINE"
+	case Physics2DServerAreaSpaceOverrideCombineReplace:
+		return "AREA_SPACE_OVERRIDE_COMBINE_REPLACE"
+	case Physics2DServerAreaSpaceOverrideDisabled:
+		return "AREA_SPACE_OVERRIDE_DISABLED"
+	case Physics2DServerAreaSpaceOverrideReplace:
+		return "AREA_SPACE_OVERRIDE_REPLACE"
+	case Physics2DServerAreaSpaceOverrideReplaceCombine:
+		return "AREA_SPACE_OVERRIDE_REPLACE_COMBINE"
+	}
+	return fmt.Sprintf("Physics2DServerAreaSpaceOverrideMode(%d)", int(e))
+}
+
+// ParsePhysics2DServerAreaSpaceOverrideMode will return the Physics2DServerAreaSpaceOverrideMode value with the given Godot name.
+func ParsePhysics2DServerAreaSpaceOverrideMode(name string) (Physics2DServerAreaSpaceOverrideMode, error) {
+	switch name {
+	case "AREA_SPACE_OVERRIDE_COMBINE":
+		return Physics2DServerAreaSpaceOverrideCombine, nil
+	case "AREA_SPACE_OVERRIDE_COMBINE_REPLACE":
+		return Physics2DServerAreaSpaceOverrideCombineReplace, nil
+	case "AREA_SPACE_OVERRIDE_DISABLED":
+		return Physics2DServerAreaSpaceOverrideDisabled, nil
+	case "AREA_SPACE_OVERRIDE_REPLACE":
+		return Physics2DServerAreaSpaceOverrideReplace, nil
+	case "AREA_SPACE_OVERRIDE_REPLACE_COMBINE":
+		return Physics2DServerAreaSpaceOverrideReplaceCombine, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerAreaSpaceOverrideMode value %q", name)
+}
+
 // Physics2DServerBodyMode is an enum for BodyMode values.
 type Physics2DServerBodyMode int
 
@@ -56,6 +161,36 @@ const (
 	Physics2DServerBodyModeStatic    Physics2DServerBodyMode = 0
 )
 
+// String will return the Godot name of the Physics2DServerBodyMode value.
+func (e Physics2DServerBodyMode) String() string {
+	switch e {
+	case Physics2DServerBodyModeCharacter:
+		return "BODY_MODE_CHARACTER"
+	case Physics2DServerBodyModeKinematic:
+		return "BODY_MODE_KINEMATIC"
+	case Physics2DServerBodyModeRigid:
+		return "BODY_MODE_RIGID"
+	case Physics2DServerBodyModeStatic:
+		return "BODY_MODE_STATIC"
+	}
+	return fmt.Sprintf("Physics2DServerBodyMode(%d)", int(e))
+}
+
+// ParsePhysics2DServerBodyMode will return the Physics2DServerBodyMode value with the given Godot name.
+func ParsePhysics2DServerBodyMode(name string) (Physics2DServerBodyMode, error) {
+	switch name {
+	case "BODY_MODE_CHARACTER":
+		return Physics2DServerBodyModeCharacter, nil
+	case "BODY_MODE_KINEMATIC":
+		return Physics2DServerBodyModeKinematic, nil
+	case "BODY_MODE_RIGID":
+		return Physics2DServerBodyModeRigid, nil
+	case "BODY_MODE_STATIC":
+		return Physics2DServerBodyModeStatic, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerBodyMode value %q", name)
+}
+
 // Physics2DServerBodyParameter is an enum for BodyParameter values.
 type Physics2DServerBodyParameter int
 
@@ -70,6 +205,52 @@ const (
 	Physics2DServerBodyParamMax          Physics2DServerBodyParameter = 7
 )
 
+// String will return the Godot name of the Physics2DServerBodyParameter value.
+func (e Physics2DServerBodyParameter) String() string {
+	switch e {
+	case Physics2DServerBodyParamAngularDamp:
+		return "BODY_PARAM_ANGULAR_DAMP"
+	case Physics2DServerBodyParamBounce:
+		return "BODY_PARAM_BOUNCE"
+	case Physics2DServerBodyParamFriction:
+		return "BODY_PARAM_FRICTION"
+	case Physics2DServerBodyParamGravityScale:
+		return "BODY_PARAM_GRAVITY_SCALE"
+	case Physics2DServerBodyParamInertia:
+		return "BODY_PARAM_INERTIA"
+	case Physics2DServerBodyParamLinearDamp:
+		return "BODY_PARAM_LINEAR_DAMP"
+	case Physics2DServerBodyParamMass:
+		return "BODY_PARAM_MASS"
+	case Physics2DServerBodyParamMax:
+		return "BODY_PARAM_MAX"
+	}
+	return fmt.Sprintf("Physics2DServerBodyParameter(%d)", int(e))
+}
+
+// ParsePhysics2DServerBodyParameter will return the Physics2DServerBodyParameter value with the given Godot name.
+func ParsePhysics2DServerBodyParameter(name string) (Physics2DServerBodyParameter, error) {
+	switch name {
+	case "BODY_PARAM_ANGULAR_DAMP":
+		return Physics2DServerBodyParamAngularDamp, nil
+	case "BODY_PARAM_BOUNCE":
+		return Physics2DServerBodyParamBounce, nil
+	case "BODY_PARAM_FRICTION":
+		return Physics2DServerBodyParamFriction, nil
+	case "BODY_PARAM_GRAVITY_SCALE":
+		return Physics2DServerBodyParamGravityScale, nil
+	case "BODY_PARAM_INERTIA":
+		return Physics2DServerBodyParamInertia, nil
+	case "BODY_PARAM_LINEAR_DAMP":
+		return Physics2DServerBodyParamLinearDamp, nil
+	case "BODY_PARAM_MASS":
+		return Physics2DServerBodyParamMass, nil
+	case "BODY_PARAM_MAX":
+		return Physics2DServerBodyParamMax, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerBodyParameter value %q", name)
+}
+
 // Physics2DServerBodyState is an enum for BodyState values.
 type Physics2DServerBodyState int
 
@@ -81,6 +262,40 @@ const (
 	Physics2DServerBodyStateTransform       Physics2DServerBodyState = 0
 )
 
+// String will return the Godot name of the Physics2DServerBodyState value.
+func (e Physics2DServerBodyState) String() string {
+	switch e {
+	case Physics2DServerBodyStateAngularVelocity:
+		return "BODY_STATE_ANGULAR_VELOCITY"
+	case Physics2DServerBodyStateCanSleep:
+		return "BODY_STATE_CAN_SLEEP"
+	case Physics2DServerBodyStateLinearVelocity:
+		return "BODY_STATE_LINEAR_VELOCITY"
+	case Physics2DServerBodyStateSleeping:
+		return "BODY_STATE_SLEEPING"
+	case Physics2DServerBodyStateTransform:
+		return "BODY_STATE_TRANSFORM"
+	}
+	return fmt.Sprintf("Physics2DServerBodyState(%d)", int(e))
+}
+
+// ParsePhysics2DServerBodyState will return the Physics2DServerBodyState value with the given Godot name.
+func ParsePhysics2DServerBodyState(name string) (Physics2DServerBodyState, error) {
+	switch name {
+	case "BODY_STATE_ANGULAR_VELOCITY":
+		return Physics2DServerBodyStateAngularVelocity, nil
+	case "BODY_STATE_CAN_SLEEP":
+		return Physics2DServerBodyStateCanSleep, nil
+	case "BODY_STATE_LINEAR_VELOCITY":
+		return Physics2DServerBodyStateLinearVelocity, nil
+	case "BODY_STATE_SLEEPING":
+		return Physics2DServerBodyStateSleeping, nil
+	case "BODY_STATE_TRANSFORM":
+		return Physics2DServerBodyStateTransform, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerBodyState value %q", name)
+}
+
 // Physics2DServerCCDMode is an enum for CCDMode values.
 type Physics2DServerCCDMode int
 
@@ -90,6 +305,32 @@ const (
 	Physics2DServerCcdModeDisabled  Physics2DServerCCDMode = 0
 )
 
+// String will return the Godot name of the Physics2DServerCCDMode value.
+func (e Physics2DServerCCDMode) String() string {
+	switch e {
+	case Physics2DServerCcdModeCastRay:
+		return "CCD_MODE_CAST_RAY"
+	case Physics2DServerCcdModeCastShape:
+		return "CCD_MODE_CAST_SHAPE"
+	case Physics2DServerCcdModeDisabled:
+		return "CCD_MODE_DISABLED"
+	}
+	return fmt.Sprintf("Physics2DServerCCDMode(%d)", int(e))
+}
+
+// ParsePhysics2DServerCCDMode will return the Physics2DServerCCDMode value with the given Godot name.
+func ParsePhysics2DServerCCDMode(name string) (Physics2DServerCCDMode, error) {
+	switch name {
+	case "CCD_MODE_CAST_RAY":
+		return Physics2DServerCcdModeCastRay, nil
+	case "CCD_MODE_CAST_SHAPE":
+		return Physics2DServerCcdModeCastShape, nil
+	case "CCD_MODE_DISABLED":
+		return Physics2DServerCcdModeDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerCCDMode value %q", name)
+}
+
 // Physics2DServerDampedStringParam is an enum for DampedStringParam values.
 type Physics2DServerDampedStringParam int
 
@@ -99,6 +340,32 @@ const (
 	Physics2DServerDampedStringStiffness  Physics2DServerDampedStringParam = 1
 )
 
+// String will return the Godot name of the Physics2DServerDampedStringParam value.
+func (e Physics2DServerDampedStringParam) String() string {
+	switch e {
+	case Physics2DServerDampedStringDamping:
+		return "DAMPED_STRING_DAMPING"
+	case Physics2DServerDampedStringRestLength:
+		return "DAMPED_STRING_REST_LENGTH"
+	case Physics2DServerDampedStringStiffness:
+		return "DAMPED_STRING_STIFFNESS"
+	}
+	return fmt.Sprintf("Physics2DServerDampedStringParam(%d)", int(e))
+}
+
+// ParsePhysics2DServerDampedStringParam will return the Physics2DServerDampedStringParam value with the given Godot name.
+func ParsePhysics2DServerDampedStringParam(name string) (Physics2DServerDampedStringParam, error) {
+	switch name {
+	case "DAMPED_STRING_DAMPING":
+		return Physics2DServerDampedStringDamping, nil
+	case "DAMPED_STRING_REST_LENGTH":
+		return Physics2DServerDampedStringRestLength, nil
+	case "DAMPED_STRING_STIFFNESS":
+		return Physics2DServerDampedStringStiffness, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerDampedStringParam value %q", name)
+}
+
 // Physics2DServerJointParam is an enum for JointParam values.
 type Physics2DServerJointParam int
 
@@ -108,6 +375,32 @@ const (
 	Physics2DServerJointParamMaxForce Physics2DServerJointParam = 2
 )
 
+// String will return the Godot name of the Physics2DServerJointParam value.
+func (e Physics2DServerJointParam) String() string {
+	switch e {
+	case Physics2DServerJointParamBias:
+		return "JOINT_PARAM_BIAS"
+	case Physics2DServerJointParamMaxBias:
+		return "JOINT_PARAM_MAX_BIAS"
+	case Physics2DServerJointParamMaxForce:
+		return "JOINT_PARAM_MAX_FORCE"
+	}
+	return fmt.Sprintf("Physics2DServerJointParam(%d)", int(e))
+}
+
+// ParsePhysics2DServerJointParam will return the Physics2DServerJointParam value with the given Godot name.
+func ParsePhysics2DServerJointParam(name string) (Physics2DServerJointParam, error) {
+	switch name {
+	case "JOINT_PARAM_BIAS":
+		return Physics2DServerJointParamBias, nil
+	case "JOINT_PARAM_MAX_BIAS":
+		return Physics2DServerJointParamMaxBias, nil
+	case "JOINT_PARAM_MAX_FORCE":
+		return Physics2DServerJointParamMaxForce, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerJointParam value %q", name)
+}
+
 // Physics2DServerJointType is an enum for JointType values.
 type Physics2DServerJointType int
 
@@ -117,6 +410,32 @@ const (
 	Physics2DServerJointPin          Physics2DServerJointType = 0
 )
 
+// String will return the Godot name of the Physics2DServerJointType value.
+func (e Physics2DServerJointType) String() string {
+	switch e {
+	case Physics2DServerJointDampedSpring:
+		return "JOINT_DAMPED_SPRING"
+	case Physics2DServerJointGroove:
+		return "JOINT_GROOVE"
+	case Physics2DServerJointPin:
+		return "JOINT_PIN"
+	}
+	return fmt.Sprintf("Physics2DServerJointType(%d)", int(e))
+}
+
+// ParsePhysics2DServerJointType will return the Physics2DServerJointType value with the given Godot name.
+func ParsePhysics2DServerJointType(name string) (Physics2DServerJointType, error) {
+	switch name {
+	case "JOINT_DAMPED_SPRING":
+		return Physics2DServerJointDampedSpring, nil
+	case "JOINT_GROOVE":
+		return Physics2DServerJointGroove, nil
+	case "JOINT_PIN":
+		return Physics2DServerJointPin, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerJointType value %q", name)
+}
+
 // Physics2DServerProcessInfo is an enum for ProcessInfo values.
 type Physics2DServerProcessInfo int
 
@@ -126,6 +445,32 @@ const (
 	Physics2DServerInfoIslandCount    Physics2DServerProcessInfo = 2
 )
 
+// String will return the Godot name of the Physics2DServerProcessInfo value.
+func (e Physics2DServerProcessInfo) String() string {
+	switch e {
+	case Physics2DServerInfoActiveObjects:
+		return "INFO_ACTIVE_OBJECTS"
+	case Physics2DServerInfoCollisionPairs:
+		return "INFO_COLLISION_PAIRS"
+	case Physics2DServerInfoIslandCount:
+		return "INFO_ISLAND_COUNT"
+	}
+	return fmt.Sprintf("Physics2DServerProcessInfo(%d)", int(e))
+}
+
+// ParsePhysics2DServerProcessInfo will return the Physics2DServerProcessInfo value with the given Godot name.
+func ParsePhysics2DServerProcessInfo(name string) (Physics2DServerProcessInfo, error) {
+	switch name {
+	case "INFO_ACTIVE_OBJECTS":
+		return Physics2DServerInfoActiveObjects, nil
+	case "INFO_COLLISION_PAIRS":
+		return Physics2DServerInfoCollisionPairs, nil
+	case "INFO_ISLAND_COUNT":
+		return Physics2DServerInfoIslandCount, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerProcessInfo value %q", name)
+}
+
 // Physics2DServerShapeType is an enum for ShapeType values.
 type Physics2DServerShapeType int
 
@@ -141,6 +486,56 @@ const (
 	Physics2DServerShapeSegment        Physics2DServerShapeType = 2
 )
 
+// String will return the Godot name of the Physics2DServerShapeType value.
+func (e Physics2DServerShapeType) String() string {
+	switch e {
+	case Physics2DServerShapeCapsule:
+		return "SHAPE_CAPSULE"
+	case Physics2DServerShapeCircle:
+		return "SHAPE_CIRCLE"
+	case Physics2DServerShapeConcavePolygon:
+		return "SHAPE_CONCAVE_POLYGON"
+	case Physics2DServerShapeConvexPolygon:
+		return "SHAPE_CONVEX_POLYGON"
+	case Physics2DServerShapeCustom:
+		return "SHAPE_CUSTOM"
+	case Physics2DServerShapeLine:
+		return "SHAPE_LINE"
+	case Physics2DServerShapeRay:
+		return "SHAPE_RAY"
+	case Physics2DServerShapeRectangle:
+		return "SHAPE_RECTANGLE"
+	case Physics2DServerShapeSegment:
+		return "SHAPE_SEGMENT"
+	}
+	return fmt.Sprintf("Physics2DServerShapeType(%d)", int(e))
+}
+
+// ParsePhysics2DServerShapeType will return the Physics2DServerShapeType value with the given Godot name.
+func ParsePhysics2DServerShapeType(name string) (Physics2DServerShapeType, error) {
+	switch name {
+	case "SHAPE_CAPSULE":
+		return Physics2DServerShapeCapsule, nil
+	case "SHAPE_CIRCLE":
+		return Physics2DServerShapeCircle, nil
+	case "SHAPE_CONCAVE_POLYGON":
+		return Physics2DServerShapeConcavePolygon, nil
+	case "SHAPE_CONVEX_POLYGON":
+		return Physics2DServerShapeConvexPolygon, nil
+	case "SHAPE_CUSTOM":
+		return Physics2DServerShapeCustom, nil
+	case "SHAPE_LINE":
+		return Physics2DServerShapeLine, nil
+	case "SHAPE_RAY":
+		return Physics2DServerShapeRay, nil
+	case "SHAPE_RECTANGLE":
+		return Physics2DServerShapeRectangle, nil
+	case "SHAPE_SEGMENT":
+		return Physics2DServerShapeSegment, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerShapeType value %q", name)
+}
+
 // Physics2DServerSpaceParameter is an enum for SpaceParameter values.
 type Physics2DServerSpaceParameter int
 
@@ -154,13 +549,46 @@ const (
 	Physics2DServerSpaceParamContactRecycleRadius              Physics2DServerSpaceParameter = 0
 )
 
-//func Newphysics2DServerFromPointer(ptr gdnative.Pointer) physics2DServer {
-func newPhysics2DServerFromPointer(ptr gdnative.Pointer) physics2DServer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := physics2DServer{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the Physics2DServerSpaceParameter value.
+func (e Physics2DServerSpaceParameter) String() string {
+	switch e {
+	case Physics2DServerSpaceParamBodyAngularVelocitySleepThreshold:
+		return "SPACE_PARAM_BODY_ANGULAR_VELOCITY_SLEEP_THRESHOLD"
+	case Physics2DServerSpaceParamBodyLinearVelocitySleepThreshold:
+		return "SPACE_PARAM_BODY_LINEAR_VELOCITY_SLEEP_THRESHOLD"
+	case Physics2DServerSpaceParamBodyMaxAllowedPenetration:
+		return "SPACE_PARAM_BODY_MAX_ALLOWED_PENETRATION"
+	case Physics2DServerSpaceParamBodyTimeToSleep:
+		return "SPACE_PARAM_BODY_TIME_TO_SLEEP"
+	case Physics2DServerSpaceParamConstraintDefaultBias:
+		return "SPACE_PARAM_CONSTRAINT_DEFAULT_BIAS"
+	case Physics2DServerSpaceParamContactMaxSeparation:
+		return "SPACE_PARAM_CONTACT_MAX_SEPARATION"
+	case Physics2DServerSpaceParamContactRecycleRadius:
+		return "SPACE_PARAM_CONTACT_RECYCLE_RADIUS"
+	}
+	return fmt.Sprintf("Physics2DServerSpaceParameter(%d)", int(e))
+}
+
+// ParsePhysics2DServerSpaceParameter will return the Physics2DServerSpaceParameter value with the given Godot name.
+func ParsePhysics2DServerSpaceParameter(name string) (Physics2DServerSpaceParameter, error) {
+	switch name {
+	case "SPACE_PARAM_BODY_ANGULAR_VELOCITY_SLEEP_THRESHOLD":
+		return Physics2DServerSpaceParamBodyAngularVelocitySleepThreshold, nil
+	case "SPACE_PARAM_BODY_LINEAR_VELOCITY_SLEEP_THRESHOLD":
+		return Physics2DServerSpaceParamBodyLinearVelocitySleepThreshold, nil
+	case "SPACE_PARAM_BODY_MAX_ALLOWED_PENETRATION":
+		return Physics2DServerSpaceParamBodyMaxAllowedPenetration, nil
+	case "SPACE_PARAM_BODY_TIME_TO_SLEEP":
+		return Physics2DServerSpaceParamBodyTimeToSleep, nil
+	case "SPACE_PARAM_CONSTRAINT_DEFAULT_BIAS":
+		return Physics2DServerSpaceParamConstraintDefaultBias, nil
+	case "SPACE_PARAM_CONTACT_MAX_SEPARATION":
+		return Physics2DServerSpaceParamContactMaxSeparation, nil
+	case "SPACE_PARAM_CONTACT_RECYCLE_RADIUS":
+		return Physics2DServerSpaceParamContactRecycleRadius, nil
+	}
+	return 0, fmt.Errorf("invalid Physics2DServerSpaceParameter value %q", name)
 }
 
 func newSingletonPhysics2DServer() *physics2DServer {
@@ -168,7 +596,7 @@ func newSingletonPhysics2DServer() *physics2DServer {
 }
 
 /*
-   Physics 2D Server is the server responsible for all 2D physics. It can create many kinds of physics objects, but does not insert them on the node tree.
+Physics 2D Server is the server responsible for all 2D physics. It can create many kinds of physics objects, but does not insert them on the node tree.
 */
 var Physics2DServer = newSingletonPhysics2DServer()
 
@@ -176,7 +604,7 @@ var Physics2DServer = newSingletonPhysics2DServer()
 Physics 2D Server is the server responsible for all 2D physics. It can create many kinds of physics objects, but does not insert them on the node tree.
 */
 type physics2DServer struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -197,9 +625,106 @@ func (o *physics2DServer) BaseClass() string {
 	return "Physics2DServer"
 }
 
+// Method binds of the Physics2DServer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysics2DServerAreaAddShape                            = gdnative.NewLazyMethodBind("Physics2DServer", "area_add_shape")
+	methodBindPhysics2DServerAreaAttachObjectInstanceId              = gdnative.NewLazyMethodBind("Physics2DServer", "area_attach_object_instance_id")
+	methodBindPhysics2DServerAreaClearShapes                         = gdnative.NewLazyMethodBind("Physics2DServer", "area_clear_shapes")
+	methodBindPhysics2DServerAreaCreate                              = gdnative.NewLazyMethodBind("Physics2DServer", "area_create")
+	methodBindPhysics2DServerAreaGetObjectInstanceId                 = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_object_instance_id")
+	methodBindPhysics2DServerAreaGetParam                            = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_param")
+	methodBindPhysics2DServerAreaGetShape                            = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_shape")
+	methodBindPhysics2DServerAreaGetShapeCount                       = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_shape_count")
+	methodBindPhysics2DServerAreaGetShapeTransform                   = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_shape_transform")
+	methodBindPhysics2DServerAreaGetSpace                            = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_space")
+	methodBindPhysics2DServerAreaGetSpaceOverrideMode                = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_space_override_mode")
+	methodBindPhysics2DServerAreaGetTransform                        = gdnative.NewLazyMethodBind("Physics2DServer", "area_get_transform")
+	methodBindPhysics2DServerAreaRemoveShape                         = gdnative.NewLazyMethodBind("Physics2DServer", "area_remove_shape")
+	methodBindPhysics2DServerAreaSetCollisionLayer                   = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_collision_layer")
+	methodBindPhysics2DServerAreaSetCollisionMask                    = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_collision_mask")
+	methodBindPhysics2DServerAreaSetMonitorCallback                  = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_monitor_callback")
+	methodBindPhysics2DServerAreaSetParam                            = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_param")
+	methodBindPhysics2DServerAreaSetShape                            = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_shape")
+	methodBindPhysics2DServerAreaSetShapeDisabled                    = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_shape_disabled")
+	methodBindPhysics2DServerAreaSetShapeTransform                   = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_shape_transform")
+	methodBindPhysics2DServerAreaSetSpace                            = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_space")
+	methodBindPhysics2DServerAreaSetSpaceOverrideMode                = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_space_override_mode")
+	methodBindPhysics2DServerAreaSetTransform                        = gdnative.NewLazyMethodBind("Physics2DServer", "area_set_transform")
+	methodBindPhysics2DServerBodyAddCollisionException               = gdnative.NewLazyMethodBind("Physics2DServer", "body_add_collision_exception")
+	methodBindPhysics2DServerBodyAddForce                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_add_force")
+	methodBindPhysics2DServerBodyAddShape                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_add_shape")
+	methodBindPhysics2DServerBodyApplyImpulse                        = gdnative.NewLazyMethodBind("Physics2DServer", "body_apply_impulse")
+	methodBindPhysics2DServerBodyAttachObjectInstanceId              = gdnative.NewLazyMethodBind("Physics2DServer", "body_attach_object_instance_id")
+	methodBindPhysics2DServerBodyClearShapes                         = gdnative.NewLazyMethodBind("Physics2DServer", "body_clear_shapes")
+	methodBindPhysics2DServerBodyCreate                              = gdnative.NewLazyMethodBind("Physics2DServer", "body_create")
+	methodBindPhysics2DServerBodyGetCollisionLayer                   = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_collision_layer")
+	methodBindPhysics2DServerBodyGetCollisionMask                    = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_collision_mask")
+	methodBindPhysics2DServerBodyGetContinuousCollisionDetectionMode = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_continuous_collision_detection_mode")
+	methodBindPhysics2DServerBodyGetDirectState                      = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_direct_state")
+	methodBindPhysics2DServerBodyGetMaxContactsReported              = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_max_contacts_reported")
+	methodBindPhysics2DServerBodyGetMode                             = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_mode")
+	methodBindPhysics2DServerBodyGetObjectInstanceId                 = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_object_instance_id")
+	methodBindPhysics2DServerBodyGetParam                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_param")
+	methodBindPhysics2DServerBodyGetShape                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_shape")
+	methodBindPhysics2DServerBodyGetShapeCount                       = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_shape_count")
+	methodBindPhysics2DServerBodyGetShapeMetadata                    = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_shape_metadata")
+	methodBindPhysics2DServerBodyGetShapeTransform                   = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_shape_transform")
+	methodBindPhysics2DServerBodyGetSpace                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_space")
+	methodBindPhysics2DServerBodyGetState                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_get_state")
+	methodBindPhysics2DServerBodyIsOmittingForceIntegration          = gdnative.NewLazyMethodBind("Physics2DServer", "body_is_omitting_force_integration")
+	methodBindPhysics2DServerBodyRemoveCollisionException            = gdnative.NewLazyMethodBind("Physics2DServer", "body_remove_collision_exception")
+	methodBindPhysics2DServerBodyRemoveShape                         = gdnative.NewLazyMethodBind("Physics2DServer", "body_remove_shape")
+	methodBindPhysics2DServerBodySetAxisVelocity                     = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_axis_velocity")
+	methodBindPhysics2DServerBodySetCollisionLayer                   = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_collision_layer")
+	methodBindPhysics2DServerBodySetCollisionMask                    = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_collision_mask")
+	methodBindPhysics2DServerBodySetContinuousCollisionDetectionMode = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_continuous_collision_detection_mode")
+	methodBindPhysics2DServerBodySetForceIntegrationCallback         = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_force_integration_callback")
+	methodBindPhysics2DServerBodySetMaxContactsReported              = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_max_contacts_reported")
+	methodBindPhysics2DServerBodySetMode                             = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_mode")
+	methodBindPhysics2DServerBodySetOmitForceIntegration             = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_omit_force_integration")
+	methodBindPhysics2DServerBodySetParam                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_param")
+	methodBindPhysics2DServerBodySetShape                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_shape")
+	methodBindPhysics2DServerBodySetShapeAsOneWayCollision           = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_shape_as_one_way_collision")
+	methodBindPhysics2DServerBodySetShapeDisabled                    = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_shape_disabled")
+	methodBindPhysics2DServerBodySetShapeMetadata                    = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_shape_metadata")
+	methodBindPhysics2DServerBodySetShapeTransform                   = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_shape_transform")
+	methodBindPhysics2DServerBodySetSpace                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_space")
+	methodBindPhysics2DServerBodySetState                            = gdnative.NewLazyMethodBind("Physics2DServer", "body_set_state")
+	methodBindPhysics2DServerBodyTestMotion                          = gdnative.NewLazyMethodBind("Physics2DServer", "body_test_motion")
+	methodBindPhysics2DServerCapsuleShapeCreate                      = gdnative.NewLazyMethodBind("Physics2DServer", "capsule_shape_create")
+	methodBindPhysics2DServerCircleShapeCreate                       = gdnative.NewLazyMethodBind("Physics2DServer", "circle_shape_create")
+	methodBindPhysics2DServerConcavePolygonShapeCreate               = gdnative.NewLazyMethodBind("Physics2DServer", "concave_polygon_shape_create")
+	methodBindPhysics2DServerConvexPolygonShapeCreate                = gdnative.NewLazyMethodBind("Physics2DServer", "convex_polygon_shape_create")
+	methodBindPhysics2DServerDampedSpringJointCreate                 = gdnative.NewLazyMethodBind("Physics2DServer", "damped_spring_joint_create")
+	methodBindPhysics2DServerDampedStringJointGetParam               = gdnative.NewLazyMethodBind("Physics2DServer", "damped_string_joint_get_param")
+	methodBindPhysics2DServerDampedStringJointSetParam               = gdnative.NewLazyMethodBind("Physics2DServer", "damped_string_joint_set_param")
+	methodBindPhysics2DServerFreeRid                                 = gdnative.NewLazyMethodBind("Physics2DServer", "free_rid")
+	methodBindPhysics2DServerGetProcessInfo                          = gdnative.NewLazyMethodBind("Physics2DServer", "get_process_info")
+	methodBindPhysics2DServerGrooveJointCreate                       = gdnative.NewLazyMethodBind("Physics2DServer", "groove_joint_create")
+	methodBindPhysics2DServerJointGetParam                           = gdnative.NewLazyMethodBind("Physics2DServer", "joint_get_param")
+	methodBindPhysics2DServerJointGetType                            = gdnative.NewLazyMethodBind("Physics2DServer", "joint_get_type")
+	methodBindPhysics2DServerJointSetParam                           = gdnative.NewLazyMethodBind("Physics2DServer", "joint_set_param")
+	methodBindPhysics2DServerLineShapeCreate                         = gdnative.NewLazyMethodBind("Physics2DServer", "line_shape_create")
+	methodBindPhysics2DServerPinJointCreate                          = gdnative.NewLazyMethodBind("Physics2DServer", "pin_joint_create")
+	methodBindPhysics2DServerRayShapeCreate                          = gdnative.NewLazyMethodBind("Physics2DServer", "ray_shape_create")
+	methodBindPhysics2DServerRectangleShapeCreate                    = gdnative.NewLazyMethodBind("Physics2DServer", "rectangle_shape_create")
+	methodBindPhysics2DServerSegmentShapeCreate                      = gdnative.NewLazyMethodBind("Physics2DServer", "segment_shape_create")
+	methodBindPhysics2DServerSetActive                               = gdnative.NewLazyMethodBind("Physics2DServer", "set_active")
+	methodBindPhysics2DServerShapeGetData                            = gdnative.NewLazyMethodBind("Physics2DServer", "shape_get_data")
+	methodBindPhysics2DServerShapeGetType                            = gdnative.NewLazyMethodBind("Physics2DServer", "shape_get_type")
+	methodBindPhysics2DServerShapeSetData                            = gdnative.NewLazyMethodBind("Physics2DServer", "shape_set_data")
+	methodBindPhysics2DServerSpaceCreate                             = gdnative.NewLazyMethodBind("Physics2DServer", "space_create")
+	methodBindPhysics2DServerSpaceGetDirectState                     = gdnative.NewLazyMethodBind("Physics2DServer", "space_get_direct_state")
+	methodBindPhysics2DServerSpaceGetParam                           = gdnative.NewLazyMethodBind("Physics2DServer", "space_get_param")
+	methodBindPhysics2DServerSpaceIsActive                           = gdnative.NewLazyMethodBind("Physics2DServer", "space_is_active")
+	methodBindPhysics2DServerSpaceSetActive                          = gdnative.NewLazyMethodBind("Physics2DServer", "space_set_active")
+	methodBindPhysics2DServerSpaceSetParam                           = gdnative.NewLazyMethodBind("Physics2DServer", "space_set_param")
+)
+
 /*
-        Adds a shape to the area, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
-	Args: [{ false area RID} { false shape RID} {((1, 0), (0, 1), (0, 0)) true transform Transform2D}], Returns: void
+	        Adds a shape to the area, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
+		Args: [{ false area RID} { false shape RID} {((1, 0), (0, 1), (0, 0)) true transform Transform2D}], Returns: void
 */
 func (o *physics2DServer) AreaAddShape(area gdnative.Rid, shape gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -212,7 +737,7 @@ func (o *physics2DServer) AreaAddShape(area gdnative.Rid, shape gdnative.Rid, tr
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_add_shape")
+	methodBind := methodBindPhysics2DServerAreaAddShape.Get()
 
 	// Call the parent method.
 	// void
@@ -222,8 +747,8 @@ func (o *physics2DServer) AreaAddShape(area gdnative.Rid, shape gdnative.Rid, tr
 }
 
 /*
-        Assigns the area to a descendant of [Object], so it can exist in the node tree.
-	Args: [{ false area RID} { false id int}], Returns: void
+	        Assigns the area to a descendant of [Object], so it can exist in the node tree.
+		Args: [{ false area RID} { false id int}], Returns: void
 */
 func (o *physics2DServer) AreaAttachObjectInstanceId(area gdnative.Rid, id gdnative.Int) {
 	o.ensureSingleton()
@@ -235,7 +760,7 @@ func (o *physics2DServer) AreaAttachObjectInstanceId(area gdnative.Rid, id gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_attach_object_instance_id")
+	methodBind := methodBindPhysics2DServerAreaAttachObjectInstanceId.Get()
 
 	// Call the parent method.
 	// void
@@ -245,8 +770,8 @@ func (o *physics2DServer) AreaAttachObjectInstanceId(area gdnative.Rid, id gdnat
 }
 
 /*
-        Removes all shapes from an area. It does not delete the shapes, so they can be reassigned later.
-	Args: [{ false area RID}], Returns: void
+	        Removes all shapes from an area. It does not delete the shapes, so they can be reassigned later.
+		Args: [{ false area RID}], Returns: void
 */
 func (o *physics2DServer) AreaClearShapes(area gdnative.Rid) {
 	o.ensureSingleton()
@@ -257,7 +782,7 @@ func (o *physics2DServer) AreaClearShapes(area gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_clear_shapes")
+	methodBind := methodBindPhysics2DServerAreaClearShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -267,8 +792,8 @@ func (o *physics2DServer) AreaClearShapes(area gdnative.Rid) {
 }
 
 /*
-        Creates an [Area2D].
-	Args: [], Returns: RID
+	        Creates an [Area2D].
+		Args: [], Returns: RID
 */
 func (o *physics2DServer) AreaCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -278,7 +803,7 @@ func (o *physics2DServer) AreaCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_create")
+	methodBind := methodBindPhysics2DServerAreaCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -291,8 +816,8 @@ func (o *physics2DServer) AreaCreate() gdnative.Rid {
 }
 
 /*
-        Gets the instance ID of the object the area is assigned to.
-	Args: [{ false area RID}], Returns: int
+	        Gets the instance ID of the object the area is assigned to.
+		Args: [{ false area RID}], Returns: int
 */
 func (o *physics2DServer) AreaGetObjectInstanceId(area gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -303,7 +828,7 @@ func (o *physics2DServer) AreaGetObjectInstanceId(area gdnative.Rid) gdnative.In
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_object_instance_id")
+	methodBind := methodBindPhysics2DServerAreaGetObjectInstanceId.Get()
 
 	// Call the parent method.
 	// int
@@ -316,8 +841,8 @@ func (o *physics2DServer) AreaGetObjectInstanceId(area gdnative.Rid) gdnative.In
 }
 
 /*
-        Returns an area parameter value. A list of available parameters is on the AREA_PARAM_* constants.
-	Args: [{ false area RID} { false param int}], Returns: Variant
+	        Returns an area parameter value. A list of available parameters is on the AREA_PARAM_* constants.
+		Args: [{ false area RID} { false param int}], Returns: Variant
 */
 func (o *physics2DServer) AreaGetParam(area gdnative.Rid, param gdnative.Int) gdnative.Variant {
 	o.ensureSingleton()
@@ -329,7 +854,7 @@ func (o *physics2DServer) AreaGetParam(area gdnative.Rid, param gdnative.Int) gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_param")
+	methodBind := methodBindPhysics2DServerAreaGetParam.Get()
 
 	// Call the parent method.
 	// Variant
@@ -342,8 +867,8 @@ func (o *physics2DServer) AreaGetParam(area gdnative.Rid, param gdnative.Int) gd
 }
 
 /*
-        Returns the [RID] of the nth shape of an area.
-	Args: [{ false area RID} { false shape_idx int}], Returns: RID
+	        Returns the [RID] of the nth shape of an area.
+		Args: [{ false area RID} { false shape_idx int}], Returns: RID
 */
 func (o *physics2DServer) AreaGetShape(area gdnative.Rid, shapeIdx gdnative.Int) gdnative.Rid {
 	o.ensureSingleton()
@@ -355,7 +880,7 @@ func (o *physics2DServer) AreaGetShape(area gdnative.Rid, shapeIdx gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_shape")
+	methodBind := methodBindPhysics2DServerAreaGetShape.Get()
 
 	// Call the parent method.
 	// RID
@@ -368,8 +893,8 @@ func (o *physics2DServer) AreaGetShape(area gdnative.Rid, shapeIdx gdnative.Int)
 }
 
 /*
-        Returns the number of shapes assigned to an area.
-	Args: [{ false area RID}], Returns: int
+	        Returns the number of shapes assigned to an area.
+		Args: [{ false area RID}], Returns: int
 */
 func (o *physics2DServer) AreaGetShapeCount(area gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -380,7 +905,7 @@ func (o *physics2DServer) AreaGetShapeCount(area gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_shape_count")
+	methodBind := methodBindPhysics2DServerAreaGetShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -393,8 +918,8 @@ func (o *physics2DServer) AreaGetShapeCount(area gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the transform matrix of a shape within an area.
-	Args: [{ false area RID} { false shape_idx int}], Returns: Transform2D
+	        Returns the transform matrix of a shape within an area.
+		Args: [{ false area RID} { false shape_idx int}], Returns: Transform2D
 */
 func (o *physics2DServer) AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdnative.Int) gdnative.Transform2D {
 	o.ensureSingleton()
@@ -406,7 +931,7 @@ func (o *physics2DServer) AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_shape_transform")
+	methodBind := methodBindPhysics2DServerAreaGetShapeTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -419,8 +944,8 @@ func (o *physics2DServer) AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdna
 }
 
 /*
-        Returns the space assigned to the area.
-	Args: [{ false area RID}], Returns: RID
+	        Returns the space assigned to the area.
+		Args: [{ false area RID}], Returns: RID
 */
 func (o *physics2DServer) AreaGetSpace(area gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -431,7 +956,7 @@ func (o *physics2DServer) AreaGetSpace(area gdnative.Rid) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_space")
+	methodBind := methodBindPhysics2DServerAreaGetSpace.Get()
 
 	// Call the parent method.
 	// RID
@@ -444,8 +969,8 @@ func (o *physics2DServer) AreaGetSpace(area gdnative.Rid) gdnative.Rid {
 }
 
 /*
-        Returns the space override mode for the area.
-	Args: [{ false area RID}], Returns: enum.Physics2DServer::AreaSpaceOverrideMode
+	        Returns the space override mode for the area.
+		Args: [{ false area RID}], Returns: enum.Physics2DServer::AreaSpaceOverrideMode
 */
 func (o *physics2DServer) AreaGetSpaceOverrideMode(area gdnative.Rid) Physics2DServerAreaSpaceOverrideMode {
 	o.ensureSingleton()
@@ -456,7 +981,7 @@ func (o *physics2DServer) AreaGetSpaceOverrideMode(area gdnative.Rid) Physics2DS
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_space_override_mode")
+	methodBind := methodBindPhysics2DServerAreaGetSpaceOverrideMode.Get()
 
 	// Call the parent method.
 	// enum.Physics2DServer::AreaSpaceOverrideMode
@@ -469,8 +994,8 @@ func (o *physics2DServer) AreaGetSpaceOverrideMode(area gdnative.Rid) Physics2DS
 }
 
 /*
-        Returns the transform matrix for an area.
-	Args: [{ false area RID}], Returns: Transform2D
+	        Returns the transform matrix for an area.
+		Args: [{ false area RID}], Returns: Transform2D
 */
 func (o *physics2DServer) AreaGetTransform(area gdnative.Rid) gdnative.Transform2D {
 	o.ensureSingleton()
@@ -481,7 +1006,7 @@ func (o *physics2DServer) AreaGetTransform(area gdnative.Rid) gdnative.Transform
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_get_transform")
+	methodBind := methodBindPhysics2DServerAreaGetTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -494,8 +1019,8 @@ func (o *physics2DServer) AreaGetTransform(area gdnative.Rid) gdnative.Transform
 }
 
 /*
-        Removes a shape from an area. It does not delete the shape, so it can be reassigned later.
-	Args: [{ false area RID} { false shape_idx int}], Returns: void
+	        Removes a shape from an area. It does not delete the shape, so it can be reassigned later.
+		Args: [{ false area RID} { false shape_idx int}], Returns: void
 */
 func (o *physics2DServer) AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.Int) {
 	o.ensureSingleton()
@@ -507,7 +1032,7 @@ func (o *physics2DServer) AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.I
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_remove_shape")
+	methodBind := methodBindPhysics2DServerAreaRemoveShape.Get()
 
 	// Call the parent method.
 	// void
@@ -517,8 +1042,8 @@ func (o *physics2DServer) AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.I
 }
 
 /*
-        Assigns the area to one or many physics layers.
-	Args: [{ false area RID} { false layer int}], Returns: void
+	        Assigns the area to one or many physics layers.
+		Args: [{ false area RID} { false layer int}], Returns: void
 */
 func (o *physics2DServer) AreaSetCollisionLayer(area gdnative.Rid, layer gdnative.Int) {
 	o.ensureSingleton()
@@ -530,7 +1055,7 @@ func (o *physics2DServer) AreaSetCollisionLayer(area gdnative.Rid, layer gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_collision_layer")
+	methodBind := methodBindPhysics2DServerAreaSetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -540,8 +1065,8 @@ func (o *physics2DServer) AreaSetCollisionLayer(area gdnative.Rid, layer gdnativ
 }
 
 /*
-        Sets which physics layers the area will monitor.
-	Args: [{ false area RID} { false mask int}], Returns: void
+	        Sets which physics layers the area will monitor.
+		Args: [{ false area RID} { false mask int}], Returns: void
 */
 func (o *physics2DServer) AreaSetCollisionMask(area gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -553,7 +1078,7 @@ func (o *physics2DServer) AreaSetCollisionMask(area gdnative.Rid, mask gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_collision_mask")
+	methodBind := methodBindPhysics2DServerAreaSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -563,21 +1088,21 @@ func (o *physics2DServer) AreaSetCollisionMask(area gdnative.Rid, mask gdnative.
 }
 
 /*
-        Sets the function to call when any body/area enters or exits the area. This callback will be called for any object interacting with the area, and takes five parameters: 1: AREA_BODY_ADDED or AREA_BODY_REMOVED, depending on whether the object entered or exited the area. 2: [RID] of the object that entered/exited the area. 3: Instance ID of the object that entered/exited the area. 4: The shape index of the object that entered/exited the area. 5: The shape index of the area where the object entered/exited.
-	Args: [{ false area RID} { false receiver Object} { false method String}], Returns: void
+	        Sets the function to call when any body/area enters or exits the area. This callback will be called for any object interacting with the area, and takes five parameters: 1: AREA_BODY_ADDED or AREA_BODY_REMOVED, depending on whether the object entered or exited the area. 2: [RID] of the object that entered/exited the area. 3: Instance ID of the object that entered/exited the area. 4: The shape index of the object that entered/exited the area. 5: The shape index of the area where the object entered/exited.
+		Args: [{ false area RID} { false receiver Object} { false method String}], Returns: void
 */
-func (o *physics2DServer) AreaSetMonitorCallback(area gdnative.Rid, receiver ObjectImplementer, method gdnative.String) {
+func (o *physics2DServer) AreaSetMonitorCallback(area gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String) {
 	o.ensureSingleton()
 	//log.Println("Calling Physics2DServer.AreaSetMonitorCallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
-	ptrArguments[1] = gdnative.NewPointerFromObject(receiver.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(receiver))
 	ptrArguments[2] = gdnative.NewPointerFromString(method)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_monitor_callback")
+	methodBind := methodBindPhysics2DServerAreaSetMonitorCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -587,8 +1112,8 @@ func (o *physics2DServer) AreaSetMonitorCallback(area gdnative.Rid, receiver Obj
 }
 
 /*
-        Sets the value for an area parameter. A list of available parameters is on the AREA_PARAM_* constants.
-	Args: [{ false area RID} { false param int} { false value Variant}], Returns: void
+	        Sets the value for an area parameter. A list of available parameters is on the AREA_PARAM_* constants.
+		Args: [{ false area RID} { false param int} { false value Variant}], Returns: void
 */
 func (o *physics2DServer) AreaSetParam(area gdnative.Rid, param gdnative.Int, value gdnative.Variant) {
 	o.ensureSingleton()
@@ -601,7 +1126,7 @@ func (o *physics2DServer) AreaSetParam(area gdnative.Rid, param gdnative.Int, va
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_param")
+	methodBind := methodBindPhysics2DServerAreaSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -611,8 +1136,8 @@ func (o *physics2DServer) AreaSetParam(area gdnative.Rid, param gdnative.Int, va
 }
 
 /*
-        Substitutes a given area shape by another. The old shape is selected by its index, the new one by its [RID].
-	Args: [{ false area RID} { false shape_idx int} { false shape RID}], Returns: void
+	        Substitutes a given area shape by another. The old shape is selected by its index, the new one by its [RID].
+		Args: [{ false area RID} { false shape_idx int} { false shape RID}], Returns: void
 */
 func (o *physics2DServer) AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int, shape gdnative.Rid) {
 	o.ensureSingleton()
@@ -625,7 +1150,7 @@ func (o *physics2DServer) AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_shape")
+	methodBind := methodBindPhysics2DServerAreaSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -635,8 +1160,8 @@ func (o *physics2DServer) AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int,
 }
 
 /*
-        Disables a given shape in an area.
-	Args: [{ false area RID} { false shape_idx int} { false disable bool}], Returns: void
+	        Disables a given shape in an area.
+		Args: [{ false area RID} { false shape_idx int} { false disable bool}], Returns: void
 */
 func (o *physics2DServer) AreaSetShapeDisabled(area gdnative.Rid, shapeIdx gdnative.Int, disable gdnative.Bool) {
 	o.ensureSingleton()
@@ -649,7 +1174,7 @@ func (o *physics2DServer) AreaSetShapeDisabled(area gdnative.Rid, shapeIdx gdnat
 	ptrArguments[2] = gdnative.NewPointerFromBool(disable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_shape_disabled")
+	methodBind := methodBindPhysics2DServerAreaSetShapeDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -659,8 +1184,8 @@ func (o *physics2DServer) AreaSetShapeDisabled(area gdnative.Rid, shapeIdx gdnat
 }
 
 /*
-        Sets the transform matrix for an area shape.
-	Args: [{ false area RID} { false shape_idx int} { false transform Transform2D}], Returns: void
+	        Sets the transform matrix for an area shape.
+		Args: [{ false area RID} { false shape_idx int} { false transform Transform2D}], Returns: void
 */
 func (o *physics2DServer) AreaSetShapeTransform(area gdnative.Rid, shapeIdx gdnative.Int, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -673,7 +1198,7 @@ func (o *physics2DServer) AreaSetShapeTransform(area gdnative.Rid, shapeIdx gdna
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_shape_transform")
+	methodBind := methodBindPhysics2DServerAreaSetShapeTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -683,8 +1208,8 @@ func (o *physics2DServer) AreaSetShapeTransform(area gdnative.Rid, shapeIdx gdna
 }
 
 /*
-        Assigns a space to the area.
-	Args: [{ false area RID} { false space RID}], Returns: void
+	        Assigns a space to the area.
+		Args: [{ false area RID} { false space RID}], Returns: void
 */
 func (o *physics2DServer) AreaSetSpace(area gdnative.Rid, space gdnative.Rid) {
 	o.ensureSingleton()
@@ -696,7 +1221,7 @@ func (o *physics2DServer) AreaSetSpace(area gdnative.Rid, space gdnative.Rid) {
 	ptrArguments[1] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_space")
+	methodBind := methodBindPhysics2DServerAreaSetSpace.Get()
 
 	// Call the parent method.
 	// void
@@ -706,8 +1231,8 @@ func (o *physics2DServer) AreaSetSpace(area gdnative.Rid, space gdnative.Rid) {
 }
 
 /*
-        Sets the space override mode for the area. The modes are described in the constants AREA_SPACE_OVERRIDE_*.
-	Args: [{ false area RID} { false mode int}], Returns: void
+	        Sets the space override mode for the area. The modes are described in the constants AREA_SPACE_OVERRIDE_*.
+		Args: [{ false area RID} { false mode int}], Returns: void
 */
 func (o *physics2DServer) AreaSetSpaceOverrideMode(area gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -719,7 +1244,7 @@ func (o *physics2DServer) AreaSetSpaceOverrideMode(area gdnative.Rid, mode gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_space_override_mode")
+	methodBind := methodBindPhysics2DServerAreaSetSpaceOverrideMode.Get()
 
 	// Call the parent method.
 	// void
@@ -729,8 +1254,8 @@ func (o *physics2DServer) AreaSetSpaceOverrideMode(area gdnative.Rid, mode gdnat
 }
 
 /*
-        Sets the transform matrix for an area.
-	Args: [{ false area RID} { false transform Transform2D}], Returns: void
+	        Sets the transform matrix for an area.
+		Args: [{ false area RID} { false transform Transform2D}], Returns: void
 */
 func (o *physics2DServer) AreaSetTransform(area gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -742,7 +1267,7 @@ func (o *physics2DServer) AreaSetTransform(area gdnative.Rid, transform gdnative
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "area_set_transform")
+	methodBind := methodBindPhysics2DServerAreaSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -752,8 +1277,8 @@ func (o *physics2DServer) AreaSetTransform(area gdnative.Rid, transform gdnative
 }
 
 /*
-        Adds a body to the list of bodies exempt from collisions.
-	Args: [{ false body RID} { false excepted_body RID}], Returns: void
+	        Adds a body to the list of bodies exempt from collisions.
+		Args: [{ false body RID} { false excepted_body RID}], Returns: void
 */
 func (o *physics2DServer) BodyAddCollisionException(body gdnative.Rid, exceptedBody gdnative.Rid) {
 	o.ensureSingleton()
@@ -765,7 +1290,7 @@ func (o *physics2DServer) BodyAddCollisionException(body gdnative.Rid, exceptedB
 	ptrArguments[1] = gdnative.NewPointerFromRid(exceptedBody)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_add_collision_exception")
+	methodBind := methodBindPhysics2DServerBodyAddCollisionException.Get()
 
 	// Call the parent method.
 	// void
@@ -775,8 +1300,8 @@ func (o *physics2DServer) BodyAddCollisionException(body gdnative.Rid, exceptedB
 }
 
 /*
-        Adds a positioned force to the applied force and torque. As with [method body_apply_impulse], both the force and the offset from the body origin are in global coordinates. A force differs from an impulse in that, while the two are forces, the impulse clears itself after being applied.
-	Args: [{ false body RID} { false offset Vector2} { false force Vector2}], Returns: void
+	        Adds a positioned force to the applied force and torque. As with [method body_apply_impulse], both the force and the offset from the body origin are in global coordinates. A force differs from an impulse in that, while the two are forces, the impulse clears itself after being applied.
+		Args: [{ false body RID} { false offset Vector2} { false force Vector2}], Returns: void
 */
 func (o *physics2DServer) BodyAddForce(body gdnative.Rid, offset gdnative.Vector2, force gdnative.Vector2) {
 	o.ensureSingleton()
@@ -789,7 +1314,7 @@ func (o *physics2DServer) BodyAddForce(body gdnative.Rid, offset gdnative.Vector
 	ptrArguments[2] = gdnative.NewPointerFromVector2(force)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_add_force")
+	methodBind := methodBindPhysics2DServerBodyAddForce.Get()
 
 	// Call the parent method.
 	// void
@@ -799,8 +1324,8 @@ func (o *physics2DServer) BodyAddForce(body gdnative.Rid, offset gdnative.Vector
 }
 
 /*
-        Adds a shape to the body, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
-	Args: [{ false body RID} { false shape RID} {((1, 0), (0, 1), (0, 0)) true transform Transform2D}], Returns: void
+	        Adds a shape to the body, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
+		Args: [{ false body RID} { false shape RID} {((1, 0), (0, 1), (0, 0)) true transform Transform2D}], Returns: void
 */
 func (o *physics2DServer) BodyAddShape(body gdnative.Rid, shape gdnative.Rid, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -813,7 +1338,7 @@ func (o *physics2DServer) BodyAddShape(body gdnative.Rid, shape gdnative.Rid, tr
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_add_shape")
+	methodBind := methodBindPhysics2DServerBodyAddShape.Get()
 
 	// Call the parent method.
 	// void
@@ -823,8 +1348,8 @@ func (o *physics2DServer) BodyAddShape(body gdnative.Rid, shape gdnative.Rid, tr
 }
 
 /*
-        Adds a positioned impulse to the applied force and torque. Both the force and the offset from the body origin are in global coordinates.
-	Args: [{ false body RID} { false position Vector2} { false impulse Vector2}], Returns: void
+	        Adds a positioned impulse to the applied force and torque. Both the force and the offset from the body origin are in global coordinates.
+		Args: [{ false body RID} { false position Vector2} { false impulse Vector2}], Returns: void
 */
 func (o *physics2DServer) BodyApplyImpulse(body gdnative.Rid, position gdnative.Vector2, impulse gdnative.Vector2) {
 	o.ensureSingleton()
@@ -837,7 +1362,7 @@ func (o *physics2DServer) BodyApplyImpulse(body gdnative.Rid, position gdnative.
 	ptrArguments[2] = gdnative.NewPointerFromVector2(impulse)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_apply_impulse")
+	methodBind := methodBindPhysics2DServerBodyApplyImpulse.Get()
 
 	// Call the parent method.
 	// void
@@ -847,8 +1372,8 @@ func (o *physics2DServer) BodyApplyImpulse(body gdnative.Rid, position gdnative.
 }
 
 /*
-        Assigns the area to a descendant of [Object], so it can exist in the node tree.
-	Args: [{ false body RID} { false id int}], Returns: void
+	        Assigns the area to a descendant of [Object], so it can exist in the node tree.
+		Args: [{ false body RID} { false id int}], Returns: void
 */
 func (o *physics2DServer) BodyAttachObjectInstanceId(body gdnative.Rid, id gdnative.Int) {
 	o.ensureSingleton()
@@ -860,7 +1385,7 @@ func (o *physics2DServer) BodyAttachObjectInstanceId(body gdnative.Rid, id gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_attach_object_instance_id")
+	methodBind := methodBindPhysics2DServerBodyAttachObjectInstanceId.Get()
 
 	// Call the parent method.
 	// void
@@ -870,8 +1395,8 @@ func (o *physics2DServer) BodyAttachObjectInstanceId(body gdnative.Rid, id gdnat
 }
 
 /*
-        Removes all shapes from a body.
-	Args: [{ false body RID}], Returns: void
+	        Removes all shapes from a body.
+		Args: [{ false body RID}], Returns: void
 */
 func (o *physics2DServer) BodyClearShapes(body gdnative.Rid) {
 	o.ensureSingleton()
@@ -882,7 +1407,7 @@ func (o *physics2DServer) BodyClearShapes(body gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_clear_shapes")
+	methodBind := methodBindPhysics2DServerBodyClearShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -892,8 +1417,8 @@ func (o *physics2DServer) BodyClearShapes(body gdnative.Rid) {
 }
 
 /*
-        Creates a physics body. The first parameter can be any value from constants BODY_MODE*, for the type of body created. Additionally, the body can be created in sleeping state to save processing time.
-	Args: [], Returns: RID
+	        Creates a physics body. The first parameter can be any value from constants BODY_MODE*, for the type of body created. Additionally, the body can be created in sleeping state to save processing time.
+		Args: [], Returns: RID
 */
 func (o *physics2DServer) BodyCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -903,7 +1428,7 @@ func (o *physics2DServer) BodyCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_create")
+	methodBind := methodBindPhysics2DServerBodyCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -916,8 +1441,8 @@ func (o *physics2DServer) BodyCreate() gdnative.Rid {
 }
 
 /*
-        Returns the physics layer or layers a body belongs to.
-	Args: [{ false body RID}], Returns: int
+	        Returns the physics layer or layers a body belongs to.
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physics2DServer) BodyGetCollisionLayer(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -928,7 +1453,7 @@ func (o *physics2DServer) BodyGetCollisionLayer(body gdnative.Rid) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_collision_layer")
+	methodBind := methodBindPhysics2DServerBodyGetCollisionLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -941,8 +1466,8 @@ func (o *physics2DServer) BodyGetCollisionLayer(body gdnative.Rid) gdnative.Int
 }
 
 /*
-        Returns the physics layer or layers a body can collide with.
-	Args: [{ false body RID}], Returns: int
+	        Returns the physics layer or layers a body can collide with.
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physics2DServer) BodyGetCollisionMask(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -953,7 +1478,7 @@ func (o *physics2DServer) BodyGetCollisionMask(body gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_collision_mask")
+	methodBind := methodBindPhysics2DServerBodyGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -966,8 +1491,8 @@ func (o *physics2DServer) BodyGetCollisionMask(body gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the continuous collision detection mode.
-	Args: [{ false body RID}], Returns: enum.Physics2DServer::CCDMode
+	        Returns the continuous collision detection mode.
+		Args: [{ false body RID}], Returns: enum.Physics2DServer::CCDMode
 */
 func (o *physics2DServer) BodyGetContinuousCollisionDetectionMode(body gdnative.Rid) Physics2DServerCCDMode {
 	o.ensureSingleton()
@@ -978,7 +1503,7 @@ func (o *physics2DServer) BodyGetContinuousCollisionDetectionMode(body gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_continuous_collision_detection_mode")
+	methodBind := methodBindPhysics2DServerBodyGetContinuousCollisionDetectionMode.Get()
 
 	// Call the parent method.
 	// enum.Physics2DServer::CCDMode
@@ -991,8 +1516,8 @@ func (o *physics2DServer) BodyGetContinuousCollisionDetectionMode(body gdnative.
 }
 
 /*
-        Returns the [Physics2DDirectBodyState] of the body.
-	Args: [{ false body RID}], Returns: Physics2DDirectBodyState
+	        Returns the [Physics2DDirectBodyState] of the body.
+		Args: [{ false body RID}], Returns: Physics2DDirectBodyState
 */
 func (o *physics2DServer) BodyGetDirectState(body gdnative.Rid) Physics2DDirectBodyStateImplementer {
 	o.ensureSingleton()
@@ -1003,7 +1528,7 @@ func (o *physics2DServer) BodyGetDirectState(body gdnative.Rid) Physics2DDirectB
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_direct_state")
+	methodBind := methodBindPhysics2DServerBodyGetDirectState.Get()
 
 	// Call the parent method.
 	// Physics2DDirectBodyState
@@ -1011,27 +1536,25 @@ func (o *physics2DServer) BodyGetDirectState(body gdnative.Rid) Physics2DDirectB
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysics2DDirectBodyStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(Physics2DDirectBodyStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Physics2DDirectBodyState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(Physics2DDirectBodyStateImplementer)
+	ret := Physics2DDirectBodyState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(Physics2DDirectBodyStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the maximum contacts that can be reported. See [method body_set_max_contacts_reported].
-	Args: [{ false body RID}], Returns: int
+	        Returns the maximum contacts that can be reported. See [method body_set_max_contacts_reported].
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physics2DServer) BodyGetMaxContactsReported(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -1042,7 +1565,7 @@ func (o *physics2DServer) BodyGetMaxContactsReported(body gdnative.Rid) gdnative
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_max_contacts_reported")
+	methodBind := methodBindPhysics2DServerBodyGetMaxContactsReported.Get()
 
 	// Call the parent method.
 	// int
@@ -1055,8 +1578,8 @@ func (o *physics2DServer) BodyGetMaxContactsReported(body gdnative.Rid) gdnative
 }
 
 /*
-        Returns the body mode.
-	Args: [{ false body RID}], Returns: enum.Physics2DServer::BodyMode
+	        Returns the body mode.
+		Args: [{ false body RID}], Returns: enum.Physics2DServer::BodyMode
 */
 func (o *physics2DServer) BodyGetMode(body gdnative.Rid) Physics2DServerBodyMode {
 	o.ensureSingleton()
@@ -1067,7 +1590,7 @@ func (o *physics2DServer) BodyGetMode(body gdnative.Rid) Physics2DServerBodyMode
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_mode")
+	methodBind := methodBindPhysics2DServerBodyGetMode.Get()
 
 	// Call the parent method.
 	// enum.Physics2DServer::BodyMode
@@ -1080,8 +1603,8 @@ func (o *physics2DServer) BodyGetMode(body gdnative.Rid) Physics2DServerBodyMode
 }
 
 /*
-        Gets the instance ID of the object the area is assigned to.
-	Args: [{ false body RID}], Returns: int
+	        Gets the instance ID of the object the area is assigned to.
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physics2DServer) BodyGetObjectInstanceId(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -1092,7 +1615,7 @@ func (o *physics2DServer) BodyGetObjectInstanceId(body gdnative.Rid) gdnative.In
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_object_instance_id")
+	methodBind := methodBindPhysics2DServerBodyGetObjectInstanceId.Get()
 
 	// Call the parent method.
 	// int
@@ -1105,8 +1628,8 @@ func (o *physics2DServer) BodyGetObjectInstanceId(body gdnative.Rid) gdnative.In
 }
 
 /*
-        Returns the value of a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
-	Args: [{ false body RID} { false param int}], Returns: float
+	        Returns the value of a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
+		Args: [{ false body RID} { false param int}], Returns: float
 */
 func (o *physics2DServer) BodyGetParam(body gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -1118,7 +1641,7 @@ func (o *physics2DServer) BodyGetParam(body gdnative.Rid, param gdnative.Int) gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_param")
+	methodBind := methodBindPhysics2DServerBodyGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -1131,8 +1654,8 @@ func (o *physics2DServer) BodyGetParam(body gdnative.Rid, param gdnative.Int) gd
 }
 
 /*
-        Returns the [RID] of the nth shape of a body.
-	Args: [{ false body RID} { false shape_idx int}], Returns: RID
+	        Returns the [RID] of the nth shape of a body.
+		Args: [{ false body RID} { false shape_idx int}], Returns: RID
 */
 func (o *physics2DServer) BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int) gdnative.Rid {
 	o.ensureSingleton()
@@ -1144,7 +1667,7 @@ func (o *physics2DServer) BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_shape")
+	methodBind := methodBindPhysics2DServerBodyGetShape.Get()
 
 	// Call the parent method.
 	// RID
@@ -1157,8 +1680,8 @@ func (o *physics2DServer) BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int)
 }
 
 /*
-        Returns the number of shapes assigned to a body.
-	Args: [{ false body RID}], Returns: int
+	        Returns the number of shapes assigned to a body.
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physics2DServer) BodyGetShapeCount(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -1169,7 +1692,7 @@ func (o *physics2DServer) BodyGetShapeCount(body gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_shape_count")
+	methodBind := methodBindPhysics2DServerBodyGetShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -1182,8 +1705,8 @@ func (o *physics2DServer) BodyGetShapeCount(body gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the metadata of a shape of a body.
-	Args: [{ false body RID} { false shape_idx int}], Returns: Variant
+	        Returns the metadata of a shape of a body.
+		Args: [{ false body RID} { false shape_idx int}], Returns: Variant
 */
 func (o *physics2DServer) BodyGetShapeMetadata(body gdnative.Rid, shapeIdx gdnative.Int) gdnative.Variant {
 	o.ensureSingleton()
@@ -1195,7 +1718,7 @@ func (o *physics2DServer) BodyGetShapeMetadata(body gdnative.Rid, shapeIdx gdnat
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_shape_metadata")
+	methodBind := methodBindPhysics2DServerBodyGetShapeMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -1208,8 +1731,8 @@ func (o *physics2DServer) BodyGetShapeMetadata(body gdnative.Rid, shapeIdx gdnat
 }
 
 /*
-        Returns the transform matrix of a body shape.
-	Args: [{ false body RID} { false shape_idx int}], Returns: Transform2D
+	        Returns the transform matrix of a body shape.
+		Args: [{ false body RID} { false shape_idx int}], Returns: Transform2D
 */
 func (o *physics2DServer) BodyGetShapeTransform(body gdnative.Rid, shapeIdx gdnative.Int) gdnative.Transform2D {
 	o.ensureSingleton()
@@ -1221,7 +1744,7 @@ func (o *physics2DServer) BodyGetShapeTransform(body gdnative.Rid, shapeIdx gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_shape_transform")
+	methodBind := methodBindPhysics2DServerBodyGetShapeTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -1234,8 +1757,8 @@ func (o *physics2DServer) BodyGetShapeTransform(body gdnative.Rid, shapeIdx gdna
 }
 
 /*
-        Returns the [RID] of the space assigned to a body.
-	Args: [{ false body RID}], Returns: RID
+	        Returns the [RID] of the space assigned to a body.
+		Args: [{ false body RID}], Returns: RID
 */
 func (o *physics2DServer) BodyGetSpace(body gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -1246,7 +1769,7 @@ func (o *physics2DServer) BodyGetSpace(body gdnative.Rid) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_space")
+	methodBind := methodBindPhysics2DServerBodyGetSpace.Get()
 
 	// Call the parent method.
 	// RID
@@ -1259,8 +1782,8 @@ func (o *physics2DServer) BodyGetSpace(body gdnative.Rid) gdnative.Rid {
 }
 
 /*
-        Returns a body state.
-	Args: [{ false body RID} { false state int}], Returns: Variant
+	        Returns a body state.
+		Args: [{ false body RID} { false state int}], Returns: Variant
 */
 func (o *physics2DServer) BodyGetState(body gdnative.Rid, state gdnative.Int) gdnative.Variant {
 	o.ensureSingleton()
@@ -1272,7 +1795,7 @@ func (o *physics2DServer) BodyGetState(body gdnative.Rid, state gdnative.Int) gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(state)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_get_state")
+	methodBind := methodBindPhysics2DServerBodyGetState.Get()
 
 	// Call the parent method.
 	// Variant
@@ -1285,8 +1808,8 @@ func (o *physics2DServer) BodyGetState(body gdnative.Rid, state gdnative.Int) gd
 }
 
 /*
-        Returns whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
-	Args: [{ false body RID}], Returns: bool
+	        Returns whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
+		Args: [{ false body RID}], Returns: bool
 */
 func (o *physics2DServer) BodyIsOmittingForceIntegration(body gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -1297,7 +1820,7 @@ func (o *physics2DServer) BodyIsOmittingForceIntegration(body gdnative.Rid) gdna
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_is_omitting_force_integration")
+	methodBind := methodBindPhysics2DServerBodyIsOmittingForceIntegration.Get()
 
 	// Call the parent method.
 	// bool
@@ -1310,8 +1833,8 @@ func (o *physics2DServer) BodyIsOmittingForceIntegration(body gdnative.Rid) gdna
 }
 
 /*
-        Removes a body from the list of bodies exempt from collisions.
-	Args: [{ false body RID} { false excepted_body RID}], Returns: void
+	        Removes a body from the list of bodies exempt from collisions.
+		Args: [{ false body RID} { false excepted_body RID}], Returns: void
 */
 func (o *physics2DServer) BodyRemoveCollisionException(body gdnative.Rid, exceptedBody gdnative.Rid) {
 	o.ensureSingleton()
@@ -1323,7 +1846,7 @@ func (o *physics2DServer) BodyRemoveCollisionException(body gdnative.Rid, except
 	ptrArguments[1] = gdnative.NewPointerFromRid(exceptedBody)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_remove_collision_exception")
+	methodBind := methodBindPhysics2DServerBodyRemoveCollisionException.Get()
 
 	// Call the parent method.
 	// void
@@ -1333,8 +1856,8 @@ func (o *physics2DServer) BodyRemoveCollisionException(body gdnative.Rid, except
 }
 
 /*
-        Removes a shape from a body. The shape is not deleted, so it can be reused afterwards.
-	Args: [{ false body RID} { false shape_idx int}], Returns: void
+	        Removes a shape from a body. The shape is not deleted, so it can be reused afterwards.
+		Args: [{ false body RID} { false shape_idx int}], Returns: void
 */
 func (o *physics2DServer) BodyRemoveShape(body gdnative.Rid, shapeIdx gdnative.Int) {
 	o.ensureSingleton()
@@ -1346,7 +1869,7 @@ func (o *physics2DServer) BodyRemoveShape(body gdnative.Rid, shapeIdx gdnative.I
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_remove_shape")
+	methodBind := methodBindPhysics2DServerBodyRemoveShape.Get()
 
 	// Call the parent method.
 	// void
@@ -1356,8 +1879,8 @@ func (o *physics2DServer) BodyRemoveShape(body gdnative.Rid, shapeIdx gdnative.I
 }
 
 /*
-        Sets an axis velocity. The velocity in the given vector axis will be set as the given vector length. This is useful for jumping behavior.
-	Args: [{ false body RID} { false axis_velocity Vector2}], Returns: void
+	        Sets an axis velocity. The velocity in the given vector axis will be set as the given vector length. This is useful for jumping behavior.
+		Args: [{ false body RID} { false axis_velocity Vector2}], Returns: void
 */
 func (o *physics2DServer) BodySetAxisVelocity(body gdnative.Rid, axisVelocity gdnative.Vector2) {
 	o.ensureSingleton()
@@ -1369,7 +1892,7 @@ func (o *physics2DServer) BodySetAxisVelocity(body gdnative.Rid, axisVelocity gd
 	ptrArguments[1] = gdnative.NewPointerFromVector2(axisVelocity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_axis_velocity")
+	methodBind := methodBindPhysics2DServerBodySetAxisVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -1379,8 +1902,8 @@ func (o *physics2DServer) BodySetAxisVelocity(body gdnative.Rid, axisVelocity gd
 }
 
 /*
-        Sets the physics layer or layers a body belongs to.
-	Args: [{ false body RID} { false layer int}], Returns: void
+	        Sets the physics layer or layers a body belongs to.
+		Args: [{ false body RID} { false layer int}], Returns: void
 */
 func (o *physics2DServer) BodySetCollisionLayer(body gdnative.Rid, layer gdnative.Int) {
 	o.ensureSingleton()
@@ -1392,7 +1915,7 @@ func (o *physics2DServer) BodySetCollisionLayer(body gdnative.Rid, layer gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_collision_layer")
+	methodBind := methodBindPhysics2DServerBodySetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -1402,8 +1925,8 @@ func (o *physics2DServer) BodySetCollisionLayer(body gdnative.Rid, layer gdnativ
 }
 
 /*
-        Sets the physics layer or layers a body can collide with.
-	Args: [{ false body RID} { false mask int}], Returns: void
+	        Sets the physics layer or layers a body can collide with.
+		Args: [{ false body RID} { false mask int}], Returns: void
 */
 func (o *physics2DServer) BodySetCollisionMask(body gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -1415,7 +1938,7 @@ func (o *physics2DServer) BodySetCollisionMask(body gdnative.Rid, mask gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_collision_mask")
+	methodBind := methodBindPhysics2DServerBodySetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1425,8 +1948,8 @@ func (o *physics2DServer) BodySetCollisionMask(body gdnative.Rid, mask gdnative.
 }
 
 /*
-        Sets the continuous collision detection mode from any of the CCD_MODE_* constants. Continuous collision detection tries to predict where a moving body will collide, instead of moving it and correcting its movement if it collided.
-	Args: [{ false body RID} { false mode int}], Returns: void
+	        Sets the continuous collision detection mode from any of the CCD_MODE_* constants. Continuous collision detection tries to predict where a moving body will collide, instead of moving it and correcting its movement if it collided.
+		Args: [{ false body RID} { false mode int}], Returns: void
 */
 func (o *physics2DServer) BodySetContinuousCollisionDetectionMode(body gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -1438,7 +1961,7 @@ func (o *physics2DServer) BodySetContinuousCollisionDetectionMode(body gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_continuous_collision_detection_mode")
+	methodBind := methodBindPhysics2DServerBodySetContinuousCollisionDetectionMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1448,22 +1971,22 @@ func (o *physics2DServer) BodySetContinuousCollisionDetectionMode(body gdnative.
 }
 
 /*
-        Sets the function used to calculate physics for an object, if that object allows it (see [method body_set_omit_force integration]).
-	Args: [{ false body RID} { false receiver Object} { false method String} {Null true userdata Variant}], Returns: void
+	        Sets the function used to calculate physics for an object, if that object allows it (see [method body_set_omit_force integration]).
+		Args: [{ false body RID} { false receiver Object} { false method String} {Null true userdata Variant}], Returns: void
 */
-func (o *physics2DServer) BodySetForceIntegrationCallback(body gdnative.Rid, receiver ObjectImplementer, method gdnative.String, userdata gdnative.Variant) {
+func (o *physics2DServer) BodySetForceIntegrationCallback(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant) {
 	o.ensureSingleton()
 	//log.Println("Calling Physics2DServer.BodySetForceIntegrationCallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
-	ptrArguments[1] = gdnative.NewPointerFromObject(receiver.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(receiver))
 	ptrArguments[2] = gdnative.NewPointerFromString(method)
 	ptrArguments[3] = gdnative.NewPointerFromVariant(userdata)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_force_integration_callback")
+	methodBind := methodBindPhysics2DServerBodySetForceIntegrationCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -1473,8 +1996,8 @@ func (o *physics2DServer) BodySetForceIntegrationCallback(body gdnative.Rid, rec
 }
 
 /*
-        Sets the maximum contacts to report. Bodies can keep a log of the contacts with other bodies, this is enabled by setting the maximum amount of contacts reported to a number greater than 0.
-	Args: [{ false body RID} { false amount int}], Returns: void
+	        Sets the maximum contacts to report. Bodies can keep a log of the contacts with other bodies, this is enabled by setting the maximum amount of contacts reported to a number greater than 0.
+		Args: [{ false body RID} { false amount int}], Returns: void
 */
 func (o *physics2DServer) BodySetMaxContactsReported(body gdnative.Rid, amount gdnative.Int) {
 	o.ensureSingleton()
@@ -1486,7 +2009,7 @@ func (o *physics2DServer) BodySetMaxContactsReported(body gdnative.Rid, amount g
 	ptrArguments[1] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_max_contacts_reported")
+	methodBind := methodBindPhysics2DServerBodySetMaxContactsReported.Get()
 
 	// Call the parent method.
 	// void
@@ -1496,8 +2019,8 @@ func (o *physics2DServer) BodySetMaxContactsReported(body gdnative.Rid, amount g
 }
 
 /*
-        Sets the body mode, from one of the constants BODY_MODE*.
-	Args: [{ false body RID} { false mode int}], Returns: void
+	        Sets the body mode, from one of the constants BODY_MODE*.
+		Args: [{ false body RID} { false mode int}], Returns: void
 */
 func (o *physics2DServer) BodySetMode(body gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -1509,7 +2032,7 @@ func (o *physics2DServer) BodySetMode(body gdnative.Rid, mode gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_mode")
+	methodBind := methodBindPhysics2DServerBodySetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1519,8 +2042,8 @@ func (o *physics2DServer) BodySetMode(body gdnative.Rid, mode gdnative.Int) {
 }
 
 /*
-        Sets whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
-	Args: [{ false body RID} { false enable bool}], Returns: void
+	        Sets whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
+		Args: [{ false body RID} { false enable bool}], Returns: void
 */
 func (o *physics2DServer) BodySetOmitForceIntegration(body gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -1532,7 +2055,7 @@ func (o *physics2DServer) BodySetOmitForceIntegration(body gdnative.Rid, enable
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_omit_force_integration")
+	methodBind := methodBindPhysics2DServerBodySetOmitForceIntegration.Get()
 
 	// Call the parent method.
 	// void
@@ -1542,8 +2065,8 @@ func (o *physics2DServer) BodySetOmitForceIntegration(body gdnative.Rid, enable
 }
 
 /*
-        Sets a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
-	Args: [{ false body RID} { false param int} { false value float}], Returns: void
+	        Sets a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
+		Args: [{ false body RID} { false param int} { false value float}], Returns: void
 */
 func (o *physics2DServer) BodySetParam(body gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -1556,7 +2079,7 @@ func (o *physics2DServer) BodySetParam(body gdnative.Rid, param gdnative.Int, va
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_param")
+	methodBind := methodBindPhysics2DServerBodySetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -1566,8 +2089,8 @@ func (o *physics2DServer) BodySetParam(body gdnative.Rid, param gdnative.Int, va
 }
 
 /*
-        Substitutes a given body shape by another. The old shape is selected by its index, the new one by its [RID].
-	Args: [{ false body RID} { false shape_idx int} { false shape RID}], Returns: void
+	        Substitutes a given body shape by another. The old shape is selected by its index, the new one by its [RID].
+		Args: [{ false body RID} { false shape_idx int} { false shape RID}], Returns: void
 */
 func (o *physics2DServer) BodySetShape(body gdnative.Rid, shapeIdx gdnative.Int, shape gdnative.Rid) {
 	o.ensureSingleton()
@@ -1580,7 +2103,7 @@ func (o *physics2DServer) BodySetShape(body gdnative.Rid, shapeIdx gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_shape")
+	methodBind := methodBindPhysics2DServerBodySetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -1590,8 +2113,8 @@ func (o *physics2DServer) BodySetShape(body gdnative.Rid, shapeIdx gdnative.Int,
 }
 
 /*
-        Enables one way collision on body if [code]enable[/code] is [code]true[/code].
-	Args: [{ false body RID} { false shape_idx int} { false enable bool}], Returns: void
+	        Enables one way collision on body if [code]enable[/code] is [code]true[/code].
+		Args: [{ false body RID} { false shape_idx int} { false enable bool}], Returns: void
 */
 func (o *physics2DServer) BodySetShapeAsOneWayCollision(body gdnative.Rid, shapeIdx gdnative.Int, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -1604,7 +2127,7 @@ func (o *physics2DServer) BodySetShapeAsOneWayCollision(body gdnative.Rid, shape
 	ptrArguments[2] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_shape_as_one_way_collision")
+	methodBind := methodBindPhysics2DServerBodySetShapeAsOneWayCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -1614,8 +2137,8 @@ func (o *physics2DServer) BodySetShapeAsOneWayCollision(body gdnative.Rid, shape
 }
 
 /*
-        Disables shape in body if [code]disable[/code] is [code]true[/code].
-	Args: [{ false body RID} { false shape_idx int} { false disable bool}], Returns: void
+	        Disables shape in body if [code]disable[/code] is [code]true[/code].
+		Args: [{ false body RID} { false shape_idx int} { false disable bool}], Returns: void
 */
 func (o *physics2DServer) BodySetShapeDisabled(body gdnative.Rid, shapeIdx gdnative.Int, disable gdnative.Bool) {
 	o.ensureSingleton()
@@ -1628,7 +2151,7 @@ func (o *physics2DServer) BodySetShapeDisabled(body gdnative.Rid, shapeIdx gdnat
 	ptrArguments[2] = gdnative.NewPointerFromBool(disable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_shape_disabled")
+	methodBind := methodBindPhysics2DServerBodySetShapeDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -1638,8 +2161,8 @@ func (o *physics2DServer) BodySetShapeDisabled(body gdnative.Rid, shapeIdx gdnat
 }
 
 /*
-        Sets metadata of a shape within a body. This metadata is different from [method Object.set_meta], and can be retrieved on shape queries.
-	Args: [{ false body RID} { false shape_idx int} { false metadata Variant}], Returns: void
+	        Sets metadata of a shape within a body. This metadata is different from [method Object.set_meta], and can be retrieved on shape queries.
+		Args: [{ false body RID} { false shape_idx int} { false metadata Variant}], Returns: void
 */
 func (o *physics2DServer) BodySetShapeMetadata(body gdnative.Rid, shapeIdx gdnative.Int, metadata gdnative.Variant) {
 	o.ensureSingleton()
@@ -1652,7 +2175,7 @@ func (o *physics2DServer) BodySetShapeMetadata(body gdnative.Rid, shapeIdx gdnat
 	ptrArguments[2] = gdnative.NewPointerFromVariant(metadata)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_shape_metadata")
+	methodBind := methodBindPhysics2DServerBodySetShapeMetadata.Get()
 
 	// Call the parent method.
 	// void
@@ -1662,8 +2185,8 @@ func (o *physics2DServer) BodySetShapeMetadata(body gdnative.Rid, shapeIdx gdnat
 }
 
 /*
-        Sets the transform matrix for a body shape.
-	Args: [{ false body RID} { false shape_idx int} { false transform Transform2D}], Returns: void
+	        Sets the transform matrix for a body shape.
+		Args: [{ false body RID} { false shape_idx int} { false transform Transform2D}], Returns: void
 */
 func (o *physics2DServer) BodySetShapeTransform(body gdnative.Rid, shapeIdx gdnative.Int, transform gdnative.Transform2D) {
 	o.ensureSingleton()
@@ -1676,7 +2199,7 @@ func (o *physics2DServer) BodySetShapeTransform(body gdnative.Rid, shapeIdx gdna
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_shape_transform")
+	methodBind := methodBindPhysics2DServerBodySetShapeTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1686,8 +2209,8 @@ func (o *physics2DServer) BodySetShapeTransform(body gdnative.Rid, shapeIdx gdna
 }
 
 /*
-        Assigns a space to the body (see [method create_space]).
-	Args: [{ false body RID} { false space RID}], Returns: void
+	        Assigns a space to the body (see [method create_space]).
+		Args: [{ false body RID} { false space RID}], Returns: void
 */
 func (o *physics2DServer) BodySetSpace(body gdnative.Rid, space gdnative.Rid) {
 	o.ensureSingleton()
@@ -1699,7 +2222,7 @@ func (o *physics2DServer) BodySetSpace(body gdnative.Rid, space gdnative.Rid) {
 	ptrArguments[1] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_space")
+	methodBind := methodBindPhysics2DServerBodySetSpace.Get()
 
 	// Call the parent method.
 	// void
@@ -1709,8 +2232,8 @@ func (o *physics2DServer) BodySetSpace(body gdnative.Rid, space gdnative.Rid) {
 }
 
 /*
-        Sets a body state (see BODY_STATE* constants).
-	Args: [{ false body RID} { false state int} { false value Variant}], Returns: void
+	        Sets a body state (see BODY_STATE* constants).
+		Args: [{ false body RID} { false state int} { false value Variant}], Returns: void
 */
 func (o *physics2DServer) BodySetState(body gdnative.Rid, state gdnative.Int, value gdnative.Variant) {
 	o.ensureSingleton()
@@ -1723,7 +2246,7 @@ func (o *physics2DServer) BodySetState(body gdnative.Rid, state gdnative.Int, va
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_set_state")
+	methodBind := methodBindPhysics2DServerBodySetState.Get()
 
 	// Call the parent method.
 	// void
@@ -1733,8 +2256,8 @@ func (o *physics2DServer) BodySetState(body gdnative.Rid, state gdnative.Int, va
 }
 
 /*
-        Returns whether a body can move from a given point in a given direction. Apart from the boolean return value, a [Physics2DTestMotionResult] can be passed to return additional information in.
-	Args: [{ false body RID} { false from Transform2D} { false motion Vector2} {0.08 true margin float} {Null true result Physics2DTestMotionResult}], Returns: bool
+	        Returns whether a body can move from a given point in a given direction. Apart from the boolean return value, a [Physics2DTestMotionResult] can be passed to return additional information in.
+		Args: [{ false body RID} { false from Transform2D} { false motion Vector2} {0.08 true margin float} {Null true result Physics2DTestMotionResult}], Returns: bool
 */
 func (o *physics2DServer) BodyTestMotion(body gdnative.Rid, from gdnative.Transform2D, motion gdnative.Vector2, margin gdnative.Real, result Physics2DTestMotionResultImplementer) gdnative.Bool {
 	o.ensureSingleton()
@@ -1746,10 +2269,10 @@ func (o *physics2DServer) BodyTestMotion(body gdnative.Rid, from gdnative.Transf
 	ptrArguments[1] = gdnative.NewPointerFromTransform2D(from)
 	ptrArguments[2] = gdnative.NewPointerFromVector2(motion)
 	ptrArguments[3] = gdnative.NewPointerFromReal(margin)
-	ptrArguments[4] = gdnative.NewPointerFromObject(result.GetBaseObject())
+	ptrArguments[4] = gdnative.NewPointerFromObject(godot.GetBaseObject(result))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "body_test_motion")
+	methodBind := methodBindPhysics2DServerBodyTestMotion.Get()
 
 	// Call the parent method.
 	// bool
@@ -1762,8 +2285,7 @@ func (o *physics2DServer) BodyTestMotion(body gdnative.Rid, from gdnative.Transf
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) CapsuleShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -1773,7 +2295,7 @@ func (o *physics2DServer) CapsuleShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "capsule_shape_create")
+	methodBind := methodBindPhysics2DServerCapsuleShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1786,8 +2308,7 @@ func (o *physics2DServer) CapsuleShapeCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) CircleShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -1797,7 +2318,7 @@ func (o *physics2DServer) CircleShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "circle_shape_create")
+	methodBind := methodBindPhysics2DServerCircleShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1810,8 +2331,7 @@ func (o *physics2DServer) CircleShapeCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) ConcavePolygonShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -1821,7 +2341,7 @@ func (o *physics2DServer) ConcavePolygonShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "concave_polygon_shape_create")
+	methodBind := methodBindPhysics2DServerConcavePolygonShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1834,8 +2354,7 @@ func (o *physics2DServer) ConcavePolygonShapeCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) ConvexPolygonShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -1845,7 +2364,7 @@ func (o *physics2DServer) ConvexPolygonShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "convex_polygon_shape_create")
+	methodBind := methodBindPhysics2DServerConvexPolygonShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1858,8 +2377,8 @@ func (o *physics2DServer) ConvexPolygonShapeCreate() gdnative.Rid {
 }
 
 /*
-        Creates a damped spring joint between two bodies. If not specified, the second body is assumed to be the joint itself.
-	Args: [{ false anchor_a Vector2} { false anchor_b Vector2} { false body_a RID} {[RID] true body_b RID}], Returns: RID
+	        Creates a damped spring joint between two bodies. If not specified, the second body is assumed to be the joint itself.
+		Args: [{ false anchor_a Vector2} { false anchor_b Vector2} { false body_a RID} {[RID] true body_b RID}], Returns: RID
 */
 func (o *physics2DServer) DampedSpringJointCreate(anchorA gdnative.Vector2, anchorB gdnative.Vector2, bodyA gdnative.Rid, bodyB gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -1873,7 +2392,7 @@ func (o *physics2DServer) DampedSpringJointCreate(anchorA gdnative.Vector2, anch
 	ptrArguments[3] = gdnative.NewPointerFromRid(bodyB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "damped_spring_joint_create")
+	methodBind := methodBindPhysics2DServerDampedSpringJointCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1886,8 +2405,8 @@ func (o *physics2DServer) DampedSpringJointCreate(anchorA gdnative.Vector2, anch
 }
 
 /*
-        Returns the value of a damped spring joint parameter.
-	Args: [{ false joint RID} { false param int}], Returns: float
+	        Returns the value of a damped spring joint parameter.
+		Args: [{ false joint RID} { false param int}], Returns: float
 */
 func (o *physics2DServer) DampedStringJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -1899,7 +2418,7 @@ func (o *physics2DServer) DampedStringJointGetParam(joint gdnative.Rid, param gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "damped_string_joint_get_param")
+	methodBind := methodBindPhysics2DServerDampedStringJointGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -1912,8 +2431,8 @@ func (o *physics2DServer) DampedStringJointGetParam(joint gdnative.Rid, param gd
 }
 
 /*
-        Sets a damped spring joint parameter. Parameters are explained in the DAMPED_STRING* constants.
-	Args: [{ false joint RID} { false param int} { false value float}], Returns: void
+	        Sets a damped spring joint parameter. Parameters are explained in the DAMPED_STRING* constants.
+		Args: [{ false joint RID} { false param int} { false value float}], Returns: void
 */
 func (o *physics2DServer) DampedStringJointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -1926,7 +2445,7 @@ func (o *physics2DServer) DampedStringJointSetParam(joint gdnative.Rid, param gd
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "damped_string_joint_set_param")
+	methodBind := methodBindPhysics2DServerDampedStringJointSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -1936,8 +2455,8 @@ func (o *physics2DServer) DampedStringJointSetParam(joint gdnative.Rid, param gd
 }
 
 /*
-        Destroys any of the objects created by Physics2DServer. If the [RID] passed is not one of the objects that can be created by Physics2DServer, an error will be sent to the console.
-	Args: [{ false rid RID}], Returns: void
+	        Destroys any of the objects created by Physics2DServer. If the [RID] passed is not one of the objects that can be created by Physics2DServer, an error will be sent to the console.
+		Args: [{ false rid RID}], Returns: void
 */
 func (o *physics2DServer) FreeRid(rid gdnative.Rid) {
 	o.ensureSingleton()
@@ -1948,7 +2467,7 @@ func (o *physics2DServer) FreeRid(rid gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "free_rid")
+	methodBind := methodBindPhysics2DServerFreeRid.Get()
 
 	// Call the parent method.
 	// void
@@ -1958,19 +2477,19 @@ func (o *physics2DServer) FreeRid(rid gdnative.Rid) {
 }
 
 /*
-        Returns information about the current state of the 2D physics engine. The states are listed under the INFO_* constants.
-	Args: [{ false process_info int}], Returns: int
+	        Returns information about the current state of the 2D physics engine. The states are listed under the INFO_* constants.
+		Args: [{ false process_info enum.Physics2DServer::ProcessInfo}], Returns: int
 */
-func (o *physics2DServer) GetProcessInfo(processInfo gdnative.Int) gdnative.Int {
+func (o *physics2DServer) GetProcessInfo(processInfo Physics2DServerProcessInfo) gdnative.Int {
 	o.ensureSingleton()
 	//log.Println("Calling Physics2DServer.GetProcessInfo()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(processInfo)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(processInfo))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "get_process_info")
+	methodBind := methodBindPhysics2DServerGetProcessInfo.Get()
 
 	// Call the parent method.
 	// int
@@ -1983,8 +2502,8 @@ func (o *physics2DServer) GetProcessInfo(processInfo gdnative.Int) gdnative.Int
 }
 
 /*
-        Creates a groove joint between two bodies. If not specified, the bodyies are assumed to be the joint itself.
-	Args: [{ false groove1_a Vector2} { false groove2_a Vector2} { false anchor_b Vector2} {[RID] true body_a RID} {[RID] true body_b RID}], Returns: RID
+	        Creates a groove joint between two bodies. If not specified, the bodyies are assumed to be the joint itself.
+		Args: [{ false groove1_a Vector2} { false groove2_a Vector2} { false anchor_b Vector2} {[RID] true body_a RID} {[RID] true body_b RID}], Returns: RID
 */
 func (o *physics2DServer) GrooveJointCreate(groove1A gdnative.Vector2, groove2A gdnative.Vector2, anchorB gdnative.Vector2, bodyA gdnative.Rid, bodyB gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -1999,7 +2518,7 @@ func (o *physics2DServer) GrooveJointCreate(groove1A gdnative.Vector2, groove2A
 	ptrArguments[4] = gdnative.NewPointerFromRid(bodyB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "groove_joint_create")
+	methodBind := methodBindPhysics2DServerGrooveJointCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2012,8 +2531,8 @@ func (o *physics2DServer) GrooveJointCreate(groove1A gdnative.Vector2, groove2A
 }
 
 /*
-        Returns the value of a joint parameter.
-	Args: [{ false joint RID} { false param int}], Returns: float
+	        Returns the value of a joint parameter.
+		Args: [{ false joint RID} { false param int}], Returns: float
 */
 func (o *physics2DServer) JointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -2025,7 +2544,7 @@ func (o *physics2DServer) JointGetParam(joint gdnative.Rid, param gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "joint_get_param")
+	methodBind := methodBindPhysics2DServerJointGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -2038,8 +2557,8 @@ func (o *physics2DServer) JointGetParam(joint gdnative.Rid, param gdnative.Int)
 }
 
 /*
-        Returns the type of a joint (see JOINT_* constants).
-	Args: [{ false joint RID}], Returns: enum.Physics2DServer::JointType
+	        Returns the type of a joint (see JOINT_* constants).
+		Args: [{ false joint RID}], Returns: enum.Physics2DServer::JointType
 */
 func (o *physics2DServer) JointGetType(joint gdnative.Rid) Physics2DServerJointType {
 	o.ensureSingleton()
@@ -2050,7 +2569,7 @@ func (o *physics2DServer) JointGetType(joint gdnative.Rid) Physics2DServerJointT
 	ptrArguments[0] = gdnative.NewPointerFromRid(joint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "joint_get_type")
+	methodBind := methodBindPhysics2DServerJointGetType.Get()
 
 	// Call the parent method.
 	// enum.Physics2DServer::JointType
@@ -2063,8 +2582,8 @@ func (o *physics2DServer) JointGetType(joint gdnative.Rid) Physics2DServerJointT
 }
 
 /*
-        Sets a joint parameter. Parameters are explained in the JOINT_PARAM* constants.
-	Args: [{ false joint RID} { false param int} { false value float}], Returns: void
+	        Sets a joint parameter. Parameters are explained in the JOINT_PARAM* constants.
+		Args: [{ false joint RID} { false param int} { false value float}], Returns: void
 */
 func (o *physics2DServer) JointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -2077,7 +2596,7 @@ func (o *physics2DServer) JointSetParam(joint gdnative.Rid, param gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "joint_set_param")
+	methodBind := methodBindPhysics2DServerJointSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -2087,8 +2606,7 @@ func (o *physics2DServer) JointSetParam(joint gdnative.Rid, param gdnative.Int,
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) LineShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2098,7 +2616,7 @@ func (o *physics2DServer) LineShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "line_shape_create")
+	methodBind := methodBindPhysics2DServerLineShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2111,8 +2629,8 @@ func (o *physics2DServer) LineShapeCreate() gdnative.Rid {
 }
 
 /*
-        Creates a pin joint between two bodies. If not specified, the second body is assumed to be the joint itself.
-	Args: [{ false anchor Vector2} { false body_a RID} {[RID] true body_b RID}], Returns: RID
+	        Creates a pin joint between two bodies. If not specified, the second body is assumed to be the joint itself.
+		Args: [{ false anchor Vector2} { false body_a RID} {[RID] true body_b RID}], Returns: RID
 */
 func (o *physics2DServer) PinJointCreate(anchor gdnative.Vector2, bodyA gdnative.Rid, bodyB gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -2125,7 +2643,7 @@ func (o *physics2DServer) PinJointCreate(anchor gdnative.Vector2, bodyA gdnative
 	ptrArguments[2] = gdnative.NewPointerFromRid(bodyB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "pin_joint_create")
+	methodBind := methodBindPhysics2DServerPinJointCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2138,8 +2656,7 @@ func (o *physics2DServer) PinJointCreate(anchor gdnative.Vector2, bodyA gdnative
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) RayShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2149,7 +2666,7 @@ func (o *physics2DServer) RayShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "ray_shape_create")
+	methodBind := methodBindPhysics2DServerRayShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2162,8 +2679,7 @@ func (o *physics2DServer) RayShapeCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) RectangleShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2173,7 +2689,7 @@ func (o *physics2DServer) RectangleShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "rectangle_shape_create")
+	methodBind := methodBindPhysics2DServerRectangleShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2186,8 +2702,7 @@ func (o *physics2DServer) RectangleShapeCreate() gdnative.Rid {
 }
 
 /*
-
-	Args: [], Returns: RID
+Args: [], Returns: RID
 */
 func (o *physics2DServer) SegmentShapeCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2197,7 +2712,7 @@ func (o *physics2DServer) SegmentShapeCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "segment_shape_create")
+	methodBind := methodBindPhysics2DServerSegmentShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2210,8 +2725,8 @@ func (o *physics2DServer) SegmentShapeCreate() gdnative.Rid {
 }
 
 /*
-        Activates or deactivates the 2D physics engine.
-	Args: [{ false active bool}], Returns: void
+	        Activates or deactivates the 2D physics engine.
+		Args: [{ false active bool}], Returns: void
 */
 func (o *physics2DServer) SetActive(active gdnative.Bool) {
 	o.ensureSingleton()
@@ -2222,7 +2737,7 @@ func (o *physics2DServer) SetActive(active gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(active)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "set_active")
+	methodBind := methodBindPhysics2DServerSetActive.Get()
 
 	// Call the parent method.
 	// void
@@ -2232,8 +2747,8 @@ func (o *physics2DServer) SetActive(active gdnative.Bool) {
 }
 
 /*
-        Returns the shape data.
-	Args: [{ false shape RID}], Returns: Variant
+	        Returns the shape data.
+		Args: [{ false shape RID}], Returns: Variant
 */
 func (o *physics2DServer) ShapeGetData(shape gdnative.Rid) gdnative.Variant {
 	o.ensureSingleton()
@@ -2244,7 +2759,7 @@ func (o *physics2DServer) ShapeGetData(shape gdnative.Rid) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "shape_get_data")
+	methodBind := methodBindPhysics2DServerShapeGetData.Get()
 
 	// Call the parent method.
 	// Variant
@@ -2257,8 +2772,8 @@ func (o *physics2DServer) ShapeGetData(shape gdnative.Rid) gdnative.Variant {
 }
 
 /*
-        Returns the type of shape (see SHAPE_* constants).
-	Args: [{ false shape RID}], Returns: enum.Physics2DServer::ShapeType
+	        Returns the type of shape (see SHAPE_* constants).
+		Args: [{ false shape RID}], Returns: enum.Physics2DServer::ShapeType
 */
 func (o *physics2DServer) ShapeGetType(shape gdnative.Rid) Physics2DServerShapeType {
 	o.ensureSingleton()
@@ -2269,7 +2784,7 @@ func (o *physics2DServer) ShapeGetType(shape gdnative.Rid) Physics2DServerShapeT
 	ptrArguments[0] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "shape_get_type")
+	methodBind := methodBindPhysics2DServerShapeGetType.Get()
 
 	// Call the parent method.
 	// enum.Physics2DServer::ShapeType
@@ -2282,8 +2797,8 @@ func (o *physics2DServer) ShapeGetType(shape gdnative.Rid) Physics2DServerShapeT
 }
 
 /*
-        Sets the shape data that defines its shape and size. The data to be passed depends on the kind of shape created [method shape_get_type].
-	Args: [{ false shape RID} { false data Variant}], Returns: void
+	        Sets the shape data that defines its shape and size. The data to be passed depends on the kind of shape created [method shape_get_type].
+		Args: [{ false shape RID} { false data Variant}], Returns: void
 */
 func (o *physics2DServer) ShapeSetData(shape gdnative.Rid, data gdnative.Variant) {
 	o.ensureSingleton()
@@ -2295,7 +2810,7 @@ func (o *physics2DServer) ShapeSetData(shape gdnative.Rid, data gdnative.Variant
 	ptrArguments[1] = gdnative.NewPointerFromVariant(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "shape_set_data")
+	methodBind := methodBindPhysics2DServerShapeSetData.Get()
 
 	// Call the parent method.
 	// void
@@ -2305,8 +2820,8 @@ func (o *physics2DServer) ShapeSetData(shape gdnative.Rid, data gdnative.Variant
 }
 
 /*
-        Creates a space. A space is a collection of parameters for the physics engine that can be assigned to an area or a body. It can be assigned to an area with [method area_set_space], or to a body with [method body_set_space].
-	Args: [], Returns: RID
+	        Creates a space. A space is a collection of parameters for the physics engine that can be assigned to an area or a body. It can be assigned to an area with [method area_set_space], or to a body with [method body_set_space].
+		Args: [], Returns: RID
 */
 func (o *physics2DServer) SpaceCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2316,7 +2831,7 @@ func (o *physics2DServer) SpaceCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "space_create")
+	methodBind := methodBindPhysics2DServerSpaceCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2329,10 +2844,10 @@ func (o *physics2DServer) SpaceCreate() gdnative.Rid {
 }
 
 /*
-        Returns the state of a space, a [Physics2DDirectSpaceState]. This object can be used to make collision/intersection queries.
-	Args: [{ false space RID}], Returns: Physics2DDirectSpaceState
+	        Returns the state of a space, a [Physics2DDirectSpaceState]. This object can be used to make collision/intersection queries.
+		Args: [{ false space RID}], Returns: Physics2DDirectSpaceState
 */
-func (o *physics2DServer) SpaceGetDirectState(space gdnative.Rid) Physics2DDirectSpaceStateImplementer {
+func (o *physics2DServer) SpaceGetDirectState(space gdnative.Rid) godot.Physics2DDirectSpaceStateImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling Physics2DServer.SpaceGetDirectState()")
 
@@ -2341,7 +2856,7 @@ func (o *physics2DServer) SpaceGetDirectState(space gdnative.Rid) Physics2DDirec
 	ptrArguments[0] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "space_get_direct_state")
+	methodBind := methodBindPhysics2DServerSpaceGetDirectState.Get()
 
 	// Call the parent method.
 	// Physics2DDirectSpaceState
@@ -2349,27 +2864,25 @@ func (o *physics2DServer) SpaceGetDirectState(space gdnative.Rid) Physics2DDirec
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysics2DDirectSpaceStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(Physics2DDirectSpaceStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Physics2DDirectSpaceState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(Physics2DDirectSpaceStateImplementer)
+	ret := godot.Physics2DDirectSpaceState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.Physics2DDirectSpaceStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the value of a space parameter.
-	Args: [{ false space RID} { false param int}], Returns: float
+	        Returns the value of a space parameter.
+		Args: [{ false space RID} { false param int}], Returns: float
 */
 func (o *physics2DServer) SpaceGetParam(space gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -2381,7 +2894,7 @@ func (o *physics2DServer) SpaceGetParam(space gdnative.Rid, param gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "space_get_param")
+	methodBind := methodBindPhysics2DServerSpaceGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -2394,8 +2907,8 @@ func (o *physics2DServer) SpaceGetParam(space gdnative.Rid, param gdnative.Int)
 }
 
 /*
-        Returns whether the space is active.
-	Args: [{ false space RID}], Returns: bool
+	        Returns whether the space is active.
+		Args: [{ false space RID}], Returns: bool
 */
 func (o *physics2DServer) SpaceIsActive(space gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -2406,7 +2919,7 @@ func (o *physics2DServer) SpaceIsActive(space gdnative.Rid) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "space_is_active")
+	methodBind := methodBindPhysics2DServerSpaceIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -2419,8 +2932,8 @@ func (o *physics2DServer) SpaceIsActive(space gdnative.Rid) gdnative.Bool {
 }
 
 /*
-        Marks a space as active. It will not have an effect, unless it is assigned to an area or body.
-	Args: [{ false space RID} { false active bool}], Returns: void
+	        Marks a space as active. It will not have an effect, unless it is assigned to an area or body.
+		Args: [{ false space RID} { false active bool}], Returns: void
 */
 func (o *physics2DServer) SpaceSetActive(space gdnative.Rid, active gdnative.Bool) {
 	o.ensureSingleton()
@@ -2432,7 +2945,7 @@ func (o *physics2DServer) SpaceSetActive(space gdnative.Rid, active gdnative.Boo
 	ptrArguments[1] = gdnative.NewPointerFromBool(active)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "space_set_active")
+	methodBind := methodBindPhysics2DServerSpaceSetActive.Get()
 
 	// Call the parent method.
 	// void
@@ -2442,8 +2955,8 @@ func (o *physics2DServer) SpaceSetActive(space gdnative.Rid, active gdnative.Boo
 }
 
 /*
-        Sets the value for a space parameter. A list of available parameters is on the SPACE_PARAM_* constants.
-	Args: [{ false space RID} { false param int} { false value float}], Returns: void
+	        Sets the value for a space parameter. A list of available parameters is on the SPACE_PARAM_* constants.
+		Args: [{ false space RID} { false param int} { false value float}], Returns: void
 */
 func (o *physics2DServer) SpaceSetParam(space gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -2456,7 +2969,7 @@ func (o *physics2DServer) SpaceSetParam(space gdnative.Rid, param gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DServer", "space_set_param")
+	methodBind := methodBindPhysics2DServerSpaceSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -2465,10 +2978,47 @@ func (o *physics2DServer) SpaceSetParam(space gdnative.Rid, param gdnative.Int,
 
 }
 
+// AreaAddShapeWithDefaults will call AreaAddShape using the default values for: transform.
+func (o *physics2DServer) AreaAddShapeWithDefaults(area gdnative.Rid, shape gdnative.Rid) {
+	o.AreaAddShape(area, shape, *gdnative.NewTransform2DAxisOrigin(gdnative.NewVector2(1, 0), gdnative.NewVector2(0, 1), gdnative.NewVector2(0, 0)))
+}
+
+// BodyAddShapeWithDefaults will call BodyAddShape using the default values for: transform.
+func (o *physics2DServer) BodyAddShapeWithDefaults(body gdnative.Rid, shape gdnative.Rid) {
+	o.BodyAddShape(body, shape, *gdnative.NewTransform2DAxisOrigin(gdnative.NewVector2(1, 0), gdnative.NewVector2(0, 1), gdnative.NewVector2(0, 0)))
+}
+
+// BodySetForceIntegrationCallbackWithDefaults will call BodySetForceIntegrationCallback using the default values for: userdata.
+func (o *physics2DServer) BodySetForceIntegrationCallbackWithDefaults(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String) {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	o.BodySetForceIntegrationCallback(body, receiver, method, default0)
+}
+
+// BodyTestMotionWithDefaults will call BodyTestMotion using the default values for: margin, result.
+func (o *physics2DServer) BodyTestMotionWithDefaults(body gdnative.Rid, from gdnative.Transform2D, motion gdnative.Vector2) gdnative.Bool {
+	return o.BodyTestMotion(body, from, motion, 0.08, nil)
+}
+
+// DampedSpringJointCreateWithDefaults will call DampedSpringJointCreate using the default values for: body_b.
+func (o *physics2DServer) DampedSpringJointCreateWithDefaults(anchorA gdnative.Vector2, anchorB gdnative.Vector2, bodyA gdnative.Rid) gdnative.Rid {
+	return o.DampedSpringJointCreate(anchorA, anchorB, bodyA, gdnative.NewRid())
+}
+
+// GrooveJointCreateWithDefaults will call GrooveJointCreate using the default values for: body_a, body_b.
+func (o *physics2DServer) GrooveJointCreateWithDefaults(groove1A gdnative.Vector2, groove2A gdnative.Vector2, anchorB gdnative.Vector2) gdnative.Rid {
+	return o.GrooveJointCreate(groove1A, groove2A, anchorB, gdnative.NewRid(), gdnative.NewRid())
+}
+
+// PinJointCreateWithDefaults will call PinJointCreate using the default values for: body_b.
+func (o *physics2DServer) PinJointCreateWithDefaults(anchor gdnative.Vector2, bodyA gdnative.Rid) gdnative.Rid {
+	return o.PinJointCreate(anchor, bodyA, gdnative.NewRid())
+}
+
 // Physics2DServerImplementer is an interface that implements the methods
 // of the Physics2DServer class.
 type Physics2DServerImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	AreaAddShape(area gdnative.Rid, shape gdnative.Rid, transform gdnative.Transform2D)
 	AreaAttachObjectInstanceId(area gdnative.Rid, id gdnative.Int)
 	AreaClearShapes(area gdnative.Rid)
@@ -2479,11 +3029,12 @@ type Physics2DServerImplementer interface {
 	AreaGetShapeCount(area gdnative.Rid) gdnative.Int
 	AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdnative.Int) gdnative.Transform2D
 	AreaGetSpace(area gdnative.Rid) gdnative.Rid
+	AreaGetSpaceOverrideMode(area gdnative.Rid) Physics2DServerAreaSpaceOverrideMode
 	AreaGetTransform(area gdnative.Rid) gdnative.Transform2D
 	AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.Int)
 	AreaSetCollisionLayer(area gdnative.Rid, layer gdnative.Int)
 	AreaSetCollisionMask(area gdnative.Rid, mask gdnative.Int)
-	AreaSetMonitorCallback(area gdnative.Rid, receiver ObjectImplementer, method gdnative.String)
+	AreaSetMonitorCallback(area gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String)
 	AreaSetParam(area gdnative.Rid, param gdnative.Int, value gdnative.Variant)
 	AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int, shape gdnative.Rid)
 	AreaSetShapeDisabled(area gdnative.Rid, shapeIdx gdnative.Int, disable gdnative.Bool)
@@ -2500,8 +3051,10 @@ type Physics2DServerImplementer interface {
 	BodyCreate() gdnative.Rid
 	BodyGetCollisionLayer(body gdnative.Rid) gdnative.Int
 	BodyGetCollisionMask(body gdnative.Rid) gdnative.Int
+	BodyGetContinuousCollisionDetectionMode(body gdnative.Rid) Physics2DServerCCDMode
 	BodyGetDirectState(body gdnative.Rid) Physics2DDirectBodyStateImplementer
 	BodyGetMaxContactsReported(body gdnative.Rid) gdnative.Int
+	BodyGetMode(body gdnative.Rid) Physics2DServerBodyMode
 	BodyGetObjectInstanceId(body gdnative.Rid) gdnative.Int
 	BodyGetParam(body gdnative.Rid, param gdnative.Int) gdnative.Real
 	BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int) gdnative.Rid
@@ -2517,7 +3070,7 @@ type Physics2DServerImplementer interface {
 	BodySetCollisionLayer(body gdnative.Rid, layer gdnative.Int)
 	BodySetCollisionMask(body gdnative.Rid, mask gdnative.Int)
 	BodySetContinuousCollisionDetectionMode(body gdnative.Rid, mode gdnative.Int)
-	BodySetForceIntegrationCallback(body gdnative.Rid, receiver ObjectImplementer, method gdnative.String, userdata gdnative.Variant)
+	BodySetForceIntegrationCallback(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant)
 	BodySetMaxContactsReported(body gdnative.Rid, amount gdnative.Int)
 	BodySetMode(body gdnative.Rid, mode gdnative.Int)
 	BodySetOmitForceIntegration(body gdnative.Rid, enable gdnative.Bool)
@@ -2538,9 +3091,10 @@ type Physics2DServerImplementer interface {
 	DampedStringJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real
 	DampedStringJointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real)
 	FreeRid(rid gdnative.Rid)
-	GetProcessInfo(processInfo gdnative.Int) gdnative.Int
+	GetProcessInfo(processInfo Physics2DServerProcessInfo) gdnative.Int
 	GrooveJointCreate(groove1A gdnative.Vector2, groove2A gdnative.Vector2, anchorB gdnative.Vector2, bodyA gdnative.Rid, bodyB gdnative.Rid) gdnative.Rid
 	JointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real
+	JointGetType(joint gdnative.Rid) Physics2DServerJointType
 	JointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real)
 	LineShapeCreate() gdnative.Rid
 	PinJointCreate(anchor gdnative.Vector2, bodyA gdnative.Rid, bodyB gdnative.Rid) gdnative.Rid
@@ -2549,11 +3103,19 @@ type Physics2DServerImplementer interface {
 	SegmentShapeCreate() gdnative.Rid
 	SetActive(active gdnative.Bool)
 	ShapeGetData(shape gdnative.Rid) gdnative.Variant
+	ShapeGetType(shape gdnative.Rid) Physics2DServerShapeType
 	ShapeSetData(shape gdnative.Rid, data gdnative.Variant)
 	SpaceCreate() gdnative.Rid
-	SpaceGetDirectState(space gdnative.Rid) Physics2DDirectSpaceStateImplementer
+	SpaceGetDirectState(space gdnative.Rid) godot.Physics2DDirectSpaceStateImplementer
 	SpaceGetParam(space gdnative.Rid, param gdnative.Int) gdnative.Real
 	SpaceIsActive(space gdnative.Rid) gdnative.Bool
 	SpaceSetActive(space gdnative.Rid, active gdnative.Bool)
 	SpaceSetParam(space gdnative.Rid, param gdnative.Int, value gdnative.Real)
+	AreaAddShapeWithDefaults(area gdnative.Rid, shape gdnative.Rid)
+	BodyAddShapeWithDefaults(body gdnative.Rid, shape gdnative.Rid)
+	BodySetForceIntegrationCallbackWithDefaults(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String)
+	BodyTestMotionWithDefaults(body gdnative.Rid, from gdnative.Transform2D, motion gdnative.Vector2) gdnative.Bool
+	DampedSpringJointCreateWithDefaults(anchorA gdnative.Vector2, anchorB gdnative.Vector2, bodyA gdnative.Rid) gdnative.Rid
+	GrooveJointCreateWithDefaults(groove1A gdnative.Vector2, groove2A gdnative.Vector2, anchorB gdnative.Vector2) gdnative.Rid
+	PinJointCreateWithDefaults(anchor gdnative.Vector2, bodyA gdnative.Rid) gdnative.Rid
 }
@@ -1,4 +1,4 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysics2DServerSWFromPointer(ptr gdnative.Pointer) Physics2DServerSW {
-func newPhysics2DServerSWFromPointer(ptr gdnative.Pointer) Physics2DServerSW {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Physics2DServerSW{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This class exposes no new methods or properties and should not be used, as [Physics2DServer] automatically selects the best implementation available.
 */
@@ -1,7 +1,8 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysics2DShapeQueryResultFromPointer(ptr gdnative.Pointer) Physics2DShapeQueryResult {
-func newPhysics2DShapeQueryResultFromPointer(ptr gdnative.Pointer) Physics2DShapeQueryResult {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Physics2DShapeQueryResult{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type Physics2DShapeQueryResult struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
@@ -34,9 +25,18 @@ func (o *Physics2DShapeQueryResult) BaseClass() string {
 	return "Physics2DShapeQueryResult"
 }
 
-/*
+// Method binds of the Physics2DShapeQueryResult class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysics2DShapeQueryResultGetResultCount       = gdnative.NewLazyMethodBind("Physics2DShapeQueryResult", "get_result_count")
+	methodBindPhysics2DShapeQueryResultGetResultObject      = gdnative.NewLazyMethodBind("Physics2DShapeQueryResult", "get_result_object")
+	methodBindPhysics2DShapeQueryResultGetResultObjectId    = gdnative.NewLazyMethodBind("Physics2DShapeQueryResult", "get_result_object_id")
+	methodBindPhysics2DShapeQueryResultGetResultObjectShape = gdnative.NewLazyMethodBind("Physics2DShapeQueryResult", "get_result_object_shape")
+	methodBindPhysics2DShapeQueryResultGetResultRid         = gdnative.NewLazyMethodBind("Physics2DShapeQueryResult", "get_result_rid")
+)
 
-	Args: [], Returns: int
+/*
+Args: [], Returns: int
 */
 func (o *Physics2DShapeQueryResult) GetResultCount() gdnative.Int {
 	//log.Println("Calling Physics2DShapeQueryResult.GetResultCount()")
@@ -45,7 +45,7 @@ func (o *Physics2DShapeQueryResult) GetResultCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryResult", "get_result_count")
+	methodBind := methodBindPhysics2DShapeQueryResultGetResultCount.Get()
 
 	// Call the parent method.
 	// int
@@ -58,10 +58,9 @@ func (o *Physics2DShapeQueryResult) GetResultCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Object
+Args: [{ false idx int}], Returns: Object
 */
-func (o *Physics2DShapeQueryResult) GetResultObject(idx gdnative.Int) ObjectImplementer {
+func (o *Physics2DShapeQueryResult) GetResultObject(idx gdnative.Int) godot.ObjectImplementer {
 	//log.Println("Calling Physics2DShapeQueryResult.GetResultObject()")
 
 	// Build out the method's arguments
@@ -69,7 +68,7 @@ func (o *Physics2DShapeQueryResult) GetResultObject(idx gdnative.Int) ObjectImpl
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryResult", "get_result_object")
+	methodBind := methodBindPhysics2DShapeQueryResultGetResultObject.Get()
 
 	// Call the parent method.
 	// Object
@@ -77,27 +76,24 @@ func (o *Physics2DShapeQueryResult) GetResultObject(idx gdnative.Int) ObjectImpl
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: int
+Args: [{ false idx int}], Returns: int
 */
 func (o *Physics2DShapeQueryResult) GetResultObjectId(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling Physics2DShapeQueryResult.GetResultObjectId()")
@@ -107,7 +103,7 @@ func (o *Physics2DShapeQueryResult) GetResultObjectId(idx gdnative.Int) gdnative
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryResult", "get_result_object_id")
+	methodBind := methodBindPhysics2DShapeQueryResultGetResultObjectId.Get()
 
 	// Call the parent method.
 	// int
@@ -120,8 +116,7 @@ func (o *Physics2DShapeQueryResult) GetResultObjectId(idx gdnative.Int) gdnative
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: int
+Args: [{ false idx int}], Returns: int
 */
 func (o *Physics2DShapeQueryResult) GetResultObjectShape(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling Physics2DShapeQueryResult.GetResultObjectShape()")
@@ -131,7 +126,7 @@ func (o *Physics2DShapeQueryResult) GetResultObjectShape(idx gdnative.Int) gdnat
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryResult", "get_result_object_shape")
+	methodBind := methodBindPhysics2DShapeQueryResultGetResultObjectShape.Get()
 
 	// Call the parent method.
 	// int
@@ -144,8 +139,7 @@ func (o *Physics2DShapeQueryResult) GetResultObjectShape(idx gdnative.Int) gdnat
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: RID
+Args: [{ false idx int}], Returns: RID
 */
 func (o *Physics2DShapeQueryResult) GetResultRid(idx gdnative.Int) gdnative.Rid {
 	//log.Println("Calling Physics2DShapeQueryResult.GetResultRid()")
@@ -155,7 +149,7 @@ func (o *Physics2DShapeQueryResult) GetResultRid(idx gdnative.Int) gdnative.Rid
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryResult", "get_result_rid")
+	methodBind := methodBindPhysics2DShapeQueryResultGetResultRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -170,9 +164,9 @@ func (o *Physics2DShapeQueryResult) GetResultRid(idx gdnative.Int) gdnative.Rid
 // Physics2DShapeQueryResultImplementer is an interface that implements the methods
 // of the Physics2DShapeQueryResult class.
 type Physics2DShapeQueryResultImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetResultCount() gdnative.Int
-	GetResultObject(idx gdnative.Int) ObjectImplementer
+	GetResultObject(idx gdnative.Int) godot.ObjectImplementer
 	GetResultObjectId(idx gdnative.Int) gdnative.Int
 	GetResultObjectShape(idx gdnative.Int) gdnative.Int
 	GetResultRid(idx gdnative.Int) gdnative.Rid
@@ -1,7 +1,8 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,39 +14,60 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysics2DTestMotionResultFromPointer(ptr gdnative.Pointer) Physics2DTestMotionResult {
-func newPhysics2DTestMotionResultFromPointer(ptr gdnative.Pointer) Physics2DTestMotionResult {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Physics2DTestMotionResult{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type Physics2DTestMotionResult struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorPhysics2DTestMotionResult is the class constructor of the Physics2DTestMotionResult class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPhysics2DTestMotionResult = gdnative.NewLazyClassConstructor("Physics2DTestMotionResult")
+
+// NewPhysics2DTestMotionResult will create a new instance of the Physics2DTestMotionResult class.
+// Physics2DTestMotionResult is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPhysics2DTestMotionResult() *Physics2DTestMotionResult {
+	obj := &Physics2DTestMotionResult{}
+	obj.SetBaseObject(classConstructorPhysics2DTestMotionResult.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Physics2DTestMotionResult) BaseClass() string {
 	return "Physics2DTestMotionResult"
 }
 
+// Method binds of the Physics2DTestMotionResult class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysics2DTestMotionResultGetCollider         = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_collider")
+	methodBindPhysics2DTestMotionResultGetColliderId       = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_collider_id")
+	methodBindPhysics2DTestMotionResultGetColliderRid      = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_collider_rid")
+	methodBindPhysics2DTestMotionResultGetColliderShape    = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_collider_shape")
+	methodBindPhysics2DTestMotionResultGetColliderVelocity = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_collider_velocity")
+	methodBindPhysics2DTestMotionResultGetCollisionNormal  = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_collision_normal")
+	methodBindPhysics2DTestMotionResultGetCollisionPoint   = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_collision_point")
+	methodBindPhysics2DTestMotionResultGetMotion           = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_motion")
+	methodBindPhysics2DTestMotionResultGetMotionRemainder  = gdnative.NewLazyMethodBind("Physics2DTestMotionResult", "get_motion_remainder")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Object
+	        Undocumented
+		Args: [], Returns: Object
 */
-func (o *Physics2DTestMotionResult) GetCollider() ObjectImplementer {
+func (o *Physics2DTestMotionResult) GetCollider() godot.ObjectImplementer {
 	//log.Println("Calling Physics2DTestMotionResult.GetCollider()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_collider")
+	methodBind := methodBindPhysics2DTestMotionResultGetCollider.Get()
 
 	// Call the parent method.
 	// Object
@@ -53,27 +75,25 @@ func (o *Physics2DTestMotionResult) GetCollider() ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Physics2DTestMotionResult) GetColliderId() gdnative.Int {
 	//log.Println("Calling Physics2DTestMotionResult.GetColliderId()")
@@ -82,7 +102,7 @@ func (o *Physics2DTestMotionResult) GetColliderId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_collider_id")
+	methodBind := methodBindPhysics2DTestMotionResultGetColliderId.Get()
 
 	// Call the parent method.
 	// int
@@ -95,8 +115,8 @@ func (o *Physics2DTestMotionResult) GetColliderId() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *Physics2DTestMotionResult) GetColliderRid() gdnative.Rid {
 	//log.Println("Calling Physics2DTestMotionResult.GetColliderRid()")
@@ -105,7 +125,7 @@ func (o *Physics2DTestMotionResult) GetColliderRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_collider_rid")
+	methodBind := methodBindPhysics2DTestMotionResultGetColliderRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -118,8 +138,8 @@ func (o *Physics2DTestMotionResult) GetColliderRid() gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Physics2DTestMotionResult) GetColliderShape() gdnative.Int {
 	//log.Println("Calling Physics2DTestMotionResult.GetColliderShape()")
@@ -128,7 +148,7 @@ func (o *Physics2DTestMotionResult) GetColliderShape() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_collider_shape")
+	methodBind := methodBindPhysics2DTestMotionResultGetColliderShape.Get()
 
 	// Call the parent method.
 	// int
@@ -141,8 +161,8 @@ func (o *Physics2DTestMotionResult) GetColliderShape() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DTestMotionResult) GetColliderVelocity() gdnative.Vector2 {
 	//log.Println("Calling Physics2DTestMotionResult.GetColliderVelocity()")
@@ -151,7 +171,7 @@ func (o *Physics2DTestMotionResult) GetColliderVelocity() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_collider_velocity")
+	methodBind := methodBindPhysics2DTestMotionResultGetColliderVelocity.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -164,8 +184,8 @@ func (o *Physics2DTestMotionResult) GetColliderVelocity() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DTestMotionResult) GetCollisionNormal() gdnative.Vector2 {
 	//log.Println("Calling Physics2DTestMotionResult.GetCollisionNormal()")
@@ -174,7 +194,7 @@ func (o *Physics2DTestMotionResult) GetCollisionNormal() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_collision_normal")
+	methodBind := methodBindPhysics2DTestMotionResultGetCollisionNormal.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -187,8 +207,8 @@ func (o *Physics2DTestMotionResult) GetCollisionNormal() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DTestMotionResult) GetCollisionPoint() gdnative.Vector2 {
 	//log.Println("Calling Physics2DTestMotionResult.GetCollisionPoint()")
@@ -197,7 +217,7 @@ func (o *Physics2DTestMotionResult) GetCollisionPoint() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_collision_point")
+	methodBind := methodBindPhysics2DTestMotionResultGetCollisionPoint.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -210,8 +230,8 @@ func (o *Physics2DTestMotionResult) GetCollisionPoint() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DTestMotionResult) GetMotion() gdnative.Vector2 {
 	//log.Println("Calling Physics2DTestMotionResult.GetMotion()")
@@ -220,7 +240,7 @@ func (o *Physics2DTestMotionResult) GetMotion() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_motion")
+	methodBind := methodBindPhysics2DTestMotionResultGetMotion.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -233,8 +253,8 @@ func (o *Physics2DTestMotionResult) GetMotion() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DTestMotionResult) GetMotionRemainder() gdnative.Vector2 {
 	//log.Println("Calling Physics2DTestMotionResult.GetMotionRemainder()")
@@ -243,7 +263,7 @@ func (o *Physics2DTestMotionResult) GetMotionRemainder() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DTestMotionResult", "get_motion_remainder")
+	methodBind := methodBindPhysics2DTestMotionResultGetMotionRemainder.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -255,11 +275,56 @@ func (o *Physics2DTestMotionResult) GetMotionRemainder() gdnative.Vector2 {
 	return ret
 }
 
+// Collider will return the value of the "collider" property.
+func (o *Physics2DTestMotionResult) Collider() godot.ObjectImplementer {
+	return o.GetCollider()
+}
+
+// ColliderId will return the value of the "collider_id" property.
+func (o *Physics2DTestMotionResult) ColliderId() gdnative.Int {
+	return o.GetColliderId()
+}
+
+// ColliderRid will return the value of the "collider_rid" property.
+func (o *Physics2DTestMotionResult) ColliderRid() gdnative.Rid {
+	return o.GetColliderRid()
+}
+
+// ColliderShape will return the value of the "collider_shape" property.
+func (o *Physics2DTestMotionResult) ColliderShape() gdnative.Int {
+	return o.GetColliderShape()
+}
+
+// ColliderVelocity will return the value of the "collider_velocity" property.
+func (o *Physics2DTestMotionResult) ColliderVelocity() gdnative.Vector2 {
+	return o.GetColliderVelocity()
+}
+
+// CollisionNormal will return the value of the "collision_normal" property.
+func (o *Physics2DTestMotionResult) CollisionNormal() gdnative.Vector2 {
+	return o.GetCollisionNormal()
+}
+
+// CollisionPoint will return the value of the "collision_point" property.
+func (o *Physics2DTestMotionResult) CollisionPoint() gdnative.Vector2 {
+	return o.GetCollisionPoint()
+}
+
+// Motion will return the value of the "motion" property.
+func (o *Physics2DTestMotionResult) Motion() gdnative.Vector2 {
+	return o.GetMotion()
+}
+
+// MotionRemainder will return the value of the "motion_remainder" property.
+func (o *Physics2DTestMotionResult) MotionRemainder() gdnative.Vector2 {
+	return o.GetMotionRemainder()
+}
+
 // Physics2DTestMotionResultImplementer is an interface that implements the methods
 // of the Physics2DTestMotionResult class.
 type Physics2DTestMotionResultImplementer interface {
-	ReferenceImplementer
-	GetCollider() ObjectImplementer
+	godot.ReferenceImplementer
+	GetCollider() godot.ObjectImplementer
 	GetColliderId() gdnative.Int
 	GetColliderRid() gdnative.Rid
 	GetColliderShape() gdnative.Int
@@ -268,4 +333,13 @@ type Physics2DTestMotionResultImplementer interface {
 	GetCollisionPoint() gdnative.Vector2
 	GetMotion() gdnative.Vector2
 	GetMotionRemainder() gdnative.Vector2
+	Collider() godot.ObjectImplementer
+	ColliderId() gdnative.Int
+	ColliderRid() gdnative.Rid
+	ColliderShape() gdnative.Int
+	ColliderVelocity() gdnative.Vector2
+	CollisionNormal() gdnative.Vector2
+	CollisionPoint() gdnative.Vector2
+	Motion() gdnative.Vector2
+	MotionRemainder() gdnative.Vector2
 }
@@ -1,7 +1,8 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysicsDirectBodyStateFromPointer(ptr gdnative.Pointer) PhysicsDirectBodyState {
-func newPhysicsDirectBodyStateFromPointer(ptr gdnative.Pointer) PhysicsDirectBodyState {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PhysicsDirectBodyState{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PhysicsDirectBodyState struct {
-	Object
+	godot.Object
 	owner gdnative.Object
 }
 
@@ -34,9 +25,44 @@ func (o *PhysicsDirectBodyState) BaseClass() string {
 	return "PhysicsDirectBodyState"
 }
 
-/*
+// Method binds of the PhysicsDirectBodyState class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysicsDirectBodyStateAddForce                             = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "add_force")
+	methodBindPhysicsDirectBodyStateApplyImpulse                         = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "apply_impulse")
+	methodBindPhysicsDirectBodyStateApplyTorqeImpulse                    = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "apply_torqe_impulse")
+	methodBindPhysicsDirectBodyStateGetAngularVelocity                   = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_angular_velocity")
+	methodBindPhysicsDirectBodyStateGetCenterOfMass                      = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_center_of_mass")
+	methodBindPhysicsDirectBodyStateGetContactCollider                   = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_collider")
+	methodBindPhysicsDirectBodyStateGetContactColliderId                 = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_collider_id")
+	methodBindPhysicsDirectBodyStateGetContactColliderObject             = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_collider_object")
+	methodBindPhysicsDirectBodyStateGetContactColliderPosition           = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_collider_position")
+	methodBindPhysicsDirectBodyStateGetContactColliderShape              = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_collider_shape")
+	methodBindPhysicsDirectBodyStateGetContactColliderVelocityAtPosition = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_collider_velocity_at_position")
+	methodBindPhysicsDirectBodyStateGetContactCount                      = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_count")
+	methodBindPhysicsDirectBodyStateGetContactLocalNormal                = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_local_normal")
+	methodBindPhysicsDirectBodyStateGetContactLocalPosition              = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_local_position")
+	methodBindPhysicsDirectBodyStateGetContactLocalShape                 = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_contact_local_shape")
+	methodBindPhysicsDirectBodyStateGetInverseInertia                    = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_inverse_inertia")
+	methodBindPhysicsDirectBodyStateGetInverseMass                       = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_inverse_mass")
+	methodBindPhysicsDirectBodyStateGetLinearVelocity                    = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_linear_velocity")
+	methodBindPhysicsDirectBodyStateGetPrincipalInertiaAxes              = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_principal_inertia_axes")
+	methodBindPhysicsDirectBodyStateGetSpaceState                        = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_space_state")
+	methodBindPhysicsDirectBodyStateGetStep                              = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_step")
+	methodBindPhysicsDirectBodyStateGetTotalAngularDamp                  = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_total_angular_damp")
+	methodBindPhysicsDirectBodyStateGetTotalGravity                      = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_total_gravity")
+	methodBindPhysicsDirectBodyStateGetTotalLinearDamp                   = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_total_linear_damp")
+	methodBindPhysicsDirectBodyStateGetTransform                         = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "get_transform")
+	methodBindPhysicsDirectBodyStateIntegrateForces                      = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "integrate_forces")
+	methodBindPhysicsDirectBodyStateIsSleeping                           = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "is_sleeping")
+	methodBindPhysicsDirectBodyStateSetAngularVelocity                   = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "set_angular_velocity")
+	methodBindPhysicsDirectBodyStateSetLinearVelocity                    = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "set_linear_velocity")
+	methodBindPhysicsDirectBodyStateSetSleepState                        = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "set_sleep_state")
+	methodBindPhysicsDirectBodyStateSetTransform                         = gdnative.NewLazyMethodBind("PhysicsDirectBodyState", "set_transform")
+)
 
-	Args: [{ false force Vector3} { false position Vector3}], Returns: void
+/*
+Args: [{ false force Vector3} { false position Vector3}], Returns: void
 */
 func (o *PhysicsDirectBodyState) AddForce(force gdnative.Vector3, position gdnative.Vector3) {
 	//log.Println("Calling PhysicsDirectBodyState.AddForce()")
@@ -47,7 +73,7 @@ func (o *PhysicsDirectBodyState) AddForce(force gdnative.Vector3, position gdnat
 	ptrArguments[1] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "add_force")
+	methodBind := methodBindPhysicsDirectBodyStateAddForce.Get()
 
 	// Call the parent method.
 	// void
@@ -57,8 +83,7 @@ func (o *PhysicsDirectBodyState) AddForce(force gdnative.Vector3, position gdnat
 }
 
 /*
-
-	Args: [{ false position Vector3} { false j Vector3}], Returns: void
+Args: [{ false position Vector3} { false j Vector3}], Returns: void
 */
 func (o *PhysicsDirectBodyState) ApplyImpulse(position gdnative.Vector3, j gdnative.Vector3) {
 	//log.Println("Calling PhysicsDirectBodyState.ApplyImpulse()")
@@ -69,7 +94,7 @@ func (o *PhysicsDirectBodyState) ApplyImpulse(position gdnative.Vector3, j gdnat
 	ptrArguments[1] = gdnative.NewPointerFromVector3(j)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "apply_impulse")
+	methodBind := methodBindPhysicsDirectBodyStateApplyImpulse.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +104,8 @@ func (o *PhysicsDirectBodyState) ApplyImpulse(position gdnative.Vector3, j gdnat
 }
 
 /*
-        Undocumented
-	Args: [{ false j Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false j Vector3}], Returns: void
 */
 func (o *PhysicsDirectBodyState) ApplyTorqeImpulse(j gdnative.Vector3) {
 	//log.Println("Calling PhysicsDirectBodyState.ApplyTorqeImpulse()")
@@ -90,7 +115,7 @@ func (o *PhysicsDirectBodyState) ApplyTorqeImpulse(j gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(j)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "apply_torqe_impulse")
+	methodBind := methodBindPhysicsDirectBodyStateApplyTorqeImpulse.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +125,8 @@ func (o *PhysicsDirectBodyState) ApplyTorqeImpulse(j gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetAngularVelocity() gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetAngularVelocity()")
@@ -110,7 +135,7 @@ func (o *PhysicsDirectBodyState) GetAngularVelocity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_angular_velocity")
+	methodBind := methodBindPhysicsDirectBodyStateGetAngularVelocity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -123,8 +148,8 @@ func (o *PhysicsDirectBodyState) GetAngularVelocity() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetCenterOfMass() gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetCenterOfMass()")
@@ -133,7 +158,7 @@ func (o *PhysicsDirectBodyState) GetCenterOfMass() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_center_of_mass")
+	methodBind := methodBindPhysicsDirectBodyStateGetCenterOfMass.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -146,8 +171,7 @@ func (o *PhysicsDirectBodyState) GetCenterOfMass() gdnative.Vector3 {
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: RID
+Args: [{ false contact_idx int}], Returns: RID
 */
 func (o *PhysicsDirectBodyState) GetContactCollider(contactIdx gdnative.Int) gdnative.Rid {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactCollider()")
@@ -157,7 +181,7 @@ func (o *PhysicsDirectBodyState) GetContactCollider(contactIdx gdnative.Int) gdn
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_collider")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactCollider.Get()
 
 	// Call the parent method.
 	// RID
@@ -170,8 +194,7 @@ func (o *PhysicsDirectBodyState) GetContactCollider(contactIdx gdnative.Int) gdn
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: int
+Args: [{ false contact_idx int}], Returns: int
 */
 func (o *PhysicsDirectBodyState) GetContactColliderId(contactIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactColliderId()")
@@ -181,7 +204,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderId(contactIdx gdnative.Int) g
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_collider_id")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactColliderId.Get()
 
 	// Call the parent method.
 	// int
@@ -194,10 +217,9 @@ func (o *PhysicsDirectBodyState) GetContactColliderId(contactIdx gdnative.Int) g
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: Object
+Args: [{ false contact_idx int}], Returns: Object
 */
-func (o *PhysicsDirectBodyState) GetContactColliderObject(contactIdx gdnative.Int) ObjectImplementer {
+func (o *PhysicsDirectBodyState) GetContactColliderObject(contactIdx gdnative.Int) godot.ObjectImplementer {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactColliderObject()")
 
 	// Build out the method's arguments
@@ -205,7 +227,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderObject(contactIdx gdnative.In
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_collider_object")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactColliderObject.Get()
 
 	// Call the parent method.
 	// Object
@@ -213,27 +235,24 @@ func (o *PhysicsDirectBodyState) GetContactColliderObject(contactIdx gdnative.In
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: Vector3
+Args: [{ false contact_idx int}], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetContactColliderPosition(contactIdx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactColliderPosition()")
@@ -243,7 +262,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderPosition(contactIdx gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_collider_position")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactColliderPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -256,8 +275,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderPosition(contactIdx gdnative.
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: int
+Args: [{ false contact_idx int}], Returns: int
 */
 func (o *PhysicsDirectBodyState) GetContactColliderShape(contactIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactColliderShape()")
@@ -267,7 +285,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderShape(contactIdx gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_collider_shape")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactColliderShape.Get()
 
 	// Call the parent method.
 	// int
@@ -280,8 +298,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderShape(contactIdx gdnative.Int
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: Vector3
+Args: [{ false contact_idx int}], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetContactColliderVelocityAtPosition(contactIdx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactColliderVelocityAtPosition()")
@@ -291,7 +308,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderVelocityAtPosition(contactIdx
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_collider_velocity_at_position")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactColliderVelocityAtPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -304,8 +321,7 @@ func (o *PhysicsDirectBodyState) GetContactColliderVelocityAtPosition(contactIdx
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *PhysicsDirectBodyState) GetContactCount() gdnative.Int {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactCount()")
@@ -314,7 +330,7 @@ func (o *PhysicsDirectBodyState) GetContactCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_count")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactCount.Get()
 
 	// Call the parent method.
 	// int
@@ -327,8 +343,7 @@ func (o *PhysicsDirectBodyState) GetContactCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: Vector3
+Args: [{ false contact_idx int}], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetContactLocalNormal(contactIdx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactLocalNormal()")
@@ -338,7 +353,7 @@ func (o *PhysicsDirectBodyState) GetContactLocalNormal(contactIdx gdnative.Int)
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_local_normal")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactLocalNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -351,8 +366,7 @@ func (o *PhysicsDirectBodyState) GetContactLocalNormal(contactIdx gdnative.Int)
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: Vector3
+Args: [{ false contact_idx int}], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetContactLocalPosition(contactIdx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactLocalPosition()")
@@ -362,7 +376,7 @@ func (o *PhysicsDirectBodyState) GetContactLocalPosition(contactIdx gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_local_position")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactLocalPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -375,8 +389,7 @@ func (o *PhysicsDirectBodyState) GetContactLocalPosition(contactIdx gdnative.Int
 }
 
 /*
-
-	Args: [{ false contact_idx int}], Returns: int
+Args: [{ false contact_idx int}], Returns: int
 */
 func (o *PhysicsDirectBodyState) GetContactLocalShape(contactIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling PhysicsDirectBodyState.GetContactLocalShape()")
@@ -386,7 +399,7 @@ func (o *PhysicsDirectBodyState) GetContactLocalShape(contactIdx gdnative.Int) g
 	ptrArguments[0] = gdnative.NewPointerFromInt(contactIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_contact_local_shape")
+	methodBind := methodBindPhysicsDirectBodyStateGetContactLocalShape.Get()
 
 	// Call the parent method.
 	// int
@@ -399,8 +412,8 @@ func (o *PhysicsDirectBodyState) GetContactLocalShape(contactIdx gdnative.Int) g
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetInverseInertia() gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetInverseInertia()")
@@ -409,7 +422,7 @@ func (o *PhysicsDirectBodyState) GetInverseInertia() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_inverse_inertia")
+	methodBind := methodBindPhysicsDirectBodyStateGetInverseInertia.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -422,8 +435,8 @@ func (o *PhysicsDirectBodyState) GetInverseInertia() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PhysicsDirectBodyState) GetInverseMass() gdnative.Real {
 	//log.Println("Calling PhysicsDirectBodyState.GetInverseMass()")
@@ -432,7 +445,7 @@ func (o *PhysicsDirectBodyState) GetInverseMass() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_inverse_mass")
+	methodBind := methodBindPhysicsDirectBodyStateGetInverseMass.Get()
 
 	// Call the parent method.
 	// float
@@ -445,8 +458,8 @@ func (o *PhysicsDirectBodyState) GetInverseMass() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetLinearVelocity() gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetLinearVelocity()")
@@ -455,7 +468,7 @@ func (o *PhysicsDirectBodyState) GetLinearVelocity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_linear_velocity")
+	methodBind := methodBindPhysicsDirectBodyStateGetLinearVelocity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -468,8 +481,8 @@ func (o *PhysicsDirectBodyState) GetLinearVelocity() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Basis
+	        Undocumented
+		Args: [], Returns: Basis
 */
 func (o *PhysicsDirectBodyState) GetPrincipalInertiaAxes() gdnative.Basis {
 	//log.Println("Calling PhysicsDirectBodyState.GetPrincipalInertiaAxes()")
@@ -478,7 +491,7 @@ func (o *PhysicsDirectBodyState) GetPrincipalInertiaAxes() gdnative.Basis {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_principal_inertia_axes")
+	methodBind := methodBindPhysicsDirectBodyStateGetPrincipalInertiaAxes.Get()
 
 	// Call the parent method.
 	// Basis
@@ -491,17 +504,16 @@ func (o *PhysicsDirectBodyState) GetPrincipalInertiaAxes() gdnative.Basis {
 }
 
 /*
-
-	Args: [], Returns: PhysicsDirectSpaceState
+Args: [], Returns: PhysicsDirectSpaceState
 */
-func (o *PhysicsDirectBodyState) GetSpaceState() PhysicsDirectSpaceStateImplementer {
+func (o *PhysicsDirectBodyState) GetSpaceState() godot.PhysicsDirectSpaceStateImplementer {
 	//log.Println("Calling PhysicsDirectBodyState.GetSpaceState()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_space_state")
+	methodBind := methodBindPhysicsDirectBodyStateGetSpaceState.Get()
 
 	// Call the parent method.
 	// PhysicsDirectSpaceState
@@ -509,27 +521,25 @@ func (o *PhysicsDirectBodyState) GetSpaceState() PhysicsDirectSpaceStateImplemen
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysicsDirectSpaceStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PhysicsDirectSpaceStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PhysicsDirectSpaceState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PhysicsDirectSpaceStateImplementer)
+	ret := godot.PhysicsDirectSpaceState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.PhysicsDirectSpaceStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PhysicsDirectBodyState) GetStep() gdnative.Real {
 	//log.Println("Calling PhysicsDirectBodyState.GetStep()")
@@ -538,7 +548,7 @@ func (o *PhysicsDirectBodyState) GetStep() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_step")
+	methodBind := methodBindPhysicsDirectBodyStateGetStep.Get()
 
 	// Call the parent method.
 	// float
@@ -551,8 +561,8 @@ func (o *PhysicsDirectBodyState) GetStep() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PhysicsDirectBodyState) GetTotalAngularDamp() gdnative.Real {
 	//log.Println("Calling PhysicsDirectBodyState.GetTotalAngularDamp()")
@@ -561,7 +571,7 @@ func (o *PhysicsDirectBodyState) GetTotalAngularDamp() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_total_angular_damp")
+	methodBind := methodBindPhysicsDirectBodyStateGetTotalAngularDamp.Get()
 
 	// Call the parent method.
 	// float
@@ -574,8 +584,8 @@ func (o *PhysicsDirectBodyState) GetTotalAngularDamp() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *PhysicsDirectBodyState) GetTotalGravity() gdnative.Vector3 {
 	//log.Println("Calling PhysicsDirectBodyState.GetTotalGravity()")
@@ -584,7 +594,7 @@ func (o *PhysicsDirectBodyState) GetTotalGravity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_total_gravity")
+	methodBind := methodBindPhysicsDirectBodyStateGetTotalGravity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -597,8 +607,8 @@ func (o *PhysicsDirectBodyState) GetTotalGravity() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PhysicsDirectBodyState) GetTotalLinearDamp() gdnative.Real {
 	//log.Println("Calling PhysicsDirectBodyState.GetTotalLinearDamp()")
@@ -607,7 +617,7 @@ func (o *PhysicsDirectBodyState) GetTotalLinearDamp() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_total_linear_damp")
+	methodBind := methodBindPhysicsDirectBodyStateGetTotalLinearDamp.Get()
 
 	// Call the parent method.
 	// float
@@ -620,8 +630,8 @@ func (o *PhysicsDirectBodyState) GetTotalLinearDamp() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform
+	        Undocumented
+		Args: [], Returns: Transform
 */
 func (o *PhysicsDirectBodyState) GetTransform() gdnative.Transform {
 	//log.Println("Calling PhysicsDirectBodyState.GetTransform()")
@@ -630,7 +640,7 @@ func (o *PhysicsDirectBodyState) GetTransform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "get_transform")
+	methodBind := methodBindPhysicsDirectBodyStateGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -643,8 +653,7 @@ func (o *PhysicsDirectBodyState) GetTransform() gdnative.Transform {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *PhysicsDirectBodyState) IntegrateForces() {
 	//log.Println("Calling PhysicsDirectBodyState.IntegrateForces()")
@@ -653,7 +662,7 @@ func (o *PhysicsDirectBodyState) IntegrateForces() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "integrate_forces")
+	methodBind := methodBindPhysicsDirectBodyStateIntegrateForces.Get()
 
 	// Call the parent method.
 	// void
@@ -663,8 +672,8 @@ func (o *PhysicsDirectBodyState) IntegrateForces() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PhysicsDirectBodyState) IsSleeping() gdnative.Bool {
 	//log.Println("Calling PhysicsDirectBodyState.IsSleeping()")
@@ -673,7 +682,7 @@ func (o *PhysicsDirectBodyState) IsSleeping() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "is_sleeping")
+	methodBind := methodBindPhysicsDirectBodyStateIsSleeping.Get()
 
 	// Call the parent method.
 	// bool
@@ -686,8 +695,8 @@ func (o *PhysicsDirectBodyState) IsSleeping() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false velocity Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false velocity Vector3}], Returns: void
 */
 func (o *PhysicsDirectBodyState) SetAngularVelocity(velocity gdnative.Vector3) {
 	//log.Println("Calling PhysicsDirectBodyState.SetAngularVelocity()")
@@ -697,7 +706,7 @@ func (o *PhysicsDirectBodyState) SetAngularVelocity(velocity gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(velocity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "set_angular_velocity")
+	methodBind := methodBindPhysicsDirectBodyStateSetAngularVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -707,8 +716,8 @@ func (o *PhysicsDirectBodyState) SetAngularVelocity(velocity gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false velocity Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false velocity Vector3}], Returns: void
 */
 func (o *PhysicsDirectBodyState) SetLinearVelocity(velocity gdnative.Vector3) {
 	//log.Println("Calling PhysicsDirectBodyState.SetLinearVelocity()")
@@ -718,7 +727,7 @@ func (o *PhysicsDirectBodyState) SetLinearVelocity(velocity gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(velocity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "set_linear_velocity")
+	methodBind := methodBindPhysicsDirectBodyStateSetLinearVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -728,8 +737,8 @@ func (o *PhysicsDirectBodyState) SetLinearVelocity(velocity gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *PhysicsDirectBodyState) SetSleepState(enabled gdnative.Bool) {
 	//log.Println("Calling PhysicsDirectBodyState.SetSleepState()")
@@ -739,7 +748,7 @@ func (o *PhysicsDirectBodyState) SetSleepState(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "set_sleep_state")
+	methodBind := methodBindPhysicsDirectBodyStateSetSleepState.Get()
 
 	// Call the parent method.
 	// void
@@ -749,8 +758,8 @@ func (o *PhysicsDirectBodyState) SetSleepState(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false transform Transform}], Returns: void
+	        Undocumented
+		Args: [{ false transform Transform}], Returns: void
 */
 func (o *PhysicsDirectBodyState) SetTransform(transform gdnative.Transform) {
 	//log.Println("Calling PhysicsDirectBodyState.SetTransform()")
@@ -760,7 +769,7 @@ func (o *PhysicsDirectBodyState) SetTransform(transform gdnative.Transform) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectBodyState", "set_transform")
+	methodBind := methodBindPhysicsDirectBodyStateSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -769,10 +778,75 @@ func (o *PhysicsDirectBodyState) SetTransform(transform gdnative.Transform) {
 
 }
 
+// AngularVelocity will return the value of the "angular_velocity" property.
+func (o *PhysicsDirectBodyState) AngularVelocity() gdnative.Vector3 {
+	return o.GetAngularVelocity()
+}
+
+// CenterOfMass will return the value of the "center_of_mass" property.
+func (o *PhysicsDirectBodyState) CenterOfMass() gdnative.Vector3 {
+	return o.GetCenterOfMass()
+}
+
+// InverseInertia will return the value of the "inverse_inertia" property.
+func (o *PhysicsDirectBodyState) InverseInertia() gdnative.Vector3 {
+	return o.GetInverseInertia()
+}
+
+// InverseMass will return the value of the "inverse_mass" property.
+func (o *PhysicsDirectBodyState) InverseMass() gdnative.Real {
+	return o.GetInverseMass()
+}
+
+// LinearVelocity will return the value of the "linear_velocity" property.
+func (o *PhysicsDirectBodyState) LinearVelocity() gdnative.Vector3 {
+	return o.GetLinearVelocity()
+}
+
+// PrincipalInertiaAxes will return the value of the "principal_inertia_axes" property.
+func (o *PhysicsDirectBodyState) PrincipalInertiaAxes() gdnative.Basis {
+	return o.GetPrincipalInertiaAxes()
+}
+
+// Sleeping will return the value of the "sleeping" property.
+func (o *PhysicsDirectBodyState) Sleeping() gdnative.Bool {
+	return o.IsSleeping()
+}
+
+// SetSleeping will set the value of the "sleeping" property.
+func (o *PhysicsDirectBodyState) SetSleeping(value gdnative.Bool) {
+	o.SetSleepState(value)
+}
+
+// Step will return the value of the "step" property.
+func (o *PhysicsDirectBodyState) Step() gdnative.Real {
+	return o.GetStep()
+}
+
+// TotalAngularDamp will return the value of the "total_angular_damp" property.
+func (o *PhysicsDirectBodyState) TotalAngularDamp() gdnative.Real {
+	return o.GetTotalAngularDamp()
+}
+
+// TotalGravity will return the value of the "total_gravity" property.
+func (o *PhysicsDirectBodyState) TotalGravity() gdnative.Vector3 {
+	return o.GetTotalGravity()
+}
+
+// TotalLinearDamp will return the value of the "total_linear_damp" property.
+func (o *PhysicsDirectBodyState) TotalLinearDamp() gdnative.Real {
+	return o.GetTotalLinearDamp()
+}
+
+// Transform will return the value of the "transform" property.
+func (o *PhysicsDirectBodyState) Transform() gdnative.Transform {
+	return o.GetTransform()
+}
+
 // PhysicsDirectBodyStateImplementer is an interface that implements the methods
 // of the PhysicsDirectBodyState class.
 type PhysicsDirectBodyStateImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	AddForce(force gdnative.Vector3, position gdnative.Vector3)
 	ApplyImpulse(position gdnative.Vector3, j gdnative.Vector3)
 	ApplyTorqeImpulse(j gdnative.Vector3)
@@ -780,7 +854,7 @@ type PhysicsDirectBodyStateImplementer interface {
 	GetCenterOfMass() gdnative.Vector3
 	GetContactCollider(contactIdx gdnative.Int) gdnative.Rid
 	GetContactColliderId(contactIdx gdnative.Int) gdnative.Int
-	GetContactColliderObject(contactIdx gdnative.Int) ObjectImplementer
+	GetContactColliderObject(contactIdx gdnative.Int) godot.ObjectImplementer
 	GetContactColliderPosition(contactIdx gdnative.Int) gdnative.Vector3
 	GetContactColliderShape(contactIdx gdnative.Int) gdnative.Int
 	GetContactColliderVelocityAtPosition(contactIdx gdnative.Int) gdnative.Vector3
@@ -792,7 +866,7 @@ type PhysicsDirectBodyStateImplementer interface {
 	GetInverseMass() gdnative.Real
 	GetLinearVelocity() gdnative.Vector3
 	GetPrincipalInertiaAxes() gdnative.Basis
-	GetSpaceState() PhysicsDirectSpaceStateImplementer
+	GetSpaceState() godot.PhysicsDirectSpaceStateImplementer
 	GetStep() gdnative.Real
 	GetTotalAngularDamp() gdnative.Real
 	GetTotalGravity() gdnative.Vector3
@@ -804,4 +878,17 @@ type PhysicsDirectBodyStateImplementer interface {
 	SetLinearVelocity(velocity gdnative.Vector3)
 	SetSleepState(enabled gdnative.Bool)
 	SetTransform(transform gdnative.Transform)
+	AngularVelocity() gdnative.Vector3
+	CenterOfMass() gdnative.Vector3
+	InverseInertia() gdnative.Vector3
+	InverseMass() gdnative.Real
+	LinearVelocity() gdnative.Vector3
+	PrincipalInertiaAxes() gdnative.Basis
+	Sleeping() gdnative.Bool
+	SetSleeping(value gdnative.Bool)
+	Step() gdnative.Real
+	TotalAngularDamp() gdnative.Real
+	TotalGravity() gdnative.Vector3
+	TotalLinearDamp() gdnative.Real
+	Transform() gdnative.Transform
 }
@@ -1,7 +1,10 @@
-package godot
+package physics
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,6 +24,28 @@ const (
 	PhysicsServerAreaBodyRemoved PhysicsServerAreaBodyStatus = 1
 )
 
+// String will return the Godot name of the PhysicsServerAreaBodyStatus value.
+func (e PhysicsServerAreaBodyStatus) String() string {
+	switch e {
+	case PhysicsServerAreaBodyAdded:
+		return "AREA_BODY_ADDED"
+	case PhysicsServerAreaBodyRemoved:
+		return "AREA_BODY_REMOVED"
+	}
+	return fmt.Sprintf("PhysicsServerAreaBodyStatus(%d)", int(e))
+}
+
+// ParsePhysicsServerAreaBodyStatus will return the PhysicsServerAreaBodyStatus value with the given Godot name.
+func ParsePhysicsServerAreaBodyStatus(name string) (PhysicsServerAreaBodyStatus, error) {
+	switch name {
+	case "AREA_BODY_ADDED":
+		return PhysicsServerAreaBodyAdded, nil
+	case "AREA_BODY_REMOVED":
+		return PhysicsServerAreaBodyRemoved, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerAreaBodyStatus value %q", name)
+}
+
 // PhysicsServerAreaParameter is an enum for AreaParameter values.
 type PhysicsServerAreaParameter int
 
@@ -35,6 +60,52 @@ const (
 	PhysicsServerAreaParamPriority                PhysicsServerAreaParameter = 7
 )
 
+// String will return the Godot name of the PhysicsServerAreaParameter value.
+func (e PhysicsServerAreaParameter) String() string {
+	switch e {
+	case PhysicsServerAreaParamAngularDamp:
+		return "AREA_PARAM_ANGULAR_DAMP"
+	case PhysicsServerAreaParamGravity:
+		return "AREA_PARAM_GRAVITY"
+	case PhysicsServerAreaParamGravityDistanceScale:
+		return "AREA_PARAM_GRAVITY_DISTANCE_SCALE"
+	case PhysicsServerAreaParamGravityIsPoint:
+		return "AREA_PARAM_GRAVITY_IS_POINT"
+	case PhysicsServerAreaParamGravityPointAttenuation:
+		return "AREA_PARAM_GRAVITY_POINT_ATTENUATION"
+	case PhysicsServerAreaParamGravityVector:
+		return "AREA_PARAM_GRAVITY_VECTOR"
+	case PhysicsServerAreaParamLinearDamp:
+		return "AREA_PARAM_LINEAR_DAMP"
+	case PhysicsServerAreaParamPriority:
+		return "AREA_PARAM_PRIORITY"
+	}
+	return fmt.Sprintf("PhysicsServerAreaParameter(%d)", int(e))
+}
+
+// ParsePhysicsServerAreaParameter will return the PhysicsServerAreaParameter value with the given Godot name.
+func ParsePhysicsServerAreaParameter(name string) (PhysicsServerAreaParameter, error) {
+	switch name {
+	case "AREA_PARAM_ANGULAR_DAMP":
+		return PhysicsServerAreaParamAngularDamp, nil
+	case "AREA_PARAM_GRAVITY":
+		return PhysicsServerAreaParamGravity, nil
+	case "AREA_PARAM_GRAVITY_DISTANCE_SCALE":
+		return PhysicsServerAreaParamGravityDistanceScale, nil
+	case "AREA_PARAM_GRAVITY_IS_POINT":
+		return PhysicsServerAreaParamGravityIsPoint, nil
+	case "AREA_PARAM_GRAVITY_POINT_ATTENUATION":
+		return PhysicsServerAreaParamGravityPointAttenuation, nil
+	case "AREA_PARAM_GRAVITY_VECTOR":
+		return PhysicsServerAreaParamGravityVector, nil
+	case "AREA_PARAM_LINEAR_DAMP":
+		return PhysicsServerAreaParamLinearDamp, nil
+	case "AREA_PARAM_PRIORITY":
+		return PhysicsServerAreaParamPriority, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerAreaParameter value %q", name)
+}
+
 // PhysicsServerAreaSpaceOverrideMode is an enum for AreaSpaceOverrideMode values.
 type PhysicsServerAreaSpaceOverrideMode int
 
@@ -46,6 +117,40 @@ const (
 	PhysicsServerAreaSpaceOverrideReplaceCombine PhysicsServerAreaSpaceOverrideMode = 4
 )
 
+// String will return the Godot name of the PhysicsServerAreaSpaceOverrideMode value.
+func (e PhysicsServerAreaSpaceOverrideMode) String() string {
+	switch e {
+	case PhysicsServerAreaSpaceOverrideCombine:
+		return "AREA_SPACE_OVERRIDE_COMBINE"
+	case PhysicsServerAreaSpaceOverrideCombineReplace:
+		return "AREA_SPACE_OVERRIDE_COMBINE_REPLACE"
+	case PhysicsServerAreaSpaceOverrideDisabled:
+		return "AREA_SPACE_OVERRIDE_DISABLED"
+	case PhysicsServerAreaSpaceOverrideReplace:
+		return "AREA_SPACE_OVERRIDE_REPLACE"
+	case PhysicsServerAreaSpaceOverrideReplaceCombine:
+		return "AREA_SPACE_OVERRIDE_REPLACE_COMBINE"
+	}
+	return fmt.Sprintf("PhysicsServerAreaSpaceOverrideMode(%d)", int(e))
+}
+
+// ParsePhysicsServerAreaSpaceOverrideMode will return the PhysicsServerAreaSpaceOverrideMode value with the given Godot name.
+func ParsePhysicsServerAreaSpaceOverrideMode(name string) (PhysicsServerAreaSpaceOverrideMode, error) {
+	switch name {
+	case "AREA_SPACE_OVERRIDE_COMBINE":
+		return PhysicsServerAreaSpaceOverrideCombine, nil
+	case "AREA_SPACE_OVERRIDE_COMBINE_REPLACE":
+		return PhysicsServerAreaSpaceOverrideCombineReplace, nil
+	case "AREA_SPACE_OVERRIDE_DISABLED":
+		return PhysicsServerAreaSpaceOverrideDisabled, nil
+	case "AREA_SPACE_OVERRIDE_REPLACE":
+		return PhysicsServerAreaSpaceOverrideReplace, nil
+	case "AREA_SPACE_OVERRIDE_REPLACE_COMBINE":
+		return PhysicsServerAreaSpaceOverrideReplaceCombine, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerAreaSpaceOverrideMode value %q", name)
+}
+
 // PhysicsServerBodyAxis is an enum for BodyAxis values.
 type PhysicsServerBodyAxis int
 
@@ -58,6 +163,44 @@ const (
 	PhysicsServerBodyAxisLinearZ  PhysicsServerBodyAxis = 4
 )
 
+// String will return the Godot name of the PhysicsServerBodyAxis value.
+func (e PhysicsServerBodyAxis) String() string {
+	switch e {
+	case PhysicsServerBodyAxisAngularX:
+		return "BODY_AXIS_ANGULAR_X"
+	case PhysicsServerBodyAxisAngularY:
+		return "BODY_AXIS_ANGULAR_Y"
+	case PhysicsServerBodyAxisAngularZ:
+		return "BODY_AXIS_ANGULAR_Z"
+	case PhysicsServerBodyAxisLinearX:
+		return "BODY_AXIS_LINEAR_X"
+	case PhysicsServerBodyAxisLinearY:
+		return "BODY_AXIS_LINEAR_Y"
+	case PhysicsServerBodyAxisLinearZ:
+		return "BODY_AXIS_LINEAR_Z"
+	}
+	return fmt.Sprintf("PhysicsServerBodyAxis(%d)", int(e))
+}
+
+// ParsePhysicsServerBodyAxis will return the PhysicsServerBodyAxis value with the given Godot name.
+func ParsePhysicsServerBodyAxis(name string) (PhysicsServerBodyAxis, error) {
+	switch name {
+	case "BODY_AXIS_ANGULAR_X":
+		return PhysicsServerBodyAxisAngularX, nil
+	case "BODY_AXIS_ANGULAR_Y":
+		return PhysicsServerBodyAxisAngularY, nil
+	case "BODY_AXIS_ANGULAR_Z":
+		return PhysicsServerBodyAxisAngularZ, nil
+	case "BODY_AXIS_LINEAR_X":
+		return PhysicsServerBodyAxisLinearX, nil
+	case "BODY_AXIS_LINEAR_Y":
+		return PhysicsServerBodyAxisLinearY, nil
+	case "BODY_AXIS_LINEAR_Z":
+		return PhysicsServerBodyAxisLinearZ, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerBodyAxis value %q", name)
+}
+
 // PhysicsServerBodyMode is an enum for BodyMode values.
 type PhysicsServerBodyMode int
 
@@ -69,6 +212,40 @@ const (
 	PhysicsServerBodyModeStatic    PhysicsServerBodyMode = 0
 )
 
+// String will return the Godot name of the PhysicsServerBodyMode value.
+func (e PhysicsServerBodyMode) String() string {
+	switch e {
+	case PhysicsServerBodyModeCharacter:
+		return "BODY_MODE_CHARACTER"
+	case PhysicsServerBodyModeKinematic:
+		return "BODY_MODE_KINEMATIC"
+	case PhysicsServerBodyModeRigid:
+		return "BODY_MODE_RIGID"
+	case PhysicsServerBodyModeSoft:
+		return "BODY_MODE_SOFT"
+	case PhysicsServerBodyModeStatic:
+		return "BODY_MODE_STATIC"
+	}
+	return fmt.Sprintf("PhysicsServerBodyMode(%d)", int(e))
+}
+
+// ParsePhysicsServerBodyMode will return the PhysicsServerBodyMode value with the given Godot name.
+func ParsePhysicsServerBodyMode(name string) (PhysicsServerBodyMode, error) {
+	switch name {
+	case "BODY_MODE_CHARACTER":
+		return PhysicsServerBodyModeCharacter, nil
+	case "BODY_MODE_KINEMATIC":
+		return PhysicsServerBodyModeKinematic, nil
+	case "BODY_MODE_RIGID":
+		return PhysicsServerBodyModeRigid, nil
+	case "BODY_MODE_SOFT":
+		return PhysicsServerBodyModeSoft, nil
+	case "BODY_MODE_STATIC":
+		return PhysicsServerBodyModeStatic, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerBodyMode value %q", name)
+}
+
 // PhysicsServerBodyParameter is an enum for BodyParameter values.
 type PhysicsServerBodyParameter int
 
@@ -82,6 +259,48 @@ const (
 	PhysicsServerBodyParamMax          PhysicsServerBodyParameter = 6
 )
 
+// String will return the Godot name of the PhysicsServerBodyParameter value.
+func (e PhysicsServerBodyParameter) String() string {
+	switch e {
+	case PhysicsServerBodyParamAngularDamp:
+		return "BODY_PARAM_ANGULAR_DAMP"
+	case PhysicsServerBodyParamBounce:
+		return "BODY_PARAM_BOUNCE"
+	case PhysicsServerBodyParamFriction:
+		return "BODY_PARAM_FRICTION"
+	case PhysicsServerBodyParamGravityScale:
+		return "BODY_PARAM_GRAVITY_SCALE"
+	case PhysicsServerBodyParamLinearDamp:
+		return "BODY_PARAM_LINEAR_DAMP"
+	case PhysicsServerBodyParamMass:
+		return "BODY_PARAM_MASS"
+	case PhysicsServerBodyParamMax:
+		return "BODY_PARAM_MAX"
+	}
+	return fmt.Sprintf("PhysicsServerBodyParameter(%d)", int(e))
+}
+
+// ParsePhysicsServerBodyParameter will return the PhysicsServerBodyParameter value with the given Godot name.
+func ParsePhysicsServerBodyParameter(name string) (PhysicsServerBodyParameter, error) {
+	switch name {
+	case "BODY_PARAM_ANGULAR_DAMP":
+		return PhysicsServerBodyParamAngularDamp, nil
+	case "BODY_PARAM_BOUNCE":
+		return PhysicsServerBodyParamBounce, nil
+	case "BODY_PARAM_FRICTION":
+		return PhysicsServerBodyParamFriction, nil
+	case "BODY_PARAM_GRAVITY_SCALE":
+		return PhysicsServerBodyParamGravityScale, nil
+	case "BODY_PARAM_LINEAR_DAMP":
+		return PhysicsServerBodyParamLinearDamp, nil
+	case "BODY_PARAM_MASS":
+		return PhysicsServerBodyParamMass, nil
+	case "BODY_PARAM_MAX":
+		return PhysicsServerBodyParamMax, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerBodyParameter value %q", name)
+}
+
 // PhysicsServerBodyState is an enum for BodyState values.
 type PhysicsServerBodyState int
 
@@ -93,6 +312,40 @@ const (
 	PhysicsServerBodyStateTransform       PhysicsServerBodyState = 0
 )
 
+// String will return the Godot name of the PhysicsServerBodyState value.
+func (e PhysicsServerBodyState) String() string {
+	switch e {
+	case PhysicsServerBodyStateAngularVelocity:
+		return "BODY_STATE_ANGULAR_VELOCITY"
+	case PhysicsServerBodyStateCanSleep:
+		return "BODY_STATE_CAN_SLEEP"
+	case PhysicsServerBodyStateLinearVelocity:
+		return "BODY_STATE_LINEAR_VELOCITY"
+	case PhysicsServerBodyStateSleeping:
+		return "BODY_STATE_SLEEPING"
+	case PhysicsServerBodyStateTransform:
+		return "BODY_STATE_TRANSFORM"
+	}
+	return fmt.Sprintf("PhysicsServerBodyState(%d)", int(e))
+}
+
+// ParsePhysicsServerBodyState will return the PhysicsServerBodyState value with the given Godot name.
+func ParsePhysicsServerBodyState(name string) (PhysicsServerBodyState, error) {
+	switch name {
+	case "BODY_STATE_ANGULAR_VELOCITY":
+		return PhysicsServerBodyStateAngularVelocity, nil
+	case "BODY_STATE_CAN_SLEEP":
+		return PhysicsServerBodyStateCanSleep, nil
+	case "BODY_STATE_LINEAR_VELOCITY":
+		return PhysicsServerBodyStateLinearVelocity, nil
+	case "BODY_STATE_SLEEPING":
+		return PhysicsServerBodyStateSleeping, nil
+	case "BODY_STATE_TRANSFORM":
+		return PhysicsServerBodyStateTransform, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerBodyState value %q", name)
+}
+
 // PhysicsServerConeTwistJointParam is an enum for ConeTwistJointParam values.
 type PhysicsServerConeTwistJointParam int
 
@@ -104,6 +357,40 @@ const (
 	PhysicsServerConeTwistJointTwistSpan  PhysicsServerConeTwistJointParam = 1
 )
 
+// String will return the Godot name of the PhysicsServerConeTwistJointParam value.
+func (e PhysicsServerConeTwistJointParam) String() string {
+	switch e {
+	case PhysicsServerConeTwistJointBias:
+		return "CONE_TWIST_JOINT_BIAS"
+	case PhysicsServerConeTwistJointRelaxation:
+		return "CONE_TWIST_JOINT_RELAXATION"
+	case PhysicsServerConeTwistJointSoftness:
+		return "CONE_TWIST_JOINT_SOFTNESS"
+	case PhysicsServerConeTwistJointSwingSpan:
+		return "CONE_TWIST_JOINT_SWING_SPAN"
+	case PhysicsServerConeTwistJointTwistSpan:
+		return "CONE_TWIST_JOINT_TWIST_SPAN"
+	}
+	return fmt.Sprintf("PhysicsServerConeTwistJointParam(%d)", int(e))
+}
+
+// ParsePhysicsServerConeTwistJointParam will return the PhysicsServerConeTwistJointParam value with the given Godot name.
+func ParsePhysicsServerConeTwistJointParam(name string) (PhysicsServerConeTwistJointParam, error) {
+	switch name {
+	case "CONE_TWIST_JOINT_BIAS":
+		return PhysicsServerConeTwistJointBias, nil
+	case "CONE_TWIST_JOINT_RELAXATION":
+		return PhysicsServerConeTwistJointRelaxation, nil
+	case "CONE_TWIST_JOINT_SOFTNESS":
+		return PhysicsServerConeTwistJointSoftness, nil
+	case "CONE_TWIST_JOINT_SWING_SPAN":
+		return PhysicsServerConeTwistJointSwingSpan, nil
+	case "CONE_TWIST_JOINT_TWIST_SPAN":
+		return PhysicsServerConeTwistJointTwistSpan, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerConeTwistJointParam value %q", name)
+}
+
 // PhysicsServerG6DOFJointAxisFlag is an enum for G6DOFJointAxisFlag values.
 type PhysicsServerG6DOFJointAxisFlag int
 
@@ -113,6 +400,32 @@ const (
 	PhysicsServerG6DofJointFlagEnableMotor        PhysicsServerG6DOFJointAxisFlag = 2
 )
 
+// String will return the Godot name of the PhysicsServerG6DOFJointAxisFlag value.
+func (e PhysicsServerG6DOFJointAxisFlag) String() string {
+	switch e {
+	case PhysicsServerG6DofJointFlagEnableAngularLimit:
+		return "G6DOF_JOINT_FLAG_ENABLE_ANGULAR_LIMIT"
+	case PhysicsServerG6DofJointFlagEnableLinearLimit:
+		return "G6DOF_JOINT_FLAG_ENABLE_LINEAR_LIMIT"
+	case PhysicsServerG6DofJointFlagEnableMotor:
+		return "G6DOF_JOINT_FLAG_ENABLE_MOTOR"
+	}
+	return fmt.Sprintf("PhysicsServerG6DOFJointAxisFlag(%d)", int(e))
+}
+
+// ParsePhysicsServerG6DOFJointAxisFlag will return the PhysicsServerG6DOFJointAxisFlag value with the given Godot name.
+func ParsePhysicsServerG6DOFJointAxisFlag(name string) (PhysicsServerG6DOFJointAxisFlag, error) {
+	switch name {
+	case "G6DOF_JOINT_FLAG_ENABLE_ANGULAR_LIMIT":
+		return PhysicsServerG6DofJointFlagEnableAngularLimit, nil
+	case "G6DOF_JOINT_FLAG_ENABLE_LINEAR_LIMIT":
+		return PhysicsServerG6DofJointFlagEnableLinearLimit, nil
+	case "G6DOF_JOINT_FLAG_ENABLE_MOTOR":
+		return PhysicsServerG6DofJointFlagEnableMotor, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerG6DOFJointAxisFlag value %q", name)
+}
+
 // PhysicsServerG6DOFJointAxisParam is an enum for G6DOFJointAxisParam values.
 type PhysicsServerG6DOFJointAxisParam int
 
@@ -133,6 +446,76 @@ const (
 	PhysicsServerG6DofJointLinearUpperLimit           PhysicsServerG6DOFJointAxisParam = 1
 )
 
+// String will return the Godot name of the PhysicsServerG6DOFJointAxisParam value.
+func (e PhysicsServerG6DOFJointAxisParam) String() string {
+	switch e {
+	case PhysicsServerG6DofJointAngularDamping:
+		return "G6DOF_JOINT_ANGULAR_DAMPING"
+	case PhysicsServerG6DofJointAngularErp:
+		return "G6DOF_JOINT_ANGULAR_ERP"
+	case PhysicsServerG6DofJointAngularForceLimit:
+		return "G6DOF_JOINT_ANGULAR_FORCE_LIMIT"
+	case PhysicsServerG6DofJointAngularLimitSoftness:
+		return "G6DOF_JOINT_ANGULAR_LIMIT_SOFTNESS"
+	case PhysicsServerG6DofJointAngularLowerLimit:
+		return "G6DOF_JOINT_ANGULAR_LOWER_LIMIT"
+	case PhysicsServerG6DofJointAngularMotorForceLimit:
+		return "G6DOF_JOINT_ANGULAR_MOTOR_FORCE_LIMIT"
+	case PhysicsServerG6DofJointAngularMotorTargetVelocity:
+		return "G6DOF_JOINT_ANGULAR_MOTOR_TARGET_VELOCITY"
+	case PhysicsServerG6DofJointAngularRestitution:
+		return "G6DOF_JOINT_ANGULAR_RESTITUTION"
+	case PhysicsServerG6DofJointAngularUpperLimit:
+		return "G6DOF_JOINT_ANGULAR_UPPER_LIMIT"
+	case PhysicsServerG6DofJointLinearDamping:
+		return "G6DOF_JOINT_LINEAR_DAMPING"
+	case PhysicsServerG6DofJointLinearLimitSoftness:
+		return "G6DOF_JOINT_LINEAR_LIMIT_SOFTNESS"
+	case PhysicsServerG6DofJointLinearLowerLimit:
+		return "G6DOF_JOINT_LINEAR_LOWER_LIMIT"
+	case PhysicsServerG6DofJointLinearRestitution:
+		return "G6DOF_JOINT_LINEAR_RESTITUTION"
+	case PhysicsServerG6DofJointLinearUpperLimit:
+		return "G6DOF_JOINT_LINEAR_UPPER_LIMIT"
+	}
+	return fmt.Sprintf("PhysicsServerG6DOFJointAxisParam(%d)", int(e))
+}
+
+// ParsePhysicsServerG6DOFJointAxisParam will return the PhysicsServerG6DOFJointAxisParam value with the given Godot name.
+func ParsePhysicsServerG6DOFJointAxisParam(name string) (PhysicsServerG6DOFJointAxisParam, error) {
+	switch name {
+	case "G6DOF_JOINT_ANGULAR_DAMPING":
+		return PhysicsServerG6DofJointAngularDamping, nil
+	case "G6DOF_JOINT_ANGULAR_ERP":
+		return PhysicsServerG6DofJointAngularErp, nil
+	case "G6DOF_JOINT_ANGULAR_FORCE_LIMIT":
+		return PhysicsServerG6DofJointAngularForceLimit, nil
+	case "G6DOF_JOINT_ANGULAR_LIMIT_SOFTNESS":
+		return PhysicsServerG6DofJointAngularLimitSoftness, nil
+	case "G6DOF_JOINT_ANGULAR_LOWER_LIMIT":
+		return PhysicsServerG6DofJointAngularLowerLimit, nil
+	case "G6DOF_JOINT_ANGULAR_MOTOR_FORCE_LIMIT":
+		return PhysicsServerG6DofJointAngularMotorForceLimit, nil
+	case "G6DOF_JOINT_ANGULAR_MOTOR_TARGET_VELOCITY":
+		return PhysicsServerG6DofJointAngularMotorTargetVelocity, nil
+	case "G6DOF_JOINT_ANGULAR_RESTITUTION":
+		return PhysicsServerG6DofJointAngularRestitution, nil
+	case "G6DOF_JOINT_ANGULAR_UPPER_LIMIT":
+		return PhysicsServerG6DofJointAngularUpperLimit, nil
+	case "G6DOF_JOINT_LINEAR_DAMPING":
+		return PhysicsServerG6DofJointLinearDamping, nil
+	case "G6DOF_JOINT_LINEAR_LIMIT_SOFTNESS":
+		return PhysicsServerG6DofJointLinearLimitSoftness, nil
+	case "G6DOF_JOINT_LINEAR_LOWER_LIMIT":
+		return PhysicsServerG6DofJointLinearLowerLimit, nil
+	case "G6DOF_JOINT_LINEAR_RESTITUTION":
+		return PhysicsServerG6DofJointLinearRestitution, nil
+	case "G6DOF_JOINT_LINEAR_UPPER_LIMIT":
+		return PhysicsServerG6DofJointLinearUpperLimit, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerG6DOFJointAxisParam value %q", name)
+}
+
 // PhysicsServerHingeJointFlag is an enum for HingeJointFlag values.
 type PhysicsServerHingeJointFlag int
 
@@ -141,6 +524,28 @@ const (
 	PhysicsServerHingeJointFlagUseLimit    PhysicsServerHingeJointFlag = 0
 )
 
+// String will return the Godot name of the PhysicsServerHingeJointFlag value.
+func (e PhysicsServerHingeJointFlag) String() string {
+	switch e {
+	case PhysicsServerHingeJointFlagEnableMotor:
+		return "HINGE_JOINT_FLAG_ENABLE_MOTOR"
+	case PhysicsServerHingeJointFlagUseLimit:
+		return "HINGE_JOINT_FLAG_USE_LIMIT"
+	}
+	return fmt.Sprintf("PhysicsServerHingeJointFlag(%d)", int(e))
+}
+
+// ParsePhysicsServerHingeJointFlag will return the PhysicsServerHingeJointFlag value with the given Godot name.
+func ParsePhysicsServerHingeJointFlag(name string) (PhysicsServerHingeJointFlag, error) {
+	switch name {
+	case "HINGE_JOINT_FLAG_ENABLE_MOTOR":
+		return PhysicsServerHingeJointFlagEnableMotor, nil
+	case "HINGE_JOINT_FLAG_USE_LIMIT":
+		return PhysicsServerHingeJointFlagUseLimit, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerHingeJointFlag value %q", name)
+}
+
 // PhysicsServerHingeJointParam is an enum for HingeJointParam values.
 type PhysicsServerHingeJointParam int
 
@@ -155,6 +560,52 @@ const (
 	PhysicsServerHingeJointMotorTargetVelocity PhysicsServerHingeJointParam = 6
 )
 
+// String will return the Godot name of the PhysicsServerHingeJointParam value.
+func (e PhysicsServerHingeJointParam) String() string {
+	switch e {
+	case PhysicsServerHingeJointBias:
+		return "HINGE_JOINT_BIAS"
+	case PhysicsServerHingeJointLimitBias:
+		return "HINGE_JOINT_LIMIT_BIAS"
+	case PhysicsServerHingeJointLimitLower:
+		return "HINGE_JOINT_LIMIT_LOWER"
+	case PhysicsServerHingeJointLimitRelaxation:
+		return "HINGE_JOINT_LIMIT_RELAXATION"
+	case PhysicsServerHingeJointLimitSoftness:
+		return "HINGE_JOINT_LIMIT_SOFTNESS"
+	case PhysicsServerHingeJointLimitUpper:
+		return "HINGE_JOINT_LIMIT_UPPER"
+	case PhysicsServerHingeJointMotorMaxImpulse:
+		return "HINGE_JOINT_MOTOR_MAX_IMPULSE"
+	case PhysicsServerHingeJointMotorTargetVelocity:
+		return "HINGE_JOINT_MOTOR_TARGET_VELOCITY"
+	}
+	return fmt.Sprintf("PhysicsServerHingeJointParam(%d)", int(e))
+}
+
+// ParsePhysicsServerHingeJointParam will return the PhysicsServerHingeJointParam value with the given Godot name.
+func ParsePhysicsServerHingeJointParam(name string) (PhysicsServerHingeJointParam, error) {
+	switch name {
+	case "HINGE_JOINT_BIAS":
+		return PhysicsServerHingeJointBias, nil
+	case "HINGE_JOINT_LIMIT_BIAS":
+		return PhysicsServerHingeJointLimitBias, nil
+	case "HINGE_JOINT_LIMIT_LOWER":
+		return PhysicsServerHingeJointLimitLower, nil
+	case "HINGE_JOINT_LIMIT_RELAXATION":
+		return PhysicsServerHingeJointLimitRelaxation, nil
+	case "HINGE_JOINT_LIMIT_SOFTNESS":
+		return PhysicsServerHingeJointLimitSoftness, nil
+	case "HINGE_JOINT_LIMIT_UPPER":
+		return PhysicsServerHingeJointLimitUpper, nil
+	case "HINGE_JOINT_MOTOR_MAX_IMPULSE":
+		return PhysicsServerHingeJointMotorMaxImpulse, nil
+	case "HINGE_JOINT_MOTOR_TARGET_VELOCITY":
+		return PhysicsServerHingeJointMotorTargetVelocity, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerHingeJointParam value %q", name)
+}
+
 // PhysicsServerJointType is an enum for JointType values.
 type PhysicsServerJointType int
 
@@ -166,6 +617,40 @@ const (
 	PhysicsServerJointSlider    PhysicsServerJointType = 2
 )
 
+// String will return the Godot name of the PhysicsServerJointType value.
+func (e PhysicsServerJointType) String() string {
+	switch e {
+	case PhysicsServerJoint6Dof:
+		return "JOINT_6DOF"
+	case PhysicsServerJointConeTwist:
+		return "JOINT_CONE_TWIST"
+	case PhysicsServerJointHinge:
+		return "JOINT_HINGE"
+	case PhysicsServerJointPin:
+		return "JOINT_PIN"
+	case PhysicsServerJointSlider:
+		return "JOINT_SLIDER"
+	}
+	return fmt.Sprintf("PhysicsServerJointType(%d)", int(e))
+}
+
+// ParsePhysicsServerJointType will return the PhysicsServerJointType value with the given Godot name.
+func ParsePhysicsServerJointType(name string) (PhysicsServerJointType, error) {
+	switch name {
+	case "JOINT_6DOF":
+		return PhysicsServerJoint6Dof, nil
+	case "JOINT_CONE_TWIST":
+		return PhysicsServerJointConeTwist, nil
+	case "JOINT_HINGE":
+		return PhysicsServerJointHinge, nil
+	case "JOINT_PIN":
+		return PhysicsServerJointPin, nil
+	case "JOINT_SLIDER":
+		return PhysicsServerJointSlider, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerJointType value %q", name)
+}
+
 // PhysicsServerPinJointParam is an enum for PinJointParam values.
 type PhysicsServerPinJointParam int
 
@@ -175,6 +660,32 @@ const (
 	PhysicsServerPinJointImpulseClamp PhysicsServerPinJointParam = 2
 )
 
+// String will return the Godot name of the PhysicsServerPinJointParam value.
+func (e PhysicsServerPinJointParam) String() string {
+	switch e {
+	case PhysicsServerPinJointBias:
+		return "PIN_JOINT_BIAS"
+	case PhysicsServerPinJointDamping:
+		return "PIN_JOINT_DAMPING"
+	case PhysicsServerPinJointImpulseClamp:
+		return "PIN_JOINT_IMPULSE_CLAMP"
+	}
+	return fmt.Sprintf("PhysicsServerPinJointParam(%d)", int(e))
+}
+
+// ParsePhysicsServerPinJointParam will return the PhysicsServerPinJointParam value with the given Godot name.
+func ParsePhysicsServerPinJointParam(name string) (PhysicsServerPinJointParam, error) {
+	switch name {
+	case "PIN_JOINT_BIAS":
+		return PhysicsServerPinJointBias, nil
+	case "PIN_JOINT_DAMPING":
+		return PhysicsServerPinJointDamping, nil
+	case "PIN_JOINT_IMPULSE_CLAMP":
+		return PhysicsServerPinJointImpulseClamp, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerPinJointParam value %q", name)
+}
+
 // PhysicsServerProcessInfo is an enum for ProcessInfo values.
 type PhysicsServerProcessInfo int
 
@@ -184,6 +695,32 @@ const (
 	PhysicsServerInfoIslandCount    PhysicsServerProcessInfo = 2
 )
 
+// String will return the Godot name of the PhysicsServerProcessInfo value.
+func (e PhysicsServerProcessInfo) String() string {
+	switch e {
+	case PhysicsServerInfoActiveObjects:
+		return "INFO_ACTIVE_OBJECTS"
+	case PhysicsServerInfoCollisionPairs:
+		return "INFO_COLLISION_PAIRS"
+	case PhysicsServerInfoIslandCount:
+		return "INFO_ISLAND_COUNT"
+	}
+	return fmt.Sprintf("PhysicsServerProcessInfo(%d)", int(e))
+}
+
+// ParsePhysicsServerProcessInfo will return the PhysicsServerProcessInfo value with the given Godot name.
+func ParsePhysicsServerProcessInfo(name string) (PhysicsServerProcessInfo, error) {
+	switch name {
+	case "INFO_ACTIVE_OBJECTS":
+		return PhysicsServerInfoActiveObjects, nil
+	case "INFO_COLLISION_PAIRS":
+		return PhysicsServerInfoCollisionPairs, nil
+	case "INFO_ISLAND_COUNT":
+		return PhysicsServerInfoIslandCount, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerProcessInfo value %q", name)
+}
+
 // PhysicsServerShapeType is an enum for ShapeType values.
 type PhysicsServerShapeType int
 
@@ -199,6 +736,56 @@ const (
 	PhysicsServerShapeSphere         PhysicsServerShapeType = 2
 )
 
+// String will return the Godot name of the PhysicsServerShapeType value.
+func (e PhysicsServerShapeType) String() string {
+	switch e {
+	case PhysicsServerShapeBox:
+		return "SHAPE_BOX"
+	case PhysicsServerShapeCapsule:
+		return "SHAPE_CAPSULE"
+	case PhysicsServerShapeConcavePolygon:
+		return "SHAPE_CONCAVE_POLYGON"
+	case PhysicsServerShapeConvexPolygon:
+		return "SHAPE_CONVEX_POLYGON"
+	case PhysicsServerShapeCustom:
+		return "SHAPE_CUSTOM"
+	case PhysicsServerShapeHeightmap:
+		return "SHAPE_HEIGHTMAP"
+	case PhysicsServerShapePlane:
+		return "SHAPE_PLANE"
+	case PhysicsServerShapeRay:
+		return "SHAPE_RAY"
+	case PhysicsServerShapeSphere:
+		return "SHAPE_SPHERE"
+	}
+	return fmt.Sprintf("PhysicsServerShapeType(%d)", int(e))
+}
+
+// ParsePhysicsServerShapeType will return the PhysicsServerShapeType value with the given Godot name.
+func ParsePhysicsServerShapeType(name string) (PhysicsServerShapeType, error) {
+	switch name {
+	case "SHAPE_BOX":
+		return PhysicsServerShapeBox, nil
+	case "SHAPE_CAPSULE":
+		return PhysicsServerShapeCapsule, nil
+	case "SHAPE_CONCAVE_POLYGON":
+		return PhysicsServerShapeConcavePolygon, nil
+	case "SHAPE_CONVEX_POLYGON":
+		return PhysicsServerShapeConvexPolygon, nil
+	case "SHAPE_CUSTOM":
+		return PhysicsServerShapeCustom, nil
+	case "SHAPE_HEIGHTMAP":
+		return PhysicsServerShapeHeightmap, nil
+	case "SHAPE_PLANE":
+		return PhysicsServerShapePlane, nil
+	case "SHAPE_RAY":
+		return PhysicsServerShapeRay, nil
+	case "SHAPE_SPHERE":
+		return PhysicsServerShapeSphere, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerShapeType value %q", name)
+}
+
 // PhysicsServerSliderJointParam is an enum for SliderJointParam values.
 type PhysicsServerSliderJointParam int
 
@@ -228,6 +815,112 @@ const (
 	PhysicsServerSliderJointMax                          PhysicsServerSliderJointParam = 22
 )
 
+// String will return the Godot name of the PhysicsServerSliderJointParam value.
+func (e PhysicsServerSliderJointParam) String() string {
+	switch e {
+	case PhysicsServerSliderJointAngularLimitDamping:
+		return "SLIDER_JOINT_ANGULAR_LIMIT_DAMPING"
+	case PhysicsServerSliderJointAngularLimitLower:
+		return "SLIDER_JOINT_ANGULAR_LIMIT_LOWER"
+	case PhysicsServerSliderJointAngularLimitRestitution:
+		return "SLIDER_JOINT_ANGULAR_LIMIT_RESTITUTION"
+	case PhysicsServerSliderJointAngularLimitSoftness:
+		return "SLIDER_JOINT_ANGULAR_LIMIT_SOFTNESS"
+	case PhysicsServerSliderJointAngularLimitUpper:
+		return "SLIDER_JOINT_ANGULAR_LIMIT_UPPER"
+	case PhysicsServerSliderJointAngularMotionDamping:
+		return "SLIDER_JOINT_ANGULAR_MOTION_DAMPING"
+	case PhysicsServerSliderJointAngularMotionRestitution:
+		return "SLIDER_JOINT_ANGULAR_MOTION_RESTITUTION"
+	case PhysicsServerSliderJointAngularMotionSoftness:
+		return "SLIDER_JOINT_ANGULAR_MOTION_SOFTNESS"
+	case PhysicsServerSliderJointAngularOrthogonalDamping:
+		return "SLIDER_JOINT_ANGULAR_ORTHOGONAL_DAMPING"
+	case PhysicsServerSliderJointAngularOrthogonalRestitution:
+		return "SLIDER_JOINT_ANGULAR_ORTHOGONAL_RESTITUTION"
+	case PhysicsServerSliderJointAngularOrthogonalSoftness:
+		return "SLIDER_JOINT_ANGULAR_ORTHOGONAL_SOFTNESS"
+	case PhysicsServerSliderJointLinearLimitDamping:
+		return "SLIDER_JOINT_LINEAR_LIMIT_DAMPING"
+	case PhysicsServerSliderJointLinearLimitLower:
+		return "SLIDER_JOINT_LINEAR_LIMIT_LOWER"
+	case PhysicsServerSliderJointLinearLimitRestitution:
+		return "SLIDER_JOINT_LINEAR_LIMIT_RESTITUTION"
+	case PhysicsServerSliderJointLinearLimitSoftness:
+		return "SLIDER_JOINT_LINEAR_LIMIT_SOFTNESS"
+	case PhysicsServerSliderJointLinearLimitUpper:
+		return "SLIDER_JOINT_LINEAR_LIMIT_UPPER"
+	case PhysicsServerSliderJointLinearMotionDamping:
+		return "SLIDER_JOINT_LINEAR_MOTION_DAMPING"
+	case PhysicsServerSliderJointLinearMotionRestitution:
+		return "SLIDER_JOINT_LINEAR_MOTION_RESTITUTION"
+	case PhysicsServerSliderJointLinearMotionSoftness:
+		return "SLIDER_JOINT_LINEAR_MOTION_SOFTNESS"
+	case PhysicsServerSliderJointLinearOrthogonalDamping:
+		return "SLIDER_JOINT_LINEAR_ORTHOGONAL_DAMPING"
+	case PhysicsServerSliderJointLinearOrthogonalRestitution:
+		return "SLIDER_JOINT_LINEAR_ORTHOGONAL_RESTITUTION"
+	case PhysicsServerSliderJointLinearOrthogonalSoftness:
+		return "SLIDER_JOINT_LINEAR_ORTHOGONAL_SOFTNESS"
+	case PhysicsServerSliderJointMax:
+		return "SLIDER_JOINT_MAX"
+	}
+	return fmt.Sprintf("PhysicsServerSliderJointParam(%d)", int(e))
+}
+
+// ParsePhysicsServerSliderJointParam will return the PhysicsServerSliderJointParam value with the given Godot name.
+func ParsePhysicsServerSliderJointParam(name string) (PhysicsServerSliderJointParam, error) {
+	switch name {
+	case "SLIDER_JOINT_ANGULAR_LIMIT_DAMPING":
+		return PhysicsServerSliderJointAngularLimitDamping, nil
+	case "SLIDER_JOINT_ANGULAR_LIMIT_LOWER":
+		return PhysicsServerSliderJointAngularLimitLower, nil
+	case "SLIDER_JOINT_ANGULAR_LIMIT_RESTITUTION":
+		return PhysicsServerSliderJointAngularLimitRestitution, nil
+	case "SLIDER_JOINT_ANGULAR_LIMIT_SOFTNESS":
+		return PhysicsServerSliderJointAngularLimitSoftness, nil
+	case "SLIDER_JOINT_ANGULAR_LIMIT_UPPER":
+		return PhysicsServerSliderJointAngularLimitUpper, nil
+	case "SLIDER_JOINT_ANGULAR_MOTION_DAMPING":
+		return PhysicsServerSliderJointAngularMotionDamping, nil
+	case "SLIDER_JOINT_ANGULAR_MOTION_RESTITUTION":
+		return PhysicsServerSliderJointAngularMotionRestitution, nil
+	case "SLIDER_JOINT_ANGULAR_MOTION_SOFTNESS":
+		return PhysicsServerSliderJointAngularMotionSoftness, nil
+	case "SLIDER_JOINT_ANGULAR_ORTHOGONAL_DAMPING":
+		return PhysicsServerSliderJointAngularOrthogonalDamping, nil
+	case "SLIDER_JOINT_ANGULAR_ORTHOGONAL_RESTITUTION":
+		return PhysicsServerSliderJointAngularOrthogonalRestitution, nil
+	case "SLIDER_JOINT_ANGULAR_ORTHOGONAL_SOFTNESS":
+		return PhysicsServerSliderJointAngularOrthogonalSoftness, nil
+	case "SLIDER_JOINT_LINEAR_LIMIT_DAMPING":
+		return PhysicsServerSliderJointLinearLimitDamping, nil
+	case "SLIDER_JOINT_LINEAR_LIMIT_LOWER":
+		return PhysicsServerSliderJointLinearLimitLower, nil
+	case "SLIDER_JOINT_LINEAR_LIMIT_RESTITUTION":
+		return PhysicsServerSliderJointLinearLimitRestitution, nil
+	case "SLIDER_JOINT_LINEAR_LIMIT_SOFTNESS":
+		return PhysicsServerSliderJointLinearLimitSoftness, nil
+	case "SLIDER_JOINT_LINEAR_LIMIT_UPPER":
+		return PhysicsServerSliderJointLinearLimitUpper, nil
+	case "SLIDER_JOINT_LINEAR_MOTION_DAMPING":
+		return PhysicsServerSliderJointLinearMotionDamping, nil
+	case "SLIDER_JOINT_LINEAR_MOTION_RESTITUTION":
+		return PhysicsServerSliderJointLinearMotionRestitution, nil
+	case "SLIDER_JOINT_LINEAR_MOTION_SOFTNESS":
+		return PhysicsServerSliderJointLinearMotionSoftness, nil
+	case "SLIDER_JOINT_LINEAR_ORTHOGONAL_DAMPING":
+		return PhysicsServerSliderJointLinearOrthogonalDamping, nil
+	case "SLIDER_JOINT_LINEAR_ORTHOGONAL_RESTITUTION":
+		return PhysicsServerSliderJointLinearOrthogonalRestitution, nil
+	case "SLIDER_JOINT_LINEAR_ORTHOGONAL_SOFTNESS":
+		return PhysicsServerSliderJointLinearOrthogonalSoftness, nil
+	case "SLIDER_JOINT_MAX":
+		return PhysicsServerSliderJointMax, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerSliderJointParam value %q", name)
+}
+
 // PhysicsServerSpaceParameter is an enum for SpaceParameter values.
 type PhysicsServerSpaceParameter int
 
@@ -242,13 +935,50 @@ const (
 	PhysicsServerSpaceParamContactRecycleRadius              PhysicsServerSpaceParameter = 0
 )
 
-//func NewphysicsServerFromPointer(ptr gdnative.Pointer) physicsServer {
-func newPhysicsServerFromPointer(ptr gdnative.Pointer) physicsServer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := physicsServer{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the PhysicsServerSpaceParameter value.
+func (e PhysicsServerSpaceParameter) String() string {
+	switch e {
+	case PhysicsServerSpaceParamBodyAngularVelocityDampRatio:
+		return "SPACE_PARAM_BODY_ANGULAR_VELOCITY_DAMP_RATIO"
+	case PhysicsServerSpaceParamBodyAngularVelocitySleepThreshold:
+		return "SPACE_PARAM_BODY_ANGULAR_VELOCITY_SLEEP_THRESHOLD"
+	case PhysicsServerSpaceParamBodyLinearVelocitySleepThreshold:
+		return "SPACE_PARAM_BODY_LINEAR_VELOCITY_SLEEP_THRESHOLD"
+	case PhysicsServerSpaceParamBodyMaxAllowedPenetration:
+		return "SPACE_PARAM_BODY_MAX_ALLOWED_PENETRATION"
+	case PhysicsServerSpaceParamBodyTimeToSleep:
+		return "SPACE_PARAM_BODY_TIME_TO_SLEEP"
+	case PhysicsServerSpaceParamConstraintDefaultBias:
+		return "SPACE_PARAM_CONSTRAINT_DEFAULT_BIAS"
+	case PhysicsServerSpaceParamContactMaxSeparation:
+		return "SPACE_PARAM_CONTACT_MAX_SEPARATION"
+	case PhysicsServerSpaceParamContactRecycleRadius:
+		return "SPACE_PARAM_CONTACT_RECYCLE_RADIUS"
+	}
+	return fmt.Sprintf("PhysicsServerSpaceParameter(%d)", int(e))
+}
+
+// ParsePhysicsServerSpaceParameter will return the PhysicsServerSpaceParameter value with the given Godot name.
+func ParsePhysicsServerSpaceParameter(name string) (PhysicsServerSpaceParameter, error) {
+	switch name {
+	case "SPACE_PARAM_BODY_ANGULAR_VELOCITY_DAMP_RATIO":
+		return PhysicsServerSpaceParamBodyAngularVelocityDampRatio, nil
+	case "SPACE_PARAM_BODY_ANGULAR_VELOCITY_SLEEP_THRESHOLD":
+		return PhysicsServerSpaceParamBodyAngularVelocitySleepThreshold, nil
+	case "SPACE_PARAM_BODY_LINEAR_VELOCITY_SLEEP_THRESHOLD":
+		return PhysicsServerSpaceParamBodyLinearVelocitySleepThreshold, nil
+	case "SPACE_PARAM_BODY_MAX_ALLOWED_PENETRATION":
+		return PhysicsServerSpaceParamBodyMaxAllowedPenetration, nil
+	case "SPACE_PARAM_BODY_TIME_TO_SLEEP":
+		return PhysicsServerSpaceParamBodyTimeToSleep, nil
+	case "SPACE_PARAM_CONSTRAINT_DEFAULT_BIAS":
+		return PhysicsServerSpaceParamConstraintDefaultBias, nil
+	case "SPACE_PARAM_CONTACT_MAX_SEPARATION":
+		return PhysicsServerSpaceParamContactMaxSeparation, nil
+	case "SPACE_PARAM_CONTACT_RECYCLE_RADIUS":
+		return PhysicsServerSpaceParamContactRecycleRadius, nil
+	}
+	return 0, fmt.Errorf("invalid PhysicsServerSpaceParameter value %q", name)
 }
 
 func newSingletonPhysicsServer() *physicsServer {
@@ -256,7 +986,7 @@ func newSingletonPhysicsServer() *physicsServer {
 }
 
 /*
-   Everything related to physics in 3D.
+Everything related to physics in 3D.
 */
 var PhysicsServer = newSingletonPhysicsServer()
 
@@ -264,7 +994,7 @@ var PhysicsServer = newSingletonPhysicsServer()
 Everything related to physics in 3D.
 */
 type physicsServer struct {
-	Object
+	godot.Object
 	owner       gdnative.Object
 	initialized bool
 }
@@ -285,9 +1015,119 @@ func (o *physicsServer) BaseClass() string {
 	return "PhysicsServer"
 }
 
+// Method binds of the PhysicsServer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysicsServerAreaAddShape                              = gdnative.NewLazyMethodBind("PhysicsServer", "area_add_shape")
+	methodBindPhysicsServerAreaAttachObjectInstanceId                = gdnative.NewLazyMethodBind("PhysicsServer", "area_attach_object_instance_id")
+	methodBindPhysicsServerAreaClearShapes                           = gdnative.NewLazyMethodBind("PhysicsServer", "area_clear_shapes")
+	methodBindPhysicsServerAreaCreate                                = gdnative.NewLazyMethodBind("PhysicsServer", "area_create")
+	methodBindPhysicsServerAreaGetObjectInstanceId                   = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_object_instance_id")
+	methodBindPhysicsServerAreaGetParam                              = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_param")
+	methodBindPhysicsServerAreaGetShape                              = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_shape")
+	methodBindPhysicsServerAreaGetShapeCount                         = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_shape_count")
+	methodBindPhysicsServerAreaGetShapeTransform                     = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_shape_transform")
+	methodBindPhysicsServerAreaGetSpace                              = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_space")
+	methodBindPhysicsServerAreaGetSpaceOverrideMode                  = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_space_override_mode")
+	methodBindPhysicsServerAreaGetTransform                          = gdnative.NewLazyMethodBind("PhysicsServer", "area_get_transform")
+	methodBindPhysicsServerAreaIsRayPickable                         = gdnative.NewLazyMethodBind("PhysicsServer", "area_is_ray_pickable")
+	methodBindPhysicsServerAreaRemoveShape                           = gdnative.NewLazyMethodBind("PhysicsServer", "area_remove_shape")
+	methodBindPhysicsServerAreaSetCollisionLayer                     = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_collision_layer")
+	methodBindPhysicsServerAreaSetCollisionMask                      = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_collision_mask")
+	methodBindPhysicsServerAreaSetMonitorCallback                    = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_monitor_callback")
+	methodBindPhysicsServerAreaSetParam                              = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_param")
+	methodBindPhysicsServerAreaSetRayPickable                        = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_ray_pickable")
+	methodBindPhysicsServerAreaSetShape                              = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_shape")
+	methodBindPhysicsServerAreaSetShapeTransform                     = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_shape_transform")
+	methodBindPhysicsServerAreaSetSpace                              = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_space")
+	methodBindPhysicsServerAreaSetSpaceOverrideMode                  = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_space_override_mode")
+	methodBindPhysicsServerAreaSetTransform                          = gdnative.NewLazyMethodBind("PhysicsServer", "area_set_transform")
+	methodBindPhysicsServerBodyAddCollisionException                 = gdnative.NewLazyMethodBind("PhysicsServer", "body_add_collision_exception")
+	methodBindPhysicsServerBodyAddShape                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_add_shape")
+	methodBindPhysicsServerBodyApplyImpulse                          = gdnative.NewLazyMethodBind("PhysicsServer", "body_apply_impulse")
+	methodBindPhysicsServerBodyApplyTorqueImpulse                    = gdnative.NewLazyMethodBind("PhysicsServer", "body_apply_torque_impulse")
+	methodBindPhysicsServerBodyAttachObjectInstanceId                = gdnative.NewLazyMethodBind("PhysicsServer", "body_attach_object_instance_id")
+	methodBindPhysicsServerBodyClearShapes                           = gdnative.NewLazyMethodBind("PhysicsServer", "body_clear_shapes")
+	methodBindPhysicsServerBodyCreate                                = gdnative.NewLazyMethodBind("PhysicsServer", "body_create")
+	methodBindPhysicsServerBodyGetCollisionLayer                     = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_collision_layer")
+	methodBindPhysicsServerBodyGetCollisionMask                      = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_collision_mask")
+	methodBindPhysicsServerBodyGetDirectState                        = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_direct_state")
+	methodBindPhysicsServerBodyGetKinematicSafeMargin                = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_kinematic_safe_margin")
+	methodBindPhysicsServerBodyGetMaxContactsReported                = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_max_contacts_reported")
+	methodBindPhysicsServerBodyGetMode                               = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_mode")
+	methodBindPhysicsServerBodyGetObjectInstanceId                   = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_object_instance_id")
+	methodBindPhysicsServerBodyGetParam                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_param")
+	methodBindPhysicsServerBodyGetShape                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_shape")
+	methodBindPhysicsServerBodyGetShapeCount                         = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_shape_count")
+	methodBindPhysicsServerBodyGetShapeTransform                     = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_shape_transform")
+	methodBindPhysicsServerBodyGetSpace                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_space")
+	methodBindPhysicsServerBodyGetState                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_get_state")
+	methodBindPhysicsServerBodyIsAxisLocked                          = gdnative.NewLazyMethodBind("PhysicsServer", "body_is_axis_locked")
+	methodBindPhysicsServerBodyIsContinuousCollisionDetectionEnabled = gdnative.NewLazyMethodBind("PhysicsServer", "body_is_continuous_collision_detection_enabled")
+	methodBindPhysicsServerBodyIsOmittingForceIntegration            = gdnative.NewLazyMethodBind("PhysicsServer", "body_is_omitting_force_integration")
+	methodBindPhysicsServerBodyIsRayPickable                         = gdnative.NewLazyMethodBind("PhysicsServer", "body_is_ray_pickable")
+	methodBindPhysicsServerBodyRemoveCollisionException              = gdnative.NewLazyMethodBind("PhysicsServer", "body_remove_collision_exception")
+	methodBindPhysicsServerBodyRemoveShape                           = gdnative.NewLazyMethodBind("PhysicsServer", "body_remove_shape")
+	methodBindPhysicsServerBodySetAxisLock                           = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_axis_lock")
+	methodBindPhysicsServerBodySetAxisVelocity                       = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_axis_velocity")
+	methodBindPhysicsServerBodySetCollisionLayer                     = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_collision_layer")
+	methodBindPhysicsServerBodySetCollisionMask                      = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_collision_mask")
+	methodBindPhysicsServerBodySetEnableContinuousCollisionDetection = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_enable_continuous_collision_detection")
+	methodBindPhysicsServerBodySetForceIntegrationCallback           = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_force_integration_callback")
+	methodBindPhysicsServerBodySetKinematicSafeMargin                = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_kinematic_safe_margin")
+	methodBindPhysicsServerBodySetMaxContactsReported                = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_max_contacts_reported")
+	methodBindPhysicsServerBodySetMode                               = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_mode")
+	methodBindPhysicsServerBodySetOmitForceIntegration               = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_omit_force_integration")
+	methodBindPhysicsServerBodySetParam                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_param")
+	methodBindPhysicsServerBodySetRayPickable                        = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_ray_pickable")
+	methodBindPhysicsServerBodySetShape                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_shape")
+	methodBindPhysicsServerBodySetShapeTransform                     = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_shape_transform")
+	methodBindPhysicsServerBodySetSpace                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_space")
+	methodBindPhysicsServerBodySetState                              = gdnative.NewLazyMethodBind("PhysicsServer", "body_set_state")
+	methodBindPhysicsServerConeTwistJointGetParam                    = gdnative.NewLazyMethodBind("PhysicsServer", "cone_twist_joint_get_param")
+	methodBindPhysicsServerConeTwistJointSetParam                    = gdnative.NewLazyMethodBind("PhysicsServer", "cone_twist_joint_set_param")
+	methodBindPhysicsServerFreeRid                                   = gdnative.NewLazyMethodBind("PhysicsServer", "free_rid")
+	methodBindPhysicsServerGeneric6DofJointGetFlag                   = gdnative.NewLazyMethodBind("PhysicsServer", "generic_6dof_joint_get_flag")
+	methodBindPhysicsServerGeneric6DofJointGetParam                  = gdnative.NewLazyMethodBind("PhysicsServer", "generic_6dof_joint_get_param")
+	methodBindPhysicsServerGeneric6DofJointSetFlag                   = gdnative.NewLazyMethodBind("PhysicsServer", "generic_6dof_joint_set_flag")
+	methodBindPhysicsServerGeneric6DofJointSetParam                  = gdnative.NewLazyMethodBind("PhysicsServer", "generic_6dof_joint_set_param")
+	methodBindPhysicsServerGetProcessInfo                            = gdnative.NewLazyMethodBind("PhysicsServer", "get_process_info")
+	methodBindPhysicsServerHingeJointGetFlag                         = gdnative.NewLazyMethodBind("PhysicsServer", "hinge_joint_get_flag")
+	methodBindPhysicsServerHingeJointGetParam                        = gdnative.NewLazyMethodBind("PhysicsServer", "hinge_joint_get_param")
+	methodBindPhysicsServerHingeJointSetFlag                         = gdnative.NewLazyMethodBind("PhysicsServer", "hinge_joint_set_flag")
+	methodBindPhysicsServerHingeJointSetParam                        = gdnative.NewLazyMethodBind("PhysicsServer", "hinge_joint_set_param")
+	methodBindPhysicsServerJointCreateConeTwist                      = gdnative.NewLazyMethodBind("PhysicsServer", "joint_create_cone_twist")
+	methodBindPhysicsServerJointCreateGeneric6Dof                    = gdnative.NewLazyMethodBind("PhysicsServer", "joint_create_generic_6dof")
+	methodBindPhysicsServerJointCreateHinge                          = gdnative.NewLazyMethodBind("PhysicsServer", "joint_create_hinge")
+	methodBindPhysicsServerJointCreatePin                            = gdnative.NewLazyMethodBind("PhysicsServer", "joint_create_pin")
+	methodBindPhysicsServerJointCreateSlider                         = gdnative.NewLazyMethodBind("PhysicsServer", "joint_create_slider")
+	methodBindPhysicsServerJointGetSolverPriority                    = gdnative.NewLazyMethodBind("PhysicsServer", "joint_get_solver_priority")
+	methodBindPhysicsServerJointGetType                              = gdnative.NewLazyMethodBind("PhysicsServer", "joint_get_type")
+	methodBindPhysicsServerJointSetSolverPriority                    = gdnative.NewLazyMethodBind("PhysicsServer", "joint_set_solver_priority")
+	methodBindPhysicsServerPinJointGetLocalA                         = gdnative.NewLazyMethodBind("PhysicsServer", "pin_joint_get_local_a")
+	methodBindPhysicsServerPinJointGetLocalB                         = gdnative.NewLazyMethodBind("PhysicsServer", "pin_joint_get_local_b")
+	methodBindPhysicsServerPinJointGetParam                          = gdnative.NewLazyMethodBind("PhysicsServer", "pin_joint_get_param")
+	methodBindPhysicsServerPinJointSetLocalA                         = gdnative.NewLazyMethodBind("PhysicsServer", "pin_joint_set_local_a")
+	methodBindPhysicsServerPinJointSetLocalB                         = gdnative.NewLazyMethodBind("PhysicsServer", "pin_joint_set_local_b")
+	methodBindPhysicsServerPinJointSetParam                          = gdnative.NewLazyMethodBind("PhysicsServer", "pin_joint_set_param")
+	methodBindPhysicsServerSetActive                                 = gdnative.NewLazyMethodBind("PhysicsServer", "set_active")
+	methodBindPhysicsServerShapeCreate                               = gdnative.NewLazyMethodBind("PhysicsServer", "shape_create")
+	methodBindPhysicsServerShapeGetData                              = gdnative.NewLazyMethodBind("PhysicsServer", "shape_get_data")
+	methodBindPhysicsServerShapeGetType                              = gdnative.NewLazyMethodBind("PhysicsServer", "shape_get_type")
+	methodBindPhysicsServerShapeSetData                              = gdnative.NewLazyMethodBind("PhysicsServer", "shape_set_data")
+	methodBindPhysicsServerSliderJointGetParam                       = gdnative.NewLazyMethodBind("PhysicsServer", "slider_joint_get_param")
+	methodBindPhysicsServerSliderJointSetParam                       = gdnative.NewLazyMethodBind("PhysicsServer", "slider_joint_set_param")
+	methodBindPhysicsServerSpaceCreate                               = gdnative.NewLazyMethodBind("PhysicsServer", "space_create")
+	methodBindPhysicsServerSpaceGetDirectState                       = gdnative.NewLazyMethodBind("PhysicsServer", "space_get_direct_state")
+	methodBindPhysicsServerSpaceGetParam                             = gdnative.NewLazyMethodBind("PhysicsServer", "space_get_param")
+	methodBindPhysicsServerSpaceIsActive                             = gdnative.NewLazyMethodBind("PhysicsServer", "space_is_active")
+	methodBindPhysicsServerSpaceSetActive                            = gdnative.NewLazyMethodBind("PhysicsServer", "space_set_active")
+	methodBindPhysicsServerSpaceSetParam                             = gdnative.NewLazyMethodBind("PhysicsServer", "space_set_param")
+)
+
 /*
-        Adds a shape to the area, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
-	Args: [{ false area RID} { false shape RID} {1, 0, 0, 0, 1, 0, 0, 0, 1 - 0, 0, 0 true transform Transform}], Returns: void
+	        Adds a shape to the area, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
+		Args: [{ false area RID} { false shape RID} {1, 0, 0, 0, 1, 0, 0, 0, 1 - 0, 0, 0 true transform Transform}], Returns: void
 */
 func (o *physicsServer) AreaAddShape(area gdnative.Rid, shape gdnative.Rid, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -300,7 +1140,7 @@ func (o *physicsServer) AreaAddShape(area gdnative.Rid, shape gdnative.Rid, tran
 	ptrArguments[2] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_add_shape")
+	methodBind := methodBindPhysicsServerAreaAddShape.Get()
 
 	// Call the parent method.
 	// void
@@ -310,8 +1150,8 @@ func (o *physicsServer) AreaAddShape(area gdnative.Rid, shape gdnative.Rid, tran
 }
 
 /*
-        Assigns the area to a descendant of [Object], so it can exist in the node tree.
-	Args: [{ false area RID} { false id int}], Returns: void
+	        Assigns the area to a descendant of [Object], so it can exist in the node tree.
+		Args: [{ false area RID} { false id int}], Returns: void
 */
 func (o *physicsServer) AreaAttachObjectInstanceId(area gdnative.Rid, id gdnative.Int) {
 	o.ensureSingleton()
@@ -323,7 +1163,7 @@ func (o *physicsServer) AreaAttachObjectInstanceId(area gdnative.Rid, id gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_attach_object_instance_id")
+	methodBind := methodBindPhysicsServerAreaAttachObjectInstanceId.Get()
 
 	// Call the parent method.
 	// void
@@ -333,8 +1173,8 @@ func (o *physicsServer) AreaAttachObjectInstanceId(area gdnative.Rid, id gdnativ
 }
 
 /*
-        Removes all shapes from an area. It does not delete the shapes, so they can be reassigned later.
-	Args: [{ false area RID}], Returns: void
+	        Removes all shapes from an area. It does not delete the shapes, so they can be reassigned later.
+		Args: [{ false area RID}], Returns: void
 */
 func (o *physicsServer) AreaClearShapes(area gdnative.Rid) {
 	o.ensureSingleton()
@@ -345,7 +1185,7 @@ func (o *physicsServer) AreaClearShapes(area gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_clear_shapes")
+	methodBind := methodBindPhysicsServerAreaClearShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -355,8 +1195,8 @@ func (o *physicsServer) AreaClearShapes(area gdnative.Rid) {
 }
 
 /*
-        Creates an [Area].
-	Args: [], Returns: RID
+	        Creates an [Area].
+		Args: [], Returns: RID
 */
 func (o *physicsServer) AreaCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -366,7 +1206,7 @@ func (o *physicsServer) AreaCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_create")
+	methodBind := methodBindPhysicsServerAreaCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -379,8 +1219,8 @@ func (o *physicsServer) AreaCreate() gdnative.Rid {
 }
 
 /*
-        Gets the instance ID of the object the area is assigned to.
-	Args: [{ false area RID}], Returns: int
+	        Gets the instance ID of the object the area is assigned to.
+		Args: [{ false area RID}], Returns: int
 */
 func (o *physicsServer) AreaGetObjectInstanceId(area gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -391,7 +1231,7 @@ func (o *physicsServer) AreaGetObjectInstanceId(area gdnative.Rid) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_object_instance_id")
+	methodBind := methodBindPhysicsServerAreaGetObjectInstanceId.Get()
 
 	// Call the parent method.
 	// int
@@ -404,8 +1244,8 @@ func (o *physicsServer) AreaGetObjectInstanceId(area gdnative.Rid) gdnative.Int
 }
 
 /*
-        Returns an area parameter value. A list of available parameters is on the AREA_PARAM_* constants.
-	Args: [{ false area RID} { false param int}], Returns: Variant
+	        Returns an area parameter value. A list of available parameters is on the AREA_PARAM_* constants.
+		Args: [{ false area RID} { false param int}], Returns: Variant
 */
 func (o *physicsServer) AreaGetParam(area gdnative.Rid, param gdnative.Int) gdnative.Variant {
 	o.ensureSingleton()
@@ -417,7 +1257,7 @@ func (o *physicsServer) AreaGetParam(area gdnative.Rid, param gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_param")
+	methodBind := methodBindPhysicsServerAreaGetParam.Get()
 
 	// Call the parent method.
 	// Variant
@@ -430,8 +1270,8 @@ func (o *physicsServer) AreaGetParam(area gdnative.Rid, param gdnative.Int) gdna
 }
 
 /*
-        Returns the [RID] of the nth shape of an area.
-	Args: [{ false area RID} { false shape_idx int}], Returns: RID
+	        Returns the [RID] of the nth shape of an area.
+		Args: [{ false area RID} { false shape_idx int}], Returns: RID
 */
 func (o *physicsServer) AreaGetShape(area gdnative.Rid, shapeIdx gdnative.Int) gdnative.Rid {
 	o.ensureSingleton()
@@ -443,7 +1283,7 @@ func (o *physicsServer) AreaGetShape(area gdnative.Rid, shapeIdx gdnative.Int) g
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_shape")
+	methodBind := methodBindPhysicsServerAreaGetShape.Get()
 
 	// Call the parent method.
 	// RID
@@ -456,8 +1296,8 @@ func (o *physicsServer) AreaGetShape(area gdnative.Rid, shapeIdx gdnative.Int) g
 }
 
 /*
-        Returns the number of shapes assigned to an area.
-	Args: [{ false area RID}], Returns: int
+	        Returns the number of shapes assigned to an area.
+		Args: [{ false area RID}], Returns: int
 */
 func (o *physicsServer) AreaGetShapeCount(area gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -468,7 +1308,7 @@ func (o *physicsServer) AreaGetShapeCount(area gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_shape_count")
+	methodBind := methodBindPhysicsServerAreaGetShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -481,8 +1321,8 @@ func (o *physicsServer) AreaGetShapeCount(area gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the transform matrix of a shape within an area.
-	Args: [{ false area RID} { false shape_idx int}], Returns: Transform
+	        Returns the transform matrix of a shape within an area.
+		Args: [{ false area RID} { false shape_idx int}], Returns: Transform
 */
 func (o *physicsServer) AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdnative.Int) gdnative.Transform {
 	o.ensureSingleton()
@@ -494,7 +1334,7 @@ func (o *physicsServer) AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdnati
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_shape_transform")
+	methodBind := methodBindPhysicsServerAreaGetShapeTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -507,8 +1347,8 @@ func (o *physicsServer) AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdnati
 }
 
 /*
-        Returns the space assigned to the area.
-	Args: [{ false area RID}], Returns: RID
+	        Returns the space assigned to the area.
+		Args: [{ false area RID}], Returns: RID
 */
 func (o *physicsServer) AreaGetSpace(area gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -519,7 +1359,7 @@ func (o *physicsServer) AreaGetSpace(area gdnative.Rid) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_space")
+	methodBind := methodBindPhysicsServerAreaGetSpace.Get()
 
 	// Call the parent method.
 	// RID
@@ -532,8 +1372,8 @@ func (o *physicsServer) AreaGetSpace(area gdnative.Rid) gdnative.Rid {
 }
 
 /*
-        Returns the space override mode for the area.
-	Args: [{ false area RID}], Returns: enum.PhysicsServer::AreaSpaceOverrideMode
+	        Returns the space override mode for the area.
+		Args: [{ false area RID}], Returns: enum.PhysicsServer::AreaSpaceOverrideMode
 */
 func (o *physicsServer) AreaGetSpaceOverrideMode(area gdnative.Rid) PhysicsServerAreaSpaceOverrideMode {
 	o.ensureSingleton()
@@ -544,7 +1384,7 @@ func (o *physicsServer) AreaGetSpaceOverrideMode(area gdnative.Rid) PhysicsServe
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_space_override_mode")
+	methodBind := methodBindPhysicsServerAreaGetSpaceOverrideMode.Get()
 
 	// Call the parent method.
 	// enum.PhysicsServer::AreaSpaceOverrideMode
@@ -557,8 +1397,8 @@ func (o *physicsServer) AreaGetSpaceOverrideMode(area gdnative.Rid) PhysicsServe
 }
 
 /*
-        Returns the transform matrix for an area.
-	Args: [{ false area RID}], Returns: Transform
+	        Returns the transform matrix for an area.
+		Args: [{ false area RID}], Returns: Transform
 */
 func (o *physicsServer) AreaGetTransform(area gdnative.Rid) gdnative.Transform {
 	o.ensureSingleton()
@@ -569,7 +1409,7 @@ func (o *physicsServer) AreaGetTransform(area gdnative.Rid) gdnative.Transform {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_get_transform")
+	methodBind := methodBindPhysicsServerAreaGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -582,8 +1422,8 @@ func (o *physicsServer) AreaGetTransform(area gdnative.Rid) gdnative.Transform {
 }
 
 /*
-        If [code]true[/code] area collides with rays.
-	Args: [{ false area RID}], Returns: bool
+	        If [code]true[/code] area collides with rays.
+		Args: [{ false area RID}], Returns: bool
 */
 func (o *physicsServer) AreaIsRayPickable(area gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -594,7 +1434,7 @@ func (o *physicsServer) AreaIsRayPickable(area gdnative.Rid) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_is_ray_pickable")
+	methodBind := methodBindPhysicsServerAreaIsRayPickable.Get()
 
 	// Call the parent method.
 	// bool
@@ -607,8 +1447,8 @@ func (o *physicsServer) AreaIsRayPickable(area gdnative.Rid) gdnative.Bool {
 }
 
 /*
-        Removes a shape from an area. It does not delete the shape, so it can be reassigned later.
-	Args: [{ false area RID} { false shape_idx int}], Returns: void
+	        Removes a shape from an area. It does not delete the shape, so it can be reassigned later.
+		Args: [{ false area RID} { false shape_idx int}], Returns: void
 */
 func (o *physicsServer) AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.Int) {
 	o.ensureSingleton()
@@ -620,7 +1460,7 @@ func (o *physicsServer) AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.Int
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_remove_shape")
+	methodBind := methodBindPhysicsServerAreaRemoveShape.Get()
 
 	// Call the parent method.
 	// void
@@ -630,8 +1470,8 @@ func (o *physicsServer) AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.Int
 }
 
 /*
-        Assigns the area to one or many physics layers.
-	Args: [{ false area RID} { false layer int}], Returns: void
+	        Assigns the area to one or many physics layers.
+		Args: [{ false area RID} { false layer int}], Returns: void
 */
 func (o *physicsServer) AreaSetCollisionLayer(area gdnative.Rid, layer gdnative.Int) {
 	o.ensureSingleton()
@@ -643,7 +1483,7 @@ func (o *physicsServer) AreaSetCollisionLayer(area gdnative.Rid, layer gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_collision_layer")
+	methodBind := methodBindPhysicsServerAreaSetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -653,8 +1493,8 @@ func (o *physicsServer) AreaSetCollisionLayer(area gdnative.Rid, layer gdnative.
 }
 
 /*
-        Sets which physics layers the area will monitor.
-	Args: [{ false area RID} { false mask int}], Returns: void
+	        Sets which physics layers the area will monitor.
+		Args: [{ false area RID} { false mask int}], Returns: void
 */
 func (o *physicsServer) AreaSetCollisionMask(area gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -666,7 +1506,7 @@ func (o *physicsServer) AreaSetCollisionMask(area gdnative.Rid, mask gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_collision_mask")
+	methodBind := methodBindPhysicsServerAreaSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -676,21 +1516,21 @@ func (o *physicsServer) AreaSetCollisionMask(area gdnative.Rid, mask gdnative.In
 }
 
 /*
-        Sets the function to call when any body/area enters or exits the area. This callback will be called for any object interacting with the area, and takes five parameters: 1: AREA_BODY_ADDED or AREA_BODY_REMOVED, depending on whether the object entered or exited the area. 2: [RID] of the object that entered/exited the area. 3: Instance ID of the object that entered/exited the area. 4: The shape index of the object that entered/exited the area. 5: The shape index of the area where the object entered/exited.
-	Args: [{ false area RID} { false receiver Object} { false method String}], Returns: void
+	        Sets the function to call when any body/area enters or exits the area. This callback will be called for any object interacting with the area, and takes five parameters: 1: AREA_BODY_ADDED or AREA_BODY_REMOVED, depending on whether the object entered or exited the area. 2: [RID] of the object that entered/exited the area. 3: Instance ID of the object that entered/exited the area. 4: The shape index of the object that entered/exited the area. 5: The shape index of the area where the object entered/exited.
+		Args: [{ false area RID} { false receiver Object} { false method String}], Returns: void
 */
-func (o *physicsServer) AreaSetMonitorCallback(area gdnative.Rid, receiver ObjectImplementer, method gdnative.String) {
+func (o *physicsServer) AreaSetMonitorCallback(area gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String) {
 	o.ensureSingleton()
 	//log.Println("Calling PhysicsServer.AreaSetMonitorCallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromRid(area)
-	ptrArguments[1] = gdnative.NewPointerFromObject(receiver.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(receiver))
 	ptrArguments[2] = gdnative.NewPointerFromString(method)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_monitor_callback")
+	methodBind := methodBindPhysicsServerAreaSetMonitorCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -700,8 +1540,8 @@ func (o *physicsServer) AreaSetMonitorCallback(area gdnative.Rid, receiver Objec
 }
 
 /*
-        Sets the value for an area parameter. A list of available parameters is on the AREA_PARAM_* constants.
-	Args: [{ false area RID} { false param int} { false value Variant}], Returns: void
+	        Sets the value for an area parameter. A list of available parameters is on the AREA_PARAM_* constants.
+		Args: [{ false area RID} { false param int} { false value Variant}], Returns: void
 */
 func (o *physicsServer) AreaSetParam(area gdnative.Rid, param gdnative.Int, value gdnative.Variant) {
 	o.ensureSingleton()
@@ -714,7 +1554,7 @@ func (o *physicsServer) AreaSetParam(area gdnative.Rid, param gdnative.Int, valu
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_param")
+	methodBind := methodBindPhysicsServerAreaSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -724,8 +1564,8 @@ func (o *physicsServer) AreaSetParam(area gdnative.Rid, param gdnative.Int, valu
 }
 
 /*
-        Sets object pickable with rays.
-	Args: [{ false area RID} { false enable bool}], Returns: void
+	        Sets object pickable with rays.
+		Args: [{ false area RID} { false enable bool}], Returns: void
 */
 func (o *physicsServer) AreaSetRayPickable(area gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -737,7 +1577,7 @@ func (o *physicsServer) AreaSetRayPickable(area gdnative.Rid, enable gdnative.Bo
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_ray_pickable")
+	methodBind := methodBindPhysicsServerAreaSetRayPickable.Get()
 
 	// Call the parent method.
 	// void
@@ -747,8 +1587,8 @@ func (o *physicsServer) AreaSetRayPickable(area gdnative.Rid, enable gdnative.Bo
 }
 
 /*
-        Substitutes a given area shape by another. The old shape is selected by its index, the new one by its [RID].
-	Args: [{ false area RID} { false shape_idx int} { false shape RID}], Returns: void
+	        Substitutes a given area shape by another. The old shape is selected by its index, the new one by its [RID].
+		Args: [{ false area RID} { false shape_idx int} { false shape RID}], Returns: void
 */
 func (o *physicsServer) AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int, shape gdnative.Rid) {
 	o.ensureSingleton()
@@ -761,7 +1601,7 @@ func (o *physicsServer) AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int, s
 	ptrArguments[2] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_shape")
+	methodBind := methodBindPhysicsServerAreaSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -771,8 +1611,8 @@ func (o *physicsServer) AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int, s
 }
 
 /*
-        Sets the transform matrix for an area shape.
-	Args: [{ false area RID} { false shape_idx int} { false transform Transform}], Returns: void
+	        Sets the transform matrix for an area shape.
+		Args: [{ false area RID} { false shape_idx int} { false transform Transform}], Returns: void
 */
 func (o *physicsServer) AreaSetShapeTransform(area gdnative.Rid, shapeIdx gdnative.Int, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -785,7 +1625,7 @@ func (o *physicsServer) AreaSetShapeTransform(area gdnative.Rid, shapeIdx gdnati
 	ptrArguments[2] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_shape_transform")
+	methodBind := methodBindPhysicsServerAreaSetShapeTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -795,8 +1635,8 @@ func (o *physicsServer) AreaSetShapeTransform(area gdnative.Rid, shapeIdx gdnati
 }
 
 /*
-        Assigns a space to the area.
-	Args: [{ false area RID} { false space RID}], Returns: void
+	        Assigns a space to the area.
+		Args: [{ false area RID} { false space RID}], Returns: void
 */
 func (o *physicsServer) AreaSetSpace(area gdnative.Rid, space gdnative.Rid) {
 	o.ensureSingleton()
@@ -808,7 +1648,7 @@ func (o *physicsServer) AreaSetSpace(area gdnative.Rid, space gdnative.Rid) {
 	ptrArguments[1] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_space")
+	methodBind := methodBindPhysicsServerAreaSetSpace.Get()
 
 	// Call the parent method.
 	// void
@@ -818,8 +1658,8 @@ func (o *physicsServer) AreaSetSpace(area gdnative.Rid, space gdnative.Rid) {
 }
 
 /*
-        Sets the space override mode for the area. The modes are described in the constants AREA_SPACE_OVERRIDE_*.
-	Args: [{ false area RID} { false mode int}], Returns: void
+	        Sets the space override mode for the area. The modes are described in the constants AREA_SPACE_OVERRIDE_*.
+		Args: [{ false area RID} { false mode int}], Returns: void
 */
 func (o *physicsServer) AreaSetSpaceOverrideMode(area gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -831,7 +1671,7 @@ func (o *physicsServer) AreaSetSpaceOverrideMode(area gdnative.Rid, mode gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_space_override_mode")
+	methodBind := methodBindPhysicsServerAreaSetSpaceOverrideMode.Get()
 
 	// Call the parent method.
 	// void
@@ -841,8 +1681,8 @@ func (o *physicsServer) AreaSetSpaceOverrideMode(area gdnative.Rid, mode gdnativ
 }
 
 /*
-        Sets the transform matrix for an area.
-	Args: [{ false area RID} { false transform Transform}], Returns: void
+	        Sets the transform matrix for an area.
+		Args: [{ false area RID} { false transform Transform}], Returns: void
 */
 func (o *physicsServer) AreaSetTransform(area gdnative.Rid, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -854,7 +1694,7 @@ func (o *physicsServer) AreaSetTransform(area gdnative.Rid, transform gdnative.T
 	ptrArguments[1] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "area_set_transform")
+	methodBind := methodBindPhysicsServerAreaSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -864,8 +1704,8 @@ func (o *physicsServer) AreaSetTransform(area gdnative.Rid, transform gdnative.T
 }
 
 /*
-        Adds a body to the list of bodies exempt from collisions.
-	Args: [{ false body RID} { false excepted_body RID}], Returns: void
+	        Adds a body to the list of bodies exempt from collisions.
+		Args: [{ false body RID} { false excepted_body RID}], Returns: void
 */
 func (o *physicsServer) BodyAddCollisionException(body gdnative.Rid, exceptedBody gdnative.Rid) {
 	o.ensureSingleton()
@@ -877,7 +1717,7 @@ func (o *physicsServer) BodyAddCollisionException(body gdnative.Rid, exceptedBod
 	ptrArguments[1] = gdnative.NewPointerFromRid(exceptedBody)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_add_collision_exception")
+	methodBind := methodBindPhysicsServerBodyAddCollisionException.Get()
 
 	// Call the parent method.
 	// void
@@ -887,8 +1727,8 @@ func (o *physicsServer) BodyAddCollisionException(body gdnative.Rid, exceptedBod
 }
 
 /*
-        Adds a shape to the body, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
-	Args: [{ false body RID} { false shape RID} {1, 0, 0, 0, 1, 0, 0, 0, 1 - 0, 0, 0 true transform Transform}], Returns: void
+	        Adds a shape to the body, along with a transform matrix. Shapes are usually referenced by their index, so you should track which shape has a given index.
+		Args: [{ false body RID} { false shape RID} {1, 0, 0, 0, 1, 0, 0, 0, 1 - 0, 0, 0 true transform Transform}], Returns: void
 */
 func (o *physicsServer) BodyAddShape(body gdnative.Rid, shape gdnative.Rid, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -901,7 +1741,7 @@ func (o *physicsServer) BodyAddShape(body gdnative.Rid, shape gdnative.Rid, tran
 	ptrArguments[2] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_add_shape")
+	methodBind := methodBindPhysicsServerBodyAddShape.Get()
 
 	// Call the parent method.
 	// void
@@ -911,8 +1751,8 @@ func (o *physicsServer) BodyAddShape(body gdnative.Rid, shape gdnative.Rid, tran
 }
 
 /*
-        Gives the body a push at a [code]position[/code] in the direction of the [code]impulse[/code].
-	Args: [{ false body RID} { false position Vector3} { false impulse Vector3}], Returns: void
+	        Gives the body a push at a [code]position[/code] in the direction of the [code]impulse[/code].
+		Args: [{ false body RID} { false position Vector3} { false impulse Vector3}], Returns: void
 */
 func (o *physicsServer) BodyApplyImpulse(body gdnative.Rid, position gdnative.Vector3, impulse gdnative.Vector3) {
 	o.ensureSingleton()
@@ -925,7 +1765,7 @@ func (o *physicsServer) BodyApplyImpulse(body gdnative.Rid, position gdnative.Ve
 	ptrArguments[2] = gdnative.NewPointerFromVector3(impulse)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_apply_impulse")
+	methodBind := methodBindPhysicsServerBodyApplyImpulse.Get()
 
 	// Call the parent method.
 	// void
@@ -935,8 +1775,8 @@ func (o *physicsServer) BodyApplyImpulse(body gdnative.Rid, position gdnative.Ve
 }
 
 /*
-        Gives the body a push to rotate it.
-	Args: [{ false body RID} { false impulse Vector3}], Returns: void
+	        Gives the body a push to rotate it.
+		Args: [{ false body RID} { false impulse Vector3}], Returns: void
 */
 func (o *physicsServer) BodyApplyTorqueImpulse(body gdnative.Rid, impulse gdnative.Vector3) {
 	o.ensureSingleton()
@@ -948,7 +1788,7 @@ func (o *physicsServer) BodyApplyTorqueImpulse(body gdnative.Rid, impulse gdnati
 	ptrArguments[1] = gdnative.NewPointerFromVector3(impulse)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_apply_torque_impulse")
+	methodBind := methodBindPhysicsServerBodyApplyTorqueImpulse.Get()
 
 	// Call the parent method.
 	// void
@@ -958,8 +1798,8 @@ func (o *physicsServer) BodyApplyTorqueImpulse(body gdnative.Rid, impulse gdnati
 }
 
 /*
-        Assigns the area to a descendant of [Object], so it can exist in the node tree.
-	Args: [{ false body RID} { false id int}], Returns: void
+	        Assigns the area to a descendant of [Object], so it can exist in the node tree.
+		Args: [{ false body RID} { false id int}], Returns: void
 */
 func (o *physicsServer) BodyAttachObjectInstanceId(body gdnative.Rid, id gdnative.Int) {
 	o.ensureSingleton()
@@ -971,7 +1811,7 @@ func (o *physicsServer) BodyAttachObjectInstanceId(body gdnative.Rid, id gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_attach_object_instance_id")
+	methodBind := methodBindPhysicsServerBodyAttachObjectInstanceId.Get()
 
 	// Call the parent method.
 	// void
@@ -981,8 +1821,8 @@ func (o *physicsServer) BodyAttachObjectInstanceId(body gdnative.Rid, id gdnativ
 }
 
 /*
-        Removes all shapes from a body.
-	Args: [{ false body RID}], Returns: void
+	        Removes all shapes from a body.
+		Args: [{ false body RID}], Returns: void
 */
 func (o *physicsServer) BodyClearShapes(body gdnative.Rid) {
 	o.ensureSingleton()
@@ -993,7 +1833,7 @@ func (o *physicsServer) BodyClearShapes(body gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_clear_shapes")
+	methodBind := methodBindPhysicsServerBodyClearShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -1003,8 +1843,8 @@ func (o *physicsServer) BodyClearShapes(body gdnative.Rid) {
 }
 
 /*
-        Creates a physics body. The first parameter can be any value from constants BODY_MODE*, for the type of body created. Additionally, the body can be created in sleeping state to save processing time.
-	Args: [{2 true mode int} {False true init_sleeping bool}], Returns: RID
+	        Creates a physics body. The first parameter can be any value from constants BODY_MODE*, for the type of body created. Additionally, the body can be created in sleeping state to save processing time.
+		Args: [{2 true mode int} {False true init_sleeping bool}], Returns: RID
 */
 func (o *physicsServer) BodyCreate(mode gdnative.Int, initSleeping gdnative.Bool) gdnative.Rid {
 	o.ensureSingleton()
@@ -1016,7 +1856,7 @@ func (o *physicsServer) BodyCreate(mode gdnative.Int, initSleeping gdnative.Bool
 	ptrArguments[1] = gdnative.NewPointerFromBool(initSleeping)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_create")
+	methodBind := methodBindPhysicsServerBodyCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -1029,8 +1869,8 @@ func (o *physicsServer) BodyCreate(mode gdnative.Int, initSleeping gdnative.Bool
 }
 
 /*
-        Returns the physics layer or layers a body belongs to.
-	Args: [{ false body RID}], Returns: int
+	        Returns the physics layer or layers a body belongs to.
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physicsServer) BodyGetCollisionLayer(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -1041,7 +1881,7 @@ func (o *physicsServer) BodyGetCollisionLayer(body gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_collision_layer")
+	methodBind := methodBindPhysicsServerBodyGetCollisionLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -1054,8 +1894,10 @@ func (o *physicsServer) BodyGetCollisionLayer(body gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the physics layer or layers a body can collide with.
+	Returns the physics layer or layers a body can collide with.
+
 -
+
 	Args: [{ false body RID}], Returns: int
 */
 func (o *physicsServer) BodyGetCollisionMask(body gdnative.Rid) gdnative.Int {
@@ -1067,7 +1909,7 @@ func (o *physicsServer) BodyGetCollisionMask(body gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_collision_mask")
+	methodBind := methodBindPhysicsServerBodyGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -1080,8 +1922,8 @@ func (o *physicsServer) BodyGetCollisionMask(body gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the [PhysicsDirectBodyState] of the body.
-	Args: [{ false body RID}], Returns: PhysicsDirectBodyState
+	        Returns the [PhysicsDirectBodyState] of the body.
+		Args: [{ false body RID}], Returns: PhysicsDirectBodyState
 */
 func (o *physicsServer) BodyGetDirectState(body gdnative.Rid) PhysicsDirectBodyStateImplementer {
 	o.ensureSingleton()
@@ -1092,7 +1934,7 @@ func (o *physicsServer) BodyGetDirectState(body gdnative.Rid) PhysicsDirectBodyS
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_direct_state")
+	methodBind := methodBindPhysicsServerBodyGetDirectState.Get()
 
 	// Call the parent method.
 	// PhysicsDirectBodyState
@@ -1100,27 +1942,24 @@ func (o *physicsServer) BodyGetDirectState(body gdnative.Rid) PhysicsDirectBodyS
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysicsDirectBodyStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PhysicsDirectBodyStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PhysicsDirectBodyState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PhysicsDirectBodyStateImplementer)
+	ret := PhysicsDirectBodyState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(PhysicsDirectBodyStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false body RID}], Returns: float
+Args: [{ false body RID}], Returns: float
 */
 func (o *physicsServer) BodyGetKinematicSafeMargin(body gdnative.Rid) gdnative.Real {
 	o.ensureSingleton()
@@ -1131,7 +1970,7 @@ func (o *physicsServer) BodyGetKinematicSafeMargin(body gdnative.Rid) gdnative.R
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_kinematic_safe_margin")
+	methodBind := methodBindPhysicsServerBodyGetKinematicSafeMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -1144,8 +1983,8 @@ func (o *physicsServer) BodyGetKinematicSafeMargin(body gdnative.Rid) gdnative.R
 }
 
 /*
-        Returns the maximum contacts that can be reported. See [method body_set_max_contacts_reported].
-	Args: [{ false body RID}], Returns: int
+	        Returns the maximum contacts that can be reported. See [method body_set_max_contacts_reported].
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physicsServer) BodyGetMaxContactsReported(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -1156,7 +1995,7 @@ func (o *physicsServer) BodyGetMaxContactsReported(body gdnative.Rid) gdnative.I
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_max_contacts_reported")
+	methodBind := methodBindPhysicsServerBodyGetMaxContactsReported.Get()
 
 	// Call the parent method.
 	// int
@@ -1169,8 +2008,8 @@ func (o *physicsServer) BodyGetMaxContactsReported(body gdnative.Rid) gdnative.I
 }
 
 /*
-        Returns the body mode.
-	Args: [{ false body RID}], Returns: enum.PhysicsServer::BodyMode
+	        Returns the body mode.
+		Args: [{ false body RID}], Returns: enum.PhysicsServer::BodyMode
 */
 func (o *physicsServer) BodyGetMode(body gdnative.Rid) PhysicsServerBodyMode {
 	o.ensureSingleton()
@@ -1181,7 +2020,7 @@ func (o *physicsServer) BodyGetMode(body gdnative.Rid) PhysicsServerBodyMode {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_mode")
+	methodBind := methodBindPhysicsServerBodyGetMode.Get()
 
 	// Call the parent method.
 	// enum.PhysicsServer::BodyMode
@@ -1194,8 +2033,8 @@ func (o *physicsServer) BodyGetMode(body gdnative.Rid) PhysicsServerBodyMode {
 }
 
 /*
-        Gets the instance ID of the object the area is assigned to.
-	Args: [{ false body RID}], Returns: int
+	        Gets the instance ID of the object the area is assigned to.
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physicsServer) BodyGetObjectInstanceId(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -1206,7 +2045,7 @@ func (o *physicsServer) BodyGetObjectInstanceId(body gdnative.Rid) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_object_instance_id")
+	methodBind := methodBindPhysicsServerBodyGetObjectInstanceId.Get()
 
 	// Call the parent method.
 	// int
@@ -1219,8 +2058,8 @@ func (o *physicsServer) BodyGetObjectInstanceId(body gdnative.Rid) gdnative.Int
 }
 
 /*
-        Returns the value of a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
-	Args: [{ false body RID} { false param int}], Returns: float
+	        Returns the value of a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
+		Args: [{ false body RID} { false param int}], Returns: float
 */
 func (o *physicsServer) BodyGetParam(body gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -1232,7 +2071,7 @@ func (o *physicsServer) BodyGetParam(body gdnative.Rid, param gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_param")
+	methodBind := methodBindPhysicsServerBodyGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -1245,8 +2084,8 @@ func (o *physicsServer) BodyGetParam(body gdnative.Rid, param gdnative.Int) gdna
 }
 
 /*
-        Returns the [RID] of the nth shape of a body.
-	Args: [{ false body RID} { false shape_idx int}], Returns: RID
+	        Returns the [RID] of the nth shape of a body.
+		Args: [{ false body RID} { false shape_idx int}], Returns: RID
 */
 func (o *physicsServer) BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int) gdnative.Rid {
 	o.ensureSingleton()
@@ -1258,7 +2097,7 @@ func (o *physicsServer) BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int) g
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_shape")
+	methodBind := methodBindPhysicsServerBodyGetShape.Get()
 
 	// Call the parent method.
 	// RID
@@ -1271,8 +2110,8 @@ func (o *physicsServer) BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int) g
 }
 
 /*
-        Returns the number of shapes assigned to a body.
-	Args: [{ false body RID}], Returns: int
+	        Returns the number of shapes assigned to a body.
+		Args: [{ false body RID}], Returns: int
 */
 func (o *physicsServer) BodyGetShapeCount(body gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -1283,7 +2122,7 @@ func (o *physicsServer) BodyGetShapeCount(body gdnative.Rid) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_shape_count")
+	methodBind := methodBindPhysicsServerBodyGetShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -1296,8 +2135,8 @@ func (o *physicsServer) BodyGetShapeCount(body gdnative.Rid) gdnative.Int {
 }
 
 /*
-        Returns the transform matrix of a body shape.
-	Args: [{ false body RID} { false shape_idx int}], Returns: Transform
+	        Returns the transform matrix of a body shape.
+		Args: [{ false body RID} { false shape_idx int}], Returns: Transform
 */
 func (o *physicsServer) BodyGetShapeTransform(body gdnative.Rid, shapeIdx gdnative.Int) gdnative.Transform {
 	o.ensureSingleton()
@@ -1309,7 +2148,7 @@ func (o *physicsServer) BodyGetShapeTransform(body gdnative.Rid, shapeIdx gdnati
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_shape_transform")
+	methodBind := methodBindPhysicsServerBodyGetShapeTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -1322,8 +2161,8 @@ func (o *physicsServer) BodyGetShapeTransform(body gdnative.Rid, shapeIdx gdnati
 }
 
 /*
-        Returns the [RID] of the space assigned to a body.
-	Args: [{ false body RID}], Returns: RID
+	        Returns the [RID] of the space assigned to a body.
+		Args: [{ false body RID}], Returns: RID
 */
 func (o *physicsServer) BodyGetSpace(body gdnative.Rid) gdnative.Rid {
 	o.ensureSingleton()
@@ -1334,7 +2173,7 @@ func (o *physicsServer) BodyGetSpace(body gdnative.Rid) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_space")
+	methodBind := methodBindPhysicsServerBodyGetSpace.Get()
 
 	// Call the parent method.
 	// RID
@@ -1347,8 +2186,8 @@ func (o *physicsServer) BodyGetSpace(body gdnative.Rid) gdnative.Rid {
 }
 
 /*
-        Returns a body state.
-	Args: [{ false body RID} { false state int}], Returns: Variant
+	        Returns a body state.
+		Args: [{ false body RID} { false state int}], Returns: Variant
 */
 func (o *physicsServer) BodyGetState(body gdnative.Rid, state gdnative.Int) gdnative.Variant {
 	o.ensureSingleton()
@@ -1360,7 +2199,7 @@ func (o *physicsServer) BodyGetState(body gdnative.Rid, state gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(state)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_get_state")
+	methodBind := methodBindPhysicsServerBodyGetState.Get()
 
 	// Call the parent method.
 	// Variant
@@ -1373,8 +2212,7 @@ func (o *physicsServer) BodyGetState(body gdnative.Rid, state gdnative.Int) gdna
 }
 
 /*
-
-	Args: [{ false body RID} { false axis int}], Returns: bool
+Args: [{ false body RID} { false axis int}], Returns: bool
 */
 func (o *physicsServer) BodyIsAxisLocked(body gdnative.Rid, axis gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -1386,7 +2224,7 @@ func (o *physicsServer) BodyIsAxisLocked(body gdnative.Rid, axis gdnative.Int) g
 	ptrArguments[1] = gdnative.NewPointerFromInt(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_is_axis_locked")
+	methodBind := methodBindPhysicsServerBodyIsAxisLocked.Get()
 
 	// Call the parent method.
 	// bool
@@ -1399,8 +2237,8 @@ func (o *physicsServer) BodyIsAxisLocked(body gdnative.Rid, axis gdnative.Int) g
 }
 
 /*
-        If [code]true[/code] the continuous collision detection mode is enabled.
-	Args: [{ false body RID}], Returns: bool
+	        If [code]true[/code] the continuous collision detection mode is enabled.
+		Args: [{ false body RID}], Returns: bool
 */
 func (o *physicsServer) BodyIsContinuousCollisionDetectionEnabled(body gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -1411,7 +2249,7 @@ func (o *physicsServer) BodyIsContinuousCollisionDetectionEnabled(body gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_is_continuous_collision_detection_enabled")
+	methodBind := methodBindPhysicsServerBodyIsContinuousCollisionDetectionEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1424,8 +2262,8 @@ func (o *physicsServer) BodyIsContinuousCollisionDetectionEnabled(body gdnative.
 }
 
 /*
-        Returns whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
-	Args: [{ false body RID}], Returns: bool
+	        Returns whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
+		Args: [{ false body RID}], Returns: bool
 */
 func (o *physicsServer) BodyIsOmittingForceIntegration(body gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -1436,7 +2274,7 @@ func (o *physicsServer) BodyIsOmittingForceIntegration(body gdnative.Rid) gdnati
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_is_omitting_force_integration")
+	methodBind := methodBindPhysicsServerBodyIsOmittingForceIntegration.Get()
 
 	// Call the parent method.
 	// bool
@@ -1449,8 +2287,8 @@ func (o *physicsServer) BodyIsOmittingForceIntegration(body gdnative.Rid) gdnati
 }
 
 /*
-        If [code]true[/code] the body can be detected by rays
-	Args: [{ false body RID}], Returns: bool
+	        If [code]true[/code] the body can be detected by rays
+		Args: [{ false body RID}], Returns: bool
 */
 func (o *physicsServer) BodyIsRayPickable(body gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -1461,7 +2299,7 @@ func (o *physicsServer) BodyIsRayPickable(body gdnative.Rid) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_is_ray_pickable")
+	methodBind := methodBindPhysicsServerBodyIsRayPickable.Get()
 
 	// Call the parent method.
 	// bool
@@ -1474,8 +2312,8 @@ func (o *physicsServer) BodyIsRayPickable(body gdnative.Rid) gdnative.Bool {
 }
 
 /*
-        Removes a body from the list of bodies exempt from collisions. Continuous collision detection tries to predict where a moving body will collide, instead of moving it and correcting its movement if it collided.
-	Args: [{ false body RID} { false excepted_body RID}], Returns: void
+	        Removes a body from the list of bodies exempt from collisions. Continuous collision detection tries to predict where a moving body will collide, instead of moving it and correcting its movement if it collided.
+		Args: [{ false body RID} { false excepted_body RID}], Returns: void
 */
 func (o *physicsServer) BodyRemoveCollisionException(body gdnative.Rid, exceptedBody gdnative.Rid) {
 	o.ensureSingleton()
@@ -1487,7 +2325,7 @@ func (o *physicsServer) BodyRemoveCollisionException(body gdnative.Rid, excepted
 	ptrArguments[1] = gdnative.NewPointerFromRid(exceptedBody)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_remove_collision_exception")
+	methodBind := methodBindPhysicsServerBodyRemoveCollisionException.Get()
 
 	// Call the parent method.
 	// void
@@ -1497,8 +2335,8 @@ func (o *physicsServer) BodyRemoveCollisionException(body gdnative.Rid, excepted
 }
 
 /*
-        Removes a shape from a body. The shape is not deleted, so it can be reused afterwards.
-	Args: [{ false body RID} { false shape_idx int}], Returns: void
+	        Removes a shape from a body. The shape is not deleted, so it can be reused afterwards.
+		Args: [{ false body RID} { false shape_idx int}], Returns: void
 */
 func (o *physicsServer) BodyRemoveShape(body gdnative.Rid, shapeIdx gdnative.Int) {
 	o.ensureSingleton()
@@ -1510,7 +2348,7 @@ func (o *physicsServer) BodyRemoveShape(body gdnative.Rid, shapeIdx gdnative.Int
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_remove_shape")
+	methodBind := methodBindPhysicsServerBodyRemoveShape.Get()
 
 	// Call the parent method.
 	// void
@@ -1520,8 +2358,7 @@ func (o *physicsServer) BodyRemoveShape(body gdnative.Rid, shapeIdx gdnative.Int
 }
 
 /*
-
-	Args: [{ false body RID} { false axis int} { false lock bool}], Returns: void
+Args: [{ false body RID} { false axis int} { false lock bool}], Returns: void
 */
 func (o *physicsServer) BodySetAxisLock(body gdnative.Rid, axis gdnative.Int, lock gdnative.Bool) {
 	o.ensureSingleton()
@@ -1534,7 +2371,7 @@ func (o *physicsServer) BodySetAxisLock(body gdnative.Rid, axis gdnative.Int, lo
 	ptrArguments[2] = gdnative.NewPointerFromBool(lock)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_axis_lock")
+	methodBind := methodBindPhysicsServerBodySetAxisLock.Get()
 
 	// Call the parent method.
 	// void
@@ -1544,8 +2381,8 @@ func (o *physicsServer) BodySetAxisLock(body gdnative.Rid, axis gdnative.Int, lo
 }
 
 /*
-        Sets an axis velocity. The velocity in the given vector axis will be set as the given vector length. This is useful for jumping behavior.
-	Args: [{ false body RID} { false axis_velocity Vector3}], Returns: void
+	        Sets an axis velocity. The velocity in the given vector axis will be set as the given vector length. This is useful for jumping behavior.
+		Args: [{ false body RID} { false axis_velocity Vector3}], Returns: void
 */
 func (o *physicsServer) BodySetAxisVelocity(body gdnative.Rid, axisVelocity gdnative.Vector3) {
 	o.ensureSingleton()
@@ -1557,7 +2394,7 @@ func (o *physicsServer) BodySetAxisVelocity(body gdnative.Rid, axisVelocity gdna
 	ptrArguments[1] = gdnative.NewPointerFromVector3(axisVelocity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_axis_velocity")
+	methodBind := methodBindPhysicsServerBodySetAxisVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -1567,8 +2404,8 @@ func (o *physicsServer) BodySetAxisVelocity(body gdnative.Rid, axisVelocity gdna
 }
 
 /*
-        Sets the physics layer or layers a body belongs to.
-	Args: [{ false body RID} { false layer int}], Returns: void
+	        Sets the physics layer or layers a body belongs to.
+		Args: [{ false body RID} { false layer int}], Returns: void
 */
 func (o *physicsServer) BodySetCollisionLayer(body gdnative.Rid, layer gdnative.Int) {
 	o.ensureSingleton()
@@ -1580,7 +2417,7 @@ func (o *physicsServer) BodySetCollisionLayer(body gdnative.Rid, layer gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_collision_layer")
+	methodBind := methodBindPhysicsServerBodySetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -1590,8 +2427,8 @@ func (o *physicsServer) BodySetCollisionLayer(body gdnative.Rid, layer gdnative.
 }
 
 /*
-        Sets the physics layer or layers a body can collide with.
-	Args: [{ false body RID} { false mask int}], Returns: void
+	        Sets the physics layer or layers a body can collide with.
+		Args: [{ false body RID} { false mask int}], Returns: void
 */
 func (o *physicsServer) BodySetCollisionMask(body gdnative.Rid, mask gdnative.Int) {
 	o.ensureSingleton()
@@ -1603,7 +2440,7 @@ func (o *physicsServer) BodySetCollisionMask(body gdnative.Rid, mask gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_collision_mask")
+	methodBind := methodBindPhysicsServerBodySetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -1613,8 +2450,8 @@ func (o *physicsServer) BodySetCollisionMask(body gdnative.Rid, mask gdnative.In
 }
 
 /*
-        If [code]true[/code] the continuous collision detection mode is enabled. Continuous collision detection tries to predict where a moving body will collide, instead of moving it and correcting its movement if it collided.
-	Args: [{ false body RID} { false enable bool}], Returns: void
+	        If [code]true[/code] the continuous collision detection mode is enabled. Continuous collision detection tries to predict where a moving body will collide, instead of moving it and correcting its movement if it collided.
+		Args: [{ false body RID} { false enable bool}], Returns: void
 */
 func (o *physicsServer) BodySetEnableContinuousCollisionDetection(body gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -1626,7 +2463,7 @@ func (o *physicsServer) BodySetEnableContinuousCollisionDetection(body gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_enable_continuous_collision_detection")
+	methodBind := methodBindPhysicsServerBodySetEnableContinuousCollisionDetection.Get()
 
 	// Call the parent method.
 	// void
@@ -1636,22 +2473,22 @@ func (o *physicsServer) BodySetEnableContinuousCollisionDetection(body gdnative.
 }
 
 /*
-        Sets the function used to calculate physics for an object, if that object allows it (see [method body_set_omit_force integration]).
-	Args: [{ false body RID} { false receiver Object} { false method String} {Null true userdata Variant}], Returns: void
+	        Sets the function used to calculate physics for an object, if that object allows it (see [method body_set_omit_force integration]).
+		Args: [{ false body RID} { false receiver Object} { false method String} {Null true userdata Variant}], Returns: void
 */
-func (o *physicsServer) BodySetForceIntegrationCallback(body gdnative.Rid, receiver ObjectImplementer, method gdnative.String, userdata gdnative.Variant) {
+func (o *physicsServer) BodySetForceIntegrationCallback(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant) {
 	o.ensureSingleton()
 	//log.Println("Calling PhysicsServer.BodySetForceIntegrationCallback()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
 	ptrArguments[0] = gdnative.NewPointerFromRid(body)
-	ptrArguments[1] = gdnative.NewPointerFromObject(receiver.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(receiver))
 	ptrArguments[2] = gdnative.NewPointerFromString(method)
 	ptrArguments[3] = gdnative.NewPointerFromVariant(userdata)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_force_integration_callback")
+	methodBind := methodBindPhysicsServerBodySetForceIntegrationCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -1661,8 +2498,7 @@ func (o *physicsServer) BodySetForceIntegrationCallback(body gdnative.Rid, recei
 }
 
 /*
-
-	Args: [{ false body RID} { false margin float}], Returns: void
+Args: [{ false body RID} { false margin float}], Returns: void
 */
 func (o *physicsServer) BodySetKinematicSafeMargin(body gdnative.Rid, margin gdnative.Real) {
 	o.ensureSingleton()
@@ -1674,7 +2510,7 @@ func (o *physicsServer) BodySetKinematicSafeMargin(body gdnative.Rid, margin gdn
 	ptrArguments[1] = gdnative.NewPointerFromReal(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_kinematic_safe_margin")
+	methodBind := methodBindPhysicsServerBodySetKinematicSafeMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -1684,8 +2520,8 @@ func (o *physicsServer) BodySetKinematicSafeMargin(body gdnative.Rid, margin gdn
 }
 
 /*
-        Sets the maximum contacts to report. Bodies can keep a log of the contacts with other bodies, this is enabled by setting the maximum amount of contacts reported to a number greater than 0.
-	Args: [{ false body RID} { false amount int}], Returns: void
+	        Sets the maximum contacts to report. Bodies can keep a log of the contacts with other bodies, this is enabled by setting the maximum amount of contacts reported to a number greater than 0.
+		Args: [{ false body RID} { false amount int}], Returns: void
 */
 func (o *physicsServer) BodySetMaxContactsReported(body gdnative.Rid, amount gdnative.Int) {
 	o.ensureSingleton()
@@ -1697,7 +2533,7 @@ func (o *physicsServer) BodySetMaxContactsReported(body gdnative.Rid, amount gdn
 	ptrArguments[1] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_max_contacts_reported")
+	methodBind := methodBindPhysicsServerBodySetMaxContactsReported.Get()
 
 	// Call the parent method.
 	// void
@@ -1707,8 +2543,8 @@ func (o *physicsServer) BodySetMaxContactsReported(body gdnative.Rid, amount gdn
 }
 
 /*
-        Sets the body mode, from one of the constants BODY_MODE*.
-	Args: [{ false body RID} { false mode int}], Returns: void
+	        Sets the body mode, from one of the constants BODY_MODE*.
+		Args: [{ false body RID} { false mode int}], Returns: void
 */
 func (o *physicsServer) BodySetMode(body gdnative.Rid, mode gdnative.Int) {
 	o.ensureSingleton()
@@ -1720,7 +2556,7 @@ func (o *physicsServer) BodySetMode(body gdnative.Rid, mode gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_mode")
+	methodBind := methodBindPhysicsServerBodySetMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1730,8 +2566,8 @@ func (o *physicsServer) BodySetMode(body gdnative.Rid, mode gdnative.Int) {
 }
 
 /*
-        Sets whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
-	Args: [{ false body RID} { false enable bool}], Returns: void
+	        Sets whether a body uses a callback function to calculate its own physics (see [method body_set_force_integration_callback]).
+		Args: [{ false body RID} { false enable bool}], Returns: void
 */
 func (o *physicsServer) BodySetOmitForceIntegration(body gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -1743,7 +2579,7 @@ func (o *physicsServer) BodySetOmitForceIntegration(body gdnative.Rid, enable gd
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_omit_force_integration")
+	methodBind := methodBindPhysicsServerBodySetOmitForceIntegration.Get()
 
 	// Call the parent method.
 	// void
@@ -1753,8 +2589,8 @@ func (o *physicsServer) BodySetOmitForceIntegration(body gdnative.Rid, enable gd
 }
 
 /*
-        Sets a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
-	Args: [{ false body RID} { false param int} { false value float}], Returns: void
+	        Sets a body parameter. A list of available parameters is on the BODY_PARAM_* constants.
+		Args: [{ false body RID} { false param int} { false value float}], Returns: void
 */
 func (o *physicsServer) BodySetParam(body gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -1767,7 +2603,7 @@ func (o *physicsServer) BodySetParam(body gdnative.Rid, param gdnative.Int, valu
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_param")
+	methodBind := methodBindPhysicsServerBodySetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -1777,8 +2613,8 @@ func (o *physicsServer) BodySetParam(body gdnative.Rid, param gdnative.Int, valu
 }
 
 /*
-        Sets the body pickable with rays if [code]enabled[/code] is set.
-	Args: [{ false body RID} { false enable bool}], Returns: void
+	        Sets the body pickable with rays if [code]enabled[/code] is set.
+		Args: [{ false body RID} { false enable bool}], Returns: void
 */
 func (o *physicsServer) BodySetRayPickable(body gdnative.Rid, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -1790,7 +2626,7 @@ func (o *physicsServer) BodySetRayPickable(body gdnative.Rid, enable gdnative.Bo
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_ray_pickable")
+	methodBind := methodBindPhysicsServerBodySetRayPickable.Get()
 
 	// Call the parent method.
 	// void
@@ -1800,8 +2636,8 @@ func (o *physicsServer) BodySetRayPickable(body gdnative.Rid, enable gdnative.Bo
 }
 
 /*
-        Substitutes a given body shape by another. The old shape is selected by its index, the new one by its [RID].
-	Args: [{ false body RID} { false shape_idx int} { false shape RID}], Returns: void
+	        Substitutes a given body shape by another. The old shape is selected by its index, the new one by its [RID].
+		Args: [{ false body RID} { false shape_idx int} { false shape RID}], Returns: void
 */
 func (o *physicsServer) BodySetShape(body gdnative.Rid, shapeIdx gdnative.Int, shape gdnative.Rid) {
 	o.ensureSingleton()
@@ -1814,7 +2650,7 @@ func (o *physicsServer) BodySetShape(body gdnative.Rid, shapeIdx gdnative.Int, s
 	ptrArguments[2] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_shape")
+	methodBind := methodBindPhysicsServerBodySetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -1824,8 +2660,8 @@ func (o *physicsServer) BodySetShape(body gdnative.Rid, shapeIdx gdnative.Int, s
 }
 
 /*
-        Sets the transform matrix for a body shape.
-	Args: [{ false body RID} { false shape_idx int} { false transform Transform}], Returns: void
+	        Sets the transform matrix for a body shape.
+		Args: [{ false body RID} { false shape_idx int} { false transform Transform}], Returns: void
 */
 func (o *physicsServer) BodySetShapeTransform(body gdnative.Rid, shapeIdx gdnative.Int, transform gdnative.Transform) {
 	o.ensureSingleton()
@@ -1838,7 +2674,7 @@ func (o *physicsServer) BodySetShapeTransform(body gdnative.Rid, shapeIdx gdnati
 	ptrArguments[2] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_shape_transform")
+	methodBind := methodBindPhysicsServerBodySetShapeTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1848,8 +2684,8 @@ func (o *physicsServer) BodySetShapeTransform(body gdnative.Rid, shapeIdx gdnati
 }
 
 /*
-        Assigns a space to the body (see [method create_space]).
-	Args: [{ false body RID} { false space RID}], Returns: void
+	        Assigns a space to the body (see [method create_space]).
+		Args: [{ false body RID} { false space RID}], Returns: void
 */
 func (o *physicsServer) BodySetSpace(body gdnative.Rid, space gdnative.Rid) {
 	o.ensureSingleton()
@@ -1861,7 +2697,7 @@ func (o *physicsServer) BodySetSpace(body gdnative.Rid, space gdnative.Rid) {
 	ptrArguments[1] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_space")
+	methodBind := methodBindPhysicsServerBodySetSpace.Get()
 
 	// Call the parent method.
 	// void
@@ -1871,8 +2707,8 @@ func (o *physicsServer) BodySetSpace(body gdnative.Rid, space gdnative.Rid) {
 }
 
 /*
-        Sets a body state (see BODY_STATE* constants).
-	Args: [{ false body RID} { false state int} { false value Variant}], Returns: void
+	        Sets a body state (see BODY_STATE* constants).
+		Args: [{ false body RID} { false state int} { false value Variant}], Returns: void
 */
 func (o *physicsServer) BodySetState(body gdnative.Rid, state gdnative.Int, value gdnative.Variant) {
 	o.ensureSingleton()
@@ -1885,7 +2721,7 @@ func (o *physicsServer) BodySetState(body gdnative.Rid, state gdnative.Int, valu
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "body_set_state")
+	methodBind := methodBindPhysicsServerBodySetState.Get()
 
 	// Call the parent method.
 	// void
@@ -1895,8 +2731,8 @@ func (o *physicsServer) BodySetState(body gdnative.Rid, state gdnative.Int, valu
 }
 
 /*
-        Gets a cone_twist_joint parameter (see CONE_TWIST_JOINT* constants).
-	Args: [{ false joint RID} { false param int}], Returns: float
+	        Gets a cone_twist_joint parameter (see CONE_TWIST_JOINT* constants).
+		Args: [{ false joint RID} { false param int}], Returns: float
 */
 func (o *physicsServer) ConeTwistJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -1908,7 +2744,7 @@ func (o *physicsServer) ConeTwistJointGetParam(joint gdnative.Rid, param gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "cone_twist_joint_get_param")
+	methodBind := methodBindPhysicsServerConeTwistJointGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -1921,8 +2757,8 @@ func (o *physicsServer) ConeTwistJointGetParam(joint gdnative.Rid, param gdnativ
 }
 
 /*
-        Sets a cone_twist_joint parameter (see CONE_TWIST_JOINT* constants).
-	Args: [{ false joint RID} { false param int} { false value float}], Returns: void
+	        Sets a cone_twist_joint parameter (see CONE_TWIST_JOINT* constants).
+		Args: [{ false joint RID} { false param int} { false value float}], Returns: void
 */
 func (o *physicsServer) ConeTwistJointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -1935,7 +2771,7 @@ func (o *physicsServer) ConeTwistJointSetParam(joint gdnative.Rid, param gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "cone_twist_joint_set_param")
+	methodBind := methodBindPhysicsServerConeTwistJointSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -1945,8 +2781,8 @@ func (o *physicsServer) ConeTwistJointSetParam(joint gdnative.Rid, param gdnativ
 }
 
 /*
-        Destroys any of the objects created by PhysicsServer. If the [RID] passed is not one of the objects that can be created by PhysicsServer, an error will be sent to the console.
-	Args: [{ false rid RID}], Returns: void
+	        Destroys any of the objects created by PhysicsServer. If the [RID] passed is not one of the objects that can be created by PhysicsServer, an error will be sent to the console.
+		Args: [{ false rid RID}], Returns: void
 */
 func (o *physicsServer) FreeRid(rid gdnative.Rid) {
 	o.ensureSingleton()
@@ -1957,7 +2793,7 @@ func (o *physicsServer) FreeRid(rid gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "free_rid")
+	methodBind := methodBindPhysicsServerFreeRid.Get()
 
 	// Call the parent method.
 	// void
@@ -1967,8 +2803,8 @@ func (o *physicsServer) FreeRid(rid gdnative.Rid) {
 }
 
 /*
-        Gets a generic_6_DOF_joint flag (see G6DOF_JOINT_FLAG* constants).
-	Args: [{ false joint RID} { false axis int} { false flag int}], Returns: bool
+	        Gets a generic_6_DOF_joint flag (see G6DOF_JOINT_FLAG* constants).
+		Args: [{ false joint RID} { false axis int} { false flag int}], Returns: bool
 */
 func (o *physicsServer) Generic6DofJointGetFlag(joint gdnative.Rid, axis gdnative.Int, flag gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -1981,7 +2817,7 @@ func (o *physicsServer) Generic6DofJointGetFlag(joint gdnative.Rid, axis gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromInt(flag)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "generic_6dof_joint_get_flag")
+	methodBind := methodBindPhysicsServerGeneric6DofJointGetFlag.Get()
 
 	// Call the parent method.
 	// bool
@@ -1994,8 +2830,8 @@ func (o *physicsServer) Generic6DofJointGetFlag(joint gdnative.Rid, axis gdnativ
 }
 
 /*
-        Gets a generic_6_DOF_joint parameter (see G6DOF_JOINT* constants without the G6DOF_JOINT_FLAG*).
-	Args: [{ false joint RID} { false axis int} { false param int}], Returns: float
+	        Gets a generic_6_DOF_joint parameter (see G6DOF_JOINT* constants without the G6DOF_JOINT_FLAG*).
+		Args: [{ false joint RID} { false axis int} { false param int}], Returns: float
 */
 func (o *physicsServer) Generic6DofJointGetParam(joint gdnative.Rid, axis gdnative.Int, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -2008,7 +2844,7 @@ func (o *physicsServer) Generic6DofJointGetParam(joint gdnative.Rid, axis gdnati
 	ptrArguments[2] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "generic_6dof_joint_get_param")
+	methodBind := methodBindPhysicsServerGeneric6DofJointGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -2021,8 +2857,8 @@ func (o *physicsServer) Generic6DofJointGetParam(joint gdnative.Rid, axis gdnati
 }
 
 /*
-        Sets a generic_6_DOF_joint flag (see G6DOF_JOINT_FLAG* constants).
-	Args: [{ false joint RID} { false axis int} { false flag int} { false enable bool}], Returns: void
+	        Sets a generic_6_DOF_joint flag (see G6DOF_JOINT_FLAG* constants).
+		Args: [{ false joint RID} { false axis int} { false flag int} { false enable bool}], Returns: void
 */
 func (o *physicsServer) Generic6DofJointSetFlag(joint gdnative.Rid, axis gdnative.Int, flag gdnative.Int, enable gdnative.Bool) {
 	o.ensureSingleton()
@@ -2036,7 +2872,7 @@ func (o *physicsServer) Generic6DofJointSetFlag(joint gdnative.Rid, axis gdnativ
 	ptrArguments[3] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "generic_6dof_joint_set_flag")
+	methodBind := methodBindPhysicsServerGeneric6DofJointSetFlag.Get()
 
 	// Call the parent method.
 	// void
@@ -2046,8 +2882,8 @@ func (o *physicsServer) Generic6DofJointSetFlag(joint gdnative.Rid, axis gdnativ
 }
 
 /*
-        Sets a generic_6_DOF_joint parameter (see G6DOF_JOINT* constants without the G6DOF_JOINT_FLAG*).
-	Args: [{ false joint RID} { false axis int} { false param int} { false value float}], Returns: void
+	        Sets a generic_6_DOF_joint parameter (see G6DOF_JOINT* constants without the G6DOF_JOINT_FLAG*).
+		Args: [{ false joint RID} { false axis int} { false param int} { false value float}], Returns: void
 */
 func (o *physicsServer) Generic6DofJointSetParam(joint gdnative.Rid, axis gdnative.Int, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -2061,7 +2897,7 @@ func (o *physicsServer) Generic6DofJointSetParam(joint gdnative.Rid, axis gdnati
 	ptrArguments[3] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "generic_6dof_joint_set_param")
+	methodBind := methodBindPhysicsServerGeneric6DofJointSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -2071,19 +2907,19 @@ func (o *physicsServer) Generic6DofJointSetParam(joint gdnative.Rid, axis gdnati
 }
 
 /*
-        Returns an Info defined by the [ProcessInfo] input given.
-	Args: [{ false process_info int}], Returns: int
+	        Returns an Info defined by the [ProcessInfo] input given.
+		Args: [{ false process_info enum.PhysicsServer::ProcessInfo}], Returns: int
 */
-func (o *physicsServer) GetProcessInfo(processInfo gdnative.Int) gdnative.Int {
+func (o *physicsServer) GetProcessInfo(processInfo PhysicsServerProcessInfo) gdnative.Int {
 	o.ensureSingleton()
 	//log.Println("Calling PhysicsServer.GetProcessInfo()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(processInfo)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(processInfo))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "get_process_info")
+	methodBind := methodBindPhysicsServerGetProcessInfo.Get()
 
 	// Call the parent method.
 	// int
@@ -2096,8 +2932,8 @@ func (o *physicsServer) GetProcessInfo(processInfo gdnative.Int) gdnative.Int {
 }
 
 /*
-        Gets a hinge_joint flag (see HINGE_JOINT_FLAG* constants).
-	Args: [{ false joint RID} { false flag int}], Returns: bool
+	        Gets a hinge_joint flag (see HINGE_JOINT_FLAG* constants).
+		Args: [{ false joint RID} { false flag int}], Returns: bool
 */
 func (o *physicsServer) HingeJointGetFlag(joint gdnative.Rid, flag gdnative.Int) gdnative.Bool {
 	o.ensureSingleton()
@@ -2109,7 +2945,7 @@ func (o *physicsServer) HingeJointGetFlag(joint gdnative.Rid, flag gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(flag)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "hinge_joint_get_flag")
+	methodBind := methodBindPhysicsServerHingeJointGetFlag.Get()
 
 	// Call the parent method.
 	// bool
@@ -2122,8 +2958,8 @@ func (o *physicsServer) HingeJointGetFlag(joint gdnative.Rid, flag gdnative.Int)
 }
 
 /*
-        Gets a hinge_joint parameter (see HINGE_JOINT* constants without the HINGE_JOINT_FLAG*).
-	Args: [{ false joint RID} { false param int}], Returns: float
+	        Gets a hinge_joint parameter (see HINGE_JOINT* constants without the HINGE_JOINT_FLAG*).
+		Args: [{ false joint RID} { false param int}], Returns: float
 */
 func (o *physicsServer) HingeJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -2135,7 +2971,7 @@ func (o *physicsServer) HingeJointGetParam(joint gdnative.Rid, param gdnative.In
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "hinge_joint_get_param")
+	methodBind := methodBindPhysicsServerHingeJointGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -2148,8 +2984,8 @@ func (o *physicsServer) HingeJointGetParam(joint gdnative.Rid, param gdnative.In
 }
 
 /*
-        Sets a hinge_joint flag (see HINGE_JOINT_FLAG* constants).
-	Args: [{ false joint RID} { false flag int} { false enabled bool}], Returns: void
+	        Sets a hinge_joint flag (see HINGE_JOINT_FLAG* constants).
+		Args: [{ false joint RID} { false flag int} { false enabled bool}], Returns: void
 */
 func (o *physicsServer) HingeJointSetFlag(joint gdnative.Rid, flag gdnative.Int, enabled gdnative.Bool) {
 	o.ensureSingleton()
@@ -2162,7 +2998,7 @@ func (o *physicsServer) HingeJointSetFlag(joint gdnative.Rid, flag gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "hinge_joint_set_flag")
+	methodBind := methodBindPhysicsServerHingeJointSetFlag.Get()
 
 	// Call the parent method.
 	// void
@@ -2172,8 +3008,8 @@ func (o *physicsServer) HingeJointSetFlag(joint gdnative.Rid, flag gdnative.Int,
 }
 
 /*
-        Sets a hinge_joint parameter (see HINGE_JOINT* constants without the HINGE_JOINT_FLAG*).
-	Args: [{ false joint RID} { false param int} { false value float}], Returns: void
+	        Sets a hinge_joint parameter (see HINGE_JOINT* constants without the HINGE_JOINT_FLAG*).
+		Args: [{ false joint RID} { false param int} { false value float}], Returns: void
 */
 func (o *physicsServer) HingeJointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -2186,7 +3022,7 @@ func (o *physicsServer) HingeJointSetParam(joint gdnative.Rid, param gdnative.In
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "hinge_joint_set_param")
+	methodBind := methodBindPhysicsServerHingeJointSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -2196,8 +3032,8 @@ func (o *physicsServer) HingeJointSetParam(joint gdnative.Rid, param gdnative.In
 }
 
 /*
-        Creates a [ConeTwistJoint].
-	Args: [{ false body_A RID} { false local_ref_A Transform} { false body_B RID} { false local_ref_B Transform}], Returns: RID
+	        Creates a [ConeTwistJoint].
+		Args: [{ false body_A RID} { false local_ref_A Transform} { false body_B RID} { false local_ref_B Transform}], Returns: RID
 */
 func (o *physicsServer) JointCreateConeTwist(bodyA gdnative.Rid, localRefA gdnative.Transform, bodyB gdnative.Rid, localRefB gdnative.Transform) gdnative.Rid {
 	o.ensureSingleton()
@@ -2211,7 +3047,7 @@ func (o *physicsServer) JointCreateConeTwist(bodyA gdnative.Rid, localRefA gdnat
 	ptrArguments[3] = gdnative.NewPointerFromTransform(localRefB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_create_cone_twist")
+	methodBind := methodBindPhysicsServerJointCreateConeTwist.Get()
 
 	// Call the parent method.
 	// RID
@@ -2224,8 +3060,8 @@ func (o *physicsServer) JointCreateConeTwist(bodyA gdnative.Rid, localRefA gdnat
 }
 
 /*
-        Creates a [Generic6DOFJoint].
-	Args: [{ false body_A RID} { false local_ref_A Transform} { false body_B RID} { false local_ref_B Transform}], Returns: RID
+	        Creates a [Generic6DOFJoint].
+		Args: [{ false body_A RID} { false local_ref_A Transform} { false body_B RID} { false local_ref_B Transform}], Returns: RID
 */
 func (o *physicsServer) JointCreateGeneric6Dof(bodyA gdnative.Rid, localRefA gdnative.Transform, bodyB gdnative.Rid, localRefB gdnative.Transform) gdnative.Rid {
 	o.ensureSingleton()
@@ -2239,7 +3075,7 @@ func (o *physicsServer) JointCreateGeneric6Dof(bodyA gdnative.Rid, localRefA gdn
 	ptrArguments[3] = gdnative.NewPointerFromTransform(localRefB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_create_generic_6dof")
+	methodBind := methodBindPhysicsServerJointCreateGeneric6Dof.Get()
 
 	// Call the parent method.
 	// RID
@@ -2252,8 +3088,8 @@ func (o *physicsServer) JointCreateGeneric6Dof(bodyA gdnative.Rid, localRefA gdn
 }
 
 /*
-        Creates a [HingeJoint].
-	Args: [{ false body_A RID} { false hinge_A Transform} { false body_B RID} { false hinge_B Transform}], Returns: RID
+	        Creates a [HingeJoint].
+		Args: [{ false body_A RID} { false hinge_A Transform} { false body_B RID} { false hinge_B Transform}], Returns: RID
 */
 func (o *physicsServer) JointCreateHinge(bodyA gdnative.Rid, hingeA gdnative.Transform, bodyB gdnative.Rid, hingeB gdnative.Transform) gdnative.Rid {
 	o.ensureSingleton()
@@ -2267,7 +3103,7 @@ func (o *physicsServer) JointCreateHinge(bodyA gdnative.Rid, hingeA gdnative.Tra
 	ptrArguments[3] = gdnative.NewPointerFromTransform(hingeB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_create_hinge")
+	methodBind := methodBindPhysicsServerJointCreateHinge.Get()
 
 	// Call the parent method.
 	// RID
@@ -2280,8 +3116,8 @@ func (o *physicsServer) JointCreateHinge(bodyA gdnative.Rid, hingeA gdnative.Tra
 }
 
 /*
-        Creates a [PinJoint].
-	Args: [{ false body_A RID} { false local_A Vector3} { false body_B RID} { false local_B Vector3}], Returns: RID
+	        Creates a [PinJoint].
+		Args: [{ false body_A RID} { false local_A Vector3} { false body_B RID} { false local_B Vector3}], Returns: RID
 */
 func (o *physicsServer) JointCreatePin(bodyA gdnative.Rid, localA gdnative.Vector3, bodyB gdnative.Rid, localB gdnative.Vector3) gdnative.Rid {
 	o.ensureSingleton()
@@ -2295,7 +3131,7 @@ func (o *physicsServer) JointCreatePin(bodyA gdnative.Rid, localA gdnative.Vecto
 	ptrArguments[3] = gdnative.NewPointerFromVector3(localB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_create_pin")
+	methodBind := methodBindPhysicsServerJointCreatePin.Get()
 
 	// Call the parent method.
 	// RID
@@ -2308,8 +3144,8 @@ func (o *physicsServer) JointCreatePin(bodyA gdnative.Rid, localA gdnative.Vecto
 }
 
 /*
-        Creates a [SliderJoint].
-	Args: [{ false body_A RID} { false local_ref_A Transform} { false body_B RID} { false local_ref_B Transform}], Returns: RID
+	        Creates a [SliderJoint].
+		Args: [{ false body_A RID} { false local_ref_A Transform} { false body_B RID} { false local_ref_B Transform}], Returns: RID
 */
 func (o *physicsServer) JointCreateSlider(bodyA gdnative.Rid, localRefA gdnative.Transform, bodyB gdnative.Rid, localRefB gdnative.Transform) gdnative.Rid {
 	o.ensureSingleton()
@@ -2323,7 +3159,7 @@ func (o *physicsServer) JointCreateSlider(bodyA gdnative.Rid, localRefA gdnative
 	ptrArguments[3] = gdnative.NewPointerFromTransform(localRefB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_create_slider")
+	methodBind := methodBindPhysicsServerJointCreateSlider.Get()
 
 	// Call the parent method.
 	// RID
@@ -2336,8 +3172,8 @@ func (o *physicsServer) JointCreateSlider(bodyA gdnative.Rid, localRefA gdnative
 }
 
 /*
-        Gets the priority value of the Joint.
-	Args: [{ false joint RID}], Returns: int
+	        Gets the priority value of the Joint.
+		Args: [{ false joint RID}], Returns: int
 */
 func (o *physicsServer) JointGetSolverPriority(joint gdnative.Rid) gdnative.Int {
 	o.ensureSingleton()
@@ -2348,7 +3184,7 @@ func (o *physicsServer) JointGetSolverPriority(joint gdnative.Rid) gdnative.Int
 	ptrArguments[0] = gdnative.NewPointerFromRid(joint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_get_solver_priority")
+	methodBind := methodBindPhysicsServerJointGetSolverPriority.Get()
 
 	// Call the parent method.
 	// int
@@ -2361,8 +3197,8 @@ func (o *physicsServer) JointGetSolverPriority(joint gdnative.Rid) gdnative.Int
 }
 
 /*
-        Returns the type of the Joint.
-	Args: [{ false joint RID}], Returns: enum.PhysicsServer::JointType
+	        Returns the type of the Joint.
+		Args: [{ false joint RID}], Returns: enum.PhysicsServer::JointType
 */
 func (o *physicsServer) JointGetType(joint gdnative.Rid) PhysicsServerJointType {
 	o.ensureSingleton()
@@ -2373,7 +3209,7 @@ func (o *physicsServer) JointGetType(joint gdnative.Rid) PhysicsServerJointType
 	ptrArguments[0] = gdnative.NewPointerFromRid(joint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_get_type")
+	methodBind := methodBindPhysicsServerJointGetType.Get()
 
 	// Call the parent method.
 	// enum.PhysicsServer::JointType
@@ -2386,8 +3222,8 @@ func (o *physicsServer) JointGetType(joint gdnative.Rid) PhysicsServerJointType
 }
 
 /*
-        Sets the priority value of the Joint.
-	Args: [{ false joint RID} { false priority int}], Returns: void
+	        Sets the priority value of the Joint.
+		Args: [{ false joint RID} { false priority int}], Returns: void
 */
 func (o *physicsServer) JointSetSolverPriority(joint gdnative.Rid, priority gdnative.Int) {
 	o.ensureSingleton()
@@ -2399,7 +3235,7 @@ func (o *physicsServer) JointSetSolverPriority(joint gdnative.Rid, priority gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(priority)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "joint_set_solver_priority")
+	methodBind := methodBindPhysicsServerJointSetSolverPriority.Get()
 
 	// Call the parent method.
 	// void
@@ -2409,8 +3245,8 @@ func (o *physicsServer) JointSetSolverPriority(joint gdnative.Rid, priority gdna
 }
 
 /*
-        Returns position of the joint in the local space of body a of the joint.
-	Args: [{ false joint RID}], Returns: Vector3
+	        Returns position of the joint in the local space of body a of the joint.
+		Args: [{ false joint RID}], Returns: Vector3
 */
 func (o *physicsServer) PinJointGetLocalA(joint gdnative.Rid) gdnative.Vector3 {
 	o.ensureSingleton()
@@ -2421,7 +3257,7 @@ func (o *physicsServer) PinJointGetLocalA(joint gdnative.Rid) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromRid(joint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "pin_joint_get_local_a")
+	methodBind := methodBindPhysicsServerPinJointGetLocalA.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -2434,8 +3270,8 @@ func (o *physicsServer) PinJointGetLocalA(joint gdnative.Rid) gdnative.Vector3 {
 }
 
 /*
-        Returns position of the joint in the local space of body b of the joint.
-	Args: [{ false joint RID}], Returns: Vector3
+	        Returns position of the joint in the local space of body b of the joint.
+		Args: [{ false joint RID}], Returns: Vector3
 */
 func (o *physicsServer) PinJointGetLocalB(joint gdnative.Rid) gdnative.Vector3 {
 	o.ensureSingleton()
@@ -2446,7 +3282,7 @@ func (o *physicsServer) PinJointGetLocalB(joint gdnative.Rid) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromRid(joint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "pin_joint_get_local_b")
+	methodBind := methodBindPhysicsServerPinJointGetLocalB.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -2459,8 +3295,8 @@ func (o *physicsServer) PinJointGetLocalB(joint gdnative.Rid) gdnative.Vector3 {
 }
 
 /*
-        Gets a pin_joint parameter (see PIN_JOINT* constants).
-	Args: [{ false joint RID} { false param int}], Returns: float
+	        Gets a pin_joint parameter (see PIN_JOINT* constants).
+		Args: [{ false joint RID} { false param int}], Returns: float
 */
 func (o *physicsServer) PinJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -2472,7 +3308,7 @@ func (o *physicsServer) PinJointGetParam(joint gdnative.Rid, param gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "pin_joint_get_param")
+	methodBind := methodBindPhysicsServerPinJointGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -2485,8 +3321,8 @@ func (o *physicsServer) PinJointGetParam(joint gdnative.Rid, param gdnative.Int)
 }
 
 /*
-        Sets position of the joint in the local space of body a of the joint.
-	Args: [{ false joint RID} { false local_A Vector3}], Returns: void
+	        Sets position of the joint in the local space of body a of the joint.
+		Args: [{ false joint RID} { false local_A Vector3}], Returns: void
 */
 func (o *physicsServer) PinJointSetLocalA(joint gdnative.Rid, localA gdnative.Vector3) {
 	o.ensureSingleton()
@@ -2498,7 +3334,7 @@ func (o *physicsServer) PinJointSetLocalA(joint gdnative.Rid, localA gdnative.Ve
 	ptrArguments[1] = gdnative.NewPointerFromVector3(localA)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "pin_joint_set_local_a")
+	methodBind := methodBindPhysicsServerPinJointSetLocalA.Get()
 
 	// Call the parent method.
 	// void
@@ -2508,8 +3344,8 @@ func (o *physicsServer) PinJointSetLocalA(joint gdnative.Rid, localA gdnative.Ve
 }
 
 /*
-        Sets position of the joint in the local space of body b of the joint.
-	Args: [{ false joint RID} { false local_B Vector3}], Returns: void
+	        Sets position of the joint in the local space of body b of the joint.
+		Args: [{ false joint RID} { false local_B Vector3}], Returns: void
 */
 func (o *physicsServer) PinJointSetLocalB(joint gdnative.Rid, localB gdnative.Vector3) {
 	o.ensureSingleton()
@@ -2521,7 +3357,7 @@ func (o *physicsServer) PinJointSetLocalB(joint gdnative.Rid, localB gdnative.Ve
 	ptrArguments[1] = gdnative.NewPointerFromVector3(localB)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "pin_joint_set_local_b")
+	methodBind := methodBindPhysicsServerPinJointSetLocalB.Get()
 
 	// Call the parent method.
 	// void
@@ -2531,8 +3367,8 @@ func (o *physicsServer) PinJointSetLocalB(joint gdnative.Rid, localB gdnative.Ve
 }
 
 /*
-        Sets a pin_joint parameter (see PIN_JOINT* constants).
-	Args: [{ false joint RID} { false param int} { false value float}], Returns: void
+	        Sets a pin_joint parameter (see PIN_JOINT* constants).
+		Args: [{ false joint RID} { false param int} { false value float}], Returns: void
 */
 func (o *physicsServer) PinJointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -2545,7 +3381,7 @@ func (o *physicsServer) PinJointSetParam(joint gdnative.Rid, param gdnative.Int,
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "pin_joint_set_param")
+	methodBind := methodBindPhysicsServerPinJointSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -2555,8 +3391,8 @@ func (o *physicsServer) PinJointSetParam(joint gdnative.Rid, param gdnative.Int,
 }
 
 /*
-        Activates or deactivates the 3D physics engine.
-	Args: [{ false active bool}], Returns: void
+	        Activates or deactivates the 3D physics engine.
+		Args: [{ false active bool}], Returns: void
 */
 func (o *physicsServer) SetActive(active gdnative.Bool) {
 	o.ensureSingleton()
@@ -2567,7 +3403,7 @@ func (o *physicsServer) SetActive(active gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(active)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "set_active")
+	methodBind := methodBindPhysicsServerSetActive.Get()
 
 	// Call the parent method.
 	// void
@@ -2577,8 +3413,8 @@ func (o *physicsServer) SetActive(active gdnative.Bool) {
 }
 
 /*
-        Creates a shape of type SHAPE_*. Does not assign it to a body or an area. To do so, you must use [method area_set_shape] or [method body_set_shape].
-	Args: [{ false type int}], Returns: RID
+	        Creates a shape of type SHAPE_*. Does not assign it to a body or an area. To do so, you must use [method area_set_shape] or [method body_set_shape].
+		Args: [{ false type int}], Returns: RID
 */
 func (o *physicsServer) ShapeCreate(aType gdnative.Int) gdnative.Rid {
 	o.ensureSingleton()
@@ -2589,7 +3425,7 @@ func (o *physicsServer) ShapeCreate(aType gdnative.Int) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "shape_create")
+	methodBind := methodBindPhysicsServerShapeCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2602,8 +3438,8 @@ func (o *physicsServer) ShapeCreate(aType gdnative.Int) gdnative.Rid {
 }
 
 /*
-        Returns the shape data.
-	Args: [{ false shape RID}], Returns: Variant
+	        Returns the shape data.
+		Args: [{ false shape RID}], Returns: Variant
 */
 func (o *physicsServer) ShapeGetData(shape gdnative.Rid) gdnative.Variant {
 	o.ensureSingleton()
@@ -2614,7 +3450,7 @@ func (o *physicsServer) ShapeGetData(shape gdnative.Rid) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "shape_get_data")
+	methodBind := methodBindPhysicsServerShapeGetData.Get()
 
 	// Call the parent method.
 	// Variant
@@ -2627,8 +3463,8 @@ func (o *physicsServer) ShapeGetData(shape gdnative.Rid) gdnative.Variant {
 }
 
 /*
-        Returns the type of shape (see SHAPE_* constants).
-	Args: [{ false shape RID}], Returns: enum.PhysicsServer::ShapeType
+	        Returns the type of shape (see SHAPE_* constants).
+		Args: [{ false shape RID}], Returns: enum.PhysicsServer::ShapeType
 */
 func (o *physicsServer) ShapeGetType(shape gdnative.Rid) PhysicsServerShapeType {
 	o.ensureSingleton()
@@ -2639,7 +3475,7 @@ func (o *physicsServer) ShapeGetType(shape gdnative.Rid) PhysicsServerShapeType
 	ptrArguments[0] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "shape_get_type")
+	methodBind := methodBindPhysicsServerShapeGetType.Get()
 
 	// Call the parent method.
 	// enum.PhysicsServer::ShapeType
@@ -2652,8 +3488,8 @@ func (o *physicsServer) ShapeGetType(shape gdnative.Rid) PhysicsServerShapeType
 }
 
 /*
-        Sets the shape data that defines its shape and size. The data to be passed depends on the kind of shape created [method shape_get_type].
-	Args: [{ false shape RID} { false data Variant}], Returns: void
+	        Sets the shape data that defines its shape and size. The data to be passed depends on the kind of shape created [method shape_get_type].
+		Args: [{ false shape RID} { false data Variant}], Returns: void
 */
 func (o *physicsServer) ShapeSetData(shape gdnative.Rid, data gdnative.Variant) {
 	o.ensureSingleton()
@@ -2665,7 +3501,7 @@ func (o *physicsServer) ShapeSetData(shape gdnative.Rid, data gdnative.Variant)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "shape_set_data")
+	methodBind := methodBindPhysicsServerShapeSetData.Get()
 
 	// Call the parent method.
 	// void
@@ -2675,8 +3511,8 @@ func (o *physicsServer) ShapeSetData(shape gdnative.Rid, data gdnative.Variant)
 }
 
 /*
-        Gets a slider_joint parameter (see SLIDER_JOINT* constants).
-	Args: [{ false joint RID} { false param int}], Returns: float
+	        Gets a slider_joint parameter (see SLIDER_JOINT* constants).
+		Args: [{ false joint RID} { false param int}], Returns: float
 */
 func (o *physicsServer) SliderJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -2688,7 +3524,7 @@ func (o *physicsServer) SliderJointGetParam(joint gdnative.Rid, param gdnative.I
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "slider_joint_get_param")
+	methodBind := methodBindPhysicsServerSliderJointGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -2701,8 +3537,8 @@ func (o *physicsServer) SliderJointGetParam(joint gdnative.Rid, param gdnative.I
 }
 
 /*
-        Gets a slider_joint parameter (see SLIDER_JOINT* constants).
-	Args: [{ false joint RID} { false param int} { false value float}], Returns: void
+	        Gets a slider_joint parameter (see SLIDER_JOINT* constants).
+		Args: [{ false joint RID} { false param int} { false value float}], Returns: void
 */
 func (o *physicsServer) SliderJointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -2715,7 +3551,7 @@ func (o *physicsServer) SliderJointSetParam(joint gdnative.Rid, param gdnative.I
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "slider_joint_set_param")
+	methodBind := methodBindPhysicsServerSliderJointSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -2725,8 +3561,8 @@ func (o *physicsServer) SliderJointSetParam(joint gdnative.Rid, param gdnative.I
 }
 
 /*
-        Creates a space. A space is a collection of parameters for the physics engine that can be assigned to an area or a body. It can be assigned to an area with [method area_set_space], or to a body with [method body_set_space].
-	Args: [], Returns: RID
+	        Creates a space. A space is a collection of parameters for the physics engine that can be assigned to an area or a body. It can be assigned to an area with [method area_set_space], or to a body with [method body_set_space].
+		Args: [], Returns: RID
 */
 func (o *physicsServer) SpaceCreate() gdnative.Rid {
 	o.ensureSingleton()
@@ -2736,7 +3572,7 @@ func (o *physicsServer) SpaceCreate() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "space_create")
+	methodBind := methodBindPhysicsServerSpaceCreate.Get()
 
 	// Call the parent method.
 	// RID
@@ -2749,10 +3585,10 @@ func (o *physicsServer) SpaceCreate() gdnative.Rid {
 }
 
 /*
-        Returns the state of a space, a [PhysicsDirectSpaceState]. This object can be used to make collision/intersection queries.
-	Args: [{ false space RID}], Returns: PhysicsDirectSpaceState
+	        Returns the state of a space, a [PhysicsDirectSpaceState]. This object can be used to make collision/intersection queries.
+		Args: [{ false space RID}], Returns: PhysicsDirectSpaceState
 */
-func (o *physicsServer) SpaceGetDirectState(space gdnative.Rid) PhysicsDirectSpaceStateImplementer {
+func (o *physicsServer) SpaceGetDirectState(space gdnative.Rid) godot.PhysicsDirectSpaceStateImplementer {
 	o.ensureSingleton()
 	//log.Println("Calling PhysicsServer.SpaceGetDirectState()")
 
@@ -2761,7 +3597,7 @@ func (o *physicsServer) SpaceGetDirectState(space gdnative.Rid) PhysicsDirectSpa
 	ptrArguments[0] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "space_get_direct_state")
+	methodBind := methodBindPhysicsServerSpaceGetDirectState.Get()
 
 	// Call the parent method.
 	// PhysicsDirectSpaceState
@@ -2769,27 +3605,25 @@ func (o *physicsServer) SpaceGetDirectState(space gdnative.Rid) PhysicsDirectSpa
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysicsDirectSpaceStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PhysicsDirectSpaceStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PhysicsDirectSpaceState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PhysicsDirectSpaceStateImplementer)
+	ret := godot.PhysicsDirectSpaceState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.PhysicsDirectSpaceStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the value of a space parameter.
-	Args: [{ false space RID} { false param int}], Returns: float
+	        Returns the value of a space parameter.
+		Args: [{ false space RID} { false param int}], Returns: float
 */
 func (o *physicsServer) SpaceGetParam(space gdnative.Rid, param gdnative.Int) gdnative.Real {
 	o.ensureSingleton()
@@ -2801,7 +3635,7 @@ func (o *physicsServer) SpaceGetParam(space gdnative.Rid, param gdnative.Int) gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "space_get_param")
+	methodBind := methodBindPhysicsServerSpaceGetParam.Get()
 
 	// Call the parent method.
 	// float
@@ -2814,8 +3648,8 @@ func (o *physicsServer) SpaceGetParam(space gdnative.Rid, param gdnative.Int) gd
 }
 
 /*
-        Returns whether the space is active.
-	Args: [{ false space RID}], Returns: bool
+	        Returns whether the space is active.
+		Args: [{ false space RID}], Returns: bool
 */
 func (o *physicsServer) SpaceIsActive(space gdnative.Rid) gdnative.Bool {
 	o.ensureSingleton()
@@ -2826,7 +3660,7 @@ func (o *physicsServer) SpaceIsActive(space gdnative.Rid) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromRid(space)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "space_is_active")
+	methodBind := methodBindPhysicsServerSpaceIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -2839,8 +3673,8 @@ func (o *physicsServer) SpaceIsActive(space gdnative.Rid) gdnative.Bool {
 }
 
 /*
-        Marks a space as active. It will not have an effect, unless it is assigned to an area or body.
-	Args: [{ false space RID} { false active bool}], Returns: void
+	        Marks a space as active. It will not have an effect, unless it is assigned to an area or body.
+		Args: [{ false space RID} { false active bool}], Returns: void
 */
 func (o *physicsServer) SpaceSetActive(space gdnative.Rid, active gdnative.Bool) {
 	o.ensureSingleton()
@@ -2852,7 +3686,7 @@ func (o *physicsServer) SpaceSetActive(space gdnative.Rid, active gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(active)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "space_set_active")
+	methodBind := methodBindPhysicsServerSpaceSetActive.Get()
 
 	// Call the parent method.
 	// void
@@ -2862,8 +3696,8 @@ func (o *physicsServer) SpaceSetActive(space gdnative.Rid, active gdnative.Bool)
 }
 
 /*
-        Sets the value for a space parameter. A list of available parameters is on the SPACE_PARAM_* constants.
-	Args: [{ false space RID} { false param int} { false value float}], Returns: void
+	        Sets the value for a space parameter. A list of available parameters is on the SPACE_PARAM_* constants.
+		Args: [{ false space RID} { false param int} { false value float}], Returns: void
 */
 func (o *physicsServer) SpaceSetParam(space gdnative.Rid, param gdnative.Int, value gdnative.Real) {
 	o.ensureSingleton()
@@ -2876,7 +3710,7 @@ func (o *physicsServer) SpaceSetParam(space gdnative.Rid, param gdnative.Int, va
 	ptrArguments[2] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsServer", "space_set_param")
+	methodBind := methodBindPhysicsServerSpaceSetParam.Get()
 
 	// Call the parent method.
 	// void
@@ -2885,10 +3719,32 @@ func (o *physicsServer) SpaceSetParam(space gdnative.Rid, param gdnative.Int, va
 
 }
 
+// AreaAddShapeWithDefaults will call AreaAddShape using the default values for: transform.
+func (o *physicsServer) AreaAddShapeWithDefaults(area gdnative.Rid, shape gdnative.Rid) {
+	o.AreaAddShape(area, shape, gdnative.NewTransform(gdnative.NewBasisWithRows(gdnative.NewVector3(1, 0, 0), gdnative.NewVector3(0, 1, 0), gdnative.NewVector3(0, 0, 1)), gdnative.NewVector3(0, 0, 0)))
+}
+
+// BodyAddShapeWithDefaults will call BodyAddShape using the default values for: transform.
+func (o *physicsServer) BodyAddShapeWithDefaults(body gdnative.Rid, shape gdnative.Rid) {
+	o.BodyAddShape(body, shape, gdnative.NewTransform(gdnative.NewBasisWithRows(gdnative.NewVector3(1, 0, 0), gdnative.NewVector3(0, 1, 0), gdnative.NewVector3(0, 0, 1)), gdnative.NewVector3(0, 0, 0)))
+}
+
+// BodyCreateWithDefaults will call BodyCreate using the default values for: mode, init_sleeping.
+func (o *physicsServer) BodyCreateWithDefaults() gdnative.Rid {
+	return o.BodyCreate(2, false)
+}
+
+// BodySetForceIntegrationCallbackWithDefaults will call BodySetForceIntegrationCallback using the default values for: userdata.
+func (o *physicsServer) BodySetForceIntegrationCallbackWithDefaults(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String) {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	o.BodySetForceIntegrationCallback(body, receiver, method, default0)
+}
+
 // PhysicsServerImplementer is an interface that implements the methods
 // of the PhysicsServer class.
 type PhysicsServerImplementer interface {
-	ObjectImplementer
+	godot.ObjectImplementer
 	AreaAddShape(area gdnative.Rid, shape gdnative.Rid, transform gdnative.Transform)
 	AreaAttachObjectInstanceId(area gdnative.Rid, id gdnative.Int)
 	AreaClearShapes(area gdnative.Rid)
@@ -2899,12 +3755,13 @@ type PhysicsServerImplementer interface {
 	AreaGetShapeCount(area gdnative.Rid) gdnative.Int
 	AreaGetShapeTransform(area gdnative.Rid, shapeIdx gdnative.Int) gdnative.Transform
 	AreaGetSpace(area gdnative.Rid) gdnative.Rid
+	AreaGetSpaceOverrideMode(area gdnative.Rid) PhysicsServerAreaSpaceOverrideMode
 	AreaGetTransform(area gdnative.Rid) gdnative.Transform
 	AreaIsRayPickable(area gdnative.Rid) gdnative.Bool
 	AreaRemoveShape(area gdnative.Rid, shapeIdx gdnative.Int)
 	AreaSetCollisionLayer(area gdnative.Rid, layer gdnative.Int)
 	AreaSetCollisionMask(area gdnative.Rid, mask gdnative.Int)
-	AreaSetMonitorCallback(area gdnative.Rid, receiver ObjectImplementer, method gdnative.String)
+	AreaSetMonitorCallback(area gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String)
 	AreaSetParam(area gdnative.Rid, param gdnative.Int, value gdnative.Variant)
 	AreaSetRayPickable(area gdnative.Rid, enable gdnative.Bool)
 	AreaSetShape(area gdnative.Rid, shapeIdx gdnative.Int, shape gdnative.Rid)
@@ -2924,6 +3781,7 @@ type PhysicsServerImplementer interface {
 	BodyGetDirectState(body gdnative.Rid) PhysicsDirectBodyStateImplementer
 	BodyGetKinematicSafeMargin(body gdnative.Rid) gdnative.Real
 	BodyGetMaxContactsReported(body gdnative.Rid) gdnative.Int
+	BodyGetMode(body gdnative.Rid) PhysicsServerBodyMode
 	BodyGetObjectInstanceId(body gdnative.Rid) gdnative.Int
 	BodyGetParam(body gdnative.Rid, param gdnative.Int) gdnative.Real
 	BodyGetShape(body gdnative.Rid, shapeIdx gdnative.Int) gdnative.Rid
@@ -2942,7 +3800,7 @@ type PhysicsServerImplementer interface {
 	BodySetCollisionLayer(body gdnative.Rid, layer gdnative.Int)
 	BodySetCollisionMask(body gdnative.Rid, mask gdnative.Int)
 	BodySetEnableContinuousCollisionDetection(body gdnative.Rid, enable gdnative.Bool)
-	BodySetForceIntegrationCallback(body gdnative.Rid, receiver ObjectImplementer, method gdnative.String, userdata gdnative.Variant)
+	BodySetForceIntegrationCallback(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant)
 	BodySetKinematicSafeMargin(body gdnative.Rid, margin gdnative.Real)
 	BodySetMaxContactsReported(body gdnative.Rid, amount gdnative.Int)
 	BodySetMode(body gdnative.Rid, mode gdnative.Int)
@@ -2960,7 +3818,7 @@ type PhysicsServerImplementer interface {
 	Generic6DofJointGetParam(joint gdnative.Rid, axis gdnative.Int, param gdnative.Int) gdnative.Real
 	Generic6DofJointSetFlag(joint gdnative.Rid, axis gdnative.Int, flag gdnative.Int, enable gdnative.Bool)
 	Generic6DofJointSetParam(joint gdnative.Rid, axis gdnative.Int, param gdnative.Int, value gdnative.Real)
-	GetProcessInfo(processInfo gdnative.Int) gdnative.Int
+	GetProcessInfo(processInfo PhysicsServerProcessInfo) gdnative.Int
 	HingeJointGetFlag(joint gdnative.Rid, flag gdnative.Int) gdnative.Bool
 	HingeJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real
 	HingeJointSetFlag(joint gdnative.Rid, flag gdnative.Int, enabled gdnative.Bool)
@@ -2971,6 +3829,7 @@ type PhysicsServerImplementer interface {
 	JointCreatePin(bodyA gdnative.Rid, localA gdnative.Vector3, bodyB gdnative.Rid, localB gdnative.Vector3) gdnative.Rid
 	JointCreateSlider(bodyA gdnative.Rid, localRefA gdnative.Transform, bodyB gdnative.Rid, localRefB gdnative.Transform) gdnative.Rid
 	JointGetSolverPriority(joint gdnative.Rid) gdnative.Int
+	JointGetType(joint gdnative.Rid) PhysicsServerJointType
 	JointSetSolverPriority(joint gdnative.Rid, priority gdnative.Int)
 	PinJointGetLocalA(joint gdnative.Rid) gdnative.Vector3
 	PinJointGetLocalB(joint gdnative.Rid) gdnative.Vector3
@@ -2981,13 +3840,18 @@ type PhysicsServerImplementer interface {
 	SetActive(active gdnative.Bool)
 	ShapeCreate(aType gdnative.Int) gdnative.Rid
 	ShapeGetData(shape gdnative.Rid) gdnative.Variant
+	ShapeGetType(shape gdnative.Rid) PhysicsServerShapeType
 	ShapeSetData(shape gdnative.Rid, data gdnative.Variant)
 	SliderJointGetParam(joint gdnative.Rid, param gdnative.Int) gdnative.Real
 	SliderJointSetParam(joint gdnative.Rid, param gdnative.Int, value gdnative.Real)
 	SpaceCreate() gdnative.Rid
-	SpaceGetDirectState(space gdnative.Rid) PhysicsDirectSpaceStateImplementer
+	SpaceGetDirectState(space gdnative.Rid) godot.PhysicsDirectSpaceStateImplementer
 	SpaceGetParam(space gdnative.Rid, param gdnative.Int) gdnative.Real
 	SpaceIsActive(space gdnative.Rid) gdnative.Bool
 	SpaceSetActive(space gdnative.Rid, active gdnative.Bool)
 	SpaceSetParam(space gdnative.Rid, param gdnative.Int, value gdnative.Real)
+	AreaAddShapeWithDefaults(area gdnative.Rid, shape gdnative.Rid)
+	BodyAddShapeWithDefaults(body gdnative.Rid, shape gdnative.Rid)
+	BodyCreateWithDefaults() gdnative.Rid
+	BodySetForceIntegrationCallbackWithDefaults(body gdnative.Rid, receiver godot.ObjectImplementer, method gdnative.String)
 }
@@ -1,7 +1,8 @@
-package godot
+package physics
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysicsShapeQueryResultFromPointer(ptr gdnative.Pointer) PhysicsShapeQueryResult {
-func newPhysicsShapeQueryResultFromPointer(ptr gdnative.Pointer) PhysicsShapeQueryResult {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PhysicsShapeQueryResult{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PhysicsShapeQueryResult struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
@@ -34,9 +25,18 @@ func (o *PhysicsShapeQueryResult) BaseClass() string {
 	return "PhysicsShapeQueryResult"
 }
 
-/*
+// Method binds of the PhysicsShapeQueryResult class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysicsShapeQueryResultGetResultCount       = gdnative.NewLazyMethodBind("PhysicsShapeQueryResult", "get_result_count")
+	methodBindPhysicsShapeQueryResultGetResultObject      = gdnative.NewLazyMethodBind("PhysicsShapeQueryResult", "get_result_object")
+	methodBindPhysicsShapeQueryResultGetResultObjectId    = gdnative.NewLazyMethodBind("PhysicsShapeQueryResult", "get_result_object_id")
+	methodBindPhysicsShapeQueryResultGetResultObjectShape = gdnative.NewLazyMethodBind("PhysicsShapeQueryResult", "get_result_object_shape")
+	methodBindPhysicsShapeQueryResultGetResultRid         = gdnative.NewLazyMethodBind("PhysicsShapeQueryResult", "get_result_rid")
+)
 
-	Args: [], Returns: int
+/*
+Args: [], Returns: int
 */
 func (o *PhysicsShapeQueryResult) GetResultCount() gdnative.Int {
 	//log.Println("Calling PhysicsShapeQueryResult.GetResultCount()")
@@ -45,7 +45,7 @@ func (o *PhysicsShapeQueryResult) GetResultCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryResult", "get_result_count")
+	methodBind := methodBindPhysicsShapeQueryResultGetResultCount.Get()
 
 	// Call the parent method.
 	// int
@@ -58,10 +58,9 @@ func (o *PhysicsShapeQueryResult) GetResultCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Object
+Args: [{ false idx int}], Returns: Object
 */
-func (o *PhysicsShapeQueryResult) GetResultObject(idx gdnative.Int) ObjectImplementer {
+func (o *PhysicsShapeQueryResult) GetResultObject(idx gdnative.Int) godot.ObjectImplementer {
 	//log.Println("Calling PhysicsShapeQueryResult.GetResultObject()")
 
 	// Build out the method's arguments
@@ -69,7 +68,7 @@ func (o *PhysicsShapeQueryResult) GetResultObject(idx gdnative.Int) ObjectImplem
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryResult", "get_result_object")
+	methodBind := methodBindPhysicsShapeQueryResultGetResultObject.Get()
 
 	// Call the parent method.
 	// Object
@@ -77,27 +76,24 @@ func (o *PhysicsShapeQueryResult) GetResultObject(idx gdnative.Int) ObjectImplem
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: int
+Args: [{ false idx int}], Returns: int
 */
 func (o *PhysicsShapeQueryResult) GetResultObjectId(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling PhysicsShapeQueryResult.GetResultObjectId()")
@@ -107,7 +103,7 @@ func (o *PhysicsShapeQueryResult) GetResultObjectId(idx gdnative.Int) gdnative.I
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryResult", "get_result_object_id")
+	methodBind := methodBindPhysicsShapeQueryResultGetResultObjectId.Get()
 
 	// Call the parent method.
 	// int
@@ -120,8 +116,7 @@ func (o *PhysicsShapeQueryResult) GetResultObjectId(idx gdnative.Int) gdnative.I
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: int
+Args: [{ false idx int}], Returns: int
 */
 func (o *PhysicsShapeQueryResult) GetResultObjectShape(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling PhysicsShapeQueryResult.GetResultObjectShape()")
@@ -131,7 +126,7 @@ func (o *PhysicsShapeQueryResult) GetResultObjectShape(idx gdnative.Int) gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryResult", "get_result_object_shape")
+	methodBind := methodBindPhysicsShapeQueryResultGetResultObjectShape.Get()
 
 	// Call the parent method.
 	// int
@@ -144,8 +139,7 @@ func (o *PhysicsShapeQueryResult) GetResultObjectShape(idx gdnative.Int) gdnativ
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: RID
+Args: [{ false idx int}], Returns: RID
 */
 func (o *PhysicsShapeQueryResult) GetResultRid(idx gdnative.Int) gdnative.Rid {
 	//log.Println("Calling PhysicsShapeQueryResult.GetResultRid()")
@@ -155,7 +149,7 @@ func (o *PhysicsShapeQueryResult) GetResultRid(idx gdnative.Int) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryResult", "get_result_rid")
+	methodBind := methodBindPhysicsShapeQueryResultGetResultRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -170,9 +164,9 @@ func (o *PhysicsShapeQueryResult) GetResultRid(idx gdnative.Int) gdnative.Rid {
 // PhysicsShapeQueryResultImplementer is an interface that implements the methods
 // of the PhysicsShapeQueryResult class.
 type PhysicsShapeQueryResultImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetResultCount() gdnative.Int
-	GetResultObject(idx gdnative.Int) ObjectImplementer
+	GetResultObject(idx gdnative.Int) godot.ObjectImplementer
 	GetResultObjectId(idx gdnative.Int) gdnative.Int
 	GetResultObjectShape(idx gdnative.Int) gdnative.Int
 	GetResultRid(idx gdnative.Int) gdnative.Rid
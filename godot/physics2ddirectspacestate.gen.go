@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysics2DDirectSpaceStateFromPointer(ptr gdnative.Pointer) Physics2DDirectSpaceState {
-func newPhysics2DDirectSpaceStateFromPointer(ptr gdnative.Pointer) Physics2DDirectSpaceState {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Physics2DDirectSpaceState{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Direct access object to a space in the [Physics2DServer]. It's used mainly to do queries against objects and areas residing in a given space.
 */
@@ -34,19 +25,30 @@ func (o *Physics2DDirectSpaceState) BaseClass() string {
 	return "Physics2DDirectSpaceState"
 }
 
+// Method binds of the Physics2DDirectSpaceState class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysics2DDirectSpaceStateCastMotion     = gdnative.NewLazyMethodBind("Physics2DDirectSpaceState", "cast_motion")
+	methodBindPhysics2DDirectSpaceStateCollideShape   = gdnative.NewLazyMethodBind("Physics2DDirectSpaceState", "collide_shape")
+	methodBindPhysics2DDirectSpaceStateGetRestInfo    = gdnative.NewLazyMethodBind("Physics2DDirectSpaceState", "get_rest_info")
+	methodBindPhysics2DDirectSpaceStateIntersectPoint = gdnative.NewLazyMethodBind("Physics2DDirectSpaceState", "intersect_point")
+	methodBindPhysics2DDirectSpaceStateIntersectRay   = gdnative.NewLazyMethodBind("Physics2DDirectSpaceState", "intersect_ray")
+	methodBindPhysics2DDirectSpaceStateIntersectShape = gdnative.NewLazyMethodBind("Physics2DDirectSpaceState", "intersect_shape")
+)
+
 /*
-        Checks how far the shape can travel toward a point. Note that both the shape and the motion are supplied through a [Physics2DShapeQueryParameters] object. The method will return an array with two floats between 0 and 1, both representing a fraction of [code]motion[/code]. The first is how far the shape can move without triggering a collision, and the second is the point at which a collision will occur. If no collision is detected, the returned array will be [1, 1]. If the shape can not move, the array will be empty ([code]dir.empty()==true[/code]).
-	Args: [{ false shape Physics2DShapeQueryParameters}], Returns: Array
+	        Checks how far the shape can travel toward a point. Note that both the shape and the motion are supplied through a [Physics2DShapeQueryParameters] object. The method will return an array with two floats between 0 and 1, both representing a fraction of [code]motion[/code]. The first is how far the shape can move without triggering a collision, and the second is the point at which a collision will occur. If no collision is detected, the returned array will be [1, 1]. If the shape can not move, the array will be empty ([code]dir.empty()==true[/code]).
+		Args: [{ false shape Physics2DShapeQueryParameters}], Returns: Array
 */
 func (o *Physics2DDirectSpaceState) CastMotion(shape Physics2DShapeQueryParametersImplementer) gdnative.Array {
 	//log.Println("Calling Physics2DDirectSpaceState.CastMotion()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectSpaceState", "cast_motion")
+	methodBind := methodBindPhysics2DDirectSpaceStateCastMotion.Get()
 
 	// Call the parent method.
 	// Array
@@ -59,19 +61,19 @@ func (o *Physics2DDirectSpaceState) CastMotion(shape Physics2DShapeQueryParamete
 }
 
 /*
-        Checks the intersections of a shape, given through a [Physics2DShapeQueryParameters] object, against the space. The resulting array contains a list of points where the shape intersects another. Like with [method intersect_shape], the number of returned results can be limited to save processing time.
-	Args: [{ false shape Physics2DShapeQueryParameters} {32 true max_results int}], Returns: Array
+	        Checks the intersections of a shape, given through a [Physics2DShapeQueryParameters] object, against the space. The resulting array contains a list of points where the shape intersects another. Like with [method intersect_shape], the number of returned results can be limited to save processing time.
+		Args: [{ false shape Physics2DShapeQueryParameters} {32 true max_results int}], Returns: Array
 */
 func (o *Physics2DDirectSpaceState) CollideShape(shape Physics2DShapeQueryParametersImplementer, maxResults gdnative.Int) gdnative.Array {
 	//log.Println("Calling Physics2DDirectSpaceState.CollideShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 	ptrArguments[1] = gdnative.NewPointerFromInt(maxResults)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectSpaceState", "collide_shape")
+	methodBind := methodBindPhysics2DDirectSpaceStateCollideShape.Get()
 
 	// Call the parent method.
 	// Array
@@ -84,18 +86,18 @@ func (o *Physics2DDirectSpaceState) CollideShape(shape Physics2DShapeQueryParame
 }
 
 /*
-        Checks the intersections of a shape, given through a [Physics2DShapeQueryParameters] object, against the space. If it collides with more than one shape, the nearest one is selected. Note that this method does not take into account the [code]motion[/code] property of the object. The returned object is a dictionary containing the following fields: [code]collider_id[/code]: The colliding object's ID. [code]linear_velocity[/code]: The colliding object's velocity [Vector2]. If the object is an [Area2D], the result is [code](0, 0)[/code]. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]normal[/code]: The object's surface normal at the intersection point. [code]point[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the shape did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead.
-	Args: [{ false shape Physics2DShapeQueryParameters}], Returns: Dictionary
+	        Checks the intersections of a shape, given through a [Physics2DShapeQueryParameters] object, against the space. If it collides with more than one shape, the nearest one is selected. Note that this method does not take into account the [code]motion[/code] property of the object. The returned object is a dictionary containing the following fields: [code]collider_id[/code]: The colliding object's ID. [code]linear_velocity[/code]: The colliding object's velocity [Vector2]. If the object is an [Area2D], the result is [code](0, 0)[/code]. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]normal[/code]: The object's surface normal at the intersection point. [code]point[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the shape did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead.
+		Args: [{ false shape Physics2DShapeQueryParameters}], Returns: Dictionary
 */
 func (o *Physics2DDirectSpaceState) GetRestInfo(shape Physics2DShapeQueryParametersImplementer) gdnative.Dictionary {
 	//log.Println("Calling Physics2DDirectSpaceState.GetRestInfo()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectSpaceState", "get_rest_info")
+	methodBind := methodBindPhysics2DDirectSpaceStateGetRestInfo.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -108,8 +110,8 @@ func (o *Physics2DDirectSpaceState) GetRestInfo(shape Physics2DShapeQueryParamet
 }
 
 /*
-        Checks whether a point is inside any shape. The shapes the point is inside of are returned in an array containing dictionaries with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. Additionally, the method can take an array of objects or [RID]s that are to be excluded from collisions, or a bitmask representing the physics layers to check in.
-	Args: [{ false point Vector2} {32 true max_results int} {[] true exclude Array} {2147483647 true collision_layer int}], Returns: Array
+	        Checks whether a point is inside any shape. The shapes the point is inside of are returned in an array containing dictionaries with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. Additionally, the method can take an array of objects or [RID]s that are to be excluded from collisions, or a bitmask representing the physics layers to check in.
+		Args: [{ false point Vector2} {32 true max_results int} {[] true exclude Array} {2147483647 true collision_layer int}], Returns: Array
 */
 func (o *Physics2DDirectSpaceState) IntersectPoint(point gdnative.Vector2, maxResults gdnative.Int, exclude gdnative.Array, collisionLayer gdnative.Int) gdnative.Array {
 	//log.Println("Calling Physics2DDirectSpaceState.IntersectPoint()")
@@ -122,7 +124,7 @@ func (o *Physics2DDirectSpaceState) IntersectPoint(point gdnative.Vector2, maxRe
 	ptrArguments[3] = gdnative.NewPointerFromInt(collisionLayer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectSpaceState", "intersect_point")
+	methodBind := methodBindPhysics2DDirectSpaceStateIntersectPoint.Get()
 
 	// Call the parent method.
 	// Array
@@ -135,8 +137,8 @@ func (o *Physics2DDirectSpaceState) IntersectPoint(point gdnative.Vector2, maxRe
 }
 
 /*
-        Intersects a ray in a given space. The returned object is a dictionary with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]normal[/code]: The object's surface normal at the intersection point. [code]position[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the ray did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead. Additionally, the method can take an array of objects or [RID]s that are to be excluded from collisions, or a bitmask representing the physics layers to check in.
-	Args: [{ false from Vector2} { false to Vector2} {[] true exclude Array} {2147483647 true collision_layer int}], Returns: Dictionary
+	        Intersects a ray in a given space. The returned object is a dictionary with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]normal[/code]: The object's surface normal at the intersection point. [code]position[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the ray did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead. Additionally, the method can take an array of objects or [RID]s that are to be excluded from collisions, or a bitmask representing the physics layers to check in.
+		Args: [{ false from Vector2} { false to Vector2} {[] true exclude Array} {2147483647 true collision_layer int}], Returns: Dictionary
 */
 func (o *Physics2DDirectSpaceState) IntersectRay(from gdnative.Vector2, to gdnative.Vector2, exclude gdnative.Array, collisionLayer gdnative.Int) gdnative.Dictionary {
 	//log.Println("Calling Physics2DDirectSpaceState.IntersectRay()")
@@ -149,7 +151,7 @@ func (o *Physics2DDirectSpaceState) IntersectRay(from gdnative.Vector2, to gdnat
 	ptrArguments[3] = gdnative.NewPointerFromInt(collisionLayer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectSpaceState", "intersect_ray")
+	methodBind := methodBindPhysics2DDirectSpaceStateIntersectRay.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -162,19 +164,19 @@ func (o *Physics2DDirectSpaceState) IntersectRay(from gdnative.Vector2, to gdnat
 }
 
 /*
-        Checks the intersections of a shape, given through a [Physics2DShapeQueryParameters] object, against the space. Note that this method does not take into account the [code]motion[/code] property of the object. The intersected shapes are returned in an array containing dictionaries with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. The number of intersections can be limited with the second parameter, to reduce the processing time.
-	Args: [{ false shape Physics2DShapeQueryParameters} {32 true max_results int}], Returns: Array
+	        Checks the intersections of a shape, given through a [Physics2DShapeQueryParameters] object, against the space. Note that this method does not take into account the [code]motion[/code] property of the object. The intersected shapes are returned in an array containing dictionaries with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]metadata[/code]: The intersecting shape's metadata. This metadata is different from [method Object.get_meta], and is set with [method Physics2DServer.shape_set_data]. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. The number of intersections can be limited with the second parameter, to reduce the processing time.
+		Args: [{ false shape Physics2DShapeQueryParameters} {32 true max_results int}], Returns: Array
 */
 func (o *Physics2DDirectSpaceState) IntersectShape(shape Physics2DShapeQueryParametersImplementer, maxResults gdnative.Int) gdnative.Array {
 	//log.Println("Calling Physics2DDirectSpaceState.IntersectShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 	ptrArguments[1] = gdnative.NewPointerFromInt(maxResults)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DDirectSpaceState", "intersect_shape")
+	methodBind := methodBindPhysics2DDirectSpaceStateIntersectShape.Get()
 
 	// Call the parent method.
 	// Array
@@ -186,6 +188,30 @@ func (o *Physics2DDirectSpaceState) IntersectShape(shape Physics2DShapeQueryPara
 	return ret
 }
 
+// CollideShapeWithDefaults will call CollideShape using the default values for: max_results.
+func (o *Physics2DDirectSpaceState) CollideShapeWithDefaults(shape Physics2DShapeQueryParametersImplementer) gdnative.Array {
+	return o.CollideShape(shape, 32)
+}
+
+// IntersectPointWithDefaults will call IntersectPoint using the default values for: max_results, exclude, collision_layer.
+func (o *Physics2DDirectSpaceState) IntersectPointWithDefaults(point gdnative.Vector2) gdnative.Array {
+	default1 := gdnative.NewArray()
+	defer default1.Destroy()
+	return o.IntersectPoint(point, 32, default1, 2147483647)
+}
+
+// IntersectRayWithDefaults will call IntersectRay using the default values for: exclude, collision_layer.
+func (o *Physics2DDirectSpaceState) IntersectRayWithDefaults(from gdnative.Vector2, to gdnative.Vector2) gdnative.Dictionary {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	return o.IntersectRay(from, to, default0, 2147483647)
+}
+
+// IntersectShapeWithDefaults will call IntersectShape using the default values for: max_results.
+func (o *Physics2DDirectSpaceState) IntersectShapeWithDefaults(shape Physics2DShapeQueryParametersImplementer) gdnative.Array {
+	return o.IntersectShape(shape, 32)
+}
+
 // Physics2DDirectSpaceStateImplementer is an interface that implements the methods
 // of the Physics2DDirectSpaceState class.
 type Physics2DDirectSpaceStateImplementer interface {
@@ -196,4 +222,8 @@ type Physics2DDirectSpaceStateImplementer interface {
 	IntersectPoint(point gdnative.Vector2, maxResults gdnative.Int, exclude gdnative.Array, collisionLayer gdnative.Int) gdnative.Array
 	IntersectRay(from gdnative.Vector2, to gdnative.Vector2, exclude gdnative.Array, collisionLayer gdnative.Int) gdnative.Dictionary
 	IntersectShape(shape Physics2DShapeQueryParametersImplementer, maxResults gdnative.Int) gdnative.Array
+	CollideShapeWithDefaults(shape Physics2DShapeQueryParametersImplementer) gdnative.Array
+	IntersectPointWithDefaults(point gdnative.Vector2) gdnative.Array
+	IntersectRayWithDefaults(from gdnative.Vector2, to gdnative.Vector2) gdnative.Dictionary
+	IntersectShapeWithDefaults(shape Physics2DShapeQueryParametersImplementer) gdnative.Array
 }
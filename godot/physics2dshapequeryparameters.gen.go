@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysics2DShapeQueryParametersFromPointer(ptr gdnative.Pointer) Physics2DShapeQueryParameters {
-func newPhysics2DShapeQueryParametersFromPointer(ptr gdnative.Pointer) Physics2DShapeQueryParameters {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Physics2DShapeQueryParameters{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This class contains the shape and other parameters for intersection/collision queries.
 */
@@ -30,13 +21,48 @@ type Physics2DShapeQueryParameters struct {
 	owner gdnative.Object
 }
 
+// classConstructorPhysics2DShapeQueryParameters is the class constructor of the Physics2DShapeQueryParameters class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPhysics2DShapeQueryParameters = gdnative.NewLazyClassConstructor("Physics2DShapeQueryParameters")
+
+// NewPhysics2DShapeQueryParameters will create a new instance of the Physics2DShapeQueryParameters class.
+// Physics2DShapeQueryParameters is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPhysics2DShapeQueryParameters() *Physics2DShapeQueryParameters {
+	obj := &Physics2DShapeQueryParameters{}
+	obj.SetBaseObject(classConstructorPhysics2DShapeQueryParameters.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Physics2DShapeQueryParameters) BaseClass() string {
 	return "Physics2DShapeQueryParameters"
 }
 
+// Method binds of the Physics2DShapeQueryParameters class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysics2DShapeQueryParametersGetCollisionLayer = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "get_collision_layer")
+	methodBindPhysics2DShapeQueryParametersGetExclude        = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "get_exclude")
+	methodBindPhysics2DShapeQueryParametersGetMargin         = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "get_margin")
+	methodBindPhysics2DShapeQueryParametersGetMotion         = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "get_motion")
+	methodBindPhysics2DShapeQueryParametersGetShapeRid       = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "get_shape_rid")
+	methodBindPhysics2DShapeQueryParametersGetTransform      = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "get_transform")
+	methodBindPhysics2DShapeQueryParametersSetCollisionLayer = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "set_collision_layer")
+	methodBindPhysics2DShapeQueryParametersSetExclude        = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "set_exclude")
+	methodBindPhysics2DShapeQueryParametersSetMargin         = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "set_margin")
+	methodBindPhysics2DShapeQueryParametersSetMotion         = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "set_motion")
+	methodBindPhysics2DShapeQueryParametersSetShape          = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "set_shape")
+	methodBindPhysics2DShapeQueryParametersSetShapeRid       = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "set_shape_rid")
+	methodBindPhysics2DShapeQueryParametersSetTransform      = gdnative.NewLazyMethodBind("Physics2DShapeQueryParameters", "set_transform")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Physics2DShapeQueryParameters) GetCollisionLayer() gdnative.Int {
 	//log.Println("Calling Physics2DShapeQueryParameters.GetCollisionLayer()")
@@ -45,7 +71,7 @@ func (o *Physics2DShapeQueryParameters) GetCollisionLayer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "get_collision_layer")
+	methodBind := methodBindPhysics2DShapeQueryParametersGetCollisionLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +84,8 @@ func (o *Physics2DShapeQueryParameters) GetCollisionLayer() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *Physics2DShapeQueryParameters) GetExclude() gdnative.Array {
 	//log.Println("Calling Physics2DShapeQueryParameters.GetExclude()")
@@ -68,7 +94,7 @@ func (o *Physics2DShapeQueryParameters) GetExclude() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "get_exclude")
+	methodBind := methodBindPhysics2DShapeQueryParametersGetExclude.Get()
 
 	// Call the parent method.
 	// Array
@@ -81,8 +107,8 @@ func (o *Physics2DShapeQueryParameters) GetExclude() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Physics2DShapeQueryParameters) GetMargin() gdnative.Real {
 	//log.Println("Calling Physics2DShapeQueryParameters.GetMargin()")
@@ -91,7 +117,7 @@ func (o *Physics2DShapeQueryParameters) GetMargin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "get_margin")
+	methodBind := methodBindPhysics2DShapeQueryParametersGetMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +130,8 @@ func (o *Physics2DShapeQueryParameters) GetMargin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Physics2DShapeQueryParameters) GetMotion() gdnative.Vector2 {
 	//log.Println("Calling Physics2DShapeQueryParameters.GetMotion()")
@@ -114,7 +140,7 @@ func (o *Physics2DShapeQueryParameters) GetMotion() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "get_motion")
+	methodBind := methodBindPhysics2DShapeQueryParametersGetMotion.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -127,8 +153,8 @@ func (o *Physics2DShapeQueryParameters) GetMotion() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *Physics2DShapeQueryParameters) GetShapeRid() gdnative.Rid {
 	//log.Println("Calling Physics2DShapeQueryParameters.GetShapeRid()")
@@ -137,7 +163,7 @@ func (o *Physics2DShapeQueryParameters) GetShapeRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "get_shape_rid")
+	methodBind := methodBindPhysics2DShapeQueryParametersGetShapeRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -150,8 +176,8 @@ func (o *Physics2DShapeQueryParameters) GetShapeRid() gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform2D
+	        Undocumented
+		Args: [], Returns: Transform2D
 */
 func (o *Physics2DShapeQueryParameters) GetTransform() gdnative.Transform2D {
 	//log.Println("Calling Physics2DShapeQueryParameters.GetTransform()")
@@ -160,7 +186,7 @@ func (o *Physics2DShapeQueryParameters) GetTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "get_transform")
+	methodBind := methodBindPhysics2DShapeQueryParametersGetTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -173,8 +199,8 @@ func (o *Physics2DShapeQueryParameters) GetTransform() gdnative.Transform2D {
 }
 
 /*
-        Undocumented
-	Args: [{ false collision_layer int}], Returns: void
+	        Undocumented
+		Args: [{ false collision_layer int}], Returns: void
 */
 func (o *Physics2DShapeQueryParameters) SetCollisionLayer(collisionLayer gdnative.Int) {
 	//log.Println("Calling Physics2DShapeQueryParameters.SetCollisionLayer()")
@@ -184,7 +210,7 @@ func (o *Physics2DShapeQueryParameters) SetCollisionLayer(collisionLayer gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromInt(collisionLayer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "set_collision_layer")
+	methodBind := methodBindPhysics2DShapeQueryParametersSetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -194,8 +220,8 @@ func (o *Physics2DShapeQueryParameters) SetCollisionLayer(collisionLayer gdnativ
 }
 
 /*
-        Undocumented
-	Args: [{ false exclude Array}], Returns: void
+	        Undocumented
+		Args: [{ false exclude Array}], Returns: void
 */
 func (o *Physics2DShapeQueryParameters) SetExclude(exclude gdnative.Array) {
 	//log.Println("Calling Physics2DShapeQueryParameters.SetExclude()")
@@ -205,7 +231,7 @@ func (o *Physics2DShapeQueryParameters) SetExclude(exclude gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(exclude)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "set_exclude")
+	methodBind := methodBindPhysics2DShapeQueryParametersSetExclude.Get()
 
 	// Call the parent method.
 	// void
@@ -215,8 +241,8 @@ func (o *Physics2DShapeQueryParameters) SetExclude(exclude gdnative.Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin float}], Returns: void
+	        Undocumented
+		Args: [{ false margin float}], Returns: void
 */
 func (o *Physics2DShapeQueryParameters) SetMargin(margin gdnative.Real) {
 	//log.Println("Calling Physics2DShapeQueryParameters.SetMargin()")
@@ -226,7 +252,7 @@ func (o *Physics2DShapeQueryParameters) SetMargin(margin gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "set_margin")
+	methodBind := methodBindPhysics2DShapeQueryParametersSetMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -236,8 +262,8 @@ func (o *Physics2DShapeQueryParameters) SetMargin(margin gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false motion Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false motion Vector2}], Returns: void
 */
 func (o *Physics2DShapeQueryParameters) SetMotion(motion gdnative.Vector2) {
 	//log.Println("Calling Physics2DShapeQueryParameters.SetMotion()")
@@ -247,7 +273,7 @@ func (o *Physics2DShapeQueryParameters) SetMotion(motion gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(motion)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "set_motion")
+	methodBind := methodBindPhysics2DShapeQueryParametersSetMotion.Get()
 
 	// Call the parent method.
 	// void
@@ -257,18 +283,18 @@ func (o *Physics2DShapeQueryParameters) SetMotion(motion gdnative.Vector2) {
 }
 
 /*
-        Set the [Shape2D] that will be used for collision/intersection queries.
-	Args: [{ false shape Resource}], Returns: void
+	        Set the [Shape2D] that will be used for collision/intersection queries.
+		Args: [{ false shape Resource}], Returns: void
 */
 func (o *Physics2DShapeQueryParameters) SetShape(shape ResourceImplementer) {
 	//log.Println("Calling Physics2DShapeQueryParameters.SetShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "set_shape")
+	methodBind := methodBindPhysics2DShapeQueryParametersSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -278,8 +304,8 @@ func (o *Physics2DShapeQueryParameters) SetShape(shape ResourceImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false shape RID}], Returns: void
+	        Undocumented
+		Args: [{ false shape RID}], Returns: void
 */
 func (o *Physics2DShapeQueryParameters) SetShapeRid(shape gdnative.Rid) {
 	//log.Println("Calling Physics2DShapeQueryParameters.SetShapeRid()")
@@ -289,7 +315,7 @@ func (o *Physics2DShapeQueryParameters) SetShapeRid(shape gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "set_shape_rid")
+	methodBind := methodBindPhysics2DShapeQueryParametersSetShapeRid.Get()
 
 	// Call the parent method.
 	// void
@@ -299,8 +325,8 @@ func (o *Physics2DShapeQueryParameters) SetShapeRid(shape gdnative.Rid) {
 }
 
 /*
-        Undocumented
-	Args: [{ false transform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false transform Transform2D}], Returns: void
 */
 func (o *Physics2DShapeQueryParameters) SetTransform(transform gdnative.Transform2D) {
 	//log.Println("Calling Physics2DShapeQueryParameters.SetTransform()")
@@ -310,7 +336,7 @@ func (o *Physics2DShapeQueryParameters) SetTransform(transform gdnative.Transfor
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Physics2DShapeQueryParameters", "set_transform")
+	methodBind := methodBindPhysics2DShapeQueryParametersSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -319,6 +345,36 @@ func (o *Physics2DShapeQueryParameters) SetTransform(transform gdnative.Transfor
 
 }
 
+// CollisionLayer will return the value of the "collision_layer" property.
+func (o *Physics2DShapeQueryParameters) CollisionLayer() gdnative.Int {
+	return o.GetCollisionLayer()
+}
+
+// Exclude will return the value of the "exclude" property.
+func (o *Physics2DShapeQueryParameters) Exclude() gdnative.Array {
+	return o.GetExclude()
+}
+
+// Margin will return the value of the "margin" property.
+func (o *Physics2DShapeQueryParameters) Margin() gdnative.Real {
+	return o.GetMargin()
+}
+
+// Motion will return the value of the "motion" property.
+func (o *Physics2DShapeQueryParameters) Motion() gdnative.Vector2 {
+	return o.GetMotion()
+}
+
+// ShapeRid will return the value of the "shape_rid" property.
+func (o *Physics2DShapeQueryParameters) ShapeRid() gdnative.Rid {
+	return o.GetShapeRid()
+}
+
+// Transform will return the value of the "transform" property.
+func (o *Physics2DShapeQueryParameters) Transform() gdnative.Transform2D {
+	return o.GetTransform()
+}
+
 // Physics2DShapeQueryParametersImplementer is an interface that implements the methods
 // of the Physics2DShapeQueryParameters class.
 type Physics2DShapeQueryParametersImplementer interface {
@@ -336,4 +392,10 @@ type Physics2DShapeQueryParametersImplementer interface {
 	SetShape(shape ResourceImplementer)
 	SetShapeRid(shape gdnative.Rid)
 	SetTransform(transform gdnative.Transform2D)
+	CollisionLayer() gdnative.Int
+	Exclude() gdnative.Array
+	Margin() gdnative.Real
+	Motion() gdnative.Vector2
+	ShapeRid() gdnative.Rid
+	Transform() gdnative.Transform2D
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysicsBodyFromPointer(ptr gdnative.Pointer) PhysicsBody {
-func newPhysicsBodyFromPointer(ptr gdnative.Pointer) PhysicsBody {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PhysicsBody{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 PhysicsBody is an abstract base class for implementing a physics body. All *Body types inherit from it.
 */
@@ -34,9 +25,26 @@ func (o *PhysicsBody) BaseClass() string {
 	return "PhysicsBody"
 }
 
+// Method binds of the PhysicsBody class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysicsBodyX_GetLayers                  = gdnative.NewLazyMethodBind("PhysicsBody", "_get_layers")
+	methodBindPhysicsBodyX_SetLayers                  = gdnative.NewLazyMethodBind("PhysicsBody", "_set_layers")
+	methodBindPhysicsBodyAddCollisionExceptionWith    = gdnative.NewLazyMethodBind("PhysicsBody", "add_collision_exception_with")
+	methodBindPhysicsBodyGetCollisionLayer            = gdnative.NewLazyMethodBind("PhysicsBody", "get_collision_layer")
+	methodBindPhysicsBodyGetCollisionLayerBit         = gdnative.NewLazyMethodBind("PhysicsBody", "get_collision_layer_bit")
+	methodBindPhysicsBodyGetCollisionMask             = gdnative.NewLazyMethodBind("PhysicsBody", "get_collision_mask")
+	methodBindPhysicsBodyGetCollisionMaskBit          = gdnative.NewLazyMethodBind("PhysicsBody", "get_collision_mask_bit")
+	methodBindPhysicsBodyRemoveCollisionExceptionWith = gdnative.NewLazyMethodBind("PhysicsBody", "remove_collision_exception_with")
+	methodBindPhysicsBodySetCollisionLayer            = gdnative.NewLazyMethodBind("PhysicsBody", "set_collision_layer")
+	methodBindPhysicsBodySetCollisionLayerBit         = gdnative.NewLazyMethodBind("PhysicsBody", "set_collision_layer_bit")
+	methodBindPhysicsBodySetCollisionMask             = gdnative.NewLazyMethodBind("PhysicsBody", "set_collision_mask")
+	methodBindPhysicsBodySetCollisionMaskBit          = gdnative.NewLazyMethodBind("PhysicsBody", "set_collision_mask_bit")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PhysicsBody) X_GetLayers() gdnative.Int {
 	//log.Println("Calling PhysicsBody.X_GetLayers()")
@@ -45,7 +53,7 @@ func (o *PhysicsBody) X_GetLayers() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "_get_layers")
+	methodBind := methodBindPhysicsBodyX_GetLayers.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +66,8 @@ func (o *PhysicsBody) X_GetLayers() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *PhysicsBody) X_SetLayers(mask gdnative.Int) {
 	//log.Println("Calling PhysicsBody.X_SetLayers()")
@@ -69,7 +77,7 @@ func (o *PhysicsBody) X_SetLayers(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "_set_layers")
+	methodBind := methodBindPhysicsBodyX_SetLayers.Get()
 
 	// Call the parent method.
 	// void
@@ -79,18 +87,18 @@ func (o *PhysicsBody) X_SetLayers(mask gdnative.Int) {
 }
 
 /*
-        Adds a body to the list of bodies that this body can't collide with.
-	Args: [{ false body Object}], Returns: void
+	        Adds a body to the list of bodies that this body can't collide with.
+		Args: [{ false body Object}], Returns: void
 */
 func (o *PhysicsBody) AddCollisionExceptionWith(body ObjectImplementer) {
 	//log.Println("Calling PhysicsBody.AddCollisionExceptionWith()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(body.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(body))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "add_collision_exception_with")
+	methodBind := methodBindPhysicsBodyAddCollisionExceptionWith.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +108,8 @@ func (o *PhysicsBody) AddCollisionExceptionWith(body ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PhysicsBody) GetCollisionLayer() gdnative.Int {
 	//log.Println("Calling PhysicsBody.GetCollisionLayer()")
@@ -110,7 +118,7 @@ func (o *PhysicsBody) GetCollisionLayer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "get_collision_layer")
+	methodBind := methodBindPhysicsBodyGetCollisionLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -123,8 +131,7 @@ func (o *PhysicsBody) GetCollisionLayer() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false bit int}], Returns: bool
+Args: [{ false bit int}], Returns: bool
 */
 func (o *PhysicsBody) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PhysicsBody.GetCollisionLayerBit()")
@@ -134,7 +141,7 @@ func (o *PhysicsBody) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "get_collision_layer_bit")
+	methodBind := methodBindPhysicsBodyGetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -147,8 +154,8 @@ func (o *PhysicsBody) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PhysicsBody) GetCollisionMask() gdnative.Int {
 	//log.Println("Calling PhysicsBody.GetCollisionMask()")
@@ -157,7 +164,7 @@ func (o *PhysicsBody) GetCollisionMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "get_collision_mask")
+	methodBind := methodBindPhysicsBodyGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -170,8 +177,7 @@ func (o *PhysicsBody) GetCollisionMask() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false bit int}], Returns: bool
+Args: [{ false bit int}], Returns: bool
 */
 func (o *PhysicsBody) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PhysicsBody.GetCollisionMaskBit()")
@@ -181,7 +187,7 @@ func (o *PhysicsBody) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "get_collision_mask_bit")
+	methodBind := methodBindPhysicsBodyGetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -194,18 +200,18 @@ func (o *PhysicsBody) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Removes a body from the list of bodies that this body can't collide with.
-	Args: [{ false body Object}], Returns: void
+	        Removes a body from the list of bodies that this body can't collide with.
+		Args: [{ false body Object}], Returns: void
 */
 func (o *PhysicsBody) RemoveCollisionExceptionWith(body ObjectImplementer) {
 	//log.Println("Calling PhysicsBody.RemoveCollisionExceptionWith()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(body.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(body))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "remove_collision_exception_with")
+	methodBind := methodBindPhysicsBodyRemoveCollisionExceptionWith.Get()
 
 	// Call the parent method.
 	// void
@@ -215,8 +221,8 @@ func (o *PhysicsBody) RemoveCollisionExceptionWith(body ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *PhysicsBody) SetCollisionLayer(layer gdnative.Int) {
 	//log.Println("Calling PhysicsBody.SetCollisionLayer()")
@@ -226,7 +232,7 @@ func (o *PhysicsBody) SetCollisionLayer(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "set_collision_layer")
+	methodBind := methodBindPhysicsBodySetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -236,8 +242,7 @@ func (o *PhysicsBody) SetCollisionLayer(layer gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false bit int} { false value bool}], Returns: void
+Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *PhysicsBody) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling PhysicsBody.SetCollisionLayerBit()")
@@ -248,7 +253,7 @@ func (o *PhysicsBody) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "set_collision_layer_bit")
+	methodBind := methodBindPhysicsBodySetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// void
@@ -258,8 +263,8 @@ func (o *PhysicsBody) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *PhysicsBody) SetCollisionMask(mask gdnative.Int) {
 	//log.Println("Calling PhysicsBody.SetCollisionMask()")
@@ -269,7 +274,7 @@ func (o *PhysicsBody) SetCollisionMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "set_collision_mask")
+	methodBind := methodBindPhysicsBodySetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -279,8 +284,7 @@ func (o *PhysicsBody) SetCollisionMask(mask gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false bit int} { false value bool}], Returns: void
+Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *PhysicsBody) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling PhysicsBody.SetCollisionMaskBit()")
@@ -291,7 +295,7 @@ func (o *PhysicsBody) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody", "set_collision_mask_bit")
+	methodBind := methodBindPhysicsBodySetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// void
@@ -300,6 +304,16 @@ func (o *PhysicsBody) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
 
 }
 
+// CollisionLayer will return the value of the "collision_layer" property.
+func (o *PhysicsBody) CollisionLayer() gdnative.Int {
+	return o.GetCollisionLayer()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *PhysicsBody) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
 // PhysicsBodyImplementer is an interface that implements the methods
 // of the PhysicsBody class.
 type PhysicsBodyImplementer interface {
@@ -316,4 +330,6 @@ type PhysicsBodyImplementer interface {
 	SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool)
 	SetCollisionMask(mask gdnative.Int)
 	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
+	CollisionLayer() gdnative.Int
+	CollisionMask() gdnative.Int
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysicsBody2DFromPointer(ptr gdnative.Pointer) PhysicsBody2D {
-func newPhysicsBody2DFromPointer(ptr gdnative.Pointer) PhysicsBody2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PhysicsBody2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 PhysicsBody2D is an abstract base class for implementing a physics body. All *Body2D types inherit from it.
 */
@@ -34,9 +25,26 @@ func (o *PhysicsBody2D) BaseClass() string {
 	return "PhysicsBody2D"
 }
 
+// Method binds of the PhysicsBody2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysicsBody2DX_GetLayers                  = gdnative.NewLazyMethodBind("PhysicsBody2D", "_get_layers")
+	methodBindPhysicsBody2DX_SetLayers                  = gdnative.NewLazyMethodBind("PhysicsBody2D", "_set_layers")
+	methodBindPhysicsBody2DAddCollisionExceptionWith    = gdnative.NewLazyMethodBind("PhysicsBody2D", "add_collision_exception_with")
+	methodBindPhysicsBody2DGetCollisionLayer            = gdnative.NewLazyMethodBind("PhysicsBody2D", "get_collision_layer")
+	methodBindPhysicsBody2DGetCollisionLayerBit         = gdnative.NewLazyMethodBind("PhysicsBody2D", "get_collision_layer_bit")
+	methodBindPhysicsBody2DGetCollisionMask             = gdnative.NewLazyMethodBind("PhysicsBody2D", "get_collision_mask")
+	methodBindPhysicsBody2DGetCollisionMaskBit          = gdnative.NewLazyMethodBind("PhysicsBody2D", "get_collision_mask_bit")
+	methodBindPhysicsBody2DRemoveCollisionExceptionWith = gdnative.NewLazyMethodBind("PhysicsBody2D", "remove_collision_exception_with")
+	methodBindPhysicsBody2DSetCollisionLayer            = gdnative.NewLazyMethodBind("PhysicsBody2D", "set_collision_layer")
+	methodBindPhysicsBody2DSetCollisionLayerBit         = gdnative.NewLazyMethodBind("PhysicsBody2D", "set_collision_layer_bit")
+	methodBindPhysicsBody2DSetCollisionMask             = gdnative.NewLazyMethodBind("PhysicsBody2D", "set_collision_mask")
+	methodBindPhysicsBody2DSetCollisionMaskBit          = gdnative.NewLazyMethodBind("PhysicsBody2D", "set_collision_mask_bit")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PhysicsBody2D) X_GetLayers() gdnative.Int {
 	//log.Println("Calling PhysicsBody2D.X_GetLayers()")
@@ -45,7 +53,7 @@ func (o *PhysicsBody2D) X_GetLayers() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "_get_layers")
+	methodBind := methodBindPhysicsBody2DX_GetLayers.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +66,8 @@ func (o *PhysicsBody2D) X_GetLayers() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *PhysicsBody2D) X_SetLayers(mask gdnative.Int) {
 	//log.Println("Calling PhysicsBody2D.X_SetLayers()")
@@ -69,7 +77,7 @@ func (o *PhysicsBody2D) X_SetLayers(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "_set_layers")
+	methodBind := methodBindPhysicsBody2DX_SetLayers.Get()
 
 	// Call the parent method.
 	// void
@@ -79,18 +87,18 @@ func (o *PhysicsBody2D) X_SetLayers(mask gdnative.Int) {
 }
 
 /*
-        Adds a body to the list of bodies that this body can't collide with.
-	Args: [{ false body Object}], Returns: void
+	        Adds a body to the list of bodies that this body can't collide with.
+		Args: [{ false body Object}], Returns: void
 */
 func (o *PhysicsBody2D) AddCollisionExceptionWith(body ObjectImplementer) {
 	//log.Println("Calling PhysicsBody2D.AddCollisionExceptionWith()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(body.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(body))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "add_collision_exception_with")
+	methodBind := methodBindPhysicsBody2DAddCollisionExceptionWith.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +108,8 @@ func (o *PhysicsBody2D) AddCollisionExceptionWith(body ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PhysicsBody2D) GetCollisionLayer() gdnative.Int {
 	//log.Println("Calling PhysicsBody2D.GetCollisionLayer()")
@@ -110,7 +118,7 @@ func (o *PhysicsBody2D) GetCollisionLayer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "get_collision_layer")
+	methodBind := methodBindPhysicsBody2DGetCollisionLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -123,8 +131,8 @@ func (o *PhysicsBody2D) GetCollisionLayer() gdnative.Int {
 }
 
 /*
-        Return an individual bit on the collision mask.
-	Args: [{ false bit int}], Returns: bool
+	        Return an individual bit on the collision mask.
+		Args: [{ false bit int}], Returns: bool
 */
 func (o *PhysicsBody2D) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PhysicsBody2D.GetCollisionLayerBit()")
@@ -134,7 +142,7 @@ func (o *PhysicsBody2D) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "get_collision_layer_bit")
+	methodBind := methodBindPhysicsBody2DGetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -147,8 +155,8 @@ func (o *PhysicsBody2D) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PhysicsBody2D) GetCollisionMask() gdnative.Int {
 	//log.Println("Calling PhysicsBody2D.GetCollisionMask()")
@@ -157,7 +165,7 @@ func (o *PhysicsBody2D) GetCollisionMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "get_collision_mask")
+	methodBind := methodBindPhysicsBody2DGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -170,8 +178,8 @@ func (o *PhysicsBody2D) GetCollisionMask() gdnative.Int {
 }
 
 /*
-        Return an individual bit on the collision mask.
-	Args: [{ false bit int}], Returns: bool
+	        Return an individual bit on the collision mask.
+		Args: [{ false bit int}], Returns: bool
 */
 func (o *PhysicsBody2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PhysicsBody2D.GetCollisionMaskBit()")
@@ -181,7 +189,7 @@ func (o *PhysicsBody2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "get_collision_mask_bit")
+	methodBind := methodBindPhysicsBody2DGetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -194,18 +202,18 @@ func (o *PhysicsBody2D) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Removes a body from the list of bodies that this body can't collide with.
-	Args: [{ false body Object}], Returns: void
+	        Removes a body from the list of bodies that this body can't collide with.
+		Args: [{ false body Object}], Returns: void
 */
 func (o *PhysicsBody2D) RemoveCollisionExceptionWith(body ObjectImplementer) {
 	//log.Println("Calling PhysicsBody2D.RemoveCollisionExceptionWith()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(body.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(body))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "remove_collision_exception_with")
+	methodBind := methodBindPhysicsBody2DRemoveCollisionExceptionWith.Get()
 
 	// Call the parent method.
 	// void
@@ -215,8 +223,8 @@ func (o *PhysicsBody2D) RemoveCollisionExceptionWith(body ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *PhysicsBody2D) SetCollisionLayer(layer gdnative.Int) {
 	//log.Println("Calling PhysicsBody2D.SetCollisionLayer()")
@@ -226,7 +234,7 @@ func (o *PhysicsBody2D) SetCollisionLayer(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "set_collision_layer")
+	methodBind := methodBindPhysicsBody2DSetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -236,8 +244,8 @@ func (o *PhysicsBody2D) SetCollisionLayer(layer gdnative.Int) {
 }
 
 /*
-        Set/clear individual bits on the layer mask. This makes getting a body in/out of only one layer easier.
-	Args: [{ false bit int} { false value bool}], Returns: void
+	        Set/clear individual bits on the layer mask. This makes getting a body in/out of only one layer easier.
+		Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *PhysicsBody2D) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling PhysicsBody2D.SetCollisionLayerBit()")
@@ -248,7 +256,7 @@ func (o *PhysicsBody2D) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bo
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "set_collision_layer_bit")
+	methodBind := methodBindPhysicsBody2DSetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// void
@@ -258,8 +266,8 @@ func (o *PhysicsBody2D) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bo
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *PhysicsBody2D) SetCollisionMask(mask gdnative.Int) {
 	//log.Println("Calling PhysicsBody2D.SetCollisionMask()")
@@ -269,7 +277,7 @@ func (o *PhysicsBody2D) SetCollisionMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "set_collision_mask")
+	methodBind := methodBindPhysicsBody2DSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -279,8 +287,8 @@ func (o *PhysicsBody2D) SetCollisionMask(mask gdnative.Int) {
 }
 
 /*
-        Set/clear individual bits on the collision mask. This makes selecting the areas scanned easier.
-	Args: [{ false bit int} { false value bool}], Returns: void
+	        Set/clear individual bits on the collision mask. This makes selecting the areas scanned easier.
+		Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *PhysicsBody2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling PhysicsBody2D.SetCollisionMaskBit()")
@@ -291,7 +299,7 @@ func (o *PhysicsBody2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Boo
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsBody2D", "set_collision_mask_bit")
+	methodBind := methodBindPhysicsBody2DSetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// void
@@ -300,6 +308,26 @@ func (o *PhysicsBody2D) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Boo
 
 }
 
+// CollisionLayer will return the value of the "collision_layer" property.
+func (o *PhysicsBody2D) CollisionLayer() gdnative.Int {
+	return o.GetCollisionLayer()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *PhysicsBody2D) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// Layers will return the value of the "layers" property.
+func (o *PhysicsBody2D) Layers() gdnative.Int {
+	return o.X_GetLayers()
+}
+
+// SetLayers will set the value of the "layers" property.
+func (o *PhysicsBody2D) SetLayers(value gdnative.Int) {
+	o.X_SetLayers(value)
+}
+
 // PhysicsBody2DImplementer is an interface that implements the methods
 // of the PhysicsBody2D class.
 type PhysicsBody2DImplementer interface {
@@ -316,4 +344,8 @@ type PhysicsBody2DImplementer interface {
 	SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool)
 	SetCollisionMask(mask gdnative.Int)
 	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
+	CollisionLayer() gdnative.Int
+	CollisionMask() gdnative.Int
+	Layers() gdnative.Int
+	SetLayers(value gdnative.Int)
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysicsDirectSpaceStateFromPointer(ptr gdnative.Pointer) PhysicsDirectSpaceState {
-func newPhysicsDirectSpaceStateFromPointer(ptr gdnative.Pointer) PhysicsDirectSpaceState {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PhysicsDirectSpaceState{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Direct access object to a space in the [PhysicsServer]. It's used mainly to do queries against objects and areas residing in a given space.
 */
@@ -34,20 +25,30 @@ func (o *PhysicsDirectSpaceState) BaseClass() string {
 	return "PhysicsDirectSpaceState"
 }
 
+// Method binds of the PhysicsDirectSpaceState class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysicsDirectSpaceStateCastMotion     = gdnative.NewLazyMethodBind("PhysicsDirectSpaceState", "cast_motion")
+	methodBindPhysicsDirectSpaceStateCollideShape   = gdnative.NewLazyMethodBind("PhysicsDirectSpaceState", "collide_shape")
+	methodBindPhysicsDirectSpaceStateGetRestInfo    = gdnative.NewLazyMethodBind("PhysicsDirectSpaceState", "get_rest_info")
+	methodBindPhysicsDirectSpaceStateIntersectRay   = gdnative.NewLazyMethodBind("PhysicsDirectSpaceState", "intersect_ray")
+	methodBindPhysicsDirectSpaceStateIntersectShape = gdnative.NewLazyMethodBind("PhysicsDirectSpaceState", "intersect_shape")
+)
+
 /*
-        Checks whether the shape can travel to a point. The method will return an array with two floats between 0 and 1, both representing a fraction of [code]motion[/code]. The first is how far the shape can move without triggering a collision, and the second is the point at which a collision will occur. If no collision is detected, the returned array will be [1, 1]. If the shape can not move, the array will be empty ([code]dir.empty()==true[/code]).
-	Args: [{ false shape PhysicsShapeQueryParameters} { false motion Vector3}], Returns: Array
+	        Checks whether the shape can travel to a point. The method will return an array with two floats between 0 and 1, both representing a fraction of [code]motion[/code]. The first is how far the shape can move without triggering a collision, and the second is the point at which a collision will occur. If no collision is detected, the returned array will be [1, 1]. If the shape can not move, the array will be empty ([code]dir.empty()==true[/code]).
+		Args: [{ false shape PhysicsShapeQueryParameters} { false motion Vector3}], Returns: Array
 */
 func (o *PhysicsDirectSpaceState) CastMotion(shape PhysicsShapeQueryParametersImplementer, motion gdnative.Vector3) gdnative.Array {
 	//log.Println("Calling PhysicsDirectSpaceState.CastMotion()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 	ptrArguments[1] = gdnative.NewPointerFromVector3(motion)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectSpaceState", "cast_motion")
+	methodBind := methodBindPhysicsDirectSpaceStateCastMotion.Get()
 
 	// Call the parent method.
 	// Array
@@ -60,19 +61,19 @@ func (o *PhysicsDirectSpaceState) CastMotion(shape PhysicsShapeQueryParametersIm
 }
 
 /*
-        Checks the intersections of a shape, given through a [PhysicsShapeQueryParameters] object, against the space. The resulting array contains a list of points where the shape intersects another. Like with [method intersect_shape], the number of returned results can be limited to save processing time.
-	Args: [{ false shape PhysicsShapeQueryParameters} {32 true max_results int}], Returns: Array
+	        Checks the intersections of a shape, given through a [PhysicsShapeQueryParameters] object, against the space. The resulting array contains a list of points where the shape intersects another. Like with [method intersect_shape], the number of returned results can be limited to save processing time.
+		Args: [{ false shape PhysicsShapeQueryParameters} {32 true max_results int}], Returns: Array
 */
 func (o *PhysicsDirectSpaceState) CollideShape(shape PhysicsShapeQueryParametersImplementer, maxResults gdnative.Int) gdnative.Array {
 	//log.Println("Calling PhysicsDirectSpaceState.CollideShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 	ptrArguments[1] = gdnative.NewPointerFromInt(maxResults)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectSpaceState", "collide_shape")
+	methodBind := methodBindPhysicsDirectSpaceStateCollideShape.Get()
 
 	// Call the parent method.
 	// Array
@@ -85,18 +86,18 @@ func (o *PhysicsDirectSpaceState) CollideShape(shape PhysicsShapeQueryParameters
 }
 
 /*
-        Checks the intersections of a shape, given through a [PhysicsShapeQueryParameters] object, against the space. If it collides with more than a shape, the nearest one is selected. The returned object is a dictionary containing the following fields: [code]collider_id[/code]: The colliding object's ID. [code]linear_velocity[/code]: The colliding object's velocity [Vector3]. If the object is an [Area], the result is [code](0, 0, 0)[/code]. [code]normal[/code]: The object's surface normal at the intersection point. [code]point[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the shape did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead.
-	Args: [{ false shape PhysicsShapeQueryParameters}], Returns: Dictionary
+	        Checks the intersections of a shape, given through a [PhysicsShapeQueryParameters] object, against the space. If it collides with more than a shape, the nearest one is selected. The returned object is a dictionary containing the following fields: [code]collider_id[/code]: The colliding object's ID. [code]linear_velocity[/code]: The colliding object's velocity [Vector3]. If the object is an [Area], the result is [code](0, 0, 0)[/code]. [code]normal[/code]: The object's surface normal at the intersection point. [code]point[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the shape did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead.
+		Args: [{ false shape PhysicsShapeQueryParameters}], Returns: Dictionary
 */
 func (o *PhysicsDirectSpaceState) GetRestInfo(shape PhysicsShapeQueryParametersImplementer) gdnative.Dictionary {
 	//log.Println("Calling PhysicsDirectSpaceState.GetRestInfo()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectSpaceState", "get_rest_info")
+	methodBind := methodBindPhysicsDirectSpaceStateGetRestInfo.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -109,8 +110,8 @@ func (o *PhysicsDirectSpaceState) GetRestInfo(shape PhysicsShapeQueryParametersI
 }
 
 /*
-        Intersects a ray in a given space. The returned object is a dictionary with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]normal[/code]: The object's surface normal at the intersection point. [code]position[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the ray did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead. Additionally, the method can take an array of objects or [RID]s that are to be excluded from collisions, or a bitmask representing the physics layers to check in.
-	Args: [{ false from Vector3} { false to Vector3} {[] true exclude Array} {2147483647 true collision_layer int}], Returns: Dictionary
+	        Intersects a ray in a given space. The returned object is a dictionary with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]normal[/code]: The object's surface normal at the intersection point. [code]position[/code]: The intersection point. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. If the ray did not intersect anything, then an empty dictionary ([code]dir.empty()==true[/code]) is returned instead. Additionally, the method can take an array of objects or [RID]s that are to be excluded from collisions, or a bitmask representing the physics layers to check in.
+		Args: [{ false from Vector3} { false to Vector3} {[] true exclude Array} {2147483647 true collision_layer int}], Returns: Dictionary
 */
 func (o *PhysicsDirectSpaceState) IntersectRay(from gdnative.Vector3, to gdnative.Vector3, exclude gdnative.Array, collisionLayer gdnative.Int) gdnative.Dictionary {
 	//log.Println("Calling PhysicsDirectSpaceState.IntersectRay()")
@@ -123,7 +124,7 @@ func (o *PhysicsDirectSpaceState) IntersectRay(from gdnative.Vector3, to gdnativ
 	ptrArguments[3] = gdnative.NewPointerFromInt(collisionLayer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectSpaceState", "intersect_ray")
+	methodBind := methodBindPhysicsDirectSpaceStateIntersectRay.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -136,19 +137,19 @@ func (o *PhysicsDirectSpaceState) IntersectRay(from gdnative.Vector3, to gdnativ
 }
 
 /*
-        Checks the intersections of a shape, given through a [PhysicsShapeQueryParameters] object, against the space. The intersected shapes are returned in an array containing dictionaries with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. The number of intersections can be limited with the second parameter, to reduce the processing time.
-	Args: [{ false shape PhysicsShapeQueryParameters} {32 true max_results int}], Returns: Array
+	        Checks the intersections of a shape, given through a [PhysicsShapeQueryParameters] object, against the space. The intersected shapes are returned in an array containing dictionaries with the following fields: [code]collider[/code]: The colliding object. [code]collider_id[/code]: The colliding object's ID. [code]rid[/code]: The intersecting object's [RID]. [code]shape[/code]: The shape index of the colliding shape. The number of intersections can be limited with the second parameter, to reduce the processing time.
+		Args: [{ false shape PhysicsShapeQueryParameters} {32 true max_results int}], Returns: Array
 */
 func (o *PhysicsDirectSpaceState) IntersectShape(shape PhysicsShapeQueryParametersImplementer, maxResults gdnative.Int) gdnative.Array {
 	//log.Println("Calling PhysicsDirectSpaceState.IntersectShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 	ptrArguments[1] = gdnative.NewPointerFromInt(maxResults)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsDirectSpaceState", "intersect_shape")
+	methodBind := methodBindPhysicsDirectSpaceStateIntersectShape.Get()
 
 	// Call the parent method.
 	// Array
@@ -160,6 +161,23 @@ func (o *PhysicsDirectSpaceState) IntersectShape(shape PhysicsShapeQueryParamete
 	return ret
 }
 
+// CollideShapeWithDefaults will call CollideShape using the default values for: max_results.
+func (o *PhysicsDirectSpaceState) CollideShapeWithDefaults(shape PhysicsShapeQueryParametersImplementer) gdnative.Array {
+	return o.CollideShape(shape, 32)
+}
+
+// IntersectRayWithDefaults will call IntersectRay using the default values for: exclude, collision_layer.
+func (o *PhysicsDirectSpaceState) IntersectRayWithDefaults(from gdnative.Vector3, to gdnative.Vector3) gdnative.Dictionary {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	return o.IntersectRay(from, to, default0, 2147483647)
+}
+
+// IntersectShapeWithDefaults will call IntersectShape using the default values for: max_results.
+func (o *PhysicsDirectSpaceState) IntersectShapeWithDefaults(shape PhysicsShapeQueryParametersImplementer) gdnative.Array {
+	return o.IntersectShape(shape, 32)
+}
+
 // PhysicsDirectSpaceStateImplementer is an interface that implements the methods
 // of the PhysicsDirectSpaceState class.
 type PhysicsDirectSpaceStateImplementer interface {
@@ -169,4 +187,7 @@ type PhysicsDirectSpaceStateImplementer interface {
 	GetRestInfo(shape PhysicsShapeQueryParametersImplementer) gdnative.Dictionary
 	IntersectRay(from gdnative.Vector3, to gdnative.Vector3, exclude gdnative.Array, collisionLayer gdnative.Int) gdnative.Dictionary
 	IntersectShape(shape PhysicsShapeQueryParametersImplementer, maxResults gdnative.Int) gdnative.Array
+	CollideShapeWithDefaults(shape PhysicsShapeQueryParametersImplementer) gdnative.Array
+	IntersectRayWithDefaults(from gdnative.Vector3, to gdnative.Vector3) gdnative.Dictionary
+	IntersectShapeWithDefaults(shape PhysicsShapeQueryParametersImplementer) gdnative.Array
 }
@@ -13,30 +13,53 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPhysicsShapeQueryParametersFromPointer(ptr gdnative.Pointer) PhysicsShapeQueryParameters {
-func newPhysicsShapeQueryParametersFromPointer(ptr gdnative.Pointer) PhysicsShapeQueryParameters {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PhysicsShapeQueryParameters{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PhysicsShapeQueryParameters struct {
 	Reference
 	owner gdnative.Object
 }
 
+// classConstructorPhysicsShapeQueryParameters is the class constructor of the PhysicsShapeQueryParameters class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPhysicsShapeQueryParameters = gdnative.NewLazyClassConstructor("PhysicsShapeQueryParameters")
+
+// NewPhysicsShapeQueryParameters will create a new instance of the PhysicsShapeQueryParameters class.
+// PhysicsShapeQueryParameters is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPhysicsShapeQueryParameters() *PhysicsShapeQueryParameters {
+	obj := &PhysicsShapeQueryParameters{}
+	obj.SetBaseObject(classConstructorPhysicsShapeQueryParameters.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PhysicsShapeQueryParameters) BaseClass() string {
 	return "PhysicsShapeQueryParameters"
 }
 
+// Method binds of the PhysicsShapeQueryParameters class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPhysicsShapeQueryParametersGetCollisionMask = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "get_collision_mask")
+	methodBindPhysicsShapeQueryParametersGetExclude       = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "get_exclude")
+	methodBindPhysicsShapeQueryParametersGetMargin        = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "get_margin")
+	methodBindPhysicsShapeQueryParametersGetShapeRid      = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "get_shape_rid")
+	methodBindPhysicsShapeQueryParametersGetTransform     = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "get_transform")
+	methodBindPhysicsShapeQueryParametersSetCollisionMask = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "set_collision_mask")
+	methodBindPhysicsShapeQueryParametersSetExclude       = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "set_exclude")
+	methodBindPhysicsShapeQueryParametersSetMargin        = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "set_margin")
+	methodBindPhysicsShapeQueryParametersSetShape         = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "set_shape")
+	methodBindPhysicsShapeQueryParametersSetShapeRid      = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "set_shape_rid")
+	methodBindPhysicsShapeQueryParametersSetTransform     = gdnative.NewLazyMethodBind("PhysicsShapeQueryParameters", "set_transform")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PhysicsShapeQueryParameters) GetCollisionMask() gdnative.Int {
 	//log.Println("Calling PhysicsShapeQueryParameters.GetCollisionMask()")
@@ -45,7 +68,7 @@ func (o *PhysicsShapeQueryParameters) GetCollisionMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "get_collision_mask")
+	methodBind := methodBindPhysicsShapeQueryParametersGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +81,8 @@ func (o *PhysicsShapeQueryParameters) GetCollisionMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *PhysicsShapeQueryParameters) GetExclude() gdnative.Array {
 	//log.Println("Calling PhysicsShapeQueryParameters.GetExclude()")
@@ -68,7 +91,7 @@ func (o *PhysicsShapeQueryParameters) GetExclude() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "get_exclude")
+	methodBind := methodBindPhysicsShapeQueryParametersGetExclude.Get()
 
 	// Call the parent method.
 	// Array
@@ -81,8 +104,8 @@ func (o *PhysicsShapeQueryParameters) GetExclude() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PhysicsShapeQueryParameters) GetMargin() gdnative.Real {
 	//log.Println("Calling PhysicsShapeQueryParameters.GetMargin()")
@@ -91,7 +114,7 @@ func (o *PhysicsShapeQueryParameters) GetMargin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "get_margin")
+	methodBind := methodBindPhysicsShapeQueryParametersGetMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +127,8 @@ func (o *PhysicsShapeQueryParameters) GetMargin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *PhysicsShapeQueryParameters) GetShapeRid() gdnative.Rid {
 	//log.Println("Calling PhysicsShapeQueryParameters.GetShapeRid()")
@@ -114,7 +137,7 @@ func (o *PhysicsShapeQueryParameters) GetShapeRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "get_shape_rid")
+	methodBind := methodBindPhysicsShapeQueryParametersGetShapeRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -127,8 +150,8 @@ func (o *PhysicsShapeQueryParameters) GetShapeRid() gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform
+	        Undocumented
+		Args: [], Returns: Transform
 */
 func (o *PhysicsShapeQueryParameters) GetTransform() gdnative.Transform {
 	//log.Println("Calling PhysicsShapeQueryParameters.GetTransform()")
@@ -137,7 +160,7 @@ func (o *PhysicsShapeQueryParameters) GetTransform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "get_transform")
+	methodBind := methodBindPhysicsShapeQueryParametersGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -150,8 +173,8 @@ func (o *PhysicsShapeQueryParameters) GetTransform() gdnative.Transform {
 }
 
 /*
-        Undocumented
-	Args: [{ false collision_mask int}], Returns: void
+	        Undocumented
+		Args: [{ false collision_mask int}], Returns: void
 */
 func (o *PhysicsShapeQueryParameters) SetCollisionMask(collisionMask gdnative.Int) {
 	//log.Println("Calling PhysicsShapeQueryParameters.SetCollisionMask()")
@@ -161,7 +184,7 @@ func (o *PhysicsShapeQueryParameters) SetCollisionMask(collisionMask gdnative.In
 	ptrArguments[0] = gdnative.NewPointerFromInt(collisionMask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "set_collision_mask")
+	methodBind := methodBindPhysicsShapeQueryParametersSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +194,8 @@ func (o *PhysicsShapeQueryParameters) SetCollisionMask(collisionMask gdnative.In
 }
 
 /*
-        Undocumented
-	Args: [{ false exclude Array}], Returns: void
+	        Undocumented
+		Args: [{ false exclude Array}], Returns: void
 */
 func (o *PhysicsShapeQueryParameters) SetExclude(exclude gdnative.Array) {
 	//log.Println("Calling PhysicsShapeQueryParameters.SetExclude()")
@@ -182,7 +205,7 @@ func (o *PhysicsShapeQueryParameters) SetExclude(exclude gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(exclude)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "set_exclude")
+	methodBind := methodBindPhysicsShapeQueryParametersSetExclude.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +215,8 @@ func (o *PhysicsShapeQueryParameters) SetExclude(exclude gdnative.Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin float}], Returns: void
+	        Undocumented
+		Args: [{ false margin float}], Returns: void
 */
 func (o *PhysicsShapeQueryParameters) SetMargin(margin gdnative.Real) {
 	//log.Println("Calling PhysicsShapeQueryParameters.SetMargin()")
@@ -203,7 +226,7 @@ func (o *PhysicsShapeQueryParameters) SetMargin(margin gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "set_margin")
+	methodBind := methodBindPhysicsShapeQueryParametersSetMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -213,18 +236,17 @@ func (o *PhysicsShapeQueryParameters) SetMargin(margin gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false shape Resource}], Returns: void
+Args: [{ false shape Resource}], Returns: void
 */
 func (o *PhysicsShapeQueryParameters) SetShape(shape ResourceImplementer) {
 	//log.Println("Calling PhysicsShapeQueryParameters.SetShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "set_shape")
+	methodBind := methodBindPhysicsShapeQueryParametersSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +256,8 @@ func (o *PhysicsShapeQueryParameters) SetShape(shape ResourceImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false shape RID}], Returns: void
+	        Undocumented
+		Args: [{ false shape RID}], Returns: void
 */
 func (o *PhysicsShapeQueryParameters) SetShapeRid(shape gdnative.Rid) {
 	//log.Println("Calling PhysicsShapeQueryParameters.SetShapeRid()")
@@ -245,7 +267,7 @@ func (o *PhysicsShapeQueryParameters) SetShapeRid(shape gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(shape)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "set_shape_rid")
+	methodBind := methodBindPhysicsShapeQueryParametersSetShapeRid.Get()
 
 	// Call the parent method.
 	// void
@@ -255,8 +277,8 @@ func (o *PhysicsShapeQueryParameters) SetShapeRid(shape gdnative.Rid) {
 }
 
 /*
-        Undocumented
-	Args: [{ false transform Transform}], Returns: void
+	        Undocumented
+		Args: [{ false transform Transform}], Returns: void
 */
 func (o *PhysicsShapeQueryParameters) SetTransform(transform gdnative.Transform) {
 	//log.Println("Calling PhysicsShapeQueryParameters.SetTransform()")
@@ -266,7 +288,7 @@ func (o *PhysicsShapeQueryParameters) SetTransform(transform gdnative.Transform)
 	ptrArguments[0] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PhysicsShapeQueryParameters", "set_transform")
+	methodBind := methodBindPhysicsShapeQueryParametersSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -275,6 +297,31 @@ func (o *PhysicsShapeQueryParameters) SetTransform(transform gdnative.Transform)
 
 }
 
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *PhysicsShapeQueryParameters) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// Exclude will return the value of the "exclude" property.
+func (o *PhysicsShapeQueryParameters) Exclude() gdnative.Array {
+	return o.GetExclude()
+}
+
+// Margin will return the value of the "margin" property.
+func (o *PhysicsShapeQueryParameters) Margin() gdnative.Real {
+	return o.GetMargin()
+}
+
+// ShapeRid will return the value of the "shape_rid" property.
+func (o *PhysicsShapeQueryParameters) ShapeRid() gdnative.Rid {
+	return o.GetShapeRid()
+}
+
+// Transform will return the value of the "transform" property.
+func (o *PhysicsShapeQueryParameters) Transform() gdnative.Transform {
+	return o.GetTransform()
+}
+
 // PhysicsShapeQueryParametersImplementer is an interface that implements the methods
 // of the PhysicsShapeQueryParameters class.
 type PhysicsShapeQueryParametersImplementer interface {
@@ -290,4 +337,9 @@ type PhysicsShapeQueryParametersImplementer interface {
 	SetShape(shape ResourceImplementer)
 	SetShapeRid(shape gdnative.Rid)
 	SetTransform(transform gdnative.Transform)
+	CollisionMask() gdnative.Int
+	Exclude() gdnative.Array
+	Margin() gdnative.Real
+	ShapeRid() gdnative.Rid
+	Transform() gdnative.Transform
 }
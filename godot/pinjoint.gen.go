@@ -1,98 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-// PinJointParam is an enum for Param values.
-type PinJointParam int
-
-const (
-	PinJointParamBias         PinJointParam = 0
-	PinJointParamDamping      PinJointParam = 1
-	PinJointParamImpulseClamp PinJointParam = 2
-)
-
-//func NewPinJointFromPointer(ptr gdnative.Pointer) PinJoint {
-func newPinJointFromPointer(ptr gdnative.Pointer) PinJoint {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PinJoint{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Pin Joint for 3D Rigid Bodies. It pins 2 bodies (rigid or static) together.
-*/
-type PinJoint struct {
-	Joint
-	owner gdnative.Object
-}
-
-func (o *PinJoint) BaseClass() string {
-	return "PinJoint"
-}
-
-/*
-        Undocumented
-	Args: [{ false param int}], Returns: float
-*/
-func (o *PinJoint) GetParam(param gdnative.Int) gdnative.Real {
-	//log.Println("Calling PinJoint.GetParam()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(param)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PinJoint", "get_param")
-
-	// Call the parent method.
-	// float
-	retPtr := gdnative.NewEmptyReal()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewRealFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [{ false param int} { false value float}], Returns: void
-*/
-func (o *PinJoint) SetParam(param gdnative.Int, value gdnative.Real) {
-	//log.Println("Calling PinJoint.SetParam()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromInt(param)
-	ptrArguments[1] = gdnative.NewPointerFromReal(value)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PinJoint", "set_param")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-// PinJointImplementer is an interface that implements the methods
-// of the PinJoint class.
-type PinJointImplementer interface {
-	JointImplementer
-	GetParam(param gdnative.Int) gdnative.Real
-	SetParam(param gdnative.Int, value gdnative.Real)
-}
@@ -1,41 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewPluginScriptFromPointer(ptr gdnative.Pointer) PluginScript {
-func newPluginScriptFromPointer(ptr gdnative.Pointer) PluginScript {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PluginScript{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Undocumented
-*/
-type PluginScript struct {
-	Script
-	owner gdnative.Object
-}
-
-func (o *PluginScript) BaseClass() string {
-	return "PluginScript"
-}
-
-// PluginScriptImplementer is an interface that implements the methods
-// of the PluginScript class.
-type PluginScriptImplementer interface {
-	ScriptImplementer
-}
@@ -0,0 +1,276 @@
+package popup
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// Constants of the Popup class.
+const (
+	PopupNotificationPopupHide gdnative.Int = 81
+	PopupNotificationPostPopup gdnative.Int = 80
+)
+
+/*
+Popup is a base [Control] used to show dialogs and popups. It's a subwindow and modal by default (see [Control]) and has helpers for custom popup behavior.
+*/
+type Popup struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorPopup is the class constructor of the Popup class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPopup = gdnative.NewLazyClassConstructor("Popup")
+
+// NewPopup will create a new instance of the Popup class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPopup() *Popup {
+	obj := &Popup{}
+	obj.SetBaseObject(classConstructorPopup.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Popup) BaseClass() string {
+	return "Popup"
+}
+
+// Method binds of the Popup class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPopupIsExclusive          = gdnative.NewLazyMethodBind("Popup", "is_exclusive")
+	methodBindPopupPopupMethod          = gdnative.NewLazyMethodBind("Popup", "popup")
+	methodBindPopupPopupCentered        = gdnative.NewLazyMethodBind("Popup", "popup_centered")
+	methodBindPopupPopupCenteredMinsize = gdnative.NewLazyMethodBind("Popup", "popup_centered_minsize")
+	methodBindPopupPopupCenteredRatio   = gdnative.NewLazyMethodBind("Popup", "popup_centered_ratio")
+	methodBindPopupSetExclusive         = gdnative.NewLazyMethodBind("Popup", "set_exclusive")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Popup) IsExclusive() gdnative.Bool {
+	//log.Println("Calling Popup.IsExclusive()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPopupIsExclusive.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Popup (show the control in modal form).
+		Args: [{(0, 0, 0, 0) true bounds Rect2}], Returns: void
+*/
+func (o *Popup) PopupMethod(bounds gdnative.Rect2) {
+	//log.Println("Calling Popup.PopupMethod()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromRect2(bounds)
+
+	// Get the method bind
+	methodBind := methodBindPopupPopupMethod.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Popup (show the control in modal form) in the center of the screen, at the current size, or at a size determined by "size".
+		Args: [{(0, 0) true size Vector2}], Returns: void
+*/
+func (o *Popup) PopupCentered(size gdnative.Vector2) {
+	//log.Println("Calling Popup.PopupCentered()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
+
+	// Get the method bind
+	methodBind := methodBindPopupPopupCentered.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Popup (show the control in modal form) in the center of the screen, ensuring the size is never smaller than [code]minsize[/code].
+		Args: [{(0, 0) true minsize Vector2}], Returns: void
+*/
+func (o *Popup) PopupCenteredMinsize(minsize gdnative.Vector2) {
+	//log.Println("Calling Popup.PopupCenteredMinsize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(minsize)
+
+	// Get the method bind
+	methodBind := methodBindPopupPopupCenteredMinsize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Popup (show the control in modal form) in the center of the screen, scaled at a ratio of size of the screen.
+		Args: [{0.75 true ratio float}], Returns: void
+*/
+func (o *Popup) PopupCenteredRatio(ratio gdnative.Real) {
+	//log.Println("Calling Popup.PopupCenteredRatio()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(ratio)
+
+	// Get the method bind
+	methodBind := methodBindPopupPopupCenteredRatio.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Popup) SetExclusive(enable gdnative.Bool) {
+	//log.Println("Calling Popup.SetExclusive()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindPopupSetExclusive.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// PopupMethodWithDefaults will call PopupMethod using the default values for: bounds.
+func (o *Popup) PopupMethodWithDefaults() {
+	o.PopupMethod(gdnative.NewRect2(0, 0, 0, 0))
+}
+
+// PopupCenteredWithDefaults will call PopupCentered using the default values for: size.
+func (o *Popup) PopupCenteredWithDefaults() {
+	o.PopupCentered(gdnative.NewVector2(0, 0))
+}
+
+// PopupCenteredMinsizeWithDefaults will call PopupCenteredMinsize using the default values for: minsize.
+func (o *Popup) PopupCenteredMinsizeWithDefaults() {
+	o.PopupCenteredMinsize(gdnative.NewVector2(0, 0))
+}
+
+// PopupCenteredRatioWithDefaults will call PopupCenteredRatio using the default values for: ratio.
+func (o *Popup) PopupCenteredRatioWithDefaults() {
+	o.PopupCenteredRatio(0.75)
+}
+
+// PopupExclusive will return the value of the "popup_exclusive" property.
+func (o *Popup) PopupExclusive() gdnative.Bool {
+	return o.IsExclusive()
+}
+
+// SetPopupExclusive will set the value of the "popup_exclusive" property.
+func (o *Popup) SetPopupExclusive(value gdnative.Bool) {
+	o.SetExclusive(value)
+}
+
+// Signals of the Popup class.
+const (
+	PopupSignalAboutToShow gdnative.String = "about_to_show"
+	PopupSignalPopupHide   gdnative.String = "popup_hide"
+)
+
+// ConnectAboutToShow will connect the "about_to_show" signal to the given method of the target object.
+func (o *Popup) ConnectAboutToShow(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(PopupSignalAboutToShow, target, method, binds, 0)
+}
+
+// EmitAboutToShow will emit the "about_to_show" signal.
+func (o *Popup) EmitAboutToShow() error {
+
+	ret, err := o.EmitSignal(PopupSignalAboutToShow)
+	ret.Destroy()
+	return err
+}
+
+// ConnectPopupHide will connect the "popup_hide" signal to the given method of the target object.
+func (o *Popup) ConnectPopupHide(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(PopupSignalPopupHide, target, method, binds, 0)
+}
+
+// EmitPopupHide will emit the "popup_hide" signal.
+func (o *Popup) EmitPopupHide() error {
+
+	ret, err := o.EmitSignal(PopupSignalPopupHide)
+	ret.Destroy()
+	return err
+}
+
+// PopupImplementer is an interface that implements the methods
+// of the Popup class.
+type PopupImplementer interface {
+	godot.ControlImplementer
+	IsExclusive() gdnative.Bool
+	PopupMethod(bounds gdnative.Rect2)
+	PopupCentered(size gdnative.Vector2)
+	PopupCenteredMinsize(minsize gdnative.Vector2)
+	PopupCenteredRatio(ratio gdnative.Real)
+	SetExclusive(enable gdnative.Bool)
+	PopupMethodWithDefaults()
+	PopupCenteredWithDefaults()
+	PopupCenteredMinsizeWithDefaults()
+	PopupCenteredRatioWithDefaults()
+	PopupExclusive() gdnative.Bool
+	SetPopupExclusive(value gdnative.Bool)
+	ConnectAboutToShow(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAboutToShow() error
+	ConnectPopupHide(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPopupHide() error
+}
@@ -1,7 +1,8 @@
-package godot
+package popup
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPopupDialogFromPointer(ptr gdnative.Pointer) PopupDialog {
-func newPopupDialogFromPointer(ptr gdnative.Pointer) PopupDialog {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PopupDialog{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 PopupDialog is a base class for popup dialogs, along with [WindowDialog].
 */
@@ -30,6 +22,20 @@ type PopupDialog struct {
 	owner gdnative.Object
 }
 
+// classConstructorPopupDialog is the class constructor of the PopupDialog class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPopupDialog = gdnative.NewLazyClassConstructor("PopupDialog")
+
+// NewPopupDialog will create a new instance of the PopupDialog class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPopupDialog() *PopupDialog {
+	obj := &PopupDialog{}
+	obj.SetBaseObject(classConstructorPopupDialog.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PopupDialog) BaseClass() string {
 	return "PopupDialog"
 }
@@ -1,7 +1,8 @@
-package godot
+package popup
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPopupMenuFromPointer(ptr gdnative.Pointer) PopupMenu {
-func newPopupMenuFromPointer(ptr gdnative.Pointer) PopupMenu {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PopupMenu{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 PopupMenu is the typical Control that displays a list of options. They are popular in toolbars or context menus.
 */
@@ -30,13 +22,83 @@ type PopupMenu struct {
 	owner gdnative.Object
 }
 
+// classConstructorPopupMenu is the class constructor of the PopupMenu class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPopupMenu = gdnative.NewLazyClassConstructor("PopupMenu")
+
+// NewPopupMenu will create a new instance of the PopupMenu class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPopupMenu() *PopupMenu {
+	obj := &PopupMenu{}
+	obj.SetBaseObject(classConstructorPopupMenu.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PopupMenu) BaseClass() string {
 	return "PopupMenu"
 }
 
+// Method binds of the PopupMenu class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPopupMenuX_GetItems                      = gdnative.NewLazyMethodBind("PopupMenu", "_get_items")
+	methodBindPopupMenuX_GuiInput                      = gdnative.NewLazyMethodBind("PopupMenu", "_gui_input")
+	methodBindPopupMenuX_SetItems                      = gdnative.NewLazyMethodBind("PopupMenu", "_set_items")
+	methodBindPopupMenuX_SubmenuTimeout                = gdnative.NewLazyMethodBind("PopupMenu", "_submenu_timeout")
+	methodBindPopupMenuAddCheckItem                    = gdnative.NewLazyMethodBind("PopupMenu", "add_check_item")
+	methodBindPopupMenuAddCheckShortcut                = gdnative.NewLazyMethodBind("PopupMenu", "add_check_shortcut")
+	methodBindPopupMenuAddIconCheckItem                = gdnative.NewLazyMethodBind("PopupMenu", "add_icon_check_item")
+	methodBindPopupMenuAddIconCheckShortcut            = gdnative.NewLazyMethodBind("PopupMenu", "add_icon_check_shortcut")
+	methodBindPopupMenuAddIconItem                     = gdnative.NewLazyMethodBind("PopupMenu", "add_icon_item")
+	methodBindPopupMenuAddIconShortcut                 = gdnative.NewLazyMethodBind("PopupMenu", "add_icon_shortcut")
+	methodBindPopupMenuAddItem                         = gdnative.NewLazyMethodBind("PopupMenu", "add_item")
+	methodBindPopupMenuAddSeparator                    = gdnative.NewLazyMethodBind("PopupMenu", "add_separator")
+	methodBindPopupMenuAddShortcut                     = gdnative.NewLazyMethodBind("PopupMenu", "add_shortcut")
+	methodBindPopupMenuAddSubmenuItem                  = gdnative.NewLazyMethodBind("PopupMenu", "add_submenu_item")
+	methodBindPopupMenuClear                           = gdnative.NewLazyMethodBind("PopupMenu", "clear")
+	methodBindPopupMenuGetItemAccelerator              = gdnative.NewLazyMethodBind("PopupMenu", "get_item_accelerator")
+	methodBindPopupMenuGetItemCount                    = gdnative.NewLazyMethodBind("PopupMenu", "get_item_count")
+	methodBindPopupMenuGetItemIcon                     = gdnative.NewLazyMethodBind("PopupMenu", "get_item_icon")
+	methodBindPopupMenuGetItemId                       = gdnative.NewLazyMethodBind("PopupMenu", "get_item_id")
+	methodBindPopupMenuGetItemIndex                    = gdnative.NewLazyMethodBind("PopupMenu", "get_item_index")
+	methodBindPopupMenuGetItemMetadata                 = gdnative.NewLazyMethodBind("PopupMenu", "get_item_metadata")
+	methodBindPopupMenuGetItemShortcut                 = gdnative.NewLazyMethodBind("PopupMenu", "get_item_shortcut")
+	methodBindPopupMenuGetItemSubmenu                  = gdnative.NewLazyMethodBind("PopupMenu", "get_item_submenu")
+	methodBindPopupMenuGetItemText                     = gdnative.NewLazyMethodBind("PopupMenu", "get_item_text")
+	methodBindPopupMenuGetItemTooltip                  = gdnative.NewLazyMethodBind("PopupMenu", "get_item_tooltip")
+	methodBindPopupMenuIsHideOnCheckableItemSelection  = gdnative.NewLazyMethodBind("PopupMenu", "is_hide_on_checkable_item_selection")
+	methodBindPopupMenuIsHideOnItemSelection           = gdnative.NewLazyMethodBind("PopupMenu", "is_hide_on_item_selection")
+	methodBindPopupMenuIsHideOnStateItemSelection      = gdnative.NewLazyMethodBind("PopupMenu", "is_hide_on_state_item_selection")
+	methodBindPopupMenuIsItemCheckable                 = gdnative.NewLazyMethodBind("PopupMenu", "is_item_checkable")
+	methodBindPopupMenuIsItemChecked                   = gdnative.NewLazyMethodBind("PopupMenu", "is_item_checked")
+	methodBindPopupMenuIsItemDisabled                  = gdnative.NewLazyMethodBind("PopupMenu", "is_item_disabled")
+	methodBindPopupMenuIsItemSeparator                 = gdnative.NewLazyMethodBind("PopupMenu", "is_item_separator")
+	methodBindPopupMenuRemoveItem                      = gdnative.NewLazyMethodBind("PopupMenu", "remove_item")
+	methodBindPopupMenuSetHideOnCheckableItemSelection = gdnative.NewLazyMethodBind("PopupMenu", "set_hide_on_checkable_item_selection")
+	methodBindPopupMenuSetHideOnItemSelection          = gdnative.NewLazyMethodBind("PopupMenu", "set_hide_on_item_selection")
+	methodBindPopupMenuSetHideOnStateItemSelection     = gdnative.NewLazyMethodBind("PopupMenu", "set_hide_on_state_item_selection")
+	methodBindPopupMenuSetItemAccelerator              = gdnative.NewLazyMethodBind("PopupMenu", "set_item_accelerator")
+	methodBindPopupMenuSetItemAsCheckable              = gdnative.NewLazyMethodBind("PopupMenu", "set_item_as_checkable")
+	methodBindPopupMenuSetItemAsSeparator              = gdnative.NewLazyMethodBind("PopupMenu", "set_item_as_separator")
+	methodBindPopupMenuSetItemChecked                  = gdnative.NewLazyMethodBind("PopupMenu", "set_item_checked")
+	methodBindPopupMenuSetItemDisabled                 = gdnative.NewLazyMethodBind("PopupMenu", "set_item_disabled")
+	methodBindPopupMenuSetItemIcon                     = gdnative.NewLazyMethodBind("PopupMenu", "set_item_icon")
+	methodBindPopupMenuSetItemId                       = gdnative.NewLazyMethodBind("PopupMenu", "set_item_id")
+	methodBindPopupMenuSetItemMetadata                 = gdnative.NewLazyMethodBind("PopupMenu", "set_item_metadata")
+	methodBindPopupMenuSetItemMultistate               = gdnative.NewLazyMethodBind("PopupMenu", "set_item_multistate")
+	methodBindPopupMenuSetItemShortcut                 = gdnative.NewLazyMethodBind("PopupMenu", "set_item_shortcut")
+	methodBindPopupMenuSetItemSubmenu                  = gdnative.NewLazyMethodBind("PopupMenu", "set_item_submenu")
+	methodBindPopupMenuSetItemText                     = gdnative.NewLazyMethodBind("PopupMenu", "set_item_text")
+	methodBindPopupMenuSetItemTooltip                  = gdnative.NewLazyMethodBind("PopupMenu", "set_item_tooltip")
+	methodBindPopupMenuToggleItemChecked               = gdnative.NewLazyMethodBind("PopupMenu", "toggle_item_checked")
+	methodBindPopupMenuToggleItemMultistate            = gdnative.NewLazyMethodBind("PopupMenu", "toggle_item_multistate")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *PopupMenu) X_GetItems() gdnative.Array {
 	//log.Println("Calling PopupMenu.X_GetItems()")
@@ -45,7 +107,7 @@ func (o *PopupMenu) X_GetItems() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "_get_items")
+	methodBind := methodBindPopupMenuX_GetItems.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,18 +120,18 @@ func (o *PopupMenu) X_GetItems() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
-func (o *PopupMenu) X_GuiInput(arg0 InputEventImplementer) {
+func (o *PopupMenu) X_GuiInput(arg0 godot.InputEventImplementer) {
 	//log.Println("Calling PopupMenu.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "_gui_input")
+	methodBind := methodBindPopupMenuX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +141,8 @@ func (o *PopupMenu) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
 */
 func (o *PopupMenu) X_SetItems(arg0 gdnative.Array) {
 	//log.Println("Calling PopupMenu.X_SetItems()")
@@ -90,7 +152,7 @@ func (o *PopupMenu) X_SetItems(arg0 gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "_set_items")
+	methodBind := methodBindPopupMenuX_SetItems.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +162,8 @@ func (o *PopupMenu) X_SetItems(arg0 gdnative.Array) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *PopupMenu) X_SubmenuTimeout() {
 	//log.Println("Calling PopupMenu.X_SubmenuTimeout()")
@@ -110,7 +172,7 @@ func (o *PopupMenu) X_SubmenuTimeout() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "_submenu_timeout")
+	methodBind := methodBindPopupMenuX_SubmenuTimeout.Get()
 
 	// Call the parent method.
 	// void
@@ -120,8 +182,8 @@ func (o *PopupMenu) X_SubmenuTimeout() {
 }
 
 /*
-        Add a new checkable item with text "label". An id can optionally be provided, as well as an accelerator. If no id is provided, one will be created from the index. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
-	Args: [{ false label String} {-1 true id int} {0 true accel int}], Returns: void
+	        Add a new checkable item with text "label". An id can optionally be provided, as well as an accelerator. If no id is provided, one will be created from the index. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
+		Args: [{ false label String} {-1 true id int} {0 true accel int}], Returns: void
 */
 func (o *PopupMenu) AddCheckItem(label gdnative.String, id gdnative.Int, accel gdnative.Int) {
 	//log.Println("Calling PopupMenu.AddCheckItem()")
@@ -133,7 +195,7 @@ func (o *PopupMenu) AddCheckItem(label gdnative.String, id gdnative.Int, accel g
 	ptrArguments[2] = gdnative.NewPointerFromInt(accel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_check_item")
+	methodBind := methodBindPopupMenuAddCheckItem.Get()
 
 	// Call the parent method.
 	// void
@@ -143,20 +205,19 @@ func (o *PopupMenu) AddCheckItem(label gdnative.String, id gdnative.Int, accel g
 }
 
 /*
-
-	Args: [{ false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
+Args: [{ false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
 */
-func (o *PopupMenu) AddCheckShortcut(shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
+func (o *PopupMenu) AddCheckShortcut(shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
 	//log.Println("Calling PopupMenu.AddCheckShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shortcut.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(shortcut))
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 	ptrArguments[2] = gdnative.NewPointerFromBool(global)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_check_shortcut")
+	methodBind := methodBindPopupMenuAddCheckShortcut.Get()
 
 	// Call the parent method.
 	// void
@@ -166,21 +227,21 @@ func (o *PopupMenu) AddCheckShortcut(shortcut ShortCutImplementer, id gdnative.I
 }
 
 /*
-        Add a new checkable item with text "label" and icon "texture". An id can optionally be provided, as well as an accelerator. If no id is provided, one will be created from the index. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
-	Args: [{ false texture Texture} { false label String} {-1 true id int} {0 true accel int}], Returns: void
+	        Add a new checkable item with text "label" and icon "texture". An id can optionally be provided, as well as an accelerator. If no id is provided, one will be created from the index. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
+		Args: [{ false texture Texture} { false label String} {-1 true id int} {0 true accel int}], Returns: void
 */
-func (o *PopupMenu) AddIconCheckItem(texture TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int) {
+func (o *PopupMenu) AddIconCheckItem(texture godot.TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int) {
 	//log.Println("Calling PopupMenu.AddIconCheckItem()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 	ptrArguments[1] = gdnative.NewPointerFromString(label)
 	ptrArguments[2] = gdnative.NewPointerFromInt(id)
 	ptrArguments[3] = gdnative.NewPointerFromInt(accel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_icon_check_item")
+	methodBind := methodBindPopupMenuAddIconCheckItem.Get()
 
 	// Call the parent method.
 	// void
@@ -190,21 +251,20 @@ func (o *PopupMenu) AddIconCheckItem(texture TextureImplementer, label gdnative.
 }
 
 /*
-
-	Args: [{ false texture Texture} { false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
+Args: [{ false texture Texture} { false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
 */
-func (o *PopupMenu) AddIconCheckShortcut(texture TextureImplementer, shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
+func (o *PopupMenu) AddIconCheckShortcut(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
 	//log.Println("Calling PopupMenu.AddIconCheckShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
-	ptrArguments[1] = gdnative.NewPointerFromObject(shortcut.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(shortcut))
 	ptrArguments[2] = gdnative.NewPointerFromInt(id)
 	ptrArguments[3] = gdnative.NewPointerFromBool(global)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_icon_check_shortcut")
+	methodBind := methodBindPopupMenuAddIconCheckShortcut.Get()
 
 	// Call the parent method.
 	// void
@@ -214,21 +274,21 @@ func (o *PopupMenu) AddIconCheckShortcut(texture TextureImplementer, shortcut Sh
 }
 
 /*
-        Add a new item with text "label" and icon "texture". An id can optionally be provided, as well as an accelerator keybinding. If no id is provided, one will be created from the index.
-	Args: [{ false texture Texture} { false label String} {-1 true id int} {0 true accel int}], Returns: void
+	        Add a new item with text "label" and icon "texture". An id can optionally be provided, as well as an accelerator keybinding. If no id is provided, one will be created from the index.
+		Args: [{ false texture Texture} { false label String} {-1 true id int} {0 true accel int}], Returns: void
 */
-func (o *PopupMenu) AddIconItem(texture TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int) {
+func (o *PopupMenu) AddIconItem(texture godot.TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int) {
 	//log.Println("Calling PopupMenu.AddIconItem()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 	ptrArguments[1] = gdnative.NewPointerFromString(label)
 	ptrArguments[2] = gdnative.NewPointerFromInt(id)
 	ptrArguments[3] = gdnative.NewPointerFromInt(accel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_icon_item")
+	methodBind := methodBindPopupMenuAddIconItem.Get()
 
 	// Call the parent method.
 	// void
@@ -238,21 +298,20 @@ func (o *PopupMenu) AddIconItem(texture TextureImplementer, label gdnative.Strin
 }
 
 /*
-
-	Args: [{ false texture Texture} { false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
+Args: [{ false texture Texture} { false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
 */
-func (o *PopupMenu) AddIconShortcut(texture TextureImplementer, shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
+func (o *PopupMenu) AddIconShortcut(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
 	//log.Println("Calling PopupMenu.AddIconShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
-	ptrArguments[1] = gdnative.NewPointerFromObject(shortcut.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(shortcut))
 	ptrArguments[2] = gdnative.NewPointerFromInt(id)
 	ptrArguments[3] = gdnative.NewPointerFromBool(global)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_icon_shortcut")
+	methodBind := methodBindPopupMenuAddIconShortcut.Get()
 
 	// Call the parent method.
 	// void
@@ -262,8 +321,8 @@ func (o *PopupMenu) AddIconShortcut(texture TextureImplementer, shortcut ShortCu
 }
 
 /*
-        Add a new item with text "label". An id can optionally be provided, as well as an accelerator keybinding. If no id is provided, one will be created from the index.
-	Args: [{ false label String} {-1 true id int} {0 true accel int}], Returns: void
+	        Add a new item with text "label". An id can optionally be provided, as well as an accelerator keybinding. If no id is provided, one will be created from the index.
+		Args: [{ false label String} {-1 true id int} {0 true accel int}], Returns: void
 */
 func (o *PopupMenu) AddItem(label gdnative.String, id gdnative.Int, accel gdnative.Int) {
 	//log.Println("Calling PopupMenu.AddItem()")
@@ -275,7 +334,7 @@ func (o *PopupMenu) AddItem(label gdnative.String, id gdnative.Int, accel gdnati
 	ptrArguments[2] = gdnative.NewPointerFromInt(accel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_item")
+	methodBind := methodBindPopupMenuAddItem.Get()
 
 	// Call the parent method.
 	// void
@@ -285,8 +344,8 @@ func (o *PopupMenu) AddItem(label gdnative.String, id gdnative.Int, accel gdnati
 }
 
 /*
-        Add a separator between items. Separators also occupy an index.
-	Args: [], Returns: void
+	        Add a separator between items. Separators also occupy an index.
+		Args: [], Returns: void
 */
 func (o *PopupMenu) AddSeparator() {
 	//log.Println("Calling PopupMenu.AddSeparator()")
@@ -295,7 +354,7 @@ func (o *PopupMenu) AddSeparator() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_separator")
+	methodBind := methodBindPopupMenuAddSeparator.Get()
 
 	// Call the parent method.
 	// void
@@ -305,20 +364,19 @@ func (o *PopupMenu) AddSeparator() {
 }
 
 /*
-
-	Args: [{ false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
+Args: [{ false shortcut ShortCut} {-1 true id int} {False true global bool}], Returns: void
 */
-func (o *PopupMenu) AddShortcut(shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
+func (o *PopupMenu) AddShortcut(shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool) {
 	//log.Println("Calling PopupMenu.AddShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shortcut.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(shortcut))
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 	ptrArguments[2] = gdnative.NewPointerFromBool(global)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_shortcut")
+	methodBind := methodBindPopupMenuAddShortcut.Get()
 
 	// Call the parent method.
 	// void
@@ -328,8 +386,8 @@ func (o *PopupMenu) AddShortcut(shortcut ShortCutImplementer, id gdnative.Int, g
 }
 
 /*
-        Adds an item with a submenu. The submenu is the name of a child PopupMenu node that would be shown when the item is clicked. An id can optionally be provided, but if is isn't provided, one will be created from the index.
-	Args: [{ false label String} { false submenu String} {-1 true id int}], Returns: void
+	        Adds an item with a submenu. The submenu is the name of a child PopupMenu node that would be shown when the item is clicked. An id can optionally be provided, but if is isn't provided, one will be created from the index.
+		Args: [{ false label String} { false submenu String} {-1 true id int}], Returns: void
 */
 func (o *PopupMenu) AddSubmenuItem(label gdnative.String, submenu gdnative.String, id gdnative.Int) {
 	//log.Println("Calling PopupMenu.AddSubmenuItem()")
@@ -341,7 +399,7 @@ func (o *PopupMenu) AddSubmenuItem(label gdnative.String, submenu gdnative.Strin
 	ptrArguments[2] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "add_submenu_item")
+	methodBind := methodBindPopupMenuAddSubmenuItem.Get()
 
 	// Call the parent method.
 	// void
@@ -351,8 +409,8 @@ func (o *PopupMenu) AddSubmenuItem(label gdnative.String, submenu gdnative.Strin
 }
 
 /*
-        Clear the popup menu, in effect removing all items.
-	Args: [], Returns: void
+	        Clear the popup menu, in effect removing all items.
+		Args: [], Returns: void
 */
 func (o *PopupMenu) Clear() {
 	//log.Println("Calling PopupMenu.Clear()")
@@ -361,7 +419,7 @@ func (o *PopupMenu) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "clear")
+	methodBind := methodBindPopupMenuClear.Get()
 
 	// Call the parent method.
 	// void
@@ -371,8 +429,8 @@ func (o *PopupMenu) Clear() {
 }
 
 /*
-        Return the accelerator of the item at index "idx". Accelerators are special combinations of keys that activate the item, no matter which control is focused.
-	Args: [{ false idx int}], Returns: int
+	        Return the accelerator of the item at index "idx". Accelerators are special combinations of keys that activate the item, no matter which control is focused.
+		Args: [{ false idx int}], Returns: int
 */
 func (o *PopupMenu) GetItemAccelerator(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling PopupMenu.GetItemAccelerator()")
@@ -382,7 +440,7 @@ func (o *PopupMenu) GetItemAccelerator(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_accelerator")
+	methodBind := methodBindPopupMenuGetItemAccelerator.Get()
 
 	// Call the parent method.
 	// int
@@ -395,8 +453,8 @@ func (o *PopupMenu) GetItemAccelerator(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return the amount of items.
-	Args: [], Returns: int
+	        Return the amount of items.
+		Args: [], Returns: int
 */
 func (o *PopupMenu) GetItemCount() gdnative.Int {
 	//log.Println("Calling PopupMenu.GetItemCount()")
@@ -405,7 +463,7 @@ func (o *PopupMenu) GetItemCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_count")
+	methodBind := methodBindPopupMenuGetItemCount.Get()
 
 	// Call the parent method.
 	// int
@@ -418,10 +476,10 @@ func (o *PopupMenu) GetItemCount() gdnative.Int {
 }
 
 /*
-        Return the icon of the item at index "idx".
-	Args: [{ false idx int}], Returns: Texture
+	        Return the icon of the item at index "idx".
+		Args: [{ false idx int}], Returns: Texture
 */
-func (o *PopupMenu) GetItemIcon(idx gdnative.Int) TextureImplementer {
+func (o *PopupMenu) GetItemIcon(idx gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling PopupMenu.GetItemIcon()")
 
 	// Build out the method's arguments
@@ -429,7 +487,7 @@ func (o *PopupMenu) GetItemIcon(idx gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_icon")
+	methodBind := methodBindPopupMenuGetItemIcon.Get()
 
 	// Call the parent method.
 	// Texture
@@ -437,27 +495,25 @@ func (o *PopupMenu) GetItemIcon(idx gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the id of the item at index "idx".
-	Args: [{ false idx int}], Returns: int
+	        Return the id of the item at index "idx".
+		Args: [{ false idx int}], Returns: int
 */
 func (o *PopupMenu) GetItemId(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling PopupMenu.GetItemId()")
@@ -467,7 +523,7 @@ func (o *PopupMenu) GetItemId(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_id")
+	methodBind := methodBindPopupMenuGetItemId.Get()
 
 	// Call the parent method.
 	// int
@@ -480,8 +536,8 @@ func (o *PopupMenu) GetItemId(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Find and return the index of the item containing a given id.
-	Args: [{ false id int}], Returns: int
+	        Find and return the index of the item containing a given id.
+		Args: [{ false id int}], Returns: int
 */
 func (o *PopupMenu) GetItemIndex(id gdnative.Int) gdnative.Int {
 	//log.Println("Calling PopupMenu.GetItemIndex()")
@@ -491,7 +547,7 @@ func (o *PopupMenu) GetItemIndex(id gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_index")
+	methodBind := methodBindPopupMenuGetItemIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -504,8 +560,8 @@ func (o *PopupMenu) GetItemIndex(id gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return the metadata of an item, which might be of any type. You can set it with [method set_item_metadata], which provides a simple way of assigning context data to items.
-	Args: [{ false idx int}], Returns: Variant
+	        Return the metadata of an item, which might be of any type. You can set it with [method set_item_metadata], which provides a simple way of assigning context data to items.
+		Args: [{ false idx int}], Returns: Variant
 */
 func (o *PopupMenu) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling PopupMenu.GetItemMetadata()")
@@ -515,7 +571,7 @@ func (o *PopupMenu) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_metadata")
+	methodBind := methodBindPopupMenuGetItemMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -528,10 +584,9 @@ func (o *PopupMenu) GetItemMetadata(idx gdnative.Int) gdnative.Variant {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: ShortCut
+Args: [{ false idx int}], Returns: ShortCut
 */
-func (o *PopupMenu) GetItemShortcut(idx gdnative.Int) ShortCutImplementer {
+func (o *PopupMenu) GetItemShortcut(idx gdnative.Int) godot.ShortCutImplementer {
 	//log.Println("Calling PopupMenu.GetItemShortcut()")
 
 	// Build out the method's arguments
@@ -539,7 +594,7 @@ func (o *PopupMenu) GetItemShortcut(idx gdnative.Int) ShortCutImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_shortcut")
+	methodBind := methodBindPopupMenuGetItemShortcut.Get()
 
 	// Call the parent method.
 	// ShortCut
@@ -547,27 +602,25 @@ func (o *PopupMenu) GetItemShortcut(idx gdnative.Int) ShortCutImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShortCutFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShortCutImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ShortCut" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShortCutImplementer)
+	ret := godot.ShortCut{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ShortCutImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the submenu name of the item at index "idx".
-	Args: [{ false idx int}], Returns: String
+	        Return the submenu name of the item at index "idx".
+		Args: [{ false idx int}], Returns: String
 */
 func (o *PopupMenu) GetItemSubmenu(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling PopupMenu.GetItemSubmenu()")
@@ -577,7 +630,7 @@ func (o *PopupMenu) GetItemSubmenu(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_submenu")
+	methodBind := methodBindPopupMenuGetItemSubmenu.Get()
 
 	// Call the parent method.
 	// String
@@ -590,8 +643,8 @@ func (o *PopupMenu) GetItemSubmenu(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Return the text of the item at index "idx".
-	Args: [{ false idx int}], Returns: String
+	        Return the text of the item at index "idx".
+		Args: [{ false idx int}], Returns: String
 */
 func (o *PopupMenu) GetItemText(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling PopupMenu.GetItemText()")
@@ -601,7 +654,7 @@ func (o *PopupMenu) GetItemText(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_text")
+	methodBind := methodBindPopupMenuGetItemText.Get()
 
 	// Call the parent method.
 	// String
@@ -614,8 +667,7 @@ func (o *PopupMenu) GetItemText(idx gdnative.Int) gdnative.String {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: String
+Args: [{ false idx int}], Returns: String
 */
 func (o *PopupMenu) GetItemTooltip(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling PopupMenu.GetItemTooltip()")
@@ -625,7 +677,7 @@ func (o *PopupMenu) GetItemTooltip(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "get_item_tooltip")
+	methodBind := methodBindPopupMenuGetItemTooltip.Get()
 
 	// Call the parent method.
 	// String
@@ -638,8 +690,8 @@ func (o *PopupMenu) GetItemTooltip(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PopupMenu) IsHideOnCheckableItemSelection() gdnative.Bool {
 	//log.Println("Calling PopupMenu.IsHideOnCheckableItemSelection()")
@@ -648,7 +700,7 @@ func (o *PopupMenu) IsHideOnCheckableItemSelection() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "is_hide_on_checkable_item_selection")
+	methodBind := methodBindPopupMenuIsHideOnCheckableItemSelection.Get()
 
 	// Call the parent method.
 	// bool
@@ -661,8 +713,8 @@ func (o *PopupMenu) IsHideOnCheckableItemSelection() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PopupMenu) IsHideOnItemSelection() gdnative.Bool {
 	//log.Println("Calling PopupMenu.IsHideOnItemSelection()")
@@ -671,7 +723,7 @@ func (o *PopupMenu) IsHideOnItemSelection() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "is_hide_on_item_selection")
+	methodBind := methodBindPopupMenuIsHideOnItemSelection.Get()
 
 	// Call the parent method.
 	// bool
@@ -684,8 +736,8 @@ func (o *PopupMenu) IsHideOnItemSelection() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PopupMenu) IsHideOnStateItemSelection() gdnative.Bool {
 	//log.Println("Calling PopupMenu.IsHideOnStateItemSelection()")
@@ -694,7 +746,7 @@ func (o *PopupMenu) IsHideOnStateItemSelection() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "is_hide_on_state_item_selection")
+	methodBind := methodBindPopupMenuIsHideOnStateItemSelection.Get()
 
 	// Call the parent method.
 	// bool
@@ -707,8 +759,8 @@ func (o *PopupMenu) IsHideOnStateItemSelection() gdnative.Bool {
 }
 
 /*
-        Return whether the item at index "idx" has a checkbox. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
-	Args: [{ false idx int}], Returns: bool
+	        Return whether the item at index "idx" has a checkbox. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *PopupMenu) IsItemCheckable(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PopupMenu.IsItemCheckable()")
@@ -718,7 +770,7 @@ func (o *PopupMenu) IsItemCheckable(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "is_item_checkable")
+	methodBind := methodBindPopupMenuIsItemCheckable.Get()
 
 	// Call the parent method.
 	// bool
@@ -731,8 +783,8 @@ func (o *PopupMenu) IsItemCheckable(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Return the checkstate status of the item at index "idx".
-	Args: [{ false idx int}], Returns: bool
+	        Return the checkstate status of the item at index "idx".
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *PopupMenu) IsItemChecked(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PopupMenu.IsItemChecked()")
@@ -742,7 +794,7 @@ func (o *PopupMenu) IsItemChecked(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "is_item_checked")
+	methodBind := methodBindPopupMenuIsItemChecked.Get()
 
 	// Call the parent method.
 	// bool
@@ -755,8 +807,8 @@ func (o *PopupMenu) IsItemChecked(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Return whether the item at index "idx" is disabled. When it is disabled it can't be selected, or its action invoked.
-	Args: [{ false idx int}], Returns: bool
+	        Return whether the item at index "idx" is disabled. When it is disabled it can't be selected, or its action invoked.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *PopupMenu) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PopupMenu.IsItemDisabled()")
@@ -766,7 +818,7 @@ func (o *PopupMenu) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "is_item_disabled")
+	methodBind := methodBindPopupMenuIsItemDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -779,8 +831,8 @@ func (o *PopupMenu) IsItemDisabled(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Return whether the item is a separator. If it is, it would be displayed as a line.
-	Args: [{ false idx int}], Returns: bool
+	        Return whether the item is a separator. If it is, it would be displayed as a line.
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *PopupMenu) IsItemSeparator(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling PopupMenu.IsItemSeparator()")
@@ -790,7 +842,7 @@ func (o *PopupMenu) IsItemSeparator(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "is_item_separator")
+	methodBind := methodBindPopupMenuIsItemSeparator.Get()
 
 	// Call the parent method.
 	// bool
@@ -803,8 +855,8 @@ func (o *PopupMenu) IsItemSeparator(idx gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Removes the item at index "idx" from the menu. Note that the indexes of items after the removed item are going to be shifted by one.
-	Args: [{ false idx int}], Returns: void
+	        Removes the item at index "idx" from the menu. Note that the indexes of items after the removed item are going to be shifted by one.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *PopupMenu) RemoveItem(idx gdnative.Int) {
 	//log.Println("Calling PopupMenu.RemoveItem()")
@@ -814,7 +866,7 @@ func (o *PopupMenu) RemoveItem(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "remove_item")
+	methodBind := methodBindPopupMenuRemoveItem.Get()
 
 	// Call the parent method.
 	// void
@@ -824,8 +876,8 @@ func (o *PopupMenu) RemoveItem(idx gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *PopupMenu) SetHideOnCheckableItemSelection(enable gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetHideOnCheckableItemSelection()")
@@ -835,7 +887,7 @@ func (o *PopupMenu) SetHideOnCheckableItemSelection(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_hide_on_checkable_item_selection")
+	methodBind := methodBindPopupMenuSetHideOnCheckableItemSelection.Get()
 
 	// Call the parent method.
 	// void
@@ -845,8 +897,8 @@ func (o *PopupMenu) SetHideOnCheckableItemSelection(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *PopupMenu) SetHideOnItemSelection(enable gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetHideOnItemSelection()")
@@ -856,7 +908,7 @@ func (o *PopupMenu) SetHideOnItemSelection(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_hide_on_item_selection")
+	methodBind := methodBindPopupMenuSetHideOnItemSelection.Get()
 
 	// Call the parent method.
 	// void
@@ -866,8 +918,8 @@ func (o *PopupMenu) SetHideOnItemSelection(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *PopupMenu) SetHideOnStateItemSelection(enable gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetHideOnStateItemSelection()")
@@ -877,7 +929,7 @@ func (o *PopupMenu) SetHideOnStateItemSelection(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_hide_on_state_item_selection")
+	methodBind := methodBindPopupMenuSetHideOnStateItemSelection.Get()
 
 	// Call the parent method.
 	// void
@@ -887,8 +939,8 @@ func (o *PopupMenu) SetHideOnStateItemSelection(enable gdnative.Bool) {
 }
 
 /*
-        Set the accelerator of the item at index "idx". Accelerators are special combinations of keys that activate the item, no matter which control is focused.
-	Args: [{ false idx int} { false accel int}], Returns: void
+	        Set the accelerator of the item at index "idx". Accelerators are special combinations of keys that activate the item, no matter which control is focused.
+		Args: [{ false idx int} { false accel int}], Returns: void
 */
 func (o *PopupMenu) SetItemAccelerator(idx gdnative.Int, accel gdnative.Int) {
 	//log.Println("Calling PopupMenu.SetItemAccelerator()")
@@ -899,7 +951,7 @@ func (o *PopupMenu) SetItemAccelerator(idx gdnative.Int, accel gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(accel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_accelerator")
+	methodBind := methodBindPopupMenuSetItemAccelerator.Get()
 
 	// Call the parent method.
 	// void
@@ -909,8 +961,8 @@ func (o *PopupMenu) SetItemAccelerator(idx gdnative.Int, accel gdnative.Int) {
 }
 
 /*
-        Set whether the item at index "idx" has a checkbox. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
-	Args: [{ false idx int} { false enable bool}], Returns: void
+	        Set whether the item at index "idx" has a checkbox. Note that checkable items just display a checkmark, but don't have any built-in checking behavior and must be checked/unchecked manually.
+		Args: [{ false idx int} { false enable bool}], Returns: void
 */
 func (o *PopupMenu) SetItemAsCheckable(idx gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetItemAsCheckable()")
@@ -921,7 +973,7 @@ func (o *PopupMenu) SetItemAsCheckable(idx gdnative.Int, enable gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_as_checkable")
+	methodBind := methodBindPopupMenuSetItemAsCheckable.Get()
 
 	// Call the parent method.
 	// void
@@ -931,8 +983,8 @@ func (o *PopupMenu) SetItemAsCheckable(idx gdnative.Int, enable gdnative.Bool) {
 }
 
 /*
-        Mark the item at index "idx" as a separator, which means that it would be displayed as a mere line.
-	Args: [{ false idx int} { false enable bool}], Returns: void
+	        Mark the item at index "idx" as a separator, which means that it would be displayed as a mere line.
+		Args: [{ false idx int} { false enable bool}], Returns: void
 */
 func (o *PopupMenu) SetItemAsSeparator(idx gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetItemAsSeparator()")
@@ -943,7 +995,7 @@ func (o *PopupMenu) SetItemAsSeparator(idx gdnative.Int, enable gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_as_separator")
+	methodBind := methodBindPopupMenuSetItemAsSeparator.Get()
 
 	// Call the parent method.
 	// void
@@ -953,8 +1005,8 @@ func (o *PopupMenu) SetItemAsSeparator(idx gdnative.Int, enable gdnative.Bool) {
 }
 
 /*
-        Set the checkstate status of the item at index "idx".
-	Args: [{ false idx int} { false checked bool}], Returns: void
+	        Set the checkstate status of the item at index "idx".
+		Args: [{ false idx int} { false checked bool}], Returns: void
 */
 func (o *PopupMenu) SetItemChecked(idx gdnative.Int, checked gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetItemChecked()")
@@ -965,7 +1017,7 @@ func (o *PopupMenu) SetItemChecked(idx gdnative.Int, checked gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(checked)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_checked")
+	methodBind := methodBindPopupMenuSetItemChecked.Get()
 
 	// Call the parent method.
 	// void
@@ -975,8 +1027,8 @@ func (o *PopupMenu) SetItemChecked(idx gdnative.Int, checked gdnative.Bool) {
 }
 
 /*
-        Sets whether the item at index "idx" is disabled or not. When it is disabled it can't be selected, or its action invoked.
-	Args: [{ false idx int} { false disabled bool}], Returns: void
+	        Sets whether the item at index "idx" is disabled or not. When it is disabled it can't be selected, or its action invoked.
+		Args: [{ false idx int} { false disabled bool}], Returns: void
 */
 func (o *PopupMenu) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetItemDisabled()")
@@ -987,7 +1039,7 @@ func (o *PopupMenu) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_disabled")
+	methodBind := methodBindPopupMenuSetItemDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -997,19 +1049,18 @@ func (o *PopupMenu) SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false idx int} { false icon Texture}], Returns: void
+Args: [{ false idx int} { false icon Texture}], Returns: void
 */
-func (o *PopupMenu) SetItemIcon(idx gdnative.Int, icon TextureImplementer) {
+func (o *PopupMenu) SetItemIcon(idx gdnative.Int, icon godot.TextureImplementer) {
 	//log.Println("Calling PopupMenu.SetItemIcon()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(icon.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(icon))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_icon")
+	methodBind := methodBindPopupMenuSetItemIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -1019,8 +1070,8 @@ func (o *PopupMenu) SetItemIcon(idx gdnative.Int, icon TextureImplementer) {
 }
 
 /*
-        Set the id of the item at index "idx".
-	Args: [{ false idx int} { false id int}], Returns: void
+	        Set the id of the item at index "idx".
+		Args: [{ false idx int} { false id int}], Returns: void
 */
 func (o *PopupMenu) SetItemId(idx gdnative.Int, id gdnative.Int) {
 	//log.Println("Calling PopupMenu.SetItemId()")
@@ -1031,7 +1082,7 @@ func (o *PopupMenu) SetItemId(idx gdnative.Int, id gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_id")
+	methodBind := methodBindPopupMenuSetItemId.Get()
 
 	// Call the parent method.
 	// void
@@ -1041,8 +1092,8 @@ func (o *PopupMenu) SetItemId(idx gdnative.Int, id gdnative.Int) {
 }
 
 /*
-        Sets the metadata of an item, which might be of any type. You can later get it with [method get_item_metadata], which provides a simple way of assigning context data to items.
-	Args: [{ false idx int} { false metadata Variant}], Returns: void
+	        Sets the metadata of an item, which might be of any type. You can later get it with [method get_item_metadata], which provides a simple way of assigning context data to items.
+		Args: [{ false idx int} { false metadata Variant}], Returns: void
 */
 func (o *PopupMenu) SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant) {
 	//log.Println("Calling PopupMenu.SetItemMetadata()")
@@ -1053,7 +1104,7 @@ func (o *PopupMenu) SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant)
 	ptrArguments[1] = gdnative.NewPointerFromVariant(metadata)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_metadata")
+	methodBind := methodBindPopupMenuSetItemMetadata.Get()
 
 	// Call the parent method.
 	// void
@@ -1063,8 +1114,7 @@ func (o *PopupMenu) SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant)
 }
 
 /*
-
-	Args: [{ false idx int} { false state int}], Returns: void
+Args: [{ false idx int} { false state int}], Returns: void
 */
 func (o *PopupMenu) SetItemMultistate(idx gdnative.Int, state gdnative.Int) {
 	//log.Println("Calling PopupMenu.SetItemMultistate()")
@@ -1075,7 +1125,7 @@ func (o *PopupMenu) SetItemMultistate(idx gdnative.Int, state gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(state)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_multistate")
+	methodBind := methodBindPopupMenuSetItemMultistate.Get()
 
 	// Call the parent method.
 	// void
@@ -1085,20 +1135,19 @@ func (o *PopupMenu) SetItemMultistate(idx gdnative.Int, state gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false idx int} { false shortcut ShortCut} {False true global bool}], Returns: void
+Args: [{ false idx int} { false shortcut ShortCut} {False true global bool}], Returns: void
 */
-func (o *PopupMenu) SetItemShortcut(idx gdnative.Int, shortcut ShortCutImplementer, global gdnative.Bool) {
+func (o *PopupMenu) SetItemShortcut(idx gdnative.Int, shortcut godot.ShortCutImplementer, global gdnative.Bool) {
 	//log.Println("Calling PopupMenu.SetItemShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(shortcut.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(shortcut))
 	ptrArguments[2] = gdnative.NewPointerFromBool(global)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_shortcut")
+	methodBind := methodBindPopupMenuSetItemShortcut.Get()
 
 	// Call the parent method.
 	// void
@@ -1108,8 +1157,8 @@ func (o *PopupMenu) SetItemShortcut(idx gdnative.Int, shortcut ShortCutImplement
 }
 
 /*
-        Sets the submenu of the item at index "idx". The submenu is the name of a child PopupMenu node that would be shown when the item is clicked.
-	Args: [{ false idx int} { false submenu String}], Returns: void
+	        Sets the submenu of the item at index "idx". The submenu is the name of a child PopupMenu node that would be shown when the item is clicked.
+		Args: [{ false idx int} { false submenu String}], Returns: void
 */
 func (o *PopupMenu) SetItemSubmenu(idx gdnative.Int, submenu gdnative.String) {
 	//log.Println("Calling PopupMenu.SetItemSubmenu()")
@@ -1120,7 +1169,7 @@ func (o *PopupMenu) SetItemSubmenu(idx gdnative.Int, submenu gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(submenu)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_submenu")
+	methodBind := methodBindPopupMenuSetItemSubmenu.Get()
 
 	// Call the parent method.
 	// void
@@ -1130,8 +1179,8 @@ func (o *PopupMenu) SetItemSubmenu(idx gdnative.Int, submenu gdnative.String) {
 }
 
 /*
-        Set the text of the item at index "idx".
-	Args: [{ false idx int} { false text String}], Returns: void
+	        Set the text of the item at index "idx".
+		Args: [{ false idx int} { false text String}], Returns: void
 */
 func (o *PopupMenu) SetItemText(idx gdnative.Int, text gdnative.String) {
 	//log.Println("Calling PopupMenu.SetItemText()")
@@ -1142,7 +1191,7 @@ func (o *PopupMenu) SetItemText(idx gdnative.Int, text gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_text")
+	methodBind := methodBindPopupMenuSetItemText.Get()
 
 	// Call the parent method.
 	// void
@@ -1152,8 +1201,7 @@ func (o *PopupMenu) SetItemText(idx gdnative.Int, text gdnative.String) {
 }
 
 /*
-
-	Args: [{ false idx int} { false tooltip String}], Returns: void
+Args: [{ false idx int} { false tooltip String}], Returns: void
 */
 func (o *PopupMenu) SetItemTooltip(idx gdnative.Int, tooltip gdnative.String) {
 	//log.Println("Calling PopupMenu.SetItemTooltip()")
@@ -1164,7 +1212,7 @@ func (o *PopupMenu) SetItemTooltip(idx gdnative.Int, tooltip gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(tooltip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "set_item_tooltip")
+	methodBind := methodBindPopupMenuSetItemTooltip.Get()
 
 	// Call the parent method.
 	// void
@@ -1174,8 +1222,7 @@ func (o *PopupMenu) SetItemTooltip(idx gdnative.Int, tooltip gdnative.String) {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: void
+Args: [{ false idx int}], Returns: void
 */
 func (o *PopupMenu) ToggleItemChecked(idx gdnative.Int) {
 	//log.Println("Calling PopupMenu.ToggleItemChecked()")
@@ -1185,7 +1232,7 @@ func (o *PopupMenu) ToggleItemChecked(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "toggle_item_checked")
+	methodBind := methodBindPopupMenuToggleItemChecked.Get()
 
 	// Call the parent method.
 	// void
@@ -1195,8 +1242,7 @@ func (o *PopupMenu) ToggleItemChecked(idx gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: void
+Args: [{ false idx int}], Returns: void
 */
 func (o *PopupMenu) ToggleItemMultistate(idx gdnative.Int) {
 	//log.Println("Calling PopupMenu.ToggleItemMultistate()")
@@ -1206,7 +1252,7 @@ func (o *PopupMenu) ToggleItemMultistate(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PopupMenu", "toggle_item_multistate")
+	methodBind := methodBindPopupMenuToggleItemMultistate.Get()
 
 	// Call the parent method.
 	// void
@@ -1215,6 +1261,133 @@ func (o *PopupMenu) ToggleItemMultistate(idx gdnative.Int) {
 
 }
 
+// AddCheckItemWithDefaults will call AddCheckItem using the default values for: id, accel.
+func (o *PopupMenu) AddCheckItemWithDefaults(label gdnative.String) {
+	o.AddCheckItem(label, -1, 0)
+}
+
+// AddCheckShortcutWithDefaults will call AddCheckShortcut using the default values for: id, global.
+func (o *PopupMenu) AddCheckShortcutWithDefaults(shortcut godot.ShortCutImplementer) {
+	o.AddCheckShortcut(shortcut, -1, false)
+}
+
+// AddIconCheckItemWithDefaults will call AddIconCheckItem using the default values for: id, accel.
+func (o *PopupMenu) AddIconCheckItemWithDefaults(texture godot.TextureImplementer, label gdnative.String) {
+	o.AddIconCheckItem(texture, label, -1, 0)
+}
+
+// AddIconCheckShortcutWithDefaults will call AddIconCheckShortcut using the default values for: id, global.
+func (o *PopupMenu) AddIconCheckShortcutWithDefaults(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer) {
+	o.AddIconCheckShortcut(texture, shortcut, -1, false)
+}
+
+// AddIconItemWithDefaults will call AddIconItem using the default values for: id, accel.
+func (o *PopupMenu) AddIconItemWithDefaults(texture godot.TextureImplementer, label gdnative.String) {
+	o.AddIconItem(texture, label, -1, 0)
+}
+
+// AddIconShortcutWithDefaults will call AddIconShortcut using the default values for: id, global.
+func (o *PopupMenu) AddIconShortcutWithDefaults(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer) {
+	o.AddIconShortcut(texture, shortcut, -1, false)
+}
+
+// AddItemWithDefaults will call AddItem using the default values for: id, accel.
+func (o *PopupMenu) AddItemWithDefaults(label gdnative.String) {
+	o.AddItem(label, -1, 0)
+}
+
+// AddShortcutWithDefaults will call AddShortcut using the default values for: id, global.
+func (o *PopupMenu) AddShortcutWithDefaults(shortcut godot.ShortCutImplementer) {
+	o.AddShortcut(shortcut, -1, false)
+}
+
+// AddSubmenuItemWithDefaults will call AddSubmenuItem using the default values for: id.
+func (o *PopupMenu) AddSubmenuItemWithDefaults(label gdnative.String, submenu gdnative.String) {
+	o.AddSubmenuItem(label, submenu, -1)
+}
+
+// SetItemShortcutWithDefaults will call SetItemShortcut using the default values for: global.
+func (o *PopupMenu) SetItemShortcutWithDefaults(idx gdnative.Int, shortcut godot.ShortCutImplementer) {
+	o.SetItemShortcut(idx, shortcut, false)
+}
+
+// HideOnCheckableItemSelection will return the value of the "hide_on_checkable_item_selection" property.
+func (o *PopupMenu) HideOnCheckableItemSelection() gdnative.Bool {
+	return o.IsHideOnCheckableItemSelection()
+}
+
+// HideOnItemSelection will return the value of the "hide_on_item_selection" property.
+func (o *PopupMenu) HideOnItemSelection() gdnative.Bool {
+	return o.IsHideOnItemSelection()
+}
+
+// HideOnStateItemSelection will return the value of the "hide_on_state_item_selection" property.
+func (o *PopupMenu) HideOnStateItemSelection() gdnative.Bool {
+	return o.IsHideOnStateItemSelection()
+}
+
+// Items will return the value of the "items" property.
+func (o *PopupMenu) Items() gdnative.Array {
+	return o.X_GetItems()
+}
+
+// SetItems will set the value of the "items" property.
+func (o *PopupMenu) SetItems(value gdnative.Array) {
+	o.X_SetItems(value)
+}
+
+// Signals of the PopupMenu class.
+const (
+	PopupMenuSignalIdPressed    gdnative.String = "id_pressed"
+	PopupMenuSignalIndexPressed gdnative.String = "index_pressed"
+)
+
+// PopupMenuIdPressedArgs holds the arguments of the "id_pressed" signal.
+type PopupMenuIdPressedArgs struct {
+	Id gdnative.Int
+}
+
+// ConnectIdPressed will connect the "id_pressed" signal to the given method of the target object.
+func (o *PopupMenu) ConnectIdPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(PopupMenuSignalIdPressed, target, method, binds, 0)
+}
+
+// EmitIdPressed will emit the "id_pressed" signal.
+func (o *PopupMenu) EmitIdPressed(args PopupMenuIdPressedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(PopupMenuSignalIdPressed, arg0)
+	ret.Destroy()
+	return err
+}
+
+// PopupMenuIndexPressedArgs holds the arguments of the "index_pressed" signal.
+type PopupMenuIndexPressedArgs struct {
+	Index gdnative.Int
+}
+
+// ConnectIndexPressed will connect the "index_pressed" signal to the given method of the target object.
+func (o *PopupMenu) ConnectIndexPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(PopupMenuSignalIndexPressed, target, method, binds, 0)
+}
+
+// EmitIndexPressed will emit the "index_pressed" signal.
+func (o *PopupMenu) EmitIndexPressed(args PopupMenuIndexPressedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Index))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(PopupMenuSignalIndexPressed, arg0)
+	ret.Destroy()
+	return err
+}
+
 // PopupMenuImplementer is an interface that implements the methods
 // of the PopupMenu class.
 type PopupMenuImplementer interface {
@@ -1223,23 +1396,23 @@ type PopupMenuImplementer interface {
 	X_SetItems(arg0 gdnative.Array)
 	X_SubmenuTimeout()
 	AddCheckItem(label gdnative.String, id gdnative.Int, accel gdnative.Int)
-	AddCheckShortcut(shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
-	AddIconCheckItem(texture TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int)
-	AddIconCheckShortcut(texture TextureImplementer, shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
-	AddIconItem(texture TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int)
-	AddIconShortcut(texture TextureImplementer, shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
+	AddCheckShortcut(shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
+	AddIconCheckItem(texture godot.TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int)
+	AddIconCheckShortcut(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
+	AddIconItem(texture godot.TextureImplementer, label gdnative.String, id gdnative.Int, accel gdnative.Int)
+	AddIconShortcut(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
 	AddItem(label gdnative.String, id gdnative.Int, accel gdnative.Int)
 	AddSeparator()
-	AddShortcut(shortcut ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
+	AddShortcut(shortcut godot.ShortCutImplementer, id gdnative.Int, global gdnative.Bool)
 	AddSubmenuItem(label gdnative.String, submenu gdnative.String, id gdnative.Int)
 	Clear()
 	GetItemAccelerator(idx gdnative.Int) gdnative.Int
 	GetItemCount() gdnative.Int
-	GetItemIcon(idx gdnative.Int) TextureImplementer
+	GetItemIcon(idx gdnative.Int) godot.TextureImplementer
 	GetItemId(idx gdnative.Int) gdnative.Int
 	GetItemIndex(id gdnative.Int) gdnative.Int
 	GetItemMetadata(idx gdnative.Int) gdnative.Variant
-	GetItemShortcut(idx gdnative.Int) ShortCutImplementer
+	GetItemShortcut(idx gdnative.Int) godot.ShortCutImplementer
 	GetItemSubmenu(idx gdnative.Int) gdnative.String
 	GetItemText(idx gdnative.Int) gdnative.String
 	GetItemTooltip(idx gdnative.Int) gdnative.String
@@ -1259,14 +1432,33 @@ type PopupMenuImplementer interface {
 	SetItemAsSeparator(idx gdnative.Int, enable gdnative.Bool)
 	SetItemChecked(idx gdnative.Int, checked gdnative.Bool)
 	SetItemDisabled(idx gdnative.Int, disabled gdnative.Bool)
-	SetItemIcon(idx gdnative.Int, icon TextureImplementer)
+	SetItemIcon(idx gdnative.Int, icon godot.TextureImplementer)
 	SetItemId(idx gdnative.Int, id gdnative.Int)
 	SetItemMetadata(idx gdnative.Int, metadata gdnative.Variant)
 	SetItemMultistate(idx gdnative.Int, state gdnative.Int)
-	SetItemShortcut(idx gdnative.Int, shortcut ShortCutImplementer, global gdnative.Bool)
+	SetItemShortcut(idx gdnative.Int, shortcut godot.ShortCutImplementer, global gdnative.Bool)
 	SetItemSubmenu(idx gdnative.Int, submenu gdnative.String)
 	SetItemText(idx gdnative.Int, text gdnative.String)
 	SetItemTooltip(idx gdnative.Int, tooltip gdnative.String)
 	ToggleItemChecked(idx gdnative.Int)
 	ToggleItemMultistate(idx gdnative.Int)
+	AddCheckItemWithDefaults(label gdnative.String)
+	AddCheckShortcutWithDefaults(shortcut godot.ShortCutImplementer)
+	AddIconCheckItemWithDefaults(texture godot.TextureImplementer, label gdnative.String)
+	AddIconCheckShortcutWithDefaults(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer)
+	AddIconItemWithDefaults(texture godot.TextureImplementer, label gdnative.String)
+	AddIconShortcutWithDefaults(texture godot.TextureImplementer, shortcut godot.ShortCutImplementer)
+	AddItemWithDefaults(label gdnative.String)
+	AddShortcutWithDefaults(shortcut godot.ShortCutImplementer)
+	AddSubmenuItemWithDefaults(label gdnative.String, submenu gdnative.String)
+	SetItemShortcutWithDefaults(idx gdnative.Int, shortcut godot.ShortCutImplementer)
+	HideOnCheckableItemSelection() gdnative.Bool
+	HideOnItemSelection() gdnative.Bool
+	HideOnStateItemSelection() gdnative.Bool
+	Items() gdnative.Array
+	SetItems(value gdnative.Array)
+	ConnectIdPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitIdPressed(args PopupMenuIdPressedArgs) error
+	ConnectIndexPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitIndexPressed(args PopupMenuIndexPressedArgs) error
 }
@@ -1,7 +1,8 @@
-package godot
+package popup
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPopupPanelFromPointer(ptr gdnative.Pointer) PopupPanel {
-func newPopupPanelFromPointer(ptr gdnative.Pointer) PopupPanel {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PopupPanel{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class for displaying popups with a panel background. In some cases it might be simpler to use than [Popup], since it provides a configurable background. If you are making windows, better check [WindowDialog].
 */
@@ -30,6 +22,20 @@ type PopupPanel struct {
 	owner gdnative.Object
 }
 
+// classConstructorPopupPanel is the class constructor of the PopupPanel class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPopupPanel = gdnative.NewLazyClassConstructor("PopupPanel")
+
+// NewPopupPanel will create a new instance of the PopupPanel class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPopupPanel() *PopupPanel {
+	obj := &PopupPanel{}
+	obj.SetBaseObject(classConstructorPopupPanel.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PopupPanel) BaseClass() string {
 	return "PopupPanel"
 }
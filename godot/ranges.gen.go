@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRangeFromPointer(ptr gdnative.Pointer) Range {
-func newRangeFromPointer(ptr gdnative.Pointer) Range {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Range{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Range is a base class for [Control] nodes that change a floating point [i]value[/i] between a [i]minimum[/i] and a [i]maximum[/i], using [i]step[/i] and [i]page[/i], for example a [ScrollBar].
 */
@@ -34,9 +25,32 @@ func (o *Range) BaseClass() string {
 	return "Range"
 }
 
+// Method binds of the Range class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRangeGetAsRatio           = gdnative.NewLazyMethodBind("Range", "get_as_ratio")
+	methodBindRangeGetMax               = gdnative.NewLazyMethodBind("Range", "get_max")
+	methodBindRangeGetMin               = gdnative.NewLazyMethodBind("Range", "get_min")
+	methodBindRangeGetPage              = gdnative.NewLazyMethodBind("Range", "get_page")
+	methodBindRangeGetStep              = gdnative.NewLazyMethodBind("Range", "get_step")
+	methodBindRangeGetValue             = gdnative.NewLazyMethodBind("Range", "get_value")
+	methodBindRangeIsRatioExp           = gdnative.NewLazyMethodBind("Range", "is_ratio_exp")
+	methodBindRangeIsUsingRoundedValues = gdnative.NewLazyMethodBind("Range", "is_using_rounded_values")
+	methodBindRangeSetAsRatio           = gdnative.NewLazyMethodBind("Range", "set_as_ratio")
+	methodBindRangeSetExpRatio          = gdnative.NewLazyMethodBind("Range", "set_exp_ratio")
+	methodBindRangeSetMax               = gdnative.NewLazyMethodBind("Range", "set_max")
+	methodBindRangeSetMin               = gdnative.NewLazyMethodBind("Range", "set_min")
+	methodBindRangeSetPage              = gdnative.NewLazyMethodBind("Range", "set_page")
+	methodBindRangeSetStep              = gdnative.NewLazyMethodBind("Range", "set_step")
+	methodBindRangeSetUseRoundedValues  = gdnative.NewLazyMethodBind("Range", "set_use_rounded_values")
+	methodBindRangeSetValue             = gdnative.NewLazyMethodBind("Range", "set_value")
+	methodBindRangeShare                = gdnative.NewLazyMethodBind("Range", "share")
+	methodBindRangeUnshare              = gdnative.NewLazyMethodBind("Range", "unshare")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Range) GetAsRatio() gdnative.Real {
 	//log.Println("Calling Range.GetAsRatio()")
@@ -45,7 +59,7 @@ func (o *Range) GetAsRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "get_as_ratio")
+	methodBind := methodBindRangeGetAsRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +72,8 @@ func (o *Range) GetAsRatio() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Range) GetMax() gdnative.Real {
 	//log.Println("Calling Range.GetMax()")
@@ -68,7 +82,7 @@ func (o *Range) GetMax() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "get_max")
+	methodBind := methodBindRangeGetMax.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +95,8 @@ func (o *Range) GetMax() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Range) GetMin() gdnative.Real {
 	//log.Println("Calling Range.GetMin()")
@@ -91,7 +105,7 @@ func (o *Range) GetMin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "get_min")
+	methodBind := methodBindRangeGetMin.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +118,8 @@ func (o *Range) GetMin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Range) GetPage() gdnative.Real {
 	//log.Println("Calling Range.GetPage()")
@@ -114,7 +128,7 @@ func (o *Range) GetPage() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "get_page")
+	methodBind := methodBindRangeGetPage.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +141,8 @@ func (o *Range) GetPage() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Range) GetStep() gdnative.Real {
 	//log.Println("Calling Range.GetStep()")
@@ -137,7 +151,7 @@ func (o *Range) GetStep() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "get_step")
+	methodBind := methodBindRangeGetStep.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +164,8 @@ func (o *Range) GetStep() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Range) GetValue() gdnative.Real {
 	//log.Println("Calling Range.GetValue()")
@@ -160,7 +174,7 @@ func (o *Range) GetValue() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "get_value")
+	methodBind := methodBindRangeGetValue.Get()
 
 	// Call the parent method.
 	// float
@@ -173,8 +187,8 @@ func (o *Range) GetValue() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Range) IsRatioExp() gdnative.Bool {
 	//log.Println("Calling Range.IsRatioExp()")
@@ -183,7 +197,7 @@ func (o *Range) IsRatioExp() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "is_ratio_exp")
+	methodBind := methodBindRangeIsRatioExp.Get()
 
 	// Call the parent method.
 	// bool
@@ -196,8 +210,8 @@ func (o *Range) IsRatioExp() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Range) IsUsingRoundedValues() gdnative.Bool {
 	//log.Println("Calling Range.IsUsingRoundedValues()")
@@ -206,7 +220,7 @@ func (o *Range) IsUsingRoundedValues() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "is_using_rounded_values")
+	methodBind := methodBindRangeIsUsingRoundedValues.Get()
 
 	// Call the parent method.
 	// bool
@@ -219,8 +233,8 @@ func (o *Range) IsUsingRoundedValues() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false value float}], Returns: void
+	        Undocumented
+		Args: [{ false value float}], Returns: void
 */
 func (o *Range) SetAsRatio(value gdnative.Real) {
 	//log.Println("Calling Range.SetAsRatio()")
@@ -230,7 +244,7 @@ func (o *Range) SetAsRatio(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_as_ratio")
+	methodBind := methodBindRangeSetAsRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -240,8 +254,8 @@ func (o *Range) SetAsRatio(value gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Range) SetExpRatio(enabled gdnative.Bool) {
 	//log.Println("Calling Range.SetExpRatio()")
@@ -251,7 +265,7 @@ func (o *Range) SetExpRatio(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_exp_ratio")
+	methodBind := methodBindRangeSetExpRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -261,8 +275,8 @@ func (o *Range) SetExpRatio(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false maximum float}], Returns: void
+	        Undocumented
+		Args: [{ false maximum float}], Returns: void
 */
 func (o *Range) SetMax(maximum gdnative.Real) {
 	//log.Println("Calling Range.SetMax()")
@@ -272,7 +286,7 @@ func (o *Range) SetMax(maximum gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(maximum)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_max")
+	methodBind := methodBindRangeSetMax.Get()
 
 	// Call the parent method.
 	// void
@@ -282,8 +296,8 @@ func (o *Range) SetMax(maximum gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false minimum float}], Returns: void
+	        Undocumented
+		Args: [{ false minimum float}], Returns: void
 */
 func (o *Range) SetMin(minimum gdnative.Real) {
 	//log.Println("Calling Range.SetMin()")
@@ -293,7 +307,7 @@ func (o *Range) SetMin(minimum gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(minimum)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_min")
+	methodBind := methodBindRangeSetMin.Get()
 
 	// Call the parent method.
 	// void
@@ -303,8 +317,8 @@ func (o *Range) SetMin(minimum gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pagesize float}], Returns: void
+	        Undocumented
+		Args: [{ false pagesize float}], Returns: void
 */
 func (o *Range) SetPage(pagesize gdnative.Real) {
 	//log.Println("Calling Range.SetPage()")
@@ -314,7 +328,7 @@ func (o *Range) SetPage(pagesize gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(pagesize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_page")
+	methodBind := methodBindRangeSetPage.Get()
 
 	// Call the parent method.
 	// void
@@ -324,8 +338,8 @@ func (o *Range) SetPage(pagesize gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false step float}], Returns: void
+	        Undocumented
+		Args: [{ false step float}], Returns: void
 */
 func (o *Range) SetStep(step gdnative.Real) {
 	//log.Println("Calling Range.SetStep()")
@@ -335,7 +349,7 @@ func (o *Range) SetStep(step gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(step)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_step")
+	methodBind := methodBindRangeSetStep.Get()
 
 	// Call the parent method.
 	// void
@@ -345,8 +359,8 @@ func (o *Range) SetStep(step gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Range) SetUseRoundedValues(enabled gdnative.Bool) {
 	//log.Println("Calling Range.SetUseRoundedValues()")
@@ -356,7 +370,7 @@ func (o *Range) SetUseRoundedValues(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_use_rounded_values")
+	methodBind := methodBindRangeSetUseRoundedValues.Get()
 
 	// Call the parent method.
 	// void
@@ -366,8 +380,8 @@ func (o *Range) SetUseRoundedValues(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value float}], Returns: void
+	        Undocumented
+		Args: [{ false value float}], Returns: void
 */
 func (o *Range) SetValue(value gdnative.Real) {
 	//log.Println("Calling Range.SetValue()")
@@ -377,7 +391,7 @@ func (o *Range) SetValue(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "set_value")
+	methodBind := methodBindRangeSetValue.Get()
 
 	// Call the parent method.
 	// void
@@ -387,18 +401,18 @@ func (o *Range) SetValue(value gdnative.Real) {
 }
 
 /*
-        Binds two Ranges together along with any Ranges previously grouped with either of them. When any of Range's member variables change, it will share the new value with all other Ranges in its group.
-	Args: [{ false with Object}], Returns: void
+	        Binds two Ranges together along with any Ranges previously grouped with either of them. When any of Range's member variables change, it will share the new value with all other Ranges in its group.
+		Args: [{ false with Object}], Returns: void
 */
 func (o *Range) Share(with ObjectImplementer) {
 	//log.Println("Calling Range.Share()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(with.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(with))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "share")
+	methodBind := methodBindRangeShare.Get()
 
 	// Call the parent method.
 	// void
@@ -408,8 +422,8 @@ func (o *Range) Share(with ObjectImplementer) {
 }
 
 /*
-        Stop Range from sharing its member variables with any other Range.
-	Args: [], Returns: void
+	        Stop Range from sharing its member variables with any other Range.
+		Args: [], Returns: void
 */
 func (o *Range) Unshare() {
 	//log.Println("Calling Range.Unshare()")
@@ -418,7 +432,7 @@ func (o *Range) Unshare() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Range", "unshare")
+	methodBind := methodBindRangeUnshare.Get()
 
 	// Call the parent method.
 	// void
@@ -427,6 +441,116 @@ func (o *Range) Unshare() {
 
 }
 
+// ExpEdit will return the value of the "exp_edit" property.
+func (o *Range) ExpEdit() gdnative.Bool {
+	return o.IsRatioExp()
+}
+
+// SetExpEdit will set the value of the "exp_edit" property.
+func (o *Range) SetExpEdit(value gdnative.Bool) {
+	o.SetExpRatio(value)
+}
+
+// MaxValue will return the value of the "max_value" property.
+func (o *Range) MaxValue() gdnative.Real {
+	return o.GetMax()
+}
+
+// SetMaxValue will set the value of the "max_value" property.
+func (o *Range) SetMaxValue(value gdnative.Real) {
+	o.SetMax(value)
+}
+
+// MinValue will return the value of the "min_value" property.
+func (o *Range) MinValue() gdnative.Real {
+	return o.GetMin()
+}
+
+// SetMinValue will set the value of the "min_value" property.
+func (o *Range) SetMinValue(value gdnative.Real) {
+	o.SetMin(value)
+}
+
+// Page will return the value of the "page" property.
+func (o *Range) Page() gdnative.Real {
+	return o.GetPage()
+}
+
+// Ratio will return the value of the "ratio" property.
+func (o *Range) Ratio() gdnative.Real {
+	return o.GetAsRatio()
+}
+
+// SetRatio will set the value of the "ratio" property.
+func (o *Range) SetRatio(value gdnative.Real) {
+	o.SetAsRatio(value)
+}
+
+// Rounded will return the value of the "rounded" property.
+func (o *Range) Rounded() gdnative.Bool {
+	return o.IsUsingRoundedValues()
+}
+
+// SetRounded will set the value of the "rounded" property.
+func (o *Range) SetRounded(value gdnative.Bool) {
+	o.SetUseRoundedValues(value)
+}
+
+// Step will return the value of the "step" property.
+func (o *Range) Step() gdnative.Real {
+	return o.GetStep()
+}
+
+// Value will return the value of the "value" property.
+func (o *Range) Value() gdnative.Real {
+	return o.GetValue()
+}
+
+// Signals of the Range class.
+const (
+	RangeSignalChanged      gdnative.String = "changed"
+	RangeSignalValueChanged gdnative.String = "value_changed"
+)
+
+// ConnectChanged will connect the "changed" signal to the given method of the target object.
+func (o *Range) ConnectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RangeSignalChanged, target, method, binds, 0)
+}
+
+// EmitChanged will emit the "changed" signal.
+func (o *Range) EmitChanged() error {
+
+	ret, err := o.EmitSignal(RangeSignalChanged)
+	ret.Destroy()
+	return err
+}
+
+// RangeValueChangedArgs holds the arguments of the "value_changed" signal.
+type RangeValueChangedArgs struct {
+	Value gdnative.Real
+}
+
+// ConnectValueChanged will connect the "value_changed" signal to the given method of the target object.
+func (o *Range) ConnectValueChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RangeSignalValueChanged, target, method, binds, 0)
+}
+
+// EmitValueChanged will emit the "value_changed" signal.
+func (o *Range) EmitValueChanged(args RangeValueChangedArgs) error {
+	arg0 := gdnative.NewVariantReal(gdnative.Double(args.Value))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RangeSignalValueChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
 // RangeImplementer is an interface that implements the methods
 // of the Range class.
 type RangeImplementer interface {
@@ -449,4 +573,21 @@ type RangeImplementer interface {
 	SetValue(value gdnative.Real)
 	Share(with ObjectImplementer)
 	Unshare()
+	ExpEdit() gdnative.Bool
+	SetExpEdit(value gdnative.Bool)
+	MaxValue() gdnative.Real
+	SetMaxValue(value gdnative.Real)
+	MinValue() gdnative.Real
+	SetMinValue(value gdnative.Real)
+	Page() gdnative.Real
+	Ratio() gdnative.Real
+	SetRatio(value gdnative.Real)
+	Rounded() gdnative.Bool
+	SetRounded(value gdnative.Bool)
+	Step() gdnative.Real
+	Value() gdnative.Real
+	ConnectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitChanged() error
+	ConnectValueChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitValueChanged(args RangeValueChangedArgs) error
 }
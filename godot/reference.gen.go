@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewReferenceFromPointer(ptr gdnative.Pointer) Reference {
-func newReferenceFromPointer(ptr gdnative.Pointer) Reference {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Reference{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base class for anything that keeps a reference count. Resource and many other helper objects inherit this. References keep an internal reference counter so they are only released when no longer in use.
 */
@@ -30,13 +21,46 @@ type Reference struct {
 	owner gdnative.Object
 }
 
+// classConstructorReference is the class constructor of the Reference class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorReference = gdnative.NewLazyClassConstructor("Reference")
+
+// NewReference will create a new instance of the Reference class.
+// Reference is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewReference() *Reference {
+	obj := &Reference{}
+	obj.SetBaseObject(classConstructorReference.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Reference) BaseClass() string {
 	return "Reference"
 }
 
-/*
+// Release will drop the reference held by the Go wrapper with Unreference(), and
+// free the object if it was the last reference. It must be called once for every
+// object created with one of the New functions of a reference type.
+func (o *Reference) Release() {
+	if o.Unreference() {
+		o.GetBaseObject().Destroy()
+	}
+}
 
-	Args: [], Returns: bool
+// Method binds of the Reference class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindReferenceInitRef         = gdnative.NewLazyMethodBind("Reference", "init_ref")
+	methodBindReferenceReferenceMethod = gdnative.NewLazyMethodBind("Reference", "reference")
+	methodBindReferenceUnreference     = gdnative.NewLazyMethodBind("Reference", "unreference")
+)
+
+/*
+Args: [], Returns: bool
 */
 func (o *Reference) InitRef() gdnative.Bool {
 	//log.Println("Calling Reference.InitRef()")
@@ -45,7 +69,7 @@ func (o *Reference) InitRef() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Reference", "init_ref")
+	methodBind := methodBindReferenceInitRef.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +82,8 @@ func (o *Reference) InitRef() gdnative.Bool {
 }
 
 /*
-        Increase the internal reference counter. Use this only if you really know what you are doing.
-	Args: [], Returns: bool
+	        Increase the internal reference counter. Use this only if you really know what you are doing.
+		Args: [], Returns: bool
 */
 func (o *Reference) ReferenceMethod() gdnative.Bool {
 	//log.Println("Calling Reference.ReferenceMethod()")
@@ -68,7 +92,7 @@ func (o *Reference) ReferenceMethod() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Reference", "reference")
+	methodBind := methodBindReferenceReferenceMethod.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +105,8 @@ func (o *Reference) ReferenceMethod() gdnative.Bool {
 }
 
 /*
-        Decrease the internal reference counter. Use this only if you really know what you are doing.
-	Args: [], Returns: bool
+	        Decrease the internal reference counter. Use this only if you really know what you are doing.
+		Args: [], Returns: bool
 */
 func (o *Reference) Unreference() gdnative.Bool {
 	//log.Println("Calling Reference.Unreference()")
@@ -91,7 +115,7 @@ func (o *Reference) Unreference() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Reference", "unreference")
+	methodBind := methodBindReferenceUnreference.Get()
 
 	// Call the parent method.
 	// bool
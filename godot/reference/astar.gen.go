@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,63 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAStarFromPointer(ptr gdnative.Pointer) AStar {
-func newAStarFromPointer(ptr gdnative.Pointer) AStar {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AStar{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A* (A star) is a computer algorithm that is widely used in pathfinding and graph traversal, the process of plotting an efficiently directed path between multiple points. It enjoys widespread use due to its performance and accuracy. Godot's A* implementation make use of vectors as points. You must add points manually with [method AStar.add_point] and create segments manually with [method AStar.connect_points]. So you can test if there is a path between two points with the [method AStar.are_points_connected] function, get the list of existing ids in the found path with [method AStar.get_id_path], or the points list with [method AStar.get_point_path].
 */
 type AStar struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorAStar is the class constructor of the AStar class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAStar = gdnative.NewLazyClassConstructor("AStar")
+
+// NewAStar will create a new instance of the AStar class.
+// AStar is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAStar() *AStar {
+	obj := &AStar{}
+	obj.SetBaseObject(classConstructorAStar.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AStar) BaseClass() string {
 	return "AStar"
 }
 
+// Method binds of the AStar class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAStarX_ComputeCost               = gdnative.NewLazyMethodBind("AStar", "_compute_cost")
+	methodBindAStarX_EstimateCost              = gdnative.NewLazyMethodBind("AStar", "_estimate_cost")
+	methodBindAStarAddPoint                    = gdnative.NewLazyMethodBind("AStar", "add_point")
+	methodBindAStarArePointsConnected          = gdnative.NewLazyMethodBind("AStar", "are_points_connected")
+	methodBindAStarClear                       = gdnative.NewLazyMethodBind("AStar", "clear")
+	methodBindAStarConnectPoints               = gdnative.NewLazyMethodBind("AStar", "connect_points")
+	methodBindAStarDisconnectPoints            = gdnative.NewLazyMethodBind("AStar", "disconnect_points")
+	methodBindAStarGetAvailablePointId         = gdnative.NewLazyMethodBind("AStar", "get_available_point_id")
+	methodBindAStarGetClosestPoint             = gdnative.NewLazyMethodBind("AStar", "get_closest_point")
+	methodBindAStarGetClosestPositionInSegment = gdnative.NewLazyMethodBind("AStar", "get_closest_position_in_segment")
+	methodBindAStarGetIdPath                   = gdnative.NewLazyMethodBind("AStar", "get_id_path")
+	methodBindAStarGetPointConnections         = gdnative.NewLazyMethodBind("AStar", "get_point_connections")
+	methodBindAStarGetPointPath                = gdnative.NewLazyMethodBind("AStar", "get_point_path")
+	methodBindAStarGetPointPosition            = gdnative.NewLazyMethodBind("AStar", "get_point_position")
+	methodBindAStarGetPointWeightScale         = gdnative.NewLazyMethodBind("AStar", "get_point_weight_scale")
+	methodBindAStarGetPoints                   = gdnative.NewLazyMethodBind("AStar", "get_points")
+	methodBindAStarHasPoint                    = gdnative.NewLazyMethodBind("AStar", "has_point")
+	methodBindAStarRemovePoint                 = gdnative.NewLazyMethodBind("AStar", "remove_point")
+	methodBindAStarSetPointPosition            = gdnative.NewLazyMethodBind("AStar", "set_point_position")
+	methodBindAStarSetPointWeightScale         = gdnative.NewLazyMethodBind("AStar", "set_point_weight_scale")
+)
+
 /*
-        Called when computing the cost between two connected points.
-	Args: [{ false from_id int} { false to_id int}], Returns: float
+	        Called when computing the cost between two connected points.
+		Args: [{ false from_id int} { false to_id int}], Returns: float
 */
 func (o *AStar) X_ComputeCost(fromId gdnative.Int, toId gdnative.Int) gdnative.Real {
 	//log.Println("Calling AStar.X_ComputeCost()")
@@ -47,7 +81,7 @@ func (o *AStar) X_ComputeCost(fromId gdnative.Int, toId gdnative.Int) gdnative.R
 	ptrArguments[1] = gdnative.NewPointerFromInt(toId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "_compute_cost")
+	methodBind := methodBindAStarX_ComputeCost.Get()
 
 	// Call the parent method.
 	// float
@@ -60,8 +94,8 @@ func (o *AStar) X_ComputeCost(fromId gdnative.Int, toId gdnative.Int) gdnative.R
 }
 
 /*
-        Called when estimating the cost between a point and the path's ending point.
-	Args: [{ false from_id int} { false to_id int}], Returns: float
+	        Called when estimating the cost between a point and the path's ending point.
+		Args: [{ false from_id int} { false to_id int}], Returns: float
 */
 func (o *AStar) X_EstimateCost(fromId gdnative.Int, toId gdnative.Int) gdnative.Real {
 	//log.Println("Calling AStar.X_EstimateCost()")
@@ -72,7 +106,7 @@ func (o *AStar) X_EstimateCost(fromId gdnative.Int, toId gdnative.Int) gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(toId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "_estimate_cost")
+	methodBind := methodBindAStarX_EstimateCost.Get()
 
 	// Call the parent method.
 	// float
@@ -85,8 +119,8 @@ func (o *AStar) X_EstimateCost(fromId gdnative.Int, toId gdnative.Int) gdnative.
 }
 
 /*
-        Adds a new point at the given position with the given identifier. The algorithm prefers points with lower [code]weight_scale[/code] to form a path. The [code]id[/code] must be 0 or larger, and the [code]weight_scale[/code] must be 1 or larger. [codeblock] var as = AStar.new() as.add_point(1, Vector3(1,0,0), 4) # Adds the point (1,0,0) with weight_scale=4 and id=1 [/codeblock] If there already exists a point for the given id, its position and weight scale are updated to the given values.
-	Args: [{ false id int} { false position Vector3} {1 true weight_scale float}], Returns: void
+	        Adds a new point at the given position with the given identifier. The algorithm prefers points with lower [code]weight_scale[/code] to form a path. The [code]id[/code] must be 0 or larger, and the [code]weight_scale[/code] must be 1 or larger. [codeblock] var as = AStar.new() as.add_point(1, Vector3(1,0,0), 4) # Adds the point (1,0,0) with weight_scale=4 and id=1 [/codeblock] If there already exists a point for the given id, its position and weight scale are updated to the given values.
+		Args: [{ false id int} { false position Vector3} {1 true weight_scale float}], Returns: void
 */
 func (o *AStar) AddPoint(id gdnative.Int, position gdnative.Vector3, weightScale gdnative.Real) {
 	//log.Println("Calling AStar.AddPoint()")
@@ -98,7 +132,7 @@ func (o *AStar) AddPoint(id gdnative.Int, position gdnative.Vector3, weightScale
 	ptrArguments[2] = gdnative.NewPointerFromReal(weightScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "add_point")
+	methodBind := methodBindAStarAddPoint.Get()
 
 	// Call the parent method.
 	// void
@@ -108,8 +142,8 @@ func (o *AStar) AddPoint(id gdnative.Int, position gdnative.Vector3, weightScale
 }
 
 /*
-        Returns whether there is a connection/segment between the given points.
-	Args: [{ false id int} { false to_id int}], Returns: bool
+	        Returns whether there is a connection/segment between the given points.
+		Args: [{ false id int} { false to_id int}], Returns: bool
 */
 func (o *AStar) ArePointsConnected(id gdnative.Int, toId gdnative.Int) gdnative.Bool {
 	//log.Println("Calling AStar.ArePointsConnected()")
@@ -120,7 +154,7 @@ func (o *AStar) ArePointsConnected(id gdnative.Int, toId gdnative.Int) gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromInt(toId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "are_points_connected")
+	methodBind := methodBindAStarArePointsConnected.Get()
 
 	// Call the parent method.
 	// bool
@@ -133,8 +167,8 @@ func (o *AStar) ArePointsConnected(id gdnative.Int, toId gdnative.Int) gdnative.
 }
 
 /*
-        Clears all the points and segments.
-	Args: [], Returns: void
+	        Clears all the points and segments.
+		Args: [], Returns: void
 */
 func (o *AStar) Clear() {
 	//log.Println("Calling AStar.Clear()")
@@ -143,7 +177,7 @@ func (o *AStar) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "clear")
+	methodBind := methodBindAStarClear.Get()
 
 	// Call the parent method.
 	// void
@@ -153,8 +187,8 @@ func (o *AStar) Clear() {
 }
 
 /*
-        Creates a segment between the given points. [codeblock] var as = AStar.new() as.add_point(1, Vector3(1,1,0)) as.add_point(2, Vector3(0,5,0)) as.connect_points(1, 2, false) # If bidirectional=false it's only possible to go from point 1 to point 2 # and not from point 2 to point 1. [/codeblock]
-	Args: [{ false id int} { false to_id int} {True true bidirectional bool}], Returns: void
+	        Creates a segment between the given points. [codeblock] var as = AStar.new() as.add_point(1, Vector3(1,1,0)) as.add_point(2, Vector3(0,5,0)) as.connect_points(1, 2, false) # If bidirectional=false it's only possible to go from point 1 to point 2 # and not from point 2 to point 1. [/codeblock]
+		Args: [{ false id int} { false to_id int} {True true bidirectional bool}], Returns: void
 */
 func (o *AStar) ConnectPoints(id gdnative.Int, toId gdnative.Int, bidirectional gdnative.Bool) {
 	//log.Println("Calling AStar.ConnectPoints()")
@@ -166,7 +200,7 @@ func (o *AStar) ConnectPoints(id gdnative.Int, toId gdnative.Int, bidirectional
 	ptrArguments[2] = gdnative.NewPointerFromBool(bidirectional)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "connect_points")
+	methodBind := methodBindAStarConnectPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -176,8 +210,8 @@ func (o *AStar) ConnectPoints(id gdnative.Int, toId gdnative.Int, bidirectional
 }
 
 /*
-        Deletes the segment between the given points.
-	Args: [{ false id int} { false to_id int}], Returns: void
+	        Deletes the segment between the given points.
+		Args: [{ false id int} { false to_id int}], Returns: void
 */
 func (o *AStar) DisconnectPoints(id gdnative.Int, toId gdnative.Int) {
 	//log.Println("Calling AStar.DisconnectPoints()")
@@ -188,7 +222,7 @@ func (o *AStar) DisconnectPoints(id gdnative.Int, toId gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(toId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "disconnect_points")
+	methodBind := methodBindAStarDisconnectPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -198,8 +232,8 @@ func (o *AStar) DisconnectPoints(id gdnative.Int, toId gdnative.Int) {
 }
 
 /*
-        Returns the next available point id with no point associated to it.
-	Args: [], Returns: int
+	        Returns the next available point id with no point associated to it.
+		Args: [], Returns: int
 */
 func (o *AStar) GetAvailablePointId() gdnative.Int {
 	//log.Println("Calling AStar.GetAvailablePointId()")
@@ -208,7 +242,7 @@ func (o *AStar) GetAvailablePointId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_available_point_id")
+	methodBind := methodBindAStarGetAvailablePointId.Get()
 
 	// Call the parent method.
 	// int
@@ -221,8 +255,8 @@ func (o *AStar) GetAvailablePointId() gdnative.Int {
 }
 
 /*
-        Returns the id of the closest point to [code]to_position[/code]. Returns -1 if there are no points in the points pool.
-	Args: [{ false to_position Vector3}], Returns: int
+	        Returns the id of the closest point to [code]to_position[/code]. Returns -1 if there are no points in the points pool.
+		Args: [{ false to_position Vector3}], Returns: int
 */
 func (o *AStar) GetClosestPoint(toPosition gdnative.Vector3) gdnative.Int {
 	//log.Println("Calling AStar.GetClosestPoint()")
@@ -232,7 +266,7 @@ func (o *AStar) GetClosestPoint(toPosition gdnative.Vector3) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_closest_point")
+	methodBind := methodBindAStarGetClosestPoint.Get()
 
 	// Call the parent method.
 	// int
@@ -245,8 +279,8 @@ func (o *AStar) GetClosestPoint(toPosition gdnative.Vector3) gdnative.Int {
 }
 
 /*
-        Returns the closest position to [code]to_position[/code] that resides inside a segment between two connected points. [codeblock] var as = AStar.new() as.add_point(1, Vector3(0,0,0)) as.add_point(2, Vector3(0,5,0)) as.connect_points(1, 2) var res = as.get_closest_position_in_segment(Vector3(3,3,0)) # returns (0, 3, 0) [/codeblock] The result is in the segment that goes from [code]y=0[/code] to [code]y=5[/code]. It's the closest position in the segment to the given point.
-	Args: [{ false to_position Vector3}], Returns: Vector3
+	        Returns the closest position to [code]to_position[/code] that resides inside a segment between two connected points. [codeblock] var as = AStar.new() as.add_point(1, Vector3(0,0,0)) as.add_point(2, Vector3(0,5,0)) as.connect_points(1, 2) var res = as.get_closest_position_in_segment(Vector3(3,3,0)) # returns (0, 3, 0) [/codeblock] The result is in the segment that goes from [code]y=0[/code] to [code]y=5[/code]. It's the closest position in the segment to the given point.
+		Args: [{ false to_position Vector3}], Returns: Vector3
 */
 func (o *AStar) GetClosestPositionInSegment(toPosition gdnative.Vector3) gdnative.Vector3 {
 	//log.Println("Calling AStar.GetClosestPositionInSegment()")
@@ -256,7 +290,7 @@ func (o *AStar) GetClosestPositionInSegment(toPosition gdnative.Vector3) gdnativ
 	ptrArguments[0] = gdnative.NewPointerFromVector3(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_closest_position_in_segment")
+	methodBind := methodBindAStarGetClosestPositionInSegment.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -269,8 +303,8 @@ func (o *AStar) GetClosestPositionInSegment(toPosition gdnative.Vector3) gdnativ
 }
 
 /*
-        Returns an array with the ids of the points that form the path found by AStar between the given points. The array is ordered from the starting point to the ending point of the path. [codeblock] var as = AStar.new() as.add_point(1, Vector3(0,0,0)) as.add_point(2, Vector3(0,1,0), 1) # default weight is 1 as.add_point(3, Vector3(1,1,0)) as.add_point(4, Vector3(2,0,0)) as.connect_points(1, 2, false) as.connect_points(2, 3, false) as.connect_points(4, 3, false) as.connect_points(1, 4, false) as.connect_points(5, 4, false) var res = as.get_id_path(1, 3) # returns [1, 2, 3] [/codeblock] If you change the 2nd point's weight to 3, then the result will be [code][1, 4, 3][/code] instead, because now even though the distance is longer, it's "easier" to get through point 4 than through point 2.
-	Args: [{ false from_id int} { false to_id int}], Returns: PoolIntArray
+	        Returns an array with the ids of the points that form the path found by AStar between the given points. The array is ordered from the starting point to the ending point of the path. [codeblock] var as = AStar.new() as.add_point(1, Vector3(0,0,0)) as.add_point(2, Vector3(0,1,0), 1) # default weight is 1 as.add_point(3, Vector3(1,1,0)) as.add_point(4, Vector3(2,0,0)) as.connect_points(1, 2, false) as.connect_points(2, 3, false) as.connect_points(4, 3, false) as.connect_points(1, 4, false) as.connect_points(5, 4, false) var res = as.get_id_path(1, 3) # returns [1, 2, 3] [/codeblock] If you change the 2nd point's weight to 3, then the result will be [code][1, 4, 3][/code] instead, because now even though the distance is longer, it's "easier" to get through point 4 than through point 2.
+		Args: [{ false from_id int} { false to_id int}], Returns: PoolIntArray
 */
 func (o *AStar) GetIdPath(fromId gdnative.Int, toId gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling AStar.GetIdPath()")
@@ -281,7 +315,7 @@ func (o *AStar) GetIdPath(fromId gdnative.Int, toId gdnative.Int) gdnative.PoolI
 	ptrArguments[1] = gdnative.NewPointerFromInt(toId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_id_path")
+	methodBind := methodBindAStarGetIdPath.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -294,8 +328,8 @@ func (o *AStar) GetIdPath(fromId gdnative.Int, toId gdnative.Int) gdnative.PoolI
 }
 
 /*
-        Returns an array with the ids of the points that form the connect with the given point. [codeblock] var as = AStar.new() as.add_point(1, Vector3(0,0,0)) as.add_point(2, Vector3(0,1,0)) as.add_point(3, Vector3(1,1,0)) as.add_point(4, Vector3(2,0,0)) as.connect_points(1, 2, true) as.connect_points(1, 3, true) var neighbors = as.get_point_connections(1) # returns [2, 3] [/codeblock]
-	Args: [{ false id int}], Returns: PoolIntArray
+	        Returns an array with the ids of the points that form the connect with the given point. [codeblock] var as = AStar.new() as.add_point(1, Vector3(0,0,0)) as.add_point(2, Vector3(0,1,0)) as.add_point(3, Vector3(1,1,0)) as.add_point(4, Vector3(2,0,0)) as.connect_points(1, 2, true) as.connect_points(1, 3, true) var neighbors = as.get_point_connections(1) # returns [2, 3] [/codeblock]
+		Args: [{ false id int}], Returns: PoolIntArray
 */
 func (o *AStar) GetPointConnections(id gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling AStar.GetPointConnections()")
@@ -305,7 +339,7 @@ func (o *AStar) GetPointConnections(id gdnative.Int) gdnative.PoolIntArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_point_connections")
+	methodBind := methodBindAStarGetPointConnections.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -318,8 +352,8 @@ func (o *AStar) GetPointConnections(id gdnative.Int) gdnative.PoolIntArray {
 }
 
 /*
-        Returns an array with the points that are in the path found by AStar between the given points. The array is ordered from the starting point to the ending point of the path.
-	Args: [{ false from_id int} { false to_id int}], Returns: PoolVector3Array
+	        Returns an array with the points that are in the path found by AStar between the given points. The array is ordered from the starting point to the ending point of the path.
+		Args: [{ false from_id int} { false to_id int}], Returns: PoolVector3Array
 */
 func (o *AStar) GetPointPath(fromId gdnative.Int, toId gdnative.Int) gdnative.PoolVector3Array {
 	//log.Println("Calling AStar.GetPointPath()")
@@ -330,7 +364,7 @@ func (o *AStar) GetPointPath(fromId gdnative.Int, toId gdnative.Int) gdnative.Po
 	ptrArguments[1] = gdnative.NewPointerFromInt(toId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_point_path")
+	methodBind := methodBindAStarGetPointPath.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -343,8 +377,8 @@ func (o *AStar) GetPointPath(fromId gdnative.Int, toId gdnative.Int) gdnative.Po
 }
 
 /*
-        Returns the position of the point associated with the given id.
-	Args: [{ false id int}], Returns: Vector3
+	        Returns the position of the point associated with the given id.
+		Args: [{ false id int}], Returns: Vector3
 */
 func (o *AStar) GetPointPosition(id gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling AStar.GetPointPosition()")
@@ -354,7 +388,7 @@ func (o *AStar) GetPointPosition(id gdnative.Int) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_point_position")
+	methodBind := methodBindAStarGetPointPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -367,8 +401,8 @@ func (o *AStar) GetPointPosition(id gdnative.Int) gdnative.Vector3 {
 }
 
 /*
-        Returns the weight scale of the point associated with the given id.
-	Args: [{ false id int}], Returns: float
+	        Returns the weight scale of the point associated with the given id.
+		Args: [{ false id int}], Returns: float
 */
 func (o *AStar) GetPointWeightScale(id gdnative.Int) gdnative.Real {
 	//log.Println("Calling AStar.GetPointWeightScale()")
@@ -378,7 +412,7 @@ func (o *AStar) GetPointWeightScale(id gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_point_weight_scale")
+	methodBind := methodBindAStarGetPointWeightScale.Get()
 
 	// Call the parent method.
 	// float
@@ -391,8 +425,8 @@ func (o *AStar) GetPointWeightScale(id gdnative.Int) gdnative.Real {
 }
 
 /*
-        Returns an array of all points.
-	Args: [], Returns: Array
+	        Returns an array of all points.
+		Args: [], Returns: Array
 */
 func (o *AStar) GetPoints() gdnative.Array {
 	//log.Println("Calling AStar.GetPoints()")
@@ -401,7 +435,7 @@ func (o *AStar) GetPoints() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "get_points")
+	methodBind := methodBindAStarGetPoints.Get()
 
 	// Call the parent method.
 	// Array
@@ -414,8 +448,8 @@ func (o *AStar) GetPoints() gdnative.Array {
 }
 
 /*
-        Returns whether a point associated with the given id exists.
-	Args: [{ false id int}], Returns: bool
+	        Returns whether a point associated with the given id exists.
+		Args: [{ false id int}], Returns: bool
 */
 func (o *AStar) HasPoint(id gdnative.Int) gdnative.Bool {
 	//log.Println("Calling AStar.HasPoint()")
@@ -425,7 +459,7 @@ func (o *AStar) HasPoint(id gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "has_point")
+	methodBind := methodBindAStarHasPoint.Get()
 
 	// Call the parent method.
 	// bool
@@ -438,8 +472,8 @@ func (o *AStar) HasPoint(id gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Removes the point associated with the given id from the points pool.
-	Args: [{ false id int}], Returns: void
+	        Removes the point associated with the given id from the points pool.
+		Args: [{ false id int}], Returns: void
 */
 func (o *AStar) RemovePoint(id gdnative.Int) {
 	//log.Println("Calling AStar.RemovePoint()")
@@ -449,7 +483,7 @@ func (o *AStar) RemovePoint(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "remove_point")
+	methodBind := methodBindAStarRemovePoint.Get()
 
 	// Call the parent method.
 	// void
@@ -459,8 +493,8 @@ func (o *AStar) RemovePoint(id gdnative.Int) {
 }
 
 /*
-        Sets the position for the point with the given id.
-	Args: [{ false id int} { false position Vector3}], Returns: void
+	        Sets the position for the point with the given id.
+		Args: [{ false id int} { false position Vector3}], Returns: void
 */
 func (o *AStar) SetPointPosition(id gdnative.Int, position gdnative.Vector3) {
 	//log.Println("Calling AStar.SetPointPosition()")
@@ -471,7 +505,7 @@ func (o *AStar) SetPointPosition(id gdnative.Int, position gdnative.Vector3) {
 	ptrArguments[1] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "set_point_position")
+	methodBind := methodBindAStarSetPointPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -481,8 +515,8 @@ func (o *AStar) SetPointPosition(id gdnative.Int, position gdnative.Vector3) {
 }
 
 /*
-        Sets the [code]weight_scale[/code] for the point with the given id.
-	Args: [{ false id int} { false weight_scale float}], Returns: void
+	        Sets the [code]weight_scale[/code] for the point with the given id.
+		Args: [{ false id int} { false weight_scale float}], Returns: void
 */
 func (o *AStar) SetPointWeightScale(id gdnative.Int, weightScale gdnative.Real) {
 	//log.Println("Calling AStar.SetPointWeightScale()")
@@ -493,7 +527,7 @@ func (o *AStar) SetPointWeightScale(id gdnative.Int, weightScale gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(weightScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AStar", "set_point_weight_scale")
+	methodBind := methodBindAStarSetPointWeightScale.Get()
 
 	// Call the parent method.
 	// void
@@ -502,10 +536,20 @@ func (o *AStar) SetPointWeightScale(id gdnative.Int, weightScale gdnative.Real)
 
 }
 
+// AddPointWithDefaults will call AddPoint using the default values for: weight_scale.
+func (o *AStar) AddPointWithDefaults(id gdnative.Int, position gdnative.Vector3) {
+	o.AddPoint(id, position, 1)
+}
+
+// ConnectPointsWithDefaults will call ConnectPoints using the default values for: bidirectional.
+func (o *AStar) ConnectPointsWithDefaults(id gdnative.Int, toId gdnative.Int) {
+	o.ConnectPoints(id, toId, true)
+}
+
 // AStarImplementer is an interface that implements the methods
 // of the AStar class.
 type AStarImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	X_ComputeCost(fromId gdnative.Int, toId gdnative.Int) gdnative.Real
 	X_EstimateCost(fromId gdnative.Int, toId gdnative.Int) gdnative.Real
 	AddPoint(id gdnative.Int, position gdnative.Vector3, weightScale gdnative.Real)
@@ -526,4 +570,6 @@ type AStarImplementer interface {
 	RemovePoint(id gdnative.Int)
 	SetPointPosition(id gdnative.Int, position gdnative.Vector3)
 	SetPointWeightScale(id gdnative.Int, weightScale gdnative.Real)
+	AddPointWithDefaults(id gdnative.Int, position gdnative.Vector3)
+	ConnectPointsWithDefaults(id gdnative.Int, toId gdnative.Int)
 }
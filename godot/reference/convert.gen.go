@@ -0,0 +1,145 @@
+package reference
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("AStar", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AStar{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("EncodedObjectAsID", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &EncodedObjectAsID{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("FuncRef", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &FuncRef{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GDNative", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GDNative{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GDScriptFunctionState", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GDScriptFunctionState{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HTTPClient", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HTTPClient{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("JSONParseResult", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &JSONParseResult{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("KinematicCollision2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &KinematicCollision2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("MeshDataTool", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &MeshDataTool{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("MobileVRInterface", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &MobileVRInterface{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("NetworkedMultiplayerENet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &NetworkedMultiplayerENet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PCKPacker", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PCKPacker{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PackedDataContainerRef", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PackedDataContainerRef{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PacketPeerStream", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PacketPeerStream{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PacketPeerUDP", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PacketPeerUDP{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ReferenceRect", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ReferenceRect{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SurfaceTool", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SurfaceTool{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("TCP_Server", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &TCP_Server{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("WeakRef", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &WeakRef{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("XMLParser", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &XMLParser{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("_Directory", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Directory{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("_File", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &File{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("_Mutex", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Mutex{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("_Semaphore", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Semaphore{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("_Thread", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Thread{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,61 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewDirectoryFromPointer(ptr gdnative.Pointer) Directory {
-func new_DirectoryFromPointer(ptr gdnative.Pointer) Directory {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Directory{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Directory type. It is used to manage directories and their content (not restricted to the project folder). Here is an example on how to iterate through the files of a directory: [codeblock] func dir_contents(path): var dir = Directory.new() if dir.open(path) == OK: dir.list_dir_begin() var file_name = dir.get_next() while (file_name != ""): if dir.current_is_dir(): print("Found directory: " + file_name) else: print("Found file: " + file_name) file_name = dir.get_next() else: print("An error occurred when trying to access the path.") [/codeblock]
 */
 type Directory struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorDirectory is the class constructor of the Directory class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorDirectory = gdnative.NewLazyClassConstructor("_Directory")
+
+// NewDirectory will create a new instance of the Directory class.
+// Directory is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewDirectory() *Directory {
+	obj := &Directory{}
+	obj.SetBaseObject(classConstructorDirectory.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Directory) BaseClass() string {
 	return "_Directory"
 }
 
+// Method binds of the Directory class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindDirectoryChangeDir        = gdnative.NewLazyMethodBind("_Directory", "change_dir")
+	methodBindDirectoryCopy             = gdnative.NewLazyMethodBind("_Directory", "copy")
+	methodBindDirectoryCurrentIsDir     = gdnative.NewLazyMethodBind("_Directory", "current_is_dir")
+	methodBindDirectoryDirExists        = gdnative.NewLazyMethodBind("_Directory", "dir_exists")
+	methodBindDirectoryFileExists       = gdnative.NewLazyMethodBind("_Directory", "file_exists")
+	methodBindDirectoryGetCurrentDir    = gdnative.NewLazyMethodBind("_Directory", "get_current_dir")
+	methodBindDirectoryGetCurrentDrive  = gdnative.NewLazyMethodBind("_Directory", "get_current_drive")
+	methodBindDirectoryGetDrive         = gdnative.NewLazyMethodBind("_Directory", "get_drive")
+	methodBindDirectoryGetDriveCount    = gdnative.NewLazyMethodBind("_Directory", "get_drive_count")
+	methodBindDirectoryGetNext          = gdnative.NewLazyMethodBind("_Directory", "get_next")
+	methodBindDirectoryGetSpaceLeft     = gdnative.NewLazyMethodBind("_Directory", "get_space_left")
+	methodBindDirectoryListDirBegin     = gdnative.NewLazyMethodBind("_Directory", "list_dir_begin")
+	methodBindDirectoryListDirEnd       = gdnative.NewLazyMethodBind("_Directory", "list_dir_end")
+	methodBindDirectoryMakeDir          = gdnative.NewLazyMethodBind("_Directory", "make_dir")
+	methodBindDirectoryMakeDirRecursive = gdnative.NewLazyMethodBind("_Directory", "make_dir_recursive")
+	methodBindDirectoryOpen             = gdnative.NewLazyMethodBind("_Directory", "open")
+	methodBindDirectoryRemove           = gdnative.NewLazyMethodBind("_Directory", "remove")
+	methodBindDirectoryRename           = gdnative.NewLazyMethodBind("_Directory", "rename")
+)
+
 /*
-        Undocumented
-	Args: [{ false todir String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false todir String}], Returns: enum.Error
 */
 func (o *Directory) ChangeDir(todir gdnative.String) gdnative.Error {
 	//log.Println("Calling _Directory.ChangeDir()")
@@ -46,7 +78,7 @@ func (o *Directory) ChangeDir(todir gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(todir)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "change_dir")
+	methodBind := methodBindDirectoryChangeDir.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -59,8 +91,8 @@ func (o *Directory) ChangeDir(todir gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false from String} { false to String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false from String} { false to String}], Returns: enum.Error
 */
 func (o *Directory) Copy(from gdnative.String, to gdnative.String) gdnative.Error {
 	//log.Println("Calling _Directory.Copy()")
@@ -71,7 +103,7 @@ func (o *Directory) Copy(from gdnative.String, to gdnative.String) gdnative.Erro
 	ptrArguments[1] = gdnative.NewPointerFromString(to)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "copy")
+	methodBind := methodBindDirectoryCopy.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -84,8 +116,8 @@ func (o *Directory) Copy(from gdnative.String, to gdnative.String) gdnative.Erro
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Directory) CurrentIsDir() gdnative.Bool {
 	//log.Println("Calling _Directory.CurrentIsDir()")
@@ -94,7 +126,7 @@ func (o *Directory) CurrentIsDir() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "current_is_dir")
+	methodBind := methodBindDirectoryCurrentIsDir.Get()
 
 	// Call the parent method.
 	// bool
@@ -107,8 +139,8 @@ func (o *Directory) CurrentIsDir() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: bool
+	        Undocumented
+		Args: [{ false path String}], Returns: bool
 */
 func (o *Directory) DirExists(path gdnative.String) gdnative.Bool {
 	//log.Println("Calling _Directory.DirExists()")
@@ -118,7 +150,7 @@ func (o *Directory) DirExists(path gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "dir_exists")
+	methodBind := methodBindDirectoryDirExists.Get()
 
 	// Call the parent method.
 	// bool
@@ -131,8 +163,8 @@ func (o *Directory) DirExists(path gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: bool
+	        Undocumented
+		Args: [{ false path String}], Returns: bool
 */
 func (o *Directory) FileExists(path gdnative.String) gdnative.Bool {
 	//log.Println("Calling _Directory.FileExists()")
@@ -142,7 +174,7 @@ func (o *Directory) FileExists(path gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "file_exists")
+	methodBind := methodBindDirectoryFileExists.Get()
 
 	// Call the parent method.
 	// bool
@@ -155,8 +187,8 @@ func (o *Directory) FileExists(path gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Directory) GetCurrentDir() gdnative.String {
 	//log.Println("Calling _Directory.GetCurrentDir()")
@@ -165,7 +197,7 @@ func (o *Directory) GetCurrentDir() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "get_current_dir")
+	methodBind := methodBindDirectoryGetCurrentDir.Get()
 
 	// Call the parent method.
 	// String
@@ -178,8 +210,8 @@ func (o *Directory) GetCurrentDir() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Directory) GetCurrentDrive() gdnative.Int {
 	//log.Println("Calling _Directory.GetCurrentDrive()")
@@ -188,7 +220,7 @@ func (o *Directory) GetCurrentDrive() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "get_current_drive")
+	methodBind := methodBindDirectoryGetCurrentDrive.Get()
 
 	// Call the parent method.
 	// int
@@ -201,8 +233,8 @@ func (o *Directory) GetCurrentDrive() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: String
+	        Undocumented
+		Args: [{ false idx int}], Returns: String
 */
 func (o *Directory) GetDrive(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling _Directory.GetDrive()")
@@ -212,7 +244,7 @@ func (o *Directory) GetDrive(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "get_drive")
+	methodBind := methodBindDirectoryGetDrive.Get()
 
 	// Call the parent method.
 	// String
@@ -225,8 +257,8 @@ func (o *Directory) GetDrive(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Directory) GetDriveCount() gdnative.Int {
 	//log.Println("Calling _Directory.GetDriveCount()")
@@ -235,7 +267,7 @@ func (o *Directory) GetDriveCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "get_drive_count")
+	methodBind := methodBindDirectoryGetDriveCount.Get()
 
 	// Call the parent method.
 	// int
@@ -248,8 +280,8 @@ func (o *Directory) GetDriveCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Directory) GetNext() gdnative.String {
 	//log.Println("Calling _Directory.GetNext()")
@@ -258,7 +290,7 @@ func (o *Directory) GetNext() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "get_next")
+	methodBind := methodBindDirectoryGetNext.Get()
 
 	// Call the parent method.
 	// String
@@ -271,8 +303,8 @@ func (o *Directory) GetNext() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Directory) GetSpaceLeft() gdnative.Int {
 	//log.Println("Calling _Directory.GetSpaceLeft()")
@@ -281,7 +313,7 @@ func (o *Directory) GetSpaceLeft() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "get_space_left")
+	methodBind := methodBindDirectoryGetSpaceLeft.Get()
 
 	// Call the parent method.
 	// int
@@ -294,8 +326,8 @@ func (o *Directory) GetSpaceLeft() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{False true skip_navigational bool} {False true skip_hidden bool}], Returns: enum.Error
+	        Undocumented
+		Args: [{False true skip_navigational bool} {False true skip_hidden bool}], Returns: enum.Error
 */
 func (o *Directory) ListDirBegin(skipNavigational gdnative.Bool, skipHidden gdnative.Bool) gdnative.Error {
 	//log.Println("Calling _Directory.ListDirBegin()")
@@ -306,7 +338,7 @@ func (o *Directory) ListDirBegin(skipNavigational gdnative.Bool, skipHidden gdna
 	ptrArguments[1] = gdnative.NewPointerFromBool(skipHidden)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "list_dir_begin")
+	methodBind := methodBindDirectoryListDirBegin.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -319,8 +351,8 @@ func (o *Directory) ListDirBegin(skipNavigational gdnative.Bool, skipHidden gdna
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Directory) ListDirEnd() {
 	//log.Println("Calling _Directory.ListDirEnd()")
@@ -329,7 +361,7 @@ func (o *Directory) ListDirEnd() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "list_dir_end")
+	methodBind := methodBindDirectoryListDirEnd.Get()
 
 	// Call the parent method.
 	// void
@@ -339,8 +371,8 @@ func (o *Directory) ListDirEnd() {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *Directory) MakeDir(path gdnative.String) gdnative.Error {
 	//log.Println("Calling _Directory.MakeDir()")
@@ -350,7 +382,7 @@ func (o *Directory) MakeDir(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "make_dir")
+	methodBind := methodBindDirectoryMakeDir.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -363,8 +395,8 @@ func (o *Directory) MakeDir(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *Directory) MakeDirRecursive(path gdnative.String) gdnative.Error {
 	//log.Println("Calling _Directory.MakeDirRecursive()")
@@ -374,7 +406,7 @@ func (o *Directory) MakeDirRecursive(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "make_dir_recursive")
+	methodBind := methodBindDirectoryMakeDirRecursive.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -387,8 +419,8 @@ func (o *Directory) MakeDirRecursive(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *Directory) Open(path gdnative.String) gdnative.Error {
 	//log.Println("Calling _Directory.Open()")
@@ -398,7 +430,7 @@ func (o *Directory) Open(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "open")
+	methodBind := methodBindDirectoryOpen.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -411,8 +443,8 @@ func (o *Directory) Open(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *Directory) Remove(path gdnative.String) gdnative.Error {
 	//log.Println("Calling _Directory.Remove()")
@@ -422,7 +454,7 @@ func (o *Directory) Remove(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "remove")
+	methodBind := methodBindDirectoryRemove.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -435,8 +467,8 @@ func (o *Directory) Remove(path gdnative.String) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false from String} { false to String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false from String} { false to String}], Returns: enum.Error
 */
 func (o *Directory) Rename(from gdnative.String, to gdnative.String) gdnative.Error {
 	//log.Println("Calling _Directory.Rename()")
@@ -447,7 +479,7 @@ func (o *Directory) Rename(from gdnative.String, to gdnative.String) gdnative.Er
 	ptrArguments[1] = gdnative.NewPointerFromString(to)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Directory", "rename")
+	methodBind := methodBindDirectoryRename.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -459,10 +491,17 @@ func (o *Directory) Rename(from gdnative.String, to gdnative.String) gdnative.Er
 	return gdnative.Error(ret)
 }
 
+// ListDirBeginWithDefaults will call ListDirBegin using the default values for: skip_navigational, skip_hidden.
+func (o *Directory) ListDirBeginWithDefaults() gdnative.Error {
+	return o.ListDirBegin(false, false)
+}
+
 // DirectoryImplementer is an interface that implements the methods
 // of the Directory class.
 type DirectoryImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
+	ChangeDir(todir gdnative.String) gdnative.Error
+	Copy(from gdnative.String, to gdnative.String) gdnative.Error
 	CurrentIsDir() gdnative.Bool
 	DirExists(path gdnative.String) gdnative.Bool
 	FileExists(path gdnative.String) gdnative.Bool
@@ -472,5 +511,12 @@ type DirectoryImplementer interface {
 	GetDriveCount() gdnative.Int
 	GetNext() gdnative.String
 	GetSpaceLeft() gdnative.Int
+	ListDirBegin(skipNavigational gdnative.Bool, skipHidden gdnative.Bool) gdnative.Error
 	ListDirEnd()
+	MakeDir(path gdnative.String) gdnative.Error
+	MakeDirRecursive(path gdnative.String) gdnative.Error
+	Open(path gdnative.String) gdnative.Error
+	Remove(path gdnative.String) gdnative.Error
+	Rename(from gdnative.String, to gdnative.String) gdnative.Error
+	ListDirBeginWithDefaults() gdnative.Error
 }
@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,43 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewEncodedObjectAsIDFromPointer(ptr gdnative.Pointer) EncodedObjectAsID {
-func newEncodedObjectAsIDFromPointer(ptr gdnative.Pointer) EncodedObjectAsID {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := EncodedObjectAsID{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type EncodedObjectAsID struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorEncodedObjectAsID is the class constructor of the EncodedObjectAsID class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorEncodedObjectAsID = gdnative.NewLazyClassConstructor("EncodedObjectAsID")
+
+// NewEncodedObjectAsID will create a new instance of the EncodedObjectAsID class.
+// EncodedObjectAsID is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewEncodedObjectAsID() *EncodedObjectAsID {
+	obj := &EncodedObjectAsID{}
+	obj.SetBaseObject(classConstructorEncodedObjectAsID.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *EncodedObjectAsID) BaseClass() string {
 	return "EncodedObjectAsID"
 }
 
-/*
+// Method binds of the EncodedObjectAsID class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindEncodedObjectAsIDGetObjectId = gdnative.NewLazyMethodBind("EncodedObjectAsID", "get_object_id")
+	methodBindEncodedObjectAsIDSetObjectId = gdnative.NewLazyMethodBind("EncodedObjectAsID", "set_object_id")
+)
 
-	Args: [], Returns: int
+/*
+Args: [], Returns: int
 */
 func (o *EncodedObjectAsID) GetObjectId() gdnative.Int {
 	//log.Println("Calling EncodedObjectAsID.GetObjectId()")
@@ -45,7 +59,7 @@ func (o *EncodedObjectAsID) GetObjectId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EncodedObjectAsID", "get_object_id")
+	methodBind := methodBindEncodedObjectAsIDGetObjectId.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +72,7 @@ func (o *EncodedObjectAsID) GetObjectId() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false id int}], Returns: void
+Args: [{ false id int}], Returns: void
 */
 func (o *EncodedObjectAsID) SetObjectId(id gdnative.Int) {
 	//log.Println("Calling EncodedObjectAsID.SetObjectId()")
@@ -69,7 +82,7 @@ func (o *EncodedObjectAsID) SetObjectId(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("EncodedObjectAsID", "set_object_id")
+	methodBind := methodBindEncodedObjectAsIDSetObjectId.Get()
 
 	// Call the parent method.
 	// void
@@ -81,7 +94,7 @@ func (o *EncodedObjectAsID) SetObjectId(id gdnative.Int) {
 // EncodedObjectAsIDImplementer is an interface that implements the methods
 // of the EncodedObjectAsID class.
 type EncodedObjectAsIDImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetObjectId() gdnative.Int
 	SetObjectId(id gdnative.Int)
 }
@@ -1,7 +1,10 @@
-package godot
+package reference
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,6 +26,36 @@ const (
 	_FileCompressionZstd    _FileCompressionMode = 2
 )
 
+// String will return the Godot name of the _FileCompressionMode value.
+func (e _FileCompressionMode) String() string {
+	switch e {
+	case _FileCompressionDeflate:
+		return "COMPRESSION_DEFLATE"
+	case _FileCompressionFastlz:
+		return "COMPRESSION_FASTLZ"
+	case _FileCompressionGzip:
+		return "COMPRESSION_GZIP"
+	case _FileCompressionZstd:
+		return "COMPRESSION_ZSTD"
+	}
+	return fmt.Sprintf("_FileCompressionMode(%d)", int(e))
+}
+
+// Parse_FileCompressionMode will return the _FileCompressionMode value with the given Godot name.
+func Parse_FileCompressionMode(name string) (_FileCompressionMode, error) {
+	switch name {
+	case "COMPRESSION_DEFLATE":
+		return _FileCompressionDeflate, nil
+	case "COMPRESSION_FASTLZ":
+		return _FileCompressionFastlz, nil
+	case "COMPRESSION_GZIP":
+		return _FileCompressionGzip, nil
+	case "COMPRESSION_ZSTD":
+		return _FileCompressionZstd, nil
+	}
+	return 0, fmt.Errorf("invalid _FileCompressionMode value %q", name)
+}
+
 // _FileModeFlags is an enum for ModeFlags values.
 type _FileModeFlags int
 
@@ -33,30 +66,116 @@ const (
 	_FileWriteRead _FileModeFlags = 7
 )
 
-//func NewFileFromPointer(ptr gdnative.Pointer) File {
-func new_FileFromPointer(ptr gdnative.Pointer) File {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := File{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the _FileModeFlags value.
+func (e _FileModeFlags) String() string {
+	switch e {
+	case _FileRead:
+		return "READ"
+	case _FileReadWrite:
+		return "READ_WRITE"
+	case _FileWrite:
+		return "WRITE"
+	case _FileWriteRead:
+		return "WRITE_READ"
+	}
+	return fmt.Sprintf("_FileModeFlags(%d)", int(e))
+}
 
-	return obj
+// Parse_FileModeFlags will return the _FileModeFlags value with the given Godot name.
+func Parse_FileModeFlags(name string) (_FileModeFlags, error) {
+	switch name {
+	case "READ":
+		return _FileRead, nil
+	case "READ_WRITE":
+		return _FileReadWrite, nil
+	case "WRITE":
+		return _FileWrite, nil
+	case "WRITE_READ":
+		return _FileWriteRead, nil
+	}
+	return 0, fmt.Errorf("invalid _FileModeFlags value %q", name)
 }
 
 /*
 File type. This is used to permanently store data into the user device's file system and to read from it. This can be used to store game save data or player configuration files, for example. Here's a sample on how to write and read from a file: [codeblock] func save(content): var file = File.new() file.open("user://save_game.dat", file.WRITE) file.store_string(content) file.close() func load(): var file = File.new() file.open("user://save_game.dat", file.READ) var content = file.get_as_text() file.close() return content [/codeblock]
 */
 type File struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorFile is the class constructor of the File class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorFile = gdnative.NewLazyClassConstructor("_File")
+
+// NewFile will create a new instance of the File class.
+// File is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewFile() *File {
+	obj := &File{}
+	obj.SetBaseObject(classConstructorFile.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *File) BaseClass() string {
 	return "_File"
 }
 
+// Method binds of the File class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindFileClose                 = gdnative.NewLazyMethodBind("_File", "close")
+	methodBindFileEofReached            = gdnative.NewLazyMethodBind("_File", "eof_reached")
+	methodBindFileFileExists            = gdnative.NewLazyMethodBind("_File", "file_exists")
+	methodBindFileGet16                 = gdnative.NewLazyMethodBind("_File", "get_16")
+	methodBindFileGet32                 = gdnative.NewLazyMethodBind("_File", "get_32")
+	methodBindFileGet64                 = gdnative.NewLazyMethodBind("_File", "get_64")
+	methodBindFileGet8                  = gdnative.NewLazyMethodBind("_File", "get_8")
+	methodBindFileGetAsText             = gdnative.NewLazyMethodBind("_File", "get_as_text")
+	methodBindFileGetBuffer             = gdnative.NewLazyMethodBind("_File", "get_buffer")
+	methodBindFileGetCsvLine            = gdnative.NewLazyMethodBind("_File", "get_csv_line")
+	methodBindFileGetDouble             = gdnative.NewLazyMethodBind("_File", "get_double")
+	methodBindFileGetEndianSwap         = gdnative.NewLazyMethodBind("_File", "get_endian_swap")
+	methodBindFileGetError              = gdnative.NewLazyMethodBind("_File", "get_error")
+	methodBindFileGetFloat              = gdnative.NewLazyMethodBind("_File", "get_float")
+	methodBindFileGetLen                = gdnative.NewLazyMethodBind("_File", "get_len")
+	methodBindFileGetLine               = gdnative.NewLazyMethodBind("_File", "get_line")
+	methodBindFileGetMd5                = gdnative.NewLazyMethodBind("_File", "get_md5")
+	methodBindFileGetModifiedTime       = gdnative.NewLazyMethodBind("_File", "get_modified_time")
+	methodBindFileGetPascalString       = gdnative.NewLazyMethodBind("_File", "get_pascal_string")
+	methodBindFileGetPosition           = gdnative.NewLazyMethodBind("_File", "get_position")
+	methodBindFileGetReal               = gdnative.NewLazyMethodBind("_File", "get_real")
+	methodBindFileGetSha256             = gdnative.NewLazyMethodBind("_File", "get_sha256")
+	methodBindFileGetVar                = gdnative.NewLazyMethodBind("_File", "get_var")
+	methodBindFileIsOpen                = gdnative.NewLazyMethodBind("_File", "is_open")
+	methodBindFileOpen                  = gdnative.NewLazyMethodBind("_File", "open")
+	methodBindFileOpenCompressed        = gdnative.NewLazyMethodBind("_File", "open_compressed")
+	methodBindFileOpenEncrypted         = gdnative.NewLazyMethodBind("_File", "open_encrypted")
+	methodBindFileOpenEncryptedWithPass = gdnative.NewLazyMethodBind("_File", "open_encrypted_with_pass")
+	methodBindFileSeek                  = gdnative.NewLazyMethodBind("_File", "seek")
+	methodBindFileSeekEnd               = gdnative.NewLazyMethodBind("_File", "seek_end")
+	methodBindFileSetEndianSwap         = gdnative.NewLazyMethodBind("_File", "set_endian_swap")
+	methodBindFileStore16               = gdnative.NewLazyMethodBind("_File", "store_16")
+	methodBindFileStore32               = gdnative.NewLazyMethodBind("_File", "store_32")
+	methodBindFileStore64               = gdnative.NewLazyMethodBind("_File", "store_64")
+	methodBindFileStore8                = gdnative.NewLazyMethodBind("_File", "store_8")
+	methodBindFileStoreBuffer           = gdnative.NewLazyMethodBind("_File", "store_buffer")
+	methodBindFileStoreDouble           = gdnative.NewLazyMethodBind("_File", "store_double")
+	methodBindFileStoreFloat            = gdnative.NewLazyMethodBind("_File", "store_float")
+	methodBindFileStoreLine             = gdnative.NewLazyMethodBind("_File", "store_line")
+	methodBindFileStorePascalString     = gdnative.NewLazyMethodBind("_File", "store_pascal_string")
+	methodBindFileStoreReal             = gdnative.NewLazyMethodBind("_File", "store_real")
+	methodBindFileStoreString           = gdnative.NewLazyMethodBind("_File", "store_string")
+	methodBindFileStoreVar              = gdnative.NewLazyMethodBind("_File", "store_var")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *File) Close() {
 	//log.Println("Calling _File.Close()")
@@ -65,7 +184,7 @@ func (o *File) Close() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "close")
+	methodBind := methodBindFileClose.Get()
 
 	// Call the parent method.
 	// void
@@ -75,8 +194,8 @@ func (o *File) Close() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *File) EofReached() gdnative.Bool {
 	//log.Println("Calling _File.EofReached()")
@@ -85,7 +204,7 @@ func (o *File) EofReached() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "eof_reached")
+	methodBind := methodBindFileEofReached.Get()
 
 	// Call the parent method.
 	// bool
@@ -98,8 +217,8 @@ func (o *File) EofReached() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: bool
+	        Undocumented
+		Args: [{ false path String}], Returns: bool
 */
 func (o *File) FileExists(path gdnative.String) gdnative.Bool {
 	//log.Println("Calling _File.FileExists()")
@@ -109,7 +228,7 @@ func (o *File) FileExists(path gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "file_exists")
+	methodBind := methodBindFileFileExists.Get()
 
 	// Call the parent method.
 	// bool
@@ -122,8 +241,8 @@ func (o *File) FileExists(path gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *File) Get16() gdnative.Int {
 	//log.Println("Calling _File.Get16()")
@@ -132,7 +251,7 @@ func (o *File) Get16() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_16")
+	methodBind := methodBindFileGet16.Get()
 
 	// Call the parent method.
 	// int
@@ -145,8 +264,8 @@ func (o *File) Get16() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *File) Get32() gdnative.Int {
 	//log.Println("Calling _File.Get32()")
@@ -155,7 +274,7 @@ func (o *File) Get32() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_32")
+	methodBind := methodBindFileGet32.Get()
 
 	// Call the parent method.
 	// int
@@ -168,8 +287,8 @@ func (o *File) Get32() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *File) Get64() gdnative.Int {
 	//log.Println("Calling _File.Get64()")
@@ -178,7 +297,7 @@ func (o *File) Get64() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_64")
+	methodBind := methodBindFileGet64.Get()
 
 	// Call the parent method.
 	// int
@@ -191,8 +310,8 @@ func (o *File) Get64() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *File) Get8() gdnative.Int {
 	//log.Println("Calling _File.Get8()")
@@ -201,7 +320,7 @@ func (o *File) Get8() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_8")
+	methodBind := methodBindFileGet8.Get()
 
 	// Call the parent method.
 	// int
@@ -214,8 +333,8 @@ func (o *File) Get8() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *File) GetAsText() gdnative.String {
 	//log.Println("Calling _File.GetAsText()")
@@ -224,7 +343,7 @@ func (o *File) GetAsText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_as_text")
+	methodBind := methodBindFileGetAsText.Get()
 
 	// Call the parent method.
 	// String
@@ -237,8 +356,8 @@ func (o *File) GetAsText() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false len int}], Returns: PoolByteArray
+	        Undocumented
+		Args: [{ false len int}], Returns: PoolByteArray
 */
 func (o *File) GetBuffer(len gdnative.Int) gdnative.PoolByteArray {
 	//log.Println("Calling _File.GetBuffer()")
@@ -248,7 +367,7 @@ func (o *File) GetBuffer(len gdnative.Int) gdnative.PoolByteArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(len)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_buffer")
+	methodBind := methodBindFileGetBuffer.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -261,8 +380,8 @@ func (o *File) GetBuffer(len gdnative.Int) gdnative.PoolByteArray {
 }
 
 /*
-        Undocumented
-	Args: [{, true delim String}], Returns: PoolStringArray
+	        Undocumented
+		Args: [{, true delim String}], Returns: PoolStringArray
 */
 func (o *File) GetCsvLine(delim gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling _File.GetCsvLine()")
@@ -272,7 +391,7 @@ func (o *File) GetCsvLine(delim gdnative.String) gdnative.PoolStringArray {
 	ptrArguments[0] = gdnative.NewPointerFromString(delim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_csv_line")
+	methodBind := methodBindFileGetCsvLine.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -285,8 +404,8 @@ func (o *File) GetCsvLine(delim gdnative.String) gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *File) GetDouble() gdnative.Real {
 	//log.Println("Calling _File.GetDouble()")
@@ -295,7 +414,7 @@ func (o *File) GetDouble() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_double")
+	methodBind := methodBindFileGetDouble.Get()
 
 	// Call the parent method.
 	// float
@@ -308,8 +427,8 @@ func (o *File) GetDouble() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *File) GetEndianSwap() gdnative.Bool {
 	//log.Println("Calling _File.GetEndianSwap()")
@@ -318,7 +437,7 @@ func (o *File) GetEndianSwap() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_endian_swap")
+	methodBind := methodBindFileGetEndianSwap.Get()
 
 	// Call the parent method.
 	// bool
@@ -331,8 +450,8 @@ func (o *File) GetEndianSwap() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Error
+	        Undocumented
+		Args: [], Returns: enum.Error
 */
 func (o *File) GetError() gdnative.Error {
 	//log.Println("Calling _File.GetError()")
@@ -341,7 +460,7 @@ func (o *File) GetError() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_error")
+	methodBind := methodBindFileGetError.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -354,8 +473,8 @@ func (o *File) GetError() gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *File) GetFloat() gdnative.Real {
 	//log.Println("Calling _File.GetFloat()")
@@ -364,7 +483,7 @@ func (o *File) GetFloat() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_float")
+	methodBind := methodBindFileGetFloat.Get()
 
 	// Call the parent method.
 	// float
@@ -377,8 +496,8 @@ func (o *File) GetFloat() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *File) GetLen() gdnative.Int {
 	//log.Println("Calling _File.GetLen()")
@@ -387,7 +506,7 @@ func (o *File) GetLen() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_len")
+	methodBind := methodBindFileGetLen.Get()
 
 	// Call the parent method.
 	// int
@@ -400,8 +519,8 @@ func (o *File) GetLen() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *File) GetLine() gdnative.String {
 	//log.Println("Calling _File.GetLine()")
@@ -410,7 +529,7 @@ func (o *File) GetLine() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_line")
+	methodBind := methodBindFileGetLine.Get()
 
 	// Call the parent method.
 	// String
@@ -423,8 +542,8 @@ func (o *File) GetLine() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: String
+	        Undocumented
+		Args: [{ false path String}], Returns: String
 */
 func (o *File) GetMd5(path gdnative.String) gdnative.String {
 	//log.Println("Calling _File.GetMd5()")
@@ -434,7 +553,7 @@ func (o *File) GetMd5(path gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_md5")
+	methodBind := methodBindFileGetMd5.Get()
 
 	// Call the parent method.
 	// String
@@ -447,8 +566,8 @@ func (o *File) GetMd5(path gdnative.String) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false file String}], Returns: int
+	        Undocumented
+		Args: [{ false file String}], Returns: int
 */
 func (o *File) GetModifiedTime(file gdnative.String) gdnative.Int {
 	//log.Println("Calling _File.GetModifiedTime()")
@@ -458,7 +577,7 @@ func (o *File) GetModifiedTime(file gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_modified_time")
+	methodBind := methodBindFileGetModifiedTime.Get()
 
 	// Call the parent method.
 	// int
@@ -471,8 +590,8 @@ func (o *File) GetModifiedTime(file gdnative.String) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *File) GetPascalString() gdnative.String {
 	//log.Println("Calling _File.GetPascalString()")
@@ -481,7 +600,7 @@ func (o *File) GetPascalString() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_pascal_string")
+	methodBind := methodBindFileGetPascalString.Get()
 
 	// Call the parent method.
 	// String
@@ -494,8 +613,8 @@ func (o *File) GetPascalString() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *File) GetPosition() gdnative.Int {
 	//log.Println("Calling _File.GetPosition()")
@@ -504,7 +623,7 @@ func (o *File) GetPosition() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_position")
+	methodBind := methodBindFileGetPosition.Get()
 
 	// Call the parent method.
 	// int
@@ -517,8 +636,8 @@ func (o *File) GetPosition() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *File) GetReal() gdnative.Real {
 	//log.Println("Calling _File.GetReal()")
@@ -527,7 +646,7 @@ func (o *File) GetReal() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_real")
+	methodBind := methodBindFileGetReal.Get()
 
 	// Call the parent method.
 	// float
@@ -540,8 +659,8 @@ func (o *File) GetReal() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: String
+	        Undocumented
+		Args: [{ false path String}], Returns: String
 */
 func (o *File) GetSha256(path gdnative.String) gdnative.String {
 	//log.Println("Calling _File.GetSha256()")
@@ -551,7 +670,7 @@ func (o *File) GetSha256(path gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_sha256")
+	methodBind := methodBindFileGetSha256.Get()
 
 	// Call the parent method.
 	// String
@@ -564,8 +683,8 @@ func (o *File) GetSha256(path gdnative.String) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Variant
+	        Undocumented
+		Args: [], Returns: Variant
 */
 func (o *File) GetVar() gdnative.Variant {
 	//log.Println("Calling _File.GetVar()")
@@ -574,7 +693,7 @@ func (o *File) GetVar() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "get_var")
+	methodBind := methodBindFileGetVar.Get()
 
 	// Call the parent method.
 	// Variant
@@ -587,8 +706,8 @@ func (o *File) GetVar() gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *File) IsOpen() gdnative.Bool {
 	//log.Println("Calling _File.IsOpen()")
@@ -597,7 +716,7 @@ func (o *File) IsOpen() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "is_open")
+	methodBind := methodBindFileIsOpen.Get()
 
 	// Call the parent method.
 	// bool
@@ -610,8 +729,8 @@ func (o *File) IsOpen() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { false flags int}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String} { false flags int}], Returns: enum.Error
 */
 func (o *File) Open(path gdnative.String, flags gdnative.Int) gdnative.Error {
 	//log.Println("Calling _File.Open()")
@@ -622,7 +741,7 @@ func (o *File) Open(path gdnative.String, flags gdnative.Int) gdnative.Error {
 	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "open")
+	methodBind := methodBindFileOpen.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -635,20 +754,20 @@ func (o *File) Open(path gdnative.String, flags gdnative.Int) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { false mode_flags int} {0 true compression_mode int}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String} { false mode_flags enum._File::ModeFlags} {0 true compression_mode enum._File::CompressionMode}], Returns: enum.Error
 */
-func (o *File) OpenCompressed(path gdnative.String, modeFlags gdnative.Int, compressionMode gdnative.Int) gdnative.Error {
+func (o *File) OpenCompressed(path gdnative.String, modeFlags _FileModeFlags, compressionMode _FileCompressionMode) gdnative.Error {
 	//log.Println("Calling _File.OpenCompressed()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
-	ptrArguments[1] = gdnative.NewPointerFromInt(modeFlags)
-	ptrArguments[2] = gdnative.NewPointerFromInt(compressionMode)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(modeFlags))
+	ptrArguments[2] = gdnative.NewPointerFromInt(gdnative.Int(compressionMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "open_compressed")
+	methodBind := methodBindFileOpenCompressed.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -661,20 +780,20 @@ func (o *File) OpenCompressed(path gdnative.String, modeFlags gdnative.Int, comp
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { false mode_flags int} { false key PoolByteArray}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String} { false mode_flags enum._File::ModeFlags} { false key PoolByteArray}], Returns: enum.Error
 */
-func (o *File) OpenEncrypted(path gdnative.String, modeFlags gdnative.Int, key gdnative.PoolByteArray) gdnative.Error {
+func (o *File) OpenEncrypted(path gdnative.String, modeFlags _FileModeFlags, key gdnative.PoolByteArray) gdnative.Error {
 	//log.Println("Calling _File.OpenEncrypted()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
-	ptrArguments[1] = gdnative.NewPointerFromInt(modeFlags)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(modeFlags))
 	ptrArguments[2] = gdnative.NewPointerFromPoolByteArray(key)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "open_encrypted")
+	methodBind := methodBindFileOpenEncrypted.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -687,20 +806,20 @@ func (o *File) OpenEncrypted(path gdnative.String, modeFlags gdnative.Int, key g
 }
 
 /*
-        Undocumented
-	Args: [{ false path String} { false mode_flags int} { false pass String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String} { false mode_flags enum._File::ModeFlags} { false pass String}], Returns: enum.Error
 */
-func (o *File) OpenEncryptedWithPass(path gdnative.String, modeFlags gdnative.Int, pass gdnative.String) gdnative.Error {
+func (o *File) OpenEncryptedWithPass(path gdnative.String, modeFlags _FileModeFlags, pass gdnative.String) gdnative.Error {
 	//log.Println("Calling _File.OpenEncryptedWithPass()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
-	ptrArguments[1] = gdnative.NewPointerFromInt(modeFlags)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(modeFlags))
 	ptrArguments[2] = gdnative.NewPointerFromString(pass)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "open_encrypted_with_pass")
+	methodBind := methodBindFileOpenEncryptedWithPass.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -713,8 +832,8 @@ func (o *File) OpenEncryptedWithPass(path gdnative.String, modeFlags gdnative.In
 }
 
 /*
-        Undocumented
-	Args: [{ false position int}], Returns: void
+	        Undocumented
+		Args: [{ false position int}], Returns: void
 */
 func (o *File) Seek(position gdnative.Int) {
 	//log.Println("Calling _File.Seek()")
@@ -724,7 +843,7 @@ func (o *File) Seek(position gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "seek")
+	methodBind := methodBindFileSeek.Get()
 
 	// Call the parent method.
 	// void
@@ -734,8 +853,8 @@ func (o *File) Seek(position gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{0 true position int}], Returns: void
+	        Undocumented
+		Args: [{0 true position int}], Returns: void
 */
 func (o *File) SeekEnd(position gdnative.Int) {
 	//log.Println("Calling _File.SeekEnd()")
@@ -745,7 +864,7 @@ func (o *File) SeekEnd(position gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "seek_end")
+	methodBind := methodBindFileSeekEnd.Get()
 
 	// Call the parent method.
 	// void
@@ -755,8 +874,8 @@ func (o *File) SeekEnd(position gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *File) SetEndianSwap(enable gdnative.Bool) {
 	//log.Println("Calling _File.SetEndianSwap()")
@@ -766,7 +885,7 @@ func (o *File) SetEndianSwap(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "set_endian_swap")
+	methodBind := methodBindFileSetEndianSwap.Get()
 
 	// Call the parent method.
 	// void
@@ -776,8 +895,8 @@ func (o *File) SetEndianSwap(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value int}], Returns: void
+	        Undocumented
+		Args: [{ false value int}], Returns: void
 */
 func (o *File) Store16(value gdnative.Int) {
 	//log.Println("Calling _File.Store16()")
@@ -787,7 +906,7 @@ func (o *File) Store16(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_16")
+	methodBind := methodBindFileStore16.Get()
 
 	// Call the parent method.
 	// void
@@ -797,8 +916,8 @@ func (o *File) Store16(value gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value int}], Returns: void
+	        Undocumented
+		Args: [{ false value int}], Returns: void
 */
 func (o *File) Store32(value gdnative.Int) {
 	//log.Println("Calling _File.Store32()")
@@ -808,7 +927,7 @@ func (o *File) Store32(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_32")
+	methodBind := methodBindFileStore32.Get()
 
 	// Call the parent method.
 	// void
@@ -818,8 +937,8 @@ func (o *File) Store32(value gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value int}], Returns: void
+	        Undocumented
+		Args: [{ false value int}], Returns: void
 */
 func (o *File) Store64(value gdnative.Int) {
 	//log.Println("Calling _File.Store64()")
@@ -829,7 +948,7 @@ func (o *File) Store64(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_64")
+	methodBind := methodBindFileStore64.Get()
 
 	// Call the parent method.
 	// void
@@ -839,8 +958,8 @@ func (o *File) Store64(value gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value int}], Returns: void
+	        Undocumented
+		Args: [{ false value int}], Returns: void
 */
 func (o *File) Store8(value gdnative.Int) {
 	//log.Println("Calling _File.Store8()")
@@ -850,7 +969,7 @@ func (o *File) Store8(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_8")
+	methodBind := methodBindFileStore8.Get()
 
 	// Call the parent method.
 	// void
@@ -860,8 +979,8 @@ func (o *File) Store8(value gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false buffer PoolByteArray}], Returns: void
+	        Undocumented
+		Args: [{ false buffer PoolByteArray}], Returns: void
 */
 func (o *File) StoreBuffer(buffer gdnative.PoolByteArray) {
 	//log.Println("Calling _File.StoreBuffer()")
@@ -871,7 +990,7 @@ func (o *File) StoreBuffer(buffer gdnative.PoolByteArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(buffer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_buffer")
+	methodBind := methodBindFileStoreBuffer.Get()
 
 	// Call the parent method.
 	// void
@@ -881,8 +1000,8 @@ func (o *File) StoreBuffer(buffer gdnative.PoolByteArray) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value float}], Returns: void
+	        Undocumented
+		Args: [{ false value float}], Returns: void
 */
 func (o *File) StoreDouble(value gdnative.Real) {
 	//log.Println("Calling _File.StoreDouble()")
@@ -892,7 +1011,7 @@ func (o *File) StoreDouble(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_double")
+	methodBind := methodBindFileStoreDouble.Get()
 
 	// Call the parent method.
 	// void
@@ -902,8 +1021,8 @@ func (o *File) StoreDouble(value gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value float}], Returns: void
+	        Undocumented
+		Args: [{ false value float}], Returns: void
 */
 func (o *File) StoreFloat(value gdnative.Real) {
 	//log.Println("Calling _File.StoreFloat()")
@@ -913,7 +1032,7 @@ func (o *File) StoreFloat(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_float")
+	methodBind := methodBindFileStoreFloat.Get()
 
 	// Call the parent method.
 	// void
@@ -923,8 +1042,8 @@ func (o *File) StoreFloat(value gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false line String}], Returns: void
+	        Undocumented
+		Args: [{ false line String}], Returns: void
 */
 func (o *File) StoreLine(line gdnative.String) {
 	//log.Println("Calling _File.StoreLine()")
@@ -934,7 +1053,7 @@ func (o *File) StoreLine(line gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(line)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_line")
+	methodBind := methodBindFileStoreLine.Get()
 
 	// Call the parent method.
 	// void
@@ -944,8 +1063,8 @@ func (o *File) StoreLine(line gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false string String}], Returns: void
+	        Undocumented
+		Args: [{ false string String}], Returns: void
 */
 func (o *File) StorePascalString(string gdnative.String) {
 	//log.Println("Calling _File.StorePascalString()")
@@ -955,7 +1074,7 @@ func (o *File) StorePascalString(string gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(string)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_pascal_string")
+	methodBind := methodBindFileStorePascalString.Get()
 
 	// Call the parent method.
 	// void
@@ -965,8 +1084,8 @@ func (o *File) StorePascalString(string gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value float}], Returns: void
+	        Undocumented
+		Args: [{ false value float}], Returns: void
 */
 func (o *File) StoreReal(value gdnative.Real) {
 	//log.Println("Calling _File.StoreReal()")
@@ -976,7 +1095,7 @@ func (o *File) StoreReal(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_real")
+	methodBind := methodBindFileStoreReal.Get()
 
 	// Call the parent method.
 	// void
@@ -986,8 +1105,8 @@ func (o *File) StoreReal(value gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false string String}], Returns: void
+	        Undocumented
+		Args: [{ false string String}], Returns: void
 */
 func (o *File) StoreString(string gdnative.String) {
 	//log.Println("Calling _File.StoreString()")
@@ -997,7 +1116,7 @@ func (o *File) StoreString(string gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(string)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_string")
+	methodBind := methodBindFileStoreString.Get()
 
 	// Call the parent method.
 	// void
@@ -1007,8 +1126,8 @@ func (o *File) StoreString(string gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false value Variant}], Returns: void
+	        Undocumented
+		Args: [{ false value Variant}], Returns: void
 */
 func (o *File) StoreVar(value gdnative.Variant) {
 	//log.Println("Calling _File.StoreVar()")
@@ -1018,7 +1137,7 @@ func (o *File) StoreVar(value gdnative.Variant) {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_File", "store_var")
+	methodBind := methodBindFileStoreVar.Get()
 
 	// Call the parent method.
 	// void
@@ -1027,10 +1146,30 @@ func (o *File) StoreVar(value gdnative.Variant) {
 
 }
 
+// GetCsvLineWithDefaults will call GetCsvLine using the default values for: delim.
+func (o *File) GetCsvLineWithDefaults() gdnative.PoolStringArray {
+	return o.GetCsvLine(",")
+}
+
+// OpenCompressedWithDefaults will call OpenCompressed using the default values for: compression_mode.
+func (o *File) OpenCompressedWithDefaults(path gdnative.String, modeFlags _FileModeFlags) gdnative.Error {
+	return o.OpenCompressed(path, modeFlags, 0)
+}
+
+// SeekEndWithDefaults will call SeekEnd using the default values for: position.
+func (o *File) SeekEndWithDefaults() {
+	o.SeekEnd(0)
+}
+
+// EndianSwap will return the value of the "endian_swap" property.
+func (o *File) EndianSwap() gdnative.Bool {
+	return o.GetEndianSwap()
+}
+
 // FileImplementer is an interface that implements the methods
 // of the File class.
 type FileImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	Close()
 	EofReached() gdnative.Bool
 	FileExists(path gdnative.String) gdnative.Bool
@@ -1043,6 +1182,7 @@ type FileImplementer interface {
 	GetCsvLine(delim gdnative.String) gdnative.PoolStringArray
 	GetDouble() gdnative.Real
 	GetEndianSwap() gdnative.Bool
+	GetError() gdnative.Error
 	GetFloat() gdnative.Real
 	GetLen() gdnative.Int
 	GetLine() gdnative.String
@@ -1054,6 +1194,10 @@ type FileImplementer interface {
 	GetSha256(path gdnative.String) gdnative.String
 	GetVar() gdnative.Variant
 	IsOpen() gdnative.Bool
+	Open(path gdnative.String, flags gdnative.Int) gdnative.Error
+	OpenCompressed(path gdnative.String, modeFlags _FileModeFlags, compressionMode _FileCompressionMode) gdnative.Error
+	OpenEncrypted(path gdnative.String, modeFlags _FileModeFlags, key gdnative.PoolByteArray) gdnative.Error
+	OpenEncryptedWithPass(path gdnative.String, modeFlags _FileModeFlags, pass gdnative.String) gdnative.Error
 	Seek(position gdnative.Int)
 	SeekEnd(position gdnative.Int)
 	SetEndianSwap(enable gdnative.Bool)
@@ -1069,4 +1213,8 @@ type FileImplementer interface {
 	StoreReal(value gdnative.Real)
 	StoreString(string gdnative.String)
 	StoreVar(value gdnative.Variant)
+	GetCsvLineWithDefaults() gdnative.PoolStringArray
+	OpenCompressedWithDefaults(path gdnative.String, modeFlags _FileModeFlags) gdnative.Error
+	SeekEndWithDefaults()
+	EndianSwap() gdnative.Bool
 }
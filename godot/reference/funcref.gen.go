@@ -0,0 +1,125 @@
+package reference
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+In GDScript, functions are not [i]first-class objects[/i]. This means it is impossible to store them directly as variables, return them from another function, or pass them as arguments. However, by creating a [code]FuncRef[/code] using the [method @GDScript.funcref] function, a reference to a function in a given object can be created, passed around and called.
+*/
+type FuncRef struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorFuncRef is the class constructor of the FuncRef class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorFuncRef = gdnative.NewLazyClassConstructor("FuncRef")
+
+// NewFuncRef will create a new instance of the FuncRef class.
+// FuncRef is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewFuncRef() *FuncRef {
+	obj := &FuncRef{}
+	obj.SetBaseObject(classConstructorFuncRef.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *FuncRef) BaseClass() string {
+	return "FuncRef"
+}
+
+// Method binds of the FuncRef class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindFuncRefCallFunc    = gdnative.NewLazyMethodBind("FuncRef", "call_func")
+	methodBindFuncRefSetFunction = gdnative.NewLazyMethodBind("FuncRef", "set_function")
+	methodBindFuncRefSetInstance = gdnative.NewLazyMethodBind("FuncRef", "set_instance")
+)
+
+/*
+	        Calls the referenced function previously set by [method set_function] or [method @GDScript.funcref].
+		Args: [], Returns: Variant
+*/
+func (o *FuncRef) CallFunc(args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling FuncRef.CallFunc()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 0+len(args))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindFuncRefCallFunc.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        The name of the referenced function to call on the object, without parentheses or any parameters.
+		Args: [{ false name String}], Returns: void
+*/
+func (o *FuncRef) SetFunction(name gdnative.String) {
+	//log.Println("Calling FuncRef.SetFunction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindFuncRefSetFunction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        The object containing the referenced function. This object must be of a type actually inheriting from [Object], not a built-in type such as [int], [Vector2] or [Dictionary].
+		Args: [{ false instance Object}], Returns: void
+*/
+func (o *FuncRef) SetInstance(instance godot.ObjectImplementer) {
+	//log.Println("Calling FuncRef.SetInstance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(instance))
+
+	// Get the method bind
+	methodBind := methodBindFuncRefSetInstance.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// FuncRefImplementer is an interface that implements the methods
+// of the FuncRef class.
+type FuncRefImplementer interface {
+	godot.ReferenceImplementer
+	CallFunc(args ...gdnative.Variant) (gdnative.Variant, error)
+	SetFunction(name gdnative.String)
+	SetInstance(instance godot.ObjectImplementer)
+}
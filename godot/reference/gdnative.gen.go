@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,48 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGDNativeFromPointer(ptr gdnative.Pointer) GDNative {
-func newGDNativeFromPointer(ptr gdnative.Pointer) GDNative {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GDNative{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
 type GDNative struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorGDNative is the class constructor of the GDNative class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGDNative = gdnative.NewLazyClassConstructor("GDNative")
+
+// NewGDNative will create a new instance of the GDNative class.
+// GDNative is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewGDNative() *GDNative {
+	obj := &GDNative{}
+	obj.SetBaseObject(classConstructorGDNative.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GDNative) BaseClass() string {
 	return "GDNative"
 }
 
+// Method binds of the GDNative class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGDNativeCallNative = gdnative.NewLazyMethodBind("GDNative", "call_native")
+	methodBindGDNativeGetLibrary = gdnative.NewLazyMethodBind("GDNative", "get_library")
+	methodBindGDNativeInitialize = gdnative.NewLazyMethodBind("GDNative", "initialize")
+	methodBindGDNativeSetLibrary = gdnative.NewLazyMethodBind("GDNative", "set_library")
+	methodBindGDNativeTerminate  = gdnative.NewLazyMethodBind("GDNative", "terminate")
+)
+
 /*
-        Undocumented
-	Args: [{ false calling_type String} { false procedure_name String} { false arguments Array}], Returns: Variant
+	        Undocumented
+		Args: [{ false calling_type String} { false procedure_name String} { false arguments Array}], Returns: Variant
 */
 func (o *GDNative) CallNative(callingType gdnative.String, procedureName gdnative.String, arguments gdnative.Array) gdnative.Variant {
 	//log.Println("Calling GDNative.CallNative()")
@@ -48,7 +67,7 @@ func (o *GDNative) CallNative(callingType gdnative.String, procedureName gdnativ
 	ptrArguments[2] = gdnative.NewPointerFromArray(arguments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNative", "call_native")
+	methodBind := methodBindGDNativeCallNative.Get()
 
 	// Call the parent method.
 	// Variant
@@ -61,17 +80,17 @@ func (o *GDNative) CallNative(callingType gdnative.String, procedureName gdnativ
 }
 
 /*
-        Undocumented
-	Args: [], Returns: GDNativeLibrary
+	        Undocumented
+		Args: [], Returns: GDNativeLibrary
 */
-func (o *GDNative) GetLibrary() GDNativeLibraryImplementer {
+func (o *GDNative) GetLibrary() godot.GDNativeLibraryImplementer {
 	//log.Println("Calling GDNative.GetLibrary()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNative", "get_library")
+	methodBind := methodBindGDNativeGetLibrary.Get()
 
 	// Call the parent method.
 	// GDNativeLibrary
@@ -79,27 +98,25 @@ func (o *GDNative) GetLibrary() GDNativeLibraryImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newGDNativeLibraryFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(GDNativeLibraryImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "GDNativeLibrary" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(GDNativeLibraryImplementer)
+	ret := godot.GDNativeLibrary{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.GDNativeLibraryImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GDNative) Initialize() gdnative.Bool {
 	//log.Println("Calling GDNative.Initialize()")
@@ -108,7 +125,7 @@ func (o *GDNative) Initialize() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNative", "initialize")
+	methodBind := methodBindGDNativeInitialize.Get()
 
 	// Call the parent method.
 	// bool
@@ -121,18 +138,18 @@ func (o *GDNative) Initialize() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false library GDNativeLibrary}], Returns: void
+	        Undocumented
+		Args: [{ false library GDNativeLibrary}], Returns: void
 */
-func (o *GDNative) SetLibrary(library GDNativeLibraryImplementer) {
+func (o *GDNative) SetLibrary(library godot.GDNativeLibraryImplementer) {
 	//log.Println("Calling GDNative.SetLibrary()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(library.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(library))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNative", "set_library")
+	methodBind := methodBindGDNativeSetLibrary.Get()
 
 	// Call the parent method.
 	// void
@@ -142,8 +159,8 @@ func (o *GDNative) SetLibrary(library GDNativeLibraryImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GDNative) Terminate() gdnative.Bool {
 	//log.Println("Calling GDNative.Terminate()")
@@ -152,7 +169,7 @@ func (o *GDNative) Terminate() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GDNative", "terminate")
+	methodBind := methodBindGDNativeTerminate.Get()
 
 	// Call the parent method.
 	// bool
@@ -164,13 +181,19 @@ func (o *GDNative) Terminate() gdnative.Bool {
 	return ret
 }
 
+// Library will return the value of the "library" property.
+func (o *GDNative) Library() godot.GDNativeLibraryImplementer {
+	return o.GetLibrary()
+}
+
 // GDNativeImplementer is an interface that implements the methods
 // of the GDNative class.
 type GDNativeImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	CallNative(callingType gdnative.String, procedureName gdnative.String, arguments gdnative.Array) gdnative.Variant
-	GetLibrary() GDNativeLibraryImplementer
+	GetLibrary() godot.GDNativeLibraryImplementer
 	Initialize() gdnative.Bool
-	SetLibrary(library GDNativeLibraryImplementer)
+	SetLibrary(library godot.GDNativeLibraryImplementer)
 	Terminate() gdnative.Bool
+	Library() godot.GDNativeLibraryImplementer
 }
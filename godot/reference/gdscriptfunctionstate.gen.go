@@ -0,0 +1,158 @@
+package reference
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type GDScriptFunctionState struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+func (o *GDScriptFunctionState) BaseClass() string {
+	return "GDScriptFunctionState"
+}
+
+// Method binds of the GDScriptFunctionState class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGDScriptFunctionStateX_SignalCallback = gdnative.NewLazyMethodBind("GDScriptFunctionState", "_signal_callback")
+	methodBindGDScriptFunctionStateIsValid          = gdnative.NewLazyMethodBind("GDScriptFunctionState", "is_valid")
+	methodBindGDScriptFunctionStateResume           = gdnative.NewLazyMethodBind("GDScriptFunctionState", "resume")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Variant
+*/
+func (o *GDScriptFunctionState) X_SignalCallback(args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling GDScriptFunctionState.X_SignalCallback()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 0+len(args))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindGDScriptFunctionStateX_SignalCallback.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Undocumented
+		Args: [{False true extended_check bool}], Returns: bool
+*/
+func (o *GDScriptFunctionState) IsValid(extendedCheck gdnative.Bool) gdnative.Bool {
+	//log.Println("Calling GDScriptFunctionState.IsValid()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(extendedCheck)
+
+	// Get the method bind
+	methodBind := methodBindGDScriptFunctionStateIsValid.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{Null true arg Variant}], Returns: Variant
+*/
+func (o *GDScriptFunctionState) Resume(arg gdnative.Variant) gdnative.Variant {
+	//log.Println("Calling GDScriptFunctionState.Resume()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVariant(arg)
+
+	// Get the method bind
+	methodBind := methodBindGDScriptFunctionStateResume.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+// IsValidWithDefaults will call IsValid using the default values for: extended_check.
+func (o *GDScriptFunctionState) IsValidWithDefaults() gdnative.Bool {
+	return o.IsValid(false)
+}
+
+// ResumeWithDefaults will call Resume using the default values for: arg.
+func (o *GDScriptFunctionState) ResumeWithDefaults() gdnative.Variant {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	return o.Resume(default0)
+}
+
+// Signals of the GDScriptFunctionState class.
+const (
+	GDScriptFunctionStateSignalCompleted gdnative.String = "completed"
+)
+
+// GDScriptFunctionStateCompletedArgs holds the arguments of the "completed" signal.
+type GDScriptFunctionStateCompletedArgs struct {
+	Result gdnative.Variant
+}
+
+// ConnectCompleted will connect the "completed" signal to the given method of the target object.
+func (o *GDScriptFunctionState) ConnectCompleted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(GDScriptFunctionStateSignalCompleted, target, method, binds, 0)
+}
+
+// EmitCompleted will emit the "completed" signal.
+func (o *GDScriptFunctionState) EmitCompleted(args GDScriptFunctionStateCompletedArgs) error {
+	arg0 := gdnative.NewVariantCopy(args.Result)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(GDScriptFunctionStateSignalCompleted, arg0)
+	ret.Destroy()
+	return err
+}
+
+// GDScriptFunctionStateImplementer is an interface that implements the methods
+// of the GDScriptFunctionState class.
+type GDScriptFunctionStateImplementer interface {
+	godot.ReferenceImplementer
+	X_SignalCallback(args ...gdnative.Variant) (gdnative.Variant, error)
+	IsValid(extendedCheck gdnative.Bool) gdnative.Bool
+	Resume(arg gdnative.Variant) gdnative.Variant
+	IsValidWithDefaults() gdnative.Bool
+	ResumeWithDefaults() gdnative.Variant
+	ConnectCompleted(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCompleted(args GDScriptFunctionStateCompletedArgs) error
+}
@@ -0,0 +1,1044 @@
+package reference
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/streampeer"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// HTTPClientMethod is an enum for Method values.
+type HTTPClientMethod int
+
+const (
+	HTTPClientMethodConnect HTTPClientMethod = 7
+	HTTPClientMethodDelete  HTTPClientMethod = 4
+	HTTPClientMethodGet     HTTPClientMethod = 0
+	HTTPClientMethodHead    HTTPClientMethod = 1
+	HTTPClientMethodMax     HTTPClientMethod = 9
+	HTTPClientMethodOptions HTTPClientMethod = 5
+	HTTPClientMethodPatch   HTTPClientMethod = 8
+	HTTPClientMethodPost    HTTPClientMethod = 2
+	HTTPClientMethodPut     HTTPClientMethod = 3
+	HTTPClientMethodTrace   HTTPClientMethod = 6
+)
+
+// String will return the Godot name of the HTTPClientMethod value.
+func (e HTTPClientMethod) String() string {
+	switch e {
+	case HTTPClientMethodConnect:
+		return "METHOD_CONNECT"
+	case HTTPClientMethodDelete:
+		return "METHOD_DELETE"
+	case HTTPClientMethodGet:
+		return "METHOD_GET"
+	case HTTPClientMethodHead:
+		return "METHOD_HEAD"
+	case HTTPClientMethodMax:
+		return "METHOD_MAX"
+	case HTTPClientMethodOptions:
+		return "METHOD_OPTIONS"
+	case HTTPClientMethodPatch:
+		return "METHOD_PATCH"
+	case HTTPClientMethodPost:
+		return "METHOD_POST"
+	case HTTPClientMethodPut:
+		return "METHOD_PUT"
+	case HTTPClientMethodTrace:
+		return "METHOD_TRACE"
+	}
+	return fmt.Sprintf("HTTPClientMethod(%d)", int(e))
+}
+
+// ParseHTTPClientMethod will return the HTTPClientMethod value with the given Godot name.
+func ParseHTTPClientMethod(name string) (HTTPClientMethod, error) {
+	switch name {
+	case "METHOD_CONNECT":
+		return HTTPClientMethodConnect, nil
+	case "METHOD_DELETE":
+		return HTTPClientMethodDelete, nil
+	case "METHOD_GET":
+		return HTTPClientMethodGet, nil
+	case "METHOD_HEAD":
+		return HTTPClientMethodHead, nil
+	case "METHOD_MAX":
+		return HTTPClientMethodMax, nil
+	case "METHOD_OPTIONS":
+		return HTTPClientMethodOptions, nil
+	case "METHOD_PATCH":
+		return HTTPClientMethodPatch, nil
+	case "METHOD_POST":
+		return HTTPClientMethodPost, nil
+	case "METHOD_PUT":
+		return HTTPClientMethodPut, nil
+	case "METHOD_TRACE":
+		return HTTPClientMethodTrace, nil
+	}
+	return 0, fmt.Errorf("invalid HTTPClientMethod value %q", name)
+}
+
+// HTTPClientResponseCode is an enum for ResponseCode values.
+type HTTPClientResponseCode int
+
+const (
+	HTTPClientResponseAccepted                     HTTPClientResponseCode = 202
+	HTTPClientResponseAlreadyReported              HTTPClientResponseCode = 208
+	HTTPClientResponseBadGateway                   HTTPClientResponseCode = 502
+	HTTPClientResponseBadRequest                   HTTPClientResponseCode = 400
+	HTTPClientResponseConflict                     HTTPClientResponseCode = 409
+	HTTPClientResponseContinue                     HTTPClientResponseCode = 100
+	HTTPClientResponseCreated                      HTTPClientResponseCode = 201
+	HTTPClientResponseExpectationFailed            HTTPClientResponseCode = 417
+	HTTPClientResponseFailedDependency             HTTPClientResponseCode = 424
+	HTTPClientResponseForbidden                    HTTPClientResponseCode = 403
+	HTTPClientResponseFound                        HTTPClientResponseCode = 302
+	HTTPClientResponseGatewayTimeout               HTTPClientResponseCode = 504
+	HTTPClientResponseGone                         HTTPClientResponseCode = 410
+	HTTPClientResponseHttpVersionNotSupported      HTTPClientResponseCode = 505
+	HTTPClientResponseImATeapot                    HTTPClientResponseCode = 418
+	HTTPClientResponseImUsed                       HTTPClientResponseCode = 226
+	HTTPClientResponseInsufficientStorage          HTTPClientResponseCode = 507
+	HTTPClientResponseInternalServerError          HTTPClientResponseCode = 500
+	HTTPClientResponseLengthRequired               HTTPClientResponseCode = 411
+	HTTPClientResponseLocked                       HTTPClientResponseCode = 423
+	HTTPClientResponseLoopDetected                 HTTPClientResponseCode = 508
+	HTTPClientResponseMethodNotAllowed             HTTPClientResponseCode = 405
+	HTTPClientResponseMisdirectedRequest           HTTPClientResponseCode = 421
+	HTTPClientResponseMovedPermanently             HTTPClientResponseCode = 301
+	HTTPClientResponseMultipleChoices              HTTPClientResponseCode = 300
+	HTTPClientResponseMultiStatus                  HTTPClientResponseCode = 207
+	HTTPClientResponseNetworkAuthRequired          HTTPClientResponseCode = 511
+	HTTPClientResponseNonAuthoritativeInformation  HTTPClientResponseCode = 203
+	HTTPClientResponseNotAcceptable                HTTPClientResponseCode = 406
+	HTTPClientResponseNotExtended                  HTTPClientResponseCode = 510
+	HTTPClientResponseNotFound                     HTTPClientResponseCode = 404
+	HTTPClientResponseNotImplemented               HTTPClientResponseCode = 501
+	HTTPClientResponseNotModified                  HTTPClientResponseCode = 304
+	HTTPClientResponseNoContent                    HTTPClientResponseCode = 204
+	HTTPClientResponseOk                           HTTPClientResponseCode = 200
+	HTTPClientResponsePartialContent               HTTPClientResponseCode = 206
+	HTTPClientResponsePaymentRequired              HTTPClientResponseCode = 402
+	HTTPClientResponsePermanentRedirect            HTTPClientResponseCode = 308
+	HTTPClientResponsePreconditionFailed           HTTPClientResponseCode = 412
+	HTTPClientResponsePreconditionRequired         HTTPClientResponseCode = 428
+	HTTPClientResponseProcessing                   HTTPClientResponseCode = 102
+	HTTPClientResponseProxyAuthenticationRequired  HTTPClientResponseCode = 407
+	HTTPClientResponseRequestedRangeNotSatisfiable HTTPClientResponseCode = 416
+	HTTPClientResponseRequestEntityTooLarge        HTTPClientResponseCode = 413
+	HTTPClientResponseRequestHeaderFieldsTooLarge  HTTPClientResponseCode = 431
+	HTTPClientResponseRequestTimeout               HTTPClientResponseCode = 408
+	HTTPClientResponseRequestUriTooLong            HTTPClientResponseCode = 414
+	HTTPClientResponseResetContent                 HTTPClientResponseCode = 205
+	HTTPClientResponseSeeOther                     HTTPClientResponseCode = 303
+	HTTPClientResponseServiceUnavailable           HTTPClientResponseCode = 503
+	HTTPClientResponseSwitchingProtocols           HTTPClientResponseCode = 101
+	HTTPClientResponseSwitchProxy                  HTTPClientResponseCode = 306
+	HTTPClientResponseTemporaryRedirect            HTTPClientResponseCode = 307
+	HTTPClientResponseTooManyRequests              HTTPClientResponseCode = 429
+	HTTPClientResponseUnauthorized                 HTTPClientResponseCode = 401
+	HTTPClientResponseUnavailableForLegalReasons   HTTPClientResponseCode = 451
+	HTTPClientResponseUnprocessableEntity          HTTPClientResponseCode = 422
+	HTTPClientResponseUnsupportedMediaType         HTTPClientResponseCode = 415
+	HTTPClientResponseUpgradeRequired              HTTPClientResponseCode = 426
+	HTTPClientResponseUseProxy                     HTTPClientResponseCode = 305
+	HTTPClientResponseVariantAlsoNegotiates        HTTPClientResponseCode = 506
+)
+
+// String will return the Godot name of the HTTPClientResponseCode value.
+func (e HTTPClientResponseCode) String() string {
+	switch e {
+	case HTTPClientResponseAccepted:
+		return "RESPONSE_ACCEPTED"
+	case HTTPClientResponseAlreadyReported:
+		return "RESPONSE_ALREADY_REPORTED"
+	case HTTPClientResponseBadGateway:
+		return "RESPONSE_BAD_GATEWAY"
+	case HTTPClientResponseBadRequest:
+		return "RESPONSE_BAD_REQUEST"
+	case HTTPClientResponseConflict:
+		return "RESPONSE_CONFLICT"
+	case HTTPClientResponseContinue:
+		return "RESPONSE_CONTINUE"
+	case HTTPClientResponseCreated:
+		return "RESPONSE_CREATED"
+	case HTTPClientResponseExpectationFailed:
+		return "RESPONSE_EXPECTATION_FAILED"
+	case HTTPClientResponseFailedDependency:
+		return "RESPONSE_FAILED_DEPENDENCY"
+	case HTTPClientResponseForbidden:
+		return "RESPONSE_FORBIDDEN"
+	case HTTPClientResponseFound:
+		return "RESPONSE_FOUND"
+	case HTTPClientResponseGatewayTimeout:
+		return "RESPONSE_GATEWAY_TIMEOUT"
+	case HTTPClientResponseGone:
+		return "RESPONSE_GONE"
+	case HTTPClientResponseHttpVersionNotSupported:
+		return "RESPONSE_HTTP_VERSION_NOT_SUPPORTED"
+	case HTTPClientResponseImATeapot:
+		return "RESPONSE_IM_A_TEAPOT"
+	case HTTPClientResponseImUsed:
+		return "RESPONSE_IM_USED"
+	case HTTPClientResponseInsufficientStorage:
+		return "RESPONSE_INSUFFICIENT_STORAGE"
+	case HTTPClientResponseInternalServerError:
+		return "RESPONSE_INTERNAL_SERVER_ERROR"
+	case HTTPClientResponseLengthRequired:
+		return "RESPONSE_LENGTH_REQUIRED"
+	case HTTPClientResponseLocked:
+		return "RESPONSE_LOCKED"
+	case HTTPClientResponseLoopDetected:
+		return "RESPONSE_LOOP_DETECTED"
+	case HTTPClientResponseMethodNotAllowed:
+		return "RESPONSE_METHOD_NOT_ALLOWED"
+	case HTTPClientResponseMisdirectedRequest:
+		return "RESPONSE_MISDIRECTED_REQUEST"
+	case HTTPClientResponseMovedPermanently:
+		return "RESPONSE_MOVED_PERMANENTLY"
+	case HTTPClientResponseMultipleChoices:
+		return "RESPONSE_MULTIPLE_CHOICES"
+	case HTTPClientResponseMultiStatus:
+		return "RESPONSE_MULTI_STATUS"
+	case HTTPClientResponseNetworkAuthRequired:
+		return "RESPONSE_NETWORK_AUTH_REQUIRED"
+	case HTTPClientResponseNonAuthoritativeInformation:
+		return "RESPONSE_NON_AUTHORITATIVE_INFORMATION"
+	case HTTPClientResponseNotAcceptable:
+		return "RESPONSE_NOT_ACCEPTABLE"
+	case HTTPClientResponseNotExtended:
+		return "RESPONSE_NOT_EXTENDED"
+	case HTTPClientResponseNotFound:
+		return "RESPONSE_NOT_FOUND"
+	case HTTPClientResponseNotImplemented:
+		return "RESPONSE_NOT_IMPLEMENTED"
+	case HTTPClientResponseNotModified:
+		return "RESPONSE_NOT_MODIFIED"
+	case HTTPClientResponseNoContent:
+		return "RESPONSE_NO_CONTENT"
+	case HTTPClientResponseOk:
+		return "RESPONSE_OK"
+	case HTTPClientResponsePartialContent:
+		return "RESPONSE_PARTIAL_CONTENT"
+	case HTTPClientResponsePaymentRequired:
+		return "RESPONSE_PAYMENT_REQUIRED"
+	case HTTPClientResponsePermanentRedirect:
+		return "RESPONSE_PERMANENT_REDIRECT"
+	case HTTPClientResponsePreconditionFailed:
+		return "RESPONSE_PRECONDITION_FAILED"
+	case HTTPClientResponsePreconditionRequired:
+		return "RESPONSE_PRECONDITION_REQUIRED"
+	case HTTPClientResponseProcessing:
+		return "RESPONSE_PROCESSING"
+	case HTTPClientResponseProxyAuthenticationRequired:
+		return "RESPONSE_PROXY_AUTHENTICATION_REQUIRED"
+	case HTTPClientResponseRequestedRangeNotSatisfiable:
+		return "RESPONSE_REQUESTED_RANGE_NOT_SATISFIABLE"
+	case HTTPClientResponseRequestEntityTooLarge:
+		return "RESPONSE_REQUEST_ENTITY_TOO_LARGE"
+	case HTTPClientResponseRequestHeaderFieldsTooLarge:
+		return "RESPONSE_REQUEST_HEADER_FIELDS_TOO_LARGE"
+	case HTTPClientResponseRequestTimeout:
+		return "RESPONSE_REQUEST_TIMEOUT"
+	case HTTPClientResponseRequestUriTooLong:
+		return "RESPONSE_REQUEST_URI_TOO_LONG"
+	case HTTPClientResponseResetContent:
+		return "RESPONSE_RESET_CONTENT"
+	case HTTPClientResponseSeeOther:
+		return "RESPONSE_SEE_OTHER"
+	case HTTPClientResponseServiceUnavailable:
+		return "RESPONSE_SERVICE_UNAVAILABLE"
+	case HTTPClientResponseSwitchingProtocols:
+		return "RESPONSE_SWITCHING_PROTOCOLS"
+	case HTTPClientResponseSwitchProxy:
+		return "RESPONSE_SWITCH_PROXY"
+	case HTTPClientResponseTemporaryRedirect:
+		return "RESPONSE_TEMPORARY_REDIRECT"
+	case HTTPClientResponseTooManyRequests:
+		return "RESPONSE_TOO_MANY_REQUESTS"
+	case HTTPClientResponseUnauthorized:
+		return "RESPONSE_UNAUTHORIZED"
+	case HTTPClientResponseUnavailableForLegalReasons:
+		return "RESPONSE_UNAVAILABLE_FOR_LEGAL_REASONS"
+	case HTTPClientResponseUnprocessableEntity:
+		return "RESPONSE_UNPROCESSABLE_ENTITY"
+	case HTTPClientResponseUnsupportedMediaType:
+		return "RESPONSE_UNSUPPORTED_MEDIA_TYPE"
+	case HTTPClientResponseUpgradeRequired:
+		return "RESPONSE_UPGRADE_REQUIRED"
+	case HTTPClientResponseUseProxy:
+		return "RESPONSE_USE_PROXY"
+	case HTTPClientResponseVariantAlsoNegotiates:
+		return "RESPONSE_VARIANT_ALSO_NEGOTIATES"
+	}
+	return fmt.Sprintf("HTTPClientResponseCode(%d)", int(e))
+}
+
+// ParseHTTPClientResponseCode will return the HTTPClientResponseCode value with the given Godot name.
+func ParseHTTPClientResponseCode(name string) (HTTPClientResponseCode, error) {
+	switch name {
+	case "RESPONSE_ACCEPTED":
+		return HTTPClientResponseAccepted, nil
+	case "RESPONSE_ALREADY_REPORTED":
+		return HTTPClientResponseAlreadyReported, nil
+	case "RESPONSE_BAD_GATEWAY":
+		return HTTPClientResponseBadGateway, nil
+	case "RESPONSE_BAD_REQUEST":
+		return HTTPClientResponseBadRequest, nil
+	case "RESPONSE_CONFLICT":
+		return HTTPClientResponseConflict, nil
+	case "RESPONSE_CONTINUE":
+		return HTTPClientResponseContinue, nil
+	case "RESPONSE_CREATED":
+		return HTTPClientResponseCreated, nil
+	case "RESPONSE_EXPECTATION_FAILED":
+		return HTTPClientResponseExpectationFailed, nil
+	case "RESPONSE_FAILED_DEPENDENCY":
+		return HTTPClientResponseFailedDependency, nil
+	case "RESPONSE_FORBIDDEN":
+		return HTTPClientResponseForbidden, nil
+	case "RESPONSE_FOUND":
+		return HTTPClientResponseFound, nil
+	case "RESPONSE_GATEWAY_TIMEOUT":
+		return HTTPClientResponseGatewayTimeout, nil
+	case "RESPONSE_GONE":
+		return HTTPClientResponseGone, nil
+	case "RESPONSE_HTTP_VERSION_NOT_SUPPORTED":
+		return HTTPClientResponseHttpVersionNotSupported, nil
+	case "RESPONSE_IM_A_TEAPOT":
+		return HTTPClientResponseImATeapot, nil
+	case "RESPONSE_IM_USED":
+		return HTTPClientResponseImUsed, nil
+	case "RESPONSE_INSUFFICIENT_STORAGE":
+		return HTTPClientResponseInsufficientStorage, nil
+	case "RESPONSE_INTERNAL_SERVER_ERROR":
+		return HTTPClientResponseInternalServerError, nil
+	case "RESPONSE_LENGTH_REQUIRED":
+		return HTTPClientResponseLengthRequired, nil
+	case "RESPONSE_LOCKED":
+		return HTTPClientResponseLocked, nil
+	case "RESPONSE_LOOP_DETECTED":
+		return HTTPClientResponseLoopDetected, nil
+	case "RESPONSE_METHOD_NOT_ALLOWED":
+		return HTTPClientResponseMethodNotAllowed, nil
+	case "RESPONSE_MISDIRECTED_REQUEST":
+		return HTTPClientResponseMisdirectedRequest, nil
+	case "RESPONSE_MOVED_PERMANENTLY":
+		return HTTPClientResponseMovedPermanently, nil
+	case "RESPONSE_MULTIPLE_CHOICES":
+		return HTTPClientResponseMultipleChoices, nil
+	case "RESPONSE_MULTI_STATUS":
+		return HTTPClientResponseMultiStatus, nil
+	case "RESPONSE_NETWORK_AUTH_REQUIRED":
+		return HTTPClientResponseNetworkAuthRequired, nil
+	case "RESPONSE_NON_AUTHORITATIVE_INFORMATION":
+		return HTTPClientResponseNonAuthoritativeInformation, nil
+	case "RESPONSE_NOT_ACCEPTABLE":
+		return HTTPClientResponseNotAcceptable, nil
+	case "RESPONSE_NOT_EXTENDED":
+		return HTTPClientResponseNotExtended, nil
+	case "RESPONSE_NOT_FOUND":
+		return HTTPClientResponseNotFound, nil
+	case "RESPONSE_NOT_IMPLEMENTED":
+		return HTTPClientResponseNotImplemented, nil
+	case "RESPONSE_NOT_MODIFIED":
+		return HTTPClientResponseNotModified, nil
+	case "RESPONSE_NO_CONTENT":
+		return HTTPClientResponseNoContent, nil
+	case "RESPONSE_OK":
+		return HTTPClientResponseOk, nil
+	case "RESPONSE_PARTIAL_CONTENT":
+		return HTTPClientResponsePartialContent, nil
+	case "RESPONSE_PAYMENT_REQUIRED":
+		return HTTPClientResponsePaymentRequired, nil
+	case "RESPONSE_PERMANENT_REDIRECT":
+		return HTTPClientResponsePermanentRedirect, nil
+	case "RESPONSE_PRECONDITION_FAILED":
+		return HTTPClientResponsePreconditionFailed, nil
+	case "RESPONSE_PRECONDITION_REQUIRED":
+		return HTTPClientResponsePreconditionRequired, nil
+	case "RESPONSE_PROCESSING":
+		return HTTPClientResponseProcessing, nil
+	case "RESPONSE_PROXY_AUTHENTICATION_REQUIRED":
+		return HTTPClientResponseProxyAuthenticationRequired, nil
+	case "RESPONSE_REQUESTED_RANGE_NOT_SATISFIABLE":
+		return HTTPClientResponseRequestedRangeNotSatisfiable, nil
+	case "RESPONSE_REQUEST_ENTITY_TOO_LARGE":
+		return HTTPClientResponseRequestEntityTooLarge, nil
+	case "RESPONSE_REQUEST_HEADER_FIELDS_TOO_LARGE":
+		return HTTPClientResponseRequestHeaderFieldsTooLarge, nil
+	case "RESPONSE_REQUEST_TIMEOUT":
+		return HTTPClientResponseRequestTimeout, nil
+	case "RESPONSE_REQUEST_URI_TOO_LONG":
+		return HTTPClientResponseRequestUriTooLong, nil
+	case "RESPONSE_RESET_CONTENT":
+		return HTTPClientResponseResetContent, nil
+	case "RESPONSE_SEE_OTHER":
+		return HTTPClientResponseSeeOther, nil
+	case "RESPONSE_SERVICE_UNAVAILABLE":
+		return HTTPClientResponseServiceUnavailable, nil
+	case "RESPONSE_SWITCHING_PROTOCOLS":
+		return HTTPClientResponseSwitchingProtocols, nil
+	case "RESPONSE_SWITCH_PROXY":
+		return HTTPClientResponseSwitchProxy, nil
+	case "RESPONSE_TEMPORARY_REDIRECT":
+		return HTTPClientResponseTemporaryRedirect, nil
+	case "RESPONSE_TOO_MANY_REQUESTS":
+		return HTTPClientResponseTooManyRequests, nil
+	case "RESPONSE_UNAUTHORIZED":
+		return HTTPClientResponseUnauthorized, nil
+	case "RESPONSE_UNAVAILABLE_FOR_LEGAL_REASONS":
+		return HTTPClientResponseUnavailableForLegalReasons, nil
+	case "RESPONSE_UNPROCESSABLE_ENTITY":
+		return HTTPClientResponseUnprocessableEntity, nil
+	case "RESPONSE_UNSUPPORTED_MEDIA_TYPE":
+		return HTTPClientResponseUnsupportedMediaType, nil
+	case "RESPONSE_UPGRADE_REQUIRED":
+		return HTTPClientResponseUpgradeRequired, nil
+	case "RESPONSE_USE_PROXY":
+		return HTTPClientResponseUseProxy, nil
+	case "RESPONSE_VARIANT_ALSO_NEGOTIATES":
+		return HTTPClientResponseVariantAlsoNegotiates, nil
+	}
+	return 0, fmt.Errorf("invalid HTTPClientResponseCode value %q", name)
+}
+
+// HTTPClientStatus is an enum for Status values.
+type HTTPClientStatus int
+
+const (
+	HTTPClientStatusBody              HTTPClientStatus = 7
+	HTTPClientStatusCantConnect       HTTPClientStatus = 4
+	HTTPClientStatusCantResolve       HTTPClientStatus = 2
+	HTTPClientStatusConnected         HTTPClientStatus = 5
+	HTTPClientStatusConnecting        HTTPClientStatus = 3
+	HTTPClientStatusConnectionError   HTTPClientStatus = 8
+	HTTPClientStatusDisconnected      HTTPClientStatus = 0
+	HTTPClientStatusRequesting        HTTPClientStatus = 6
+	HTTPClientStatusResolving         HTTPClientStatus = 1
+	HTTPClientStatusSslHandshakeError HTTPClientStatus = 9
+)
+
+// String will return the Godot name of the HTTPClientStatus value.
+func (e HTTPClientStatus) String() string {
+	switch e {
+	case HTTPClientStatusBody:
+		return "STATUS_BODY"
+	case HTTPClientStatusCantConnect:
+		return "STATUS_CANT_CONNECT"
+	case HTTPClientStatusCantResolve:
+		return "STATUS_CANT_RESOLVE"
+	case HTTPClientStatusConnected:
+		return "STATUS_CONNECTED"
+	case HTTPClientStatusConnecting:
+		return "STATUS_CONNECTING"
+	case HTTPClientStatusConnectionError:
+		return "STATUS_CONNECTION_ERROR"
+	case HTTPClientStatusDisconnected:
+		return "STATUS_DISCONNECTED"
+	case HTTPClientStatusRequesting:
+		return "STATUS_REQUESTING"
+	case HTTPClientStatusResolving:
+		return "STATUS_RESOLVING"
+	case HTTPClientStatusSslHandshakeError:
+		return "STATUS_SSL_HANDSHAKE_ERROR"
+	}
+	return fmt.Sprintf("HTTPClientStatus(%d)", int(e))
+}
+
+// ParseHTTPClientStatus will return the HTTPClientStatus value with the given Godot name.
+func ParseHTTPClientStatus(name string) (HTTPClientStatus, error) {
+	switch name {
+	case "STATUS_BODY":
+		return HTTPClientStatusBody, nil
+	case "STATUS_CANT_CONNECT":
+		return HTTPClientStatusCantConnect, nil
+	case "STATUS_CANT_RESOLVE":
+		return HTTPClientStatusCantResolve, nil
+	case "STATUS_CONNECTED":
+		return HTTPClientStatusConnected, nil
+	case "STATUS_CONNECTING":
+		return HTTPClientStatusConnecting, nil
+	case "STATUS_CONNECTION_ERROR":
+		return HTTPClientStatusConnectionError, nil
+	case "STATUS_DISCONNECTED":
+		return HTTPClientStatusDisconnected, nil
+	case "STATUS_REQUESTING":
+		return HTTPClientStatusRequesting, nil
+	case "STATUS_RESOLVING":
+		return HTTPClientStatusResolving, nil
+	case "STATUS_SSL_HANDSHAKE_ERROR":
+		return HTTPClientStatusSslHandshakeError, nil
+	}
+	return 0, fmt.Errorf("invalid HTTPClientStatus value %q", name)
+}
+
+/*
+Hyper-text transfer protocol client (sometimes called "User Agent"). Used to make HTTP requests to download web content, upload files and other data or to communicate with various services, among other use cases. Note that this client only needs to connect to a host once (see [method connect_to_host]) to send multiple requests. Because of this, methods that take URLs usually take just the part after the host instead of the full URL, as the client is already connected to a host. See [method request] for a full example and to get started. A [code]HTTPClient[/code] should be reused between multiple requests or to connect to different hosts instead of creating one client per request. Supports SSL and SSL server certificate verification. HTTP status codes in the 2xx range indicate success, 3xx redirection (i.e. "try again, but over here"), 4xx something was wrong with the request, and 5xx something went wrong on the server's side. For more information on HTTP, see https://developer.mozilla.org/en-US/docs/Web/HTTP (or read RFC 2616 to get it straight from the source: https://tools.ietf.org/html/rfc2616).
+*/
+type HTTPClient struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorHTTPClient is the class constructor of the HTTPClient class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHTTPClient = gdnative.NewLazyClassConstructor("HTTPClient")
+
+// NewHTTPClient will create a new instance of the HTTPClient class.
+// HTTPClient is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewHTTPClient() *HTTPClient {
+	obj := &HTTPClient{}
+	obj.SetBaseObject(classConstructorHTTPClient.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *HTTPClient) BaseClass() string {
+	return "HTTPClient"
+}
+
+// Method binds of the HTTPClient class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindHTTPClientClose                          = gdnative.NewLazyMethodBind("HTTPClient", "close")
+	methodBindHTTPClientConnectToHost                  = gdnative.NewLazyMethodBind("HTTPClient", "connect_to_host")
+	methodBindHTTPClientGetConnection                  = gdnative.NewLazyMethodBind("HTTPClient", "get_connection")
+	methodBindHTTPClientGetResponseBodyLength          = gdnative.NewLazyMethodBind("HTTPClient", "get_response_body_length")
+	methodBindHTTPClientGetResponseCode                = gdnative.NewLazyMethodBind("HTTPClient", "get_response_code")
+	methodBindHTTPClientGetResponseHeaders             = gdnative.NewLazyMethodBind("HTTPClient", "get_response_headers")
+	methodBindHTTPClientGetResponseHeadersAsDictionary = gdnative.NewLazyMethodBind("HTTPClient", "get_response_headers_as_dictionary")
+	methodBindHTTPClientGetStatus                      = gdnative.NewLazyMethodBind("HTTPClient", "get_status")
+	methodBindHTTPClientHasResponse                    = gdnative.NewLazyMethodBind("HTTPClient", "has_response")
+	methodBindHTTPClientIsBlockingModeEnabled          = gdnative.NewLazyMethodBind("HTTPClient", "is_blocking_mode_enabled")
+	methodBindHTTPClientIsResponseChunked              = gdnative.NewLazyMethodBind("HTTPClient", "is_response_chunked")
+	methodBindHTTPClientPoll                           = gdnative.NewLazyMethodBind("HTTPClient", "poll")
+	methodBindHTTPClientQueryStringFromDict            = gdnative.NewLazyMethodBind("HTTPClient", "query_string_from_dict")
+	methodBindHTTPClientReadResponseBodyChunk          = gdnative.NewLazyMethodBind("HTTPClient", "read_response_body_chunk")
+	methodBindHTTPClientRequest                        = gdnative.NewLazyMethodBind("HTTPClient", "request")
+	methodBindHTTPClientRequestRaw                     = gdnative.NewLazyMethodBind("HTTPClient", "request_raw")
+	methodBindHTTPClientSetBlockingMode                = gdnative.NewLazyMethodBind("HTTPClient", "set_blocking_mode")
+	methodBindHTTPClientSetConnection                  = gdnative.NewLazyMethodBind("HTTPClient", "set_connection")
+	methodBindHTTPClientSetReadChunkSize               = gdnative.NewLazyMethodBind("HTTPClient", "set_read_chunk_size")
+)
+
+/*
+	        Closes the current connection, allowing reuse of this [code]HTTPClient[/code].
+		Args: [], Returns: void
+*/
+func (o *HTTPClient) Close() {
+	//log.Println("Calling HTTPClient.Close()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientClose.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Connect to a host. This needs to be done before any requests are sent. The host should not have http:// prepended but will strip the protocol identifier if provided. If no [code]port[/code] is specified (or [code]-1[/code] is used), it is automatically set to 80 for HTTP and 443 for HTTPS (if [code]use_ssl[/code] is enabled). [code]verify_host[/code] will check the SSL identity of the host if set to [code]true[/code].
+		Args: [{ false host String} {-1 true port int} {False true use_ssl bool} {True true verify_host bool}], Returns: enum.Error
+*/
+func (o *HTTPClient) ConnectToHost(host gdnative.String, port gdnative.Int, useSsl gdnative.Bool, verifyHost gdnative.Bool) gdnative.Error {
+	//log.Println("Calling HTTPClient.ConnectToHost()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromString(host)
+	ptrArguments[1] = gdnative.NewPointerFromInt(port)
+	ptrArguments[2] = gdnative.NewPointerFromBool(useSsl)
+	ptrArguments[3] = gdnative.NewPointerFromBool(verifyHost)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientConnectToHost.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: StreamPeer
+*/
+func (o *HTTPClient) GetConnection() streampeer.StreamPeerImplementer {
+	//log.Println("Calling HTTPClient.GetConnection()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientGetConnection.Get()
+
+	// Call the parent method.
+	// StreamPeer
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := streampeer.StreamPeer{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(streampeer.StreamPeerImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the response's body length.
+		Args: [], Returns: int
+*/
+func (o *HTTPClient) GetResponseBodyLength() gdnative.Int {
+	//log.Println("Calling HTTPClient.GetResponseBodyLength()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientGetResponseBodyLength.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the response's HTTP status code.
+		Args: [], Returns: int
+*/
+func (o *HTTPClient) GetResponseCode() gdnative.Int {
+	//log.Println("Calling HTTPClient.GetResponseCode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientGetResponseCode.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the response headers.
+		Args: [], Returns: PoolStringArray
+*/
+func (o *HTTPClient) GetResponseHeaders() gdnative.PoolStringArray {
+	//log.Println("Calling HTTPClient.GetResponseHeaders()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientGetResponseHeaders.Get()
+
+	// Call the parent method.
+	// PoolStringArray
+	retPtr := gdnative.NewEmptyPoolStringArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewPoolStringArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns all response headers as dictionary where the case-sensitivity of the keys and values is kept like the server delivers it. A value is a simple String, this string can have more than one value where "; " is used as separator. Structure: ("key":"value1; value2") Example: (content-length:12), (Content-Type:application/json; charset=UTF-8)
+		Args: [], Returns: Dictionary
+*/
+func (o *HTTPClient) GetResponseHeadersAsDictionary() gdnative.Dictionary {
+	//log.Println("Calling HTTPClient.GetResponseHeadersAsDictionary()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientGetResponseHeadersAsDictionary.Get()
+
+	// Call the parent method.
+	// Dictionary
+	retPtr := gdnative.NewEmptyDictionary()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewDictionaryFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a STATUS_* enum constant. Need to call [method poll] in order to get status updates.
+		Args: [], Returns: enum.HTTPClient::Status
+*/
+func (o *HTTPClient) GetStatus() HTTPClientStatus {
+	//log.Println("Calling HTTPClient.GetStatus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientGetStatus.Get()
+
+	// Call the parent method.
+	// enum.HTTPClient::Status
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return HTTPClientStatus(ret)
+}
+
+/*
+	        If [code]true[/code] this [code]HTTPClient[/code] has a response available.
+		Args: [], Returns: bool
+*/
+func (o *HTTPClient) HasResponse() gdnative.Bool {
+	//log.Println("Calling HTTPClient.HasResponse()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientHasResponse.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *HTTPClient) IsBlockingModeEnabled() gdnative.Bool {
+	//log.Println("Calling HTTPClient.IsBlockingModeEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientIsBlockingModeEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If [code]true[/code] this [code]HTTPClient[/code] has a response that is chunked.
+		Args: [], Returns: bool
+*/
+func (o *HTTPClient) IsResponseChunked() gdnative.Bool {
+	//log.Println("Calling HTTPClient.IsResponseChunked()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientIsResponseChunked.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        This needs to be called in order to have any request processed. Check results with [method get_status]
+		Args: [], Returns: enum.Error
+*/
+func (o *HTTPClient) Poll() gdnative.Error {
+	//log.Println("Calling HTTPClient.Poll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientPoll.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Generates a GET/POST application/x-www-form-urlencoded style query string from a provided dictionary, e.g.: [codeblock] var fields = {"username": "user", "password": "pass"} String queryString = httpClient.query_string_from_dict(fields) returns:= "username=user&password=pass" [/codeblock]
+		Args: [{ false fields Dictionary}], Returns: String
+*/
+func (o *HTTPClient) QueryStringFromDict(fields gdnative.Dictionary) gdnative.String {
+	//log.Println("Calling HTTPClient.QueryStringFromDict()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromDictionary(fields)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientQueryStringFromDict.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Reads one chunk from the response.
+		Args: [], Returns: PoolByteArray
+*/
+func (o *HTTPClient) ReadResponseBodyChunk() gdnative.PoolByteArray {
+	//log.Println("Calling HTTPClient.ReadResponseBodyChunk()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientReadResponseBodyChunk.Get()
+
+	// Call the parent method.
+	// PoolByteArray
+	retPtr := gdnative.NewEmptyPoolByteArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewPoolByteArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Sends a request to the connected host. The URL parameter is just the part after the host, so for [code]http://somehost.com/index.php[/code], it is [code]index.php[/code]. Headers are HTTP request headers. For available HTTP methods, see [code]METHOD_*[/code]. To create a POST request with query strings to push to the server, do: [codeblock] var fields = {"username" : "user", "password" : "pass"} var queryString = httpClient.query_string_from_dict(fields) var headers = ["Content-Type: application/x-www-form-urlencoded", "Content-Length: " + str(queryString.length())] var result = httpClient.request(httpClient.METHOD_POST, "index.php", headers, queryString) [/codeblock]
+		Args: [{ false method enum.HTTPClient::Method} { false url String} { false headers PoolStringArray} { true body String}], Returns: enum.Error
+*/
+func (o *HTTPClient) Request(method HTTPClientMethod, url gdnative.String, headers gdnative.PoolStringArray, body gdnative.String) gdnative.Error {
+	//log.Println("Calling HTTPClient.Request()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(method))
+	ptrArguments[1] = gdnative.NewPointerFromString(url)
+	ptrArguments[2] = gdnative.NewPointerFromPoolStringArray(headers)
+	ptrArguments[3] = gdnative.NewPointerFromString(body)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientRequest.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Sends a raw request to the connected host. The URL parameter is just the part after the host, so for [code]http://somehost.com/index.php[/code], it is [code]index.php[/code]. Headers are HTTP request headers. For available HTTP methods, see [code]METHOD_*[/code]. Sends the body data raw, as a byte array and does not encode it in any way.
+		Args: [{ false method enum.HTTPClient::Method} { false url String} { false headers PoolStringArray} { false body PoolByteArray}], Returns: enum.Error
+*/
+func (o *HTTPClient) RequestRaw(method HTTPClientMethod, url gdnative.String, headers gdnative.PoolStringArray, body gdnative.PoolByteArray) gdnative.Error {
+	//log.Println("Calling HTTPClient.RequestRaw()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(method))
+	ptrArguments[1] = gdnative.NewPointerFromString(url)
+	ptrArguments[2] = gdnative.NewPointerFromPoolStringArray(headers)
+	ptrArguments[3] = gdnative.NewPointerFromPoolByteArray(body)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientRequestRaw.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *HTTPClient) SetBlockingMode(enabled gdnative.Bool) {
+	//log.Println("Calling HTTPClient.SetBlockingMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientSetBlockingMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false connection StreamPeer}], Returns: void
+*/
+func (o *HTTPClient) SetConnection(connection streampeer.StreamPeerImplementer) {
+	//log.Println("Calling HTTPClient.SetConnection()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(connection))
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientSetConnection.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Sets the size of the buffer used and maximum bytes to read per iteration. see [method read_response_body_chunk]
+		Args: [{ false bytes int}], Returns: void
+*/
+func (o *HTTPClient) SetReadChunkSize(bytes gdnative.Int) {
+	//log.Println("Calling HTTPClient.SetReadChunkSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bytes)
+
+	// Get the method bind
+	methodBind := methodBindHTTPClientSetReadChunkSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// ConnectToHostWithDefaults will call ConnectToHost using the default values for: port, use_ssl, verify_host.
+func (o *HTTPClient) ConnectToHostWithDefaults(host gdnative.String) gdnative.Error {
+	return o.ConnectToHost(host, -1, false, true)
+}
+
+// RequestWithDefaults will call Request using the default values for: body.
+func (o *HTTPClient) RequestWithDefaults(method HTTPClientMethod, url gdnative.String, headers gdnative.PoolStringArray) gdnative.Error {
+	return o.Request(method, url, headers, "")
+}
+
+// BlockingModeEnabled will return the value of the "blocking_mode_enabled" property.
+func (o *HTTPClient) BlockingModeEnabled() gdnative.Bool {
+	return o.IsBlockingModeEnabled()
+}
+
+// SetBlockingModeEnabled will set the value of the "blocking_mode_enabled" property.
+func (o *HTTPClient) SetBlockingModeEnabled(value gdnative.Bool) {
+	o.SetBlockingMode(value)
+}
+
+// Connection will return the value of the "connection" property.
+func (o *HTTPClient) Connection() streampeer.StreamPeerImplementer {
+	return o.GetConnection()
+}
+
+// HTTPClientImplementer is an interface that implements the methods
+// of the HTTPClient class.
+type HTTPClientImplementer interface {
+	godot.ReferenceImplementer
+	Close()
+	ConnectToHost(host gdnative.String, port gdnative.Int, useSsl gdnative.Bool, verifyHost gdnative.Bool) gdnative.Error
+	GetConnection() streampeer.StreamPeerImplementer
+	GetResponseBodyLength() gdnative.Int
+	GetResponseCode() gdnative.Int
+	GetResponseHeaders() gdnative.PoolStringArray
+	GetResponseHeadersAsDictionary() gdnative.Dictionary
+	GetStatus() HTTPClientStatus
+	HasResponse() gdnative.Bool
+	IsBlockingModeEnabled() gdnative.Bool
+	IsResponseChunked() gdnative.Bool
+	Poll() gdnative.Error
+	QueryStringFromDict(fields gdnative.Dictionary) gdnative.String
+	ReadResponseBodyChunk() gdnative.PoolByteArray
+	Request(method HTTPClientMethod, url gdnative.String, headers gdnative.PoolStringArray, body gdnative.String) gdnative.Error
+	RequestRaw(method HTTPClientMethod, url gdnative.String, headers gdnative.PoolStringArray, body gdnative.PoolByteArray) gdnative.Error
+	SetBlockingMode(enabled gdnative.Bool)
+	SetConnection(connection streampeer.StreamPeerImplementer)
+	SetReadChunkSize(bytes gdnative.Int)
+	ConnectToHostWithDefaults(host gdnative.String) gdnative.Error
+	RequestWithDefaults(method HTTPClientMethod, url gdnative.String, headers gdnative.PoolStringArray) gdnative.Error
+	BlockingModeEnabled() gdnative.Bool
+	SetBlockingModeEnabled(value gdnative.Bool)
+	Connection() streampeer.StreamPeerImplementer
+}
@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,51 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewJSONParseResultFromPointer(ptr gdnative.Pointer) JSONParseResult {
-func newJSONParseResultFromPointer(ptr gdnative.Pointer) JSONParseResult {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := JSONParseResult{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Returned by [method JSON.parse], [code]JSONParseResult[/code] contains decoded JSON or error information if JSON source not successfully parsed. You can check if JSON source was successfully parsed with [code]if json_result.error == OK[/code].
 */
 type JSONParseResult struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorJSONParseResult is the class constructor of the JSONParseResult class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorJSONParseResult = gdnative.NewLazyClassConstructor("JSONParseResult")
+
+// NewJSONParseResult will create a new instance of the JSONParseResult class.
+// JSONParseResult is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewJSONParseResult() *JSONParseResult {
+	obj := &JSONParseResult{}
+	obj.SetBaseObject(classConstructorJSONParseResult.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *JSONParseResult) BaseClass() string {
 	return "JSONParseResult"
 }
 
+// Method binds of the JSONParseResult class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindJSONParseResultGetError       = gdnative.NewLazyMethodBind("JSONParseResult", "get_error")
+	methodBindJSONParseResultGetErrorLine   = gdnative.NewLazyMethodBind("JSONParseResult", "get_error_line")
+	methodBindJSONParseResultGetErrorString = gdnative.NewLazyMethodBind("JSONParseResult", "get_error_string")
+	methodBindJSONParseResultGetResult      = gdnative.NewLazyMethodBind("JSONParseResult", "get_result")
+	methodBindJSONParseResultSetError       = gdnative.NewLazyMethodBind("JSONParseResult", "set_error")
+	methodBindJSONParseResultSetErrorLine   = gdnative.NewLazyMethodBind("JSONParseResult", "set_error_line")
+	methodBindJSONParseResultSetErrorString = gdnative.NewLazyMethodBind("JSONParseResult", "set_error_string")
+	methodBindJSONParseResultSetResult      = gdnative.NewLazyMethodBind("JSONParseResult", "set_result")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.Error
+	        Undocumented
+		Args: [], Returns: enum.Error
 */
 func (o *JSONParseResult) GetError() gdnative.Error {
 	//log.Println("Calling JSONParseResult.GetError()")
@@ -45,7 +67,7 @@ func (o *JSONParseResult) GetError() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "get_error")
+	methodBind := methodBindJSONParseResultGetError.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -58,8 +80,8 @@ func (o *JSONParseResult) GetError() gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *JSONParseResult) GetErrorLine() gdnative.Int {
 	//log.Println("Calling JSONParseResult.GetErrorLine()")
@@ -68,7 +90,7 @@ func (o *JSONParseResult) GetErrorLine() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "get_error_line")
+	methodBind := methodBindJSONParseResultGetErrorLine.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +103,8 @@ func (o *JSONParseResult) GetErrorLine() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *JSONParseResult) GetErrorString() gdnative.String {
 	//log.Println("Calling JSONParseResult.GetErrorString()")
@@ -91,7 +113,7 @@ func (o *JSONParseResult) GetErrorString() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "get_error_string")
+	methodBind := methodBindJSONParseResultGetErrorString.Get()
 
 	// Call the parent method.
 	// String
@@ -104,8 +126,8 @@ func (o *JSONParseResult) GetErrorString() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Variant
+	        Undocumented
+		Args: [], Returns: Variant
 */
 func (o *JSONParseResult) GetResult() gdnative.Variant {
 	//log.Println("Calling JSONParseResult.GetResult()")
@@ -114,7 +136,7 @@ func (o *JSONParseResult) GetResult() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "get_result")
+	methodBind := methodBindJSONParseResultGetResult.Get()
 
 	// Call the parent method.
 	// Variant
@@ -127,8 +149,8 @@ func (o *JSONParseResult) GetResult() gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [{ false error int}], Returns: void
+	        Undocumented
+		Args: [{ false error int}], Returns: void
 */
 func (o *JSONParseResult) SetError(error gdnative.Int) {
 	//log.Println("Calling JSONParseResult.SetError()")
@@ -138,7 +160,7 @@ func (o *JSONParseResult) SetError(error gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(error)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "set_error")
+	methodBind := methodBindJSONParseResultSetError.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +170,8 @@ func (o *JSONParseResult) SetError(error gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false error_line int}], Returns: void
+	        Undocumented
+		Args: [{ false error_line int}], Returns: void
 */
 func (o *JSONParseResult) SetErrorLine(errorLine gdnative.Int) {
 	//log.Println("Calling JSONParseResult.SetErrorLine()")
@@ -159,7 +181,7 @@ func (o *JSONParseResult) SetErrorLine(errorLine gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(errorLine)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "set_error_line")
+	methodBind := methodBindJSONParseResultSetErrorLine.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +191,8 @@ func (o *JSONParseResult) SetErrorLine(errorLine gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false error_string String}], Returns: void
+	        Undocumented
+		Args: [{ false error_string String}], Returns: void
 */
 func (o *JSONParseResult) SetErrorString(errorString gdnative.String) {
 	//log.Println("Calling JSONParseResult.SetErrorString()")
@@ -180,7 +202,7 @@ func (o *JSONParseResult) SetErrorString(errorString gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(errorString)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "set_error_string")
+	methodBind := methodBindJSONParseResultSetErrorString.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +212,8 @@ func (o *JSONParseResult) SetErrorString(errorString gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false result Variant}], Returns: void
+	        Undocumented
+		Args: [{ false result Variant}], Returns: void
 */
 func (o *JSONParseResult) SetResult(result gdnative.Variant) {
 	//log.Println("Calling JSONParseResult.SetResult()")
@@ -201,7 +223,7 @@ func (o *JSONParseResult) SetResult(result gdnative.Variant) {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(result)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("JSONParseResult", "set_result")
+	methodBind := methodBindJSONParseResultSetResult.Get()
 
 	// Call the parent method.
 	// void
@@ -210,10 +232,31 @@ func (o *JSONParseResult) SetResult(result gdnative.Variant) {
 
 }
 
+// Error will return the value of the "error" property.
+func (o *JSONParseResult) Error() gdnative.Error {
+	return o.GetError()
+}
+
+// ErrorLine will return the value of the "error_line" property.
+func (o *JSONParseResult) ErrorLine() gdnative.Int {
+	return o.GetErrorLine()
+}
+
+// ErrorString will return the value of the "error_string" property.
+func (o *JSONParseResult) ErrorString() gdnative.String {
+	return o.GetErrorString()
+}
+
+// Result will return the value of the "result" property.
+func (o *JSONParseResult) Result() gdnative.Variant {
+	return o.GetResult()
+}
+
 // JSONParseResultImplementer is an interface that implements the methods
 // of the JSONParseResult class.
 type JSONParseResultImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
+	GetError() gdnative.Error
 	GetErrorLine() gdnative.Int
 	GetErrorString() gdnative.String
 	GetResult() gdnative.Variant
@@ -221,4 +264,8 @@ type JSONParseResultImplementer interface {
 	SetErrorLine(errorLine gdnative.Int)
 	SetErrorString(errorString gdnative.String)
 	SetResult(result gdnative.Variant)
+	Error() gdnative.Error
+	ErrorLine() gdnative.Int
+	ErrorString() gdnative.String
+	Result() gdnative.Variant
 }
@@ -0,0 +1,432 @@
+package reference
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Contains collision data for KinematicBody2D collisions. When a [KinematicBody2D] is moved using [method KinematicBody2D.move_and_collide], it stops if it detects a collision with another body. If a collision is detected, a KinematicCollision2D object is returned. This object contains information about the collision, including the colliding object, the remaining motion, and the collision position. This information can be used to calculate a collision response.
+*/
+type KinematicCollision2D struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorKinematicCollision2D is the class constructor of the KinematicCollision2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorKinematicCollision2D = gdnative.NewLazyClassConstructor("KinematicCollision2D")
+
+// NewKinematicCollision2D will create a new instance of the KinematicCollision2D class.
+// KinematicCollision2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewKinematicCollision2D() *KinematicCollision2D {
+	obj := &KinematicCollision2D{}
+	obj.SetBaseObject(classConstructorKinematicCollision2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *KinematicCollision2D) BaseClass() string {
+	return "KinematicCollision2D"
+}
+
+// Method binds of the KinematicCollision2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindKinematicCollision2DGetCollider           = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_collider")
+	methodBindKinematicCollision2DGetColliderId         = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_collider_id")
+	methodBindKinematicCollision2DGetColliderMetadata   = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_collider_metadata")
+	methodBindKinematicCollision2DGetColliderShape      = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_collider_shape")
+	methodBindKinematicCollision2DGetColliderShapeIndex = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_collider_shape_index")
+	methodBindKinematicCollision2DGetColliderVelocity   = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_collider_velocity")
+	methodBindKinematicCollision2DGetLocalShape         = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_local_shape")
+	methodBindKinematicCollision2DGetNormal             = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_normal")
+	methodBindKinematicCollision2DGetPosition           = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_position")
+	methodBindKinematicCollision2DGetRemainder          = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_remainder")
+	methodBindKinematicCollision2DGetTravel             = gdnative.NewLazyMethodBind("KinematicCollision2D", "get_travel")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Object
+*/
+func (o *KinematicCollision2D) GetCollider() godot.ObjectImplementer {
+	//log.Println("Calling KinematicCollision2D.GetCollider()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetCollider.Get()
+
+	// Call the parent method.
+	// Object
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *KinematicCollision2D) GetColliderId() gdnative.Int {
+	//log.Println("Calling KinematicCollision2D.GetColliderId()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetColliderId.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Variant
+*/
+func (o *KinematicCollision2D) GetColliderMetadata() gdnative.Variant {
+	//log.Println("Calling KinematicCollision2D.GetColliderMetadata()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetColliderMetadata.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Object
+*/
+func (o *KinematicCollision2D) GetColliderShape() godot.ObjectImplementer {
+	//log.Println("Calling KinematicCollision2D.GetColliderShape()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetColliderShape.Get()
+
+	// Call the parent method.
+	// Object
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *KinematicCollision2D) GetColliderShapeIndex() gdnative.Int {
+	//log.Println("Calling KinematicCollision2D.GetColliderShapeIndex()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetColliderShapeIndex.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *KinematicCollision2D) GetColliderVelocity() gdnative.Vector2 {
+	//log.Println("Calling KinematicCollision2D.GetColliderVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetColliderVelocity.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Object
+*/
+func (o *KinematicCollision2D) GetLocalShape() godot.ObjectImplementer {
+	//log.Println("Calling KinematicCollision2D.GetLocalShape()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetLocalShape.Get()
+
+	// Call the parent method.
+	// Object
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *KinematicCollision2D) GetNormal() gdnative.Vector2 {
+	//log.Println("Calling KinematicCollision2D.GetNormal()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetNormal.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *KinematicCollision2D) GetPosition() gdnative.Vector2 {
+	//log.Println("Calling KinematicCollision2D.GetPosition()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetPosition.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *KinematicCollision2D) GetRemainder() gdnative.Vector2 {
+	//log.Println("Calling KinematicCollision2D.GetRemainder()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetRemainder.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *KinematicCollision2D) GetTravel() gdnative.Vector2 {
+	//log.Println("Calling KinematicCollision2D.GetTravel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicCollision2DGetTravel.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+// Collider will return the value of the "collider" property.
+func (o *KinematicCollision2D) Collider() godot.ObjectImplementer {
+	return o.GetCollider()
+}
+
+// ColliderId will return the value of the "collider_id" property.
+func (o *KinematicCollision2D) ColliderId() gdnative.Int {
+	return o.GetColliderId()
+}
+
+// ColliderMetadata will return the value of the "collider_metadata" property.
+func (o *KinematicCollision2D) ColliderMetadata() gdnative.Variant {
+	return o.GetColliderMetadata()
+}
+
+// ColliderShape will return the value of the "collider_shape" property.
+func (o *KinematicCollision2D) ColliderShape() godot.ObjectImplementer {
+	return o.GetColliderShape()
+}
+
+// ColliderShapeIndex will return the value of the "collider_shape_index" property.
+func (o *KinematicCollision2D) ColliderShapeIndex() gdnative.Int {
+	return o.GetColliderShapeIndex()
+}
+
+// ColliderVelocity will return the value of the "collider_velocity" property.
+func (o *KinematicCollision2D) ColliderVelocity() gdnative.Vector2 {
+	return o.GetColliderVelocity()
+}
+
+// LocalShape will return the value of the "local_shape" property.
+func (o *KinematicCollision2D) LocalShape() godot.ObjectImplementer {
+	return o.GetLocalShape()
+}
+
+// Normal will return the value of the "normal" property.
+func (o *KinematicCollision2D) Normal() gdnative.Vector2 {
+	return o.GetNormal()
+}
+
+// Position will return the value of the "position" property.
+func (o *KinematicCollision2D) Position() gdnative.Vector2 {
+	return o.GetPosition()
+}
+
+// Remainder will return the value of the "remainder" property.
+func (o *KinematicCollision2D) Remainder() gdnative.Vector2 {
+	return o.GetRemainder()
+}
+
+// Travel will return the value of the "travel" property.
+func (o *KinematicCollision2D) Travel() gdnative.Vector2 {
+	return o.GetTravel()
+}
+
+// KinematicCollision2DImplementer is an interface that implements the methods
+// of the KinematicCollision2D class.
+type KinematicCollision2DImplementer interface {
+	godot.ReferenceImplementer
+	GetCollider() godot.ObjectImplementer
+	GetColliderId() gdnative.Int
+	GetColliderMetadata() gdnative.Variant
+	GetColliderShape() godot.ObjectImplementer
+	GetColliderShapeIndex() gdnative.Int
+	GetColliderVelocity() gdnative.Vector2
+	GetLocalShape() godot.ObjectImplementer
+	GetNormal() gdnative.Vector2
+	GetPosition() gdnative.Vector2
+	GetRemainder() gdnative.Vector2
+	GetTravel() gdnative.Vector2
+	Collider() godot.ObjectImplementer
+	ColliderId() gdnative.Int
+	ColliderMetadata() gdnative.Variant
+	ColliderShape() godot.ObjectImplementer
+	ColliderShapeIndex() gdnative.Int
+	ColliderVelocity() gdnative.Vector2
+	LocalShape() godot.ObjectImplementer
+	Normal() gdnative.Vector2
+	Position() gdnative.Vector2
+	Remainder() gdnative.Vector2
+	Travel() gdnative.Vector2
+}
@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,21 +14,12 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewmarshallsFromPointer(ptr gdnative.Pointer) marshalls {
-func new_MarshallsFromPointer(ptr gdnative.Pointer) marshalls {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := marshalls{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 func newSingletonMarshalls() *marshalls {
 	return &marshalls{}
 }
 
 /*
-   Provides data transformation and encoding utility functions.
+Provides data transformation and encoding utility functions.
 */
 var Marshalls = newSingletonMarshalls()
 
@@ -35,7 +27,7 @@ var Marshalls = newSingletonMarshalls()
 Provides data transformation and encoding utility functions.
 */
 type marshalls struct {
-	Reference
+	godot.Reference
 	owner       gdnative.Object
 	initialized bool
 }
@@ -56,9 +48,20 @@ func (o *marshalls) BaseClass() string {
 	return "_Marshalls"
 }
 
+// Method binds of the Marshalls class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMarshallsBase64ToRaw     = gdnative.NewLazyMethodBind("_Marshalls", "base64_to_raw")
+	methodBindMarshallsBase64ToUtf8    = gdnative.NewLazyMethodBind("_Marshalls", "base64_to_utf8")
+	methodBindMarshallsBase64ToVariant = gdnative.NewLazyMethodBind("_Marshalls", "base64_to_variant")
+	methodBindMarshallsRawToBase64     = gdnative.NewLazyMethodBind("_Marshalls", "raw_to_base64")
+	methodBindMarshallsUtf8ToBase64    = gdnative.NewLazyMethodBind("_Marshalls", "utf8_to_base64")
+	methodBindMarshallsVariantToBase64 = gdnative.NewLazyMethodBind("_Marshalls", "variant_to_base64")
+)
+
 /*
-        Undocumented
-	Args: [{ false base64_str String}], Returns: PoolByteArray
+	        Undocumented
+		Args: [{ false base64_str String}], Returns: PoolByteArray
 */
 func (o *marshalls) Base64ToRaw(base64Str gdnative.String) gdnative.PoolByteArray {
 	o.ensureSingleton()
@@ -69,7 +72,7 @@ func (o *marshalls) Base64ToRaw(base64Str gdnative.String) gdnative.PoolByteArra
 	ptrArguments[0] = gdnative.NewPointerFromString(base64Str)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Marshalls", "base64_to_raw")
+	methodBind := methodBindMarshallsBase64ToRaw.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -82,8 +85,8 @@ func (o *marshalls) Base64ToRaw(base64Str gdnative.String) gdnative.PoolByteArra
 }
 
 /*
-        Undocumented
-	Args: [{ false base64_str String}], Returns: String
+	        Undocumented
+		Args: [{ false base64_str String}], Returns: String
 */
 func (o *marshalls) Base64ToUtf8(base64Str gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -94,7 +97,7 @@ func (o *marshalls) Base64ToUtf8(base64Str gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(base64Str)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Marshalls", "base64_to_utf8")
+	methodBind := methodBindMarshallsBase64ToUtf8.Get()
 
 	// Call the parent method.
 	// String
@@ -107,8 +110,8 @@ func (o *marshalls) Base64ToUtf8(base64Str gdnative.String) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false base64_str String}], Returns: Variant
+	        Undocumented
+		Args: [{ false base64_str String}], Returns: Variant
 */
 func (o *marshalls) Base64ToVariant(base64Str gdnative.String) gdnative.Variant {
 	o.ensureSingleton()
@@ -119,7 +122,7 @@ func (o *marshalls) Base64ToVariant(base64Str gdnative.String) gdnative.Variant
 	ptrArguments[0] = gdnative.NewPointerFromString(base64Str)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Marshalls", "base64_to_variant")
+	methodBind := methodBindMarshallsBase64ToVariant.Get()
 
 	// Call the parent method.
 	// Variant
@@ -132,8 +135,8 @@ func (o *marshalls) Base64ToVariant(base64Str gdnative.String) gdnative.Variant
 }
 
 /*
-        Undocumented
-	Args: [{ false array PoolByteArray}], Returns: String
+	        Undocumented
+		Args: [{ false array PoolByteArray}], Returns: String
 */
 func (o *marshalls) RawToBase64(array gdnative.PoolByteArray) gdnative.String {
 	o.ensureSingleton()
@@ -144,7 +147,7 @@ func (o *marshalls) RawToBase64(array gdnative.PoolByteArray) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(array)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Marshalls", "raw_to_base64")
+	methodBind := methodBindMarshallsRawToBase64.Get()
 
 	// Call the parent method.
 	// String
@@ -157,8 +160,8 @@ func (o *marshalls) RawToBase64(array gdnative.PoolByteArray) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false utf8_str String}], Returns: String
+	        Undocumented
+		Args: [{ false utf8_str String}], Returns: String
 */
 func (o *marshalls) Utf8ToBase64(utf8Str gdnative.String) gdnative.String {
 	o.ensureSingleton()
@@ -169,7 +172,7 @@ func (o *marshalls) Utf8ToBase64(utf8Str gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(utf8Str)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Marshalls", "utf8_to_base64")
+	methodBind := methodBindMarshallsUtf8ToBase64.Get()
 
 	// Call the parent method.
 	// String
@@ -182,8 +185,8 @@ func (o *marshalls) Utf8ToBase64(utf8Str gdnative.String) gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false variant Variant}], Returns: String
+	        Undocumented
+		Args: [{ false variant Variant}], Returns: String
 */
 func (o *marshalls) VariantToBase64(variant gdnative.Variant) gdnative.String {
 	o.ensureSingleton()
@@ -194,7 +197,7 @@ func (o *marshalls) VariantToBase64(variant gdnative.Variant) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(variant)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Marshalls", "variant_to_base64")
+	methodBind := methodBindMarshallsVariantToBase64.Get()
 
 	// Call the parent method.
 	// String
@@ -209,7 +212,7 @@ func (o *marshalls) VariantToBase64(variant gdnative.Variant) gdnative.String {
 // MarshallsImplementer is an interface that implements the methods
 // of the Marshalls class.
 type MarshallsImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	Base64ToRaw(base64Str gdnative.String) gdnative.PoolByteArray
 	Base64ToUtf8(base64Str gdnative.String) gdnative.String
 	Base64ToVariant(base64Str gdnative.String) gdnative.Variant
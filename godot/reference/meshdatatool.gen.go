@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,79 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMeshDataToolFromPointer(ptr gdnative.Pointer) MeshDataTool {
-func newMeshDataToolFromPointer(ptr gdnative.Pointer) MeshDataTool {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := MeshDataTool{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type MeshDataTool struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorMeshDataTool is the class constructor of the MeshDataTool class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMeshDataTool = gdnative.NewLazyClassConstructor("MeshDataTool")
+
+// NewMeshDataTool will create a new instance of the MeshDataTool class.
+// MeshDataTool is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewMeshDataTool() *MeshDataTool {
+	obj := &MeshDataTool{}
+	obj.SetBaseObject(classConstructorMeshDataTool.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *MeshDataTool) BaseClass() string {
 	return "MeshDataTool"
 }
 
-/*
+// Method binds of the MeshDataTool class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMeshDataToolClear             = gdnative.NewLazyMethodBind("MeshDataTool", "clear")
+	methodBindMeshDataToolCommitToSurface   = gdnative.NewLazyMethodBind("MeshDataTool", "commit_to_surface")
+	methodBindMeshDataToolCreateFromSurface = gdnative.NewLazyMethodBind("MeshDataTool", "create_from_surface")
+	methodBindMeshDataToolGetEdgeCount      = gdnative.NewLazyMethodBind("MeshDataTool", "get_edge_count")
+	methodBindMeshDataToolGetEdgeFaces      = gdnative.NewLazyMethodBind("MeshDataTool", "get_edge_faces")
+	methodBindMeshDataToolGetEdgeMeta       = gdnative.NewLazyMethodBind("MeshDataTool", "get_edge_meta")
+	methodBindMeshDataToolGetEdgeVertex     = gdnative.NewLazyMethodBind("MeshDataTool", "get_edge_vertex")
+	methodBindMeshDataToolGetFaceCount      = gdnative.NewLazyMethodBind("MeshDataTool", "get_face_count")
+	methodBindMeshDataToolGetFaceEdge       = gdnative.NewLazyMethodBind("MeshDataTool", "get_face_edge")
+	methodBindMeshDataToolGetFaceMeta       = gdnative.NewLazyMethodBind("MeshDataTool", "get_face_meta")
+	methodBindMeshDataToolGetFaceNormal     = gdnative.NewLazyMethodBind("MeshDataTool", "get_face_normal")
+	methodBindMeshDataToolGetFaceVertex     = gdnative.NewLazyMethodBind("MeshDataTool", "get_face_vertex")
+	methodBindMeshDataToolGetFormat         = gdnative.NewLazyMethodBind("MeshDataTool", "get_format")
+	methodBindMeshDataToolGetMaterial       = gdnative.NewLazyMethodBind("MeshDataTool", "get_material")
+	methodBindMeshDataToolGetVertex         = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex")
+	methodBindMeshDataToolGetVertexBones    = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_bones")
+	methodBindMeshDataToolGetVertexColor    = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_color")
+	methodBindMeshDataToolGetVertexCount    = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_count")
+	methodBindMeshDataToolGetVertexEdges    = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_edges")
+	methodBindMeshDataToolGetVertexFaces    = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_faces")
+	methodBindMeshDataToolGetVertexMeta     = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_meta")
+	methodBindMeshDataToolGetVertexNormal   = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_normal")
+	methodBindMeshDataToolGetVertexTangent  = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_tangent")
+	methodBindMeshDataToolGetVertexUv       = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_uv")
+	methodBindMeshDataToolGetVertexUv2      = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_uv2")
+	methodBindMeshDataToolGetVertexWeights  = gdnative.NewLazyMethodBind("MeshDataTool", "get_vertex_weights")
+	methodBindMeshDataToolSetEdgeMeta       = gdnative.NewLazyMethodBind("MeshDataTool", "set_edge_meta")
+	methodBindMeshDataToolSetFaceMeta       = gdnative.NewLazyMethodBind("MeshDataTool", "set_face_meta")
+	methodBindMeshDataToolSetMaterial       = gdnative.NewLazyMethodBind("MeshDataTool", "set_material")
+	methodBindMeshDataToolSetVertex         = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex")
+	methodBindMeshDataToolSetVertexBones    = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_bones")
+	methodBindMeshDataToolSetVertexColor    = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_color")
+	methodBindMeshDataToolSetVertexMeta     = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_meta")
+	methodBindMeshDataToolSetVertexNormal   = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_normal")
+	methodBindMeshDataToolSetVertexTangent  = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_tangent")
+	methodBindMeshDataToolSetVertexUv       = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_uv")
+	methodBindMeshDataToolSetVertexUv2      = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_uv2")
+	methodBindMeshDataToolSetVertexWeights  = gdnative.NewLazyMethodBind("MeshDataTool", "set_vertex_weights")
+)
 
-	Args: [], Returns: void
+/*
+Args: [], Returns: void
 */
 func (o *MeshDataTool) Clear() {
 	//log.Println("Calling MeshDataTool.Clear()")
@@ -45,7 +95,7 @@ func (o *MeshDataTool) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "clear")
+	methodBind := methodBindMeshDataToolClear.Get()
 
 	// Call the parent method.
 	// void
@@ -55,18 +105,17 @@ func (o *MeshDataTool) Clear() {
 }
 
 /*
-
-	Args: [{ false mesh ArrayMesh}], Returns: enum.Error
+Args: [{ false mesh ArrayMesh}], Returns: enum.Error
 */
-func (o *MeshDataTool) CommitToSurface(mesh ArrayMeshImplementer) gdnative.Error {
+func (o *MeshDataTool) CommitToSurface(mesh godot.ArrayMeshImplementer) gdnative.Error {
 	//log.Println("Calling MeshDataTool.CommitToSurface()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "commit_to_surface")
+	methodBind := methodBindMeshDataToolCommitToSurface.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -79,19 +128,18 @@ func (o *MeshDataTool) CommitToSurface(mesh ArrayMeshImplementer) gdnative.Error
 }
 
 /*
-
-	Args: [{ false mesh ArrayMesh} { false surface int}], Returns: enum.Error
+Args: [{ false mesh ArrayMesh} { false surface int}], Returns: enum.Error
 */
-func (o *MeshDataTool) CreateFromSurface(mesh ArrayMeshImplementer, surface gdnative.Int) gdnative.Error {
+func (o *MeshDataTool) CreateFromSurface(mesh godot.ArrayMeshImplementer, surface gdnative.Int) gdnative.Error {
 	//log.Println("Calling MeshDataTool.CreateFromSurface()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "create_from_surface")
+	methodBind := methodBindMeshDataToolCreateFromSurface.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -104,8 +152,7 @@ func (o *MeshDataTool) CreateFromSurface(mesh ArrayMeshImplementer, surface gdna
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *MeshDataTool) GetEdgeCount() gdnative.Int {
 	//log.Println("Calling MeshDataTool.GetEdgeCount()")
@@ -114,7 +161,7 @@ func (o *MeshDataTool) GetEdgeCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_edge_count")
+	methodBind := methodBindMeshDataToolGetEdgeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +174,7 @@ func (o *MeshDataTool) GetEdgeCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolIntArray
+Args: [{ false idx int}], Returns: PoolIntArray
 */
 func (o *MeshDataTool) GetEdgeFaces(idx gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling MeshDataTool.GetEdgeFaces()")
@@ -138,7 +184,7 @@ func (o *MeshDataTool) GetEdgeFaces(idx gdnative.Int) gdnative.PoolIntArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_edge_faces")
+	methodBind := methodBindMeshDataToolGetEdgeFaces.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -151,8 +197,7 @@ func (o *MeshDataTool) GetEdgeFaces(idx gdnative.Int) gdnative.PoolIntArray {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Variant
+Args: [{ false idx int}], Returns: Variant
 */
 func (o *MeshDataTool) GetEdgeMeta(idx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling MeshDataTool.GetEdgeMeta()")
@@ -162,7 +207,7 @@ func (o *MeshDataTool) GetEdgeMeta(idx gdnative.Int) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_edge_meta")
+	methodBind := methodBindMeshDataToolGetEdgeMeta.Get()
 
 	// Call the parent method.
 	// Variant
@@ -175,8 +220,7 @@ func (o *MeshDataTool) GetEdgeMeta(idx gdnative.Int) gdnative.Variant {
 }
 
 /*
-
-	Args: [{ false idx int} { false vertex int}], Returns: int
+Args: [{ false idx int} { false vertex int}], Returns: int
 */
 func (o *MeshDataTool) GetEdgeVertex(idx gdnative.Int, vertex gdnative.Int) gdnative.Int {
 	//log.Println("Calling MeshDataTool.GetEdgeVertex()")
@@ -187,7 +231,7 @@ func (o *MeshDataTool) GetEdgeVertex(idx gdnative.Int, vertex gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(vertex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_edge_vertex")
+	methodBind := methodBindMeshDataToolGetEdgeVertex.Get()
 
 	// Call the parent method.
 	// int
@@ -200,8 +244,7 @@ func (o *MeshDataTool) GetEdgeVertex(idx gdnative.Int, vertex gdnative.Int) gdna
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *MeshDataTool) GetFaceCount() gdnative.Int {
 	//log.Println("Calling MeshDataTool.GetFaceCount()")
@@ -210,7 +253,7 @@ func (o *MeshDataTool) GetFaceCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_face_count")
+	methodBind := methodBindMeshDataToolGetFaceCount.Get()
 
 	// Call the parent method.
 	// int
@@ -223,8 +266,7 @@ func (o *MeshDataTool) GetFaceCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int} { false edge int}], Returns: int
+Args: [{ false idx int} { false edge int}], Returns: int
 */
 func (o *MeshDataTool) GetFaceEdge(idx gdnative.Int, edge gdnative.Int) gdnative.Int {
 	//log.Println("Calling MeshDataTool.GetFaceEdge()")
@@ -235,7 +277,7 @@ func (o *MeshDataTool) GetFaceEdge(idx gdnative.Int, edge gdnative.Int) gdnative
 	ptrArguments[1] = gdnative.NewPointerFromInt(edge)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_face_edge")
+	methodBind := methodBindMeshDataToolGetFaceEdge.Get()
 
 	// Call the parent method.
 	// int
@@ -248,8 +290,7 @@ func (o *MeshDataTool) GetFaceEdge(idx gdnative.Int, edge gdnative.Int) gdnative
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Variant
+Args: [{ false idx int}], Returns: Variant
 */
 func (o *MeshDataTool) GetFaceMeta(idx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling MeshDataTool.GetFaceMeta()")
@@ -259,7 +300,7 @@ func (o *MeshDataTool) GetFaceMeta(idx gdnative.Int) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_face_meta")
+	methodBind := methodBindMeshDataToolGetFaceMeta.Get()
 
 	// Call the parent method.
 	// Variant
@@ -272,8 +313,7 @@ func (o *MeshDataTool) GetFaceMeta(idx gdnative.Int) gdnative.Variant {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Vector3
+Args: [{ false idx int}], Returns: Vector3
 */
 func (o *MeshDataTool) GetFaceNormal(idx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling MeshDataTool.GetFaceNormal()")
@@ -283,7 +323,7 @@ func (o *MeshDataTool) GetFaceNormal(idx gdnative.Int) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_face_normal")
+	methodBind := methodBindMeshDataToolGetFaceNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -296,8 +336,7 @@ func (o *MeshDataTool) GetFaceNormal(idx gdnative.Int) gdnative.Vector3 {
 }
 
 /*
-
-	Args: [{ false idx int} { false vertex int}], Returns: int
+Args: [{ false idx int} { false vertex int}], Returns: int
 */
 func (o *MeshDataTool) GetFaceVertex(idx gdnative.Int, vertex gdnative.Int) gdnative.Int {
 	//log.Println("Calling MeshDataTool.GetFaceVertex()")
@@ -308,7 +347,7 @@ func (o *MeshDataTool) GetFaceVertex(idx gdnative.Int, vertex gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(vertex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_face_vertex")
+	methodBind := methodBindMeshDataToolGetFaceVertex.Get()
 
 	// Call the parent method.
 	// int
@@ -321,8 +360,7 @@ func (o *MeshDataTool) GetFaceVertex(idx gdnative.Int, vertex gdnative.Int) gdna
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *MeshDataTool) GetFormat() gdnative.Int {
 	//log.Println("Calling MeshDataTool.GetFormat()")
@@ -331,7 +369,7 @@ func (o *MeshDataTool) GetFormat() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_format")
+	methodBind := methodBindMeshDataToolGetFormat.Get()
 
 	// Call the parent method.
 	// int
@@ -344,17 +382,16 @@ func (o *MeshDataTool) GetFormat() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: Material
+Args: [], Returns: Material
 */
-func (o *MeshDataTool) GetMaterial() MaterialImplementer {
+func (o *MeshDataTool) GetMaterial() godot.MaterialImplementer {
 	//log.Println("Calling MeshDataTool.GetMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_material")
+	methodBind := methodBindMeshDataToolGetMaterial.Get()
 
 	// Call the parent method.
 	// Material
@@ -362,27 +399,24 @@ func (o *MeshDataTool) GetMaterial() MaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := godot.Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Vector3
+Args: [{ false idx int}], Returns: Vector3
 */
 func (o *MeshDataTool) GetVertex(idx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling MeshDataTool.GetVertex()")
@@ -392,7 +426,7 @@ func (o *MeshDataTool) GetVertex(idx gdnative.Int) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex")
+	methodBind := methodBindMeshDataToolGetVertex.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -405,8 +439,7 @@ func (o *MeshDataTool) GetVertex(idx gdnative.Int) gdnative.Vector3 {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolIntArray
+Args: [{ false idx int}], Returns: PoolIntArray
 */
 func (o *MeshDataTool) GetVertexBones(idx gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling MeshDataTool.GetVertexBones()")
@@ -416,7 +449,7 @@ func (o *MeshDataTool) GetVertexBones(idx gdnative.Int) gdnative.PoolIntArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_bones")
+	methodBind := methodBindMeshDataToolGetVertexBones.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -429,8 +462,7 @@ func (o *MeshDataTool) GetVertexBones(idx gdnative.Int) gdnative.PoolIntArray {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Color
+Args: [{ false idx int}], Returns: Color
 */
 func (o *MeshDataTool) GetVertexColor(idx gdnative.Int) gdnative.Color {
 	//log.Println("Calling MeshDataTool.GetVertexColor()")
@@ -440,7 +472,7 @@ func (o *MeshDataTool) GetVertexColor(idx gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_color")
+	methodBind := methodBindMeshDataToolGetVertexColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -453,8 +485,7 @@ func (o *MeshDataTool) GetVertexColor(idx gdnative.Int) gdnative.Color {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *MeshDataTool) GetVertexCount() gdnative.Int {
 	//log.Println("Calling MeshDataTool.GetVertexCount()")
@@ -463,7 +494,7 @@ func (o *MeshDataTool) GetVertexCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_count")
+	methodBind := methodBindMeshDataToolGetVertexCount.Get()
 
 	// Call the parent method.
 	// int
@@ -476,8 +507,7 @@ func (o *MeshDataTool) GetVertexCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolIntArray
+Args: [{ false idx int}], Returns: PoolIntArray
 */
 func (o *MeshDataTool) GetVertexEdges(idx gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling MeshDataTool.GetVertexEdges()")
@@ -487,7 +517,7 @@ func (o *MeshDataTool) GetVertexEdges(idx gdnative.Int) gdnative.PoolIntArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_edges")
+	methodBind := methodBindMeshDataToolGetVertexEdges.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -500,8 +530,7 @@ func (o *MeshDataTool) GetVertexEdges(idx gdnative.Int) gdnative.PoolIntArray {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolIntArray
+Args: [{ false idx int}], Returns: PoolIntArray
 */
 func (o *MeshDataTool) GetVertexFaces(idx gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling MeshDataTool.GetVertexFaces()")
@@ -511,7 +540,7 @@ func (o *MeshDataTool) GetVertexFaces(idx gdnative.Int) gdnative.PoolIntArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_faces")
+	methodBind := methodBindMeshDataToolGetVertexFaces.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -524,8 +553,7 @@ func (o *MeshDataTool) GetVertexFaces(idx gdnative.Int) gdnative.PoolIntArray {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Variant
+Args: [{ false idx int}], Returns: Variant
 */
 func (o *MeshDataTool) GetVertexMeta(idx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling MeshDataTool.GetVertexMeta()")
@@ -535,7 +563,7 @@ func (o *MeshDataTool) GetVertexMeta(idx gdnative.Int) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_meta")
+	methodBind := methodBindMeshDataToolGetVertexMeta.Get()
 
 	// Call the parent method.
 	// Variant
@@ -548,8 +576,7 @@ func (o *MeshDataTool) GetVertexMeta(idx gdnative.Int) gdnative.Variant {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Vector3
+Args: [{ false idx int}], Returns: Vector3
 */
 func (o *MeshDataTool) GetVertexNormal(idx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling MeshDataTool.GetVertexNormal()")
@@ -559,7 +586,7 @@ func (o *MeshDataTool) GetVertexNormal(idx gdnative.Int) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_normal")
+	methodBind := methodBindMeshDataToolGetVertexNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -572,8 +599,7 @@ func (o *MeshDataTool) GetVertexNormal(idx gdnative.Int) gdnative.Vector3 {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Plane
+Args: [{ false idx int}], Returns: Plane
 */
 func (o *MeshDataTool) GetVertexTangent(idx gdnative.Int) gdnative.Plane {
 	//log.Println("Calling MeshDataTool.GetVertexTangent()")
@@ -583,7 +609,7 @@ func (o *MeshDataTool) GetVertexTangent(idx gdnative.Int) gdnative.Plane {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_tangent")
+	methodBind := methodBindMeshDataToolGetVertexTangent.Get()
 
 	// Call the parent method.
 	// Plane
@@ -596,8 +622,7 @@ func (o *MeshDataTool) GetVertexTangent(idx gdnative.Int) gdnative.Plane {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Vector2
+Args: [{ false idx int}], Returns: Vector2
 */
 func (o *MeshDataTool) GetVertexUv(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling MeshDataTool.GetVertexUv()")
@@ -607,7 +632,7 @@ func (o *MeshDataTool) GetVertexUv(idx gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_uv")
+	methodBind := methodBindMeshDataToolGetVertexUv.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -620,8 +645,7 @@ func (o *MeshDataTool) GetVertexUv(idx gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: Vector2
+Args: [{ false idx int}], Returns: Vector2
 */
 func (o *MeshDataTool) GetVertexUv2(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling MeshDataTool.GetVertexUv2()")
@@ -631,7 +655,7 @@ func (o *MeshDataTool) GetVertexUv2(idx gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_uv2")
+	methodBind := methodBindMeshDataToolGetVertexUv2.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -644,8 +668,7 @@ func (o *MeshDataTool) GetVertexUv2(idx gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolRealArray
+Args: [{ false idx int}], Returns: PoolRealArray
 */
 func (o *MeshDataTool) GetVertexWeights(idx gdnative.Int) gdnative.PoolRealArray {
 	//log.Println("Calling MeshDataTool.GetVertexWeights()")
@@ -655,7 +678,7 @@ func (o *MeshDataTool) GetVertexWeights(idx gdnative.Int) gdnative.PoolRealArray
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "get_vertex_weights")
+	methodBind := methodBindMeshDataToolGetVertexWeights.Get()
 
 	// Call the parent method.
 	// PoolRealArray
@@ -668,8 +691,7 @@ func (o *MeshDataTool) GetVertexWeights(idx gdnative.Int) gdnative.PoolRealArray
 }
 
 /*
-
-	Args: [{ false idx int} { false meta Variant}], Returns: void
+Args: [{ false idx int} { false meta Variant}], Returns: void
 */
 func (o *MeshDataTool) SetEdgeMeta(idx gdnative.Int, meta gdnative.Variant) {
 	//log.Println("Calling MeshDataTool.SetEdgeMeta()")
@@ -680,7 +702,7 @@ func (o *MeshDataTool) SetEdgeMeta(idx gdnative.Int, meta gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(meta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_edge_meta")
+	methodBind := methodBindMeshDataToolSetEdgeMeta.Get()
 
 	// Call the parent method.
 	// void
@@ -690,8 +712,7 @@ func (o *MeshDataTool) SetEdgeMeta(idx gdnative.Int, meta gdnative.Variant) {
 }
 
 /*
-
-	Args: [{ false idx int} { false meta Variant}], Returns: void
+Args: [{ false idx int} { false meta Variant}], Returns: void
 */
 func (o *MeshDataTool) SetFaceMeta(idx gdnative.Int, meta gdnative.Variant) {
 	//log.Println("Calling MeshDataTool.SetFaceMeta()")
@@ -702,7 +723,7 @@ func (o *MeshDataTool) SetFaceMeta(idx gdnative.Int, meta gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(meta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_face_meta")
+	methodBind := methodBindMeshDataToolSetFaceMeta.Get()
 
 	// Call the parent method.
 	// void
@@ -712,18 +733,17 @@ func (o *MeshDataTool) SetFaceMeta(idx gdnative.Int, meta gdnative.Variant) {
 }
 
 /*
-
-	Args: [{ false material Material}], Returns: void
+Args: [{ false material Material}], Returns: void
 */
-func (o *MeshDataTool) SetMaterial(material MaterialImplementer) {
+func (o *MeshDataTool) SetMaterial(material godot.MaterialImplementer) {
 	//log.Println("Calling MeshDataTool.SetMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_material")
+	methodBind := methodBindMeshDataToolSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -733,8 +753,7 @@ func (o *MeshDataTool) SetMaterial(material MaterialImplementer) {
 }
 
 /*
-
-	Args: [{ false idx int} { false vertex Vector3}], Returns: void
+Args: [{ false idx int} { false vertex Vector3}], Returns: void
 */
 func (o *MeshDataTool) SetVertex(idx gdnative.Int, vertex gdnative.Vector3) {
 	//log.Println("Calling MeshDataTool.SetVertex()")
@@ -745,7 +764,7 @@ func (o *MeshDataTool) SetVertex(idx gdnative.Int, vertex gdnative.Vector3) {
 	ptrArguments[1] = gdnative.NewPointerFromVector3(vertex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex")
+	methodBind := methodBindMeshDataToolSetVertex.Get()
 
 	// Call the parent method.
 	// void
@@ -755,8 +774,7 @@ func (o *MeshDataTool) SetVertex(idx gdnative.Int, vertex gdnative.Vector3) {
 }
 
 /*
-
-	Args: [{ false idx int} { false bones PoolIntArray}], Returns: void
+Args: [{ false idx int} { false bones PoolIntArray}], Returns: void
 */
 func (o *MeshDataTool) SetVertexBones(idx gdnative.Int, bones gdnative.PoolIntArray) {
 	//log.Println("Calling MeshDataTool.SetVertexBones()")
@@ -767,7 +785,7 @@ func (o *MeshDataTool) SetVertexBones(idx gdnative.Int, bones gdnative.PoolIntAr
 	ptrArguments[1] = gdnative.NewPointerFromPoolIntArray(bones)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_bones")
+	methodBind := methodBindMeshDataToolSetVertexBones.Get()
 
 	// Call the parent method.
 	// void
@@ -777,8 +795,7 @@ func (o *MeshDataTool) SetVertexBones(idx gdnative.Int, bones gdnative.PoolIntAr
 }
 
 /*
-
-	Args: [{ false idx int} { false color Color}], Returns: void
+Args: [{ false idx int} { false color Color}], Returns: void
 */
 func (o *MeshDataTool) SetVertexColor(idx gdnative.Int, color gdnative.Color) {
 	//log.Println("Calling MeshDataTool.SetVertexColor()")
@@ -789,7 +806,7 @@ func (o *MeshDataTool) SetVertexColor(idx gdnative.Int, color gdnative.Color) {
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_color")
+	methodBind := methodBindMeshDataToolSetVertexColor.Get()
 
 	// Call the parent method.
 	// void
@@ -799,8 +816,7 @@ func (o *MeshDataTool) SetVertexColor(idx gdnative.Int, color gdnative.Color) {
 }
 
 /*
-
-	Args: [{ false idx int} { false meta Variant}], Returns: void
+Args: [{ false idx int} { false meta Variant}], Returns: void
 */
 func (o *MeshDataTool) SetVertexMeta(idx gdnative.Int, meta gdnative.Variant) {
 	//log.Println("Calling MeshDataTool.SetVertexMeta()")
@@ -811,7 +827,7 @@ func (o *MeshDataTool) SetVertexMeta(idx gdnative.Int, meta gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(meta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_meta")
+	methodBind := methodBindMeshDataToolSetVertexMeta.Get()
 
 	// Call the parent method.
 	// void
@@ -821,8 +837,7 @@ func (o *MeshDataTool) SetVertexMeta(idx gdnative.Int, meta gdnative.Variant) {
 }
 
 /*
-
-	Args: [{ false idx int} { false normal Vector3}], Returns: void
+Args: [{ false idx int} { false normal Vector3}], Returns: void
 */
 func (o *MeshDataTool) SetVertexNormal(idx gdnative.Int, normal gdnative.Vector3) {
 	//log.Println("Calling MeshDataTool.SetVertexNormal()")
@@ -833,7 +848,7 @@ func (o *MeshDataTool) SetVertexNormal(idx gdnative.Int, normal gdnative.Vector3
 	ptrArguments[1] = gdnative.NewPointerFromVector3(normal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_normal")
+	methodBind := methodBindMeshDataToolSetVertexNormal.Get()
 
 	// Call the parent method.
 	// void
@@ -843,8 +858,7 @@ func (o *MeshDataTool) SetVertexNormal(idx gdnative.Int, normal gdnative.Vector3
 }
 
 /*
-
-	Args: [{ false idx int} { false tangent Plane}], Returns: void
+Args: [{ false idx int} { false tangent Plane}], Returns: void
 */
 func (o *MeshDataTool) SetVertexTangent(idx gdnative.Int, tangent gdnative.Plane) {
 	//log.Println("Calling MeshDataTool.SetVertexTangent()")
@@ -855,7 +869,7 @@ func (o *MeshDataTool) SetVertexTangent(idx gdnative.Int, tangent gdnative.Plane
 	ptrArguments[1] = gdnative.NewPointerFromPlane(tangent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_tangent")
+	methodBind := methodBindMeshDataToolSetVertexTangent.Get()
 
 	// Call the parent method.
 	// void
@@ -865,8 +879,7 @@ func (o *MeshDataTool) SetVertexTangent(idx gdnative.Int, tangent gdnative.Plane
 }
 
 /*
-
-	Args: [{ false idx int} { false uv Vector2}], Returns: void
+Args: [{ false idx int} { false uv Vector2}], Returns: void
 */
 func (o *MeshDataTool) SetVertexUv(idx gdnative.Int, uv gdnative.Vector2) {
 	//log.Println("Calling MeshDataTool.SetVertexUv()")
@@ -877,7 +890,7 @@ func (o *MeshDataTool) SetVertexUv(idx gdnative.Int, uv gdnative.Vector2) {
 	ptrArguments[1] = gdnative.NewPointerFromVector2(uv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_uv")
+	methodBind := methodBindMeshDataToolSetVertexUv.Get()
 
 	// Call the parent method.
 	// void
@@ -887,8 +900,7 @@ func (o *MeshDataTool) SetVertexUv(idx gdnative.Int, uv gdnative.Vector2) {
 }
 
 /*
-
-	Args: [{ false idx int} { false uv2 Vector2}], Returns: void
+Args: [{ false idx int} { false uv2 Vector2}], Returns: void
 */
 func (o *MeshDataTool) SetVertexUv2(idx gdnative.Int, uv2 gdnative.Vector2) {
 	//log.Println("Calling MeshDataTool.SetVertexUv2()")
@@ -899,7 +911,7 @@ func (o *MeshDataTool) SetVertexUv2(idx gdnative.Int, uv2 gdnative.Vector2) {
 	ptrArguments[1] = gdnative.NewPointerFromVector2(uv2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_uv2")
+	methodBind := methodBindMeshDataToolSetVertexUv2.Get()
 
 	// Call the parent method.
 	// void
@@ -909,8 +921,7 @@ func (o *MeshDataTool) SetVertexUv2(idx gdnative.Int, uv2 gdnative.Vector2) {
 }
 
 /*
-
-	Args: [{ false idx int} { false weights PoolRealArray}], Returns: void
+Args: [{ false idx int} { false weights PoolRealArray}], Returns: void
 */
 func (o *MeshDataTool) SetVertexWeights(idx gdnative.Int, weights gdnative.PoolRealArray) {
 	//log.Println("Calling MeshDataTool.SetVertexWeights()")
@@ -921,7 +932,7 @@ func (o *MeshDataTool) SetVertexWeights(idx gdnative.Int, weights gdnative.PoolR
 	ptrArguments[1] = gdnative.NewPointerFromPoolRealArray(weights)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshDataTool", "set_vertex_weights")
+	methodBind := methodBindMeshDataToolSetVertexWeights.Get()
 
 	// Call the parent method.
 	// void
@@ -933,8 +944,10 @@ func (o *MeshDataTool) SetVertexWeights(idx gdnative.Int, weights gdnative.PoolR
 // MeshDataToolImplementer is an interface that implements the methods
 // of the MeshDataTool class.
 type MeshDataToolImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	Clear()
+	CommitToSurface(mesh godot.ArrayMeshImplementer) gdnative.Error
+	CreateFromSurface(mesh godot.ArrayMeshImplementer, surface gdnative.Int) gdnative.Error
 	GetEdgeCount() gdnative.Int
 	GetEdgeFaces(idx gdnative.Int) gdnative.PoolIntArray
 	GetEdgeMeta(idx gdnative.Int) gdnative.Variant
@@ -945,7 +958,7 @@ type MeshDataToolImplementer interface {
 	GetFaceNormal(idx gdnative.Int) gdnative.Vector3
 	GetFaceVertex(idx gdnative.Int, vertex gdnative.Int) gdnative.Int
 	GetFormat() gdnative.Int
-	GetMaterial() MaterialImplementer
+	GetMaterial() godot.MaterialImplementer
 	GetVertex(idx gdnative.Int) gdnative.Vector3
 	GetVertexBones(idx gdnative.Int) gdnative.PoolIntArray
 	GetVertexColor(idx gdnative.Int) gdnative.Color
@@ -960,7 +973,7 @@ type MeshDataToolImplementer interface {
 	GetVertexWeights(idx gdnative.Int) gdnative.PoolRealArray
 	SetEdgeMeta(idx gdnative.Int, meta gdnative.Variant)
 	SetFaceMeta(idx gdnative.Int, meta gdnative.Variant)
-	SetMaterial(material MaterialImplementer)
+	SetMaterial(material godot.MaterialImplementer)
 	SetVertex(idx gdnative.Int, vertex gdnative.Vector3)
 	SetVertexBones(idx gdnative.Int, bones gdnative.PoolIntArray)
 	SetVertexColor(idx gdnative.Int, color gdnative.Color)
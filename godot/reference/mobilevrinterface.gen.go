@@ -1,7 +1,9 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/arvr"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +15,55 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMobileVRInterfaceFromPointer(ptr gdnative.Pointer) MobileVRInterface {
-func newMobileVRInterfaceFromPointer(ptr gdnative.Pointer) MobileVRInterface {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := MobileVRInterface{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
 type MobileVRInterface struct {
-	ARVRInterface
+	arvr.ARVRInterface
 	owner gdnative.Object
 }
 
+// classConstructorMobileVRInterface is the class constructor of the MobileVRInterface class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMobileVRInterface = gdnative.NewLazyClassConstructor("MobileVRInterface")
+
+// NewMobileVRInterface will create a new instance of the MobileVRInterface class.
+// MobileVRInterface is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewMobileVRInterface() *MobileVRInterface {
+	obj := &MobileVRInterface{}
+	obj.SetBaseObject(classConstructorMobileVRInterface.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *MobileVRInterface) BaseClass() string {
 	return "MobileVRInterface"
 }
 
+// Method binds of the MobileVRInterface class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMobileVRInterfaceGetDisplayToLens = gdnative.NewLazyMethodBind("MobileVRInterface", "get_display_to_lens")
+	methodBindMobileVRInterfaceGetDisplayWidth  = gdnative.NewLazyMethodBind("MobileVRInterface", "get_display_width")
+	methodBindMobileVRInterfaceGetIod           = gdnative.NewLazyMethodBind("MobileVRInterface", "get_iod")
+	methodBindMobileVRInterfaceGetK1            = gdnative.NewLazyMethodBind("MobileVRInterface", "get_k1")
+	methodBindMobileVRInterfaceGetK2            = gdnative.NewLazyMethodBind("MobileVRInterface", "get_k2")
+	methodBindMobileVRInterfaceGetOversample    = gdnative.NewLazyMethodBind("MobileVRInterface", "get_oversample")
+	methodBindMobileVRInterfaceSetDisplayToLens = gdnative.NewLazyMethodBind("MobileVRInterface", "set_display_to_lens")
+	methodBindMobileVRInterfaceSetDisplayWidth  = gdnative.NewLazyMethodBind("MobileVRInterface", "set_display_width")
+	methodBindMobileVRInterfaceSetIod           = gdnative.NewLazyMethodBind("MobileVRInterface", "set_iod")
+	methodBindMobileVRInterfaceSetK1            = gdnative.NewLazyMethodBind("MobileVRInterface", "set_k1")
+	methodBindMobileVRInterfaceSetK2            = gdnative.NewLazyMethodBind("MobileVRInterface", "set_k2")
+	methodBindMobileVRInterfaceSetOversample    = gdnative.NewLazyMethodBind("MobileVRInterface", "set_oversample")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *MobileVRInterface) GetDisplayToLens() gdnative.Real {
 	//log.Println("Calling MobileVRInterface.GetDisplayToLens()")
@@ -45,7 +72,7 @@ func (o *MobileVRInterface) GetDisplayToLens() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "get_display_to_lens")
+	methodBind := methodBindMobileVRInterfaceGetDisplayToLens.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +85,8 @@ func (o *MobileVRInterface) GetDisplayToLens() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *MobileVRInterface) GetDisplayWidth() gdnative.Real {
 	//log.Println("Calling MobileVRInterface.GetDisplayWidth()")
@@ -68,7 +95,7 @@ func (o *MobileVRInterface) GetDisplayWidth() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "get_display_width")
+	methodBind := methodBindMobileVRInterfaceGetDisplayWidth.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +108,8 @@ func (o *MobileVRInterface) GetDisplayWidth() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *MobileVRInterface) GetIod() gdnative.Real {
 	//log.Println("Calling MobileVRInterface.GetIod()")
@@ -91,7 +118,7 @@ func (o *MobileVRInterface) GetIod() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "get_iod")
+	methodBind := methodBindMobileVRInterfaceGetIod.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +131,8 @@ func (o *MobileVRInterface) GetIod() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *MobileVRInterface) GetK1() gdnative.Real {
 	//log.Println("Calling MobileVRInterface.GetK1()")
@@ -114,7 +141,7 @@ func (o *MobileVRInterface) GetK1() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "get_k1")
+	methodBind := methodBindMobileVRInterfaceGetK1.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +154,8 @@ func (o *MobileVRInterface) GetK1() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *MobileVRInterface) GetK2() gdnative.Real {
 	//log.Println("Calling MobileVRInterface.GetK2()")
@@ -137,7 +164,7 @@ func (o *MobileVRInterface) GetK2() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "get_k2")
+	methodBind := methodBindMobileVRInterfaceGetK2.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +177,8 @@ func (o *MobileVRInterface) GetK2() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *MobileVRInterface) GetOversample() gdnative.Real {
 	//log.Println("Calling MobileVRInterface.GetOversample()")
@@ -160,7 +187,7 @@ func (o *MobileVRInterface) GetOversample() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "get_oversample")
+	methodBind := methodBindMobileVRInterfaceGetOversample.Get()
 
 	// Call the parent method.
 	// float
@@ -173,8 +200,8 @@ func (o *MobileVRInterface) GetOversample() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false display_to_lens float}], Returns: void
+	        Undocumented
+		Args: [{ false display_to_lens float}], Returns: void
 */
 func (o *MobileVRInterface) SetDisplayToLens(displayToLens gdnative.Real) {
 	//log.Println("Calling MobileVRInterface.SetDisplayToLens()")
@@ -184,7 +211,7 @@ func (o *MobileVRInterface) SetDisplayToLens(displayToLens gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(displayToLens)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "set_display_to_lens")
+	methodBind := methodBindMobileVRInterfaceSetDisplayToLens.Get()
 
 	// Call the parent method.
 	// void
@@ -194,8 +221,8 @@ func (o *MobileVRInterface) SetDisplayToLens(displayToLens gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false display_width float}], Returns: void
+	        Undocumented
+		Args: [{ false display_width float}], Returns: void
 */
 func (o *MobileVRInterface) SetDisplayWidth(displayWidth gdnative.Real) {
 	//log.Println("Calling MobileVRInterface.SetDisplayWidth()")
@@ -205,7 +232,7 @@ func (o *MobileVRInterface) SetDisplayWidth(displayWidth gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(displayWidth)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "set_display_width")
+	methodBind := methodBindMobileVRInterfaceSetDisplayWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -215,8 +242,8 @@ func (o *MobileVRInterface) SetDisplayWidth(displayWidth gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false iod float}], Returns: void
+	        Undocumented
+		Args: [{ false iod float}], Returns: void
 */
 func (o *MobileVRInterface) SetIod(iod gdnative.Real) {
 	//log.Println("Calling MobileVRInterface.SetIod()")
@@ -226,7 +253,7 @@ func (o *MobileVRInterface) SetIod(iod gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(iod)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "set_iod")
+	methodBind := methodBindMobileVRInterfaceSetIod.Get()
 
 	// Call the parent method.
 	// void
@@ -236,8 +263,8 @@ func (o *MobileVRInterface) SetIod(iod gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false k float}], Returns: void
+	        Undocumented
+		Args: [{ false k float}], Returns: void
 */
 func (o *MobileVRInterface) SetK1(k gdnative.Real) {
 	//log.Println("Calling MobileVRInterface.SetK1()")
@@ -247,7 +274,7 @@ func (o *MobileVRInterface) SetK1(k gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(k)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "set_k1")
+	methodBind := methodBindMobileVRInterfaceSetK1.Get()
 
 	// Call the parent method.
 	// void
@@ -257,8 +284,8 @@ func (o *MobileVRInterface) SetK1(k gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false k float}], Returns: void
+	        Undocumented
+		Args: [{ false k float}], Returns: void
 */
 func (o *MobileVRInterface) SetK2(k gdnative.Real) {
 	//log.Println("Calling MobileVRInterface.SetK2()")
@@ -268,7 +295,7 @@ func (o *MobileVRInterface) SetK2(k gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(k)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "set_k2")
+	methodBind := methodBindMobileVRInterfaceSetK2.Get()
 
 	// Call the parent method.
 	// void
@@ -278,8 +305,8 @@ func (o *MobileVRInterface) SetK2(k gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false oversample float}], Returns: void
+	        Undocumented
+		Args: [{ false oversample float}], Returns: void
 */
 func (o *MobileVRInterface) SetOversample(oversample gdnative.Real) {
 	//log.Println("Calling MobileVRInterface.SetOversample()")
@@ -289,7 +316,7 @@ func (o *MobileVRInterface) SetOversample(oversample gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(oversample)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MobileVRInterface", "set_oversample")
+	methodBind := methodBindMobileVRInterfaceSetOversample.Get()
 
 	// Call the parent method.
 	// void
@@ -298,10 +325,40 @@ func (o *MobileVRInterface) SetOversample(oversample gdnative.Real) {
 
 }
 
+// DisplayToLens will return the value of the "display_to_lens" property.
+func (o *MobileVRInterface) DisplayToLens() gdnative.Real {
+	return o.GetDisplayToLens()
+}
+
+// DisplayWidth will return the value of the "display_width" property.
+func (o *MobileVRInterface) DisplayWidth() gdnative.Real {
+	return o.GetDisplayWidth()
+}
+
+// Iod will return the value of the "iod" property.
+func (o *MobileVRInterface) Iod() gdnative.Real {
+	return o.GetIod()
+}
+
+// K1 will return the value of the "k1" property.
+func (o *MobileVRInterface) K1() gdnative.Real {
+	return o.GetK1()
+}
+
+// K2 will return the value of the "k2" property.
+func (o *MobileVRInterface) K2() gdnative.Real {
+	return o.GetK2()
+}
+
+// Oversample will return the value of the "oversample" property.
+func (o *MobileVRInterface) Oversample() gdnative.Real {
+	return o.GetOversample()
+}
+
 // MobileVRInterfaceImplementer is an interface that implements the methods
 // of the MobileVRInterface class.
 type MobileVRInterfaceImplementer interface {
-	ARVRInterfaceImplementer
+	arvr.ARVRInterfaceImplementer
 	GetDisplayToLens() gdnative.Real
 	GetDisplayWidth() gdnative.Real
 	GetIod() gdnative.Real
@@ -314,4 +371,10 @@ type MobileVRInterfaceImplementer interface {
 	SetK1(k gdnative.Real)
 	SetK2(k gdnative.Real)
 	SetOversample(oversample gdnative.Real)
+	DisplayToLens() gdnative.Real
+	DisplayWidth() gdnative.Real
+	Iod() gdnative.Real
+	K1() gdnative.Real
+	K2() gdnative.Real
+	Oversample() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMutexFromPointer(ptr gdnative.Pointer) Mutex {
-func new_MutexFromPointer(ptr gdnative.Pointer) Mutex {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Mutex{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A synchronization Mutex. Element used to synchronize multiple [Thread]s. Basically a binary [Semaphore]. Guarantees that only one thread can ever acquire this lock at a time. Can be used to protect a critical section. Be careful to avoid deadlocks.
 */
 type Mutex struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorMutex is the class constructor of the Mutex class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMutex = gdnative.NewLazyClassConstructor("_Mutex")
+
+// NewMutex will create a new instance of the Mutex class.
+// Mutex is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewMutex() *Mutex {
+	obj := &Mutex{}
+	obj.SetBaseObject(classConstructorMutex.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Mutex) BaseClass() string {
 	return "_Mutex"
 }
 
+// Method binds of the Mutex class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMutexLock    = gdnative.NewLazyMethodBind("_Mutex", "lock")
+	methodBindMutexTryLock = gdnative.NewLazyMethodBind("_Mutex", "try_lock")
+	methodBindMutexUnlock  = gdnative.NewLazyMethodBind("_Mutex", "unlock")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Mutex) Lock() {
 	//log.Println("Calling _Mutex.Lock()")
@@ -45,7 +62,7 @@ func (o *Mutex) Lock() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Mutex", "lock")
+	methodBind := methodBindMutexLock.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +72,8 @@ func (o *Mutex) Lock() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Error
+	        Undocumented
+		Args: [], Returns: enum.Error
 */
 func (o *Mutex) TryLock() gdnative.Error {
 	//log.Println("Calling _Mutex.TryLock()")
@@ -65,7 +82,7 @@ func (o *Mutex) TryLock() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Mutex", "try_lock")
+	methodBind := methodBindMutexTryLock.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -78,8 +95,8 @@ func (o *Mutex) TryLock() gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Mutex) Unlock() {
 	//log.Println("Calling _Mutex.Unlock()")
@@ -88,7 +105,7 @@ func (o *Mutex) Unlock() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Mutex", "unlock")
+	methodBind := methodBindMutexUnlock.Get()
 
 	// Call the parent method.
 	// void
@@ -100,7 +117,8 @@ func (o *Mutex) Unlock() {
 // MutexImplementer is an interface that implements the methods
 // of the Mutex class.
 type MutexImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	Lock()
+	TryLock() gdnative.Error
 	Unlock()
 }
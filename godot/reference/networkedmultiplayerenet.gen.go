@@ -0,0 +1,271 @@
+package reference
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// NetworkedMultiplayerENetCompressionMode is an enum for CompressionMode values.
+type NetworkedMultiplayerENetCompressionMode int
+
+const (
+	NetworkedMultiplayerENetCompressFastlz     NetworkedMultiplayerENetCompressionMode = 2
+	NetworkedMultiplayerENetCompressNone       NetworkedMultiplayerENetCompressionMode = 0
+	NetworkedMultiplayerENetCompressRangeCoder NetworkedMultiplayerENetCompressionMode = 1
+	NetworkedMultiplayerENetCompressZlib       NetworkedMultiplayerENetCompressionMode = 3
+	NetworkedMultiplayerENetCompressZstd       NetworkedMultiplayerENetCompressionMode = 4
+)
+
+// String will return the Godot name of the NetworkedMultiplayerENetCompressionMode value.
+func (e NetworkedMultiplayerENetCompressionMode) String() string {
+	switch e {
+	case NetworkedMultiplayerENetCompressFastlz:
+		return "COMPRESS_FASTLZ"
+	case NetworkedMultiplayerENetCompressNone:
+		return "COMPRESS_NONE"
+	case NetworkedMultiplayerENetCompressRangeCoder:
+		return "COMPRESS_RANGE_CODER"
+	case NetworkedMultiplayerENetCompressZlib:
+		return "COMPRESS_ZLIB"
+	case NetworkedMultiplayerENetCompressZstd:
+		return "COMPRESS_ZSTD"
+	}
+	return fmt.Sprintf("NetworkedMultiplayerENetCompressionMode(%d)", int(e))
+}
+
+// ParseNetworkedMultiplayerENetCompressionMode will return the NetworkedMultiplayerENetCompressionMode value with the given Godot name.
+func ParseNetworkedMultiplayerENetCompressionMode(name string) (NetworkedMultiplayerENetCompressionMode, error) {
+	switch name {
+	case "COMPRESS_FASTLZ":
+		return NetworkedMultiplayerENetCompressFastlz, nil
+	case "COMPRESS_NONE":
+		return NetworkedMultiplayerENetCompressNone, nil
+	case "COMPRESS_RANGE_CODER":
+		return NetworkedMultiplayerENetCompressRangeCoder, nil
+	case "COMPRESS_ZLIB":
+		return NetworkedMultiplayerENetCompressZlib, nil
+	case "COMPRESS_ZSTD":
+		return NetworkedMultiplayerENetCompressZstd, nil
+	}
+	return 0, fmt.Errorf("invalid NetworkedMultiplayerENetCompressionMode value %q", name)
+}
+
+/*
+Undocumented
+*/
+type NetworkedMultiplayerENet struct {
+	godot.NetworkedMultiplayerPeer
+	owner gdnative.Object
+}
+
+// classConstructorNetworkedMultiplayerENet is the class constructor of the NetworkedMultiplayerENet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNetworkedMultiplayerENet = gdnative.NewLazyClassConstructor("NetworkedMultiplayerENet")
+
+// NewNetworkedMultiplayerENet will create a new instance of the NetworkedMultiplayerENet class.
+// NetworkedMultiplayerENet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewNetworkedMultiplayerENet() *NetworkedMultiplayerENet {
+	obj := &NetworkedMultiplayerENet{}
+	obj.SetBaseObject(classConstructorNetworkedMultiplayerENet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *NetworkedMultiplayerENet) BaseClass() string {
+	return "NetworkedMultiplayerENet"
+}
+
+// Method binds of the NetworkedMultiplayerENet class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNetworkedMultiplayerENetCloseConnection    = gdnative.NewLazyMethodBind("NetworkedMultiplayerENet", "close_connection")
+	methodBindNetworkedMultiplayerENetCreateClient       = gdnative.NewLazyMethodBind("NetworkedMultiplayerENet", "create_client")
+	methodBindNetworkedMultiplayerENetCreateServer       = gdnative.NewLazyMethodBind("NetworkedMultiplayerENet", "create_server")
+	methodBindNetworkedMultiplayerENetGetCompressionMode = gdnative.NewLazyMethodBind("NetworkedMultiplayerENet", "get_compression_mode")
+	methodBindNetworkedMultiplayerENetSetBindIp          = gdnative.NewLazyMethodBind("NetworkedMultiplayerENet", "set_bind_ip")
+	methodBindNetworkedMultiplayerENetSetCompressionMode = gdnative.NewLazyMethodBind("NetworkedMultiplayerENet", "set_compression_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *NetworkedMultiplayerENet) CloseConnection() {
+	//log.Println("Calling NetworkedMultiplayerENet.CloseConnection()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNetworkedMultiplayerENetCloseConnection.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false ip String} { false port int} {0 true in_bandwidth int} {0 true out_bandwidth int}], Returns: enum.Error
+*/
+func (o *NetworkedMultiplayerENet) CreateClient(ip gdnative.String, port gdnative.Int, inBandwidth gdnative.Int, outBandwidth gdnative.Int) gdnative.Error {
+	//log.Println("Calling NetworkedMultiplayerENet.CreateClient()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromString(ip)
+	ptrArguments[1] = gdnative.NewPointerFromInt(port)
+	ptrArguments[2] = gdnative.NewPointerFromInt(inBandwidth)
+	ptrArguments[3] = gdnative.NewPointerFromInt(outBandwidth)
+
+	// Get the method bind
+	methodBind := methodBindNetworkedMultiplayerENetCreateClient.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false port int} {32 true max_clients int} {0 true in_bandwidth int} {0 true out_bandwidth int}], Returns: enum.Error
+*/
+func (o *NetworkedMultiplayerENet) CreateServer(port gdnative.Int, maxClients gdnative.Int, inBandwidth gdnative.Int, outBandwidth gdnative.Int) gdnative.Error {
+	//log.Println("Calling NetworkedMultiplayerENet.CreateServer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromInt(port)
+	ptrArguments[1] = gdnative.NewPointerFromInt(maxClients)
+	ptrArguments[2] = gdnative.NewPointerFromInt(inBandwidth)
+	ptrArguments[3] = gdnative.NewPointerFromInt(outBandwidth)
+
+	// Get the method bind
+	methodBind := methodBindNetworkedMultiplayerENetCreateServer.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.NetworkedMultiplayerENet::CompressionMode
+*/
+func (o *NetworkedMultiplayerENet) GetCompressionMode() NetworkedMultiplayerENetCompressionMode {
+	//log.Println("Calling NetworkedMultiplayerENet.GetCompressionMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNetworkedMultiplayerENetGetCompressionMode.Get()
+
+	// Call the parent method.
+	// enum.NetworkedMultiplayerENet::CompressionMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return NetworkedMultiplayerENetCompressionMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false ip String}], Returns: void
+*/
+func (o *NetworkedMultiplayerENet) SetBindIp(ip gdnative.String) {
+	//log.Println("Calling NetworkedMultiplayerENet.SetBindIp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(ip)
+
+	// Get the method bind
+	methodBind := methodBindNetworkedMultiplayerENetSetBindIp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.NetworkedMultiplayerENet::CompressionMode}], Returns: void
+*/
+func (o *NetworkedMultiplayerENet) SetCompressionMode(mode NetworkedMultiplayerENetCompressionMode) {
+	//log.Println("Calling NetworkedMultiplayerENet.SetCompressionMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindNetworkedMultiplayerENetSetCompressionMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// CreateClientWithDefaults will call CreateClient using the default values for: in_bandwidth, out_bandwidth.
+func (o *NetworkedMultiplayerENet) CreateClientWithDefaults(ip gdnative.String, port gdnative.Int) gdnative.Error {
+	return o.CreateClient(ip, port, 0, 0)
+}
+
+// CreateServerWithDefaults will call CreateServer using the default values for: max_clients, in_bandwidth, out_bandwidth.
+func (o *NetworkedMultiplayerENet) CreateServerWithDefaults(port gdnative.Int) gdnative.Error {
+	return o.CreateServer(port, 32, 0, 0)
+}
+
+// CompressionMode will return the value of the "compression_mode" property.
+func (o *NetworkedMultiplayerENet) CompressionMode() NetworkedMultiplayerENetCompressionMode {
+	return o.GetCompressionMode()
+}
+
+// NetworkedMultiplayerENetImplementer is an interface that implements the methods
+// of the NetworkedMultiplayerENet class.
+type NetworkedMultiplayerENetImplementer interface {
+	godot.NetworkedMultiplayerPeerImplementer
+	CloseConnection()
+	CreateClient(ip gdnative.String, port gdnative.Int, inBandwidth gdnative.Int, outBandwidth gdnative.Int) gdnative.Error
+	CreateServer(port gdnative.Int, maxClients gdnative.Int, inBandwidth gdnative.Int, outBandwidth gdnative.Int) gdnative.Error
+	GetCompressionMode() NetworkedMultiplayerENetCompressionMode
+	SetBindIp(ip gdnative.String)
+	SetCompressionMode(mode NetworkedMultiplayerENetCompressionMode)
+	CreateClientWithDefaults(ip gdnative.String, port gdnative.Int) gdnative.Error
+	CreateServerWithDefaults(port gdnative.Int) gdnative.Error
+	CompressionMode() NetworkedMultiplayerENetCompressionMode
+}
@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPackedDataContainerRefFromPointer(ptr gdnative.Pointer) PackedDataContainerRef {
-func newPackedDataContainerRefFromPointer(ptr gdnative.Pointer) PackedDataContainerRef {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PackedDataContainerRef{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PackedDataContainerRef struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
@@ -34,9 +25,19 @@ func (o *PackedDataContainerRef) BaseClass() string {
 	return "PackedDataContainerRef"
 }
 
+// Method binds of the PackedDataContainerRef class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPackedDataContainerRefX_IsDictionary = gdnative.NewLazyMethodBind("PackedDataContainerRef", "_is_dictionary")
+	methodBindPackedDataContainerRefX_IterGet      = gdnative.NewLazyMethodBind("PackedDataContainerRef", "_iter_get")
+	methodBindPackedDataContainerRefX_IterInit     = gdnative.NewLazyMethodBind("PackedDataContainerRef", "_iter_init")
+	methodBindPackedDataContainerRefX_IterNext     = gdnative.NewLazyMethodBind("PackedDataContainerRef", "_iter_next")
+	methodBindPackedDataContainerRefSize           = gdnative.NewLazyMethodBind("PackedDataContainerRef", "size")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PackedDataContainerRef) X_IsDictionary() gdnative.Bool {
 	//log.Println("Calling PackedDataContainerRef.X_IsDictionary()")
@@ -45,7 +46,7 @@ func (o *PackedDataContainerRef) X_IsDictionary() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainerRef", "_is_dictionary")
+	methodBind := methodBindPackedDataContainerRefX_IsDictionary.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +59,8 @@ func (o *PackedDataContainerRef) X_IsDictionary() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Variant}], Returns: Variant
+	        Undocumented
+		Args: [{ false arg0 Variant}], Returns: Variant
 */
 func (o *PackedDataContainerRef) X_IterGet(arg0 gdnative.Variant) gdnative.Variant {
 	//log.Println("Calling PackedDataContainerRef.X_IterGet()")
@@ -69,7 +70,7 @@ func (o *PackedDataContainerRef) X_IterGet(arg0 gdnative.Variant) gdnative.Varia
 	ptrArguments[0] = gdnative.NewPointerFromVariant(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainerRef", "_iter_get")
+	methodBind := methodBindPackedDataContainerRefX_IterGet.Get()
 
 	// Call the parent method.
 	// Variant
@@ -82,8 +83,8 @@ func (o *PackedDataContainerRef) X_IterGet(arg0 gdnative.Variant) gdnative.Varia
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: Variant
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: Variant
 */
 func (o *PackedDataContainerRef) X_IterInit(arg0 gdnative.Array) gdnative.Variant {
 	//log.Println("Calling PackedDataContainerRef.X_IterInit()")
@@ -93,7 +94,7 @@ func (o *PackedDataContainerRef) X_IterInit(arg0 gdnative.Array) gdnative.Varian
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainerRef", "_iter_init")
+	methodBind := methodBindPackedDataContainerRefX_IterInit.Get()
 
 	// Call the parent method.
 	// Variant
@@ -106,8 +107,8 @@ func (o *PackedDataContainerRef) X_IterInit(arg0 gdnative.Array) gdnative.Varian
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: Variant
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: Variant
 */
 func (o *PackedDataContainerRef) X_IterNext(arg0 gdnative.Array) gdnative.Variant {
 	//log.Println("Calling PackedDataContainerRef.X_IterNext()")
@@ -117,7 +118,7 @@ func (o *PackedDataContainerRef) X_IterNext(arg0 gdnative.Array) gdnative.Varian
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainerRef", "_iter_next")
+	methodBind := methodBindPackedDataContainerRefX_IterNext.Get()
 
 	// Call the parent method.
 	// Variant
@@ -130,8 +131,7 @@ func (o *PackedDataContainerRef) X_IterNext(arg0 gdnative.Array) gdnative.Varian
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *PackedDataContainerRef) Size() gdnative.Int {
 	//log.Println("Calling PackedDataContainerRef.Size()")
@@ -140,7 +140,7 @@ func (o *PackedDataContainerRef) Size() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainerRef", "size")
+	methodBind := methodBindPackedDataContainerRefSize.Get()
 
 	// Call the parent method.
 	// int
@@ -155,7 +155,7 @@ func (o *PackedDataContainerRef) Size() gdnative.Int {
 // PackedDataContainerRefImplementer is an interface that implements the methods
 // of the PackedDataContainerRef class.
 type PackedDataContainerRefImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	X_IsDictionary() gdnative.Bool
 	X_IterGet(arg0 gdnative.Variant) gdnative.Variant
 	X_IterInit(arg0 gdnative.Array) gdnative.Variant
@@ -0,0 +1,230 @@
+package reference
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/streampeer"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+PacketStreamPeer provides a wrapper for working using packets over a stream. This allows for using packet based code with StreamPeers. PacketPeerStream implements a custom protocol over the StreamPeer, so the user should not read or write to the wrapped StreamPeer directly.
+*/
+type PacketPeerStream struct {
+	godot.PacketPeer
+	owner gdnative.Object
+}
+
+// classConstructorPacketPeerStream is the class constructor of the PacketPeerStream class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPacketPeerStream = gdnative.NewLazyClassConstructor("PacketPeerStream")
+
+// NewPacketPeerStream will create a new instance of the PacketPeerStream class.
+// PacketPeerStream is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPacketPeerStream() *PacketPeerStream {
+	obj := &PacketPeerStream{}
+	obj.SetBaseObject(classConstructorPacketPeerStream.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *PacketPeerStream) BaseClass() string {
+	return "PacketPeerStream"
+}
+
+// Method binds of the PacketPeerStream class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPacketPeerStreamGetInputBufferMaxSize  = gdnative.NewLazyMethodBind("PacketPeerStream", "get_input_buffer_max_size")
+	methodBindPacketPeerStreamGetOutputBufferMaxSize = gdnative.NewLazyMethodBind("PacketPeerStream", "get_output_buffer_max_size")
+	methodBindPacketPeerStreamGetStreamPeer          = gdnative.NewLazyMethodBind("PacketPeerStream", "get_stream_peer")
+	methodBindPacketPeerStreamSetInputBufferMaxSize  = gdnative.NewLazyMethodBind("PacketPeerStream", "set_input_buffer_max_size")
+	methodBindPacketPeerStreamSetOutputBufferMaxSize = gdnative.NewLazyMethodBind("PacketPeerStream", "set_output_buffer_max_size")
+	methodBindPacketPeerStreamSetStreamPeer          = gdnative.NewLazyMethodBind("PacketPeerStream", "set_stream_peer")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *PacketPeerStream) GetInputBufferMaxSize() gdnative.Int {
+	//log.Println("Calling PacketPeerStream.GetInputBufferMaxSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPacketPeerStreamGetInputBufferMaxSize.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *PacketPeerStream) GetOutputBufferMaxSize() gdnative.Int {
+	//log.Println("Calling PacketPeerStream.GetOutputBufferMaxSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPacketPeerStreamGetOutputBufferMaxSize.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: StreamPeer
+*/
+func (o *PacketPeerStream) GetStreamPeer() streampeer.StreamPeerImplementer {
+	//log.Println("Calling PacketPeerStream.GetStreamPeer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPacketPeerStreamGetStreamPeer.Get()
+
+	// Call the parent method.
+	// StreamPeer
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := streampeer.StreamPeer{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(streampeer.StreamPeerImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false max_size_bytes int}], Returns: void
+*/
+func (o *PacketPeerStream) SetInputBufferMaxSize(maxSizeBytes gdnative.Int) {
+	//log.Println("Calling PacketPeerStream.SetInputBufferMaxSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(maxSizeBytes)
+
+	// Get the method bind
+	methodBind := methodBindPacketPeerStreamSetInputBufferMaxSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false max_size_bytes int}], Returns: void
+*/
+func (o *PacketPeerStream) SetOutputBufferMaxSize(maxSizeBytes gdnative.Int) {
+	//log.Println("Calling PacketPeerStream.SetOutputBufferMaxSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(maxSizeBytes)
+
+	// Get the method bind
+	methodBind := methodBindPacketPeerStreamSetOutputBufferMaxSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false peer StreamPeer}], Returns: void
+*/
+func (o *PacketPeerStream) SetStreamPeer(peer streampeer.StreamPeerImplementer) {
+	//log.Println("Calling PacketPeerStream.SetStreamPeer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(peer))
+
+	// Get the method bind
+	methodBind := methodBindPacketPeerStreamSetStreamPeer.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// InputBufferMaxSize will return the value of the "input_buffer_max_size" property.
+func (o *PacketPeerStream) InputBufferMaxSize() gdnative.Int {
+	return o.GetInputBufferMaxSize()
+}
+
+// OutputBufferMaxSize will return the value of the "output_buffer_max_size" property.
+func (o *PacketPeerStream) OutputBufferMaxSize() gdnative.Int {
+	return o.GetOutputBufferMaxSize()
+}
+
+// StreamPeer will return the value of the "stream_peer" property.
+func (o *PacketPeerStream) StreamPeer() streampeer.StreamPeerImplementer {
+	return o.GetStreamPeer()
+}
+
+// PacketPeerStreamImplementer is an interface that implements the methods
+// of the PacketPeerStream class.
+type PacketPeerStreamImplementer interface {
+	godot.PacketPeerImplementer
+	GetInputBufferMaxSize() gdnative.Int
+	GetOutputBufferMaxSize() gdnative.Int
+	GetStreamPeer() streampeer.StreamPeerImplementer
+	SetInputBufferMaxSize(maxSizeBytes gdnative.Int)
+	SetOutputBufferMaxSize(maxSizeBytes gdnative.Int)
+	SetStreamPeer(peer streampeer.StreamPeerImplementer)
+	InputBufferMaxSize() gdnative.Int
+	OutputBufferMaxSize() gdnative.Int
+	StreamPeer() streampeer.StreamPeerImplementer
+}
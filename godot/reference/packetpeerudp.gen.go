@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,50 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPacketPeerUDPFromPointer(ptr gdnative.Pointer) PacketPeerUDP {
-func newPacketPeerUDPFromPointer(ptr gdnative.Pointer) PacketPeerUDP {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PacketPeerUDP{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 UDP packet peer. Can be used to send raw UDP packets as well as [Variant]s.
 */
 type PacketPeerUDP struct {
-	PacketPeer
+	godot.PacketPeer
 	owner gdnative.Object
 }
 
+// classConstructorPacketPeerUDP is the class constructor of the PacketPeerUDP class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPacketPeerUDP = gdnative.NewLazyClassConstructor("PacketPeerUDP")
+
+// NewPacketPeerUDP will create a new instance of the PacketPeerUDP class.
+// PacketPeerUDP is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPacketPeerUDP() *PacketPeerUDP {
+	obj := &PacketPeerUDP{}
+	obj.SetBaseObject(classConstructorPacketPeerUDP.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PacketPeerUDP) BaseClass() string {
 	return "PacketPeerUDP"
 }
 
+// Method binds of the PacketPeerUDP class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPacketPeerUDPClose          = gdnative.NewLazyMethodBind("PacketPeerUDP", "close")
+	methodBindPacketPeerUDPGetPacketIp    = gdnative.NewLazyMethodBind("PacketPeerUDP", "get_packet_ip")
+	methodBindPacketPeerUDPGetPacketPort  = gdnative.NewLazyMethodBind("PacketPeerUDP", "get_packet_port")
+	methodBindPacketPeerUDPIsListening    = gdnative.NewLazyMethodBind("PacketPeerUDP", "is_listening")
+	methodBindPacketPeerUDPListen         = gdnative.NewLazyMethodBind("PacketPeerUDP", "listen")
+	methodBindPacketPeerUDPSetDestAddress = gdnative.NewLazyMethodBind("PacketPeerUDP", "set_dest_address")
+	methodBindPacketPeerUDPWait           = gdnative.NewLazyMethodBind("PacketPeerUDP", "wait")
+)
+
 /*
-        Close the UDP socket the [code]PacketPeerUDP[/code] is currently listening on.
-	Args: [], Returns: void
+	        Close the UDP socket the [code]PacketPeerUDP[/code] is currently listening on.
+		Args: [], Returns: void
 */
 func (o *PacketPeerUDP) Close() {
 	//log.Println("Calling PacketPeerUDP.Close()")
@@ -45,7 +66,7 @@ func (o *PacketPeerUDP) Close() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeerUDP", "close")
+	methodBind := methodBindPacketPeerUDPClose.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +76,8 @@ func (o *PacketPeerUDP) Close() {
 }
 
 /*
-        Return the IP of the remote peer that sent the last packet(that was received with [method get_packet] or [method get_var]).
-	Args: [], Returns: String
+	        Return the IP of the remote peer that sent the last packet(that was received with [method get_packet] or [method get_var]).
+		Args: [], Returns: String
 */
 func (o *PacketPeerUDP) GetPacketIp() gdnative.String {
 	//log.Println("Calling PacketPeerUDP.GetPacketIp()")
@@ -65,7 +86,7 @@ func (o *PacketPeerUDP) GetPacketIp() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeerUDP", "get_packet_ip")
+	methodBind := methodBindPacketPeerUDPGetPacketIp.Get()
 
 	// Call the parent method.
 	// String
@@ -78,8 +99,8 @@ func (o *PacketPeerUDP) GetPacketIp() gdnative.String {
 }
 
 /*
-        Return the port of the remote peer that sent the last packet(that was received with [method get_packet] or [method get_var]).
-	Args: [], Returns: int
+	        Return the port of the remote peer that sent the last packet(that was received with [method get_packet] or [method get_var]).
+		Args: [], Returns: int
 */
 func (o *PacketPeerUDP) GetPacketPort() gdnative.Int {
 	//log.Println("Calling PacketPeerUDP.GetPacketPort()")
@@ -88,7 +109,7 @@ func (o *PacketPeerUDP) GetPacketPort() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeerUDP", "get_packet_port")
+	methodBind := methodBindPacketPeerUDPGetPacketPort.Get()
 
 	// Call the parent method.
 	// int
@@ -101,8 +122,8 @@ func (o *PacketPeerUDP) GetPacketPort() gdnative.Int {
 }
 
 /*
-        Return whether this [code]PacketPeerUDP[/code] is listening.
-	Args: [], Returns: bool
+	        Return whether this [code]PacketPeerUDP[/code] is listening.
+		Args: [], Returns: bool
 */
 func (o *PacketPeerUDP) IsListening() gdnative.Bool {
 	//log.Println("Calling PacketPeerUDP.IsListening()")
@@ -111,7 +132,7 @@ func (o *PacketPeerUDP) IsListening() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeerUDP", "is_listening")
+	methodBind := methodBindPacketPeerUDPIsListening.Get()
 
 	// Call the parent method.
 	// bool
@@ -124,8 +145,8 @@ func (o *PacketPeerUDP) IsListening() gdnative.Bool {
 }
 
 /*
-        Make this [code]PacketPeerUDP[/code] listen on the "port" binding to "bind_address" with a buffer size "recv_buf_size". If "bind_address" is set as "*" (default), the peer will listen on all available addresses (both IPv4 and IPv6). If "bind_address" is set as "0.0.0.0" (for IPv4) or "::" (for IPv6), the peer will listen on all available addresses matching that IP type. If "bind_address" is set to any valid address (e.g. "192.168.1.101", "::1", etc), the peer will only listen on the interface with that addresses (or fail if no interface with the given address exists).
-	Args: [{ false port int} {* true bind_address String} {65536 true recv_buf_size int}], Returns: enum.Error
+	        Make this [code]PacketPeerUDP[/code] listen on the "port" binding to "bind_address" with a buffer size "recv_buf_size". If "bind_address" is set as "*" (default), the peer will listen on all available addresses (both IPv4 and IPv6). If "bind_address" is set as "0.0.0.0" (for IPv4) or "::" (for IPv6), the peer will listen on all available addresses matching that IP type. If "bind_address" is set to any valid address (e.g. "192.168.1.101", "::1", etc), the peer will only listen on the interface with that addresses (or fail if no interface with the given address exists).
+		Args: [{ false port int} {* true bind_address String} {65536 true recv_buf_size int}], Returns: enum.Error
 */
 func (o *PacketPeerUDP) Listen(port gdnative.Int, bindAddress gdnative.String, recvBufSize gdnative.Int) gdnative.Error {
 	//log.Println("Calling PacketPeerUDP.Listen()")
@@ -137,7 +158,7 @@ func (o *PacketPeerUDP) Listen(port gdnative.Int, bindAddress gdnative.String, r
 	ptrArguments[2] = gdnative.NewPointerFromInt(recvBufSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeerUDP", "listen")
+	methodBind := methodBindPacketPeerUDPListen.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -150,8 +171,8 @@ func (o *PacketPeerUDP) Listen(port gdnative.Int, bindAddress gdnative.String, r
 }
 
 /*
-        Set the destination address and port for sending packets and variables, a hostname will be resolved using if valid.
-	Args: [{ false host String} { false port int}], Returns: enum.Error
+	        Set the destination address and port for sending packets and variables, a hostname will be resolved using if valid.
+		Args: [{ false host String} { false port int}], Returns: enum.Error
 */
 func (o *PacketPeerUDP) SetDestAddress(host gdnative.String, port gdnative.Int) gdnative.Error {
 	//log.Println("Calling PacketPeerUDP.SetDestAddress()")
@@ -162,7 +183,7 @@ func (o *PacketPeerUDP) SetDestAddress(host gdnative.String, port gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(port)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeerUDP", "set_dest_address")
+	methodBind := methodBindPacketPeerUDPSetDestAddress.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -175,8 +196,8 @@ func (o *PacketPeerUDP) SetDestAddress(host gdnative.String, port gdnative.Int)
 }
 
 /*
-        Wait for a packet to arrive on the listening port, see [method listen].
-	Args: [], Returns: enum.Error
+	        Wait for a packet to arrive on the listening port, see [method listen].
+		Args: [], Returns: enum.Error
 */
 func (o *PacketPeerUDP) Wait() gdnative.Error {
 	//log.Println("Calling PacketPeerUDP.Wait()")
@@ -185,7 +206,7 @@ func (o *PacketPeerUDP) Wait() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PacketPeerUDP", "wait")
+	methodBind := methodBindPacketPeerUDPWait.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -197,12 +218,21 @@ func (o *PacketPeerUDP) Wait() gdnative.Error {
 	return gdnative.Error(ret)
 }
 
+// ListenWithDefaults will call Listen using the default values for: bind_address, recv_buf_size.
+func (o *PacketPeerUDP) ListenWithDefaults(port gdnative.Int) gdnative.Error {
+	return o.Listen(port, "*", 65536)
+}
+
 // PacketPeerUDPImplementer is an interface that implements the methods
 // of the PacketPeerUDP class.
 type PacketPeerUDPImplementer interface {
-	PacketPeerImplementer
+	godot.PacketPeerImplementer
 	Close()
 	GetPacketIp() gdnative.String
 	GetPacketPort() gdnative.Int
 	IsListening() gdnative.Bool
+	Listen(port gdnative.Int, bindAddress gdnative.String, recvBufSize gdnative.Int) gdnative.Error
+	SetDestAddress(host gdnative.String, port gdnative.Int) gdnative.Error
+	Wait() gdnative.Error
+	ListenWithDefaults(port gdnative.Int) gdnative.Error
 }
@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,44 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPCKPackerFromPointer(ptr gdnative.Pointer) PCKPacker {
-func newPCKPackerFromPointer(ptr gdnative.Pointer) PCKPacker {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PCKPacker{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PCKPacker struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorPCKPacker is the class constructor of the PCKPacker class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPCKPacker = gdnative.NewLazyClassConstructor("PCKPacker")
+
+// NewPCKPacker will create a new instance of the PCKPacker class.
+// PCKPacker is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPCKPacker() *PCKPacker {
+	obj := &PCKPacker{}
+	obj.SetBaseObject(classConstructorPCKPacker.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PCKPacker) BaseClass() string {
 	return "PCKPacker"
 }
 
-/*
+// Method binds of the PCKPacker class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPCKPackerAddFile  = gdnative.NewLazyMethodBind("PCKPacker", "add_file")
+	methodBindPCKPackerFlush    = gdnative.NewLazyMethodBind("PCKPacker", "flush")
+	methodBindPCKPackerPckStart = gdnative.NewLazyMethodBind("PCKPacker", "pck_start")
+)
 
-	Args: [{ false pck_path String} { false source_path String}], Returns: enum.Error
+/*
+Args: [{ false pck_path String} { false source_path String}], Returns: enum.Error
 */
 func (o *PCKPacker) AddFile(pckPath gdnative.String, sourcePath gdnative.String) gdnative.Error {
 	//log.Println("Calling PCKPacker.AddFile()")
@@ -47,7 +62,7 @@ func (o *PCKPacker) AddFile(pckPath gdnative.String, sourcePath gdnative.String)
 	ptrArguments[1] = gdnative.NewPointerFromString(sourcePath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PCKPacker", "add_file")
+	methodBind := methodBindPCKPackerAddFile.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -60,8 +75,7 @@ func (o *PCKPacker) AddFile(pckPath gdnative.String, sourcePath gdnative.String)
 }
 
 /*
-
-	Args: [{ false verbose bool}], Returns: enum.Error
+Args: [{ false verbose bool}], Returns: enum.Error
 */
 func (o *PCKPacker) Flush(verbose gdnative.Bool) gdnative.Error {
 	//log.Println("Calling PCKPacker.Flush()")
@@ -71,7 +85,7 @@ func (o *PCKPacker) Flush(verbose gdnative.Bool) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromBool(verbose)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PCKPacker", "flush")
+	methodBind := methodBindPCKPackerFlush.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -84,8 +98,7 @@ func (o *PCKPacker) Flush(verbose gdnative.Bool) gdnative.Error {
 }
 
 /*
-
-	Args: [{ false pck_name String} { false alignment int}], Returns: enum.Error
+Args: [{ false pck_name String} { false alignment int}], Returns: enum.Error
 */
 func (o *PCKPacker) PckStart(pckName gdnative.String, alignment gdnative.Int) gdnative.Error {
 	//log.Println("Calling PCKPacker.PckStart()")
@@ -96,7 +109,7 @@ func (o *PCKPacker) PckStart(pckName gdnative.String, alignment gdnative.Int) gd
 	ptrArguments[1] = gdnative.NewPointerFromInt(alignment)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PCKPacker", "pck_start")
+	methodBind := methodBindPCKPackerPckStart.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -111,5 +124,8 @@ func (o *PCKPacker) PckStart(pckName gdnative.String, alignment gdnative.Int) gd
 // PCKPackerImplementer is an interface that implements the methods
 // of the PCKPacker class.
 type PCKPackerImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
+	AddFile(pckPath gdnative.String, sourcePath gdnative.String) gdnative.Error
+	Flush(verbose gdnative.Bool) gdnative.Error
+	PckStart(pckName gdnative.String, alignment gdnative.Int) gdnative.Error
 }
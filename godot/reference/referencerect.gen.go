@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,28 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewReferenceRectFromPointer(ptr gdnative.Pointer) ReferenceRect {
-func newReferenceRectFromPointer(ptr gdnative.Pointer) ReferenceRect {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ReferenceRect{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Reference frame for GUI. It's just like an empty control, except a red box is displayed while editing around its size at all times.
 */
 type ReferenceRect struct {
-	Control
+	godot.Control
 	owner gdnative.Object
 }
 
+// classConstructorReferenceRect is the class constructor of the ReferenceRect class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorReferenceRect = gdnative.NewLazyClassConstructor("ReferenceRect")
+
+// NewReferenceRect will create a new instance of the ReferenceRect class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewReferenceRect() *ReferenceRect {
+	obj := &ReferenceRect{}
+	obj.SetBaseObject(classConstructorReferenceRect.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ReferenceRect) BaseClass() string {
 	return "ReferenceRect"
 }
@@ -37,5 +43,5 @@ func (o *ReferenceRect) BaseClass() string {
 // ReferenceRectImplementer is an interface that implements the methods
 // of the ReferenceRect class.
 type ReferenceRectImplementer interface {
-	ControlImplementer
+	godot.ControlImplementer
 }
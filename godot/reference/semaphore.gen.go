@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSemaphoreFromPointer(ptr gdnative.Pointer) Semaphore {
-func new_SemaphoreFromPointer(ptr gdnative.Pointer) Semaphore {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Semaphore{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A synchronization Semaphore. Element used to synchronize multiple [Thread]s. Initialized to zero on creation. Be careful to avoid deadlocks. For a binary version, see [Mutex].
 */
 type Semaphore struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorSemaphore is the class constructor of the Semaphore class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSemaphore = gdnative.NewLazyClassConstructor("_Semaphore")
+
+// NewSemaphore will create a new instance of the Semaphore class.
+// Semaphore is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSemaphore() *Semaphore {
+	obj := &Semaphore{}
+	obj.SetBaseObject(classConstructorSemaphore.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Semaphore) BaseClass() string {
 	return "_Semaphore"
 }
 
+// Method binds of the Semaphore class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSemaphorePost = gdnative.NewLazyMethodBind("_Semaphore", "post")
+	methodBindSemaphoreWait = gdnative.NewLazyMethodBind("_Semaphore", "wait")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.Error
+	        Undocumented
+		Args: [], Returns: enum.Error
 */
 func (o *Semaphore) Post() gdnative.Error {
 	//log.Println("Calling _Semaphore.Post()")
@@ -45,7 +61,7 @@ func (o *Semaphore) Post() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Semaphore", "post")
+	methodBind := methodBindSemaphorePost.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -58,8 +74,8 @@ func (o *Semaphore) Post() gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Error
+	        Undocumented
+		Args: [], Returns: enum.Error
 */
 func (o *Semaphore) Wait() gdnative.Error {
 	//log.Println("Calling _Semaphore.Wait()")
@@ -68,7 +84,7 @@ func (o *Semaphore) Wait() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Semaphore", "wait")
+	methodBind := methodBindSemaphoreWait.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -83,5 +99,7 @@ func (o *Semaphore) Wait() gdnative.Error {
 // SemaphoreImplementer is an interface that implements the methods
 // of the Semaphore class.
 type SemaphoreImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
+	Post() gdnative.Error
+	Wait() gdnative.Error
 }
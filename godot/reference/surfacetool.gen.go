@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,65 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSurfaceToolFromPointer(ptr gdnative.Pointer) SurfaceTool {
-func newSurfaceToolFromPointer(ptr gdnative.Pointer) SurfaceTool {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SurfaceTool{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 The [code]SurfaceTool[/code] is used to construct a [Mesh] by specifying vertex attributes individually. It can be used to construct a [Mesh] from script. All properties except index need to be added before a call to [method add_vertex]. For example adding vertex colors and UVs looks like [codeblock] var st = SurfaceTool.new() st.begin(Mesh.PRIMITIVE_TRIANGLES) st.add_color(Color(1, 0, 0)) st.add_uv(Vector2(0, 0)) st.add_vertex(Vector3(0, 0, 0)) [/codeblock] The [code]SurfaceTool[/code] now contains one vertex of a triangle which has a UV coordinate and a specified [Color]. If another vertex were added without calls to [method add_uv] or [method add_color] then the last values would be used. It is very important that vertex attributes are passed [b]before[/b] the call to [method add_vertex], failure to do this will result in an error when committing the vertex information to a mesh.
 */
 type SurfaceTool struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorSurfaceTool is the class constructor of the SurfaceTool class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSurfaceTool = gdnative.NewLazyClassConstructor("SurfaceTool")
+
+// NewSurfaceTool will create a new instance of the SurfaceTool class.
+// SurfaceTool is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSurfaceTool() *SurfaceTool {
+	obj := &SurfaceTool{}
+	obj.SetBaseObject(classConstructorSurfaceTool.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SurfaceTool) BaseClass() string {
 	return "SurfaceTool"
 }
 
+// Method binds of the SurfaceTool class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSurfaceToolAddBones         = gdnative.NewLazyMethodBind("SurfaceTool", "add_bones")
+	methodBindSurfaceToolAddColor         = gdnative.NewLazyMethodBind("SurfaceTool", "add_color")
+	methodBindSurfaceToolAddIndex         = gdnative.NewLazyMethodBind("SurfaceTool", "add_index")
+	methodBindSurfaceToolAddNormal        = gdnative.NewLazyMethodBind("SurfaceTool", "add_normal")
+	methodBindSurfaceToolAddSmoothGroup   = gdnative.NewLazyMethodBind("SurfaceTool", "add_smooth_group")
+	methodBindSurfaceToolAddTangent       = gdnative.NewLazyMethodBind("SurfaceTool", "add_tangent")
+	methodBindSurfaceToolAddToFormat      = gdnative.NewLazyMethodBind("SurfaceTool", "add_to_format")
+	methodBindSurfaceToolAddTriangleFan   = gdnative.NewLazyMethodBind("SurfaceTool", "add_triangle_fan")
+	methodBindSurfaceToolAddUv            = gdnative.NewLazyMethodBind("SurfaceTool", "add_uv")
+	methodBindSurfaceToolAddUv2           = gdnative.NewLazyMethodBind("SurfaceTool", "add_uv2")
+	methodBindSurfaceToolAddVertex        = gdnative.NewLazyMethodBind("SurfaceTool", "add_vertex")
+	methodBindSurfaceToolAddWeights       = gdnative.NewLazyMethodBind("SurfaceTool", "add_weights")
+	methodBindSurfaceToolAppendFrom       = gdnative.NewLazyMethodBind("SurfaceTool", "append_from")
+	methodBindSurfaceToolBegin            = gdnative.NewLazyMethodBind("SurfaceTool", "begin")
+	methodBindSurfaceToolClear            = gdnative.NewLazyMethodBind("SurfaceTool", "clear")
+	methodBindSurfaceToolCommit           = gdnative.NewLazyMethodBind("SurfaceTool", "commit")
+	methodBindSurfaceToolCreateFrom       = gdnative.NewLazyMethodBind("SurfaceTool", "create_from")
+	methodBindSurfaceToolDeindex          = gdnative.NewLazyMethodBind("SurfaceTool", "deindex")
+	methodBindSurfaceToolGenerateNormals  = gdnative.NewLazyMethodBind("SurfaceTool", "generate_normals")
+	methodBindSurfaceToolGenerateTangents = gdnative.NewLazyMethodBind("SurfaceTool", "generate_tangents")
+	methodBindSurfaceToolIndex            = gdnative.NewLazyMethodBind("SurfaceTool", "index")
+	methodBindSurfaceToolSetMaterial      = gdnative.NewLazyMethodBind("SurfaceTool", "set_material")
+)
+
 /*
-        Add an array of bones for the next Vertex to use.
-	Args: [{ false bones PoolIntArray}], Returns: void
+	        Add an array of bones for the next Vertex to use.
+		Args: [{ false bones PoolIntArray}], Returns: void
 */
 func (o *SurfaceTool) AddBones(bones gdnative.PoolIntArray) {
 	//log.Println("Calling SurfaceTool.AddBones()")
@@ -46,7 +82,7 @@ func (o *SurfaceTool) AddBones(bones gdnative.PoolIntArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolIntArray(bones)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_bones")
+	methodBind := methodBindSurfaceToolAddBones.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +92,8 @@ func (o *SurfaceTool) AddBones(bones gdnative.PoolIntArray) {
 }
 
 /*
-        Specify a [Color] for the next Vertex to use.
-	Args: [{ false color Color}], Returns: void
+	        Specify a [Color] for the next Vertex to use.
+		Args: [{ false color Color}], Returns: void
 */
 func (o *SurfaceTool) AddColor(color gdnative.Color) {
 	//log.Println("Calling SurfaceTool.AddColor()")
@@ -67,7 +103,7 @@ func (o *SurfaceTool) AddColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_color")
+	methodBind := methodBindSurfaceToolAddColor.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +113,8 @@ func (o *SurfaceTool) AddColor(color gdnative.Color) {
 }
 
 /*
-        Adds an index to index array if you are using indexed Vertices. Does not need to be called before adding Vertex.
-	Args: [{ false index int}], Returns: void
+	        Adds an index to index array if you are using indexed Vertices. Does not need to be called before adding Vertex.
+		Args: [{ false index int}], Returns: void
 */
 func (o *SurfaceTool) AddIndex(index gdnative.Int) {
 	//log.Println("Calling SurfaceTool.AddIndex()")
@@ -88,7 +124,7 @@ func (o *SurfaceTool) AddIndex(index gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_index")
+	methodBind := methodBindSurfaceToolAddIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -98,8 +134,8 @@ func (o *SurfaceTool) AddIndex(index gdnative.Int) {
 }
 
 /*
-        Specify a normal for the next Vertex to use.
-	Args: [{ false normal Vector3}], Returns: void
+	        Specify a normal for the next Vertex to use.
+		Args: [{ false normal Vector3}], Returns: void
 */
 func (o *SurfaceTool) AddNormal(normal gdnative.Vector3) {
 	//log.Println("Calling SurfaceTool.AddNormal()")
@@ -109,7 +145,7 @@ func (o *SurfaceTool) AddNormal(normal gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(normal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_normal")
+	methodBind := methodBindSurfaceToolAddNormal.Get()
 
 	// Call the parent method.
 	// void
@@ -119,8 +155,8 @@ func (o *SurfaceTool) AddNormal(normal gdnative.Vector3) {
 }
 
 /*
-        Specify whether current Vertex (if using only Vertex arrays) or current index (if also using index arrays) should utilize smooth normals for normal calculation.
-	Args: [{ false smooth bool}], Returns: void
+	        Specify whether current Vertex (if using only Vertex arrays) or current index (if also using index arrays) should utilize smooth normals for normal calculation.
+		Args: [{ false smooth bool}], Returns: void
 */
 func (o *SurfaceTool) AddSmoothGroup(smooth gdnative.Bool) {
 	//log.Println("Calling SurfaceTool.AddSmoothGroup()")
@@ -130,7 +166,7 @@ func (o *SurfaceTool) AddSmoothGroup(smooth gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(smooth)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_smooth_group")
+	methodBind := methodBindSurfaceToolAddSmoothGroup.Get()
 
 	// Call the parent method.
 	// void
@@ -140,8 +176,8 @@ func (o *SurfaceTool) AddSmoothGroup(smooth gdnative.Bool) {
 }
 
 /*
-        Specify a Tangent for the next Vertex to use.
-	Args: [{ false tangent Plane}], Returns: void
+	        Specify a Tangent for the next Vertex to use.
+		Args: [{ false tangent Plane}], Returns: void
 */
 func (o *SurfaceTool) AddTangent(tangent gdnative.Plane) {
 	//log.Println("Calling SurfaceTool.AddTangent()")
@@ -151,7 +187,7 @@ func (o *SurfaceTool) AddTangent(tangent gdnative.Plane) {
 	ptrArguments[0] = gdnative.NewPointerFromPlane(tangent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_tangent")
+	methodBind := methodBindSurfaceToolAddTangent.Get()
 
 	// Call the parent method.
 	// void
@@ -161,8 +197,7 @@ func (o *SurfaceTool) AddTangent(tangent gdnative.Plane) {
 }
 
 /*
-
-	Args: [{ false flags int}], Returns: void
+Args: [{ false flags int}], Returns: void
 */
 func (o *SurfaceTool) AddToFormat(flags gdnative.Int) {
 	//log.Println("Calling SurfaceTool.AddToFormat()")
@@ -172,7 +207,7 @@ func (o *SurfaceTool) AddToFormat(flags gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_to_format")
+	methodBind := methodBindSurfaceToolAddToFormat.Get()
 
 	// Call the parent method.
 	// void
@@ -182,8 +217,8 @@ func (o *SurfaceTool) AddToFormat(flags gdnative.Int) {
 }
 
 /*
-        Insert a triangle fan made of array data into [Mesh] being constructed.
-	Args: [{ false vertexes PoolVector3Array} {[] true uvs PoolVector2Array} {[PoolColorArray] true colors PoolColorArray} {[] true uv2s PoolVector2Array} {[] true normals PoolVector3Array} {[] true tangents Array}], Returns: void
+	        Insert a triangle fan made of array data into [Mesh] being constructed.
+		Args: [{ false vertexes PoolVector3Array} {[] true uvs PoolVector2Array} {[PoolColorArray] true colors PoolColorArray} {[] true uv2s PoolVector2Array} {[] true normals PoolVector3Array} {[] true tangents Array}], Returns: void
 */
 func (o *SurfaceTool) AddTriangleFan(vertexes gdnative.PoolVector3Array, uvs gdnative.PoolVector2Array, colors gdnative.PoolColorArray, uv2S gdnative.PoolVector2Array, normals gdnative.PoolVector3Array, tangents gdnative.Array) {
 	//log.Println("Calling SurfaceTool.AddTriangleFan()")
@@ -198,7 +233,7 @@ func (o *SurfaceTool) AddTriangleFan(vertexes gdnative.PoolVector3Array, uvs gdn
 	ptrArguments[5] = gdnative.NewPointerFromArray(tangents)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_triangle_fan")
+	methodBind := methodBindSurfaceToolAddTriangleFan.Get()
 
 	// Call the parent method.
 	// void
@@ -208,8 +243,8 @@ func (o *SurfaceTool) AddTriangleFan(vertexes gdnative.PoolVector3Array, uvs gdn
 }
 
 /*
-        Specify UV Coordinate for next Vertex to use.
-	Args: [{ false uv Vector2}], Returns: void
+	        Specify UV Coordinate for next Vertex to use.
+		Args: [{ false uv Vector2}], Returns: void
 */
 func (o *SurfaceTool) AddUv(uv gdnative.Vector2) {
 	//log.Println("Calling SurfaceTool.AddUv()")
@@ -219,7 +254,7 @@ func (o *SurfaceTool) AddUv(uv gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(uv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_uv")
+	methodBind := methodBindSurfaceToolAddUv.Get()
 
 	// Call the parent method.
 	// void
@@ -229,8 +264,8 @@ func (o *SurfaceTool) AddUv(uv gdnative.Vector2) {
 }
 
 /*
-        Specify an optional second set of UV coordinates for next Vertex to use.
-	Args: [{ false uv2 Vector2}], Returns: void
+	        Specify an optional second set of UV coordinates for next Vertex to use.
+		Args: [{ false uv2 Vector2}], Returns: void
 */
 func (o *SurfaceTool) AddUv2(uv2 gdnative.Vector2) {
 	//log.Println("Calling SurfaceTool.AddUv2()")
@@ -240,7 +275,7 @@ func (o *SurfaceTool) AddUv2(uv2 gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(uv2)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_uv2")
+	methodBind := methodBindSurfaceToolAddUv2.Get()
 
 	// Call the parent method.
 	// void
@@ -250,8 +285,8 @@ func (o *SurfaceTool) AddUv2(uv2 gdnative.Vector2) {
 }
 
 /*
-        Specify position of current Vertex. Should be called after specifying other vertex properties (e.g. Color, UV).
-	Args: [{ false vertex Vector3}], Returns: void
+	        Specify position of current Vertex. Should be called after specifying other vertex properties (e.g. Color, UV).
+		Args: [{ false vertex Vector3}], Returns: void
 */
 func (o *SurfaceTool) AddVertex(vertex gdnative.Vector3) {
 	//log.Println("Calling SurfaceTool.AddVertex()")
@@ -261,7 +296,7 @@ func (o *SurfaceTool) AddVertex(vertex gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(vertex)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_vertex")
+	methodBind := methodBindSurfaceToolAddVertex.Get()
 
 	// Call the parent method.
 	// void
@@ -271,8 +306,8 @@ func (o *SurfaceTool) AddVertex(vertex gdnative.Vector3) {
 }
 
 /*
-        Specify weight value for next Vertex to use.
-	Args: [{ false weights PoolRealArray}], Returns: void
+	        Specify weight value for next Vertex to use.
+		Args: [{ false weights PoolRealArray}], Returns: void
 */
 func (o *SurfaceTool) AddWeights(weights gdnative.PoolRealArray) {
 	//log.Println("Calling SurfaceTool.AddWeights()")
@@ -282,7 +317,7 @@ func (o *SurfaceTool) AddWeights(weights gdnative.PoolRealArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolRealArray(weights)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "add_weights")
+	methodBind := methodBindSurfaceToolAddWeights.Get()
 
 	// Call the parent method.
 	// void
@@ -292,20 +327,19 @@ func (o *SurfaceTool) AddWeights(weights gdnative.PoolRealArray) {
 }
 
 /*
-
-	Args: [{ false existing Mesh} { false surface int} { false transform Transform}], Returns: void
+Args: [{ false existing Mesh} { false surface int} { false transform Transform}], Returns: void
 */
-func (o *SurfaceTool) AppendFrom(existing MeshImplementer, surface gdnative.Int, transform gdnative.Transform) {
+func (o *SurfaceTool) AppendFrom(existing godot.MeshImplementer, surface gdnative.Int, transform gdnative.Transform) {
 	//log.Println("Calling SurfaceTool.AppendFrom()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(existing.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(existing))
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 	ptrArguments[2] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "append_from")
+	methodBind := methodBindSurfaceToolAppendFrom.Get()
 
 	// Call the parent method.
 	// void
@@ -315,8 +349,8 @@ func (o *SurfaceTool) AppendFrom(existing MeshImplementer, surface gdnative.Int,
 }
 
 /*
-        Called before adding any Vertices. Takes the primitive type as an argument (e.g. Mesh.PRIMITIVE_TRIANGLES).
-	Args: [{ false primitive int}], Returns: void
+	        Called before adding any Vertices. Takes the primitive type as an argument (e.g. Mesh.PRIMITIVE_TRIANGLES).
+		Args: [{ false primitive int}], Returns: void
 */
 func (o *SurfaceTool) Begin(primitive gdnative.Int) {
 	//log.Println("Calling SurfaceTool.Begin()")
@@ -326,7 +360,7 @@ func (o *SurfaceTool) Begin(primitive gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(primitive)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "begin")
+	methodBind := methodBindSurfaceToolBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -336,8 +370,8 @@ func (o *SurfaceTool) Begin(primitive gdnative.Int) {
 }
 
 /*
-        Clear all information passed into the surface tool so far.
-	Args: [], Returns: void
+	        Clear all information passed into the surface tool so far.
+		Args: [], Returns: void
 */
 func (o *SurfaceTool) Clear() {
 	//log.Println("Calling SurfaceTool.Clear()")
@@ -346,7 +380,7 @@ func (o *SurfaceTool) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "clear")
+	methodBind := methodBindSurfaceToolClear.Get()
 
 	// Call the parent method.
 	// void
@@ -356,19 +390,19 @@ func (o *SurfaceTool) Clear() {
 }
 
 /*
-        Returns a constructed [ArrayMesh] from current information passed in. If an existing [ArrayMesh] is passed in as an argument, will add an extra surface to the existing [ArrayMesh].
-	Args: [{Null true existing ArrayMesh} {97792 true flags int}], Returns: ArrayMesh
+	        Returns a constructed [ArrayMesh] from current information passed in. If an existing [ArrayMesh] is passed in as an argument, will add an extra surface to the existing [ArrayMesh].
+		Args: [{Null true existing ArrayMesh} {97792 true flags int}], Returns: ArrayMesh
 */
-func (o *SurfaceTool) Commit(existing ArrayMeshImplementer, flags gdnative.Int) ArrayMeshImplementer {
+func (o *SurfaceTool) Commit(existing godot.ArrayMeshImplementer, flags gdnative.Int) godot.ArrayMeshImplementer {
 	//log.Println("Calling SurfaceTool.Commit()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(existing.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(existing))
 	ptrArguments[1] = gdnative.NewPointerFromInt(flags)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "commit")
+	methodBind := methodBindSurfaceToolCommit.Get()
 
 	// Call the parent method.
 	// ArrayMesh
@@ -376,38 +410,35 @@ func (o *SurfaceTool) Commit(existing ArrayMeshImplementer, flags gdnative.Int)
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newArrayMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ArrayMeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ArrayMesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ArrayMeshImplementer)
+	ret := godot.ArrayMesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ArrayMeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false existing Mesh} { false surface int}], Returns: void
+Args: [{ false existing Mesh} { false surface int}], Returns: void
 */
-func (o *SurfaceTool) CreateFrom(existing MeshImplementer, surface gdnative.Int) {
+func (o *SurfaceTool) CreateFrom(existing godot.MeshImplementer, surface gdnative.Int) {
 	//log.Println("Calling SurfaceTool.CreateFrom()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(existing.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(existing))
 	ptrArguments[1] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "create_from")
+	methodBind := methodBindSurfaceToolCreateFrom.Get()
 
 	// Call the parent method.
 	// void
@@ -417,8 +448,8 @@ func (o *SurfaceTool) CreateFrom(existing MeshImplementer, surface gdnative.Int)
 }
 
 /*
-        Removes index array by expanding Vertex array.
-	Args: [], Returns: void
+	        Removes index array by expanding Vertex array.
+		Args: [], Returns: void
 */
 func (o *SurfaceTool) Deindex() {
 	//log.Println("Calling SurfaceTool.Deindex()")
@@ -427,7 +458,7 @@ func (o *SurfaceTool) Deindex() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "deindex")
+	methodBind := methodBindSurfaceToolDeindex.Get()
 
 	// Call the parent method.
 	// void
@@ -437,8 +468,8 @@ func (o *SurfaceTool) Deindex() {
 }
 
 /*
-        Generates normals from Vertices so you do not have to do it manually.
-	Args: [], Returns: void
+	        Generates normals from Vertices so you do not have to do it manually.
+		Args: [], Returns: void
 */
 func (o *SurfaceTool) GenerateNormals() {
 	//log.Println("Calling SurfaceTool.GenerateNormals()")
@@ -447,7 +478,7 @@ func (o *SurfaceTool) GenerateNormals() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "generate_normals")
+	methodBind := methodBindSurfaceToolGenerateNormals.Get()
 
 	// Call the parent method.
 	// void
@@ -457,8 +488,7 @@ func (o *SurfaceTool) GenerateNormals() {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *SurfaceTool) GenerateTangents() {
 	//log.Println("Calling SurfaceTool.GenerateTangents()")
@@ -467,7 +497,7 @@ func (o *SurfaceTool) GenerateTangents() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "generate_tangents")
+	methodBind := methodBindSurfaceToolGenerateTangents.Get()
 
 	// Call the parent method.
 	// void
@@ -477,8 +507,8 @@ func (o *SurfaceTool) GenerateTangents() {
 }
 
 /*
-        Shrinks Vertex array by creating an index array. Avoids reusing Vertices.
-	Args: [], Returns: void
+	        Shrinks Vertex array by creating an index array. Avoids reusing Vertices.
+		Args: [], Returns: void
 */
 func (o *SurfaceTool) Index() {
 	//log.Println("Calling SurfaceTool.Index()")
@@ -487,7 +517,7 @@ func (o *SurfaceTool) Index() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "index")
+	methodBind := methodBindSurfaceToolIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -497,18 +527,18 @@ func (o *SurfaceTool) Index() {
 }
 
 /*
-        Sets [Material] to be used by the [Mesh] you are constructing.
-	Args: [{ false material Material}], Returns: void
+	        Sets [Material] to be used by the [Mesh] you are constructing.
+		Args: [{ false material Material}], Returns: void
 */
-func (o *SurfaceTool) SetMaterial(material MaterialImplementer) {
+func (o *SurfaceTool) SetMaterial(material godot.MaterialImplementer) {
 	//log.Println("Calling SurfaceTool.SetMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SurfaceTool", "set_material")
+	methodBind := methodBindSurfaceToolSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -517,10 +547,30 @@ func (o *SurfaceTool) SetMaterial(material MaterialImplementer) {
 
 }
 
+// AddTriangleFanWithDefaults will call AddTriangleFan using the default values for: uvs, colors, uv2s, normals, tangents.
+func (o *SurfaceTool) AddTriangleFanWithDefaults(vertexes gdnative.PoolVector3Array) {
+	default0 := gdnative.NewPoolVector2Array()
+	defer default0.Destroy()
+	default1 := gdnative.NewPoolColorArray()
+	defer default1.Destroy()
+	default2 := gdnative.NewPoolVector2Array()
+	defer default2.Destroy()
+	default3 := gdnative.NewPoolVector3Array()
+	defer default3.Destroy()
+	default4 := gdnative.NewArray()
+	defer default4.Destroy()
+	o.AddTriangleFan(vertexes, default0, default1, default2, default3, default4)
+}
+
+// CommitWithDefaults will call Commit using the default values for: existing, flags.
+func (o *SurfaceTool) CommitWithDefaults() godot.ArrayMeshImplementer {
+	return o.Commit(nil, 97792)
+}
+
 // SurfaceToolImplementer is an interface that implements the methods
 // of the SurfaceTool class.
 type SurfaceToolImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	AddBones(bones gdnative.PoolIntArray)
 	AddColor(color gdnative.Color)
 	AddIndex(index gdnative.Int)
@@ -533,14 +583,16 @@ type SurfaceToolImplementer interface {
 	AddUv2(uv2 gdnative.Vector2)
 	AddVertex(vertex gdnative.Vector3)
 	AddWeights(weights gdnative.PoolRealArray)
-	AppendFrom(existing MeshImplementer, surface gdnative.Int, transform gdnative.Transform)
+	AppendFrom(existing godot.MeshImplementer, surface gdnative.Int, transform gdnative.Transform)
 	Begin(primitive gdnative.Int)
 	Clear()
-	Commit(existing ArrayMeshImplementer, flags gdnative.Int) ArrayMeshImplementer
-	CreateFrom(existing MeshImplementer, surface gdnative.Int)
+	Commit(existing godot.ArrayMeshImplementer, flags gdnative.Int) godot.ArrayMeshImplementer
+	CreateFrom(existing godot.MeshImplementer, surface gdnative.Int)
 	Deindex()
 	GenerateNormals()
 	GenerateTangents()
 	Index()
-	SetMaterial(material MaterialImplementer)
+	SetMaterial(material godot.MaterialImplementer)
+	AddTriangleFanWithDefaults(vertexes gdnative.PoolVector3Array)
+	CommitWithDefaults() godot.ArrayMeshImplementer
 }
@@ -0,0 +1,173 @@
+package reference
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/streampeer"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+TCP Server class. Listens to connections on a port and returns a [StreamPeerTCP] when got a connection.
+*/
+type TCP_Server struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorTCP_Server is the class constructor of the TCP_Server class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTCP_Server = gdnative.NewLazyClassConstructor("TCP_Server")
+
+// NewTCP_Server will create a new instance of the TCP_Server class.
+// TCP_Server is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewTCP_Server() *TCP_Server {
+	obj := &TCP_Server{}
+	obj.SetBaseObject(classConstructorTCP_Server.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *TCP_Server) BaseClass() string {
+	return "TCP_Server"
+}
+
+// Method binds of the TCP_Server class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTCP_ServerIsConnectionAvailable = gdnative.NewLazyMethodBind("TCP_Server", "is_connection_available")
+	methodBindTCP_ServerListen                = gdnative.NewLazyMethodBind("TCP_Server", "listen")
+	methodBindTCP_ServerStop                  = gdnative.NewLazyMethodBind("TCP_Server", "stop")
+	methodBindTCP_ServerTakeConnection        = gdnative.NewLazyMethodBind("TCP_Server", "take_connection")
+)
+
+/*
+	        Return true if a connection is available for taking.
+		Args: [], Returns: bool
+*/
+func (o *TCP_Server) IsConnectionAvailable() gdnative.Bool {
+	//log.Println("Calling TCP_Server.IsConnectionAvailable()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTCP_ServerIsConnectionAvailable.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Listen on the "port" binding to "bind_address". If "bind_address" is set as "*" (default), the server will listen on all available addresses (both IPv4 and IPv6). If "bind_address" is set as "0.0.0.0" (for IPv4) or "::" (for IPv6), the server will listen on all available addresses matching that IP type. If "bind_address" is set to any valid address (e.g. "192.168.1.101", "::1", etc), the server will only listen on the interface with that addresses (or fail if no interface with the given address exists).
+		Args: [{ false port int} {* true bind_address String}], Returns: enum.Error
+*/
+func (o *TCP_Server) Listen(port gdnative.Int, bindAddress gdnative.String) gdnative.Error {
+	//log.Println("Calling TCP_Server.Listen()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(port)
+	ptrArguments[1] = gdnative.NewPointerFromString(bindAddress)
+
+	// Get the method bind
+	methodBind := methodBindTCP_ServerListen.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Stop listening.
+		Args: [], Returns: void
+*/
+func (o *TCP_Server) Stop() {
+	//log.Println("Calling TCP_Server.Stop()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTCP_ServerStop.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        If a connection is available, return a StreamPeerTCP with the connection/
+		Args: [], Returns: StreamPeerTCP
+*/
+func (o *TCP_Server) TakeConnection() streampeer.StreamPeerTCPImplementer {
+	//log.Println("Calling TCP_Server.TakeConnection()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTCP_ServerTakeConnection.Get()
+
+	// Call the parent method.
+	// StreamPeerTCP
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := streampeer.StreamPeerTCP{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(streampeer.StreamPeerTCPImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+// ListenWithDefaults will call Listen using the default values for: bind_address.
+func (o *TCP_Server) ListenWithDefaults(port gdnative.Int) gdnative.Error {
+	return o.Listen(port, "*")
+}
+
+// TCP_ServerImplementer is an interface that implements the methods
+// of the TCP_Server class.
+type TCP_ServerImplementer interface {
+	godot.ReferenceImplementer
+	IsConnectionAvailable() gdnative.Bool
+	Listen(port gdnative.Int, bindAddress gdnative.String) gdnative.Error
+	Stop()
+	TakeConnection() streampeer.StreamPeerTCPImplementer
+	ListenWithDefaults(port gdnative.Int) gdnative.Error
+}
@@ -1,7 +1,10 @@
-package godot
+package reference
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,30 +25,73 @@ const (
 	_ThreadPriorityNormal _ThreadPriority = 1
 )
 
-//func NewThreadFromPointer(ptr gdnative.Pointer) Thread {
-func new_ThreadFromPointer(ptr gdnative.Pointer) Thread {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Thread{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the _ThreadPriority value.
+func (e _ThreadPriority) String() string {
+	switch e {
+	case _ThreadPriorityHigh:
+		return "PRIORITY_HIGH"
+	case _ThreadPriorityLow:
+		return "PRIORITY_LOW"
+	case _ThreadPriorityNormal:
+		return "PRIORITY_NORMAL"
+	}
+	return fmt.Sprintf("_ThreadPriority(%d)", int(e))
+}
 
-	return obj
+// Parse_ThreadPriority will return the _ThreadPriority value with the given Godot name.
+func Parse_ThreadPriority(name string) (_ThreadPriority, error) {
+	switch name {
+	case "PRIORITY_HIGH":
+		return _ThreadPriorityHigh, nil
+	case "PRIORITY_LOW":
+		return _ThreadPriorityLow, nil
+	case "PRIORITY_NORMAL":
+		return _ThreadPriorityNormal, nil
+	}
+	return 0, fmt.Errorf("invalid _ThreadPriority value %q", name)
 }
 
 /*
 A unit of execution in a process. Can run methods on [Object]s simultaneously. The use of synchronization via [Mutex], [Semaphore] is advised if working with shared objects.
 */
 type Thread struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorThread is the class constructor of the Thread class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorThread = gdnative.NewLazyClassConstructor("_Thread")
+
+// NewThread will create a new instance of the Thread class.
+// Thread is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewThread() *Thread {
+	obj := &Thread{}
+	obj.SetBaseObject(classConstructorThread.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Thread) BaseClass() string {
 	return "_Thread"
 }
 
+// Method binds of the Thread class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindThreadGetId        = gdnative.NewLazyMethodBind("_Thread", "get_id")
+	methodBindThreadIsActive     = gdnative.NewLazyMethodBind("_Thread", "is_active")
+	methodBindThreadStart        = gdnative.NewLazyMethodBind("_Thread", "start")
+	methodBindThreadWaitToFinish = gdnative.NewLazyMethodBind("_Thread", "wait_to_finish")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Thread) GetId() gdnative.String {
 	//log.Println("Calling _Thread.GetId()")
@@ -54,7 +100,7 @@ func (o *Thread) GetId() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Thread", "get_id")
+	methodBind := methodBindThreadGetId.Get()
 
 	// Call the parent method.
 	// String
@@ -67,8 +113,8 @@ func (o *Thread) GetId() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Thread) IsActive() gdnative.Bool {
 	//log.Println("Calling _Thread.IsActive()")
@@ -77,7 +123,7 @@ func (o *Thread) IsActive() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Thread", "is_active")
+	methodBind := methodBindThreadIsActive.Get()
 
 	// Call the parent method.
 	// bool
@@ -90,21 +136,21 @@ func (o *Thread) IsActive() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false instance Object} { false method String} {Null true userdata Variant} {1 true priority int}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false instance Object} { false method String} {Null true userdata Variant} {1 true priority enum._Thread::Priority}], Returns: enum.Error
 */
-func (o *Thread) Start(instance ObjectImplementer, method gdnative.String, userdata gdnative.Variant, priority gdnative.Int) gdnative.Error {
+func (o *Thread) Start(instance godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant, priority _ThreadPriority) gdnative.Error {
 	//log.Println("Calling _Thread.Start()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromObject(instance.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(instance))
 	ptrArguments[1] = gdnative.NewPointerFromString(method)
 	ptrArguments[2] = gdnative.NewPointerFromVariant(userdata)
-	ptrArguments[3] = gdnative.NewPointerFromInt(priority)
+	ptrArguments[3] = gdnative.NewPointerFromInt(gdnative.Int(priority))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Thread", "start")
+	methodBind := methodBindThreadStart.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -117,8 +163,8 @@ func (o *Thread) Start(instance ObjectImplementer, method gdnative.String, userd
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Variant
+	        Undocumented
+		Args: [], Returns: Variant
 */
 func (o *Thread) WaitToFinish() gdnative.Variant {
 	//log.Println("Calling _Thread.WaitToFinish()")
@@ -127,7 +173,7 @@ func (o *Thread) WaitToFinish() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("_Thread", "wait_to_finish")
+	methodBind := methodBindThreadWaitToFinish.Get()
 
 	// Call the parent method.
 	// Variant
@@ -139,11 +185,20 @@ func (o *Thread) WaitToFinish() gdnative.Variant {
 	return ret
 }
 
+// StartWithDefaults will call Start using the default values for: userdata, priority.
+func (o *Thread) StartWithDefaults(instance godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	return o.Start(instance, method, default0, 1)
+}
+
 // ThreadImplementer is an interface that implements the methods
 // of the Thread class.
 type ThreadImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetId() gdnative.String
 	IsActive() gdnative.Bool
+	Start(instance godot.ObjectImplementer, method gdnative.String, userdata gdnative.Variant, priority _ThreadPriority) gdnative.Error
 	WaitToFinish() gdnative.Variant
+	StartWithDefaults(instance godot.ObjectImplementer, method gdnative.String) gdnative.Error
 }
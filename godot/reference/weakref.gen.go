@@ -1,7 +1,8 @@
-package godot
+package reference
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,44 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewWeakRefFromPointer(ptr gdnative.Pointer) WeakRef {
-func newWeakRefFromPointer(ptr gdnative.Pointer) WeakRef {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := WeakRef{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A weakref can hold a [Reference], without contributing to the reference counter. A weakref can be created from an [Object] using [method @GDScript.weakref]. If this object is not a reference, weakref still works, however, it does not have any effect on the object. Weakrefs are useful in cases where multiple classes have variables that refer to each other. Without weakrefs, using these classes could lead to memory leaks, since both references keep each other from being released. Making part of the variables a weakref can prevent this cyclic dependency, and allows the references to be released.
 */
 type WeakRef struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorWeakRef is the class constructor of the WeakRef class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorWeakRef = gdnative.NewLazyClassConstructor("WeakRef")
+
+// NewWeakRef will create a new instance of the WeakRef class.
+// WeakRef is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewWeakRef() *WeakRef {
+	obj := &WeakRef{}
+	obj.SetBaseObject(classConstructorWeakRef.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *WeakRef) BaseClass() string {
 	return "WeakRef"
 }
 
+// Method binds of the WeakRef class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindWeakRefGetRef = gdnative.NewLazyMethodBind("WeakRef", "get_ref")
+)
+
 /*
-        Returns the [Object] this weakref is referring to.
-	Args: [], Returns: Variant
+	        Returns the [Object] this weakref is referring to.
+		Args: [], Returns: Variant
 */
 func (o *WeakRef) GetRef() gdnative.Variant {
 	//log.Println("Calling WeakRef.GetRef()")
@@ -45,7 +60,7 @@ func (o *WeakRef) GetRef() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("WeakRef", "get_ref")
+	methodBind := methodBindWeakRefGetRef.Get()
 
 	// Call the parent method.
 	// Variant
@@ -60,6 +75,6 @@ func (o *WeakRef) GetRef() gdnative.Variant {
 // WeakRefImplementer is an interface that implements the methods
 // of the WeakRef class.
 type WeakRefImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetRef() gdnative.Variant
 }
@@ -1,7 +1,10 @@
-package godot
+package reference
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -26,30 +29,102 @@ const (
 	XMLParserNodeUnknown    XMLParserNodeType = 6
 )
 
-//func NewXMLParserFromPointer(ptr gdnative.Pointer) XMLParser {
-func newXMLParserFromPointer(ptr gdnative.Pointer) XMLParser {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := XMLParser{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the XMLParserNodeType value.
+func (e XMLParserNodeType) String() string {
+	switch e {
+	case XMLParserNodeCdata:
+		return "NODE_CDATA"
+	case XMLParserNodeComment:
+		return "NODE_COMMENT"
+	case XMLParserNodeElement:
+		return "NODE_ELEMENT"
+	case XMLParserNodeElementEnd:
+		return "NODE_ELEMENT_END"
+	case XMLParserNodeNone:
+		return "NODE_NONE"
+	case XMLParserNodeText:
+		return "NODE_TEXT"
+	case XMLParserNodeUnknown:
+		return "NODE_UNKNOWN"
+	}
+	return fmt.Sprintf("XMLParserNodeType(%d)", int(e))
+}
 
-	return obj
+// ParseXMLParserNodeType will return the XMLParserNodeType value with the given Godot name.
+func ParseXMLParserNodeType(name string) (XMLParserNodeType, error) {
+	switch name {
+	case "NODE_CDATA":
+		return XMLParserNodeCdata, nil
+	case "NODE_COMMENT":
+		return XMLParserNodeComment, nil
+	case "NODE_ELEMENT":
+		return XMLParserNodeElement, nil
+	case "NODE_ELEMENT_END":
+		return XMLParserNodeElementEnd, nil
+	case "NODE_NONE":
+		return XMLParserNodeNone, nil
+	case "NODE_TEXT":
+		return XMLParserNodeText, nil
+	case "NODE_UNKNOWN":
+		return XMLParserNodeUnknown, nil
+	}
+	return 0, fmt.Errorf("invalid XMLParserNodeType value %q", name)
 }
 
 /*
 This class can serve as base to make custom XML parsers. Since XML is a very flexible standard, this interface is low level so it can be applied to any possible schema.
 */
 type XMLParser struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorXMLParser is the class constructor of the XMLParser class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorXMLParser = gdnative.NewLazyClassConstructor("XMLParser")
+
+// NewXMLParser will create a new instance of the XMLParser class.
+// XMLParser is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewXMLParser() *XMLParser {
+	obj := &XMLParser{}
+	obj.SetBaseObject(classConstructorXMLParser.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *XMLParser) BaseClass() string {
 	return "XMLParser"
 }
 
+// Method binds of the XMLParser class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindXMLParserGetAttributeCount          = gdnative.NewLazyMethodBind("XMLParser", "get_attribute_count")
+	methodBindXMLParserGetAttributeName           = gdnative.NewLazyMethodBind("XMLParser", "get_attribute_name")
+	methodBindXMLParserGetAttributeValue          = gdnative.NewLazyMethodBind("XMLParser", "get_attribute_value")
+	methodBindXMLParserGetCurrentLine             = gdnative.NewLazyMethodBind("XMLParser", "get_current_line")
+	methodBindXMLParserGetNamedAttributeValue     = gdnative.NewLazyMethodBind("XMLParser", "get_named_attribute_value")
+	methodBindXMLParserGetNamedAttributeValueSafe = gdnative.NewLazyMethodBind("XMLParser", "get_named_attribute_value_safe")
+	methodBindXMLParserGetNodeData                = gdnative.NewLazyMethodBind("XMLParser", "get_node_data")
+	methodBindXMLParserGetNodeName                = gdnative.NewLazyMethodBind("XMLParser", "get_node_name")
+	methodBindXMLParserGetNodeOffset              = gdnative.NewLazyMethodBind("XMLParser", "get_node_offset")
+	methodBindXMLParserGetNodeType                = gdnative.NewLazyMethodBind("XMLParser", "get_node_type")
+	methodBindXMLParserHasAttribute               = gdnative.NewLazyMethodBind("XMLParser", "has_attribute")
+	methodBindXMLParserIsEmpty                    = gdnative.NewLazyMethodBind("XMLParser", "is_empty")
+	methodBindXMLParserOpen                       = gdnative.NewLazyMethodBind("XMLParser", "open")
+	methodBindXMLParserOpenBuffer                 = gdnative.NewLazyMethodBind("XMLParser", "open_buffer")
+	methodBindXMLParserRead                       = gdnative.NewLazyMethodBind("XMLParser", "read")
+	methodBindXMLParserSeek                       = gdnative.NewLazyMethodBind("XMLParser", "seek")
+	methodBindXMLParserSkipSection                = gdnative.NewLazyMethodBind("XMLParser", "skip_section")
+)
+
 /*
-        Get the amount of attributes in the current element.
-	Args: [], Returns: int
+	        Get the amount of attributes in the current element.
+		Args: [], Returns: int
 */
 func (o *XMLParser) GetAttributeCount() gdnative.Int {
 	//log.Println("Calling XMLParser.GetAttributeCount()")
@@ -58,7 +133,7 @@ func (o *XMLParser) GetAttributeCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_attribute_count")
+	methodBind := methodBindXMLParserGetAttributeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -71,8 +146,8 @@ func (o *XMLParser) GetAttributeCount() gdnative.Int {
 }
 
 /*
-        Get the name of the attribute specified by the index in [code]idx[/code] argument.
-	Args: [{ false idx int}], Returns: String
+	        Get the name of the attribute specified by the index in [code]idx[/code] argument.
+		Args: [{ false idx int}], Returns: String
 */
 func (o *XMLParser) GetAttributeName(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling XMLParser.GetAttributeName()")
@@ -82,7 +157,7 @@ func (o *XMLParser) GetAttributeName(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_attribute_name")
+	methodBind := methodBindXMLParserGetAttributeName.Get()
 
 	// Call the parent method.
 	// String
@@ -95,8 +170,8 @@ func (o *XMLParser) GetAttributeName(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Get the value of the attribute specified by the index in [code]idx[/code] argument.
-	Args: [{ false idx int}], Returns: String
+	        Get the value of the attribute specified by the index in [code]idx[/code] argument.
+		Args: [{ false idx int}], Returns: String
 */
 func (o *XMLParser) GetAttributeValue(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling XMLParser.GetAttributeValue()")
@@ -106,7 +181,7 @@ func (o *XMLParser) GetAttributeValue(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_attribute_value")
+	methodBind := methodBindXMLParserGetAttributeValue.Get()
 
 	// Call the parent method.
 	// String
@@ -119,8 +194,8 @@ func (o *XMLParser) GetAttributeValue(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Get the current line in the parsed file (currently not implemented).
-	Args: [], Returns: int
+	        Get the current line in the parsed file (currently not implemented).
+		Args: [], Returns: int
 */
 func (o *XMLParser) GetCurrentLine() gdnative.Int {
 	//log.Println("Calling XMLParser.GetCurrentLine()")
@@ -129,7 +204,7 @@ func (o *XMLParser) GetCurrentLine() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_current_line")
+	methodBind := methodBindXMLParserGetCurrentLine.Get()
 
 	// Call the parent method.
 	// int
@@ -142,8 +217,8 @@ func (o *XMLParser) GetCurrentLine() gdnative.Int {
 }
 
 /*
-        Get the value of a certain attribute of the current element by name. This will raise an error if the element has no such attribute.
-	Args: [{ false name String}], Returns: String
+	        Get the value of a certain attribute of the current element by name. This will raise an error if the element has no such attribute.
+		Args: [{ false name String}], Returns: String
 */
 func (o *XMLParser) GetNamedAttributeValue(name gdnative.String) gdnative.String {
 	//log.Println("Calling XMLParser.GetNamedAttributeValue()")
@@ -153,7 +228,7 @@ func (o *XMLParser) GetNamedAttributeValue(name gdnative.String) gdnative.String
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_named_attribute_value")
+	methodBind := methodBindXMLParserGetNamedAttributeValue.Get()
 
 	// Call the parent method.
 	// String
@@ -166,8 +241,8 @@ func (o *XMLParser) GetNamedAttributeValue(name gdnative.String) gdnative.String
 }
 
 /*
-        Get the value of a certain attribute of the current element by name. This will return an empty [String] if the attribute is not found.
-	Args: [{ false name String}], Returns: String
+	        Get the value of a certain attribute of the current element by name. This will return an empty [String] if the attribute is not found.
+		Args: [{ false name String}], Returns: String
 */
 func (o *XMLParser) GetNamedAttributeValueSafe(name gdnative.String) gdnative.String {
 	//log.Println("Calling XMLParser.GetNamedAttributeValueSafe()")
@@ -177,7 +252,7 @@ func (o *XMLParser) GetNamedAttributeValueSafe(name gdnative.String) gdnative.St
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_named_attribute_value_safe")
+	methodBind := methodBindXMLParserGetNamedAttributeValueSafe.Get()
 
 	// Call the parent method.
 	// String
@@ -190,8 +265,8 @@ func (o *XMLParser) GetNamedAttributeValueSafe(name gdnative.String) gdnative.St
 }
 
 /*
-        Get the contents of a text node. This will raise an error in any other type of node.
-	Args: [], Returns: String
+	        Get the contents of a text node. This will raise an error in any other type of node.
+		Args: [], Returns: String
 */
 func (o *XMLParser) GetNodeData() gdnative.String {
 	//log.Println("Calling XMLParser.GetNodeData()")
@@ -200,7 +275,7 @@ func (o *XMLParser) GetNodeData() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_node_data")
+	methodBind := methodBindXMLParserGetNodeData.Get()
 
 	// Call the parent method.
 	// String
@@ -213,8 +288,8 @@ func (o *XMLParser) GetNodeData() gdnative.String {
 }
 
 /*
-        Get the name of the current element node. This will raise an error if the current node type is not [code]NODE_ELEMENT[/code] nor [code]NODE_ELEMENT_END[/code]
-	Args: [], Returns: String
+	        Get the name of the current element node. This will raise an error if the current node type is not [code]NODE_ELEMENT[/code] nor [code]NODE_ELEMENT_END[/code]
+		Args: [], Returns: String
 */
 func (o *XMLParser) GetNodeName() gdnative.String {
 	//log.Println("Calling XMLParser.GetNodeName()")
@@ -223,7 +298,7 @@ func (o *XMLParser) GetNodeName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_node_name")
+	methodBind := methodBindXMLParserGetNodeName.Get()
 
 	// Call the parent method.
 	// String
@@ -236,8 +311,8 @@ func (o *XMLParser) GetNodeName() gdnative.String {
 }
 
 /*
-        Get the byte offset of the current node since the beginning of the file or buffer.
-	Args: [], Returns: int
+	        Get the byte offset of the current node since the beginning of the file or buffer.
+		Args: [], Returns: int
 */
 func (o *XMLParser) GetNodeOffset() gdnative.Int {
 	//log.Println("Calling XMLParser.GetNodeOffset()")
@@ -246,7 +321,7 @@ func (o *XMLParser) GetNodeOffset() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_node_offset")
+	methodBind := methodBindXMLParserGetNodeOffset.Get()
 
 	// Call the parent method.
 	// int
@@ -259,8 +334,8 @@ func (o *XMLParser) GetNodeOffset() gdnative.Int {
 }
 
 /*
-        Get the type of the current node. Compare with [code]NODE_*[/code] constants.
-	Args: [], Returns: enum.XMLParser::NodeType
+	        Get the type of the current node. Compare with [code]NODE_*[/code] constants.
+		Args: [], Returns: enum.XMLParser::NodeType
 */
 func (o *XMLParser) GetNodeType() XMLParserNodeType {
 	//log.Println("Calling XMLParser.GetNodeType()")
@@ -269,7 +344,7 @@ func (o *XMLParser) GetNodeType() XMLParserNodeType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "get_node_type")
+	methodBind := methodBindXMLParserGetNodeType.Get()
 
 	// Call the parent method.
 	// enum.XMLParser::NodeType
@@ -282,8 +357,8 @@ func (o *XMLParser) GetNodeType() XMLParserNodeType {
 }
 
 /*
-        Check whether or not the current element has a certain attribute.
-	Args: [{ false name String}], Returns: bool
+	        Check whether or not the current element has a certain attribute.
+		Args: [{ false name String}], Returns: bool
 */
 func (o *XMLParser) HasAttribute(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling XMLParser.HasAttribute()")
@@ -293,7 +368,7 @@ func (o *XMLParser) HasAttribute(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "has_attribute")
+	methodBind := methodBindXMLParserHasAttribute.Get()
 
 	// Call the parent method.
 	// bool
@@ -306,8 +381,8 @@ func (o *XMLParser) HasAttribute(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Check whether the current element is empty (this only works for completely empty tags, e.g. <element \>).
-	Args: [], Returns: bool
+	        Check whether the current element is empty (this only works for completely empty tags, e.g. <element \>).
+		Args: [], Returns: bool
 */
 func (o *XMLParser) IsEmpty() gdnative.Bool {
 	//log.Println("Calling XMLParser.IsEmpty()")
@@ -316,7 +391,7 @@ func (o *XMLParser) IsEmpty() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "is_empty")
+	methodBind := methodBindXMLParserIsEmpty.Get()
 
 	// Call the parent method.
 	// bool
@@ -329,8 +404,8 @@ func (o *XMLParser) IsEmpty() gdnative.Bool {
 }
 
 /*
-        Open a XML file for parsing. This returns an error code.
-	Args: [{ false file String}], Returns: enum.Error
+	        Open a XML file for parsing. This returns an error code.
+		Args: [{ false file String}], Returns: enum.Error
 */
 func (o *XMLParser) Open(file gdnative.String) gdnative.Error {
 	//log.Println("Calling XMLParser.Open()")
@@ -340,7 +415,7 @@ func (o *XMLParser) Open(file gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "open")
+	methodBind := methodBindXMLParserOpen.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -353,8 +428,8 @@ func (o *XMLParser) Open(file gdnative.String) gdnative.Error {
 }
 
 /*
-        Open a XML raw buffer for parsing. This returns an error code.
-	Args: [{ false buffer PoolByteArray}], Returns: enum.Error
+	        Open a XML raw buffer for parsing. This returns an error code.
+		Args: [{ false buffer PoolByteArray}], Returns: enum.Error
 */
 func (o *XMLParser) OpenBuffer(buffer gdnative.PoolByteArray) gdnative.Error {
 	//log.Println("Calling XMLParser.OpenBuffer()")
@@ -364,7 +439,7 @@ func (o *XMLParser) OpenBuffer(buffer gdnative.PoolByteArray) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(buffer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "open_buffer")
+	methodBind := methodBindXMLParserOpenBuffer.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -377,8 +452,8 @@ func (o *XMLParser) OpenBuffer(buffer gdnative.PoolByteArray) gdnative.Error {
 }
 
 /*
-        Read the next node of the file. This returns an error code.
-	Args: [], Returns: enum.Error
+	        Read the next node of the file. This returns an error code.
+		Args: [], Returns: enum.Error
 */
 func (o *XMLParser) Read() gdnative.Error {
 	//log.Println("Calling XMLParser.Read()")
@@ -387,7 +462,7 @@ func (o *XMLParser) Read() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "read")
+	methodBind := methodBindXMLParserRead.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -400,8 +475,8 @@ func (o *XMLParser) Read() gdnative.Error {
 }
 
 /*
-        Move the buffer cursor to a certain offset (since the beginning) and read the next node there. This returns an error code.
-	Args: [{ false position int}], Returns: enum.Error
+	        Move the buffer cursor to a certain offset (since the beginning) and read the next node there. This returns an error code.
+		Args: [{ false position int}], Returns: enum.Error
 */
 func (o *XMLParser) Seek(position gdnative.Int) gdnative.Error {
 	//log.Println("Calling XMLParser.Seek()")
@@ -411,7 +486,7 @@ func (o *XMLParser) Seek(position gdnative.Int) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromInt(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "seek")
+	methodBind := methodBindXMLParserSeek.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -424,8 +499,8 @@ func (o *XMLParser) Seek(position gdnative.Int) gdnative.Error {
 }
 
 /*
-        Skips the current section. If the node contains other elements, they will be ignored and the cursor will go to the closing of the current element.
-	Args: [], Returns: void
+	        Skips the current section. If the node contains other elements, they will be ignored and the cursor will go to the closing of the current element.
+		Args: [], Returns: void
 */
 func (o *XMLParser) SkipSection() {
 	//log.Println("Calling XMLParser.SkipSection()")
@@ -434,7 +509,7 @@ func (o *XMLParser) SkipSection() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("XMLParser", "skip_section")
+	methodBind := methodBindXMLParserSkipSection.Get()
 
 	// Call the parent method.
 	// void
@@ -446,7 +521,7 @@ func (o *XMLParser) SkipSection() {
 // XMLParserImplementer is an interface that implements the methods
 // of the XMLParser class.
 type XMLParserImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetAttributeCount() gdnative.Int
 	GetAttributeName(idx gdnative.Int) gdnative.String
 	GetAttributeValue(idx gdnative.Int) gdnative.String
@@ -456,7 +531,12 @@ type XMLParserImplementer interface {
 	GetNodeData() gdnative.String
 	GetNodeName() gdnative.String
 	GetNodeOffset() gdnative.Int
+	GetNodeType() XMLParserNodeType
 	HasAttribute(name gdnative.String) gdnative.Bool
 	IsEmpty() gdnative.Bool
+	Open(file gdnative.String) gdnative.Error
+	OpenBuffer(buffer gdnative.PoolByteArray) gdnative.Error
+	Read() gdnative.Error
+	Seek(position gdnative.Int) gdnative.Error
 	SkipSection()
 }
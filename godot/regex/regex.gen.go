@@ -0,0 +1,319 @@
+package regex
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type RegEx struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorRegEx is the class constructor of the RegEx class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRegEx = gdnative.NewLazyClassConstructor("RegEx")
+
+// NewRegEx will create a new instance of the RegEx class.
+// RegEx is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewRegEx() *RegEx {
+	obj := &RegEx{}
+	obj.SetBaseObject(classConstructorRegEx.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *RegEx) BaseClass() string {
+	return "RegEx"
+}
+
+// Method binds of the RegEx class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRegExClear         = gdnative.NewLazyMethodBind("RegEx", "clear")
+	methodBindRegExCompile       = gdnative.NewLazyMethodBind("RegEx", "compile")
+	methodBindRegExGetGroupCount = gdnative.NewLazyMethodBind("RegEx", "get_group_count")
+	methodBindRegExGetNames      = gdnative.NewLazyMethodBind("RegEx", "get_names")
+	methodBindRegExGetPattern    = gdnative.NewLazyMethodBind("RegEx", "get_pattern")
+	methodBindRegExIsValid       = gdnative.NewLazyMethodBind("RegEx", "is_valid")
+	methodBindRegExSearch        = gdnative.NewLazyMethodBind("RegEx", "search")
+	methodBindRegExSearchAll     = gdnative.NewLazyMethodBind("RegEx", "search_all")
+	methodBindRegExSub           = gdnative.NewLazyMethodBind("RegEx", "sub")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *RegEx) Clear() {
+	//log.Println("Calling RegEx.Clear()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExClear.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false pattern String}], Returns: enum.Error
+*/
+func (o *RegEx) Compile(pattern gdnative.String) gdnative.Error {
+	//log.Println("Calling RegEx.Compile()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(pattern)
+
+	// Get the method bind
+	methodBind := methodBindRegExCompile.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *RegEx) GetGroupCount() gdnative.Int {
+	//log.Println("Calling RegEx.GetGroupCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExGetGroupCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *RegEx) GetNames() gdnative.Array {
+	//log.Println("Calling RegEx.GetNames()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExGetNames.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *RegEx) GetPattern() gdnative.String {
+	//log.Println("Calling RegEx.GetPattern()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExGetPattern.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RegEx) IsValid() gdnative.Bool {
+	//log.Println("Calling RegEx.IsValid()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExIsValid.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false subject String} {0 true offset int} {-1 true end int}], Returns: RegExMatch
+*/
+func (o *RegEx) Search(subject gdnative.String, offset gdnative.Int, end gdnative.Int) RegExMatchImplementer {
+	//log.Println("Calling RegEx.Search()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(subject)
+	ptrArguments[1] = gdnative.NewPointerFromInt(offset)
+	ptrArguments[2] = gdnative.NewPointerFromInt(end)
+
+	// Get the method bind
+	methodBind := methodBindRegExSearch.Get()
+
+	// Call the parent method.
+	// RegExMatch
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := RegExMatch{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(RegExMatchImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false subject String} {0 true offset int} {-1 true end int}], Returns: Array
+*/
+func (o *RegEx) SearchAll(subject gdnative.String, offset gdnative.Int, end gdnative.Int) gdnative.Array {
+	//log.Println("Calling RegEx.SearchAll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromString(subject)
+	ptrArguments[1] = gdnative.NewPointerFromInt(offset)
+	ptrArguments[2] = gdnative.NewPointerFromInt(end)
+
+	// Get the method bind
+	methodBind := methodBindRegExSearchAll.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false subject String} { false replacement String} {False true all bool} {0 true offset int} {-1 true end int}], Returns: String
+*/
+func (o *RegEx) Sub(subject gdnative.String, replacement gdnative.String, all gdnative.Bool, offset gdnative.Int, end gdnative.Int) gdnative.String {
+	//log.Println("Calling RegEx.Sub()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromString(subject)
+	ptrArguments[1] = gdnative.NewPointerFromString(replacement)
+	ptrArguments[2] = gdnative.NewPointerFromBool(all)
+	ptrArguments[3] = gdnative.NewPointerFromInt(offset)
+	ptrArguments[4] = gdnative.NewPointerFromInt(end)
+
+	// Get the method bind
+	methodBind := methodBindRegExSub.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+// SearchWithDefaults will call Search using the default values for: offset, end.
+func (o *RegEx) SearchWithDefaults(subject gdnative.String) RegExMatchImplementer {
+	return o.Search(subject, 0, -1)
+}
+
+// SearchAllWithDefaults will call SearchAll using the default values for: offset, end.
+func (o *RegEx) SearchAllWithDefaults(subject gdnative.String) gdnative.Array {
+	return o.SearchAll(subject, 0, -1)
+}
+
+// SubWithDefaults will call Sub using the default values for: all, offset, end.
+func (o *RegEx) SubWithDefaults(subject gdnative.String, replacement gdnative.String) gdnative.String {
+	return o.Sub(subject, replacement, false, 0, -1)
+}
+
+// RegExImplementer is an interface that implements the methods
+// of the RegEx class.
+type RegExImplementer interface {
+	godot.ReferenceImplementer
+	Clear()
+	Compile(pattern gdnative.String) gdnative.Error
+	GetGroupCount() gdnative.Int
+	GetNames() gdnative.Array
+	GetPattern() gdnative.String
+	IsValid() gdnative.Bool
+	Search(subject gdnative.String, offset gdnative.Int, end gdnative.Int) RegExMatchImplementer
+	SearchAll(subject gdnative.String, offset gdnative.Int, end gdnative.Int) gdnative.Array
+	Sub(subject gdnative.String, replacement gdnative.String, all gdnative.Bool, offset gdnative.Int, end gdnative.Int) gdnative.String
+	SearchWithDefaults(subject gdnative.String) RegExMatchImplementer
+	SearchAllWithDefaults(subject gdnative.String) gdnative.Array
+	SubWithDefaults(subject gdnative.String, replacement gdnative.String) gdnative.String
+}
@@ -0,0 +1,275 @@
+package regex
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type RegExMatch struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorRegExMatch is the class constructor of the RegExMatch class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRegExMatch = gdnative.NewLazyClassConstructor("RegExMatch")
+
+// NewRegExMatch will create a new instance of the RegExMatch class.
+// RegExMatch is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewRegExMatch() *RegExMatch {
+	obj := &RegExMatch{}
+	obj.SetBaseObject(classConstructorRegExMatch.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *RegExMatch) BaseClass() string {
+	return "RegExMatch"
+}
+
+// Method binds of the RegExMatch class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRegExMatchGetEnd        = gdnative.NewLazyMethodBind("RegExMatch", "get_end")
+	methodBindRegExMatchGetGroupCount = gdnative.NewLazyMethodBind("RegExMatch", "get_group_count")
+	methodBindRegExMatchGetNames      = gdnative.NewLazyMethodBind("RegExMatch", "get_names")
+	methodBindRegExMatchGetStart      = gdnative.NewLazyMethodBind("RegExMatch", "get_start")
+	methodBindRegExMatchGetString     = gdnative.NewLazyMethodBind("RegExMatch", "get_string")
+	methodBindRegExMatchGetStrings    = gdnative.NewLazyMethodBind("RegExMatch", "get_strings")
+	methodBindRegExMatchGetSubject    = gdnative.NewLazyMethodBind("RegExMatch", "get_subject")
+)
+
+/*
+	        Undocumented
+		Args: [{0 true name Variant}], Returns: int
+*/
+func (o *RegExMatch) GetEnd(name gdnative.Variant) gdnative.Int {
+	//log.Println("Calling RegExMatch.GetEnd()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVariant(name)
+
+	// Get the method bind
+	methodBind := methodBindRegExMatchGetEnd.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *RegExMatch) GetGroupCount() gdnative.Int {
+	//log.Println("Calling RegExMatch.GetGroupCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExMatchGetGroupCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Dictionary
+*/
+func (o *RegExMatch) GetNames() gdnative.Dictionary {
+	//log.Println("Calling RegExMatch.GetNames()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExMatchGetNames.Get()
+
+	// Call the parent method.
+	// Dictionary
+	retPtr := gdnative.NewEmptyDictionary()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewDictionaryFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{0 true name Variant}], Returns: int
+*/
+func (o *RegExMatch) GetStart(name gdnative.Variant) gdnative.Int {
+	//log.Println("Calling RegExMatch.GetStart()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVariant(name)
+
+	// Get the method bind
+	methodBind := methodBindRegExMatchGetStart.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{0 true name Variant}], Returns: String
+*/
+func (o *RegExMatch) GetString(name gdnative.Variant) gdnative.String {
+	//log.Println("Calling RegExMatch.GetString()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVariant(name)
+
+	// Get the method bind
+	methodBind := methodBindRegExMatchGetString.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *RegExMatch) GetStrings() gdnative.Array {
+	//log.Println("Calling RegExMatch.GetStrings()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExMatchGetStrings.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *RegExMatch) GetSubject() gdnative.String {
+	//log.Println("Calling RegExMatch.GetSubject()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRegExMatchGetSubject.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+// GetEndWithDefaults will call GetEnd using the default values for: name.
+func (o *RegExMatch) GetEndWithDefaults() gdnative.Int {
+	default0 := gdnative.NewVariantInt(0)
+	defer default0.Destroy()
+	return o.GetEnd(default0)
+}
+
+// GetStartWithDefaults will call GetStart using the default values for: name.
+func (o *RegExMatch) GetStartWithDefaults() gdnative.Int {
+	default0 := gdnative.NewVariantInt(0)
+	defer default0.Destroy()
+	return o.GetStart(default0)
+}
+
+// GetStringWithDefaults will call GetString using the default values for: name.
+func (o *RegExMatch) GetStringWithDefaults() gdnative.String {
+	default0 := gdnative.NewVariantInt(0)
+	defer default0.Destroy()
+	return o.GetString(default0)
+}
+
+// Names will return the value of the "names" property.
+func (o *RegExMatch) Names() gdnative.Dictionary {
+	return o.GetNames()
+}
+
+// Strings will return the value of the "strings" property.
+func (o *RegExMatch) Strings() gdnative.Array {
+	return o.GetStrings()
+}
+
+// Subject will return the value of the "subject" property.
+func (o *RegExMatch) Subject() gdnative.String {
+	return o.GetSubject()
+}
+
+// RegExMatchImplementer is an interface that implements the methods
+// of the RegExMatch class.
+type RegExMatchImplementer interface {
+	godot.ReferenceImplementer
+	GetEnd(name gdnative.Variant) gdnative.Int
+	GetGroupCount() gdnative.Int
+	GetNames() gdnative.Dictionary
+	GetStart(name gdnative.Variant) gdnative.Int
+	GetString(name gdnative.Variant) gdnative.String
+	GetStrings() gdnative.Array
+	GetSubject() gdnative.String
+	GetEndWithDefaults() gdnative.Int
+	GetStartWithDefaults() gdnative.Int
+	GetStringWithDefaults() gdnative.String
+	Names() gdnative.Dictionary
+	Strings() gdnative.Array
+	Subject() gdnative.String
+}
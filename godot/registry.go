@@ -3,6 +3,7 @@ package godot
 import (
 	"github.com/shadowapex/godot-go/gdnative"
 	"reflect"
+	"sync"
 )
 
 // AutoRegister will register the given object(s) as a Godot class, so it will be available
@@ -16,13 +17,15 @@ func AutoRegister(constructor ...ClassConstructor) {
 	}
 }
 
-// Register class will register the given Go struct as a Godot class, so it will be
-// available inside Godot. If you use this method, you will also need to manually
-// register this method's properties and methods. If you want to automatically
-// discover this class's methods and properties, use the 'AutoRegister' function.
-//func RegisterClass(className string, constructor ClassConstructor) {
-//	godotConstructorsToRegister[className] = constructor
-//}
+// RegisterClass will register the given class builder(s) as Godot classes, so they
+// will be available inside Godot. Unlike AutoRegister, only the methods, properties
+// and signals that were added to the builder will be registered with Godot. Use
+// NewClassBuilder to create a class builder.
+func RegisterClass(builder ...*ClassBuilder) {
+	for _, build := range builder {
+		godotClassesToRegister = append(godotClassesToRegister, build)
+	}
+}
 
 // Class is an interface for any objects that can have Godot
 // inheritance.
@@ -32,6 +35,25 @@ type Class interface {
 	GetBaseObject() gdnative.Object
 }
 
+// ToolClass is an interface for classes that should also run inside the Godot
+// editor, like a GDScript with the "tool" keyword. Any class registered with
+// AutoRegister that implements this interface and returns true from IsTool will
+// be registered with Godot as a tool class. Tool classes can use
+// object.Engine.IsEditorHint to check if they are running inside the editor.
+type ToolClass interface {
+	Class
+	IsTool() bool
+}
+
+// isToolClass will return true if the given class should be registered as a
+// tool class.
+func isToolClass(class Class) bool {
+	if tool, ok := class.(ToolClass); ok {
+		return tool.IsTool()
+	}
+	return false
+}
+
 // ClassConstructor is any function that will build and return a class to be registered
 // with Godot.
 type ClassConstructor func() Class
@@ -63,11 +85,13 @@ func (r *registeredClass) addMethod(name string, method *registeredMethod) {
 
 // registeredMethod is a structure for holding on to the reflected details of a Go
 // method that has been registered as a Godot method. It contains the method's
-// argument types and return types.
+// argument types and return types, and the default values of its optional
+// trailing arguments.
 type registeredMethod struct {
 	method    reflect.Method
 	arguments []reflect.Type
 	returns   []reflect.Type
+	defaults  []reflect.Value
 }
 
 // newRegisteredMethod takes in a struct type and uses reflection to discover all
@@ -106,9 +130,9 @@ func newRegisteredMethod(classMethod reflect.Method) *registeredMethod {
 // properties and methods.
 var godotConstructorsToAutoRegister = []ClassConstructor{}
 
-// godotConstructorsToRegister is a slice of objects that will be registered as a Godot class
-// upon library initialization. It will not attempt to discover methods or properties.
-var godotConstructorsToRegister = map[string]ClassConstructor{}
+// godotClassesToRegister is a slice of class builders that will be registered as a Godot
+// class upon library initialization. It will not attempt to discover methods or properties.
+var godotClassesToRegister = []*ClassBuilder{}
 
 // classRegistry is a mapping of all classes that have been registered in Godot.
 var classRegistry = map[string]*registeredClass{}
@@ -118,26 +142,45 @@ var classRegistry = map[string]*registeredClass{}
 var InstanceRegistry = &classInstanceRegistry{registry: map[string]Class{}}
 
 // classInstanceRegistry is a structure for holding on to Class instances that have
-// been constructed.
+// been constructed. It is safe to use from multiple goroutines.
 type classInstanceRegistry struct {
+	lock     sync.RWMutex
 	registry map[string]Class
 }
 
 // Add will add the instance to the registry.
 func (i *classInstanceRegistry) Add(instanceID string, class Class) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
 	i.registry[instanceID] = class
 }
 
 // Get will return the instance with the given instance ID.
 func (i *classInstanceRegistry) Get(instanceID string) (Class, bool) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
 	if instance, ok := i.registry[instanceID]; ok {
 		return instance, true
 	}
 	return nil, false
 }
 
+// getInstance will return the instance that a method or property with the given
+// name was called on. When running inside the editor, Godot can call into
+// instances that do not exist in the registry, so instead of panicking and
+// taking the editor down with it, the error is logged and false is returned.
+func (i *classInstanceRegistry) getInstance(kind, name, instanceID string) (Class, bool) {
+	instance, ok := i.Get(instanceID)
+	if !ok {
+		Log.Error(kind, " ", name, " was called on instance (", instanceID, "), but does not exist in the instance registry!")
+	}
+	return instance, ok
+}
+
 // Delete will delete the given instance from the registry, so it can be
 // garbage collected.
 func (i *classInstanceRegistry) Delete(instanceID string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
 	delete(i.registry, instanceID)
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewResourceFromPointer(ptr gdnative.Pointer) Resource {
-func newResourceFromPointer(ptr gdnative.Pointer) Resource {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Resource{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Resource is the base class for all resource types. Resources are primarily data containers. They are reference counted and freed when no longer in use. They are also loaded only once from disk, and further attempts to load the resource will return the same reference (all this in contrast to a [Node], which is not reference counted and can be instanced from disk as many times as desired). Resources can be saved externally on disk or bundled into another object, such as a [Node] or another resource.
 */
@@ -30,13 +21,46 @@ type Resource struct {
 	owner gdnative.Object
 }
 
+// classConstructorResource is the class constructor of the Resource class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorResource = gdnative.NewLazyClassConstructor("Resource")
+
+// NewResource will create a new instance of the Resource class.
+// Resource is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewResource() *Resource {
+	obj := &Resource{}
+	obj.SetBaseObject(classConstructorResource.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Resource) BaseClass() string {
 	return "Resource"
 }
 
-/*
+// Method binds of the Resource class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindResourceX_SetupLocalToScene = gdnative.NewLazyMethodBind("Resource", "_setup_local_to_scene")
+	methodBindResourceDuplicate           = gdnative.NewLazyMethodBind("Resource", "duplicate")
+	methodBindResourceGetLocalScene       = gdnative.NewLazyMethodBind("Resource", "get_local_scene")
+	methodBindResourceGetName             = gdnative.NewLazyMethodBind("Resource", "get_name")
+	methodBindResourceGetPath             = gdnative.NewLazyMethodBind("Resource", "get_path")
+	methodBindResourceGetRid              = gdnative.NewLazyMethodBind("Resource", "get_rid")
+	methodBindResourceIsLocalToScene      = gdnative.NewLazyMethodBind("Resource", "is_local_to_scene")
+	methodBindResourceSetLocalToScene     = gdnative.NewLazyMethodBind("Resource", "set_local_to_scene")
+	methodBindResourceSetName             = gdnative.NewLazyMethodBind("Resource", "set_name")
+	methodBindResourceSetPath             = gdnative.NewLazyMethodBind("Resource", "set_path")
+	methodBindResourceSetupLocalToScene   = gdnative.NewLazyMethodBind("Resource", "setup_local_to_scene")
+	methodBindResourceTakeOverPath        = gdnative.NewLazyMethodBind("Resource", "take_over_path")
+)
 
-	Args: [], Returns: void
+/*
+Args: [], Returns: void
 */
 func (o *Resource) X_SetupLocalToScene() {
 	//log.Println("Calling Resource.X_SetupLocalToScene()")
@@ -45,7 +69,7 @@ func (o *Resource) X_SetupLocalToScene() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "_setup_local_to_scene")
+	methodBind := methodBindResourceX_SetupLocalToScene.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +79,7 @@ func (o *Resource) X_SetupLocalToScene() {
 }
 
 /*
-
-	Args: [{False true subresources bool}], Returns: Resource
+Args: [{False true subresources bool}], Returns: Resource
 */
 func (o *Resource) Duplicate(subresources gdnative.Bool) ResourceImplementer {
 	//log.Println("Calling Resource.Duplicate()")
@@ -66,7 +89,7 @@ func (o *Resource) Duplicate(subresources gdnative.Bool) ResourceImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromBool(subresources)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "duplicate")
+	methodBind := methodBindResourceDuplicate.Get()
 
 	// Call the parent method.
 	// Resource
@@ -74,27 +97,24 @@ func (o *Resource) Duplicate(subresources gdnative.Bool) ResourceImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newResourceFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ResourceImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Resource" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ResourceImplementer)
+	ret := Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ResourceImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: Node
+Args: [], Returns: Node
 */
 func (o *Resource) GetLocalScene() NodeImplementer {
 	//log.Println("Calling Resource.GetLocalScene()")
@@ -103,7 +123,7 @@ func (o *Resource) GetLocalScene() NodeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "get_local_scene")
+	methodBind := methodBindResourceGetLocalScene.Get()
 
 	// Call the parent method.
 	// Node
@@ -111,27 +131,25 @@ func (o *Resource) GetLocalScene() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Resource) GetName() gdnative.String {
 	//log.Println("Calling Resource.GetName()")
@@ -140,7 +158,7 @@ func (o *Resource) GetName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "get_name")
+	methodBind := methodBindResourceGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -153,8 +171,8 @@ func (o *Resource) GetName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Resource) GetPath() gdnative.String {
 	//log.Println("Calling Resource.GetPath()")
@@ -163,7 +181,7 @@ func (o *Resource) GetPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "get_path")
+	methodBind := methodBindResourceGetPath.Get()
 
 	// Call the parent method.
 	// String
@@ -176,8 +194,8 @@ func (o *Resource) GetPath() gdnative.String {
 }
 
 /*
-        Return the RID of the resource (or an empty RID). Many resources (such as [Texture], [Mesh], etc) are high level abstractions of resources stored in a server, so this function will return the original RID.
-	Args: [], Returns: RID
+	        Return the RID of the resource (or an empty RID). Many resources (such as [Texture], [Mesh], etc) are high level abstractions of resources stored in a server, so this function will return the original RID.
+		Args: [], Returns: RID
 */
 func (o *Resource) GetRid() gdnative.Rid {
 	//log.Println("Calling Resource.GetRid()")
@@ -186,7 +204,7 @@ func (o *Resource) GetRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "get_rid")
+	methodBind := methodBindResourceGetRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -199,8 +217,8 @@ func (o *Resource) GetRid() gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Resource) IsLocalToScene() gdnative.Bool {
 	//log.Println("Calling Resource.IsLocalToScene()")
@@ -209,7 +227,7 @@ func (o *Resource) IsLocalToScene() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "is_local_to_scene")
+	methodBind := methodBindResourceIsLocalToScene.Get()
 
 	// Call the parent method.
 	// bool
@@ -222,8 +240,8 @@ func (o *Resource) IsLocalToScene() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Resource) SetLocalToScene(enable gdnative.Bool) {
 	//log.Println("Calling Resource.SetLocalToScene()")
@@ -233,7 +251,7 @@ func (o *Resource) SetLocalToScene(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "set_local_to_scene")
+	methodBind := methodBindResourceSetLocalToScene.Get()
 
 	// Call the parent method.
 	// void
@@ -243,8 +261,8 @@ func (o *Resource) SetLocalToScene(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *Resource) SetName(name gdnative.String) {
 	//log.Println("Calling Resource.SetName()")
@@ -254,7 +272,7 @@ func (o *Resource) SetName(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "set_name")
+	methodBind := methodBindResourceSetName.Get()
 
 	// Call the parent method.
 	// void
@@ -264,8 +282,8 @@ func (o *Resource) SetName(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: void
+	        Undocumented
+		Args: [{ false path String}], Returns: void
 */
 func (o *Resource) SetPath(path gdnative.String) {
 	//log.Println("Calling Resource.SetPath()")
@@ -275,7 +293,7 @@ func (o *Resource) SetPath(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "set_path")
+	methodBind := methodBindResourceSetPath.Get()
 
 	// Call the parent method.
 	// void
@@ -285,8 +303,7 @@ func (o *Resource) SetPath(path gdnative.String) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *Resource) SetupLocalToScene() {
 	//log.Println("Calling Resource.SetupLocalToScene()")
@@ -295,7 +312,7 @@ func (o *Resource) SetupLocalToScene() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "setup_local_to_scene")
+	methodBind := methodBindResourceSetupLocalToScene.Get()
 
 	// Call the parent method.
 	// void
@@ -305,8 +322,8 @@ func (o *Resource) SetupLocalToScene() {
 }
 
 /*
-        Set the path of the resource. Differs from set_path(), if another [code]Resource[/code] exists with "path" it over-takes it, instead of failing.
-	Args: [{ false path String}], Returns: void
+	        Set the path of the resource. Differs from set_path(), if another [code]Resource[/code] exists with "path" it over-takes it, instead of failing.
+		Args: [{ false path String}], Returns: void
 */
 func (o *Resource) TakeOverPath(path gdnative.String) {
 	//log.Println("Calling Resource.TakeOverPath()")
@@ -316,7 +333,7 @@ func (o *Resource) TakeOverPath(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Resource", "take_over_path")
+	methodBind := methodBindResourceTakeOverPath.Get()
 
 	// Call the parent method.
 	// void
@@ -325,6 +342,57 @@ func (o *Resource) TakeOverPath(path gdnative.String) {
 
 }
 
+// DuplicateWithDefaults will call Duplicate using the default values for: subresources.
+func (o *Resource) DuplicateWithDefaults() ResourceImplementer {
+	return o.Duplicate(false)
+}
+
+// ResourceLocalToScene will return the value of the "resource_local_to_scene" property.
+func (o *Resource) ResourceLocalToScene() gdnative.Bool {
+	return o.IsLocalToScene()
+}
+
+// SetResourceLocalToScene will set the value of the "resource_local_to_scene" property.
+func (o *Resource) SetResourceLocalToScene(value gdnative.Bool) {
+	o.SetLocalToScene(value)
+}
+
+// ResourceName will return the value of the "resource_name" property.
+func (o *Resource) ResourceName() gdnative.String {
+	return o.GetName()
+}
+
+// SetResourceName will set the value of the "resource_name" property.
+func (o *Resource) SetResourceName(value gdnative.String) {
+	o.SetName(value)
+}
+
+// ResourcePath will return the value of the "resource_path" property.
+func (o *Resource) ResourcePath() gdnative.String {
+	return o.GetPath()
+}
+
+// Signals of the Resource class.
+const (
+	ResourceSignalChanged gdnative.String = "changed"
+)
+
+// ConnectChanged will connect the "changed" signal to the given method of the target object.
+func (o *Resource) ConnectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ResourceSignalChanged, target, method, binds, 0)
+}
+
+// EmitChanged will emit the "changed" signal.
+func (o *Resource) EmitChanged() error {
+
+	ret, err := o.EmitSignal(ResourceSignalChanged)
+	ret.Destroy()
+	return err
+}
+
 // ResourceImplementer is an interface that implements the methods
 // of the Resource class.
 type ResourceImplementer interface {
@@ -341,4 +409,12 @@ type ResourceImplementer interface {
 	SetPath(path gdnative.String)
 	SetupLocalToScene()
 	TakeOverPath(path gdnative.String)
+	DuplicateWithDefaults() ResourceImplementer
+	ResourceLocalToScene() gdnative.Bool
+	SetResourceLocalToScene(value gdnative.Bool)
+	ResourceName() gdnative.String
+	SetResourceName(value gdnative.String)
+	ResourcePath() gdnative.String
+	ConnectChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitChanged() error
 }
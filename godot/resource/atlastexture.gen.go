@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,39 +14,60 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAtlasTextureFromPointer(ptr gdnative.Pointer) AtlasTexture {
-func newAtlasTextureFromPointer(ptr gdnative.Pointer) AtlasTexture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AtlasTexture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 [Texture] resource aimed at managing big textures files that pack multiple smaller textures. Consists of a [Texture], a margin that defines the border width, and a region that defines the actual area of the AtlasTexture.
 */
 type AtlasTexture struct {
-	Texture
+	godot.Texture
 	owner gdnative.Object
 }
 
+// classConstructorAtlasTexture is the class constructor of the AtlasTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAtlasTexture = gdnative.NewLazyClassConstructor("AtlasTexture")
+
+// NewAtlasTexture will create a new instance of the AtlasTexture class.
+// AtlasTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewAtlasTexture() *AtlasTexture {
+	obj := &AtlasTexture{}
+	obj.SetBaseObject(classConstructorAtlasTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AtlasTexture) BaseClass() string {
 	return "AtlasTexture"
 }
 
+// Method binds of the AtlasTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAtlasTextureGetAtlas      = gdnative.NewLazyMethodBind("AtlasTexture", "get_atlas")
+	methodBindAtlasTextureGetMargin     = gdnative.NewLazyMethodBind("AtlasTexture", "get_margin")
+	methodBindAtlasTextureGetRegion     = gdnative.NewLazyMethodBind("AtlasTexture", "get_region")
+	methodBindAtlasTextureHasFilterClip = gdnative.NewLazyMethodBind("AtlasTexture", "has_filter_clip")
+	methodBindAtlasTextureSetAtlas      = gdnative.NewLazyMethodBind("AtlasTexture", "set_atlas")
+	methodBindAtlasTextureSetFilterClip = gdnative.NewLazyMethodBind("AtlasTexture", "set_filter_clip")
+	methodBindAtlasTextureSetMargin     = gdnative.NewLazyMethodBind("AtlasTexture", "set_margin")
+	methodBindAtlasTextureSetRegion     = gdnative.NewLazyMethodBind("AtlasTexture", "set_region")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *AtlasTexture) GetAtlas() TextureImplementer {
+func (o *AtlasTexture) GetAtlas() godot.TextureImplementer {
 	//log.Println("Calling AtlasTexture.GetAtlas()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "get_atlas")
+	methodBind := methodBindAtlasTextureGetAtlas.Get()
 
 	// Call the parent method.
 	// Texture
@@ -53,27 +75,25 @@ func (o *AtlasTexture) GetAtlas() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *AtlasTexture) GetMargin() gdnative.Rect2 {
 	//log.Println("Calling AtlasTexture.GetMargin()")
@@ -82,7 +102,7 @@ func (o *AtlasTexture) GetMargin() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "get_margin")
+	methodBind := methodBindAtlasTextureGetMargin.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -95,8 +115,8 @@ func (o *AtlasTexture) GetMargin() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *AtlasTexture) GetRegion() gdnative.Rect2 {
 	//log.Println("Calling AtlasTexture.GetRegion()")
@@ -105,7 +125,7 @@ func (o *AtlasTexture) GetRegion() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "get_region")
+	methodBind := methodBindAtlasTextureGetRegion.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -118,8 +138,8 @@ func (o *AtlasTexture) GetRegion() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AtlasTexture) HasFilterClip() gdnative.Bool {
 	//log.Println("Calling AtlasTexture.HasFilterClip()")
@@ -128,7 +148,7 @@ func (o *AtlasTexture) HasFilterClip() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "has_filter_clip")
+	methodBind := methodBindAtlasTextureHasFilterClip.Get()
 
 	// Call the parent method.
 	// bool
@@ -141,18 +161,18 @@ func (o *AtlasTexture) HasFilterClip() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false atlas Texture}], Returns: void
+	        Undocumented
+		Args: [{ false atlas Texture}], Returns: void
 */
-func (o *AtlasTexture) SetAtlas(atlas TextureImplementer) {
+func (o *AtlasTexture) SetAtlas(atlas godot.TextureImplementer) {
 	//log.Println("Calling AtlasTexture.SetAtlas()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(atlas.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(atlas))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "set_atlas")
+	methodBind := methodBindAtlasTextureSetAtlas.Get()
 
 	// Call the parent method.
 	// void
@@ -162,8 +182,8 @@ func (o *AtlasTexture) SetAtlas(atlas TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *AtlasTexture) SetFilterClip(enable gdnative.Bool) {
 	//log.Println("Calling AtlasTexture.SetFilterClip()")
@@ -173,7 +193,7 @@ func (o *AtlasTexture) SetFilterClip(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "set_filter_clip")
+	methodBind := methodBindAtlasTextureSetFilterClip.Get()
 
 	// Call the parent method.
 	// void
@@ -183,8 +203,8 @@ func (o *AtlasTexture) SetFilterClip(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin Rect2}], Returns: void
+	        Undocumented
+		Args: [{ false margin Rect2}], Returns: void
 */
 func (o *AtlasTexture) SetMargin(margin gdnative.Rect2) {
 	//log.Println("Calling AtlasTexture.SetMargin()")
@@ -194,7 +214,7 @@ func (o *AtlasTexture) SetMargin(margin gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "set_margin")
+	methodBind := methodBindAtlasTextureSetMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -204,8 +224,8 @@ func (o *AtlasTexture) SetMargin(margin gdnative.Rect2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false region Rect2}], Returns: void
+	        Undocumented
+		Args: [{ false region Rect2}], Returns: void
 */
 func (o *AtlasTexture) SetRegion(region gdnative.Rect2) {
 	//log.Println("Calling AtlasTexture.SetRegion()")
@@ -215,7 +235,7 @@ func (o *AtlasTexture) SetRegion(region gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(region)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AtlasTexture", "set_region")
+	methodBind := methodBindAtlasTextureSetRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -224,16 +244,40 @@ func (o *AtlasTexture) SetRegion(region gdnative.Rect2) {
 
 }
 
+// Atlas will return the value of the "atlas" property.
+func (o *AtlasTexture) Atlas() godot.TextureImplementer {
+	return o.GetAtlas()
+}
+
+// FilterClip will return the value of the "filter_clip" property.
+func (o *AtlasTexture) FilterClip() gdnative.Bool {
+	return o.HasFilterClip()
+}
+
+// Margin will return the value of the "margin" property.
+func (o *AtlasTexture) Margin() gdnative.Rect2 {
+	return o.GetMargin()
+}
+
+// Region will return the value of the "region" property.
+func (o *AtlasTexture) Region() gdnative.Rect2 {
+	return o.GetRegion()
+}
+
 // AtlasTextureImplementer is an interface that implements the methods
 // of the AtlasTexture class.
 type AtlasTextureImplementer interface {
-	TextureImplementer
-	GetAtlas() TextureImplementer
+	godot.TextureImplementer
+	GetAtlas() godot.TextureImplementer
 	GetMargin() gdnative.Rect2
 	GetRegion() gdnative.Rect2
 	HasFilterClip() gdnative.Bool
-	SetAtlas(atlas TextureImplementer)
+	SetAtlas(atlas godot.TextureImplementer)
 	SetFilterClip(enable gdnative.Bool)
 	SetMargin(margin gdnative.Rect2)
 	SetRegion(region gdnative.Rect2)
+	Atlas() godot.TextureImplementer
+	FilterClip() gdnative.Bool
+	Margin() gdnative.Rect2
+	Region() gdnative.Rect2
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewBoxShapeFromPointer(ptr gdnative.Pointer) BoxShape {
-func newBoxShapeFromPointer(ptr gdnative.Pointer) BoxShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BoxShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 3D box shape that can be a child of a [PhysicsBody] or [Area].
 */
 type BoxShape struct {
-	Shape
+	godot.Shape
 	owner gdnative.Object
 }
 
+// classConstructorBoxShape is the class constructor of the BoxShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorBoxShape = gdnative.NewLazyClassConstructor("BoxShape")
+
+// NewBoxShape will create a new instance of the BoxShape class.
+// BoxShape is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewBoxShape() *BoxShape {
+	obj := &BoxShape{}
+	obj.SetBaseObject(classConstructorBoxShape.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *BoxShape) BaseClass() string {
 	return "BoxShape"
 }
 
+// Method binds of the BoxShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBoxShapeGetExtents = gdnative.NewLazyMethodBind("BoxShape", "get_extents")
+	methodBindBoxShapeSetExtents = gdnative.NewLazyMethodBind("BoxShape", "set_extents")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *BoxShape) GetExtents() gdnative.Vector3 {
 	//log.Println("Calling BoxShape.GetExtents()")
@@ -45,7 +61,7 @@ func (o *BoxShape) GetExtents() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BoxShape", "get_extents")
+	methodBind := methodBindBoxShapeGetExtents.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -58,8 +74,8 @@ func (o *BoxShape) GetExtents() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [{ false extents Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false extents Vector3}], Returns: void
 */
 func (o *BoxShape) SetExtents(extents gdnative.Vector3) {
 	//log.Println("Calling BoxShape.SetExtents()")
@@ -69,7 +85,7 @@ func (o *BoxShape) SetExtents(extents gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(extents)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BoxShape", "set_extents")
+	methodBind := methodBindBoxShapeSetExtents.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *BoxShape) SetExtents(extents gdnative.Vector3) {
 
 }
 
+// Extents will return the value of the "extents" property.
+func (o *BoxShape) Extents() gdnative.Vector3 {
+	return o.GetExtents()
+}
+
 // BoxShapeImplementer is an interface that implements the methods
 // of the BoxShape class.
 type BoxShapeImplementer interface {
-	ShapeImplementer
+	godot.ShapeImplementer
 	GetExtents() gdnative.Vector3
 	SetExtents(extents gdnative.Vector3)
+	Extents() gdnative.Vector3
 }
@@ -0,0 +1,245 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// CanvasItemMaterialBlendMode is an enum for BlendMode values.
+type CanvasItemMaterialBlendMode int
+
+const (
+	CanvasItemMaterialBlendModeAdd          CanvasItemMaterialBlendMode = 1
+	CanvasItemMaterialBlendModeMix          CanvasItemMaterialBlendMode = 0
+	CanvasItemMaterialBlendModeMul          CanvasItemMaterialBlendMode = 3
+	CanvasItemMaterialBlendModePremultAlpha CanvasItemMaterialBlendMode = 4
+	CanvasItemMaterialBlendModeSub          CanvasItemMaterialBlendMode = 2
+)
+
+// String will return the Godot name of the CanvasItemMaterialBlendMode value.
+func (e CanvasItemMaterialBlendMode) String() string {
+	switch e {
+	case CanvasItemMaterialBlendModeAdd:
+		return "BLEND_MODE_ADD"
+	case CanvasItemMaterialBlendModeMix:
+		return "BLEND_MODE_MIX"
+	case CanvasItemMaterialBlendModeMul:
+		return "BLEND_MODE_MUL"
+	case CanvasItemMaterialBlendModePremultAlpha:
+		return "BLEND_MODE_PREMULT_ALPHA"
+	case CanvasItemMaterialBlendModeSub:
+		return "BLEND_MODE_SUB"
+	}
+	return fmt.Sprintf("CanvasItemMaterialBlendMode(%d)", int(e))
+}
+
+// ParseCanvasItemMaterialBlendMode will return the CanvasItemMaterialBlendMode value with the given Godot name.
+func ParseCanvasItemMaterialBlendMode(name string) (CanvasItemMaterialBlendMode, error) {
+	switch name {
+	case "BLEND_MODE_ADD":
+		return CanvasItemMaterialBlendModeAdd, nil
+	case "BLEND_MODE_MIX":
+		return CanvasItemMaterialBlendModeMix, nil
+	case "BLEND_MODE_MUL":
+		return CanvasItemMaterialBlendModeMul, nil
+	case "BLEND_MODE_PREMULT_ALPHA":
+		return CanvasItemMaterialBlendModePremultAlpha, nil
+	case "BLEND_MODE_SUB":
+		return CanvasItemMaterialBlendModeSub, nil
+	}
+	return 0, fmt.Errorf("invalid CanvasItemMaterialBlendMode value %q", name)
+}
+
+// CanvasItemMaterialLightMode is an enum for LightMode values.
+type CanvasItemMaterialLightMode int
+
+const (
+	CanvasItemMaterialLightModeLightOnly CanvasItemMaterialLightMode = 2
+	CanvasItemMaterialLightModeNormal    CanvasItemMaterialLightMode = 0
+	CanvasItemMaterialLightModeUnshaded  CanvasItemMaterialLightMode = 1
+)
+
+// String will return the Godot name of the CanvasItemMaterialLightMode value.
+func (e CanvasItemMaterialLightMode) String() string {
+	switch e {
+	case CanvasItemMaterialLightModeLightOnly:
+		return "LIGHT_MODE_LIGHT_ONLY"
+	case CanvasItemMaterialLightModeNormal:
+		return "LIGHT_MODE_NORMAL"
+	case CanvasItemMaterialLightModeUnshaded:
+		return "LIGHT_MODE_UNSHADED"
+	}
+	return fmt.Sprintf("CanvasItemMaterialLightMode(%d)", int(e))
+}
+
+// ParseCanvasItemMaterialLightMode will return the CanvasItemMaterialLightMode value with the given Godot name.
+func ParseCanvasItemMaterialLightMode(name string) (CanvasItemMaterialLightMode, error) {
+	switch name {
+	case "LIGHT_MODE_LIGHT_ONLY":
+		return CanvasItemMaterialLightModeLightOnly, nil
+	case "LIGHT_MODE_NORMAL":
+		return CanvasItemMaterialLightModeNormal, nil
+	case "LIGHT_MODE_UNSHADED":
+		return CanvasItemMaterialLightModeUnshaded, nil
+	}
+	return 0, fmt.Errorf("invalid CanvasItemMaterialLightMode value %q", name)
+}
+
+/*
+[code]CanvasItemMaterial[/code]s provide a means of modifying the textures associated with a CanvasItem. They specialize in describing blend and lighting behaviors for textures. Use a [ShaderMaterial] to more fully customize a material's interactions with a [CanvasItem].
+*/
+type CanvasItemMaterial struct {
+	godot.Material
+	owner gdnative.Object
+}
+
+// classConstructorCanvasItemMaterial is the class constructor of the CanvasItemMaterial class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCanvasItemMaterial = gdnative.NewLazyClassConstructor("CanvasItemMaterial")
+
+// NewCanvasItemMaterial will create a new instance of the CanvasItemMaterial class.
+// CanvasItemMaterial is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCanvasItemMaterial() *CanvasItemMaterial {
+	obj := &CanvasItemMaterial{}
+	obj.SetBaseObject(classConstructorCanvasItemMaterial.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *CanvasItemMaterial) BaseClass() string {
+	return "CanvasItemMaterial"
+}
+
+// Method binds of the CanvasItemMaterial class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCanvasItemMaterialGetBlendMode = gdnative.NewLazyMethodBind("CanvasItemMaterial", "get_blend_mode")
+	methodBindCanvasItemMaterialGetLightMode = gdnative.NewLazyMethodBind("CanvasItemMaterial", "get_light_mode")
+	methodBindCanvasItemMaterialSetBlendMode = gdnative.NewLazyMethodBind("CanvasItemMaterial", "set_blend_mode")
+	methodBindCanvasItemMaterialSetLightMode = gdnative.NewLazyMethodBind("CanvasItemMaterial", "set_light_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.CanvasItemMaterial::BlendMode
+*/
+func (o *CanvasItemMaterial) GetBlendMode() CanvasItemMaterialBlendMode {
+	//log.Println("Calling CanvasItemMaterial.GetBlendMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindCanvasItemMaterialGetBlendMode.Get()
+
+	// Call the parent method.
+	// enum.CanvasItemMaterial::BlendMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return CanvasItemMaterialBlendMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.CanvasItemMaterial::LightMode
+*/
+func (o *CanvasItemMaterial) GetLightMode() CanvasItemMaterialLightMode {
+	//log.Println("Calling CanvasItemMaterial.GetLightMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindCanvasItemMaterialGetLightMode.Get()
+
+	// Call the parent method.
+	// enum.CanvasItemMaterial::LightMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return CanvasItemMaterialLightMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false blend_mode enum.CanvasItemMaterial::BlendMode}], Returns: void
+*/
+func (o *CanvasItemMaterial) SetBlendMode(blendMode CanvasItemMaterialBlendMode) {
+	//log.Println("Calling CanvasItemMaterial.SetBlendMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(blendMode))
+
+	// Get the method bind
+	methodBind := methodBindCanvasItemMaterialSetBlendMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false light_mode enum.CanvasItemMaterial::LightMode}], Returns: void
+*/
+func (o *CanvasItemMaterial) SetLightMode(lightMode CanvasItemMaterialLightMode) {
+	//log.Println("Calling CanvasItemMaterial.SetLightMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(lightMode))
+
+	// Get the method bind
+	methodBind := methodBindCanvasItemMaterialSetLightMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// BlendMode will return the value of the "blend_mode" property.
+func (o *CanvasItemMaterial) BlendMode() CanvasItemMaterialBlendMode {
+	return o.GetBlendMode()
+}
+
+// LightMode will return the value of the "light_mode" property.
+func (o *CanvasItemMaterial) LightMode() CanvasItemMaterialLightMode {
+	return o.GetLightMode()
+}
+
+// CanvasItemMaterialImplementer is an interface that implements the methods
+// of the CanvasItemMaterial class.
+type CanvasItemMaterialImplementer interface {
+	godot.MaterialImplementer
+	GetBlendMode() CanvasItemMaterialBlendMode
+	GetLightMode() CanvasItemMaterialLightMode
+	SetBlendMode(blendMode CanvasItemMaterialBlendMode)
+	SetLightMode(lightMode CanvasItemMaterialLightMode)
+	BlendMode() CanvasItemMaterialBlendMode
+	LightMode() CanvasItemMaterialLightMode
+}
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCapsuleMeshFromPointer(ptr gdnative.Pointer) CapsuleMesh {
-func newCapsuleMeshFromPointer(ptr gdnative.Pointer) CapsuleMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CapsuleMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class representing a capsule-shaped [PrimitiveMesh].
 */
@@ -30,13 +22,43 @@ type CapsuleMesh struct {
 	owner gdnative.Object
 }
 
+// classConstructorCapsuleMesh is the class constructor of the CapsuleMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCapsuleMesh = gdnative.NewLazyClassConstructor("CapsuleMesh")
+
+// NewCapsuleMesh will create a new instance of the CapsuleMesh class.
+// CapsuleMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCapsuleMesh() *CapsuleMesh {
+	obj := &CapsuleMesh{}
+	obj.SetBaseObject(classConstructorCapsuleMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CapsuleMesh) BaseClass() string {
 	return "CapsuleMesh"
 }
 
+// Method binds of the CapsuleMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCapsuleMeshGetMidHeight      = gdnative.NewLazyMethodBind("CapsuleMesh", "get_mid_height")
+	methodBindCapsuleMeshGetRadialSegments = gdnative.NewLazyMethodBind("CapsuleMesh", "get_radial_segments")
+	methodBindCapsuleMeshGetRadius         = gdnative.NewLazyMethodBind("CapsuleMesh", "get_radius")
+	methodBindCapsuleMeshGetRings          = gdnative.NewLazyMethodBind("CapsuleMesh", "get_rings")
+	methodBindCapsuleMeshSetMidHeight      = gdnative.NewLazyMethodBind("CapsuleMesh", "set_mid_height")
+	methodBindCapsuleMeshSetRadialSegments = gdnative.NewLazyMethodBind("CapsuleMesh", "set_radial_segments")
+	methodBindCapsuleMeshSetRadius         = gdnative.NewLazyMethodBind("CapsuleMesh", "set_radius")
+	methodBindCapsuleMeshSetRings          = gdnative.NewLazyMethodBind("CapsuleMesh", "set_rings")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CapsuleMesh) GetMidHeight() gdnative.Real {
 	//log.Println("Calling CapsuleMesh.GetMidHeight()")
@@ -45,7 +67,7 @@ func (o *CapsuleMesh) GetMidHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "get_mid_height")
+	methodBind := methodBindCapsuleMeshGetMidHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +80,8 @@ func (o *CapsuleMesh) GetMidHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CapsuleMesh) GetRadialSegments() gdnative.Int {
 	//log.Println("Calling CapsuleMesh.GetRadialSegments()")
@@ -68,7 +90,7 @@ func (o *CapsuleMesh) GetRadialSegments() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "get_radial_segments")
+	methodBind := methodBindCapsuleMeshGetRadialSegments.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +103,8 @@ func (o *CapsuleMesh) GetRadialSegments() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CapsuleMesh) GetRadius() gdnative.Real {
 	//log.Println("Calling CapsuleMesh.GetRadius()")
@@ -91,7 +113,7 @@ func (o *CapsuleMesh) GetRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "get_radius")
+	methodBind := methodBindCapsuleMeshGetRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +126,8 @@ func (o *CapsuleMesh) GetRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CapsuleMesh) GetRings() gdnative.Int {
 	//log.Println("Calling CapsuleMesh.GetRings()")
@@ -114,7 +136,7 @@ func (o *CapsuleMesh) GetRings() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "get_rings")
+	methodBind := methodBindCapsuleMeshGetRings.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +149,8 @@ func (o *CapsuleMesh) GetRings() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false mid_height float}], Returns: void
+	        Undocumented
+		Args: [{ false mid_height float}], Returns: void
 */
 func (o *CapsuleMesh) SetMidHeight(midHeight gdnative.Real) {
 	//log.Println("Calling CapsuleMesh.SetMidHeight()")
@@ -138,7 +160,7 @@ func (o *CapsuleMesh) SetMidHeight(midHeight gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(midHeight)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "set_mid_height")
+	methodBind := methodBindCapsuleMeshSetMidHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +170,8 @@ func (o *CapsuleMesh) SetMidHeight(midHeight gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false segments int}], Returns: void
+	        Undocumented
+		Args: [{ false segments int}], Returns: void
 */
 func (o *CapsuleMesh) SetRadialSegments(segments gdnative.Int) {
 	//log.Println("Calling CapsuleMesh.SetRadialSegments()")
@@ -159,7 +181,7 @@ func (o *CapsuleMesh) SetRadialSegments(segments gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(segments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "set_radial_segments")
+	methodBind := methodBindCapsuleMeshSetRadialSegments.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +191,8 @@ func (o *CapsuleMesh) SetRadialSegments(segments gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *CapsuleMesh) SetRadius(radius gdnative.Real) {
 	//log.Println("Calling CapsuleMesh.SetRadius()")
@@ -180,7 +202,7 @@ func (o *CapsuleMesh) SetRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "set_radius")
+	methodBind := methodBindCapsuleMeshSetRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +212,8 @@ func (o *CapsuleMesh) SetRadius(radius gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rings int}], Returns: void
+	        Undocumented
+		Args: [{ false rings int}], Returns: void
 */
 func (o *CapsuleMesh) SetRings(rings gdnative.Int) {
 	//log.Println("Calling CapsuleMesh.SetRings()")
@@ -201,7 +223,7 @@ func (o *CapsuleMesh) SetRings(rings gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(rings)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleMesh", "set_rings")
+	methodBind := methodBindCapsuleMeshSetRings.Get()
 
 	// Call the parent method.
 	// void
@@ -210,6 +232,26 @@ func (o *CapsuleMesh) SetRings(rings gdnative.Int) {
 
 }
 
+// MidHeight will return the value of the "mid_height" property.
+func (o *CapsuleMesh) MidHeight() gdnative.Real {
+	return o.GetMidHeight()
+}
+
+// RadialSegments will return the value of the "radial_segments" property.
+func (o *CapsuleMesh) RadialSegments() gdnative.Int {
+	return o.GetRadialSegments()
+}
+
+// Radius will return the value of the "radius" property.
+func (o *CapsuleMesh) Radius() gdnative.Real {
+	return o.GetRadius()
+}
+
+// Rings will return the value of the "rings" property.
+func (o *CapsuleMesh) Rings() gdnative.Int {
+	return o.GetRings()
+}
+
 // CapsuleMeshImplementer is an interface that implements the methods
 // of the CapsuleMesh class.
 type CapsuleMeshImplementer interface {
@@ -222,4 +264,8 @@ type CapsuleMeshImplementer interface {
 	SetRadialSegments(segments gdnative.Int)
 	SetRadius(radius gdnative.Real)
 	SetRings(rings gdnative.Int)
+	MidHeight() gdnative.Real
+	RadialSegments() gdnative.Int
+	Radius() gdnative.Real
+	Rings() gdnative.Int
 }
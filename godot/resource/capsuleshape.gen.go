@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCapsuleShapeFromPointer(ptr gdnative.Pointer) CapsuleShape {
-func newCapsuleShapeFromPointer(ptr gdnative.Pointer) CapsuleShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CapsuleShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Capsule shape for collisions.
 */
 type CapsuleShape struct {
-	Shape
+	godot.Shape
 	owner gdnative.Object
 }
 
+// classConstructorCapsuleShape is the class constructor of the CapsuleShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCapsuleShape = gdnative.NewLazyClassConstructor("CapsuleShape")
+
+// NewCapsuleShape will create a new instance of the CapsuleShape class.
+// CapsuleShape is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCapsuleShape() *CapsuleShape {
+	obj := &CapsuleShape{}
+	obj.SetBaseObject(classConstructorCapsuleShape.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CapsuleShape) BaseClass() string {
 	return "CapsuleShape"
 }
 
+// Method binds of the CapsuleShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCapsuleShapeGetHeight = gdnative.NewLazyMethodBind("CapsuleShape", "get_height")
+	methodBindCapsuleShapeGetRadius = gdnative.NewLazyMethodBind("CapsuleShape", "get_radius")
+	methodBindCapsuleShapeSetHeight = gdnative.NewLazyMethodBind("CapsuleShape", "set_height")
+	methodBindCapsuleShapeSetRadius = gdnative.NewLazyMethodBind("CapsuleShape", "set_radius")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CapsuleShape) GetHeight() gdnative.Real {
 	//log.Println("Calling CapsuleShape.GetHeight()")
@@ -45,7 +63,7 @@ func (o *CapsuleShape) GetHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape", "get_height")
+	methodBind := methodBindCapsuleShapeGetHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +76,8 @@ func (o *CapsuleShape) GetHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CapsuleShape) GetRadius() gdnative.Real {
 	//log.Println("Calling CapsuleShape.GetRadius()")
@@ -68,7 +86,7 @@ func (o *CapsuleShape) GetRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape", "get_radius")
+	methodBind := methodBindCapsuleShapeGetRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +99,8 @@ func (o *CapsuleShape) GetRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false height float}], Returns: void
+	        Undocumented
+		Args: [{ false height float}], Returns: void
 */
 func (o *CapsuleShape) SetHeight(height gdnative.Real) {
 	//log.Println("Calling CapsuleShape.SetHeight()")
@@ -92,7 +110,7 @@ func (o *CapsuleShape) SetHeight(height gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape", "set_height")
+	methodBind := methodBindCapsuleShapeSetHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *CapsuleShape) SetHeight(height gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *CapsuleShape) SetRadius(radius gdnative.Real) {
 	//log.Println("Calling CapsuleShape.SetRadius()")
@@ -113,7 +131,7 @@ func (o *CapsuleShape) SetRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape", "set_radius")
+	methodBind := methodBindCapsuleShapeSetRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -122,12 +140,24 @@ func (o *CapsuleShape) SetRadius(radius gdnative.Real) {
 
 }
 
+// Height will return the value of the "height" property.
+func (o *CapsuleShape) Height() gdnative.Real {
+	return o.GetHeight()
+}
+
+// Radius will return the value of the "radius" property.
+func (o *CapsuleShape) Radius() gdnative.Real {
+	return o.GetRadius()
+}
+
 // CapsuleShapeImplementer is an interface that implements the methods
 // of the CapsuleShape class.
 type CapsuleShapeImplementer interface {
-	ShapeImplementer
+	godot.ShapeImplementer
 	GetHeight() gdnative.Real
 	GetRadius() gdnative.Real
 	SetHeight(height gdnative.Real)
 	SetRadius(radius gdnative.Real)
+	Height() gdnative.Real
+	Radius() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCapsuleShape2DFromPointer(ptr gdnative.Pointer) CapsuleShape2D {
-func newCapsuleShape2DFromPointer(ptr gdnative.Pointer) CapsuleShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CapsuleShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Capsule shape for 2D collisions.
 */
 type CapsuleShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorCapsuleShape2D is the class constructor of the CapsuleShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCapsuleShape2D = gdnative.NewLazyClassConstructor("CapsuleShape2D")
+
+// NewCapsuleShape2D will create a new instance of the CapsuleShape2D class.
+// CapsuleShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCapsuleShape2D() *CapsuleShape2D {
+	obj := &CapsuleShape2D{}
+	obj.SetBaseObject(classConstructorCapsuleShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CapsuleShape2D) BaseClass() string {
 	return "CapsuleShape2D"
 }
 
+// Method binds of the CapsuleShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCapsuleShape2DGetHeight = gdnative.NewLazyMethodBind("CapsuleShape2D", "get_height")
+	methodBindCapsuleShape2DGetRadius = gdnative.NewLazyMethodBind("CapsuleShape2D", "get_radius")
+	methodBindCapsuleShape2DSetHeight = gdnative.NewLazyMethodBind("CapsuleShape2D", "set_height")
+	methodBindCapsuleShape2DSetRadius = gdnative.NewLazyMethodBind("CapsuleShape2D", "set_radius")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CapsuleShape2D) GetHeight() gdnative.Real {
 	//log.Println("Calling CapsuleShape2D.GetHeight()")
@@ -45,7 +63,7 @@ func (o *CapsuleShape2D) GetHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape2D", "get_height")
+	methodBind := methodBindCapsuleShape2DGetHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +76,8 @@ func (o *CapsuleShape2D) GetHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CapsuleShape2D) GetRadius() gdnative.Real {
 	//log.Println("Calling CapsuleShape2D.GetRadius()")
@@ -68,7 +86,7 @@ func (o *CapsuleShape2D) GetRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape2D", "get_radius")
+	methodBind := methodBindCapsuleShape2DGetRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +99,8 @@ func (o *CapsuleShape2D) GetRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false height float}], Returns: void
+	        Undocumented
+		Args: [{ false height float}], Returns: void
 */
 func (o *CapsuleShape2D) SetHeight(height gdnative.Real) {
 	//log.Println("Calling CapsuleShape2D.SetHeight()")
@@ -92,7 +110,7 @@ func (o *CapsuleShape2D) SetHeight(height gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape2D", "set_height")
+	methodBind := methodBindCapsuleShape2DSetHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *CapsuleShape2D) SetHeight(height gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *CapsuleShape2D) SetRadius(radius gdnative.Real) {
 	//log.Println("Calling CapsuleShape2D.SetRadius()")
@@ -113,7 +131,7 @@ func (o *CapsuleShape2D) SetRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CapsuleShape2D", "set_radius")
+	methodBind := methodBindCapsuleShape2DSetRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -122,12 +140,24 @@ func (o *CapsuleShape2D) SetRadius(radius gdnative.Real) {
 
 }
 
+// Height will return the value of the "height" property.
+func (o *CapsuleShape2D) Height() gdnative.Real {
+	return o.GetHeight()
+}
+
+// Radius will return the value of the "radius" property.
+func (o *CapsuleShape2D) Radius() gdnative.Real {
+	return o.GetRadius()
+}
+
 // CapsuleShape2DImplementer is an interface that implements the methods
 // of the CapsuleShape2D class.
 type CapsuleShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetHeight() gdnative.Real
 	GetRadius() gdnative.Real
 	SetHeight(height gdnative.Real)
 	SetRadius(radius gdnative.Real)
+	Height() gdnative.Real
+	Radius() gdnative.Real
 }
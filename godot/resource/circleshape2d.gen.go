@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCircleShape2DFromPointer(ptr gdnative.Pointer) CircleShape2D {
-func newCircleShape2DFromPointer(ptr gdnative.Pointer) CircleShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CircleShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Circular shape for 2D collisions. This shape is useful for modeling balls or small characters and its collision detection with everything else is very fast.
 */
 type CircleShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorCircleShape2D is the class constructor of the CircleShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCircleShape2D = gdnative.NewLazyClassConstructor("CircleShape2D")
+
+// NewCircleShape2D will create a new instance of the CircleShape2D class.
+// CircleShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCircleShape2D() *CircleShape2D {
+	obj := &CircleShape2D{}
+	obj.SetBaseObject(classConstructorCircleShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CircleShape2D) BaseClass() string {
 	return "CircleShape2D"
 }
 
+// Method binds of the CircleShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCircleShape2DGetRadius = gdnative.NewLazyMethodBind("CircleShape2D", "get_radius")
+	methodBindCircleShape2DSetRadius = gdnative.NewLazyMethodBind("CircleShape2D", "set_radius")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CircleShape2D) GetRadius() gdnative.Real {
 	//log.Println("Calling CircleShape2D.GetRadius()")
@@ -45,7 +61,7 @@ func (o *CircleShape2D) GetRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CircleShape2D", "get_radius")
+	methodBind := methodBindCircleShape2DGetRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +74,8 @@ func (o *CircleShape2D) GetRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *CircleShape2D) SetRadius(radius gdnative.Real) {
 	//log.Println("Calling CircleShape2D.SetRadius()")
@@ -69,7 +85,7 @@ func (o *CircleShape2D) SetRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CircleShape2D", "set_radius")
+	methodBind := methodBindCircleShape2DSetRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *CircleShape2D) SetRadius(radius gdnative.Real) {
 
 }
 
+// Radius will return the value of the "radius" property.
+func (o *CircleShape2D) Radius() gdnative.Real {
+	return o.GetRadius()
+}
+
 // CircleShape2DImplementer is an interface that implements the methods
 // of the CircleShape2D class.
 type CircleShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetRadius() gdnative.Real
 	SetRadius(radius gdnative.Real)
+	Radius() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewConcavePolygonShapeFromPointer(ptr gdnative.Pointer) ConcavePolygonShape {
-func newConcavePolygonShapeFromPointer(ptr gdnative.Pointer) ConcavePolygonShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ConcavePolygonShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Concave polygon shape resource, which can be set into a [PhysicsBody] or area. This shape is created by feeding a list of triangles.
 */
 type ConcavePolygonShape struct {
-	Shape
+	godot.Shape
 	owner gdnative.Object
 }
 
+// classConstructorConcavePolygonShape is the class constructor of the ConcavePolygonShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorConcavePolygonShape = gdnative.NewLazyClassConstructor("ConcavePolygonShape")
+
+// NewConcavePolygonShape will create a new instance of the ConcavePolygonShape class.
+// ConcavePolygonShape is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewConcavePolygonShape() *ConcavePolygonShape {
+	obj := &ConcavePolygonShape{}
+	obj.SetBaseObject(classConstructorConcavePolygonShape.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ConcavePolygonShape) BaseClass() string {
 	return "ConcavePolygonShape"
 }
 
+// Method binds of the ConcavePolygonShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindConcavePolygonShapeGetFaces = gdnative.NewLazyMethodBind("ConcavePolygonShape", "get_faces")
+	methodBindConcavePolygonShapeSetFaces = gdnative.NewLazyMethodBind("ConcavePolygonShape", "set_faces")
+)
+
 /*
-        Return the faces (an array of triangles).
-	Args: [], Returns: PoolVector3Array
+	        Return the faces (an array of triangles).
+		Args: [], Returns: PoolVector3Array
 */
 func (o *ConcavePolygonShape) GetFaces() gdnative.PoolVector3Array {
 	//log.Println("Calling ConcavePolygonShape.GetFaces()")
@@ -45,7 +61,7 @@ func (o *ConcavePolygonShape) GetFaces() gdnative.PoolVector3Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConcavePolygonShape", "get_faces")
+	methodBind := methodBindConcavePolygonShapeGetFaces.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -58,8 +74,8 @@ func (o *ConcavePolygonShape) GetFaces() gdnative.PoolVector3Array {
 }
 
 /*
-        Set the faces (an array of triangles).
-	Args: [{ false faces PoolVector3Array}], Returns: void
+	        Set the faces (an array of triangles).
+		Args: [{ false faces PoolVector3Array}], Returns: void
 */
 func (o *ConcavePolygonShape) SetFaces(faces gdnative.PoolVector3Array) {
 	//log.Println("Calling ConcavePolygonShape.SetFaces()")
@@ -69,7 +85,7 @@ func (o *ConcavePolygonShape) SetFaces(faces gdnative.PoolVector3Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector3Array(faces)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConcavePolygonShape", "set_faces")
+	methodBind := methodBindConcavePolygonShapeSetFaces.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,22 @@ func (o *ConcavePolygonShape) SetFaces(faces gdnative.PoolVector3Array) {
 
 }
 
+// Data will return the value of the "data" property.
+func (o *ConcavePolygonShape) Data() gdnative.PoolVector3Array {
+	return o.GetFaces()
+}
+
+// SetData will set the value of the "data" property.
+func (o *ConcavePolygonShape) SetData(value gdnative.PoolVector3Array) {
+	o.SetFaces(value)
+}
+
 // ConcavePolygonShapeImplementer is an interface that implements the methods
 // of the ConcavePolygonShape class.
 type ConcavePolygonShapeImplementer interface {
-	ShapeImplementer
+	godot.ShapeImplementer
 	GetFaces() gdnative.PoolVector3Array
 	SetFaces(faces gdnative.PoolVector3Array)
+	Data() gdnative.PoolVector3Array
+	SetData(value gdnative.PoolVector3Array)
 }
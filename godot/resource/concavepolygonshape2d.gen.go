@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewConcavePolygonShape2DFromPointer(ptr gdnative.Pointer) ConcavePolygonShape2D {
-func newConcavePolygonShape2DFromPointer(ptr gdnative.Pointer) ConcavePolygonShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ConcavePolygonShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Concave polygon 2D shape resource for physics. It is made out of segments and is very optimal for complex polygonal concave collisions. It is really not advised to use for [RigidBody2D] nodes. A CollisionPolygon2D in convex decomposition mode (solids) or several convex objects are advised for that instead. Otherwise, a concave polygon 2D shape is better for static collisions. The main difference between a [ConvexPolygonShape2D] and a [code]ConcavePolygonShape2D[/code] is that a concave polygon assumes it is concave and uses a more complex method of collision detection, and a convex one forces itself to be convex in order to speed up collision detection.
 */
 type ConcavePolygonShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorConcavePolygonShape2D is the class constructor of the ConcavePolygonShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorConcavePolygonShape2D = gdnative.NewLazyClassConstructor("ConcavePolygonShape2D")
+
+// NewConcavePolygonShape2D will create a new instance of the ConcavePolygonShape2D class.
+// ConcavePolygonShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewConcavePolygonShape2D() *ConcavePolygonShape2D {
+	obj := &ConcavePolygonShape2D{}
+	obj.SetBaseObject(classConstructorConcavePolygonShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ConcavePolygonShape2D) BaseClass() string {
 	return "ConcavePolygonShape2D"
 }
 
+// Method binds of the ConcavePolygonShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindConcavePolygonShape2DGetSegments = gdnative.NewLazyMethodBind("ConcavePolygonShape2D", "get_segments")
+	methodBindConcavePolygonShape2DSetSegments = gdnative.NewLazyMethodBind("ConcavePolygonShape2D", "set_segments")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *ConcavePolygonShape2D) GetSegments() gdnative.PoolVector2Array {
 	//log.Println("Calling ConcavePolygonShape2D.GetSegments()")
@@ -45,7 +61,7 @@ func (o *ConcavePolygonShape2D) GetSegments() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConcavePolygonShape2D", "get_segments")
+	methodBind := methodBindConcavePolygonShape2DGetSegments.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -58,8 +74,8 @@ func (o *ConcavePolygonShape2D) GetSegments() gdnative.PoolVector2Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false segments PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false segments PoolVector2Array}], Returns: void
 */
 func (o *ConcavePolygonShape2D) SetSegments(segments gdnative.PoolVector2Array) {
 	//log.Println("Calling ConcavePolygonShape2D.SetSegments()")
@@ -69,7 +85,7 @@ func (o *ConcavePolygonShape2D) SetSegments(segments gdnative.PoolVector2Array)
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(segments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConcavePolygonShape2D", "set_segments")
+	methodBind := methodBindConcavePolygonShape2DSetSegments.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *ConcavePolygonShape2D) SetSegments(segments gdnative.PoolVector2Array)
 
 }
 
+// Segments will return the value of the "segments" property.
+func (o *ConcavePolygonShape2D) Segments() gdnative.PoolVector2Array {
+	return o.GetSegments()
+}
+
 // ConcavePolygonShape2DImplementer is an interface that implements the methods
 // of the ConcavePolygonShape2D class.
 type ConcavePolygonShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetSegments() gdnative.PoolVector2Array
 	SetSegments(segments gdnative.PoolVector2Array)
+	Segments() gdnative.PoolVector2Array
 }
@@ -0,0 +1,295 @@
+package resource
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("AtlasTexture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AtlasTexture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("BoxShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &BoxShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CanvasItemMaterial", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CanvasItemMaterial{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CapsuleMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CapsuleMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CapsuleShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CapsuleShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CapsuleShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CapsuleShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CircleShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CircleShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ConcavePolygonShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ConcavePolygonShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ConcavePolygonShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ConcavePolygonShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ConvexPolygonShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ConvexPolygonShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ConvexPolygonShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ConvexPolygonShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CubeMap", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CubeMap{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CubeMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CubeMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Curve", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Curve{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Curve2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Curve2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Curve3D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Curve3D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CurveTexture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CurveTexture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CylinderMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CylinderMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GDScript", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GDScript{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Gradient", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Gradient{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GradientTexture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GradientTexture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ImageTexture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ImageTexture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("LargeTexture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &LargeTexture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("LineShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &LineShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("MultiMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &MultiMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("NavigationPolygon", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &NavigationPolygon{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("OccluderPolygon2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &OccluderPolygon2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PHashTranslation", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PHashTranslation{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PackedDataContainer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PackedDataContainer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PanoramaSky", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PanoramaSky{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ParticlesMaterial", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ParticlesMaterial{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PlaneMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PlaneMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PlaneShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PlaneShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PluginScript", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PluginScript{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PolygonPathFinder", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PolygonPathFinder{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PrimitiveMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PrimitiveMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PrismMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PrismMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ProceduralSky", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ProceduralSky{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ProxyTexture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ProxyTexture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("QuadMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &QuadMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RayShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RayShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RayShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RayShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RectangleShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RectangleShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ResourceImporter", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ResourceImporter{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ResourceImporterOGGVorbis", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ResourceImporterOGGVorbis{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ResourceImporterTheora", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ResourceImporterTheora{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ResourceImporterWebm", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ResourceImporterWebm{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ResourceInteractiveLoader", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ResourceInteractiveLoader{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ResourcePreloader", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ResourcePreloader{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SegmentShape2D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SegmentShape2D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SphereMesh", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SphereMesh{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SphereShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SphereShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("StreamTexture", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &StreamTexture{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("TileSet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &TileSet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Translation", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Translation{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewConvexPolygonShapeFromPointer(ptr gdnative.Pointer) ConvexPolygonShape {
-func newConvexPolygonShapeFromPointer(ptr gdnative.Pointer) ConvexPolygonShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ConvexPolygonShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Convex polygon shape resource, which can be added to a [PhysicsBody] or area.
 */
 type ConvexPolygonShape struct {
-	Shape
+	godot.Shape
 	owner gdnative.Object
 }
 
+// classConstructorConvexPolygonShape is the class constructor of the ConvexPolygonShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorConvexPolygonShape = gdnative.NewLazyClassConstructor("ConvexPolygonShape")
+
+// NewConvexPolygonShape will create a new instance of the ConvexPolygonShape class.
+// ConvexPolygonShape is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewConvexPolygonShape() *ConvexPolygonShape {
+	obj := &ConvexPolygonShape{}
+	obj.SetBaseObject(classConstructorConvexPolygonShape.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ConvexPolygonShape) BaseClass() string {
 	return "ConvexPolygonShape"
 }
 
+// Method binds of the ConvexPolygonShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindConvexPolygonShapeGetPoints = gdnative.NewLazyMethodBind("ConvexPolygonShape", "get_points")
+	methodBindConvexPolygonShapeSetPoints = gdnative.NewLazyMethodBind("ConvexPolygonShape", "set_points")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolVector3Array
+	        Undocumented
+		Args: [], Returns: PoolVector3Array
 */
 func (o *ConvexPolygonShape) GetPoints() gdnative.PoolVector3Array {
 	//log.Println("Calling ConvexPolygonShape.GetPoints()")
@@ -45,7 +61,7 @@ func (o *ConvexPolygonShape) GetPoints() gdnative.PoolVector3Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConvexPolygonShape", "get_points")
+	methodBind := methodBindConvexPolygonShapeGetPoints.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -58,8 +74,8 @@ func (o *ConvexPolygonShape) GetPoints() gdnative.PoolVector3Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false points PoolVector3Array}], Returns: void
+	        Undocumented
+		Args: [{ false points PoolVector3Array}], Returns: void
 */
 func (o *ConvexPolygonShape) SetPoints(points gdnative.PoolVector3Array) {
 	//log.Println("Calling ConvexPolygonShape.SetPoints()")
@@ -69,7 +85,7 @@ func (o *ConvexPolygonShape) SetPoints(points gdnative.PoolVector3Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector3Array(points)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConvexPolygonShape", "set_points")
+	methodBind := methodBindConvexPolygonShapeSetPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *ConvexPolygonShape) SetPoints(points gdnative.PoolVector3Array) {
 
 }
 
+// Points will return the value of the "points" property.
+func (o *ConvexPolygonShape) Points() gdnative.PoolVector3Array {
+	return o.GetPoints()
+}
+
 // ConvexPolygonShapeImplementer is an interface that implements the methods
 // of the ConvexPolygonShape class.
 type ConvexPolygonShapeImplementer interface {
-	ShapeImplementer
+	godot.ShapeImplementer
 	GetPoints() gdnative.PoolVector3Array
 	SetPoints(points gdnative.PoolVector3Array)
+	Points() gdnative.PoolVector3Array
 }
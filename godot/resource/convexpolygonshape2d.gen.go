@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewConvexPolygonShape2DFromPointer(ptr gdnative.Pointer) ConvexPolygonShape2D {
-func newConvexPolygonShape2DFromPointer(ptr gdnative.Pointer) ConvexPolygonShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ConvexPolygonShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Convex Polygon Shape for 2D physics. A convex polygon, whatever its shape, is internally decomposed into as many convex polygons as needed to ensure all collision checks against it are always done on convex polygons (which are faster to check). The main difference between a [code]ConvexPolygonShape2D[/code] and a [ConcavePolygonShape2D] is that a concave polygon assumes it is concave and uses a more complex method of collision detection, and a convex one forces itself to be convex in order to speed up collision detection.
 */
 type ConvexPolygonShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorConvexPolygonShape2D is the class constructor of the ConvexPolygonShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorConvexPolygonShape2D = gdnative.NewLazyClassConstructor("ConvexPolygonShape2D")
+
+// NewConvexPolygonShape2D will create a new instance of the ConvexPolygonShape2D class.
+// ConvexPolygonShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewConvexPolygonShape2D() *ConvexPolygonShape2D {
+	obj := &ConvexPolygonShape2D{}
+	obj.SetBaseObject(classConstructorConvexPolygonShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ConvexPolygonShape2D) BaseClass() string {
 	return "ConvexPolygonShape2D"
 }
 
+// Method binds of the ConvexPolygonShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindConvexPolygonShape2DGetPoints     = gdnative.NewLazyMethodBind("ConvexPolygonShape2D", "get_points")
+	methodBindConvexPolygonShape2DSetPointCloud = gdnative.NewLazyMethodBind("ConvexPolygonShape2D", "set_point_cloud")
+	methodBindConvexPolygonShape2DSetPoints     = gdnative.NewLazyMethodBind("ConvexPolygonShape2D", "set_points")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *ConvexPolygonShape2D) GetPoints() gdnative.PoolVector2Array {
 	//log.Println("Calling ConvexPolygonShape2D.GetPoints()")
@@ -45,7 +62,7 @@ func (o *ConvexPolygonShape2D) GetPoints() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConvexPolygonShape2D", "get_points")
+	methodBind := methodBindConvexPolygonShape2DGetPoints.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -58,8 +75,8 @@ func (o *ConvexPolygonShape2D) GetPoints() gdnative.PoolVector2Array {
 }
 
 /*
-        Currently, this method does nothing.
-	Args: [{ false point_cloud PoolVector2Array}], Returns: void
+	        Currently, this method does nothing.
+		Args: [{ false point_cloud PoolVector2Array}], Returns: void
 */
 func (o *ConvexPolygonShape2D) SetPointCloud(pointCloud gdnative.PoolVector2Array) {
 	//log.Println("Calling ConvexPolygonShape2D.SetPointCloud()")
@@ -69,7 +86,7 @@ func (o *ConvexPolygonShape2D) SetPointCloud(pointCloud gdnative.PoolVector2Arra
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(pointCloud)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConvexPolygonShape2D", "set_point_cloud")
+	methodBind := methodBindConvexPolygonShape2DSetPointCloud.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +96,8 @@ func (o *ConvexPolygonShape2D) SetPointCloud(pointCloud gdnative.PoolVector2Arra
 }
 
 /*
-        Undocumented
-	Args: [{ false points PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false points PoolVector2Array}], Returns: void
 */
 func (o *ConvexPolygonShape2D) SetPoints(points gdnative.PoolVector2Array) {
 	//log.Println("Calling ConvexPolygonShape2D.SetPoints()")
@@ -90,7 +107,7 @@ func (o *ConvexPolygonShape2D) SetPoints(points gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(points)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ConvexPolygonShape2D", "set_points")
+	methodBind := methodBindConvexPolygonShape2DSetPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -99,11 +116,17 @@ func (o *ConvexPolygonShape2D) SetPoints(points gdnative.PoolVector2Array) {
 
 }
 
+// Points will return the value of the "points" property.
+func (o *ConvexPolygonShape2D) Points() gdnative.PoolVector2Array {
+	return o.GetPoints()
+}
+
 // ConvexPolygonShape2DImplementer is an interface that implements the methods
 // of the ConvexPolygonShape2D class.
 type ConvexPolygonShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetPoints() gdnative.PoolVector2Array
 	SetPointCloud(pointCloud gdnative.PoolVector2Array)
 	SetPoints(points gdnative.PoolVector2Array)
+	Points() gdnative.PoolVector2Array
 }
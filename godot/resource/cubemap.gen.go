@@ -0,0 +1,462 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// CubeMapFlags is an enum for Flags values.
+type CubeMapFlags int
+
+const (
+	CubeMapFlagsDefault CubeMapFlags = 7
+	CubeMapFlagFilter   CubeMapFlags = 4
+	CubeMapFlagMipmaps  CubeMapFlags = 1
+	CubeMapFlagRepeat   CubeMapFlags = 2
+)
+
+// String will return the Godot name of the CubeMapFlags value.
+func (e CubeMapFlags) String() string {
+	switch e {
+	case CubeMapFlagsDefault:
+		return "FLAGS_DEFAULT"
+	case CubeMapFlagFilter:
+		return "FLAG_FILTER"
+	case CubeMapFlagMipmaps:
+		return "FLAG_MIPMAPS"
+	case CubeMapFlagRepeat:
+		return "FLAG_REPEAT"
+	}
+	return fmt.Sprintf("CubeMapFlags(%d)", int(e))
+}
+
+// ParseCubeMapFlags will return the CubeMapFlags value with the given Godot name.
+func ParseCubeMapFlags(name string) (CubeMapFlags, error) {
+	switch name {
+	case "FLAGS_DEFAULT":
+		return CubeMapFlagsDefault, nil
+	case "FLAG_FILTER":
+		return CubeMapFlagFilter, nil
+	case "FLAG_MIPMAPS":
+		return CubeMapFlagMipmaps, nil
+	case "FLAG_REPEAT":
+		return CubeMapFlagRepeat, nil
+	}
+	return 0, fmt.Errorf("invalid CubeMapFlags value %q", name)
+}
+
+// CubeMapSide is an enum for Side values.
+type CubeMapSide int
+
+const (
+	CubeMapSideBack   CubeMapSide = 5
+	CubeMapSideBottom CubeMapSide = 2
+	CubeMapSideFront  CubeMapSide = 4
+	CubeMapSideLeft   CubeMapSide = 0
+	CubeMapSideRight  CubeMapSide = 1
+	CubeMapSideTop    CubeMapSide = 3
+)
+
+// String will return the Godot name of the CubeMapSide value.
+func (e CubeMapSide) String() string {
+	switch e {
+	case CubeMapSideBack:
+		return "SIDE_BACK"
+	case CubeMapSideBottom:
+		return "SIDE_BOTTOM"
+	case CubeMapSideFront:
+		return "SIDE_FRONT"
+	case CubeMapSideLeft:
+		return "SIDE_LEFT"
+	case CubeMapSideRight:
+		return "SIDE_RIGHT"
+	case CubeMapSideTop:
+		return "SIDE_TOP"
+	}
+	return fmt.Sprintf("CubeMapSide(%d)", int(e))
+}
+
+// ParseCubeMapSide will return the CubeMapSide value with the given Godot name.
+func ParseCubeMapSide(name string) (CubeMapSide, error) {
+	switch name {
+	case "SIDE_BACK":
+		return CubeMapSideBack, nil
+	case "SIDE_BOTTOM":
+		return CubeMapSideBottom, nil
+	case "SIDE_FRONT":
+		return CubeMapSideFront, nil
+	case "SIDE_LEFT":
+		return CubeMapSideLeft, nil
+	case "SIDE_RIGHT":
+		return CubeMapSideRight, nil
+	case "SIDE_TOP":
+		return CubeMapSideTop, nil
+	}
+	return 0, fmt.Errorf("invalid CubeMapSide value %q", name)
+}
+
+// CubeMapStorage is an enum for Storage values.
+type CubeMapStorage int
+
+const (
+	CubeMapStorageCompressLossless CubeMapStorage = 2
+	CubeMapStorageCompressLossy    CubeMapStorage = 1
+	CubeMapStorageRaw              CubeMapStorage = 0
+)
+
+// String will return the Godot name of the CubeMapStorage value.
+func (e CubeMapStorage) String() string {
+	switch e {
+	case CubeMapStorageCompressLossless:
+		return "STORAGE_COMPRESS_LOSSLESS"
+	case CubeMapStorageCompressLossy:
+		return "STORAGE_COMPRESS_LOSSY"
+	case CubeMapStorageRaw:
+		return "STORAGE_RAW"
+	}
+	return fmt.Sprintf("CubeMapStorage(%d)", int(e))
+}
+
+// ParseCubeMapStorage will return the CubeMapStorage value with the given Godot name.
+func ParseCubeMapStorage(name string) (CubeMapStorage, error) {
+	switch name {
+	case "STORAGE_COMPRESS_LOSSLESS":
+		return CubeMapStorageCompressLossless, nil
+	case "STORAGE_COMPRESS_LOSSY":
+		return CubeMapStorageCompressLossy, nil
+	case "STORAGE_RAW":
+		return CubeMapStorageRaw, nil
+	}
+	return 0, fmt.Errorf("invalid CubeMapStorage value %q", name)
+}
+
+/*
+A 6-sided 3D texture typically used for faking reflections. It can be used to make an object look as if it's reflecting its surroundings. This usually delivers much better performance than other reflection methods.
+*/
+type CubeMap struct {
+	godot.Resource
+	owner gdnative.Object
+}
+
+// classConstructorCubeMap is the class constructor of the CubeMap class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCubeMap = gdnative.NewLazyClassConstructor("CubeMap")
+
+// NewCubeMap will create a new instance of the CubeMap class.
+// CubeMap is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCubeMap() *CubeMap {
+	obj := &CubeMap{}
+	obj.SetBaseObject(classConstructorCubeMap.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *CubeMap) BaseClass() string {
+	return "CubeMap"
+}
+
+// Method binds of the CubeMap class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCubeMapGetFlags               = gdnative.NewLazyMethodBind("CubeMap", "get_flags")
+	methodBindCubeMapGetHeight              = gdnative.NewLazyMethodBind("CubeMap", "get_height")
+	methodBindCubeMapGetLossyStorageQuality = gdnative.NewLazyMethodBind("CubeMap", "get_lossy_storage_quality")
+	methodBindCubeMapGetSide                = gdnative.NewLazyMethodBind("CubeMap", "get_side")
+	methodBindCubeMapGetStorage             = gdnative.NewLazyMethodBind("CubeMap", "get_storage")
+	methodBindCubeMapGetWidth               = gdnative.NewLazyMethodBind("CubeMap", "get_width")
+	methodBindCubeMapSetFlags               = gdnative.NewLazyMethodBind("CubeMap", "set_flags")
+	methodBindCubeMapSetLossyStorageQuality = gdnative.NewLazyMethodBind("CubeMap", "set_lossy_storage_quality")
+	methodBindCubeMapSetSide                = gdnative.NewLazyMethodBind("CubeMap", "set_side")
+	methodBindCubeMapSetStorage             = gdnative.NewLazyMethodBind("CubeMap", "set_storage")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *CubeMap) GetFlags() gdnative.Int {
+	//log.Println("Calling CubeMap.GetFlags()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindCubeMapGetFlags.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the [code]CubeMap[/code]'s height.
+		Args: [], Returns: int
+*/
+func (o *CubeMap) GetHeight() gdnative.Int {
+	//log.Println("Calling CubeMap.GetHeight()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindCubeMapGetHeight.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *CubeMap) GetLossyStorageQuality() gdnative.Real {
+	//log.Println("Calling CubeMap.GetLossyStorageQuality()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindCubeMapGetLossyStorageQuality.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns an [Image] for a side of the [code]CubeMap[/code] using one of the [code]SIDE_*[/code] constants or an integer 0-5.
+		Args: [{ false side enum.CubeMap::Side}], Returns: Image
+*/
+func (o *CubeMap) GetSide(side CubeMapSide) godot.ImageImplementer {
+	//log.Println("Calling CubeMap.GetSide()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(side))
+
+	// Get the method bind
+	methodBind := methodBindCubeMapGetSide.Get()
+
+	// Call the parent method.
+	// Image
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Image{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ImageImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.CubeMap::Storage
+*/
+func (o *CubeMap) GetStorage() CubeMapStorage {
+	//log.Println("Calling CubeMap.GetStorage()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindCubeMapGetStorage.Get()
+
+	// Call the parent method.
+	// enum.CubeMap::Storage
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return CubeMapStorage(ret)
+}
+
+/*
+	        Returns the [code]CubeMap[/code]'s width.
+		Args: [], Returns: int
+*/
+func (o *CubeMap) GetWidth() gdnative.Int {
+	//log.Println("Calling CubeMap.GetWidth()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindCubeMapGetWidth.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false flags enum.CubeMap::Flags}], Returns: void
+*/
+func (o *CubeMap) SetFlags(flags CubeMapFlags) {
+	//log.Println("Calling CubeMap.SetFlags()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flags))
+
+	// Get the method bind
+	methodBind := methodBindCubeMapSetFlags.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false quality float}], Returns: void
+*/
+func (o *CubeMap) SetLossyStorageQuality(quality gdnative.Real) {
+	//log.Println("Calling CubeMap.SetLossyStorageQuality()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(quality)
+
+	// Get the method bind
+	methodBind := methodBindCubeMapSetLossyStorageQuality.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Sets an [Image] for a side of the [code]CubeMap[/code] using one of the [code]SIDE_*[/code] constants or an integer 0-5.
+		Args: [{ false side enum.CubeMap::Side} { false image Image}], Returns: void
+*/
+func (o *CubeMap) SetSide(side CubeMapSide, image godot.ImageImplementer) {
+	//log.Println("Calling CubeMap.SetSide()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(side))
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
+
+	// Get the method bind
+	methodBind := methodBindCubeMapSetSide.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.CubeMap::Storage}], Returns: void
+*/
+func (o *CubeMap) SetStorage(mode CubeMapStorage) {
+	//log.Println("Calling CubeMap.SetStorage()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindCubeMapSetStorage.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Flags will return the value of the "flags" property.
+func (o *CubeMap) Flags() gdnative.Int {
+	return o.GetFlags()
+}
+
+// LossyStorageQuality will return the value of the "lossy_storage_quality" property.
+func (o *CubeMap) LossyStorageQuality() gdnative.Real {
+	return o.GetLossyStorageQuality()
+}
+
+// StorageMode will return the value of the "storage_mode" property.
+func (o *CubeMap) StorageMode() CubeMapStorage {
+	return o.GetStorage()
+}
+
+// SetStorageMode will set the value of the "storage_mode" property.
+func (o *CubeMap) SetStorageMode(value CubeMapStorage) {
+	o.SetStorage(value)
+}
+
+// CubeMapImplementer is an interface that implements the methods
+// of the CubeMap class.
+type CubeMapImplementer interface {
+	godot.ResourceImplementer
+	GetFlags() gdnative.Int
+	GetHeight() gdnative.Int
+	GetLossyStorageQuality() gdnative.Real
+	GetSide(side CubeMapSide) godot.ImageImplementer
+	GetStorage() CubeMapStorage
+	GetWidth() gdnative.Int
+	SetFlags(flags CubeMapFlags)
+	SetLossyStorageQuality(quality gdnative.Real)
+	SetSide(side CubeMapSide, image godot.ImageImplementer)
+	SetStorage(mode CubeMapStorage)
+	Flags() gdnative.Int
+	LossyStorageQuality() gdnative.Real
+	StorageMode() CubeMapStorage
+	SetStorageMode(value CubeMapStorage)
+}
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCubeMeshFromPointer(ptr gdnative.Pointer) CubeMesh {
-func newCubeMeshFromPointer(ptr gdnative.Pointer) CubeMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CubeMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Generate an axis-aligned cuboid [PrimitiveMesh].
 */
@@ -30,13 +22,43 @@ type CubeMesh struct {
 	owner gdnative.Object
 }
 
+// classConstructorCubeMesh is the class constructor of the CubeMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCubeMesh = gdnative.NewLazyClassConstructor("CubeMesh")
+
+// NewCubeMesh will create a new instance of the CubeMesh class.
+// CubeMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCubeMesh() *CubeMesh {
+	obj := &CubeMesh{}
+	obj.SetBaseObject(classConstructorCubeMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CubeMesh) BaseClass() string {
 	return "CubeMesh"
 }
 
+// Method binds of the CubeMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCubeMeshGetSize            = gdnative.NewLazyMethodBind("CubeMesh", "get_size")
+	methodBindCubeMeshGetSubdivideDepth  = gdnative.NewLazyMethodBind("CubeMesh", "get_subdivide_depth")
+	methodBindCubeMeshGetSubdivideHeight = gdnative.NewLazyMethodBind("CubeMesh", "get_subdivide_height")
+	methodBindCubeMeshGetSubdivideWidth  = gdnative.NewLazyMethodBind("CubeMesh", "get_subdivide_width")
+	methodBindCubeMeshSetSize            = gdnative.NewLazyMethodBind("CubeMesh", "set_size")
+	methodBindCubeMeshSetSubdivideDepth  = gdnative.NewLazyMethodBind("CubeMesh", "set_subdivide_depth")
+	methodBindCubeMeshSetSubdivideHeight = gdnative.NewLazyMethodBind("CubeMesh", "set_subdivide_height")
+	methodBindCubeMeshSetSubdivideWidth  = gdnative.NewLazyMethodBind("CubeMesh", "set_subdivide_width")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *CubeMesh) GetSize() gdnative.Vector3 {
 	//log.Println("Calling CubeMesh.GetSize()")
@@ -45,7 +67,7 @@ func (o *CubeMesh) GetSize() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "get_size")
+	methodBind := methodBindCubeMeshGetSize.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -58,8 +80,8 @@ func (o *CubeMesh) GetSize() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CubeMesh) GetSubdivideDepth() gdnative.Int {
 	//log.Println("Calling CubeMesh.GetSubdivideDepth()")
@@ -68,7 +90,7 @@ func (o *CubeMesh) GetSubdivideDepth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "get_subdivide_depth")
+	methodBind := methodBindCubeMeshGetSubdivideDepth.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +103,8 @@ func (o *CubeMesh) GetSubdivideDepth() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CubeMesh) GetSubdivideHeight() gdnative.Int {
 	//log.Println("Calling CubeMesh.GetSubdivideHeight()")
@@ -91,7 +113,7 @@ func (o *CubeMesh) GetSubdivideHeight() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "get_subdivide_height")
+	methodBind := methodBindCubeMeshGetSubdivideHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +126,8 @@ func (o *CubeMesh) GetSubdivideHeight() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CubeMesh) GetSubdivideWidth() gdnative.Int {
 	//log.Println("Calling CubeMesh.GetSubdivideWidth()")
@@ -114,7 +136,7 @@ func (o *CubeMesh) GetSubdivideWidth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "get_subdivide_width")
+	methodBind := methodBindCubeMeshGetSubdivideWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +149,8 @@ func (o *CubeMesh) GetSubdivideWidth() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector3}], Returns: void
 */
 func (o *CubeMesh) SetSize(size gdnative.Vector3) {
 	//log.Println("Calling CubeMesh.SetSize()")
@@ -138,7 +160,7 @@ func (o *CubeMesh) SetSize(size gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "set_size")
+	methodBind := methodBindCubeMeshSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +170,8 @@ func (o *CubeMesh) SetSize(size gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false divisions int}], Returns: void
+	        Undocumented
+		Args: [{ false divisions int}], Returns: void
 */
 func (o *CubeMesh) SetSubdivideDepth(divisions gdnative.Int) {
 	//log.Println("Calling CubeMesh.SetSubdivideDepth()")
@@ -159,7 +181,7 @@ func (o *CubeMesh) SetSubdivideDepth(divisions gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(divisions)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "set_subdivide_depth")
+	methodBind := methodBindCubeMeshSetSubdivideDepth.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +191,8 @@ func (o *CubeMesh) SetSubdivideDepth(divisions gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false divisions int}], Returns: void
+	        Undocumented
+		Args: [{ false divisions int}], Returns: void
 */
 func (o *CubeMesh) SetSubdivideHeight(divisions gdnative.Int) {
 	//log.Println("Calling CubeMesh.SetSubdivideHeight()")
@@ -180,7 +202,7 @@ func (o *CubeMesh) SetSubdivideHeight(divisions gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(divisions)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "set_subdivide_height")
+	methodBind := methodBindCubeMeshSetSubdivideHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +212,8 @@ func (o *CubeMesh) SetSubdivideHeight(divisions gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false subdivide int}], Returns: void
+	        Undocumented
+		Args: [{ false subdivide int}], Returns: void
 */
 func (o *CubeMesh) SetSubdivideWidth(subdivide gdnative.Int) {
 	//log.Println("Calling CubeMesh.SetSubdivideWidth()")
@@ -201,7 +223,7 @@ func (o *CubeMesh) SetSubdivideWidth(subdivide gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(subdivide)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CubeMesh", "set_subdivide_width")
+	methodBind := methodBindCubeMeshSetSubdivideWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -210,6 +232,26 @@ func (o *CubeMesh) SetSubdivideWidth(subdivide gdnative.Int) {
 
 }
 
+// Size will return the value of the "size" property.
+func (o *CubeMesh) Size() gdnative.Vector3 {
+	return o.GetSize()
+}
+
+// SubdivideDepth will return the value of the "subdivide_depth" property.
+func (o *CubeMesh) SubdivideDepth() gdnative.Int {
+	return o.GetSubdivideDepth()
+}
+
+// SubdivideHeight will return the value of the "subdivide_height" property.
+func (o *CubeMesh) SubdivideHeight() gdnative.Int {
+	return o.GetSubdivideHeight()
+}
+
+// SubdivideWidth will return the value of the "subdivide_width" property.
+func (o *CubeMesh) SubdivideWidth() gdnative.Int {
+	return o.GetSubdivideWidth()
+}
+
 // CubeMeshImplementer is an interface that implements the methods
 // of the CubeMesh class.
 type CubeMeshImplementer interface {
@@ -222,4 +264,8 @@ type CubeMeshImplementer interface {
 	SetSubdivideDepth(divisions gdnative.Int)
 	SetSubdivideHeight(divisions gdnative.Int)
 	SetSubdivideWidth(subdivide gdnative.Int)
+	Size() gdnative.Vector3
+	SubdivideDepth() gdnative.Int
+	SubdivideHeight() gdnative.Int
+	SubdivideWidth() gdnative.Int
 }
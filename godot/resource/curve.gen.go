@@ -1,7 +1,10 @@
-package godot
+package resource
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,30 +25,95 @@ const (
 	CurveTangentModeCount CurveTangentMode = 2
 )
 
-//func NewCurveFromPointer(ptr gdnative.Pointer) Curve {
-func newCurveFromPointer(ptr gdnative.Pointer) Curve {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Curve{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the CurveTangentMode value.
+func (e CurveTangentMode) String() string {
+	switch e {
+	case CurveTangentFree:
+		return "TANGENT_FREE"
+	case CurveTangentLinear:
+		return "TANGENT_LINEAR"
+	case CurveTangentModeCount:
+		return "TANGENT_MODE_COUNT"
+	}
+	return fmt.Sprintf("CurveTangentMode(%d)", int(e))
+}
 
-	return obj
+// ParseCurveTangentMode will return the CurveTangentMode value with the given Godot name.
+func ParseCurveTangentMode(name string) (CurveTangentMode, error) {
+	switch name {
+	case "TANGENT_FREE":
+		return CurveTangentFree, nil
+	case "TANGENT_LINEAR":
+		return CurveTangentLinear, nil
+	case "TANGENT_MODE_COUNT":
+		return CurveTangentModeCount, nil
+	}
+	return 0, fmt.Errorf("invalid CurveTangentMode value %q", name)
 }
 
 /*
 A curve that can be saved and re-used for other objects. By default it ranges between [code]0[/code] and [code]1[/code] on the y-axis and positions points relative to the [code]0.5[/code] y-position.
 */
 type Curve struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorCurve is the class constructor of the Curve class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCurve = gdnative.NewLazyClassConstructor("Curve")
+
+// NewCurve will create a new instance of the Curve class.
+// Curve is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCurve() *Curve {
+	obj := &Curve{}
+	obj.SetBaseObject(classConstructorCurve.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Curve) BaseClass() string {
 	return "Curve"
 }
 
+// Method binds of the Curve class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCurveX_GetData            = gdnative.NewLazyMethodBind("Curve", "_get_data")
+	methodBindCurveX_SetData            = gdnative.NewLazyMethodBind("Curve", "_set_data")
+	methodBindCurveAddPoint             = gdnative.NewLazyMethodBind("Curve", "add_point")
+	methodBindCurveBake                 = gdnative.NewLazyMethodBind("Curve", "bake")
+	methodBindCurveCleanDupes           = gdnative.NewLazyMethodBind("Curve", "clean_dupes")
+	methodBindCurveClearPoints          = gdnative.NewLazyMethodBind("Curve", "clear_points")
+	methodBindCurveGetBakeResolution    = gdnative.NewLazyMethodBind("Curve", "get_bake_resolution")
+	methodBindCurveGetMaxValue          = gdnative.NewLazyMethodBind("Curve", "get_max_value")
+	methodBindCurveGetMinValue          = gdnative.NewLazyMethodBind("Curve", "get_min_value")
+	methodBindCurveGetPointLeftMode     = gdnative.NewLazyMethodBind("Curve", "get_point_left_mode")
+	methodBindCurveGetPointLeftTangent  = gdnative.NewLazyMethodBind("Curve", "get_point_left_tangent")
+	methodBindCurveGetPointPosition     = gdnative.NewLazyMethodBind("Curve", "get_point_position")
+	methodBindCurveGetPointRightMode    = gdnative.NewLazyMethodBind("Curve", "get_point_right_mode")
+	methodBindCurveGetPointRightTangent = gdnative.NewLazyMethodBind("Curve", "get_point_right_tangent")
+	methodBindCurveInterpolate          = gdnative.NewLazyMethodBind("Curve", "interpolate")
+	methodBindCurveInterpolateBaked     = gdnative.NewLazyMethodBind("Curve", "interpolate_baked")
+	methodBindCurveRemovePoint          = gdnative.NewLazyMethodBind("Curve", "remove_point")
+	methodBindCurveSetBakeResolution    = gdnative.NewLazyMethodBind("Curve", "set_bake_resolution")
+	methodBindCurveSetMaxValue          = gdnative.NewLazyMethodBind("Curve", "set_max_value")
+	methodBindCurveSetMinValue          = gdnative.NewLazyMethodBind("Curve", "set_min_value")
+	methodBindCurveSetPointLeftMode     = gdnative.NewLazyMethodBind("Curve", "set_point_left_mode")
+	methodBindCurveSetPointLeftTangent  = gdnative.NewLazyMethodBind("Curve", "set_point_left_tangent")
+	methodBindCurveSetPointOffset       = gdnative.NewLazyMethodBind("Curve", "set_point_offset")
+	methodBindCurveSetPointRightMode    = gdnative.NewLazyMethodBind("Curve", "set_point_right_mode")
+	methodBindCurveSetPointRightTangent = gdnative.NewLazyMethodBind("Curve", "set_point_right_tangent")
+	methodBindCurveSetPointValue        = gdnative.NewLazyMethodBind("Curve", "set_point_value")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *Curve) X_GetData() gdnative.Array {
 	//log.Println("Calling Curve.X_GetData()")
@@ -54,7 +122,7 @@ func (o *Curve) X_GetData() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "_get_data")
+	methodBind := methodBindCurveX_GetData.Get()
 
 	// Call the parent method.
 	// Array
@@ -67,8 +135,8 @@ func (o *Curve) X_GetData() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false data Array}], Returns: void
+	        Undocumented
+		Args: [{ false data Array}], Returns: void
 */
 func (o *Curve) X_SetData(data gdnative.Array) {
 	//log.Println("Calling Curve.X_SetData()")
@@ -78,7 +146,7 @@ func (o *Curve) X_SetData(data gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "_set_data")
+	methodBind := methodBindCurveX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -88,8 +156,8 @@ func (o *Curve) X_SetData(data gdnative.Array) {
 }
 
 /*
-        Adds a point to the curve. For each side, if the [code]*_mode[/code] is [code]TANGENT_LINEAR[/code], the [code]*_tangent[/code] angle (in degrees) uses the slope of the curve halfway to the adjacent point. Allows custom assignments to the [code]*_tangent[/code] angle if [code]*_mode[/code] is set to [code]TANGENT_FREE[/code].
-	Args: [{ false position Vector2} {0 true left_tangent float} {0 true right_tangent float} {0 true left_mode int} {0 true right_mode int}], Returns: int
+	        Adds a point to the curve. For each side, if the [code]*_mode[/code] is [code]TANGENT_LINEAR[/code], the [code]*_tangent[/code] angle (in degrees) uses the slope of the curve halfway to the adjacent point. Allows custom assignments to the [code]*_tangent[/code] angle if [code]*_mode[/code] is set to [code]TANGENT_FREE[/code].
+		Args: [{ false position Vector2} {0 true left_tangent float} {0 true right_tangent float} {0 true left_mode int} {0 true right_mode int}], Returns: int
 */
 func (o *Curve) AddPoint(position gdnative.Vector2, leftTangent gdnative.Real, rightTangent gdnative.Real, leftMode gdnative.Int, rightMode gdnative.Int) gdnative.Int {
 	//log.Println("Calling Curve.AddPoint()")
@@ -103,7 +171,7 @@ func (o *Curve) AddPoint(position gdnative.Vector2, leftTangent gdnative.Real, r
 	ptrArguments[4] = gdnative.NewPointerFromInt(rightMode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "add_point")
+	methodBind := methodBindCurveAddPoint.Get()
 
 	// Call the parent method.
 	// int
@@ -116,8 +184,8 @@ func (o *Curve) AddPoint(position gdnative.Vector2, leftTangent gdnative.Real, r
 }
 
 /*
-        Recomputes the baked cache of points for the curve.
-	Args: [], Returns: void
+	        Recomputes the baked cache of points for the curve.
+		Args: [], Returns: void
 */
 func (o *Curve) Bake() {
 	//log.Println("Calling Curve.Bake()")
@@ -126,7 +194,7 @@ func (o *Curve) Bake() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "bake")
+	methodBind := methodBindCurveBake.Get()
 
 	// Call the parent method.
 	// void
@@ -136,8 +204,8 @@ func (o *Curve) Bake() {
 }
 
 /*
-        Removes points that are closer than [code]CMP_EPSILON[/code] (0.00001) units to their neighbor on the curve.
-	Args: [], Returns: void
+	        Removes points that are closer than [code]CMP_EPSILON[/code] (0.00001) units to their neighbor on the curve.
+		Args: [], Returns: void
 */
 func (o *Curve) CleanDupes() {
 	//log.Println("Calling Curve.CleanDupes()")
@@ -146,7 +214,7 @@ func (o *Curve) CleanDupes() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "clean_dupes")
+	methodBind := methodBindCurveCleanDupes.Get()
 
 	// Call the parent method.
 	// void
@@ -156,8 +224,8 @@ func (o *Curve) CleanDupes() {
 }
 
 /*
-        Removes all points from the curve.
-	Args: [], Returns: void
+	        Removes all points from the curve.
+		Args: [], Returns: void
 */
 func (o *Curve) ClearPoints() {
 	//log.Println("Calling Curve.ClearPoints()")
@@ -166,7 +234,7 @@ func (o *Curve) ClearPoints() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "clear_points")
+	methodBind := methodBindCurveClearPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -176,8 +244,8 @@ func (o *Curve) ClearPoints() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Curve) GetBakeResolution() gdnative.Int {
 	//log.Println("Calling Curve.GetBakeResolution()")
@@ -186,7 +254,7 @@ func (o *Curve) GetBakeResolution() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_bake_resolution")
+	methodBind := methodBindCurveGetBakeResolution.Get()
 
 	// Call the parent method.
 	// int
@@ -199,8 +267,8 @@ func (o *Curve) GetBakeResolution() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Curve) GetMaxValue() gdnative.Real {
 	//log.Println("Calling Curve.GetMaxValue()")
@@ -209,7 +277,7 @@ func (o *Curve) GetMaxValue() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_max_value")
+	methodBind := methodBindCurveGetMaxValue.Get()
 
 	// Call the parent method.
 	// float
@@ -222,8 +290,8 @@ func (o *Curve) GetMaxValue() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Curve) GetMinValue() gdnative.Real {
 	//log.Println("Calling Curve.GetMinValue()")
@@ -232,7 +300,7 @@ func (o *Curve) GetMinValue() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_min_value")
+	methodBind := methodBindCurveGetMinValue.Get()
 
 	// Call the parent method.
 	// float
@@ -245,8 +313,8 @@ func (o *Curve) GetMinValue() gdnative.Real {
 }
 
 /*
-        Returns the left [code]TangentMode[/code] for the point at [code]index[/code].
-	Args: [{ false index int}], Returns: enum.Curve::TangentMode
+	        Returns the left [code]TangentMode[/code] for the point at [code]index[/code].
+		Args: [{ false index int}], Returns: enum.Curve::TangentMode
 */
 func (o *Curve) GetPointLeftMode(index gdnative.Int) CurveTangentMode {
 	//log.Println("Calling Curve.GetPointLeftMode()")
@@ -256,7 +324,7 @@ func (o *Curve) GetPointLeftMode(index gdnative.Int) CurveTangentMode {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_point_left_mode")
+	methodBind := methodBindCurveGetPointLeftMode.Get()
 
 	// Call the parent method.
 	// enum.Curve::TangentMode
@@ -269,8 +337,8 @@ func (o *Curve) GetPointLeftMode(index gdnative.Int) CurveTangentMode {
 }
 
 /*
-        Returns the left tangent angle (in degrees) for the point at [code]index[/code].
-	Args: [{ false index int}], Returns: float
+	        Returns the left tangent angle (in degrees) for the point at [code]index[/code].
+		Args: [{ false index int}], Returns: float
 */
 func (o *Curve) GetPointLeftTangent(index gdnative.Int) gdnative.Real {
 	//log.Println("Calling Curve.GetPointLeftTangent()")
@@ -280,7 +348,7 @@ func (o *Curve) GetPointLeftTangent(index gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_point_left_tangent")
+	methodBind := methodBindCurveGetPointLeftTangent.Get()
 
 	// Call the parent method.
 	// float
@@ -293,8 +361,8 @@ func (o *Curve) GetPointLeftTangent(index gdnative.Int) gdnative.Real {
 }
 
 /*
-        Returns the curve coordinates for the point at [code]index[/code].
-	Args: [{ false index int}], Returns: Vector2
+	        Returns the curve coordinates for the point at [code]index[/code].
+		Args: [{ false index int}], Returns: Vector2
 */
 func (o *Curve) GetPointPosition(index gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Curve.GetPointPosition()")
@@ -304,7 +372,7 @@ func (o *Curve) GetPointPosition(index gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_point_position")
+	methodBind := methodBindCurveGetPointPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -317,8 +385,8 @@ func (o *Curve) GetPointPosition(index gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Returns the right [code]TangentMode[/code] for the point at [code]index[/code].
-	Args: [{ false index int}], Returns: enum.Curve::TangentMode
+	        Returns the right [code]TangentMode[/code] for the point at [code]index[/code].
+		Args: [{ false index int}], Returns: enum.Curve::TangentMode
 */
 func (o *Curve) GetPointRightMode(index gdnative.Int) CurveTangentMode {
 	//log.Println("Calling Curve.GetPointRightMode()")
@@ -328,7 +396,7 @@ func (o *Curve) GetPointRightMode(index gdnative.Int) CurveTangentMode {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_point_right_mode")
+	methodBind := methodBindCurveGetPointRightMode.Get()
 
 	// Call the parent method.
 	// enum.Curve::TangentMode
@@ -341,8 +409,8 @@ func (o *Curve) GetPointRightMode(index gdnative.Int) CurveTangentMode {
 }
 
 /*
-        Returns the right tangent angle (in degrees) for the point at [code]index[/code].
-	Args: [{ false index int}], Returns: float
+	        Returns the right tangent angle (in degrees) for the point at [code]index[/code].
+		Args: [{ false index int}], Returns: float
 */
 func (o *Curve) GetPointRightTangent(index gdnative.Int) gdnative.Real {
 	//log.Println("Calling Curve.GetPointRightTangent()")
@@ -352,7 +420,7 @@ func (o *Curve) GetPointRightTangent(index gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "get_point_right_tangent")
+	methodBind := methodBindCurveGetPointRightTangent.Get()
 
 	// Call the parent method.
 	// float
@@ -365,8 +433,8 @@ func (o *Curve) GetPointRightTangent(index gdnative.Int) gdnative.Real {
 }
 
 /*
-        Returns the y value for the point that would exist at x-position [code]offset[/code] along the curve.
-	Args: [{ false offset float}], Returns: float
+	        Returns the y value for the point that would exist at x-position [code]offset[/code] along the curve.
+		Args: [{ false offset float}], Returns: float
 */
 func (o *Curve) Interpolate(offset gdnative.Real) gdnative.Real {
 	//log.Println("Calling Curve.Interpolate()")
@@ -376,7 +444,7 @@ func (o *Curve) Interpolate(offset gdnative.Real) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "interpolate")
+	methodBind := methodBindCurveInterpolate.Get()
 
 	// Call the parent method.
 	// float
@@ -389,8 +457,8 @@ func (o *Curve) Interpolate(offset gdnative.Real) gdnative.Real {
 }
 
 /*
-        Returns the y value for the point that would exist at x-position [code]offset[/code] along the curve using the baked cache. Bakes the curve's points if not already baked.
-	Args: [{ false offset float}], Returns: float
+	        Returns the y value for the point that would exist at x-position [code]offset[/code] along the curve using the baked cache. Bakes the curve's points if not already baked.
+		Args: [{ false offset float}], Returns: float
 */
 func (o *Curve) InterpolateBaked(offset gdnative.Real) gdnative.Real {
 	//log.Println("Calling Curve.InterpolateBaked()")
@@ -400,7 +468,7 @@ func (o *Curve) InterpolateBaked(offset gdnative.Real) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "interpolate_baked")
+	methodBind := methodBindCurveInterpolateBaked.Get()
 
 	// Call the parent method.
 	// float
@@ -413,8 +481,8 @@ func (o *Curve) InterpolateBaked(offset gdnative.Real) gdnative.Real {
 }
 
 /*
-        Removes the point at [code]index[/code] from the curve.
-	Args: [{ false index int}], Returns: void
+	        Removes the point at [code]index[/code] from the curve.
+		Args: [{ false index int}], Returns: void
 */
 func (o *Curve) RemovePoint(index gdnative.Int) {
 	//log.Println("Calling Curve.RemovePoint()")
@@ -424,7 +492,7 @@ func (o *Curve) RemovePoint(index gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "remove_point")
+	methodBind := methodBindCurveRemovePoint.Get()
 
 	// Call the parent method.
 	// void
@@ -434,8 +502,8 @@ func (o *Curve) RemovePoint(index gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false resolution int}], Returns: void
+	        Undocumented
+		Args: [{ false resolution int}], Returns: void
 */
 func (o *Curve) SetBakeResolution(resolution gdnative.Int) {
 	//log.Println("Calling Curve.SetBakeResolution()")
@@ -445,7 +513,7 @@ func (o *Curve) SetBakeResolution(resolution gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(resolution)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_bake_resolution")
+	methodBind := methodBindCurveSetBakeResolution.Get()
 
 	// Call the parent method.
 	// void
@@ -455,8 +523,8 @@ func (o *Curve) SetBakeResolution(resolution gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false max float}], Returns: void
+	        Undocumented
+		Args: [{ false max float}], Returns: void
 */
 func (o *Curve) SetMaxValue(max gdnative.Real) {
 	//log.Println("Calling Curve.SetMaxValue()")
@@ -466,7 +534,7 @@ func (o *Curve) SetMaxValue(max gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(max)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_max_value")
+	methodBind := methodBindCurveSetMaxValue.Get()
 
 	// Call the parent method.
 	// void
@@ -476,8 +544,8 @@ func (o *Curve) SetMaxValue(max gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false min float}], Returns: void
+	        Undocumented
+		Args: [{ false min float}], Returns: void
 */
 func (o *Curve) SetMinValue(min gdnative.Real) {
 	//log.Println("Calling Curve.SetMinValue()")
@@ -487,7 +555,7 @@ func (o *Curve) SetMinValue(min gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(min)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_min_value")
+	methodBind := methodBindCurveSetMinValue.Get()
 
 	// Call the parent method.
 	// void
@@ -497,19 +565,19 @@ func (o *Curve) SetMinValue(min gdnative.Real) {
 }
 
 /*
-        Sets the left [code]TangentMode[/code] for the point at [code]index[/code] to [code]mode[/code].
-	Args: [{ false index int} { false mode int}], Returns: void
+	        Sets the left [code]TangentMode[/code] for the point at [code]index[/code] to [code]mode[/code].
+		Args: [{ false index int} { false mode enum.Curve::TangentMode}], Returns: void
 */
-func (o *Curve) SetPointLeftMode(index gdnative.Int, mode gdnative.Int) {
+func (o *Curve) SetPointLeftMode(index gdnative.Int, mode CurveTangentMode) {
 	//log.Println("Calling Curve.SetPointLeftMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
-	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_point_left_mode")
+	methodBind := methodBindCurveSetPointLeftMode.Get()
 
 	// Call the parent method.
 	// void
@@ -519,8 +587,8 @@ func (o *Curve) SetPointLeftMode(index gdnative.Int, mode gdnative.Int) {
 }
 
 /*
-        Sets the left tangent angle for the point at [code]index[/code] to [code]tangent[/code].
-	Args: [{ false index int} { false tangent float}], Returns: void
+	        Sets the left tangent angle for the point at [code]index[/code] to [code]tangent[/code].
+		Args: [{ false index int} { false tangent float}], Returns: void
 */
 func (o *Curve) SetPointLeftTangent(index gdnative.Int, tangent gdnative.Real) {
 	//log.Println("Calling Curve.SetPointLeftTangent()")
@@ -531,7 +599,7 @@ func (o *Curve) SetPointLeftTangent(index gdnative.Int, tangent gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(tangent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_point_left_tangent")
+	methodBind := methodBindCurveSetPointLeftTangent.Get()
 
 	// Call the parent method.
 	// void
@@ -541,8 +609,8 @@ func (o *Curve) SetPointLeftTangent(index gdnative.Int, tangent gdnative.Real) {
 }
 
 /*
-        Sets the offset from [code]0.5[/code]
-	Args: [{ false index int} { false offset float}], Returns: int
+	        Sets the offset from [code]0.5[/code]
+		Args: [{ false index int} { false offset float}], Returns: int
 */
 func (o *Curve) SetPointOffset(index gdnative.Int, offset gdnative.Real) gdnative.Int {
 	//log.Println("Calling Curve.SetPointOffset()")
@@ -553,7 +621,7 @@ func (o *Curve) SetPointOffset(index gdnative.Int, offset gdnative.Real) gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_point_offset")
+	methodBind := methodBindCurveSetPointOffset.Get()
 
 	// Call the parent method.
 	// int
@@ -566,19 +634,19 @@ func (o *Curve) SetPointOffset(index gdnative.Int, offset gdnative.Real) gdnativ
 }
 
 /*
-        Sets the right [code]TangentMode[/code] for the point at [code]index[/code] to [code]mode[/code].
-	Args: [{ false index int} { false mode int}], Returns: void
+	        Sets the right [code]TangentMode[/code] for the point at [code]index[/code] to [code]mode[/code].
+		Args: [{ false index int} { false mode enum.Curve::TangentMode}], Returns: void
 */
-func (o *Curve) SetPointRightMode(index gdnative.Int, mode gdnative.Int) {
+func (o *Curve) SetPointRightMode(index gdnative.Int, mode CurveTangentMode) {
 	//log.Println("Calling Curve.SetPointRightMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
-	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_point_right_mode")
+	methodBind := methodBindCurveSetPointRightMode.Get()
 
 	// Call the parent method.
 	// void
@@ -588,8 +656,8 @@ func (o *Curve) SetPointRightMode(index gdnative.Int, mode gdnative.Int) {
 }
 
 /*
-        Sets the right tangent angle for the point at [code]index[/code] to [code]tangent[/code].
-	Args: [{ false index int} { false tangent float}], Returns: void
+	        Sets the right tangent angle for the point at [code]index[/code] to [code]tangent[/code].
+		Args: [{ false index int} { false tangent float}], Returns: void
 */
 func (o *Curve) SetPointRightTangent(index gdnative.Int, tangent gdnative.Real) {
 	//log.Println("Calling Curve.SetPointRightTangent()")
@@ -600,7 +668,7 @@ func (o *Curve) SetPointRightTangent(index gdnative.Int, tangent gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(tangent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_point_right_tangent")
+	methodBind := methodBindCurveSetPointRightTangent.Get()
 
 	// Call the parent method.
 	// void
@@ -610,8 +678,8 @@ func (o *Curve) SetPointRightTangent(index gdnative.Int, tangent gdnative.Real)
 }
 
 /*
-        Assigns the vertical position [code]y[/code] to the point at [code]index[/code].
-	Args: [{ false index int} { false y float}], Returns: void
+	        Assigns the vertical position [code]y[/code] to the point at [code]index[/code].
+		Args: [{ false index int} { false y float}], Returns: void
 */
 func (o *Curve) SetPointValue(index gdnative.Int, y gdnative.Real) {
 	//log.Println("Calling Curve.SetPointValue()")
@@ -622,7 +690,7 @@ func (o *Curve) SetPointValue(index gdnative.Int, y gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(y)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve", "set_point_value")
+	methodBind := methodBindCurveSetPointValue.Get()
 
 	// Call the parent method.
 	// void
@@ -631,10 +699,51 @@ func (o *Curve) SetPointValue(index gdnative.Int, y gdnative.Real) {
 
 }
 
+// AddPointWithDefaults will call AddPoint using the default values for: left_tangent, right_tangent, left_mode, right_mode.
+func (o *Curve) AddPointWithDefaults(position gdnative.Vector2) gdnative.Int {
+	return o.AddPoint(position, 0, 0, 0, 0)
+}
+
+// BakeResolution will return the value of the "bake_resolution" property.
+func (o *Curve) BakeResolution() gdnative.Int {
+	return o.GetBakeResolution()
+}
+
+// MaxValue will return the value of the "max_value" property.
+func (o *Curve) MaxValue() gdnative.Real {
+	return o.GetMaxValue()
+}
+
+// MinValue will return the value of the "min_value" property.
+func (o *Curve) MinValue() gdnative.Real {
+	return o.GetMinValue()
+}
+
+// Signals of the Curve class.
+const (
+	CurveSignalRangeChanged gdnative.String = "range_changed"
+)
+
+// ConnectRangeChanged will connect the "range_changed" signal to the given method of the target object.
+func (o *Curve) ConnectRangeChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(CurveSignalRangeChanged, target, method, binds, 0)
+}
+
+// EmitRangeChanged will emit the "range_changed" signal.
+func (o *Curve) EmitRangeChanged() error {
+
+	ret, err := o.EmitSignal(CurveSignalRangeChanged)
+	ret.Destroy()
+	return err
+}
+
 // CurveImplementer is an interface that implements the methods
 // of the Curve class.
 type CurveImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetData() gdnative.Array
 	X_SetData(data gdnative.Array)
 	AddPoint(position gdnative.Vector2, leftTangent gdnative.Real, rightTangent gdnative.Real, leftMode gdnative.Int, rightMode gdnative.Int) gdnative.Int
@@ -644,8 +753,10 @@ type CurveImplementer interface {
 	GetBakeResolution() gdnative.Int
 	GetMaxValue() gdnative.Real
 	GetMinValue() gdnative.Real
+	GetPointLeftMode(index gdnative.Int) CurveTangentMode
 	GetPointLeftTangent(index gdnative.Int) gdnative.Real
 	GetPointPosition(index gdnative.Int) gdnative.Vector2
+	GetPointRightMode(index gdnative.Int) CurveTangentMode
 	GetPointRightTangent(index gdnative.Int) gdnative.Real
 	Interpolate(offset gdnative.Real) gdnative.Real
 	InterpolateBaked(offset gdnative.Real) gdnative.Real
@@ -653,10 +764,16 @@ type CurveImplementer interface {
 	SetBakeResolution(resolution gdnative.Int)
 	SetMaxValue(max gdnative.Real)
 	SetMinValue(min gdnative.Real)
-	SetPointLeftMode(index gdnative.Int, mode gdnative.Int)
+	SetPointLeftMode(index gdnative.Int, mode CurveTangentMode)
 	SetPointLeftTangent(index gdnative.Int, tangent gdnative.Real)
 	SetPointOffset(index gdnative.Int, offset gdnative.Real) gdnative.Int
-	SetPointRightMode(index gdnative.Int, mode gdnative.Int)
+	SetPointRightMode(index gdnative.Int, mode CurveTangentMode)
 	SetPointRightTangent(index gdnative.Int, tangent gdnative.Real)
 	SetPointValue(index gdnative.Int, y gdnative.Real)
+	AddPointWithDefaults(position gdnative.Vector2) gdnative.Int
+	BakeResolution() gdnative.Int
+	MaxValue() gdnative.Real
+	MinValue() gdnative.Real
+	ConnectRangeChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitRangeChanged() error
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,63 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCurve2DFromPointer(ptr gdnative.Pointer) Curve2D {
-func newCurve2DFromPointer(ptr gdnative.Pointer) Curve2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Curve2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This class describes a Bezier curve in 2D space. It is mainly used to give a shape to a [Path2D], but can be manually sampled for other purposes. It keeps a cache of precalculated points along the curve, to speed further calculations up.
 */
 type Curve2D struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorCurve2D is the class constructor of the Curve2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCurve2D = gdnative.NewLazyClassConstructor("Curve2D")
+
+// NewCurve2D will create a new instance of the Curve2D class.
+// Curve2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCurve2D() *Curve2D {
+	obj := &Curve2D{}
+	obj.SetBaseObject(classConstructorCurve2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Curve2D) BaseClass() string {
 	return "Curve2D"
 }
 
+// Method binds of the Curve2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCurve2DX_GetData        = gdnative.NewLazyMethodBind("Curve2D", "_get_data")
+	methodBindCurve2DX_SetData        = gdnative.NewLazyMethodBind("Curve2D", "_set_data")
+	methodBindCurve2DAddPoint         = gdnative.NewLazyMethodBind("Curve2D", "add_point")
+	methodBindCurve2DClearPoints      = gdnative.NewLazyMethodBind("Curve2D", "clear_points")
+	methodBindCurve2DGetBakeInterval  = gdnative.NewLazyMethodBind("Curve2D", "get_bake_interval")
+	methodBindCurve2DGetBakedLength   = gdnative.NewLazyMethodBind("Curve2D", "get_baked_length")
+	methodBindCurve2DGetBakedPoints   = gdnative.NewLazyMethodBind("Curve2D", "get_baked_points")
+	methodBindCurve2DGetPointCount    = gdnative.NewLazyMethodBind("Curve2D", "get_point_count")
+	methodBindCurve2DGetPointIn       = gdnative.NewLazyMethodBind("Curve2D", "get_point_in")
+	methodBindCurve2DGetPointOut      = gdnative.NewLazyMethodBind("Curve2D", "get_point_out")
+	methodBindCurve2DGetPointPosition = gdnative.NewLazyMethodBind("Curve2D", "get_point_position")
+	methodBindCurve2DInterpolate      = gdnative.NewLazyMethodBind("Curve2D", "interpolate")
+	methodBindCurve2DInterpolateBaked = gdnative.NewLazyMethodBind("Curve2D", "interpolate_baked")
+	methodBindCurve2DInterpolatef     = gdnative.NewLazyMethodBind("Curve2D", "interpolatef")
+	methodBindCurve2DRemovePoint      = gdnative.NewLazyMethodBind("Curve2D", "remove_point")
+	methodBindCurve2DSetBakeInterval  = gdnative.NewLazyMethodBind("Curve2D", "set_bake_interval")
+	methodBindCurve2DSetPointIn       = gdnative.NewLazyMethodBind("Curve2D", "set_point_in")
+	methodBindCurve2DSetPointOut      = gdnative.NewLazyMethodBind("Curve2D", "set_point_out")
+	methodBindCurve2DSetPointPosition = gdnative.NewLazyMethodBind("Curve2D", "set_point_position")
+	methodBindCurve2DTessellate       = gdnative.NewLazyMethodBind("Curve2D", "tessellate")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *Curve2D) X_GetData() gdnative.Dictionary {
 	//log.Println("Calling Curve2D.X_GetData()")
@@ -45,7 +79,7 @@ func (o *Curve2D) X_GetData() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "_get_data")
+	methodBind := methodBindCurve2DX_GetData.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -58,8 +92,8 @@ func (o *Curve2D) X_GetData() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Dictionary}], Returns: void
 */
 func (o *Curve2D) X_SetData(arg0 gdnative.Dictionary) {
 	//log.Println("Calling Curve2D.X_SetData()")
@@ -69,7 +103,7 @@ func (o *Curve2D) X_SetData(arg0 gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "_set_data")
+	methodBind := methodBindCurve2DX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +113,8 @@ func (o *Curve2D) X_SetData(arg0 gdnative.Dictionary) {
 }
 
 /*
-        Adds a point to a curve, at "position", with control points "in" and "out". If "at_position" is given, the point is inserted before the point number "at_position", moving that point (and every point after) after the inserted point. If "at_position" is not given, or is an illegal value (at_position <0 or at_position >= [method get_point_count]), the point will be appended at the end of the point list.
-	Args: [{ false position Vector2} {(0, 0) true in Vector2} {(0, 0) true out Vector2} {-1 true at_position int}], Returns: void
+	        Adds a point to a curve, at "position", with control points "in" and "out". If "at_position" is given, the point is inserted before the point number "at_position", moving that point (and every point after) after the inserted point. If "at_position" is not given, or is an illegal value (at_position <0 or at_position >= [method get_point_count]), the point will be appended at the end of the point list.
+		Args: [{ false position Vector2} {(0, 0) true in Vector2} {(0, 0) true out Vector2} {-1 true at_position int}], Returns: void
 */
 func (o *Curve2D) AddPoint(position gdnative.Vector2, in gdnative.Vector2, out gdnative.Vector2, atPosition gdnative.Int) {
 	//log.Println("Calling Curve2D.AddPoint()")
@@ -93,7 +127,7 @@ func (o *Curve2D) AddPoint(position gdnative.Vector2, in gdnative.Vector2, out g
 	ptrArguments[3] = gdnative.NewPointerFromInt(atPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "add_point")
+	methodBind := methodBindCurve2DAddPoint.Get()
 
 	// Call the parent method.
 	// void
@@ -103,8 +137,8 @@ func (o *Curve2D) AddPoint(position gdnative.Vector2, in gdnative.Vector2, out g
 }
 
 /*
-        Removes all points from the curve.
-	Args: [], Returns: void
+	        Removes all points from the curve.
+		Args: [], Returns: void
 */
 func (o *Curve2D) ClearPoints() {
 	//log.Println("Calling Curve2D.ClearPoints()")
@@ -113,7 +147,7 @@ func (o *Curve2D) ClearPoints() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "clear_points")
+	methodBind := methodBindCurve2DClearPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +157,8 @@ func (o *Curve2D) ClearPoints() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Curve2D) GetBakeInterval() gdnative.Real {
 	//log.Println("Calling Curve2D.GetBakeInterval()")
@@ -133,7 +167,7 @@ func (o *Curve2D) GetBakeInterval() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "get_bake_interval")
+	methodBind := methodBindCurve2DGetBakeInterval.Get()
 
 	// Call the parent method.
 	// float
@@ -146,8 +180,8 @@ func (o *Curve2D) GetBakeInterval() gdnative.Real {
 }
 
 /*
-        Returns the total length of the curve, based on the cached points. Given enough density (see [method set_bake_interval]), it should be approximate enough.
-	Args: [], Returns: float
+	        Returns the total length of the curve, based on the cached points. Given enough density (see [method set_bake_interval]), it should be approximate enough.
+		Args: [], Returns: float
 */
 func (o *Curve2D) GetBakedLength() gdnative.Real {
 	//log.Println("Calling Curve2D.GetBakedLength()")
@@ -156,7 +190,7 @@ func (o *Curve2D) GetBakedLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "get_baked_length")
+	methodBind := methodBindCurve2DGetBakedLength.Get()
 
 	// Call the parent method.
 	// float
@@ -169,8 +203,8 @@ func (o *Curve2D) GetBakedLength() gdnative.Real {
 }
 
 /*
-        Returns the cache of points as a [PoolVector2Array].
-	Args: [], Returns: PoolVector2Array
+	        Returns the cache of points as a [PoolVector2Array].
+		Args: [], Returns: PoolVector2Array
 */
 func (o *Curve2D) GetBakedPoints() gdnative.PoolVector2Array {
 	//log.Println("Calling Curve2D.GetBakedPoints()")
@@ -179,7 +213,7 @@ func (o *Curve2D) GetBakedPoints() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "get_baked_points")
+	methodBind := methodBindCurve2DGetBakedPoints.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -192,8 +226,8 @@ func (o *Curve2D) GetBakedPoints() gdnative.PoolVector2Array {
 }
 
 /*
-        Returns the number of points describing the curve.
-	Args: [], Returns: int
+	        Returns the number of points describing the curve.
+		Args: [], Returns: int
 */
 func (o *Curve2D) GetPointCount() gdnative.Int {
 	//log.Println("Calling Curve2D.GetPointCount()")
@@ -202,7 +236,7 @@ func (o *Curve2D) GetPointCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "get_point_count")
+	methodBind := methodBindCurve2DGetPointCount.Get()
 
 	// Call the parent method.
 	// int
@@ -215,8 +249,8 @@ func (o *Curve2D) GetPointCount() gdnative.Int {
 }
 
 /*
-        Returns the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0).
-	Args: [{ false idx int}], Returns: Vector2
+	        Returns the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0).
+		Args: [{ false idx int}], Returns: Vector2
 */
 func (o *Curve2D) GetPointIn(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Curve2D.GetPointIn()")
@@ -226,7 +260,7 @@ func (o *Curve2D) GetPointIn(idx gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "get_point_in")
+	methodBind := methodBindCurve2DGetPointIn.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -239,8 +273,8 @@ func (o *Curve2D) GetPointIn(idx gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Returns the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0).
-	Args: [{ false idx int}], Returns: Vector2
+	        Returns the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0).
+		Args: [{ false idx int}], Returns: Vector2
 */
 func (o *Curve2D) GetPointOut(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Curve2D.GetPointOut()")
@@ -250,7 +284,7 @@ func (o *Curve2D) GetPointOut(idx gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "get_point_out")
+	methodBind := methodBindCurve2DGetPointOut.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -263,8 +297,8 @@ func (o *Curve2D) GetPointOut(idx gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Returns the position of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0).
-	Args: [{ false idx int}], Returns: Vector2
+	        Returns the position of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0).
+		Args: [{ false idx int}], Returns: Vector2
 */
 func (o *Curve2D) GetPointPosition(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling Curve2D.GetPointPosition()")
@@ -274,7 +308,7 @@ func (o *Curve2D) GetPointPosition(idx gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "get_point_position")
+	methodBind := methodBindCurve2DGetPointPosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -287,8 +321,8 @@ func (o *Curve2D) GetPointPosition(idx gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Returns the position between the vertex "idx" and the vertex "idx"+1, where "t" controls if the point is the first vertex (t = 0.0), the last vertex (t = 1.0), or in between. Values of "t" outside the range (0.0 >= t <=1) give strange, but predictable results. If "idx" is out of bounds it is truncated to the first or last vertex, and "t" is ignored. If the curve has no points, the function sends an error to the console, and returns (0, 0).
-	Args: [{ false idx int} { false t float}], Returns: Vector2
+	        Returns the position between the vertex "idx" and the vertex "idx"+1, where "t" controls if the point is the first vertex (t = 0.0), the last vertex (t = 1.0), or in between. Values of "t" outside the range (0.0 >= t <=1) give strange, but predictable results. If "idx" is out of bounds it is truncated to the first or last vertex, and "t" is ignored. If the curve has no points, the function sends an error to the console, and returns (0, 0).
+		Args: [{ false idx int} { false t float}], Returns: Vector2
 */
 func (o *Curve2D) Interpolate(idx gdnative.Int, t gdnative.Real) gdnative.Vector2 {
 	//log.Println("Calling Curve2D.Interpolate()")
@@ -299,7 +333,7 @@ func (o *Curve2D) Interpolate(idx gdnative.Int, t gdnative.Real) gdnative.Vector
 	ptrArguments[1] = gdnative.NewPointerFromReal(t)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "interpolate")
+	methodBind := methodBindCurve2DInterpolate.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -312,8 +346,8 @@ func (o *Curve2D) Interpolate(idx gdnative.Int, t gdnative.Real) gdnative.Vector
 }
 
 /*
-        Returns a point within the curve at position "offset", where "offset" is measured as a pixel distance along the curve. To do that, it finds the two cached points where the "offset" lies between, then interpolates the values. This interpolation is cubic if "cubic" is set to true, or linear if set to false. Cubic interpolation tends to follow the curves better, but linear is faster (and often, precise enough).
-	Args: [{ false offset float} {False true cubic bool}], Returns: Vector2
+	        Returns a point within the curve at position "offset", where "offset" is measured as a pixel distance along the curve. To do that, it finds the two cached points where the "offset" lies between, then interpolates the values. This interpolation is cubic if "cubic" is set to true, or linear if set to false. Cubic interpolation tends to follow the curves better, but linear is faster (and often, precise enough).
+		Args: [{ false offset float} {False true cubic bool}], Returns: Vector2
 */
 func (o *Curve2D) InterpolateBaked(offset gdnative.Real, cubic gdnative.Bool) gdnative.Vector2 {
 	//log.Println("Calling Curve2D.InterpolateBaked()")
@@ -324,7 +358,7 @@ func (o *Curve2D) InterpolateBaked(offset gdnative.Real, cubic gdnative.Bool) gd
 	ptrArguments[1] = gdnative.NewPointerFromBool(cubic)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "interpolate_baked")
+	methodBind := methodBindCurve2DInterpolateBaked.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -337,8 +371,8 @@ func (o *Curve2D) InterpolateBaked(offset gdnative.Real, cubic gdnative.Bool) gd
 }
 
 /*
-        Returns the position at the vertex "fofs". It calls [method interpolate] using the integer part of fofs as "idx", and its fractional part as "t".
-	Args: [{ false fofs float}], Returns: Vector2
+	        Returns the position at the vertex "fofs". It calls [method interpolate] using the integer part of fofs as "idx", and its fractional part as "t".
+		Args: [{ false fofs float}], Returns: Vector2
 */
 func (o *Curve2D) Interpolatef(fofs gdnative.Real) gdnative.Vector2 {
 	//log.Println("Calling Curve2D.Interpolatef()")
@@ -348,7 +382,7 @@ func (o *Curve2D) Interpolatef(fofs gdnative.Real) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "interpolatef")
+	methodBind := methodBindCurve2DInterpolatef.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -361,8 +395,8 @@ func (o *Curve2D) Interpolatef(fofs gdnative.Real) gdnative.Vector2 {
 }
 
 /*
-        Deletes the point "idx" from the curve. Sends an error to the console if "idx" is out of bounds.
-	Args: [{ false idx int}], Returns: void
+	        Deletes the point "idx" from the curve. Sends an error to the console if "idx" is out of bounds.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *Curve2D) RemovePoint(idx gdnative.Int) {
 	//log.Println("Calling Curve2D.RemovePoint()")
@@ -372,7 +406,7 @@ func (o *Curve2D) RemovePoint(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "remove_point")
+	methodBind := methodBindCurve2DRemovePoint.Get()
 
 	// Call the parent method.
 	// void
@@ -382,8 +416,8 @@ func (o *Curve2D) RemovePoint(idx gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false distance float}], Returns: void
+	        Undocumented
+		Args: [{ false distance float}], Returns: void
 */
 func (o *Curve2D) SetBakeInterval(distance gdnative.Real) {
 	//log.Println("Calling Curve2D.SetBakeInterval()")
@@ -393,7 +427,7 @@ func (o *Curve2D) SetBakeInterval(distance gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(distance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "set_bake_interval")
+	methodBind := methodBindCurve2DSetBakeInterval.Get()
 
 	// Call the parent method.
 	// void
@@ -403,8 +437,8 @@ func (o *Curve2D) SetBakeInterval(distance gdnative.Real) {
 }
 
 /*
-        Sets the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console.
-	Args: [{ false idx int} { false position Vector2}], Returns: void
+	        Sets the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console.
+		Args: [{ false idx int} { false position Vector2}], Returns: void
 */
 func (o *Curve2D) SetPointIn(idx gdnative.Int, position gdnative.Vector2) {
 	//log.Println("Calling Curve2D.SetPointIn()")
@@ -415,7 +449,7 @@ func (o *Curve2D) SetPointIn(idx gdnative.Int, position gdnative.Vector2) {
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "set_point_in")
+	methodBind := methodBindCurve2DSetPointIn.Get()
 
 	// Call the parent method.
 	// void
@@ -425,8 +459,8 @@ func (o *Curve2D) SetPointIn(idx gdnative.Int, position gdnative.Vector2) {
 }
 
 /*
-        Sets the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console.
-	Args: [{ false idx int} { false position Vector2}], Returns: void
+	        Sets the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console.
+		Args: [{ false idx int} { false position Vector2}], Returns: void
 */
 func (o *Curve2D) SetPointOut(idx gdnative.Int, position gdnative.Vector2) {
 	//log.Println("Calling Curve2D.SetPointOut()")
@@ -437,7 +471,7 @@ func (o *Curve2D) SetPointOut(idx gdnative.Int, position gdnative.Vector2) {
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "set_point_out")
+	methodBind := methodBindCurve2DSetPointOut.Get()
 
 	// Call the parent method.
 	// void
@@ -447,8 +481,8 @@ func (o *Curve2D) SetPointOut(idx gdnative.Int, position gdnative.Vector2) {
 }
 
 /*
-        Sets the position for the vertex "idx". If the index is out of bounds, the function sends an error to the console.
-	Args: [{ false idx int} { false position Vector2}], Returns: void
+	        Sets the position for the vertex "idx". If the index is out of bounds, the function sends an error to the console.
+		Args: [{ false idx int} { false position Vector2}], Returns: void
 */
 func (o *Curve2D) SetPointPosition(idx gdnative.Int, position gdnative.Vector2) {
 	//log.Println("Calling Curve2D.SetPointPosition()")
@@ -459,7 +493,7 @@ func (o *Curve2D) SetPointPosition(idx gdnative.Int, position gdnative.Vector2)
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "set_point_position")
+	methodBind := methodBindCurve2DSetPointPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -469,8 +503,8 @@ func (o *Curve2D) SetPointPosition(idx gdnative.Int, position gdnative.Vector2)
 }
 
 /*
-        Returns a list of points along the curve, with a curvature controlled point density. That is, the curvier parts will have more points than the straighter parts. This approximation makes straight segments between each point, then subdivides those segments until the resulting shape is similar enough. "max_stages" controls how many subdivisions a curve segment may face before it is considered approximate enough. Each subdivision splits the segment in half, so the default 5 stages may mean up to 32 subdivisions per curve segment. Increase with care! "tolerance_degrees" controls how many degrees the midpoint of a segment may deviate from the real curve, before the segment has to be subdivided.
-	Args: [{5 true max_stages int} {4 true tolerance_degrees float}], Returns: PoolVector2Array
+	        Returns a list of points along the curve, with a curvature controlled point density. That is, the curvier parts will have more points than the straighter parts. This approximation makes straight segments between each point, then subdivides those segments until the resulting shape is similar enough. "max_stages" controls how many subdivisions a curve segment may face before it is considered approximate enough. Each subdivision splits the segment in half, so the default 5 stages may mean up to 32 subdivisions per curve segment. Increase with care! "tolerance_degrees" controls how many degrees the midpoint of a segment may deviate from the real curve, before the segment has to be subdivided.
+		Args: [{5 true max_stages int} {4 true tolerance_degrees float}], Returns: PoolVector2Array
 */
 func (o *Curve2D) Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.Real) gdnative.PoolVector2Array {
 	//log.Println("Calling Curve2D.Tessellate()")
@@ -481,7 +515,7 @@ func (o *Curve2D) Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.R
 	ptrArguments[1] = gdnative.NewPointerFromReal(toleranceDegrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve2D", "tessellate")
+	methodBind := methodBindCurve2DTessellate.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -493,10 +527,30 @@ func (o *Curve2D) Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.R
 	return ret
 }
 
+// AddPointWithDefaults will call AddPoint using the default values for: in, out, at_position.
+func (o *Curve2D) AddPointWithDefaults(position gdnative.Vector2) {
+	o.AddPoint(position, gdnative.NewVector2(0, 0), gdnative.NewVector2(0, 0), -1)
+}
+
+// InterpolateBakedWithDefaults will call InterpolateBaked using the default values for: cubic.
+func (o *Curve2D) InterpolateBakedWithDefaults(offset gdnative.Real) gdnative.Vector2 {
+	return o.InterpolateBaked(offset, false)
+}
+
+// TessellateWithDefaults will call Tessellate using the default values for: max_stages, tolerance_degrees.
+func (o *Curve2D) TessellateWithDefaults() gdnative.PoolVector2Array {
+	return o.Tessellate(5, 4)
+}
+
+// BakeInterval will return the value of the "bake_interval" property.
+func (o *Curve2D) BakeInterval() gdnative.Real {
+	return o.GetBakeInterval()
+}
+
 // Curve2DImplementer is an interface that implements the methods
 // of the Curve2D class.
 type Curve2DImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetData() gdnative.Dictionary
 	X_SetData(arg0 gdnative.Dictionary)
 	AddPoint(position gdnative.Vector2, in gdnative.Vector2, out gdnative.Vector2, atPosition gdnative.Int)
@@ -517,4 +571,8 @@ type Curve2DImplementer interface {
 	SetPointOut(idx gdnative.Int, position gdnative.Vector2)
 	SetPointPosition(idx gdnative.Int, position gdnative.Vector2)
 	Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.Real) gdnative.PoolVector2Array
+	AddPointWithDefaults(position gdnative.Vector2)
+	InterpolateBakedWithDefaults(offset gdnative.Real) gdnative.Vector2
+	TessellateWithDefaults() gdnative.PoolVector2Array
+	BakeInterval() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,66 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCurve3DFromPointer(ptr gdnative.Pointer) Curve3D {
-func newCurve3DFromPointer(ptr gdnative.Pointer) Curve3D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Curve3D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This class describes a Bezier curve in 3D space. It is mainly used to give a shape to a [Path], but can be manually sampled for other purposes. It keeps a cache of precalculated points along the curve, to speed further calculations up.
 */
 type Curve3D struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorCurve3D is the class constructor of the Curve3D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCurve3D = gdnative.NewLazyClassConstructor("Curve3D")
+
+// NewCurve3D will create a new instance of the Curve3D class.
+// Curve3D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCurve3D() *Curve3D {
+	obj := &Curve3D{}
+	obj.SetBaseObject(classConstructorCurve3D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Curve3D) BaseClass() string {
 	return "Curve3D"
 }
 
+// Method binds of the Curve3D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCurve3DX_GetData        = gdnative.NewLazyMethodBind("Curve3D", "_get_data")
+	methodBindCurve3DX_SetData        = gdnative.NewLazyMethodBind("Curve3D", "_set_data")
+	methodBindCurve3DAddPoint         = gdnative.NewLazyMethodBind("Curve3D", "add_point")
+	methodBindCurve3DClearPoints      = gdnative.NewLazyMethodBind("Curve3D", "clear_points")
+	methodBindCurve3DGetBakeInterval  = gdnative.NewLazyMethodBind("Curve3D", "get_bake_interval")
+	methodBindCurve3DGetBakedLength   = gdnative.NewLazyMethodBind("Curve3D", "get_baked_length")
+	methodBindCurve3DGetBakedPoints   = gdnative.NewLazyMethodBind("Curve3D", "get_baked_points")
+	methodBindCurve3DGetBakedTilts    = gdnative.NewLazyMethodBind("Curve3D", "get_baked_tilts")
+	methodBindCurve3DGetPointCount    = gdnative.NewLazyMethodBind("Curve3D", "get_point_count")
+	methodBindCurve3DGetPointIn       = gdnative.NewLazyMethodBind("Curve3D", "get_point_in")
+	methodBindCurve3DGetPointOut      = gdnative.NewLazyMethodBind("Curve3D", "get_point_out")
+	methodBindCurve3DGetPointPosition = gdnative.NewLazyMethodBind("Curve3D", "get_point_position")
+	methodBindCurve3DGetPointTilt     = gdnative.NewLazyMethodBind("Curve3D", "get_point_tilt")
+	methodBindCurve3DInterpolate      = gdnative.NewLazyMethodBind("Curve3D", "interpolate")
+	methodBindCurve3DInterpolateBaked = gdnative.NewLazyMethodBind("Curve3D", "interpolate_baked")
+	methodBindCurve3DInterpolatef     = gdnative.NewLazyMethodBind("Curve3D", "interpolatef")
+	methodBindCurve3DRemovePoint      = gdnative.NewLazyMethodBind("Curve3D", "remove_point")
+	methodBindCurve3DSetBakeInterval  = gdnative.NewLazyMethodBind("Curve3D", "set_bake_interval")
+	methodBindCurve3DSetPointIn       = gdnative.NewLazyMethodBind("Curve3D", "set_point_in")
+	methodBindCurve3DSetPointOut      = gdnative.NewLazyMethodBind("Curve3D", "set_point_out")
+	methodBindCurve3DSetPointPosition = gdnative.NewLazyMethodBind("Curve3D", "set_point_position")
+	methodBindCurve3DSetPointTilt     = gdnative.NewLazyMethodBind("Curve3D", "set_point_tilt")
+	methodBindCurve3DTessellate       = gdnative.NewLazyMethodBind("Curve3D", "tessellate")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *Curve3D) X_GetData() gdnative.Dictionary {
 	//log.Println("Calling Curve3D.X_GetData()")
@@ -45,7 +82,7 @@ func (o *Curve3D) X_GetData() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "_get_data")
+	methodBind := methodBindCurve3DX_GetData.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -58,8 +95,8 @@ func (o *Curve3D) X_GetData() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Dictionary}], Returns: void
 */
 func (o *Curve3D) X_SetData(arg0 gdnative.Dictionary) {
 	//log.Println("Calling Curve3D.X_SetData()")
@@ -69,7 +106,7 @@ func (o *Curve3D) X_SetData(arg0 gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "_set_data")
+	methodBind := methodBindCurve3DX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +116,8 @@ func (o *Curve3D) X_SetData(arg0 gdnative.Dictionary) {
 }
 
 /*
-        Adds a point to a curve, at "position", with control points "in" and "out". If "at_position" is given, the point is inserted before the point number "at_position", moving that point (and every point after) after the inserted point. If "at_position" is not given, or is an illegal value (at_position <0 or at_position >= [method get_point_count]), the point will be appended at the end of the point list.
-	Args: [{ false position Vector3} {(0, 0, 0) true in Vector3} {(0, 0, 0) true out Vector3} {-1 true at_position int}], Returns: void
+	        Adds a point to a curve, at "position", with control points "in" and "out". If "at_position" is given, the point is inserted before the point number "at_position", moving that point (and every point after) after the inserted point. If "at_position" is not given, or is an illegal value (at_position <0 or at_position >= [method get_point_count]), the point will be appended at the end of the point list.
+		Args: [{ false position Vector3} {(0, 0, 0) true in Vector3} {(0, 0, 0) true out Vector3} {-1 true at_position int}], Returns: void
 */
 func (o *Curve3D) AddPoint(position gdnative.Vector3, in gdnative.Vector3, out gdnative.Vector3, atPosition gdnative.Int) {
 	//log.Println("Calling Curve3D.AddPoint()")
@@ -93,7 +130,7 @@ func (o *Curve3D) AddPoint(position gdnative.Vector3, in gdnative.Vector3, out g
 	ptrArguments[3] = gdnative.NewPointerFromInt(atPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "add_point")
+	methodBind := methodBindCurve3DAddPoint.Get()
 
 	// Call the parent method.
 	// void
@@ -103,8 +140,8 @@ func (o *Curve3D) AddPoint(position gdnative.Vector3, in gdnative.Vector3, out g
 }
 
 /*
-        Removes all points from the curve.
-	Args: [], Returns: void
+	        Removes all points from the curve.
+		Args: [], Returns: void
 */
 func (o *Curve3D) ClearPoints() {
 	//log.Println("Calling Curve3D.ClearPoints()")
@@ -113,7 +150,7 @@ func (o *Curve3D) ClearPoints() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "clear_points")
+	methodBind := methodBindCurve3DClearPoints.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +160,8 @@ func (o *Curve3D) ClearPoints() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Curve3D) GetBakeInterval() gdnative.Real {
 	//log.Println("Calling Curve3D.GetBakeInterval()")
@@ -133,7 +170,7 @@ func (o *Curve3D) GetBakeInterval() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_bake_interval")
+	methodBind := methodBindCurve3DGetBakeInterval.Get()
 
 	// Call the parent method.
 	// float
@@ -146,8 +183,8 @@ func (o *Curve3D) GetBakeInterval() gdnative.Real {
 }
 
 /*
-        Returns the total length of the curve, based on the cached points. Given enough density (see [method set_bake_interval]), it should be approximate enough.
-	Args: [], Returns: float
+	        Returns the total length of the curve, based on the cached points. Given enough density (see [method set_bake_interval]), it should be approximate enough.
+		Args: [], Returns: float
 */
 func (o *Curve3D) GetBakedLength() gdnative.Real {
 	//log.Println("Calling Curve3D.GetBakedLength()")
@@ -156,7 +193,7 @@ func (o *Curve3D) GetBakedLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_baked_length")
+	methodBind := methodBindCurve3DGetBakedLength.Get()
 
 	// Call the parent method.
 	// float
@@ -169,8 +206,8 @@ func (o *Curve3D) GetBakedLength() gdnative.Real {
 }
 
 /*
-        Returns the cache of points as a [PoolVector3Array].
-	Args: [], Returns: PoolVector3Array
+	        Returns the cache of points as a [PoolVector3Array].
+		Args: [], Returns: PoolVector3Array
 */
 func (o *Curve3D) GetBakedPoints() gdnative.PoolVector3Array {
 	//log.Println("Calling Curve3D.GetBakedPoints()")
@@ -179,7 +216,7 @@ func (o *Curve3D) GetBakedPoints() gdnative.PoolVector3Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_baked_points")
+	methodBind := methodBindCurve3DGetBakedPoints.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -192,8 +229,8 @@ func (o *Curve3D) GetBakedPoints() gdnative.PoolVector3Array {
 }
 
 /*
-        Returns the cache of tilts as a [RealArray].
-	Args: [], Returns: PoolRealArray
+	        Returns the cache of tilts as a [RealArray].
+		Args: [], Returns: PoolRealArray
 */
 func (o *Curve3D) GetBakedTilts() gdnative.PoolRealArray {
 	//log.Println("Calling Curve3D.GetBakedTilts()")
@@ -202,7 +239,7 @@ func (o *Curve3D) GetBakedTilts() gdnative.PoolRealArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_baked_tilts")
+	methodBind := methodBindCurve3DGetBakedTilts.Get()
 
 	// Call the parent method.
 	// PoolRealArray
@@ -215,8 +252,8 @@ func (o *Curve3D) GetBakedTilts() gdnative.PoolRealArray {
 }
 
 /*
-        Returns the number of points describing the curve.
-	Args: [], Returns: int
+	        Returns the number of points describing the curve.
+		Args: [], Returns: int
 */
 func (o *Curve3D) GetPointCount() gdnative.Int {
 	//log.Println("Calling Curve3D.GetPointCount()")
@@ -225,7 +262,7 @@ func (o *Curve3D) GetPointCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_point_count")
+	methodBind := methodBindCurve3DGetPointCount.Get()
 
 	// Call the parent method.
 	// int
@@ -238,8 +275,8 @@ func (o *Curve3D) GetPointCount() gdnative.Int {
 }
 
 /*
-        Returns the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0, 0).
-	Args: [{ false idx int}], Returns: Vector3
+	        Returns the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0, 0).
+		Args: [{ false idx int}], Returns: Vector3
 */
 func (o *Curve3D) GetPointIn(idx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling Curve3D.GetPointIn()")
@@ -249,7 +286,7 @@ func (o *Curve3D) GetPointIn(idx gdnative.Int) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_point_in")
+	methodBind := methodBindCurve3DGetPointIn.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -262,8 +299,8 @@ func (o *Curve3D) GetPointIn(idx gdnative.Int) gdnative.Vector3 {
 }
 
 /*
-        Returns the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0, 0).
-	Args: [{ false idx int}], Returns: Vector3
+	        Returns the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0, 0).
+		Args: [{ false idx int}], Returns: Vector3
 */
 func (o *Curve3D) GetPointOut(idx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling Curve3D.GetPointOut()")
@@ -273,7 +310,7 @@ func (o *Curve3D) GetPointOut(idx gdnative.Int) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_point_out")
+	methodBind := methodBindCurve3DGetPointOut.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -286,8 +323,8 @@ func (o *Curve3D) GetPointOut(idx gdnative.Int) gdnative.Vector3 {
 }
 
 /*
-        Returns the position of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0, 0).
-	Args: [{ false idx int}], Returns: Vector3
+	        Returns the position of the vertex "idx". If the index is out of bounds, the function sends an error to the console, and returns (0, 0, 0).
+		Args: [{ false idx int}], Returns: Vector3
 */
 func (o *Curve3D) GetPointPosition(idx gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling Curve3D.GetPointPosition()")
@@ -297,7 +334,7 @@ func (o *Curve3D) GetPointPosition(idx gdnative.Int) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_point_position")
+	methodBind := methodBindCurve3DGetPointPosition.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -310,8 +347,8 @@ func (o *Curve3D) GetPointPosition(idx gdnative.Int) gdnative.Vector3 {
 }
 
 /*
-        Returns the tilt angle in radians for the point "idx". If the index is out of bounds, the function sends an error to the console, and returns 0.
-	Args: [{ false idx int}], Returns: float
+	        Returns the tilt angle in radians for the point "idx". If the index is out of bounds, the function sends an error to the console, and returns 0.
+		Args: [{ false idx int}], Returns: float
 */
 func (o *Curve3D) GetPointTilt(idx gdnative.Int) gdnative.Real {
 	//log.Println("Calling Curve3D.GetPointTilt()")
@@ -321,7 +358,7 @@ func (o *Curve3D) GetPointTilt(idx gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "get_point_tilt")
+	methodBind := methodBindCurve3DGetPointTilt.Get()
 
 	// Call the parent method.
 	// float
@@ -334,8 +371,8 @@ func (o *Curve3D) GetPointTilt(idx gdnative.Int) gdnative.Real {
 }
 
 /*
-        Returns the position between the vertex "idx" and the vertex "idx"+1, where "t" controls if the point is the first vertex (t = 0.0), the last vertex (t = 1.0), or in between. Values of "t" outside the range (0.0 >= t <=1) give strange, but predictable results. If "idx" is out of bounds it is truncated to the first or last vertex, and "t" is ignored. If the curve has no points, the function sends an error to the console, and returns (0, 0, 0).
-	Args: [{ false idx int} { false t float}], Returns: Vector3
+	        Returns the position between the vertex "idx" and the vertex "idx"+1, where "t" controls if the point is the first vertex (t = 0.0), the last vertex (t = 1.0), or in between. Values of "t" outside the range (0.0 >= t <=1) give strange, but predictable results. If "idx" is out of bounds it is truncated to the first or last vertex, and "t" is ignored. If the curve has no points, the function sends an error to the console, and returns (0, 0, 0).
+		Args: [{ false idx int} { false t float}], Returns: Vector3
 */
 func (o *Curve3D) Interpolate(idx gdnative.Int, t gdnative.Real) gdnative.Vector3 {
 	//log.Println("Calling Curve3D.Interpolate()")
@@ -346,7 +383,7 @@ func (o *Curve3D) Interpolate(idx gdnative.Int, t gdnative.Real) gdnative.Vector
 	ptrArguments[1] = gdnative.NewPointerFromReal(t)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "interpolate")
+	methodBind := methodBindCurve3DInterpolate.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -359,8 +396,8 @@ func (o *Curve3D) Interpolate(idx gdnative.Int, t gdnative.Real) gdnative.Vector
 }
 
 /*
-        Returns a point within the curve at position "offset", where "offset" is measured as a distance in 3D units along the curve. To do that, it finds the two cached points where the "offset" lies between, then interpolates the values. This interpolation is cubic if "cubic" is set to true, or linear if set to false. Cubic interpolation tends to follow the curves better, but linear is faster (and often, precise enough).
-	Args: [{ false offset float} {False true cubic bool}], Returns: Vector3
+	        Returns a point within the curve at position "offset", where "offset" is measured as a distance in 3D units along the curve. To do that, it finds the two cached points where the "offset" lies between, then interpolates the values. This interpolation is cubic if "cubic" is set to true, or linear if set to false. Cubic interpolation tends to follow the curves better, but linear is faster (and often, precise enough).
+		Args: [{ false offset float} {False true cubic bool}], Returns: Vector3
 */
 func (o *Curve3D) InterpolateBaked(offset gdnative.Real, cubic gdnative.Bool) gdnative.Vector3 {
 	//log.Println("Calling Curve3D.InterpolateBaked()")
@@ -371,7 +408,7 @@ func (o *Curve3D) InterpolateBaked(offset gdnative.Real, cubic gdnative.Bool) gd
 	ptrArguments[1] = gdnative.NewPointerFromBool(cubic)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "interpolate_baked")
+	methodBind := methodBindCurve3DInterpolateBaked.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -384,8 +421,8 @@ func (o *Curve3D) InterpolateBaked(offset gdnative.Real, cubic gdnative.Bool) gd
 }
 
 /*
-        Returns the position at the vertex "fofs". It calls [method interpolate] using the integer part of fofs as "idx", and its fractional part as "t".
-	Args: [{ false fofs float}], Returns: Vector3
+	        Returns the position at the vertex "fofs". It calls [method interpolate] using the integer part of fofs as "idx", and its fractional part as "t".
+		Args: [{ false fofs float}], Returns: Vector3
 */
 func (o *Curve3D) Interpolatef(fofs gdnative.Real) gdnative.Vector3 {
 	//log.Println("Calling Curve3D.Interpolatef()")
@@ -395,7 +432,7 @@ func (o *Curve3D) Interpolatef(fofs gdnative.Real) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromReal(fofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "interpolatef")
+	methodBind := methodBindCurve3DInterpolatef.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -408,8 +445,8 @@ func (o *Curve3D) Interpolatef(fofs gdnative.Real) gdnative.Vector3 {
 }
 
 /*
-        Deletes the point "idx" from the curve. Sends an error to the console if "idx" is out of bounds.
-	Args: [{ false idx int}], Returns: void
+	        Deletes the point "idx" from the curve. Sends an error to the console if "idx" is out of bounds.
+		Args: [{ false idx int}], Returns: void
 */
 func (o *Curve3D) RemovePoint(idx gdnative.Int) {
 	//log.Println("Calling Curve3D.RemovePoint()")
@@ -419,7 +456,7 @@ func (o *Curve3D) RemovePoint(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "remove_point")
+	methodBind := methodBindCurve3DRemovePoint.Get()
 
 	// Call the parent method.
 	// void
@@ -429,8 +466,8 @@ func (o *Curve3D) RemovePoint(idx gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false distance float}], Returns: void
+	        Undocumented
+		Args: [{ false distance float}], Returns: void
 */
 func (o *Curve3D) SetBakeInterval(distance gdnative.Real) {
 	//log.Println("Calling Curve3D.SetBakeInterval()")
@@ -440,7 +477,7 @@ func (o *Curve3D) SetBakeInterval(distance gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(distance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "set_bake_interval")
+	methodBind := methodBindCurve3DSetBakeInterval.Get()
 
 	// Call the parent method.
 	// void
@@ -450,8 +487,8 @@ func (o *Curve3D) SetBakeInterval(distance gdnative.Real) {
 }
 
 /*
-        Sets the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console.
-	Args: [{ false idx int} { false position Vector3}], Returns: void
+	        Sets the position of the control point leading to the vertex "idx". If the index is out of bounds, the function sends an error to the console.
+		Args: [{ false idx int} { false position Vector3}], Returns: void
 */
 func (o *Curve3D) SetPointIn(idx gdnative.Int, position gdnative.Vector3) {
 	//log.Println("Calling Curve3D.SetPointIn()")
@@ -462,7 +499,7 @@ func (o *Curve3D) SetPointIn(idx gdnative.Int, position gdnative.Vector3) {
 	ptrArguments[1] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "set_point_in")
+	methodBind := methodBindCurve3DSetPointIn.Get()
 
 	// Call the parent method.
 	// void
@@ -472,8 +509,8 @@ func (o *Curve3D) SetPointIn(idx gdnative.Int, position gdnative.Vector3) {
 }
 
 /*
-        Sets the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console.
-	Args: [{ false idx int} { false position Vector3}], Returns: void
+	        Sets the position of the control point leading out of the vertex "idx". If the index is out of bounds, the function sends an error to the console.
+		Args: [{ false idx int} { false position Vector3}], Returns: void
 */
 func (o *Curve3D) SetPointOut(idx gdnative.Int, position gdnative.Vector3) {
 	//log.Println("Calling Curve3D.SetPointOut()")
@@ -484,7 +521,7 @@ func (o *Curve3D) SetPointOut(idx gdnative.Int, position gdnative.Vector3) {
 	ptrArguments[1] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "set_point_out")
+	methodBind := methodBindCurve3DSetPointOut.Get()
 
 	// Call the parent method.
 	// void
@@ -494,8 +531,8 @@ func (o *Curve3D) SetPointOut(idx gdnative.Int, position gdnative.Vector3) {
 }
 
 /*
-        Sets the position for the vertex "idx". If the index is out of bounds, the function sends an error to the console.
-	Args: [{ false idx int} { false position Vector3}], Returns: void
+	        Sets the position for the vertex "idx". If the index is out of bounds, the function sends an error to the console.
+		Args: [{ false idx int} { false position Vector3}], Returns: void
 */
 func (o *Curve3D) SetPointPosition(idx gdnative.Int, position gdnative.Vector3) {
 	//log.Println("Calling Curve3D.SetPointPosition()")
@@ -506,7 +543,7 @@ func (o *Curve3D) SetPointPosition(idx gdnative.Int, position gdnative.Vector3)
 	ptrArguments[1] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "set_point_position")
+	methodBind := methodBindCurve3DSetPointPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -516,8 +553,8 @@ func (o *Curve3D) SetPointPosition(idx gdnative.Int, position gdnative.Vector3)
 }
 
 /*
-        Sets the tilt angle in radians for the point "idx". If the index is out of bounds, the function sends an error to the console. The tilt controls the rotation along the look-at axis an object traveling the path would have. In the case of a curve controlling a [PathFollow], this tilt is an offset over the natural tilt the PathFollow calculates.
-	Args: [{ false idx int} { false tilt float}], Returns: void
+	        Sets the tilt angle in radians for the point "idx". If the index is out of bounds, the function sends an error to the console. The tilt controls the rotation along the look-at axis an object traveling the path would have. In the case of a curve controlling a [PathFollow], this tilt is an offset over the natural tilt the PathFollow calculates.
+		Args: [{ false idx int} { false tilt float}], Returns: void
 */
 func (o *Curve3D) SetPointTilt(idx gdnative.Int, tilt gdnative.Real) {
 	//log.Println("Calling Curve3D.SetPointTilt()")
@@ -528,7 +565,7 @@ func (o *Curve3D) SetPointTilt(idx gdnative.Int, tilt gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(tilt)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "set_point_tilt")
+	methodBind := methodBindCurve3DSetPointTilt.Get()
 
 	// Call the parent method.
 	// void
@@ -538,8 +575,8 @@ func (o *Curve3D) SetPointTilt(idx gdnative.Int, tilt gdnative.Real) {
 }
 
 /*
-        Returns a list of points along the curve, with a curvature controlled point density. That is, the curvier parts will have more points than the straighter parts. This approximation makes straight segments between each point, then subdivides those segments until the resulting shape is similar enough. "max_stages" controls how many subdivisions a curve segment may face before it is considered approximate enough. Each subdivision splits the segment in half, so the default 5 stages may mean up to 32 subdivisions per curve segment. Increase with care! "tolerance_degrees" controls how many degrees the midpoint of a segment may deviate from the real curve, before the segment has to be subdivided.
-	Args: [{5 true max_stages int} {4 true tolerance_degrees float}], Returns: PoolVector3Array
+	        Returns a list of points along the curve, with a curvature controlled point density. That is, the curvier parts will have more points than the straighter parts. This approximation makes straight segments between each point, then subdivides those segments until the resulting shape is similar enough. "max_stages" controls how many subdivisions a curve segment may face before it is considered approximate enough. Each subdivision splits the segment in half, so the default 5 stages may mean up to 32 subdivisions per curve segment. Increase with care! "tolerance_degrees" controls how many degrees the midpoint of a segment may deviate from the real curve, before the segment has to be subdivided.
+		Args: [{5 true max_stages int} {4 true tolerance_degrees float}], Returns: PoolVector3Array
 */
 func (o *Curve3D) Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.Real) gdnative.PoolVector3Array {
 	//log.Println("Calling Curve3D.Tessellate()")
@@ -550,7 +587,7 @@ func (o *Curve3D) Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.R
 	ptrArguments[1] = gdnative.NewPointerFromReal(toleranceDegrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Curve3D", "tessellate")
+	methodBind := methodBindCurve3DTessellate.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -562,10 +599,30 @@ func (o *Curve3D) Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.R
 	return ret
 }
 
+// AddPointWithDefaults will call AddPoint using the default values for: in, out, at_position.
+func (o *Curve3D) AddPointWithDefaults(position gdnative.Vector3) {
+	o.AddPoint(position, gdnative.NewVector3(0, 0, 0), gdnative.NewVector3(0, 0, 0), -1)
+}
+
+// InterpolateBakedWithDefaults will call InterpolateBaked using the default values for: cubic.
+func (o *Curve3D) InterpolateBakedWithDefaults(offset gdnative.Real) gdnative.Vector3 {
+	return o.InterpolateBaked(offset, false)
+}
+
+// TessellateWithDefaults will call Tessellate using the default values for: max_stages, tolerance_degrees.
+func (o *Curve3D) TessellateWithDefaults() gdnative.PoolVector3Array {
+	return o.Tessellate(5, 4)
+}
+
+// BakeInterval will return the value of the "bake_interval" property.
+func (o *Curve3D) BakeInterval() gdnative.Real {
+	return o.GetBakeInterval()
+}
+
 // Curve3DImplementer is an interface that implements the methods
 // of the Curve3D class.
 type Curve3DImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetData() gdnative.Dictionary
 	X_SetData(arg0 gdnative.Dictionary)
 	AddPoint(position gdnative.Vector3, in gdnative.Vector3, out gdnative.Vector3, atPosition gdnative.Int)
@@ -589,4 +646,8 @@ type Curve3DImplementer interface {
 	SetPointPosition(idx gdnative.Int, position gdnative.Vector3)
 	SetPointTilt(idx gdnative.Int, tilt gdnative.Real)
 	Tessellate(maxStages gdnative.Int, toleranceDegrees gdnative.Real) gdnative.PoolVector3Array
+	AddPointWithDefaults(position gdnative.Vector3)
+	InterpolateBakedWithDefaults(offset gdnative.Real) gdnative.Vector3
+	TessellateWithDefaults() gdnative.PoolVector3Array
+	BakeInterval() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCurveTextureFromPointer(ptr gdnative.Pointer) CurveTexture {
-func newCurveTextureFromPointer(ptr gdnative.Pointer) CurveTexture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CurveTexture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Renders a given [Curve] provided to it. Simplifies the task of drawing curves and/or saving them as image files.
 */
 type CurveTexture struct {
-	Texture
+	godot.Texture
 	owner gdnative.Object
 }
 
+// classConstructorCurveTexture is the class constructor of the CurveTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCurveTexture = gdnative.NewLazyClassConstructor("CurveTexture")
+
+// NewCurveTexture will create a new instance of the CurveTexture class.
+// CurveTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCurveTexture() *CurveTexture {
+	obj := &CurveTexture{}
+	obj.SetBaseObject(classConstructorCurveTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CurveTexture) BaseClass() string {
 	return "CurveTexture"
 }
 
+// Method binds of the CurveTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCurveTextureX_Update = gdnative.NewLazyMethodBind("CurveTexture", "_update")
+	methodBindCurveTextureGetCurve = gdnative.NewLazyMethodBind("CurveTexture", "get_curve")
+	methodBindCurveTextureSetCurve = gdnative.NewLazyMethodBind("CurveTexture", "set_curve")
+	methodBindCurveTextureSetWidth = gdnative.NewLazyMethodBind("CurveTexture", "set_width")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *CurveTexture) X_Update() {
 	//log.Println("Calling CurveTexture.X_Update()")
@@ -45,7 +63,7 @@ func (o *CurveTexture) X_Update() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CurveTexture", "_update")
+	methodBind := methodBindCurveTextureX_Update.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +73,8 @@ func (o *CurveTexture) X_Update() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Curve
+	        Undocumented
+		Args: [], Returns: Curve
 */
 func (o *CurveTexture) GetCurve() CurveImplementer {
 	//log.Println("Calling CurveTexture.GetCurve()")
@@ -65,7 +83,7 @@ func (o *CurveTexture) GetCurve() CurveImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CurveTexture", "get_curve")
+	methodBind := methodBindCurveTextureGetCurve.Get()
 
 	// Call the parent method.
 	// Curve
@@ -73,37 +91,35 @@ func (o *CurveTexture) GetCurve() CurveImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newCurveFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(CurveImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Curve" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(CurveImplementer)
+	ret := Curve{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(CurveImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false curve Curve}], Returns: void
+	        Undocumented
+		Args: [{ false curve Curve}], Returns: void
 */
 func (o *CurveTexture) SetCurve(curve CurveImplementer) {
 	//log.Println("Calling CurveTexture.SetCurve()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(curve.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(curve))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CurveTexture", "set_curve")
+	methodBind := methodBindCurveTextureSetCurve.Get()
 
 	// Call the parent method.
 	// void
@@ -113,8 +129,8 @@ func (o *CurveTexture) SetCurve(curve CurveImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false width int}], Returns: void
+	        Undocumented
+		Args: [{ false width int}], Returns: void
 */
 func (o *CurveTexture) SetWidth(width gdnative.Int) {
 	//log.Println("Calling CurveTexture.SetWidth()")
@@ -124,7 +140,7 @@ func (o *CurveTexture) SetWidth(width gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CurveTexture", "set_width")
+	methodBind := methodBindCurveTextureSetWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -133,12 +149,24 @@ func (o *CurveTexture) SetWidth(width gdnative.Int) {
 
 }
 
+// Curve will return the value of the "curve" property.
+func (o *CurveTexture) Curve() CurveImplementer {
+	return o.GetCurve()
+}
+
+// Width will return the value of the "width" property.
+func (o *CurveTexture) Width() gdnative.Int {
+	return o.GetWidth()
+}
+
 // CurveTextureImplementer is an interface that implements the methods
 // of the CurveTexture class.
 type CurveTextureImplementer interface {
-	TextureImplementer
+	godot.TextureImplementer
 	X_Update()
 	GetCurve() CurveImplementer
 	SetCurve(curve CurveImplementer)
 	SetWidth(width gdnative.Int)
+	Curve() CurveImplementer
+	Width() gdnative.Int
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCylinderMeshFromPointer(ptr gdnative.Pointer) CylinderMesh {
-func newCylinderMeshFromPointer(ptr gdnative.Pointer) CylinderMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CylinderMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class representing a cylindrical [PrimitiveMesh].
 */
@@ -30,13 +22,45 @@ type CylinderMesh struct {
 	owner gdnative.Object
 }
 
+// classConstructorCylinderMesh is the class constructor of the CylinderMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCylinderMesh = gdnative.NewLazyClassConstructor("CylinderMesh")
+
+// NewCylinderMesh will create a new instance of the CylinderMesh class.
+// CylinderMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewCylinderMesh() *CylinderMesh {
+	obj := &CylinderMesh{}
+	obj.SetBaseObject(classConstructorCylinderMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CylinderMesh) BaseClass() string {
 	return "CylinderMesh"
 }
 
+// Method binds of the CylinderMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCylinderMeshGetBottomRadius   = gdnative.NewLazyMethodBind("CylinderMesh", "get_bottom_radius")
+	methodBindCylinderMeshGetHeight         = gdnative.NewLazyMethodBind("CylinderMesh", "get_height")
+	methodBindCylinderMeshGetRadialSegments = gdnative.NewLazyMethodBind("CylinderMesh", "get_radial_segments")
+	methodBindCylinderMeshGetRings          = gdnative.NewLazyMethodBind("CylinderMesh", "get_rings")
+	methodBindCylinderMeshGetTopRadius      = gdnative.NewLazyMethodBind("CylinderMesh", "get_top_radius")
+	methodBindCylinderMeshSetBottomRadius   = gdnative.NewLazyMethodBind("CylinderMesh", "set_bottom_radius")
+	methodBindCylinderMeshSetHeight         = gdnative.NewLazyMethodBind("CylinderMesh", "set_height")
+	methodBindCylinderMeshSetRadialSegments = gdnative.NewLazyMethodBind("CylinderMesh", "set_radial_segments")
+	methodBindCylinderMeshSetRings          = gdnative.NewLazyMethodBind("CylinderMesh", "set_rings")
+	methodBindCylinderMeshSetTopRadius      = gdnative.NewLazyMethodBind("CylinderMesh", "set_top_radius")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CylinderMesh) GetBottomRadius() gdnative.Real {
 	//log.Println("Calling CylinderMesh.GetBottomRadius()")
@@ -45,7 +69,7 @@ func (o *CylinderMesh) GetBottomRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "get_bottom_radius")
+	methodBind := methodBindCylinderMeshGetBottomRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +82,8 @@ func (o *CylinderMesh) GetBottomRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CylinderMesh) GetHeight() gdnative.Real {
 	//log.Println("Calling CylinderMesh.GetHeight()")
@@ -68,7 +92,7 @@ func (o *CylinderMesh) GetHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "get_height")
+	methodBind := methodBindCylinderMeshGetHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +105,8 @@ func (o *CylinderMesh) GetHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CylinderMesh) GetRadialSegments() gdnative.Int {
 	//log.Println("Calling CylinderMesh.GetRadialSegments()")
@@ -91,7 +115,7 @@ func (o *CylinderMesh) GetRadialSegments() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "get_radial_segments")
+	methodBind := methodBindCylinderMeshGetRadialSegments.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +128,8 @@ func (o *CylinderMesh) GetRadialSegments() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *CylinderMesh) GetRings() gdnative.Int {
 	//log.Println("Calling CylinderMesh.GetRings()")
@@ -114,7 +138,7 @@ func (o *CylinderMesh) GetRings() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "get_rings")
+	methodBind := methodBindCylinderMeshGetRings.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +151,8 @@ func (o *CylinderMesh) GetRings() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CylinderMesh) GetTopRadius() gdnative.Real {
 	//log.Println("Calling CylinderMesh.GetTopRadius()")
@@ -137,7 +161,7 @@ func (o *CylinderMesh) GetTopRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "get_top_radius")
+	methodBind := methodBindCylinderMeshGetTopRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +174,8 @@ func (o *CylinderMesh) GetTopRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *CylinderMesh) SetBottomRadius(radius gdnative.Real) {
 	//log.Println("Calling CylinderMesh.SetBottomRadius()")
@@ -161,7 +185,7 @@ func (o *CylinderMesh) SetBottomRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "set_bottom_radius")
+	methodBind := methodBindCylinderMeshSetBottomRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +195,8 @@ func (o *CylinderMesh) SetBottomRadius(radius gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false height float}], Returns: void
+	        Undocumented
+		Args: [{ false height float}], Returns: void
 */
 func (o *CylinderMesh) SetHeight(height gdnative.Real) {
 	//log.Println("Calling CylinderMesh.SetHeight()")
@@ -182,7 +206,7 @@ func (o *CylinderMesh) SetHeight(height gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "set_height")
+	methodBind := methodBindCylinderMeshSetHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +216,8 @@ func (o *CylinderMesh) SetHeight(height gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false segments int}], Returns: void
+	        Undocumented
+		Args: [{ false segments int}], Returns: void
 */
 func (o *CylinderMesh) SetRadialSegments(segments gdnative.Int) {
 	//log.Println("Calling CylinderMesh.SetRadialSegments()")
@@ -203,7 +227,7 @@ func (o *CylinderMesh) SetRadialSegments(segments gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(segments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "set_radial_segments")
+	methodBind := methodBindCylinderMeshSetRadialSegments.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +237,8 @@ func (o *CylinderMesh) SetRadialSegments(segments gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rings int}], Returns: void
+	        Undocumented
+		Args: [{ false rings int}], Returns: void
 */
 func (o *CylinderMesh) SetRings(rings gdnative.Int) {
 	//log.Println("Calling CylinderMesh.SetRings()")
@@ -224,7 +248,7 @@ func (o *CylinderMesh) SetRings(rings gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(rings)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "set_rings")
+	methodBind := methodBindCylinderMeshSetRings.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +258,8 @@ func (o *CylinderMesh) SetRings(rings gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *CylinderMesh) SetTopRadius(radius gdnative.Real) {
 	//log.Println("Calling CylinderMesh.SetTopRadius()")
@@ -245,7 +269,7 @@ func (o *CylinderMesh) SetTopRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CylinderMesh", "set_top_radius")
+	methodBind := methodBindCylinderMeshSetTopRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -254,6 +278,31 @@ func (o *CylinderMesh) SetTopRadius(radius gdnative.Real) {
 
 }
 
+// BottomRadius will return the value of the "bottom_radius" property.
+func (o *CylinderMesh) BottomRadius() gdnative.Real {
+	return o.GetBottomRadius()
+}
+
+// Height will return the value of the "height" property.
+func (o *CylinderMesh) Height() gdnative.Real {
+	return o.GetHeight()
+}
+
+// RadialSegments will return the value of the "radial_segments" property.
+func (o *CylinderMesh) RadialSegments() gdnative.Int {
+	return o.GetRadialSegments()
+}
+
+// Rings will return the value of the "rings" property.
+func (o *CylinderMesh) Rings() gdnative.Int {
+	return o.GetRings()
+}
+
+// TopRadius will return the value of the "top_radius" property.
+func (o *CylinderMesh) TopRadius() gdnative.Real {
+	return o.GetTopRadius()
+}
+
 // CylinderMeshImplementer is an interface that implements the methods
 // of the CylinderMesh class.
 type CylinderMeshImplementer interface {
@@ -268,4 +317,9 @@ type CylinderMeshImplementer interface {
 	SetRadialSegments(segments gdnative.Int)
 	SetRings(rings gdnative.Int)
 	SetTopRadius(radius gdnative.Real)
+	BottomRadius() gdnative.Real
+	Height() gdnative.Real
+	RadialSegments() gdnative.Int
+	Rings() gdnative.Int
+	TopRadius() gdnative.Real
 }
@@ -0,0 +1,130 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type GDScript struct {
+	godot.Script
+	owner gdnative.Object
+}
+
+// classConstructorGDScript is the class constructor of the GDScript class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGDScript = gdnative.NewLazyClassConstructor("GDScript")
+
+// NewGDScript will create a new instance of the GDScript class.
+// GDScript is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewGDScript() *GDScript {
+	obj := &GDScript{}
+	obj.SetBaseObject(classConstructorGDScript.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *GDScript) BaseClass() string {
+	return "GDScript"
+}
+
+// Method binds of the GDScript class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGDScriptGetAsByteCode = gdnative.NewLazyMethodBind("GDScript", "get_as_byte_code")
+	methodBindGDScriptNew           = gdnative.NewLazyMethodBind("GDScript", "new")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: PoolByteArray
+*/
+func (o *GDScript) GetAsByteCode() gdnative.PoolByteArray {
+	//log.Println("Calling GDScript.GetAsByteCode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGDScriptGetAsByteCode.Get()
+
+	// Call the parent method.
+	// PoolByteArray
+	retPtr := gdnative.NewEmptyPoolByteArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewPoolByteArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Object
+*/
+func (o *GDScript) New(args ...gdnative.Variant) (godot.ObjectImplementer, error) {
+	//log.Println("Calling GDScript.New()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 0+len(args))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindGDScriptNew.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// The returned variant is only needed to get the object.
+	defer ret.Destroy()
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the returned variant into its actual object.
+	retObject := ret.AsObject()
+	if retObject.IsNil() {
+		return nil, nil
+	}
+	className := godot.Object{}
+	className.SetBaseObject(retObject)
+
+	// Destroying the returned variant drops its reference, so take our own
+	// reference to returned reference types. The caller must drop it with
+	// Release().
+	if className.IsClass("Reference") {
+		reference := godot.Reference{}
+		reference.SetBaseObject(retObject)
+		reference.ReferenceMethod()
+	}
+	if actualRet, ok := godot.GetActualClass(className.GetClass(), retObject).(godot.ObjectImplementer); ok {
+		return actualRet, nil
+	}
+	return nil, fmt.Errorf("object of class %s can't be returned as godot.Object", className.GetClass())
+}
+
+// GDScriptImplementer is an interface that implements the methods
+// of the GDScript class.
+type GDScriptImplementer interface {
+	godot.ScriptImplementer
+	GetAsByteCode() gdnative.PoolByteArray
+	New(args ...gdnative.Variant) (godot.ObjectImplementer, error)
+}
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,55 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGradientFromPointer(ptr gdnative.Pointer) Gradient {
-func newGradientFromPointer(ptr gdnative.Pointer) Gradient {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Gradient{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Given a set of colors, this node will interpolate them in order, meaning, that if you have color 1, color 2 and color3, the ramp will interpolate (generate the colors between two colors) from color 1 to color 2 and from color 2 to color 3. Initially the ramp will have 2 colors (black and white), one (black) at ramp lower offset offset 0 and the other (white) at the ramp higher offset 1.
 */
 type Gradient struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorGradient is the class constructor of the Gradient class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGradient = gdnative.NewLazyClassConstructor("Gradient")
+
+// NewGradient will create a new instance of the Gradient class.
+// Gradient is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewGradient() *Gradient {
+	obj := &Gradient{}
+	obj.SetBaseObject(classConstructorGradient.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Gradient) BaseClass() string {
 	return "Gradient"
 }
 
+// Method binds of the Gradient class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGradientAddPoint      = gdnative.NewLazyMethodBind("Gradient", "add_point")
+	methodBindGradientGetColor      = gdnative.NewLazyMethodBind("Gradient", "get_color")
+	methodBindGradientGetColors     = gdnative.NewLazyMethodBind("Gradient", "get_colors")
+	methodBindGradientGetOffset     = gdnative.NewLazyMethodBind("Gradient", "get_offset")
+	methodBindGradientGetOffsets    = gdnative.NewLazyMethodBind("Gradient", "get_offsets")
+	methodBindGradientGetPointCount = gdnative.NewLazyMethodBind("Gradient", "get_point_count")
+	methodBindGradientInterpolate   = gdnative.NewLazyMethodBind("Gradient", "interpolate")
+	methodBindGradientRemovePoint   = gdnative.NewLazyMethodBind("Gradient", "remove_point")
+	methodBindGradientSetColor      = gdnative.NewLazyMethodBind("Gradient", "set_color")
+	methodBindGradientSetColors     = gdnative.NewLazyMethodBind("Gradient", "set_colors")
+	methodBindGradientSetOffset     = gdnative.NewLazyMethodBind("Gradient", "set_offset")
+	methodBindGradientSetOffsets    = gdnative.NewLazyMethodBind("Gradient", "set_offsets")
+)
+
 /*
-        Adds the specified color to the end of the ramp, with the specified offset
-	Args: [{ false offset float} { false color Color}], Returns: void
+	        Adds the specified color to the end of the ramp, with the specified offset
+		Args: [{ false offset float} { false color Color}], Returns: void
 */
 func (o *Gradient) AddPoint(offset gdnative.Real, color gdnative.Color) {
 	//log.Println("Calling Gradient.AddPoint()")
@@ -47,7 +73,7 @@ func (o *Gradient) AddPoint(offset gdnative.Real, color gdnative.Color) {
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "add_point")
+	methodBind := methodBindGradientAddPoint.Get()
 
 	// Call the parent method.
 	// void
@@ -57,8 +83,8 @@ func (o *Gradient) AddPoint(offset gdnative.Real, color gdnative.Color) {
 }
 
 /*
-        Returns the color of the ramp color at index [i]point[/i]
-	Args: [{ false point int}], Returns: Color
+	        Returns the color of the ramp color at index [i]point[/i]
+		Args: [{ false point int}], Returns: Color
 */
 func (o *Gradient) GetColor(point gdnative.Int) gdnative.Color {
 	//log.Println("Calling Gradient.GetColor()")
@@ -68,7 +94,7 @@ func (o *Gradient) GetColor(point gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(point)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "get_color")
+	methodBind := methodBindGradientGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -81,8 +107,8 @@ func (o *Gradient) GetColor(point gdnative.Int) gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolColorArray
+	        Undocumented
+		Args: [], Returns: PoolColorArray
 */
 func (o *Gradient) GetColors() gdnative.PoolColorArray {
 	//log.Println("Calling Gradient.GetColors()")
@@ -91,7 +117,7 @@ func (o *Gradient) GetColors() gdnative.PoolColorArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "get_colors")
+	methodBind := methodBindGradientGetColors.Get()
 
 	// Call the parent method.
 	// PoolColorArray
@@ -104,8 +130,8 @@ func (o *Gradient) GetColors() gdnative.PoolColorArray {
 }
 
 /*
-        Returns the offset of the ramp color at index [i]point[/i]
-	Args: [{ false point int}], Returns: float
+	        Returns the offset of the ramp color at index [i]point[/i]
+		Args: [{ false point int}], Returns: float
 */
 func (o *Gradient) GetOffset(point gdnative.Int) gdnative.Real {
 	//log.Println("Calling Gradient.GetOffset()")
@@ -115,7 +141,7 @@ func (o *Gradient) GetOffset(point gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(point)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "get_offset")
+	methodBind := methodBindGradientGetOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -128,8 +154,8 @@ func (o *Gradient) GetOffset(point gdnative.Int) gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolRealArray
+	        Undocumented
+		Args: [], Returns: PoolRealArray
 */
 func (o *Gradient) GetOffsets() gdnative.PoolRealArray {
 	//log.Println("Calling Gradient.GetOffsets()")
@@ -138,7 +164,7 @@ func (o *Gradient) GetOffsets() gdnative.PoolRealArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "get_offsets")
+	methodBind := methodBindGradientGetOffsets.Get()
 
 	// Call the parent method.
 	// PoolRealArray
@@ -151,8 +177,8 @@ func (o *Gradient) GetOffsets() gdnative.PoolRealArray {
 }
 
 /*
-        Returns the number of colors in the ramp
-	Args: [], Returns: int
+	        Returns the number of colors in the ramp
+		Args: [], Returns: int
 */
 func (o *Gradient) GetPointCount() gdnative.Int {
 	//log.Println("Calling Gradient.GetPointCount()")
@@ -161,7 +187,7 @@ func (o *Gradient) GetPointCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "get_point_count")
+	methodBind := methodBindGradientGetPointCount.Get()
 
 	// Call the parent method.
 	// int
@@ -174,8 +200,8 @@ func (o *Gradient) GetPointCount() gdnative.Int {
 }
 
 /*
-        Returns the interpolated color specified by [i]offset[/i]
-	Args: [{ false offset float}], Returns: Color
+	        Returns the interpolated color specified by [i]offset[/i]
+		Args: [{ false offset float}], Returns: Color
 */
 func (o *Gradient) Interpolate(offset gdnative.Real) gdnative.Color {
 	//log.Println("Calling Gradient.Interpolate()")
@@ -185,7 +211,7 @@ func (o *Gradient) Interpolate(offset gdnative.Real) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "interpolate")
+	methodBind := methodBindGradientInterpolate.Get()
 
 	// Call the parent method.
 	// Color
@@ -198,8 +224,8 @@ func (o *Gradient) Interpolate(offset gdnative.Real) gdnative.Color {
 }
 
 /*
-        Removes the color at the index [i]offset[/i]
-	Args: [{ false offset int}], Returns: void
+	        Removes the color at the index [i]offset[/i]
+		Args: [{ false offset int}], Returns: void
 */
 func (o *Gradient) RemovePoint(offset gdnative.Int) {
 	//log.Println("Calling Gradient.RemovePoint()")
@@ -209,7 +235,7 @@ func (o *Gradient) RemovePoint(offset gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "remove_point")
+	methodBind := methodBindGradientRemovePoint.Get()
 
 	// Call the parent method.
 	// void
@@ -219,8 +245,8 @@ func (o *Gradient) RemovePoint(offset gdnative.Int) {
 }
 
 /*
-        Sets the color of the ramp color at index [i]point[/i]
-	Args: [{ false point int} { false color Color}], Returns: void
+	        Sets the color of the ramp color at index [i]point[/i]
+		Args: [{ false point int} { false color Color}], Returns: void
 */
 func (o *Gradient) SetColor(point gdnative.Int, color gdnative.Color) {
 	//log.Println("Calling Gradient.SetColor()")
@@ -231,7 +257,7 @@ func (o *Gradient) SetColor(point gdnative.Int, color gdnative.Color) {
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "set_color")
+	methodBind := methodBindGradientSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -241,8 +267,8 @@ func (o *Gradient) SetColor(point gdnative.Int, color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false colors PoolColorArray}], Returns: void
+	        Undocumented
+		Args: [{ false colors PoolColorArray}], Returns: void
 */
 func (o *Gradient) SetColors(colors gdnative.PoolColorArray) {
 	//log.Println("Calling Gradient.SetColors()")
@@ -252,7 +278,7 @@ func (o *Gradient) SetColors(colors gdnative.PoolColorArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolColorArray(colors)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "set_colors")
+	methodBind := methodBindGradientSetColors.Get()
 
 	// Call the parent method.
 	// void
@@ -262,8 +288,8 @@ func (o *Gradient) SetColors(colors gdnative.PoolColorArray) {
 }
 
 /*
-        Sets the offset for the ramp color at index [i]point[/i]
-	Args: [{ false point int} { false offset float}], Returns: void
+	        Sets the offset for the ramp color at index [i]point[/i]
+		Args: [{ false point int} { false offset float}], Returns: void
 */
 func (o *Gradient) SetOffset(point gdnative.Int, offset gdnative.Real) {
 	//log.Println("Calling Gradient.SetOffset()")
@@ -274,7 +300,7 @@ func (o *Gradient) SetOffset(point gdnative.Int, offset gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "set_offset")
+	methodBind := methodBindGradientSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -284,8 +310,8 @@ func (o *Gradient) SetOffset(point gdnative.Int, offset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offsets PoolRealArray}], Returns: void
+	        Undocumented
+		Args: [{ false offsets PoolRealArray}], Returns: void
 */
 func (o *Gradient) SetOffsets(offsets gdnative.PoolRealArray) {
 	//log.Println("Calling Gradient.SetOffsets()")
@@ -295,7 +321,7 @@ func (o *Gradient) SetOffsets(offsets gdnative.PoolRealArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolRealArray(offsets)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Gradient", "set_offsets")
+	methodBind := methodBindGradientSetOffsets.Get()
 
 	// Call the parent method.
 	// void
@@ -304,10 +330,20 @@ func (o *Gradient) SetOffsets(offsets gdnative.PoolRealArray) {
 
 }
 
+// Colors will return the value of the "colors" property.
+func (o *Gradient) Colors() gdnative.PoolColorArray {
+	return o.GetColors()
+}
+
+// Offsets will return the value of the "offsets" property.
+func (o *Gradient) Offsets() gdnative.PoolRealArray {
+	return o.GetOffsets()
+}
+
 // GradientImplementer is an interface that implements the methods
 // of the Gradient class.
 type GradientImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	AddPoint(offset gdnative.Real, color gdnative.Color)
 	GetColor(point gdnative.Int) gdnative.Color
 	GetColors() gdnative.PoolColorArray
@@ -320,4 +356,6 @@ type GradientImplementer interface {
 	SetColors(colors gdnative.PoolColorArray)
 	SetOffset(point gdnative.Int, offset gdnative.Real)
 	SetOffsets(offsets gdnative.PoolRealArray)
+	Colors() gdnative.PoolColorArray
+	Offsets() gdnative.PoolRealArray
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGradientTextureFromPointer(ptr gdnative.Pointer) GradientTexture {
-func newGradientTextureFromPointer(ptr gdnative.Pointer) GradientTexture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GradientTexture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Uses a [Gradient] to fill the texture data, the gradient will be filled from left to right using colors obtained from the gradient, this means that the texture does not necessarily represent an exact copy of the gradient, but instead an interpolation of samples obtained from the gradient at fixed steps (see [method set_width]).
 */
 type GradientTexture struct {
-	Texture
+	godot.Texture
 	owner gdnative.Object
 }
 
+// classConstructorGradientTexture is the class constructor of the GradientTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGradientTexture = gdnative.NewLazyClassConstructor("GradientTexture")
+
+// NewGradientTexture will create a new instance of the GradientTexture class.
+// GradientTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewGradientTexture() *GradientTexture {
+	obj := &GradientTexture{}
+	obj.SetBaseObject(classConstructorGradientTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GradientTexture) BaseClass() string {
 	return "GradientTexture"
 }
 
+// Method binds of the GradientTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGradientTextureX_Update    = gdnative.NewLazyMethodBind("GradientTexture", "_update")
+	methodBindGradientTextureGetGradient = gdnative.NewLazyMethodBind("GradientTexture", "get_gradient")
+	methodBindGradientTextureSetGradient = gdnative.NewLazyMethodBind("GradientTexture", "set_gradient")
+	methodBindGradientTextureSetWidth    = gdnative.NewLazyMethodBind("GradientTexture", "set_width")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *GradientTexture) X_Update() {
 	//log.Println("Calling GradientTexture.X_Update()")
@@ -45,7 +63,7 @@ func (o *GradientTexture) X_Update() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GradientTexture", "_update")
+	methodBind := methodBindGradientTextureX_Update.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +73,8 @@ func (o *GradientTexture) X_Update() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Gradient
+	        Undocumented
+		Args: [], Returns: Gradient
 */
 func (o *GradientTexture) GetGradient() GradientImplementer {
 	//log.Println("Calling GradientTexture.GetGradient()")
@@ -65,7 +83,7 @@ func (o *GradientTexture) GetGradient() GradientImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GradientTexture", "get_gradient")
+	methodBind := methodBindGradientTextureGetGradient.Get()
 
 	// Call the parent method.
 	// Gradient
@@ -73,37 +91,35 @@ func (o *GradientTexture) GetGradient() GradientImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newGradientFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(GradientImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Gradient" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(GradientImplementer)
+	ret := Gradient{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(GradientImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false gradient Gradient}], Returns: void
+	        Undocumented
+		Args: [{ false gradient Gradient}], Returns: void
 */
 func (o *GradientTexture) SetGradient(gradient GradientImplementer) {
 	//log.Println("Calling GradientTexture.SetGradient()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(gradient.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(gradient))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GradientTexture", "set_gradient")
+	methodBind := methodBindGradientTextureSetGradient.Get()
 
 	// Call the parent method.
 	// void
@@ -113,8 +129,8 @@ func (o *GradientTexture) SetGradient(gradient GradientImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false width int}], Returns: void
+	        Undocumented
+		Args: [{ false width int}], Returns: void
 */
 func (o *GradientTexture) SetWidth(width gdnative.Int) {
 	//log.Println("Calling GradientTexture.SetWidth()")
@@ -124,7 +140,7 @@ func (o *GradientTexture) SetWidth(width gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GradientTexture", "set_width")
+	methodBind := methodBindGradientTextureSetWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -133,12 +149,24 @@ func (o *GradientTexture) SetWidth(width gdnative.Int) {
 
 }
 
+// Gradient will return the value of the "gradient" property.
+func (o *GradientTexture) Gradient() GradientImplementer {
+	return o.GetGradient()
+}
+
+// Width will return the value of the "width" property.
+func (o *GradientTexture) Width() gdnative.Int {
+	return o.GetWidth()
+}
+
 // GradientTextureImplementer is an interface that implements the methods
 // of the GradientTexture class.
 type GradientTextureImplementer interface {
-	TextureImplementer
+	godot.TextureImplementer
 	X_Update()
 	GetGradient() GradientImplementer
 	SetGradient(gradient GradientImplementer)
 	SetWidth(width gdnative.Int)
+	Gradient() GradientImplementer
+	Width() gdnative.Int
 }
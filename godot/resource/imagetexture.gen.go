@@ -0,0 +1,385 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// ImageTextureStorage is an enum for Storage values.
+type ImageTextureStorage int
+
+const (
+	ImageTextureStorageCompressLossless ImageTextureStorage = 2
+	ImageTextureStorageCompressLossy    ImageTextureStorage = 1
+	ImageTextureStorageRaw              ImageTextureStorage = 0
+)
+
+// String will return the Godot name of the ImageTextureStorage value.
+func (e ImageTextureStorage) String() string {
+	switch e {
+	case ImageTextureStorageCompressLossless:
+		return "STORAGE_COMPRESS_LOSSLESS"
+	case ImageTextureStorageCompressLossy:
+		return "STORAGE_COMPRESS_LOSSY"
+	case ImageTextureStorageRaw:
+		return "STORAGE_RAW"
+	}
+	return fmt.Sprintf("ImageTextureStorage(%d)", int(e))
+}
+
+// ParseImageTextureStorage will return the ImageTextureStorage value with the given Godot name.
+func ParseImageTextureStorage(name string) (ImageTextureStorage, error) {
+	switch name {
+	case "STORAGE_COMPRESS_LOSSLESS":
+		return ImageTextureStorageCompressLossless, nil
+	case "STORAGE_COMPRESS_LOSSY":
+		return ImageTextureStorageCompressLossy, nil
+	case "STORAGE_RAW":
+		return ImageTextureStorageRaw, nil
+	}
+	return 0, fmt.Errorf("invalid ImageTextureStorage value %q", name)
+}
+
+/*
+A [Texture] based on an [Image]. Can be created from an [Image] with [method create_from_image].
+*/
+type ImageTexture struct {
+	godot.Texture
+	owner gdnative.Object
+}
+
+// classConstructorImageTexture is the class constructor of the ImageTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorImageTexture = gdnative.NewLazyClassConstructor("ImageTexture")
+
+// NewImageTexture will create a new instance of the ImageTexture class.
+// ImageTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewImageTexture() *ImageTexture {
+	obj := &ImageTexture{}
+	obj.SetBaseObject(classConstructorImageTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *ImageTexture) BaseClass() string {
+	return "ImageTexture"
+}
+
+// Method binds of the ImageTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindImageTextureX_ReloadHook           = gdnative.NewLazyMethodBind("ImageTexture", "_reload_hook")
+	methodBindImageTextureCreate                 = gdnative.NewLazyMethodBind("ImageTexture", "create")
+	methodBindImageTextureCreateFromImage        = gdnative.NewLazyMethodBind("ImageTexture", "create_from_image")
+	methodBindImageTextureGetFormat              = gdnative.NewLazyMethodBind("ImageTexture", "get_format")
+	methodBindImageTextureGetLossyStorageQuality = gdnative.NewLazyMethodBind("ImageTexture", "get_lossy_storage_quality")
+	methodBindImageTextureGetStorage             = gdnative.NewLazyMethodBind("ImageTexture", "get_storage")
+	methodBindImageTextureLoad                   = gdnative.NewLazyMethodBind("ImageTexture", "load")
+	methodBindImageTextureSetData                = gdnative.NewLazyMethodBind("ImageTexture", "set_data")
+	methodBindImageTextureSetLossyStorageQuality = gdnative.NewLazyMethodBind("ImageTexture", "set_lossy_storage_quality")
+	methodBindImageTextureSetSizeOverride        = gdnative.NewLazyMethodBind("ImageTexture", "set_size_override")
+	methodBindImageTextureSetStorage             = gdnative.NewLazyMethodBind("ImageTexture", "set_storage")
+)
+
+/*
+	        Undocumented
+		Args: [{ false rid RID}], Returns: void
+*/
+func (o *ImageTexture) X_ReloadHook(rid gdnative.Rid) {
+	//log.Println("Calling ImageTexture.X_ReloadHook()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
+
+	// Get the method bind
+	methodBind := methodBindImageTextureX_ReloadHook.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Create a new [code]ImageTexture[/code] with "width" and "height". "format" one of [Image].FORMAT_*. "flags" one or more of [Texture].FLAG_*.
+		Args: [{ false width int} { false height int} { false format int} {7 true flags enum.Texture::Flags}], Returns: void
+*/
+func (o *ImageTexture) Create(width gdnative.Int, height gdnative.Int, format gdnative.Int, flags godot.TextureFlags) {
+	//log.Println("Calling ImageTexture.Create()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromInt(width)
+	ptrArguments[1] = gdnative.NewPointerFromInt(height)
+	ptrArguments[2] = gdnative.NewPointerFromInt(format)
+	ptrArguments[3] = gdnative.NewPointerFromInt(gdnative.Int(flags))
+
+	// Get the method bind
+	methodBind := methodBindImageTextureCreate.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Create a new [code]ImageTexture[/code] from an [Image] with "flags" from [Texture].FLAG_*.
+		Args: [{ false image Image} {7 true flags enum.Texture::Flags}], Returns: void
+*/
+func (o *ImageTexture) CreateFromImage(image godot.ImageImplementer, flags godot.TextureFlags) {
+	//log.Println("Calling ImageTexture.CreateFromImage()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(flags))
+
+	// Get the method bind
+	methodBind := methodBindImageTextureCreateFromImage.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Return the format of the [code]ImageTexture[/code], one of [Image].FORMAT_*.
+		Args: [], Returns: enum.Image::Format
+*/
+func (o *ImageTexture) GetFormat() godot.ImageFormat {
+	//log.Println("Calling ImageTexture.GetFormat()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindImageTextureGetFormat.Get()
+
+	// Call the parent method.
+	// enum.Image::Format
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return godot.ImageFormat(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *ImageTexture) GetLossyStorageQuality() gdnative.Real {
+	//log.Println("Calling ImageTexture.GetLossyStorageQuality()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindImageTextureGetLossyStorageQuality.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.ImageTexture::Storage
+*/
+func (o *ImageTexture) GetStorage() ImageTextureStorage {
+	//log.Println("Calling ImageTexture.GetStorage()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindImageTextureGetStorage.Get()
+
+	// Call the parent method.
+	// enum.ImageTexture::Storage
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ImageTextureStorage(ret)
+}
+
+/*
+	        Load an [code]ImageTexture[/code].
+		Args: [{ false path String}], Returns: void
+*/
+func (o *ImageTexture) Load(path gdnative.String) {
+	//log.Println("Calling ImageTexture.Load()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(path)
+
+	// Get the method bind
+	methodBind := methodBindImageTextureLoad.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set the [Image] of this [code]ImageTexture[/code].
+		Args: [{ false image Image}], Returns: void
+*/
+func (o *ImageTexture) SetData(image godot.ImageImplementer) {
+	//log.Println("Calling ImageTexture.SetData()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
+
+	// Get the method bind
+	methodBind := methodBindImageTextureSetData.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false quality float}], Returns: void
+*/
+func (o *ImageTexture) SetLossyStorageQuality(quality gdnative.Real) {
+	//log.Println("Calling ImageTexture.SetLossyStorageQuality()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(quality)
+
+	// Get the method bind
+	methodBind := methodBindImageTextureSetLossyStorageQuality.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Resizes the [code]ImageTexture[/code] to the specified dimensions.
+		Args: [{ false size Vector2}], Returns: void
+*/
+func (o *ImageTexture) SetSizeOverride(size gdnative.Vector2) {
+	//log.Println("Calling ImageTexture.SetSizeOverride()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
+
+	// Get the method bind
+	methodBind := methodBindImageTextureSetSizeOverride.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.ImageTexture::Storage}], Returns: void
+*/
+func (o *ImageTexture) SetStorage(mode ImageTextureStorage) {
+	//log.Println("Calling ImageTexture.SetStorage()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindImageTextureSetStorage.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// CreateWithDefaults will call Create using the default values for: flags.
+func (o *ImageTexture) CreateWithDefaults(width gdnative.Int, height gdnative.Int, format gdnative.Int) {
+	o.Create(width, height, format, 7)
+}
+
+// CreateFromImageWithDefaults will call CreateFromImage using the default values for: flags.
+func (o *ImageTexture) CreateFromImageWithDefaults(image godot.ImageImplementer) {
+	o.CreateFromImage(image, 7)
+}
+
+// LossyQuality will return the value of the "lossy_quality" property.
+func (o *ImageTexture) LossyQuality() gdnative.Real {
+	return o.GetLossyStorageQuality()
+}
+
+// SetLossyQuality will set the value of the "lossy_quality" property.
+func (o *ImageTexture) SetLossyQuality(value gdnative.Real) {
+	o.SetLossyStorageQuality(value)
+}
+
+// Storage will return the value of the "storage" property.
+func (o *ImageTexture) Storage() ImageTextureStorage {
+	return o.GetStorage()
+}
+
+// ImageTextureImplementer is an interface that implements the methods
+// of the ImageTexture class.
+type ImageTextureImplementer interface {
+	godot.TextureImplementer
+	X_ReloadHook(rid gdnative.Rid)
+	Create(width gdnative.Int, height gdnative.Int, format gdnative.Int, flags godot.TextureFlags)
+	CreateFromImage(image godot.ImageImplementer, flags godot.TextureFlags)
+	GetFormat() godot.ImageFormat
+	GetLossyStorageQuality() gdnative.Real
+	GetStorage() ImageTextureStorage
+	Load(path gdnative.String)
+	SetData(image godot.ImageImplementer)
+	SetLossyStorageQuality(quality gdnative.Real)
+	SetSizeOverride(size gdnative.Vector2)
+	SetStorage(mode ImageTextureStorage)
+	CreateWithDefaults(width gdnative.Int, height gdnative.Int, format gdnative.Int)
+	CreateFromImageWithDefaults(image godot.ImageImplementer)
+	LossyQuality() gdnative.Real
+	SetLossyQuality(value gdnative.Real)
+	Storage() ImageTextureStorage
+}
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,53 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewLargeTextureFromPointer(ptr gdnative.Pointer) LargeTexture {
-func newLargeTextureFromPointer(ptr gdnative.Pointer) LargeTexture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := LargeTexture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A Texture capable of storing many smaller Textures with offsets. You can dynamically add pieces([Texture]) to this [code]LargeTexture[/code] using different offsets.
 */
 type LargeTexture struct {
-	Texture
+	godot.Texture
 	owner gdnative.Object
 }
 
+// classConstructorLargeTexture is the class constructor of the LargeTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLargeTexture = gdnative.NewLazyClassConstructor("LargeTexture")
+
+// NewLargeTexture will create a new instance of the LargeTexture class.
+// LargeTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewLargeTexture() *LargeTexture {
+	obj := &LargeTexture{}
+	obj.SetBaseObject(classConstructorLargeTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *LargeTexture) BaseClass() string {
 	return "LargeTexture"
 }
 
+// Method binds of the LargeTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLargeTextureX_GetData       = gdnative.NewLazyMethodBind("LargeTexture", "_get_data")
+	methodBindLargeTextureX_SetData       = gdnative.NewLazyMethodBind("LargeTexture", "_set_data")
+	methodBindLargeTextureAddPiece        = gdnative.NewLazyMethodBind("LargeTexture", "add_piece")
+	methodBindLargeTextureClear           = gdnative.NewLazyMethodBind("LargeTexture", "clear")
+	methodBindLargeTextureGetPieceCount   = gdnative.NewLazyMethodBind("LargeTexture", "get_piece_count")
+	methodBindLargeTextureGetPieceOffset  = gdnative.NewLazyMethodBind("LargeTexture", "get_piece_offset")
+	methodBindLargeTextureGetPieceTexture = gdnative.NewLazyMethodBind("LargeTexture", "get_piece_texture")
+	methodBindLargeTextureSetPieceOffset  = gdnative.NewLazyMethodBind("LargeTexture", "set_piece_offset")
+	methodBindLargeTextureSetPieceTexture = gdnative.NewLazyMethodBind("LargeTexture", "set_piece_texture")
+	methodBindLargeTextureSetSize         = gdnative.NewLazyMethodBind("LargeTexture", "set_size")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *LargeTexture) X_GetData() gdnative.Array {
 	//log.Println("Calling LargeTexture.X_GetData()")
@@ -45,7 +69,7 @@ func (o *LargeTexture) X_GetData() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "_get_data")
+	methodBind := methodBindLargeTextureX_GetData.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +82,8 @@ func (o *LargeTexture) X_GetData() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false data Array}], Returns: void
+	        Undocumented
+		Args: [{ false data Array}], Returns: void
 */
 func (o *LargeTexture) X_SetData(data gdnative.Array) {
 	//log.Println("Calling LargeTexture.X_SetData()")
@@ -69,7 +93,7 @@ func (o *LargeTexture) X_SetData(data gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "_set_data")
+	methodBind := methodBindLargeTextureX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -79,19 +103,19 @@ func (o *LargeTexture) X_SetData(data gdnative.Array) {
 }
 
 /*
-        Add another [Texture] to this [code]LargeTexture[/code], starting on offset "ofs".
-	Args: [{ false ofs Vector2} { false texture Texture}], Returns: int
+	        Add another [Texture] to this [code]LargeTexture[/code], starting on offset "ofs".
+		Args: [{ false ofs Vector2} { false texture Texture}], Returns: int
 */
-func (o *LargeTexture) AddPiece(ofs gdnative.Vector2, texture TextureImplementer) gdnative.Int {
+func (o *LargeTexture) AddPiece(ofs gdnative.Vector2, texture godot.TextureImplementer) gdnative.Int {
 	//log.Println("Calling LargeTexture.AddPiece()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromVector2(ofs)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "add_piece")
+	methodBind := methodBindLargeTextureAddPiece.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +128,8 @@ func (o *LargeTexture) AddPiece(ofs gdnative.Vector2, texture TextureImplementer
 }
 
 /*
-        Clears the [code]LargeTexture[/code].
-	Args: [], Returns: void
+	        Clears the [code]LargeTexture[/code].
+		Args: [], Returns: void
 */
 func (o *LargeTexture) Clear() {
 	//log.Println("Calling LargeTexture.Clear()")
@@ -114,7 +138,7 @@ func (o *LargeTexture) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "clear")
+	methodBind := methodBindLargeTextureClear.Get()
 
 	// Call the parent method.
 	// void
@@ -124,8 +148,8 @@ func (o *LargeTexture) Clear() {
 }
 
 /*
-        Returns the number of pieces currently in this [code]LargeTexture[/code].
-	Args: [], Returns: int
+	        Returns the number of pieces currently in this [code]LargeTexture[/code].
+		Args: [], Returns: int
 */
 func (o *LargeTexture) GetPieceCount() gdnative.Int {
 	//log.Println("Calling LargeTexture.GetPieceCount()")
@@ -134,7 +158,7 @@ func (o *LargeTexture) GetPieceCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "get_piece_count")
+	methodBind := methodBindLargeTextureGetPieceCount.Get()
 
 	// Call the parent method.
 	// int
@@ -147,8 +171,8 @@ func (o *LargeTexture) GetPieceCount() gdnative.Int {
 }
 
 /*
-        Returns the offset of the piece with index "idx".
-	Args: [{ false idx int}], Returns: Vector2
+	        Returns the offset of the piece with index "idx".
+		Args: [{ false idx int}], Returns: Vector2
 */
 func (o *LargeTexture) GetPieceOffset(idx gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling LargeTexture.GetPieceOffset()")
@@ -158,7 +182,7 @@ func (o *LargeTexture) GetPieceOffset(idx gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "get_piece_offset")
+	methodBind := methodBindLargeTextureGetPieceOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -171,10 +195,10 @@ func (o *LargeTexture) GetPieceOffset(idx gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Returns the [Texture] of the piece with index "idx".
-	Args: [{ false idx int}], Returns: Texture
+	        Returns the [Texture] of the piece with index "idx".
+		Args: [{ false idx int}], Returns: Texture
 */
-func (o *LargeTexture) GetPieceTexture(idx gdnative.Int) TextureImplementer {
+func (o *LargeTexture) GetPieceTexture(idx gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling LargeTexture.GetPieceTexture()")
 
 	// Build out the method's arguments
@@ -182,7 +206,7 @@ func (o *LargeTexture) GetPieceTexture(idx gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "get_piece_texture")
+	methodBind := methodBindLargeTextureGetPieceTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -190,27 +214,25 @@ func (o *LargeTexture) GetPieceTexture(idx gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Sets the offset of the piece with index "idx" to "ofs".
-	Args: [{ false idx int} { false ofs Vector2}], Returns: void
+	        Sets the offset of the piece with index "idx" to "ofs".
+		Args: [{ false idx int} { false ofs Vector2}], Returns: void
 */
 func (o *LargeTexture) SetPieceOffset(idx gdnative.Int, ofs gdnative.Vector2) {
 	//log.Println("Calling LargeTexture.SetPieceOffset()")
@@ -221,7 +243,7 @@ func (o *LargeTexture) SetPieceOffset(idx gdnative.Int, ofs gdnative.Vector2) {
 	ptrArguments[1] = gdnative.NewPointerFromVector2(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "set_piece_offset")
+	methodBind := methodBindLargeTextureSetPieceOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -231,19 +253,19 @@ func (o *LargeTexture) SetPieceOffset(idx gdnative.Int, ofs gdnative.Vector2) {
 }
 
 /*
-        Sets the [Texture] of the piece with index "idx" to "ofs".
-	Args: [{ false idx int} { false texture Texture}], Returns: void
+	        Sets the [Texture] of the piece with index "idx" to "ofs".
+		Args: [{ false idx int} { false texture Texture}], Returns: void
 */
-func (o *LargeTexture) SetPieceTexture(idx gdnative.Int, texture TextureImplementer) {
+func (o *LargeTexture) SetPieceTexture(idx gdnative.Int, texture godot.TextureImplementer) {
 	//log.Println("Calling LargeTexture.SetPieceTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "set_piece_texture")
+	methodBind := methodBindLargeTextureSetPieceTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -253,8 +275,8 @@ func (o *LargeTexture) SetPieceTexture(idx gdnative.Int, texture TextureImplemen
 }
 
 /*
-        Sets the size of this [code]LargeTexture[/code].
-	Args: [{ false size Vector2}], Returns: void
+	        Sets the size of this [code]LargeTexture[/code].
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *LargeTexture) SetSize(size gdnative.Vector2) {
 	//log.Println("Calling LargeTexture.SetSize()")
@@ -264,7 +286,7 @@ func (o *LargeTexture) SetSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LargeTexture", "set_size")
+	methodBind := methodBindLargeTextureSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -276,15 +298,15 @@ func (o *LargeTexture) SetSize(size gdnative.Vector2) {
 // LargeTextureImplementer is an interface that implements the methods
 // of the LargeTexture class.
 type LargeTextureImplementer interface {
-	TextureImplementer
+	godot.TextureImplementer
 	X_GetData() gdnative.Array
 	X_SetData(data gdnative.Array)
-	AddPiece(ofs gdnative.Vector2, texture TextureImplementer) gdnative.Int
+	AddPiece(ofs gdnative.Vector2, texture godot.TextureImplementer) gdnative.Int
 	Clear()
 	GetPieceCount() gdnative.Int
 	GetPieceOffset(idx gdnative.Int) gdnative.Vector2
-	GetPieceTexture(idx gdnative.Int) TextureImplementer
+	GetPieceTexture(idx gdnative.Int) godot.TextureImplementer
 	SetPieceOffset(idx gdnative.Int, ofs gdnative.Vector2)
-	SetPieceTexture(idx gdnative.Int, texture TextureImplementer)
+	SetPieceTexture(idx gdnative.Int, texture godot.TextureImplementer)
 	SetSize(size gdnative.Vector2)
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewLineShape2DFromPointer(ptr gdnative.Pointer) LineShape2D {
-func newLineShape2DFromPointer(ptr gdnative.Pointer) LineShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := LineShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Line shape for 2D collisions. It works like a 2D plane and will not allow any body to go to the negative side. Not recommended for rigid bodies, and usually not recommended for static bodies either because it forces checks against it on every frame.
 */
 type LineShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorLineShape2D is the class constructor of the LineShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorLineShape2D = gdnative.NewLazyClassConstructor("LineShape2D")
+
+// NewLineShape2D will create a new instance of the LineShape2D class.
+// LineShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewLineShape2D() *LineShape2D {
+	obj := &LineShape2D{}
+	obj.SetBaseObject(classConstructorLineShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *LineShape2D) BaseClass() string {
 	return "LineShape2D"
 }
 
+// Method binds of the LineShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLineShape2DGetD      = gdnative.NewLazyMethodBind("LineShape2D", "get_d")
+	methodBindLineShape2DGetNormal = gdnative.NewLazyMethodBind("LineShape2D", "get_normal")
+	methodBindLineShape2DSetD      = gdnative.NewLazyMethodBind("LineShape2D", "set_d")
+	methodBindLineShape2DSetNormal = gdnative.NewLazyMethodBind("LineShape2D", "set_normal")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *LineShape2D) GetD() gdnative.Real {
 	//log.Println("Calling LineShape2D.GetD()")
@@ -45,7 +63,7 @@ func (o *LineShape2D) GetD() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineShape2D", "get_d")
+	methodBind := methodBindLineShape2DGetD.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +76,8 @@ func (o *LineShape2D) GetD() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *LineShape2D) GetNormal() gdnative.Vector2 {
 	//log.Println("Calling LineShape2D.GetNormal()")
@@ -68,7 +86,7 @@ func (o *LineShape2D) GetNormal() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineShape2D", "get_normal")
+	methodBind := methodBindLineShape2DGetNormal.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +99,8 @@ func (o *LineShape2D) GetNormal() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false d float}], Returns: void
+	        Undocumented
+		Args: [{ false d float}], Returns: void
 */
 func (o *LineShape2D) SetD(d gdnative.Real) {
 	//log.Println("Calling LineShape2D.SetD()")
@@ -92,7 +110,7 @@ func (o *LineShape2D) SetD(d gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(d)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineShape2D", "set_d")
+	methodBind := methodBindLineShape2DSetD.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *LineShape2D) SetD(d gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false normal Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false normal Vector2}], Returns: void
 */
 func (o *LineShape2D) SetNormal(normal gdnative.Vector2) {
 	//log.Println("Calling LineShape2D.SetNormal()")
@@ -113,7 +131,7 @@ func (o *LineShape2D) SetNormal(normal gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(normal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("LineShape2D", "set_normal")
+	methodBind := methodBindLineShape2DSetNormal.Get()
 
 	// Call the parent method.
 	// void
@@ -122,12 +140,24 @@ func (o *LineShape2D) SetNormal(normal gdnative.Vector2) {
 
 }
 
+// D will return the value of the "d" property.
+func (o *LineShape2D) D() gdnative.Real {
+	return o.GetD()
+}
+
+// Normal will return the value of the "normal" property.
+func (o *LineShape2D) Normal() gdnative.Vector2 {
+	return o.GetNormal()
+}
+
 // LineShape2DImplementer is an interface that implements the methods
 // of the LineShape2D class.
 type LineShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetD() gdnative.Real
 	GetNormal() gdnative.Vector2
 	SetD(d gdnative.Real)
 	SetNormal(normal gdnative.Vector2)
+	D() gdnative.Real
+	Normal() gdnative.Vector2
 }
@@ -1,7 +1,10 @@
-package godot
+package resource
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +25,32 @@ const (
 	MultiMeshColorNone  MultiMeshColorFormat = 0
 )
 
+// String will return the Godot name of the MultiMeshColorFormat value.
+func (e MultiMeshColorFormat) String() string {
+	switch e {
+	case MultiMeshColor8Bit:
+		return "COLOR_8BIT"
+	case MultiMeshColorFloat:
+		return "COLOR_FLOAT"
+	case MultiMeshColorNone:
+		return "COLOR_NONE"
+	}
+	return fmt.Sprintf("MultiMeshColorFormat(%d)", int(e))
+}
+
+// ParseMultiMeshColorFormat will return the MultiMeshColorFormat value with the given Godot name.
+func ParseMultiMeshColorFormat(name string) (MultiMeshColorFormat, error) {
+	switch name {
+	case "COLOR_8BIT":
+		return MultiMeshColor8Bit, nil
+	case "COLOR_FLOAT":
+		return MultiMeshColorFloat, nil
+	case "COLOR_NONE":
+		return MultiMeshColorNone, nil
+	}
+	return 0, fmt.Errorf("invalid MultiMeshColorFormat value %q", name)
+}
+
 // MultiMeshTransformFormat is an enum for TransformFormat values.
 type MultiMeshTransformFormat int
 
@@ -30,30 +59,82 @@ const (
 	MultiMeshTransform3D MultiMeshTransformFormat = 1
 )
 
-//func NewMultiMeshFromPointer(ptr gdnative.Pointer) MultiMesh {
-func newMultiMeshFromPointer(ptr gdnative.Pointer) MultiMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := MultiMesh{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the MultiMeshTransformFormat value.
+func (e MultiMeshTransformFormat) String() string {
+	switch e {
+	case MultiMeshTransform2D:
+		return "TRANSFORM_2D"
+	case MultiMeshTransform3D:
+		return "TRANSFORM_3D"
+	}
+	return fmt.Sprintf("MultiMeshTransformFormat(%d)", int(e))
+}
 
-	return obj
+// ParseMultiMeshTransformFormat will return the MultiMeshTransformFormat value with the given Godot name.
+func ParseMultiMeshTransformFormat(name string) (MultiMeshTransformFormat, error) {
+	switch name {
+	case "TRANSFORM_2D":
+		return MultiMeshTransform2D, nil
+	case "TRANSFORM_3D":
+		return MultiMeshTransform3D, nil
+	}
+	return 0, fmt.Errorf("invalid MultiMeshTransformFormat value %q", name)
 }
 
 /*
 MultiMesh provides low level mesh instancing. If the amount of [Mesh] instances needed goes from hundreds to thousands (and most need to be visible at close proximity) creating such a large amount of [MeshInstance] nodes may affect performance by using too much CPU or video memory. For this case a MultiMesh becomes very useful, as it can draw thousands of instances with little API overhead. As a drawback, if the instances are too far away of each other, performance may be reduced as every single instance will always rendered (they are spatially indexed as one, for the whole object). Since instances may have any behavior, the AABB used for visibility must be provided by the user.
 */
 type MultiMesh struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorMultiMesh is the class constructor of the MultiMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMultiMesh = gdnative.NewLazyClassConstructor("MultiMesh")
+
+// NewMultiMesh will create a new instance of the MultiMesh class.
+// MultiMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewMultiMesh() *MultiMesh {
+	obj := &MultiMesh{}
+	obj.SetBaseObject(classConstructorMultiMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *MultiMesh) BaseClass() string {
 	return "MultiMesh"
 }
 
+// Method binds of the MultiMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMultiMeshX_GetColorArray      = gdnative.NewLazyMethodBind("MultiMesh", "_get_color_array")
+	methodBindMultiMeshX_GetTransformArray  = gdnative.NewLazyMethodBind("MultiMesh", "_get_transform_array")
+	methodBindMultiMeshX_SetColorArray      = gdnative.NewLazyMethodBind("MultiMesh", "_set_color_array")
+	methodBindMultiMeshX_SetTransformArray  = gdnative.NewLazyMethodBind("MultiMesh", "_set_transform_array")
+	methodBindMultiMeshGetAabb              = gdnative.NewLazyMethodBind("MultiMesh", "get_aabb")
+	methodBindMultiMeshGetColorFormat       = gdnative.NewLazyMethodBind("MultiMesh", "get_color_format")
+	methodBindMultiMeshGetInstanceColor     = gdnative.NewLazyMethodBind("MultiMesh", "get_instance_color")
+	methodBindMultiMeshGetInstanceCount     = gdnative.NewLazyMethodBind("MultiMesh", "get_instance_count")
+	methodBindMultiMeshGetInstanceTransform = gdnative.NewLazyMethodBind("MultiMesh", "get_instance_transform")
+	methodBindMultiMeshGetMesh              = gdnative.NewLazyMethodBind("MultiMesh", "get_mesh")
+	methodBindMultiMeshGetTransformFormat   = gdnative.NewLazyMethodBind("MultiMesh", "get_transform_format")
+	methodBindMultiMeshSetColorFormat       = gdnative.NewLazyMethodBind("MultiMesh", "set_color_format")
+	methodBindMultiMeshSetInstanceColor     = gdnative.NewLazyMethodBind("MultiMesh", "set_instance_color")
+	methodBindMultiMeshSetInstanceCount     = gdnative.NewLazyMethodBind("MultiMesh", "set_instance_count")
+	methodBindMultiMeshSetInstanceTransform = gdnative.NewLazyMethodBind("MultiMesh", "set_instance_transform")
+	methodBindMultiMeshSetMesh              = gdnative.NewLazyMethodBind("MultiMesh", "set_mesh")
+	methodBindMultiMeshSetTransformFormat   = gdnative.NewLazyMethodBind("MultiMesh", "set_transform_format")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolColorArray
+	        Undocumented
+		Args: [], Returns: PoolColorArray
 */
 func (o *MultiMesh) X_GetColorArray() gdnative.PoolColorArray {
 	//log.Println("Calling MultiMesh.X_GetColorArray()")
@@ -62,7 +143,7 @@ func (o *MultiMesh) X_GetColorArray() gdnative.PoolColorArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "_get_color_array")
+	methodBind := methodBindMultiMeshX_GetColorArray.Get()
 
 	// Call the parent method.
 	// PoolColorArray
@@ -75,8 +156,8 @@ func (o *MultiMesh) X_GetColorArray() gdnative.PoolColorArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolVector3Array
+	        Undocumented
+		Args: [], Returns: PoolVector3Array
 */
 func (o *MultiMesh) X_GetTransformArray() gdnative.PoolVector3Array {
 	//log.Println("Calling MultiMesh.X_GetTransformArray()")
@@ -85,7 +166,7 @@ func (o *MultiMesh) X_GetTransformArray() gdnative.PoolVector3Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "_get_transform_array")
+	methodBind := methodBindMultiMeshX_GetTransformArray.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -98,8 +179,8 @@ func (o *MultiMesh) X_GetTransformArray() gdnative.PoolVector3Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 PoolColorArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 PoolColorArray}], Returns: void
 */
 func (o *MultiMesh) X_SetColorArray(arg0 gdnative.PoolColorArray) {
 	//log.Println("Calling MultiMesh.X_SetColorArray()")
@@ -109,7 +190,7 @@ func (o *MultiMesh) X_SetColorArray(arg0 gdnative.PoolColorArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolColorArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "_set_color_array")
+	methodBind := methodBindMultiMeshX_SetColorArray.Get()
 
 	// Call the parent method.
 	// void
@@ -119,8 +200,8 @@ func (o *MultiMesh) X_SetColorArray(arg0 gdnative.PoolColorArray) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 PoolVector3Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 PoolVector3Array}], Returns: void
 */
 func (o *MultiMesh) X_SetTransformArray(arg0 gdnative.PoolVector3Array) {
 	//log.Println("Calling MultiMesh.X_SetTransformArray()")
@@ -130,7 +211,7 @@ func (o *MultiMesh) X_SetTransformArray(arg0 gdnative.PoolVector3Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector3Array(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "_set_transform_array")
+	methodBind := methodBindMultiMeshX_SetTransformArray.Get()
 
 	// Call the parent method.
 	// void
@@ -140,8 +221,8 @@ func (o *MultiMesh) X_SetTransformArray(arg0 gdnative.PoolVector3Array) {
 }
 
 /*
-        Return the visibility AABB.
-	Args: [], Returns: AABB
+	        Return the visibility AABB.
+		Args: [], Returns: AABB
 */
 func (o *MultiMesh) GetAabb() gdnative.Aabb {
 	//log.Println("Calling MultiMesh.GetAabb()")
@@ -150,7 +231,7 @@ func (o *MultiMesh) GetAabb() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "get_aabb")
+	methodBind := methodBindMultiMeshGetAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -163,8 +244,8 @@ func (o *MultiMesh) GetAabb() gdnative.Aabb {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.MultiMesh::ColorFormat
+	        Undocumented
+		Args: [], Returns: enum.MultiMesh::ColorFormat
 */
 func (o *MultiMesh) GetColorFormat() MultiMeshColorFormat {
 	//log.Println("Calling MultiMesh.GetColorFormat()")
@@ -173,7 +254,7 @@ func (o *MultiMesh) GetColorFormat() MultiMeshColorFormat {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "get_color_format")
+	methodBind := methodBindMultiMeshGetColorFormat.Get()
 
 	// Call the parent method.
 	// enum.MultiMesh::ColorFormat
@@ -186,8 +267,8 @@ func (o *MultiMesh) GetColorFormat() MultiMeshColorFormat {
 }
 
 /*
-        Get the color of a specific instance.
-	Args: [{ false instance int}], Returns: Color
+	        Get the color of a specific instance.
+		Args: [{ false instance int}], Returns: Color
 */
 func (o *MultiMesh) GetInstanceColor(instance gdnative.Int) gdnative.Color {
 	//log.Println("Calling MultiMesh.GetInstanceColor()")
@@ -197,7 +278,7 @@ func (o *MultiMesh) GetInstanceColor(instance gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(instance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "get_instance_color")
+	methodBind := methodBindMultiMeshGetInstanceColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -210,8 +291,8 @@ func (o *MultiMesh) GetInstanceColor(instance gdnative.Int) gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *MultiMesh) GetInstanceCount() gdnative.Int {
 	//log.Println("Calling MultiMesh.GetInstanceCount()")
@@ -220,7 +301,7 @@ func (o *MultiMesh) GetInstanceCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "get_instance_count")
+	methodBind := methodBindMultiMeshGetInstanceCount.Get()
 
 	// Call the parent method.
 	// int
@@ -233,8 +314,8 @@ func (o *MultiMesh) GetInstanceCount() gdnative.Int {
 }
 
 /*
-        Return the transform of a specific instance.
-	Args: [{ false instance int}], Returns: Transform
+	        Return the transform of a specific instance.
+		Args: [{ false instance int}], Returns: Transform
 */
 func (o *MultiMesh) GetInstanceTransform(instance gdnative.Int) gdnative.Transform {
 	//log.Println("Calling MultiMesh.GetInstanceTransform()")
@@ -244,7 +325,7 @@ func (o *MultiMesh) GetInstanceTransform(instance gdnative.Int) gdnative.Transfo
 	ptrArguments[0] = gdnative.NewPointerFromInt(instance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "get_instance_transform")
+	methodBind := methodBindMultiMeshGetInstanceTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -257,17 +338,17 @@ func (o *MultiMesh) GetInstanceTransform(instance gdnative.Int) gdnative.Transfo
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Mesh
+	        Undocumented
+		Args: [], Returns: Mesh
 */
-func (o *MultiMesh) GetMesh() MeshImplementer {
+func (o *MultiMesh) GetMesh() godot.MeshImplementer {
 	//log.Println("Calling MultiMesh.GetMesh()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "get_mesh")
+	methodBind := methodBindMultiMeshGetMesh.Get()
 
 	// Call the parent method.
 	// Mesh
@@ -275,27 +356,25 @@ func (o *MultiMesh) GetMesh() MeshImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Mesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MeshImplementer)
+	ret := godot.Mesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.MultiMesh::TransformFormat
+	        Undocumented
+		Args: [], Returns: enum.MultiMesh::TransformFormat
 */
 func (o *MultiMesh) GetTransformFormat() MultiMeshTransformFormat {
 	//log.Println("Calling MultiMesh.GetTransformFormat()")
@@ -304,7 +383,7 @@ func (o *MultiMesh) GetTransformFormat() MultiMeshTransformFormat {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "get_transform_format")
+	methodBind := methodBindMultiMeshGetTransformFormat.Get()
 
 	// Call the parent method.
 	// enum.MultiMesh::TransformFormat
@@ -317,18 +396,18 @@ func (o *MultiMesh) GetTransformFormat() MultiMeshTransformFormat {
 }
 
 /*
-        Undocumented
-	Args: [{ false format int}], Returns: void
+	        Undocumented
+		Args: [{ false format enum.MultiMesh::ColorFormat}], Returns: void
 */
-func (o *MultiMesh) SetColorFormat(format gdnative.Int) {
+func (o *MultiMesh) SetColorFormat(format MultiMeshColorFormat) {
 	//log.Println("Calling MultiMesh.SetColorFormat()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(format)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(format))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "set_color_format")
+	methodBind := methodBindMultiMeshSetColorFormat.Get()
 
 	// Call the parent method.
 	// void
@@ -338,8 +417,8 @@ func (o *MultiMesh) SetColorFormat(format gdnative.Int) {
 }
 
 /*
-        Set the color of a specific instance.
-	Args: [{ false instance int} { false color Color}], Returns: void
+	        Set the color of a specific instance.
+		Args: [{ false instance int} { false color Color}], Returns: void
 */
 func (o *MultiMesh) SetInstanceColor(instance gdnative.Int, color gdnative.Color) {
 	//log.Println("Calling MultiMesh.SetInstanceColor()")
@@ -350,7 +429,7 @@ func (o *MultiMesh) SetInstanceColor(instance gdnative.Int, color gdnative.Color
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "set_instance_color")
+	methodBind := methodBindMultiMeshSetInstanceColor.Get()
 
 	// Call the parent method.
 	// void
@@ -360,8 +439,8 @@ func (o *MultiMesh) SetInstanceColor(instance gdnative.Int, color gdnative.Color
 }
 
 /*
-        Undocumented
-	Args: [{ false count int}], Returns: void
+	        Undocumented
+		Args: [{ false count int}], Returns: void
 */
 func (o *MultiMesh) SetInstanceCount(count gdnative.Int) {
 	//log.Println("Calling MultiMesh.SetInstanceCount()")
@@ -371,7 +450,7 @@ func (o *MultiMesh) SetInstanceCount(count gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(count)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "set_instance_count")
+	methodBind := methodBindMultiMeshSetInstanceCount.Get()
 
 	// Call the parent method.
 	// void
@@ -381,8 +460,8 @@ func (o *MultiMesh) SetInstanceCount(count gdnative.Int) {
 }
 
 /*
-        Set the transform for a specific instance.
-	Args: [{ false instance int} { false transform Transform}], Returns: void
+	        Set the transform for a specific instance.
+		Args: [{ false instance int} { false transform Transform}], Returns: void
 */
 func (o *MultiMesh) SetInstanceTransform(instance gdnative.Int, transform gdnative.Transform) {
 	//log.Println("Calling MultiMesh.SetInstanceTransform()")
@@ -393,7 +472,7 @@ func (o *MultiMesh) SetInstanceTransform(instance gdnative.Int, transform gdnati
 	ptrArguments[1] = gdnative.NewPointerFromTransform(transform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "set_instance_transform")
+	methodBind := methodBindMultiMeshSetInstanceTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -403,18 +482,18 @@ func (o *MultiMesh) SetInstanceTransform(instance gdnative.Int, transform gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false mesh Mesh}], Returns: void
+	        Undocumented
+		Args: [{ false mesh Mesh}], Returns: void
 */
-func (o *MultiMesh) SetMesh(mesh MeshImplementer) {
+func (o *MultiMesh) SetMesh(mesh godot.MeshImplementer) {
 	//log.Println("Calling MultiMesh.SetMesh()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "set_mesh")
+	methodBind := methodBindMultiMeshSetMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -424,18 +503,18 @@ func (o *MultiMesh) SetMesh(mesh MeshImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false format int}], Returns: void
+	        Undocumented
+		Args: [{ false format enum.MultiMesh::TransformFormat}], Returns: void
 */
-func (o *MultiMesh) SetTransformFormat(format gdnative.Int) {
+func (o *MultiMesh) SetTransformFormat(format MultiMeshTransformFormat) {
 	//log.Println("Calling MultiMesh.SetTransformFormat()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(format)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(format))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MultiMesh", "set_transform_format")
+	methodBind := methodBindMultiMeshSetTransformFormat.Get()
 
 	// Call the parent method.
 	// void
@@ -444,23 +523,73 @@ func (o *MultiMesh) SetTransformFormat(format gdnative.Int) {
 
 }
 
+// ColorArray will return the value of the "color_array" property.
+func (o *MultiMesh) ColorArray() gdnative.PoolColorArray {
+	return o.X_GetColorArray()
+}
+
+// SetColorArray will set the value of the "color_array" property.
+func (o *MultiMesh) SetColorArray(value gdnative.PoolColorArray) {
+	o.X_SetColorArray(value)
+}
+
+// ColorFormat will return the value of the "color_format" property.
+func (o *MultiMesh) ColorFormat() MultiMeshColorFormat {
+	return o.GetColorFormat()
+}
+
+// InstanceCount will return the value of the "instance_count" property.
+func (o *MultiMesh) InstanceCount() gdnative.Int {
+	return o.GetInstanceCount()
+}
+
+// Mesh will return the value of the "mesh" property.
+func (o *MultiMesh) Mesh() godot.MeshImplementer {
+	return o.GetMesh()
+}
+
+// TransformArray will return the value of the "transform_array" property.
+func (o *MultiMesh) TransformArray() gdnative.PoolVector3Array {
+	return o.X_GetTransformArray()
+}
+
+// SetTransformArray will set the value of the "transform_array" property.
+func (o *MultiMesh) SetTransformArray(value gdnative.PoolVector3Array) {
+	o.X_SetTransformArray(value)
+}
+
+// TransformFormat will return the value of the "transform_format" property.
+func (o *MultiMesh) TransformFormat() MultiMeshTransformFormat {
+	return o.GetTransformFormat()
+}
+
 // MultiMeshImplementer is an interface that implements the methods
 // of the MultiMesh class.
 type MultiMeshImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetColorArray() gdnative.PoolColorArray
 	X_GetTransformArray() gdnative.PoolVector3Array
 	X_SetColorArray(arg0 gdnative.PoolColorArray)
 	X_SetTransformArray(arg0 gdnative.PoolVector3Array)
 	GetAabb() gdnative.Aabb
+	GetColorFormat() MultiMeshColorFormat
 	GetInstanceColor(instance gdnative.Int) gdnative.Color
 	GetInstanceCount() gdnative.Int
 	GetInstanceTransform(instance gdnative.Int) gdnative.Transform
-	GetMesh() MeshImplementer
-	SetColorFormat(format gdnative.Int)
+	GetMesh() godot.MeshImplementer
+	GetTransformFormat() MultiMeshTransformFormat
+	SetColorFormat(format MultiMeshColorFormat)
 	SetInstanceColor(instance gdnative.Int, color gdnative.Color)
 	SetInstanceCount(count gdnative.Int)
 	SetInstanceTransform(instance gdnative.Int, transform gdnative.Transform)
-	SetMesh(mesh MeshImplementer)
-	SetTransformFormat(format gdnative.Int)
+	SetMesh(mesh godot.MeshImplementer)
+	SetTransformFormat(format MultiMeshTransformFormat)
+	ColorArray() gdnative.PoolColorArray
+	SetColorArray(value gdnative.PoolColorArray)
+	ColorFormat() MultiMeshColorFormat
+	InstanceCount() gdnative.Int
+	Mesh() godot.MeshImplementer
+	TransformArray() gdnative.PoolVector3Array
+	SetTransformArray(value gdnative.PoolVector3Array)
+	TransformFormat() MultiMeshTransformFormat
 }
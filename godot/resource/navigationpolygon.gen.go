@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,60 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewNavigationPolygonFromPointer(ptr gdnative.Pointer) NavigationPolygon {
-func newNavigationPolygonFromPointer(ptr gdnative.Pointer) NavigationPolygon {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := NavigationPolygon{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type NavigationPolygon struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorNavigationPolygon is the class constructor of the NavigationPolygon class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNavigationPolygon = gdnative.NewLazyClassConstructor("NavigationPolygon")
+
+// NewNavigationPolygon will create a new instance of the NavigationPolygon class.
+// NavigationPolygon is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewNavigationPolygon() *NavigationPolygon {
+	obj := &NavigationPolygon{}
+	obj.SetBaseObject(classConstructorNavigationPolygon.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *NavigationPolygon) BaseClass() string {
 	return "NavigationPolygon"
 }
 
+// Method binds of the NavigationPolygon class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNavigationPolygonX_GetOutlines            = gdnative.NewLazyMethodBind("NavigationPolygon", "_get_outlines")
+	methodBindNavigationPolygonX_GetPolygons            = gdnative.NewLazyMethodBind("NavigationPolygon", "_get_polygons")
+	methodBindNavigationPolygonX_SetOutlines            = gdnative.NewLazyMethodBind("NavigationPolygon", "_set_outlines")
+	methodBindNavigationPolygonX_SetPolygons            = gdnative.NewLazyMethodBind("NavigationPolygon", "_set_polygons")
+	methodBindNavigationPolygonAddOutline               = gdnative.NewLazyMethodBind("NavigationPolygon", "add_outline")
+	methodBindNavigationPolygonAddOutlineAtIndex        = gdnative.NewLazyMethodBind("NavigationPolygon", "add_outline_at_index")
+	methodBindNavigationPolygonAddPolygon               = gdnative.NewLazyMethodBind("NavigationPolygon", "add_polygon")
+	methodBindNavigationPolygonClearOutlines            = gdnative.NewLazyMethodBind("NavigationPolygon", "clear_outlines")
+	methodBindNavigationPolygonClearPolygons            = gdnative.NewLazyMethodBind("NavigationPolygon", "clear_polygons")
+	methodBindNavigationPolygonGetOutline               = gdnative.NewLazyMethodBind("NavigationPolygon", "get_outline")
+	methodBindNavigationPolygonGetOutlineCount          = gdnative.NewLazyMethodBind("NavigationPolygon", "get_outline_count")
+	methodBindNavigationPolygonGetPolygon               = gdnative.NewLazyMethodBind("NavigationPolygon", "get_polygon")
+	methodBindNavigationPolygonGetPolygonCount          = gdnative.NewLazyMethodBind("NavigationPolygon", "get_polygon_count")
+	methodBindNavigationPolygonGetVertices              = gdnative.NewLazyMethodBind("NavigationPolygon", "get_vertices")
+	methodBindNavigationPolygonMakePolygonsFromOutlines = gdnative.NewLazyMethodBind("NavigationPolygon", "make_polygons_from_outlines")
+	methodBindNavigationPolygonRemoveOutline            = gdnative.NewLazyMethodBind("NavigationPolygon", "remove_outline")
+	methodBindNavigationPolygonSetOutline               = gdnative.NewLazyMethodBind("NavigationPolygon", "set_outline")
+	methodBindNavigationPolygonSetVertices              = gdnative.NewLazyMethodBind("NavigationPolygon", "set_vertices")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *NavigationPolygon) X_GetOutlines() gdnative.Array {
 	//log.Println("Calling NavigationPolygon.X_GetOutlines()")
@@ -45,7 +76,7 @@ func (o *NavigationPolygon) X_GetOutlines() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "_get_outlines")
+	methodBind := methodBindNavigationPolygonX_GetOutlines.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +89,8 @@ func (o *NavigationPolygon) X_GetOutlines() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *NavigationPolygon) X_GetPolygons() gdnative.Array {
 	//log.Println("Calling NavigationPolygon.X_GetPolygons()")
@@ -68,7 +99,7 @@ func (o *NavigationPolygon) X_GetPolygons() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "_get_polygons")
+	methodBind := methodBindNavigationPolygonX_GetPolygons.Get()
 
 	// Call the parent method.
 	// Array
@@ -81,8 +112,8 @@ func (o *NavigationPolygon) X_GetPolygons() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false outlines Array}], Returns: void
+	        Undocumented
+		Args: [{ false outlines Array}], Returns: void
 */
 func (o *NavigationPolygon) X_SetOutlines(outlines gdnative.Array) {
 	//log.Println("Calling NavigationPolygon.X_SetOutlines()")
@@ -92,7 +123,7 @@ func (o *NavigationPolygon) X_SetOutlines(outlines gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(outlines)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "_set_outlines")
+	methodBind := methodBindNavigationPolygonX_SetOutlines.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +133,8 @@ func (o *NavigationPolygon) X_SetOutlines(outlines gdnative.Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false polygons Array}], Returns: void
+	        Undocumented
+		Args: [{ false polygons Array}], Returns: void
 */
 func (o *NavigationPolygon) X_SetPolygons(polygons gdnative.Array) {
 	//log.Println("Calling NavigationPolygon.X_SetPolygons()")
@@ -113,7 +144,7 @@ func (o *NavigationPolygon) X_SetPolygons(polygons gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(polygons)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "_set_polygons")
+	methodBind := methodBindNavigationPolygonX_SetPolygons.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +154,7 @@ func (o *NavigationPolygon) X_SetPolygons(polygons gdnative.Array) {
 }
 
 /*
-
-	Args: [{ false outline PoolVector2Array}], Returns: void
+Args: [{ false outline PoolVector2Array}], Returns: void
 */
 func (o *NavigationPolygon) AddOutline(outline gdnative.PoolVector2Array) {
 	//log.Println("Calling NavigationPolygon.AddOutline()")
@@ -134,7 +164,7 @@ func (o *NavigationPolygon) AddOutline(outline gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(outline)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "add_outline")
+	methodBind := methodBindNavigationPolygonAddOutline.Get()
 
 	// Call the parent method.
 	// void
@@ -144,8 +174,7 @@ func (o *NavigationPolygon) AddOutline(outline gdnative.PoolVector2Array) {
 }
 
 /*
-
-	Args: [{ false outline PoolVector2Array} { false index int}], Returns: void
+Args: [{ false outline PoolVector2Array} { false index int}], Returns: void
 */
 func (o *NavigationPolygon) AddOutlineAtIndex(outline gdnative.PoolVector2Array, index gdnative.Int) {
 	//log.Println("Calling NavigationPolygon.AddOutlineAtIndex()")
@@ -156,7 +185,7 @@ func (o *NavigationPolygon) AddOutlineAtIndex(outline gdnative.PoolVector2Array,
 	ptrArguments[1] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "add_outline_at_index")
+	methodBind := methodBindNavigationPolygonAddOutlineAtIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -166,8 +195,7 @@ func (o *NavigationPolygon) AddOutlineAtIndex(outline gdnative.PoolVector2Array,
 }
 
 /*
-
-	Args: [{ false polygon PoolIntArray}], Returns: void
+Args: [{ false polygon PoolIntArray}], Returns: void
 */
 func (o *NavigationPolygon) AddPolygon(polygon gdnative.PoolIntArray) {
 	//log.Println("Calling NavigationPolygon.AddPolygon()")
@@ -177,7 +205,7 @@ func (o *NavigationPolygon) AddPolygon(polygon gdnative.PoolIntArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolIntArray(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "add_polygon")
+	methodBind := methodBindNavigationPolygonAddPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -187,8 +215,7 @@ func (o *NavigationPolygon) AddPolygon(polygon gdnative.PoolIntArray) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *NavigationPolygon) ClearOutlines() {
 	//log.Println("Calling NavigationPolygon.ClearOutlines()")
@@ -197,7 +224,7 @@ func (o *NavigationPolygon) ClearOutlines() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "clear_outlines")
+	methodBind := methodBindNavigationPolygonClearOutlines.Get()
 
 	// Call the parent method.
 	// void
@@ -207,8 +234,7 @@ func (o *NavigationPolygon) ClearOutlines() {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *NavigationPolygon) ClearPolygons() {
 	//log.Println("Calling NavigationPolygon.ClearPolygons()")
@@ -217,7 +243,7 @@ func (o *NavigationPolygon) ClearPolygons() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "clear_polygons")
+	methodBind := methodBindNavigationPolygonClearPolygons.Get()
 
 	// Call the parent method.
 	// void
@@ -227,8 +253,7 @@ func (o *NavigationPolygon) ClearPolygons() {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolVector2Array
+Args: [{ false idx int}], Returns: PoolVector2Array
 */
 func (o *NavigationPolygon) GetOutline(idx gdnative.Int) gdnative.PoolVector2Array {
 	//log.Println("Calling NavigationPolygon.GetOutline()")
@@ -238,7 +263,7 @@ func (o *NavigationPolygon) GetOutline(idx gdnative.Int) gdnative.PoolVector2Arr
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "get_outline")
+	methodBind := methodBindNavigationPolygonGetOutline.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -251,8 +276,7 @@ func (o *NavigationPolygon) GetOutline(idx gdnative.Int) gdnative.PoolVector2Arr
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *NavigationPolygon) GetOutlineCount() gdnative.Int {
 	//log.Println("Calling NavigationPolygon.GetOutlineCount()")
@@ -261,7 +285,7 @@ func (o *NavigationPolygon) GetOutlineCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "get_outline_count")
+	methodBind := methodBindNavigationPolygonGetOutlineCount.Get()
 
 	// Call the parent method.
 	// int
@@ -274,8 +298,7 @@ func (o *NavigationPolygon) GetOutlineCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: PoolIntArray
+Args: [{ false idx int}], Returns: PoolIntArray
 */
 func (o *NavigationPolygon) GetPolygon(idx gdnative.Int) gdnative.PoolIntArray {
 	//log.Println("Calling NavigationPolygon.GetPolygon()")
@@ -285,7 +308,7 @@ func (o *NavigationPolygon) GetPolygon(idx gdnative.Int) gdnative.PoolIntArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "get_polygon")
+	methodBind := methodBindNavigationPolygonGetPolygon.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -298,8 +321,7 @@ func (o *NavigationPolygon) GetPolygon(idx gdnative.Int) gdnative.PoolIntArray {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *NavigationPolygon) GetPolygonCount() gdnative.Int {
 	//log.Println("Calling NavigationPolygon.GetPolygonCount()")
@@ -308,7 +330,7 @@ func (o *NavigationPolygon) GetPolygonCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "get_polygon_count")
+	methodBind := methodBindNavigationPolygonGetPolygonCount.Get()
 
 	// Call the parent method.
 	// int
@@ -321,8 +343,7 @@ func (o *NavigationPolygon) GetPolygonCount() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: PoolVector2Array
+Args: [], Returns: PoolVector2Array
 */
 func (o *NavigationPolygon) GetVertices() gdnative.PoolVector2Array {
 	//log.Println("Calling NavigationPolygon.GetVertices()")
@@ -331,7 +352,7 @@ func (o *NavigationPolygon) GetVertices() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "get_vertices")
+	methodBind := methodBindNavigationPolygonGetVertices.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -344,8 +365,7 @@ func (o *NavigationPolygon) GetVertices() gdnative.PoolVector2Array {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *NavigationPolygon) MakePolygonsFromOutlines() {
 	//log.Println("Calling NavigationPolygon.MakePolygonsFromOutlines()")
@@ -354,7 +374,7 @@ func (o *NavigationPolygon) MakePolygonsFromOutlines() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "make_polygons_from_outlines")
+	methodBind := methodBindNavigationPolygonMakePolygonsFromOutlines.Get()
 
 	// Call the parent method.
 	// void
@@ -364,8 +384,7 @@ func (o *NavigationPolygon) MakePolygonsFromOutlines() {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: void
+Args: [{ false idx int}], Returns: void
 */
 func (o *NavigationPolygon) RemoveOutline(idx gdnative.Int) {
 	//log.Println("Calling NavigationPolygon.RemoveOutline()")
@@ -375,7 +394,7 @@ func (o *NavigationPolygon) RemoveOutline(idx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "remove_outline")
+	methodBind := methodBindNavigationPolygonRemoveOutline.Get()
 
 	// Call the parent method.
 	// void
@@ -385,8 +404,7 @@ func (o *NavigationPolygon) RemoveOutline(idx gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false idx int} { false outline PoolVector2Array}], Returns: void
+Args: [{ false idx int} { false outline PoolVector2Array}], Returns: void
 */
 func (o *NavigationPolygon) SetOutline(idx gdnative.Int, outline gdnative.PoolVector2Array) {
 	//log.Println("Calling NavigationPolygon.SetOutline()")
@@ -397,7 +415,7 @@ func (o *NavigationPolygon) SetOutline(idx gdnative.Int, outline gdnative.PoolVe
 	ptrArguments[1] = gdnative.NewPointerFromPoolVector2Array(outline)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "set_outline")
+	methodBind := methodBindNavigationPolygonSetOutline.Get()
 
 	// Call the parent method.
 	// void
@@ -407,8 +425,7 @@ func (o *NavigationPolygon) SetOutline(idx gdnative.Int, outline gdnative.PoolVe
 }
 
 /*
-
-	Args: [{ false vertices PoolVector2Array}], Returns: void
+Args: [{ false vertices PoolVector2Array}], Returns: void
 */
 func (o *NavigationPolygon) SetVertices(vertices gdnative.PoolVector2Array) {
 	//log.Println("Calling NavigationPolygon.SetVertices()")
@@ -418,7 +435,7 @@ func (o *NavigationPolygon) SetVertices(vertices gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(vertices)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("NavigationPolygon", "set_vertices")
+	methodBind := methodBindNavigationPolygonSetVertices.Get()
 
 	// Call the parent method.
 	// void
@@ -427,10 +444,35 @@ func (o *NavigationPolygon) SetVertices(vertices gdnative.PoolVector2Array) {
 
 }
 
+// Outlines will return the value of the "outlines" property.
+func (o *NavigationPolygon) Outlines() gdnative.Array {
+	return o.X_GetOutlines()
+}
+
+// SetOutlines will set the value of the "outlines" property.
+func (o *NavigationPolygon) SetOutlines(value gdnative.Array) {
+	o.X_SetOutlines(value)
+}
+
+// Polygons will return the value of the "polygons" property.
+func (o *NavigationPolygon) Polygons() gdnative.Array {
+	return o.X_GetPolygons()
+}
+
+// SetPolygons will set the value of the "polygons" property.
+func (o *NavigationPolygon) SetPolygons(value gdnative.Array) {
+	o.X_SetPolygons(value)
+}
+
+// Vertices will return the value of the "vertices" property.
+func (o *NavigationPolygon) Vertices() gdnative.PoolVector2Array {
+	return o.GetVertices()
+}
+
 // NavigationPolygonImplementer is an interface that implements the methods
 // of the NavigationPolygon class.
 type NavigationPolygonImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetOutlines() gdnative.Array
 	X_GetPolygons() gdnative.Array
 	X_SetOutlines(outlines gdnative.Array)
@@ -449,4 +491,9 @@ type NavigationPolygonImplementer interface {
 	RemoveOutline(idx gdnative.Int)
 	SetOutline(idx gdnative.Int, outline gdnative.PoolVector2Array)
 	SetVertices(vertices gdnative.PoolVector2Array)
+	Outlines() gdnative.Array
+	SetOutlines(value gdnative.Array)
+	Polygons() gdnative.Array
+	SetPolygons(value gdnative.Array)
+	Vertices() gdnative.PoolVector2Array
 }
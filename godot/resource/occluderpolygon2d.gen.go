@@ -1,7 +1,10 @@
-package godot
+package resource
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,30 +25,75 @@ const (
 	OccluderPolygon2DCullDisabled         OccluderPolygon2DCullMode = 0
 )
 
-//func NewOccluderPolygon2DFromPointer(ptr gdnative.Pointer) OccluderPolygon2D {
-func newOccluderPolygon2DFromPointer(ptr gdnative.Pointer) OccluderPolygon2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := OccluderPolygon2D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the OccluderPolygon2DCullMode value.
+func (e OccluderPolygon2DCullMode) String() string {
+	switch e {
+	case OccluderPolygon2DCullClockwise:
+		return "CULL_CLOCKWISE"
+	case OccluderPolygon2DCullCounterClockwise:
+		return "CULL_COUNTER_CLOCKWISE"
+	case OccluderPolygon2DCullDisabled:
+		return "CULL_DISABLED"
+	}
+	return fmt.Sprintf("OccluderPolygon2DCullMode(%d)", int(e))
+}
 
-	return obj
+// ParseOccluderPolygon2DCullMode will return the OccluderPolygon2DCullMode value with the given Godot name.
+func ParseOccluderPolygon2DCullMode(name string) (OccluderPolygon2DCullMode, error) {
+	switch name {
+	case "CULL_CLOCKWISE":
+		return OccluderPolygon2DCullClockwise, nil
+	case "CULL_COUNTER_CLOCKWISE":
+		return OccluderPolygon2DCullCounterClockwise, nil
+	case "CULL_DISABLED":
+		return OccluderPolygon2DCullDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid OccluderPolygon2DCullMode value %q", name)
 }
 
 /*
 Editor facility that helps you draw a 2D polygon used as resource for [LightOccluder2D].
 */
 type OccluderPolygon2D struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorOccluderPolygon2D is the class constructor of the OccluderPolygon2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorOccluderPolygon2D = gdnative.NewLazyClassConstructor("OccluderPolygon2D")
+
+// NewOccluderPolygon2D will create a new instance of the OccluderPolygon2D class.
+// OccluderPolygon2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewOccluderPolygon2D() *OccluderPolygon2D {
+	obj := &OccluderPolygon2D{}
+	obj.SetBaseObject(classConstructorOccluderPolygon2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *OccluderPolygon2D) BaseClass() string {
 	return "OccluderPolygon2D"
 }
 
+// Method binds of the OccluderPolygon2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindOccluderPolygon2DGetCullMode = gdnative.NewLazyMethodBind("OccluderPolygon2D", "get_cull_mode")
+	methodBindOccluderPolygon2DGetPolygon  = gdnative.NewLazyMethodBind("OccluderPolygon2D", "get_polygon")
+	methodBindOccluderPolygon2DIsClosed    = gdnative.NewLazyMethodBind("OccluderPolygon2D", "is_closed")
+	methodBindOccluderPolygon2DSetClosed   = gdnative.NewLazyMethodBind("OccluderPolygon2D", "set_closed")
+	methodBindOccluderPolygon2DSetCullMode = gdnative.NewLazyMethodBind("OccluderPolygon2D", "set_cull_mode")
+	methodBindOccluderPolygon2DSetPolygon  = gdnative.NewLazyMethodBind("OccluderPolygon2D", "set_polygon")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.OccluderPolygon2D::CullMode
+	        Undocumented
+		Args: [], Returns: enum.OccluderPolygon2D::CullMode
 */
 func (o *OccluderPolygon2D) GetCullMode() OccluderPolygon2DCullMode {
 	//log.Println("Calling OccluderPolygon2D.GetCullMode()")
@@ -54,7 +102,7 @@ func (o *OccluderPolygon2D) GetCullMode() OccluderPolygon2DCullMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OccluderPolygon2D", "get_cull_mode")
+	methodBind := methodBindOccluderPolygon2DGetCullMode.Get()
 
 	// Call the parent method.
 	// enum.OccluderPolygon2D::CullMode
@@ -67,8 +115,8 @@ func (o *OccluderPolygon2D) GetCullMode() OccluderPolygon2DCullMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *OccluderPolygon2D) GetPolygon() gdnative.PoolVector2Array {
 	//log.Println("Calling OccluderPolygon2D.GetPolygon()")
@@ -77,7 +125,7 @@ func (o *OccluderPolygon2D) GetPolygon() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OccluderPolygon2D", "get_polygon")
+	methodBind := methodBindOccluderPolygon2DGetPolygon.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -90,8 +138,8 @@ func (o *OccluderPolygon2D) GetPolygon() gdnative.PoolVector2Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *OccluderPolygon2D) IsClosed() gdnative.Bool {
 	//log.Println("Calling OccluderPolygon2D.IsClosed()")
@@ -100,7 +148,7 @@ func (o *OccluderPolygon2D) IsClosed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OccluderPolygon2D", "is_closed")
+	methodBind := methodBindOccluderPolygon2DIsClosed.Get()
 
 	// Call the parent method.
 	// bool
@@ -113,8 +161,8 @@ func (o *OccluderPolygon2D) IsClosed() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false closed bool}], Returns: void
+	        Undocumented
+		Args: [{ false closed bool}], Returns: void
 */
 func (o *OccluderPolygon2D) SetClosed(closed gdnative.Bool) {
 	//log.Println("Calling OccluderPolygon2D.SetClosed()")
@@ -124,7 +172,7 @@ func (o *OccluderPolygon2D) SetClosed(closed gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(closed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OccluderPolygon2D", "set_closed")
+	methodBind := methodBindOccluderPolygon2DSetClosed.Get()
 
 	// Call the parent method.
 	// void
@@ -134,18 +182,18 @@ func (o *OccluderPolygon2D) SetClosed(closed gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false cull_mode int}], Returns: void
+	        Undocumented
+		Args: [{ false cull_mode enum.OccluderPolygon2D::CullMode}], Returns: void
 */
-func (o *OccluderPolygon2D) SetCullMode(cullMode gdnative.Int) {
+func (o *OccluderPolygon2D) SetCullMode(cullMode OccluderPolygon2DCullMode) {
 	//log.Println("Calling OccluderPolygon2D.SetCullMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(cullMode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(cullMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OccluderPolygon2D", "set_cull_mode")
+	methodBind := methodBindOccluderPolygon2DSetCullMode.Get()
 
 	// Call the parent method.
 	// void
@@ -155,8 +203,8 @@ func (o *OccluderPolygon2D) SetCullMode(cullMode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false polygon PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false polygon PoolVector2Array}], Returns: void
 */
 func (o *OccluderPolygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 	//log.Println("Calling OccluderPolygon2D.SetPolygon()")
@@ -166,7 +214,7 @@ func (o *OccluderPolygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("OccluderPolygon2D", "set_polygon")
+	methodBind := methodBindOccluderPolygon2DSetPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -175,13 +223,32 @@ func (o *OccluderPolygon2D) SetPolygon(polygon gdnative.PoolVector2Array) {
 
 }
 
+// Closed will return the value of the "closed" property.
+func (o *OccluderPolygon2D) Closed() gdnative.Bool {
+	return o.IsClosed()
+}
+
+// CullMode will return the value of the "cull_mode" property.
+func (o *OccluderPolygon2D) CullMode() OccluderPolygon2DCullMode {
+	return o.GetCullMode()
+}
+
+// Polygon will return the value of the "polygon" property.
+func (o *OccluderPolygon2D) Polygon() gdnative.PoolVector2Array {
+	return o.GetPolygon()
+}
+
 // OccluderPolygon2DImplementer is an interface that implements the methods
 // of the OccluderPolygon2D class.
 type OccluderPolygon2DImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
+	GetCullMode() OccluderPolygon2DCullMode
 	GetPolygon() gdnative.PoolVector2Array
 	IsClosed() gdnative.Bool
 	SetClosed(closed gdnative.Bool)
-	SetCullMode(cullMode gdnative.Int)
+	SetCullMode(cullMode OccluderPolygon2DCullMode)
 	SetPolygon(polygon gdnative.PoolVector2Array)
+	Closed() gdnative.Bool
+	CullMode() OccluderPolygon2DCullMode
+	Polygon() gdnative.PoolVector2Array
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,49 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPackedDataContainerFromPointer(ptr gdnative.Pointer) PackedDataContainer {
-func newPackedDataContainerFromPointer(ptr gdnative.Pointer) PackedDataContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PackedDataContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PackedDataContainer struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorPackedDataContainer is the class constructor of the PackedDataContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPackedDataContainer = gdnative.NewLazyClassConstructor("PackedDataContainer")
+
+// NewPackedDataContainer will create a new instance of the PackedDataContainer class.
+// PackedDataContainer is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPackedDataContainer() *PackedDataContainer {
+	obj := &PackedDataContainer{}
+	obj.SetBaseObject(classConstructorPackedDataContainer.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PackedDataContainer) BaseClass() string {
 	return "PackedDataContainer"
 }
 
+// Method binds of the PackedDataContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPackedDataContainerX_GetData  = gdnative.NewLazyMethodBind("PackedDataContainer", "_get_data")
+	methodBindPackedDataContainerX_IterGet  = gdnative.NewLazyMethodBind("PackedDataContainer", "_iter_get")
+	methodBindPackedDataContainerX_IterInit = gdnative.NewLazyMethodBind("PackedDataContainer", "_iter_init")
+	methodBindPackedDataContainerX_IterNext = gdnative.NewLazyMethodBind("PackedDataContainer", "_iter_next")
+	methodBindPackedDataContainerX_SetData  = gdnative.NewLazyMethodBind("PackedDataContainer", "_set_data")
+	methodBindPackedDataContainerPack       = gdnative.NewLazyMethodBind("PackedDataContainer", "pack")
+	methodBindPackedDataContainerSize       = gdnative.NewLazyMethodBind("PackedDataContainer", "size")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolByteArray
+	        Undocumented
+		Args: [], Returns: PoolByteArray
 */
 func (o *PackedDataContainer) X_GetData() gdnative.PoolByteArray {
 	//log.Println("Calling PackedDataContainer.X_GetData()")
@@ -45,7 +65,7 @@ func (o *PackedDataContainer) X_GetData() gdnative.PoolByteArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainer", "_get_data")
+	methodBind := methodBindPackedDataContainerX_GetData.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -58,8 +78,8 @@ func (o *PackedDataContainer) X_GetData() gdnative.PoolByteArray {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Variant}], Returns: Variant
+	        Undocumented
+		Args: [{ false arg0 Variant}], Returns: Variant
 */
 func (o *PackedDataContainer) X_IterGet(arg0 gdnative.Variant) gdnative.Variant {
 	//log.Println("Calling PackedDataContainer.X_IterGet()")
@@ -69,7 +89,7 @@ func (o *PackedDataContainer) X_IterGet(arg0 gdnative.Variant) gdnative.Variant
 	ptrArguments[0] = gdnative.NewPointerFromVariant(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainer", "_iter_get")
+	methodBind := methodBindPackedDataContainerX_IterGet.Get()
 
 	// Call the parent method.
 	// Variant
@@ -82,8 +102,8 @@ func (o *PackedDataContainer) X_IterGet(arg0 gdnative.Variant) gdnative.Variant
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: Variant
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: Variant
 */
 func (o *PackedDataContainer) X_IterInit(arg0 gdnative.Array) gdnative.Variant {
 	//log.Println("Calling PackedDataContainer.X_IterInit()")
@@ -93,7 +113,7 @@ func (o *PackedDataContainer) X_IterInit(arg0 gdnative.Array) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainer", "_iter_init")
+	methodBind := methodBindPackedDataContainerX_IterInit.Get()
 
 	// Call the parent method.
 	// Variant
@@ -106,8 +126,8 @@ func (o *PackedDataContainer) X_IterInit(arg0 gdnative.Array) gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: Variant
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: Variant
 */
 func (o *PackedDataContainer) X_IterNext(arg0 gdnative.Array) gdnative.Variant {
 	//log.Println("Calling PackedDataContainer.X_IterNext()")
@@ -117,7 +137,7 @@ func (o *PackedDataContainer) X_IterNext(arg0 gdnative.Array) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainer", "_iter_next")
+	methodBind := methodBindPackedDataContainerX_IterNext.Get()
 
 	// Call the parent method.
 	// Variant
@@ -130,8 +150,8 @@ func (o *PackedDataContainer) X_IterNext(arg0 gdnative.Array) gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 PoolByteArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 PoolByteArray}], Returns: void
 */
 func (o *PackedDataContainer) X_SetData(arg0 gdnative.PoolByteArray) {
 	//log.Println("Calling PackedDataContainer.X_SetData()")
@@ -141,7 +161,7 @@ func (o *PackedDataContainer) X_SetData(arg0 gdnative.PoolByteArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainer", "_set_data")
+	methodBind := methodBindPackedDataContainerX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -151,8 +171,7 @@ func (o *PackedDataContainer) X_SetData(arg0 gdnative.PoolByteArray) {
 }
 
 /*
-
-	Args: [{ false value Variant}], Returns: enum.Error
+Args: [{ false value Variant}], Returns: enum.Error
 */
 func (o *PackedDataContainer) Pack(value gdnative.Variant) gdnative.Error {
 	//log.Println("Calling PackedDataContainer.Pack()")
@@ -162,7 +181,7 @@ func (o *PackedDataContainer) Pack(value gdnative.Variant) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainer", "pack")
+	methodBind := methodBindPackedDataContainerPack.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -175,8 +194,7 @@ func (o *PackedDataContainer) Pack(value gdnative.Variant) gdnative.Error {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *PackedDataContainer) Size() gdnative.Int {
 	//log.Println("Calling PackedDataContainer.Size()")
@@ -185,7 +203,7 @@ func (o *PackedDataContainer) Size() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PackedDataContainer", "size")
+	methodBind := methodBindPackedDataContainerSize.Get()
 
 	// Call the parent method.
 	// int
@@ -200,11 +218,12 @@ func (o *PackedDataContainer) Size() gdnative.Int {
 // PackedDataContainerImplementer is an interface that implements the methods
 // of the PackedDataContainer class.
 type PackedDataContainerImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetData() gdnative.PoolByteArray
 	X_IterGet(arg0 gdnative.Variant) gdnative.Variant
 	X_IterInit(arg0 gdnative.Array) gdnative.Variant
 	X_IterNext(arg0 gdnative.Array) gdnative.Variant
 	X_SetData(arg0 gdnative.PoolByteArray)
+	Pack(value gdnative.Variant) gdnative.Error
 	Size() gdnative.Int
 }
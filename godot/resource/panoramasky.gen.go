@@ -0,0 +1,120 @@
+package resource
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+ */
+type PanoramaSky struct {
+	godot.Sky
+	owner gdnative.Object
+}
+
+// classConstructorPanoramaSky is the class constructor of the PanoramaSky class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPanoramaSky = gdnative.NewLazyClassConstructor("PanoramaSky")
+
+// NewPanoramaSky will create a new instance of the PanoramaSky class.
+// PanoramaSky is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPanoramaSky() *PanoramaSky {
+	obj := &PanoramaSky{}
+	obj.SetBaseObject(classConstructorPanoramaSky.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *PanoramaSky) BaseClass() string {
+	return "PanoramaSky"
+}
+
+// Method binds of the PanoramaSky class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPanoramaSkyGetPanorama = gdnative.NewLazyMethodBind("PanoramaSky", "get_panorama")
+	methodBindPanoramaSkySetPanorama = gdnative.NewLazyMethodBind("PanoramaSky", "set_panorama")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *PanoramaSky) GetPanorama() godot.TextureImplementer {
+	//log.Println("Calling PanoramaSky.GetPanorama()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPanoramaSkyGetPanorama.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *PanoramaSky) SetPanorama(texture godot.TextureImplementer) {
+	//log.Println("Calling PanoramaSky.SetPanorama()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindPanoramaSkySetPanorama.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Panorama will return the value of the "panorama" property.
+func (o *PanoramaSky) Panorama() godot.TextureImplementer {
+	return o.GetPanorama()
+}
+
+// PanoramaSkyImplementer is an interface that implements the methods
+// of the PanoramaSky class.
+type PanoramaSkyImplementer interface {
+	godot.SkyImplementer
+	GetPanorama() godot.TextureImplementer
+	SetPanorama(texture godot.TextureImplementer)
+	Panorama() godot.TextureImplementer
+}
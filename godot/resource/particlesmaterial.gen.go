@@ -0,0 +1,1784 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// ParticlesMaterialEmissionShape is an enum for EmissionShape values.
+type ParticlesMaterialEmissionShape int
+
+const (
+	ParticlesMaterialEmissionShapeBox            ParticlesMaterialEmissionShape = 2
+	ParticlesMaterialEmissionShapeDirectedPoints ParticlesMaterialEmissionShape = 4
+	ParticlesMaterialEmissionShapePoint          ParticlesMaterialEmissionShape = 0
+	ParticlesMaterialEmissionShapePoints         ParticlesMaterialEmissionShape = 3
+	ParticlesMaterialEmissionShapeSphere         ParticlesMaterialEmissionShape = 1
+)
+
+// String will return the Godot name of the ParticlesMaterialEmissionShape value.
+func (e ParticlesMaterialEmissionShape) String() string {
+	switch e {
+	case ParticlesMaterialEmissionShapeBox:
+		return "EMISSION_SHAPE_BOX"
+	case ParticlesMaterialEmissionShapeDirectedPoints:
+		return "EMISSION_SHAPE_DIRECTED_POINTS"
+	case ParticlesMaterialEmissionShapePoint:
+		return "EMISSION_SHAPE_POINT"
+	case ParticlesMaterialEmissionShapePoints:
+		return "EMISSION_SHAPE_POINTS"
+	case ParticlesMaterialEmissionShapeSphere:
+		return "EMISSION_SHAPE_SPHERE"
+	}
+	return fmt.Sprintf("ParticlesMaterialEmissionShape(%d)", int(e))
+}
+
+// ParseParticlesMaterialEmissionShape will return the ParticlesMaterialEmissionShape value with the given Godot name.
+func ParseParticlesMaterialEmissionShape(name string) (ParticlesMaterialEmissionShape, error) {
+	switch name {
+	case "EMISSION_SHAPE_BOX":
+		return ParticlesMaterialEmissionShapeBox, nil
+	case "EMISSION_SHAPE_DIRECTED_POINTS":
+		return ParticlesMaterialEmissionShapeDirectedPoints, nil
+	case "EMISSION_SHAPE_POINT":
+		return ParticlesMaterialEmissionShapePoint, nil
+	case "EMISSION_SHAPE_POINTS":
+		return ParticlesMaterialEmissionShapePoints, nil
+	case "EMISSION_SHAPE_SPHERE":
+		return ParticlesMaterialEmissionShapeSphere, nil
+	}
+	return 0, fmt.Errorf("invalid ParticlesMaterialEmissionShape value %q", name)
+}
+
+// ParticlesMaterialFlags is an enum for Flags values.
+type ParticlesMaterialFlags int
+
+const (
+	ParticlesMaterialFlagAlignYToVelocity ParticlesMaterialFlags = 0
+	ParticlesMaterialFlagMax              ParticlesMaterialFlags = 4
+	ParticlesMaterialFlagRotateY          ParticlesMaterialFlags = 1
+)
+
+// String will return the Godot name of the ParticlesMaterialFlags value.
+func (e ParticlesMaterialFlags) String() string {
+	switch e {
+	case ParticlesMaterialFlagAlignYToVelocity:
+		return "FLAG_ALIGN_Y_TO_VELOCITY"
+	case ParticlesMaterialFlagMax:
+		return "FLAG_MAX"
+	case ParticlesMaterialFlagRotateY:
+		return "FLAG_ROTATE_Y"
+	}
+	return fmt.Sprintf("ParticlesMaterialFlags(%d)", int(e))
+}
+
+// ParseParticlesMaterialFlags will return the ParticlesMaterialFlags value with the given Godot name.
+func ParseParticlesMaterialFlags(name string) (ParticlesMaterialFlags, error) {
+	switch name {
+	case "FLAG_ALIGN_Y_TO_VELOCITY":
+		return ParticlesMaterialFlagAlignYToVelocity, nil
+	case "FLAG_MAX":
+		return ParticlesMaterialFlagMax, nil
+	case "FLAG_ROTATE_Y":
+		return ParticlesMaterialFlagRotateY, nil
+	}
+	return 0, fmt.Errorf("invalid ParticlesMaterialFlags value %q", name)
+}
+
+// ParticlesMaterialParameter is an enum for Parameter values.
+type ParticlesMaterialParameter int
+
+const (
+	ParticlesMaterialParamAngle                 ParticlesMaterialParameter = 7
+	ParticlesMaterialParamAngularVelocity       ParticlesMaterialParameter = 1
+	ParticlesMaterialParamAnimOffset            ParticlesMaterialParameter = 11
+	ParticlesMaterialParamAnimSpeed             ParticlesMaterialParameter = 10
+	ParticlesMaterialParamDamping               ParticlesMaterialParameter = 6
+	ParticlesMaterialParamHueVariation          ParticlesMaterialParameter = 9
+	ParticlesMaterialParamInitialLinearVelocity ParticlesMaterialParameter = 0
+	ParticlesMaterialParamLinearAccel           ParticlesMaterialParameter = 3
+	ParticlesMaterialParamMax                   ParticlesMaterialParameter = 12
+	ParticlesMaterialParamOrbitVelocity         ParticlesMaterialParameter = 2
+	ParticlesMaterialParamRadialAccel           ParticlesMaterialParameter = 4
+	ParticlesMaterialParamScale                 ParticlesMaterialParameter = 8
+	ParticlesMaterialParamTangentialAccel       ParticlesMaterialParameter = 5
+)
+
+// String will return the Godot name of the ParticlesMaterialParameter value.
+func (e ParticlesMaterialParameter) String() string {
+	switch e {
+	case ParticlesMaterialParamAngle:
+		return "PARAM_ANGLE"
+	case ParticlesMaterialParamAngularVelocity:
+		return "PARAM_ANGULAR_VELOCITY"
+	case ParticlesMaterialParamAnimOffset:
+		return "PARAM_ANIM_OFFSET"
+	case ParticlesMaterialParamAnimSpeed:
+		return "PARAM_ANIM_SPEED"
+	case ParticlesMaterialParamDamping:
+		return "PARAM_DAMPING"
+	case ParticlesMaterialParamHueVariation:
+		return "PARAM_HUE_VARIATION"
+	case ParticlesMaterialParamInitialLinearVelocity:
+		return "PARAM_INITIAL_LINEAR_VELOCITY"
+	case ParticlesMaterialParamLinearAccel:
+		return "PARAM_LINEAR_ACCEL"
+	case ParticlesMaterialParamMax:
+		return "PARAM_MAX"
+	case ParticlesMaterialParamOrbitVelocity:
+		return "PARAM_ORBIT_VELOCITY"
+	case ParticlesMaterialParamRadialAccel:
+		return "PARAM_RADIAL_ACCEL"
+	case ParticlesMaterialParamScale:
+		return "PARAM_SCALE"
+	case ParticlesMaterialParamTangentialAccel:
+		return "PARAM_TANGENTIAL_ACCEL"
+	}
+	return fmt.Sprintf("ParticlesMaterialParameter(%d)", int(e))
+}
+
+// ParseParticlesMaterialParameter will return the ParticlesMaterialParameter value with the given Godot name.
+func ParseParticlesMaterialParameter(name string) (ParticlesMaterialParameter, error) {
+	switch name {
+	case "PARAM_ANGLE":
+		return ParticlesMaterialParamAngle, nil
+	case "PARAM_ANGULAR_VELOCITY":
+		return ParticlesMaterialParamAngularVelocity, nil
+	case "PARAM_ANIM_OFFSET":
+		return ParticlesMaterialParamAnimOffset, nil
+	case "PARAM_ANIM_SPEED":
+		return ParticlesMaterialParamAnimSpeed, nil
+	case "PARAM_DAMPING":
+		return ParticlesMaterialParamDamping, nil
+	case "PARAM_HUE_VARIATION":
+		return ParticlesMaterialParamHueVariation, nil
+	case "PARAM_INITIAL_LINEAR_VELOCITY":
+		return ParticlesMaterialParamInitialLinearVelocity, nil
+	case "PARAM_LINEAR_ACCEL":
+		return ParticlesMaterialParamLinearAccel, nil
+	case "PARAM_MAX":
+		return ParticlesMaterialParamMax, nil
+	case "PARAM_ORBIT_VELOCITY":
+		return ParticlesMaterialParamOrbitVelocity, nil
+	case "PARAM_RADIAL_ACCEL":
+		return ParticlesMaterialParamRadialAccel, nil
+	case "PARAM_SCALE":
+		return ParticlesMaterialParamScale, nil
+	case "PARAM_TANGENTIAL_ACCEL":
+		return ParticlesMaterialParamTangentialAccel, nil
+	}
+	return 0, fmt.Errorf("invalid ParticlesMaterialParameter value %q", name)
+}
+
+/*
+ParticlesMaterial defines particle properties and behavior. It is used in the [code]process_material[/code] of [Particles] and [Particles2D] emitter nodes. Some of this material's properties are applied to each particle when emitted, while others can have a [CurveTexture] applied to vary values over the lifetime of the particle.
+*/
+type ParticlesMaterial struct {
+	godot.Material
+	owner gdnative.Object
+}
+
+// classConstructorParticlesMaterial is the class constructor of the ParticlesMaterial class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorParticlesMaterial = gdnative.NewLazyClassConstructor("ParticlesMaterial")
+
+// NewParticlesMaterial will create a new instance of the ParticlesMaterial class.
+// ParticlesMaterial is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewParticlesMaterial() *ParticlesMaterial {
+	obj := &ParticlesMaterial{}
+	obj.SetBaseObject(classConstructorParticlesMaterial.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *ParticlesMaterial) BaseClass() string {
+	return "ParticlesMaterial"
+}
+
+// Method binds of the ParticlesMaterial class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindParticlesMaterialGetColor                 = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_color")
+	methodBindParticlesMaterialGetColorRamp             = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_color_ramp")
+	methodBindParticlesMaterialGetEmissionBoxExtents    = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_emission_box_extents")
+	methodBindParticlesMaterialGetEmissionColorTexture  = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_emission_color_texture")
+	methodBindParticlesMaterialGetEmissionNormalTexture = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_emission_normal_texture")
+	methodBindParticlesMaterialGetEmissionPointCount    = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_emission_point_count")
+	methodBindParticlesMaterialGetEmissionPointTexture  = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_emission_point_texture")
+	methodBindParticlesMaterialGetEmissionShape         = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_emission_shape")
+	methodBindParticlesMaterialGetEmissionSphereRadius  = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_emission_sphere_radius")
+	methodBindParticlesMaterialGetFlag                  = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_flag")
+	methodBindParticlesMaterialGetFlatness              = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_flatness")
+	methodBindParticlesMaterialGetGravity               = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_gravity")
+	methodBindParticlesMaterialGetParam                 = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_param")
+	methodBindParticlesMaterialGetParamRandomness       = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_param_randomness")
+	methodBindParticlesMaterialGetParamTexture          = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_param_texture")
+	methodBindParticlesMaterialGetSpread                = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_spread")
+	methodBindParticlesMaterialGetTrailColorModifier    = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_trail_color_modifier")
+	methodBindParticlesMaterialGetTrailDivisor          = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_trail_divisor")
+	methodBindParticlesMaterialGetTrailSizeModifier     = gdnative.NewLazyMethodBind("ParticlesMaterial", "get_trail_size_modifier")
+	methodBindParticlesMaterialSetColor                 = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_color")
+	methodBindParticlesMaterialSetColorRamp             = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_color_ramp")
+	methodBindParticlesMaterialSetEmissionBoxExtents    = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_emission_box_extents")
+	methodBindParticlesMaterialSetEmissionColorTexture  = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_emission_color_texture")
+	methodBindParticlesMaterialSetEmissionNormalTexture = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_emission_normal_texture")
+	methodBindParticlesMaterialSetEmissionPointCount    = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_emission_point_count")
+	methodBindParticlesMaterialSetEmissionPointTexture  = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_emission_point_texture")
+	methodBindParticlesMaterialSetEmissionShape         = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_emission_shape")
+	methodBindParticlesMaterialSetEmissionSphereRadius  = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_emission_sphere_radius")
+	methodBindParticlesMaterialSetFlag                  = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_flag")
+	methodBindParticlesMaterialSetFlatness              = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_flatness")
+	methodBindParticlesMaterialSetGravity               = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_gravity")
+	methodBindParticlesMaterialSetParam                 = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_param")
+	methodBindParticlesMaterialSetParamRandomness       = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_param_randomness")
+	methodBindParticlesMaterialSetParamTexture          = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_param_texture")
+	methodBindParticlesMaterialSetSpread                = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_spread")
+	methodBindParticlesMaterialSetTrailColorModifier    = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_trail_color_modifier")
+	methodBindParticlesMaterialSetTrailDivisor          = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_trail_divisor")
+	methodBindParticlesMaterialSetTrailSizeModifier     = gdnative.NewLazyMethodBind("ParticlesMaterial", "set_trail_size_modifier")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *ParticlesMaterial) GetColor() gdnative.Color {
+	//log.Println("Calling ParticlesMaterial.GetColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetColor.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *ParticlesMaterial) GetColorRamp() godot.TextureImplementer {
+	//log.Println("Calling ParticlesMaterial.GetColorRamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetColorRamp.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *ParticlesMaterial) GetEmissionBoxExtents() gdnative.Vector3 {
+	//log.Println("Calling ParticlesMaterial.GetEmissionBoxExtents()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetEmissionBoxExtents.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *ParticlesMaterial) GetEmissionColorTexture() godot.TextureImplementer {
+	//log.Println("Calling ParticlesMaterial.GetEmissionColorTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetEmissionColorTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *ParticlesMaterial) GetEmissionNormalTexture() godot.TextureImplementer {
+	//log.Println("Calling ParticlesMaterial.GetEmissionNormalTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetEmissionNormalTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *ParticlesMaterial) GetEmissionPointCount() gdnative.Int {
+	//log.Println("Calling ParticlesMaterial.GetEmissionPointCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetEmissionPointCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *ParticlesMaterial) GetEmissionPointTexture() godot.TextureImplementer {
+	//log.Println("Calling ParticlesMaterial.GetEmissionPointTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetEmissionPointTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.ParticlesMaterial::EmissionShape
+*/
+func (o *ParticlesMaterial) GetEmissionShape() ParticlesMaterialEmissionShape {
+	//log.Println("Calling ParticlesMaterial.GetEmissionShape()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetEmissionShape.Get()
+
+	// Call the parent method.
+	// enum.ParticlesMaterial::EmissionShape
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ParticlesMaterialEmissionShape(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *ParticlesMaterial) GetEmissionSphereRadius() gdnative.Real {
+	//log.Println("Calling ParticlesMaterial.GetEmissionSphereRadius()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetEmissionSphereRadius.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag int}], Returns: bool
+*/
+func (o *ParticlesMaterial) GetFlag(flag gdnative.Int) gdnative.Bool {
+	//log.Println("Calling ParticlesMaterial.GetFlag()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(flag)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetFlag.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *ParticlesMaterial) GetFlatness() gdnative.Real {
+	//log.Println("Calling ParticlesMaterial.GetFlatness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetFlatness.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *ParticlesMaterial) GetGravity() gdnative.Vector3 {
+	//log.Println("Calling ParticlesMaterial.GetGravity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetGravity.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int}], Returns: float
+*/
+func (o *ParticlesMaterial) GetParam(param gdnative.Int) gdnative.Real {
+	//log.Println("Calling ParticlesMaterial.GetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetParam.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int}], Returns: float
+*/
+func (o *ParticlesMaterial) GetParamRandomness(param gdnative.Int) gdnative.Real {
+	//log.Println("Calling ParticlesMaterial.GetParamRandomness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetParamRandomness.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int}], Returns: Texture
+*/
+func (o *ParticlesMaterial) GetParamTexture(param gdnative.Int) godot.TextureImplementer {
+	//log.Println("Calling ParticlesMaterial.GetParamTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetParamTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *ParticlesMaterial) GetSpread() gdnative.Real {
+	//log.Println("Calling ParticlesMaterial.GetSpread()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetSpread.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: GradientTexture
+*/
+func (o *ParticlesMaterial) GetTrailColorModifier() GradientTextureImplementer {
+	//log.Println("Calling ParticlesMaterial.GetTrailColorModifier()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetTrailColorModifier.Get()
+
+	// Call the parent method.
+	// GradientTexture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := GradientTexture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(GradientTextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *ParticlesMaterial) GetTrailDivisor() gdnative.Int {
+	//log.Println("Calling ParticlesMaterial.GetTrailDivisor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetTrailDivisor.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: CurveTexture
+*/
+func (o *ParticlesMaterial) GetTrailSizeModifier() CurveTextureImplementer {
+	//log.Println("Calling ParticlesMaterial.GetTrailSizeModifier()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialGetTrailSizeModifier.Get()
+
+	// Call the parent method.
+	// CurveTexture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := CurveTexture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(CurveTextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
+*/
+func (o *ParticlesMaterial) SetColor(color gdnative.Color) {
+	//log.Println("Calling ParticlesMaterial.SetColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(color)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetColor.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false ramp Texture}], Returns: void
+*/
+func (o *ParticlesMaterial) SetColorRamp(ramp godot.TextureImplementer) {
+	//log.Println("Calling ParticlesMaterial.SetColorRamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(ramp))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetColorRamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false extents Vector3}], Returns: void
+*/
+func (o *ParticlesMaterial) SetEmissionBoxExtents(extents gdnative.Vector3) {
+	//log.Println("Calling ParticlesMaterial.SetEmissionBoxExtents()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(extents)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetEmissionBoxExtents.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *ParticlesMaterial) SetEmissionColorTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling ParticlesMaterial.SetEmissionColorTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetEmissionColorTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *ParticlesMaterial) SetEmissionNormalTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling ParticlesMaterial.SetEmissionNormalTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetEmissionNormalTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false point_count int}], Returns: void
+*/
+func (o *ParticlesMaterial) SetEmissionPointCount(pointCount gdnative.Int) {
+	//log.Println("Calling ParticlesMaterial.SetEmissionPointCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(pointCount)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetEmissionPointCount.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *ParticlesMaterial) SetEmissionPointTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling ParticlesMaterial.SetEmissionPointTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetEmissionPointTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false shape enum.ParticlesMaterial::EmissionShape}], Returns: void
+*/
+func (o *ParticlesMaterial) SetEmissionShape(shape ParticlesMaterialEmissionShape) {
+	//log.Println("Calling ParticlesMaterial.SetEmissionShape()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(shape))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetEmissionShape.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
+*/
+func (o *ParticlesMaterial) SetEmissionSphereRadius(radius gdnative.Real) {
+	//log.Println("Calling ParticlesMaterial.SetEmissionSphereRadius()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetEmissionSphereRadius.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag int} { false enable bool}], Returns: void
+*/
+func (o *ParticlesMaterial) SetFlag(flag gdnative.Int, enable gdnative.Bool) {
+	//log.Println("Calling ParticlesMaterial.SetFlag()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(flag)
+	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetFlag.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
+*/
+func (o *ParticlesMaterial) SetFlatness(amount gdnative.Real) {
+	//log.Println("Calling ParticlesMaterial.SetFlatness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetFlatness.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false accel_vec Vector3}], Returns: void
+*/
+func (o *ParticlesMaterial) SetGravity(accelVec gdnative.Vector3) {
+	//log.Println("Calling ParticlesMaterial.SetGravity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(accelVec)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetGravity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int} { false value float}], Returns: void
+*/
+func (o *ParticlesMaterial) SetParam(param gdnative.Int, value gdnative.Real) {
+	//log.Println("Calling ParticlesMaterial.SetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetParam.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int} { false randomness float}], Returns: void
+*/
+func (o *ParticlesMaterial) SetParamRandomness(param gdnative.Int, randomness gdnative.Real) {
+	//log.Println("Calling ParticlesMaterial.SetParamRandomness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+	ptrArguments[1] = gdnative.NewPointerFromReal(randomness)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetParamRandomness.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int} { false texture Texture}], Returns: void
+*/
+func (o *ParticlesMaterial) SetParamTexture(param gdnative.Int, texture godot.TextureImplementer) {
+	//log.Println("Calling ParticlesMaterial.SetParamTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetParamTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
+*/
+func (o *ParticlesMaterial) SetSpread(degrees gdnative.Real) {
+	//log.Println("Calling ParticlesMaterial.SetSpread()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetSpread.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture GradientTexture}], Returns: void
+*/
+func (o *ParticlesMaterial) SetTrailColorModifier(texture GradientTextureImplementer) {
+	//log.Println("Calling ParticlesMaterial.SetTrailColorModifier()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetTrailColorModifier.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false divisor int}], Returns: void
+*/
+func (o *ParticlesMaterial) SetTrailDivisor(divisor gdnative.Int) {
+	//log.Println("Calling ParticlesMaterial.SetTrailDivisor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(divisor)
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetTrailDivisor.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture CurveTexture}], Returns: void
+*/
+func (o *ParticlesMaterial) SetTrailSizeModifier(texture CurveTextureImplementer) {
+	//log.Println("Calling ParticlesMaterial.SetTrailSizeModifier()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindParticlesMaterialSetTrailSizeModifier.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Angle will return the value of the "angle" property.
+func (o *ParticlesMaterial) Angle() gdnative.Real {
+	return o.GetParam(7)
+}
+
+// SetAngle will set the value of the "angle" property.
+func (o *ParticlesMaterial) SetAngle(value gdnative.Real) {
+	o.SetParam(7, value)
+}
+
+// AngleCurve will return the value of the "angle_curve" property.
+func (o *ParticlesMaterial) AngleCurve() godot.TextureImplementer {
+	return o.GetParamTexture(7)
+}
+
+// SetAngleCurve will set the value of the "angle_curve" property.
+func (o *ParticlesMaterial) SetAngleCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(7, value)
+}
+
+// AngleRandom will return the value of the "angle_random" property.
+func (o *ParticlesMaterial) AngleRandom() gdnative.Real {
+	return o.GetParamRandomness(7)
+}
+
+// SetAngleRandom will set the value of the "angle_random" property.
+func (o *ParticlesMaterial) SetAngleRandom(value gdnative.Real) {
+	o.SetParamRandomness(7, value)
+}
+
+// AngularVelocity will return the value of the "angular_velocity" property.
+func (o *ParticlesMaterial) AngularVelocity() gdnative.Real {
+	return o.GetParam(1)
+}
+
+// SetAngularVelocity will set the value of the "angular_velocity" property.
+func (o *ParticlesMaterial) SetAngularVelocity(value gdnative.Real) {
+	o.SetParam(1, value)
+}
+
+// AngularVelocityCurve will return the value of the "angular_velocity_curve" property.
+func (o *ParticlesMaterial) AngularVelocityCurve() godot.TextureImplementer {
+	return o.GetParamTexture(1)
+}
+
+// SetAngularVelocityCurve will set the value of the "angular_velocity_curve" property.
+func (o *ParticlesMaterial) SetAngularVelocityCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(1, value)
+}
+
+// AngularVelocityRandom will return the value of the "angular_velocity_random" property.
+func (o *ParticlesMaterial) AngularVelocityRandom() gdnative.Real {
+	return o.GetParamRandomness(1)
+}
+
+// SetAngularVelocityRandom will set the value of the "angular_velocity_random" property.
+func (o *ParticlesMaterial) SetAngularVelocityRandom(value gdnative.Real) {
+	o.SetParamRandomness(1, value)
+}
+
+// AnimLoop will return the value of the "anim_loop" property.
+func (o *ParticlesMaterial) AnimLoop() gdnative.Bool {
+	return o.GetFlag(3)
+}
+
+// SetAnimLoop will set the value of the "anim_loop" property.
+func (o *ParticlesMaterial) SetAnimLoop(value gdnative.Bool) {
+	o.SetFlag(3, value)
+}
+
+// AnimOffset will return the value of the "anim_offset" property.
+func (o *ParticlesMaterial) AnimOffset() gdnative.Real {
+	return o.GetParam(11)
+}
+
+// SetAnimOffset will set the value of the "anim_offset" property.
+func (o *ParticlesMaterial) SetAnimOffset(value gdnative.Real) {
+	o.SetParam(11, value)
+}
+
+// AnimOffsetCurve will return the value of the "anim_offset_curve" property.
+func (o *ParticlesMaterial) AnimOffsetCurve() godot.TextureImplementer {
+	return o.GetParamTexture(11)
+}
+
+// SetAnimOffsetCurve will set the value of the "anim_offset_curve" property.
+func (o *ParticlesMaterial) SetAnimOffsetCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(11, value)
+}
+
+// AnimOffsetRandom will return the value of the "anim_offset_random" property.
+func (o *ParticlesMaterial) AnimOffsetRandom() gdnative.Real {
+	return o.GetParamRandomness(11)
+}
+
+// SetAnimOffsetRandom will set the value of the "anim_offset_random" property.
+func (o *ParticlesMaterial) SetAnimOffsetRandom(value gdnative.Real) {
+	o.SetParamRandomness(11, value)
+}
+
+// AnimSpeed will return the value of the "anim_speed" property.
+func (o *ParticlesMaterial) AnimSpeed() gdnative.Real {
+	return o.GetParam(10)
+}
+
+// SetAnimSpeed will set the value of the "anim_speed" property.
+func (o *ParticlesMaterial) SetAnimSpeed(value gdnative.Real) {
+	o.SetParam(10, value)
+}
+
+// AnimSpeedCurve will return the value of the "anim_speed_curve" property.
+func (o *ParticlesMaterial) AnimSpeedCurve() godot.TextureImplementer {
+	return o.GetParamTexture(10)
+}
+
+// SetAnimSpeedCurve will set the value of the "anim_speed_curve" property.
+func (o *ParticlesMaterial) SetAnimSpeedCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(10, value)
+}
+
+// AnimSpeedRandom will return the value of the "anim_speed_random" property.
+func (o *ParticlesMaterial) AnimSpeedRandom() gdnative.Real {
+	return o.GetParamRandomness(10)
+}
+
+// SetAnimSpeedRandom will set the value of the "anim_speed_random" property.
+func (o *ParticlesMaterial) SetAnimSpeedRandom(value gdnative.Real) {
+	o.SetParamRandomness(10, value)
+}
+
+// Color will return the value of the "color" property.
+func (o *ParticlesMaterial) Color() gdnative.Color {
+	return o.GetColor()
+}
+
+// ColorRamp will return the value of the "color_ramp" property.
+func (o *ParticlesMaterial) ColorRamp() godot.TextureImplementer {
+	return o.GetColorRamp()
+}
+
+// Damping will return the value of the "damping" property.
+func (o *ParticlesMaterial) Damping() gdnative.Real {
+	return o.GetParam(6)
+}
+
+// SetDamping will set the value of the "damping" property.
+func (o *ParticlesMaterial) SetDamping(value gdnative.Real) {
+	o.SetParam(6, value)
+}
+
+// DampingCurve will return the value of the "damping_curve" property.
+func (o *ParticlesMaterial) DampingCurve() godot.TextureImplementer {
+	return o.GetParamTexture(6)
+}
+
+// SetDampingCurve will set the value of the "damping_curve" property.
+func (o *ParticlesMaterial) SetDampingCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(6, value)
+}
+
+// DampingRandom will return the value of the "damping_random" property.
+func (o *ParticlesMaterial) DampingRandom() gdnative.Real {
+	return o.GetParamRandomness(6)
+}
+
+// SetDampingRandom will set the value of the "damping_random" property.
+func (o *ParticlesMaterial) SetDampingRandom(value gdnative.Real) {
+	o.SetParamRandomness(6, value)
+}
+
+// EmissionBoxExtents will return the value of the "emission_box_extents" property.
+func (o *ParticlesMaterial) EmissionBoxExtents() gdnative.Vector3 {
+	return o.GetEmissionBoxExtents()
+}
+
+// EmissionColorTexture will return the value of the "emission_color_texture" property.
+func (o *ParticlesMaterial) EmissionColorTexture() godot.TextureImplementer {
+	return o.GetEmissionColorTexture()
+}
+
+// EmissionNormalTexture will return the value of the "emission_normal_texture" property.
+func (o *ParticlesMaterial) EmissionNormalTexture() godot.TextureImplementer {
+	return o.GetEmissionNormalTexture()
+}
+
+// EmissionPointCount will return the value of the "emission_point_count" property.
+func (o *ParticlesMaterial) EmissionPointCount() gdnative.Int {
+	return o.GetEmissionPointCount()
+}
+
+// EmissionPointTexture will return the value of the "emission_point_texture" property.
+func (o *ParticlesMaterial) EmissionPointTexture() godot.TextureImplementer {
+	return o.GetEmissionPointTexture()
+}
+
+// EmissionShape will return the value of the "emission_shape" property.
+func (o *ParticlesMaterial) EmissionShape() ParticlesMaterialEmissionShape {
+	return o.GetEmissionShape()
+}
+
+// EmissionSphereRadius will return the value of the "emission_sphere_radius" property.
+func (o *ParticlesMaterial) EmissionSphereRadius() gdnative.Real {
+	return o.GetEmissionSphereRadius()
+}
+
+// FlagAlignY will return the value of the "flag_align_y" property.
+func (o *ParticlesMaterial) FlagAlignY() gdnative.Bool {
+	return o.GetFlag(0)
+}
+
+// SetFlagAlignY will set the value of the "flag_align_y" property.
+func (o *ParticlesMaterial) SetFlagAlignY(value gdnative.Bool) {
+	o.SetFlag(0, value)
+}
+
+// FlagDisableZ will return the value of the "flag_disable_z" property.
+func (o *ParticlesMaterial) FlagDisableZ() gdnative.Bool {
+	return o.GetFlag(2)
+}
+
+// SetFlagDisableZ will set the value of the "flag_disable_z" property.
+func (o *ParticlesMaterial) SetFlagDisableZ(value gdnative.Bool) {
+	o.SetFlag(2, value)
+}
+
+// FlagRotateY will return the value of the "flag_rotate_y" property.
+func (o *ParticlesMaterial) FlagRotateY() gdnative.Bool {
+	return o.GetFlag(1)
+}
+
+// SetFlagRotateY will set the value of the "flag_rotate_y" property.
+func (o *ParticlesMaterial) SetFlagRotateY(value gdnative.Bool) {
+	o.SetFlag(1, value)
+}
+
+// Flatness will return the value of the "flatness" property.
+func (o *ParticlesMaterial) Flatness() gdnative.Real {
+	return o.GetFlatness()
+}
+
+// Gravity will return the value of the "gravity" property.
+func (o *ParticlesMaterial) Gravity() gdnative.Vector3 {
+	return o.GetGravity()
+}
+
+// HueVariation will return the value of the "hue_variation" property.
+func (o *ParticlesMaterial) HueVariation() gdnative.Real {
+	return o.GetParam(9)
+}
+
+// SetHueVariation will set the value of the "hue_variation" property.
+func (o *ParticlesMaterial) SetHueVariation(value gdnative.Real) {
+	o.SetParam(9, value)
+}
+
+// HueVariationCurve will return the value of the "hue_variation_curve" property.
+func (o *ParticlesMaterial) HueVariationCurve() godot.TextureImplementer {
+	return o.GetParamTexture(9)
+}
+
+// SetHueVariationCurve will set the value of the "hue_variation_curve" property.
+func (o *ParticlesMaterial) SetHueVariationCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(9, value)
+}
+
+// HueVariationRandom will return the value of the "hue_variation_random" property.
+func (o *ParticlesMaterial) HueVariationRandom() gdnative.Real {
+	return o.GetParamRandomness(9)
+}
+
+// SetHueVariationRandom will set the value of the "hue_variation_random" property.
+func (o *ParticlesMaterial) SetHueVariationRandom(value gdnative.Real) {
+	o.SetParamRandomness(9, value)
+}
+
+// InitialVelocity will return the value of the "initial_velocity" property.
+func (o *ParticlesMaterial) InitialVelocity() gdnative.Real {
+	return o.GetParam(0)
+}
+
+// SetInitialVelocity will set the value of the "initial_velocity" property.
+func (o *ParticlesMaterial) SetInitialVelocity(value gdnative.Real) {
+	o.SetParam(0, value)
+}
+
+// InitialVelocityRandom will return the value of the "initial_velocity_random" property.
+func (o *ParticlesMaterial) InitialVelocityRandom() gdnative.Real {
+	return o.GetParamRandomness(0)
+}
+
+// SetInitialVelocityRandom will set the value of the "initial_velocity_random" property.
+func (o *ParticlesMaterial) SetInitialVelocityRandom(value gdnative.Real) {
+	o.SetParamRandomness(0, value)
+}
+
+// LinearAccel will return the value of the "linear_accel" property.
+func (o *ParticlesMaterial) LinearAccel() gdnative.Real {
+	return o.GetParam(3)
+}
+
+// SetLinearAccel will set the value of the "linear_accel" property.
+func (o *ParticlesMaterial) SetLinearAccel(value gdnative.Real) {
+	o.SetParam(3, value)
+}
+
+// LinearAccelCurve will return the value of the "linear_accel_curve" property.
+func (o *ParticlesMaterial) LinearAccelCurve() godot.TextureImplementer {
+	return o.GetParamTexture(3)
+}
+
+// SetLinearAccelCurve will set the value of the "linear_accel_curve" property.
+func (o *ParticlesMaterial) SetLinearAccelCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(3, value)
+}
+
+// LinearAccelRandom will return the value of the "linear_accel_random" property.
+func (o *ParticlesMaterial) LinearAccelRandom() gdnative.Real {
+	return o.GetParamRandomness(3)
+}
+
+// SetLinearAccelRandom will set the value of the "linear_accel_random" property.
+func (o *ParticlesMaterial) SetLinearAccelRandom(value gdnative.Real) {
+	o.SetParamRandomness(3, value)
+}
+
+// OrbitVelocity will return the value of the "orbit_velocity" property.
+func (o *ParticlesMaterial) OrbitVelocity() gdnative.Real {
+	return o.GetParam(2)
+}
+
+// SetOrbitVelocity will set the value of the "orbit_velocity" property.
+func (o *ParticlesMaterial) SetOrbitVelocity(value gdnative.Real) {
+	o.SetParam(2, value)
+}
+
+// OrbitVelocityCurve will return the value of the "orbit_velocity_curve" property.
+func (o *ParticlesMaterial) OrbitVelocityCurve() godot.TextureImplementer {
+	return o.GetParamTexture(2)
+}
+
+// SetOrbitVelocityCurve will set the value of the "orbit_velocity_curve" property.
+func (o *ParticlesMaterial) SetOrbitVelocityCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(2, value)
+}
+
+// OrbitVelocityRandom will return the value of the "orbit_velocity_random" property.
+func (o *ParticlesMaterial) OrbitVelocityRandom() gdnative.Real {
+	return o.GetParamRandomness(2)
+}
+
+// SetOrbitVelocityRandom will set the value of the "orbit_velocity_random" property.
+func (o *ParticlesMaterial) SetOrbitVelocityRandom(value gdnative.Real) {
+	o.SetParamRandomness(2, value)
+}
+
+// RadialAccel will return the value of the "radial_accel" property.
+func (o *ParticlesMaterial) RadialAccel() gdnative.Real {
+	return o.GetParam(4)
+}
+
+// SetRadialAccel will set the value of the "radial_accel" property.
+func (o *ParticlesMaterial) SetRadialAccel(value gdnative.Real) {
+	o.SetParam(4, value)
+}
+
+// RadialAccelCurve will return the value of the "radial_accel_curve" property.
+func (o *ParticlesMaterial) RadialAccelCurve() godot.TextureImplementer {
+	return o.GetParamTexture(4)
+}
+
+// SetRadialAccelCurve will set the value of the "radial_accel_curve" property.
+func (o *ParticlesMaterial) SetRadialAccelCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(4, value)
+}
+
+// RadialAccelRandom will return the value of the "radial_accel_random" property.
+func (o *ParticlesMaterial) RadialAccelRandom() gdnative.Real {
+	return o.GetParamRandomness(4)
+}
+
+// SetRadialAccelRandom will set the value of the "radial_accel_random" property.
+func (o *ParticlesMaterial) SetRadialAccelRandom(value gdnative.Real) {
+	o.SetParamRandomness(4, value)
+}
+
+// Scale will return the value of the "scale" property.
+func (o *ParticlesMaterial) Scale() gdnative.Real {
+	return o.GetParam(8)
+}
+
+// SetScale will set the value of the "scale" property.
+func (o *ParticlesMaterial) SetScale(value gdnative.Real) {
+	o.SetParam(8, value)
+}
+
+// ScaleCurve will return the value of the "scale_curve" property.
+func (o *ParticlesMaterial) ScaleCurve() godot.TextureImplementer {
+	return o.GetParamTexture(8)
+}
+
+// SetScaleCurve will set the value of the "scale_curve" property.
+func (o *ParticlesMaterial) SetScaleCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(8, value)
+}
+
+// ScaleRandom will return the value of the "scale_random" property.
+func (o *ParticlesMaterial) ScaleRandom() gdnative.Real {
+	return o.GetParamRandomness(8)
+}
+
+// SetScaleRandom will set the value of the "scale_random" property.
+func (o *ParticlesMaterial) SetScaleRandom(value gdnative.Real) {
+	o.SetParamRandomness(8, value)
+}
+
+// Spread will return the value of the "spread" property.
+func (o *ParticlesMaterial) Spread() gdnative.Real {
+	return o.GetSpread()
+}
+
+// TangentialAccel will return the value of the "tangential_accel" property.
+func (o *ParticlesMaterial) TangentialAccel() gdnative.Real {
+	return o.GetParam(5)
+}
+
+// SetTangentialAccel will set the value of the "tangential_accel" property.
+func (o *ParticlesMaterial) SetTangentialAccel(value gdnative.Real) {
+	o.SetParam(5, value)
+}
+
+// TangentialAccelCurve will return the value of the "tangential_accel_curve" property.
+func (o *ParticlesMaterial) TangentialAccelCurve() godot.TextureImplementer {
+	return o.GetParamTexture(5)
+}
+
+// SetTangentialAccelCurve will set the value of the "tangential_accel_curve" property.
+func (o *ParticlesMaterial) SetTangentialAccelCurve(value godot.TextureImplementer) {
+	o.SetParamTexture(5, value)
+}
+
+// TangentialAccelRandom will return the value of the "tangential_accel_random" property.
+func (o *ParticlesMaterial) TangentialAccelRandom() gdnative.Real {
+	return o.GetParamRandomness(5)
+}
+
+// SetTangentialAccelRandom will set the value of the "tangential_accel_random" property.
+func (o *ParticlesMaterial) SetTangentialAccelRandom(value gdnative.Real) {
+	o.SetParamRandomness(5, value)
+}
+
+// TrailColorModifier will return the value of the "trail_color_modifier" property.
+func (o *ParticlesMaterial) TrailColorModifier() GradientTextureImplementer {
+	return o.GetTrailColorModifier()
+}
+
+// TrailDivisor will return the value of the "trail_divisor" property.
+func (o *ParticlesMaterial) TrailDivisor() gdnative.Int {
+	return o.GetTrailDivisor()
+}
+
+// TrailSizeModifier will return the value of the "trail_size_modifier" property.
+func (o *ParticlesMaterial) TrailSizeModifier() CurveTextureImplementer {
+	return o.GetTrailSizeModifier()
+}
+
+// ParticlesMaterialImplementer is an interface that implements the methods
+// of the ParticlesMaterial class.
+type ParticlesMaterialImplementer interface {
+	godot.MaterialImplementer
+	GetColor() gdnative.Color
+	GetColorRamp() godot.TextureImplementer
+	GetEmissionBoxExtents() gdnative.Vector3
+	GetEmissionColorTexture() godot.TextureImplementer
+	GetEmissionNormalTexture() godot.TextureImplementer
+	GetEmissionPointCount() gdnative.Int
+	GetEmissionPointTexture() godot.TextureImplementer
+	GetEmissionShape() ParticlesMaterialEmissionShape
+	GetEmissionSphereRadius() gdnative.Real
+	GetFlag(flag gdnative.Int) gdnative.Bool
+	GetFlatness() gdnative.Real
+	GetGravity() gdnative.Vector3
+	GetParam(param gdnative.Int) gdnative.Real
+	GetParamRandomness(param gdnative.Int) gdnative.Real
+	GetParamTexture(param gdnative.Int) godot.TextureImplementer
+	GetSpread() gdnative.Real
+	GetTrailColorModifier() GradientTextureImplementer
+	GetTrailDivisor() gdnative.Int
+	GetTrailSizeModifier() CurveTextureImplementer
+	SetColor(color gdnative.Color)
+	SetColorRamp(ramp godot.TextureImplementer)
+	SetEmissionBoxExtents(extents gdnative.Vector3)
+	SetEmissionColorTexture(texture godot.TextureImplementer)
+	SetEmissionNormalTexture(texture godot.TextureImplementer)
+	SetEmissionPointCount(pointCount gdnative.Int)
+	SetEmissionPointTexture(texture godot.TextureImplementer)
+	SetEmissionShape(shape ParticlesMaterialEmissionShape)
+	SetEmissionSphereRadius(radius gdnative.Real)
+	SetFlag(flag gdnative.Int, enable gdnative.Bool)
+	SetFlatness(amount gdnative.Real)
+	SetGravity(accelVec gdnative.Vector3)
+	SetParam(param gdnative.Int, value gdnative.Real)
+	SetParamRandomness(param gdnative.Int, randomness gdnative.Real)
+	SetParamTexture(param gdnative.Int, texture godot.TextureImplementer)
+	SetSpread(degrees gdnative.Real)
+	SetTrailColorModifier(texture GradientTextureImplementer)
+	SetTrailDivisor(divisor gdnative.Int)
+	SetTrailSizeModifier(texture CurveTextureImplementer)
+	Angle() gdnative.Real
+	SetAngle(value gdnative.Real)
+	AngleCurve() godot.TextureImplementer
+	SetAngleCurve(value godot.TextureImplementer)
+	AngleRandom() gdnative.Real
+	SetAngleRandom(value gdnative.Real)
+	AngularVelocity() gdnative.Real
+	SetAngularVelocity(value gdnative.Real)
+	AngularVelocityCurve() godot.TextureImplementer
+	SetAngularVelocityCurve(value godot.TextureImplementer)
+	AngularVelocityRandom() gdnative.Real
+	SetAngularVelocityRandom(value gdnative.Real)
+	AnimLoop() gdnative.Bool
+	SetAnimLoop(value gdnative.Bool)
+	AnimOffset() gdnative.Real
+	SetAnimOffset(value gdnative.Real)
+	AnimOffsetCurve() godot.TextureImplementer
+	SetAnimOffsetCurve(value godot.TextureImplementer)
+	AnimOffsetRandom() gdnative.Real
+	SetAnimOffsetRandom(value gdnative.Real)
+	AnimSpeed() gdnative.Real
+	SetAnimSpeed(value gdnative.Real)
+	AnimSpeedCurve() godot.TextureImplementer
+	SetAnimSpeedCurve(value godot.TextureImplementer)
+	AnimSpeedRandom() gdnative.Real
+	SetAnimSpeedRandom(value gdnative.Real)
+	Color() gdnative.Color
+	ColorRamp() godot.TextureImplementer
+	Damping() gdnative.Real
+	SetDamping(value gdnative.Real)
+	DampingCurve() godot.TextureImplementer
+	SetDampingCurve(value godot.TextureImplementer)
+	DampingRandom() gdnative.Real
+	SetDampingRandom(value gdnative.Real)
+	EmissionBoxExtents() gdnative.Vector3
+	EmissionColorTexture() godot.TextureImplementer
+	EmissionNormalTexture() godot.TextureImplementer
+	EmissionPointCount() gdnative.Int
+	EmissionPointTexture() godot.TextureImplementer
+	EmissionShape() ParticlesMaterialEmissionShape
+	EmissionSphereRadius() gdnative.Real
+	FlagAlignY() gdnative.Bool
+	SetFlagAlignY(value gdnative.Bool)
+	FlagDisableZ() gdnative.Bool
+	SetFlagDisableZ(value gdnative.Bool)
+	FlagRotateY() gdnative.Bool
+	SetFlagRotateY(value gdnative.Bool)
+	Flatness() gdnative.Real
+	Gravity() gdnative.Vector3
+	HueVariation() gdnative.Real
+	SetHueVariation(value gdnative.Real)
+	HueVariationCurve() godot.TextureImplementer
+	SetHueVariationCurve(value godot.TextureImplementer)
+	HueVariationRandom() gdnative.Real
+	SetHueVariationRandom(value gdnative.Real)
+	InitialVelocity() gdnative.Real
+	SetInitialVelocity(value gdnative.Real)
+	InitialVelocityRandom() gdnative.Real
+	SetInitialVelocityRandom(value gdnative.Real)
+	LinearAccel() gdnative.Real
+	SetLinearAccel(value gdnative.Real)
+	LinearAccelCurve() godot.TextureImplementer
+	SetLinearAccelCurve(value godot.TextureImplementer)
+	LinearAccelRandom() gdnative.Real
+	SetLinearAccelRandom(value gdnative.Real)
+	OrbitVelocity() gdnative.Real
+	SetOrbitVelocity(value gdnative.Real)
+	OrbitVelocityCurve() godot.TextureImplementer
+	SetOrbitVelocityCurve(value godot.TextureImplementer)
+	OrbitVelocityRandom() gdnative.Real
+	SetOrbitVelocityRandom(value gdnative.Real)
+	RadialAccel() gdnative.Real
+	SetRadialAccel(value gdnative.Real)
+	RadialAccelCurve() godot.TextureImplementer
+	SetRadialAccelCurve(value godot.TextureImplementer)
+	RadialAccelRandom() gdnative.Real
+	SetRadialAccelRandom(value gdnative.Real)
+	Scale() gdnative.Real
+	SetScale(value gdnative.Real)
+	ScaleCurve() godot.TextureImplementer
+	SetScaleCurve(value godot.TextureImplementer)
+	ScaleRandom() gdnative.Real
+	SetScaleRandom(value gdnative.Real)
+	Spread() gdnative.Real
+	TangentialAccel() gdnative.Real
+	SetTangentialAccel(value gdnative.Real)
+	TangentialAccelCurve() godot.TextureImplementer
+	SetTangentialAccelCurve(value godot.TextureImplementer)
+	TangentialAccelRandom() gdnative.Real
+	SetTangentialAccelRandom(value gdnative.Real)
+	TrailColorModifier() GradientTextureImplementer
+	TrailDivisor() gdnative.Int
+	TrailSizeModifier() CurveTextureImplementer
+}
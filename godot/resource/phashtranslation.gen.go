@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPHashTranslationFromPointer(ptr gdnative.Pointer) PHashTranslation {
-func newPHashTranslationFromPointer(ptr gdnative.Pointer) PHashTranslation {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PHashTranslation{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Optimized translation. Uses real-time compressed translations, which results in very small dictionaries.
 */
@@ -30,23 +22,45 @@ type PHashTranslation struct {
 	owner gdnative.Object
 }
 
+// classConstructorPHashTranslation is the class constructor of the PHashTranslation class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPHashTranslation = gdnative.NewLazyClassConstructor("PHashTranslation")
+
+// NewPHashTranslation will create a new instance of the PHashTranslation class.
+// PHashTranslation is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPHashTranslation() *PHashTranslation {
+	obj := &PHashTranslation{}
+	obj.SetBaseObject(classConstructorPHashTranslation.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PHashTranslation) BaseClass() string {
 	return "PHashTranslation"
 }
 
-/*
+// Method binds of the PHashTranslation class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPHashTranslationGenerate = gdnative.NewLazyMethodBind("PHashTranslation", "generate")
+)
 
-	Args: [{ false from Translation}], Returns: void
+/*
+Args: [{ false from Translation}], Returns: void
 */
 func (o *PHashTranslation) Generate(from TranslationImplementer) {
 	//log.Println("Calling PHashTranslation.Generate()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(from.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(from))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PHashTranslation", "generate")
+	methodBind := methodBindPHashTranslationGenerate.Get()
 
 	// Call the parent method.
 	// void
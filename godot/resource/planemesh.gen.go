@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPlaneMeshFromPointer(ptr gdnative.Pointer) PlaneMesh {
-func newPlaneMeshFromPointer(ptr gdnative.Pointer) PlaneMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PlaneMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class representing a planar [PrimitiveMesh]. This flat mesh does not have a thickness.
 */
@@ -30,13 +22,41 @@ type PlaneMesh struct {
 	owner gdnative.Object
 }
 
+// classConstructorPlaneMesh is the class constructor of the PlaneMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPlaneMesh = gdnative.NewLazyClassConstructor("PlaneMesh")
+
+// NewPlaneMesh will create a new instance of the PlaneMesh class.
+// PlaneMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPlaneMesh() *PlaneMesh {
+	obj := &PlaneMesh{}
+	obj.SetBaseObject(classConstructorPlaneMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PlaneMesh) BaseClass() string {
 	return "PlaneMesh"
 }
 
+// Method binds of the PlaneMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPlaneMeshGetSize           = gdnative.NewLazyMethodBind("PlaneMesh", "get_size")
+	methodBindPlaneMeshGetSubdivideDepth = gdnative.NewLazyMethodBind("PlaneMesh", "get_subdivide_depth")
+	methodBindPlaneMeshGetSubdivideWidth = gdnative.NewLazyMethodBind("PlaneMesh", "get_subdivide_width")
+	methodBindPlaneMeshSetSize           = gdnative.NewLazyMethodBind("PlaneMesh", "set_size")
+	methodBindPlaneMeshSetSubdivideDepth = gdnative.NewLazyMethodBind("PlaneMesh", "set_subdivide_depth")
+	methodBindPlaneMeshSetSubdivideWidth = gdnative.NewLazyMethodBind("PlaneMesh", "set_subdivide_width")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *PlaneMesh) GetSize() gdnative.Vector2 {
 	//log.Println("Calling PlaneMesh.GetSize()")
@@ -45,7 +65,7 @@ func (o *PlaneMesh) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneMesh", "get_size")
+	methodBind := methodBindPlaneMeshGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +78,8 @@ func (o *PlaneMesh) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PlaneMesh) GetSubdivideDepth() gdnative.Int {
 	//log.Println("Calling PlaneMesh.GetSubdivideDepth()")
@@ -68,7 +88,7 @@ func (o *PlaneMesh) GetSubdivideDepth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneMesh", "get_subdivide_depth")
+	methodBind := methodBindPlaneMeshGetSubdivideDepth.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +101,8 @@ func (o *PlaneMesh) GetSubdivideDepth() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PlaneMesh) GetSubdivideWidth() gdnative.Int {
 	//log.Println("Calling PlaneMesh.GetSubdivideWidth()")
@@ -91,7 +111,7 @@ func (o *PlaneMesh) GetSubdivideWidth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneMesh", "get_subdivide_width")
+	methodBind := methodBindPlaneMeshGetSubdivideWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +124,8 @@ func (o *PlaneMesh) GetSubdivideWidth() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *PlaneMesh) SetSize(size gdnative.Vector2) {
 	//log.Println("Calling PlaneMesh.SetSize()")
@@ -115,7 +135,7 @@ func (o *PlaneMesh) SetSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneMesh", "set_size")
+	methodBind := methodBindPlaneMeshSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +145,8 @@ func (o *PlaneMesh) SetSize(size gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false subdivide int}], Returns: void
+	        Undocumented
+		Args: [{ false subdivide int}], Returns: void
 */
 func (o *PlaneMesh) SetSubdivideDepth(subdivide gdnative.Int) {
 	//log.Println("Calling PlaneMesh.SetSubdivideDepth()")
@@ -136,7 +156,7 @@ func (o *PlaneMesh) SetSubdivideDepth(subdivide gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(subdivide)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneMesh", "set_subdivide_depth")
+	methodBind := methodBindPlaneMeshSetSubdivideDepth.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +166,8 @@ func (o *PlaneMesh) SetSubdivideDepth(subdivide gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false subdivide int}], Returns: void
+	        Undocumented
+		Args: [{ false subdivide int}], Returns: void
 */
 func (o *PlaneMesh) SetSubdivideWidth(subdivide gdnative.Int) {
 	//log.Println("Calling PlaneMesh.SetSubdivideWidth()")
@@ -157,7 +177,7 @@ func (o *PlaneMesh) SetSubdivideWidth(subdivide gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(subdivide)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneMesh", "set_subdivide_width")
+	methodBind := methodBindPlaneMeshSetSubdivideWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -166,6 +186,21 @@ func (o *PlaneMesh) SetSubdivideWidth(subdivide gdnative.Int) {
 
 }
 
+// Size will return the value of the "size" property.
+func (o *PlaneMesh) Size() gdnative.Vector2 {
+	return o.GetSize()
+}
+
+// SubdivideDepth will return the value of the "subdivide_depth" property.
+func (o *PlaneMesh) SubdivideDepth() gdnative.Int {
+	return o.GetSubdivideDepth()
+}
+
+// SubdivideWidth will return the value of the "subdivide_width" property.
+func (o *PlaneMesh) SubdivideWidth() gdnative.Int {
+	return o.GetSubdivideWidth()
+}
+
 // PlaneMeshImplementer is an interface that implements the methods
 // of the PlaneMesh class.
 type PlaneMeshImplementer interface {
@@ -176,4 +211,7 @@ type PlaneMeshImplementer interface {
 	SetSize(size gdnative.Vector2)
 	SetSubdivideDepth(subdivide gdnative.Int)
 	SetSubdivideWidth(subdivide gdnative.Int)
+	Size() gdnative.Vector2
+	SubdivideDepth() gdnative.Int
+	SubdivideWidth() gdnative.Int
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,44 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPlaneShapeFromPointer(ptr gdnative.Pointer) PlaneShape {
-func newPlaneShapeFromPointer(ptr gdnative.Pointer) PlaneShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PlaneShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PlaneShape struct {
-	Shape
+	godot.Shape
 	owner gdnative.Object
 }
 
+// classConstructorPlaneShape is the class constructor of the PlaneShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPlaneShape = gdnative.NewLazyClassConstructor("PlaneShape")
+
+// NewPlaneShape will create a new instance of the PlaneShape class.
+// PlaneShape is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPlaneShape() *PlaneShape {
+	obj := &PlaneShape{}
+	obj.SetBaseObject(classConstructorPlaneShape.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PlaneShape) BaseClass() string {
 	return "PlaneShape"
 }
 
+// Method binds of the PlaneShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPlaneShapeGetPlane = gdnative.NewLazyMethodBind("PlaneShape", "get_plane")
+	methodBindPlaneShapeSetPlane = gdnative.NewLazyMethodBind("PlaneShape", "set_plane")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Plane
+	        Undocumented
+		Args: [], Returns: Plane
 */
 func (o *PlaneShape) GetPlane() gdnative.Plane {
 	//log.Println("Calling PlaneShape.GetPlane()")
@@ -45,7 +60,7 @@ func (o *PlaneShape) GetPlane() gdnative.Plane {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneShape", "get_plane")
+	methodBind := methodBindPlaneShapeGetPlane.Get()
 
 	// Call the parent method.
 	// Plane
@@ -58,8 +73,8 @@ func (o *PlaneShape) GetPlane() gdnative.Plane {
 }
 
 /*
-        Undocumented
-	Args: [{ false plane Plane}], Returns: void
+	        Undocumented
+		Args: [{ false plane Plane}], Returns: void
 */
 func (o *PlaneShape) SetPlane(plane gdnative.Plane) {
 	//log.Println("Calling PlaneShape.SetPlane()")
@@ -69,7 +84,7 @@ func (o *PlaneShape) SetPlane(plane gdnative.Plane) {
 	ptrArguments[0] = gdnative.NewPointerFromPlane(plane)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PlaneShape", "set_plane")
+	methodBind := methodBindPlaneShapeSetPlane.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +93,16 @@ func (o *PlaneShape) SetPlane(plane gdnative.Plane) {
 
 }
 
+// Plane will return the value of the "plane" property.
+func (o *PlaneShape) Plane() gdnative.Plane {
+	return o.GetPlane()
+}
+
 // PlaneShapeImplementer is an interface that implements the methods
 // of the PlaneShape class.
 type PlaneShapeImplementer interface {
-	ShapeImplementer
+	godot.ShapeImplementer
 	GetPlane() gdnative.Plane
 	SetPlane(plane gdnative.Plane)
+	Plane() gdnative.Plane
 }
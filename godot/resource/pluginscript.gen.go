@@ -0,0 +1,50 @@
+package resource
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type PluginScript struct {
+	godot.Script
+	owner gdnative.Object
+}
+
+// classConstructorPluginScript is the class constructor of the PluginScript class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPluginScript = gdnative.NewLazyClassConstructor("PluginScript")
+
+// NewPluginScript will create a new instance of the PluginScript class.
+// PluginScript is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPluginScript() *PluginScript {
+	obj := &PluginScript{}
+	obj.SetBaseObject(classConstructorPluginScript.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *PluginScript) BaseClass() string {
+	return "PluginScript"
+}
+
+// PluginScriptImplementer is an interface that implements the methods
+// of the PluginScript class.
+type PluginScriptImplementer interface {
+	godot.ScriptImplementer
+}
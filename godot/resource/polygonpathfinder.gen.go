@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,52 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPolygonPathFinderFromPointer(ptr gdnative.Pointer) PolygonPathFinder {
-func newPolygonPathFinderFromPointer(ptr gdnative.Pointer) PolygonPathFinder {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PolygonPathFinder{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type PolygonPathFinder struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorPolygonPathFinder is the class constructor of the PolygonPathFinder class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPolygonPathFinder = gdnative.NewLazyClassConstructor("PolygonPathFinder")
+
+// NewPolygonPathFinder will create a new instance of the PolygonPathFinder class.
+// PolygonPathFinder is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPolygonPathFinder() *PolygonPathFinder {
+	obj := &PolygonPathFinder{}
+	obj.SetBaseObject(classConstructorPolygonPathFinder.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PolygonPathFinder) BaseClass() string {
 	return "PolygonPathFinder"
 }
 
+// Method binds of the PolygonPathFinder class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPolygonPathFinderX_GetData        = gdnative.NewLazyMethodBind("PolygonPathFinder", "_get_data")
+	methodBindPolygonPathFinderX_SetData        = gdnative.NewLazyMethodBind("PolygonPathFinder", "_set_data")
+	methodBindPolygonPathFinderFindPath         = gdnative.NewLazyMethodBind("PolygonPathFinder", "find_path")
+	methodBindPolygonPathFinderGetBounds        = gdnative.NewLazyMethodBind("PolygonPathFinder", "get_bounds")
+	methodBindPolygonPathFinderGetClosestPoint  = gdnative.NewLazyMethodBind("PolygonPathFinder", "get_closest_point")
+	methodBindPolygonPathFinderGetIntersections = gdnative.NewLazyMethodBind("PolygonPathFinder", "get_intersections")
+	methodBindPolygonPathFinderGetPointPenalty  = gdnative.NewLazyMethodBind("PolygonPathFinder", "get_point_penalty")
+	methodBindPolygonPathFinderIsPointInside    = gdnative.NewLazyMethodBind("PolygonPathFinder", "is_point_inside")
+	methodBindPolygonPathFinderSetPointPenalty  = gdnative.NewLazyMethodBind("PolygonPathFinder", "set_point_penalty")
+	methodBindPolygonPathFinderSetup            = gdnative.NewLazyMethodBind("PolygonPathFinder", "setup")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *PolygonPathFinder) X_GetData() gdnative.Dictionary {
 	//log.Println("Calling PolygonPathFinder.X_GetData()")
@@ -45,7 +68,7 @@ func (o *PolygonPathFinder) X_GetData() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "_get_data")
+	methodBind := methodBindPolygonPathFinderX_GetData.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -58,8 +81,8 @@ func (o *PolygonPathFinder) X_GetData() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Dictionary}], Returns: void
 */
 func (o *PolygonPathFinder) X_SetData(arg0 gdnative.Dictionary) {
 	//log.Println("Calling PolygonPathFinder.X_SetData()")
@@ -69,7 +92,7 @@ func (o *PolygonPathFinder) X_SetData(arg0 gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "_set_data")
+	methodBind := methodBindPolygonPathFinderX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +102,7 @@ func (o *PolygonPathFinder) X_SetData(arg0 gdnative.Dictionary) {
 }
 
 /*
-
-	Args: [{ false from Vector2} { false to Vector2}], Returns: PoolVector2Array
+Args: [{ false from Vector2} { false to Vector2}], Returns: PoolVector2Array
 */
 func (o *PolygonPathFinder) FindPath(from gdnative.Vector2, to gdnative.Vector2) gdnative.PoolVector2Array {
 	//log.Println("Calling PolygonPathFinder.FindPath()")
@@ -91,7 +113,7 @@ func (o *PolygonPathFinder) FindPath(from gdnative.Vector2, to gdnative.Vector2)
 	ptrArguments[1] = gdnative.NewPointerFromVector2(to)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "find_path")
+	methodBind := methodBindPolygonPathFinderFindPath.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -104,8 +126,7 @@ func (o *PolygonPathFinder) FindPath(from gdnative.Vector2, to gdnative.Vector2)
 }
 
 /*
-
-	Args: [], Returns: Rect2
+Args: [], Returns: Rect2
 */
 func (o *PolygonPathFinder) GetBounds() gdnative.Rect2 {
 	//log.Println("Calling PolygonPathFinder.GetBounds()")
@@ -114,7 +135,7 @@ func (o *PolygonPathFinder) GetBounds() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "get_bounds")
+	methodBind := methodBindPolygonPathFinderGetBounds.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -127,8 +148,7 @@ func (o *PolygonPathFinder) GetBounds() gdnative.Rect2 {
 }
 
 /*
-
-	Args: [{ false point Vector2}], Returns: Vector2
+Args: [{ false point Vector2}], Returns: Vector2
 */
 func (o *PolygonPathFinder) GetClosestPoint(point gdnative.Vector2) gdnative.Vector2 {
 	//log.Println("Calling PolygonPathFinder.GetClosestPoint()")
@@ -138,7 +158,7 @@ func (o *PolygonPathFinder) GetClosestPoint(point gdnative.Vector2) gdnative.Vec
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "get_closest_point")
+	methodBind := methodBindPolygonPathFinderGetClosestPoint.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -151,8 +171,7 @@ func (o *PolygonPathFinder) GetClosestPoint(point gdnative.Vector2) gdnative.Vec
 }
 
 /*
-
-	Args: [{ false from Vector2} { false to Vector2}], Returns: PoolVector2Array
+Args: [{ false from Vector2} { false to Vector2}], Returns: PoolVector2Array
 */
 func (o *PolygonPathFinder) GetIntersections(from gdnative.Vector2, to gdnative.Vector2) gdnative.PoolVector2Array {
 	//log.Println("Calling PolygonPathFinder.GetIntersections()")
@@ -163,7 +182,7 @@ func (o *PolygonPathFinder) GetIntersections(from gdnative.Vector2, to gdnative.
 	ptrArguments[1] = gdnative.NewPointerFromVector2(to)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "get_intersections")
+	methodBind := methodBindPolygonPathFinderGetIntersections.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -176,8 +195,7 @@ func (o *PolygonPathFinder) GetIntersections(from gdnative.Vector2, to gdnative.
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: float
+Args: [{ false idx int}], Returns: float
 */
 func (o *PolygonPathFinder) GetPointPenalty(idx gdnative.Int) gdnative.Real {
 	//log.Println("Calling PolygonPathFinder.GetPointPenalty()")
@@ -187,7 +205,7 @@ func (o *PolygonPathFinder) GetPointPenalty(idx gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "get_point_penalty")
+	methodBind := methodBindPolygonPathFinderGetPointPenalty.Get()
 
 	// Call the parent method.
 	// float
@@ -200,8 +218,7 @@ func (o *PolygonPathFinder) GetPointPenalty(idx gdnative.Int) gdnative.Real {
 }
 
 /*
-
-	Args: [{ false point Vector2}], Returns: bool
+Args: [{ false point Vector2}], Returns: bool
 */
 func (o *PolygonPathFinder) IsPointInside(point gdnative.Vector2) gdnative.Bool {
 	//log.Println("Calling PolygonPathFinder.IsPointInside()")
@@ -211,7 +228,7 @@ func (o *PolygonPathFinder) IsPointInside(point gdnative.Vector2) gdnative.Bool
 	ptrArguments[0] = gdnative.NewPointerFromVector2(point)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "is_point_inside")
+	methodBind := methodBindPolygonPathFinderIsPointInside.Get()
 
 	// Call the parent method.
 	// bool
@@ -224,8 +241,7 @@ func (o *PolygonPathFinder) IsPointInside(point gdnative.Vector2) gdnative.Bool
 }
 
 /*
-
-	Args: [{ false idx int} { false penalty float}], Returns: void
+Args: [{ false idx int} { false penalty float}], Returns: void
 */
 func (o *PolygonPathFinder) SetPointPenalty(idx gdnative.Int, penalty gdnative.Real) {
 	//log.Println("Calling PolygonPathFinder.SetPointPenalty()")
@@ -236,7 +252,7 @@ func (o *PolygonPathFinder) SetPointPenalty(idx gdnative.Int, penalty gdnative.R
 	ptrArguments[1] = gdnative.NewPointerFromReal(penalty)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "set_point_penalty")
+	methodBind := methodBindPolygonPathFinderSetPointPenalty.Get()
 
 	// Call the parent method.
 	// void
@@ -246,8 +262,7 @@ func (o *PolygonPathFinder) SetPointPenalty(idx gdnative.Int, penalty gdnative.R
 }
 
 /*
-
-	Args: [{ false points PoolVector2Array} { false connections PoolIntArray}], Returns: void
+Args: [{ false points PoolVector2Array} { false connections PoolIntArray}], Returns: void
 */
 func (o *PolygonPathFinder) Setup(points gdnative.PoolVector2Array, connections gdnative.PoolIntArray) {
 	//log.Println("Calling PolygonPathFinder.Setup()")
@@ -258,7 +273,7 @@ func (o *PolygonPathFinder) Setup(points gdnative.PoolVector2Array, connections
 	ptrArguments[1] = gdnative.NewPointerFromPoolIntArray(connections)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PolygonPathFinder", "setup")
+	methodBind := methodBindPolygonPathFinderSetup.Get()
 
 	// Call the parent method.
 	// void
@@ -267,10 +282,20 @@ func (o *PolygonPathFinder) Setup(points gdnative.PoolVector2Array, connections
 
 }
 
+// Data will return the value of the "data" property.
+func (o *PolygonPathFinder) Data() gdnative.Dictionary {
+	return o.X_GetData()
+}
+
+// SetData will set the value of the "data" property.
+func (o *PolygonPathFinder) SetData(value gdnative.Dictionary) {
+	o.X_SetData(value)
+}
+
 // PolygonPathFinderImplementer is an interface that implements the methods
 // of the PolygonPathFinder class.
 type PolygonPathFinderImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetData() gdnative.Dictionary
 	X_SetData(arg0 gdnative.Dictionary)
 	FindPath(from gdnative.Vector2, to gdnative.Vector2) gdnative.PoolVector2Array
@@ -281,4 +306,6 @@ type PolygonPathFinderImplementer interface {
 	IsPointInside(point gdnative.Vector2) gdnative.Bool
 	SetPointPenalty(idx gdnative.Int, penalty gdnative.Real)
 	Setup(points gdnative.PoolVector2Array, connections gdnative.PoolIntArray)
+	Data() gdnative.Dictionary
+	SetData(value gdnative.Dictionary)
 }
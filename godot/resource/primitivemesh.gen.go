@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPrimitiveMeshFromPointer(ptr gdnative.Pointer) PrimitiveMesh {
-func newPrimitiveMeshFromPointer(ptr gdnative.Pointer) PrimitiveMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PrimitiveMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base class for all primitive meshes. Handles applying a [Material] to a primitive mesh.
 */
 type PrimitiveMesh struct {
-	Mesh
+	godot.Mesh
 	owner gdnative.Object
 }
 
@@ -34,9 +26,18 @@ func (o *PrimitiveMesh) BaseClass() string {
 	return "PrimitiveMesh"
 }
 
+// Method binds of the PrimitiveMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPrimitiveMeshX_Update      = gdnative.NewLazyMethodBind("PrimitiveMesh", "_update")
+	methodBindPrimitiveMeshGetMaterial   = gdnative.NewLazyMethodBind("PrimitiveMesh", "get_material")
+	methodBindPrimitiveMeshGetMeshArrays = gdnative.NewLazyMethodBind("PrimitiveMesh", "get_mesh_arrays")
+	methodBindPrimitiveMeshSetMaterial   = gdnative.NewLazyMethodBind("PrimitiveMesh", "set_material")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *PrimitiveMesh) X_Update() {
 	//log.Println("Calling PrimitiveMesh.X_Update()")
@@ -45,7 +46,7 @@ func (o *PrimitiveMesh) X_Update() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrimitiveMesh", "_update")
+	methodBind := methodBindPrimitiveMeshX_Update.Get()
 
 	// Call the parent method.
 	// void
@@ -55,17 +56,17 @@ func (o *PrimitiveMesh) X_Update() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Material
+	        Undocumented
+		Args: [], Returns: Material
 */
-func (o *PrimitiveMesh) GetMaterial() MaterialImplementer {
+func (o *PrimitiveMesh) GetMaterial() godot.MaterialImplementer {
 	//log.Println("Calling PrimitiveMesh.GetMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrimitiveMesh", "get_material")
+	methodBind := methodBindPrimitiveMeshGetMaterial.Get()
 
 	// Call the parent method.
 	// Material
@@ -73,27 +74,24 @@ func (o *PrimitiveMesh) GetMaterial() MaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := godot.Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: Array
+Args: [], Returns: Array
 */
 func (o *PrimitiveMesh) GetMeshArrays() gdnative.Array {
 	//log.Println("Calling PrimitiveMesh.GetMeshArrays()")
@@ -102,7 +100,7 @@ func (o *PrimitiveMesh) GetMeshArrays() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrimitiveMesh", "get_mesh_arrays")
+	methodBind := methodBindPrimitiveMeshGetMeshArrays.Get()
 
 	// Call the parent method.
 	// Array
@@ -115,18 +113,18 @@ func (o *PrimitiveMesh) GetMeshArrays() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false material Material}], Returns: void
+	        Undocumented
+		Args: [{ false material Material}], Returns: void
 */
-func (o *PrimitiveMesh) SetMaterial(material MaterialImplementer) {
+func (o *PrimitiveMesh) SetMaterial(material godot.MaterialImplementer) {
 	//log.Println("Calling PrimitiveMesh.SetMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrimitiveMesh", "set_material")
+	methodBind := methodBindPrimitiveMeshSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -135,12 +133,18 @@ func (o *PrimitiveMesh) SetMaterial(material MaterialImplementer) {
 
 }
 
+// Material will return the value of the "material" property.
+func (o *PrimitiveMesh) Material() godot.MaterialImplementer {
+	return o.GetMaterial()
+}
+
 // PrimitiveMeshImplementer is an interface that implements the methods
 // of the PrimitiveMesh class.
 type PrimitiveMeshImplementer interface {
-	MeshImplementer
+	godot.MeshImplementer
 	X_Update()
-	GetMaterial() MaterialImplementer
+	GetMaterial() godot.MaterialImplementer
 	GetMeshArrays() gdnative.Array
-	SetMaterial(material MaterialImplementer)
+	SetMaterial(material godot.MaterialImplementer)
+	Material() godot.MaterialImplementer
 }
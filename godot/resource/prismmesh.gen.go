@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPrismMeshFromPointer(ptr gdnative.Pointer) PrismMesh {
-func newPrismMeshFromPointer(ptr gdnative.Pointer) PrismMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PrismMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class representing a prism-shaped [PrimitiveMesh].
 */
@@ -30,13 +22,45 @@ type PrismMesh struct {
 	owner gdnative.Object
 }
 
+// classConstructorPrismMesh is the class constructor of the PrismMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPrismMesh = gdnative.NewLazyClassConstructor("PrismMesh")
+
+// NewPrismMesh will create a new instance of the PrismMesh class.
+// PrismMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewPrismMesh() *PrismMesh {
+	obj := &PrismMesh{}
+	obj.SetBaseObject(classConstructorPrismMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PrismMesh) BaseClass() string {
 	return "PrismMesh"
 }
 
+// Method binds of the PrismMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPrismMeshGetLeftToRight     = gdnative.NewLazyMethodBind("PrismMesh", "get_left_to_right")
+	methodBindPrismMeshGetSize            = gdnative.NewLazyMethodBind("PrismMesh", "get_size")
+	methodBindPrismMeshGetSubdivideDepth  = gdnative.NewLazyMethodBind("PrismMesh", "get_subdivide_depth")
+	methodBindPrismMeshGetSubdivideHeight = gdnative.NewLazyMethodBind("PrismMesh", "get_subdivide_height")
+	methodBindPrismMeshGetSubdivideWidth  = gdnative.NewLazyMethodBind("PrismMesh", "get_subdivide_width")
+	methodBindPrismMeshSetLeftToRight     = gdnative.NewLazyMethodBind("PrismMesh", "set_left_to_right")
+	methodBindPrismMeshSetSize            = gdnative.NewLazyMethodBind("PrismMesh", "set_size")
+	methodBindPrismMeshSetSubdivideDepth  = gdnative.NewLazyMethodBind("PrismMesh", "set_subdivide_depth")
+	methodBindPrismMeshSetSubdivideHeight = gdnative.NewLazyMethodBind("PrismMesh", "set_subdivide_height")
+	methodBindPrismMeshSetSubdivideWidth  = gdnative.NewLazyMethodBind("PrismMesh", "set_subdivide_width")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PrismMesh) GetLeftToRight() gdnative.Real {
 	//log.Println("Calling PrismMesh.GetLeftToRight()")
@@ -45,7 +69,7 @@ func (o *PrismMesh) GetLeftToRight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "get_left_to_right")
+	methodBind := methodBindPrismMeshGetLeftToRight.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +82,8 @@ func (o *PrismMesh) GetLeftToRight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *PrismMesh) GetSize() gdnative.Vector3 {
 	//log.Println("Calling PrismMesh.GetSize()")
@@ -68,7 +92,7 @@ func (o *PrismMesh) GetSize() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "get_size")
+	methodBind := methodBindPrismMeshGetSize.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -81,8 +105,8 @@ func (o *PrismMesh) GetSize() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PrismMesh) GetSubdivideDepth() gdnative.Int {
 	//log.Println("Calling PrismMesh.GetSubdivideDepth()")
@@ -91,7 +115,7 @@ func (o *PrismMesh) GetSubdivideDepth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "get_subdivide_depth")
+	methodBind := methodBindPrismMeshGetSubdivideDepth.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +128,8 @@ func (o *PrismMesh) GetSubdivideDepth() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PrismMesh) GetSubdivideHeight() gdnative.Int {
 	//log.Println("Calling PrismMesh.GetSubdivideHeight()")
@@ -114,7 +138,7 @@ func (o *PrismMesh) GetSubdivideHeight() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "get_subdivide_height")
+	methodBind := methodBindPrismMeshGetSubdivideHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +151,8 @@ func (o *PrismMesh) GetSubdivideHeight() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *PrismMesh) GetSubdivideWidth() gdnative.Int {
 	//log.Println("Calling PrismMesh.GetSubdivideWidth()")
@@ -137,7 +161,7 @@ func (o *PrismMesh) GetSubdivideWidth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "get_subdivide_width")
+	methodBind := methodBindPrismMeshGetSubdivideWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -150,8 +174,8 @@ func (o *PrismMesh) GetSubdivideWidth() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false left_to_right float}], Returns: void
+	        Undocumented
+		Args: [{ false left_to_right float}], Returns: void
 */
 func (o *PrismMesh) SetLeftToRight(leftToRight gdnative.Real) {
 	//log.Println("Calling PrismMesh.SetLeftToRight()")
@@ -161,7 +185,7 @@ func (o *PrismMesh) SetLeftToRight(leftToRight gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(leftToRight)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "set_left_to_right")
+	methodBind := methodBindPrismMeshSetLeftToRight.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +195,8 @@ func (o *PrismMesh) SetLeftToRight(leftToRight gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector3}], Returns: void
 */
 func (o *PrismMesh) SetSize(size gdnative.Vector3) {
 	//log.Println("Calling PrismMesh.SetSize()")
@@ -182,7 +206,7 @@ func (o *PrismMesh) SetSize(size gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "set_size")
+	methodBind := methodBindPrismMeshSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +216,8 @@ func (o *PrismMesh) SetSize(size gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false segments int}], Returns: void
+	        Undocumented
+		Args: [{ false segments int}], Returns: void
 */
 func (o *PrismMesh) SetSubdivideDepth(segments gdnative.Int) {
 	//log.Println("Calling PrismMesh.SetSubdivideDepth()")
@@ -203,7 +227,7 @@ func (o *PrismMesh) SetSubdivideDepth(segments gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(segments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "set_subdivide_depth")
+	methodBind := methodBindPrismMeshSetSubdivideDepth.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +237,8 @@ func (o *PrismMesh) SetSubdivideDepth(segments gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false segments int}], Returns: void
+	        Undocumented
+		Args: [{ false segments int}], Returns: void
 */
 func (o *PrismMesh) SetSubdivideHeight(segments gdnative.Int) {
 	//log.Println("Calling PrismMesh.SetSubdivideHeight()")
@@ -224,7 +248,7 @@ func (o *PrismMesh) SetSubdivideHeight(segments gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(segments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "set_subdivide_height")
+	methodBind := methodBindPrismMeshSetSubdivideHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +258,8 @@ func (o *PrismMesh) SetSubdivideHeight(segments gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false segments int}], Returns: void
+	        Undocumented
+		Args: [{ false segments int}], Returns: void
 */
 func (o *PrismMesh) SetSubdivideWidth(segments gdnative.Int) {
 	//log.Println("Calling PrismMesh.SetSubdivideWidth()")
@@ -245,7 +269,7 @@ func (o *PrismMesh) SetSubdivideWidth(segments gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(segments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PrismMesh", "set_subdivide_width")
+	methodBind := methodBindPrismMeshSetSubdivideWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -254,6 +278,31 @@ func (o *PrismMesh) SetSubdivideWidth(segments gdnative.Int) {
 
 }
 
+// LeftToRight will return the value of the "left_to_right" property.
+func (o *PrismMesh) LeftToRight() gdnative.Real {
+	return o.GetLeftToRight()
+}
+
+// Size will return the value of the "size" property.
+func (o *PrismMesh) Size() gdnative.Vector3 {
+	return o.GetSize()
+}
+
+// SubdivideDepth will return the value of the "subdivide_depth" property.
+func (o *PrismMesh) SubdivideDepth() gdnative.Int {
+	return o.GetSubdivideDepth()
+}
+
+// SubdivideHeight will return the value of the "subdivide_height" property.
+func (o *PrismMesh) SubdivideHeight() gdnative.Int {
+	return o.GetSubdivideHeight()
+}
+
+// SubdivideWidth will return the value of the "subdivide_width" property.
+func (o *PrismMesh) SubdivideWidth() gdnative.Int {
+	return o.GetSubdivideWidth()
+}
+
 // PrismMeshImplementer is an interface that implements the methods
 // of the PrismMesh class.
 type PrismMeshImplementer interface {
@@ -268,4 +317,9 @@ type PrismMeshImplementer interface {
 	SetSubdivideDepth(segments gdnative.Int)
 	SetSubdivideHeight(segments gdnative.Int)
 	SetSubdivideWidth(segments gdnative.Int)
+	LeftToRight() gdnative.Real
+	Size() gdnative.Vector3
+	SubdivideDepth() gdnative.Int
+	SubdivideHeight() gdnative.Int
+	SubdivideWidth() gdnative.Int
 }
@@ -1,7 +1,10 @@
-package godot
+package resource
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -25,40 +28,124 @@ const (
 	ProceduralSkyTextureSizeMax  ProceduralSkyTextureSize = 5
 )
 
-//func NewProceduralSkyFromPointer(ptr gdnative.Pointer) ProceduralSky {
-func newProceduralSkyFromPointer(ptr gdnative.Pointer) ProceduralSky {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ProceduralSky{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the ProceduralSkyTextureSize value.
+func (e ProceduralSkyTextureSize) String() string {
+	switch e {
+	case ProceduralSkyTextureSize1024:
+		return "TEXTURE_SIZE_1024"
+	case ProceduralSkyTextureSize2048:
+		return "TEXTURE_SIZE_2048"
+	case ProceduralSkyTextureSize256:
+		return "TEXTURE_SIZE_256"
+	case ProceduralSkyTextureSize4096:
+		return "TEXTURE_SIZE_4096"
+	case ProceduralSkyTextureSize512:
+		return "TEXTURE_SIZE_512"
+	case ProceduralSkyTextureSizeMax:
+		return "TEXTURE_SIZE_MAX"
+	}
+	return fmt.Sprintf("ProceduralSkyTextureSize(%d)", int(e))
+}
+
+// ParseProceduralSkyTextureSize will return the ProceduralSkyTextureSize value with the given Godot name.
+func ParseProceduralSkyTextureSize(name string) (ProceduralSkyTextureSize, error) {
+	switch name {
+	case "TEXTURE_SIZE_1024":
+		return ProceduralSkyTextureSize1024, nil
+	case "TEXTURE_SIZE_2048":
+		return ProceduralSkyTextureSize2048, nil
+	case "TEXTURE_SIZE_256":
+		return ProceduralSkyTextureSize256, nil
+	case "TEXTURE_SIZE_4096":
+		return ProceduralSkyTextureSize4096, nil
+	case "TEXTURE_SIZE_512":
+		return ProceduralSkyTextureSize512, nil
+	case "TEXTURE_SIZE_MAX":
+		return ProceduralSkyTextureSizeMax, nil
+	}
+	return 0, fmt.Errorf("invalid ProceduralSkyTextureSize value %q", name)
 }
 
 /*
-
  */
 type ProceduralSky struct {
-	Sky
+	godot.Sky
 	owner gdnative.Object
 }
 
+// classConstructorProceduralSky is the class constructor of the ProceduralSky class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorProceduralSky = gdnative.NewLazyClassConstructor("ProceduralSky")
+
+// NewProceduralSky will create a new instance of the ProceduralSky class.
+// ProceduralSky is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewProceduralSky() *ProceduralSky {
+	obj := &ProceduralSky{}
+	obj.SetBaseObject(classConstructorProceduralSky.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ProceduralSky) BaseClass() string {
 	return "ProceduralSky"
 }
 
+// Method binds of the ProceduralSky class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindProceduralSkyX_ThreadDone          = gdnative.NewLazyMethodBind("ProceduralSky", "_thread_done")
+	methodBindProceduralSkyX_UpdateSky           = gdnative.NewLazyMethodBind("ProceduralSky", "_update_sky")
+	methodBindProceduralSkyGetGroundBottomColor  = gdnative.NewLazyMethodBind("ProceduralSky", "get_ground_bottom_color")
+	methodBindProceduralSkyGetGroundCurve        = gdnative.NewLazyMethodBind("ProceduralSky", "get_ground_curve")
+	methodBindProceduralSkyGetGroundEnergy       = gdnative.NewLazyMethodBind("ProceduralSky", "get_ground_energy")
+	methodBindProceduralSkyGetGroundHorizonColor = gdnative.NewLazyMethodBind("ProceduralSky", "get_ground_horizon_color")
+	methodBindProceduralSkyGetSkyCurve           = gdnative.NewLazyMethodBind("ProceduralSky", "get_sky_curve")
+	methodBindProceduralSkyGetSkyEnergy          = gdnative.NewLazyMethodBind("ProceduralSky", "get_sky_energy")
+	methodBindProceduralSkyGetSkyHorizonColor    = gdnative.NewLazyMethodBind("ProceduralSky", "get_sky_horizon_color")
+	methodBindProceduralSkyGetSkyTopColor        = gdnative.NewLazyMethodBind("ProceduralSky", "get_sky_top_color")
+	methodBindProceduralSkyGetSunAngleMax        = gdnative.NewLazyMethodBind("ProceduralSky", "get_sun_angle_max")
+	methodBindProceduralSkyGetSunAngleMin        = gdnative.NewLazyMethodBind("ProceduralSky", "get_sun_angle_min")
+	methodBindProceduralSkyGetSunColor           = gdnative.NewLazyMethodBind("ProceduralSky", "get_sun_color")
+	methodBindProceduralSkyGetSunCurve           = gdnative.NewLazyMethodBind("ProceduralSky", "get_sun_curve")
+	methodBindProceduralSkyGetSunEnergy          = gdnative.NewLazyMethodBind("ProceduralSky", "get_sun_energy")
+	methodBindProceduralSkyGetSunLatitude        = gdnative.NewLazyMethodBind("ProceduralSky", "get_sun_latitude")
+	methodBindProceduralSkyGetSunLongitude       = gdnative.NewLazyMethodBind("ProceduralSky", "get_sun_longitude")
+	methodBindProceduralSkyGetTextureSize        = gdnative.NewLazyMethodBind("ProceduralSky", "get_texture_size")
+	methodBindProceduralSkySetGroundBottomColor  = gdnative.NewLazyMethodBind("ProceduralSky", "set_ground_bottom_color")
+	methodBindProceduralSkySetGroundCurve        = gdnative.NewLazyMethodBind("ProceduralSky", "set_ground_curve")
+	methodBindProceduralSkySetGroundEnergy       = gdnative.NewLazyMethodBind("ProceduralSky", "set_ground_energy")
+	methodBindProceduralSkySetGroundHorizonColor = gdnative.NewLazyMethodBind("ProceduralSky", "set_ground_horizon_color")
+	methodBindProceduralSkySetSkyCurve           = gdnative.NewLazyMethodBind("ProceduralSky", "set_sky_curve")
+	methodBindProceduralSkySetSkyEnergy          = gdnative.NewLazyMethodBind("ProceduralSky", "set_sky_energy")
+	methodBindProceduralSkySetSkyHorizonColor    = gdnative.NewLazyMethodBind("ProceduralSky", "set_sky_horizon_color")
+	methodBindProceduralSkySetSkyTopColor        = gdnative.NewLazyMethodBind("ProceduralSky", "set_sky_top_color")
+	methodBindProceduralSkySetSunAngleMax        = gdnative.NewLazyMethodBind("ProceduralSky", "set_sun_angle_max")
+	methodBindProceduralSkySetSunAngleMin        = gdnative.NewLazyMethodBind("ProceduralSky", "set_sun_angle_min")
+	methodBindProceduralSkySetSunColor           = gdnative.NewLazyMethodBind("ProceduralSky", "set_sun_color")
+	methodBindProceduralSkySetSunCurve           = gdnative.NewLazyMethodBind("ProceduralSky", "set_sun_curve")
+	methodBindProceduralSkySetSunEnergy          = gdnative.NewLazyMethodBind("ProceduralSky", "set_sun_energy")
+	methodBindProceduralSkySetSunLatitude        = gdnative.NewLazyMethodBind("ProceduralSky", "set_sun_latitude")
+	methodBindProceduralSkySetSunLongitude       = gdnative.NewLazyMethodBind("ProceduralSky", "set_sun_longitude")
+	methodBindProceduralSkySetTextureSize        = gdnative.NewLazyMethodBind("ProceduralSky", "set_texture_size")
+)
+
 /*
-        Undocumented
-	Args: [{ false image Image}], Returns: void
+	        Undocumented
+		Args: [{ false image Image}], Returns: void
 */
-func (o *ProceduralSky) X_ThreadDone(image ImageImplementer) {
+func (o *ProceduralSky) X_ThreadDone(image godot.ImageImplementer) {
 	//log.Println("Calling ProceduralSky.X_ThreadDone()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(image.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(image))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "_thread_done")
+	methodBind := methodBindProceduralSkyX_ThreadDone.Get()
 
 	// Call the parent method.
 	// void
@@ -68,8 +155,8 @@ func (o *ProceduralSky) X_ThreadDone(image ImageImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *ProceduralSky) X_UpdateSky() {
 	//log.Println("Calling ProceduralSky.X_UpdateSky()")
@@ -78,7 +165,7 @@ func (o *ProceduralSky) X_UpdateSky() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "_update_sky")
+	methodBind := methodBindProceduralSkyX_UpdateSky.Get()
 
 	// Call the parent method.
 	// void
@@ -88,8 +175,8 @@ func (o *ProceduralSky) X_UpdateSky() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ProceduralSky) GetGroundBottomColor() gdnative.Color {
 	//log.Println("Calling ProceduralSky.GetGroundBottomColor()")
@@ -98,7 +185,7 @@ func (o *ProceduralSky) GetGroundBottomColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_ground_bottom_color")
+	methodBind := methodBindProceduralSkyGetGroundBottomColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -111,8 +198,8 @@ func (o *ProceduralSky) GetGroundBottomColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetGroundCurve() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetGroundCurve()")
@@ -121,7 +208,7 @@ func (o *ProceduralSky) GetGroundCurve() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_ground_curve")
+	methodBind := methodBindProceduralSkyGetGroundCurve.Get()
 
 	// Call the parent method.
 	// float
@@ -134,8 +221,8 @@ func (o *ProceduralSky) GetGroundCurve() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetGroundEnergy() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetGroundEnergy()")
@@ -144,7 +231,7 @@ func (o *ProceduralSky) GetGroundEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_ground_energy")
+	methodBind := methodBindProceduralSkyGetGroundEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -157,8 +244,8 @@ func (o *ProceduralSky) GetGroundEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ProceduralSky) GetGroundHorizonColor() gdnative.Color {
 	//log.Println("Calling ProceduralSky.GetGroundHorizonColor()")
@@ -167,7 +254,7 @@ func (o *ProceduralSky) GetGroundHorizonColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_ground_horizon_color")
+	methodBind := methodBindProceduralSkyGetGroundHorizonColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -180,8 +267,8 @@ func (o *ProceduralSky) GetGroundHorizonColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSkyCurve() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSkyCurve()")
@@ -190,7 +277,7 @@ func (o *ProceduralSky) GetSkyCurve() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sky_curve")
+	methodBind := methodBindProceduralSkyGetSkyCurve.Get()
 
 	// Call the parent method.
 	// float
@@ -203,8 +290,8 @@ func (o *ProceduralSky) GetSkyCurve() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSkyEnergy() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSkyEnergy()")
@@ -213,7 +300,7 @@ func (o *ProceduralSky) GetSkyEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sky_energy")
+	methodBind := methodBindProceduralSkyGetSkyEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -226,8 +313,8 @@ func (o *ProceduralSky) GetSkyEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ProceduralSky) GetSkyHorizonColor() gdnative.Color {
 	//log.Println("Calling ProceduralSky.GetSkyHorizonColor()")
@@ -236,7 +323,7 @@ func (o *ProceduralSky) GetSkyHorizonColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sky_horizon_color")
+	methodBind := methodBindProceduralSkyGetSkyHorizonColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -249,8 +336,8 @@ func (o *ProceduralSky) GetSkyHorizonColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ProceduralSky) GetSkyTopColor() gdnative.Color {
 	//log.Println("Calling ProceduralSky.GetSkyTopColor()")
@@ -259,7 +346,7 @@ func (o *ProceduralSky) GetSkyTopColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sky_top_color")
+	methodBind := methodBindProceduralSkyGetSkyTopColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -272,8 +359,8 @@ func (o *ProceduralSky) GetSkyTopColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSunAngleMax() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSunAngleMax()")
@@ -282,7 +369,7 @@ func (o *ProceduralSky) GetSunAngleMax() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sun_angle_max")
+	methodBind := methodBindProceduralSkyGetSunAngleMax.Get()
 
 	// Call the parent method.
 	// float
@@ -295,8 +382,8 @@ func (o *ProceduralSky) GetSunAngleMax() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSunAngleMin() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSunAngleMin()")
@@ -305,7 +392,7 @@ func (o *ProceduralSky) GetSunAngleMin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sun_angle_min")
+	methodBind := methodBindProceduralSkyGetSunAngleMin.Get()
 
 	// Call the parent method.
 	// float
@@ -318,8 +405,8 @@ func (o *ProceduralSky) GetSunAngleMin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ProceduralSky) GetSunColor() gdnative.Color {
 	//log.Println("Calling ProceduralSky.GetSunColor()")
@@ -328,7 +415,7 @@ func (o *ProceduralSky) GetSunColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sun_color")
+	methodBind := methodBindProceduralSkyGetSunColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -341,8 +428,8 @@ func (o *ProceduralSky) GetSunColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSunCurve() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSunCurve()")
@@ -351,7 +438,7 @@ func (o *ProceduralSky) GetSunCurve() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sun_curve")
+	methodBind := methodBindProceduralSkyGetSunCurve.Get()
 
 	// Call the parent method.
 	// float
@@ -364,8 +451,8 @@ func (o *ProceduralSky) GetSunCurve() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSunEnergy() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSunEnergy()")
@@ -374,7 +461,7 @@ func (o *ProceduralSky) GetSunEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sun_energy")
+	methodBind := methodBindProceduralSkyGetSunEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -387,8 +474,8 @@ func (o *ProceduralSky) GetSunEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSunLatitude() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSunLatitude()")
@@ -397,7 +484,7 @@ func (o *ProceduralSky) GetSunLatitude() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sun_latitude")
+	methodBind := methodBindProceduralSkyGetSunLatitude.Get()
 
 	// Call the parent method.
 	// float
@@ -410,8 +497,8 @@ func (o *ProceduralSky) GetSunLatitude() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ProceduralSky) GetSunLongitude() gdnative.Real {
 	//log.Println("Calling ProceduralSky.GetSunLongitude()")
@@ -420,7 +507,7 @@ func (o *ProceduralSky) GetSunLongitude() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_sun_longitude")
+	methodBind := methodBindProceduralSkyGetSunLongitude.Get()
 
 	// Call the parent method.
 	// float
@@ -433,8 +520,8 @@ func (o *ProceduralSky) GetSunLongitude() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.ProceduralSky::TextureSize
+	        Undocumented
+		Args: [], Returns: enum.ProceduralSky::TextureSize
 */
 func (o *ProceduralSky) GetTextureSize() ProceduralSkyTextureSize {
 	//log.Println("Calling ProceduralSky.GetTextureSize()")
@@ -443,7 +530,7 @@ func (o *ProceduralSky) GetTextureSize() ProceduralSkyTextureSize {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "get_texture_size")
+	methodBind := methodBindProceduralSkyGetTextureSize.Get()
 
 	// Call the parent method.
 	// enum.ProceduralSky::TextureSize
@@ -456,8 +543,8 @@ func (o *ProceduralSky) GetTextureSize() ProceduralSkyTextureSize {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ProceduralSky) SetGroundBottomColor(color gdnative.Color) {
 	//log.Println("Calling ProceduralSky.SetGroundBottomColor()")
@@ -467,7 +554,7 @@ func (o *ProceduralSky) SetGroundBottomColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_ground_bottom_color")
+	methodBind := methodBindProceduralSkySetGroundBottomColor.Get()
 
 	// Call the parent method.
 	// void
@@ -477,8 +564,8 @@ func (o *ProceduralSky) SetGroundBottomColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false curve float}], Returns: void
+	        Undocumented
+		Args: [{ false curve float}], Returns: void
 */
 func (o *ProceduralSky) SetGroundCurve(curve gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetGroundCurve()")
@@ -488,7 +575,7 @@ func (o *ProceduralSky) SetGroundCurve(curve gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(curve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_ground_curve")
+	methodBind := methodBindProceduralSkySetGroundCurve.Get()
 
 	// Call the parent method.
 	// void
@@ -498,8 +585,8 @@ func (o *ProceduralSky) SetGroundCurve(curve gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *ProceduralSky) SetGroundEnergy(energy gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetGroundEnergy()")
@@ -509,7 +596,7 @@ func (o *ProceduralSky) SetGroundEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_ground_energy")
+	methodBind := methodBindProceduralSkySetGroundEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -519,8 +606,8 @@ func (o *ProceduralSky) SetGroundEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ProceduralSky) SetGroundHorizonColor(color gdnative.Color) {
 	//log.Println("Calling ProceduralSky.SetGroundHorizonColor()")
@@ -530,7 +617,7 @@ func (o *ProceduralSky) SetGroundHorizonColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_ground_horizon_color")
+	methodBind := methodBindProceduralSkySetGroundHorizonColor.Get()
 
 	// Call the parent method.
 	// void
@@ -540,8 +627,8 @@ func (o *ProceduralSky) SetGroundHorizonColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false curve float}], Returns: void
+	        Undocumented
+		Args: [{ false curve float}], Returns: void
 */
 func (o *ProceduralSky) SetSkyCurve(curve gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSkyCurve()")
@@ -551,7 +638,7 @@ func (o *ProceduralSky) SetSkyCurve(curve gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(curve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sky_curve")
+	methodBind := methodBindProceduralSkySetSkyCurve.Get()
 
 	// Call the parent method.
 	// void
@@ -561,8 +648,8 @@ func (o *ProceduralSky) SetSkyCurve(curve gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *ProceduralSky) SetSkyEnergy(energy gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSkyEnergy()")
@@ -572,7 +659,7 @@ func (o *ProceduralSky) SetSkyEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sky_energy")
+	methodBind := methodBindProceduralSkySetSkyEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -582,8 +669,8 @@ func (o *ProceduralSky) SetSkyEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ProceduralSky) SetSkyHorizonColor(color gdnative.Color) {
 	//log.Println("Calling ProceduralSky.SetSkyHorizonColor()")
@@ -593,7 +680,7 @@ func (o *ProceduralSky) SetSkyHorizonColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sky_horizon_color")
+	methodBind := methodBindProceduralSkySetSkyHorizonColor.Get()
 
 	// Call the parent method.
 	// void
@@ -603,8 +690,8 @@ func (o *ProceduralSky) SetSkyHorizonColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ProceduralSky) SetSkyTopColor(color gdnative.Color) {
 	//log.Println("Calling ProceduralSky.SetSkyTopColor()")
@@ -614,7 +701,7 @@ func (o *ProceduralSky) SetSkyTopColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sky_top_color")
+	methodBind := methodBindProceduralSkySetSkyTopColor.Get()
 
 	// Call the parent method.
 	// void
@@ -624,8 +711,8 @@ func (o *ProceduralSky) SetSkyTopColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *ProceduralSky) SetSunAngleMax(degrees gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSunAngleMax()")
@@ -635,7 +722,7 @@ func (o *ProceduralSky) SetSunAngleMax(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sun_angle_max")
+	methodBind := methodBindProceduralSkySetSunAngleMax.Get()
 
 	// Call the parent method.
 	// void
@@ -645,8 +732,8 @@ func (o *ProceduralSky) SetSunAngleMax(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *ProceduralSky) SetSunAngleMin(degrees gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSunAngleMin()")
@@ -656,7 +743,7 @@ func (o *ProceduralSky) SetSunAngleMin(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sun_angle_min")
+	methodBind := methodBindProceduralSkySetSunAngleMin.Get()
 
 	// Call the parent method.
 	// void
@@ -666,8 +753,8 @@ func (o *ProceduralSky) SetSunAngleMin(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ProceduralSky) SetSunColor(color gdnative.Color) {
 	//log.Println("Calling ProceduralSky.SetSunColor()")
@@ -677,7 +764,7 @@ func (o *ProceduralSky) SetSunColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sun_color")
+	methodBind := methodBindProceduralSkySetSunColor.Get()
 
 	// Call the parent method.
 	// void
@@ -687,8 +774,8 @@ func (o *ProceduralSky) SetSunColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false curve float}], Returns: void
+	        Undocumented
+		Args: [{ false curve float}], Returns: void
 */
 func (o *ProceduralSky) SetSunCurve(curve gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSunCurve()")
@@ -698,7 +785,7 @@ func (o *ProceduralSky) SetSunCurve(curve gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(curve)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sun_curve")
+	methodBind := methodBindProceduralSkySetSunCurve.Get()
 
 	// Call the parent method.
 	// void
@@ -708,8 +795,8 @@ func (o *ProceduralSky) SetSunCurve(curve gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false energy float}], Returns: void
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
 */
 func (o *ProceduralSky) SetSunEnergy(energy gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSunEnergy()")
@@ -719,7 +806,7 @@ func (o *ProceduralSky) SetSunEnergy(energy gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sun_energy")
+	methodBind := methodBindProceduralSkySetSunEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -729,8 +816,8 @@ func (o *ProceduralSky) SetSunEnergy(energy gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *ProceduralSky) SetSunLatitude(degrees gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSunLatitude()")
@@ -740,7 +827,7 @@ func (o *ProceduralSky) SetSunLatitude(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sun_latitude")
+	methodBind := methodBindProceduralSkySetSunLatitude.Get()
 
 	// Call the parent method.
 	// void
@@ -750,8 +837,8 @@ func (o *ProceduralSky) SetSunLatitude(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false degrees float}], Returns: void
+	        Undocumented
+		Args: [{ false degrees float}], Returns: void
 */
 func (o *ProceduralSky) SetSunLongitude(degrees gdnative.Real) {
 	//log.Println("Calling ProceduralSky.SetSunLongitude()")
@@ -761,7 +848,7 @@ func (o *ProceduralSky) SetSunLongitude(degrees gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(degrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_sun_longitude")
+	methodBind := methodBindProceduralSkySetSunLongitude.Get()
 
 	// Call the parent method.
 	// void
@@ -771,18 +858,18 @@ func (o *ProceduralSky) SetSunLongitude(degrees gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size enum.ProceduralSky::TextureSize}], Returns: void
 */
-func (o *ProceduralSky) SetTextureSize(size gdnative.Int) {
+func (o *ProceduralSky) SetTextureSize(size ProceduralSkyTextureSize) {
 	//log.Println("Calling ProceduralSky.SetTextureSize()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(size)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(size))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ProceduralSky", "set_texture_size")
+	methodBind := methodBindProceduralSkySetTextureSize.Get()
 
 	// Call the parent method.
 	// void
@@ -791,11 +878,91 @@ func (o *ProceduralSky) SetTextureSize(size gdnative.Int) {
 
 }
 
+// GroundBottomColor will return the value of the "ground_bottom_color" property.
+func (o *ProceduralSky) GroundBottomColor() gdnative.Color {
+	return o.GetGroundBottomColor()
+}
+
+// GroundCurve will return the value of the "ground_curve" property.
+func (o *ProceduralSky) GroundCurve() gdnative.Real {
+	return o.GetGroundCurve()
+}
+
+// GroundEnergy will return the value of the "ground_energy" property.
+func (o *ProceduralSky) GroundEnergy() gdnative.Real {
+	return o.GetGroundEnergy()
+}
+
+// GroundHorizonColor will return the value of the "ground_horizon_color" property.
+func (o *ProceduralSky) GroundHorizonColor() gdnative.Color {
+	return o.GetGroundHorizonColor()
+}
+
+// SkyCurve will return the value of the "sky_curve" property.
+func (o *ProceduralSky) SkyCurve() gdnative.Real {
+	return o.GetSkyCurve()
+}
+
+// SkyEnergy will return the value of the "sky_energy" property.
+func (o *ProceduralSky) SkyEnergy() gdnative.Real {
+	return o.GetSkyEnergy()
+}
+
+// SkyHorizonColor will return the value of the "sky_horizon_color" property.
+func (o *ProceduralSky) SkyHorizonColor() gdnative.Color {
+	return o.GetSkyHorizonColor()
+}
+
+// SkyTopColor will return the value of the "sky_top_color" property.
+func (o *ProceduralSky) SkyTopColor() gdnative.Color {
+	return o.GetSkyTopColor()
+}
+
+// SunAngleMax will return the value of the "sun_angle_max" property.
+func (o *ProceduralSky) SunAngleMax() gdnative.Real {
+	return o.GetSunAngleMax()
+}
+
+// SunAngleMin will return the value of the "sun_angle_min" property.
+func (o *ProceduralSky) SunAngleMin() gdnative.Real {
+	return o.GetSunAngleMin()
+}
+
+// SunColor will return the value of the "sun_color" property.
+func (o *ProceduralSky) SunColor() gdnative.Color {
+	return o.GetSunColor()
+}
+
+// SunCurve will return the value of the "sun_curve" property.
+func (o *ProceduralSky) SunCurve() gdnative.Real {
+	return o.GetSunCurve()
+}
+
+// SunEnergy will return the value of the "sun_energy" property.
+func (o *ProceduralSky) SunEnergy() gdnative.Real {
+	return o.GetSunEnergy()
+}
+
+// SunLatitude will return the value of the "sun_latitude" property.
+func (o *ProceduralSky) SunLatitude() gdnative.Real {
+	return o.GetSunLatitude()
+}
+
+// SunLongitude will return the value of the "sun_longitude" property.
+func (o *ProceduralSky) SunLongitude() gdnative.Real {
+	return o.GetSunLongitude()
+}
+
+// TextureSize will return the value of the "texture_size" property.
+func (o *ProceduralSky) TextureSize() ProceduralSkyTextureSize {
+	return o.GetTextureSize()
+}
+
 // ProceduralSkyImplementer is an interface that implements the methods
 // of the ProceduralSky class.
 type ProceduralSkyImplementer interface {
-	SkyImplementer
-	X_ThreadDone(image ImageImplementer)
+	godot.SkyImplementer
+	X_ThreadDone(image godot.ImageImplementer)
 	X_UpdateSky()
 	GetGroundBottomColor() gdnative.Color
 	GetGroundCurve() gdnative.Real
@@ -812,6 +979,7 @@ type ProceduralSkyImplementer interface {
 	GetSunEnergy() gdnative.Real
 	GetSunLatitude() gdnative.Real
 	GetSunLongitude() gdnative.Real
+	GetTextureSize() ProceduralSkyTextureSize
 	SetGroundBottomColor(color gdnative.Color)
 	SetGroundCurve(curve gdnative.Real)
 	SetGroundEnergy(energy gdnative.Real)
@@ -827,5 +995,21 @@ type ProceduralSkyImplementer interface {
 	SetSunEnergy(energy gdnative.Real)
 	SetSunLatitude(degrees gdnative.Real)
 	SetSunLongitude(degrees gdnative.Real)
-	SetTextureSize(size gdnative.Int)
+	SetTextureSize(size ProceduralSkyTextureSize)
+	GroundBottomColor() gdnative.Color
+	GroundCurve() gdnative.Real
+	GroundEnergy() gdnative.Real
+	GroundHorizonColor() gdnative.Color
+	SkyCurve() gdnative.Real
+	SkyEnergy() gdnative.Real
+	SkyHorizonColor() gdnative.Color
+	SkyTopColor() gdnative.Color
+	SunAngleMax() gdnative.Real
+	SunAngleMin() gdnative.Real
+	SunColor() gdnative.Color
+	SunCurve() gdnative.Real
+	SunEnergy() gdnative.Real
+	SunLatitude() gdnative.Real
+	SunLongitude() gdnative.Real
+	TextureSize() ProceduralSkyTextureSize
 }
@@ -0,0 +1,120 @@
+package resource
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+ */
+type ProxyTexture struct {
+	godot.Texture
+	owner gdnative.Object
+}
+
+// classConstructorProxyTexture is the class constructor of the ProxyTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorProxyTexture = gdnative.NewLazyClassConstructor("ProxyTexture")
+
+// NewProxyTexture will create a new instance of the ProxyTexture class.
+// ProxyTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewProxyTexture() *ProxyTexture {
+	obj := &ProxyTexture{}
+	obj.SetBaseObject(classConstructorProxyTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *ProxyTexture) BaseClass() string {
+	return "ProxyTexture"
+}
+
+// Method binds of the ProxyTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindProxyTextureGetBase = gdnative.NewLazyMethodBind("ProxyTexture", "get_base")
+	methodBindProxyTextureSetBase = gdnative.NewLazyMethodBind("ProxyTexture", "set_base")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *ProxyTexture) GetBase() godot.TextureImplementer {
+	//log.Println("Calling ProxyTexture.GetBase()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindProxyTextureGetBase.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false base Texture}], Returns: void
+*/
+func (o *ProxyTexture) SetBase(base godot.TextureImplementer) {
+	//log.Println("Calling ProxyTexture.SetBase()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(base))
+
+	// Get the method bind
+	methodBind := methodBindProxyTextureSetBase.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Base will return the value of the "base" property.
+func (o *ProxyTexture) Base() godot.TextureImplementer {
+	return o.GetBase()
+}
+
+// ProxyTextureImplementer is an interface that implements the methods
+// of the ProxyTexture class.
+type ProxyTextureImplementer interface {
+	godot.TextureImplementer
+	GetBase() godot.TextureImplementer
+	SetBase(base godot.TextureImplementer)
+	Base() godot.TextureImplementer
+}
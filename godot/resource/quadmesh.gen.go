@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewQuadMeshFromPointer(ptr gdnative.Pointer) QuadMesh {
-func newQuadMeshFromPointer(ptr gdnative.Pointer) QuadMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := QuadMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class representing a square mesh with size (2,2,0). Consider using a [PlaneMesh] if you require a differently sized plane.
 */
@@ -30,13 +22,37 @@ type QuadMesh struct {
 	owner gdnative.Object
 }
 
+// classConstructorQuadMesh is the class constructor of the QuadMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorQuadMesh = gdnative.NewLazyClassConstructor("QuadMesh")
+
+// NewQuadMesh will create a new instance of the QuadMesh class.
+// QuadMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewQuadMesh() *QuadMesh {
+	obj := &QuadMesh{}
+	obj.SetBaseObject(classConstructorQuadMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *QuadMesh) BaseClass() string {
 	return "QuadMesh"
 }
 
+// Method binds of the QuadMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindQuadMeshGetSize = gdnative.NewLazyMethodBind("QuadMesh", "get_size")
+	methodBindQuadMeshSetSize = gdnative.NewLazyMethodBind("QuadMesh", "set_size")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *QuadMesh) GetSize() gdnative.Vector2 {
 	//log.Println("Calling QuadMesh.GetSize()")
@@ -45,7 +61,7 @@ func (o *QuadMesh) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("QuadMesh", "get_size")
+	methodBind := methodBindQuadMeshGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +74,8 @@ func (o *QuadMesh) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *QuadMesh) SetSize(size gdnative.Vector2) {
 	//log.Println("Calling QuadMesh.SetSize()")
@@ -69,7 +85,7 @@ func (o *QuadMesh) SetSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("QuadMesh", "set_size")
+	methodBind := methodBindQuadMeshSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *QuadMesh) SetSize(size gdnative.Vector2) {
 
 }
 
+// Size will return the value of the "size" property.
+func (o *QuadMesh) Size() gdnative.Vector2 {
+	return o.GetSize()
+}
+
 // QuadMeshImplementer is an interface that implements the methods
 // of the QuadMesh class.
 type QuadMeshImplementer interface {
 	PrimitiveMeshImplementer
 	GetSize() gdnative.Vector2
 	SetSize(size gdnative.Vector2)
+	Size() gdnative.Vector2
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRayShapeFromPointer(ptr gdnative.Pointer) RayShape {
-func newRayShapeFromPointer(ptr gdnative.Pointer) RayShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RayShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Ray shape for 3D collisions, which can be set into a [PhysicsBody] or [Area]. A ray is not really a collision body, instead it tries to separate itself from whatever is touching its far endpoint. It's often useful for characters.
 */
 type RayShape struct {
-	Shape
+	godot.Shape
 	owner gdnative.Object
 }
 
+// classConstructorRayShape is the class constructor of the RayShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRayShape = gdnative.NewLazyClassConstructor("RayShape")
+
+// NewRayShape will create a new instance of the RayShape class.
+// RayShape is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewRayShape() *RayShape {
+	obj := &RayShape{}
+	obj.SetBaseObject(classConstructorRayShape.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RayShape) BaseClass() string {
 	return "RayShape"
 }
 
+// Method binds of the RayShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRayShapeGetLength = gdnative.NewLazyMethodBind("RayShape", "get_length")
+	methodBindRayShapeSetLength = gdnative.NewLazyMethodBind("RayShape", "set_length")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *RayShape) GetLength() gdnative.Real {
 	//log.Println("Calling RayShape.GetLength()")
@@ -45,7 +61,7 @@ func (o *RayShape) GetLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayShape", "get_length")
+	methodBind := methodBindRayShapeGetLength.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +74,8 @@ func (o *RayShape) GetLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *RayShape) SetLength(length gdnative.Real) {
 	//log.Println("Calling RayShape.SetLength()")
@@ -69,7 +85,7 @@ func (o *RayShape) SetLength(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayShape", "set_length")
+	methodBind := methodBindRayShapeSetLength.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *RayShape) SetLength(length gdnative.Real) {
 
 }
 
+// Length will return the value of the "length" property.
+func (o *RayShape) Length() gdnative.Real {
+	return o.GetLength()
+}
+
 // RayShapeImplementer is an interface that implements the methods
 // of the RayShape class.
 type RayShapeImplementer interface {
-	ShapeImplementer
+	godot.ShapeImplementer
 	GetLength() gdnative.Real
 	SetLength(length gdnative.Real)
+	Length() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRayShape2DFromPointer(ptr gdnative.Pointer) RayShape2D {
-func newRayShape2DFromPointer(ptr gdnative.Pointer) RayShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RayShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Ray shape for 2D collisions. A ray is not really a collision body, instead it tries to separate itself from whatever is touching its far endpoint. It's often useful for characters.
 */
 type RayShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorRayShape2D is the class constructor of the RayShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRayShape2D = gdnative.NewLazyClassConstructor("RayShape2D")
+
+// NewRayShape2D will create a new instance of the RayShape2D class.
+// RayShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewRayShape2D() *RayShape2D {
+	obj := &RayShape2D{}
+	obj.SetBaseObject(classConstructorRayShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RayShape2D) BaseClass() string {
 	return "RayShape2D"
 }
 
+// Method binds of the RayShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRayShape2DGetLength = gdnative.NewLazyMethodBind("RayShape2D", "get_length")
+	methodBindRayShape2DSetLength = gdnative.NewLazyMethodBind("RayShape2D", "set_length")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *RayShape2D) GetLength() gdnative.Real {
 	//log.Println("Calling RayShape2D.GetLength()")
@@ -45,7 +61,7 @@ func (o *RayShape2D) GetLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayShape2D", "get_length")
+	methodBind := methodBindRayShape2DGetLength.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +74,8 @@ func (o *RayShape2D) GetLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *RayShape2D) SetLength(length gdnative.Real) {
 	//log.Println("Calling RayShape2D.SetLength()")
@@ -69,7 +85,7 @@ func (o *RayShape2D) SetLength(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayShape2D", "set_length")
+	methodBind := methodBindRayShape2DSetLength.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *RayShape2D) SetLength(length gdnative.Real) {
 
 }
 
+// Length will return the value of the "length" property.
+func (o *RayShape2D) Length() gdnative.Real {
+	return o.GetLength()
+}
+
 // RayShape2DImplementer is an interface that implements the methods
 // of the RayShape2D class.
 type RayShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetLength() gdnative.Real
 	SetLength(length gdnative.Real)
+	Length() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRectangleShape2DFromPointer(ptr gdnative.Pointer) RectangleShape2D {
-func newRectangleShape2DFromPointer(ptr gdnative.Pointer) RectangleShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RectangleShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Rectangle shape for 2D collisions. This shape is useful for modeling box-like 2D objects.
 */
 type RectangleShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorRectangleShape2D is the class constructor of the RectangleShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRectangleShape2D = gdnative.NewLazyClassConstructor("RectangleShape2D")
+
+// NewRectangleShape2D will create a new instance of the RectangleShape2D class.
+// RectangleShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewRectangleShape2D() *RectangleShape2D {
+	obj := &RectangleShape2D{}
+	obj.SetBaseObject(classConstructorRectangleShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RectangleShape2D) BaseClass() string {
 	return "RectangleShape2D"
 }
 
+// Method binds of the RectangleShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRectangleShape2DGetExtents = gdnative.NewLazyMethodBind("RectangleShape2D", "get_extents")
+	methodBindRectangleShape2DSetExtents = gdnative.NewLazyMethodBind("RectangleShape2D", "set_extents")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *RectangleShape2D) GetExtents() gdnative.Vector2 {
 	//log.Println("Calling RectangleShape2D.GetExtents()")
@@ -45,7 +61,7 @@ func (o *RectangleShape2D) GetExtents() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RectangleShape2D", "get_extents")
+	methodBind := methodBindRectangleShape2DGetExtents.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +74,8 @@ func (o *RectangleShape2D) GetExtents() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false extents Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false extents Vector2}], Returns: void
 */
 func (o *RectangleShape2D) SetExtents(extents gdnative.Vector2) {
 	//log.Println("Calling RectangleShape2D.SetExtents()")
@@ -69,7 +85,7 @@ func (o *RectangleShape2D) SetExtents(extents gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(extents)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RectangleShape2D", "set_extents")
+	methodBind := methodBindRectangleShape2DSetExtents.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *RectangleShape2D) SetExtents(extents gdnative.Vector2) {
 
 }
 
+// Extents will return the value of the "extents" property.
+func (o *RectangleShape2D) Extents() gdnative.Vector2 {
+	return o.GetExtents()
+}
+
 // RectangleShape2DImplementer is an interface that implements the methods
 // of the RectangleShape2D class.
 type RectangleShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetExtents() gdnative.Vector2
 	SetExtents(extents gdnative.Vector2)
+	Extents() gdnative.Vector2
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewResourceImporterFromPointer(ptr gdnative.Pointer) ResourceImporter {
-func newResourceImporterFromPointer(ptr gdnative.Pointer) ResourceImporter {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ResourceImporter{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type ResourceImporter struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
@@ -37,5 +28,5 @@ func (o *ResourceImporter) BaseClass() string {
 // ResourceImporterImplementer is an interface that implements the methods
 // of the ResourceImporter class.
 type ResourceImporterImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 }
@@ -1,4 +1,4 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewResourceImporterOGGVorbisFromPointer(ptr gdnative.Pointer) ResourceImporterOGGVorbis {
-func newResourceImporterOGGVorbisFromPointer(ptr gdnative.Pointer) ResourceImporterOGGVorbis {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ResourceImporterOGGVorbis{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -1,4 +1,4 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewResourceImporterTheoraFromPointer(ptr gdnative.Pointer) ResourceImporterTheora {
-func newResourceImporterTheoraFromPointer(ptr gdnative.Pointer) ResourceImporterTheora {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ResourceImporterTheora{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -1,4 +1,4 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewResourceImporterWebmFromPointer(ptr gdnative.Pointer) ResourceImporterWebm {
-func newResourceImporterWebmFromPointer(ptr gdnative.Pointer) ResourceImporterWebm {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ResourceImporterWebm{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewResourceInteractiveLoaderFromPointer(ptr gdnative.Pointer) ResourceInteractiveLoader {
-func newResourceInteractiveLoaderFromPointer(ptr gdnative.Pointer) ResourceInteractiveLoader {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ResourceInteractiveLoader{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Interactive Resource Loader. This object is returned by ResourceLoader when performing an interactive load. It allows to load with high granularity, so this is mainly useful for displaying load bars/percentages.
 */
 type ResourceInteractiveLoader struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
@@ -34,18 +26,28 @@ func (o *ResourceInteractiveLoader) BaseClass() string {
 	return "ResourceInteractiveLoader"
 }
 
+// Method binds of the ResourceInteractiveLoader class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindResourceInteractiveLoaderGetResource   = gdnative.NewLazyMethodBind("ResourceInteractiveLoader", "get_resource")
+	methodBindResourceInteractiveLoaderGetStage      = gdnative.NewLazyMethodBind("ResourceInteractiveLoader", "get_stage")
+	methodBindResourceInteractiveLoaderGetStageCount = gdnative.NewLazyMethodBind("ResourceInteractiveLoader", "get_stage_count")
+	methodBindResourceInteractiveLoaderPoll          = gdnative.NewLazyMethodBind("ResourceInteractiveLoader", "poll")
+	methodBindResourceInteractiveLoaderWait          = gdnative.NewLazyMethodBind("ResourceInteractiveLoader", "wait")
+)
+
 /*
-        Return the loaded resource (only if loaded). Otherwise, returns null.
-	Args: [], Returns: Resource
+	        Return the loaded resource (only if loaded). Otherwise, returns null.
+		Args: [], Returns: Resource
 */
-func (o *ResourceInteractiveLoader) GetResource() ResourceImplementer {
+func (o *ResourceInteractiveLoader) GetResource() godot.ResourceImplementer {
 	//log.Println("Calling ResourceInteractiveLoader.GetResource()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourceInteractiveLoader", "get_resource")
+	methodBind := methodBindResourceInteractiveLoaderGetResource.Get()
 
 	// Call the parent method.
 	// Resource
@@ -53,27 +55,25 @@ func (o *ResourceInteractiveLoader) GetResource() ResourceImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newResourceFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ResourceImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Resource" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ResourceImplementer)
+	ret := godot.Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ResourceImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the load stage. The total amount of stages can be queried with [method get_stage_count]
-	Args: [], Returns: int
+	        Return the load stage. The total amount of stages can be queried with [method get_stage_count]
+		Args: [], Returns: int
 */
 func (o *ResourceInteractiveLoader) GetStage() gdnative.Int {
 	//log.Println("Calling ResourceInteractiveLoader.GetStage()")
@@ -82,7 +82,7 @@ func (o *ResourceInteractiveLoader) GetStage() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourceInteractiveLoader", "get_stage")
+	methodBind := methodBindResourceInteractiveLoaderGetStage.Get()
 
 	// Call the parent method.
 	// int
@@ -95,8 +95,8 @@ func (o *ResourceInteractiveLoader) GetStage() gdnative.Int {
 }
 
 /*
-        Return the total amount of stages (calls to [method poll]) needed to completely load this resource.
-	Args: [], Returns: int
+	        Return the total amount of stages (calls to [method poll]) needed to completely load this resource.
+		Args: [], Returns: int
 */
 func (o *ResourceInteractiveLoader) GetStageCount() gdnative.Int {
 	//log.Println("Calling ResourceInteractiveLoader.GetStageCount()")
@@ -105,7 +105,7 @@ func (o *ResourceInteractiveLoader) GetStageCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourceInteractiveLoader", "get_stage_count")
+	methodBind := methodBindResourceInteractiveLoaderGetStageCount.Get()
 
 	// Call the parent method.
 	// int
@@ -118,8 +118,8 @@ func (o *ResourceInteractiveLoader) GetStageCount() gdnative.Int {
 }
 
 /*
-        Poll the load. If OK is returned, this means poll will have to be called again. If ERR_FILE_EOF is returned, them the load has finished and the resource can be obtained by calling [method get_resource].
-	Args: [], Returns: enum.Error
+	        Poll the load. If OK is returned, this means poll will have to be called again. If ERR_FILE_EOF is returned, them the load has finished and the resource can be obtained by calling [method get_resource].
+		Args: [], Returns: enum.Error
 */
 func (o *ResourceInteractiveLoader) Poll() gdnative.Error {
 	//log.Println("Calling ResourceInteractiveLoader.Poll()")
@@ -128,7 +128,7 @@ func (o *ResourceInteractiveLoader) Poll() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourceInteractiveLoader", "poll")
+	methodBind := methodBindResourceInteractiveLoaderPoll.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -141,8 +141,7 @@ func (o *ResourceInteractiveLoader) Poll() gdnative.Error {
 }
 
 /*
-
-	Args: [], Returns: enum.Error
+Args: [], Returns: enum.Error
 */
 func (o *ResourceInteractiveLoader) Wait() gdnative.Error {
 	//log.Println("Calling ResourceInteractiveLoader.Wait()")
@@ -151,7 +150,7 @@ func (o *ResourceInteractiveLoader) Wait() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourceInteractiveLoader", "wait")
+	methodBind := methodBindResourceInteractiveLoaderWait.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -166,8 +165,10 @@ func (o *ResourceInteractiveLoader) Wait() gdnative.Error {
 // ResourceInteractiveLoaderImplementer is an interface that implements the methods
 // of the ResourceInteractiveLoader class.
 type ResourceInteractiveLoaderImplementer interface {
-	ReferenceImplementer
-	GetResource() ResourceImplementer
+	godot.ReferenceImplementer
+	GetResource() godot.ResourceImplementer
 	GetStage() gdnative.Int
 	GetStageCount() gdnative.Int
+	Poll() gdnative.Error
+	Wait() gdnative.Error
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,48 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewResourcePreloaderFromPointer(ptr gdnative.Pointer) ResourcePreloader {
-func newResourcePreloaderFromPointer(ptr gdnative.Pointer) ResourcePreloader {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ResourcePreloader{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Resource Preloader Node. This node is used to preload sub-resources inside a scene, so when the scene is loaded all the resources are ready to use and be retrieved from here.
 */
 type ResourcePreloader struct {
-	Node
+	godot.Node
 	owner gdnative.Object
 }
 
+// classConstructorResourcePreloader is the class constructor of the ResourcePreloader class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorResourcePreloader = gdnative.NewLazyClassConstructor("ResourcePreloader")
+
+// NewResourcePreloader will create a new instance of the ResourcePreloader class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewResourcePreloader() *ResourcePreloader {
+	obj := &ResourcePreloader{}
+	obj.SetBaseObject(classConstructorResourcePreloader.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ResourcePreloader) BaseClass() string {
 	return "ResourcePreloader"
 }
 
+// Method binds of the ResourcePreloader class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindResourcePreloaderX_GetResources  = gdnative.NewLazyMethodBind("ResourcePreloader", "_get_resources")
+	methodBindResourcePreloaderX_SetResources  = gdnative.NewLazyMethodBind("ResourcePreloader", "_set_resources")
+	methodBindResourcePreloaderAddResource     = gdnative.NewLazyMethodBind("ResourcePreloader", "add_resource")
+	methodBindResourcePreloaderGetResource     = gdnative.NewLazyMethodBind("ResourcePreloader", "get_resource")
+	methodBindResourcePreloaderGetResourceList = gdnative.NewLazyMethodBind("ResourcePreloader", "get_resource_list")
+	methodBindResourcePreloaderHasResource     = gdnative.NewLazyMethodBind("ResourcePreloader", "has_resource")
+	methodBindResourcePreloaderRemoveResource  = gdnative.NewLazyMethodBind("ResourcePreloader", "remove_resource")
+	methodBindResourcePreloaderRenameResource  = gdnative.NewLazyMethodBind("ResourcePreloader", "rename_resource")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *ResourcePreloader) X_GetResources() gdnative.Array {
 	//log.Println("Calling ResourcePreloader.X_GetResources()")
@@ -45,7 +64,7 @@ func (o *ResourcePreloader) X_GetResources() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "_get_resources")
+	methodBind := methodBindResourcePreloaderX_GetResources.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +77,8 @@ func (o *ResourcePreloader) X_GetResources() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
 */
 func (o *ResourcePreloader) X_SetResources(arg0 gdnative.Array) {
 	//log.Println("Calling ResourcePreloader.X_SetResources()")
@@ -69,7 +88,7 @@ func (o *ResourcePreloader) X_SetResources(arg0 gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "_set_resources")
+	methodBind := methodBindResourcePreloaderX_SetResources.Get()
 
 	// Call the parent method.
 	// void
@@ -79,19 +98,18 @@ func (o *ResourcePreloader) X_SetResources(arg0 gdnative.Array) {
 }
 
 /*
-
-	Args: [{ false name String} { false resource Resource}], Returns: void
+Args: [{ false name String} { false resource Resource}], Returns: void
 */
-func (o *ResourcePreloader) AddResource(name gdnative.String, resource ResourceImplementer) {
+func (o *ResourcePreloader) AddResource(name gdnative.String, resource godot.ResourceImplementer) {
 	//log.Println("Calling ResourcePreloader.AddResource()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
-	ptrArguments[1] = gdnative.NewPointerFromObject(resource.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "add_resource")
+	methodBind := methodBindResourcePreloaderAddResource.Get()
 
 	// Call the parent method.
 	// void
@@ -101,10 +119,10 @@ func (o *ResourcePreloader) AddResource(name gdnative.String, resource ResourceI
 }
 
 /*
-        Return the resource given a text-id.
-	Args: [{ false name String}], Returns: Resource
+	        Return the resource given a text-id.
+		Args: [{ false name String}], Returns: Resource
 */
-func (o *ResourcePreloader) GetResource(name gdnative.String) ResourceImplementer {
+func (o *ResourcePreloader) GetResource(name gdnative.String) godot.ResourceImplementer {
 	//log.Println("Calling ResourcePreloader.GetResource()")
 
 	// Build out the method's arguments
@@ -112,7 +130,7 @@ func (o *ResourcePreloader) GetResource(name gdnative.String) ResourceImplemente
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "get_resource")
+	methodBind := methodBindResourcePreloaderGetResource.Get()
 
 	// Call the parent method.
 	// Resource
@@ -120,27 +138,25 @@ func (o *ResourcePreloader) GetResource(name gdnative.String) ResourceImplemente
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newResourceFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ResourceImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Resource" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ResourceImplementer)
+	ret := godot.Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ResourceImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the list of resources inside the preloader.
-	Args: [], Returns: PoolStringArray
+	        Return the list of resources inside the preloader.
+		Args: [], Returns: PoolStringArray
 */
 func (o *ResourcePreloader) GetResourceList() gdnative.PoolStringArray {
 	//log.Println("Calling ResourcePreloader.GetResourceList()")
@@ -149,7 +165,7 @@ func (o *ResourcePreloader) GetResourceList() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "get_resource_list")
+	methodBind := methodBindResourcePreloaderGetResourceList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -162,8 +178,8 @@ func (o *ResourcePreloader) GetResourceList() gdnative.PoolStringArray {
 }
 
 /*
-        Return true if the preloader has a given resource.
-	Args: [{ false name String}], Returns: bool
+	        Return true if the preloader has a given resource.
+		Args: [{ false name String}], Returns: bool
 */
 func (o *ResourcePreloader) HasResource(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling ResourcePreloader.HasResource()")
@@ -173,7 +189,7 @@ func (o *ResourcePreloader) HasResource(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "has_resource")
+	methodBind := methodBindResourcePreloaderHasResource.Get()
 
 	// Call the parent method.
 	// bool
@@ -186,8 +202,8 @@ func (o *ResourcePreloader) HasResource(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Remove a resource from the preloader by text id.
-	Args: [{ false name String}], Returns: void
+	        Remove a resource from the preloader by text id.
+		Args: [{ false name String}], Returns: void
 */
 func (o *ResourcePreloader) RemoveResource(name gdnative.String) {
 	//log.Println("Calling ResourcePreloader.RemoveResource()")
@@ -197,7 +213,7 @@ func (o *ResourcePreloader) RemoveResource(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "remove_resource")
+	methodBind := methodBindResourcePreloaderRemoveResource.Get()
 
 	// Call the parent method.
 	// void
@@ -207,8 +223,8 @@ func (o *ResourcePreloader) RemoveResource(name gdnative.String) {
 }
 
 /*
-        Rename a resource inside the preloader, from a text-id to a new text-id.
-	Args: [{ false name String} { false newname String}], Returns: void
+	        Rename a resource inside the preloader, from a text-id to a new text-id.
+		Args: [{ false name String} { false newname String}], Returns: void
 */
 func (o *ResourcePreloader) RenameResource(name gdnative.String, newname gdnative.String) {
 	//log.Println("Calling ResourcePreloader.RenameResource()")
@@ -219,7 +235,7 @@ func (o *ResourcePreloader) RenameResource(name gdnative.String, newname gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromString(newname)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ResourcePreloader", "rename_resource")
+	methodBind := methodBindResourcePreloaderRenameResource.Get()
 
 	// Call the parent method.
 	// void
@@ -228,16 +244,28 @@ func (o *ResourcePreloader) RenameResource(name gdnative.String, newname gdnativ
 
 }
 
+// Resources will return the value of the "resources" property.
+func (o *ResourcePreloader) Resources() gdnative.Array {
+	return o.X_GetResources()
+}
+
+// SetResources will set the value of the "resources" property.
+func (o *ResourcePreloader) SetResources(value gdnative.Array) {
+	o.X_SetResources(value)
+}
+
 // ResourcePreloaderImplementer is an interface that implements the methods
 // of the ResourcePreloader class.
 type ResourcePreloaderImplementer interface {
-	NodeImplementer
+	godot.NodeImplementer
 	X_GetResources() gdnative.Array
 	X_SetResources(arg0 gdnative.Array)
-	AddResource(name gdnative.String, resource ResourceImplementer)
-	GetResource(name gdnative.String) ResourceImplementer
+	AddResource(name gdnative.String, resource godot.ResourceImplementer)
+	GetResource(name gdnative.String) godot.ResourceImplementer
 	GetResourceList() gdnative.PoolStringArray
 	HasResource(name gdnative.String) gdnative.Bool
 	RemoveResource(name gdnative.String)
 	RenameResource(name gdnative.String, newname gdnative.String)
+	Resources() gdnative.Array
+	SetResources(value gdnative.Array)
 }
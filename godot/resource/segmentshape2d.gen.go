@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,47 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSegmentShape2DFromPointer(ptr gdnative.Pointer) SegmentShape2D {
-func newSegmentShape2DFromPointer(ptr gdnative.Pointer) SegmentShape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SegmentShape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Segment shape for 2D collisions. Consists of two points, [code]a[/code] and [code]b[/code].
 */
 type SegmentShape2D struct {
-	Shape2D
+	godot.Shape2D
 	owner gdnative.Object
 }
 
+// classConstructorSegmentShape2D is the class constructor of the SegmentShape2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSegmentShape2D = gdnative.NewLazyClassConstructor("SegmentShape2D")
+
+// NewSegmentShape2D will create a new instance of the SegmentShape2D class.
+// SegmentShape2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSegmentShape2D() *SegmentShape2D {
+	obj := &SegmentShape2D{}
+	obj.SetBaseObject(classConstructorSegmentShape2D.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SegmentShape2D) BaseClass() string {
 	return "SegmentShape2D"
 }
 
+// Method binds of the SegmentShape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSegmentShape2DGetA = gdnative.NewLazyMethodBind("SegmentShape2D", "get_a")
+	methodBindSegmentShape2DGetB = gdnative.NewLazyMethodBind("SegmentShape2D", "get_b")
+	methodBindSegmentShape2DSetA = gdnative.NewLazyMethodBind("SegmentShape2D", "set_a")
+	methodBindSegmentShape2DSetB = gdnative.NewLazyMethodBind("SegmentShape2D", "set_b")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *SegmentShape2D) GetA() gdnative.Vector2 {
 	//log.Println("Calling SegmentShape2D.GetA()")
@@ -45,7 +63,7 @@ func (o *SegmentShape2D) GetA() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SegmentShape2D", "get_a")
+	methodBind := methodBindSegmentShape2DGetA.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -58,8 +76,8 @@ func (o *SegmentShape2D) GetA() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *SegmentShape2D) GetB() gdnative.Vector2 {
 	//log.Println("Calling SegmentShape2D.GetB()")
@@ -68,7 +86,7 @@ func (o *SegmentShape2D) GetB() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SegmentShape2D", "get_b")
+	methodBind := methodBindSegmentShape2DGetB.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +99,8 @@ func (o *SegmentShape2D) GetB() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false a Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false a Vector2}], Returns: void
 */
 func (o *SegmentShape2D) SetA(a gdnative.Vector2) {
 	//log.Println("Calling SegmentShape2D.SetA()")
@@ -92,7 +110,7 @@ func (o *SegmentShape2D) SetA(a gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(a)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SegmentShape2D", "set_a")
+	methodBind := methodBindSegmentShape2DSetA.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *SegmentShape2D) SetA(a gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false b Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false b Vector2}], Returns: void
 */
 func (o *SegmentShape2D) SetB(b gdnative.Vector2) {
 	//log.Println("Calling SegmentShape2D.SetB()")
@@ -113,7 +131,7 @@ func (o *SegmentShape2D) SetB(b gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(b)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SegmentShape2D", "set_b")
+	methodBind := methodBindSegmentShape2DSetB.Get()
 
 	// Call the parent method.
 	// void
@@ -122,12 +140,24 @@ func (o *SegmentShape2D) SetB(b gdnative.Vector2) {
 
 }
 
+// A will return the value of the "a" property.
+func (o *SegmentShape2D) A() gdnative.Vector2 {
+	return o.GetA()
+}
+
+// B will return the value of the "b" property.
+func (o *SegmentShape2D) B() gdnative.Vector2 {
+	return o.GetB()
+}
+
 // SegmentShape2DImplementer is an interface that implements the methods
 // of the SegmentShape2D class.
 type SegmentShape2DImplementer interface {
-	Shape2DImplementer
+	godot.Shape2DImplementer
 	GetA() gdnative.Vector2
 	GetB() gdnative.Vector2
 	SetA(a gdnative.Vector2)
 	SetB(b gdnative.Vector2)
+	A() gdnative.Vector2
+	B() gdnative.Vector2
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSphereMeshFromPointer(ptr gdnative.Pointer) SphereMesh {
-func newSphereMeshFromPointer(ptr gdnative.Pointer) SphereMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SphereMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class representing a spherical [PrimitiveMesh].
 */
@@ -30,13 +22,45 @@ type SphereMesh struct {
 	owner gdnative.Object
 }
 
+// classConstructorSphereMesh is the class constructor of the SphereMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSphereMesh = gdnative.NewLazyClassConstructor("SphereMesh")
+
+// NewSphereMesh will create a new instance of the SphereMesh class.
+// SphereMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSphereMesh() *SphereMesh {
+	obj := &SphereMesh{}
+	obj.SetBaseObject(classConstructorSphereMesh.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SphereMesh) BaseClass() string {
 	return "SphereMesh"
 }
 
+// Method binds of the SphereMesh class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSphereMeshGetHeight         = gdnative.NewLazyMethodBind("SphereMesh", "get_height")
+	methodBindSphereMeshGetIsHemisphere   = gdnative.NewLazyMethodBind("SphereMesh", "get_is_hemisphere")
+	methodBindSphereMeshGetRadialSegments = gdnative.NewLazyMethodBind("SphereMesh", "get_radial_segments")
+	methodBindSphereMeshGetRadius         = gdnative.NewLazyMethodBind("SphereMesh", "get_radius")
+	methodBindSphereMeshGetRings          = gdnative.NewLazyMethodBind("SphereMesh", "get_rings")
+	methodBindSphereMeshSetHeight         = gdnative.NewLazyMethodBind("SphereMesh", "set_height")
+	methodBindSphereMeshSetIsHemisphere   = gdnative.NewLazyMethodBind("SphereMesh", "set_is_hemisphere")
+	methodBindSphereMeshSetRadialSegments = gdnative.NewLazyMethodBind("SphereMesh", "set_radial_segments")
+	methodBindSphereMeshSetRadius         = gdnative.NewLazyMethodBind("SphereMesh", "set_radius")
+	methodBindSphereMeshSetRings          = gdnative.NewLazyMethodBind("SphereMesh", "set_rings")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *SphereMesh) GetHeight() gdnative.Real {
 	//log.Println("Calling SphereMesh.GetHeight()")
@@ -45,7 +69,7 @@ func (o *SphereMesh) GetHeight() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "get_height")
+	methodBind := methodBindSphereMeshGetHeight.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +82,8 @@ func (o *SphereMesh) GetHeight() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SphereMesh) GetIsHemisphere() gdnative.Bool {
 	//log.Println("Calling SphereMesh.GetIsHemisphere()")
@@ -68,7 +92,7 @@ func (o *SphereMesh) GetIsHemisphere() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "get_is_hemisphere")
+	methodBind := methodBindSphereMeshGetIsHemisphere.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +105,8 @@ func (o *SphereMesh) GetIsHemisphere() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *SphereMesh) GetRadialSegments() gdnative.Int {
 	//log.Println("Calling SphereMesh.GetRadialSegments()")
@@ -91,7 +115,7 @@ func (o *SphereMesh) GetRadialSegments() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "get_radial_segments")
+	methodBind := methodBindSphereMeshGetRadialSegments.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +128,8 @@ func (o *SphereMesh) GetRadialSegments() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *SphereMesh) GetRadius() gdnative.Real {
 	//log.Println("Calling SphereMesh.GetRadius()")
@@ -114,7 +138,7 @@ func (o *SphereMesh) GetRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "get_radius")
+	methodBind := methodBindSphereMeshGetRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +151,8 @@ func (o *SphereMesh) GetRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *SphereMesh) GetRings() gdnative.Int {
 	//log.Println("Calling SphereMesh.GetRings()")
@@ -137,7 +161,7 @@ func (o *SphereMesh) GetRings() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "get_rings")
+	methodBind := methodBindSphereMeshGetRings.Get()
 
 	// Call the parent method.
 	// int
@@ -150,8 +174,8 @@ func (o *SphereMesh) GetRings() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false height float}], Returns: void
+	        Undocumented
+		Args: [{ false height float}], Returns: void
 */
 func (o *SphereMesh) SetHeight(height gdnative.Real) {
 	//log.Println("Calling SphereMesh.SetHeight()")
@@ -161,7 +185,7 @@ func (o *SphereMesh) SetHeight(height gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "set_height")
+	methodBind := methodBindSphereMeshSetHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +195,8 @@ func (o *SphereMesh) SetHeight(height gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false is_hemisphere bool}], Returns: void
+	        Undocumented
+		Args: [{ false is_hemisphere bool}], Returns: void
 */
 func (o *SphereMesh) SetIsHemisphere(isHemisphere gdnative.Bool) {
 	//log.Println("Calling SphereMesh.SetIsHemisphere()")
@@ -182,7 +206,7 @@ func (o *SphereMesh) SetIsHemisphere(isHemisphere gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(isHemisphere)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "set_is_hemisphere")
+	methodBind := methodBindSphereMeshSetIsHemisphere.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +216,8 @@ func (o *SphereMesh) SetIsHemisphere(isHemisphere gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radial_segments int}], Returns: void
+	        Undocumented
+		Args: [{ false radial_segments int}], Returns: void
 */
 func (o *SphereMesh) SetRadialSegments(radialSegments gdnative.Int) {
 	//log.Println("Calling SphereMesh.SetRadialSegments()")
@@ -203,7 +227,7 @@ func (o *SphereMesh) SetRadialSegments(radialSegments gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(radialSegments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "set_radial_segments")
+	methodBind := methodBindSphereMeshSetRadialSegments.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +237,8 @@ func (o *SphereMesh) SetRadialSegments(radialSegments gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *SphereMesh) SetRadius(radius gdnative.Real) {
 	//log.Println("Calling SphereMesh.SetRadius()")
@@ -224,7 +248,7 @@ func (o *SphereMesh) SetRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "set_radius")
+	methodBind := methodBindSphereMeshSetRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +258,8 @@ func (o *SphereMesh) SetRadius(radius gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rings int}], Returns: void
+	        Undocumented
+		Args: [{ false rings int}], Returns: void
 */
 func (o *SphereMesh) SetRings(rings gdnative.Int) {
 	//log.Println("Calling SphereMesh.SetRings()")
@@ -245,7 +269,7 @@ func (o *SphereMesh) SetRings(rings gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(rings)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereMesh", "set_rings")
+	methodBind := methodBindSphereMeshSetRings.Get()
 
 	// Call the parent method.
 	// void
@@ -254,6 +278,31 @@ func (o *SphereMesh) SetRings(rings gdnative.Int) {
 
 }
 
+// Height will return the value of the "height" property.
+func (o *SphereMesh) Height() gdnative.Real {
+	return o.GetHeight()
+}
+
+// IsHemisphere will return the value of the "is_hemisphere" property.
+func (o *SphereMesh) IsHemisphere() gdnative.Bool {
+	return o.GetIsHemisphere()
+}
+
+// RadialSegments will return the value of the "radial_segments" property.
+func (o *SphereMesh) RadialSegments() gdnative.Int {
+	return o.GetRadialSegments()
+}
+
+// Radius will return the value of the "radius" property.
+func (o *SphereMesh) Radius() gdnative.Real {
+	return o.GetRadius()
+}
+
+// Rings will return the value of the "rings" property.
+func (o *SphereMesh) Rings() gdnative.Int {
+	return o.GetRings()
+}
+
 // SphereMeshImplementer is an interface that implements the methods
 // of the SphereMesh class.
 type SphereMeshImplementer interface {
@@ -268,4 +317,9 @@ type SphereMeshImplementer interface {
 	SetRadialSegments(radialSegments gdnative.Int)
 	SetRadius(radius gdnative.Real)
 	SetRings(rings gdnative.Int)
+	Height() gdnative.Real
+	IsHemisphere() gdnative.Bool
+	RadialSegments() gdnative.Int
+	Radius() gdnative.Real
+	Rings() gdnative.Int
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSphereShapeFromPointer(ptr gdnative.Pointer) SphereShape {
-func newSphereShapeFromPointer(ptr gdnative.Pointer) SphereShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SphereShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Sphere shape for 3D collisions, which can be set into a [PhysicsBody] or [Area]. This shape is useful for modeling sphere-like 3D objects.
 */
 type SphereShape struct {
-	Shape
+	godot.Shape
 	owner gdnative.Object
 }
 
+// classConstructorSphereShape is the class constructor of the SphereShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSphereShape = gdnative.NewLazyClassConstructor("SphereShape")
+
+// NewSphereShape will create a new instance of the SphereShape class.
+// SphereShape is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSphereShape() *SphereShape {
+	obj := &SphereShape{}
+	obj.SetBaseObject(classConstructorSphereShape.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SphereShape) BaseClass() string {
 	return "SphereShape"
 }
 
+// Method binds of the SphereShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSphereShapeGetRadius = gdnative.NewLazyMethodBind("SphereShape", "get_radius")
+	methodBindSphereShapeSetRadius = gdnative.NewLazyMethodBind("SphereShape", "set_radius")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *SphereShape) GetRadius() gdnative.Real {
 	//log.Println("Calling SphereShape.GetRadius()")
@@ -45,7 +61,7 @@ func (o *SphereShape) GetRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereShape", "get_radius")
+	methodBind := methodBindSphereShapeGetRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +74,8 @@ func (o *SphereShape) GetRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false radius float}], Returns: void
+	        Undocumented
+		Args: [{ false radius float}], Returns: void
 */
 func (o *SphereShape) SetRadius(radius gdnative.Real) {
 	//log.Println("Calling SphereShape.SetRadius()")
@@ -69,7 +85,7 @@ func (o *SphereShape) SetRadius(radius gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SphereShape", "set_radius")
+	methodBind := methodBindSphereShapeSetRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *SphereShape) SetRadius(radius gdnative.Real) {
 
 }
 
+// Radius will return the value of the "radius" property.
+func (o *SphereShape) Radius() gdnative.Real {
+	return o.GetRadius()
+}
+
 // SphereShapeImplementer is an interface that implements the methods
 // of the SphereShape class.
 type SphereShapeImplementer interface {
-	ShapeImplementer
+	godot.ShapeImplementer
 	GetRadius() gdnative.Real
 	SetRadius(radius gdnative.Real)
+	Radius() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,45 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStreamTextureFromPointer(ptr gdnative.Pointer) StreamTexture {
-func newStreamTextureFromPointer(ptr gdnative.Pointer) StreamTexture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StreamTexture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A texture that is loaded from a .stex file.
 */
 type StreamTexture struct {
-	Texture
+	godot.Texture
 	owner gdnative.Object
 }
 
+// classConstructorStreamTexture is the class constructor of the StreamTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStreamTexture = gdnative.NewLazyClassConstructor("StreamTexture")
+
+// NewStreamTexture will create a new instance of the StreamTexture class.
+// StreamTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStreamTexture() *StreamTexture {
+	obj := &StreamTexture{}
+	obj.SetBaseObject(classConstructorStreamTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *StreamTexture) BaseClass() string {
 	return "StreamTexture"
 }
 
+// Method binds of the StreamTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStreamTextureGetLoadPath = gdnative.NewLazyMethodBind("StreamTexture", "get_load_path")
+	methodBindStreamTextureLoad        = gdnative.NewLazyMethodBind("StreamTexture", "load")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *StreamTexture) GetLoadPath() gdnative.String {
 	//log.Println("Calling StreamTexture.GetLoadPath()")
@@ -45,7 +61,7 @@ func (o *StreamTexture) GetLoadPath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamTexture", "get_load_path")
+	methodBind := methodBindStreamTextureGetLoadPath.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *StreamTexture) GetLoadPath() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: enum.Error
+	        Undocumented
+		Args: [{ false path String}], Returns: enum.Error
 */
 func (o *StreamTexture) Load(path gdnative.String) gdnative.Error {
 	//log.Println("Calling StreamTexture.Load()")
@@ -69,7 +85,7 @@ func (o *StreamTexture) Load(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamTexture", "load")
+	methodBind := methodBindStreamTextureLoad.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -81,9 +97,22 @@ func (o *StreamTexture) Load(path gdnative.String) gdnative.Error {
 	return gdnative.Error(ret)
 }
 
+// LoadPath will return the value of the "load_path" property.
+func (o *StreamTexture) LoadPath() gdnative.String {
+	return o.GetLoadPath()
+}
+
+// SetLoadPath will set the value of the "load_path" property.
+func (o *StreamTexture) SetLoadPath(value gdnative.String) {
+	o.Load(value)
+}
+
 // StreamTextureImplementer is an interface that implements the methods
 // of the StreamTexture class.
 type StreamTextureImplementer interface {
-	TextureImplementer
+	godot.TextureImplementer
 	GetLoadPath() gdnative.String
+	Load(path gdnative.String) gdnative.Error
+	LoadPath() gdnative.String
+	SetLoadPath(value gdnative.String)
 }
@@ -1,7 +1,11 @@
-package godot
+package resource
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/shader"
 )
 
 /*------------------------------------------------------------------------------
@@ -27,6 +31,52 @@ const (
 	TileSetBindTopright    TileSetAutotileBindings = 4
 )
 
+// String will return the Godot name of the TileSetAutotileBindings value.
+func (e TileSetAutotileBindings) String() string {
+	switch e {
+	case TileSetBindBottom:
+		return "BIND_BOTTOM"
+	case TileSetBindBottomleft:
+		return "BIND_BOTTOMLEFT"
+	case TileSetBindBottomright:
+		return "BIND_BOTTOMRIGHT"
+	case TileSetBindLeft:
+		return "BIND_LEFT"
+	case TileSetBindRight:
+		return "BIND_RIGHT"
+	case TileSetBindTop:
+		return "BIND_TOP"
+	case TileSetBindTopleft:
+		return "BIND_TOPLEFT"
+	case TileSetBindTopright:
+		return "BIND_TOPRIGHT"
+	}
+	return fmt.Sprintf("TileSetAutotileBindings(%d)", int(e))
+}
+
+// ParseTileSetAutotileBindings will return the TileSetAutotileBindings value with the given Godot name.
+func ParseTileSetAutotileBindings(name string) (TileSetAutotileBindings, error) {
+	switch name {
+	case "BIND_BOTTOM":
+		return TileSetBindBottom, nil
+	case "BIND_BOTTOMLEFT":
+		return TileSetBindBottomleft, nil
+	case "BIND_BOTTOMRIGHT":
+		return TileSetBindBottomright, nil
+	case "BIND_LEFT":
+		return TileSetBindLeft, nil
+	case "BIND_RIGHT":
+		return TileSetBindRight, nil
+	case "BIND_TOP":
+		return TileSetBindTop, nil
+	case "BIND_TOPLEFT":
+		return TileSetBindTopleft, nil
+	case "BIND_TOPRIGHT":
+		return TileSetBindTopright, nil
+	}
+	return 0, fmt.Errorf("invalid TileSetAutotileBindings value %q", name)
+}
+
 // TileSetBitmaskMode is an enum for BitmaskMode values.
 type TileSetBitmaskMode int
 
@@ -35,43 +85,117 @@ const (
 	TileSetBitmask3X3 TileSetBitmaskMode = 1
 )
 
-//func NewTileSetFromPointer(ptr gdnative.Pointer) TileSet {
-func newTileSetFromPointer(ptr gdnative.Pointer) TileSet {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := TileSet{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the TileSetBitmaskMode value.
+func (e TileSetBitmaskMode) String() string {
+	switch e {
+	case TileSetBitmask2X2:
+		return "BITMASK_2X2"
+	case TileSetBitmask3X3:
+		return "BITMASK_3X3"
+	}
+	return fmt.Sprintf("TileSetBitmaskMode(%d)", int(e))
+}
 
-	return obj
+// ParseTileSetBitmaskMode will return the TileSetBitmaskMode value with the given Godot name.
+func ParseTileSetBitmaskMode(name string) (TileSetBitmaskMode, error) {
+	switch name {
+	case "BITMASK_2X2":
+		return TileSetBitmask2X2, nil
+	case "BITMASK_3X3":
+		return TileSetBitmask3X3, nil
+	}
+	return 0, fmt.Errorf("invalid TileSetBitmaskMode value %q", name)
 }
 
 /*
 A TileSet is a library of tiles for a [TileMap]. It contains a list of tiles, each consisting of a sprite and optional collision shapes. Tiles are referenced by a unique integer ID.
 */
 type TileSet struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorTileSet is the class constructor of the TileSet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTileSet = gdnative.NewLazyClassConstructor("TileSet")
+
+// NewTileSet will create a new instance of the TileSet class.
+// TileSet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewTileSet() *TileSet {
+	obj := &TileSet{}
+	obj.SetBaseObject(classConstructorTileSet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *TileSet) BaseClass() string {
 	return "TileSet"
 }
 
-/*
+// Method binds of the TileSet class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTileSetX_ForwardSubtileSelection      = gdnative.NewLazyMethodBind("TileSet", "_forward_subtile_selection")
+	methodBindTileSetX_IsTileBound                  = gdnative.NewLazyMethodBind("TileSet", "_is_tile_bound")
+	methodBindTileSetAutotileGetBitmaskMode         = gdnative.NewLazyMethodBind("TileSet", "autotile_get_bitmask_mode")
+	methodBindTileSetAutotileSetBitmaskMode         = gdnative.NewLazyMethodBind("TileSet", "autotile_set_bitmask_mode")
+	methodBindTileSetClear                          = gdnative.NewLazyMethodBind("TileSet", "clear")
+	methodBindTileSetCreateTile                     = gdnative.NewLazyMethodBind("TileSet", "create_tile")
+	methodBindTileSetFindTileByName                 = gdnative.NewLazyMethodBind("TileSet", "find_tile_by_name")
+	methodBindTileSetGetLastUnusedTileId            = gdnative.NewLazyMethodBind("TileSet", "get_last_unused_tile_id")
+	methodBindTileSetGetTilesIds                    = gdnative.NewLazyMethodBind("TileSet", "get_tiles_ids")
+	methodBindTileSetRemoveTile                     = gdnative.NewLazyMethodBind("TileSet", "remove_tile")
+	methodBindTileSetTileAddShape                   = gdnative.NewLazyMethodBind("TileSet", "tile_add_shape")
+	methodBindTileSetTileGetLightOccluder           = gdnative.NewLazyMethodBind("TileSet", "tile_get_light_occluder")
+	methodBindTileSetTileGetMaterial                = gdnative.NewLazyMethodBind("TileSet", "tile_get_material")
+	methodBindTileSetTileGetName                    = gdnative.NewLazyMethodBind("TileSet", "tile_get_name")
+	methodBindTileSetTileGetNavigationPolygon       = gdnative.NewLazyMethodBind("TileSet", "tile_get_navigation_polygon")
+	methodBindTileSetTileGetNavigationPolygonOffset = gdnative.NewLazyMethodBind("TileSet", "tile_get_navigation_polygon_offset")
+	methodBindTileSetTileGetNormalMap               = gdnative.NewLazyMethodBind("TileSet", "tile_get_normal_map")
+	methodBindTileSetTileGetOccluderOffset          = gdnative.NewLazyMethodBind("TileSet", "tile_get_occluder_offset")
+	methodBindTileSetTileGetRegion                  = gdnative.NewLazyMethodBind("TileSet", "tile_get_region")
+	methodBindTileSetTileGetShape                   = gdnative.NewLazyMethodBind("TileSet", "tile_get_shape")
+	methodBindTileSetTileGetShapeCount              = gdnative.NewLazyMethodBind("TileSet", "tile_get_shape_count")
+	methodBindTileSetTileGetShapeOneWay             = gdnative.NewLazyMethodBind("TileSet", "tile_get_shape_one_way")
+	methodBindTileSetTileGetShapeTransform          = gdnative.NewLazyMethodBind("TileSet", "tile_get_shape_transform")
+	methodBindTileSetTileGetShapes                  = gdnative.NewLazyMethodBind("TileSet", "tile_get_shapes")
+	methodBindTileSetTileGetTexture                 = gdnative.NewLazyMethodBind("TileSet", "tile_get_texture")
+	methodBindTileSetTileGetTextureOffset           = gdnative.NewLazyMethodBind("TileSet", "tile_get_texture_offset")
+	methodBindTileSetTileSetLightOccluder           = gdnative.NewLazyMethodBind("TileSet", "tile_set_light_occluder")
+	methodBindTileSetTileSetMaterial                = gdnative.NewLazyMethodBind("TileSet", "tile_set_material")
+	methodBindTileSetTileSetName                    = gdnative.NewLazyMethodBind("TileSet", "tile_set_name")
+	methodBindTileSetTileSetNavigationPolygon       = gdnative.NewLazyMethodBind("TileSet", "tile_set_navigation_polygon")
+	methodBindTileSetTileSetNavigationPolygonOffset = gdnative.NewLazyMethodBind("TileSet", "tile_set_navigation_polygon_offset")
+	methodBindTileSetTileSetNormalMap               = gdnative.NewLazyMethodBind("TileSet", "tile_set_normal_map")
+	methodBindTileSetTileSetOccluderOffset          = gdnative.NewLazyMethodBind("TileSet", "tile_set_occluder_offset")
+	methodBindTileSetTileSetRegion                  = gdnative.NewLazyMethodBind("TileSet", "tile_set_region")
+	methodBindTileSetTileSetShape                   = gdnative.NewLazyMethodBind("TileSet", "tile_set_shape")
+	methodBindTileSetTileSetShapeOneWay             = gdnative.NewLazyMethodBind("TileSet", "tile_set_shape_one_way")
+	methodBindTileSetTileSetShapeTransform          = gdnative.NewLazyMethodBind("TileSet", "tile_set_shape_transform")
+	methodBindTileSetTileSetShapes                  = gdnative.NewLazyMethodBind("TileSet", "tile_set_shapes")
+	methodBindTileSetTileSetTexture                 = gdnative.NewLazyMethodBind("TileSet", "tile_set_texture")
+	methodBindTileSetTileSetTextureOffset           = gdnative.NewLazyMethodBind("TileSet", "tile_set_texture_offset")
+)
 
-	Args: [{ false autotile_id int} { false bitmask int} { false tilemap Object} { false tile_location Vector2}], Returns: Vector2
+/*
+Args: [{ false autotile_id int} { false bitmask int} { false tilemap Object} { false tile_location Vector2}], Returns: Vector2
 */
-func (o *TileSet) X_ForwardSubtileSelection(autotileId gdnative.Int, bitmask gdnative.Int, tilemap ObjectImplementer, tileLocation gdnative.Vector2) gdnative.Vector2 {
+func (o *TileSet) X_ForwardSubtileSelection(autotileId gdnative.Int, bitmask gdnative.Int, tilemap godot.ObjectImplementer, tileLocation gdnative.Vector2) gdnative.Vector2 {
 	//log.Println("Calling TileSet.X_ForwardSubtileSelection()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
 	ptrArguments[0] = gdnative.NewPointerFromInt(autotileId)
 	ptrArguments[1] = gdnative.NewPointerFromInt(bitmask)
-	ptrArguments[2] = gdnative.NewPointerFromObject(tilemap.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(tilemap))
 	ptrArguments[3] = gdnative.NewPointerFromVector2(tileLocation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "_forward_subtile_selection")
+	methodBind := methodBindTileSetX_ForwardSubtileSelection.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -84,8 +208,7 @@ func (o *TileSet) X_ForwardSubtileSelection(autotileId gdnative.Int, bitmask gdn
 }
 
 /*
-
-	Args: [{ false drawn_id int} { false neighbor_id int}], Returns: bool
+Args: [{ false drawn_id int} { false neighbor_id int}], Returns: bool
 */
 func (o *TileSet) X_IsTileBound(drawnId gdnative.Int, neighborId gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TileSet.X_IsTileBound()")
@@ -96,7 +219,7 @@ func (o *TileSet) X_IsTileBound(drawnId gdnative.Int, neighborId gdnative.Int) g
 	ptrArguments[1] = gdnative.NewPointerFromInt(neighborId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "_is_tile_bound")
+	methodBind := methodBindTileSetX_IsTileBound.Get()
 
 	// Call the parent method.
 	// bool
@@ -109,8 +232,7 @@ func (o *TileSet) X_IsTileBound(drawnId gdnative.Int, neighborId gdnative.Int) g
 }
 
 /*
-
-	Args: [{ false id int}], Returns: enum.TileSet::BitmaskMode
+Args: [{ false id int}], Returns: enum.TileSet::BitmaskMode
 */
 func (o *TileSet) AutotileGetBitmaskMode(id gdnative.Int) TileSetBitmaskMode {
 	//log.Println("Calling TileSet.AutotileGetBitmaskMode()")
@@ -120,7 +242,7 @@ func (o *TileSet) AutotileGetBitmaskMode(id gdnative.Int) TileSetBitmaskMode {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "autotile_get_bitmask_mode")
+	methodBind := methodBindTileSetAutotileGetBitmaskMode.Get()
 
 	// Call the parent method.
 	// enum.TileSet::BitmaskMode
@@ -133,8 +255,7 @@ func (o *TileSet) AutotileGetBitmaskMode(id gdnative.Int) TileSetBitmaskMode {
 }
 
 /*
-
-	Args: [{ false id int} { false mode int}], Returns: void
+Args: [{ false id int} { false mode int}], Returns: void
 */
 func (o *TileSet) AutotileSetBitmaskMode(id gdnative.Int, mode gdnative.Int) {
 	//log.Println("Calling TileSet.AutotileSetBitmaskMode()")
@@ -145,7 +266,7 @@ func (o *TileSet) AutotileSetBitmaskMode(id gdnative.Int, mode gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "autotile_set_bitmask_mode")
+	methodBind := methodBindTileSetAutotileSetBitmaskMode.Get()
 
 	// Call the parent method.
 	// void
@@ -155,8 +276,8 @@ func (o *TileSet) AutotileSetBitmaskMode(id gdnative.Int, mode gdnative.Int) {
 }
 
 /*
-        Clear all tiles.
-	Args: [], Returns: void
+	        Clear all tiles.
+		Args: [], Returns: void
 */
 func (o *TileSet) Clear() {
 	//log.Println("Calling TileSet.Clear()")
@@ -165,7 +286,7 @@ func (o *TileSet) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "clear")
+	methodBind := methodBindTileSetClear.Get()
 
 	// Call the parent method.
 	// void
@@ -175,8 +296,8 @@ func (o *TileSet) Clear() {
 }
 
 /*
-        Create a new tile which will be referenced by the given ID.
-	Args: [{ false id int}], Returns: void
+	        Create a new tile which will be referenced by the given ID.
+		Args: [{ false id int}], Returns: void
 */
 func (o *TileSet) CreateTile(id gdnative.Int) {
 	//log.Println("Calling TileSet.CreateTile()")
@@ -186,7 +307,7 @@ func (o *TileSet) CreateTile(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "create_tile")
+	methodBind := methodBindTileSetCreateTile.Get()
 
 	// Call the parent method.
 	// void
@@ -196,8 +317,8 @@ func (o *TileSet) CreateTile(id gdnative.Int) {
 }
 
 /*
-        Find the first tile matching the given name.
-	Args: [{ false name String}], Returns: int
+	        Find the first tile matching the given name.
+		Args: [{ false name String}], Returns: int
 */
 func (o *TileSet) FindTileByName(name gdnative.String) gdnative.Int {
 	//log.Println("Calling TileSet.FindTileByName()")
@@ -207,7 +328,7 @@ func (o *TileSet) FindTileByName(name gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "find_tile_by_name")
+	methodBind := methodBindTileSetFindTileByName.Get()
 
 	// Call the parent method.
 	// int
@@ -220,8 +341,8 @@ func (o *TileSet) FindTileByName(name gdnative.String) gdnative.Int {
 }
 
 /*
-        Return the ID following the last currently used ID, useful when creating a new tile.
-	Args: [], Returns: int
+	        Return the ID following the last currently used ID, useful when creating a new tile.
+		Args: [], Returns: int
 */
 func (o *TileSet) GetLastUnusedTileId() gdnative.Int {
 	//log.Println("Calling TileSet.GetLastUnusedTileId()")
@@ -230,7 +351,7 @@ func (o *TileSet) GetLastUnusedTileId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "get_last_unused_tile_id")
+	methodBind := methodBindTileSetGetLastUnusedTileId.Get()
 
 	// Call the parent method.
 	// int
@@ -243,8 +364,8 @@ func (o *TileSet) GetLastUnusedTileId() gdnative.Int {
 }
 
 /*
-        Return an array of all currently used tile IDs.
-	Args: [], Returns: Array
+	        Return an array of all currently used tile IDs.
+		Args: [], Returns: Array
 */
 func (o *TileSet) GetTilesIds() gdnative.Array {
 	//log.Println("Calling TileSet.GetTilesIds()")
@@ -253,7 +374,7 @@ func (o *TileSet) GetTilesIds() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "get_tiles_ids")
+	methodBind := methodBindTileSetGetTilesIds.Get()
 
 	// Call the parent method.
 	// Array
@@ -266,8 +387,8 @@ func (o *TileSet) GetTilesIds() gdnative.Array {
 }
 
 /*
-        Remove the tile referenced by the given ID.
-	Args: [{ false id int}], Returns: void
+	        Remove the tile referenced by the given ID.
+		Args: [{ false id int}], Returns: void
 */
 func (o *TileSet) RemoveTile(id gdnative.Int) {
 	//log.Println("Calling TileSet.RemoveTile()")
@@ -277,7 +398,7 @@ func (o *TileSet) RemoveTile(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "remove_tile")
+	methodBind := methodBindTileSetRemoveTile.Get()
 
 	// Call the parent method.
 	// void
@@ -287,22 +408,21 @@ func (o *TileSet) RemoveTile(id gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false id int} { false shape Shape2D} { false shape_transform Transform2D} {False true one_way bool} {(0, 0) true autotile_coord Vector2}], Returns: void
+Args: [{ false id int} { false shape Shape2D} { false shape_transform Transform2D} {False true one_way bool} {(0, 0) true autotile_coord Vector2}], Returns: void
 */
-func (o *TileSet) TileAddShape(id gdnative.Int, shape Shape2DImplementer, shapeTransform gdnative.Transform2D, oneWay gdnative.Bool, autotileCoord gdnative.Vector2) {
+func (o *TileSet) TileAddShape(id gdnative.Int, shape godot.Shape2DImplementer, shapeTransform gdnative.Transform2D, oneWay gdnative.Bool, autotileCoord gdnative.Vector2) {
 	//log.Println("Calling TileSet.TileAddShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(shape))
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(shapeTransform)
 	ptrArguments[3] = gdnative.NewPointerFromBool(oneWay)
 	ptrArguments[4] = gdnative.NewPointerFromVector2(autotileCoord)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_add_shape")
+	methodBind := methodBindTileSetTileAddShape.Get()
 
 	// Call the parent method.
 	// void
@@ -312,8 +432,8 @@ func (o *TileSet) TileAddShape(id gdnative.Int, shape Shape2DImplementer, shapeT
 }
 
 /*
-        Return the light occluder of the tile.
-	Args: [{ false id int}], Returns: OccluderPolygon2D
+	        Return the light occluder of the tile.
+		Args: [{ false id int}], Returns: OccluderPolygon2D
 */
 func (o *TileSet) TileGetLightOccluder(id gdnative.Int) OccluderPolygon2DImplementer {
 	//log.Println("Calling TileSet.TileGetLightOccluder()")
@@ -323,7 +443,7 @@ func (o *TileSet) TileGetLightOccluder(id gdnative.Int) OccluderPolygon2DImpleme
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_light_occluder")
+	methodBind := methodBindTileSetTileGetLightOccluder.Get()
 
 	// Call the parent method.
 	// OccluderPolygon2D
@@ -331,29 +451,27 @@ func (o *TileSet) TileGetLightOccluder(id gdnative.Int) OccluderPolygon2DImpleme
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newOccluderPolygon2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(OccluderPolygon2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "OccluderPolygon2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(OccluderPolygon2DImplementer)
+	ret := OccluderPolygon2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(OccluderPolygon2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the material of the tile.
-	Args: [{ false id int}], Returns: ShaderMaterial
+	        Return the material of the tile.
+		Args: [{ false id int}], Returns: ShaderMaterial
 */
-func (o *TileSet) TileGetMaterial(id gdnative.Int) ShaderMaterialImplementer {
+func (o *TileSet) TileGetMaterial(id gdnative.Int) shader.ShaderMaterialImplementer {
 	//log.Println("Calling TileSet.TileGetMaterial()")
 
 	// Build out the method's arguments
@@ -361,7 +479,7 @@ func (o *TileSet) TileGetMaterial(id gdnative.Int) ShaderMaterialImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_material")
+	methodBind := methodBindTileSetTileGetMaterial.Get()
 
 	// Call the parent method.
 	// ShaderMaterial
@@ -369,27 +487,25 @@ func (o *TileSet) TileGetMaterial(id gdnative.Int) ShaderMaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShaderMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShaderMaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ShaderMaterial" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShaderMaterialImplementer)
+	ret := shader.ShaderMaterial{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(shader.ShaderMaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the name of the tile.
-	Args: [{ false id int}], Returns: String
+	        Return the name of the tile.
+		Args: [{ false id int}], Returns: String
 */
 func (o *TileSet) TileGetName(id gdnative.Int) gdnative.String {
 	//log.Println("Calling TileSet.TileGetName()")
@@ -399,7 +515,7 @@ func (o *TileSet) TileGetName(id gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_name")
+	methodBind := methodBindTileSetTileGetName.Get()
 
 	// Call the parent method.
 	// String
@@ -412,8 +528,8 @@ func (o *TileSet) TileGetName(id gdnative.Int) gdnative.String {
 }
 
 /*
-        Return the navigation polygon of the tile.
-	Args: [{ false id int}], Returns: NavigationPolygon
+	        Return the navigation polygon of the tile.
+		Args: [{ false id int}], Returns: NavigationPolygon
 */
 func (o *TileSet) TileGetNavigationPolygon(id gdnative.Int) NavigationPolygonImplementer {
 	//log.Println("Calling TileSet.TileGetNavigationPolygon()")
@@ -423,7 +539,7 @@ func (o *TileSet) TileGetNavigationPolygon(id gdnative.Int) NavigationPolygonImp
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_navigation_polygon")
+	methodBind := methodBindTileSetTileGetNavigationPolygon.Get()
 
 	// Call the parent method.
 	// NavigationPolygon
@@ -431,27 +547,25 @@ func (o *TileSet) TileGetNavigationPolygon(id gdnative.Int) NavigationPolygonImp
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNavigationPolygonFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NavigationPolygonImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "NavigationPolygon" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NavigationPolygonImplementer)
+	ret := NavigationPolygon{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NavigationPolygonImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the offset of the tile's navigation polygon.
-	Args: [{ false id int}], Returns: Vector2
+	        Return the offset of the tile's navigation polygon.
+		Args: [{ false id int}], Returns: Vector2
 */
 func (o *TileSet) TileGetNavigationPolygonOffset(id gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling TileSet.TileGetNavigationPolygonOffset()")
@@ -461,7 +575,7 @@ func (o *TileSet) TileGetNavigationPolygonOffset(id gdnative.Int) gdnative.Vecto
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_navigation_polygon_offset")
+	methodBind := methodBindTileSetTileGetNavigationPolygonOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -474,10 +588,9 @@ func (o *TileSet) TileGetNavigationPolygonOffset(id gdnative.Int) gdnative.Vecto
 }
 
 /*
-
-	Args: [{ false id int}], Returns: Texture
+Args: [{ false id int}], Returns: Texture
 */
-func (o *TileSet) TileGetNormalMap(id gdnative.Int) TextureImplementer {
+func (o *TileSet) TileGetNormalMap(id gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling TileSet.TileGetNormalMap()")
 
 	// Build out the method's arguments
@@ -485,7 +598,7 @@ func (o *TileSet) TileGetNormalMap(id gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_normal_map")
+	methodBind := methodBindTileSetTileGetNormalMap.Get()
 
 	// Call the parent method.
 	// Texture
@@ -493,27 +606,25 @@ func (o *TileSet) TileGetNormalMap(id gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the offset of the tile's light occluder.
-	Args: [{ false id int}], Returns: Vector2
+	        Return the offset of the tile's light occluder.
+		Args: [{ false id int}], Returns: Vector2
 */
 func (o *TileSet) TileGetOccluderOffset(id gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling TileSet.TileGetOccluderOffset()")
@@ -523,7 +634,7 @@ func (o *TileSet) TileGetOccluderOffset(id gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_occluder_offset")
+	methodBind := methodBindTileSetTileGetOccluderOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -536,8 +647,8 @@ func (o *TileSet) TileGetOccluderOffset(id gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Return the tile sub-region in the texture.
-	Args: [{ false id int}], Returns: Rect2
+	        Return the tile sub-region in the texture.
+		Args: [{ false id int}], Returns: Rect2
 */
 func (o *TileSet) TileGetRegion(id gdnative.Int) gdnative.Rect2 {
 	//log.Println("Calling TileSet.TileGetRegion()")
@@ -547,7 +658,7 @@ func (o *TileSet) TileGetRegion(id gdnative.Int) gdnative.Rect2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_region")
+	methodBind := methodBindTileSetTileGetRegion.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -560,10 +671,9 @@ func (o *TileSet) TileGetRegion(id gdnative.Int) gdnative.Rect2 {
 }
 
 /*
-
-	Args: [{ false id int} { false shape_id int}], Returns: Shape2D
+Args: [{ false id int} { false shape_id int}], Returns: Shape2D
 */
-func (o *TileSet) TileGetShape(id gdnative.Int, shapeId gdnative.Int) Shape2DImplementer {
+func (o *TileSet) TileGetShape(id gdnative.Int, shapeId gdnative.Int) godot.Shape2DImplementer {
 	//log.Println("Calling TileSet.TileGetShape()")
 
 	// Build out the method's arguments
@@ -572,7 +682,7 @@ func (o *TileSet) TileGetShape(id gdnative.Int, shapeId gdnative.Int) Shape2DImp
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_shape")
+	methodBind := methodBindTileSetTileGetShape.Get()
 
 	// Call the parent method.
 	// Shape2D
@@ -580,27 +690,24 @@ func (o *TileSet) TileGetShape(id gdnative.Int, shapeId gdnative.Int) Shape2DImp
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShape2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(Shape2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shape2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(Shape2DImplementer)
+	ret := godot.Shape2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.Shape2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false id int}], Returns: int
+Args: [{ false id int}], Returns: int
 */
 func (o *TileSet) TileGetShapeCount(id gdnative.Int) gdnative.Int {
 	//log.Println("Calling TileSet.TileGetShapeCount()")
@@ -610,7 +717,7 @@ func (o *TileSet) TileGetShapeCount(id gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_shape_count")
+	methodBind := methodBindTileSetTileGetShapeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -623,8 +730,7 @@ func (o *TileSet) TileGetShapeCount(id gdnative.Int) gdnative.Int {
 }
 
 /*
-
-	Args: [{ false id int} { false shape_id int}], Returns: bool
+Args: [{ false id int} { false shape_id int}], Returns: bool
 */
 func (o *TileSet) TileGetShapeOneWay(id gdnative.Int, shapeId gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TileSet.TileGetShapeOneWay()")
@@ -635,7 +741,7 @@ func (o *TileSet) TileGetShapeOneWay(id gdnative.Int, shapeId gdnative.Int) gdna
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_shape_one_way")
+	methodBind := methodBindTileSetTileGetShapeOneWay.Get()
 
 	// Call the parent method.
 	// bool
@@ -648,8 +754,7 @@ func (o *TileSet) TileGetShapeOneWay(id gdnative.Int, shapeId gdnative.Int) gdna
 }
 
 /*
-
-	Args: [{ false id int} { false shape_id int}], Returns: Transform2D
+Args: [{ false id int} { false shape_id int}], Returns: Transform2D
 */
 func (o *TileSet) TileGetShapeTransform(id gdnative.Int, shapeId gdnative.Int) gdnative.Transform2D {
 	//log.Println("Calling TileSet.TileGetShapeTransform()")
@@ -660,7 +765,7 @@ func (o *TileSet) TileGetShapeTransform(id gdnative.Int, shapeId gdnative.Int) g
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_shape_transform")
+	methodBind := methodBindTileSetTileGetShapeTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -673,8 +778,8 @@ func (o *TileSet) TileGetShapeTransform(id gdnative.Int, shapeId gdnative.Int) g
 }
 
 /*
-        Return the array of shapes of the tile.
-	Args: [{ false id int}], Returns: Array
+	        Return the array of shapes of the tile.
+		Args: [{ false id int}], Returns: Array
 */
 func (o *TileSet) TileGetShapes(id gdnative.Int) gdnative.Array {
 	//log.Println("Calling TileSet.TileGetShapes()")
@@ -684,7 +789,7 @@ func (o *TileSet) TileGetShapes(id gdnative.Int) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_shapes")
+	methodBind := methodBindTileSetTileGetShapes.Get()
 
 	// Call the parent method.
 	// Array
@@ -697,10 +802,10 @@ func (o *TileSet) TileGetShapes(id gdnative.Int) gdnative.Array {
 }
 
 /*
-        Return the texture of the tile.
-	Args: [{ false id int}], Returns: Texture
+	        Return the texture of the tile.
+		Args: [{ false id int}], Returns: Texture
 */
-func (o *TileSet) TileGetTexture(id gdnative.Int) TextureImplementer {
+func (o *TileSet) TileGetTexture(id gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling TileSet.TileGetTexture()")
 
 	// Build out the method's arguments
@@ -708,7 +813,7 @@ func (o *TileSet) TileGetTexture(id gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_texture")
+	methodBind := methodBindTileSetTileGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -716,27 +821,25 @@ func (o *TileSet) TileGetTexture(id gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the texture offset of the tile.
-	Args: [{ false id int}], Returns: Vector2
+	        Return the texture offset of the tile.
+		Args: [{ false id int}], Returns: Vector2
 */
 func (o *TileSet) TileGetTextureOffset(id gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling TileSet.TileGetTextureOffset()")
@@ -746,7 +849,7 @@ func (o *TileSet) TileGetTextureOffset(id gdnative.Int) gdnative.Vector2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_get_texture_offset")
+	methodBind := methodBindTileSetTileGetTextureOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -759,8 +862,8 @@ func (o *TileSet) TileGetTextureOffset(id gdnative.Int) gdnative.Vector2 {
 }
 
 /*
-        Set a light occluder for the tile.
-	Args: [{ false id int} { false light_occluder OccluderPolygon2D}], Returns: void
+	        Set a light occluder for the tile.
+		Args: [{ false id int} { false light_occluder OccluderPolygon2D}], Returns: void
 */
 func (o *TileSet) TileSetLightOccluder(id gdnative.Int, lightOccluder OccluderPolygon2DImplementer) {
 	//log.Println("Calling TileSet.TileSetLightOccluder()")
@@ -768,10 +871,10 @@ func (o *TileSet) TileSetLightOccluder(id gdnative.Int, lightOccluder OccluderPo
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(lightOccluder.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(lightOccluder))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_light_occluder")
+	methodBind := methodBindTileSetTileSetLightOccluder.Get()
 
 	// Call the parent method.
 	// void
@@ -781,19 +884,19 @@ func (o *TileSet) TileSetLightOccluder(id gdnative.Int, lightOccluder OccluderPo
 }
 
 /*
-        Set the material of the tile.
-	Args: [{ false id int} { false material ShaderMaterial}], Returns: void
+	        Set the material of the tile.
+		Args: [{ false id int} { false material ShaderMaterial}], Returns: void
 */
-func (o *TileSet) TileSetMaterial(id gdnative.Int, material ShaderMaterialImplementer) {
+func (o *TileSet) TileSetMaterial(id gdnative.Int, material shader.ShaderMaterialImplementer) {
 	//log.Println("Calling TileSet.TileSetMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_material")
+	methodBind := methodBindTileSetTileSetMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -803,8 +906,8 @@ func (o *TileSet) TileSetMaterial(id gdnative.Int, material ShaderMaterialImplem
 }
 
 /*
-        Set the name of the tile, for descriptive purposes.
-	Args: [{ false id int} { false name String}], Returns: void
+	        Set the name of the tile, for descriptive purposes.
+		Args: [{ false id int} { false name String}], Returns: void
 */
 func (o *TileSet) TileSetName(id gdnative.Int, name gdnative.String) {
 	//log.Println("Calling TileSet.TileSetName()")
@@ -815,7 +918,7 @@ func (o *TileSet) TileSetName(id gdnative.Int, name gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_name")
+	methodBind := methodBindTileSetTileSetName.Get()
 
 	// Call the parent method.
 	// void
@@ -825,8 +928,8 @@ func (o *TileSet) TileSetName(id gdnative.Int, name gdnative.String) {
 }
 
 /*
-        Set a navigation polygon for the tile.
-	Args: [{ false id int} { false navigation_polygon NavigationPolygon}], Returns: void
+	        Set a navigation polygon for the tile.
+		Args: [{ false id int} { false navigation_polygon NavigationPolygon}], Returns: void
 */
 func (o *TileSet) TileSetNavigationPolygon(id gdnative.Int, navigationPolygon NavigationPolygonImplementer) {
 	//log.Println("Calling TileSet.TileSetNavigationPolygon()")
@@ -834,10 +937,10 @@ func (o *TileSet) TileSetNavigationPolygon(id gdnative.Int, navigationPolygon Na
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(navigationPolygon.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(navigationPolygon))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_navigation_polygon")
+	methodBind := methodBindTileSetTileSetNavigationPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -847,8 +950,8 @@ func (o *TileSet) TileSetNavigationPolygon(id gdnative.Int, navigationPolygon Na
 }
 
 /*
-        Set an offset for the tile's navigation polygon.
-	Args: [{ false id int} { false navigation_polygon_offset Vector2}], Returns: void
+	        Set an offset for the tile's navigation polygon.
+		Args: [{ false id int} { false navigation_polygon_offset Vector2}], Returns: void
 */
 func (o *TileSet) TileSetNavigationPolygonOffset(id gdnative.Int, navigationPolygonOffset gdnative.Vector2) {
 	//log.Println("Calling TileSet.TileSetNavigationPolygonOffset()")
@@ -859,7 +962,7 @@ func (o *TileSet) TileSetNavigationPolygonOffset(id gdnative.Int, navigationPoly
 	ptrArguments[1] = gdnative.NewPointerFromVector2(navigationPolygonOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_navigation_polygon_offset")
+	methodBind := methodBindTileSetTileSetNavigationPolygonOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -869,19 +972,18 @@ func (o *TileSet) TileSetNavigationPolygonOffset(id gdnative.Int, navigationPoly
 }
 
 /*
-
-	Args: [{ false id int} { false normal_map Texture}], Returns: void
+Args: [{ false id int} { false normal_map Texture}], Returns: void
 */
-func (o *TileSet) TileSetNormalMap(id gdnative.Int, normalMap TextureImplementer) {
+func (o *TileSet) TileSetNormalMap(id gdnative.Int, normalMap godot.TextureImplementer) {
 	//log.Println("Calling TileSet.TileSetNormalMap()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(normalMap))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_normal_map")
+	methodBind := methodBindTileSetTileSetNormalMap.Get()
 
 	// Call the parent method.
 	// void
@@ -891,8 +993,8 @@ func (o *TileSet) TileSetNormalMap(id gdnative.Int, normalMap TextureImplementer
 }
 
 /*
-        Set an offset for the tile's light occluder.
-	Args: [{ false id int} { false occluder_offset Vector2}], Returns: void
+	        Set an offset for the tile's light occluder.
+		Args: [{ false id int} { false occluder_offset Vector2}], Returns: void
 */
 func (o *TileSet) TileSetOccluderOffset(id gdnative.Int, occluderOffset gdnative.Vector2) {
 	//log.Println("Calling TileSet.TileSetOccluderOffset()")
@@ -903,7 +1005,7 @@ func (o *TileSet) TileSetOccluderOffset(id gdnative.Int, occluderOffset gdnative
 	ptrArguments[1] = gdnative.NewPointerFromVector2(occluderOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_occluder_offset")
+	methodBind := methodBindTileSetTileSetOccluderOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -913,8 +1015,8 @@ func (o *TileSet) TileSetOccluderOffset(id gdnative.Int, occluderOffset gdnative
 }
 
 /*
-        Set the tile sub-region in the texture. This is common in texture atlases.
-	Args: [{ false id int} { false region Rect2}], Returns: void
+	        Set the tile sub-region in the texture. This is common in texture atlases.
+		Args: [{ false id int} { false region Rect2}], Returns: void
 */
 func (o *TileSet) TileSetRegion(id gdnative.Int, region gdnative.Rect2) {
 	//log.Println("Calling TileSet.TileSetRegion()")
@@ -925,7 +1027,7 @@ func (o *TileSet) TileSetRegion(id gdnative.Int, region gdnative.Rect2) {
 	ptrArguments[1] = gdnative.NewPointerFromRect2(region)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_region")
+	methodBind := methodBindTileSetTileSetRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -935,20 +1037,19 @@ func (o *TileSet) TileSetRegion(id gdnative.Int, region gdnative.Rect2) {
 }
 
 /*
-
-	Args: [{ false id int} { false shape_id int} { false shape Shape2D}], Returns: void
+Args: [{ false id int} { false shape_id int} { false shape Shape2D}], Returns: void
 */
-func (o *TileSet) TileSetShape(id gdnative.Int, shapeId gdnative.Int, shape Shape2DImplementer) {
+func (o *TileSet) TileSetShape(id gdnative.Int, shapeId gdnative.Int, shape godot.Shape2DImplementer) {
 	//log.Println("Calling TileSet.TileSetShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 	ptrArguments[1] = gdnative.NewPointerFromInt(shapeId)
-	ptrArguments[2] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_shape")
+	methodBind := methodBindTileSetTileSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -958,8 +1059,7 @@ func (o *TileSet) TileSetShape(id gdnative.Int, shapeId gdnative.Int, shape Shap
 }
 
 /*
-
-	Args: [{ false id int} { false shape_id int} { false one_way bool}], Returns: void
+Args: [{ false id int} { false shape_id int} { false one_way bool}], Returns: void
 */
 func (o *TileSet) TileSetShapeOneWay(id gdnative.Int, shapeId gdnative.Int, oneWay gdnative.Bool) {
 	//log.Println("Calling TileSet.TileSetShapeOneWay()")
@@ -971,7 +1071,7 @@ func (o *TileSet) TileSetShapeOneWay(id gdnative.Int, shapeId gdnative.Int, oneW
 	ptrArguments[2] = gdnative.NewPointerFromBool(oneWay)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_shape_one_way")
+	methodBind := methodBindTileSetTileSetShapeOneWay.Get()
 
 	// Call the parent method.
 	// void
@@ -981,8 +1081,7 @@ func (o *TileSet) TileSetShapeOneWay(id gdnative.Int, shapeId gdnative.Int, oneW
 }
 
 /*
-
-	Args: [{ false id int} { false shape_id int} { false shape_transform Transform2D}], Returns: void
+Args: [{ false id int} { false shape_id int} { false shape_transform Transform2D}], Returns: void
 */
 func (o *TileSet) TileSetShapeTransform(id gdnative.Int, shapeId gdnative.Int, shapeTransform gdnative.Transform2D) {
 	//log.Println("Calling TileSet.TileSetShapeTransform()")
@@ -994,7 +1093,7 @@ func (o *TileSet) TileSetShapeTransform(id gdnative.Int, shapeId gdnative.Int, s
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(shapeTransform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_shape_transform")
+	methodBind := methodBindTileSetTileSetShapeTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1004,8 +1103,8 @@ func (o *TileSet) TileSetShapeTransform(id gdnative.Int, shapeId gdnative.Int, s
 }
 
 /*
-        Set an array of shapes for the tile, enabling physics to collide with it.
-	Args: [{ false id int} { false shapes Array}], Returns: void
+	        Set an array of shapes for the tile, enabling physics to collide with it.
+		Args: [{ false id int} { false shapes Array}], Returns: void
 */
 func (o *TileSet) TileSetShapes(id gdnative.Int, shapes gdnative.Array) {
 	//log.Println("Calling TileSet.TileSetShapes()")
@@ -1016,7 +1115,7 @@ func (o *TileSet) TileSetShapes(id gdnative.Int, shapes gdnative.Array) {
 	ptrArguments[1] = gdnative.NewPointerFromArray(shapes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_shapes")
+	methodBind := methodBindTileSetTileSetShapes.Get()
 
 	// Call the parent method.
 	// void
@@ -1026,19 +1125,19 @@ func (o *TileSet) TileSetShapes(id gdnative.Int, shapes gdnative.Array) {
 }
 
 /*
-        Set the texture of the tile.
-	Args: [{ false id int} { false texture Texture}], Returns: void
+	        Set the texture of the tile.
+		Args: [{ false id int} { false texture Texture}], Returns: void
 */
-func (o *TileSet) TileSetTexture(id gdnative.Int, texture TextureImplementer) {
+func (o *TileSet) TileSetTexture(id gdnative.Int, texture godot.TextureImplementer) {
 	//log.Println("Calling TileSet.TileSetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_texture")
+	methodBind := methodBindTileSetTileSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -1048,8 +1147,8 @@ func (o *TileSet) TileSetTexture(id gdnative.Int, texture TextureImplementer) {
 }
 
 /*
-        Set the texture offset of the tile.
-	Args: [{ false id int} { false texture_offset Vector2}], Returns: void
+	        Set the texture offset of the tile.
+		Args: [{ false id int} { false texture_offset Vector2}], Returns: void
 */
 func (o *TileSet) TileSetTextureOffset(id gdnative.Int, textureOffset gdnative.Vector2) {
 	//log.Println("Calling TileSet.TileSetTextureOffset()")
@@ -1060,7 +1159,7 @@ func (o *TileSet) TileSetTextureOffset(id gdnative.Int, textureOffset gdnative.V
 	ptrArguments[1] = gdnative.NewPointerFromVector2(textureOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TileSet", "tile_set_texture_offset")
+	methodBind := methodBindTileSetTileSetTextureOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -1069,12 +1168,18 @@ func (o *TileSet) TileSetTextureOffset(id gdnative.Int, textureOffset gdnative.V
 
 }
 
+// TileAddShapeWithDefaults will call TileAddShape using the default values for: one_way, autotile_coord.
+func (o *TileSet) TileAddShapeWithDefaults(id gdnative.Int, shape godot.Shape2DImplementer, shapeTransform gdnative.Transform2D) {
+	o.TileAddShape(id, shape, shapeTransform, false, gdnative.NewVector2(0, 0))
+}
+
 // TileSetImplementer is an interface that implements the methods
 // of the TileSet class.
 type TileSetImplementer interface {
-	ResourceImplementer
-	X_ForwardSubtileSelection(autotileId gdnative.Int, bitmask gdnative.Int, tilemap ObjectImplementer, tileLocation gdnative.Vector2) gdnative.Vector2
+	godot.ResourceImplementer
+	X_ForwardSubtileSelection(autotileId gdnative.Int, bitmask gdnative.Int, tilemap godot.ObjectImplementer, tileLocation gdnative.Vector2) gdnative.Vector2
 	X_IsTileBound(drawnId gdnative.Int, neighborId gdnative.Int) gdnative.Bool
+	AutotileGetBitmaskMode(id gdnative.Int) TileSetBitmaskMode
 	AutotileSetBitmaskMode(id gdnative.Int, mode gdnative.Int)
 	Clear()
 	CreateTile(id gdnative.Int)
@@ -1082,34 +1187,35 @@ type TileSetImplementer interface {
 	GetLastUnusedTileId() gdnative.Int
 	GetTilesIds() gdnative.Array
 	RemoveTile(id gdnative.Int)
-	TileAddShape(id gdnative.Int, shape Shape2DImplementer, shapeTransform gdnative.Transform2D, oneWay gdnative.Bool, autotileCoord gdnative.Vector2)
+	TileAddShape(id gdnative.Int, shape godot.Shape2DImplementer, shapeTransform gdnative.Transform2D, oneWay gdnative.Bool, autotileCoord gdnative.Vector2)
 	TileGetLightOccluder(id gdnative.Int) OccluderPolygon2DImplementer
-	TileGetMaterial(id gdnative.Int) ShaderMaterialImplementer
+	TileGetMaterial(id gdnative.Int) shader.ShaderMaterialImplementer
 	TileGetName(id gdnative.Int) gdnative.String
 	TileGetNavigationPolygon(id gdnative.Int) NavigationPolygonImplementer
 	TileGetNavigationPolygonOffset(id gdnative.Int) gdnative.Vector2
-	TileGetNormalMap(id gdnative.Int) TextureImplementer
+	TileGetNormalMap(id gdnative.Int) godot.TextureImplementer
 	TileGetOccluderOffset(id gdnative.Int) gdnative.Vector2
 	TileGetRegion(id gdnative.Int) gdnative.Rect2
-	TileGetShape(id gdnative.Int, shapeId gdnative.Int) Shape2DImplementer
+	TileGetShape(id gdnative.Int, shapeId gdnative.Int) godot.Shape2DImplementer
 	TileGetShapeCount(id gdnative.Int) gdnative.Int
 	TileGetShapeOneWay(id gdnative.Int, shapeId gdnative.Int) gdnative.Bool
 	TileGetShapeTransform(id gdnative.Int, shapeId gdnative.Int) gdnative.Transform2D
 	TileGetShapes(id gdnative.Int) gdnative.Array
-	TileGetTexture(id gdnative.Int) TextureImplementer
+	TileGetTexture(id gdnative.Int) godot.TextureImplementer
 	TileGetTextureOffset(id gdnative.Int) gdnative.Vector2
 	TileSetLightOccluder(id gdnative.Int, lightOccluder OccluderPolygon2DImplementer)
-	TileSetMaterial(id gdnative.Int, material ShaderMaterialImplementer)
+	TileSetMaterial(id gdnative.Int, material shader.ShaderMaterialImplementer)
 	TileSetName(id gdnative.Int, name gdnative.String)
 	TileSetNavigationPolygon(id gdnative.Int, navigationPolygon NavigationPolygonImplementer)
 	TileSetNavigationPolygonOffset(id gdnative.Int, navigationPolygonOffset gdnative.Vector2)
-	TileSetNormalMap(id gdnative.Int, normalMap TextureImplementer)
+	TileSetNormalMap(id gdnative.Int, normalMap godot.TextureImplementer)
 	TileSetOccluderOffset(id gdnative.Int, occluderOffset gdnative.Vector2)
 	TileSetRegion(id gdnative.Int, region gdnative.Rect2)
-	TileSetShape(id gdnative.Int, shapeId gdnative.Int, shape Shape2DImplementer)
+	TileSetShape(id gdnative.Int, shapeId gdnative.Int, shape godot.Shape2DImplementer)
 	TileSetShapeOneWay(id gdnative.Int, shapeId gdnative.Int, oneWay gdnative.Bool)
 	TileSetShapeTransform(id gdnative.Int, shapeId gdnative.Int, shapeTransform gdnative.Transform2D)
 	TileSetShapes(id gdnative.Int, shapes gdnative.Array)
-	TileSetTexture(id gdnative.Int, texture TextureImplementer)
+	TileSetTexture(id gdnative.Int, texture godot.TextureImplementer)
 	TileSetTextureOffset(id gdnative.Int, textureOffset gdnative.Vector2)
+	TileAddShapeWithDefaults(id gdnative.Int, shape godot.Shape2DImplementer, shapeTransform gdnative.Transform2D)
 }
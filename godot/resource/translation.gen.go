@@ -1,7 +1,8 @@
-package godot
+package resource
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,52 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewTranslationFromPointer(ptr gdnative.Pointer) Translation {
-func newTranslationFromPointer(ptr gdnative.Pointer) Translation {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Translation{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Translations are resources that can be loaded/unloaded on demand. They map a string to another string.
 */
 type Translation struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
+// classConstructorTranslation is the class constructor of the Translation class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTranslation = gdnative.NewLazyClassConstructor("Translation")
+
+// NewTranslation will create a new instance of the Translation class.
+// Translation is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewTranslation() *Translation {
+	obj := &Translation{}
+	obj.SetBaseObject(classConstructorTranslation.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Translation) BaseClass() string {
 	return "Translation"
 }
 
+// Method binds of the Translation class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTranslationX_GetMessages   = gdnative.NewLazyMethodBind("Translation", "_get_messages")
+	methodBindTranslationX_SetMessages   = gdnative.NewLazyMethodBind("Translation", "_set_messages")
+	methodBindTranslationAddMessage      = gdnative.NewLazyMethodBind("Translation", "add_message")
+	methodBindTranslationEraseMessage    = gdnative.NewLazyMethodBind("Translation", "erase_message")
+	methodBindTranslationGetLocale       = gdnative.NewLazyMethodBind("Translation", "get_locale")
+	methodBindTranslationGetMessage      = gdnative.NewLazyMethodBind("Translation", "get_message")
+	methodBindTranslationGetMessageCount = gdnative.NewLazyMethodBind("Translation", "get_message_count")
+	methodBindTranslationGetMessageList  = gdnative.NewLazyMethodBind("Translation", "get_message_list")
+	methodBindTranslationSetLocale       = gdnative.NewLazyMethodBind("Translation", "set_locale")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PoolStringArray
+	        Undocumented
+		Args: [], Returns: PoolStringArray
 */
 func (o *Translation) X_GetMessages() gdnative.PoolStringArray {
 	//log.Println("Calling Translation.X_GetMessages()")
@@ -45,7 +68,7 @@ func (o *Translation) X_GetMessages() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "_get_messages")
+	methodBind := methodBindTranslationX_GetMessages.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -58,8 +81,8 @@ func (o *Translation) X_GetMessages() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 PoolStringArray}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 PoolStringArray}], Returns: void
 */
 func (o *Translation) X_SetMessages(arg0 gdnative.PoolStringArray) {
 	//log.Println("Calling Translation.X_SetMessages()")
@@ -69,7 +92,7 @@ func (o *Translation) X_SetMessages(arg0 gdnative.PoolStringArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolStringArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "_set_messages")
+	methodBind := methodBindTranslationX_SetMessages.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +102,8 @@ func (o *Translation) X_SetMessages(arg0 gdnative.PoolStringArray) {
 }
 
 /*
-        Add a message for translation.
-	Args: [{ false src_message String} { false xlated_message String}], Returns: void
+	        Add a message for translation.
+		Args: [{ false src_message String} { false xlated_message String}], Returns: void
 */
 func (o *Translation) AddMessage(srcMessage gdnative.String, xlatedMessage gdnative.String) {
 	//log.Println("Calling Translation.AddMessage()")
@@ -91,7 +114,7 @@ func (o *Translation) AddMessage(srcMessage gdnative.String, xlatedMessage gdnat
 	ptrArguments[1] = gdnative.NewPointerFromString(xlatedMessage)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "add_message")
+	methodBind := methodBindTranslationAddMessage.Get()
 
 	// Call the parent method.
 	// void
@@ -101,8 +124,8 @@ func (o *Translation) AddMessage(srcMessage gdnative.String, xlatedMessage gdnat
 }
 
 /*
-        Erase a message.
-	Args: [{ false src_message String}], Returns: void
+	        Erase a message.
+		Args: [{ false src_message String}], Returns: void
 */
 func (o *Translation) EraseMessage(srcMessage gdnative.String) {
 	//log.Println("Calling Translation.EraseMessage()")
@@ -112,7 +135,7 @@ func (o *Translation) EraseMessage(srcMessage gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(srcMessage)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "erase_message")
+	methodBind := methodBindTranslationEraseMessage.Get()
 
 	// Call the parent method.
 	// void
@@ -122,8 +145,8 @@ func (o *Translation) EraseMessage(srcMessage gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Translation) GetLocale() gdnative.String {
 	//log.Println("Calling Translation.GetLocale()")
@@ -132,7 +155,7 @@ func (o *Translation) GetLocale() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "get_locale")
+	methodBind := methodBindTranslationGetLocale.Get()
 
 	// Call the parent method.
 	// String
@@ -145,8 +168,8 @@ func (o *Translation) GetLocale() gdnative.String {
 }
 
 /*
-        Return a message for translation.
-	Args: [{ false src_message String}], Returns: String
+	        Return a message for translation.
+		Args: [{ false src_message String}], Returns: String
 */
 func (o *Translation) GetMessage(srcMessage gdnative.String) gdnative.String {
 	//log.Println("Calling Translation.GetMessage()")
@@ -156,7 +179,7 @@ func (o *Translation) GetMessage(srcMessage gdnative.String) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromString(srcMessage)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "get_message")
+	methodBind := methodBindTranslationGetMessage.Get()
 
 	// Call the parent method.
 	// String
@@ -169,8 +192,7 @@ func (o *Translation) GetMessage(srcMessage gdnative.String) gdnative.String {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *Translation) GetMessageCount() gdnative.Int {
 	//log.Println("Calling Translation.GetMessageCount()")
@@ -179,7 +201,7 @@ func (o *Translation) GetMessageCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "get_message_count")
+	methodBind := methodBindTranslationGetMessageCount.Get()
 
 	// Call the parent method.
 	// int
@@ -192,8 +214,8 @@ func (o *Translation) GetMessageCount() gdnative.Int {
 }
 
 /*
-        Return all the messages (keys).
-	Args: [], Returns: PoolStringArray
+	        Return all the messages (keys).
+		Args: [], Returns: PoolStringArray
 */
 func (o *Translation) GetMessageList() gdnative.PoolStringArray {
 	//log.Println("Calling Translation.GetMessageList()")
@@ -202,7 +224,7 @@ func (o *Translation) GetMessageList() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "get_message_list")
+	methodBind := methodBindTranslationGetMessageList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -215,8 +237,8 @@ func (o *Translation) GetMessageList() gdnative.PoolStringArray {
 }
 
 /*
-        Undocumented
-	Args: [{ false locale String}], Returns: void
+	        Undocumented
+		Args: [{ false locale String}], Returns: void
 */
 func (o *Translation) SetLocale(locale gdnative.String) {
 	//log.Println("Calling Translation.SetLocale()")
@@ -226,7 +248,7 @@ func (o *Translation) SetLocale(locale gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(locale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Translation", "set_locale")
+	methodBind := methodBindTranslationSetLocale.Get()
 
 	// Call the parent method.
 	// void
@@ -235,10 +257,25 @@ func (o *Translation) SetLocale(locale gdnative.String) {
 
 }
 
+// Locale will return the value of the "locale" property.
+func (o *Translation) Locale() gdnative.String {
+	return o.GetLocale()
+}
+
+// Messages will return the value of the "messages" property.
+func (o *Translation) Messages() gdnative.PoolStringArray {
+	return o.X_GetMessages()
+}
+
+// SetMessages will set the value of the "messages" property.
+func (o *Translation) SetMessages(value gdnative.PoolStringArray) {
+	o.X_SetMessages(value)
+}
+
 // TranslationImplementer is an interface that implements the methods
 // of the Translation class.
 type TranslationImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetMessages() gdnative.PoolStringArray
 	X_SetMessages(arg0 gdnative.PoolStringArray)
 	AddMessage(srcMessage gdnative.String, xlatedMessage gdnative.String)
@@ -248,4 +285,7 @@ type TranslationImplementer interface {
 	GetMessageCount() gdnative.Int
 	GetMessageList() gdnative.PoolStringArray
 	SetLocale(locale gdnative.String)
+	Locale() gdnative.String
+	Messages() gdnative.PoolStringArray
+	SetMessages(value gdnative.PoolStringArray)
 }
@@ -0,0 +1,47 @@
+package godot
+
+import (
+	"fmt"
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+// RPCConfigurer is an interface for classes registered with AutoRegister that
+// can be called over the network with Godot's high-level multiplayer API, like
+// `rpc` and `rpc_id`. Methods that are not listed are registered with
+// MethodRpcModeDisabled.
+type RPCConfigurer interface {
+	// RPCModes returns the RPC mode of methods, keyed by Go method name.
+	RPCModes() map[string]gdnative.MethodRpcMode
+}
+
+// classRPCModes will return a copy of the RPC modes of the methods of the given
+// class, or an empty map if it does not implement RPCConfigurer. A copy is made
+// so that registration can remove the modes it has used without changing a map
+// that belongs to the class.
+func classRPCModes(class Class) map[string]gdnative.MethodRpcMode {
+	modes := map[string]gdnative.MethodRpcMode{}
+	if configurer, ok := class.(RPCConfigurer); ok {
+		for method, mode := range configurer.RPCModes() {
+			modes[method] = mode
+		}
+	}
+	return modes
+}
+
+// checkRPCMethod will return an error if the arguments of the given method can't
+// be sent over the network. Objects only exist on the peer that created them, so
+// they can't be used as RPC arguments.
+func checkRPCMethod(method *registeredMethod) error {
+	// The first argument of the method is the receiver.
+	for i, argType := range method.arguments[1:] {
+		argVariantType, ok := variantTypeOf(argType)
+		if !ok {
+			return fmt.Errorf("argument %d of type %s can't be converted from a Variant", i, argType)
+		}
+		if argVariantType == gdnative.VariantTypeObject {
+			return fmt.Errorf("argument %d of type %s can't be sent over the network", i, argType)
+		}
+	}
+
+	return nil
+}
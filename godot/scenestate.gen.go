@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,13 +24,30 @@ const (
 	SceneStateGenEditStateMain     SceneStateGenEditState = 2
 )
 
-//func NewSceneStateFromPointer(ptr gdnative.Pointer) SceneState {
-func newSceneStateFromPointer(ptr gdnative.Pointer) SceneState {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SceneState{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the SceneStateGenEditState value.
+func (e SceneStateGenEditState) String() string {
+	switch e {
+	case SceneStateGenEditStateDisabled:
+		return "GEN_EDIT_STATE_DISABLED"
+	case SceneStateGenEditStateInstance:
+		return "GEN_EDIT_STATE_INSTANCE"
+	case SceneStateGenEditStateMain:
+		return "GEN_EDIT_STATE_MAIN"
+	}
+	return fmt.Sprintf("SceneStateGenEditState(%d)", int(e))
+}
 
-	return obj
+// ParseSceneStateGenEditState will return the SceneStateGenEditState value with the given Godot name.
+func ParseSceneStateGenEditState(name string) (SceneStateGenEditState, error) {
+	switch name {
+	case "GEN_EDIT_STATE_DISABLED":
+		return SceneStateGenEditStateDisabled, nil
+	case "GEN_EDIT_STATE_INSTANCE":
+		return SceneStateGenEditStateInstance, nil
+	case "GEN_EDIT_STATE_MAIN":
+		return SceneStateGenEditStateMain, nil
+	}
+	return 0, fmt.Errorf("invalid SceneStateGenEditState value %q", name)
 }
 
 /*
@@ -43,9 +62,34 @@ func (o *SceneState) BaseClass() string {
 	return "SceneState"
 }
 
+// Method binds of the SceneState class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSceneStateGetConnectionBinds         = gdnative.NewLazyMethodBind("SceneState", "get_connection_binds")
+	methodBindSceneStateGetConnectionCount         = gdnative.NewLazyMethodBind("SceneState", "get_connection_count")
+	methodBindSceneStateGetConnectionFlags         = gdnative.NewLazyMethodBind("SceneState", "get_connection_flags")
+	methodBindSceneStateGetConnectionMethod        = gdnative.NewLazyMethodBind("SceneState", "get_connection_method")
+	methodBindSceneStateGetConnectionSignal        = gdnative.NewLazyMethodBind("SceneState", "get_connection_signal")
+	methodBindSceneStateGetConnectionSource        = gdnative.NewLazyMethodBind("SceneState", "get_connection_source")
+	methodBindSceneStateGetConnectionTarget        = gdnative.NewLazyMethodBind("SceneState", "get_connection_target")
+	methodBindSceneStateGetNodeCount               = gdnative.NewLazyMethodBind("SceneState", "get_node_count")
+	methodBindSceneStateGetNodeGroups              = gdnative.NewLazyMethodBind("SceneState", "get_node_groups")
+	methodBindSceneStateGetNodeIndex               = gdnative.NewLazyMethodBind("SceneState", "get_node_index")
+	methodBindSceneStateGetNodeInstance            = gdnative.NewLazyMethodBind("SceneState", "get_node_instance")
+	methodBindSceneStateGetNodeInstancePlaceholder = gdnative.NewLazyMethodBind("SceneState", "get_node_instance_placeholder")
+	methodBindSceneStateGetNodeName                = gdnative.NewLazyMethodBind("SceneState", "get_node_name")
+	methodBindSceneStateGetNodeOwnerPath           = gdnative.NewLazyMethodBind("SceneState", "get_node_owner_path")
+	methodBindSceneStateGetNodePath                = gdnative.NewLazyMethodBind("SceneState", "get_node_path")
+	methodBindSceneStateGetNodePropertyCount       = gdnative.NewLazyMethodBind("SceneState", "get_node_property_count")
+	methodBindSceneStateGetNodePropertyName        = gdnative.NewLazyMethodBind("SceneState", "get_node_property_name")
+	methodBindSceneStateGetNodePropertyValue       = gdnative.NewLazyMethodBind("SceneState", "get_node_property_value")
+	methodBindSceneStateGetNodeType                = gdnative.NewLazyMethodBind("SceneState", "get_node_type")
+	methodBindSceneStateIsNodeInstancePlaceholder  = gdnative.NewLazyMethodBind("SceneState", "is_node_instance_placeholder")
+)
+
 /*
-        Returns the list of bound parameters for the signal at [code]idx[/code].
-	Args: [{ false idx int}], Returns: Array
+	        Returns the list of bound parameters for the signal at [code]idx[/code].
+		Args: [{ false idx int}], Returns: Array
 */
 func (o *SceneState) GetConnectionBinds(idx gdnative.Int) gdnative.Array {
 	//log.Println("Calling SceneState.GetConnectionBinds()")
@@ -55,7 +99,7 @@ func (o *SceneState) GetConnectionBinds(idx gdnative.Int) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_connection_binds")
+	methodBind := methodBindSceneStateGetConnectionBinds.Get()
 
 	// Call the parent method.
 	// Array
@@ -68,8 +112,8 @@ func (o *SceneState) GetConnectionBinds(idx gdnative.Int) gdnative.Array {
 }
 
 /*
-        Returns the number of signal connections in the scene.
-	Args: [], Returns: int
+	        Returns the number of signal connections in the scene.
+		Args: [], Returns: int
 */
 func (o *SceneState) GetConnectionCount() gdnative.Int {
 	//log.Println("Calling SceneState.GetConnectionCount()")
@@ -78,7 +122,7 @@ func (o *SceneState) GetConnectionCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_connection_count")
+	methodBind := methodBindSceneStateGetConnectionCount.Get()
 
 	// Call the parent method.
 	// int
@@ -91,8 +135,8 @@ func (o *SceneState) GetConnectionCount() gdnative.Int {
 }
 
 /*
-        Returns the flags for the signal at [code]idx[/code]. See [Object]'s [code]CONNECT_*[/code] flags.
-	Args: [{ false idx int}], Returns: int
+	        Returns the flags for the signal at [code]idx[/code]. See [Object]'s [code]CONNECT_*[/code] flags.
+		Args: [{ false idx int}], Returns: int
 */
 func (o *SceneState) GetConnectionFlags(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling SceneState.GetConnectionFlags()")
@@ -102,7 +146,7 @@ func (o *SceneState) GetConnectionFlags(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_connection_flags")
+	methodBind := methodBindSceneStateGetConnectionFlags.Get()
 
 	// Call the parent method.
 	// int
@@ -115,8 +159,8 @@ func (o *SceneState) GetConnectionFlags(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the method connected to the signal at [code]idx[/code].
-	Args: [{ false idx int}], Returns: String
+	        Returns the method connected to the signal at [code]idx[/code].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *SceneState) GetConnectionMethod(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling SceneState.GetConnectionMethod()")
@@ -126,7 +170,7 @@ func (o *SceneState) GetConnectionMethod(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_connection_method")
+	methodBind := methodBindSceneStateGetConnectionMethod.Get()
 
 	// Call the parent method.
 	// String
@@ -139,8 +183,8 @@ func (o *SceneState) GetConnectionMethod(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the name of the signal at [code]idx[/code].
-	Args: [{ false idx int}], Returns: String
+	        Returns the name of the signal at [code]idx[/code].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *SceneState) GetConnectionSignal(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling SceneState.GetConnectionSignal()")
@@ -150,7 +194,7 @@ func (o *SceneState) GetConnectionSignal(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_connection_signal")
+	methodBind := methodBindSceneStateGetConnectionSignal.Get()
 
 	// Call the parent method.
 	// String
@@ -163,8 +207,8 @@ func (o *SceneState) GetConnectionSignal(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the path to the node that owns the signal at [code]idx[/code], relative to the root node.
-	Args: [{ false idx int}], Returns: NodePath
+	        Returns the path to the node that owns the signal at [code]idx[/code], relative to the root node.
+		Args: [{ false idx int}], Returns: NodePath
 */
 func (o *SceneState) GetConnectionSource(idx gdnative.Int) gdnative.NodePath {
 	//log.Println("Calling SceneState.GetConnectionSource()")
@@ -174,7 +218,7 @@ func (o *SceneState) GetConnectionSource(idx gdnative.Int) gdnative.NodePath {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_connection_source")
+	methodBind := methodBindSceneStateGetConnectionSource.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -187,8 +231,8 @@ func (o *SceneState) GetConnectionSource(idx gdnative.Int) gdnative.NodePath {
 }
 
 /*
-        Returns the path to the node that owns the method connected to the signal at [code]idx[/code], relative to the root node.
-	Args: [{ false idx int}], Returns: NodePath
+	        Returns the path to the node that owns the method connected to the signal at [code]idx[/code], relative to the root node.
+		Args: [{ false idx int}], Returns: NodePath
 */
 func (o *SceneState) GetConnectionTarget(idx gdnative.Int) gdnative.NodePath {
 	//log.Println("Calling SceneState.GetConnectionTarget()")
@@ -198,7 +242,7 @@ func (o *SceneState) GetConnectionTarget(idx gdnative.Int) gdnative.NodePath {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_connection_target")
+	methodBind := methodBindSceneStateGetConnectionTarget.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -211,8 +255,8 @@ func (o *SceneState) GetConnectionTarget(idx gdnative.Int) gdnative.NodePath {
 }
 
 /*
-        Returns the number of nodes in the scene.
-	Args: [], Returns: int
+	        Returns the number of nodes in the scene.
+		Args: [], Returns: int
 */
 func (o *SceneState) GetNodeCount() gdnative.Int {
 	//log.Println("Calling SceneState.GetNodeCount()")
@@ -221,7 +265,7 @@ func (o *SceneState) GetNodeCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_count")
+	methodBind := methodBindSceneStateGetNodeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -234,8 +278,8 @@ func (o *SceneState) GetNodeCount() gdnative.Int {
 }
 
 /*
-        Returns the list of group names associated with the node at [code]idx[/code].
-	Args: [{ false idx int}], Returns: PoolStringArray
+	        Returns the list of group names associated with the node at [code]idx[/code].
+		Args: [{ false idx int}], Returns: PoolStringArray
 */
 func (o *SceneState) GetNodeGroups(idx gdnative.Int) gdnative.PoolStringArray {
 	//log.Println("Calling SceneState.GetNodeGroups()")
@@ -245,7 +289,7 @@ func (o *SceneState) GetNodeGroups(idx gdnative.Int) gdnative.PoolStringArray {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_groups")
+	methodBind := methodBindSceneStateGetNodeGroups.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -258,8 +302,7 @@ func (o *SceneState) GetNodeGroups(idx gdnative.Int) gdnative.PoolStringArray {
 }
 
 /*
-
-	Args: [{ false idx int}], Returns: int
+Args: [{ false idx int}], Returns: int
 */
 func (o *SceneState) GetNodeIndex(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling SceneState.GetNodeIndex()")
@@ -269,7 +312,7 @@ func (o *SceneState) GetNodeIndex(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_index")
+	methodBind := methodBindSceneStateGetNodeIndex.Get()
 
 	// Call the parent method.
 	// int
@@ -282,8 +325,8 @@ func (o *SceneState) GetNodeIndex(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the scene for the node at [code]idx[/code] or [code]null[/code] if the node is not an instance.
-	Args: [{ false idx int}], Returns: PackedScene
+	        Returns the scene for the node at [code]idx[/code] or [code]null[/code] if the node is not an instance.
+		Args: [{ false idx int}], Returns: PackedScene
 */
 func (o *SceneState) GetNodeInstance(idx gdnative.Int) PackedSceneImplementer {
 	//log.Println("Calling SceneState.GetNodeInstance()")
@@ -293,7 +336,7 @@ func (o *SceneState) GetNodeInstance(idx gdnative.Int) PackedSceneImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_instance")
+	methodBind := methodBindSceneStateGetNodeInstance.Get()
 
 	// Call the parent method.
 	// PackedScene
@@ -301,27 +344,25 @@ func (o *SceneState) GetNodeInstance(idx gdnative.Int) PackedSceneImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPackedSceneFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PackedSceneImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PackedScene" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PackedSceneImplementer)
+	ret := PackedScene{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(PackedSceneImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the path to the represented scene file if the node at [code]idx[/code] is an [InstancePlaceholder].
-	Args: [{ false idx int}], Returns: String
+	        Returns the path to the represented scene file if the node at [code]idx[/code] is an [InstancePlaceholder].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *SceneState) GetNodeInstancePlaceholder(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling SceneState.GetNodeInstancePlaceholder()")
@@ -331,7 +372,7 @@ func (o *SceneState) GetNodeInstancePlaceholder(idx gdnative.Int) gdnative.Strin
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_instance_placeholder")
+	methodBind := methodBindSceneStateGetNodeInstancePlaceholder.Get()
 
 	// Call the parent method.
 	// String
@@ -344,8 +385,8 @@ func (o *SceneState) GetNodeInstancePlaceholder(idx gdnative.Int) gdnative.Strin
 }
 
 /*
-        Returns the name of the node at [code]idx[/code].
-	Args: [{ false idx int}], Returns: String
+	        Returns the name of the node at [code]idx[/code].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *SceneState) GetNodeName(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling SceneState.GetNodeName()")
@@ -355,7 +396,7 @@ func (o *SceneState) GetNodeName(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_name")
+	methodBind := methodBindSceneStateGetNodeName.Get()
 
 	// Call the parent method.
 	// String
@@ -368,8 +409,8 @@ func (o *SceneState) GetNodeName(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the path to the owner of the node at [code]idx[/code], relative to the root node.
-	Args: [{ false idx int}], Returns: NodePath
+	        Returns the path to the owner of the node at [code]idx[/code], relative to the root node.
+		Args: [{ false idx int}], Returns: NodePath
 */
 func (o *SceneState) GetNodeOwnerPath(idx gdnative.Int) gdnative.NodePath {
 	//log.Println("Calling SceneState.GetNodeOwnerPath()")
@@ -379,7 +420,7 @@ func (o *SceneState) GetNodeOwnerPath(idx gdnative.Int) gdnative.NodePath {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_owner_path")
+	methodBind := methodBindSceneStateGetNodeOwnerPath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -392,8 +433,8 @@ func (o *SceneState) GetNodeOwnerPath(idx gdnative.Int) gdnative.NodePath {
 }
 
 /*
-        Returns the path to the node at [code]idx[/code].
-	Args: [{ false idx int} {False true for_parent bool}], Returns: NodePath
+	        Returns the path to the node at [code]idx[/code].
+		Args: [{ false idx int} {False true for_parent bool}], Returns: NodePath
 */
 func (o *SceneState) GetNodePath(idx gdnative.Int, forParent gdnative.Bool) gdnative.NodePath {
 	//log.Println("Calling SceneState.GetNodePath()")
@@ -404,7 +445,7 @@ func (o *SceneState) GetNodePath(idx gdnative.Int, forParent gdnative.Bool) gdna
 	ptrArguments[1] = gdnative.NewPointerFromBool(forParent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_path")
+	methodBind := methodBindSceneStateGetNodePath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -417,8 +458,8 @@ func (o *SceneState) GetNodePath(idx gdnative.Int, forParent gdnative.Bool) gdna
 }
 
 /*
-        Returns the number of exported or overridden properties for the node at [code]idx[/code].
-	Args: [{ false idx int}], Returns: int
+	        Returns the number of exported or overridden properties for the node at [code]idx[/code].
+		Args: [{ false idx int}], Returns: int
 */
 func (o *SceneState) GetNodePropertyCount(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling SceneState.GetNodePropertyCount()")
@@ -428,7 +469,7 @@ func (o *SceneState) GetNodePropertyCount(idx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_property_count")
+	methodBind := methodBindSceneStateGetNodePropertyCount.Get()
 
 	// Call the parent method.
 	// int
@@ -441,8 +482,8 @@ func (o *SceneState) GetNodePropertyCount(idx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the name of the property at [code]prop_idx[/code] for the node at [code]idx[/code].
-	Args: [{ false idx int} { false prop_idx int}], Returns: String
+	        Returns the name of the property at [code]prop_idx[/code] for the node at [code]idx[/code].
+		Args: [{ false idx int} { false prop_idx int}], Returns: String
 */
 func (o *SceneState) GetNodePropertyName(idx gdnative.Int, propIdx gdnative.Int) gdnative.String {
 	//log.Println("Calling SceneState.GetNodePropertyName()")
@@ -453,7 +494,7 @@ func (o *SceneState) GetNodePropertyName(idx gdnative.Int, propIdx gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(propIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_property_name")
+	methodBind := methodBindSceneStateGetNodePropertyName.Get()
 
 	// Call the parent method.
 	// String
@@ -466,8 +507,8 @@ func (o *SceneState) GetNodePropertyName(idx gdnative.Int, propIdx gdnative.Int)
 }
 
 /*
-        Returns the value of the property at [code]prop_idx[/code] for the node at [code]idx[/code].
-	Args: [{ false idx int} { false prop_idx int}], Returns: Variant
+	        Returns the value of the property at [code]prop_idx[/code] for the node at [code]idx[/code].
+		Args: [{ false idx int} { false prop_idx int}], Returns: Variant
 */
 func (o *SceneState) GetNodePropertyValue(idx gdnative.Int, propIdx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling SceneState.GetNodePropertyValue()")
@@ -478,7 +519,7 @@ func (o *SceneState) GetNodePropertyValue(idx gdnative.Int, propIdx gdnative.Int
 	ptrArguments[1] = gdnative.NewPointerFromInt(propIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_property_value")
+	methodBind := methodBindSceneStateGetNodePropertyValue.Get()
 
 	// Call the parent method.
 	// Variant
@@ -491,8 +532,8 @@ func (o *SceneState) GetNodePropertyValue(idx gdnative.Int, propIdx gdnative.Int
 }
 
 /*
-        Returns the type of the node at [code]idx[/code].
-	Args: [{ false idx int}], Returns: String
+	        Returns the type of the node at [code]idx[/code].
+		Args: [{ false idx int}], Returns: String
 */
 func (o *SceneState) GetNodeType(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling SceneState.GetNodeType()")
@@ -502,7 +543,7 @@ func (o *SceneState) GetNodeType(idx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "get_node_type")
+	methodBind := methodBindSceneStateGetNodeType.Get()
 
 	// Call the parent method.
 	// String
@@ -515,8 +556,8 @@ func (o *SceneState) GetNodeType(idx gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns [code]true[/code] if the node at [code]idx[/code] is an [InstancePlaceholder].
-	Args: [{ false idx int}], Returns: bool
+	        Returns [code]true[/code] if the node at [code]idx[/code] is an [InstancePlaceholder].
+		Args: [{ false idx int}], Returns: bool
 */
 func (o *SceneState) IsNodeInstancePlaceholder(idx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling SceneState.IsNodeInstancePlaceholder()")
@@ -526,7 +567,7 @@ func (o *SceneState) IsNodeInstancePlaceholder(idx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneState", "is_node_instance_placeholder")
+	methodBind := methodBindSceneStateIsNodeInstancePlaceholder.Get()
 
 	// Call the parent method.
 	// bool
@@ -538,6 +579,11 @@ func (o *SceneState) IsNodeInstancePlaceholder(idx gdnative.Int) gdnative.Bool {
 	return ret
 }
 
+// GetNodePathWithDefaults will call GetNodePath using the default values for: for_parent.
+func (o *SceneState) GetNodePathWithDefaults(idx gdnative.Int) gdnative.NodePath {
+	return o.GetNodePath(idx, false)
+}
+
 // SceneStateImplementer is an interface that implements the methods
 // of the SceneState class.
 type SceneStateImplementer interface {
@@ -562,4 +608,5 @@ type SceneStateImplementer interface {
 	GetNodePropertyValue(idx gdnative.Int, propIdx gdnative.Int) gdnative.Variant
 	GetNodeType(idx gdnative.Int) gdnative.String
 	IsNodeInstancePlaceholder(idx gdnative.Int) gdnative.Bool
+	GetNodePathWithDefaults(idx gdnative.Int) gdnative.NodePath
 }
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -23,6 +25,36 @@ const (
 	SceneTreeGroupCallUnique   SceneTreeGroupCallFlags = 4
 )
 
+// String will return the Godot name of the SceneTreeGroupCallFlags value.
+func (e SceneTreeGroupCallFlags) String() string {
+	switch e {
+	case SceneTreeGroupCallDefault:
+		return "GROUP_CALL_DEFAULT"
+	case SceneTreeGroupCallRealtime:
+		return "GROUP_CALL_REALTIME"
+	case SceneTreeGroupCallReverse:
+		return "GROUP_CALL_REVERSE"
+	case SceneTreeGroupCallUnique:
+		return "GROUP_CALL_UNIQUE"
+	}
+	return fmt.Sprintf("SceneTreeGroupCallFlags(%d)", int(e))
+}
+
+// ParseSceneTreeGroupCallFlags will return the SceneTreeGroupCallFlags value with the given Godot name.
+func ParseSceneTreeGroupCallFlags(name string) (SceneTreeGroupCallFlags, error) {
+	switch name {
+	case "GROUP_CALL_DEFAULT":
+		return SceneTreeGroupCallDefault, nil
+	case "GROUP_CALL_REALTIME":
+		return SceneTreeGroupCallRealtime, nil
+	case "GROUP_CALL_REVERSE":
+		return SceneTreeGroupCallReverse, nil
+	case "GROUP_CALL_UNIQUE":
+		return SceneTreeGroupCallUnique, nil
+	}
+	return 0, fmt.Errorf("invalid SceneTreeGroupCallFlags value %q", name)
+}
+
 // SceneTreeStretchAspect is an enum for StretchAspect values.
 type SceneTreeStretchAspect int
 
@@ -34,6 +66,40 @@ const (
 	SceneTreeStretchAspectKeepWidth  SceneTreeStretchAspect = 2
 )
 
+// String will return the Godot name of the SceneTreeStretchAspect value.
+func (e SceneTreeStretchAspect) String() string {
+	switch e {
+	case SceneTreeStretchAspectExpand:
+		return "STRETCH_ASPECT_EXPAND"
+	case SceneTreeStretchAspectIgnore:
+		return "STRETCH_ASPECT_IGNORE"
+	case SceneTreeStretchAspectKeep:
+		return "STRETCH_ASPECT_KEEP"
+	case SceneTreeStretchAspectKeepHeight:
+		return "STRETCH_ASPECT_KEEP_HEIGHT"
+	case SceneTreeStretchAspectKeepWidth:
+		return "STRETCH_ASPECT_KEEP_WIDTH"
+	}
+	return fmt.Sprintf("SceneTreeStretchAspect(%d)", int(e))
+}
+
+// ParseSceneTreeStretchAspect will return the SceneTreeStretchAspect value with the given Godot name.
+func ParseSceneTreeStretchAspect(name string) (SceneTreeStretchAspect, error) {
+	switch name {
+	case "STRETCH_ASPECT_EXPAND":
+		return SceneTreeStretchAspectExpand, nil
+	case "STRETCH_ASPECT_IGNORE":
+		return SceneTreeStretchAspectIgnore, nil
+	case "STRETCH_ASPECT_KEEP":
+		return SceneTreeStretchAspectKeep, nil
+	case "STRETCH_ASPECT_KEEP_HEIGHT":
+		return SceneTreeStretchAspectKeepHeight, nil
+	case "STRETCH_ASPECT_KEEP_WIDTH":
+		return SceneTreeStretchAspectKeepWidth, nil
+	}
+	return 0, fmt.Errorf("invalid SceneTreeStretchAspect value %q", name)
+}
+
 // SceneTreeStretchMode is an enum for StretchMode values.
 type SceneTreeStretchMode int
 
@@ -43,40 +109,124 @@ const (
 	SceneTreeStretchModeViewport SceneTreeStretchMode = 2
 )
 
-//func NewSceneTreeFromPointer(ptr gdnative.Pointer) SceneTree {
-func newSceneTreeFromPointer(ptr gdnative.Pointer) SceneTree {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SceneTree{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the SceneTreeStretchMode value.
+func (e SceneTreeStretchMode) String() string {
+	switch e {
+	case SceneTreeStretchMode2D:
+		return "STRETCH_MODE_2D"
+	case SceneTreeStretchModeDisabled:
+		return "STRETCH_MODE_DISABLED"
+	case SceneTreeStretchModeViewport:
+		return "STRETCH_MODE_VIEWPORT"
+	}
+	return fmt.Sprintf("SceneTreeStretchMode(%d)", int(e))
+}
 
-	return obj
+// ParseSceneTreeStretchMode will return the SceneTreeStretchMode value with the given Godot name.
+func ParseSceneTreeStretchMode(name string) (SceneTreeStretchMode, error) {
+	switch name {
+	case "STRETCH_MODE_2D":
+		return SceneTreeStretchMode2D, nil
+	case "STRETCH_MODE_DISABLED":
+		return SceneTreeStretchModeDisabled, nil
+	case "STRETCH_MODE_VIEWPORT":
+		return SceneTreeStretchModeViewport, nil
+	}
+	return 0, fmt.Errorf("invalid SceneTreeStretchMode value %q", name)
 }
 
 /*
-
  */
 type SceneTree struct {
 	MainLoop
 	owner gdnative.Object
 }
 
+// classConstructorSceneTree is the class constructor of the SceneTree class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSceneTree = gdnative.NewLazyClassConstructor("SceneTree")
+
+// NewSceneTree will create a new instance of the SceneTree class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSceneTree() *SceneTree {
+	obj := &SceneTree{}
+	obj.SetBaseObject(classConstructorSceneTree.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SceneTree) BaseClass() string {
 	return "SceneTree"
 }
 
+// Method binds of the SceneTree class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSceneTreeX_ChangeScene                   = gdnative.NewLazyMethodBind("SceneTree", "_change_scene")
+	methodBindSceneTreeX_ConnectedToServer             = gdnative.NewLazyMethodBind("SceneTree", "_connected_to_server")
+	methodBindSceneTreeX_ConnectionFailed              = gdnative.NewLazyMethodBind("SceneTree", "_connection_failed")
+	methodBindSceneTreeX_NetworkPeerConnected          = gdnative.NewLazyMethodBind("SceneTree", "_network_peer_connected")
+	methodBindSceneTreeX_NetworkPeerDisconnected       = gdnative.NewLazyMethodBind("SceneTree", "_network_peer_disconnected")
+	methodBindSceneTreeX_ServerDisconnected            = gdnative.NewLazyMethodBind("SceneTree", "_server_disconnected")
+	methodBindSceneTreeCallGroup                       = gdnative.NewLazyMethodBind("SceneTree", "call_group")
+	methodBindSceneTreeCallGroupFlags                  = gdnative.NewLazyMethodBind("SceneTree", "call_group_flags")
+	methodBindSceneTreeChangeScene                     = gdnative.NewLazyMethodBind("SceneTree", "change_scene")
+	methodBindSceneTreeChangeSceneTo                   = gdnative.NewLazyMethodBind("SceneTree", "change_scene_to")
+	methodBindSceneTreeCreateTimer                     = gdnative.NewLazyMethodBind("SceneTree", "create_timer")
+	methodBindSceneTreeGetCurrentScene                 = gdnative.NewLazyMethodBind("SceneTree", "get_current_scene")
+	methodBindSceneTreeGetEditedSceneRoot              = gdnative.NewLazyMethodBind("SceneTree", "get_edited_scene_root")
+	methodBindSceneTreeGetFrame                        = gdnative.NewLazyMethodBind("SceneTree", "get_frame")
+	methodBindSceneTreeGetNetworkConnectedPeers        = gdnative.NewLazyMethodBind("SceneTree", "get_network_connected_peers")
+	methodBindSceneTreeGetNetworkPeer                  = gdnative.NewLazyMethodBind("SceneTree", "get_network_peer")
+	methodBindSceneTreeGetNetworkUniqueId              = gdnative.NewLazyMethodBind("SceneTree", "get_network_unique_id")
+	methodBindSceneTreeGetNodeCount                    = gdnative.NewLazyMethodBind("SceneTree", "get_node_count")
+	methodBindSceneTreeGetNodesInGroup                 = gdnative.NewLazyMethodBind("SceneTree", "get_nodes_in_group")
+	methodBindSceneTreeGetRoot                         = gdnative.NewLazyMethodBind("SceneTree", "get_root")
+	methodBindSceneTreeGetRpcSenderId                  = gdnative.NewLazyMethodBind("SceneTree", "get_rpc_sender_id")
+	methodBindSceneTreeHasGroup                        = gdnative.NewLazyMethodBind("SceneTree", "has_group")
+	methodBindSceneTreeHasNetworkPeer                  = gdnative.NewLazyMethodBind("SceneTree", "has_network_peer")
+	methodBindSceneTreeIsDebuggingCollisionsHint       = gdnative.NewLazyMethodBind("SceneTree", "is_debugging_collisions_hint")
+	methodBindSceneTreeIsDebuggingNavigationHint       = gdnative.NewLazyMethodBind("SceneTree", "is_debugging_navigation_hint")
+	methodBindSceneTreeIsInputHandled                  = gdnative.NewLazyMethodBind("SceneTree", "is_input_handled")
+	methodBindSceneTreeIsNetworkServer                 = gdnative.NewLazyMethodBind("SceneTree", "is_network_server")
+	methodBindSceneTreeIsPaused                        = gdnative.NewLazyMethodBind("SceneTree", "is_paused")
+	methodBindSceneTreeIsRefusingNewNetworkConnections = gdnative.NewLazyMethodBind("SceneTree", "is_refusing_new_network_connections")
+	methodBindSceneTreeIsUsingFontOversampling         = gdnative.NewLazyMethodBind("SceneTree", "is_using_font_oversampling")
+	methodBindSceneTreeNotifyGroup                     = gdnative.NewLazyMethodBind("SceneTree", "notify_group")
+	methodBindSceneTreeNotifyGroupFlags                = gdnative.NewLazyMethodBind("SceneTree", "notify_group_flags")
+	methodBindSceneTreeQueueDelete                     = gdnative.NewLazyMethodBind("SceneTree", "queue_delete")
+	methodBindSceneTreeQuit                            = gdnative.NewLazyMethodBind("SceneTree", "quit")
+	methodBindSceneTreeReloadCurrentScene              = gdnative.NewLazyMethodBind("SceneTree", "reload_current_scene")
+	methodBindSceneTreeSetAutoAcceptQuit               = gdnative.NewLazyMethodBind("SceneTree", "set_auto_accept_quit")
+	methodBindSceneTreeSetCurrentScene                 = gdnative.NewLazyMethodBind("SceneTree", "set_current_scene")
+	methodBindSceneTreeSetDebugCollisionsHint          = gdnative.NewLazyMethodBind("SceneTree", "set_debug_collisions_hint")
+	methodBindSceneTreeSetDebugNavigationHint          = gdnative.NewLazyMethodBind("SceneTree", "set_debug_navigation_hint")
+	methodBindSceneTreeSetEditedSceneRoot              = gdnative.NewLazyMethodBind("SceneTree", "set_edited_scene_root")
+	methodBindSceneTreeSetGroup                        = gdnative.NewLazyMethodBind("SceneTree", "set_group")
+	methodBindSceneTreeSetGroupFlags                   = gdnative.NewLazyMethodBind("SceneTree", "set_group_flags")
+	methodBindSceneTreeSetInputAsHandled               = gdnative.NewLazyMethodBind("SceneTree", "set_input_as_handled")
+	methodBindSceneTreeSetNetworkPeer                  = gdnative.NewLazyMethodBind("SceneTree", "set_network_peer")
+	methodBindSceneTreeSetPause                        = gdnative.NewLazyMethodBind("SceneTree", "set_pause")
+	methodBindSceneTreeSetQuitOnGoBack                 = gdnative.NewLazyMethodBind("SceneTree", "set_quit_on_go_back")
+	methodBindSceneTreeSetRefuseNewNetworkConnections  = gdnative.NewLazyMethodBind("SceneTree", "set_refuse_new_network_connections")
+	methodBindSceneTreeSetScreenStretch                = gdnative.NewLazyMethodBind("SceneTree", "set_screen_stretch")
+	methodBindSceneTreeSetUseFontOversampling          = gdnative.NewLazyMethodBind("SceneTree", "set_use_font_oversampling")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 Object}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
 */
 func (o *SceneTree) X_ChangeScene(arg0 ObjectImplementer) {
 	//log.Println("Calling SceneTree.X_ChangeScene()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "_change_scene")
+	methodBind := methodBindSceneTreeX_ChangeScene.Get()
 
 	// Call the parent method.
 	// void
@@ -86,8 +236,8 @@ func (o *SceneTree) X_ChangeScene(arg0 ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *SceneTree) X_ConnectedToServer() {
 	//log.Println("Calling SceneTree.X_ConnectedToServer()")
@@ -96,7 +246,7 @@ func (o *SceneTree) X_ConnectedToServer() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "_connected_to_server")
+	methodBind := methodBindSceneTreeX_ConnectedToServer.Get()
 
 	// Call the parent method.
 	// void
@@ -106,8 +256,8 @@ func (o *SceneTree) X_ConnectedToServer() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *SceneTree) X_ConnectionFailed() {
 	//log.Println("Calling SceneTree.X_ConnectionFailed()")
@@ -116,7 +266,7 @@ func (o *SceneTree) X_ConnectionFailed() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "_connection_failed")
+	methodBind := methodBindSceneTreeX_ConnectionFailed.Get()
 
 	// Call the parent method.
 	// void
@@ -126,8 +276,8 @@ func (o *SceneTree) X_ConnectionFailed() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *SceneTree) X_NetworkPeerConnected(arg0 gdnative.Int) {
 	//log.Println("Calling SceneTree.X_NetworkPeerConnected()")
@@ -137,7 +287,7 @@ func (o *SceneTree) X_NetworkPeerConnected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "_network_peer_connected")
+	methodBind := methodBindSceneTreeX_NetworkPeerConnected.Get()
 
 	// Call the parent method.
 	// void
@@ -147,8 +297,8 @@ func (o *SceneTree) X_NetworkPeerConnected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *SceneTree) X_NetworkPeerDisconnected(arg0 gdnative.Int) {
 	//log.Println("Calling SceneTree.X_NetworkPeerDisconnected()")
@@ -158,7 +308,7 @@ func (o *SceneTree) X_NetworkPeerDisconnected(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "_network_peer_disconnected")
+	methodBind := methodBindSceneTreeX_NetworkPeerDisconnected.Get()
 
 	// Call the parent method.
 	// void
@@ -168,8 +318,8 @@ func (o *SceneTree) X_NetworkPeerDisconnected(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *SceneTree) X_ServerDisconnected() {
 	//log.Println("Calling SceneTree.X_ServerDisconnected()")
@@ -178,7 +328,7 @@ func (o *SceneTree) X_ServerDisconnected() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "_server_disconnected")
+	methodBind := methodBindSceneTreeX_ServerDisconnected.Get()
 
 	// Call the parent method.
 	// void
@@ -188,59 +338,64 @@ func (o *SceneTree) X_ServerDisconnected() {
 }
 
 /*
-
-	Args: [{ false group String} { false method String}], Returns: Variant
+Args: [{ false group String} { false method String}], Returns: Variant
 */
-func (o *SceneTree) CallGroup(group gdnative.String, method gdnative.String) gdnative.Variant {
+func (o *SceneTree) CallGroup(group gdnative.String, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling SceneTree.CallGroup()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromString(group)
-	ptrArguments[1] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 2+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(group))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "call_group")
+	methodBind := methodBindSceneTreeCallGroup.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 2; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-
-	Args: [{ false flags int} { false group String} { false method String}], Returns: Variant
+Args: [{ false flags int} { false group String} { false method String}], Returns: Variant
 */
-func (o *SceneTree) CallGroupFlags(flags gdnative.Int, group gdnative.String, method gdnative.String) gdnative.Variant {
+func (o *SceneTree) CallGroupFlags(flags gdnative.Int, group gdnative.String, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error) {
 	//log.Println("Calling SceneTree.CallGroupFlags()")
 
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
-	ptrArguments[1] = gdnative.NewPointerFromString(group)
-	ptrArguments[2] = gdnative.NewPointerFromString(method)
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 3+len(args))
+	variantArguments = append(variantArguments, gdnative.NewVariantInt(gdnative.Int64T(flags)))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(group))
+	variantArguments = append(variantArguments, gdnative.NewVariantString(method))
+	variantArguments = append(variantArguments, args...)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "call_group_flags")
+	methodBind := methodBindSceneTreeCallGroupFlags.Get()
 
 	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
 
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
+	// Free the variants we created for the fixed arguments.
+	for i := 0; i < 3; i++ {
+		variantArguments[i].Destroy()
+	}
+
+	// The returned variant is owned by the caller.
+	return ret, err
 }
 
 /*
-
-	Args: [{ false path String}], Returns: enum.Error
+Args: [{ false path String}], Returns: enum.Error
 */
 func (o *SceneTree) ChangeScene(path gdnative.String) gdnative.Error {
 	//log.Println("Calling SceneTree.ChangeScene()")
@@ -250,7 +405,7 @@ func (o *SceneTree) ChangeScene(path gdnative.String) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "change_scene")
+	methodBind := methodBindSceneTreeChangeScene.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -263,18 +418,17 @@ func (o *SceneTree) ChangeScene(path gdnative.String) gdnative.Error {
 }
 
 /*
-
-	Args: [{ false packed_scene PackedScene}], Returns: enum.Error
+Args: [{ false packed_scene PackedScene}], Returns: enum.Error
 */
 func (o *SceneTree) ChangeSceneTo(packedScene PackedSceneImplementer) gdnative.Error {
 	//log.Println("Calling SceneTree.ChangeSceneTo()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(packedScene.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(packedScene))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "change_scene_to")
+	methodBind := methodBindSceneTreeChangeSceneTo.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -287,8 +441,7 @@ func (o *SceneTree) ChangeSceneTo(packedScene PackedSceneImplementer) gdnative.E
 }
 
 /*
-
-	Args: [{ false time_sec float} {True true pause_mode_process bool}], Returns: SceneTreeTimer
+Args: [{ false time_sec float} {True true pause_mode_process bool}], Returns: SceneTreeTimer
 */
 func (o *SceneTree) CreateTimer(timeSec gdnative.Real, pauseModeProcess gdnative.Bool) SceneTreeTimerImplementer {
 	//log.Println("Calling SceneTree.CreateTimer()")
@@ -299,7 +452,7 @@ func (o *SceneTree) CreateTimer(timeSec gdnative.Real, pauseModeProcess gdnative
 	ptrArguments[1] = gdnative.NewPointerFromBool(pauseModeProcess)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "create_timer")
+	methodBind := methodBindSceneTreeCreateTimer.Get()
 
 	// Call the parent method.
 	// SceneTreeTimer
@@ -307,27 +460,25 @@ func (o *SceneTree) CreateTimer(timeSec gdnative.Real, pauseModeProcess gdnative
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSceneTreeTimerFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SceneTreeTimerImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "SceneTreeTimer" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SceneTreeTimerImplementer)
+	ret := SceneTreeTimer{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(SceneTreeTimerImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Node
+	        Undocumented
+		Args: [], Returns: Node
 */
 func (o *SceneTree) GetCurrentScene() NodeImplementer {
 	//log.Println("Calling SceneTree.GetCurrentScene()")
@@ -336,7 +487,7 @@ func (o *SceneTree) GetCurrentScene() NodeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_current_scene")
+	methodBind := methodBindSceneTreeGetCurrentScene.Get()
 
 	// Call the parent method.
 	// Node
@@ -344,27 +495,25 @@ func (o *SceneTree) GetCurrentScene() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Node
+	        Undocumented
+		Args: [], Returns: Node
 */
 func (o *SceneTree) GetEditedSceneRoot() NodeImplementer {
 	//log.Println("Calling SceneTree.GetEditedSceneRoot()")
@@ -373,7 +522,7 @@ func (o *SceneTree) GetEditedSceneRoot() NodeImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_edited_scene_root")
+	methodBind := methodBindSceneTreeGetEditedSceneRoot.Get()
 
 	// Call the parent method.
 	// Node
@@ -381,27 +530,24 @@ func (o *SceneTree) GetEditedSceneRoot() NodeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Node" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NodeImplementer)
+	ret := Node{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *SceneTree) GetFrame() gdnative.Int {
 	//log.Println("Calling SceneTree.GetFrame()")
@@ -410,7 +556,7 @@ func (o *SceneTree) GetFrame() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_frame")
+	methodBind := methodBindSceneTreeGetFrame.Get()
 
 	// Call the parent method.
 	// int
@@ -423,8 +569,7 @@ func (o *SceneTree) GetFrame() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: PoolIntArray
+Args: [], Returns: PoolIntArray
 */
 func (o *SceneTree) GetNetworkConnectedPeers() gdnative.PoolIntArray {
 	//log.Println("Calling SceneTree.GetNetworkConnectedPeers()")
@@ -433,7 +578,7 @@ func (o *SceneTree) GetNetworkConnectedPeers() gdnative.PoolIntArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_network_connected_peers")
+	methodBind := methodBindSceneTreeGetNetworkConnectedPeers.Get()
 
 	// Call the parent method.
 	// PoolIntArray
@@ -446,8 +591,8 @@ func (o *SceneTree) GetNetworkConnectedPeers() gdnative.PoolIntArray {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NetworkedMultiplayerPeer
+	        Undocumented
+		Args: [], Returns: NetworkedMultiplayerPeer
 */
 func (o *SceneTree) GetNetworkPeer() NetworkedMultiplayerPeerImplementer {
 	//log.Println("Calling SceneTree.GetNetworkPeer()")
@@ -456,7 +601,7 @@ func (o *SceneTree) GetNetworkPeer() NetworkedMultiplayerPeerImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_network_peer")
+	methodBind := methodBindSceneTreeGetNetworkPeer.Get()
 
 	// Call the parent method.
 	// NetworkedMultiplayerPeer
@@ -464,27 +609,24 @@ func (o *SceneTree) GetNetworkPeer() NetworkedMultiplayerPeerImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newNetworkedMultiplayerPeerFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(NetworkedMultiplayerPeerImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "NetworkedMultiplayerPeer" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(NetworkedMultiplayerPeerImplementer)
+	ret := NetworkedMultiplayerPeer{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(NetworkedMultiplayerPeerImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *SceneTree) GetNetworkUniqueId() gdnative.Int {
 	//log.Println("Calling SceneTree.GetNetworkUniqueId()")
@@ -493,7 +635,7 @@ func (o *SceneTree) GetNetworkUniqueId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_network_unique_id")
+	methodBind := methodBindSceneTreeGetNetworkUniqueId.Get()
 
 	// Call the parent method.
 	// int
@@ -506,8 +648,7 @@ func (o *SceneTree) GetNetworkUniqueId() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *SceneTree) GetNodeCount() gdnative.Int {
 	//log.Println("Calling SceneTree.GetNodeCount()")
@@ -516,7 +657,7 @@ func (o *SceneTree) GetNodeCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_node_count")
+	methodBind := methodBindSceneTreeGetNodeCount.Get()
 
 	// Call the parent method.
 	// int
@@ -529,8 +670,7 @@ func (o *SceneTree) GetNodeCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false group String}], Returns: Array
+Args: [{ false group String}], Returns: Array
 */
 func (o *SceneTree) GetNodesInGroup(group gdnative.String) gdnative.Array {
 	//log.Println("Calling SceneTree.GetNodesInGroup()")
@@ -540,7 +680,7 @@ func (o *SceneTree) GetNodesInGroup(group gdnative.String) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromString(group)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_nodes_in_group")
+	methodBind := methodBindSceneTreeGetNodesInGroup.Get()
 
 	// Call the parent method.
 	// Array
@@ -553,8 +693,8 @@ func (o *SceneTree) GetNodesInGroup(group gdnative.String) gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Viewport
+	        Undocumented
+		Args: [], Returns: Viewport
 */
 func (o *SceneTree) GetRoot() ViewportImplementer {
 	//log.Println("Calling SceneTree.GetRoot()")
@@ -563,7 +703,7 @@ func (o *SceneTree) GetRoot() ViewportImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_root")
+	methodBind := methodBindSceneTreeGetRoot.Get()
 
 	// Call the parent method.
 	// Viewport
@@ -571,27 +711,24 @@ func (o *SceneTree) GetRoot() ViewportImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newViewportFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ViewportImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Viewport" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ViewportImplementer)
+	ret := Viewport{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ViewportImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *SceneTree) GetRpcSenderId() gdnative.Int {
 	//log.Println("Calling SceneTree.GetRpcSenderId()")
@@ -600,7 +737,7 @@ func (o *SceneTree) GetRpcSenderId() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "get_rpc_sender_id")
+	methodBind := methodBindSceneTreeGetRpcSenderId.Get()
 
 	// Call the parent method.
 	// int
@@ -613,8 +750,7 @@ func (o *SceneTree) GetRpcSenderId() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *SceneTree) HasGroup(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling SceneTree.HasGroup()")
@@ -624,7 +760,7 @@ func (o *SceneTree) HasGroup(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "has_group")
+	methodBind := methodBindSceneTreeHasGroup.Get()
 
 	// Call the parent method.
 	// bool
@@ -637,8 +773,8 @@ func (o *SceneTree) HasGroup(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns true if there is a [NetworkedMultiplayerPeer] set (with [method SceneTree.set_network_peer]).
-	Args: [], Returns: bool
+	        Returns true if there is a [NetworkedMultiplayerPeer] set (with [method SceneTree.set_network_peer]).
+		Args: [], Returns: bool
 */
 func (o *SceneTree) HasNetworkPeer() gdnative.Bool {
 	//log.Println("Calling SceneTree.HasNetworkPeer()")
@@ -647,7 +783,7 @@ func (o *SceneTree) HasNetworkPeer() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "has_network_peer")
+	methodBind := methodBindSceneTreeHasNetworkPeer.Get()
 
 	// Call the parent method.
 	// bool
@@ -660,8 +796,8 @@ func (o *SceneTree) HasNetworkPeer() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SceneTree) IsDebuggingCollisionsHint() gdnative.Bool {
 	//log.Println("Calling SceneTree.IsDebuggingCollisionsHint()")
@@ -670,7 +806,7 @@ func (o *SceneTree) IsDebuggingCollisionsHint() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "is_debugging_collisions_hint")
+	methodBind := methodBindSceneTreeIsDebuggingCollisionsHint.Get()
 
 	// Call the parent method.
 	// bool
@@ -683,8 +819,8 @@ func (o *SceneTree) IsDebuggingCollisionsHint() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SceneTree) IsDebuggingNavigationHint() gdnative.Bool {
 	//log.Println("Calling SceneTree.IsDebuggingNavigationHint()")
@@ -693,7 +829,7 @@ func (o *SceneTree) IsDebuggingNavigationHint() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "is_debugging_navigation_hint")
+	methodBind := methodBindSceneTreeIsDebuggingNavigationHint.Get()
 
 	// Call the parent method.
 	// bool
@@ -706,8 +842,7 @@ func (o *SceneTree) IsDebuggingNavigationHint() gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: bool
+Args: [], Returns: bool
 */
 func (o *SceneTree) IsInputHandled() gdnative.Bool {
 	//log.Println("Calling SceneTree.IsInputHandled()")
@@ -716,7 +851,7 @@ func (o *SceneTree) IsInputHandled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "is_input_handled")
+	methodBind := methodBindSceneTreeIsInputHandled.Get()
 
 	// Call the parent method.
 	// bool
@@ -729,8 +864,8 @@ func (o *SceneTree) IsInputHandled() gdnative.Bool {
 }
 
 /*
-        Returns true if this SceneTree's [NetworkedMultiplayerPeer] is in server mode (listening for connections).
-	Args: [], Returns: bool
+	        Returns true if this SceneTree's [NetworkedMultiplayerPeer] is in server mode (listening for connections).
+		Args: [], Returns: bool
 */
 func (o *SceneTree) IsNetworkServer() gdnative.Bool {
 	//log.Println("Calling SceneTree.IsNetworkServer()")
@@ -739,7 +874,7 @@ func (o *SceneTree) IsNetworkServer() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "is_network_server")
+	methodBind := methodBindSceneTreeIsNetworkServer.Get()
 
 	// Call the parent method.
 	// bool
@@ -752,8 +887,8 @@ func (o *SceneTree) IsNetworkServer() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SceneTree) IsPaused() gdnative.Bool {
 	//log.Println("Calling SceneTree.IsPaused()")
@@ -762,7 +897,7 @@ func (o *SceneTree) IsPaused() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "is_paused")
+	methodBind := methodBindSceneTreeIsPaused.Get()
 
 	// Call the parent method.
 	// bool
@@ -775,8 +910,8 @@ func (o *SceneTree) IsPaused() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SceneTree) IsRefusingNewNetworkConnections() gdnative.Bool {
 	//log.Println("Calling SceneTree.IsRefusingNewNetworkConnections()")
@@ -785,7 +920,7 @@ func (o *SceneTree) IsRefusingNewNetworkConnections() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "is_refusing_new_network_connections")
+	methodBind := methodBindSceneTreeIsRefusingNewNetworkConnections.Get()
 
 	// Call the parent method.
 	// bool
@@ -798,8 +933,8 @@ func (o *SceneTree) IsRefusingNewNetworkConnections() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SceneTree) IsUsingFontOversampling() gdnative.Bool {
 	//log.Println("Calling SceneTree.IsUsingFontOversampling()")
@@ -808,7 +943,7 @@ func (o *SceneTree) IsUsingFontOversampling() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "is_using_font_oversampling")
+	methodBind := methodBindSceneTreeIsUsingFontOversampling.Get()
 
 	// Call the parent method.
 	// bool
@@ -821,8 +956,7 @@ func (o *SceneTree) IsUsingFontOversampling() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false group String} { false notification int}], Returns: void
+Args: [{ false group String} { false notification int}], Returns: void
 */
 func (o *SceneTree) NotifyGroup(group gdnative.String, notification gdnative.Int) {
 	//log.Println("Calling SceneTree.NotifyGroup()")
@@ -833,7 +967,7 @@ func (o *SceneTree) NotifyGroup(group gdnative.String, notification gdnative.Int
 	ptrArguments[1] = gdnative.NewPointerFromInt(notification)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "notify_group")
+	methodBind := methodBindSceneTreeNotifyGroup.Get()
 
 	// Call the parent method.
 	// void
@@ -843,20 +977,19 @@ func (o *SceneTree) NotifyGroup(group gdnative.String, notification gdnative.Int
 }
 
 /*
-
-	Args: [{ false call_flags int} { false group String} { false notification int}], Returns: void
+Args: [{ false call_flags enum.SceneTree::GroupCallFlags} { false group String} { false notification int}], Returns: void
 */
-func (o *SceneTree) NotifyGroupFlags(callFlags gdnative.Int, group gdnative.String, notification gdnative.Int) {
+func (o *SceneTree) NotifyGroupFlags(callFlags SceneTreeGroupCallFlags, group gdnative.String, notification gdnative.Int) {
 	//log.Println("Calling SceneTree.NotifyGroupFlags()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromInt(callFlags)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(callFlags))
 	ptrArguments[1] = gdnative.NewPointerFromString(group)
 	ptrArguments[2] = gdnative.NewPointerFromInt(notification)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "notify_group_flags")
+	methodBind := methodBindSceneTreeNotifyGroupFlags.Get()
 
 	// Call the parent method.
 	// void
@@ -866,18 +999,17 @@ func (o *SceneTree) NotifyGroupFlags(callFlags gdnative.Int, group gdnative.Stri
 }
 
 /*
-
-	Args: [{ false obj Object}], Returns: void
+Args: [{ false obj Object}], Returns: void
 */
 func (o *SceneTree) QueueDelete(obj ObjectImplementer) {
 	//log.Println("Calling SceneTree.QueueDelete()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(obj.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(obj))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "queue_delete")
+	methodBind := methodBindSceneTreeQueueDelete.Get()
 
 	// Call the parent method.
 	// void
@@ -887,8 +1019,7 @@ func (o *SceneTree) QueueDelete(obj ObjectImplementer) {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *SceneTree) Quit() {
 	//log.Println("Calling SceneTree.Quit()")
@@ -897,7 +1028,7 @@ func (o *SceneTree) Quit() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "quit")
+	methodBind := methodBindSceneTreeQuit.Get()
 
 	// Call the parent method.
 	// void
@@ -907,8 +1038,7 @@ func (o *SceneTree) Quit() {
 }
 
 /*
-
-	Args: [], Returns: enum.Error
+Args: [], Returns: enum.Error
 */
 func (o *SceneTree) ReloadCurrentScene() gdnative.Error {
 	//log.Println("Calling SceneTree.ReloadCurrentScene()")
@@ -917,7 +1047,7 @@ func (o *SceneTree) ReloadCurrentScene() gdnative.Error {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "reload_current_scene")
+	methodBind := methodBindSceneTreeReloadCurrentScene.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -930,8 +1060,7 @@ func (o *SceneTree) ReloadCurrentScene() gdnative.Error {
 }
 
 /*
-
-	Args: [{ false enabled bool}], Returns: void
+Args: [{ false enabled bool}], Returns: void
 */
 func (o *SceneTree) SetAutoAcceptQuit(enabled gdnative.Bool) {
 	//log.Println("Calling SceneTree.SetAutoAcceptQuit()")
@@ -941,7 +1070,7 @@ func (o *SceneTree) SetAutoAcceptQuit(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_auto_accept_quit")
+	methodBind := methodBindSceneTreeSetAutoAcceptQuit.Get()
 
 	// Call the parent method.
 	// void
@@ -951,18 +1080,18 @@ func (o *SceneTree) SetAutoAcceptQuit(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false child_node Object}], Returns: void
+	        Undocumented
+		Args: [{ false child_node Object}], Returns: void
 */
 func (o *SceneTree) SetCurrentScene(childNode ObjectImplementer) {
 	//log.Println("Calling SceneTree.SetCurrentScene()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(childNode.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(childNode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_current_scene")
+	methodBind := methodBindSceneTreeSetCurrentScene.Get()
 
 	// Call the parent method.
 	// void
@@ -972,8 +1101,8 @@ func (o *SceneTree) SetCurrentScene(childNode ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *SceneTree) SetDebugCollisionsHint(enable gdnative.Bool) {
 	//log.Println("Calling SceneTree.SetDebugCollisionsHint()")
@@ -983,7 +1112,7 @@ func (o *SceneTree) SetDebugCollisionsHint(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_debug_collisions_hint")
+	methodBind := methodBindSceneTreeSetDebugCollisionsHint.Get()
 
 	// Call the parent method.
 	// void
@@ -993,8 +1122,8 @@ func (o *SceneTree) SetDebugCollisionsHint(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *SceneTree) SetDebugNavigationHint(enable gdnative.Bool) {
 	//log.Println("Calling SceneTree.SetDebugNavigationHint()")
@@ -1004,7 +1133,7 @@ func (o *SceneTree) SetDebugNavigationHint(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_debug_navigation_hint")
+	methodBind := methodBindSceneTreeSetDebugNavigationHint.Get()
 
 	// Call the parent method.
 	// void
@@ -1014,18 +1143,18 @@ func (o *SceneTree) SetDebugNavigationHint(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scene Object}], Returns: void
+	        Undocumented
+		Args: [{ false scene Object}], Returns: void
 */
 func (o *SceneTree) SetEditedSceneRoot(scene ObjectImplementer) {
 	//log.Println("Calling SceneTree.SetEditedSceneRoot()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(scene.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(scene))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_edited_scene_root")
+	methodBind := methodBindSceneTreeSetEditedSceneRoot.Get()
 
 	// Call the parent method.
 	// void
@@ -1035,8 +1164,7 @@ func (o *SceneTree) SetEditedSceneRoot(scene ObjectImplementer) {
 }
 
 /*
-
-	Args: [{ false group String} { false property String} { false value Variant}], Returns: void
+Args: [{ false group String} { false property String} { false value Variant}], Returns: void
 */
 func (o *SceneTree) SetGroup(group gdnative.String, property gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling SceneTree.SetGroup()")
@@ -1048,7 +1176,7 @@ func (o *SceneTree) SetGroup(group gdnative.String, property gdnative.String, va
 	ptrArguments[2] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_group")
+	methodBind := methodBindSceneTreeSetGroup.Get()
 
 	// Call the parent method.
 	// void
@@ -1058,21 +1186,20 @@ func (o *SceneTree) SetGroup(group gdnative.String, property gdnative.String, va
 }
 
 /*
-
-	Args: [{ false call_flags int} { false group String} { false property String} { false value Variant}], Returns: void
+Args: [{ false call_flags enum.SceneTree::GroupCallFlags} { false group String} { false property String} { false value Variant}], Returns: void
 */
-func (o *SceneTree) SetGroupFlags(callFlags gdnative.Int, group gdnative.String, property gdnative.String, value gdnative.Variant) {
+func (o *SceneTree) SetGroupFlags(callFlags SceneTreeGroupCallFlags, group gdnative.String, property gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling SceneTree.SetGroupFlags()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
-	ptrArguments[0] = gdnative.NewPointerFromInt(callFlags)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(callFlags))
 	ptrArguments[1] = gdnative.NewPointerFromString(group)
 	ptrArguments[2] = gdnative.NewPointerFromString(property)
 	ptrArguments[3] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_group_flags")
+	methodBind := methodBindSceneTreeSetGroupFlags.Get()
 
 	// Call the parent method.
 	// void
@@ -1082,8 +1209,7 @@ func (o *SceneTree) SetGroupFlags(callFlags gdnative.Int, group gdnative.String,
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *SceneTree) SetInputAsHandled() {
 	//log.Println("Calling SceneTree.SetInputAsHandled()")
@@ -1092,7 +1218,7 @@ func (o *SceneTree) SetInputAsHandled() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_input_as_handled")
+	methodBind := methodBindSceneTreeSetInputAsHandled.Get()
 
 	// Call the parent method.
 	// void
@@ -1102,18 +1228,18 @@ func (o *SceneTree) SetInputAsHandled() {
 }
 
 /*
-        Undocumented
-	Args: [{ false peer NetworkedMultiplayerPeer}], Returns: void
+	        Undocumented
+		Args: [{ false peer NetworkedMultiplayerPeer}], Returns: void
 */
 func (o *SceneTree) SetNetworkPeer(peer NetworkedMultiplayerPeerImplementer) {
 	//log.Println("Calling SceneTree.SetNetworkPeer()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(peer.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(peer))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_network_peer")
+	methodBind := methodBindSceneTreeSetNetworkPeer.Get()
 
 	// Call the parent method.
 	// void
@@ -1123,8 +1249,8 @@ func (o *SceneTree) SetNetworkPeer(peer NetworkedMultiplayerPeerImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *SceneTree) SetPause(enable gdnative.Bool) {
 	//log.Println("Calling SceneTree.SetPause()")
@@ -1134,7 +1260,7 @@ func (o *SceneTree) SetPause(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_pause")
+	methodBind := methodBindSceneTreeSetPause.Get()
 
 	// Call the parent method.
 	// void
@@ -1144,8 +1270,7 @@ func (o *SceneTree) SetPause(enable gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false enabled bool}], Returns: void
+Args: [{ false enabled bool}], Returns: void
 */
 func (o *SceneTree) SetQuitOnGoBack(enabled gdnative.Bool) {
 	//log.Println("Calling SceneTree.SetQuitOnGoBack()")
@@ -1155,7 +1280,7 @@ func (o *SceneTree) SetQuitOnGoBack(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_quit_on_go_back")
+	methodBind := methodBindSceneTreeSetQuitOnGoBack.Get()
 
 	// Call the parent method.
 	// void
@@ -1165,8 +1290,8 @@ func (o *SceneTree) SetQuitOnGoBack(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false refuse bool}], Returns: void
+	        Undocumented
+		Args: [{ false refuse bool}], Returns: void
 */
 func (o *SceneTree) SetRefuseNewNetworkConnections(refuse gdnative.Bool) {
 	//log.Println("Calling SceneTree.SetRefuseNewNetworkConnections()")
@@ -1176,7 +1301,7 @@ func (o *SceneTree) SetRefuseNewNetworkConnections(refuse gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(refuse)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_refuse_new_network_connections")
+	methodBind := methodBindSceneTreeSetRefuseNewNetworkConnections.Get()
 
 	// Call the parent method.
 	// void
@@ -1186,8 +1311,7 @@ func (o *SceneTree) SetRefuseNewNetworkConnections(refuse gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false mode int} { false aspect int} { false minsize Vector2} {1 true shrink float}], Returns: void
+Args: [{ false mode int} { false aspect int} { false minsize Vector2} {1 true shrink float}], Returns: void
 */
 func (o *SceneTree) SetScreenStretch(mode gdnative.Int, aspect gdnative.Int, minsize gdnative.Vector2, shrink gdnative.Real) {
 	//log.Println("Calling SceneTree.SetScreenStretch()")
@@ -1200,7 +1324,7 @@ func (o *SceneTree) SetScreenStretch(mode gdnative.Int, aspect gdnative.Int, min
 	ptrArguments[3] = gdnative.NewPointerFromReal(shrink)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_screen_stretch")
+	methodBind := methodBindSceneTreeSetScreenStretch.Get()
 
 	// Call the parent method.
 	// void
@@ -1210,8 +1334,8 @@ func (o *SceneTree) SetScreenStretch(mode gdnative.Int, aspect gdnative.Int, min
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *SceneTree) SetUseFontOversampling(enable gdnative.Bool) {
 	//log.Println("Calling SceneTree.SetUseFontOversampling()")
@@ -1221,7 +1345,7 @@ func (o *SceneTree) SetUseFontOversampling(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTree", "set_use_font_oversampling")
+	methodBind := methodBindSceneTreeSetUseFontOversampling.Get()
 
 	// Call the parent method.
 	// void
@@ -1230,6 +1354,336 @@ func (o *SceneTree) SetUseFontOversampling(enable gdnative.Bool) {
 
 }
 
+// CreateTimerWithDefaults will call CreateTimer using the default values for: pause_mode_process.
+func (o *SceneTree) CreateTimerWithDefaults(timeSec gdnative.Real) SceneTreeTimerImplementer {
+	return o.CreateTimer(timeSec, true)
+}
+
+// SetScreenStretchWithDefaults will call SetScreenStretch using the default values for: shrink.
+func (o *SceneTree) SetScreenStretchWithDefaults(mode gdnative.Int, aspect gdnative.Int, minsize gdnative.Vector2) {
+	o.SetScreenStretch(mode, aspect, minsize, 1)
+}
+
+// CurrentScene will return the value of the "current_scene" property.
+func (o *SceneTree) CurrentScene() NodeImplementer {
+	return o.GetCurrentScene()
+}
+
+// DebugCollisionsHint will return the value of the "debug_collisions_hint" property.
+func (o *SceneTree) DebugCollisionsHint() gdnative.Bool {
+	return o.IsDebuggingCollisionsHint()
+}
+
+// DebugNavigationHint will return the value of the "debug_navigation_hint" property.
+func (o *SceneTree) DebugNavigationHint() gdnative.Bool {
+	return o.IsDebuggingNavigationHint()
+}
+
+// EditedSceneRoot will return the value of the "edited_scene_root" property.
+func (o *SceneTree) EditedSceneRoot() NodeImplementer {
+	return o.GetEditedSceneRoot()
+}
+
+// NetworkPeer will return the value of the "network_peer" property.
+func (o *SceneTree) NetworkPeer() NetworkedMultiplayerPeerImplementer {
+	return o.GetNetworkPeer()
+}
+
+// Paused will return the value of the "paused" property.
+func (o *SceneTree) Paused() gdnative.Bool {
+	return o.IsPaused()
+}
+
+// SetPaused will set the value of the "paused" property.
+func (o *SceneTree) SetPaused(value gdnative.Bool) {
+	o.SetPause(value)
+}
+
+// RefuseNewNetworkConnections will return the value of the "refuse_new_network_connections" property.
+func (o *SceneTree) RefuseNewNetworkConnections() gdnative.Bool {
+	return o.IsRefusingNewNetworkConnections()
+}
+
+// Root will return the value of the "root" property.
+func (o *SceneTree) Root() ViewportImplementer {
+	return o.GetRoot()
+}
+
+// UseFontOversampling will return the value of the "use_font_oversampling" property.
+func (o *SceneTree) UseFontOversampling() gdnative.Bool {
+	return o.IsUsingFontOversampling()
+}
+
+// Signals of the SceneTree class.
+const (
+	SceneTreeSignalConnectedToServer               gdnative.String = "connected_to_server"
+	SceneTreeSignalConnectionFailed                gdnative.String = "connection_failed"
+	SceneTreeSignalFilesDropped                    gdnative.String = "files_dropped"
+	SceneTreeSignalIdleFrame                       gdnative.String = "idle_frame"
+	SceneTreeSignalNetworkPeerConnected            gdnative.String = "network_peer_connected"
+	SceneTreeSignalNetworkPeerDisconnected         gdnative.String = "network_peer_disconnected"
+	SceneTreeSignalNodeAdded                       gdnative.String = "node_added"
+	SceneTreeSignalNodeConfigurationWarningChanged gdnative.String = "node_configuration_warning_changed"
+	SceneTreeSignalNodeRemoved                     gdnative.String = "node_removed"
+	SceneTreeSignalPhysicsFrame                    gdnative.String = "physics_frame"
+	SceneTreeSignalScreenResized                   gdnative.String = "screen_resized"
+	SceneTreeSignalServerDisconnected              gdnative.String = "server_disconnected"
+	SceneTreeSignalTreeChanged                     gdnative.String = "tree_changed"
+)
+
+// ConnectConnectedToServer will connect the "connected_to_server" signal to the given method of the target object.
+func (o *SceneTree) ConnectConnectedToServer(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalConnectedToServer, target, method, binds, 0)
+}
+
+// EmitConnectedToServer will emit the "connected_to_server" signal.
+func (o *SceneTree) EmitConnectedToServer() error {
+
+	ret, err := o.EmitSignal(SceneTreeSignalConnectedToServer)
+	ret.Destroy()
+	return err
+}
+
+// ConnectConnectionFailed will connect the "connection_failed" signal to the given method of the target object.
+func (o *SceneTree) ConnectConnectionFailed(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalConnectionFailed, target, method, binds, 0)
+}
+
+// EmitConnectionFailed will emit the "connection_failed" signal.
+func (o *SceneTree) EmitConnectionFailed() error {
+
+	ret, err := o.EmitSignal(SceneTreeSignalConnectionFailed)
+	ret.Destroy()
+	return err
+}
+
+// SceneTreeFilesDroppedArgs holds the arguments of the "files_dropped" signal.
+type SceneTreeFilesDroppedArgs struct {
+	Files  gdnative.PoolStringArray
+	Screen gdnative.Int
+}
+
+// ConnectFilesDropped will connect the "files_dropped" signal to the given method of the target object.
+func (o *SceneTree) ConnectFilesDropped(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalFilesDropped, target, method, binds, 0)
+}
+
+// EmitFilesDropped will emit the "files_dropped" signal.
+func (o *SceneTree) EmitFilesDropped(args SceneTreeFilesDroppedArgs) error {
+	arg0 := gdnative.NewVariantPoolStringArray(args.Files)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.Screen))
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(SceneTreeSignalFilesDropped, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// ConnectIdleFrame will connect the "idle_frame" signal to the given method of the target object.
+func (o *SceneTree) ConnectIdleFrame(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalIdleFrame, target, method, binds, 0)
+}
+
+// EmitIdleFrame will emit the "idle_frame" signal.
+func (o *SceneTree) EmitIdleFrame() error {
+
+	ret, err := o.EmitSignal(SceneTreeSignalIdleFrame)
+	ret.Destroy()
+	return err
+}
+
+// SceneTreeNetworkPeerConnectedArgs holds the arguments of the "network_peer_connected" signal.
+type SceneTreeNetworkPeerConnectedArgs struct {
+	Id gdnative.Int
+}
+
+// ConnectNetworkPeerConnected will connect the "network_peer_connected" signal to the given method of the target object.
+func (o *SceneTree) ConnectNetworkPeerConnected(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalNetworkPeerConnected, target, method, binds, 0)
+}
+
+// EmitNetworkPeerConnected will emit the "network_peer_connected" signal.
+func (o *SceneTree) EmitNetworkPeerConnected(args SceneTreeNetworkPeerConnectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(SceneTreeSignalNetworkPeerConnected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// SceneTreeNetworkPeerDisconnectedArgs holds the arguments of the "network_peer_disconnected" signal.
+type SceneTreeNetworkPeerDisconnectedArgs struct {
+	Id gdnative.Int
+}
+
+// ConnectNetworkPeerDisconnected will connect the "network_peer_disconnected" signal to the given method of the target object.
+func (o *SceneTree) ConnectNetworkPeerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalNetworkPeerDisconnected, target, method, binds, 0)
+}
+
+// EmitNetworkPeerDisconnected will emit the "network_peer_disconnected" signal.
+func (o *SceneTree) EmitNetworkPeerDisconnected(args SceneTreeNetworkPeerDisconnectedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(SceneTreeSignalNetworkPeerDisconnected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// SceneTreeNodeAddedArgs holds the arguments of the "node_added" signal.
+type SceneTreeNodeAddedArgs struct {
+	Node ObjectImplementer
+}
+
+// ConnectNodeAdded will connect the "node_added" signal to the given method of the target object.
+func (o *SceneTree) ConnectNodeAdded(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalNodeAdded, target, method, binds, 0)
+}
+
+// EmitNodeAdded will emit the "node_added" signal.
+func (o *SceneTree) EmitNodeAdded(args SceneTreeNodeAddedArgs) error {
+	arg0 := gdnative.NewVariantObject(GetBaseObject(args.Node))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(SceneTreeSignalNodeAdded, arg0)
+	ret.Destroy()
+	return err
+}
+
+// SceneTreeNodeConfigurationWarningChangedArgs holds the arguments of the "node_configuration_warning_changed" signal.
+type SceneTreeNodeConfigurationWarningChangedArgs struct {
+	Node ObjectImplementer
+}
+
+// ConnectNodeConfigurationWarningChanged will connect the "node_configuration_warning_changed" signal to the given method of the target object.
+func (o *SceneTree) ConnectNodeConfigurationWarningChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalNodeConfigurationWarningChanged, target, method, binds, 0)
+}
+
+// EmitNodeConfigurationWarningChanged will emit the "node_configuration_warning_changed" signal.
+func (o *SceneTree) EmitNodeConfigurationWarningChanged(args SceneTreeNodeConfigurationWarningChangedArgs) error {
+	arg0 := gdnative.NewVariantObject(GetBaseObject(args.Node))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(SceneTreeSignalNodeConfigurationWarningChanged, arg0)
+	ret.Destroy()
+	return err
+}
+
+// SceneTreeNodeRemovedArgs holds the arguments of the "node_removed" signal.
+type SceneTreeNodeRemovedArgs struct {
+	Node ObjectImplementer
+}
+
+// ConnectNodeRemoved will connect the "node_removed" signal to the given method of the target object.
+func (o *SceneTree) ConnectNodeRemoved(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalNodeRemoved, target, method, binds, 0)
+}
+
+// EmitNodeRemoved will emit the "node_removed" signal.
+func (o *SceneTree) EmitNodeRemoved(args SceneTreeNodeRemovedArgs) error {
+	arg0 := gdnative.NewVariantObject(GetBaseObject(args.Node))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(SceneTreeSignalNodeRemoved, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectPhysicsFrame will connect the "physics_frame" signal to the given method of the target object.
+func (o *SceneTree) ConnectPhysicsFrame(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalPhysicsFrame, target, method, binds, 0)
+}
+
+// EmitPhysicsFrame will emit the "physics_frame" signal.
+func (o *SceneTree) EmitPhysicsFrame() error {
+
+	ret, err := o.EmitSignal(SceneTreeSignalPhysicsFrame)
+	ret.Destroy()
+	return err
+}
+
+// ConnectScreenResized will connect the "screen_resized" signal to the given method of the target object.
+func (o *SceneTree) ConnectScreenResized(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalScreenResized, target, method, binds, 0)
+}
+
+// EmitScreenResized will emit the "screen_resized" signal.
+func (o *SceneTree) EmitScreenResized() error {
+
+	ret, err := o.EmitSignal(SceneTreeSignalScreenResized)
+	ret.Destroy()
+	return err
+}
+
+// ConnectServerDisconnected will connect the "server_disconnected" signal to the given method of the target object.
+func (o *SceneTree) ConnectServerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalServerDisconnected, target, method, binds, 0)
+}
+
+// EmitServerDisconnected will emit the "server_disconnected" signal.
+func (o *SceneTree) EmitServerDisconnected() error {
+
+	ret, err := o.EmitSignal(SceneTreeSignalServerDisconnected)
+	ret.Destroy()
+	return err
+}
+
+// ConnectTreeChanged will connect the "tree_changed" signal to the given method of the target object.
+func (o *SceneTree) ConnectTreeChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeSignalTreeChanged, target, method, binds, 0)
+}
+
+// EmitTreeChanged will emit the "tree_changed" signal.
+func (o *SceneTree) EmitTreeChanged() error {
+
+	ret, err := o.EmitSignal(SceneTreeSignalTreeChanged)
+	ret.Destroy()
+	return err
+}
+
 // SceneTreeImplementer is an interface that implements the methods
 // of the SceneTree class.
 type SceneTreeImplementer interface {
@@ -1240,8 +1694,10 @@ type SceneTreeImplementer interface {
 	X_NetworkPeerConnected(arg0 gdnative.Int)
 	X_NetworkPeerDisconnected(arg0 gdnative.Int)
 	X_ServerDisconnected()
-	CallGroup(group gdnative.String, method gdnative.String) gdnative.Variant
-	CallGroupFlags(flags gdnative.Int, group gdnative.String, method gdnative.String) gdnative.Variant
+	CallGroup(group gdnative.String, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	CallGroupFlags(flags gdnative.Int, group gdnative.String, method gdnative.String, args ...gdnative.Variant) (gdnative.Variant, error)
+	ChangeScene(path gdnative.String) gdnative.Error
+	ChangeSceneTo(packedScene PackedSceneImplementer) gdnative.Error
 	CreateTimer(timeSec gdnative.Real, pauseModeProcess gdnative.Bool) SceneTreeTimerImplementer
 	GetCurrentScene() NodeImplementer
 	GetEditedSceneRoot() NodeImplementer
@@ -1263,16 +1719,17 @@ type SceneTreeImplementer interface {
 	IsRefusingNewNetworkConnections() gdnative.Bool
 	IsUsingFontOversampling() gdnative.Bool
 	NotifyGroup(group gdnative.String, notification gdnative.Int)
-	NotifyGroupFlags(callFlags gdnative.Int, group gdnative.String, notification gdnative.Int)
+	NotifyGroupFlags(callFlags SceneTreeGroupCallFlags, group gdnative.String, notification gdnative.Int)
 	QueueDelete(obj ObjectImplementer)
 	Quit()
+	ReloadCurrentScene() gdnative.Error
 	SetAutoAcceptQuit(enabled gdnative.Bool)
 	SetCurrentScene(childNode ObjectImplementer)
 	SetDebugCollisionsHint(enable gdnative.Bool)
 	SetDebugNavigationHint(enable gdnative.Bool)
 	SetEditedSceneRoot(scene ObjectImplementer)
 	SetGroup(group gdnative.String, property gdnative.String, value gdnative.Variant)
-	SetGroupFlags(callFlags gdnative.Int, group gdnative.String, property gdnative.String, value gdnative.Variant)
+	SetGroupFlags(callFlags SceneTreeGroupCallFlags, group gdnative.String, property gdnative.String, value gdnative.Variant)
 	SetInputAsHandled()
 	SetNetworkPeer(peer NetworkedMultiplayerPeerImplementer)
 	SetPause(enable gdnative.Bool)
@@ -1280,4 +1737,42 @@ type SceneTreeImplementer interface {
 	SetRefuseNewNetworkConnections(refuse gdnative.Bool)
 	SetScreenStretch(mode gdnative.Int, aspect gdnative.Int, minsize gdnative.Vector2, shrink gdnative.Real)
 	SetUseFontOversampling(enable gdnative.Bool)
+	CreateTimerWithDefaults(timeSec gdnative.Real) SceneTreeTimerImplementer
+	SetScreenStretchWithDefaults(mode gdnative.Int, aspect gdnative.Int, minsize gdnative.Vector2)
+	CurrentScene() NodeImplementer
+	DebugCollisionsHint() gdnative.Bool
+	DebugNavigationHint() gdnative.Bool
+	EditedSceneRoot() NodeImplementer
+	NetworkPeer() NetworkedMultiplayerPeerImplementer
+	Paused() gdnative.Bool
+	SetPaused(value gdnative.Bool)
+	RefuseNewNetworkConnections() gdnative.Bool
+	Root() ViewportImplementer
+	UseFontOversampling() gdnative.Bool
+	ConnectConnectedToServer(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitConnectedToServer() error
+	ConnectConnectionFailed(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitConnectionFailed() error
+	ConnectFilesDropped(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFilesDropped(args SceneTreeFilesDroppedArgs) error
+	ConnectIdleFrame(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitIdleFrame() error
+	ConnectNetworkPeerConnected(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNetworkPeerConnected(args SceneTreeNetworkPeerConnectedArgs) error
+	ConnectNetworkPeerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNetworkPeerDisconnected(args SceneTreeNetworkPeerDisconnectedArgs) error
+	ConnectNodeAdded(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNodeAdded(args SceneTreeNodeAddedArgs) error
+	ConnectNodeConfigurationWarningChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNodeConfigurationWarningChanged(args SceneTreeNodeConfigurationWarningChangedArgs) error
+	ConnectNodeRemoved(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNodeRemoved(args SceneTreeNodeRemovedArgs) error
+	ConnectPhysicsFrame(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPhysicsFrame() error
+	ConnectScreenResized(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScreenResized() error
+	ConnectServerDisconnected(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitServerDisconnected() error
+	ConnectTreeChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTreeChanged() error
 }
@@ -13,17 +13,7 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSceneTreeTimerFromPointer(ptr gdnative.Pointer) SceneTreeTimer {
-func newSceneTreeTimerFromPointer(ptr gdnative.Pointer) SceneTreeTimer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SceneTreeTimer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type SceneTreeTimer struct {
 	Reference
@@ -34,9 +24,16 @@ func (o *SceneTreeTimer) BaseClass() string {
 	return "SceneTreeTimer"
 }
 
+// Method binds of the SceneTreeTimer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSceneTreeTimerGetTimeLeft = gdnative.NewLazyMethodBind("SceneTreeTimer", "get_time_left")
+	methodBindSceneTreeTimerSetTimeLeft = gdnative.NewLazyMethodBind("SceneTreeTimer", "set_time_left")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *SceneTreeTimer) GetTimeLeft() gdnative.Real {
 	//log.Println("Calling SceneTreeTimer.GetTimeLeft()")
@@ -45,7 +42,7 @@ func (o *SceneTreeTimer) GetTimeLeft() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTreeTimer", "get_time_left")
+	methodBind := methodBindSceneTreeTimerGetTimeLeft.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +55,8 @@ func (o *SceneTreeTimer) GetTimeLeft() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false time float}], Returns: void
+	        Undocumented
+		Args: [{ false time float}], Returns: void
 */
 func (o *SceneTreeTimer) SetTimeLeft(time gdnative.Real) {
 	//log.Println("Calling SceneTreeTimer.SetTimeLeft()")
@@ -69,7 +66,7 @@ func (o *SceneTreeTimer) SetTimeLeft(time gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(time)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SceneTreeTimer", "set_time_left")
+	methodBind := methodBindSceneTreeTimerSetTimeLeft.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +75,39 @@ func (o *SceneTreeTimer) SetTimeLeft(time gdnative.Real) {
 
 }
 
+// TimeLeft will return the value of the "time_left" property.
+func (o *SceneTreeTimer) TimeLeft() gdnative.Real {
+	return o.GetTimeLeft()
+}
+
+// Signals of the SceneTreeTimer class.
+const (
+	SceneTreeTimerSignalTimeout gdnative.String = "timeout"
+)
+
+// ConnectTimeout will connect the "timeout" signal to the given method of the target object.
+func (o *SceneTreeTimer) ConnectTimeout(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SceneTreeTimerSignalTimeout, target, method, binds, 0)
+}
+
+// EmitTimeout will emit the "timeout" signal.
+func (o *SceneTreeTimer) EmitTimeout() error {
+
+	ret, err := o.EmitSignal(SceneTreeTimerSignalTimeout)
+	ret.Destroy()
+	return err
+}
+
 // SceneTreeTimerImplementer is an interface that implements the methods
 // of the SceneTreeTimer class.
 type SceneTreeTimerImplementer interface {
 	ReferenceImplementer
 	GetTimeLeft() gdnative.Real
 	SetTimeLeft(time gdnative.Real)
+	TimeLeft() gdnative.Real
+	ConnectTimeout(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTimeout() error
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewScriptFromPointer(ptr gdnative.Pointer) Script {
-func newScriptFromPointer(ptr gdnative.Pointer) Script {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Script{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A class stored as a resource. The script exends the functionality of all objects that instance it. The 'new' method of a script subclass creates a new instance. [method Object.set_script] extends an existing object, if that object's class matches one of the script's base classes.
 */
@@ -34,9 +25,24 @@ func (o *Script) BaseClass() string {
 	return "Script"
 }
 
+// Method binds of the Script class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindScriptCanInstance         = gdnative.NewLazyMethodBind("Script", "can_instance")
+	methodBindScriptGetBaseScript       = gdnative.NewLazyMethodBind("Script", "get_base_script")
+	methodBindScriptGetInstanceBaseType = gdnative.NewLazyMethodBind("Script", "get_instance_base_type")
+	methodBindScriptGetSourceCode       = gdnative.NewLazyMethodBind("Script", "get_source_code")
+	methodBindScriptHasScriptSignal     = gdnative.NewLazyMethodBind("Script", "has_script_signal")
+	methodBindScriptHasSourceCode       = gdnative.NewLazyMethodBind("Script", "has_source_code")
+	methodBindScriptInstanceHas         = gdnative.NewLazyMethodBind("Script", "instance_has")
+	methodBindScriptIsTool              = gdnative.NewLazyMethodBind("Script", "is_tool")
+	methodBindScriptReload              = gdnative.NewLazyMethodBind("Script", "reload")
+	methodBindScriptSetSourceCode       = gdnative.NewLazyMethodBind("Script", "set_source_code")
+)
+
 /*
-        Returns true if the script can be instanced.
-	Args: [], Returns: bool
+	        Returns true if the script can be instanced.
+		Args: [], Returns: bool
 */
 func (o *Script) CanInstance() gdnative.Bool {
 	//log.Println("Calling Script.CanInstance()")
@@ -45,7 +51,7 @@ func (o *Script) CanInstance() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "can_instance")
+	methodBind := methodBindScriptCanInstance.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +64,7 @@ func (o *Script) CanInstance() gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: Script
+Args: [], Returns: Script
 */
 func (o *Script) GetBaseScript() ScriptImplementer {
 	//log.Println("Calling Script.GetBaseScript()")
@@ -68,7 +73,7 @@ func (o *Script) GetBaseScript() ScriptImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "get_base_script")
+	methodBind := methodBindScriptGetBaseScript.Get()
 
 	// Call the parent method.
 	// Script
@@ -76,27 +81,24 @@ func (o *Script) GetBaseScript() ScriptImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newScriptFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ScriptImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Script" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ScriptImplementer)
+	ret := Script{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ScriptImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: String
+Args: [], Returns: String
 */
 func (o *Script) GetInstanceBaseType() gdnative.String {
 	//log.Println("Calling Script.GetInstanceBaseType()")
@@ -105,7 +107,7 @@ func (o *Script) GetInstanceBaseType() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "get_instance_base_type")
+	methodBind := methodBindScriptGetInstanceBaseType.Get()
 
 	// Call the parent method.
 	// String
@@ -118,8 +120,8 @@ func (o *Script) GetInstanceBaseType() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Script) GetSourceCode() gdnative.String {
 	//log.Println("Calling Script.GetSourceCode()")
@@ -128,7 +130,7 @@ func (o *Script) GetSourceCode() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "get_source_code")
+	methodBind := methodBindScriptGetSourceCode.Get()
 
 	// Call the parent method.
 	// String
@@ -141,8 +143,8 @@ func (o *Script) GetSourceCode() gdnative.String {
 }
 
 /*
-        Returns true if the script, or a base class, defines a signal with the given name.
-	Args: [{ false signal_name String}], Returns: bool
+	        Returns true if the script, or a base class, defines a signal with the given name.
+		Args: [{ false signal_name String}], Returns: bool
 */
 func (o *Script) HasScriptSignal(signalName gdnative.String) gdnative.Bool {
 	//log.Println("Calling Script.HasScriptSignal()")
@@ -152,7 +154,7 @@ func (o *Script) HasScriptSignal(signalName gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(signalName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "has_script_signal")
+	methodBind := methodBindScriptHasScriptSignal.Get()
 
 	// Call the parent method.
 	// bool
@@ -165,8 +167,8 @@ func (o *Script) HasScriptSignal(signalName gdnative.String) gdnative.Bool {
 }
 
 /*
-        Returns true if the script contains non-empty source code.
-	Args: [], Returns: bool
+	        Returns true if the script contains non-empty source code.
+		Args: [], Returns: bool
 */
 func (o *Script) HasSourceCode() gdnative.Bool {
 	//log.Println("Calling Script.HasSourceCode()")
@@ -175,7 +177,7 @@ func (o *Script) HasSourceCode() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "has_source_code")
+	methodBind := methodBindScriptHasSourceCode.Get()
 
 	// Call the parent method.
 	// bool
@@ -188,18 +190,18 @@ func (o *Script) HasSourceCode() gdnative.Bool {
 }
 
 /*
-        Returns true if 'base_object' is an instance of this script.
-	Args: [{ false base_object Object}], Returns: bool
+	        Returns true if 'base_object' is an instance of this script.
+		Args: [{ false base_object Object}], Returns: bool
 */
 func (o *Script) InstanceHas(baseObject ObjectImplementer) gdnative.Bool {
 	//log.Println("Calling Script.InstanceHas()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(baseObject.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(baseObject))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "instance_has")
+	methodBind := methodBindScriptInstanceHas.Get()
 
 	// Call the parent method.
 	// bool
@@ -212,8 +214,8 @@ func (o *Script) InstanceHas(baseObject ObjectImplementer) gdnative.Bool {
 }
 
 /*
-        Returns true if the script is a tool script. A tool script can run in the editor.
-	Args: [], Returns: bool
+	        Returns true if the script is a tool script. A tool script can run in the editor.
+		Args: [], Returns: bool
 */
 func (o *Script) IsTool() gdnative.Bool {
 	//log.Println("Calling Script.IsTool()")
@@ -222,7 +224,7 @@ func (o *Script) IsTool() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "is_tool")
+	methodBind := methodBindScriptIsTool.Get()
 
 	// Call the parent method.
 	// bool
@@ -235,8 +237,8 @@ func (o *Script) IsTool() gdnative.Bool {
 }
 
 /*
-        Reloads the script's class implementation. Returns an error code.
-	Args: [{False true keep_state bool}], Returns: enum.Error
+	        Reloads the script's class implementation. Returns an error code.
+		Args: [{False true keep_state bool}], Returns: enum.Error
 */
 func (o *Script) Reload(keepState gdnative.Bool) gdnative.Error {
 	//log.Println("Calling Script.Reload()")
@@ -246,7 +248,7 @@ func (o *Script) Reload(keepState gdnative.Bool) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromBool(keepState)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "reload")
+	methodBind := methodBindScriptReload.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -259,8 +261,8 @@ func (o *Script) Reload(keepState gdnative.Bool) gdnative.Error {
 }
 
 /*
-        Undocumented
-	Args: [{ false source String}], Returns: void
+	        Undocumented
+		Args: [{ false source String}], Returns: void
 */
 func (o *Script) SetSourceCode(source gdnative.String) {
 	//log.Println("Calling Script.SetSourceCode()")
@@ -270,7 +272,7 @@ func (o *Script) SetSourceCode(source gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(source)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Script", "set_source_code")
+	methodBind := methodBindScriptSetSourceCode.Get()
 
 	// Call the parent method.
 	// void
@@ -279,6 +281,16 @@ func (o *Script) SetSourceCode(source gdnative.String) {
 
 }
 
+// ReloadWithDefaults will call Reload using the default values for: keep_state.
+func (o *Script) ReloadWithDefaults() gdnative.Error {
+	return o.Reload(false)
+}
+
+// SourceCode will return the value of the "source_code" property.
+func (o *Script) SourceCode() gdnative.String {
+	return o.GetSourceCode()
+}
+
 // ScriptImplementer is an interface that implements the methods
 // of the Script class.
 type ScriptImplementer interface {
@@ -291,5 +303,8 @@ type ScriptImplementer interface {
 	HasSourceCode() gdnative.Bool
 	InstanceHas(baseObject ObjectImplementer) gdnative.Bool
 	IsTool() gdnative.Bool
+	Reload(keepState gdnative.Bool) gdnative.Error
 	SetSourceCode(source gdnative.String)
+	ReloadWithDefaults() gdnative.Error
+	SourceCode() gdnative.String
 }
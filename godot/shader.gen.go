@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,13 +24,30 @@ const (
 	ShaderModeSpatial    ShaderMode = 0
 )
 
-//func NewShaderFromPointer(ptr gdnative.Pointer) Shader {
-func newShaderFromPointer(ptr gdnative.Pointer) Shader {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Shader{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ShaderMode value.
+func (e ShaderMode) String() string {
+	switch e {
+	case ShaderModeCanvasItem:
+		return "MODE_CANVAS_ITEM"
+	case ShaderModeParticles:
+		return "MODE_PARTICLES"
+	case ShaderModeSpatial:
+		return "MODE_SPATIAL"
+	}
+	return fmt.Sprintf("ShaderMode(%d)", int(e))
+}
 
-	return obj
+// ParseShaderMode will return the ShaderMode value with the given Godot name.
+func ParseShaderMode(name string) (ShaderMode, error) {
+	switch name {
+	case "MODE_CANVAS_ITEM":
+		return ShaderModeCanvasItem, nil
+	case "MODE_PARTICLES":
+		return ShaderModeParticles, nil
+	case "MODE_SPATIAL":
+		return ShaderModeSpatial, nil
+	}
+	return 0, fmt.Errorf("invalid ShaderMode value %q", name)
 }
 
 /*
@@ -39,13 +58,41 @@ type Shader struct {
 	owner gdnative.Object
 }
 
+// classConstructorShader is the class constructor of the Shader class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorShader = gdnative.NewLazyClassConstructor("Shader")
+
+// NewShader will create a new instance of the Shader class.
+// Shader is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewShader() *Shader {
+	obj := &Shader{}
+	obj.SetBaseObject(classConstructorShader.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Shader) BaseClass() string {
 	return "Shader"
 }
 
+// Method binds of the Shader class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindShaderGetCode                = gdnative.NewLazyMethodBind("Shader", "get_code")
+	methodBindShaderGetDefaultTextureParam = gdnative.NewLazyMethodBind("Shader", "get_default_texture_param")
+	methodBindShaderGetMode                = gdnative.NewLazyMethodBind("Shader", "get_mode")
+	methodBindShaderHasParam               = gdnative.NewLazyMethodBind("Shader", "has_param")
+	methodBindShaderSetCode                = gdnative.NewLazyMethodBind("Shader", "set_code")
+	methodBindShaderSetDefaultTextureParam = gdnative.NewLazyMethodBind("Shader", "set_default_texture_param")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *Shader) GetCode() gdnative.String {
 	//log.Println("Calling Shader.GetCode()")
@@ -54,7 +101,7 @@ func (o *Shader) GetCode() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shader", "get_code")
+	methodBind := methodBindShaderGetCode.Get()
 
 	// Call the parent method.
 	// String
@@ -67,8 +114,7 @@ func (o *Shader) GetCode() gdnative.String {
 }
 
 /*
-
-	Args: [{ false param String}], Returns: Texture
+Args: [{ false param String}], Returns: Texture
 */
 func (o *Shader) GetDefaultTextureParam(param gdnative.String) TextureImplementer {
 	//log.Println("Calling Shader.GetDefaultTextureParam()")
@@ -78,7 +124,7 @@ func (o *Shader) GetDefaultTextureParam(param gdnative.String) TextureImplemente
 	ptrArguments[0] = gdnative.NewPointerFromString(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shader", "get_default_texture_param")
+	methodBind := methodBindShaderGetDefaultTextureParam.Get()
 
 	// Call the parent method.
 	// Texture
@@ -86,27 +132,24 @@ func (o *Shader) GetDefaultTextureParam(param gdnative.String) TextureImplemente
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [], Returns: enum.Shader::Mode
+Args: [], Returns: enum.Shader::Mode
 */
 func (o *Shader) GetMode() ShaderMode {
 	//log.Println("Calling Shader.GetMode()")
@@ -115,7 +158,7 @@ func (o *Shader) GetMode() ShaderMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shader", "get_mode")
+	methodBind := methodBindShaderGetMode.Get()
 
 	// Call the parent method.
 	// enum.Shader::Mode
@@ -128,8 +171,7 @@ func (o *Shader) GetMode() ShaderMode {
 }
 
 /*
-
-	Args: [{ false name String}], Returns: bool
+Args: [{ false name String}], Returns: bool
 */
 func (o *Shader) HasParam(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling Shader.HasParam()")
@@ -139,7 +181,7 @@ func (o *Shader) HasParam(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shader", "has_param")
+	methodBind := methodBindShaderHasParam.Get()
 
 	// Call the parent method.
 	// bool
@@ -152,8 +194,8 @@ func (o *Shader) HasParam(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false code String}], Returns: void
+	        Undocumented
+		Args: [{ false code String}], Returns: void
 */
 func (o *Shader) SetCode(code gdnative.String) {
 	//log.Println("Calling Shader.SetCode()")
@@ -163,7 +205,7 @@ func (o *Shader) SetCode(code gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(code)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shader", "set_code")
+	methodBind := methodBindShaderSetCode.Get()
 
 	// Call the parent method.
 	// void
@@ -173,8 +215,7 @@ func (o *Shader) SetCode(code gdnative.String) {
 }
 
 /*
-
-	Args: [{ false param String} { false texture Texture}], Returns: void
+Args: [{ false param String} { false texture Texture}], Returns: void
 */
 func (o *Shader) SetDefaultTextureParam(param gdnative.String, texture TextureImplementer) {
 	//log.Println("Calling Shader.SetDefaultTextureParam()")
@@ -182,10 +223,10 @@ func (o *Shader) SetDefaultTextureParam(param gdnative.String, texture TextureIm
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromString(param)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shader", "set_default_texture_param")
+	methodBind := methodBindShaderSetDefaultTextureParam.Get()
 
 	// Call the parent method.
 	// void
@@ -194,13 +235,20 @@ func (o *Shader) SetDefaultTextureParam(param gdnative.String, texture TextureIm
 
 }
 
+// Code will return the value of the "code" property.
+func (o *Shader) Code() gdnative.String {
+	return o.GetCode()
+}
+
 // ShaderImplementer is an interface that implements the methods
 // of the Shader class.
 type ShaderImplementer interface {
 	ResourceImplementer
 	GetCode() gdnative.String
 	GetDefaultTextureParam(param gdnative.String) TextureImplementer
+	GetMode() ShaderMode
 	HasParam(name gdnative.String) gdnative.Bool
 	SetCode(code gdnative.String)
 	SetDefaultTextureParam(param gdnative.String, texture TextureImplementer)
+	Code() gdnative.String
 }
@@ -1,7 +1,8 @@
-package godot
+package shader
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,39 +14,55 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewShaderMaterialFromPointer(ptr gdnative.Pointer) ShaderMaterial {
-func newShaderMaterialFromPointer(ptr gdnative.Pointer) ShaderMaterial {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ShaderMaterial{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type ShaderMaterial struct {
-	Material
+	godot.Material
 	owner gdnative.Object
 }
 
+// classConstructorShaderMaterial is the class constructor of the ShaderMaterial class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorShaderMaterial = gdnative.NewLazyClassConstructor("ShaderMaterial")
+
+// NewShaderMaterial will create a new instance of the ShaderMaterial class.
+// ShaderMaterial is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewShaderMaterial() *ShaderMaterial {
+	obj := &ShaderMaterial{}
+	obj.SetBaseObject(classConstructorShaderMaterial.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ShaderMaterial) BaseClass() string {
 	return "ShaderMaterial"
 }
 
+// Method binds of the ShaderMaterial class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindShaderMaterialGetShader      = gdnative.NewLazyMethodBind("ShaderMaterial", "get_shader")
+	methodBindShaderMaterialGetShaderParam = gdnative.NewLazyMethodBind("ShaderMaterial", "get_shader_param")
+	methodBindShaderMaterialSetShader      = gdnative.NewLazyMethodBind("ShaderMaterial", "set_shader")
+	methodBindShaderMaterialSetShaderParam = gdnative.NewLazyMethodBind("ShaderMaterial", "set_shader_param")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Shader
+	        Undocumented
+		Args: [], Returns: Shader
 */
-func (o *ShaderMaterial) GetShader() ShaderImplementer {
+func (o *ShaderMaterial) GetShader() godot.ShaderImplementer {
 	//log.Println("Calling ShaderMaterial.GetShader()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShaderMaterial", "get_shader")
+	methodBind := methodBindShaderMaterialGetShader.Get()
 
 	// Call the parent method.
 	// Shader
@@ -53,27 +70,24 @@ func (o *ShaderMaterial) GetShader() ShaderImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShaderFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShaderImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shader" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShaderImplementer)
+	ret := godot.Shader{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ShaderImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false param String}], Returns: Variant
+Args: [{ false param String}], Returns: Variant
 */
 func (o *ShaderMaterial) GetShaderParam(param gdnative.String) gdnative.Variant {
 	//log.Println("Calling ShaderMaterial.GetShaderParam()")
@@ -83,7 +97,7 @@ func (o *ShaderMaterial) GetShaderParam(param gdnative.String) gdnative.Variant
 	ptrArguments[0] = gdnative.NewPointerFromString(param)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShaderMaterial", "get_shader_param")
+	methodBind := methodBindShaderMaterialGetShaderParam.Get()
 
 	// Call the parent method.
 	// Variant
@@ -96,18 +110,18 @@ func (o *ShaderMaterial) GetShaderParam(param gdnative.String) gdnative.Variant
 }
 
 /*
-        Undocumented
-	Args: [{ false shader Shader}], Returns: void
+	        Undocumented
+		Args: [{ false shader Shader}], Returns: void
 */
-func (o *ShaderMaterial) SetShader(shader ShaderImplementer) {
+func (o *ShaderMaterial) SetShader(shader godot.ShaderImplementer) {
 	//log.Println("Calling ShaderMaterial.SetShader()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shader.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(shader))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShaderMaterial", "set_shader")
+	methodBind := methodBindShaderMaterialSetShader.Get()
 
 	// Call the parent method.
 	// void
@@ -117,8 +131,7 @@ func (o *ShaderMaterial) SetShader(shader ShaderImplementer) {
 }
 
 /*
-
-	Args: [{ false param String} { false value Variant}], Returns: void
+Args: [{ false param String} { false value Variant}], Returns: void
 */
 func (o *ShaderMaterial) SetShaderParam(param gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling ShaderMaterial.SetShaderParam()")
@@ -129,7 +142,7 @@ func (o *ShaderMaterial) SetShaderParam(param gdnative.String, value gdnative.Va
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShaderMaterial", "set_shader_param")
+	methodBind := methodBindShaderMaterialSetShaderParam.Get()
 
 	// Call the parent method.
 	// void
@@ -138,12 +151,18 @@ func (o *ShaderMaterial) SetShaderParam(param gdnative.String, value gdnative.Va
 
 }
 
+// Shader will return the value of the "shader" property.
+func (o *ShaderMaterial) Shader() godot.ShaderImplementer {
+	return o.GetShader()
+}
+
 // ShaderMaterialImplementer is an interface that implements the methods
 // of the ShaderMaterial class.
 type ShaderMaterialImplementer interface {
-	MaterialImplementer
-	GetShader() ShaderImplementer
+	godot.MaterialImplementer
+	GetShader() godot.ShaderImplementer
 	GetShaderParam(param gdnative.String) gdnative.Variant
-	SetShader(shader ShaderImplementer)
+	SetShader(shader godot.ShaderImplementer)
 	SetShaderParam(param gdnative.String, value gdnative.Variant)
+	Shader() godot.ShaderImplementer
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewShapeFromPointer(ptr gdnative.Pointer) Shape {
-func newShapeFromPointer(ptr gdnative.Pointer) Shape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Shape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base class for all 3D shape resources. All 3D shapes that inherit from this can be set into a [PhysicsBody] or [Area].
 */
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewShape2DFromPointer(ptr gdnative.Pointer) Shape2D {
-func newShape2DFromPointer(ptr gdnative.Pointer) Shape2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Shape2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base class for all 2D Shapes. All 2D shape types inherit from this.
 */
@@ -34,9 +25,20 @@ func (o *Shape2D) BaseClass() string {
 	return "Shape2D"
 }
 
+// Method binds of the Shape2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindShape2DCollide                         = gdnative.NewLazyMethodBind("Shape2D", "collide")
+	methodBindShape2DCollideAndGetContacts           = gdnative.NewLazyMethodBind("Shape2D", "collide_and_get_contacts")
+	methodBindShape2DCollideWithMotion               = gdnative.NewLazyMethodBind("Shape2D", "collide_with_motion")
+	methodBindShape2DCollideWithMotionAndGetContacts = gdnative.NewLazyMethodBind("Shape2D", "collide_with_motion_and_get_contacts")
+	methodBindShape2DGetCustomSolverBias             = gdnative.NewLazyMethodBind("Shape2D", "get_custom_solver_bias")
+	methodBindShape2DSetCustomSolverBias             = gdnative.NewLazyMethodBind("Shape2D", "set_custom_solver_bias")
+)
+
 /*
-        Return whether this shape is colliding with another. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the shape to check collisions with ([code]with_shape[/code]), and the transformation matrix of that shape ([code]shape_xform[/code]).
-	Args: [{ false local_xform Transform2D} { false with_shape Shape2D} { false shape_xform Transform2D}], Returns: bool
+	        Return whether this shape is colliding with another. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the shape to check collisions with ([code]with_shape[/code]), and the transformation matrix of that shape ([code]shape_xform[/code]).
+		Args: [{ false local_xform Transform2D} { false with_shape Shape2D} { false shape_xform Transform2D}], Returns: bool
 */
 func (o *Shape2D) Collide(localXform gdnative.Transform2D, withShape Shape2DImplementer, shapeXform gdnative.Transform2D) gdnative.Bool {
 	//log.Println("Calling Shape2D.Collide()")
@@ -44,11 +46,11 @@ func (o *Shape2D) Collide(localXform gdnative.Transform2D, withShape Shape2DImpl
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(localXform)
-	ptrArguments[1] = gdnative.NewPointerFromObject(withShape.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(withShape))
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(shapeXform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shape2D", "collide")
+	methodBind := methodBindShape2DCollide.Get()
 
 	// Call the parent method.
 	// bool
@@ -61,8 +63,8 @@ func (o *Shape2D) Collide(localXform gdnative.Transform2D, withShape Shape2DImpl
 }
 
 /*
-        Return a list of the points where this shape touches another. If there are no collisions, the list is empty. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the shape to check collisions with ([code]with_shape[/code]), and the transformation matrix of that shape ([code]shape_xform[/code]).
-	Args: [{ false local_xform Transform2D} { false with_shape Shape2D} { false shape_xform Transform2D}], Returns: Variant
+	        Return a list of the points where this shape touches another. If there are no collisions, the list is empty. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the shape to check collisions with ([code]with_shape[/code]), and the transformation matrix of that shape ([code]shape_xform[/code]).
+		Args: [{ false local_xform Transform2D} { false with_shape Shape2D} { false shape_xform Transform2D}], Returns: Variant
 */
 func (o *Shape2D) CollideAndGetContacts(localXform gdnative.Transform2D, withShape Shape2DImplementer, shapeXform gdnative.Transform2D) gdnative.Variant {
 	//log.Println("Calling Shape2D.CollideAndGetContacts()")
@@ -70,11 +72,11 @@ func (o *Shape2D) CollideAndGetContacts(localXform gdnative.Transform2D, withSha
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(localXform)
-	ptrArguments[1] = gdnative.NewPointerFromObject(withShape.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(withShape))
 	ptrArguments[2] = gdnative.NewPointerFromTransform2D(shapeXform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shape2D", "collide_and_get_contacts")
+	methodBind := methodBindShape2DCollideAndGetContacts.Get()
 
 	// Call the parent method.
 	// Variant
@@ -87,8 +89,8 @@ func (o *Shape2D) CollideAndGetContacts(localXform gdnative.Transform2D, withSha
 }
 
 /*
-        Return whether this shape would collide with another, if a given movement was applied. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the movement to test on this shape ([code]local_motion[/code]), the shape to check collisions with ([code]with_shape[/code]), the transformation matrix of that shape ([code]shape_xform[/code]), and the movement to test onto the other object ([code]shape_motion[/code]).
-	Args: [{ false local_xform Transform2D} { false local_motion Vector2} { false with_shape Shape2D} { false shape_xform Transform2D} { false shape_motion Vector2}], Returns: bool
+	        Return whether this shape would collide with another, if a given movement was applied. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the movement to test on this shape ([code]local_motion[/code]), the shape to check collisions with ([code]with_shape[/code]), the transformation matrix of that shape ([code]shape_xform[/code]), and the movement to test onto the other object ([code]shape_motion[/code]).
+		Args: [{ false local_xform Transform2D} { false local_motion Vector2} { false with_shape Shape2D} { false shape_xform Transform2D} { false shape_motion Vector2}], Returns: bool
 */
 func (o *Shape2D) CollideWithMotion(localXform gdnative.Transform2D, localMotion gdnative.Vector2, withShape Shape2DImplementer, shapeXform gdnative.Transform2D, shapeMotion gdnative.Vector2) gdnative.Bool {
 	//log.Println("Calling Shape2D.CollideWithMotion()")
@@ -97,12 +99,12 @@ func (o *Shape2D) CollideWithMotion(localXform gdnative.Transform2D, localMotion
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(localXform)
 	ptrArguments[1] = gdnative.NewPointerFromVector2(localMotion)
-	ptrArguments[2] = gdnative.NewPointerFromObject(withShape.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(GetBaseObject(withShape))
 	ptrArguments[3] = gdnative.NewPointerFromTransform2D(shapeXform)
 	ptrArguments[4] = gdnative.NewPointerFromVector2(shapeMotion)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shape2D", "collide_with_motion")
+	methodBind := methodBindShape2DCollideWithMotion.Get()
 
 	// Call the parent method.
 	// bool
@@ -115,8 +117,8 @@ func (o *Shape2D) CollideWithMotion(localXform gdnative.Transform2D, localMotion
 }
 
 /*
-        Return a list of the points where this shape would touch another, if a given movement was applied. If there are no collisions, the list is empty. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the movement to test on this shape ([code]local_motion[/code]), the shape to check collisions with ([code]with_shape[/code]), the transformation matrix of that shape ([code]shape_xform[/code]), and the movement to test onto the other object ([code]shape_motion[/code]).
-	Args: [{ false local_xform Transform2D} { false local_motion Vector2} { false with_shape Shape2D} { false shape_xform Transform2D} { false shape_motion Vector2}], Returns: Variant
+	        Return a list of the points where this shape would touch another, if a given movement was applied. If there are no collisions, the list is empty. This method needs the transformation matrix for this shape ([code]local_xform[/code]), the movement to test on this shape ([code]local_motion[/code]), the shape to check collisions with ([code]with_shape[/code]), the transformation matrix of that shape ([code]shape_xform[/code]), and the movement to test onto the other object ([code]shape_motion[/code]).
+		Args: [{ false local_xform Transform2D} { false local_motion Vector2} { false with_shape Shape2D} { false shape_xform Transform2D} { false shape_motion Vector2}], Returns: Variant
 */
 func (o *Shape2D) CollideWithMotionAndGetContacts(localXform gdnative.Transform2D, localMotion gdnative.Vector2, withShape Shape2DImplementer, shapeXform gdnative.Transform2D, shapeMotion gdnative.Vector2) gdnative.Variant {
 	//log.Println("Calling Shape2D.CollideWithMotionAndGetContacts()")
@@ -125,12 +127,12 @@ func (o *Shape2D) CollideWithMotionAndGetContacts(localXform gdnative.Transform2
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(localXform)
 	ptrArguments[1] = gdnative.NewPointerFromVector2(localMotion)
-	ptrArguments[2] = gdnative.NewPointerFromObject(withShape.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(GetBaseObject(withShape))
 	ptrArguments[3] = gdnative.NewPointerFromTransform2D(shapeXform)
 	ptrArguments[4] = gdnative.NewPointerFromVector2(shapeMotion)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shape2D", "collide_with_motion_and_get_contacts")
+	methodBind := methodBindShape2DCollideWithMotionAndGetContacts.Get()
 
 	// Call the parent method.
 	// Variant
@@ -143,8 +145,8 @@ func (o *Shape2D) CollideWithMotionAndGetContacts(localXform gdnative.Transform2
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Shape2D) GetCustomSolverBias() gdnative.Real {
 	//log.Println("Calling Shape2D.GetCustomSolverBias()")
@@ -153,7 +155,7 @@ func (o *Shape2D) GetCustomSolverBias() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shape2D", "get_custom_solver_bias")
+	methodBind := methodBindShape2DGetCustomSolverBias.Get()
 
 	// Call the parent method.
 	// float
@@ -166,8 +168,8 @@ func (o *Shape2D) GetCustomSolverBias() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false bias float}], Returns: void
+	        Undocumented
+		Args: [{ false bias float}], Returns: void
 */
 func (o *Shape2D) SetCustomSolverBias(bias gdnative.Real) {
 	//log.Println("Calling Shape2D.SetCustomSolverBias()")
@@ -177,7 +179,7 @@ func (o *Shape2D) SetCustomSolverBias(bias gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(bias)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Shape2D", "set_custom_solver_bias")
+	methodBind := methodBindShape2DSetCustomSolverBias.Get()
 
 	// Call the parent method.
 	// void
@@ -186,6 +188,11 @@ func (o *Shape2D) SetCustomSolverBias(bias gdnative.Real) {
 
 }
 
+// CustomSolverBias will return the value of the "custom_solver_bias" property.
+func (o *Shape2D) CustomSolverBias() gdnative.Real {
+	return o.GetCustomSolverBias()
+}
+
 // Shape2DImplementer is an interface that implements the methods
 // of the Shape2D class.
 type Shape2DImplementer interface {
@@ -196,4 +203,5 @@ type Shape2DImplementer interface {
 	CollideWithMotionAndGetContacts(localXform gdnative.Transform2D, localMotion gdnative.Vector2, withShape Shape2DImplementer, shapeXform gdnative.Transform2D, shapeMotion gdnative.Vector2) gdnative.Variant
 	GetCustomSolverBias() gdnative.Real
 	SetCustomSolverBias(bias gdnative.Real)
+	CustomSolverBias() gdnative.Real
 }
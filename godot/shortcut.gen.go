@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewShortCutFromPointer(ptr gdnative.Pointer) ShortCut {
-func newShortCutFromPointer(ptr gdnative.Pointer) ShortCut {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ShortCut{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A shortcut for binding input. Shortcuts are commonly used for interacting with a [Control] element from a [InputEvent].
 */
@@ -30,13 +21,40 @@ type ShortCut struct {
 	owner gdnative.Object
 }
 
+// classConstructorShortCut is the class constructor of the ShortCut class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorShortCut = gdnative.NewLazyClassConstructor("ShortCut")
+
+// NewShortCut will create a new instance of the ShortCut class.
+// ShortCut is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewShortCut() *ShortCut {
+	obj := &ShortCut{}
+	obj.SetBaseObject(classConstructorShortCut.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ShortCut) BaseClass() string {
 	return "ShortCut"
 }
 
+// Method binds of the ShortCut class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindShortCutGetAsText   = gdnative.NewLazyMethodBind("ShortCut", "get_as_text")
+	methodBindShortCutGetShortcut = gdnative.NewLazyMethodBind("ShortCut", "get_shortcut")
+	methodBindShortCutIsShortcut  = gdnative.NewLazyMethodBind("ShortCut", "is_shortcut")
+	methodBindShortCutIsValid     = gdnative.NewLazyMethodBind("ShortCut", "is_valid")
+	methodBindShortCutSetShortcut = gdnative.NewLazyMethodBind("ShortCut", "set_shortcut")
+)
+
 /*
-        Returns the Shortcut's [InputEvent] as a [String].
-	Args: [], Returns: String
+	        Returns the Shortcut's [InputEvent] as a [String].
+		Args: [], Returns: String
 */
 func (o *ShortCut) GetAsText() gdnative.String {
 	//log.Println("Calling ShortCut.GetAsText()")
@@ -45,7 +63,7 @@ func (o *ShortCut) GetAsText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShortCut", "get_as_text")
+	methodBind := methodBindShortCutGetAsText.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +76,8 @@ func (o *ShortCut) GetAsText() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: InputEvent
+	        Undocumented
+		Args: [], Returns: InputEvent
 */
 func (o *ShortCut) GetShortcut() InputEventImplementer {
 	//log.Println("Calling ShortCut.GetShortcut()")
@@ -68,7 +86,7 @@ func (o *ShortCut) GetShortcut() InputEventImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShortCut", "get_shortcut")
+	methodBind := methodBindShortCutGetShortcut.Get()
 
 	// Call the parent method.
 	// InputEvent
@@ -76,37 +94,35 @@ func (o *ShortCut) GetShortcut() InputEventImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newInputEventFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(InputEventImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "InputEvent" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(InputEventImplementer)
+	ret := InputEvent{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(InputEventImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns [code]true[/code] if the Shortcut's [InputEvent] equals [code]event[/code].
-	Args: [{ false event InputEvent}], Returns: bool
+	        Returns [code]true[/code] if the Shortcut's [InputEvent] equals [code]event[/code].
+		Args: [{ false event InputEvent}], Returns: bool
 */
 func (o *ShortCut) IsShortcut(event InputEventImplementer) gdnative.Bool {
 	//log.Println("Calling ShortCut.IsShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShortCut", "is_shortcut")
+	methodBind := methodBindShortCutIsShortcut.Get()
 
 	// Call the parent method.
 	// bool
@@ -119,8 +135,8 @@ func (o *ShortCut) IsShortcut(event InputEventImplementer) gdnative.Bool {
 }
 
 /*
-        If [code]true[/code] this Shortcut is valid.
-	Args: [], Returns: bool
+	        If [code]true[/code] this Shortcut is valid.
+		Args: [], Returns: bool
 */
 func (o *ShortCut) IsValid() gdnative.Bool {
 	//log.Println("Calling ShortCut.IsValid()")
@@ -129,7 +145,7 @@ func (o *ShortCut) IsValid() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShortCut", "is_valid")
+	methodBind := methodBindShortCutIsValid.Get()
 
 	// Call the parent method.
 	// bool
@@ -142,18 +158,18 @@ func (o *ShortCut) IsValid() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false event InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false event InputEvent}], Returns: void
 */
 func (o *ShortCut) SetShortcut(event InputEventImplementer) {
 	//log.Println("Calling ShortCut.SetShortcut()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ShortCut", "set_shortcut")
+	methodBind := methodBindShortCutSetShortcut.Get()
 
 	// Call the parent method.
 	// void
@@ -162,6 +178,11 @@ func (o *ShortCut) SetShortcut(event InputEventImplementer) {
 
 }
 
+// Shortcut will return the value of the "shortcut" property.
+func (o *ShortCut) Shortcut() InputEventImplementer {
+	return o.GetShortcut()
+}
+
 // ShortCutImplementer is an interface that implements the methods
 // of the ShortCut class.
 type ShortCutImplementer interface {
@@ -171,4 +192,5 @@ type ShortCutImplementer interface {
 	IsShortcut(event InputEventImplementer) gdnative.Bool
 	IsValid() gdnative.Bool
 	SetShortcut(event InputEventImplementer)
+	Shortcut() InputEventImplementer
 }
@@ -1,25 +1,174 @@
 package godot
 
 import (
+	"fmt"
 	"github.com/shadowapex/godot-go/gdnative"
+	"reflect"
 )
 
-// Signal is a structure definition that defines a custom Godot signal.
+// Signal is a structure definition that defines a custom Godot signal. Signal
+// fields of a registered class are bound to their instance when it is created,
+// so the signal can be emitted with Emit.
 type Signal struct {
 	Name        string
 	Args        []SignalArg
 	DefaultArgs []SignalDefaultArg
+
+	owner Class
 }
 
-// SignalArg is any valid Godot class or variant type.
+// SignalArg is any valid Godot class or variant type. The type of the argument
+// is given by Type, where VariantTypeNil means the argument can be of any type.
+// If Value is set, the type of the value is used instead.
 type SignalArg struct {
 	Name       gdnative.String
+	Type       gdnative.VariantType
 	Value      interface{}
 	Hint       gdnative.PropertyHint
 	HintString gdnative.String
 	Usage      gdnative.PropertyUsageFlags
-	//Type         Int
 	//DefaultValue Variant
 }
 
+// argType will return the variant type of the signal argument.
+func (a SignalArg) argType() gdnative.VariantType {
+	if a.Value == nil {
+		return a.Type
+	}
+	return VariantTypeToConstant(reflect.TypeOf(a.Value))
+}
+
+// Emit will emit the signal from the instance it is bound to. The arguments are
+// converted into Variants, and must match the types of the signal arguments.
+// Trailing arguments that have default values can be left out, and their
+// default values are emitted instead.
+func (s *Signal) Emit(args ...interface{}) error {
+	if s.owner == nil {
+		return fmt.Errorf("signal %s is not bound to an instance", s.Name)
+	}
+	required := len(s.Args) - len(s.DefaultArgs)
+	if len(args) < required || len(args) > len(s.Args) {
+		return fmt.Errorf("signal %s takes %d arguments, got %d", s.Name, len(s.Args), len(args))
+	}
+
+	// Godot does not fill in the default arguments of signals, so add the
+	// defaults of the arguments that were left out.
+	for i := len(args); i < len(s.Args); i++ {
+		args = append(args, s.DefaultArgs[i-required])
+	}
+
+	variants := make([]gdnative.Variant, 0, len(args))
+	for i, arg := range args {
+		variant, owned, err := signalArgVariant(s.Args[i], arg)
+		if err != nil {
+			return fmt.Errorf("invalid argument %d of signal %s: %s", i, s.Name, err)
+		}
+		variants = append(variants, variant)
+
+		// Free the variants we created once the signal has been emitted.
+		if owned {
+			defer variant.Destroy()
+		}
+	}
+
+	object := Object{owner: s.owner.GetBaseObject()}
+	ret, err := object.EmitSignal(gdnative.String(s.Name), variants...)
+	ret.Destroy()
+
+	return err
+}
+
+// signalArgVariant will convert the given value into a Variant of the type of
+// the given signal argument. It returns true if the Variant was created for the
+// value, and has to be destroyed by the caller.
+func signalArgVariant(signalArg SignalArg, value interface{}) (gdnative.Variant, bool, error) {
+	variant, err := ToVariant(value)
+	if err != nil {
+		return variant, false, err
+	}
+	owned := !isVariantValue(reflect.ValueOf(value))
+
+	// Arguments of any type accept all values, and objects may be null.
+	argType := signalArg.argType()
+	valueType := variant.GetType()
+	switch {
+	case argType == gdnative.VariantTypeNil, argType == valueType:
+		return variant, owned, nil
+	case argType == gdnative.VariantTypeObject && valueType == gdnative.VariantTypeNil:
+		return variant, owned, nil
+	case argType == gdnative.VariantTypeReal && valueType == gdnative.VariantTypeInt:
+		realVariant := gdnative.NewVariantReal(gdnative.Double(variant.AsInt()))
+		if owned {
+			variant.Destroy()
+		}
+		return realVariant, true, nil
+	}
+
+	if owned {
+		variant.Destroy()
+	}
+	return gdnative.Variant{}, false, fmt.Errorf("expected %s, got %s", gdnative.VariantTypeName(argType), gdnative.VariantTypeName(valueType))
+}
+
+// bindSignals will bind all of the Signal fields of the given class instance to
+// the instance, so they can be emitted.
+func bindSignals(class Class) {
+	classValue := reflect.ValueOf(class)
+	if classValue.Kind() != reflect.Ptr || classValue.Elem().Kind() != reflect.Struct {
+		return
+	}
+	classValue = classValue.Elem()
+
+	for i := 0; i < classValue.NumField(); i++ {
+		field := classValue.Field(i)
+		if field.Type() != signalType || !field.CanSet() {
+			continue
+		}
+		field.Addr().Interface().(*Signal).owner = class
+	}
+}
+
+// signalType is the type of Signal fields.
+var signalType = reflect.TypeOf(Signal{})
+
 type SignalDefaultArg interface{}
+
+// newGDNativeSignal will construct a GDNative Signal struct from the given
+// signal, so it can be registered with Godot.
+func newGDNativeSignal(signalValue Signal) *gdnative.Signal {
+	signal := &gdnative.Signal{}
+	signal.Name = gdnative.String(signalValue.Name)
+	signal.NumArgs = gdnative.Int(len(signalValue.Args))
+	signal.Args = []gdnative.SignalArgument{}
+	signal.DefaultArgs = []gdnative.Variant{}
+
+	// Construct the arguments for our GDNative Signal struct
+	for _, argValue := range signalValue.Args {
+		var arg gdnative.SignalArgument
+		arg.Name = argValue.Name
+		arg.Hint = argValue.Hint
+		arg.HintString = argValue.HintString
+		arg.Usage = argValue.Usage
+
+		arg.Type = gdnative.Int(argValue.argType())
+
+		signal.Args = append(signal.Args, arg)
+	}
+
+	// Construct the default arguments for our GDNative Signal struct. Default
+	// arguments belong to the last arguments of the signal, so an invalid default
+	// is skipped along with the defaults before it.
+	for _, argValue := range signalValue.DefaultArgs {
+		variant, err := ToVariant(argValue)
+		if err != nil {
+			Log.Error("Invalid default argument for signal ", signalValue.Name, ": ", err.Error())
+			signal.DefaultArgs = []gdnative.Variant{}
+			continue
+		}
+
+		signal.DefaultArgs = append(signal.DefaultArgs, variant)
+	}
+	signal.NumDefaultArgs = gdnative.Int(len(signal.DefaultArgs))
+
+	return signal
+}
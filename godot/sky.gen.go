@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -27,13 +29,50 @@ const (
 	SkyRadianceSizeMax  SkyRadianceSize = 7
 )
 
-//func NewSkyFromPointer(ptr gdnative.Pointer) Sky {
-func newSkyFromPointer(ptr gdnative.Pointer) Sky {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Sky{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the SkyRadianceSize value.
+func (e SkyRadianceSize) String() string {
+	switch e {
+	case SkyRadianceSize1024:
+		return "RADIANCE_SIZE_1024"
+	case SkyRadianceSize128:
+		return "RADIANCE_SIZE_128"
+	case SkyRadianceSize2048:
+		return "RADIANCE_SIZE_2048"
+	case SkyRadianceSize256:
+		return "RADIANCE_SIZE_256"
+	case SkyRadianceSize32:
+		return "RADIANCE_SIZE_32"
+	case SkyRadianceSize512:
+		return "RADIANCE_SIZE_512"
+	case SkyRadianceSize64:
+		return "RADIANCE_SIZE_64"
+	case SkyRadianceSizeMax:
+		return "RADIANCE_SIZE_MAX"
+	}
+	return fmt.Sprintf("SkyRadianceSize(%d)", int(e))
+}
 
-	return obj
+// ParseSkyRadianceSize will return the SkyRadianceSize value with the given Godot name.
+func ParseSkyRadianceSize(name string) (SkyRadianceSize, error) {
+	switch name {
+	case "RADIANCE_SIZE_1024":
+		return SkyRadianceSize1024, nil
+	case "RADIANCE_SIZE_128":
+		return SkyRadianceSize128, nil
+	case "RADIANCE_SIZE_2048":
+		return SkyRadianceSize2048, nil
+	case "RADIANCE_SIZE_256":
+		return SkyRadianceSize256, nil
+	case "RADIANCE_SIZE_32":
+		return SkyRadianceSize32, nil
+	case "RADIANCE_SIZE_512":
+		return SkyRadianceSize512, nil
+	case "RADIANCE_SIZE_64":
+		return SkyRadianceSize64, nil
+	case "RADIANCE_SIZE_MAX":
+		return SkyRadianceSizeMax, nil
+	}
+	return 0, fmt.Errorf("invalid SkyRadianceSize value %q", name)
 }
 
 /*
@@ -48,9 +87,16 @@ func (o *Sky) BaseClass() string {
 	return "Sky"
 }
 
+// Method binds of the Sky class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSkyGetRadianceSize = gdnative.NewLazyMethodBind("Sky", "get_radiance_size")
+	methodBindSkySetRadianceSize = gdnative.NewLazyMethodBind("Sky", "set_radiance_size")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.Sky::RadianceSize
+	        Undocumented
+		Args: [], Returns: enum.Sky::RadianceSize
 */
 func (o *Sky) GetRadianceSize() SkyRadianceSize {
 	//log.Println("Calling Sky.GetRadianceSize()")
@@ -59,7 +105,7 @@ func (o *Sky) GetRadianceSize() SkyRadianceSize {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sky", "get_radiance_size")
+	methodBind := methodBindSkyGetRadianceSize.Get()
 
 	// Call the parent method.
 	// enum.Sky::RadianceSize
@@ -72,18 +118,18 @@ func (o *Sky) GetRadianceSize() SkyRadianceSize {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size enum.Sky::RadianceSize}], Returns: void
 */
-func (o *Sky) SetRadianceSize(size gdnative.Int) {
+func (o *Sky) SetRadianceSize(size SkyRadianceSize) {
 	//log.Println("Calling Sky.SetRadianceSize()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(size)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(size))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sky", "set_radiance_size")
+	methodBind := methodBindSkySetRadianceSize.Get()
 
 	// Call the parent method.
 	// void
@@ -92,9 +138,16 @@ func (o *Sky) SetRadianceSize(size gdnative.Int) {
 
 }
 
+// RadianceSize will return the value of the "radiance_size" property.
+func (o *Sky) RadianceSize() SkyRadianceSize {
+	return o.GetRadianceSize()
+}
+
 // SkyImplementer is an interface that implements the methods
 // of the Sky class.
 type SkyImplementer interface {
 	ResourceImplementer
-	SetRadianceSize(size gdnative.Int)
+	GetRadianceSize() SkyRadianceSize
+	SetRadianceSize(size SkyRadianceSize)
+	RadianceSize() SkyRadianceSize
 }
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSliderFromPointer(ptr gdnative.Pointer) Slider {
-func newSliderFromPointer(ptr gdnative.Pointer) Slider {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Slider{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Base class for GUI Sliders.
 */
@@ -34,19 +25,31 @@ func (o *Slider) BaseClass() string {
 	return "Slider"
 }
 
+// Method binds of the Slider class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSliderX_GuiInput        = gdnative.NewLazyMethodBind("Slider", "_gui_input")
+	methodBindSliderGetTicks          = gdnative.NewLazyMethodBind("Slider", "get_ticks")
+	methodBindSliderGetTicksOnBorders = gdnative.NewLazyMethodBind("Slider", "get_ticks_on_borders")
+	methodBindSliderIsEditable        = gdnative.NewLazyMethodBind("Slider", "is_editable")
+	methodBindSliderSetEditable       = gdnative.NewLazyMethodBind("Slider", "set_editable")
+	methodBindSliderSetTicks          = gdnative.NewLazyMethodBind("Slider", "set_ticks")
+	methodBindSliderSetTicksOnBorders = gdnative.NewLazyMethodBind("Slider", "set_ticks_on_borders")
+)
+
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
 func (o *Slider) X_GuiInput(arg0 InputEventImplementer) {
 	//log.Println("Calling Slider.X_GuiInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Slider", "_gui_input")
+	methodBind := methodBindSliderX_GuiInput.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +59,8 @@ func (o *Slider) X_GuiInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Slider) GetTicks() gdnative.Int {
 	//log.Println("Calling Slider.GetTicks()")
@@ -66,7 +69,7 @@ func (o *Slider) GetTicks() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Slider", "get_ticks")
+	methodBind := methodBindSliderGetTicks.Get()
 
 	// Call the parent method.
 	// int
@@ -79,8 +82,8 @@ func (o *Slider) GetTicks() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Slider) GetTicksOnBorders() gdnative.Bool {
 	//log.Println("Calling Slider.GetTicksOnBorders()")
@@ -89,7 +92,7 @@ func (o *Slider) GetTicksOnBorders() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Slider", "get_ticks_on_borders")
+	methodBind := methodBindSliderGetTicksOnBorders.Get()
 
 	// Call the parent method.
 	// bool
@@ -102,8 +105,8 @@ func (o *Slider) GetTicksOnBorders() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Slider) IsEditable() gdnative.Bool {
 	//log.Println("Calling Slider.IsEditable()")
@@ -112,7 +115,7 @@ func (o *Slider) IsEditable() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Slider", "is_editable")
+	methodBind := methodBindSliderIsEditable.Get()
 
 	// Call the parent method.
 	// bool
@@ -125,8 +128,8 @@ func (o *Slider) IsEditable() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false editable bool}], Returns: void
+	        Undocumented
+		Args: [{ false editable bool}], Returns: void
 */
 func (o *Slider) SetEditable(editable gdnative.Bool) {
 	//log.Println("Calling Slider.SetEditable()")
@@ -136,7 +139,7 @@ func (o *Slider) SetEditable(editable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(editable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Slider", "set_editable")
+	methodBind := methodBindSliderSetEditable.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +149,8 @@ func (o *Slider) SetEditable(editable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false count int}], Returns: void
+	        Undocumented
+		Args: [{ false count int}], Returns: void
 */
 func (o *Slider) SetTicks(count gdnative.Int) {
 	//log.Println("Calling Slider.SetTicks()")
@@ -157,7 +160,7 @@ func (o *Slider) SetTicks(count gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(count)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Slider", "set_ticks")
+	methodBind := methodBindSliderSetTicks.Get()
 
 	// Call the parent method.
 	// void
@@ -167,8 +170,8 @@ func (o *Slider) SetTicks(count gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ticks_on_border bool}], Returns: void
+	        Undocumented
+		Args: [{ false ticks_on_border bool}], Returns: void
 */
 func (o *Slider) SetTicksOnBorders(ticksOnBorder gdnative.Bool) {
 	//log.Println("Calling Slider.SetTicksOnBorders()")
@@ -178,7 +181,7 @@ func (o *Slider) SetTicksOnBorders(ticksOnBorder gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(ticksOnBorder)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Slider", "set_ticks_on_borders")
+	methodBind := methodBindSliderSetTicksOnBorders.Get()
 
 	// Call the parent method.
 	// void
@@ -187,6 +190,26 @@ func (o *Slider) SetTicksOnBorders(ticksOnBorder gdnative.Bool) {
 
 }
 
+// Editable will return the value of the "editable" property.
+func (o *Slider) Editable() gdnative.Bool {
+	return o.IsEditable()
+}
+
+// TickCount will return the value of the "tick_count" property.
+func (o *Slider) TickCount() gdnative.Int {
+	return o.GetTicks()
+}
+
+// SetTickCount will set the value of the "tick_count" property.
+func (o *Slider) SetTickCount(value gdnative.Int) {
+	o.SetTicks(value)
+}
+
+// TicksOnBorders will return the value of the "ticks_on_borders" property.
+func (o *Slider) TicksOnBorders() gdnative.Bool {
+	return o.GetTicksOnBorders()
+}
+
 // SliderImplementer is an interface that implements the methods
 // of the Slider class.
 type SliderImplementer interface {
@@ -197,4 +220,8 @@ type SliderImplementer interface {
 	SetEditable(editable gdnative.Bool)
 	SetTicks(count gdnative.Int)
 	SetTicksOnBorders(ticksOnBorder gdnative.Bool)
+	Editable() gdnative.Bool
+	TickCount() gdnative.Int
+	SetTickCount(value gdnative.Int)
+	TicksOnBorders() gdnative.Bool
 }
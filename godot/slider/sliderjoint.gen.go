@@ -0,0 +1,600 @@
+package slider
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/spatial"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// SliderJointParam is an enum for Param values.
+type SliderJointParam int
+
+const (
+	SliderJointParamAngularLimitDamping          SliderJointParam = 15
+	SliderJointParamAngularLimitLower            SliderJointParam = 12
+	SliderJointParamAngularLimitRestitution      SliderJointParam = 14
+	SliderJointParamAngularLimitSoftness         SliderJointParam = 13
+	SliderJointParamAngularLimitUpper            SliderJointParam = 11
+	SliderJointParamAngularMotionDamping         SliderJointParam = 18
+	SliderJointParamAngularMotionRestitution     SliderJointParam = 17
+	SliderJointParamAngularMotionSoftness        SliderJointParam = 16
+	SliderJointParamAngularOrthogonalDamping     SliderJointParam = 21
+	SliderJointParamAngularOrthogonalRestitution SliderJointParam = 20
+	SliderJointParamAngularOrthogonalSoftness    SliderJointParam = 19
+	SliderJointParamLinearLimitDamping           SliderJointParam = 4
+	SliderJointParamLinearLimitLower             SliderJointParam = 1
+	SliderJointParamLinearLimitRestitution       SliderJointParam = 3
+	SliderJointParamLinearLimitSoftness          SliderJointParam = 2
+	SliderJointParamLinearLimitUpper             SliderJointParam = 0
+	SliderJointParamLinearMotionDamping          SliderJointParam = 7
+	SliderJointParamLinearMotionRestitution      SliderJointParam = 6
+	SliderJointParamLinearMotionSoftness         SliderJointParam = 5
+	SliderJointParamLinearOrthogonalDamping      SliderJointParam = 10
+	SliderJointParamLinearOrthogonalRestitution  SliderJointParam = 9
+	SliderJointParamLinearOrthogonalSoftness     SliderJointParam = 8
+	SliderJointParamMax                          SliderJointParam = 22
+)
+
+// String will return the Godot name of the SliderJointParam value.
+func (e SliderJointParam) String() string {
+	switch e {
+	case SliderJointParamAngularLimitDamping:
+		return "PARAM_ANGULAR_LIMIT_DAMPING"
+	case SliderJointParamAngularLimitLower:
+		return "PARAM_ANGULAR_LIMIT_LOWER"
+	case SliderJointParamAngularLimitRestitution:
+		return "PARAM_ANGULAR_LIMIT_RESTITUTION"
+	case SliderJointParamAngularLimitSoftness:
+		return "PARAM_ANGULAR_LIMIT_SOFTNESS"
+	case SliderJointParamAngularLimitUpper:
+		return "PARAM_ANGULAR_LIMIT_UPPER"
+	case SliderJointParamAngularMotionDamping:
+		return "PARAM_ANGULAR_MOTION_DAMPING"
+	case SliderJointParamAngularMotionRestitution:
+		return "PARAM_ANGULAR_MOTION_RESTITUTION"
+	case SliderJointParamAngularMotionSoftness:
+		return "PARAM_ANGULAR_MOTION_SOFTNESS"
+	case SliderJointParamAngularOrthogonalDamping:
+		return "PARAM_ANGULAR_ORTHOGONAL_DAMPING"
+	case SliderJointParamAngularOrthogonalRestitution:
+		return "PARAM_ANGULAR_ORTHOGONAL_RESTITUTION"
+	case SliderJointParamAngularOrthogonalSoftness:
+		return "PARAM_ANGULAR_ORTHOGONAL_SOFTNESS"
+	case SliderJointParamLinearLimitDamping:
+		return "PARAM_LINEAR_LIMIT_DAMPING"
+	case SliderJointParamLinearLimitLower:
+		return "PARAM_LINEAR_LIMIT_LOWER"
+	case SliderJointParamLinearLimitRestitution:
+		return "PARAM_LINEAR_LIMIT_RESTITUTION"
+	case SliderJointParamLinearLimitSoftness:
+		return "PARAM_LINEAR_LIMIT_SOFTNESS"
+	case SliderJointParamLinearLimitUpper:
+		return "PARAM_LINEAR_LIMIT_UPPER"
+	case SliderJointParamLinearMotionDamping:
+		return "PARAM_LINEAR_MOTION_DAMPING"
+	case SliderJointParamLinearMotionRestitution:
+		return "PARAM_LINEAR_MOTION_RESTITUTION"
+	case SliderJointParamLinearMotionSoftness:
+		return "PARAM_LINEAR_MOTION_SOFTNESS"
+	case SliderJointParamLinearOrthogonalDamping:
+		return "PARAM_LINEAR_ORTHOGONAL_DAMPING"
+	case SliderJointParamLinearOrthogonalRestitution:
+		return "PARAM_LINEAR_ORTHOGONAL_RESTITUTION"
+	case SliderJointParamLinearOrthogonalSoftness:
+		return "PARAM_LINEAR_ORTHOGONAL_SOFTNESS"
+	case SliderJointParamMax:
+		return "PARAM_MAX"
+	}
+	return fmt.Sprintf("SliderJointParam(%d)", int(e))
+}
+
+// ParseSliderJointParam will return the SliderJointParam value with the given Godot name.
+func ParseSliderJointParam(name string) (SliderJointParam, error) {
+	switch name {
+	case "PARAM_ANGULAR_LIMIT_DAMPING":
+		return SliderJointParamAngularLimitDamping, nil
+	case "PARAM_ANGULAR_LIMIT_LOWER":
+		return SliderJointParamAngularLimitLower, nil
+	case "PARAM_ANGULAR_LIMIT_RESTITUTION":
+		return SliderJointParamAngularLimitRestitution, nil
+	case "PARAM_ANGULAR_LIMIT_SOFTNESS":
+		return SliderJointParamAngularLimitSoftness, nil
+	case "PARAM_ANGULAR_LIMIT_UPPER":
+		return SliderJointParamAngularLimitUpper, nil
+	case "PARAM_ANGULAR_MOTION_DAMPING":
+		return SliderJointParamAngularMotionDamping, nil
+	case "PARAM_ANGULAR_MOTION_RESTITUTION":
+		return SliderJointParamAngularMotionRestitution, nil
+	case "PARAM_ANGULAR_MOTION_SOFTNESS":
+		return SliderJointParamAngularMotionSoftness, nil
+	case "PARAM_ANGULAR_ORTHOGONAL_DAMPING":
+		return SliderJointParamAngularOrthogonalDamping, nil
+	case "PARAM_ANGULAR_ORTHOGONAL_RESTITUTION":
+		return SliderJointParamAngularOrthogonalRestitution, nil
+	case "PARAM_ANGULAR_ORTHOGONAL_SOFTNESS":
+		return SliderJointParamAngularOrthogonalSoftness, nil
+	case "PARAM_LINEAR_LIMIT_DAMPING":
+		return SliderJointParamLinearLimitDamping, nil
+	case "PARAM_LINEAR_LIMIT_LOWER":
+		return SliderJointParamLinearLimitLower, nil
+	case "PARAM_LINEAR_LIMIT_RESTITUTION":
+		return SliderJointParamLinearLimitRestitution, nil
+	case "PARAM_LINEAR_LIMIT_SOFTNESS":
+		return SliderJointParamLinearLimitSoftness, nil
+	case "PARAM_LINEAR_LIMIT_UPPER":
+		return SliderJointParamLinearLimitUpper, nil
+	case "PARAM_LINEAR_MOTION_DAMPING":
+		return SliderJointParamLinearMotionDamping, nil
+	case "PARAM_LINEAR_MOTION_RESTITUTION":
+		return SliderJointParamLinearMotionRestitution, nil
+	case "PARAM_LINEAR_MOTION_SOFTNESS":
+		return SliderJointParamLinearMotionSoftness, nil
+	case "PARAM_LINEAR_ORTHOGONAL_DAMPING":
+		return SliderJointParamLinearOrthogonalDamping, nil
+	case "PARAM_LINEAR_ORTHOGONAL_RESTITUTION":
+		return SliderJointParamLinearOrthogonalRestitution, nil
+	case "PARAM_LINEAR_ORTHOGONAL_SOFTNESS":
+		return SliderJointParamLinearOrthogonalSoftness, nil
+	case "PARAM_MAX":
+		return SliderJointParamMax, nil
+	}
+	return 0, fmt.Errorf("invalid SliderJointParam value %q", name)
+}
+
+/*
+Slides across the x-axis of the [Pivot] object.
+*/
+type SliderJoint struct {
+	spatial.Joint
+	owner gdnative.Object
+}
+
+// classConstructorSliderJoint is the class constructor of the SliderJoint class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSliderJoint = gdnative.NewLazyClassConstructor("SliderJoint")
+
+// NewSliderJoint will create a new instance of the SliderJoint class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSliderJoint() *SliderJoint {
+	obj := &SliderJoint{}
+	obj.SetBaseObject(classConstructorSliderJoint.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *SliderJoint) BaseClass() string {
+	return "SliderJoint"
+}
+
+// Method binds of the SliderJoint class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSliderJointX_GetLowerLimitAngular = gdnative.NewLazyMethodBind("SliderJoint", "_get_lower_limit_angular")
+	methodBindSliderJointX_GetUpperLimitAngular = gdnative.NewLazyMethodBind("SliderJoint", "_get_upper_limit_angular")
+	methodBindSliderJointX_SetLowerLimitAngular = gdnative.NewLazyMethodBind("SliderJoint", "_set_lower_limit_angular")
+	methodBindSliderJointX_SetUpperLimitAngular = gdnative.NewLazyMethodBind("SliderJoint", "_set_upper_limit_angular")
+	methodBindSliderJointGetParam               = gdnative.NewLazyMethodBind("SliderJoint", "get_param")
+	methodBindSliderJointSetParam               = gdnative.NewLazyMethodBind("SliderJoint", "set_param")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SliderJoint) X_GetLowerLimitAngular() gdnative.Real {
+	//log.Println("Calling SliderJoint.X_GetLowerLimitAngular()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSliderJointX_GetLowerLimitAngular.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SliderJoint) X_GetUpperLimitAngular() gdnative.Real {
+	//log.Println("Calling SliderJoint.X_GetUpperLimitAngular()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSliderJointX_GetUpperLimitAngular.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false lower_limit_angular float}], Returns: void
+*/
+func (o *SliderJoint) X_SetLowerLimitAngular(lowerLimitAngular gdnative.Real) {
+	//log.Println("Calling SliderJoint.X_SetLowerLimitAngular()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(lowerLimitAngular)
+
+	// Get the method bind
+	methodBind := methodBindSliderJointX_SetLowerLimitAngular.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false upper_limit_angular float}], Returns: void
+*/
+func (o *SliderJoint) X_SetUpperLimitAngular(upperLimitAngular gdnative.Real) {
+	//log.Println("Calling SliderJoint.X_SetUpperLimitAngular()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(upperLimitAngular)
+
+	// Get the method bind
+	methodBind := methodBindSliderJointX_SetUpperLimitAngular.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.SliderJoint::Param}], Returns: float
+*/
+func (o *SliderJoint) GetParam(param SliderJointParam) gdnative.Real {
+	//log.Println("Calling SliderJoint.GetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindSliderJointGetParam.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.SliderJoint::Param} { false value float}], Returns: void
+*/
+func (o *SliderJoint) SetParam(param SliderJointParam, value gdnative.Real) {
+	//log.Println("Calling SliderJoint.SetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindSliderJointSetParam.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AngularLimitDamping will return the value of the "angular_limit/damping" property.
+func (o *SliderJoint) AngularLimitDamping() gdnative.Real {
+	return o.GetParam(15)
+}
+
+// SetAngularLimitDamping will set the value of the "angular_limit/damping" property.
+func (o *SliderJoint) SetAngularLimitDamping(value gdnative.Real) {
+	o.SetParam(15, value)
+}
+
+// AngularLimitLowerAngle will return the value of the "angular_limit/lower_angle" property.
+func (o *SliderJoint) AngularLimitLowerAngle() gdnative.Real {
+	return o.X_GetLowerLimitAngular()
+}
+
+// SetAngularLimitLowerAngle will set the value of the "angular_limit/lower_angle" property.
+func (o *SliderJoint) SetAngularLimitLowerAngle(value gdnative.Real) {
+	o.X_SetLowerLimitAngular(value)
+}
+
+// AngularLimitRestitution will return the value of the "angular_limit/restitution" property.
+func (o *SliderJoint) AngularLimitRestitution() gdnative.Real {
+	return o.GetParam(14)
+}
+
+// SetAngularLimitRestitution will set the value of the "angular_limit/restitution" property.
+func (o *SliderJoint) SetAngularLimitRestitution(value gdnative.Real) {
+	o.SetParam(14, value)
+}
+
+// AngularLimitSoftness will return the value of the "angular_limit/softness" property.
+func (o *SliderJoint) AngularLimitSoftness() gdnative.Real {
+	return o.GetParam(13)
+}
+
+// SetAngularLimitSoftness will set the value of the "angular_limit/softness" property.
+func (o *SliderJoint) SetAngularLimitSoftness(value gdnative.Real) {
+	o.SetParam(13, value)
+}
+
+// AngularLimitUpperAngle will return the value of the "angular_limit/upper_angle" property.
+func (o *SliderJoint) AngularLimitUpperAngle() gdnative.Real {
+	return o.X_GetUpperLimitAngular()
+}
+
+// SetAngularLimitUpperAngle will set the value of the "angular_limit/upper_angle" property.
+func (o *SliderJoint) SetAngularLimitUpperAngle(value gdnative.Real) {
+	o.X_SetUpperLimitAngular(value)
+}
+
+// AngularMotionDamping will return the value of the "angular_motion/damping" property.
+func (o *SliderJoint) AngularMotionDamping() gdnative.Real {
+	return o.GetParam(18)
+}
+
+// SetAngularMotionDamping will set the value of the "angular_motion/damping" property.
+func (o *SliderJoint) SetAngularMotionDamping(value gdnative.Real) {
+	o.SetParam(18, value)
+}
+
+// AngularMotionRestitution will return the value of the "angular_motion/restitution" property.
+func (o *SliderJoint) AngularMotionRestitution() gdnative.Real {
+	return o.GetParam(17)
+}
+
+// SetAngularMotionRestitution will set the value of the "angular_motion/restitution" property.
+func (o *SliderJoint) SetAngularMotionRestitution(value gdnative.Real) {
+	o.SetParam(17, value)
+}
+
+// AngularMotionSoftness will return the value of the "angular_motion/softness" property.
+func (o *SliderJoint) AngularMotionSoftness() gdnative.Real {
+	return o.GetParam(16)
+}
+
+// SetAngularMotionSoftness will set the value of the "angular_motion/softness" property.
+func (o *SliderJoint) SetAngularMotionSoftness(value gdnative.Real) {
+	o.SetParam(16, value)
+}
+
+// AngularOrthoDamping will return the value of the "angular_ortho/damping" property.
+func (o *SliderJoint) AngularOrthoDamping() gdnative.Real {
+	return o.GetParam(21)
+}
+
+// SetAngularOrthoDamping will set the value of the "angular_ortho/damping" property.
+func (o *SliderJoint) SetAngularOrthoDamping(value gdnative.Real) {
+	o.SetParam(21, value)
+}
+
+// AngularOrthoRestitution will return the value of the "angular_ortho/restitution" property.
+func (o *SliderJoint) AngularOrthoRestitution() gdnative.Real {
+	return o.GetParam(20)
+}
+
+// SetAngularOrthoRestitution will set the value of the "angular_ortho/restitution" property.
+func (o *SliderJoint) SetAngularOrthoRestitution(value gdnative.Real) {
+	o.SetParam(20, value)
+}
+
+// AngularOrthoSoftness will return the value of the "angular_ortho/softness" property.
+func (o *SliderJoint) AngularOrthoSoftness() gdnative.Real {
+	return o.GetParam(19)
+}
+
+// SetAngularOrthoSoftness will set the value of the "angular_ortho/softness" property.
+func (o *SliderJoint) SetAngularOrthoSoftness(value gdnative.Real) {
+	o.SetParam(19, value)
+}
+
+// LinearLimitDamping will return the value of the "linear_limit/damping" property.
+func (o *SliderJoint) LinearLimitDamping() gdnative.Real {
+	return o.GetParam(4)
+}
+
+// SetLinearLimitDamping will set the value of the "linear_limit/damping" property.
+func (o *SliderJoint) SetLinearLimitDamping(value gdnative.Real) {
+	o.SetParam(4, value)
+}
+
+// LinearLimitLowerDistance will return the value of the "linear_limit/lower_distance" property.
+func (o *SliderJoint) LinearLimitLowerDistance() gdnative.Real {
+	return o.GetParam(1)
+}
+
+// SetLinearLimitLowerDistance will set the value of the "linear_limit/lower_distance" property.
+func (o *SliderJoint) SetLinearLimitLowerDistance(value gdnative.Real) {
+	o.SetParam(1, value)
+}
+
+// LinearLimitRestitution will return the value of the "linear_limit/restitution" property.
+func (o *SliderJoint) LinearLimitRestitution() gdnative.Real {
+	return o.GetParam(3)
+}
+
+// SetLinearLimitRestitution will set the value of the "linear_limit/restitution" property.
+func (o *SliderJoint) SetLinearLimitRestitution(value gdnative.Real) {
+	o.SetParam(3, value)
+}
+
+// LinearLimitSoftness will return the value of the "linear_limit/softness" property.
+func (o *SliderJoint) LinearLimitSoftness() gdnative.Real {
+	return o.GetParam(2)
+}
+
+// SetLinearLimitSoftness will set the value of the "linear_limit/softness" property.
+func (o *SliderJoint) SetLinearLimitSoftness(value gdnative.Real) {
+	o.SetParam(2, value)
+}
+
+// LinearLimitUpperDistance will return the value of the "linear_limit/upper_distance" property.
+func (o *SliderJoint) LinearLimitUpperDistance() gdnative.Real {
+	return o.GetParam(0)
+}
+
+// SetLinearLimitUpperDistance will set the value of the "linear_limit/upper_distance" property.
+func (o *SliderJoint) SetLinearLimitUpperDistance(value gdnative.Real) {
+	o.SetParam(0, value)
+}
+
+// LinearMotionDamping will return the value of the "linear_motion/damping" property.
+func (o *SliderJoint) LinearMotionDamping() gdnative.Real {
+	return o.GetParam(7)
+}
+
+// SetLinearMotionDamping will set the value of the "linear_motion/damping" property.
+func (o *SliderJoint) SetLinearMotionDamping(value gdnative.Real) {
+	o.SetParam(7, value)
+}
+
+// LinearMotionRestitution will return the value of the "linear_motion/restitution" property.
+func (o *SliderJoint) LinearMotionRestitution() gdnative.Real {
+	return o.GetParam(6)
+}
+
+// SetLinearMotionRestitution will set the value of the "linear_motion/restitution" property.
+func (o *SliderJoint) SetLinearMotionRestitution(value gdnative.Real) {
+	o.SetParam(6, value)
+}
+
+// LinearMotionSoftness will return the value of the "linear_motion/softness" property.
+func (o *SliderJoint) LinearMotionSoftness() gdnative.Real {
+	return o.GetParam(5)
+}
+
+// SetLinearMotionSoftness will set the value of the "linear_motion/softness" property.
+func (o *SliderJoint) SetLinearMotionSoftness(value gdnative.Real) {
+	o.SetParam(5, value)
+}
+
+// LinearOrthoDamping will return the value of the "linear_ortho/damping" property.
+func (o *SliderJoint) LinearOrthoDamping() gdnative.Real {
+	return o.GetParam(10)
+}
+
+// SetLinearOrthoDamping will set the value of the "linear_ortho/damping" property.
+func (o *SliderJoint) SetLinearOrthoDamping(value gdnative.Real) {
+	o.SetParam(10, value)
+}
+
+// LinearOrthoRestitution will return the value of the "linear_ortho/restitution" property.
+func (o *SliderJoint) LinearOrthoRestitution() gdnative.Real {
+	return o.GetParam(9)
+}
+
+// SetLinearOrthoRestitution will set the value of the "linear_ortho/restitution" property.
+func (o *SliderJoint) SetLinearOrthoRestitution(value gdnative.Real) {
+	o.SetParam(9, value)
+}
+
+// LinearOrthoSoftness will return the value of the "linear_ortho/softness" property.
+func (o *SliderJoint) LinearOrthoSoftness() gdnative.Real {
+	return o.GetParam(8)
+}
+
+// SetLinearOrthoSoftness will set the value of the "linear_ortho/softness" property.
+func (o *SliderJoint) SetLinearOrthoSoftness(value gdnative.Real) {
+	o.SetParam(8, value)
+}
+
+// SliderJointImplementer is an interface that implements the methods
+// of the SliderJoint class.
+type SliderJointImplementer interface {
+	spatial.JointImplementer
+	X_GetLowerLimitAngular() gdnative.Real
+	X_GetUpperLimitAngular() gdnative.Real
+	X_SetLowerLimitAngular(lowerLimitAngular gdnative.Real)
+	X_SetUpperLimitAngular(upperLimitAngular gdnative.Real)
+	GetParam(param SliderJointParam) gdnative.Real
+	SetParam(param SliderJointParam, value gdnative.Real)
+	AngularLimitDamping() gdnative.Real
+	SetAngularLimitDamping(value gdnative.Real)
+	AngularLimitLowerAngle() gdnative.Real
+	SetAngularLimitLowerAngle(value gdnative.Real)
+	AngularLimitRestitution() gdnative.Real
+	SetAngularLimitRestitution(value gdnative.Real)
+	AngularLimitSoftness() gdnative.Real
+	SetAngularLimitSoftness(value gdnative.Real)
+	AngularLimitUpperAngle() gdnative.Real
+	SetAngularLimitUpperAngle(value gdnative.Real)
+	AngularMotionDamping() gdnative.Real
+	SetAngularMotionDamping(value gdnative.Real)
+	AngularMotionRestitution() gdnative.Real
+	SetAngularMotionRestitution(value gdnative.Real)
+	AngularMotionSoftness() gdnative.Real
+	SetAngularMotionSoftness(value gdnative.Real)
+	AngularOrthoDamping() gdnative.Real
+	SetAngularOrthoDamping(value gdnative.Real)
+	AngularOrthoRestitution() gdnative.Real
+	SetAngularOrthoRestitution(value gdnative.Real)
+	AngularOrthoSoftness() gdnative.Real
+	SetAngularOrthoSoftness(value gdnative.Real)
+	LinearLimitDamping() gdnative.Real
+	SetLinearLimitDamping(value gdnative.Real)
+	LinearLimitLowerDistance() gdnative.Real
+	SetLinearLimitLowerDistance(value gdnative.Real)
+	LinearLimitRestitution() gdnative.Real
+	SetLinearLimitRestitution(value gdnative.Real)
+	LinearLimitSoftness() gdnative.Real
+	SetLinearLimitSoftness(value gdnative.Real)
+	LinearLimitUpperDistance() gdnative.Real
+	SetLinearLimitUpperDistance(value gdnative.Real)
+	LinearMotionDamping() gdnative.Real
+	SetLinearMotionDamping(value gdnative.Real)
+	LinearMotionRestitution() gdnative.Real
+	SetLinearMotionRestitution(value gdnative.Real)
+	LinearMotionSoftness() gdnative.Real
+	SetLinearMotionSoftness(value gdnative.Real)
+	LinearOrthoDamping() gdnative.Real
+	SetLinearOrthoDamping(value gdnative.Real)
+	LinearOrthoRestitution() gdnative.Real
+	SetLinearOrthoRestitution(value gdnative.Real)
+	LinearOrthoSoftness() gdnative.Real
+	SetLinearOrthoSoftness(value gdnative.Real)
+}
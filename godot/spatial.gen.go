@@ -13,14 +13,13 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSpatialFromPointer(ptr gdnative.Pointer) Spatial {
-func newSpatialFromPointer(ptr gdnative.Pointer) Spatial {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Spatial{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
+// Constants of the Spatial class.
+const (
+	SpatialNotificationEnterWorld        gdnative.Int = 41
+	SpatialNotificationExitWorld         gdnative.Int = 42
+	SpatialNotificationTransformChanged  gdnative.Int = 29
+	SpatialNotificationVisibilityChanged gdnative.Int = 43
+)
 
 /*
 Most basic 3D game object, with a 3D [Transform] and visibility settings. All other 3D game objects inherit from Spatial. Use Spatial as a parent node to move, scale, rotate and show/hide children in a 3D project. Affine operations (rotate, scale, translate) happen in parent's local coordinate system, unless the Spatial object is set as top level. Affine operations in this coordinate system correspond to direct affine operations on the Spatial's transform. The word local below refers to this coordinate system. The coordinate system that is attached to the Spatial object itself is referred to as object-local coordinate system.
@@ -30,13 +29,79 @@ type Spatial struct {
 	owner gdnative.Object
 }
 
+// classConstructorSpatial is the class constructor of the Spatial class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSpatial = gdnative.NewLazyClassConstructor("Spatial")
+
+// NewSpatial will create a new instance of the Spatial class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSpatial() *Spatial {
+	obj := &Spatial{}
+	obj.SetBaseObject(classConstructorSpatial.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Spatial) BaseClass() string {
 	return "Spatial"
 }
 
+// Method binds of the Spatial class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSpatialX_UpdateGizmo                       = gdnative.NewLazyMethodBind("Spatial", "_update_gizmo")
+	methodBindSpatialGetGizmo                            = gdnative.NewLazyMethodBind("Spatial", "get_gizmo")
+	methodBindSpatialGetGlobalTransform                  = gdnative.NewLazyMethodBind("Spatial", "get_global_transform")
+	methodBindSpatialGetParentSpatial                    = gdnative.NewLazyMethodBind("Spatial", "get_parent_spatial")
+	methodBindSpatialGetRotation                         = gdnative.NewLazyMethodBind("Spatial", "get_rotation")
+	methodBindSpatialGetRotationDegrees                  = gdnative.NewLazyMethodBind("Spatial", "get_rotation_degrees")
+	methodBindSpatialGetScale                            = gdnative.NewLazyMethodBind("Spatial", "get_scale")
+	methodBindSpatialGetTransform                        = gdnative.NewLazyMethodBind("Spatial", "get_transform")
+	methodBindSpatialGetTranslation                      = gdnative.NewLazyMethodBind("Spatial", "get_translation")
+	methodBindSpatialGetWorld                            = gdnative.NewLazyMethodBind("Spatial", "get_world")
+	methodBindSpatialGlobalRotate                        = gdnative.NewLazyMethodBind("Spatial", "global_rotate")
+	methodBindSpatialGlobalScale                         = gdnative.NewLazyMethodBind("Spatial", "global_scale")
+	methodBindSpatialGlobalTranslate                     = gdnative.NewLazyMethodBind("Spatial", "global_translate")
+	methodBindSpatialHide                                = gdnative.NewLazyMethodBind("Spatial", "hide")
+	methodBindSpatialIsLocalTransformNotificationEnabled = gdnative.NewLazyMethodBind("Spatial", "is_local_transform_notification_enabled")
+	methodBindSpatialIsSetAsToplevel                     = gdnative.NewLazyMethodBind("Spatial", "is_set_as_toplevel")
+	methodBindSpatialIsTransformNotificationEnabled      = gdnative.NewLazyMethodBind("Spatial", "is_transform_notification_enabled")
+	methodBindSpatialIsVisible                           = gdnative.NewLazyMethodBind("Spatial", "is_visible")
+	methodBindSpatialIsVisibleInTree                     = gdnative.NewLazyMethodBind("Spatial", "is_visible_in_tree")
+	methodBindSpatialLookAt                              = gdnative.NewLazyMethodBind("Spatial", "look_at")
+	methodBindSpatialLookAtFromPosition                  = gdnative.NewLazyMethodBind("Spatial", "look_at_from_position")
+	methodBindSpatialOrthonormalize                      = gdnative.NewLazyMethodBind("Spatial", "orthonormalize")
+	methodBindSpatialRotate                              = gdnative.NewLazyMethodBind("Spatial", "rotate")
+	methodBindSpatialRotateObjectLocal                   = gdnative.NewLazyMethodBind("Spatial", "rotate_object_local")
+	methodBindSpatialRotateX                             = gdnative.NewLazyMethodBind("Spatial", "rotate_x")
+	methodBindSpatialRotateY                             = gdnative.NewLazyMethodBind("Spatial", "rotate_y")
+	methodBindSpatialRotateZ                             = gdnative.NewLazyMethodBind("Spatial", "rotate_z")
+	methodBindSpatialScaleObjectLocal                    = gdnative.NewLazyMethodBind("Spatial", "scale_object_local")
+	methodBindSpatialSetAsToplevel                       = gdnative.NewLazyMethodBind("Spatial", "set_as_toplevel")
+	methodBindSpatialSetGizmo                            = gdnative.NewLazyMethodBind("Spatial", "set_gizmo")
+	methodBindSpatialSetGlobalTransform                  = gdnative.NewLazyMethodBind("Spatial", "set_global_transform")
+	methodBindSpatialSetIdentity                         = gdnative.NewLazyMethodBind("Spatial", "set_identity")
+	methodBindSpatialSetIgnoreTransformNotification      = gdnative.NewLazyMethodBind("Spatial", "set_ignore_transform_notification")
+	methodBindSpatialSetNotifyLocalTransform             = gdnative.NewLazyMethodBind("Spatial", "set_notify_local_transform")
+	methodBindSpatialSetNotifyTransform                  = gdnative.NewLazyMethodBind("Spatial", "set_notify_transform")
+	methodBindSpatialSetRotation                         = gdnative.NewLazyMethodBind("Spatial", "set_rotation")
+	methodBindSpatialSetRotationDegrees                  = gdnative.NewLazyMethodBind("Spatial", "set_rotation_degrees")
+	methodBindSpatialSetScale                            = gdnative.NewLazyMethodBind("Spatial", "set_scale")
+	methodBindSpatialSetTransform                        = gdnative.NewLazyMethodBind("Spatial", "set_transform")
+	methodBindSpatialSetTranslation                      = gdnative.NewLazyMethodBind("Spatial", "set_translation")
+	methodBindSpatialSetVisible                          = gdnative.NewLazyMethodBind("Spatial", "set_visible")
+	methodBindSpatialShow                                = gdnative.NewLazyMethodBind("Spatial", "show")
+	methodBindSpatialToGlobal                            = gdnative.NewLazyMethodBind("Spatial", "to_global")
+	methodBindSpatialToLocal                             = gdnative.NewLazyMethodBind("Spatial", "to_local")
+	methodBindSpatialTranslate                           = gdnative.NewLazyMethodBind("Spatial", "translate")
+	methodBindSpatialTranslateObjectLocal                = gdnative.NewLazyMethodBind("Spatial", "translate_object_local")
+	methodBindSpatialUpdateGizmo                         = gdnative.NewLazyMethodBind("Spatial", "update_gizmo")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Spatial) X_UpdateGizmo() {
 	//log.Println("Calling Spatial.X_UpdateGizmo()")
@@ -45,7 +110,7 @@ func (o *Spatial) X_UpdateGizmo() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "_update_gizmo")
+	methodBind := methodBindSpatialX_UpdateGizmo.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +120,8 @@ func (o *Spatial) X_UpdateGizmo() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: SpatialGizmo
+	        Undocumented
+		Args: [], Returns: SpatialGizmo
 */
 func (o *Spatial) GetGizmo() SpatialGizmoImplementer {
 	//log.Println("Calling Spatial.GetGizmo()")
@@ -65,7 +130,7 @@ func (o *Spatial) GetGizmo() SpatialGizmoImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_gizmo")
+	methodBind := methodBindSpatialGetGizmo.Get()
 
 	// Call the parent method.
 	// SpatialGizmo
@@ -73,27 +138,25 @@ func (o *Spatial) GetGizmo() SpatialGizmoImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSpatialGizmoFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SpatialGizmoImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "SpatialGizmo" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SpatialGizmoImplementer)
+	ret := SpatialGizmo{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(SpatialGizmoImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform
+	        Undocumented
+		Args: [], Returns: Transform
 */
 func (o *Spatial) GetGlobalTransform() gdnative.Transform {
 	//log.Println("Calling Spatial.GetGlobalTransform()")
@@ -102,7 +165,7 @@ func (o *Spatial) GetGlobalTransform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_global_transform")
+	methodBind := methodBindSpatialGetGlobalTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -115,8 +178,8 @@ func (o *Spatial) GetGlobalTransform() gdnative.Transform {
 }
 
 /*
-        Returns the parent [code]Spatial[/code], or an empty [Object] if no parent exists or parent is not of type [code]Spatial[/code].
-	Args: [], Returns: Spatial
+	        Returns the parent [code]Spatial[/code], or an empty [Object] if no parent exists or parent is not of type [code]Spatial[/code].
+		Args: [], Returns: Spatial
 */
 func (o *Spatial) GetParentSpatial() SpatialImplementer {
 	//log.Println("Calling Spatial.GetParentSpatial()")
@@ -125,7 +188,7 @@ func (o *Spatial) GetParentSpatial() SpatialImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_parent_spatial")
+	methodBind := methodBindSpatialGetParentSpatial.Get()
 
 	// Call the parent method.
 	// Spatial
@@ -133,27 +196,25 @@ func (o *Spatial) GetParentSpatial() SpatialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSpatialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SpatialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Spatial" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SpatialImplementer)
+	ret := Spatial{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(SpatialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *Spatial) GetRotation() gdnative.Vector3 {
 	//log.Println("Calling Spatial.GetRotation()")
@@ -162,7 +223,7 @@ func (o *Spatial) GetRotation() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_rotation")
+	methodBind := methodBindSpatialGetRotation.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -175,8 +236,8 @@ func (o *Spatial) GetRotation() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *Spatial) GetRotationDegrees() gdnative.Vector3 {
 	//log.Println("Calling Spatial.GetRotationDegrees()")
@@ -185,7 +246,7 @@ func (o *Spatial) GetRotationDegrees() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_rotation_degrees")
+	methodBind := methodBindSpatialGetRotationDegrees.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -198,8 +259,8 @@ func (o *Spatial) GetRotationDegrees() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *Spatial) GetScale() gdnative.Vector3 {
 	//log.Println("Calling Spatial.GetScale()")
@@ -208,7 +269,7 @@ func (o *Spatial) GetScale() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_scale")
+	methodBind := methodBindSpatialGetScale.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -221,8 +282,8 @@ func (o *Spatial) GetScale() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform
+	        Undocumented
+		Args: [], Returns: Transform
 */
 func (o *Spatial) GetTransform() gdnative.Transform {
 	//log.Println("Calling Spatial.GetTransform()")
@@ -231,7 +292,7 @@ func (o *Spatial) GetTransform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_transform")
+	methodBind := methodBindSpatialGetTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -244,8 +305,8 @@ func (o *Spatial) GetTransform() gdnative.Transform {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *Spatial) GetTranslation() gdnative.Vector3 {
 	//log.Println("Calling Spatial.GetTranslation()")
@@ -254,7 +315,7 @@ func (o *Spatial) GetTranslation() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_translation")
+	methodBind := methodBindSpatialGetTranslation.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -267,8 +328,8 @@ func (o *Spatial) GetTranslation() gdnative.Vector3 {
 }
 
 /*
-        Returns the current [World] resource this Spatial node is registered to.
-	Args: [], Returns: World
+	        Returns the current [World] resource this Spatial node is registered to.
+		Args: [], Returns: World
 */
 func (o *Spatial) GetWorld() WorldImplementer {
 	//log.Println("Calling Spatial.GetWorld()")
@@ -277,7 +338,7 @@ func (o *Spatial) GetWorld() WorldImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "get_world")
+	methodBind := methodBindSpatialGetWorld.Get()
 
 	// Call the parent method.
 	// World
@@ -285,27 +346,25 @@ func (o *Spatial) GetWorld() WorldImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newWorldFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(WorldImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "World" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(WorldImplementer)
+	ret := World{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(WorldImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Rotates the global (world) transformation around axis, a unit [Vector3], by specified angle in radians. The rotation axis is in global coordinate system.
-	Args: [{ false axis Vector3} { false angle float}], Returns: void
+	        Rotates the global (world) transformation around axis, a unit [Vector3], by specified angle in radians. The rotation axis is in global coordinate system.
+		Args: [{ false axis Vector3} { false angle float}], Returns: void
 */
 func (o *Spatial) GlobalRotate(axis gdnative.Vector3, angle gdnative.Real) {
 	//log.Println("Calling Spatial.GlobalRotate()")
@@ -316,7 +375,7 @@ func (o *Spatial) GlobalRotate(axis gdnative.Vector3, angle gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(angle)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "global_rotate")
+	methodBind := methodBindSpatialGlobalRotate.Get()
 
 	// Call the parent method.
 	// void
@@ -326,8 +385,7 @@ func (o *Spatial) GlobalRotate(axis gdnative.Vector3, angle gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false scale Vector3}], Returns: void
+Args: [{ false scale Vector3}], Returns: void
 */
 func (o *Spatial) GlobalScale(scale gdnative.Vector3) {
 	//log.Println("Calling Spatial.GlobalScale()")
@@ -337,7 +395,7 @@ func (o *Spatial) GlobalScale(scale gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "global_scale")
+	methodBind := methodBindSpatialGlobalScale.Get()
 
 	// Call the parent method.
 	// void
@@ -347,8 +405,8 @@ func (o *Spatial) GlobalScale(scale gdnative.Vector3) {
 }
 
 /*
-        Moves the global (world) transformation by [Vector3] offset. The offset is in global coordinate system.
-	Args: [{ false offset Vector3}], Returns: void
+	        Moves the global (world) transformation by [Vector3] offset. The offset is in global coordinate system.
+		Args: [{ false offset Vector3}], Returns: void
 */
 func (o *Spatial) GlobalTranslate(offset gdnative.Vector3) {
 	//log.Println("Calling Spatial.GlobalTranslate()")
@@ -358,7 +416,7 @@ func (o *Spatial) GlobalTranslate(offset gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "global_translate")
+	methodBind := methodBindSpatialGlobalTranslate.Get()
 
 	// Call the parent method.
 	// void
@@ -368,8 +426,8 @@ func (o *Spatial) GlobalTranslate(offset gdnative.Vector3) {
 }
 
 /*
-        Disables rendering of this node. Change Spatial Visible property to false.
-	Args: [], Returns: void
+	        Disables rendering of this node. Change Spatial Visible property to false.
+		Args: [], Returns: void
 */
 func (o *Spatial) Hide() {
 	//log.Println("Calling Spatial.Hide()")
@@ -378,7 +436,7 @@ func (o *Spatial) Hide() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "hide")
+	methodBind := methodBindSpatialHide.Get()
 
 	// Call the parent method.
 	// void
@@ -388,8 +446,8 @@ func (o *Spatial) Hide() {
 }
 
 /*
-        Returns whether node notifies about its local transformation changes. Spatial will not propagate this by default.
-	Args: [], Returns: bool
+	        Returns whether node notifies about its local transformation changes. Spatial will not propagate this by default.
+		Args: [], Returns: bool
 */
 func (o *Spatial) IsLocalTransformNotificationEnabled() gdnative.Bool {
 	//log.Println("Calling Spatial.IsLocalTransformNotificationEnabled()")
@@ -398,7 +456,7 @@ func (o *Spatial) IsLocalTransformNotificationEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "is_local_transform_notification_enabled")
+	methodBind := methodBindSpatialIsLocalTransformNotificationEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -411,8 +469,8 @@ func (o *Spatial) IsLocalTransformNotificationEnabled() gdnative.Bool {
 }
 
 /*
-        Returns whether this node is set as Toplevel, that is whether it ignores its parent nodes transformations.
-	Args: [], Returns: bool
+	        Returns whether this node is set as Toplevel, that is whether it ignores its parent nodes transformations.
+		Args: [], Returns: bool
 */
 func (o *Spatial) IsSetAsToplevel() gdnative.Bool {
 	//log.Println("Calling Spatial.IsSetAsToplevel()")
@@ -421,7 +479,7 @@ func (o *Spatial) IsSetAsToplevel() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "is_set_as_toplevel")
+	methodBind := methodBindSpatialIsSetAsToplevel.Get()
 
 	// Call the parent method.
 	// bool
@@ -434,8 +492,8 @@ func (o *Spatial) IsSetAsToplevel() gdnative.Bool {
 }
 
 /*
-        Returns whether the node notifies about its global and local transformation changes. Spatial will not propagate this by default.
-	Args: [], Returns: bool
+	        Returns whether the node notifies about its global and local transformation changes. Spatial will not propagate this by default.
+		Args: [], Returns: bool
 */
 func (o *Spatial) IsTransformNotificationEnabled() gdnative.Bool {
 	//log.Println("Calling Spatial.IsTransformNotificationEnabled()")
@@ -444,7 +502,7 @@ func (o *Spatial) IsTransformNotificationEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "is_transform_notification_enabled")
+	methodBind := methodBindSpatialIsTransformNotificationEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -457,8 +515,8 @@ func (o *Spatial) IsTransformNotificationEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Spatial) IsVisible() gdnative.Bool {
 	//log.Println("Calling Spatial.IsVisible()")
@@ -467,7 +525,7 @@ func (o *Spatial) IsVisible() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "is_visible")
+	methodBind := methodBindSpatialIsVisible.Get()
 
 	// Call the parent method.
 	// bool
@@ -480,8 +538,8 @@ func (o *Spatial) IsVisible() gdnative.Bool {
 }
 
 /*
-        Returns whether the node is visible, taking into consideration that its parents visibility.
-	Args: [], Returns: bool
+	        Returns whether the node is visible, taking into consideration that its parents visibility.
+		Args: [], Returns: bool
 */
 func (o *Spatial) IsVisibleInTree() gdnative.Bool {
 	//log.Println("Calling Spatial.IsVisibleInTree()")
@@ -490,7 +548,7 @@ func (o *Spatial) IsVisibleInTree() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "is_visible_in_tree")
+	methodBind := methodBindSpatialIsVisibleInTree.Get()
 
 	// Call the parent method.
 	// bool
@@ -503,8 +561,8 @@ func (o *Spatial) IsVisibleInTree() gdnative.Bool {
 }
 
 /*
-        Rotates itself to point into direction of target position. Operations take place in global space.
-	Args: [{ false target Vector3} { false up Vector3}], Returns: void
+	        Rotates itself to point into direction of target position. Operations take place in global space.
+		Args: [{ false target Vector3} { false up Vector3}], Returns: void
 */
 func (o *Spatial) LookAt(target gdnative.Vector3, up gdnative.Vector3) {
 	//log.Println("Calling Spatial.LookAt()")
@@ -515,7 +573,7 @@ func (o *Spatial) LookAt(target gdnative.Vector3, up gdnative.Vector3) {
 	ptrArguments[1] = gdnative.NewPointerFromVector3(up)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "look_at")
+	methodBind := methodBindSpatialLookAt.Get()
 
 	// Call the parent method.
 	// void
@@ -525,8 +583,8 @@ func (o *Spatial) LookAt(target gdnative.Vector3, up gdnative.Vector3) {
 }
 
 /*
-        Moves the node to specified position and then rotates itself to point into direction of target position. Operations take place in global space.
-	Args: [{ false position Vector3} { false target Vector3} { false up Vector3}], Returns: void
+	        Moves the node to specified position and then rotates itself to point into direction of target position. Operations take place in global space.
+		Args: [{ false position Vector3} { false target Vector3} { false up Vector3}], Returns: void
 */
 func (o *Spatial) LookAtFromPosition(position gdnative.Vector3, target gdnative.Vector3, up gdnative.Vector3) {
 	//log.Println("Calling Spatial.LookAtFromPosition()")
@@ -538,7 +596,7 @@ func (o *Spatial) LookAtFromPosition(position gdnative.Vector3, target gdnative.
 	ptrArguments[2] = gdnative.NewPointerFromVector3(up)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "look_at_from_position")
+	methodBind := methodBindSpatialLookAtFromPosition.Get()
 
 	// Call the parent method.
 	// void
@@ -548,8 +606,8 @@ func (o *Spatial) LookAtFromPosition(position gdnative.Vector3, target gdnative.
 }
 
 /*
-        Resets this node's transformations (like scale, skew and taper) preserving its rotation and translation by performing Gram-Schmidt orthonormalization on this node's [Transform3D].
-	Args: [], Returns: void
+	        Resets this node's transformations (like scale, skew and taper) preserving its rotation and translation by performing Gram-Schmidt orthonormalization on this node's [Transform3D].
+		Args: [], Returns: void
 */
 func (o *Spatial) Orthonormalize() {
 	//log.Println("Calling Spatial.Orthonormalize()")
@@ -558,7 +616,7 @@ func (o *Spatial) Orthonormalize() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "orthonormalize")
+	methodBind := methodBindSpatialOrthonormalize.Get()
 
 	// Call the parent method.
 	// void
@@ -568,8 +626,8 @@ func (o *Spatial) Orthonormalize() {
 }
 
 /*
-        Rotates the local transformation around axis, a unit [Vector3], by specified angle in radians.
-	Args: [{ false axis Vector3} { false angle float}], Returns: void
+	        Rotates the local transformation around axis, a unit [Vector3], by specified angle in radians.
+		Args: [{ false axis Vector3} { false angle float}], Returns: void
 */
 func (o *Spatial) Rotate(axis gdnative.Vector3, angle gdnative.Real) {
 	//log.Println("Calling Spatial.Rotate()")
@@ -580,7 +638,7 @@ func (o *Spatial) Rotate(axis gdnative.Vector3, angle gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(angle)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "rotate")
+	methodBind := methodBindSpatialRotate.Get()
 
 	// Call the parent method.
 	// void
@@ -590,8 +648,8 @@ func (o *Spatial) Rotate(axis gdnative.Vector3, angle gdnative.Real) {
 }
 
 /*
-        Rotates the local transformation around axis, a unit [Vector3], by specified angle in radians. The rotation axis is in object-local coordinate system.
-	Args: [{ false axis Vector3} { false angle float}], Returns: void
+	        Rotates the local transformation around axis, a unit [Vector3], by specified angle in radians. The rotation axis is in object-local coordinate system.
+		Args: [{ false axis Vector3} { false angle float}], Returns: void
 */
 func (o *Spatial) RotateObjectLocal(axis gdnative.Vector3, angle gdnative.Real) {
 	//log.Println("Calling Spatial.RotateObjectLocal()")
@@ -602,7 +660,7 @@ func (o *Spatial) RotateObjectLocal(axis gdnative.Vector3, angle gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(angle)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "rotate_object_local")
+	methodBind := methodBindSpatialRotateObjectLocal.Get()
 
 	// Call the parent method.
 	// void
@@ -612,8 +670,8 @@ func (o *Spatial) RotateObjectLocal(axis gdnative.Vector3, angle gdnative.Real)
 }
 
 /*
-        Rotates the local transformation around the X axis by angle in radians
-	Args: [{ false angle float}], Returns: void
+	        Rotates the local transformation around the X axis by angle in radians
+		Args: [{ false angle float}], Returns: void
 */
 func (o *Spatial) RotateX(angle gdnative.Real) {
 	//log.Println("Calling Spatial.RotateX()")
@@ -623,7 +681,7 @@ func (o *Spatial) RotateX(angle gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "rotate_x")
+	methodBind := methodBindSpatialRotateX.Get()
 
 	// Call the parent method.
 	// void
@@ -633,8 +691,8 @@ func (o *Spatial) RotateX(angle gdnative.Real) {
 }
 
 /*
-        Rotates the local transformation around the Y axis by angle in radians.
-	Args: [{ false angle float}], Returns: void
+	        Rotates the local transformation around the Y axis by angle in radians.
+		Args: [{ false angle float}], Returns: void
 */
 func (o *Spatial) RotateY(angle gdnative.Real) {
 	//log.Println("Calling Spatial.RotateY()")
@@ -644,7 +702,7 @@ func (o *Spatial) RotateY(angle gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "rotate_y")
+	methodBind := methodBindSpatialRotateY.Get()
 
 	// Call the parent method.
 	// void
@@ -654,8 +712,8 @@ func (o *Spatial) RotateY(angle gdnative.Real) {
 }
 
 /*
-        Rotates the local transformation around the Z axis by angle in radians.
-	Args: [{ false angle float}], Returns: void
+	        Rotates the local transformation around the Z axis by angle in radians.
+		Args: [{ false angle float}], Returns: void
 */
 func (o *Spatial) RotateZ(angle gdnative.Real) {
 	//log.Println("Calling Spatial.RotateZ()")
@@ -665,7 +723,7 @@ func (o *Spatial) RotateZ(angle gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "rotate_z")
+	methodBind := methodBindSpatialRotateZ.Get()
 
 	// Call the parent method.
 	// void
@@ -675,8 +733,8 @@ func (o *Spatial) RotateZ(angle gdnative.Real) {
 }
 
 /*
-        Scales the local transformation by given 3D scale factors in object-local coordinate system.
-	Args: [{ false scale Vector3}], Returns: void
+	        Scales the local transformation by given 3D scale factors in object-local coordinate system.
+		Args: [{ false scale Vector3}], Returns: void
 */
 func (o *Spatial) ScaleObjectLocal(scale gdnative.Vector3) {
 	//log.Println("Calling Spatial.ScaleObjectLocal()")
@@ -686,7 +744,7 @@ func (o *Spatial) ScaleObjectLocal(scale gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "scale_object_local")
+	methodBind := methodBindSpatialScaleObjectLocal.Get()
 
 	// Call the parent method.
 	// void
@@ -696,8 +754,8 @@ func (o *Spatial) ScaleObjectLocal(scale gdnative.Vector3) {
 }
 
 /*
-        Makes the node ignore its parents transformations. Node transformations are only in global space.
-	Args: [{ false enable bool}], Returns: void
+	        Makes the node ignore its parents transformations. Node transformations are only in global space.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Spatial) SetAsToplevel(enable gdnative.Bool) {
 	//log.Println("Calling Spatial.SetAsToplevel()")
@@ -707,7 +765,7 @@ func (o *Spatial) SetAsToplevel(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_as_toplevel")
+	methodBind := methodBindSpatialSetAsToplevel.Get()
 
 	// Call the parent method.
 	// void
@@ -717,18 +775,18 @@ func (o *Spatial) SetAsToplevel(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false gizmo SpatialGizmo}], Returns: void
+	        Undocumented
+		Args: [{ false gizmo SpatialGizmo}], Returns: void
 */
 func (o *Spatial) SetGizmo(gizmo SpatialGizmoImplementer) {
 	//log.Println("Calling Spatial.SetGizmo()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(gizmo.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(gizmo))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_gizmo")
+	methodBind := methodBindSpatialSetGizmo.Get()
 
 	// Call the parent method.
 	// void
@@ -738,8 +796,8 @@ func (o *Spatial) SetGizmo(gizmo SpatialGizmoImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false global Transform}], Returns: void
+	        Undocumented
+		Args: [{ false global Transform}], Returns: void
 */
 func (o *Spatial) SetGlobalTransform(global gdnative.Transform) {
 	//log.Println("Calling Spatial.SetGlobalTransform()")
@@ -749,7 +807,7 @@ func (o *Spatial) SetGlobalTransform(global gdnative.Transform) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform(global)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_global_transform")
+	methodBind := methodBindSpatialSetGlobalTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -759,8 +817,8 @@ func (o *Spatial) SetGlobalTransform(global gdnative.Transform) {
 }
 
 /*
-        Reset all transformations for this node. Set its [Transform3D] to identity matrix.
-	Args: [], Returns: void
+	        Reset all transformations for this node. Set its [Transform3D] to identity matrix.
+		Args: [], Returns: void
 */
 func (o *Spatial) SetIdentity() {
 	//log.Println("Calling Spatial.SetIdentity()")
@@ -769,7 +827,7 @@ func (o *Spatial) SetIdentity() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_identity")
+	methodBind := methodBindSpatialSetIdentity.Get()
 
 	// Call the parent method.
 	// void
@@ -779,8 +837,8 @@ func (o *Spatial) SetIdentity() {
 }
 
 /*
-        Set whether the node ignores notification that its transformation (global or local) changed.
-	Args: [{ false enabled bool}], Returns: void
+	        Set whether the node ignores notification that its transformation (global or local) changed.
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Spatial) SetIgnoreTransformNotification(enabled gdnative.Bool) {
 	//log.Println("Calling Spatial.SetIgnoreTransformNotification()")
@@ -790,7 +848,7 @@ func (o *Spatial) SetIgnoreTransformNotification(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_ignore_transform_notification")
+	methodBind := methodBindSpatialSetIgnoreTransformNotification.Get()
 
 	// Call the parent method.
 	// void
@@ -800,8 +858,8 @@ func (o *Spatial) SetIgnoreTransformNotification(enabled gdnative.Bool) {
 }
 
 /*
-        Set whether the node notifies about its local transformation changes. Spatial will not propagate this by default.
-	Args: [{ false enable bool}], Returns: void
+	        Set whether the node notifies about its local transformation changes. Spatial will not propagate this by default.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Spatial) SetNotifyLocalTransform(enable gdnative.Bool) {
 	//log.Println("Calling Spatial.SetNotifyLocalTransform()")
@@ -811,7 +869,7 @@ func (o *Spatial) SetNotifyLocalTransform(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_notify_local_transform")
+	methodBind := methodBindSpatialSetNotifyLocalTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -821,8 +879,8 @@ func (o *Spatial) SetNotifyLocalTransform(enable gdnative.Bool) {
 }
 
 /*
-        Set whether the node notifies about its global and local transformation changes. Spatial will not propagate this by default.
-	Args: [{ false enable bool}], Returns: void
+	        Set whether the node notifies about its global and local transformation changes. Spatial will not propagate this by default.
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Spatial) SetNotifyTransform(enable gdnative.Bool) {
 	//log.Println("Calling Spatial.SetNotifyTransform()")
@@ -832,7 +890,7 @@ func (o *Spatial) SetNotifyTransform(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_notify_transform")
+	methodBind := methodBindSpatialSetNotifyTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -842,8 +900,8 @@ func (o *Spatial) SetNotifyTransform(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false euler Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false euler Vector3}], Returns: void
 */
 func (o *Spatial) SetRotation(euler gdnative.Vector3) {
 	//log.Println("Calling Spatial.SetRotation()")
@@ -853,7 +911,7 @@ func (o *Spatial) SetRotation(euler gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(euler)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_rotation")
+	methodBind := methodBindSpatialSetRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -863,8 +921,8 @@ func (o *Spatial) SetRotation(euler gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false euler_degrees Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false euler_degrees Vector3}], Returns: void
 */
 func (o *Spatial) SetRotationDegrees(eulerDegrees gdnative.Vector3) {
 	//log.Println("Calling Spatial.SetRotationDegrees()")
@@ -874,7 +932,7 @@ func (o *Spatial) SetRotationDegrees(eulerDegrees gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(eulerDegrees)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_rotation_degrees")
+	methodBind := methodBindSpatialSetRotationDegrees.Get()
 
 	// Call the parent method.
 	// void
@@ -884,8 +942,8 @@ func (o *Spatial) SetRotationDegrees(eulerDegrees gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false scale Vector3}], Returns: void
 */
 func (o *Spatial) SetScale(scale gdnative.Vector3) {
 	//log.Println("Calling Spatial.SetScale()")
@@ -895,7 +953,7 @@ func (o *Spatial) SetScale(scale gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_scale")
+	methodBind := methodBindSpatialSetScale.Get()
 
 	// Call the parent method.
 	// void
@@ -905,8 +963,8 @@ func (o *Spatial) SetScale(scale gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false local Transform}], Returns: void
+	        Undocumented
+		Args: [{ false local Transform}], Returns: void
 */
 func (o *Spatial) SetTransform(local gdnative.Transform) {
 	//log.Println("Calling Spatial.SetTransform()")
@@ -916,7 +974,7 @@ func (o *Spatial) SetTransform(local gdnative.Transform) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform(local)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_transform")
+	methodBind := methodBindSpatialSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -926,8 +984,8 @@ func (o *Spatial) SetTransform(local gdnative.Transform) {
 }
 
 /*
-        Undocumented
-	Args: [{ false translation Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false translation Vector3}], Returns: void
 */
 func (o *Spatial) SetTranslation(translation gdnative.Vector3) {
 	//log.Println("Calling Spatial.SetTranslation()")
@@ -937,7 +995,7 @@ func (o *Spatial) SetTranslation(translation gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(translation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_translation")
+	methodBind := methodBindSpatialSetTranslation.Get()
 
 	// Call the parent method.
 	// void
@@ -947,8 +1005,8 @@ func (o *Spatial) SetTranslation(translation gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false visible bool}], Returns: void
+	        Undocumented
+		Args: [{ false visible bool}], Returns: void
 */
 func (o *Spatial) SetVisible(visible gdnative.Bool) {
 	//log.Println("Calling Spatial.SetVisible()")
@@ -958,7 +1016,7 @@ func (o *Spatial) SetVisible(visible gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(visible)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "set_visible")
+	methodBind := methodBindSpatialSetVisible.Get()
 
 	// Call the parent method.
 	// void
@@ -968,8 +1026,8 @@ func (o *Spatial) SetVisible(visible gdnative.Bool) {
 }
 
 /*
-        Enables rendering of this node. Change Spatial Visible property to "True".
-	Args: [], Returns: void
+	        Enables rendering of this node. Change Spatial Visible property to "True".
+		Args: [], Returns: void
 */
 func (o *Spatial) Show() {
 	//log.Println("Calling Spatial.Show()")
@@ -978,7 +1036,7 @@ func (o *Spatial) Show() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "show")
+	methodBind := methodBindSpatialShow.Get()
 
 	// Call the parent method.
 	// void
@@ -988,8 +1046,8 @@ func (o *Spatial) Show() {
 }
 
 /*
-        Transforms [Vector3] "local_point" from this node's local space to world space.
-	Args: [{ false local_point Vector3}], Returns: Vector3
+	        Transforms [Vector3] "local_point" from this node's local space to world space.
+		Args: [{ false local_point Vector3}], Returns: Vector3
 */
 func (o *Spatial) ToGlobal(localPoint gdnative.Vector3) gdnative.Vector3 {
 	//log.Println("Calling Spatial.ToGlobal()")
@@ -999,7 +1057,7 @@ func (o *Spatial) ToGlobal(localPoint gdnative.Vector3) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(localPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "to_global")
+	methodBind := methodBindSpatialToGlobal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -1012,8 +1070,8 @@ func (o *Spatial) ToGlobal(localPoint gdnative.Vector3) gdnative.Vector3 {
 }
 
 /*
-        Transforms [Vector3] "global_point" from world space to this node's local space.
-	Args: [{ false global_point Vector3}], Returns: Vector3
+	        Transforms [Vector3] "global_point" from world space to this node's local space.
+		Args: [{ false global_point Vector3}], Returns: Vector3
 */
 func (o *Spatial) ToLocal(globalPoint gdnative.Vector3) gdnative.Vector3 {
 	//log.Println("Calling Spatial.ToLocal()")
@@ -1023,7 +1081,7 @@ func (o *Spatial) ToLocal(globalPoint gdnative.Vector3) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(globalPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "to_local")
+	methodBind := methodBindSpatialToLocal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -1036,8 +1094,8 @@ func (o *Spatial) ToLocal(globalPoint gdnative.Vector3) gdnative.Vector3 {
 }
 
 /*
-        Changes the node's position by given offset [Vector3].
-	Args: [{ false offset Vector3}], Returns: void
+	        Changes the node's position by given offset [Vector3].
+		Args: [{ false offset Vector3}], Returns: void
 */
 func (o *Spatial) Translate(offset gdnative.Vector3) {
 	//log.Println("Calling Spatial.Translate()")
@@ -1047,7 +1105,7 @@ func (o *Spatial) Translate(offset gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "translate")
+	methodBind := methodBindSpatialTranslate.Get()
 
 	// Call the parent method.
 	// void
@@ -1057,8 +1115,7 @@ func (o *Spatial) Translate(offset gdnative.Vector3) {
 }
 
 /*
-
-	Args: [{ false offset Vector3}], Returns: void
+Args: [{ false offset Vector3}], Returns: void
 */
 func (o *Spatial) TranslateObjectLocal(offset gdnative.Vector3) {
 	//log.Println("Calling Spatial.TranslateObjectLocal()")
@@ -1068,7 +1125,7 @@ func (o *Spatial) TranslateObjectLocal(offset gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "translate_object_local")
+	methodBind := methodBindSpatialTranslateObjectLocal.Get()
 
 	// Call the parent method.
 	// void
@@ -1078,8 +1135,8 @@ func (o *Spatial) TranslateObjectLocal(offset gdnative.Vector3) {
 }
 
 /*
-        Updates the [SpatialGizmo] of this node.
-	Args: [], Returns: void
+	        Updates the [SpatialGizmo] of this node.
+		Args: [], Returns: void
 */
 func (o *Spatial) UpdateGizmo() {
 	//log.Println("Calling Spatial.UpdateGizmo()")
@@ -1088,7 +1145,7 @@ func (o *Spatial) UpdateGizmo() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Spatial", "update_gizmo")
+	methodBind := methodBindSpatialUpdateGizmo.Get()
 
 	// Call the parent method.
 	// void
@@ -1097,6 +1154,67 @@ func (o *Spatial) UpdateGizmo() {
 
 }
 
+// Gizmo will return the value of the "gizmo" property.
+func (o *Spatial) Gizmo() SpatialGizmoImplementer {
+	return o.GetGizmo()
+}
+
+// GlobalTransform will return the value of the "global_transform" property.
+func (o *Spatial) GlobalTransform() gdnative.Transform {
+	return o.GetGlobalTransform()
+}
+
+// Rotation will return the value of the "rotation" property.
+func (o *Spatial) Rotation() gdnative.Vector3 {
+	return o.GetRotation()
+}
+
+// RotationDegrees will return the value of the "rotation_degrees" property.
+func (o *Spatial) RotationDegrees() gdnative.Vector3 {
+	return o.GetRotationDegrees()
+}
+
+// Scale will return the value of the "scale" property.
+func (o *Spatial) Scale() gdnative.Vector3 {
+	return o.GetScale()
+}
+
+// Transform will return the value of the "transform" property.
+func (o *Spatial) Transform() gdnative.Transform {
+	return o.GetTransform()
+}
+
+// Translation will return the value of the "translation" property.
+func (o *Spatial) Translation() gdnative.Vector3 {
+	return o.GetTranslation()
+}
+
+// Visible will return the value of the "visible" property.
+func (o *Spatial) Visible() gdnative.Bool {
+	return o.IsVisible()
+}
+
+// Signals of the Spatial class.
+const (
+	SpatialSignalVisibilityChanged gdnative.String = "visibility_changed"
+)
+
+// ConnectVisibilityChanged will connect the "visibility_changed" signal to the given method of the target object.
+func (o *Spatial) ConnectVisibilityChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(SpatialSignalVisibilityChanged, target, method, binds, 0)
+}
+
+// EmitVisibilityChanged will emit the "visibility_changed" signal.
+func (o *Spatial) EmitVisibilityChanged() error {
+
+	ret, err := o.EmitSignal(SpatialSignalVisibilityChanged)
+	ret.Destroy()
+	return err
+}
+
 // SpatialImplementer is an interface that implements the methods
 // of the Spatial class.
 type SpatialImplementer interface {
@@ -1148,4 +1266,14 @@ type SpatialImplementer interface {
 	Translate(offset gdnative.Vector3)
 	TranslateObjectLocal(offset gdnative.Vector3)
 	UpdateGizmo()
+	Gizmo() SpatialGizmoImplementer
+	GlobalTransform() gdnative.Transform
+	Rotation() gdnative.Vector3
+	RotationDegrees() gdnative.Vector3
+	Scale() gdnative.Vector3
+	Transform() gdnative.Transform
+	Translation() gdnative.Vector3
+	Visible() gdnative.Bool
+	ConnectVisibilityChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitVisibilityChanged() error
 }
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewAnimatedSprite3DFromPointer(ptr gdnative.Pointer) AnimatedSprite3D {
-func newAnimatedSprite3DFromPointer(ptr gdnative.Pointer) AnimatedSprite3D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := AnimatedSprite3D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Animations are created using a [SpriteFrames] resource, which can be configured in the editor via the SpriteFrames panel.
 */
@@ -30,13 +22,44 @@ type AnimatedSprite3D struct {
 	owner gdnative.Object
 }
 
+// classConstructorAnimatedSprite3D is the class constructor of the AnimatedSprite3D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorAnimatedSprite3D = gdnative.NewLazyClassConstructor("AnimatedSprite3D")
+
+// NewAnimatedSprite3D will create a new instance of the AnimatedSprite3D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewAnimatedSprite3D() *AnimatedSprite3D {
+	obj := &AnimatedSprite3D{}
+	obj.SetBaseObject(classConstructorAnimatedSprite3D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *AnimatedSprite3D) BaseClass() string {
 	return "AnimatedSprite3D"
 }
 
+// Method binds of the AnimatedSprite3D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAnimatedSprite3DX_IsPlaying     = gdnative.NewLazyMethodBind("AnimatedSprite3D", "_is_playing")
+	methodBindAnimatedSprite3DX_ResChanged    = gdnative.NewLazyMethodBind("AnimatedSprite3D", "_res_changed")
+	methodBindAnimatedSprite3DX_SetPlaying    = gdnative.NewLazyMethodBind("AnimatedSprite3D", "_set_playing")
+	methodBindAnimatedSprite3DGetAnimation    = gdnative.NewLazyMethodBind("AnimatedSprite3D", "get_animation")
+	methodBindAnimatedSprite3DGetFrame        = gdnative.NewLazyMethodBind("AnimatedSprite3D", "get_frame")
+	methodBindAnimatedSprite3DGetSpriteFrames = gdnative.NewLazyMethodBind("AnimatedSprite3D", "get_sprite_frames")
+	methodBindAnimatedSprite3DIsPlaying       = gdnative.NewLazyMethodBind("AnimatedSprite3D", "is_playing")
+	methodBindAnimatedSprite3DPlay            = gdnative.NewLazyMethodBind("AnimatedSprite3D", "play")
+	methodBindAnimatedSprite3DSetAnimation    = gdnative.NewLazyMethodBind("AnimatedSprite3D", "set_animation")
+	methodBindAnimatedSprite3DSetFrame        = gdnative.NewLazyMethodBind("AnimatedSprite3D", "set_frame")
+	methodBindAnimatedSprite3DSetSpriteFrames = gdnative.NewLazyMethodBind("AnimatedSprite3D", "set_sprite_frames")
+	methodBindAnimatedSprite3DStop            = gdnative.NewLazyMethodBind("AnimatedSprite3D", "stop")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *AnimatedSprite3D) X_IsPlaying() gdnative.Bool {
 	//log.Println("Calling AnimatedSprite3D.X_IsPlaying()")
@@ -45,7 +68,7 @@ func (o *AnimatedSprite3D) X_IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "_is_playing")
+	methodBind := methodBindAnimatedSprite3DX_IsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +81,8 @@ func (o *AnimatedSprite3D) X_IsPlaying() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *AnimatedSprite3D) X_ResChanged() {
 	//log.Println("Calling AnimatedSprite3D.X_ResChanged()")
@@ -68,7 +91,7 @@ func (o *AnimatedSprite3D) X_ResChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "_res_changed")
+	methodBind := methodBindAnimatedSprite3DX_ResChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -78,8 +101,8 @@ func (o *AnimatedSprite3D) X_ResChanged() {
 }
 
 /*
-        Undocumented
-	Args: [{ false playing bool}], Returns: void
+	        Undocumented
+		Args: [{ false playing bool}], Returns: void
 */
 func (o *AnimatedSprite3D) X_SetPlaying(playing gdnative.Bool) {
 	//log.Println("Calling AnimatedSprite3D.X_SetPlaying()")
@@ -89,7 +112,7 @@ func (o *AnimatedSprite3D) X_SetPlaying(playing gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(playing)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "_set_playing")
+	methodBind := methodBindAnimatedSprite3DX_SetPlaying.Get()
 
 	// Call the parent method.
 	// void
@@ -99,8 +122,8 @@ func (o *AnimatedSprite3D) X_SetPlaying(playing gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *AnimatedSprite3D) GetAnimation() gdnative.String {
 	//log.Println("Calling AnimatedSprite3D.GetAnimation()")
@@ -109,7 +132,7 @@ func (o *AnimatedSprite3D) GetAnimation() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "get_animation")
+	methodBind := methodBindAnimatedSprite3DGetAnimation.Get()
 
 	// Call the parent method.
 	// String
@@ -122,8 +145,8 @@ func (o *AnimatedSprite3D) GetAnimation() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *AnimatedSprite3D) GetFrame() gdnative.Int {
 	//log.Println("Calling AnimatedSprite3D.GetFrame()")
@@ -132,7 +155,7 @@ func (o *AnimatedSprite3D) GetFrame() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "get_frame")
+	methodBind := methodBindAnimatedSprite3DGetFrame.Get()
 
 	// Call the parent method.
 	// int
@@ -145,17 +168,17 @@ func (o *AnimatedSprite3D) GetFrame() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: SpriteFrames
+	        Undocumented
+		Args: [], Returns: SpriteFrames
 */
-func (o *AnimatedSprite3D) GetSpriteFrames() SpriteFramesImplementer {
+func (o *AnimatedSprite3D) GetSpriteFrames() godot.SpriteFramesImplementer {
 	//log.Println("Calling AnimatedSprite3D.GetSpriteFrames()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "get_sprite_frames")
+	methodBind := methodBindAnimatedSprite3DGetSpriteFrames.Get()
 
 	// Call the parent method.
 	// SpriteFrames
@@ -163,27 +186,25 @@ func (o *AnimatedSprite3D) GetSpriteFrames() SpriteFramesImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newSpriteFramesFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(SpriteFramesImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "SpriteFrames" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(SpriteFramesImplementer)
+	ret := godot.SpriteFrames{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.SpriteFramesImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return true if an animation if currently being played.
-	Args: [], Returns: bool
+	        Return true if an animation if currently being played.
+		Args: [], Returns: bool
 */
 func (o *AnimatedSprite3D) IsPlaying() gdnative.Bool {
 	//log.Println("Calling AnimatedSprite3D.IsPlaying()")
@@ -192,7 +213,7 @@ func (o *AnimatedSprite3D) IsPlaying() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "is_playing")
+	methodBind := methodBindAnimatedSprite3DIsPlaying.Get()
 
 	// Call the parent method.
 	// bool
@@ -205,8 +226,8 @@ func (o *AnimatedSprite3D) IsPlaying() gdnative.Bool {
 }
 
 /*
-        Play the animation set in parameter. If no parameter is provided, the current animation is played.
-	Args: [{ true anim String}], Returns: void
+	        Play the animation set in parameter. If no parameter is provided, the current animation is played.
+		Args: [{ true anim String}], Returns: void
 */
 func (o *AnimatedSprite3D) Play(anim gdnative.String) {
 	//log.Println("Calling AnimatedSprite3D.Play()")
@@ -216,7 +237,7 @@ func (o *AnimatedSprite3D) Play(anim gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "play")
+	methodBind := methodBindAnimatedSprite3DPlay.Get()
 
 	// Call the parent method.
 	// void
@@ -226,8 +247,8 @@ func (o *AnimatedSprite3D) Play(anim gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false animation String}], Returns: void
+	        Undocumented
+		Args: [{ false animation String}], Returns: void
 */
 func (o *AnimatedSprite3D) SetAnimation(animation gdnative.String) {
 	//log.Println("Calling AnimatedSprite3D.SetAnimation()")
@@ -237,7 +258,7 @@ func (o *AnimatedSprite3D) SetAnimation(animation gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(animation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "set_animation")
+	methodBind := methodBindAnimatedSprite3DSetAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -247,8 +268,8 @@ func (o *AnimatedSprite3D) SetAnimation(animation gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false frame int}], Returns: void
+	        Undocumented
+		Args: [{ false frame int}], Returns: void
 */
 func (o *AnimatedSprite3D) SetFrame(frame gdnative.Int) {
 	//log.Println("Calling AnimatedSprite3D.SetFrame()")
@@ -258,7 +279,7 @@ func (o *AnimatedSprite3D) SetFrame(frame gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(frame)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "set_frame")
+	methodBind := methodBindAnimatedSprite3DSetFrame.Get()
 
 	// Call the parent method.
 	// void
@@ -268,18 +289,18 @@ func (o *AnimatedSprite3D) SetFrame(frame gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false sprite_frames SpriteFrames}], Returns: void
+	        Undocumented
+		Args: [{ false sprite_frames SpriteFrames}], Returns: void
 */
-func (o *AnimatedSprite3D) SetSpriteFrames(spriteFrames SpriteFramesImplementer) {
+func (o *AnimatedSprite3D) SetSpriteFrames(spriteFrames godot.SpriteFramesImplementer) {
 	//log.Println("Calling AnimatedSprite3D.SetSpriteFrames()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(spriteFrames.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(spriteFrames))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "set_sprite_frames")
+	methodBind := methodBindAnimatedSprite3DSetSpriteFrames.Get()
 
 	// Call the parent method.
 	// void
@@ -289,8 +310,8 @@ func (o *AnimatedSprite3D) SetSpriteFrames(spriteFrames SpriteFramesImplementer)
 }
 
 /*
-        Stop the current animation (does not reset the frame counter).
-	Args: [], Returns: void
+	        Stop the current animation (does not reset the frame counter).
+		Args: [], Returns: void
 */
 func (o *AnimatedSprite3D) Stop() {
 	//log.Println("Calling AnimatedSprite3D.Stop()")
@@ -299,7 +320,7 @@ func (o *AnimatedSprite3D) Stop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("AnimatedSprite3D", "stop")
+	methodBind := methodBindAnimatedSprite3DStop.Get()
 
 	// Call the parent method.
 	// void
@@ -308,6 +329,62 @@ func (o *AnimatedSprite3D) Stop() {
 
 }
 
+// PlayWithDefaults will call Play using the default values for: anim.
+func (o *AnimatedSprite3D) PlayWithDefaults() {
+	o.Play("")
+}
+
+// Animation will return the value of the "animation" property.
+func (o *AnimatedSprite3D) Animation() gdnative.String {
+	return o.GetAnimation()
+}
+
+// Frame will return the value of the "frame" property.
+func (o *AnimatedSprite3D) Frame() gdnative.Int {
+	return o.GetFrame()
+}
+
+// Frames will return the value of the "frames" property.
+func (o *AnimatedSprite3D) Frames() godot.SpriteFramesImplementer {
+	return o.GetSpriteFrames()
+}
+
+// SetFrames will set the value of the "frames" property.
+func (o *AnimatedSprite3D) SetFrames(value godot.SpriteFramesImplementer) {
+	o.SetSpriteFrames(value)
+}
+
+// Playing will return the value of the "playing" property.
+func (o *AnimatedSprite3D) Playing() gdnative.Bool {
+	return o.X_IsPlaying()
+}
+
+// SetPlaying will set the value of the "playing" property.
+func (o *AnimatedSprite3D) SetPlaying(value gdnative.Bool) {
+	o.X_SetPlaying(value)
+}
+
+// Signals of the AnimatedSprite3D class.
+const (
+	AnimatedSprite3DSignalFrameChanged gdnative.String = "frame_changed"
+)
+
+// ConnectFrameChanged will connect the "frame_changed" signal to the given method of the target object.
+func (o *AnimatedSprite3D) ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AnimatedSprite3DSignalFrameChanged, target, method, binds, 0)
+}
+
+// EmitFrameChanged will emit the "frame_changed" signal.
+func (o *AnimatedSprite3D) EmitFrameChanged() error {
+
+	ret, err := o.EmitSignal(AnimatedSprite3DSignalFrameChanged)
+	ret.Destroy()
+	return err
+}
+
 // AnimatedSprite3DImplementer is an interface that implements the methods
 // of the AnimatedSprite3D class.
 type AnimatedSprite3DImplementer interface {
@@ -317,11 +394,20 @@ type AnimatedSprite3DImplementer interface {
 	X_SetPlaying(playing gdnative.Bool)
 	GetAnimation() gdnative.String
 	GetFrame() gdnative.Int
-	GetSpriteFrames() SpriteFramesImplementer
+	GetSpriteFrames() godot.SpriteFramesImplementer
 	IsPlaying() gdnative.Bool
 	Play(anim gdnative.String)
 	SetAnimation(animation gdnative.String)
 	SetFrame(frame gdnative.Int)
-	SetSpriteFrames(spriteFrames SpriteFramesImplementer)
+	SetSpriteFrames(spriteFrames godot.SpriteFramesImplementer)
 	Stop()
+	PlayWithDefaults()
+	Animation() gdnative.String
+	Frame() gdnative.Int
+	Frames() godot.SpriteFramesImplementer
+	SetFrames(value godot.SpriteFramesImplementer)
+	Playing() gdnative.Bool
+	SetPlaying(value gdnative.Bool)
+	ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFrameChanged() error
 }
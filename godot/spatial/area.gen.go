@@ -0,0 +1,1715 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// AreaSpaceOverride is an enum for SpaceOverride values.
+type AreaSpaceOverride int
+
+const (
+	AreaSpaceOverrideCombine        AreaSpaceOverride = 1
+	AreaSpaceOverrideCombineReplace AreaSpaceOverride = 2
+	AreaSpaceOverrideDisabled       AreaSpaceOverride = 0
+	AreaSpaceOverrideReplace        AreaSpaceOverride = 3
+	AreaSpaceOverrideReplaceCombine AreaSpaceOverride = 4
+)
+
+// String will return the Godot name of the AreaSpaceOverride value.
+func (e AreaSpaceOverride) String() string {
+	switch e {
+	case AreaSpaceOverrideCombine:
+		return "SPACE_OVERRIDE_COMBINE"
+	case AreaSpaceOverrideCombineReplace:
+		return "SPACE_OVERRIDE_COMBINE_REPLACE"
+	case AreaSpaceOverrideDisabled:
+		return "SPACE_OVERRIDE_DISABLED"
+	case AreaSpaceOverrideReplace:
+		return "SPACE_OVERRIDE_REPLACE"
+	case AreaSpaceOverrideReplaceCombine:
+		return "SPACE_OVERRIDE_REPLACE_COMBINE"
+	}
+	return fmt.Sprintf("AreaSpaceOverride(%d)", int(e))
+}
+
+// ParseAreaSpaceOverride will return the AreaSpaceOverride value with the given Godot name.
+func ParseAreaSpaceOverride(name string) (AreaSpaceOverride, error) {
+	switch name {
+	case "SPACE_OVERRIDE_COMBINE":
+		return AreaSpaceOverrideCombine, nil
+	case "SPACE_OVERRIDE_COMBINE_REPLACE":
+		return AreaSpaceOverrideCombineReplace, nil
+	case "SPACE_OVERRIDE_DISABLED":
+		return AreaSpaceOverrideDisabled, nil
+	case "SPACE_OVERRIDE_REPLACE":
+		return AreaSpaceOverrideReplace, nil
+	case "SPACE_OVERRIDE_REPLACE_COMBINE":
+		return AreaSpaceOverrideReplaceCombine, nil
+	}
+	return 0, fmt.Errorf("invalid AreaSpaceOverride value %q", name)
+}
+
+/*
+3D area that detects [CollisionObject] nodes overlapping, entering, or exiting. Can also alter or override local physics parameters (gravity, damping).
+*/
+type Area struct {
+	godot.CollisionObject
+	owner gdnative.Object
+}
+
+// classConstructorArea is the class constructor of the Area class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorArea = gdnative.NewLazyClassConstructor("Area")
+
+// NewArea will create a new instance of the Area class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewArea() *Area {
+	obj := &Area{}
+	obj.SetBaseObject(classConstructorArea.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Area) BaseClass() string {
+	return "Area"
+}
+
+// Method binds of the Area class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindAreaX_AreaEnterTree         = gdnative.NewLazyMethodBind("Area", "_area_enter_tree")
+	methodBindAreaX_AreaExitTree          = gdnative.NewLazyMethodBind("Area", "_area_exit_tree")
+	methodBindAreaX_AreaInout             = gdnative.NewLazyMethodBind("Area", "_area_inout")
+	methodBindAreaX_BodyEnterTree         = gdnative.NewLazyMethodBind("Area", "_body_enter_tree")
+	methodBindAreaX_BodyExitTree          = gdnative.NewLazyMethodBind("Area", "_body_exit_tree")
+	methodBindAreaX_BodyInout             = gdnative.NewLazyMethodBind("Area", "_body_inout")
+	methodBindAreaGetAngularDamp          = gdnative.NewLazyMethodBind("Area", "get_angular_damp")
+	methodBindAreaGetAudioBus             = gdnative.NewLazyMethodBind("Area", "get_audio_bus")
+	methodBindAreaGetCollisionLayer       = gdnative.NewLazyMethodBind("Area", "get_collision_layer")
+	methodBindAreaGetCollisionLayerBit    = gdnative.NewLazyMethodBind("Area", "get_collision_layer_bit")
+	methodBindAreaGetCollisionMask        = gdnative.NewLazyMethodBind("Area", "get_collision_mask")
+	methodBindAreaGetCollisionMaskBit     = gdnative.NewLazyMethodBind("Area", "get_collision_mask_bit")
+	methodBindAreaGetGravity              = gdnative.NewLazyMethodBind("Area", "get_gravity")
+	methodBindAreaGetGravityDistanceScale = gdnative.NewLazyMethodBind("Area", "get_gravity_distance_scale")
+	methodBindAreaGetGravityVector        = gdnative.NewLazyMethodBind("Area", "get_gravity_vector")
+	methodBindAreaGetLinearDamp           = gdnative.NewLazyMethodBind("Area", "get_linear_damp")
+	methodBindAreaGetOverlappingAreas     = gdnative.NewLazyMethodBind("Area", "get_overlapping_areas")
+	methodBindAreaGetOverlappingBodies    = gdnative.NewLazyMethodBind("Area", "get_overlapping_bodies")
+	methodBindAreaGetPriority             = gdnative.NewLazyMethodBind("Area", "get_priority")
+	methodBindAreaGetReverbAmount         = gdnative.NewLazyMethodBind("Area", "get_reverb_amount")
+	methodBindAreaGetReverbBus            = gdnative.NewLazyMethodBind("Area", "get_reverb_bus")
+	methodBindAreaGetReverbUniformity     = gdnative.NewLazyMethodBind("Area", "get_reverb_uniformity")
+	methodBindAreaGetSpaceOverrideMode    = gdnative.NewLazyMethodBind("Area", "get_space_override_mode")
+	methodBindAreaIsGravityAPoint         = gdnative.NewLazyMethodBind("Area", "is_gravity_a_point")
+	methodBindAreaIsMonitorable           = gdnative.NewLazyMethodBind("Area", "is_monitorable")
+	methodBindAreaIsMonitoring            = gdnative.NewLazyMethodBind("Area", "is_monitoring")
+	methodBindAreaIsOverridingAudioBus    = gdnative.NewLazyMethodBind("Area", "is_overriding_audio_bus")
+	methodBindAreaIsUsingReverbBus        = gdnative.NewLazyMethodBind("Area", "is_using_reverb_bus")
+	methodBindAreaOverlapsArea            = gdnative.NewLazyMethodBind("Area", "overlaps_area")
+	methodBindAreaOverlapsBody            = gdnative.NewLazyMethodBind("Area", "overlaps_body")
+	methodBindAreaSetAngularDamp          = gdnative.NewLazyMethodBind("Area", "set_angular_damp")
+	methodBindAreaSetAudioBus             = gdnative.NewLazyMethodBind("Area", "set_audio_bus")
+	methodBindAreaSetAudioBusOverride     = gdnative.NewLazyMethodBind("Area", "set_audio_bus_override")
+	methodBindAreaSetCollisionLayer       = gdnative.NewLazyMethodBind("Area", "set_collision_layer")
+	methodBindAreaSetCollisionLayerBit    = gdnative.NewLazyMethodBind("Area", "set_collision_layer_bit")
+	methodBindAreaSetCollisionMask        = gdnative.NewLazyMethodBind("Area", "set_collision_mask")
+	methodBindAreaSetCollisionMaskBit     = gdnative.NewLazyMethodBind("Area", "set_collision_mask_bit")
+	methodBindAreaSetGravity              = gdnative.NewLazyMethodBind("Area", "set_gravity")
+	methodBindAreaSetGravityDistanceScale = gdnative.NewLazyMethodBind("Area", "set_gravity_distance_scale")
+	methodBindAreaSetGravityIsPoint       = gdnative.NewLazyMethodBind("Area", "set_gravity_is_point")
+	methodBindAreaSetGravityVector        = gdnative.NewLazyMethodBind("Area", "set_gravity_vector")
+	methodBindAreaSetLinearDamp           = gdnative.NewLazyMethodBind("Area", "set_linear_damp")
+	methodBindAreaSetMonitorable          = gdnative.NewLazyMethodBind("Area", "set_monitorable")
+	methodBindAreaSetMonitoring           = gdnative.NewLazyMethodBind("Area", "set_monitoring")
+	methodBindAreaSetPriority             = gdnative.NewLazyMethodBind("Area", "set_priority")
+	methodBindAreaSetReverbAmount         = gdnative.NewLazyMethodBind("Area", "set_reverb_amount")
+	methodBindAreaSetReverbBus            = gdnative.NewLazyMethodBind("Area", "set_reverb_bus")
+	methodBindAreaSetReverbUniformity     = gdnative.NewLazyMethodBind("Area", "set_reverb_uniformity")
+	methodBindAreaSetSpaceOverrideMode    = gdnative.NewLazyMethodBind("Area", "set_space_override_mode")
+	methodBindAreaSetUseReverbBus         = gdnative.NewLazyMethodBind("Area", "set_use_reverb_bus")
+)
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area) X_AreaEnterTree(id gdnative.Int) {
+	//log.Println("Calling Area.X_AreaEnterTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindAreaX_AreaEnterTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area) X_AreaExitTree(id gdnative.Int) {
+	//log.Println("Calling Area.X_AreaExitTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindAreaX_AreaExitTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
+*/
+func (o *Area) X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
+	//log.Println("Calling Area.X_AreaInout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
+	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
+	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
+	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
+
+	// Get the method bind
+	methodBind := methodBindAreaX_AreaInout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area) X_BodyEnterTree(id gdnative.Int) {
+	//log.Println("Calling Area.X_BodyEnterTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindAreaX_BodyEnterTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false id int}], Returns: void
+*/
+func (o *Area) X_BodyExitTree(id gdnative.Int) {
+	//log.Println("Calling Area.X_BodyExitTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(id)
+
+	// Get the method bind
+	methodBind := methodBindAreaX_BodyExitTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int} { false arg1 RID} { false arg2 int} { false arg3 int} { false arg4 int}], Returns: void
+*/
+func (o *Area) X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int) {
+	//log.Println("Calling Area.X_BodyInout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+	ptrArguments[1] = gdnative.NewPointerFromRid(arg1)
+	ptrArguments[2] = gdnative.NewPointerFromInt(arg2)
+	ptrArguments[3] = gdnative.NewPointerFromInt(arg3)
+	ptrArguments[4] = gdnative.NewPointerFromInt(arg4)
+
+	// Get the method bind
+	methodBind := methodBindAreaX_BodyInout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area) GetAngularDamp() gdnative.Real {
+	//log.Println("Calling Area.GetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetAngularDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Area) GetAudioBus() gdnative.String {
+	//log.Println("Calling Area.GetAudioBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetAudioBus.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Area) GetCollisionLayer() gdnative.Int {
+	//log.Println("Calling Area.GetCollisionLayer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetCollisionLayer.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns an individual bit on the layer mask.
+		Args: [{ false bit int}], Returns: bool
+*/
+func (o *Area) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
+	//log.Println("Calling Area.GetCollisionLayerBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetCollisionLayerBit.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Area) GetCollisionMask() gdnative.Int {
+	//log.Println("Calling Area.GetCollisionMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetCollisionMask.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns an individual bit on the collision mask.
+		Args: [{ false bit int}], Returns: bool
+*/
+func (o *Area) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
+	//log.Println("Calling Area.GetCollisionMaskBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetCollisionMaskBit.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area) GetGravity() gdnative.Real {
+	//log.Println("Calling Area.GetGravity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetGravity.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area) GetGravityDistanceScale() gdnative.Real {
+	//log.Println("Calling Area.GetGravityDistanceScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetGravityDistanceScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *Area) GetGravityVector() gdnative.Vector3 {
+	//log.Println("Calling Area.GetGravityVector()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetGravityVector.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area) GetLinearDamp() gdnative.Real {
+	//log.Println("Calling Area.GetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetLinearDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a list of intersecting [code]Area[/code]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
+		Args: [], Returns: Array
+*/
+func (o *Area) GetOverlappingAreas() gdnative.Array {
+	//log.Println("Calling Area.GetOverlappingAreas()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetOverlappingAreas.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a list of intersecting [PhysicsBody]s. For performance reasons (collisions are all processed at the same time) this list is modified once during the physics step, not immediately after objects are moved. Consider using signals instead.
+		Args: [], Returns: Array
+*/
+func (o *Area) GetOverlappingBodies() gdnative.Array {
+	//log.Println("Calling Area.GetOverlappingBodies()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetOverlappingBodies.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area) GetPriority() gdnative.Real {
+	//log.Println("Calling Area.GetPriority()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetPriority.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area) GetReverbAmount() gdnative.Real {
+	//log.Println("Calling Area.GetReverbAmount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetReverbAmount.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *Area) GetReverbBus() gdnative.String {
+	//log.Println("Calling Area.GetReverbBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetReverbBus.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Area) GetReverbUniformity() gdnative.Real {
+	//log.Println("Calling Area.GetReverbUniformity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetReverbUniformity.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Area::SpaceOverride
+*/
+func (o *Area) GetSpaceOverrideMode() AreaSpaceOverride {
+	//log.Println("Calling Area.GetSpaceOverrideMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaGetSpaceOverrideMode.Get()
+
+	// Call the parent method.
+	// enum.Area::SpaceOverride
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return AreaSpaceOverride(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area) IsGravityAPoint() gdnative.Bool {
+	//log.Println("Calling Area.IsGravityAPoint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaIsGravityAPoint.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area) IsMonitorable() gdnative.Bool {
+	//log.Println("Calling Area.IsMonitorable()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaIsMonitorable.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area) IsMonitoring() gdnative.Bool {
+	//log.Println("Calling Area.IsMonitoring()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaIsMonitoring.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area) IsOverridingAudioBus() gdnative.Bool {
+	//log.Println("Calling Area.IsOverridingAudioBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaIsOverridingAudioBus.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Area) IsUsingReverbBus() gdnative.Bool {
+	//log.Println("Calling Area.IsUsingReverbBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindAreaIsUsingReverbBus.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If [code]true[/code] the given area overlaps the Area. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
+		Args: [{ false area Object}], Returns: bool
+*/
+func (o *Area) OverlapsArea(area godot.ObjectImplementer) gdnative.Bool {
+	//log.Println("Calling Area.OverlapsArea()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(area))
+
+	// Get the method bind
+	methodBind := methodBindAreaOverlapsArea.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If [code]true[/code] the given body overlaps the Area. Note that the result of this test is not immediate after moving objects. For performance, list of overlaps is updated once per frame and before the physics step. Consider using signals instead.
+		Args: [{ false body Object}], Returns: bool
+*/
+func (o *Area) OverlapsBody(body godot.ObjectImplementer) gdnative.Bool {
+	//log.Println("Calling Area.OverlapsBody()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(body))
+
+	// Get the method bind
+	methodBind := methodBindAreaOverlapsBody.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false angular_damp float}], Returns: void
+*/
+func (o *Area) SetAngularDamp(angularDamp gdnative.Real) {
+	//log.Println("Calling Area.SetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angularDamp)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetAngularDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: void
+*/
+func (o *Area) SetAudioBus(name gdnative.String) {
+	//log.Println("Calling Area.SetAudioBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetAudioBus.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area) SetAudioBusOverride(enable gdnative.Bool) {
+	//log.Println("Calling Area.SetAudioBusOverride()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetAudioBusOverride.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false collision_layer int}], Returns: void
+*/
+func (o *Area) SetCollisionLayer(collisionLayer gdnative.Int) {
+	//log.Println("Calling Area.SetCollisionLayer()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(collisionLayer)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetCollisionLayer.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set/clear individual bits on the layer mask. This simplifies editing this [code]Area[code]'s layers.
+		Args: [{ false bit int} { false value bool}], Returns: void
+*/
+func (o *Area) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
+	//log.Println("Calling Area.SetCollisionLayerBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+	ptrArguments[1] = gdnative.NewPointerFromBool(value)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetCollisionLayerBit.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false collision_mask int}], Returns: void
+*/
+func (o *Area) SetCollisionMask(collisionMask gdnative.Int) {
+	//log.Println("Calling Area.SetCollisionMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(collisionMask)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetCollisionMask.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set/clear individual bits on the collision mask. This simplifies editing which [code]Area[/code] layers this [code]Area[/code] scans.
+		Args: [{ false bit int} { false value bool}], Returns: void
+*/
+func (o *Area) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
+	//log.Println("Calling Area.SetCollisionMaskBit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
+	ptrArguments[1] = gdnative.NewPointerFromBool(value)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetCollisionMaskBit.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false gravity float}], Returns: void
+*/
+func (o *Area) SetGravity(gravity gdnative.Real) {
+	//log.Println("Calling Area.SetGravity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(gravity)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetGravity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false distance_scale float}], Returns: void
+*/
+func (o *Area) SetGravityDistanceScale(distanceScale gdnative.Real) {
+	//log.Println("Calling Area.SetGravityDistanceScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(distanceScale)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetGravityDistanceScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area) SetGravityIsPoint(enable gdnative.Bool) {
+	//log.Println("Calling Area.SetGravityIsPoint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetGravityIsPoint.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false vector Vector3}], Returns: void
+*/
+func (o *Area) SetGravityVector(vector gdnative.Vector3) {
+	//log.Println("Calling Area.SetGravityVector()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(vector)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetGravityVector.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false linear_damp float}], Returns: void
+*/
+func (o *Area) SetLinearDamp(linearDamp gdnative.Real) {
+	//log.Println("Calling Area.SetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(linearDamp)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetLinearDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area) SetMonitorable(enable gdnative.Bool) {
+	//log.Println("Calling Area.SetMonitorable()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetMonitorable.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area) SetMonitoring(enable gdnative.Bool) {
+	//log.Println("Calling Area.SetMonitoring()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetMonitoring.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false priority float}], Returns: void
+*/
+func (o *Area) SetPriority(priority gdnative.Real) {
+	//log.Println("Calling Area.SetPriority()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(priority)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetPriority.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
+*/
+func (o *Area) SetReverbAmount(amount gdnative.Real) {
+	//log.Println("Calling Area.SetReverbAmount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetReverbAmount.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: void
+*/
+func (o *Area) SetReverbBus(name gdnative.String) {
+	//log.Println("Calling Area.SetReverbBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetReverbBus.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
+*/
+func (o *Area) SetReverbUniformity(amount gdnative.Real) {
+	//log.Println("Calling Area.SetReverbUniformity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetReverbUniformity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable enum.Area::SpaceOverride}], Returns: void
+*/
+func (o *Area) SetSpaceOverrideMode(enable AreaSpaceOverride) {
+	//log.Println("Calling Area.SetSpaceOverrideMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(enable))
+
+	// Get the method bind
+	methodBind := methodBindAreaSetSpaceOverrideMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Area) SetUseReverbBus(enable gdnative.Bool) {
+	//log.Println("Calling Area.SetUseReverbBus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindAreaSetUseReverbBus.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AngularDamp will return the value of the "angular_damp" property.
+func (o *Area) AngularDamp() gdnative.Real {
+	return o.GetAngularDamp()
+}
+
+// AudioBusName will return the value of the "audio_bus_name" property.
+func (o *Area) AudioBusName() gdnative.String {
+	return o.GetAudioBus()
+}
+
+// SetAudioBusName will set the value of the "audio_bus_name" property.
+func (o *Area) SetAudioBusName(value gdnative.String) {
+	o.SetAudioBus(value)
+}
+
+// AudioBusOverride will return the value of the "audio_bus_override" property.
+func (o *Area) AudioBusOverride() gdnative.Bool {
+	return o.IsOverridingAudioBus()
+}
+
+// CollisionLayer will return the value of the "collision_layer" property.
+func (o *Area) CollisionLayer() gdnative.Int {
+	return o.GetCollisionLayer()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *Area) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// Gravity will return the value of the "gravity" property.
+func (o *Area) Gravity() gdnative.Real {
+	return o.GetGravity()
+}
+
+// GravityDistanceScale will return the value of the "gravity_distance_scale" property.
+func (o *Area) GravityDistanceScale() gdnative.Real {
+	return o.GetGravityDistanceScale()
+}
+
+// GravityPoint will return the value of the "gravity_point" property.
+func (o *Area) GravityPoint() gdnative.Bool {
+	return o.IsGravityAPoint()
+}
+
+// SetGravityPoint will set the value of the "gravity_point" property.
+func (o *Area) SetGravityPoint(value gdnative.Bool) {
+	o.SetGravityIsPoint(value)
+}
+
+// GravityVec will return the value of the "gravity_vec" property.
+func (o *Area) GravityVec() gdnative.Vector3 {
+	return o.GetGravityVector()
+}
+
+// SetGravityVec will set the value of the "gravity_vec" property.
+func (o *Area) SetGravityVec(value gdnative.Vector3) {
+	o.SetGravityVector(value)
+}
+
+// LinearDamp will return the value of the "linear_damp" property.
+func (o *Area) LinearDamp() gdnative.Real {
+	return o.GetLinearDamp()
+}
+
+// Monitorable will return the value of the "monitorable" property.
+func (o *Area) Monitorable() gdnative.Bool {
+	return o.IsMonitorable()
+}
+
+// Monitoring will return the value of the "monitoring" property.
+func (o *Area) Monitoring() gdnative.Bool {
+	return o.IsMonitoring()
+}
+
+// Priority will return the value of the "priority" property.
+func (o *Area) Priority() gdnative.Real {
+	return o.GetPriority()
+}
+
+// ReverbBusAmount will return the value of the "reverb_bus_amount" property.
+func (o *Area) ReverbBusAmount() gdnative.Real {
+	return o.GetReverbAmount()
+}
+
+// SetReverbBusAmount will set the value of the "reverb_bus_amount" property.
+func (o *Area) SetReverbBusAmount(value gdnative.Real) {
+	o.SetReverbAmount(value)
+}
+
+// ReverbBusEnable will return the value of the "reverb_bus_enable" property.
+func (o *Area) ReverbBusEnable() gdnative.Bool {
+	return o.IsUsingReverbBus()
+}
+
+// SetReverbBusEnable will set the value of the "reverb_bus_enable" property.
+func (o *Area) SetReverbBusEnable(value gdnative.Bool) {
+	o.SetUseReverbBus(value)
+}
+
+// ReverbBusName will return the value of the "reverb_bus_name" property.
+func (o *Area) ReverbBusName() gdnative.String {
+	return o.GetReverbBus()
+}
+
+// SetReverbBusName will set the value of the "reverb_bus_name" property.
+func (o *Area) SetReverbBusName(value gdnative.String) {
+	o.SetReverbBus(value)
+}
+
+// ReverbBusUniformity will return the value of the "reverb_bus_uniformity" property.
+func (o *Area) ReverbBusUniformity() gdnative.Real {
+	return o.GetReverbUniformity()
+}
+
+// SetReverbBusUniformity will set the value of the "reverb_bus_uniformity" property.
+func (o *Area) SetReverbBusUniformity(value gdnative.Real) {
+	o.SetReverbUniformity(value)
+}
+
+// SpaceOverride will return the value of the "space_override" property.
+func (o *Area) SpaceOverride() AreaSpaceOverride {
+	return o.GetSpaceOverrideMode()
+}
+
+// SetSpaceOverride will set the value of the "space_override" property.
+func (o *Area) SetSpaceOverride(value AreaSpaceOverride) {
+	o.SetSpaceOverrideMode(value)
+}
+
+// Signals of the Area class.
+const (
+	AreaSignalAreaEntered      gdnative.String = "area_entered"
+	AreaSignalAreaExited       gdnative.String = "area_exited"
+	AreaSignalAreaShapeEntered gdnative.String = "area_shape_entered"
+	AreaSignalAreaShapeExited  gdnative.String = "area_shape_exited"
+	AreaSignalBodyEntered      gdnative.String = "body_entered"
+	AreaSignalBodyExited       gdnative.String = "body_exited"
+	AreaSignalBodyShapeEntered gdnative.String = "body_shape_entered"
+	AreaSignalBodyShapeExited  gdnative.String = "body_shape_exited"
+)
+
+// AreaAreaEnteredArgs holds the arguments of the "area_entered" signal.
+type AreaAreaEnteredArgs struct {
+	Area AreaImplementer
+}
+
+// ConnectAreaEntered will connect the "area_entered" signal to the given method of the target object.
+func (o *Area) ConnectAreaEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalAreaEntered, target, method, binds, 0)
+}
+
+// EmitAreaEntered will emit the "area_entered" signal.
+func (o *Area) EmitAreaEntered(args AreaAreaEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalAreaEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// AreaAreaExitedArgs holds the arguments of the "area_exited" signal.
+type AreaAreaExitedArgs struct {
+	Area AreaImplementer
+}
+
+// ConnectAreaExited will connect the "area_exited" signal to the given method of the target object.
+func (o *Area) ConnectAreaExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalAreaExited, target, method, binds, 0)
+}
+
+// EmitAreaExited will emit the "area_exited" signal.
+func (o *Area) EmitAreaExited(args AreaAreaExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalAreaExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// AreaAreaShapeEnteredArgs holds the arguments of the "area_shape_entered" signal.
+type AreaAreaShapeEnteredArgs struct {
+	AreaId    gdnative.Int
+	Area      AreaImplementer
+	AreaShape gdnative.Int
+	SelfShape gdnative.Int
+}
+
+// ConnectAreaShapeEntered will connect the "area_shape_entered" signal to the given method of the target object.
+func (o *Area) ConnectAreaShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalAreaShapeEntered, target, method, binds, 0)
+}
+
+// EmitAreaShapeEntered will emit the "area_shape_entered" signal.
+func (o *Area) EmitAreaShapeEntered(args AreaAreaShapeEnteredArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.SelfShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalAreaShapeEntered, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// AreaAreaShapeExitedArgs holds the arguments of the "area_shape_exited" signal.
+type AreaAreaShapeExitedArgs struct {
+	AreaId    gdnative.Int
+	Area      AreaImplementer
+	AreaShape gdnative.Int
+	SelfShape gdnative.Int
+}
+
+// ConnectAreaShapeExited will connect the "area_shape_exited" signal to the given method of the target object.
+func (o *Area) ConnectAreaShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalAreaShapeExited, target, method, binds, 0)
+}
+
+// EmitAreaShapeExited will emit the "area_shape_exited" signal.
+func (o *Area) EmitAreaShapeExited(args AreaAreaShapeExitedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Area))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.SelfShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalAreaShapeExited, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// AreaBodyEnteredArgs holds the arguments of the "body_entered" signal.
+type AreaBodyEnteredArgs struct {
+	Body godot.ObjectImplementer
+}
+
+// ConnectBodyEntered will connect the "body_entered" signal to the given method of the target object.
+func (o *Area) ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalBodyEntered, target, method, binds, 0)
+}
+
+// EmitBodyEntered will emit the "body_entered" signal.
+func (o *Area) EmitBodyEntered(args AreaBodyEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalBodyEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// AreaBodyExitedArgs holds the arguments of the "body_exited" signal.
+type AreaBodyExitedArgs struct {
+	Body godot.ObjectImplementer
+}
+
+// ConnectBodyExited will connect the "body_exited" signal to the given method of the target object.
+func (o *Area) ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalBodyExited, target, method, binds, 0)
+}
+
+// EmitBodyExited will emit the "body_exited" signal.
+func (o *Area) EmitBodyExited(args AreaBodyExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalBodyExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// AreaBodyShapeEnteredArgs holds the arguments of the "body_shape_entered" signal.
+type AreaBodyShapeEnteredArgs struct {
+	BodyId    gdnative.Int
+	Body      godot.ObjectImplementer
+	BodyShape gdnative.Int
+	AreaShape gdnative.Int
+}
+
+// ConnectBodyShapeEntered will connect the "body_shape_entered" signal to the given method of the target object.
+func (o *Area) ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalBodyShapeEntered, target, method, binds, 0)
+}
+
+// EmitBodyShapeEntered will emit the "body_shape_entered" signal.
+func (o *Area) EmitBodyShapeEntered(args AreaBodyShapeEnteredArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalBodyShapeEntered, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// AreaBodyShapeExitedArgs holds the arguments of the "body_shape_exited" signal.
+type AreaBodyShapeExitedArgs struct {
+	BodyId    gdnative.Int
+	Body      godot.ObjectImplementer
+	BodyShape gdnative.Int
+	AreaShape gdnative.Int
+}
+
+// ConnectBodyShapeExited will connect the "body_shape_exited" signal to the given method of the target object.
+func (o *Area) ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(AreaSignalBodyShapeExited, target, method, binds, 0)
+}
+
+// EmitBodyShapeExited will emit the "body_shape_exited" signal.
+func (o *Area) EmitBodyShapeExited(args AreaBodyShapeExitedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.AreaShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(AreaSignalBodyShapeExited, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// AreaImplementer is an interface that implements the methods
+// of the Area class.
+type AreaImplementer interface {
+	godot.CollisionObjectImplementer
+	X_AreaEnterTree(id gdnative.Int)
+	X_AreaExitTree(id gdnative.Int)
+	X_AreaInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
+	X_BodyEnterTree(id gdnative.Int)
+	X_BodyExitTree(id gdnative.Int)
+	X_BodyInout(arg0 gdnative.Int, arg1 gdnative.Rid, arg2 gdnative.Int, arg3 gdnative.Int, arg4 gdnative.Int)
+	GetAngularDamp() gdnative.Real
+	GetAudioBus() gdnative.String
+	GetCollisionLayer() gdnative.Int
+	GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool
+	GetCollisionMask() gdnative.Int
+	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
+	GetGravity() gdnative.Real
+	GetGravityDistanceScale() gdnative.Real
+	GetGravityVector() gdnative.Vector3
+	GetLinearDamp() gdnative.Real
+	GetOverlappingAreas() gdnative.Array
+	GetOverlappingBodies() gdnative.Array
+	GetPriority() gdnative.Real
+	GetReverbAmount() gdnative.Real
+	GetReverbBus() gdnative.String
+	GetReverbUniformity() gdnative.Real
+	GetSpaceOverrideMode() AreaSpaceOverride
+	IsGravityAPoint() gdnative.Bool
+	IsMonitorable() gdnative.Bool
+	IsMonitoring() gdnative.Bool
+	IsOverridingAudioBus() gdnative.Bool
+	IsUsingReverbBus() gdnative.Bool
+	OverlapsArea(area godot.ObjectImplementer) gdnative.Bool
+	OverlapsBody(body godot.ObjectImplementer) gdnative.Bool
+	SetAngularDamp(angularDamp gdnative.Real)
+	SetAudioBus(name gdnative.String)
+	SetAudioBusOverride(enable gdnative.Bool)
+	SetCollisionLayer(collisionLayer gdnative.Int)
+	SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool)
+	SetCollisionMask(collisionMask gdnative.Int)
+	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
+	SetGravity(gravity gdnative.Real)
+	SetGravityDistanceScale(distanceScale gdnative.Real)
+	SetGravityIsPoint(enable gdnative.Bool)
+	SetGravityVector(vector gdnative.Vector3)
+	SetLinearDamp(linearDamp gdnative.Real)
+	SetMonitorable(enable gdnative.Bool)
+	SetMonitoring(enable gdnative.Bool)
+	SetPriority(priority gdnative.Real)
+	SetReverbAmount(amount gdnative.Real)
+	SetReverbBus(name gdnative.String)
+	SetReverbUniformity(amount gdnative.Real)
+	SetSpaceOverrideMode(enable AreaSpaceOverride)
+	SetUseReverbBus(enable gdnative.Bool)
+	AngularDamp() gdnative.Real
+	AudioBusName() gdnative.String
+	SetAudioBusName(value gdnative.String)
+	AudioBusOverride() gdnative.Bool
+	CollisionLayer() gdnative.Int
+	CollisionMask() gdnative.Int
+	Gravity() gdnative.Real
+	GravityDistanceScale() gdnative.Real
+	GravityPoint() gdnative.Bool
+	SetGravityPoint(value gdnative.Bool)
+	GravityVec() gdnative.Vector3
+	SetGravityVec(value gdnative.Vector3)
+	LinearDamp() gdnative.Real
+	Monitorable() gdnative.Bool
+	Monitoring() gdnative.Bool
+	Priority() gdnative.Real
+	ReverbBusAmount() gdnative.Real
+	SetReverbBusAmount(value gdnative.Real)
+	ReverbBusEnable() gdnative.Bool
+	SetReverbBusEnable(value gdnative.Bool)
+	ReverbBusName() gdnative.String
+	SetReverbBusName(value gdnative.String)
+	ReverbBusUniformity() gdnative.Real
+	SetReverbBusUniformity(value gdnative.Real)
+	SpaceOverride() AreaSpaceOverride
+	SetSpaceOverride(value AreaSpaceOverride)
+	ConnectAreaEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaEntered(args AreaAreaEnteredArgs) error
+	ConnectAreaExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaExited(args AreaAreaExitedArgs) error
+	ConnectAreaShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaShapeEntered(args AreaAreaShapeEnteredArgs) error
+	ConnectAreaShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitAreaShapeExited(args AreaAreaShapeExitedArgs) error
+	ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyEntered(args AreaBodyEnteredArgs) error
+	ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyExited(args AreaBodyExitedArgs) error
+	ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeEntered(args AreaBodyShapeEnteredArgs) error
+	ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeExited(args AreaBodyShapeExitedArgs) error
+}
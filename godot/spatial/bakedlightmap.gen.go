@@ -0,0 +1,792 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// BakedLightmapBakeError is an enum for BakeError values.
+type BakedLightmapBakeError int
+
+const (
+	BakedLightmapBakeErrorCantCreateImage BakedLightmapBakeError = 3
+	BakedLightmapBakeErrorNoMeshes        BakedLightmapBakeError = 2
+	BakedLightmapBakeErrorNoSavePath      BakedLightmapBakeError = 1
+	BakedLightmapBakeErrorOk              BakedLightmapBakeError = 0
+	BakedLightmapBakeErrorUserAborted     BakedLightmapBakeError = 4
+)
+
+// String will return the Godot name of the BakedLightmapBakeError value.
+func (e BakedLightmapBakeError) String() string {
+	switch e {
+	case BakedLightmapBakeErrorCantCreateImage:
+		return "BAKE_ERROR_CANT_CREATE_IMAGE"
+	case BakedLightmapBakeErrorNoMeshes:
+		return "BAKE_ERROR_NO_MESHES"
+	case BakedLightmapBakeErrorNoSavePath:
+		return "BAKE_ERROR_NO_SAVE_PATH"
+	case BakedLightmapBakeErrorOk:
+		return "BAKE_ERROR_OK"
+	case BakedLightmapBakeErrorUserAborted:
+		return "BAKE_ERROR_USER_ABORTED"
+	}
+	return fmt.Sprintf("BakedLightmapBakeError(%d)", int(e))
+}
+
+// ParseBakedLightmapBakeError will return the BakedLightmapBakeError value with the given Godot name.
+func ParseBakedLightmapBakeError(name string) (BakedLightmapBakeError, error) {
+	switch name {
+	case "BAKE_ERROR_CANT_CREATE_IMAGE":
+		return BakedLightmapBakeErrorCantCreateImage, nil
+	case "BAKE_ERROR_NO_MESHES":
+		return BakedLightmapBakeErrorNoMeshes, nil
+	case "BAKE_ERROR_NO_SAVE_PATH":
+		return BakedLightmapBakeErrorNoSavePath, nil
+	case "BAKE_ERROR_OK":
+		return BakedLightmapBakeErrorOk, nil
+	case "BAKE_ERROR_USER_ABORTED":
+		return BakedLightmapBakeErrorUserAborted, nil
+	}
+	return 0, fmt.Errorf("invalid BakedLightmapBakeError value %q", name)
+}
+
+// BakedLightmapBakeMode is an enum for BakeMode values.
+type BakedLightmapBakeMode int
+
+const (
+	BakedLightmapBakeModeConeTrace BakedLightmapBakeMode = 0
+	BakedLightmapBakeModeRayTrace  BakedLightmapBakeMode = 1
+)
+
+// String will return the Godot name of the BakedLightmapBakeMode value.
+func (e BakedLightmapBakeMode) String() string {
+	switch e {
+	case BakedLightmapBakeModeConeTrace:
+		return "BAKE_MODE_CONE_TRACE"
+	case BakedLightmapBakeModeRayTrace:
+		return "BAKE_MODE_RAY_TRACE"
+	}
+	return fmt.Sprintf("BakedLightmapBakeMode(%d)", int(e))
+}
+
+// ParseBakedLightmapBakeMode will return the BakedLightmapBakeMode value with the given Godot name.
+func ParseBakedLightmapBakeMode(name string) (BakedLightmapBakeMode, error) {
+	switch name {
+	case "BAKE_MODE_CONE_TRACE":
+		return BakedLightmapBakeModeConeTrace, nil
+	case "BAKE_MODE_RAY_TRACE":
+		return BakedLightmapBakeModeRayTrace, nil
+	}
+	return 0, fmt.Errorf("invalid BakedLightmapBakeMode value %q", name)
+}
+
+// BakedLightmapBakeQuality is an enum for BakeQuality values.
+type BakedLightmapBakeQuality int
+
+const (
+	BakedLightmapBakeQualityHigh   BakedLightmapBakeQuality = 2
+	BakedLightmapBakeQualityLow    BakedLightmapBakeQuality = 0
+	BakedLightmapBakeQualityMedium BakedLightmapBakeQuality = 1
+)
+
+// String will return the Godot name of the BakedLightmapBakeQuality value.
+func (e BakedLightmapBakeQuality) String() string {
+	switch e {
+	case BakedLightmapBakeQualityHigh:
+		return "BAKE_QUALITY_HIGH"
+	case BakedLightmapBakeQualityLow:
+		return "BAKE_QUALITY_LOW"
+	case BakedLightmapBakeQualityMedium:
+		return "BAKE_QUALITY_MEDIUM"
+	}
+	return fmt.Sprintf("BakedLightmapBakeQuality(%d)", int(e))
+}
+
+// ParseBakedLightmapBakeQuality will return the BakedLightmapBakeQuality value with the given Godot name.
+func ParseBakedLightmapBakeQuality(name string) (BakedLightmapBakeQuality, error) {
+	switch name {
+	case "BAKE_QUALITY_HIGH":
+		return BakedLightmapBakeQualityHigh, nil
+	case "BAKE_QUALITY_LOW":
+		return BakedLightmapBakeQualityLow, nil
+	case "BAKE_QUALITY_MEDIUM":
+		return BakedLightmapBakeQualityMedium, nil
+	}
+	return 0, fmt.Errorf("invalid BakedLightmapBakeQuality value %q", name)
+}
+
+/*
+ */
+type BakedLightmap struct {
+	VisualInstance
+	owner gdnative.Object
+}
+
+// classConstructorBakedLightmap is the class constructor of the BakedLightmap class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorBakedLightmap = gdnative.NewLazyClassConstructor("BakedLightmap")
+
+// NewBakedLightmap will create a new instance of the BakedLightmap class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewBakedLightmap() *BakedLightmap {
+	obj := &BakedLightmap{}
+	obj.SetBaseObject(classConstructorBakedLightmap.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *BakedLightmap) BaseClass() string {
+	return "BakedLightmap"
+}
+
+// Method binds of the BakedLightmap class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBakedLightmapBake               = gdnative.NewLazyMethodBind("BakedLightmap", "bake")
+	methodBindBakedLightmapDebugBake          = gdnative.NewLazyMethodBind("BakedLightmap", "debug_bake")
+	methodBindBakedLightmapGetBakeCellSize    = gdnative.NewLazyMethodBind("BakedLightmap", "get_bake_cell_size")
+	methodBindBakedLightmapGetBakeMode        = gdnative.NewLazyMethodBind("BakedLightmap", "get_bake_mode")
+	methodBindBakedLightmapGetBakeQuality     = gdnative.NewLazyMethodBind("BakedLightmap", "get_bake_quality")
+	methodBindBakedLightmapGetCaptureCellSize = gdnative.NewLazyMethodBind("BakedLightmap", "get_capture_cell_size")
+	methodBindBakedLightmapGetEnergy          = gdnative.NewLazyMethodBind("BakedLightmap", "get_energy")
+	methodBindBakedLightmapGetExtents         = gdnative.NewLazyMethodBind("BakedLightmap", "get_extents")
+	methodBindBakedLightmapGetImagePath       = gdnative.NewLazyMethodBind("BakedLightmap", "get_image_path")
+	methodBindBakedLightmapGetLightData       = gdnative.NewLazyMethodBind("BakedLightmap", "get_light_data")
+	methodBindBakedLightmapGetPropagation     = gdnative.NewLazyMethodBind("BakedLightmap", "get_propagation")
+	methodBindBakedLightmapIsHdr              = gdnative.NewLazyMethodBind("BakedLightmap", "is_hdr")
+	methodBindBakedLightmapSetBakeCellSize    = gdnative.NewLazyMethodBind("BakedLightmap", "set_bake_cell_size")
+	methodBindBakedLightmapSetBakeMode        = gdnative.NewLazyMethodBind("BakedLightmap", "set_bake_mode")
+	methodBindBakedLightmapSetBakeQuality     = gdnative.NewLazyMethodBind("BakedLightmap", "set_bake_quality")
+	methodBindBakedLightmapSetCaptureCellSize = gdnative.NewLazyMethodBind("BakedLightmap", "set_capture_cell_size")
+	methodBindBakedLightmapSetEnergy          = gdnative.NewLazyMethodBind("BakedLightmap", "set_energy")
+	methodBindBakedLightmapSetExtents         = gdnative.NewLazyMethodBind("BakedLightmap", "set_extents")
+	methodBindBakedLightmapSetHdr             = gdnative.NewLazyMethodBind("BakedLightmap", "set_hdr")
+	methodBindBakedLightmapSetImagePath       = gdnative.NewLazyMethodBind("BakedLightmap", "set_image_path")
+	methodBindBakedLightmapSetLightData       = gdnative.NewLazyMethodBind("BakedLightmap", "set_light_data")
+	methodBindBakedLightmapSetPropagation     = gdnative.NewLazyMethodBind("BakedLightmap", "set_propagation")
+)
+
+/*
+Args: [{Null true from_node Object} {False true create_visual_debug bool}], Returns: enum.BakedLightmap::BakeError
+*/
+func (o *BakedLightmap) Bake(fromNode godot.ObjectImplementer, createVisualDebug gdnative.Bool) BakedLightmapBakeError {
+	//log.Println("Calling BakedLightmap.Bake()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(fromNode))
+	ptrArguments[1] = gdnative.NewPointerFromBool(createVisualDebug)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapBake.Get()
+
+	// Call the parent method.
+	// enum.BakedLightmap::BakeError
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return BakedLightmapBakeError(ret)
+}
+
+/*
+Args: [], Returns: void
+*/
+func (o *BakedLightmap) DebugBake() {
+	//log.Println("Calling BakedLightmap.DebugBake()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapDebugBake.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *BakedLightmap) GetBakeCellSize() gdnative.Real {
+	//log.Println("Calling BakedLightmap.GetBakeCellSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetBakeCellSize.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.BakedLightmap::BakeMode
+*/
+func (o *BakedLightmap) GetBakeMode() BakedLightmapBakeMode {
+	//log.Println("Calling BakedLightmap.GetBakeMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetBakeMode.Get()
+
+	// Call the parent method.
+	// enum.BakedLightmap::BakeMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return BakedLightmapBakeMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.BakedLightmap::BakeQuality
+*/
+func (o *BakedLightmap) GetBakeQuality() BakedLightmapBakeQuality {
+	//log.Println("Calling BakedLightmap.GetBakeQuality()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetBakeQuality.Get()
+
+	// Call the parent method.
+	// enum.BakedLightmap::BakeQuality
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return BakedLightmapBakeQuality(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *BakedLightmap) GetCaptureCellSize() gdnative.Real {
+	//log.Println("Calling BakedLightmap.GetCaptureCellSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetCaptureCellSize.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *BakedLightmap) GetEnergy() gdnative.Real {
+	//log.Println("Calling BakedLightmap.GetEnergy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetEnergy.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *BakedLightmap) GetExtents() gdnative.Vector3 {
+	//log.Println("Calling BakedLightmap.GetExtents()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetExtents.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *BakedLightmap) GetImagePath() gdnative.String {
+	//log.Println("Calling BakedLightmap.GetImagePath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetImagePath.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: BakedLightmapData
+*/
+func (o *BakedLightmap) GetLightData() godot.BakedLightmapDataImplementer {
+	//log.Println("Calling BakedLightmap.GetLightData()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetLightData.Get()
+
+	// Call the parent method.
+	// BakedLightmapData
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.BakedLightmapData{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.BakedLightmapDataImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *BakedLightmap) GetPropagation() gdnative.Real {
+	//log.Println("Calling BakedLightmap.GetPropagation()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapGetPropagation.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *BakedLightmap) IsHdr() gdnative.Bool {
+	//log.Println("Calling BakedLightmap.IsHdr()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapIsHdr.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false bake_cell_size float}], Returns: void
+*/
+func (o *BakedLightmap) SetBakeCellSize(bakeCellSize gdnative.Real) {
+	//log.Println("Calling BakedLightmap.SetBakeCellSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(bakeCellSize)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetBakeCellSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false bake_mode enum.BakedLightmap::BakeMode}], Returns: void
+*/
+func (o *BakedLightmap) SetBakeMode(bakeMode BakedLightmapBakeMode) {
+	//log.Println("Calling BakedLightmap.SetBakeMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(bakeMode))
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetBakeMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false bake_quality enum.BakedLightmap::BakeQuality}], Returns: void
+*/
+func (o *BakedLightmap) SetBakeQuality(bakeQuality BakedLightmapBakeQuality) {
+	//log.Println("Calling BakedLightmap.SetBakeQuality()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(bakeQuality))
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetBakeQuality.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false capture_cell_size float}], Returns: void
+*/
+func (o *BakedLightmap) SetCaptureCellSize(captureCellSize gdnative.Real) {
+	//log.Println("Calling BakedLightmap.SetCaptureCellSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(captureCellSize)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetCaptureCellSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false energy float}], Returns: void
+*/
+func (o *BakedLightmap) SetEnergy(energy gdnative.Real) {
+	//log.Println("Calling BakedLightmap.SetEnergy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(energy)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetEnergy.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false extents Vector3}], Returns: void
+*/
+func (o *BakedLightmap) SetExtents(extents gdnative.Vector3) {
+	//log.Println("Calling BakedLightmap.SetExtents()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(extents)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetExtents.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false hdr bool}], Returns: void
+*/
+func (o *BakedLightmap) SetHdr(hdr gdnative.Bool) {
+	//log.Println("Calling BakedLightmap.SetHdr()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(hdr)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetHdr.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false image_path String}], Returns: void
+*/
+func (o *BakedLightmap) SetImagePath(imagePath gdnative.String) {
+	//log.Println("Calling BakedLightmap.SetImagePath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(imagePath)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetImagePath.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false data BakedLightmapData}], Returns: void
+*/
+func (o *BakedLightmap) SetLightData(data godot.BakedLightmapDataImplementer) {
+	//log.Println("Calling BakedLightmap.SetLightData()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(data))
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetLightData.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false propagation float}], Returns: void
+*/
+func (o *BakedLightmap) SetPropagation(propagation gdnative.Real) {
+	//log.Println("Calling BakedLightmap.SetPropagation()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(propagation)
+
+	// Get the method bind
+	methodBind := methodBindBakedLightmapSetPropagation.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// BakeWithDefaults will call Bake using the default values for: from_node, create_visual_debug.
+func (o *BakedLightmap) BakeWithDefaults() BakedLightmapBakeError {
+	return o.Bake(nil, false)
+}
+
+// BakeCellSize will return the value of the "bake_cell_size" property.
+func (o *BakedLightmap) BakeCellSize() gdnative.Real {
+	return o.GetBakeCellSize()
+}
+
+// BakeEnergy will return the value of the "bake_energy" property.
+func (o *BakedLightmap) BakeEnergy() gdnative.Real {
+	return o.GetEnergy()
+}
+
+// SetBakeEnergy will set the value of the "bake_energy" property.
+func (o *BakedLightmap) SetBakeEnergy(value gdnative.Real) {
+	o.SetEnergy(value)
+}
+
+// BakeExtents will return the value of the "bake_extents" property.
+func (o *BakedLightmap) BakeExtents() gdnative.Vector3 {
+	return o.GetExtents()
+}
+
+// SetBakeExtents will set the value of the "bake_extents" property.
+func (o *BakedLightmap) SetBakeExtents(value gdnative.Vector3) {
+	o.SetExtents(value)
+}
+
+// BakeHdr will return the value of the "bake_hdr" property.
+func (o *BakedLightmap) BakeHdr() gdnative.Bool {
+	return o.IsHdr()
+}
+
+// SetBakeHdr will set the value of the "bake_hdr" property.
+func (o *BakedLightmap) SetBakeHdr(value gdnative.Bool) {
+	o.SetHdr(value)
+}
+
+// BakeMode will return the value of the "bake_mode" property.
+func (o *BakedLightmap) BakeMode() BakedLightmapBakeMode {
+	return o.GetBakeMode()
+}
+
+// BakePropagation will return the value of the "bake_propagation" property.
+func (o *BakedLightmap) BakePropagation() gdnative.Real {
+	return o.GetPropagation()
+}
+
+// SetBakePropagation will set the value of the "bake_propagation" property.
+func (o *BakedLightmap) SetBakePropagation(value gdnative.Real) {
+	o.SetPropagation(value)
+}
+
+// BakeQuality will return the value of the "bake_quality" property.
+func (o *BakedLightmap) BakeQuality() BakedLightmapBakeQuality {
+	return o.GetBakeQuality()
+}
+
+// CaptureCellSize will return the value of the "capture_cell_size" property.
+func (o *BakedLightmap) CaptureCellSize() gdnative.Real {
+	return o.GetCaptureCellSize()
+}
+
+// ImagePath will return the value of the "image_path" property.
+func (o *BakedLightmap) ImagePath() gdnative.String {
+	return o.GetImagePath()
+}
+
+// LightData will return the value of the "light_data" property.
+func (o *BakedLightmap) LightData() godot.BakedLightmapDataImplementer {
+	return o.GetLightData()
+}
+
+// BakedLightmapImplementer is an interface that implements the methods
+// of the BakedLightmap class.
+type BakedLightmapImplementer interface {
+	VisualInstanceImplementer
+	Bake(fromNode godot.ObjectImplementer, createVisualDebug gdnative.Bool) BakedLightmapBakeError
+	DebugBake()
+	GetBakeCellSize() gdnative.Real
+	GetBakeMode() BakedLightmapBakeMode
+	GetBakeQuality() BakedLightmapBakeQuality
+	GetCaptureCellSize() gdnative.Real
+	GetEnergy() gdnative.Real
+	GetExtents() gdnative.Vector3
+	GetImagePath() gdnative.String
+	GetLightData() godot.BakedLightmapDataImplementer
+	GetPropagation() gdnative.Real
+	IsHdr() gdnative.Bool
+	SetBakeCellSize(bakeCellSize gdnative.Real)
+	SetBakeMode(bakeMode BakedLightmapBakeMode)
+	SetBakeQuality(bakeQuality BakedLightmapBakeQuality)
+	SetCaptureCellSize(captureCellSize gdnative.Real)
+	SetEnergy(energy gdnative.Real)
+	SetExtents(extents gdnative.Vector3)
+	SetHdr(hdr gdnative.Bool)
+	SetImagePath(imagePath gdnative.String)
+	SetLightData(data godot.BakedLightmapDataImplementer)
+	SetPropagation(propagation gdnative.Real)
+	BakeWithDefaults() BakedLightmapBakeError
+	BakeCellSize() gdnative.Real
+	BakeEnergy() gdnative.Real
+	SetBakeEnergy(value gdnative.Real)
+	BakeExtents() gdnative.Vector3
+	SetBakeExtents(value gdnative.Vector3)
+	BakeHdr() gdnative.Bool
+	SetBakeHdr(value gdnative.Bool)
+	BakeMode() BakedLightmapBakeMode
+	BakePropagation() gdnative.Real
+	SetBakePropagation(value gdnative.Real)
+	BakeQuality() BakedLightmapBakeQuality
+	CaptureCellSize() gdnative.Real
+	ImagePath() gdnative.String
+	LightData() godot.BakedLightmapDataImplementer
+}
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,42 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewBoneAttachmentFromPointer(ptr gdnative.Pointer) BoneAttachment {
-func newBoneAttachmentFromPointer(ptr gdnative.Pointer) BoneAttachment {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := BoneAttachment{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This node must be the child of a [Skeleton] node. You can then select a bone for this node to attach to. The BoneAttachment node will copy the transform of the selected bone.
 */
 type BoneAttachment struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorBoneAttachment is the class constructor of the BoneAttachment class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorBoneAttachment = gdnative.NewLazyClassConstructor("BoneAttachment")
+
+// NewBoneAttachment will create a new instance of the BoneAttachment class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewBoneAttachment() *BoneAttachment {
+	obj := &BoneAttachment{}
+	obj.SetBaseObject(classConstructorBoneAttachment.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *BoneAttachment) BaseClass() string {
 	return "BoneAttachment"
 }
 
+// Method binds of the BoneAttachment class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindBoneAttachmentGetBoneName = gdnative.NewLazyMethodBind("BoneAttachment", "get_bone_name")
+	methodBindBoneAttachmentSetBoneName = gdnative.NewLazyMethodBind("BoneAttachment", "set_bone_name")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *BoneAttachment) GetBoneName() gdnative.String {
 	//log.Println("Calling BoneAttachment.GetBoneName()")
@@ -45,7 +58,7 @@ func (o *BoneAttachment) GetBoneName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BoneAttachment", "get_bone_name")
+	methodBind := methodBindBoneAttachmentGetBoneName.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +71,8 @@ func (o *BoneAttachment) GetBoneName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false bone_name String}], Returns: void
+	        Undocumented
+		Args: [{ false bone_name String}], Returns: void
 */
 func (o *BoneAttachment) SetBoneName(boneName gdnative.String) {
 	//log.Println("Calling BoneAttachment.SetBoneName()")
@@ -69,7 +82,7 @@ func (o *BoneAttachment) SetBoneName(boneName gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(boneName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("BoneAttachment", "set_bone_name")
+	methodBind := methodBindBoneAttachmentSetBoneName.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +91,16 @@ func (o *BoneAttachment) SetBoneName(boneName gdnative.String) {
 
 }
 
+// BoneName will return the value of the "bone_name" property.
+func (o *BoneAttachment) BoneName() gdnative.String {
+	return o.GetBoneName()
+}
+
 // BoneAttachmentImplementer is an interface that implements the methods
 // of the BoneAttachment class.
 type BoneAttachmentImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetBoneName() gdnative.String
 	SetBoneName(boneName gdnative.String)
+	BoneName() gdnative.String
 }
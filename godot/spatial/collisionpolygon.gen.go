@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCollisionPolygonFromPointer(ptr gdnative.Pointer) CollisionPolygon {
-func newCollisionPolygonFromPointer(ptr gdnative.Pointer) CollisionPolygon {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CollisionPolygon{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Allows editing a collision polygon's vertices on a selected plane. Can also set a depth perpendicular to that plane. This class is only available in the editor. It will not appear in the scene tree at runtime. Creates a [Shape] for gameplay. Properties modified during gameplay will have no effect.
 */
 type CollisionPolygon struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorCollisionPolygon is the class constructor of the CollisionPolygon class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCollisionPolygon = gdnative.NewLazyClassConstructor("CollisionPolygon")
+
+// NewCollisionPolygon will create a new instance of the CollisionPolygon class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCollisionPolygon() *CollisionPolygon {
+	obj := &CollisionPolygon{}
+	obj.SetBaseObject(classConstructorCollisionPolygon.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CollisionPolygon) BaseClass() string {
 	return "CollisionPolygon"
 }
 
+// Method binds of the CollisionPolygon class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCollisionPolygonGetDepth    = gdnative.NewLazyMethodBind("CollisionPolygon", "get_depth")
+	methodBindCollisionPolygonGetPolygon  = gdnative.NewLazyMethodBind("CollisionPolygon", "get_polygon")
+	methodBindCollisionPolygonIsDisabled  = gdnative.NewLazyMethodBind("CollisionPolygon", "is_disabled")
+	methodBindCollisionPolygonSetDepth    = gdnative.NewLazyMethodBind("CollisionPolygon", "set_depth")
+	methodBindCollisionPolygonSetDisabled = gdnative.NewLazyMethodBind("CollisionPolygon", "set_disabled")
+	methodBindCollisionPolygonSetPolygon  = gdnative.NewLazyMethodBind("CollisionPolygon", "set_polygon")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *CollisionPolygon) GetDepth() gdnative.Real {
 	//log.Println("Calling CollisionPolygon.GetDepth()")
@@ -45,7 +62,7 @@ func (o *CollisionPolygon) GetDepth() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon", "get_depth")
+	methodBind := methodBindCollisionPolygonGetDepth.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +75,8 @@ func (o *CollisionPolygon) GetDepth() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolVector2Array
+	        Undocumented
+		Args: [], Returns: PoolVector2Array
 */
 func (o *CollisionPolygon) GetPolygon() gdnative.PoolVector2Array {
 	//log.Println("Calling CollisionPolygon.GetPolygon()")
@@ -68,7 +85,7 @@ func (o *CollisionPolygon) GetPolygon() gdnative.PoolVector2Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon", "get_polygon")
+	methodBind := methodBindCollisionPolygonGetPolygon.Get()
 
 	// Call the parent method.
 	// PoolVector2Array
@@ -81,8 +98,8 @@ func (o *CollisionPolygon) GetPolygon() gdnative.PoolVector2Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionPolygon) IsDisabled() gdnative.Bool {
 	//log.Println("Calling CollisionPolygon.IsDisabled()")
@@ -91,7 +108,7 @@ func (o *CollisionPolygon) IsDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon", "is_disabled")
+	methodBind := methodBindCollisionPolygonIsDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +121,8 @@ func (o *CollisionPolygon) IsDisabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false depth float}], Returns: void
+	        Undocumented
+		Args: [{ false depth float}], Returns: void
 */
 func (o *CollisionPolygon) SetDepth(depth gdnative.Real) {
 	//log.Println("Calling CollisionPolygon.SetDepth()")
@@ -115,7 +132,7 @@ func (o *CollisionPolygon) SetDepth(depth gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(depth)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon", "set_depth")
+	methodBind := methodBindCollisionPolygonSetDepth.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +142,8 @@ func (o *CollisionPolygon) SetDepth(depth gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false disabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false disabled bool}], Returns: void
 */
 func (o *CollisionPolygon) SetDisabled(disabled gdnative.Bool) {
 	//log.Println("Calling CollisionPolygon.SetDisabled()")
@@ -136,7 +153,7 @@ func (o *CollisionPolygon) SetDisabled(disabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon", "set_disabled")
+	methodBind := methodBindCollisionPolygonSetDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +163,8 @@ func (o *CollisionPolygon) SetDisabled(disabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false polygon PoolVector2Array}], Returns: void
+	        Undocumented
+		Args: [{ false polygon PoolVector2Array}], Returns: void
 */
 func (o *CollisionPolygon) SetPolygon(polygon gdnative.PoolVector2Array) {
 	//log.Println("Calling CollisionPolygon.SetPolygon()")
@@ -157,7 +174,7 @@ func (o *CollisionPolygon) SetPolygon(polygon gdnative.PoolVector2Array) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolVector2Array(polygon)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionPolygon", "set_polygon")
+	methodBind := methodBindCollisionPolygonSetPolygon.Get()
 
 	// Call the parent method.
 	// void
@@ -166,14 +183,32 @@ func (o *CollisionPolygon) SetPolygon(polygon gdnative.PoolVector2Array) {
 
 }
 
+// Depth will return the value of the "depth" property.
+func (o *CollisionPolygon) Depth() gdnative.Real {
+	return o.GetDepth()
+}
+
+// Disabled will return the value of the "disabled" property.
+func (o *CollisionPolygon) Disabled() gdnative.Bool {
+	return o.IsDisabled()
+}
+
+// Polygon will return the value of the "polygon" property.
+func (o *CollisionPolygon) Polygon() gdnative.PoolVector2Array {
+	return o.GetPolygon()
+}
+
 // CollisionPolygonImplementer is an interface that implements the methods
 // of the CollisionPolygon class.
 type CollisionPolygonImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetDepth() gdnative.Real
 	GetPolygon() gdnative.PoolVector2Array
 	IsDisabled() gdnative.Bool
 	SetDepth(depth gdnative.Real)
 	SetDisabled(disabled gdnative.Bool)
 	SetPolygon(polygon gdnative.PoolVector2Array)
+	Depth() gdnative.Real
+	Disabled() gdnative.Bool
+	Polygon() gdnative.PoolVector2Array
 }
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,39 +14,55 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewCollisionShapeFromPointer(ptr gdnative.Pointer) CollisionShape {
-func newCollisionShapeFromPointer(ptr gdnative.Pointer) CollisionShape {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := CollisionShape{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Editor facility for creating and editing collision shapes in 3D space. You can use this node to represent all sorts of collision shapes, for example, add this to an [Area] to give it a detection shape, or add it to a [PhysicsBody] to give create solid object. [b]IMPORTANT[/b]: this is an Editor-only helper to create shapes, use [method get_shape] to get the actual shape.
 */
 type CollisionShape struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorCollisionShape is the class constructor of the CollisionShape class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorCollisionShape = gdnative.NewLazyClassConstructor("CollisionShape")
+
+// NewCollisionShape will create a new instance of the CollisionShape class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewCollisionShape() *CollisionShape {
+	obj := &CollisionShape{}
+	obj.SetBaseObject(classConstructorCollisionShape.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *CollisionShape) BaseClass() string {
 	return "CollisionShape"
 }
 
+// Method binds of the CollisionShape class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindCollisionShapeGetShape               = gdnative.NewLazyMethodBind("CollisionShape", "get_shape")
+	methodBindCollisionShapeIsDisabled             = gdnative.NewLazyMethodBind("CollisionShape", "is_disabled")
+	methodBindCollisionShapeMakeConvexFromBrothers = gdnative.NewLazyMethodBind("CollisionShape", "make_convex_from_brothers")
+	methodBindCollisionShapeResourceChanged        = gdnative.NewLazyMethodBind("CollisionShape", "resource_changed")
+	methodBindCollisionShapeSetDisabled            = gdnative.NewLazyMethodBind("CollisionShape", "set_disabled")
+	methodBindCollisionShapeSetShape               = gdnative.NewLazyMethodBind("CollisionShape", "set_shape")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Shape
+	        Undocumented
+		Args: [], Returns: Shape
 */
-func (o *CollisionShape) GetShape() ShapeImplementer {
+func (o *CollisionShape) GetShape() godot.ShapeImplementer {
 	//log.Println("Calling CollisionShape.GetShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape", "get_shape")
+	methodBind := methodBindCollisionShapeGetShape.Get()
 
 	// Call the parent method.
 	// Shape
@@ -53,27 +70,25 @@ func (o *CollisionShape) GetShape() ShapeImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newShapeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ShapeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Shape" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ShapeImplementer)
+	ret := godot.Shape{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ShapeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *CollisionShape) IsDisabled() gdnative.Bool {
 	//log.Println("Calling CollisionShape.IsDisabled()")
@@ -82,7 +97,7 @@ func (o *CollisionShape) IsDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape", "is_disabled")
+	methodBind := methodBindCollisionShapeIsDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -95,8 +110,8 @@ func (o *CollisionShape) IsDisabled() gdnative.Bool {
 }
 
 /*
-        Sets the collision shape's shape to the addition of all its convexed [MeshInstance] siblings geometry.
-	Args: [], Returns: void
+	        Sets the collision shape's shape to the addition of all its convexed [MeshInstance] siblings geometry.
+		Args: [], Returns: void
 */
 func (o *CollisionShape) MakeConvexFromBrothers() {
 	//log.Println("Calling CollisionShape.MakeConvexFromBrothers()")
@@ -105,7 +120,7 @@ func (o *CollisionShape) MakeConvexFromBrothers() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape", "make_convex_from_brothers")
+	methodBind := methodBindCollisionShapeMakeConvexFromBrothers.Get()
 
 	// Call the parent method.
 	// void
@@ -115,18 +130,18 @@ func (o *CollisionShape) MakeConvexFromBrothers() {
 }
 
 /*
-        If this method exists within a script it will be called whenever the shape resource has been modified.
-	Args: [{ false resource Resource}], Returns: void
+	        If this method exists within a script it will be called whenever the shape resource has been modified.
+		Args: [{ false resource Resource}], Returns: void
 */
-func (o *CollisionShape) ResourceChanged(resource ResourceImplementer) {
+func (o *CollisionShape) ResourceChanged(resource godot.ResourceImplementer) {
 	//log.Println("Calling CollisionShape.ResourceChanged()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(resource.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape", "resource_changed")
+	methodBind := methodBindCollisionShapeResourceChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -136,8 +151,8 @@ func (o *CollisionShape) ResourceChanged(resource ResourceImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *CollisionShape) SetDisabled(enable gdnative.Bool) {
 	//log.Println("Calling CollisionShape.SetDisabled()")
@@ -147,7 +162,7 @@ func (o *CollisionShape) SetDisabled(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape", "set_disabled")
+	methodBind := methodBindCollisionShapeSetDisabled.Get()
 
 	// Call the parent method.
 	// void
@@ -157,18 +172,18 @@ func (o *CollisionShape) SetDisabled(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false shape Shape}], Returns: void
+	        Undocumented
+		Args: [{ false shape Shape}], Returns: void
 */
-func (o *CollisionShape) SetShape(shape ShapeImplementer) {
+func (o *CollisionShape) SetShape(shape godot.ShapeImplementer) {
 	//log.Println("Calling CollisionShape.SetShape()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(shape.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(shape))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("CollisionShape", "set_shape")
+	methodBind := methodBindCollisionShapeSetShape.Get()
 
 	// Call the parent method.
 	// void
@@ -177,14 +192,26 @@ func (o *CollisionShape) SetShape(shape ShapeImplementer) {
 
 }
 
+// Disabled will return the value of the "disabled" property.
+func (o *CollisionShape) Disabled() gdnative.Bool {
+	return o.IsDisabled()
+}
+
+// Shape will return the value of the "shape" property.
+func (o *CollisionShape) Shape() godot.ShapeImplementer {
+	return o.GetShape()
+}
+
 // CollisionShapeImplementer is an interface that implements the methods
 // of the CollisionShape class.
 type CollisionShapeImplementer interface {
-	SpatialImplementer
-	GetShape() ShapeImplementer
+	godot.SpatialImplementer
+	GetShape() godot.ShapeImplementer
 	IsDisabled() gdnative.Bool
 	MakeConvexFromBrothers()
-	ResourceChanged(resource ResourceImplementer)
+	ResourceChanged(resource godot.ResourceImplementer)
 	SetDisabled(enable gdnative.Bool)
-	SetShape(shape ShapeImplementer)
+	SetShape(shape godot.ShapeImplementer)
+	Disabled() gdnative.Bool
+	Shape() godot.ShapeImplementer
 }
@@ -0,0 +1,310 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// ConeTwistJointParam is an enum for Param values.
+type ConeTwistJointParam int
+
+const (
+	ConeTwistJointParamBias       ConeTwistJointParam = 2
+	ConeTwistJointParamMax        ConeTwistJointParam = 5
+	ConeTwistJointParamRelaxation ConeTwistJointParam = 4
+	ConeTwistJointParamSoftness   ConeTwistJointParam = 3
+	ConeTwistJointParamSwingSpan  ConeTwistJointParam = 0
+	ConeTwistJointParamTwistSpan  ConeTwistJointParam = 1
+)
+
+// String will return the Godot name of the ConeTwistJointParam value.
+func (e ConeTwistJointParam) String() string {
+	switch e {
+	case ConeTwistJointParamBias:
+		return "PARAM_BIAS"
+	case ConeTwistJointParamMax:
+		return "PARAM_MAX"
+	case ConeTwistJointParamRelaxation:
+		return "PARAM_RELAXATION"
+	case ConeTwistJointParamSoftness:
+		return "PARAM_SOFTNESS"
+	case ConeTwistJointParamSwingSpan:
+		return "PARAM_SWING_SPAN"
+	case ConeTwistJointParamTwistSpan:
+		return "PARAM_TWIST_SPAN"
+	}
+	return fmt.Sprintf("ConeTwistJointParam(%d)", int(e))
+}
+
+// ParseConeTwistJointParam will return the ConeTwistJointParam value with the given Godot name.
+func ParseConeTwistJointParam(name string) (ConeTwistJointParam, error) {
+	switch name {
+	case "PARAM_BIAS":
+		return ConeTwistJointParamBias, nil
+	case "PARAM_MAX":
+		return ConeTwistJointParamMax, nil
+	case "PARAM_RELAXATION":
+		return ConeTwistJointParamRelaxation, nil
+	case "PARAM_SOFTNESS":
+		return ConeTwistJointParamSoftness, nil
+	case "PARAM_SWING_SPAN":
+		return ConeTwistJointParamSwingSpan, nil
+	case "PARAM_TWIST_SPAN":
+		return ConeTwistJointParamTwistSpan, nil
+	}
+	return 0, fmt.Errorf("invalid ConeTwistJointParam value %q", name)
+}
+
+/*
+The joint can rotate the bodies across an axis defined by the local x-axes of the [Joint]. The twist axis is initiated as the x-axis of the [Joint]. Once the Bodies swing, the twist axis is calculated as the middle of the x-axes of the Joint in the local space of the two Bodies.
+*/
+type ConeTwistJoint struct {
+	Joint
+	owner gdnative.Object
+}
+
+// classConstructorConeTwistJoint is the class constructor of the ConeTwistJoint class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorConeTwistJoint = gdnative.NewLazyClassConstructor("ConeTwistJoint")
+
+// NewConeTwistJoint will create a new instance of the ConeTwistJoint class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewConeTwistJoint() *ConeTwistJoint {
+	obj := &ConeTwistJoint{}
+	obj.SetBaseObject(classConstructorConeTwistJoint.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *ConeTwistJoint) BaseClass() string {
+	return "ConeTwistJoint"
+}
+
+// Method binds of the ConeTwistJoint class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindConeTwistJointX_GetSwingSpan = gdnative.NewLazyMethodBind("ConeTwistJoint", "_get_swing_span")
+	methodBindConeTwistJointX_GetTwistSpan = gdnative.NewLazyMethodBind("ConeTwistJoint", "_get_twist_span")
+	methodBindConeTwistJointX_SetSwingSpan = gdnative.NewLazyMethodBind("ConeTwistJoint", "_set_swing_span")
+	methodBindConeTwistJointX_SetTwistSpan = gdnative.NewLazyMethodBind("ConeTwistJoint", "_set_twist_span")
+	methodBindConeTwistJointGetParam       = gdnative.NewLazyMethodBind("ConeTwistJoint", "get_param")
+	methodBindConeTwistJointSetParam       = gdnative.NewLazyMethodBind("ConeTwistJoint", "set_param")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *ConeTwistJoint) X_GetSwingSpan() gdnative.Real {
+	//log.Println("Calling ConeTwistJoint.X_GetSwingSpan()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindConeTwistJointX_GetSwingSpan.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *ConeTwistJoint) X_GetTwistSpan() gdnative.Real {
+	//log.Println("Calling ConeTwistJoint.X_GetTwistSpan()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindConeTwistJointX_GetTwistSpan.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false swing_span float}], Returns: void
+*/
+func (o *ConeTwistJoint) X_SetSwingSpan(swingSpan gdnative.Real) {
+	//log.Println("Calling ConeTwistJoint.X_SetSwingSpan()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(swingSpan)
+
+	// Get the method bind
+	methodBind := methodBindConeTwistJointX_SetSwingSpan.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false twist_span float}], Returns: void
+*/
+func (o *ConeTwistJoint) X_SetTwistSpan(twistSpan gdnative.Real) {
+	//log.Println("Calling ConeTwistJoint.X_SetTwistSpan()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(twistSpan)
+
+	// Get the method bind
+	methodBind := methodBindConeTwistJointX_SetTwistSpan.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.ConeTwistJoint::Param}], Returns: float
+*/
+func (o *ConeTwistJoint) GetParam(param ConeTwistJointParam) gdnative.Real {
+	//log.Println("Calling ConeTwistJoint.GetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindConeTwistJointGetParam.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.ConeTwistJoint::Param} { false value float}], Returns: void
+*/
+func (o *ConeTwistJoint) SetParam(param ConeTwistJointParam, value gdnative.Real) {
+	//log.Println("Calling ConeTwistJoint.SetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindConeTwistJointSetParam.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Bias will return the value of the "bias" property.
+func (o *ConeTwistJoint) Bias() gdnative.Real {
+	return o.GetParam(2)
+}
+
+// SetBias will set the value of the "bias" property.
+func (o *ConeTwistJoint) SetBias(value gdnative.Real) {
+	o.SetParam(2, value)
+}
+
+// Relaxation will return the value of the "relaxation" property.
+func (o *ConeTwistJoint) Relaxation() gdnative.Real {
+	return o.GetParam(4)
+}
+
+// SetRelaxation will set the value of the "relaxation" property.
+func (o *ConeTwistJoint) SetRelaxation(value gdnative.Real) {
+	o.SetParam(4, value)
+}
+
+// Softness will return the value of the "softness" property.
+func (o *ConeTwistJoint) Softness() gdnative.Real {
+	return o.GetParam(3)
+}
+
+// SetSoftness will set the value of the "softness" property.
+func (o *ConeTwistJoint) SetSoftness(value gdnative.Real) {
+	o.SetParam(3, value)
+}
+
+// SwingSpan will return the value of the "swing_span" property.
+func (o *ConeTwistJoint) SwingSpan() gdnative.Real {
+	return o.X_GetSwingSpan()
+}
+
+// SetSwingSpan will set the value of the "swing_span" property.
+func (o *ConeTwistJoint) SetSwingSpan(value gdnative.Real) {
+	o.X_SetSwingSpan(value)
+}
+
+// TwistSpan will return the value of the "twist_span" property.
+func (o *ConeTwistJoint) TwistSpan() gdnative.Real {
+	return o.X_GetTwistSpan()
+}
+
+// SetTwistSpan will set the value of the "twist_span" property.
+func (o *ConeTwistJoint) SetTwistSpan(value gdnative.Real) {
+	o.X_SetTwistSpan(value)
+}
+
+// ConeTwistJointImplementer is an interface that implements the methods
+// of the ConeTwistJoint class.
+type ConeTwistJointImplementer interface {
+	JointImplementer
+	X_GetSwingSpan() gdnative.Real
+	X_GetTwistSpan() gdnative.Real
+	X_SetSwingSpan(swingSpan gdnative.Real)
+	X_SetTwistSpan(twistSpan gdnative.Real)
+	GetParam(param ConeTwistJointParam) gdnative.Real
+	SetParam(param ConeTwistJointParam, value gdnative.Real)
+	Bias() gdnative.Real
+	SetBias(value gdnative.Real)
+	Relaxation() gdnative.Real
+	SetRelaxation(value gdnative.Real)
+	Softness() gdnative.Real
+	SetSoftness(value gdnative.Real)
+	SwingSpan() gdnative.Real
+	SetSwingSpan(value gdnative.Real)
+	TwistSpan() gdnative.Real
+	SetTwistSpan(value gdnative.Real)
+}
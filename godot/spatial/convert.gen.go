@@ -0,0 +1,230 @@
+package spatial
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("AnimatedSprite3D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &AnimatedSprite3D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Area", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Area{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("BakedLightmap", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &BakedLightmap{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("BoneAttachment", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &BoneAttachment{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CollisionPolygon", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CollisionPolygon{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("CollisionShape", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &CollisionShape{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ConeTwistJoint", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ConeTwistJoint{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("DirectionalLight", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &DirectionalLight{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GIProbe", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GIProbe{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Generic6DOFJoint", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Generic6DOFJoint{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GeometryInstance", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GeometryInstance{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("GridMap", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &GridMap{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("HingeJoint", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &HingeJoint{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ImmediateGeometry", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ImmediateGeometry{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("InterpolatedCamera", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &InterpolatedCamera{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Joint", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Joint{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("KinematicBody", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &KinematicBody{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Light", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Light{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Listener", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Listener{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("MeshInstance", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &MeshInstance{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("MultiMeshInstance", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &MultiMeshInstance{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Navigation", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Navigation{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("NavigationMeshInstance", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &NavigationMeshInstance{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("OmniLight", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &OmniLight{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Particles", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Particles{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Path", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Path{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PathFollow", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PathFollow{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("PinJoint", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &PinJoint{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Position3D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Position3D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ProximityGroup", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ProximityGroup{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RayCast", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RayCast{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("ReflectionProbe", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &ReflectionProbe{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RemoteTransform", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RemoteTransform{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("RigidBody", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &RigidBody{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Skeleton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Skeleton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SpatialMaterial", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SpatialMaterial{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SpatialVelocityTracker", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SpatialVelocityTracker{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SpotLight", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SpotLight{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("Sprite3D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &Sprite3D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("SpriteBase3D", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &SpriteBase3D{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("StaticBody", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &StaticBody{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualInstance", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualInstance{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -0,0 +1,371 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// DirectionalLightShadowDepthRange is an enum for ShadowDepthRange values.
+type DirectionalLightShadowDepthRange int
+
+const (
+	DirectionalLightShadowDepthRangeOptimized DirectionalLightShadowDepthRange = 1
+	DirectionalLightShadowDepthRangeStable    DirectionalLightShadowDepthRange = 0
+)
+
+// String will return the Godot name of the DirectionalLightShadowDepthRange value.
+func (e DirectionalLightShadowDepthRange) String() string {
+	switch e {
+	case DirectionalLightShadowDepthRangeOptimized:
+		return "SHADOW_DEPTH_RANGE_OPTIMIZED"
+	case DirectionalLightShadowDepthRangeStable:
+		return "SHADOW_DEPTH_RANGE_STABLE"
+	}
+	return fmt.Sprintf("DirectionalLightShadowDepthRange(%d)", int(e))
+}
+
+// ParseDirectionalLightShadowDepthRange will return the DirectionalLightShadowDepthRange value with the given Godot name.
+func ParseDirectionalLightShadowDepthRange(name string) (DirectionalLightShadowDepthRange, error) {
+	switch name {
+	case "SHADOW_DEPTH_RANGE_OPTIMIZED":
+		return DirectionalLightShadowDepthRangeOptimized, nil
+	case "SHADOW_DEPTH_RANGE_STABLE":
+		return DirectionalLightShadowDepthRangeStable, nil
+	}
+	return 0, fmt.Errorf("invalid DirectionalLightShadowDepthRange value %q", name)
+}
+
+// DirectionalLightShadowMode is an enum for ShadowMode values.
+type DirectionalLightShadowMode int
+
+const (
+	DirectionalLightShadowOrthogonal      DirectionalLightShadowMode = 0
+	DirectionalLightShadowParallel2Splits DirectionalLightShadowMode = 1
+	DirectionalLightShadowParallel4Splits DirectionalLightShadowMode = 2
+)
+
+// String will return the Godot name of the DirectionalLightShadowMode value.
+func (e DirectionalLightShadowMode) String() string {
+	switch e {
+	case DirectionalLightShadowOrthogonal:
+		return "SHADOW_ORTHOGONAL"
+	case DirectionalLightShadowParallel2Splits:
+		return "SHADOW_PARALLEL_2_SPLITS"
+	case DirectionalLightShadowParallel4Splits:
+		return "SHADOW_PARALLEL_4_SPLITS"
+	}
+	return fmt.Sprintf("DirectionalLightShadowMode(%d)", int(e))
+}
+
+// ParseDirectionalLightShadowMode will return the DirectionalLightShadowMode value with the given Godot name.
+func ParseDirectionalLightShadowMode(name string) (DirectionalLightShadowMode, error) {
+	switch name {
+	case "SHADOW_ORTHOGONAL":
+		return DirectionalLightShadowOrthogonal, nil
+	case "SHADOW_PARALLEL_2_SPLITS":
+		return DirectionalLightShadowParallel2Splits, nil
+	case "SHADOW_PARALLEL_4_SPLITS":
+		return DirectionalLightShadowParallel4Splits, nil
+	}
+	return 0, fmt.Errorf("invalid DirectionalLightShadowMode value %q", name)
+}
+
+/*
+A DirectionalLight is a type of [Light] node that emits light constantly in one direction (the negative z axis of the node). It is used lights with strong intensity that are located far away from the scene to model sunlight or moonlight. The worldspace location of the DirectionalLight transform (origin) is ignored, only the basis is used do determine light direction.
+*/
+type DirectionalLight struct {
+	Light
+	owner gdnative.Object
+}
+
+// classConstructorDirectionalLight is the class constructor of the DirectionalLight class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorDirectionalLight = gdnative.NewLazyClassConstructor("DirectionalLight")
+
+// NewDirectionalLight will create a new instance of the DirectionalLight class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewDirectionalLight() *DirectionalLight {
+	obj := &DirectionalLight{}
+	obj.SetBaseObject(classConstructorDirectionalLight.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *DirectionalLight) BaseClass() string {
+	return "DirectionalLight"
+}
+
+// Method binds of the DirectionalLight class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindDirectionalLightGetShadowDepthRange  = gdnative.NewLazyMethodBind("DirectionalLight", "get_shadow_depth_range")
+	methodBindDirectionalLightGetShadowMode        = gdnative.NewLazyMethodBind("DirectionalLight", "get_shadow_mode")
+	methodBindDirectionalLightIsBlendSplitsEnabled = gdnative.NewLazyMethodBind("DirectionalLight", "is_blend_splits_enabled")
+	methodBindDirectionalLightSetBlendSplits       = gdnative.NewLazyMethodBind("DirectionalLight", "set_blend_splits")
+	methodBindDirectionalLightSetShadowDepthRange  = gdnative.NewLazyMethodBind("DirectionalLight", "set_shadow_depth_range")
+	methodBindDirectionalLightSetShadowMode        = gdnative.NewLazyMethodBind("DirectionalLight", "set_shadow_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.DirectionalLight::ShadowDepthRange
+*/
+func (o *DirectionalLight) GetShadowDepthRange() DirectionalLightShadowDepthRange {
+	//log.Println("Calling DirectionalLight.GetShadowDepthRange()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindDirectionalLightGetShadowDepthRange.Get()
+
+	// Call the parent method.
+	// enum.DirectionalLight::ShadowDepthRange
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return DirectionalLightShadowDepthRange(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.DirectionalLight::ShadowMode
+*/
+func (o *DirectionalLight) GetShadowMode() DirectionalLightShadowMode {
+	//log.Println("Calling DirectionalLight.GetShadowMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindDirectionalLightGetShadowMode.Get()
+
+	// Call the parent method.
+	// enum.DirectionalLight::ShadowMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return DirectionalLightShadowMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *DirectionalLight) IsBlendSplitsEnabled() gdnative.Bool {
+	//log.Println("Calling DirectionalLight.IsBlendSplitsEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindDirectionalLightIsBlendSplitsEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *DirectionalLight) SetBlendSplits(enabled gdnative.Bool) {
+	//log.Println("Calling DirectionalLight.SetBlendSplits()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindDirectionalLightSetBlendSplits.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.DirectionalLight::ShadowDepthRange}], Returns: void
+*/
+func (o *DirectionalLight) SetShadowDepthRange(mode DirectionalLightShadowDepthRange) {
+	//log.Println("Calling DirectionalLight.SetShadowDepthRange()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindDirectionalLightSetShadowDepthRange.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.DirectionalLight::ShadowMode}], Returns: void
+*/
+func (o *DirectionalLight) SetShadowMode(mode DirectionalLightShadowMode) {
+	//log.Println("Calling DirectionalLight.SetShadowMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindDirectionalLightSetShadowMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// DirectionalShadowBiasSplitScale will return the value of the "directional_shadow_bias_split_scale" property.
+func (o *DirectionalLight) DirectionalShadowBiasSplitScale() gdnative.Real {
+	return o.GetParam(14)
+}
+
+// SetDirectionalShadowBiasSplitScale will set the value of the "directional_shadow_bias_split_scale" property.
+func (o *DirectionalLight) SetDirectionalShadowBiasSplitScale(value gdnative.Real) {
+	o.SetParam(14, value)
+}
+
+// DirectionalShadowBlendSplits will return the value of the "directional_shadow_blend_splits" property.
+func (o *DirectionalLight) DirectionalShadowBlendSplits() gdnative.Bool {
+	return o.IsBlendSplitsEnabled()
+}
+
+// SetDirectionalShadowBlendSplits will set the value of the "directional_shadow_blend_splits" property.
+func (o *DirectionalLight) SetDirectionalShadowBlendSplits(value gdnative.Bool) {
+	o.SetBlendSplits(value)
+}
+
+// DirectionalShadowDepthRange will return the value of the "directional_shadow_depth_range" property.
+func (o *DirectionalLight) DirectionalShadowDepthRange() DirectionalLightShadowDepthRange {
+	return o.GetShadowDepthRange()
+}
+
+// SetDirectionalShadowDepthRange will set the value of the "directional_shadow_depth_range" property.
+func (o *DirectionalLight) SetDirectionalShadowDepthRange(value DirectionalLightShadowDepthRange) {
+	o.SetShadowDepthRange(value)
+}
+
+// DirectionalShadowMaxDistance will return the value of the "directional_shadow_max_distance" property.
+func (o *DirectionalLight) DirectionalShadowMaxDistance() gdnative.Real {
+	return o.GetParam(8)
+}
+
+// SetDirectionalShadowMaxDistance will set the value of the "directional_shadow_max_distance" property.
+func (o *DirectionalLight) SetDirectionalShadowMaxDistance(value gdnative.Real) {
+	o.SetParam(8, value)
+}
+
+// DirectionalShadowMode will return the value of the "directional_shadow_mode" property.
+func (o *DirectionalLight) DirectionalShadowMode() DirectionalLightShadowMode {
+	return o.GetShadowMode()
+}
+
+// SetDirectionalShadowMode will set the value of the "directional_shadow_mode" property.
+func (o *DirectionalLight) SetDirectionalShadowMode(value DirectionalLightShadowMode) {
+	o.SetShadowMode(value)
+}
+
+// DirectionalShadowNormalBias will return the value of the "directional_shadow_normal_bias" property.
+func (o *DirectionalLight) DirectionalShadowNormalBias() gdnative.Real {
+	return o.GetParam(12)
+}
+
+// SetDirectionalShadowNormalBias will set the value of the "directional_shadow_normal_bias" property.
+func (o *DirectionalLight) SetDirectionalShadowNormalBias(value gdnative.Real) {
+	o.SetParam(12, value)
+}
+
+// DirectionalShadowSplit1 will return the value of the "directional_shadow_split_1" property.
+func (o *DirectionalLight) DirectionalShadowSplit1() gdnative.Real {
+	return o.GetParam(9)
+}
+
+// SetDirectionalShadowSplit1 will set the value of the "directional_shadow_split_1" property.
+func (o *DirectionalLight) SetDirectionalShadowSplit1(value gdnative.Real) {
+	o.SetParam(9, value)
+}
+
+// DirectionalShadowSplit2 will return the value of the "directional_shadow_split_2" property.
+func (o *DirectionalLight) DirectionalShadowSplit2() gdnative.Real {
+	return o.GetParam(10)
+}
+
+// SetDirectionalShadowSplit2 will set the value of the "directional_shadow_split_2" property.
+func (o *DirectionalLight) SetDirectionalShadowSplit2(value gdnative.Real) {
+	o.SetParam(10, value)
+}
+
+// DirectionalShadowSplit3 will return the value of the "directional_shadow_split_3" property.
+func (o *DirectionalLight) DirectionalShadowSplit3() gdnative.Real {
+	return o.GetParam(11)
+}
+
+// SetDirectionalShadowSplit3 will set the value of the "directional_shadow_split_3" property.
+func (o *DirectionalLight) SetDirectionalShadowSplit3(value gdnative.Real) {
+	o.SetParam(11, value)
+}
+
+// DirectionalLightImplementer is an interface that implements the methods
+// of the DirectionalLight class.
+type DirectionalLightImplementer interface {
+	LightImplementer
+	GetShadowDepthRange() DirectionalLightShadowDepthRange
+	GetShadowMode() DirectionalLightShadowMode
+	IsBlendSplitsEnabled() gdnative.Bool
+	SetBlendSplits(enabled gdnative.Bool)
+	SetShadowDepthRange(mode DirectionalLightShadowDepthRange)
+	SetShadowMode(mode DirectionalLightShadowMode)
+	DirectionalShadowBiasSplitScale() gdnative.Real
+	SetDirectionalShadowBiasSplitScale(value gdnative.Real)
+	DirectionalShadowBlendSplits() gdnative.Bool
+	SetDirectionalShadowBlendSplits(value gdnative.Bool)
+	DirectionalShadowDepthRange() DirectionalLightShadowDepthRange
+	SetDirectionalShadowDepthRange(value DirectionalLightShadowDepthRange)
+	DirectionalShadowMaxDistance() gdnative.Real
+	SetDirectionalShadowMaxDistance(value gdnative.Real)
+	DirectionalShadowMode() DirectionalLightShadowMode
+	SetDirectionalShadowMode(value DirectionalLightShadowMode)
+	DirectionalShadowNormalBias() gdnative.Real
+	SetDirectionalShadowNormalBias(value gdnative.Real)
+	DirectionalShadowSplit1() gdnative.Real
+	SetDirectionalShadowSplit1(value gdnative.Real)
+	DirectionalShadowSplit2() gdnative.Real
+	SetDirectionalShadowSplit2(value gdnative.Real)
+	DirectionalShadowSplit3() gdnative.Real
+	SetDirectionalShadowSplit3(value gdnative.Real)
+}
@@ -0,0 +1,1389 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// Generic6DOFJointFlag is an enum for Flag values.
+type Generic6DOFJointFlag int
+
+const (
+	Generic6DOFJointFlagEnableAngularLimit Generic6DOFJointFlag = 1
+	Generic6DOFJointFlagEnableLinearLimit  Generic6DOFJointFlag = 0
+	Generic6DOFJointFlagEnableMotor        Generic6DOFJointFlag = 2
+	Generic6DOFJointFlagMax                Generic6DOFJointFlag = 3
+)
+
+// String will return the Godot name of the Generic6DOFJointFlag value.
+func (e Generic6DOFJointFlag) String() string {
+	switch e {
+	case Generic6DOFJointFlagEnableAngularLimit:
+		return "FLAG_ENABLE_ANGULAR_LIMIT"
+	case Generic6DOFJointFlagEnableLinearLimit:
+		return "FLAG_ENABLE_LINEAR_LIMIT"
+	case Generic6DOFJointFlagEnableMotor:
+		return "FLAG_ENABLE_MOTOR"
+	case Generic6DOFJointFlagMax:
+		return "FLAG_MAX"
+	}
+	return fmt.Sprintf("Generic6DOFJointFlag(%d)", int(e))
+}
+
+// ParseGeneric6DOFJointFlag will return the Generic6DOFJointFlag value with the given Godot name.
+func ParseGeneric6DOFJointFlag(name string) (Generic6DOFJointFlag, error) {
+	switch name {
+	case "FLAG_ENABLE_ANGULAR_LIMIT":
+		return Generic6DOFJointFlagEnableAngularLimit, nil
+	case "FLAG_ENABLE_LINEAR_LIMIT":
+		return Generic6DOFJointFlagEnableLinearLimit, nil
+	case "FLAG_ENABLE_MOTOR":
+		return Generic6DOFJointFlagEnableMotor, nil
+	case "FLAG_MAX":
+		return Generic6DOFJointFlagMax, nil
+	}
+	return 0, fmt.Errorf("invalid Generic6DOFJointFlag value %q", name)
+}
+
+// Generic6DOFJointParam is an enum for Param values.
+type Generic6DOFJointParam int
+
+const (
+	Generic6DOFJointParamAngularDamping             Generic6DOFJointParam = 8
+	Generic6DOFJointParamAngularErp                 Generic6DOFJointParam = 11
+	Generic6DOFJointParamAngularForceLimit          Generic6DOFJointParam = 10
+	Generic6DOFJointParamAngularLimitSoftness       Generic6DOFJointParam = 7
+	Generic6DOFJointParamAngularLowerLimit          Generic6DOFJointParam = 5
+	Generic6DOFJointParamAngularMotorForceLimit     Generic6DOFJointParam = 13
+	Generic6DOFJointParamAngularMotorTargetVelocity Generic6DOFJointParam = 12
+	Generic6DOFJointParamAngularRestitution         Generic6DOFJointParam = 9
+	Generic6DOFJointParamAngularUpperLimit          Generic6DOFJointParam = 6
+	Generic6DOFJointParamLinearDamping              Generic6DOFJointParam = 4
+	Generic6DOFJointParamLinearLimitSoftness        Generic6DOFJointParam = 2
+	Generic6DOFJointParamLinearLowerLimit           Generic6DOFJointParam = 0
+	Generic6DOFJointParamLinearRestitution          Generic6DOFJointParam = 3
+	Generic6DOFJointParamLinearUpperLimit           Generic6DOFJointParam = 1
+	Generic6DOFJointParamMax                        Generic6DOFJointParam = 14
+)
+
+// String will return the Godot name of the Generic6DOFJointParam value.
+func (e Generic6DOFJointParam) String() string {
+	switch e {
+	case Generic6DOFJointParamAngularDamping:
+		return "PARAM_ANGULAR_DAMPING"
+	case Generic6DOFJointParamAngularErp:
+		return "PARAM_ANGULAR_ERP"
+	case Generic6DOFJointParamAngularForceLimit:
+		return "PARAM_ANGULAR_FORCE_LIMIT"
+	case Generic6DOFJointParamAngularLimitSoftness:
+		return "PARAM_ANGULAR_LIMIT_SOFTNESS"
+	case Generic6DOFJointParamAngularLowerLimit:
+		return "PARAM_ANGULAR_LOWER_LIMIT"
+	case Generic6DOFJointParamAngularMotorForceLimit:
+		return "PARAM_ANGULAR_MOTOR_FORCE_LIMIT"
+	case Generic6DOFJointParamAngularMotorTargetVelocity:
+		return "PARAM_ANGULAR_MOTOR_TARGET_VELOCITY"
+	case Generic6DOFJointParamAngularRestitution:
+		return "PARAM_ANGULAR_RESTITUTION"
+	case Generic6DOFJointParamAngularUpperLimit:
+		return "PARAM_ANGULAR_UPPER_LIMIT"
+	case Generic6DOFJointParamLinearDamping:
+		return "PARAM_LINEAR_DAMPING"
+	case Generic6DOFJointParamLinearLimitSoftness:
+		return "PARAM_LINEAR_LIMIT_SOFTNESS"
+	case Generic6DOFJointParamLinearLowerLimit:
+		return "PARAM_LINEAR_LOWER_LIMIT"
+	case Generic6DOFJointParamLinearRestitution:
+		return "PARAM_LINEAR_RESTITUTION"
+	case Generic6DOFJointParamLinearUpperLimit:
+		return "PARAM_LINEAR_UPPER_LIMIT"
+	case Generic6DOFJointParamMax:
+		return "PARAM_MAX"
+	}
+	return fmt.Sprintf("Generic6DOFJointParam(%d)", int(e))
+}
+
+// ParseGeneric6DOFJointParam will return the Generic6DOFJointParam value with the given Godot name.
+func ParseGeneric6DOFJointParam(name string) (Generic6DOFJointParam, error) {
+	switch name {
+	case "PARAM_ANGULAR_DAMPING":
+		return Generic6DOFJointParamAngularDamping, nil
+	case "PARAM_ANGULAR_ERP":
+		return Generic6DOFJointParamAngularErp, nil
+	case "PARAM_ANGULAR_FORCE_LIMIT":
+		return Generic6DOFJointParamAngularForceLimit, nil
+	case "PARAM_ANGULAR_LIMIT_SOFTNESS":
+		return Generic6DOFJointParamAngularLimitSoftness, nil
+	case "PARAM_ANGULAR_LOWER_LIMIT":
+		return Generic6DOFJointParamAngularLowerLimit, nil
+	case "PARAM_ANGULAR_MOTOR_FORCE_LIMIT":
+		return Generic6DOFJointParamAngularMotorForceLimit, nil
+	case "PARAM_ANGULAR_MOTOR_TARGET_VELOCITY":
+		return Generic6DOFJointParamAngularMotorTargetVelocity, nil
+	case "PARAM_ANGULAR_RESTITUTION":
+		return Generic6DOFJointParamAngularRestitution, nil
+	case "PARAM_ANGULAR_UPPER_LIMIT":
+		return Generic6DOFJointParamAngularUpperLimit, nil
+	case "PARAM_LINEAR_DAMPING":
+		return Generic6DOFJointParamLinearDamping, nil
+	case "PARAM_LINEAR_LIMIT_SOFTNESS":
+		return Generic6DOFJointParamLinearLimitSoftness, nil
+	case "PARAM_LINEAR_LOWER_LIMIT":
+		return Generic6DOFJointParamLinearLowerLimit, nil
+	case "PARAM_LINEAR_RESTITUTION":
+		return Generic6DOFJointParamLinearRestitution, nil
+	case "PARAM_LINEAR_UPPER_LIMIT":
+		return Generic6DOFJointParamLinearUpperLimit, nil
+	case "PARAM_MAX":
+		return Generic6DOFJointParamMax, nil
+	}
+	return 0, fmt.Errorf("invalid Generic6DOFJointParam value %q", name)
+}
+
+/*
+The first 3 dof axes are linear axes, which represent translation of Bodies, and the latter 3 dof axes represent the angular motion. Each axis can be either locked, or limited.
+*/
+type Generic6DOFJoint struct {
+	Joint
+	owner gdnative.Object
+}
+
+// classConstructorGeneric6DOFJoint is the class constructor of the Generic6DOFJoint class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGeneric6DOFJoint = gdnative.NewLazyClassConstructor("Generic6DOFJoint")
+
+// NewGeneric6DOFJoint will create a new instance of the Generic6DOFJoint class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewGeneric6DOFJoint() *Generic6DOFJoint {
+	obj := &Generic6DOFJoint{}
+	obj.SetBaseObject(classConstructorGeneric6DOFJoint.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Generic6DOFJoint) BaseClass() string {
+	return "Generic6DOFJoint"
+}
+
+// Method binds of the Generic6DOFJoint class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGeneric6DOFJointX_GetAngularHiLimitX = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_get_angular_hi_limit_x")
+	methodBindGeneric6DOFJointX_GetAngularHiLimitY = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_get_angular_hi_limit_y")
+	methodBindGeneric6DOFJointX_GetAngularHiLimitZ = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_get_angular_hi_limit_z")
+	methodBindGeneric6DOFJointX_GetAngularLoLimitX = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_get_angular_lo_limit_x")
+	methodBindGeneric6DOFJointX_GetAngularLoLimitY = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_get_angular_lo_limit_y")
+	methodBindGeneric6DOFJointX_GetAngularLoLimitZ = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_get_angular_lo_limit_z")
+	methodBindGeneric6DOFJointX_SetAngularHiLimitX = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_set_angular_hi_limit_x")
+	methodBindGeneric6DOFJointX_SetAngularHiLimitY = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_set_angular_hi_limit_y")
+	methodBindGeneric6DOFJointX_SetAngularHiLimitZ = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_set_angular_hi_limit_z")
+	methodBindGeneric6DOFJointX_SetAngularLoLimitX = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_set_angular_lo_limit_x")
+	methodBindGeneric6DOFJointX_SetAngularLoLimitY = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_set_angular_lo_limit_y")
+	methodBindGeneric6DOFJointX_SetAngularLoLimitZ = gdnative.NewLazyMethodBind("Generic6DOFJoint", "_set_angular_lo_limit_z")
+	methodBindGeneric6DOFJointGetFlagX             = gdnative.NewLazyMethodBind("Generic6DOFJoint", "get_flag_x")
+	methodBindGeneric6DOFJointGetFlagY             = gdnative.NewLazyMethodBind("Generic6DOFJoint", "get_flag_y")
+	methodBindGeneric6DOFJointGetFlagZ             = gdnative.NewLazyMethodBind("Generic6DOFJoint", "get_flag_z")
+	methodBindGeneric6DOFJointGetParamX            = gdnative.NewLazyMethodBind("Generic6DOFJoint", "get_param_x")
+	methodBindGeneric6DOFJointGetParamY            = gdnative.NewLazyMethodBind("Generic6DOFJoint", "get_param_y")
+	methodBindGeneric6DOFJointGetParamZ            = gdnative.NewLazyMethodBind("Generic6DOFJoint", "get_param_z")
+	methodBindGeneric6DOFJointSetFlagX             = gdnative.NewLazyMethodBind("Generic6DOFJoint", "set_flag_x")
+	methodBindGeneric6DOFJointSetFlagY             = gdnative.NewLazyMethodBind("Generic6DOFJoint", "set_flag_y")
+	methodBindGeneric6DOFJointSetFlagZ             = gdnative.NewLazyMethodBind("Generic6DOFJoint", "set_flag_z")
+	methodBindGeneric6DOFJointSetParamX            = gdnative.NewLazyMethodBind("Generic6DOFJoint", "set_param_x")
+	methodBindGeneric6DOFJointSetParamY            = gdnative.NewLazyMethodBind("Generic6DOFJoint", "set_param_y")
+	methodBindGeneric6DOFJointSetParamZ            = gdnative.NewLazyMethodBind("Generic6DOFJoint", "set_param_z")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Generic6DOFJoint) X_GetAngularHiLimitX() gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.X_GetAngularHiLimitX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_GetAngularHiLimitX.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Generic6DOFJoint) X_GetAngularHiLimitY() gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.X_GetAngularHiLimitY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_GetAngularHiLimitY.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Generic6DOFJoint) X_GetAngularHiLimitZ() gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.X_GetAngularHiLimitZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_GetAngularHiLimitZ.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Generic6DOFJoint) X_GetAngularLoLimitX() gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.X_GetAngularLoLimitX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_GetAngularLoLimitX.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Generic6DOFJoint) X_GetAngularLoLimitY() gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.X_GetAngularLoLimitY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_GetAngularLoLimitY.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *Generic6DOFJoint) X_GetAngularLoLimitZ() gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.X_GetAngularLoLimitZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_GetAngularLoLimitZ.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false angle float}], Returns: void
+*/
+func (o *Generic6DOFJoint) X_SetAngularHiLimitX(angle gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.X_SetAngularHiLimitX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_SetAngularHiLimitX.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false angle float}], Returns: void
+*/
+func (o *Generic6DOFJoint) X_SetAngularHiLimitY(angle gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.X_SetAngularHiLimitY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_SetAngularHiLimitY.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false angle float}], Returns: void
+*/
+func (o *Generic6DOFJoint) X_SetAngularHiLimitZ(angle gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.X_SetAngularHiLimitZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_SetAngularHiLimitZ.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false angle float}], Returns: void
+*/
+func (o *Generic6DOFJoint) X_SetAngularLoLimitX(angle gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.X_SetAngularLoLimitX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_SetAngularLoLimitX.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false angle float}], Returns: void
+*/
+func (o *Generic6DOFJoint) X_SetAngularLoLimitY(angle gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.X_SetAngularLoLimitY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_SetAngularLoLimitY.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false angle float}], Returns: void
+*/
+func (o *Generic6DOFJoint) X_SetAngularLoLimitZ(angle gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.X_SetAngularLoLimitZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angle)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointX_SetAngularLoLimitZ.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.Generic6DOFJoint::Flag}], Returns: bool
+*/
+func (o *Generic6DOFJoint) GetFlagX(flag Generic6DOFJointFlag) gdnative.Bool {
+	//log.Println("Calling Generic6DOFJoint.GetFlagX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointGetFlagX.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.Generic6DOFJoint::Flag}], Returns: bool
+*/
+func (o *Generic6DOFJoint) GetFlagY(flag Generic6DOFJointFlag) gdnative.Bool {
+	//log.Println("Calling Generic6DOFJoint.GetFlagY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointGetFlagY.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.Generic6DOFJoint::Flag}], Returns: bool
+*/
+func (o *Generic6DOFJoint) GetFlagZ(flag Generic6DOFJointFlag) gdnative.Bool {
+	//log.Println("Calling Generic6DOFJoint.GetFlagZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointGetFlagZ.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Generic6DOFJoint::Param}], Returns: float
+*/
+func (o *Generic6DOFJoint) GetParamX(param Generic6DOFJointParam) gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.GetParamX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointGetParamX.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Generic6DOFJoint::Param}], Returns: float
+*/
+func (o *Generic6DOFJoint) GetParamY(param Generic6DOFJointParam) gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.GetParamY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointGetParamY.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Generic6DOFJoint::Param}], Returns: float
+*/
+func (o *Generic6DOFJoint) GetParamZ(param Generic6DOFJointParam) gdnative.Real {
+	//log.Println("Calling Generic6DOFJoint.GetParamZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointGetParamZ.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.Generic6DOFJoint::Flag} { false value bool}], Returns: void
+*/
+func (o *Generic6DOFJoint) SetFlagX(flag Generic6DOFJointFlag, value gdnative.Bool) {
+	//log.Println("Calling Generic6DOFJoint.SetFlagX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+	ptrArguments[1] = gdnative.NewPointerFromBool(value)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointSetFlagX.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.Generic6DOFJoint::Flag} { false value bool}], Returns: void
+*/
+func (o *Generic6DOFJoint) SetFlagY(flag Generic6DOFJointFlag, value gdnative.Bool) {
+	//log.Println("Calling Generic6DOFJoint.SetFlagY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+	ptrArguments[1] = gdnative.NewPointerFromBool(value)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointSetFlagY.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.Generic6DOFJoint::Flag} { false value bool}], Returns: void
+*/
+func (o *Generic6DOFJoint) SetFlagZ(flag Generic6DOFJointFlag, value gdnative.Bool) {
+	//log.Println("Calling Generic6DOFJoint.SetFlagZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+	ptrArguments[1] = gdnative.NewPointerFromBool(value)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointSetFlagZ.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Generic6DOFJoint::Param} { false value float}], Returns: void
+*/
+func (o *Generic6DOFJoint) SetParamX(param Generic6DOFJointParam, value gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.SetParamX()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointSetParamX.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Generic6DOFJoint::Param} { false value float}], Returns: void
+*/
+func (o *Generic6DOFJoint) SetParamY(param Generic6DOFJointParam, value gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.SetParamY()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointSetParamY.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Generic6DOFJoint::Param} { false value float}], Returns: void
+*/
+func (o *Generic6DOFJoint) SetParamZ(param Generic6DOFJointParam, value gdnative.Real) {
+	//log.Println("Calling Generic6DOFJoint.SetParamZ()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindGeneric6DOFJointSetParamZ.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AngularLimitXDamping will return the value of the "angular_limit_x/damping" property.
+func (o *Generic6DOFJoint) AngularLimitXDamping() gdnative.Real {
+	return o.GetParamX(8)
+}
+
+// SetAngularLimitXDamping will set the value of the "angular_limit_x/damping" property.
+func (o *Generic6DOFJoint) SetAngularLimitXDamping(value gdnative.Real) {
+	o.SetParamX(8, value)
+}
+
+// AngularLimitXEnabled will return the value of the "angular_limit_x/enabled" property.
+func (o *Generic6DOFJoint) AngularLimitXEnabled() gdnative.Bool {
+	return o.GetFlagX(1)
+}
+
+// SetAngularLimitXEnabled will set the value of the "angular_limit_x/enabled" property.
+func (o *Generic6DOFJoint) SetAngularLimitXEnabled(value gdnative.Bool) {
+	o.SetFlagX(1, value)
+}
+
+// AngularLimitXErp will return the value of the "angular_limit_x/erp" property.
+func (o *Generic6DOFJoint) AngularLimitXErp() gdnative.Real {
+	return o.GetParamX(11)
+}
+
+// SetAngularLimitXErp will set the value of the "angular_limit_x/erp" property.
+func (o *Generic6DOFJoint) SetAngularLimitXErp(value gdnative.Real) {
+	o.SetParamX(11, value)
+}
+
+// AngularLimitXForceLimit will return the value of the "angular_limit_x/force_limit" property.
+func (o *Generic6DOFJoint) AngularLimitXForceLimit() gdnative.Real {
+	return o.GetParamX(10)
+}
+
+// SetAngularLimitXForceLimit will set the value of the "angular_limit_x/force_limit" property.
+func (o *Generic6DOFJoint) SetAngularLimitXForceLimit(value gdnative.Real) {
+	o.SetParamX(10, value)
+}
+
+// AngularLimitXLowerAngle will return the value of the "angular_limit_x/lower_angle" property.
+func (o *Generic6DOFJoint) AngularLimitXLowerAngle() gdnative.Real {
+	return o.X_GetAngularLoLimitX()
+}
+
+// SetAngularLimitXLowerAngle will set the value of the "angular_limit_x/lower_angle" property.
+func (o *Generic6DOFJoint) SetAngularLimitXLowerAngle(value gdnative.Real) {
+	o.X_SetAngularLoLimitX(value)
+}
+
+// AngularLimitXRestitution will return the value of the "angular_limit_x/restitution" property.
+func (o *Generic6DOFJoint) AngularLimitXRestitution() gdnative.Real {
+	return o.GetParamX(9)
+}
+
+// SetAngularLimitXRestitution will set the value of the "angular_limit_x/restitution" property.
+func (o *Generic6DOFJoint) SetAngularLimitXRestitution(value gdnative.Real) {
+	o.SetParamX(9, value)
+}
+
+// AngularLimitXSoftness will return the value of the "angular_limit_x/softness" property.
+func (o *Generic6DOFJoint) AngularLimitXSoftness() gdnative.Real {
+	return o.GetParamX(7)
+}
+
+// SetAngularLimitXSoftness will set the value of the "angular_limit_x/softness" property.
+func (o *Generic6DOFJoint) SetAngularLimitXSoftness(value gdnative.Real) {
+	o.SetParamX(7, value)
+}
+
+// AngularLimitXUpperAngle will return the value of the "angular_limit_x/upper_angle" property.
+func (o *Generic6DOFJoint) AngularLimitXUpperAngle() gdnative.Real {
+	return o.X_GetAngularHiLimitX()
+}
+
+// SetAngularLimitXUpperAngle will set the value of the "angular_limit_x/upper_angle" property.
+func (o *Generic6DOFJoint) SetAngularLimitXUpperAngle(value gdnative.Real) {
+	o.X_SetAngularHiLimitX(value)
+}
+
+// AngularLimitYDamping will return the value of the "angular_limit_y/damping" property.
+func (o *Generic6DOFJoint) AngularLimitYDamping() gdnative.Real {
+	return o.GetParamY(8)
+}
+
+// SetAngularLimitYDamping will set the value of the "angular_limit_y/damping" property.
+func (o *Generic6DOFJoint) SetAngularLimitYDamping(value gdnative.Real) {
+	o.SetParamY(8, value)
+}
+
+// AngularLimitYEnabled will return the value of the "angular_limit_y/enabled" property.
+func (o *Generic6DOFJoint) AngularLimitYEnabled() gdnative.Bool {
+	return o.GetFlagY(1)
+}
+
+// SetAngularLimitYEnabled will set the value of the "angular_limit_y/enabled" property.
+func (o *Generic6DOFJoint) SetAngularLimitYEnabled(value gdnative.Bool) {
+	o.SetFlagY(1, value)
+}
+
+// AngularLimitYErp will return the value of the "angular_limit_y/erp" property.
+func (o *Generic6DOFJoint) AngularLimitYErp() gdnative.Real {
+	return o.GetParamY(11)
+}
+
+// SetAngularLimitYErp will set the value of the "angular_limit_y/erp" property.
+func (o *Generic6DOFJoint) SetAngularLimitYErp(value gdnative.Real) {
+	o.SetParamY(11, value)
+}
+
+// AngularLimitYForceLimit will return the value of the "angular_limit_y/force_limit" property.
+func (o *Generic6DOFJoint) AngularLimitYForceLimit() gdnative.Real {
+	return o.GetParamY(10)
+}
+
+// SetAngularLimitYForceLimit will set the value of the "angular_limit_y/force_limit" property.
+func (o *Generic6DOFJoint) SetAngularLimitYForceLimit(value gdnative.Real) {
+	o.SetParamY(10, value)
+}
+
+// AngularLimitYLowerAngle will return the value of the "angular_limit_y/lower_angle" property.
+func (o *Generic6DOFJoint) AngularLimitYLowerAngle() gdnative.Real {
+	return o.X_GetAngularLoLimitY()
+}
+
+// SetAngularLimitYLowerAngle will set the value of the "angular_limit_y/lower_angle" property.
+func (o *Generic6DOFJoint) SetAngularLimitYLowerAngle(value gdnative.Real) {
+	o.X_SetAngularLoLimitY(value)
+}
+
+// AngularLimitYRestitution will return the value of the "angular_limit_y/restitution" property.
+func (o *Generic6DOFJoint) AngularLimitYRestitution() gdnative.Real {
+	return o.GetParamY(9)
+}
+
+// SetAngularLimitYRestitution will set the value of the "angular_limit_y/restitution" property.
+func (o *Generic6DOFJoint) SetAngularLimitYRestitution(value gdnative.Real) {
+	o.SetParamY(9, value)
+}
+
+// AngularLimitYSoftness will return the value of the "angular_limit_y/softness" property.
+func (o *Generic6DOFJoint) AngularLimitYSoftness() gdnative.Real {
+	return o.GetParamY(7)
+}
+
+// SetAngularLimitYSoftness will set the value of the "angular_limit_y/softness" property.
+func (o *Generic6DOFJoint) SetAngularLimitYSoftness(value gdnative.Real) {
+	o.SetParamY(7, value)
+}
+
+// AngularLimitYUpperAngle will return the value of the "angular_limit_y/upper_angle" property.
+func (o *Generic6DOFJoint) AngularLimitYUpperAngle() gdnative.Real {
+	return o.X_GetAngularHiLimitY()
+}
+
+// SetAngularLimitYUpperAngle will set the value of the "angular_limit_y/upper_angle" property.
+func (o *Generic6DOFJoint) SetAngularLimitYUpperAngle(value gdnative.Real) {
+	o.X_SetAngularHiLimitY(value)
+}
+
+// AngularLimitZDamping will return the value of the "angular_limit_z/damping" property.
+func (o *Generic6DOFJoint) AngularLimitZDamping() gdnative.Real {
+	return o.GetParamZ(8)
+}
+
+// SetAngularLimitZDamping will set the value of the "angular_limit_z/damping" property.
+func (o *Generic6DOFJoint) SetAngularLimitZDamping(value gdnative.Real) {
+	o.SetParamZ(8, value)
+}
+
+// AngularLimitZEnabled will return the value of the "angular_limit_z/enabled" property.
+func (o *Generic6DOFJoint) AngularLimitZEnabled() gdnative.Bool {
+	return o.GetFlagZ(1)
+}
+
+// SetAngularLimitZEnabled will set the value of the "angular_limit_z/enabled" property.
+func (o *Generic6DOFJoint) SetAngularLimitZEnabled(value gdnative.Bool) {
+	o.SetFlagZ(1, value)
+}
+
+// AngularLimitZErp will return the value of the "angular_limit_z/erp" property.
+func (o *Generic6DOFJoint) AngularLimitZErp() gdnative.Real {
+	return o.GetParamZ(11)
+}
+
+// SetAngularLimitZErp will set the value of the "angular_limit_z/erp" property.
+func (o *Generic6DOFJoint) SetAngularLimitZErp(value gdnative.Real) {
+	o.SetParamZ(11, value)
+}
+
+// AngularLimitZForceLimit will return the value of the "angular_limit_z/force_limit" property.
+func (o *Generic6DOFJoint) AngularLimitZForceLimit() gdnative.Real {
+	return o.GetParamZ(10)
+}
+
+// SetAngularLimitZForceLimit will set the value of the "angular_limit_z/force_limit" property.
+func (o *Generic6DOFJoint) SetAngularLimitZForceLimit(value gdnative.Real) {
+	o.SetParamZ(10, value)
+}
+
+// AngularLimitZLowerAngle will return the value of the "angular_limit_z/lower_angle" property.
+func (o *Generic6DOFJoint) AngularLimitZLowerAngle() gdnative.Real {
+	return o.X_GetAngularLoLimitZ()
+}
+
+// SetAngularLimitZLowerAngle will set the value of the "angular_limit_z/lower_angle" property.
+func (o *Generic6DOFJoint) SetAngularLimitZLowerAngle(value gdnative.Real) {
+	o.X_SetAngularLoLimitZ(value)
+}
+
+// AngularLimitZRestitution will return the value of the "angular_limit_z/restitution" property.
+func (o *Generic6DOFJoint) AngularLimitZRestitution() gdnative.Real {
+	return o.GetParamZ(9)
+}
+
+// SetAngularLimitZRestitution will set the value of the "angular_limit_z/restitution" property.
+func (o *Generic6DOFJoint) SetAngularLimitZRestitution(value gdnative.Real) {
+	o.SetParamZ(9, value)
+}
+
+// AngularLimitZSoftness will return the value of the "angular_limit_z/softness" property.
+func (o *Generic6DOFJoint) AngularLimitZSoftness() gdnative.Real {
+	return o.GetParamZ(7)
+}
+
+// SetAngularLimitZSoftness will set the value of the "angular_limit_z/softness" property.
+func (o *Generic6DOFJoint) SetAngularLimitZSoftness(value gdnative.Real) {
+	o.SetParamZ(7, value)
+}
+
+// AngularLimitZUpperAngle will return the value of the "angular_limit_z/upper_angle" property.
+func (o *Generic6DOFJoint) AngularLimitZUpperAngle() gdnative.Real {
+	return o.X_GetAngularHiLimitZ()
+}
+
+// SetAngularLimitZUpperAngle will set the value of the "angular_limit_z/upper_angle" property.
+func (o *Generic6DOFJoint) SetAngularLimitZUpperAngle(value gdnative.Real) {
+	o.X_SetAngularHiLimitZ(value)
+}
+
+// AngularMotorXEnabled will return the value of the "angular_motor_x/enabled" property.
+func (o *Generic6DOFJoint) AngularMotorXEnabled() gdnative.Bool {
+	return o.GetFlagX(2)
+}
+
+// SetAngularMotorXEnabled will set the value of the "angular_motor_x/enabled" property.
+func (o *Generic6DOFJoint) SetAngularMotorXEnabled(value gdnative.Bool) {
+	o.SetFlagX(2, value)
+}
+
+// AngularMotorXForceLimit will return the value of the "angular_motor_x/force_limit" property.
+func (o *Generic6DOFJoint) AngularMotorXForceLimit() gdnative.Real {
+	return o.GetParamX(13)
+}
+
+// SetAngularMotorXForceLimit will set the value of the "angular_motor_x/force_limit" property.
+func (o *Generic6DOFJoint) SetAngularMotorXForceLimit(value gdnative.Real) {
+	o.SetParamX(13, value)
+}
+
+// AngularMotorXTargetVelocity will return the value of the "angular_motor_x/target_velocity" property.
+func (o *Generic6DOFJoint) AngularMotorXTargetVelocity() gdnative.Real {
+	return o.GetParamX(12)
+}
+
+// SetAngularMotorXTargetVelocity will set the value of the "angular_motor_x/target_velocity" property.
+func (o *Generic6DOFJoint) SetAngularMotorXTargetVelocity(value gdnative.Real) {
+	o.SetParamX(12, value)
+}
+
+// AngularMotorYEnabled will return the value of the "angular_motor_y/enabled" property.
+func (o *Generic6DOFJoint) AngularMotorYEnabled() gdnative.Bool {
+	return o.GetFlagY(2)
+}
+
+// SetAngularMotorYEnabled will set the value of the "angular_motor_y/enabled" property.
+func (o *Generic6DOFJoint) SetAngularMotorYEnabled(value gdnative.Bool) {
+	o.SetFlagY(2, value)
+}
+
+// AngularMotorYForceLimit will return the value of the "angular_motor_y/force_limit" property.
+func (o *Generic6DOFJoint) AngularMotorYForceLimit() gdnative.Real {
+	return o.GetParamY(13)
+}
+
+// SetAngularMotorYForceLimit will set the value of the "angular_motor_y/force_limit" property.
+func (o *Generic6DOFJoint) SetAngularMotorYForceLimit(value gdnative.Real) {
+	o.SetParamY(13, value)
+}
+
+// AngularMotorYTargetVelocity will return the value of the "angular_motor_y/target_velocity" property.
+func (o *Generic6DOFJoint) AngularMotorYTargetVelocity() gdnative.Real {
+	return o.GetParamY(12)
+}
+
+// SetAngularMotorYTargetVelocity will set the value of the "angular_motor_y/target_velocity" property.
+func (o *Generic6DOFJoint) SetAngularMotorYTargetVelocity(value gdnative.Real) {
+	o.SetParamY(12, value)
+}
+
+// AngularMotorZEnabled will return the value of the "angular_motor_z/enabled" property.
+func (o *Generic6DOFJoint) AngularMotorZEnabled() gdnative.Bool {
+	return o.GetFlagZ(2)
+}
+
+// SetAngularMotorZEnabled will set the value of the "angular_motor_z/enabled" property.
+func (o *Generic6DOFJoint) SetAngularMotorZEnabled(value gdnative.Bool) {
+	o.SetFlagZ(2, value)
+}
+
+// AngularMotorZForceLimit will return the value of the "angular_motor_z/force_limit" property.
+func (o *Generic6DOFJoint) AngularMotorZForceLimit() gdnative.Real {
+	return o.GetParamZ(13)
+}
+
+// SetAngularMotorZForceLimit will set the value of the "angular_motor_z/force_limit" property.
+func (o *Generic6DOFJoint) SetAngularMotorZForceLimit(value gdnative.Real) {
+	o.SetParamZ(13, value)
+}
+
+// AngularMotorZTargetVelocity will return the value of the "angular_motor_z/target_velocity" property.
+func (o *Generic6DOFJoint) AngularMotorZTargetVelocity() gdnative.Real {
+	return o.GetParamZ(12)
+}
+
+// SetAngularMotorZTargetVelocity will set the value of the "angular_motor_z/target_velocity" property.
+func (o *Generic6DOFJoint) SetAngularMotorZTargetVelocity(value gdnative.Real) {
+	o.SetParamZ(12, value)
+}
+
+// LinearLimitXDamping will return the value of the "linear_limit_x/damping" property.
+func (o *Generic6DOFJoint) LinearLimitXDamping() gdnative.Real {
+	return o.GetParamX(4)
+}
+
+// SetLinearLimitXDamping will set the value of the "linear_limit_x/damping" property.
+func (o *Generic6DOFJoint) SetLinearLimitXDamping(value gdnative.Real) {
+	o.SetParamX(4, value)
+}
+
+// LinearLimitXEnabled will return the value of the "linear_limit_x/enabled" property.
+func (o *Generic6DOFJoint) LinearLimitXEnabled() gdnative.Bool {
+	return o.GetFlagX(0)
+}
+
+// SetLinearLimitXEnabled will set the value of the "linear_limit_x/enabled" property.
+func (o *Generic6DOFJoint) SetLinearLimitXEnabled(value gdnative.Bool) {
+	o.SetFlagX(0, value)
+}
+
+// LinearLimitXLowerDistance will return the value of the "linear_limit_x/lower_distance" property.
+func (o *Generic6DOFJoint) LinearLimitXLowerDistance() gdnative.Real {
+	return o.GetParamX(0)
+}
+
+// SetLinearLimitXLowerDistance will set the value of the "linear_limit_x/lower_distance" property.
+func (o *Generic6DOFJoint) SetLinearLimitXLowerDistance(value gdnative.Real) {
+	o.SetParamX(0, value)
+}
+
+// LinearLimitXRestitution will return the value of the "linear_limit_x/restitution" property.
+func (o *Generic6DOFJoint) LinearLimitXRestitution() gdnative.Real {
+	return o.GetParamX(3)
+}
+
+// SetLinearLimitXRestitution will set the value of the "linear_limit_x/restitution" property.
+func (o *Generic6DOFJoint) SetLinearLimitXRestitution(value gdnative.Real) {
+	o.SetParamX(3, value)
+}
+
+// LinearLimitXSoftness will return the value of the "linear_limit_x/softness" property.
+func (o *Generic6DOFJoint) LinearLimitXSoftness() gdnative.Real {
+	return o.GetParamX(2)
+}
+
+// SetLinearLimitXSoftness will set the value of the "linear_limit_x/softness" property.
+func (o *Generic6DOFJoint) SetLinearLimitXSoftness(value gdnative.Real) {
+	o.SetParamX(2, value)
+}
+
+// LinearLimitXUpperDistance will return the value of the "linear_limit_x/upper_distance" property.
+func (o *Generic6DOFJoint) LinearLimitXUpperDistance() gdnative.Real {
+	return o.GetParamX(1)
+}
+
+// SetLinearLimitXUpperDistance will set the value of the "linear_limit_x/upper_distance" property.
+func (o *Generic6DOFJoint) SetLinearLimitXUpperDistance(value gdnative.Real) {
+	o.SetParamX(1, value)
+}
+
+// LinearLimitYDamping will return the value of the "linear_limit_y/damping" property.
+func (o *Generic6DOFJoint) LinearLimitYDamping() gdnative.Real {
+	return o.GetParamY(4)
+}
+
+// SetLinearLimitYDamping will set the value of the "linear_limit_y/damping" property.
+func (o *Generic6DOFJoint) SetLinearLimitYDamping(value gdnative.Real) {
+	o.SetParamY(4, value)
+}
+
+// LinearLimitYEnabled will return the value of the "linear_limit_y/enabled" property.
+func (o *Generic6DOFJoint) LinearLimitYEnabled() gdnative.Bool {
+	return o.GetFlagY(0)
+}
+
+// SetLinearLimitYEnabled will set the value of the "linear_limit_y/enabled" property.
+func (o *Generic6DOFJoint) SetLinearLimitYEnabled(value gdnative.Bool) {
+	o.SetFlagY(0, value)
+}
+
+// LinearLimitYLowerDistance will return the value of the "linear_limit_y/lower_distance" property.
+func (o *Generic6DOFJoint) LinearLimitYLowerDistance() gdnative.Real {
+	return o.GetParamY(0)
+}
+
+// SetLinearLimitYLowerDistance will set the value of the "linear_limit_y/lower_distance" property.
+func (o *Generic6DOFJoint) SetLinearLimitYLowerDistance(value gdnative.Real) {
+	o.SetParamY(0, value)
+}
+
+// LinearLimitYRestitution will return the value of the "linear_limit_y/restitution" property.
+func (o *Generic6DOFJoint) LinearLimitYRestitution() gdnative.Real {
+	return o.GetParamY(3)
+}
+
+// SetLinearLimitYRestitution will set the value of the "linear_limit_y/restitution" property.
+func (o *Generic6DOFJoint) SetLinearLimitYRestitution(value gdnative.Real) {
+	o.SetParamY(3, value)
+}
+
+// LinearLimitYSoftness will return the value of the "linear_limit_y/softness" property.
+func (o *Generic6DOFJoint) LinearLimitYSoftness() gdnative.Real {
+	return o.GetParamY(2)
+}
+
+// SetLinearLimitYSoftness will set the value of the "linear_limit_y/softness" property.
+func (o *Generic6DOFJoint) SetLinearLimitYSoftness(value gdnative.Real) {
+	o.SetParamY(2, value)
+}
+
+// LinearLimitYUpperDistance will return the value of the "linear_limit_y/upper_distance" property.
+func (o *Generic6DOFJoint) LinearLimitYUpperDistance() gdnative.Real {
+	return o.GetParamY(1)
+}
+
+// SetLinearLimitYUpperDistance will set the value of the "linear_limit_y/upper_distance" property.
+func (o *Generic6DOFJoint) SetLinearLimitYUpperDistance(value gdnative.Real) {
+	o.SetParamY(1, value)
+}
+
+// LinearLimitZDamping will return the value of the "linear_limit_z/damping" property.
+func (o *Generic6DOFJoint) LinearLimitZDamping() gdnative.Real {
+	return o.GetParamZ(4)
+}
+
+// SetLinearLimitZDamping will set the value of the "linear_limit_z/damping" property.
+func (o *Generic6DOFJoint) SetLinearLimitZDamping(value gdnative.Real) {
+	o.SetParamZ(4, value)
+}
+
+// LinearLimitZEnabled will return the value of the "linear_limit_z/enabled" property.
+func (o *Generic6DOFJoint) LinearLimitZEnabled() gdnative.Bool {
+	return o.GetFlagZ(0)
+}
+
+// SetLinearLimitZEnabled will set the value of the "linear_limit_z/enabled" property.
+func (o *Generic6DOFJoint) SetLinearLimitZEnabled(value gdnative.Bool) {
+	o.SetFlagZ(0, value)
+}
+
+// LinearLimitZLowerDistance will return the value of the "linear_limit_z/lower_distance" property.
+func (o *Generic6DOFJoint) LinearLimitZLowerDistance() gdnative.Real {
+	return o.GetParamZ(0)
+}
+
+// SetLinearLimitZLowerDistance will set the value of the "linear_limit_z/lower_distance" property.
+func (o *Generic6DOFJoint) SetLinearLimitZLowerDistance(value gdnative.Real) {
+	o.SetParamZ(0, value)
+}
+
+// LinearLimitZRestitution will return the value of the "linear_limit_z/restitution" property.
+func (o *Generic6DOFJoint) LinearLimitZRestitution() gdnative.Real {
+	return o.GetParamZ(3)
+}
+
+// SetLinearLimitZRestitution will set the value of the "linear_limit_z/restitution" property.
+func (o *Generic6DOFJoint) SetLinearLimitZRestitution(value gdnative.Real) {
+	o.SetParamZ(3, value)
+}
+
+// LinearLimitZSoftness will return the value of the "linear_limit_z/softness" property.
+func (o *Generic6DOFJoint) LinearLimitZSoftness() gdnative.Real {
+	return o.GetParamZ(2)
+}
+
+// SetLinearLimitZSoftness will set the value of the "linear_limit_z/softness" property.
+func (o *Generic6DOFJoint) SetLinearLimitZSoftness(value gdnative.Real) {
+	o.SetParamZ(2, value)
+}
+
+// LinearLimitZUpperDistance will return the value of the "linear_limit_z/upper_distance" property.
+func (o *Generic6DOFJoint) LinearLimitZUpperDistance() gdnative.Real {
+	return o.GetParamZ(1)
+}
+
+// SetLinearLimitZUpperDistance will set the value of the "linear_limit_z/upper_distance" property.
+func (o *Generic6DOFJoint) SetLinearLimitZUpperDistance(value gdnative.Real) {
+	o.SetParamZ(1, value)
+}
+
+// Generic6DOFJointImplementer is an interface that implements the methods
+// of the Generic6DOFJoint class.
+type Generic6DOFJointImplementer interface {
+	JointImplementer
+	X_GetAngularHiLimitX() gdnative.Real
+	X_GetAngularHiLimitY() gdnative.Real
+	X_GetAngularHiLimitZ() gdnative.Real
+	X_GetAngularLoLimitX() gdnative.Real
+	X_GetAngularLoLimitY() gdnative.Real
+	X_GetAngularLoLimitZ() gdnative.Real
+	X_SetAngularHiLimitX(angle gdnative.Real)
+	X_SetAngularHiLimitY(angle gdnative.Real)
+	X_SetAngularHiLimitZ(angle gdnative.Real)
+	X_SetAngularLoLimitX(angle gdnative.Real)
+	X_SetAngularLoLimitY(angle gdnative.Real)
+	X_SetAngularLoLimitZ(angle gdnative.Real)
+	GetFlagX(flag Generic6DOFJointFlag) gdnative.Bool
+	GetFlagY(flag Generic6DOFJointFlag) gdnative.Bool
+	GetFlagZ(flag Generic6DOFJointFlag) gdnative.Bool
+	GetParamX(param Generic6DOFJointParam) gdnative.Real
+	GetParamY(param Generic6DOFJointParam) gdnative.Real
+	GetParamZ(param Generic6DOFJointParam) gdnative.Real
+	SetFlagX(flag Generic6DOFJointFlag, value gdnative.Bool)
+	SetFlagY(flag Generic6DOFJointFlag, value gdnative.Bool)
+	SetFlagZ(flag Generic6DOFJointFlag, value gdnative.Bool)
+	SetParamX(param Generic6DOFJointParam, value gdnative.Real)
+	SetParamY(param Generic6DOFJointParam, value gdnative.Real)
+	SetParamZ(param Generic6DOFJointParam, value gdnative.Real)
+	AngularLimitXDamping() gdnative.Real
+	SetAngularLimitXDamping(value gdnative.Real)
+	AngularLimitXEnabled() gdnative.Bool
+	SetAngularLimitXEnabled(value gdnative.Bool)
+	AngularLimitXErp() gdnative.Real
+	SetAngularLimitXErp(value gdnative.Real)
+	AngularLimitXForceLimit() gdnative.Real
+	SetAngularLimitXForceLimit(value gdnative.Real)
+	AngularLimitXLowerAngle() gdnative.Real
+	SetAngularLimitXLowerAngle(value gdnative.Real)
+	AngularLimitXRestitution() gdnative.Real
+	SetAngularLimitXRestitution(value gdnative.Real)
+	AngularLimitXSoftness() gdnative.Real
+	SetAngularLimitXSoftness(value gdnative.Real)
+	AngularLimitXUpperAngle() gdnative.Real
+	SetAngularLimitXUpperAngle(value gdnative.Real)
+	AngularLimitYDamping() gdnative.Real
+	SetAngularLimitYDamping(value gdnative.Real)
+	AngularLimitYEnabled() gdnative.Bool
+	SetAngularLimitYEnabled(value gdnative.Bool)
+	AngularLimitYErp() gdnative.Real
+	SetAngularLimitYErp(value gdnative.Real)
+	AngularLimitYForceLimit() gdnative.Real
+	SetAngularLimitYForceLimit(value gdnative.Real)
+	AngularLimitYLowerAngle() gdnative.Real
+	SetAngularLimitYLowerAngle(value gdnative.Real)
+	AngularLimitYRestitution() gdnative.Real
+	SetAngularLimitYRestitution(value gdnative.Real)
+	AngularLimitYSoftness() gdnative.Real
+	SetAngularLimitYSoftness(value gdnative.Real)
+	AngularLimitYUpperAngle() gdnative.Real
+	SetAngularLimitYUpperAngle(value gdnative.Real)
+	AngularLimitZDamping() gdnative.Real
+	SetAngularLimitZDamping(value gdnative.Real)
+	AngularLimitZEnabled() gdnative.Bool
+	SetAngularLimitZEnabled(value gdnative.Bool)
+	AngularLimitZErp() gdnative.Real
+	SetAngularLimitZErp(value gdnative.Real)
+	AngularLimitZForceLimit() gdnative.Real
+	SetAngularLimitZForceLimit(value gdnative.Real)
+	AngularLimitZLowerAngle() gdnative.Real
+	SetAngularLimitZLowerAngle(value gdnative.Real)
+	AngularLimitZRestitution() gdnative.Real
+	SetAngularLimitZRestitution(value gdnative.Real)
+	AngularLimitZSoftness() gdnative.Real
+	SetAngularLimitZSoftness(value gdnative.Real)
+	AngularLimitZUpperAngle() gdnative.Real
+	SetAngularLimitZUpperAngle(value gdnative.Real)
+	AngularMotorXEnabled() gdnative.Bool
+	SetAngularMotorXEnabled(value gdnative.Bool)
+	AngularMotorXForceLimit() gdnative.Real
+	SetAngularMotorXForceLimit(value gdnative.Real)
+	AngularMotorXTargetVelocity() gdnative.Real
+	SetAngularMotorXTargetVelocity(value gdnative.Real)
+	AngularMotorYEnabled() gdnative.Bool
+	SetAngularMotorYEnabled(value gdnative.Bool)
+	AngularMotorYForceLimit() gdnative.Real
+	SetAngularMotorYForceLimit(value gdnative.Real)
+	AngularMotorYTargetVelocity() gdnative.Real
+	SetAngularMotorYTargetVelocity(value gdnative.Real)
+	AngularMotorZEnabled() gdnative.Bool
+	SetAngularMotorZEnabled(value gdnative.Bool)
+	AngularMotorZForceLimit() gdnative.Real
+	SetAngularMotorZForceLimit(value gdnative.Real)
+	AngularMotorZTargetVelocity() gdnative.Real
+	SetAngularMotorZTargetVelocity(value gdnative.Real)
+	LinearLimitXDamping() gdnative.Real
+	SetLinearLimitXDamping(value gdnative.Real)
+	LinearLimitXEnabled() gdnative.Bool
+	SetLinearLimitXEnabled(value gdnative.Bool)
+	LinearLimitXLowerDistance() gdnative.Real
+	SetLinearLimitXLowerDistance(value gdnative.Real)
+	LinearLimitXRestitution() gdnative.Real
+	SetLinearLimitXRestitution(value gdnative.Real)
+	LinearLimitXSoftness() gdnative.Real
+	SetLinearLimitXSoftness(value gdnative.Real)
+	LinearLimitXUpperDistance() gdnative.Real
+	SetLinearLimitXUpperDistance(value gdnative.Real)
+	LinearLimitYDamping() gdnative.Real
+	SetLinearLimitYDamping(value gdnative.Real)
+	LinearLimitYEnabled() gdnative.Bool
+	SetLinearLimitYEnabled(value gdnative.Bool)
+	LinearLimitYLowerDistance() gdnative.Real
+	SetLinearLimitYLowerDistance(value gdnative.Real)
+	LinearLimitYRestitution() gdnative.Real
+	SetLinearLimitYRestitution(value gdnative.Real)
+	LinearLimitYSoftness() gdnative.Real
+	SetLinearLimitYSoftness(value gdnative.Real)
+	LinearLimitYUpperDistance() gdnative.Real
+	SetLinearLimitYUpperDistance(value gdnative.Real)
+	LinearLimitZDamping() gdnative.Real
+	SetLinearLimitZDamping(value gdnative.Real)
+	LinearLimitZEnabled() gdnative.Bool
+	SetLinearLimitZEnabled(value gdnative.Bool)
+	LinearLimitZLowerDistance() gdnative.Real
+	SetLinearLimitZLowerDistance(value gdnative.Real)
+	LinearLimitZRestitution() gdnative.Real
+	SetLinearLimitZRestitution(value gdnative.Real)
+	LinearLimitZSoftness() gdnative.Real
+	SetLinearLimitZSoftness(value gdnative.Real)
+	LinearLimitZUpperDistance() gdnative.Real
+	SetLinearLimitZUpperDistance(value gdnative.Real)
+}
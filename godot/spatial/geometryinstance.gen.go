@@ -1,7 +1,10 @@
-package godot
+package spatial
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,6 +24,28 @@ const (
 	GeometryInstanceFlagUseBakedLight GeometryInstanceFlags = 0
 )
 
+// String will return the Godot name of the GeometryInstanceFlags value.
+func (e GeometryInstanceFlags) String() string {
+	switch e {
+	case GeometryInstanceFlagMax:
+		return "FLAG_MAX"
+	case GeometryInstanceFlagUseBakedLight:
+		return "FLAG_USE_BAKED_LIGHT"
+	}
+	return fmt.Sprintf("GeometryInstanceFlags(%d)", int(e))
+}
+
+// ParseGeometryInstanceFlags will return the GeometryInstanceFlags value with the given Godot name.
+func ParseGeometryInstanceFlags(name string) (GeometryInstanceFlags, error) {
+	switch name {
+	case "FLAG_MAX":
+		return GeometryInstanceFlagMax, nil
+	case "FLAG_USE_BAKED_LIGHT":
+		return GeometryInstanceFlagUseBakedLight, nil
+	}
+	return 0, fmt.Errorf("invalid GeometryInstanceFlags value %q", name)
+}
+
 // GeometryInstanceShadowCastingSetting is an enum for ShadowCastingSetting values.
 type GeometryInstanceShadowCastingSetting int
 
@@ -31,13 +56,34 @@ const (
 	GeometryInstanceShadowCastingSettingShadowsOnly GeometryInstanceShadowCastingSetting = 3
 )
 
-//func NewGeometryInstanceFromPointer(ptr gdnative.Pointer) GeometryInstance {
-func newGeometryInstanceFromPointer(ptr gdnative.Pointer) GeometryInstance {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GeometryInstance{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the GeometryInstanceShadowCastingSetting value.
+func (e GeometryInstanceShadowCastingSetting) String() string {
+	switch e {
+	case GeometryInstanceShadowCastingSettingDoubleSided:
+		return "SHADOW_CASTING_SETTING_DOUBLE_SIDED"
+	case GeometryInstanceShadowCastingSettingOff:
+		return "SHADOW_CASTING_SETTING_OFF"
+	case GeometryInstanceShadowCastingSettingOn:
+		return "SHADOW_CASTING_SETTING_ON"
+	case GeometryInstanceShadowCastingSettingShadowsOnly:
+		return "SHADOW_CASTING_SETTING_SHADOWS_ONLY"
+	}
+	return fmt.Sprintf("GeometryInstanceShadowCastingSetting(%d)", int(e))
+}
 
-	return obj
+// ParseGeometryInstanceShadowCastingSetting will return the GeometryInstanceShadowCastingSetting value with the given Godot name.
+func ParseGeometryInstanceShadowCastingSetting(name string) (GeometryInstanceShadowCastingSetting, error) {
+	switch name {
+	case "SHADOW_CASTING_SETTING_DOUBLE_SIDED":
+		return GeometryInstanceShadowCastingSettingDoubleSided, nil
+	case "SHADOW_CASTING_SETTING_OFF":
+		return GeometryInstanceShadowCastingSettingOff, nil
+	case "SHADOW_CASTING_SETTING_ON":
+		return GeometryInstanceShadowCastingSettingOn, nil
+	case "SHADOW_CASTING_SETTING_SHADOWS_ONLY":
+		return GeometryInstanceShadowCastingSettingShadowsOnly, nil
+	}
+	return 0, fmt.Errorf("invalid GeometryInstanceShadowCastingSetting value %q", name)
 }
 
 /*
@@ -52,9 +98,30 @@ func (o *GeometryInstance) BaseClass() string {
 	return "GeometryInstance"
 }
 
+// Method binds of the GeometryInstance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGeometryInstanceGetCastShadowsSetting = gdnative.NewLazyMethodBind("GeometryInstance", "get_cast_shadows_setting")
+	methodBindGeometryInstanceGetExtraCullMargin    = gdnative.NewLazyMethodBind("GeometryInstance", "get_extra_cull_margin")
+	methodBindGeometryInstanceGetFlag               = gdnative.NewLazyMethodBind("GeometryInstance", "get_flag")
+	methodBindGeometryInstanceGetLodMaxDistance     = gdnative.NewLazyMethodBind("GeometryInstance", "get_lod_max_distance")
+	methodBindGeometryInstanceGetLodMaxHysteresis   = gdnative.NewLazyMethodBind("GeometryInstance", "get_lod_max_hysteresis")
+	methodBindGeometryInstanceGetLodMinDistance     = gdnative.NewLazyMethodBind("GeometryInstance", "get_lod_min_distance")
+	methodBindGeometryInstanceGetLodMinHysteresis   = gdnative.NewLazyMethodBind("GeometryInstance", "get_lod_min_hysteresis")
+	methodBindGeometryInstanceGetMaterialOverride   = gdnative.NewLazyMethodBind("GeometryInstance", "get_material_override")
+	methodBindGeometryInstanceSetCastShadowsSetting = gdnative.NewLazyMethodBind("GeometryInstance", "set_cast_shadows_setting")
+	methodBindGeometryInstanceSetExtraCullMargin    = gdnative.NewLazyMethodBind("GeometryInstance", "set_extra_cull_margin")
+	methodBindGeometryInstanceSetFlag               = gdnative.NewLazyMethodBind("GeometryInstance", "set_flag")
+	methodBindGeometryInstanceSetLodMaxDistance     = gdnative.NewLazyMethodBind("GeometryInstance", "set_lod_max_distance")
+	methodBindGeometryInstanceSetLodMaxHysteresis   = gdnative.NewLazyMethodBind("GeometryInstance", "set_lod_max_hysteresis")
+	methodBindGeometryInstanceSetLodMinDistance     = gdnative.NewLazyMethodBind("GeometryInstance", "set_lod_min_distance")
+	methodBindGeometryInstanceSetLodMinHysteresis   = gdnative.NewLazyMethodBind("GeometryInstance", "set_lod_min_hysteresis")
+	methodBindGeometryInstanceSetMaterialOverride   = gdnative.NewLazyMethodBind("GeometryInstance", "set_material_override")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.GeometryInstance::ShadowCastingSetting
+	        Undocumented
+		Args: [], Returns: enum.GeometryInstance::ShadowCastingSetting
 */
 func (o *GeometryInstance) GetCastShadowsSetting() GeometryInstanceShadowCastingSetting {
 	//log.Println("Calling GeometryInstance.GetCastShadowsSetting()")
@@ -63,7 +130,7 @@ func (o *GeometryInstance) GetCastShadowsSetting() GeometryInstanceShadowCasting
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_cast_shadows_setting")
+	methodBind := methodBindGeometryInstanceGetCastShadowsSetting.Get()
 
 	// Call the parent method.
 	// enum.GeometryInstance::ShadowCastingSetting
@@ -76,8 +143,8 @@ func (o *GeometryInstance) GetCastShadowsSetting() GeometryInstanceShadowCasting
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GeometryInstance) GetExtraCullMargin() gdnative.Real {
 	//log.Println("Calling GeometryInstance.GetExtraCullMargin()")
@@ -86,7 +153,7 @@ func (o *GeometryInstance) GetExtraCullMargin() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_extra_cull_margin")
+	methodBind := methodBindGeometryInstanceGetExtraCullMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -99,8 +166,8 @@ func (o *GeometryInstance) GetExtraCullMargin() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false flag int}], Returns: bool
+	        Undocumented
+		Args: [{ false flag int}], Returns: bool
 */
 func (o *GeometryInstance) GetFlag(flag gdnative.Int) gdnative.Bool {
 	//log.Println("Calling GeometryInstance.GetFlag()")
@@ -110,7 +177,7 @@ func (o *GeometryInstance) GetFlag(flag gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(flag)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_flag")
+	methodBind := methodBindGeometryInstanceGetFlag.Get()
 
 	// Call the parent method.
 	// bool
@@ -123,8 +190,8 @@ func (o *GeometryInstance) GetFlag(flag gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GeometryInstance) GetLodMaxDistance() gdnative.Real {
 	//log.Println("Calling GeometryInstance.GetLodMaxDistance()")
@@ -133,7 +200,7 @@ func (o *GeometryInstance) GetLodMaxDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_lod_max_distance")
+	methodBind := methodBindGeometryInstanceGetLodMaxDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -146,8 +213,8 @@ func (o *GeometryInstance) GetLodMaxDistance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GeometryInstance) GetLodMaxHysteresis() gdnative.Real {
 	//log.Println("Calling GeometryInstance.GetLodMaxHysteresis()")
@@ -156,7 +223,7 @@ func (o *GeometryInstance) GetLodMaxHysteresis() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_lod_max_hysteresis")
+	methodBind := methodBindGeometryInstanceGetLodMaxHysteresis.Get()
 
 	// Call the parent method.
 	// float
@@ -169,8 +236,8 @@ func (o *GeometryInstance) GetLodMaxHysteresis() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GeometryInstance) GetLodMinDistance() gdnative.Real {
 	//log.Println("Calling GeometryInstance.GetLodMinDistance()")
@@ -179,7 +246,7 @@ func (o *GeometryInstance) GetLodMinDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_lod_min_distance")
+	methodBind := methodBindGeometryInstanceGetLodMinDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -192,8 +259,8 @@ func (o *GeometryInstance) GetLodMinDistance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GeometryInstance) GetLodMinHysteresis() gdnative.Real {
 	//log.Println("Calling GeometryInstance.GetLodMinHysteresis()")
@@ -202,7 +269,7 @@ func (o *GeometryInstance) GetLodMinHysteresis() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_lod_min_hysteresis")
+	methodBind := methodBindGeometryInstanceGetLodMinHysteresis.Get()
 
 	// Call the parent method.
 	// float
@@ -215,17 +282,17 @@ func (o *GeometryInstance) GetLodMinHysteresis() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Material
+	        Undocumented
+		Args: [], Returns: Material
 */
-func (o *GeometryInstance) GetMaterialOverride() MaterialImplementer {
+func (o *GeometryInstance) GetMaterialOverride() godot.MaterialImplementer {
 	//log.Println("Calling GeometryInstance.GetMaterialOverride()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "get_material_override")
+	methodBind := methodBindGeometryInstanceGetMaterialOverride.Get()
 
 	// Call the parent method.
 	// Material
@@ -233,37 +300,35 @@ func (o *GeometryInstance) GetMaterialOverride() MaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := godot.Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false shadow_casting_setting int}], Returns: void
+	        Undocumented
+		Args: [{ false shadow_casting_setting enum.GeometryInstance::ShadowCastingSetting}], Returns: void
 */
-func (o *GeometryInstance) SetCastShadowsSetting(shadowCastingSetting gdnative.Int) {
+func (o *GeometryInstance) SetCastShadowsSetting(shadowCastingSetting GeometryInstanceShadowCastingSetting) {
 	//log.Println("Calling GeometryInstance.SetCastShadowsSetting()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(shadowCastingSetting)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(shadowCastingSetting))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_cast_shadows_setting")
+	methodBind := methodBindGeometryInstanceSetCastShadowsSetting.Get()
 
 	// Call the parent method.
 	// void
@@ -273,8 +338,8 @@ func (o *GeometryInstance) SetCastShadowsSetting(shadowCastingSetting gdnative.I
 }
 
 /*
-        Undocumented
-	Args: [{ false margin float}], Returns: void
+	        Undocumented
+		Args: [{ false margin float}], Returns: void
 */
 func (o *GeometryInstance) SetExtraCullMargin(margin gdnative.Real) {
 	//log.Println("Calling GeometryInstance.SetExtraCullMargin()")
@@ -284,7 +349,7 @@ func (o *GeometryInstance) SetExtraCullMargin(margin gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_extra_cull_margin")
+	methodBind := methodBindGeometryInstanceSetExtraCullMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -294,8 +359,8 @@ func (o *GeometryInstance) SetExtraCullMargin(margin gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flag int} { false value bool}], Returns: void
+	        Undocumented
+		Args: [{ false flag int} { false value bool}], Returns: void
 */
 func (o *GeometryInstance) SetFlag(flag gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling GeometryInstance.SetFlag()")
@@ -306,7 +371,7 @@ func (o *GeometryInstance) SetFlag(flag gdnative.Int, value gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_flag")
+	methodBind := methodBindGeometryInstanceSetFlag.Get()
 
 	// Call the parent method.
 	// void
@@ -316,8 +381,8 @@ func (o *GeometryInstance) SetFlag(flag gdnative.Int, value gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode float}], Returns: void
+	        Undocumented
+		Args: [{ false mode float}], Returns: void
 */
 func (o *GeometryInstance) SetLodMaxDistance(mode gdnative.Real) {
 	//log.Println("Calling GeometryInstance.SetLodMaxDistance()")
@@ -327,7 +392,7 @@ func (o *GeometryInstance) SetLodMaxDistance(mode gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_lod_max_distance")
+	methodBind := methodBindGeometryInstanceSetLodMaxDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -337,8 +402,8 @@ func (o *GeometryInstance) SetLodMaxDistance(mode gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode float}], Returns: void
+	        Undocumented
+		Args: [{ false mode float}], Returns: void
 */
 func (o *GeometryInstance) SetLodMaxHysteresis(mode gdnative.Real) {
 	//log.Println("Calling GeometryInstance.SetLodMaxHysteresis()")
@@ -348,7 +413,7 @@ func (o *GeometryInstance) SetLodMaxHysteresis(mode gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_lod_max_hysteresis")
+	methodBind := methodBindGeometryInstanceSetLodMaxHysteresis.Get()
 
 	// Call the parent method.
 	// void
@@ -358,8 +423,8 @@ func (o *GeometryInstance) SetLodMaxHysteresis(mode gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode float}], Returns: void
+	        Undocumented
+		Args: [{ false mode float}], Returns: void
 */
 func (o *GeometryInstance) SetLodMinDistance(mode gdnative.Real) {
 	//log.Println("Calling GeometryInstance.SetLodMinDistance()")
@@ -369,7 +434,7 @@ func (o *GeometryInstance) SetLodMinDistance(mode gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_lod_min_distance")
+	methodBind := methodBindGeometryInstanceSetLodMinDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -379,8 +444,8 @@ func (o *GeometryInstance) SetLodMinDistance(mode gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode float}], Returns: void
+	        Undocumented
+		Args: [{ false mode float}], Returns: void
 */
 func (o *GeometryInstance) SetLodMinHysteresis(mode gdnative.Real) {
 	//log.Println("Calling GeometryInstance.SetLodMinHysteresis()")
@@ -390,7 +455,7 @@ func (o *GeometryInstance) SetLodMinHysteresis(mode gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(mode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_lod_min_hysteresis")
+	methodBind := methodBindGeometryInstanceSetLodMinHysteresis.Get()
 
 	// Call the parent method.
 	// void
@@ -400,18 +465,18 @@ func (o *GeometryInstance) SetLodMinHysteresis(mode gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false material Material}], Returns: void
+	        Undocumented
+		Args: [{ false material Material}], Returns: void
 */
-func (o *GeometryInstance) SetMaterialOverride(material MaterialImplementer) {
+func (o *GeometryInstance) SetMaterialOverride(material godot.MaterialImplementer) {
 	//log.Println("Calling GeometryInstance.SetMaterialOverride()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GeometryInstance", "set_material_override")
+	methodBind := methodBindGeometryInstanceSetMaterialOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -420,23 +485,84 @@ func (o *GeometryInstance) SetMaterialOverride(material MaterialImplementer) {
 
 }
 
+// CastShadow will return the value of the "cast_shadow" property.
+func (o *GeometryInstance) CastShadow() GeometryInstanceShadowCastingSetting {
+	return o.GetCastShadowsSetting()
+}
+
+// SetCastShadow will set the value of the "cast_shadow" property.
+func (o *GeometryInstance) SetCastShadow(value GeometryInstanceShadowCastingSetting) {
+	o.SetCastShadowsSetting(value)
+}
+
+// ExtraCullMargin will return the value of the "extra_cull_margin" property.
+func (o *GeometryInstance) ExtraCullMargin() gdnative.Real {
+	return o.GetExtraCullMargin()
+}
+
+// LodMaxDistance will return the value of the "lod_max_distance" property.
+func (o *GeometryInstance) LodMaxDistance() gdnative.Real {
+	return o.GetLodMaxDistance()
+}
+
+// LodMaxHysteresis will return the value of the "lod_max_hysteresis" property.
+func (o *GeometryInstance) LodMaxHysteresis() gdnative.Real {
+	return o.GetLodMaxHysteresis()
+}
+
+// LodMinDistance will return the value of the "lod_min_distance" property.
+func (o *GeometryInstance) LodMinDistance() gdnative.Real {
+	return o.GetLodMinDistance()
+}
+
+// LodMinHysteresis will return the value of the "lod_min_hysteresis" property.
+func (o *GeometryInstance) LodMinHysteresis() gdnative.Real {
+	return o.GetLodMinHysteresis()
+}
+
+// MaterialOverride will return the value of the "material_override" property.
+func (o *GeometryInstance) MaterialOverride() godot.MaterialImplementer {
+	return o.GetMaterialOverride()
+}
+
+// UseInBakedLight will return the value of the "use_in_baked_light" property.
+func (o *GeometryInstance) UseInBakedLight() gdnative.Bool {
+	return o.GetFlag(0)
+}
+
+// SetUseInBakedLight will set the value of the "use_in_baked_light" property.
+func (o *GeometryInstance) SetUseInBakedLight(value gdnative.Bool) {
+	o.SetFlag(0, value)
+}
+
 // GeometryInstanceImplementer is an interface that implements the methods
 // of the GeometryInstance class.
 type GeometryInstanceImplementer interface {
 	VisualInstanceImplementer
+	GetCastShadowsSetting() GeometryInstanceShadowCastingSetting
 	GetExtraCullMargin() gdnative.Real
 	GetFlag(flag gdnative.Int) gdnative.Bool
 	GetLodMaxDistance() gdnative.Real
 	GetLodMaxHysteresis() gdnative.Real
 	GetLodMinDistance() gdnative.Real
 	GetLodMinHysteresis() gdnative.Real
-	GetMaterialOverride() MaterialImplementer
-	SetCastShadowsSetting(shadowCastingSetting gdnative.Int)
+	GetMaterialOverride() godot.MaterialImplementer
+	SetCastShadowsSetting(shadowCastingSetting GeometryInstanceShadowCastingSetting)
 	SetExtraCullMargin(margin gdnative.Real)
 	SetFlag(flag gdnative.Int, value gdnative.Bool)
 	SetLodMaxDistance(mode gdnative.Real)
 	SetLodMaxHysteresis(mode gdnative.Real)
 	SetLodMinDistance(mode gdnative.Real)
 	SetLodMinHysteresis(mode gdnative.Real)
-	SetMaterialOverride(material MaterialImplementer)
+	SetMaterialOverride(material godot.MaterialImplementer)
+	CastShadow() GeometryInstanceShadowCastingSetting
+	SetCastShadow(value GeometryInstanceShadowCastingSetting)
+	ExtraCullMargin() gdnative.Real
+	LodMaxDistance() gdnative.Real
+	LodMaxHysteresis() gdnative.Real
+	LodMinDistance() gdnative.Real
+	LodMinHysteresis() gdnative.Real
+	MaterialOverride() godot.MaterialImplementer
+	UseInBakedLight() gdnative.Bool
+	SetUseInBakedLight(value gdnative.Bool)
 }
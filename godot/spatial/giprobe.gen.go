@@ -1,7 +1,10 @@
-package godot
+package spatial
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -24,41 +27,105 @@ const (
 	GIProbeSubdivMax GIProbeSubdiv = 4
 )
 
-//func NewGIProbeFromPointer(ptr gdnative.Pointer) GIProbe {
-func newGIProbeFromPointer(ptr gdnative.Pointer) GIProbe {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GIProbe{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the GIProbeSubdiv value.
+func (e GIProbeSubdiv) String() string {
+	switch e {
+	case GIProbeSubdiv128:
+		return "SUBDIV_128"
+	case GIProbeSubdiv256:
+		return "SUBDIV_256"
+	case GIProbeSubdiv512:
+		return "SUBDIV_512"
+	case GIProbeSubdiv64:
+		return "SUBDIV_64"
+	case GIProbeSubdivMax:
+		return "SUBDIV_MAX"
+	}
+	return fmt.Sprintf("GIProbeSubdiv(%d)", int(e))
+}
 
-	return obj
+// ParseGIProbeSubdiv will return the GIProbeSubdiv value with the given Godot name.
+func ParseGIProbeSubdiv(name string) (GIProbeSubdiv, error) {
+	switch name {
+	case "SUBDIV_128":
+		return GIProbeSubdiv128, nil
+	case "SUBDIV_256":
+		return GIProbeSubdiv256, nil
+	case "SUBDIV_512":
+		return GIProbeSubdiv512, nil
+	case "SUBDIV_64":
+		return GIProbeSubdiv64, nil
+	case "SUBDIV_MAX":
+		return GIProbeSubdivMax, nil
+	}
+	return 0, fmt.Errorf("invalid GIProbeSubdiv value %q", name)
 }
 
 /*
-
  */
 type GIProbe struct {
 	VisualInstance
 	owner gdnative.Object
 }
 
+// classConstructorGIProbe is the class constructor of the GIProbe class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGIProbe = gdnative.NewLazyClassConstructor("GIProbe")
+
+// NewGIProbe will create a new instance of the GIProbe class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewGIProbe() *GIProbe {
+	obj := &GIProbe{}
+	obj.SetBaseObject(classConstructorGIProbe.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GIProbe) BaseClass() string {
 	return "GIProbe"
 }
 
-/*
+// Method binds of the GIProbe class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGIProbeBake            = gdnative.NewLazyMethodBind("GIProbe", "bake")
+	methodBindGIProbeDebugBake       = gdnative.NewLazyMethodBind("GIProbe", "debug_bake")
+	methodBindGIProbeGetBias         = gdnative.NewLazyMethodBind("GIProbe", "get_bias")
+	methodBindGIProbeGetDynamicRange = gdnative.NewLazyMethodBind("GIProbe", "get_dynamic_range")
+	methodBindGIProbeGetEnergy       = gdnative.NewLazyMethodBind("GIProbe", "get_energy")
+	methodBindGIProbeGetExtents      = gdnative.NewLazyMethodBind("GIProbe", "get_extents")
+	methodBindGIProbeGetNormalBias   = gdnative.NewLazyMethodBind("GIProbe", "get_normal_bias")
+	methodBindGIProbeGetProbeData    = gdnative.NewLazyMethodBind("GIProbe", "get_probe_data")
+	methodBindGIProbeGetPropagation  = gdnative.NewLazyMethodBind("GIProbe", "get_propagation")
+	methodBindGIProbeGetSubdiv       = gdnative.NewLazyMethodBind("GIProbe", "get_subdiv")
+	methodBindGIProbeIsCompressed    = gdnative.NewLazyMethodBind("GIProbe", "is_compressed")
+	methodBindGIProbeIsInterior      = gdnative.NewLazyMethodBind("GIProbe", "is_interior")
+	methodBindGIProbeSetBias         = gdnative.NewLazyMethodBind("GIProbe", "set_bias")
+	methodBindGIProbeSetCompress     = gdnative.NewLazyMethodBind("GIProbe", "set_compress")
+	methodBindGIProbeSetDynamicRange = gdnative.NewLazyMethodBind("GIProbe", "set_dynamic_range")
+	methodBindGIProbeSetEnergy       = gdnative.NewLazyMethodBind("GIProbe", "set_energy")
+	methodBindGIProbeSetExtents      = gdnative.NewLazyMethodBind("GIProbe", "set_extents")
+	methodBindGIProbeSetInterior     = gdnative.NewLazyMethodBind("GIProbe", "set_interior")
+	methodBindGIProbeSetNormalBias   = gdnative.NewLazyMethodBind("GIProbe", "set_normal_bias")
+	methodBindGIProbeSetProbeData    = gdnative.NewLazyMethodBind("GIProbe", "set_probe_data")
+	methodBindGIProbeSetPropagation  = gdnative.NewLazyMethodBind("GIProbe", "set_propagation")
+	methodBindGIProbeSetSubdiv       = gdnative.NewLazyMethodBind("GIProbe", "set_subdiv")
+)
 
-	Args: [{Null true from_node Object} {False true create_visual_debug bool}], Returns: void
+/*
+Args: [{Null true from_node Object} {False true create_visual_debug bool}], Returns: void
 */
-func (o *GIProbe) Bake(fromNode ObjectImplementer, createVisualDebug gdnative.Bool) {
+func (o *GIProbe) Bake(fromNode godot.ObjectImplementer, createVisualDebug gdnative.Bool) {
 	//log.Println("Calling GIProbe.Bake()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
-	ptrArguments[0] = gdnative.NewPointerFromObject(fromNode.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(fromNode))
 	ptrArguments[1] = gdnative.NewPointerFromBool(createVisualDebug)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "bake")
+	methodBind := methodBindGIProbeBake.Get()
 
 	// Call the parent method.
 	// void
@@ -68,8 +135,7 @@ func (o *GIProbe) Bake(fromNode ObjectImplementer, createVisualDebug gdnative.Bo
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *GIProbe) DebugBake() {
 	//log.Println("Calling GIProbe.DebugBake()")
@@ -78,7 +144,7 @@ func (o *GIProbe) DebugBake() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "debug_bake")
+	methodBind := methodBindGIProbeDebugBake.Get()
 
 	// Call the parent method.
 	// void
@@ -88,8 +154,8 @@ func (o *GIProbe) DebugBake() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbe) GetBias() gdnative.Real {
 	//log.Println("Calling GIProbe.GetBias()")
@@ -98,7 +164,7 @@ func (o *GIProbe) GetBias() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_bias")
+	methodBind := methodBindGIProbeGetBias.Get()
 
 	// Call the parent method.
 	// float
@@ -111,8 +177,8 @@ func (o *GIProbe) GetBias() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *GIProbe) GetDynamicRange() gdnative.Int {
 	//log.Println("Calling GIProbe.GetDynamicRange()")
@@ -121,7 +187,7 @@ func (o *GIProbe) GetDynamicRange() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_dynamic_range")
+	methodBind := methodBindGIProbeGetDynamicRange.Get()
 
 	// Call the parent method.
 	// int
@@ -134,8 +200,8 @@ func (o *GIProbe) GetDynamicRange() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbe) GetEnergy() gdnative.Real {
 	//log.Println("Calling GIProbe.GetEnergy()")
@@ -144,7 +210,7 @@ func (o *GIProbe) GetEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_energy")
+	methodBind := methodBindGIProbeGetEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -157,8 +223,8 @@ func (o *GIProbe) GetEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *GIProbe) GetExtents() gdnative.Vector3 {
 	//log.Println("Calling GIProbe.GetExtents()")
@@ -167,7 +233,7 @@ func (o *GIProbe) GetExtents() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_extents")
+	methodBind := methodBindGIProbeGetExtents.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -180,8 +246,8 @@ func (o *GIProbe) GetExtents() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbe) GetNormalBias() gdnative.Real {
 	//log.Println("Calling GIProbe.GetNormalBias()")
@@ -190,7 +256,7 @@ func (o *GIProbe) GetNormalBias() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_normal_bias")
+	methodBind := methodBindGIProbeGetNormalBias.Get()
 
 	// Call the parent method.
 	// float
@@ -203,17 +269,17 @@ func (o *GIProbe) GetNormalBias() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: GIProbeData
+	        Undocumented
+		Args: [], Returns: GIProbeData
 */
-func (o *GIProbe) GetProbeData() GIProbeDataImplementer {
+func (o *GIProbe) GetProbeData() godot.GIProbeDataImplementer {
 	//log.Println("Calling GIProbe.GetProbeData()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_probe_data")
+	methodBind := methodBindGIProbeGetProbeData.Get()
 
 	// Call the parent method.
 	// GIProbeData
@@ -221,27 +287,25 @@ func (o *GIProbe) GetProbeData() GIProbeDataImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newGIProbeDataFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(GIProbeDataImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "GIProbeData" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(GIProbeDataImplementer)
+	ret := godot.GIProbeData{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.GIProbeDataImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GIProbe) GetPropagation() gdnative.Real {
 	//log.Println("Calling GIProbe.GetPropagation()")
@@ -250,7 +314,7 @@ func (o *GIProbe) GetPropagation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_propagation")
+	methodBind := methodBindGIProbeGetPropagation.Get()
 
 	// Call the parent method.
 	// float
@@ -263,8 +327,8 @@ func (o *GIProbe) GetPropagation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.GIProbe::Subdiv
+	        Undocumented
+		Args: [], Returns: enum.GIProbe::Subdiv
 */
 func (o *GIProbe) GetSubdiv() GIProbeSubdiv {
 	//log.Println("Calling GIProbe.GetSubdiv()")
@@ -273,7 +337,7 @@ func (o *GIProbe) GetSubdiv() GIProbeSubdiv {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "get_subdiv")
+	methodBind := methodBindGIProbeGetSubdiv.Get()
 
 	// Call the parent method.
 	// enum.GIProbe::Subdiv
@@ -286,8 +350,8 @@ func (o *GIProbe) GetSubdiv() GIProbeSubdiv {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GIProbe) IsCompressed() gdnative.Bool {
 	//log.Println("Calling GIProbe.IsCompressed()")
@@ -296,7 +360,7 @@ func (o *GIProbe) IsCompressed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "is_compressed")
+	methodBind := methodBindGIProbeIsCompressed.Get()
 
 	// Call the parent method.
 	// bool
@@ -309,8 +373,8 @@ func (o *GIProbe) IsCompressed() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GIProbe) IsInterior() gdnative.Bool {
 	//log.Println("Calling GIProbe.IsInterior()")
@@ -319,7 +383,7 @@ func (o *GIProbe) IsInterior() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "is_interior")
+	methodBind := methodBindGIProbeIsInterior.Get()
 
 	// Call the parent method.
 	// bool
@@ -332,8 +396,8 @@ func (o *GIProbe) IsInterior() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false max float}], Returns: void
+	        Undocumented
+		Args: [{ false max float}], Returns: void
 */
 func (o *GIProbe) SetBias(max gdnative.Real) {
 	//log.Println("Calling GIProbe.SetBias()")
@@ -343,7 +407,7 @@ func (o *GIProbe) SetBias(max gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(max)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_bias")
+	methodBind := methodBindGIProbeSetBias.Get()
 
 	// Call the parent method.
 	// void
@@ -353,8 +417,8 @@ func (o *GIProbe) SetBias(max gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *GIProbe) SetCompress(enable gdnative.Bool) {
 	//log.Println("Calling GIProbe.SetCompress()")
@@ -364,7 +428,7 @@ func (o *GIProbe) SetCompress(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_compress")
+	methodBind := methodBindGIProbeSetCompress.Get()
 
 	// Call the parent method.
 	// void
@@ -374,8 +438,8 @@ func (o *GIProbe) SetCompress(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false max int}], Returns: void
+	        Undocumented
+		Args: [{ false max int}], Returns: void
 */
 func (o *GIProbe) SetDynamicRange(max gdnative.Int) {
 	//log.Println("Calling GIProbe.SetDynamicRange()")
@@ -385,7 +449,7 @@ func (o *GIProbe) SetDynamicRange(max gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(max)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_dynamic_range")
+	methodBind := methodBindGIProbeSetDynamicRange.Get()
 
 	// Call the parent method.
 	// void
@@ -395,8 +459,8 @@ func (o *GIProbe) SetDynamicRange(max gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false max float}], Returns: void
+	        Undocumented
+		Args: [{ false max float}], Returns: void
 */
 func (o *GIProbe) SetEnergy(max gdnative.Real) {
 	//log.Println("Calling GIProbe.SetEnergy()")
@@ -406,7 +470,7 @@ func (o *GIProbe) SetEnergy(max gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(max)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_energy")
+	methodBind := methodBindGIProbeSetEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -416,8 +480,8 @@ func (o *GIProbe) SetEnergy(max gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false extents Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false extents Vector3}], Returns: void
 */
 func (o *GIProbe) SetExtents(extents gdnative.Vector3) {
 	//log.Println("Calling GIProbe.SetExtents()")
@@ -427,7 +491,7 @@ func (o *GIProbe) SetExtents(extents gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(extents)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_extents")
+	methodBind := methodBindGIProbeSetExtents.Get()
 
 	// Call the parent method.
 	// void
@@ -437,8 +501,8 @@ func (o *GIProbe) SetExtents(extents gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *GIProbe) SetInterior(enable gdnative.Bool) {
 	//log.Println("Calling GIProbe.SetInterior()")
@@ -448,7 +512,7 @@ func (o *GIProbe) SetInterior(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_interior")
+	methodBind := methodBindGIProbeSetInterior.Get()
 
 	// Call the parent method.
 	// void
@@ -458,8 +522,8 @@ func (o *GIProbe) SetInterior(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false max float}], Returns: void
+	        Undocumented
+		Args: [{ false max float}], Returns: void
 */
 func (o *GIProbe) SetNormalBias(max gdnative.Real) {
 	//log.Println("Calling GIProbe.SetNormalBias()")
@@ -469,7 +533,7 @@ func (o *GIProbe) SetNormalBias(max gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(max)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_normal_bias")
+	methodBind := methodBindGIProbeSetNormalBias.Get()
 
 	// Call the parent method.
 	// void
@@ -479,18 +543,18 @@ func (o *GIProbe) SetNormalBias(max gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false data GIProbeData}], Returns: void
+	        Undocumented
+		Args: [{ false data GIProbeData}], Returns: void
 */
-func (o *GIProbe) SetProbeData(data GIProbeDataImplementer) {
+func (o *GIProbe) SetProbeData(data godot.GIProbeDataImplementer) {
 	//log.Println("Calling GIProbe.SetProbeData()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(data.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(data))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_probe_data")
+	methodBind := methodBindGIProbeSetProbeData.Get()
 
 	// Call the parent method.
 	// void
@@ -500,8 +564,8 @@ func (o *GIProbe) SetProbeData(data GIProbeDataImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false max float}], Returns: void
+	        Undocumented
+		Args: [{ false max float}], Returns: void
 */
 func (o *GIProbe) SetPropagation(max gdnative.Real) {
 	//log.Println("Calling GIProbe.SetPropagation()")
@@ -511,7 +575,7 @@ func (o *GIProbe) SetPropagation(max gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(max)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_propagation")
+	methodBind := methodBindGIProbeSetPropagation.Get()
 
 	// Call the parent method.
 	// void
@@ -521,18 +585,18 @@ func (o *GIProbe) SetPropagation(max gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false subdiv int}], Returns: void
+	        Undocumented
+		Args: [{ false subdiv enum.GIProbe::Subdiv}], Returns: void
 */
-func (o *GIProbe) SetSubdiv(subdiv gdnative.Int) {
+func (o *GIProbe) SetSubdiv(subdiv GIProbeSubdiv) {
 	//log.Println("Calling GIProbe.SetSubdiv()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(subdiv)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(subdiv))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GIProbe", "set_subdiv")
+	methodBind := methodBindGIProbeSetSubdiv.Get()
 
 	// Call the parent method.
 	// void
@@ -541,19 +605,80 @@ func (o *GIProbe) SetSubdiv(subdiv gdnative.Int) {
 
 }
 
+// BakeWithDefaults will call Bake using the default values for: from_node, create_visual_debug.
+func (o *GIProbe) BakeWithDefaults() {
+	o.Bake(nil, false)
+}
+
+// Bias will return the value of the "bias" property.
+func (o *GIProbe) Bias() gdnative.Real {
+	return o.GetBias()
+}
+
+// Compress will return the value of the "compress" property.
+func (o *GIProbe) Compress() gdnative.Bool {
+	return o.IsCompressed()
+}
+
+// Data will return the value of the "data" property.
+func (o *GIProbe) Data() godot.GIProbeDataImplementer {
+	return o.GetProbeData()
+}
+
+// SetData will set the value of the "data" property.
+func (o *GIProbe) SetData(value godot.GIProbeDataImplementer) {
+	o.SetProbeData(value)
+}
+
+// DynamicRange will return the value of the "dynamic_range" property.
+func (o *GIProbe) DynamicRange() gdnative.Int {
+	return o.GetDynamicRange()
+}
+
+// Energy will return the value of the "energy" property.
+func (o *GIProbe) Energy() gdnative.Real {
+	return o.GetEnergy()
+}
+
+// Extents will return the value of the "extents" property.
+func (o *GIProbe) Extents() gdnative.Vector3 {
+	return o.GetExtents()
+}
+
+// Interior will return the value of the "interior" property.
+func (o *GIProbe) Interior() gdnative.Bool {
+	return o.IsInterior()
+}
+
+// NormalBias will return the value of the "normal_bias" property.
+func (o *GIProbe) NormalBias() gdnative.Real {
+	return o.GetNormalBias()
+}
+
+// Propagation will return the value of the "propagation" property.
+func (o *GIProbe) Propagation() gdnative.Real {
+	return o.GetPropagation()
+}
+
+// Subdiv will return the value of the "subdiv" property.
+func (o *GIProbe) Subdiv() GIProbeSubdiv {
+	return o.GetSubdiv()
+}
+
 // GIProbeImplementer is an interface that implements the methods
 // of the GIProbe class.
 type GIProbeImplementer interface {
 	VisualInstanceImplementer
-	Bake(fromNode ObjectImplementer, createVisualDebug gdnative.Bool)
+	Bake(fromNode godot.ObjectImplementer, createVisualDebug gdnative.Bool)
 	DebugBake()
 	GetBias() gdnative.Real
 	GetDynamicRange() gdnative.Int
 	GetEnergy() gdnative.Real
 	GetExtents() gdnative.Vector3
 	GetNormalBias() gdnative.Real
-	GetProbeData() GIProbeDataImplementer
+	GetProbeData() godot.GIProbeDataImplementer
 	GetPropagation() gdnative.Real
+	GetSubdiv() GIProbeSubdiv
 	IsCompressed() gdnative.Bool
 	IsInterior() gdnative.Bool
 	SetBias(max gdnative.Real)
@@ -563,7 +688,19 @@ type GIProbeImplementer interface {
 	SetExtents(extents gdnative.Vector3)
 	SetInterior(enable gdnative.Bool)
 	SetNormalBias(max gdnative.Real)
-	SetProbeData(data GIProbeDataImplementer)
+	SetProbeData(data godot.GIProbeDataImplementer)
 	SetPropagation(max gdnative.Real)
-	SetSubdiv(subdiv gdnative.Int)
+	SetSubdiv(subdiv GIProbeSubdiv)
+	BakeWithDefaults()
+	Bias() gdnative.Real
+	Compress() gdnative.Bool
+	Data() godot.GIProbeDataImplementer
+	SetData(value godot.GIProbeDataImplementer)
+	DynamicRange() gdnative.Int
+	Energy() gdnative.Real
+	Extents() gdnative.Vector3
+	Interior() gdnative.Bool
+	NormalBias() gdnative.Real
+	Propagation() gdnative.Real
+	Subdiv() GIProbeSubdiv
 }
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,82 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewGridMapFromPointer(ptr gdnative.Pointer) GridMap {
-func newGridMapFromPointer(ptr gdnative.Pointer) GridMap {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := GridMap{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
+// Constants of the GridMap class.
+const (
+	GridMapInvalidCellItem gdnative.Int = -1
+)
 
 /*
 Undocumented
 */
 type GridMap struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorGridMap is the class constructor of the GridMap class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorGridMap = gdnative.NewLazyClassConstructor("GridMap")
+
+// NewGridMap will create a new instance of the GridMap class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewGridMap() *GridMap {
+	obj := &GridMap{}
+	obj.SetBaseObject(classConstructorGridMap.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *GridMap) BaseClass() string {
 	return "GridMap"
 }
 
+// Method binds of the GridMap class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindGridMapX_UpdateOctantsCallback = gdnative.NewLazyMethodBind("GridMap", "_update_octants_callback")
+	methodBindGridMapClear                   = gdnative.NewLazyMethodBind("GridMap", "clear")
+	methodBindGridMapClearBakedMeshes        = gdnative.NewLazyMethodBind("GridMap", "clear_baked_meshes")
+	methodBindGridMapGetBakeMeshInstance     = gdnative.NewLazyMethodBind("GridMap", "get_bake_mesh_instance")
+	methodBindGridMapGetBakeMeshes           = gdnative.NewLazyMethodBind("GridMap", "get_bake_meshes")
+	methodBindGridMapGetCellItem             = gdnative.NewLazyMethodBind("GridMap", "get_cell_item")
+	methodBindGridMapGetCellItemOrientation  = gdnative.NewLazyMethodBind("GridMap", "get_cell_item_orientation")
+	methodBindGridMapGetCellScale            = gdnative.NewLazyMethodBind("GridMap", "get_cell_scale")
+	methodBindGridMapGetCellSize             = gdnative.NewLazyMethodBind("GridMap", "get_cell_size")
+	methodBindGridMapGetCenterX              = gdnative.NewLazyMethodBind("GridMap", "get_center_x")
+	methodBindGridMapGetCenterY              = gdnative.NewLazyMethodBind("GridMap", "get_center_y")
+	methodBindGridMapGetCenterZ              = gdnative.NewLazyMethodBind("GridMap", "get_center_z")
+	methodBindGridMapGetCollisionLayer       = gdnative.NewLazyMethodBind("GridMap", "get_collision_layer")
+	methodBindGridMapGetCollisionLayerBit    = gdnative.NewLazyMethodBind("GridMap", "get_collision_layer_bit")
+	methodBindGridMapGetCollisionMask        = gdnative.NewLazyMethodBind("GridMap", "get_collision_mask")
+	methodBindGridMapGetCollisionMaskBit     = gdnative.NewLazyMethodBind("GridMap", "get_collision_mask_bit")
+	methodBindGridMapGetMeshes               = gdnative.NewLazyMethodBind("GridMap", "get_meshes")
+	methodBindGridMapGetOctantSize           = gdnative.NewLazyMethodBind("GridMap", "get_octant_size")
+	methodBindGridMapGetTheme                = gdnative.NewLazyMethodBind("GridMap", "get_theme")
+	methodBindGridMapGetUsedCells            = gdnative.NewLazyMethodBind("GridMap", "get_used_cells")
+	methodBindGridMapMakeBakedMeshes         = gdnative.NewLazyMethodBind("GridMap", "make_baked_meshes")
+	methodBindGridMapMapToWorld              = gdnative.NewLazyMethodBind("GridMap", "map_to_world")
+	methodBindGridMapResourceChanged         = gdnative.NewLazyMethodBind("GridMap", "resource_changed")
+	methodBindGridMapSetCellItem             = gdnative.NewLazyMethodBind("GridMap", "set_cell_item")
+	methodBindGridMapSetCellScale            = gdnative.NewLazyMethodBind("GridMap", "set_cell_scale")
+	methodBindGridMapSetCellSize             = gdnative.NewLazyMethodBind("GridMap", "set_cell_size")
+	methodBindGridMapSetCenterX              = gdnative.NewLazyMethodBind("GridMap", "set_center_x")
+	methodBindGridMapSetCenterY              = gdnative.NewLazyMethodBind("GridMap", "set_center_y")
+	methodBindGridMapSetCenterZ              = gdnative.NewLazyMethodBind("GridMap", "set_center_z")
+	methodBindGridMapSetClip                 = gdnative.NewLazyMethodBind("GridMap", "set_clip")
+	methodBindGridMapSetCollisionLayer       = gdnative.NewLazyMethodBind("GridMap", "set_collision_layer")
+	methodBindGridMapSetCollisionLayerBit    = gdnative.NewLazyMethodBind("GridMap", "set_collision_layer_bit")
+	methodBindGridMapSetCollisionMask        = gdnative.NewLazyMethodBind("GridMap", "set_collision_mask")
+	methodBindGridMapSetCollisionMaskBit     = gdnative.NewLazyMethodBind("GridMap", "set_collision_mask_bit")
+	methodBindGridMapSetOctantSize           = gdnative.NewLazyMethodBind("GridMap", "set_octant_size")
+	methodBindGridMapSetTheme                = gdnative.NewLazyMethodBind("GridMap", "set_theme")
+	methodBindGridMapWorldToMap              = gdnative.NewLazyMethodBind("GridMap", "world_to_map")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *GridMap) X_UpdateOctantsCallback() {
 	//log.Println("Calling GridMap.X_UpdateOctantsCallback()")
@@ -45,7 +98,7 @@ func (o *GridMap) X_UpdateOctantsCallback() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "_update_octants_callback")
+	methodBind := methodBindGridMapX_UpdateOctantsCallback.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +108,8 @@ func (o *GridMap) X_UpdateOctantsCallback() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *GridMap) Clear() {
 	//log.Println("Calling GridMap.Clear()")
@@ -65,7 +118,7 @@ func (o *GridMap) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "clear")
+	methodBind := methodBindGridMapClear.Get()
 
 	// Call the parent method.
 	// void
@@ -75,8 +128,8 @@ func (o *GridMap) Clear() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *GridMap) ClearBakedMeshes() {
 	//log.Println("Calling GridMap.ClearBakedMeshes()")
@@ -85,7 +138,7 @@ func (o *GridMap) ClearBakedMeshes() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "clear_baked_meshes")
+	methodBind := methodBindGridMapClearBakedMeshes.Get()
 
 	// Call the parent method.
 	// void
@@ -95,8 +148,8 @@ func (o *GridMap) ClearBakedMeshes() {
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: RID
+	        Undocumented
+		Args: [{ false idx int}], Returns: RID
 */
 func (o *GridMap) GetBakeMeshInstance(idx gdnative.Int) gdnative.Rid {
 	//log.Println("Calling GridMap.GetBakeMeshInstance()")
@@ -106,7 +159,7 @@ func (o *GridMap) GetBakeMeshInstance(idx gdnative.Int) gdnative.Rid {
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_bake_mesh_instance")
+	methodBind := methodBindGridMapGetBakeMeshInstance.Get()
 
 	// Call the parent method.
 	// RID
@@ -119,8 +172,8 @@ func (o *GridMap) GetBakeMeshInstance(idx gdnative.Int) gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *GridMap) GetBakeMeshes() gdnative.Array {
 	//log.Println("Calling GridMap.GetBakeMeshes()")
@@ -129,7 +182,7 @@ func (o *GridMap) GetBakeMeshes() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_bake_meshes")
+	methodBind := methodBindGridMapGetBakeMeshes.Get()
 
 	// Call the parent method.
 	// Array
@@ -142,8 +195,8 @@ func (o *GridMap) GetBakeMeshes() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false x int} { false y int} { false z int}], Returns: int
+	        Undocumented
+		Args: [{ false x int} { false y int} { false z int}], Returns: int
 */
 func (o *GridMap) GetCellItem(x gdnative.Int, y gdnative.Int, z gdnative.Int) gdnative.Int {
 	//log.Println("Calling GridMap.GetCellItem()")
@@ -155,7 +208,7 @@ func (o *GridMap) GetCellItem(x gdnative.Int, y gdnative.Int, z gdnative.Int) gd
 	ptrArguments[2] = gdnative.NewPointerFromInt(z)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_cell_item")
+	methodBind := methodBindGridMapGetCellItem.Get()
 
 	// Call the parent method.
 	// int
@@ -168,8 +221,8 @@ func (o *GridMap) GetCellItem(x gdnative.Int, y gdnative.Int, z gdnative.Int) gd
 }
 
 /*
-        Undocumented
-	Args: [{ false x int} { false y int} { false z int}], Returns: int
+	        Undocumented
+		Args: [{ false x int} { false y int} { false z int}], Returns: int
 */
 func (o *GridMap) GetCellItemOrientation(x gdnative.Int, y gdnative.Int, z gdnative.Int) gdnative.Int {
 	//log.Println("Calling GridMap.GetCellItemOrientation()")
@@ -181,7 +234,7 @@ func (o *GridMap) GetCellItemOrientation(x gdnative.Int, y gdnative.Int, z gdnat
 	ptrArguments[2] = gdnative.NewPointerFromInt(z)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_cell_item_orientation")
+	methodBind := methodBindGridMapGetCellItemOrientation.Get()
 
 	// Call the parent method.
 	// int
@@ -194,8 +247,8 @@ func (o *GridMap) GetCellItemOrientation(x gdnative.Int, y gdnative.Int, z gdnat
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *GridMap) GetCellScale() gdnative.Real {
 	//log.Println("Calling GridMap.GetCellScale()")
@@ -204,7 +257,7 @@ func (o *GridMap) GetCellScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_cell_scale")
+	methodBind := methodBindGridMapGetCellScale.Get()
 
 	// Call the parent method.
 	// float
@@ -217,8 +270,8 @@ func (o *GridMap) GetCellScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *GridMap) GetCellSize() gdnative.Vector3 {
 	//log.Println("Calling GridMap.GetCellSize()")
@@ -227,7 +280,7 @@ func (o *GridMap) GetCellSize() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_cell_size")
+	methodBind := methodBindGridMapGetCellSize.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -240,8 +293,8 @@ func (o *GridMap) GetCellSize() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GridMap) GetCenterX() gdnative.Bool {
 	//log.Println("Calling GridMap.GetCenterX()")
@@ -250,7 +303,7 @@ func (o *GridMap) GetCenterX() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_center_x")
+	methodBind := methodBindGridMapGetCenterX.Get()
 
 	// Call the parent method.
 	// bool
@@ -263,8 +316,8 @@ func (o *GridMap) GetCenterX() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GridMap) GetCenterY() gdnative.Bool {
 	//log.Println("Calling GridMap.GetCenterY()")
@@ -273,7 +326,7 @@ func (o *GridMap) GetCenterY() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_center_y")
+	methodBind := methodBindGridMapGetCenterY.Get()
 
 	// Call the parent method.
 	// bool
@@ -286,8 +339,8 @@ func (o *GridMap) GetCenterY() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *GridMap) GetCenterZ() gdnative.Bool {
 	//log.Println("Calling GridMap.GetCenterZ()")
@@ -296,7 +349,7 @@ func (o *GridMap) GetCenterZ() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_center_z")
+	methodBind := methodBindGridMapGetCenterZ.Get()
 
 	// Call the parent method.
 	// bool
@@ -309,8 +362,8 @@ func (o *GridMap) GetCenterZ() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *GridMap) GetCollisionLayer() gdnative.Int {
 	//log.Println("Calling GridMap.GetCollisionLayer()")
@@ -319,7 +372,7 @@ func (o *GridMap) GetCollisionLayer() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_collision_layer")
+	methodBind := methodBindGridMapGetCollisionLayer.Get()
 
 	// Call the parent method.
 	// int
@@ -332,8 +385,8 @@ func (o *GridMap) GetCollisionLayer() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false bit int}], Returns: bool
+	        Undocumented
+		Args: [{ false bit int}], Returns: bool
 */
 func (o *GridMap) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling GridMap.GetCollisionLayerBit()")
@@ -343,7 +396,7 @@ func (o *GridMap) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_collision_layer_bit")
+	methodBind := methodBindGridMapGetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -356,8 +409,8 @@ func (o *GridMap) GetCollisionLayerBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *GridMap) GetCollisionMask() gdnative.Int {
 	//log.Println("Calling GridMap.GetCollisionMask()")
@@ -366,7 +419,7 @@ func (o *GridMap) GetCollisionMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_collision_mask")
+	methodBind := methodBindGridMapGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -379,8 +432,8 @@ func (o *GridMap) GetCollisionMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false bit int}], Returns: bool
+	        Undocumented
+		Args: [{ false bit int}], Returns: bool
 */
 func (o *GridMap) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling GridMap.GetCollisionMaskBit()")
@@ -390,7 +443,7 @@ func (o *GridMap) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_collision_mask_bit")
+	methodBind := methodBindGridMapGetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -403,8 +456,8 @@ func (o *GridMap) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *GridMap) GetMeshes() gdnative.Array {
 	//log.Println("Calling GridMap.GetMeshes()")
@@ -413,7 +466,7 @@ func (o *GridMap) GetMeshes() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_meshes")
+	methodBind := methodBindGridMapGetMeshes.Get()
 
 	// Call the parent method.
 	// Array
@@ -426,8 +479,8 @@ func (o *GridMap) GetMeshes() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *GridMap) GetOctantSize() gdnative.Int {
 	//log.Println("Calling GridMap.GetOctantSize()")
@@ -436,7 +489,7 @@ func (o *GridMap) GetOctantSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_octant_size")
+	methodBind := methodBindGridMapGetOctantSize.Get()
 
 	// Call the parent method.
 	// int
@@ -449,17 +502,17 @@ func (o *GridMap) GetOctantSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: MeshLibrary
+	        Undocumented
+		Args: [], Returns: MeshLibrary
 */
-func (o *GridMap) GetTheme() MeshLibraryImplementer {
+func (o *GridMap) GetTheme() godot.MeshLibraryImplementer {
 	//log.Println("Calling GridMap.GetTheme()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_theme")
+	methodBind := methodBindGridMapGetTheme.Get()
 
 	// Call the parent method.
 	// MeshLibrary
@@ -467,27 +520,25 @@ func (o *GridMap) GetTheme() MeshLibraryImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMeshLibraryFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MeshLibraryImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "MeshLibrary" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MeshLibraryImplementer)
+	ret := godot.MeshLibrary{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MeshLibraryImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *GridMap) GetUsedCells() gdnative.Array {
 	//log.Println("Calling GridMap.GetUsedCells()")
@@ -496,7 +547,7 @@ func (o *GridMap) GetUsedCells() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "get_used_cells")
+	methodBind := methodBindGridMapGetUsedCells.Get()
 
 	// Call the parent method.
 	// Array
@@ -509,8 +560,8 @@ func (o *GridMap) GetUsedCells() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{False true gen_lightmap_uv bool} {0.1 true lightmap_uv_texel_size float}], Returns: void
+	        Undocumented
+		Args: [{False true gen_lightmap_uv bool} {0.1 true lightmap_uv_texel_size float}], Returns: void
 */
 func (o *GridMap) MakeBakedMeshes(genLightmapUv gdnative.Bool, lightmapUvTexelSize gdnative.Real) {
 	//log.Println("Calling GridMap.MakeBakedMeshes()")
@@ -521,7 +572,7 @@ func (o *GridMap) MakeBakedMeshes(genLightmapUv gdnative.Bool, lightmapUvTexelSi
 	ptrArguments[1] = gdnative.NewPointerFromReal(lightmapUvTexelSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "make_baked_meshes")
+	methodBind := methodBindGridMapMakeBakedMeshes.Get()
 
 	// Call the parent method.
 	// void
@@ -531,8 +582,8 @@ func (o *GridMap) MakeBakedMeshes(genLightmapUv gdnative.Bool, lightmapUvTexelSi
 }
 
 /*
-        Undocumented
-	Args: [{ false x int} { false y int} { false z int}], Returns: Vector3
+	        Undocumented
+		Args: [{ false x int} { false y int} { false z int}], Returns: Vector3
 */
 func (o *GridMap) MapToWorld(x gdnative.Int, y gdnative.Int, z gdnative.Int) gdnative.Vector3 {
 	//log.Println("Calling GridMap.MapToWorld()")
@@ -544,7 +595,7 @@ func (o *GridMap) MapToWorld(x gdnative.Int, y gdnative.Int, z gdnative.Int) gdn
 	ptrArguments[2] = gdnative.NewPointerFromInt(z)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "map_to_world")
+	methodBind := methodBindGridMapMapToWorld.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -557,18 +608,18 @@ func (o *GridMap) MapToWorld(x gdnative.Int, y gdnative.Int, z gdnative.Int) gdn
 }
 
 /*
-        Undocumented
-	Args: [{ false resource Resource}], Returns: void
+	        Undocumented
+		Args: [{ false resource Resource}], Returns: void
 */
-func (o *GridMap) ResourceChanged(resource ResourceImplementer) {
+func (o *GridMap) ResourceChanged(resource godot.ResourceImplementer) {
 	//log.Println("Calling GridMap.ResourceChanged()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(resource.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "resource_changed")
+	methodBind := methodBindGridMapResourceChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -578,8 +629,8 @@ func (o *GridMap) ResourceChanged(resource ResourceImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false x int} { false y int} { false z int} { false item int} {0 true orientation int}], Returns: void
+	        Undocumented
+		Args: [{ false x int} { false y int} { false z int} { false item int} {0 true orientation int}], Returns: void
 */
 func (o *GridMap) SetCellItem(x gdnative.Int, y gdnative.Int, z gdnative.Int, item gdnative.Int, orientation gdnative.Int) {
 	//log.Println("Calling GridMap.SetCellItem()")
@@ -593,7 +644,7 @@ func (o *GridMap) SetCellItem(x gdnative.Int, y gdnative.Int, z gdnative.Int, it
 	ptrArguments[4] = gdnative.NewPointerFromInt(orientation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_cell_item")
+	methodBind := methodBindGridMapSetCellItem.Get()
 
 	// Call the parent method.
 	// void
@@ -603,8 +654,8 @@ func (o *GridMap) SetCellItem(x gdnative.Int, y gdnative.Int, z gdnative.Int, it
 }
 
 /*
-        Undocumented
-	Args: [{ false scale float}], Returns: void
+	        Undocumented
+		Args: [{ false scale float}], Returns: void
 */
 func (o *GridMap) SetCellScale(scale gdnative.Real) {
 	//log.Println("Calling GridMap.SetCellScale()")
@@ -614,7 +665,7 @@ func (o *GridMap) SetCellScale(scale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_cell_scale")
+	methodBind := methodBindGridMapSetCellScale.Get()
 
 	// Call the parent method.
 	// void
@@ -624,8 +675,8 @@ func (o *GridMap) SetCellScale(scale gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector3}], Returns: void
 */
 func (o *GridMap) SetCellSize(size gdnative.Vector3) {
 	//log.Println("Calling GridMap.SetCellSize()")
@@ -635,7 +686,7 @@ func (o *GridMap) SetCellSize(size gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_cell_size")
+	methodBind := methodBindGridMapSetCellSize.Get()
 
 	// Call the parent method.
 	// void
@@ -645,8 +696,8 @@ func (o *GridMap) SetCellSize(size gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *GridMap) SetCenterX(enable gdnative.Bool) {
 	//log.Println("Calling GridMap.SetCenterX()")
@@ -656,7 +707,7 @@ func (o *GridMap) SetCenterX(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_center_x")
+	methodBind := methodBindGridMapSetCenterX.Get()
 
 	// Call the parent method.
 	// void
@@ -666,8 +717,8 @@ func (o *GridMap) SetCenterX(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *GridMap) SetCenterY(enable gdnative.Bool) {
 	//log.Println("Calling GridMap.SetCenterY()")
@@ -677,7 +728,7 @@ func (o *GridMap) SetCenterY(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_center_y")
+	methodBind := methodBindGridMapSetCenterY.Get()
 
 	// Call the parent method.
 	// void
@@ -687,8 +738,8 @@ func (o *GridMap) SetCenterY(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *GridMap) SetCenterZ(enable gdnative.Bool) {
 	//log.Println("Calling GridMap.SetCenterZ()")
@@ -698,7 +749,7 @@ func (o *GridMap) SetCenterZ(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_center_z")
+	methodBind := methodBindGridMapSetCenterZ.Get()
 
 	// Call the parent method.
 	// void
@@ -708,8 +759,8 @@ func (o *GridMap) SetCenterZ(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool} {True true clipabove bool} {0 true floor int} {0 true axis int}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool} {True true clipabove bool} {0 true floor int} {0 true axis int}], Returns: void
 */
 func (o *GridMap) SetClip(enabled gdnative.Bool, clipabove gdnative.Bool, floor gdnative.Int, axis gdnative.Int) {
 	//log.Println("Calling GridMap.SetClip()")
@@ -722,7 +773,7 @@ func (o *GridMap) SetClip(enabled gdnative.Bool, clipabove gdnative.Bool, floor
 	ptrArguments[3] = gdnative.NewPointerFromInt(axis)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_clip")
+	methodBind := methodBindGridMapSetClip.Get()
 
 	// Call the parent method.
 	// void
@@ -732,8 +783,8 @@ func (o *GridMap) SetClip(enabled gdnative.Bool, clipabove gdnative.Bool, floor
 }
 
 /*
-        Undocumented
-	Args: [{ false layer int}], Returns: void
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
 */
 func (o *GridMap) SetCollisionLayer(layer gdnative.Int) {
 	//log.Println("Calling GridMap.SetCollisionLayer()")
@@ -743,7 +794,7 @@ func (o *GridMap) SetCollisionLayer(layer gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_collision_layer")
+	methodBind := methodBindGridMapSetCollisionLayer.Get()
 
 	// Call the parent method.
 	// void
@@ -753,8 +804,8 @@ func (o *GridMap) SetCollisionLayer(layer gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bit int} { false value bool}], Returns: void
+	        Undocumented
+		Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *GridMap) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling GridMap.SetCollisionLayerBit()")
@@ -765,7 +816,7 @@ func (o *GridMap) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_collision_layer_bit")
+	methodBind := methodBindGridMapSetCollisionLayerBit.Get()
 
 	// Call the parent method.
 	// void
@@ -775,8 +826,8 @@ func (o *GridMap) SetCollisionLayerBit(bit gdnative.Int, value gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *GridMap) SetCollisionMask(mask gdnative.Int) {
 	//log.Println("Calling GridMap.SetCollisionMask()")
@@ -786,7 +837,7 @@ func (o *GridMap) SetCollisionMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_collision_mask")
+	methodBind := methodBindGridMapSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -796,8 +847,8 @@ func (o *GridMap) SetCollisionMask(mask gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false bit int} { false value bool}], Returns: void
+	        Undocumented
+		Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *GridMap) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling GridMap.SetCollisionMaskBit()")
@@ -808,7 +859,7 @@ func (o *GridMap) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_collision_mask_bit")
+	methodBind := methodBindGridMapSetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// void
@@ -818,8 +869,8 @@ func (o *GridMap) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size int}], Returns: void
 */
 func (o *GridMap) SetOctantSize(size gdnative.Int) {
 	//log.Println("Calling GridMap.SetOctantSize()")
@@ -829,7 +880,7 @@ func (o *GridMap) SetOctantSize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_octant_size")
+	methodBind := methodBindGridMapSetOctantSize.Get()
 
 	// Call the parent method.
 	// void
@@ -839,18 +890,18 @@ func (o *GridMap) SetOctantSize(size gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false theme MeshLibrary}], Returns: void
+	        Undocumented
+		Args: [{ false theme MeshLibrary}], Returns: void
 */
-func (o *GridMap) SetTheme(theme MeshLibraryImplementer) {
+func (o *GridMap) SetTheme(theme godot.MeshLibraryImplementer) {
 	//log.Println("Calling GridMap.SetTheme()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(theme.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(theme))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "set_theme")
+	methodBind := methodBindGridMapSetTheme.Get()
 
 	// Call the parent method.
 	// void
@@ -860,8 +911,8 @@ func (o *GridMap) SetTheme(theme MeshLibraryImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pos Vector3}], Returns: Vector3
+	        Undocumented
+		Args: [{ false pos Vector3}], Returns: Vector3
 */
 func (o *GridMap) WorldToMap(pos gdnative.Vector3) gdnative.Vector3 {
 	//log.Println("Calling GridMap.WorldToMap()")
@@ -871,7 +922,7 @@ func (o *GridMap) WorldToMap(pos gdnative.Vector3) gdnative.Vector3 {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(pos)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("GridMap", "world_to_map")
+	methodBind := methodBindGridMapWorldToMap.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -883,10 +934,90 @@ func (o *GridMap) WorldToMap(pos gdnative.Vector3) gdnative.Vector3 {
 	return ret
 }
 
+// MakeBakedMeshesWithDefaults will call MakeBakedMeshes using the default values for: gen_lightmap_uv, lightmap_uv_texel_size.
+func (o *GridMap) MakeBakedMeshesWithDefaults() {
+	o.MakeBakedMeshes(false, 0.1)
+}
+
+// SetCellItemWithDefaults will call SetCellItem using the default values for: orientation.
+func (o *GridMap) SetCellItemWithDefaults(x gdnative.Int, y gdnative.Int, z gdnative.Int, item gdnative.Int) {
+	o.SetCellItem(x, y, z, item, 0)
+}
+
+// SetClipWithDefaults will call SetClip using the default values for: clipabove, floor, axis.
+func (o *GridMap) SetClipWithDefaults(enabled gdnative.Bool) {
+	o.SetClip(enabled, true, 0, 0)
+}
+
+// CellCenterX will return the value of the "cell_center_x" property.
+func (o *GridMap) CellCenterX() gdnative.Bool {
+	return o.GetCenterX()
+}
+
+// SetCellCenterX will set the value of the "cell_center_x" property.
+func (o *GridMap) SetCellCenterX(value gdnative.Bool) {
+	o.SetCenterX(value)
+}
+
+// CellCenterY will return the value of the "cell_center_y" property.
+func (o *GridMap) CellCenterY() gdnative.Bool {
+	return o.GetCenterY()
+}
+
+// SetCellCenterY will set the value of the "cell_center_y" property.
+func (o *GridMap) SetCellCenterY(value gdnative.Bool) {
+	o.SetCenterY(value)
+}
+
+// CellCenterZ will return the value of the "cell_center_z" property.
+func (o *GridMap) CellCenterZ() gdnative.Bool {
+	return o.GetCenterZ()
+}
+
+// SetCellCenterZ will set the value of the "cell_center_z" property.
+func (o *GridMap) SetCellCenterZ(value gdnative.Bool) {
+	o.SetCenterZ(value)
+}
+
+// CellOctantSize will return the value of the "cell_octant_size" property.
+func (o *GridMap) CellOctantSize() gdnative.Int {
+	return o.GetOctantSize()
+}
+
+// SetCellOctantSize will set the value of the "cell_octant_size" property.
+func (o *GridMap) SetCellOctantSize(value gdnative.Int) {
+	o.SetOctantSize(value)
+}
+
+// CellScale will return the value of the "cell_scale" property.
+func (o *GridMap) CellScale() gdnative.Real {
+	return o.GetCellScale()
+}
+
+// CellSize will return the value of the "cell_size" property.
+func (o *GridMap) CellSize() gdnative.Vector3 {
+	return o.GetCellSize()
+}
+
+// CollisionLayer will return the value of the "collision_layer" property.
+func (o *GridMap) CollisionLayer() gdnative.Int {
+	return o.GetCollisionLayer()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *GridMap) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// Theme will return the value of the "theme" property.
+func (o *GridMap) Theme() godot.MeshLibraryImplementer {
+	return o.GetTheme()
+}
+
 // GridMapImplementer is an interface that implements the methods
 // of the GridMap class.
 type GridMapImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	X_UpdateOctantsCallback()
 	Clear()
 	ClearBakedMeshes()
@@ -905,11 +1036,11 @@ type GridMapImplementer interface {
 	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
 	GetMeshes() gdnative.Array
 	GetOctantSize() gdnative.Int
-	GetTheme() MeshLibraryImplementer
+	GetTheme() godot.MeshLibraryImplementer
 	GetUsedCells() gdnative.Array
 	MakeBakedMeshes(genLightmapUv gdnative.Bool, lightmapUvTexelSize gdnative.Real)
 	MapToWorld(x gdnative.Int, y gdnative.Int, z gdnative.Int) gdnative.Vector3
-	ResourceChanged(resource ResourceImplementer)
+	ResourceChanged(resource godot.ResourceImplementer)
 	SetCellItem(x gdnative.Int, y gdnative.Int, z gdnative.Int, item gdnative.Int, orientation gdnative.Int)
 	SetCellScale(scale gdnative.Real)
 	SetCellSize(size gdnative.Vector3)
@@ -922,6 +1053,22 @@ type GridMapImplementer interface {
 	SetCollisionMask(mask gdnative.Int)
 	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
 	SetOctantSize(size gdnative.Int)
-	SetTheme(theme MeshLibraryImplementer)
+	SetTheme(theme godot.MeshLibraryImplementer)
 	WorldToMap(pos gdnative.Vector3) gdnative.Vector3
+	MakeBakedMeshesWithDefaults()
+	SetCellItemWithDefaults(x gdnative.Int, y gdnative.Int, z gdnative.Int, item gdnative.Int)
+	SetClipWithDefaults(enabled gdnative.Bool)
+	CellCenterX() gdnative.Bool
+	SetCellCenterX(value gdnative.Bool)
+	CellCenterY() gdnative.Bool
+	SetCellCenterY(value gdnative.Bool)
+	CellCenterZ() gdnative.Bool
+	SetCellCenterZ(value gdnative.Bool)
+	CellOctantSize() gdnative.Int
+	SetCellOctantSize(value gdnative.Int)
+	CellScale() gdnative.Real
+	CellSize() gdnative.Vector3
+	CollisionLayer() gdnative.Int
+	CollisionMask() gdnative.Int
+	Theme() godot.MeshLibraryImplementer
 }
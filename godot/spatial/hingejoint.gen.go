@@ -0,0 +1,470 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// HingeJointFlag is an enum for Flag values.
+type HingeJointFlag int
+
+const (
+	HingeJointFlagEnableMotor HingeJointFlag = 1
+	HingeJointFlagMax         HingeJointFlag = 2
+	HingeJointFlagUseLimit    HingeJointFlag = 0
+)
+
+// String will return the Godot name of the HingeJointFlag value.
+func (e HingeJointFlag) String() string {
+	switch e {
+	case HingeJointFlagEnableMotor:
+		return "FLAG_ENABLE_MOTOR"
+	case HingeJointFlagMax:
+		return "FLAG_MAX"
+	case HingeJointFlagUseLimit:
+		return "FLAG_USE_LIMIT"
+	}
+	return fmt.Sprintf("HingeJointFlag(%d)", int(e))
+}
+
+// ParseHingeJointFlag will return the HingeJointFlag value with the given Godot name.
+func ParseHingeJointFlag(name string) (HingeJointFlag, error) {
+	switch name {
+	case "FLAG_ENABLE_MOTOR":
+		return HingeJointFlagEnableMotor, nil
+	case "FLAG_MAX":
+		return HingeJointFlagMax, nil
+	case "FLAG_USE_LIMIT":
+		return HingeJointFlagUseLimit, nil
+	}
+	return 0, fmt.Errorf("invalid HingeJointFlag value %q", name)
+}
+
+// HingeJointParam is an enum for Param values.
+type HingeJointParam int
+
+const (
+	HingeJointParamBias                HingeJointParam = 0
+	HingeJointParamLimitBias           HingeJointParam = 3
+	HingeJointParamLimitLower          HingeJointParam = 2
+	HingeJointParamLimitRelaxation     HingeJointParam = 5
+	HingeJointParamLimitSoftness       HingeJointParam = 4
+	HingeJointParamLimitUpper          HingeJointParam = 1
+	HingeJointParamMax                 HingeJointParam = 8
+	HingeJointParamMotorMaxImpulse     HingeJointParam = 7
+	HingeJointParamMotorTargetVelocity HingeJointParam = 6
+)
+
+// String will return the Godot name of the HingeJointParam value.
+func (e HingeJointParam) String() string {
+	switch e {
+	case HingeJointParamBias:
+		return "PARAM_BIAS"
+	case HingeJointParamLimitBias:
+		return "PARAM_LIMIT_BIAS"
+	case HingeJointParamLimitLower:
+		return "PARAM_LIMIT_LOWER"
+	case HingeJointParamLimitRelaxation:
+		return "PARAM_LIMIT_RELAXATION"
+	case HingeJointParamLimitSoftness:
+		return "PARAM_LIMIT_SOFTNESS"
+	case HingeJointParamLimitUpper:
+		return "PARAM_LIMIT_UPPER"
+	case HingeJointParamMax:
+		return "PARAM_MAX"
+	case HingeJointParamMotorMaxImpulse:
+		return "PARAM_MOTOR_MAX_IMPULSE"
+	case HingeJointParamMotorTargetVelocity:
+		return "PARAM_MOTOR_TARGET_VELOCITY"
+	}
+	return fmt.Sprintf("HingeJointParam(%d)", int(e))
+}
+
+// ParseHingeJointParam will return the HingeJointParam value with the given Godot name.
+func ParseHingeJointParam(name string) (HingeJointParam, error) {
+	switch name {
+	case "PARAM_BIAS":
+		return HingeJointParamBias, nil
+	case "PARAM_LIMIT_BIAS":
+		return HingeJointParamLimitBias, nil
+	case "PARAM_LIMIT_LOWER":
+		return HingeJointParamLimitLower, nil
+	case "PARAM_LIMIT_RELAXATION":
+		return HingeJointParamLimitRelaxation, nil
+	case "PARAM_LIMIT_SOFTNESS":
+		return HingeJointParamLimitSoftness, nil
+	case "PARAM_LIMIT_UPPER":
+		return HingeJointParamLimitUpper, nil
+	case "PARAM_MAX":
+		return HingeJointParamMax, nil
+	case "PARAM_MOTOR_MAX_IMPULSE":
+		return HingeJointParamMotorMaxImpulse, nil
+	case "PARAM_MOTOR_TARGET_VELOCITY":
+		return HingeJointParamMotorTargetVelocity, nil
+	}
+	return 0, fmt.Errorf("invalid HingeJointParam value %q", name)
+}
+
+/*
+Normally uses the z-axis of body A as the hinge axis, another axis can be specified when adding it manually though.
+*/
+type HingeJoint struct {
+	Joint
+	owner gdnative.Object
+}
+
+// classConstructorHingeJoint is the class constructor of the HingeJoint class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorHingeJoint = gdnative.NewLazyClassConstructor("HingeJoint")
+
+// NewHingeJoint will create a new instance of the HingeJoint class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewHingeJoint() *HingeJoint {
+	obj := &HingeJoint{}
+	obj.SetBaseObject(classConstructorHingeJoint.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *HingeJoint) BaseClass() string {
+	return "HingeJoint"
+}
+
+// Method binds of the HingeJoint class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindHingeJointX_GetLowerLimit = gdnative.NewLazyMethodBind("HingeJoint", "_get_lower_limit")
+	methodBindHingeJointX_GetUpperLimit = gdnative.NewLazyMethodBind("HingeJoint", "_get_upper_limit")
+	methodBindHingeJointX_SetLowerLimit = gdnative.NewLazyMethodBind("HingeJoint", "_set_lower_limit")
+	methodBindHingeJointX_SetUpperLimit = gdnative.NewLazyMethodBind("HingeJoint", "_set_upper_limit")
+	methodBindHingeJointGetFlag         = gdnative.NewLazyMethodBind("HingeJoint", "get_flag")
+	methodBindHingeJointGetParam        = gdnative.NewLazyMethodBind("HingeJoint", "get_param")
+	methodBindHingeJointSetFlag         = gdnative.NewLazyMethodBind("HingeJoint", "set_flag")
+	methodBindHingeJointSetParam        = gdnative.NewLazyMethodBind("HingeJoint", "set_param")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *HingeJoint) X_GetLowerLimit() gdnative.Real {
+	//log.Println("Calling HingeJoint.X_GetLowerLimit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHingeJointX_GetLowerLimit.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *HingeJoint) X_GetUpperLimit() gdnative.Real {
+	//log.Println("Calling HingeJoint.X_GetUpperLimit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindHingeJointX_GetUpperLimit.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false lower_limit float}], Returns: void
+*/
+func (o *HingeJoint) X_SetLowerLimit(lowerLimit gdnative.Real) {
+	//log.Println("Calling HingeJoint.X_SetLowerLimit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(lowerLimit)
+
+	// Get the method bind
+	methodBind := methodBindHingeJointX_SetLowerLimit.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false upper_limit float}], Returns: void
+*/
+func (o *HingeJoint) X_SetUpperLimit(upperLimit gdnative.Real) {
+	//log.Println("Calling HingeJoint.X_SetUpperLimit()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(upperLimit)
+
+	// Get the method bind
+	methodBind := methodBindHingeJointX_SetUpperLimit.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.HingeJoint::Flag}], Returns: bool
+*/
+func (o *HingeJoint) GetFlag(flag HingeJointFlag) gdnative.Bool {
+	//log.Println("Calling HingeJoint.GetFlag()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+
+	// Get the method bind
+	methodBind := methodBindHingeJointGetFlag.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.HingeJoint::Param}], Returns: float
+*/
+func (o *HingeJoint) GetParam(param HingeJointParam) gdnative.Real {
+	//log.Println("Calling HingeJoint.GetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindHingeJointGetParam.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag enum.HingeJoint::Flag} { false enabled bool}], Returns: void
+*/
+func (o *HingeJoint) SetFlag(flag HingeJointFlag, enabled gdnative.Bool) {
+	//log.Println("Calling HingeJoint.SetFlag()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flag))
+	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindHingeJointSetFlag.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.HingeJoint::Param} { false value float}], Returns: void
+*/
+func (o *HingeJoint) SetParam(param HingeJointParam, value gdnative.Real) {
+	//log.Println("Calling HingeJoint.SetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindHingeJointSetParam.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AngularLimitBias will return the value of the "angular_limit/bias" property.
+func (o *HingeJoint) AngularLimitBias() gdnative.Real {
+	return o.GetParam(3)
+}
+
+// SetAngularLimitBias will set the value of the "angular_limit/bias" property.
+func (o *HingeJoint) SetAngularLimitBias(value gdnative.Real) {
+	o.SetParam(3, value)
+}
+
+// AngularLimitEnable will return the value of the "angular_limit/enable" property.
+func (o *HingeJoint) AngularLimitEnable() gdnative.Bool {
+	return o.GetFlag(0)
+}
+
+// SetAngularLimitEnable will set the value of the "angular_limit/enable" property.
+func (o *HingeJoint) SetAngularLimitEnable(value gdnative.Bool) {
+	o.SetFlag(0, value)
+}
+
+// AngularLimitLower will return the value of the "angular_limit/lower" property.
+func (o *HingeJoint) AngularLimitLower() gdnative.Real {
+	return o.X_GetLowerLimit()
+}
+
+// SetAngularLimitLower will set the value of the "angular_limit/lower" property.
+func (o *HingeJoint) SetAngularLimitLower(value gdnative.Real) {
+	o.X_SetLowerLimit(value)
+}
+
+// AngularLimitRelaxation will return the value of the "angular_limit/relaxation" property.
+func (o *HingeJoint) AngularLimitRelaxation() gdnative.Real {
+	return o.GetParam(5)
+}
+
+// SetAngularLimitRelaxation will set the value of the "angular_limit/relaxation" property.
+func (o *HingeJoint) SetAngularLimitRelaxation(value gdnative.Real) {
+	o.SetParam(5, value)
+}
+
+// AngularLimitSoftness will return the value of the "angular_limit/softness" property.
+func (o *HingeJoint) AngularLimitSoftness() gdnative.Real {
+	return o.GetParam(4)
+}
+
+// SetAngularLimitSoftness will set the value of the "angular_limit/softness" property.
+func (o *HingeJoint) SetAngularLimitSoftness(value gdnative.Real) {
+	o.SetParam(4, value)
+}
+
+// AngularLimitUpper will return the value of the "angular_limit/upper" property.
+func (o *HingeJoint) AngularLimitUpper() gdnative.Real {
+	return o.X_GetUpperLimit()
+}
+
+// SetAngularLimitUpper will set the value of the "angular_limit/upper" property.
+func (o *HingeJoint) SetAngularLimitUpper(value gdnative.Real) {
+	o.X_SetUpperLimit(value)
+}
+
+// MotorEnable will return the value of the "motor/enable" property.
+func (o *HingeJoint) MotorEnable() gdnative.Bool {
+	return o.GetFlag(1)
+}
+
+// SetMotorEnable will set the value of the "motor/enable" property.
+func (o *HingeJoint) SetMotorEnable(value gdnative.Bool) {
+	o.SetFlag(1, value)
+}
+
+// MotorMaxImpulse will return the value of the "motor/max_impulse" property.
+func (o *HingeJoint) MotorMaxImpulse() gdnative.Real {
+	return o.GetParam(7)
+}
+
+// SetMotorMaxImpulse will set the value of the "motor/max_impulse" property.
+func (o *HingeJoint) SetMotorMaxImpulse(value gdnative.Real) {
+	o.SetParam(7, value)
+}
+
+// MotorTargetVelocity will return the value of the "motor/target_velocity" property.
+func (o *HingeJoint) MotorTargetVelocity() gdnative.Real {
+	return o.GetParam(6)
+}
+
+// SetMotorTargetVelocity will set the value of the "motor/target_velocity" property.
+func (o *HingeJoint) SetMotorTargetVelocity(value gdnative.Real) {
+	o.SetParam(6, value)
+}
+
+// ParamsBias will return the value of the "params/bias" property.
+func (o *HingeJoint) ParamsBias() gdnative.Real {
+	return o.GetParam(0)
+}
+
+// SetParamsBias will set the value of the "params/bias" property.
+func (o *HingeJoint) SetParamsBias(value gdnative.Real) {
+	o.SetParam(0, value)
+}
+
+// HingeJointImplementer is an interface that implements the methods
+// of the HingeJoint class.
+type HingeJointImplementer interface {
+	JointImplementer
+	X_GetLowerLimit() gdnative.Real
+	X_GetUpperLimit() gdnative.Real
+	X_SetLowerLimit(lowerLimit gdnative.Real)
+	X_SetUpperLimit(upperLimit gdnative.Real)
+	GetFlag(flag HingeJointFlag) gdnative.Bool
+	GetParam(param HingeJointParam) gdnative.Real
+	SetFlag(flag HingeJointFlag, enabled gdnative.Bool)
+	SetParam(param HingeJointParam, value gdnative.Real)
+	AngularLimitBias() gdnative.Real
+	SetAngularLimitBias(value gdnative.Real)
+	AngularLimitEnable() gdnative.Bool
+	SetAngularLimitEnable(value gdnative.Bool)
+	AngularLimitLower() gdnative.Real
+	SetAngularLimitLower(value gdnative.Real)
+	AngularLimitRelaxation() gdnative.Real
+	SetAngularLimitRelaxation(value gdnative.Real)
+	AngularLimitSoftness() gdnative.Real
+	SetAngularLimitSoftness(value gdnative.Real)
+	AngularLimitUpper() gdnative.Real
+	SetAngularLimitUpper(value gdnative.Real)
+	MotorEnable() gdnative.Bool
+	SetMotorEnable(value gdnative.Bool)
+	MotorMaxImpulse() gdnative.Real
+	SetMotorMaxImpulse(value gdnative.Real)
+	MotorTargetVelocity() gdnative.Real
+	SetMotorTargetVelocity(value gdnative.Real)
+	ParamsBias() gdnative.Real
+	SetParamsBias(value gdnative.Real)
+}
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewImmediateGeometryFromPointer(ptr gdnative.Pointer) ImmediateGeometry {
-func newImmediateGeometryFromPointer(ptr gdnative.Pointer) ImmediateGeometry {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ImmediateGeometry{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Draws simple geometry from code. Uses a drawing mode similar to OpenGL 1.x.
 */
@@ -30,13 +22,42 @@ type ImmediateGeometry struct {
 	owner gdnative.Object
 }
 
+// classConstructorImmediateGeometry is the class constructor of the ImmediateGeometry class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorImmediateGeometry = gdnative.NewLazyClassConstructor("ImmediateGeometry")
+
+// NewImmediateGeometry will create a new instance of the ImmediateGeometry class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewImmediateGeometry() *ImmediateGeometry {
+	obj := &ImmediateGeometry{}
+	obj.SetBaseObject(classConstructorImmediateGeometry.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ImmediateGeometry) BaseClass() string {
 	return "ImmediateGeometry"
 }
 
+// Method binds of the ImmediateGeometry class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindImmediateGeometryAddSphere  = gdnative.NewLazyMethodBind("ImmediateGeometry", "add_sphere")
+	methodBindImmediateGeometryAddVertex  = gdnative.NewLazyMethodBind("ImmediateGeometry", "add_vertex")
+	methodBindImmediateGeometryBegin      = gdnative.NewLazyMethodBind("ImmediateGeometry", "begin")
+	methodBindImmediateGeometryClear      = gdnative.NewLazyMethodBind("ImmediateGeometry", "clear")
+	methodBindImmediateGeometryEnd        = gdnative.NewLazyMethodBind("ImmediateGeometry", "end")
+	methodBindImmediateGeometrySetColor   = gdnative.NewLazyMethodBind("ImmediateGeometry", "set_color")
+	methodBindImmediateGeometrySetNormal  = gdnative.NewLazyMethodBind("ImmediateGeometry", "set_normal")
+	methodBindImmediateGeometrySetTangent = gdnative.NewLazyMethodBind("ImmediateGeometry", "set_tangent")
+	methodBindImmediateGeometrySetUv      = gdnative.NewLazyMethodBind("ImmediateGeometry", "set_uv")
+	methodBindImmediateGeometrySetUv2     = gdnative.NewLazyMethodBind("ImmediateGeometry", "set_uv2")
+)
+
 /*
-        Simple helper to draw a uvsphere, with given latitudes, longitude and radius.
-	Args: [{ false lats int} { false lons int} { false radius float} {True true add_uv bool}], Returns: void
+	        Simple helper to draw a uvsphere, with given latitudes, longitude and radius.
+		Args: [{ false lats int} { false lons int} { false radius float} {True true add_uv bool}], Returns: void
 */
 func (o *ImmediateGeometry) AddSphere(lats gdnative.Int, lons gdnative.Int, radius gdnative.Real, addUv gdnative.Bool) {
 	//log.Println("Calling ImmediateGeometry.AddSphere()")
@@ -49,7 +70,7 @@ func (o *ImmediateGeometry) AddSphere(lats gdnative.Int, lons gdnative.Int, radi
 	ptrArguments[3] = gdnative.NewPointerFromBool(addUv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "add_sphere")
+	methodBind := methodBindImmediateGeometryAddSphere.Get()
 
 	// Call the parent method.
 	// void
@@ -59,8 +80,8 @@ func (o *ImmediateGeometry) AddSphere(lats gdnative.Int, lons gdnative.Int, radi
 }
 
 /*
-        Adds a vertex with the currently set color/uv/etc.
-	Args: [{ false position Vector3}], Returns: void
+	        Adds a vertex with the currently set color/uv/etc.
+		Args: [{ false position Vector3}], Returns: void
 */
 func (o *ImmediateGeometry) AddVertex(position gdnative.Vector3) {
 	//log.Println("Calling ImmediateGeometry.AddVertex()")
@@ -70,7 +91,7 @@ func (o *ImmediateGeometry) AddVertex(position gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "add_vertex")
+	methodBind := methodBindImmediateGeometryAddVertex.Get()
 
 	// Call the parent method.
 	// void
@@ -80,19 +101,19 @@ func (o *ImmediateGeometry) AddVertex(position gdnative.Vector3) {
 }
 
 /*
-        Begin drawing (And optionally pass a texture override). When done call end(). For more information on how this works, search for glBegin() glEnd() references. For the type of primitive, use the [Mesh].PRIMITIVE_* enumerations.
-	Args: [{ false primitive int} {[Object:null] true texture Texture}], Returns: void
+	        Begin drawing (And optionally pass a texture override). When done call end(). For more information on how this works, search for glBegin() glEnd() references. For the type of primitive, use the [Mesh].PRIMITIVE_* enumerations.
+		Args: [{ false primitive int} {[Object:null] true texture Texture}], Returns: void
 */
-func (o *ImmediateGeometry) Begin(primitive gdnative.Int, texture TextureImplementer) {
+func (o *ImmediateGeometry) Begin(primitive gdnative.Int, texture godot.TextureImplementer) {
 	//log.Println("Calling ImmediateGeometry.Begin()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(primitive)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "begin")
+	methodBind := methodBindImmediateGeometryBegin.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +123,8 @@ func (o *ImmediateGeometry) Begin(primitive gdnative.Int, texture TextureImpleme
 }
 
 /*
-        Clears everything that was drawn using begin/end.
-	Args: [], Returns: void
+	        Clears everything that was drawn using begin/end.
+		Args: [], Returns: void
 */
 func (o *ImmediateGeometry) Clear() {
 	//log.Println("Calling ImmediateGeometry.Clear()")
@@ -112,7 +133,7 @@ func (o *ImmediateGeometry) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "clear")
+	methodBind := methodBindImmediateGeometryClear.Get()
 
 	// Call the parent method.
 	// void
@@ -122,8 +143,8 @@ func (o *ImmediateGeometry) Clear() {
 }
 
 /*
-        Ends a drawing context and displays the results.
-	Args: [], Returns: void
+	        Ends a drawing context and displays the results.
+		Args: [], Returns: void
 */
 func (o *ImmediateGeometry) End() {
 	//log.Println("Calling ImmediateGeometry.End()")
@@ -132,7 +153,7 @@ func (o *ImmediateGeometry) End() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "end")
+	methodBind := methodBindImmediateGeometryEnd.Get()
 
 	// Call the parent method.
 	// void
@@ -142,8 +163,8 @@ func (o *ImmediateGeometry) End() {
 }
 
 /*
-        The current drawing color.
-	Args: [{ false color Color}], Returns: void
+	        The current drawing color.
+		Args: [{ false color Color}], Returns: void
 */
 func (o *ImmediateGeometry) SetColor(color gdnative.Color) {
 	//log.Println("Calling ImmediateGeometry.SetColor()")
@@ -153,7 +174,7 @@ func (o *ImmediateGeometry) SetColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "set_color")
+	methodBind := methodBindImmediateGeometrySetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -163,8 +184,8 @@ func (o *ImmediateGeometry) SetColor(color gdnative.Color) {
 }
 
 /*
-        The next vertex's normal.
-	Args: [{ false normal Vector3}], Returns: void
+	        The next vertex's normal.
+		Args: [{ false normal Vector3}], Returns: void
 */
 func (o *ImmediateGeometry) SetNormal(normal gdnative.Vector3) {
 	//log.Println("Calling ImmediateGeometry.SetNormal()")
@@ -174,7 +195,7 @@ func (o *ImmediateGeometry) SetNormal(normal gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(normal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "set_normal")
+	methodBind := methodBindImmediateGeometrySetNormal.Get()
 
 	// Call the parent method.
 	// void
@@ -184,8 +205,8 @@ func (o *ImmediateGeometry) SetNormal(normal gdnative.Vector3) {
 }
 
 /*
-        The next vertex's tangent (and binormal facing).
-	Args: [{ false tangent Plane}], Returns: void
+	        The next vertex's tangent (and binormal facing).
+		Args: [{ false tangent Plane}], Returns: void
 */
 func (o *ImmediateGeometry) SetTangent(tangent gdnative.Plane) {
 	//log.Println("Calling ImmediateGeometry.SetTangent()")
@@ -195,7 +216,7 @@ func (o *ImmediateGeometry) SetTangent(tangent gdnative.Plane) {
 	ptrArguments[0] = gdnative.NewPointerFromPlane(tangent)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "set_tangent")
+	methodBind := methodBindImmediateGeometrySetTangent.Get()
 
 	// Call the parent method.
 	// void
@@ -205,8 +226,8 @@ func (o *ImmediateGeometry) SetTangent(tangent gdnative.Plane) {
 }
 
 /*
-        The next vertex's UV.
-	Args: [{ false uv Vector2}], Returns: void
+	        The next vertex's UV.
+		Args: [{ false uv Vector2}], Returns: void
 */
 func (o *ImmediateGeometry) SetUv(uv gdnative.Vector2) {
 	//log.Println("Calling ImmediateGeometry.SetUv()")
@@ -216,7 +237,7 @@ func (o *ImmediateGeometry) SetUv(uv gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(uv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "set_uv")
+	methodBind := methodBindImmediateGeometrySetUv.Get()
 
 	// Call the parent method.
 	// void
@@ -226,8 +247,8 @@ func (o *ImmediateGeometry) SetUv(uv gdnative.Vector2) {
 }
 
 /*
-        The next vertex's second layer UV.
-	Args: [{ false uv Vector2}], Returns: void
+	        The next vertex's second layer UV.
+		Args: [{ false uv Vector2}], Returns: void
 */
 func (o *ImmediateGeometry) SetUv2(uv gdnative.Vector2) {
 	//log.Println("Calling ImmediateGeometry.SetUv2()")
@@ -237,7 +258,7 @@ func (o *ImmediateGeometry) SetUv2(uv gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(uv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ImmediateGeometry", "set_uv2")
+	methodBind := methodBindImmediateGeometrySetUv2.Get()
 
 	// Call the parent method.
 	// void
@@ -246,13 +267,23 @@ func (o *ImmediateGeometry) SetUv2(uv gdnative.Vector2) {
 
 }
 
+// AddSphereWithDefaults will call AddSphere using the default values for: add_uv.
+func (o *ImmediateGeometry) AddSphereWithDefaults(lats gdnative.Int, lons gdnative.Int, radius gdnative.Real) {
+	o.AddSphere(lats, lons, radius, true)
+}
+
+// BeginWithDefaults will call Begin using the default values for: texture.
+func (o *ImmediateGeometry) BeginWithDefaults(primitive gdnative.Int) {
+	o.Begin(primitive, nil)
+}
+
 // ImmediateGeometryImplementer is an interface that implements the methods
 // of the ImmediateGeometry class.
 type ImmediateGeometryImplementer interface {
 	GeometryInstanceImplementer
 	AddSphere(lats gdnative.Int, lons gdnative.Int, radius gdnative.Real, addUv gdnative.Bool)
 	AddVertex(position gdnative.Vector3)
-	Begin(primitive gdnative.Int, texture TextureImplementer)
+	Begin(primitive gdnative.Int, texture godot.TextureImplementer)
 	Clear()
 	End()
 	SetColor(color gdnative.Color)
@@ -260,4 +291,6 @@ type ImmediateGeometryImplementer interface {
 	SetTangent(tangent gdnative.Plane)
 	SetUv(uv gdnative.Vector2)
 	SetUv2(uv gdnative.Vector2)
+	AddSphereWithDefaults(lats gdnative.Int, lons gdnative.Int, radius gdnative.Real)
+	BeginWithDefaults(primitive gdnative.Int)
 }
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewInterpolatedCameraFromPointer(ptr gdnative.Pointer) InterpolatedCamera {
-func newInterpolatedCameraFromPointer(ptr gdnative.Pointer) InterpolatedCamera {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := InterpolatedCamera{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type InterpolatedCamera struct {
-	Camera
+	godot.Camera
 	owner gdnative.Object
 }
 
+// classConstructorInterpolatedCamera is the class constructor of the InterpolatedCamera class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorInterpolatedCamera = gdnative.NewLazyClassConstructor("InterpolatedCamera")
+
+// NewInterpolatedCamera will create a new instance of the InterpolatedCamera class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewInterpolatedCamera() *InterpolatedCamera {
+	obj := &InterpolatedCamera{}
+	obj.SetBaseObject(classConstructorInterpolatedCamera.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *InterpolatedCamera) BaseClass() string {
 	return "InterpolatedCamera"
 }
 
+// Method binds of the InterpolatedCamera class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindInterpolatedCameraGetSpeed                = gdnative.NewLazyMethodBind("InterpolatedCamera", "get_speed")
+	methodBindInterpolatedCameraGetTargetPath           = gdnative.NewLazyMethodBind("InterpolatedCamera", "get_target_path")
+	methodBindInterpolatedCameraIsInterpolationEnabled  = gdnative.NewLazyMethodBind("InterpolatedCamera", "is_interpolation_enabled")
+	methodBindInterpolatedCameraSetInterpolationEnabled = gdnative.NewLazyMethodBind("InterpolatedCamera", "set_interpolation_enabled")
+	methodBindInterpolatedCameraSetSpeed                = gdnative.NewLazyMethodBind("InterpolatedCamera", "set_speed")
+	methodBindInterpolatedCameraSetTarget               = gdnative.NewLazyMethodBind("InterpolatedCamera", "set_target")
+	methodBindInterpolatedCameraSetTargetPath           = gdnative.NewLazyMethodBind("InterpolatedCamera", "set_target_path")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *InterpolatedCamera) GetSpeed() gdnative.Real {
 	//log.Println("Calling InterpolatedCamera.GetSpeed()")
@@ -45,7 +62,7 @@ func (o *InterpolatedCamera) GetSpeed() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InterpolatedCamera", "get_speed")
+	methodBind := methodBindInterpolatedCameraGetSpeed.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +75,8 @@ func (o *InterpolatedCamera) GetSpeed() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *InterpolatedCamera) GetTargetPath() gdnative.NodePath {
 	//log.Println("Calling InterpolatedCamera.GetTargetPath()")
@@ -68,7 +85,7 @@ func (o *InterpolatedCamera) GetTargetPath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InterpolatedCamera", "get_target_path")
+	methodBind := methodBindInterpolatedCameraGetTargetPath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -81,8 +98,8 @@ func (o *InterpolatedCamera) GetTargetPath() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *InterpolatedCamera) IsInterpolationEnabled() gdnative.Bool {
 	//log.Println("Calling InterpolatedCamera.IsInterpolationEnabled()")
@@ -91,7 +108,7 @@ func (o *InterpolatedCamera) IsInterpolationEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InterpolatedCamera", "is_interpolation_enabled")
+	methodBind := methodBindInterpolatedCameraIsInterpolationEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +121,8 @@ func (o *InterpolatedCamera) IsInterpolationEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false target_path bool}], Returns: void
+	        Undocumented
+		Args: [{ false target_path bool}], Returns: void
 */
 func (o *InterpolatedCamera) SetInterpolationEnabled(targetPath gdnative.Bool) {
 	//log.Println("Calling InterpolatedCamera.SetInterpolationEnabled()")
@@ -115,7 +132,7 @@ func (o *InterpolatedCamera) SetInterpolationEnabled(targetPath gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(targetPath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InterpolatedCamera", "set_interpolation_enabled")
+	methodBind := methodBindInterpolatedCameraSetInterpolationEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +142,8 @@ func (o *InterpolatedCamera) SetInterpolationEnabled(targetPath gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false speed float}], Returns: void
+	        Undocumented
+		Args: [{ false speed float}], Returns: void
 */
 func (o *InterpolatedCamera) SetSpeed(speed gdnative.Real) {
 	//log.Println("Calling InterpolatedCamera.SetSpeed()")
@@ -136,7 +153,7 @@ func (o *InterpolatedCamera) SetSpeed(speed gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(speed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InterpolatedCamera", "set_speed")
+	methodBind := methodBindInterpolatedCameraSetSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -146,18 +163,17 @@ func (o *InterpolatedCamera) SetSpeed(speed gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false target Object}], Returns: void
+Args: [{ false target Object}], Returns: void
 */
-func (o *InterpolatedCamera) SetTarget(target ObjectImplementer) {
+func (o *InterpolatedCamera) SetTarget(target godot.ObjectImplementer) {
 	//log.Println("Calling InterpolatedCamera.SetTarget()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(target.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(target))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InterpolatedCamera", "set_target")
+	methodBind := methodBindInterpolatedCameraSetTarget.Get()
 
 	// Call the parent method.
 	// void
@@ -167,8 +183,8 @@ func (o *InterpolatedCamera) SetTarget(target ObjectImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false target_path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false target_path NodePath}], Returns: void
 */
 func (o *InterpolatedCamera) SetTargetPath(targetPath gdnative.NodePath) {
 	//log.Println("Calling InterpolatedCamera.SetTargetPath()")
@@ -178,7 +194,7 @@ func (o *InterpolatedCamera) SetTargetPath(targetPath gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(targetPath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("InterpolatedCamera", "set_target_path")
+	methodBind := methodBindInterpolatedCameraSetTargetPath.Get()
 
 	// Call the parent method.
 	// void
@@ -187,15 +203,39 @@ func (o *InterpolatedCamera) SetTargetPath(targetPath gdnative.NodePath) {
 
 }
 
+// Enabled will return the value of the "enabled" property.
+func (o *InterpolatedCamera) Enabled() gdnative.Bool {
+	return o.IsInterpolationEnabled()
+}
+
+// SetEnabled will set the value of the "enabled" property.
+func (o *InterpolatedCamera) SetEnabled(value gdnative.Bool) {
+	o.SetInterpolationEnabled(value)
+}
+
+// Speed will return the value of the "speed" property.
+func (o *InterpolatedCamera) Speed() gdnative.Real {
+	return o.GetSpeed()
+}
+
+// Target will return the value of the "target" property.
+func (o *InterpolatedCamera) Target() gdnative.NodePath {
+	return o.GetTargetPath()
+}
+
 // InterpolatedCameraImplementer is an interface that implements the methods
 // of the InterpolatedCamera class.
 type InterpolatedCameraImplementer interface {
-	CameraImplementer
+	godot.CameraImplementer
 	GetSpeed() gdnative.Real
 	GetTargetPath() gdnative.NodePath
 	IsInterpolationEnabled() gdnative.Bool
 	SetInterpolationEnabled(targetPath gdnative.Bool)
 	SetSpeed(speed gdnative.Real)
-	SetTarget(target ObjectImplementer)
+	SetTarget(target godot.ObjectImplementer)
 	SetTargetPath(targetPath gdnative.NodePath)
+	Enabled() gdnative.Bool
+	SetEnabled(value gdnative.Bool)
+	Speed() gdnative.Real
+	Target() gdnative.NodePath
 }
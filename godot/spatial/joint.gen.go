@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewJointFromPointer(ptr gdnative.Pointer) Joint {
-func newJointFromPointer(ptr gdnative.Pointer) Joint {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Joint{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 All 3D joints link two nodes, has a priority, and can decide if the two bodies of the nodes should be able to collide with each other
 */
 type Joint struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
@@ -34,9 +26,22 @@ func (o *Joint) BaseClass() string {
 	return "Joint"
 }
 
+// Method binds of the Joint class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindJointGetExcludeNodesFromCollision = gdnative.NewLazyMethodBind("Joint", "get_exclude_nodes_from_collision")
+	methodBindJointGetNodeA                     = gdnative.NewLazyMethodBind("Joint", "get_node_a")
+	methodBindJointGetNodeB                     = gdnative.NewLazyMethodBind("Joint", "get_node_b")
+	methodBindJointGetSolverPriority            = gdnative.NewLazyMethodBind("Joint", "get_solver_priority")
+	methodBindJointSetExcludeNodesFromCollision = gdnative.NewLazyMethodBind("Joint", "set_exclude_nodes_from_collision")
+	methodBindJointSetNodeA                     = gdnative.NewLazyMethodBind("Joint", "set_node_a")
+	methodBindJointSetNodeB                     = gdnative.NewLazyMethodBind("Joint", "set_node_b")
+	methodBindJointSetSolverPriority            = gdnative.NewLazyMethodBind("Joint", "set_solver_priority")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Joint) GetExcludeNodesFromCollision() gdnative.Bool {
 	//log.Println("Calling Joint.GetExcludeNodesFromCollision()")
@@ -45,7 +50,7 @@ func (o *Joint) GetExcludeNodesFromCollision() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "get_exclude_nodes_from_collision")
+	methodBind := methodBindJointGetExcludeNodesFromCollision.Get()
 
 	// Call the parent method.
 	// bool
@@ -58,8 +63,8 @@ func (o *Joint) GetExcludeNodesFromCollision() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *Joint) GetNodeA() gdnative.NodePath {
 	//log.Println("Calling Joint.GetNodeA()")
@@ -68,7 +73,7 @@ func (o *Joint) GetNodeA() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "get_node_a")
+	methodBind := methodBindJointGetNodeA.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -81,8 +86,8 @@ func (o *Joint) GetNodeA() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *Joint) GetNodeB() gdnative.NodePath {
 	//log.Println("Calling Joint.GetNodeB()")
@@ -91,7 +96,7 @@ func (o *Joint) GetNodeB() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "get_node_b")
+	methodBind := methodBindJointGetNodeB.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -104,8 +109,8 @@ func (o *Joint) GetNodeB() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Joint) GetSolverPriority() gdnative.Int {
 	//log.Println("Calling Joint.GetSolverPriority()")
@@ -114,7 +119,7 @@ func (o *Joint) GetSolverPriority() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "get_solver_priority")
+	methodBind := methodBindJointGetSolverPriority.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +132,8 @@ func (o *Joint) GetSolverPriority() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Joint) SetExcludeNodesFromCollision(enable gdnative.Bool) {
 	//log.Println("Calling Joint.SetExcludeNodesFromCollision()")
@@ -138,7 +143,7 @@ func (o *Joint) SetExcludeNodesFromCollision(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "set_exclude_nodes_from_collision")
+	methodBind := methodBindJointSetExcludeNodesFromCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +153,8 @@ func (o *Joint) SetExcludeNodesFromCollision(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false node NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false node NodePath}], Returns: void
 */
 func (o *Joint) SetNodeA(node gdnative.NodePath) {
 	//log.Println("Calling Joint.SetNodeA()")
@@ -159,7 +164,7 @@ func (o *Joint) SetNodeA(node gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(node)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "set_node_a")
+	methodBind := methodBindJointSetNodeA.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +174,8 @@ func (o *Joint) SetNodeA(node gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false node NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false node NodePath}], Returns: void
 */
 func (o *Joint) SetNodeB(node gdnative.NodePath) {
 	//log.Println("Calling Joint.SetNodeB()")
@@ -180,7 +185,7 @@ func (o *Joint) SetNodeB(node gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(node)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "set_node_b")
+	methodBind := methodBindJointSetNodeB.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +195,8 @@ func (o *Joint) SetNodeB(node gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false priority int}], Returns: void
+	        Undocumented
+		Args: [{ false priority int}], Returns: void
 */
 func (o *Joint) SetSolverPriority(priority gdnative.Int) {
 	//log.Println("Calling Joint.SetSolverPriority()")
@@ -201,7 +206,7 @@ func (o *Joint) SetSolverPriority(priority gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(priority)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Joint", "set_solver_priority")
+	methodBind := methodBindJointSetSolverPriority.Get()
 
 	// Call the parent method.
 	// void
@@ -210,10 +215,45 @@ func (o *Joint) SetSolverPriority(priority gdnative.Int) {
 
 }
 
+// CollisionExcludeNodes will return the value of the "collision/exclude_nodes" property.
+func (o *Joint) CollisionExcludeNodes() gdnative.Bool {
+	return o.GetExcludeNodesFromCollision()
+}
+
+// SetCollisionExcludeNodes will set the value of the "collision/exclude_nodes" property.
+func (o *Joint) SetCollisionExcludeNodes(value gdnative.Bool) {
+	o.SetExcludeNodesFromCollision(value)
+}
+
+// NodesNodeA will return the value of the "nodes/node_a" property.
+func (o *Joint) NodesNodeA() gdnative.NodePath {
+	return o.GetNodeA()
+}
+
+// SetNodesNodeA will set the value of the "nodes/node_a" property.
+func (o *Joint) SetNodesNodeA(value gdnative.NodePath) {
+	o.SetNodeA(value)
+}
+
+// NodesNodeB will return the value of the "nodes/node_b" property.
+func (o *Joint) NodesNodeB() gdnative.NodePath {
+	return o.GetNodeB()
+}
+
+// SetNodesNodeB will set the value of the "nodes/node_b" property.
+func (o *Joint) SetNodesNodeB(value gdnative.NodePath) {
+	o.SetNodeB(value)
+}
+
+// SolverPriority will return the value of the "solver/priority" property.
+func (o *Joint) SolverPriority() gdnative.Int {
+	return o.GetSolverPriority()
+}
+
 // JointImplementer is an interface that implements the methods
 // of the Joint class.
 type JointImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetExcludeNodesFromCollision() gdnative.Bool
 	GetNodeA() gdnative.NodePath
 	GetNodeB() gdnative.NodePath
@@ -222,4 +262,11 @@ type JointImplementer interface {
 	SetNodeA(node gdnative.NodePath)
 	SetNodeB(node gdnative.NodePath)
 	SetSolverPriority(priority gdnative.Int)
+	CollisionExcludeNodes() gdnative.Bool
+	SetCollisionExcludeNodes(value gdnative.Bool)
+	NodesNodeA() gdnative.NodePath
+	SetNodesNodeA(value gdnative.NodePath)
+	NodesNodeB() gdnative.NodePath
+	SetNodesNodeB(value gdnative.NodePath)
+	SolverPriority() gdnative.Int
 }
@@ -0,0 +1,498 @@
+package spatial
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Kinematic bodies are special types of bodies that are meant to be user-controlled. They are not affected by physics at all (to other types of bodies, such a character or a rigid body, these are the same as a static body). They have however, two main uses: Simulated Motion: When these bodies are moved manually, either from code or from an AnimationPlayer (with process mode set to fixed), the physics will automatically compute an estimate of their linear and angular velocity. This makes them very useful for moving platforms or other AnimationPlayer-controlled objects (like a door, a bridge that opens, etc). Kinematic Characters: KinematicBody also has an API for moving objects (the [method move_and_collide] and [method move_and_slide] methods) while performing collision tests. This makes them really useful to implement characters that collide against a world, but that don't require advanced physics.
+*/
+type KinematicBody struct {
+	godot.PhysicsBody
+	owner gdnative.Object
+}
+
+// classConstructorKinematicBody is the class constructor of the KinematicBody class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorKinematicBody = gdnative.NewLazyClassConstructor("KinematicBody")
+
+// NewKinematicBody will create a new instance of the KinematicBody class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewKinematicBody() *KinematicBody {
+	obj := &KinematicBody{}
+	obj.SetBaseObject(classConstructorKinematicBody.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *KinematicBody) BaseClass() string {
+	return "KinematicBody"
+}
+
+// Method binds of the KinematicBody class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindKinematicBodyGetAxisLock       = gdnative.NewLazyMethodBind("KinematicBody", "get_axis_lock")
+	methodBindKinematicBodyGetFloorVelocity  = gdnative.NewLazyMethodBind("KinematicBody", "get_floor_velocity")
+	methodBindKinematicBodyGetSafeMargin     = gdnative.NewLazyMethodBind("KinematicBody", "get_safe_margin")
+	methodBindKinematicBodyGetSlideCollision = gdnative.NewLazyMethodBind("KinematicBody", "get_slide_collision")
+	methodBindKinematicBodyGetSlideCount     = gdnative.NewLazyMethodBind("KinematicBody", "get_slide_count")
+	methodBindKinematicBodyIsOnCeiling       = gdnative.NewLazyMethodBind("KinematicBody", "is_on_ceiling")
+	methodBindKinematicBodyIsOnFloor         = gdnative.NewLazyMethodBind("KinematicBody", "is_on_floor")
+	methodBindKinematicBodyIsOnWall          = gdnative.NewLazyMethodBind("KinematicBody", "is_on_wall")
+	methodBindKinematicBodyMoveAndCollide    = gdnative.NewLazyMethodBind("KinematicBody", "move_and_collide")
+	methodBindKinematicBodyMoveAndSlide      = gdnative.NewLazyMethodBind("KinematicBody", "move_and_slide")
+	methodBindKinematicBodySetAxisLock       = gdnative.NewLazyMethodBind("KinematicBody", "set_axis_lock")
+	methodBindKinematicBodySetSafeMargin     = gdnative.NewLazyMethodBind("KinematicBody", "set_safe_margin")
+	methodBindKinematicBodyTestMove          = gdnative.NewLazyMethodBind("KinematicBody", "test_move")
+)
+
+/*
+	        Undocumented
+		Args: [{ false axis int}], Returns: bool
+*/
+func (o *KinematicBody) GetAxisLock(axis gdnative.Int) gdnative.Bool {
+	//log.Println("Calling KinematicBody.GetAxisLock()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(axis)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyGetAxisLock.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the velocity of the floor. Only updates when calling [method move_and_slide].
+		Args: [], Returns: Vector3
+*/
+func (o *KinematicBody) GetFloorVelocity() gdnative.Vector3 {
+	//log.Println("Calling KinematicBody.GetFloorVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyGetFloorVelocity.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *KinematicBody) GetSafeMargin() gdnative.Real {
+	//log.Println("Calling KinematicBody.GetSafeMargin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyGetSafeMargin.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns a [KinematicCollision], which contains information about a collision that occurred during the last [method move_and_slide] call. Since the body can collide several times in a single call to [method move_and_slide], you must specify the index of the collision in the range 0 to ([method get_slide_count] - 1).
+		Args: [{ false slide_idx int}], Returns: KinematicCollision
+*/
+func (o *KinematicBody) GetSlideCollision(slideIdx gdnative.Int) godot.KinematicCollisionImplementer {
+	//log.Println("Calling KinematicBody.GetSlideCollision()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(slideIdx)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyGetSlideCollision.Get()
+
+	// Call the parent method.
+	// KinematicCollision
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.KinematicCollision{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.KinematicCollisionImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the number of times the body collided and changed direction during the last call to [method move_and_slide].
+		Args: [], Returns: int
+*/
+func (o *KinematicBody) GetSlideCount() gdnative.Int {
+	//log.Println("Calling KinematicBody.GetSlideCount()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyGetSlideCount.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns [code]true[/code] if the body is on the ceiling. Only updates when calling [method move_and_slide].
+		Args: [], Returns: bool
+*/
+func (o *KinematicBody) IsOnCeiling() gdnative.Bool {
+	//log.Println("Calling KinematicBody.IsOnCeiling()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyIsOnCeiling.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns [code]true[/code] if the body is on the floor. Only updates when calling [method move_and_slide].
+		Args: [], Returns: bool
+*/
+func (o *KinematicBody) IsOnFloor() gdnative.Bool {
+	//log.Println("Calling KinematicBody.IsOnFloor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyIsOnFloor.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns [code]true[/code] if the body is on a wall. Only updates when calling [method move_and_slide].
+		Args: [], Returns: bool
+*/
+func (o *KinematicBody) IsOnWall() gdnative.Bool {
+	//log.Println("Calling KinematicBody.IsOnWall()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyIsOnWall.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Moves the body along the vector [code]rel_vec[/code]. The body will stop if it collides. Returns a [KinematicCollision], which contains information about the collision.
+		Args: [{ false rel_vec Vector3}], Returns: KinematicCollision
+*/
+func (o *KinematicBody) MoveAndCollide(relVec gdnative.Vector3) godot.KinematicCollisionImplementer {
+	//log.Println("Calling KinematicBody.MoveAndCollide()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(relVec)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyMoveAndCollide.Get()
+
+	// Call the parent method.
+	// KinematicCollision
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.KinematicCollision{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.KinematicCollisionImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Moves the body along a vector. If the body collides with another, it will slide along the other body rather than stop immediately. If the other body is a [code]KinematicBody[/code] or [RigidBody], it will also be affected by the motion of the other body. You can use this to make moving or rotating platforms, or to make nodes push other nodes. [code]linear_velocity[/code] is a value in pixels per second. Unlike in for example [method move_and_collide], you should [i]not[/i] multiply it with [code]delta[/code] — this is done by the method. [code]floor_normal[/code] is the up direction, used to determine what is a wall and what is a floor or a ceiling. If set to the default value of [code]Vector3(0, 0, 0)[/code], everything is considered a wall. This is useful for topdown games. If the body is standing on a slope and the horizontal speed (relative to the floor's speed) goes below [code]slope_stop_min_velocity[/code], the body will stop completely. This prevents the body from sliding down slopes when you include gravity in [code]linear_velocity[/code]. When set to lower values, the body will not be able to stand still on steep slopes. If the body collides, it will change direction a maximum of [code]max_slides[/code] times before it stops. [code]floor_max_angle[/code] is the maximum angle (in radians) where a slope is still considered a floor (or a ceiling), rather than a wall. The default value equals 45 degrees. Returns the movement that remained when the body stopped. To get more detailed information about collisions that occurred, use [method get_slide_collision].
+		Args: [{ false linear_velocity Vector3} {(0, 0, 0) true floor_normal Vector3} {0.05 true slope_stop_min_velocity float} {4 true max_slides int} {0.785398 true floor_max_angle float}], Returns: Vector3
+*/
+func (o *KinematicBody) MoveAndSlide(linearVelocity gdnative.Vector3, floorNormal gdnative.Vector3, slopeStopMinVelocity gdnative.Real, maxSlides gdnative.Int, floorMaxAngle gdnative.Real) gdnative.Vector3 {
+	//log.Println("Calling KinematicBody.MoveAndSlide()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 5, 5)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(linearVelocity)
+	ptrArguments[1] = gdnative.NewPointerFromVector3(floorNormal)
+	ptrArguments[2] = gdnative.NewPointerFromReal(slopeStopMinVelocity)
+	ptrArguments[3] = gdnative.NewPointerFromInt(maxSlides)
+	ptrArguments[4] = gdnative.NewPointerFromReal(floorMaxAngle)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyMoveAndSlide.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false axis int} { false lock bool}], Returns: void
+*/
+func (o *KinematicBody) SetAxisLock(axis gdnative.Int, lock gdnative.Bool) {
+	//log.Println("Calling KinematicBody.SetAxisLock()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(axis)
+	ptrArguments[1] = gdnative.NewPointerFromBool(lock)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodySetAxisLock.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false pixels float}], Returns: void
+*/
+func (o *KinematicBody) SetSafeMargin(pixels gdnative.Real) {
+	//log.Println("Calling KinematicBody.SetSafeMargin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(pixels)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodySetSafeMargin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Checks for collisions without moving the body. Virtually sets the node's position, scale and rotation to that of the given [Transform], then tries to move the body along the vector [code]rel_vec[/code]. Returns [code]true[/code] if a collision would occur.
+		Args: [{ false from Transform} { false rel_vec Vector3}], Returns: bool
+*/
+func (o *KinematicBody) TestMove(from gdnative.Transform, relVec gdnative.Vector3) gdnative.Bool {
+	//log.Println("Calling KinematicBody.TestMove()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromTransform(from)
+	ptrArguments[1] = gdnative.NewPointerFromVector3(relVec)
+
+	// Get the method bind
+	methodBind := methodBindKinematicBodyTestMove.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+// MoveAndSlideWithDefaults will call MoveAndSlide using the default values for: floor_normal, slope_stop_min_velocity, max_slides, floor_max_angle.
+func (o *KinematicBody) MoveAndSlideWithDefaults(linearVelocity gdnative.Vector3) gdnative.Vector3 {
+	return o.MoveAndSlide(linearVelocity, gdnative.NewVector3(0, 0, 0), 0.05, 4, 0.785398)
+}
+
+// AxisLockAngularX will return the value of the "axis_lock_angular_x" property.
+func (o *KinematicBody) AxisLockAngularX() gdnative.Bool {
+	return o.GetAxisLock(8)
+}
+
+// SetAxisLockAngularX will set the value of the "axis_lock_angular_x" property.
+func (o *KinematicBody) SetAxisLockAngularX(value gdnative.Bool) {
+	o.SetAxisLock(8, value)
+}
+
+// AxisLockAngularY will return the value of the "axis_lock_angular_y" property.
+func (o *KinematicBody) AxisLockAngularY() gdnative.Bool {
+	return o.GetAxisLock(16)
+}
+
+// SetAxisLockAngularY will set the value of the "axis_lock_angular_y" property.
+func (o *KinematicBody) SetAxisLockAngularY(value gdnative.Bool) {
+	o.SetAxisLock(16, value)
+}
+
+// AxisLockAngularZ will return the value of the "axis_lock_angular_z" property.
+func (o *KinematicBody) AxisLockAngularZ() gdnative.Bool {
+	return o.GetAxisLock(32)
+}
+
+// SetAxisLockAngularZ will set the value of the "axis_lock_angular_z" property.
+func (o *KinematicBody) SetAxisLockAngularZ(value gdnative.Bool) {
+	o.SetAxisLock(32, value)
+}
+
+// AxisLockLinearX will return the value of the "axis_lock_linear_x" property.
+func (o *KinematicBody) AxisLockLinearX() gdnative.Bool {
+	return o.GetAxisLock(1)
+}
+
+// SetAxisLockLinearX will set the value of the "axis_lock_linear_x" property.
+func (o *KinematicBody) SetAxisLockLinearX(value gdnative.Bool) {
+	o.SetAxisLock(1, value)
+}
+
+// AxisLockLinearY will return the value of the "axis_lock_linear_y" property.
+func (o *KinematicBody) AxisLockLinearY() gdnative.Bool {
+	return o.GetAxisLock(2)
+}
+
+// SetAxisLockLinearY will set the value of the "axis_lock_linear_y" property.
+func (o *KinematicBody) SetAxisLockLinearY(value gdnative.Bool) {
+	o.SetAxisLock(2, value)
+}
+
+// AxisLockLinearZ will return the value of the "axis_lock_linear_z" property.
+func (o *KinematicBody) AxisLockLinearZ() gdnative.Bool {
+	return o.GetAxisLock(4)
+}
+
+// SetAxisLockLinearZ will set the value of the "axis_lock_linear_z" property.
+func (o *KinematicBody) SetAxisLockLinearZ(value gdnative.Bool) {
+	o.SetAxisLock(4, value)
+}
+
+// CollisionSafeMargin will return the value of the "collision/safe_margin" property.
+func (o *KinematicBody) CollisionSafeMargin() gdnative.Real {
+	return o.GetSafeMargin()
+}
+
+// SetCollisionSafeMargin will set the value of the "collision/safe_margin" property.
+func (o *KinematicBody) SetCollisionSafeMargin(value gdnative.Real) {
+	o.SetSafeMargin(value)
+}
+
+// KinematicBodyImplementer is an interface that implements the methods
+// of the KinematicBody class.
+type KinematicBodyImplementer interface {
+	godot.PhysicsBodyImplementer
+	GetAxisLock(axis gdnative.Int) gdnative.Bool
+	GetFloorVelocity() gdnative.Vector3
+	GetSafeMargin() gdnative.Real
+	GetSlideCollision(slideIdx gdnative.Int) godot.KinematicCollisionImplementer
+	GetSlideCount() gdnative.Int
+	IsOnCeiling() gdnative.Bool
+	IsOnFloor() gdnative.Bool
+	IsOnWall() gdnative.Bool
+	MoveAndCollide(relVec gdnative.Vector3) godot.KinematicCollisionImplementer
+	MoveAndSlide(linearVelocity gdnative.Vector3, floorNormal gdnative.Vector3, slopeStopMinVelocity gdnative.Real, maxSlides gdnative.Int, floorMaxAngle gdnative.Real) gdnative.Vector3
+	SetAxisLock(axis gdnative.Int, lock gdnative.Bool)
+	SetSafeMargin(pixels gdnative.Real)
+	TestMove(from gdnative.Transform, relVec gdnative.Vector3) gdnative.Bool
+	MoveAndSlideWithDefaults(linearVelocity gdnative.Vector3) gdnative.Vector3
+	AxisLockAngularX() gdnative.Bool
+	SetAxisLockAngularX(value gdnative.Bool)
+	AxisLockAngularY() gdnative.Bool
+	SetAxisLockAngularY(value gdnative.Bool)
+	AxisLockAngularZ() gdnative.Bool
+	SetAxisLockAngularZ(value gdnative.Bool)
+	AxisLockLinearX() gdnative.Bool
+	SetAxisLockLinearX(value gdnative.Bool)
+	AxisLockLinearY() gdnative.Bool
+	SetAxisLockLinearY(value gdnative.Bool)
+	AxisLockLinearZ() gdnative.Bool
+	SetAxisLockLinearZ(value gdnative.Bool)
+	CollisionSafeMargin() gdnative.Real
+	SetCollisionSafeMargin(value gdnative.Real)
+}
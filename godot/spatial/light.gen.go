@@ -0,0 +1,746 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// LightBakeMode is an enum for BakeMode values.
+type LightBakeMode int
+
+const (
+	LightBakeAll      LightBakeMode = 2
+	LightBakeDisabled LightBakeMode = 0
+	LightBakeIndirect LightBakeMode = 1
+)
+
+// String will return the Godot name of the LightBakeMode value.
+func (e LightBakeMode) String() string {
+	switch e {
+	case LightBakeAll:
+		return "BAKE_ALL"
+	case LightBakeDisabled:
+		return "BAKE_DISABLED"
+	case LightBakeIndirect:
+		return "BAKE_INDIRECT"
+	}
+	return fmt.Sprintf("LightBakeMode(%d)", int(e))
+}
+
+// ParseLightBakeMode will return the LightBakeMode value with the given Godot name.
+func ParseLightBakeMode(name string) (LightBakeMode, error) {
+	switch name {
+	case "BAKE_ALL":
+		return LightBakeAll, nil
+	case "BAKE_DISABLED":
+		return LightBakeDisabled, nil
+	case "BAKE_INDIRECT":
+		return LightBakeIndirect, nil
+	}
+	return 0, fmt.Errorf("invalid LightBakeMode value %q", name)
+}
+
+// LightParam is an enum for Param values.
+type LightParam int
+
+const (
+	LightParamAttenuation          LightParam = 4
+	LightParamContactShadowSize    LightParam = 7
+	LightParamEnergy               LightParam = 0
+	LightParamIndirectEnergy       LightParam = 1
+	LightParamMax                  LightParam = 15
+	LightParamRange                LightParam = 3
+	LightParamShadowBias           LightParam = 13
+	LightParamShadowBiasSplitScale LightParam = 14
+	LightParamShadowMaxDistance    LightParam = 8
+	LightParamShadowNormalBias     LightParam = 12
+	LightParamShadowSplit1Offset   LightParam = 9
+	LightParamShadowSplit2Offset   LightParam = 10
+	LightParamShadowSplit3Offset   LightParam = 11
+	LightParamSpecular             LightParam = 2
+	LightParamSpotAngle            LightParam = 5
+	LightParamSpotAttenuation      LightParam = 6
+)
+
+// String will return the Godot name of the LightParam value.
+func (e LightParam) String() string {
+	switch e {
+	case LightParamAttenuation:
+		return "PARAM_ATTENUATION"
+	case LightParamContactShadowSize:
+		return "PARAM_CONTACT_SHADOW_SIZE"
+	case LightParamEnergy:
+		return "PARAM_ENERGY"
+	case LightParamIndirectEnergy:
+		return "PARAM_INDIRECT_ENERGY"
+	case LightParamMax:
+		return "PARAM_MAX"
+	case LightParamRange:
+		return "PARAM_RANGE"
+	case LightParamShadowBias:
+		return "PARAM_SHADOW_BIAS"
+	case LightParamShadowBiasSplitScale:
+		return "PARAM_SHADOW_BIAS_SPLIT_SCALE"
+	case LightParamShadowMaxDistance:
+		return "PARAM_SHADOW_MAX_DISTANCE"
+	case LightParamShadowNormalBias:
+		return "PARAM_SHADOW_NORMAL_BIAS"
+	case LightParamShadowSplit1Offset:
+		return "PARAM_SHADOW_SPLIT_1_OFFSET"
+	case LightParamShadowSplit2Offset:
+		return "PARAM_SHADOW_SPLIT_2_OFFSET"
+	case LightParamShadowSplit3Offset:
+		return "PARAM_SHADOW_SPLIT_3_OFFSET"
+	case LightParamSpecular:
+		return "PARAM_SPECULAR"
+	case LightParamSpotAngle:
+		return "PARAM_SPOT_ANGLE"
+	case LightParamSpotAttenuation:
+		return "PARAM_SPOT_ATTENUATION"
+	}
+	return fmt.Sprintf("LightParam(%d)", int(e))
+}
+
+// ParseLightParam will return the LightParam value with the given Godot name.
+func ParseLightParam(name string) (LightParam, error) {
+	switch name {
+	case "PARAM_ATTENUATION":
+		return LightParamAttenuation, nil
+	case "PARAM_CONTACT_SHADOW_SIZE":
+		return LightParamContactShadowSize, nil
+	case "PARAM_ENERGY":
+		return LightParamEnergy, nil
+	case "PARAM_INDIRECT_ENERGY":
+		return LightParamIndirectEnergy, nil
+	case "PARAM_MAX":
+		return LightParamMax, nil
+	case "PARAM_RANGE":
+		return LightParamRange, nil
+	case "PARAM_SHADOW_BIAS":
+		return LightParamShadowBias, nil
+	case "PARAM_SHADOW_BIAS_SPLIT_SCALE":
+		return LightParamShadowBiasSplitScale, nil
+	case "PARAM_SHADOW_MAX_DISTANCE":
+		return LightParamShadowMaxDistance, nil
+	case "PARAM_SHADOW_NORMAL_BIAS":
+		return LightParamShadowNormalBias, nil
+	case "PARAM_SHADOW_SPLIT_1_OFFSET":
+		return LightParamShadowSplit1Offset, nil
+	case "PARAM_SHADOW_SPLIT_2_OFFSET":
+		return LightParamShadowSplit2Offset, nil
+	case "PARAM_SHADOW_SPLIT_3_OFFSET":
+		return LightParamShadowSplit3Offset, nil
+	case "PARAM_SPECULAR":
+		return LightParamSpecular, nil
+	case "PARAM_SPOT_ANGLE":
+		return LightParamSpotAngle, nil
+	case "PARAM_SPOT_ATTENUATION":
+		return LightParamSpotAttenuation, nil
+	}
+	return 0, fmt.Errorf("invalid LightParam value %q", name)
+}
+
+/*
+Light is the abstract base class for light nodes, so it shouldn't be used directly (It can't be instanced). Other types of light nodes inherit from it. Light contains the common variables and parameters used for lighting.
+*/
+type Light struct {
+	VisualInstance
+	owner gdnative.Object
+}
+
+func (o *Light) BaseClass() string {
+	return "Light"
+}
+
+// Method binds of the Light class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindLightGetBakeMode              = gdnative.NewLazyMethodBind("Light", "get_bake_mode")
+	methodBindLightGetColor                 = gdnative.NewLazyMethodBind("Light", "get_color")
+	methodBindLightGetCullMask              = gdnative.NewLazyMethodBind("Light", "get_cull_mask")
+	methodBindLightGetParam                 = gdnative.NewLazyMethodBind("Light", "get_param")
+	methodBindLightGetShadowColor           = gdnative.NewLazyMethodBind("Light", "get_shadow_color")
+	methodBindLightGetShadowReverseCullFace = gdnative.NewLazyMethodBind("Light", "get_shadow_reverse_cull_face")
+	methodBindLightHasShadow                = gdnative.NewLazyMethodBind("Light", "has_shadow")
+	methodBindLightIsEditorOnly             = gdnative.NewLazyMethodBind("Light", "is_editor_only")
+	methodBindLightIsNegative               = gdnative.NewLazyMethodBind("Light", "is_negative")
+	methodBindLightSetBakeMode              = gdnative.NewLazyMethodBind("Light", "set_bake_mode")
+	methodBindLightSetColor                 = gdnative.NewLazyMethodBind("Light", "set_color")
+	methodBindLightSetCullMask              = gdnative.NewLazyMethodBind("Light", "set_cull_mask")
+	methodBindLightSetEditorOnly            = gdnative.NewLazyMethodBind("Light", "set_editor_only")
+	methodBindLightSetNegative              = gdnative.NewLazyMethodBind("Light", "set_negative")
+	methodBindLightSetParam                 = gdnative.NewLazyMethodBind("Light", "set_param")
+	methodBindLightSetShadow                = gdnative.NewLazyMethodBind("Light", "set_shadow")
+	methodBindLightSetShadowColor           = gdnative.NewLazyMethodBind("Light", "set_shadow_color")
+	methodBindLightSetShadowReverseCullFace = gdnative.NewLazyMethodBind("Light", "set_shadow_reverse_cull_face")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Light::BakeMode
+*/
+func (o *Light) GetBakeMode() LightBakeMode {
+	//log.Println("Calling Light.GetBakeMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightGetBakeMode.Get()
+
+	// Call the parent method.
+	// enum.Light::BakeMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return LightBakeMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *Light) GetColor() gdnative.Color {
+	//log.Println("Calling Light.GetColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightGetColor.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Light) GetCullMask() gdnative.Int {
+	//log.Println("Calling Light.GetCullMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightGetCullMask.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Light::Param}], Returns: float
+*/
+func (o *Light) GetParam(param LightParam) gdnative.Real {
+	//log.Println("Calling Light.GetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindLightGetParam.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *Light) GetShadowColor() gdnative.Color {
+	//log.Println("Calling Light.GetShadowColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightGetShadowColor.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Light) GetShadowReverseCullFace() gdnative.Bool {
+	//log.Println("Calling Light.GetShadowReverseCullFace()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightGetShadowReverseCullFace.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Light) HasShadow() gdnative.Bool {
+	//log.Println("Calling Light.HasShadow()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightHasShadow.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Light) IsEditorOnly() gdnative.Bool {
+	//log.Println("Calling Light.IsEditorOnly()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightIsEditorOnly.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Light) IsNegative() gdnative.Bool {
+	//log.Println("Calling Light.IsNegative()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindLightIsNegative.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false bake_mode enum.Light::BakeMode}], Returns: void
+*/
+func (o *Light) SetBakeMode(bakeMode LightBakeMode) {
+	//log.Println("Calling Light.SetBakeMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(bakeMode))
+
+	// Get the method bind
+	methodBind := methodBindLightSetBakeMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
+*/
+func (o *Light) SetColor(color gdnative.Color) {
+	//log.Println("Calling Light.SetColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(color)
+
+	// Get the method bind
+	methodBind := methodBindLightSetColor.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false cull_mask int}], Returns: void
+*/
+func (o *Light) SetCullMask(cullMask gdnative.Int) {
+	//log.Println("Calling Light.SetCullMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(cullMask)
+
+	// Get the method bind
+	methodBind := methodBindLightSetCullMask.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false editor_only bool}], Returns: void
+*/
+func (o *Light) SetEditorOnly(editorOnly gdnative.Bool) {
+	//log.Println("Calling Light.SetEditorOnly()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(editorOnly)
+
+	// Get the method bind
+	methodBind := methodBindLightSetEditorOnly.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *Light) SetNegative(enabled gdnative.Bool) {
+	//log.Println("Calling Light.SetNegative()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindLightSetNegative.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.Light::Param} { false value float}], Returns: void
+*/
+func (o *Light) SetParam(param LightParam, value gdnative.Real) {
+	//log.Println("Calling Light.SetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindLightSetParam.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *Light) SetShadow(enabled gdnative.Bool) {
+	//log.Println("Calling Light.SetShadow()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindLightSetShadow.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false shadow_color Color}], Returns: void
+*/
+func (o *Light) SetShadowColor(shadowColor gdnative.Color) {
+	//log.Println("Calling Light.SetShadowColor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(shadowColor)
+
+	// Get the method bind
+	methodBind := methodBindLightSetShadowColor.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Light) SetShadowReverseCullFace(enable gdnative.Bool) {
+	//log.Println("Calling Light.SetShadowReverseCullFace()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindLightSetShadowReverseCullFace.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// EditorOnly will return the value of the "editor_only" property.
+func (o *Light) EditorOnly() gdnative.Bool {
+	return o.IsEditorOnly()
+}
+
+// LightBakeMode will return the value of the "light_bake_mode" property.
+func (o *Light) LightBakeMode() LightBakeMode {
+	return o.GetBakeMode()
+}
+
+// SetLightBakeMode will set the value of the "light_bake_mode" property.
+func (o *Light) SetLightBakeMode(value LightBakeMode) {
+	o.SetBakeMode(value)
+}
+
+// LightColor will return the value of the "light_color" property.
+func (o *Light) LightColor() gdnative.Color {
+	return o.GetColor()
+}
+
+// SetLightColor will set the value of the "light_color" property.
+func (o *Light) SetLightColor(value gdnative.Color) {
+	o.SetColor(value)
+}
+
+// LightCullMask will return the value of the "light_cull_mask" property.
+func (o *Light) LightCullMask() gdnative.Int {
+	return o.GetCullMask()
+}
+
+// SetLightCullMask will set the value of the "light_cull_mask" property.
+func (o *Light) SetLightCullMask(value gdnative.Int) {
+	o.SetCullMask(value)
+}
+
+// LightEnergy will return the value of the "light_energy" property.
+func (o *Light) LightEnergy() gdnative.Real {
+	return o.GetParam(0)
+}
+
+// SetLightEnergy will set the value of the "light_energy" property.
+func (o *Light) SetLightEnergy(value gdnative.Real) {
+	o.SetParam(0, value)
+}
+
+// LightIndirectEnergy will return the value of the "light_indirect_energy" property.
+func (o *Light) LightIndirectEnergy() gdnative.Real {
+	return o.GetParam(1)
+}
+
+// SetLightIndirectEnergy will set the value of the "light_indirect_energy" property.
+func (o *Light) SetLightIndirectEnergy(value gdnative.Real) {
+	o.SetParam(1, value)
+}
+
+// LightNegative will return the value of the "light_negative" property.
+func (o *Light) LightNegative() gdnative.Bool {
+	return o.IsNegative()
+}
+
+// SetLightNegative will set the value of the "light_negative" property.
+func (o *Light) SetLightNegative(value gdnative.Bool) {
+	o.SetNegative(value)
+}
+
+// LightSpecular will return the value of the "light_specular" property.
+func (o *Light) LightSpecular() gdnative.Real {
+	return o.GetParam(2)
+}
+
+// SetLightSpecular will set the value of the "light_specular" property.
+func (o *Light) SetLightSpecular(value gdnative.Real) {
+	o.SetParam(2, value)
+}
+
+// ShadowBias will return the value of the "shadow_bias" property.
+func (o *Light) ShadowBias() gdnative.Real {
+	return o.GetParam(13)
+}
+
+// SetShadowBias will set the value of the "shadow_bias" property.
+func (o *Light) SetShadowBias(value gdnative.Real) {
+	o.SetParam(13, value)
+}
+
+// ShadowColor will return the value of the "shadow_color" property.
+func (o *Light) ShadowColor() gdnative.Color {
+	return o.GetShadowColor()
+}
+
+// ShadowContact will return the value of the "shadow_contact" property.
+func (o *Light) ShadowContact() gdnative.Real {
+	return o.GetParam(7)
+}
+
+// SetShadowContact will set the value of the "shadow_contact" property.
+func (o *Light) SetShadowContact(value gdnative.Real) {
+	o.SetParam(7, value)
+}
+
+// ShadowEnabled will return the value of the "shadow_enabled" property.
+func (o *Light) ShadowEnabled() gdnative.Bool {
+	return o.HasShadow()
+}
+
+// SetShadowEnabled will set the value of the "shadow_enabled" property.
+func (o *Light) SetShadowEnabled(value gdnative.Bool) {
+	o.SetShadow(value)
+}
+
+// ShadowReverseCullFace will return the value of the "shadow_reverse_cull_face" property.
+func (o *Light) ShadowReverseCullFace() gdnative.Bool {
+	return o.GetShadowReverseCullFace()
+}
+
+// LightImplementer is an interface that implements the methods
+// of the Light class.
+type LightImplementer interface {
+	VisualInstanceImplementer
+	GetBakeMode() LightBakeMode
+	GetColor() gdnative.Color
+	GetCullMask() gdnative.Int
+	GetParam(param LightParam) gdnative.Real
+	GetShadowColor() gdnative.Color
+	GetShadowReverseCullFace() gdnative.Bool
+	HasShadow() gdnative.Bool
+	IsEditorOnly() gdnative.Bool
+	IsNegative() gdnative.Bool
+	SetBakeMode(bakeMode LightBakeMode)
+	SetColor(color gdnative.Color)
+	SetCullMask(cullMask gdnative.Int)
+	SetEditorOnly(editorOnly gdnative.Bool)
+	SetNegative(enabled gdnative.Bool)
+	SetParam(param LightParam, value gdnative.Real)
+	SetShadow(enabled gdnative.Bool)
+	SetShadowColor(shadowColor gdnative.Color)
+	SetShadowReverseCullFace(enable gdnative.Bool)
+	EditorOnly() gdnative.Bool
+	LightBakeMode() LightBakeMode
+	SetLightBakeMode(value LightBakeMode)
+	LightColor() gdnative.Color
+	SetLightColor(value gdnative.Color)
+	LightCullMask() gdnative.Int
+	SetLightCullMask(value gdnative.Int)
+	LightEnergy() gdnative.Real
+	SetLightEnergy(value gdnative.Real)
+	LightIndirectEnergy() gdnative.Real
+	SetLightIndirectEnergy(value gdnative.Real)
+	LightNegative() gdnative.Bool
+	SetLightNegative(value gdnative.Bool)
+	LightSpecular() gdnative.Real
+	SetLightSpecular(value gdnative.Real)
+	ShadowBias() gdnative.Real
+	SetShadowBias(value gdnative.Real)
+	ShadowColor() gdnative.Color
+	ShadowContact() gdnative.Real
+	SetShadowContact(value gdnative.Real)
+	ShadowEnabled() gdnative.Bool
+	SetShadowEnabled(value gdnative.Bool)
+	ShadowReverseCullFace() gdnative.Bool
+}
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,42 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewListenerFromPointer(ptr gdnative.Pointer) Listener {
-func newListenerFromPointer(ptr gdnative.Pointer) Listener {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Listener{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type Listener struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorListener is the class constructor of the Listener class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorListener = gdnative.NewLazyClassConstructor("Listener")
+
+// NewListener will create a new instance of the Listener class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewListener() *Listener {
+	obj := &Listener{}
+	obj.SetBaseObject(classConstructorListener.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Listener) BaseClass() string {
 	return "Listener"
 }
 
-/*
+// Method binds of the Listener class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindListenerClearCurrent         = gdnative.NewLazyMethodBind("Listener", "clear_current")
+	methodBindListenerGetListenerTransform = gdnative.NewLazyMethodBind("Listener", "get_listener_transform")
+	methodBindListenerIsCurrent            = gdnative.NewLazyMethodBind("Listener", "is_current")
+	methodBindListenerMakeCurrent          = gdnative.NewLazyMethodBind("Listener", "make_current")
+)
 
-	Args: [], Returns: void
+/*
+Args: [], Returns: void
 */
 func (o *Listener) ClearCurrent() {
 	//log.Println("Calling Listener.ClearCurrent()")
@@ -45,7 +58,7 @@ func (o *Listener) ClearCurrent() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Listener", "clear_current")
+	methodBind := methodBindListenerClearCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +68,7 @@ func (o *Listener) ClearCurrent() {
 }
 
 /*
-
-	Args: [], Returns: Transform
+Args: [], Returns: Transform
 */
 func (o *Listener) GetListenerTransform() gdnative.Transform {
 	//log.Println("Calling Listener.GetListenerTransform()")
@@ -65,7 +77,7 @@ func (o *Listener) GetListenerTransform() gdnative.Transform {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Listener", "get_listener_transform")
+	methodBind := methodBindListenerGetListenerTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -78,8 +90,7 @@ func (o *Listener) GetListenerTransform() gdnative.Transform {
 }
 
 /*
-
-	Args: [], Returns: bool
+Args: [], Returns: bool
 */
 func (o *Listener) IsCurrent() gdnative.Bool {
 	//log.Println("Calling Listener.IsCurrent()")
@@ -88,7 +99,7 @@ func (o *Listener) IsCurrent() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Listener", "is_current")
+	methodBind := methodBindListenerIsCurrent.Get()
 
 	// Call the parent method.
 	// bool
@@ -101,8 +112,7 @@ func (o *Listener) IsCurrent() gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *Listener) MakeCurrent() {
 	//log.Println("Calling Listener.MakeCurrent()")
@@ -111,7 +121,7 @@ func (o *Listener) MakeCurrent() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Listener", "make_current")
+	methodBind := methodBindListenerMakeCurrent.Get()
 
 	// Call the parent method.
 	// void
@@ -123,7 +133,7 @@ func (o *Listener) MakeCurrent() {
 // ListenerImplementer is an interface that implements the methods
 // of the Listener class.
 type ListenerImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	ClearCurrent()
 	GetListenerTransform() gdnative.Transform
 	IsCurrent() gdnative.Bool
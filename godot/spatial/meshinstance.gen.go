@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewMeshInstanceFromPointer(ptr gdnative.Pointer) MeshInstance {
-func newMeshInstanceFromPointer(ptr gdnative.Pointer) MeshInstance {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := MeshInstance{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 MeshInstance is a node that takes a [Mesh] resource and adds it to the current scenario by creating an instance of it. This is the class most often used to get 3D geometry rendered and can be used to instance a single [Mesh] in many places. This allows to reuse geometry and save on resources. When a [Mesh] has to be instanced more than thousands of times at close proximity, consider using a [MultiMesh] in a [MultiMeshInstance] instead.
 */
@@ -30,13 +22,42 @@ type MeshInstance struct {
 	owner gdnative.Object
 }
 
+// classConstructorMeshInstance is the class constructor of the MeshInstance class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMeshInstance = gdnative.NewLazyClassConstructor("MeshInstance")
+
+// NewMeshInstance will create a new instance of the MeshInstance class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewMeshInstance() *MeshInstance {
+	obj := &MeshInstance{}
+	obj.SetBaseObject(classConstructorMeshInstance.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *MeshInstance) BaseClass() string {
 	return "MeshInstance"
 }
 
+// Method binds of the MeshInstance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMeshInstanceX_MeshChanged          = gdnative.NewLazyMethodBind("MeshInstance", "_mesh_changed")
+	methodBindMeshInstanceCreateConvexCollision  = gdnative.NewLazyMethodBind("MeshInstance", "create_convex_collision")
+	methodBindMeshInstanceCreateDebugTangents    = gdnative.NewLazyMethodBind("MeshInstance", "create_debug_tangents")
+	methodBindMeshInstanceCreateTrimeshCollision = gdnative.NewLazyMethodBind("MeshInstance", "create_trimesh_collision")
+	methodBindMeshInstanceGetMesh                = gdnative.NewLazyMethodBind("MeshInstance", "get_mesh")
+	methodBindMeshInstanceGetSkeletonPath        = gdnative.NewLazyMethodBind("MeshInstance", "get_skeleton_path")
+	methodBindMeshInstanceGetSurfaceMaterial     = gdnative.NewLazyMethodBind("MeshInstance", "get_surface_material")
+	methodBindMeshInstanceSetMesh                = gdnative.NewLazyMethodBind("MeshInstance", "set_mesh")
+	methodBindMeshInstanceSetSkeletonPath        = gdnative.NewLazyMethodBind("MeshInstance", "set_skeleton_path")
+	methodBindMeshInstanceSetSurfaceMaterial     = gdnative.NewLazyMethodBind("MeshInstance", "set_surface_material")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *MeshInstance) X_MeshChanged() {
 	//log.Println("Calling MeshInstance.X_MeshChanged()")
@@ -45,7 +66,7 @@ func (o *MeshInstance) X_MeshChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "_mesh_changed")
+	methodBind := methodBindMeshInstanceX_MeshChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +76,8 @@ func (o *MeshInstance) X_MeshChanged() {
 }
 
 /*
-        This helper creates a [StaticBody] child node with a [ConvexPolygonShape] collision shape calculated from the mesh geometry. It's mainly used for testing.
-	Args: [], Returns: void
+	        This helper creates a [StaticBody] child node with a [ConvexPolygonShape] collision shape calculated from the mesh geometry. It's mainly used for testing.
+		Args: [], Returns: void
 */
 func (o *MeshInstance) CreateConvexCollision() {
 	//log.Println("Calling MeshInstance.CreateConvexCollision()")
@@ -65,7 +86,7 @@ func (o *MeshInstance) CreateConvexCollision() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "create_convex_collision")
+	methodBind := methodBindMeshInstanceCreateConvexCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -75,8 +96,8 @@ func (o *MeshInstance) CreateConvexCollision() {
 }
 
 /*
-        This helper creates a [MeshInstance] child node with gizmos at every vertex calculated from the mesh geometry. It's mainly used for testing.
-	Args: [], Returns: void
+	        This helper creates a [MeshInstance] child node with gizmos at every vertex calculated from the mesh geometry. It's mainly used for testing.
+		Args: [], Returns: void
 */
 func (o *MeshInstance) CreateDebugTangents() {
 	//log.Println("Calling MeshInstance.CreateDebugTangents()")
@@ -85,7 +106,7 @@ func (o *MeshInstance) CreateDebugTangents() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "create_debug_tangents")
+	methodBind := methodBindMeshInstanceCreateDebugTangents.Get()
 
 	// Call the parent method.
 	// void
@@ -95,8 +116,8 @@ func (o *MeshInstance) CreateDebugTangents() {
 }
 
 /*
-        This helper creates a [StaticBody] child node with a [ConcavePolygonShape] collision shape calculated from the mesh geometry. It's mainly used for testing.
-	Args: [], Returns: void
+	        This helper creates a [StaticBody] child node with a [ConcavePolygonShape] collision shape calculated from the mesh geometry. It's mainly used for testing.
+		Args: [], Returns: void
 */
 func (o *MeshInstance) CreateTrimeshCollision() {
 	//log.Println("Calling MeshInstance.CreateTrimeshCollision()")
@@ -105,7 +126,7 @@ func (o *MeshInstance) CreateTrimeshCollision() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "create_trimesh_collision")
+	methodBind := methodBindMeshInstanceCreateTrimeshCollision.Get()
 
 	// Call the parent method.
 	// void
@@ -115,17 +136,17 @@ func (o *MeshInstance) CreateTrimeshCollision() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Mesh
+	        Undocumented
+		Args: [], Returns: Mesh
 */
-func (o *MeshInstance) GetMesh() MeshImplementer {
+func (o *MeshInstance) GetMesh() godot.MeshImplementer {
 	//log.Println("Calling MeshInstance.GetMesh()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "get_mesh")
+	methodBind := methodBindMeshInstanceGetMesh.Get()
 
 	// Call the parent method.
 	// Mesh
@@ -133,27 +154,25 @@ func (o *MeshInstance) GetMesh() MeshImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Mesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MeshImplementer)
+	ret := godot.Mesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *MeshInstance) GetSkeletonPath() gdnative.NodePath {
 	//log.Println("Calling MeshInstance.GetSkeletonPath()")
@@ -162,7 +181,7 @@ func (o *MeshInstance) GetSkeletonPath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "get_skeleton_path")
+	methodBind := methodBindMeshInstanceGetSkeletonPath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -175,10 +194,10 @@ func (o *MeshInstance) GetSkeletonPath() gdnative.NodePath {
 }
 
 /*
-        Returns the [Material] for a surface of the [Mesh] resource.
-	Args: [{ false surface int}], Returns: Material
+	        Returns the [Material] for a surface of the [Mesh] resource.
+		Args: [{ false surface int}], Returns: Material
 */
-func (o *MeshInstance) GetSurfaceMaterial(surface gdnative.Int) MaterialImplementer {
+func (o *MeshInstance) GetSurfaceMaterial(surface gdnative.Int) godot.MaterialImplementer {
 	//log.Println("Calling MeshInstance.GetSurfaceMaterial()")
 
 	// Build out the method's arguments
@@ -186,7 +205,7 @@ func (o *MeshInstance) GetSurfaceMaterial(surface gdnative.Int) MaterialImplemen
 	ptrArguments[0] = gdnative.NewPointerFromInt(surface)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "get_surface_material")
+	methodBind := methodBindMeshInstanceGetSurfaceMaterial.Get()
 
 	// Call the parent method.
 	// Material
@@ -194,37 +213,35 @@ func (o *MeshInstance) GetSurfaceMaterial(surface gdnative.Int) MaterialImplemen
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := godot.Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false mesh Mesh}], Returns: void
+	        Undocumented
+		Args: [{ false mesh Mesh}], Returns: void
 */
-func (o *MeshInstance) SetMesh(mesh MeshImplementer) {
+func (o *MeshInstance) SetMesh(mesh godot.MeshImplementer) {
 	//log.Println("Calling MeshInstance.SetMesh()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "set_mesh")
+	methodBind := methodBindMeshInstanceSetMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +251,8 @@ func (o *MeshInstance) SetMesh(mesh MeshImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false skeleton_path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false skeleton_path NodePath}], Returns: void
 */
 func (o *MeshInstance) SetSkeletonPath(skeletonPath gdnative.NodePath) {
 	//log.Println("Calling MeshInstance.SetSkeletonPath()")
@@ -245,7 +262,7 @@ func (o *MeshInstance) SetSkeletonPath(skeletonPath gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(skeletonPath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "set_skeleton_path")
+	methodBind := methodBindMeshInstanceSetSkeletonPath.Get()
 
 	// Call the parent method.
 	// void
@@ -255,19 +272,19 @@ func (o *MeshInstance) SetSkeletonPath(skeletonPath gdnative.NodePath) {
 }
 
 /*
-        Sets the [Material] for a surface of the [Mesh] resource.
-	Args: [{ false surface int} { false material Material}], Returns: void
+	        Sets the [Material] for a surface of the [Mesh] resource.
+		Args: [{ false surface int} { false material Material}], Returns: void
 */
-func (o *MeshInstance) SetSurfaceMaterial(surface gdnative.Int, material MaterialImplementer) {
+func (o *MeshInstance) SetSurfaceMaterial(surface gdnative.Int, material godot.MaterialImplementer) {
 	//log.Println("Calling MeshInstance.SetSurfaceMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(surface)
-	ptrArguments[1] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("MeshInstance", "set_surface_material")
+	methodBind := methodBindMeshInstanceSetSurfaceMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -276,6 +293,21 @@ func (o *MeshInstance) SetSurfaceMaterial(surface gdnative.Int, material Materia
 
 }
 
+// Mesh will return the value of the "mesh" property.
+func (o *MeshInstance) Mesh() godot.MeshImplementer {
+	return o.GetMesh()
+}
+
+// Skeleton will return the value of the "skeleton" property.
+func (o *MeshInstance) Skeleton() gdnative.NodePath {
+	return o.GetSkeletonPath()
+}
+
+// SetSkeleton will set the value of the "skeleton" property.
+func (o *MeshInstance) SetSkeleton(value gdnative.NodePath) {
+	o.SetSkeletonPath(value)
+}
+
 // MeshInstanceImplementer is an interface that implements the methods
 // of the MeshInstance class.
 type MeshInstanceImplementer interface {
@@ -284,10 +316,13 @@ type MeshInstanceImplementer interface {
 	CreateConvexCollision()
 	CreateDebugTangents()
 	CreateTrimeshCollision()
-	GetMesh() MeshImplementer
+	GetMesh() godot.MeshImplementer
 	GetSkeletonPath() gdnative.NodePath
-	GetSurfaceMaterial(surface gdnative.Int) MaterialImplementer
-	SetMesh(mesh MeshImplementer)
+	GetSurfaceMaterial(surface gdnative.Int) godot.MaterialImplementer
+	SetMesh(mesh godot.MeshImplementer)
 	SetSkeletonPath(skeletonPath gdnative.NodePath)
-	SetSurfaceMaterial(surface gdnative.Int, material MaterialImplementer)
+	SetSurfaceMaterial(surface gdnative.Int, material godot.MaterialImplementer)
+	Mesh() godot.MeshImplementer
+	Skeleton() gdnative.NodePath
+	SetSkeleton(value gdnative.NodePath)
 }
@@ -0,0 +1,119 @@
+package spatial
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+[code]MultiMeshInstance[/code] is a specialized node to instance [GeometryInstance]s based on a [MultiMesh] resource. This is useful to optimize the rendering of a high amount of instances of a given mesh (for example tree in a forest or grass strands).
+*/
+type MultiMeshInstance struct {
+	GeometryInstance
+	owner gdnative.Object
+}
+
+// classConstructorMultiMeshInstance is the class constructor of the MultiMeshInstance class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorMultiMeshInstance = gdnative.NewLazyClassConstructor("MultiMeshInstance")
+
+// NewMultiMeshInstance will create a new instance of the MultiMeshInstance class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewMultiMeshInstance() *MultiMeshInstance {
+	obj := &MultiMeshInstance{}
+	obj.SetBaseObject(classConstructorMultiMeshInstance.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *MultiMeshInstance) BaseClass() string {
+	return "MultiMeshInstance"
+}
+
+// Method binds of the MultiMeshInstance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindMultiMeshInstanceGetMultimesh = gdnative.NewLazyMethodBind("MultiMeshInstance", "get_multimesh")
+	methodBindMultiMeshInstanceSetMultimesh = gdnative.NewLazyMethodBind("MultiMeshInstance", "set_multimesh")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: MultiMesh
+*/
+func (o *MultiMeshInstance) GetMultimesh() resource.MultiMeshImplementer {
+	//log.Println("Calling MultiMeshInstance.GetMultimesh()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindMultiMeshInstanceGetMultimesh.Get()
+
+	// Call the parent method.
+	// MultiMesh
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := resource.MultiMesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.MultiMeshImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false multimesh MultiMesh}], Returns: void
+*/
+func (o *MultiMeshInstance) SetMultimesh(multimesh resource.MultiMeshImplementer) {
+	//log.Println("Calling MultiMeshInstance.SetMultimesh()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(multimesh))
+
+	// Get the method bind
+	methodBind := methodBindMultiMeshInstanceSetMultimesh.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Multimesh will return the value of the "multimesh" property.
+func (o *MultiMeshInstance) Multimesh() resource.MultiMeshImplementer {
+	return o.GetMultimesh()
+}
+
+// MultiMeshInstanceImplementer is an interface that implements the methods
+// of the MultiMeshInstance class.
+type MultiMeshInstanceImplementer interface {
+	GeometryInstanceImplementer
+	GetMultimesh() resource.MultiMeshImplementer
+	SetMultimesh(multimesh resource.MultiMeshImplementer)
+	Multimesh() resource.MultiMeshImplementer
+}
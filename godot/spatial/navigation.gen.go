@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,50 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewNavigationFromPointer(ptr gdnative.Pointer) Navigation {
-func newNavigationFromPointer(ptr gdnative.Pointer) Navigation {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Navigation{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 The Navigation node is used for basic or advanced navigation. By default it will automatically collect all child [code]NavigationMesh[/code] resources, but they can also be added on the fly through scripting. It can be used for generating a simple path between two points or it can be used to ensure that a navigation agent is angled perfectly to the terrain it is navigating.
 */
 type Navigation struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorNavigation is the class constructor of the Navigation class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNavigation = gdnative.NewLazyClassConstructor("Navigation")
+
+// NewNavigation will create a new instance of the Navigation class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNavigation() *Navigation {
+	obj := &Navigation{}
+	obj.SetBaseObject(classConstructorNavigation.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Navigation) BaseClass() string {
 	return "Navigation"
 }
 
+// Method binds of the Navigation class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNavigationGetClosestPoint          = gdnative.NewLazyMethodBind("Navigation", "get_closest_point")
+	methodBindNavigationGetClosestPointNormal    = gdnative.NewLazyMethodBind("Navigation", "get_closest_point_normal")
+	methodBindNavigationGetClosestPointOwner     = gdnative.NewLazyMethodBind("Navigation", "get_closest_point_owner")
+	methodBindNavigationGetClosestPointToSegment = gdnative.NewLazyMethodBind("Navigation", "get_closest_point_to_segment")
+	methodBindNavigationGetSimplePath            = gdnative.NewLazyMethodBind("Navigation", "get_simple_path")
+	methodBindNavigationGetUpVector              = gdnative.NewLazyMethodBind("Navigation", "get_up_vector")
+	methodBindNavigationNavmeshAdd               = gdnative.NewLazyMethodBind("Navigation", "navmesh_add")
+	methodBindNavigationNavmeshRemove            = gdnative.NewLazyMethodBind("Navigation", "navmesh_remove")
+	methodBindNavigationNavmeshSetTransform      = gdnative.NewLazyMethodBind("Navigation", "navmesh_set_transform")
+	methodBindNavigationSetUpVector              = gdnative.NewLazyMethodBind("Navigation", "set_up_vector")
+)
+
 /*
-        Returns the closest navigation point to the point passed.
-	Args: [{ false to_point Vector3}], Returns: Vector3
+	        Returns the closest navigation point to the point passed.
+		Args: [{ false to_point Vector3}], Returns: Vector3
 */
 func (o *Navigation) GetClosestPoint(toPoint gdnative.Vector3) gdnative.Vector3 {
 	//log.Println("Calling Navigation.GetClosestPoint()")
@@ -46,7 +67,7 @@ func (o *Navigation) GetClosestPoint(toPoint gdnative.Vector3) gdnative.Vector3
 	ptrArguments[0] = gdnative.NewPointerFromVector3(toPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "get_closest_point")
+	methodBind := methodBindNavigationGetClosestPoint.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -59,8 +80,8 @@ func (o *Navigation) GetClosestPoint(toPoint gdnative.Vector3) gdnative.Vector3
 }
 
 /*
-        Returns the surface normal of the navigation mesh at the point passed. For instance, if the point passed was at a 45 degree slope it would return something like (0.5,0.5,0). This is useful for rotating a navigation agent in accordance with the [code]NavigationMesh[/code].
-	Args: [{ false to_point Vector3}], Returns: Vector3
+	        Returns the surface normal of the navigation mesh at the point passed. For instance, if the point passed was at a 45 degree slope it would return something like (0.5,0.5,0). This is useful for rotating a navigation agent in accordance with the [code]NavigationMesh[/code].
+		Args: [{ false to_point Vector3}], Returns: Vector3
 */
 func (o *Navigation) GetClosestPointNormal(toPoint gdnative.Vector3) gdnative.Vector3 {
 	//log.Println("Calling Navigation.GetClosestPointNormal()")
@@ -70,7 +91,7 @@ func (o *Navigation) GetClosestPointNormal(toPoint gdnative.Vector3) gdnative.Ve
 	ptrArguments[0] = gdnative.NewPointerFromVector3(toPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "get_closest_point_normal")
+	methodBind := methodBindNavigationGetClosestPointNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -83,10 +104,10 @@ func (o *Navigation) GetClosestPointNormal(toPoint gdnative.Vector3) gdnative.Ve
 }
 
 /*
-        Returns the nearest [code]NavigationMeshInstance[/code] to the point passed.
-	Args: [{ false to_point Vector3}], Returns: Object
+	        Returns the nearest [code]NavigationMeshInstance[/code] to the point passed.
+		Args: [{ false to_point Vector3}], Returns: Object
 */
-func (o *Navigation) GetClosestPointOwner(toPoint gdnative.Vector3) ObjectImplementer {
+func (o *Navigation) GetClosestPointOwner(toPoint gdnative.Vector3) godot.ObjectImplementer {
 	//log.Println("Calling Navigation.GetClosestPointOwner()")
 
 	// Build out the method's arguments
@@ -94,7 +115,7 @@ func (o *Navigation) GetClosestPointOwner(toPoint gdnative.Vector3) ObjectImplem
 	ptrArguments[0] = gdnative.NewPointerFromVector3(toPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "get_closest_point_owner")
+	methodBind := methodBindNavigationGetClosestPointOwner.Get()
 
 	// Call the parent method.
 	// Object
@@ -102,27 +123,25 @@ func (o *Navigation) GetClosestPointOwner(toPoint gdnative.Vector3) ObjectImplem
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the nearest point to the line segment passed. The third optional parameter takes collisions into account.
-	Args: [{ false start Vector3} { false end Vector3} {False true use_collision bool}], Returns: Vector3
+	        Returns the nearest point to the line segment passed. The third optional parameter takes collisions into account.
+		Args: [{ false start Vector3} { false end Vector3} {False true use_collision bool}], Returns: Vector3
 */
 func (o *Navigation) GetClosestPointToSegment(start gdnative.Vector3, end gdnative.Vector3, useCollision gdnative.Bool) gdnative.Vector3 {
 	//log.Println("Calling Navigation.GetClosestPointToSegment()")
@@ -134,7 +153,7 @@ func (o *Navigation) GetClosestPointToSegment(start gdnative.Vector3, end gdnati
 	ptrArguments[2] = gdnative.NewPointerFromBool(useCollision)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "get_closest_point_to_segment")
+	methodBind := methodBindNavigationGetClosestPointToSegment.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -147,8 +166,8 @@ func (o *Navigation) GetClosestPointToSegment(start gdnative.Vector3, end gdnati
 }
 
 /*
-        Returns a path of points as a [code]PoolVector3Array[/code]. If [code]optimize[/code] is false the [code]NavigationMesh[/code] agent properties will be taken into account, otherwise it will return the nearest path and ignore agent radius, height, etc.
-	Args: [{ false start Vector3} { false end Vector3} {True true optimize bool}], Returns: PoolVector3Array
+	        Returns a path of points as a [code]PoolVector3Array[/code]. If [code]optimize[/code] is false the [code]NavigationMesh[/code] agent properties will be taken into account, otherwise it will return the nearest path and ignore agent radius, height, etc.
+		Args: [{ false start Vector3} { false end Vector3} {True true optimize bool}], Returns: PoolVector3Array
 */
 func (o *Navigation) GetSimplePath(start gdnative.Vector3, end gdnative.Vector3, optimize gdnative.Bool) gdnative.PoolVector3Array {
 	//log.Println("Calling Navigation.GetSimplePath()")
@@ -160,7 +179,7 @@ func (o *Navigation) GetSimplePath(start gdnative.Vector3, end gdnative.Vector3,
 	ptrArguments[2] = gdnative.NewPointerFromBool(optimize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "get_simple_path")
+	methodBind := methodBindNavigationGetSimplePath.Get()
 
 	// Call the parent method.
 	// PoolVector3Array
@@ -173,8 +192,8 @@ func (o *Navigation) GetSimplePath(start gdnative.Vector3, end gdnative.Vector3,
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *Navigation) GetUpVector() gdnative.Vector3 {
 	//log.Println("Calling Navigation.GetUpVector()")
@@ -183,7 +202,7 @@ func (o *Navigation) GetUpVector() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "get_up_vector")
+	methodBind := methodBindNavigationGetUpVector.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -196,20 +215,20 @@ func (o *Navigation) GetUpVector() gdnative.Vector3 {
 }
 
 /*
-        Adds a [code]NavigationMesh[/code] to the list of NavigationMesh's in this node. Returns an id. Its position, rotation and scale are associated with the [code]Transform[/code] passed. The [code]Node[/code] (or [code]Object[/code]) that owns this node is an optional parameter.
-	Args: [{ false mesh NavigationMesh} { false xform Transform} {Null true owner Object}], Returns: int
+	        Adds a [code]NavigationMesh[/code] to the list of NavigationMesh's in this node. Returns an id. Its position, rotation and scale are associated with the [code]Transform[/code] passed. The [code]Node[/code] (or [code]Object[/code]) that owns this node is an optional parameter.
+		Args: [{ false mesh NavigationMesh} { false xform Transform} {Null true owner Object}], Returns: int
 */
-func (o *Navigation) NavmeshAdd(mesh NavigationMeshImplementer, xform gdnative.Transform, owner ObjectImplementer) gdnative.Int {
+func (o *Navigation) NavmeshAdd(mesh godot.NavigationMeshImplementer, xform gdnative.Transform, owner godot.ObjectImplementer) gdnative.Int {
 	//log.Println("Calling Navigation.NavmeshAdd()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
-	ptrArguments[0] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
 	ptrArguments[1] = gdnative.NewPointerFromTransform(xform)
-	ptrArguments[2] = gdnative.NewPointerFromObject(owner.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(owner))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "navmesh_add")
+	methodBind := methodBindNavigationNavmeshAdd.Get()
 
 	// Call the parent method.
 	// int
@@ -222,8 +241,8 @@ func (o *Navigation) NavmeshAdd(mesh NavigationMeshImplementer, xform gdnative.T
 }
 
 /*
-        Removes a [code]NavigationMesh[/code] from the list of NavigationMesh's in this node.
-	Args: [{ false id int}], Returns: void
+	        Removes a [code]NavigationMesh[/code] from the list of NavigationMesh's in this node.
+		Args: [{ false id int}], Returns: void
 */
 func (o *Navigation) NavmeshRemove(id gdnative.Int) {
 	//log.Println("Calling Navigation.NavmeshRemove()")
@@ -233,7 +252,7 @@ func (o *Navigation) NavmeshRemove(id gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "navmesh_remove")
+	methodBind := methodBindNavigationNavmeshRemove.Get()
 
 	// Call the parent method.
 	// void
@@ -243,8 +262,8 @@ func (o *Navigation) NavmeshRemove(id gdnative.Int) {
 }
 
 /*
-        Associates a [code]NavigationMesh[/code]'s id with a [code]Transform[/code]. Its position, rotation and scale are based on the [code]Transform[/code] passed.
-	Args: [{ false id int} { false xform Transform}], Returns: void
+	        Associates a [code]NavigationMesh[/code]'s id with a [code]Transform[/code]. Its position, rotation and scale are based on the [code]Transform[/code] passed.
+		Args: [{ false id int} { false xform Transform}], Returns: void
 */
 func (o *Navigation) NavmeshSetTransform(id gdnative.Int, xform gdnative.Transform) {
 	//log.Println("Calling Navigation.NavmeshSetTransform()")
@@ -255,7 +274,7 @@ func (o *Navigation) NavmeshSetTransform(id gdnative.Int, xform gdnative.Transfo
 	ptrArguments[1] = gdnative.NewPointerFromTransform(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "navmesh_set_transform")
+	methodBind := methodBindNavigationNavmeshSetTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -265,8 +284,8 @@ func (o *Navigation) NavmeshSetTransform(id gdnative.Int, xform gdnative.Transfo
 }
 
 /*
-        Undocumented
-	Args: [{ false up Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false up Vector3}], Returns: void
 */
 func (o *Navigation) SetUpVector(up gdnative.Vector3) {
 	//log.Println("Calling Navigation.SetUpVector()")
@@ -276,7 +295,7 @@ func (o *Navigation) SetUpVector(up gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(up)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Navigation", "set_up_vector")
+	methodBind := methodBindNavigationSetUpVector.Get()
 
 	// Call the parent method.
 	// void
@@ -285,18 +304,42 @@ func (o *Navigation) SetUpVector(up gdnative.Vector3) {
 
 }
 
+// GetClosestPointToSegmentWithDefaults will call GetClosestPointToSegment using the default values for: use_collision.
+func (o *Navigation) GetClosestPointToSegmentWithDefaults(start gdnative.Vector3, end gdnative.Vector3) gdnative.Vector3 {
+	return o.GetClosestPointToSegment(start, end, false)
+}
+
+// GetSimplePathWithDefaults will call GetSimplePath using the default values for: optimize.
+func (o *Navigation) GetSimplePathWithDefaults(start gdnative.Vector3, end gdnative.Vector3) gdnative.PoolVector3Array {
+	return o.GetSimplePath(start, end, true)
+}
+
+// NavmeshAddWithDefaults will call NavmeshAdd using the default values for: owner.
+func (o *Navigation) NavmeshAddWithDefaults(mesh godot.NavigationMeshImplementer, xform gdnative.Transform) gdnative.Int {
+	return o.NavmeshAdd(mesh, xform, nil)
+}
+
+// UpVector will return the value of the "up_vector" property.
+func (o *Navigation) UpVector() gdnative.Vector3 {
+	return o.GetUpVector()
+}
+
 // NavigationImplementer is an interface that implements the methods
 // of the Navigation class.
 type NavigationImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetClosestPoint(toPoint gdnative.Vector3) gdnative.Vector3
 	GetClosestPointNormal(toPoint gdnative.Vector3) gdnative.Vector3
-	GetClosestPointOwner(toPoint gdnative.Vector3) ObjectImplementer
+	GetClosestPointOwner(toPoint gdnative.Vector3) godot.ObjectImplementer
 	GetClosestPointToSegment(start gdnative.Vector3, end gdnative.Vector3, useCollision gdnative.Bool) gdnative.Vector3
 	GetSimplePath(start gdnative.Vector3, end gdnative.Vector3, optimize gdnative.Bool) gdnative.PoolVector3Array
 	GetUpVector() gdnative.Vector3
-	NavmeshAdd(mesh NavigationMeshImplementer, xform gdnative.Transform, owner ObjectImplementer) gdnative.Int
+	NavmeshAdd(mesh godot.NavigationMeshImplementer, xform gdnative.Transform, owner godot.ObjectImplementer) gdnative.Int
 	NavmeshRemove(id gdnative.Int)
 	NavmeshSetTransform(id gdnative.Int, xform gdnative.Transform)
 	SetUpVector(up gdnative.Vector3)
+	GetClosestPointToSegmentWithDefaults(start gdnative.Vector3, end gdnative.Vector3) gdnative.Vector3
+	GetSimplePathWithDefaults(start gdnative.Vector3, end gdnative.Vector3) gdnative.PoolVector3Array
+	NavmeshAddWithDefaults(mesh godot.NavigationMeshImplementer, xform gdnative.Transform) gdnative.Int
+	UpVector() gdnative.Vector3
 }
@@ -0,0 +1,177 @@
+package spatial
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+ */
+type NavigationMeshInstance struct {
+	godot.Spatial
+	owner gdnative.Object
+}
+
+// classConstructorNavigationMeshInstance is the class constructor of the NavigationMeshInstance class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorNavigationMeshInstance = gdnative.NewLazyClassConstructor("NavigationMeshInstance")
+
+// NewNavigationMeshInstance will create a new instance of the NavigationMeshInstance class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewNavigationMeshInstance() *NavigationMeshInstance {
+	obj := &NavigationMeshInstance{}
+	obj.SetBaseObject(classConstructorNavigationMeshInstance.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *NavigationMeshInstance) BaseClass() string {
+	return "NavigationMeshInstance"
+}
+
+// Method binds of the NavigationMeshInstance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindNavigationMeshInstanceGetNavigationMesh = gdnative.NewLazyMethodBind("NavigationMeshInstance", "get_navigation_mesh")
+	methodBindNavigationMeshInstanceIsEnabled         = gdnative.NewLazyMethodBind("NavigationMeshInstance", "is_enabled")
+	methodBindNavigationMeshInstanceSetEnabled        = gdnative.NewLazyMethodBind("NavigationMeshInstance", "set_enabled")
+	methodBindNavigationMeshInstanceSetNavigationMesh = gdnative.NewLazyMethodBind("NavigationMeshInstance", "set_navigation_mesh")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: NavigationMesh
+*/
+func (o *NavigationMeshInstance) GetNavigationMesh() godot.NavigationMeshImplementer {
+	//log.Println("Calling NavigationMeshInstance.GetNavigationMesh()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNavigationMeshInstanceGetNavigationMesh.Get()
+
+	// Call the parent method.
+	// NavigationMesh
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.NavigationMesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.NavigationMeshImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *NavigationMeshInstance) IsEnabled() gdnative.Bool {
+	//log.Println("Calling NavigationMeshInstance.IsEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindNavigationMeshInstanceIsEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *NavigationMeshInstance) SetEnabled(enabled gdnative.Bool) {
+	//log.Println("Calling NavigationMeshInstance.SetEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindNavigationMeshInstanceSetEnabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false navmesh NavigationMesh}], Returns: void
+*/
+func (o *NavigationMeshInstance) SetNavigationMesh(navmesh godot.NavigationMeshImplementer) {
+	//log.Println("Calling NavigationMeshInstance.SetNavigationMesh()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(navmesh))
+
+	// Get the method bind
+	methodBind := methodBindNavigationMeshInstanceSetNavigationMesh.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Enabled will return the value of the "enabled" property.
+func (o *NavigationMeshInstance) Enabled() gdnative.Bool {
+	return o.IsEnabled()
+}
+
+// Navmesh will return the value of the "navmesh" property.
+func (o *NavigationMeshInstance) Navmesh() godot.NavigationMeshImplementer {
+	return o.GetNavigationMesh()
+}
+
+// SetNavmesh will set the value of the "navmesh" property.
+func (o *NavigationMeshInstance) SetNavmesh(value godot.NavigationMeshImplementer) {
+	o.SetNavigationMesh(value)
+}
+
+// NavigationMeshInstanceImplementer is an interface that implements the methods
+// of the NavigationMeshInstance class.
+type NavigationMeshInstanceImplementer interface {
+	godot.SpatialImplementer
+	GetNavigationMesh() godot.NavigationMeshImplementer
+	IsEnabled() gdnative.Bool
+	SetEnabled(enabled gdnative.Bool)
+	SetNavigationMesh(navmesh godot.NavigationMeshImplementer)
+	Enabled() gdnative.Bool
+	Navmesh() godot.NavigationMeshImplementer
+	SetNavmesh(value godot.NavigationMeshImplementer)
+}
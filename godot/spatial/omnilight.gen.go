@@ -0,0 +1,258 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// OmniLightShadowDetail is an enum for ShadowDetail values.
+type OmniLightShadowDetail int
+
+const (
+	OmniLightShadowDetailHorizontal OmniLightShadowDetail = 1
+	OmniLightShadowDetailVertical   OmniLightShadowDetail = 0
+)
+
+// String will return the Godot name of the OmniLightShadowDetail value.
+func (e OmniLightShadowDetail) String() string {
+	switch e {
+	case OmniLightShadowDetailHorizontal:
+		return "SHADOW_DETAIL_HORIZONTAL"
+	case OmniLightShadowDetailVertical:
+		return "SHADOW_DETAIL_VERTICAL"
+	}
+	return fmt.Sprintf("OmniLightShadowDetail(%d)", int(e))
+}
+
+// ParseOmniLightShadowDetail will return the OmniLightShadowDetail value with the given Godot name.
+func ParseOmniLightShadowDetail(name string) (OmniLightShadowDetail, error) {
+	switch name {
+	case "SHADOW_DETAIL_HORIZONTAL":
+		return OmniLightShadowDetailHorizontal, nil
+	case "SHADOW_DETAIL_VERTICAL":
+		return OmniLightShadowDetailVertical, nil
+	}
+	return 0, fmt.Errorf("invalid OmniLightShadowDetail value %q", name)
+}
+
+// OmniLightShadowMode is an enum for ShadowMode values.
+type OmniLightShadowMode int
+
+const (
+	OmniLightShadowCube           OmniLightShadowMode = 1
+	OmniLightShadowDualParaboloid OmniLightShadowMode = 0
+)
+
+// String will return the Godot name of the OmniLightShadowMode value.
+func (e OmniLightShadowMode) String() string {
+	switch e {
+	case OmniLightShadowCube:
+		return "SHADOW_CUBE"
+	case OmniLightShadowDualParaboloid:
+		return "SHADOW_DUAL_PARABOLOID"
+	}
+	return fmt.Sprintf("OmniLightShadowMode(%d)", int(e))
+}
+
+// ParseOmniLightShadowMode will return the OmniLightShadowMode value with the given Godot name.
+func ParseOmniLightShadowMode(name string) (OmniLightShadowMode, error) {
+	switch name {
+	case "SHADOW_CUBE":
+		return OmniLightShadowCube, nil
+	case "SHADOW_DUAL_PARABOLOID":
+		return OmniLightShadowDualParaboloid, nil
+	}
+	return 0, fmt.Errorf("invalid OmniLightShadowMode value %q", name)
+}
+
+/*
+An OmniDirectional light is a type of [Light] node that emits lights in all directions. The light is attenuated through the distance and this attenuation can be configured by changing the energy, radius and attenuation parameters of [Light].
+*/
+type OmniLight struct {
+	Light
+	owner gdnative.Object
+}
+
+// classConstructorOmniLight is the class constructor of the OmniLight class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorOmniLight = gdnative.NewLazyClassConstructor("OmniLight")
+
+// NewOmniLight will create a new instance of the OmniLight class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewOmniLight() *OmniLight {
+	obj := &OmniLight{}
+	obj.SetBaseObject(classConstructorOmniLight.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *OmniLight) BaseClass() string {
+	return "OmniLight"
+}
+
+// Method binds of the OmniLight class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindOmniLightGetShadowDetail = gdnative.NewLazyMethodBind("OmniLight", "get_shadow_detail")
+	methodBindOmniLightGetShadowMode   = gdnative.NewLazyMethodBind("OmniLight", "get_shadow_mode")
+	methodBindOmniLightSetShadowDetail = gdnative.NewLazyMethodBind("OmniLight", "set_shadow_detail")
+	methodBindOmniLightSetShadowMode   = gdnative.NewLazyMethodBind("OmniLight", "set_shadow_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.OmniLight::ShadowDetail
+*/
+func (o *OmniLight) GetShadowDetail() OmniLightShadowDetail {
+	//log.Println("Calling OmniLight.GetShadowDetail()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindOmniLightGetShadowDetail.Get()
+
+	// Call the parent method.
+	// enum.OmniLight::ShadowDetail
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return OmniLightShadowDetail(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.OmniLight::ShadowMode
+*/
+func (o *OmniLight) GetShadowMode() OmniLightShadowMode {
+	//log.Println("Calling OmniLight.GetShadowMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindOmniLightGetShadowMode.Get()
+
+	// Call the parent method.
+	// enum.OmniLight::ShadowMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return OmniLightShadowMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false detail enum.OmniLight::ShadowDetail}], Returns: void
+*/
+func (o *OmniLight) SetShadowDetail(detail OmniLightShadowDetail) {
+	//log.Println("Calling OmniLight.SetShadowDetail()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(detail))
+
+	// Get the method bind
+	methodBind := methodBindOmniLightSetShadowDetail.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.OmniLight::ShadowMode}], Returns: void
+*/
+func (o *OmniLight) SetShadowMode(mode OmniLightShadowMode) {
+	//log.Println("Calling OmniLight.SetShadowMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindOmniLightSetShadowMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// OmniAttenuation will return the value of the "omni_attenuation" property.
+func (o *OmniLight) OmniAttenuation() gdnative.Real {
+	return o.GetParam(4)
+}
+
+// SetOmniAttenuation will set the value of the "omni_attenuation" property.
+func (o *OmniLight) SetOmniAttenuation(value gdnative.Real) {
+	o.SetParam(4, value)
+}
+
+// OmniRange will return the value of the "omni_range" property.
+func (o *OmniLight) OmniRange() gdnative.Real {
+	return o.GetParam(3)
+}
+
+// SetOmniRange will set the value of the "omni_range" property.
+func (o *OmniLight) SetOmniRange(value gdnative.Real) {
+	o.SetParam(3, value)
+}
+
+// OmniShadowDetail will return the value of the "omni_shadow_detail" property.
+func (o *OmniLight) OmniShadowDetail() OmniLightShadowDetail {
+	return o.GetShadowDetail()
+}
+
+// SetOmniShadowDetail will set the value of the "omni_shadow_detail" property.
+func (o *OmniLight) SetOmniShadowDetail(value OmniLightShadowDetail) {
+	o.SetShadowDetail(value)
+}
+
+// OmniShadowMode will return the value of the "omni_shadow_mode" property.
+func (o *OmniLight) OmniShadowMode() OmniLightShadowMode {
+	return o.GetShadowMode()
+}
+
+// SetOmniShadowMode will set the value of the "omni_shadow_mode" property.
+func (o *OmniLight) SetOmniShadowMode(value OmniLightShadowMode) {
+	o.SetShadowMode(value)
+}
+
+// OmniLightImplementer is an interface that implements the methods
+// of the OmniLight class.
+type OmniLightImplementer interface {
+	LightImplementer
+	GetShadowDetail() OmniLightShadowDetail
+	GetShadowMode() OmniLightShadowMode
+	SetShadowDetail(detail OmniLightShadowDetail)
+	SetShadowMode(mode OmniLightShadowMode)
+	OmniAttenuation() gdnative.Real
+	SetOmniAttenuation(value gdnative.Real)
+	OmniRange() gdnative.Real
+	SetOmniRange(value gdnative.Real)
+	OmniShadowDetail() OmniLightShadowDetail
+	SetOmniShadowDetail(value OmniLightShadowDetail)
+	OmniShadowMode() OmniLightShadowMode
+	SetOmniShadowMode(value OmniLightShadowMode)
+}
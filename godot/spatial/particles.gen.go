@@ -1,7 +1,10 @@
-package godot
+package spatial
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,15 +25,37 @@ const (
 	ParticlesDrawOrderViewDepth ParticlesDrawOrder = 2
 )
 
-//func NewParticlesFromPointer(ptr gdnative.Pointer) Particles {
-func newParticlesFromPointer(ptr gdnative.Pointer) Particles {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Particles{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ParticlesDrawOrder value.
+func (e ParticlesDrawOrder) String() string {
+	switch e {
+	case ParticlesDrawOrderIndex:
+		return "DRAW_ORDER_INDEX"
+	case ParticlesDrawOrderLifetime:
+		return "DRAW_ORDER_LIFETIME"
+	case ParticlesDrawOrderViewDepth:
+		return "DRAW_ORDER_VIEW_DEPTH"
+	}
+	return fmt.Sprintf("ParticlesDrawOrder(%d)", int(e))
+}
 
-	return obj
+// ParseParticlesDrawOrder will return the ParticlesDrawOrder value with the given Godot name.
+func ParseParticlesDrawOrder(name string) (ParticlesDrawOrder, error) {
+	switch name {
+	case "DRAW_ORDER_INDEX":
+		return ParticlesDrawOrderIndex, nil
+	case "DRAW_ORDER_LIFETIME":
+		return ParticlesDrawOrderLifetime, nil
+	case "DRAW_ORDER_VIEW_DEPTH":
+		return ParticlesDrawOrderViewDepth, nil
+	}
+	return 0, fmt.Errorf("invalid ParticlesDrawOrder value %q", name)
 }
 
+// Constants of the Particles class.
+const (
+	ParticlesMaxDrawPasses gdnative.Int = 4
+)
+
 /*
 3D particle node used to create a variety of particle systems and effects. [code]Particles[/code] features an emitter that generates some number of particles at a given rate. Use the [code]process_material[/code] property to add a [ParticlesMaterial] to configure particle appearance and behavior. Alternatively, you can add a [ShaderMaterial] which will be applied to all particles.
 */
@@ -39,13 +64,65 @@ type Particles struct {
 	owner gdnative.Object
 }
 
+// classConstructorParticles is the class constructor of the Particles class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorParticles = gdnative.NewLazyClassConstructor("Particles")
+
+// NewParticles will create a new instance of the Particles class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewParticles() *Particles {
+	obj := &Particles{}
+	obj.SetBaseObject(classConstructorParticles.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Particles) BaseClass() string {
 	return "Particles"
 }
 
-/*
+// Method binds of the Particles class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindParticlesCaptureAabb            = gdnative.NewLazyMethodBind("Particles", "capture_aabb")
+	methodBindParticlesGetAmount              = gdnative.NewLazyMethodBind("Particles", "get_amount")
+	methodBindParticlesGetDrawOrder           = gdnative.NewLazyMethodBind("Particles", "get_draw_order")
+	methodBindParticlesGetDrawPassMesh        = gdnative.NewLazyMethodBind("Particles", "get_draw_pass_mesh")
+	methodBindParticlesGetDrawPasses          = gdnative.NewLazyMethodBind("Particles", "get_draw_passes")
+	methodBindParticlesGetExplosivenessRatio  = gdnative.NewLazyMethodBind("Particles", "get_explosiveness_ratio")
+	methodBindParticlesGetFixedFps            = gdnative.NewLazyMethodBind("Particles", "get_fixed_fps")
+	methodBindParticlesGetFractionalDelta     = gdnative.NewLazyMethodBind("Particles", "get_fractional_delta")
+	methodBindParticlesGetLifetime            = gdnative.NewLazyMethodBind("Particles", "get_lifetime")
+	methodBindParticlesGetOneShot             = gdnative.NewLazyMethodBind("Particles", "get_one_shot")
+	methodBindParticlesGetPreProcessTime      = gdnative.NewLazyMethodBind("Particles", "get_pre_process_time")
+	methodBindParticlesGetProcessMaterial     = gdnative.NewLazyMethodBind("Particles", "get_process_material")
+	methodBindParticlesGetRandomnessRatio     = gdnative.NewLazyMethodBind("Particles", "get_randomness_ratio")
+	methodBindParticlesGetSpeedScale          = gdnative.NewLazyMethodBind("Particles", "get_speed_scale")
+	methodBindParticlesGetUseLocalCoordinates = gdnative.NewLazyMethodBind("Particles", "get_use_local_coordinates")
+	methodBindParticlesGetVisibilityAabb      = gdnative.NewLazyMethodBind("Particles", "get_visibility_aabb")
+	methodBindParticlesIsEmitting             = gdnative.NewLazyMethodBind("Particles", "is_emitting")
+	methodBindParticlesRestart                = gdnative.NewLazyMethodBind("Particles", "restart")
+	methodBindParticlesSetAmount              = gdnative.NewLazyMethodBind("Particles", "set_amount")
+	methodBindParticlesSetDrawOrder           = gdnative.NewLazyMethodBind("Particles", "set_draw_order")
+	methodBindParticlesSetDrawPassMesh        = gdnative.NewLazyMethodBind("Particles", "set_draw_pass_mesh")
+	methodBindParticlesSetDrawPasses          = gdnative.NewLazyMethodBind("Particles", "set_draw_passes")
+	methodBindParticlesSetEmitting            = gdnative.NewLazyMethodBind("Particles", "set_emitting")
+	methodBindParticlesSetExplosivenessRatio  = gdnative.NewLazyMethodBind("Particles", "set_explosiveness_ratio")
+	methodBindParticlesSetFixedFps            = gdnative.NewLazyMethodBind("Particles", "set_fixed_fps")
+	methodBindParticlesSetFractionalDelta     = gdnative.NewLazyMethodBind("Particles", "set_fractional_delta")
+	methodBindParticlesSetLifetime            = gdnative.NewLazyMethodBind("Particles", "set_lifetime")
+	methodBindParticlesSetOneShot             = gdnative.NewLazyMethodBind("Particles", "set_one_shot")
+	methodBindParticlesSetPreProcessTime      = gdnative.NewLazyMethodBind("Particles", "set_pre_process_time")
+	methodBindParticlesSetProcessMaterial     = gdnative.NewLazyMethodBind("Particles", "set_process_material")
+	methodBindParticlesSetRandomnessRatio     = gdnative.NewLazyMethodBind("Particles", "set_randomness_ratio")
+	methodBindParticlesSetSpeedScale          = gdnative.NewLazyMethodBind("Particles", "set_speed_scale")
+	methodBindParticlesSetUseLocalCoordinates = gdnative.NewLazyMethodBind("Particles", "set_use_local_coordinates")
+	methodBindParticlesSetVisibilityAabb      = gdnative.NewLazyMethodBind("Particles", "set_visibility_aabb")
+)
 
-	Args: [], Returns: AABB
+/*
+Args: [], Returns: AABB
 */
 func (o *Particles) CaptureAabb() gdnative.Aabb {
 	//log.Println("Calling Particles.CaptureAabb()")
@@ -54,7 +131,7 @@ func (o *Particles) CaptureAabb() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "capture_aabb")
+	methodBind := methodBindParticlesCaptureAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -67,8 +144,8 @@ func (o *Particles) CaptureAabb() gdnative.Aabb {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Particles) GetAmount() gdnative.Int {
 	//log.Println("Calling Particles.GetAmount()")
@@ -77,7 +154,7 @@ func (o *Particles) GetAmount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_amount")
+	methodBind := methodBindParticlesGetAmount.Get()
 
 	// Call the parent method.
 	// int
@@ -90,8 +167,8 @@ func (o *Particles) GetAmount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Particles::DrawOrder
+	        Undocumented
+		Args: [], Returns: enum.Particles::DrawOrder
 */
 func (o *Particles) GetDrawOrder() ParticlesDrawOrder {
 	//log.Println("Calling Particles.GetDrawOrder()")
@@ -100,7 +177,7 @@ func (o *Particles) GetDrawOrder() ParticlesDrawOrder {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_draw_order")
+	methodBind := methodBindParticlesGetDrawOrder.Get()
 
 	// Call the parent method.
 	// enum.Particles::DrawOrder
@@ -113,10 +190,10 @@ func (o *Particles) GetDrawOrder() ParticlesDrawOrder {
 }
 
 /*
-        Undocumented
-	Args: [{ false pass int}], Returns: Mesh
+	        Undocumented
+		Args: [{ false pass int}], Returns: Mesh
 */
-func (o *Particles) GetDrawPassMesh(pass gdnative.Int) MeshImplementer {
+func (o *Particles) GetDrawPassMesh(pass gdnative.Int) godot.MeshImplementer {
 	//log.Println("Calling Particles.GetDrawPassMesh()")
 
 	// Build out the method's arguments
@@ -124,7 +201,7 @@ func (o *Particles) GetDrawPassMesh(pass gdnative.Int) MeshImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(pass)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_draw_pass_mesh")
+	methodBind := methodBindParticlesGetDrawPassMesh.Get()
 
 	// Call the parent method.
 	// Mesh
@@ -132,27 +209,25 @@ func (o *Particles) GetDrawPassMesh(pass gdnative.Int) MeshImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMeshFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MeshImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Mesh" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MeshImplementer)
+	ret := godot.Mesh{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MeshImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Particles) GetDrawPasses() gdnative.Int {
 	//log.Println("Calling Particles.GetDrawPasses()")
@@ -161,7 +236,7 @@ func (o *Particles) GetDrawPasses() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_draw_passes")
+	methodBind := methodBindParticlesGetDrawPasses.Get()
 
 	// Call the parent method.
 	// int
@@ -174,8 +249,8 @@ func (o *Particles) GetDrawPasses() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles) GetExplosivenessRatio() gdnative.Real {
 	//log.Println("Calling Particles.GetExplosivenessRatio()")
@@ -184,7 +259,7 @@ func (o *Particles) GetExplosivenessRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_explosiveness_ratio")
+	methodBind := methodBindParticlesGetExplosivenessRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -197,8 +272,8 @@ func (o *Particles) GetExplosivenessRatio() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Particles) GetFixedFps() gdnative.Int {
 	//log.Println("Calling Particles.GetFixedFps()")
@@ -207,7 +282,7 @@ func (o *Particles) GetFixedFps() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_fixed_fps")
+	methodBind := methodBindParticlesGetFixedFps.Get()
 
 	// Call the parent method.
 	// int
@@ -220,8 +295,8 @@ func (o *Particles) GetFixedFps() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles) GetFractionalDelta() gdnative.Bool {
 	//log.Println("Calling Particles.GetFractionalDelta()")
@@ -230,7 +305,7 @@ func (o *Particles) GetFractionalDelta() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_fractional_delta")
+	methodBind := methodBindParticlesGetFractionalDelta.Get()
 
 	// Call the parent method.
 	// bool
@@ -243,8 +318,8 @@ func (o *Particles) GetFractionalDelta() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles) GetLifetime() gdnative.Real {
 	//log.Println("Calling Particles.GetLifetime()")
@@ -253,7 +328,7 @@ func (o *Particles) GetLifetime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_lifetime")
+	methodBind := methodBindParticlesGetLifetime.Get()
 
 	// Call the parent method.
 	// float
@@ -266,8 +341,8 @@ func (o *Particles) GetLifetime() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles) GetOneShot() gdnative.Bool {
 	//log.Println("Calling Particles.GetOneShot()")
@@ -276,7 +351,7 @@ func (o *Particles) GetOneShot() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_one_shot")
+	methodBind := methodBindParticlesGetOneShot.Get()
 
 	// Call the parent method.
 	// bool
@@ -289,8 +364,8 @@ func (o *Particles) GetOneShot() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles) GetPreProcessTime() gdnative.Real {
 	//log.Println("Calling Particles.GetPreProcessTime()")
@@ -299,7 +374,7 @@ func (o *Particles) GetPreProcessTime() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_pre_process_time")
+	methodBind := methodBindParticlesGetPreProcessTime.Get()
 
 	// Call the parent method.
 	// float
@@ -312,17 +387,17 @@ func (o *Particles) GetPreProcessTime() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Material
+	        Undocumented
+		Args: [], Returns: Material
 */
-func (o *Particles) GetProcessMaterial() MaterialImplementer {
+func (o *Particles) GetProcessMaterial() godot.MaterialImplementer {
 	//log.Println("Calling Particles.GetProcessMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_process_material")
+	methodBind := methodBindParticlesGetProcessMaterial.Get()
 
 	// Call the parent method.
 	// Material
@@ -330,27 +405,25 @@ func (o *Particles) GetProcessMaterial() MaterialImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newMaterialFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(MaterialImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Material" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(MaterialImplementer)
+	ret := godot.Material{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.MaterialImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles) GetRandomnessRatio() gdnative.Real {
 	//log.Println("Calling Particles.GetRandomnessRatio()")
@@ -359,7 +432,7 @@ func (o *Particles) GetRandomnessRatio() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_randomness_ratio")
+	methodBind := methodBindParticlesGetRandomnessRatio.Get()
 
 	// Call the parent method.
 	// float
@@ -372,8 +445,8 @@ func (o *Particles) GetRandomnessRatio() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *Particles) GetSpeedScale() gdnative.Real {
 	//log.Println("Calling Particles.GetSpeedScale()")
@@ -382,7 +455,7 @@ func (o *Particles) GetSpeedScale() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_speed_scale")
+	methodBind := methodBindParticlesGetSpeedScale.Get()
 
 	// Call the parent method.
 	// float
@@ -395,8 +468,8 @@ func (o *Particles) GetSpeedScale() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles) GetUseLocalCoordinates() gdnative.Bool {
 	//log.Println("Calling Particles.GetUseLocalCoordinates()")
@@ -405,7 +478,7 @@ func (o *Particles) GetUseLocalCoordinates() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_use_local_coordinates")
+	methodBind := methodBindParticlesGetUseLocalCoordinates.Get()
 
 	// Call the parent method.
 	// bool
@@ -418,8 +491,8 @@ func (o *Particles) GetUseLocalCoordinates() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: AABB
+	        Undocumented
+		Args: [], Returns: AABB
 */
 func (o *Particles) GetVisibilityAabb() gdnative.Aabb {
 	//log.Println("Calling Particles.GetVisibilityAabb()")
@@ -428,7 +501,7 @@ func (o *Particles) GetVisibilityAabb() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "get_visibility_aabb")
+	methodBind := methodBindParticlesGetVisibilityAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -441,8 +514,8 @@ func (o *Particles) GetVisibilityAabb() gdnative.Aabb {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Particles) IsEmitting() gdnative.Bool {
 	//log.Println("Calling Particles.IsEmitting()")
@@ -451,7 +524,7 @@ func (o *Particles) IsEmitting() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "is_emitting")
+	methodBind := methodBindParticlesIsEmitting.Get()
 
 	// Call the parent method.
 	// bool
@@ -464,8 +537,7 @@ func (o *Particles) IsEmitting() gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: void
+Args: [], Returns: void
 */
 func (o *Particles) Restart() {
 	//log.Println("Calling Particles.Restart()")
@@ -474,7 +546,7 @@ func (o *Particles) Restart() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "restart")
+	methodBind := methodBindParticlesRestart.Get()
 
 	// Call the parent method.
 	// void
@@ -484,8 +556,8 @@ func (o *Particles) Restart() {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *Particles) SetAmount(amount gdnative.Int) {
 	//log.Println("Calling Particles.SetAmount()")
@@ -495,7 +567,7 @@ func (o *Particles) SetAmount(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_amount")
+	methodBind := methodBindParticlesSetAmount.Get()
 
 	// Call the parent method.
 	// void
@@ -505,18 +577,18 @@ func (o *Particles) SetAmount(amount gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false order int}], Returns: void
+	        Undocumented
+		Args: [{ false order enum.Particles::DrawOrder}], Returns: void
 */
-func (o *Particles) SetDrawOrder(order gdnative.Int) {
+func (o *Particles) SetDrawOrder(order ParticlesDrawOrder) {
 	//log.Println("Calling Particles.SetDrawOrder()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(order)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(order))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_draw_order")
+	methodBind := methodBindParticlesSetDrawOrder.Get()
 
 	// Call the parent method.
 	// void
@@ -526,19 +598,19 @@ func (o *Particles) SetDrawOrder(order gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pass int} { false mesh Mesh}], Returns: void
+	        Undocumented
+		Args: [{ false pass int} { false mesh Mesh}], Returns: void
 */
-func (o *Particles) SetDrawPassMesh(pass gdnative.Int, mesh MeshImplementer) {
+func (o *Particles) SetDrawPassMesh(pass gdnative.Int, mesh godot.MeshImplementer) {
 	//log.Println("Calling Particles.SetDrawPassMesh()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(pass)
-	ptrArguments[1] = gdnative.NewPointerFromObject(mesh.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(mesh))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_draw_pass_mesh")
+	methodBind := methodBindParticlesSetDrawPassMesh.Get()
 
 	// Call the parent method.
 	// void
@@ -548,8 +620,8 @@ func (o *Particles) SetDrawPassMesh(pass gdnative.Int, mesh MeshImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false passes int}], Returns: void
+	        Undocumented
+		Args: [{ false passes int}], Returns: void
 */
 func (o *Particles) SetDrawPasses(passes gdnative.Int) {
 	//log.Println("Calling Particles.SetDrawPasses()")
@@ -559,7 +631,7 @@ func (o *Particles) SetDrawPasses(passes gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(passes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_draw_passes")
+	methodBind := methodBindParticlesSetDrawPasses.Get()
 
 	// Call the parent method.
 	// void
@@ -569,8 +641,8 @@ func (o *Particles) SetDrawPasses(passes gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false emitting bool}], Returns: void
+	        Undocumented
+		Args: [{ false emitting bool}], Returns: void
 */
 func (o *Particles) SetEmitting(emitting gdnative.Bool) {
 	//log.Println("Calling Particles.SetEmitting()")
@@ -580,7 +652,7 @@ func (o *Particles) SetEmitting(emitting gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(emitting)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_emitting")
+	methodBind := methodBindParticlesSetEmitting.Get()
 
 	// Call the parent method.
 	// void
@@ -590,8 +662,8 @@ func (o *Particles) SetEmitting(emitting gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ratio float}], Returns: void
+	        Undocumented
+		Args: [{ false ratio float}], Returns: void
 */
 func (o *Particles) SetExplosivenessRatio(ratio gdnative.Real) {
 	//log.Println("Calling Particles.SetExplosivenessRatio()")
@@ -601,7 +673,7 @@ func (o *Particles) SetExplosivenessRatio(ratio gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_explosiveness_ratio")
+	methodBind := methodBindParticlesSetExplosivenessRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -611,8 +683,8 @@ func (o *Particles) SetExplosivenessRatio(ratio gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false fps int}], Returns: void
+	        Undocumented
+		Args: [{ false fps int}], Returns: void
 */
 func (o *Particles) SetFixedFps(fps gdnative.Int) {
 	//log.Println("Calling Particles.SetFixedFps()")
@@ -622,7 +694,7 @@ func (o *Particles) SetFixedFps(fps gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(fps)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_fixed_fps")
+	methodBind := methodBindParticlesSetFixedFps.Get()
 
 	// Call the parent method.
 	// void
@@ -632,8 +704,8 @@ func (o *Particles) SetFixedFps(fps gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Particles) SetFractionalDelta(enable gdnative.Bool) {
 	//log.Println("Calling Particles.SetFractionalDelta()")
@@ -643,7 +715,7 @@ func (o *Particles) SetFractionalDelta(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_fractional_delta")
+	methodBind := methodBindParticlesSetFractionalDelta.Get()
 
 	// Call the parent method.
 	// void
@@ -653,8 +725,8 @@ func (o *Particles) SetFractionalDelta(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false secs float}], Returns: void
+	        Undocumented
+		Args: [{ false secs float}], Returns: void
 */
 func (o *Particles) SetLifetime(secs gdnative.Real) {
 	//log.Println("Calling Particles.SetLifetime()")
@@ -664,7 +736,7 @@ func (o *Particles) SetLifetime(secs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(secs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_lifetime")
+	methodBind := methodBindParticlesSetLifetime.Get()
 
 	// Call the parent method.
 	// void
@@ -674,8 +746,8 @@ func (o *Particles) SetLifetime(secs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Particles) SetOneShot(enable gdnative.Bool) {
 	//log.Println("Calling Particles.SetOneShot()")
@@ -685,7 +757,7 @@ func (o *Particles) SetOneShot(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_one_shot")
+	methodBind := methodBindParticlesSetOneShot.Get()
 
 	// Call the parent method.
 	// void
@@ -695,8 +767,8 @@ func (o *Particles) SetOneShot(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false secs float}], Returns: void
+	        Undocumented
+		Args: [{ false secs float}], Returns: void
 */
 func (o *Particles) SetPreProcessTime(secs gdnative.Real) {
 	//log.Println("Calling Particles.SetPreProcessTime()")
@@ -706,7 +778,7 @@ func (o *Particles) SetPreProcessTime(secs gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(secs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_pre_process_time")
+	methodBind := methodBindParticlesSetPreProcessTime.Get()
 
 	// Call the parent method.
 	// void
@@ -716,18 +788,18 @@ func (o *Particles) SetPreProcessTime(secs gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false material Material}], Returns: void
+	        Undocumented
+		Args: [{ false material Material}], Returns: void
 */
-func (o *Particles) SetProcessMaterial(material MaterialImplementer) {
+func (o *Particles) SetProcessMaterial(material godot.MaterialImplementer) {
 	//log.Println("Calling Particles.SetProcessMaterial()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(material.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(material))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_process_material")
+	methodBind := methodBindParticlesSetProcessMaterial.Get()
 
 	// Call the parent method.
 	// void
@@ -737,8 +809,8 @@ func (o *Particles) SetProcessMaterial(material MaterialImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ratio float}], Returns: void
+	        Undocumented
+		Args: [{ false ratio float}], Returns: void
 */
 func (o *Particles) SetRandomnessRatio(ratio gdnative.Real) {
 	//log.Println("Calling Particles.SetRandomnessRatio()")
@@ -748,7 +820,7 @@ func (o *Particles) SetRandomnessRatio(ratio gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(ratio)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_randomness_ratio")
+	methodBind := methodBindParticlesSetRandomnessRatio.Get()
 
 	// Call the parent method.
 	// void
@@ -758,8 +830,8 @@ func (o *Particles) SetRandomnessRatio(ratio gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false scale float}], Returns: void
+	        Undocumented
+		Args: [{ false scale float}], Returns: void
 */
 func (o *Particles) SetSpeedScale(scale gdnative.Real) {
 	//log.Println("Calling Particles.SetSpeedScale()")
@@ -769,7 +841,7 @@ func (o *Particles) SetSpeedScale(scale gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(scale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_speed_scale")
+	methodBind := methodBindParticlesSetSpeedScale.Get()
 
 	// Call the parent method.
 	// void
@@ -779,8 +851,8 @@ func (o *Particles) SetSpeedScale(scale gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Particles) SetUseLocalCoordinates(enable gdnative.Bool) {
 	//log.Println("Calling Particles.SetUseLocalCoordinates()")
@@ -790,7 +862,7 @@ func (o *Particles) SetUseLocalCoordinates(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_use_local_coordinates")
+	methodBind := methodBindParticlesSetUseLocalCoordinates.Get()
 
 	// Call the parent method.
 	// void
@@ -800,8 +872,8 @@ func (o *Particles) SetUseLocalCoordinates(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false aabb AABB}], Returns: void
+	        Undocumented
+		Args: [{ false aabb AABB}], Returns: void
 */
 func (o *Particles) SetVisibilityAabb(aabb gdnative.Aabb) {
 	//log.Println("Calling Particles.SetVisibilityAabb()")
@@ -811,7 +883,7 @@ func (o *Particles) SetVisibilityAabb(aabb gdnative.Aabb) {
 	ptrArguments[0] = gdnative.NewPointerFromAabb(aabb)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Particles", "set_visibility_aabb")
+	methodBind := methodBindParticlesSetVisibilityAabb.Get()
 
 	// Call the parent method.
 	// void
@@ -820,13 +892,154 @@ func (o *Particles) SetVisibilityAabb(aabb gdnative.Aabb) {
 
 }
 
+// Amount will return the value of the "amount" property.
+func (o *Particles) Amount() gdnative.Int {
+	return o.GetAmount()
+}
+
+// DrawOrder will return the value of the "draw_order" property.
+func (o *Particles) DrawOrder() ParticlesDrawOrder {
+	return o.GetDrawOrder()
+}
+
+// DrawPass1 will return the value of the "draw_pass_1" property.
+func (o *Particles) DrawPass1() godot.MeshImplementer {
+	return o.GetDrawPassMesh(0)
+}
+
+// SetDrawPass1 will set the value of the "draw_pass_1" property.
+func (o *Particles) SetDrawPass1(value godot.MeshImplementer) {
+	o.SetDrawPassMesh(0, value)
+}
+
+// DrawPass2 will return the value of the "draw_pass_2" property.
+func (o *Particles) DrawPass2() godot.MeshImplementer {
+	return o.GetDrawPassMesh(1)
+}
+
+// SetDrawPass2 will set the value of the "draw_pass_2" property.
+func (o *Particles) SetDrawPass2(value godot.MeshImplementer) {
+	o.SetDrawPassMesh(1, value)
+}
+
+// DrawPass3 will return the value of the "draw_pass_3" property.
+func (o *Particles) DrawPass3() godot.MeshImplementer {
+	return o.GetDrawPassMesh(2)
+}
+
+// SetDrawPass3 will set the value of the "draw_pass_3" property.
+func (o *Particles) SetDrawPass3(value godot.MeshImplementer) {
+	o.SetDrawPassMesh(2, value)
+}
+
+// DrawPass4 will return the value of the "draw_pass_4" property.
+func (o *Particles) DrawPass4() godot.MeshImplementer {
+	return o.GetDrawPassMesh(3)
+}
+
+// SetDrawPass4 will set the value of the "draw_pass_4" property.
+func (o *Particles) SetDrawPass4(value godot.MeshImplementer) {
+	o.SetDrawPassMesh(3, value)
+}
+
+// DrawPasses will return the value of the "draw_passes" property.
+func (o *Particles) DrawPasses() gdnative.Int {
+	return o.GetDrawPasses()
+}
+
+// Emitting will return the value of the "emitting" property.
+func (o *Particles) Emitting() gdnative.Bool {
+	return o.IsEmitting()
+}
+
+// Explosiveness will return the value of the "explosiveness" property.
+func (o *Particles) Explosiveness() gdnative.Real {
+	return o.GetExplosivenessRatio()
+}
+
+// SetExplosiveness will set the value of the "explosiveness" property.
+func (o *Particles) SetExplosiveness(value gdnative.Real) {
+	o.SetExplosivenessRatio(value)
+}
+
+// FixedFps will return the value of the "fixed_fps" property.
+func (o *Particles) FixedFps() gdnative.Int {
+	return o.GetFixedFps()
+}
+
+// FractDelta will return the value of the "fract_delta" property.
+func (o *Particles) FractDelta() gdnative.Bool {
+	return o.GetFractionalDelta()
+}
+
+// SetFractDelta will set the value of the "fract_delta" property.
+func (o *Particles) SetFractDelta(value gdnative.Bool) {
+	o.SetFractionalDelta(value)
+}
+
+// Lifetime will return the value of the "lifetime" property.
+func (o *Particles) Lifetime() gdnative.Real {
+	return o.GetLifetime()
+}
+
+// LocalCoords will return the value of the "local_coords" property.
+func (o *Particles) LocalCoords() gdnative.Bool {
+	return o.GetUseLocalCoordinates()
+}
+
+// SetLocalCoords will set the value of the "local_coords" property.
+func (o *Particles) SetLocalCoords(value gdnative.Bool) {
+	o.SetUseLocalCoordinates(value)
+}
+
+// OneShot will return the value of the "one_shot" property.
+func (o *Particles) OneShot() gdnative.Bool {
+	return o.GetOneShot()
+}
+
+// Preprocess will return the value of the "preprocess" property.
+func (o *Particles) Preprocess() gdnative.Real {
+	return o.GetPreProcessTime()
+}
+
+// SetPreprocess will set the value of the "preprocess" property.
+func (o *Particles) SetPreprocess(value gdnative.Real) {
+	o.SetPreProcessTime(value)
+}
+
+// ProcessMaterial will return the value of the "process_material" property.
+func (o *Particles) ProcessMaterial() godot.MaterialImplementer {
+	return o.GetProcessMaterial()
+}
+
+// Randomness will return the value of the "randomness" property.
+func (o *Particles) Randomness() gdnative.Real {
+	return o.GetRandomnessRatio()
+}
+
+// SetRandomness will set the value of the "randomness" property.
+func (o *Particles) SetRandomness(value gdnative.Real) {
+	o.SetRandomnessRatio(value)
+}
+
+// SpeedScale will return the value of the "speed_scale" property.
+func (o *Particles) SpeedScale() gdnative.Real {
+	return o.GetSpeedScale()
+}
+
+// VisibilityAabb will return the value of the "visibility_aabb" property.
+func (o *Particles) VisibilityAabb() gdnative.Aabb {
+	return o.GetVisibilityAabb()
+}
+
 // ParticlesImplementer is an interface that implements the methods
 // of the Particles class.
 type ParticlesImplementer interface {
 	GeometryInstanceImplementer
 	CaptureAabb() gdnative.Aabb
 	GetAmount() gdnative.Int
-	GetDrawPassMesh(pass gdnative.Int) MeshImplementer
+	GetDrawOrder() ParticlesDrawOrder
+	GetDrawPassMesh(pass gdnative.Int) godot.MeshImplementer
 	GetDrawPasses() gdnative.Int
 	GetExplosivenessRatio() gdnative.Real
 	GetFixedFps() gdnative.Int
@@ -834,7 +1047,7 @@ type ParticlesImplementer interface {
 	GetLifetime() gdnative.Real
 	GetOneShot() gdnative.Bool
 	GetPreProcessTime() gdnative.Real
-	GetProcessMaterial() MaterialImplementer
+	GetProcessMaterial() godot.MaterialImplementer
 	GetRandomnessRatio() gdnative.Real
 	GetSpeedScale() gdnative.Real
 	GetUseLocalCoordinates() gdnative.Bool
@@ -842,8 +1055,8 @@ type ParticlesImplementer interface {
 	IsEmitting() gdnative.Bool
 	Restart()
 	SetAmount(amount gdnative.Int)
-	SetDrawOrder(order gdnative.Int)
-	SetDrawPassMesh(pass gdnative.Int, mesh MeshImplementer)
+	SetDrawOrder(order ParticlesDrawOrder)
+	SetDrawPassMesh(pass gdnative.Int, mesh godot.MeshImplementer)
 	SetDrawPasses(passes gdnative.Int)
 	SetEmitting(emitting gdnative.Bool)
 	SetExplosivenessRatio(ratio gdnative.Real)
@@ -852,9 +1065,37 @@ type ParticlesImplementer interface {
 	SetLifetime(secs gdnative.Real)
 	SetOneShot(enable gdnative.Bool)
 	SetPreProcessTime(secs gdnative.Real)
-	SetProcessMaterial(material MaterialImplementer)
+	SetProcessMaterial(material godot.MaterialImplementer)
 	SetRandomnessRatio(ratio gdnative.Real)
 	SetSpeedScale(scale gdnative.Real)
 	SetUseLocalCoordinates(enable gdnative.Bool)
 	SetVisibilityAabb(aabb gdnative.Aabb)
+	Amount() gdnative.Int
+	DrawOrder() ParticlesDrawOrder
+	DrawPass1() godot.MeshImplementer
+	SetDrawPass1(value godot.MeshImplementer)
+	DrawPass2() godot.MeshImplementer
+	SetDrawPass2(value godot.MeshImplementer)
+	DrawPass3() godot.MeshImplementer
+	SetDrawPass3(value godot.MeshImplementer)
+	DrawPass4() godot.MeshImplementer
+	SetDrawPass4(value godot.MeshImplementer)
+	DrawPasses() gdnative.Int
+	Emitting() gdnative.Bool
+	Explosiveness() gdnative.Real
+	SetExplosiveness(value gdnative.Real)
+	FixedFps() gdnative.Int
+	FractDelta() gdnative.Bool
+	SetFractDelta(value gdnative.Bool)
+	Lifetime() gdnative.Real
+	LocalCoords() gdnative.Bool
+	SetLocalCoords(value gdnative.Bool)
+	OneShot() gdnative.Bool
+	Preprocess() gdnative.Real
+	SetPreprocess(value gdnative.Real)
+	ProcessMaterial() godot.MaterialImplementer
+	Randomness() gdnative.Real
+	SetRandomness(value gdnative.Real)
+	SpeedScale() gdnative.Real
+	VisibilityAabb() gdnative.Aabb
 }
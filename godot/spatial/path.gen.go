@@ -0,0 +1,141 @@
+package spatial
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/resource"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+This class is a container/Node-ification of a [Curve3D], so it can have [Spatial] properties and [Node] info.
+*/
+type Path struct {
+	godot.Spatial
+	owner gdnative.Object
+}
+
+// classConstructorPath is the class constructor of the Path class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPath = gdnative.NewLazyClassConstructor("Path")
+
+// NewPath will create a new instance of the Path class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPath() *Path {
+	obj := &Path{}
+	obj.SetBaseObject(classConstructorPath.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Path) BaseClass() string {
+	return "Path"
+}
+
+// Method binds of the Path class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPathX_CurveChanged = gdnative.NewLazyMethodBind("Path", "_curve_changed")
+	methodBindPathGetCurve       = gdnative.NewLazyMethodBind("Path", "get_curve")
+	methodBindPathSetCurve       = gdnative.NewLazyMethodBind("Path", "set_curve")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Path) X_CurveChanged() {
+	//log.Println("Calling Path.X_CurveChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPathX_CurveChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Curve3D
+*/
+func (o *Path) GetCurve() resource.Curve3DImplementer {
+	//log.Println("Calling Path.GetCurve()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindPathGetCurve.Get()
+
+	// Call the parent method.
+	// Curve3D
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := resource.Curve3D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(resource.Curve3DImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false curve Curve3D}], Returns: void
+*/
+func (o *Path) SetCurve(curve resource.Curve3DImplementer) {
+	//log.Println("Calling Path.SetCurve()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(curve))
+
+	// Get the method bind
+	methodBind := methodBindPathSetCurve.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Curve will return the value of the "curve" property.
+func (o *Path) Curve() resource.Curve3DImplementer {
+	return o.GetCurve()
+}
+
+// PathImplementer is an interface that implements the methods
+// of the Path class.
+type PathImplementer interface {
+	godot.SpatialImplementer
+	X_CurveChanged()
+	GetCurve() resource.Curve3DImplementer
+	SetCurve(curve resource.Curve3DImplementer)
+	Curve() resource.Curve3DImplementer
+}
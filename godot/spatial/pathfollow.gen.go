@@ -1,7 +1,10 @@
-package godot
+package spatial
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,30 +26,84 @@ const (
 	PathFollowRotationY    PathFollowRotationMode = 1
 )
 
-//func NewPathFollowFromPointer(ptr gdnative.Pointer) PathFollow {
-func newPathFollowFromPointer(ptr gdnative.Pointer) PathFollow {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := PathFollow{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the PathFollowRotationMode value.
+func (e PathFollowRotationMode) String() string {
+	switch e {
+	case PathFollowRotationNone:
+		return "ROTATION_NONE"
+	case PathFollowRotationXy:
+		return "ROTATION_XY"
+	case PathFollowRotationXyz:
+		return "ROTATION_XYZ"
+	case PathFollowRotationY:
+		return "ROTATION_Y"
+	}
+	return fmt.Sprintf("PathFollowRotationMode(%d)", int(e))
+}
 
-	return obj
+// ParsePathFollowRotationMode will return the PathFollowRotationMode value with the given Godot name.
+func ParsePathFollowRotationMode(name string) (PathFollowRotationMode, error) {
+	switch name {
+	case "ROTATION_NONE":
+		return PathFollowRotationNone, nil
+	case "ROTATION_XY":
+		return PathFollowRotationXy, nil
+	case "ROTATION_XYZ":
+		return PathFollowRotationXyz, nil
+	case "ROTATION_Y":
+		return PathFollowRotationY, nil
+	}
+	return 0, fmt.Errorf("invalid PathFollowRotationMode value %q", name)
 }
 
 /*
 This node takes its parent [Path], and returns the coordinates of a point within it, given a distance from the first vertex. It is useful for making other nodes follow a path, without coding the movement pattern. For that, the nodes must be descendants of this node. Then, when setting an offset in this node, the descendant nodes will move accordingly.
 */
 type PathFollow struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorPathFollow is the class constructor of the PathFollow class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPathFollow = gdnative.NewLazyClassConstructor("PathFollow")
+
+// NewPathFollow will create a new instance of the PathFollow class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPathFollow() *PathFollow {
+	obj := &PathFollow{}
+	obj.SetBaseObject(classConstructorPathFollow.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *PathFollow) BaseClass() string {
 	return "PathFollow"
 }
 
+// Method binds of the PathFollow class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPathFollowGetCubicInterpolation = gdnative.NewLazyMethodBind("PathFollow", "get_cubic_interpolation")
+	methodBindPathFollowGetHOffset            = gdnative.NewLazyMethodBind("PathFollow", "get_h_offset")
+	methodBindPathFollowGetOffset             = gdnative.NewLazyMethodBind("PathFollow", "get_offset")
+	methodBindPathFollowGetRotationMode       = gdnative.NewLazyMethodBind("PathFollow", "get_rotation_mode")
+	methodBindPathFollowGetUnitOffset         = gdnative.NewLazyMethodBind("PathFollow", "get_unit_offset")
+	methodBindPathFollowGetVOffset            = gdnative.NewLazyMethodBind("PathFollow", "get_v_offset")
+	methodBindPathFollowHasLoop               = gdnative.NewLazyMethodBind("PathFollow", "has_loop")
+	methodBindPathFollowSetCubicInterpolation = gdnative.NewLazyMethodBind("PathFollow", "set_cubic_interpolation")
+	methodBindPathFollowSetHOffset            = gdnative.NewLazyMethodBind("PathFollow", "set_h_offset")
+	methodBindPathFollowSetLoop               = gdnative.NewLazyMethodBind("PathFollow", "set_loop")
+	methodBindPathFollowSetOffset             = gdnative.NewLazyMethodBind("PathFollow", "set_offset")
+	methodBindPathFollowSetRotationMode       = gdnative.NewLazyMethodBind("PathFollow", "set_rotation_mode")
+	methodBindPathFollowSetUnitOffset         = gdnative.NewLazyMethodBind("PathFollow", "set_unit_offset")
+	methodBindPathFollowSetVOffset            = gdnative.NewLazyMethodBind("PathFollow", "set_v_offset")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PathFollow) GetCubicInterpolation() gdnative.Bool {
 	//log.Println("Calling PathFollow.GetCubicInterpolation()")
@@ -55,7 +112,7 @@ func (o *PathFollow) GetCubicInterpolation() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "get_cubic_interpolation")
+	methodBind := methodBindPathFollowGetCubicInterpolation.Get()
 
 	// Call the parent method.
 	// bool
@@ -68,8 +125,8 @@ func (o *PathFollow) GetCubicInterpolation() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow) GetHOffset() gdnative.Real {
 	//log.Println("Calling PathFollow.GetHOffset()")
@@ -78,7 +135,7 @@ func (o *PathFollow) GetHOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "get_h_offset")
+	methodBind := methodBindPathFollowGetHOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -91,8 +148,8 @@ func (o *PathFollow) GetHOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow) GetOffset() gdnative.Real {
 	//log.Println("Calling PathFollow.GetOffset()")
@@ -101,7 +158,7 @@ func (o *PathFollow) GetOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "get_offset")
+	methodBind := methodBindPathFollowGetOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -114,8 +171,8 @@ func (o *PathFollow) GetOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.PathFollow::RotationMode
+	        Undocumented
+		Args: [], Returns: enum.PathFollow::RotationMode
 */
 func (o *PathFollow) GetRotationMode() PathFollowRotationMode {
 	//log.Println("Calling PathFollow.GetRotationMode()")
@@ -124,7 +181,7 @@ func (o *PathFollow) GetRotationMode() PathFollowRotationMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "get_rotation_mode")
+	methodBind := methodBindPathFollowGetRotationMode.Get()
 
 	// Call the parent method.
 	// enum.PathFollow::RotationMode
@@ -137,8 +194,8 @@ func (o *PathFollow) GetRotationMode() PathFollowRotationMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow) GetUnitOffset() gdnative.Real {
 	//log.Println("Calling PathFollow.GetUnitOffset()")
@@ -147,7 +204,7 @@ func (o *PathFollow) GetUnitOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "get_unit_offset")
+	methodBind := methodBindPathFollowGetUnitOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -160,8 +217,8 @@ func (o *PathFollow) GetUnitOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *PathFollow) GetVOffset() gdnative.Real {
 	//log.Println("Calling PathFollow.GetVOffset()")
@@ -170,7 +227,7 @@ func (o *PathFollow) GetVOffset() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "get_v_offset")
+	methodBind := methodBindPathFollowGetVOffset.Get()
 
 	// Call the parent method.
 	// float
@@ -183,8 +240,8 @@ func (o *PathFollow) GetVOffset() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *PathFollow) HasLoop() gdnative.Bool {
 	//log.Println("Calling PathFollow.HasLoop()")
@@ -193,7 +250,7 @@ func (o *PathFollow) HasLoop() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "has_loop")
+	methodBind := methodBindPathFollowHasLoop.Get()
 
 	// Call the parent method.
 	// bool
@@ -206,8 +263,8 @@ func (o *PathFollow) HasLoop() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *PathFollow) SetCubicInterpolation(enable gdnative.Bool) {
 	//log.Println("Calling PathFollow.SetCubicInterpolation()")
@@ -217,7 +274,7 @@ func (o *PathFollow) SetCubicInterpolation(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "set_cubic_interpolation")
+	methodBind := methodBindPathFollowSetCubicInterpolation.Get()
 
 	// Call the parent method.
 	// void
@@ -227,8 +284,8 @@ func (o *PathFollow) SetCubicInterpolation(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false h_offset float}], Returns: void
+	        Undocumented
+		Args: [{ false h_offset float}], Returns: void
 */
 func (o *PathFollow) SetHOffset(hOffset gdnative.Real) {
 	//log.Println("Calling PathFollow.SetHOffset()")
@@ -238,7 +295,7 @@ func (o *PathFollow) SetHOffset(hOffset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(hOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "set_h_offset")
+	methodBind := methodBindPathFollowSetHOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -248,8 +305,8 @@ func (o *PathFollow) SetHOffset(hOffset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false loop bool}], Returns: void
+	        Undocumented
+		Args: [{ false loop bool}], Returns: void
 */
 func (o *PathFollow) SetLoop(loop gdnative.Bool) {
 	//log.Println("Calling PathFollow.SetLoop()")
@@ -259,7 +316,7 @@ func (o *PathFollow) SetLoop(loop gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(loop)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "set_loop")
+	methodBind := methodBindPathFollowSetLoop.Get()
 
 	// Call the parent method.
 	// void
@@ -269,8 +326,8 @@ func (o *PathFollow) SetLoop(loop gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset float}], Returns: void
+	        Undocumented
+		Args: [{ false offset float}], Returns: void
 */
 func (o *PathFollow) SetOffset(offset gdnative.Real) {
 	//log.Println("Calling PathFollow.SetOffset()")
@@ -280,7 +337,7 @@ func (o *PathFollow) SetOffset(offset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "set_offset")
+	methodBind := methodBindPathFollowSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -290,18 +347,18 @@ func (o *PathFollow) SetOffset(offset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rotation_mode int}], Returns: void
+	        Undocumented
+		Args: [{ false rotation_mode enum.PathFollow::RotationMode}], Returns: void
 */
-func (o *PathFollow) SetRotationMode(rotationMode gdnative.Int) {
+func (o *PathFollow) SetRotationMode(rotationMode PathFollowRotationMode) {
 	//log.Println("Calling PathFollow.SetRotationMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(rotationMode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(rotationMode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "set_rotation_mode")
+	methodBind := methodBindPathFollowSetRotationMode.Get()
 
 	// Call the parent method.
 	// void
@@ -311,8 +368,8 @@ func (o *PathFollow) SetRotationMode(rotationMode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false unit_offset float}], Returns: void
+	        Undocumented
+		Args: [{ false unit_offset float}], Returns: void
 */
 func (o *PathFollow) SetUnitOffset(unitOffset gdnative.Real) {
 	//log.Println("Calling PathFollow.SetUnitOffset()")
@@ -322,7 +379,7 @@ func (o *PathFollow) SetUnitOffset(unitOffset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(unitOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "set_unit_offset")
+	methodBind := methodBindPathFollowSetUnitOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -332,8 +389,8 @@ func (o *PathFollow) SetUnitOffset(unitOffset gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false v_offset float}], Returns: void
+	        Undocumented
+		Args: [{ false v_offset float}], Returns: void
 */
 func (o *PathFollow) SetVOffset(vOffset gdnative.Real) {
 	//log.Println("Calling PathFollow.SetVOffset()")
@@ -343,7 +400,7 @@ func (o *PathFollow) SetVOffset(vOffset gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(vOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("PathFollow", "set_v_offset")
+	methodBind := methodBindPathFollowSetVOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -352,13 +409,54 @@ func (o *PathFollow) SetVOffset(vOffset gdnative.Real) {
 
 }
 
+// CubicInterp will return the value of the "cubic_interp" property.
+func (o *PathFollow) CubicInterp() gdnative.Bool {
+	return o.GetCubicInterpolation()
+}
+
+// SetCubicInterp will set the value of the "cubic_interp" property.
+func (o *PathFollow) SetCubicInterp(value gdnative.Bool) {
+	o.SetCubicInterpolation(value)
+}
+
+// HOffset will return the value of the "h_offset" property.
+func (o *PathFollow) HOffset() gdnative.Real {
+	return o.GetHOffset()
+}
+
+// Loop will return the value of the "loop" property.
+func (o *PathFollow) Loop() gdnative.Bool {
+	return o.HasLoop()
+}
+
+// Offset will return the value of the "offset" property.
+func (o *PathFollow) Offset() gdnative.Real {
+	return o.GetOffset()
+}
+
+// RotationMode will return the value of the "rotation_mode" property.
+func (o *PathFollow) RotationMode() PathFollowRotationMode {
+	return o.GetRotationMode()
+}
+
+// UnitOffset will return the value of the "unit_offset" property.
+func (o *PathFollow) UnitOffset() gdnative.Real {
+	return o.GetUnitOffset()
+}
+
+// VOffset will return the value of the "v_offset" property.
+func (o *PathFollow) VOffset() gdnative.Real {
+	return o.GetVOffset()
+}
+
 // PathFollowImplementer is an interface that implements the methods
 // of the PathFollow class.
 type PathFollowImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetCubicInterpolation() gdnative.Bool
 	GetHOffset() gdnative.Real
 	GetOffset() gdnative.Real
+	GetRotationMode() PathFollowRotationMode
 	GetUnitOffset() gdnative.Real
 	GetVOffset() gdnative.Real
 	HasLoop() gdnative.Bool
@@ -366,7 +464,15 @@ type PathFollowImplementer interface {
 	SetHOffset(hOffset gdnative.Real)
 	SetLoop(loop gdnative.Bool)
 	SetOffset(offset gdnative.Real)
-	SetRotationMode(rotationMode gdnative.Int)
+	SetRotationMode(rotationMode PathFollowRotationMode)
 	SetUnitOffset(unitOffset gdnative.Real)
 	SetVOffset(vOffset gdnative.Real)
+	CubicInterp() gdnative.Bool
+	SetCubicInterp(value gdnative.Bool)
+	HOffset() gdnative.Real
+	Loop() gdnative.Bool
+	Offset() gdnative.Real
+	RotationMode() PathFollowRotationMode
+	UnitOffset() gdnative.Real
+	VOffset() gdnative.Real
 }
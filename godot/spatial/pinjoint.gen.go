@@ -0,0 +1,175 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// PinJointParam is an enum for Param values.
+type PinJointParam int
+
+const (
+	PinJointParamBias         PinJointParam = 0
+	PinJointParamDamping      PinJointParam = 1
+	PinJointParamImpulseClamp PinJointParam = 2
+)
+
+// String will return the Godot name of the PinJointParam value.
+func (e PinJointParam) String() string {
+	switch e {
+	case PinJointParamBias:
+		return "PARAM_BIAS"
+	case PinJointParamDamping:
+		return "PARAM_DAMPING"
+	case PinJointParamImpulseClamp:
+		return "PARAM_IMPULSE_CLAMP"
+	}
+	return fmt.Sprintf("PinJointParam(%d)", int(e))
+}
+
+// ParsePinJointParam will return the PinJointParam value with the given Godot name.
+func ParsePinJointParam(name string) (PinJointParam, error) {
+	switch name {
+	case "PARAM_BIAS":
+		return PinJointParamBias, nil
+	case "PARAM_DAMPING":
+		return PinJointParamDamping, nil
+	case "PARAM_IMPULSE_CLAMP":
+		return PinJointParamImpulseClamp, nil
+	}
+	return 0, fmt.Errorf("invalid PinJointParam value %q", name)
+}
+
+/*
+Pin Joint for 3D Rigid Bodies. It pins 2 bodies (rigid or static) together.
+*/
+type PinJoint struct {
+	Joint
+	owner gdnative.Object
+}
+
+// classConstructorPinJoint is the class constructor of the PinJoint class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPinJoint = gdnative.NewLazyClassConstructor("PinJoint")
+
+// NewPinJoint will create a new instance of the PinJoint class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPinJoint() *PinJoint {
+	obj := &PinJoint{}
+	obj.SetBaseObject(classConstructorPinJoint.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *PinJoint) BaseClass() string {
+	return "PinJoint"
+}
+
+// Method binds of the PinJoint class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindPinJointGetParam = gdnative.NewLazyMethodBind("PinJoint", "get_param")
+	methodBindPinJointSetParam = gdnative.NewLazyMethodBind("PinJoint", "set_param")
+)
+
+/*
+	        Undocumented
+		Args: [{ false param enum.PinJoint::Param}], Returns: float
+*/
+func (o *PinJoint) GetParam(param PinJointParam) gdnative.Real {
+	//log.Println("Calling PinJoint.GetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+
+	// Get the method bind
+	methodBind := methodBindPinJointGetParam.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param enum.PinJoint::Param} { false value float}], Returns: void
+*/
+func (o *PinJoint) SetParam(param PinJointParam, value gdnative.Real) {
+	//log.Println("Calling PinJoint.SetParam()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(param))
+	ptrArguments[1] = gdnative.NewPointerFromReal(value)
+
+	// Get the method bind
+	methodBind := methodBindPinJointSetParam.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// ParamsBias will return the value of the "params/bias" property.
+func (o *PinJoint) ParamsBias() gdnative.Real {
+	return o.GetParam(0)
+}
+
+// SetParamsBias will set the value of the "params/bias" property.
+func (o *PinJoint) SetParamsBias(value gdnative.Real) {
+	o.SetParam(0, value)
+}
+
+// ParamsDamping will return the value of the "params/damping" property.
+func (o *PinJoint) ParamsDamping() gdnative.Real {
+	return o.GetParam(1)
+}
+
+// SetParamsDamping will set the value of the "params/damping" property.
+func (o *PinJoint) SetParamsDamping(value gdnative.Real) {
+	o.SetParam(1, value)
+}
+
+// ParamsImpulseClamp will return the value of the "params/impulse_clamp" property.
+func (o *PinJoint) ParamsImpulseClamp() gdnative.Real {
+	return o.GetParam(2)
+}
+
+// SetParamsImpulseClamp will set the value of the "params/impulse_clamp" property.
+func (o *PinJoint) SetParamsImpulseClamp(value gdnative.Real) {
+	o.SetParam(2, value)
+}
+
+// PinJointImplementer is an interface that implements the methods
+// of the PinJoint class.
+type PinJointImplementer interface {
+	JointImplementer
+	GetParam(param PinJointParam) gdnative.Real
+	SetParam(param PinJointParam, value gdnative.Real)
+	ParamsBias() gdnative.Real
+	SetParamsBias(value gdnative.Real)
+	ParamsDamping() gdnative.Real
+	SetParamsDamping(value gdnative.Real)
+	ParamsImpulseClamp() gdnative.Real
+	SetParamsImpulseClamp(value gdnative.Real)
+}
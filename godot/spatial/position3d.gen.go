@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,23 +14,28 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewPosition3DFromPointer(ptr gdnative.Pointer) Position3D {
-func newPosition3DFromPointer(ptr gdnative.Pointer) Position3D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Position3D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Generic 3D Position hint for editing. It's just like a plain [Spatial] but displays as a cross in the 3D-Editor at all times.
 */
 type Position3D struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorPosition3D is the class constructor of the Position3D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorPosition3D = gdnative.NewLazyClassConstructor("Position3D")
+
+// NewPosition3D will create a new instance of the Position3D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewPosition3D() *Position3D {
+	obj := &Position3D{}
+	obj.SetBaseObject(classConstructorPosition3D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Position3D) BaseClass() string {
 	return "Position3D"
 }
@@ -37,5 +43,5 @@ func (o *Position3D) BaseClass() string {
 // Position3DImplementer is an interface that implements the methods
 // of the Position3D class.
 type Position3DImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 }
@@ -0,0 +1,326 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// ProximityGroupDispatchMode is an enum for DispatchMode values.
+type ProximityGroupDispatchMode int
+
+const (
+	ProximityGroupModeProxy  ProximityGroupDispatchMode = 0
+	ProximityGroupModeSignal ProximityGroupDispatchMode = 1
+)
+
+// String will return the Godot name of the ProximityGroupDispatchMode value.
+func (e ProximityGroupDispatchMode) String() string {
+	switch e {
+	case ProximityGroupModeProxy:
+		return "MODE_PROXY"
+	case ProximityGroupModeSignal:
+		return "MODE_SIGNAL"
+	}
+	return fmt.Sprintf("ProximityGroupDispatchMode(%d)", int(e))
+}
+
+// ParseProximityGroupDispatchMode will return the ProximityGroupDispatchMode value with the given Godot name.
+func ParseProximityGroupDispatchMode(name string) (ProximityGroupDispatchMode, error) {
+	switch name {
+	case "MODE_PROXY":
+		return ProximityGroupModeProxy, nil
+	case "MODE_SIGNAL":
+		return ProximityGroupModeSignal, nil
+	}
+	return 0, fmt.Errorf("invalid ProximityGroupDispatchMode value %q", name)
+}
+
+/*
+General purpose proximity-detection node.
+*/
+type ProximityGroup struct {
+	godot.Spatial
+	owner gdnative.Object
+}
+
+// classConstructorProximityGroup is the class constructor of the ProximityGroup class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorProximityGroup = gdnative.NewLazyClassConstructor("ProximityGroup")
+
+// NewProximityGroup will create a new instance of the ProximityGroup class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewProximityGroup() *ProximityGroup {
+	obj := &ProximityGroup{}
+	obj.SetBaseObject(classConstructorProximityGroup.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *ProximityGroup) BaseClass() string {
+	return "ProximityGroup"
+}
+
+// Method binds of the ProximityGroup class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindProximityGroupX_ProximityGroupBroadcast = gdnative.NewLazyMethodBind("ProximityGroup", "_proximity_group_broadcast")
+	methodBindProximityGroupBroadcast                 = gdnative.NewLazyMethodBind("ProximityGroup", "broadcast")
+	methodBindProximityGroupGetDispatchMode           = gdnative.NewLazyMethodBind("ProximityGroup", "get_dispatch_mode")
+	methodBindProximityGroupGetGridRadius             = gdnative.NewLazyMethodBind("ProximityGroup", "get_grid_radius")
+	methodBindProximityGroupGetGroupName              = gdnative.NewLazyMethodBind("ProximityGroup", "get_group_name")
+	methodBindProximityGroupSetDispatchMode           = gdnative.NewLazyMethodBind("ProximityGroup", "set_dispatch_mode")
+	methodBindProximityGroupSetGridRadius             = gdnative.NewLazyMethodBind("ProximityGroup", "set_grid_radius")
+	methodBindProximityGroupSetGroupName              = gdnative.NewLazyMethodBind("ProximityGroup", "set_group_name")
+)
+
+/*
+	        Undocumented
+		Args: [{ false name String} { false params Variant}], Returns: void
+*/
+func (o *ProximityGroup) X_ProximityGroupBroadcast(name gdnative.String, params gdnative.Variant) {
+	//log.Println("Calling ProximityGroup.X_ProximityGroupBroadcast()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+	ptrArguments[1] = gdnative.NewPointerFromVariant(params)
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupX_ProximityGroupBroadcast.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false name String} { false parameters Variant}], Returns: void
+*/
+func (o *ProximityGroup) Broadcast(name gdnative.String, parameters gdnative.Variant) {
+	//log.Println("Calling ProximityGroup.Broadcast()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+	ptrArguments[1] = gdnative.NewPointerFromVariant(parameters)
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupBroadcast.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.ProximityGroup::DispatchMode
+*/
+func (o *ProximityGroup) GetDispatchMode() ProximityGroupDispatchMode {
+	//log.Println("Calling ProximityGroup.GetDispatchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupGetDispatchMode.Get()
+
+	// Call the parent method.
+	// enum.ProximityGroup::DispatchMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ProximityGroupDispatchMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *ProximityGroup) GetGridRadius() gdnative.Vector3 {
+	//log.Println("Calling ProximityGroup.GetGridRadius()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupGetGridRadius.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *ProximityGroup) GetGroupName() gdnative.String {
+	//log.Println("Calling ProximityGroup.GetGroupName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupGetGroupName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.ProximityGroup::DispatchMode}], Returns: void
+*/
+func (o *ProximityGroup) SetDispatchMode(mode ProximityGroupDispatchMode) {
+	//log.Println("Calling ProximityGroup.SetDispatchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupSetDispatchMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false radius Vector3}], Returns: void
+*/
+func (o *ProximityGroup) SetGridRadius(radius gdnative.Vector3) {
+	//log.Println("Calling ProximityGroup.SetGridRadius()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(radius)
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupSetGridRadius.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: void
+*/
+func (o *ProximityGroup) SetGroupName(name gdnative.String) {
+	//log.Println("Calling ProximityGroup.SetGroupName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindProximityGroupSetGroupName.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// DispatchMode will return the value of the "dispatch_mode" property.
+func (o *ProximityGroup) DispatchMode() ProximityGroupDispatchMode {
+	return o.GetDispatchMode()
+}
+
+// GridRadius will return the value of the "grid_radius" property.
+func (o *ProximityGroup) GridRadius() gdnative.Vector3 {
+	return o.GetGridRadius()
+}
+
+// GroupName will return the value of the "group_name" property.
+func (o *ProximityGroup) GroupName() gdnative.String {
+	return o.GetGroupName()
+}
+
+// Signals of the ProximityGroup class.
+const (
+	ProximityGroupSignalBroadcast gdnative.String = "broadcast"
+)
+
+// ProximityGroupBroadcastArgs holds the arguments of the "broadcast" signal.
+type ProximityGroupBroadcastArgs struct {
+	GroupName  gdnative.String
+	Parameters gdnative.Array
+}
+
+// ConnectBroadcast will connect the "broadcast" signal to the given method of the target object.
+func (o *ProximityGroup) ConnectBroadcast(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ProximityGroupSignalBroadcast, target, method, binds, 0)
+}
+
+// EmitBroadcast will emit the "broadcast" signal.
+func (o *ProximityGroup) EmitBroadcast(args ProximityGroupBroadcastArgs) error {
+	arg0 := gdnative.NewVariantString(args.GroupName)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantArray(args.Parameters)
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(ProximityGroupSignalBroadcast, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
+// ProximityGroupImplementer is an interface that implements the methods
+// of the ProximityGroup class.
+type ProximityGroupImplementer interface {
+	godot.SpatialImplementer
+	X_ProximityGroupBroadcast(name gdnative.String, params gdnative.Variant)
+	Broadcast(name gdnative.String, parameters gdnative.Variant)
+	GetDispatchMode() ProximityGroupDispatchMode
+	GetGridRadius() gdnative.Vector3
+	GetGroupName() gdnative.String
+	SetDispatchMode(mode ProximityGroupDispatchMode)
+	SetGridRadius(radius gdnative.Vector3)
+	SetGroupName(name gdnative.String)
+	DispatchMode() ProximityGroupDispatchMode
+	GridRadius() gdnative.Vector3
+	GroupName() gdnative.String
+	ConnectBroadcast(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBroadcast(args ProximityGroupBroadcastArgs) error
+}
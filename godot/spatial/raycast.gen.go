@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,40 +14,71 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRayCastFromPointer(ptr gdnative.Pointer) RayCast {
-func newRayCastFromPointer(ptr gdnative.Pointer) RayCast {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RayCast{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A RayCast represents a line from its origin to its destination position, [code]cast_to[/code]. It is used to query the 3D space in order to find the closest object along the path of the ray. RayCast can ignore some objects by adding them to the exception list via [code]add_exception[/code], by setting proper filtering with collision layers, or by filtering object types with type masks. Only enabled raycasts will be able to query the space and report collisions. RayCast calculates intersection every physics frame (see [Node]), and the result is cached so it can be used later until the next frame. If multiple queries are required between physics frames (or during the same frame) use [method force_raycast_update] after adjusting the raycast.
 */
 type RayCast struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorRayCast is the class constructor of the RayCast class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRayCast = gdnative.NewLazyClassConstructor("RayCast")
+
+// NewRayCast will create a new instance of the RayCast class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewRayCast() *RayCast {
+	obj := &RayCast{}
+	obj.SetBaseObject(classConstructorRayCast.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RayCast) BaseClass() string {
 	return "RayCast"
 }
 
+// Method binds of the RayCast class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRayCastAddException         = gdnative.NewLazyMethodBind("RayCast", "add_exception")
+	methodBindRayCastAddExceptionRid      = gdnative.NewLazyMethodBind("RayCast", "add_exception_rid")
+	methodBindRayCastClearExceptions      = gdnative.NewLazyMethodBind("RayCast", "clear_exceptions")
+	methodBindRayCastForceRaycastUpdate   = gdnative.NewLazyMethodBind("RayCast", "force_raycast_update")
+	methodBindRayCastGetCastTo            = gdnative.NewLazyMethodBind("RayCast", "get_cast_to")
+	methodBindRayCastGetCollider          = gdnative.NewLazyMethodBind("RayCast", "get_collider")
+	methodBindRayCastGetColliderShape     = gdnative.NewLazyMethodBind("RayCast", "get_collider_shape")
+	methodBindRayCastGetCollisionMask     = gdnative.NewLazyMethodBind("RayCast", "get_collision_mask")
+	methodBindRayCastGetCollisionMaskBit  = gdnative.NewLazyMethodBind("RayCast", "get_collision_mask_bit")
+	methodBindRayCastGetCollisionNormal   = gdnative.NewLazyMethodBind("RayCast", "get_collision_normal")
+	methodBindRayCastGetCollisionPoint    = gdnative.NewLazyMethodBind("RayCast", "get_collision_point")
+	methodBindRayCastGetExcludeParentBody = gdnative.NewLazyMethodBind("RayCast", "get_exclude_parent_body")
+	methodBindRayCastIsColliding          = gdnative.NewLazyMethodBind("RayCast", "is_colliding")
+	methodBindRayCastIsEnabled            = gdnative.NewLazyMethodBind("RayCast", "is_enabled")
+	methodBindRayCastRemoveException      = gdnative.NewLazyMethodBind("RayCast", "remove_exception")
+	methodBindRayCastRemoveExceptionRid   = gdnative.NewLazyMethodBind("RayCast", "remove_exception_rid")
+	methodBindRayCastSetCastTo            = gdnative.NewLazyMethodBind("RayCast", "set_cast_to")
+	methodBindRayCastSetCollisionMask     = gdnative.NewLazyMethodBind("RayCast", "set_collision_mask")
+	methodBindRayCastSetCollisionMaskBit  = gdnative.NewLazyMethodBind("RayCast", "set_collision_mask_bit")
+	methodBindRayCastSetEnabled           = gdnative.NewLazyMethodBind("RayCast", "set_enabled")
+	methodBindRayCastSetExcludeParentBody = gdnative.NewLazyMethodBind("RayCast", "set_exclude_parent_body")
+)
+
 /*
-        Adds a collision exception so the ray does not report collisions with the specified node.
-	Args: [{ false node Object}], Returns: void
+	        Adds a collision exception so the ray does not report collisions with the specified node.
+		Args: [{ false node Object}], Returns: void
 */
-func (o *RayCast) AddException(node ObjectImplementer) {
+func (o *RayCast) AddException(node godot.ObjectImplementer) {
 	//log.Println("Calling RayCast.AddException()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "add_exception")
+	methodBind := methodBindRayCastAddException.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +88,8 @@ func (o *RayCast) AddException(node ObjectImplementer) {
 }
 
 /*
-        Adds a collision exception so the ray does not report collisions with the specified [RID].
-	Args: [{ false rid RID}], Returns: void
+	        Adds a collision exception so the ray does not report collisions with the specified [RID].
+		Args: [{ false rid RID}], Returns: void
 */
 func (o *RayCast) AddExceptionRid(rid gdnative.Rid) {
 	//log.Println("Calling RayCast.AddExceptionRid()")
@@ -67,7 +99,7 @@ func (o *RayCast) AddExceptionRid(rid gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "add_exception_rid")
+	methodBind := methodBindRayCastAddExceptionRid.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +109,8 @@ func (o *RayCast) AddExceptionRid(rid gdnative.Rid) {
 }
 
 /*
-        Removes all collision exceptions for this ray.
-	Args: [], Returns: void
+	        Removes all collision exceptions for this ray.
+		Args: [], Returns: void
 */
 func (o *RayCast) ClearExceptions() {
 	//log.Println("Calling RayCast.ClearExceptions()")
@@ -87,7 +119,7 @@ func (o *RayCast) ClearExceptions() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "clear_exceptions")
+	methodBind := methodBindRayCastClearExceptions.Get()
 
 	// Call the parent method.
 	// void
@@ -97,8 +129,8 @@ func (o *RayCast) ClearExceptions() {
 }
 
 /*
-        Updates the collision information for the ray. Use this method to update the collision information immediately instead of waiting for the next [code]_physics_process[/code] call, for example if the ray or its parent has changed state. Note: [code]enabled == true[/code] is not required for this to work.
-	Args: [], Returns: void
+	        Updates the collision information for the ray. Use this method to update the collision information immediately instead of waiting for the next [code]_physics_process[/code] call, for example if the ray or its parent has changed state. Note: [code]enabled == true[/code] is not required for this to work.
+		Args: [], Returns: void
 */
 func (o *RayCast) ForceRaycastUpdate() {
 	//log.Println("Calling RayCast.ForceRaycastUpdate()")
@@ -107,7 +139,7 @@ func (o *RayCast) ForceRaycastUpdate() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "force_raycast_update")
+	methodBind := methodBindRayCastForceRaycastUpdate.Get()
 
 	// Call the parent method.
 	// void
@@ -117,8 +149,8 @@ func (o *RayCast) ForceRaycastUpdate() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *RayCast) GetCastTo() gdnative.Vector3 {
 	//log.Println("Calling RayCast.GetCastTo()")
@@ -127,7 +159,7 @@ func (o *RayCast) GetCastTo() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_cast_to")
+	methodBind := methodBindRayCastGetCastTo.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -140,17 +172,17 @@ func (o *RayCast) GetCastTo() gdnative.Vector3 {
 }
 
 /*
-        Return the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast.is_colliding(): var collider = RayCast.get_collider() [/codeblock]
-	Args: [], Returns: Object
+	        Return the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast.is_colliding(): var collider = RayCast.get_collider() [/codeblock]
+		Args: [], Returns: Object
 */
-func (o *RayCast) GetCollider() ObjectImplementer {
+func (o *RayCast) GetCollider() godot.ObjectImplementer {
 	//log.Println("Calling RayCast.GetCollider()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_collider")
+	methodBind := methodBindRayCastGetCollider.Get()
 
 	// Call the parent method.
 	// Object
@@ -158,27 +190,25 @@ func (o *RayCast) GetCollider() ObjectImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newObjectFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ObjectImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Object" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ObjectImplementer)
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the collision shape of the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast.is_colliding(): var shape = RayCast.get_collider_shape() [/codeblock]
-	Args: [], Returns: int
+	        Returns the collision shape of the closest object the ray is pointing to. Note that this does not consider the length of the ray, so you must also use [method is_colliding] to check if the object returned is actually colliding with the ray. Example: [codeblock] if RayCast.is_colliding(): var shape = RayCast.get_collider_shape() [/codeblock]
+		Args: [], Returns: int
 */
 func (o *RayCast) GetColliderShape() gdnative.Int {
 	//log.Println("Calling RayCast.GetColliderShape()")
@@ -187,7 +217,7 @@ func (o *RayCast) GetColliderShape() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_collider_shape")
+	methodBind := methodBindRayCastGetColliderShape.Get()
 
 	// Call the parent method.
 	// int
@@ -200,8 +230,8 @@ func (o *RayCast) GetColliderShape() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *RayCast) GetCollisionMask() gdnative.Int {
 	//log.Println("Calling RayCast.GetCollisionMask()")
@@ -210,7 +240,7 @@ func (o *RayCast) GetCollisionMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_collision_mask")
+	methodBind := methodBindRayCastGetCollisionMask.Get()
 
 	// Call the parent method.
 	// int
@@ -223,8 +253,8 @@ func (o *RayCast) GetCollisionMask() gdnative.Int {
 }
 
 /*
-        Returns [code]true[/code] if the bit index passed is turned on. Note that bit indexes range from 0-19.
-	Args: [{ false bit int}], Returns: bool
+	        Returns [code]true[/code] if the bit index passed is turned on. Note that bit indexes range from 0-19.
+		Args: [{ false bit int}], Returns: bool
 */
 func (o *RayCast) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	//log.Println("Calling RayCast.GetCollisionMaskBit()")
@@ -234,7 +264,7 @@ func (o *RayCast) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bit)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_collision_mask_bit")
+	methodBind := methodBindRayCastGetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// bool
@@ -247,8 +277,8 @@ func (o *RayCast) GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns the normal of the intersecting object's shape at the collision point.
-	Args: [], Returns: Vector3
+	        Returns the normal of the intersecting object's shape at the collision point.
+		Args: [], Returns: Vector3
 */
 func (o *RayCast) GetCollisionNormal() gdnative.Vector3 {
 	//log.Println("Calling RayCast.GetCollisionNormal()")
@@ -257,7 +287,7 @@ func (o *RayCast) GetCollisionNormal() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_collision_normal")
+	methodBind := methodBindRayCastGetCollisionNormal.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -270,8 +300,8 @@ func (o *RayCast) GetCollisionNormal() gdnative.Vector3 {
 }
 
 /*
-        Returns the collision point at which the ray intersects the closest object. Note: this point is in the [b]global[/b] coordinate system.
-	Args: [], Returns: Vector3
+	        Returns the collision point at which the ray intersects the closest object. Note: this point is in the [b]global[/b] coordinate system.
+		Args: [], Returns: Vector3
 */
 func (o *RayCast) GetCollisionPoint() gdnative.Vector3 {
 	//log.Println("Calling RayCast.GetCollisionPoint()")
@@ -280,7 +310,7 @@ func (o *RayCast) GetCollisionPoint() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_collision_point")
+	methodBind := methodBindRayCastGetCollisionPoint.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -293,8 +323,8 @@ func (o *RayCast) GetCollisionPoint() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RayCast) GetExcludeParentBody() gdnative.Bool {
 	//log.Println("Calling RayCast.GetExcludeParentBody()")
@@ -303,7 +333,7 @@ func (o *RayCast) GetExcludeParentBody() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "get_exclude_parent_body")
+	methodBind := methodBindRayCastGetExcludeParentBody.Get()
 
 	// Call the parent method.
 	// bool
@@ -316,8 +346,8 @@ func (o *RayCast) GetExcludeParentBody() gdnative.Bool {
 }
 
 /*
-        Return whether the closest object the ray is pointing to is colliding with the vector (considering the vector length).
-	Args: [], Returns: bool
+	        Return whether the closest object the ray is pointing to is colliding with the vector (considering the vector length).
+		Args: [], Returns: bool
 */
 func (o *RayCast) IsColliding() gdnative.Bool {
 	//log.Println("Calling RayCast.IsColliding()")
@@ -326,7 +356,7 @@ func (o *RayCast) IsColliding() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "is_colliding")
+	methodBind := methodBindRayCastIsColliding.Get()
 
 	// Call the parent method.
 	// bool
@@ -339,8 +369,8 @@ func (o *RayCast) IsColliding() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RayCast) IsEnabled() gdnative.Bool {
 	//log.Println("Calling RayCast.IsEnabled()")
@@ -349,7 +379,7 @@ func (o *RayCast) IsEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "is_enabled")
+	methodBind := methodBindRayCastIsEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -362,18 +392,18 @@ func (o *RayCast) IsEnabled() gdnative.Bool {
 }
 
 /*
-        Removes a collision exception so the ray does report collisions with the specified node.
-	Args: [{ false node Object}], Returns: void
+	        Removes a collision exception so the ray does report collisions with the specified node.
+		Args: [{ false node Object}], Returns: void
 */
-func (o *RayCast) RemoveException(node ObjectImplementer) {
+func (o *RayCast) RemoveException(node godot.ObjectImplementer) {
 	//log.Println("Calling RayCast.RemoveException()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "remove_exception")
+	methodBind := methodBindRayCastRemoveException.Get()
 
 	// Call the parent method.
 	// void
@@ -383,8 +413,8 @@ func (o *RayCast) RemoveException(node ObjectImplementer) {
 }
 
 /*
-        Removes a collision exception so the ray does report collisions with the specified [RID].
-	Args: [{ false rid RID}], Returns: void
+	        Removes a collision exception so the ray does report collisions with the specified [RID].
+		Args: [{ false rid RID}], Returns: void
 */
 func (o *RayCast) RemoveExceptionRid(rid gdnative.Rid) {
 	//log.Println("Calling RayCast.RemoveExceptionRid()")
@@ -394,7 +424,7 @@ func (o *RayCast) RemoveExceptionRid(rid gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(rid)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "remove_exception_rid")
+	methodBind := methodBindRayCastRemoveExceptionRid.Get()
 
 	// Call the parent method.
 	// void
@@ -404,8 +434,8 @@ func (o *RayCast) RemoveExceptionRid(rid gdnative.Rid) {
 }
 
 /*
-        Undocumented
-	Args: [{ false local_point Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false local_point Vector3}], Returns: void
 */
 func (o *RayCast) SetCastTo(localPoint gdnative.Vector3) {
 	//log.Println("Calling RayCast.SetCastTo()")
@@ -415,7 +445,7 @@ func (o *RayCast) SetCastTo(localPoint gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(localPoint)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "set_cast_to")
+	methodBind := methodBindRayCastSetCastTo.Get()
 
 	// Call the parent method.
 	// void
@@ -425,8 +455,8 @@ func (o *RayCast) SetCastTo(localPoint gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *RayCast) SetCollisionMask(mask gdnative.Int) {
 	//log.Println("Calling RayCast.SetCollisionMask()")
@@ -436,7 +466,7 @@ func (o *RayCast) SetCollisionMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "set_collision_mask")
+	methodBind := methodBindRayCastSetCollisionMask.Get()
 
 	// Call the parent method.
 	// void
@@ -446,8 +476,8 @@ func (o *RayCast) SetCollisionMask(mask gdnative.Int) {
 }
 
 /*
-        Sets the bit index passed to the [code]value[/code] passed. Note that bit indexes range from 0-19.
-	Args: [{ false bit int} { false value bool}], Returns: void
+	        Sets the bit index passed to the [code]value[/code] passed. Note that bit indexes range from 0-19.
+		Args: [{ false bit int} { false value bool}], Returns: void
 */
 func (o *RayCast) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	//log.Println("Calling RayCast.SetCollisionMaskBit()")
@@ -458,7 +488,7 @@ func (o *RayCast) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "set_collision_mask_bit")
+	methodBind := methodBindRayCastSetCollisionMaskBit.Get()
 
 	// Call the parent method.
 	// void
@@ -468,8 +498,8 @@ func (o *RayCast) SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *RayCast) SetEnabled(enabled gdnative.Bool) {
 	//log.Println("Calling RayCast.SetEnabled()")
@@ -479,7 +509,7 @@ func (o *RayCast) SetEnabled(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "set_enabled")
+	methodBind := methodBindRayCastSetEnabled.Get()
 
 	// Call the parent method.
 	// void
@@ -489,8 +519,8 @@ func (o *RayCast) SetEnabled(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask bool}], Returns: void
+	        Undocumented
+		Args: [{ false mask bool}], Returns: void
 */
 func (o *RayCast) SetExcludeParentBody(mask gdnative.Bool) {
 	//log.Println("Calling RayCast.SetExcludeParentBody()")
@@ -500,7 +530,7 @@ func (o *RayCast) SetExcludeParentBody(mask gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RayCast", "set_exclude_parent_body")
+	methodBind := methodBindRayCastSetExcludeParentBody.Get()
 
 	// Call the parent method.
 	// void
@@ -509,16 +539,41 @@ func (o *RayCast) SetExcludeParentBody(mask gdnative.Bool) {
 
 }
 
+// CastTo will return the value of the "cast_to" property.
+func (o *RayCast) CastTo() gdnative.Vector3 {
+	return o.GetCastTo()
+}
+
+// CollisionMask will return the value of the "collision_mask" property.
+func (o *RayCast) CollisionMask() gdnative.Int {
+	return o.GetCollisionMask()
+}
+
+// Enabled will return the value of the "enabled" property.
+func (o *RayCast) Enabled() gdnative.Bool {
+	return o.IsEnabled()
+}
+
+// ExcludeParent will return the value of the "exclude_parent" property.
+func (o *RayCast) ExcludeParent() gdnative.Bool {
+	return o.GetExcludeParentBody()
+}
+
+// SetExcludeParent will set the value of the "exclude_parent" property.
+func (o *RayCast) SetExcludeParent(value gdnative.Bool) {
+	o.SetExcludeParentBody(value)
+}
+
 // RayCastImplementer is an interface that implements the methods
 // of the RayCast class.
 type RayCastImplementer interface {
-	SpatialImplementer
-	AddException(node ObjectImplementer)
+	godot.SpatialImplementer
+	AddException(node godot.ObjectImplementer)
 	AddExceptionRid(rid gdnative.Rid)
 	ClearExceptions()
 	ForceRaycastUpdate()
 	GetCastTo() gdnative.Vector3
-	GetCollider() ObjectImplementer
+	GetCollider() godot.ObjectImplementer
 	GetColliderShape() gdnative.Int
 	GetCollisionMask() gdnative.Int
 	GetCollisionMaskBit(bit gdnative.Int) gdnative.Bool
@@ -527,11 +582,16 @@ type RayCastImplementer interface {
 	GetExcludeParentBody() gdnative.Bool
 	IsColliding() gdnative.Bool
 	IsEnabled() gdnative.Bool
-	RemoveException(node ObjectImplementer)
+	RemoveException(node godot.ObjectImplementer)
 	RemoveExceptionRid(rid gdnative.Rid)
 	SetCastTo(localPoint gdnative.Vector3)
 	SetCollisionMask(mask gdnative.Int)
 	SetCollisionMaskBit(bit gdnative.Int, value gdnative.Bool)
 	SetEnabled(enabled gdnative.Bool)
 	SetExcludeParentBody(mask gdnative.Bool)
+	CastTo() gdnative.Vector3
+	CollisionMask() gdnative.Int
+	Enabled() gdnative.Bool
+	ExcludeParent() gdnative.Bool
+	SetExcludeParent(value gdnative.Bool)
 }
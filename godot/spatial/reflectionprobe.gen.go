@@ -1,7 +1,10 @@
-package godot
+package spatial
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -21,30 +24,85 @@ const (
 	ReflectionProbeUpdateOnce   ReflectionProbeUpdateMode = 0
 )
 
-//func NewReflectionProbeFromPointer(ptr gdnative.Pointer) ReflectionProbe {
-func newReflectionProbeFromPointer(ptr gdnative.Pointer) ReflectionProbe {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ReflectionProbe{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the ReflectionProbeUpdateMode value.
+func (e ReflectionProbeUpdateMode) String() string {
+	switch e {
+	case ReflectionProbeUpdateAlways:
+		return "UPDATE_ALWAYS"
+	case ReflectionProbeUpdateOnce:
+		return "UPDATE_ONCE"
+	}
+	return fmt.Sprintf("ReflectionProbeUpdateMode(%d)", int(e))
+}
 
-	return obj
+// ParseReflectionProbeUpdateMode will return the ReflectionProbeUpdateMode value with the given Godot name.
+func ParseReflectionProbeUpdateMode(name string) (ReflectionProbeUpdateMode, error) {
+	switch name {
+	case "UPDATE_ALWAYS":
+		return ReflectionProbeUpdateAlways, nil
+	case "UPDATE_ONCE":
+		return ReflectionProbeUpdateOnce, nil
+	}
+	return 0, fmt.Errorf("invalid ReflectionProbeUpdateMode value %q", name)
 }
 
 /*
-
  */
 type ReflectionProbe struct {
 	VisualInstance
 	owner gdnative.Object
 }
 
+// classConstructorReflectionProbe is the class constructor of the ReflectionProbe class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorReflectionProbe = gdnative.NewLazyClassConstructor("ReflectionProbe")
+
+// NewReflectionProbe will create a new instance of the ReflectionProbe class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewReflectionProbe() *ReflectionProbe {
+	obj := &ReflectionProbe{}
+	obj.SetBaseObject(classConstructorReflectionProbe.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ReflectionProbe) BaseClass() string {
 	return "ReflectionProbe"
 }
 
+// Method binds of the ReflectionProbe class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindReflectionProbeAreShadowsEnabled                   = gdnative.NewLazyMethodBind("ReflectionProbe", "are_shadows_enabled")
+	methodBindReflectionProbeGetCullMask                         = gdnative.NewLazyMethodBind("ReflectionProbe", "get_cull_mask")
+	methodBindReflectionProbeGetExtents                          = gdnative.NewLazyMethodBind("ReflectionProbe", "get_extents")
+	methodBindReflectionProbeGetIntensity                        = gdnative.NewLazyMethodBind("ReflectionProbe", "get_intensity")
+	methodBindReflectionProbeGetInteriorAmbient                  = gdnative.NewLazyMethodBind("ReflectionProbe", "get_interior_ambient")
+	methodBindReflectionProbeGetInteriorAmbientEnergy            = gdnative.NewLazyMethodBind("ReflectionProbe", "get_interior_ambient_energy")
+	methodBindReflectionProbeGetInteriorAmbientProbeContribution = gdnative.NewLazyMethodBind("ReflectionProbe", "get_interior_ambient_probe_contribution")
+	methodBindReflectionProbeGetMaxDistance                      = gdnative.NewLazyMethodBind("ReflectionProbe", "get_max_distance")
+	methodBindReflectionProbeGetOriginOffset                     = gdnative.NewLazyMethodBind("ReflectionProbe", "get_origin_offset")
+	methodBindReflectionProbeGetUpdateMode                       = gdnative.NewLazyMethodBind("ReflectionProbe", "get_update_mode")
+	methodBindReflectionProbeIsBoxProjectionEnabled              = gdnative.NewLazyMethodBind("ReflectionProbe", "is_box_projection_enabled")
+	methodBindReflectionProbeIsSetAsInterior                     = gdnative.NewLazyMethodBind("ReflectionProbe", "is_set_as_interior")
+	methodBindReflectionProbeSetAsInterior                       = gdnative.NewLazyMethodBind("ReflectionProbe", "set_as_interior")
+	methodBindReflectionProbeSetCullMask                         = gdnative.NewLazyMethodBind("ReflectionProbe", "set_cull_mask")
+	methodBindReflectionProbeSetEnableBoxProjection              = gdnative.NewLazyMethodBind("ReflectionProbe", "set_enable_box_projection")
+	methodBindReflectionProbeSetEnableShadows                    = gdnative.NewLazyMethodBind("ReflectionProbe", "set_enable_shadows")
+	methodBindReflectionProbeSetExtents                          = gdnative.NewLazyMethodBind("ReflectionProbe", "set_extents")
+	methodBindReflectionProbeSetIntensity                        = gdnative.NewLazyMethodBind("ReflectionProbe", "set_intensity")
+	methodBindReflectionProbeSetInteriorAmbient                  = gdnative.NewLazyMethodBind("ReflectionProbe", "set_interior_ambient")
+	methodBindReflectionProbeSetInteriorAmbientEnergy            = gdnative.NewLazyMethodBind("ReflectionProbe", "set_interior_ambient_energy")
+	methodBindReflectionProbeSetInteriorAmbientProbeContribution = gdnative.NewLazyMethodBind("ReflectionProbe", "set_interior_ambient_probe_contribution")
+	methodBindReflectionProbeSetMaxDistance                      = gdnative.NewLazyMethodBind("ReflectionProbe", "set_max_distance")
+	methodBindReflectionProbeSetOriginOffset                     = gdnative.NewLazyMethodBind("ReflectionProbe", "set_origin_offset")
+	methodBindReflectionProbeSetUpdateMode                       = gdnative.NewLazyMethodBind("ReflectionProbe", "set_update_mode")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ReflectionProbe) AreShadowsEnabled() gdnative.Bool {
 	//log.Println("Calling ReflectionProbe.AreShadowsEnabled()")
@@ -53,7 +111,7 @@ func (o *ReflectionProbe) AreShadowsEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "are_shadows_enabled")
+	methodBind := methodBindReflectionProbeAreShadowsEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -66,8 +124,8 @@ func (o *ReflectionProbe) AreShadowsEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ReflectionProbe) GetCullMask() gdnative.Int {
 	//log.Println("Calling ReflectionProbe.GetCullMask()")
@@ -76,7 +134,7 @@ func (o *ReflectionProbe) GetCullMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_cull_mask")
+	methodBind := methodBindReflectionProbeGetCullMask.Get()
 
 	// Call the parent method.
 	// int
@@ -89,8 +147,8 @@ func (o *ReflectionProbe) GetCullMask() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *ReflectionProbe) GetExtents() gdnative.Vector3 {
 	//log.Println("Calling ReflectionProbe.GetExtents()")
@@ -99,7 +157,7 @@ func (o *ReflectionProbe) GetExtents() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_extents")
+	methodBind := methodBindReflectionProbeGetExtents.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -112,8 +170,8 @@ func (o *ReflectionProbe) GetExtents() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ReflectionProbe) GetIntensity() gdnative.Real {
 	//log.Println("Calling ReflectionProbe.GetIntensity()")
@@ -122,7 +180,7 @@ func (o *ReflectionProbe) GetIntensity() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_intensity")
+	methodBind := methodBindReflectionProbeGetIntensity.Get()
 
 	// Call the parent method.
 	// float
@@ -135,8 +193,8 @@ func (o *ReflectionProbe) GetIntensity() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *ReflectionProbe) GetInteriorAmbient() gdnative.Color {
 	//log.Println("Calling ReflectionProbe.GetInteriorAmbient()")
@@ -145,7 +203,7 @@ func (o *ReflectionProbe) GetInteriorAmbient() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_interior_ambient")
+	methodBind := methodBindReflectionProbeGetInteriorAmbient.Get()
 
 	// Call the parent method.
 	// Color
@@ -158,8 +216,8 @@ func (o *ReflectionProbe) GetInteriorAmbient() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ReflectionProbe) GetInteriorAmbientEnergy() gdnative.Real {
 	//log.Println("Calling ReflectionProbe.GetInteriorAmbientEnergy()")
@@ -168,7 +226,7 @@ func (o *ReflectionProbe) GetInteriorAmbientEnergy() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_interior_ambient_energy")
+	methodBind := methodBindReflectionProbeGetInteriorAmbientEnergy.Get()
 
 	// Call the parent method.
 	// float
@@ -181,8 +239,8 @@ func (o *ReflectionProbe) GetInteriorAmbientEnergy() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ReflectionProbe) GetInteriorAmbientProbeContribution() gdnative.Real {
 	//log.Println("Calling ReflectionProbe.GetInteriorAmbientProbeContribution()")
@@ -191,7 +249,7 @@ func (o *ReflectionProbe) GetInteriorAmbientProbeContribution() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_interior_ambient_probe_contribution")
+	methodBind := methodBindReflectionProbeGetInteriorAmbientProbeContribution.Get()
 
 	// Call the parent method.
 	// float
@@ -204,8 +262,8 @@ func (o *ReflectionProbe) GetInteriorAmbientProbeContribution() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *ReflectionProbe) GetMaxDistance() gdnative.Real {
 	//log.Println("Calling ReflectionProbe.GetMaxDistance()")
@@ -214,7 +272,7 @@ func (o *ReflectionProbe) GetMaxDistance() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_max_distance")
+	methodBind := methodBindReflectionProbeGetMaxDistance.Get()
 
 	// Call the parent method.
 	// float
@@ -227,8 +285,8 @@ func (o *ReflectionProbe) GetMaxDistance() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *ReflectionProbe) GetOriginOffset() gdnative.Vector3 {
 	//log.Println("Calling ReflectionProbe.GetOriginOffset()")
@@ -237,7 +295,7 @@ func (o *ReflectionProbe) GetOriginOffset() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_origin_offset")
+	methodBind := methodBindReflectionProbeGetOriginOffset.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -250,8 +308,8 @@ func (o *ReflectionProbe) GetOriginOffset() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.ReflectionProbe::UpdateMode
+	        Undocumented
+		Args: [], Returns: enum.ReflectionProbe::UpdateMode
 */
 func (o *ReflectionProbe) GetUpdateMode() ReflectionProbeUpdateMode {
 	//log.Println("Calling ReflectionProbe.GetUpdateMode()")
@@ -260,7 +318,7 @@ func (o *ReflectionProbe) GetUpdateMode() ReflectionProbeUpdateMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "get_update_mode")
+	methodBind := methodBindReflectionProbeGetUpdateMode.Get()
 
 	// Call the parent method.
 	// enum.ReflectionProbe::UpdateMode
@@ -273,8 +331,8 @@ func (o *ReflectionProbe) GetUpdateMode() ReflectionProbeUpdateMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ReflectionProbe) IsBoxProjectionEnabled() gdnative.Bool {
 	//log.Println("Calling ReflectionProbe.IsBoxProjectionEnabled()")
@@ -283,7 +341,7 @@ func (o *ReflectionProbe) IsBoxProjectionEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "is_box_projection_enabled")
+	methodBind := methodBindReflectionProbeIsBoxProjectionEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -296,8 +354,8 @@ func (o *ReflectionProbe) IsBoxProjectionEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ReflectionProbe) IsSetAsInterior() gdnative.Bool {
 	//log.Println("Calling ReflectionProbe.IsSetAsInterior()")
@@ -306,7 +364,7 @@ func (o *ReflectionProbe) IsSetAsInterior() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "is_set_as_interior")
+	methodBind := methodBindReflectionProbeIsSetAsInterior.Get()
 
 	// Call the parent method.
 	// bool
@@ -319,8 +377,8 @@ func (o *ReflectionProbe) IsSetAsInterior() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ReflectionProbe) SetAsInterior(enable gdnative.Bool) {
 	//log.Println("Calling ReflectionProbe.SetAsInterior()")
@@ -330,7 +388,7 @@ func (o *ReflectionProbe) SetAsInterior(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_as_interior")
+	methodBind := methodBindReflectionProbeSetAsInterior.Get()
 
 	// Call the parent method.
 	// void
@@ -340,8 +398,8 @@ func (o *ReflectionProbe) SetAsInterior(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false layers int}], Returns: void
+	        Undocumented
+		Args: [{ false layers int}], Returns: void
 */
 func (o *ReflectionProbe) SetCullMask(layers gdnative.Int) {
 	//log.Println("Calling ReflectionProbe.SetCullMask()")
@@ -351,7 +409,7 @@ func (o *ReflectionProbe) SetCullMask(layers gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(layers)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_cull_mask")
+	methodBind := methodBindReflectionProbeSetCullMask.Get()
 
 	// Call the parent method.
 	// void
@@ -361,8 +419,8 @@ func (o *ReflectionProbe) SetCullMask(layers gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ReflectionProbe) SetEnableBoxProjection(enable gdnative.Bool) {
 	//log.Println("Calling ReflectionProbe.SetEnableBoxProjection()")
@@ -372,7 +430,7 @@ func (o *ReflectionProbe) SetEnableBoxProjection(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_enable_box_projection")
+	methodBind := methodBindReflectionProbeSetEnableBoxProjection.Get()
 
 	// Call the parent method.
 	// void
@@ -382,8 +440,8 @@ func (o *ReflectionProbe) SetEnableBoxProjection(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ReflectionProbe) SetEnableShadows(enable gdnative.Bool) {
 	//log.Println("Calling ReflectionProbe.SetEnableShadows()")
@@ -393,7 +451,7 @@ func (o *ReflectionProbe) SetEnableShadows(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_enable_shadows")
+	methodBind := methodBindReflectionProbeSetEnableShadows.Get()
 
 	// Call the parent method.
 	// void
@@ -403,8 +461,8 @@ func (o *ReflectionProbe) SetEnableShadows(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false extents Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false extents Vector3}], Returns: void
 */
 func (o *ReflectionProbe) SetExtents(extents gdnative.Vector3) {
 	//log.Println("Calling ReflectionProbe.SetExtents()")
@@ -414,7 +472,7 @@ func (o *ReflectionProbe) SetExtents(extents gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(extents)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_extents")
+	methodBind := methodBindReflectionProbeSetExtents.Get()
 
 	// Call the parent method.
 	// void
@@ -424,8 +482,8 @@ func (o *ReflectionProbe) SetExtents(extents gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false intensity float}], Returns: void
+	        Undocumented
+		Args: [{ false intensity float}], Returns: void
 */
 func (o *ReflectionProbe) SetIntensity(intensity gdnative.Real) {
 	//log.Println("Calling ReflectionProbe.SetIntensity()")
@@ -435,7 +493,7 @@ func (o *ReflectionProbe) SetIntensity(intensity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(intensity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_intensity")
+	methodBind := methodBindReflectionProbeSetIntensity.Get()
 
 	// Call the parent method.
 	// void
@@ -445,8 +503,8 @@ func (o *ReflectionProbe) SetIntensity(intensity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ambient Color}], Returns: void
+	        Undocumented
+		Args: [{ false ambient Color}], Returns: void
 */
 func (o *ReflectionProbe) SetInteriorAmbient(ambient gdnative.Color) {
 	//log.Println("Calling ReflectionProbe.SetInteriorAmbient()")
@@ -456,7 +514,7 @@ func (o *ReflectionProbe) SetInteriorAmbient(ambient gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(ambient)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_interior_ambient")
+	methodBind := methodBindReflectionProbeSetInteriorAmbient.Get()
 
 	// Call the parent method.
 	// void
@@ -466,8 +524,8 @@ func (o *ReflectionProbe) SetInteriorAmbient(ambient gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false ambient_energy float}], Returns: void
+	        Undocumented
+		Args: [{ false ambient_energy float}], Returns: void
 */
 func (o *ReflectionProbe) SetInteriorAmbientEnergy(ambientEnergy gdnative.Real) {
 	//log.Println("Calling ReflectionProbe.SetInteriorAmbientEnergy()")
@@ -477,7 +535,7 @@ func (o *ReflectionProbe) SetInteriorAmbientEnergy(ambientEnergy gdnative.Real)
 	ptrArguments[0] = gdnative.NewPointerFromReal(ambientEnergy)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_interior_ambient_energy")
+	methodBind := methodBindReflectionProbeSetInteriorAmbientEnergy.Get()
 
 	// Call the parent method.
 	// void
@@ -487,8 +545,8 @@ func (o *ReflectionProbe) SetInteriorAmbientEnergy(ambientEnergy gdnative.Real)
 }
 
 /*
-        Undocumented
-	Args: [{ false ambient_probe_contribution float}], Returns: void
+	        Undocumented
+		Args: [{ false ambient_probe_contribution float}], Returns: void
 */
 func (o *ReflectionProbe) SetInteriorAmbientProbeContribution(ambientProbeContribution gdnative.Real) {
 	//log.Println("Calling ReflectionProbe.SetInteriorAmbientProbeContribution()")
@@ -498,7 +556,7 @@ func (o *ReflectionProbe) SetInteriorAmbientProbeContribution(ambientProbeContri
 	ptrArguments[0] = gdnative.NewPointerFromReal(ambientProbeContribution)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_interior_ambient_probe_contribution")
+	methodBind := methodBindReflectionProbeSetInteriorAmbientProbeContribution.Get()
 
 	// Call the parent method.
 	// void
@@ -508,8 +566,8 @@ func (o *ReflectionProbe) SetInteriorAmbientProbeContribution(ambientProbeContri
 }
 
 /*
-        Undocumented
-	Args: [{ false max_distance float}], Returns: void
+	        Undocumented
+		Args: [{ false max_distance float}], Returns: void
 */
 func (o *ReflectionProbe) SetMaxDistance(maxDistance gdnative.Real) {
 	//log.Println("Calling ReflectionProbe.SetMaxDistance()")
@@ -519,7 +577,7 @@ func (o *ReflectionProbe) SetMaxDistance(maxDistance gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(maxDistance)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_max_distance")
+	methodBind := methodBindReflectionProbeSetMaxDistance.Get()
 
 	// Call the parent method.
 	// void
@@ -529,8 +587,8 @@ func (o *ReflectionProbe) SetMaxDistance(maxDistance gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false origin_offset Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false origin_offset Vector3}], Returns: void
 */
 func (o *ReflectionProbe) SetOriginOffset(originOffset gdnative.Vector3) {
 	//log.Println("Calling ReflectionProbe.SetOriginOffset()")
@@ -540,7 +598,7 @@ func (o *ReflectionProbe) SetOriginOffset(originOffset gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(originOffset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_origin_offset")
+	methodBind := methodBindReflectionProbeSetOriginOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -550,18 +608,18 @@ func (o *ReflectionProbe) SetOriginOffset(originOffset gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.ReflectionProbe::UpdateMode}], Returns: void
 */
-func (o *ReflectionProbe) SetUpdateMode(mode gdnative.Int) {
+func (o *ReflectionProbe) SetUpdateMode(mode ReflectionProbeUpdateMode) {
 	//log.Println("Calling ReflectionProbe.SetUpdateMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ReflectionProbe", "set_update_mode")
+	methodBind := methodBindReflectionProbeSetUpdateMode.Get()
 
 	// Call the parent method.
 	// void
@@ -570,6 +628,86 @@ func (o *ReflectionProbe) SetUpdateMode(mode gdnative.Int) {
 
 }
 
+// BoxProjection will return the value of the "box_projection" property.
+func (o *ReflectionProbe) BoxProjection() gdnative.Bool {
+	return o.IsBoxProjectionEnabled()
+}
+
+// SetBoxProjection will set the value of the "box_projection" property.
+func (o *ReflectionProbe) SetBoxProjection(value gdnative.Bool) {
+	o.SetEnableBoxProjection(value)
+}
+
+// CullMask will return the value of the "cull_mask" property.
+func (o *ReflectionProbe) CullMask() gdnative.Int {
+	return o.GetCullMask()
+}
+
+// EnableShadows will return the value of the "enable_shadows" property.
+func (o *ReflectionProbe) EnableShadows() gdnative.Bool {
+	return o.AreShadowsEnabled()
+}
+
+// Extents will return the value of the "extents" property.
+func (o *ReflectionProbe) Extents() gdnative.Vector3 {
+	return o.GetExtents()
+}
+
+// Intensity will return the value of the "intensity" property.
+func (o *ReflectionProbe) Intensity() gdnative.Real {
+	return o.GetIntensity()
+}
+
+// InteriorAmbientColor will return the value of the "interior_ambient_color" property.
+func (o *ReflectionProbe) InteriorAmbientColor() gdnative.Color {
+	return o.GetInteriorAmbient()
+}
+
+// SetInteriorAmbientColor will set the value of the "interior_ambient_color" property.
+func (o *ReflectionProbe) SetInteriorAmbientColor(value gdnative.Color) {
+	o.SetInteriorAmbient(value)
+}
+
+// InteriorAmbientContrib will return the value of the "interior_ambient_contrib" property.
+func (o *ReflectionProbe) InteriorAmbientContrib() gdnative.Real {
+	return o.GetInteriorAmbientProbeContribution()
+}
+
+// SetInteriorAmbientContrib will set the value of the "interior_ambient_contrib" property.
+func (o *ReflectionProbe) SetInteriorAmbientContrib(value gdnative.Real) {
+	o.SetInteriorAmbientProbeContribution(value)
+}
+
+// InteriorAmbientEnergy will return the value of the "interior_ambient_energy" property.
+func (o *ReflectionProbe) InteriorAmbientEnergy() gdnative.Real {
+	return o.GetInteriorAmbientEnergy()
+}
+
+// InteriorEnable will return the value of the "interior_enable" property.
+func (o *ReflectionProbe) InteriorEnable() gdnative.Bool {
+	return o.IsSetAsInterior()
+}
+
+// SetInteriorEnable will set the value of the "interior_enable" property.
+func (o *ReflectionProbe) SetInteriorEnable(value gdnative.Bool) {
+	o.SetAsInterior(value)
+}
+
+// MaxDistance will return the value of the "max_distance" property.
+func (o *ReflectionProbe) MaxDistance() gdnative.Real {
+	return o.GetMaxDistance()
+}
+
+// OriginOffset will return the value of the "origin_offset" property.
+func (o *ReflectionProbe) OriginOffset() gdnative.Vector3 {
+	return o.GetOriginOffset()
+}
+
+// UpdateMode will return the value of the "update_mode" property.
+func (o *ReflectionProbe) UpdateMode() ReflectionProbeUpdateMode {
+	return o.GetUpdateMode()
+}
+
 // ReflectionProbeImplementer is an interface that implements the methods
 // of the ReflectionProbe class.
 type ReflectionProbeImplementer interface {
@@ -583,6 +721,7 @@ type ReflectionProbeImplementer interface {
 	GetInteriorAmbientProbeContribution() gdnative.Real
 	GetMaxDistance() gdnative.Real
 	GetOriginOffset() gdnative.Vector3
+	GetUpdateMode() ReflectionProbeUpdateMode
 	IsBoxProjectionEnabled() gdnative.Bool
 	IsSetAsInterior() gdnative.Bool
 	SetAsInterior(enable gdnative.Bool)
@@ -596,5 +735,21 @@ type ReflectionProbeImplementer interface {
 	SetInteriorAmbientProbeContribution(ambientProbeContribution gdnative.Real)
 	SetMaxDistance(maxDistance gdnative.Real)
 	SetOriginOffset(originOffset gdnative.Vector3)
-	SetUpdateMode(mode gdnative.Int)
+	SetUpdateMode(mode ReflectionProbeUpdateMode)
+	BoxProjection() gdnative.Bool
+	SetBoxProjection(value gdnative.Bool)
+	CullMask() gdnative.Int
+	EnableShadows() gdnative.Bool
+	Extents() gdnative.Vector3
+	Intensity() gdnative.Real
+	InteriorAmbientColor() gdnative.Color
+	SetInteriorAmbientColor(value gdnative.Color)
+	InteriorAmbientContrib() gdnative.Real
+	SetInteriorAmbientContrib(value gdnative.Real)
+	InteriorAmbientEnergy() gdnative.Real
+	InteriorEnable() gdnative.Bool
+	SetInteriorEnable(value gdnative.Bool)
+	MaxDistance() gdnative.Real
+	OriginOffset() gdnative.Vector3
+	UpdateMode() ReflectionProbeUpdateMode
 }
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,50 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewRemoteTransformFromPointer(ptr gdnative.Pointer) RemoteTransform {
-func newRemoteTransformFromPointer(ptr gdnative.Pointer) RemoteTransform {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := RemoteTransform{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 RemoteTransform leads the [Transform] of another [Spatial] derived Node (called the remote node) in the scene. It can be set to track another Node's position, rotation and/or scale. It can update using either global or local coordinates.
 */
 type RemoteTransform struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorRemoteTransform is the class constructor of the RemoteTransform class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRemoteTransform = gdnative.NewLazyClassConstructor("RemoteTransform")
+
+// NewRemoteTransform will create a new instance of the RemoteTransform class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewRemoteTransform() *RemoteTransform {
+	obj := &RemoteTransform{}
+	obj.SetBaseObject(classConstructorRemoteTransform.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *RemoteTransform) BaseClass() string {
 	return "RemoteTransform"
 }
 
+// Method binds of the RemoteTransform class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRemoteTransformGetRemoteNode           = gdnative.NewLazyMethodBind("RemoteTransform", "get_remote_node")
+	methodBindRemoteTransformGetUpdatePosition       = gdnative.NewLazyMethodBind("RemoteTransform", "get_update_position")
+	methodBindRemoteTransformGetUpdateRotation       = gdnative.NewLazyMethodBind("RemoteTransform", "get_update_rotation")
+	methodBindRemoteTransformGetUpdateScale          = gdnative.NewLazyMethodBind("RemoteTransform", "get_update_scale")
+	methodBindRemoteTransformGetUseGlobalCoordinates = gdnative.NewLazyMethodBind("RemoteTransform", "get_use_global_coordinates")
+	methodBindRemoteTransformSetRemoteNode           = gdnative.NewLazyMethodBind("RemoteTransform", "set_remote_node")
+	methodBindRemoteTransformSetUpdatePosition       = gdnative.NewLazyMethodBind("RemoteTransform", "set_update_position")
+	methodBindRemoteTransformSetUpdateRotation       = gdnative.NewLazyMethodBind("RemoteTransform", "set_update_rotation")
+	methodBindRemoteTransformSetUpdateScale          = gdnative.NewLazyMethodBind("RemoteTransform", "set_update_scale")
+	methodBindRemoteTransformSetUseGlobalCoordinates = gdnative.NewLazyMethodBind("RemoteTransform", "set_use_global_coordinates")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *RemoteTransform) GetRemoteNode() gdnative.NodePath {
 	//log.Println("Calling RemoteTransform.GetRemoteNode()")
@@ -45,7 +66,7 @@ func (o *RemoteTransform) GetRemoteNode() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "get_remote_node")
+	methodBind := methodBindRemoteTransformGetRemoteNode.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -58,8 +79,8 @@ func (o *RemoteTransform) GetRemoteNode() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform) GetUpdatePosition() gdnative.Bool {
 	//log.Println("Calling RemoteTransform.GetUpdatePosition()")
@@ -68,7 +89,7 @@ func (o *RemoteTransform) GetUpdatePosition() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "get_update_position")
+	methodBind := methodBindRemoteTransformGetUpdatePosition.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +102,8 @@ func (o *RemoteTransform) GetUpdatePosition() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform) GetUpdateRotation() gdnative.Bool {
 	//log.Println("Calling RemoteTransform.GetUpdateRotation()")
@@ -91,7 +112,7 @@ func (o *RemoteTransform) GetUpdateRotation() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "get_update_rotation")
+	methodBind := methodBindRemoteTransformGetUpdateRotation.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +125,8 @@ func (o *RemoteTransform) GetUpdateRotation() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform) GetUpdateScale() gdnative.Bool {
 	//log.Println("Calling RemoteTransform.GetUpdateScale()")
@@ -114,7 +135,7 @@ func (o *RemoteTransform) GetUpdateScale() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "get_update_scale")
+	methodBind := methodBindRemoteTransformGetUpdateScale.Get()
 
 	// Call the parent method.
 	// bool
@@ -127,8 +148,8 @@ func (o *RemoteTransform) GetUpdateScale() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *RemoteTransform) GetUseGlobalCoordinates() gdnative.Bool {
 	//log.Println("Calling RemoteTransform.GetUseGlobalCoordinates()")
@@ -137,7 +158,7 @@ func (o *RemoteTransform) GetUseGlobalCoordinates() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "get_use_global_coordinates")
+	methodBind := methodBindRemoteTransformGetUseGlobalCoordinates.Get()
 
 	// Call the parent method.
 	// bool
@@ -150,8 +171,8 @@ func (o *RemoteTransform) GetUseGlobalCoordinates() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false path NodePath}], Returns: void
 */
 func (o *RemoteTransform) SetRemoteNode(path gdnative.NodePath) {
 	//log.Println("Calling RemoteTransform.SetRemoteNode()")
@@ -161,7 +182,7 @@ func (o *RemoteTransform) SetRemoteNode(path gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "set_remote_node")
+	methodBind := methodBindRemoteTransformSetRemoteNode.Get()
 
 	// Call the parent method.
 	// void
@@ -171,8 +192,8 @@ func (o *RemoteTransform) SetRemoteNode(path gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false update_remote_position bool}], Returns: void
+	        Undocumented
+		Args: [{ false update_remote_position bool}], Returns: void
 */
 func (o *RemoteTransform) SetUpdatePosition(updateRemotePosition gdnative.Bool) {
 	//log.Println("Calling RemoteTransform.SetUpdatePosition()")
@@ -182,7 +203,7 @@ func (o *RemoteTransform) SetUpdatePosition(updateRemotePosition gdnative.Bool)
 	ptrArguments[0] = gdnative.NewPointerFromBool(updateRemotePosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "set_update_position")
+	methodBind := methodBindRemoteTransformSetUpdatePosition.Get()
 
 	// Call the parent method.
 	// void
@@ -192,8 +213,8 @@ func (o *RemoteTransform) SetUpdatePosition(updateRemotePosition gdnative.Bool)
 }
 
 /*
-        Undocumented
-	Args: [{ false update_remote_rotation bool}], Returns: void
+	        Undocumented
+		Args: [{ false update_remote_rotation bool}], Returns: void
 */
 func (o *RemoteTransform) SetUpdateRotation(updateRemoteRotation gdnative.Bool) {
 	//log.Println("Calling RemoteTransform.SetUpdateRotation()")
@@ -203,7 +224,7 @@ func (o *RemoteTransform) SetUpdateRotation(updateRemoteRotation gdnative.Bool)
 	ptrArguments[0] = gdnative.NewPointerFromBool(updateRemoteRotation)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "set_update_rotation")
+	methodBind := methodBindRemoteTransformSetUpdateRotation.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +234,8 @@ func (o *RemoteTransform) SetUpdateRotation(updateRemoteRotation gdnative.Bool)
 }
 
 /*
-        Undocumented
-	Args: [{ false update_remote_scale bool}], Returns: void
+	        Undocumented
+		Args: [{ false update_remote_scale bool}], Returns: void
 */
 func (o *RemoteTransform) SetUpdateScale(updateRemoteScale gdnative.Bool) {
 	//log.Println("Calling RemoteTransform.SetUpdateScale()")
@@ -224,7 +245,7 @@ func (o *RemoteTransform) SetUpdateScale(updateRemoteScale gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(updateRemoteScale)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "set_update_scale")
+	methodBind := methodBindRemoteTransformSetUpdateScale.Get()
 
 	// Call the parent method.
 	// void
@@ -234,8 +255,8 @@ func (o *RemoteTransform) SetUpdateScale(updateRemoteScale gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false use_global_coordinates bool}], Returns: void
+	        Undocumented
+		Args: [{ false use_global_coordinates bool}], Returns: void
 */
 func (o *RemoteTransform) SetUseGlobalCoordinates(useGlobalCoordinates gdnative.Bool) {
 	//log.Println("Calling RemoteTransform.SetUseGlobalCoordinates()")
@@ -245,7 +266,7 @@ func (o *RemoteTransform) SetUseGlobalCoordinates(useGlobalCoordinates gdnative.
 	ptrArguments[0] = gdnative.NewPointerFromBool(useGlobalCoordinates)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("RemoteTransform", "set_use_global_coordinates")
+	methodBind := methodBindRemoteTransformSetUseGlobalCoordinates.Get()
 
 	// Call the parent method.
 	// void
@@ -254,10 +275,40 @@ func (o *RemoteTransform) SetUseGlobalCoordinates(useGlobalCoordinates gdnative.
 
 }
 
+// RemotePath will return the value of the "remote_path" property.
+func (o *RemoteTransform) RemotePath() gdnative.NodePath {
+	return o.GetRemoteNode()
+}
+
+// SetRemotePath will set the value of the "remote_path" property.
+func (o *RemoteTransform) SetRemotePath(value gdnative.NodePath) {
+	o.SetRemoteNode(value)
+}
+
+// UpdatePosition will return the value of the "update_position" property.
+func (o *RemoteTransform) UpdatePosition() gdnative.Bool {
+	return o.GetUpdatePosition()
+}
+
+// UpdateRotation will return the value of the "update_rotation" property.
+func (o *RemoteTransform) UpdateRotation() gdnative.Bool {
+	return o.GetUpdateRotation()
+}
+
+// UpdateScale will return the value of the "update_scale" property.
+func (o *RemoteTransform) UpdateScale() gdnative.Bool {
+	return o.GetUpdateScale()
+}
+
+// UseGlobalCoordinates will return the value of the "use_global_coordinates" property.
+func (o *RemoteTransform) UseGlobalCoordinates() gdnative.Bool {
+	return o.GetUseGlobalCoordinates()
+}
+
 // RemoteTransformImplementer is an interface that implements the methods
 // of the RemoteTransform class.
 type RemoteTransformImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetRemoteNode() gdnative.NodePath
 	GetUpdatePosition() gdnative.Bool
 	GetUpdateRotation() gdnative.Bool
@@ -268,4 +319,10 @@ type RemoteTransformImplementer interface {
 	SetUpdateRotation(updateRemoteRotation gdnative.Bool)
 	SetUpdateScale(updateRemoteScale gdnative.Bool)
 	SetUseGlobalCoordinates(useGlobalCoordinates gdnative.Bool)
+	RemotePath() gdnative.NodePath
+	SetRemotePath(value gdnative.NodePath)
+	UpdatePosition() gdnative.Bool
+	UpdateRotation() gdnative.Bool
+	UpdateScale() gdnative.Bool
+	UseGlobalCoordinates() gdnative.Bool
 }
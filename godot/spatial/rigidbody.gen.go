@@ -0,0 +1,1408 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/physics"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// RigidBodyMode is an enum for Mode values.
+type RigidBodyMode int
+
+const (
+	RigidBodyModeCharacter RigidBodyMode = 2
+	RigidBodyModeKinematic RigidBodyMode = 3
+	RigidBodyModeRigid     RigidBodyMode = 0
+	RigidBodyModeStatic    RigidBodyMode = 1
+)
+
+// String will return the Godot name of the RigidBodyMode value.
+func (e RigidBodyMode) String() string {
+	switch e {
+	case RigidBodyModeCharacter:
+		return "MODE_CHARACTER"
+	case RigidBodyModeKinematic:
+		return "MODE_KINEMATIC"
+	case RigidBodyModeRigid:
+		return "MODE_RIGID"
+	case RigidBodyModeStatic:
+		return "MODE_STATIC"
+	}
+	return fmt.Sprintf("RigidBodyMode(%d)", int(e))
+}
+
+// ParseRigidBodyMode will return the RigidBodyMode value with the given Godot name.
+func ParseRigidBodyMode(name string) (RigidBodyMode, error) {
+	switch name {
+	case "MODE_CHARACTER":
+		return RigidBodyModeCharacter, nil
+	case "MODE_KINEMATIC":
+		return RigidBodyModeKinematic, nil
+	case "MODE_RIGID":
+		return RigidBodyModeRigid, nil
+	case "MODE_STATIC":
+		return RigidBodyModeStatic, nil
+	}
+	return 0, fmt.Errorf("invalid RigidBodyMode value %q", name)
+}
+
+/*
+This is the node that implements full 3D physics. This means that you do not control a RigidBody directly. Instead you can apply forces to it (gravity, impulses, etc.), and the physics simulation will calculate the resulting movement, collision, bouncing, rotating, etc. A RigidBody has 4 behavior [member mode]s: Rigid, Static, Character, and Kinematic. [b]Note:[/b] Don't change a RigidBody's position every frame or very often. Sporadic changes work fine, but physics runs at a different granularity (fixed hz) than usual rendering (process callback) and maybe even in a separate thread, so changing this from a process loop will yield strange behavior. If you need to directly affect the body's state, use [method _integrate_forces], which allows you to directly access the physics state. If you need to override the default physics behavior, you can write a custom force integration. See [member custom_integrator].
+*/
+type RigidBody struct {
+	godot.PhysicsBody
+	owner gdnative.Object
+}
+
+// classConstructorRigidBody is the class constructor of the RigidBody class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorRigidBody = gdnative.NewLazyClassConstructor("RigidBody")
+
+// NewRigidBody will create a new instance of the RigidBody class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewRigidBody() *RigidBody {
+	obj := &RigidBody{}
+	obj.SetBaseObject(classConstructorRigidBody.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *RigidBody) BaseClass() string {
+	return "RigidBody"
+}
+
+// Method binds of the RigidBody class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindRigidBodyX_BodyEnterTree                     = gdnative.NewLazyMethodBind("RigidBody", "_body_enter_tree")
+	methodBindRigidBodyX_BodyExitTree                      = gdnative.NewLazyMethodBind("RigidBody", "_body_exit_tree")
+	methodBindRigidBodyX_DirectStateChanged                = gdnative.NewLazyMethodBind("RigidBody", "_direct_state_changed")
+	methodBindRigidBodyX_IntegrateForces                   = gdnative.NewLazyMethodBind("RigidBody", "_integrate_forces")
+	methodBindRigidBodyApplyImpulse                        = gdnative.NewLazyMethodBind("RigidBody", "apply_impulse")
+	methodBindRigidBodyGetAngularDamp                      = gdnative.NewLazyMethodBind("RigidBody", "get_angular_damp")
+	methodBindRigidBodyGetAngularVelocity                  = gdnative.NewLazyMethodBind("RigidBody", "get_angular_velocity")
+	methodBindRigidBodyGetAxisLock                         = gdnative.NewLazyMethodBind("RigidBody", "get_axis_lock")
+	methodBindRigidBodyGetBounce                           = gdnative.NewLazyMethodBind("RigidBody", "get_bounce")
+	methodBindRigidBodyGetCollidingBodies                  = gdnative.NewLazyMethodBind("RigidBody", "get_colliding_bodies")
+	methodBindRigidBodyGetFriction                         = gdnative.NewLazyMethodBind("RigidBody", "get_friction")
+	methodBindRigidBodyGetGravityScale                     = gdnative.NewLazyMethodBind("RigidBody", "get_gravity_scale")
+	methodBindRigidBodyGetLinearDamp                       = gdnative.NewLazyMethodBind("RigidBody", "get_linear_damp")
+	methodBindRigidBodyGetLinearVelocity                   = gdnative.NewLazyMethodBind("RigidBody", "get_linear_velocity")
+	methodBindRigidBodyGetMass                             = gdnative.NewLazyMethodBind("RigidBody", "get_mass")
+	methodBindRigidBodyGetMaxContactsReported              = gdnative.NewLazyMethodBind("RigidBody", "get_max_contacts_reported")
+	methodBindRigidBodyGetMode                             = gdnative.NewLazyMethodBind("RigidBody", "get_mode")
+	methodBindRigidBodyGetWeight                           = gdnative.NewLazyMethodBind("RigidBody", "get_weight")
+	methodBindRigidBodyIsAbleToSleep                       = gdnative.NewLazyMethodBind("RigidBody", "is_able_to_sleep")
+	methodBindRigidBodyIsContactMonitorEnabled             = gdnative.NewLazyMethodBind("RigidBody", "is_contact_monitor_enabled")
+	methodBindRigidBodyIsSleeping                          = gdnative.NewLazyMethodBind("RigidBody", "is_sleeping")
+	methodBindRigidBodyIsUsingContinuousCollisionDetection = gdnative.NewLazyMethodBind("RigidBody", "is_using_continuous_collision_detection")
+	methodBindRigidBodyIsUsingCustomIntegrator             = gdnative.NewLazyMethodBind("RigidBody", "is_using_custom_integrator")
+	methodBindRigidBodySetAngularDamp                      = gdnative.NewLazyMethodBind("RigidBody", "set_angular_damp")
+	methodBindRigidBodySetAngularVelocity                  = gdnative.NewLazyMethodBind("RigidBody", "set_angular_velocity")
+	methodBindRigidBodySetAxisLock                         = gdnative.NewLazyMethodBind("RigidBody", "set_axis_lock")
+	methodBindRigidBodySetAxisVelocity                     = gdnative.NewLazyMethodBind("RigidBody", "set_axis_velocity")
+	methodBindRigidBodySetBounce                           = gdnative.NewLazyMethodBind("RigidBody", "set_bounce")
+	methodBindRigidBodySetCanSleep                         = gdnative.NewLazyMethodBind("RigidBody", "set_can_sleep")
+	methodBindRigidBodySetContactMonitor                   = gdnative.NewLazyMethodBind("RigidBody", "set_contact_monitor")
+	methodBindRigidBodySetFriction                         = gdnative.NewLazyMethodBind("RigidBody", "set_friction")
+	methodBindRigidBodySetGravityScale                     = gdnative.NewLazyMethodBind("RigidBody", "set_gravity_scale")
+	methodBindRigidBodySetLinearDamp                       = gdnative.NewLazyMethodBind("RigidBody", "set_linear_damp")
+	methodBindRigidBodySetLinearVelocity                   = gdnative.NewLazyMethodBind("RigidBody", "set_linear_velocity")
+	methodBindRigidBodySetMass                             = gdnative.NewLazyMethodBind("RigidBody", "set_mass")
+	methodBindRigidBodySetMaxContactsReported              = gdnative.NewLazyMethodBind("RigidBody", "set_max_contacts_reported")
+	methodBindRigidBodySetMode                             = gdnative.NewLazyMethodBind("RigidBody", "set_mode")
+	methodBindRigidBodySetSleeping                         = gdnative.NewLazyMethodBind("RigidBody", "set_sleeping")
+	methodBindRigidBodySetUseContinuousCollisionDetection  = gdnative.NewLazyMethodBind("RigidBody", "set_use_continuous_collision_detection")
+	methodBindRigidBodySetUseCustomIntegrator              = gdnative.NewLazyMethodBind("RigidBody", "set_use_custom_integrator")
+	methodBindRigidBodySetWeight                           = gdnative.NewLazyMethodBind("RigidBody", "set_weight")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
+*/
+func (o *RigidBody) X_BodyEnterTree(arg0 gdnative.Int) {
+	//log.Println("Calling RigidBody.X_BodyEnterTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyX_BodyEnterTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
+*/
+func (o *RigidBody) X_BodyExitTree(arg0 gdnative.Int) {
+	//log.Println("Calling RigidBody.X_BodyExitTree()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyX_BodyExitTree.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
+*/
+func (o *RigidBody) X_DirectStateChanged(arg0 godot.ObjectImplementer) {
+	//log.Println("Calling RigidBody.X_DirectStateChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyX_DirectStateChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Called during physics processing, allowing you to read and safely modify the simulation state for the object. By default it works in addition to the usual physics behavior, but [method set_use_custom_integrator] allows you to disable the default behavior and do fully custom force integration for a body.
+		Args: [{ false state PhysicsDirectBodyState}], Returns: void
+*/
+func (o *RigidBody) X_IntegrateForces(state physics.PhysicsDirectBodyStateImplementer) {
+	//log.Println("Calling RigidBody.X_IntegrateForces()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(state))
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyX_IntegrateForces.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Apply a positioned impulse (which will be affected by the body mass and shape). This is the equivalent of hitting a billiard ball with a cue: a force that is applied once, and only once. Both the impulse and the position are in global coordinates, and the position is relative to the object's origin.
+		Args: [{ false position Vector3} { false impulse Vector3}], Returns: void
+*/
+func (o *RigidBody) ApplyImpulse(position gdnative.Vector3, impulse gdnative.Vector3) {
+	//log.Println("Calling RigidBody.ApplyImpulse()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(position)
+	ptrArguments[1] = gdnative.NewPointerFromVector3(impulse)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyApplyImpulse.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody) GetAngularDamp() gdnative.Real {
+	//log.Println("Calling RigidBody.GetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetAngularDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *RigidBody) GetAngularVelocity() gdnative.Vector3 {
+	//log.Println("Calling RigidBody.GetAngularVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetAngularVelocity.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false axis int}], Returns: bool
+*/
+func (o *RigidBody) GetAxisLock(axis gdnative.Int) gdnative.Bool {
+	//log.Println("Calling RigidBody.GetAxisLock()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(axis)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetAxisLock.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody) GetBounce() gdnative.Real {
+	//log.Println("Calling RigidBody.GetBounce()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetBounce.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Return a list of the bodies colliding with this one. By default, number of max contacts reported is at 0 , see [method set_max_contacts_reported] to increase it. Note that the result of this test is not immediate after moving objects. For performance, list of collisions is updated once per frame and before the physics step. Consider using signals instead.
+		Args: [], Returns: Array
+*/
+func (o *RigidBody) GetCollidingBodies() gdnative.Array {
+	//log.Println("Calling RigidBody.GetCollidingBodies()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetCollidingBodies.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody) GetFriction() gdnative.Real {
+	//log.Println("Calling RigidBody.GetFriction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetFriction.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody) GetGravityScale() gdnative.Real {
+	//log.Println("Calling RigidBody.GetGravityScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetGravityScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody) GetLinearDamp() gdnative.Real {
+	//log.Println("Calling RigidBody.GetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetLinearDamp.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *RigidBody) GetLinearVelocity() gdnative.Vector3 {
+	//log.Println("Calling RigidBody.GetLinearVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetLinearVelocity.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody) GetMass() gdnative.Real {
+	//log.Println("Calling RigidBody.GetMass()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetMass.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *RigidBody) GetMaxContactsReported() gdnative.Int {
+	//log.Println("Calling RigidBody.GetMaxContactsReported()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetMaxContactsReported.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.RigidBody::Mode
+*/
+func (o *RigidBody) GetMode() RigidBodyMode {
+	//log.Println("Calling RigidBody.GetMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetMode.Get()
+
+	// Call the parent method.
+	// enum.RigidBody::Mode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return RigidBodyMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *RigidBody) GetWeight() gdnative.Real {
+	//log.Println("Calling RigidBody.GetWeight()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyGetWeight.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody) IsAbleToSleep() gdnative.Bool {
+	//log.Println("Calling RigidBody.IsAbleToSleep()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyIsAbleToSleep.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody) IsContactMonitorEnabled() gdnative.Bool {
+	//log.Println("Calling RigidBody.IsContactMonitorEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyIsContactMonitorEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody) IsSleeping() gdnative.Bool {
+	//log.Println("Calling RigidBody.IsSleeping()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyIsSleeping.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody) IsUsingContinuousCollisionDetection() gdnative.Bool {
+	//log.Println("Calling RigidBody.IsUsingContinuousCollisionDetection()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyIsUsingContinuousCollisionDetection.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *RigidBody) IsUsingCustomIntegrator() gdnative.Bool {
+	//log.Println("Calling RigidBody.IsUsingCustomIntegrator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodyIsUsingCustomIntegrator.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false angular_damp float}], Returns: void
+*/
+func (o *RigidBody) SetAngularDamp(angularDamp gdnative.Real) {
+	//log.Println("Calling RigidBody.SetAngularDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(angularDamp)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetAngularDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false angular_velocity Vector3}], Returns: void
+*/
+func (o *RigidBody) SetAngularVelocity(angularVelocity gdnative.Vector3) {
+	//log.Println("Calling RigidBody.SetAngularVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(angularVelocity)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetAngularVelocity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false axis int} { false lock bool}], Returns: void
+*/
+func (o *RigidBody) SetAxisLock(axis gdnative.Int, lock gdnative.Bool) {
+	//log.Println("Calling RigidBody.SetAxisLock()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(axis)
+	ptrArguments[1] = gdnative.NewPointerFromBool(lock)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetAxisLock.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set an axis velocity. The velocity in the given vector axis will be set as the given vector length. This is useful for jumping behavior.
+		Args: [{ false axis_velocity Vector3}], Returns: void
+*/
+func (o *RigidBody) SetAxisVelocity(axisVelocity gdnative.Vector3) {
+	//log.Println("Calling RigidBody.SetAxisVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(axisVelocity)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetAxisVelocity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false bounce float}], Returns: void
+*/
+func (o *RigidBody) SetBounce(bounce gdnative.Real) {
+	//log.Println("Calling RigidBody.SetBounce()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(bounce)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetBounce.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false able_to_sleep bool}], Returns: void
+*/
+func (o *RigidBody) SetCanSleep(ableToSleep gdnative.Bool) {
+	//log.Println("Calling RigidBody.SetCanSleep()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(ableToSleep)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetCanSleep.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *RigidBody) SetContactMonitor(enabled gdnative.Bool) {
+	//log.Println("Calling RigidBody.SetContactMonitor()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetContactMonitor.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false friction float}], Returns: void
+*/
+func (o *RigidBody) SetFriction(friction gdnative.Real) {
+	//log.Println("Calling RigidBody.SetFriction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(friction)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetFriction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false gravity_scale float}], Returns: void
+*/
+func (o *RigidBody) SetGravityScale(gravityScale gdnative.Real) {
+	//log.Println("Calling RigidBody.SetGravityScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(gravityScale)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetGravityScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false linear_damp float}], Returns: void
+*/
+func (o *RigidBody) SetLinearDamp(linearDamp gdnative.Real) {
+	//log.Println("Calling RigidBody.SetLinearDamp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(linearDamp)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetLinearDamp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false linear_velocity Vector3}], Returns: void
+*/
+func (o *RigidBody) SetLinearVelocity(linearVelocity gdnative.Vector3) {
+	//log.Println("Calling RigidBody.SetLinearVelocity()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(linearVelocity)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetLinearVelocity.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mass float}], Returns: void
+*/
+func (o *RigidBody) SetMass(mass gdnative.Real) {
+	//log.Println("Calling RigidBody.SetMass()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(mass)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetMass.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
+*/
+func (o *RigidBody) SetMaxContactsReported(amount gdnative.Int) {
+	//log.Println("Calling RigidBody.SetMaxContactsReported()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetMaxContactsReported.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.RigidBody::Mode}], Returns: void
+*/
+func (o *RigidBody) SetMode(mode RigidBodyMode) {
+	//log.Println("Calling RigidBody.SetMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false sleeping bool}], Returns: void
+*/
+func (o *RigidBody) SetSleeping(sleeping gdnative.Bool) {
+	//log.Println("Calling RigidBody.SetSleeping()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(sleeping)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetSleeping.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *RigidBody) SetUseContinuousCollisionDetection(enable gdnative.Bool) {
+	//log.Println("Calling RigidBody.SetUseContinuousCollisionDetection()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetUseContinuousCollisionDetection.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *RigidBody) SetUseCustomIntegrator(enable gdnative.Bool) {
+	//log.Println("Calling RigidBody.SetUseCustomIntegrator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetUseCustomIntegrator.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false weight float}], Returns: void
+*/
+func (o *RigidBody) SetWeight(weight gdnative.Real) {
+	//log.Println("Calling RigidBody.SetWeight()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(weight)
+
+	// Get the method bind
+	methodBind := methodBindRigidBodySetWeight.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AngularDamp will return the value of the "angular_damp" property.
+func (o *RigidBody) AngularDamp() gdnative.Real {
+	return o.GetAngularDamp()
+}
+
+// AngularVelocity will return the value of the "angular_velocity" property.
+func (o *RigidBody) AngularVelocity() gdnative.Vector3 {
+	return o.GetAngularVelocity()
+}
+
+// AxisLockAngularX will return the value of the "axis_lock_angular_x" property.
+func (o *RigidBody) AxisLockAngularX() gdnative.Bool {
+	return o.GetAxisLock(8)
+}
+
+// SetAxisLockAngularX will set the value of the "axis_lock_angular_x" property.
+func (o *RigidBody) SetAxisLockAngularX(value gdnative.Bool) {
+	o.SetAxisLock(8, value)
+}
+
+// AxisLockAngularY will return the value of the "axis_lock_angular_y" property.
+func (o *RigidBody) AxisLockAngularY() gdnative.Bool {
+	return o.GetAxisLock(16)
+}
+
+// SetAxisLockAngularY will set the value of the "axis_lock_angular_y" property.
+func (o *RigidBody) SetAxisLockAngularY(value gdnative.Bool) {
+	o.SetAxisLock(16, value)
+}
+
+// AxisLockAngularZ will return the value of the "axis_lock_angular_z" property.
+func (o *RigidBody) AxisLockAngularZ() gdnative.Bool {
+	return o.GetAxisLock(32)
+}
+
+// SetAxisLockAngularZ will set the value of the "axis_lock_angular_z" property.
+func (o *RigidBody) SetAxisLockAngularZ(value gdnative.Bool) {
+	o.SetAxisLock(32, value)
+}
+
+// AxisLockLinearX will return the value of the "axis_lock_linear_x" property.
+func (o *RigidBody) AxisLockLinearX() gdnative.Bool {
+	return o.GetAxisLock(1)
+}
+
+// SetAxisLockLinearX will set the value of the "axis_lock_linear_x" property.
+func (o *RigidBody) SetAxisLockLinearX(value gdnative.Bool) {
+	o.SetAxisLock(1, value)
+}
+
+// AxisLockLinearY will return the value of the "axis_lock_linear_y" property.
+func (o *RigidBody) AxisLockLinearY() gdnative.Bool {
+	return o.GetAxisLock(2)
+}
+
+// SetAxisLockLinearY will set the value of the "axis_lock_linear_y" property.
+func (o *RigidBody) SetAxisLockLinearY(value gdnative.Bool) {
+	o.SetAxisLock(2, value)
+}
+
+// AxisLockLinearZ will return the value of the "axis_lock_linear_z" property.
+func (o *RigidBody) AxisLockLinearZ() gdnative.Bool {
+	return o.GetAxisLock(4)
+}
+
+// SetAxisLockLinearZ will set the value of the "axis_lock_linear_z" property.
+func (o *RigidBody) SetAxisLockLinearZ(value gdnative.Bool) {
+	o.SetAxisLock(4, value)
+}
+
+// Bounce will return the value of the "bounce" property.
+func (o *RigidBody) Bounce() gdnative.Real {
+	return o.GetBounce()
+}
+
+// CanSleep will return the value of the "can_sleep" property.
+func (o *RigidBody) CanSleep() gdnative.Bool {
+	return o.IsAbleToSleep()
+}
+
+// ContactMonitor will return the value of the "contact_monitor" property.
+func (o *RigidBody) ContactMonitor() gdnative.Bool {
+	return o.IsContactMonitorEnabled()
+}
+
+// ContactsReported will return the value of the "contacts_reported" property.
+func (o *RigidBody) ContactsReported() gdnative.Int {
+	return o.GetMaxContactsReported()
+}
+
+// SetContactsReported will set the value of the "contacts_reported" property.
+func (o *RigidBody) SetContactsReported(value gdnative.Int) {
+	o.SetMaxContactsReported(value)
+}
+
+// ContinuousCd will return the value of the "continuous_cd" property.
+func (o *RigidBody) ContinuousCd() gdnative.Bool {
+	return o.IsUsingContinuousCollisionDetection()
+}
+
+// SetContinuousCd will set the value of the "continuous_cd" property.
+func (o *RigidBody) SetContinuousCd(value gdnative.Bool) {
+	o.SetUseContinuousCollisionDetection(value)
+}
+
+// CustomIntegrator will return the value of the "custom_integrator" property.
+func (o *RigidBody) CustomIntegrator() gdnative.Bool {
+	return o.IsUsingCustomIntegrator()
+}
+
+// SetCustomIntegrator will set the value of the "custom_integrator" property.
+func (o *RigidBody) SetCustomIntegrator(value gdnative.Bool) {
+	o.SetUseCustomIntegrator(value)
+}
+
+// Friction will return the value of the "friction" property.
+func (o *RigidBody) Friction() gdnative.Real {
+	return o.GetFriction()
+}
+
+// GravityScale will return the value of the "gravity_scale" property.
+func (o *RigidBody) GravityScale() gdnative.Real {
+	return o.GetGravityScale()
+}
+
+// LinearDamp will return the value of the "linear_damp" property.
+func (o *RigidBody) LinearDamp() gdnative.Real {
+	return o.GetLinearDamp()
+}
+
+// LinearVelocity will return the value of the "linear_velocity" property.
+func (o *RigidBody) LinearVelocity() gdnative.Vector3 {
+	return o.GetLinearVelocity()
+}
+
+// Mass will return the value of the "mass" property.
+func (o *RigidBody) Mass() gdnative.Real {
+	return o.GetMass()
+}
+
+// Mode will return the value of the "mode" property.
+func (o *RigidBody) Mode() RigidBodyMode {
+	return o.GetMode()
+}
+
+// Sleeping will return the value of the "sleeping" property.
+func (o *RigidBody) Sleeping() gdnative.Bool {
+	return o.IsSleeping()
+}
+
+// Weight will return the value of the "weight" property.
+func (o *RigidBody) Weight() gdnative.Real {
+	return o.GetWeight()
+}
+
+// Signals of the RigidBody class.
+const (
+	RigidBodySignalBodyEntered          gdnative.String = "body_entered"
+	RigidBodySignalBodyExited           gdnative.String = "body_exited"
+	RigidBodySignalBodyShapeEntered     gdnative.String = "body_shape_entered"
+	RigidBodySignalBodyShapeExited      gdnative.String = "body_shape_exited"
+	RigidBodySignalSleepingStateChanged gdnative.String = "sleeping_state_changed"
+)
+
+// RigidBodyBodyEnteredArgs holds the arguments of the "body_entered" signal.
+type RigidBodyBodyEnteredArgs struct {
+	Body godot.ObjectImplementer
+}
+
+// ConnectBodyEntered will connect the "body_entered" signal to the given method of the target object.
+func (o *RigidBody) ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBodySignalBodyEntered, target, method, binds, 0)
+}
+
+// EmitBodyEntered will emit the "body_entered" signal.
+func (o *RigidBody) EmitBodyEntered(args RigidBodyBodyEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RigidBodySignalBodyEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// RigidBodyBodyExitedArgs holds the arguments of the "body_exited" signal.
+type RigidBodyBodyExitedArgs struct {
+	Body godot.ObjectImplementer
+}
+
+// ConnectBodyExited will connect the "body_exited" signal to the given method of the target object.
+func (o *RigidBody) ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBodySignalBodyExited, target, method, binds, 0)
+}
+
+// EmitBodyExited will emit the "body_exited" signal.
+func (o *RigidBody) EmitBodyExited(args RigidBodyBodyExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(RigidBodySignalBodyExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// RigidBodyBodyShapeEnteredArgs holds the arguments of the "body_shape_entered" signal.
+type RigidBodyBodyShapeEnteredArgs struct {
+	BodyId     gdnative.Int
+	Body       godot.ObjectImplementer
+	BodyShape  gdnative.Int
+	LocalShape gdnative.Int
+}
+
+// ConnectBodyShapeEntered will connect the "body_shape_entered" signal to the given method of the target object.
+func (o *RigidBody) ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBodySignalBodyShapeEntered, target, method, binds, 0)
+}
+
+// EmitBodyShapeEntered will emit the "body_shape_entered" signal.
+func (o *RigidBody) EmitBodyShapeEntered(args RigidBodyBodyShapeEnteredArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.LocalShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(RigidBodySignalBodyShapeEntered, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// RigidBodyBodyShapeExitedArgs holds the arguments of the "body_shape_exited" signal.
+type RigidBodyBodyShapeExitedArgs struct {
+	BodyId     gdnative.Int
+	Body       godot.ObjectImplementer
+	BodyShape  gdnative.Int
+	LocalShape gdnative.Int
+}
+
+// ConnectBodyShapeExited will connect the "body_shape_exited" signal to the given method of the target object.
+func (o *RigidBody) ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBodySignalBodyShapeExited, target, method, binds, 0)
+}
+
+// EmitBodyShapeExited will emit the "body_shape_exited" signal.
+func (o *RigidBody) EmitBodyShapeExited(args RigidBodyBodyShapeExitedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyId))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantObject(godot.GetBaseObject(args.Body))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.BodyShape))
+	defer arg2.Destroy()
+	arg3 := gdnative.NewVariantInt(gdnative.Int64T(args.LocalShape))
+	defer arg3.Destroy()
+
+	ret, err := o.EmitSignal(RigidBodySignalBodyShapeExited, arg0, arg1, arg2, arg3)
+	ret.Destroy()
+	return err
+}
+
+// ConnectSleepingStateChanged will connect the "sleeping_state_changed" signal to the given method of the target object.
+func (o *RigidBody) ConnectSleepingStateChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(RigidBodySignalSleepingStateChanged, target, method, binds, 0)
+}
+
+// EmitSleepingStateChanged will emit the "sleeping_state_changed" signal.
+func (o *RigidBody) EmitSleepingStateChanged() error {
+
+	ret, err := o.EmitSignal(RigidBodySignalSleepingStateChanged)
+	ret.Destroy()
+	return err
+}
+
+// RigidBodyImplementer is an interface that implements the methods
+// of the RigidBody class.
+type RigidBodyImplementer interface {
+	godot.PhysicsBodyImplementer
+	X_BodyEnterTree(arg0 gdnative.Int)
+	X_BodyExitTree(arg0 gdnative.Int)
+	X_DirectStateChanged(arg0 godot.ObjectImplementer)
+	X_IntegrateForces(state physics.PhysicsDirectBodyStateImplementer)
+	ApplyImpulse(position gdnative.Vector3, impulse gdnative.Vector3)
+	GetAngularDamp() gdnative.Real
+	GetAngularVelocity() gdnative.Vector3
+	GetAxisLock(axis gdnative.Int) gdnative.Bool
+	GetBounce() gdnative.Real
+	GetCollidingBodies() gdnative.Array
+	GetFriction() gdnative.Real
+	GetGravityScale() gdnative.Real
+	GetLinearDamp() gdnative.Real
+	GetLinearVelocity() gdnative.Vector3
+	GetMass() gdnative.Real
+	GetMaxContactsReported() gdnative.Int
+	GetMode() RigidBodyMode
+	GetWeight() gdnative.Real
+	IsAbleToSleep() gdnative.Bool
+	IsContactMonitorEnabled() gdnative.Bool
+	IsSleeping() gdnative.Bool
+	IsUsingContinuousCollisionDetection() gdnative.Bool
+	IsUsingCustomIntegrator() gdnative.Bool
+	SetAngularDamp(angularDamp gdnative.Real)
+	SetAngularVelocity(angularVelocity gdnative.Vector3)
+	SetAxisLock(axis gdnative.Int, lock gdnative.Bool)
+	SetAxisVelocity(axisVelocity gdnative.Vector3)
+	SetBounce(bounce gdnative.Real)
+	SetCanSleep(ableToSleep gdnative.Bool)
+	SetContactMonitor(enabled gdnative.Bool)
+	SetFriction(friction gdnative.Real)
+	SetGravityScale(gravityScale gdnative.Real)
+	SetLinearDamp(linearDamp gdnative.Real)
+	SetLinearVelocity(linearVelocity gdnative.Vector3)
+	SetMass(mass gdnative.Real)
+	SetMaxContactsReported(amount gdnative.Int)
+	SetMode(mode RigidBodyMode)
+	SetSleeping(sleeping gdnative.Bool)
+	SetUseContinuousCollisionDetection(enable gdnative.Bool)
+	SetUseCustomIntegrator(enable gdnative.Bool)
+	SetWeight(weight gdnative.Real)
+	AngularDamp() gdnative.Real
+	AngularVelocity() gdnative.Vector3
+	AxisLockAngularX() gdnative.Bool
+	SetAxisLockAngularX(value gdnative.Bool)
+	AxisLockAngularY() gdnative.Bool
+	SetAxisLockAngularY(value gdnative.Bool)
+	AxisLockAngularZ() gdnative.Bool
+	SetAxisLockAngularZ(value gdnative.Bool)
+	AxisLockLinearX() gdnative.Bool
+	SetAxisLockLinearX(value gdnative.Bool)
+	AxisLockLinearY() gdnative.Bool
+	SetAxisLockLinearY(value gdnative.Bool)
+	AxisLockLinearZ() gdnative.Bool
+	SetAxisLockLinearZ(value gdnative.Bool)
+	Bounce() gdnative.Real
+	CanSleep() gdnative.Bool
+	ContactMonitor() gdnative.Bool
+	ContactsReported() gdnative.Int
+	SetContactsReported(value gdnative.Int)
+	ContinuousCd() gdnative.Bool
+	SetContinuousCd(value gdnative.Bool)
+	CustomIntegrator() gdnative.Bool
+	SetCustomIntegrator(value gdnative.Bool)
+	Friction() gdnative.Real
+	GravityScale() gdnative.Real
+	LinearDamp() gdnative.Real
+	LinearVelocity() gdnative.Vector3
+	Mass() gdnative.Real
+	Mode() RigidBodyMode
+	Sleeping() gdnative.Bool
+	Weight() gdnative.Real
+	ConnectBodyEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyEntered(args RigidBodyBodyEnteredArgs) error
+	ConnectBodyExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyExited(args RigidBodyBodyExitedArgs) error
+	ConnectBodyShapeEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeEntered(args RigidBodyBodyShapeEnteredArgs) error
+	ConnectBodyShapeExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitBodyShapeExited(args RigidBodyBodyShapeExitedArgs) error
+	ConnectSleepingStateChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSleepingStateChanged() error
+}
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,67 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSkeletonFromPointer(ptr gdnative.Pointer) Skeleton {
-func newSkeletonFromPointer(ptr gdnative.Pointer) Skeleton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Skeleton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
+// Constants of the Skeleton class.
+const (
+	SkeletonNotificationUpdateSkeleton gdnative.Int = 50
+)
 
 /*
 Skeleton provides a hierarchical interface for managing bones, including pose, rest and animation (see [Animation]). Skeleton will support rag doll dynamics in the future.
 */
 type Skeleton struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorSkeleton is the class constructor of the Skeleton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSkeleton = gdnative.NewLazyClassConstructor("Skeleton")
+
+// NewSkeleton will create a new instance of the Skeleton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSkeleton() *Skeleton {
+	obj := &Skeleton{}
+	obj.SetBaseObject(classConstructorSkeleton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Skeleton) BaseClass() string {
 	return "Skeleton"
 }
 
+// Method binds of the Skeleton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSkeletonAddBone                  = gdnative.NewLazyMethodBind("Skeleton", "add_bone")
+	methodBindSkeletonBindChildNodeToBone      = gdnative.NewLazyMethodBind("Skeleton", "bind_child_node_to_bone")
+	methodBindSkeletonClearBones               = gdnative.NewLazyMethodBind("Skeleton", "clear_bones")
+	methodBindSkeletonFindBone                 = gdnative.NewLazyMethodBind("Skeleton", "find_bone")
+	methodBindSkeletonGetBoneCount             = gdnative.NewLazyMethodBind("Skeleton", "get_bone_count")
+	methodBindSkeletonGetBoneCustomPose        = gdnative.NewLazyMethodBind("Skeleton", "get_bone_custom_pose")
+	methodBindSkeletonGetBoneGlobalPose        = gdnative.NewLazyMethodBind("Skeleton", "get_bone_global_pose")
+	methodBindSkeletonGetBoneName              = gdnative.NewLazyMethodBind("Skeleton", "get_bone_name")
+	methodBindSkeletonGetBoneParent            = gdnative.NewLazyMethodBind("Skeleton", "get_bone_parent")
+	methodBindSkeletonGetBonePose              = gdnative.NewLazyMethodBind("Skeleton", "get_bone_pose")
+	methodBindSkeletonGetBoneRest              = gdnative.NewLazyMethodBind("Skeleton", "get_bone_rest")
+	methodBindSkeletonGetBoneTransform         = gdnative.NewLazyMethodBind("Skeleton", "get_bone_transform")
+	methodBindSkeletonGetBoundChildNodesToBone = gdnative.NewLazyMethodBind("Skeleton", "get_bound_child_nodes_to_bone")
+	methodBindSkeletonIsBoneRestDisabled       = gdnative.NewLazyMethodBind("Skeleton", "is_bone_rest_disabled")
+	methodBindSkeletonSetBoneCustomPose        = gdnative.NewLazyMethodBind("Skeleton", "set_bone_custom_pose")
+	methodBindSkeletonSetBoneDisableRest       = gdnative.NewLazyMethodBind("Skeleton", "set_bone_disable_rest")
+	methodBindSkeletonSetBoneGlobalPose        = gdnative.NewLazyMethodBind("Skeleton", "set_bone_global_pose")
+	methodBindSkeletonSetBoneParent            = gdnative.NewLazyMethodBind("Skeleton", "set_bone_parent")
+	methodBindSkeletonSetBonePose              = gdnative.NewLazyMethodBind("Skeleton", "set_bone_pose")
+	methodBindSkeletonSetBoneRest              = gdnative.NewLazyMethodBind("Skeleton", "set_bone_rest")
+	methodBindSkeletonUnbindChildNodeFromBone  = gdnative.NewLazyMethodBind("Skeleton", "unbind_child_node_from_bone")
+	methodBindSkeletonUnparentBoneAndRest      = gdnative.NewLazyMethodBind("Skeleton", "unparent_bone_and_rest")
+)
+
 /*
-        Add a bone, with name "name". [method get_bone_count] will become the bone index.
-	Args: [{ false name String}], Returns: void
+	        Add a bone, with name "name". [method get_bone_count] will become the bone index.
+		Args: [{ false name String}], Returns: void
 */
 func (o *Skeleton) AddBone(name gdnative.String) {
 	//log.Println("Calling Skeleton.AddBone()")
@@ -46,7 +84,7 @@ func (o *Skeleton) AddBone(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "add_bone")
+	methodBind := methodBindSkeletonAddBone.Get()
 
 	// Call the parent method.
 	// void
@@ -56,19 +94,19 @@ func (o *Skeleton) AddBone(name gdnative.String) {
 }
 
 /*
-        Deprecated soon.
-	Args: [{ false bone_idx int} { false node Object}], Returns: void
+	        Deprecated soon.
+		Args: [{ false bone_idx int} { false node Object}], Returns: void
 */
-func (o *Skeleton) BindChildNodeToBone(boneIdx gdnative.Int, node ObjectImplementer) {
+func (o *Skeleton) BindChildNodeToBone(boneIdx gdnative.Int, node godot.ObjectImplementer) {
 	//log.Println("Calling Skeleton.BindChildNodeToBone()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "bind_child_node_to_bone")
+	methodBind := methodBindSkeletonBindChildNodeToBone.Get()
 
 	// Call the parent method.
 	// void
@@ -78,8 +116,8 @@ func (o *Skeleton) BindChildNodeToBone(boneIdx gdnative.Int, node ObjectImplemen
 }
 
 /*
-        Clear all the bones in this skeleton.
-	Args: [], Returns: void
+	        Clear all the bones in this skeleton.
+		Args: [], Returns: void
 */
 func (o *Skeleton) ClearBones() {
 	//log.Println("Calling Skeleton.ClearBones()")
@@ -88,7 +126,7 @@ func (o *Skeleton) ClearBones() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "clear_bones")
+	methodBind := methodBindSkeletonClearBones.Get()
 
 	// Call the parent method.
 	// void
@@ -98,8 +136,8 @@ func (o *Skeleton) ClearBones() {
 }
 
 /*
-        Return the bone index that matches "name" as its name.
-	Args: [{ false name String}], Returns: int
+	        Return the bone index that matches "name" as its name.
+		Args: [{ false name String}], Returns: int
 */
 func (o *Skeleton) FindBone(name gdnative.String) gdnative.Int {
 	//log.Println("Calling Skeleton.FindBone()")
@@ -109,7 +147,7 @@ func (o *Skeleton) FindBone(name gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "find_bone")
+	methodBind := methodBindSkeletonFindBone.Get()
 
 	// Call the parent method.
 	// int
@@ -122,8 +160,8 @@ func (o *Skeleton) FindBone(name gdnative.String) gdnative.Int {
 }
 
 /*
-        Return the amount of bones in the skeleton.
-	Args: [], Returns: int
+	        Return the amount of bones in the skeleton.
+		Args: [], Returns: int
 */
 func (o *Skeleton) GetBoneCount() gdnative.Int {
 	//log.Println("Calling Skeleton.GetBoneCount()")
@@ -132,7 +170,7 @@ func (o *Skeleton) GetBoneCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_count")
+	methodBind := methodBindSkeletonGetBoneCount.Get()
 
 	// Call the parent method.
 	// int
@@ -145,8 +183,7 @@ func (o *Skeleton) GetBoneCount() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false bone_idx int}], Returns: Transform
+Args: [{ false bone_idx int}], Returns: Transform
 */
 func (o *Skeleton) GetBoneCustomPose(boneIdx gdnative.Int) gdnative.Transform {
 	//log.Println("Calling Skeleton.GetBoneCustomPose()")
@@ -156,7 +193,7 @@ func (o *Skeleton) GetBoneCustomPose(boneIdx gdnative.Int) gdnative.Transform {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_custom_pose")
+	methodBind := methodBindSkeletonGetBoneCustomPose.Get()
 
 	// Call the parent method.
 	// Transform
@@ -169,8 +206,7 @@ func (o *Skeleton) GetBoneCustomPose(boneIdx gdnative.Int) gdnative.Transform {
 }
 
 /*
-
-	Args: [{ false bone_idx int}], Returns: Transform
+Args: [{ false bone_idx int}], Returns: Transform
 */
 func (o *Skeleton) GetBoneGlobalPose(boneIdx gdnative.Int) gdnative.Transform {
 	//log.Println("Calling Skeleton.GetBoneGlobalPose()")
@@ -180,7 +216,7 @@ func (o *Skeleton) GetBoneGlobalPose(boneIdx gdnative.Int) gdnative.Transform {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_global_pose")
+	methodBind := methodBindSkeletonGetBoneGlobalPose.Get()
 
 	// Call the parent method.
 	// Transform
@@ -193,8 +229,8 @@ func (o *Skeleton) GetBoneGlobalPose(boneIdx gdnative.Int) gdnative.Transform {
 }
 
 /*
-        Return the name of the bone at index "index"
-	Args: [{ false bone_idx int}], Returns: String
+	        Return the name of the bone at index "index"
+		Args: [{ false bone_idx int}], Returns: String
 */
 func (o *Skeleton) GetBoneName(boneIdx gdnative.Int) gdnative.String {
 	//log.Println("Calling Skeleton.GetBoneName()")
@@ -204,7 +240,7 @@ func (o *Skeleton) GetBoneName(boneIdx gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_name")
+	methodBind := methodBindSkeletonGetBoneName.Get()
 
 	// Call the parent method.
 	// String
@@ -217,8 +253,8 @@ func (o *Skeleton) GetBoneName(boneIdx gdnative.Int) gdnative.String {
 }
 
 /*
-        Return the bone index which is the parent of the bone at "bone_idx". If -1, then bone has no parent. Note that the parent bone returned will always be less than "bone_idx".
-	Args: [{ false bone_idx int}], Returns: int
+	        Return the bone index which is the parent of the bone at "bone_idx". If -1, then bone has no parent. Note that the parent bone returned will always be less than "bone_idx".
+		Args: [{ false bone_idx int}], Returns: int
 */
 func (o *Skeleton) GetBoneParent(boneIdx gdnative.Int) gdnative.Int {
 	//log.Println("Calling Skeleton.GetBoneParent()")
@@ -228,7 +264,7 @@ func (o *Skeleton) GetBoneParent(boneIdx gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_parent")
+	methodBind := methodBindSkeletonGetBoneParent.Get()
 
 	// Call the parent method.
 	// int
@@ -241,8 +277,8 @@ func (o *Skeleton) GetBoneParent(boneIdx gdnative.Int) gdnative.Int {
 }
 
 /*
-        Return the pose transform for bone "bone_idx".
-	Args: [{ false bone_idx int}], Returns: Transform
+	        Return the pose transform for bone "bone_idx".
+		Args: [{ false bone_idx int}], Returns: Transform
 */
 func (o *Skeleton) GetBonePose(boneIdx gdnative.Int) gdnative.Transform {
 	//log.Println("Calling Skeleton.GetBonePose()")
@@ -252,7 +288,7 @@ func (o *Skeleton) GetBonePose(boneIdx gdnative.Int) gdnative.Transform {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_pose")
+	methodBind := methodBindSkeletonGetBonePose.Get()
 
 	// Call the parent method.
 	// Transform
@@ -265,8 +301,8 @@ func (o *Skeleton) GetBonePose(boneIdx gdnative.Int) gdnative.Transform {
 }
 
 /*
-        Return the rest transform for a bone "bone_idx".
-	Args: [{ false bone_idx int}], Returns: Transform
+	        Return the rest transform for a bone "bone_idx".
+		Args: [{ false bone_idx int}], Returns: Transform
 */
 func (o *Skeleton) GetBoneRest(boneIdx gdnative.Int) gdnative.Transform {
 	//log.Println("Calling Skeleton.GetBoneRest()")
@@ -276,7 +312,7 @@ func (o *Skeleton) GetBoneRest(boneIdx gdnative.Int) gdnative.Transform {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_rest")
+	methodBind := methodBindSkeletonGetBoneRest.Get()
 
 	// Call the parent method.
 	// Transform
@@ -289,8 +325,7 @@ func (o *Skeleton) GetBoneRest(boneIdx gdnative.Int) gdnative.Transform {
 }
 
 /*
-
-	Args: [{ false bone_idx int}], Returns: Transform
+Args: [{ false bone_idx int}], Returns: Transform
 */
 func (o *Skeleton) GetBoneTransform(boneIdx gdnative.Int) gdnative.Transform {
 	//log.Println("Calling Skeleton.GetBoneTransform()")
@@ -300,7 +335,7 @@ func (o *Skeleton) GetBoneTransform(boneIdx gdnative.Int) gdnative.Transform {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bone_transform")
+	methodBind := methodBindSkeletonGetBoneTransform.Get()
 
 	// Call the parent method.
 	// Transform
@@ -313,8 +348,8 @@ func (o *Skeleton) GetBoneTransform(boneIdx gdnative.Int) gdnative.Transform {
 }
 
 /*
-        Deprecated soon.
-	Args: [{ false bone_idx int}], Returns: Array
+	        Deprecated soon.
+		Args: [{ false bone_idx int}], Returns: Array
 */
 func (o *Skeleton) GetBoundChildNodesToBone(boneIdx gdnative.Int) gdnative.Array {
 	//log.Println("Calling Skeleton.GetBoundChildNodesToBone()")
@@ -324,7 +359,7 @@ func (o *Skeleton) GetBoundChildNodesToBone(boneIdx gdnative.Int) gdnative.Array
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "get_bound_child_nodes_to_bone")
+	methodBind := methodBindSkeletonGetBoundChildNodesToBone.Get()
 
 	// Call the parent method.
 	// Array
@@ -337,8 +372,7 @@ func (o *Skeleton) GetBoundChildNodesToBone(boneIdx gdnative.Int) gdnative.Array
 }
 
 /*
-
-	Args: [{ false bone_idx int}], Returns: bool
+Args: [{ false bone_idx int}], Returns: bool
 */
 func (o *Skeleton) IsBoneRestDisabled(boneIdx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling Skeleton.IsBoneRestDisabled()")
@@ -348,7 +382,7 @@ func (o *Skeleton) IsBoneRestDisabled(boneIdx gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "is_bone_rest_disabled")
+	methodBind := methodBindSkeletonIsBoneRestDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -361,8 +395,7 @@ func (o *Skeleton) IsBoneRestDisabled(boneIdx gdnative.Int) gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false bone_idx int} { false custom_pose Transform}], Returns: void
+Args: [{ false bone_idx int} { false custom_pose Transform}], Returns: void
 */
 func (o *Skeleton) SetBoneCustomPose(boneIdx gdnative.Int, customPose gdnative.Transform) {
 	//log.Println("Calling Skeleton.SetBoneCustomPose()")
@@ -373,7 +406,7 @@ func (o *Skeleton) SetBoneCustomPose(boneIdx gdnative.Int, customPose gdnative.T
 	ptrArguments[1] = gdnative.NewPointerFromTransform(customPose)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "set_bone_custom_pose")
+	methodBind := methodBindSkeletonSetBoneCustomPose.Get()
 
 	// Call the parent method.
 	// void
@@ -383,8 +416,7 @@ func (o *Skeleton) SetBoneCustomPose(boneIdx gdnative.Int, customPose gdnative.T
 }
 
 /*
-
-	Args: [{ false bone_idx int} { false disable bool}], Returns: void
+Args: [{ false bone_idx int} { false disable bool}], Returns: void
 */
 func (o *Skeleton) SetBoneDisableRest(boneIdx gdnative.Int, disable gdnative.Bool) {
 	//log.Println("Calling Skeleton.SetBoneDisableRest()")
@@ -395,7 +427,7 @@ func (o *Skeleton) SetBoneDisableRest(boneIdx gdnative.Int, disable gdnative.Boo
 	ptrArguments[1] = gdnative.NewPointerFromBool(disable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "set_bone_disable_rest")
+	methodBind := methodBindSkeletonSetBoneDisableRest.Get()
 
 	// Call the parent method.
 	// void
@@ -405,8 +437,7 @@ func (o *Skeleton) SetBoneDisableRest(boneIdx gdnative.Int, disable gdnative.Boo
 }
 
 /*
-
-	Args: [{ false bone_idx int} { false pose Transform}], Returns: void
+Args: [{ false bone_idx int} { false pose Transform}], Returns: void
 */
 func (o *Skeleton) SetBoneGlobalPose(boneIdx gdnative.Int, pose gdnative.Transform) {
 	//log.Println("Calling Skeleton.SetBoneGlobalPose()")
@@ -417,7 +448,7 @@ func (o *Skeleton) SetBoneGlobalPose(boneIdx gdnative.Int, pose gdnative.Transfo
 	ptrArguments[1] = gdnative.NewPointerFromTransform(pose)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "set_bone_global_pose")
+	methodBind := methodBindSkeletonSetBoneGlobalPose.Get()
 
 	// Call the parent method.
 	// void
@@ -427,8 +458,8 @@ func (o *Skeleton) SetBoneGlobalPose(boneIdx gdnative.Int, pose gdnative.Transfo
 }
 
 /*
-        Set the bone index "parent_idx" as the parent of the bone at "bone_idx". If -1, then bone has no parent. Note: "parent_idx" must be less than "bone_idx".
-	Args: [{ false bone_idx int} { false parent_idx int}], Returns: void
+	        Set the bone index "parent_idx" as the parent of the bone at "bone_idx". If -1, then bone has no parent. Note: "parent_idx" must be less than "bone_idx".
+		Args: [{ false bone_idx int} { false parent_idx int}], Returns: void
 */
 func (o *Skeleton) SetBoneParent(boneIdx gdnative.Int, parentIdx gdnative.Int) {
 	//log.Println("Calling Skeleton.SetBoneParent()")
@@ -439,7 +470,7 @@ func (o *Skeleton) SetBoneParent(boneIdx gdnative.Int, parentIdx gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(parentIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "set_bone_parent")
+	methodBind := methodBindSkeletonSetBoneParent.Get()
 
 	// Call the parent method.
 	// void
@@ -449,8 +480,8 @@ func (o *Skeleton) SetBoneParent(boneIdx gdnative.Int, parentIdx gdnative.Int) {
 }
 
 /*
-        Return the pose transform for bone "bone_idx".
-	Args: [{ false bone_idx int} { false pose Transform}], Returns: void
+	        Return the pose transform for bone "bone_idx".
+		Args: [{ false bone_idx int} { false pose Transform}], Returns: void
 */
 func (o *Skeleton) SetBonePose(boneIdx gdnative.Int, pose gdnative.Transform) {
 	//log.Println("Calling Skeleton.SetBonePose()")
@@ -461,7 +492,7 @@ func (o *Skeleton) SetBonePose(boneIdx gdnative.Int, pose gdnative.Transform) {
 	ptrArguments[1] = gdnative.NewPointerFromTransform(pose)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "set_bone_pose")
+	methodBind := methodBindSkeletonSetBonePose.Get()
 
 	// Call the parent method.
 	// void
@@ -471,8 +502,8 @@ func (o *Skeleton) SetBonePose(boneIdx gdnative.Int, pose gdnative.Transform) {
 }
 
 /*
-        Set the rest transform for bone "bone_idx"
-	Args: [{ false bone_idx int} { false rest Transform}], Returns: void
+	        Set the rest transform for bone "bone_idx"
+		Args: [{ false bone_idx int} { false rest Transform}], Returns: void
 */
 func (o *Skeleton) SetBoneRest(boneIdx gdnative.Int, rest gdnative.Transform) {
 	//log.Println("Calling Skeleton.SetBoneRest()")
@@ -483,7 +514,7 @@ func (o *Skeleton) SetBoneRest(boneIdx gdnative.Int, rest gdnative.Transform) {
 	ptrArguments[1] = gdnative.NewPointerFromTransform(rest)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "set_bone_rest")
+	methodBind := methodBindSkeletonSetBoneRest.Get()
 
 	// Call the parent method.
 	// void
@@ -493,19 +524,19 @@ func (o *Skeleton) SetBoneRest(boneIdx gdnative.Int, rest gdnative.Transform) {
 }
 
 /*
-        Deprecated soon.
-	Args: [{ false bone_idx int} { false node Object}], Returns: void
+	        Deprecated soon.
+		Args: [{ false bone_idx int} { false node Object}], Returns: void
 */
-func (o *Skeleton) UnbindChildNodeFromBone(boneIdx gdnative.Int, node ObjectImplementer) {
+func (o *Skeleton) UnbindChildNodeFromBone(boneIdx gdnative.Int, node godot.ObjectImplementer) {
 	//log.Println("Calling Skeleton.UnbindChildNodeFromBone()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
-	ptrArguments[1] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "unbind_child_node_from_bone")
+	methodBind := methodBindSkeletonUnbindChildNodeFromBone.Get()
 
 	// Call the parent method.
 	// void
@@ -515,8 +546,7 @@ func (o *Skeleton) UnbindChildNodeFromBone(boneIdx gdnative.Int, node ObjectImpl
 }
 
 /*
-
-	Args: [{ false bone_idx int}], Returns: void
+Args: [{ false bone_idx int}], Returns: void
 */
 func (o *Skeleton) UnparentBoneAndRest(boneIdx gdnative.Int) {
 	//log.Println("Calling Skeleton.UnparentBoneAndRest()")
@@ -526,7 +556,7 @@ func (o *Skeleton) UnparentBoneAndRest(boneIdx gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(boneIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Skeleton", "unparent_bone_and_rest")
+	methodBind := methodBindSkeletonUnparentBoneAndRest.Get()
 
 	// Call the parent method.
 	// void
@@ -538,9 +568,9 @@ func (o *Skeleton) UnparentBoneAndRest(boneIdx gdnative.Int) {
 // SkeletonImplementer is an interface that implements the methods
 // of the Skeleton class.
 type SkeletonImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	AddBone(name gdnative.String)
-	BindChildNodeToBone(boneIdx gdnative.Int, node ObjectImplementer)
+	BindChildNodeToBone(boneIdx gdnative.Int, node godot.ObjectImplementer)
 	ClearBones()
 	FindBone(name gdnative.String) gdnative.Int
 	GetBoneCount() gdnative.Int
@@ -559,6 +589,6 @@ type SkeletonImplementer interface {
 	SetBoneParent(boneIdx gdnative.Int, parentIdx gdnative.Int)
 	SetBonePose(boneIdx gdnative.Int, pose gdnative.Transform)
 	SetBoneRest(boneIdx gdnative.Int, rest gdnative.Transform)
-	UnbindChildNodeFromBone(boneIdx gdnative.Int, node ObjectImplementer)
+	UnbindChildNodeFromBone(boneIdx gdnative.Int, node godot.ObjectImplementer)
 	UnparentBoneAndRest(boneIdx gdnative.Int)
 }
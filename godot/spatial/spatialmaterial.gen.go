@@ -0,0 +1,4297 @@
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// SpatialMaterialBillboardMode is an enum for BillboardMode values.
+type SpatialMaterialBillboardMode int
+
+const (
+	SpatialMaterialBillboardDisabled  SpatialMaterialBillboardMode = 0
+	SpatialMaterialBillboardEnabled   SpatialMaterialBillboardMode = 1
+	SpatialMaterialBillboardFixedY    SpatialMaterialBillboardMode = 2
+	SpatialMaterialBillboardParticles SpatialMaterialBillboardMode = 3
+)
+
+// String will return the Godot name of the SpatialMaterialBillboardMode value.
+func (e SpatialMaterialBillboardMode) String() string {
+	switch e {
+	case SpatialMaterialBillboardDisabled:
+		return "BILLBOARD_DISABLED"
+	case SpatialMaterialBillboardEnabled:
+		return "BILLBOARD_ENABLED"
+	case SpatialMaterialBillboardFixedY:
+		return "BILLBOARD_FIXED_Y"
+	case SpatialMaterialBillboardParticles:
+		return "BILLBOARD_PARTICLES"
+	}
+	return fmt.Sprintf("SpatialMaterialBillboardMode(%d)", int(e))
+}
+
+// ParseSpatialMaterialBillboardMode will return the SpatialMaterialBillboardMode value with the given Godot name.
+func ParseSpatialMaterialBillboardMode(name string) (SpatialMaterialBillboardMode, error) {
+	switch name {
+	case "BILLBOARD_DISABLED":
+		return SpatialMaterialBillboardDisabled, nil
+	case "BILLBOARD_ENABLED":
+		return SpatialMaterialBillboardEnabled, nil
+	case "BILLBOARD_FIXED_Y":
+		return SpatialMaterialBillboardFixedY, nil
+	case "BILLBOARD_PARTICLES":
+		return SpatialMaterialBillboardParticles, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialBillboardMode value %q", name)
+}
+
+// SpatialMaterialBlendMode is an enum for BlendMode values.
+type SpatialMaterialBlendMode int
+
+const (
+	SpatialMaterialBlendModeAdd SpatialMaterialBlendMode = 1
+	SpatialMaterialBlendModeMix SpatialMaterialBlendMode = 0
+	SpatialMaterialBlendModeMul SpatialMaterialBlendMode = 3
+	SpatialMaterialBlendModeSub SpatialMaterialBlendMode = 2
+)
+
+// String will return the Godot name of the SpatialMaterialBlendMode value.
+func (e SpatialMaterialBlendMode) String() string {
+	switch e {
+	case SpatialMaterialBlendModeAdd:
+		return "BLEND_MODE_ADD"
+	case SpatialMaterialBlendModeMix:
+		return "BLEND_MODE_MIX"
+	case SpatialMaterialBlendModeMul:
+		return "BLEND_MODE_MUL"
+	case SpatialMaterialBlendModeSub:
+		return "BLEND_MODE_SUB"
+	}
+	return fmt.Sprintf("SpatialMaterialBlendMode(%d)", int(e))
+}
+
+// ParseSpatialMaterialBlendMode will return the SpatialMaterialBlendMode value with the given Godot name.
+func ParseSpatialMaterialBlendMode(name string) (SpatialMaterialBlendMode, error) {
+	switch name {
+	case "BLEND_MODE_ADD":
+		return SpatialMaterialBlendModeAdd, nil
+	case "BLEND_MODE_MIX":
+		return SpatialMaterialBlendModeMix, nil
+	case "BLEND_MODE_MUL":
+		return SpatialMaterialBlendModeMul, nil
+	case "BLEND_MODE_SUB":
+		return SpatialMaterialBlendModeSub, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialBlendMode value %q", name)
+}
+
+// SpatialMaterialCullMode is an enum for CullMode values.
+type SpatialMaterialCullMode int
+
+const (
+	SpatialMaterialCullBack     SpatialMaterialCullMode = 0
+	SpatialMaterialCullDisabled SpatialMaterialCullMode = 2
+	SpatialMaterialCullFront    SpatialMaterialCullMode = 1
+)
+
+// String will return the Godot name of the SpatialMaterialCullMode value.
+func (e SpatialMaterialCullMode) String() string {
+	switch e {
+	case SpatialMaterialCullBack:
+		return "CULL_BACK"
+	case SpatialMaterialCullDisabled:
+		return "CULL_DISABLED"
+	case SpatialMaterialCullFront:
+		return "CULL_FRONT"
+	}
+	return fmt.Sprintf("SpatialMaterialCullMode(%d)", int(e))
+}
+
+// ParseSpatialMaterialCullMode will return the SpatialMaterialCullMode value with the given Godot name.
+func ParseSpatialMaterialCullMode(name string) (SpatialMaterialCullMode, error) {
+	switch name {
+	case "CULL_BACK":
+		return SpatialMaterialCullBack, nil
+	case "CULL_DISABLED":
+		return SpatialMaterialCullDisabled, nil
+	case "CULL_FRONT":
+		return SpatialMaterialCullFront, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialCullMode value %q", name)
+}
+
+// SpatialMaterialDepthDrawMode is an enum for DepthDrawMode values.
+type SpatialMaterialDepthDrawMode int
+
+const (
+	SpatialMaterialDepthDrawAlphaOpaquePrepass SpatialMaterialDepthDrawMode = 3
+	SpatialMaterialDepthDrawAlways             SpatialMaterialDepthDrawMode = 1
+	SpatialMaterialDepthDrawDisabled           SpatialMaterialDepthDrawMode = 2
+	SpatialMaterialDepthDrawOpaqueOnly         SpatialMaterialDepthDrawMode = 0
+)
+
+// String will return the Godot name of the SpatialMaterialDepthDrawMode value.
+func (e SpatialMaterialDepthDrawMode) String() string {
+	switch e {
+	case SpatialMaterialDepthDrawAlphaOpaquePrepass:
+		return "DEPTH_DRAW_ALPHA_OPAQUE_PREPASS"
+	case SpatialMaterialDepthDrawAlways:
+		return "DEPTH_DRAW_ALWAYS"
+	case SpatialMaterialDepthDrawDisabled:
+		return "DEPTH_DRAW_DISABLED"
+	case SpatialMaterialDepthDrawOpaqueOnly:
+		return "DEPTH_DRAW_OPAQUE_ONLY"
+	}
+	return fmt.Sprintf("SpatialMaterialDepthDrawMode(%d)", int(e))
+}
+
+// ParseSpatialMaterialDepthDrawMode will return the SpatialMaterialDepthDrawMode value with the given Godot name.
+func ParseSpatialMaterialDepthDrawMode(name string) (SpatialMaterialDepthDrawMode, error) {
+	switch name {
+	case "DEPTH_DRAW_ALPHA_OPAQUE_PREPASS":
+		return SpatialMaterialDepthDrawAlphaOpaquePrepass, nil
+	case "DEPTH_DRAW_ALWAYS":
+		return SpatialMaterialDepthDrawAlways, nil
+	case "DEPTH_DRAW_DISABLED":
+		return SpatialMaterialDepthDrawDisabled, nil
+	case "DEPTH_DRAW_OPAQUE_ONLY":
+		return SpatialMaterialDepthDrawOpaqueOnly, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialDepthDrawMode value %q", name)
+}
+
+// SpatialMaterialDetailUV is an enum for DetailUV values.
+type SpatialMaterialDetailUV int
+
+const (
+	SpatialMaterialDetailUv1 SpatialMaterialDetailUV = 0
+	SpatialMaterialDetailUv2 SpatialMaterialDetailUV = 1
+)
+
+// String will return the Godot name of the SpatialMaterialDetailUV value.
+func (e SpatialMaterialDetailUV) String() string {
+	switch e {
+	case SpatialMaterialDetailUv1:
+		return "DETAIL_UV_1"
+	case SpatialMaterialDetailUv2:
+		return "DETAIL_UV_2"
+	}
+	return fmt.Sprintf("SpatialMaterialDetailUV(%d)", int(e))
+}
+
+// ParseSpatialMaterialDetailUV will return the SpatialMaterialDetailUV value with the given Godot name.
+func ParseSpatialMaterialDetailUV(name string) (SpatialMaterialDetailUV, error) {
+	switch name {
+	case "DETAIL_UV_1":
+		return SpatialMaterialDetailUv1, nil
+	case "DETAIL_UV_2":
+		return SpatialMaterialDetailUv2, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialDetailUV value %q", name)
+}
+
+// SpatialMaterialDiffuseMode is an enum for DiffuseMode values.
+type SpatialMaterialDiffuseMode int
+
+const (
+	SpatialMaterialDiffuseBurley      SpatialMaterialDiffuseMode = 0
+	SpatialMaterialDiffuseLambert     SpatialMaterialDiffuseMode = 1
+	SpatialMaterialDiffuseLambertWrap SpatialMaterialDiffuseMode = 2
+	SpatialMaterialDiffuseOrenNayar   SpatialMaterialDiffuseMode = 3
+	SpatialMaterialDiffuseToon        SpatialMaterialDiffuseMode = 4
+)
+
+// String will return the Godot name of the SpatialMaterialDiffuseMode value.
+func (e SpatialMaterialDiffuseMode) String() string {
+	switch e {
+	case SpatialMaterialDiffuseBurley:
+		return "DIFFUSE_BURLEY"
+	case SpatialMaterialDiffuseLambert:
+		return "DIFFUSE_LAMBERT"
+	case SpatialMaterialDiffuseLambertWrap:
+		return "DIFFUSE_LAMBERT_WRAP"
+	case SpatialMaterialDiffuseOrenNayar:
+		return "DIFFUSE_OREN_NAYAR"
+	case SpatialMaterialDiffuseToon:
+		return "DIFFUSE_TOON"
+	}
+	return fmt.Sprintf("SpatialMaterialDiffuseMode(%d)", int(e))
+}
+
+// ParseSpatialMaterialDiffuseMode will return the SpatialMaterialDiffuseMode value with the given Godot name.
+func ParseSpatialMaterialDiffuseMode(name string) (SpatialMaterialDiffuseMode, error) {
+	switch name {
+	case "DIFFUSE_BURLEY":
+		return SpatialMaterialDiffuseBurley, nil
+	case "DIFFUSE_LAMBERT":
+		return SpatialMaterialDiffuseLambert, nil
+	case "DIFFUSE_LAMBERT_WRAP":
+		return SpatialMaterialDiffuseLambertWrap, nil
+	case "DIFFUSE_OREN_NAYAR":
+		return SpatialMaterialDiffuseOrenNayar, nil
+	case "DIFFUSE_TOON":
+		return SpatialMaterialDiffuseToon, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialDiffuseMode value %q", name)
+}
+
+// SpatialMaterialEmissionOperator is an enum for EmissionOperator values.
+type SpatialMaterialEmissionOperator int
+
+const (
+	SpatialMaterialEmissionOpAdd      SpatialMaterialEmissionOperator = 0
+	SpatialMaterialEmissionOpMultiply SpatialMaterialEmissionOperator = 1
+)
+
+// String will return the Godot name of the SpatialMaterialEmissionOperator value.
+func (e SpatialMaterialEmissionOperator) String() string {
+	switch e {
+	case SpatialMaterialEmissionOpAdd:
+		return "EMISSION_OP_ADD"
+	case SpatialMaterialEmissionOpMultiply:
+		return "EMISSION_OP_MULTIPLY"
+	}
+	return fmt.Sprintf("SpatialMaterialEmissionOperator(%d)", int(e))
+}
+
+// ParseSpatialMaterialEmissionOperator will return the SpatialMaterialEmissionOperator value with the given Godot name.
+func ParseSpatialMaterialEmissionOperator(name string) (SpatialMaterialEmissionOperator, error) {
+	switch name {
+	case "EMISSION_OP_ADD":
+		return SpatialMaterialEmissionOpAdd, nil
+	case "EMISSION_OP_MULTIPLY":
+		return SpatialMaterialEmissionOpMultiply, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialEmissionOperator value %q", name)
+}
+
+// SpatialMaterialFeature is an enum for Feature values.
+type SpatialMaterialFeature int
+
+const (
+	SpatialMaterialFeatureAmbientOcclusion    SpatialMaterialFeature = 6
+	SpatialMaterialFeatureAnisotropy          SpatialMaterialFeature = 5
+	SpatialMaterialFeatureClearcoat           SpatialMaterialFeature = 4
+	SpatialMaterialFeatureDepthMapping        SpatialMaterialFeature = 7
+	SpatialMaterialFeatureDetail              SpatialMaterialFeature = 11
+	SpatialMaterialFeatureEmission            SpatialMaterialFeature = 1
+	SpatialMaterialFeatureMax                 SpatialMaterialFeature = 12
+	SpatialMaterialFeatureNormalMapping       SpatialMaterialFeature = 2
+	SpatialMaterialFeatureRefraction          SpatialMaterialFeature = 10
+	SpatialMaterialFeatureRim                 SpatialMaterialFeature = 3
+	SpatialMaterialFeatureSubsuraceScattering SpatialMaterialFeature = 8
+	SpatialMaterialFeatureTransmission        SpatialMaterialFeature = 9
+	SpatialMaterialFeatureTransparent         SpatialMaterialFeature = 0
+)
+
+// String will return the Godot name of the SpatialMaterialFeature value.
+func (e SpatialMaterialFeature) String() string {
+	switch e {
+	case SpatialMaterialFeatureAmbientOcclusion:
+		return "FEATURE_AMBIENT_OCCLUSION"
+	case SpatialMaterialFeatureAnisotropy:
+		return "FEATURE_ANISOTROPY"
+	case SpatialMaterialFeatureClearcoat:
+		return "FEATURE_CLEARCOAT"
+	case SpatialMaterialFeatureDepthMapping:
+		return "FEATURE_DEPTH_MAPPING"
+	case SpatialMaterialFeatureDetail:
+		return "FEATURE_DETAIL"
+	case SpatialMaterialFeatureEmission:
+		return "FEATURE_EMISSION"
+	case SpatialMaterialFeatureMax:
+		return "FEATURE_MAX"
+	case SpatialMaterialFeatureNormalMapping:
+		return "FEATURE_NORMAL_MAPPING"
+	case SpatialMaterialFeatureRefraction:
+		return "FEATURE_REFRACTION"
+	case SpatialMaterialFeatureRim:
+		return "FEATURE_RIM"
+	case SpatialMaterialFeatureSubsuraceScattering:
+		return "FEATURE_SUBSURACE_SCATTERING"
+	case SpatialMaterialFeatureTransmission:
+		return "FEATURE_TRANSMISSION"
+	case SpatialMaterialFeatureTransparent:
+		return "FEATURE_TRANSPARENT"
+	}
+	return fmt.Sprintf("SpatialMaterialFeature(%d)", int(e))
+}
+
+// ParseSpatialMaterialFeature will return the SpatialMaterialFeature value with the given Godot name.
+func ParseSpatialMaterialFeature(name string) (SpatialMaterialFeature, error) {
+	switch name {
+	case "FEATURE_AMBIENT_OCCLUSION":
+		return SpatialMaterialFeatureAmbientOcclusion, nil
+	case "FEATURE_ANISOTROPY":
+		return SpatialMaterialFeatureAnisotropy, nil
+	case "FEATURE_CLEARCOAT":
+		return SpatialMaterialFeatureClearcoat, nil
+	case "FEATURE_DEPTH_MAPPING":
+		return SpatialMaterialFeatureDepthMapping, nil
+	case "FEATURE_DETAIL":
+		return SpatialMaterialFeatureDetail, nil
+	case "FEATURE_EMISSION":
+		return SpatialMaterialFeatureEmission, nil
+	case "FEATURE_MAX":
+		return SpatialMaterialFeatureMax, nil
+	case "FEATURE_NORMAL_MAPPING":
+		return SpatialMaterialFeatureNormalMapping, nil
+	case "FEATURE_REFRACTION":
+		return SpatialMaterialFeatureRefraction, nil
+	case "FEATURE_RIM":
+		return SpatialMaterialFeatureRim, nil
+	case "FEATURE_SUBSURACE_SCATTERING":
+		return SpatialMaterialFeatureSubsuraceScattering, nil
+	case "FEATURE_TRANSMISSION":
+		return SpatialMaterialFeatureTransmission, nil
+	case "FEATURE_TRANSPARENT":
+		return SpatialMaterialFeatureTransparent, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialFeature value %q", name)
+}
+
+// SpatialMaterialFlags is an enum for Flags values.
+type SpatialMaterialFlags int
+
+const (
+	SpatialMaterialFlagAlbedoFromVertexColor  SpatialMaterialFlags = 3
+	SpatialMaterialFlagAlbedoTextureForceSrgb SpatialMaterialFlags = 13
+	SpatialMaterialFlagAoOnUv2                SpatialMaterialFlags = 10
+	SpatialMaterialFlagDisableDepthTest       SpatialMaterialFlags = 2
+	SpatialMaterialFlagEmissionOnUv2          SpatialMaterialFlags = 11
+	SpatialMaterialFlagFixedSize              SpatialMaterialFlags = 6
+	SpatialMaterialFlagMax                    SpatialMaterialFlags = 14
+	SpatialMaterialFlagSrgbVertexColor        SpatialMaterialFlags = 4
+	SpatialMaterialFlagTriplanarUseWorld      SpatialMaterialFlags = 9
+	SpatialMaterialFlagUnshaded               SpatialMaterialFlags = 0
+	SpatialMaterialFlagUseAlphaScissor        SpatialMaterialFlags = 12
+	SpatialMaterialFlagUsePointSize           SpatialMaterialFlags = 5
+	SpatialMaterialFlagUseVertexLighting      SpatialMaterialFlags = 1
+	SpatialMaterialFlagUv1UseTriplanar        SpatialMaterialFlags = 7
+	SpatialMaterialFlagUv2UseTriplanar        SpatialMaterialFlags = 8
+)
+
+// String will return the Godot name of the SpatialMaterialFlags value.
+func (e SpatialMaterialFlags) String() string {
+	switch e {
+	case SpatialMaterialFlagAlbedoFromVertexColor:
+		return "FLAG_ALBEDO_FROM_VERTEX_COLOR"
+	case SpatialMaterialFlagAlbedoTextureForceSrgb:
+		return "FLAG_ALBEDO_TEXTURE_FORCE_SRGB"
+	case SpatialMaterialFlagAoOnUv2:
+		return "FLAG_AO_ON_UV2"
+	case SpatialMaterialFlagDisableDepthTest:
+		return "FLAG_DISABLE_DEPTH_TEST"
+	case SpatialMaterialFlagEmissionOnUv2:
+		return "FLAG_EMISSION_ON_UV2"
+	case SpatialMaterialFlagFixedSize:
+		return "FLAG_FIXED_SIZE"
+	case SpatialMaterialFlagMax:
+		return "FLAG_MAX"
+	case SpatialMaterialFlagSrgbVertexColor:
+		return "FLAG_SRGB_VERTEX_COLOR"
+	case SpatialMaterialFlagTriplanarUseWorld:
+		return "FLAG_TRIPLANAR_USE_WORLD"
+	case SpatialMaterialFlagUnshaded:
+		return "FLAG_UNSHADED"
+	case SpatialMaterialFlagUseAlphaScissor:
+		return "FLAG_USE_ALPHA_SCISSOR"
+	case SpatialMaterialFlagUsePointSize:
+		return "FLAG_USE_POINT_SIZE"
+	case SpatialMaterialFlagUseVertexLighting:
+		return "FLAG_USE_VERTEX_LIGHTING"
+	case SpatialMaterialFlagUv1UseTriplanar:
+		return "FLAG_UV1_USE_TRIPLANAR"
+	case SpatialMaterialFlagUv2UseTriplanar:
+		return "FLAG_UV2_USE_TRIPLANAR"
+	}
+	return fmt.Sprintf("SpatialMaterialFlags(%d)", int(e))
+}
+
+// ParseSpatialMaterialFlags will return the SpatialMaterialFlags value with the given Godot name.
+func ParseSpatialMaterialFlags(name string) (SpatialMaterialFlags, error) {
+	switch name {
+	case "FLAG_ALBEDO_FROM_VERTEX_COLOR":
+		return SpatialMaterialFlagAlbedoFromVertexColor, nil
+	case "FLAG_ALBEDO_TEXTURE_FORCE_SRGB":
+		return SpatialMaterialFlagAlbedoTextureForceSrgb, nil
+	case "FLAG_AO_ON_UV2":
+		return SpatialMaterialFlagAoOnUv2, nil
+	case "FLAG_DISABLE_DEPTH_TEST":
+		return SpatialMaterialFlagDisableDepthTest, nil
+	case "FLAG_EMISSION_ON_UV2":
+		return SpatialMaterialFlagEmissionOnUv2, nil
+	case "FLAG_FIXED_SIZE":
+		return SpatialMaterialFlagFixedSize, nil
+	case "FLAG_MAX":
+		return SpatialMaterialFlagMax, nil
+	case "FLAG_SRGB_VERTEX_COLOR":
+		return SpatialMaterialFlagSrgbVertexColor, nil
+	case "FLAG_TRIPLANAR_USE_WORLD":
+		return SpatialMaterialFlagTriplanarUseWorld, nil
+	case "FLAG_UNSHADED":
+		return SpatialMaterialFlagUnshaded, nil
+	case "FLAG_USE_ALPHA_SCISSOR":
+		return SpatialMaterialFlagUseAlphaScissor, nil
+	case "FLAG_USE_POINT_SIZE":
+		return SpatialMaterialFlagUsePointSize, nil
+	case "FLAG_USE_VERTEX_LIGHTING":
+		return SpatialMaterialFlagUseVertexLighting, nil
+	case "FLAG_UV1_USE_TRIPLANAR":
+		return SpatialMaterialFlagUv1UseTriplanar, nil
+	case "FLAG_UV2_USE_TRIPLANAR":
+		return SpatialMaterialFlagUv2UseTriplanar, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialFlags value %q", name)
+}
+
+// SpatialMaterialSpecularMode is an enum for SpecularMode values.
+type SpatialMaterialSpecularMode int
+
+const (
+	SpatialMaterialSpecularBlinn      SpatialMaterialSpecularMode = 1
+	SpatialMaterialSpecularDisabled   SpatialMaterialSpecularMode = 4
+	SpatialMaterialSpecularPhong      SpatialMaterialSpecularMode = 2
+	SpatialMaterialSpecularSchlickGgx SpatialMaterialSpecularMode = 0
+	SpatialMaterialSpecularToon       SpatialMaterialSpecularMode = 3
+)
+
+// String will return the Godot name of the SpatialMaterialSpecularMode value.
+func (e SpatialMaterialSpecularMode) String() string {
+	switch e {
+	case SpatialMaterialSpecularBlinn:
+		return "SPECULAR_BLINN"
+	case SpatialMaterialSpecularDisabled:
+		return "SPECULAR_DISABLED"
+	case SpatialMaterialSpecularPhong:
+		return "SPECULAR_PHONG"
+	case SpatialMaterialSpecularSchlickGgx:
+		return "SPECULAR_SCHLICK_GGX"
+	case SpatialMaterialSpecularToon:
+		return "SPECULAR_TOON"
+	}
+	return fmt.Sprintf("SpatialMaterialSpecularMode(%d)", int(e))
+}
+
+// ParseSpatialMaterialSpecularMode will return the SpatialMaterialSpecularMode value with the given Godot name.
+func ParseSpatialMaterialSpecularMode(name string) (SpatialMaterialSpecularMode, error) {
+	switch name {
+	case "SPECULAR_BLINN":
+		return SpatialMaterialSpecularBlinn, nil
+	case "SPECULAR_DISABLED":
+		return SpatialMaterialSpecularDisabled, nil
+	case "SPECULAR_PHONG":
+		return SpatialMaterialSpecularPhong, nil
+	case "SPECULAR_SCHLICK_GGX":
+		return SpatialMaterialSpecularSchlickGgx, nil
+	case "SPECULAR_TOON":
+		return SpatialMaterialSpecularToon, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialSpecularMode value %q", name)
+}
+
+// SpatialMaterialTextureChannel is an enum for TextureChannel values.
+type SpatialMaterialTextureChannel int
+
+const (
+	SpatialMaterialTextureChannelAlpha     SpatialMaterialTextureChannel = 3
+	SpatialMaterialTextureChannelBlue      SpatialMaterialTextureChannel = 2
+	SpatialMaterialTextureChannelGrayscale SpatialMaterialTextureChannel = 4
+	SpatialMaterialTextureChannelGreen     SpatialMaterialTextureChannel = 1
+	SpatialMaterialTextureChannelRed       SpatialMaterialTextureChannel = 0
+)
+
+// String will return the Godot name of the SpatialMaterialTextureChannel value.
+func (e SpatialMaterialTextureChannel) String() string {
+	switch e {
+	case SpatialMaterialTextureChannelAlpha:
+		return "TEXTURE_CHANNEL_ALPHA"
+	case SpatialMaterialTextureChannelBlue:
+		return "TEXTURE_CHANNEL_BLUE"
+	case SpatialMaterialTextureChannelGrayscale:
+		return "TEXTURE_CHANNEL_GRAYSCALE"
+	case SpatialMaterialTextureChannelGreen:
+		return "TEXTURE_CHANNEL_GREEN"
+	case SpatialMaterialTextureChannelRed:
+		return "TEXTURE_CHANNEL_RED"
+	}
+	return fmt.Sprintf("SpatialMaterialTextureChannel(%d)", int(e))
+}
+
+// ParseSpatialMaterialTextureChannel will return the SpatialMaterialTextureChannel value with the given Godot name.
+func ParseSpatialMaterialTextureChannel(name string) (SpatialMaterialTextureChannel, error) {
+	switch name {
+	case "TEXTURE_CHANNEL_ALPHA":
+		return SpatialMaterialTextureChannelAlpha, nil
+	case "TEXTURE_CHANNEL_BLUE":
+		return SpatialMaterialTextureChannelBlue, nil
+	case "TEXTURE_CHANNEL_GRAYSCALE":
+		return SpatialMaterialTextureChannelGrayscale, nil
+	case "TEXTURE_CHANNEL_GREEN":
+		return SpatialMaterialTextureChannelGreen, nil
+	case "TEXTURE_CHANNEL_RED":
+		return SpatialMaterialTextureChannelRed, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialTextureChannel value %q", name)
+}
+
+// SpatialMaterialTextureParam is an enum for TextureParam values.
+type SpatialMaterialTextureParam int
+
+const (
+	SpatialMaterialTextureAlbedo               SpatialMaterialTextureParam = 0
+	SpatialMaterialTextureAmbientOcclusion     SpatialMaterialTextureParam = 8
+	SpatialMaterialTextureClearcoat            SpatialMaterialTextureParam = 6
+	SpatialMaterialTextureDepth                SpatialMaterialTextureParam = 9
+	SpatialMaterialTextureDetailAlbedo         SpatialMaterialTextureParam = 14
+	SpatialMaterialTextureDetailMask           SpatialMaterialTextureParam = 13
+	SpatialMaterialTextureDetailNormal         SpatialMaterialTextureParam = 15
+	SpatialMaterialTextureEmission             SpatialMaterialTextureParam = 3
+	SpatialMaterialTextureFlowmap              SpatialMaterialTextureParam = 7
+	SpatialMaterialTextureMax                  SpatialMaterialTextureParam = 16
+	SpatialMaterialTextureMetallic             SpatialMaterialTextureParam = 1
+	SpatialMaterialTextureNormal               SpatialMaterialTextureParam = 4
+	SpatialMaterialTextureRefraction           SpatialMaterialTextureParam = 12
+	SpatialMaterialTextureRim                  SpatialMaterialTextureParam = 5
+	SpatialMaterialTextureRoughness            SpatialMaterialTextureParam = 2
+	SpatialMaterialTextureSubsurfaceScattering SpatialMaterialTextureParam = 10
+	SpatialMaterialTextureTransmission         SpatialMaterialTextureParam = 11
+)
+
+// String will return the Godot name of the SpatialMaterialTextureParam value.
+func (e SpatialMaterialTextureParam) String() string {
+	switch e {
+	case SpatialMaterialTextureAlbedo:
+		return "TEXTURE_ALBEDO"
+	case SpatialMaterialTextureAmbientOcclusion:
+		return "TEXTURE_AMBIENT_OCCLUSION"
+	case SpatialMaterialTextureClearcoat:
+		return "TEXTURE_CLEARCOAT"
+	case SpatialMaterialTextureDepth:
+		return "TEXTURE_DEPTH"
+	case SpatialMaterialTextureDetailAlbedo:
+		return "TEXTURE_DETAIL_ALBEDO"
+	case SpatialMaterialTextureDetailMask:
+		return "TEXTURE_DETAIL_MASK"
+	case SpatialMaterialTextureDetailNormal:
+		return "TEXTURE_DETAIL_NORMAL"
+	case SpatialMaterialTextureEmission:
+		return "TEXTURE_EMISSION"
+	case SpatialMaterialTextureFlowmap:
+		return "TEXTURE_FLOWMAP"
+	case SpatialMaterialTextureMax:
+		return "TEXTURE_MAX"
+	case SpatialMaterialTextureMetallic:
+		return "TEXTURE_METALLIC"
+	case SpatialMaterialTextureNormal:
+		return "TEXTURE_NORMAL"
+	case SpatialMaterialTextureRefraction:
+		return "TEXTURE_REFRACTION"
+	case SpatialMaterialTextureRim:
+		return "TEXTURE_RIM"
+	case SpatialMaterialTextureRoughness:
+		return "TEXTURE_ROUGHNESS"
+	case SpatialMaterialTextureSubsurfaceScattering:
+		return "TEXTURE_SUBSURFACE_SCATTERING"
+	case SpatialMaterialTextureTransmission:
+		return "TEXTURE_TRANSMISSION"
+	}
+	return fmt.Sprintf("SpatialMaterialTextureParam(%d)", int(e))
+}
+
+// ParseSpatialMaterialTextureParam will return the SpatialMaterialTextureParam value with the given Godot name.
+func ParseSpatialMaterialTextureParam(name string) (SpatialMaterialTextureParam, error) {
+	switch name {
+	case "TEXTURE_ALBEDO":
+		return SpatialMaterialTextureAlbedo, nil
+	case "TEXTURE_AMBIENT_OCCLUSION":
+		return SpatialMaterialTextureAmbientOcclusion, nil
+	case "TEXTURE_CLEARCOAT":
+		return SpatialMaterialTextureClearcoat, nil
+	case "TEXTURE_DEPTH":
+		return SpatialMaterialTextureDepth, nil
+	case "TEXTURE_DETAIL_ALBEDO":
+		return SpatialMaterialTextureDetailAlbedo, nil
+	case "TEXTURE_DETAIL_MASK":
+		return SpatialMaterialTextureDetailMask, nil
+	case "TEXTURE_DETAIL_NORMAL":
+		return SpatialMaterialTextureDetailNormal, nil
+	case "TEXTURE_EMISSION":
+		return SpatialMaterialTextureEmission, nil
+	case "TEXTURE_FLOWMAP":
+		return SpatialMaterialTextureFlowmap, nil
+	case "TEXTURE_MAX":
+		return SpatialMaterialTextureMax, nil
+	case "TEXTURE_METALLIC":
+		return SpatialMaterialTextureMetallic, nil
+	case "TEXTURE_NORMAL":
+		return SpatialMaterialTextureNormal, nil
+	case "TEXTURE_REFRACTION":
+		return SpatialMaterialTextureRefraction, nil
+	case "TEXTURE_RIM":
+		return SpatialMaterialTextureRim, nil
+	case "TEXTURE_ROUGHNESS":
+		return SpatialMaterialTextureRoughness, nil
+	case "TEXTURE_SUBSURFACE_SCATTERING":
+		return SpatialMaterialTextureSubsurfaceScattering, nil
+	case "TEXTURE_TRANSMISSION":
+		return SpatialMaterialTextureTransmission, nil
+	}
+	return 0, fmt.Errorf("invalid SpatialMaterialTextureParam value %q", name)
+}
+
+/*
+ */
+type SpatialMaterial struct {
+	godot.Material
+	owner gdnative.Object
+}
+
+// classConstructorSpatialMaterial is the class constructor of the SpatialMaterial class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSpatialMaterial = gdnative.NewLazyClassConstructor("SpatialMaterial")
+
+// NewSpatialMaterial will create a new instance of the SpatialMaterial class.
+// SpatialMaterial is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSpatialMaterial() *SpatialMaterial {
+	obj := &SpatialMaterial{}
+	obj.SetBaseObject(classConstructorSpatialMaterial.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *SpatialMaterial) BaseClass() string {
+	return "SpatialMaterial"
+}
+
+// Method binds of the SpatialMaterial class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSpatialMaterialGetAlbedo                       = gdnative.NewLazyMethodBind("SpatialMaterial", "get_albedo")
+	methodBindSpatialMaterialGetAlphaScissorThreshold        = gdnative.NewLazyMethodBind("SpatialMaterial", "get_alpha_scissor_threshold")
+	methodBindSpatialMaterialGetAnisotropy                   = gdnative.NewLazyMethodBind("SpatialMaterial", "get_anisotropy")
+	methodBindSpatialMaterialGetAoLightAffect                = gdnative.NewLazyMethodBind("SpatialMaterial", "get_ao_light_affect")
+	methodBindSpatialMaterialGetAoTextureChannel             = gdnative.NewLazyMethodBind("SpatialMaterial", "get_ao_texture_channel")
+	methodBindSpatialMaterialGetBillboardMode                = gdnative.NewLazyMethodBind("SpatialMaterial", "get_billboard_mode")
+	methodBindSpatialMaterialGetBlendMode                    = gdnative.NewLazyMethodBind("SpatialMaterial", "get_blend_mode")
+	methodBindSpatialMaterialGetClearcoat                    = gdnative.NewLazyMethodBind("SpatialMaterial", "get_clearcoat")
+	methodBindSpatialMaterialGetClearcoatGloss               = gdnative.NewLazyMethodBind("SpatialMaterial", "get_clearcoat_gloss")
+	methodBindSpatialMaterialGetCullMode                     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_cull_mode")
+	methodBindSpatialMaterialGetDepthDeepParallaxMaxLayers   = gdnative.NewLazyMethodBind("SpatialMaterial", "get_depth_deep_parallax_max_layers")
+	methodBindSpatialMaterialGetDepthDeepParallaxMinLayers   = gdnative.NewLazyMethodBind("SpatialMaterial", "get_depth_deep_parallax_min_layers")
+	methodBindSpatialMaterialGetDepthDrawMode                = gdnative.NewLazyMethodBind("SpatialMaterial", "get_depth_draw_mode")
+	methodBindSpatialMaterialGetDepthScale                   = gdnative.NewLazyMethodBind("SpatialMaterial", "get_depth_scale")
+	methodBindSpatialMaterialGetDetailBlendMode              = gdnative.NewLazyMethodBind("SpatialMaterial", "get_detail_blend_mode")
+	methodBindSpatialMaterialGetDetailUv                     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_detail_uv")
+	methodBindSpatialMaterialGetDiffuseMode                  = gdnative.NewLazyMethodBind("SpatialMaterial", "get_diffuse_mode")
+	methodBindSpatialMaterialGetDistanceFadeMaxDistance      = gdnative.NewLazyMethodBind("SpatialMaterial", "get_distance_fade_max_distance")
+	methodBindSpatialMaterialGetDistanceFadeMinDistance      = gdnative.NewLazyMethodBind("SpatialMaterial", "get_distance_fade_min_distance")
+	methodBindSpatialMaterialGetEmission                     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_emission")
+	methodBindSpatialMaterialGetEmissionEnergy               = gdnative.NewLazyMethodBind("SpatialMaterial", "get_emission_energy")
+	methodBindSpatialMaterialGetEmissionOperator             = gdnative.NewLazyMethodBind("SpatialMaterial", "get_emission_operator")
+	methodBindSpatialMaterialGetFeature                      = gdnative.NewLazyMethodBind("SpatialMaterial", "get_feature")
+	methodBindSpatialMaterialGetFlag                         = gdnative.NewLazyMethodBind("SpatialMaterial", "get_flag")
+	methodBindSpatialMaterialGetGrow                         = gdnative.NewLazyMethodBind("SpatialMaterial", "get_grow")
+	methodBindSpatialMaterialGetLineWidth                    = gdnative.NewLazyMethodBind("SpatialMaterial", "get_line_width")
+	methodBindSpatialMaterialGetMetallic                     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_metallic")
+	methodBindSpatialMaterialGetMetallicTextureChannel       = gdnative.NewLazyMethodBind("SpatialMaterial", "get_metallic_texture_channel")
+	methodBindSpatialMaterialGetNormalScale                  = gdnative.NewLazyMethodBind("SpatialMaterial", "get_normal_scale")
+	methodBindSpatialMaterialGetParticlesAnimHFrames         = gdnative.NewLazyMethodBind("SpatialMaterial", "get_particles_anim_h_frames")
+	methodBindSpatialMaterialGetParticlesAnimLoop            = gdnative.NewLazyMethodBind("SpatialMaterial", "get_particles_anim_loop")
+	methodBindSpatialMaterialGetParticlesAnimVFrames         = gdnative.NewLazyMethodBind("SpatialMaterial", "get_particles_anim_v_frames")
+	methodBindSpatialMaterialGetPointSize                    = gdnative.NewLazyMethodBind("SpatialMaterial", "get_point_size")
+	methodBindSpatialMaterialGetProximityFadeDistance        = gdnative.NewLazyMethodBind("SpatialMaterial", "get_proximity_fade_distance")
+	methodBindSpatialMaterialGetRefraction                   = gdnative.NewLazyMethodBind("SpatialMaterial", "get_refraction")
+	methodBindSpatialMaterialGetRefractionTextureChannel     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_refraction_texture_channel")
+	methodBindSpatialMaterialGetRim                          = gdnative.NewLazyMethodBind("SpatialMaterial", "get_rim")
+	methodBindSpatialMaterialGetRimTint                      = gdnative.NewLazyMethodBind("SpatialMaterial", "get_rim_tint")
+	methodBindSpatialMaterialGetRoughness                    = gdnative.NewLazyMethodBind("SpatialMaterial", "get_roughness")
+	methodBindSpatialMaterialGetRoughnessTextureChannel      = gdnative.NewLazyMethodBind("SpatialMaterial", "get_roughness_texture_channel")
+	methodBindSpatialMaterialGetSpecular                     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_specular")
+	methodBindSpatialMaterialGetSpecularMode                 = gdnative.NewLazyMethodBind("SpatialMaterial", "get_specular_mode")
+	methodBindSpatialMaterialGetSubsurfaceScatteringStrength = gdnative.NewLazyMethodBind("SpatialMaterial", "get_subsurface_scattering_strength")
+	methodBindSpatialMaterialGetTexture                      = gdnative.NewLazyMethodBind("SpatialMaterial", "get_texture")
+	methodBindSpatialMaterialGetTransmission                 = gdnative.NewLazyMethodBind("SpatialMaterial", "get_transmission")
+	methodBindSpatialMaterialGetUv1Offset                    = gdnative.NewLazyMethodBind("SpatialMaterial", "get_uv1_offset")
+	methodBindSpatialMaterialGetUv1Scale                     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_uv1_scale")
+	methodBindSpatialMaterialGetUv1TriplanarBlendSharpness   = gdnative.NewLazyMethodBind("SpatialMaterial", "get_uv1_triplanar_blend_sharpness")
+	methodBindSpatialMaterialGetUv2Offset                    = gdnative.NewLazyMethodBind("SpatialMaterial", "get_uv2_offset")
+	methodBindSpatialMaterialGetUv2Scale                     = gdnative.NewLazyMethodBind("SpatialMaterial", "get_uv2_scale")
+	methodBindSpatialMaterialGetUv2TriplanarBlendSharpness   = gdnative.NewLazyMethodBind("SpatialMaterial", "get_uv2_triplanar_blend_sharpness")
+	methodBindSpatialMaterialIsDepthDeepParallaxEnabled      = gdnative.NewLazyMethodBind("SpatialMaterial", "is_depth_deep_parallax_enabled")
+	methodBindSpatialMaterialIsDistanceFadeEnabled           = gdnative.NewLazyMethodBind("SpatialMaterial", "is_distance_fade_enabled")
+	methodBindSpatialMaterialIsGrowEnabled                   = gdnative.NewLazyMethodBind("SpatialMaterial", "is_grow_enabled")
+	methodBindSpatialMaterialIsProximityFadeEnabled          = gdnative.NewLazyMethodBind("SpatialMaterial", "is_proximity_fade_enabled")
+	methodBindSpatialMaterialSetAlbedo                       = gdnative.NewLazyMethodBind("SpatialMaterial", "set_albedo")
+	methodBindSpatialMaterialSetAlphaScissorThreshold        = gdnative.NewLazyMethodBind("SpatialMaterial", "set_alpha_scissor_threshold")
+	methodBindSpatialMaterialSetAnisotropy                   = gdnative.NewLazyMethodBind("SpatialMaterial", "set_anisotropy")
+	methodBindSpatialMaterialSetAoLightAffect                = gdnative.NewLazyMethodBind("SpatialMaterial", "set_ao_light_affect")
+	methodBindSpatialMaterialSetAoTextureChannel             = gdnative.NewLazyMethodBind("SpatialMaterial", "set_ao_texture_channel")
+	methodBindSpatialMaterialSetBillboardMode                = gdnative.NewLazyMethodBind("SpatialMaterial", "set_billboard_mode")
+	methodBindSpatialMaterialSetBlendMode                    = gdnative.NewLazyMethodBind("SpatialMaterial", "set_blend_mode")
+	methodBindSpatialMaterialSetClearcoat                    = gdnative.NewLazyMethodBind("SpatialMaterial", "set_clearcoat")
+	methodBindSpatialMaterialSetClearcoatGloss               = gdnative.NewLazyMethodBind("SpatialMaterial", "set_clearcoat_gloss")
+	methodBindSpatialMaterialSetCullMode                     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_cull_mode")
+	methodBindSpatialMaterialSetDepthDeepParallax            = gdnative.NewLazyMethodBind("SpatialMaterial", "set_depth_deep_parallax")
+	methodBindSpatialMaterialSetDepthDeepParallaxMaxLayers   = gdnative.NewLazyMethodBind("SpatialMaterial", "set_depth_deep_parallax_max_layers")
+	methodBindSpatialMaterialSetDepthDeepParallaxMinLayers   = gdnative.NewLazyMethodBind("SpatialMaterial", "set_depth_deep_parallax_min_layers")
+	methodBindSpatialMaterialSetDepthDrawMode                = gdnative.NewLazyMethodBind("SpatialMaterial", "set_depth_draw_mode")
+	methodBindSpatialMaterialSetDepthScale                   = gdnative.NewLazyMethodBind("SpatialMaterial", "set_depth_scale")
+	methodBindSpatialMaterialSetDetailBlendMode              = gdnative.NewLazyMethodBind("SpatialMaterial", "set_detail_blend_mode")
+	methodBindSpatialMaterialSetDetailUv                     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_detail_uv")
+	methodBindSpatialMaterialSetDiffuseMode                  = gdnative.NewLazyMethodBind("SpatialMaterial", "set_diffuse_mode")
+	methodBindSpatialMaterialSetDistanceFade                 = gdnative.NewLazyMethodBind("SpatialMaterial", "set_distance_fade")
+	methodBindSpatialMaterialSetDistanceFadeMaxDistance      = gdnative.NewLazyMethodBind("SpatialMaterial", "set_distance_fade_max_distance")
+	methodBindSpatialMaterialSetDistanceFadeMinDistance      = gdnative.NewLazyMethodBind("SpatialMaterial", "set_distance_fade_min_distance")
+	methodBindSpatialMaterialSetEmission                     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_emission")
+	methodBindSpatialMaterialSetEmissionEnergy               = gdnative.NewLazyMethodBind("SpatialMaterial", "set_emission_energy")
+	methodBindSpatialMaterialSetEmissionOperator             = gdnative.NewLazyMethodBind("SpatialMaterial", "set_emission_operator")
+	methodBindSpatialMaterialSetFeature                      = gdnative.NewLazyMethodBind("SpatialMaterial", "set_feature")
+	methodBindSpatialMaterialSetFlag                         = gdnative.NewLazyMethodBind("SpatialMaterial", "set_flag")
+	methodBindSpatialMaterialSetGrow                         = gdnative.NewLazyMethodBind("SpatialMaterial", "set_grow")
+	methodBindSpatialMaterialSetGrowEnabled                  = gdnative.NewLazyMethodBind("SpatialMaterial", "set_grow_enabled")
+	methodBindSpatialMaterialSetLineWidth                    = gdnative.NewLazyMethodBind("SpatialMaterial", "set_line_width")
+	methodBindSpatialMaterialSetMetallic                     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_metallic")
+	methodBindSpatialMaterialSetMetallicTextureChannel       = gdnative.NewLazyMethodBind("SpatialMaterial", "set_metallic_texture_channel")
+	methodBindSpatialMaterialSetNormalScale                  = gdnative.NewLazyMethodBind("SpatialMaterial", "set_normal_scale")
+	methodBindSpatialMaterialSetParticlesAnimHFrames         = gdnative.NewLazyMethodBind("SpatialMaterial", "set_particles_anim_h_frames")
+	methodBindSpatialMaterialSetParticlesAnimLoop            = gdnative.NewLazyMethodBind("SpatialMaterial", "set_particles_anim_loop")
+	methodBindSpatialMaterialSetParticlesAnimVFrames         = gdnative.NewLazyMethodBind("SpatialMaterial", "set_particles_anim_v_frames")
+	methodBindSpatialMaterialSetPointSize                    = gdnative.NewLazyMethodBind("SpatialMaterial", "set_point_size")
+	methodBindSpatialMaterialSetProximityFade                = gdnative.NewLazyMethodBind("SpatialMaterial", "set_proximity_fade")
+	methodBindSpatialMaterialSetProximityFadeDistance        = gdnative.NewLazyMethodBind("SpatialMaterial", "set_proximity_fade_distance")
+	methodBindSpatialMaterialSetRefraction                   = gdnative.NewLazyMethodBind("SpatialMaterial", "set_refraction")
+	methodBindSpatialMaterialSetRefractionTextureChannel     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_refraction_texture_channel")
+	methodBindSpatialMaterialSetRim                          = gdnative.NewLazyMethodBind("SpatialMaterial", "set_rim")
+	methodBindSpatialMaterialSetRimTint                      = gdnative.NewLazyMethodBind("SpatialMaterial", "set_rim_tint")
+	methodBindSpatialMaterialSetRoughness                    = gdnative.NewLazyMethodBind("SpatialMaterial", "set_roughness")
+	methodBindSpatialMaterialSetRoughnessTextureChannel      = gdnative.NewLazyMethodBind("SpatialMaterial", "set_roughness_texture_channel")
+	methodBindSpatialMaterialSetSpecular                     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_specular")
+	methodBindSpatialMaterialSetSpecularMode                 = gdnative.NewLazyMethodBind("SpatialMaterial", "set_specular_mode")
+	methodBindSpatialMaterialSetSubsurfaceScatteringStrength = gdnative.NewLazyMethodBind("SpatialMaterial", "set_subsurface_scattering_strength")
+	methodBindSpatialMaterialSetTexture                      = gdnative.NewLazyMethodBind("SpatialMaterial", "set_texture")
+	methodBindSpatialMaterialSetTransmission                 = gdnative.NewLazyMethodBind("SpatialMaterial", "set_transmission")
+	methodBindSpatialMaterialSetUv1Offset                    = gdnative.NewLazyMethodBind("SpatialMaterial", "set_uv1_offset")
+	methodBindSpatialMaterialSetUv1Scale                     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_uv1_scale")
+	methodBindSpatialMaterialSetUv1TriplanarBlendSharpness   = gdnative.NewLazyMethodBind("SpatialMaterial", "set_uv1_triplanar_blend_sharpness")
+	methodBindSpatialMaterialSetUv2Offset                    = gdnative.NewLazyMethodBind("SpatialMaterial", "set_uv2_offset")
+	methodBindSpatialMaterialSetUv2Scale                     = gdnative.NewLazyMethodBind("SpatialMaterial", "set_uv2_scale")
+	methodBindSpatialMaterialSetUv2TriplanarBlendSharpness   = gdnative.NewLazyMethodBind("SpatialMaterial", "set_uv2_triplanar_blend_sharpness")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *SpatialMaterial) GetAlbedo() gdnative.Color {
+	//log.Println("Calling SpatialMaterial.GetAlbedo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetAlbedo.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetAlphaScissorThreshold() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetAlphaScissorThreshold()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetAlphaScissorThreshold.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetAnisotropy() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetAnisotropy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetAnisotropy.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetAoLightAffect() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetAoLightAffect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetAoLightAffect.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::TextureChannel
+*/
+func (o *SpatialMaterial) GetAoTextureChannel() SpatialMaterialTextureChannel {
+	//log.Println("Calling SpatialMaterial.GetAoTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetAoTextureChannel.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::TextureChannel
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialTextureChannel(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::BillboardMode
+*/
+func (o *SpatialMaterial) GetBillboardMode() SpatialMaterialBillboardMode {
+	//log.Println("Calling SpatialMaterial.GetBillboardMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetBillboardMode.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::BillboardMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialBillboardMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::BlendMode
+*/
+func (o *SpatialMaterial) GetBlendMode() SpatialMaterialBlendMode {
+	//log.Println("Calling SpatialMaterial.GetBlendMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetBlendMode.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::BlendMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialBlendMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetClearcoat() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetClearcoat()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetClearcoat.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetClearcoatGloss() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetClearcoatGloss()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetClearcoatGloss.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::CullMode
+*/
+func (o *SpatialMaterial) GetCullMode() SpatialMaterialCullMode {
+	//log.Println("Calling SpatialMaterial.GetCullMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetCullMode.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::CullMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialCullMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *SpatialMaterial) GetDepthDeepParallaxMaxLayers() gdnative.Int {
+	//log.Println("Calling SpatialMaterial.GetDepthDeepParallaxMaxLayers()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDepthDeepParallaxMaxLayers.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *SpatialMaterial) GetDepthDeepParallaxMinLayers() gdnative.Int {
+	//log.Println("Calling SpatialMaterial.GetDepthDeepParallaxMinLayers()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDepthDeepParallaxMinLayers.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::DepthDrawMode
+*/
+func (o *SpatialMaterial) GetDepthDrawMode() SpatialMaterialDepthDrawMode {
+	//log.Println("Calling SpatialMaterial.GetDepthDrawMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDepthDrawMode.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::DepthDrawMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialDepthDrawMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetDepthScale() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetDepthScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDepthScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::BlendMode
+*/
+func (o *SpatialMaterial) GetDetailBlendMode() SpatialMaterialBlendMode {
+	//log.Println("Calling SpatialMaterial.GetDetailBlendMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDetailBlendMode.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::BlendMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialBlendMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::DetailUV
+*/
+func (o *SpatialMaterial) GetDetailUv() SpatialMaterialDetailUV {
+	//log.Println("Calling SpatialMaterial.GetDetailUv()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDetailUv.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::DetailUV
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialDetailUV(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::DiffuseMode
+*/
+func (o *SpatialMaterial) GetDiffuseMode() SpatialMaterialDiffuseMode {
+	//log.Println("Calling SpatialMaterial.GetDiffuseMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDiffuseMode.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::DiffuseMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialDiffuseMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetDistanceFadeMaxDistance() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetDistanceFadeMaxDistance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDistanceFadeMaxDistance.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetDistanceFadeMinDistance() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetDistanceFadeMinDistance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetDistanceFadeMinDistance.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *SpatialMaterial) GetEmission() gdnative.Color {
+	//log.Println("Calling SpatialMaterial.GetEmission()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetEmission.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetEmissionEnergy() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetEmissionEnergy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetEmissionEnergy.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::EmissionOperator
+*/
+func (o *SpatialMaterial) GetEmissionOperator() SpatialMaterialEmissionOperator {
+	//log.Println("Calling SpatialMaterial.GetEmissionOperator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetEmissionOperator.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::EmissionOperator
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialEmissionOperator(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false feature enum.SpatialMaterial::Feature}], Returns: bool
+*/
+func (o *SpatialMaterial) GetFeature(feature SpatialMaterialFeature) gdnative.Bool {
+	//log.Println("Calling SpatialMaterial.GetFeature()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(feature))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetFeature.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag int}], Returns: bool
+*/
+func (o *SpatialMaterial) GetFlag(flag gdnative.Int) gdnative.Bool {
+	//log.Println("Calling SpatialMaterial.GetFlag()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(flag)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetFlag.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetGrow() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetGrow()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetGrow.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetLineWidth() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetLineWidth()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetLineWidth.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetMetallic() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetMetallic()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetMetallic.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::TextureChannel
+*/
+func (o *SpatialMaterial) GetMetallicTextureChannel() SpatialMaterialTextureChannel {
+	//log.Println("Calling SpatialMaterial.GetMetallicTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetMetallicTextureChannel.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::TextureChannel
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialTextureChannel(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetNormalScale() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetNormalScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetNormalScale.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *SpatialMaterial) GetParticlesAnimHFrames() gdnative.Int {
+	//log.Println("Calling SpatialMaterial.GetParticlesAnimHFrames()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetParticlesAnimHFrames.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *SpatialMaterial) GetParticlesAnimLoop() gdnative.Int {
+	//log.Println("Calling SpatialMaterial.GetParticlesAnimLoop()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetParticlesAnimLoop.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *SpatialMaterial) GetParticlesAnimVFrames() gdnative.Int {
+	//log.Println("Calling SpatialMaterial.GetParticlesAnimVFrames()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetParticlesAnimVFrames.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetPointSize() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetPointSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetPointSize.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetProximityFadeDistance() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetProximityFadeDistance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetProximityFadeDistance.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetRefraction() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetRefraction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetRefraction.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::TextureChannel
+*/
+func (o *SpatialMaterial) GetRefractionTextureChannel() SpatialMaterialTextureChannel {
+	//log.Println("Calling SpatialMaterial.GetRefractionTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetRefractionTextureChannel.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::TextureChannel
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialTextureChannel(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetRim() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetRim()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetRim.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetRimTint() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetRimTint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetRimTint.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetRoughness() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetRoughness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetRoughness.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::TextureChannel
+*/
+func (o *SpatialMaterial) GetRoughnessTextureChannel() SpatialMaterialTextureChannel {
+	//log.Println("Calling SpatialMaterial.GetRoughnessTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetRoughnessTextureChannel.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::TextureChannel
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialTextureChannel(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetSpecular() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetSpecular()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetSpecular.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.SpatialMaterial::SpecularMode
+*/
+func (o *SpatialMaterial) GetSpecularMode() SpatialMaterialSpecularMode {
+	//log.Println("Calling SpatialMaterial.GetSpecularMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetSpecularMode.Get()
+
+	// Call the parent method.
+	// enum.SpatialMaterial::SpecularMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return SpatialMaterialSpecularMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetSubsurfaceScatteringStrength() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetSubsurfaceScatteringStrength()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetSubsurfaceScatteringStrength.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int}], Returns: Texture
+*/
+func (o *SpatialMaterial) GetTexture(param gdnative.Int) godot.TextureImplementer {
+	//log.Println("Calling SpatialMaterial.GetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *SpatialMaterial) GetTransmission() gdnative.Color {
+	//log.Println("Calling SpatialMaterial.GetTransmission()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetTransmission.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *SpatialMaterial) GetUv1Offset() gdnative.Vector3 {
+	//log.Println("Calling SpatialMaterial.GetUv1Offset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetUv1Offset.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *SpatialMaterial) GetUv1Scale() gdnative.Vector3 {
+	//log.Println("Calling SpatialMaterial.GetUv1Scale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetUv1Scale.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetUv1TriplanarBlendSharpness() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetUv1TriplanarBlendSharpness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetUv1TriplanarBlendSharpness.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *SpatialMaterial) GetUv2Offset() gdnative.Vector3 {
+	//log.Println("Calling SpatialMaterial.GetUv2Offset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetUv2Offset.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector3
+*/
+func (o *SpatialMaterial) GetUv2Scale() gdnative.Vector3 {
+	//log.Println("Calling SpatialMaterial.GetUv2Scale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetUv2Scale.Get()
+
+	// Call the parent method.
+	// Vector3
+	retPtr := gdnative.NewEmptyVector3()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector3FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *SpatialMaterial) GetUv2TriplanarBlendSharpness() gdnative.Real {
+	//log.Println("Calling SpatialMaterial.GetUv2TriplanarBlendSharpness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialGetUv2TriplanarBlendSharpness.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *SpatialMaterial) IsDepthDeepParallaxEnabled() gdnative.Bool {
+	//log.Println("Calling SpatialMaterial.IsDepthDeepParallaxEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialIsDepthDeepParallaxEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *SpatialMaterial) IsDistanceFadeEnabled() gdnative.Bool {
+	//log.Println("Calling SpatialMaterial.IsDistanceFadeEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialIsDistanceFadeEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *SpatialMaterial) IsGrowEnabled() gdnative.Bool {
+	//log.Println("Calling SpatialMaterial.IsGrowEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialIsGrowEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *SpatialMaterial) IsProximityFadeEnabled() gdnative.Bool {
+	//log.Println("Calling SpatialMaterial.IsProximityFadeEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialIsProximityFadeEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false albedo Color}], Returns: void
+*/
+func (o *SpatialMaterial) SetAlbedo(albedo gdnative.Color) {
+	//log.Println("Calling SpatialMaterial.SetAlbedo()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(albedo)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetAlbedo.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false threshold float}], Returns: void
+*/
+func (o *SpatialMaterial) SetAlphaScissorThreshold(threshold gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetAlphaScissorThreshold()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(threshold)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetAlphaScissorThreshold.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false anisotropy float}], Returns: void
+*/
+func (o *SpatialMaterial) SetAnisotropy(anisotropy gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetAnisotropy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(anisotropy)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetAnisotropy.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
+*/
+func (o *SpatialMaterial) SetAoLightAffect(amount gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetAoLightAffect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetAoLightAffect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false channel enum.SpatialMaterial::TextureChannel}], Returns: void
+*/
+func (o *SpatialMaterial) SetAoTextureChannel(channel SpatialMaterialTextureChannel) {
+	//log.Println("Calling SpatialMaterial.SetAoTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(channel))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetAoTextureChannel.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.SpatialMaterial::BillboardMode}], Returns: void
+*/
+func (o *SpatialMaterial) SetBillboardMode(mode SpatialMaterialBillboardMode) {
+	//log.Println("Calling SpatialMaterial.SetBillboardMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetBillboardMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false blend_mode enum.SpatialMaterial::BlendMode}], Returns: void
+*/
+func (o *SpatialMaterial) SetBlendMode(blendMode SpatialMaterialBlendMode) {
+	//log.Println("Calling SpatialMaterial.SetBlendMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(blendMode))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetBlendMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false clearcoat float}], Returns: void
+*/
+func (o *SpatialMaterial) SetClearcoat(clearcoat gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetClearcoat()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(clearcoat)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetClearcoat.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false clearcoat_gloss float}], Returns: void
+*/
+func (o *SpatialMaterial) SetClearcoatGloss(clearcoatGloss gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetClearcoatGloss()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(clearcoatGloss)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetClearcoatGloss.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false cull_mode enum.SpatialMaterial::CullMode}], Returns: void
+*/
+func (o *SpatialMaterial) SetCullMode(cullMode SpatialMaterialCullMode) {
+	//log.Println("Calling SpatialMaterial.SetCullMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(cullMode))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetCullMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *SpatialMaterial) SetDepthDeepParallax(enable gdnative.Bool) {
+	//log.Println("Calling SpatialMaterial.SetDepthDeepParallax()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDepthDeepParallax.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
+*/
+func (o *SpatialMaterial) SetDepthDeepParallaxMaxLayers(layer gdnative.Int) {
+	//log.Println("Calling SpatialMaterial.SetDepthDeepParallaxMaxLayers()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDepthDeepParallaxMaxLayers.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false layer int}], Returns: void
+*/
+func (o *SpatialMaterial) SetDepthDeepParallaxMinLayers(layer gdnative.Int) {
+	//log.Println("Calling SpatialMaterial.SetDepthDeepParallaxMinLayers()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(layer)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDepthDeepParallaxMinLayers.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false depth_draw_mode enum.SpatialMaterial::DepthDrawMode}], Returns: void
+*/
+func (o *SpatialMaterial) SetDepthDrawMode(depthDrawMode SpatialMaterialDepthDrawMode) {
+	//log.Println("Calling SpatialMaterial.SetDepthDrawMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(depthDrawMode))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDepthDrawMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false depth_scale float}], Returns: void
+*/
+func (o *SpatialMaterial) SetDepthScale(depthScale gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetDepthScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(depthScale)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDepthScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false detail_blend_mode enum.SpatialMaterial::BlendMode}], Returns: void
+*/
+func (o *SpatialMaterial) SetDetailBlendMode(detailBlendMode SpatialMaterialBlendMode) {
+	//log.Println("Calling SpatialMaterial.SetDetailBlendMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(detailBlendMode))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDetailBlendMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false detail_uv enum.SpatialMaterial::DetailUV}], Returns: void
+*/
+func (o *SpatialMaterial) SetDetailUv(detailUv SpatialMaterialDetailUV) {
+	//log.Println("Calling SpatialMaterial.SetDetailUv()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(detailUv))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDetailUv.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false diffuse_mode enum.SpatialMaterial::DiffuseMode}], Returns: void
+*/
+func (o *SpatialMaterial) SetDiffuseMode(diffuseMode SpatialMaterialDiffuseMode) {
+	//log.Println("Calling SpatialMaterial.SetDiffuseMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(diffuseMode))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDiffuseMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *SpatialMaterial) SetDistanceFade(enabled gdnative.Bool) {
+	//log.Println("Calling SpatialMaterial.SetDistanceFade()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDistanceFade.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false distance float}], Returns: void
+*/
+func (o *SpatialMaterial) SetDistanceFadeMaxDistance(distance gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetDistanceFadeMaxDistance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(distance)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDistanceFadeMaxDistance.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false distance float}], Returns: void
+*/
+func (o *SpatialMaterial) SetDistanceFadeMinDistance(distance gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetDistanceFadeMinDistance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(distance)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetDistanceFadeMinDistance.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false emission Color}], Returns: void
+*/
+func (o *SpatialMaterial) SetEmission(emission gdnative.Color) {
+	//log.Println("Calling SpatialMaterial.SetEmission()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(emission)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetEmission.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false emission_energy float}], Returns: void
+*/
+func (o *SpatialMaterial) SetEmissionEnergy(emissionEnergy gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetEmissionEnergy()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(emissionEnergy)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetEmissionEnergy.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false operator enum.SpatialMaterial::EmissionOperator}], Returns: void
+*/
+func (o *SpatialMaterial) SetEmissionOperator(operator SpatialMaterialEmissionOperator) {
+	//log.Println("Calling SpatialMaterial.SetEmissionOperator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(operator))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetEmissionOperator.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false feature enum.SpatialMaterial::Feature} { false enable bool}], Returns: void
+*/
+func (o *SpatialMaterial) SetFeature(feature SpatialMaterialFeature, enable gdnative.Bool) {
+	//log.Println("Calling SpatialMaterial.SetFeature()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(feature))
+	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetFeature.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false flag int} { false enable bool}], Returns: void
+*/
+func (o *SpatialMaterial) SetFlag(flag gdnative.Int, enable gdnative.Bool) {
+	//log.Println("Calling SpatialMaterial.SetFlag()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(flag)
+	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetFlag.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount float}], Returns: void
+*/
+func (o *SpatialMaterial) SetGrow(amount gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetGrow()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(amount)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetGrow.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *SpatialMaterial) SetGrowEnabled(enable gdnative.Bool) {
+	//log.Println("Calling SpatialMaterial.SetGrowEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetGrowEnabled.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false line_width float}], Returns: void
+*/
+func (o *SpatialMaterial) SetLineWidth(lineWidth gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetLineWidth()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(lineWidth)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetLineWidth.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false metallic float}], Returns: void
+*/
+func (o *SpatialMaterial) SetMetallic(metallic gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetMetallic()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(metallic)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetMetallic.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false channel enum.SpatialMaterial::TextureChannel}], Returns: void
+*/
+func (o *SpatialMaterial) SetMetallicTextureChannel(channel SpatialMaterialTextureChannel) {
+	//log.Println("Calling SpatialMaterial.SetMetallicTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(channel))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetMetallicTextureChannel.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false normal_scale float}], Returns: void
+*/
+func (o *SpatialMaterial) SetNormalScale(normalScale gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetNormalScale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(normalScale)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetNormalScale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false frames int}], Returns: void
+*/
+func (o *SpatialMaterial) SetParticlesAnimHFrames(frames gdnative.Int) {
+	//log.Println("Calling SpatialMaterial.SetParticlesAnimHFrames()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(frames)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetParticlesAnimHFrames.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false frames int}], Returns: void
+*/
+func (o *SpatialMaterial) SetParticlesAnimLoop(frames gdnative.Int) {
+	//log.Println("Calling SpatialMaterial.SetParticlesAnimLoop()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(frames)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetParticlesAnimLoop.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false frames int}], Returns: void
+*/
+func (o *SpatialMaterial) SetParticlesAnimVFrames(frames gdnative.Int) {
+	//log.Println("Calling SpatialMaterial.SetParticlesAnimVFrames()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(frames)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetParticlesAnimVFrames.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false point_size float}], Returns: void
+*/
+func (o *SpatialMaterial) SetPointSize(pointSize gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetPointSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(pointSize)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetPointSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
+*/
+func (o *SpatialMaterial) SetProximityFade(enabled gdnative.Bool) {
+	//log.Println("Calling SpatialMaterial.SetProximityFade()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetProximityFade.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false distance float}], Returns: void
+*/
+func (o *SpatialMaterial) SetProximityFadeDistance(distance gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetProximityFadeDistance()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(distance)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetProximityFadeDistance.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false refraction float}], Returns: void
+*/
+func (o *SpatialMaterial) SetRefraction(refraction gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetRefraction()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(refraction)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetRefraction.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false channel enum.SpatialMaterial::TextureChannel}], Returns: void
+*/
+func (o *SpatialMaterial) SetRefractionTextureChannel(channel SpatialMaterialTextureChannel) {
+	//log.Println("Calling SpatialMaterial.SetRefractionTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(channel))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetRefractionTextureChannel.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false rim float}], Returns: void
+*/
+func (o *SpatialMaterial) SetRim(rim gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetRim()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(rim)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetRim.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false rim_tint float}], Returns: void
+*/
+func (o *SpatialMaterial) SetRimTint(rimTint gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetRimTint()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(rimTint)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetRimTint.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false roughness float}], Returns: void
+*/
+func (o *SpatialMaterial) SetRoughness(roughness gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetRoughness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(roughness)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetRoughness.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false channel enum.SpatialMaterial::TextureChannel}], Returns: void
+*/
+func (o *SpatialMaterial) SetRoughnessTextureChannel(channel SpatialMaterialTextureChannel) {
+	//log.Println("Calling SpatialMaterial.SetRoughnessTextureChannel()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(channel))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetRoughnessTextureChannel.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false specular float}], Returns: void
+*/
+func (o *SpatialMaterial) SetSpecular(specular gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetSpecular()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(specular)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetSpecular.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false specular_mode enum.SpatialMaterial::SpecularMode}], Returns: void
+*/
+func (o *SpatialMaterial) SetSpecularMode(specularMode SpatialMaterialSpecularMode) {
+	//log.Println("Calling SpatialMaterial.SetSpecularMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(specularMode))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetSpecularMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false strength float}], Returns: void
+*/
+func (o *SpatialMaterial) SetSubsurfaceScatteringStrength(strength gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetSubsurfaceScatteringStrength()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(strength)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetSubsurfaceScatteringStrength.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false param int} { false texture Texture}], Returns: void
+*/
+func (o *SpatialMaterial) SetTexture(param gdnative.Int, texture godot.TextureImplementer) {
+	//log.Println("Calling SpatialMaterial.SetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(param)
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false transmission Color}], Returns: void
+*/
+func (o *SpatialMaterial) SetTransmission(transmission gdnative.Color) {
+	//log.Println("Calling SpatialMaterial.SetTransmission()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(transmission)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetTransmission.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false offset Vector3}], Returns: void
+*/
+func (o *SpatialMaterial) SetUv1Offset(offset gdnative.Vector3) {
+	//log.Println("Calling SpatialMaterial.SetUv1Offset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(offset)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetUv1Offset.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false scale Vector3}], Returns: void
+*/
+func (o *SpatialMaterial) SetUv1Scale(scale gdnative.Vector3) {
+	//log.Println("Calling SpatialMaterial.SetUv1Scale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(scale)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetUv1Scale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false sharpness float}], Returns: void
+*/
+func (o *SpatialMaterial) SetUv1TriplanarBlendSharpness(sharpness gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetUv1TriplanarBlendSharpness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(sharpness)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetUv1TriplanarBlendSharpness.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false offset Vector3}], Returns: void
+*/
+func (o *SpatialMaterial) SetUv2Offset(offset gdnative.Vector3) {
+	//log.Println("Calling SpatialMaterial.SetUv2Offset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(offset)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetUv2Offset.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false scale Vector3}], Returns: void
+*/
+func (o *SpatialMaterial) SetUv2Scale(scale gdnative.Vector3) {
+	//log.Println("Calling SpatialMaterial.SetUv2Scale()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector3(scale)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetUv2Scale.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false sharpness float}], Returns: void
+*/
+func (o *SpatialMaterial) SetUv2TriplanarBlendSharpness(sharpness gdnative.Real) {
+	//log.Println("Calling SpatialMaterial.SetUv2TriplanarBlendSharpness()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(sharpness)
+
+	// Get the method bind
+	methodBind := methodBindSpatialMaterialSetUv2TriplanarBlendSharpness.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AlbedoColor will return the value of the "albedo_color" property.
+func (o *SpatialMaterial) AlbedoColor() gdnative.Color {
+	return o.GetAlbedo()
+}
+
+// SetAlbedoColor will set the value of the "albedo_color" property.
+func (o *SpatialMaterial) SetAlbedoColor(value gdnative.Color) {
+	o.SetAlbedo(value)
+}
+
+// AlbedoTexture will return the value of the "albedo_texture" property.
+func (o *SpatialMaterial) AlbedoTexture() godot.TextureImplementer {
+	return o.GetTexture(0)
+}
+
+// SetAlbedoTexture will set the value of the "albedo_texture" property.
+func (o *SpatialMaterial) SetAlbedoTexture(value godot.TextureImplementer) {
+	o.SetTexture(0, value)
+}
+
+// Anisotropy will return the value of the "anisotropy" property.
+func (o *SpatialMaterial) Anisotropy() gdnative.Real {
+	return o.GetAnisotropy()
+}
+
+// AnisotropyEnabled will return the value of the "anisotropy_enabled" property.
+func (o *SpatialMaterial) AnisotropyEnabled() gdnative.Bool {
+	return o.GetFeature(5)
+}
+
+// SetAnisotropyEnabled will set the value of the "anisotropy_enabled" property.
+func (o *SpatialMaterial) SetAnisotropyEnabled(value gdnative.Bool) {
+	o.SetFeature(5, value)
+}
+
+// AnisotropyFlowmap will return the value of the "anisotropy_flowmap" property.
+func (o *SpatialMaterial) AnisotropyFlowmap() godot.TextureImplementer {
+	return o.GetTexture(7)
+}
+
+// SetAnisotropyFlowmap will set the value of the "anisotropy_flowmap" property.
+func (o *SpatialMaterial) SetAnisotropyFlowmap(value godot.TextureImplementer) {
+	o.SetTexture(7, value)
+}
+
+// AoEnabled will return the value of the "ao_enabled" property.
+func (o *SpatialMaterial) AoEnabled() gdnative.Bool {
+	return o.GetFeature(6)
+}
+
+// SetAoEnabled will set the value of the "ao_enabled" property.
+func (o *SpatialMaterial) SetAoEnabled(value gdnative.Bool) {
+	o.SetFeature(6, value)
+}
+
+// AoLightAffect will return the value of the "ao_light_affect" property.
+func (o *SpatialMaterial) AoLightAffect() gdnative.Real {
+	return o.GetAoLightAffect()
+}
+
+// AoOnUv2 will return the value of the "ao_on_uv2" property.
+func (o *SpatialMaterial) AoOnUv2() gdnative.Bool {
+	return o.GetFlag(10)
+}
+
+// SetAoOnUv2 will set the value of the "ao_on_uv2" property.
+func (o *SpatialMaterial) SetAoOnUv2(value gdnative.Bool) {
+	o.SetFlag(10, value)
+}
+
+// AoTexture will return the value of the "ao_texture" property.
+func (o *SpatialMaterial) AoTexture() godot.TextureImplementer {
+	return o.GetTexture(8)
+}
+
+// SetAoTexture will set the value of the "ao_texture" property.
+func (o *SpatialMaterial) SetAoTexture(value godot.TextureImplementer) {
+	o.SetTexture(8, value)
+}
+
+// AoTextureChannel will return the value of the "ao_texture_channel" property.
+func (o *SpatialMaterial) AoTextureChannel() SpatialMaterialTextureChannel {
+	return o.GetAoTextureChannel()
+}
+
+// Clearcoat will return the value of the "clearcoat" property.
+func (o *SpatialMaterial) Clearcoat() gdnative.Real {
+	return o.GetClearcoat()
+}
+
+// ClearcoatEnabled will return the value of the "clearcoat_enabled" property.
+func (o *SpatialMaterial) ClearcoatEnabled() gdnative.Bool {
+	return o.GetFeature(4)
+}
+
+// SetClearcoatEnabled will set the value of the "clearcoat_enabled" property.
+func (o *SpatialMaterial) SetClearcoatEnabled(value gdnative.Bool) {
+	o.SetFeature(4, value)
+}
+
+// ClearcoatGloss will return the value of the "clearcoat_gloss" property.
+func (o *SpatialMaterial) ClearcoatGloss() gdnative.Real {
+	return o.GetClearcoatGloss()
+}
+
+// ClearcoatTexture will return the value of the "clearcoat_texture" property.
+func (o *SpatialMaterial) ClearcoatTexture() godot.TextureImplementer {
+	return o.GetTexture(6)
+}
+
+// SetClearcoatTexture will set the value of the "clearcoat_texture" property.
+func (o *SpatialMaterial) SetClearcoatTexture(value godot.TextureImplementer) {
+	o.SetTexture(6, value)
+}
+
+// DepthDeepParallax will return the value of the "depth_deep_parallax" property.
+func (o *SpatialMaterial) DepthDeepParallax() gdnative.Bool {
+	return o.IsDepthDeepParallaxEnabled()
+}
+
+// DepthEnabled will return the value of the "depth_enabled" property.
+func (o *SpatialMaterial) DepthEnabled() gdnative.Bool {
+	return o.GetFeature(7)
+}
+
+// SetDepthEnabled will set the value of the "depth_enabled" property.
+func (o *SpatialMaterial) SetDepthEnabled(value gdnative.Bool) {
+	o.SetFeature(7, value)
+}
+
+// DepthMaxLayers will return the value of the "depth_max_layers" property.
+func (o *SpatialMaterial) DepthMaxLayers() gdnative.Int {
+	return o.GetDepthDeepParallaxMaxLayers()
+}
+
+// SetDepthMaxLayers will set the value of the "depth_max_layers" property.
+func (o *SpatialMaterial) SetDepthMaxLayers(value gdnative.Int) {
+	o.SetDepthDeepParallaxMaxLayers(value)
+}
+
+// DepthMinLayers will return the value of the "depth_min_layers" property.
+func (o *SpatialMaterial) DepthMinLayers() gdnative.Int {
+	return o.GetDepthDeepParallaxMinLayers()
+}
+
+// SetDepthMinLayers will set the value of the "depth_min_layers" property.
+func (o *SpatialMaterial) SetDepthMinLayers(value gdnative.Int) {
+	o.SetDepthDeepParallaxMinLayers(value)
+}
+
+// DepthScale will return the value of the "depth_scale" property.
+func (o *SpatialMaterial) DepthScale() gdnative.Real {
+	return o.GetDepthScale()
+}
+
+// DepthTexture will return the value of the "depth_texture" property.
+func (o *SpatialMaterial) DepthTexture() godot.TextureImplementer {
+	return o.GetTexture(9)
+}
+
+// SetDepthTexture will set the value of the "depth_texture" property.
+func (o *SpatialMaterial) SetDepthTexture(value godot.TextureImplementer) {
+	o.SetTexture(9, value)
+}
+
+// DetailAlbedo will return the value of the "detail_albedo" property.
+func (o *SpatialMaterial) DetailAlbedo() godot.TextureImplementer {
+	return o.GetTexture(14)
+}
+
+// SetDetailAlbedo will set the value of the "detail_albedo" property.
+func (o *SpatialMaterial) SetDetailAlbedo(value godot.TextureImplementer) {
+	o.SetTexture(14, value)
+}
+
+// DetailBlendMode will return the value of the "detail_blend_mode" property.
+func (o *SpatialMaterial) DetailBlendMode() SpatialMaterialBlendMode {
+	return o.GetDetailBlendMode()
+}
+
+// DetailEnabled will return the value of the "detail_enabled" property.
+func (o *SpatialMaterial) DetailEnabled() gdnative.Bool {
+	return o.GetFeature(11)
+}
+
+// SetDetailEnabled will set the value of the "detail_enabled" property.
+func (o *SpatialMaterial) SetDetailEnabled(value gdnative.Bool) {
+	o.SetFeature(11, value)
+}
+
+// DetailMask will return the value of the "detail_mask" property.
+func (o *SpatialMaterial) DetailMask() godot.TextureImplementer {
+	return o.GetTexture(13)
+}
+
+// SetDetailMask will set the value of the "detail_mask" property.
+func (o *SpatialMaterial) SetDetailMask(value godot.TextureImplementer) {
+	o.SetTexture(13, value)
+}
+
+// DetailNormal will return the value of the "detail_normal" property.
+func (o *SpatialMaterial) DetailNormal() godot.TextureImplementer {
+	return o.GetTexture(15)
+}
+
+// SetDetailNormal will set the value of the "detail_normal" property.
+func (o *SpatialMaterial) SetDetailNormal(value godot.TextureImplementer) {
+	o.SetTexture(15, value)
+}
+
+// DetailUvLayer will return the value of the "detail_uv_layer" property.
+func (o *SpatialMaterial) DetailUvLayer() SpatialMaterialDetailUV {
+	return o.GetDetailUv()
+}
+
+// SetDetailUvLayer will set the value of the "detail_uv_layer" property.
+func (o *SpatialMaterial) SetDetailUvLayer(value SpatialMaterialDetailUV) {
+	o.SetDetailUv(value)
+}
+
+// DistanceFadeEnable will return the value of the "distance_fade_enable" property.
+func (o *SpatialMaterial) DistanceFadeEnable() gdnative.Bool {
+	return o.IsDistanceFadeEnabled()
+}
+
+// SetDistanceFadeEnable will set the value of the "distance_fade_enable" property.
+func (o *SpatialMaterial) SetDistanceFadeEnable(value gdnative.Bool) {
+	o.SetDistanceFade(value)
+}
+
+// DistanceFadeMaxDistance will return the value of the "distance_fade_max_distance" property.
+func (o *SpatialMaterial) DistanceFadeMaxDistance() gdnative.Real {
+	return o.GetDistanceFadeMaxDistance()
+}
+
+// DistanceFadeMinDistance will return the value of the "distance_fade_min_distance" property.
+func (o *SpatialMaterial) DistanceFadeMinDistance() gdnative.Real {
+	return o.GetDistanceFadeMinDistance()
+}
+
+// Emission will return the value of the "emission" property.
+func (o *SpatialMaterial) Emission() gdnative.Color {
+	return o.GetEmission()
+}
+
+// EmissionEnabled will return the value of the "emission_enabled" property.
+func (o *SpatialMaterial) EmissionEnabled() gdnative.Bool {
+	return o.GetFeature(1)
+}
+
+// SetEmissionEnabled will set the value of the "emission_enabled" property.
+func (o *SpatialMaterial) SetEmissionEnabled(value gdnative.Bool) {
+	o.SetFeature(1, value)
+}
+
+// EmissionEnergy will return the value of the "emission_energy" property.
+func (o *SpatialMaterial) EmissionEnergy() gdnative.Real {
+	return o.GetEmissionEnergy()
+}
+
+// EmissionOnUv2 will return the value of the "emission_on_uv2" property.
+func (o *SpatialMaterial) EmissionOnUv2() gdnative.Bool {
+	return o.GetFlag(11)
+}
+
+// SetEmissionOnUv2 will set the value of the "emission_on_uv2" property.
+func (o *SpatialMaterial) SetEmissionOnUv2(value gdnative.Bool) {
+	o.SetFlag(11, value)
+}
+
+// EmissionOperator will return the value of the "emission_operator" property.
+func (o *SpatialMaterial) EmissionOperator() SpatialMaterialEmissionOperator {
+	return o.GetEmissionOperator()
+}
+
+// EmissionTexture will return the value of the "emission_texture" property.
+func (o *SpatialMaterial) EmissionTexture() godot.TextureImplementer {
+	return o.GetTexture(3)
+}
+
+// SetEmissionTexture will set the value of the "emission_texture" property.
+func (o *SpatialMaterial) SetEmissionTexture(value godot.TextureImplementer) {
+	o.SetTexture(3, value)
+}
+
+// FlagsAlbedoTexForceSrgb will return the value of the "flags_albedo_tex_force_srgb" property.
+func (o *SpatialMaterial) FlagsAlbedoTexForceSrgb() gdnative.Bool {
+	return o.GetFlag(13)
+}
+
+// SetFlagsAlbedoTexForceSrgb will set the value of the "flags_albedo_tex_force_srgb" property.
+func (o *SpatialMaterial) SetFlagsAlbedoTexForceSrgb(value gdnative.Bool) {
+	o.SetFlag(13, value)
+}
+
+// FlagsFixedSize will return the value of the "flags_fixed_size" property.
+func (o *SpatialMaterial) FlagsFixedSize() gdnative.Bool {
+	return o.GetFlag(6)
+}
+
+// SetFlagsFixedSize will set the value of the "flags_fixed_size" property.
+func (o *SpatialMaterial) SetFlagsFixedSize(value gdnative.Bool) {
+	o.SetFlag(6, value)
+}
+
+// FlagsNoDepthTest will return the value of the "flags_no_depth_test" property.
+func (o *SpatialMaterial) FlagsNoDepthTest() gdnative.Bool {
+	return o.GetFlag(2)
+}
+
+// SetFlagsNoDepthTest will set the value of the "flags_no_depth_test" property.
+func (o *SpatialMaterial) SetFlagsNoDepthTest(value gdnative.Bool) {
+	o.SetFlag(2, value)
+}
+
+// FlagsTransparent will return the value of the "flags_transparent" property.
+func (o *SpatialMaterial) FlagsTransparent() gdnative.Bool {
+	return o.GetFeature(0)
+}
+
+// SetFlagsTransparent will set the value of the "flags_transparent" property.
+func (o *SpatialMaterial) SetFlagsTransparent(value gdnative.Bool) {
+	o.SetFeature(0, value)
+}
+
+// FlagsUnshaded will return the value of the "flags_unshaded" property.
+func (o *SpatialMaterial) FlagsUnshaded() gdnative.Bool {
+	return o.GetFlag(0)
+}
+
+// SetFlagsUnshaded will set the value of the "flags_unshaded" property.
+func (o *SpatialMaterial) SetFlagsUnshaded(value gdnative.Bool) {
+	o.SetFlag(0, value)
+}
+
+// FlagsUsePointSize will return the value of the "flags_use_point_size" property.
+func (o *SpatialMaterial) FlagsUsePointSize() gdnative.Bool {
+	return o.GetFlag(5)
+}
+
+// SetFlagsUsePointSize will set the value of the "flags_use_point_size" property.
+func (o *SpatialMaterial) SetFlagsUsePointSize(value gdnative.Bool) {
+	o.SetFlag(5, value)
+}
+
+// FlagsVertexLighting will return the value of the "flags_vertex_lighting" property.
+func (o *SpatialMaterial) FlagsVertexLighting() gdnative.Bool {
+	return o.GetFlag(1)
+}
+
+// SetFlagsVertexLighting will set the value of the "flags_vertex_lighting" property.
+func (o *SpatialMaterial) SetFlagsVertexLighting(value gdnative.Bool) {
+	o.SetFlag(1, value)
+}
+
+// FlagsWorldTriplanar will return the value of the "flags_world_triplanar" property.
+func (o *SpatialMaterial) FlagsWorldTriplanar() gdnative.Bool {
+	return o.GetFlag(9)
+}
+
+// SetFlagsWorldTriplanar will set the value of the "flags_world_triplanar" property.
+func (o *SpatialMaterial) SetFlagsWorldTriplanar(value gdnative.Bool) {
+	o.SetFlag(9, value)
+}
+
+// Metallic will return the value of the "metallic" property.
+func (o *SpatialMaterial) Metallic() gdnative.Real {
+	return o.GetMetallic()
+}
+
+// MetallicSpecular will return the value of the "metallic_specular" property.
+func (o *SpatialMaterial) MetallicSpecular() gdnative.Real {
+	return o.GetSpecular()
+}
+
+// SetMetallicSpecular will set the value of the "metallic_specular" property.
+func (o *SpatialMaterial) SetMetallicSpecular(value gdnative.Real) {
+	o.SetSpecular(value)
+}
+
+// MetallicTexture will return the value of the "metallic_texture" property.
+func (o *SpatialMaterial) MetallicTexture() godot.TextureImplementer {
+	return o.GetTexture(1)
+}
+
+// SetMetallicTexture will set the value of the "metallic_texture" property.
+func (o *SpatialMaterial) SetMetallicTexture(value godot.TextureImplementer) {
+	o.SetTexture(1, value)
+}
+
+// MetallicTextureChannel will return the value of the "metallic_texture_channel" property.
+func (o *SpatialMaterial) MetallicTextureChannel() SpatialMaterialTextureChannel {
+	return o.GetMetallicTextureChannel()
+}
+
+// NormalEnabled will return the value of the "normal_enabled" property.
+func (o *SpatialMaterial) NormalEnabled() gdnative.Bool {
+	return o.GetFeature(2)
+}
+
+// SetNormalEnabled will set the value of the "normal_enabled" property.
+func (o *SpatialMaterial) SetNormalEnabled(value gdnative.Bool) {
+	o.SetFeature(2, value)
+}
+
+// NormalScale will return the value of the "normal_scale" property.
+func (o *SpatialMaterial) NormalScale() gdnative.Real {
+	return o.GetNormalScale()
+}
+
+// NormalTexture will return the value of the "normal_texture" property.
+func (o *SpatialMaterial) NormalTexture() godot.TextureImplementer {
+	return o.GetTexture(4)
+}
+
+// SetNormalTexture will set the value of the "normal_texture" property.
+func (o *SpatialMaterial) SetNormalTexture(value godot.TextureImplementer) {
+	o.SetTexture(4, value)
+}
+
+// ParamsAlphaScissorThreshold will return the value of the "params_alpha_scissor_threshold" property.
+func (o *SpatialMaterial) ParamsAlphaScissorThreshold() gdnative.Real {
+	return o.GetAlphaScissorThreshold()
+}
+
+// SetParamsAlphaScissorThreshold will set the value of the "params_alpha_scissor_threshold" property.
+func (o *SpatialMaterial) SetParamsAlphaScissorThreshold(value gdnative.Real) {
+	o.SetAlphaScissorThreshold(value)
+}
+
+// ParamsBillboardMode will return the value of the "params_billboard_mode" property.
+func (o *SpatialMaterial) ParamsBillboardMode() SpatialMaterialBillboardMode {
+	return o.GetBillboardMode()
+}
+
+// SetParamsBillboardMode will set the value of the "params_billboard_mode" property.
+func (o *SpatialMaterial) SetParamsBillboardMode(value SpatialMaterialBillboardMode) {
+	o.SetBillboardMode(value)
+}
+
+// ParamsBlendMode will return the value of the "params_blend_mode" property.
+func (o *SpatialMaterial) ParamsBlendMode() SpatialMaterialBlendMode {
+	return o.GetBlendMode()
+}
+
+// SetParamsBlendMode will set the value of the "params_blend_mode" property.
+func (o *SpatialMaterial) SetParamsBlendMode(value SpatialMaterialBlendMode) {
+	o.SetBlendMode(value)
+}
+
+// ParamsCullMode will return the value of the "params_cull_mode" property.
+func (o *SpatialMaterial) ParamsCullMode() SpatialMaterialCullMode {
+	return o.GetCullMode()
+}
+
+// SetParamsCullMode will set the value of the "params_cull_mode" property.
+func (o *SpatialMaterial) SetParamsCullMode(value SpatialMaterialCullMode) {
+	o.SetCullMode(value)
+}
+
+// ParamsDepthDrawMode will return the value of the "params_depth_draw_mode" property.
+func (o *SpatialMaterial) ParamsDepthDrawMode() SpatialMaterialDepthDrawMode {
+	return o.GetDepthDrawMode()
+}
+
+// SetParamsDepthDrawMode will set the value of the "params_depth_draw_mode" property.
+func (o *SpatialMaterial) SetParamsDepthDrawMode(value SpatialMaterialDepthDrawMode) {
+	o.SetDepthDrawMode(value)
+}
+
+// ParamsDiffuseMode will return the value of the "params_diffuse_mode" property.
+func (o *SpatialMaterial) ParamsDiffuseMode() SpatialMaterialDiffuseMode {
+	return o.GetDiffuseMode()
+}
+
+// SetParamsDiffuseMode will set the value of the "params_diffuse_mode" property.
+func (o *SpatialMaterial) SetParamsDiffuseMode(value SpatialMaterialDiffuseMode) {
+	o.SetDiffuseMode(value)
+}
+
+// ParamsGrow will return the value of the "params_grow" property.
+func (o *SpatialMaterial) ParamsGrow() gdnative.Bool {
+	return o.IsGrowEnabled()
+}
+
+// SetParamsGrow will set the value of the "params_grow" property.
+func (o *SpatialMaterial) SetParamsGrow(value gdnative.Bool) {
+	o.SetGrowEnabled(value)
+}
+
+// ParamsGrowAmount will return the value of the "params_grow_amount" property.
+func (o *SpatialMaterial) ParamsGrowAmount() gdnative.Real {
+	return o.GetGrow()
+}
+
+// SetParamsGrowAmount will set the value of the "params_grow_amount" property.
+func (o *SpatialMaterial) SetParamsGrowAmount(value gdnative.Real) {
+	o.SetGrow(value)
+}
+
+// ParamsLineWidth will return the value of the "params_line_width" property.
+func (o *SpatialMaterial) ParamsLineWidth() gdnative.Real {
+	return o.GetLineWidth()
+}
+
+// SetParamsLineWidth will set the value of the "params_line_width" property.
+func (o *SpatialMaterial) SetParamsLineWidth(value gdnative.Real) {
+	o.SetLineWidth(value)
+}
+
+// ParamsPointSize will return the value of the "params_point_size" property.
+func (o *SpatialMaterial) ParamsPointSize() gdnative.Real {
+	return o.GetPointSize()
+}
+
+// SetParamsPointSize will set the value of the "params_point_size" property.
+func (o *SpatialMaterial) SetParamsPointSize(value gdnative.Real) {
+	o.SetPointSize(value)
+}
+
+// ParamsSpecularMode will return the value of the "params_specular_mode" property.
+func (o *SpatialMaterial) ParamsSpecularMode() SpatialMaterialSpecularMode {
+	return o.GetSpecularMode()
+}
+
+// SetParamsSpecularMode will set the value of the "params_specular_mode" property.
+func (o *SpatialMaterial) SetParamsSpecularMode(value SpatialMaterialSpecularMode) {
+	o.SetSpecularMode(value)
+}
+
+// ParamsUseAlphaScissor will return the value of the "params_use_alpha_scissor" property.
+func (o *SpatialMaterial) ParamsUseAlphaScissor() gdnative.Bool {
+	return o.GetFlag(12)
+}
+
+// SetParamsUseAlphaScissor will set the value of the "params_use_alpha_scissor" property.
+func (o *SpatialMaterial) SetParamsUseAlphaScissor(value gdnative.Bool) {
+	o.SetFlag(12, value)
+}
+
+// ParticlesAnimHFrames will return the value of the "particles_anim_h_frames" property.
+func (o *SpatialMaterial) ParticlesAnimHFrames() gdnative.Int {
+	return o.GetParticlesAnimHFrames()
+}
+
+// ParticlesAnimLoop will return the value of the "particles_anim_loop" property.
+func (o *SpatialMaterial) ParticlesAnimLoop() gdnative.Int {
+	return o.GetParticlesAnimLoop()
+}
+
+// ParticlesAnimVFrames will return the value of the "particles_anim_v_frames" property.
+func (o *SpatialMaterial) ParticlesAnimVFrames() gdnative.Int {
+	return o.GetParticlesAnimVFrames()
+}
+
+// ProximityFadeDistance will return the value of the "proximity_fade_distance" property.
+func (o *SpatialMaterial) ProximityFadeDistance() gdnative.Real {
+	return o.GetProximityFadeDistance()
+}
+
+// ProximityFadeEnable will return the value of the "proximity_fade_enable" property.
+func (o *SpatialMaterial) ProximityFadeEnable() gdnative.Bool {
+	return o.IsProximityFadeEnabled()
+}
+
+// SetProximityFadeEnable will set the value of the "proximity_fade_enable" property.
+func (o *SpatialMaterial) SetProximityFadeEnable(value gdnative.Bool) {
+	o.SetProximityFade(value)
+}
+
+// RefractionEnabled will return the value of the "refraction_enabled" property.
+func (o *SpatialMaterial) RefractionEnabled() gdnative.Bool {
+	return o.GetFeature(10)
+}
+
+// SetRefractionEnabled will set the value of the "refraction_enabled" property.
+func (o *SpatialMaterial) SetRefractionEnabled(value gdnative.Bool) {
+	o.SetFeature(10, value)
+}
+
+// RefractionScale will return the value of the "refraction_scale" property.
+func (o *SpatialMaterial) RefractionScale() gdnative.Real {
+	return o.GetRefraction()
+}
+
+// SetRefractionScale will set the value of the "refraction_scale" property.
+func (o *SpatialMaterial) SetRefractionScale(value gdnative.Real) {
+	o.SetRefraction(value)
+}
+
+// RefractionTexture will return the value of the "refraction_texture" property.
+func (o *SpatialMaterial) RefractionTexture() godot.TextureImplementer {
+	return o.GetTexture(12)
+}
+
+// SetRefractionTexture will set the value of the "refraction_texture" property.
+func (o *SpatialMaterial) SetRefractionTexture(value godot.TextureImplementer) {
+	o.SetTexture(12, value)
+}
+
+// RefractionTextureChannel will return the value of the "refraction_texture_channel" property.
+func (o *SpatialMaterial) RefractionTextureChannel() SpatialMaterialTextureChannel {
+	return o.GetRefractionTextureChannel()
+}
+
+// Rim will return the value of the "rim" property.
+func (o *SpatialMaterial) Rim() gdnative.Real {
+	return o.GetRim()
+}
+
+// RimEnabled will return the value of the "rim_enabled" property.
+func (o *SpatialMaterial) RimEnabled() gdnative.Bool {
+	return o.GetFeature(3)
+}
+
+// SetRimEnabled will set the value of the "rim_enabled" property.
+func (o *SpatialMaterial) SetRimEnabled(value gdnative.Bool) {
+	o.SetFeature(3, value)
+}
+
+// RimTexture will return the value of the "rim_texture" property.
+func (o *SpatialMaterial) RimTexture() godot.TextureImplementer {
+	return o.GetTexture(5)
+}
+
+// SetRimTexture will set the value of the "rim_texture" property.
+func (o *SpatialMaterial) SetRimTexture(value godot.TextureImplementer) {
+	o.SetTexture(5, value)
+}
+
+// RimTint will return the value of the "rim_tint" property.
+func (o *SpatialMaterial) RimTint() gdnative.Real {
+	return o.GetRimTint()
+}
+
+// Roughness will return the value of the "roughness" property.
+func (o *SpatialMaterial) Roughness() gdnative.Real {
+	return o.GetRoughness()
+}
+
+// RoughnessTexture will return the value of the "roughness_texture" property.
+func (o *SpatialMaterial) RoughnessTexture() godot.TextureImplementer {
+	return o.GetTexture(2)
+}
+
+// SetRoughnessTexture will set the value of the "roughness_texture" property.
+func (o *SpatialMaterial) SetRoughnessTexture(value godot.TextureImplementer) {
+	o.SetTexture(2, value)
+}
+
+// RoughnessTextureChannel will return the value of the "roughness_texture_channel" property.
+func (o *SpatialMaterial) RoughnessTextureChannel() SpatialMaterialTextureChannel {
+	return o.GetRoughnessTextureChannel()
+}
+
+// SubsurfScatterEnabled will return the value of the "subsurf_scatter_enabled" property.
+func (o *SpatialMaterial) SubsurfScatterEnabled() gdnative.Bool {
+	return o.GetFeature(8)
+}
+
+// SetSubsurfScatterEnabled will set the value of the "subsurf_scatter_enabled" property.
+func (o *SpatialMaterial) SetSubsurfScatterEnabled(value gdnative.Bool) {
+	o.SetFeature(8, value)
+}
+
+// SubsurfScatterStrength will return the value of the "subsurf_scatter_strength" property.
+func (o *SpatialMaterial) SubsurfScatterStrength() gdnative.Real {
+	return o.GetSubsurfaceScatteringStrength()
+}
+
+// SetSubsurfScatterStrength will set the value of the "subsurf_scatter_strength" property.
+func (o *SpatialMaterial) SetSubsurfScatterStrength(value gdnative.Real) {
+	o.SetSubsurfaceScatteringStrength(value)
+}
+
+// SubsurfScatterTexture will return the value of the "subsurf_scatter_texture" property.
+func (o *SpatialMaterial) SubsurfScatterTexture() godot.TextureImplementer {
+	return o.GetTexture(10)
+}
+
+// SetSubsurfScatterTexture will set the value of the "subsurf_scatter_texture" property.
+func (o *SpatialMaterial) SetSubsurfScatterTexture(value godot.TextureImplementer) {
+	o.SetTexture(10, value)
+}
+
+// Transmission will return the value of the "transmission" property.
+func (o *SpatialMaterial) Transmission() gdnative.Color {
+	return o.GetTransmission()
+}
+
+// TransmissionEnabled will return the value of the "transmission_enabled" property.
+func (o *SpatialMaterial) TransmissionEnabled() gdnative.Bool {
+	return o.GetFeature(9)
+}
+
+// SetTransmissionEnabled will set the value of the "transmission_enabled" property.
+func (o *SpatialMaterial) SetTransmissionEnabled(value gdnative.Bool) {
+	o.SetFeature(9, value)
+}
+
+// TransmissionTexture will return the value of the "transmission_texture" property.
+func (o *SpatialMaterial) TransmissionTexture() godot.TextureImplementer {
+	return o.GetTexture(11)
+}
+
+// SetTransmissionTexture will set the value of the "transmission_texture" property.
+func (o *SpatialMaterial) SetTransmissionTexture(value godot.TextureImplementer) {
+	o.SetTexture(11, value)
+}
+
+// Uv1Offset will return the value of the "uv1_offset" property.
+func (o *SpatialMaterial) Uv1Offset() gdnative.Vector3 {
+	return o.GetUv1Offset()
+}
+
+// Uv1Scale will return the value of the "uv1_scale" property.
+func (o *SpatialMaterial) Uv1Scale() gdnative.Vector3 {
+	return o.GetUv1Scale()
+}
+
+// Uv1Triplanar will return the value of the "uv1_triplanar" property.
+func (o *SpatialMaterial) Uv1Triplanar() gdnative.Bool {
+	return o.GetFlag(7)
+}
+
+// SetUv1Triplanar will set the value of the "uv1_triplanar" property.
+func (o *SpatialMaterial) SetUv1Triplanar(value gdnative.Bool) {
+	o.SetFlag(7, value)
+}
+
+// Uv1TriplanarSharpness will return the value of the "uv1_triplanar_sharpness" property.
+func (o *SpatialMaterial) Uv1TriplanarSharpness() gdnative.Real {
+	return o.GetUv1TriplanarBlendSharpness()
+}
+
+// SetUv1TriplanarSharpness will set the value of the "uv1_triplanar_sharpness" property.
+func (o *SpatialMaterial) SetUv1TriplanarSharpness(value gdnative.Real) {
+	o.SetUv1TriplanarBlendSharpness(value)
+}
+
+// Uv2Offset will return the value of the "uv2_offset" property.
+func (o *SpatialMaterial) Uv2Offset() gdnative.Vector3 {
+	return o.GetUv2Offset()
+}
+
+// Uv2Scale will return the value of the "uv2_scale" property.
+func (o *SpatialMaterial) Uv2Scale() gdnative.Vector3 {
+	return o.GetUv2Scale()
+}
+
+// Uv2Triplanar will return the value of the "uv2_triplanar" property.
+func (o *SpatialMaterial) Uv2Triplanar() gdnative.Bool {
+	return o.GetFlag(8)
+}
+
+// SetUv2Triplanar will set the value of the "uv2_triplanar" property.
+func (o *SpatialMaterial) SetUv2Triplanar(value gdnative.Bool) {
+	o.SetFlag(8, value)
+}
+
+// Uv2TriplanarSharpness will return the value of the "uv2_triplanar_sharpness" property.
+func (o *SpatialMaterial) Uv2TriplanarSharpness() gdnative.Real {
+	return o.GetUv2TriplanarBlendSharpness()
+}
+
+// SetUv2TriplanarSharpness will set the value of the "uv2_triplanar_sharpness" property.
+func (o *SpatialMaterial) SetUv2TriplanarSharpness(value gdnative.Real) {
+	o.SetUv2TriplanarBlendSharpness(value)
+}
+
+// VertexColorIsSrgb will return the value of the "vertex_color_is_srgb" property.
+func (o *SpatialMaterial) VertexColorIsSrgb() gdnative.Bool {
+	return o.GetFlag(4)
+}
+
+// SetVertexColorIsSrgb will set the value of the "vertex_color_is_srgb" property.
+func (o *SpatialMaterial) SetVertexColorIsSrgb(value gdnative.Bool) {
+	o.SetFlag(4, value)
+}
+
+// VertexColorUseAsAlbedo will return the value of the "vertex_color_use_as_albedo" property.
+func (o *SpatialMaterial) VertexColorUseAsAlbedo() gdnative.Bool {
+	return o.GetFlag(3)
+}
+
+// SetVertexColorUseAsAlbedo will set the value of the "vertex_color_use_as_albedo" property.
+func (o *SpatialMaterial) SetVertexColorUseAsAlbedo(value gdnative.Bool) {
+	o.SetFlag(3, value)
+}
+
+// SpatialMaterialImplementer is an interface that implements the methods
+// of the SpatialMaterial class.
+type SpatialMaterialImplementer interface {
+	godot.MaterialImplementer
+	GetAlbedo() gdnative.Color
+	GetAlphaScissorThreshold() gdnative.Real
+	GetAnisotropy() gdnative.Real
+	GetAoLightAffect() gdnative.Real
+	GetAoTextureChannel() SpatialMaterialTextureChannel
+	GetBillboardMode() SpatialMaterialBillboardMode
+	GetBlendMode() SpatialMaterialBlendMode
+	GetClearcoat() gdnative.Real
+	GetClearcoatGloss() gdnative.Real
+	GetCullMode() SpatialMaterialCullMode
+	GetDepthDeepParallaxMaxLayers() gdnative.Int
+	GetDepthDeepParallaxMinLayers() gdnative.Int
+	GetDepthDrawMode() SpatialMaterialDepthDrawMode
+	GetDepthScale() gdnative.Real
+	GetDetailBlendMode() SpatialMaterialBlendMode
+	GetDetailUv() SpatialMaterialDetailUV
+	GetDiffuseMode() SpatialMaterialDiffuseMode
+	GetDistanceFadeMaxDistance() gdnative.Real
+	GetDistanceFadeMinDistance() gdnative.Real
+	GetEmission() gdnative.Color
+	GetEmissionEnergy() gdnative.Real
+	GetEmissionOperator() SpatialMaterialEmissionOperator
+	GetFeature(feature SpatialMaterialFeature) gdnative.Bool
+	GetFlag(flag gdnative.Int) gdnative.Bool
+	GetGrow() gdnative.Real
+	GetLineWidth() gdnative.Real
+	GetMetallic() gdnative.Real
+	GetMetallicTextureChannel() SpatialMaterialTextureChannel
+	GetNormalScale() gdnative.Real
+	GetParticlesAnimHFrames() gdnative.Int
+	GetParticlesAnimLoop() gdnative.Int
+	GetParticlesAnimVFrames() gdnative.Int
+	GetPointSize() gdnative.Real
+	GetProximityFadeDistance() gdnative.Real
+	GetRefraction() gdnative.Real
+	GetRefractionTextureChannel() SpatialMaterialTextureChannel
+	GetRim() gdnative.Real
+	GetRimTint() gdnative.Real
+	GetRoughness() gdnative.Real
+	GetRoughnessTextureChannel() SpatialMaterialTextureChannel
+	GetSpecular() gdnative.Real
+	GetSpecularMode() SpatialMaterialSpecularMode
+	GetSubsurfaceScatteringStrength() gdnative.Real
+	GetTexture(param gdnative.Int) godot.TextureImplementer
+	GetTransmission() gdnative.Color
+	GetUv1Offset() gdnative.Vector3
+	GetUv1Scale() gdnative.Vector3
+	GetUv1TriplanarBlendSharpness() gdnative.Real
+	GetUv2Offset() gdnative.Vector3
+	GetUv2Scale() gdnative.Vector3
+	GetUv2TriplanarBlendSharpness() gdnative.Real
+	IsDepthDeepParallaxEnabled() gdnative.Bool
+	IsDistanceFadeEnabled() gdnative.Bool
+	IsGrowEnabled() gdnative.Bool
+	IsProximityFadeEnabled() gdnative.Bool
+	SetAlbedo(albedo gdnative.Color)
+	SetAlphaScissorThreshold(threshold gdnative.Real)
+	SetAnisotropy(anisotropy gdnative.Real)
+	SetAoLightAffect(amount gdnative.Real)
+	SetAoTextureChannel(channel SpatialMaterialTextureChannel)
+	SetBillboardMode(mode SpatialMaterialBillboardMode)
+	SetBlendMode(blendMode SpatialMaterialBlendMode)
+	SetClearcoat(clearcoat gdnative.Real)
+	SetClearcoatGloss(clearcoatGloss gdnative.Real)
+	SetCullMode(cullMode SpatialMaterialCullMode)
+	SetDepthDeepParallax(enable gdnative.Bool)
+	SetDepthDeepParallaxMaxLayers(layer gdnative.Int)
+	SetDepthDeepParallaxMinLayers(layer gdnative.Int)
+	SetDepthDrawMode(depthDrawMode SpatialMaterialDepthDrawMode)
+	SetDepthScale(depthScale gdnative.Real)
+	SetDetailBlendMode(detailBlendMode SpatialMaterialBlendMode)
+	SetDetailUv(detailUv SpatialMaterialDetailUV)
+	SetDiffuseMode(diffuseMode SpatialMaterialDiffuseMode)
+	SetDistanceFade(enabled gdnative.Bool)
+	SetDistanceFadeMaxDistance(distance gdnative.Real)
+	SetDistanceFadeMinDistance(distance gdnative.Real)
+	SetEmission(emission gdnative.Color)
+	SetEmissionEnergy(emissionEnergy gdnative.Real)
+	SetEmissionOperator(operator SpatialMaterialEmissionOperator)
+	SetFeature(feature SpatialMaterialFeature, enable gdnative.Bool)
+	SetFlag(flag gdnative.Int, enable gdnative.Bool)
+	SetGrow(amount gdnative.Real)
+	SetGrowEnabled(enable gdnative.Bool)
+	SetLineWidth(lineWidth gdnative.Real)
+	SetMetallic(metallic gdnative.Real)
+	SetMetallicTextureChannel(channel SpatialMaterialTextureChannel)
+	SetNormalScale(normalScale gdnative.Real)
+	SetParticlesAnimHFrames(frames gdnative.Int)
+	SetParticlesAnimLoop(frames gdnative.Int)
+	SetParticlesAnimVFrames(frames gdnative.Int)
+	SetPointSize(pointSize gdnative.Real)
+	SetProximityFade(enabled gdnative.Bool)
+	SetProximityFadeDistance(distance gdnative.Real)
+	SetRefraction(refraction gdnative.Real)
+	SetRefractionTextureChannel(channel SpatialMaterialTextureChannel)
+	SetRim(rim gdnative.Real)
+	SetRimTint(rimTint gdnative.Real)
+	SetRoughness(roughness gdnative.Real)
+	SetRoughnessTextureChannel(channel SpatialMaterialTextureChannel)
+	SetSpecular(specular gdnative.Real)
+	SetSpecularMode(specularMode SpatialMaterialSpecularMode)
+	SetSubsurfaceScatteringStrength(strength gdnative.Real)
+	SetTexture(param gdnative.Int, texture godot.TextureImplementer)
+	SetTransmission(transmission gdnative.Color)
+	SetUv1Offset(offset gdnative.Vector3)
+	SetUv1Scale(scale gdnative.Vector3)
+	SetUv1TriplanarBlendSharpness(sharpness gdnative.Real)
+	SetUv2Offset(offset gdnative.Vector3)
+	SetUv2Scale(scale gdnative.Vector3)
+	SetUv2TriplanarBlendSharpness(sharpness gdnative.Real)
+	AlbedoColor() gdnative.Color
+	SetAlbedoColor(value gdnative.Color)
+	AlbedoTexture() godot.TextureImplementer
+	SetAlbedoTexture(value godot.TextureImplementer)
+	Anisotropy() gdnative.Real
+	AnisotropyEnabled() gdnative.Bool
+	SetAnisotropyEnabled(value gdnative.Bool)
+	AnisotropyFlowmap() godot.TextureImplementer
+	SetAnisotropyFlowmap(value godot.TextureImplementer)
+	AoEnabled() gdnative.Bool
+	SetAoEnabled(value gdnative.Bool)
+	AoLightAffect() gdnative.Real
+	AoOnUv2() gdnative.Bool
+	SetAoOnUv2(value gdnative.Bool)
+	AoTexture() godot.TextureImplementer
+	SetAoTexture(value godot.TextureImplementer)
+	AoTextureChannel() SpatialMaterialTextureChannel
+	Clearcoat() gdnative.Real
+	ClearcoatEnabled() gdnative.Bool
+	SetClearcoatEnabled(value gdnative.Bool)
+	ClearcoatGloss() gdnative.Real
+	ClearcoatTexture() godot.TextureImplementer
+	SetClearcoatTexture(value godot.TextureImplementer)
+	DepthDeepParallax() gdnative.Bool
+	DepthEnabled() gdnative.Bool
+	SetDepthEnabled(value gdnative.Bool)
+	DepthMaxLayers() gdnative.Int
+	SetDepthMaxLayers(value gdnative.Int)
+	DepthMinLayers() gdnative.Int
+	SetDepthMinLayers(value gdnative.Int)
+	DepthScale() gdnative.Real
+	DepthTexture() godot.TextureImplementer
+	SetDepthTexture(value godot.TextureImplementer)
+	DetailAlbedo() godot.TextureImplementer
+	SetDetailAlbedo(value godot.TextureImplementer)
+	DetailBlendMode() SpatialMaterialBlendMode
+	DetailEnabled() gdnative.Bool
+	SetDetailEnabled(value gdnative.Bool)
+	DetailMask() godot.TextureImplementer
+	SetDetailMask(value godot.TextureImplementer)
+	DetailNormal() godot.TextureImplementer
+	SetDetailNormal(value godot.TextureImplementer)
+	DetailUvLayer() SpatialMaterialDetailUV
+	SetDetailUvLayer(value SpatialMaterialDetailUV)
+	DistanceFadeEnable() gdnative.Bool
+	SetDistanceFadeEnable(value gdnative.Bool)
+	DistanceFadeMaxDistance() gdnative.Real
+	DistanceFadeMinDistance() gdnative.Real
+	Emission() gdnative.Color
+	EmissionEnabled() gdnative.Bool
+	SetEmissionEnabled(value gdnative.Bool)
+	EmissionEnergy() gdnative.Real
+	EmissionOnUv2() gdnative.Bool
+	SetEmissionOnUv2(value gdnative.Bool)
+	EmissionOperator() SpatialMaterialEmissionOperator
+	EmissionTexture() godot.TextureImplementer
+	SetEmissionTexture(value godot.TextureImplementer)
+	FlagsAlbedoTexForceSrgb() gdnative.Bool
+	SetFlagsAlbedoTexForceSrgb(value gdnative.Bool)
+	FlagsFixedSize() gdnative.Bool
+	SetFlagsFixedSize(value gdnative.Bool)
+	FlagsNoDepthTest() gdnative.Bool
+	SetFlagsNoDepthTest(value gdnative.Bool)
+	FlagsTransparent() gdnative.Bool
+	SetFlagsTransparent(value gdnative.Bool)
+	FlagsUnshaded() gdnative.Bool
+	SetFlagsUnshaded(value gdnative.Bool)
+	FlagsUsePointSize() gdnative.Bool
+	SetFlagsUsePointSize(value gdnative.Bool)
+	FlagsVertexLighting() gdnative.Bool
+	SetFlagsVertexLighting(value gdnative.Bool)
+	FlagsWorldTriplanar() gdnative.Bool
+	SetFlagsWorldTriplanar(value gdnative.Bool)
+	Metallic() gdnative.Real
+	MetallicSpecular() gdnative.Real
+	SetMetallicSpecular(value gdnative.Real)
+	MetallicTexture() godot.TextureImplementer
+	SetMetallicTexture(value godot.TextureImplementer)
+	MetallicTextureChannel() SpatialMaterialTextureChannel
+	NormalEnabled() gdnative.Bool
+	SetNormalEnabled(value gdnative.Bool)
+	NormalScale() gdnative.Real
+	NormalTexture() godot.TextureImplementer
+	SetNormalTexture(value godot.TextureImplementer)
+	ParamsAlphaScissorThreshold() gdnative.Real
+	SetParamsAlphaScissorThreshold(value gdnative.Real)
+	ParamsBillboardMode() SpatialMaterialBillboardMode
+	SetParamsBillboardMode(value SpatialMaterialBillboardMode)
+	ParamsBlendMode() SpatialMaterialBlendMode
+	SetParamsBlendMode(value SpatialMaterialBlendMode)
+	ParamsCullMode() SpatialMaterialCullMode
+	SetParamsCullMode(value SpatialMaterialCullMode)
+	ParamsDepthDrawMode() SpatialMaterialDepthDrawMode
+	SetParamsDepthDrawMode(value SpatialMaterialDepthDrawMode)
+	ParamsDiffuseMode() SpatialMaterialDiffuseMode
+	SetParamsDiffuseMode(value SpatialMaterialDiffuseMode)
+	ParamsGrow() gdnative.Bool
+	SetParamsGrow(value gdnative.Bool)
+	ParamsGrowAmount() gdnative.Real
+	SetParamsGrowAmount(value gdnative.Real)
+	ParamsLineWidth() gdnative.Real
+	SetParamsLineWidth(value gdnative.Real)
+	ParamsPointSize() gdnative.Real
+	SetParamsPointSize(value gdnative.Real)
+	ParamsSpecularMode() SpatialMaterialSpecularMode
+	SetParamsSpecularMode(value SpatialMaterialSpecularMode)
+	ParamsUseAlphaScissor() gdnative.Bool
+	SetParamsUseAlphaScissor(value gdnative.Bool)
+	ParticlesAnimHFrames() gdnative.Int
+	ParticlesAnimLoop() gdnative.Int
+	ParticlesAnimVFrames() gdnative.Int
+	ProximityFadeDistance() gdnative.Real
+	ProximityFadeEnable() gdnative.Bool
+	SetProximityFadeEnable(value gdnative.Bool)
+	RefractionEnabled() gdnative.Bool
+	SetRefractionEnabled(value gdnative.Bool)
+	RefractionScale() gdnative.Real
+	SetRefractionScale(value gdnative.Real)
+	RefractionTexture() godot.TextureImplementer
+	SetRefractionTexture(value godot.TextureImplementer)
+	RefractionTextureChannel() SpatialMaterialTextureChannel
+	Rim() gdnative.Real
+	RimEnabled() gdnative.Bool
+	SetRimEnabled(value gdnative.Bool)
+	RimTexture() godot.TextureImplementer
+	SetRimTexture(value godot.TextureImplementer)
+	RimTint() gdnative.Real
+	Roughness() gdnative.Real
+	RoughnessTexture() godot.TextureImplementer
+	SetRoughnessTexture(value godot.TextureImplementer)
+	RoughnessTextureChannel() SpatialMaterialTextureChannel
+	SubsurfScatterEnabled() gdnative.Bool
+	SetSubsurfScatterEnabled(value gdnative.Bool)
+	SubsurfScatterStrength() gdnative.Real
+	SetSubsurfScatterStrength(value gdnative.Real)
+	SubsurfScatterTexture() godot.TextureImplementer
+	SetSubsurfScatterTexture(value godot.TextureImplementer)
+	Transmission() gdnative.Color
+	TransmissionEnabled() gdnative.Bool
+	SetTransmissionEnabled(value gdnative.Bool)
+	TransmissionTexture() godot.TextureImplementer
+	SetTransmissionTexture(value godot.TextureImplementer)
+	Uv1Offset() gdnative.Vector3
+	Uv1Scale() gdnative.Vector3
+	Uv1Triplanar() gdnative.Bool
+	SetUv1Triplanar(value gdnative.Bool)
+	Uv1TriplanarSharpness() gdnative.Real
+	SetUv1TriplanarSharpness(value gdnative.Real)
+	Uv2Offset() gdnative.Vector3
+	Uv2Scale() gdnative.Vector3
+	Uv2Triplanar() gdnative.Bool
+	SetUv2Triplanar(value gdnative.Bool)
+	Uv2TriplanarSharpness() gdnative.Real
+	SetUv2TriplanarSharpness(value gdnative.Real)
+	VertexColorIsSrgb() gdnative.Bool
+	SetVertexColorIsSrgb(value gdnative.Bool)
+	VertexColorUseAsAlbedo() gdnative.Bool
+	SetVertexColorUseAsAlbedo(value gdnative.Bool)
+}
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSpatialVelocityTrackerFromPointer(ptr gdnative.Pointer) SpatialVelocityTracker {
-func newSpatialVelocityTrackerFromPointer(ptr gdnative.Pointer) SpatialVelocityTracker {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SpatialVelocityTracker{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type SpatialVelocityTracker struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
+// classConstructorSpatialVelocityTracker is the class constructor of the SpatialVelocityTracker class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSpatialVelocityTracker = gdnative.NewLazyClassConstructor("SpatialVelocityTracker")
+
+// NewSpatialVelocityTracker will create a new instance of the SpatialVelocityTracker class.
+// SpatialVelocityTracker is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSpatialVelocityTracker() *SpatialVelocityTracker {
+	obj := &SpatialVelocityTracker{}
+	obj.SetBaseObject(classConstructorSpatialVelocityTracker.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SpatialVelocityTracker) BaseClass() string {
 	return "SpatialVelocityTracker"
 }
 
-/*
+// Method binds of the SpatialVelocityTracker class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSpatialVelocityTrackerGetTrackedLinearVelocity = gdnative.NewLazyMethodBind("SpatialVelocityTracker", "get_tracked_linear_velocity")
+	methodBindSpatialVelocityTrackerIsTrackingPhysicsStep    = gdnative.NewLazyMethodBind("SpatialVelocityTracker", "is_tracking_physics_step")
+	methodBindSpatialVelocityTrackerReset                    = gdnative.NewLazyMethodBind("SpatialVelocityTracker", "reset")
+	methodBindSpatialVelocityTrackerSetTrackPhysicsStep      = gdnative.NewLazyMethodBind("SpatialVelocityTracker", "set_track_physics_step")
+	methodBindSpatialVelocityTrackerUpdatePosition           = gdnative.NewLazyMethodBind("SpatialVelocityTracker", "update_position")
+)
 
-	Args: [], Returns: Vector3
+/*
+Args: [], Returns: Vector3
 */
 func (o *SpatialVelocityTracker) GetTrackedLinearVelocity() gdnative.Vector3 {
 	//log.Println("Calling SpatialVelocityTracker.GetTrackedLinearVelocity()")
@@ -45,7 +62,7 @@ func (o *SpatialVelocityTracker) GetTrackedLinearVelocity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpatialVelocityTracker", "get_tracked_linear_velocity")
+	methodBind := methodBindSpatialVelocityTrackerGetTrackedLinearVelocity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -58,8 +75,8 @@ func (o *SpatialVelocityTracker) GetTrackedLinearVelocity() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SpatialVelocityTracker) IsTrackingPhysicsStep() gdnative.Bool {
 	//log.Println("Calling SpatialVelocityTracker.IsTrackingPhysicsStep()")
@@ -68,7 +85,7 @@ func (o *SpatialVelocityTracker) IsTrackingPhysicsStep() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpatialVelocityTracker", "is_tracking_physics_step")
+	methodBind := methodBindSpatialVelocityTrackerIsTrackingPhysicsStep.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +98,7 @@ func (o *SpatialVelocityTracker) IsTrackingPhysicsStep() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false position Vector3}], Returns: void
+Args: [{ false position Vector3}], Returns: void
 */
 func (o *SpatialVelocityTracker) Reset(position gdnative.Vector3) {
 	//log.Println("Calling SpatialVelocityTracker.Reset()")
@@ -92,7 +108,7 @@ func (o *SpatialVelocityTracker) Reset(position gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpatialVelocityTracker", "reset")
+	methodBind := methodBindSpatialVelocityTrackerReset.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +118,8 @@ func (o *SpatialVelocityTracker) Reset(position gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *SpatialVelocityTracker) SetTrackPhysicsStep(enable gdnative.Bool) {
 	//log.Println("Calling SpatialVelocityTracker.SetTrackPhysicsStep()")
@@ -113,7 +129,7 @@ func (o *SpatialVelocityTracker) SetTrackPhysicsStep(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpatialVelocityTracker", "set_track_physics_step")
+	methodBind := methodBindSpatialVelocityTrackerSetTrackPhysicsStep.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +139,7 @@ func (o *SpatialVelocityTracker) SetTrackPhysicsStep(enable gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false position Vector3}], Returns: void
+Args: [{ false position Vector3}], Returns: void
 */
 func (o *SpatialVelocityTracker) UpdatePosition(position gdnative.Vector3) {
 	//log.Println("Calling SpatialVelocityTracker.UpdatePosition()")
@@ -134,7 +149,7 @@ func (o *SpatialVelocityTracker) UpdatePosition(position gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpatialVelocityTracker", "update_position")
+	methodBind := methodBindSpatialVelocityTrackerUpdatePosition.Get()
 
 	// Call the parent method.
 	// void
@@ -143,13 +158,19 @@ func (o *SpatialVelocityTracker) UpdatePosition(position gdnative.Vector3) {
 
 }
 
+// TrackPhysicsStep will return the value of the "track_physics_step" property.
+func (o *SpatialVelocityTracker) TrackPhysicsStep() gdnative.Bool {
+	return o.IsTrackingPhysicsStep()
+}
+
 // SpatialVelocityTrackerImplementer is an interface that implements the methods
 // of the SpatialVelocityTracker class.
 type SpatialVelocityTrackerImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	GetTrackedLinearVelocity() gdnative.Vector3
 	IsTrackingPhysicsStep() gdnative.Bool
 	Reset(position gdnative.Vector3)
 	SetTrackPhysicsStep(enable gdnative.Bool)
 	UpdatePosition(position gdnative.Vector3)
+	TrackPhysicsStep() gdnative.Bool
 }
@@ -0,0 +1,95 @@
+package spatial
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+A SpotLight light is a type of [Light] node that emits lights in a specific direction, in the shape of a cone. The light is attenuated through the distance and this attenuation can be configured by changing the energy, radius and attenuation parameters of [Light]. TODO: Image of a spotlight.
+*/
+type SpotLight struct {
+	Light
+	owner gdnative.Object
+}
+
+// classConstructorSpotLight is the class constructor of the SpotLight class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSpotLight = gdnative.NewLazyClassConstructor("SpotLight")
+
+// NewSpotLight will create a new instance of the SpotLight class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSpotLight() *SpotLight {
+	obj := &SpotLight{}
+	obj.SetBaseObject(classConstructorSpotLight.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *SpotLight) BaseClass() string {
+	return "SpotLight"
+}
+
+// SpotAngle will return the value of the "spot_angle" property.
+func (o *SpotLight) SpotAngle() gdnative.Real {
+	return o.GetParam(5)
+}
+
+// SetSpotAngle will set the value of the "spot_angle" property.
+func (o *SpotLight) SetSpotAngle(value gdnative.Real) {
+	o.SetParam(5, value)
+}
+
+// SpotAngleAttenuation will return the value of the "spot_angle_attenuation" property.
+func (o *SpotLight) SpotAngleAttenuation() gdnative.Real {
+	return o.GetParam(6)
+}
+
+// SetSpotAngleAttenuation will set the value of the "spot_angle_attenuation" property.
+func (o *SpotLight) SetSpotAngleAttenuation(value gdnative.Real) {
+	o.SetParam(6, value)
+}
+
+// SpotAttenuation will return the value of the "spot_attenuation" property.
+func (o *SpotLight) SpotAttenuation() gdnative.Real {
+	return o.GetParam(4)
+}
+
+// SetSpotAttenuation will set the value of the "spot_attenuation" property.
+func (o *SpotLight) SetSpotAttenuation(value gdnative.Real) {
+	o.SetParam(4, value)
+}
+
+// SpotRange will return the value of the "spot_range" property.
+func (o *SpotLight) SpotRange() gdnative.Real {
+	return o.GetParam(3)
+}
+
+// SetSpotRange will set the value of the "spot_range" property.
+func (o *SpotLight) SetSpotRange(value gdnative.Real) {
+	o.SetParam(3, value)
+}
+
+// SpotLightImplementer is an interface that implements the methods
+// of the SpotLight class.
+type SpotLightImplementer interface {
+	LightImplementer
+	SpotAngle() gdnative.Real
+	SetSpotAngle(value gdnative.Real)
+	SpotAngleAttenuation() gdnative.Real
+	SetSpotAngleAttenuation(value gdnative.Real)
+	SpotAttenuation() gdnative.Real
+	SetSpotAttenuation(value gdnative.Real)
+	SpotRange() gdnative.Real
+	SetSpotRange(value gdnative.Real)
+}
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSprite3DFromPointer(ptr gdnative.Pointer) Sprite3D {
-func newSprite3DFromPointer(ptr gdnative.Pointer) Sprite3D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Sprite3D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 A node that displays a 2D texture in a 3D environment. The texture displayed can be a region from a larger atlas texture, or a frame from a sprite sheet animation.
 */
@@ -30,13 +22,44 @@ type Sprite3D struct {
 	owner gdnative.Object
 }
 
+// classConstructorSprite3D is the class constructor of the Sprite3D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSprite3D = gdnative.NewLazyClassConstructor("Sprite3D")
+
+// NewSprite3D will create a new instance of the Sprite3D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewSprite3D() *Sprite3D {
+	obj := &Sprite3D{}
+	obj.SetBaseObject(classConstructorSprite3D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Sprite3D) BaseClass() string {
 	return "Sprite3D"
 }
 
+// Method binds of the Sprite3D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSprite3DGetFrame      = gdnative.NewLazyMethodBind("Sprite3D", "get_frame")
+	methodBindSprite3DGetHframes    = gdnative.NewLazyMethodBind("Sprite3D", "get_hframes")
+	methodBindSprite3DGetRegionRect = gdnative.NewLazyMethodBind("Sprite3D", "get_region_rect")
+	methodBindSprite3DGetTexture    = gdnative.NewLazyMethodBind("Sprite3D", "get_texture")
+	methodBindSprite3DGetVframes    = gdnative.NewLazyMethodBind("Sprite3D", "get_vframes")
+	methodBindSprite3DIsRegion      = gdnative.NewLazyMethodBind("Sprite3D", "is_region")
+	methodBindSprite3DSetFrame      = gdnative.NewLazyMethodBind("Sprite3D", "set_frame")
+	methodBindSprite3DSetHframes    = gdnative.NewLazyMethodBind("Sprite3D", "set_hframes")
+	methodBindSprite3DSetRegion     = gdnative.NewLazyMethodBind("Sprite3D", "set_region")
+	methodBindSprite3DSetRegionRect = gdnative.NewLazyMethodBind("Sprite3D", "set_region_rect")
+	methodBindSprite3DSetTexture    = gdnative.NewLazyMethodBind("Sprite3D", "set_texture")
+	methodBindSprite3DSetVframes    = gdnative.NewLazyMethodBind("Sprite3D", "set_vframes")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Sprite3D) GetFrame() gdnative.Int {
 	//log.Println("Calling Sprite3D.GetFrame()")
@@ -45,7 +68,7 @@ func (o *Sprite3D) GetFrame() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "get_frame")
+	methodBind := methodBindSprite3DGetFrame.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +81,8 @@ func (o *Sprite3D) GetFrame() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Sprite3D) GetHframes() gdnative.Int {
 	//log.Println("Calling Sprite3D.GetHframes()")
@@ -68,7 +91,7 @@ func (o *Sprite3D) GetHframes() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "get_hframes")
+	methodBind := methodBindSprite3DGetHframes.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +104,8 @@ func (o *Sprite3D) GetHframes() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Rect2
+	        Undocumented
+		Args: [], Returns: Rect2
 */
 func (o *Sprite3D) GetRegionRect() gdnative.Rect2 {
 	//log.Println("Calling Sprite3D.GetRegionRect()")
@@ -91,7 +114,7 @@ func (o *Sprite3D) GetRegionRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "get_region_rect")
+	methodBind := methodBindSprite3DGetRegionRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -104,17 +127,17 @@ func (o *Sprite3D) GetRegionRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Texture
+	        Undocumented
+		Args: [], Returns: Texture
 */
-func (o *Sprite3D) GetTexture() TextureImplementer {
+func (o *Sprite3D) GetTexture() godot.TextureImplementer {
 	//log.Println("Calling Sprite3D.GetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "get_texture")
+	methodBind := methodBindSprite3DGetTexture.Get()
 
 	// Call the parent method.
 	// Texture
@@ -122,27 +145,25 @@ func (o *Sprite3D) GetTexture() TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Sprite3D) GetVframes() gdnative.Int {
 	//log.Println("Calling Sprite3D.GetVframes()")
@@ -151,7 +172,7 @@ func (o *Sprite3D) GetVframes() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "get_vframes")
+	methodBind := methodBindSprite3DGetVframes.Get()
 
 	// Call the parent method.
 	// int
@@ -164,8 +185,8 @@ func (o *Sprite3D) GetVframes() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Sprite3D) IsRegion() gdnative.Bool {
 	//log.Println("Calling Sprite3D.IsRegion()")
@@ -174,7 +195,7 @@ func (o *Sprite3D) IsRegion() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "is_region")
+	methodBind := methodBindSprite3DIsRegion.Get()
 
 	// Call the parent method.
 	// bool
@@ -187,8 +208,8 @@ func (o *Sprite3D) IsRegion() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false frame int}], Returns: void
+	        Undocumented
+		Args: [{ false frame int}], Returns: void
 */
 func (o *Sprite3D) SetFrame(frame gdnative.Int) {
 	//log.Println("Calling Sprite3D.SetFrame()")
@@ -198,7 +219,7 @@ func (o *Sprite3D) SetFrame(frame gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(frame)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "set_frame")
+	methodBind := methodBindSprite3DSetFrame.Get()
 
 	// Call the parent method.
 	// void
@@ -208,8 +229,8 @@ func (o *Sprite3D) SetFrame(frame gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false hframes int}], Returns: void
+	        Undocumented
+		Args: [{ false hframes int}], Returns: void
 */
 func (o *Sprite3D) SetHframes(hframes gdnative.Int) {
 	//log.Println("Calling Sprite3D.SetHframes()")
@@ -219,7 +240,7 @@ func (o *Sprite3D) SetHframes(hframes gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(hframes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "set_hframes")
+	methodBind := methodBindSprite3DSetHframes.Get()
 
 	// Call the parent method.
 	// void
@@ -229,8 +250,8 @@ func (o *Sprite3D) SetHframes(hframes gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Sprite3D) SetRegion(enabled gdnative.Bool) {
 	//log.Println("Calling Sprite3D.SetRegion()")
@@ -240,7 +261,7 @@ func (o *Sprite3D) SetRegion(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "set_region")
+	methodBind := methodBindSprite3DSetRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -250,8 +271,8 @@ func (o *Sprite3D) SetRegion(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false rect Rect2}], Returns: void
+	        Undocumented
+		Args: [{ false rect Rect2}], Returns: void
 */
 func (o *Sprite3D) SetRegionRect(rect gdnative.Rect2) {
 	//log.Println("Calling Sprite3D.SetRegionRect()")
@@ -261,7 +282,7 @@ func (o *Sprite3D) SetRegionRect(rect gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "set_region_rect")
+	methodBind := methodBindSprite3DSetRegionRect.Get()
 
 	// Call the parent method.
 	// void
@@ -271,18 +292,18 @@ func (o *Sprite3D) SetRegionRect(rect gdnative.Rect2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false texture Texture}], Returns: void
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
 */
-func (o *Sprite3D) SetTexture(texture TextureImplementer) {
+func (o *Sprite3D) SetTexture(texture godot.TextureImplementer) {
 	//log.Println("Calling Sprite3D.SetTexture()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "set_texture")
+	methodBind := methodBindSprite3DSetTexture.Get()
 
 	// Call the parent method.
 	// void
@@ -292,8 +313,8 @@ func (o *Sprite3D) SetTexture(texture TextureImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vframes int}], Returns: void
+	        Undocumented
+		Args: [{ false vframes int}], Returns: void
 */
 func (o *Sprite3D) SetVframes(vframes gdnative.Int) {
 	//log.Println("Calling Sprite3D.SetVframes()")
@@ -303,7 +324,7 @@ func (o *Sprite3D) SetVframes(vframes gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(vframes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Sprite3D", "set_vframes")
+	methodBind := methodBindSprite3DSetVframes.Get()
 
 	// Call the parent method.
 	// void
@@ -312,6 +333,62 @@ func (o *Sprite3D) SetVframes(vframes gdnative.Int) {
 
 }
 
+// Frame will return the value of the "frame" property.
+func (o *Sprite3D) Frame() gdnative.Int {
+	return o.GetFrame()
+}
+
+// Hframes will return the value of the "hframes" property.
+func (o *Sprite3D) Hframes() gdnative.Int {
+	return o.GetHframes()
+}
+
+// RegionEnabled will return the value of the "region_enabled" property.
+func (o *Sprite3D) RegionEnabled() gdnative.Bool {
+	return o.IsRegion()
+}
+
+// SetRegionEnabled will set the value of the "region_enabled" property.
+func (o *Sprite3D) SetRegionEnabled(value gdnative.Bool) {
+	o.SetRegion(value)
+}
+
+// RegionRect will return the value of the "region_rect" property.
+func (o *Sprite3D) RegionRect() gdnative.Rect2 {
+	return o.GetRegionRect()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *Sprite3D) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// Vframes will return the value of the "vframes" property.
+func (o *Sprite3D) Vframes() gdnative.Int {
+	return o.GetVframes()
+}
+
+// Signals of the Sprite3D class.
+const (
+	Sprite3DSignalFrameChanged gdnative.String = "frame_changed"
+)
+
+// ConnectFrameChanged will connect the "frame_changed" signal to the given method of the target object.
+func (o *Sprite3D) ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(Sprite3DSignalFrameChanged, target, method, binds, 0)
+}
+
+// EmitFrameChanged will emit the "frame_changed" signal.
+func (o *Sprite3D) EmitFrameChanged() error {
+
+	ret, err := o.EmitSignal(Sprite3DSignalFrameChanged)
+	ret.Destroy()
+	return err
+}
+
 // Sprite3DImplementer is an interface that implements the methods
 // of the Sprite3D class.
 type Sprite3DImplementer interface {
@@ -319,13 +396,22 @@ type Sprite3DImplementer interface {
 	GetFrame() gdnative.Int
 	GetHframes() gdnative.Int
 	GetRegionRect() gdnative.Rect2
-	GetTexture() TextureImplementer
+	GetTexture() godot.TextureImplementer
 	GetVframes() gdnative.Int
 	IsRegion() gdnative.Bool
 	SetFrame(frame gdnative.Int)
 	SetHframes(hframes gdnative.Int)
 	SetRegion(enabled gdnative.Bool)
 	SetRegionRect(rect gdnative.Rect2)
-	SetTexture(texture TextureImplementer)
+	SetTexture(texture godot.TextureImplementer)
 	SetVframes(vframes gdnative.Int)
+	Frame() gdnative.Int
+	Hframes() gdnative.Int
+	RegionEnabled() gdnative.Bool
+	SetRegionEnabled(value gdnative.Bool)
+	RegionRect() gdnative.Rect2
+	Texture() godot.TextureImplementer
+	Vframes() gdnative.Int
+	ConnectFrameChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitFrameChanged() error
 }
@@ -1,6 +1,8 @@
-package godot
+package spatial
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,6 +24,32 @@ const (
 	SpriteBase3DAlphaCutOpaquePrepass SpriteBase3DAlphaCutMode = 2
 )
 
+// String will return the Godot name of the SpriteBase3DAlphaCutMode value.
+func (e SpriteBase3DAlphaCutMode) String() string {
+	switch e {
+	case SpriteBase3DAlphaCutDisabled:
+		return "ALPHA_CUT_DISABLED"
+	case SpriteBase3DAlphaCutDiscard:
+		return "ALPHA_CUT_DISCARD"
+	case SpriteBase3DAlphaCutOpaquePrepass:
+		return "ALPHA_CUT_OPAQUE_PREPASS"
+	}
+	return fmt.Sprintf("SpriteBase3DAlphaCutMode(%d)", int(e))
+}
+
+// ParseSpriteBase3DAlphaCutMode will return the SpriteBase3DAlphaCutMode value with the given Godot name.
+func ParseSpriteBase3DAlphaCutMode(name string) (SpriteBase3DAlphaCutMode, error) {
+	switch name {
+	case "ALPHA_CUT_DISABLED":
+		return SpriteBase3DAlphaCutDisabled, nil
+	case "ALPHA_CUT_DISCARD":
+		return SpriteBase3DAlphaCutDiscard, nil
+	case "ALPHA_CUT_OPAQUE_PREPASS":
+		return SpriteBase3DAlphaCutOpaquePrepass, nil
+	}
+	return 0, fmt.Errorf("invalid SpriteBase3DAlphaCutMode value %q", name)
+}
+
 // SpriteBase3DDrawFlags is an enum for DrawFlags values.
 type SpriteBase3DDrawFlags int
 
@@ -32,13 +60,34 @@ const (
 	SpriteBase3DFlagTransparent SpriteBase3DDrawFlags = 0
 )
 
-//func NewSpriteBase3DFromPointer(ptr gdnative.Pointer) SpriteBase3D {
-func newSpriteBase3DFromPointer(ptr gdnative.Pointer) SpriteBase3D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SpriteBase3D{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the SpriteBase3DDrawFlags value.
+func (e SpriteBase3DDrawFlags) String() string {
+	switch e {
+	case SpriteBase3DFlagDoubleSided:
+		return "FLAG_DOUBLE_SIDED"
+	case SpriteBase3DFlagMax:
+		return "FLAG_MAX"
+	case SpriteBase3DFlagShaded:
+		return "FLAG_SHADED"
+	case SpriteBase3DFlagTransparent:
+		return "FLAG_TRANSPARENT"
+	}
+	return fmt.Sprintf("SpriteBase3DDrawFlags(%d)", int(e))
+}
 
-	return obj
+// ParseSpriteBase3DDrawFlags will return the SpriteBase3DDrawFlags value with the given Godot name.
+func ParseSpriteBase3DDrawFlags(name string) (SpriteBase3DDrawFlags, error) {
+	switch name {
+	case "FLAG_DOUBLE_SIDED":
+		return SpriteBase3DFlagDoubleSided, nil
+	case "FLAG_MAX":
+		return SpriteBase3DFlagMax, nil
+	case "FLAG_SHADED":
+		return SpriteBase3DFlagShaded, nil
+	case "FLAG_TRANSPARENT":
+		return SpriteBase3DFlagTransparent, nil
+	}
+	return 0, fmt.Errorf("invalid SpriteBase3DDrawFlags value %q", name)
 }
 
 /*
@@ -53,9 +102,37 @@ func (o *SpriteBase3D) BaseClass() string {
 	return "SpriteBase3D"
 }
 
+// Method binds of the SpriteBase3D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSpriteBase3DX_ImUpdate      = gdnative.NewLazyMethodBind("SpriteBase3D", "_im_update")
+	methodBindSpriteBase3DX_QueueUpdate   = gdnative.NewLazyMethodBind("SpriteBase3D", "_queue_update")
+	methodBindSpriteBase3DGetAlphaCutMode = gdnative.NewLazyMethodBind("SpriteBase3D", "get_alpha_cut_mode")
+	methodBindSpriteBase3DGetAxis         = gdnative.NewLazyMethodBind("SpriteBase3D", "get_axis")
+	methodBindSpriteBase3DGetDrawFlag     = gdnative.NewLazyMethodBind("SpriteBase3D", "get_draw_flag")
+	methodBindSpriteBase3DGetItemRect     = gdnative.NewLazyMethodBind("SpriteBase3D", "get_item_rect")
+	methodBindSpriteBase3DGetModulate     = gdnative.NewLazyMethodBind("SpriteBase3D", "get_modulate")
+	methodBindSpriteBase3DGetOffset       = gdnative.NewLazyMethodBind("SpriteBase3D", "get_offset")
+	methodBindSpriteBase3DGetOpacity      = gdnative.NewLazyMethodBind("SpriteBase3D", "get_opacity")
+	methodBindSpriteBase3DGetPixelSize    = gdnative.NewLazyMethodBind("SpriteBase3D", "get_pixel_size")
+	methodBindSpriteBase3DIsCentered      = gdnative.NewLazyMethodBind("SpriteBase3D", "is_centered")
+	methodBindSpriteBase3DIsFlippedH      = gdnative.NewLazyMethodBind("SpriteBase3D", "is_flipped_h")
+	methodBindSpriteBase3DIsFlippedV      = gdnative.NewLazyMethodBind("SpriteBase3D", "is_flipped_v")
+	methodBindSpriteBase3DSetAlphaCutMode = gdnative.NewLazyMethodBind("SpriteBase3D", "set_alpha_cut_mode")
+	methodBindSpriteBase3DSetAxis         = gdnative.NewLazyMethodBind("SpriteBase3D", "set_axis")
+	methodBindSpriteBase3DSetCentered     = gdnative.NewLazyMethodBind("SpriteBase3D", "set_centered")
+	methodBindSpriteBase3DSetDrawFlag     = gdnative.NewLazyMethodBind("SpriteBase3D", "set_draw_flag")
+	methodBindSpriteBase3DSetFlipH        = gdnative.NewLazyMethodBind("SpriteBase3D", "set_flip_h")
+	methodBindSpriteBase3DSetFlipV        = gdnative.NewLazyMethodBind("SpriteBase3D", "set_flip_v")
+	methodBindSpriteBase3DSetModulate     = gdnative.NewLazyMethodBind("SpriteBase3D", "set_modulate")
+	methodBindSpriteBase3DSetOffset       = gdnative.NewLazyMethodBind("SpriteBase3D", "set_offset")
+	methodBindSpriteBase3DSetOpacity      = gdnative.NewLazyMethodBind("SpriteBase3D", "set_opacity")
+	methodBindSpriteBase3DSetPixelSize    = gdnative.NewLazyMethodBind("SpriteBase3D", "set_pixel_size")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *SpriteBase3D) X_ImUpdate() {
 	//log.Println("Calling SpriteBase3D.X_ImUpdate()")
@@ -64,7 +141,7 @@ func (o *SpriteBase3D) X_ImUpdate() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "_im_update")
+	methodBind := methodBindSpriteBase3DX_ImUpdate.Get()
 
 	// Call the parent method.
 	// void
@@ -74,8 +151,8 @@ func (o *SpriteBase3D) X_ImUpdate() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *SpriteBase3D) X_QueueUpdate() {
 	//log.Println("Calling SpriteBase3D.X_QueueUpdate()")
@@ -84,7 +161,7 @@ func (o *SpriteBase3D) X_QueueUpdate() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "_queue_update")
+	methodBind := methodBindSpriteBase3DX_QueueUpdate.Get()
 
 	// Call the parent method.
 	// void
@@ -94,8 +171,8 @@ func (o *SpriteBase3D) X_QueueUpdate() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.SpriteBase3D::AlphaCutMode
+	        Undocumented
+		Args: [], Returns: enum.SpriteBase3D::AlphaCutMode
 */
 func (o *SpriteBase3D) GetAlphaCutMode() SpriteBase3DAlphaCutMode {
 	//log.Println("Calling SpriteBase3D.GetAlphaCutMode()")
@@ -104,7 +181,7 @@ func (o *SpriteBase3D) GetAlphaCutMode() SpriteBase3DAlphaCutMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_alpha_cut_mode")
+	methodBind := methodBindSpriteBase3DGetAlphaCutMode.Get()
 
 	// Call the parent method.
 	// enum.SpriteBase3D::AlphaCutMode
@@ -117,8 +194,8 @@ func (o *SpriteBase3D) GetAlphaCutMode() SpriteBase3DAlphaCutMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Vector3::Axis
+	        Undocumented
+		Args: [], Returns: enum.Vector3::Axis
 */
 func (o *SpriteBase3D) GetAxis() gdnative.Vector3Axis {
 	//log.Println("Calling SpriteBase3D.GetAxis()")
@@ -127,7 +204,7 @@ func (o *SpriteBase3D) GetAxis() gdnative.Vector3Axis {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_axis")
+	methodBind := methodBindSpriteBase3DGetAxis.Get()
 
 	// Call the parent method.
 	// enum.Vector3::Axis
@@ -140,8 +217,8 @@ func (o *SpriteBase3D) GetAxis() gdnative.Vector3Axis {
 }
 
 /*
-        Undocumented
-	Args: [{ false flag int}], Returns: bool
+	        Undocumented
+		Args: [{ false flag int}], Returns: bool
 */
 func (o *SpriteBase3D) GetDrawFlag(flag gdnative.Int) gdnative.Bool {
 	//log.Println("Calling SpriteBase3D.GetDrawFlag()")
@@ -151,7 +228,7 @@ func (o *SpriteBase3D) GetDrawFlag(flag gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(flag)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_draw_flag")
+	methodBind := methodBindSpriteBase3DGetDrawFlag.Get()
 
 	// Call the parent method.
 	// bool
@@ -164,8 +241,7 @@ func (o *SpriteBase3D) GetDrawFlag(flag gdnative.Int) gdnative.Bool {
 }
 
 /*
-
-	Args: [], Returns: Rect2
+Args: [], Returns: Rect2
 */
 func (o *SpriteBase3D) GetItemRect() gdnative.Rect2 {
 	//log.Println("Calling SpriteBase3D.GetItemRect()")
@@ -174,7 +250,7 @@ func (o *SpriteBase3D) GetItemRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_item_rect")
+	methodBind := methodBindSpriteBase3DGetItemRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -187,8 +263,8 @@ func (o *SpriteBase3D) GetItemRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *SpriteBase3D) GetModulate() gdnative.Color {
 	//log.Println("Calling SpriteBase3D.GetModulate()")
@@ -197,7 +273,7 @@ func (o *SpriteBase3D) GetModulate() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_modulate")
+	methodBind := methodBindSpriteBase3DGetModulate.Get()
 
 	// Call the parent method.
 	// Color
@@ -210,8 +286,8 @@ func (o *SpriteBase3D) GetModulate() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *SpriteBase3D) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling SpriteBase3D.GetOffset()")
@@ -220,7 +296,7 @@ func (o *SpriteBase3D) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_offset")
+	methodBind := methodBindSpriteBase3DGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -233,8 +309,8 @@ func (o *SpriteBase3D) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *SpriteBase3D) GetOpacity() gdnative.Real {
 	//log.Println("Calling SpriteBase3D.GetOpacity()")
@@ -243,7 +319,7 @@ func (o *SpriteBase3D) GetOpacity() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_opacity")
+	methodBind := methodBindSpriteBase3DGetOpacity.Get()
 
 	// Call the parent method.
 	// float
@@ -256,8 +332,8 @@ func (o *SpriteBase3D) GetOpacity() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *SpriteBase3D) GetPixelSize() gdnative.Real {
 	//log.Println("Calling SpriteBase3D.GetPixelSize()")
@@ -266,7 +342,7 @@ func (o *SpriteBase3D) GetPixelSize() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "get_pixel_size")
+	methodBind := methodBindSpriteBase3DGetPixelSize.Get()
 
 	// Call the parent method.
 	// float
@@ -279,8 +355,8 @@ func (o *SpriteBase3D) GetPixelSize() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SpriteBase3D) IsCentered() gdnative.Bool {
 	//log.Println("Calling SpriteBase3D.IsCentered()")
@@ -289,7 +365,7 @@ func (o *SpriteBase3D) IsCentered() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "is_centered")
+	methodBind := methodBindSpriteBase3DIsCentered.Get()
 
 	// Call the parent method.
 	// bool
@@ -302,8 +378,8 @@ func (o *SpriteBase3D) IsCentered() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SpriteBase3D) IsFlippedH() gdnative.Bool {
 	//log.Println("Calling SpriteBase3D.IsFlippedH()")
@@ -312,7 +388,7 @@ func (o *SpriteBase3D) IsFlippedH() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "is_flipped_h")
+	methodBind := methodBindSpriteBase3DIsFlippedH.Get()
 
 	// Call the parent method.
 	// bool
@@ -325,8 +401,8 @@ func (o *SpriteBase3D) IsFlippedH() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *SpriteBase3D) IsFlippedV() gdnative.Bool {
 	//log.Println("Calling SpriteBase3D.IsFlippedV()")
@@ -335,7 +411,7 @@ func (o *SpriteBase3D) IsFlippedV() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "is_flipped_v")
+	methodBind := methodBindSpriteBase3DIsFlippedV.Get()
 
 	// Call the parent method.
 	// bool
@@ -348,18 +424,18 @@ func (o *SpriteBase3D) IsFlippedV() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.SpriteBase3D::AlphaCutMode}], Returns: void
 */
-func (o *SpriteBase3D) SetAlphaCutMode(mode gdnative.Int) {
+func (o *SpriteBase3D) SetAlphaCutMode(mode SpriteBase3DAlphaCutMode) {
 	//log.Println("Calling SpriteBase3D.SetAlphaCutMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_alpha_cut_mode")
+	methodBind := methodBindSpriteBase3DSetAlphaCutMode.Get()
 
 	// Call the parent method.
 	// void
@@ -369,18 +445,18 @@ func (o *SpriteBase3D) SetAlphaCutMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false axis int}], Returns: void
+	        Undocumented
+		Args: [{ false axis enum.Vector3::Axis}], Returns: void
 */
-func (o *SpriteBase3D) SetAxis(axis gdnative.Int) {
+func (o *SpriteBase3D) SetAxis(axis gdnative.Vector3Axis) {
 	//log.Println("Calling SpriteBase3D.SetAxis()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(axis)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(axis))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_axis")
+	methodBind := methodBindSpriteBase3DSetAxis.Get()
 
 	// Call the parent method.
 	// void
@@ -390,8 +466,8 @@ func (o *SpriteBase3D) SetAxis(axis gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false centered bool}], Returns: void
+	        Undocumented
+		Args: [{ false centered bool}], Returns: void
 */
 func (o *SpriteBase3D) SetCentered(centered gdnative.Bool) {
 	//log.Println("Calling SpriteBase3D.SetCentered()")
@@ -401,7 +477,7 @@ func (o *SpriteBase3D) SetCentered(centered gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(centered)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_centered")
+	methodBind := methodBindSpriteBase3DSetCentered.Get()
 
 	// Call the parent method.
 	// void
@@ -411,8 +487,8 @@ func (o *SpriteBase3D) SetCentered(centered gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flag int} { false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false flag int} { false enabled bool}], Returns: void
 */
 func (o *SpriteBase3D) SetDrawFlag(flag gdnative.Int, enabled gdnative.Bool) {
 	//log.Println("Calling SpriteBase3D.SetDrawFlag()")
@@ -423,7 +499,7 @@ func (o *SpriteBase3D) SetDrawFlag(flag gdnative.Int, enabled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_draw_flag")
+	methodBind := methodBindSpriteBase3DSetDrawFlag.Get()
 
 	// Call the parent method.
 	// void
@@ -433,8 +509,8 @@ func (o *SpriteBase3D) SetDrawFlag(flag gdnative.Int, enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flip_h bool}], Returns: void
+	        Undocumented
+		Args: [{ false flip_h bool}], Returns: void
 */
 func (o *SpriteBase3D) SetFlipH(flipH gdnative.Bool) {
 	//log.Println("Calling SpriteBase3D.SetFlipH()")
@@ -444,7 +520,7 @@ func (o *SpriteBase3D) SetFlipH(flipH gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(flipH)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_flip_h")
+	methodBind := methodBindSpriteBase3DSetFlipH.Get()
 
 	// Call the parent method.
 	// void
@@ -454,8 +530,8 @@ func (o *SpriteBase3D) SetFlipH(flipH gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false flip_v bool}], Returns: void
+	        Undocumented
+		Args: [{ false flip_v bool}], Returns: void
 */
 func (o *SpriteBase3D) SetFlipV(flipV gdnative.Bool) {
 	//log.Println("Calling SpriteBase3D.SetFlipV()")
@@ -465,7 +541,7 @@ func (o *SpriteBase3D) SetFlipV(flipV gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(flipV)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_flip_v")
+	methodBind := methodBindSpriteBase3DSetFlipV.Get()
 
 	// Call the parent method.
 	// void
@@ -475,8 +551,8 @@ func (o *SpriteBase3D) SetFlipV(flipV gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false modulate Color}], Returns: void
+	        Undocumented
+		Args: [{ false modulate Color}], Returns: void
 */
 func (o *SpriteBase3D) SetModulate(modulate gdnative.Color) {
 	//log.Println("Calling SpriteBase3D.SetModulate()")
@@ -486,7 +562,7 @@ func (o *SpriteBase3D) SetModulate(modulate gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(modulate)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_modulate")
+	methodBind := methodBindSpriteBase3DSetModulate.Get()
 
 	// Call the parent method.
 	// void
@@ -496,8 +572,8 @@ func (o *SpriteBase3D) SetModulate(modulate gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false offset Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false offset Vector2}], Returns: void
 */
 func (o *SpriteBase3D) SetOffset(offset gdnative.Vector2) {
 	//log.Println("Calling SpriteBase3D.SetOffset()")
@@ -507,7 +583,7 @@ func (o *SpriteBase3D) SetOffset(offset gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_offset")
+	methodBind := methodBindSpriteBase3DSetOffset.Get()
 
 	// Call the parent method.
 	// void
@@ -517,8 +593,8 @@ func (o *SpriteBase3D) SetOffset(offset gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false opacity float}], Returns: void
+	        Undocumented
+		Args: [{ false opacity float}], Returns: void
 */
 func (o *SpriteBase3D) SetOpacity(opacity gdnative.Real) {
 	//log.Println("Calling SpriteBase3D.SetOpacity()")
@@ -528,7 +604,7 @@ func (o *SpriteBase3D) SetOpacity(opacity gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(opacity)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_opacity")
+	methodBind := methodBindSpriteBase3DSetOpacity.Get()
 
 	// Call the parent method.
 	// void
@@ -538,8 +614,8 @@ func (o *SpriteBase3D) SetOpacity(opacity gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false pixel_size float}], Returns: void
+	        Undocumented
+		Args: [{ false pixel_size float}], Returns: void
 */
 func (o *SpriteBase3D) SetPixelSize(pixelSize gdnative.Real) {
 	//log.Println("Calling SpriteBase3D.SetPixelSize()")
@@ -549,7 +625,7 @@ func (o *SpriteBase3D) SetPixelSize(pixelSize gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(pixelSize)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteBase3D", "set_pixel_size")
+	methodBind := methodBindSpriteBase3DSetPixelSize.Get()
 
 	// Call the parent method.
 	// void
@@ -558,12 +634,94 @@ func (o *SpriteBase3D) SetPixelSize(pixelSize gdnative.Real) {
 
 }
 
+// AlphaCut will return the value of the "alpha_cut" property.
+func (o *SpriteBase3D) AlphaCut() SpriteBase3DAlphaCutMode {
+	return o.GetAlphaCutMode()
+}
+
+// SetAlphaCut will set the value of the "alpha_cut" property.
+func (o *SpriteBase3D) SetAlphaCut(value SpriteBase3DAlphaCutMode) {
+	o.SetAlphaCutMode(value)
+}
+
+// Axis will return the value of the "axis" property.
+func (o *SpriteBase3D) Axis() gdnative.Vector3Axis {
+	return o.GetAxis()
+}
+
+// Centered will return the value of the "centered" property.
+func (o *SpriteBase3D) Centered() gdnative.Bool {
+	return o.IsCentered()
+}
+
+// DoubleSided will return the value of the "double_sided" property.
+func (o *SpriteBase3D) DoubleSided() gdnative.Bool {
+	return o.GetDrawFlag(2)
+}
+
+// SetDoubleSided will set the value of the "double_sided" property.
+func (o *SpriteBase3D) SetDoubleSided(value gdnative.Bool) {
+	o.SetDrawFlag(2, value)
+}
+
+// FlipH will return the value of the "flip_h" property.
+func (o *SpriteBase3D) FlipH() gdnative.Bool {
+	return o.IsFlippedH()
+}
+
+// FlipV will return the value of the "flip_v" property.
+func (o *SpriteBase3D) FlipV() gdnative.Bool {
+	return o.IsFlippedV()
+}
+
+// Modulate will return the value of the "modulate" property.
+func (o *SpriteBase3D) Modulate() gdnative.Color {
+	return o.GetModulate()
+}
+
+// Offset will return the value of the "offset" property.
+func (o *SpriteBase3D) Offset() gdnative.Vector2 {
+	return o.GetOffset()
+}
+
+// Opacity will return the value of the "opacity" property.
+func (o *SpriteBase3D) Opacity() gdnative.Real {
+	return o.GetOpacity()
+}
+
+// PixelSize will return the value of the "pixel_size" property.
+func (o *SpriteBase3D) PixelSize() gdnative.Real {
+	return o.GetPixelSize()
+}
+
+// Shaded will return the value of the "shaded" property.
+func (o *SpriteBase3D) Shaded() gdnative.Bool {
+	return o.GetDrawFlag(1)
+}
+
+// SetShaded will set the value of the "shaded" property.
+func (o *SpriteBase3D) SetShaded(value gdnative.Bool) {
+	o.SetDrawFlag(1, value)
+}
+
+// Transparent will return the value of the "transparent" property.
+func (o *SpriteBase3D) Transparent() gdnative.Bool {
+	return o.GetDrawFlag(0)
+}
+
+// SetTransparent will set the value of the "transparent" property.
+func (o *SpriteBase3D) SetTransparent(value gdnative.Bool) {
+	o.SetDrawFlag(0, value)
+}
+
 // SpriteBase3DImplementer is an interface that implements the methods
 // of the SpriteBase3D class.
 type SpriteBase3DImplementer interface {
 	GeometryInstanceImplementer
 	X_ImUpdate()
 	X_QueueUpdate()
+	GetAlphaCutMode() SpriteBase3DAlphaCutMode
+	GetAxis() gdnative.Vector3Axis
 	GetDrawFlag(flag gdnative.Int) gdnative.Bool
 	GetItemRect() gdnative.Rect2
 	GetModulate() gdnative.Color
@@ -573,8 +731,8 @@ type SpriteBase3DImplementer interface {
 	IsCentered() gdnative.Bool
 	IsFlippedH() gdnative.Bool
 	IsFlippedV() gdnative.Bool
-	SetAlphaCutMode(mode gdnative.Int)
-	SetAxis(axis gdnative.Int)
+	SetAlphaCutMode(mode SpriteBase3DAlphaCutMode)
+	SetAxis(axis gdnative.Vector3Axis)
 	SetCentered(centered gdnative.Bool)
 	SetDrawFlag(flag gdnative.Int, enabled gdnative.Bool)
 	SetFlipH(flipH gdnative.Bool)
@@ -583,4 +741,20 @@ type SpriteBase3DImplementer interface {
 	SetOffset(offset gdnative.Vector2)
 	SetOpacity(opacity gdnative.Real)
 	SetPixelSize(pixelSize gdnative.Real)
+	AlphaCut() SpriteBase3DAlphaCutMode
+	SetAlphaCut(value SpriteBase3DAlphaCutMode)
+	Axis() gdnative.Vector3Axis
+	Centered() gdnative.Bool
+	DoubleSided() gdnative.Bool
+	SetDoubleSided(value gdnative.Bool)
+	FlipH() gdnative.Bool
+	FlipV() gdnative.Bool
+	Modulate() gdnative.Color
+	Offset() gdnative.Vector2
+	Opacity() gdnative.Real
+	PixelSize() gdnative.Real
+	Shaded() gdnative.Bool
+	SetShaded(value gdnative.Bool)
+	Transparent() gdnative.Bool
+	SetTransparent(value gdnative.Bool)
 }
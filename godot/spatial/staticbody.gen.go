@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,48 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStaticBodyFromPointer(ptr gdnative.Pointer) StaticBody {
-func newStaticBodyFromPointer(ptr gdnative.Pointer) StaticBody {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StaticBody{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Static body for 3D Physics. A static body is a simple body that is not intended to move. They don't consume any CPU resources in contrast to a [RigidBody3D] so they are great for scenario collision. A static body can also be animated by using simulated motion mode. This is useful for implementing functionalities such as moving platforms. When this mode is active the body can be animated and automatically computes linear and angular velocity to apply in that frame and to influence other bodies. Alternatively, a constant linear or angular velocity can be set for the static body, so even if it doesn't move, it affects other bodies as if it was moving (this is useful for simulating conveyor belts or conveyor wheels).
 */
 type StaticBody struct {
-	PhysicsBody
+	godot.PhysicsBody
 	owner gdnative.Object
 }
 
+// classConstructorStaticBody is the class constructor of the StaticBody class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStaticBody = gdnative.NewLazyClassConstructor("StaticBody")
+
+// NewStaticBody will create a new instance of the StaticBody class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewStaticBody() *StaticBody {
+	obj := &StaticBody{}
+	obj.SetBaseObject(classConstructorStaticBody.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *StaticBody) BaseClass() string {
 	return "StaticBody"
 }
 
+// Method binds of the StaticBody class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStaticBodyGetBounce                  = gdnative.NewLazyMethodBind("StaticBody", "get_bounce")
+	methodBindStaticBodyGetConstantAngularVelocity = gdnative.NewLazyMethodBind("StaticBody", "get_constant_angular_velocity")
+	methodBindStaticBodyGetConstantLinearVelocity  = gdnative.NewLazyMethodBind("StaticBody", "get_constant_linear_velocity")
+	methodBindStaticBodyGetFriction                = gdnative.NewLazyMethodBind("StaticBody", "get_friction")
+	methodBindStaticBodySetBounce                  = gdnative.NewLazyMethodBind("StaticBody", "set_bounce")
+	methodBindStaticBodySetConstantAngularVelocity = gdnative.NewLazyMethodBind("StaticBody", "set_constant_angular_velocity")
+	methodBindStaticBodySetConstantLinearVelocity  = gdnative.NewLazyMethodBind("StaticBody", "set_constant_linear_velocity")
+	methodBindStaticBodySetFriction                = gdnative.NewLazyMethodBind("StaticBody", "set_friction")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *StaticBody) GetBounce() gdnative.Real {
 	//log.Println("Calling StaticBody.GetBounce()")
@@ -45,7 +64,7 @@ func (o *StaticBody) GetBounce() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "get_bounce")
+	methodBind := methodBindStaticBodyGetBounce.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +77,8 @@ func (o *StaticBody) GetBounce() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *StaticBody) GetConstantAngularVelocity() gdnative.Vector3 {
 	//log.Println("Calling StaticBody.GetConstantAngularVelocity()")
@@ -68,7 +87,7 @@ func (o *StaticBody) GetConstantAngularVelocity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "get_constant_angular_velocity")
+	methodBind := methodBindStaticBodyGetConstantAngularVelocity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -81,8 +100,8 @@ func (o *StaticBody) GetConstantAngularVelocity() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector3
+	        Undocumented
+		Args: [], Returns: Vector3
 */
 func (o *StaticBody) GetConstantLinearVelocity() gdnative.Vector3 {
 	//log.Println("Calling StaticBody.GetConstantLinearVelocity()")
@@ -91,7 +110,7 @@ func (o *StaticBody) GetConstantLinearVelocity() gdnative.Vector3 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "get_constant_linear_velocity")
+	methodBind := methodBindStaticBodyGetConstantLinearVelocity.Get()
 
 	// Call the parent method.
 	// Vector3
@@ -104,8 +123,8 @@ func (o *StaticBody) GetConstantLinearVelocity() gdnative.Vector3 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *StaticBody) GetFriction() gdnative.Real {
 	//log.Println("Calling StaticBody.GetFriction()")
@@ -114,7 +133,7 @@ func (o *StaticBody) GetFriction() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "get_friction")
+	methodBind := methodBindStaticBodyGetFriction.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +146,8 @@ func (o *StaticBody) GetFriction() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false bounce float}], Returns: void
+	        Undocumented
+		Args: [{ false bounce float}], Returns: void
 */
 func (o *StaticBody) SetBounce(bounce gdnative.Real) {
 	//log.Println("Calling StaticBody.SetBounce()")
@@ -138,7 +157,7 @@ func (o *StaticBody) SetBounce(bounce gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(bounce)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "set_bounce")
+	methodBind := methodBindStaticBodySetBounce.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +167,8 @@ func (o *StaticBody) SetBounce(bounce gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vel Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false vel Vector3}], Returns: void
 */
 func (o *StaticBody) SetConstantAngularVelocity(vel gdnative.Vector3) {
 	//log.Println("Calling StaticBody.SetConstantAngularVelocity()")
@@ -159,7 +178,7 @@ func (o *StaticBody) SetConstantAngularVelocity(vel gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(vel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "set_constant_angular_velocity")
+	methodBind := methodBindStaticBodySetConstantAngularVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +188,8 @@ func (o *StaticBody) SetConstantAngularVelocity(vel gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vel Vector3}], Returns: void
+	        Undocumented
+		Args: [{ false vel Vector3}], Returns: void
 */
 func (o *StaticBody) SetConstantLinearVelocity(vel gdnative.Vector3) {
 	//log.Println("Calling StaticBody.SetConstantLinearVelocity()")
@@ -180,7 +199,7 @@ func (o *StaticBody) SetConstantLinearVelocity(vel gdnative.Vector3) {
 	ptrArguments[0] = gdnative.NewPointerFromVector3(vel)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "set_constant_linear_velocity")
+	methodBind := methodBindStaticBodySetConstantLinearVelocity.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +209,8 @@ func (o *StaticBody) SetConstantLinearVelocity(vel gdnative.Vector3) {
 }
 
 /*
-        Undocumented
-	Args: [{ false friction float}], Returns: void
+	        Undocumented
+		Args: [{ false friction float}], Returns: void
 */
 func (o *StaticBody) SetFriction(friction gdnative.Real) {
 	//log.Println("Calling StaticBody.SetFriction()")
@@ -201,7 +220,7 @@ func (o *StaticBody) SetFriction(friction gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(friction)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StaticBody", "set_friction")
+	methodBind := methodBindStaticBodySetFriction.Get()
 
 	// Call the parent method.
 	// void
@@ -210,10 +229,30 @@ func (o *StaticBody) SetFriction(friction gdnative.Real) {
 
 }
 
+// Bounce will return the value of the "bounce" property.
+func (o *StaticBody) Bounce() gdnative.Real {
+	return o.GetBounce()
+}
+
+// ConstantAngularVelocity will return the value of the "constant_angular_velocity" property.
+func (o *StaticBody) ConstantAngularVelocity() gdnative.Vector3 {
+	return o.GetConstantAngularVelocity()
+}
+
+// ConstantLinearVelocity will return the value of the "constant_linear_velocity" property.
+func (o *StaticBody) ConstantLinearVelocity() gdnative.Vector3 {
+	return o.GetConstantLinearVelocity()
+}
+
+// Friction will return the value of the "friction" property.
+func (o *StaticBody) Friction() gdnative.Real {
+	return o.GetFriction()
+}
+
 // StaticBodyImplementer is an interface that implements the methods
 // of the StaticBody class.
 type StaticBodyImplementer interface {
-	PhysicsBodyImplementer
+	godot.PhysicsBodyImplementer
 	GetBounce() gdnative.Real
 	GetConstantAngularVelocity() gdnative.Vector3
 	GetConstantLinearVelocity() gdnative.Vector3
@@ -222,4 +261,8 @@ type StaticBodyImplementer interface {
 	SetConstantAngularVelocity(vel gdnative.Vector3)
 	SetConstantLinearVelocity(vel gdnative.Vector3)
 	SetFriction(friction gdnative.Real)
+	Bounce() gdnative.Real
+	ConstantAngularVelocity() gdnative.Vector3
+	ConstantLinearVelocity() gdnative.Vector3
+	Friction() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package spatial
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualInstanceFromPointer(ptr gdnative.Pointer) VisualInstance {
-func newVisualInstanceFromPointer(ptr gdnative.Pointer) VisualInstance {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualInstance{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type VisualInstance struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
@@ -34,9 +25,20 @@ func (o *VisualInstance) BaseClass() string {
 	return "VisualInstance"
 }
 
+// Method binds of the VisualInstance class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualInstanceX_GetVisualInstanceRid = gdnative.NewLazyMethodBind("VisualInstance", "_get_visual_instance_rid")
+	methodBindVisualInstanceGetAabb                = gdnative.NewLazyMethodBind("VisualInstance", "get_aabb")
+	methodBindVisualInstanceGetLayerMask           = gdnative.NewLazyMethodBind("VisualInstance", "get_layer_mask")
+	methodBindVisualInstanceGetTransformedAabb     = gdnative.NewLazyMethodBind("VisualInstance", "get_transformed_aabb")
+	methodBindVisualInstanceSetBase                = gdnative.NewLazyMethodBind("VisualInstance", "set_base")
+	methodBindVisualInstanceSetLayerMask           = gdnative.NewLazyMethodBind("VisualInstance", "set_layer_mask")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *VisualInstance) X_GetVisualInstanceRid() gdnative.Rid {
 	//log.Println("Calling VisualInstance.X_GetVisualInstanceRid()")
@@ -45,7 +47,7 @@ func (o *VisualInstance) X_GetVisualInstanceRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualInstance", "_get_visual_instance_rid")
+	methodBind := methodBindVisualInstanceX_GetVisualInstanceRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -58,8 +60,8 @@ func (o *VisualInstance) X_GetVisualInstanceRid() gdnative.Rid {
 }
 
 /*
-        Returns the [AABB] (also known as the bounding box) for this VisualInstance.
-	Args: [], Returns: AABB
+	        Returns the [AABB] (also known as the bounding box) for this VisualInstance.
+		Args: [], Returns: AABB
 */
 func (o *VisualInstance) GetAabb() gdnative.Aabb {
 	//log.Println("Calling VisualInstance.GetAabb()")
@@ -68,7 +70,7 @@ func (o *VisualInstance) GetAabb() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualInstance", "get_aabb")
+	methodBind := methodBindVisualInstanceGetAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -81,8 +83,8 @@ func (o *VisualInstance) GetAabb() gdnative.Aabb {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualInstance) GetLayerMask() gdnative.Int {
 	//log.Println("Calling VisualInstance.GetLayerMask()")
@@ -91,7 +93,7 @@ func (o *VisualInstance) GetLayerMask() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualInstance", "get_layer_mask")
+	methodBind := methodBindVisualInstanceGetLayerMask.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +106,8 @@ func (o *VisualInstance) GetLayerMask() gdnative.Int {
 }
 
 /*
-        Returns the transformed [AABB] (also known as the bounding box) for this VisualInstance. Transformed in this case means the [AABB] plus the position, rotation, and scale of the [Spatial]s [Transform]
-	Args: [], Returns: AABB
+	        Returns the transformed [AABB] (also known as the bounding box) for this VisualInstance. Transformed in this case means the [AABB] plus the position, rotation, and scale of the [Spatial]s [Transform]
+		Args: [], Returns: AABB
 */
 func (o *VisualInstance) GetTransformedAabb() gdnative.Aabb {
 	//log.Println("Calling VisualInstance.GetTransformedAabb()")
@@ -114,7 +116,7 @@ func (o *VisualInstance) GetTransformedAabb() gdnative.Aabb {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualInstance", "get_transformed_aabb")
+	methodBind := methodBindVisualInstanceGetTransformedAabb.Get()
 
 	// Call the parent method.
 	// AABB
@@ -127,8 +129,8 @@ func (o *VisualInstance) GetTransformedAabb() gdnative.Aabb {
 }
 
 /*
-        Sets the base of the VisualInstance, which changes how the engine handles the VisualInstance under the hood. It is recommended to only use set_base if you know what you're doing.
-	Args: [{ false base RID}], Returns: void
+	        Sets the base of the VisualInstance, which changes how the engine handles the VisualInstance under the hood. It is recommended to only use set_base if you know what you're doing.
+		Args: [{ false base RID}], Returns: void
 */
 func (o *VisualInstance) SetBase(base gdnative.Rid) {
 	//log.Println("Calling VisualInstance.SetBase()")
@@ -138,7 +140,7 @@ func (o *VisualInstance) SetBase(base gdnative.Rid) {
 	ptrArguments[0] = gdnative.NewPointerFromRid(base)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualInstance", "set_base")
+	methodBind := methodBindVisualInstanceSetBase.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +150,8 @@ func (o *VisualInstance) SetBase(base gdnative.Rid) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mask int}], Returns: void
+	        Undocumented
+		Args: [{ false mask int}], Returns: void
 */
 func (o *VisualInstance) SetLayerMask(mask gdnative.Int) {
 	//log.Println("Calling VisualInstance.SetLayerMask()")
@@ -159,7 +161,7 @@ func (o *VisualInstance) SetLayerMask(mask gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(mask)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualInstance", "set_layer_mask")
+	methodBind := methodBindVisualInstanceSetLayerMask.Get()
 
 	// Call the parent method.
 	// void
@@ -168,14 +170,26 @@ func (o *VisualInstance) SetLayerMask(mask gdnative.Int) {
 
 }
 
+// Layers will return the value of the "layers" property.
+func (o *VisualInstance) Layers() gdnative.Int {
+	return o.GetLayerMask()
+}
+
+// SetLayers will set the value of the "layers" property.
+func (o *VisualInstance) SetLayers(value gdnative.Int) {
+	o.SetLayerMask(value)
+}
+
 // VisualInstanceImplementer is an interface that implements the methods
 // of the VisualInstance class.
 type VisualInstanceImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	X_GetVisualInstanceRid() gdnative.Rid
 	GetAabb() gdnative.Aabb
 	GetLayerMask() gdnative.Int
 	GetTransformedAabb() gdnative.Aabb
 	SetBase(base gdnative.Rid)
 	SetLayerMask(mask gdnative.Int)
+	Layers() gdnative.Int
+	SetLayers(value gdnative.Int)
 }
@@ -13,17 +13,7 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSpatialGizmoFromPointer(ptr gdnative.Pointer) SpatialGizmo {
-func newSpatialGizmoFromPointer(ptr gdnative.Pointer) SpatialGizmo {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SpatialGizmo{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type SpatialGizmo struct {
 	Reference
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewSpriteFramesFromPointer(ptr gdnative.Pointer) SpriteFrames {
-func newSpriteFramesFromPointer(ptr gdnative.Pointer) SpriteFrames {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := SpriteFrames{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Sprite frame library for [AnimatedSprite]. Contains frames and animation data for playback.
 */
@@ -30,13 +21,54 @@ type SpriteFrames struct {
 	owner gdnative.Object
 }
 
+// classConstructorSpriteFrames is the class constructor of the SpriteFrames class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorSpriteFrames = gdnative.NewLazyClassConstructor("SpriteFrames")
+
+// NewSpriteFrames will create a new instance of the SpriteFrames class.
+// SpriteFrames is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewSpriteFrames() *SpriteFrames {
+	obj := &SpriteFrames{}
+	obj.SetBaseObject(classConstructorSpriteFrames.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *SpriteFrames) BaseClass() string {
 	return "SpriteFrames"
 }
 
+// Method binds of the SpriteFrames class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindSpriteFramesX_GetAnimations   = gdnative.NewLazyMethodBind("SpriteFrames", "_get_animations")
+	methodBindSpriteFramesX_GetFrames       = gdnative.NewLazyMethodBind("SpriteFrames", "_get_frames")
+	methodBindSpriteFramesX_SetAnimations   = gdnative.NewLazyMethodBind("SpriteFrames", "_set_animations")
+	methodBindSpriteFramesX_SetFrames       = gdnative.NewLazyMethodBind("SpriteFrames", "_set_frames")
+	methodBindSpriteFramesAddAnimation      = gdnative.NewLazyMethodBind("SpriteFrames", "add_animation")
+	methodBindSpriteFramesAddFrame          = gdnative.NewLazyMethodBind("SpriteFrames", "add_frame")
+	methodBindSpriteFramesClear             = gdnative.NewLazyMethodBind("SpriteFrames", "clear")
+	methodBindSpriteFramesClearAll          = gdnative.NewLazyMethodBind("SpriteFrames", "clear_all")
+	methodBindSpriteFramesGetAnimationLoop  = gdnative.NewLazyMethodBind("SpriteFrames", "get_animation_loop")
+	methodBindSpriteFramesGetAnimationSpeed = gdnative.NewLazyMethodBind("SpriteFrames", "get_animation_speed")
+	methodBindSpriteFramesGetFrame          = gdnative.NewLazyMethodBind("SpriteFrames", "get_frame")
+	methodBindSpriteFramesGetFrameCount     = gdnative.NewLazyMethodBind("SpriteFrames", "get_frame_count")
+	methodBindSpriteFramesHasAnimation      = gdnative.NewLazyMethodBind("SpriteFrames", "has_animation")
+	methodBindSpriteFramesRemoveAnimation   = gdnative.NewLazyMethodBind("SpriteFrames", "remove_animation")
+	methodBindSpriteFramesRemoveFrame       = gdnative.NewLazyMethodBind("SpriteFrames", "remove_frame")
+	methodBindSpriteFramesRenameAnimation   = gdnative.NewLazyMethodBind("SpriteFrames", "rename_animation")
+	methodBindSpriteFramesSetAnimationLoop  = gdnative.NewLazyMethodBind("SpriteFrames", "set_animation_loop")
+	methodBindSpriteFramesSetAnimationSpeed = gdnative.NewLazyMethodBind("SpriteFrames", "set_animation_speed")
+	methodBindSpriteFramesSetFrame          = gdnative.NewLazyMethodBind("SpriteFrames", "set_frame")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *SpriteFrames) X_GetAnimations() gdnative.Array {
 	//log.Println("Calling SpriteFrames.X_GetAnimations()")
@@ -45,7 +77,7 @@ func (o *SpriteFrames) X_GetAnimations() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "_get_animations")
+	methodBind := methodBindSpriteFramesX_GetAnimations.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +90,8 @@ func (o *SpriteFrames) X_GetAnimations() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *SpriteFrames) X_GetFrames() gdnative.Array {
 	//log.Println("Calling SpriteFrames.X_GetFrames()")
@@ -68,7 +100,7 @@ func (o *SpriteFrames) X_GetFrames() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "_get_frames")
+	methodBind := methodBindSpriteFramesX_GetFrames.Get()
 
 	// Call the parent method.
 	// Array
@@ -81,8 +113,8 @@ func (o *SpriteFrames) X_GetFrames() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
 */
 func (o *SpriteFrames) X_SetAnimations(arg0 gdnative.Array) {
 	//log.Println("Calling SpriteFrames.X_SetAnimations()")
@@ -92,7 +124,7 @@ func (o *SpriteFrames) X_SetAnimations(arg0 gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "_set_animations")
+	methodBind := methodBindSpriteFramesX_SetAnimations.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +134,8 @@ func (o *SpriteFrames) X_SetAnimations(arg0 gdnative.Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 Array}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 Array}], Returns: void
 */
 func (o *SpriteFrames) X_SetFrames(arg0 gdnative.Array) {
 	//log.Println("Calling SpriteFrames.X_SetFrames()")
@@ -113,7 +145,7 @@ func (o *SpriteFrames) X_SetFrames(arg0 gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "_set_frames")
+	methodBind := methodBindSpriteFramesX_SetFrames.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +155,8 @@ func (o *SpriteFrames) X_SetFrames(arg0 gdnative.Array) {
 }
 
 /*
-        Adds a new animation to the the library.
-	Args: [{ false anim String}], Returns: void
+	        Adds a new animation to the the library.
+		Args: [{ false anim String}], Returns: void
 */
 func (o *SpriteFrames) AddAnimation(anim gdnative.String) {
 	//log.Println("Calling SpriteFrames.AddAnimation()")
@@ -134,7 +166,7 @@ func (o *SpriteFrames) AddAnimation(anim gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "add_animation")
+	methodBind := methodBindSpriteFramesAddAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -144,8 +176,8 @@ func (o *SpriteFrames) AddAnimation(anim gdnative.String) {
 }
 
 /*
-        Adds a frame to the given animation.
-	Args: [{ false anim String} { false frame Texture} {-1 true at_position int}], Returns: void
+	        Adds a frame to the given animation.
+		Args: [{ false anim String} { false frame Texture} {-1 true at_position int}], Returns: void
 */
 func (o *SpriteFrames) AddFrame(anim gdnative.String, frame TextureImplementer, atPosition gdnative.Int) {
 	//log.Println("Calling SpriteFrames.AddFrame()")
@@ -153,11 +185,11 @@ func (o *SpriteFrames) AddFrame(anim gdnative.String, frame TextureImplementer,
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
-	ptrArguments[1] = gdnative.NewPointerFromObject(frame.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(GetBaseObject(frame))
 	ptrArguments[2] = gdnative.NewPointerFromInt(atPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "add_frame")
+	methodBind := methodBindSpriteFramesAddFrame.Get()
 
 	// Call the parent method.
 	// void
@@ -167,8 +199,8 @@ func (o *SpriteFrames) AddFrame(anim gdnative.String, frame TextureImplementer,
 }
 
 /*
-        Removes all frames from the given animation.
-	Args: [{ false anim String}], Returns: void
+	        Removes all frames from the given animation.
+		Args: [{ false anim String}], Returns: void
 */
 func (o *SpriteFrames) Clear(anim gdnative.String) {
 	//log.Println("Calling SpriteFrames.Clear()")
@@ -178,7 +210,7 @@ func (o *SpriteFrames) Clear(anim gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "clear")
+	methodBind := methodBindSpriteFramesClear.Get()
 
 	// Call the parent method.
 	// void
@@ -188,8 +220,8 @@ func (o *SpriteFrames) Clear(anim gdnative.String) {
 }
 
 /*
-        Removes all animations. A "default" animation will be created.
-	Args: [], Returns: void
+	        Removes all animations. A "default" animation will be created.
+		Args: [], Returns: void
 */
 func (o *SpriteFrames) ClearAll() {
 	//log.Println("Calling SpriteFrames.ClearAll()")
@@ -198,7 +230,7 @@ func (o *SpriteFrames) ClearAll() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "clear_all")
+	methodBind := methodBindSpriteFramesClearAll.Get()
 
 	// Call the parent method.
 	// void
@@ -208,8 +240,8 @@ func (o *SpriteFrames) ClearAll() {
 }
 
 /*
-        If [code]true[/code] the given animation will loop.
-	Args: [{ false anim String}], Returns: bool
+	        If [code]true[/code] the given animation will loop.
+		Args: [{ false anim String}], Returns: bool
 */
 func (o *SpriteFrames) GetAnimationLoop(anim gdnative.String) gdnative.Bool {
 	//log.Println("Calling SpriteFrames.GetAnimationLoop()")
@@ -219,7 +251,7 @@ func (o *SpriteFrames) GetAnimationLoop(anim gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "get_animation_loop")
+	methodBind := methodBindSpriteFramesGetAnimationLoop.Get()
 
 	// Call the parent method.
 	// bool
@@ -232,8 +264,8 @@ func (o *SpriteFrames) GetAnimationLoop(anim gdnative.String) gdnative.Bool {
 }
 
 /*
-        The animation's speed in frames per second.
-	Args: [{ false anim String}], Returns: float
+	        The animation's speed in frames per second.
+		Args: [{ false anim String}], Returns: float
 */
 func (o *SpriteFrames) GetAnimationSpeed(anim gdnative.String) gdnative.Real {
 	//log.Println("Calling SpriteFrames.GetAnimationSpeed()")
@@ -243,7 +275,7 @@ func (o *SpriteFrames) GetAnimationSpeed(anim gdnative.String) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "get_animation_speed")
+	methodBind := methodBindSpriteFramesGetAnimationSpeed.Get()
 
 	// Call the parent method.
 	// float
@@ -256,8 +288,8 @@ func (o *SpriteFrames) GetAnimationSpeed(anim gdnative.String) gdnative.Real {
 }
 
 /*
-        Returns the animation's selected frame.
-	Args: [{ false anim String} { false idx int}], Returns: Texture
+	        Returns the animation's selected frame.
+		Args: [{ false anim String} { false idx int}], Returns: Texture
 */
 func (o *SpriteFrames) GetFrame(anim gdnative.String, idx gdnative.Int) TextureImplementer {
 	//log.Println("Calling SpriteFrames.GetFrame()")
@@ -268,7 +300,7 @@ func (o *SpriteFrames) GetFrame(anim gdnative.String, idx gdnative.Int) TextureI
 	ptrArguments[1] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "get_frame")
+	methodBind := methodBindSpriteFramesGetFrame.Get()
 
 	// Call the parent method.
 	// Texture
@@ -276,27 +308,25 @@ func (o *SpriteFrames) GetFrame(anim gdnative.String, idx gdnative.Int) TextureI
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of frames in the animation.
-	Args: [{ false anim String}], Returns: int
+	        Returns the number of frames in the animation.
+		Args: [{ false anim String}], Returns: int
 */
 func (o *SpriteFrames) GetFrameCount(anim gdnative.String) gdnative.Int {
 	//log.Println("Calling SpriteFrames.GetFrameCount()")
@@ -306,7 +336,7 @@ func (o *SpriteFrames) GetFrameCount(anim gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "get_frame_count")
+	methodBind := methodBindSpriteFramesGetFrameCount.Get()
 
 	// Call the parent method.
 	// int
@@ -319,8 +349,8 @@ func (o *SpriteFrames) GetFrameCount(anim gdnative.String) gdnative.Int {
 }
 
 /*
-        If [code]true[/code] the named animation exists.
-	Args: [{ false anim String}], Returns: bool
+	        If [code]true[/code] the named animation exists.
+		Args: [{ false anim String}], Returns: bool
 */
 func (o *SpriteFrames) HasAnimation(anim gdnative.String) gdnative.Bool {
 	//log.Println("Calling SpriteFrames.HasAnimation()")
@@ -330,7 +360,7 @@ func (o *SpriteFrames) HasAnimation(anim gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "has_animation")
+	methodBind := methodBindSpriteFramesHasAnimation.Get()
 
 	// Call the parent method.
 	// bool
@@ -343,8 +373,8 @@ func (o *SpriteFrames) HasAnimation(anim gdnative.String) gdnative.Bool {
 }
 
 /*
-        Removes the given animation.
-	Args: [{ false anim String}], Returns: void
+	        Removes the given animation.
+		Args: [{ false anim String}], Returns: void
 */
 func (o *SpriteFrames) RemoveAnimation(anim gdnative.String) {
 	//log.Println("Calling SpriteFrames.RemoveAnimation()")
@@ -354,7 +384,7 @@ func (o *SpriteFrames) RemoveAnimation(anim gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "remove_animation")
+	methodBind := methodBindSpriteFramesRemoveAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -364,8 +394,8 @@ func (o *SpriteFrames) RemoveAnimation(anim gdnative.String) {
 }
 
 /*
-        Removes the animation's selected frame.
-	Args: [{ false anim String} { false idx int}], Returns: void
+	        Removes the animation's selected frame.
+		Args: [{ false anim String} { false idx int}], Returns: void
 */
 func (o *SpriteFrames) RemoveFrame(anim gdnative.String, idx gdnative.Int) {
 	//log.Println("Calling SpriteFrames.RemoveFrame()")
@@ -376,7 +406,7 @@ func (o *SpriteFrames) RemoveFrame(anim gdnative.String, idx gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "remove_frame")
+	methodBind := methodBindSpriteFramesRemoveFrame.Get()
 
 	// Call the parent method.
 	// void
@@ -386,8 +416,8 @@ func (o *SpriteFrames) RemoveFrame(anim gdnative.String, idx gdnative.Int) {
 }
 
 /*
-        Changes the animation's name to [code]newname[/code].
-	Args: [{ false anim String} { false newname String}], Returns: void
+	        Changes the animation's name to [code]newname[/code].
+		Args: [{ false anim String} { false newname String}], Returns: void
 */
 func (o *SpriteFrames) RenameAnimation(anim gdnative.String, newname gdnative.String) {
 	//log.Println("Calling SpriteFrames.RenameAnimation()")
@@ -398,7 +428,7 @@ func (o *SpriteFrames) RenameAnimation(anim gdnative.String, newname gdnative.St
 	ptrArguments[1] = gdnative.NewPointerFromString(newname)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "rename_animation")
+	methodBind := methodBindSpriteFramesRenameAnimation.Get()
 
 	// Call the parent method.
 	// void
@@ -408,8 +438,8 @@ func (o *SpriteFrames) RenameAnimation(anim gdnative.String, newname gdnative.St
 }
 
 /*
-        If [code]true[/code] the animation will loop.
-	Args: [{ false anim String} { false loop bool}], Returns: void
+	        If [code]true[/code] the animation will loop.
+		Args: [{ false anim String} { false loop bool}], Returns: void
 */
 func (o *SpriteFrames) SetAnimationLoop(anim gdnative.String, loop gdnative.Bool) {
 	//log.Println("Calling SpriteFrames.SetAnimationLoop()")
@@ -420,7 +450,7 @@ func (o *SpriteFrames) SetAnimationLoop(anim gdnative.String, loop gdnative.Bool
 	ptrArguments[1] = gdnative.NewPointerFromBool(loop)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "set_animation_loop")
+	methodBind := methodBindSpriteFramesSetAnimationLoop.Get()
 
 	// Call the parent method.
 	// void
@@ -430,8 +460,8 @@ func (o *SpriteFrames) SetAnimationLoop(anim gdnative.String, loop gdnative.Bool
 }
 
 /*
-        The animation's speed in frames per second.
-	Args: [{ false anim String} { false speed float}], Returns: void
+	        The animation's speed in frames per second.
+		Args: [{ false anim String} { false speed float}], Returns: void
 */
 func (o *SpriteFrames) SetAnimationSpeed(anim gdnative.String, speed gdnative.Real) {
 	//log.Println("Calling SpriteFrames.SetAnimationSpeed()")
@@ -442,7 +472,7 @@ func (o *SpriteFrames) SetAnimationSpeed(anim gdnative.String, speed gdnative.Re
 	ptrArguments[1] = gdnative.NewPointerFromReal(speed)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "set_animation_speed")
+	methodBind := methodBindSpriteFramesSetAnimationSpeed.Get()
 
 	// Call the parent method.
 	// void
@@ -452,8 +482,8 @@ func (o *SpriteFrames) SetAnimationSpeed(anim gdnative.String, speed gdnative.Re
 }
 
 /*
-        Sets the texture of the given frame.
-	Args: [{ false anim String} { false idx int} { false txt Texture}], Returns: void
+	        Sets the texture of the given frame.
+		Args: [{ false anim String} { false idx int} { false txt Texture}], Returns: void
 */
 func (o *SpriteFrames) SetFrame(anim gdnative.String, idx gdnative.Int, txt TextureImplementer) {
 	//log.Println("Calling SpriteFrames.SetFrame()")
@@ -462,10 +492,10 @@ func (o *SpriteFrames) SetFrame(anim gdnative.String, idx gdnative.Int, txt Text
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(anim)
 	ptrArguments[1] = gdnative.NewPointerFromInt(idx)
-	ptrArguments[2] = gdnative.NewPointerFromObject(txt.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(GetBaseObject(txt))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("SpriteFrames", "set_frame")
+	methodBind := methodBindSpriteFramesSetFrame.Get()
 
 	// Call the parent method.
 	// void
@@ -474,6 +504,31 @@ func (o *SpriteFrames) SetFrame(anim gdnative.String, idx gdnative.Int, txt Text
 
 }
 
+// AddFrameWithDefaults will call AddFrame using the default values for: at_position.
+func (o *SpriteFrames) AddFrameWithDefaults(anim gdnative.String, frame TextureImplementer) {
+	o.AddFrame(anim, frame, -1)
+}
+
+// Animations will return the value of the "animations" property.
+func (o *SpriteFrames) Animations() gdnative.Array {
+	return o.X_GetAnimations()
+}
+
+// SetAnimations will set the value of the "animations" property.
+func (o *SpriteFrames) SetAnimations(value gdnative.Array) {
+	o.X_SetAnimations(value)
+}
+
+// Frames will return the value of the "frames" property.
+func (o *SpriteFrames) Frames() gdnative.Array {
+	return o.X_GetFrames()
+}
+
+// SetFrames will set the value of the "frames" property.
+func (o *SpriteFrames) SetFrames(value gdnative.Array) {
+	o.X_SetFrames(value)
+}
+
 // SpriteFramesImplementer is an interface that implements the methods
 // of the SpriteFrames class.
 type SpriteFramesImplementer interface {
@@ -497,4 +552,9 @@ type SpriteFramesImplementer interface {
 	SetAnimationLoop(anim gdnative.String, loop gdnative.Bool)
 	SetAnimationSpeed(anim gdnative.String, speed gdnative.Real)
 	SetFrame(anim gdnative.String, idx gdnative.Int, txt TextureImplementer)
+	AddFrameWithDefaults(anim gdnative.String, frame TextureImplementer)
+	Animations() gdnative.Array
+	SetAnimations(value gdnative.Array)
+	Frames() gdnative.Array
+	SetFrames(value gdnative.Array)
 }
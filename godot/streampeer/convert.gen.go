@@ -0,0 +1,40 @@
+package streampeer
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("StreamPeer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &StreamPeer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("StreamPeerBuffer", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &StreamPeerBuffer{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("StreamPeerSSL", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &StreamPeerSSL{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("StreamPeerTCP", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &StreamPeerTCP{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,8 @@
-package godot
+package streampeer
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStreamPeerFromPointer(ptr gdnative.Pointer) StreamPeer {
-func newStreamPeerFromPointer(ptr gdnative.Pointer) StreamPeer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StreamPeer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 StreamPeer is an abstraction and base class for stream-based protocols (such as TCP or Unix Sockets). It provides an API for sending and receiving data through streams as raw data or strings.
 */
 type StreamPeer struct {
-	Reference
+	godot.Reference
 	owner gdnative.Object
 }
 
@@ -34,9 +26,46 @@ func (o *StreamPeer) BaseClass() string {
 	return "StreamPeer"
 }
 
+// Method binds of the StreamPeer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStreamPeerGet16              = gdnative.NewLazyMethodBind("StreamPeer", "get_16")
+	methodBindStreamPeerGet32              = gdnative.NewLazyMethodBind("StreamPeer", "get_32")
+	methodBindStreamPeerGet64              = gdnative.NewLazyMethodBind("StreamPeer", "get_64")
+	methodBindStreamPeerGet8               = gdnative.NewLazyMethodBind("StreamPeer", "get_8")
+	methodBindStreamPeerGetAvailableBytes  = gdnative.NewLazyMethodBind("StreamPeer", "get_available_bytes")
+	methodBindStreamPeerGetData            = gdnative.NewLazyMethodBind("StreamPeer", "get_data")
+	methodBindStreamPeerGetDouble          = gdnative.NewLazyMethodBind("StreamPeer", "get_double")
+	methodBindStreamPeerGetFloat           = gdnative.NewLazyMethodBind("StreamPeer", "get_float")
+	methodBindStreamPeerGetPartialData     = gdnative.NewLazyMethodBind("StreamPeer", "get_partial_data")
+	methodBindStreamPeerGetString          = gdnative.NewLazyMethodBind("StreamPeer", "get_string")
+	methodBindStreamPeerGetU16             = gdnative.NewLazyMethodBind("StreamPeer", "get_u16")
+	methodBindStreamPeerGetU32             = gdnative.NewLazyMethodBind("StreamPeer", "get_u32")
+	methodBindStreamPeerGetU64             = gdnative.NewLazyMethodBind("StreamPeer", "get_u64")
+	methodBindStreamPeerGetU8              = gdnative.NewLazyMethodBind("StreamPeer", "get_u8")
+	methodBindStreamPeerGetUtf8String      = gdnative.NewLazyMethodBind("StreamPeer", "get_utf8_string")
+	methodBindStreamPeerGetVar             = gdnative.NewLazyMethodBind("StreamPeer", "get_var")
+	methodBindStreamPeerIsBigEndianEnabled = gdnative.NewLazyMethodBind("StreamPeer", "is_big_endian_enabled")
+	methodBindStreamPeerPut16              = gdnative.NewLazyMethodBind("StreamPeer", "put_16")
+	methodBindStreamPeerPut32              = gdnative.NewLazyMethodBind("StreamPeer", "put_32")
+	methodBindStreamPeerPut64              = gdnative.NewLazyMethodBind("StreamPeer", "put_64")
+	methodBindStreamPeerPut8               = gdnative.NewLazyMethodBind("StreamPeer", "put_8")
+	methodBindStreamPeerPutData            = gdnative.NewLazyMethodBind("StreamPeer", "put_data")
+	methodBindStreamPeerPutDouble          = gdnative.NewLazyMethodBind("StreamPeer", "put_double")
+	methodBindStreamPeerPutFloat           = gdnative.NewLazyMethodBind("StreamPeer", "put_float")
+	methodBindStreamPeerPutPartialData     = gdnative.NewLazyMethodBind("StreamPeer", "put_partial_data")
+	methodBindStreamPeerPutU16             = gdnative.NewLazyMethodBind("StreamPeer", "put_u16")
+	methodBindStreamPeerPutU32             = gdnative.NewLazyMethodBind("StreamPeer", "put_u32")
+	methodBindStreamPeerPutU64             = gdnative.NewLazyMethodBind("StreamPeer", "put_u64")
+	methodBindStreamPeerPutU8              = gdnative.NewLazyMethodBind("StreamPeer", "put_u8")
+	methodBindStreamPeerPutUtf8String      = gdnative.NewLazyMethodBind("StreamPeer", "put_utf8_string")
+	methodBindStreamPeerPutVar             = gdnative.NewLazyMethodBind("StreamPeer", "put_var")
+	methodBindStreamPeerSetBigEndian       = gdnative.NewLazyMethodBind("StreamPeer", "set_big_endian")
+)
+
 /*
-        Get a signed 16 bit value from the stream.
-	Args: [], Returns: int
+	        Get a signed 16 bit value from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) Get16() gdnative.Int {
 	//log.Println("Calling StreamPeer.Get16()")
@@ -45,7 +74,7 @@ func (o *StreamPeer) Get16() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_16")
+	methodBind := methodBindStreamPeerGet16.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +87,8 @@ func (o *StreamPeer) Get16() gdnative.Int {
 }
 
 /*
-        Get a signed 32 bit value from the stream.
-	Args: [], Returns: int
+	        Get a signed 32 bit value from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) Get32() gdnative.Int {
 	//log.Println("Calling StreamPeer.Get32()")
@@ -68,7 +97,7 @@ func (o *StreamPeer) Get32() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_32")
+	methodBind := methodBindStreamPeerGet32.Get()
 
 	// Call the parent method.
 	// int
@@ -81,8 +110,8 @@ func (o *StreamPeer) Get32() gdnative.Int {
 }
 
 /*
-        Get a signed 64 bit value from the stream.
-	Args: [], Returns: int
+	        Get a signed 64 bit value from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) Get64() gdnative.Int {
 	//log.Println("Calling StreamPeer.Get64()")
@@ -91,7 +120,7 @@ func (o *StreamPeer) Get64() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_64")
+	methodBind := methodBindStreamPeerGet64.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +133,8 @@ func (o *StreamPeer) Get64() gdnative.Int {
 }
 
 /*
-        Get a signed byte from the stream.
-	Args: [], Returns: int
+	        Get a signed byte from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) Get8() gdnative.Int {
 	//log.Println("Calling StreamPeer.Get8()")
@@ -114,7 +143,7 @@ func (o *StreamPeer) Get8() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_8")
+	methodBind := methodBindStreamPeerGet8.Get()
 
 	// Call the parent method.
 	// int
@@ -127,8 +156,8 @@ func (o *StreamPeer) Get8() gdnative.Int {
 }
 
 /*
-        Return the amount of bytes this [code]StreamPeer[/code] has available.
-	Args: [], Returns: int
+	        Return the amount of bytes this [code]StreamPeer[/code] has available.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) GetAvailableBytes() gdnative.Int {
 	//log.Println("Calling StreamPeer.GetAvailableBytes()")
@@ -137,7 +166,7 @@ func (o *StreamPeer) GetAvailableBytes() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_available_bytes")
+	methodBind := methodBindStreamPeerGetAvailableBytes.Get()
 
 	// Call the parent method.
 	// int
@@ -150,8 +179,8 @@ func (o *StreamPeer) GetAvailableBytes() gdnative.Int {
 }
 
 /*
-        Return a chunk data with the received bytes. The amount of bytes to be received can be requested in the "bytes" argument. If not enough bytes are available, the function will block until the desired amount is received. This function returns two values, an Error code and a data array.
-	Args: [{ false bytes int}], Returns: Array
+	        Return a chunk data with the received bytes. The amount of bytes to be received can be requested in the "bytes" argument. If not enough bytes are available, the function will block until the desired amount is received. This function returns two values, an Error code and a data array.
+		Args: [{ false bytes int}], Returns: Array
 */
 func (o *StreamPeer) GetData(bytes gdnative.Int) gdnative.Array {
 	//log.Println("Calling StreamPeer.GetData()")
@@ -161,7 +190,7 @@ func (o *StreamPeer) GetData(bytes gdnative.Int) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bytes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_data")
+	methodBind := methodBindStreamPeerGetData.Get()
 
 	// Call the parent method.
 	// Array
@@ -174,8 +203,8 @@ func (o *StreamPeer) GetData(bytes gdnative.Int) gdnative.Array {
 }
 
 /*
-        Get a double-precision float from the stream.
-	Args: [], Returns: float
+	        Get a double-precision float from the stream.
+		Args: [], Returns: float
 */
 func (o *StreamPeer) GetDouble() gdnative.Real {
 	//log.Println("Calling StreamPeer.GetDouble()")
@@ -184,7 +213,7 @@ func (o *StreamPeer) GetDouble() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_double")
+	methodBind := methodBindStreamPeerGetDouble.Get()
 
 	// Call the parent method.
 	// float
@@ -197,8 +226,8 @@ func (o *StreamPeer) GetDouble() gdnative.Real {
 }
 
 /*
-        Get a single-precision float from the stream.
-	Args: [], Returns: float
+	        Get a single-precision float from the stream.
+		Args: [], Returns: float
 */
 func (o *StreamPeer) GetFloat() gdnative.Real {
 	//log.Println("Calling StreamPeer.GetFloat()")
@@ -207,7 +236,7 @@ func (o *StreamPeer) GetFloat() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_float")
+	methodBind := methodBindStreamPeerGetFloat.Get()
 
 	// Call the parent method.
 	// float
@@ -220,8 +249,8 @@ func (o *StreamPeer) GetFloat() gdnative.Real {
 }
 
 /*
-        Return a chunk data with the received bytes. The amount of bytes to be received can be requested in the "bytes" argument. If not enough bytes are available, the function will return how many were actually received. This function returns two values, an Error code, and a data array.
-	Args: [{ false bytes int}], Returns: Array
+	        Return a chunk data with the received bytes. The amount of bytes to be received can be requested in the "bytes" argument. If not enough bytes are available, the function will return how many were actually received. This function returns two values, an Error code, and a data array.
+		Args: [{ false bytes int}], Returns: Array
 */
 func (o *StreamPeer) GetPartialData(bytes gdnative.Int) gdnative.Array {
 	//log.Println("Calling StreamPeer.GetPartialData()")
@@ -231,7 +260,7 @@ func (o *StreamPeer) GetPartialData(bytes gdnative.Int) gdnative.Array {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bytes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_partial_data")
+	methodBind := methodBindStreamPeerGetPartialData.Get()
 
 	// Call the parent method.
 	// Array
@@ -244,8 +273,8 @@ func (o *StreamPeer) GetPartialData(bytes gdnative.Int) gdnative.Array {
 }
 
 /*
-        Get a string with byte-length "bytes" from the stream.
-	Args: [{ false bytes int}], Returns: String
+	        Get a string with byte-length "bytes" from the stream.
+		Args: [{ false bytes int}], Returns: String
 */
 func (o *StreamPeer) GetString(bytes gdnative.Int) gdnative.String {
 	//log.Println("Calling StreamPeer.GetString()")
@@ -255,7 +284,7 @@ func (o *StreamPeer) GetString(bytes gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bytes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_string")
+	methodBind := methodBindStreamPeerGetString.Get()
 
 	// Call the parent method.
 	// String
@@ -268,8 +297,8 @@ func (o *StreamPeer) GetString(bytes gdnative.Int) gdnative.String {
 }
 
 /*
-        Get an unsigned 16 bit value from the stream.
-	Args: [], Returns: int
+	        Get an unsigned 16 bit value from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) GetU16() gdnative.Int {
 	//log.Println("Calling StreamPeer.GetU16()")
@@ -278,7 +307,7 @@ func (o *StreamPeer) GetU16() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_u16")
+	methodBind := methodBindStreamPeerGetU16.Get()
 
 	// Call the parent method.
 	// int
@@ -291,8 +320,8 @@ func (o *StreamPeer) GetU16() gdnative.Int {
 }
 
 /*
-        Get an unsigned 32 bit value from the stream.
-	Args: [], Returns: int
+	        Get an unsigned 32 bit value from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) GetU32() gdnative.Int {
 	//log.Println("Calling StreamPeer.GetU32()")
@@ -301,7 +330,7 @@ func (o *StreamPeer) GetU32() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_u32")
+	methodBind := methodBindStreamPeerGetU32.Get()
 
 	// Call the parent method.
 	// int
@@ -314,8 +343,8 @@ func (o *StreamPeer) GetU32() gdnative.Int {
 }
 
 /*
-        Get an unsigned 64 bit value from the stream.
-	Args: [], Returns: int
+	        Get an unsigned 64 bit value from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) GetU64() gdnative.Int {
 	//log.Println("Calling StreamPeer.GetU64()")
@@ -324,7 +353,7 @@ func (o *StreamPeer) GetU64() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_u64")
+	methodBind := methodBindStreamPeerGetU64.Get()
 
 	// Call the parent method.
 	// int
@@ -337,8 +366,8 @@ func (o *StreamPeer) GetU64() gdnative.Int {
 }
 
 /*
-        Get an unsigned byte from the stream.
-	Args: [], Returns: int
+	        Get an unsigned byte from the stream.
+		Args: [], Returns: int
 */
 func (o *StreamPeer) GetU8() gdnative.Int {
 	//log.Println("Calling StreamPeer.GetU8()")
@@ -347,7 +376,7 @@ func (o *StreamPeer) GetU8() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_u8")
+	methodBind := methodBindStreamPeerGetU8.Get()
 
 	// Call the parent method.
 	// int
@@ -360,8 +389,8 @@ func (o *StreamPeer) GetU8() gdnative.Int {
 }
 
 /*
-        Get a utf8 string with byte-length "bytes" from the stream (this decodes the string sent as utf8).
-	Args: [{ false bytes int}], Returns: String
+	        Get a utf8 string with byte-length "bytes" from the stream (this decodes the string sent as utf8).
+		Args: [{ false bytes int}], Returns: String
 */
 func (o *StreamPeer) GetUtf8String(bytes gdnative.Int) gdnative.String {
 	//log.Println("Calling StreamPeer.GetUtf8String()")
@@ -371,7 +400,7 @@ func (o *StreamPeer) GetUtf8String(bytes gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(bytes)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_utf8_string")
+	methodBind := methodBindStreamPeerGetUtf8String.Get()
 
 	// Call the parent method.
 	// String
@@ -384,8 +413,8 @@ func (o *StreamPeer) GetUtf8String(bytes gdnative.Int) gdnative.String {
 }
 
 /*
-        Get a Variant from the stream.
-	Args: [], Returns: Variant
+	        Get a Variant from the stream.
+		Args: [], Returns: Variant
 */
 func (o *StreamPeer) GetVar() gdnative.Variant {
 	//log.Println("Calling StreamPeer.GetVar()")
@@ -394,7 +423,7 @@ func (o *StreamPeer) GetVar() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "get_var")
+	methodBind := methodBindStreamPeerGetVar.Get()
 
 	// Call the parent method.
 	// Variant
@@ -407,8 +436,8 @@ func (o *StreamPeer) GetVar() gdnative.Variant {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *StreamPeer) IsBigEndianEnabled() gdnative.Bool {
 	//log.Println("Calling StreamPeer.IsBigEndianEnabled()")
@@ -417,7 +446,7 @@ func (o *StreamPeer) IsBigEndianEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "is_big_endian_enabled")
+	methodBind := methodBindStreamPeerIsBigEndianEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -430,8 +459,8 @@ func (o *StreamPeer) IsBigEndianEnabled() gdnative.Bool {
 }
 
 /*
-        Put a signed 16 bit value into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put a signed 16 bit value into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) Put16(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.Put16()")
@@ -441,7 +470,7 @@ func (o *StreamPeer) Put16(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_16")
+	methodBind := methodBindStreamPeerPut16.Get()
 
 	// Call the parent method.
 	// void
@@ -451,8 +480,8 @@ func (o *StreamPeer) Put16(value gdnative.Int) {
 }
 
 /*
-        Put a signed 32 bit value into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put a signed 32 bit value into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) Put32(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.Put32()")
@@ -462,7 +491,7 @@ func (o *StreamPeer) Put32(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_32")
+	methodBind := methodBindStreamPeerPut32.Get()
 
 	// Call the parent method.
 	// void
@@ -472,8 +501,8 @@ func (o *StreamPeer) Put32(value gdnative.Int) {
 }
 
 /*
-        Put a signed 64 bit value into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put a signed 64 bit value into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) Put64(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.Put64()")
@@ -483,7 +512,7 @@ func (o *StreamPeer) Put64(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_64")
+	methodBind := methodBindStreamPeerPut64.Get()
 
 	// Call the parent method.
 	// void
@@ -493,8 +522,8 @@ func (o *StreamPeer) Put64(value gdnative.Int) {
 }
 
 /*
-        Put a signed byte into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put a signed byte into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) Put8(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.Put8()")
@@ -504,7 +533,7 @@ func (o *StreamPeer) Put8(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_8")
+	methodBind := methodBindStreamPeerPut8.Get()
 
 	// Call the parent method.
 	// void
@@ -514,8 +543,8 @@ func (o *StreamPeer) Put8(value gdnative.Int) {
 }
 
 /*
-        Send a chunk of data through the connection, blocking if necessary until the data is done sending. This function returns an Error code.
-	Args: [{ false data PoolByteArray}], Returns: enum.Error
+	        Send a chunk of data through the connection, blocking if necessary until the data is done sending. This function returns an Error code.
+		Args: [{ false data PoolByteArray}], Returns: enum.Error
 */
 func (o *StreamPeer) PutData(data gdnative.PoolByteArray) gdnative.Error {
 	//log.Println("Calling StreamPeer.PutData()")
@@ -525,7 +554,7 @@ func (o *StreamPeer) PutData(data gdnative.PoolByteArray) gdnative.Error {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_data")
+	methodBind := methodBindStreamPeerPutData.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -538,8 +567,8 @@ func (o *StreamPeer) PutData(data gdnative.PoolByteArray) gdnative.Error {
 }
 
 /*
-        Put a double-precision float into the stream.
-	Args: [{ false value float}], Returns: void
+	        Put a double-precision float into the stream.
+		Args: [{ false value float}], Returns: void
 */
 func (o *StreamPeer) PutDouble(value gdnative.Real) {
 	//log.Println("Calling StreamPeer.PutDouble()")
@@ -549,7 +578,7 @@ func (o *StreamPeer) PutDouble(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_double")
+	methodBind := methodBindStreamPeerPutDouble.Get()
 
 	// Call the parent method.
 	// void
@@ -559,8 +588,8 @@ func (o *StreamPeer) PutDouble(value gdnative.Real) {
 }
 
 /*
-        Put a single-precision float into the stream.
-	Args: [{ false value float}], Returns: void
+	        Put a single-precision float into the stream.
+		Args: [{ false value float}], Returns: void
 */
 func (o *StreamPeer) PutFloat(value gdnative.Real) {
 	//log.Println("Calling StreamPeer.PutFloat()")
@@ -570,7 +599,7 @@ func (o *StreamPeer) PutFloat(value gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_float")
+	methodBind := methodBindStreamPeerPutFloat.Get()
 
 	// Call the parent method.
 	// void
@@ -580,8 +609,8 @@ func (o *StreamPeer) PutFloat(value gdnative.Real) {
 }
 
 /*
-        Send a chunk of data through the connection, if all the data could not be sent at once, only part of it will. This function returns two values, an Error code and an integer, describing how much data was actually sent.
-	Args: [{ false data PoolByteArray}], Returns: Array
+	        Send a chunk of data through the connection, if all the data could not be sent at once, only part of it will. This function returns two values, an Error code and an integer, describing how much data was actually sent.
+		Args: [{ false data PoolByteArray}], Returns: Array
 */
 func (o *StreamPeer) PutPartialData(data gdnative.PoolByteArray) gdnative.Array {
 	//log.Println("Calling StreamPeer.PutPartialData()")
@@ -591,7 +620,7 @@ func (o *StreamPeer) PutPartialData(data gdnative.PoolByteArray) gdnative.Array
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_partial_data")
+	methodBind := methodBindStreamPeerPutPartialData.Get()
 
 	// Call the parent method.
 	// Array
@@ -604,8 +633,8 @@ func (o *StreamPeer) PutPartialData(data gdnative.PoolByteArray) gdnative.Array
 }
 
 /*
-        Put an unsigned 16 bit value into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put an unsigned 16 bit value into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) PutU16(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.PutU16()")
@@ -615,7 +644,7 @@ func (o *StreamPeer) PutU16(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_u16")
+	methodBind := methodBindStreamPeerPutU16.Get()
 
 	// Call the parent method.
 	// void
@@ -625,8 +654,8 @@ func (o *StreamPeer) PutU16(value gdnative.Int) {
 }
 
 /*
-        Put an unsigned 32 bit value into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put an unsigned 32 bit value into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) PutU32(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.PutU32()")
@@ -636,7 +665,7 @@ func (o *StreamPeer) PutU32(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_u32")
+	methodBind := methodBindStreamPeerPutU32.Get()
 
 	// Call the parent method.
 	// void
@@ -646,8 +675,8 @@ func (o *StreamPeer) PutU32(value gdnative.Int) {
 }
 
 /*
-        Put an unsigned 64 bit value into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put an unsigned 64 bit value into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) PutU64(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.PutU64()")
@@ -657,7 +686,7 @@ func (o *StreamPeer) PutU64(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_u64")
+	methodBind := methodBindStreamPeerPutU64.Get()
 
 	// Call the parent method.
 	// void
@@ -667,8 +696,8 @@ func (o *StreamPeer) PutU64(value gdnative.Int) {
 }
 
 /*
-        Put an unsigned byte into the stream.
-	Args: [{ false value int}], Returns: void
+	        Put an unsigned byte into the stream.
+		Args: [{ false value int}], Returns: void
 */
 func (o *StreamPeer) PutU8(value gdnative.Int) {
 	//log.Println("Calling StreamPeer.PutU8()")
@@ -678,7 +707,7 @@ func (o *StreamPeer) PutU8(value gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_u8")
+	methodBind := methodBindStreamPeerPutU8.Get()
 
 	// Call the parent method.
 	// void
@@ -688,8 +717,8 @@ func (o *StreamPeer) PutU8(value gdnative.Int) {
 }
 
 /*
-        Put a zero-terminated utf8 string into the stream.
-	Args: [{ false value String}], Returns: void
+	        Put a zero-terminated utf8 string into the stream.
+		Args: [{ false value String}], Returns: void
 */
 func (o *StreamPeer) PutUtf8String(value gdnative.String) {
 	//log.Println("Calling StreamPeer.PutUtf8String()")
@@ -699,7 +728,7 @@ func (o *StreamPeer) PutUtf8String(value gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_utf8_string")
+	methodBind := methodBindStreamPeerPutUtf8String.Get()
 
 	// Call the parent method.
 	// void
@@ -709,8 +738,8 @@ func (o *StreamPeer) PutUtf8String(value gdnative.String) {
 }
 
 /*
-        Put a Variant into the stream.
-	Args: [{ false value Variant}], Returns: void
+	        Put a Variant into the stream.
+		Args: [{ false value Variant}], Returns: void
 */
 func (o *StreamPeer) PutVar(value gdnative.Variant) {
 	//log.Println("Calling StreamPeer.PutVar()")
@@ -720,7 +749,7 @@ func (o *StreamPeer) PutVar(value gdnative.Variant) {
 	ptrArguments[0] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "put_var")
+	methodBind := methodBindStreamPeerPutVar.Get()
 
 	// Call the parent method.
 	// void
@@ -730,8 +759,8 @@ func (o *StreamPeer) PutVar(value gdnative.Variant) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *StreamPeer) SetBigEndian(enable gdnative.Bool) {
 	//log.Println("Calling StreamPeer.SetBigEndian()")
@@ -741,7 +770,7 @@ func (o *StreamPeer) SetBigEndian(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeer", "set_big_endian")
+	methodBind := methodBindStreamPeerSetBigEndian.Get()
 
 	// Call the parent method.
 	// void
@@ -750,10 +779,15 @@ func (o *StreamPeer) SetBigEndian(enable gdnative.Bool) {
 
 }
 
+// BigEndian will return the value of the "big_endian" property.
+func (o *StreamPeer) BigEndian() gdnative.Bool {
+	return o.IsBigEndianEnabled()
+}
+
 // StreamPeerImplementer is an interface that implements the methods
 // of the StreamPeer class.
 type StreamPeerImplementer interface {
-	ReferenceImplementer
+	godot.ReferenceImplementer
 	Get16() gdnative.Int
 	Get32() gdnative.Int
 	Get64() gdnative.Int
@@ -775,6 +809,7 @@ type StreamPeerImplementer interface {
 	Put32(value gdnative.Int)
 	Put64(value gdnative.Int)
 	Put8(value gdnative.Int)
+	PutData(data gdnative.PoolByteArray) gdnative.Error
 	PutDouble(value gdnative.Real)
 	PutFloat(value gdnative.Real)
 	PutPartialData(data gdnative.PoolByteArray) gdnative.Array
@@ -785,4 +820,5 @@ type StreamPeerImplementer interface {
 	PutUtf8String(value gdnative.String)
 	PutVar(value gdnative.Variant)
 	SetBigEndian(enable gdnative.Bool)
+	BigEndian() gdnative.Bool
 }
@@ -1,7 +1,8 @@
-package godot
+package streampeer
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,49 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStreamPeerBufferFromPointer(ptr gdnative.Pointer) StreamPeerBuffer {
-func newStreamPeerBufferFromPointer(ptr gdnative.Pointer) StreamPeerBuffer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StreamPeerBuffer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type StreamPeerBuffer struct {
 	StreamPeer
 	owner gdnative.Object
 }
 
+// classConstructorStreamPeerBuffer is the class constructor of the StreamPeerBuffer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStreamPeerBuffer = gdnative.NewLazyClassConstructor("StreamPeerBuffer")
+
+// NewStreamPeerBuffer will create a new instance of the StreamPeerBuffer class.
+// StreamPeerBuffer is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStreamPeerBuffer() *StreamPeerBuffer {
+	obj := &StreamPeerBuffer{}
+	obj.SetBaseObject(classConstructorStreamPeerBuffer.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *StreamPeerBuffer) BaseClass() string {
 	return "StreamPeerBuffer"
 }
 
-/*
+// Method binds of the StreamPeerBuffer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStreamPeerBufferClear        = gdnative.NewLazyMethodBind("StreamPeerBuffer", "clear")
+	methodBindStreamPeerBufferDuplicate    = gdnative.NewLazyMethodBind("StreamPeerBuffer", "duplicate")
+	methodBindStreamPeerBufferGetDataArray = gdnative.NewLazyMethodBind("StreamPeerBuffer", "get_data_array")
+	methodBindStreamPeerBufferGetPosition  = gdnative.NewLazyMethodBind("StreamPeerBuffer", "get_position")
+	methodBindStreamPeerBufferGetSize      = gdnative.NewLazyMethodBind("StreamPeerBuffer", "get_size")
+	methodBindStreamPeerBufferResize       = gdnative.NewLazyMethodBind("StreamPeerBuffer", "resize")
+	methodBindStreamPeerBufferSeek         = gdnative.NewLazyMethodBind("StreamPeerBuffer", "seek")
+	methodBindStreamPeerBufferSetDataArray = gdnative.NewLazyMethodBind("StreamPeerBuffer", "set_data_array")
+)
 
-	Args: [], Returns: void
+/*
+Args: [], Returns: void
 */
 func (o *StreamPeerBuffer) Clear() {
 	//log.Println("Calling StreamPeerBuffer.Clear()")
@@ -45,7 +65,7 @@ func (o *StreamPeerBuffer) Clear() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "clear")
+	methodBind := methodBindStreamPeerBufferClear.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +75,7 @@ func (o *StreamPeerBuffer) Clear() {
 }
 
 /*
-
-	Args: [], Returns: StreamPeerBuffer
+Args: [], Returns: StreamPeerBuffer
 */
 func (o *StreamPeerBuffer) Duplicate() StreamPeerBufferImplementer {
 	//log.Println("Calling StreamPeerBuffer.Duplicate()")
@@ -65,7 +84,7 @@ func (o *StreamPeerBuffer) Duplicate() StreamPeerBufferImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "duplicate")
+	methodBind := methodBindStreamPeerBufferDuplicate.Get()
 
 	// Call the parent method.
 	// StreamPeerBuffer
@@ -73,27 +92,25 @@ func (o *StreamPeerBuffer) Duplicate() StreamPeerBufferImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newStreamPeerBufferFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(StreamPeerBufferImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "StreamPeerBuffer" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(StreamPeerBufferImplementer)
+	ret := StreamPeerBuffer{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(StreamPeerBufferImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: PoolByteArray
+	        Undocumented
+		Args: [], Returns: PoolByteArray
 */
 func (o *StreamPeerBuffer) GetDataArray() gdnative.PoolByteArray {
 	//log.Println("Calling StreamPeerBuffer.GetDataArray()")
@@ -102,7 +119,7 @@ func (o *StreamPeerBuffer) GetDataArray() gdnative.PoolByteArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "get_data_array")
+	methodBind := methodBindStreamPeerBufferGetDataArray.Get()
 
 	// Call the parent method.
 	// PoolByteArray
@@ -115,8 +132,7 @@ func (o *StreamPeerBuffer) GetDataArray() gdnative.PoolByteArray {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *StreamPeerBuffer) GetPosition() gdnative.Int {
 	//log.Println("Calling StreamPeerBuffer.GetPosition()")
@@ -125,7 +141,7 @@ func (o *StreamPeerBuffer) GetPosition() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "get_position")
+	methodBind := methodBindStreamPeerBufferGetPosition.Get()
 
 	// Call the parent method.
 	// int
@@ -138,8 +154,7 @@ func (o *StreamPeerBuffer) GetPosition() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *StreamPeerBuffer) GetSize() gdnative.Int {
 	//log.Println("Calling StreamPeerBuffer.GetSize()")
@@ -148,7 +163,7 @@ func (o *StreamPeerBuffer) GetSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "get_size")
+	methodBind := methodBindStreamPeerBufferGetSize.Get()
 
 	// Call the parent method.
 	// int
@@ -161,8 +176,7 @@ func (o *StreamPeerBuffer) GetSize() gdnative.Int {
 }
 
 /*
-
-	Args: [{ false size int}], Returns: void
+Args: [{ false size int}], Returns: void
 */
 func (o *StreamPeerBuffer) Resize(size gdnative.Int) {
 	//log.Println("Calling StreamPeerBuffer.Resize()")
@@ -172,7 +186,7 @@ func (o *StreamPeerBuffer) Resize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "resize")
+	methodBind := methodBindStreamPeerBufferResize.Get()
 
 	// Call the parent method.
 	// void
@@ -182,8 +196,7 @@ func (o *StreamPeerBuffer) Resize(size gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false position int}], Returns: void
+Args: [{ false position int}], Returns: void
 */
 func (o *StreamPeerBuffer) Seek(position gdnative.Int) {
 	//log.Println("Calling StreamPeerBuffer.Seek()")
@@ -193,7 +206,7 @@ func (o *StreamPeerBuffer) Seek(position gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "seek")
+	methodBind := methodBindStreamPeerBufferSeek.Get()
 
 	// Call the parent method.
 	// void
@@ -203,8 +216,8 @@ func (o *StreamPeerBuffer) Seek(position gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false data PoolByteArray}], Returns: void
+	        Undocumented
+		Args: [{ false data PoolByteArray}], Returns: void
 */
 func (o *StreamPeerBuffer) SetDataArray(data gdnative.PoolByteArray) {
 	//log.Println("Calling StreamPeerBuffer.SetDataArray()")
@@ -214,7 +227,7 @@ func (o *StreamPeerBuffer) SetDataArray(data gdnative.PoolByteArray) {
 	ptrArguments[0] = gdnative.NewPointerFromPoolByteArray(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerBuffer", "set_data_array")
+	methodBind := methodBindStreamPeerBufferSetDataArray.Get()
 
 	// Call the parent method.
 	// void
@@ -223,6 +236,11 @@ func (o *StreamPeerBuffer) SetDataArray(data gdnative.PoolByteArray) {
 
 }
 
+// DataArray will return the value of the "data_array" property.
+func (o *StreamPeerBuffer) DataArray() gdnative.PoolByteArray {
+	return o.GetDataArray()
+}
+
 // StreamPeerBufferImplementer is an interface that implements the methods
 // of the StreamPeerBuffer class.
 type StreamPeerBufferImplementer interface {
@@ -235,4 +253,5 @@ type StreamPeerBufferImplementer interface {
 	Resize(size gdnative.Int)
 	Seek(position gdnative.Int)
 	SetDataArray(data gdnative.PoolByteArray)
+	DataArray() gdnative.PoolByteArray
 }
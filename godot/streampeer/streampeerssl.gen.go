@@ -0,0 +1,203 @@
+package streampeer
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// StreamPeerSSLStatus is an enum for Status values.
+type StreamPeerSSLStatus int
+
+const (
+	StreamPeerSSLStatusConnected             StreamPeerSSLStatus = 1
+	StreamPeerSSLStatusDisconnected          StreamPeerSSLStatus = 0
+	StreamPeerSSLStatusErrorHostnameMismatch StreamPeerSSLStatus = 3
+	StreamPeerSSLStatusErrorNoCertificate    StreamPeerSSLStatus = 2
+)
+
+// String will return the Godot name of the StreamPeerSSLStatus value.
+func (e StreamPeerSSLStatus) String() string {
+	switch e {
+	case StreamPeerSSLStatusConnected:
+		return "STATUS_CONNECTED"
+	case StreamPeerSSLStatusDisconnected:
+		return "STATUS_DISCONNECTED"
+	case StreamPeerSSLStatusErrorHostnameMismatch:
+		return "STATUS_ERROR_HOSTNAME_MISMATCH"
+	case StreamPeerSSLStatusErrorNoCertificate:
+		return "STATUS_ERROR_NO_CERTIFICATE"
+	}
+	return fmt.Sprintf("StreamPeerSSLStatus(%d)", int(e))
+}
+
+// ParseStreamPeerSSLStatus will return the StreamPeerSSLStatus value with the given Godot name.
+func ParseStreamPeerSSLStatus(name string) (StreamPeerSSLStatus, error) {
+	switch name {
+	case "STATUS_CONNECTED":
+		return StreamPeerSSLStatusConnected, nil
+	case "STATUS_DISCONNECTED":
+		return StreamPeerSSLStatusDisconnected, nil
+	case "STATUS_ERROR_HOSTNAME_MISMATCH":
+		return StreamPeerSSLStatusErrorHostnameMismatch, nil
+	case "STATUS_ERROR_NO_CERTIFICATE":
+		return StreamPeerSSLStatusErrorNoCertificate, nil
+	}
+	return 0, fmt.Errorf("invalid StreamPeerSSLStatus value %q", name)
+}
+
+/*
+SSL Stream peer. This object can be used to connect to SSL servers.
+*/
+type StreamPeerSSL struct {
+	StreamPeer
+	owner gdnative.Object
+}
+
+// classConstructorStreamPeerSSL is the class constructor of the StreamPeerSSL class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStreamPeerSSL = gdnative.NewLazyClassConstructor("StreamPeerSSL")
+
+// NewStreamPeerSSL will create a new instance of the StreamPeerSSL class.
+// StreamPeerSSL is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStreamPeerSSL() *StreamPeerSSL {
+	obj := &StreamPeerSSL{}
+	obj.SetBaseObject(classConstructorStreamPeerSSL.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *StreamPeerSSL) BaseClass() string {
+	return "StreamPeerSSL"
+}
+
+// Method binds of the StreamPeerSSL class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStreamPeerSSLAcceptStream         = gdnative.NewLazyMethodBind("StreamPeerSSL", "accept_stream")
+	methodBindStreamPeerSSLConnectToStream      = gdnative.NewLazyMethodBind("StreamPeerSSL", "connect_to_stream")
+	methodBindStreamPeerSSLDisconnectFromStream = gdnative.NewLazyMethodBind("StreamPeerSSL", "disconnect_from_stream")
+	methodBindStreamPeerSSLGetStatus            = gdnative.NewLazyMethodBind("StreamPeerSSL", "get_status")
+)
+
+/*
+Args: [{ false stream StreamPeer}], Returns: enum.Error
+*/
+func (o *StreamPeerSSL) AcceptStream(stream StreamPeerImplementer) gdnative.Error {
+	//log.Println("Calling StreamPeerSSL.AcceptStream()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(stream))
+
+	// Get the method bind
+	methodBind := methodBindStreamPeerSSLAcceptStream.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Connect to a peer using an underlying [StreamPeer] "stream", when "validate_certs" is true, [code]StreamPeerSSL[/code] will validate that the certificate presented by the peer matches the "for_hostname".
+		Args: [{ false stream StreamPeer} {False true validate_certs bool} { true for_hostname String}], Returns: enum.Error
+*/
+func (o *StreamPeerSSL) ConnectToStream(stream StreamPeerImplementer, validateCerts gdnative.Bool, forHostname gdnative.String) gdnative.Error {
+	//log.Println("Calling StreamPeerSSL.ConnectToStream()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(stream))
+	ptrArguments[1] = gdnative.NewPointerFromBool(validateCerts)
+	ptrArguments[2] = gdnative.NewPointerFromString(forHostname)
+
+	// Get the method bind
+	methodBind := methodBindStreamPeerSSLConnectToStream.Get()
+
+	// Call the parent method.
+	// enum.Error
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.Error(ret)
+}
+
+/*
+	        Disconnect from host.
+		Args: [], Returns: void
+*/
+func (o *StreamPeerSSL) DisconnectFromStream() {
+	//log.Println("Calling StreamPeerSSL.DisconnectFromStream()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStreamPeerSSLDisconnectFromStream.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Return the status of the connection, one of STATUS_* enum.
+		Args: [], Returns: enum.StreamPeerSSL::Status
+*/
+func (o *StreamPeerSSL) GetStatus() StreamPeerSSLStatus {
+	//log.Println("Calling StreamPeerSSL.GetStatus()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStreamPeerSSLGetStatus.Get()
+
+	// Call the parent method.
+	// enum.StreamPeerSSL::Status
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return StreamPeerSSLStatus(ret)
+}
+
+// ConnectToStreamWithDefaults will call ConnectToStream using the default values for: validate_certs, for_hostname.
+func (o *StreamPeerSSL) ConnectToStreamWithDefaults(stream StreamPeerImplementer) gdnative.Error {
+	return o.ConnectToStream(stream, false, "")
+}
+
+// StreamPeerSSLImplementer is an interface that implements the methods
+// of the StreamPeerSSL class.
+type StreamPeerSSLImplementer interface {
+	StreamPeerImplementer
+	AcceptStream(stream StreamPeerImplementer) gdnative.Error
+	ConnectToStream(stream StreamPeerImplementer, validateCerts gdnative.Bool, forHostname gdnative.String) gdnative.Error
+	DisconnectFromStream()
+	GetStatus() StreamPeerSSLStatus
+	ConnectToStreamWithDefaults(stream StreamPeerImplementer) gdnative.Error
+}
@@ -1,7 +1,10 @@
-package godot
+package streampeer
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -23,13 +26,34 @@ const (
 	StreamPeerTCPStatusNone       StreamPeerTCPStatus = 0
 )
 
-//func NewStreamPeerTCPFromPointer(ptr gdnative.Pointer) StreamPeerTCP {
-func newStreamPeerTCPFromPointer(ptr gdnative.Pointer) StreamPeerTCP {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StreamPeerTCP{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the StreamPeerTCPStatus value.
+func (e StreamPeerTCPStatus) String() string {
+	switch e {
+	case StreamPeerTCPStatusConnected:
+		return "STATUS_CONNECTED"
+	case StreamPeerTCPStatusConnecting:
+		return "STATUS_CONNECTING"
+	case StreamPeerTCPStatusError:
+		return "STATUS_ERROR"
+	case StreamPeerTCPStatusNone:
+		return "STATUS_NONE"
+	}
+	return fmt.Sprintf("StreamPeerTCPStatus(%d)", int(e))
+}
 
-	return obj
+// ParseStreamPeerTCPStatus will return the StreamPeerTCPStatus value with the given Godot name.
+func ParseStreamPeerTCPStatus(name string) (StreamPeerTCPStatus, error) {
+	switch name {
+	case "STATUS_CONNECTED":
+		return StreamPeerTCPStatusConnected, nil
+	case "STATUS_CONNECTING":
+		return StreamPeerTCPStatusConnecting, nil
+	case "STATUS_ERROR":
+		return StreamPeerTCPStatusError, nil
+	case "STATUS_NONE":
+		return StreamPeerTCPStatusNone, nil
+	}
+	return 0, fmt.Errorf("invalid StreamPeerTCPStatus value %q", name)
 }
 
 /*
@@ -40,13 +64,42 @@ type StreamPeerTCP struct {
 	owner gdnative.Object
 }
 
+// classConstructorStreamPeerTCP is the class constructor of the StreamPeerTCP class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStreamPeerTCP = gdnative.NewLazyClassConstructor("StreamPeerTCP")
+
+// NewStreamPeerTCP will create a new instance of the StreamPeerTCP class.
+// StreamPeerTCP is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStreamPeerTCP() *StreamPeerTCP {
+	obj := &StreamPeerTCP{}
+	obj.SetBaseObject(classConstructorStreamPeerTCP.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *StreamPeerTCP) BaseClass() string {
 	return "StreamPeerTCP"
 }
 
+// Method binds of the StreamPeerTCP class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStreamPeerTCPConnectToHost      = gdnative.NewLazyMethodBind("StreamPeerTCP", "connect_to_host")
+	methodBindStreamPeerTCPDisconnectFromHost = gdnative.NewLazyMethodBind("StreamPeerTCP", "disconnect_from_host")
+	methodBindStreamPeerTCPGetConnectedHost   = gdnative.NewLazyMethodBind("StreamPeerTCP", "get_connected_host")
+	methodBindStreamPeerTCPGetConnectedPort   = gdnative.NewLazyMethodBind("StreamPeerTCP", "get_connected_port")
+	methodBindStreamPeerTCPGetStatus          = gdnative.NewLazyMethodBind("StreamPeerTCP", "get_status")
+	methodBindStreamPeerTCPIsConnectedToHost  = gdnative.NewLazyMethodBind("StreamPeerTCP", "is_connected_to_host")
+	methodBindStreamPeerTCPSetNoDelay         = gdnative.NewLazyMethodBind("StreamPeerTCP", "set_no_delay")
+)
+
 /*
-        Connect to the specified host:port pair. A hostname will be resolved if valid. Returns [OK] on success or [FAILED] on failure.
-	Args: [{ false host String} { false port int}], Returns: enum.Error
+	        Connect to the specified host:port pair. A hostname will be resolved if valid. Returns [OK] on success or [FAILED] on failure.
+		Args: [{ false host String} { false port int}], Returns: enum.Error
 */
 func (o *StreamPeerTCP) ConnectToHost(host gdnative.String, port gdnative.Int) gdnative.Error {
 	//log.Println("Calling StreamPeerTCP.ConnectToHost()")
@@ -57,7 +110,7 @@ func (o *StreamPeerTCP) ConnectToHost(host gdnative.String, port gdnative.Int) g
 	ptrArguments[1] = gdnative.NewPointerFromInt(port)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerTCP", "connect_to_host")
+	methodBind := methodBindStreamPeerTCPConnectToHost.Get()
 
 	// Call the parent method.
 	// enum.Error
@@ -70,8 +123,8 @@ func (o *StreamPeerTCP) ConnectToHost(host gdnative.String, port gdnative.Int) g
 }
 
 /*
-        Disconnect from host.
-	Args: [], Returns: void
+	        Disconnect from host.
+		Args: [], Returns: void
 */
 func (o *StreamPeerTCP) DisconnectFromHost() {
 	//log.Println("Calling StreamPeerTCP.DisconnectFromHost()")
@@ -80,7 +133,7 @@ func (o *StreamPeerTCP) DisconnectFromHost() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerTCP", "disconnect_from_host")
+	methodBind := methodBindStreamPeerTCPDisconnectFromHost.Get()
 
 	// Call the parent method.
 	// void
@@ -90,8 +143,8 @@ func (o *StreamPeerTCP) DisconnectFromHost() {
 }
 
 /*
-        Return the IP of this peer.
-	Args: [], Returns: String
+	        Return the IP of this peer.
+		Args: [], Returns: String
 */
 func (o *StreamPeerTCP) GetConnectedHost() gdnative.String {
 	//log.Println("Calling StreamPeerTCP.GetConnectedHost()")
@@ -100,7 +153,7 @@ func (o *StreamPeerTCP) GetConnectedHost() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerTCP", "get_connected_host")
+	methodBind := methodBindStreamPeerTCPGetConnectedHost.Get()
 
 	// Call the parent method.
 	// String
@@ -113,8 +166,8 @@ func (o *StreamPeerTCP) GetConnectedHost() gdnative.String {
 }
 
 /*
-        Return the port of this peer.
-	Args: [], Returns: int
+	        Return the port of this peer.
+		Args: [], Returns: int
 */
 func (o *StreamPeerTCP) GetConnectedPort() gdnative.Int {
 	//log.Println("Calling StreamPeerTCP.GetConnectedPort()")
@@ -123,7 +176,7 @@ func (o *StreamPeerTCP) GetConnectedPort() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerTCP", "get_connected_port")
+	methodBind := methodBindStreamPeerTCPGetConnectedPort.Get()
 
 	// Call the parent method.
 	// int
@@ -136,8 +189,8 @@ func (o *StreamPeerTCP) GetConnectedPort() gdnative.Int {
 }
 
 /*
-        Return the status of the connection, one of STATUS_* enum.
-	Args: [], Returns: enum.StreamPeerTCP::Status
+	        Return the status of the connection, one of STATUS_* enum.
+		Args: [], Returns: enum.StreamPeerTCP::Status
 */
 func (o *StreamPeerTCP) GetStatus() StreamPeerTCPStatus {
 	//log.Println("Calling StreamPeerTCP.GetStatus()")
@@ -146,7 +199,7 @@ func (o *StreamPeerTCP) GetStatus() StreamPeerTCPStatus {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerTCP", "get_status")
+	methodBind := methodBindStreamPeerTCPGetStatus.Get()
 
 	// Call the parent method.
 	// enum.StreamPeerTCP::Status
@@ -159,8 +212,7 @@ func (o *StreamPeerTCP) GetStatus() StreamPeerTCPStatus {
 }
 
 /*
-
-	Args: [], Returns: bool
+Args: [], Returns: bool
 */
 func (o *StreamPeerTCP) IsConnectedToHost() gdnative.Bool {
 	//log.Println("Calling StreamPeerTCP.IsConnectedToHost()")
@@ -169,7 +221,7 @@ func (o *StreamPeerTCP) IsConnectedToHost() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerTCP", "is_connected_to_host")
+	methodBind := methodBindStreamPeerTCPIsConnectedToHost.Get()
 
 	// Call the parent method.
 	// bool
@@ -182,8 +234,8 @@ func (o *StreamPeerTCP) IsConnectedToHost() gdnative.Bool {
 }
 
 /*
-        Disable Nagle algorithm to improve latency for small packets. Note that for applications that send large packets, or need to transfer a lot of data, this can reduce total bandwidth.
-	Args: [{ false enabled bool}], Returns: void
+	        Disable Nagle algorithm to improve latency for small packets. Note that for applications that send large packets, or need to transfer a lot of data, this can reduce total bandwidth.
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *StreamPeerTCP) SetNoDelay(enabled gdnative.Bool) {
 	//log.Println("Calling StreamPeerTCP.SetNoDelay()")
@@ -193,7 +245,7 @@ func (o *StreamPeerTCP) SetNoDelay(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StreamPeerTCP", "set_no_delay")
+	methodBind := methodBindStreamPeerTCPSetNoDelay.Get()
 
 	// Call the parent method.
 	// void
@@ -206,9 +258,11 @@ func (o *StreamPeerTCP) SetNoDelay(enabled gdnative.Bool) {
 // of the StreamPeerTCP class.
 type StreamPeerTCPImplementer interface {
 	StreamPeerImplementer
+	ConnectToHost(host gdnative.String, port gdnative.Int) gdnative.Error
 	DisconnectFromHost()
 	GetConnectedHost() gdnative.String
 	GetConnectedPort() gdnative.Int
+	GetStatus() StreamPeerTCPStatus
 	IsConnectedToHost() gdnative.Bool
 	SetNoDelay(enabled gdnative.Bool)
 }
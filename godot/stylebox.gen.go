@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStyleBoxFromPointer(ptr gdnative.Pointer) StyleBox {
-func newStyleBoxFromPointer(ptr gdnative.Pointer) StyleBox {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StyleBox{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 StyleBox is [Resource] that provides an abstract base class for drawing stylized boxes for the UI. StyleBoxes are used for drawing the styles of buttons, line edit backgrounds, tree backgrounds, etc. and also for testing a transparency mask for pointer signals. If mask test fails on a StyleBox assigned as mask to a control, clicks and motion signals will go through it to the one below.
 */
@@ -34,9 +25,21 @@ func (o *StyleBox) BaseClass() string {
 	return "StyleBox"
 }
 
-/*
+// Method binds of the StyleBox class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStyleBoxDraw             = gdnative.NewLazyMethodBind("StyleBox", "draw")
+	methodBindStyleBoxGetCenterSize    = gdnative.NewLazyMethodBind("StyleBox", "get_center_size")
+	methodBindStyleBoxGetDefaultMargin = gdnative.NewLazyMethodBind("StyleBox", "get_default_margin")
+	methodBindStyleBoxGetMargin        = gdnative.NewLazyMethodBind("StyleBox", "get_margin")
+	methodBindStyleBoxGetMinimumSize   = gdnative.NewLazyMethodBind("StyleBox", "get_minimum_size")
+	methodBindStyleBoxGetOffset        = gdnative.NewLazyMethodBind("StyleBox", "get_offset")
+	methodBindStyleBoxSetDefaultMargin = gdnative.NewLazyMethodBind("StyleBox", "set_default_margin")
+	methodBindStyleBoxTestMask         = gdnative.NewLazyMethodBind("StyleBox", "test_mask")
+)
 
-	Args: [{ false canvas_item RID} { false rect Rect2}], Returns: void
+/*
+Args: [{ false canvas_item RID} { false rect Rect2}], Returns: void
 */
 func (o *StyleBox) Draw(canvasItem gdnative.Rid, rect gdnative.Rect2) {
 	//log.Println("Calling StyleBox.Draw()")
@@ -47,7 +50,7 @@ func (o *StyleBox) Draw(canvasItem gdnative.Rid, rect gdnative.Rect2) {
 	ptrArguments[1] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "draw")
+	methodBind := methodBindStyleBoxDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -57,8 +60,7 @@ func (o *StyleBox) Draw(canvasItem gdnative.Rid, rect gdnative.Rect2) {
 }
 
 /*
-
-	Args: [], Returns: Vector2
+Args: [], Returns: Vector2
 */
 func (o *StyleBox) GetCenterSize() gdnative.Vector2 {
 	//log.Println("Calling StyleBox.GetCenterSize()")
@@ -67,7 +69,7 @@ func (o *StyleBox) GetCenterSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "get_center_size")
+	methodBind := methodBindStyleBoxGetCenterSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -80,8 +82,8 @@ func (o *StyleBox) GetCenterSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: float
+	        Undocumented
+		Args: [{ false margin int}], Returns: float
 */
 func (o *StyleBox) GetDefaultMargin(margin gdnative.Int) gdnative.Real {
 	//log.Println("Calling StyleBox.GetDefaultMargin()")
@@ -91,7 +93,7 @@ func (o *StyleBox) GetDefaultMargin(margin gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "get_default_margin")
+	methodBind := methodBindStyleBoxGetDefaultMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +106,8 @@ func (o *StyleBox) GetDefaultMargin(margin gdnative.Int) gdnative.Real {
 }
 
 /*
-        Return the offset of margin "margin" (see MARGIN_* enum).
-	Args: [{ false margin int}], Returns: float
+	        Return the offset of margin "margin" (see MARGIN_* enum).
+		Args: [{ false margin int}], Returns: float
 */
 func (o *StyleBox) GetMargin(margin gdnative.Int) gdnative.Real {
 	//log.Println("Calling StyleBox.GetMargin()")
@@ -115,7 +117,7 @@ func (o *StyleBox) GetMargin(margin gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "get_margin")
+	methodBind := methodBindStyleBoxGetMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -128,8 +130,8 @@ func (o *StyleBox) GetMargin(margin gdnative.Int) gdnative.Real {
 }
 
 /*
-        Return the minimum size that this stylebox can be shrunk to.
-	Args: [], Returns: Vector2
+	        Return the minimum size that this stylebox can be shrunk to.
+		Args: [], Returns: Vector2
 */
 func (o *StyleBox) GetMinimumSize() gdnative.Vector2 {
 	//log.Println("Calling StyleBox.GetMinimumSize()")
@@ -138,7 +140,7 @@ func (o *StyleBox) GetMinimumSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "get_minimum_size")
+	methodBind := methodBindStyleBoxGetMinimumSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -151,8 +153,8 @@ func (o *StyleBox) GetMinimumSize() gdnative.Vector2 {
 }
 
 /*
-        Return the "offset" of a stylebox, this is a helper function, like writing [code]Vector2(style.get_margin(MARGIN_LEFT), style.get_margin(MARGIN_TOP))[/code].
-	Args: [], Returns: Vector2
+	        Return the "offset" of a stylebox, this is a helper function, like writing [code]Vector2(style.get_margin(MARGIN_LEFT), style.get_margin(MARGIN_TOP))[/code].
+		Args: [], Returns: Vector2
 */
 func (o *StyleBox) GetOffset() gdnative.Vector2 {
 	//log.Println("Calling StyleBox.GetOffset()")
@@ -161,7 +163,7 @@ func (o *StyleBox) GetOffset() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "get_offset")
+	methodBind := methodBindStyleBoxGetOffset.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -174,8 +176,8 @@ func (o *StyleBox) GetOffset() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false offset float}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false offset float}], Returns: void
 */
 func (o *StyleBox) SetDefaultMargin(margin gdnative.Int, offset gdnative.Real) {
 	//log.Println("Calling StyleBox.SetDefaultMargin()")
@@ -186,7 +188,7 @@ func (o *StyleBox) SetDefaultMargin(margin gdnative.Int, offset gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(offset)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "set_default_margin")
+	methodBind := methodBindStyleBoxSetDefaultMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -196,8 +198,8 @@ func (o *StyleBox) SetDefaultMargin(margin gdnative.Int, offset gdnative.Real) {
 }
 
 /*
-        Test a position in a rectangle, return whether it passes the mask test.
-	Args: [{ false point Vector2} { false rect Rect2}], Returns: bool
+	        Test a position in a rectangle, return whether it passes the mask test.
+		Args: [{ false point Vector2} { false rect Rect2}], Returns: bool
 */
 func (o *StyleBox) TestMask(point gdnative.Vector2, rect gdnative.Rect2) gdnative.Bool {
 	//log.Println("Calling StyleBox.TestMask()")
@@ -208,7 +210,7 @@ func (o *StyleBox) TestMask(point gdnative.Vector2, rect gdnative.Rect2) gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBox", "test_mask")
+	methodBind := methodBindStyleBoxTestMask.Get()
 
 	// Call the parent method.
 	// bool
@@ -220,6 +222,46 @@ func (o *StyleBox) TestMask(point gdnative.Vector2, rect gdnative.Rect2) gdnativ
 	return ret
 }
 
+// ContentMarginBottom will return the value of the "content_margin_bottom" property.
+func (o *StyleBox) ContentMarginBottom() gdnative.Real {
+	return o.GetDefaultMargin(3)
+}
+
+// SetContentMarginBottom will set the value of the "content_margin_bottom" property.
+func (o *StyleBox) SetContentMarginBottom(value gdnative.Real) {
+	o.SetDefaultMargin(3, value)
+}
+
+// ContentMarginLeft will return the value of the "content_margin_left" property.
+func (o *StyleBox) ContentMarginLeft() gdnative.Real {
+	return o.GetDefaultMargin(0)
+}
+
+// SetContentMarginLeft will set the value of the "content_margin_left" property.
+func (o *StyleBox) SetContentMarginLeft(value gdnative.Real) {
+	o.SetDefaultMargin(0, value)
+}
+
+// ContentMarginRight will return the value of the "content_margin_right" property.
+func (o *StyleBox) ContentMarginRight() gdnative.Real {
+	return o.GetDefaultMargin(2)
+}
+
+// SetContentMarginRight will set the value of the "content_margin_right" property.
+func (o *StyleBox) SetContentMarginRight(value gdnative.Real) {
+	o.SetDefaultMargin(2, value)
+}
+
+// ContentMarginTop will return the value of the "content_margin_top" property.
+func (o *StyleBox) ContentMarginTop() gdnative.Real {
+	return o.GetDefaultMargin(1)
+}
+
+// SetContentMarginTop will set the value of the "content_margin_top" property.
+func (o *StyleBox) SetContentMarginTop(value gdnative.Real) {
+	o.SetDefaultMargin(1, value)
+}
+
 // StyleBoxImplementer is an interface that implements the methods
 // of the StyleBox class.
 type StyleBoxImplementer interface {
@@ -232,4 +274,12 @@ type StyleBoxImplementer interface {
 	GetOffset() gdnative.Vector2
 	SetDefaultMargin(margin gdnative.Int, offset gdnative.Real)
 	TestMask(point gdnative.Vector2, rect gdnative.Rect2) gdnative.Bool
+	ContentMarginBottom() gdnative.Real
+	SetContentMarginBottom(value gdnative.Real)
+	ContentMarginLeft() gdnative.Real
+	SetContentMarginLeft(value gdnative.Real)
+	ContentMarginRight() gdnative.Real
+	SetContentMarginRight(value gdnative.Real)
+	ContentMarginTop() gdnative.Real
+	SetContentMarginTop(value gdnative.Real)
 }
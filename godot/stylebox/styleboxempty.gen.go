@@ -0,0 +1,50 @@
+package stylebox
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Empty stylebox (really does not display anything).
+*/
+type StyleBoxEmpty struct {
+	godot.StyleBox
+	owner gdnative.Object
+}
+
+// classConstructorStyleBoxEmpty is the class constructor of the StyleBoxEmpty class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStyleBoxEmpty = gdnative.NewLazyClassConstructor("StyleBoxEmpty")
+
+// NewStyleBoxEmpty will create a new instance of the StyleBoxEmpty class.
+// StyleBoxEmpty is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStyleBoxEmpty() *StyleBoxEmpty {
+	obj := &StyleBoxEmpty{}
+	obj.SetBaseObject(classConstructorStyleBoxEmpty.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *StyleBoxEmpty) BaseClass() string {
+	return "StyleBoxEmpty"
+}
+
+// StyleBoxEmptyImplementer is an interface that implements the methods
+// of the StyleBoxEmpty class.
+type StyleBoxEmptyImplementer interface {
+	godot.StyleBoxImplementer
+}
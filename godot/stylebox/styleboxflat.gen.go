@@ -1,7 +1,8 @@
-package godot
+package stylebox
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,73 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStyleBoxFlatFromPointer(ptr gdnative.Pointer) StyleBoxFlat {
-func newStyleBoxFlatFromPointer(ptr gdnative.Pointer) StyleBoxFlat {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StyleBoxFlat{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This stylebox can be used to achieve all kinds of looks without the need of a texture. Those properties are customizable: - Color - Border width (individual width for each border) - Rounded corners (individual radius for each corner) - Shadow About corner radius: Setting corner radius to high values is allowed. As soon as corners would overlap the stylebox will switch to a relative system. Example: [codeblock] height = 30 corner_radius_top_left = 50 corner_radius_bottom_left = 100 [/codeblock] The relative system now would take the 1:2 ratio of the two left corners to calculate the actual corner width. Both corners added will [b]never[/b] be more than the height. Result: [codeblock] corner_radius_top_left: 10 corner_radius_bottom_left: 20 [/codeblock]
 */
 type StyleBoxFlat struct {
-	StyleBox
+	godot.StyleBox
 	owner gdnative.Object
 }
 
+// classConstructorStyleBoxFlat is the class constructor of the StyleBoxFlat class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStyleBoxFlat = gdnative.NewLazyClassConstructor("StyleBoxFlat")
+
+// NewStyleBoxFlat will create a new instance of the StyleBoxFlat class.
+// StyleBoxFlat is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStyleBoxFlat() *StyleBoxFlat {
+	obj := &StyleBoxFlat{}
+	obj.SetBaseObject(classConstructorStyleBoxFlat.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *StyleBoxFlat) BaseClass() string {
 	return "StyleBoxFlat"
 }
 
+// Method binds of the StyleBoxFlat class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStyleBoxFlatGetAaSize                 = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_aa_size")
+	methodBindStyleBoxFlatGetBgColor                = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_bg_color")
+	methodBindStyleBoxFlatGetBorderBlend            = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_border_blend")
+	methodBindStyleBoxFlatGetBorderColor            = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_border_color")
+	methodBindStyleBoxFlatGetBorderWidth            = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_border_width")
+	methodBindStyleBoxFlatGetBorderWidthMin         = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_border_width_min")
+	methodBindStyleBoxFlatGetCornerDetail           = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_corner_detail")
+	methodBindStyleBoxFlatGetCornerRadius           = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_corner_radius")
+	methodBindStyleBoxFlatGetExpandMargin           = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_expand_margin")
+	methodBindStyleBoxFlatGetShadowColor            = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_shadow_color")
+	methodBindStyleBoxFlatGetShadowSize             = gdnative.NewLazyMethodBind("StyleBoxFlat", "get_shadow_size")
+	methodBindStyleBoxFlatIsAntiAliased             = gdnative.NewLazyMethodBind("StyleBoxFlat", "is_anti_aliased")
+	methodBindStyleBoxFlatIsDrawCenterEnabled       = gdnative.NewLazyMethodBind("StyleBoxFlat", "is_draw_center_enabled")
+	methodBindStyleBoxFlatSetAaSize                 = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_aa_size")
+	methodBindStyleBoxFlatSetAntiAliased            = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_anti_aliased")
+	methodBindStyleBoxFlatSetBgColor                = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_bg_color")
+	methodBindStyleBoxFlatSetBorderBlend            = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_border_blend")
+	methodBindStyleBoxFlatSetBorderColor            = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_border_color")
+	methodBindStyleBoxFlatSetBorderWidth            = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_border_width")
+	methodBindStyleBoxFlatSetBorderWidthAll         = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_border_width_all")
+	methodBindStyleBoxFlatSetCornerDetail           = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_corner_detail")
+	methodBindStyleBoxFlatSetCornerRadius           = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_corner_radius")
+	methodBindStyleBoxFlatSetCornerRadiusAll        = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_corner_radius_all")
+	methodBindStyleBoxFlatSetCornerRadiusIndividual = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_corner_radius_individual")
+	methodBindStyleBoxFlatSetDrawCenter             = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_draw_center")
+	methodBindStyleBoxFlatSetExpandMargin           = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_expand_margin")
+	methodBindStyleBoxFlatSetExpandMarginAll        = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_expand_margin_all")
+	methodBindStyleBoxFlatSetExpandMarginIndividual = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_expand_margin_individual")
+	methodBindStyleBoxFlatSetShadowColor            = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_shadow_color")
+	methodBindStyleBoxFlatSetShadowSize             = gdnative.NewLazyMethodBind("StyleBoxFlat", "set_shadow_size")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *StyleBoxFlat) GetAaSize() gdnative.Int {
 	//log.Println("Calling StyleBoxFlat.GetAaSize()")
@@ -45,7 +89,7 @@ func (o *StyleBoxFlat) GetAaSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_aa_size")
+	methodBind := methodBindStyleBoxFlatGetAaSize.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +102,8 @@ func (o *StyleBoxFlat) GetAaSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *StyleBoxFlat) GetBgColor() gdnative.Color {
 	//log.Println("Calling StyleBoxFlat.GetBgColor()")
@@ -68,7 +112,7 @@ func (o *StyleBoxFlat) GetBgColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_bg_color")
+	methodBind := methodBindStyleBoxFlatGetBgColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -81,8 +125,8 @@ func (o *StyleBoxFlat) GetBgColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *StyleBoxFlat) GetBorderBlend() gdnative.Bool {
 	//log.Println("Calling StyleBoxFlat.GetBorderBlend()")
@@ -91,7 +135,7 @@ func (o *StyleBoxFlat) GetBorderBlend() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_border_blend")
+	methodBind := methodBindStyleBoxFlatGetBorderBlend.Get()
 
 	// Call the parent method.
 	// bool
@@ -104,8 +148,8 @@ func (o *StyleBoxFlat) GetBorderBlend() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *StyleBoxFlat) GetBorderColor() gdnative.Color {
 	//log.Println("Calling StyleBoxFlat.GetBorderColor()")
@@ -114,7 +158,7 @@ func (o *StyleBoxFlat) GetBorderColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_border_color")
+	methodBind := methodBindStyleBoxFlatGetBorderColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -127,8 +171,8 @@ func (o *StyleBoxFlat) GetBorderColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: int
+	        Undocumented
+		Args: [{ false margin int}], Returns: int
 */
 func (o *StyleBoxFlat) GetBorderWidth(margin gdnative.Int) gdnative.Int {
 	//log.Println("Calling StyleBoxFlat.GetBorderWidth()")
@@ -138,7 +182,7 @@ func (o *StyleBoxFlat) GetBorderWidth(margin gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_border_width")
+	methodBind := methodBindStyleBoxFlatGetBorderWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -151,8 +195,7 @@ func (o *StyleBoxFlat) GetBorderWidth(margin gdnative.Int) gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: int
+Args: [], Returns: int
 */
 func (o *StyleBoxFlat) GetBorderWidthMin() gdnative.Int {
 	//log.Println("Calling StyleBoxFlat.GetBorderWidthMin()")
@@ -161,7 +204,7 @@ func (o *StyleBoxFlat) GetBorderWidthMin() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_border_width_min")
+	methodBind := methodBindStyleBoxFlatGetBorderWidthMin.Get()
 
 	// Call the parent method.
 	// int
@@ -174,8 +217,8 @@ func (o *StyleBoxFlat) GetBorderWidthMin() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *StyleBoxFlat) GetCornerDetail() gdnative.Int {
 	//log.Println("Calling StyleBoxFlat.GetCornerDetail()")
@@ -184,7 +227,7 @@ func (o *StyleBoxFlat) GetCornerDetail() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_corner_detail")
+	methodBind := methodBindStyleBoxFlatGetCornerDetail.Get()
 
 	// Call the parent method.
 	// int
@@ -197,8 +240,8 @@ func (o *StyleBoxFlat) GetCornerDetail() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false corner int}], Returns: int
+	        Undocumented
+		Args: [{ false corner int}], Returns: int
 */
 func (o *StyleBoxFlat) GetCornerRadius(corner gdnative.Int) gdnative.Int {
 	//log.Println("Calling StyleBoxFlat.GetCornerRadius()")
@@ -208,7 +251,7 @@ func (o *StyleBoxFlat) GetCornerRadius(corner gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(corner)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_corner_radius")
+	methodBind := methodBindStyleBoxFlatGetCornerRadius.Get()
 
 	// Call the parent method.
 	// int
@@ -221,8 +264,8 @@ func (o *StyleBoxFlat) GetCornerRadius(corner gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int}], Returns: float
+	        Undocumented
+		Args: [{ false margin int}], Returns: float
 */
 func (o *StyleBoxFlat) GetExpandMargin(margin gdnative.Int) gdnative.Real {
 	//log.Println("Calling StyleBoxFlat.GetExpandMargin()")
@@ -232,7 +275,7 @@ func (o *StyleBoxFlat) GetExpandMargin(margin gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_expand_margin")
+	methodBind := methodBindStyleBoxFlatGetExpandMargin.Get()
 
 	// Call the parent method.
 	// float
@@ -245,8 +288,8 @@ func (o *StyleBoxFlat) GetExpandMargin(margin gdnative.Int) gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *StyleBoxFlat) GetShadowColor() gdnative.Color {
 	//log.Println("Calling StyleBoxFlat.GetShadowColor()")
@@ -255,7 +298,7 @@ func (o *StyleBoxFlat) GetShadowColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_shadow_color")
+	methodBind := methodBindStyleBoxFlatGetShadowColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -268,8 +311,8 @@ func (o *StyleBoxFlat) GetShadowColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *StyleBoxFlat) GetShadowSize() gdnative.Int {
 	//log.Println("Calling StyleBoxFlat.GetShadowSize()")
@@ -278,7 +321,7 @@ func (o *StyleBoxFlat) GetShadowSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "get_shadow_size")
+	methodBind := methodBindStyleBoxFlatGetShadowSize.Get()
 
 	// Call the parent method.
 	// int
@@ -291,8 +334,8 @@ func (o *StyleBoxFlat) GetShadowSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *StyleBoxFlat) IsAntiAliased() gdnative.Bool {
 	//log.Println("Calling StyleBoxFlat.IsAntiAliased()")
@@ -301,7 +344,7 @@ func (o *StyleBoxFlat) IsAntiAliased() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "is_anti_aliased")
+	methodBind := methodBindStyleBoxFlatIsAntiAliased.Get()
 
 	// Call the parent method.
 	// bool
@@ -314,8 +357,8 @@ func (o *StyleBoxFlat) IsAntiAliased() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *StyleBoxFlat) IsDrawCenterEnabled() gdnative.Bool {
 	//log.Println("Calling StyleBoxFlat.IsDrawCenterEnabled()")
@@ -324,7 +367,7 @@ func (o *StyleBoxFlat) IsDrawCenterEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "is_draw_center_enabled")
+	methodBind := methodBindStyleBoxFlatIsDrawCenterEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -337,8 +380,8 @@ func (o *StyleBoxFlat) IsDrawCenterEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size int}], Returns: void
 */
 func (o *StyleBoxFlat) SetAaSize(size gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetAaSize()")
@@ -348,7 +391,7 @@ func (o *StyleBoxFlat) SetAaSize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_aa_size")
+	methodBind := methodBindStyleBoxFlatSetAaSize.Get()
 
 	// Call the parent method.
 	// void
@@ -358,8 +401,8 @@ func (o *StyleBoxFlat) SetAaSize(size gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false anti_aliased bool}], Returns: void
+	        Undocumented
+		Args: [{ false anti_aliased bool}], Returns: void
 */
 func (o *StyleBoxFlat) SetAntiAliased(antiAliased gdnative.Bool) {
 	//log.Println("Calling StyleBoxFlat.SetAntiAliased()")
@@ -369,7 +412,7 @@ func (o *StyleBoxFlat) SetAntiAliased(antiAliased gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(antiAliased)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_anti_aliased")
+	methodBind := methodBindStyleBoxFlatSetAntiAliased.Get()
 
 	// Call the parent method.
 	// void
@@ -379,8 +422,8 @@ func (o *StyleBoxFlat) SetAntiAliased(antiAliased gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *StyleBoxFlat) SetBgColor(color gdnative.Color) {
 	//log.Println("Calling StyleBoxFlat.SetBgColor()")
@@ -390,7 +433,7 @@ func (o *StyleBoxFlat) SetBgColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_bg_color")
+	methodBind := methodBindStyleBoxFlatSetBgColor.Get()
 
 	// Call the parent method.
 	// void
@@ -400,8 +443,8 @@ func (o *StyleBoxFlat) SetBgColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false blend bool}], Returns: void
+	        Undocumented
+		Args: [{ false blend bool}], Returns: void
 */
 func (o *StyleBoxFlat) SetBorderBlend(blend gdnative.Bool) {
 	//log.Println("Calling StyleBoxFlat.SetBorderBlend()")
@@ -411,7 +454,7 @@ func (o *StyleBoxFlat) SetBorderBlend(blend gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(blend)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_border_blend")
+	methodBind := methodBindStyleBoxFlatSetBorderBlend.Get()
 
 	// Call the parent method.
 	// void
@@ -421,8 +464,8 @@ func (o *StyleBoxFlat) SetBorderBlend(blend gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *StyleBoxFlat) SetBorderColor(color gdnative.Color) {
 	//log.Println("Calling StyleBoxFlat.SetBorderColor()")
@@ -432,7 +475,7 @@ func (o *StyleBoxFlat) SetBorderColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_border_color")
+	methodBind := methodBindStyleBoxFlatSetBorderColor.Get()
 
 	// Call the parent method.
 	// void
@@ -442,8 +485,8 @@ func (o *StyleBoxFlat) SetBorderColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false width int}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false width int}], Returns: void
 */
 func (o *StyleBoxFlat) SetBorderWidth(margin gdnative.Int, width gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetBorderWidth()")
@@ -454,7 +497,7 @@ func (o *StyleBoxFlat) SetBorderWidth(margin gdnative.Int, width gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_border_width")
+	methodBind := methodBindStyleBoxFlatSetBorderWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -464,8 +507,7 @@ func (o *StyleBoxFlat) SetBorderWidth(margin gdnative.Int, width gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false width int}], Returns: void
+Args: [{ false width int}], Returns: void
 */
 func (o *StyleBoxFlat) SetBorderWidthAll(width gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetBorderWidthAll()")
@@ -475,7 +517,7 @@ func (o *StyleBoxFlat) SetBorderWidthAll(width gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_border_width_all")
+	methodBind := methodBindStyleBoxFlatSetBorderWidthAll.Get()
 
 	// Call the parent method.
 	// void
@@ -485,8 +527,8 @@ func (o *StyleBoxFlat) SetBorderWidthAll(width gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false detail int}], Returns: void
+	        Undocumented
+		Args: [{ false detail int}], Returns: void
 */
 func (o *StyleBoxFlat) SetCornerDetail(detail gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetCornerDetail()")
@@ -496,7 +538,7 @@ func (o *StyleBoxFlat) SetCornerDetail(detail gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(detail)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_corner_detail")
+	methodBind := methodBindStyleBoxFlatSetCornerDetail.Get()
 
 	// Call the parent method.
 	// void
@@ -506,8 +548,8 @@ func (o *StyleBoxFlat) SetCornerDetail(detail gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false corner int} { false radius int}], Returns: void
+	        Undocumented
+		Args: [{ false corner int} { false radius int}], Returns: void
 */
 func (o *StyleBoxFlat) SetCornerRadius(corner gdnative.Int, radius gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetCornerRadius()")
@@ -518,7 +560,7 @@ func (o *StyleBoxFlat) SetCornerRadius(corner gdnative.Int, radius gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_corner_radius")
+	methodBind := methodBindStyleBoxFlatSetCornerRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -528,8 +570,7 @@ func (o *StyleBoxFlat) SetCornerRadius(corner gdnative.Int, radius gdnative.Int)
 }
 
 /*
-
-	Args: [{ false radius int}], Returns: void
+Args: [{ false radius int}], Returns: void
 */
 func (o *StyleBoxFlat) SetCornerRadiusAll(radius gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetCornerRadiusAll()")
@@ -539,7 +580,7 @@ func (o *StyleBoxFlat) SetCornerRadiusAll(radius gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(radius)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_corner_radius_all")
+	methodBind := methodBindStyleBoxFlatSetCornerRadiusAll.Get()
 
 	// Call the parent method.
 	// void
@@ -549,8 +590,7 @@ func (o *StyleBoxFlat) SetCornerRadiusAll(radius gdnative.Int) {
 }
 
 /*
-
-	Args: [{ false radius_top_left int} { false radius_top_right int} { false radius_bottom_right int} { false radius_bottom_left int}], Returns: void
+Args: [{ false radius_top_left int} { false radius_top_right int} { false radius_bottom_right int} { false radius_bottom_left int}], Returns: void
 */
 func (o *StyleBoxFlat) SetCornerRadiusIndividual(radiusTopLeft gdnative.Int, radiusTopRight gdnative.Int, radiusBottomRight gdnative.Int, radiusBottomLeft gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetCornerRadiusIndividual()")
@@ -563,7 +603,7 @@ func (o *StyleBoxFlat) SetCornerRadiusIndividual(radiusTopLeft gdnative.Int, rad
 	ptrArguments[3] = gdnative.NewPointerFromInt(radiusBottomLeft)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_corner_radius_individual")
+	methodBind := methodBindStyleBoxFlatSetCornerRadiusIndividual.Get()
 
 	// Call the parent method.
 	// void
@@ -573,8 +613,8 @@ func (o *StyleBoxFlat) SetCornerRadiusIndividual(radiusTopLeft gdnative.Int, rad
 }
 
 /*
-        Undocumented
-	Args: [{ false draw_center bool}], Returns: void
+	        Undocumented
+		Args: [{ false draw_center bool}], Returns: void
 */
 func (o *StyleBoxFlat) SetDrawCenter(drawCenter gdnative.Bool) {
 	//log.Println("Calling StyleBoxFlat.SetDrawCenter()")
@@ -584,7 +624,7 @@ func (o *StyleBoxFlat) SetDrawCenter(drawCenter gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(drawCenter)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_draw_center")
+	methodBind := methodBindStyleBoxFlatSetDrawCenter.Get()
 
 	// Call the parent method.
 	// void
@@ -594,8 +634,8 @@ func (o *StyleBoxFlat) SetDrawCenter(drawCenter gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false margin int} { false size float}], Returns: void
+	        Undocumented
+		Args: [{ false margin int} { false size float}], Returns: void
 */
 func (o *StyleBoxFlat) SetExpandMargin(margin gdnative.Int, size gdnative.Real) {
 	//log.Println("Calling StyleBoxFlat.SetExpandMargin()")
@@ -606,7 +646,7 @@ func (o *StyleBoxFlat) SetExpandMargin(margin gdnative.Int, size gdnative.Real)
 	ptrArguments[1] = gdnative.NewPointerFromReal(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_expand_margin")
+	methodBind := methodBindStyleBoxFlatSetExpandMargin.Get()
 
 	// Call the parent method.
 	// void
@@ -616,8 +656,7 @@ func (o *StyleBoxFlat) SetExpandMargin(margin gdnative.Int, size gdnative.Real)
 }
 
 /*
-
-	Args: [{ false size float}], Returns: void
+Args: [{ false size float}], Returns: void
 */
 func (o *StyleBoxFlat) SetExpandMarginAll(size gdnative.Real) {
 	//log.Println("Calling StyleBoxFlat.SetExpandMarginAll()")
@@ -627,7 +666,7 @@ func (o *StyleBoxFlat) SetExpandMarginAll(size gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_expand_margin_all")
+	methodBind := methodBindStyleBoxFlatSetExpandMarginAll.Get()
 
 	// Call the parent method.
 	// void
@@ -637,8 +676,7 @@ func (o *StyleBoxFlat) SetExpandMarginAll(size gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false size_left float} { false size_top float} { false size_right float} { false size_bottom float}], Returns: void
+Args: [{ false size_left float} { false size_top float} { false size_right float} { false size_bottom float}], Returns: void
 */
 func (o *StyleBoxFlat) SetExpandMarginIndividual(sizeLeft gdnative.Real, sizeTop gdnative.Real, sizeRight gdnative.Real, sizeBottom gdnative.Real) {
 	//log.Println("Calling StyleBoxFlat.SetExpandMarginIndividual()")
@@ -651,7 +689,7 @@ func (o *StyleBoxFlat) SetExpandMarginIndividual(sizeLeft gdnative.Real, sizeTop
 	ptrArguments[3] = gdnative.NewPointerFromReal(sizeBottom)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_expand_margin_individual")
+	methodBind := methodBindStyleBoxFlatSetExpandMarginIndividual.Get()
 
 	// Call the parent method.
 	// void
@@ -661,8 +699,8 @@ func (o *StyleBoxFlat) SetExpandMarginIndividual(sizeLeft gdnative.Real, sizeTop
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *StyleBoxFlat) SetShadowColor(color gdnative.Color) {
 	//log.Println("Calling StyleBoxFlat.SetShadowColor()")
@@ -672,7 +710,7 @@ func (o *StyleBoxFlat) SetShadowColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_shadow_color")
+	methodBind := methodBindStyleBoxFlatSetShadowColor.Get()
 
 	// Call the parent method.
 	// void
@@ -682,8 +720,8 @@ func (o *StyleBoxFlat) SetShadowColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size int}], Returns: void
 */
 func (o *StyleBoxFlat) SetShadowSize(size gdnative.Int) {
 	//log.Println("Calling StyleBoxFlat.SetShadowSize()")
@@ -693,7 +731,7 @@ func (o *StyleBoxFlat) SetShadowSize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxFlat", "set_shadow_size")
+	methodBind := methodBindStyleBoxFlatSetShadowSize.Get()
 
 	// Call the parent method.
 	// void
@@ -702,10 +740,185 @@ func (o *StyleBoxFlat) SetShadowSize(size gdnative.Int) {
 
 }
 
+// AntiAliasing will return the value of the "anti_aliasing" property.
+func (o *StyleBoxFlat) AntiAliasing() gdnative.Bool {
+	return o.IsAntiAliased()
+}
+
+// SetAntiAliasing will set the value of the "anti_aliasing" property.
+func (o *StyleBoxFlat) SetAntiAliasing(value gdnative.Bool) {
+	o.SetAntiAliased(value)
+}
+
+// AntiAliasingSize will return the value of the "anti_aliasing_size" property.
+func (o *StyleBoxFlat) AntiAliasingSize() gdnative.Int {
+	return o.GetAaSize()
+}
+
+// SetAntiAliasingSize will set the value of the "anti_aliasing_size" property.
+func (o *StyleBoxFlat) SetAntiAliasingSize(value gdnative.Int) {
+	o.SetAaSize(value)
+}
+
+// BgColor will return the value of the "bg_color" property.
+func (o *StyleBoxFlat) BgColor() gdnative.Color {
+	return o.GetBgColor()
+}
+
+// BorderBlend will return the value of the "border_blend" property.
+func (o *StyleBoxFlat) BorderBlend() gdnative.Bool {
+	return o.GetBorderBlend()
+}
+
+// BorderColor will return the value of the "border_color" property.
+func (o *StyleBoxFlat) BorderColor() gdnative.Color {
+	return o.GetBorderColor()
+}
+
+// BorderWidthBottom will return the value of the "border_width_bottom" property.
+func (o *StyleBoxFlat) BorderWidthBottom() gdnative.Int {
+	return o.GetBorderWidth(3)
+}
+
+// SetBorderWidthBottom will set the value of the "border_width_bottom" property.
+func (o *StyleBoxFlat) SetBorderWidthBottom(value gdnative.Int) {
+	o.SetBorderWidth(3, value)
+}
+
+// BorderWidthLeft will return the value of the "border_width_left" property.
+func (o *StyleBoxFlat) BorderWidthLeft() gdnative.Int {
+	return o.GetBorderWidth(0)
+}
+
+// SetBorderWidthLeft will set the value of the "border_width_left" property.
+func (o *StyleBoxFlat) SetBorderWidthLeft(value gdnative.Int) {
+	o.SetBorderWidth(0, value)
+}
+
+// BorderWidthRight will return the value of the "border_width_right" property.
+func (o *StyleBoxFlat) BorderWidthRight() gdnative.Int {
+	return o.GetBorderWidth(2)
+}
+
+// SetBorderWidthRight will set the value of the "border_width_right" property.
+func (o *StyleBoxFlat) SetBorderWidthRight(value gdnative.Int) {
+	o.SetBorderWidth(2, value)
+}
+
+// BorderWidthTop will return the value of the "border_width_top" property.
+func (o *StyleBoxFlat) BorderWidthTop() gdnative.Int {
+	return o.GetBorderWidth(1)
+}
+
+// SetBorderWidthTop will set the value of the "border_width_top" property.
+func (o *StyleBoxFlat) SetBorderWidthTop(value gdnative.Int) {
+	o.SetBorderWidth(1, value)
+}
+
+// CornerDetail will return the value of the "corner_detail" property.
+func (o *StyleBoxFlat) CornerDetail() gdnative.Int {
+	return o.GetCornerDetail()
+}
+
+// CornerRadiusBottomLeft will return the value of the "corner_radius_bottom_left" property.
+func (o *StyleBoxFlat) CornerRadiusBottomLeft() gdnative.Int {
+	return o.GetCornerRadius(3)
+}
+
+// SetCornerRadiusBottomLeft will set the value of the "corner_radius_bottom_left" property.
+func (o *StyleBoxFlat) SetCornerRadiusBottomLeft(value gdnative.Int) {
+	o.SetCornerRadius(3, value)
+}
+
+// CornerRadiusBottomRight will return the value of the "corner_radius_bottom_right" property.
+func (o *StyleBoxFlat) CornerRadiusBottomRight() gdnative.Int {
+	return o.GetCornerRadius(2)
+}
+
+// SetCornerRadiusBottomRight will set the value of the "corner_radius_bottom_right" property.
+func (o *StyleBoxFlat) SetCornerRadiusBottomRight(value gdnative.Int) {
+	o.SetCornerRadius(2, value)
+}
+
+// CornerRadiusTopLeft will return the value of the "corner_radius_top_left" property.
+func (o *StyleBoxFlat) CornerRadiusTopLeft() gdnative.Int {
+	return o.GetCornerRadius(0)
+}
+
+// SetCornerRadiusTopLeft will set the value of the "corner_radius_top_left" property.
+func (o *StyleBoxFlat) SetCornerRadiusTopLeft(value gdnative.Int) {
+	o.SetCornerRadius(0, value)
+}
+
+// CornerRadiusTopRight will return the value of the "corner_radius_top_right" property.
+func (o *StyleBoxFlat) CornerRadiusTopRight() gdnative.Int {
+	return o.GetCornerRadius(1)
+}
+
+// SetCornerRadiusTopRight will set the value of the "corner_radius_top_right" property.
+func (o *StyleBoxFlat) SetCornerRadiusTopRight(value gdnative.Int) {
+	o.SetCornerRadius(1, value)
+}
+
+// DrawCenter will return the value of the "draw_center" property.
+func (o *StyleBoxFlat) DrawCenter() gdnative.Bool {
+	return o.IsDrawCenterEnabled()
+}
+
+// ExpandMarginBottom will return the value of the "expand_margin_bottom" property.
+func (o *StyleBoxFlat) ExpandMarginBottom() gdnative.Real {
+	return o.GetExpandMargin(3)
+}
+
+// SetExpandMarginBottom will set the value of the "expand_margin_bottom" property.
+func (o *StyleBoxFlat) SetExpandMarginBottom(value gdnative.Real) {
+	o.SetExpandMargin(3, value)
+}
+
+// ExpandMarginLeft will return the value of the "expand_margin_left" property.
+func (o *StyleBoxFlat) ExpandMarginLeft() gdnative.Real {
+	return o.GetExpandMargin(0)
+}
+
+// SetExpandMarginLeft will set the value of the "expand_margin_left" property.
+func (o *StyleBoxFlat) SetExpandMarginLeft(value gdnative.Real) {
+	o.SetExpandMargin(0, value)
+}
+
+// ExpandMarginRight will return the value of the "expand_margin_right" property.
+func (o *StyleBoxFlat) ExpandMarginRight() gdnative.Real {
+	return o.GetExpandMargin(2)
+}
+
+// SetExpandMarginRight will set the value of the "expand_margin_right" property.
+func (o *StyleBoxFlat) SetExpandMarginRight(value gdnative.Real) {
+	o.SetExpandMargin(2, value)
+}
+
+// ExpandMarginTop will return the value of the "expand_margin_top" property.
+func (o *StyleBoxFlat) ExpandMarginTop() gdnative.Real {
+	return o.GetExpandMargin(1)
+}
+
+// SetExpandMarginTop will set the value of the "expand_margin_top" property.
+func (o *StyleBoxFlat) SetExpandMarginTop(value gdnative.Real) {
+	o.SetExpandMargin(1, value)
+}
+
+// ShadowColor will return the value of the "shadow_color" property.
+func (o *StyleBoxFlat) ShadowColor() gdnative.Color {
+	return o.GetShadowColor()
+}
+
+// ShadowSize will return the value of the "shadow_size" property.
+func (o *StyleBoxFlat) ShadowSize() gdnative.Int {
+	return o.GetShadowSize()
+}
+
 // StyleBoxFlatImplementer is an interface that implements the methods
 // of the StyleBoxFlat class.
 type StyleBoxFlatImplementer interface {
-	StyleBoxImplementer
+	godot.StyleBoxImplementer
 	GetAaSize() gdnative.Int
 	GetBgColor() gdnative.Color
 	GetBorderBlend() gdnative.Bool
@@ -736,4 +949,39 @@ type StyleBoxFlatImplementer interface {
 	SetExpandMarginIndividual(sizeLeft gdnative.Real, sizeTop gdnative.Real, sizeRight gdnative.Real, sizeBottom gdnative.Real)
 	SetShadowColor(color gdnative.Color)
 	SetShadowSize(size gdnative.Int)
+	AntiAliasing() gdnative.Bool
+	SetAntiAliasing(value gdnative.Bool)
+	AntiAliasingSize() gdnative.Int
+	SetAntiAliasingSize(value gdnative.Int)
+	BgColor() gdnative.Color
+	BorderBlend() gdnative.Bool
+	BorderColor() gdnative.Color
+	BorderWidthBottom() gdnative.Int
+	SetBorderWidthBottom(value gdnative.Int)
+	BorderWidthLeft() gdnative.Int
+	SetBorderWidthLeft(value gdnative.Int)
+	BorderWidthRight() gdnative.Int
+	SetBorderWidthRight(value gdnative.Int)
+	BorderWidthTop() gdnative.Int
+	SetBorderWidthTop(value gdnative.Int)
+	CornerDetail() gdnative.Int
+	CornerRadiusBottomLeft() gdnative.Int
+	SetCornerRadiusBottomLeft(value gdnative.Int)
+	CornerRadiusBottomRight() gdnative.Int
+	SetCornerRadiusBottomRight(value gdnative.Int)
+	CornerRadiusTopLeft() gdnative.Int
+	SetCornerRadiusTopLeft(value gdnative.Int)
+	CornerRadiusTopRight() gdnative.Int
+	SetCornerRadiusTopRight(value gdnative.Int)
+	DrawCenter() gdnative.Bool
+	ExpandMarginBottom() gdnative.Real
+	SetExpandMarginBottom(value gdnative.Real)
+	ExpandMarginLeft() gdnative.Real
+	SetExpandMarginLeft(value gdnative.Real)
+	ExpandMarginRight() gdnative.Real
+	SetExpandMarginRight(value gdnative.Real)
+	ExpandMarginTop() gdnative.Real
+	SetExpandMarginTop(value gdnative.Real)
+	ShadowColor() gdnative.Color
+	ShadowSize() gdnative.Int
 }
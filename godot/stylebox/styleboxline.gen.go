@@ -1,7 +1,8 @@
-package godot
+package stylebox
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,50 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewStyleBoxLineFromPointer(ptr gdnative.Pointer) StyleBoxLine {
-func newStyleBoxLineFromPointer(ptr gdnative.Pointer) StyleBoxLine {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := StyleBoxLine{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type StyleBoxLine struct {
-	StyleBox
+	godot.StyleBox
 	owner gdnative.Object
 }
 
+// classConstructorStyleBoxLine is the class constructor of the StyleBoxLine class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStyleBoxLine = gdnative.NewLazyClassConstructor("StyleBoxLine")
+
+// NewStyleBoxLine will create a new instance of the StyleBoxLine class.
+// StyleBoxLine is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStyleBoxLine() *StyleBoxLine {
+	obj := &StyleBoxLine{}
+	obj.SetBaseObject(classConstructorStyleBoxLine.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *StyleBoxLine) BaseClass() string {
 	return "StyleBoxLine"
 }
 
+// Method binds of the StyleBoxLine class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStyleBoxLineGetColor     = gdnative.NewLazyMethodBind("StyleBoxLine", "get_color")
+	methodBindStyleBoxLineGetGrow      = gdnative.NewLazyMethodBind("StyleBoxLine", "get_grow")
+	methodBindStyleBoxLineGetThickness = gdnative.NewLazyMethodBind("StyleBoxLine", "get_thickness")
+	methodBindStyleBoxLineIsVertical   = gdnative.NewLazyMethodBind("StyleBoxLine", "is_vertical")
+	methodBindStyleBoxLineSetColor     = gdnative.NewLazyMethodBind("StyleBoxLine", "set_color")
+	methodBindStyleBoxLineSetGrow      = gdnative.NewLazyMethodBind("StyleBoxLine", "set_grow")
+	methodBindStyleBoxLineSetThickness = gdnative.NewLazyMethodBind("StyleBoxLine", "set_thickness")
+	methodBindStyleBoxLineSetVertical  = gdnative.NewLazyMethodBind("StyleBoxLine", "set_vertical")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Color
+	        Undocumented
+		Args: [], Returns: Color
 */
 func (o *StyleBoxLine) GetColor() gdnative.Color {
 	//log.Println("Calling StyleBoxLine.GetColor()")
@@ -45,7 +66,7 @@ func (o *StyleBoxLine) GetColor() gdnative.Color {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "get_color")
+	methodBind := methodBindStyleBoxLineGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -58,8 +79,8 @@ func (o *StyleBoxLine) GetColor() gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *StyleBoxLine) GetGrow() gdnative.Real {
 	//log.Println("Calling StyleBoxLine.GetGrow()")
@@ -68,7 +89,7 @@ func (o *StyleBoxLine) GetGrow() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "get_grow")
+	methodBind := methodBindStyleBoxLineGetGrow.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +102,8 @@ func (o *StyleBoxLine) GetGrow() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *StyleBoxLine) GetThickness() gdnative.Int {
 	//log.Println("Calling StyleBoxLine.GetThickness()")
@@ -91,7 +112,7 @@ func (o *StyleBoxLine) GetThickness() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "get_thickness")
+	methodBind := methodBindStyleBoxLineGetThickness.Get()
 
 	// Call the parent method.
 	// int
@@ -104,8 +125,8 @@ func (o *StyleBoxLine) GetThickness() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *StyleBoxLine) IsVertical() gdnative.Bool {
 	//log.Println("Calling StyleBoxLine.IsVertical()")
@@ -114,7 +135,7 @@ func (o *StyleBoxLine) IsVertical() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "is_vertical")
+	methodBind := methodBindStyleBoxLineIsVertical.Get()
 
 	// Call the parent method.
 	// bool
@@ -127,8 +148,8 @@ func (o *StyleBoxLine) IsVertical() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false color Color}], Returns: void
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
 */
 func (o *StyleBoxLine) SetColor(color gdnative.Color) {
 	//log.Println("Calling StyleBoxLine.SetColor()")
@@ -138,7 +159,7 @@ func (o *StyleBoxLine) SetColor(color gdnative.Color) {
 	ptrArguments[0] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "set_color")
+	methodBind := methodBindStyleBoxLineSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -148,8 +169,8 @@ func (o *StyleBoxLine) SetColor(color gdnative.Color) {
 }
 
 /*
-        Undocumented
-	Args: [{ false grow float}], Returns: void
+	        Undocumented
+		Args: [{ false grow float}], Returns: void
 */
 func (o *StyleBoxLine) SetGrow(grow gdnative.Real) {
 	//log.Println("Calling StyleBoxLine.SetGrow()")
@@ -159,7 +180,7 @@ func (o *StyleBoxLine) SetGrow(grow gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(grow)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "set_grow")
+	methodBind := methodBindStyleBoxLineSetGrow.Get()
 
 	// Call the parent method.
 	// void
@@ -169,8 +190,8 @@ func (o *StyleBoxLine) SetGrow(grow gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false thickness int}], Returns: void
+	        Undocumented
+		Args: [{ false thickness int}], Returns: void
 */
 func (o *StyleBoxLine) SetThickness(thickness gdnative.Int) {
 	//log.Println("Calling StyleBoxLine.SetThickness()")
@@ -180,7 +201,7 @@ func (o *StyleBoxLine) SetThickness(thickness gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(thickness)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "set_thickness")
+	methodBind := methodBindStyleBoxLineSetThickness.Get()
 
 	// Call the parent method.
 	// void
@@ -190,8 +211,8 @@ func (o *StyleBoxLine) SetThickness(thickness gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false vertical bool}], Returns: void
+	        Undocumented
+		Args: [{ false vertical bool}], Returns: void
 */
 func (o *StyleBoxLine) SetVertical(vertical gdnative.Bool) {
 	//log.Println("Calling StyleBoxLine.SetVertical()")
@@ -201,7 +222,7 @@ func (o *StyleBoxLine) SetVertical(vertical gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(vertical)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("StyleBoxLine", "set_vertical")
+	methodBind := methodBindStyleBoxLineSetVertical.Get()
 
 	// Call the parent method.
 	// void
@@ -210,10 +231,30 @@ func (o *StyleBoxLine) SetVertical(vertical gdnative.Bool) {
 
 }
 
+// Color will return the value of the "color" property.
+func (o *StyleBoxLine) Color() gdnative.Color {
+	return o.GetColor()
+}
+
+// Grow will return the value of the "grow" property.
+func (o *StyleBoxLine) Grow() gdnative.Real {
+	return o.GetGrow()
+}
+
+// Thickness will return the value of the "thickness" property.
+func (o *StyleBoxLine) Thickness() gdnative.Int {
+	return o.GetThickness()
+}
+
+// Vertical will return the value of the "vertical" property.
+func (o *StyleBoxLine) Vertical() gdnative.Bool {
+	return o.IsVertical()
+}
+
 // StyleBoxLineImplementer is an interface that implements the methods
 // of the StyleBoxLine class.
 type StyleBoxLineImplementer interface {
-	StyleBoxImplementer
+	godot.StyleBoxImplementer
 	GetColor() gdnative.Color
 	GetGrow() gdnative.Real
 	GetThickness() gdnative.Int
@@ -222,4 +263,8 @@ type StyleBoxLineImplementer interface {
 	SetGrow(grow gdnative.Real)
 	SetThickness(thickness gdnative.Int)
 	SetVertical(vertical gdnative.Bool)
+	Color() gdnative.Color
+	Grow() gdnative.Real
+	Thickness() gdnative.Int
+	Vertical() gdnative.Bool
 }
@@ -0,0 +1,778 @@
+package stylebox
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// StyleBoxTextureAxisStretchMode is an enum for AxisStretchMode values.
+type StyleBoxTextureAxisStretchMode int
+
+const (
+	StyleBoxTextureAxisStretchModeStretch StyleBoxTextureAxisStretchMode = 0
+	StyleBoxTextureAxisStretchModeTile    StyleBoxTextureAxisStretchMode = 1
+	StyleBoxTextureAxisStretchModeTileFit StyleBoxTextureAxisStretchMode = 2
+)
+
+// String will return the Godot name of the StyleBoxTextureAxisStretchMode value.
+func (e StyleBoxTextureAxisStretchMode) String() string {
+	switch e {
+	case StyleBoxTextureAxisStretchModeStretch:
+		return "AXIS_STRETCH_MODE_STRETCH"
+	case StyleBoxTextureAxisStretchModeTile:
+		return "AXIS_STRETCH_MODE_TILE"
+	case StyleBoxTextureAxisStretchModeTileFit:
+		return "AXIS_STRETCH_MODE_TILE_FIT"
+	}
+	return fmt.Sprintf("StyleBoxTextureAxisStretchMode(%d)", int(e))
+}
+
+// ParseStyleBoxTextureAxisStretchMode will return the StyleBoxTextureAxisStretchMode value with the given Godot name.
+func ParseStyleBoxTextureAxisStretchMode(name string) (StyleBoxTextureAxisStretchMode, error) {
+	switch name {
+	case "AXIS_STRETCH_MODE_STRETCH":
+		return StyleBoxTextureAxisStretchModeStretch, nil
+	case "AXIS_STRETCH_MODE_TILE":
+		return StyleBoxTextureAxisStretchModeTile, nil
+	case "AXIS_STRETCH_MODE_TILE_FIT":
+		return StyleBoxTextureAxisStretchModeTileFit, nil
+	}
+	return 0, fmt.Errorf("invalid StyleBoxTextureAxisStretchMode value %q", name)
+}
+
+/*
+Texture Based 3x3 scale style. This stylebox performs a 3x3 scaling of a texture, where only the center cell is fully stretched. This allows for the easy creation of bordered styles.
+*/
+type StyleBoxTexture struct {
+	godot.StyleBox
+	owner gdnative.Object
+}
+
+// classConstructorStyleBoxTexture is the class constructor of the StyleBoxTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorStyleBoxTexture = gdnative.NewLazyClassConstructor("StyleBoxTexture")
+
+// NewStyleBoxTexture will create a new instance of the StyleBoxTexture class.
+// StyleBoxTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewStyleBoxTexture() *StyleBoxTexture {
+	obj := &StyleBoxTexture{}
+	obj.SetBaseObject(classConstructorStyleBoxTexture.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *StyleBoxTexture) BaseClass() string {
+	return "StyleBoxTexture"
+}
+
+// Method binds of the StyleBoxTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindStyleBoxTextureGetExpandMarginSize       = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_expand_margin_size")
+	methodBindStyleBoxTextureGetHAxisStretchMode       = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_h_axis_stretch_mode")
+	methodBindStyleBoxTextureGetMarginSize             = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_margin_size")
+	methodBindStyleBoxTextureGetModulate               = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_modulate")
+	methodBindStyleBoxTextureGetNormalMap              = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_normal_map")
+	methodBindStyleBoxTextureGetRegionRect             = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_region_rect")
+	methodBindStyleBoxTextureGetTexture                = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_texture")
+	methodBindStyleBoxTextureGetVAxisStretchMode       = gdnative.NewLazyMethodBind("StyleBoxTexture", "get_v_axis_stretch_mode")
+	methodBindStyleBoxTextureIsDrawCenterEnabled       = gdnative.NewLazyMethodBind("StyleBoxTexture", "is_draw_center_enabled")
+	methodBindStyleBoxTextureSetDrawCenter             = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_draw_center")
+	methodBindStyleBoxTextureSetExpandMarginAll        = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_expand_margin_all")
+	methodBindStyleBoxTextureSetExpandMarginIndividual = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_expand_margin_individual")
+	methodBindStyleBoxTextureSetExpandMarginSize       = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_expand_margin_size")
+	methodBindStyleBoxTextureSetHAxisStretchMode       = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_h_axis_stretch_mode")
+	methodBindStyleBoxTextureSetMarginSize             = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_margin_size")
+	methodBindStyleBoxTextureSetModulate               = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_modulate")
+	methodBindStyleBoxTextureSetNormalMap              = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_normal_map")
+	methodBindStyleBoxTextureSetRegionRect             = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_region_rect")
+	methodBindStyleBoxTextureSetTexture                = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_texture")
+	methodBindStyleBoxTextureSetVAxisStretchMode       = gdnative.NewLazyMethodBind("StyleBoxTexture", "set_v_axis_stretch_mode")
+)
+
+/*
+	        Undocumented
+		Args: [{ false margin int}], Returns: float
+*/
+func (o *StyleBoxTexture) GetExpandMarginSize(margin gdnative.Int) gdnative.Real {
+	//log.Println("Calling StyleBoxTexture.GetExpandMarginSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetExpandMarginSize.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.StyleBoxTexture::AxisStretchMode
+*/
+func (o *StyleBoxTexture) GetHAxisStretchMode() StyleBoxTextureAxisStretchMode {
+	//log.Println("Calling StyleBoxTexture.GetHAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetHAxisStretchMode.Get()
+
+	// Call the parent method.
+	// enum.StyleBoxTexture::AxisStretchMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return StyleBoxTextureAxisStretchMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false margin int}], Returns: float
+*/
+func (o *StyleBoxTexture) GetMarginSize(margin gdnative.Int) gdnative.Real {
+	//log.Println("Calling StyleBoxTexture.GetMarginSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetMarginSize.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Color
+*/
+func (o *StyleBoxTexture) GetModulate() gdnative.Color {
+	//log.Println("Calling StyleBoxTexture.GetModulate()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetModulate.Get()
+
+	// Call the parent method.
+	// Color
+	retPtr := gdnative.NewEmptyColor()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewColorFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Resource
+*/
+func (o *StyleBoxTexture) GetNormalMap() godot.ResourceImplementer {
+	//log.Println("Calling StyleBoxTexture.GetNormalMap()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetNormalMap.Get()
+
+	// Call the parent method.
+	// Resource
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ResourceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Rect2
+*/
+func (o *StyleBoxTexture) GetRegionRect() gdnative.Rect2 {
+	//log.Println("Calling StyleBoxTexture.GetRegionRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetRegionRect.Get()
+
+	// Call the parent method.
+	// Rect2
+	retPtr := gdnative.NewEmptyRect2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRect2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Resource
+*/
+func (o *StyleBoxTexture) GetTexture() godot.ResourceImplementer {
+	//log.Println("Calling StyleBoxTexture.GetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetTexture.Get()
+
+	// Call the parent method.
+	// Resource
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ResourceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.StyleBoxTexture::AxisStretchMode
+*/
+func (o *StyleBoxTexture) GetVAxisStretchMode() StyleBoxTextureAxisStretchMode {
+	//log.Println("Calling StyleBoxTexture.GetVAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureGetVAxisStretchMode.Get()
+
+	// Call the parent method.
+	// enum.StyleBoxTexture::AxisStretchMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return StyleBoxTextureAxisStretchMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *StyleBoxTexture) IsDrawCenterEnabled() gdnative.Bool {
+	//log.Println("Calling StyleBoxTexture.IsDrawCenterEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureIsDrawCenterEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *StyleBoxTexture) SetDrawCenter(enable gdnative.Bool) {
+	//log.Println("Calling StyleBoxTexture.SetDrawCenter()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetDrawCenter.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false size float}], Returns: void
+*/
+func (o *StyleBoxTexture) SetExpandMarginAll(size gdnative.Real) {
+	//log.Println("Calling StyleBoxTexture.SetExpandMarginAll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(size)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetExpandMarginAll.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+Args: [{ false size_left float} { false size_top float} { false size_right float} { false size_bottom float}], Returns: void
+*/
+func (o *StyleBoxTexture) SetExpandMarginIndividual(sizeLeft gdnative.Real, sizeTop gdnative.Real, sizeRight gdnative.Real, sizeBottom gdnative.Real) {
+	//log.Println("Calling StyleBoxTexture.SetExpandMarginIndividual()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 4, 4)
+	ptrArguments[0] = gdnative.NewPointerFromReal(sizeLeft)
+	ptrArguments[1] = gdnative.NewPointerFromReal(sizeTop)
+	ptrArguments[2] = gdnative.NewPointerFromReal(sizeRight)
+	ptrArguments[3] = gdnative.NewPointerFromReal(sizeBottom)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetExpandMarginIndividual.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false margin int} { false size float}], Returns: void
+*/
+func (o *StyleBoxTexture) SetExpandMarginSize(margin gdnative.Int, size gdnative.Real) {
+	//log.Println("Calling StyleBoxTexture.SetExpandMarginSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+	ptrArguments[1] = gdnative.NewPointerFromReal(size)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetExpandMarginSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.StyleBoxTexture::AxisStretchMode}], Returns: void
+*/
+func (o *StyleBoxTexture) SetHAxisStretchMode(mode StyleBoxTextureAxisStretchMode) {
+	//log.Println("Calling StyleBoxTexture.SetHAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetHAxisStretchMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false margin int} { false size float}], Returns: void
+*/
+func (o *StyleBoxTexture) SetMarginSize(margin gdnative.Int, size gdnative.Real) {
+	//log.Println("Calling StyleBoxTexture.SetMarginSize()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+	ptrArguments[1] = gdnative.NewPointerFromReal(size)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetMarginSize.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false color Color}], Returns: void
+*/
+func (o *StyleBoxTexture) SetModulate(color gdnative.Color) {
+	//log.Println("Calling StyleBoxTexture.SetModulate()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromColor(color)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetModulate.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false normal_map Resource}], Returns: void
+*/
+func (o *StyleBoxTexture) SetNormalMap(normalMap godot.ResourceImplementer) {
+	//log.Println("Calling StyleBoxTexture.SetNormalMap()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(normalMap))
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetNormalMap.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false region Rect2}], Returns: void
+*/
+func (o *StyleBoxTexture) SetRegionRect(region gdnative.Rect2) {
+	//log.Println("Calling StyleBoxTexture.SetRegionRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromRect2(region)
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetRegionRect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Resource}], Returns: void
+*/
+func (o *StyleBoxTexture) SetTexture(texture godot.ResourceImplementer) {
+	//log.Println("Calling StyleBoxTexture.SetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.StyleBoxTexture::AxisStretchMode}], Returns: void
+*/
+func (o *StyleBoxTexture) SetVAxisStretchMode(mode StyleBoxTextureAxisStretchMode) {
+	//log.Println("Calling StyleBoxTexture.SetVAxisStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindStyleBoxTextureSetVAxisStretchMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AxisStretchHorizontal will return the value of the "axis_stretch_horizontal" property.
+func (o *StyleBoxTexture) AxisStretchHorizontal() StyleBoxTextureAxisStretchMode {
+	return o.GetHAxisStretchMode()
+}
+
+// SetAxisStretchHorizontal will set the value of the "axis_stretch_horizontal" property.
+func (o *StyleBoxTexture) SetAxisStretchHorizontal(value StyleBoxTextureAxisStretchMode) {
+	o.SetHAxisStretchMode(value)
+}
+
+// AxisStretchVertical will return the value of the "axis_stretch_vertical" property.
+func (o *StyleBoxTexture) AxisStretchVertical() StyleBoxTextureAxisStretchMode {
+	return o.GetVAxisStretchMode()
+}
+
+// SetAxisStretchVertical will set the value of the "axis_stretch_vertical" property.
+func (o *StyleBoxTexture) SetAxisStretchVertical(value StyleBoxTextureAxisStretchMode) {
+	o.SetVAxisStretchMode(value)
+}
+
+// DrawCenter will return the value of the "draw_center" property.
+func (o *StyleBoxTexture) DrawCenter() gdnative.Bool {
+	return o.IsDrawCenterEnabled()
+}
+
+// ExpandMarginBottom will return the value of the "expand_margin_bottom" property.
+func (o *StyleBoxTexture) ExpandMarginBottom() gdnative.Real {
+	return o.GetExpandMarginSize(3)
+}
+
+// SetExpandMarginBottom will set the value of the "expand_margin_bottom" property.
+func (o *StyleBoxTexture) SetExpandMarginBottom(value gdnative.Real) {
+	o.SetExpandMarginSize(3, value)
+}
+
+// ExpandMarginLeft will return the value of the "expand_margin_left" property.
+func (o *StyleBoxTexture) ExpandMarginLeft() gdnative.Real {
+	return o.GetExpandMarginSize(0)
+}
+
+// SetExpandMarginLeft will set the value of the "expand_margin_left" property.
+func (o *StyleBoxTexture) SetExpandMarginLeft(value gdnative.Real) {
+	o.SetExpandMarginSize(0, value)
+}
+
+// ExpandMarginRight will return the value of the "expand_margin_right" property.
+func (o *StyleBoxTexture) ExpandMarginRight() gdnative.Real {
+	return o.GetExpandMarginSize(2)
+}
+
+// SetExpandMarginRight will set the value of the "expand_margin_right" property.
+func (o *StyleBoxTexture) SetExpandMarginRight(value gdnative.Real) {
+	o.SetExpandMarginSize(2, value)
+}
+
+// ExpandMarginTop will return the value of the "expand_margin_top" property.
+func (o *StyleBoxTexture) ExpandMarginTop() gdnative.Real {
+	return o.GetExpandMarginSize(1)
+}
+
+// SetExpandMarginTop will set the value of the "expand_margin_top" property.
+func (o *StyleBoxTexture) SetExpandMarginTop(value gdnative.Real) {
+	o.SetExpandMarginSize(1, value)
+}
+
+// MarginBottom will return the value of the "margin_bottom" property.
+func (o *StyleBoxTexture) MarginBottom() gdnative.Real {
+	return o.GetMarginSize(3)
+}
+
+// SetMarginBottom will set the value of the "margin_bottom" property.
+func (o *StyleBoxTexture) SetMarginBottom(value gdnative.Real) {
+	o.SetMarginSize(3, value)
+}
+
+// MarginLeft will return the value of the "margin_left" property.
+func (o *StyleBoxTexture) MarginLeft() gdnative.Real {
+	return o.GetMarginSize(0)
+}
+
+// SetMarginLeft will set the value of the "margin_left" property.
+func (o *StyleBoxTexture) SetMarginLeft(value gdnative.Real) {
+	o.SetMarginSize(0, value)
+}
+
+// MarginRight will return the value of the "margin_right" property.
+func (o *StyleBoxTexture) MarginRight() gdnative.Real {
+	return o.GetMarginSize(2)
+}
+
+// SetMarginRight will set the value of the "margin_right" property.
+func (o *StyleBoxTexture) SetMarginRight(value gdnative.Real) {
+	o.SetMarginSize(2, value)
+}
+
+// MarginTop will return the value of the "margin_top" property.
+func (o *StyleBoxTexture) MarginTop() gdnative.Real {
+	return o.GetMarginSize(1)
+}
+
+// SetMarginTop will set the value of the "margin_top" property.
+func (o *StyleBoxTexture) SetMarginTop(value gdnative.Real) {
+	o.SetMarginSize(1, value)
+}
+
+// ModulateColor will return the value of the "modulate_color" property.
+func (o *StyleBoxTexture) ModulateColor() gdnative.Color {
+	return o.GetModulate()
+}
+
+// SetModulateColor will set the value of the "modulate_color" property.
+func (o *StyleBoxTexture) SetModulateColor(value gdnative.Color) {
+	o.SetModulate(value)
+}
+
+// NormalMap will return the value of the "normal_map" property.
+func (o *StyleBoxTexture) NormalMap() godot.ResourceImplementer {
+	return o.GetNormalMap()
+}
+
+// RegionRect will return the value of the "region_rect" property.
+func (o *StyleBoxTexture) RegionRect() gdnative.Rect2 {
+	return o.GetRegionRect()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *StyleBoxTexture) Texture() godot.ResourceImplementer {
+	return o.GetTexture()
+}
+
+// Signals of the StyleBoxTexture class.
+const (
+	StyleBoxTextureSignalTextureChanged gdnative.String = "texture_changed"
+)
+
+// ConnectTextureChanged will connect the "texture_changed" signal to the given method of the target object.
+func (o *StyleBoxTexture) ConnectTextureChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(StyleBoxTextureSignalTextureChanged, target, method, binds, 0)
+}
+
+// EmitTextureChanged will emit the "texture_changed" signal.
+func (o *StyleBoxTexture) EmitTextureChanged() error {
+
+	ret, err := o.EmitSignal(StyleBoxTextureSignalTextureChanged)
+	ret.Destroy()
+	return err
+}
+
+// StyleBoxTextureImplementer is an interface that implements the methods
+// of the StyleBoxTexture class.
+type StyleBoxTextureImplementer interface {
+	godot.StyleBoxImplementer
+	GetExpandMarginSize(margin gdnative.Int) gdnative.Real
+	GetHAxisStretchMode() StyleBoxTextureAxisStretchMode
+	GetMarginSize(margin gdnative.Int) gdnative.Real
+	GetModulate() gdnative.Color
+	GetNormalMap() godot.ResourceImplementer
+	GetRegionRect() gdnative.Rect2
+	GetTexture() godot.ResourceImplementer
+	GetVAxisStretchMode() StyleBoxTextureAxisStretchMode
+	IsDrawCenterEnabled() gdnative.Bool
+	SetDrawCenter(enable gdnative.Bool)
+	SetExpandMarginAll(size gdnative.Real)
+	SetExpandMarginIndividual(sizeLeft gdnative.Real, sizeTop gdnative.Real, sizeRight gdnative.Real, sizeBottom gdnative.Real)
+	SetExpandMarginSize(margin gdnative.Int, size gdnative.Real)
+	SetHAxisStretchMode(mode StyleBoxTextureAxisStretchMode)
+	SetMarginSize(margin gdnative.Int, size gdnative.Real)
+	SetModulate(color gdnative.Color)
+	SetNormalMap(normalMap godot.ResourceImplementer)
+	SetRegionRect(region gdnative.Rect2)
+	SetTexture(texture godot.ResourceImplementer)
+	SetVAxisStretchMode(mode StyleBoxTextureAxisStretchMode)
+	AxisStretchHorizontal() StyleBoxTextureAxisStretchMode
+	SetAxisStretchHorizontal(value StyleBoxTextureAxisStretchMode)
+	AxisStretchVertical() StyleBoxTextureAxisStretchMode
+	SetAxisStretchVertical(value StyleBoxTextureAxisStretchMode)
+	DrawCenter() gdnative.Bool
+	ExpandMarginBottom() gdnative.Real
+	SetExpandMarginBottom(value gdnative.Real)
+	ExpandMarginLeft() gdnative.Real
+	SetExpandMarginLeft(value gdnative.Real)
+	ExpandMarginRight() gdnative.Real
+	SetExpandMarginRight(value gdnative.Real)
+	ExpandMarginTop() gdnative.Real
+	SetExpandMarginTop(value gdnative.Real)
+	MarginBottom() gdnative.Real
+	SetMarginBottom(value gdnative.Real)
+	MarginLeft() gdnative.Real
+	SetMarginLeft(value gdnative.Real)
+	MarginRight() gdnative.Real
+	SetMarginRight(value gdnative.Real)
+	MarginTop() gdnative.Real
+	SetMarginTop(value gdnative.Real)
+	ModulateColor() gdnative.Color
+	SetModulateColor(value gdnative.Color)
+	NormalMap() godot.ResourceImplementer
+	RegionRect() gdnative.Rect2
+	Texture() godot.ResourceImplementer
+	ConnectTextureChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitTextureChanged() error
+}
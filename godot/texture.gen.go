@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -27,13 +29,50 @@ const (
 	TextureFlagVideoSurface      TextureFlags = 4096
 )
 
-//func NewTextureFromPointer(ptr gdnative.Pointer) Texture {
-func newTextureFromPointer(ptr gdnative.Pointer) Texture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Texture{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the TextureFlags value.
+func (e TextureFlags) String() string {
+	switch e {
+	case TextureFlagsDefault:
+		return "FLAGS_DEFAULT"
+	case TextureFlagAnisotropicFilter:
+		return "FLAG_ANISOTROPIC_FILTER"
+	case TextureFlagConvertToLinear:
+		return "FLAG_CONVERT_TO_LINEAR"
+	case TextureFlagFilter:
+		return "FLAG_FILTER"
+	case TextureFlagMipmaps:
+		return "FLAG_MIPMAPS"
+	case TextureFlagMirroredRepeat:
+		return "FLAG_MIRRORED_REPEAT"
+	case TextureFlagRepeat:
+		return "FLAG_REPEAT"
+	case TextureFlagVideoSurface:
+		return "FLAG_VIDEO_SURFACE"
+	}
+	return fmt.Sprintf("TextureFlags(%d)", int(e))
+}
 
-	return obj
+// ParseTextureFlags will return the TextureFlags value with the given Godot name.
+func ParseTextureFlags(name string) (TextureFlags, error) {
+	switch name {
+	case "FLAGS_DEFAULT":
+		return TextureFlagsDefault, nil
+	case "FLAG_ANISOTROPIC_FILTER":
+		return TextureFlagAnisotropicFilter, nil
+	case "FLAG_CONVERT_TO_LINEAR":
+		return TextureFlagConvertToLinear, nil
+	case "FLAG_FILTER":
+		return TextureFlagFilter, nil
+	case "FLAG_MIPMAPS":
+		return TextureFlagMipmaps, nil
+	case "FLAG_MIRRORED_REPEAT":
+		return TextureFlagMirroredRepeat, nil
+	case "FLAG_REPEAT":
+		return TextureFlagRepeat, nil
+	case "FLAG_VIDEO_SURFACE":
+		return TextureFlagVideoSurface, nil
+	}
+	return 0, fmt.Errorf("invalid TextureFlags value %q", name)
 }
 
 /*
@@ -48,9 +87,23 @@ func (o *Texture) BaseClass() string {
 	return "Texture"
 }
 
-/*
+// Method binds of the Texture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTextureDraw           = gdnative.NewLazyMethodBind("Texture", "draw")
+	methodBindTextureDrawRect       = gdnative.NewLazyMethodBind("Texture", "draw_rect")
+	methodBindTextureDrawRectRegion = gdnative.NewLazyMethodBind("Texture", "draw_rect_region")
+	methodBindTextureGetData        = gdnative.NewLazyMethodBind("Texture", "get_data")
+	methodBindTextureGetFlags       = gdnative.NewLazyMethodBind("Texture", "get_flags")
+	methodBindTextureGetHeight      = gdnative.NewLazyMethodBind("Texture", "get_height")
+	methodBindTextureGetSize        = gdnative.NewLazyMethodBind("Texture", "get_size")
+	methodBindTextureGetWidth       = gdnative.NewLazyMethodBind("Texture", "get_width")
+	methodBindTextureHasAlpha       = gdnative.NewLazyMethodBind("Texture", "has_alpha")
+	methodBindTextureSetFlags       = gdnative.NewLazyMethodBind("Texture", "set_flags")
+)
 
-	Args: [{ false canvas_item RID} { false position Vector2} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture}], Returns: void
+/*
+Args: [{ false canvas_item RID} { false position Vector2} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture}], Returns: void
 */
 func (o *Texture) Draw(canvasItem gdnative.Rid, position gdnative.Vector2, modulate gdnative.Color, transpose gdnative.Bool, normalMap TextureImplementer) {
 	//log.Println("Calling Texture.Draw()")
@@ -61,10 +114,10 @@ func (o *Texture) Draw(canvasItem gdnative.Rid, position gdnative.Vector2, modul
 	ptrArguments[1] = gdnative.NewPointerFromVector2(position)
 	ptrArguments[2] = gdnative.NewPointerFromColor(modulate)
 	ptrArguments[3] = gdnative.NewPointerFromBool(transpose)
-	ptrArguments[4] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[4] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "draw")
+	methodBind := methodBindTextureDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -74,8 +127,7 @@ func (o *Texture) Draw(canvasItem gdnative.Rid, position gdnative.Vector2, modul
 }
 
 /*
-
-	Args: [{ false canvas_item RID} { false rect Rect2} { false tile bool} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture}], Returns: void
+Args: [{ false canvas_item RID} { false rect Rect2} { false tile bool} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture}], Returns: void
 */
 func (o *Texture) DrawRect(canvasItem gdnative.Rid, rect gdnative.Rect2, tile gdnative.Bool, modulate gdnative.Color, transpose gdnative.Bool, normalMap TextureImplementer) {
 	//log.Println("Calling Texture.DrawRect()")
@@ -87,10 +139,10 @@ func (o *Texture) DrawRect(canvasItem gdnative.Rid, rect gdnative.Rect2, tile gd
 	ptrArguments[2] = gdnative.NewPointerFromBool(tile)
 	ptrArguments[3] = gdnative.NewPointerFromColor(modulate)
 	ptrArguments[4] = gdnative.NewPointerFromBool(transpose)
-	ptrArguments[5] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[5] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "draw_rect")
+	methodBind := methodBindTextureDrawRect.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +152,7 @@ func (o *Texture) DrawRect(canvasItem gdnative.Rid, rect gdnative.Rect2, tile gd
 }
 
 /*
-
-	Args: [{ false canvas_item RID} { false rect Rect2} { false src_rect Rect2} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture} {True true clip_uv bool}], Returns: void
+Args: [{ false canvas_item RID} { false rect Rect2} { false src_rect Rect2} {1,1,1,1 true modulate Color} {False true transpose bool} {Null true normal_map Texture} {True true clip_uv bool}], Returns: void
 */
 func (o *Texture) DrawRectRegion(canvasItem gdnative.Rid, rect gdnative.Rect2, srcRect gdnative.Rect2, modulate gdnative.Color, transpose gdnative.Bool, normalMap TextureImplementer, clipUv gdnative.Bool) {
 	//log.Println("Calling Texture.DrawRectRegion()")
@@ -113,11 +164,11 @@ func (o *Texture) DrawRectRegion(canvasItem gdnative.Rid, rect gdnative.Rect2, s
 	ptrArguments[2] = gdnative.NewPointerFromRect2(srcRect)
 	ptrArguments[3] = gdnative.NewPointerFromColor(modulate)
 	ptrArguments[4] = gdnative.NewPointerFromBool(transpose)
-	ptrArguments[5] = gdnative.NewPointerFromObject(normalMap.GetBaseObject())
+	ptrArguments[5] = gdnative.NewPointerFromObject(GetBaseObject(normalMap))
 	ptrArguments[6] = gdnative.NewPointerFromBool(clipUv)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "draw_rect_region")
+	methodBind := methodBindTextureDrawRectRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -127,8 +178,7 @@ func (o *Texture) DrawRectRegion(canvasItem gdnative.Rid, rect gdnative.Rect2, s
 }
 
 /*
-
-	Args: [], Returns: Image
+Args: [], Returns: Image
 */
 func (o *Texture) GetData() ImageImplementer {
 	//log.Println("Calling Texture.GetData()")
@@ -137,7 +187,7 @@ func (o *Texture) GetData() ImageImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "get_data")
+	methodBind := methodBindTextureGetData.Get()
 
 	// Call the parent method.
 	// Image
@@ -145,27 +195,25 @@ func (o *Texture) GetData() ImageImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newImageFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ImageImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Image" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ImageImplementer)
+	ret := Image{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ImageImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Texture) GetFlags() gdnative.Int {
 	//log.Println("Calling Texture.GetFlags()")
@@ -174,7 +222,7 @@ func (o *Texture) GetFlags() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "get_flags")
+	methodBind := methodBindTextureGetFlags.Get()
 
 	// Call the parent method.
 	// int
@@ -187,8 +235,8 @@ func (o *Texture) GetFlags() gdnative.Int {
 }
 
 /*
-        Return the texture height.
-	Args: [], Returns: int
+	        Return the texture height.
+		Args: [], Returns: int
 */
 func (o *Texture) GetHeight() gdnative.Int {
 	//log.Println("Calling Texture.GetHeight()")
@@ -197,7 +245,7 @@ func (o *Texture) GetHeight() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "get_height")
+	methodBind := methodBindTextureGetHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -210,8 +258,8 @@ func (o *Texture) GetHeight() gdnative.Int {
 }
 
 /*
-        Return the texture size.
-	Args: [], Returns: Vector2
+	        Return the texture size.
+		Args: [], Returns: Vector2
 */
 func (o *Texture) GetSize() gdnative.Vector2 {
 	//log.Println("Calling Texture.GetSize()")
@@ -220,7 +268,7 @@ func (o *Texture) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "get_size")
+	methodBind := methodBindTextureGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -233,8 +281,8 @@ func (o *Texture) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Return the texture width.
-	Args: [], Returns: int
+	        Return the texture width.
+		Args: [], Returns: int
 */
 func (o *Texture) GetWidth() gdnative.Int {
 	//log.Println("Calling Texture.GetWidth()")
@@ -243,7 +291,7 @@ func (o *Texture) GetWidth() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "get_width")
+	methodBind := methodBindTextureGetWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -256,8 +304,7 @@ func (o *Texture) GetWidth() gdnative.Int {
 }
 
 /*
-
-	Args: [], Returns: bool
+Args: [], Returns: bool
 */
 func (o *Texture) HasAlpha() gdnative.Bool {
 	//log.Println("Calling Texture.HasAlpha()")
@@ -266,7 +313,7 @@ func (o *Texture) HasAlpha() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "has_alpha")
+	methodBind := methodBindTextureHasAlpha.Get()
 
 	// Call the parent method.
 	// bool
@@ -279,18 +326,18 @@ func (o *Texture) HasAlpha() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false flags int}], Returns: void
+	        Undocumented
+		Args: [{ false flags enum.Texture::Flags}], Returns: void
 */
-func (o *Texture) SetFlags(flags gdnative.Int) {
+func (o *Texture) SetFlags(flags TextureFlags) {
 	//log.Println("Calling Texture.SetFlags()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(flags))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Texture", "set_flags")
+	methodBind := methodBindTextureSetFlags.Get()
 
 	// Call the parent method.
 	// void
@@ -299,6 +346,26 @@ func (o *Texture) SetFlags(flags gdnative.Int) {
 
 }
 
+// DrawWithDefaults will call Draw using the default values for: modulate, transpose, normal_map.
+func (o *Texture) DrawWithDefaults(canvasItem gdnative.Rid, position gdnative.Vector2) {
+	o.Draw(canvasItem, position, gdnative.NewColorRgba(1, 1, 1, 1), false, nil)
+}
+
+// DrawRectWithDefaults will call DrawRect using the default values for: modulate, transpose, normal_map.
+func (o *Texture) DrawRectWithDefaults(canvasItem gdnative.Rid, rect gdnative.Rect2, tile gdnative.Bool) {
+	o.DrawRect(canvasItem, rect, tile, gdnative.NewColorRgba(1, 1, 1, 1), false, nil)
+}
+
+// DrawRectRegionWithDefaults will call DrawRectRegion using the default values for: modulate, transpose, normal_map, clip_uv.
+func (o *Texture) DrawRectRegionWithDefaults(canvasItem gdnative.Rid, rect gdnative.Rect2, srcRect gdnative.Rect2) {
+	o.DrawRectRegion(canvasItem, rect, srcRect, gdnative.NewColorRgba(1, 1, 1, 1), false, nil, true)
+}
+
+// Flags will return the value of the "flags" property.
+func (o *Texture) Flags() gdnative.Int {
+	return o.GetFlags()
+}
+
 // TextureImplementer is an interface that implements the methods
 // of the Texture class.
 type TextureImplementer interface {
@@ -312,5 +379,9 @@ type TextureImplementer interface {
 	GetSize() gdnative.Vector2
 	GetWidth() gdnative.Int
 	HasAlpha() gdnative.Bool
-	SetFlags(flags gdnative.Int)
+	SetFlags(flags TextureFlags)
+	DrawWithDefaults(canvasItem gdnative.Rid, position gdnative.Vector2)
+	DrawRectWithDefaults(canvasItem gdnative.Rid, rect gdnative.Rect2, tile gdnative.Bool)
+	DrawRectRegionWithDefaults(canvasItem gdnative.Rid, rect gdnative.Rect2, srcRect gdnative.Rect2)
+	Flags() gdnative.Int
 }
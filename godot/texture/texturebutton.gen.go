@@ -0,0 +1,650 @@
+package texture
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/bitmap"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TextureButtonStretchMode is an enum for StretchMode values.
+type TextureButtonStretchMode int
+
+const (
+	TextureButtonStretchKeep               TextureButtonStretchMode = 2
+	TextureButtonStretchKeepAspect         TextureButtonStretchMode = 4
+	TextureButtonStretchKeepAspectCentered TextureButtonStretchMode = 5
+	TextureButtonStretchKeepAspectCovered  TextureButtonStretchMode = 6
+	TextureButtonStretchKeepCentered       TextureButtonStretchMode = 3
+	TextureButtonStretchScale              TextureButtonStretchMode = 0
+	TextureButtonStretchTile               TextureButtonStretchMode = 1
+)
+
+// String will return the Godot name of the TextureButtonStretchMode value.
+func (e TextureButtonStretchMode) String() string {
+	switch e {
+	case TextureButtonStretchKeep:
+		return "STRETCH_KEEP"
+	case TextureButtonStretchKeepAspect:
+		return "STRETCH_KEEP_ASPECT"
+	case TextureButtonStretchKeepAspectCentered:
+		return "STRETCH_KEEP_ASPECT_CENTERED"
+	case TextureButtonStretchKeepAspectCovered:
+		return "STRETCH_KEEP_ASPECT_COVERED"
+	case TextureButtonStretchKeepCentered:
+		return "STRETCH_KEEP_CENTERED"
+	case TextureButtonStretchScale:
+		return "STRETCH_SCALE"
+	case TextureButtonStretchTile:
+		return "STRETCH_TILE"
+	}
+	return fmt.Sprintf("TextureButtonStretchMode(%d)", int(e))
+}
+
+// ParseTextureButtonStretchMode will return the TextureButtonStretchMode value with the given Godot name.
+func ParseTextureButtonStretchMode(name string) (TextureButtonStretchMode, error) {
+	switch name {
+	case "STRETCH_KEEP":
+		return TextureButtonStretchKeep, nil
+	case "STRETCH_KEEP_ASPECT":
+		return TextureButtonStretchKeepAspect, nil
+	case "STRETCH_KEEP_ASPECT_CENTERED":
+		return TextureButtonStretchKeepAspectCentered, nil
+	case "STRETCH_KEEP_ASPECT_COVERED":
+		return TextureButtonStretchKeepAspectCovered, nil
+	case "STRETCH_KEEP_CENTERED":
+		return TextureButtonStretchKeepCentered, nil
+	case "STRETCH_SCALE":
+		return TextureButtonStretchScale, nil
+	case "STRETCH_TILE":
+		return TextureButtonStretchTile, nil
+	}
+	return 0, fmt.Errorf("invalid TextureButtonStretchMode value %q", name)
+}
+
+/*
+[code]TextureButton[/code] has the same functionality as [Button], except it uses sprites instead of Godot's [Theme] resource. It is faster to create, but it doesn't support localization like more complex Controls. The Normal state's texture is required. Others are optional.
+*/
+type TextureButton struct {
+	godot.BaseButton
+	owner gdnative.Object
+}
+
+// classConstructorTextureButton is the class constructor of the TextureButton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTextureButton = gdnative.NewLazyClassConstructor("TextureButton")
+
+// NewTextureButton will create a new instance of the TextureButton class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTextureButton() *TextureButton {
+	obj := &TextureButton{}
+	obj.SetBaseObject(classConstructorTextureButton.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *TextureButton) BaseClass() string {
+	return "TextureButton"
+}
+
+// Method binds of the TextureButton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTextureButtonGetClickMask       = gdnative.NewLazyMethodBind("TextureButton", "get_click_mask")
+	methodBindTextureButtonGetDisabledTexture = gdnative.NewLazyMethodBind("TextureButton", "get_disabled_texture")
+	methodBindTextureButtonGetExpand          = gdnative.NewLazyMethodBind("TextureButton", "get_expand")
+	methodBindTextureButtonGetFocusedTexture  = gdnative.NewLazyMethodBind("TextureButton", "get_focused_texture")
+	methodBindTextureButtonGetHoverTexture    = gdnative.NewLazyMethodBind("TextureButton", "get_hover_texture")
+	methodBindTextureButtonGetNormalTexture   = gdnative.NewLazyMethodBind("TextureButton", "get_normal_texture")
+	methodBindTextureButtonGetPressedTexture  = gdnative.NewLazyMethodBind("TextureButton", "get_pressed_texture")
+	methodBindTextureButtonGetStretchMode     = gdnative.NewLazyMethodBind("TextureButton", "get_stretch_mode")
+	methodBindTextureButtonSetClickMask       = gdnative.NewLazyMethodBind("TextureButton", "set_click_mask")
+	methodBindTextureButtonSetDisabledTexture = gdnative.NewLazyMethodBind("TextureButton", "set_disabled_texture")
+	methodBindTextureButtonSetExpand          = gdnative.NewLazyMethodBind("TextureButton", "set_expand")
+	methodBindTextureButtonSetFocusedTexture  = gdnative.NewLazyMethodBind("TextureButton", "set_focused_texture")
+	methodBindTextureButtonSetHoverTexture    = gdnative.NewLazyMethodBind("TextureButton", "set_hover_texture")
+	methodBindTextureButtonSetNormalTexture   = gdnative.NewLazyMethodBind("TextureButton", "set_normal_texture")
+	methodBindTextureButtonSetPressedTexture  = gdnative.NewLazyMethodBind("TextureButton", "set_pressed_texture")
+	methodBindTextureButtonSetStretchMode     = gdnative.NewLazyMethodBind("TextureButton", "set_stretch_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: BitMap
+*/
+func (o *TextureButton) GetClickMask() bitmap.BitMapImplementer {
+	//log.Println("Calling TextureButton.GetClickMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetClickMask.Get()
+
+	// Call the parent method.
+	// BitMap
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := bitmap.BitMap{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(bitmap.BitMapImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureButton) GetDisabledTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureButton.GetDisabledTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetDisabledTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *TextureButton) GetExpand() gdnative.Bool {
+	//log.Println("Calling TextureButton.GetExpand()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetExpand.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureButton) GetFocusedTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureButton.GetFocusedTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetFocusedTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureButton) GetHoverTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureButton.GetHoverTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetHoverTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureButton) GetNormalTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureButton.GetNormalTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetNormalTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureButton) GetPressedTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureButton.GetPressedTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetPressedTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.TextureButton::StretchMode
+*/
+func (o *TextureButton) GetStretchMode() TextureButtonStretchMode {
+	//log.Println("Calling TextureButton.GetStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonGetStretchMode.Get()
+
+	// Call the parent method.
+	// enum.TextureButton::StretchMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TextureButtonStretchMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false mask BitMap}], Returns: void
+*/
+func (o *TextureButton) SetClickMask(mask bitmap.BitMapImplementer) {
+	//log.Println("Calling TextureButton.SetClickMask()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(mask))
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetClickMask.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *TextureButton) SetDisabledTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling TextureButton.SetDisabledTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetDisabledTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false p_expand bool}], Returns: void
+*/
+func (o *TextureButton) SetExpand(pExpand gdnative.Bool) {
+	//log.Println("Calling TextureButton.SetExpand()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(pExpand)
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetExpand.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *TextureButton) SetFocusedTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling TextureButton.SetFocusedTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetFocusedTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *TextureButton) SetHoverTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling TextureButton.SetHoverTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetHoverTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *TextureButton) SetNormalTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling TextureButton.SetNormalTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetNormalTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *TextureButton) SetPressedTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling TextureButton.SetPressedTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetPressedTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false p_mode enum.TextureButton::StretchMode}], Returns: void
+*/
+func (o *TextureButton) SetStretchMode(pMode TextureButtonStretchMode) {
+	//log.Println("Calling TextureButton.SetStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(pMode))
+
+	// Get the method bind
+	methodBind := methodBindTextureButtonSetStretchMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Expand will return the value of the "expand" property.
+func (o *TextureButton) Expand() gdnative.Bool {
+	return o.GetExpand()
+}
+
+// StretchMode will return the value of the "stretch_mode" property.
+func (o *TextureButton) StretchMode() TextureButtonStretchMode {
+	return o.GetStretchMode()
+}
+
+// TextureClickMask will return the value of the "texture_click_mask" property.
+func (o *TextureButton) TextureClickMask() bitmap.BitMapImplementer {
+	return o.GetClickMask()
+}
+
+// SetTextureClickMask will set the value of the "texture_click_mask" property.
+func (o *TextureButton) SetTextureClickMask(value bitmap.BitMapImplementer) {
+	o.SetClickMask(value)
+}
+
+// TextureDisabled will return the value of the "texture_disabled" property.
+func (o *TextureButton) TextureDisabled() godot.TextureImplementer {
+	return o.GetDisabledTexture()
+}
+
+// SetTextureDisabled will set the value of the "texture_disabled" property.
+func (o *TextureButton) SetTextureDisabled(value godot.TextureImplementer) {
+	o.SetDisabledTexture(value)
+}
+
+// TextureFocused will return the value of the "texture_focused" property.
+func (o *TextureButton) TextureFocused() godot.TextureImplementer {
+	return o.GetFocusedTexture()
+}
+
+// SetTextureFocused will set the value of the "texture_focused" property.
+func (o *TextureButton) SetTextureFocused(value godot.TextureImplementer) {
+	o.SetFocusedTexture(value)
+}
+
+// TextureHover will return the value of the "texture_hover" property.
+func (o *TextureButton) TextureHover() godot.TextureImplementer {
+	return o.GetHoverTexture()
+}
+
+// SetTextureHover will set the value of the "texture_hover" property.
+func (o *TextureButton) SetTextureHover(value godot.TextureImplementer) {
+	o.SetHoverTexture(value)
+}
+
+// TextureNormal will return the value of the "texture_normal" property.
+func (o *TextureButton) TextureNormal() godot.TextureImplementer {
+	return o.GetNormalTexture()
+}
+
+// SetTextureNormal will set the value of the "texture_normal" property.
+func (o *TextureButton) SetTextureNormal(value godot.TextureImplementer) {
+	o.SetNormalTexture(value)
+}
+
+// TexturePressed will return the value of the "texture_pressed" property.
+func (o *TextureButton) TexturePressed() godot.TextureImplementer {
+	return o.GetPressedTexture()
+}
+
+// SetTexturePressed will set the value of the "texture_pressed" property.
+func (o *TextureButton) SetTexturePressed(value godot.TextureImplementer) {
+	o.SetPressedTexture(value)
+}
+
+// TextureButtonImplementer is an interface that implements the methods
+// of the TextureButton class.
+type TextureButtonImplementer interface {
+	godot.BaseButtonImplementer
+	GetClickMask() bitmap.BitMapImplementer
+	GetDisabledTexture() godot.TextureImplementer
+	GetExpand() gdnative.Bool
+	GetFocusedTexture() godot.TextureImplementer
+	GetHoverTexture() godot.TextureImplementer
+	GetNormalTexture() godot.TextureImplementer
+	GetPressedTexture() godot.TextureImplementer
+	GetStretchMode() TextureButtonStretchMode
+	SetClickMask(mask bitmap.BitMapImplementer)
+	SetDisabledTexture(texture godot.TextureImplementer)
+	SetExpand(pExpand gdnative.Bool)
+	SetFocusedTexture(texture godot.TextureImplementer)
+	SetHoverTexture(texture godot.TextureImplementer)
+	SetNormalTexture(texture godot.TextureImplementer)
+	SetPressedTexture(texture godot.TextureImplementer)
+	SetStretchMode(pMode TextureButtonStretchMode)
+	Expand() gdnative.Bool
+	StretchMode() TextureButtonStretchMode
+	TextureClickMask() bitmap.BitMapImplementer
+	SetTextureClickMask(value bitmap.BitMapImplementer)
+	TextureDisabled() godot.TextureImplementer
+	SetTextureDisabled(value godot.TextureImplementer)
+	TextureFocused() godot.TextureImplementer
+	SetTextureFocused(value godot.TextureImplementer)
+	TextureHover() godot.TextureImplementer
+	SetTextureHover(value godot.TextureImplementer)
+	TextureNormal() godot.TextureImplementer
+	SetTextureNormal(value godot.TextureImplementer)
+	TexturePressed() godot.TextureImplementer
+	SetTexturePressed(value godot.TextureImplementer)
+}
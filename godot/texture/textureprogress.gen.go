@@ -0,0 +1,682 @@
+package texture
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TextureProgressFillMode is an enum for FillMode values.
+type TextureProgressFillMode int
+
+const (
+	TextureProgressFillBottomToTop      TextureProgressFillMode = 3
+	TextureProgressFillClockwise        TextureProgressFillMode = 4
+	TextureProgressFillCounterClockwise TextureProgressFillMode = 5
+	TextureProgressFillLeftToRight      TextureProgressFillMode = 0
+	TextureProgressFillRightToLeft      TextureProgressFillMode = 1
+	TextureProgressFillTopToBottom      TextureProgressFillMode = 2
+)
+
+// String will return the Godot name of the TextureProgressFillMode value.
+func (e TextureProgressFillMode) String() string {
+	switch e {
+	case TextureProgressFillBottomToTop:
+		return "FILL_BOTTOM_TO_TOP"
+	case TextureProgressFillClockwise:
+		return "FILL_CLOCKWISE"
+	case TextureProgressFillCounterClockwise:
+		return "FILL_COUNTER_CLOCKWISE"
+	case TextureProgressFillLeftToRight:
+		return "FILL_LEFT_TO_RIGHT"
+	case TextureProgressFillRightToLeft:
+		return "FILL_RIGHT_TO_LEFT"
+	case TextureProgressFillTopToBottom:
+		return "FILL_TOP_TO_BOTTOM"
+	}
+	return fmt.Sprintf("TextureProgressFillMode(%d)", int(e))
+}
+
+// ParseTextureProgressFillMode will return the TextureProgressFillMode value with the given Godot name.
+func ParseTextureProgressFillMode(name string) (TextureProgressFillMode, error) {
+	switch name {
+	case "FILL_BOTTOM_TO_TOP":
+		return TextureProgressFillBottomToTop, nil
+	case "FILL_CLOCKWISE":
+		return TextureProgressFillClockwise, nil
+	case "FILL_COUNTER_CLOCKWISE":
+		return TextureProgressFillCounterClockwise, nil
+	case "FILL_LEFT_TO_RIGHT":
+		return TextureProgressFillLeftToRight, nil
+	case "FILL_RIGHT_TO_LEFT":
+		return TextureProgressFillRightToLeft, nil
+	case "FILL_TOP_TO_BOTTOM":
+		return TextureProgressFillTopToBottom, nil
+	}
+	return 0, fmt.Errorf("invalid TextureProgressFillMode value %q", name)
+}
+
+/*
+TextureProgress works like [ProgressBar] but it uses up to 3 textures instead of Godot's [Theme] resource. Works horizontally, vertically, and radially.
+*/
+type TextureProgress struct {
+	godot.Range
+	owner gdnative.Object
+}
+
+// classConstructorTextureProgress is the class constructor of the TextureProgress class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTextureProgress = gdnative.NewLazyClassConstructor("TextureProgress")
+
+// NewTextureProgress will create a new instance of the TextureProgress class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTextureProgress() *TextureProgress {
+	obj := &TextureProgress{}
+	obj.SetBaseObject(classConstructorTextureProgress.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *TextureProgress) BaseClass() string {
+	return "TextureProgress"
+}
+
+// Method binds of the TextureProgress class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTextureProgressGetFillDegrees        = gdnative.NewLazyMethodBind("TextureProgress", "get_fill_degrees")
+	methodBindTextureProgressGetFillMode           = gdnative.NewLazyMethodBind("TextureProgress", "get_fill_mode")
+	methodBindTextureProgressGetNinePatchStretch   = gdnative.NewLazyMethodBind("TextureProgress", "get_nine_patch_stretch")
+	methodBindTextureProgressGetOverTexture        = gdnative.NewLazyMethodBind("TextureProgress", "get_over_texture")
+	methodBindTextureProgressGetProgressTexture    = gdnative.NewLazyMethodBind("TextureProgress", "get_progress_texture")
+	methodBindTextureProgressGetRadialCenterOffset = gdnative.NewLazyMethodBind("TextureProgress", "get_radial_center_offset")
+	methodBindTextureProgressGetRadialInitialAngle = gdnative.NewLazyMethodBind("TextureProgress", "get_radial_initial_angle")
+	methodBindTextureProgressGetStretchMargin      = gdnative.NewLazyMethodBind("TextureProgress", "get_stretch_margin")
+	methodBindTextureProgressGetUnderTexture       = gdnative.NewLazyMethodBind("TextureProgress", "get_under_texture")
+	methodBindTextureProgressSetFillDegrees        = gdnative.NewLazyMethodBind("TextureProgress", "set_fill_degrees")
+	methodBindTextureProgressSetFillMode           = gdnative.NewLazyMethodBind("TextureProgress", "set_fill_mode")
+	methodBindTextureProgressSetNinePatchStretch   = gdnative.NewLazyMethodBind("TextureProgress", "set_nine_patch_stretch")
+	methodBindTextureProgressSetOverTexture        = gdnative.NewLazyMethodBind("TextureProgress", "set_over_texture")
+	methodBindTextureProgressSetProgressTexture    = gdnative.NewLazyMethodBind("TextureProgress", "set_progress_texture")
+	methodBindTextureProgressSetRadialCenterOffset = gdnative.NewLazyMethodBind("TextureProgress", "set_radial_center_offset")
+	methodBindTextureProgressSetRadialInitialAngle = gdnative.NewLazyMethodBind("TextureProgress", "set_radial_initial_angle")
+	methodBindTextureProgressSetStretchMargin      = gdnative.NewLazyMethodBind("TextureProgress", "set_stretch_margin")
+	methodBindTextureProgressSetUnderTexture       = gdnative.NewLazyMethodBind("TextureProgress", "set_under_texture")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *TextureProgress) GetFillDegrees() gdnative.Real {
+	//log.Println("Calling TextureProgress.GetFillDegrees()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetFillDegrees.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *TextureProgress) GetFillMode() gdnative.Int {
+	//log.Println("Calling TextureProgress.GetFillMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetFillMode.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *TextureProgress) GetNinePatchStretch() gdnative.Bool {
+	//log.Println("Calling TextureProgress.GetNinePatchStretch()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetNinePatchStretch.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureProgress) GetOverTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureProgress.GetOverTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetOverTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureProgress) GetProgressTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureProgress.GetProgressTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetProgressTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Vector2
+*/
+func (o *TextureProgress) GetRadialCenterOffset() gdnative.Vector2 {
+	//log.Println("Calling TextureProgress.GetRadialCenterOffset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetRadialCenterOffset.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *TextureProgress) GetRadialInitialAngle() gdnative.Real {
+	//log.Println("Calling TextureProgress.GetRadialInitialAngle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetRadialInitialAngle.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false margin int}], Returns: int
+*/
+func (o *TextureProgress) GetStretchMargin(margin gdnative.Int) gdnative.Int {
+	//log.Println("Calling TextureProgress.GetStretchMargin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetStretchMargin.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureProgress) GetUnderTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureProgress.GetUnderTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressGetUnderTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode float}], Returns: void
+*/
+func (o *TextureProgress) SetFillDegrees(mode gdnative.Real) {
+	//log.Println("Calling TextureProgress.SetFillDegrees()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(mode)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetFillDegrees.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode int}], Returns: void
+*/
+func (o *TextureProgress) SetFillMode(mode gdnative.Int) {
+	//log.Println("Calling TextureProgress.SetFillMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetFillMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false stretch bool}], Returns: void
+*/
+func (o *TextureProgress) SetNinePatchStretch(stretch gdnative.Bool) {
+	//log.Println("Calling TextureProgress.SetNinePatchStretch()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(stretch)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetNinePatchStretch.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false tex Texture}], Returns: void
+*/
+func (o *TextureProgress) SetOverTexture(tex godot.TextureImplementer) {
+	//log.Println("Calling TextureProgress.SetOverTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(tex))
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetOverTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false tex Texture}], Returns: void
+*/
+func (o *TextureProgress) SetProgressTexture(tex godot.TextureImplementer) {
+	//log.Println("Calling TextureProgress.SetProgressTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(tex))
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetProgressTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode Vector2}], Returns: void
+*/
+func (o *TextureProgress) SetRadialCenterOffset(mode gdnative.Vector2) {
+	//log.Println("Calling TextureProgress.SetRadialCenterOffset()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(mode)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetRadialCenterOffset.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode float}], Returns: void
+*/
+func (o *TextureProgress) SetRadialInitialAngle(mode gdnative.Real) {
+	//log.Println("Calling TextureProgress.SetRadialInitialAngle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(mode)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetRadialInitialAngle.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false margin int} { false value int}], Returns: void
+*/
+func (o *TextureProgress) SetStretchMargin(margin gdnative.Int, value gdnative.Int) {
+	//log.Println("Calling TextureProgress.SetStretchMargin()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(margin)
+	ptrArguments[1] = gdnative.NewPointerFromInt(value)
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetStretchMargin.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false tex Texture}], Returns: void
+*/
+func (o *TextureProgress) SetUnderTexture(tex godot.TextureImplementer) {
+	//log.Println("Calling TextureProgress.SetUnderTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(tex))
+
+	// Get the method bind
+	methodBind := methodBindTextureProgressSetUnderTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// FillMode will return the value of the "fill_mode" property.
+func (o *TextureProgress) FillMode() gdnative.Int {
+	return o.GetFillMode()
+}
+
+// NinePatchStretch will return the value of the "nine_patch_stretch" property.
+func (o *TextureProgress) NinePatchStretch() gdnative.Bool {
+	return o.GetNinePatchStretch()
+}
+
+// RadialCenterOffset will return the value of the "radial_center_offset" property.
+func (o *TextureProgress) RadialCenterOffset() gdnative.Vector2 {
+	return o.GetRadialCenterOffset()
+}
+
+// RadialFillDegrees will return the value of the "radial_fill_degrees" property.
+func (o *TextureProgress) RadialFillDegrees() gdnative.Real {
+	return o.GetFillDegrees()
+}
+
+// SetRadialFillDegrees will set the value of the "radial_fill_degrees" property.
+func (o *TextureProgress) SetRadialFillDegrees(value gdnative.Real) {
+	o.SetFillDegrees(value)
+}
+
+// RadialInitialAngle will return the value of the "radial_initial_angle" property.
+func (o *TextureProgress) RadialInitialAngle() gdnative.Real {
+	return o.GetRadialInitialAngle()
+}
+
+// StretchMarginBottom will return the value of the "stretch_margin_bottom" property.
+func (o *TextureProgress) StretchMarginBottom() gdnative.Int {
+	return o.GetStretchMargin(3)
+}
+
+// SetStretchMarginBottom will set the value of the "stretch_margin_bottom" property.
+func (o *TextureProgress) SetStretchMarginBottom(value gdnative.Int) {
+	o.SetStretchMargin(3, value)
+}
+
+// StretchMarginLeft will return the value of the "stretch_margin_left" property.
+func (o *TextureProgress) StretchMarginLeft() gdnative.Int {
+	return o.GetStretchMargin(0)
+}
+
+// SetStretchMarginLeft will set the value of the "stretch_margin_left" property.
+func (o *TextureProgress) SetStretchMarginLeft(value gdnative.Int) {
+	o.SetStretchMargin(0, value)
+}
+
+// StretchMarginRight will return the value of the "stretch_margin_right" property.
+func (o *TextureProgress) StretchMarginRight() gdnative.Int {
+	return o.GetStretchMargin(2)
+}
+
+// SetStretchMarginRight will set the value of the "stretch_margin_right" property.
+func (o *TextureProgress) SetStretchMarginRight(value gdnative.Int) {
+	o.SetStretchMargin(2, value)
+}
+
+// StretchMarginTop will return the value of the "stretch_margin_top" property.
+func (o *TextureProgress) StretchMarginTop() gdnative.Int {
+	return o.GetStretchMargin(1)
+}
+
+// SetStretchMarginTop will set the value of the "stretch_margin_top" property.
+func (o *TextureProgress) SetStretchMarginTop(value gdnative.Int) {
+	o.SetStretchMargin(1, value)
+}
+
+// TextureOver will return the value of the "texture_over" property.
+func (o *TextureProgress) TextureOver() godot.TextureImplementer {
+	return o.GetOverTexture()
+}
+
+// SetTextureOver will set the value of the "texture_over" property.
+func (o *TextureProgress) SetTextureOver(value godot.TextureImplementer) {
+	o.SetOverTexture(value)
+}
+
+// TextureUnder will return the value of the "texture_under" property.
+func (o *TextureProgress) TextureUnder() godot.TextureImplementer {
+	return o.GetUnderTexture()
+}
+
+// SetTextureUnder will set the value of the "texture_under" property.
+func (o *TextureProgress) SetTextureUnder(value godot.TextureImplementer) {
+	o.SetUnderTexture(value)
+}
+
+// TextureProgressImplementer is an interface that implements the methods
+// of the TextureProgress class.
+type TextureProgressImplementer interface {
+	godot.RangeImplementer
+	GetFillDegrees() gdnative.Real
+	GetFillMode() gdnative.Int
+	GetNinePatchStretch() gdnative.Bool
+	GetOverTexture() godot.TextureImplementer
+	GetProgressTexture() godot.TextureImplementer
+	GetRadialCenterOffset() gdnative.Vector2
+	GetRadialInitialAngle() gdnative.Real
+	GetStretchMargin(margin gdnative.Int) gdnative.Int
+	GetUnderTexture() godot.TextureImplementer
+	SetFillDegrees(mode gdnative.Real)
+	SetFillMode(mode gdnative.Int)
+	SetNinePatchStretch(stretch gdnative.Bool)
+	SetOverTexture(tex godot.TextureImplementer)
+	SetProgressTexture(tex godot.TextureImplementer)
+	SetRadialCenterOffset(mode gdnative.Vector2)
+	SetRadialInitialAngle(mode gdnative.Real)
+	SetStretchMargin(margin gdnative.Int, value gdnative.Int)
+	SetUnderTexture(tex godot.TextureImplementer)
+	FillMode() gdnative.Int
+	NinePatchStretch() gdnative.Bool
+	RadialCenterOffset() gdnative.Vector2
+	RadialFillDegrees() gdnative.Real
+	SetRadialFillDegrees(value gdnative.Real)
+	RadialInitialAngle() gdnative.Real
+	StretchMarginBottom() gdnative.Int
+	SetStretchMarginBottom(value gdnative.Int)
+	StretchMarginLeft() gdnative.Int
+	SetStretchMarginLeft(value gdnative.Int)
+	StretchMarginRight() gdnative.Int
+	SetStretchMarginRight(value gdnative.Int)
+	StretchMarginTop() gdnative.Int
+	SetStretchMarginTop(value gdnative.Int)
+	TextureOver() godot.TextureImplementer
+	SetTextureOver(value godot.TextureImplementer)
+	TextureUnder() godot.TextureImplementer
+	SetTextureUnder(value godot.TextureImplementer)
+}
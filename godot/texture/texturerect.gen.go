@@ -0,0 +1,288 @@
+package texture
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TextureRectStretchMode is an enum for StretchMode values.
+type TextureRectStretchMode int
+
+const (
+	TextureRectStretchKeep               TextureRectStretchMode = 3
+	TextureRectStretchKeepAspect         TextureRectStretchMode = 5
+	TextureRectStretchKeepAspectCentered TextureRectStretchMode = 6
+	TextureRectStretchKeepAspectCovered  TextureRectStretchMode = 7
+	TextureRectStretchKeepCentered       TextureRectStretchMode = 4
+	TextureRectStretchScale              TextureRectStretchMode = 1
+	TextureRectStretchScaleOnExpand      TextureRectStretchMode = 0
+	TextureRectStretchTile               TextureRectStretchMode = 2
+)
+
+// String will return the Godot name of the TextureRectStretchMode value.
+func (e TextureRectStretchMode) String() string {
+	switch e {
+	case TextureRectStretchKeep:
+		return "STRETCH_KEEP"
+	case TextureRectStretchKeepAspect:
+		return "STRETCH_KEEP_ASPECT"
+	case TextureRectStretchKeepAspectCentered:
+		return "STRETCH_KEEP_ASPECT_CENTERED"
+	case TextureRectStretchKeepAspectCovered:
+		return "STRETCH_KEEP_ASPECT_COVERED"
+	case TextureRectStretchKeepCentered:
+		return "STRETCH_KEEP_CENTERED"
+	case TextureRectStretchScale:
+		return "STRETCH_SCALE"
+	case TextureRectStretchScaleOnExpand:
+		return "STRETCH_SCALE_ON_EXPAND"
+	case TextureRectStretchTile:
+		return "STRETCH_TILE"
+	}
+	return fmt.Sprintf("TextureRectStretchMode(%d)", int(e))
+}
+
+// ParseTextureRectStretchMode will return the TextureRectStretchMode value with the given Godot name.
+func ParseTextureRectStretchMode(name string) (TextureRectStretchMode, error) {
+	switch name {
+	case "STRETCH_KEEP":
+		return TextureRectStretchKeep, nil
+	case "STRETCH_KEEP_ASPECT":
+		return TextureRectStretchKeepAspect, nil
+	case "STRETCH_KEEP_ASPECT_CENTERED":
+		return TextureRectStretchKeepAspectCentered, nil
+	case "STRETCH_KEEP_ASPECT_COVERED":
+		return TextureRectStretchKeepAspectCovered, nil
+	case "STRETCH_KEEP_CENTERED":
+		return TextureRectStretchKeepCentered, nil
+	case "STRETCH_SCALE":
+		return TextureRectStretchScale, nil
+	case "STRETCH_SCALE_ON_EXPAND":
+		return TextureRectStretchScaleOnExpand, nil
+	case "STRETCH_TILE":
+		return TextureRectStretchTile, nil
+	}
+	return 0, fmt.Errorf("invalid TextureRectStretchMode value %q", name)
+}
+
+/*
+Use TextureRect to draw icons and sprites in your User Interfaces. To create panels and menu boxes, take a look at [NinePatchFrame]. Its Stretch Mode property controls the texture's scale and placement. It can scale, tile and stay centered inside its bounding rectangle. TextureRect is one of the 5 most common nodes to create game UI.
+*/
+type TextureRect struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorTextureRect is the class constructor of the TextureRect class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTextureRect = gdnative.NewLazyClassConstructor("TextureRect")
+
+// NewTextureRect will create a new instance of the TextureRect class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTextureRect() *TextureRect {
+	obj := &TextureRect{}
+	obj.SetBaseObject(classConstructorTextureRect.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *TextureRect) BaseClass() string {
+	return "TextureRect"
+}
+
+// Method binds of the TextureRect class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTextureRectGetStretchMode = gdnative.NewLazyMethodBind("TextureRect", "get_stretch_mode")
+	methodBindTextureRectGetTexture     = gdnative.NewLazyMethodBind("TextureRect", "get_texture")
+	methodBindTextureRectHasExpand      = gdnative.NewLazyMethodBind("TextureRect", "has_expand")
+	methodBindTextureRectSetExpand      = gdnative.NewLazyMethodBind("TextureRect", "set_expand")
+	methodBindTextureRectSetStretchMode = gdnative.NewLazyMethodBind("TextureRect", "set_stretch_mode")
+	methodBindTextureRectSetTexture     = gdnative.NewLazyMethodBind("TextureRect", "set_texture")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.TextureRect::StretchMode
+*/
+func (o *TextureRect) GetStretchMode() TextureRectStretchMode {
+	//log.Println("Calling TextureRect.GetStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureRectGetStretchMode.Get()
+
+	// Call the parent method.
+	// enum.TextureRect::StretchMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TextureRectStretchMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Texture
+*/
+func (o *TextureRect) GetTexture() godot.TextureImplementer {
+	//log.Println("Calling TextureRect.GetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureRectGetTexture.Get()
+
+	// Call the parent method.
+	// Texture
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *TextureRect) HasExpand() gdnative.Bool {
+	//log.Println("Calling TextureRect.HasExpand()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTextureRectHasExpand.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *TextureRect) SetExpand(enable gdnative.Bool) {
+	//log.Println("Calling TextureRect.SetExpand()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindTextureRectSetExpand.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false stretch_mode enum.TextureRect::StretchMode}], Returns: void
+*/
+func (o *TextureRect) SetStretchMode(stretchMode TextureRectStretchMode) {
+	//log.Println("Calling TextureRect.SetStretchMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(stretchMode))
+
+	// Get the method bind
+	methodBind := methodBindTextureRectSetStretchMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false texture Texture}], Returns: void
+*/
+func (o *TextureRect) SetTexture(texture godot.TextureImplementer) {
+	//log.Println("Calling TextureRect.SetTexture()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
+
+	// Get the method bind
+	methodBind := methodBindTextureRectSetTexture.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Expand will return the value of the "expand" property.
+func (o *TextureRect) Expand() gdnative.Bool {
+	return o.HasExpand()
+}
+
+// StretchMode will return the value of the "stretch_mode" property.
+func (o *TextureRect) StretchMode() TextureRectStretchMode {
+	return o.GetStretchMode()
+}
+
+// Texture will return the value of the "texture" property.
+func (o *TextureRect) Texture() godot.TextureImplementer {
+	return o.GetTexture()
+}
+
+// TextureRectImplementer is an interface that implements the methods
+// of the TextureRect class.
+type TextureRectImplementer interface {
+	godot.ControlImplementer
+	GetStretchMode() TextureRectStretchMode
+	GetTexture() godot.TextureImplementer
+	HasExpand() gdnative.Bool
+	SetExpand(enable gdnative.Bool)
+	SetStretchMode(stretchMode TextureRectStretchMode)
+	SetTexture(texture godot.TextureImplementer)
+	Expand() gdnative.Bool
+	StretchMode() TextureRectStretchMode
+	Texture() godot.TextureImplementer
+}
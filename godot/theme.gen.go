@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewThemeFromPointer(ptr gdnative.Pointer) Theme {
-func newThemeFromPointer(ptr gdnative.Pointer) Theme {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Theme{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Theme for skinning controls. Controls can be skinned individually, but for complex applications it's more efficient to just create a global theme that defines everything. This theme can be applied to any [Control], and it and its children will automatically use it. Theme resources can be alternatively loaded by writing them in a .theme file, see docs for more info.
 */
@@ -30,13 +21,66 @@ type Theme struct {
 	owner gdnative.Object
 }
 
+// classConstructorTheme is the class constructor of the Theme class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTheme = gdnative.NewLazyClassConstructor("Theme")
+
+// NewTheme will create a new instance of the Theme class.
+// Theme is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewTheme() *Theme {
+	obj := &Theme{}
+	obj.SetBaseObject(classConstructorTheme.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Theme) BaseClass() string {
 	return "Theme"
 }
 
+// Method binds of the Theme class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindThemeX_EmitThemeChanged = gdnative.NewLazyMethodBind("Theme", "_emit_theme_changed")
+	methodBindThemeClearColor         = gdnative.NewLazyMethodBind("Theme", "clear_color")
+	methodBindThemeClearConstant      = gdnative.NewLazyMethodBind("Theme", "clear_constant")
+	methodBindThemeClearFont          = gdnative.NewLazyMethodBind("Theme", "clear_font")
+	methodBindThemeClearIcon          = gdnative.NewLazyMethodBind("Theme", "clear_icon")
+	methodBindThemeClearStylebox      = gdnative.NewLazyMethodBind("Theme", "clear_stylebox")
+	methodBindThemeCopyDefaultTheme   = gdnative.NewLazyMethodBind("Theme", "copy_default_theme")
+	methodBindThemeGetColor           = gdnative.NewLazyMethodBind("Theme", "get_color")
+	methodBindThemeGetColorList       = gdnative.NewLazyMethodBind("Theme", "get_color_list")
+	methodBindThemeGetConstant        = gdnative.NewLazyMethodBind("Theme", "get_constant")
+	methodBindThemeGetConstantList    = gdnative.NewLazyMethodBind("Theme", "get_constant_list")
+	methodBindThemeGetDefaultFont     = gdnative.NewLazyMethodBind("Theme", "get_default_font")
+	methodBindThemeGetFont            = gdnative.NewLazyMethodBind("Theme", "get_font")
+	methodBindThemeGetFontList        = gdnative.NewLazyMethodBind("Theme", "get_font_list")
+	methodBindThemeGetIcon            = gdnative.NewLazyMethodBind("Theme", "get_icon")
+	methodBindThemeGetIconList        = gdnative.NewLazyMethodBind("Theme", "get_icon_list")
+	methodBindThemeGetStylebox        = gdnative.NewLazyMethodBind("Theme", "get_stylebox")
+	methodBindThemeGetStyleboxList    = gdnative.NewLazyMethodBind("Theme", "get_stylebox_list")
+	methodBindThemeGetStyleboxTypes   = gdnative.NewLazyMethodBind("Theme", "get_stylebox_types")
+	methodBindThemeGetTypeList        = gdnative.NewLazyMethodBind("Theme", "get_type_list")
+	methodBindThemeHasColor           = gdnative.NewLazyMethodBind("Theme", "has_color")
+	methodBindThemeHasConstant        = gdnative.NewLazyMethodBind("Theme", "has_constant")
+	methodBindThemeHasFont            = gdnative.NewLazyMethodBind("Theme", "has_font")
+	methodBindThemeHasIcon            = gdnative.NewLazyMethodBind("Theme", "has_icon")
+	methodBindThemeHasStylebox        = gdnative.NewLazyMethodBind("Theme", "has_stylebox")
+	methodBindThemeSetColor           = gdnative.NewLazyMethodBind("Theme", "set_color")
+	methodBindThemeSetConstant        = gdnative.NewLazyMethodBind("Theme", "set_constant")
+	methodBindThemeSetDefaultFont     = gdnative.NewLazyMethodBind("Theme", "set_default_font")
+	methodBindThemeSetFont            = gdnative.NewLazyMethodBind("Theme", "set_font")
+	methodBindThemeSetIcon            = gdnative.NewLazyMethodBind("Theme", "set_icon")
+	methodBindThemeSetStylebox        = gdnative.NewLazyMethodBind("Theme", "set_stylebox")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Theme) X_EmitThemeChanged() {
 	//log.Println("Calling Theme.X_EmitThemeChanged()")
@@ -45,7 +89,7 @@ func (o *Theme) X_EmitThemeChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "_emit_theme_changed")
+	methodBind := methodBindThemeX_EmitThemeChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -55,8 +99,8 @@ func (o *Theme) X_EmitThemeChanged() {
 }
 
 /*
-        Clears theme [Color] at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: void
+	        Clears theme [Color] at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: void
 */
 func (o *Theme) ClearColor(name gdnative.String, aType gdnative.String) {
 	//log.Println("Calling Theme.ClearColor()")
@@ -67,7 +111,7 @@ func (o *Theme) ClearColor(name gdnative.String, aType gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "clear_color")
+	methodBind := methodBindThemeClearColor.Get()
 
 	// Call the parent method.
 	// void
@@ -77,8 +121,8 @@ func (o *Theme) ClearColor(name gdnative.String, aType gdnative.String) {
 }
 
 /*
-        Clears theme constant at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: void
+	        Clears theme constant at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: void
 */
 func (o *Theme) ClearConstant(name gdnative.String, aType gdnative.String) {
 	//log.Println("Calling Theme.ClearConstant()")
@@ -89,7 +133,7 @@ func (o *Theme) ClearConstant(name gdnative.String, aType gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "clear_constant")
+	methodBind := methodBindThemeClearConstant.Get()
 
 	// Call the parent method.
 	// void
@@ -99,8 +143,8 @@ func (o *Theme) ClearConstant(name gdnative.String, aType gdnative.String) {
 }
 
 /*
-        Clears [Font] at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: void
+	        Clears [Font] at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: void
 */
 func (o *Theme) ClearFont(name gdnative.String, aType gdnative.String) {
 	//log.Println("Calling Theme.ClearFont()")
@@ -111,7 +155,7 @@ func (o *Theme) ClearFont(name gdnative.String, aType gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "clear_font")
+	methodBind := methodBindThemeClearFont.Get()
 
 	// Call the parent method.
 	// void
@@ -121,8 +165,8 @@ func (o *Theme) ClearFont(name gdnative.String, aType gdnative.String) {
 }
 
 /*
-        Clears icon at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: void
+	        Clears icon at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: void
 */
 func (o *Theme) ClearIcon(name gdnative.String, aType gdnative.String) {
 	//log.Println("Calling Theme.ClearIcon()")
@@ -133,7 +177,7 @@ func (o *Theme) ClearIcon(name gdnative.String, aType gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "clear_icon")
+	methodBind := methodBindThemeClearIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -143,8 +187,8 @@ func (o *Theme) ClearIcon(name gdnative.String, aType gdnative.String) {
 }
 
 /*
-        Clears [StyleBox] at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: void
+	        Clears [StyleBox] at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: void
 */
 func (o *Theme) ClearStylebox(name gdnative.String, aType gdnative.String) {
 	//log.Println("Calling Theme.ClearStylebox()")
@@ -155,7 +199,7 @@ func (o *Theme) ClearStylebox(name gdnative.String, aType gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "clear_stylebox")
+	methodBind := methodBindThemeClearStylebox.Get()
 
 	// Call the parent method.
 	// void
@@ -165,8 +209,8 @@ func (o *Theme) ClearStylebox(name gdnative.String, aType gdnative.String) {
 }
 
 /*
-        Sets theme values to a copy of the default theme values.
-	Args: [], Returns: void
+	        Sets theme values to a copy of the default theme values.
+		Args: [], Returns: void
 */
 func (o *Theme) CopyDefaultTheme() {
 	//log.Println("Calling Theme.CopyDefaultTheme()")
@@ -175,7 +219,7 @@ func (o *Theme) CopyDefaultTheme() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "copy_default_theme")
+	methodBind := methodBindThemeCopyDefaultTheme.Get()
 
 	// Call the parent method.
 	// void
@@ -185,8 +229,8 @@ func (o *Theme) CopyDefaultTheme() {
 }
 
 /*
-        Returns the [Color] at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: Color
+	        Returns the [Color] at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: Color
 */
 func (o *Theme) GetColor(name gdnative.String, aType gdnative.String) gdnative.Color {
 	//log.Println("Calling Theme.GetColor()")
@@ -197,7 +241,7 @@ func (o *Theme) GetColor(name gdnative.String, aType gdnative.String) gdnative.C
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_color")
+	methodBind := methodBindThemeGetColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -210,8 +254,8 @@ func (o *Theme) GetColor(name gdnative.String, aType gdnative.String) gdnative.C
 }
 
 /*
-        Returns all of the [Color]s as a [PoolStringArray] filled with each [Color]'s name, for use in [method get_color], if Theme has [code]type[/code].
-	Args: [{ false type String}], Returns: PoolStringArray
+	        Returns all of the [Color]s as a [PoolStringArray] filled with each [Color]'s name, for use in [method get_color], if Theme has [code]type[/code].
+		Args: [{ false type String}], Returns: PoolStringArray
 */
 func (o *Theme) GetColorList(aType gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling Theme.GetColorList()")
@@ -221,7 +265,7 @@ func (o *Theme) GetColorList(aType gdnative.String) gdnative.PoolStringArray {
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_color_list")
+	methodBind := methodBindThemeGetColorList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -234,8 +278,8 @@ func (o *Theme) GetColorList(aType gdnative.String) gdnative.PoolStringArray {
 }
 
 /*
-        Returns the constant at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: int
+	        Returns the constant at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: int
 */
 func (o *Theme) GetConstant(name gdnative.String, aType gdnative.String) gdnative.Int {
 	//log.Println("Calling Theme.GetConstant()")
@@ -246,7 +290,7 @@ func (o *Theme) GetConstant(name gdnative.String, aType gdnative.String) gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_constant")
+	methodBind := methodBindThemeGetConstant.Get()
 
 	// Call the parent method.
 	// int
@@ -259,8 +303,8 @@ func (o *Theme) GetConstant(name gdnative.String, aType gdnative.String) gdnativ
 }
 
 /*
-        Returns all of the constants as a [PoolStringArray] filled with each constant's name, for use in [method get_constant], if Theme has [code]type[/code].
-	Args: [{ false type String}], Returns: PoolStringArray
+	        Returns all of the constants as a [PoolStringArray] filled with each constant's name, for use in [method get_constant], if Theme has [code]type[/code].
+		Args: [{ false type String}], Returns: PoolStringArray
 */
 func (o *Theme) GetConstantList(aType gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling Theme.GetConstantList()")
@@ -270,7 +314,7 @@ func (o *Theme) GetConstantList(aType gdnative.String) gdnative.PoolStringArray
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_constant_list")
+	methodBind := methodBindThemeGetConstantList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -283,8 +327,8 @@ func (o *Theme) GetConstantList(aType gdnative.String) gdnative.PoolStringArray
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Font
+	        Undocumented
+		Args: [], Returns: Font
 */
 func (o *Theme) GetDefaultFont() FontImplementer {
 	//log.Println("Calling Theme.GetDefaultFont()")
@@ -293,7 +337,7 @@ func (o *Theme) GetDefaultFont() FontImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_default_font")
+	methodBind := methodBindThemeGetDefaultFont.Get()
 
 	// Call the parent method.
 	// Font
@@ -301,27 +345,25 @@ func (o *Theme) GetDefaultFont() FontImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newFontFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(FontImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Font" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(FontImplementer)
+	ret := Font{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(FontImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the [Font] at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: Font
+	        Returns the [Font] at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: Font
 */
 func (o *Theme) GetFont(name gdnative.String, aType gdnative.String) FontImplementer {
 	//log.Println("Calling Theme.GetFont()")
@@ -332,7 +374,7 @@ func (o *Theme) GetFont(name gdnative.String, aType gdnative.String) FontImpleme
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_font")
+	methodBind := methodBindThemeGetFont.Get()
 
 	// Call the parent method.
 	// Font
@@ -340,27 +382,25 @@ func (o *Theme) GetFont(name gdnative.String, aType gdnative.String) FontImpleme
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newFontFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(FontImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Font" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(FontImplementer)
+	ret := Font{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(FontImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns all of the [Font]s as a [PoolStringArray] filled with each [Font]'s name, for use in [method get_font], if Theme has [code]type[/code].
-	Args: [{ false type String}], Returns: PoolStringArray
+	        Returns all of the [Font]s as a [PoolStringArray] filled with each [Font]'s name, for use in [method get_font], if Theme has [code]type[/code].
+		Args: [{ false type String}], Returns: PoolStringArray
 */
 func (o *Theme) GetFontList(aType gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling Theme.GetFontList()")
@@ -370,7 +410,7 @@ func (o *Theme) GetFontList(aType gdnative.String) gdnative.PoolStringArray {
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_font_list")
+	methodBind := methodBindThemeGetFontList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -383,8 +423,8 @@ func (o *Theme) GetFontList(aType gdnative.String) gdnative.PoolStringArray {
 }
 
 /*
-        Returns the icon [Texture] at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: Texture
+	        Returns the icon [Texture] at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: Texture
 */
 func (o *Theme) GetIcon(name gdnative.String, aType gdnative.String) TextureImplementer {
 	//log.Println("Calling Theme.GetIcon()")
@@ -395,7 +435,7 @@ func (o *Theme) GetIcon(name gdnative.String, aType gdnative.String) TextureImpl
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_icon")
+	methodBind := methodBindThemeGetIcon.Get()
 
 	// Call the parent method.
 	// Texture
@@ -403,27 +443,25 @@ func (o *Theme) GetIcon(name gdnative.String, aType gdnative.String) TextureImpl
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns all of the icons as a [PoolStringArray] filled with each [Texture]'s name, for use in [method get_icon], if Theme has [code]type[/code].
-	Args: [{ false type String}], Returns: PoolStringArray
+	        Returns all of the icons as a [PoolStringArray] filled with each [Texture]'s name, for use in [method get_icon], if Theme has [code]type[/code].
+		Args: [{ false type String}], Returns: PoolStringArray
 */
 func (o *Theme) GetIconList(aType gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling Theme.GetIconList()")
@@ -433,7 +471,7 @@ func (o *Theme) GetIconList(aType gdnative.String) gdnative.PoolStringArray {
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_icon_list")
+	methodBind := methodBindThemeGetIconList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -446,8 +484,8 @@ func (o *Theme) GetIconList(aType gdnative.String) gdnative.PoolStringArray {
 }
 
 /*
-        Returns the icon [StyleBox] at [code]name[/code] if Theme has [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: StyleBox
+	        Returns the icon [StyleBox] at [code]name[/code] if Theme has [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: StyleBox
 */
 func (o *Theme) GetStylebox(name gdnative.String, aType gdnative.String) StyleBoxImplementer {
 	//log.Println("Calling Theme.GetStylebox()")
@@ -458,7 +496,7 @@ func (o *Theme) GetStylebox(name gdnative.String, aType gdnative.String) StyleBo
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_stylebox")
+	methodBind := methodBindThemeGetStylebox.Get()
 
 	// Call the parent method.
 	// StyleBox
@@ -466,27 +504,25 @@ func (o *Theme) GetStylebox(name gdnative.String, aType gdnative.String) StyleBo
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newStyleBoxFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(StyleBoxImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "StyleBox" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(StyleBoxImplementer)
+	ret := StyleBox{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(StyleBoxImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns all of the [StyleBox]s as a [PoolStringArray] filled with each [StyleBox]'s name, for use in [method get_stylebox], if Theme has [code]type[/code].
-	Args: [{ false type String}], Returns: PoolStringArray
+	        Returns all of the [StyleBox]s as a [PoolStringArray] filled with each [StyleBox]'s name, for use in [method get_stylebox], if Theme has [code]type[/code].
+		Args: [{ false type String}], Returns: PoolStringArray
 */
 func (o *Theme) GetStyleboxList(aType gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling Theme.GetStyleboxList()")
@@ -496,7 +532,7 @@ func (o *Theme) GetStyleboxList(aType gdnative.String) gdnative.PoolStringArray
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_stylebox_list")
+	methodBind := methodBindThemeGetStyleboxList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -509,8 +545,8 @@ func (o *Theme) GetStyleboxList(aType gdnative.String) gdnative.PoolStringArray
 }
 
 /*
-        Returns all of the [StyleBox] types as a [PoolStringArray] filled with each [StyleBox]'s type, for use in [method get_stylebox] and/or [method get_stylebox_list], if Theme has [code]type[/code].
-	Args: [], Returns: PoolStringArray
+	        Returns all of the [StyleBox] types as a [PoolStringArray] filled with each [StyleBox]'s type, for use in [method get_stylebox] and/or [method get_stylebox_list], if Theme has [code]type[/code].
+		Args: [], Returns: PoolStringArray
 */
 func (o *Theme) GetStyleboxTypes() gdnative.PoolStringArray {
 	//log.Println("Calling Theme.GetStyleboxTypes()")
@@ -519,7 +555,7 @@ func (o *Theme) GetStyleboxTypes() gdnative.PoolStringArray {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_stylebox_types")
+	methodBind := methodBindThemeGetStyleboxTypes.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -532,8 +568,8 @@ func (o *Theme) GetStyleboxTypes() gdnative.PoolStringArray {
 }
 
 /*
-        Returns all of the types in [code]type[/code] as a [PoolStringArray] for use in any of the get_* functions, if Theme has [code]type[/code].
-	Args: [{ false type String}], Returns: PoolStringArray
+	        Returns all of the types in [code]type[/code] as a [PoolStringArray] for use in any of the get_* functions, if Theme has [code]type[/code].
+		Args: [{ false type String}], Returns: PoolStringArray
 */
 func (o *Theme) GetTypeList(aType gdnative.String) gdnative.PoolStringArray {
 	//log.Println("Calling Theme.GetTypeList()")
@@ -543,7 +579,7 @@ func (o *Theme) GetTypeList(aType gdnative.String) gdnative.PoolStringArray {
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "get_type_list")
+	methodBind := methodBindThemeGetTypeList.Get()
 
 	// Call the parent method.
 	// PoolStringArray
@@ -556,8 +592,8 @@ func (o *Theme) GetTypeList(aType gdnative.String) gdnative.PoolStringArray {
 }
 
 /*
-        Returns [code]true[/code] if [Color] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: bool
+	        Returns [code]true[/code] if [Color] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: bool
 */
 func (o *Theme) HasColor(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Theme.HasColor()")
@@ -568,7 +604,7 @@ func (o *Theme) HasColor(name gdnative.String, aType gdnative.String) gdnative.B
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "has_color")
+	methodBind := methodBindThemeHasColor.Get()
 
 	// Call the parent method.
 	// bool
@@ -581,8 +617,8 @@ func (o *Theme) HasColor(name gdnative.String, aType gdnative.String) gdnative.B
 }
 
 /*
-        Returns [code]true[/code] if constant with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: bool
+	        Returns [code]true[/code] if constant with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: bool
 */
 func (o *Theme) HasConstant(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Theme.HasConstant()")
@@ -593,7 +629,7 @@ func (o *Theme) HasConstant(name gdnative.String, aType gdnative.String) gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "has_constant")
+	methodBind := methodBindThemeHasConstant.Get()
 
 	// Call the parent method.
 	// bool
@@ -606,8 +642,8 @@ func (o *Theme) HasConstant(name gdnative.String, aType gdnative.String) gdnativ
 }
 
 /*
-        Returns [code]true[/code] if [Font] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: bool
+	        Returns [code]true[/code] if [Font] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: bool
 */
 func (o *Theme) HasFont(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Theme.HasFont()")
@@ -618,7 +654,7 @@ func (o *Theme) HasFont(name gdnative.String, aType gdnative.String) gdnative.Bo
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "has_font")
+	methodBind := methodBindThemeHasFont.Get()
 
 	// Call the parent method.
 	// bool
@@ -631,8 +667,8 @@ func (o *Theme) HasFont(name gdnative.String, aType gdnative.String) gdnative.Bo
 }
 
 /*
-        Returns [code]true[/code] if icon [Texture] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: bool
+	        Returns [code]true[/code] if icon [Texture] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: bool
 */
 func (o *Theme) HasIcon(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Theme.HasIcon()")
@@ -643,7 +679,7 @@ func (o *Theme) HasIcon(name gdnative.String, aType gdnative.String) gdnative.Bo
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "has_icon")
+	methodBind := methodBindThemeHasIcon.Get()
 
 	// Call the parent method.
 	// bool
@@ -656,8 +692,8 @@ func (o *Theme) HasIcon(name gdnative.String, aType gdnative.String) gdnative.Bo
 }
 
 /*
-        Returns [code]true[/code] if [StyleBox] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String}], Returns: bool
+	        Returns [code]true[/code] if [StyleBox] with [code]name[/code] is in [code]type[/code]. Returns [code]false[/code] if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String}], Returns: bool
 */
 func (o *Theme) HasStylebox(name gdnative.String, aType gdnative.String) gdnative.Bool {
 	//log.Println("Calling Theme.HasStylebox()")
@@ -668,7 +704,7 @@ func (o *Theme) HasStylebox(name gdnative.String, aType gdnative.String) gdnativ
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "has_stylebox")
+	methodBind := methodBindThemeHasStylebox.Get()
 
 	// Call the parent method.
 	// bool
@@ -681,8 +717,8 @@ func (o *Theme) HasStylebox(name gdnative.String, aType gdnative.String) gdnativ
 }
 
 /*
-        Sets Theme's [Color] to [code]color[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String} { false color Color}], Returns: void
+	        Sets Theme's [Color] to [code]color[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String} { false color Color}], Returns: void
 */
 func (o *Theme) SetColor(name gdnative.String, aType gdnative.String, color gdnative.Color) {
 	//log.Println("Calling Theme.SetColor()")
@@ -694,7 +730,7 @@ func (o *Theme) SetColor(name gdnative.String, aType gdnative.String, color gdna
 	ptrArguments[2] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "set_color")
+	methodBind := methodBindThemeSetColor.Get()
 
 	// Call the parent method.
 	// void
@@ -704,8 +740,8 @@ func (o *Theme) SetColor(name gdnative.String, aType gdnative.String, color gdna
 }
 
 /*
-        Sets Theme's constant to [code]constant[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String} { false constant int}], Returns: void
+	        Sets Theme's constant to [code]constant[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String} { false constant int}], Returns: void
 */
 func (o *Theme) SetConstant(name gdnative.String, aType gdnative.String, constant gdnative.Int) {
 	//log.Println("Calling Theme.SetConstant()")
@@ -717,7 +753,7 @@ func (o *Theme) SetConstant(name gdnative.String, aType gdnative.String, constan
 	ptrArguments[2] = gdnative.NewPointerFromInt(constant)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "set_constant")
+	methodBind := methodBindThemeSetConstant.Get()
 
 	// Call the parent method.
 	// void
@@ -727,18 +763,18 @@ func (o *Theme) SetConstant(name gdnative.String, aType gdnative.String, constan
 }
 
 /*
-        Undocumented
-	Args: [{ false font Font}], Returns: void
+	        Undocumented
+		Args: [{ false font Font}], Returns: void
 */
 func (o *Theme) SetDefaultFont(font FontImplementer) {
 	//log.Println("Calling Theme.SetDefaultFont()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(font.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(font))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "set_default_font")
+	methodBind := methodBindThemeSetDefaultFont.Get()
 
 	// Call the parent method.
 	// void
@@ -748,8 +784,8 @@ func (o *Theme) SetDefaultFont(font FontImplementer) {
 }
 
 /*
-        Sets Theme's [Font] to [code]font[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String} { false font Font}], Returns: void
+	        Sets Theme's [Font] to [code]font[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String} { false font Font}], Returns: void
 */
 func (o *Theme) SetFont(name gdnative.String, aType gdnative.String, font FontImplementer) {
 	//log.Println("Calling Theme.SetFont()")
@@ -758,10 +794,10 @@ func (o *Theme) SetFont(name gdnative.String, aType gdnative.String, font FontIm
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
-	ptrArguments[2] = gdnative.NewPointerFromObject(font.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(GetBaseObject(font))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "set_font")
+	methodBind := methodBindThemeSetFont.Get()
 
 	// Call the parent method.
 	// void
@@ -771,8 +807,8 @@ func (o *Theme) SetFont(name gdnative.String, aType gdnative.String, font FontIm
 }
 
 /*
-        Sets Theme's icon [Texture] to [code]texture[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String} { false texture Texture}], Returns: void
+	        Sets Theme's icon [Texture] to [code]texture[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String} { false texture Texture}], Returns: void
 */
 func (o *Theme) SetIcon(name gdnative.String, aType gdnative.String, texture TextureImplementer) {
 	//log.Println("Calling Theme.SetIcon()")
@@ -781,10 +817,10 @@ func (o *Theme) SetIcon(name gdnative.String, aType gdnative.String, texture Tex
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
-	ptrArguments[2] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "set_icon")
+	methodBind := methodBindThemeSetIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -794,8 +830,8 @@ func (o *Theme) SetIcon(name gdnative.String, aType gdnative.String, texture Tex
 }
 
 /*
-        Sets Theme's [StyleBox] to [code]stylebox[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
-	Args: [{ false name String} { false type String} { false texture StyleBox}], Returns: void
+	        Sets Theme's [StyleBox] to [code]stylebox[/code] at [code]name[/code] in [code]type[/code]. Does nothing if Theme does not have [code]type[/code].
+		Args: [{ false name String} { false type String} { false texture StyleBox}], Returns: void
 */
 func (o *Theme) SetStylebox(name gdnative.String, aType gdnative.String, texture StyleBoxImplementer) {
 	//log.Println("Calling Theme.SetStylebox()")
@@ -804,10 +840,10 @@ func (o *Theme) SetStylebox(name gdnative.String, aType gdnative.String, texture
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 	ptrArguments[1] = gdnative.NewPointerFromString(aType)
-	ptrArguments[2] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Theme", "set_stylebox")
+	methodBind := methodBindThemeSetStylebox.Get()
 
 	// Call the parent method.
 	// void
@@ -816,6 +852,11 @@ func (o *Theme) SetStylebox(name gdnative.String, aType gdnative.String, texture
 
 }
 
+// DefaultFont will return the value of the "default_font" property.
+func (o *Theme) DefaultFont() FontImplementer {
+	return o.GetDefaultFont()
+}
+
 // ThemeImplementer is an interface that implements the methods
 // of the Theme class.
 type ThemeImplementer interface {
@@ -851,4 +892,5 @@ type ThemeImplementer interface {
 	SetFont(name gdnative.String, aType gdnative.String, font FontImplementer)
 	SetIcon(name gdnative.String, aType gdnative.String, texture TextureImplementer)
 	SetStylebox(name gdnative.String, aType gdnative.String, texture StyleBoxImplementer)
+	DefaultFont() FontImplementer
 }
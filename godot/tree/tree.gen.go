@@ -0,0 +1,1667 @@
+package tree
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// TreeDropModeFlags is an enum for DropModeFlags values.
+type TreeDropModeFlags int
+
+const (
+	TreeDropModeDisabled  TreeDropModeFlags = 0
+	TreeDropModeInbetween TreeDropModeFlags = 2
+	TreeDropModeOnItem    TreeDropModeFlags = 1
+)
+
+// String will return the Godot name of the TreeDropModeFlags value.
+func (e TreeDropModeFlags) String() string {
+	switch e {
+	case TreeDropModeDisabled:
+		return "DROP_MODE_DISABLED"
+	case TreeDropModeInbetween:
+		return "DROP_MODE_INBETWEEN"
+	case TreeDropModeOnItem:
+		return "DROP_MODE_ON_ITEM"
+	}
+	return fmt.Sprintf("TreeDropModeFlags(%d)", int(e))
+}
+
+// ParseTreeDropModeFlags will return the TreeDropModeFlags value with the given Godot name.
+func ParseTreeDropModeFlags(name string) (TreeDropModeFlags, error) {
+	switch name {
+	case "DROP_MODE_DISABLED":
+		return TreeDropModeDisabled, nil
+	case "DROP_MODE_INBETWEEN":
+		return TreeDropModeInbetween, nil
+	case "DROP_MODE_ON_ITEM":
+		return TreeDropModeOnItem, nil
+	}
+	return 0, fmt.Errorf("invalid TreeDropModeFlags value %q", name)
+}
+
+// TreeSelectMode is an enum for SelectMode values.
+type TreeSelectMode int
+
+const (
+	TreeSelectMulti  TreeSelectMode = 2
+	TreeSelectRow    TreeSelectMode = 1
+	TreeSelectSingle TreeSelectMode = 0
+)
+
+// String will return the Godot name of the TreeSelectMode value.
+func (e TreeSelectMode) String() string {
+	switch e {
+	case TreeSelectMulti:
+		return "SELECT_MULTI"
+	case TreeSelectRow:
+		return "SELECT_ROW"
+	case TreeSelectSingle:
+		return "SELECT_SINGLE"
+	}
+	return fmt.Sprintf("TreeSelectMode(%d)", int(e))
+}
+
+// ParseTreeSelectMode will return the TreeSelectMode value with the given Godot name.
+func ParseTreeSelectMode(name string) (TreeSelectMode, error) {
+	switch name {
+	case "SELECT_MULTI":
+		return TreeSelectMulti, nil
+	case "SELECT_ROW":
+		return TreeSelectRow, nil
+	case "SELECT_SINGLE":
+		return TreeSelectSingle, nil
+	}
+	return 0, fmt.Errorf("invalid TreeSelectMode value %q", name)
+}
+
+/*
+This shows a tree of items that can be selected, expanded and collapsed. The tree can have multiple columns with custom controls like text editing, buttons and popups. It can be useful for structured displays and interactions. Trees are built via code, using [TreeItem] objects to create the structure. They have a single root but multiple roots can be simulated if a dummy hidden root is added. [codeblock] func _ready(): var tree = Tree.new() var root = tree.create_item() tree.set_hide_root(true) var child1 = tree.create_item(root) var child2 = tree.create_item(root) var subchild1 = tree.create_item(child1) subchild1.set_text(0, "Subchild1") [/codeblock]
+*/
+type Tree struct {
+	godot.Control
+	owner gdnative.Object
+}
+
+// classConstructorTree is the class constructor of the Tree class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTree = gdnative.NewLazyClassConstructor("Tree")
+
+// NewTree will create a new instance of the Tree class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewTree() *Tree {
+	obj := &Tree{}
+	obj.SetBaseObject(classConstructorTree.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *Tree) BaseClass() string {
+	return "Tree"
+}
+
+// Method binds of the Tree class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTreeX_GuiInput               = gdnative.NewLazyMethodBind("Tree", "_gui_input")
+	methodBindTreeX_PopupSelect            = gdnative.NewLazyMethodBind("Tree", "_popup_select")
+	methodBindTreeX_RangeClickTimeout      = gdnative.NewLazyMethodBind("Tree", "_range_click_timeout")
+	methodBindTreeX_ScrollMoved            = gdnative.NewLazyMethodBind("Tree", "_scroll_moved")
+	methodBindTreeX_TextEditorEnter        = gdnative.NewLazyMethodBind("Tree", "_text_editor_enter")
+	methodBindTreeX_TextEditorModalClose   = gdnative.NewLazyMethodBind("Tree", "_text_editor_modal_close")
+	methodBindTreeX_ValueEditorChanged     = gdnative.NewLazyMethodBind("Tree", "_value_editor_changed")
+	methodBindTreeAreColumnTitlesVisible   = gdnative.NewLazyMethodBind("Tree", "are_column_titles_visible")
+	methodBindTreeClear                    = gdnative.NewLazyMethodBind("Tree", "clear")
+	methodBindTreeCreateItem               = gdnative.NewLazyMethodBind("Tree", "create_item")
+	methodBindTreeEnsureCursorIsVisible    = gdnative.NewLazyMethodBind("Tree", "ensure_cursor_is_visible")
+	methodBindTreeGetAllowReselect         = gdnative.NewLazyMethodBind("Tree", "get_allow_reselect")
+	methodBindTreeGetAllowRmbSelect        = gdnative.NewLazyMethodBind("Tree", "get_allow_rmb_select")
+	methodBindTreeGetColumnAtPosition      = gdnative.NewLazyMethodBind("Tree", "get_column_at_position")
+	methodBindTreeGetColumnTitle           = gdnative.NewLazyMethodBind("Tree", "get_column_title")
+	methodBindTreeGetColumnWidth           = gdnative.NewLazyMethodBind("Tree", "get_column_width")
+	methodBindTreeGetColumns               = gdnative.NewLazyMethodBind("Tree", "get_columns")
+	methodBindTreeGetCustomPopupRect       = gdnative.NewLazyMethodBind("Tree", "get_custom_popup_rect")
+	methodBindTreeGetDropModeFlags         = gdnative.NewLazyMethodBind("Tree", "get_drop_mode_flags")
+	methodBindTreeGetDropSectionAtPosition = gdnative.NewLazyMethodBind("Tree", "get_drop_section_at_position")
+	methodBindTreeGetEdited                = gdnative.NewLazyMethodBind("Tree", "get_edited")
+	methodBindTreeGetEditedColumn          = gdnative.NewLazyMethodBind("Tree", "get_edited_column")
+	methodBindTreeGetItemAreaRect          = gdnative.NewLazyMethodBind("Tree", "get_item_area_rect")
+	methodBindTreeGetItemAtPosition        = gdnative.NewLazyMethodBind("Tree", "get_item_at_position")
+	methodBindTreeGetNextSelected          = gdnative.NewLazyMethodBind("Tree", "get_next_selected")
+	methodBindTreeGetPressedButton         = gdnative.NewLazyMethodBind("Tree", "get_pressed_button")
+	methodBindTreeGetRoot                  = gdnative.NewLazyMethodBind("Tree", "get_root")
+	methodBindTreeGetScroll                = gdnative.NewLazyMethodBind("Tree", "get_scroll")
+	methodBindTreeGetSelectMode            = gdnative.NewLazyMethodBind("Tree", "get_select_mode")
+	methodBindTreeGetSelected              = gdnative.NewLazyMethodBind("Tree", "get_selected")
+	methodBindTreeGetSelectedColumn        = gdnative.NewLazyMethodBind("Tree", "get_selected_column")
+	methodBindTreeIsFoldingHidden          = gdnative.NewLazyMethodBind("Tree", "is_folding_hidden")
+	methodBindTreeIsRootHidden             = gdnative.NewLazyMethodBind("Tree", "is_root_hidden")
+	methodBindTreeSetAllowReselect         = gdnative.NewLazyMethodBind("Tree", "set_allow_reselect")
+	methodBindTreeSetAllowRmbSelect        = gdnative.NewLazyMethodBind("Tree", "set_allow_rmb_select")
+	methodBindTreeSetColumnExpand          = gdnative.NewLazyMethodBind("Tree", "set_column_expand")
+	methodBindTreeSetColumnMinWidth        = gdnative.NewLazyMethodBind("Tree", "set_column_min_width")
+	methodBindTreeSetColumnTitle           = gdnative.NewLazyMethodBind("Tree", "set_column_title")
+	methodBindTreeSetColumnTitlesVisible   = gdnative.NewLazyMethodBind("Tree", "set_column_titles_visible")
+	methodBindTreeSetColumns               = gdnative.NewLazyMethodBind("Tree", "set_columns")
+	methodBindTreeSetDropModeFlags         = gdnative.NewLazyMethodBind("Tree", "set_drop_mode_flags")
+	methodBindTreeSetHideFolding           = gdnative.NewLazyMethodBind("Tree", "set_hide_folding")
+	methodBindTreeSetHideRoot              = gdnative.NewLazyMethodBind("Tree", "set_hide_root")
+	methodBindTreeSetSelectMode            = gdnative.NewLazyMethodBind("Tree", "set_select_mode")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
+*/
+func (o *Tree) X_GuiInput(arg0 godot.InputEventImplementer) {
+	//log.Println("Calling Tree.X_GuiInput()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindTreeX_GuiInput.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
+*/
+func (o *Tree) X_PopupSelect(arg0 gdnative.Int) {
+	//log.Println("Calling Tree.X_PopupSelect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
+
+	// Get the method bind
+	methodBind := methodBindTreeX_PopupSelect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Tree) X_RangeClickTimeout() {
+	//log.Println("Calling Tree.X_RangeClickTimeout()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeX_RangeClickTimeout.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
+*/
+func (o *Tree) X_ScrollMoved(arg0 gdnative.Real) {
+	//log.Println("Calling Tree.X_ScrollMoved()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
+
+	// Get the method bind
+	methodBind := methodBindTreeX_ScrollMoved.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 String}], Returns: void
+*/
+func (o *Tree) X_TextEditorEnter(arg0 gdnative.String) {
+	//log.Println("Calling Tree.X_TextEditorEnter()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(arg0)
+
+	// Get the method bind
+	methodBind := methodBindTreeX_TextEditorEnter.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: void
+*/
+func (o *Tree) X_TextEditorModalClose() {
+	//log.Println("Calling Tree.X_TextEditorModalClose()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeX_TextEditorModalClose.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false arg0 float}], Returns: void
+*/
+func (o *Tree) X_ValueEditorChanged(arg0 gdnative.Real) {
+	//log.Println("Calling Tree.X_ValueEditorChanged()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(arg0)
+
+	// Get the method bind
+	methodBind := methodBindTreeX_ValueEditorChanged.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Returns [code]true[/code] if the column titles are being shown.
+		Args: [], Returns: bool
+*/
+func (o *Tree) AreColumnTitlesVisible() gdnative.Bool {
+	//log.Println("Calling Tree.AreColumnTitlesVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeAreColumnTitlesVisible.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Clears the tree. This removes all items.
+		Args: [], Returns: void
+*/
+func (o *Tree) Clear() {
+	//log.Println("Calling Tree.Clear()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeClear.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Create an item in the tree and add it as the last child of [code]parent[/code]. If parent is not given, it will be added as the root's last child, or it'll the be the root itself if the tree is empty.
+		Args: [{Null true parent Object} {-1 true idx int}], Returns: Object
+*/
+func (o *Tree) CreateItem(parent godot.ObjectImplementer, idx gdnative.Int) godot.ObjectImplementer {
+	//log.Println("Calling Tree.CreateItem()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(parent))
+	ptrArguments[1] = gdnative.NewPointerFromInt(idx)
+
+	// Get the method bind
+	methodBind := methodBindTreeCreateItem.Get()
+
+	// Call the parent method.
+	// Object
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Object{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ObjectImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Makes the currently selected item visible. This will scroll the tree to make sure the selected item is visible.
+		Args: [], Returns: void
+*/
+func (o *Tree) EnsureCursorIsVisible() {
+	//log.Println("Calling Tree.EnsureCursorIsVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeEnsureCursorIsVisible.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Tree) GetAllowReselect() gdnative.Bool {
+	//log.Println("Calling Tree.GetAllowReselect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetAllowReselect.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Tree) GetAllowRmbSelect() gdnative.Bool {
+	//log.Println("Calling Tree.GetAllowRmbSelect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetAllowRmbSelect.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the column index under the given point.
+		Args: [{ false position Vector2}], Returns: int
+*/
+func (o *Tree) GetColumnAtPosition(position gdnative.Vector2) gdnative.Int {
+	//log.Println("Calling Tree.GetColumnAtPosition()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetColumnAtPosition.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the column's title.
+		Args: [{ false column int}], Returns: String
+*/
+func (o *Tree) GetColumnTitle(column gdnative.Int) gdnative.String {
+	//log.Println("Calling Tree.GetColumnTitle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(column)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetColumnTitle.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the column's width in pixels.
+		Args: [{ false column int}], Returns: int
+*/
+func (o *Tree) GetColumnWidth(column gdnative.Int) gdnative.Int {
+	//log.Println("Calling Tree.GetColumnWidth()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(column)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetColumnWidth.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Tree) GetColumns() gdnative.Int {
+	//log.Println("Calling Tree.GetColumns()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetColumns.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the rectangle for custom popups. Helper to create custom cell controls that display a popup. See [method TreeItem.set_cell_mode].
+		Args: [], Returns: Rect2
+*/
+func (o *Tree) GetCustomPopupRect() gdnative.Rect2 {
+	//log.Println("Calling Tree.GetCustomPopupRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetCustomPopupRect.Get()
+
+	// Call the parent method.
+	// Rect2
+	retPtr := gdnative.NewEmptyRect2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRect2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: int
+*/
+func (o *Tree) GetDropModeFlags() gdnative.Int {
+	//log.Println("Calling Tree.GetDropModeFlags()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetDropModeFlags.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+Args: [{ false position Vector2}], Returns: int
+*/
+func (o *Tree) GetDropSectionAtPosition(position gdnative.Vector2) gdnative.Int {
+	//log.Println("Calling Tree.GetDropSectionAtPosition()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetDropSectionAtPosition.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the currently edited item. This is only available for custom cell mode.
+		Args: [], Returns: TreeItem
+*/
+func (o *Tree) GetEdited() TreeItemImplementer {
+	//log.Println("Calling Tree.GetEdited()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetEdited.Get()
+
+	// Call the parent method.
+	// TreeItem
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the column for the currently edited item. This is only available for custom cell mode.
+		Args: [], Returns: int
+*/
+func (o *Tree) GetEditedColumn() gdnative.Int {
+	//log.Println("Calling Tree.GetEditedColumn()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetEditedColumn.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the rectangle area for the specified item. If column is specified, only get the position and size of that column, otherwise get the rectangle containing all columns.
+		Args: [{ false item Object} {-1 true column int}], Returns: Rect2
+*/
+func (o *Tree) GetItemAreaRect(item godot.ObjectImplementer, column gdnative.Int) gdnative.Rect2 {
+	//log.Println("Calling Tree.GetItemAreaRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(item))
+	ptrArguments[1] = gdnative.NewPointerFromInt(column)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetItemAreaRect.Get()
+
+	// Call the parent method.
+	// Rect2
+	retPtr := gdnative.NewEmptyRect2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRect2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the tree item at the specified position (relative to the tree origin position).
+		Args: [{ false position Vector2}], Returns: TreeItem
+*/
+func (o *Tree) GetItemAtPosition(position gdnative.Vector2) TreeItemImplementer {
+	//log.Println("Calling Tree.GetItemAtPosition()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVector2(position)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetItemAtPosition.Get()
+
+	// Call the parent method.
+	// TreeItem
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the next selected item after the given one.
+		Args: [{ false from Object}], Returns: TreeItem
+*/
+func (o *Tree) GetNextSelected(from godot.ObjectImplementer) TreeItemImplementer {
+	//log.Println("Calling Tree.GetNextSelected()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(from))
+
+	// Get the method bind
+	methodBind := methodBindTreeGetNextSelected.Get()
+
+	// Call the parent method.
+	// TreeItem
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the last pressed button's index.
+		Args: [], Returns: int
+*/
+func (o *Tree) GetPressedButton() gdnative.Int {
+	//log.Println("Calling Tree.GetPressedButton()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetPressedButton.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Returns the tree's root item.
+		Args: [], Returns: TreeItem
+*/
+func (o *Tree) GetRoot() TreeItemImplementer {
+	//log.Println("Calling Tree.GetRoot()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetRoot.Get()
+
+	// Call the parent method.
+	// TreeItem
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the current scrolling position.
+		Args: [], Returns: Vector2
+*/
+func (o *Tree) GetScroll() gdnative.Vector2 {
+	//log.Println("Calling Tree.GetScroll()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetScroll.Get()
+
+	// Call the parent method.
+	// Vector2
+	retPtr := gdnative.NewEmptyVector2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVector2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Tree::SelectMode
+*/
+func (o *Tree) GetSelectMode() TreeSelectMode {
+	//log.Println("Calling Tree.GetSelectMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetSelectMode.Get()
+
+	// Call the parent method.
+	// enum.Tree::SelectMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return TreeSelectMode(ret)
+}
+
+/*
+	        Returns the currently selected item.
+		Args: [], Returns: TreeItem
+*/
+func (o *Tree) GetSelected() TreeItemImplementer {
+	//log.Println("Calling Tree.GetSelected()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetSelected.Get()
+
+	// Call the parent method.
+	// TreeItem
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Returns the current selection's column.
+		Args: [], Returns: int
+*/
+func (o *Tree) GetSelectedColumn() gdnative.Int {
+	//log.Println("Calling Tree.GetSelectedColumn()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeGetSelectedColumn.Get()
+
+	// Call the parent method.
+	// int
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Tree) IsFoldingHidden() gdnative.Bool {
+	//log.Println("Calling Tree.IsFoldingHidden()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeIsFoldingHidden.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *Tree) IsRootHidden() gdnative.Bool {
+	//log.Println("Calling Tree.IsRootHidden()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindTreeIsRootHidden.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false allow bool}], Returns: void
+*/
+func (o *Tree) SetAllowReselect(allow gdnative.Bool) {
+	//log.Println("Calling Tree.SetAllowReselect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(allow)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetAllowReselect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false allow bool}], Returns: void
+*/
+func (o *Tree) SetAllowRmbSelect(allow gdnative.Bool) {
+	//log.Println("Calling Tree.SetAllowRmbSelect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(allow)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetAllowRmbSelect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        If [code]true[/code] the column will have the "Expand" flag of [Control].
+		Args: [{ false column int} { false expand bool}], Returns: void
+*/
+func (o *Tree) SetColumnExpand(column gdnative.Int, expand gdnative.Bool) {
+	//log.Println("Calling Tree.SetColumnExpand()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(column)
+	ptrArguments[1] = gdnative.NewPointerFromBool(expand)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetColumnExpand.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set the minimum width of a column.
+		Args: [{ false column int} { false min_width int}], Returns: void
+*/
+func (o *Tree) SetColumnMinWidth(column gdnative.Int, minWidth gdnative.Int) {
+	//log.Println("Calling Tree.SetColumnMinWidth()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(column)
+	ptrArguments[1] = gdnative.NewPointerFromInt(minWidth)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetColumnMinWidth.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Set the title of a column.
+		Args: [{ false column int} { false title String}], Returns: void
+*/
+func (o *Tree) SetColumnTitle(column gdnative.Int, title gdnative.String) {
+	//log.Println("Calling Tree.SetColumnTitle()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(column)
+	ptrArguments[1] = gdnative.NewPointerFromString(title)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetColumnTitle.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        If [code]true[/code] column titles are visible.
+		Args: [{ false visible bool}], Returns: void
+*/
+func (o *Tree) SetColumnTitlesVisible(visible gdnative.Bool) {
+	//log.Println("Calling Tree.SetColumnTitlesVisible()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(visible)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetColumnTitlesVisible.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
+*/
+func (o *Tree) SetColumns(amount gdnative.Int) {
+	//log.Println("Calling Tree.SetColumns()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetColumns.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false flags int}], Returns: void
+*/
+func (o *Tree) SetDropModeFlags(flags gdnative.Int) {
+	//log.Println("Calling Tree.SetDropModeFlags()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(flags)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetDropModeFlags.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false hide bool}], Returns: void
+*/
+func (o *Tree) SetHideFolding(hide gdnative.Bool) {
+	//log.Println("Calling Tree.SetHideFolding()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(hide)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetHideFolding.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
+*/
+func (o *Tree) SetHideRoot(enable gdnative.Bool) {
+	//log.Println("Calling Tree.SetHideRoot()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
+
+	// Get the method bind
+	methodBind := methodBindTreeSetHideRoot.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.Tree::SelectMode}], Returns: void
+*/
+func (o *Tree) SetSelectMode(mode TreeSelectMode) {
+	//log.Println("Calling Tree.SetSelectMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindTreeSetSelectMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// CreateItemWithDefaults will call CreateItem using the default values for: parent, idx.
+func (o *Tree) CreateItemWithDefaults() godot.ObjectImplementer {
+	return o.CreateItem(nil, -1)
+}
+
+// GetItemAreaRectWithDefaults will call GetItemAreaRect using the default values for: column.
+func (o *Tree) GetItemAreaRectWithDefaults(item godot.ObjectImplementer) gdnative.Rect2 {
+	return o.GetItemAreaRect(item, -1)
+}
+
+// AllowReselect will return the value of the "allow_reselect" property.
+func (o *Tree) AllowReselect() gdnative.Bool {
+	return o.GetAllowReselect()
+}
+
+// AllowRmbSelect will return the value of the "allow_rmb_select" property.
+func (o *Tree) AllowRmbSelect() gdnative.Bool {
+	return o.GetAllowRmbSelect()
+}
+
+// Columns will return the value of the "columns" property.
+func (o *Tree) Columns() gdnative.Int {
+	return o.GetColumns()
+}
+
+// DropModeFlags will return the value of the "drop_mode_flags" property.
+func (o *Tree) DropModeFlags() gdnative.Int {
+	return o.GetDropModeFlags()
+}
+
+// HideFolding will return the value of the "hide_folding" property.
+func (o *Tree) HideFolding() gdnative.Bool {
+	return o.IsFoldingHidden()
+}
+
+// HideRoot will return the value of the "hide_root" property.
+func (o *Tree) HideRoot() gdnative.Bool {
+	return o.IsRootHidden()
+}
+
+// SelectMode will return the value of the "select_mode" property.
+func (o *Tree) SelectMode() TreeSelectMode {
+	return o.GetSelectMode()
+}
+
+// Signals of the Tree class.
+const (
+	TreeSignalButtonPressed           gdnative.String = "button_pressed"
+	TreeSignalCellSelected            gdnative.String = "cell_selected"
+	TreeSignalColumnTitlePressed      gdnative.String = "column_title_pressed"
+	TreeSignalCustomPopupEdited       gdnative.String = "custom_popup_edited"
+	TreeSignalEmptyTreeRmbSelected    gdnative.String = "empty_tree_rmb_selected"
+	TreeSignalItemActivated           gdnative.String = "item_activated"
+	TreeSignalItemCollapsed           gdnative.String = "item_collapsed"
+	TreeSignalItemCustomButtonPressed gdnative.String = "item_custom_button_pressed"
+	TreeSignalItemDoubleClicked       gdnative.String = "item_double_clicked"
+	TreeSignalItemEdited              gdnative.String = "item_edited"
+	TreeSignalItemRmbEdited           gdnative.String = "item_rmb_edited"
+	TreeSignalItemRmbSelected         gdnative.String = "item_rmb_selected"
+	TreeSignalItemSelected            gdnative.String = "item_selected"
+	TreeSignalMultiSelected           gdnative.String = "multi_selected"
+	TreeSignalNothingSelected         gdnative.String = "nothing_selected"
+)
+
+// TreeButtonPressedArgs holds the arguments of the "button_pressed" signal.
+type TreeButtonPressedArgs struct {
+	Item   godot.ObjectImplementer
+	Column gdnative.Int
+	Id     gdnative.Int
+}
+
+// ConnectButtonPressed will connect the "button_pressed" signal to the given method of the target object.
+func (o *Tree) ConnectButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalButtonPressed, target, method, binds, 0)
+}
+
+// EmitButtonPressed will emit the "button_pressed" signal.
+func (o *Tree) EmitButtonPressed(args TreeButtonPressedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Item))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.Column))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg2.Destroy()
+
+	ret, err := o.EmitSignal(TreeSignalButtonPressed, arg0, arg1, arg2)
+	ret.Destroy()
+	return err
+}
+
+// ConnectCellSelected will connect the "cell_selected" signal to the given method of the target object.
+func (o *Tree) ConnectCellSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalCellSelected, target, method, binds, 0)
+}
+
+// EmitCellSelected will emit the "cell_selected" signal.
+func (o *Tree) EmitCellSelected() error {
+
+	ret, err := o.EmitSignal(TreeSignalCellSelected)
+	ret.Destroy()
+	return err
+}
+
+// TreeColumnTitlePressedArgs holds the arguments of the "column_title_pressed" signal.
+type TreeColumnTitlePressedArgs struct {
+	Column gdnative.Int
+}
+
+// ConnectColumnTitlePressed will connect the "column_title_pressed" signal to the given method of the target object.
+func (o *Tree) ConnectColumnTitlePressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalColumnTitlePressed, target, method, binds, 0)
+}
+
+// EmitColumnTitlePressed will emit the "column_title_pressed" signal.
+func (o *Tree) EmitColumnTitlePressed(args TreeColumnTitlePressedArgs) error {
+	arg0 := gdnative.NewVariantInt(gdnative.Int64T(args.Column))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TreeSignalColumnTitlePressed, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TreeCustomPopupEditedArgs holds the arguments of the "custom_popup_edited" signal.
+type TreeCustomPopupEditedArgs struct {
+	ArrowClicked gdnative.Bool
+}
+
+// ConnectCustomPopupEdited will connect the "custom_popup_edited" signal to the given method of the target object.
+func (o *Tree) ConnectCustomPopupEdited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalCustomPopupEdited, target, method, binds, 0)
+}
+
+// EmitCustomPopupEdited will emit the "custom_popup_edited" signal.
+func (o *Tree) EmitCustomPopupEdited(args TreeCustomPopupEditedArgs) error {
+	arg0 := gdnative.NewVariantBool(args.ArrowClicked)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TreeSignalCustomPopupEdited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// TreeEmptyTreeRmbSelectedArgs holds the arguments of the "empty_tree_rmb_selected" signal.
+type TreeEmptyTreeRmbSelectedArgs struct {
+	Position gdnative.Vector2
+}
+
+// ConnectEmptyTreeRmbSelected will connect the "empty_tree_rmb_selected" signal to the given method of the target object.
+func (o *Tree) ConnectEmptyTreeRmbSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalEmptyTreeRmbSelected, target, method, binds, 0)
+}
+
+// EmitEmptyTreeRmbSelected will emit the "empty_tree_rmb_selected" signal.
+func (o *Tree) EmitEmptyTreeRmbSelected(args TreeEmptyTreeRmbSelectedArgs) error {
+	arg0 := gdnative.NewVariantVector2(args.Position)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TreeSignalEmptyTreeRmbSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectItemActivated will connect the "item_activated" signal to the given method of the target object.
+func (o *Tree) ConnectItemActivated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemActivated, target, method, binds, 0)
+}
+
+// EmitItemActivated will emit the "item_activated" signal.
+func (o *Tree) EmitItemActivated() error {
+
+	ret, err := o.EmitSignal(TreeSignalItemActivated)
+	ret.Destroy()
+	return err
+}
+
+// TreeItemCollapsedArgs holds the arguments of the "item_collapsed" signal.
+type TreeItemCollapsedArgs struct {
+	Item godot.ObjectImplementer
+}
+
+// ConnectItemCollapsed will connect the "item_collapsed" signal to the given method of the target object.
+func (o *Tree) ConnectItemCollapsed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemCollapsed, target, method, binds, 0)
+}
+
+// EmitItemCollapsed will emit the "item_collapsed" signal.
+func (o *Tree) EmitItemCollapsed(args TreeItemCollapsedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Item))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TreeSignalItemCollapsed, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectItemCustomButtonPressed will connect the "item_custom_button_pressed" signal to the given method of the target object.
+func (o *Tree) ConnectItemCustomButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemCustomButtonPressed, target, method, binds, 0)
+}
+
+// EmitItemCustomButtonPressed will emit the "item_custom_button_pressed" signal.
+func (o *Tree) EmitItemCustomButtonPressed() error {
+
+	ret, err := o.EmitSignal(TreeSignalItemCustomButtonPressed)
+	ret.Destroy()
+	return err
+}
+
+// ConnectItemDoubleClicked will connect the "item_double_clicked" signal to the given method of the target object.
+func (o *Tree) ConnectItemDoubleClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemDoubleClicked, target, method, binds, 0)
+}
+
+// EmitItemDoubleClicked will emit the "item_double_clicked" signal.
+func (o *Tree) EmitItemDoubleClicked() error {
+
+	ret, err := o.EmitSignal(TreeSignalItemDoubleClicked)
+	ret.Destroy()
+	return err
+}
+
+// ConnectItemEdited will connect the "item_edited" signal to the given method of the target object.
+func (o *Tree) ConnectItemEdited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemEdited, target, method, binds, 0)
+}
+
+// EmitItemEdited will emit the "item_edited" signal.
+func (o *Tree) EmitItemEdited() error {
+
+	ret, err := o.EmitSignal(TreeSignalItemEdited)
+	ret.Destroy()
+	return err
+}
+
+// ConnectItemRmbEdited will connect the "item_rmb_edited" signal to the given method of the target object.
+func (o *Tree) ConnectItemRmbEdited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemRmbEdited, target, method, binds, 0)
+}
+
+// EmitItemRmbEdited will emit the "item_rmb_edited" signal.
+func (o *Tree) EmitItemRmbEdited() error {
+
+	ret, err := o.EmitSignal(TreeSignalItemRmbEdited)
+	ret.Destroy()
+	return err
+}
+
+// TreeItemRmbSelectedArgs holds the arguments of the "item_rmb_selected" signal.
+type TreeItemRmbSelectedArgs struct {
+	Position gdnative.Vector2
+}
+
+// ConnectItemRmbSelected will connect the "item_rmb_selected" signal to the given method of the target object.
+func (o *Tree) ConnectItemRmbSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemRmbSelected, target, method, binds, 0)
+}
+
+// EmitItemRmbSelected will emit the "item_rmb_selected" signal.
+func (o *Tree) EmitItemRmbSelected(args TreeItemRmbSelectedArgs) error {
+	arg0 := gdnative.NewVariantVector2(args.Position)
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(TreeSignalItemRmbSelected, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectItemSelected will connect the "item_selected" signal to the given method of the target object.
+func (o *Tree) ConnectItemSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalItemSelected, target, method, binds, 0)
+}
+
+// EmitItemSelected will emit the "item_selected" signal.
+func (o *Tree) EmitItemSelected() error {
+
+	ret, err := o.EmitSignal(TreeSignalItemSelected)
+	ret.Destroy()
+	return err
+}
+
+// TreeMultiSelectedArgs holds the arguments of the "multi_selected" signal.
+type TreeMultiSelectedArgs struct {
+	Item     godot.ObjectImplementer
+	Column   gdnative.Int
+	Selected gdnative.Bool
+}
+
+// ConnectMultiSelected will connect the "multi_selected" signal to the given method of the target object.
+func (o *Tree) ConnectMultiSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalMultiSelected, target, method, binds, 0)
+}
+
+// EmitMultiSelected will emit the "multi_selected" signal.
+func (o *Tree) EmitMultiSelected(args TreeMultiSelectedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Item))
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.Column))
+	defer arg1.Destroy()
+	arg2 := gdnative.NewVariantBool(args.Selected)
+	defer arg2.Destroy()
+
+	ret, err := o.EmitSignal(TreeSignalMultiSelected, arg0, arg1, arg2)
+	ret.Destroy()
+	return err
+}
+
+// ConnectNothingSelected will connect the "nothing_selected" signal to the given method of the target object.
+func (o *Tree) ConnectNothingSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(TreeSignalNothingSelected, target, method, binds, 0)
+}
+
+// EmitNothingSelected will emit the "nothing_selected" signal.
+func (o *Tree) EmitNothingSelected() error {
+
+	ret, err := o.EmitSignal(TreeSignalNothingSelected)
+	ret.Destroy()
+	return err
+}
+
+// TreeImplementer is an interface that implements the methods
+// of the Tree class.
+type TreeImplementer interface {
+	godot.ControlImplementer
+	X_PopupSelect(arg0 gdnative.Int)
+	X_RangeClickTimeout()
+	X_ScrollMoved(arg0 gdnative.Real)
+	X_TextEditorEnter(arg0 gdnative.String)
+	X_TextEditorModalClose()
+	X_ValueEditorChanged(arg0 gdnative.Real)
+	AreColumnTitlesVisible() gdnative.Bool
+	Clear()
+	CreateItem(parent godot.ObjectImplementer, idx gdnative.Int) godot.ObjectImplementer
+	EnsureCursorIsVisible()
+	GetAllowReselect() gdnative.Bool
+	GetAllowRmbSelect() gdnative.Bool
+	GetColumnAtPosition(position gdnative.Vector2) gdnative.Int
+	GetColumnTitle(column gdnative.Int) gdnative.String
+	GetColumnWidth(column gdnative.Int) gdnative.Int
+	GetColumns() gdnative.Int
+	GetCustomPopupRect() gdnative.Rect2
+	GetDropModeFlags() gdnative.Int
+	GetDropSectionAtPosition(position gdnative.Vector2) gdnative.Int
+	GetEdited() TreeItemImplementer
+	GetEditedColumn() gdnative.Int
+	GetItemAreaRect(item godot.ObjectImplementer, column gdnative.Int) gdnative.Rect2
+	GetItemAtPosition(position gdnative.Vector2) TreeItemImplementer
+	GetNextSelected(from godot.ObjectImplementer) TreeItemImplementer
+	GetPressedButton() gdnative.Int
+	GetRoot() TreeItemImplementer
+	GetScroll() gdnative.Vector2
+	GetSelectMode() TreeSelectMode
+	GetSelected() TreeItemImplementer
+	GetSelectedColumn() gdnative.Int
+	IsFoldingHidden() gdnative.Bool
+	IsRootHidden() gdnative.Bool
+	SetAllowReselect(allow gdnative.Bool)
+	SetAllowRmbSelect(allow gdnative.Bool)
+	SetColumnExpand(column gdnative.Int, expand gdnative.Bool)
+	SetColumnMinWidth(column gdnative.Int, minWidth gdnative.Int)
+	SetColumnTitle(column gdnative.Int, title gdnative.String)
+	SetColumnTitlesVisible(visible gdnative.Bool)
+	SetColumns(amount gdnative.Int)
+	SetDropModeFlags(flags gdnative.Int)
+	SetHideFolding(hide gdnative.Bool)
+	SetHideRoot(enable gdnative.Bool)
+	SetSelectMode(mode TreeSelectMode)
+	CreateItemWithDefaults() godot.ObjectImplementer
+	GetItemAreaRectWithDefaults(item godot.ObjectImplementer) gdnative.Rect2
+	AllowReselect() gdnative.Bool
+	AllowRmbSelect() gdnative.Bool
+	Columns() gdnative.Int
+	DropModeFlags() gdnative.Int
+	HideFolding() gdnative.Bool
+	HideRoot() gdnative.Bool
+	SelectMode() TreeSelectMode
+	ConnectButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitButtonPressed(args TreeButtonPressedArgs) error
+	ConnectCellSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCellSelected() error
+	ConnectColumnTitlePressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitColumnTitlePressed(args TreeColumnTitlePressedArgs) error
+	ConnectCustomPopupEdited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCustomPopupEdited(args TreeCustomPopupEditedArgs) error
+	ConnectEmptyTreeRmbSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitEmptyTreeRmbSelected(args TreeEmptyTreeRmbSelectedArgs) error
+	ConnectItemActivated(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemActivated() error
+	ConnectItemCollapsed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemCollapsed(args TreeItemCollapsedArgs) error
+	ConnectItemCustomButtonPressed(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemCustomButtonPressed() error
+	ConnectItemDoubleClicked(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemDoubleClicked() error
+	ConnectItemEdited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemEdited() error
+	ConnectItemRmbEdited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemRmbEdited() error
+	ConnectItemRmbSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemRmbSelected(args TreeItemRmbSelectedArgs) error
+	ConnectItemSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitItemSelected() error
+	ConnectMultiSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitMultiSelected(args TreeMultiSelectedArgs) error
+	ConnectNothingSelected(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNothingSelected() error
+}
@@ -1,7 +1,10 @@
-package godot
+package tree
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,6 +25,32 @@ const (
 	TreeItemAlignRight  TreeItemTextAlign = 2
 )
 
+// String will return the Godot name of the TreeItemTextAlign value.
+func (e TreeItemTextAlign) String() string {
+	switch e {
+	case TreeItemAlignCenter:
+		return "ALIGN_CENTER"
+	case TreeItemAlignLeft:
+		return "ALIGN_LEFT"
+	case TreeItemAlignRight:
+		return "ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("TreeItemTextAlign(%d)", int(e))
+}
+
+// ParseTreeItemTextAlign will return the TreeItemTextAlign value with the given Godot name.
+func ParseTreeItemTextAlign(name string) (TreeItemTextAlign, error) {
+	switch name {
+	case "ALIGN_CENTER":
+		return TreeItemAlignCenter, nil
+	case "ALIGN_LEFT":
+		return TreeItemAlignLeft, nil
+	case "ALIGN_RIGHT":
+		return TreeItemAlignRight, nil
+	}
+	return 0, fmt.Errorf("invalid TreeItemTextAlign value %q", name)
+}
+
 // TreeItemTreeCellMode is an enum for TreeCellMode values.
 type TreeItemTreeCellMode int
 
@@ -34,20 +63,49 @@ const (
 	TreeItemCellModeString          TreeItemTreeCellMode = 0
 )
 
-//func NewTreeItemFromPointer(ptr gdnative.Pointer) TreeItem {
-func newTreeItemFromPointer(ptr gdnative.Pointer) TreeItem {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := TreeItem{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the TreeItemTreeCellMode value.
+func (e TreeItemTreeCellMode) String() string {
+	switch e {
+	case TreeItemCellModeCheck:
+		return "CELL_MODE_CHECK"
+	case TreeItemCellModeCustom:
+		return "CELL_MODE_CUSTOM"
+	case TreeItemCellModeIcon:
+		return "CELL_MODE_ICON"
+	case TreeItemCellModeRange:
+		return "CELL_MODE_RANGE"
+	case TreeItemCellModeRangeExpression:
+		return "CELL_MODE_RANGE_EXPRESSION"
+	case TreeItemCellModeString:
+		return "CELL_MODE_STRING"
+	}
+	return fmt.Sprintf("TreeItemTreeCellMode(%d)", int(e))
+}
+
+// ParseTreeItemTreeCellMode will return the TreeItemTreeCellMode value with the given Godot name.
+func ParseTreeItemTreeCellMode(name string) (TreeItemTreeCellMode, error) {
+	switch name {
+	case "CELL_MODE_CHECK":
+		return TreeItemCellModeCheck, nil
+	case "CELL_MODE_CUSTOM":
+		return TreeItemCellModeCustom, nil
+	case "CELL_MODE_ICON":
+		return TreeItemCellModeIcon, nil
+	case "CELL_MODE_RANGE":
+		return TreeItemCellModeRange, nil
+	case "CELL_MODE_RANGE_EXPRESSION":
+		return TreeItemCellModeRangeExpression, nil
+	case "CELL_MODE_STRING":
+		return TreeItemCellModeString, nil
+	}
+	return 0, fmt.Errorf("invalid TreeItemTreeCellMode value %q", name)
 }
 
 /*
 Control for a single item inside a [Tree]. May have child [code]TreeItem[/code]s and be styled as well as contain buttons.
 */
 type TreeItem struct {
-	Object
+	godot.Object
 	owner gdnative.Object
 }
 
@@ -55,23 +113,88 @@ func (o *TreeItem) BaseClass() string {
 	return "TreeItem"
 }
 
+// Method binds of the TreeItem class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindTreeItemAddButton              = gdnative.NewLazyMethodBind("TreeItem", "add_button")
+	methodBindTreeItemClearCustomBgColor     = gdnative.NewLazyMethodBind("TreeItem", "clear_custom_bg_color")
+	methodBindTreeItemClearCustomColor       = gdnative.NewLazyMethodBind("TreeItem", "clear_custom_color")
+	methodBindTreeItemDeselect               = gdnative.NewLazyMethodBind("TreeItem", "deselect")
+	methodBindTreeItemEraseButton            = gdnative.NewLazyMethodBind("TreeItem", "erase_button")
+	methodBindTreeItemGetButton              = gdnative.NewLazyMethodBind("TreeItem", "get_button")
+	methodBindTreeItemGetButtonCount         = gdnative.NewLazyMethodBind("TreeItem", "get_button_count")
+	methodBindTreeItemGetCellMode            = gdnative.NewLazyMethodBind("TreeItem", "get_cell_mode")
+	methodBindTreeItemGetChildren            = gdnative.NewLazyMethodBind("TreeItem", "get_children")
+	methodBindTreeItemGetCustomBgColor       = gdnative.NewLazyMethodBind("TreeItem", "get_custom_bg_color")
+	methodBindTreeItemGetCustomMinimumHeight = gdnative.NewLazyMethodBind("TreeItem", "get_custom_minimum_height")
+	methodBindTreeItemGetExpandRight         = gdnative.NewLazyMethodBind("TreeItem", "get_expand_right")
+	methodBindTreeItemGetIcon                = gdnative.NewLazyMethodBind("TreeItem", "get_icon")
+	methodBindTreeItemGetIconMaxWidth        = gdnative.NewLazyMethodBind("TreeItem", "get_icon_max_width")
+	methodBindTreeItemGetIconRegion          = gdnative.NewLazyMethodBind("TreeItem", "get_icon_region")
+	methodBindTreeItemGetMetadata            = gdnative.NewLazyMethodBind("TreeItem", "get_metadata")
+	methodBindTreeItemGetNext                = gdnative.NewLazyMethodBind("TreeItem", "get_next")
+	methodBindTreeItemGetNextVisible         = gdnative.NewLazyMethodBind("TreeItem", "get_next_visible")
+	methodBindTreeItemGetParent              = gdnative.NewLazyMethodBind("TreeItem", "get_parent")
+	methodBindTreeItemGetPrev                = gdnative.NewLazyMethodBind("TreeItem", "get_prev")
+	methodBindTreeItemGetPrevVisible         = gdnative.NewLazyMethodBind("TreeItem", "get_prev_visible")
+	methodBindTreeItemGetRange               = gdnative.NewLazyMethodBind("TreeItem", "get_range")
+	methodBindTreeItemGetRangeConfig         = gdnative.NewLazyMethodBind("TreeItem", "get_range_config")
+	methodBindTreeItemGetText                = gdnative.NewLazyMethodBind("TreeItem", "get_text")
+	methodBindTreeItemGetTextAlign           = gdnative.NewLazyMethodBind("TreeItem", "get_text_align")
+	methodBindTreeItemGetTooltip             = gdnative.NewLazyMethodBind("TreeItem", "get_tooltip")
+	methodBindTreeItemIsButtonDisabled       = gdnative.NewLazyMethodBind("TreeItem", "is_button_disabled")
+	methodBindTreeItemIsChecked              = gdnative.NewLazyMethodBind("TreeItem", "is_checked")
+	methodBindTreeItemIsCollapsed            = gdnative.NewLazyMethodBind("TreeItem", "is_collapsed")
+	methodBindTreeItemIsCustomSetAsButton    = gdnative.NewLazyMethodBind("TreeItem", "is_custom_set_as_button")
+	methodBindTreeItemIsEditable             = gdnative.NewLazyMethodBind("TreeItem", "is_editable")
+	methodBindTreeItemIsFoldingDisabled      = gdnative.NewLazyMethodBind("TreeItem", "is_folding_disabled")
+	methodBindTreeItemIsSelectable           = gdnative.NewLazyMethodBind("TreeItem", "is_selectable")
+	methodBindTreeItemIsSelected             = gdnative.NewLazyMethodBind("TreeItem", "is_selected")
+	methodBindTreeItemMoveToBottom           = gdnative.NewLazyMethodBind("TreeItem", "move_to_bottom")
+	methodBindTreeItemMoveToTop              = gdnative.NewLazyMethodBind("TreeItem", "move_to_top")
+	methodBindTreeItemRemoveChild            = gdnative.NewLazyMethodBind("TreeItem", "remove_child")
+	methodBindTreeItemSelect                 = gdnative.NewLazyMethodBind("TreeItem", "select")
+	methodBindTreeItemSetButton              = gdnative.NewLazyMethodBind("TreeItem", "set_button")
+	methodBindTreeItemSetCellMode            = gdnative.NewLazyMethodBind("TreeItem", "set_cell_mode")
+	methodBindTreeItemSetChecked             = gdnative.NewLazyMethodBind("TreeItem", "set_checked")
+	methodBindTreeItemSetCollapsed           = gdnative.NewLazyMethodBind("TreeItem", "set_collapsed")
+	methodBindTreeItemSetCustomAsButton      = gdnative.NewLazyMethodBind("TreeItem", "set_custom_as_button")
+	methodBindTreeItemSetCustomBgColor       = gdnative.NewLazyMethodBind("TreeItem", "set_custom_bg_color")
+	methodBindTreeItemSetCustomColor         = gdnative.NewLazyMethodBind("TreeItem", "set_custom_color")
+	methodBindTreeItemSetCustomDraw          = gdnative.NewLazyMethodBind("TreeItem", "set_custom_draw")
+	methodBindTreeItemSetCustomMinimumHeight = gdnative.NewLazyMethodBind("TreeItem", "set_custom_minimum_height")
+	methodBindTreeItemSetDisableFolding      = gdnative.NewLazyMethodBind("TreeItem", "set_disable_folding")
+	methodBindTreeItemSetEditable            = gdnative.NewLazyMethodBind("TreeItem", "set_editable")
+	methodBindTreeItemSetExpandRight         = gdnative.NewLazyMethodBind("TreeItem", "set_expand_right")
+	methodBindTreeItemSetIcon                = gdnative.NewLazyMethodBind("TreeItem", "set_icon")
+	methodBindTreeItemSetIconMaxWidth        = gdnative.NewLazyMethodBind("TreeItem", "set_icon_max_width")
+	methodBindTreeItemSetIconRegion          = gdnative.NewLazyMethodBind("TreeItem", "set_icon_region")
+	methodBindTreeItemSetMetadata            = gdnative.NewLazyMethodBind("TreeItem", "set_metadata")
+	methodBindTreeItemSetRange               = gdnative.NewLazyMethodBind("TreeItem", "set_range")
+	methodBindTreeItemSetRangeConfig         = gdnative.NewLazyMethodBind("TreeItem", "set_range_config")
+	methodBindTreeItemSetSelectable          = gdnative.NewLazyMethodBind("TreeItem", "set_selectable")
+	methodBindTreeItemSetText                = gdnative.NewLazyMethodBind("TreeItem", "set_text")
+	methodBindTreeItemSetTextAlign           = gdnative.NewLazyMethodBind("TreeItem", "set_text_align")
+	methodBindTreeItemSetTooltip             = gdnative.NewLazyMethodBind("TreeItem", "set_tooltip")
+)
+
 /*
-        Adds a button with [Texture] [code]button[/code] at column [code]column[/code]. The [code]button_idx[/code] index is used to identify the button when calling other methods. If not specified, the next available index is used, which may be retrieved by calling [code]get_buton_count()[/code] immediately after this method. Optionally, the button can be [code]disabled[/code] and have a [code]tooltip[/code].
-	Args: [{ false column int} { false button Texture} {-1 true button_idx int} {False true disabled bool} { true tooltip String}], Returns: void
+	        Adds a button with [Texture] [code]button[/code] at column [code]column[/code]. The [code]button_idx[/code] index is used to identify the button when calling other methods. If not specified, the next available index is used, which may be retrieved by calling [code]get_buton_count()[/code] immediately after this method. Optionally, the button can be [code]disabled[/code] and have a [code]tooltip[/code].
+		Args: [{ false column int} { false button Texture} {-1 true button_idx int} {False true disabled bool} { true tooltip String}], Returns: void
 */
-func (o *TreeItem) AddButton(column gdnative.Int, button TextureImplementer, buttonIdx gdnative.Int, disabled gdnative.Bool, tooltip gdnative.String) {
+func (o *TreeItem) AddButton(column gdnative.Int, button godot.TextureImplementer, buttonIdx gdnative.Int, disabled gdnative.Bool, tooltip gdnative.String) {
 	//log.Println("Calling TreeItem.AddButton()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 5, 5)
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
-	ptrArguments[1] = gdnative.NewPointerFromObject(button.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(button))
 	ptrArguments[2] = gdnative.NewPointerFromInt(buttonIdx)
 	ptrArguments[3] = gdnative.NewPointerFromBool(disabled)
 	ptrArguments[4] = gdnative.NewPointerFromString(tooltip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "add_button")
+	methodBind := methodBindTreeItemAddButton.Get()
 
 	// Call the parent method.
 	// void
@@ -81,8 +204,8 @@ func (o *TreeItem) AddButton(column gdnative.Int, button TextureImplementer, but
 }
 
 /*
-        Resets the background color for the given column to default.
-	Args: [{ false column int}], Returns: void
+	        Resets the background color for the given column to default.
+		Args: [{ false column int}], Returns: void
 */
 func (o *TreeItem) ClearCustomBgColor(column gdnative.Int) {
 	//log.Println("Calling TreeItem.ClearCustomBgColor()")
@@ -92,7 +215,7 @@ func (o *TreeItem) ClearCustomBgColor(column gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "clear_custom_bg_color")
+	methodBind := methodBindTreeItemClearCustomBgColor.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +225,8 @@ func (o *TreeItem) ClearCustomBgColor(column gdnative.Int) {
 }
 
 /*
-        Resets the color for the given column to default.
-	Args: [{ false column int}], Returns: void
+	        Resets the color for the given column to default.
+		Args: [{ false column int}], Returns: void
 */
 func (o *TreeItem) ClearCustomColor(column gdnative.Int) {
 	//log.Println("Calling TreeItem.ClearCustomColor()")
@@ -113,7 +236,7 @@ func (o *TreeItem) ClearCustomColor(column gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "clear_custom_color")
+	methodBind := methodBindTreeItemClearCustomColor.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +246,8 @@ func (o *TreeItem) ClearCustomColor(column gdnative.Int) {
 }
 
 /*
-        Deselects the given column.
-	Args: [{ false column int}], Returns: void
+	        Deselects the given column.
+		Args: [{ false column int}], Returns: void
 */
 func (o *TreeItem) Deselect(column gdnative.Int) {
 	//log.Println("Calling TreeItem.Deselect()")
@@ -134,7 +257,7 @@ func (o *TreeItem) Deselect(column gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "deselect")
+	methodBind := methodBindTreeItemDeselect.Get()
 
 	// Call the parent method.
 	// void
@@ -144,8 +267,8 @@ func (o *TreeItem) Deselect(column gdnative.Int) {
 }
 
 /*
-        Removes the button at index [code]button_idx[/code] in column [code]column[/code].
-	Args: [{ false column int} { false button_idx int}], Returns: void
+	        Removes the button at index [code]button_idx[/code] in column [code]column[/code].
+		Args: [{ false column int} { false button_idx int}], Returns: void
 */
 func (o *TreeItem) EraseButton(column gdnative.Int, buttonIdx gdnative.Int) {
 	//log.Println("Calling TreeItem.EraseButton()")
@@ -156,7 +279,7 @@ func (o *TreeItem) EraseButton(column gdnative.Int, buttonIdx gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(buttonIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "erase_button")
+	methodBind := methodBindTreeItemEraseButton.Get()
 
 	// Call the parent method.
 	// void
@@ -166,10 +289,10 @@ func (o *TreeItem) EraseButton(column gdnative.Int, buttonIdx gdnative.Int) {
 }
 
 /*
-        Returns the [Texture] of the button at index [code]button_idx[/code] in column [code]column[/code].
-	Args: [{ false column int} { false button_idx int}], Returns: Texture
+	        Returns the [Texture] of the button at index [code]button_idx[/code] in column [code]column[/code].
+		Args: [{ false column int} { false button_idx int}], Returns: Texture
 */
-func (o *TreeItem) GetButton(column gdnative.Int, buttonIdx gdnative.Int) TextureImplementer {
+func (o *TreeItem) GetButton(column gdnative.Int, buttonIdx gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling TreeItem.GetButton()")
 
 	// Build out the method's arguments
@@ -178,7 +301,7 @@ func (o *TreeItem) GetButton(column gdnative.Int, buttonIdx gdnative.Int) Textur
 	ptrArguments[1] = gdnative.NewPointerFromInt(buttonIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_button")
+	methodBind := methodBindTreeItemGetButton.Get()
 
 	// Call the parent method.
 	// Texture
@@ -186,27 +309,25 @@ func (o *TreeItem) GetButton(column gdnative.Int, buttonIdx gdnative.Int) Textur
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the number of buttons in column [code]column[/code]. May be used to get the most recently added button's index, if no index was specified.
-	Args: [{ false column int}], Returns: int
+	        Returns the number of buttons in column [code]column[/code]. May be used to get the most recently added button's index, if no index was specified.
+		Args: [{ false column int}], Returns: int
 */
 func (o *TreeItem) GetButtonCount(column gdnative.Int) gdnative.Int {
 	//log.Println("Calling TreeItem.GetButtonCount()")
@@ -216,7 +337,7 @@ func (o *TreeItem) GetButtonCount(column gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_button_count")
+	methodBind := methodBindTreeItemGetButtonCount.Get()
 
 	// Call the parent method.
 	// int
@@ -229,8 +350,8 @@ func (o *TreeItem) GetButtonCount(column gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the column's cell mode. See [code]CELL_MODE_*[/code] constants.
-	Args: [{ false column int}], Returns: enum.TreeItem::TreeCellMode
+	        Returns the column's cell mode. See [code]CELL_MODE_*[/code] constants.
+		Args: [{ false column int}], Returns: enum.TreeItem::TreeCellMode
 */
 func (o *TreeItem) GetCellMode(column gdnative.Int) TreeItemTreeCellMode {
 	//log.Println("Calling TreeItem.GetCellMode()")
@@ -240,7 +361,7 @@ func (o *TreeItem) GetCellMode(column gdnative.Int) TreeItemTreeCellMode {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_cell_mode")
+	methodBind := methodBindTreeItemGetCellMode.Get()
 
 	// Call the parent method.
 	// enum.TreeItem::TreeCellMode
@@ -253,8 +374,8 @@ func (o *TreeItem) GetCellMode(column gdnative.Int) TreeItemTreeCellMode {
 }
 
 /*
-        Returns the TreeItem's child items.
-	Args: [], Returns: TreeItem
+	        Returns the TreeItem's child items.
+		Args: [], Returns: TreeItem
 */
 func (o *TreeItem) GetChildren() TreeItemImplementer {
 	//log.Println("Calling TreeItem.GetChildren()")
@@ -263,7 +384,7 @@ func (o *TreeItem) GetChildren() TreeItemImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_children")
+	methodBind := methodBindTreeItemGetChildren.Get()
 
 	// Call the parent method.
 	// TreeItem
@@ -271,27 +392,25 @@ func (o *TreeItem) GetChildren() TreeItemImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTreeItemFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TreeItemImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TreeItem" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TreeItemImplementer)
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the custom background color of column [code]column[/code].
-	Args: [{ false column int}], Returns: Color
+	        Returns the custom background color of column [code]column[/code].
+		Args: [{ false column int}], Returns: Color
 */
 func (o *TreeItem) GetCustomBgColor(column gdnative.Int) gdnative.Color {
 	//log.Println("Calling TreeItem.GetCustomBgColor()")
@@ -301,7 +420,7 @@ func (o *TreeItem) GetCustomBgColor(column gdnative.Int) gdnative.Color {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_custom_bg_color")
+	methodBind := methodBindTreeItemGetCustomBgColor.Get()
 
 	// Call the parent method.
 	// Color
@@ -314,8 +433,8 @@ func (o *TreeItem) GetCustomBgColor(column gdnative.Int) gdnative.Color {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *TreeItem) GetCustomMinimumHeight() gdnative.Int {
 	//log.Println("Calling TreeItem.GetCustomMinimumHeight()")
@@ -324,7 +443,7 @@ func (o *TreeItem) GetCustomMinimumHeight() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_custom_minimum_height")
+	methodBind := methodBindTreeItemGetCustomMinimumHeight.Get()
 
 	// Call the parent method.
 	// int
@@ -337,8 +456,8 @@ func (o *TreeItem) GetCustomMinimumHeight() gdnative.Int {
 }
 
 /*
-        Returns [code]true[/code] if [code]expand_right[/code] is set.
-	Args: [{ false column int}], Returns: bool
+	        Returns [code]true[/code] if [code]expand_right[/code] is set.
+		Args: [{ false column int}], Returns: bool
 */
 func (o *TreeItem) GetExpandRight(column gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TreeItem.GetExpandRight()")
@@ -348,7 +467,7 @@ func (o *TreeItem) GetExpandRight(column gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_expand_right")
+	methodBind := methodBindTreeItemGetExpandRight.Get()
 
 	// Call the parent method.
 	// bool
@@ -361,10 +480,10 @@ func (o *TreeItem) GetExpandRight(column gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns the given column's icon [Texture]. Error if no icon is set.
-	Args: [{ false column int}], Returns: Texture
+	        Returns the given column's icon [Texture]. Error if no icon is set.
+		Args: [{ false column int}], Returns: Texture
 */
-func (o *TreeItem) GetIcon(column gdnative.Int) TextureImplementer {
+func (o *TreeItem) GetIcon(column gdnative.Int) godot.TextureImplementer {
 	//log.Println("Calling TreeItem.GetIcon()")
 
 	// Build out the method's arguments
@@ -372,7 +491,7 @@ func (o *TreeItem) GetIcon(column gdnative.Int) TextureImplementer {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_icon")
+	methodBind := methodBindTreeItemGetIcon.Get()
 
 	// Call the parent method.
 	// Texture
@@ -380,27 +499,25 @@ func (o *TreeItem) GetIcon(column gdnative.Int) TextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Texture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TextureImplementer)
+	ret := godot.Texture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.TextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the column's icon's maximum width.
-	Args: [{ false column int}], Returns: int
+	        Returns the column's icon's maximum width.
+		Args: [{ false column int}], Returns: int
 */
 func (o *TreeItem) GetIconMaxWidth(column gdnative.Int) gdnative.Int {
 	//log.Println("Calling TreeItem.GetIconMaxWidth()")
@@ -410,7 +527,7 @@ func (o *TreeItem) GetIconMaxWidth(column gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_icon_max_width")
+	methodBind := methodBindTreeItemGetIconMaxWidth.Get()
 
 	// Call the parent method.
 	// int
@@ -423,8 +540,8 @@ func (o *TreeItem) GetIconMaxWidth(column gdnative.Int) gdnative.Int {
 }
 
 /*
-        Returns the icon [Texture] region as [Rect2].
-	Args: [{ false column int}], Returns: Rect2
+	        Returns the icon [Texture] region as [Rect2].
+		Args: [{ false column int}], Returns: Rect2
 */
 func (o *TreeItem) GetIconRegion(column gdnative.Int) gdnative.Rect2 {
 	//log.Println("Calling TreeItem.GetIconRegion()")
@@ -434,7 +551,7 @@ func (o *TreeItem) GetIconRegion(column gdnative.Int) gdnative.Rect2 {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_icon_region")
+	methodBind := methodBindTreeItemGetIconRegion.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -447,8 +564,7 @@ func (o *TreeItem) GetIconRegion(column gdnative.Int) gdnative.Rect2 {
 }
 
 /*
-
-	Args: [{ false column int}], Returns: Variant
+Args: [{ false column int}], Returns: Variant
 */
 func (o *TreeItem) GetMetadata(column gdnative.Int) gdnative.Variant {
 	//log.Println("Calling TreeItem.GetMetadata()")
@@ -458,7 +574,7 @@ func (o *TreeItem) GetMetadata(column gdnative.Int) gdnative.Variant {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_metadata")
+	methodBind := methodBindTreeItemGetMetadata.Get()
 
 	// Call the parent method.
 	// Variant
@@ -471,8 +587,8 @@ func (o *TreeItem) GetMetadata(column gdnative.Int) gdnative.Variant {
 }
 
 /*
-        Returns the next TreeItem in the tree.
-	Args: [], Returns: TreeItem
+	        Returns the next TreeItem in the tree.
+		Args: [], Returns: TreeItem
 */
 func (o *TreeItem) GetNext() TreeItemImplementer {
 	//log.Println("Calling TreeItem.GetNext()")
@@ -481,7 +597,7 @@ func (o *TreeItem) GetNext() TreeItemImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_next")
+	methodBind := methodBindTreeItemGetNext.Get()
 
 	// Call the parent method.
 	// TreeItem
@@ -489,27 +605,25 @@ func (o *TreeItem) GetNext() TreeItemImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTreeItemFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TreeItemImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TreeItem" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TreeItemImplementer)
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the next visible TreeItem in the tree.
-	Args: [], Returns: TreeItem
+	        Returns the next visible TreeItem in the tree.
+		Args: [], Returns: TreeItem
 */
 func (o *TreeItem) GetNextVisible() TreeItemImplementer {
 	//log.Println("Calling TreeItem.GetNextVisible()")
@@ -518,7 +632,7 @@ func (o *TreeItem) GetNextVisible() TreeItemImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_next_visible")
+	methodBind := methodBindTreeItemGetNextVisible.Get()
 
 	// Call the parent method.
 	// TreeItem
@@ -526,27 +640,25 @@ func (o *TreeItem) GetNextVisible() TreeItemImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTreeItemFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TreeItemImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TreeItem" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TreeItemImplementer)
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the parent TreeItem.
-	Args: [], Returns: TreeItem
+	        Returns the parent TreeItem.
+		Args: [], Returns: TreeItem
 */
 func (o *TreeItem) GetParent() TreeItemImplementer {
 	//log.Println("Calling TreeItem.GetParent()")
@@ -555,7 +667,7 @@ func (o *TreeItem) GetParent() TreeItemImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_parent")
+	methodBind := methodBindTreeItemGetParent.Get()
 
 	// Call the parent method.
 	// TreeItem
@@ -563,27 +675,25 @@ func (o *TreeItem) GetParent() TreeItemImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTreeItemFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TreeItemImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TreeItem" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TreeItemImplementer)
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the previous TreeItem in the tree.
-	Args: [], Returns: TreeItem
+	        Returns the previous TreeItem in the tree.
+		Args: [], Returns: TreeItem
 */
 func (o *TreeItem) GetPrev() TreeItemImplementer {
 	//log.Println("Calling TreeItem.GetPrev()")
@@ -592,7 +702,7 @@ func (o *TreeItem) GetPrev() TreeItemImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_prev")
+	methodBind := methodBindTreeItemGetPrev.Get()
 
 	// Call the parent method.
 	// TreeItem
@@ -600,27 +710,25 @@ func (o *TreeItem) GetPrev() TreeItemImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTreeItemFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TreeItemImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TreeItem" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TreeItemImplementer)
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the previous visible TreeItem in the tree.
-	Args: [], Returns: TreeItem
+	        Returns the previous visible TreeItem in the tree.
+		Args: [], Returns: TreeItem
 */
 func (o *TreeItem) GetPrevVisible() TreeItemImplementer {
 	//log.Println("Calling TreeItem.GetPrevVisible()")
@@ -629,7 +737,7 @@ func (o *TreeItem) GetPrevVisible() TreeItemImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_prev_visible")
+	methodBind := methodBindTreeItemGetPrevVisible.Get()
 
 	// Call the parent method.
 	// TreeItem
@@ -637,27 +745,24 @@ func (o *TreeItem) GetPrevVisible() TreeItemImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newTreeItemFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(TreeItemImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "TreeItem" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(TreeItemImplementer)
+	ret := TreeItem{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(TreeItemImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-
-	Args: [{ false column int}], Returns: float
+Args: [{ false column int}], Returns: float
 */
 func (o *TreeItem) GetRange(column gdnative.Int) gdnative.Real {
 	//log.Println("Calling TreeItem.GetRange()")
@@ -667,7 +772,7 @@ func (o *TreeItem) GetRange(column gdnative.Int) gdnative.Real {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_range")
+	methodBind := methodBindTreeItemGetRange.Get()
 
 	// Call the parent method.
 	// float
@@ -680,8 +785,7 @@ func (o *TreeItem) GetRange(column gdnative.Int) gdnative.Real {
 }
 
 /*
-
-	Args: [{ false column int}], Returns: Dictionary
+Args: [{ false column int}], Returns: Dictionary
 */
 func (o *TreeItem) GetRangeConfig(column gdnative.Int) gdnative.Dictionary {
 	//log.Println("Calling TreeItem.GetRangeConfig()")
@@ -691,7 +795,7 @@ func (o *TreeItem) GetRangeConfig(column gdnative.Int) gdnative.Dictionary {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_range_config")
+	methodBind := methodBindTreeItemGetRangeConfig.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -704,8 +808,8 @@ func (o *TreeItem) GetRangeConfig(column gdnative.Int) gdnative.Dictionary {
 }
 
 /*
-        Returns the given column's text.
-	Args: [{ false column int}], Returns: String
+	        Returns the given column's text.
+		Args: [{ false column int}], Returns: String
 */
 func (o *TreeItem) GetText(column gdnative.Int) gdnative.String {
 	//log.Println("Calling TreeItem.GetText()")
@@ -715,7 +819,7 @@ func (o *TreeItem) GetText(column gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_text")
+	methodBind := methodBindTreeItemGetText.Get()
 
 	// Call the parent method.
 	// String
@@ -728,8 +832,8 @@ func (o *TreeItem) GetText(column gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns the given column's text alignment.
-	Args: [{ false column int}], Returns: enum.TreeItem::TextAlign
+	        Returns the given column's text alignment.
+		Args: [{ false column int}], Returns: enum.TreeItem::TextAlign
 */
 func (o *TreeItem) GetTextAlign(column gdnative.Int) TreeItemTextAlign {
 	//log.Println("Calling TreeItem.GetTextAlign()")
@@ -739,7 +843,7 @@ func (o *TreeItem) GetTextAlign(column gdnative.Int) TreeItemTextAlign {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_text_align")
+	methodBind := methodBindTreeItemGetTextAlign.Get()
 
 	// Call the parent method.
 	// enum.TreeItem::TextAlign
@@ -752,8 +856,8 @@ func (o *TreeItem) GetTextAlign(column gdnative.Int) TreeItemTextAlign {
 }
 
 /*
-        Returns the given column's tooltip.
-	Args: [{ false column int}], Returns: String
+	        Returns the given column's tooltip.
+		Args: [{ false column int}], Returns: String
 */
 func (o *TreeItem) GetTooltip(column gdnative.Int) gdnative.String {
 	//log.Println("Calling TreeItem.GetTooltip()")
@@ -763,7 +867,7 @@ func (o *TreeItem) GetTooltip(column gdnative.Int) gdnative.String {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "get_tooltip")
+	methodBind := methodBindTreeItemGetTooltip.Get()
 
 	// Call the parent method.
 	// String
@@ -776,8 +880,8 @@ func (o *TreeItem) GetTooltip(column gdnative.Int) gdnative.String {
 }
 
 /*
-        Returns [code]true[/code] if the button at index [code]button_idx[/code] for the given column is disabled.
-	Args: [{ false column int} { false button_idx int}], Returns: bool
+	        Returns [code]true[/code] if the button at index [code]button_idx[/code] for the given column is disabled.
+		Args: [{ false column int} { false button_idx int}], Returns: bool
 */
 func (o *TreeItem) IsButtonDisabled(column gdnative.Int, buttonIdx gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TreeItem.IsButtonDisabled()")
@@ -788,7 +892,7 @@ func (o *TreeItem) IsButtonDisabled(column gdnative.Int, buttonIdx gdnative.Int)
 	ptrArguments[1] = gdnative.NewPointerFromInt(buttonIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_button_disabled")
+	methodBind := methodBindTreeItemIsButtonDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -801,8 +905,8 @@ func (o *TreeItem) IsButtonDisabled(column gdnative.Int, buttonIdx gdnative.Int)
 }
 
 /*
-        Returns [code]true[/code] if the given column is checked.
-	Args: [{ false column int}], Returns: bool
+	        Returns [code]true[/code] if the given column is checked.
+		Args: [{ false column int}], Returns: bool
 */
 func (o *TreeItem) IsChecked(column gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TreeItem.IsChecked()")
@@ -812,7 +916,7 @@ func (o *TreeItem) IsChecked(column gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_checked")
+	methodBind := methodBindTreeItemIsChecked.Get()
 
 	// Call the parent method.
 	// bool
@@ -825,8 +929,8 @@ func (o *TreeItem) IsChecked(column gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TreeItem) IsCollapsed() gdnative.Bool {
 	//log.Println("Calling TreeItem.IsCollapsed()")
@@ -835,7 +939,7 @@ func (o *TreeItem) IsCollapsed() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_collapsed")
+	methodBind := methodBindTreeItemIsCollapsed.Get()
 
 	// Call the parent method.
 	// bool
@@ -848,8 +952,7 @@ func (o *TreeItem) IsCollapsed() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false column int}], Returns: bool
+Args: [{ false column int}], Returns: bool
 */
 func (o *TreeItem) IsCustomSetAsButton(column gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TreeItem.IsCustomSetAsButton()")
@@ -859,7 +962,7 @@ func (o *TreeItem) IsCustomSetAsButton(column gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_custom_set_as_button")
+	methodBind := methodBindTreeItemIsCustomSetAsButton.Get()
 
 	// Call the parent method.
 	// bool
@@ -872,8 +975,8 @@ func (o *TreeItem) IsCustomSetAsButton(column gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if column [code]column[/code] is editable.
-	Args: [{ false column int}], Returns: bool
+	        Returns [code]true[/code] if column [code]column[/code] is editable.
+		Args: [{ false column int}], Returns: bool
 */
 func (o *TreeItem) IsEditable(column gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TreeItem.IsEditable()")
@@ -883,7 +986,7 @@ func (o *TreeItem) IsEditable(column gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_editable")
+	methodBind := methodBindTreeItemIsEditable.Get()
 
 	// Call the parent method.
 	// bool
@@ -896,8 +999,8 @@ func (o *TreeItem) IsEditable(column gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *TreeItem) IsFoldingDisabled() gdnative.Bool {
 	//log.Println("Calling TreeItem.IsFoldingDisabled()")
@@ -906,7 +1009,7 @@ func (o *TreeItem) IsFoldingDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_folding_disabled")
+	methodBind := methodBindTreeItemIsFoldingDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -919,8 +1022,8 @@ func (o *TreeItem) IsFoldingDisabled() gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if column [code]column[/code] is selectable.
-	Args: [{ false column int}], Returns: bool
+	        Returns [code]true[/code] if column [code]column[/code] is selectable.
+		Args: [{ false column int}], Returns: bool
 */
 func (o *TreeItem) IsSelectable(column gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TreeItem.IsSelectable()")
@@ -930,7 +1033,7 @@ func (o *TreeItem) IsSelectable(column gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_selectable")
+	methodBind := methodBindTreeItemIsSelectable.Get()
 
 	// Call the parent method.
 	// bool
@@ -943,8 +1046,8 @@ func (o *TreeItem) IsSelectable(column gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Returns [code]true[/code] if column [code]column[/code] is selected.
-	Args: [{ false column int}], Returns: bool
+	        Returns [code]true[/code] if column [code]column[/code] is selected.
+		Args: [{ false column int}], Returns: bool
 */
 func (o *TreeItem) IsSelected(column gdnative.Int) gdnative.Bool {
 	//log.Println("Calling TreeItem.IsSelected()")
@@ -954,7 +1057,7 @@ func (o *TreeItem) IsSelected(column gdnative.Int) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "is_selected")
+	methodBind := methodBindTreeItemIsSelected.Get()
 
 	// Call the parent method.
 	// bool
@@ -967,8 +1070,8 @@ func (o *TreeItem) IsSelected(column gdnative.Int) gdnative.Bool {
 }
 
 /*
-        Moves this TreeItem to the bottom in the [Tree] hierarchy.
-	Args: [], Returns: void
+	        Moves this TreeItem to the bottom in the [Tree] hierarchy.
+		Args: [], Returns: void
 */
 func (o *TreeItem) MoveToBottom() {
 	//log.Println("Calling TreeItem.MoveToBottom()")
@@ -977,7 +1080,7 @@ func (o *TreeItem) MoveToBottom() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "move_to_bottom")
+	methodBind := methodBindTreeItemMoveToBottom.Get()
 
 	// Call the parent method.
 	// void
@@ -987,8 +1090,8 @@ func (o *TreeItem) MoveToBottom() {
 }
 
 /*
-        Moves this TreeItem to the top in the [Tree] hierarchy.
-	Args: [], Returns: void
+	        Moves this TreeItem to the top in the [Tree] hierarchy.
+		Args: [], Returns: void
 */
 func (o *TreeItem) MoveToTop() {
 	//log.Println("Calling TreeItem.MoveToTop()")
@@ -997,7 +1100,7 @@ func (o *TreeItem) MoveToTop() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "move_to_top")
+	methodBind := methodBindTreeItemMoveToTop.Get()
 
 	// Call the parent method.
 	// void
@@ -1007,18 +1110,18 @@ func (o *TreeItem) MoveToTop() {
 }
 
 /*
-        Removes the child TreeItem at index [code]index[/code].
-	Args: [{ false child Object}], Returns: void
+	        Removes the child TreeItem at index [code]index[/code].
+		Args: [{ false child Object}], Returns: void
 */
-func (o *TreeItem) RemoveChild(child ObjectImplementer) {
+func (o *TreeItem) RemoveChild(child godot.ObjectImplementer) {
 	//log.Println("Calling TreeItem.RemoveChild()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(child.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(child))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "remove_child")
+	methodBind := methodBindTreeItemRemoveChild.Get()
 
 	// Call the parent method.
 	// void
@@ -1028,8 +1131,8 @@ func (o *TreeItem) RemoveChild(child ObjectImplementer) {
 }
 
 /*
-        Selects the column [code]column[/code].
-	Args: [{ false column int}], Returns: void
+	        Selects the column [code]column[/code].
+		Args: [{ false column int}], Returns: void
 */
 func (o *TreeItem) Select(column gdnative.Int) {
 	//log.Println("Calling TreeItem.Select()")
@@ -1039,7 +1142,7 @@ func (o *TreeItem) Select(column gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "select")
+	methodBind := methodBindTreeItemSelect.Get()
 
 	// Call the parent method.
 	// void
@@ -1049,20 +1152,20 @@ func (o *TreeItem) Select(column gdnative.Int) {
 }
 
 /*
-        Sets the given column's button [Texture] at index [code]button_idx[/code] to [code]button[/code].
-	Args: [{ false column int} { false button_idx int} { false button Texture}], Returns: void
+	        Sets the given column's button [Texture] at index [code]button_idx[/code] to [code]button[/code].
+		Args: [{ false column int} { false button_idx int} { false button Texture}], Returns: void
 */
-func (o *TreeItem) SetButton(column gdnative.Int, buttonIdx gdnative.Int, button TextureImplementer) {
+func (o *TreeItem) SetButton(column gdnative.Int, buttonIdx gdnative.Int, button godot.TextureImplementer) {
 	//log.Println("Calling TreeItem.SetButton()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
 	ptrArguments[1] = gdnative.NewPointerFromInt(buttonIdx)
-	ptrArguments[2] = gdnative.NewPointerFromObject(button.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(button))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_button")
+	methodBind := methodBindTreeItemSetButton.Get()
 
 	// Call the parent method.
 	// void
@@ -1072,19 +1175,19 @@ func (o *TreeItem) SetButton(column gdnative.Int, buttonIdx gdnative.Int, button
 }
 
 /*
-        Sets the given column's cell mode to [code]mode[/code]. See [code]CELL_MODE_*[/code] constants.
-	Args: [{ false column int} { false mode int}], Returns: void
+	        Sets the given column's cell mode to [code]mode[/code]. See [code]CELL_MODE_*[/code] constants.
+		Args: [{ false column int} { false mode enum.TreeItem::TreeCellMode}], Returns: void
 */
-func (o *TreeItem) SetCellMode(column gdnative.Int, mode gdnative.Int) {
+func (o *TreeItem) SetCellMode(column gdnative.Int, mode TreeItemTreeCellMode) {
 	//log.Println("Calling TreeItem.SetCellMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
-	ptrArguments[1] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_cell_mode")
+	methodBind := methodBindTreeItemSetCellMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1094,8 +1197,8 @@ func (o *TreeItem) SetCellMode(column gdnative.Int, mode gdnative.Int) {
 }
 
 /*
-        If [code]true[/code] the column [code]column[/code] is checked.
-	Args: [{ false column int} { false checked bool}], Returns: void
+	        If [code]true[/code] the column [code]column[/code] is checked.
+		Args: [{ false column int} { false checked bool}], Returns: void
 */
 func (o *TreeItem) SetChecked(column gdnative.Int, checked gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetChecked()")
@@ -1106,7 +1209,7 @@ func (o *TreeItem) SetChecked(column gdnative.Int, checked gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(checked)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_checked")
+	methodBind := methodBindTreeItemSetChecked.Get()
 
 	// Call the parent method.
 	// void
@@ -1116,8 +1219,8 @@ func (o *TreeItem) SetChecked(column gdnative.Int, checked gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *TreeItem) SetCollapsed(enable gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetCollapsed()")
@@ -1127,7 +1230,7 @@ func (o *TreeItem) SetCollapsed(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_collapsed")
+	methodBind := methodBindTreeItemSetCollapsed.Get()
 
 	// Call the parent method.
 	// void
@@ -1137,8 +1240,7 @@ func (o *TreeItem) SetCollapsed(enable gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false column int} { false enable bool}], Returns: void
+Args: [{ false column int} { false enable bool}], Returns: void
 */
 func (o *TreeItem) SetCustomAsButton(column gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetCustomAsButton()")
@@ -1149,7 +1251,7 @@ func (o *TreeItem) SetCustomAsButton(column gdnative.Int, enable gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_custom_as_button")
+	methodBind := methodBindTreeItemSetCustomAsButton.Get()
 
 	// Call the parent method.
 	// void
@@ -1159,8 +1261,8 @@ func (o *TreeItem) SetCustomAsButton(column gdnative.Int, enable gdnative.Bool)
 }
 
 /*
-        Sets the given column's custom background color and whether to just use it as an outline.
-	Args: [{ false column int} { false color Color} {False true just_outline bool}], Returns: void
+	        Sets the given column's custom background color and whether to just use it as an outline.
+		Args: [{ false column int} { false color Color} {False true just_outline bool}], Returns: void
 */
 func (o *TreeItem) SetCustomBgColor(column gdnative.Int, color gdnative.Color, justOutline gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetCustomBgColor()")
@@ -1172,7 +1274,7 @@ func (o *TreeItem) SetCustomBgColor(column gdnative.Int, color gdnative.Color, j
 	ptrArguments[2] = gdnative.NewPointerFromBool(justOutline)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_custom_bg_color")
+	methodBind := methodBindTreeItemSetCustomBgColor.Get()
 
 	// Call the parent method.
 	// void
@@ -1182,8 +1284,8 @@ func (o *TreeItem) SetCustomBgColor(column gdnative.Int, color gdnative.Color, j
 }
 
 /*
-        Sets the given column's custom color.
-	Args: [{ false column int} { false color Color}], Returns: void
+	        Sets the given column's custom color.
+		Args: [{ false column int} { false color Color}], Returns: void
 */
 func (o *TreeItem) SetCustomColor(column gdnative.Int, color gdnative.Color) {
 	//log.Println("Calling TreeItem.SetCustomColor()")
@@ -1194,7 +1296,7 @@ func (o *TreeItem) SetCustomColor(column gdnative.Int, color gdnative.Color) {
 	ptrArguments[1] = gdnative.NewPointerFromColor(color)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_custom_color")
+	methodBind := methodBindTreeItemSetCustomColor.Get()
 
 	// Call the parent method.
 	// void
@@ -1204,20 +1306,20 @@ func (o *TreeItem) SetCustomColor(column gdnative.Int, color gdnative.Color) {
 }
 
 /*
-        Sets the given column's custom draw callback to [code]callback[/code] method on [code]object[/code].
-	Args: [{ false column int} { false object Object} { false callback String}], Returns: void
+	        Sets the given column's custom draw callback to [code]callback[/code] method on [code]object[/code].
+		Args: [{ false column int} { false object Object} { false callback String}], Returns: void
 */
-func (o *TreeItem) SetCustomDraw(column gdnative.Int, object ObjectImplementer, callback gdnative.String) {
+func (o *TreeItem) SetCustomDraw(column gdnative.Int, object godot.ObjectImplementer, callback gdnative.String) {
 	//log.Println("Calling TreeItem.SetCustomDraw()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 3, 3)
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
-	ptrArguments[1] = gdnative.NewPointerFromObject(object.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(object))
 	ptrArguments[2] = gdnative.NewPointerFromString(callback)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_custom_draw")
+	methodBind := methodBindTreeItemSetCustomDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -1227,8 +1329,8 @@ func (o *TreeItem) SetCustomDraw(column gdnative.Int, object ObjectImplementer,
 }
 
 /*
-        Undocumented
-	Args: [{ false height int}], Returns: void
+	        Undocumented
+		Args: [{ false height int}], Returns: void
 */
 func (o *TreeItem) SetCustomMinimumHeight(height gdnative.Int) {
 	//log.Println("Calling TreeItem.SetCustomMinimumHeight()")
@@ -1238,7 +1340,7 @@ func (o *TreeItem) SetCustomMinimumHeight(height gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(height)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_custom_minimum_height")
+	methodBind := methodBindTreeItemSetCustomMinimumHeight.Get()
 
 	// Call the parent method.
 	// void
@@ -1248,8 +1350,8 @@ func (o *TreeItem) SetCustomMinimumHeight(height gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false disable bool}], Returns: void
+	        Undocumented
+		Args: [{ false disable bool}], Returns: void
 */
 func (o *TreeItem) SetDisableFolding(disable gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetDisableFolding()")
@@ -1259,7 +1361,7 @@ func (o *TreeItem) SetDisableFolding(disable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_disable_folding")
+	methodBind := methodBindTreeItemSetDisableFolding.Get()
 
 	// Call the parent method.
 	// void
@@ -1269,8 +1371,8 @@ func (o *TreeItem) SetDisableFolding(disable gdnative.Bool) {
 }
 
 /*
-        If [code]true[/code] column [code]column[/code] is editable.
-	Args: [{ false column int} { false enabled bool}], Returns: void
+	        If [code]true[/code] column [code]column[/code] is editable.
+		Args: [{ false column int} { false enabled bool}], Returns: void
 */
 func (o *TreeItem) SetEditable(column gdnative.Int, enabled gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetEditable()")
@@ -1281,7 +1383,7 @@ func (o *TreeItem) SetEditable(column gdnative.Int, enabled gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_editable")
+	methodBind := methodBindTreeItemSetEditable.Get()
 
 	// Call the parent method.
 	// void
@@ -1291,8 +1393,8 @@ func (o *TreeItem) SetEditable(column gdnative.Int, enabled gdnative.Bool) {
 }
 
 /*
-        If [code]true[/code] column [code]column[/code] is expanded to the right.
-	Args: [{ false column int} { false enable bool}], Returns: void
+	        If [code]true[/code] column [code]column[/code] is expanded to the right.
+		Args: [{ false column int} { false enable bool}], Returns: void
 */
 func (o *TreeItem) SetExpandRight(column gdnative.Int, enable gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetExpandRight()")
@@ -1303,7 +1405,7 @@ func (o *TreeItem) SetExpandRight(column gdnative.Int, enable gdnative.Bool) {
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_expand_right")
+	methodBind := methodBindTreeItemSetExpandRight.Get()
 
 	// Call the parent method.
 	// void
@@ -1313,19 +1415,19 @@ func (o *TreeItem) SetExpandRight(column gdnative.Int, enable gdnative.Bool) {
 }
 
 /*
-        Sets the given column's icon [Texture].
-	Args: [{ false column int} { false texture Texture}], Returns: void
+	        Sets the given column's icon [Texture].
+		Args: [{ false column int} { false texture Texture}], Returns: void
 */
-func (o *TreeItem) SetIcon(column gdnative.Int, texture TextureImplementer) {
+func (o *TreeItem) SetIcon(column gdnative.Int, texture godot.TextureImplementer) {
 	//log.Println("Calling TreeItem.SetIcon()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
-	ptrArguments[1] = gdnative.NewPointerFromObject(texture.GetBaseObject())
+	ptrArguments[1] = gdnative.NewPointerFromObject(godot.GetBaseObject(texture))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_icon")
+	methodBind := methodBindTreeItemSetIcon.Get()
 
 	// Call the parent method.
 	// void
@@ -1335,8 +1437,8 @@ func (o *TreeItem) SetIcon(column gdnative.Int, texture TextureImplementer) {
 }
 
 /*
-        Sets the given column's icon's maximum width.
-	Args: [{ false column int} { false width int}], Returns: void
+	        Sets the given column's icon's maximum width.
+		Args: [{ false column int} { false width int}], Returns: void
 */
 func (o *TreeItem) SetIconMaxWidth(column gdnative.Int, width gdnative.Int) {
 	//log.Println("Calling TreeItem.SetIconMaxWidth()")
@@ -1347,7 +1449,7 @@ func (o *TreeItem) SetIconMaxWidth(column gdnative.Int, width gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(width)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_icon_max_width")
+	methodBind := methodBindTreeItemSetIconMaxWidth.Get()
 
 	// Call the parent method.
 	// void
@@ -1357,8 +1459,8 @@ func (o *TreeItem) SetIconMaxWidth(column gdnative.Int, width gdnative.Int) {
 }
 
 /*
-        Sets the given column's icon's texture region.
-	Args: [{ false column int} { false region Rect2}], Returns: void
+	        Sets the given column's icon's texture region.
+		Args: [{ false column int} { false region Rect2}], Returns: void
 */
 func (o *TreeItem) SetIconRegion(column gdnative.Int, region gdnative.Rect2) {
 	//log.Println("Calling TreeItem.SetIconRegion()")
@@ -1369,7 +1471,7 @@ func (o *TreeItem) SetIconRegion(column gdnative.Int, region gdnative.Rect2) {
 	ptrArguments[1] = gdnative.NewPointerFromRect2(region)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_icon_region")
+	methodBind := methodBindTreeItemSetIconRegion.Get()
 
 	// Call the parent method.
 	// void
@@ -1379,8 +1481,7 @@ func (o *TreeItem) SetIconRegion(column gdnative.Int, region gdnative.Rect2) {
 }
 
 /*
-
-	Args: [{ false column int} { false meta Variant}], Returns: void
+Args: [{ false column int} { false meta Variant}], Returns: void
 */
 func (o *TreeItem) SetMetadata(column gdnative.Int, meta gdnative.Variant) {
 	//log.Println("Calling TreeItem.SetMetadata()")
@@ -1391,7 +1492,7 @@ func (o *TreeItem) SetMetadata(column gdnative.Int, meta gdnative.Variant) {
 	ptrArguments[1] = gdnative.NewPointerFromVariant(meta)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_metadata")
+	methodBind := methodBindTreeItemSetMetadata.Get()
 
 	// Call the parent method.
 	// void
@@ -1401,8 +1502,7 @@ func (o *TreeItem) SetMetadata(column gdnative.Int, meta gdnative.Variant) {
 }
 
 /*
-
-	Args: [{ false column int} { false value float}], Returns: void
+Args: [{ false column int} { false value float}], Returns: void
 */
 func (o *TreeItem) SetRange(column gdnative.Int, value gdnative.Real) {
 	//log.Println("Calling TreeItem.SetRange()")
@@ -1413,7 +1513,7 @@ func (o *TreeItem) SetRange(column gdnative.Int, value gdnative.Real) {
 	ptrArguments[1] = gdnative.NewPointerFromReal(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_range")
+	methodBind := methodBindTreeItemSetRange.Get()
 
 	// Call the parent method.
 	// void
@@ -1423,8 +1523,7 @@ func (o *TreeItem) SetRange(column gdnative.Int, value gdnative.Real) {
 }
 
 /*
-
-	Args: [{ false column int} { false min float} { false max float} { false step float} {False true expr bool}], Returns: void
+Args: [{ false column int} { false min float} { false max float} { false step float} {False true expr bool}], Returns: void
 */
 func (o *TreeItem) SetRangeConfig(column gdnative.Int, min gdnative.Real, max gdnative.Real, step gdnative.Real, expr gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetRangeConfig()")
@@ -1438,7 +1537,7 @@ func (o *TreeItem) SetRangeConfig(column gdnative.Int, min gdnative.Real, max gd
 	ptrArguments[4] = gdnative.NewPointerFromBool(expr)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_range_config")
+	methodBind := methodBindTreeItemSetRangeConfig.Get()
 
 	// Call the parent method.
 	// void
@@ -1448,8 +1547,8 @@ func (o *TreeItem) SetRangeConfig(column gdnative.Int, min gdnative.Real, max gd
 }
 
 /*
-        If [code]true[/code] the given column is selectable.
-	Args: [{ false column int} { false selectable bool}], Returns: void
+	        If [code]true[/code] the given column is selectable.
+		Args: [{ false column int} { false selectable bool}], Returns: void
 */
 func (o *TreeItem) SetSelectable(column gdnative.Int, selectable gdnative.Bool) {
 	//log.Println("Calling TreeItem.SetSelectable()")
@@ -1460,7 +1559,7 @@ func (o *TreeItem) SetSelectable(column gdnative.Int, selectable gdnative.Bool)
 	ptrArguments[1] = gdnative.NewPointerFromBool(selectable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_selectable")
+	methodBind := methodBindTreeItemSetSelectable.Get()
 
 	// Call the parent method.
 	// void
@@ -1470,8 +1569,7 @@ func (o *TreeItem) SetSelectable(column gdnative.Int, selectable gdnative.Bool)
 }
 
 /*
-
-	Args: [{ false column int} { false text String}], Returns: void
+Args: [{ false column int} { false text String}], Returns: void
 */
 func (o *TreeItem) SetText(column gdnative.Int, text gdnative.String) {
 	//log.Println("Calling TreeItem.SetText()")
@@ -1482,7 +1580,7 @@ func (o *TreeItem) SetText(column gdnative.Int, text gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_text")
+	methodBind := methodBindTreeItemSetText.Get()
 
 	// Call the parent method.
 	// void
@@ -1492,19 +1590,19 @@ func (o *TreeItem) SetText(column gdnative.Int, text gdnative.String) {
 }
 
 /*
-        Sets the given column's text alignment. See [code]ALIGN_*[/code] constants.
-	Args: [{ false column int} { false text_align int}], Returns: void
+	        Sets the given column's text alignment. See [code]ALIGN_*[/code] constants.
+		Args: [{ false column int} { false text_align enum.TreeItem::TextAlign}], Returns: void
 */
-func (o *TreeItem) SetTextAlign(column gdnative.Int, textAlign gdnative.Int) {
+func (o *TreeItem) SetTextAlign(column gdnative.Int, textAlign TreeItemTextAlign) {
 	//log.Println("Calling TreeItem.SetTextAlign()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(column)
-	ptrArguments[1] = gdnative.NewPointerFromInt(textAlign)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(textAlign))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_text_align")
+	methodBind := methodBindTreeItemSetTextAlign.Get()
 
 	// Call the parent method.
 	// void
@@ -1514,8 +1612,8 @@ func (o *TreeItem) SetTextAlign(column gdnative.Int, textAlign gdnative.Int) {
 }
 
 /*
-        Sets the given column's tooltip text.
-	Args: [{ false column int} { false tooltip String}], Returns: void
+	        Sets the given column's tooltip text.
+		Args: [{ false column int} { false tooltip String}], Returns: void
 */
 func (o *TreeItem) SetTooltip(column gdnative.Int, tooltip gdnative.String) {
 	//log.Println("Calling TreeItem.SetTooltip()")
@@ -1526,7 +1624,7 @@ func (o *TreeItem) SetTooltip(column gdnative.Int, tooltip gdnative.String) {
 	ptrArguments[1] = gdnative.NewPointerFromString(tooltip)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("TreeItem", "set_tooltip")
+	methodBind := methodBindTreeItemSetTooltip.Get()
 
 	// Call the parent method.
 	// void
@@ -1535,22 +1633,53 @@ func (o *TreeItem) SetTooltip(column gdnative.Int, tooltip gdnative.String) {
 
 }
 
+// AddButtonWithDefaults will call AddButton using the default values for: button_idx, disabled, tooltip.
+func (o *TreeItem) AddButtonWithDefaults(column gdnative.Int, button godot.TextureImplementer) {
+	o.AddButton(column, button, -1, false, "")
+}
+
+// SetCustomBgColorWithDefaults will call SetCustomBgColor using the default values for: just_outline.
+func (o *TreeItem) SetCustomBgColorWithDefaults(column gdnative.Int, color gdnative.Color) {
+	o.SetCustomBgColor(column, color, false)
+}
+
+// SetRangeConfigWithDefaults will call SetRangeConfig using the default values for: expr.
+func (o *TreeItem) SetRangeConfigWithDefaults(column gdnative.Int, min gdnative.Real, max gdnative.Real, step gdnative.Real) {
+	o.SetRangeConfig(column, min, max, step, false)
+}
+
+// Collapsed will return the value of the "collapsed" property.
+func (o *TreeItem) Collapsed() gdnative.Bool {
+	return o.IsCollapsed()
+}
+
+// CustomMinimumHeight will return the value of the "custom_minimum_height" property.
+func (o *TreeItem) CustomMinimumHeight() gdnative.Int {
+	return o.GetCustomMinimumHeight()
+}
+
+// DisableFolding will return the value of the "disable_folding" property.
+func (o *TreeItem) DisableFolding() gdnative.Bool {
+	return o.IsFoldingDisabled()
+}
+
 // TreeItemImplementer is an interface that implements the methods
 // of the TreeItem class.
 type TreeItemImplementer interface {
-	ObjectImplementer
-	AddButton(column gdnative.Int, button TextureImplementer, buttonIdx gdnative.Int, disabled gdnative.Bool, tooltip gdnative.String)
+	godot.ObjectImplementer
+	AddButton(column gdnative.Int, button godot.TextureImplementer, buttonIdx gdnative.Int, disabled gdnative.Bool, tooltip gdnative.String)
 	ClearCustomBgColor(column gdnative.Int)
 	ClearCustomColor(column gdnative.Int)
 	Deselect(column gdnative.Int)
 	EraseButton(column gdnative.Int, buttonIdx gdnative.Int)
-	GetButton(column gdnative.Int, buttonIdx gdnative.Int) TextureImplementer
+	GetButton(column gdnative.Int, buttonIdx gdnative.Int) godot.TextureImplementer
 	GetButtonCount(column gdnative.Int) gdnative.Int
+	GetCellMode(column gdnative.Int) TreeItemTreeCellMode
 	GetChildren() TreeItemImplementer
 	GetCustomBgColor(column gdnative.Int) gdnative.Color
 	GetCustomMinimumHeight() gdnative.Int
 	GetExpandRight(column gdnative.Int) gdnative.Bool
-	GetIcon(column gdnative.Int) TextureImplementer
+	GetIcon(column gdnative.Int) godot.TextureImplementer
 	GetIconMaxWidth(column gdnative.Int) gdnative.Int
 	GetIconRegion(column gdnative.Int) gdnative.Rect2
 	GetMetadata(column gdnative.Int) gdnative.Variant
@@ -1562,6 +1691,7 @@ type TreeItemImplementer interface {
 	GetRange(column gdnative.Int) gdnative.Real
 	GetRangeConfig(column gdnative.Int) gdnative.Dictionary
 	GetText(column gdnative.Int) gdnative.String
+	GetTextAlign(column gdnative.Int) TreeItemTextAlign
 	GetTooltip(column gdnative.Int) gdnative.String
 	IsButtonDisabled(column gdnative.Int, buttonIdx gdnative.Int) gdnative.Bool
 	IsChecked(column gdnative.Int) gdnative.Bool
@@ -1573,21 +1703,21 @@ type TreeItemImplementer interface {
 	IsSelected(column gdnative.Int) gdnative.Bool
 	MoveToBottom()
 	MoveToTop()
-	RemoveChild(child ObjectImplementer)
+	RemoveChild(child godot.ObjectImplementer)
 	Select(column gdnative.Int)
-	SetButton(column gdnative.Int, buttonIdx gdnative.Int, button TextureImplementer)
-	SetCellMode(column gdnative.Int, mode gdnative.Int)
+	SetButton(column gdnative.Int, buttonIdx gdnative.Int, button godot.TextureImplementer)
+	SetCellMode(column gdnative.Int, mode TreeItemTreeCellMode)
 	SetChecked(column gdnative.Int, checked gdnative.Bool)
 	SetCollapsed(enable gdnative.Bool)
 	SetCustomAsButton(column gdnative.Int, enable gdnative.Bool)
 	SetCustomBgColor(column gdnative.Int, color gdnative.Color, justOutline gdnative.Bool)
 	SetCustomColor(column gdnative.Int, color gdnative.Color)
-	SetCustomDraw(column gdnative.Int, object ObjectImplementer, callback gdnative.String)
+	SetCustomDraw(column gdnative.Int, object godot.ObjectImplementer, callback gdnative.String)
 	SetCustomMinimumHeight(height gdnative.Int)
 	SetDisableFolding(disable gdnative.Bool)
 	SetEditable(column gdnative.Int, enabled gdnative.Bool)
 	SetExpandRight(column gdnative.Int, enable gdnative.Bool)
-	SetIcon(column gdnative.Int, texture TextureImplementer)
+	SetIcon(column gdnative.Int, texture godot.TextureImplementer)
 	SetIconMaxWidth(column gdnative.Int, width gdnative.Int)
 	SetIconRegion(column gdnative.Int, region gdnative.Rect2)
 	SetMetadata(column gdnative.Int, meta gdnative.Variant)
@@ -1595,6 +1725,12 @@ type TreeItemImplementer interface {
 	SetRangeConfig(column gdnative.Int, min gdnative.Real, max gdnative.Real, step gdnative.Real, expr gdnative.Bool)
 	SetSelectable(column gdnative.Int, selectable gdnative.Bool)
 	SetText(column gdnative.Int, text gdnative.String)
-	SetTextAlign(column gdnative.Int, textAlign gdnative.Int)
+	SetTextAlign(column gdnative.Int, textAlign TreeItemTextAlign)
 	SetTooltip(column gdnative.Int, tooltip gdnative.String)
+	AddButtonWithDefaults(column gdnative.Int, button godot.TextureImplementer)
+	SetCustomBgColorWithDefaults(column gdnative.Int, color gdnative.Color)
+	SetRangeConfigWithDefaults(column gdnative.Int, min gdnative.Real, max gdnative.Real, step gdnative.Real)
+	Collapsed() gdnative.Bool
+	CustomMinimumHeight() gdnative.Int
+	DisableFolding() gdnative.Bool
 }
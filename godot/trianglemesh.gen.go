@@ -13,23 +13,30 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewTriangleMeshFromPointer(ptr gdnative.Pointer) TriangleMesh {
-func newTriangleMeshFromPointer(ptr gdnative.Pointer) TriangleMesh {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := TriangleMesh{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type TriangleMesh struct {
 	Reference
 	owner gdnative.Object
 }
 
+// classConstructorTriangleMesh is the class constructor of the TriangleMesh class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorTriangleMesh = gdnative.NewLazyClassConstructor("TriangleMesh")
+
+// NewTriangleMesh will create a new instance of the TriangleMesh class.
+// TriangleMesh is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewTriangleMesh() *TriangleMesh {
+	obj := &TriangleMesh{}
+	obj.SetBaseObject(classConstructorTriangleMesh.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *TriangleMesh) BaseClass() string {
 	return "TriangleMesh"
 }
@@ -1,7 +1,9 @@
-package godot
+package vehicle
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/spatial"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +15,46 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVehicleBodyFromPointer(ptr gdnative.Pointer) VehicleBody {
-func newVehicleBodyFromPointer(ptr gdnative.Pointer) VehicleBody {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VehicleBody{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This nodes implements all the physics logic needed to simulate a car. It is based on the raycast vehicle system commonly found in physics engines. You will need to add a [CollisionShape] for the main body of your vehicle and add [VehicleWheel] nodes for the wheels. You should also add a [MeshInstance] to this node for the 3D model of your car but this model should not include meshes for the wheels. You should control the vehicle by using the [member brake], [member engine_force], and [member steering] properties and not change the position or orientation of this node directly. Note that the origin point of your VehicleBody will determine the center of gravity of your vehicle so it is better to keep this low and move the [CollisionShape] and [MeshInstance] upwards.
 */
 type VehicleBody struct {
-	RigidBody
+	spatial.RigidBody
 	owner gdnative.Object
 }
 
+// classConstructorVehicleBody is the class constructor of the VehicleBody class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVehicleBody = gdnative.NewLazyClassConstructor("VehicleBody")
+
+// NewVehicleBody will create a new instance of the VehicleBody class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVehicleBody() *VehicleBody {
+	obj := &VehicleBody{}
+	obj.SetBaseObject(classConstructorVehicleBody.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VehicleBody) BaseClass() string {
 	return "VehicleBody"
 }
 
+// Method binds of the VehicleBody class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVehicleBodyGetBrake       = gdnative.NewLazyMethodBind("VehicleBody", "get_brake")
+	methodBindVehicleBodyGetEngineForce = gdnative.NewLazyMethodBind("VehicleBody", "get_engine_force")
+	methodBindVehicleBodyGetSteering    = gdnative.NewLazyMethodBind("VehicleBody", "get_steering")
+	methodBindVehicleBodySetBrake       = gdnative.NewLazyMethodBind("VehicleBody", "set_brake")
+	methodBindVehicleBodySetEngineForce = gdnative.NewLazyMethodBind("VehicleBody", "set_engine_force")
+	methodBindVehicleBodySetSteering    = gdnative.NewLazyMethodBind("VehicleBody", "set_steering")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleBody) GetBrake() gdnative.Real {
 	//log.Println("Calling VehicleBody.GetBrake()")
@@ -45,7 +63,7 @@ func (o *VehicleBody) GetBrake() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleBody", "get_brake")
+	methodBind := methodBindVehicleBodyGetBrake.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +76,8 @@ func (o *VehicleBody) GetBrake() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleBody) GetEngineForce() gdnative.Real {
 	//log.Println("Calling VehicleBody.GetEngineForce()")
@@ -68,7 +86,7 @@ func (o *VehicleBody) GetEngineForce() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleBody", "get_engine_force")
+	methodBind := methodBindVehicleBodyGetEngineForce.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +99,8 @@ func (o *VehicleBody) GetEngineForce() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleBody) GetSteering() gdnative.Real {
 	//log.Println("Calling VehicleBody.GetSteering()")
@@ -91,7 +109,7 @@ func (o *VehicleBody) GetSteering() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleBody", "get_steering")
+	methodBind := methodBindVehicleBodyGetSteering.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +122,8 @@ func (o *VehicleBody) GetSteering() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [{ false brake float}], Returns: void
+	        Undocumented
+		Args: [{ false brake float}], Returns: void
 */
 func (o *VehicleBody) SetBrake(brake gdnative.Real) {
 	//log.Println("Calling VehicleBody.SetBrake()")
@@ -115,7 +133,7 @@ func (o *VehicleBody) SetBrake(brake gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(brake)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleBody", "set_brake")
+	methodBind := methodBindVehicleBodySetBrake.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +143,8 @@ func (o *VehicleBody) SetBrake(brake gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false engine_force float}], Returns: void
+	        Undocumented
+		Args: [{ false engine_force float}], Returns: void
 */
 func (o *VehicleBody) SetEngineForce(engineForce gdnative.Real) {
 	//log.Println("Calling VehicleBody.SetEngineForce()")
@@ -136,7 +154,7 @@ func (o *VehicleBody) SetEngineForce(engineForce gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(engineForce)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleBody", "set_engine_force")
+	methodBind := methodBindVehicleBodySetEngineForce.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +164,8 @@ func (o *VehicleBody) SetEngineForce(engineForce gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false steering float}], Returns: void
+	        Undocumented
+		Args: [{ false steering float}], Returns: void
 */
 func (o *VehicleBody) SetSteering(steering gdnative.Real) {
 	//log.Println("Calling VehicleBody.SetSteering()")
@@ -157,7 +175,7 @@ func (o *VehicleBody) SetSteering(steering gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(steering)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleBody", "set_steering")
+	methodBind := methodBindVehicleBodySetSteering.Get()
 
 	// Call the parent method.
 	// void
@@ -166,14 +184,32 @@ func (o *VehicleBody) SetSteering(steering gdnative.Real) {
 
 }
 
+// Brake will return the value of the "brake" property.
+func (o *VehicleBody) Brake() gdnative.Real {
+	return o.GetBrake()
+}
+
+// EngineForce will return the value of the "engine_force" property.
+func (o *VehicleBody) EngineForce() gdnative.Real {
+	return o.GetEngineForce()
+}
+
+// Steering will return the value of the "steering" property.
+func (o *VehicleBody) Steering() gdnative.Real {
+	return o.GetSteering()
+}
+
 // VehicleBodyImplementer is an interface that implements the methods
 // of the VehicleBody class.
 type VehicleBodyImplementer interface {
-	RigidBodyImplementer
+	spatial.RigidBodyImplementer
 	GetBrake() gdnative.Real
 	GetEngineForce() gdnative.Real
 	GetSteering() gdnative.Real
 	SetBrake(brake gdnative.Real)
 	SetEngineForce(engineForce gdnative.Real)
 	SetSteering(steering gdnative.Real)
+	Brake() gdnative.Real
+	EngineForce() gdnative.Real
+	Steering() gdnative.Real
 }
@@ -1,7 +1,8 @@
-package godot
+package vehicle
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,64 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVehicleWheelFromPointer(ptr gdnative.Pointer) VehicleWheel {
-func newVehicleWheelFromPointer(ptr gdnative.Pointer) VehicleWheel {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VehicleWheel{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 This node needs to be used as a child node of [VehicleBody] and simulates the behaviour of one of its wheels. This node also acts as a collider to detect if the wheel is touching a surface.
 */
 type VehicleWheel struct {
-	Spatial
+	godot.Spatial
 	owner gdnative.Object
 }
 
+// classConstructorVehicleWheel is the class constructor of the VehicleWheel class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVehicleWheel = gdnative.NewLazyClassConstructor("VehicleWheel")
+
+// NewVehicleWheel will create a new instance of the VehicleWheel class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVehicleWheel() *VehicleWheel {
+	obj := &VehicleWheel{}
+	obj.SetBaseObject(classConstructorVehicleWheel.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VehicleWheel) BaseClass() string {
 	return "VehicleWheel"
 }
 
+// Method binds of the VehicleWheel class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVehicleWheelGetDampingCompression   = gdnative.NewLazyMethodBind("VehicleWheel", "get_damping_compression")
+	methodBindVehicleWheelGetDampingRelaxation    = gdnative.NewLazyMethodBind("VehicleWheel", "get_damping_relaxation")
+	methodBindVehicleWheelGetFrictionSlip         = gdnative.NewLazyMethodBind("VehicleWheel", "get_friction_slip")
+	methodBindVehicleWheelGetRadius               = gdnative.NewLazyMethodBind("VehicleWheel", "get_radius")
+	methodBindVehicleWheelGetRollInfluence        = gdnative.NewLazyMethodBind("VehicleWheel", "get_roll_influence")
+	methodBindVehicleWheelGetSkidinfo             = gdnative.NewLazyMethodBind("VehicleWheel", "get_skidinfo")
+	methodBindVehicleWheelGetSuspensionMaxForce   = gdnative.NewLazyMethodBind("VehicleWheel", "get_suspension_max_force")
+	methodBindVehicleWheelGetSuspensionRestLength = gdnative.NewLazyMethodBind("VehicleWheel", "get_suspension_rest_length")
+	methodBindVehicleWheelGetSuspensionStiffness  = gdnative.NewLazyMethodBind("VehicleWheel", "get_suspension_stiffness")
+	methodBindVehicleWheelGetSuspensionTravel     = gdnative.NewLazyMethodBind("VehicleWheel", "get_suspension_travel")
+	methodBindVehicleWheelIsInContact             = gdnative.NewLazyMethodBind("VehicleWheel", "is_in_contact")
+	methodBindVehicleWheelIsUsedAsSteering        = gdnative.NewLazyMethodBind("VehicleWheel", "is_used_as_steering")
+	methodBindVehicleWheelIsUsedAsTraction        = gdnative.NewLazyMethodBind("VehicleWheel", "is_used_as_traction")
+	methodBindVehicleWheelSetDampingCompression   = gdnative.NewLazyMethodBind("VehicleWheel", "set_damping_compression")
+	methodBindVehicleWheelSetDampingRelaxation    = gdnative.NewLazyMethodBind("VehicleWheel", "set_damping_relaxation")
+	methodBindVehicleWheelSetFrictionSlip         = gdnative.NewLazyMethodBind("VehicleWheel", "set_friction_slip")
+	methodBindVehicleWheelSetRadius               = gdnative.NewLazyMethodBind("VehicleWheel", "set_radius")
+	methodBindVehicleWheelSetRollInfluence        = gdnative.NewLazyMethodBind("VehicleWheel", "set_roll_influence")
+	methodBindVehicleWheelSetSuspensionMaxForce   = gdnative.NewLazyMethodBind("VehicleWheel", "set_suspension_max_force")
+	methodBindVehicleWheelSetSuspensionRestLength = gdnative.NewLazyMethodBind("VehicleWheel", "set_suspension_rest_length")
+	methodBindVehicleWheelSetSuspensionStiffness  = gdnative.NewLazyMethodBind("VehicleWheel", "set_suspension_stiffness")
+	methodBindVehicleWheelSetSuspensionTravel     = gdnative.NewLazyMethodBind("VehicleWheel", "set_suspension_travel")
+	methodBindVehicleWheelSetUseAsSteering        = gdnative.NewLazyMethodBind("VehicleWheel", "set_use_as_steering")
+	methodBindVehicleWheelSetUseAsTraction        = gdnative.NewLazyMethodBind("VehicleWheel", "set_use_as_traction")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetDampingCompression() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetDampingCompression()")
@@ -45,7 +80,7 @@ func (o *VehicleWheel) GetDampingCompression() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_damping_compression")
+	methodBind := methodBindVehicleWheelGetDampingCompression.Get()
 
 	// Call the parent method.
 	// float
@@ -58,8 +93,8 @@ func (o *VehicleWheel) GetDampingCompression() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetDampingRelaxation() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetDampingRelaxation()")
@@ -68,7 +103,7 @@ func (o *VehicleWheel) GetDampingRelaxation() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_damping_relaxation")
+	methodBind := methodBindVehicleWheelGetDampingRelaxation.Get()
 
 	// Call the parent method.
 	// float
@@ -81,8 +116,8 @@ func (o *VehicleWheel) GetDampingRelaxation() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetFrictionSlip() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetFrictionSlip()")
@@ -91,7 +126,7 @@ func (o *VehicleWheel) GetFrictionSlip() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_friction_slip")
+	methodBind := methodBindVehicleWheelGetFrictionSlip.Get()
 
 	// Call the parent method.
 	// float
@@ -104,8 +139,8 @@ func (o *VehicleWheel) GetFrictionSlip() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetRadius() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetRadius()")
@@ -114,7 +149,7 @@ func (o *VehicleWheel) GetRadius() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_radius")
+	methodBind := methodBindVehicleWheelGetRadius.Get()
 
 	// Call the parent method.
 	// float
@@ -127,8 +162,8 @@ func (o *VehicleWheel) GetRadius() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetRollInfluence() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetRollInfluence()")
@@ -137,7 +172,7 @@ func (o *VehicleWheel) GetRollInfluence() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_roll_influence")
+	methodBind := methodBindVehicleWheelGetRollInfluence.Get()
 
 	// Call the parent method.
 	// float
@@ -150,8 +185,8 @@ func (o *VehicleWheel) GetRollInfluence() gdnative.Real {
 }
 
 /*
-        Returns a value between 0.0 and 1.0 that indicates whether this wheel is skidding. 0.0 is not skidding, 1.0 means the wheel has lost grip.
-	Args: [], Returns: float
+	        Returns a value between 0.0 and 1.0 that indicates whether this wheel is skidding. 0.0 is not skidding, 1.0 means the wheel has lost grip.
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetSkidinfo() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetSkidinfo()")
@@ -160,7 +195,7 @@ func (o *VehicleWheel) GetSkidinfo() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_skidinfo")
+	methodBind := methodBindVehicleWheelGetSkidinfo.Get()
 
 	// Call the parent method.
 	// float
@@ -173,8 +208,8 @@ func (o *VehicleWheel) GetSkidinfo() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetSuspensionMaxForce() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetSuspensionMaxForce()")
@@ -183,7 +218,7 @@ func (o *VehicleWheel) GetSuspensionMaxForce() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_suspension_max_force")
+	methodBind := methodBindVehicleWheelGetSuspensionMaxForce.Get()
 
 	// Call the parent method.
 	// float
@@ -196,8 +231,8 @@ func (o *VehicleWheel) GetSuspensionMaxForce() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetSuspensionRestLength() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetSuspensionRestLength()")
@@ -206,7 +241,7 @@ func (o *VehicleWheel) GetSuspensionRestLength() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_suspension_rest_length")
+	methodBind := methodBindVehicleWheelGetSuspensionRestLength.Get()
 
 	// Call the parent method.
 	// float
@@ -219,8 +254,8 @@ func (o *VehicleWheel) GetSuspensionRestLength() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetSuspensionStiffness() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetSuspensionStiffness()")
@@ -229,7 +264,7 @@ func (o *VehicleWheel) GetSuspensionStiffness() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_suspension_stiffness")
+	methodBind := methodBindVehicleWheelGetSuspensionStiffness.Get()
 
 	// Call the parent method.
 	// float
@@ -242,8 +277,8 @@ func (o *VehicleWheel) GetSuspensionStiffness() gdnative.Real {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: float
+	        Undocumented
+		Args: [], Returns: float
 */
 func (o *VehicleWheel) GetSuspensionTravel() gdnative.Real {
 	//log.Println("Calling VehicleWheel.GetSuspensionTravel()")
@@ -252,7 +287,7 @@ func (o *VehicleWheel) GetSuspensionTravel() gdnative.Real {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "get_suspension_travel")
+	methodBind := methodBindVehicleWheelGetSuspensionTravel.Get()
 
 	// Call the parent method.
 	// float
@@ -265,8 +300,8 @@ func (o *VehicleWheel) GetSuspensionTravel() gdnative.Real {
 }
 
 /*
-        Returns true if this wheel is in contact with a surface.
-	Args: [], Returns: bool
+	        Returns true if this wheel is in contact with a surface.
+		Args: [], Returns: bool
 */
 func (o *VehicleWheel) IsInContact() gdnative.Bool {
 	//log.Println("Calling VehicleWheel.IsInContact()")
@@ -275,7 +310,7 @@ func (o *VehicleWheel) IsInContact() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "is_in_contact")
+	methodBind := methodBindVehicleWheelIsInContact.Get()
 
 	// Call the parent method.
 	// bool
@@ -288,8 +323,8 @@ func (o *VehicleWheel) IsInContact() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VehicleWheel) IsUsedAsSteering() gdnative.Bool {
 	//log.Println("Calling VehicleWheel.IsUsedAsSteering()")
@@ -298,7 +333,7 @@ func (o *VehicleWheel) IsUsedAsSteering() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "is_used_as_steering")
+	methodBind := methodBindVehicleWheelIsUsedAsSteering.Get()
 
 	// Call the parent method.
 	// bool
@@ -311,8 +346,8 @@ func (o *VehicleWheel) IsUsedAsSteering() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VehicleWheel) IsUsedAsTraction() gdnative.Bool {
 	//log.Println("Calling VehicleWheel.IsUsedAsTraction()")
@@ -321,7 +356,7 @@ func (o *VehicleWheel) IsUsedAsTraction() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "is_used_as_traction")
+	methodBind := methodBindVehicleWheelIsUsedAsTraction.Get()
 
 	// Call the parent method.
 	// bool
@@ -334,8 +369,8 @@ func (o *VehicleWheel) IsUsedAsTraction() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetDampingCompression(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetDampingCompression()")
@@ -345,7 +380,7 @@ func (o *VehicleWheel) SetDampingCompression(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_damping_compression")
+	methodBind := methodBindVehicleWheelSetDampingCompression.Get()
 
 	// Call the parent method.
 	// void
@@ -355,8 +390,8 @@ func (o *VehicleWheel) SetDampingCompression(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetDampingRelaxation(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetDampingRelaxation()")
@@ -366,7 +401,7 @@ func (o *VehicleWheel) SetDampingRelaxation(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_damping_relaxation")
+	methodBind := methodBindVehicleWheelSetDampingRelaxation.Get()
 
 	// Call the parent method.
 	// void
@@ -376,8 +411,8 @@ func (o *VehicleWheel) SetDampingRelaxation(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetFrictionSlip(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetFrictionSlip()")
@@ -387,7 +422,7 @@ func (o *VehicleWheel) SetFrictionSlip(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_friction_slip")
+	methodBind := methodBindVehicleWheelSetFrictionSlip.Get()
 
 	// Call the parent method.
 	// void
@@ -397,8 +432,8 @@ func (o *VehicleWheel) SetFrictionSlip(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetRadius(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetRadius()")
@@ -408,7 +443,7 @@ func (o *VehicleWheel) SetRadius(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_radius")
+	methodBind := methodBindVehicleWheelSetRadius.Get()
 
 	// Call the parent method.
 	// void
@@ -418,8 +453,8 @@ func (o *VehicleWheel) SetRadius(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false roll_influence float}], Returns: void
+	        Undocumented
+		Args: [{ false roll_influence float}], Returns: void
 */
 func (o *VehicleWheel) SetRollInfluence(rollInfluence gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetRollInfluence()")
@@ -429,7 +464,7 @@ func (o *VehicleWheel) SetRollInfluence(rollInfluence gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(rollInfluence)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_roll_influence")
+	methodBind := methodBindVehicleWheelSetRollInfluence.Get()
 
 	// Call the parent method.
 	// void
@@ -439,8 +474,8 @@ func (o *VehicleWheel) SetRollInfluence(rollInfluence gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetSuspensionMaxForce(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetSuspensionMaxForce()")
@@ -450,7 +485,7 @@ func (o *VehicleWheel) SetSuspensionMaxForce(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_suspension_max_force")
+	methodBind := methodBindVehicleWheelSetSuspensionMaxForce.Get()
 
 	// Call the parent method.
 	// void
@@ -460,8 +495,8 @@ func (o *VehicleWheel) SetSuspensionMaxForce(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetSuspensionRestLength(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetSuspensionRestLength()")
@@ -471,7 +506,7 @@ func (o *VehicleWheel) SetSuspensionRestLength(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_suspension_rest_length")
+	methodBind := methodBindVehicleWheelSetSuspensionRestLength.Get()
 
 	// Call the parent method.
 	// void
@@ -481,8 +516,8 @@ func (o *VehicleWheel) SetSuspensionRestLength(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetSuspensionStiffness(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetSuspensionStiffness()")
@@ -492,7 +527,7 @@ func (o *VehicleWheel) SetSuspensionStiffness(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_suspension_stiffness")
+	methodBind := methodBindVehicleWheelSetSuspensionStiffness.Get()
 
 	// Call the parent method.
 	// void
@@ -502,8 +537,8 @@ func (o *VehicleWheel) SetSuspensionStiffness(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false length float}], Returns: void
+	        Undocumented
+		Args: [{ false length float}], Returns: void
 */
 func (o *VehicleWheel) SetSuspensionTravel(length gdnative.Real) {
 	//log.Println("Calling VehicleWheel.SetSuspensionTravel()")
@@ -513,7 +548,7 @@ func (o *VehicleWheel) SetSuspensionTravel(length gdnative.Real) {
 	ptrArguments[0] = gdnative.NewPointerFromReal(length)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_suspension_travel")
+	methodBind := methodBindVehicleWheelSetSuspensionTravel.Get()
 
 	// Call the parent method.
 	// void
@@ -523,8 +558,8 @@ func (o *VehicleWheel) SetSuspensionTravel(length gdnative.Real) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *VehicleWheel) SetUseAsSteering(enable gdnative.Bool) {
 	//log.Println("Calling VehicleWheel.SetUseAsSteering()")
@@ -534,7 +569,7 @@ func (o *VehicleWheel) SetUseAsSteering(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_use_as_steering")
+	methodBind := methodBindVehicleWheelSetUseAsSteering.Get()
 
 	// Call the parent method.
 	// void
@@ -544,8 +579,8 @@ func (o *VehicleWheel) SetUseAsSteering(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *VehicleWheel) SetUseAsTraction(enable gdnative.Bool) {
 	//log.Println("Calling VehicleWheel.SetUseAsTraction()")
@@ -555,7 +590,7 @@ func (o *VehicleWheel) SetUseAsTraction(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VehicleWheel", "set_use_as_traction")
+	methodBind := methodBindVehicleWheelSetUseAsTraction.Get()
 
 	// Call the parent method.
 	// void
@@ -564,10 +599,85 @@ func (o *VehicleWheel) SetUseAsTraction(enable gdnative.Bool) {
 
 }
 
+// DampingCompression will return the value of the "damping_compression" property.
+func (o *VehicleWheel) DampingCompression() gdnative.Real {
+	return o.GetDampingCompression()
+}
+
+// DampingRelaxation will return the value of the "damping_relaxation" property.
+func (o *VehicleWheel) DampingRelaxation() gdnative.Real {
+	return o.GetDampingRelaxation()
+}
+
+// SuspensionMaxForce will return the value of the "suspension_max_force" property.
+func (o *VehicleWheel) SuspensionMaxForce() gdnative.Real {
+	return o.GetSuspensionMaxForce()
+}
+
+// SuspensionStiffness will return the value of the "suspension_stiffness" property.
+func (o *VehicleWheel) SuspensionStiffness() gdnative.Real {
+	return o.GetSuspensionStiffness()
+}
+
+// SuspensionTravel will return the value of the "suspension_travel" property.
+func (o *VehicleWheel) SuspensionTravel() gdnative.Real {
+	return o.GetSuspensionTravel()
+}
+
+// UseAsSteering will return the value of the "use_as_steering" property.
+func (o *VehicleWheel) UseAsSteering() gdnative.Bool {
+	return o.IsUsedAsSteering()
+}
+
+// UseAsTraction will return the value of the "use_as_traction" property.
+func (o *VehicleWheel) UseAsTraction() gdnative.Bool {
+	return o.IsUsedAsTraction()
+}
+
+// WheelFrictionSlip will return the value of the "wheel_friction_slip" property.
+func (o *VehicleWheel) WheelFrictionSlip() gdnative.Real {
+	return o.GetFrictionSlip()
+}
+
+// SetWheelFrictionSlip will set the value of the "wheel_friction_slip" property.
+func (o *VehicleWheel) SetWheelFrictionSlip(value gdnative.Real) {
+	o.SetFrictionSlip(value)
+}
+
+// WheelRadius will return the value of the "wheel_radius" property.
+func (o *VehicleWheel) WheelRadius() gdnative.Real {
+	return o.GetRadius()
+}
+
+// SetWheelRadius will set the value of the "wheel_radius" property.
+func (o *VehicleWheel) SetWheelRadius(value gdnative.Real) {
+	o.SetRadius(value)
+}
+
+// WheelRestLength will return the value of the "wheel_rest_length" property.
+func (o *VehicleWheel) WheelRestLength() gdnative.Real {
+	return o.GetSuspensionRestLength()
+}
+
+// SetWheelRestLength will set the value of the "wheel_rest_length" property.
+func (o *VehicleWheel) SetWheelRestLength(value gdnative.Real) {
+	o.SetSuspensionRestLength(value)
+}
+
+// WheelRollInfluence will return the value of the "wheel_roll_influence" property.
+func (o *VehicleWheel) WheelRollInfluence() gdnative.Real {
+	return o.GetRollInfluence()
+}
+
+// SetWheelRollInfluence will set the value of the "wheel_roll_influence" property.
+func (o *VehicleWheel) SetWheelRollInfluence(value gdnative.Real) {
+	o.SetRollInfluence(value)
+}
+
 // VehicleWheelImplementer is an interface that implements the methods
 // of the VehicleWheel class.
 type VehicleWheelImplementer interface {
-	SpatialImplementer
+	godot.SpatialImplementer
 	GetDampingCompression() gdnative.Real
 	GetDampingRelaxation() gdnative.Real
 	GetFrictionSlip() gdnative.Real
@@ -592,4 +702,19 @@ type VehicleWheelImplementer interface {
 	SetSuspensionTravel(length gdnative.Real)
 	SetUseAsSteering(enable gdnative.Bool)
 	SetUseAsTraction(enable gdnative.Bool)
+	DampingCompression() gdnative.Real
+	DampingRelaxation() gdnative.Real
+	SuspensionMaxForce() gdnative.Real
+	SuspensionStiffness() gdnative.Real
+	SuspensionTravel() gdnative.Real
+	UseAsSteering() gdnative.Bool
+	UseAsTraction() gdnative.Bool
+	WheelFrictionSlip() gdnative.Real
+	SetWheelFrictionSlip(value gdnative.Real)
+	WheelRadius() gdnative.Real
+	SetWheelRadius(value gdnative.Real)
+	WheelRestLength() gdnative.Real
+	SetWheelRestLength(value gdnative.Real)
+	WheelRollInfluence() gdnative.Real
+	SetWheelRollInfluence(value gdnative.Real)
 }
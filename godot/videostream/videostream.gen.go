@@ -1,7 +1,8 @@
-package godot
+package videostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,10 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVideoStreamFromPointer(ptr gdnative.Pointer) VideoStream {
-func newVideoStreamFromPointer(ptr gdnative.Pointer) VideoStream {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VideoStream{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type VideoStream struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
@@ -37,5 +28,5 @@ func (o *VideoStream) BaseClass() string {
 // VideoStreamImplementer is an interface that implements the methods
 // of the VideoStream class.
 type VideoStreamImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 }
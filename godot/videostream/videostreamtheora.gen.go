@@ -1,7 +1,8 @@
-package godot
+package videostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVideoStreamTheoraFromPointer(ptr gdnative.Pointer) VideoStreamTheora {
-func newVideoStreamTheoraFromPointer(ptr gdnative.Pointer) VideoStreamTheora {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VideoStreamTheora{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VideoStreamTheora struct {
 	owner gdnative.Object
 }
 
+// classConstructorVideoStreamTheora is the class constructor of the VideoStreamTheora class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVideoStreamTheora = gdnative.NewLazyClassConstructor("VideoStreamTheora")
+
+// NewVideoStreamTheora will create a new instance of the VideoStreamTheora class.
+// VideoStreamTheora is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVideoStreamTheora() *VideoStreamTheora {
+	obj := &VideoStreamTheora{}
+	obj.SetBaseObject(classConstructorVideoStreamTheora.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VideoStreamTheora) BaseClass() string {
 	return "VideoStreamTheora"
 }
 
+// Method binds of the VideoStreamTheora class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVideoStreamTheoraGetFile = gdnative.NewLazyMethodBind("VideoStreamTheora", "get_file")
+	methodBindVideoStreamTheoraSetFile = gdnative.NewLazyMethodBind("VideoStreamTheora", "set_file")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VideoStreamTheora) GetFile() gdnative.String {
 	//log.Println("Calling VideoStreamTheora.GetFile()")
@@ -45,7 +61,7 @@ func (o *VideoStreamTheora) GetFile() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoStreamTheora", "get_file")
+	methodBind := methodBindVideoStreamTheoraGetFile.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *VideoStreamTheora) GetFile() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false file String}], Returns: void
+	        Undocumented
+		Args: [{ false file String}], Returns: void
 */
 func (o *VideoStreamTheora) SetFile(file gdnative.String) {
 	//log.Println("Calling VideoStreamTheora.SetFile()")
@@ -69,7 +85,7 @@ func (o *VideoStreamTheora) SetFile(file gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoStreamTheora", "set_file")
+	methodBind := methodBindVideoStreamTheoraSetFile.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *VideoStreamTheora) SetFile(file gdnative.String) {
 
 }
 
+// File will return the value of the "file" property.
+func (o *VideoStreamTheora) File() gdnative.String {
+	return o.GetFile()
+}
+
 // VideoStreamTheoraImplementer is an interface that implements the methods
 // of the VideoStreamTheora class.
 type VideoStreamTheoraImplementer interface {
 	VideoStreamImplementer
 	GetFile() gdnative.String
 	SetFile(file gdnative.String)
+	File() gdnative.String
 }
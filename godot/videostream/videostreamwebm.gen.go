@@ -1,7 +1,8 @@
-package godot
+package videostream
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVideoStreamWebmFromPointer(ptr gdnative.Pointer) VideoStreamWebm {
-func newVideoStreamWebmFromPointer(ptr gdnative.Pointer) VideoStreamWebm {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VideoStreamWebm{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VideoStreamWebm struct {
 	owner gdnative.Object
 }
 
+// classConstructorVideoStreamWebm is the class constructor of the VideoStreamWebm class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVideoStreamWebm = gdnative.NewLazyClassConstructor("VideoStreamWebm")
+
+// NewVideoStreamWebm will create a new instance of the VideoStreamWebm class.
+// VideoStreamWebm is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVideoStreamWebm() *VideoStreamWebm {
+	obj := &VideoStreamWebm{}
+	obj.SetBaseObject(classConstructorVideoStreamWebm.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VideoStreamWebm) BaseClass() string {
 	return "VideoStreamWebm"
 }
 
+// Method binds of the VideoStreamWebm class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVideoStreamWebmGetFile = gdnative.NewLazyMethodBind("VideoStreamWebm", "get_file")
+	methodBindVideoStreamWebmSetFile = gdnative.NewLazyMethodBind("VideoStreamWebm", "set_file")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VideoStreamWebm) GetFile() gdnative.String {
 	//log.Println("Calling VideoStreamWebm.GetFile()")
@@ -45,7 +61,7 @@ func (o *VideoStreamWebm) GetFile() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoStreamWebm", "get_file")
+	methodBind := methodBindVideoStreamWebmGetFile.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *VideoStreamWebm) GetFile() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false file String}], Returns: void
+	        Undocumented
+		Args: [{ false file String}], Returns: void
 */
 func (o *VideoStreamWebm) SetFile(file gdnative.String) {
 	//log.Println("Calling VideoStreamWebm.SetFile()")
@@ -69,7 +85,7 @@ func (o *VideoStreamWebm) SetFile(file gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(file)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VideoStreamWebm", "set_file")
+	methodBind := methodBindVideoStreamWebmSetFile.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *VideoStreamWebm) SetFile(file gdnative.String) {
 
 }
 
+// File will return the value of the "file" property.
+func (o *VideoStreamWebm) File() gdnative.String {
+	return o.GetFile()
+}
+
 // VideoStreamWebmImplementer is an interface that implements the methods
 // of the VideoStreamWebm class.
 type VideoStreamWebmImplementer interface {
 	VideoStreamImplementer
 	GetFile() gdnative.String
 	SetFile(file gdnative.String)
+	File() gdnative.String
 }
@@ -1,6 +1,8 @@
 package godot
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
 )
 
@@ -22,6 +24,32 @@ const (
 	ViewportClearModeOnlyNextFrame ViewportClearMode = 2
 )
 
+// String will return the Godot name of the ViewportClearMode value.
+func (e ViewportClearMode) String() string {
+	switch e {
+	case ViewportClearModeAlways:
+		return "CLEAR_MODE_ALWAYS"
+	case ViewportClearModeNever:
+		return "CLEAR_MODE_NEVER"
+	case ViewportClearModeOnlyNextFrame:
+		return "CLEAR_MODE_ONLY_NEXT_FRAME"
+	}
+	return fmt.Sprintf("ViewportClearMode(%d)", int(e))
+}
+
+// ParseViewportClearMode will return the ViewportClearMode value with the given Godot name.
+func ParseViewportClearMode(name string) (ViewportClearMode, error) {
+	switch name {
+	case "CLEAR_MODE_ALWAYS":
+		return ViewportClearModeAlways, nil
+	case "CLEAR_MODE_NEVER":
+		return ViewportClearModeNever, nil
+	case "CLEAR_MODE_ONLY_NEXT_FRAME":
+		return ViewportClearModeOnlyNextFrame, nil
+	}
+	return 0, fmt.Errorf("invalid ViewportClearMode value %q", name)
+}
+
 // ViewportDebugDraw is an enum for DebugDraw values.
 type ViewportDebugDraw int
 
@@ -32,6 +60,36 @@ const (
 	ViewportDebugDrawWireframe ViewportDebugDraw = 3
 )
 
+// String will return the Godot name of the ViewportDebugDraw value.
+func (e ViewportDebugDraw) String() string {
+	switch e {
+	case ViewportDebugDrawDisabled:
+		return "DEBUG_DRAW_DISABLED"
+	case ViewportDebugDrawOverdraw:
+		return "DEBUG_DRAW_OVERDRAW"
+	case ViewportDebugDrawUnshaded:
+		return "DEBUG_DRAW_UNSHADED"
+	case ViewportDebugDrawWireframe:
+		return "DEBUG_DRAW_WIREFRAME"
+	}
+	return fmt.Sprintf("ViewportDebugDraw(%d)", int(e))
+}
+
+// ParseViewportDebugDraw will return the ViewportDebugDraw value with the given Godot name.
+func ParseViewportDebugDraw(name string) (ViewportDebugDraw, error) {
+	switch name {
+	case "DEBUG_DRAW_DISABLED":
+		return ViewportDebugDrawDisabled, nil
+	case "DEBUG_DRAW_OVERDRAW":
+		return ViewportDebugDrawOverdraw, nil
+	case "DEBUG_DRAW_UNSHADED":
+		return ViewportDebugDrawUnshaded, nil
+	case "DEBUG_DRAW_WIREFRAME":
+		return ViewportDebugDrawWireframe, nil
+	}
+	return 0, fmt.Errorf("invalid ViewportDebugDraw value %q", name)
+}
+
 // ViewportMSAA is an enum for MSAA values.
 type ViewportMSAA int
 
@@ -43,6 +101,40 @@ const (
 	ViewportMsaaDisabled ViewportMSAA = 0
 )
 
+// String will return the Godot name of the ViewportMSAA value.
+func (e ViewportMSAA) String() string {
+	switch e {
+	case ViewportMsaa16X:
+		return "MSAA_16X"
+	case ViewportMsaa2X:
+		return "MSAA_2X"
+	case ViewportMsaa4X:
+		return "MSAA_4X"
+	case ViewportMsaa8X:
+		return "MSAA_8X"
+	case ViewportMsaaDisabled:
+		return "MSAA_DISABLED"
+	}
+	return fmt.Sprintf("ViewportMSAA(%d)", int(e))
+}
+
+// ParseViewportMSAA will return the ViewportMSAA value with the given Godot name.
+func ParseViewportMSAA(name string) (ViewportMSAA, error) {
+	switch name {
+	case "MSAA_16X":
+		return ViewportMsaa16X, nil
+	case "MSAA_2X":
+		return ViewportMsaa2X, nil
+	case "MSAA_4X":
+		return ViewportMsaa4X, nil
+	case "MSAA_8X":
+		return ViewportMsaa8X, nil
+	case "MSAA_DISABLED":
+		return ViewportMsaaDisabled, nil
+	}
+	return 0, fmt.Errorf("invalid ViewportMSAA value %q", name)
+}
+
 // ViewportRenderInfo is an enum for RenderInfo values.
 type ViewportRenderInfo int
 
@@ -56,6 +148,48 @@ const (
 	ViewportRenderInfoVerticesInFrame        ViewportRenderInfo = 1
 )
 
+// String will return the Godot name of the ViewportRenderInfo value.
+func (e ViewportRenderInfo) String() string {
+	switch e {
+	case ViewportRenderInfoDrawCallsInFrame:
+		return "RENDER_INFO_DRAW_CALLS_IN_FRAME"
+	case ViewportRenderInfoMaterialChangesInFrame:
+		return "RENDER_INFO_MATERIAL_CHANGES_IN_FRAME"
+	case ViewportRenderInfoMax:
+		return "RENDER_INFO_MAX"
+	case ViewportRenderInfoObjectsInFrame:
+		return "RENDER_INFO_OBJECTS_IN_FRAME"
+	case ViewportRenderInfoShaderChangesInFrame:
+		return "RENDER_INFO_SHADER_CHANGES_IN_FRAME"
+	case ViewportRenderInfoSurfaceChangesInFrame:
+		return "RENDER_INFO_SURFACE_CHANGES_IN_FRAME"
+	case ViewportRenderInfoVerticesInFrame:
+		return "RENDER_INFO_VERTICES_IN_FRAME"
+	}
+	return fmt.Sprintf("ViewportRenderInfo(%d)", int(e))
+}
+
+// ParseViewportRenderInfo will return the ViewportRenderInfo value with the given Godot name.
+func ParseViewportRenderInfo(name string) (ViewportRenderInfo, error) {
+	switch name {
+	case "RENDER_INFO_DRAW_CALLS_IN_FRAME":
+		return ViewportRenderInfoDrawCallsInFrame, nil
+	case "RENDER_INFO_MATERIAL_CHANGES_IN_FRAME":
+		return ViewportRenderInfoMaterialChangesInFrame, nil
+	case "RENDER_INFO_MAX":
+		return ViewportRenderInfoMax, nil
+	case "RENDER_INFO_OBJECTS_IN_FRAME":
+		return ViewportRenderInfoObjectsInFrame, nil
+	case "RENDER_INFO_SHADER_CHANGES_IN_FRAME":
+		return ViewportRenderInfoShaderChangesInFrame, nil
+	case "RENDER_INFO_SURFACE_CHANGES_IN_FRAME":
+		return ViewportRenderInfoSurfaceChangesInFrame, nil
+	case "RENDER_INFO_VERTICES_IN_FRAME":
+		return ViewportRenderInfoVerticesInFrame, nil
+	}
+	return 0, fmt.Errorf("invalid ViewportRenderInfo value %q", name)
+}
+
 // ViewportShadowAtlasQuadrantSubdiv is an enum for ShadowAtlasQuadrantSubdiv values.
 type ViewportShadowAtlasQuadrantSubdiv int
 
@@ -70,6 +204,52 @@ const (
 	ViewportShadowAtlasQuadrantSubdivMax      ViewportShadowAtlasQuadrantSubdiv = 7
 )
 
+// String will return the Godot name of the ViewportShadowAtlasQuadrantSubdiv value.
+func (e ViewportShadowAtlasQuadrantSubdiv) String() string {
+	switch e {
+	case ViewportShadowAtlasQuadrantSubdiv1:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_1"
+	case ViewportShadowAtlasQuadrantSubdiv1024:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_1024"
+	case ViewportShadowAtlasQuadrantSubdiv16:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_16"
+	case ViewportShadowAtlasQuadrantSubdiv256:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_256"
+	case ViewportShadowAtlasQuadrantSubdiv4:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_4"
+	case ViewportShadowAtlasQuadrantSubdiv64:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_64"
+	case ViewportShadowAtlasQuadrantSubdivDisabled:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_DISABLED"
+	case ViewportShadowAtlasQuadrantSubdivMax:
+		return "SHADOW_ATLAS_QUADRANT_SUBDIV_MAX"
+	}
+	return fmt.Sprintf("ViewportShadowAtlasQuadrantSubdiv(%d)", int(e))
+}
+
+// ParseViewportShadowAtlasQuadrantSubdiv will return the ViewportShadowAtlasQuadrantSubdiv value with the given Godot name.
+func ParseViewportShadowAtlasQuadrantSubdiv(name string) (ViewportShadowAtlasQuadrantSubdiv, error) {
+	switch name {
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_1":
+		return ViewportShadowAtlasQuadrantSubdiv1, nil
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_1024":
+		return ViewportShadowAtlasQuadrantSubdiv1024, nil
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_16":
+		return ViewportShadowAtlasQuadrantSubdiv16, nil
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_256":
+		return ViewportShadowAtlasQuadrantSubdiv256, nil
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_4":
+		return ViewportShadowAtlasQuadrantSubdiv4, nil
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_64":
+		return ViewportShadowAtlasQuadrantSubdiv64, nil
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_DISABLED":
+		return ViewportShadowAtlasQuadrantSubdivDisabled, nil
+	case "SHADOW_ATLAS_QUADRANT_SUBDIV_MAX":
+		return ViewportShadowAtlasQuadrantSubdivMax, nil
+	}
+	return 0, fmt.Errorf("invalid ViewportShadowAtlasQuadrantSubdiv value %q", name)
+}
+
 // ViewportUpdateMode is an enum for UpdateMode values.
 type ViewportUpdateMode int
 
@@ -80,6 +260,36 @@ const (
 	ViewportUpdateWhenVisible ViewportUpdateMode = 2
 )
 
+// String will return the Godot name of the ViewportUpdateMode value.
+func (e ViewportUpdateMode) String() string {
+	switch e {
+	case ViewportUpdateAlways:
+		return "UPDATE_ALWAYS"
+	case ViewportUpdateDisabled:
+		return "UPDATE_DISABLED"
+	case ViewportUpdateOnce:
+		return "UPDATE_ONCE"
+	case ViewportUpdateWhenVisible:
+		return "UPDATE_WHEN_VISIBLE"
+	}
+	return fmt.Sprintf("ViewportUpdateMode(%d)", int(e))
+}
+
+// ParseViewportUpdateMode will return the ViewportUpdateMode value with the given Godot name.
+func ParseViewportUpdateMode(name string) (ViewportUpdateMode, error) {
+	switch name {
+	case "UPDATE_ALWAYS":
+		return ViewportUpdateAlways, nil
+	case "UPDATE_DISABLED":
+		return ViewportUpdateDisabled, nil
+	case "UPDATE_ONCE":
+		return ViewportUpdateOnce, nil
+	case "UPDATE_WHEN_VISIBLE":
+		return ViewportUpdateWhenVisible, nil
+	}
+	return 0, fmt.Errorf("invalid ViewportUpdateMode value %q", name)
+}
+
 // ViewportUsage is an enum for Usage values.
 type ViewportUsage int
 
@@ -90,13 +300,34 @@ const (
 	ViewportUsage3DNoEffects  ViewportUsage = 3
 )
 
-//func NewViewportFromPointer(ptr gdnative.Pointer) Viewport {
-func newViewportFromPointer(ptr gdnative.Pointer) Viewport {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := Viewport{}
-	obj.SetBaseObject(owner)
-
-	return obj
+// String will return the Godot name of the ViewportUsage value.
+func (e ViewportUsage) String() string {
+	switch e {
+	case ViewportUsage2D:
+		return "USAGE_2D"
+	case ViewportUsage2DNoSampling:
+		return "USAGE_2D_NO_SAMPLING"
+	case ViewportUsage3D:
+		return "USAGE_3D"
+	case ViewportUsage3DNoEffects:
+		return "USAGE_3D_NO_EFFECTS"
+	}
+	return fmt.Sprintf("ViewportUsage(%d)", int(e))
+}
+
+// ParseViewportUsage will return the ViewportUsage value with the given Godot name.
+func ParseViewportUsage(name string) (ViewportUsage, error) {
+	switch name {
+	case "USAGE_2D":
+		return ViewportUsage2D, nil
+	case "USAGE_2D_NO_SAMPLING":
+		return ViewportUsage2DNoSampling, nil
+	case "USAGE_3D":
+		return ViewportUsage3D, nil
+	case "USAGE_3D_NO_EFFECTS":
+		return ViewportUsage3DNoEffects, nil
+	}
+	return 0, fmt.Errorf("invalid ViewportUsage value %q", name)
 }
 
 /*
@@ -107,13 +338,104 @@ type Viewport struct {
 	owner gdnative.Object
 }
 
+// classConstructorViewport is the class constructor of the Viewport class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorViewport = gdnative.NewLazyClassConstructor("Viewport")
+
+// NewViewport will create a new instance of the Viewport class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewViewport() *Viewport {
+	obj := &Viewport{}
+	obj.SetBaseObject(classConstructorViewport.Get().Call())
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *Viewport) BaseClass() string {
 	return "Viewport"
 }
 
+// Method binds of the Viewport class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindViewportX_GuiRemoveFocus              = gdnative.NewLazyMethodBind("Viewport", "_gui_remove_focus")
+	methodBindViewportX_GuiShowTooltip              = gdnative.NewLazyMethodBind("Viewport", "_gui_show_tooltip")
+	methodBindViewportX_VpInput                     = gdnative.NewLazyMethodBind("Viewport", "_vp_input")
+	methodBindViewportX_VpInputText                 = gdnative.NewLazyMethodBind("Viewport", "_vp_input_text")
+	methodBindViewportX_VpUnhandledInput            = gdnative.NewLazyMethodBind("Viewport", "_vp_unhandled_input")
+	methodBindViewportFindWorld                     = gdnative.NewLazyMethodBind("Viewport", "find_world")
+	methodBindViewportFindWorld2D                   = gdnative.NewLazyMethodBind("Viewport", "find_world_2d")
+	methodBindViewportGetCamera                     = gdnative.NewLazyMethodBind("Viewport", "get_camera")
+	methodBindViewportGetCanvasTransform            = gdnative.NewLazyMethodBind("Viewport", "get_canvas_transform")
+	methodBindViewportGetClearMode                  = gdnative.NewLazyMethodBind("Viewport", "get_clear_mode")
+	methodBindViewportGetDebugDraw                  = gdnative.NewLazyMethodBind("Viewport", "get_debug_draw")
+	methodBindViewportGetFinalTransform             = gdnative.NewLazyMethodBind("Viewport", "get_final_transform")
+	methodBindViewportGetGlobalCanvasTransform      = gdnative.NewLazyMethodBind("Viewport", "get_global_canvas_transform")
+	methodBindViewportGetHdr                        = gdnative.NewLazyMethodBind("Viewport", "get_hdr")
+	methodBindViewportGetMousePosition              = gdnative.NewLazyMethodBind("Viewport", "get_mouse_position")
+	methodBindViewportGetMsaa                       = gdnative.NewLazyMethodBind("Viewport", "get_msaa")
+	methodBindViewportGetPhysicsObjectPicking       = gdnative.NewLazyMethodBind("Viewport", "get_physics_object_picking")
+	methodBindViewportGetRenderInfo                 = gdnative.NewLazyMethodBind("Viewport", "get_render_info")
+	methodBindViewportGetShadowAtlasQuadrantSubdiv  = gdnative.NewLazyMethodBind("Viewport", "get_shadow_atlas_quadrant_subdiv")
+	methodBindViewportGetShadowAtlasSize            = gdnative.NewLazyMethodBind("Viewport", "get_shadow_atlas_size")
+	methodBindViewportGetSize                       = gdnative.NewLazyMethodBind("Viewport", "get_size")
+	methodBindViewportGetSizeOverride               = gdnative.NewLazyMethodBind("Viewport", "get_size_override")
+	methodBindViewportGetTexture                    = gdnative.NewLazyMethodBind("Viewport", "get_texture")
+	methodBindViewportGetUpdateMode                 = gdnative.NewLazyMethodBind("Viewport", "get_update_mode")
+	methodBindViewportGetUsage                      = gdnative.NewLazyMethodBind("Viewport", "get_usage")
+	methodBindViewportGetVflip                      = gdnative.NewLazyMethodBind("Viewport", "get_vflip")
+	methodBindViewportGetViewportRid                = gdnative.NewLazyMethodBind("Viewport", "get_viewport_rid")
+	methodBindViewportGetVisibleRect                = gdnative.NewLazyMethodBind("Viewport", "get_visible_rect")
+	methodBindViewportGetWorld                      = gdnative.NewLazyMethodBind("Viewport", "get_world")
+	methodBindViewportGetWorld2D                    = gdnative.NewLazyMethodBind("Viewport", "get_world_2d")
+	methodBindViewportGuiGetDragData                = gdnative.NewLazyMethodBind("Viewport", "gui_get_drag_data")
+	methodBindViewportGuiHasModalStack              = gdnative.NewLazyMethodBind("Viewport", "gui_has_modal_stack")
+	methodBindViewportHasTransparentBackground      = gdnative.NewLazyMethodBind("Viewport", "has_transparent_background")
+	methodBindViewportInputMethod                   = gdnative.NewLazyMethodBind("Viewport", "input")
+	methodBindViewportIs3DDisabled                  = gdnative.NewLazyMethodBind("Viewport", "is_3d_disabled")
+	methodBindViewportIsAudioListener               = gdnative.NewLazyMethodBind("Viewport", "is_audio_listener")
+	methodBindViewportIsAudioListener2D             = gdnative.NewLazyMethodBind("Viewport", "is_audio_listener_2d")
+	methodBindViewportIsInputDisabled               = gdnative.NewLazyMethodBind("Viewport", "is_input_disabled")
+	methodBindViewportIsSizeOverrideEnabled         = gdnative.NewLazyMethodBind("Viewport", "is_size_override_enabled")
+	methodBindViewportIsSizeOverrideStretchEnabled  = gdnative.NewLazyMethodBind("Viewport", "is_size_override_stretch_enabled")
+	methodBindViewportIsSnapControlsToPixelsEnabled = gdnative.NewLazyMethodBind("Viewport", "is_snap_controls_to_pixels_enabled")
+	methodBindViewportIsUsingOwnWorld               = gdnative.NewLazyMethodBind("Viewport", "is_using_own_world")
+	methodBindViewportSetAsAudioListener            = gdnative.NewLazyMethodBind("Viewport", "set_as_audio_listener")
+	methodBindViewportSetAsAudioListener2D          = gdnative.NewLazyMethodBind("Viewport", "set_as_audio_listener_2d")
+	methodBindViewportSetAttachToScreenRect         = gdnative.NewLazyMethodBind("Viewport", "set_attach_to_screen_rect")
+	methodBindViewportSetCanvasTransform            = gdnative.NewLazyMethodBind("Viewport", "set_canvas_transform")
+	methodBindViewportSetClearMode                  = gdnative.NewLazyMethodBind("Viewport", "set_clear_mode")
+	methodBindViewportSetDebugDraw                  = gdnative.NewLazyMethodBind("Viewport", "set_debug_draw")
+	methodBindViewportSetDisable3D                  = gdnative.NewLazyMethodBind("Viewport", "set_disable_3d")
+	methodBindViewportSetDisableInput               = gdnative.NewLazyMethodBind("Viewport", "set_disable_input")
+	methodBindViewportSetGlobalCanvasTransform      = gdnative.NewLazyMethodBind("Viewport", "set_global_canvas_transform")
+	methodBindViewportSetHdr                        = gdnative.NewLazyMethodBind("Viewport", "set_hdr")
+	methodBindViewportSetMsaa                       = gdnative.NewLazyMethodBind("Viewport", "set_msaa")
+	methodBindViewportSetPhysicsObjectPicking       = gdnative.NewLazyMethodBind("Viewport", "set_physics_object_picking")
+	methodBindViewportSetShadowAtlasQuadrantSubdiv  = gdnative.NewLazyMethodBind("Viewport", "set_shadow_atlas_quadrant_subdiv")
+	methodBindViewportSetShadowAtlasSize            = gdnative.NewLazyMethodBind("Viewport", "set_shadow_atlas_size")
+	methodBindViewportSetSize                       = gdnative.NewLazyMethodBind("Viewport", "set_size")
+	methodBindViewportSetSizeOverride               = gdnative.NewLazyMethodBind("Viewport", "set_size_override")
+	methodBindViewportSetSizeOverrideStretch        = gdnative.NewLazyMethodBind("Viewport", "set_size_override_stretch")
+	methodBindViewportSetSnapControlsToPixels       = gdnative.NewLazyMethodBind("Viewport", "set_snap_controls_to_pixels")
+	methodBindViewportSetTransparentBackground      = gdnative.NewLazyMethodBind("Viewport", "set_transparent_background")
+	methodBindViewportSetUpdateMode                 = gdnative.NewLazyMethodBind("Viewport", "set_update_mode")
+	methodBindViewportSetUsage                      = gdnative.NewLazyMethodBind("Viewport", "set_usage")
+	methodBindViewportSetUseArvr                    = gdnative.NewLazyMethodBind("Viewport", "set_use_arvr")
+	methodBindViewportSetUseOwnWorld                = gdnative.NewLazyMethodBind("Viewport", "set_use_own_world")
+	methodBindViewportSetVflip                      = gdnative.NewLazyMethodBind("Viewport", "set_vflip")
+	methodBindViewportSetWorld                      = gdnative.NewLazyMethodBind("Viewport", "set_world")
+	methodBindViewportSetWorld2D                    = gdnative.NewLazyMethodBind("Viewport", "set_world_2d")
+	methodBindViewportUnhandledInput                = gdnative.NewLazyMethodBind("Viewport", "unhandled_input")
+	methodBindViewportUpdateWorlds                  = gdnative.NewLazyMethodBind("Viewport", "update_worlds")
+	methodBindViewportUseArvr                       = gdnative.NewLazyMethodBind("Viewport", "use_arvr")
+	methodBindViewportWarpMouse                     = gdnative.NewLazyMethodBind("Viewport", "warp_mouse")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Viewport) X_GuiRemoveFocus() {
 	//log.Println("Calling Viewport.X_GuiRemoveFocus()")
@@ -122,7 +444,7 @@ func (o *Viewport) X_GuiRemoveFocus() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "_gui_remove_focus")
+	methodBind := methodBindViewportX_GuiRemoveFocus.Get()
 
 	// Call the parent method.
 	// void
@@ -132,8 +454,8 @@ func (o *Viewport) X_GuiRemoveFocus() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *Viewport) X_GuiShowTooltip() {
 	//log.Println("Calling Viewport.X_GuiShowTooltip()")
@@ -142,7 +464,7 @@ func (o *Viewport) X_GuiShowTooltip() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "_gui_show_tooltip")
+	methodBind := methodBindViewportX_GuiShowTooltip.Get()
 
 	// Call the parent method.
 	// void
@@ -152,18 +474,18 @@ func (o *Viewport) X_GuiShowTooltip() {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
 func (o *Viewport) X_VpInput(arg0 InputEventImplementer) {
 	//log.Println("Calling Viewport.X_VpInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "_vp_input")
+	methodBind := methodBindViewportX_VpInput.Get()
 
 	// Call the parent method.
 	// void
@@ -173,8 +495,8 @@ func (o *Viewport) X_VpInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false text String}], Returns: void
+	        Undocumented
+		Args: [{ false text String}], Returns: void
 */
 func (o *Viewport) X_VpInputText(text gdnative.String) {
 	//log.Println("Calling Viewport.X_VpInputText()")
@@ -184,7 +506,7 @@ func (o *Viewport) X_VpInputText(text gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(text)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "_vp_input_text")
+	methodBind := methodBindViewportX_VpInputText.Get()
 
 	// Call the parent method.
 	// void
@@ -194,18 +516,18 @@ func (o *Viewport) X_VpInputText(text gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 InputEvent}], Returns: void
 */
 func (o *Viewport) X_VpUnhandledInput(arg0 InputEventImplementer) {
 	//log.Println("Calling Viewport.X_VpUnhandledInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(arg0.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(arg0))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "_vp_unhandled_input")
+	methodBind := methodBindViewportX_VpUnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -215,8 +537,8 @@ func (o *Viewport) X_VpUnhandledInput(arg0 InputEventImplementer) {
 }
 
 /*
-        Return the 3D world of the viewport, or if no such present, the one of the parent viewport.
-	Args: [], Returns: World
+	        Return the 3D world of the viewport, or if no such present, the one of the parent viewport.
+		Args: [], Returns: World
 */
 func (o *Viewport) FindWorld() WorldImplementer {
 	//log.Println("Calling Viewport.FindWorld()")
@@ -225,7 +547,7 @@ func (o *Viewport) FindWorld() WorldImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "find_world")
+	methodBind := methodBindViewportFindWorld.Get()
 
 	// Call the parent method.
 	// World
@@ -233,27 +555,25 @@ func (o *Viewport) FindWorld() WorldImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newWorldFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(WorldImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "World" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(WorldImplementer)
+	ret := World{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(WorldImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the 2D world of the viewport.
-	Args: [], Returns: World2D
+	        Return the 2D world of the viewport.
+		Args: [], Returns: World2D
 */
 func (o *Viewport) FindWorld2D() World2DImplementer {
 	//log.Println("Calling Viewport.FindWorld2D()")
@@ -262,7 +582,7 @@ func (o *Viewport) FindWorld2D() World2DImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "find_world_2d")
+	methodBind := methodBindViewportFindWorld2D.Get()
 
 	// Call the parent method.
 	// World2D
@@ -270,27 +590,25 @@ func (o *Viewport) FindWorld2D() World2DImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newWorld2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(World2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "World2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(World2DImplementer)
+	ret := World2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(World2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Return the active 3D camera.
-	Args: [], Returns: Camera
+	        Return the active 3D camera.
+		Args: [], Returns: Camera
 */
 func (o *Viewport) GetCamera() CameraImplementer {
 	//log.Println("Calling Viewport.GetCamera()")
@@ -299,7 +617,7 @@ func (o *Viewport) GetCamera() CameraImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_camera")
+	methodBind := methodBindViewportGetCamera.Get()
 
 	// Call the parent method.
 	// Camera
@@ -307,27 +625,25 @@ func (o *Viewport) GetCamera() CameraImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newCameraFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(CameraImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Camera" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(CameraImplementer)
+	ret := Camera{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(CameraImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform2D
+	        Undocumented
+		Args: [], Returns: Transform2D
 */
 func (o *Viewport) GetCanvasTransform() gdnative.Transform2D {
 	//log.Println("Calling Viewport.GetCanvasTransform()")
@@ -336,7 +652,7 @@ func (o *Viewport) GetCanvasTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_canvas_transform")
+	methodBind := methodBindViewportGetCanvasTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -349,8 +665,8 @@ func (o *Viewport) GetCanvasTransform() gdnative.Transform2D {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Viewport::ClearMode
+	        Undocumented
+		Args: [], Returns: enum.Viewport::ClearMode
 */
 func (o *Viewport) GetClearMode() ViewportClearMode {
 	//log.Println("Calling Viewport.GetClearMode()")
@@ -359,7 +675,7 @@ func (o *Viewport) GetClearMode() ViewportClearMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_clear_mode")
+	methodBind := methodBindViewportGetClearMode.Get()
 
 	// Call the parent method.
 	// enum.Viewport::ClearMode
@@ -372,8 +688,8 @@ func (o *Viewport) GetClearMode() ViewportClearMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Viewport::DebugDraw
+	        Undocumented
+		Args: [], Returns: enum.Viewport::DebugDraw
 */
 func (o *Viewport) GetDebugDraw() ViewportDebugDraw {
 	//log.Println("Calling Viewport.GetDebugDraw()")
@@ -382,7 +698,7 @@ func (o *Viewport) GetDebugDraw() ViewportDebugDraw {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_debug_draw")
+	methodBind := methodBindViewportGetDebugDraw.Get()
 
 	// Call the parent method.
 	// enum.Viewport::DebugDraw
@@ -395,8 +711,8 @@ func (o *Viewport) GetDebugDraw() ViewportDebugDraw {
 }
 
 /*
-        Get the total transform of the viewport.
-	Args: [], Returns: Transform2D
+	        Get the total transform of the viewport.
+		Args: [], Returns: Transform2D
 */
 func (o *Viewport) GetFinalTransform() gdnative.Transform2D {
 	//log.Println("Calling Viewport.GetFinalTransform()")
@@ -405,7 +721,7 @@ func (o *Viewport) GetFinalTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_final_transform")
+	methodBind := methodBindViewportGetFinalTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -418,8 +734,8 @@ func (o *Viewport) GetFinalTransform() gdnative.Transform2D {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Transform2D
+	        Undocumented
+		Args: [], Returns: Transform2D
 */
 func (o *Viewport) GetGlobalCanvasTransform() gdnative.Transform2D {
 	//log.Println("Calling Viewport.GetGlobalCanvasTransform()")
@@ -428,7 +744,7 @@ func (o *Viewport) GetGlobalCanvasTransform() gdnative.Transform2D {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_global_canvas_transform")
+	methodBind := methodBindViewportGetGlobalCanvasTransform.Get()
 
 	// Call the parent method.
 	// Transform2D
@@ -441,8 +757,8 @@ func (o *Viewport) GetGlobalCanvasTransform() gdnative.Transform2D {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) GetHdr() gdnative.Bool {
 	//log.Println("Calling Viewport.GetHdr()")
@@ -451,7 +767,7 @@ func (o *Viewport) GetHdr() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_hdr")
+	methodBind := methodBindViewportGetHdr.Get()
 
 	// Call the parent method.
 	// bool
@@ -464,8 +780,8 @@ func (o *Viewport) GetHdr() gdnative.Bool {
 }
 
 /*
-        Get the mouse position, relative to the viewport.
-	Args: [], Returns: Vector2
+	        Get the mouse position, relative to the viewport.
+		Args: [], Returns: Vector2
 */
 func (o *Viewport) GetMousePosition() gdnative.Vector2 {
 	//log.Println("Calling Viewport.GetMousePosition()")
@@ -474,7 +790,7 @@ func (o *Viewport) GetMousePosition() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_mouse_position")
+	methodBind := methodBindViewportGetMousePosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -487,8 +803,8 @@ func (o *Viewport) GetMousePosition() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Viewport::MSAA
+	        Undocumented
+		Args: [], Returns: enum.Viewport::MSAA
 */
 func (o *Viewport) GetMsaa() ViewportMSAA {
 	//log.Println("Calling Viewport.GetMsaa()")
@@ -497,7 +813,7 @@ func (o *Viewport) GetMsaa() ViewportMSAA {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_msaa")
+	methodBind := methodBindViewportGetMsaa.Get()
 
 	// Call the parent method.
 	// enum.Viewport::MSAA
@@ -510,8 +826,8 @@ func (o *Viewport) GetMsaa() ViewportMSAA {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) GetPhysicsObjectPicking() gdnative.Bool {
 	//log.Println("Calling Viewport.GetPhysicsObjectPicking()")
@@ -520,7 +836,7 @@ func (o *Viewport) GetPhysicsObjectPicking() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_physics_object_picking")
+	methodBind := methodBindViewportGetPhysicsObjectPicking.Get()
 
 	// Call the parent method.
 	// bool
@@ -533,8 +849,8 @@ func (o *Viewport) GetPhysicsObjectPicking() gdnative.Bool {
 }
 
 /*
-        Get the specific information about the viewport from rendering pipeline.
-	Args: [{ false info int}], Returns: int
+	        Get the specific information about the viewport from rendering pipeline.
+		Args: [{ false info int}], Returns: int
 */
 func (o *Viewport) GetRenderInfo(info gdnative.Int) gdnative.Int {
 	//log.Println("Calling Viewport.GetRenderInfo()")
@@ -544,7 +860,7 @@ func (o *Viewport) GetRenderInfo(info gdnative.Int) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromInt(info)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_render_info")
+	methodBind := methodBindViewportGetRenderInfo.Get()
 
 	// Call the parent method.
 	// int
@@ -557,8 +873,8 @@ func (o *Viewport) GetRenderInfo(info gdnative.Int) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false quadrant int}], Returns: enum.Viewport::ShadowAtlasQuadrantSubdiv
+	        Undocumented
+		Args: [{ false quadrant int}], Returns: enum.Viewport::ShadowAtlasQuadrantSubdiv
 */
 func (o *Viewport) GetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int) ViewportShadowAtlasQuadrantSubdiv {
 	//log.Println("Calling Viewport.GetShadowAtlasQuadrantSubdiv()")
@@ -568,7 +884,7 @@ func (o *Viewport) GetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int) ViewportS
 	ptrArguments[0] = gdnative.NewPointerFromInt(quadrant)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_shadow_atlas_quadrant_subdiv")
+	methodBind := methodBindViewportGetShadowAtlasQuadrantSubdiv.Get()
 
 	// Call the parent method.
 	// enum.Viewport::ShadowAtlasQuadrantSubdiv
@@ -581,8 +897,8 @@ func (o *Viewport) GetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int) ViewportS
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *Viewport) GetShadowAtlasSize() gdnative.Int {
 	//log.Println("Calling Viewport.GetShadowAtlasSize()")
@@ -591,7 +907,7 @@ func (o *Viewport) GetShadowAtlasSize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_shadow_atlas_size")
+	methodBind := methodBindViewportGetShadowAtlasSize.Get()
 
 	// Call the parent method.
 	// int
@@ -604,8 +920,8 @@ func (o *Viewport) GetShadowAtlasSize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *Viewport) GetSize() gdnative.Vector2 {
 	//log.Println("Calling Viewport.GetSize()")
@@ -614,7 +930,7 @@ func (o *Viewport) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_size")
+	methodBind := methodBindViewportGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -627,8 +943,8 @@ func (o *Viewport) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Get the size override set with [method set_size_override].
-	Args: [], Returns: Vector2
+	        Get the size override set with [method set_size_override].
+		Args: [], Returns: Vector2
 */
 func (o *Viewport) GetSizeOverride() gdnative.Vector2 {
 	//log.Println("Calling Viewport.GetSizeOverride()")
@@ -637,7 +953,7 @@ func (o *Viewport) GetSizeOverride() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_size_override")
+	methodBind := methodBindViewportGetSizeOverride.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -650,8 +966,8 @@ func (o *Viewport) GetSizeOverride() gdnative.Vector2 {
 }
 
 /*
-        Get the viewport's texture, for use with various objects that you want to texture with the viewport.
-	Args: [], Returns: ViewportTexture
+	        Get the viewport's texture, for use with various objects that you want to texture with the viewport.
+		Args: [], Returns: ViewportTexture
 */
 func (o *Viewport) GetTexture() ViewportTextureImplementer {
 	//log.Println("Calling Viewport.GetTexture()")
@@ -660,7 +976,7 @@ func (o *Viewport) GetTexture() ViewportTextureImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_texture")
+	methodBind := methodBindViewportGetTexture.Get()
 
 	// Call the parent method.
 	// ViewportTexture
@@ -668,27 +984,25 @@ func (o *Viewport) GetTexture() ViewportTextureImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newViewportTextureFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(ViewportTextureImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "ViewportTexture" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(ViewportTextureImplementer)
+	ret := ViewportTexture{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(ViewportTextureImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Viewport::UpdateMode
+	        Undocumented
+		Args: [], Returns: enum.Viewport::UpdateMode
 */
 func (o *Viewport) GetUpdateMode() ViewportUpdateMode {
 	//log.Println("Calling Viewport.GetUpdateMode()")
@@ -697,7 +1011,7 @@ func (o *Viewport) GetUpdateMode() ViewportUpdateMode {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_update_mode")
+	methodBind := methodBindViewportGetUpdateMode.Get()
 
 	// Call the parent method.
 	// enum.Viewport::UpdateMode
@@ -710,8 +1024,8 @@ func (o *Viewport) GetUpdateMode() ViewportUpdateMode {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Viewport::Usage
+	        Undocumented
+		Args: [], Returns: enum.Viewport::Usage
 */
 func (o *Viewport) GetUsage() ViewportUsage {
 	//log.Println("Calling Viewport.GetUsage()")
@@ -720,7 +1034,7 @@ func (o *Viewport) GetUsage() ViewportUsage {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_usage")
+	methodBind := methodBindViewportGetUsage.Get()
 
 	// Call the parent method.
 	// enum.Viewport::Usage
@@ -733,8 +1047,8 @@ func (o *Viewport) GetUsage() ViewportUsage {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) GetVflip() gdnative.Bool {
 	//log.Println("Calling Viewport.GetVflip()")
@@ -743,7 +1057,7 @@ func (o *Viewport) GetVflip() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_vflip")
+	methodBind := methodBindViewportGetVflip.Get()
 
 	// Call the parent method.
 	// bool
@@ -756,8 +1070,8 @@ func (o *Viewport) GetVflip() gdnative.Bool {
 }
 
 /*
-        Get the viewport RID from the [VisualServer].
-	Args: [], Returns: RID
+	        Get the viewport RID from the [VisualServer].
+		Args: [], Returns: RID
 */
 func (o *Viewport) GetViewportRid() gdnative.Rid {
 	//log.Println("Calling Viewport.GetViewportRid()")
@@ -766,7 +1080,7 @@ func (o *Viewport) GetViewportRid() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_viewport_rid")
+	methodBind := methodBindViewportGetViewportRid.Get()
 
 	// Call the parent method.
 	// RID
@@ -779,8 +1093,8 @@ func (o *Viewport) GetViewportRid() gdnative.Rid {
 }
 
 /*
-        Return the final, visible rect in global screen coordinates.
-	Args: [], Returns: Rect2
+	        Return the final, visible rect in global screen coordinates.
+		Args: [], Returns: Rect2
 */
 func (o *Viewport) GetVisibleRect() gdnative.Rect2 {
 	//log.Println("Calling Viewport.GetVisibleRect()")
@@ -789,7 +1103,7 @@ func (o *Viewport) GetVisibleRect() gdnative.Rect2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_visible_rect")
+	methodBind := methodBindViewportGetVisibleRect.Get()
 
 	// Call the parent method.
 	// Rect2
@@ -802,8 +1116,8 @@ func (o *Viewport) GetVisibleRect() gdnative.Rect2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: World
+	        Undocumented
+		Args: [], Returns: World
 */
 func (o *Viewport) GetWorld() WorldImplementer {
 	//log.Println("Calling Viewport.GetWorld()")
@@ -812,7 +1126,7 @@ func (o *Viewport) GetWorld() WorldImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_world")
+	methodBind := methodBindViewportGetWorld.Get()
 
 	// Call the parent method.
 	// World
@@ -820,27 +1134,25 @@ func (o *Viewport) GetWorld() WorldImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newWorldFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(WorldImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "World" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(WorldImplementer)
+	ret := World{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(WorldImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: World2D
+	        Undocumented
+		Args: [], Returns: World2D
 */
 func (o *Viewport) GetWorld2D() World2DImplementer {
 	//log.Println("Calling Viewport.GetWorld2D()")
@@ -849,7 +1161,7 @@ func (o *Viewport) GetWorld2D() World2DImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "get_world_2d")
+	methodBind := methodBindViewportGetWorld2D.Get()
 
 	// Call the parent method.
 	// World2D
@@ -857,27 +1169,25 @@ func (o *Viewport) GetWorld2D() World2DImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newWorld2DFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(World2DImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "World2D" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(World2DImplementer)
+	ret := World2D{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(World2DImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Returns the drag data from the GUI, that was previously returned by [method Control.get_drag_data].
-	Args: [], Returns: Variant
+	        Returns the drag data from the GUI, that was previously returned by [method Control.get_drag_data].
+		Args: [], Returns: Variant
 */
 func (o *Viewport) GuiGetDragData() gdnative.Variant {
 	//log.Println("Calling Viewport.GuiGetDragData()")
@@ -886,7 +1196,7 @@ func (o *Viewport) GuiGetDragData() gdnative.Variant {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "gui_get_drag_data")
+	methodBind := methodBindViewportGuiGetDragData.Get()
 
 	// Call the parent method.
 	// Variant
@@ -899,8 +1209,8 @@ func (o *Viewport) GuiGetDragData() gdnative.Variant {
 }
 
 /*
-        Returns whether there are shown modals on-screen.
-	Args: [], Returns: bool
+	        Returns whether there are shown modals on-screen.
+		Args: [], Returns: bool
 */
 func (o *Viewport) GuiHasModalStack() gdnative.Bool {
 	//log.Println("Calling Viewport.GuiHasModalStack()")
@@ -909,7 +1219,7 @@ func (o *Viewport) GuiHasModalStack() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "gui_has_modal_stack")
+	methodBind := methodBindViewportGuiHasModalStack.Get()
 
 	// Call the parent method.
 	// bool
@@ -922,8 +1232,8 @@ func (o *Viewport) GuiHasModalStack() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) HasTransparentBackground() gdnative.Bool {
 	//log.Println("Calling Viewport.HasTransparentBackground()")
@@ -932,7 +1242,7 @@ func (o *Viewport) HasTransparentBackground() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "has_transparent_background")
+	methodBind := methodBindViewportHasTransparentBackground.Get()
 
 	// Call the parent method.
 	// bool
@@ -945,18 +1255,17 @@ func (o *Viewport) HasTransparentBackground() gdnative.Bool {
 }
 
 /*
-
-	Args: [{ false local_event InputEvent}], Returns: void
+Args: [{ false local_event InputEvent}], Returns: void
 */
 func (o *Viewport) InputMethod(localEvent InputEventImplementer) {
 	//log.Println("Calling Viewport.InputMethod()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(localEvent.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(localEvent))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "input")
+	methodBind := methodBindViewportInputMethod.Get()
 
 	// Call the parent method.
 	// void
@@ -966,8 +1275,8 @@ func (o *Viewport) InputMethod(localEvent InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) Is3DDisabled() gdnative.Bool {
 	//log.Println("Calling Viewport.Is3DDisabled()")
@@ -976,7 +1285,7 @@ func (o *Viewport) Is3DDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_3d_disabled")
+	methodBind := methodBindViewportIs3DDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -989,8 +1298,8 @@ func (o *Viewport) Is3DDisabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) IsAudioListener() gdnative.Bool {
 	//log.Println("Calling Viewport.IsAudioListener()")
@@ -999,7 +1308,7 @@ func (o *Viewport) IsAudioListener() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_audio_listener")
+	methodBind := methodBindViewportIsAudioListener.Get()
 
 	// Call the parent method.
 	// bool
@@ -1012,8 +1321,8 @@ func (o *Viewport) IsAudioListener() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) IsAudioListener2D() gdnative.Bool {
 	//log.Println("Calling Viewport.IsAudioListener2D()")
@@ -1022,7 +1331,7 @@ func (o *Viewport) IsAudioListener2D() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_audio_listener_2d")
+	methodBind := methodBindViewportIsAudioListener2D.Get()
 
 	// Call the parent method.
 	// bool
@@ -1035,8 +1344,8 @@ func (o *Viewport) IsAudioListener2D() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) IsInputDisabled() gdnative.Bool {
 	//log.Println("Calling Viewport.IsInputDisabled()")
@@ -1045,7 +1354,7 @@ func (o *Viewport) IsInputDisabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_input_disabled")
+	methodBind := methodBindViewportIsInputDisabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1058,8 +1367,8 @@ func (o *Viewport) IsInputDisabled() gdnative.Bool {
 }
 
 /*
-        Get the enabled status of the size override set with [method set_size_override].
-	Args: [], Returns: bool
+	        Get the enabled status of the size override set with [method set_size_override].
+		Args: [], Returns: bool
 */
 func (o *Viewport) IsSizeOverrideEnabled() gdnative.Bool {
 	//log.Println("Calling Viewport.IsSizeOverrideEnabled()")
@@ -1068,7 +1377,7 @@ func (o *Viewport) IsSizeOverrideEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_size_override_enabled")
+	methodBind := methodBindViewportIsSizeOverrideEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1081,8 +1390,8 @@ func (o *Viewport) IsSizeOverrideEnabled() gdnative.Bool {
 }
 
 /*
-        Get the enabled status of the size stretch override set with [method set_size_override_stretch].
-	Args: [], Returns: bool
+	        Get the enabled status of the size stretch override set with [method set_size_override_stretch].
+		Args: [], Returns: bool
 */
 func (o *Viewport) IsSizeOverrideStretchEnabled() gdnative.Bool {
 	//log.Println("Calling Viewport.IsSizeOverrideStretchEnabled()")
@@ -1091,7 +1400,7 @@ func (o *Viewport) IsSizeOverrideStretchEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_size_override_stretch_enabled")
+	methodBind := methodBindViewportIsSizeOverrideStretchEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1104,8 +1413,8 @@ func (o *Viewport) IsSizeOverrideStretchEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) IsSnapControlsToPixelsEnabled() gdnative.Bool {
 	//log.Println("Calling Viewport.IsSnapControlsToPixelsEnabled()")
@@ -1114,7 +1423,7 @@ func (o *Viewport) IsSnapControlsToPixelsEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_snap_controls_to_pixels_enabled")
+	methodBind := methodBindViewportIsSnapControlsToPixelsEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -1127,8 +1436,8 @@ func (o *Viewport) IsSnapControlsToPixelsEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) IsUsingOwnWorld() gdnative.Bool {
 	//log.Println("Calling Viewport.IsUsingOwnWorld()")
@@ -1137,7 +1446,7 @@ func (o *Viewport) IsUsingOwnWorld() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "is_using_own_world")
+	methodBind := methodBindViewportIsUsingOwnWorld.Get()
 
 	// Call the parent method.
 	// bool
@@ -1150,8 +1459,8 @@ func (o *Viewport) IsUsingOwnWorld() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Viewport) SetAsAudioListener(enable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetAsAudioListener()")
@@ -1161,7 +1470,7 @@ func (o *Viewport) SetAsAudioListener(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_as_audio_listener")
+	methodBind := methodBindViewportSetAsAudioListener.Get()
 
 	// Call the parent method.
 	// void
@@ -1171,8 +1480,8 @@ func (o *Viewport) SetAsAudioListener(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Viewport) SetAsAudioListener2D(enable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetAsAudioListener2D()")
@@ -1182,7 +1491,7 @@ func (o *Viewport) SetAsAudioListener2D(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_as_audio_listener_2d")
+	methodBind := methodBindViewportSetAsAudioListener2D.Get()
 
 	// Call the parent method.
 	// void
@@ -1192,8 +1501,7 @@ func (o *Viewport) SetAsAudioListener2D(enable gdnative.Bool) {
 }
 
 /*
-
-	Args: [{ false rect Rect2}], Returns: void
+Args: [{ false rect Rect2}], Returns: void
 */
 func (o *Viewport) SetAttachToScreenRect(rect gdnative.Rect2) {
 	//log.Println("Calling Viewport.SetAttachToScreenRect()")
@@ -1203,7 +1511,7 @@ func (o *Viewport) SetAttachToScreenRect(rect gdnative.Rect2) {
 	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_attach_to_screen_rect")
+	methodBind := methodBindViewportSetAttachToScreenRect.Get()
 
 	// Call the parent method.
 	// void
@@ -1213,8 +1521,8 @@ func (o *Viewport) SetAttachToScreenRect(rect gdnative.Rect2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false xform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false xform Transform2D}], Returns: void
 */
 func (o *Viewport) SetCanvasTransform(xform gdnative.Transform2D) {
 	//log.Println("Calling Viewport.SetCanvasTransform()")
@@ -1224,7 +1532,7 @@ func (o *Viewport) SetCanvasTransform(xform gdnative.Transform2D) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_canvas_transform")
+	methodBind := methodBindViewportSetCanvasTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1234,18 +1542,18 @@ func (o *Viewport) SetCanvasTransform(xform gdnative.Transform2D) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Viewport::ClearMode}], Returns: void
 */
-func (o *Viewport) SetClearMode(mode gdnative.Int) {
+func (o *Viewport) SetClearMode(mode ViewportClearMode) {
 	//log.Println("Calling Viewport.SetClearMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_clear_mode")
+	methodBind := methodBindViewportSetClearMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1255,18 +1563,18 @@ func (o *Viewport) SetClearMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false debug_draw int}], Returns: void
+	        Undocumented
+		Args: [{ false debug_draw enum.Viewport::DebugDraw}], Returns: void
 */
-func (o *Viewport) SetDebugDraw(debugDraw gdnative.Int) {
+func (o *Viewport) SetDebugDraw(debugDraw ViewportDebugDraw) {
 	//log.Println("Calling Viewport.SetDebugDraw()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(debugDraw)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(debugDraw))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_debug_draw")
+	methodBind := methodBindViewportSetDebugDraw.Get()
 
 	// Call the parent method.
 	// void
@@ -1276,8 +1584,8 @@ func (o *Viewport) SetDebugDraw(debugDraw gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false disable bool}], Returns: void
+	        Undocumented
+		Args: [{ false disable bool}], Returns: void
 */
 func (o *Viewport) SetDisable3D(disable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetDisable3D()")
@@ -1287,7 +1595,7 @@ func (o *Viewport) SetDisable3D(disable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_disable_3d")
+	methodBind := methodBindViewportSetDisable3D.Get()
 
 	// Call the parent method.
 	// void
@@ -1297,8 +1605,8 @@ func (o *Viewport) SetDisable3D(disable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false disable bool}], Returns: void
+	        Undocumented
+		Args: [{ false disable bool}], Returns: void
 */
 func (o *Viewport) SetDisableInput(disable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetDisableInput()")
@@ -1308,7 +1616,7 @@ func (o *Viewport) SetDisableInput(disable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(disable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_disable_input")
+	methodBind := methodBindViewportSetDisableInput.Get()
 
 	// Call the parent method.
 	// void
@@ -1318,8 +1626,8 @@ func (o *Viewport) SetDisableInput(disable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false xform Transform2D}], Returns: void
+	        Undocumented
+		Args: [{ false xform Transform2D}], Returns: void
 */
 func (o *Viewport) SetGlobalCanvasTransform(xform gdnative.Transform2D) {
 	//log.Println("Calling Viewport.SetGlobalCanvasTransform()")
@@ -1329,7 +1637,7 @@ func (o *Viewport) SetGlobalCanvasTransform(xform gdnative.Transform2D) {
 	ptrArguments[0] = gdnative.NewPointerFromTransform2D(xform)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_global_canvas_transform")
+	methodBind := methodBindViewportSetGlobalCanvasTransform.Get()
 
 	// Call the parent method.
 	// void
@@ -1339,8 +1647,8 @@ func (o *Viewport) SetGlobalCanvasTransform(xform gdnative.Transform2D) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Viewport) SetHdr(enable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetHdr()")
@@ -1350,7 +1658,7 @@ func (o *Viewport) SetHdr(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_hdr")
+	methodBind := methodBindViewportSetHdr.Get()
 
 	// Call the parent method.
 	// void
@@ -1360,18 +1668,18 @@ func (o *Viewport) SetHdr(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false msaa int}], Returns: void
+	        Undocumented
+		Args: [{ false msaa enum.Viewport::MSAA}], Returns: void
 */
-func (o *Viewport) SetMsaa(msaa gdnative.Int) {
+func (o *Viewport) SetMsaa(msaa ViewportMSAA) {
 	//log.Println("Calling Viewport.SetMsaa()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(msaa)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(msaa))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_msaa")
+	methodBind := methodBindViewportSetMsaa.Get()
 
 	// Call the parent method.
 	// void
@@ -1381,8 +1689,8 @@ func (o *Viewport) SetMsaa(msaa gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Viewport) SetPhysicsObjectPicking(enable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetPhysicsObjectPicking()")
@@ -1392,7 +1700,7 @@ func (o *Viewport) SetPhysicsObjectPicking(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_physics_object_picking")
+	methodBind := methodBindViewportSetPhysicsObjectPicking.Get()
 
 	// Call the parent method.
 	// void
@@ -1402,19 +1710,19 @@ func (o *Viewport) SetPhysicsObjectPicking(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false quadrant int} { false subdiv int}], Returns: void
+	        Undocumented
+		Args: [{ false quadrant int} { false subdiv enum.Viewport::ShadowAtlasQuadrantSubdiv}], Returns: void
 */
-func (o *Viewport) SetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int, subdiv gdnative.Int) {
+func (o *Viewport) SetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int, subdiv ViewportShadowAtlasQuadrantSubdiv) {
 	//log.Println("Calling Viewport.SetShadowAtlasQuadrantSubdiv()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 2, 2)
 	ptrArguments[0] = gdnative.NewPointerFromInt(quadrant)
-	ptrArguments[1] = gdnative.NewPointerFromInt(subdiv)
+	ptrArguments[1] = gdnative.NewPointerFromInt(gdnative.Int(subdiv))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_shadow_atlas_quadrant_subdiv")
+	methodBind := methodBindViewportSetShadowAtlasQuadrantSubdiv.Get()
 
 	// Call the parent method.
 	// void
@@ -1424,8 +1732,8 @@ func (o *Viewport) SetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int, subdiv gd
 }
 
 /*
-        Undocumented
-	Args: [{ false size int}], Returns: void
+	        Undocumented
+		Args: [{ false size int}], Returns: void
 */
 func (o *Viewport) SetShadowAtlasSize(size gdnative.Int) {
 	//log.Println("Calling Viewport.SetShadowAtlasSize()")
@@ -1435,7 +1743,7 @@ func (o *Viewport) SetShadowAtlasSize(size gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_shadow_atlas_size")
+	methodBind := methodBindViewportSetShadowAtlasSize.Get()
 
 	// Call the parent method.
 	// void
@@ -1445,8 +1753,8 @@ func (o *Viewport) SetShadowAtlasSize(size gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *Viewport) SetSize(size gdnative.Vector2) {
 	//log.Println("Calling Viewport.SetSize()")
@@ -1456,7 +1764,7 @@ func (o *Viewport) SetSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_size")
+	methodBind := methodBindViewportSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -1466,8 +1774,8 @@ func (o *Viewport) SetSize(size gdnative.Vector2) {
 }
 
 /*
-        Set the size override of the viewport. If the enable parameter is true, it would use the override, otherwise it would use the default size. If the size parameter is equal to [code](-1, -1)[/code], it won't update the size.
-	Args: [{ false enable bool} {(-1, -1) true size Vector2} {(0, 0) true margin Vector2}], Returns: void
+	        Set the size override of the viewport. If the enable parameter is true, it would use the override, otherwise it would use the default size. If the size parameter is equal to [code](-1, -1)[/code], it won't update the size.
+		Args: [{ false enable bool} {(-1, -1) true size Vector2} {(0, 0) true margin Vector2}], Returns: void
 */
 func (o *Viewport) SetSizeOverride(enable gdnative.Bool, size gdnative.Vector2, margin gdnative.Vector2) {
 	//log.Println("Calling Viewport.SetSizeOverride()")
@@ -1479,7 +1787,7 @@ func (o *Viewport) SetSizeOverride(enable gdnative.Bool, size gdnative.Vector2,
 	ptrArguments[2] = gdnative.NewPointerFromVector2(margin)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_size_override")
+	methodBind := methodBindViewportSetSizeOverride.Get()
 
 	// Call the parent method.
 	// void
@@ -1489,8 +1797,8 @@ func (o *Viewport) SetSizeOverride(enable gdnative.Bool, size gdnative.Vector2,
 }
 
 /*
-        Set whether the size override affects stretch as well.
-	Args: [{ false enabled bool}], Returns: void
+	        Set whether the size override affects stretch as well.
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Viewport) SetSizeOverrideStretch(enabled gdnative.Bool) {
 	//log.Println("Calling Viewport.SetSizeOverrideStretch()")
@@ -1500,7 +1808,7 @@ func (o *Viewport) SetSizeOverrideStretch(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_size_override_stretch")
+	methodBind := methodBindViewportSetSizeOverrideStretch.Get()
 
 	// Call the parent method.
 	// void
@@ -1510,8 +1818,8 @@ func (o *Viewport) SetSizeOverrideStretch(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enabled bool}], Returns: void
+	        Undocumented
+		Args: [{ false enabled bool}], Returns: void
 */
 func (o *Viewport) SetSnapControlsToPixels(enabled gdnative.Bool) {
 	//log.Println("Calling Viewport.SetSnapControlsToPixels()")
@@ -1521,7 +1829,7 @@ func (o *Viewport) SetSnapControlsToPixels(enabled gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enabled)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_snap_controls_to_pixels")
+	methodBind := methodBindViewportSetSnapControlsToPixels.Get()
 
 	// Call the parent method.
 	// void
@@ -1531,8 +1839,8 @@ func (o *Viewport) SetSnapControlsToPixels(enabled gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Viewport) SetTransparentBackground(enable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetTransparentBackground()")
@@ -1542,7 +1850,7 @@ func (o *Viewport) SetTransparentBackground(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_transparent_background")
+	methodBind := methodBindViewportSetTransparentBackground.Get()
 
 	// Call the parent method.
 	// void
@@ -1552,18 +1860,18 @@ func (o *Viewport) SetTransparentBackground(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.Viewport::UpdateMode}], Returns: void
 */
-func (o *Viewport) SetUpdateMode(mode gdnative.Int) {
+func (o *Viewport) SetUpdateMode(mode ViewportUpdateMode) {
 	//log.Println("Calling Viewport.SetUpdateMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_update_mode")
+	methodBind := methodBindViewportSetUpdateMode.Get()
 
 	// Call the parent method.
 	// void
@@ -1573,18 +1881,18 @@ func (o *Viewport) SetUpdateMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false usage int}], Returns: void
+	        Undocumented
+		Args: [{ false usage enum.Viewport::Usage}], Returns: void
 */
-func (o *Viewport) SetUsage(usage gdnative.Int) {
+func (o *Viewport) SetUsage(usage ViewportUsage) {
 	//log.Println("Calling Viewport.SetUsage()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(usage)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(usage))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_usage")
+	methodBind := methodBindViewportSetUsage.Get()
 
 	// Call the parent method.
 	// void
@@ -1594,8 +1902,8 @@ func (o *Viewport) SetUsage(usage gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false use bool}], Returns: void
+	        Undocumented
+		Args: [{ false use bool}], Returns: void
 */
 func (o *Viewport) SetUseArvr(use gdnative.Bool) {
 	//log.Println("Calling Viewport.SetUseArvr()")
@@ -1605,7 +1913,7 @@ func (o *Viewport) SetUseArvr(use gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(use)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_use_arvr")
+	methodBind := methodBindViewportSetUseArvr.Get()
 
 	// Call the parent method.
 	// void
@@ -1615,8 +1923,8 @@ func (o *Viewport) SetUseArvr(use gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Viewport) SetUseOwnWorld(enable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetUseOwnWorld()")
@@ -1626,7 +1934,7 @@ func (o *Viewport) SetUseOwnWorld(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_use_own_world")
+	methodBind := methodBindViewportSetUseOwnWorld.Get()
 
 	// Call the parent method.
 	// void
@@ -1636,8 +1944,8 @@ func (o *Viewport) SetUseOwnWorld(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *Viewport) SetVflip(enable gdnative.Bool) {
 	//log.Println("Calling Viewport.SetVflip()")
@@ -1647,7 +1955,7 @@ func (o *Viewport) SetVflip(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_vflip")
+	methodBind := methodBindViewportSetVflip.Get()
 
 	// Call the parent method.
 	// void
@@ -1657,18 +1965,18 @@ func (o *Viewport) SetVflip(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false world World}], Returns: void
+	        Undocumented
+		Args: [{ false world World}], Returns: void
 */
 func (o *Viewport) SetWorld(world WorldImplementer) {
 	//log.Println("Calling Viewport.SetWorld()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(world.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(world))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_world")
+	methodBind := methodBindViewportSetWorld.Get()
 
 	// Call the parent method.
 	// void
@@ -1678,18 +1986,18 @@ func (o *Viewport) SetWorld(world WorldImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false world_2d World2D}], Returns: void
+	        Undocumented
+		Args: [{ false world_2d World2D}], Returns: void
 */
 func (o *Viewport) SetWorld2D(world2D World2DImplementer) {
 	//log.Println("Calling Viewport.SetWorld2D()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(world2D.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(world2D))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "set_world_2d")
+	methodBind := methodBindViewportSetWorld2D.Get()
 
 	// Call the parent method.
 	// void
@@ -1699,18 +2007,17 @@ func (o *Viewport) SetWorld2D(world2D World2DImplementer) {
 }
 
 /*
-
-	Args: [{ false local_event InputEvent}], Returns: void
+Args: [{ false local_event InputEvent}], Returns: void
 */
 func (o *Viewport) UnhandledInput(localEvent InputEventImplementer) {
 	//log.Println("Calling Viewport.UnhandledInput()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(localEvent.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(localEvent))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "unhandled_input")
+	methodBind := methodBindViewportUnhandledInput.Get()
 
 	// Call the parent method.
 	// void
@@ -1720,8 +2027,8 @@ func (o *Viewport) UnhandledInput(localEvent InputEventImplementer) {
 }
 
 /*
-        Force update of the 2D and 3D worlds.
-	Args: [], Returns: void
+	        Force update of the 2D and 3D worlds.
+		Args: [], Returns: void
 */
 func (o *Viewport) UpdateWorlds() {
 	//log.Println("Calling Viewport.UpdateWorlds()")
@@ -1730,7 +2037,7 @@ func (o *Viewport) UpdateWorlds() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "update_worlds")
+	methodBind := methodBindViewportUpdateWorlds.Get()
 
 	// Call the parent method.
 	// void
@@ -1740,8 +2047,8 @@ func (o *Viewport) UpdateWorlds() {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *Viewport) UseArvr() gdnative.Bool {
 	//log.Println("Calling Viewport.UseArvr()")
@@ -1750,7 +2057,7 @@ func (o *Viewport) UseArvr() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "use_arvr")
+	methodBind := methodBindViewportUseArvr.Get()
 
 	// Call the parent method.
 	// bool
@@ -1763,8 +2070,8 @@ func (o *Viewport) UseArvr() gdnative.Bool {
 }
 
 /*
-        Warp the mouse to a position, relative to the viewport.
-	Args: [{ false to_position Vector2}], Returns: void
+	        Warp the mouse to a position, relative to the viewport.
+		Args: [{ false to_position Vector2}], Returns: void
 */
 func (o *Viewport) WarpMouse(toPosition gdnative.Vector2) {
 	//log.Println("Calling Viewport.WarpMouse()")
@@ -1774,7 +2081,7 @@ func (o *Viewport) WarpMouse(toPosition gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(toPosition)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("Viewport", "warp_mouse")
+	methodBind := methodBindViewportWarpMouse.Get()
 
 	// Call the parent method.
 	// void
@@ -1783,6 +2090,232 @@ func (o *Viewport) WarpMouse(toPosition gdnative.Vector2) {
 
 }
 
+// SetSizeOverrideWithDefaults will call SetSizeOverride using the default values for: size, margin.
+func (o *Viewport) SetSizeOverrideWithDefaults(enable gdnative.Bool) {
+	o.SetSizeOverride(enable, gdnative.NewVector2(-1, -1), gdnative.NewVector2(0, 0))
+}
+
+// Arvr will return the value of the "arvr" property.
+func (o *Viewport) Arvr() gdnative.Bool {
+	return o.UseArvr()
+}
+
+// SetArvr will set the value of the "arvr" property.
+func (o *Viewport) SetArvr(value gdnative.Bool) {
+	o.SetUseArvr(value)
+}
+
+// AudioListenerEnable2D will return the value of the "audio_listener_enable_2d" property.
+func (o *Viewport) AudioListenerEnable2D() gdnative.Bool {
+	return o.IsAudioListener2D()
+}
+
+// SetAudioListenerEnable2D will set the value of the "audio_listener_enable_2d" property.
+func (o *Viewport) SetAudioListenerEnable2D(value gdnative.Bool) {
+	o.SetAsAudioListener2D(value)
+}
+
+// AudioListenerEnable3D will return the value of the "audio_listener_enable_3d" property.
+func (o *Viewport) AudioListenerEnable3D() gdnative.Bool {
+	return o.IsAudioListener()
+}
+
+// SetAudioListenerEnable3D will set the value of the "audio_listener_enable_3d" property.
+func (o *Viewport) SetAudioListenerEnable3D(value gdnative.Bool) {
+	o.SetAsAudioListener(value)
+}
+
+// CanvasTransform will return the value of the "canvas_transform" property.
+func (o *Viewport) CanvasTransform() gdnative.Transform2D {
+	return o.GetCanvasTransform()
+}
+
+// DebugDraw will return the value of the "debug_draw" property.
+func (o *Viewport) DebugDraw() ViewportDebugDraw {
+	return o.GetDebugDraw()
+}
+
+// Disable3D will return the value of the "disable_3d" property.
+func (o *Viewport) Disable3D() gdnative.Bool {
+	return o.Is3DDisabled()
+}
+
+// GlobalCanvasTransform will return the value of the "global_canvas_transform" property.
+func (o *Viewport) GlobalCanvasTransform() gdnative.Transform2D {
+	return o.GetGlobalCanvasTransform()
+}
+
+// GuiDisableInput will return the value of the "gui_disable_input" property.
+func (o *Viewport) GuiDisableInput() gdnative.Bool {
+	return o.IsInputDisabled()
+}
+
+// SetGuiDisableInput will set the value of the "gui_disable_input" property.
+func (o *Viewport) SetGuiDisableInput(value gdnative.Bool) {
+	o.SetDisableInput(value)
+}
+
+// GuiSnapControlsToPixels will return the value of the "gui_snap_controls_to_pixels" property.
+func (o *Viewport) GuiSnapControlsToPixels() gdnative.Bool {
+	return o.IsSnapControlsToPixelsEnabled()
+}
+
+// SetGuiSnapControlsToPixels will set the value of the "gui_snap_controls_to_pixels" property.
+func (o *Viewport) SetGuiSnapControlsToPixels(value gdnative.Bool) {
+	o.SetSnapControlsToPixels(value)
+}
+
+// Hdr will return the value of the "hdr" property.
+func (o *Viewport) Hdr() gdnative.Bool {
+	return o.GetHdr()
+}
+
+// Msaa will return the value of the "msaa" property.
+func (o *Viewport) Msaa() ViewportMSAA {
+	return o.GetMsaa()
+}
+
+// OwnWorld will return the value of the "own_world" property.
+func (o *Viewport) OwnWorld() gdnative.Bool {
+	return o.IsUsingOwnWorld()
+}
+
+// SetOwnWorld will set the value of the "own_world" property.
+func (o *Viewport) SetOwnWorld(value gdnative.Bool) {
+	o.SetUseOwnWorld(value)
+}
+
+// PhysicsObjectPicking will return the value of the "physics_object_picking" property.
+func (o *Viewport) PhysicsObjectPicking() gdnative.Bool {
+	return o.GetPhysicsObjectPicking()
+}
+
+// RenderTargetClearMode will return the value of the "render_target_clear_mode" property.
+func (o *Viewport) RenderTargetClearMode() ViewportClearMode {
+	return o.GetClearMode()
+}
+
+// SetRenderTargetClearMode will set the value of the "render_target_clear_mode" property.
+func (o *Viewport) SetRenderTargetClearMode(value ViewportClearMode) {
+	o.SetClearMode(value)
+}
+
+// RenderTargetUpdateMode will return the value of the "render_target_update_mode" property.
+func (o *Viewport) RenderTargetUpdateMode() ViewportUpdateMode {
+	return o.GetUpdateMode()
+}
+
+// SetRenderTargetUpdateMode will set the value of the "render_target_update_mode" property.
+func (o *Viewport) SetRenderTargetUpdateMode(value ViewportUpdateMode) {
+	o.SetUpdateMode(value)
+}
+
+// RenderTargetVFlip will return the value of the "render_target_v_flip" property.
+func (o *Viewport) RenderTargetVFlip() gdnative.Bool {
+	return o.GetVflip()
+}
+
+// SetRenderTargetVFlip will set the value of the "render_target_v_flip" property.
+func (o *Viewport) SetRenderTargetVFlip(value gdnative.Bool) {
+	o.SetVflip(value)
+}
+
+// ShadowAtlasQuad0 will return the value of the "shadow_atlas_quad_0" property.
+func (o *Viewport) ShadowAtlasQuad0() ViewportShadowAtlasQuadrantSubdiv {
+	return o.GetShadowAtlasQuadrantSubdiv(0)
+}
+
+// SetShadowAtlasQuad0 will set the value of the "shadow_atlas_quad_0" property.
+func (o *Viewport) SetShadowAtlasQuad0(value ViewportShadowAtlasQuadrantSubdiv) {
+	o.SetShadowAtlasQuadrantSubdiv(0, value)
+}
+
+// ShadowAtlasQuad1 will return the value of the "shadow_atlas_quad_1" property.
+func (o *Viewport) ShadowAtlasQuad1() ViewportShadowAtlasQuadrantSubdiv {
+	return o.GetShadowAtlasQuadrantSubdiv(1)
+}
+
+// SetShadowAtlasQuad1 will set the value of the "shadow_atlas_quad_1" property.
+func (o *Viewport) SetShadowAtlasQuad1(value ViewportShadowAtlasQuadrantSubdiv) {
+	o.SetShadowAtlasQuadrantSubdiv(1, value)
+}
+
+// ShadowAtlasQuad2 will return the value of the "shadow_atlas_quad_2" property.
+func (o *Viewport) ShadowAtlasQuad2() ViewportShadowAtlasQuadrantSubdiv {
+	return o.GetShadowAtlasQuadrantSubdiv(2)
+}
+
+// SetShadowAtlasQuad2 will set the value of the "shadow_atlas_quad_2" property.
+func (o *Viewport) SetShadowAtlasQuad2(value ViewportShadowAtlasQuadrantSubdiv) {
+	o.SetShadowAtlasQuadrantSubdiv(2, value)
+}
+
+// ShadowAtlasQuad3 will return the value of the "shadow_atlas_quad_3" property.
+func (o *Viewport) ShadowAtlasQuad3() ViewportShadowAtlasQuadrantSubdiv {
+	return o.GetShadowAtlasQuadrantSubdiv(3)
+}
+
+// SetShadowAtlasQuad3 will set the value of the "shadow_atlas_quad_3" property.
+func (o *Viewport) SetShadowAtlasQuad3(value ViewportShadowAtlasQuadrantSubdiv) {
+	o.SetShadowAtlasQuadrantSubdiv(3, value)
+}
+
+// ShadowAtlasSize will return the value of the "shadow_atlas_size" property.
+func (o *Viewport) ShadowAtlasSize() gdnative.Int {
+	return o.GetShadowAtlasSize()
+}
+
+// Size will return the value of the "size" property.
+func (o *Viewport) Size() gdnative.Vector2 {
+	return o.GetSize()
+}
+
+// TransparentBg will return the value of the "transparent_bg" property.
+func (o *Viewport) TransparentBg() gdnative.Bool {
+	return o.HasTransparentBackground()
+}
+
+// SetTransparentBg will set the value of the "transparent_bg" property.
+func (o *Viewport) SetTransparentBg(value gdnative.Bool) {
+	o.SetTransparentBackground(value)
+}
+
+// Usage will return the value of the "usage" property.
+func (o *Viewport) Usage() ViewportUsage {
+	return o.GetUsage()
+}
+
+// World will return the value of the "world" property.
+func (o *Viewport) World() WorldImplementer {
+	return o.GetWorld()
+}
+
+// World2D will return the value of the "world_2d" property.
+func (o *Viewport) World2D() World2DImplementer {
+	return o.GetWorld2D()
+}
+
+// Signals of the Viewport class.
+const (
+	ViewportSignalSizeChanged gdnative.String = "size_changed"
+)
+
+// ConnectSizeChanged will connect the "size_changed" signal to the given method of the target object.
+func (o *Viewport) ConnectSizeChanged(target ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(ViewportSignalSizeChanged, target, method, binds, 0)
+}
+
+// EmitSizeChanged will emit the "size_changed" signal.
+func (o *Viewport) EmitSizeChanged() error {
+
+	ret, err := o.EmitSignal(ViewportSignalSizeChanged)
+	ret.Destroy()
+	return err
+}
+
 // ViewportImplementer is an interface that implements the methods
 // of the Viewport class.
 type ViewportImplementer interface {
@@ -1796,16 +2329,22 @@ type ViewportImplementer interface {
 	FindWorld2D() World2DImplementer
 	GetCamera() CameraImplementer
 	GetCanvasTransform() gdnative.Transform2D
+	GetClearMode() ViewportClearMode
+	GetDebugDraw() ViewportDebugDraw
 	GetFinalTransform() gdnative.Transform2D
 	GetGlobalCanvasTransform() gdnative.Transform2D
 	GetHdr() gdnative.Bool
 	GetMousePosition() gdnative.Vector2
+	GetMsaa() ViewportMSAA
 	GetPhysicsObjectPicking() gdnative.Bool
 	GetRenderInfo(info gdnative.Int) gdnative.Int
+	GetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int) ViewportShadowAtlasQuadrantSubdiv
 	GetShadowAtlasSize() gdnative.Int
 	GetSize() gdnative.Vector2
 	GetSizeOverride() gdnative.Vector2
 	GetTexture() ViewportTextureImplementer
+	GetUpdateMode() ViewportUpdateMode
+	GetUsage() ViewportUsage
 	GetVflip() gdnative.Bool
 	GetViewportRid() gdnative.Rid
 	GetVisibleRect() gdnative.Rect2
@@ -1827,23 +2366,23 @@ type ViewportImplementer interface {
 	SetAsAudioListener2D(enable gdnative.Bool)
 	SetAttachToScreenRect(rect gdnative.Rect2)
 	SetCanvasTransform(xform gdnative.Transform2D)
-	SetClearMode(mode gdnative.Int)
-	SetDebugDraw(debugDraw gdnative.Int)
+	SetClearMode(mode ViewportClearMode)
+	SetDebugDraw(debugDraw ViewportDebugDraw)
 	SetDisable3D(disable gdnative.Bool)
 	SetDisableInput(disable gdnative.Bool)
 	SetGlobalCanvasTransform(xform gdnative.Transform2D)
 	SetHdr(enable gdnative.Bool)
-	SetMsaa(msaa gdnative.Int)
+	SetMsaa(msaa ViewportMSAA)
 	SetPhysicsObjectPicking(enable gdnative.Bool)
-	SetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int, subdiv gdnative.Int)
+	SetShadowAtlasQuadrantSubdiv(quadrant gdnative.Int, subdiv ViewportShadowAtlasQuadrantSubdiv)
 	SetShadowAtlasSize(size gdnative.Int)
 	SetSize(size gdnative.Vector2)
 	SetSizeOverride(enable gdnative.Bool, size gdnative.Vector2, margin gdnative.Vector2)
 	SetSizeOverrideStretch(enabled gdnative.Bool)
 	SetSnapControlsToPixels(enabled gdnative.Bool)
 	SetTransparentBackground(enable gdnative.Bool)
-	SetUpdateMode(mode gdnative.Int)
-	SetUsage(usage gdnative.Int)
+	SetUpdateMode(mode ViewportUpdateMode)
+	SetUsage(usage ViewportUsage)
 	SetUseArvr(use gdnative.Bool)
 	SetUseOwnWorld(enable gdnative.Bool)
 	SetVflip(enable gdnative.Bool)
@@ -1853,4 +2392,47 @@ type ViewportImplementer interface {
 	UpdateWorlds()
 	UseArvr() gdnative.Bool
 	WarpMouse(toPosition gdnative.Vector2)
+	SetSizeOverrideWithDefaults(enable gdnative.Bool)
+	Arvr() gdnative.Bool
+	SetArvr(value gdnative.Bool)
+	AudioListenerEnable2D() gdnative.Bool
+	SetAudioListenerEnable2D(value gdnative.Bool)
+	AudioListenerEnable3D() gdnative.Bool
+	SetAudioListenerEnable3D(value gdnative.Bool)
+	CanvasTransform() gdnative.Transform2D
+	DebugDraw() ViewportDebugDraw
+	Disable3D() gdnative.Bool
+	GlobalCanvasTransform() gdnative.Transform2D
+	GuiDisableInput() gdnative.Bool
+	SetGuiDisableInput(value gdnative.Bool)
+	GuiSnapControlsToPixels() gdnative.Bool
+	SetGuiSnapControlsToPixels(value gdnative.Bool)
+	Hdr() gdnative.Bool
+	Msaa() ViewportMSAA
+	OwnWorld() gdnative.Bool
+	SetOwnWorld(value gdnative.Bool)
+	PhysicsObjectPicking() gdnative.Bool
+	RenderTargetClearMode() ViewportClearMode
+	SetRenderTargetClearMode(value ViewportClearMode)
+	RenderTargetUpdateMode() ViewportUpdateMode
+	SetRenderTargetUpdateMode(value ViewportUpdateMode)
+	RenderTargetVFlip() gdnative.Bool
+	SetRenderTargetVFlip(value gdnative.Bool)
+	ShadowAtlasQuad0() ViewportShadowAtlasQuadrantSubdiv
+	SetShadowAtlasQuad0(value ViewportShadowAtlasQuadrantSubdiv)
+	ShadowAtlasQuad1() ViewportShadowAtlasQuadrantSubdiv
+	SetShadowAtlasQuad1(value ViewportShadowAtlasQuadrantSubdiv)
+	ShadowAtlasQuad2() ViewportShadowAtlasQuadrantSubdiv
+	SetShadowAtlasQuad2(value ViewportShadowAtlasQuadrantSubdiv)
+	ShadowAtlasQuad3() ViewportShadowAtlasQuadrantSubdiv
+	SetShadowAtlasQuad3(value ViewportShadowAtlasQuadrantSubdiv)
+	ShadowAtlasSize() gdnative.Int
+	Size() gdnative.Vector2
+	TransparentBg() gdnative.Bool
+	SetTransparentBg(value gdnative.Bool)
+	Usage() ViewportUsage
+	World() WorldImplementer
+	World2D() World2DImplementer
+	ConnectSizeChanged(target ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitSizeChanged() error
 }
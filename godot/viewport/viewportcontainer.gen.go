@@ -1,7 +1,9 @@
-package godot
+package viewport
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+	"github.com/shadowapex/godot-go/godot/control"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,40 +15,54 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewViewportContainerFromPointer(ptr gdnative.Pointer) ViewportContainer {
-func newViewportContainerFromPointer(ptr gdnative.Pointer) ViewportContainer {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ViewportContainer{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type ViewportContainer struct {
-	Container
+	control.Container
 	owner gdnative.Object
 }
 
+// classConstructorViewportContainer is the class constructor of the ViewportContainer class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorViewportContainer = gdnative.NewLazyClassConstructor("ViewportContainer")
+
+// NewViewportContainer will create a new instance of the ViewportContainer class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewViewportContainer() *ViewportContainer {
+	obj := &ViewportContainer{}
+	obj.SetBaseObject(classConstructorViewportContainer.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ViewportContainer) BaseClass() string {
 	return "ViewportContainer"
 }
 
+// Method binds of the ViewportContainer class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindViewportContainerX_Input          = gdnative.NewLazyMethodBind("ViewportContainer", "_input")
+	methodBindViewportContainerGetStretchShrink = gdnative.NewLazyMethodBind("ViewportContainer", "get_stretch_shrink")
+	methodBindViewportContainerIsStretchEnabled = gdnative.NewLazyMethodBind("ViewportContainer", "is_stretch_enabled")
+	methodBindViewportContainerSetStretch       = gdnative.NewLazyMethodBind("ViewportContainer", "set_stretch")
+	methodBindViewportContainerSetStretchShrink = gdnative.NewLazyMethodBind("ViewportContainer", "set_stretch_shrink")
+)
+
 /*
-        Undocumented
-	Args: [{ false event InputEvent}], Returns: void
+	        Undocumented
+		Args: [{ false event InputEvent}], Returns: void
 */
-func (o *ViewportContainer) X_Input(event InputEventImplementer) {
+func (o *ViewportContainer) X_Input(event godot.InputEventImplementer) {
 	//log.Println("Calling ViewportContainer.X_Input()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(event.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(event))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ViewportContainer", "_input")
+	methodBind := methodBindViewportContainerX_Input.Get()
 
 	// Call the parent method.
 	// void
@@ -56,8 +72,8 @@ func (o *ViewportContainer) X_Input(event InputEventImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *ViewportContainer) GetStretchShrink() gdnative.Int {
 	//log.Println("Calling ViewportContainer.GetStretchShrink()")
@@ -66,7 +82,7 @@ func (o *ViewportContainer) GetStretchShrink() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ViewportContainer", "get_stretch_shrink")
+	methodBind := methodBindViewportContainerGetStretchShrink.Get()
 
 	// Call the parent method.
 	// int
@@ -79,8 +95,8 @@ func (o *ViewportContainer) GetStretchShrink() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *ViewportContainer) IsStretchEnabled() gdnative.Bool {
 	//log.Println("Calling ViewportContainer.IsStretchEnabled()")
@@ -89,7 +105,7 @@ func (o *ViewportContainer) IsStretchEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ViewportContainer", "is_stretch_enabled")
+	methodBind := methodBindViewportContainerIsStretchEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -102,8 +118,8 @@ func (o *ViewportContainer) IsStretchEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *ViewportContainer) SetStretch(enable gdnative.Bool) {
 	//log.Println("Calling ViewportContainer.SetStretch()")
@@ -113,7 +129,7 @@ func (o *ViewportContainer) SetStretch(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ViewportContainer", "set_stretch")
+	methodBind := methodBindViewportContainerSetStretch.Get()
 
 	// Call the parent method.
 	// void
@@ -123,8 +139,8 @@ func (o *ViewportContainer) SetStretch(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *ViewportContainer) SetStretchShrink(amount gdnative.Int) {
 	//log.Println("Calling ViewportContainer.SetStretchShrink()")
@@ -134,7 +150,7 @@ func (o *ViewportContainer) SetStretchShrink(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ViewportContainer", "set_stretch_shrink")
+	methodBind := methodBindViewportContainerSetStretchShrink.Get()
 
 	// Call the parent method.
 	// void
@@ -143,12 +159,24 @@ func (o *ViewportContainer) SetStretchShrink(amount gdnative.Int) {
 
 }
 
+// Stretch will return the value of the "stretch" property.
+func (o *ViewportContainer) Stretch() gdnative.Bool {
+	return o.IsStretchEnabled()
+}
+
+// StretchShrink will return the value of the "stretch_shrink" property.
+func (o *ViewportContainer) StretchShrink() gdnative.Int {
+	return o.GetStretchShrink()
+}
+
 // ViewportContainerImplementer is an interface that implements the methods
 // of the ViewportContainer class.
 type ViewportContainerImplementer interface {
-	ContainerImplementer
+	control.ContainerImplementer
 	GetStretchShrink() gdnative.Int
 	IsStretchEnabled() gdnative.Bool
 	SetStretch(enable gdnative.Bool)
 	SetStretchShrink(amount gdnative.Int)
+	Stretch() gdnative.Bool
+	StretchShrink() gdnative.Int
 }
@@ -13,30 +13,44 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewViewportTextureFromPointer(ptr gdnative.Pointer) ViewportTexture {
-func newViewportTextureFromPointer(ptr gdnative.Pointer) ViewportTexture {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := ViewportTexture{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
-
  */
 type ViewportTexture struct {
 	Texture
 	owner gdnative.Object
 }
 
+// classConstructorViewportTexture is the class constructor of the ViewportTexture class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorViewportTexture = gdnative.NewLazyClassConstructor("ViewportTexture")
+
+// NewViewportTexture will create a new instance of the ViewportTexture class.
+// ViewportTexture is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewViewportTexture() *ViewportTexture {
+	obj := &ViewportTexture{}
+	obj.SetBaseObject(classConstructorViewportTexture.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *ViewportTexture) BaseClass() string {
 	return "ViewportTexture"
 }
 
+// Method binds of the ViewportTexture class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindViewportTextureGetViewportPathInScene = gdnative.NewLazyMethodBind("ViewportTexture", "get_viewport_path_in_scene")
+	methodBindViewportTextureSetViewportPathInScene = gdnative.NewLazyMethodBind("ViewportTexture", "set_viewport_path_in_scene")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *ViewportTexture) GetViewportPathInScene() gdnative.NodePath {
 	//log.Println("Calling ViewportTexture.GetViewportPathInScene()")
@@ -45,7 +59,7 @@ func (o *ViewportTexture) GetViewportPathInScene() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ViewportTexture", "get_viewport_path_in_scene")
+	methodBind := methodBindViewportTextureGetViewportPathInScene.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -58,8 +72,8 @@ func (o *ViewportTexture) GetViewportPathInScene() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [{ false path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false path NodePath}], Returns: void
 */
 func (o *ViewportTexture) SetViewportPathInScene(path gdnative.NodePath) {
 	//log.Println("Calling ViewportTexture.SetViewportPathInScene()")
@@ -69,7 +83,7 @@ func (o *ViewportTexture) SetViewportPathInScene(path gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("ViewportTexture", "set_viewport_path_in_scene")
+	methodBind := methodBindViewportTextureSetViewportPathInScene.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +92,22 @@ func (o *ViewportTexture) SetViewportPathInScene(path gdnative.NodePath) {
 
 }
 
+// ViewportPath will return the value of the "viewport_path" property.
+func (o *ViewportTexture) ViewportPath() gdnative.NodePath {
+	return o.GetViewportPathInScene()
+}
+
+// SetViewportPath will set the value of the "viewport_path" property.
+func (o *ViewportTexture) SetViewportPath(value gdnative.NodePath) {
+	o.SetViewportPathInScene(value)
+}
+
 // ViewportTextureImplementer is an interface that implements the methods
 // of the ViewportTexture class.
 type ViewportTextureImplementer interface {
 	TextureImplementer
 	GetViewportPathInScene() gdnative.NodePath
 	SetViewportPathInScene(path gdnative.NodePath)
+	ViewportPath() gdnative.NodePath
+	SetViewportPath(value gdnative.NodePath)
 }
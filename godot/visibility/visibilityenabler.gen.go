@@ -0,0 +1,186 @@
+package visibility
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// VisibilityEnablerEnabler is an enum for Enabler values.
+type VisibilityEnablerEnabler int
+
+const (
+	VisibilityEnablerEnablerFreezeBodies    VisibilityEnablerEnabler = 1
+	VisibilityEnablerEnablerMax             VisibilityEnablerEnabler = 2
+	VisibilityEnablerEnablerPauseAnimations VisibilityEnablerEnabler = 0
+)
+
+// String will return the Godot name of the VisibilityEnablerEnabler value.
+func (e VisibilityEnablerEnabler) String() string {
+	switch e {
+	case VisibilityEnablerEnablerFreezeBodies:
+		return "ENABLER_FREEZE_BODIES"
+	case VisibilityEnablerEnablerMax:
+		return "ENABLER_MAX"
+	case VisibilityEnablerEnablerPauseAnimations:
+		return "ENABLER_PAUSE_ANIMATIONS"
+	}
+	return fmt.Sprintf("VisibilityEnablerEnabler(%d)", int(e))
+}
+
+// ParseVisibilityEnablerEnabler will return the VisibilityEnablerEnabler value with the given Godot name.
+func ParseVisibilityEnablerEnabler(name string) (VisibilityEnablerEnabler, error) {
+	switch name {
+	case "ENABLER_FREEZE_BODIES":
+		return VisibilityEnablerEnablerFreezeBodies, nil
+	case "ENABLER_MAX":
+		return VisibilityEnablerEnablerMax, nil
+	case "ENABLER_PAUSE_ANIMATIONS":
+		return VisibilityEnablerEnablerPauseAnimations, nil
+	}
+	return 0, fmt.Errorf("invalid VisibilityEnablerEnabler value %q", name)
+}
+
+/*
+The VisibilityEnabler will disable [RigidBody] and [AnimationPlayer] nodes when they are not visible. It will only affect other nodes within the same scene as the VisibilityEnabler itself.
+*/
+type VisibilityEnabler struct {
+	VisibilityNotifier
+	owner gdnative.Object
+}
+
+// classConstructorVisibilityEnabler is the class constructor of the VisibilityEnabler class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisibilityEnabler = gdnative.NewLazyClassConstructor("VisibilityEnabler")
+
+// NewVisibilityEnabler will create a new instance of the VisibilityEnabler class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVisibilityEnabler() *VisibilityEnabler {
+	obj := &VisibilityEnabler{}
+	obj.SetBaseObject(classConstructorVisibilityEnabler.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisibilityEnabler) BaseClass() string {
+	return "VisibilityEnabler"
+}
+
+// Method binds of the VisibilityEnabler class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisibilityEnablerX_NodeRemoved    = gdnative.NewLazyMethodBind("VisibilityEnabler", "_node_removed")
+	methodBindVisibilityEnablerIsEnablerEnabled = gdnative.NewLazyMethodBind("VisibilityEnabler", "is_enabler_enabled")
+	methodBindVisibilityEnablerSetEnabler       = gdnative.NewLazyMethodBind("VisibilityEnabler", "set_enabler")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
+*/
+func (o *VisibilityEnabler) X_NodeRemoved(arg0 godot.ObjectImplementer) {
+	//log.Println("Calling VisibilityEnabler.X_NodeRemoved()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindVisibilityEnablerX_NodeRemoved.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabler enum.VisibilityEnabler::Enabler}], Returns: bool
+*/
+func (o *VisibilityEnabler) IsEnablerEnabled(enabler VisibilityEnablerEnabler) gdnative.Bool {
+	//log.Println("Calling VisibilityEnabler.IsEnablerEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(enabler))
+
+	// Get the method bind
+	methodBind := methodBindVisibilityEnablerIsEnablerEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabler enum.VisibilityEnabler::Enabler} { false enabled bool}], Returns: void
+*/
+func (o *VisibilityEnabler) SetEnabler(enabler VisibilityEnablerEnabler, enabled gdnative.Bool) {
+	//log.Println("Calling VisibilityEnabler.SetEnabler()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(enabler))
+	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityEnablerSetEnabler.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// FreezeBodies will return the value of the "freeze_bodies" property.
+func (o *VisibilityEnabler) FreezeBodies() gdnative.Bool {
+	return o.IsEnablerEnabled(1)
+}
+
+// SetFreezeBodies will set the value of the "freeze_bodies" property.
+func (o *VisibilityEnabler) SetFreezeBodies(value gdnative.Bool) {
+	o.SetEnabler(1, value)
+}
+
+// PauseAnimations will return the value of the "pause_animations" property.
+func (o *VisibilityEnabler) PauseAnimations() gdnative.Bool {
+	return o.IsEnablerEnabled(0)
+}
+
+// SetPauseAnimations will set the value of the "pause_animations" property.
+func (o *VisibilityEnabler) SetPauseAnimations(value gdnative.Bool) {
+	o.SetEnabler(0, value)
+}
+
+// VisibilityEnablerImplementer is an interface that implements the methods
+// of the VisibilityEnabler class.
+type VisibilityEnablerImplementer interface {
+	VisibilityNotifierImplementer
+	X_NodeRemoved(arg0 godot.ObjectImplementer)
+	IsEnablerEnabled(enabler VisibilityEnablerEnabler) gdnative.Bool
+	SetEnabler(enabler VisibilityEnablerEnabler, enabled gdnative.Bool)
+	FreezeBodies() gdnative.Bool
+	SetFreezeBodies(value gdnative.Bool)
+	PauseAnimations() gdnative.Bool
+	SetPauseAnimations(value gdnative.Bool)
+}
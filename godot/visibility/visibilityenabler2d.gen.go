@@ -0,0 +1,254 @@
+package visibility
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// VisibilityEnabler2DEnabler is an enum for Enabler values.
+type VisibilityEnabler2DEnabler int
+
+const (
+	VisibilityEnabler2DEnablerFreezeBodies         VisibilityEnabler2DEnabler = 1
+	VisibilityEnabler2DEnablerMax                  VisibilityEnabler2DEnabler = 6
+	VisibilityEnabler2DEnablerParentPhysicsProcess VisibilityEnabler2DEnabler = 4
+	VisibilityEnabler2DEnablerParentProcess        VisibilityEnabler2DEnabler = 3
+	VisibilityEnabler2DEnablerPauseAnimatedSprites VisibilityEnabler2DEnabler = 5
+	VisibilityEnabler2DEnablerPauseAnimations      VisibilityEnabler2DEnabler = 0
+	VisibilityEnabler2DEnablerPauseParticles       VisibilityEnabler2DEnabler = 2
+)
+
+// String will return the Godot name of the VisibilityEnabler2DEnabler value.
+func (e VisibilityEnabler2DEnabler) String() string {
+	switch e {
+	case VisibilityEnabler2DEnablerFreezeBodies:
+		return "ENABLER_FREEZE_BODIES"
+	case VisibilityEnabler2DEnablerMax:
+		return "ENABLER_MAX"
+	case VisibilityEnabler2DEnablerParentPhysicsProcess:
+		return "ENABLER_PARENT_PHYSICS_PROCESS"
+	case VisibilityEnabler2DEnablerParentProcess:
+		return "ENABLER_PARENT_PROCESS"
+	case VisibilityEnabler2DEnablerPauseAnimatedSprites:
+		return "ENABLER_PAUSE_ANIMATED_SPRITES"
+	case VisibilityEnabler2DEnablerPauseAnimations:
+		return "ENABLER_PAUSE_ANIMATIONS"
+	case VisibilityEnabler2DEnablerPauseParticles:
+		return "ENABLER_PAUSE_PARTICLES"
+	}
+	return fmt.Sprintf("VisibilityEnabler2DEnabler(%d)", int(e))
+}
+
+// ParseVisibilityEnabler2DEnabler will return the VisibilityEnabler2DEnabler value with the given Godot name.
+func ParseVisibilityEnabler2DEnabler(name string) (VisibilityEnabler2DEnabler, error) {
+	switch name {
+	case "ENABLER_FREEZE_BODIES":
+		return VisibilityEnabler2DEnablerFreezeBodies, nil
+	case "ENABLER_MAX":
+		return VisibilityEnabler2DEnablerMax, nil
+	case "ENABLER_PARENT_PHYSICS_PROCESS":
+		return VisibilityEnabler2DEnablerParentPhysicsProcess, nil
+	case "ENABLER_PARENT_PROCESS":
+		return VisibilityEnabler2DEnablerParentProcess, nil
+	case "ENABLER_PAUSE_ANIMATED_SPRITES":
+		return VisibilityEnabler2DEnablerPauseAnimatedSprites, nil
+	case "ENABLER_PAUSE_ANIMATIONS":
+		return VisibilityEnabler2DEnablerPauseAnimations, nil
+	case "ENABLER_PAUSE_PARTICLES":
+		return VisibilityEnabler2DEnablerPauseParticles, nil
+	}
+	return 0, fmt.Errorf("invalid VisibilityEnabler2DEnabler value %q", name)
+}
+
+/*
+The VisibilityEnabler2D will disable [RigidBody2D], [AnimationPlayer], and other nodes when they are not visible. It will only affect other nodes within the same scene as the VisibilityEnabler2D itself.
+*/
+type VisibilityEnabler2D struct {
+	VisibilityNotifier2D
+	owner gdnative.Object
+}
+
+// classConstructorVisibilityEnabler2D is the class constructor of the VisibilityEnabler2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisibilityEnabler2D = gdnative.NewLazyClassConstructor("VisibilityEnabler2D")
+
+// NewVisibilityEnabler2D will create a new instance of the VisibilityEnabler2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVisibilityEnabler2D() *VisibilityEnabler2D {
+	obj := &VisibilityEnabler2D{}
+	obj.SetBaseObject(classConstructorVisibilityEnabler2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisibilityEnabler2D) BaseClass() string {
+	return "VisibilityEnabler2D"
+}
+
+// Method binds of the VisibilityEnabler2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisibilityEnabler2DX_NodeRemoved    = gdnative.NewLazyMethodBind("VisibilityEnabler2D", "_node_removed")
+	methodBindVisibilityEnabler2DIsEnablerEnabled = gdnative.NewLazyMethodBind("VisibilityEnabler2D", "is_enabler_enabled")
+	methodBindVisibilityEnabler2DSetEnabler       = gdnative.NewLazyMethodBind("VisibilityEnabler2D", "set_enabler")
+)
+
+/*
+	        Undocumented
+		Args: [{ false arg0 Object}], Returns: void
+*/
+func (o *VisibilityEnabler2D) X_NodeRemoved(arg0 godot.ObjectImplementer) {
+	//log.Println("Calling VisibilityEnabler2D.X_NodeRemoved()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(arg0))
+
+	// Get the method bind
+	methodBind := methodBindVisibilityEnabler2DX_NodeRemoved.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabler enum.VisibilityEnabler2D::Enabler}], Returns: bool
+*/
+func (o *VisibilityEnabler2D) IsEnablerEnabled(enabler VisibilityEnabler2DEnabler) gdnative.Bool {
+	//log.Println("Calling VisibilityEnabler2D.IsEnablerEnabled()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(enabler))
+
+	// Get the method bind
+	methodBind := methodBindVisibilityEnabler2DIsEnablerEnabled.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false enabler enum.VisibilityEnabler2D::Enabler} { false enabled bool}], Returns: void
+*/
+func (o *VisibilityEnabler2D) SetEnabler(enabler VisibilityEnabler2DEnabler, enabled gdnative.Bool) {
+	//log.Println("Calling VisibilityEnabler2D.SetEnabler()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 2, 2)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(enabler))
+	ptrArguments[1] = gdnative.NewPointerFromBool(enabled)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityEnabler2DSetEnabler.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// FreezeBodies will return the value of the "freeze_bodies" property.
+func (o *VisibilityEnabler2D) FreezeBodies() gdnative.Bool {
+	return o.IsEnablerEnabled(1)
+}
+
+// SetFreezeBodies will set the value of the "freeze_bodies" property.
+func (o *VisibilityEnabler2D) SetFreezeBodies(value gdnative.Bool) {
+	o.SetEnabler(1, value)
+}
+
+// PauseAnimatedSprites will return the value of the "pause_animated_sprites" property.
+func (o *VisibilityEnabler2D) PauseAnimatedSprites() gdnative.Bool {
+	return o.IsEnablerEnabled(5)
+}
+
+// SetPauseAnimatedSprites will set the value of the "pause_animated_sprites" property.
+func (o *VisibilityEnabler2D) SetPauseAnimatedSprites(value gdnative.Bool) {
+	o.SetEnabler(5, value)
+}
+
+// PauseAnimations will return the value of the "pause_animations" property.
+func (o *VisibilityEnabler2D) PauseAnimations() gdnative.Bool {
+	return o.IsEnablerEnabled(0)
+}
+
+// SetPauseAnimations will set the value of the "pause_animations" property.
+func (o *VisibilityEnabler2D) SetPauseAnimations(value gdnative.Bool) {
+	o.SetEnabler(0, value)
+}
+
+// PauseParticles will return the value of the "pause_particles" property.
+func (o *VisibilityEnabler2D) PauseParticles() gdnative.Bool {
+	return o.IsEnablerEnabled(2)
+}
+
+// SetPauseParticles will set the value of the "pause_particles" property.
+func (o *VisibilityEnabler2D) SetPauseParticles(value gdnative.Bool) {
+	o.SetEnabler(2, value)
+}
+
+// PhysicsProcessParent will return the value of the "physics_process_parent" property.
+func (o *VisibilityEnabler2D) PhysicsProcessParent() gdnative.Bool {
+	return o.IsEnablerEnabled(4)
+}
+
+// SetPhysicsProcessParent will set the value of the "physics_process_parent" property.
+func (o *VisibilityEnabler2D) SetPhysicsProcessParent(value gdnative.Bool) {
+	o.SetEnabler(4, value)
+}
+
+// ProcessParent will return the value of the "process_parent" property.
+func (o *VisibilityEnabler2D) ProcessParent() gdnative.Bool {
+	return o.IsEnablerEnabled(3)
+}
+
+// SetProcessParent will set the value of the "process_parent" property.
+func (o *VisibilityEnabler2D) SetProcessParent(value gdnative.Bool) {
+	o.SetEnabler(3, value)
+}
+
+// VisibilityEnabler2DImplementer is an interface that implements the methods
+// of the VisibilityEnabler2D class.
+type VisibilityEnabler2DImplementer interface {
+	VisibilityNotifier2DImplementer
+	X_NodeRemoved(arg0 godot.ObjectImplementer)
+	IsEnablerEnabled(enabler VisibilityEnabler2DEnabler) gdnative.Bool
+	SetEnabler(enabler VisibilityEnabler2DEnabler, enabled gdnative.Bool)
+	FreezeBodies() gdnative.Bool
+	SetFreezeBodies(value gdnative.Bool)
+	PauseAnimatedSprites() gdnative.Bool
+	SetPauseAnimatedSprites(value gdnative.Bool)
+	PauseAnimations() gdnative.Bool
+	SetPauseAnimations(value gdnative.Bool)
+	PauseParticles() gdnative.Bool
+	SetPauseParticles(value gdnative.Bool)
+	PhysicsProcessParent() gdnative.Bool
+	SetPhysicsProcessParent(value gdnative.Bool)
+	ProcessParent() gdnative.Bool
+	SetProcessParent(value gdnative.Bool)
+}
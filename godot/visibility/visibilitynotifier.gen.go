@@ -0,0 +1,225 @@
+package visibility
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+The VisibilityNotifier detects when it is visible on the screen. It also notifies when its bounding rectangle enters or exits the screen or a [Camera]'s view.
+*/
+type VisibilityNotifier struct {
+	godot.Spatial
+	owner gdnative.Object
+}
+
+// classConstructorVisibilityNotifier is the class constructor of the VisibilityNotifier class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisibilityNotifier = gdnative.NewLazyClassConstructor("VisibilityNotifier")
+
+// NewVisibilityNotifier will create a new instance of the VisibilityNotifier class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVisibilityNotifier() *VisibilityNotifier {
+	obj := &VisibilityNotifier{}
+	obj.SetBaseObject(classConstructorVisibilityNotifier.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisibilityNotifier) BaseClass() string {
+	return "VisibilityNotifier"
+}
+
+// Method binds of the VisibilityNotifier class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisibilityNotifierGetAabb    = gdnative.NewLazyMethodBind("VisibilityNotifier", "get_aabb")
+	methodBindVisibilityNotifierIsOnScreen = gdnative.NewLazyMethodBind("VisibilityNotifier", "is_on_screen")
+	methodBindVisibilityNotifierSetAabb    = gdnative.NewLazyMethodBind("VisibilityNotifier", "set_aabb")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: AABB
+*/
+func (o *VisibilityNotifier) GetAabb() gdnative.Aabb {
+	//log.Println("Calling VisibilityNotifier.GetAabb()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityNotifierGetAabb.Get()
+
+	// Call the parent method.
+	// AABB
+	retPtr := gdnative.NewEmptyAabb()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewAabbFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If [code]true[/code] the bounding box is on the screen.
+		Args: [], Returns: bool
+*/
+func (o *VisibilityNotifier) IsOnScreen() gdnative.Bool {
+	//log.Println("Calling VisibilityNotifier.IsOnScreen()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityNotifierIsOnScreen.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false rect AABB}], Returns: void
+*/
+func (o *VisibilityNotifier) SetAabb(rect gdnative.Aabb) {
+	//log.Println("Calling VisibilityNotifier.SetAabb()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromAabb(rect)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityNotifierSetAabb.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Aabb will return the value of the "aabb" property.
+func (o *VisibilityNotifier) Aabb() gdnative.Aabb {
+	return o.GetAabb()
+}
+
+// Signals of the VisibilityNotifier class.
+const (
+	VisibilityNotifierSignalCameraEntered gdnative.String = "camera_entered"
+	VisibilityNotifierSignalCameraExited  gdnative.String = "camera_exited"
+	VisibilityNotifierSignalScreenEntered gdnative.String = "screen_entered"
+	VisibilityNotifierSignalScreenExited  gdnative.String = "screen_exited"
+)
+
+// VisibilityNotifierCameraEnteredArgs holds the arguments of the "camera_entered" signal.
+type VisibilityNotifierCameraEnteredArgs struct {
+	Camera godot.CameraImplementer
+}
+
+// ConnectCameraEntered will connect the "camera_entered" signal to the given method of the target object.
+func (o *VisibilityNotifier) ConnectCameraEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifierSignalCameraEntered, target, method, binds, 0)
+}
+
+// EmitCameraEntered will emit the "camera_entered" signal.
+func (o *VisibilityNotifier) EmitCameraEntered(args VisibilityNotifierCameraEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Camera))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(VisibilityNotifierSignalCameraEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// VisibilityNotifierCameraExitedArgs holds the arguments of the "camera_exited" signal.
+type VisibilityNotifierCameraExitedArgs struct {
+	Camera godot.CameraImplementer
+}
+
+// ConnectCameraExited will connect the "camera_exited" signal to the given method of the target object.
+func (o *VisibilityNotifier) ConnectCameraExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifierSignalCameraExited, target, method, binds, 0)
+}
+
+// EmitCameraExited will emit the "camera_exited" signal.
+func (o *VisibilityNotifier) EmitCameraExited(args VisibilityNotifierCameraExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Camera))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(VisibilityNotifierSignalCameraExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// ConnectScreenEntered will connect the "screen_entered" signal to the given method of the target object.
+func (o *VisibilityNotifier) ConnectScreenEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifierSignalScreenEntered, target, method, binds, 0)
+}
+
+// EmitScreenEntered will emit the "screen_entered" signal.
+func (o *VisibilityNotifier) EmitScreenEntered() error {
+
+	ret, err := o.EmitSignal(VisibilityNotifierSignalScreenEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectScreenExited will connect the "screen_exited" signal to the given method of the target object.
+func (o *VisibilityNotifier) ConnectScreenExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifierSignalScreenExited, target, method, binds, 0)
+}
+
+// EmitScreenExited will emit the "screen_exited" signal.
+func (o *VisibilityNotifier) EmitScreenExited() error {
+
+	ret, err := o.EmitSignal(VisibilityNotifierSignalScreenExited)
+	ret.Destroy()
+	return err
+}
+
+// VisibilityNotifierImplementer is an interface that implements the methods
+// of the VisibilityNotifier class.
+type VisibilityNotifierImplementer interface {
+	godot.SpatialImplementer
+	GetAabb() gdnative.Aabb
+	IsOnScreen() gdnative.Bool
+	SetAabb(rect gdnative.Aabb)
+	Aabb() gdnative.Aabb
+	ConnectCameraEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCameraEntered(args VisibilityNotifierCameraEnteredArgs) error
+	ConnectCameraExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitCameraExited(args VisibilityNotifierCameraExitedArgs) error
+	ConnectScreenEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScreenEntered() error
+	ConnectScreenExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScreenExited() error
+}
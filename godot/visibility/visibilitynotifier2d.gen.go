@@ -0,0 +1,225 @@
+package visibility
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+The VisibilityNotifier2D detects when it is visible on the screen. It also notifies when its bounding rectangle enters or exits the screen or a viewport.
+*/
+type VisibilityNotifier2D struct {
+	godot.Node2D
+	owner gdnative.Object
+}
+
+// classConstructorVisibilityNotifier2D is the class constructor of the VisibilityNotifier2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisibilityNotifier2D = gdnative.NewLazyClassConstructor("VisibilityNotifier2D")
+
+// NewVisibilityNotifier2D will create a new instance of the VisibilityNotifier2D class.
+// The created object must be freed with Destroy() when it is no longer needed.
+func NewVisibilityNotifier2D() *VisibilityNotifier2D {
+	obj := &VisibilityNotifier2D{}
+	obj.SetBaseObject(classConstructorVisibilityNotifier2D.Get().Call())
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisibilityNotifier2D) BaseClass() string {
+	return "VisibilityNotifier2D"
+}
+
+// Method binds of the VisibilityNotifier2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisibilityNotifier2DGetRect    = gdnative.NewLazyMethodBind("VisibilityNotifier2D", "get_rect")
+	methodBindVisibilityNotifier2DIsOnScreen = gdnative.NewLazyMethodBind("VisibilityNotifier2D", "is_on_screen")
+	methodBindVisibilityNotifier2DSetRect    = gdnative.NewLazyMethodBind("VisibilityNotifier2D", "set_rect")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Rect2
+*/
+func (o *VisibilityNotifier2D) GetRect() gdnative.Rect2 {
+	//log.Println("Calling VisibilityNotifier2D.GetRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityNotifier2DGetRect.Get()
+
+	// Call the parent method.
+	// Rect2
+	retPtr := gdnative.NewEmptyRect2()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRect2FromPointer(retPtr)
+	return ret
+}
+
+/*
+	        If [code]true[/code] the bounding rectangle is on the screen.
+		Args: [], Returns: bool
+*/
+func (o *VisibilityNotifier2D) IsOnScreen() gdnative.Bool {
+	//log.Println("Calling VisibilityNotifier2D.IsOnScreen()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityNotifier2DIsOnScreen.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false rect Rect2}], Returns: void
+*/
+func (o *VisibilityNotifier2D) SetRect(rect gdnative.Rect2) {
+	//log.Println("Calling VisibilityNotifier2D.SetRect()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromRect2(rect)
+
+	// Get the method bind
+	methodBind := methodBindVisibilityNotifier2DSetRect.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Rect will return the value of the "rect" property.
+func (o *VisibilityNotifier2D) Rect() gdnative.Rect2 {
+	return o.GetRect()
+}
+
+// Signals of the VisibilityNotifier2D class.
+const (
+	VisibilityNotifier2DSignalScreenEntered   gdnative.String = "screen_entered"
+	VisibilityNotifier2DSignalScreenExited    gdnative.String = "screen_exited"
+	VisibilityNotifier2DSignalViewportEntered gdnative.String = "viewport_entered"
+	VisibilityNotifier2DSignalViewportExited  gdnative.String = "viewport_exited"
+)
+
+// ConnectScreenEntered will connect the "screen_entered" signal to the given method of the target object.
+func (o *VisibilityNotifier2D) ConnectScreenEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifier2DSignalScreenEntered, target, method, binds, 0)
+}
+
+// EmitScreenEntered will emit the "screen_entered" signal.
+func (o *VisibilityNotifier2D) EmitScreenEntered() error {
+
+	ret, err := o.EmitSignal(VisibilityNotifier2DSignalScreenEntered)
+	ret.Destroy()
+	return err
+}
+
+// ConnectScreenExited will connect the "screen_exited" signal to the given method of the target object.
+func (o *VisibilityNotifier2D) ConnectScreenExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifier2DSignalScreenExited, target, method, binds, 0)
+}
+
+// EmitScreenExited will emit the "screen_exited" signal.
+func (o *VisibilityNotifier2D) EmitScreenExited() error {
+
+	ret, err := o.EmitSignal(VisibilityNotifier2DSignalScreenExited)
+	ret.Destroy()
+	return err
+}
+
+// VisibilityNotifier2DViewportEnteredArgs holds the arguments of the "viewport_entered" signal.
+type VisibilityNotifier2DViewportEnteredArgs struct {
+	Viewport godot.ViewportImplementer
+}
+
+// ConnectViewportEntered will connect the "viewport_entered" signal to the given method of the target object.
+func (o *VisibilityNotifier2D) ConnectViewportEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifier2DSignalViewportEntered, target, method, binds, 0)
+}
+
+// EmitViewportEntered will emit the "viewport_entered" signal.
+func (o *VisibilityNotifier2D) EmitViewportEntered(args VisibilityNotifier2DViewportEnteredArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Viewport))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(VisibilityNotifier2DSignalViewportEntered, arg0)
+	ret.Destroy()
+	return err
+}
+
+// VisibilityNotifier2DViewportExitedArgs holds the arguments of the "viewport_exited" signal.
+type VisibilityNotifier2DViewportExitedArgs struct {
+	Viewport godot.ViewportImplementer
+}
+
+// ConnectViewportExited will connect the "viewport_exited" signal to the given method of the target object.
+func (o *VisibilityNotifier2D) ConnectViewportExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisibilityNotifier2DSignalViewportExited, target, method, binds, 0)
+}
+
+// EmitViewportExited will emit the "viewport_exited" signal.
+func (o *VisibilityNotifier2D) EmitViewportExited(args VisibilityNotifier2DViewportExitedArgs) error {
+	arg0 := gdnative.NewVariantObject(godot.GetBaseObject(args.Viewport))
+	defer arg0.Destroy()
+
+	ret, err := o.EmitSignal(VisibilityNotifier2DSignalViewportExited, arg0)
+	ret.Destroy()
+	return err
+}
+
+// VisibilityNotifier2DImplementer is an interface that implements the methods
+// of the VisibilityNotifier2D class.
+type VisibilityNotifier2DImplementer interface {
+	godot.Node2DImplementer
+	GetRect() gdnative.Rect2
+	IsOnScreen() gdnative.Bool
+	SetRect(rect gdnative.Rect2)
+	Rect() gdnative.Rect2
+	ConnectScreenEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScreenEntered() error
+	ConnectScreenExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitScreenExited() error
+	ConnectViewportEntered(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitViewportEntered(args VisibilityNotifier2DViewportEnteredArgs) error
+	ConnectViewportExited(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitViewportExited(args VisibilityNotifier2DViewportExitedArgs) error
+}
@@ -0,0 +1,240 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "convert.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// init will register the constructors of all classes in this package, so
+// objects of these classes can be converted into their concrete class type.
+func init() {
+	godot.RegisterObjectConstructor("VisualScript", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScript{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptBasicTypeConstant", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptBasicTypeConstant{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptBuiltinFunc", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptBuiltinFunc{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptClassConstant", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptClassConstant{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptComment", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptComment{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptCondition", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptCondition{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptConstant", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptConstant{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptConstructor", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptConstructor{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptCustomNode", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptCustomNode{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptDeconstruct", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptDeconstruct{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptEmitSignal", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptEmitSignal{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptEngineSingleton", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptEngineSingleton{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptExpression", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptExpression{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptFunction", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptFunction{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptFunctionCall", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptFunctionCall{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptFunctionState", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptFunctionState{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptGlobalConstant", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptGlobalConstant{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptIndexGet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptIndexGet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptIndexSet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptIndexSet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptInputAction", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptInputAction{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptIterator", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptIterator{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptLocalVar", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptLocalVar{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptLocalVarSet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptLocalVarSet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptMathConstant", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptMathConstant{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptNode", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptNode{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptOperator", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptOperator{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptPreload", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptPreload{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptPropertyGet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptPropertyGet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptPropertySet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptPropertySet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptResourcePath", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptResourcePath{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptReturn", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptReturn{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptSceneNode", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptSceneNode{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptSceneTree", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptSceneTree{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptSelect", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptSelect{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptSelf", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptSelf{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptSequence", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptSequence{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptSubCall", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptSubCall{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptSwitch", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptSwitch{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptTypeCast", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptTypeCast{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptVariableGet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptVariableGet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptVariableSet", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptVariableSet{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptWhile", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptWhile{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptYield", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptYield{}
+		class.SetBaseObject(obj)
+		return class
+	})
+	godot.RegisterObjectConstructor("VisualScriptYieldSignal", func(obj gdnative.Object) godot.ObjectImplementer {
+		class := &VisualScriptYieldSignal{}
+		class.SetBaseObject(obj)
+		return class
+	})
+}
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,30 +14,88 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptFromPointer(ptr gdnative.Pointer) VisualScript {
-func newVisualScriptFromPointer(ptr gdnative.Pointer) VisualScript {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScript{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
 type VisualScript struct {
-	Script
+	godot.Script
 	owner gdnative.Object
 }
 
+// classConstructorVisualScript is the class constructor of the VisualScript class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScript = gdnative.NewLazyClassConstructor("VisualScript")
+
+// NewVisualScript will create a new instance of the VisualScript class.
+// VisualScript is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScript() *VisualScript {
+	obj := &VisualScript{}
+	obj.SetBaseObject(classConstructorVisualScript.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScript) BaseClass() string {
 	return "VisualScript"
 }
 
+// Method binds of the VisualScript class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptX_GetData                    = gdnative.NewLazyMethodBind("VisualScript", "_get_data")
+	methodBindVisualScriptX_NodePortsChanged           = gdnative.NewLazyMethodBind("VisualScript", "_node_ports_changed")
+	methodBindVisualScriptX_SetData                    = gdnative.NewLazyMethodBind("VisualScript", "_set_data")
+	methodBindVisualScriptAddCustomSignal              = gdnative.NewLazyMethodBind("VisualScript", "add_custom_signal")
+	methodBindVisualScriptAddFunction                  = gdnative.NewLazyMethodBind("VisualScript", "add_function")
+	methodBindVisualScriptAddNode                      = gdnative.NewLazyMethodBind("VisualScript", "add_node")
+	methodBindVisualScriptAddVariable                  = gdnative.NewLazyMethodBind("VisualScript", "add_variable")
+	methodBindVisualScriptCustomSignalAddArgument      = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_add_argument")
+	methodBindVisualScriptCustomSignalGetArgumentCount = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_get_argument_count")
+	methodBindVisualScriptCustomSignalGetArgumentName  = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_get_argument_name")
+	methodBindVisualScriptCustomSignalGetArgumentType  = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_get_argument_type")
+	methodBindVisualScriptCustomSignalRemoveArgument   = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_remove_argument")
+	methodBindVisualScriptCustomSignalSetArgumentName  = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_set_argument_name")
+	methodBindVisualScriptCustomSignalSetArgumentType  = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_set_argument_type")
+	methodBindVisualScriptCustomSignalSwapArgument     = gdnative.NewLazyMethodBind("VisualScript", "custom_signal_swap_argument")
+	methodBindVisualScriptDataConnect                  = gdnative.NewLazyMethodBind("VisualScript", "data_connect")
+	methodBindVisualScriptDataDisconnect               = gdnative.NewLazyMethodBind("VisualScript", "data_disconnect")
+	methodBindVisualScriptGetFunctionNodeId            = gdnative.NewLazyMethodBind("VisualScript", "get_function_node_id")
+	methodBindVisualScriptGetFunctionScroll            = gdnative.NewLazyMethodBind("VisualScript", "get_function_scroll")
+	methodBindVisualScriptGetNode                      = gdnative.NewLazyMethodBind("VisualScript", "get_node")
+	methodBindVisualScriptGetNodePosition              = gdnative.NewLazyMethodBind("VisualScript", "get_node_position")
+	methodBindVisualScriptGetVariableDefaultValue      = gdnative.NewLazyMethodBind("VisualScript", "get_variable_default_value")
+	methodBindVisualScriptGetVariableExport            = gdnative.NewLazyMethodBind("VisualScript", "get_variable_export")
+	methodBindVisualScriptGetVariableInfo              = gdnative.NewLazyMethodBind("VisualScript", "get_variable_info")
+	methodBindVisualScriptHasCustomSignal              = gdnative.NewLazyMethodBind("VisualScript", "has_custom_signal")
+	methodBindVisualScriptHasDataConnection            = gdnative.NewLazyMethodBind("VisualScript", "has_data_connection")
+	methodBindVisualScriptHasFunction                  = gdnative.NewLazyMethodBind("VisualScript", "has_function")
+	methodBindVisualScriptHasNode                      = gdnative.NewLazyMethodBind("VisualScript", "has_node")
+	methodBindVisualScriptHasSequenceConnection        = gdnative.NewLazyMethodBind("VisualScript", "has_sequence_connection")
+	methodBindVisualScriptHasVariable                  = gdnative.NewLazyMethodBind("VisualScript", "has_variable")
+	methodBindVisualScriptRemoveCustomSignal           = gdnative.NewLazyMethodBind("VisualScript", "remove_custom_signal")
+	methodBindVisualScriptRemoveFunction               = gdnative.NewLazyMethodBind("VisualScript", "remove_function")
+	methodBindVisualScriptRemoveNode                   = gdnative.NewLazyMethodBind("VisualScript", "remove_node")
+	methodBindVisualScriptRemoveVariable               = gdnative.NewLazyMethodBind("VisualScript", "remove_variable")
+	methodBindVisualScriptRenameCustomSignal           = gdnative.NewLazyMethodBind("VisualScript", "rename_custom_signal")
+	methodBindVisualScriptRenameFunction               = gdnative.NewLazyMethodBind("VisualScript", "rename_function")
+	methodBindVisualScriptRenameVariable               = gdnative.NewLazyMethodBind("VisualScript", "rename_variable")
+	methodBindVisualScriptSequenceConnect              = gdnative.NewLazyMethodBind("VisualScript", "sequence_connect")
+	methodBindVisualScriptSequenceDisconnect           = gdnative.NewLazyMethodBind("VisualScript", "sequence_disconnect")
+	methodBindVisualScriptSetFunctionScroll            = gdnative.NewLazyMethodBind("VisualScript", "set_function_scroll")
+	methodBindVisualScriptSetInstanceBaseType          = gdnative.NewLazyMethodBind("VisualScript", "set_instance_base_type")
+	methodBindVisualScriptSetNodePosition              = gdnative.NewLazyMethodBind("VisualScript", "set_node_position")
+	methodBindVisualScriptSetVariableDefaultValue      = gdnative.NewLazyMethodBind("VisualScript", "set_variable_default_value")
+	methodBindVisualScriptSetVariableExport            = gdnative.NewLazyMethodBind("VisualScript", "set_variable_export")
+	methodBindVisualScriptSetVariableInfo              = gdnative.NewLazyMethodBind("VisualScript", "set_variable_info")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *VisualScript) X_GetData() gdnative.Dictionary {
 	//log.Println("Calling VisualScript.X_GetData()")
@@ -45,7 +104,7 @@ func (o *VisualScript) X_GetData() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "_get_data")
+	methodBind := methodBindVisualScriptX_GetData.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -58,8 +117,8 @@ func (o *VisualScript) X_GetData() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false arg0 int}], Returns: void
+	        Undocumented
+		Args: [{ false arg0 int}], Returns: void
 */
 func (o *VisualScript) X_NodePortsChanged(arg0 gdnative.Int) {
 	//log.Println("Calling VisualScript.X_NodePortsChanged()")
@@ -69,7 +128,7 @@ func (o *VisualScript) X_NodePortsChanged(arg0 gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(arg0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "_node_ports_changed")
+	methodBind := methodBindVisualScriptX_NodePortsChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +138,8 @@ func (o *VisualScript) X_NodePortsChanged(arg0 gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false data Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false data Dictionary}], Returns: void
 */
 func (o *VisualScript) X_SetData(data gdnative.Dictionary) {
 	//log.Println("Calling VisualScript.X_SetData()")
@@ -90,7 +149,7 @@ func (o *VisualScript) X_SetData(data gdnative.Dictionary) {
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(data)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "_set_data")
+	methodBind := methodBindVisualScriptX_SetData.Get()
 
 	// Call the parent method.
 	// void
@@ -100,8 +159,8 @@ func (o *VisualScript) X_SetData(data gdnative.Dictionary) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScript) AddCustomSignal(name gdnative.String) {
 	//log.Println("Calling VisualScript.AddCustomSignal()")
@@ -111,7 +170,7 @@ func (o *VisualScript) AddCustomSignal(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "add_custom_signal")
+	methodBind := methodBindVisualScriptAddCustomSignal.Get()
 
 	// Call the parent method.
 	// void
@@ -121,8 +180,8 @@ func (o *VisualScript) AddCustomSignal(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScript) AddFunction(name gdnative.String) {
 	//log.Println("Calling VisualScript.AddFunction()")
@@ -132,7 +191,7 @@ func (o *VisualScript) AddFunction(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "add_function")
+	methodBind := methodBindVisualScriptAddFunction.Get()
 
 	// Call the parent method.
 	// void
@@ -142,8 +201,8 @@ func (o *VisualScript) AddFunction(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false id int} { false node VisualScriptNode} {(0, 0) true position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false func String} { false id int} { false node VisualScriptNode} {(0, 0) true position Vector2}], Returns: void
 */
 func (o *VisualScript) AddNode(function gdnative.String, id gdnative.Int, node VisualScriptNodeImplementer, position gdnative.Vector2) {
 	//log.Println("Calling VisualScript.AddNode()")
@@ -152,11 +211,11 @@ func (o *VisualScript) AddNode(function gdnative.String, id gdnative.Int, node V
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
 	ptrArguments[0] = gdnative.NewPointerFromString(function)
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
-	ptrArguments[2] = gdnative.NewPointerFromObject(node.GetBaseObject())
+	ptrArguments[2] = gdnative.NewPointerFromObject(godot.GetBaseObject(node))
 	ptrArguments[3] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "add_node")
+	methodBind := methodBindVisualScriptAddNode.Get()
 
 	// Call the parent method.
 	// void
@@ -166,8 +225,8 @@ func (o *VisualScript) AddNode(function gdnative.String, id gdnative.Int, node V
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} {Null true default_value Variant} {False true export bool}], Returns: void
+	        Undocumented
+		Args: [{ false name String} {Null true default_value Variant} {False true export bool}], Returns: void
 */
 func (o *VisualScript) AddVariable(name gdnative.String, defaultValue gdnative.Variant, export gdnative.Bool) {
 	//log.Println("Calling VisualScript.AddVariable()")
@@ -179,7 +238,7 @@ func (o *VisualScript) AddVariable(name gdnative.String, defaultValue gdnative.V
 	ptrArguments[2] = gdnative.NewPointerFromBool(export)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "add_variable")
+	methodBind := methodBindVisualScriptAddVariable.Get()
 
 	// Call the parent method.
 	// void
@@ -189,8 +248,8 @@ func (o *VisualScript) AddVariable(name gdnative.String, defaultValue gdnative.V
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false type int} { false argname String} {-1 true index int}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false type int} { false argname String} {-1 true index int}], Returns: void
 */
 func (o *VisualScript) CustomSignalAddArgument(name gdnative.String, aType gdnative.Int, argname gdnative.String, index gdnative.Int) {
 	//log.Println("Calling VisualScript.CustomSignalAddArgument()")
@@ -203,7 +262,7 @@ func (o *VisualScript) CustomSignalAddArgument(name gdnative.String, aType gdnat
 	ptrArguments[3] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_add_argument")
+	methodBind := methodBindVisualScriptCustomSignalAddArgument.Get()
 
 	// Call the parent method.
 	// void
@@ -213,8 +272,8 @@ func (o *VisualScript) CustomSignalAddArgument(name gdnative.String, aType gdnat
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: int
+	        Undocumented
+		Args: [{ false name String}], Returns: int
 */
 func (o *VisualScript) CustomSignalGetArgumentCount(name gdnative.String) gdnative.Int {
 	//log.Println("Calling VisualScript.CustomSignalGetArgumentCount()")
@@ -224,7 +283,7 @@ func (o *VisualScript) CustomSignalGetArgumentCount(name gdnative.String) gdnati
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_get_argument_count")
+	methodBind := methodBindVisualScriptCustomSignalGetArgumentCount.Get()
 
 	// Call the parent method.
 	// int
@@ -237,8 +296,8 @@ func (o *VisualScript) CustomSignalGetArgumentCount(name gdnative.String) gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false argidx int}], Returns: String
+	        Undocumented
+		Args: [{ false name String} { false argidx int}], Returns: String
 */
 func (o *VisualScript) CustomSignalGetArgumentName(name gdnative.String, argidx gdnative.Int) gdnative.String {
 	//log.Println("Calling VisualScript.CustomSignalGetArgumentName()")
@@ -249,7 +308,7 @@ func (o *VisualScript) CustomSignalGetArgumentName(name gdnative.String, argidx
 	ptrArguments[1] = gdnative.NewPointerFromInt(argidx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_get_argument_name")
+	methodBind := methodBindVisualScriptCustomSignalGetArgumentName.Get()
 
 	// Call the parent method.
 	// String
@@ -262,8 +321,8 @@ func (o *VisualScript) CustomSignalGetArgumentName(name gdnative.String, argidx
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false argidx int}], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [{ false name String} { false argidx int}], Returns: enum.Variant::Type
 */
 func (o *VisualScript) CustomSignalGetArgumentType(name gdnative.String, argidx gdnative.Int) gdnative.VariantType {
 	//log.Println("Calling VisualScript.CustomSignalGetArgumentType()")
@@ -274,7 +333,7 @@ func (o *VisualScript) CustomSignalGetArgumentType(name gdnative.String, argidx
 	ptrArguments[1] = gdnative.NewPointerFromInt(argidx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_get_argument_type")
+	methodBind := methodBindVisualScriptCustomSignalGetArgumentType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -287,8 +346,8 @@ func (o *VisualScript) CustomSignalGetArgumentType(name gdnative.String, argidx
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false argidx int}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false argidx int}], Returns: void
 */
 func (o *VisualScript) CustomSignalRemoveArgument(name gdnative.String, argidx gdnative.Int) {
 	//log.Println("Calling VisualScript.CustomSignalRemoveArgument()")
@@ -299,7 +358,7 @@ func (o *VisualScript) CustomSignalRemoveArgument(name gdnative.String, argidx g
 	ptrArguments[1] = gdnative.NewPointerFromInt(argidx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_remove_argument")
+	methodBind := methodBindVisualScriptCustomSignalRemoveArgument.Get()
 
 	// Call the parent method.
 	// void
@@ -309,8 +368,8 @@ func (o *VisualScript) CustomSignalRemoveArgument(name gdnative.String, argidx g
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false argidx int} { false argname String}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false argidx int} { false argname String}], Returns: void
 */
 func (o *VisualScript) CustomSignalSetArgumentName(name gdnative.String, argidx gdnative.Int, argname gdnative.String) {
 	//log.Println("Calling VisualScript.CustomSignalSetArgumentName()")
@@ -322,7 +381,7 @@ func (o *VisualScript) CustomSignalSetArgumentName(name gdnative.String, argidx
 	ptrArguments[2] = gdnative.NewPointerFromString(argname)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_set_argument_name")
+	methodBind := methodBindVisualScriptCustomSignalSetArgumentName.Get()
 
 	// Call the parent method.
 	// void
@@ -332,8 +391,8 @@ func (o *VisualScript) CustomSignalSetArgumentName(name gdnative.String, argidx
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false argidx int} { false type int}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false argidx int} { false type int}], Returns: void
 */
 func (o *VisualScript) CustomSignalSetArgumentType(name gdnative.String, argidx gdnative.Int, aType gdnative.Int) {
 	//log.Println("Calling VisualScript.CustomSignalSetArgumentType()")
@@ -345,7 +404,7 @@ func (o *VisualScript) CustomSignalSetArgumentType(name gdnative.String, argidx
 	ptrArguments[2] = gdnative.NewPointerFromInt(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_set_argument_type")
+	methodBind := methodBindVisualScriptCustomSignalSetArgumentType.Get()
 
 	// Call the parent method.
 	// void
@@ -355,8 +414,8 @@ func (o *VisualScript) CustomSignalSetArgumentType(name gdnative.String, argidx
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false argidx int} { false withidx int}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false argidx int} { false withidx int}], Returns: void
 */
 func (o *VisualScript) CustomSignalSwapArgument(name gdnative.String, argidx gdnative.Int, withidx gdnative.Int) {
 	//log.Println("Calling VisualScript.CustomSignalSwapArgument()")
@@ -368,7 +427,7 @@ func (o *VisualScript) CustomSignalSwapArgument(name gdnative.String, argidx gdn
 	ptrArguments[2] = gdnative.NewPointerFromInt(withidx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "custom_signal_swap_argument")
+	methodBind := methodBindVisualScriptCustomSignalSwapArgument.Get()
 
 	// Call the parent method.
 	// void
@@ -378,8 +437,8 @@ func (o *VisualScript) CustomSignalSwapArgument(name gdnative.String, argidx gdn
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false from_node int} { false from_port int} { false to_node int} { false to_port int}], Returns: void
+	        Undocumented
+		Args: [{ false func String} { false from_node int} { false from_port int} { false to_node int} { false to_port int}], Returns: void
 */
 func (o *VisualScript) DataConnect(function gdnative.String, fromNode gdnative.Int, fromPort gdnative.Int, toNode gdnative.Int, toPort gdnative.Int) {
 	//log.Println("Calling VisualScript.DataConnect()")
@@ -393,7 +452,7 @@ func (o *VisualScript) DataConnect(function gdnative.String, fromNode gdnative.I
 	ptrArguments[4] = gdnative.NewPointerFromInt(toPort)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "data_connect")
+	methodBind := methodBindVisualScriptDataConnect.Get()
 
 	// Call the parent method.
 	// void
@@ -403,8 +462,8 @@ func (o *VisualScript) DataConnect(function gdnative.String, fromNode gdnative.I
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false from_node int} { false from_port int} { false to_node int} { false to_port int}], Returns: void
+	        Undocumented
+		Args: [{ false func String} { false from_node int} { false from_port int} { false to_node int} { false to_port int}], Returns: void
 */
 func (o *VisualScript) DataDisconnect(function gdnative.String, fromNode gdnative.Int, fromPort gdnative.Int, toNode gdnative.Int, toPort gdnative.Int) {
 	//log.Println("Calling VisualScript.DataDisconnect()")
@@ -418,7 +477,7 @@ func (o *VisualScript) DataDisconnect(function gdnative.String, fromNode gdnativ
 	ptrArguments[4] = gdnative.NewPointerFromInt(toPort)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "data_disconnect")
+	methodBind := methodBindVisualScriptDataDisconnect.Get()
 
 	// Call the parent method.
 	// void
@@ -428,8 +487,8 @@ func (o *VisualScript) DataDisconnect(function gdnative.String, fromNode gdnativ
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: int
+	        Undocumented
+		Args: [{ false name String}], Returns: int
 */
 func (o *VisualScript) GetFunctionNodeId(name gdnative.String) gdnative.Int {
 	//log.Println("Calling VisualScript.GetFunctionNodeId()")
@@ -439,7 +498,7 @@ func (o *VisualScript) GetFunctionNodeId(name gdnative.String) gdnative.Int {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "get_function_node_id")
+	methodBind := methodBindVisualScriptGetFunctionNodeId.Get()
 
 	// Call the parent method.
 	// int
@@ -452,8 +511,8 @@ func (o *VisualScript) GetFunctionNodeId(name gdnative.String) gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: Vector2
+	        Undocumented
+		Args: [{ false name String}], Returns: Vector2
 */
 func (o *VisualScript) GetFunctionScroll(name gdnative.String) gdnative.Vector2 {
 	//log.Println("Calling VisualScript.GetFunctionScroll()")
@@ -463,7 +522,7 @@ func (o *VisualScript) GetFunctionScroll(name gdnative.String) gdnative.Vector2
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "get_function_scroll")
+	methodBind := methodBindVisualScriptGetFunctionScroll.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -476,8 +535,8 @@ func (o *VisualScript) GetFunctionScroll(name gdnative.String) gdnative.Vector2
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false id int}], Returns: VisualScriptNode
+	        Undocumented
+		Args: [{ false func String} { false id int}], Returns: VisualScriptNode
 */
 func (o *VisualScript) GetNode(function gdnative.String, id gdnative.Int) VisualScriptNodeImplementer {
 	//log.Println("Calling VisualScript.GetNode()")
@@ -488,7 +547,7 @@ func (o *VisualScript) GetNode(function gdnative.String, id gdnative.Int) Visual
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "get_node")
+	methodBind := methodBindVisualScriptGetNode.Get()
 
 	// Call the parent method.
 	// VisualScriptNode
@@ -496,27 +555,25 @@ func (o *VisualScript) GetNode(function gdnative.String, id gdnative.Int) Visual
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newVisualScriptNodeFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(VisualScriptNodeImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "VisualScriptNode" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(VisualScriptNodeImplementer)
+	ret := VisualScriptNode{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(VisualScriptNodeImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false id int}], Returns: Vector2
+	        Undocumented
+		Args: [{ false func String} { false id int}], Returns: Vector2
 */
 func (o *VisualScript) GetNodePosition(function gdnative.String, id gdnative.Int) gdnative.Vector2 {
 	//log.Println("Calling VisualScript.GetNodePosition()")
@@ -527,7 +584,7 @@ func (o *VisualScript) GetNodePosition(function gdnative.String, id gdnative.Int
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "get_node_position")
+	methodBind := methodBindVisualScriptGetNodePosition.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -540,8 +597,8 @@ func (o *VisualScript) GetNodePosition(function gdnative.String, id gdnative.Int
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: Variant
+	        Undocumented
+		Args: [{ false name String}], Returns: Variant
 */
 func (o *VisualScript) GetVariableDefaultValue(name gdnative.String) gdnative.Variant {
 	//log.Println("Calling VisualScript.GetVariableDefaultValue()")
@@ -551,7 +608,7 @@ func (o *VisualScript) GetVariableDefaultValue(name gdnative.String) gdnative.Va
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "get_variable_default_value")
+	methodBind := methodBindVisualScriptGetVariableDefaultValue.Get()
 
 	// Call the parent method.
 	// Variant
@@ -564,8 +621,8 @@ func (o *VisualScript) GetVariableDefaultValue(name gdnative.String) gdnative.Va
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: bool
+	        Undocumented
+		Args: [{ false name String}], Returns: bool
 */
 func (o *VisualScript) GetVariableExport(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling VisualScript.GetVariableExport()")
@@ -575,7 +632,7 @@ func (o *VisualScript) GetVariableExport(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "get_variable_export")
+	methodBind := methodBindVisualScriptGetVariableExport.Get()
 
 	// Call the parent method.
 	// bool
@@ -588,8 +645,8 @@ func (o *VisualScript) GetVariableExport(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: Dictionary
+	        Undocumented
+		Args: [{ false name String}], Returns: Dictionary
 */
 func (o *VisualScript) GetVariableInfo(name gdnative.String) gdnative.Dictionary {
 	//log.Println("Calling VisualScript.GetVariableInfo()")
@@ -599,7 +656,7 @@ func (o *VisualScript) GetVariableInfo(name gdnative.String) gdnative.Dictionary
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "get_variable_info")
+	methodBind := methodBindVisualScriptGetVariableInfo.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -612,8 +669,8 @@ func (o *VisualScript) GetVariableInfo(name gdnative.String) gdnative.Dictionary
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: bool
+	        Undocumented
+		Args: [{ false name String}], Returns: bool
 */
 func (o *VisualScript) HasCustomSignal(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling VisualScript.HasCustomSignal()")
@@ -623,7 +680,7 @@ func (o *VisualScript) HasCustomSignal(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "has_custom_signal")
+	methodBind := methodBindVisualScriptHasCustomSignal.Get()
 
 	// Call the parent method.
 	// bool
@@ -636,8 +693,8 @@ func (o *VisualScript) HasCustomSignal(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false from_node int} { false from_port int} { false to_node int} { false to_port int}], Returns: bool
+	        Undocumented
+		Args: [{ false func String} { false from_node int} { false from_port int} { false to_node int} { false to_port int}], Returns: bool
 */
 func (o *VisualScript) HasDataConnection(function gdnative.String, fromNode gdnative.Int, fromPort gdnative.Int, toNode gdnative.Int, toPort gdnative.Int) gdnative.Bool {
 	//log.Println("Calling VisualScript.HasDataConnection()")
@@ -651,7 +708,7 @@ func (o *VisualScript) HasDataConnection(function gdnative.String, fromNode gdna
 	ptrArguments[4] = gdnative.NewPointerFromInt(toPort)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "has_data_connection")
+	methodBind := methodBindVisualScriptHasDataConnection.Get()
 
 	// Call the parent method.
 	// bool
@@ -664,8 +721,8 @@ func (o *VisualScript) HasDataConnection(function gdnative.String, fromNode gdna
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: bool
+	        Undocumented
+		Args: [{ false name String}], Returns: bool
 */
 func (o *VisualScript) HasFunction(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling VisualScript.HasFunction()")
@@ -675,7 +732,7 @@ func (o *VisualScript) HasFunction(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "has_function")
+	methodBind := methodBindVisualScriptHasFunction.Get()
 
 	// Call the parent method.
 	// bool
@@ -688,8 +745,8 @@ func (o *VisualScript) HasFunction(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false id int}], Returns: bool
+	        Undocumented
+		Args: [{ false func String} { false id int}], Returns: bool
 */
 func (o *VisualScript) HasNode(function gdnative.String, id gdnative.Int) gdnative.Bool {
 	//log.Println("Calling VisualScript.HasNode()")
@@ -700,7 +757,7 @@ func (o *VisualScript) HasNode(function gdnative.String, id gdnative.Int) gdnati
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "has_node")
+	methodBind := methodBindVisualScriptHasNode.Get()
 
 	// Call the parent method.
 	// bool
@@ -713,8 +770,8 @@ func (o *VisualScript) HasNode(function gdnative.String, id gdnative.Int) gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false from_node int} { false from_output int} { false to_node int}], Returns: bool
+	        Undocumented
+		Args: [{ false func String} { false from_node int} { false from_output int} { false to_node int}], Returns: bool
 */
 func (o *VisualScript) HasSequenceConnection(function gdnative.String, fromNode gdnative.Int, fromOutput gdnative.Int, toNode gdnative.Int) gdnative.Bool {
 	//log.Println("Calling VisualScript.HasSequenceConnection()")
@@ -727,7 +784,7 @@ func (o *VisualScript) HasSequenceConnection(function gdnative.String, fromNode
 	ptrArguments[3] = gdnative.NewPointerFromInt(toNode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "has_sequence_connection")
+	methodBind := methodBindVisualScriptHasSequenceConnection.Get()
 
 	// Call the parent method.
 	// bool
@@ -740,8 +797,8 @@ func (o *VisualScript) HasSequenceConnection(function gdnative.String, fromNode
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: bool
+	        Undocumented
+		Args: [{ false name String}], Returns: bool
 */
 func (o *VisualScript) HasVariable(name gdnative.String) gdnative.Bool {
 	//log.Println("Calling VisualScript.HasVariable()")
@@ -751,7 +808,7 @@ func (o *VisualScript) HasVariable(name gdnative.String) gdnative.Bool {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "has_variable")
+	methodBind := methodBindVisualScriptHasVariable.Get()
 
 	// Call the parent method.
 	// bool
@@ -764,8 +821,8 @@ func (o *VisualScript) HasVariable(name gdnative.String) gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScript) RemoveCustomSignal(name gdnative.String) {
 	//log.Println("Calling VisualScript.RemoveCustomSignal()")
@@ -775,7 +832,7 @@ func (o *VisualScript) RemoveCustomSignal(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "remove_custom_signal")
+	methodBind := methodBindVisualScriptRemoveCustomSignal.Get()
 
 	// Call the parent method.
 	// void
@@ -785,8 +842,8 @@ func (o *VisualScript) RemoveCustomSignal(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScript) RemoveFunction(name gdnative.String) {
 	//log.Println("Calling VisualScript.RemoveFunction()")
@@ -796,7 +853,7 @@ func (o *VisualScript) RemoveFunction(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "remove_function")
+	methodBind := methodBindVisualScriptRemoveFunction.Get()
 
 	// Call the parent method.
 	// void
@@ -806,8 +863,8 @@ func (o *VisualScript) RemoveFunction(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false id int}], Returns: void
+	        Undocumented
+		Args: [{ false func String} { false id int}], Returns: void
 */
 func (o *VisualScript) RemoveNode(function gdnative.String, id gdnative.Int) {
 	//log.Println("Calling VisualScript.RemoveNode()")
@@ -818,7 +875,7 @@ func (o *VisualScript) RemoveNode(function gdnative.String, id gdnative.Int) {
 	ptrArguments[1] = gdnative.NewPointerFromInt(id)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "remove_node")
+	methodBind := methodBindVisualScriptRemoveNode.Get()
 
 	// Call the parent method.
 	// void
@@ -828,8 +885,8 @@ func (o *VisualScript) RemoveNode(function gdnative.String, id gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScript) RemoveVariable(name gdnative.String) {
 	//log.Println("Calling VisualScript.RemoveVariable()")
@@ -839,7 +896,7 @@ func (o *VisualScript) RemoveVariable(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "remove_variable")
+	methodBind := methodBindVisualScriptRemoveVariable.Get()
 
 	// Call the parent method.
 	// void
@@ -849,8 +906,8 @@ func (o *VisualScript) RemoveVariable(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false new_name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false new_name String}], Returns: void
 */
 func (o *VisualScript) RenameCustomSignal(name gdnative.String, newName gdnative.String) {
 	//log.Println("Calling VisualScript.RenameCustomSignal()")
@@ -861,7 +918,7 @@ func (o *VisualScript) RenameCustomSignal(name gdnative.String, newName gdnative
 	ptrArguments[1] = gdnative.NewPointerFromString(newName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "rename_custom_signal")
+	methodBind := methodBindVisualScriptRenameCustomSignal.Get()
 
 	// Call the parent method.
 	// void
@@ -871,8 +928,8 @@ func (o *VisualScript) RenameCustomSignal(name gdnative.String, newName gdnative
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false new_name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false new_name String}], Returns: void
 */
 func (o *VisualScript) RenameFunction(name gdnative.String, newName gdnative.String) {
 	//log.Println("Calling VisualScript.RenameFunction()")
@@ -883,7 +940,7 @@ func (o *VisualScript) RenameFunction(name gdnative.String, newName gdnative.Str
 	ptrArguments[1] = gdnative.NewPointerFromString(newName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "rename_function")
+	methodBind := methodBindVisualScriptRenameFunction.Get()
 
 	// Call the parent method.
 	// void
@@ -893,8 +950,8 @@ func (o *VisualScript) RenameFunction(name gdnative.String, newName gdnative.Str
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false new_name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false new_name String}], Returns: void
 */
 func (o *VisualScript) RenameVariable(name gdnative.String, newName gdnative.String) {
 	//log.Println("Calling VisualScript.RenameVariable()")
@@ -905,7 +962,7 @@ func (o *VisualScript) RenameVariable(name gdnative.String, newName gdnative.Str
 	ptrArguments[1] = gdnative.NewPointerFromString(newName)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "rename_variable")
+	methodBind := methodBindVisualScriptRenameVariable.Get()
 
 	// Call the parent method.
 	// void
@@ -915,8 +972,8 @@ func (o *VisualScript) RenameVariable(name gdnative.String, newName gdnative.Str
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false from_node int} { false from_output int} { false to_node int}], Returns: void
+	        Undocumented
+		Args: [{ false func String} { false from_node int} { false from_output int} { false to_node int}], Returns: void
 */
 func (o *VisualScript) SequenceConnect(function gdnative.String, fromNode gdnative.Int, fromOutput gdnative.Int, toNode gdnative.Int) {
 	//log.Println("Calling VisualScript.SequenceConnect()")
@@ -929,7 +986,7 @@ func (o *VisualScript) SequenceConnect(function gdnative.String, fromNode gdnati
 	ptrArguments[3] = gdnative.NewPointerFromInt(toNode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "sequence_connect")
+	methodBind := methodBindVisualScriptSequenceConnect.Get()
 
 	// Call the parent method.
 	// void
@@ -939,8 +996,8 @@ func (o *VisualScript) SequenceConnect(function gdnative.String, fromNode gdnati
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false from_node int} { false from_output int} { false to_node int}], Returns: void
+	        Undocumented
+		Args: [{ false func String} { false from_node int} { false from_output int} { false to_node int}], Returns: void
 */
 func (o *VisualScript) SequenceDisconnect(function gdnative.String, fromNode gdnative.Int, fromOutput gdnative.Int, toNode gdnative.Int) {
 	//log.Println("Calling VisualScript.SequenceDisconnect()")
@@ -953,7 +1010,7 @@ func (o *VisualScript) SequenceDisconnect(function gdnative.String, fromNode gdn
 	ptrArguments[3] = gdnative.NewPointerFromInt(toNode)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "sequence_disconnect")
+	methodBind := methodBindVisualScriptSequenceDisconnect.Get()
 
 	// Call the parent method.
 	// void
@@ -963,8 +1020,8 @@ func (o *VisualScript) SequenceDisconnect(function gdnative.String, fromNode gdn
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false ofs Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false ofs Vector2}], Returns: void
 */
 func (o *VisualScript) SetFunctionScroll(name gdnative.String, ofs gdnative.Vector2) {
 	//log.Println("Calling VisualScript.SetFunctionScroll()")
@@ -975,7 +1032,7 @@ func (o *VisualScript) SetFunctionScroll(name gdnative.String, ofs gdnative.Vect
 	ptrArguments[1] = gdnative.NewPointerFromVector2(ofs)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "set_function_scroll")
+	methodBind := methodBindVisualScriptSetFunctionScroll.Get()
 
 	// Call the parent method.
 	// void
@@ -985,8 +1042,8 @@ func (o *VisualScript) SetFunctionScroll(name gdnative.String, ofs gdnative.Vect
 }
 
 /*
-        Undocumented
-	Args: [{ false type String}], Returns: void
+	        Undocumented
+		Args: [{ false type String}], Returns: void
 */
 func (o *VisualScript) SetInstanceBaseType(aType gdnative.String) {
 	//log.Println("Calling VisualScript.SetInstanceBaseType()")
@@ -996,7 +1053,7 @@ func (o *VisualScript) SetInstanceBaseType(aType gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "set_instance_base_type")
+	methodBind := methodBindVisualScriptSetInstanceBaseType.Get()
 
 	// Call the parent method.
 	// void
@@ -1006,8 +1063,8 @@ func (o *VisualScript) SetInstanceBaseType(aType gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false func String} { false id int} { false position Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false func String} { false id int} { false position Vector2}], Returns: void
 */
 func (o *VisualScript) SetNodePosition(function gdnative.String, id gdnative.Int, position gdnative.Vector2) {
 	//log.Println("Calling VisualScript.SetNodePosition()")
@@ -1019,7 +1076,7 @@ func (o *VisualScript) SetNodePosition(function gdnative.String, id gdnative.Int
 	ptrArguments[2] = gdnative.NewPointerFromVector2(position)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "set_node_position")
+	methodBind := methodBindVisualScriptSetNodePosition.Get()
 
 	// Call the parent method.
 	// void
@@ -1029,8 +1086,8 @@ func (o *VisualScript) SetNodePosition(function gdnative.String, id gdnative.Int
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false value Variant}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false value Variant}], Returns: void
 */
 func (o *VisualScript) SetVariableDefaultValue(name gdnative.String, value gdnative.Variant) {
 	//log.Println("Calling VisualScript.SetVariableDefaultValue()")
@@ -1041,7 +1098,7 @@ func (o *VisualScript) SetVariableDefaultValue(name gdnative.String, value gdnat
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "set_variable_default_value")
+	methodBind := methodBindVisualScriptSetVariableDefaultValue.Get()
 
 	// Call the parent method.
 	// void
@@ -1051,8 +1108,8 @@ func (o *VisualScript) SetVariableDefaultValue(name gdnative.String, value gdnat
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false enable bool}], Returns: void
 */
 func (o *VisualScript) SetVariableExport(name gdnative.String, enable gdnative.Bool) {
 	//log.Println("Calling VisualScript.SetVariableExport()")
@@ -1063,7 +1120,7 @@ func (o *VisualScript) SetVariableExport(name gdnative.String, enable gdnative.B
 	ptrArguments[1] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "set_variable_export")
+	methodBind := methodBindVisualScriptSetVariableExport.Get()
 
 	// Call the parent method.
 	// void
@@ -1073,8 +1130,8 @@ func (o *VisualScript) SetVariableExport(name gdnative.String, enable gdnative.B
 }
 
 /*
-        Undocumented
-	Args: [{ false name String} { false value Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false name String} { false value Dictionary}], Returns: void
 */
 func (o *VisualScript) SetVariableInfo(name gdnative.String, value gdnative.Dictionary) {
 	//log.Println("Calling VisualScript.SetVariableInfo()")
@@ -1085,7 +1142,7 @@ func (o *VisualScript) SetVariableInfo(name gdnative.String, value gdnative.Dict
 	ptrArguments[1] = gdnative.NewPointerFromDictionary(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScript", "set_variable_info")
+	methodBind := methodBindVisualScriptSetVariableInfo.Get()
 
 	// Call the parent method.
 	// void
@@ -1094,10 +1151,68 @@ func (o *VisualScript) SetVariableInfo(name gdnative.String, value gdnative.Dict
 
 }
 
+// AddNodeWithDefaults will call AddNode using the default values for: position.
+func (o *VisualScript) AddNodeWithDefaults(function gdnative.String, id gdnative.Int, node VisualScriptNodeImplementer) {
+	o.AddNode(function, id, node, gdnative.NewVector2(0, 0))
+}
+
+// AddVariableWithDefaults will call AddVariable using the default values for: default_value, export.
+func (o *VisualScript) AddVariableWithDefaults(name gdnative.String) {
+	default0 := gdnative.NewVariantNil()
+	defer default0.Destroy()
+	o.AddVariable(name, default0, false)
+}
+
+// CustomSignalAddArgumentWithDefaults will call CustomSignalAddArgument using the default values for: index.
+func (o *VisualScript) CustomSignalAddArgumentWithDefaults(name gdnative.String, aType gdnative.Int, argname gdnative.String) {
+	o.CustomSignalAddArgument(name, aType, argname, -1)
+}
+
+// Data will return the value of the "data" property.
+func (o *VisualScript) Data() gdnative.Dictionary {
+	return o.X_GetData()
+}
+
+// SetData will set the value of the "data" property.
+func (o *VisualScript) SetData(value gdnative.Dictionary) {
+	o.X_SetData(value)
+}
+
+// Signals of the VisualScript class.
+const (
+	VisualScriptSignalNodePortsChanged gdnative.String = "node_ports_changed"
+)
+
+// VisualScriptNodePortsChangedArgs holds the arguments of the "node_ports_changed" signal.
+type VisualScriptNodePortsChangedArgs struct {
+	Function gdnative.String
+	Id       gdnative.Int
+}
+
+// ConnectNodePortsChanged will connect the "node_ports_changed" signal to the given method of the target object.
+func (o *VisualScript) ConnectNodePortsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisualScriptSignalNodePortsChanged, target, method, binds, 0)
+}
+
+// EmitNodePortsChanged will emit the "node_ports_changed" signal.
+func (o *VisualScript) EmitNodePortsChanged(args VisualScriptNodePortsChangedArgs) error {
+	arg0 := gdnative.NewVariantString(args.Function)
+	defer arg0.Destroy()
+	arg1 := gdnative.NewVariantInt(gdnative.Int64T(args.Id))
+	defer arg1.Destroy()
+
+	ret, err := o.EmitSignal(VisualScriptSignalNodePortsChanged, arg0, arg1)
+	ret.Destroy()
+	return err
+}
+
 // VisualScriptImplementer is an interface that implements the methods
 // of the VisualScript class.
 type VisualScriptImplementer interface {
-	ScriptImplementer
+	godot.ScriptImplementer
 	X_GetData() gdnative.Dictionary
 	X_NodePortsChanged(arg0 gdnative.Int)
 	X_SetData(data gdnative.Dictionary)
@@ -1108,6 +1223,7 @@ type VisualScriptImplementer interface {
 	CustomSignalAddArgument(name gdnative.String, aType gdnative.Int, argname gdnative.String, index gdnative.Int)
 	CustomSignalGetArgumentCount(name gdnative.String) gdnative.Int
 	CustomSignalGetArgumentName(name gdnative.String, argidx gdnative.Int) gdnative.String
+	CustomSignalGetArgumentType(name gdnative.String, argidx gdnative.Int) gdnative.VariantType
 	CustomSignalRemoveArgument(name gdnative.String, argidx gdnative.Int)
 	CustomSignalSetArgumentName(name gdnative.String, argidx gdnative.Int, argname gdnative.String)
 	CustomSignalSetArgumentType(name gdnative.String, argidx gdnative.Int, aType gdnative.Int)
@@ -1142,4 +1258,11 @@ type VisualScriptImplementer interface {
 	SetVariableDefaultValue(name gdnative.String, value gdnative.Variant)
 	SetVariableExport(name gdnative.String, enable gdnative.Bool)
 	SetVariableInfo(name gdnative.String, value gdnative.Dictionary)
+	AddNodeWithDefaults(function gdnative.String, id gdnative.Int, node VisualScriptNodeImplementer)
+	AddVariableWithDefaults(name gdnative.String)
+	CustomSignalAddArgumentWithDefaults(name gdnative.String, aType gdnative.Int, argname gdnative.String)
+	Data() gdnative.Dictionary
+	SetData(value gdnative.Dictionary)
+	ConnectNodePortsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitNodePortsChanged(args VisualScriptNodePortsChangedArgs) error
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptBasicTypeConstantFromPointer(ptr gdnative.Pointer) VisualScriptBasicTypeConstant {
-func newVisualScriptBasicTypeConstantFromPointer(ptr gdnative.Pointer) VisualScriptBasicTypeConstant {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptBasicTypeConstant{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,39 @@ type VisualScriptBasicTypeConstant struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptBasicTypeConstant is the class constructor of the VisualScriptBasicTypeConstant class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptBasicTypeConstant = gdnative.NewLazyClassConstructor("VisualScriptBasicTypeConstant")
+
+// NewVisualScriptBasicTypeConstant will create a new instance of the VisualScriptBasicTypeConstant class.
+// VisualScriptBasicTypeConstant is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptBasicTypeConstant() *VisualScriptBasicTypeConstant {
+	obj := &VisualScriptBasicTypeConstant{}
+	obj.SetBaseObject(classConstructorVisualScriptBasicTypeConstant.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptBasicTypeConstant) BaseClass() string {
 	return "VisualScriptBasicTypeConstant"
 }
 
+// Method binds of the VisualScriptBasicTypeConstant class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptBasicTypeConstantGetBasicType         = gdnative.NewLazyMethodBind("VisualScriptBasicTypeConstant", "get_basic_type")
+	methodBindVisualScriptBasicTypeConstantGetBasicTypeConstant = gdnative.NewLazyMethodBind("VisualScriptBasicTypeConstant", "get_basic_type_constant")
+	methodBindVisualScriptBasicTypeConstantSetBasicType         = gdnative.NewLazyMethodBind("VisualScriptBasicTypeConstant", "set_basic_type")
+	methodBindVisualScriptBasicTypeConstantSetBasicTypeConstant = gdnative.NewLazyMethodBind("VisualScriptBasicTypeConstant", "set_basic_type_constant")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptBasicTypeConstant) GetBasicType() gdnative.VariantType {
 	//log.Println("Calling VisualScriptBasicTypeConstant.GetBasicType()")
@@ -45,7 +63,7 @@ func (o *VisualScriptBasicTypeConstant) GetBasicType() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptBasicTypeConstant", "get_basic_type")
+	methodBind := methodBindVisualScriptBasicTypeConstantGetBasicType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -58,8 +76,8 @@ func (o *VisualScriptBasicTypeConstant) GetBasicType() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptBasicTypeConstant) GetBasicTypeConstant() gdnative.String {
 	//log.Println("Calling VisualScriptBasicTypeConstant.GetBasicTypeConstant()")
@@ -68,7 +86,7 @@ func (o *VisualScriptBasicTypeConstant) GetBasicTypeConstant() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptBasicTypeConstant", "get_basic_type_constant")
+	methodBind := methodBindVisualScriptBasicTypeConstantGetBasicTypeConstant.Get()
 
 	// Call the parent method.
 	// String
@@ -81,18 +99,18 @@ func (o *VisualScriptBasicTypeConstant) GetBasicTypeConstant() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false name int}], Returns: void
+	        Undocumented
+		Args: [{ false name enum.Variant::Type}], Returns: void
 */
-func (o *VisualScriptBasicTypeConstant) SetBasicType(name gdnative.Int) {
+func (o *VisualScriptBasicTypeConstant) SetBasicType(name gdnative.VariantType) {
 	//log.Println("Calling VisualScriptBasicTypeConstant.SetBasicType()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(name)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(name))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptBasicTypeConstant", "set_basic_type")
+	methodBind := methodBindVisualScriptBasicTypeConstantSetBasicType.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *VisualScriptBasicTypeConstant) SetBasicType(name gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptBasicTypeConstant) SetBasicTypeConstant(name gdnative.String) {
 	//log.Println("Calling VisualScriptBasicTypeConstant.SetBasicTypeConstant()")
@@ -113,7 +131,7 @@ func (o *VisualScriptBasicTypeConstant) SetBasicTypeConstant(name gdnative.Strin
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptBasicTypeConstant", "set_basic_type_constant")
+	methodBind := methodBindVisualScriptBasicTypeConstantSetBasicTypeConstant.Get()
 
 	// Call the parent method.
 	// void
@@ -122,11 +140,30 @@ func (o *VisualScriptBasicTypeConstant) SetBasicTypeConstant(name gdnative.Strin
 
 }
 
+// BasicType will return the value of the "basic_type" property.
+func (o *VisualScriptBasicTypeConstant) BasicType() gdnative.VariantType {
+	return o.GetBasicType()
+}
+
+// Constant will return the value of the "constant" property.
+func (o *VisualScriptBasicTypeConstant) Constant() gdnative.String {
+	return o.GetBasicTypeConstant()
+}
+
+// SetConstant will set the value of the "constant" property.
+func (o *VisualScriptBasicTypeConstant) SetConstant(value gdnative.String) {
+	o.SetBasicTypeConstant(value)
+}
+
 // VisualScriptBasicTypeConstantImplementer is an interface that implements the methods
 // of the VisualScriptBasicTypeConstant class.
 type VisualScriptBasicTypeConstantImplementer interface {
 	VisualScriptNodeImplementer
+	GetBasicType() gdnative.VariantType
 	GetBasicTypeConstant() gdnative.String
-	SetBasicType(name gdnative.Int)
+	SetBasicType(name gdnative.VariantType)
 	SetBasicTypeConstant(name gdnative.String)
+	BasicType() gdnative.VariantType
+	Constant() gdnative.String
+	SetConstant(value gdnative.String)
 }
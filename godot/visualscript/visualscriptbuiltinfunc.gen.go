@@ -0,0 +1,457 @@
+package visualscript
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// VisualScriptBuiltinFuncBuiltinFunc is an enum for BuiltinFunc values.
+type VisualScriptBuiltinFuncBuiltinFunc int
+
+const (
+	VisualScriptBuiltinFuncBytesToVar          VisualScriptBuiltinFuncBuiltinFunc = 61
+	VisualScriptBuiltinFuncColorn              VisualScriptBuiltinFuncBuiltinFunc = 62
+	VisualScriptBuiltinFuncFuncFuncref         VisualScriptBuiltinFuncBuiltinFunc = 49
+	VisualScriptBuiltinFuncFuncMax             VisualScriptBuiltinFuncBuiltinFunc = 63
+	VisualScriptBuiltinFuncLogicClamp          VisualScriptBuiltinFuncBuiltinFunc = 46
+	VisualScriptBuiltinFuncLogicMax            VisualScriptBuiltinFuncBuiltinFunc = 44
+	VisualScriptBuiltinFuncLogicMin            VisualScriptBuiltinFuncBuiltinFunc = 45
+	VisualScriptBuiltinFuncLogicNearestPo2     VisualScriptBuiltinFuncBuiltinFunc = 47
+	VisualScriptBuiltinFuncMathAbs             VisualScriptBuiltinFuncBuiltinFunc = 16
+	VisualScriptBuiltinFuncMathAcos            VisualScriptBuiltinFuncBuiltinFunc = 7
+	VisualScriptBuiltinFuncMathAsin            VisualScriptBuiltinFuncBuiltinFunc = 6
+	VisualScriptBuiltinFuncMathAtan            VisualScriptBuiltinFuncBuiltinFunc = 8
+	VisualScriptBuiltinFuncMathAtan2           VisualScriptBuiltinFuncBuiltinFunc = 9
+	VisualScriptBuiltinFuncMathCartesian2Polar VisualScriptBuiltinFuncBuiltinFunc = 41
+	VisualScriptBuiltinFuncMathCeil            VisualScriptBuiltinFuncBuiltinFunc = 14
+	VisualScriptBuiltinFuncMathCos             VisualScriptBuiltinFuncBuiltinFunc = 1
+	VisualScriptBuiltinFuncMathCosh            VisualScriptBuiltinFuncBuiltinFunc = 4
+	VisualScriptBuiltinFuncMathDb2Linear       VisualScriptBuiltinFuncBuiltinFunc = 39
+	VisualScriptBuiltinFuncMathDecimals        VisualScriptBuiltinFuncBuiltinFunc = 24
+	VisualScriptBuiltinFuncMathDectime         VisualScriptBuiltinFuncBuiltinFunc = 29
+	VisualScriptBuiltinFuncMathDeg2Rad         VisualScriptBuiltinFuncBuiltinFunc = 36
+	VisualScriptBuiltinFuncMathEase            VisualScriptBuiltinFuncBuiltinFunc = 23
+	VisualScriptBuiltinFuncMathExp             VisualScriptBuiltinFuncBuiltinFunc = 20
+	VisualScriptBuiltinFuncMathFloor           VisualScriptBuiltinFuncBuiltinFunc = 13
+	VisualScriptBuiltinFuncMathFmod            VisualScriptBuiltinFuncBuiltinFunc = 11
+	VisualScriptBuiltinFuncMathFposmod         VisualScriptBuiltinFuncBuiltinFunc = 12
+	VisualScriptBuiltinFuncMathInverseLerp     VisualScriptBuiltinFuncBuiltinFunc = 27
+	VisualScriptBuiltinFuncMathIsinf           VisualScriptBuiltinFuncBuiltinFunc = 22
+	VisualScriptBuiltinFuncMathIsnan           VisualScriptBuiltinFuncBuiltinFunc = 21
+	VisualScriptBuiltinFuncMathLerp            VisualScriptBuiltinFuncBuiltinFunc = 26
+	VisualScriptBuiltinFuncMathLinear2Db       VisualScriptBuiltinFuncBuiltinFunc = 38
+	VisualScriptBuiltinFuncMathLog             VisualScriptBuiltinFuncBuiltinFunc = 19
+	VisualScriptBuiltinFuncMathPolar2Cartesian VisualScriptBuiltinFuncBuiltinFunc = 40
+	VisualScriptBuiltinFuncMathPow             VisualScriptBuiltinFuncBuiltinFunc = 18
+	VisualScriptBuiltinFuncMathRad2Deg         VisualScriptBuiltinFuncBuiltinFunc = 37
+	VisualScriptBuiltinFuncMathRand            VisualScriptBuiltinFuncBuiltinFunc = 31
+	VisualScriptBuiltinFuncMathRandf           VisualScriptBuiltinFuncBuiltinFunc = 32
+	VisualScriptBuiltinFuncMathRandom          VisualScriptBuiltinFuncBuiltinFunc = 33
+	VisualScriptBuiltinFuncMathRandomize       VisualScriptBuiltinFuncBuiltinFunc = 30
+	VisualScriptBuiltinFuncMathRandseed        VisualScriptBuiltinFuncBuiltinFunc = 35
+	VisualScriptBuiltinFuncMathRangeLerp       VisualScriptBuiltinFuncBuiltinFunc = 28
+	VisualScriptBuiltinFuncMathRound           VisualScriptBuiltinFuncBuiltinFunc = 15
+	VisualScriptBuiltinFuncMathSeed            VisualScriptBuiltinFuncBuiltinFunc = 34
+	VisualScriptBuiltinFuncMathSign            VisualScriptBuiltinFuncBuiltinFunc = 17
+	VisualScriptBuiltinFuncMathSin             VisualScriptBuiltinFuncBuiltinFunc = 0
+	VisualScriptBuiltinFuncMathSinh            VisualScriptBuiltinFuncBuiltinFunc = 3
+	VisualScriptBuiltinFuncMathSqrt            VisualScriptBuiltinFuncBuiltinFunc = 10
+	VisualScriptBuiltinFuncMathStepify         VisualScriptBuiltinFuncBuiltinFunc = 25
+	VisualScriptBuiltinFuncMathTan             VisualScriptBuiltinFuncBuiltinFunc = 2
+	VisualScriptBuiltinFuncMathTanh            VisualScriptBuiltinFuncBuiltinFunc = 5
+	VisualScriptBuiltinFuncMathWrap            VisualScriptBuiltinFuncBuiltinFunc = 42
+	VisualScriptBuiltinFuncMathWrapf           VisualScriptBuiltinFuncBuiltinFunc = 43
+	VisualScriptBuiltinFuncObjWeakref          VisualScriptBuiltinFuncBuiltinFunc = 48
+	VisualScriptBuiltinFuncStrToVar            VisualScriptBuiltinFuncBuiltinFunc = 59
+	VisualScriptBuiltinFuncTextChar            VisualScriptBuiltinFuncBuiltinFunc = 53
+	VisualScriptBuiltinFuncTextPrint           VisualScriptBuiltinFuncBuiltinFunc = 55
+	VisualScriptBuiltinFuncTextPrinterr        VisualScriptBuiltinFuncBuiltinFunc = 56
+	VisualScriptBuiltinFuncTextPrintraw        VisualScriptBuiltinFuncBuiltinFunc = 57
+	VisualScriptBuiltinFuncTextStr             VisualScriptBuiltinFuncBuiltinFunc = 54
+	VisualScriptBuiltinFuncTypeConvert         VisualScriptBuiltinFuncBuiltinFunc = 50
+	VisualScriptBuiltinFuncTypeExists          VisualScriptBuiltinFuncBuiltinFunc = 52
+	VisualScriptBuiltinFuncTypeOf              VisualScriptBuiltinFuncBuiltinFunc = 51
+	VisualScriptBuiltinFuncVarToBytes          VisualScriptBuiltinFuncBuiltinFunc = 60
+	VisualScriptBuiltinFuncVarToStr            VisualScriptBuiltinFuncBuiltinFunc = 58
+)
+
+// String will return the Godot name of the VisualScriptBuiltinFuncBuiltinFunc value.
+func (e VisualScriptBuiltinFuncBuiltinFunc) String() string {
+	switch e {
+	case VisualScriptBuiltinFuncBytesToVar:
+		return "BYTES_TO_VAR"
+	case VisualScriptBuiltinFuncColorn:
+		return "COLORN"
+	case VisualScriptBuiltinFuncFuncFuncref:
+		return "FUNC_FUNCREF"
+	case VisualScriptBuiltinFuncFuncMax:
+		return "FUNC_MAX"
+	case VisualScriptBuiltinFuncLogicClamp:
+		return "LOGIC_CLAMP"
+	case VisualScriptBuiltinFuncLogicMax:
+		return "LOGIC_MAX"
+	case VisualScriptBuiltinFuncLogicMin:
+		return "LOGIC_MIN"
+	case VisualScriptBuiltinFuncLogicNearestPo2:
+		return "LOGIC_NEAREST_PO2"
+	case VisualScriptBuiltinFuncMathAbs:
+		return "MATH_ABS"
+	case VisualScriptBuiltinFuncMathAcos:
+		return "MATH_ACOS"
+	case VisualScriptBuiltinFuncMathAsin:
+		return "MATH_ASIN"
+	case VisualScriptBuiltinFuncMathAtan:
+		return "MATH_ATAN"
+	case VisualScriptBuiltinFuncMathAtan2:
+		return "MATH_ATAN2"
+	case VisualScriptBuiltinFuncMathCartesian2Polar:
+		return "MATH_CARTESIAN2POLAR"
+	case VisualScriptBuiltinFuncMathCeil:
+		return "MATH_CEIL"
+	case VisualScriptBuiltinFuncMathCos:
+		return "MATH_COS"
+	case VisualScriptBuiltinFuncMathCosh:
+		return "MATH_COSH"
+	case VisualScriptBuiltinFuncMathDb2Linear:
+		return "MATH_DB2LINEAR"
+	case VisualScriptBuiltinFuncMathDecimals:
+		return "MATH_DECIMALS"
+	case VisualScriptBuiltinFuncMathDectime:
+		return "MATH_DECTIME"
+	case VisualScriptBuiltinFuncMathDeg2Rad:
+		return "MATH_DEG2RAD"
+	case VisualScriptBuiltinFuncMathEase:
+		return "MATH_EASE"
+	case VisualScriptBuiltinFuncMathExp:
+		return "MATH_EXP"
+	case VisualScriptBuiltinFuncMathFloor:
+		return "MATH_FLOOR"
+	case VisualScriptBuiltinFuncMathFmod:
+		return "MATH_FMOD"
+	case VisualScriptBuiltinFuncMathFposmod:
+		return "MATH_FPOSMOD"
+	case VisualScriptBuiltinFuncMathInverseLerp:
+		return "MATH_INVERSE_LERP"
+	case VisualScriptBuiltinFuncMathIsinf:
+		return "MATH_ISINF"
+	case VisualScriptBuiltinFuncMathIsnan:
+		return "MATH_ISNAN"
+	case VisualScriptBuiltinFuncMathLerp:
+		return "MATH_LERP"
+	case VisualScriptBuiltinFuncMathLinear2Db:
+		return "MATH_LINEAR2DB"
+	case VisualScriptBuiltinFuncMathLog:
+		return "MATH_LOG"
+	case VisualScriptBuiltinFuncMathPolar2Cartesian:
+		return "MATH_POLAR2CARTESIAN"
+	case VisualScriptBuiltinFuncMathPow:
+		return "MATH_POW"
+	case VisualScriptBuiltinFuncMathRad2Deg:
+		return "MATH_RAD2DEG"
+	case VisualScriptBuiltinFuncMathRand:
+		return "MATH_RAND"
+	case VisualScriptBuiltinFuncMathRandf:
+		return "MATH_RANDF"
+	case VisualScriptBuiltinFuncMathRandom:
+		return "MATH_RANDOM"
+	case VisualScriptBuiltinFuncMathRandomize:
+		return "MATH_RANDOMIZE"
+	case VisualScriptBuiltinFuncMathRandseed:
+		return "MATH_RANDSEED"
+	case VisualScriptBuiltinFuncMathRangeLerp:
+		return "MATH_RANGE_LERP"
+	case VisualScriptBuiltinFuncMathRound:
+		return "MATH_ROUND"
+	case VisualScriptBuiltinFuncMathSeed:
+		return "MATH_SEED"
+	case VisualScriptBuiltinFuncMathSign:
+		return "MATH_SIGN"
+	case VisualScriptBuiltinFuncMathSin:
+		return "MATH_SIN"
+	case VisualScriptBuiltinFuncMathSinh:
+		return "MATH_SINH"
+	case VisualScriptBuiltinFuncMathSqrt:
+		return "MATH_SQRT"
+	case VisualScriptBuiltinFuncMathStepify:
+		return "MATH_STEPIFY"
+	case VisualScriptBuiltinFuncMathTan:
+		return "MATH_TAN"
+	case VisualScriptBuiltinFuncMathTanh:
+		return "MATH_TANH"
+	case VisualScriptBuiltinFuncMathWrap:
+		return "MATH_WRAP"
+	case VisualScriptBuiltinFuncMathWrapf:
+		return "MATH_WRAPF"
+	case VisualScriptBuiltinFuncObjWeakref:
+		return "OBJ_WEAKREF"
+	case VisualScriptBuiltinFuncStrToVar:
+		return "STR_TO_VAR"
+	case VisualScriptBuiltinFuncTextChar:
+		return "TEXT_CHAR"
+	case VisualScriptBuiltinFuncTextPrint:
+		return "TEXT_PRINT"
+	case VisualScriptBuiltinFuncTextPrinterr:
+		return "TEXT_PRINTERR"
+	case VisualScriptBuiltinFuncTextPrintraw:
+		return "TEXT_PRINTRAW"
+	case VisualScriptBuiltinFuncTextStr:
+		return "TEXT_STR"
+	case VisualScriptBuiltinFuncTypeConvert:
+		return "TYPE_CONVERT"
+	case VisualScriptBuiltinFuncTypeExists:
+		return "TYPE_EXISTS"
+	case VisualScriptBuiltinFuncTypeOf:
+		return "TYPE_OF"
+	case VisualScriptBuiltinFuncVarToBytes:
+		return "VAR_TO_BYTES"
+	case VisualScriptBuiltinFuncVarToStr:
+		return "VAR_TO_STR"
+	}
+	return fmt.Sprintf("VisualScriptBuiltinFuncBuiltinFunc(%d)", int(e))
+}
+
+// ParseVisualScriptBuiltinFuncBuiltinFunc will return the VisualScriptBuiltinFuncBuiltinFunc value with the given Godot name.
+func ParseVisualScriptBuiltinFuncBuiltinFunc(name string) (VisualScriptBuiltinFuncBuiltinFunc, error) {
+	switch name {
+	case "BYTES_TO_VAR":
+		return VisualScriptBuiltinFuncBytesToVar, nil
+	case "COLORN":
+		return VisualScriptBuiltinFuncColorn, nil
+	case "FUNC_FUNCREF":
+		return VisualScriptBuiltinFuncFuncFuncref, nil
+	case "FUNC_MAX":
+		return VisualScriptBuiltinFuncFuncMax, nil
+	case "LOGIC_CLAMP":
+		return VisualScriptBuiltinFuncLogicClamp, nil
+	case "LOGIC_MAX":
+		return VisualScriptBuiltinFuncLogicMax, nil
+	case "LOGIC_MIN":
+		return VisualScriptBuiltinFuncLogicMin, nil
+	case "LOGIC_NEAREST_PO2":
+		return VisualScriptBuiltinFuncLogicNearestPo2, nil
+	case "MATH_ABS":
+		return VisualScriptBuiltinFuncMathAbs, nil
+	case "MATH_ACOS":
+		return VisualScriptBuiltinFuncMathAcos, nil
+	case "MATH_ASIN":
+		return VisualScriptBuiltinFuncMathAsin, nil
+	case "MATH_ATAN":
+		return VisualScriptBuiltinFuncMathAtan, nil
+	case "MATH_ATAN2":
+		return VisualScriptBuiltinFuncMathAtan2, nil
+	case "MATH_CARTESIAN2POLAR":
+		return VisualScriptBuiltinFuncMathCartesian2Polar, nil
+	case "MATH_CEIL":
+		return VisualScriptBuiltinFuncMathCeil, nil
+	case "MATH_COS":
+		return VisualScriptBuiltinFuncMathCos, nil
+	case "MATH_COSH":
+		return VisualScriptBuiltinFuncMathCosh, nil
+	case "MATH_DB2LINEAR":
+		return VisualScriptBuiltinFuncMathDb2Linear, nil
+	case "MATH_DECIMALS":
+		return VisualScriptBuiltinFuncMathDecimals, nil
+	case "MATH_DECTIME":
+		return VisualScriptBuiltinFuncMathDectime, nil
+	case "MATH_DEG2RAD":
+		return VisualScriptBuiltinFuncMathDeg2Rad, nil
+	case "MATH_EASE":
+		return VisualScriptBuiltinFuncMathEase, nil
+	case "MATH_EXP":
+		return VisualScriptBuiltinFuncMathExp, nil
+	case "MATH_FLOOR":
+		return VisualScriptBuiltinFuncMathFloor, nil
+	case "MATH_FMOD":
+		return VisualScriptBuiltinFuncMathFmod, nil
+	case "MATH_FPOSMOD":
+		return VisualScriptBuiltinFuncMathFposmod, nil
+	case "MATH_INVERSE_LERP":
+		return VisualScriptBuiltinFuncMathInverseLerp, nil
+	case "MATH_ISINF":
+		return VisualScriptBuiltinFuncMathIsinf, nil
+	case "MATH_ISNAN":
+		return VisualScriptBuiltinFuncMathIsnan, nil
+	case "MATH_LERP":
+		return VisualScriptBuiltinFuncMathLerp, nil
+	case "MATH_LINEAR2DB":
+		return VisualScriptBuiltinFuncMathLinear2Db, nil
+	case "MATH_LOG":
+		return VisualScriptBuiltinFuncMathLog, nil
+	case "MATH_POLAR2CARTESIAN":
+		return VisualScriptBuiltinFuncMathPolar2Cartesian, nil
+	case "MATH_POW":
+		return VisualScriptBuiltinFuncMathPow, nil
+	case "MATH_RAD2DEG":
+		return VisualScriptBuiltinFuncMathRad2Deg, nil
+	case "MATH_RAND":
+		return VisualScriptBuiltinFuncMathRand, nil
+	case "MATH_RANDF":
+		return VisualScriptBuiltinFuncMathRandf, nil
+	case "MATH_RANDOM":
+		return VisualScriptBuiltinFuncMathRandom, nil
+	case "MATH_RANDOMIZE":
+		return VisualScriptBuiltinFuncMathRandomize, nil
+	case "MATH_RANDSEED":
+		return VisualScriptBuiltinFuncMathRandseed, nil
+	case "MATH_RANGE_LERP":
+		return VisualScriptBuiltinFuncMathRangeLerp, nil
+	case "MATH_ROUND":
+		return VisualScriptBuiltinFuncMathRound, nil
+	case "MATH_SEED":
+		return VisualScriptBuiltinFuncMathSeed, nil
+	case "MATH_SIGN":
+		return VisualScriptBuiltinFuncMathSign, nil
+	case "MATH_SIN":
+		return VisualScriptBuiltinFuncMathSin, nil
+	case "MATH_SINH":
+		return VisualScriptBuiltinFuncMathSinh, nil
+	case "MATH_SQRT":
+		return VisualScriptBuiltinFuncMathSqrt, nil
+	case "MATH_STEPIFY":
+		return VisualScriptBuiltinFuncMathStepify, nil
+	case "MATH_TAN":
+		return VisualScriptBuiltinFuncMathTan, nil
+	case "MATH_TANH":
+		return VisualScriptBuiltinFuncMathTanh, nil
+	case "MATH_WRAP":
+		return VisualScriptBuiltinFuncMathWrap, nil
+	case "MATH_WRAPF":
+		return VisualScriptBuiltinFuncMathWrapf, nil
+	case "OBJ_WEAKREF":
+		return VisualScriptBuiltinFuncObjWeakref, nil
+	case "STR_TO_VAR":
+		return VisualScriptBuiltinFuncStrToVar, nil
+	case "TEXT_CHAR":
+		return VisualScriptBuiltinFuncTextChar, nil
+	case "TEXT_PRINT":
+		return VisualScriptBuiltinFuncTextPrint, nil
+	case "TEXT_PRINTERR":
+		return VisualScriptBuiltinFuncTextPrinterr, nil
+	case "TEXT_PRINTRAW":
+		return VisualScriptBuiltinFuncTextPrintraw, nil
+	case "TEXT_STR":
+		return VisualScriptBuiltinFuncTextStr, nil
+	case "TYPE_CONVERT":
+		return VisualScriptBuiltinFuncTypeConvert, nil
+	case "TYPE_EXISTS":
+		return VisualScriptBuiltinFuncTypeExists, nil
+	case "TYPE_OF":
+		return VisualScriptBuiltinFuncTypeOf, nil
+	case "VAR_TO_BYTES":
+		return VisualScriptBuiltinFuncVarToBytes, nil
+	case "VAR_TO_STR":
+		return VisualScriptBuiltinFuncVarToStr, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptBuiltinFuncBuiltinFunc value %q", name)
+}
+
+/*
+Undocumented
+*/
+type VisualScriptBuiltinFunc struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptBuiltinFunc is the class constructor of the VisualScriptBuiltinFunc class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptBuiltinFunc = gdnative.NewLazyClassConstructor("VisualScriptBuiltinFunc")
+
+// NewVisualScriptBuiltinFunc will create a new instance of the VisualScriptBuiltinFunc class.
+// VisualScriptBuiltinFunc is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptBuiltinFunc() *VisualScriptBuiltinFunc {
+	obj := &VisualScriptBuiltinFunc{}
+	obj.SetBaseObject(classConstructorVisualScriptBuiltinFunc.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptBuiltinFunc) BaseClass() string {
+	return "VisualScriptBuiltinFunc"
+}
+
+// Method binds of the VisualScriptBuiltinFunc class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptBuiltinFuncGetFunc = gdnative.NewLazyMethodBind("VisualScriptBuiltinFunc", "get_func")
+	methodBindVisualScriptBuiltinFuncSetFunc = gdnative.NewLazyMethodBind("VisualScriptBuiltinFunc", "set_func")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptBuiltinFunc::BuiltinFunc
+*/
+func (o *VisualScriptBuiltinFunc) GetFunc() VisualScriptBuiltinFuncBuiltinFunc {
+	//log.Println("Calling VisualScriptBuiltinFunc.GetFunc()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptBuiltinFuncGetFunc.Get()
+
+	// Call the parent method.
+	// enum.VisualScriptBuiltinFunc::BuiltinFunc
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return VisualScriptBuiltinFuncBuiltinFunc(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false which enum.VisualScriptBuiltinFunc::BuiltinFunc}], Returns: void
+*/
+func (o *VisualScriptBuiltinFunc) SetFunc(which VisualScriptBuiltinFuncBuiltinFunc) {
+	//log.Println("Calling VisualScriptBuiltinFunc.SetFunc()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(which))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptBuiltinFuncSetFunc.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Function will return the value of the "function" property.
+func (o *VisualScriptBuiltinFunc) Function() VisualScriptBuiltinFuncBuiltinFunc {
+	return o.GetFunc()
+}
+
+// SetFunction will set the value of the "function" property.
+func (o *VisualScriptBuiltinFunc) SetFunction(value VisualScriptBuiltinFuncBuiltinFunc) {
+	o.SetFunc(value)
+}
+
+// VisualScriptBuiltinFuncImplementer is an interface that implements the methods
+// of the VisualScriptBuiltinFunc class.
+type VisualScriptBuiltinFuncImplementer interface {
+	VisualScriptNodeImplementer
+	GetFunc() VisualScriptBuiltinFuncBuiltinFunc
+	SetFunc(which VisualScriptBuiltinFuncBuiltinFunc)
+	Function() VisualScriptBuiltinFuncBuiltinFunc
+	SetFunction(value VisualScriptBuiltinFuncBuiltinFunc)
+}
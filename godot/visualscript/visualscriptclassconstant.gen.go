@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptClassConstantFromPointer(ptr gdnative.Pointer) VisualScriptClassConstant {
-func newVisualScriptClassConstantFromPointer(ptr gdnative.Pointer) VisualScriptClassConstant {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptClassConstant{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,39 @@ type VisualScriptClassConstant struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptClassConstant is the class constructor of the VisualScriptClassConstant class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptClassConstant = gdnative.NewLazyClassConstructor("VisualScriptClassConstant")
+
+// NewVisualScriptClassConstant will create a new instance of the VisualScriptClassConstant class.
+// VisualScriptClassConstant is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptClassConstant() *VisualScriptClassConstant {
+	obj := &VisualScriptClassConstant{}
+	obj.SetBaseObject(classConstructorVisualScriptClassConstant.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptClassConstant) BaseClass() string {
 	return "VisualScriptClassConstant"
 }
 
+// Method binds of the VisualScriptClassConstant class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptClassConstantGetBaseType      = gdnative.NewLazyMethodBind("VisualScriptClassConstant", "get_base_type")
+	methodBindVisualScriptClassConstantGetClassConstant = gdnative.NewLazyMethodBind("VisualScriptClassConstant", "get_class_constant")
+	methodBindVisualScriptClassConstantSetBaseType      = gdnative.NewLazyMethodBind("VisualScriptClassConstant", "set_base_type")
+	methodBindVisualScriptClassConstantSetClassConstant = gdnative.NewLazyMethodBind("VisualScriptClassConstant", "set_class_constant")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptClassConstant) GetBaseType() gdnative.String {
 	//log.Println("Calling VisualScriptClassConstant.GetBaseType()")
@@ -45,7 +63,7 @@ func (o *VisualScriptClassConstant) GetBaseType() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptClassConstant", "get_base_type")
+	methodBind := methodBindVisualScriptClassConstantGetBaseType.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +76,8 @@ func (o *VisualScriptClassConstant) GetBaseType() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptClassConstant) GetClassConstant() gdnative.String {
 	//log.Println("Calling VisualScriptClassConstant.GetClassConstant()")
@@ -68,7 +86,7 @@ func (o *VisualScriptClassConstant) GetClassConstant() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptClassConstant", "get_class_constant")
+	methodBind := methodBindVisualScriptClassConstantGetClassConstant.Get()
 
 	// Call the parent method.
 	// String
@@ -81,8 +99,8 @@ func (o *VisualScriptClassConstant) GetClassConstant() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptClassConstant) SetBaseType(name gdnative.String) {
 	//log.Println("Calling VisualScriptClassConstant.SetBaseType()")
@@ -92,7 +110,7 @@ func (o *VisualScriptClassConstant) SetBaseType(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptClassConstant", "set_base_type")
+	methodBind := methodBindVisualScriptClassConstantSetBaseType.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *VisualScriptClassConstant) SetBaseType(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptClassConstant) SetClassConstant(name gdnative.String) {
 	//log.Println("Calling VisualScriptClassConstant.SetClassConstant()")
@@ -113,7 +131,7 @@ func (o *VisualScriptClassConstant) SetClassConstant(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptClassConstant", "set_class_constant")
+	methodBind := methodBindVisualScriptClassConstantSetClassConstant.Get()
 
 	// Call the parent method.
 	// void
@@ -122,6 +140,21 @@ func (o *VisualScriptClassConstant) SetClassConstant(name gdnative.String) {
 
 }
 
+// BaseType will return the value of the "base_type" property.
+func (o *VisualScriptClassConstant) BaseType() gdnative.String {
+	return o.GetBaseType()
+}
+
+// Constant will return the value of the "constant" property.
+func (o *VisualScriptClassConstant) Constant() gdnative.String {
+	return o.GetClassConstant()
+}
+
+// SetConstant will set the value of the "constant" property.
+func (o *VisualScriptClassConstant) SetConstant(value gdnative.String) {
+	o.SetClassConstant(value)
+}
+
 // VisualScriptClassConstantImplementer is an interface that implements the methods
 // of the VisualScriptClassConstant class.
 type VisualScriptClassConstantImplementer interface {
@@ -130,4 +163,7 @@ type VisualScriptClassConstantImplementer interface {
 	GetClassConstant() gdnative.String
 	SetBaseType(name gdnative.String)
 	SetClassConstant(name gdnative.String)
+	BaseType() gdnative.String
+	Constant() gdnative.String
+	SetConstant(value gdnative.String)
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptCommentFromPointer(ptr gdnative.Pointer) VisualScriptComment {
-func newVisualScriptCommentFromPointer(ptr gdnative.Pointer) VisualScriptComment {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptComment{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,41 @@ type VisualScriptComment struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptComment is the class constructor of the VisualScriptComment class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptComment = gdnative.NewLazyClassConstructor("VisualScriptComment")
+
+// NewVisualScriptComment will create a new instance of the VisualScriptComment class.
+// VisualScriptComment is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptComment() *VisualScriptComment {
+	obj := &VisualScriptComment{}
+	obj.SetBaseObject(classConstructorVisualScriptComment.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptComment) BaseClass() string {
 	return "VisualScriptComment"
 }
 
+// Method binds of the VisualScriptComment class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptCommentGetDescription = gdnative.NewLazyMethodBind("VisualScriptComment", "get_description")
+	methodBindVisualScriptCommentGetSize        = gdnative.NewLazyMethodBind("VisualScriptComment", "get_size")
+	methodBindVisualScriptCommentGetTitle       = gdnative.NewLazyMethodBind("VisualScriptComment", "get_title")
+	methodBindVisualScriptCommentSetDescription = gdnative.NewLazyMethodBind("VisualScriptComment", "set_description")
+	methodBindVisualScriptCommentSetSize        = gdnative.NewLazyMethodBind("VisualScriptComment", "set_size")
+	methodBindVisualScriptCommentSetTitle       = gdnative.NewLazyMethodBind("VisualScriptComment", "set_title")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptComment) GetDescription() gdnative.String {
 	//log.Println("Calling VisualScriptComment.GetDescription()")
@@ -45,7 +65,7 @@ func (o *VisualScriptComment) GetDescription() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptComment", "get_description")
+	methodBind := methodBindVisualScriptCommentGetDescription.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +78,8 @@ func (o *VisualScriptComment) GetDescription() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Vector2
+	        Undocumented
+		Args: [], Returns: Vector2
 */
 func (o *VisualScriptComment) GetSize() gdnative.Vector2 {
 	//log.Println("Calling VisualScriptComment.GetSize()")
@@ -68,7 +88,7 @@ func (o *VisualScriptComment) GetSize() gdnative.Vector2 {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptComment", "get_size")
+	methodBind := methodBindVisualScriptCommentGetSize.Get()
 
 	// Call the parent method.
 	// Vector2
@@ -81,8 +101,8 @@ func (o *VisualScriptComment) GetSize() gdnative.Vector2 {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptComment) GetTitle() gdnative.String {
 	//log.Println("Calling VisualScriptComment.GetTitle()")
@@ -91,7 +111,7 @@ func (o *VisualScriptComment) GetTitle() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptComment", "get_title")
+	methodBind := methodBindVisualScriptCommentGetTitle.Get()
 
 	// Call the parent method.
 	// String
@@ -104,8 +124,8 @@ func (o *VisualScriptComment) GetTitle() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false description String}], Returns: void
+	        Undocumented
+		Args: [{ false description String}], Returns: void
 */
 func (o *VisualScriptComment) SetDescription(description gdnative.String) {
 	//log.Println("Calling VisualScriptComment.SetDescription()")
@@ -115,7 +135,7 @@ func (o *VisualScriptComment) SetDescription(description gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(description)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptComment", "set_description")
+	methodBind := methodBindVisualScriptCommentSetDescription.Get()
 
 	// Call the parent method.
 	// void
@@ -125,8 +145,8 @@ func (o *VisualScriptComment) SetDescription(description gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false size Vector2}], Returns: void
+	        Undocumented
+		Args: [{ false size Vector2}], Returns: void
 */
 func (o *VisualScriptComment) SetSize(size gdnative.Vector2) {
 	//log.Println("Calling VisualScriptComment.SetSize()")
@@ -136,7 +156,7 @@ func (o *VisualScriptComment) SetSize(size gdnative.Vector2) {
 	ptrArguments[0] = gdnative.NewPointerFromVector2(size)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptComment", "set_size")
+	methodBind := methodBindVisualScriptCommentSetSize.Get()
 
 	// Call the parent method.
 	// void
@@ -146,8 +166,8 @@ func (o *VisualScriptComment) SetSize(size gdnative.Vector2) {
 }
 
 /*
-        Undocumented
-	Args: [{ false title String}], Returns: void
+	        Undocumented
+		Args: [{ false title String}], Returns: void
 */
 func (o *VisualScriptComment) SetTitle(title gdnative.String) {
 	//log.Println("Calling VisualScriptComment.SetTitle()")
@@ -157,7 +177,7 @@ func (o *VisualScriptComment) SetTitle(title gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(title)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptComment", "set_title")
+	methodBind := methodBindVisualScriptCommentSetTitle.Get()
 
 	// Call the parent method.
 	// void
@@ -166,6 +186,21 @@ func (o *VisualScriptComment) SetTitle(title gdnative.String) {
 
 }
 
+// Description will return the value of the "description" property.
+func (o *VisualScriptComment) Description() gdnative.String {
+	return o.GetDescription()
+}
+
+// Size will return the value of the "size" property.
+func (o *VisualScriptComment) Size() gdnative.Vector2 {
+	return o.GetSize()
+}
+
+// Title will return the value of the "title" property.
+func (o *VisualScriptComment) Title() gdnative.String {
+	return o.GetTitle()
+}
+
 // VisualScriptCommentImplementer is an interface that implements the methods
 // of the VisualScriptComment class.
 type VisualScriptCommentImplementer interface {
@@ -176,4 +211,7 @@ type VisualScriptCommentImplementer interface {
 	SetDescription(description gdnative.String)
 	SetSize(size gdnative.Vector2)
 	SetTitle(title gdnative.String)
+	Description() gdnative.String
+	Size() gdnative.Vector2
+	Title() gdnative.String
 }
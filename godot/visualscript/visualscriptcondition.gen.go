@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptConditionFromPointer(ptr gdnative.Pointer) VisualScriptCondition {
-func newVisualScriptConditionFromPointer(ptr gdnative.Pointer) VisualScriptCondition {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptCondition{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptCondition struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptCondition is the class constructor of the VisualScriptCondition class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptCondition = gdnative.NewLazyClassConstructor("VisualScriptCondition")
+
+// NewVisualScriptCondition will create a new instance of the VisualScriptCondition class.
+// VisualScriptCondition is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptCondition() *VisualScriptCondition {
+	obj := &VisualScriptCondition{}
+	obj.SetBaseObject(classConstructorVisualScriptCondition.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptCondition) BaseClass() string {
 	return "VisualScriptCondition"
 }
@@ -0,0 +1,175 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type VisualScriptConstant struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptConstant is the class constructor of the VisualScriptConstant class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptConstant = gdnative.NewLazyClassConstructor("VisualScriptConstant")
+
+// NewVisualScriptConstant will create a new instance of the VisualScriptConstant class.
+// VisualScriptConstant is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptConstant() *VisualScriptConstant {
+	obj := &VisualScriptConstant{}
+	obj.SetBaseObject(classConstructorVisualScriptConstant.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptConstant) BaseClass() string {
+	return "VisualScriptConstant"
+}
+
+// Method binds of the VisualScriptConstant class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptConstantGetConstantType  = gdnative.NewLazyMethodBind("VisualScriptConstant", "get_constant_type")
+	methodBindVisualScriptConstantGetConstantValue = gdnative.NewLazyMethodBind("VisualScriptConstant", "get_constant_value")
+	methodBindVisualScriptConstantSetConstantType  = gdnative.NewLazyMethodBind("VisualScriptConstant", "set_constant_type")
+	methodBindVisualScriptConstantSetConstantValue = gdnative.NewLazyMethodBind("VisualScriptConstant", "set_constant_value")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
+*/
+func (o *VisualScriptConstant) GetConstantType() gdnative.VariantType {
+	//log.Println("Calling VisualScriptConstant.GetConstantType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptConstantGetConstantType.Get()
+
+	// Call the parent method.
+	// enum.Variant::Type
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.VariantType(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: Variant
+*/
+func (o *VisualScriptConstant) GetConstantValue() gdnative.Variant {
+	//log.Println("Calling VisualScriptConstant.GetConstantValue()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptConstantGetConstantValue.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
+*/
+func (o *VisualScriptConstant) SetConstantType(aType gdnative.VariantType) {
+	//log.Println("Calling VisualScriptConstant.SetConstantType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptConstantSetConstantType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false value Variant}], Returns: void
+*/
+func (o *VisualScriptConstant) SetConstantValue(value gdnative.Variant) {
+	//log.Println("Calling VisualScriptConstant.SetConstantValue()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromVariant(value)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptConstantSetConstantValue.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Type will return the value of the "type" property.
+func (o *VisualScriptConstant) Type() gdnative.VariantType {
+	return o.GetConstantType()
+}
+
+// SetType will set the value of the "type" property.
+func (o *VisualScriptConstant) SetType(value gdnative.VariantType) {
+	o.SetConstantType(value)
+}
+
+// Value will return the value of the "value" property.
+func (o *VisualScriptConstant) Value() gdnative.Variant {
+	return o.GetConstantValue()
+}
+
+// SetValue will set the value of the "value" property.
+func (o *VisualScriptConstant) SetValue(value gdnative.Variant) {
+	o.SetConstantValue(value)
+}
+
+// VisualScriptConstantImplementer is an interface that implements the methods
+// of the VisualScriptConstant class.
+type VisualScriptConstantImplementer interface {
+	VisualScriptNodeImplementer
+	GetConstantType() gdnative.VariantType
+	GetConstantValue() gdnative.Variant
+	SetConstantType(aType gdnative.VariantType)
+	SetConstantValue(value gdnative.Variant)
+	Type() gdnative.VariantType
+	SetType(value gdnative.VariantType)
+	Value() gdnative.Variant
+	SetValue(value gdnative.Variant)
+}
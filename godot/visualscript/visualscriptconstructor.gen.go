@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptConstructorFromPointer(ptr gdnative.Pointer) VisualScriptConstructor {
-func newVisualScriptConstructorFromPointer(ptr gdnative.Pointer) VisualScriptConstructor {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptConstructor{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,39 @@ type VisualScriptConstructor struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptConstructor is the class constructor of the VisualScriptConstructor class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptConstructor = gdnative.NewLazyClassConstructor("VisualScriptConstructor")
+
+// NewVisualScriptConstructor will create a new instance of the VisualScriptConstructor class.
+// VisualScriptConstructor is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptConstructor() *VisualScriptConstructor {
+	obj := &VisualScriptConstructor{}
+	obj.SetBaseObject(classConstructorVisualScriptConstructor.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptConstructor) BaseClass() string {
 	return "VisualScriptConstructor"
 }
 
+// Method binds of the VisualScriptConstructor class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptConstructorGetConstructor     = gdnative.NewLazyMethodBind("VisualScriptConstructor", "get_constructor")
+	methodBindVisualScriptConstructorGetConstructorType = gdnative.NewLazyMethodBind("VisualScriptConstructor", "get_constructor_type")
+	methodBindVisualScriptConstructorSetConstructor     = gdnative.NewLazyMethodBind("VisualScriptConstructor", "set_constructor")
+	methodBindVisualScriptConstructorSetConstructorType = gdnative.NewLazyMethodBind("VisualScriptConstructor", "set_constructor_type")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *VisualScriptConstructor) GetConstructor() gdnative.Dictionary {
 	//log.Println("Calling VisualScriptConstructor.GetConstructor()")
@@ -45,7 +63,7 @@ func (o *VisualScriptConstructor) GetConstructor() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstructor", "get_constructor")
+	methodBind := methodBindVisualScriptConstructorGetConstructor.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -58,8 +76,8 @@ func (o *VisualScriptConstructor) GetConstructor() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptConstructor) GetConstructorType() gdnative.VariantType {
 	//log.Println("Calling VisualScriptConstructor.GetConstructorType()")
@@ -68,7 +86,7 @@ func (o *VisualScriptConstructor) GetConstructorType() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstructor", "get_constructor_type")
+	methodBind := methodBindVisualScriptConstructorGetConstructorType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -81,8 +99,8 @@ func (o *VisualScriptConstructor) GetConstructorType() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [{ false constructor Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false constructor Dictionary}], Returns: void
 */
 func (o *VisualScriptConstructor) SetConstructor(constructor gdnative.Dictionary) {
 	//log.Println("Calling VisualScriptConstructor.SetConstructor()")
@@ -92,7 +110,7 @@ func (o *VisualScriptConstructor) SetConstructor(constructor gdnative.Dictionary
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(constructor)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstructor", "set_constructor")
+	methodBind := methodBindVisualScriptConstructorSetConstructor.Get()
 
 	// Call the parent method.
 	// void
@@ -102,18 +120,18 @@ func (o *VisualScriptConstructor) SetConstructor(constructor gdnative.Dictionary
 }
 
 /*
-        Undocumented
-	Args: [{ false type int}], Returns: void
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
 */
-func (o *VisualScriptConstructor) SetConstructorType(aType gdnative.Int) {
+func (o *VisualScriptConstructor) SetConstructorType(aType gdnative.VariantType) {
 	//log.Println("Calling VisualScriptConstructor.SetConstructorType()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstructor", "set_constructor_type")
+	methodBind := methodBindVisualScriptConstructorSetConstructorType.Get()
 
 	// Call the parent method.
 	// void
@@ -122,11 +140,30 @@ func (o *VisualScriptConstructor) SetConstructorType(aType gdnative.Int) {
 
 }
 
+// Constructor will return the value of the "constructor" property.
+func (o *VisualScriptConstructor) Constructor() gdnative.Dictionary {
+	return o.GetConstructor()
+}
+
+// Type will return the value of the "type" property.
+func (o *VisualScriptConstructor) Type() gdnative.VariantType {
+	return o.GetConstructorType()
+}
+
+// SetType will set the value of the "type" property.
+func (o *VisualScriptConstructor) SetType(value gdnative.VariantType) {
+	o.SetConstructorType(value)
+}
+
 // VisualScriptConstructorImplementer is an interface that implements the methods
 // of the VisualScriptConstructor class.
 type VisualScriptConstructorImplementer interface {
 	VisualScriptNodeImplementer
 	GetConstructor() gdnative.Dictionary
+	GetConstructorType() gdnative.VariantType
 	SetConstructor(constructor gdnative.Dictionary)
-	SetConstructorType(aType gdnative.Int)
+	SetConstructorType(aType gdnative.VariantType)
+	Constructor() gdnative.Dictionary
+	Type() gdnative.VariantType
+	SetType(value gdnative.VariantType)
 }
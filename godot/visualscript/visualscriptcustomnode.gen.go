@@ -1,7 +1,10 @@
-package godot
+package visualscript
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,15 +25,41 @@ const (
 	VisualScriptCustomNodeStartModeResumeYield      VisualScriptCustomNodeStartMode = 2
 )
 
-//func NewVisualScriptCustomNodeFromPointer(ptr gdnative.Pointer) VisualScriptCustomNode {
-func newVisualScriptCustomNodeFromPointer(ptr gdnative.Pointer) VisualScriptCustomNode {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptCustomNode{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the VisualScriptCustomNodeStartMode value.
+func (e VisualScriptCustomNodeStartMode) String() string {
+	switch e {
+	case VisualScriptCustomNodeStartModeBeginSequence:
+		return "START_MODE_BEGIN_SEQUENCE"
+	case VisualScriptCustomNodeStartModeContinueSequence:
+		return "START_MODE_CONTINUE_SEQUENCE"
+	case VisualScriptCustomNodeStartModeResumeYield:
+		return "START_MODE_RESUME_YIELD"
+	}
+	return fmt.Sprintf("VisualScriptCustomNodeStartMode(%d)", int(e))
+}
 
-	return obj
+// ParseVisualScriptCustomNodeStartMode will return the VisualScriptCustomNodeStartMode value with the given Godot name.
+func ParseVisualScriptCustomNodeStartMode(name string) (VisualScriptCustomNodeStartMode, error) {
+	switch name {
+	case "START_MODE_BEGIN_SEQUENCE":
+		return VisualScriptCustomNodeStartModeBeginSequence, nil
+	case "START_MODE_CONTINUE_SEQUENCE":
+		return VisualScriptCustomNodeStartModeContinueSequence, nil
+	case "START_MODE_RESUME_YIELD":
+		return VisualScriptCustomNodeStartModeResumeYield, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptCustomNodeStartMode value %q", name)
 }
 
+// Constants of the VisualScriptCustomNode class.
+const (
+	VisualScriptCustomNodeStepExitFunctionBit gdnative.Int = 134217728
+	VisualScriptCustomNodeStepGoBackBit       gdnative.Int = 33554432
+	VisualScriptCustomNodeStepNoAdvanceBit    gdnative.Int = 67108864
+	VisualScriptCustomNodeStepPushStackBit    gdnative.Int = 16777216
+	VisualScriptCustomNodeStepYieldBit        gdnative.Int = 268435456
+)
+
 /*
 Undocumented
 */
@@ -39,13 +68,50 @@ type VisualScriptCustomNode struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptCustomNode is the class constructor of the VisualScriptCustomNode class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptCustomNode = gdnative.NewLazyClassConstructor("VisualScriptCustomNode")
+
+// NewVisualScriptCustomNode will create a new instance of the VisualScriptCustomNode class.
+// VisualScriptCustomNode is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptCustomNode() *VisualScriptCustomNode {
+	obj := &VisualScriptCustomNode{}
+	obj.SetBaseObject(classConstructorVisualScriptCustomNode.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptCustomNode) BaseClass() string {
 	return "VisualScriptCustomNode"
 }
 
+// Method binds of the VisualScriptCustomNode class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptCustomNodeX_GetCaption                 = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_caption")
+	methodBindVisualScriptCustomNodeX_GetCategory                = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_category")
+	methodBindVisualScriptCustomNodeX_GetInputValuePortCount     = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_input_value_port_count")
+	methodBindVisualScriptCustomNodeX_GetInputValuePortName      = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_input_value_port_name")
+	methodBindVisualScriptCustomNodeX_GetInputValuePortType      = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_input_value_port_type")
+	methodBindVisualScriptCustomNodeX_GetOutputSequencePortCount = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_output_sequence_port_count")
+	methodBindVisualScriptCustomNodeX_GetOutputSequencePortText  = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_output_sequence_port_text")
+	methodBindVisualScriptCustomNodeX_GetOutputValuePortCount    = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_output_value_port_count")
+	methodBindVisualScriptCustomNodeX_GetOutputValuePortName     = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_output_value_port_name")
+	methodBindVisualScriptCustomNodeX_GetOutputValuePortType     = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_output_value_port_type")
+	methodBindVisualScriptCustomNodeX_GetText                    = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_text")
+	methodBindVisualScriptCustomNodeX_GetWorkingMemorySize       = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_get_working_memory_size")
+	methodBindVisualScriptCustomNodeX_HasInputSequencePort       = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_has_input_sequence_port")
+	methodBindVisualScriptCustomNodeX_ScriptChanged              = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_script_changed")
+	methodBindVisualScriptCustomNodeX_Step                       = gdnative.NewLazyMethodBind("VisualScriptCustomNode", "_step")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptCustomNode) X_GetCaption() gdnative.String {
 	//log.Println("Calling VisualScriptCustomNode.X_GetCaption()")
@@ -54,7 +120,7 @@ func (o *VisualScriptCustomNode) X_GetCaption() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_caption")
+	methodBind := methodBindVisualScriptCustomNodeX_GetCaption.Get()
 
 	// Call the parent method.
 	// String
@@ -67,8 +133,8 @@ func (o *VisualScriptCustomNode) X_GetCaption() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptCustomNode) X_GetCategory() gdnative.String {
 	//log.Println("Calling VisualScriptCustomNode.X_GetCategory()")
@@ -77,7 +143,7 @@ func (o *VisualScriptCustomNode) X_GetCategory() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_category")
+	methodBind := methodBindVisualScriptCustomNodeX_GetCategory.Get()
 
 	// Call the parent method.
 	// String
@@ -90,8 +156,8 @@ func (o *VisualScriptCustomNode) X_GetCategory() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualScriptCustomNode) X_GetInputValuePortCount() gdnative.Int {
 	//log.Println("Calling VisualScriptCustomNode.X_GetInputValuePortCount()")
@@ -100,7 +166,7 @@ func (o *VisualScriptCustomNode) X_GetInputValuePortCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_input_value_port_count")
+	methodBind := methodBindVisualScriptCustomNodeX_GetInputValuePortCount.Get()
 
 	// Call the parent method.
 	// int
@@ -113,8 +179,8 @@ func (o *VisualScriptCustomNode) X_GetInputValuePortCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: String
+	        Undocumented
+		Args: [{ false idx int}], Returns: String
 */
 func (o *VisualScriptCustomNode) X_GetInputValuePortName(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling VisualScriptCustomNode.X_GetInputValuePortName()")
@@ -124,7 +190,7 @@ func (o *VisualScriptCustomNode) X_GetInputValuePortName(idx gdnative.Int) gdnat
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_input_value_port_name")
+	methodBind := methodBindVisualScriptCustomNodeX_GetInputValuePortName.Get()
 
 	// Call the parent method.
 	// String
@@ -137,8 +203,8 @@ func (o *VisualScriptCustomNode) X_GetInputValuePortName(idx gdnative.Int) gdnat
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: int
+	        Undocumented
+		Args: [{ false idx int}], Returns: int
 */
 func (o *VisualScriptCustomNode) X_GetInputValuePortType(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling VisualScriptCustomNode.X_GetInputValuePortType()")
@@ -148,7 +214,7 @@ func (o *VisualScriptCustomNode) X_GetInputValuePortType(idx gdnative.Int) gdnat
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_input_value_port_type")
+	methodBind := methodBindVisualScriptCustomNodeX_GetInputValuePortType.Get()
 
 	// Call the parent method.
 	// int
@@ -161,8 +227,8 @@ func (o *VisualScriptCustomNode) X_GetInputValuePortType(idx gdnative.Int) gdnat
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualScriptCustomNode) X_GetOutputSequencePortCount() gdnative.Int {
 	//log.Println("Calling VisualScriptCustomNode.X_GetOutputSequencePortCount()")
@@ -171,7 +237,7 @@ func (o *VisualScriptCustomNode) X_GetOutputSequencePortCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_output_sequence_port_count")
+	methodBind := methodBindVisualScriptCustomNodeX_GetOutputSequencePortCount.Get()
 
 	// Call the parent method.
 	// int
@@ -184,8 +250,8 @@ func (o *VisualScriptCustomNode) X_GetOutputSequencePortCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: String
+	        Undocumented
+		Args: [{ false idx int}], Returns: String
 */
 func (o *VisualScriptCustomNode) X_GetOutputSequencePortText(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling VisualScriptCustomNode.X_GetOutputSequencePortText()")
@@ -195,7 +261,7 @@ func (o *VisualScriptCustomNode) X_GetOutputSequencePortText(idx gdnative.Int) g
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_output_sequence_port_text")
+	methodBind := methodBindVisualScriptCustomNodeX_GetOutputSequencePortText.Get()
 
 	// Call the parent method.
 	// String
@@ -208,8 +274,8 @@ func (o *VisualScriptCustomNode) X_GetOutputSequencePortText(idx gdnative.Int) g
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualScriptCustomNode) X_GetOutputValuePortCount() gdnative.Int {
 	//log.Println("Calling VisualScriptCustomNode.X_GetOutputValuePortCount()")
@@ -218,7 +284,7 @@ func (o *VisualScriptCustomNode) X_GetOutputValuePortCount() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_output_value_port_count")
+	methodBind := methodBindVisualScriptCustomNodeX_GetOutputValuePortCount.Get()
 
 	// Call the parent method.
 	// int
@@ -231,8 +297,8 @@ func (o *VisualScriptCustomNode) X_GetOutputValuePortCount() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: String
+	        Undocumented
+		Args: [{ false idx int}], Returns: String
 */
 func (o *VisualScriptCustomNode) X_GetOutputValuePortName(idx gdnative.Int) gdnative.String {
 	//log.Println("Calling VisualScriptCustomNode.X_GetOutputValuePortName()")
@@ -242,7 +308,7 @@ func (o *VisualScriptCustomNode) X_GetOutputValuePortName(idx gdnative.Int) gdna
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_output_value_port_name")
+	methodBind := methodBindVisualScriptCustomNodeX_GetOutputValuePortName.Get()
 
 	// Call the parent method.
 	// String
@@ -255,8 +321,8 @@ func (o *VisualScriptCustomNode) X_GetOutputValuePortName(idx gdnative.Int) gdna
 }
 
 /*
-        Undocumented
-	Args: [{ false idx int}], Returns: int
+	        Undocumented
+		Args: [{ false idx int}], Returns: int
 */
 func (o *VisualScriptCustomNode) X_GetOutputValuePortType(idx gdnative.Int) gdnative.Int {
 	//log.Println("Calling VisualScriptCustomNode.X_GetOutputValuePortType()")
@@ -266,7 +332,7 @@ func (o *VisualScriptCustomNode) X_GetOutputValuePortType(idx gdnative.Int) gdna
 	ptrArguments[0] = gdnative.NewPointerFromInt(idx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_output_value_port_type")
+	methodBind := methodBindVisualScriptCustomNodeX_GetOutputValuePortType.Get()
 
 	// Call the parent method.
 	// int
@@ -279,8 +345,8 @@ func (o *VisualScriptCustomNode) X_GetOutputValuePortType(idx gdnative.Int) gdna
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptCustomNode) X_GetText() gdnative.String {
 	//log.Println("Calling VisualScriptCustomNode.X_GetText()")
@@ -289,7 +355,7 @@ func (o *VisualScriptCustomNode) X_GetText() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_text")
+	methodBind := methodBindVisualScriptCustomNodeX_GetText.Get()
 
 	// Call the parent method.
 	// String
@@ -302,8 +368,8 @@ func (o *VisualScriptCustomNode) X_GetText() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualScriptCustomNode) X_GetWorkingMemorySize() gdnative.Int {
 	//log.Println("Calling VisualScriptCustomNode.X_GetWorkingMemorySize()")
@@ -312,7 +378,7 @@ func (o *VisualScriptCustomNode) X_GetWorkingMemorySize() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_get_working_memory_size")
+	methodBind := methodBindVisualScriptCustomNodeX_GetWorkingMemorySize.Get()
 
 	// Call the parent method.
 	// int
@@ -325,8 +391,8 @@ func (o *VisualScriptCustomNode) X_GetWorkingMemorySize() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VisualScriptCustomNode) X_HasInputSequencePort() gdnative.Bool {
 	//log.Println("Calling VisualScriptCustomNode.X_HasInputSequencePort()")
@@ -335,7 +401,7 @@ func (o *VisualScriptCustomNode) X_HasInputSequencePort() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_has_input_sequence_port")
+	methodBind := methodBindVisualScriptCustomNodeX_HasInputSequencePort.Get()
 
 	// Call the parent method.
 	// bool
@@ -348,8 +414,8 @@ func (o *VisualScriptCustomNode) X_HasInputSequencePort() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *VisualScriptCustomNode) X_ScriptChanged() {
 	//log.Println("Calling VisualScriptCustomNode.X_ScriptChanged()")
@@ -358,7 +424,7 @@ func (o *VisualScriptCustomNode) X_ScriptChanged() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_script_changed")
+	methodBind := methodBindVisualScriptCustomNodeX_ScriptChanged.Get()
 
 	// Call the parent method.
 	// void
@@ -368,21 +434,21 @@ func (o *VisualScriptCustomNode) X_ScriptChanged() {
 }
 
 /*
-        Undocumented
-	Args: [{ false inputs Array} { false outputs Array} { false start_mode int} { false working_mem Array}], Returns: Variant
+	        Undocumented
+		Args: [{ false inputs Array} { false outputs Array} { false start_mode enum.VisualScriptCustomNode::StartMode} { false working_mem Array}], Returns: Variant
 */
-func (o *VisualScriptCustomNode) X_Step(inputs gdnative.Array, outputs gdnative.Array, startMode gdnative.Int, workingMem gdnative.Array) gdnative.Variant {
+func (o *VisualScriptCustomNode) X_Step(inputs gdnative.Array, outputs gdnative.Array, startMode VisualScriptCustomNodeStartMode, workingMem gdnative.Array) gdnative.Variant {
 	//log.Println("Calling VisualScriptCustomNode.X_Step()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 4, 4)
 	ptrArguments[0] = gdnative.NewPointerFromArray(inputs)
 	ptrArguments[1] = gdnative.NewPointerFromArray(outputs)
-	ptrArguments[2] = gdnative.NewPointerFromInt(startMode)
+	ptrArguments[2] = gdnative.NewPointerFromInt(gdnative.Int(startMode))
 	ptrArguments[3] = gdnative.NewPointerFromArray(workingMem)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptCustomNode", "_step")
+	methodBind := methodBindVisualScriptCustomNodeX_Step.Get()
 
 	// Call the parent method.
 	// Variant
@@ -412,5 +478,5 @@ type VisualScriptCustomNodeImplementer interface {
 	X_GetWorkingMemorySize() gdnative.Int
 	X_HasInputSequencePort() gdnative.Bool
 	X_ScriptChanged()
-	X_Step(inputs gdnative.Array, outputs gdnative.Array, startMode gdnative.Int, workingMem gdnative.Array) gdnative.Variant
+	X_Step(inputs gdnative.Array, outputs gdnative.Array, startMode VisualScriptCustomNodeStartMode, workingMem gdnative.Array) gdnative.Variant
 }
@@ -0,0 +1,175 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type VisualScriptDeconstruct struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptDeconstruct is the class constructor of the VisualScriptDeconstruct class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptDeconstruct = gdnative.NewLazyClassConstructor("VisualScriptDeconstruct")
+
+// NewVisualScriptDeconstruct will create a new instance of the VisualScriptDeconstruct class.
+// VisualScriptDeconstruct is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptDeconstruct() *VisualScriptDeconstruct {
+	obj := &VisualScriptDeconstruct{}
+	obj.SetBaseObject(classConstructorVisualScriptDeconstruct.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptDeconstruct) BaseClass() string {
+	return "VisualScriptDeconstruct"
+}
+
+// Method binds of the VisualScriptDeconstruct class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptDeconstructX_GetElemCache     = gdnative.NewLazyMethodBind("VisualScriptDeconstruct", "_get_elem_cache")
+	methodBindVisualScriptDeconstructX_SetElemCache     = gdnative.NewLazyMethodBind("VisualScriptDeconstruct", "_set_elem_cache")
+	methodBindVisualScriptDeconstructGetDeconstructType = gdnative.NewLazyMethodBind("VisualScriptDeconstruct", "get_deconstruct_type")
+	methodBindVisualScriptDeconstructSetDeconstructType = gdnative.NewLazyMethodBind("VisualScriptDeconstruct", "set_deconstruct_type")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Array
+*/
+func (o *VisualScriptDeconstruct) X_GetElemCache() gdnative.Array {
+	//log.Println("Calling VisualScriptDeconstruct.X_GetElemCache()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptDeconstructX_GetElemCache.Get()
+
+	// Call the parent method.
+	// Array
+	retPtr := gdnative.NewEmptyArray()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewArrayFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false _cache Array}], Returns: void
+*/
+func (o *VisualScriptDeconstruct) X_SetElemCache(cache gdnative.Array) {
+	//log.Println("Calling VisualScriptDeconstruct.X_SetElemCache()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromArray(cache)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptDeconstructX_SetElemCache.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
+*/
+func (o *VisualScriptDeconstruct) GetDeconstructType() gdnative.VariantType {
+	//log.Println("Calling VisualScriptDeconstruct.GetDeconstructType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptDeconstructGetDeconstructType.Get()
+
+	// Call the parent method.
+	// enum.Variant::Type
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.VariantType(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
+*/
+func (o *VisualScriptDeconstruct) SetDeconstructType(aType gdnative.VariantType) {
+	//log.Println("Calling VisualScriptDeconstruct.SetDeconstructType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptDeconstructSetDeconstructType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// ElemCache will return the value of the "elem_cache" property.
+func (o *VisualScriptDeconstruct) ElemCache() gdnative.Array {
+	return o.X_GetElemCache()
+}
+
+// SetElemCache will set the value of the "elem_cache" property.
+func (o *VisualScriptDeconstruct) SetElemCache(value gdnative.Array) {
+	o.X_SetElemCache(value)
+}
+
+// Type will return the value of the "type" property.
+func (o *VisualScriptDeconstruct) Type() gdnative.VariantType {
+	return o.GetDeconstructType()
+}
+
+// SetType will set the value of the "type" property.
+func (o *VisualScriptDeconstruct) SetType(value gdnative.VariantType) {
+	o.SetDeconstructType(value)
+}
+
+// VisualScriptDeconstructImplementer is an interface that implements the methods
+// of the VisualScriptDeconstruct class.
+type VisualScriptDeconstructImplementer interface {
+	VisualScriptNodeImplementer
+	X_GetElemCache() gdnative.Array
+	X_SetElemCache(cache gdnative.Array)
+	GetDeconstructType() gdnative.VariantType
+	SetDeconstructType(aType gdnative.VariantType)
+	ElemCache() gdnative.Array
+	SetElemCache(value gdnative.Array)
+	Type() gdnative.VariantType
+	SetType(value gdnative.VariantType)
+}
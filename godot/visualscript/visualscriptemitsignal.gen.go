@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptEmitSignalFromPointer(ptr gdnative.Pointer) VisualScriptEmitSignal {
-func newVisualScriptEmitSignalFromPointer(ptr gdnative.Pointer) VisualScriptEmitSignal {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptEmitSignal{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptEmitSignal struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptEmitSignal is the class constructor of the VisualScriptEmitSignal class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptEmitSignal = gdnative.NewLazyClassConstructor("VisualScriptEmitSignal")
+
+// NewVisualScriptEmitSignal will create a new instance of the VisualScriptEmitSignal class.
+// VisualScriptEmitSignal is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptEmitSignal() *VisualScriptEmitSignal {
+	obj := &VisualScriptEmitSignal{}
+	obj.SetBaseObject(classConstructorVisualScriptEmitSignal.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptEmitSignal) BaseClass() string {
 	return "VisualScriptEmitSignal"
 }
 
+// Method binds of the VisualScriptEmitSignal class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptEmitSignalGetSignal = gdnative.NewLazyMethodBind("VisualScriptEmitSignal", "get_signal")
+	methodBindVisualScriptEmitSignalSetSignal = gdnative.NewLazyMethodBind("VisualScriptEmitSignal", "set_signal")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptEmitSignal) GetSignal() gdnative.String {
 	//log.Println("Calling VisualScriptEmitSignal.GetSignal()")
@@ -45,7 +61,7 @@ func (o *VisualScriptEmitSignal) GetSignal() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptEmitSignal", "get_signal")
+	methodBind := methodBindVisualScriptEmitSignalGetSignal.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *VisualScriptEmitSignal) GetSignal() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptEmitSignal) SetSignal(name gdnative.String) {
 	//log.Println("Calling VisualScriptEmitSignal.SetSignal()")
@@ -69,7 +85,7 @@ func (o *VisualScriptEmitSignal) SetSignal(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptEmitSignal", "set_signal")
+	methodBind := methodBindVisualScriptEmitSignalSetSignal.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *VisualScriptEmitSignal) SetSignal(name gdnative.String) {
 
 }
 
+// Signal will return the value of the "signal" property.
+func (o *VisualScriptEmitSignal) Signal() gdnative.String {
+	return o.GetSignal()
+}
+
 // VisualScriptEmitSignalImplementer is an interface that implements the methods
 // of the VisualScriptEmitSignal class.
 type VisualScriptEmitSignalImplementer interface {
 	VisualScriptNodeImplementer
 	GetSignal() gdnative.String
 	SetSignal(name gdnative.String)
+	Signal() gdnative.String
 }
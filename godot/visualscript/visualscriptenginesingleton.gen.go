@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptEngineSingletonFromPointer(ptr gdnative.Pointer) VisualScriptEngineSingleton {
-func newVisualScriptEngineSingletonFromPointer(ptr gdnative.Pointer) VisualScriptEngineSingleton {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptEngineSingleton{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptEngineSingleton struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptEngineSingleton is the class constructor of the VisualScriptEngineSingleton class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptEngineSingleton = gdnative.NewLazyClassConstructor("VisualScriptEngineSingleton")
+
+// NewVisualScriptEngineSingleton will create a new instance of the VisualScriptEngineSingleton class.
+// VisualScriptEngineSingleton is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptEngineSingleton() *VisualScriptEngineSingleton {
+	obj := &VisualScriptEngineSingleton{}
+	obj.SetBaseObject(classConstructorVisualScriptEngineSingleton.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptEngineSingleton) BaseClass() string {
 	return "VisualScriptEngineSingleton"
 }
 
+// Method binds of the VisualScriptEngineSingleton class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptEngineSingletonGetSingleton = gdnative.NewLazyMethodBind("VisualScriptEngineSingleton", "get_singleton")
+	methodBindVisualScriptEngineSingletonSetSingleton = gdnative.NewLazyMethodBind("VisualScriptEngineSingleton", "set_singleton")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptEngineSingleton) GetSingleton() gdnative.String {
 	//log.Println("Calling VisualScriptEngineSingleton.GetSingleton()")
@@ -45,7 +61,7 @@ func (o *VisualScriptEngineSingleton) GetSingleton() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptEngineSingleton", "get_singleton")
+	methodBind := methodBindVisualScriptEngineSingletonGetSingleton.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *VisualScriptEngineSingleton) GetSingleton() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptEngineSingleton) SetSingleton(name gdnative.String) {
 	//log.Println("Calling VisualScriptEngineSingleton.SetSingleton()")
@@ -69,7 +85,7 @@ func (o *VisualScriptEngineSingleton) SetSingleton(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptEngineSingleton", "set_singleton")
+	methodBind := methodBindVisualScriptEngineSingletonSetSingleton.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,22 @@ func (o *VisualScriptEngineSingleton) SetSingleton(name gdnative.String) {
 
 }
 
+// Constant will return the value of the "constant" property.
+func (o *VisualScriptEngineSingleton) Constant() gdnative.String {
+	return o.GetSingleton()
+}
+
+// SetConstant will set the value of the "constant" property.
+func (o *VisualScriptEngineSingleton) SetConstant(value gdnative.String) {
+	o.SetSingleton(value)
+}
+
 // VisualScriptEngineSingletonImplementer is an interface that implements the methods
 // of the VisualScriptEngineSingleton class.
 type VisualScriptEngineSingletonImplementer interface {
 	VisualScriptNodeImplementer
 	GetSingleton() gdnative.String
 	SetSingleton(name gdnative.String)
+	Constant() gdnative.String
+	SetConstant(value gdnative.String)
 }
@@ -0,0 +1,50 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type VisualScriptExpression struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptExpression is the class constructor of the VisualScriptExpression class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptExpression = gdnative.NewLazyClassConstructor("VisualScriptExpression")
+
+// NewVisualScriptExpression will create a new instance of the VisualScriptExpression class.
+// VisualScriptExpression is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptExpression() *VisualScriptExpression {
+	obj := &VisualScriptExpression{}
+	obj.SetBaseObject(classConstructorVisualScriptExpression.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptExpression) BaseClass() string {
+	return "VisualScriptExpression"
+}
+
+// VisualScriptExpressionImplementer is an interface that implements the methods
+// of the VisualScriptExpression class.
+type VisualScriptExpressionImplementer interface {
+	VisualScriptNodeImplementer
+}
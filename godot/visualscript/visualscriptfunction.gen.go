@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptFunctionFromPointer(ptr gdnative.Pointer) VisualScriptFunction {
-func newVisualScriptFunctionFromPointer(ptr gdnative.Pointer) VisualScriptFunction {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptFunction{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptFunction struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptFunction is the class constructor of the VisualScriptFunction class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptFunction = gdnative.NewLazyClassConstructor("VisualScriptFunction")
+
+// NewVisualScriptFunction will create a new instance of the VisualScriptFunction class.
+// VisualScriptFunction is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptFunction() *VisualScriptFunction {
+	obj := &VisualScriptFunction{}
+	obj.SetBaseObject(classConstructorVisualScriptFunction.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptFunction) BaseClass() string {
 	return "VisualScriptFunction"
 }
@@ -1,7 +1,10 @@
-package godot
+package visualscript
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -24,6 +27,40 @@ const (
 	VisualScriptFunctionCallCallModeSingleton VisualScriptFunctionCallCallMode = 4
 )
 
+// String will return the Godot name of the VisualScriptFunctionCallCallMode value.
+func (e VisualScriptFunctionCallCallMode) String() string {
+	switch e {
+	case VisualScriptFunctionCallCallModeBasicType:
+		return "CALL_MODE_BASIC_TYPE"
+	case VisualScriptFunctionCallCallModeInstance:
+		return "CALL_MODE_INSTANCE"
+	case VisualScriptFunctionCallCallModeNodePath:
+		return "CALL_MODE_NODE_PATH"
+	case VisualScriptFunctionCallCallModeSelf:
+		return "CALL_MODE_SELF"
+	case VisualScriptFunctionCallCallModeSingleton:
+		return "CALL_MODE_SINGLETON"
+	}
+	return fmt.Sprintf("VisualScriptFunctionCallCallMode(%d)", int(e))
+}
+
+// ParseVisualScriptFunctionCallCallMode will return the VisualScriptFunctionCallCallMode value with the given Godot name.
+func ParseVisualScriptFunctionCallCallMode(name string) (VisualScriptFunctionCallCallMode, error) {
+	switch name {
+	case "CALL_MODE_BASIC_TYPE":
+		return VisualScriptFunctionCallCallModeBasicType, nil
+	case "CALL_MODE_INSTANCE":
+		return VisualScriptFunctionCallCallModeInstance, nil
+	case "CALL_MODE_NODE_PATH":
+		return VisualScriptFunctionCallCallModeNodePath, nil
+	case "CALL_MODE_SELF":
+		return VisualScriptFunctionCallCallModeSelf, nil
+	case "CALL_MODE_SINGLETON":
+		return VisualScriptFunctionCallCallModeSingleton, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptFunctionCallCallMode value %q", name)
+}
+
 // VisualScriptFunctionCallRPCCallMode is an enum for RPCCallMode values.
 type VisualScriptFunctionCallRPCCallMode int
 
@@ -35,13 +72,38 @@ const (
 	VisualScriptFunctionCallRpcUnreliableToId VisualScriptFunctionCallRPCCallMode = 4
 )
 
-//func NewVisualScriptFunctionCallFromPointer(ptr gdnative.Pointer) VisualScriptFunctionCall {
-func newVisualScriptFunctionCallFromPointer(ptr gdnative.Pointer) VisualScriptFunctionCall {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptFunctionCall{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the VisualScriptFunctionCallRPCCallMode value.
+func (e VisualScriptFunctionCallRPCCallMode) String() string {
+	switch e {
+	case VisualScriptFunctionCallRpcDisabled:
+		return "RPC_DISABLED"
+	case VisualScriptFunctionCallRpcReliable:
+		return "RPC_RELIABLE"
+	case VisualScriptFunctionCallRpcReliableToId:
+		return "RPC_RELIABLE_TO_ID"
+	case VisualScriptFunctionCallRpcUnreliable:
+		return "RPC_UNRELIABLE"
+	case VisualScriptFunctionCallRpcUnreliableToId:
+		return "RPC_UNRELIABLE_TO_ID"
+	}
+	return fmt.Sprintf("VisualScriptFunctionCallRPCCallMode(%d)", int(e))
+}
 
-	return obj
+// ParseVisualScriptFunctionCallRPCCallMode will return the VisualScriptFunctionCallRPCCallMode value with the given Godot name.
+func ParseVisualScriptFunctionCallRPCCallMode(name string) (VisualScriptFunctionCallRPCCallMode, error) {
+	switch name {
+	case "RPC_DISABLED":
+		return VisualScriptFunctionCallRpcDisabled, nil
+	case "RPC_RELIABLE":
+		return VisualScriptFunctionCallRpcReliable, nil
+	case "RPC_RELIABLE_TO_ID":
+		return VisualScriptFunctionCallRpcReliableToId, nil
+	case "RPC_UNRELIABLE":
+		return VisualScriptFunctionCallRpcUnreliable, nil
+	case "RPC_UNRELIABLE_TO_ID":
+		return VisualScriptFunctionCallRpcUnreliableToId, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptFunctionCallRPCCallMode value %q", name)
 }
 
 /*
@@ -52,13 +114,57 @@ type VisualScriptFunctionCall struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptFunctionCall is the class constructor of the VisualScriptFunctionCall class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptFunctionCall = gdnative.NewLazyClassConstructor("VisualScriptFunctionCall")
+
+// NewVisualScriptFunctionCall will create a new instance of the VisualScriptFunctionCall class.
+// VisualScriptFunctionCall is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptFunctionCall() *VisualScriptFunctionCall {
+	obj := &VisualScriptFunctionCall{}
+	obj.SetBaseObject(classConstructorVisualScriptFunctionCall.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptFunctionCall) BaseClass() string {
 	return "VisualScriptFunctionCall"
 }
 
+// Method binds of the VisualScriptFunctionCall class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptFunctionCallX_GetArgumentCache = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "_get_argument_cache")
+	methodBindVisualScriptFunctionCallX_SetArgumentCache = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "_set_argument_cache")
+	methodBindVisualScriptFunctionCallGetBasePath        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_base_path")
+	methodBindVisualScriptFunctionCallGetBaseScript      = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_base_script")
+	methodBindVisualScriptFunctionCallGetBaseType        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_base_type")
+	methodBindVisualScriptFunctionCallGetBasicType       = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_basic_type")
+	methodBindVisualScriptFunctionCallGetCallMode        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_call_mode")
+	methodBindVisualScriptFunctionCallGetFunction        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_function")
+	methodBindVisualScriptFunctionCallGetRpcCallMode     = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_rpc_call_mode")
+	methodBindVisualScriptFunctionCallGetSingleton       = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_singleton")
+	methodBindVisualScriptFunctionCallGetUseDefaultArgs  = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_use_default_args")
+	methodBindVisualScriptFunctionCallGetValidate        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "get_validate")
+	methodBindVisualScriptFunctionCallSetBasePath        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_base_path")
+	methodBindVisualScriptFunctionCallSetBaseScript      = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_base_script")
+	methodBindVisualScriptFunctionCallSetBaseType        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_base_type")
+	methodBindVisualScriptFunctionCallSetBasicType       = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_basic_type")
+	methodBindVisualScriptFunctionCallSetCallMode        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_call_mode")
+	methodBindVisualScriptFunctionCallSetFunction        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_function")
+	methodBindVisualScriptFunctionCallSetRpcCallMode     = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_rpc_call_mode")
+	methodBindVisualScriptFunctionCallSetSingleton       = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_singleton")
+	methodBindVisualScriptFunctionCallSetUseDefaultArgs  = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_use_default_args")
+	methodBindVisualScriptFunctionCallSetValidate        = gdnative.NewLazyMethodBind("VisualScriptFunctionCall", "set_validate")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Dictionary
+	        Undocumented
+		Args: [], Returns: Dictionary
 */
 func (o *VisualScriptFunctionCall) X_GetArgumentCache() gdnative.Dictionary {
 	//log.Println("Calling VisualScriptFunctionCall.X_GetArgumentCache()")
@@ -67,7 +173,7 @@ func (o *VisualScriptFunctionCall) X_GetArgumentCache() gdnative.Dictionary {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "_get_argument_cache")
+	methodBind := methodBindVisualScriptFunctionCallX_GetArgumentCache.Get()
 
 	// Call the parent method.
 	// Dictionary
@@ -80,8 +186,8 @@ func (o *VisualScriptFunctionCall) X_GetArgumentCache() gdnative.Dictionary {
 }
 
 /*
-        Undocumented
-	Args: [{ false argument_cache Dictionary}], Returns: void
+	        Undocumented
+		Args: [{ false argument_cache Dictionary}], Returns: void
 */
 func (o *VisualScriptFunctionCall) X_SetArgumentCache(argumentCache gdnative.Dictionary) {
 	//log.Println("Calling VisualScriptFunctionCall.X_SetArgumentCache()")
@@ -91,7 +197,7 @@ func (o *VisualScriptFunctionCall) X_SetArgumentCache(argumentCache gdnative.Dic
 	ptrArguments[0] = gdnative.NewPointerFromDictionary(argumentCache)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "_set_argument_cache")
+	methodBind := methodBindVisualScriptFunctionCallX_SetArgumentCache.Get()
 
 	// Call the parent method.
 	// void
@@ -101,8 +207,8 @@ func (o *VisualScriptFunctionCall) X_SetArgumentCache(argumentCache gdnative.Dic
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *VisualScriptFunctionCall) GetBasePath() gdnative.NodePath {
 	//log.Println("Calling VisualScriptFunctionCall.GetBasePath()")
@@ -111,7 +217,7 @@ func (o *VisualScriptFunctionCall) GetBasePath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_base_path")
+	methodBind := methodBindVisualScriptFunctionCallGetBasePath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -124,8 +230,8 @@ func (o *VisualScriptFunctionCall) GetBasePath() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptFunctionCall) GetBaseScript() gdnative.String {
 	//log.Println("Calling VisualScriptFunctionCall.GetBaseScript()")
@@ -134,7 +240,7 @@ func (o *VisualScriptFunctionCall) GetBaseScript() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_base_script")
+	methodBind := methodBindVisualScriptFunctionCallGetBaseScript.Get()
 
 	// Call the parent method.
 	// String
@@ -147,8 +253,8 @@ func (o *VisualScriptFunctionCall) GetBaseScript() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptFunctionCall) GetBaseType() gdnative.String {
 	//log.Println("Calling VisualScriptFunctionCall.GetBaseType()")
@@ -157,7 +263,7 @@ func (o *VisualScriptFunctionCall) GetBaseType() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_base_type")
+	methodBind := methodBindVisualScriptFunctionCallGetBaseType.Get()
 
 	// Call the parent method.
 	// String
@@ -170,8 +276,8 @@ func (o *VisualScriptFunctionCall) GetBaseType() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptFunctionCall) GetBasicType() gdnative.VariantType {
 	//log.Println("Calling VisualScriptFunctionCall.GetBasicType()")
@@ -180,7 +286,7 @@ func (o *VisualScriptFunctionCall) GetBasicType() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_basic_type")
+	methodBind := methodBindVisualScriptFunctionCallGetBasicType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -193,8 +299,8 @@ func (o *VisualScriptFunctionCall) GetBasicType() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.VisualScriptFunctionCall::CallMode
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptFunctionCall::CallMode
 */
 func (o *VisualScriptFunctionCall) GetCallMode() VisualScriptFunctionCallCallMode {
 	//log.Println("Calling VisualScriptFunctionCall.GetCallMode()")
@@ -203,7 +309,7 @@ func (o *VisualScriptFunctionCall) GetCallMode() VisualScriptFunctionCallCallMod
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_call_mode")
+	methodBind := methodBindVisualScriptFunctionCallGetCallMode.Get()
 
 	// Call the parent method.
 	// enum.VisualScriptFunctionCall::CallMode
@@ -216,8 +322,8 @@ func (o *VisualScriptFunctionCall) GetCallMode() VisualScriptFunctionCallCallMod
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptFunctionCall) GetFunction() gdnative.String {
 	//log.Println("Calling VisualScriptFunctionCall.GetFunction()")
@@ -226,7 +332,7 @@ func (o *VisualScriptFunctionCall) GetFunction() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_function")
+	methodBind := methodBindVisualScriptFunctionCallGetFunction.Get()
 
 	// Call the parent method.
 	// String
@@ -239,8 +345,8 @@ func (o *VisualScriptFunctionCall) GetFunction() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.VisualScriptFunctionCall::RPCCallMode
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptFunctionCall::RPCCallMode
 */
 func (o *VisualScriptFunctionCall) GetRpcCallMode() VisualScriptFunctionCallRPCCallMode {
 	//log.Println("Calling VisualScriptFunctionCall.GetRpcCallMode()")
@@ -249,7 +355,7 @@ func (o *VisualScriptFunctionCall) GetRpcCallMode() VisualScriptFunctionCallRPCC
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_rpc_call_mode")
+	methodBind := methodBindVisualScriptFunctionCallGetRpcCallMode.Get()
 
 	// Call the parent method.
 	// enum.VisualScriptFunctionCall::RPCCallMode
@@ -262,8 +368,8 @@ func (o *VisualScriptFunctionCall) GetRpcCallMode() VisualScriptFunctionCallRPCC
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptFunctionCall) GetSingleton() gdnative.String {
 	//log.Println("Calling VisualScriptFunctionCall.GetSingleton()")
@@ -272,7 +378,7 @@ func (o *VisualScriptFunctionCall) GetSingleton() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_singleton")
+	methodBind := methodBindVisualScriptFunctionCallGetSingleton.Get()
 
 	// Call the parent method.
 	// String
@@ -285,8 +391,8 @@ func (o *VisualScriptFunctionCall) GetSingleton() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualScriptFunctionCall) GetUseDefaultArgs() gdnative.Int {
 	//log.Println("Calling VisualScriptFunctionCall.GetUseDefaultArgs()")
@@ -295,7 +401,7 @@ func (o *VisualScriptFunctionCall) GetUseDefaultArgs() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_use_default_args")
+	methodBind := methodBindVisualScriptFunctionCallGetUseDefaultArgs.Get()
 
 	// Call the parent method.
 	// int
@@ -308,8 +414,8 @@ func (o *VisualScriptFunctionCall) GetUseDefaultArgs() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VisualScriptFunctionCall) GetValidate() gdnative.Bool {
 	//log.Println("Calling VisualScriptFunctionCall.GetValidate()")
@@ -318,7 +424,7 @@ func (o *VisualScriptFunctionCall) GetValidate() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "get_validate")
+	methodBind := methodBindVisualScriptFunctionCallGetValidate.Get()
 
 	// Call the parent method.
 	// bool
@@ -331,8 +437,8 @@ func (o *VisualScriptFunctionCall) GetValidate() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false base_path NodePath}], Returns: void
 */
 func (o *VisualScriptFunctionCall) SetBasePath(basePath gdnative.NodePath) {
 	//log.Println("Calling VisualScriptFunctionCall.SetBasePath()")
@@ -342,7 +448,7 @@ func (o *VisualScriptFunctionCall) SetBasePath(basePath gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(basePath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_base_path")
+	methodBind := methodBindVisualScriptFunctionCallSetBasePath.Get()
 
 	// Call the parent method.
 	// void
@@ -352,8 +458,8 @@ func (o *VisualScriptFunctionCall) SetBasePath(basePath gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_script String}], Returns: void
+	        Undocumented
+		Args: [{ false base_script String}], Returns: void
 */
 func (o *VisualScriptFunctionCall) SetBaseScript(baseScript gdnative.String) {
 	//log.Println("Calling VisualScriptFunctionCall.SetBaseScript()")
@@ -363,7 +469,7 @@ func (o *VisualScriptFunctionCall) SetBaseScript(baseScript gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(baseScript)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_base_script")
+	methodBind := methodBindVisualScriptFunctionCallSetBaseScript.Get()
 
 	// Call the parent method.
 	// void
@@ -373,8 +479,8 @@ func (o *VisualScriptFunctionCall) SetBaseScript(baseScript gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_type String}], Returns: void
+	        Undocumented
+		Args: [{ false base_type String}], Returns: void
 */
 func (o *VisualScriptFunctionCall) SetBaseType(baseType gdnative.String) {
 	//log.Println("Calling VisualScriptFunctionCall.SetBaseType()")
@@ -384,7 +490,7 @@ func (o *VisualScriptFunctionCall) SetBaseType(baseType gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(baseType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_base_type")
+	methodBind := methodBindVisualScriptFunctionCallSetBaseType.Get()
 
 	// Call the parent method.
 	// void
@@ -394,18 +500,18 @@ func (o *VisualScriptFunctionCall) SetBaseType(baseType gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false basic_type int}], Returns: void
+	        Undocumented
+		Args: [{ false basic_type enum.Variant::Type}], Returns: void
 */
-func (o *VisualScriptFunctionCall) SetBasicType(basicType gdnative.Int) {
+func (o *VisualScriptFunctionCall) SetBasicType(basicType gdnative.VariantType) {
 	//log.Println("Calling VisualScriptFunctionCall.SetBasicType()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(basicType)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(basicType))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_basic_type")
+	methodBind := methodBindVisualScriptFunctionCallSetBasicType.Get()
 
 	// Call the parent method.
 	// void
@@ -415,18 +521,18 @@ func (o *VisualScriptFunctionCall) SetBasicType(basicType gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.VisualScriptFunctionCall::CallMode}], Returns: void
 */
-func (o *VisualScriptFunctionCall) SetCallMode(mode gdnative.Int) {
+func (o *VisualScriptFunctionCall) SetCallMode(mode VisualScriptFunctionCallCallMode) {
 	//log.Println("Calling VisualScriptFunctionCall.SetCallMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_call_mode")
+	methodBind := methodBindVisualScriptFunctionCallSetCallMode.Get()
 
 	// Call the parent method.
 	// void
@@ -436,8 +542,8 @@ func (o *VisualScriptFunctionCall) SetCallMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false function String}], Returns: void
+	        Undocumented
+		Args: [{ false function String}], Returns: void
 */
 func (o *VisualScriptFunctionCall) SetFunction(function gdnative.String) {
 	//log.Println("Calling VisualScriptFunctionCall.SetFunction()")
@@ -447,7 +553,7 @@ func (o *VisualScriptFunctionCall) SetFunction(function gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(function)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_function")
+	methodBind := methodBindVisualScriptFunctionCallSetFunction.Get()
 
 	// Call the parent method.
 	// void
@@ -457,18 +563,18 @@ func (o *VisualScriptFunctionCall) SetFunction(function gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.VisualScriptFunctionCall::RPCCallMode}], Returns: void
 */
-func (o *VisualScriptFunctionCall) SetRpcCallMode(mode gdnative.Int) {
+func (o *VisualScriptFunctionCall) SetRpcCallMode(mode VisualScriptFunctionCallRPCCallMode) {
 	//log.Println("Calling VisualScriptFunctionCall.SetRpcCallMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_rpc_call_mode")
+	methodBind := methodBindVisualScriptFunctionCallSetRpcCallMode.Get()
 
 	// Call the parent method.
 	// void
@@ -478,8 +584,8 @@ func (o *VisualScriptFunctionCall) SetRpcCallMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false singleton String}], Returns: void
+	        Undocumented
+		Args: [{ false singleton String}], Returns: void
 */
 func (o *VisualScriptFunctionCall) SetSingleton(singleton gdnative.String) {
 	//log.Println("Calling VisualScriptFunctionCall.SetSingleton()")
@@ -489,7 +595,7 @@ func (o *VisualScriptFunctionCall) SetSingleton(singleton gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(singleton)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_singleton")
+	methodBind := methodBindVisualScriptFunctionCallSetSingleton.Get()
 
 	// Call the parent method.
 	// void
@@ -499,8 +605,8 @@ func (o *VisualScriptFunctionCall) SetSingleton(singleton gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false amount int}], Returns: void
+	        Undocumented
+		Args: [{ false amount int}], Returns: void
 */
 func (o *VisualScriptFunctionCall) SetUseDefaultArgs(amount gdnative.Int) {
 	//log.Println("Calling VisualScriptFunctionCall.SetUseDefaultArgs()")
@@ -510,7 +616,7 @@ func (o *VisualScriptFunctionCall) SetUseDefaultArgs(amount gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(amount)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_use_default_args")
+	methodBind := methodBindVisualScriptFunctionCallSetUseDefaultArgs.Get()
 
 	// Call the parent method.
 	// void
@@ -520,8 +626,8 @@ func (o *VisualScriptFunctionCall) SetUseDefaultArgs(amount gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *VisualScriptFunctionCall) SetValidate(enable gdnative.Bool) {
 	//log.Println("Calling VisualScriptFunctionCall.SetValidate()")
@@ -531,7 +637,7 @@ func (o *VisualScriptFunctionCall) SetValidate(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptFunctionCall", "set_validate")
+	methodBind := methodBindVisualScriptFunctionCallSetValidate.Get()
 
 	// Call the parent method.
 	// void
@@ -540,6 +646,71 @@ func (o *VisualScriptFunctionCall) SetValidate(enable gdnative.Bool) {
 
 }
 
+// ArgumentCache will return the value of the "argument_cache" property.
+func (o *VisualScriptFunctionCall) ArgumentCache() gdnative.Dictionary {
+	return o.X_GetArgumentCache()
+}
+
+// SetArgumentCache will set the value of the "argument_cache" property.
+func (o *VisualScriptFunctionCall) SetArgumentCache(value gdnative.Dictionary) {
+	o.X_SetArgumentCache(value)
+}
+
+// BaseScript will return the value of the "base_script" property.
+func (o *VisualScriptFunctionCall) BaseScript() gdnative.String {
+	return o.GetBaseScript()
+}
+
+// BaseType will return the value of the "base_type" property.
+func (o *VisualScriptFunctionCall) BaseType() gdnative.String {
+	return o.GetBaseType()
+}
+
+// BasicType will return the value of the "basic_type" property.
+func (o *VisualScriptFunctionCall) BasicType() gdnative.VariantType {
+	return o.GetBasicType()
+}
+
+// CallMode will return the value of the "call_mode" property.
+func (o *VisualScriptFunctionCall) CallMode() VisualScriptFunctionCallCallMode {
+	return o.GetCallMode()
+}
+
+// Function will return the value of the "function" property.
+func (o *VisualScriptFunctionCall) Function() gdnative.String {
+	return o.GetFunction()
+}
+
+// NodePath will return the value of the "node_path" property.
+func (o *VisualScriptFunctionCall) NodePath() gdnative.NodePath {
+	return o.GetBasePath()
+}
+
+// SetNodePath will set the value of the "node_path" property.
+func (o *VisualScriptFunctionCall) SetNodePath(value gdnative.NodePath) {
+	o.SetBasePath(value)
+}
+
+// RpcCallMode will return the value of the "rpc_call_mode" property.
+func (o *VisualScriptFunctionCall) RpcCallMode() VisualScriptFunctionCallRPCCallMode {
+	return o.GetRpcCallMode()
+}
+
+// Singleton will return the value of the "singleton" property.
+func (o *VisualScriptFunctionCall) Singleton() gdnative.String {
+	return o.GetSingleton()
+}
+
+// UseDefaultArgs will return the value of the "use_default_args" property.
+func (o *VisualScriptFunctionCall) UseDefaultArgs() gdnative.Int {
+	return o.GetUseDefaultArgs()
+}
+
+// Validate will return the value of the "validate" property.
+func (o *VisualScriptFunctionCall) Validate() gdnative.Bool {
+	return o.GetValidate()
+}
+
 // VisualScriptFunctionCallImplementer is an interface that implements the methods
 // of the VisualScriptFunctionCall class.
 type VisualScriptFunctionCallImplementer interface {
@@ -549,18 +720,34 @@ type VisualScriptFunctionCallImplementer interface {
 	GetBasePath() gdnative.NodePath
 	GetBaseScript() gdnative.String
 	GetBaseType() gdnative.String
+	GetBasicType() gdnative.VariantType
+	GetCallMode() VisualScriptFunctionCallCallMode
 	GetFunction() gdnative.String
+	GetRpcCallMode() VisualScriptFunctionCallRPCCallMode
 	GetSingleton() gdnative.String
 	GetUseDefaultArgs() gdnative.Int
 	GetValidate() gdnative.Bool
 	SetBasePath(basePath gdnative.NodePath)
 	SetBaseScript(baseScript gdnative.String)
 	SetBaseType(baseType gdnative.String)
-	SetBasicType(basicType gdnative.Int)
-	SetCallMode(mode gdnative.Int)
+	SetBasicType(basicType gdnative.VariantType)
+	SetCallMode(mode VisualScriptFunctionCallCallMode)
 	SetFunction(function gdnative.String)
-	SetRpcCallMode(mode gdnative.Int)
+	SetRpcCallMode(mode VisualScriptFunctionCallRPCCallMode)
 	SetSingleton(singleton gdnative.String)
 	SetUseDefaultArgs(amount gdnative.Int)
 	SetValidate(enable gdnative.Bool)
+	ArgumentCache() gdnative.Dictionary
+	SetArgumentCache(value gdnative.Dictionary)
+	BaseScript() gdnative.String
+	BaseType() gdnative.String
+	BasicType() gdnative.VariantType
+	CallMode() VisualScriptFunctionCallCallMode
+	Function() gdnative.String
+	NodePath() gdnative.NodePath
+	SetNodePath(value gdnative.NodePath)
+	RpcCallMode() VisualScriptFunctionCallRPCCallMode
+	Singleton() gdnative.String
+	UseDefaultArgs() gdnative.Int
+	Validate() gdnative.Bool
 }
@@ -0,0 +1,163 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type VisualScriptFunctionState struct {
+	godot.Reference
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptFunctionState is the class constructor of the VisualScriptFunctionState class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptFunctionState = gdnative.NewLazyClassConstructor("VisualScriptFunctionState")
+
+// NewVisualScriptFunctionState will create a new instance of the VisualScriptFunctionState class.
+// VisualScriptFunctionState is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptFunctionState() *VisualScriptFunctionState {
+	obj := &VisualScriptFunctionState{}
+	obj.SetBaseObject(classConstructorVisualScriptFunctionState.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptFunctionState) BaseClass() string {
+	return "VisualScriptFunctionState"
+}
+
+// Method binds of the VisualScriptFunctionState class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptFunctionStateX_SignalCallback = gdnative.NewLazyMethodBind("VisualScriptFunctionState", "_signal_callback")
+	methodBindVisualScriptFunctionStateConnectToSignal  = gdnative.NewLazyMethodBind("VisualScriptFunctionState", "connect_to_signal")
+	methodBindVisualScriptFunctionStateIsValid          = gdnative.NewLazyMethodBind("VisualScriptFunctionState", "is_valid")
+	methodBindVisualScriptFunctionStateResume           = gdnative.NewLazyMethodBind("VisualScriptFunctionState", "resume")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Variant
+*/
+func (o *VisualScriptFunctionState) X_SignalCallback(args ...gdnative.Variant) (gdnative.Variant, error) {
+	//log.Println("Calling VisualScriptFunctionState.X_SignalCallback()")
+
+	// Build out the method's arguments. The fixed arguments are converted
+	// into variants, followed by the variable arguments.
+	variantArguments := make([]gdnative.Variant, 0, 0+len(args))
+	variantArguments = append(variantArguments, args...)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptFunctionStateX_SignalCallback.Get()
+
+	// Call the parent method.
+	ret, err := gdnative.MethodBindCall(methodBind, o.GetBaseObject(), variantArguments)
+
+	// The returned variant is owned by the caller.
+	return ret, err
+}
+
+/*
+	        Undocumented
+		Args: [{ false obj Object} { false signals String} { false args Array}], Returns: void
+*/
+func (o *VisualScriptFunctionState) ConnectToSignal(obj godot.ObjectImplementer, signals gdnative.String, args gdnative.Array) {
+	//log.Println("Calling VisualScriptFunctionState.ConnectToSignal()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 3, 3)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(obj))
+	ptrArguments[1] = gdnative.NewPointerFromString(signals)
+	ptrArguments[2] = gdnative.NewPointerFromArray(args)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptFunctionStateConnectToSignal.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: bool
+*/
+func (o *VisualScriptFunctionState) IsValid() gdnative.Bool {
+	//log.Println("Calling VisualScriptFunctionState.IsValid()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptFunctionStateIsValid.Get()
+
+	// Call the parent method.
+	// bool
+	retPtr := gdnative.NewEmptyBool()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewBoolFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{Null true args Array}], Returns: Variant
+*/
+func (o *VisualScriptFunctionState) Resume(args gdnative.Array) gdnative.Variant {
+	//log.Println("Calling VisualScriptFunctionState.Resume()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromArray(args)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptFunctionStateResume.Get()
+
+	// Call the parent method.
+	// Variant
+	retPtr := gdnative.NewEmptyVariant()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewVariantFromPointer(retPtr)
+	return ret
+}
+
+// ResumeWithDefaults will call Resume using the default values for: args.
+func (o *VisualScriptFunctionState) ResumeWithDefaults() gdnative.Variant {
+	default0 := gdnative.NewArray()
+	defer default0.Destroy()
+	return o.Resume(default0)
+}
+
+// VisualScriptFunctionStateImplementer is an interface that implements the methods
+// of the VisualScriptFunctionState class.
+type VisualScriptFunctionStateImplementer interface {
+	godot.ReferenceImplementer
+	X_SignalCallback(args ...gdnative.Variant) (gdnative.Variant, error)
+	ConnectToSignal(obj godot.ObjectImplementer, signals gdnative.String, args gdnative.Array)
+	IsValid() gdnative.Bool
+	Resume(args gdnative.Array) gdnative.Variant
+	ResumeWithDefaults() gdnative.Variant
+}
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptGlobalConstantFromPointer(ptr gdnative.Pointer) VisualScriptGlobalConstant {
-func newVisualScriptGlobalConstantFromPointer(ptr gdnative.Pointer) VisualScriptGlobalConstant {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptGlobalConstant{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptGlobalConstant struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptGlobalConstant is the class constructor of the VisualScriptGlobalConstant class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptGlobalConstant = gdnative.NewLazyClassConstructor("VisualScriptGlobalConstant")
+
+// NewVisualScriptGlobalConstant will create a new instance of the VisualScriptGlobalConstant class.
+// VisualScriptGlobalConstant is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptGlobalConstant() *VisualScriptGlobalConstant {
+	obj := &VisualScriptGlobalConstant{}
+	obj.SetBaseObject(classConstructorVisualScriptGlobalConstant.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptGlobalConstant) BaseClass() string {
 	return "VisualScriptGlobalConstant"
 }
 
+// Method binds of the VisualScriptGlobalConstant class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptGlobalConstantGetGlobalConstant = gdnative.NewLazyMethodBind("VisualScriptGlobalConstant", "get_global_constant")
+	methodBindVisualScriptGlobalConstantSetGlobalConstant = gdnative.NewLazyMethodBind("VisualScriptGlobalConstant", "set_global_constant")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualScriptGlobalConstant) GetGlobalConstant() gdnative.Int {
 	//log.Println("Calling VisualScriptGlobalConstant.GetGlobalConstant()")
@@ -45,7 +61,7 @@ func (o *VisualScriptGlobalConstant) GetGlobalConstant() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptGlobalConstant", "get_global_constant")
+	methodBind := methodBindVisualScriptGlobalConstantGetGlobalConstant.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +74,8 @@ func (o *VisualScriptGlobalConstant) GetGlobalConstant() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false index int}], Returns: void
+	        Undocumented
+		Args: [{ false index int}], Returns: void
 */
 func (o *VisualScriptGlobalConstant) SetGlobalConstant(index gdnative.Int) {
 	//log.Println("Calling VisualScriptGlobalConstant.SetGlobalConstant()")
@@ -69,7 +85,7 @@ func (o *VisualScriptGlobalConstant) SetGlobalConstant(index gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptGlobalConstant", "set_global_constant")
+	methodBind := methodBindVisualScriptGlobalConstantSetGlobalConstant.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,22 @@ func (o *VisualScriptGlobalConstant) SetGlobalConstant(index gdnative.Int) {
 
 }
 
+// Constant will return the value of the "constant" property.
+func (o *VisualScriptGlobalConstant) Constant() gdnative.Int {
+	return o.GetGlobalConstant()
+}
+
+// SetConstant will set the value of the "constant" property.
+func (o *VisualScriptGlobalConstant) SetConstant(value gdnative.Int) {
+	o.SetGlobalConstant(value)
+}
+
 // VisualScriptGlobalConstantImplementer is an interface that implements the methods
 // of the VisualScriptGlobalConstant class.
 type VisualScriptGlobalConstantImplementer interface {
 	VisualScriptNodeImplementer
 	GetGlobalConstant() gdnative.Int
 	SetGlobalConstant(index gdnative.Int)
+	Constant() gdnative.Int
+	SetConstant(value gdnative.Int)
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptIndexGetFromPointer(ptr gdnative.Pointer) VisualScriptIndexGet {
-func newVisualScriptIndexGetFromPointer(ptr gdnative.Pointer) VisualScriptIndexGet {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptIndexGet{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptIndexGet struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptIndexGet is the class constructor of the VisualScriptIndexGet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptIndexGet = gdnative.NewLazyClassConstructor("VisualScriptIndexGet")
+
+// NewVisualScriptIndexGet will create a new instance of the VisualScriptIndexGet class.
+// VisualScriptIndexGet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptIndexGet() *VisualScriptIndexGet {
+	obj := &VisualScriptIndexGet{}
+	obj.SetBaseObject(classConstructorVisualScriptIndexGet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptIndexGet) BaseClass() string {
 	return "VisualScriptIndexGet"
 }
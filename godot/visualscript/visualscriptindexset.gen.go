@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptIndexSetFromPointer(ptr gdnative.Pointer) VisualScriptIndexSet {
-func newVisualScriptIndexSetFromPointer(ptr gdnative.Pointer) VisualScriptIndexSet {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptIndexSet{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptIndexSet struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptIndexSet is the class constructor of the VisualScriptIndexSet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptIndexSet = gdnative.NewLazyClassConstructor("VisualScriptIndexSet")
+
+// NewVisualScriptIndexSet will create a new instance of the VisualScriptIndexSet class.
+// VisualScriptIndexSet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptIndexSet() *VisualScriptIndexSet {
+	obj := &VisualScriptIndexSet{}
+	obj.SetBaseObject(classConstructorVisualScriptIndexSet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptIndexSet) BaseClass() string {
 	return "VisualScriptIndexSet"
 }
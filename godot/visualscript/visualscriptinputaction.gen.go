@@ -0,0 +1,217 @@
+package visualscript
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// VisualScriptInputActionMode is an enum for Mode values.
+type VisualScriptInputActionMode int
+
+const (
+	VisualScriptInputActionModeJustPressed  VisualScriptInputActionMode = 2
+	VisualScriptInputActionModeJustReleased VisualScriptInputActionMode = 3
+	VisualScriptInputActionModePressed      VisualScriptInputActionMode = 0
+	VisualScriptInputActionModeReleased     VisualScriptInputActionMode = 1
+)
+
+// String will return the Godot name of the VisualScriptInputActionMode value.
+func (e VisualScriptInputActionMode) String() string {
+	switch e {
+	case VisualScriptInputActionModeJustPressed:
+		return "MODE_JUST_PRESSED"
+	case VisualScriptInputActionModeJustReleased:
+		return "MODE_JUST_RELEASED"
+	case VisualScriptInputActionModePressed:
+		return "MODE_PRESSED"
+	case VisualScriptInputActionModeReleased:
+		return "MODE_RELEASED"
+	}
+	return fmt.Sprintf("VisualScriptInputActionMode(%d)", int(e))
+}
+
+// ParseVisualScriptInputActionMode will return the VisualScriptInputActionMode value with the given Godot name.
+func ParseVisualScriptInputActionMode(name string) (VisualScriptInputActionMode, error) {
+	switch name {
+	case "MODE_JUST_PRESSED":
+		return VisualScriptInputActionModeJustPressed, nil
+	case "MODE_JUST_RELEASED":
+		return VisualScriptInputActionModeJustReleased, nil
+	case "MODE_PRESSED":
+		return VisualScriptInputActionModePressed, nil
+	case "MODE_RELEASED":
+		return VisualScriptInputActionModeReleased, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptInputActionMode value %q", name)
+}
+
+/*
+Undocumented
+*/
+type VisualScriptInputAction struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptInputAction is the class constructor of the VisualScriptInputAction class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptInputAction = gdnative.NewLazyClassConstructor("VisualScriptInputAction")
+
+// NewVisualScriptInputAction will create a new instance of the VisualScriptInputAction class.
+// VisualScriptInputAction is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptInputAction() *VisualScriptInputAction {
+	obj := &VisualScriptInputAction{}
+	obj.SetBaseObject(classConstructorVisualScriptInputAction.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptInputAction) BaseClass() string {
+	return "VisualScriptInputAction"
+}
+
+// Method binds of the VisualScriptInputAction class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptInputActionGetActionMode = gdnative.NewLazyMethodBind("VisualScriptInputAction", "get_action_mode")
+	methodBindVisualScriptInputActionGetActionName = gdnative.NewLazyMethodBind("VisualScriptInputAction", "get_action_name")
+	methodBindVisualScriptInputActionSetActionMode = gdnative.NewLazyMethodBind("VisualScriptInputAction", "set_action_mode")
+	methodBindVisualScriptInputActionSetActionName = gdnative.NewLazyMethodBind("VisualScriptInputAction", "set_action_name")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptInputAction::Mode
+*/
+func (o *VisualScriptInputAction) GetActionMode() VisualScriptInputActionMode {
+	//log.Println("Calling VisualScriptInputAction.GetActionMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptInputActionGetActionMode.Get()
+
+	// Call the parent method.
+	// enum.VisualScriptInputAction::Mode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return VisualScriptInputActionMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *VisualScriptInputAction) GetActionName() gdnative.String {
+	//log.Println("Calling VisualScriptInputAction.GetActionName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptInputActionGetActionName.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.VisualScriptInputAction::Mode}], Returns: void
+*/
+func (o *VisualScriptInputAction) SetActionMode(mode VisualScriptInputActionMode) {
+	//log.Println("Calling VisualScriptInputAction.SetActionMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptInputActionSetActionMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false name String}], Returns: void
+*/
+func (o *VisualScriptInputAction) SetActionName(name gdnative.String) {
+	//log.Println("Calling VisualScriptInputAction.SetActionName()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(name)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptInputActionSetActionName.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Action will return the value of the "action" property.
+func (o *VisualScriptInputAction) Action() gdnative.String {
+	return o.GetActionName()
+}
+
+// SetAction will set the value of the "action" property.
+func (o *VisualScriptInputAction) SetAction(value gdnative.String) {
+	o.SetActionName(value)
+}
+
+// Mode will return the value of the "mode" property.
+func (o *VisualScriptInputAction) Mode() VisualScriptInputActionMode {
+	return o.GetActionMode()
+}
+
+// SetMode will set the value of the "mode" property.
+func (o *VisualScriptInputAction) SetMode(value VisualScriptInputActionMode) {
+	o.SetActionMode(value)
+}
+
+// VisualScriptInputActionImplementer is an interface that implements the methods
+// of the VisualScriptInputAction class.
+type VisualScriptInputActionImplementer interface {
+	VisualScriptNodeImplementer
+	GetActionMode() VisualScriptInputActionMode
+	GetActionName() gdnative.String
+	SetActionMode(mode VisualScriptInputActionMode)
+	SetActionName(name gdnative.String)
+	Action() gdnative.String
+	SetAction(value gdnative.String)
+	Mode() VisualScriptInputActionMode
+	SetMode(value VisualScriptInputActionMode)
+}
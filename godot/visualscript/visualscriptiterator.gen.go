@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptIteratorFromPointer(ptr gdnative.Pointer) VisualScriptIterator {
-func newVisualScriptIteratorFromPointer(ptr gdnative.Pointer) VisualScriptIterator {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptIterator{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptIterator struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptIterator is the class constructor of the VisualScriptIterator class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptIterator = gdnative.NewLazyClassConstructor("VisualScriptIterator")
+
+// NewVisualScriptIterator will create a new instance of the VisualScriptIterator class.
+// VisualScriptIterator is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptIterator() *VisualScriptIterator {
+	obj := &VisualScriptIterator{}
+	obj.SetBaseObject(classConstructorVisualScriptIterator.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptIterator) BaseClass() string {
 	return "VisualScriptIterator"
 }
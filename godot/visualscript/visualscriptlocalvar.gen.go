@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptLocalVarFromPointer(ptr gdnative.Pointer) VisualScriptLocalVar {
-func newVisualScriptLocalVarFromPointer(ptr gdnative.Pointer) VisualScriptLocalVar {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptLocalVar{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,39 @@ type VisualScriptLocalVar struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptLocalVar is the class constructor of the VisualScriptLocalVar class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptLocalVar = gdnative.NewLazyClassConstructor("VisualScriptLocalVar")
+
+// NewVisualScriptLocalVar will create a new instance of the VisualScriptLocalVar class.
+// VisualScriptLocalVar is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptLocalVar() *VisualScriptLocalVar {
+	obj := &VisualScriptLocalVar{}
+	obj.SetBaseObject(classConstructorVisualScriptLocalVar.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptLocalVar) BaseClass() string {
 	return "VisualScriptLocalVar"
 }
 
+// Method binds of the VisualScriptLocalVar class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptLocalVarGetVarName = gdnative.NewLazyMethodBind("VisualScriptLocalVar", "get_var_name")
+	methodBindVisualScriptLocalVarGetVarType = gdnative.NewLazyMethodBind("VisualScriptLocalVar", "get_var_type")
+	methodBindVisualScriptLocalVarSetVarName = gdnative.NewLazyMethodBind("VisualScriptLocalVar", "set_var_name")
+	methodBindVisualScriptLocalVarSetVarType = gdnative.NewLazyMethodBind("VisualScriptLocalVar", "set_var_type")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptLocalVar) GetVarName() gdnative.String {
 	//log.Println("Calling VisualScriptLocalVar.GetVarName()")
@@ -45,7 +63,7 @@ func (o *VisualScriptLocalVar) GetVarName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVar", "get_var_name")
+	methodBind := methodBindVisualScriptLocalVarGetVarName.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +76,8 @@ func (o *VisualScriptLocalVar) GetVarName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptLocalVar) GetVarType() gdnative.VariantType {
 	//log.Println("Calling VisualScriptLocalVar.GetVarType()")
@@ -68,7 +86,7 @@ func (o *VisualScriptLocalVar) GetVarType() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVar", "get_var_type")
+	methodBind := methodBindVisualScriptLocalVarGetVarType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -81,8 +99,8 @@ func (o *VisualScriptLocalVar) GetVarType() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptLocalVar) SetVarName(name gdnative.String) {
 	//log.Println("Calling VisualScriptLocalVar.SetVarName()")
@@ -92,7 +110,7 @@ func (o *VisualScriptLocalVar) SetVarName(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVar", "set_var_name")
+	methodBind := methodBindVisualScriptLocalVarSetVarName.Get()
 
 	// Call the parent method.
 	// void
@@ -102,18 +120,18 @@ func (o *VisualScriptLocalVar) SetVarName(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false type int}], Returns: void
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
 */
-func (o *VisualScriptLocalVar) SetVarType(aType gdnative.Int) {
+func (o *VisualScriptLocalVar) SetVarType(aType gdnative.VariantType) {
 	//log.Println("Calling VisualScriptLocalVar.SetVarType()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVar", "set_var_type")
+	methodBind := methodBindVisualScriptLocalVarSetVarType.Get()
 
 	// Call the parent method.
 	// void
@@ -122,11 +140,30 @@ func (o *VisualScriptLocalVar) SetVarType(aType gdnative.Int) {
 
 }
 
+// Type will return the value of the "type" property.
+func (o *VisualScriptLocalVar) Type() gdnative.VariantType {
+	return o.GetVarType()
+}
+
+// SetType will set the value of the "type" property.
+func (o *VisualScriptLocalVar) SetType(value gdnative.VariantType) {
+	o.SetVarType(value)
+}
+
+// VarName will return the value of the "var_name" property.
+func (o *VisualScriptLocalVar) VarName() gdnative.String {
+	return o.GetVarName()
+}
+
 // VisualScriptLocalVarImplementer is an interface that implements the methods
 // of the VisualScriptLocalVar class.
 type VisualScriptLocalVarImplementer interface {
 	VisualScriptNodeImplementer
 	GetVarName() gdnative.String
+	GetVarType() gdnative.VariantType
 	SetVarName(name gdnative.String)
-	SetVarType(aType gdnative.Int)
+	SetVarType(aType gdnative.VariantType)
+	Type() gdnative.VariantType
+	SetType(value gdnative.VariantType)
+	VarName() gdnative.String
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptLocalVarSetFromPointer(ptr gdnative.Pointer) VisualScriptLocalVarSet {
-func newVisualScriptLocalVarSetFromPointer(ptr gdnative.Pointer) VisualScriptLocalVarSet {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptLocalVarSet{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,39 @@ type VisualScriptLocalVarSet struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptLocalVarSet is the class constructor of the VisualScriptLocalVarSet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptLocalVarSet = gdnative.NewLazyClassConstructor("VisualScriptLocalVarSet")
+
+// NewVisualScriptLocalVarSet will create a new instance of the VisualScriptLocalVarSet class.
+// VisualScriptLocalVarSet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptLocalVarSet() *VisualScriptLocalVarSet {
+	obj := &VisualScriptLocalVarSet{}
+	obj.SetBaseObject(classConstructorVisualScriptLocalVarSet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptLocalVarSet) BaseClass() string {
 	return "VisualScriptLocalVarSet"
 }
 
+// Method binds of the VisualScriptLocalVarSet class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptLocalVarSetGetVarName = gdnative.NewLazyMethodBind("VisualScriptLocalVarSet", "get_var_name")
+	methodBindVisualScriptLocalVarSetGetVarType = gdnative.NewLazyMethodBind("VisualScriptLocalVarSet", "get_var_type")
+	methodBindVisualScriptLocalVarSetSetVarName = gdnative.NewLazyMethodBind("VisualScriptLocalVarSet", "set_var_name")
+	methodBindVisualScriptLocalVarSetSetVarType = gdnative.NewLazyMethodBind("VisualScriptLocalVarSet", "set_var_type")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptLocalVarSet) GetVarName() gdnative.String {
 	//log.Println("Calling VisualScriptLocalVarSet.GetVarName()")
@@ -45,7 +63,7 @@ func (o *VisualScriptLocalVarSet) GetVarName() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVarSet", "get_var_name")
+	methodBind := methodBindVisualScriptLocalVarSetGetVarName.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +76,8 @@ func (o *VisualScriptLocalVarSet) GetVarName() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptLocalVarSet) GetVarType() gdnative.VariantType {
 	//log.Println("Calling VisualScriptLocalVarSet.GetVarType()")
@@ -68,7 +86,7 @@ func (o *VisualScriptLocalVarSet) GetVarType() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVarSet", "get_var_type")
+	methodBind := methodBindVisualScriptLocalVarSetGetVarType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -81,8 +99,8 @@ func (o *VisualScriptLocalVarSet) GetVarType() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptLocalVarSet) SetVarName(name gdnative.String) {
 	//log.Println("Calling VisualScriptLocalVarSet.SetVarName()")
@@ -92,7 +110,7 @@ func (o *VisualScriptLocalVarSet) SetVarName(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVarSet", "set_var_name")
+	methodBind := methodBindVisualScriptLocalVarSetSetVarName.Get()
 
 	// Call the parent method.
 	// void
@@ -102,18 +120,18 @@ func (o *VisualScriptLocalVarSet) SetVarName(name gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false type int}], Returns: void
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
 */
-func (o *VisualScriptLocalVarSet) SetVarType(aType gdnative.Int) {
+func (o *VisualScriptLocalVarSet) SetVarType(aType gdnative.VariantType) {
 	//log.Println("Calling VisualScriptLocalVarSet.SetVarType()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptLocalVarSet", "set_var_type")
+	methodBind := methodBindVisualScriptLocalVarSetSetVarType.Get()
 
 	// Call the parent method.
 	// void
@@ -122,11 +140,30 @@ func (o *VisualScriptLocalVarSet) SetVarType(aType gdnative.Int) {
 
 }
 
+// Type will return the value of the "type" property.
+func (o *VisualScriptLocalVarSet) Type() gdnative.VariantType {
+	return o.GetVarType()
+}
+
+// SetType will set the value of the "type" property.
+func (o *VisualScriptLocalVarSet) SetType(value gdnative.VariantType) {
+	o.SetVarType(value)
+}
+
+// VarName will return the value of the "var_name" property.
+func (o *VisualScriptLocalVarSet) VarName() gdnative.String {
+	return o.GetVarName()
+}
+
 // VisualScriptLocalVarSetImplementer is an interface that implements the methods
 // of the VisualScriptLocalVarSet class.
 type VisualScriptLocalVarSetImplementer interface {
 	VisualScriptNodeImplementer
 	GetVarName() gdnative.String
+	GetVarType() gdnative.VariantType
 	SetVarName(name gdnative.String)
-	SetVarType(aType gdnative.Int)
+	SetVarType(aType gdnative.VariantType)
+	Type() gdnative.VariantType
+	SetType(value gdnative.VariantType)
+	VarName() gdnative.String
 }
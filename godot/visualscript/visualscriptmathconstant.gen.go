@@ -0,0 +1,182 @@
+package visualscript
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// VisualScriptMathConstantMathConstant is an enum for MathConstant values.
+type VisualScriptMathConstantMathConstant int
+
+const (
+	VisualScriptMathConstantMathConstantE      VisualScriptMathConstantMathConstant = 4
+	VisualScriptMathConstantMathConstantHalfPi VisualScriptMathConstantMathConstant = 2
+	VisualScriptMathConstantMathConstantInf    VisualScriptMathConstantMathConstant = 6
+	VisualScriptMathConstantMathConstantMax    VisualScriptMathConstantMathConstant = 8
+	VisualScriptMathConstantMathConstantNan    VisualScriptMathConstantMathConstant = 7
+	VisualScriptMathConstantMathConstantOne    VisualScriptMathConstantMathConstant = 0
+	VisualScriptMathConstantMathConstantPi     VisualScriptMathConstantMathConstant = 1
+	VisualScriptMathConstantMathConstantSqrt2  VisualScriptMathConstantMathConstant = 5
+	VisualScriptMathConstantMathConstantTau    VisualScriptMathConstantMathConstant = 3
+)
+
+// String will return the Godot name of the VisualScriptMathConstantMathConstant value.
+func (e VisualScriptMathConstantMathConstant) String() string {
+	switch e {
+	case VisualScriptMathConstantMathConstantE:
+		return "MATH_CONSTANT_E"
+	case VisualScriptMathConstantMathConstantHalfPi:
+		return "MATH_CONSTANT_HALF_PI"
+	case VisualScriptMathConstantMathConstantInf:
+		return "MATH_CONSTANT_INF"
+	case VisualScriptMathConstantMathConstantMax:
+		return "MATH_CONSTANT_MAX"
+	case VisualScriptMathConstantMathConstantNan:
+		return "MATH_CONSTANT_NAN"
+	case VisualScriptMathConstantMathConstantOne:
+		return "MATH_CONSTANT_ONE"
+	case VisualScriptMathConstantMathConstantPi:
+		return "MATH_CONSTANT_PI"
+	case VisualScriptMathConstantMathConstantSqrt2:
+		return "MATH_CONSTANT_SQRT2"
+	case VisualScriptMathConstantMathConstantTau:
+		return "MATH_CONSTANT_TAU"
+	}
+	return fmt.Sprintf("VisualScriptMathConstantMathConstant(%d)", int(e))
+}
+
+// ParseVisualScriptMathConstantMathConstant will return the VisualScriptMathConstantMathConstant value with the given Godot name.
+func ParseVisualScriptMathConstantMathConstant(name string) (VisualScriptMathConstantMathConstant, error) {
+	switch name {
+	case "MATH_CONSTANT_E":
+		return VisualScriptMathConstantMathConstantE, nil
+	case "MATH_CONSTANT_HALF_PI":
+		return VisualScriptMathConstantMathConstantHalfPi, nil
+	case "MATH_CONSTANT_INF":
+		return VisualScriptMathConstantMathConstantInf, nil
+	case "MATH_CONSTANT_MAX":
+		return VisualScriptMathConstantMathConstantMax, nil
+	case "MATH_CONSTANT_NAN":
+		return VisualScriptMathConstantMathConstantNan, nil
+	case "MATH_CONSTANT_ONE":
+		return VisualScriptMathConstantMathConstantOne, nil
+	case "MATH_CONSTANT_PI":
+		return VisualScriptMathConstantMathConstantPi, nil
+	case "MATH_CONSTANT_SQRT2":
+		return VisualScriptMathConstantMathConstantSqrt2, nil
+	case "MATH_CONSTANT_TAU":
+		return VisualScriptMathConstantMathConstantTau, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptMathConstantMathConstant value %q", name)
+}
+
+/*
+Undocumented
+*/
+type VisualScriptMathConstant struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptMathConstant is the class constructor of the VisualScriptMathConstant class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptMathConstant = gdnative.NewLazyClassConstructor("VisualScriptMathConstant")
+
+// NewVisualScriptMathConstant will create a new instance of the VisualScriptMathConstant class.
+// VisualScriptMathConstant is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptMathConstant() *VisualScriptMathConstant {
+	obj := &VisualScriptMathConstant{}
+	obj.SetBaseObject(classConstructorVisualScriptMathConstant.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptMathConstant) BaseClass() string {
+	return "VisualScriptMathConstant"
+}
+
+// Method binds of the VisualScriptMathConstant class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptMathConstantGetMathConstant = gdnative.NewLazyMethodBind("VisualScriptMathConstant", "get_math_constant")
+	methodBindVisualScriptMathConstantSetMathConstant = gdnative.NewLazyMethodBind("VisualScriptMathConstant", "set_math_constant")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptMathConstant::MathConstant
+*/
+func (o *VisualScriptMathConstant) GetMathConstant() VisualScriptMathConstantMathConstant {
+	//log.Println("Calling VisualScriptMathConstant.GetMathConstant()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptMathConstantGetMathConstant.Get()
+
+	// Call the parent method.
+	// enum.VisualScriptMathConstant::MathConstant
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return VisualScriptMathConstantMathConstant(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false which enum.VisualScriptMathConstant::MathConstant}], Returns: void
+*/
+func (o *VisualScriptMathConstant) SetMathConstant(which VisualScriptMathConstantMathConstant) {
+	//log.Println("Calling VisualScriptMathConstant.SetMathConstant()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(which))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptMathConstantSetMathConstant.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Constant will return the value of the "constant" property.
+func (o *VisualScriptMathConstant) Constant() VisualScriptMathConstantMathConstant {
+	return o.GetMathConstant()
+}
+
+// SetConstant will set the value of the "constant" property.
+func (o *VisualScriptMathConstant) SetConstant(value VisualScriptMathConstantMathConstant) {
+	o.SetMathConstant(value)
+}
+
+// VisualScriptMathConstantImplementer is an interface that implements the methods
+// of the VisualScriptMathConstant class.
+type VisualScriptMathConstantImplementer interface {
+	VisualScriptNodeImplementer
+	GetMathConstant() VisualScriptMathConstantMathConstant
+	SetMathConstant(which VisualScriptMathConstantMathConstant)
+	Constant() VisualScriptMathConstantMathConstant
+	SetConstant(value VisualScriptMathConstantMathConstant)
+}
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,20 +14,11 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptNodeFromPointer(ptr gdnative.Pointer) VisualScriptNode {
-func newVisualScriptNodeFromPointer(ptr gdnative.Pointer) VisualScriptNode {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptNode{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
 type VisualScriptNode struct {
-	Resource
+	godot.Resource
 	owner gdnative.Object
 }
 
@@ -34,9 +26,20 @@ func (o *VisualScriptNode) BaseClass() string {
 	return "VisualScriptNode"
 }
 
+// Method binds of the VisualScriptNode class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptNodeX_GetDefaultInputValues = gdnative.NewLazyMethodBind("VisualScriptNode", "_get_default_input_values")
+	methodBindVisualScriptNodeX_SetDefaultInputValues = gdnative.NewLazyMethodBind("VisualScriptNode", "_set_default_input_values")
+	methodBindVisualScriptNodeGetDefaultInputValue    = gdnative.NewLazyMethodBind("VisualScriptNode", "get_default_input_value")
+	methodBindVisualScriptNodeGetVisualScript         = gdnative.NewLazyMethodBind("VisualScriptNode", "get_visual_script")
+	methodBindVisualScriptNodePortsChangedNotify      = gdnative.NewLazyMethodBind("VisualScriptNode", "ports_changed_notify")
+	methodBindVisualScriptNodeSetDefaultInputValue    = gdnative.NewLazyMethodBind("VisualScriptNode", "set_default_input_value")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: Array
+	        Undocumented
+		Args: [], Returns: Array
 */
 func (o *VisualScriptNode) X_GetDefaultInputValues() gdnative.Array {
 	//log.Println("Calling VisualScriptNode.X_GetDefaultInputValues()")
@@ -45,7 +48,7 @@ func (o *VisualScriptNode) X_GetDefaultInputValues() gdnative.Array {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptNode", "_get_default_input_values")
+	methodBind := methodBindVisualScriptNodeX_GetDefaultInputValues.Get()
 
 	// Call the parent method.
 	// Array
@@ -58,8 +61,8 @@ func (o *VisualScriptNode) X_GetDefaultInputValues() gdnative.Array {
 }
 
 /*
-        Undocumented
-	Args: [{ false values Array}], Returns: void
+	        Undocumented
+		Args: [{ false values Array}], Returns: void
 */
 func (o *VisualScriptNode) X_SetDefaultInputValues(values gdnative.Array) {
 	//log.Println("Calling VisualScriptNode.X_SetDefaultInputValues()")
@@ -69,7 +72,7 @@ func (o *VisualScriptNode) X_SetDefaultInputValues(values gdnative.Array) {
 	ptrArguments[0] = gdnative.NewPointerFromArray(values)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptNode", "_set_default_input_values")
+	methodBind := methodBindVisualScriptNodeX_SetDefaultInputValues.Get()
 
 	// Call the parent method.
 	// void
@@ -79,8 +82,8 @@ func (o *VisualScriptNode) X_SetDefaultInputValues(values gdnative.Array) {
 }
 
 /*
-        Undocumented
-	Args: [{ false port_idx int}], Returns: Variant
+	        Undocumented
+		Args: [{ false port_idx int}], Returns: Variant
 */
 func (o *VisualScriptNode) GetDefaultInputValue(portIdx gdnative.Int) gdnative.Variant {
 	//log.Println("Calling VisualScriptNode.GetDefaultInputValue()")
@@ -90,7 +93,7 @@ func (o *VisualScriptNode) GetDefaultInputValue(portIdx gdnative.Int) gdnative.V
 	ptrArguments[0] = gdnative.NewPointerFromInt(portIdx)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptNode", "get_default_input_value")
+	methodBind := methodBindVisualScriptNodeGetDefaultInputValue.Get()
 
 	// Call the parent method.
 	// Variant
@@ -103,8 +106,8 @@ func (o *VisualScriptNode) GetDefaultInputValue(portIdx gdnative.Int) gdnative.V
 }
 
 /*
-        Undocumented
-	Args: [], Returns: VisualScript
+	        Undocumented
+		Args: [], Returns: VisualScript
 */
 func (o *VisualScriptNode) GetVisualScript() VisualScriptImplementer {
 	//log.Println("Calling VisualScriptNode.GetVisualScript()")
@@ -113,7 +116,7 @@ func (o *VisualScriptNode) GetVisualScript() VisualScriptImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptNode", "get_visual_script")
+	methodBind := methodBindVisualScriptNodeGetVisualScript.Get()
 
 	// Call the parent method.
 	// VisualScript
@@ -121,27 +124,25 @@ func (o *VisualScriptNode) GetVisualScript() VisualScriptImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newVisualScriptFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(VisualScriptImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "VisualScript" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(VisualScriptImplementer)
+	ret := VisualScript{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(VisualScriptImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: void
+	        Undocumented
+		Args: [], Returns: void
 */
 func (o *VisualScriptNode) PortsChangedNotify() {
 	//log.Println("Calling VisualScriptNode.PortsChangedNotify()")
@@ -150,7 +151,7 @@ func (o *VisualScriptNode) PortsChangedNotify() {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptNode", "ports_changed_notify")
+	methodBind := methodBindVisualScriptNodePortsChangedNotify.Get()
 
 	// Call the parent method.
 	// void
@@ -160,8 +161,8 @@ func (o *VisualScriptNode) PortsChangedNotify() {
 }
 
 /*
-        Undocumented
-	Args: [{ false port_idx int} { false value Variant}], Returns: void
+	        Undocumented
+		Args: [{ false port_idx int} { false value Variant}], Returns: void
 */
 func (o *VisualScriptNode) SetDefaultInputValue(portIdx gdnative.Int, value gdnative.Variant) {
 	//log.Println("Calling VisualScriptNode.SetDefaultInputValue()")
@@ -172,7 +173,7 @@ func (o *VisualScriptNode) SetDefaultInputValue(portIdx gdnative.Int, value gdna
 	ptrArguments[1] = gdnative.NewPointerFromVariant(value)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptNode", "set_default_input_value")
+	methodBind := methodBindVisualScriptNodeSetDefaultInputValue.Get()
 
 	// Call the parent method.
 	// void
@@ -181,14 +182,37 @@ func (o *VisualScriptNode) SetDefaultInputValue(portIdx gdnative.Int, value gdna
 
 }
 
+// Signals of the VisualScriptNode class.
+const (
+	VisualScriptNodeSignalPortsChanged gdnative.String = "ports_changed"
+)
+
+// ConnectPortsChanged will connect the "ports_changed" signal to the given method of the target object.
+func (o *VisualScriptNode) ConnectPortsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error {
+	binds := gdnative.NewArray()
+	defer binds.Destroy()
+
+	return o.Connect(VisualScriptNodeSignalPortsChanged, target, method, binds, 0)
+}
+
+// EmitPortsChanged will emit the "ports_changed" signal.
+func (o *VisualScriptNode) EmitPortsChanged() error {
+
+	ret, err := o.EmitSignal(VisualScriptNodeSignalPortsChanged)
+	ret.Destroy()
+	return err
+}
+
 // VisualScriptNodeImplementer is an interface that implements the methods
 // of the VisualScriptNode class.
 type VisualScriptNodeImplementer interface {
-	ResourceImplementer
+	godot.ResourceImplementer
 	X_GetDefaultInputValues() gdnative.Array
 	X_SetDefaultInputValues(values gdnative.Array)
 	GetDefaultInputValue(portIdx gdnative.Int) gdnative.Variant
 	GetVisualScript() VisualScriptImplementer
 	PortsChangedNotify()
 	SetDefaultInputValue(portIdx gdnative.Int, value gdnative.Variant)
+	ConnectPortsChanged(target godot.ObjectImplementer, method gdnative.String) gdnative.Error
+	EmitPortsChanged() error
 }
@@ -0,0 +1,169 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type VisualScriptOperator struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptOperator is the class constructor of the VisualScriptOperator class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptOperator = gdnative.NewLazyClassConstructor("VisualScriptOperator")
+
+// NewVisualScriptOperator will create a new instance of the VisualScriptOperator class.
+// VisualScriptOperator is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptOperator() *VisualScriptOperator {
+	obj := &VisualScriptOperator{}
+	obj.SetBaseObject(classConstructorVisualScriptOperator.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptOperator) BaseClass() string {
+	return "VisualScriptOperator"
+}
+
+// Method binds of the VisualScriptOperator class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptOperatorGetOperator = gdnative.NewLazyMethodBind("VisualScriptOperator", "get_operator")
+	methodBindVisualScriptOperatorGetTyped    = gdnative.NewLazyMethodBind("VisualScriptOperator", "get_typed")
+	methodBindVisualScriptOperatorSetOperator = gdnative.NewLazyMethodBind("VisualScriptOperator", "set_operator")
+	methodBindVisualScriptOperatorSetTyped    = gdnative.NewLazyMethodBind("VisualScriptOperator", "set_typed")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Variant::Operator
+*/
+func (o *VisualScriptOperator) GetOperator() gdnative.VariantOperator {
+	//log.Println("Calling VisualScriptOperator.GetOperator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptOperatorGetOperator.Get()
+
+	// Call the parent method.
+	// enum.Variant::Operator
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.VariantOperator(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
+*/
+func (o *VisualScriptOperator) GetTyped() gdnative.VariantType {
+	//log.Println("Calling VisualScriptOperator.GetTyped()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptOperatorGetTyped.Get()
+
+	// Call the parent method.
+	// enum.Variant::Type
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.VariantType(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false op enum.Variant::Operator}], Returns: void
+*/
+func (o *VisualScriptOperator) SetOperator(op gdnative.VariantOperator) {
+	//log.Println("Calling VisualScriptOperator.SetOperator()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(op))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptOperatorSetOperator.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
+*/
+func (o *VisualScriptOperator) SetTyped(aType gdnative.VariantType) {
+	//log.Println("Calling VisualScriptOperator.SetTyped()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptOperatorSetTyped.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Operator will return the value of the "operator" property.
+func (o *VisualScriptOperator) Operator() gdnative.VariantOperator {
+	return o.GetOperator()
+}
+
+// Type will return the value of the "type" property.
+func (o *VisualScriptOperator) Type() gdnative.VariantType {
+	return o.GetTyped()
+}
+
+// SetType will set the value of the "type" property.
+func (o *VisualScriptOperator) SetType(value gdnative.VariantType) {
+	o.SetTyped(value)
+}
+
+// VisualScriptOperatorImplementer is an interface that implements the methods
+// of the VisualScriptOperator class.
+type VisualScriptOperatorImplementer interface {
+	VisualScriptNodeImplementer
+	GetOperator() gdnative.VariantOperator
+	GetTyped() gdnative.VariantType
+	SetOperator(op gdnative.VariantOperator)
+	SetTyped(aType gdnative.VariantType)
+	Operator() gdnative.VariantOperator
+	Type() gdnative.VariantType
+	SetType(value gdnative.VariantType)
+}
@@ -0,0 +1,115 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type VisualScriptPreload struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptPreload is the class constructor of the VisualScriptPreload class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptPreload = gdnative.NewLazyClassConstructor("VisualScriptPreload")
+
+// NewVisualScriptPreload will create a new instance of the VisualScriptPreload class.
+// VisualScriptPreload is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptPreload() *VisualScriptPreload {
+	obj := &VisualScriptPreload{}
+	obj.SetBaseObject(classConstructorVisualScriptPreload.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptPreload) BaseClass() string {
+	return "VisualScriptPreload"
+}
+
+// Method binds of the VisualScriptPreload class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptPreloadGetPreload = gdnative.NewLazyMethodBind("VisualScriptPreload", "get_preload")
+	methodBindVisualScriptPreloadSetPreload = gdnative.NewLazyMethodBind("VisualScriptPreload", "set_preload")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Resource
+*/
+func (o *VisualScriptPreload) GetPreload() godot.ResourceImplementer {
+	//log.Println("Calling VisualScriptPreload.GetPreload()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPreloadGetPreload.Get()
+
+	// Call the parent method.
+	// Resource
+	retPtr := gdnative.NewEmptyObject()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := godot.Resource{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := godot.GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(godot.ResourceImplementer); ok {
+			return implementer
+		}
+	}
+
+	return &ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false resource Resource}], Returns: void
+*/
+func (o *VisualScriptPreload) SetPreload(resource godot.ResourceImplementer) {
+	//log.Println("Calling VisualScriptPreload.SetPreload()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromObject(godot.GetBaseObject(resource))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPreloadSetPreload.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// VisualScriptPreloadImplementer is an interface that implements the methods
+// of the VisualScriptPreload class.
+type VisualScriptPreloadImplementer interface {
+	VisualScriptNodeImplementer
+	GetPreload() godot.ResourceImplementer
+	SetPreload(resource godot.ResourceImplementer)
+}
@@ -1,7 +1,10 @@
-package godot
+package visualscript
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,13 +25,30 @@ const (
 	VisualScriptPropertyGetCallModeSelf     VisualScriptPropertyGetCallMode = 0
 )
 
-//func NewVisualScriptPropertyGetFromPointer(ptr gdnative.Pointer) VisualScriptPropertyGet {
-func newVisualScriptPropertyGetFromPointer(ptr gdnative.Pointer) VisualScriptPropertyGet {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptPropertyGet{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the VisualScriptPropertyGetCallMode value.
+func (e VisualScriptPropertyGetCallMode) String() string {
+	switch e {
+	case VisualScriptPropertyGetCallModeInstance:
+		return "CALL_MODE_INSTANCE"
+	case VisualScriptPropertyGetCallModeNodePath:
+		return "CALL_MODE_NODE_PATH"
+	case VisualScriptPropertyGetCallModeSelf:
+		return "CALL_MODE_SELF"
+	}
+	return fmt.Sprintf("VisualScriptPropertyGetCallMode(%d)", int(e))
+}
 
-	return obj
+// ParseVisualScriptPropertyGetCallMode will return the VisualScriptPropertyGetCallMode value with the given Godot name.
+func ParseVisualScriptPropertyGetCallMode(name string) (VisualScriptPropertyGetCallMode, error) {
+	switch name {
+	case "CALL_MODE_INSTANCE":
+		return VisualScriptPropertyGetCallModeInstance, nil
+	case "CALL_MODE_NODE_PATH":
+		return VisualScriptPropertyGetCallModeNodePath, nil
+	case "CALL_MODE_SELF":
+		return VisualScriptPropertyGetCallModeSelf, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptPropertyGetCallMode value %q", name)
 }
 
 /*
@@ -39,13 +59,51 @@ type VisualScriptPropertyGet struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptPropertyGet is the class constructor of the VisualScriptPropertyGet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptPropertyGet = gdnative.NewLazyClassConstructor("VisualScriptPropertyGet")
+
+// NewVisualScriptPropertyGet will create a new instance of the VisualScriptPropertyGet class.
+// VisualScriptPropertyGet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptPropertyGet() *VisualScriptPropertyGet {
+	obj := &VisualScriptPropertyGet{}
+	obj.SetBaseObject(classConstructorVisualScriptPropertyGet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptPropertyGet) BaseClass() string {
 	return "VisualScriptPropertyGet"
 }
 
+// Method binds of the VisualScriptPropertyGet class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptPropertyGetX_GetTypeCache = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "_get_type_cache")
+	methodBindVisualScriptPropertyGetX_SetTypeCache = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "_set_type_cache")
+	methodBindVisualScriptPropertyGetGetBasePath    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "get_base_path")
+	methodBindVisualScriptPropertyGetGetBaseScript  = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "get_base_script")
+	methodBindVisualScriptPropertyGetGetBaseType    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "get_base_type")
+	methodBindVisualScriptPropertyGetGetBasicType   = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "get_basic_type")
+	methodBindVisualScriptPropertyGetGetCallMode    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "get_call_mode")
+	methodBindVisualScriptPropertyGetGetIndex       = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "get_index")
+	methodBindVisualScriptPropertyGetGetProperty    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "get_property")
+	methodBindVisualScriptPropertyGetSetBasePath    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "set_base_path")
+	methodBindVisualScriptPropertyGetSetBaseScript  = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "set_base_script")
+	methodBindVisualScriptPropertyGetSetBaseType    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "set_base_type")
+	methodBindVisualScriptPropertyGetSetBasicType   = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "set_basic_type")
+	methodBindVisualScriptPropertyGetSetCallMode    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "set_call_mode")
+	methodBindVisualScriptPropertyGetSetIndex       = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "set_index")
+	methodBindVisualScriptPropertyGetSetProperty    = gdnative.NewLazyMethodBind("VisualScriptPropertyGet", "set_property")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptPropertyGet) X_GetTypeCache() gdnative.VariantType {
 	//log.Println("Calling VisualScriptPropertyGet.X_GetTypeCache()")
@@ -54,7 +112,7 @@ func (o *VisualScriptPropertyGet) X_GetTypeCache() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "_get_type_cache")
+	methodBind := methodBindVisualScriptPropertyGetX_GetTypeCache.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -67,8 +125,8 @@ func (o *VisualScriptPropertyGet) X_GetTypeCache() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [{ false type_cache int}], Returns: void
+	        Undocumented
+		Args: [{ false type_cache int}], Returns: void
 */
 func (o *VisualScriptPropertyGet) X_SetTypeCache(typeCache gdnative.Int) {
 	//log.Println("Calling VisualScriptPropertyGet.X_SetTypeCache()")
@@ -78,7 +136,7 @@ func (o *VisualScriptPropertyGet) X_SetTypeCache(typeCache gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(typeCache)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "_set_type_cache")
+	methodBind := methodBindVisualScriptPropertyGetX_SetTypeCache.Get()
 
 	// Call the parent method.
 	// void
@@ -88,8 +146,8 @@ func (o *VisualScriptPropertyGet) X_SetTypeCache(typeCache gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *VisualScriptPropertyGet) GetBasePath() gdnative.NodePath {
 	//log.Println("Calling VisualScriptPropertyGet.GetBasePath()")
@@ -98,7 +156,7 @@ func (o *VisualScriptPropertyGet) GetBasePath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "get_base_path")
+	methodBind := methodBindVisualScriptPropertyGetGetBasePath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -111,8 +169,8 @@ func (o *VisualScriptPropertyGet) GetBasePath() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptPropertyGet) GetBaseScript() gdnative.String {
 	//log.Println("Calling VisualScriptPropertyGet.GetBaseScript()")
@@ -121,7 +179,7 @@ func (o *VisualScriptPropertyGet) GetBaseScript() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "get_base_script")
+	methodBind := methodBindVisualScriptPropertyGetGetBaseScript.Get()
 
 	// Call the parent method.
 	// String
@@ -134,8 +192,8 @@ func (o *VisualScriptPropertyGet) GetBaseScript() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptPropertyGet) GetBaseType() gdnative.String {
 	//log.Println("Calling VisualScriptPropertyGet.GetBaseType()")
@@ -144,7 +202,7 @@ func (o *VisualScriptPropertyGet) GetBaseType() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "get_base_type")
+	methodBind := methodBindVisualScriptPropertyGetGetBaseType.Get()
 
 	// Call the parent method.
 	// String
@@ -157,8 +215,8 @@ func (o *VisualScriptPropertyGet) GetBaseType() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptPropertyGet) GetBasicType() gdnative.VariantType {
 	//log.Println("Calling VisualScriptPropertyGet.GetBasicType()")
@@ -167,7 +225,7 @@ func (o *VisualScriptPropertyGet) GetBasicType() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "get_basic_type")
+	methodBind := methodBindVisualScriptPropertyGetGetBasicType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -180,8 +238,8 @@ func (o *VisualScriptPropertyGet) GetBasicType() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.VisualScriptPropertyGet::CallMode
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptPropertyGet::CallMode
 */
 func (o *VisualScriptPropertyGet) GetCallMode() VisualScriptPropertyGetCallMode {
 	//log.Println("Calling VisualScriptPropertyGet.GetCallMode()")
@@ -190,7 +248,7 @@ func (o *VisualScriptPropertyGet) GetCallMode() VisualScriptPropertyGetCallMode
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "get_call_mode")
+	methodBind := methodBindVisualScriptPropertyGetGetCallMode.Get()
 
 	// Call the parent method.
 	// enum.VisualScriptPropertyGet::CallMode
@@ -203,8 +261,8 @@ func (o *VisualScriptPropertyGet) GetCallMode() VisualScriptPropertyGetCallMode
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptPropertyGet) GetIndex() gdnative.String {
 	//log.Println("Calling VisualScriptPropertyGet.GetIndex()")
@@ -213,7 +271,7 @@ func (o *VisualScriptPropertyGet) GetIndex() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "get_index")
+	methodBind := methodBindVisualScriptPropertyGetGetIndex.Get()
 
 	// Call the parent method.
 	// String
@@ -226,8 +284,8 @@ func (o *VisualScriptPropertyGet) GetIndex() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptPropertyGet) GetProperty() gdnative.String {
 	//log.Println("Calling VisualScriptPropertyGet.GetProperty()")
@@ -236,7 +294,7 @@ func (o *VisualScriptPropertyGet) GetProperty() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "get_property")
+	methodBind := methodBindVisualScriptPropertyGetGetProperty.Get()
 
 	// Call the parent method.
 	// String
@@ -249,8 +307,8 @@ func (o *VisualScriptPropertyGet) GetProperty() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false base_path NodePath}], Returns: void
 */
 func (o *VisualScriptPropertyGet) SetBasePath(basePath gdnative.NodePath) {
 	//log.Println("Calling VisualScriptPropertyGet.SetBasePath()")
@@ -260,7 +318,7 @@ func (o *VisualScriptPropertyGet) SetBasePath(basePath gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(basePath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "set_base_path")
+	methodBind := methodBindVisualScriptPropertyGetSetBasePath.Get()
 
 	// Call the parent method.
 	// void
@@ -270,8 +328,8 @@ func (o *VisualScriptPropertyGet) SetBasePath(basePath gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_script String}], Returns: void
+	        Undocumented
+		Args: [{ false base_script String}], Returns: void
 */
 func (o *VisualScriptPropertyGet) SetBaseScript(baseScript gdnative.String) {
 	//log.Println("Calling VisualScriptPropertyGet.SetBaseScript()")
@@ -281,7 +339,7 @@ func (o *VisualScriptPropertyGet) SetBaseScript(baseScript gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(baseScript)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "set_base_script")
+	methodBind := methodBindVisualScriptPropertyGetSetBaseScript.Get()
 
 	// Call the parent method.
 	// void
@@ -291,8 +349,8 @@ func (o *VisualScriptPropertyGet) SetBaseScript(baseScript gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_type String}], Returns: void
+	        Undocumented
+		Args: [{ false base_type String}], Returns: void
 */
 func (o *VisualScriptPropertyGet) SetBaseType(baseType gdnative.String) {
 	//log.Println("Calling VisualScriptPropertyGet.SetBaseType()")
@@ -302,7 +360,7 @@ func (o *VisualScriptPropertyGet) SetBaseType(baseType gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(baseType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "set_base_type")
+	methodBind := methodBindVisualScriptPropertyGetSetBaseType.Get()
 
 	// Call the parent method.
 	// void
@@ -312,18 +370,18 @@ func (o *VisualScriptPropertyGet) SetBaseType(baseType gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false basic_type int}], Returns: void
+	        Undocumented
+		Args: [{ false basic_type enum.Variant::Type}], Returns: void
 */
-func (o *VisualScriptPropertyGet) SetBasicType(basicType gdnative.Int) {
+func (o *VisualScriptPropertyGet) SetBasicType(basicType gdnative.VariantType) {
 	//log.Println("Calling VisualScriptPropertyGet.SetBasicType()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(basicType)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(basicType))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "set_basic_type")
+	methodBind := methodBindVisualScriptPropertyGetSetBasicType.Get()
 
 	// Call the parent method.
 	// void
@@ -333,18 +391,18 @@ func (o *VisualScriptPropertyGet) SetBasicType(basicType gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.VisualScriptPropertyGet::CallMode}], Returns: void
 */
-func (o *VisualScriptPropertyGet) SetCallMode(mode gdnative.Int) {
+func (o *VisualScriptPropertyGet) SetCallMode(mode VisualScriptPropertyGetCallMode) {
 	//log.Println("Calling VisualScriptPropertyGet.SetCallMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "set_call_mode")
+	methodBind := methodBindVisualScriptPropertyGetSetCallMode.Get()
 
 	// Call the parent method.
 	// void
@@ -354,8 +412,8 @@ func (o *VisualScriptPropertyGet) SetCallMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false index String}], Returns: void
+	        Undocumented
+		Args: [{ false index String}], Returns: void
 */
 func (o *VisualScriptPropertyGet) SetIndex(index gdnative.String) {
 	//log.Println("Calling VisualScriptPropertyGet.SetIndex()")
@@ -365,7 +423,7 @@ func (o *VisualScriptPropertyGet) SetIndex(index gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(index)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "set_index")
+	methodBind := methodBindVisualScriptPropertyGetSetIndex.Get()
 
 	// Call the parent method.
 	// void
@@ -375,8 +433,8 @@ func (o *VisualScriptPropertyGet) SetIndex(index gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false property String}], Returns: void
+	        Undocumented
+		Args: [{ false property String}], Returns: void
 */
 func (o *VisualScriptPropertyGet) SetProperty(property gdnative.String) {
 	//log.Println("Calling VisualScriptPropertyGet.SetProperty()")
@@ -386,7 +444,7 @@ func (o *VisualScriptPropertyGet) SetProperty(property gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(property)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptPropertyGet", "set_property")
+	methodBind := methodBindVisualScriptPropertyGetSetProperty.Get()
 
 	// Call the parent method.
 	// void
@@ -395,21 +453,90 @@ func (o *VisualScriptPropertyGet) SetProperty(property gdnative.String) {
 
 }
 
+// BaseScript will return the value of the "base_script" property.
+func (o *VisualScriptPropertyGet) BaseScript() gdnative.String {
+	return o.GetBaseScript()
+}
+
+// BaseType will return the value of the "base_type" property.
+func (o *VisualScriptPropertyGet) BaseType() gdnative.String {
+	return o.GetBaseType()
+}
+
+// BasicType will return the value of the "basic_type" property.
+func (o *VisualScriptPropertyGet) BasicType() gdnative.VariantType {
+	return o.GetBasicType()
+}
+
+// Index will return the value of the "index" property.
+func (o *VisualScriptPropertyGet) Index() gdnative.String {
+	return o.GetIndex()
+}
+
+// NodePath will return the value of the "node_path" property.
+func (o *VisualScriptPropertyGet) NodePath() gdnative.NodePath {
+	return o.GetBasePath()
+}
+
+// SetNodePath will set the value of the "node_path" property.
+func (o *VisualScriptPropertyGet) SetNodePath(value gdnative.NodePath) {
+	o.SetBasePath(value)
+}
+
+// Property will return the value of the "property" property.
+func (o *VisualScriptPropertyGet) Property() gdnative.String {
+	return o.GetProperty()
+}
+
+// SetMode will return the value of the "set_mode" property.
+func (o *VisualScriptPropertyGet) SetMode() VisualScriptPropertyGetCallMode {
+	return o.GetCallMode()
+}
+
+// SetSetMode will set the value of the "set_mode" property.
+func (o *VisualScriptPropertyGet) SetSetMode(value VisualScriptPropertyGetCallMode) {
+	o.SetCallMode(value)
+}
+
+// TypeCache will return the value of the "type_cache" property.
+func (o *VisualScriptPropertyGet) TypeCache() gdnative.VariantType {
+	return o.X_GetTypeCache()
+}
+
+// SetTypeCache will set the value of the "type_cache" property.
+func (o *VisualScriptPropertyGet) SetTypeCache(value gdnative.Int) {
+	o.X_SetTypeCache(value)
+}
+
 // VisualScriptPropertyGetImplementer is an interface that implements the methods
 // of the VisualScriptPropertyGet class.
 type VisualScriptPropertyGetImplementer interface {
 	VisualScriptNodeImplementer
+	X_GetTypeCache() gdnative.VariantType
 	X_SetTypeCache(typeCache gdnative.Int)
 	GetBasePath() gdnative.NodePath
 	GetBaseScript() gdnative.String
 	GetBaseType() gdnative.String
+	GetBasicType() gdnative.VariantType
+	GetCallMode() VisualScriptPropertyGetCallMode
 	GetIndex() gdnative.String
 	GetProperty() gdnative.String
 	SetBasePath(basePath gdnative.NodePath)
 	SetBaseScript(baseScript gdnative.String)
 	SetBaseType(baseType gdnative.String)
-	SetBasicType(basicType gdnative.Int)
-	SetCallMode(mode gdnative.Int)
+	SetBasicType(basicType gdnative.VariantType)
+	SetCallMode(mode VisualScriptPropertyGetCallMode)
 	SetIndex(index gdnative.String)
 	SetProperty(property gdnative.String)
+	BaseScript() gdnative.String
+	BaseType() gdnative.String
+	BasicType() gdnative.VariantType
+	Index() gdnative.String
+	NodePath() gdnative.NodePath
+	SetNodePath(value gdnative.NodePath)
+	Property() gdnative.String
+	SetMode() VisualScriptPropertyGetCallMode
+	SetSetMode(value VisualScriptPropertyGetCallMode)
+	TypeCache() gdnative.VariantType
+	SetTypeCache(value gdnative.Int)
 }
@@ -0,0 +1,676 @@
+package visualscript
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// VisualScriptPropertySetAssignOp is an enum for AssignOp values.
+type VisualScriptPropertySetAssignOp int
+
+const (
+	VisualScriptPropertySetAssignOpAdd        VisualScriptPropertySetAssignOp = 1
+	VisualScriptPropertySetAssignOpBitAnd     VisualScriptPropertySetAssignOp = 8
+	VisualScriptPropertySetAssignOpBitOr      VisualScriptPropertySetAssignOp = 9
+	VisualScriptPropertySetAssignOpBitXor     VisualScriptPropertySetAssignOp = 10
+	VisualScriptPropertySetAssignOpDiv        VisualScriptPropertySetAssignOp = 4
+	VisualScriptPropertySetAssignOpMod        VisualScriptPropertySetAssignOp = 5
+	VisualScriptPropertySetAssignOpMul        VisualScriptPropertySetAssignOp = 3
+	VisualScriptPropertySetAssignOpNone       VisualScriptPropertySetAssignOp = 0
+	VisualScriptPropertySetAssignOpShiftLeft  VisualScriptPropertySetAssignOp = 6
+	VisualScriptPropertySetAssignOpShiftRight VisualScriptPropertySetAssignOp = 7
+	VisualScriptPropertySetAssignOpSub        VisualScriptPropertySetAssignOp = 2
+)
+
+// String will return the Godot name of the VisualScriptPropertySetAssignOp value.
+func (e VisualScriptPropertySetAssignOp) String() string {
+	switch e {
+	case VisualScriptPropertySetAssignOpAdd:
+		return "ASSIGN_OP_ADD"
+	case VisualScriptPropertySetAssignOpBitAnd:
+		return "ASSIGN_OP_BIT_AND"
+	case VisualScriptPropertySetAssignOpBitOr:
+		return "ASSIGN_OP_BIT_OR"
+	case VisualScriptPropertySetAssignOpBitXor:
+		return "ASSIGN_OP_BIT_XOR"
+	case VisualScriptPropertySetAssignOpDiv:
+		return "ASSIGN_OP_DIV"
+	case VisualScriptPropertySetAssignOpMod:
+		return "ASSIGN_OP_MOD"
+	case VisualScriptPropertySetAssignOpMul:
+		return "ASSIGN_OP_MUL"
+	case VisualScriptPropertySetAssignOpNone:
+		return "ASSIGN_OP_NONE"
+	case VisualScriptPropertySetAssignOpShiftLeft:
+		return "ASSIGN_OP_SHIFT_LEFT"
+	case VisualScriptPropertySetAssignOpShiftRight:
+		return "ASSIGN_OP_SHIFT_RIGHT"
+	case VisualScriptPropertySetAssignOpSub:
+		return "ASSIGN_OP_SUB"
+	}
+	return fmt.Sprintf("VisualScriptPropertySetAssignOp(%d)", int(e))
+}
+
+// ParseVisualScriptPropertySetAssignOp will return the VisualScriptPropertySetAssignOp value with the given Godot name.
+func ParseVisualScriptPropertySetAssignOp(name string) (VisualScriptPropertySetAssignOp, error) {
+	switch name {
+	case "ASSIGN_OP_ADD":
+		return VisualScriptPropertySetAssignOpAdd, nil
+	case "ASSIGN_OP_BIT_AND":
+		return VisualScriptPropertySetAssignOpBitAnd, nil
+	case "ASSIGN_OP_BIT_OR":
+		return VisualScriptPropertySetAssignOpBitOr, nil
+	case "ASSIGN_OP_BIT_XOR":
+		return VisualScriptPropertySetAssignOpBitXor, nil
+	case "ASSIGN_OP_DIV":
+		return VisualScriptPropertySetAssignOpDiv, nil
+	case "ASSIGN_OP_MOD":
+		return VisualScriptPropertySetAssignOpMod, nil
+	case "ASSIGN_OP_MUL":
+		return VisualScriptPropertySetAssignOpMul, nil
+	case "ASSIGN_OP_NONE":
+		return VisualScriptPropertySetAssignOpNone, nil
+	case "ASSIGN_OP_SHIFT_LEFT":
+		return VisualScriptPropertySetAssignOpShiftLeft, nil
+	case "ASSIGN_OP_SHIFT_RIGHT":
+		return VisualScriptPropertySetAssignOpShiftRight, nil
+	case "ASSIGN_OP_SUB":
+		return VisualScriptPropertySetAssignOpSub, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptPropertySetAssignOp value %q", name)
+}
+
+// VisualScriptPropertySetCallMode is an enum for CallMode values.
+type VisualScriptPropertySetCallMode int
+
+const (
+	VisualScriptPropertySetCallModeBasicType VisualScriptPropertySetCallMode = 3
+	VisualScriptPropertySetCallModeInstance  VisualScriptPropertySetCallMode = 2
+	VisualScriptPropertySetCallModeNodePath  VisualScriptPropertySetCallMode = 1
+	VisualScriptPropertySetCallModeSelf      VisualScriptPropertySetCallMode = 0
+)
+
+// String will return the Godot name of the VisualScriptPropertySetCallMode value.
+func (e VisualScriptPropertySetCallMode) String() string {
+	switch e {
+	case VisualScriptPropertySetCallModeBasicType:
+		return "CALL_MODE_BASIC_TYPE"
+	case VisualScriptPropertySetCallModeInstance:
+		return "CALL_MODE_INSTANCE"
+	case VisualScriptPropertySetCallModeNodePath:
+		return "CALL_MODE_NODE_PATH"
+	case VisualScriptPropertySetCallModeSelf:
+		return "CALL_MODE_SELF"
+	}
+	return fmt.Sprintf("VisualScriptPropertySetCallMode(%d)", int(e))
+}
+
+// ParseVisualScriptPropertySetCallMode will return the VisualScriptPropertySetCallMode value with the given Godot name.
+func ParseVisualScriptPropertySetCallMode(name string) (VisualScriptPropertySetCallMode, error) {
+	switch name {
+	case "CALL_MODE_BASIC_TYPE":
+		return VisualScriptPropertySetCallModeBasicType, nil
+	case "CALL_MODE_INSTANCE":
+		return VisualScriptPropertySetCallModeInstance, nil
+	case "CALL_MODE_NODE_PATH":
+		return VisualScriptPropertySetCallModeNodePath, nil
+	case "CALL_MODE_SELF":
+		return VisualScriptPropertySetCallModeSelf, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptPropertySetCallMode value %q", name)
+}
+
+/*
+Undocumented
+*/
+type VisualScriptPropertySet struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptPropertySet is the class constructor of the VisualScriptPropertySet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptPropertySet = gdnative.NewLazyClassConstructor("VisualScriptPropertySet")
+
+// NewVisualScriptPropertySet will create a new instance of the VisualScriptPropertySet class.
+// VisualScriptPropertySet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptPropertySet() *VisualScriptPropertySet {
+	obj := &VisualScriptPropertySet{}
+	obj.SetBaseObject(classConstructorVisualScriptPropertySet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptPropertySet) BaseClass() string {
+	return "VisualScriptPropertySet"
+}
+
+// Method binds of the VisualScriptPropertySet class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptPropertySetX_GetTypeCache = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "_get_type_cache")
+	methodBindVisualScriptPropertySetX_SetTypeCache = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "_set_type_cache")
+	methodBindVisualScriptPropertySetGetAssignOp    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_assign_op")
+	methodBindVisualScriptPropertySetGetBasePath    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_base_path")
+	methodBindVisualScriptPropertySetGetBaseScript  = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_base_script")
+	methodBindVisualScriptPropertySetGetBaseType    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_base_type")
+	methodBindVisualScriptPropertySetGetBasicType   = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_basic_type")
+	methodBindVisualScriptPropertySetGetCallMode    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_call_mode")
+	methodBindVisualScriptPropertySetGetIndex       = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_index")
+	methodBindVisualScriptPropertySetGetProperty    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "get_property")
+	methodBindVisualScriptPropertySetSetAssignOp    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_assign_op")
+	methodBindVisualScriptPropertySetSetBasePath    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_base_path")
+	methodBindVisualScriptPropertySetSetBaseScript  = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_base_script")
+	methodBindVisualScriptPropertySetSetBaseType    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_base_type")
+	methodBindVisualScriptPropertySetSetBasicType   = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_basic_type")
+	methodBindVisualScriptPropertySetSetCallMode    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_call_mode")
+	methodBindVisualScriptPropertySetSetIndex       = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_index")
+	methodBindVisualScriptPropertySetSetProperty    = gdnative.NewLazyMethodBind("VisualScriptPropertySet", "set_property")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: Dictionary
+*/
+func (o *VisualScriptPropertySet) X_GetTypeCache() gdnative.Dictionary {
+	//log.Println("Calling VisualScriptPropertySet.X_GetTypeCache()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetX_GetTypeCache.Get()
+
+	// Call the parent method.
+	// Dictionary
+	retPtr := gdnative.NewEmptyDictionary()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewDictionaryFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false type_cache Dictionary}], Returns: void
+*/
+func (o *VisualScriptPropertySet) X_SetTypeCache(typeCache gdnative.Dictionary) {
+	//log.Println("Calling VisualScriptPropertySet.X_SetTypeCache()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromDictionary(typeCache)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetX_SetTypeCache.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptPropertySet::AssignOp
+*/
+func (o *VisualScriptPropertySet) GetAssignOp() VisualScriptPropertySetAssignOp {
+	//log.Println("Calling VisualScriptPropertySet.GetAssignOp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetAssignOp.Get()
+
+	// Call the parent method.
+	// enum.VisualScriptPropertySet::AssignOp
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return VisualScriptPropertySetAssignOp(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: NodePath
+*/
+func (o *VisualScriptPropertySet) GetBasePath() gdnative.NodePath {
+	//log.Println("Calling VisualScriptPropertySet.GetBasePath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetBasePath.Get()
+
+	// Call the parent method.
+	// NodePath
+	retPtr := gdnative.NewEmptyNodePath()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewNodePathFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *VisualScriptPropertySet) GetBaseScript() gdnative.String {
+	//log.Println("Calling VisualScriptPropertySet.GetBaseScript()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetBaseScript.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *VisualScriptPropertySet) GetBaseType() gdnative.String {
+	//log.Println("Calling VisualScriptPropertySet.GetBaseType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetBaseType.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
+*/
+func (o *VisualScriptPropertySet) GetBasicType() gdnative.VariantType {
+	//log.Println("Calling VisualScriptPropertySet.GetBasicType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetBasicType.Get()
+
+	// Call the parent method.
+	// enum.Variant::Type
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.VariantType(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptPropertySet::CallMode
+*/
+func (o *VisualScriptPropertySet) GetCallMode() VisualScriptPropertySetCallMode {
+	//log.Println("Calling VisualScriptPropertySet.GetCallMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetCallMode.Get()
+
+	// Call the parent method.
+	// enum.VisualScriptPropertySet::CallMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return VisualScriptPropertySetCallMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *VisualScriptPropertySet) GetIndex() gdnative.String {
+	//log.Println("Calling VisualScriptPropertySet.GetIndex()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetIndex.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: String
+*/
+func (o *VisualScriptPropertySet) GetProperty() gdnative.String {
+	//log.Println("Calling VisualScriptPropertySet.GetProperty()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetGetProperty.Get()
+
+	// Call the parent method.
+	// String
+	retPtr := gdnative.NewEmptyString()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewStringFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [{ false assign_op enum.VisualScriptPropertySet::AssignOp}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetAssignOp(assignOp VisualScriptPropertySetAssignOp) {
+	//log.Println("Calling VisualScriptPropertySet.SetAssignOp()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(assignOp))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetAssignOp.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false base_path NodePath}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetBasePath(basePath gdnative.NodePath) {
+	//log.Println("Calling VisualScriptPropertySet.SetBasePath()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromNodePath(basePath)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetBasePath.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false base_script String}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetBaseScript(baseScript gdnative.String) {
+	//log.Println("Calling VisualScriptPropertySet.SetBaseScript()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(baseScript)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetBaseScript.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false base_type String}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetBaseType(baseType gdnative.String) {
+	//log.Println("Calling VisualScriptPropertySet.SetBaseType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(baseType)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetBaseType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false basic_type enum.Variant::Type}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetBasicType(basicType gdnative.VariantType) {
+	//log.Println("Calling VisualScriptPropertySet.SetBasicType()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(basicType))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetBasicType.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.VisualScriptPropertySet::CallMode}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetCallMode(mode VisualScriptPropertySetCallMode) {
+	//log.Println("Calling VisualScriptPropertySet.SetCallMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetCallMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false index String}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetIndex(index gdnative.String) {
+	//log.Println("Calling VisualScriptPropertySet.SetIndex()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(index)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetIndex.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false property String}], Returns: void
+*/
+func (o *VisualScriptPropertySet) SetProperty(property gdnative.String) {
+	//log.Println("Calling VisualScriptPropertySet.SetProperty()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromString(property)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptPropertySetSetProperty.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// AssignOp will return the value of the "assign_op" property.
+func (o *VisualScriptPropertySet) AssignOp() VisualScriptPropertySetAssignOp {
+	return o.GetAssignOp()
+}
+
+// BaseScript will return the value of the "base_script" property.
+func (o *VisualScriptPropertySet) BaseScript() gdnative.String {
+	return o.GetBaseScript()
+}
+
+// BaseType will return the value of the "base_type" property.
+func (o *VisualScriptPropertySet) BaseType() gdnative.String {
+	return o.GetBaseType()
+}
+
+// BasicType will return the value of the "basic_type" property.
+func (o *VisualScriptPropertySet) BasicType() gdnative.VariantType {
+	return o.GetBasicType()
+}
+
+// Index will return the value of the "index" property.
+func (o *VisualScriptPropertySet) Index() gdnative.String {
+	return o.GetIndex()
+}
+
+// NodePath will return the value of the "node_path" property.
+func (o *VisualScriptPropertySet) NodePath() gdnative.NodePath {
+	return o.GetBasePath()
+}
+
+// SetNodePath will set the value of the "node_path" property.
+func (o *VisualScriptPropertySet) SetNodePath(value gdnative.NodePath) {
+	o.SetBasePath(value)
+}
+
+// Property will return the value of the "property" property.
+func (o *VisualScriptPropertySet) Property() gdnative.String {
+	return o.GetProperty()
+}
+
+// SetMode will return the value of the "set_mode" property.
+func (o *VisualScriptPropertySet) SetMode() VisualScriptPropertySetCallMode {
+	return o.GetCallMode()
+}
+
+// SetSetMode will set the value of the "set_mode" property.
+func (o *VisualScriptPropertySet) SetSetMode(value VisualScriptPropertySetCallMode) {
+	o.SetCallMode(value)
+}
+
+// TypeCache will return the value of the "type_cache" property.
+func (o *VisualScriptPropertySet) TypeCache() gdnative.Dictionary {
+	return o.X_GetTypeCache()
+}
+
+// SetTypeCache will set the value of the "type_cache" property.
+func (o *VisualScriptPropertySet) SetTypeCache(value gdnative.Dictionary) {
+	o.X_SetTypeCache(value)
+}
+
+// VisualScriptPropertySetImplementer is an interface that implements the methods
+// of the VisualScriptPropertySet class.
+type VisualScriptPropertySetImplementer interface {
+	VisualScriptNodeImplementer
+	X_GetTypeCache() gdnative.Dictionary
+	X_SetTypeCache(typeCache gdnative.Dictionary)
+	GetAssignOp() VisualScriptPropertySetAssignOp
+	GetBasePath() gdnative.NodePath
+	GetBaseScript() gdnative.String
+	GetBaseType() gdnative.String
+	GetBasicType() gdnative.VariantType
+	GetCallMode() VisualScriptPropertySetCallMode
+	GetIndex() gdnative.String
+	GetProperty() gdnative.String
+	SetAssignOp(assignOp VisualScriptPropertySetAssignOp)
+	SetBasePath(basePath gdnative.NodePath)
+	SetBaseScript(baseScript gdnative.String)
+	SetBaseType(baseType gdnative.String)
+	SetBasicType(basicType gdnative.VariantType)
+	SetCallMode(mode VisualScriptPropertySetCallMode)
+	SetIndex(index gdnative.String)
+	SetProperty(property gdnative.String)
+	AssignOp() VisualScriptPropertySetAssignOp
+	BaseScript() gdnative.String
+	BaseType() gdnative.String
+	BasicType() gdnative.VariantType
+	Index() gdnative.String
+	NodePath() gdnative.NodePath
+	SetNodePath(value gdnative.NodePath)
+	Property() gdnative.String
+	SetMode() VisualScriptPropertySetCallMode
+	SetSetMode(value VisualScriptPropertySetCallMode)
+	TypeCache() gdnative.Dictionary
+	SetTypeCache(value gdnative.Dictionary)
+}
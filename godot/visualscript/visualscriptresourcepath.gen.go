@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptResourcePathFromPointer(ptr gdnative.Pointer) VisualScriptResourcePath {
-func newVisualScriptResourcePathFromPointer(ptr gdnative.Pointer) VisualScriptResourcePath {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptResourcePath{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptResourcePath struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptResourcePath is the class constructor of the VisualScriptResourcePath class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptResourcePath = gdnative.NewLazyClassConstructor("VisualScriptResourcePath")
+
+// NewVisualScriptResourcePath will create a new instance of the VisualScriptResourcePath class.
+// VisualScriptResourcePath is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptResourcePath() *VisualScriptResourcePath {
+	obj := &VisualScriptResourcePath{}
+	obj.SetBaseObject(classConstructorVisualScriptResourcePath.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptResourcePath) BaseClass() string {
 	return "VisualScriptResourcePath"
 }
 
+// Method binds of the VisualScriptResourcePath class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptResourcePathGetResourcePath = gdnative.NewLazyMethodBind("VisualScriptResourcePath", "get_resource_path")
+	methodBindVisualScriptResourcePathSetResourcePath = gdnative.NewLazyMethodBind("VisualScriptResourcePath", "set_resource_path")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptResourcePath) GetResourcePath() gdnative.String {
 	//log.Println("Calling VisualScriptResourcePath.GetResourcePath()")
@@ -45,7 +61,7 @@ func (o *VisualScriptResourcePath) GetResourcePath() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptResourcePath", "get_resource_path")
+	methodBind := methodBindVisualScriptResourcePathGetResourcePath.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *VisualScriptResourcePath) GetResourcePath() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: void
+	        Undocumented
+		Args: [{ false path String}], Returns: void
 */
 func (o *VisualScriptResourcePath) SetResourcePath(path gdnative.String) {
 	//log.Println("Calling VisualScriptResourcePath.SetResourcePath()")
@@ -69,7 +85,7 @@ func (o *VisualScriptResourcePath) SetResourcePath(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptResourcePath", "set_resource_path")
+	methodBind := methodBindVisualScriptResourcePathSetResourcePath.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *VisualScriptResourcePath) SetResourcePath(path gdnative.String) {
 
 }
 
+// Path will return the value of the "path" property.
+func (o *VisualScriptResourcePath) Path() gdnative.String {
+	return o.GetResourcePath()
+}
+
 // VisualScriptResourcePathImplementer is an interface that implements the methods
 // of the VisualScriptResourcePath class.
 type VisualScriptResourcePathImplementer interface {
 	VisualScriptNodeImplementer
 	GetResourcePath() gdnative.String
 	SetResourcePath(path gdnative.String)
+	Path() gdnative.String
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptReturnFromPointer(ptr gdnative.Pointer) VisualScriptReturn {
-func newVisualScriptReturnFromPointer(ptr gdnative.Pointer) VisualScriptReturn {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptReturn{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,39 @@ type VisualScriptReturn struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptReturn is the class constructor of the VisualScriptReturn class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptReturn = gdnative.NewLazyClassConstructor("VisualScriptReturn")
+
+// NewVisualScriptReturn will create a new instance of the VisualScriptReturn class.
+// VisualScriptReturn is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptReturn() *VisualScriptReturn {
+	obj := &VisualScriptReturn{}
+	obj.SetBaseObject(classConstructorVisualScriptReturn.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptReturn) BaseClass() string {
 	return "VisualScriptReturn"
 }
 
+// Method binds of the VisualScriptReturn class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptReturnGetReturnType        = gdnative.NewLazyMethodBind("VisualScriptReturn", "get_return_type")
+	methodBindVisualScriptReturnIsReturnValueEnabled = gdnative.NewLazyMethodBind("VisualScriptReturn", "is_return_value_enabled")
+	methodBindVisualScriptReturnSetEnableReturnValue = gdnative.NewLazyMethodBind("VisualScriptReturn", "set_enable_return_value")
+	methodBindVisualScriptReturnSetReturnType        = gdnative.NewLazyMethodBind("VisualScriptReturn", "set_return_type")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
 */
 func (o *VisualScriptReturn) GetReturnType() gdnative.VariantType {
 	//log.Println("Calling VisualScriptReturn.GetReturnType()")
@@ -45,7 +63,7 @@ func (o *VisualScriptReturn) GetReturnType() gdnative.VariantType {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptReturn", "get_return_type")
+	methodBind := methodBindVisualScriptReturnGetReturnType.Get()
 
 	// Call the parent method.
 	// enum.Variant::Type
@@ -58,8 +76,8 @@ func (o *VisualScriptReturn) GetReturnType() gdnative.VariantType {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: bool
+	        Undocumented
+		Args: [], Returns: bool
 */
 func (o *VisualScriptReturn) IsReturnValueEnabled() gdnative.Bool {
 	//log.Println("Calling VisualScriptReturn.IsReturnValueEnabled()")
@@ -68,7 +86,7 @@ func (o *VisualScriptReturn) IsReturnValueEnabled() gdnative.Bool {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptReturn", "is_return_value_enabled")
+	methodBind := methodBindVisualScriptReturnIsReturnValueEnabled.Get()
 
 	// Call the parent method.
 	// bool
@@ -81,8 +99,8 @@ func (o *VisualScriptReturn) IsReturnValueEnabled() gdnative.Bool {
 }
 
 /*
-        Undocumented
-	Args: [{ false enable bool}], Returns: void
+	        Undocumented
+		Args: [{ false enable bool}], Returns: void
 */
 func (o *VisualScriptReturn) SetEnableReturnValue(enable gdnative.Bool) {
 	//log.Println("Calling VisualScriptReturn.SetEnableReturnValue()")
@@ -92,7 +110,7 @@ func (o *VisualScriptReturn) SetEnableReturnValue(enable gdnative.Bool) {
 	ptrArguments[0] = gdnative.NewPointerFromBool(enable)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptReturn", "set_enable_return_value")
+	methodBind := methodBindVisualScriptReturnSetEnableReturnValue.Get()
 
 	// Call the parent method.
 	// void
@@ -102,18 +120,18 @@ func (o *VisualScriptReturn) SetEnableReturnValue(enable gdnative.Bool) {
 }
 
 /*
-        Undocumented
-	Args: [{ false type int}], Returns: void
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
 */
-func (o *VisualScriptReturn) SetReturnType(aType gdnative.Int) {
+func (o *VisualScriptReturn) SetReturnType(aType gdnative.VariantType) {
 	//log.Println("Calling VisualScriptReturn.SetReturnType()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptReturn", "set_return_type")
+	methodBind := methodBindVisualScriptReturnSetReturnType.Get()
 
 	// Call the parent method.
 	// void
@@ -122,11 +140,30 @@ func (o *VisualScriptReturn) SetReturnType(aType gdnative.Int) {
 
 }
 
+// ReturnEnabled will return the value of the "return_enabled" property.
+func (o *VisualScriptReturn) ReturnEnabled() gdnative.Bool {
+	return o.IsReturnValueEnabled()
+}
+
+// SetReturnEnabled will set the value of the "return_enabled" property.
+func (o *VisualScriptReturn) SetReturnEnabled(value gdnative.Bool) {
+	o.SetEnableReturnValue(value)
+}
+
+// ReturnType will return the value of the "return_type" property.
+func (o *VisualScriptReturn) ReturnType() gdnative.VariantType {
+	return o.GetReturnType()
+}
+
 // VisualScriptReturnImplementer is an interface that implements the methods
 // of the VisualScriptReturn class.
 type VisualScriptReturnImplementer interface {
 	VisualScriptNodeImplementer
+	GetReturnType() gdnative.VariantType
 	IsReturnValueEnabled() gdnative.Bool
 	SetEnableReturnValue(enable gdnative.Bool)
-	SetReturnType(aType gdnative.Int)
+	SetReturnType(aType gdnative.VariantType)
+	ReturnEnabled() gdnative.Bool
+	SetReturnEnabled(value gdnative.Bool)
+	ReturnType() gdnative.VariantType
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptSceneNodeFromPointer(ptr gdnative.Pointer) VisualScriptSceneNode {
-func newVisualScriptSceneNodeFromPointer(ptr gdnative.Pointer) VisualScriptSceneNode {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptSceneNode{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptSceneNode struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptSceneNode is the class constructor of the VisualScriptSceneNode class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptSceneNode = gdnative.NewLazyClassConstructor("VisualScriptSceneNode")
+
+// NewVisualScriptSceneNode will create a new instance of the VisualScriptSceneNode class.
+// VisualScriptSceneNode is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptSceneNode() *VisualScriptSceneNode {
+	obj := &VisualScriptSceneNode{}
+	obj.SetBaseObject(classConstructorVisualScriptSceneNode.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptSceneNode) BaseClass() string {
 	return "VisualScriptSceneNode"
 }
 
+// Method binds of the VisualScriptSceneNode class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptSceneNodeGetNodePath = gdnative.NewLazyMethodBind("VisualScriptSceneNode", "get_node_path")
+	methodBindVisualScriptSceneNodeSetNodePath = gdnative.NewLazyMethodBind("VisualScriptSceneNode", "set_node_path")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *VisualScriptSceneNode) GetNodePath() gdnative.NodePath {
 	//log.Println("Calling VisualScriptSceneNode.GetNodePath()")
@@ -45,7 +61,7 @@ func (o *VisualScriptSceneNode) GetNodePath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptSceneNode", "get_node_path")
+	methodBind := methodBindVisualScriptSceneNodeGetNodePath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -58,8 +74,8 @@ func (o *VisualScriptSceneNode) GetNodePath() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [{ false path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false path NodePath}], Returns: void
 */
 func (o *VisualScriptSceneNode) SetNodePath(path gdnative.NodePath) {
 	//log.Println("Calling VisualScriptSceneNode.SetNodePath()")
@@ -69,7 +85,7 @@ func (o *VisualScriptSceneNode) SetNodePath(path gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptSceneNode", "set_node_path")
+	methodBind := methodBindVisualScriptSceneNodeSetNodePath.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *VisualScriptSceneNode) SetNodePath(path gdnative.NodePath) {
 
 }
 
+// NodePath will return the value of the "node_path" property.
+func (o *VisualScriptSceneNode) NodePath() gdnative.NodePath {
+	return o.GetNodePath()
+}
+
 // VisualScriptSceneNodeImplementer is an interface that implements the methods
 // of the VisualScriptSceneNode class.
 type VisualScriptSceneNodeImplementer interface {
 	VisualScriptNodeImplementer
 	GetNodePath() gdnative.NodePath
 	SetNodePath(path gdnative.NodePath)
+	NodePath() gdnative.NodePath
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptSceneTreeFromPointer(ptr gdnative.Pointer) VisualScriptSceneTree {
-func newVisualScriptSceneTreeFromPointer(ptr gdnative.Pointer) VisualScriptSceneTree {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptSceneTree{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptSceneTree struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptSceneTree is the class constructor of the VisualScriptSceneTree class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptSceneTree = gdnative.NewLazyClassConstructor("VisualScriptSceneTree")
+
+// NewVisualScriptSceneTree will create a new instance of the VisualScriptSceneTree class.
+// VisualScriptSceneTree is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptSceneTree() *VisualScriptSceneTree {
+	obj := &VisualScriptSceneTree{}
+	obj.SetBaseObject(classConstructorVisualScriptSceneTree.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptSceneTree) BaseClass() string {
 	return "VisualScriptSceneTree"
 }
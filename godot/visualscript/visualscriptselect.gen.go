@@ -0,0 +1,115 @@
+package visualscript
+
+import (
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+/*
+Undocumented
+*/
+type VisualScriptSelect struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptSelect is the class constructor of the VisualScriptSelect class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptSelect = gdnative.NewLazyClassConstructor("VisualScriptSelect")
+
+// NewVisualScriptSelect will create a new instance of the VisualScriptSelect class.
+// VisualScriptSelect is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptSelect() *VisualScriptSelect {
+	obj := &VisualScriptSelect{}
+	obj.SetBaseObject(classConstructorVisualScriptSelect.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptSelect) BaseClass() string {
+	return "VisualScriptSelect"
+}
+
+// Method binds of the VisualScriptSelect class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptSelectGetTyped = gdnative.NewLazyMethodBind("VisualScriptSelect", "get_typed")
+	methodBindVisualScriptSelectSetTyped = gdnative.NewLazyMethodBind("VisualScriptSelect", "set_typed")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.Variant::Type
+*/
+func (o *VisualScriptSelect) GetTyped() gdnative.VariantType {
+	//log.Println("Calling VisualScriptSelect.GetTyped()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptSelectGetTyped.Get()
+
+	// Call the parent method.
+	// enum.Variant::Type
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return gdnative.VariantType(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false type enum.Variant::Type}], Returns: void
+*/
+func (o *VisualScriptSelect) SetTyped(aType gdnative.VariantType) {
+	//log.Println("Calling VisualScriptSelect.SetTyped()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(aType))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptSelectSetTyped.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Type will return the value of the "type" property.
+func (o *VisualScriptSelect) Type() gdnative.VariantType {
+	return o.GetTyped()
+}
+
+// SetType will set the value of the "type" property.
+func (o *VisualScriptSelect) SetType(value gdnative.VariantType) {
+	o.SetTyped(value)
+}
+
+// VisualScriptSelectImplementer is an interface that implements the methods
+// of the VisualScriptSelect class.
+type VisualScriptSelectImplementer interface {
+	VisualScriptNodeImplementer
+	GetTyped() gdnative.VariantType
+	SetTyped(aType gdnative.VariantType)
+	Type() gdnative.VariantType
+	SetType(value gdnative.VariantType)
+}
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptSelfFromPointer(ptr gdnative.Pointer) VisualScriptSelf {
-func newVisualScriptSelfFromPointer(ptr gdnative.Pointer) VisualScriptSelf {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptSelf{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptSelf struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptSelf is the class constructor of the VisualScriptSelf class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptSelf = gdnative.NewLazyClassConstructor("VisualScriptSelf")
+
+// NewVisualScriptSelf will create a new instance of the VisualScriptSelf class.
+// VisualScriptSelf is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptSelf() *VisualScriptSelf {
+	obj := &VisualScriptSelf{}
+	obj.SetBaseObject(classConstructorVisualScriptSelf.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptSelf) BaseClass() string {
 	return "VisualScriptSelf"
 }
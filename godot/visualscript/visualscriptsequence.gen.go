@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptSequenceFromPointer(ptr gdnative.Pointer) VisualScriptSequence {
-func newVisualScriptSequenceFromPointer(ptr gdnative.Pointer) VisualScriptSequence {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptSequence{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptSequence struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptSequence is the class constructor of the VisualScriptSequence class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptSequence = gdnative.NewLazyClassConstructor("VisualScriptSequence")
+
+// NewVisualScriptSequence will create a new instance of the VisualScriptSequence class.
+// VisualScriptSequence is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptSequence() *VisualScriptSequence {
+	obj := &VisualScriptSequence{}
+	obj.SetBaseObject(classConstructorVisualScriptSequence.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptSequence) BaseClass() string {
 	return "VisualScriptSequence"
 }
 
+// Method binds of the VisualScriptSequence class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptSequenceGetSteps = gdnative.NewLazyMethodBind("VisualScriptSequence", "get_steps")
+	methodBindVisualScriptSequenceSetSteps = gdnative.NewLazyMethodBind("VisualScriptSequence", "set_steps")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: int
+	        Undocumented
+		Args: [], Returns: int
 */
 func (o *VisualScriptSequence) GetSteps() gdnative.Int {
 	//log.Println("Calling VisualScriptSequence.GetSteps()")
@@ -45,7 +61,7 @@ func (o *VisualScriptSequence) GetSteps() gdnative.Int {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptSequence", "get_steps")
+	methodBind := methodBindVisualScriptSequenceGetSteps.Get()
 
 	// Call the parent method.
 	// int
@@ -58,8 +74,8 @@ func (o *VisualScriptSequence) GetSteps() gdnative.Int {
 }
 
 /*
-        Undocumented
-	Args: [{ false steps int}], Returns: void
+	        Undocumented
+		Args: [{ false steps int}], Returns: void
 */
 func (o *VisualScriptSequence) SetSteps(steps gdnative.Int) {
 	//log.Println("Calling VisualScriptSequence.SetSteps()")
@@ -69,7 +85,7 @@ func (o *VisualScriptSequence) SetSteps(steps gdnative.Int) {
 	ptrArguments[0] = gdnative.NewPointerFromInt(steps)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptSequence", "set_steps")
+	methodBind := methodBindVisualScriptSequenceSetSteps.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,16 @@ func (o *VisualScriptSequence) SetSteps(steps gdnative.Int) {
 
 }
 
+// Steps will return the value of the "steps" property.
+func (o *VisualScriptSequence) Steps() gdnative.Int {
+	return o.GetSteps()
+}
+
 // VisualScriptSequenceImplementer is an interface that implements the methods
 // of the VisualScriptSequence class.
 type VisualScriptSequenceImplementer interface {
 	VisualScriptNodeImplementer
 	GetSteps() gdnative.Int
 	SetSteps(steps gdnative.Int)
+	Steps() gdnative.Int
 }
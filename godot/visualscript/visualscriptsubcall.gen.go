@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptSubCallFromPointer(ptr gdnative.Pointer) VisualScriptSubCall {
-func newVisualScriptSubCallFromPointer(ptr gdnative.Pointer) VisualScriptSubCall {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptSubCall{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,36 @@ type VisualScriptSubCall struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptSubCall is the class constructor of the VisualScriptSubCall class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptSubCall = gdnative.NewLazyClassConstructor("VisualScriptSubCall")
+
+// NewVisualScriptSubCall will create a new instance of the VisualScriptSubCall class.
+// VisualScriptSubCall is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptSubCall() *VisualScriptSubCall {
+	obj := &VisualScriptSubCall{}
+	obj.SetBaseObject(classConstructorVisualScriptSubCall.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptSubCall) BaseClass() string {
 	return "VisualScriptSubCall"
 }
 
+// Method binds of the VisualScriptSubCall class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptSubCallX_Subcall = gdnative.NewLazyMethodBind("VisualScriptSubCall", "_subcall")
+)
+
 /*
-        Undocumented
-	Args: [{ false arguments Variant}], Returns: Variant
+	        Undocumented
+		Args: [{ false arguments Variant}], Returns: Variant
 */
 func (o *VisualScriptSubCall) X_Subcall(arguments gdnative.Variant) gdnative.Variant {
 	//log.Println("Calling VisualScriptSubCall.X_Subcall()")
@@ -46,7 +61,7 @@ func (o *VisualScriptSubCall) X_Subcall(arguments gdnative.Variant) gdnative.Var
 	ptrArguments[0] = gdnative.NewPointerFromVariant(arguments)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptSubCall", "_subcall")
+	methodBind := methodBindVisualScriptSubCallX_Subcall.Get()
 
 	// Call the parent method.
 	// Variant
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptSwitchFromPointer(ptr gdnative.Pointer) VisualScriptSwitch {
-func newVisualScriptSwitchFromPointer(ptr gdnative.Pointer) VisualScriptSwitch {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptSwitch{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptSwitch struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptSwitch is the class constructor of the VisualScriptSwitch class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptSwitch = gdnative.NewLazyClassConstructor("VisualScriptSwitch")
+
+// NewVisualScriptSwitch will create a new instance of the VisualScriptSwitch class.
+// VisualScriptSwitch is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptSwitch() *VisualScriptSwitch {
+	obj := &VisualScriptSwitch{}
+	obj.SetBaseObject(classConstructorVisualScriptSwitch.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptSwitch) BaseClass() string {
 	return "VisualScriptSwitch"
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptTypeCastFromPointer(ptr gdnative.Pointer) VisualScriptTypeCast {
-func newVisualScriptTypeCastFromPointer(ptr gdnative.Pointer) VisualScriptTypeCast {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptTypeCast{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,39 @@ type VisualScriptTypeCast struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptTypeCast is the class constructor of the VisualScriptTypeCast class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptTypeCast = gdnative.NewLazyClassConstructor("VisualScriptTypeCast")
+
+// NewVisualScriptTypeCast will create a new instance of the VisualScriptTypeCast class.
+// VisualScriptTypeCast is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptTypeCast() *VisualScriptTypeCast {
+	obj := &VisualScriptTypeCast{}
+	obj.SetBaseObject(classConstructorVisualScriptTypeCast.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptTypeCast) BaseClass() string {
 	return "VisualScriptTypeCast"
 }
 
+// Method binds of the VisualScriptTypeCast class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptTypeCastGetBaseScript = gdnative.NewLazyMethodBind("VisualScriptTypeCast", "get_base_script")
+	methodBindVisualScriptTypeCastGetBaseType   = gdnative.NewLazyMethodBind("VisualScriptTypeCast", "get_base_type")
+	methodBindVisualScriptTypeCastSetBaseScript = gdnative.NewLazyMethodBind("VisualScriptTypeCast", "set_base_script")
+	methodBindVisualScriptTypeCastSetBaseType   = gdnative.NewLazyMethodBind("VisualScriptTypeCast", "set_base_type")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptTypeCast) GetBaseScript() gdnative.String {
 	//log.Println("Calling VisualScriptTypeCast.GetBaseScript()")
@@ -45,7 +63,7 @@ func (o *VisualScriptTypeCast) GetBaseScript() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptTypeCast", "get_base_script")
+	methodBind := methodBindVisualScriptTypeCastGetBaseScript.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +76,8 @@ func (o *VisualScriptTypeCast) GetBaseScript() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptTypeCast) GetBaseType() gdnative.String {
 	//log.Println("Calling VisualScriptTypeCast.GetBaseType()")
@@ -68,7 +86,7 @@ func (o *VisualScriptTypeCast) GetBaseType() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptTypeCast", "get_base_type")
+	methodBind := methodBindVisualScriptTypeCastGetBaseType.Get()
 
 	// Call the parent method.
 	// String
@@ -81,8 +99,8 @@ func (o *VisualScriptTypeCast) GetBaseType() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false path String}], Returns: void
+	        Undocumented
+		Args: [{ false path String}], Returns: void
 */
 func (o *VisualScriptTypeCast) SetBaseScript(path gdnative.String) {
 	//log.Println("Calling VisualScriptTypeCast.SetBaseScript()")
@@ -92,7 +110,7 @@ func (o *VisualScriptTypeCast) SetBaseScript(path gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(path)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptTypeCast", "set_base_script")
+	methodBind := methodBindVisualScriptTypeCastSetBaseScript.Get()
 
 	// Call the parent method.
 	// void
@@ -102,8 +120,8 @@ func (o *VisualScriptTypeCast) SetBaseScript(path gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false type String}], Returns: void
+	        Undocumented
+		Args: [{ false type String}], Returns: void
 */
 func (o *VisualScriptTypeCast) SetBaseType(aType gdnative.String) {
 	//log.Println("Calling VisualScriptTypeCast.SetBaseType()")
@@ -113,7 +131,7 @@ func (o *VisualScriptTypeCast) SetBaseType(aType gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(aType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptTypeCast", "set_base_type")
+	methodBind := methodBindVisualScriptTypeCastSetBaseType.Get()
 
 	// Call the parent method.
 	// void
@@ -122,6 +140,16 @@ func (o *VisualScriptTypeCast) SetBaseType(aType gdnative.String) {
 
 }
 
+// BaseScript will return the value of the "base_script" property.
+func (o *VisualScriptTypeCast) BaseScript() gdnative.String {
+	return o.GetBaseScript()
+}
+
+// BaseType will return the value of the "base_type" property.
+func (o *VisualScriptTypeCast) BaseType() gdnative.String {
+	return o.GetBaseType()
+}
+
 // VisualScriptTypeCastImplementer is an interface that implements the methods
 // of the VisualScriptTypeCast class.
 type VisualScriptTypeCastImplementer interface {
@@ -130,4 +158,6 @@ type VisualScriptTypeCastImplementer interface {
 	GetBaseType() gdnative.String
 	SetBaseScript(path gdnative.String)
 	SetBaseType(aType gdnative.String)
+	BaseScript() gdnative.String
+	BaseType() gdnative.String
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptVariableGetFromPointer(ptr gdnative.Pointer) VisualScriptVariableGet {
-func newVisualScriptVariableGetFromPointer(ptr gdnative.Pointer) VisualScriptVariableGet {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptVariableGet{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptVariableGet struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptVariableGet is the class constructor of the VisualScriptVariableGet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptVariableGet = gdnative.NewLazyClassConstructor("VisualScriptVariableGet")
+
+// NewVisualScriptVariableGet will create a new instance of the VisualScriptVariableGet class.
+// VisualScriptVariableGet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptVariableGet() *VisualScriptVariableGet {
+	obj := &VisualScriptVariableGet{}
+	obj.SetBaseObject(classConstructorVisualScriptVariableGet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptVariableGet) BaseClass() string {
 	return "VisualScriptVariableGet"
 }
 
+// Method binds of the VisualScriptVariableGet class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptVariableGetGetVariable = gdnative.NewLazyMethodBind("VisualScriptVariableGet", "get_variable")
+	methodBindVisualScriptVariableGetSetVariable = gdnative.NewLazyMethodBind("VisualScriptVariableGet", "set_variable")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptVariableGet) GetVariable() gdnative.String {
 	//log.Println("Calling VisualScriptVariableGet.GetVariable()")
@@ -45,7 +61,7 @@ func (o *VisualScriptVariableGet) GetVariable() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptVariableGet", "get_variable")
+	methodBind := methodBindVisualScriptVariableGetGetVariable.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *VisualScriptVariableGet) GetVariable() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptVariableGet) SetVariable(name gdnative.String) {
 	//log.Println("Calling VisualScriptVariableGet.SetVariable()")
@@ -69,7 +85,7 @@ func (o *VisualScriptVariableGet) SetVariable(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptVariableGet", "set_variable")
+	methodBind := methodBindVisualScriptVariableGetSetVariable.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,22 @@ func (o *VisualScriptVariableGet) SetVariable(name gdnative.String) {
 
 }
 
+// VarName will return the value of the "var_name" property.
+func (o *VisualScriptVariableGet) VarName() gdnative.String {
+	return o.GetVariable()
+}
+
+// SetVarName will set the value of the "var_name" property.
+func (o *VisualScriptVariableGet) SetVarName(value gdnative.String) {
+	o.SetVariable(value)
+}
+
 // VisualScriptVariableGetImplementer is an interface that implements the methods
 // of the VisualScriptVariableGet class.
 type VisualScriptVariableGetImplementer interface {
 	VisualScriptNodeImplementer
 	GetVariable() gdnative.String
 	SetVariable(name gdnative.String)
+	VarName() gdnative.String
+	SetVarName(value gdnative.String)
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptVariableSetFromPointer(ptr gdnative.Pointer) VisualScriptVariableSet {
-func newVisualScriptVariableSetFromPointer(ptr gdnative.Pointer) VisualScriptVariableSet {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptVariableSet{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,13 +22,37 @@ type VisualScriptVariableSet struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptVariableSet is the class constructor of the VisualScriptVariableSet class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptVariableSet = gdnative.NewLazyClassConstructor("VisualScriptVariableSet")
+
+// NewVisualScriptVariableSet will create a new instance of the VisualScriptVariableSet class.
+// VisualScriptVariableSet is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptVariableSet() *VisualScriptVariableSet {
+	obj := &VisualScriptVariableSet{}
+	obj.SetBaseObject(classConstructorVisualScriptVariableSet.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptVariableSet) BaseClass() string {
 	return "VisualScriptVariableSet"
 }
 
+// Method binds of the VisualScriptVariableSet class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptVariableSetGetVariable = gdnative.NewLazyMethodBind("VisualScriptVariableSet", "get_variable")
+	methodBindVisualScriptVariableSetSetVariable = gdnative.NewLazyMethodBind("VisualScriptVariableSet", "set_variable")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptVariableSet) GetVariable() gdnative.String {
 	//log.Println("Calling VisualScriptVariableSet.GetVariable()")
@@ -45,7 +61,7 @@ func (o *VisualScriptVariableSet) GetVariable() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptVariableSet", "get_variable")
+	methodBind := methodBindVisualScriptVariableSetGetVariable.Get()
 
 	// Call the parent method.
 	// String
@@ -58,8 +74,8 @@ func (o *VisualScriptVariableSet) GetVariable() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false name String}], Returns: void
+	        Undocumented
+		Args: [{ false name String}], Returns: void
 */
 func (o *VisualScriptVariableSet) SetVariable(name gdnative.String) {
 	//log.Println("Calling VisualScriptVariableSet.SetVariable()")
@@ -69,7 +85,7 @@ func (o *VisualScriptVariableSet) SetVariable(name gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(name)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptVariableSet", "set_variable")
+	methodBind := methodBindVisualScriptVariableSetSetVariable.Get()
 
 	// Call the parent method.
 	// void
@@ -78,10 +94,22 @@ func (o *VisualScriptVariableSet) SetVariable(name gdnative.String) {
 
 }
 
+// VarName will return the value of the "var_name" property.
+func (o *VisualScriptVariableSet) VarName() gdnative.String {
+	return o.GetVariable()
+}
+
+// SetVarName will set the value of the "var_name" property.
+func (o *VisualScriptVariableSet) SetVarName(value gdnative.String) {
+	o.SetVariable(value)
+}
+
 // VisualScriptVariableSetImplementer is an interface that implements the methods
 // of the VisualScriptVariableSet class.
 type VisualScriptVariableSetImplementer interface {
 	VisualScriptNodeImplementer
 	GetVariable() gdnative.String
 	SetVariable(name gdnative.String)
+	VarName() gdnative.String
+	SetVarName(value gdnative.String)
 }
@@ -1,7 +1,8 @@
-package godot
+package visualscript
 
 import (
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -13,15 +14,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewVisualScriptWhileFromPointer(ptr gdnative.Pointer) VisualScriptWhile {
-func newVisualScriptWhileFromPointer(ptr gdnative.Pointer) VisualScriptWhile {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptWhile{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Undocumented
 */
@@ -30,6 +22,23 @@ type VisualScriptWhile struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptWhile is the class constructor of the VisualScriptWhile class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptWhile = gdnative.NewLazyClassConstructor("VisualScriptWhile")
+
+// NewVisualScriptWhile will create a new instance of the VisualScriptWhile class.
+// VisualScriptWhile is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptWhile() *VisualScriptWhile {
+	obj := &VisualScriptWhile{}
+	obj.SetBaseObject(classConstructorVisualScriptWhile.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptWhile) BaseClass() string {
 	return "VisualScriptWhile"
 }
@@ -0,0 +1,206 @@
+package visualscript
+
+import (
+	"fmt"
+
+	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
+)
+
+/*------------------------------------------------------------------------------
+//   This code was generated by a tool.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "class.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+// VisualScriptYieldYieldMode is an enum for YieldMode values.
+type VisualScriptYieldYieldMode int
+
+const (
+	VisualScriptYieldYieldFrame        VisualScriptYieldYieldMode = 1
+	VisualScriptYieldYieldPhysicsFrame VisualScriptYieldYieldMode = 2
+	VisualScriptYieldYieldWait         VisualScriptYieldYieldMode = 3
+)
+
+// String will return the Godot name of the VisualScriptYieldYieldMode value.
+func (e VisualScriptYieldYieldMode) String() string {
+	switch e {
+	case VisualScriptYieldYieldFrame:
+		return "YIELD_FRAME"
+	case VisualScriptYieldYieldPhysicsFrame:
+		return "YIELD_PHYSICS_FRAME"
+	case VisualScriptYieldYieldWait:
+		return "YIELD_WAIT"
+	}
+	return fmt.Sprintf("VisualScriptYieldYieldMode(%d)", int(e))
+}
+
+// ParseVisualScriptYieldYieldMode will return the VisualScriptYieldYieldMode value with the given Godot name.
+func ParseVisualScriptYieldYieldMode(name string) (VisualScriptYieldYieldMode, error) {
+	switch name {
+	case "YIELD_FRAME":
+		return VisualScriptYieldYieldFrame, nil
+	case "YIELD_PHYSICS_FRAME":
+		return VisualScriptYieldYieldPhysicsFrame, nil
+	case "YIELD_WAIT":
+		return VisualScriptYieldYieldWait, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptYieldYieldMode value %q", name)
+}
+
+/*
+Undocumented
+*/
+type VisualScriptYield struct {
+	VisualScriptNode
+	owner gdnative.Object
+}
+
+// classConstructorVisualScriptYield is the class constructor of the VisualScriptYield class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptYield = gdnative.NewLazyClassConstructor("VisualScriptYield")
+
+// NewVisualScriptYield will create a new instance of the VisualScriptYield class.
+// VisualScriptYield is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptYield() *VisualScriptYield {
+	obj := &VisualScriptYield{}
+	obj.SetBaseObject(classConstructorVisualScriptYield.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
+func (o *VisualScriptYield) BaseClass() string {
+	return "VisualScriptYield"
+}
+
+// Method binds of the VisualScriptYield class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptYieldGetWaitTime  = gdnative.NewLazyMethodBind("VisualScriptYield", "get_wait_time")
+	methodBindVisualScriptYieldGetYieldMode = gdnative.NewLazyMethodBind("VisualScriptYield", "get_yield_mode")
+	methodBindVisualScriptYieldSetWaitTime  = gdnative.NewLazyMethodBind("VisualScriptYield", "set_wait_time")
+	methodBindVisualScriptYieldSetYieldMode = gdnative.NewLazyMethodBind("VisualScriptYield", "set_yield_mode")
+)
+
+/*
+	        Undocumented
+		Args: [], Returns: float
+*/
+func (o *VisualScriptYield) GetWaitTime() gdnative.Real {
+	//log.Println("Calling VisualScriptYield.GetWaitTime()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptYieldGetWaitTime.Get()
+
+	// Call the parent method.
+	// float
+	retPtr := gdnative.NewEmptyReal()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewRealFromPointer(retPtr)
+	return ret
+}
+
+/*
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptYield::YieldMode
+*/
+func (o *VisualScriptYield) GetYieldMode() VisualScriptYieldYieldMode {
+	//log.Println("Calling VisualScriptYield.GetYieldMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 0, 0)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptYieldGetYieldMode.Get()
+
+	// Call the parent method.
+	// enum.VisualScriptYield::YieldMode
+	retPtr := gdnative.NewEmptyInt()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+	// If we have a return type, convert it from a pointer into its actual object.
+	ret := gdnative.NewIntFromPointer(retPtr)
+	return VisualScriptYieldYieldMode(ret)
+}
+
+/*
+	        Undocumented
+		Args: [{ false sec float}], Returns: void
+*/
+func (o *VisualScriptYield) SetWaitTime(sec gdnative.Real) {
+	//log.Println("Calling VisualScriptYield.SetWaitTime()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromReal(sec)
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptYieldSetWaitTime.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+/*
+	        Undocumented
+		Args: [{ false mode enum.VisualScriptYield::YieldMode}], Returns: void
+*/
+func (o *VisualScriptYield) SetYieldMode(mode VisualScriptYieldYieldMode) {
+	//log.Println("Calling VisualScriptYield.SetYieldMode()")
+
+	// Build out the method's arguments
+	ptrArguments := make([]gdnative.Pointer, 1, 1)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
+
+	// Get the method bind
+	methodBind := methodBindVisualScriptYieldSetYieldMode.Get()
+
+	// Call the parent method.
+	// void
+	retPtr := gdnative.NewEmptyVoid()
+	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
+
+}
+
+// Mode will return the value of the "mode" property.
+func (o *VisualScriptYield) Mode() VisualScriptYieldYieldMode {
+	return o.GetYieldMode()
+}
+
+// SetMode will set the value of the "mode" property.
+func (o *VisualScriptYield) SetMode(value VisualScriptYieldYieldMode) {
+	o.SetYieldMode(value)
+}
+
+// WaitTime will return the value of the "wait_time" property.
+func (o *VisualScriptYield) WaitTime() gdnative.Real {
+	return o.GetWaitTime()
+}
+
+// VisualScriptYieldImplementer is an interface that implements the methods
+// of the VisualScriptYield class.
+type VisualScriptYieldImplementer interface {
+	VisualScriptNodeImplementer
+	GetWaitTime() gdnative.Real
+	GetYieldMode() VisualScriptYieldYieldMode
+	SetWaitTime(sec gdnative.Real)
+	SetYieldMode(mode VisualScriptYieldYieldMode)
+	Mode() VisualScriptYieldYieldMode
+	SetMode(value VisualScriptYieldYieldMode)
+	WaitTime() gdnative.Real
+}
@@ -1,7 +1,10 @@
-package godot
+package visualscript
 
 import (
+	"fmt"
+
 	"github.com/shadowapex/godot-go/gdnative"
+	"github.com/shadowapex/godot-go/godot"
 )
 
 /*------------------------------------------------------------------------------
@@ -22,13 +25,30 @@ const (
 	VisualScriptYieldSignalCallModeSelf     VisualScriptYieldSignalCallMode = 0
 )
 
-//func NewVisualScriptYieldSignalFromPointer(ptr gdnative.Pointer) VisualScriptYieldSignal {
-func newVisualScriptYieldSignalFromPointer(ptr gdnative.Pointer) VisualScriptYieldSignal {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptYieldSignal{}
-	obj.SetBaseObject(owner)
+// String will return the Godot name of the VisualScriptYieldSignalCallMode value.
+func (e VisualScriptYieldSignalCallMode) String() string {
+	switch e {
+	case VisualScriptYieldSignalCallModeInstance:
+		return "CALL_MODE_INSTANCE"
+	case VisualScriptYieldSignalCallModeNodePath:
+		return "CALL_MODE_NODE_PATH"
+	case VisualScriptYieldSignalCallModeSelf:
+		return "CALL_MODE_SELF"
+	}
+	return fmt.Sprintf("VisualScriptYieldSignalCallMode(%d)", int(e))
+}
 
-	return obj
+// ParseVisualScriptYieldSignalCallMode will return the VisualScriptYieldSignalCallMode value with the given Godot name.
+func ParseVisualScriptYieldSignalCallMode(name string) (VisualScriptYieldSignalCallMode, error) {
+	switch name {
+	case "CALL_MODE_INSTANCE":
+		return VisualScriptYieldSignalCallModeInstance, nil
+	case "CALL_MODE_NODE_PATH":
+		return VisualScriptYieldSignalCallModeNodePath, nil
+	case "CALL_MODE_SELF":
+		return VisualScriptYieldSignalCallModeSelf, nil
+	}
+	return 0, fmt.Errorf("invalid VisualScriptYieldSignalCallMode value %q", name)
 }
 
 /*
@@ -39,13 +59,43 @@ type VisualScriptYieldSignal struct {
 	owner gdnative.Object
 }
 
+// classConstructorVisualScriptYieldSignal is the class constructor of the VisualScriptYieldSignal class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorVisualScriptYieldSignal = gdnative.NewLazyClassConstructor("VisualScriptYieldSignal")
+
+// NewVisualScriptYieldSignal will create a new instance of the VisualScriptYieldSignal class.
+// VisualScriptYieldSignal is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewVisualScriptYieldSignal() *VisualScriptYieldSignal {
+	obj := &VisualScriptYieldSignal{}
+	obj.SetBaseObject(classConstructorVisualScriptYieldSignal.Get().Call())
+	obj.InitRef()
+	godot.BindWrapper(obj)
+
+	return obj
+}
+
 func (o *VisualScriptYieldSignal) BaseClass() string {
 	return "VisualScriptYieldSignal"
 }
 
+// Method binds of the VisualScriptYieldSignal class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindVisualScriptYieldSignalGetBasePath = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "get_base_path")
+	methodBindVisualScriptYieldSignalGetBaseType = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "get_base_type")
+	methodBindVisualScriptYieldSignalGetCallMode = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "get_call_mode")
+	methodBindVisualScriptYieldSignalGetSignal   = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "get_signal")
+	methodBindVisualScriptYieldSignalSetBasePath = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "set_base_path")
+	methodBindVisualScriptYieldSignalSetBaseType = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "set_base_type")
+	methodBindVisualScriptYieldSignalSetCallMode = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "set_call_mode")
+	methodBindVisualScriptYieldSignalSetSignal   = gdnative.NewLazyMethodBind("VisualScriptYieldSignal", "set_signal")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: NodePath
+	        Undocumented
+		Args: [], Returns: NodePath
 */
 func (o *VisualScriptYieldSignal) GetBasePath() gdnative.NodePath {
 	//log.Println("Calling VisualScriptYieldSignal.GetBasePath()")
@@ -54,7 +104,7 @@ func (o *VisualScriptYieldSignal) GetBasePath() gdnative.NodePath {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "get_base_path")
+	methodBind := methodBindVisualScriptYieldSignalGetBasePath.Get()
 
 	// Call the parent method.
 	// NodePath
@@ -67,8 +117,8 @@ func (o *VisualScriptYieldSignal) GetBasePath() gdnative.NodePath {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptYieldSignal) GetBaseType() gdnative.String {
 	//log.Println("Calling VisualScriptYieldSignal.GetBaseType()")
@@ -77,7 +127,7 @@ func (o *VisualScriptYieldSignal) GetBaseType() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "get_base_type")
+	methodBind := methodBindVisualScriptYieldSignalGetBaseType.Get()
 
 	// Call the parent method.
 	// String
@@ -90,8 +140,8 @@ func (o *VisualScriptYieldSignal) GetBaseType() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: enum.VisualScriptYieldSignal::CallMode
+	        Undocumented
+		Args: [], Returns: enum.VisualScriptYieldSignal::CallMode
 */
 func (o *VisualScriptYieldSignal) GetCallMode() VisualScriptYieldSignalCallMode {
 	//log.Println("Calling VisualScriptYieldSignal.GetCallMode()")
@@ -100,7 +150,7 @@ func (o *VisualScriptYieldSignal) GetCallMode() VisualScriptYieldSignalCallMode
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "get_call_mode")
+	methodBind := methodBindVisualScriptYieldSignalGetCallMode.Get()
 
 	// Call the parent method.
 	// enum.VisualScriptYieldSignal::CallMode
@@ -113,8 +163,8 @@ func (o *VisualScriptYieldSignal) GetCallMode() VisualScriptYieldSignalCallMode
 }
 
 /*
-        Undocumented
-	Args: [], Returns: String
+	        Undocumented
+		Args: [], Returns: String
 */
 func (o *VisualScriptYieldSignal) GetSignal() gdnative.String {
 	//log.Println("Calling VisualScriptYieldSignal.GetSignal()")
@@ -123,7 +173,7 @@ func (o *VisualScriptYieldSignal) GetSignal() gdnative.String {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "get_signal")
+	methodBind := methodBindVisualScriptYieldSignalGetSignal.Get()
 
 	// Call the parent method.
 	// String
@@ -136,8 +186,8 @@ func (o *VisualScriptYieldSignal) GetSignal() gdnative.String {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_path NodePath}], Returns: void
+	        Undocumented
+		Args: [{ false base_path NodePath}], Returns: void
 */
 func (o *VisualScriptYieldSignal) SetBasePath(basePath gdnative.NodePath) {
 	//log.Println("Calling VisualScriptYieldSignal.SetBasePath()")
@@ -147,7 +197,7 @@ func (o *VisualScriptYieldSignal) SetBasePath(basePath gdnative.NodePath) {
 	ptrArguments[0] = gdnative.NewPointerFromNodePath(basePath)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "set_base_path")
+	methodBind := methodBindVisualScriptYieldSignalSetBasePath.Get()
 
 	// Call the parent method.
 	// void
@@ -157,8 +207,8 @@ func (o *VisualScriptYieldSignal) SetBasePath(basePath gdnative.NodePath) {
 }
 
 /*
-        Undocumented
-	Args: [{ false base_type String}], Returns: void
+	        Undocumented
+		Args: [{ false base_type String}], Returns: void
 */
 func (o *VisualScriptYieldSignal) SetBaseType(baseType gdnative.String) {
 	//log.Println("Calling VisualScriptYieldSignal.SetBaseType()")
@@ -168,7 +218,7 @@ func (o *VisualScriptYieldSignal) SetBaseType(baseType gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(baseType)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "set_base_type")
+	methodBind := methodBindVisualScriptYieldSignalSetBaseType.Get()
 
 	// Call the parent method.
 	// void
@@ -178,18 +228,18 @@ func (o *VisualScriptYieldSignal) SetBaseType(baseType gdnative.String) {
 }
 
 /*
-        Undocumented
-	Args: [{ false mode int}], Returns: void
+	        Undocumented
+		Args: [{ false mode enum.VisualScriptYieldSignal::CallMode}], Returns: void
 */
-func (o *VisualScriptYieldSignal) SetCallMode(mode gdnative.Int) {
+func (o *VisualScriptYieldSignal) SetCallMode(mode VisualScriptYieldSignalCallMode) {
 	//log.Println("Calling VisualScriptYieldSignal.SetCallMode()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(mode)
+	ptrArguments[0] = gdnative.NewPointerFromInt(gdnative.Int(mode))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "set_call_mode")
+	methodBind := methodBindVisualScriptYieldSignalSetCallMode.Get()
 
 	// Call the parent method.
 	// void
@@ -199,8 +249,8 @@ func (o *VisualScriptYieldSignal) SetCallMode(mode gdnative.Int) {
 }
 
 /*
-        Undocumented
-	Args: [{ false signal String}], Returns: void
+	        Undocumented
+		Args: [{ false signal String}], Returns: void
 */
 func (o *VisualScriptYieldSignal) SetSignal(signal gdnative.String) {
 	//log.Println("Calling VisualScriptYieldSignal.SetSignal()")
@@ -210,7 +260,7 @@ func (o *VisualScriptYieldSignal) SetSignal(signal gdnative.String) {
 	ptrArguments[0] = gdnative.NewPointerFromString(signal)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptYieldSignal", "set_signal")
+	methodBind := methodBindVisualScriptYieldSignalSetSignal.Get()
 
 	// Call the parent method.
 	// void
@@ -219,15 +269,46 @@ func (o *VisualScriptYieldSignal) SetSignal(signal gdnative.String) {
 
 }
 
+// BaseType will return the value of the "base_type" property.
+func (o *VisualScriptYieldSignal) BaseType() gdnative.String {
+	return o.GetBaseType()
+}
+
+// CallMode will return the value of the "call_mode" property.
+func (o *VisualScriptYieldSignal) CallMode() VisualScriptYieldSignalCallMode {
+	return o.GetCallMode()
+}
+
+// NodePath will return the value of the "node_path" property.
+func (o *VisualScriptYieldSignal) NodePath() gdnative.NodePath {
+	return o.GetBasePath()
+}
+
+// SetNodePath will set the value of the "node_path" property.
+func (o *VisualScriptYieldSignal) SetNodePath(value gdnative.NodePath) {
+	o.SetBasePath(value)
+}
+
+// Signal will return the value of the "signal" property.
+func (o *VisualScriptYieldSignal) Signal() gdnative.String {
+	return o.GetSignal()
+}
+
 // VisualScriptYieldSignalImplementer is an interface that implements the methods
 // of the VisualScriptYieldSignal class.
 type VisualScriptYieldSignalImplementer interface {
 	VisualScriptNodeImplementer
 	GetBasePath() gdnative.NodePath
 	GetBaseType() gdnative.String
+	GetCallMode() VisualScriptYieldSignalCallMode
 	GetSignal() gdnative.String
 	SetBasePath(basePath gdnative.NodePath)
 	SetBaseType(baseType gdnative.String)
-	SetCallMode(mode gdnative.Int)
+	SetCallMode(mode VisualScriptYieldSignalCallMode)
 	SetSignal(signal gdnative.String)
+	BaseType() gdnative.String
+	CallMode() VisualScriptYieldSignalCallMode
+	NodePath() gdnative.NodePath
+	SetNodePath(value gdnative.NodePath)
+	Signal() gdnative.String
 }
@@ -1,132 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewVisualScriptConstantFromPointer(ptr gdnative.Pointer) VisualScriptConstant {
-func newVisualScriptConstantFromPointer(ptr gdnative.Pointer) VisualScriptConstant {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptConstant{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Undocumented
-*/
-type VisualScriptConstant struct {
-	VisualScriptNode
-	owner gdnative.Object
-}
-
-func (o *VisualScriptConstant) BaseClass() string {
-	return "VisualScriptConstant"
-}
-
-/*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
-*/
-func (o *VisualScriptConstant) GetConstantType() gdnative.VariantType {
-	//log.Println("Calling VisualScriptConstant.GetConstantType()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstant", "get_constant_type")
-
-	// Call the parent method.
-	// enum.Variant::Type
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return gdnative.VariantType(ret)
-}
-
-/*
-        Undocumented
-	Args: [], Returns: Variant
-*/
-func (o *VisualScriptConstant) GetConstantValue() gdnative.Variant {
-	//log.Println("Calling VisualScriptConstant.GetConstantValue()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstant", "get_constant_value")
-
-	// Call the parent method.
-	// Variant
-	retPtr := gdnative.NewEmptyVariant()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewVariantFromPointer(retPtr)
-	return ret
-}
-
-/*
-        Undocumented
-	Args: [{ false type int}], Returns: void
-*/
-func (o *VisualScriptConstant) SetConstantType(aType gdnative.Int) {
-	//log.Println("Calling VisualScriptConstant.SetConstantType()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstant", "set_constant_type")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false value Variant}], Returns: void
-*/
-func (o *VisualScriptConstant) SetConstantValue(value gdnative.Variant) {
-	//log.Println("Calling VisualScriptConstant.SetConstantValue()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromVariant(value)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptConstant", "set_constant_value")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-// VisualScriptConstantImplementer is an interface that implements the methods
-// of the VisualScriptConstant class.
-type VisualScriptConstantImplementer interface {
-	VisualScriptNodeImplementer
-	GetConstantValue() gdnative.Variant
-	SetConstantType(aType gdnative.Int)
-	SetConstantValue(value gdnative.Variant)
-}
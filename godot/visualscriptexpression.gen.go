@@ -1,41 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewVisualScriptExpressionFromPointer(ptr gdnative.Pointer) VisualScriptExpression {
-func newVisualScriptExpressionFromPointer(ptr gdnative.Pointer) VisualScriptExpression {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptExpression{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Undocumented
-*/
-type VisualScriptExpression struct {
-	VisualScriptNode
-	owner gdnative.Object
-}
-
-func (o *VisualScriptExpression) BaseClass() string {
-	return "VisualScriptExpression"
-}
-
-// VisualScriptExpressionImplementer is an interface that implements the methods
-// of the VisualScriptExpression class.
-type VisualScriptExpressionImplementer interface {
-	VisualScriptNodeImplementer
-}
@@ -1,131 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewVisualScriptOperatorFromPointer(ptr gdnative.Pointer) VisualScriptOperator {
-func newVisualScriptOperatorFromPointer(ptr gdnative.Pointer) VisualScriptOperator {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptOperator{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Undocumented
-*/
-type VisualScriptOperator struct {
-	VisualScriptNode
-	owner gdnative.Object
-}
-
-func (o *VisualScriptOperator) BaseClass() string {
-	return "VisualScriptOperator"
-}
-
-/*
-        Undocumented
-	Args: [], Returns: enum.Variant::Operator
-*/
-func (o *VisualScriptOperator) GetOperator() gdnative.VariantOperator {
-	//log.Println("Calling VisualScriptOperator.GetOperator()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptOperator", "get_operator")
-
-	// Call the parent method.
-	// enum.Variant::Operator
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return gdnative.VariantOperator(ret)
-}
-
-/*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
-*/
-func (o *VisualScriptOperator) GetTyped() gdnative.VariantType {
-	//log.Println("Calling VisualScriptOperator.GetTyped()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptOperator", "get_typed")
-
-	// Call the parent method.
-	// enum.Variant::Type
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return gdnative.VariantType(ret)
-}
-
-/*
-        Undocumented
-	Args: [{ false op int}], Returns: void
-*/
-func (o *VisualScriptOperator) SetOperator(op gdnative.Int) {
-	//log.Println("Calling VisualScriptOperator.SetOperator()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(op)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptOperator", "set_operator")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-/*
-        Undocumented
-	Args: [{ false type int}], Returns: void
-*/
-func (o *VisualScriptOperator) SetTyped(aType gdnative.Int) {
-	//log.Println("Calling VisualScriptOperator.SetTyped()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptOperator", "set_typed")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-// VisualScriptOperatorImplementer is an interface that implements the methods
-// of the VisualScriptOperator class.
-type VisualScriptOperatorImplementer interface {
-	VisualScriptNodeImplementer
-	SetOperator(op gdnative.Int)
-	SetTyped(aType gdnative.Int)
-}
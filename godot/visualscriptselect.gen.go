@@ -1,86 +0,0 @@
-package godot
-
-import (
-	"github.com/shadowapex/godot-go/gdnative"
-)
-
-/*------------------------------------------------------------------------------
-//   This code was generated by a tool.
-//
-//   Changes to this file may cause incorrect behavior and will be lost if
-//   the code is regenerated. Any updates should be done in
-//   "class.go.tmpl" so they can be included in the generated
-//   code.
-//----------------------------------------------------------------------------*/
-
-//func NewVisualScriptSelectFromPointer(ptr gdnative.Pointer) VisualScriptSelect {
-func newVisualScriptSelectFromPointer(ptr gdnative.Pointer) VisualScriptSelect {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := VisualScriptSelect{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
-/*
-Undocumented
-*/
-type VisualScriptSelect struct {
-	VisualScriptNode
-	owner gdnative.Object
-}
-
-func (o *VisualScriptSelect) BaseClass() string {
-	return "VisualScriptSelect"
-}
-
-/*
-        Undocumented
-	Args: [], Returns: enum.Variant::Type
-*/
-func (o *VisualScriptSelect) GetTyped() gdnative.VariantType {
-	//log.Println("Calling VisualScriptSelect.GetTyped()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 0, 0)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptSelect", "get_typed")
-
-	// Call the parent method.
-	// enum.Variant::Type
-	retPtr := gdnative.NewEmptyInt()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-	// If we have a return type, convert it from a pointer into its actual object.
-	ret := gdnative.NewIntFromPointer(retPtr)
-	return gdnative.VariantType(ret)
-}
-
-/*
-        Undocumented
-	Args: [{ false type int}], Returns: void
-*/
-func (o *VisualScriptSelect) SetTyped(aType gdnative.Int) {
-	//log.Println("Calling VisualScriptSelect.SetTyped()")
-
-	// Build out the method's arguments
-	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromInt(aType)
-
-	// Get the method bind
-	methodBind := gdnative.NewMethodBind("VisualScriptSelect", "set_typed")
-
-	// Call the parent method.
-	// void
-	retPtr := gdnative.NewEmptyVoid()
-	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
-
-}
-
-// VisualScriptSelectImplementer is an interface that implements the methods
-// of the VisualScriptSelect class.
-type VisualScriptSelectImplementer interface {
-	VisualScriptNodeImplementer
-	SetTyped(aType gdnative.Int)
-}
@@ -0,0 +1,110 @@
+package godot
+
+import (
+	"context"
+	"errors"
+	"github.com/shadowapex/godot-go/gdnative"
+)
+
+// ErrWaitOnMainThread is returned by WaitSignal when it is called on the main
+// thread. Signals are emitted on the main thread, so waiting there would block
+// forever.
+var ErrWaitOnMainThread = errors.New("godot: can not wait for a signal on the main thread")
+
+// ErrObjectFreed is returned by WaitSignal when the object is freed before the
+// signal is emitted.
+var ErrObjectFreed = errors.New("godot: object was freed while waiting for a signal")
+
+// WaitSignal will block the calling goroutine until the given signal of the
+// object is emitted, and return the arguments of the signal. This is the Go
+// equivalent of GDScript's yield. It returns an error if the context is done or
+// the object is freed first. WaitSignal must not be called on the main thread.
+// The returned arguments are copies owned by the caller, who must call Destroy on
+// each of them once they are no longer used.
+// Noticing that the object was freed requires NativeScript 1.1; on older
+// engines, the object must outlive the wait.
+func WaitSignal(ctx context.Context, object Class, signal string) ([]gdnative.Variant, error) {
+	if gdnative.IsMainThread() {
+		return nil, ErrWaitOnMainThread
+	}
+
+	emitted := make(chan []gdnative.Variant, 1)
+	var connection *Connection
+	var err error
+	Await(func() {
+		connection, err = ConnectFunc(object, signal, func(args ...gdnative.Variant) {
+			copies := copyVariants(args)
+			select {
+			case emitted <- copies:
+			default:
+				destroyVariants(copies)
+			}
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer RunOnMain(connection.Disconnect)
+
+	select {
+	case args := <-emitted:
+		return args, nil
+	case <-connection.freed:
+		return nil, ErrObjectFreed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// SignalChannel will connect the given signal of the object to a channel, which
+// receives the arguments of the signal every time it is emitted. The channel has
+// the given buffer size, and emissions are dropped with a warning if it is full,
+// since the main thread can't wait for the receiver. The channel is closed when
+// the returned Connection is disconnected or the object is freed. Disconnect
+// must be called on the main thread, for example with RunOnMain. The received
+// arguments are copies owned by the receiver, who must call Destroy on each of
+// them once they are no longer used.
+func SignalChannel(object Class, signal string, buffer int) (<-chan []gdnative.Variant, *Connection, error) {
+	emitted := make(chan []gdnative.Variant, buffer)
+	var connection *Connection
+	var err error
+	Await(func() {
+		connection, err = ConnectFunc(object, signal, func(args ...gdnative.Variant) {
+			copies := copyVariants(args)
+			select {
+			case emitted <- copies:
+			default:
+				destroyVariants(copies)
+				Log.Warning("Dropped emission of signal ", signal, ": the channel is full.")
+			}
+		})
+		if err == nil {
+			// Emissions and closing both happen on the main thread, so the
+			// channel is never sent to after it is closed.
+			connection.onFree = func() { close(emitted) }
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return emitted, connection, nil
+}
+
+// copyVariants will copy the given signal arguments, since they are only valid
+// while the signal is being emitted. The copies must be destroyed with
+// destroyVariants or by the receiver.
+func copyVariants(args []gdnative.Variant) []gdnative.Variant {
+	copies := make([]gdnative.Variant, len(args))
+	for i, arg := range args {
+		copies[i] = gdnative.NewVariantCopy(arg)
+	}
+	return copies
+}
+
+// destroyVariants will destroy the given copied signal arguments.
+func destroyVariants(variants []gdnative.Variant) {
+	for i := range variants {
+		variants[i].Destroy()
+	}
+}
@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewWorldFromPointer(ptr gdnative.Pointer) World {
-func newWorldFromPointer(ptr gdnative.Pointer) World {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := World{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class that has everything pertaining to a world. A physics space, a visual scenario and a sound space. Spatial nodes register their resources into the current world.
 */
@@ -30,13 +21,42 @@ type World struct {
 	owner gdnative.Object
 }
 
+// classConstructorWorld is the class constructor of the World class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorWorld = gdnative.NewLazyClassConstructor("World")
+
+// NewWorld will create a new instance of the World class.
+// World is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewWorld() *World {
+	obj := &World{}
+	obj.SetBaseObject(classConstructorWorld.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *World) BaseClass() string {
 	return "World"
 }
 
+// Method binds of the World class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindWorldGetDirectSpaceState    = gdnative.NewLazyMethodBind("World", "get_direct_space_state")
+	methodBindWorldGetEnvironment         = gdnative.NewLazyMethodBind("World", "get_environment")
+	methodBindWorldGetFallbackEnvironment = gdnative.NewLazyMethodBind("World", "get_fallback_environment")
+	methodBindWorldGetScenario            = gdnative.NewLazyMethodBind("World", "get_scenario")
+	methodBindWorldGetSpace               = gdnative.NewLazyMethodBind("World", "get_space")
+	methodBindWorldSetEnvironment         = gdnative.NewLazyMethodBind("World", "set_environment")
+	methodBindWorldSetFallbackEnvironment = gdnative.NewLazyMethodBind("World", "set_fallback_environment")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: PhysicsDirectSpaceState
+	        Undocumented
+		Args: [], Returns: PhysicsDirectSpaceState
 */
 func (o *World) GetDirectSpaceState() PhysicsDirectSpaceStateImplementer {
 	//log.Println("Calling World.GetDirectSpaceState()")
@@ -45,7 +65,7 @@ func (o *World) GetDirectSpaceState() PhysicsDirectSpaceStateImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World", "get_direct_space_state")
+	methodBind := methodBindWorldGetDirectSpaceState.Get()
 
 	// Call the parent method.
 	// PhysicsDirectSpaceState
@@ -53,27 +73,25 @@ func (o *World) GetDirectSpaceState() PhysicsDirectSpaceStateImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysicsDirectSpaceStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(PhysicsDirectSpaceStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "PhysicsDirectSpaceState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(PhysicsDirectSpaceStateImplementer)
+	ret := PhysicsDirectSpaceState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(PhysicsDirectSpaceStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Environment
+	        Undocumented
+		Args: [], Returns: Environment
 */
 func (o *World) GetEnvironment() EnvironmentImplementer {
 	//log.Println("Calling World.GetEnvironment()")
@@ -82,7 +100,7 @@ func (o *World) GetEnvironment() EnvironmentImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World", "get_environment")
+	methodBind := methodBindWorldGetEnvironment.Get()
 
 	// Call the parent method.
 	// Environment
@@ -90,27 +108,25 @@ func (o *World) GetEnvironment() EnvironmentImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEnvironmentFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EnvironmentImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Environment" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EnvironmentImplementer)
+	ret := Environment{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EnvironmentImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Environment
+	        Undocumented
+		Args: [], Returns: Environment
 */
 func (o *World) GetFallbackEnvironment() EnvironmentImplementer {
 	//log.Println("Calling World.GetFallbackEnvironment()")
@@ -119,7 +135,7 @@ func (o *World) GetFallbackEnvironment() EnvironmentImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World", "get_fallback_environment")
+	methodBind := methodBindWorldGetFallbackEnvironment.Get()
 
 	// Call the parent method.
 	// Environment
@@ -127,27 +143,25 @@ func (o *World) GetFallbackEnvironment() EnvironmentImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newEnvironmentFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(EnvironmentImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Environment" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(EnvironmentImplementer)
+	ret := Environment{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(EnvironmentImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *World) GetScenario() gdnative.Rid {
 	//log.Println("Calling World.GetScenario()")
@@ -156,7 +170,7 @@ func (o *World) GetScenario() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World", "get_scenario")
+	methodBind := methodBindWorldGetScenario.Get()
 
 	// Call the parent method.
 	// RID
@@ -169,8 +183,8 @@ func (o *World) GetScenario() gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *World) GetSpace() gdnative.Rid {
 	//log.Println("Calling World.GetSpace()")
@@ -179,7 +193,7 @@ func (o *World) GetSpace() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World", "get_space")
+	methodBind := methodBindWorldGetSpace.Get()
 
 	// Call the parent method.
 	// RID
@@ -192,18 +206,18 @@ func (o *World) GetSpace() gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [{ false env Environment}], Returns: void
+	        Undocumented
+		Args: [{ false env Environment}], Returns: void
 */
 func (o *World) SetEnvironment(env EnvironmentImplementer) {
 	//log.Println("Calling World.SetEnvironment()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(env.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(env))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World", "set_environment")
+	methodBind := methodBindWorldSetEnvironment.Get()
 
 	// Call the parent method.
 	// void
@@ -213,18 +227,18 @@ func (o *World) SetEnvironment(env EnvironmentImplementer) {
 }
 
 /*
-        Undocumented
-	Args: [{ false env Environment}], Returns: void
+	        Undocumented
+		Args: [{ false env Environment}], Returns: void
 */
 func (o *World) SetFallbackEnvironment(env EnvironmentImplementer) {
 	//log.Println("Calling World.SetFallbackEnvironment()")
 
 	// Build out the method's arguments
 	ptrArguments := make([]gdnative.Pointer, 1, 1)
-	ptrArguments[0] = gdnative.NewPointerFromObject(env.GetBaseObject())
+	ptrArguments[0] = gdnative.NewPointerFromObject(GetBaseObject(env))
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World", "set_fallback_environment")
+	methodBind := methodBindWorldSetFallbackEnvironment.Get()
 
 	// Call the parent method.
 	// void
@@ -233,6 +247,31 @@ func (o *World) SetFallbackEnvironment(env EnvironmentImplementer) {
 
 }
 
+// DirectSpaceState will return the value of the "direct_space_state" property.
+func (o *World) DirectSpaceState() PhysicsDirectSpaceStateImplementer {
+	return o.GetDirectSpaceState()
+}
+
+// Environment will return the value of the "environment" property.
+func (o *World) Environment() EnvironmentImplementer {
+	return o.GetEnvironment()
+}
+
+// FallbackEnvironment will return the value of the "fallback_environment" property.
+func (o *World) FallbackEnvironment() EnvironmentImplementer {
+	return o.GetFallbackEnvironment()
+}
+
+// Scenario will return the value of the "scenario" property.
+func (o *World) Scenario() gdnative.Rid {
+	return o.GetScenario()
+}
+
+// Space will return the value of the "space" property.
+func (o *World) Space() gdnative.Rid {
+	return o.GetSpace()
+}
+
 // WorldImplementer is an interface that implements the methods
 // of the World class.
 type WorldImplementer interface {
@@ -244,4 +283,9 @@ type WorldImplementer interface {
 	GetSpace() gdnative.Rid
 	SetEnvironment(env EnvironmentImplementer)
 	SetFallbackEnvironment(env EnvironmentImplementer)
+	DirectSpaceState() PhysicsDirectSpaceStateImplementer
+	Environment() EnvironmentImplementer
+	FallbackEnvironment() EnvironmentImplementer
+	Scenario() gdnative.Rid
+	Space() gdnative.Rid
 }
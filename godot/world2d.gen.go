@@ -13,15 +13,6 @@ import (
 //   code.
 //----------------------------------------------------------------------------*/
 
-//func NewWorld2DFromPointer(ptr gdnative.Pointer) World2D {
-func newWorld2DFromPointer(ptr gdnative.Pointer) World2D {
-	owner := gdnative.NewObjectFromPointer(ptr)
-	obj := World2D{}
-	obj.SetBaseObject(owner)
-
-	return obj
-}
-
 /*
 Class that has everything pertaining to a 2D world. A physics space, a visual scenario and a sound space. 2D nodes register their resources into the current 2D world.
 */
@@ -30,13 +21,38 @@ type World2D struct {
 	owner gdnative.Object
 }
 
+// classConstructorWorld2D is the class constructor of the World2D class. It is
+// looked up the first time it is used and reused afterwards.
+var classConstructorWorld2D = gdnative.NewLazyClassConstructor("World2D")
+
+// NewWorld2D will create a new instance of the World2D class.
+// World2D is a reference type. The returned object holds the first reference, which
+// the caller must drop with Unreference() when it is no longer needed. Release() does this,
+// and frees the object if it was the last reference.
+func NewWorld2D() *World2D {
+	obj := &World2D{}
+	obj.SetBaseObject(classConstructorWorld2D.Get().Call())
+	obj.InitRef()
+	BindWrapper(obj)
+
+	return obj
+}
+
 func (o *World2D) BaseClass() string {
 	return "World2D"
 }
 
+// Method binds of the World2D class. These are looked up the
+// first time the method is called and reused afterwards.
+var (
+	methodBindWorld2DGetCanvas           = gdnative.NewLazyMethodBind("World2D", "get_canvas")
+	methodBindWorld2DGetDirectSpaceState = gdnative.NewLazyMethodBind("World2D", "get_direct_space_state")
+	methodBindWorld2DGetSpace            = gdnative.NewLazyMethodBind("World2D", "get_space")
+)
+
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *World2D) GetCanvas() gdnative.Rid {
 	//log.Println("Calling World2D.GetCanvas()")
@@ -45,7 +61,7 @@ func (o *World2D) GetCanvas() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World2D", "get_canvas")
+	methodBind := methodBindWorld2DGetCanvas.Get()
 
 	// Call the parent method.
 	// RID
@@ -58,8 +74,8 @@ func (o *World2D) GetCanvas() gdnative.Rid {
 }
 
 /*
-        Undocumented
-	Args: [], Returns: Physics2DDirectSpaceState
+	        Undocumented
+		Args: [], Returns: Physics2DDirectSpaceState
 */
 func (o *World2D) GetDirectSpaceState() Physics2DDirectSpaceStateImplementer {
 	//log.Println("Calling World2D.GetDirectSpaceState()")
@@ -68,7 +84,7 @@ func (o *World2D) GetDirectSpaceState() Physics2DDirectSpaceStateImplementer {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World2D", "get_direct_space_state")
+	methodBind := methodBindWorld2DGetDirectSpaceState.Get()
 
 	// Call the parent method.
 	// Physics2DDirectSpaceState
@@ -76,27 +92,25 @@ func (o *World2D) GetDirectSpaceState() Physics2DDirectSpaceStateImplementer {
 	gdnative.MethodBindPtrCall(methodBind, o.GetBaseObject(), ptrArguments, retPtr)
 
 	// If we have a return type, convert it from a pointer into its actual object.
-	ret := newPhysics2DDirectSpaceStateFromPointer(retPtr)
-
-	// Check to see if we already have an instance of this object in our Go instance registry.
-	if instance, ok := InstanceRegistry.Get(ret.GetBaseObject().ID()); ok {
-		return instance.(Physics2DDirectSpaceStateImplementer)
-	}
-
-	// Check to see what kind of class this is and create it. This is generally used with
-	// GetNode().
-	className := ret.GetClass()
-	if className != "Physics2DDirectSpaceState" {
-		actualRet := getActualClass(className, ret.GetBaseObject())
-		return actualRet.(Physics2DDirectSpaceStateImplementer)
+	ret := Physics2DDirectSpaceState{}
+	ret.SetBaseObject(gdnative.NewObjectFromPointer(retPtr))
+
+	// Look up the Go wrapper of this object, so the same wrapper is returned every
+	// time. This will also create the actual class of the object, which is generally
+	// used with GetNode().
+	if !ret.GetBaseObject().IsNil() {
+		actualRet := GetActualClass(ret.GetClass(), ret.GetBaseObject())
+		if implementer, ok := actualRet.(Physics2DDirectSpaceStateImplementer); ok {
+			return implementer
+		}
 	}
 
 	return &ret
 }
 
 /*
-        Undocumented
-	Args: [], Returns: RID
+	        Undocumented
+		Args: [], Returns: RID
 */
 func (o *World2D) GetSpace() gdnative.Rid {
 	//log.Println("Calling World2D.GetSpace()")
@@ -105,7 +119,7 @@ func (o *World2D) GetSpace() gdnative.Rid {
 	ptrArguments := make([]gdnative.Pointer, 0, 0)
 
 	// Get the method bind
-	methodBind := gdnative.NewMethodBind("World2D", "get_space")
+	methodBind := methodBindWorld2DGetSpace.Get()
 
 	// Call the parent method.
 	// RID
@@ -117,6 +131,21 @@ func (o *World2D) GetSpace() gdnative.Rid {
 	return ret
 }
 
+// Canvas will return the value of the "canvas" property.
+func (o *World2D) Canvas() gdnative.Rid {
+	return o.GetCanvas()
+}
+
+// DirectSpaceState will return the value of the "direct_space_state" property.
+func (o *World2D) DirectSpaceState() Physics2DDirectSpaceStateImplementer {
+	return o.GetDirectSpaceState()
+}
+
+// Space will return the value of the "space" property.
+func (o *World2D) Space() gdnative.Rid {
+	return o.GetSpace()
+}
+
 // World2DImplementer is an interface that implements the methods
 // of the World2D class.
 type World2DImplementer interface {
@@ -124,4 +153,7 @@ type World2DImplementer interface {
 	GetCanvas() gdnative.Rid
 	GetDirectSpaceState() Physics2DDirectSpaceStateImplementer
 	GetSpace() gdnative.Rid
+	Canvas() gdnative.Rid
+	DirectSpaceState() Physics2DDirectSpaceStateImplementer
+	Space() gdnative.Rid
 }